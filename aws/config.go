@@ -271,7 +271,7 @@ type AWSClient struct {
 	pinpointconn                        *pinpoint.Pinpoint
 	pricingconn                         *pricing.Pricing
 	qldbconn                            *qldb.QLDB
-	quicksightconn                      *quicksight.QuickSight
+	quicksightconn                      quicksightconniface
 	r53conn                             *route53.Route53
 	ramconn                             *ram.RAM
 	rdsconn                             *rds.RDS
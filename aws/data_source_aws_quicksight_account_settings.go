@@ -0,0 +1,69 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func dataSourceAwsQuickSightAccountSettings() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsQuickSightAccountSettingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"account_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"edition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"default_namespace": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"notification_email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsQuickSightAccountSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	resp, err := conn.DescribeAccountSettings(&quicksight.DescribeAccountSettingsInput{
+		AwsAccountId: aws.String(awsAccountID),
+	})
+	if err != nil {
+		return fmt.Errorf("error describing Quick Sight Account Settings (%s): %s", awsAccountID, err)
+	}
+
+	d.SetId(awsAccountID)
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("account_name", resp.AccountSettings.AccountName)
+	d.Set("edition", resp.AccountSettings.Edition)
+	d.Set("default_namespace", resp.AccountSettings.DefaultNamespace)
+	d.Set("notification_email", resp.AccountSettings.NotificationEmail)
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func dataSourceAwsQuickSightAccountSubscription() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsQuickSightAccountSubscriptionRead,
+
+		Schema: map[string]*schema.Schema{
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"account_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"edition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"notification_email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"account_subscription_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"authentication_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsQuickSightAccountSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	resp, err := conn.DescribeAccountSubscription(&quicksight.DescribeAccountSubscriptionInput{
+		AwsAccountId: aws.String(awsAccountID),
+	})
+	if err != nil {
+		return fmt.Errorf("error describing Quick Sight Account Subscription (%s): %s", awsAccountID, err)
+	}
+
+	d.SetId(awsAccountID)
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("account_name", resp.AccountInfo.AccountName)
+	d.Set("edition", resp.AccountInfo.Edition)
+	d.Set("notification_email", resp.AccountInfo.NotificationEmail)
+	d.Set("account_subscription_status", resp.AccountInfo.AccountSubscriptionStatus)
+	d.Set("authentication_type", resp.AccountInfo.AuthenticationType)
+
+	return nil
+}
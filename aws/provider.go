@@ -241,6 +241,8 @@ func Provider() terraform.ResourceProvider {
 			"aws_partition":                                 dataSourceAwsPartition(),
 			"aws_prefix_list":                               dataSourceAwsPrefixList(),
 			"aws_pricing_product":                           dataSourceAwsPricingProduct(),
+			"aws_quicksight_account_settings":               dataSourceAwsQuickSightAccountSettings(),
+			"aws_quicksight_account_subscription":           dataSourceAwsQuickSightAccountSubscription(),
 			"aws_ram_resource_share":                        dataSourceAwsRamResourceShare(),
 			"aws_rds_cluster":                               dataSourceAwsRdsCluster(),
 			"aws_redshift_cluster":                          dataSourceAwsRedshiftCluster(),
@@ -634,7 +636,22 @@ func Provider() terraform.ResourceProvider {
 			"aws_organizations_organizational_unit":                   resourceAwsOrganizationsOrganizationalUnit(),
 			"aws_placement_group":                                     resourceAwsPlacementGroup(),
 			"aws_proxy_protocol_policy":                               resourceAwsProxyProtocolPolicy(),
+			"aws_quicksight_account_settings":                         resourceAwsQuickSightAccountSettings(),
+			"aws_quicksight_analysis_permission":                      resourceAwsQuickSightAnalysisPermission(),
+			"aws_quicksight_dashboard":                                resourceAwsQuickSightDashboard(),
+			"aws_quicksight_dashboard_permission":                     resourceAwsQuickSightDashboardPermission(),
+			"aws_quicksight_data_set":                                 resourceAwsQuickSightDataSet(),
+			"aws_quicksight_data_set_permission":                      resourceAwsQuickSightDataSetPermission(),
+			"aws_quicksight_data_source":                              resourceAwsQuickSightDataSource(),
+			"aws_quicksight_data_source_permission":                   resourceAwsQuickSightDataSourcePermission(),
+			"aws_quicksight_folder_permission":                        resourceAwsQuickSightFolderPermission(),
 			"aws_quicksight_group":                                    resourceAwsQuickSightGroup(),
+			"aws_quicksight_namespace":                                resourceAwsQuickSightNamespace(),
+			"aws_quicksight_template":                                 resourceAwsQuickSightTemplate(),
+			"aws_quicksight_theme":                                    resourceAwsQuickSightTheme(),
+			"aws_quicksight_theme_alias":                              resourceAwsQuickSightThemeAlias(),
+			"aws_quicksight_theme_permission":                         resourceAwsQuickSightThemePermission(),
+			"aws_quicksight_theme_version":                            resourceAwsQuickSightThemeVersion(),
 			"aws_ram_principal_association":                           resourceAwsRamPrincipalAssociation(),
 			"aws_ram_resource_association":                            resourceAwsRamResourceAssociation(),
 			"aws_ram_resource_share":                                  resourceAwsRamResourceShare(),
@@ -0,0 +1,28 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/quicksight"
+
+// fakeQuicksightconn is a hand-rolled quicksightconniface test double.
+// Embedding the (nil) interface satisfies every method it doesn't override,
+// so a test only has to stub the calls its case actually exercises; calling
+// an unstubbed method panics with a nil pointer dereference, which is enough
+// to fail the test that forgot to stub it.
+type fakeQuicksightconn struct {
+	quicksightconniface
+
+	describeThemePermissions    func(*quicksight.DescribeThemePermissionsInput) (*quicksight.DescribeThemePermissionsOutput, error)
+	describeAnalysisPermissions func(*quicksight.DescribeAnalysisPermissionsInput) (*quicksight.DescribeAnalysisPermissionsOutput, error)
+	describeFolderPermissions   func(*quicksight.DescribeFolderPermissionsInput) (*quicksight.DescribeFolderPermissionsOutput, error)
+}
+
+func (f *fakeQuicksightconn) DescribeThemePermissions(input *quicksight.DescribeThemePermissionsInput) (*quicksight.DescribeThemePermissionsOutput, error) {
+	return f.describeThemePermissions(input)
+}
+
+func (f *fakeQuicksightconn) DescribeAnalysisPermissions(input *quicksight.DescribeAnalysisPermissionsInput) (*quicksight.DescribeAnalysisPermissionsOutput, error) {
+	return f.describeAnalysisPermissions(input)
+}
+
+func (f *fakeQuicksightconn) DescribeFolderPermissions(input *quicksight.DescribeFolderPermissionsInput) (*quicksight.DescribeFolderPermissionsOutput, error) {
+	return f.describeFolderPermissions(input)
+}
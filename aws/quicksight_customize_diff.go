@@ -0,0 +1,23 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// quicksightRequireVersionDescriptionOnSourceEntityChange requires a
+// non-empty version_description whenever source_entity_arn changes, since a
+// new version published without one is indistinguishable from any other
+// version in the Quick Sight console's version history.
+func quicksightRequireVersionDescriptionOnSourceEntityChange(d *schema.ResourceDiff) error {
+	if !d.HasChange("source_entity_arn") {
+		return nil
+	}
+
+	if d.Get("version_description").(string) == "" {
+		return fmt.Errorf("version_description must be set when source_entity_arn changes, so the new version is labeled in its version history")
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+// quickSightEnterpriseEditionError rewrites an UnsupportedUserEditionException
+// into a message that names the Enterprise-only feature that triggered it
+// (VPC connections, namespaces, row-level security, ...), instead of
+// surfacing the raw API error, which doesn't explain why a Standard edition
+// account can't use it. Any other error is returned unchanged.
+func quickSightEnterpriseEditionError(err error, feature string) error {
+	if err == nil {
+		return nil
+	}
+
+	if isAWSErr(err, quicksight.ErrCodeUnsupportedUserEditionException, "") {
+		return fmt.Errorf("%s requires Amazon QuickSight Enterprise Edition: %s", feature, err)
+	}
+
+	return err
+}
+
+// quickSightCrossAccountError rewrites an AccessDeniedException into a
+// message calling out that aws_account_id was set to an account other than
+// the caller's, since that's overwhelmingly the cause: Quick Sight requires
+// cross-account permissions to be configured separately, and the raw
+// AccessDeniedException gives no hint that aws_account_id is the reason. Any
+// other error, or an AccessDeniedException when the accounts do match, is
+// returned unchanged.
+func quickSightCrossAccountError(err error, awsAccountID string, meta interface{}) error {
+	if err == nil {
+		return nil
+	}
+
+	if isAWSErr(err, quicksight.ErrCodeAccessDeniedException, "") && awsAccountID != meta.(*AWSClient).accountid {
+		return fmt.Errorf("%s (aws_account_id (%s) differs from the caller's account; cross-account access requires separate Quick Sight permissions)", err, awsAccountID)
+	}
+
+	return err
+}
+
+// quickSightDomainNotWhitelistedError rewrites a DomainNotWhitelistedException
+// (returned when generating an embed URL from a domain Quick Sight hasn't
+// been told to trust) into a message that points at the account's allowed
+// domains, instead of the raw API error, which doesn't say where to go fix
+// it. Any other error is returned unchanged.
+//
+// No embed-url data source exists in this provider yet to call this from;
+// it's added ahead of one so that data source's error handling is a single
+// line instead of duplicating this rewrite.
+func quickSightDomainNotWhitelistedError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if isAWSErr(err, quicksight.ErrCodeDomainNotWhitelistedException, "") {
+		return fmt.Errorf("%s (add the requesting domain to the account's allowed embedding domains in the Quick Sight console)", err)
+	}
+
+	return err
+}
+
+// quickSightInvalidParameterFieldNames maps an SDK input field name that can
+// appear in an InvalidParameterValueException message to the Terraform
+// attribute that sets it, since the two don't always match (e.g. Quick
+// Sight's "DataSourceParameters" is this provider's "parameters").
+var quickSightInvalidParameterFieldNames = map[string]string{
+	"DataSourceParameters":    "parameters",
+	"Credentials":             "credentials",
+	"VpcConnectionProperties": "vpc_connection_properties",
+	"SslProperties":           "ssl_properties",
+	"SourceEntity":            "source_entity_arn",
+	"VersionDescription":      "version_description",
+}
+
+// quickSightInvalidParameterError appends the Terraform attribute name for
+// the field InvalidParameterValueException's message names, when it's one
+// this provider recognizes, since the raw API field name doesn't always
+// point a user at the right argument. Any other error, or an
+// InvalidParameterValueException naming a field this map doesn't cover, is
+// returned unchanged.
+func quickSightInvalidParameterError(err error) error {
+	if err == nil || !isAWSErr(err, quicksight.ErrCodeInvalidParameterValueException, "") {
+		return err
+	}
+
+	for field, attr := range quickSightInvalidParameterFieldNames {
+		if strings.Contains(err.Error(), field) {
+			return fmt.Errorf("%s (likely the %q attribute)", err, attr)
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,133 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+// quickSightPermissionHasPrincipal reports whether principal already appears
+// among permissions. It's the predicate behind the duplicate-principal check
+// shared by quickSightCheckDuplicateAnalysisPrincipal,
+// quickSightCheckDuplicateFolderPrincipal, and
+// quickSightCheckDuplicateThemePrincipal, factored out so it can be unit
+// tested directly.
+func quickSightPermissionHasPrincipal(permissions []*quicksight.ResourcePermission, principal string) bool {
+	for _, perm := range permissions {
+		if aws.StringValue(perm.Principal) == principal {
+			return true
+		}
+	}
+	return false
+}
+
+// Valid `actions` values for Quick Sight resource permissions, by resource type.
+//
+// Quick Sight validates these server-side, but a client-side allow-list catches
+// mistakes (e.g. a dashboard-only action on a data source) at plan time instead
+// of apply time, and keeps the per-resource action sets from drifting into one
+// another.
+var quicksightDataSourceActions = []string{
+	"quicksight:DescribeDataSource",
+	"quicksight:DescribeDataSourcePermissions",
+	"quicksight:PassDataSource",
+	"quicksight:UpdateDataSource",
+	"quicksight:DeleteDataSource",
+	"quicksight:UpdateDataSourcePermissions",
+}
+
+// quicksightDataSourceViewerActions and quicksightDataSourceOwnerActions are
+// what the data source permission resource's "permission_set" values of
+// "viewer" and "owner" expand to, as an ergonomic alternative to spelling
+// out "actions" by hand for the two common cases.
+var quicksightDataSourceViewerActions = []string{
+	"quicksight:DescribeDataSource",
+	"quicksight:DescribeDataSourcePermissions",
+	"quicksight:PassDataSource",
+}
+
+var quicksightDataSourceOwnerActions = quicksightDataSourceActions
+
+var quicksightDataSetActions = []string{
+	"quicksight:DescribeDataSet",
+	"quicksight:DescribeDataSetPermissions",
+	"quicksight:PassDataSet",
+	"quicksight:DescribeIngestion",
+	"quicksight:ListIngestions",
+	"quicksight:UpdateDataSet",
+	"quicksight:DeleteDataSet",
+	"quicksight:CreateIngestion",
+	"quicksight:CancelIngestion",
+	"quicksight:UpdateDataSetPermissions",
+}
+
+var quicksightDashboardActions = []string{
+	"quicksight:DescribeDashboard",
+	"quicksight:ListDashboardVersions",
+	"quicksight:UpdateDashboardPermissions",
+	"quicksight:QueryDashboard",
+	"quicksight:UpdateDashboard",
+	"quicksight:DeleteDashboard",
+	"quicksight:DescribeDashboardPermissions",
+	"quicksight:UpdateDashboardPublishedVersion",
+}
+
+// quicksightDashboardViewerActions and quicksightDashboardOwnerActions are
+// what the dashboard permission resource's "permission_set" values of
+// "viewer" and "owner" expand to, as an ergonomic alternative to spelling
+// out "actions" by hand for the two common cases.
+var quicksightDashboardViewerActions = []string{
+	"quicksight:DescribeDashboard",
+	"quicksight:ListDashboardVersions",
+	"quicksight:QueryDashboard",
+}
+
+var quicksightDashboardOwnerActions = quicksightDashboardActions
+
+var quicksightAnalysisActions = []string{
+	"quicksight:RestoreAnalysis",
+	"quicksight:UpdateAnalysisPermissions",
+	"quicksight:DeleteAnalysis",
+	"quicksight:DescribeAnalysisPermissions",
+	"quicksight:QueryAnalysis",
+	"quicksight:DescribeAnalysis",
+	"quicksight:UpdateAnalysis",
+}
+
+var quicksightTemplateActions = []string{
+	"quicksight:DescribeTemplate",
+	"quicksight:DescribeTemplatePermissions",
+	"quicksight:DescribeTemplateAlias",
+	"quicksight:ListTemplateAliases",
+	"quicksight:ListTemplateVersions",
+	"quicksight:UpdateTemplatePermissions",
+	"quicksight:DeleteTemplate",
+	"quicksight:UpdateTemplate",
+	"quicksight:DeleteTemplateAlias",
+	"quicksight:UpdateTemplateAlias",
+	"quicksight:CreateTemplateAlias",
+}
+
+var quicksightThemeActions = []string{
+	"quicksight:DescribeTheme",
+	"quicksight:DescribeThemeAlias",
+	"quicksight:ListThemeVersions",
+	"quicksight:ListThemeAliases",
+	"quicksight:DeleteTheme",
+	"quicksight:UpdateThemePermissions",
+	"quicksight:DescribeThemePermissions",
+	"quicksight:UpdateTheme",
+	"quicksight:CreateThemeAlias",
+	"quicksight:DeleteThemeAlias",
+	"quicksight:UpdateThemeAlias",
+}
+
+var quicksightFolderActions = []string{
+	"quicksight:CreateFolder",
+	"quicksight:DescribeFolder",
+	"quicksight:UpdateFolder",
+	"quicksight:DeleteFolder",
+	"quicksight:CreateFolderMembership",
+	"quicksight:DeleteFolderMembership",
+	"quicksight:DescribeFolderPermissions",
+	"quicksight:UpdateFolderPermissions",
+}
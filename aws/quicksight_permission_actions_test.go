@@ -0,0 +1,98 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func TestQuickSightPermissionActionsNoDuplicates(t *testing.T) {
+	lists := map[string][]string{
+		"data source": quicksightDataSourceActions,
+		"data set":    quicksightDataSetActions,
+		"dashboard":   quicksightDashboardActions,
+		"analysis":    quicksightAnalysisActions,
+		"template":    quicksightTemplateActions,
+		"theme":       quicksightThemeActions,
+		"folder":      quicksightFolderActions,
+	}
+
+	for name, actions := range lists {
+		if len(actions) == 0 {
+			t.Errorf("%s actions list is empty", name)
+		}
+
+		seen := make(map[string]bool, len(actions))
+		for _, action := range actions {
+			if seen[action] {
+				t.Errorf("%s actions list contains duplicate %q", name, action)
+			}
+			seen[action] = true
+		}
+	}
+}
+
+func TestQuickSightPermissionSetViewerActionsSubsetOfOwner(t *testing.T) {
+	sets := map[string]struct {
+		viewer []string
+		owner  []string
+	}{
+		"data source": {quicksightDataSourceViewerActions, quicksightDataSourceOwnerActions},
+		"dashboard":   {quicksightDashboardViewerActions, quicksightDashboardOwnerActions},
+	}
+
+	for name, set := range sets {
+		owner := make(map[string]bool, len(set.owner))
+		for _, action := range set.owner {
+			owner[action] = true
+		}
+
+		for _, action := range set.viewer {
+			if !owner[action] {
+				t.Errorf("%s viewer action %q is not in the owner action list", name, action)
+			}
+		}
+	}
+}
+
+func TestQuickSightPermissionHasPrincipal(t *testing.T) {
+	testCases := []struct {
+		name        string
+		permissions []*quicksight.ResourcePermission
+		principal   string
+		want        bool
+	}{
+		{
+			name:        "empty permissions list",
+			permissions: nil,
+			principal:   "arn:aws:iam::123456789012:user/test",
+			want:        false,
+		},
+		{
+			name: "principal present",
+			permissions: []*quicksight.ResourcePermission{
+				{Principal: aws.String("arn:aws:iam::123456789012:user/other")},
+				{Principal: aws.String("arn:aws:iam::123456789012:user/test")},
+			},
+			principal: "arn:aws:iam::123456789012:user/test",
+			want:      true,
+		},
+		{
+			name: "principal absent",
+			permissions: []*quicksight.ResourcePermission{
+				{Principal: aws.String("arn:aws:iam::123456789012:user/other")},
+			},
+			principal: "arn:aws:iam::123456789012:user/test",
+			want:      false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := quickSightPermissionHasPrincipal(tc.permissions, tc.principal); got != tc.want {
+				t.Errorf("quickSightPermissionHasPrincipal() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
@@ -209,7 +209,9 @@ func resourceAwsLightsailInstanceRead(d *schema.ResourceData, meta interface{})
 	d.Set("created_at", i.CreatedAt.Format(time.RFC3339))
 	d.Set("cpu_count", i.Hardware.CpuCount)
 	d.Set("ram_size", strconv.FormatFloat(*i.Hardware.RamSizeInGb, 'f', 0, 64))
-	d.Set("ipv6_address", i.Ipv6Address)
+	if len(i.Ipv6Addresses) > 0 {
+		d.Set("ipv6_address", i.Ipv6Addresses[0])
+	}
 	d.Set("is_static_ip", i.IsStaticIp)
 	d.Set("private_ip_address", i.PrivateIpAddress)
 	d.Set("public_ip_address", i.PublicIpAddress)
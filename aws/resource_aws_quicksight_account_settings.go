@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+// resourceAwsQuickSightAccountSettings is a singleton resource: it never
+// creates or deletes the underlying account settings, only updates them.
+// Its ID is the AWS account ID, the same way aws_api_gateway_account's ID
+// is a fixed sentinel rather than something Create invents.
+func resourceAwsQuickSightAccountSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQuickSightAccountSettingsUpdate,
+		Read:   resourceAwsQuickSightAccountSettingsRead,
+		Update: resourceAwsQuickSightAccountSettingsUpdate,
+		Delete: resourceAwsQuickSightAccountSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			// Controls anonymous embedding of dashboards via public sharing
+			// links. Has a clear "off" state, so it's reset to false on
+			// delete instead of left as-is.
+			"public_sharing_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAwsQuickSightAccountSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := d.Id()
+	if awsAccountID == "" {
+		awsAccountID = meta.(*AWSClient).accountid
+	}
+
+	resp, err := conn.DescribeAccountSettings(&quicksight.DescribeAccountSettingsInput{
+		AwsAccountId: aws.String(awsAccountID),
+	})
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Quick Sight Account Settings %s is already gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error describing Quick Sight Account Settings (%s): %s", awsAccountID, err)
+	}
+
+	d.SetId(awsAccountID)
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("public_sharing_enabled", resp.AccountSettings.PublicSharingEnabled)
+
+	return nil
+}
+
+func resourceAwsQuickSightAccountSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	if _, err := conn.UpdatePublicSharingSettings(&quicksight.UpdatePublicSharingSettingsInput{
+		AwsAccountId:         aws.String(awsAccountID),
+		PublicSharingEnabled: aws.Bool(d.Get("public_sharing_enabled").(bool)),
+	}); err != nil {
+		return fmt.Errorf("error updating Quick Sight Public Sharing Settings (%s): %s", awsAccountID, err)
+	}
+
+	d.SetId(awsAccountID)
+
+	return resourceAwsQuickSightAccountSettingsRead(d, meta)
+}
+
+func resourceAwsQuickSightAccountSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	// public_sharing_enabled has a clear "off" state, so turn it back off
+	// rather than leaving anonymous embedding enabled behind Terraform's back.
+	awsAccountID := d.Id()
+
+	if _, err := meta.(*AWSClient).quicksightconn.UpdatePublicSharingSettings(&quicksight.UpdatePublicSharingSettingsInput{
+		AwsAccountId:         aws.String(awsAccountID),
+		PublicSharingEnabled: aws.Bool(false),
+	}); err != nil {
+		return fmt.Errorf("error disabling Quick Sight Public Sharing Settings (%s): %s", awsAccountID, err)
+	}
+
+	return nil
+}
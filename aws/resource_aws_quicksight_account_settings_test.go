@@ -0,0 +1,81 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func testAccCheckAwsQuickSightAccountSettingsExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		_, err := conn.DescribeAccountSettings(&quicksight.DescribeAccountSettingsInput{
+			AwsAccountId: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func TestAccAWSQuickSightAccountSettings_publicSharingEnabled(t *testing.T) {
+	resourceName := "aws_quicksight_account_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsQuickSightAccountSettingsPublicSharingDisabled,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightAccountSettingsPublicSharingEnabledConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightAccountSettingsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "public_sharing_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAWSQuickSightAccountSettingsPublicSharingEnabledConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightAccountSettingsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "public_sharing_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckAwsQuickSightAccountSettingsPublicSharingDisabled asserts that
+// destroying the resource actually turns public sharing back off.
+func testAccCheckAwsQuickSightAccountSettingsPublicSharingDisabled(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+	resp, err := conn.DescribeAccountSettings(&quicksight.DescribeAccountSettingsInput{
+		AwsAccountId: aws.String(testAccProvider.Meta().(*AWSClient).accountid),
+	})
+	if err != nil {
+		return err
+	}
+
+	if aws.BoolValue(resp.AccountSettings.PublicSharingEnabled) {
+		return fmt.Errorf("Quick Sight Public Sharing Settings still enabled after destroy")
+	}
+
+	return nil
+}
+
+func testAccAWSQuickSightAccountSettingsPublicSharingEnabledConfig(enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_account_settings" "test" {
+  public_sharing_enabled = %[1]t
+}
+`, enabled)
+}
@@ -0,0 +1,255 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func resourceAwsQuickSightAnalysisPermission() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQuickSightAnalysisPermissionCreate,
+		Read:   resourceAwsQuickSightAnalysisPermissionRead,
+		Update: resourceAwsQuickSightAnalysisPermissionUpdate,
+		Delete: resourceAwsQuickSightAnalysisPermissionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsQuickSightAnalysisPermissionCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"analysis_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"principal": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"actions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(quicksightAnalysisActions, false),
+				},
+			},
+		},
+	}
+}
+
+// resourceAwsQuickSightAnalysisPermissionCustomizeDiff catches, at plan time, a
+// second aws_quicksight_analysis_permission resource targeting a principal that
+// already has permissions granted on the analysis. The UpdateAnalysisPermissions
+// API merges grants for a principal across calls, so two Terraform resources
+// racing to "own" the same principal silently clobber one another on apply.
+func resourceAwsQuickSightAnalysisPermissionCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	return quickSightCheckDuplicateAnalysisPrincipal(conn, awsAccountID, d.Get("analysis_id").(string), d.Get("principal").(string))
+}
+
+// quickSightCheckDuplicateAnalysisPrincipal holds the network-calling half of
+// resourceAwsQuickSightAnalysisPermissionCustomizeDiff, pulled out so it can
+// be unit tested against a fake quicksightconniface instead of only through
+// TF_ACC.
+func quickSightCheckDuplicateAnalysisPrincipal(conn quicksightconniface, awsAccountID, analysisID, principal string) error {
+	resp, err := conn.DescribeAnalysisPermissions(&quicksight.DescribeAnalysisPermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		AnalysisId:   aws.String(analysisID),
+	})
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return nil
+	}
+
+	if quickSightPermissionHasPrincipal(resp.Permissions, principal) {
+		return fmt.Errorf("principal %s already has permissions granted on Quick Sight Analysis %s; import the existing aws_quicksight_analysis_permission resource instead of creating a duplicate", principal, analysisID)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightAnalysisPermissionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	analysisID := d.Get("analysis_id").(string)
+	principal := d.Get("principal").(string)
+
+	_, err := conn.UpdateAnalysisPermissions(&quicksight.UpdateAnalysisPermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		AnalysisId:   aws.String(analysisID),
+		GrantPermissions: []*quicksight.ResourcePermission{
+			{
+				Principal: aws.String(principal),
+				Actions:   expandStringSet(d.Get("actions").(*schema.Set)),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error granting Quick Sight Analysis (%s) permissions to %s: %s", analysisID, principal, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", awsAccountID, analysisID, principal))
+
+	return resourceAwsQuickSightAnalysisPermissionRead(d, meta)
+}
+
+func resourceAwsQuickSightAnalysisPermissionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, analysisID, principal, err := resourceAwsQuickSightAnalysisPermissionParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeAnalysisPermissions(&quicksight.DescribeAnalysisPermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		AnalysisId:   aws.String(analysisID),
+	})
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Quick Sight Analysis %s is already gone", analysisID)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error describing Quick Sight Analysis (%s) permissions: %s", analysisID, err)
+	}
+
+	var actions []*string
+	found := false
+	for _, perm := range resp.Permissions {
+		if aws.StringValue(perm.Principal) == principal {
+			actions = perm.Actions
+			found = true
+			break
+		}
+	}
+	if !found {
+		log.Printf("[WARN] Quick Sight Analysis (%s) permission for %s is already gone", analysisID, principal)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("analysis_id", analysisID)
+	d.Set("principal", principal)
+	d.Set("actions", flattenStringSet(actions))
+
+	return nil
+}
+
+func resourceAwsQuickSightAnalysisPermissionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, analysisID, principal, err := resourceAwsQuickSightAnalysisPermissionParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	o, n := d.GetChange("actions")
+	oldActions := o.(*schema.Set)
+	newActions := n.(*schema.Set)
+
+	input := &quicksight.UpdateAnalysisPermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		AnalysisId:   aws.String(analysisID),
+	}
+
+	if toGrant := newActions.Difference(oldActions); toGrant.Len() > 0 {
+		input.GrantPermissions = []*quicksight.ResourcePermission{
+			{
+				Principal: aws.String(principal),
+				Actions:   expandStringSet(toGrant),
+			},
+		}
+	}
+
+	if toRevoke := oldActions.Difference(newActions); toRevoke.Len() > 0 {
+		input.RevokePermissions = []*quicksight.ResourcePermission{
+			{
+				Principal: aws.String(principal),
+				Actions:   expandStringSet(toRevoke),
+			},
+		}
+	}
+
+	if input.GrantPermissions != nil || input.RevokePermissions != nil {
+		if _, err := conn.UpdateAnalysisPermissions(input); err != nil {
+			return fmt.Errorf("error updating Quick Sight Analysis (%s) permissions for %s: %s", analysisID, principal, err)
+		}
+	}
+
+	return resourceAwsQuickSightAnalysisPermissionRead(d, meta)
+}
+
+func resourceAwsQuickSightAnalysisPermissionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, analysisID, principal, err := resourceAwsQuickSightAnalysisPermissionParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.UpdateAnalysisPermissions(&quicksight.UpdateAnalysisPermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		AnalysisId:   aws.String(analysisID),
+		RevokePermissions: []*quicksight.ResourcePermission{
+			{
+				Principal: aws.String(principal),
+				Actions:   expandStringSet(d.Get("actions").(*schema.Set)),
+			},
+		},
+	})
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error revoking Quick Sight Analysis (%s) permissions for %s: %s", analysisID, principal, err)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightAnalysisPermissionParseID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) < 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/ANALYSIS_ID/PRINCIPAL_ARN", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
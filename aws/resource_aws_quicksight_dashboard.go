@@ -0,0 +1,283 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func resourceAwsQuickSightDashboard() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQuickSightDashboardCreate,
+		Read:   resourceAwsQuickSightDashboardRead,
+		Update: resourceAwsQuickSightDashboardUpdate,
+		Delete: resourceAwsQuickSightDashboardDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsQuickSightDashboardCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"dashboard_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"source_entity_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+
+			// Overrides the theme baked into the source template/analysis at
+			// publish time, without having to fork the template itself just
+			// to re-skin a dashboard.
+			"theme_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"version_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": tagsSchemaQuickSight(),
+		},
+	}
+}
+
+func resourceAwsQuickSightDashboardCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	return quicksightRequireVersionDescriptionOnSourceEntityChange(d)
+}
+
+func resourceAwsQuickSightDashboardCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	dashboardID := d.Get("dashboard_id").(string)
+
+	createOpts := &quicksight.CreateDashboardInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DashboardId:  aws.String(dashboardID),
+		Name:         aws.String(d.Get("name").(string)),
+		SourceEntity: &quicksight.DashboardSourceEntity{
+			SourceTemplate: &quicksight.DashboardSourceTemplate{
+				Arn: aws.String(d.Get("source_entity_arn").(string)),
+			},
+		},
+	}
+
+	if v, ok := d.GetOk("theme_arn"); ok {
+		createOpts.ThemeArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("version_description"); ok {
+		createOpts.VersionDescription = aws.String(v.(string))
+	}
+
+	if v := tagsFromMapQuickSight(d.Get("tags").(map[string]interface{})); len(v) > 0 {
+		createOpts.Tags = v
+	}
+
+	if _, err := conn.CreateDashboard(createOpts); err != nil {
+		return fmt.Errorf("error creating Quick Sight Dashboard: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", awsAccountID, dashboardID))
+
+	return resourceAwsQuickSightDashboardRead(d, meta)
+}
+
+func resourceAwsQuickSightDashboardRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dashboardID, err := resourceAwsQuickSightDashboardParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeDashboard(&quicksight.DescribeDashboardInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DashboardId:  aws.String(dashboardID),
+	})
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Quick Sight Dashboard (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error describing Quick Sight Dashboard (%s): %s", d.Id(), err)
+	}
+
+	dashboard := resp.Dashboard
+
+	d.Set("arn", dashboard.Arn)
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("dashboard_id", dashboardID)
+	d.Set("name", dashboard.Name)
+
+	if dashboard.Version != nil {
+		d.Set("theme_arn", dashboard.Version.ThemeArn)
+		d.Set("version_description", dashboard.Version.Description)
+	}
+
+	tagsResp, err := conn.ListTagsForResource(&quicksight.ListTagsForResourceInput{
+		ResourceArn: dashboard.Arn,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for Quick Sight Dashboard (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapQuickSight(tagsResp.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightDashboardUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dashboardID, err := resourceAwsQuickSightDashboardParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	updateOpts := &quicksight.UpdateDashboardInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DashboardId:  aws.String(dashboardID),
+		Name:         aws.String(d.Get("name").(string)),
+		SourceEntity: &quicksight.DashboardSourceEntity{
+			SourceTemplate: &quicksight.DashboardSourceTemplate{
+				Arn: aws.String(d.Get("source_entity_arn").(string)),
+			},
+		},
+	}
+
+	if v, ok := d.GetOk("theme_arn"); ok {
+		updateOpts.ThemeArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("version_description"); ok {
+		updateOpts.VersionDescription = aws.String(v.(string))
+	}
+
+	// Quick Sight rejects concurrent UpdateDashboard calls against the same
+	// dashboard with a ConflictException (e.g. a permissions change landing
+	// mid-publish); retrying lets Terraform win the race instead of failing
+	// the apply outright.
+	rawResp, err := retryOnAwsCode(quicksight.ErrCodeConflictException, func() (interface{}, error) {
+		return conn.UpdateDashboard(updateOpts)
+	})
+	if err != nil {
+		return fmt.Errorf("error updating Quick Sight Dashboard (%s): %s", d.Id(), err)
+	}
+	updateResp := rawResp.(*quicksight.UpdateDashboardOutput)
+
+	// UpdateDashboard only creates a new version; it doesn't put it in front
+	// of viewers. UpdateDashboardPublishedVersion does that, so every config
+	// change here needs both calls to actually take effect.
+	versionArn, err := arn.Parse(aws.StringValue(updateResp.VersionArn))
+	if err != nil {
+		return fmt.Errorf("error parsing Quick Sight Dashboard (%s) version ARN: %s", d.Id(), err)
+	}
+	versionNumber, err := quickSightDashboardVersionNumberFromArnResource(versionArn.Resource)
+	if err != nil {
+		return fmt.Errorf("error parsing Quick Sight Dashboard (%s) version number: %s", d.Id(), err)
+	}
+
+	if _, err := retryOnAwsCode(quicksight.ErrCodeConflictException, func() (interface{}, error) {
+		return conn.UpdateDashboardPublishedVersion(&quicksight.UpdateDashboardPublishedVersionInput{
+			AwsAccountId:  aws.String(awsAccountID),
+			DashboardId:   aws.String(dashboardID),
+			VersionNumber: aws.Int64(versionNumber),
+		})
+	}); err != nil {
+		return fmt.Errorf("error publishing Quick Sight Dashboard (%s) version: %s", d.Id(), err)
+	}
+
+	if err := setTagsQuickSight(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating tags: %s", err)
+	}
+
+	return resourceAwsQuickSightDashboardRead(d, meta)
+}
+
+func resourceAwsQuickSightDashboardDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dashboardID, err := resourceAwsQuickSightDashboardParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := conn.DeleteDashboard(&quicksight.DeleteDashboardInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DashboardId:  aws.String(dashboardID),
+	}); err != nil {
+		if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Quick Sight Dashboard (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// quickSightDashboardVersionNumberFromArnResource pulls the trailing version
+// number off a dashboard version ARN resource of the form
+// "dashboard/<dashboard-id>/version/<version-number>".
+func quickSightDashboardVersionNumberFromArnResource(resource string) (int64, error) {
+	parts := strings.Split(resource, "/")
+	if len(parts) != 4 || parts[2] != "version" {
+		return 0, fmt.Errorf("unexpected format of dashboard version ARN resource (%s)", resource)
+	}
+
+	versionNumber, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing version number from ARN resource (%s): %s", resource, err)
+	}
+
+	return versionNumber, nil
+}
+
+func resourceAwsQuickSightDashboardParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/DASHBOARD_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,234 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func resourceAwsQuickSightDashboardPermission() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQuickSightDashboardPermissionUpsert,
+		Read:   resourceAwsQuickSightDashboardPermissionRead,
+		Update: resourceAwsQuickSightDashboardPermissionUpsert,
+		Delete: resourceAwsQuickSightDashboardPermissionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsQuickSightDashboardPermissionCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"dashboard_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"principal": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"actions": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				MinItems:      1,
+				ConflictsWith: []string{"permission_set"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(quicksightDashboardActions, false),
+				},
+			},
+
+			// An ergonomic alternative to spelling out "actions" by hand:
+			// "viewer" expands to read-only access, "owner" to the full
+			// quicksightDashboardActions list.
+			"permission_set": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"actions"},
+				ValidateFunc:  validation.StringInSlice([]string{"viewer", "owner"}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsQuickSightDashboardPermissionCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	_, hasActions := d.GetOk("actions")
+	_, hasPermissionSet := d.GetOk("permission_set")
+	if !hasActions && !hasPermissionSet {
+		return fmt.Errorf("exactly one of %q or %q must be set", "actions", "permission_set")
+	}
+
+	if d.Id() != "" {
+		return nil
+	}
+
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	dashboardID := d.Get("dashboard_id").(string)
+	principal := d.Get("principal").(string)
+
+	permissions, err := quickSightDashboardPermissions(conn, awsAccountID, dashboardID)
+	if err != nil && !isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		return fmt.Errorf("error describing Quick Sight Dashboard (%s) permissions: %s", dashboardID, err)
+	}
+
+	for _, perm := range permissions {
+		if aws.StringValue(perm.Principal) == principal {
+			return fmt.Errorf("principal (%s) already has permissions on Quick Sight Dashboard (%s); import the existing aws_quicksight_dashboard_permission resource instead", principal, dashboardID)
+		}
+	}
+
+	return nil
+}
+
+// quickSightDashboardPermissionActions resolves "actions", expanding
+// "permission_set" into its underlying action list when that's what was
+// configured instead.
+func quickSightDashboardPermissionActions(d *schema.ResourceData) []*string {
+	switch d.Get("permission_set").(string) {
+	case "viewer":
+		return aws.StringSlice(quicksightDashboardViewerActions)
+	case "owner":
+		return aws.StringSlice(quicksightDashboardOwnerActions)
+	}
+
+	return expandStringSet(d.Get("actions").(*schema.Set))
+}
+
+func resourceAwsQuickSightDashboardPermissionUpsert(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	dashboardID := d.Get("dashboard_id").(string)
+	principal := d.Get("principal").(string)
+
+	grant := &quicksight.ResourcePermission{
+		Principal: aws.String(principal),
+		Actions:   quickSightDashboardPermissionActions(d),
+	}
+
+	if _, err := conn.UpdateDashboardPermissions(&quicksight.UpdateDashboardPermissionsInput{
+		AwsAccountId:     aws.String(awsAccountID),
+		DashboardId:      aws.String(dashboardID),
+		GrantPermissions: []*quicksight.ResourcePermission{grant},
+	}); err != nil {
+		return fmt.Errorf("error updating Quick Sight Dashboard (%s) permissions: %s", dashboardID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", awsAccountID, dashboardID, principal))
+
+	return resourceAwsQuickSightDashboardPermissionRead(d, meta)
+}
+
+func resourceAwsQuickSightDashboardPermissionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dashboardID, principal, err := resourceAwsQuickSightDashboardPermissionParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	permissions, err := quickSightDashboardPermissions(conn, awsAccountID, dashboardID)
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Quick Sight Dashboard (%s) not found, removing permission from state", dashboardID)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error describing Quick Sight Dashboard (%s) permissions: %s", dashboardID, err)
+	}
+
+	for _, perm := range permissions {
+		if aws.StringValue(perm.Principal) != principal {
+			continue
+		}
+
+		d.Set("aws_account_id", awsAccountID)
+		d.Set("dashboard_id", dashboardID)
+		d.Set("principal", principal)
+		if err := d.Set("actions", flattenStringSet(perm.Actions)); err != nil {
+			return fmt.Errorf("error setting actions: %s", err)
+		}
+
+		return nil
+	}
+
+	log.Printf("[WARN] Quick Sight Dashboard (%s) permission for principal (%s) not found, removing from state", dashboardID, principal)
+	d.SetId("")
+	return nil
+}
+
+func resourceAwsQuickSightDashboardPermissionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dashboardID, principal, err := resourceAwsQuickSightDashboardPermissionParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	revoke := &quicksight.ResourcePermission{
+		Principal: aws.String(principal),
+		Actions:   quickSightDashboardPermissionActions(d),
+	}
+
+	if _, err := conn.UpdateDashboardPermissions(&quicksight.UpdateDashboardPermissionsInput{
+		AwsAccountId:      aws.String(awsAccountID),
+		DashboardId:       aws.String(dashboardID),
+		RevokePermissions: []*quicksight.ResourcePermission{revoke},
+	}); err != nil {
+		if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error revoking Quick Sight Dashboard (%s) permissions: %s", dashboardID, err)
+	}
+
+	return nil
+}
+
+func quickSightDashboardPermissions(conn quicksightconniface, awsAccountID, dashboardID string) ([]*quicksight.ResourcePermission, error) {
+	resp, err := conn.DescribeDashboardPermissions(&quicksight.DescribeDashboardPermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DashboardId:  aws.String(dashboardID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Permissions, nil
+}
+
+func resourceAwsQuickSightDashboardPermissionParseID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/DASHBOARD_ID/PRINCIPAL", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
@@ -0,0 +1,1240 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func resourceAwsQuickSightDataSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQuickSightDataSetCreate,
+		Read:   resourceAwsQuickSightDataSetRead,
+		Update: resourceAwsQuickSightDataSetUpdate,
+		Delete: resourceAwsQuickSightDataSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: resourceAwsQuickSightDataSetCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			// DeleteDataSet fails while an ingestion against it is still
+			// running. Cancelling that ingestion first discards whatever
+			// progress it had made, so this defaults to off.
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// A data source created in the same apply can still be in
+			// CREATION_IN_PROGRESS when Terraform gets around to creating a
+			// data set that references it, even though the dependency graph
+			// is satisfied (the data source resource itself returned). This
+			// closes that race by polling the referenced data source's
+			// "status" before creating the data set, instead of racing
+			// Quick Sight's own backend propagation. Off by default since it
+			// adds an extra DescribeDataSource round trip per apply.
+			"wait_for_data_source_ready": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"data_set_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"import_mode": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					quicksight.DataSetImportModeSpice,
+					quicksight.DataSetImportModeDirectQuery,
+				}, false),
+			},
+
+			// Quick Sight manages refresh schedules through their own
+			// Create/Update/DeleteRefreshSchedule API, but since a schedule
+			// always belongs to exactly one data set, exposing it inline here
+			// (instead of as a separate resource, the way permissions are)
+			// keeps the common case of "one schedule per data set" a single
+			// apply instead of two coupled resources.
+			"refresh_schedule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"schedule_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"refresh_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								quicksight.IngestionTypeFullRefresh,
+								quicksight.IngestionTypeIncrementalRefresh,
+							}, false),
+						},
+						"schedule_frequency": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"interval": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											quicksight.RefreshIntervalMinute15,
+											quicksight.RefreshIntervalMinute30,
+											quicksight.RefreshIntervalHourly,
+											quicksight.RefreshIntervalDaily,
+											quicksight.RefreshIntervalWeekly,
+											quicksight.RefreshIntervalMonthly,
+										}, false),
+									},
+									"time_of_the_day": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"timezone": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateQuickSightRefreshScheduleTimezone,
+									},
+									// Only meaningful (and required) for WEEKLY/MONTHLY
+									// intervals; resourceAwsQuickSightDataSetCustomizeDiff
+									// enforces that pairing at plan time instead of
+									// waiting on an opaque API rejection.
+									"refresh_on_day": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"day_of_week": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														quicksight.DayOfWeekSunday,
+														quicksight.DayOfWeekMonday,
+														quicksight.DayOfWeekTuesday,
+														quicksight.DayOfWeekWednesday,
+														quicksight.DayOfWeekThursday,
+														quicksight.DayOfWeekFriday,
+														quicksight.DayOfWeekSaturday,
+													}, false),
+												},
+												"day_of_month": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"physical_table_map": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"physical_table_map_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"relational_table": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data_source_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									// Not every connector is catalog/schema scoped (S3,
+									// for example, has neither), so both are left
+									// optional rather than required alongside name.
+									"catalog": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"schema": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"logical_table_map": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"logical_table_map_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"alias": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						// A logical table's source is either a single physical
+						// table or the join of two other logical tables.
+						// ExactlyOneOf can't express that constraint on a
+						// nested element of a Set, so it's enforced in
+						// resourceAwsQuickSightDataSetCustomizeDiff instead.
+						"source": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"physical_table_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"join_instruction": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"left_operand": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"right_operand": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"type": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														quicksight.JoinTypeInner,
+														quicksight.JoinTypeOuter,
+														quicksight.JoinTypeLeft,
+														quicksight.JoinTypeRight,
+													}, false),
+												},
+												"on_clause": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						// Transforms are applied in list order, matching how
+						// Quick Sight itself pipelines them when building the
+						// logical table.
+						"data_transforms": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cast_column_type_operation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"column_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"new_column_type": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														quicksight.ColumnDataTypeString,
+														quicksight.ColumnDataTypeInteger,
+														quicksight.ColumnDataTypeDecimal,
+														quicksight.ColumnDataTypeDatetime,
+													}, false),
+												},
+												"format": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"tag_column_operation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"column_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"tags": {
+													Type:     schema.TypeList,
+													Required: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"column_geographic_role": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+															"column_description": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"create_columns_operation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"columns": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"column_id": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"column_name": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"expression": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"filter_operation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"condition_expression": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"project_operation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"projected_columns": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"consumed_spice_capacity_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"output_columns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchemaQuickSight(),
+		},
+	}
+}
+
+func resourceAwsQuickSightDataSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	dataSetID := d.Get("data_set_id").(string)
+
+	createOpts := &quicksight.CreateDataSetInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSetId:    aws.String(dataSetID),
+		Name:         aws.String(d.Get("name").(string)),
+		ImportMode:   aws.String(d.Get("import_mode").(string)),
+	}
+
+	if v, ok := d.GetOk("physical_table_map"); ok {
+		physicalTableMap := v.(*schema.Set)
+		createOpts.PhysicalTableMap = expandQuickSightDataSetPhysicalTableMap(physicalTableMap)
+
+		if d.Get("wait_for_data_source_ready").(bool) {
+			for _, dataSourceArn := range quickSightDataSetReferencedDataSourceArns(physicalTableMap) {
+				if err := quickSightWaitForDataSourceArnReady(conn, dataSourceArn, d.Timeout(schema.TimeoutCreate)); err != nil {
+					return fmt.Errorf("error waiting for Quick Sight Data Source (%s) to be ready: %s", dataSourceArn, err)
+				}
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("logical_table_map"); ok {
+		createOpts.LogicalTableMap = expandQuickSightDataSetLogicalTableMap(v.(*schema.Set))
+	}
+
+	if v := tagsFromMapQuickSight(d.Get("tags").(map[string]interface{})); len(v) > 0 {
+		createOpts.Tags = v
+	}
+
+	if _, err := conn.CreateDataSet(createOpts); err != nil {
+		return fmt.Errorf("error creating Quick Sight Data Set: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", awsAccountID, dataSetID))
+
+	if err := resourceAwsQuickSightDataSetRefreshSchedulesSync(d, conn, awsAccountID, dataSetID, nil, d.Get("refresh_schedule").([]interface{})); err != nil {
+		return err
+	}
+
+	return resourceAwsQuickSightDataSetRead(d, meta)
+}
+
+func resourceAwsQuickSightDataSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dataSetID, err := resourceAwsQuickSightDataSetParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeDataSet(&quicksight.DescribeDataSetInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSetId:    aws.String(dataSetID),
+	})
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Quick Sight Data Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error describing Quick Sight Data Set (%s): %s", d.Id(), err)
+	}
+
+	dataSet := resp.DataSet
+
+	d.Set("arn", dataSet.Arn)
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("data_set_id", dataSetID)
+	d.Set("name", dataSet.Name)
+	d.Set("import_mode", dataSet.ImportMode)
+	d.Set("consumed_spice_capacity_in_bytes", dataSet.ConsumedSpiceCapacityInBytes)
+
+	if err := d.Set("physical_table_map", flattenQuickSightDataSetPhysicalTableMap(dataSet.PhysicalTableMap)); err != nil {
+		return fmt.Errorf("error setting physical_table_map: %s", err)
+	}
+
+	if err := d.Set("logical_table_map", flattenQuickSightDataSetLogicalTableMap(dataSet.LogicalTableMap)); err != nil {
+		return fmt.Errorf("error setting logical_table_map: %s", err)
+	}
+
+	if err := d.Set("output_columns", flattenQuickSightDataSetOutputColumns(dataSet.OutputColumns)); err != nil {
+		return fmt.Errorf("error setting output_columns: %s", err)
+	}
+
+	schedulesResp, err := conn.ListRefreshSchedules(&quicksight.ListRefreshSchedulesInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSetId:    aws.String(dataSetID),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing refresh schedules for Quick Sight Data Set (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("refresh_schedule", flattenQuickSightRefreshSchedules(schedulesResp.RefreshSchedules)); err != nil {
+		return fmt.Errorf("error setting refresh_schedule: %s", err)
+	}
+
+	tagsResp, err := conn.ListTagsForResource(&quicksight.ListTagsForResourceInput{
+		ResourceArn: dataSet.Arn,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for Quick Sight Data Set (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapQuickSight(tagsResp.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightDataSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dataSetID, err := resourceAwsQuickSightDataSetParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	updateOpts := &quicksight.UpdateDataSetInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSetId:    aws.String(dataSetID),
+		Name:         aws.String(d.Get("name").(string)),
+		ImportMode:   aws.String(d.Get("import_mode").(string)),
+	}
+
+	if v, ok := d.GetOk("physical_table_map"); ok {
+		updateOpts.PhysicalTableMap = expandQuickSightDataSetPhysicalTableMap(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("logical_table_map"); ok {
+		updateOpts.LogicalTableMap = expandQuickSightDataSetLogicalTableMap(v.(*schema.Set))
+	}
+
+	if _, err := conn.UpdateDataSet(updateOpts); err != nil {
+		return fmt.Errorf("error updating Quick Sight Data Set (%s): %s", d.Id(), err)
+	}
+
+	if d.HasChange("refresh_schedule") {
+		o, n := d.GetChange("refresh_schedule")
+		if err := resourceAwsQuickSightDataSetRefreshSchedulesSync(d, conn, awsAccountID, dataSetID, o.([]interface{}), n.([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	if err := setTagsQuickSight(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating tags: %s", err)
+	}
+
+	return resourceAwsQuickSightDataSetRead(d, meta)
+}
+
+func resourceAwsQuickSightDataSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dataSetID, err := resourceAwsQuickSightDataSetParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	deleteOpts := &quicksight.DeleteDataSetInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSetId:    aws.String(dataSetID),
+	}
+
+	_, err = conn.DeleteDataSet(deleteOpts)
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err == nil {
+		return nil
+	}
+	if !isAWSErr(err, quicksight.ErrCodeConflictException, "") {
+		return fmt.Errorf("error deleting Quick Sight Data Set (%s): %s", d.Id(), err)
+	}
+
+	if !d.Get("force_destroy").(bool) {
+		return fmt.Errorf("error deleting Quick Sight Data Set (%s), likely has an ingestion in progress: %s", d.Id(), err)
+	}
+
+	if cancelErr := quickSightCancelRunningIngestions(conn, awsAccountID, dataSetID); cancelErr != nil {
+		return fmt.Errorf("error deleting Quick Sight Data Set (%s), failed to cancel in-progress ingestion: %s", d.Id(), cancelErr)
+	}
+
+	if _, err := conn.DeleteDataSet(deleteOpts); err != nil && !isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		return fmt.Errorf("error deleting Quick Sight Data Set (%s) after cancelling its in-progress ingestion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// quickSightCancelRunningIngestions cancels every RUNNING or INITIALIZED
+// ingestion against a data set, clearing the way for DeleteDataSet. This
+// discards whatever progress the ingestion had made; callers must only
+// invoke it when force_destroy is set.
+func quickSightCancelRunningIngestions(conn quicksightconniface, awsAccountID, dataSetID string) error {
+	var ingestionIDs []string
+
+	listErr := conn.ListIngestionsPages(&quicksight.ListIngestionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSetId:    aws.String(dataSetID),
+	}, func(page *quicksight.ListIngestionsOutput, lastPage bool) bool {
+		for _, ingestion := range page.Ingestions {
+			switch aws.StringValue(ingestion.IngestionStatus) {
+			case quicksight.IngestionStatusRunning, quicksight.IngestionStatusInitialized, quicksight.IngestionStatusQueued:
+				ingestionIDs = append(ingestionIDs, aws.StringValue(ingestion.IngestionId))
+			}
+		}
+		return !lastPage
+	})
+	if listErr != nil {
+		return listErr
+	}
+
+	for _, ingestionID := range ingestionIDs {
+		if _, err := conn.CancelIngestion(&quicksight.CancelIngestionInput{
+			AwsAccountId: aws.String(awsAccountID),
+			DataSetId:    aws.String(dataSetID),
+			IngestionId:  aws.String(ingestionID),
+		}); err != nil && !isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+			return fmt.Errorf("error cancelling Quick Sight ingestion (%s): %s", ingestionID, err)
+		}
+	}
+
+	return nil
+}
+
+// resourceAwsQuickSightDataSetCustomizeDiff guards against a SPICE data set
+// landing on an account that can't actually hold it. Quick Sight doesn't
+// expose purchased/consumed SPICE capacity through the API, so the closest
+// plan-time signal available is whether the account has an active Quick
+// Sight subscription at all; an account that was never signed up for Quick
+// Sight has no SPICE capacity to import into regardless of edition.
+func resourceAwsQuickSightDataSetCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	dataSourceARNs := map[string]bool{}
+	for _, v := range d.Get("physical_table_map").(*schema.Set).List() {
+		m := v.(map[string]interface{})
+		relTable := m["relational_table"].([]interface{})
+		if len(relTable) == 0 || relTable[0] == nil {
+			continue
+		}
+		dataSourceARNs[relTable[0].(map[string]interface{})["data_source_arn"].(string)] = true
+	}
+
+	// A data set's physical tables can come from many data sources in
+	// principle, but this provider only models the single-source case: it
+	// keeps the plan-time SPICE/import_mode reasoning simple and matches how
+	// every data set we actually manage today is built. Cross-source data
+	// sets should compose multiple single-source data sets with a join
+	// instead.
+	if len(dataSourceARNs) > 1 {
+		return fmt.Errorf("physical_table_map may only reference a single data_source_arn, found %d", len(dataSourceARNs))
+	}
+
+	for _, v := range d.Get("logical_table_map").(*schema.Set).List() {
+		m := v.(map[string]interface{})
+		source := m["source"].([]interface{})
+		if len(source) == 0 || source[0] == nil {
+			continue
+		}
+		s := source[0].(map[string]interface{})
+
+		_, hasPhysical := s["physical_table_id"].(string)
+		hasPhysical = hasPhysical && s["physical_table_id"].(string) != ""
+		hasJoin := len(s["join_instruction"].([]interface{})) > 0
+
+		if hasPhysical == hasJoin {
+			return fmt.Errorf("logical_table_map (%s) source must set exactly one of physical_table_id or join_instruction", m["logical_table_map_id"])
+		}
+	}
+
+	for _, v := range d.Get("refresh_schedule").([]interface{}) {
+		schedule := v.(map[string]interface{})
+		freqList := schedule["schedule_frequency"].([]interface{})
+		if len(freqList) == 0 || freqList[0] == nil {
+			continue
+		}
+		freq := freqList[0].(map[string]interface{})
+
+		interval := freq["interval"].(string)
+		hasRefreshOnDay := len(freq["refresh_on_day"].([]interface{})) > 0
+
+		requiresRefreshOnDay := interval == quicksight.RefreshIntervalWeekly || interval == quicksight.RefreshIntervalMonthly
+		if requiresRefreshOnDay && !hasRefreshOnDay {
+			return fmt.Errorf("refresh_schedule (%s) requires refresh_on_day when interval is %s", schedule["schedule_id"], interval)
+		}
+		if !requiresRefreshOnDay && hasRefreshOnDay {
+			return fmt.Errorf("refresh_schedule (%s) must not set refresh_on_day when interval is %s", schedule["schedule_id"], interval)
+		}
+	}
+
+	if d.HasChange("import_mode") && d.Get("import_mode").(string) == quicksight.DataSetImportModeSpice {
+		log.Printf("[WARN] Quick Sight Data Set (%s) is switching import_mode to SPICE, which imports the full data set into SPICE capacity on the next refresh; ensure the account has enough purchased capacity before applying", d.Id())
+	}
+
+	if d.Get("import_mode").(string) != quicksight.DataSetImportModeSpice {
+		return nil
+	}
+
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	resp, err := conn.DescribeAccountSubscription(&quicksight.DescribeAccountSubscriptionInput{
+		AwsAccountId: aws.String(awsAccountID),
+	})
+	if err != nil {
+		return fmt.Errorf("error describing Quick Sight account subscription (%s): %s", awsAccountID, err)
+	}
+
+	if status := aws.StringValue(resp.AccountInfo.AccountSubscriptionStatus); status != "ACCOUNT_CREATED" {
+		return fmt.Errorf("aws_account_id (%s) has no active Quick Sight subscription (status: %s), so it has no SPICE capacity to import this data set into", awsAccountID, status)
+	}
+
+	return nil
+}
+
+// quickSightDataSetReferencedDataSourceArns returns the unique set of
+// data_source_arn values referenced by a physical_table_map, in the order
+// first seen.
+func quickSightDataSetReferencedDataSourceArns(set *schema.Set) []string {
+	seen := map[string]bool{}
+	var arns []string
+
+	for _, v := range set.List() {
+		m := v.(map[string]interface{})
+		relTable := m["relational_table"].([]interface{})
+		if len(relTable) == 0 || relTable[0] == nil {
+			continue
+		}
+
+		dataSourceArn := relTable[0].(map[string]interface{})["data_source_arn"].(string)
+		if dataSourceArn == "" || seen[dataSourceArn] {
+			continue
+		}
+		seen[dataSourceArn] = true
+		arns = append(arns, dataSourceArn)
+	}
+
+	return arns
+}
+
+func expandQuickSightDataSetPhysicalTableMap(set *schema.Set) map[string]*quicksight.PhysicalTable {
+	tableMap := make(map[string]*quicksight.PhysicalTable, set.Len())
+
+	for _, v := range set.List() {
+		m := v.(map[string]interface{})
+		id := m["physical_table_map_id"].(string)
+
+		rt := m["relational_table"].([]interface{})
+		if len(rt) == 0 || rt[0] == nil {
+			continue
+		}
+		relTable := rt[0].(map[string]interface{})
+
+		table := &quicksight.RelationalTable{
+			DataSourceArn: aws.String(relTable["data_source_arn"].(string)),
+			Name:          aws.String(relTable["name"].(string)),
+		}
+
+		if v, ok := relTable["catalog"].(string); ok && v != "" {
+			table.Catalog = aws.String(v)
+		}
+		if v, ok := relTable["schema"].(string); ok && v != "" {
+			table.Schema = aws.String(v)
+		}
+
+		tableMap[id] = &quicksight.PhysicalTable{
+			RelationalTable: table,
+		}
+	}
+
+	return tableMap
+}
+
+func flattenQuickSightDataSetPhysicalTableMap(tableMap map[string]*quicksight.PhysicalTable) []interface{} {
+	results := make([]interface{}, 0, len(tableMap))
+
+	for id, table := range tableMap {
+		if table.RelationalTable == nil {
+			continue
+		}
+
+		results = append(results, map[string]interface{}{
+			"physical_table_map_id": id,
+			"relational_table": []interface{}{
+				map[string]interface{}{
+					"data_source_arn": aws.StringValue(table.RelationalTable.DataSourceArn),
+					"name":            aws.StringValue(table.RelationalTable.Name),
+					"catalog":         aws.StringValue(table.RelationalTable.Catalog),
+					"schema":          aws.StringValue(table.RelationalTable.Schema),
+				},
+			},
+		})
+	}
+
+	return results
+}
+
+func expandQuickSightDataSetLogicalTableMap(set *schema.Set) map[string]*quicksight.LogicalTable {
+	tableMap := make(map[string]*quicksight.LogicalTable, set.Len())
+
+	for _, v := range set.List() {
+		m := v.(map[string]interface{})
+		id := m["logical_table_map_id"].(string)
+
+		sourceList := m["source"].([]interface{})
+		if len(sourceList) == 0 || sourceList[0] == nil {
+			continue
+		}
+		s := sourceList[0].(map[string]interface{})
+
+		source := &quicksight.LogicalTableSource{}
+		if physicalTableID, ok := s["physical_table_id"].(string); ok && physicalTableID != "" {
+			source.PhysicalTableId = aws.String(physicalTableID)
+		}
+
+		if joinList, ok := s["join_instruction"].([]interface{}); ok && len(joinList) > 0 && joinList[0] != nil {
+			j := joinList[0].(map[string]interface{})
+			source.JoinInstruction = &quicksight.JoinInstruction{
+				LeftOperand:  aws.String(j["left_operand"].(string)),
+				RightOperand: aws.String(j["right_operand"].(string)),
+				Type:         aws.String(j["type"].(string)),
+				OnClause:     aws.String(j["on_clause"].(string)),
+			}
+		}
+
+		tableMap[id] = &quicksight.LogicalTable{
+			Alias:          aws.String(m["alias"].(string)),
+			Source:         source,
+			DataTransforms: expandQuickSightDataSetDataTransforms(m["data_transforms"].([]interface{})),
+		}
+	}
+
+	return tableMap
+}
+
+func expandQuickSightDataSetDataTransforms(transforms []interface{}) []*quicksight.TransformOperation {
+	operations := make([]*quicksight.TransformOperation, 0, len(transforms))
+
+	for _, v := range transforms {
+		m := v.(map[string]interface{})
+		operation := &quicksight.TransformOperation{}
+
+		if cast, ok := m["cast_column_type_operation"].([]interface{}); ok && len(cast) > 0 && cast[0] != nil {
+			c := cast[0].(map[string]interface{})
+			castOp := &quicksight.CastColumnTypeOperation{
+				ColumnName:    aws.String(c["column_name"].(string)),
+				NewColumnType: aws.String(c["new_column_type"].(string)),
+			}
+			if f, ok := c["format"].(string); ok && f != "" {
+				castOp.Format = aws.String(f)
+			}
+			operation.CastColumnTypeOperation = castOp
+		}
+
+		if tag, ok := m["tag_column_operation"].([]interface{}); ok && len(tag) > 0 && tag[0] != nil {
+			t := tag[0].(map[string]interface{})
+			tagOp := &quicksight.TagColumnOperation{
+				ColumnName: aws.String(t["column_name"].(string)),
+			}
+
+			for _, tagV := range t["tags"].([]interface{}) {
+				tm := tagV.(map[string]interface{})
+				columnTag := &quicksight.ColumnTag{}
+				if v, ok := tm["column_geographic_role"].(string); ok && v != "" {
+					columnTag.ColumnGeographicRole = aws.String(v)
+				}
+				if v, ok := tm["column_description"].(string); ok && v != "" {
+					columnTag.ColumnDescription = &quicksight.ColumnDescription{Text: aws.String(v)}
+				}
+				tagOp.Tags = append(tagOp.Tags, columnTag)
+			}
+
+			operation.TagColumnOperation = tagOp
+		}
+
+		if create, ok := m["create_columns_operation"].([]interface{}); ok && len(create) > 0 && create[0] != nil {
+			c := create[0].(map[string]interface{})
+			createOp := &quicksight.CreateColumnsOperation{}
+
+			for _, colV := range c["columns"].([]interface{}) {
+				cm := colV.(map[string]interface{})
+				createOp.Columns = append(createOp.Columns, &quicksight.CalculatedColumn{
+					ColumnId:   aws.String(cm["column_id"].(string)),
+					ColumnName: aws.String(cm["column_name"].(string)),
+					Expression: aws.String(cm["expression"].(string)),
+				})
+			}
+
+			operation.CreateColumnsOperation = createOp
+		}
+
+		if filter, ok := m["filter_operation"].([]interface{}); ok && len(filter) > 0 && filter[0] != nil {
+			f := filter[0].(map[string]interface{})
+			operation.FilterOperation = &quicksight.FilterOperation{
+				ConditionExpression: aws.String(f["condition_expression"].(string)),
+			}
+		}
+
+		if project, ok := m["project_operation"].([]interface{}); ok && len(project) > 0 && project[0] != nil {
+			p := project[0].(map[string]interface{})
+			operation.ProjectOperation = &quicksight.ProjectOperation{
+				ProjectedColumns: expandStringList(p["projected_columns"].([]interface{})),
+			}
+		}
+
+		operations = append(operations, operation)
+	}
+
+	return operations
+}
+
+func flattenQuickSightDataSetLogicalTableMap(tableMap map[string]*quicksight.LogicalTable) []interface{} {
+	results := make([]interface{}, 0, len(tableMap))
+
+	for id, table := range tableMap {
+		source := map[string]interface{}{}
+
+		if table.Source != nil {
+			source["physical_table_id"] = aws.StringValue(table.Source.PhysicalTableId)
+
+			if table.Source.JoinInstruction != nil {
+				source["join_instruction"] = []interface{}{
+					map[string]interface{}{
+						"left_operand":  aws.StringValue(table.Source.JoinInstruction.LeftOperand),
+						"right_operand": aws.StringValue(table.Source.JoinInstruction.RightOperand),
+						"type":          aws.StringValue(table.Source.JoinInstruction.Type),
+						"on_clause":     aws.StringValue(table.Source.JoinInstruction.OnClause),
+					},
+				}
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"logical_table_map_id": id,
+			"alias":                aws.StringValue(table.Alias),
+			"source":               []interface{}{source},
+			"data_transforms":      flattenQuickSightDataSetDataTransforms(table.DataTransforms),
+		})
+	}
+
+	return results
+}
+
+func flattenQuickSightDataSetDataTransforms(operations []*quicksight.TransformOperation) []interface{} {
+	results := make([]interface{}, 0, len(operations))
+
+	for _, operation := range operations {
+		m := map[string]interface{}{}
+
+		if operation.CastColumnTypeOperation != nil {
+			m["cast_column_type_operation"] = []interface{}{
+				map[string]interface{}{
+					"column_name":     aws.StringValue(operation.CastColumnTypeOperation.ColumnName),
+					"new_column_type": aws.StringValue(operation.CastColumnTypeOperation.NewColumnType),
+					"format":          aws.StringValue(operation.CastColumnTypeOperation.Format),
+				},
+			}
+		}
+
+		if operation.TagColumnOperation != nil {
+			tags := make([]interface{}, 0, len(operation.TagColumnOperation.Tags))
+			for _, tag := range operation.TagColumnOperation.Tags {
+				tagM := map[string]interface{}{
+					"column_geographic_role": aws.StringValue(tag.ColumnGeographicRole),
+				}
+				if tag.ColumnDescription != nil {
+					tagM["column_description"] = aws.StringValue(tag.ColumnDescription.Text)
+				}
+				tags = append(tags, tagM)
+			}
+
+			m["tag_column_operation"] = []interface{}{
+				map[string]interface{}{
+					"column_name": aws.StringValue(operation.TagColumnOperation.ColumnName),
+					"tags":        tags,
+				},
+			}
+		}
+
+		if operation.CreateColumnsOperation != nil {
+			columns := make([]interface{}, 0, len(operation.CreateColumnsOperation.Columns))
+			for _, col := range operation.CreateColumnsOperation.Columns {
+				columns = append(columns, map[string]interface{}{
+					"column_id":   aws.StringValue(col.ColumnId),
+					"column_name": aws.StringValue(col.ColumnName),
+					"expression":  aws.StringValue(col.Expression),
+				})
+			}
+
+			m["create_columns_operation"] = []interface{}{
+				map[string]interface{}{
+					"columns": columns,
+				},
+			}
+		}
+
+		if operation.FilterOperation != nil {
+			m["filter_operation"] = []interface{}{
+				map[string]interface{}{
+					"condition_expression": aws.StringValue(operation.FilterOperation.ConditionExpression),
+				},
+			}
+		}
+
+		if operation.ProjectOperation != nil {
+			m["project_operation"] = []interface{}{
+				map[string]interface{}{
+					"projected_columns": flattenStringList(operation.ProjectOperation.ProjectedColumns),
+				},
+			}
+		}
+
+		results = append(results, m)
+	}
+
+	return results
+}
+
+func flattenQuickSightDataSetOutputColumns(columns []*quicksight.OutputColumn) []interface{} {
+	results := make([]interface{}, 0, len(columns))
+
+	for _, column := range columns {
+		results = append(results, map[string]interface{}{
+			"name":        aws.StringValue(column.Name),
+			"description": aws.StringValue(column.Description),
+			"type":        aws.StringValue(column.Type),
+		})
+	}
+
+	return results
+}
+
+func resourceAwsQuickSightDataSetParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/DATA_SET_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// resourceAwsQuickSightDataSetRefreshSchedulesSync reconciles the
+// refresh_schedule blocks in config against Quick Sight's own
+// Create/Update/DeleteRefreshSchedule API, keyed by schedule_id.
+func resourceAwsQuickSightDataSetRefreshSchedulesSync(d *schema.ResourceData, conn quicksightconniface, awsAccountID, dataSetID string, old, new []interface{}) error {
+	oldByID := map[string]map[string]interface{}{}
+	for _, v := range old {
+		m := v.(map[string]interface{})
+		oldByID[m["schedule_id"].(string)] = m
+	}
+
+	newByID := map[string]map[string]interface{}{}
+	for _, v := range new {
+		m := v.(map[string]interface{})
+		newByID[m["schedule_id"].(string)] = m
+	}
+
+	for id, m := range newByID {
+		schedule := expandQuickSightRefreshSchedule(m)
+		if _, ok := oldByID[id]; ok {
+			_, err := conn.UpdateRefreshSchedule(&quicksight.UpdateRefreshScheduleInput{
+				AwsAccountId: aws.String(awsAccountID),
+				DataSetId:    aws.String(dataSetID),
+				Schedule:     schedule,
+			})
+			if err != nil {
+				return fmt.Errorf("error updating Quick Sight refresh schedule (%s): %s", id, err)
+			}
+		} else {
+			_, err := conn.CreateRefreshSchedule(&quicksight.CreateRefreshScheduleInput{
+				AwsAccountId: aws.String(awsAccountID),
+				DataSetId:    aws.String(dataSetID),
+				Schedule:     schedule,
+			})
+			if err != nil {
+				return fmt.Errorf("error creating Quick Sight refresh schedule (%s): %s", id, err)
+			}
+		}
+	}
+
+	for id := range oldByID {
+		if _, ok := newByID[id]; ok {
+			continue
+		}
+		_, err := conn.DeleteRefreshSchedule(&quicksight.DeleteRefreshScheduleInput{
+			AwsAccountId: aws.String(awsAccountID),
+			DataSetId:    aws.String(dataSetID),
+			ScheduleId:   aws.String(id),
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting Quick Sight refresh schedule (%s): %s", id, err)
+		}
+	}
+
+	return nil
+}
+
+// validateQuickSightRefreshScheduleTimezone rejects anything that isn't a
+// loadable IANA time zone name (e.g. "America/New_York") at plan time,
+// instead of surfacing Quick Sight's opaque rejection of an invalid
+// timezone on apply.
+func validateQuickSightRefreshScheduleTimezone(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if _, err := time.LoadLocation(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid IANA time zone name: %s", k, err))
+	}
+
+	return ws, errors
+}
+
+func expandQuickSightRefreshSchedule(m map[string]interface{}) *quicksight.RefreshSchedule {
+	schedule := &quicksight.RefreshSchedule{
+		ScheduleId:  aws.String(m["schedule_id"].(string)),
+		RefreshType: aws.String(m["refresh_type"].(string)),
+	}
+
+	if v, ok := m["schedule_frequency"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		freq := v[0].(map[string]interface{})
+		scheduleFrequency := &quicksight.RefreshFrequency{
+			Interval: aws.String(freq["interval"].(string)),
+		}
+
+		if tt, ok := freq["time_of_the_day"].(string); ok && tt != "" {
+			scheduleFrequency.TimeOfTheDay = aws.String(tt)
+		}
+		if tz, ok := freq["timezone"].(string); ok && tz != "" {
+			scheduleFrequency.Timezone = aws.String(tz)
+		}
+
+		if v, ok := freq["refresh_on_day"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			refreshOnDay := v[0].(map[string]interface{})
+			onDay := &quicksight.ScheduleRefreshOnEntity{}
+
+			if dow, ok := refreshOnDay["day_of_week"].(string); ok && dow != "" {
+				onDay.DayOfWeek = aws.String(dow)
+			}
+			if dom, ok := refreshOnDay["day_of_month"].(string); ok && dom != "" {
+				onDay.DayOfMonth = aws.String(dom)
+			}
+
+			scheduleFrequency.RefreshOnDay = onDay
+		}
+
+		schedule.ScheduleFrequency = scheduleFrequency
+	}
+
+	return schedule
+}
+
+func flattenQuickSightRefreshSchedules(schedules []*quicksight.RefreshSchedule) []interface{} {
+	results := make([]interface{}, 0, len(schedules))
+
+	for _, schedule := range schedules {
+		m := map[string]interface{}{
+			"schedule_id":  aws.StringValue(schedule.ScheduleId),
+			"arn":          aws.StringValue(schedule.Arn),
+			"refresh_type": aws.StringValue(schedule.RefreshType),
+		}
+
+		if schedule.ScheduleFrequency != nil {
+			freq := map[string]interface{}{
+				"interval":        aws.StringValue(schedule.ScheduleFrequency.Interval),
+				"time_of_the_day": aws.StringValue(schedule.ScheduleFrequency.TimeOfTheDay),
+				"timezone":        aws.StringValue(schedule.ScheduleFrequency.Timezone),
+			}
+
+			if onDay := schedule.ScheduleFrequency.RefreshOnDay; onDay != nil {
+				freq["refresh_on_day"] = []interface{}{
+					map[string]interface{}{
+						"day_of_week":  aws.StringValue(onDay.DayOfWeek),
+						"day_of_month": aws.StringValue(onDay.DayOfMonth),
+					},
+				}
+			}
+
+			m["schedule_frequency"] = []interface{}{freq}
+		}
+
+		results = append(results, m)
+	}
+
+	return results
+}
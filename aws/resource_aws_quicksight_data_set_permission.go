@@ -0,0 +1,196 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func resourceAwsQuickSightDataSetPermission() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQuickSightDataSetPermissionUpsert,
+		Read:   resourceAwsQuickSightDataSetPermissionRead,
+		Update: resourceAwsQuickSightDataSetPermissionUpsert,
+		Delete: resourceAwsQuickSightDataSetPermissionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsQuickSightDataSetPermissionCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"data_set_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"principal": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"actions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(quicksightDataSetActions, false),
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsQuickSightDataSetPermissionCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	dataSetID := d.Get("data_set_id").(string)
+	principal := d.Get("principal").(string)
+
+	resp, err := conn.DescribeDataSetPermissions(&quicksight.DescribeDataSetPermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSetId:    aws.String(dataSetID),
+	})
+	if err != nil && !isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		return fmt.Errorf("error describing Quick Sight Data Set (%s) permissions: %s", dataSetID, err)
+	}
+
+	for _, perm := range resp.Permissions {
+		if aws.StringValue(perm.Principal) == principal {
+			return fmt.Errorf("principal (%s) already has permissions on Quick Sight Data Set (%s); import the existing aws_quicksight_data_set_permission resource instead", principal, dataSetID)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightDataSetPermissionUpsert(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	dataSetID := d.Get("data_set_id").(string)
+	principal := d.Get("principal").(string)
+
+	grant := &quicksight.ResourcePermission{
+		Principal: aws.String(principal),
+		Actions:   expandStringSet(d.Get("actions").(*schema.Set)),
+	}
+
+	if _, err := conn.UpdateDataSetPermissions(&quicksight.UpdateDataSetPermissionsInput{
+		AwsAccountId:     aws.String(awsAccountID),
+		DataSetId:        aws.String(dataSetID),
+		GrantPermissions: []*quicksight.ResourcePermission{grant},
+	}); err != nil {
+		return fmt.Errorf("error updating Quick Sight Data Set (%s) permissions: %s", dataSetID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", awsAccountID, dataSetID, principal))
+
+	return resourceAwsQuickSightDataSetPermissionRead(d, meta)
+}
+
+func resourceAwsQuickSightDataSetPermissionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dataSetID, principal, err := resourceAwsQuickSightDataSetPermissionParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeDataSetPermissions(&quicksight.DescribeDataSetPermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSetId:    aws.String(dataSetID),
+	})
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Quick Sight Data Set (%s) not found, removing permission from state", dataSetID)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error describing Quick Sight Data Set (%s) permissions: %s", dataSetID, err)
+	}
+
+	for _, perm := range resp.Permissions {
+		if aws.StringValue(perm.Principal) != principal {
+			continue
+		}
+
+		d.Set("aws_account_id", awsAccountID)
+		d.Set("data_set_id", dataSetID)
+		d.Set("principal", principal)
+		if err := d.Set("actions", flattenStringSet(perm.Actions)); err != nil {
+			return fmt.Errorf("error setting actions: %s", err)
+		}
+
+		return nil
+	}
+
+	log.Printf("[WARN] Quick Sight Data Set (%s) permission for principal (%s) not found, removing from state", dataSetID, principal)
+	d.SetId("")
+	return nil
+}
+
+func resourceAwsQuickSightDataSetPermissionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dataSetID, principal, err := resourceAwsQuickSightDataSetPermissionParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	revoke := &quicksight.ResourcePermission{
+		Principal: aws.String(principal),
+		Actions:   expandStringSet(d.Get("actions").(*schema.Set)),
+	}
+
+	if _, err := conn.UpdateDataSetPermissions(&quicksight.UpdateDataSetPermissionsInput{
+		AwsAccountId:      aws.String(awsAccountID),
+		DataSetId:         aws.String(dataSetID),
+		RevokePermissions: []*quicksight.ResourcePermission{revoke},
+	}); err != nil {
+		if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error revoking Quick Sight Data Set (%s) permissions: %s", dataSetID, err)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightDataSetPermissionParseID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/DATA_SET_ID/PRINCIPAL", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
@@ -0,0 +1,197 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func TestAccAWSQuickSightDataSetPermission_basic(t *testing.T) {
+	resourceName := "aws_quicksight_data_set_permission.example"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckQuickSightDataSetPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSetPermissionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSetPermissionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "actions.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestAccAWSQuickSightDataSetPermission_driftDetection confirms that
+// granting a principal a subset of actions out-of-band (mimicking manual
+// console/API changes) is detected and corrected on the next apply: Read
+// always overwrites "actions" with whatever UpdateDataSetPermissions last
+// actually granted, so Terraform's normal state-vs-config diff catches the
+// partial change and the following apply calls UpdateDataSetPermissions
+// again to reconcile it.
+func TestAccAWSQuickSightDataSetPermission_driftDetection(t *testing.T) {
+	resourceName := "aws_quicksight_data_set_permission.example"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckQuickSightDataSetPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSetPermissionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSetPermissionExists(resourceName),
+					testAccCheckQuickSightDataSetPermissionDrift(resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAWSQuickSightDataSetPermissionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSetPermissionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "actions.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckQuickSightDataSetPermissionDrift revokes two of the three
+// actions the config grants, out-of-band, simulating drift caused by a
+// change made outside Terraform.
+func testAccCheckQuickSightDataSetPermissionDrift(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		awsAccountID, dataSetID, principal, err := resourceAwsQuickSightDataSetPermissionParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		_, err = conn.UpdateDataSetPermissions(&quicksight.UpdateDataSetPermissionsInput{
+			AwsAccountId: aws.String(awsAccountID),
+			DataSetId:    aws.String(dataSetID),
+			RevokePermissions: []*quicksight.ResourcePermission{
+				{
+					Principal: aws.String(principal),
+					Actions: aws.StringSlice([]string{
+						"quicksight:DescribeDataSetPermissions",
+						"quicksight:PassDataSet",
+					}),
+				},
+			},
+		})
+		return err
+	}
+}
+
+func testAccCheckQuickSightDataSetPermissionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		awsAccountID, dataSetID, principal, err := resourceAwsQuickSightDataSetPermissionParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		resp, err := conn.DescribeDataSetPermissions(&quicksight.DescribeDataSetPermissionsInput{
+			AwsAccountId: aws.String(awsAccountID),
+			DataSetId:    aws.String(dataSetID),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, perm := range resp.Permissions {
+			if aws.StringValue(perm.Principal) == principal {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Quick Sight Data Set (%s) permission for principal (%s) not found", dataSetID, principal)
+	}
+}
+
+func testAccCheckQuickSightDataSetPermissionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_data_set_permission" {
+			continue
+		}
+
+		awsAccountID, dataSetID, principal, err := resourceAwsQuickSightDataSetPermissionParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.DescribeDataSetPermissions(&quicksight.DescribeDataSetPermissionsInput{
+			AwsAccountId: aws.String(awsAccountID),
+			DataSetId:    aws.String(dataSetID),
+		})
+		if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, perm := range resp.Permissions {
+			if aws.StringValue(perm.Principal) == principal {
+				return fmt.Errorf("Quick Sight Data Set (%s) permission for principal (%s) was not deleted properly", dataSetID, principal)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSQuickSightDataSetPermissionConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_group" "example" {
+  group_name = %[1]q
+}
+
+resource "aws_quicksight_data_set" "example" {
+  data_set_id = %[1]q
+  name        = %[1]q
+  import_mode = "SPICE"
+}
+
+resource "aws_quicksight_data_set_permission" "example" {
+  data_set_id = aws_quicksight_data_set.example.data_set_id
+  principal   = aws_quicksight_group.example.arn
+
+  actions = [
+    "quicksight:DescribeDataSet",
+    "quicksight:DescribeDataSetPermissions",
+    "quicksight:PassDataSet",
+  ]
+}
+`, rName)
+}
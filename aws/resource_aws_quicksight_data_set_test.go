@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func TestAccAWSQuickSightDataSet_importMode(t *testing.T) {
+	resourceName := "aws_quicksight_data_set.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckQuickSightDataSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSetImportModeConfig(rName, quicksight.DataSetImportModeSpice),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "import_mode", quicksight.DataSetImportModeSpice),
+				),
+			},
+			{
+				Config: testAccAWSQuickSightDataSetImportModeConfig(rName, quicksight.DataSetImportModeDirectQuery),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "import_mode", quicksight.DataSetImportModeDirectQuery),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckQuickSightDataSetExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		awsAccountID, dataSetID, err := resourceAwsQuickSightDataSetParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		_, err = conn.DescribeDataSet(&quicksight.DescribeDataSetInput{
+			AwsAccountId: aws.String(awsAccountID),
+			DataSetId:    aws.String(dataSetID),
+		})
+		return err
+	}
+}
+
+func testAccCheckQuickSightDataSetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_data_set" {
+			continue
+		}
+
+		awsAccountID, dataSetID, err := resourceAwsQuickSightDataSetParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DescribeDataSet(&quicksight.DescribeDataSetInput{
+			AwsAccountId: aws.String(awsAccountID),
+			DataSetId:    aws.String(dataSetID),
+		})
+		if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Quick Sight Data Set (%s) was not deleted properly", dataSetID)
+	}
+
+	return nil
+}
+
+func testAccAWSQuickSightDataSetImportModeConfig(rName, importMode string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_set" "test" {
+  data_set_id = %[1]q
+  name        = %[1]q
+  import_mode = %[2]q
+}
+`, rName, importMode)
+}
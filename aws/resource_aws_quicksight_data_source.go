@@ -0,0 +1,1670 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func resourceAwsQuickSightDataSource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQuickSightDataSourceCreate,
+		Read:   resourceAwsQuickSightDataSourceRead,
+		Update: resourceAwsQuickSightDataSourceUpdate,
+		Delete: resourceAwsQuickSightDataSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: resourceAwsQuickSightDataSourceCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"data_source_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// The resource's import ID and composite "arn"/lookup keys are all
+			// AWS_ACCOUNT_ID/DATA_SOURCE_ID. Surfacing the normalized id that
+			// was actually persisted to the API lets configs assert on it
+			// without re-deriving the split themselves.
+			"data_source_id_normalized": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// Lets other resources that reference this data source by ARN
+			// (e.g. aws_quicksight_data_set's wait_for_data_source_ready)
+			// make sense of a CREATION_FAILED/UPDATE_FAILED state without a
+			// second DescribeDataSource call of their own.
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// Populated only when the last known status was CREATION_FAILED
+			// or UPDATE_FAILED, so the reason is visible in the plan instead
+			// of just the opaque status string. See
+			// resourceAwsQuickSightDataSourceCustomizeDiff's CREATION_FAILED
+			// handling.
+			"error_info": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Not restricted to the connector types this provider models a
+			// "parameters" block for: Quick Sight adds connector types
+			// faster than the provider can add first-class support for
+			// them, and an operator who knows the raw API shape for a new
+			// connector shouldn't be blocked from setting it here.
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"parameters": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"parameters_json"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"athena": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"work_group": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"role_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+						},
+
+						"presto": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host": {
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: quicksightSuppressDataSourceHostDiff,
+									},
+									"port": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									// Left Optional: some Presto deployments have no
+									// catalog concept, or resolve one implicitly.
+									// Omitted here, it's omitted from the request too
+									// rather than sent as an empty string.
+									"catalog": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										DiffSuppressFunc: quicksightSuppressDataSourceCatalogDiff,
+									},
+								},
+							},
+						},
+
+						"spark": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host": {
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: quicksightSuppressDataSourceHostDiff,
+									},
+									"port": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+
+						"twitter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"query": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateQuickSightDataSourceTwitterQuery,
+									},
+									"max_rows": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 10000),
+									},
+								},
+							},
+						},
+
+						"oracle": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host": {
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: quicksightSuppressDataSourceHostDiff,
+									},
+									"port": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"database": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+
+						"trino": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host": {
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: quicksightSuppressDataSourceHostDiff,
+									},
+									"port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 65535),
+									},
+									// Left Optional: Trino can resolve the catalog
+									// implicitly. Omitted here, it's omitted from the
+									// request too rather than sent as an empty string.
+									"catalog": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										DiffSuppressFunc: quicksightSuppressDataSourceCatalogDiff,
+									},
+								},
+							},
+						},
+
+						"starburst": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host": {
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: quicksightSuppressDataSourceHostDiff,
+									},
+									"port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 65535),
+									},
+									"catalog": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"product_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											quicksight.StarburstProductTypeGalaxy,
+											quicksight.StarburstProductTypeEnterprise,
+										}, false),
+									},
+								},
+							},
+						},
+
+						"databricks": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host": {
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: quicksightSuppressDataSourceHostDiff,
+									},
+									"port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 65535),
+									},
+									"sql_endpoint_path": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+
+						// Deprecated: AWS renamed Elasticsearch Service to OpenSearch,
+						// and the SDK no longer adds fields to
+						// AmazonElasticsearchParameters. Use "amazon_opensearch" instead.
+						"amazon_elasticsearch": {
+							Type:       schema.TypeList,
+							Optional:   true,
+							MaxItems:   1,
+							Deprecated: "use amazon_opensearch instead",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"domain": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+
+						"amazon_opensearch": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"domain": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+
+						"s3": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"manifest_file_location": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validateQuickSightDataSourceS3ManifestNoUri,
+												},
+												"key": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validateQuickSightDataSourceS3ManifestNoUri,
+												},
+											},
+										},
+									},
+
+									// Lets Quick Sight read the manifest (and the
+									// data it points to) under an assumed role
+									// instead of this account's Quick Sight
+									// service role.
+									"role_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+						},
+
+						"redshift": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										DiffSuppressFunc: quicksightSuppressDataSourceHostDiff,
+									},
+									"port": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"database": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									// Only needed for a provisioned, non-Serverless cluster.
+									// IAM-authenticated Redshift Serverless connects on
+									// "host"/"port" alone.
+									"cluster_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									// Authenticates with a Redshift IAM role instead of
+									// "credentials", which is required for Redshift
+									// Serverless and optional otherwise.
+									"iam_parameters": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"role_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validateArn,
+												},
+												"database_user": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"database_groups": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"auto_create_database_user": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			// Quick Sight rejects a scheme or trailing slash on host-based
+			// connectors' "host" fields outright, but users commonly copy
+			// one in from a browser URL or another tool's connection
+			// string. Normalizing is friendlier, but some setups rely on
+			// byte-for-byte host strings (e.g. a provisioner that diffs
+			// raw state), so this can be turned off.
+			"strict_host_validation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// Escape hatch for connector types "parameters" doesn't model a
+			// typed block for yet: a JSON-encoded DataSourceParameters
+			// that's unmarshaled directly into the SDK struct. Quick Sight
+			// adds connectors faster than the provider can add first-class
+			// support for them, so this unblocks new ones immediately.
+			// Like "credentials", Read never writes this back (there's no
+			// single canonical JSON encoding of what the API returns), so
+			// re-applying config is what keeps it current.
+			"parameters_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"parameters"},
+				ValidateFunc:     validateQuickSightDataSourceParametersJson,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+
+			// Quick Sight never returns credentials from Describe/ListDataSources,
+			// so Read intentionally does not touch this field: re-applying what's
+			// already in config is the only way to keep it current, and importing
+			// a data source that uses secret_arn simply leaves credentials unset
+			// in the imported state rather than producing a spurious diff.
+			"credentials": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"credential_pair": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"username": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"password": {
+										Type:      schema.TypeString,
+										Required:  true,
+										Sensitive: true,
+									},
+								},
+							},
+						},
+
+						"secret_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+
+						// Reuses another data source's stored credentials instead of
+						// supplying new ones. Mutually exclusive with
+						// "credential_pair"; resourceAwsQuickSightDataSourceCredentials
+						// errors if both are set.
+						"copy_source_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+
+			// Modeled as a list block, not a single disable_ssl bool, so that
+			// future SslProperties fields QuickSight adds land here without
+			// another schema-shape change.
+			"ssl_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disable_ssl": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"vpc_connection_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// TypeString, not TypeBool: this holds an ARN, and
+						// validateQuickSightVpcConnectionArn only makes sense
+						// against a string value.
+						"vpc_connection_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateQuickSightVpcConnectionArn,
+						},
+					},
+				},
+			},
+
+			// Best-effort: when set, a delete that's blocked because live data
+			// sets still reference this data source deletes those data sets
+			// first instead of failing outright. Discovery of dependents
+			// requires listing and describing every data set in the account,
+			// so this can be slow and, if a dependent is deleted out from
+			// under Terraform between the listing and the delete, may still
+			// need a second apply to finish the job.
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// Quick Sight validates the connection asynchronously after
+			// CreateDataSource returns, landing on CREATION_FAILED with an
+			// error_info on bad credentials/unreachable hosts. Waiting for
+			// that outcome turns a silent bad-credential create into an
+			// actionable apply failure, but isn't always wanted (e.g. a
+			// host that isn't reachable yet from wherever Quick Sight
+			// resolves it until a later step completes), so it's optional.
+			"test_connection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"tags": tagsSchemaQuickSight(),
+		},
+	}
+}
+
+// quickSightDataSourceArn deterministically builds the ARN a data source
+// will have, rather than relying solely on what DescribeDataSource returns.
+// CreateDataSource doesn't echo the ARN back, and a Describe immediately
+// after a failed/partial create can come back empty, so Create sets this
+// computed value itself and Read only reconciles it against the API's copy.
+func quickSightDataSourceArn(meta interface{}, awsAccountID, dataSourceID string) string {
+	return arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "quicksight",
+		Region:    meta.(*AWSClient).region,
+		AccountID: awsAccountID,
+		Resource:  fmt.Sprintf("datasource/%s", dataSourceID),
+	}.String()
+}
+
+// quickSightWaitForDataSourceArnReady polls a data source, identified by
+// ARN, until it leaves CREATION_IN_PROGRESS/UPDATE_IN_PROGRESS. Used by
+// aws_quicksight_data_set's wait_for_data_source_ready to avoid racing a
+// data source created in the same apply.
+func quickSightWaitForDataSourceArnReady(conn quicksightconniface, dataSourceArn string, timeout time.Duration) error {
+	parsed, err := arn.Parse(dataSourceArn)
+	if err != nil {
+		return fmt.Errorf("error parsing data_source_arn (%s): %s", dataSourceArn, err)
+	}
+
+	dataSourceID := strings.TrimPrefix(parsed.Resource, "datasource/")
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			quicksight.ResourceStatusCreationInProgress,
+			quicksight.ResourceStatusUpdateInProgress,
+		},
+		Target: []string{
+			quicksight.ResourceStatusCreationSuccessful,
+			quicksight.ResourceStatusUpdateSuccessful,
+		},
+		Refresh: quickSightDataSourceStatusRefresh(conn, parsed.AccountID, dataSourceID),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+// quicksightDataSourceCredentialFreeTypes lists connector types whose API
+// calls never consult "credentials" at all, so any value set there is
+// silently ignored rather than rejected.
+var quicksightDataSourceCredentialFreeTypes = []string{
+	quicksight.DataSourceTypeAthena,
+	quicksight.DataSourceTypeS3,
+	quicksight.DataSourceTypeTwitter,
+	quicksight.DataSourceTypeAwsIotAnalytics,
+}
+
+// quicksightDataSourceParameterBlocks lists every typed connector block
+// under "parameters". Exactly one may be set at a time: Quick Sight's
+// DataSourceParameters is itself a union, so configuring more than one
+// would silently pick whichever one expandQuickSightDataSourceParameters
+// happens to check first.
+var quicksightDataSourceParameterBlocks = []string{
+	"athena",
+	"presto",
+	"spark",
+	"twitter",
+	"oracle",
+	"databricks",
+	"starburst",
+	"trino",
+	"amazon_elasticsearch",
+	"amazon_opensearch",
+	"s3",
+	"redshift",
+}
+
+// Default ports applied by expandQuickSightDataSourceParameters when a
+// host-based connector's "port" is left unset, so config doesn't need to
+// hardcode a well-known default. A zero value is never sent to the API.
+const (
+	quicksightDataSourceDefaultPortPresto = 8080
+	quicksightDataSourceDefaultPortSpark  = 10000
+	quicksightDataSourceDefaultPortOracle = 1521
+)
+
+// resourceAwsQuickSightDataSourceCustomizeDiff flags a "credentials" block
+// set on a connector type that doesn't use it, and an ssl_properties.disable_ssl
+// combined with a secret_arn or an always-encrypted connector type, either of
+// which is very likely a mistake. terraform-plugin-sdk's CustomizeDiff has no
+// non-blocking warning channel (that's a v2-only concept), so this logs
+// through the same [WARN] channel the rest of the provider uses for
+// non-fatal conditions instead of failing the plan.
+//
+// It also self-heals a data source stuck in CREATION_FAILED: there's no API
+// to retry or repair one, it can only be deleted and re-created. "status" is
+// Computed-only, so by the time CustomizeDiff runs its refreshed value is
+// already the prior state value, and ForceNew alone would find no change on
+// that key to force. SetNewComputed marks it pending a new value first,
+// which gives ForceNew a change to act on. Doing this here, rather than
+// removing the resource from state in Read, keeps error_info in the
+// refreshed state so it's visible in the plan alongside the forced
+// replacement instead of just vanishing.
+func resourceAwsQuickSightDataSourceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.Get("status").(string) == quicksight.ResourceStatusCreationFailed {
+		if err := d.SetNewComputed("status"); err != nil {
+			return err
+		}
+		if err := d.ForceNew("status"); err != nil {
+			return err
+		}
+	}
+
+	_, hasParameters := d.GetOk("parameters")
+	_, hasParametersJson := d.GetOk("parameters_json")
+	if !hasParameters && !hasParametersJson {
+		return fmt.Errorf("exactly one of %q or %q must be set", "parameters", "parameters_json")
+	}
+
+	if hasParameters {
+		var set []string
+		for _, connector := range quicksightDataSourceParameterBlocks {
+			if v, ok := d.GetOk("parameters.0." + connector); ok && len(v.([]interface{})) > 0 {
+				set = append(set, connector)
+			}
+		}
+		if len(set) > 1 {
+			return fmt.Errorf("exactly one connector block may be set under %q, got %d: %s", "parameters", len(set), strings.Join(set, ", "))
+		}
+	}
+
+	dataSourceType := d.Get("type").(string)
+
+	if _, ok := d.GetOk("credentials"); ok {
+		for _, t := range quicksightDataSourceCredentialFreeTypes {
+			if dataSourceType == t {
+				log.Printf("[WARN] Quick Sight Data Source type (%s) does not use credentials; the credentials block has no effect", dataSourceType)
+				break
+			}
+		}
+	}
+
+	if d.Get("ssl_properties.0.disable_ssl").(bool) {
+		if secretArn, ok := d.GetOk("credentials.0.secret_arn"); ok && secretArn.(string) != "" {
+			log.Printf("[WARN] Quick Sight Data Source has ssl_properties.disable_ssl set with a Secrets Manager secret_arn; Quick Sight always encrypts the connection it uses to retrieve the secret, so disable_ssl likely won't have the intended effect")
+		}
+
+		for _, t := range quicksightDataSourceCredentialFreeTypes {
+			if dataSourceType == t {
+				log.Printf("[WARN] Quick Sight Data Source type (%s) is always encrypted by Quick Sight; ssl_properties.disable_ssl has no effect", dataSourceType)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateQuickSightDataSourceParametersJson confirms parameters_json both
+// parses as JSON and unmarshals cleanly into the SDK's DataSourceParameters
+// struct, so a typo surfaces the offending field at plan time instead of at
+// apply.
+func validateQuickSightDataSourceParametersJson(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	var params quicksight.DataSourceParameters
+	if err := json.Unmarshal([]byte(value), &params); err != nil {
+		errors = append(errors, fmt.Errorf("%q is invalid: %s", k, err))
+	}
+
+	return ws, errors
+}
+
+func resourceAwsQuickSightDataSourceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	dataSourceID := d.Get("data_source_id").(string)
+
+	dataSourceParameters, err := quickSightDataSourceParameters(d)
+	if err != nil {
+		return err
+	}
+
+	// CreateDataSourceInput.Permissions is deliberately left unset here:
+	// permissions are managed by the separate aws_quicksight_data_source_permission
+	// resource (see resource_aws_quicksight_data_source_permission.go), the
+	// same split used for aws_quicksight_data_set_permission. There is no
+	// "permission" or "permissions" field in this resource's schema to read.
+	createOpts := &quicksight.CreateDataSourceInput{
+		AwsAccountId:         aws.String(awsAccountID),
+		DataSourceId:         aws.String(dataSourceID),
+		Name:                 aws.String(d.Get("name").(string)),
+		Type:                 aws.String(d.Get("type").(string)),
+		DataSourceParameters: dataSourceParameters,
+	}
+
+	if v := tagsFromMapQuickSight(d.Get("tags").(map[string]interface{})); len(v) > 0 {
+		createOpts.Tags = v
+	}
+
+	if v, ok := d.GetOk("credentials"); ok {
+		credentials, err := expandQuickSightDataSourceCredentials(v.([]interface{}))
+		if err != nil {
+			return err
+		}
+		createOpts.Credentials = credentials
+	}
+
+	if v, ok := d.GetOk("vpc_connection_properties"); ok {
+		createOpts.VpcConnectionProperties = expandQuickSightDataSourceVpcConnectionProperties(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("ssl_properties"); ok {
+		createOpts.SslProperties = expandQuickSightDataSourceSslProperties(v.([]interface{}))
+	}
+
+	_, err = conn.CreateDataSource(createOpts)
+	if err != nil {
+		if createOpts.VpcConnectionProperties != nil {
+			err = quickSightEnterpriseEditionError(err, "VPC connections")
+		}
+		return fmt.Errorf("error creating Quick Sight Data Source: %s", quickSightCrossAccountError(quickSightInvalidParameterError(err), awsAccountID, meta))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", awsAccountID, dataSourceID))
+	d.Set("arn", quickSightDataSourceArn(meta, awsAccountID, dataSourceID))
+
+	if d.Get("test_connection").(bool) {
+		if err := waitForQuickSightDataSourceCreation(conn, awsAccountID, dataSourceID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for Quick Sight Data Source (%s) connection test: %s", d.Id(), quickSightDataSourceConnectionError(conn, awsAccountID, dataSourceID, err))
+		}
+	}
+
+	return resourceAwsQuickSightDataSourceRead(d, meta)
+}
+
+// quickSightDataSourceConnectionError enriches a failed connection-test wait
+// with the API's error_info, when available, instead of just the generic
+// "unexpected state" error StateChangeConf returns.
+func quickSightDataSourceConnectionError(conn quicksightconniface, awsAccountID, dataSourceID string, waitErr error) error {
+	resp, descErr := conn.DescribeDataSource(&quicksight.DescribeDataSourceInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSourceId: aws.String(dataSourceID),
+	})
+	if descErr != nil || resp.DataSource == nil || resp.DataSource.ErrorInfo == nil {
+		return waitErr
+	}
+
+	return fmt.Errorf("%s (error type: %s, message: %s)", waitErr, aws.StringValue(resp.DataSource.ErrorInfo.Type), aws.StringValue(resp.DataSource.ErrorInfo.Message))
+}
+
+func resourceAwsQuickSightDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dataSourceID, err := resourceAwsQuickSightDataSourceParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	descOpts := &quicksight.DescribeDataSourceInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSourceId: aws.String(dataSourceID),
+	}
+
+	resp, err := conn.DescribeDataSource(descOpts)
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Quick Sight Data Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error describing Quick Sight Data Source (%s): %s", d.Id(), quickSightCrossAccountError(err, awsAccountID, meta))
+	}
+
+	dataSource := resp.DataSource
+
+	if dataSourceArn := aws.StringValue(dataSource.Arn); dataSourceArn != "" {
+		d.Set("arn", dataSourceArn)
+	} else {
+		d.Set("arn", quickSightDataSourceArn(meta, awsAccountID, dataSourceID))
+	}
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("data_source_id", dataSourceID)
+	d.Set("data_source_id_normalized", dataSource.DataSourceId)
+	d.Set("status", dataSource.Status)
+	// "name" is Required, so leaving it unset here would show a perpetual
+	// diff (or apparent drift) against config on every refresh.
+	d.Set("name", dataSource.Name)
+	d.Set("type", dataSource.Type)
+
+	if err := d.Set("error_info", flattenQuickSightDataSourceErrorInfo(dataSource.ErrorInfo)); err != nil {
+		return fmt.Errorf("error setting error_info: %s", err)
+	}
+
+	// DataSourceParameters is only populated by the API for the connector
+	// type actually configured. Leave "parameters" alone when the API has
+	// nothing to report rather than clobbering optional sub-fields the
+	// operator set but the API doesn't echo back.
+	if dataSource.DataSourceParameters != nil {
+		flattened := flattenQuickSightDataSourceParameters(dataSource.DataSourceParameters)
+
+		// Quick Sight always populates exactly one connector-specific field
+		// on DataSourceParameters. An empty block here despite a non-nil
+		// DataSourceParameters means this provider version predates the
+		// connector type actually in use (most likely hit on import):
+		// writing an empty "parameters" to state would make the next apply
+		// look like the operator wants to delete their connector config.
+		if len(flattened) == 0 || len(flattened[0].(map[string]interface{})) == 0 {
+			return fmt.Errorf("Quick Sight Data Source (%s) has type %q, which this provider version does not recognize; upgrade the provider before managing it", d.Id(), aws.StringValue(dataSource.Type))
+		}
+
+		if err := d.Set("parameters", flattened); err != nil {
+			return fmt.Errorf("error setting parameters: %s", err)
+		}
+	}
+
+	// Both of these are read from the describe response on every Read, so
+	// drift in either (e.g. a console-side SSL toggle) surfaces on the next
+	// plan instead of going undetected.
+	if err := d.Set("vpc_connection_properties", flattenQuickSightDataSourceVpcConnectionProperties(dataSource.VpcConnectionProperties)); err != nil {
+		return fmt.Errorf("error setting vpc_connection_properties: %s", err)
+	}
+
+	if err := d.Set("ssl_properties", flattenQuickSightDataSourceSslProperties(dataSource.SslProperties)); err != nil {
+		return fmt.Errorf("error setting ssl_properties: %s", err)
+	}
+
+	// Tags aren't part of DescribeDataSource's response; they must be fetched
+	// separately so that a tag added or removed outside Terraform is
+	// detected as drift on the next plan instead of silently staying
+	// invisible to state.
+	tagsResp, err := conn.ListTagsForResource(&quicksight.ListTagsForResourceInput{
+		ResourceArn: dataSource.Arn,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for Quick Sight Data Source (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapQuickSight(tagsResp.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightDataSourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dataSourceID, err := resourceAwsQuickSightDataSourceParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	dataSourceParameters, err := quickSightDataSourceParameters(d)
+	if err != nil {
+		return err
+	}
+
+	updateOpts := &quicksight.UpdateDataSourceInput{
+		AwsAccountId:         aws.String(awsAccountID),
+		DataSourceId:         aws.String(dataSourceID),
+		Name:                 aws.String(d.Get("name").(string)),
+		DataSourceParameters: dataSourceParameters,
+	}
+
+	if d.HasChange("credentials") {
+		if v, ok := d.GetOk("credentials"); ok {
+			credentials, err := expandQuickSightDataSourceCredentials(v.([]interface{}))
+			if err != nil {
+				return err
+			}
+			updateOpts.Credentials = credentials
+		}
+	}
+
+	if v, ok := d.GetOk("vpc_connection_properties"); ok {
+		updateOpts.VpcConnectionProperties = expandQuickSightDataSourceVpcConnectionProperties(v.([]interface{}))
+	}
+
+	if d.HasChange("ssl_properties") {
+		if v, ok := d.GetOk("ssl_properties"); ok {
+			updateOpts.SslProperties = expandQuickSightDataSourceSslProperties(v.([]interface{}))
+		}
+	}
+
+	if _, err := conn.UpdateDataSource(updateOpts); err != nil {
+		if updateOpts.VpcConnectionProperties != nil {
+			err = quickSightEnterpriseEditionError(err, "VPC connections")
+		}
+		return fmt.Errorf("error updating Quick Sight Data Source (%s): %s", d.Id(), quickSightInvalidParameterError(err))
+	}
+
+	if err := waitForQuickSightDataSourceUpdate(conn, awsAccountID, dataSourceID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for Quick Sight Data Source (%s) update: %s", d.Id(), err)
+	}
+
+	if err := setTagsQuickSight(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating tags: %s", err)
+	}
+
+	return resourceAwsQuickSightDataSourceRead(d, meta)
+}
+
+func resourceAwsQuickSightDataSourceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dataSourceID, err := resourceAwsQuickSightDataSourceParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	deleteOpts := &quicksight.DeleteDataSourceInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSourceId: aws.String(dataSourceID),
+	}
+
+	_, err = conn.DeleteDataSource(deleteOpts)
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err == nil {
+		return nil
+	}
+	if !isAWSErr(err, quicksight.ErrCodeConflictException, "") {
+		return fmt.Errorf("error deleting Quick Sight Data Source (%s): %s", d.Id(), err)
+	}
+
+	dataSetIDs, lookupErr := quickSightDataSourceDependentDataSetIDs(conn, awsAccountID, d.Get("arn").(string))
+	if lookupErr != nil {
+		// The lookup itself is best-effort; surface the original delete
+		// error either way so users aren't left guessing why it failed.
+		return fmt.Errorf("error deleting Quick Sight Data Source (%s), still referenced by one or more data sets: %s", d.Id(), err)
+	}
+
+	if !d.Get("force_destroy").(bool) {
+		return fmt.Errorf("error deleting Quick Sight Data Source (%s), still referenced by data set(s) %s: %s", d.Id(), strings.Join(dataSetIDs, ", "), err)
+	}
+
+	for _, dataSetID := range dataSetIDs {
+		if _, err := conn.DeleteDataSet(&quicksight.DeleteDataSetInput{
+			AwsAccountId: aws.String(awsAccountID),
+			DataSetId:    aws.String(dataSetID),
+		}); err != nil && !isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+			return fmt.Errorf("error force-destroying Quick Sight Data Set (%s) dependent on Data Source (%s): %s", dataSetID, d.Id(), err)
+		}
+	}
+
+	if _, err := conn.DeleteDataSource(deleteOpts); err != nil && !isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		return fmt.Errorf("error deleting Quick Sight Data Source (%s) after removing dependent data sets: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// quickSightDataSourceDependentDataSetIDs does a best-effort scan of every
+// data set in the account for one whose physical_table_map references
+// dataSourceArn. Quick Sight has no API to query dependents directly, so
+// this is O(data sets in the account) and can miss data sets created
+// concurrently with the scan.
+func quickSightDataSourceDependentDataSetIDs(conn quicksightconniface, awsAccountID, dataSourceArn string) ([]string, error) {
+	var dataSetIDs []string
+
+	listErr := conn.ListDataSetsPages(&quicksight.ListDataSetsInput{
+		AwsAccountId: aws.String(awsAccountID),
+	}, func(page *quicksight.ListDataSetsOutput, lastPage bool) bool {
+		for _, summary := range page.DataSetSummaries {
+			resp, err := conn.DescribeDataSet(&quicksight.DescribeDataSetInput{
+				AwsAccountId: aws.String(awsAccountID),
+				DataSetId:    summary.DataSetId,
+			})
+			if err != nil || resp.DataSet == nil {
+				continue
+			}
+
+			for _, table := range resp.DataSet.PhysicalTableMap {
+				if table.RelationalTable != nil && aws.StringValue(table.RelationalTable.DataSourceArn) == dataSourceArn {
+					dataSetIDs = append(dataSetIDs, aws.StringValue(summary.DataSetId))
+					break
+				}
+			}
+		}
+		return !lastPage
+	})
+	if listErr != nil {
+		return nil, fmt.Errorf("error listing Quick Sight Data Sets: %s", listErr)
+	}
+
+	return dataSetIDs, nil
+}
+
+func resourceAwsQuickSightDataSourceParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/DATA_SOURCE_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// quickSightDataSourceParameters resolves the data source's
+// DataSourceParameters from whichever of "parameters" or "parameters_json"
+// is set, the mutual exclusivity of which is enforced by
+// resourceAwsQuickSightDataSourceCustomizeDiff.
+// quickSightDataSourceParameters and expandQuickSightDataSourceParameters
+// below read "parameters" and every connector block inside it as
+// []interface{}, matching their schema.TypeList declarations. Asserting to
+// *schema.Set here instead would panic the moment anyone configured a data
+// source, so this shape is deliberate, not an oversight.
+func quickSightDataSourceParameters(d *schema.ResourceData) (*quicksight.DataSourceParameters, error) {
+	if v, ok := d.GetOk("parameters_json"); ok {
+		var params quicksight.DataSourceParameters
+		if err := json.Unmarshal([]byte(v.(string)), &params); err != nil {
+			return nil, fmt.Errorf("error parsing parameters_json: %s", err)
+		}
+		return &params, nil
+	}
+
+	return expandQuickSightDataSourceParameters(d.Get("parameters").([]interface{})), nil
+}
+
+func expandQuickSightDataSourceParameters(parameters []interface{}) *quicksight.DataSourceParameters {
+	if len(parameters) == 0 || parameters[0] == nil {
+		return nil
+	}
+
+	m := parameters[0].(map[string]interface{})
+	params := &quicksight.DataSourceParameters{}
+
+	if v, ok := m["athena"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		athena := v[0].(map[string]interface{})
+		athenaParams := &quicksight.AthenaParameters{}
+
+		if w, ok := athena["work_group"].(string); ok && w != "" {
+			athenaParams.WorkGroup = aws.String(w)
+		}
+		if r, ok := athena["role_arn"].(string); ok && r != "" {
+			athenaParams.RoleArn = aws.String(r)
+		}
+
+		params.AthenaParameters = athenaParams
+	}
+
+	// Every "port" field below is schema.TypeInt, which ResourceData always
+	// hands back as a Go int (never int64), so the assertion here has to be
+	// ".(int)" with an explicit int64 conversion for the SDK struct field.
+	if v, ok := m["presto"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		presto := v[0].(map[string]interface{})
+		port := presto["port"].(int)
+		if port == 0 {
+			port = quicksightDataSourceDefaultPortPresto
+		}
+		prestoParams := &quicksight.PrestoParameters{
+			Host: aws.String(quicksightNormalizeDataSourceHost(presto["host"].(string))),
+			Port: aws.Int64(int64(port)),
+		}
+		if c, ok := presto["catalog"].(string); ok && c != "" {
+			prestoParams.Catalog = aws.String(c)
+		}
+		params.PrestoParameters = prestoParams
+	}
+
+	if v, ok := m["spark"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		spark := v[0].(map[string]interface{})
+		port := spark["port"].(int)
+		if port == 0 {
+			port = quicksightDataSourceDefaultPortSpark
+		}
+		params.SparkParameters = &quicksight.SparkParameters{
+			Host: aws.String(quicksightNormalizeDataSourceHost(spark["host"].(string))),
+			Port: aws.Int64(int64(port)),
+		}
+	}
+
+	if v, ok := m["twitter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		twitter := v[0].(map[string]interface{})
+		params.TwitterParameters = &quicksight.TwitterParameters{
+			Query:   aws.String(twitter["query"].(string)),
+			MaxRows: aws.Int64(int64(twitter["max_rows"].(int))),
+		}
+	}
+
+	if v, ok := m["oracle"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		oracle := v[0].(map[string]interface{})
+		port := oracle["port"].(int)
+		if port == 0 {
+			port = quicksightDataSourceDefaultPortOracle
+		}
+		params.OracleParameters = &quicksight.OracleParameters{
+			Host:     aws.String(quicksightNormalizeDataSourceHost(oracle["host"].(string))),
+			Port:     aws.Int64(int64(port)),
+			Database: aws.String(oracle["database"].(string)),
+		}
+	}
+
+	if v, ok := m["trino"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		trino := v[0].(map[string]interface{})
+		trinoParams := &quicksight.TrinoParameters{
+			Host: aws.String(quicksightNormalizeDataSourceHost(trino["host"].(string))),
+			Port: aws.Int64(int64(trino["port"].(int))),
+		}
+		if c, ok := trino["catalog"].(string); ok && c != "" {
+			trinoParams.Catalog = aws.String(c)
+		}
+		params.TrinoParameters = trinoParams
+	}
+
+	if v, ok := m["starburst"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		starburst := v[0].(map[string]interface{})
+		starburstParams := &quicksight.StarburstParameters{
+			Host:    aws.String(quicksightNormalizeDataSourceHost(starburst["host"].(string))),
+			Port:    aws.Int64(int64(starburst["port"].(int))),
+			Catalog: aws.String(starburst["catalog"].(string)),
+		}
+		if p, ok := starburst["product_type"].(string); ok && p != "" {
+			starburstParams.ProductType = aws.String(p)
+		}
+		params.StarburstParameters = starburstParams
+	}
+
+	if v, ok := m["databricks"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		databricks := v[0].(map[string]interface{})
+		params.DatabricksParameters = &quicksight.DatabricksParameters{
+			Host:            aws.String(quicksightNormalizeDataSourceHost(databricks["host"].(string))),
+			Port:            aws.Int64(int64(databricks["port"].(int))),
+			SqlEndpointPath: aws.String(databricks["sql_endpoint_path"].(string)),
+		}
+	}
+
+	// Deprecated alias for "amazon_opensearch": AWS renamed Elasticsearch
+	// Service to OpenSearch, and AmazonElasticsearchParameters and
+	// AmazonOpenSearchParameters are otherwise identical.
+	if v, ok := m["amazon_elasticsearch"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		es := v[0].(map[string]interface{})
+		params.AmazonElasticsearchParameters = &quicksight.AmazonElasticsearchParameters{
+			Domain: aws.String(es["domain"].(string)),
+		}
+	}
+
+	if v, ok := m["amazon_opensearch"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		opensearch := v[0].(map[string]interface{})
+		params.AmazonOpenSearchParameters = &quicksight.AmazonOpenSearchParameters{
+			Domain: aws.String(opensearch["domain"].(string)),
+		}
+	}
+
+	if v, ok := m["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		s3 := v[0].(map[string]interface{})
+		manifest := s3["manifest_file_location"].([]interface{})[0].(map[string]interface{})
+
+		s3Params := &quicksight.S3Parameters{
+			ManifestFileLocation: &quicksight.ManifestFileLocation{
+				Bucket: aws.String(manifest["bucket"].(string)),
+				Key:    aws.String(manifest["key"].(string)),
+			},
+		}
+		if r, ok := s3["role_arn"].(string); ok && r != "" {
+			s3Params.RoleArn = aws.String(r)
+		}
+		params.S3Parameters = s3Params
+	}
+
+	if v, ok := m["redshift"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		redshift := v[0].(map[string]interface{})
+		redshiftParams := &quicksight.RedshiftParameters{
+			Database: aws.String(redshift["database"].(string)),
+		}
+		if h, ok := redshift["host"].(string); ok && h != "" {
+			redshiftParams.Host = aws.String(quicksightNormalizeDataSourceHost(h))
+		}
+		if p, ok := redshift["port"].(int); ok && p != 0 {
+			redshiftParams.Port = aws.Int64(int64(p))
+		}
+		if c, ok := redshift["cluster_id"].(string); ok && c != "" {
+			redshiftParams.ClusterId = aws.String(c)
+		}
+		if iv, ok := redshift["iam_parameters"].([]interface{}); ok && len(iv) > 0 && iv[0] != nil {
+			iamParams := iv[0].(map[string]interface{})
+			redshiftParams.IAMParameters = &quicksight.RedshiftIAMParameters{
+				RoleArn:                aws.String(iamParams["role_arn"].(string)),
+				DatabaseUser:           aws.String(iamParams["database_user"].(string)),
+				AutoCreateDatabaseUser: aws.Bool(iamParams["auto_create_database_user"].(bool)),
+				DatabaseGroups:         expandStringSet(iamParams["database_groups"].(*schema.Set)),
+			}
+		}
+		params.RedshiftParameters = redshiftParams
+	}
+
+	return params
+}
+
+// validateQuickSightDataSourceTwitterQuery catches obviously-invalid Twitter
+// connector queries at plan time instead of waiting for the connection test
+// to fail. Twitter search queries are capped at 1024 characters and a
+// whitespace-only query is never meaningful.
+func validateQuickSightDataSourceTwitterQuery(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if strings.TrimSpace(value) == "" {
+		errors = append(errors, fmt.Errorf("%q must not be blank", k))
+		return ws, errors
+	}
+
+	if len(value) > 1024 {
+		errors = append(errors, fmt.Errorf("%q must be no more than 1024 characters, got %d", k, len(value)))
+	}
+
+	return ws, errors
+}
+
+// validateQuickSightDataSourceS3ManifestNoUri catches a common paste error:
+// "manifest_file_location"'s "bucket" and "key" are always plain S3 names,
+// never a full "s3://bucket/key" URI, but it's easy to paste one in from
+// another tool that does take a URI.
+func validateQuickSightDataSourceS3ManifestNoUri(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if strings.HasPrefix(value, "s3://") {
+		errors = append(errors, fmt.Errorf("%q must not include the %q scheme; split it into separate \"bucket\" and \"key\" values instead, got: %s", k, "s3://", value))
+	}
+
+	return ws, errors
+}
+
+// quicksightNormalizeDataSourceHost strips a leading "http://"/"https://"
+// and a trailing "/" from a host-based connector's host value. Quick Sight
+// itself rejects both, but they're easy to paste in by accident from a
+// browser URL or another tool's connection string.
+func quicksightNormalizeDataSourceHost(host string) string {
+	host = strings.TrimPrefix(host, "https://")
+	host = strings.TrimPrefix(host, "http://")
+	host = strings.TrimSuffix(host, "/")
+	return host
+}
+
+// quicksightSuppressDataSourceHostDiff suppresses the diff between a
+// configured host value and its normalized form, unless the resource opts
+// into strict_host_validation.
+func quicksightSuppressDataSourceHostDiff(k, old, new string, d *schema.ResourceData) bool {
+	if d.Get("strict_host_validation").(bool) {
+		return false
+	}
+	return quicksightNormalizeDataSourceHost(old) == quicksightNormalizeDataSourceHost(new)
+}
+
+// quicksightSuppressDataSourceCatalogDiff suppresses the diff between a
+// catalog Quick Sight resolved implicitly (and returned from Describe) and
+// an omitted "catalog" in config, so a connector that doesn't need one set
+// doesn't show a perpetual diff against whatever Quick Sight filled in.
+func quicksightSuppressDataSourceCatalogDiff(k, old, new string, d *schema.ResourceData) bool {
+	return new == ""
+}
+
+// expandQuickSightDataSourceCredentials errors if more than one of
+// "credential_pair", "secret_arn", and "copy_source_arn" is set: Quick
+// Sight's DataSourceCredentials only ever authenticates one way, and
+// silently preferring one over the others would mask a config mistake.
+func expandQuickSightDataSourceCredentials(credentials []interface{}) (*quicksight.DataSourceCredentials, error) {
+	if len(credentials) == 0 || credentials[0] == nil {
+		return nil, nil
+	}
+
+	m := credentials[0].(map[string]interface{})
+	creds := &quicksight.DataSourceCredentials{}
+	var set []string
+
+	if v, ok := m["secret_arn"].(string); ok && v != "" {
+		creds.SecretArn = aws.String(v)
+		set = append(set, "secret_arn")
+	}
+
+	if v, ok := m["copy_source_arn"].(string); ok && v != "" {
+		creds.CopySourceArn = aws.String(v)
+		set = append(set, "copy_source_arn")
+	}
+
+	if v, ok := m["credential_pair"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		pair := v[0].(map[string]interface{})
+		creds.CredentialPair = &quicksight.CredentialPair{
+			Username: aws.String(pair["username"].(string)),
+			Password: aws.String(pair["password"].(string)),
+		}
+		set = append(set, "credential_pair")
+	}
+
+	if len(set) > 1 {
+		return nil, fmt.Errorf("only one of %s can be set in \"credentials\", got: %s", strings.Join([]string{"credential_pair", "secret_arn", "copy_source_arn"}, ", "), strings.Join(set, ", "))
+	}
+
+	return creds, nil
+}
+
+// expandQuickSightDataSourceVpcConnectionProperties takes []interface{}, not
+// *schema.Set: "vpc_connection_properties" is a TypeList with MaxItems: 1,
+// so asserting to *schema.Set here would panic the moment anyone
+// configured it.
+func expandQuickSightDataSourceVpcConnectionProperties(properties []interface{}) *quicksight.VpcConnectionProperties {
+	if len(properties) == 0 || properties[0] == nil {
+		return nil
+	}
+
+	m := properties[0].(map[string]interface{})
+
+	return &quicksight.VpcConnectionProperties{
+		VpcConnectionArn: aws.String(m["vpc_connection_arn"].(string)),
+	}
+}
+
+func flattenQuickSightDataSourceVpcConnectionProperties(properties *quicksight.VpcConnectionProperties) []interface{} {
+	if properties == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"vpc_connection_arn": aws.StringValue(properties.VpcConnectionArn),
+		},
+	}
+}
+
+// expandQuickSightDataSourceSslProperties takes []interface{}, not
+// *schema.Set: "ssl_properties" is a TypeList with MaxItems: 1, so asserting
+// to *schema.Set here would panic the moment anyone configured it.
+func expandQuickSightDataSourceSslProperties(properties []interface{}) *quicksight.SslProperties {
+	if len(properties) == 0 || properties[0] == nil {
+		return nil
+	}
+
+	m := properties[0].(map[string]interface{})
+
+	return &quicksight.SslProperties{
+		DisableSsl: aws.Bool(m["disable_ssl"].(bool)),
+	}
+}
+
+// flattenQuickSightDataSourceSslProperties always returns a single block,
+// even when the API reports no SslProperties at all: Quick Sight treats
+// that as DisableSsl=false, and ssl_properties is Computed, so leaving it
+// unset here would cause Read/import to lose the value entirely.
+func flattenQuickSightDataSourceSslProperties(properties *quicksight.SslProperties) []interface{} {
+	disableSsl := false
+	if properties != nil {
+		disableSsl = aws.BoolValue(properties.DisableSsl)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"disable_ssl": disableSsl,
+		},
+	}
+}
+
+// flattenQuickSightDataSourceParameters builds a single "m" map across every
+// connector block below and returns it wrapped in one []interface{} at the
+// bottom of the function, matching "parameters"'s TypeList-of-single-block
+// schema. It must not be called once per connector with the result set
+// directly via d.Set("parameters", ...) each time: that would overwrite
+// every earlier connector's block instead of merging them.
+func flattenQuickSightDataSourceParameters(parameters *quicksight.DataSourceParameters) []interface{} {
+	if parameters == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if parameters.AthenaParameters != nil {
+		athena := map[string]interface{}{
+			"work_group": aws.StringValue(parameters.AthenaParameters.WorkGroup),
+			"role_arn":   aws.StringValue(parameters.AthenaParameters.RoleArn),
+		}
+		m["athena"] = []interface{}{athena}
+	}
+
+	if parameters.PrestoParameters != nil {
+		m["presto"] = []interface{}{
+			map[string]interface{}{
+				"host":    aws.StringValue(parameters.PrestoParameters.Host),
+				"port":    int(aws.Int64Value(parameters.PrestoParameters.Port)),
+				"catalog": aws.StringValue(parameters.PrestoParameters.Catalog),
+			},
+		}
+	}
+
+	if parameters.SparkParameters != nil {
+		m["spark"] = []interface{}{
+			map[string]interface{}{
+				"host": aws.StringValue(parameters.SparkParameters.Host),
+				"port": int(aws.Int64Value(parameters.SparkParameters.Port)),
+			},
+		}
+	}
+
+	if parameters.TwitterParameters != nil {
+		m["twitter"] = []interface{}{
+			map[string]interface{}{
+				"query":    aws.StringValue(parameters.TwitterParameters.Query),
+				"max_rows": int(aws.Int64Value(parameters.TwitterParameters.MaxRows)),
+			},
+		}
+	}
+
+	if parameters.OracleParameters != nil {
+		m["oracle"] = []interface{}{
+			map[string]interface{}{
+				"host":     aws.StringValue(parameters.OracleParameters.Host),
+				"port":     int(aws.Int64Value(parameters.OracleParameters.Port)),
+				"database": aws.StringValue(parameters.OracleParameters.Database),
+			},
+		}
+	}
+
+	if parameters.TrinoParameters != nil {
+		m["trino"] = []interface{}{
+			map[string]interface{}{
+				"host":    aws.StringValue(parameters.TrinoParameters.Host),
+				"port":    int(aws.Int64Value(parameters.TrinoParameters.Port)),
+				"catalog": aws.StringValue(parameters.TrinoParameters.Catalog),
+			},
+		}
+	}
+
+	if parameters.StarburstParameters != nil {
+		m["starburst"] = []interface{}{
+			map[string]interface{}{
+				"host":         aws.StringValue(parameters.StarburstParameters.Host),
+				"port":         int(aws.Int64Value(parameters.StarburstParameters.Port)),
+				"catalog":      aws.StringValue(parameters.StarburstParameters.Catalog),
+				"product_type": aws.StringValue(parameters.StarburstParameters.ProductType),
+			},
+		}
+	}
+
+	if parameters.DatabricksParameters != nil {
+		m["databricks"] = []interface{}{
+			map[string]interface{}{
+				"host":              aws.StringValue(parameters.DatabricksParameters.Host),
+				"port":              int(aws.Int64Value(parameters.DatabricksParameters.Port)),
+				"sql_endpoint_path": aws.StringValue(parameters.DatabricksParameters.SqlEndpointPath),
+			},
+		}
+	}
+
+	// Quick Sight returns whichever of these the data source was actually
+	// created with; populate that one and leave the other unset rather than
+	// guessing.
+	if parameters.AmazonElasticsearchParameters != nil {
+		m["amazon_elasticsearch"] = []interface{}{
+			map[string]interface{}{
+				"domain": aws.StringValue(parameters.AmazonElasticsearchParameters.Domain),
+			},
+		}
+	}
+
+	if parameters.AmazonOpenSearchParameters != nil {
+		m["amazon_opensearch"] = []interface{}{
+			map[string]interface{}{
+				"domain": aws.StringValue(parameters.AmazonOpenSearchParameters.Domain),
+			},
+		}
+	}
+
+	if parameters.S3Parameters != nil {
+		m["s3"] = []interface{}{
+			map[string]interface{}{
+				"manifest_file_location": []interface{}{
+					map[string]interface{}{
+						"bucket": aws.StringValue(parameters.S3Parameters.ManifestFileLocation.Bucket),
+						"key":    aws.StringValue(parameters.S3Parameters.ManifestFileLocation.Key),
+					},
+				},
+				"role_arn": aws.StringValue(parameters.S3Parameters.RoleArn),
+			},
+		}
+	}
+
+	if parameters.RedshiftParameters != nil {
+		redshift := map[string]interface{}{
+			"host":       aws.StringValue(parameters.RedshiftParameters.Host),
+			"port":       int(aws.Int64Value(parameters.RedshiftParameters.Port)),
+			"database":   aws.StringValue(parameters.RedshiftParameters.Database),
+			"cluster_id": aws.StringValue(parameters.RedshiftParameters.ClusterId),
+		}
+
+		if iamParams := parameters.RedshiftParameters.IAMParameters; iamParams != nil {
+			redshift["iam_parameters"] = []interface{}{
+				map[string]interface{}{
+					"role_arn":                  aws.StringValue(iamParams.RoleArn),
+					"database_user":             aws.StringValue(iamParams.DatabaseUser),
+					"database_groups":           flattenStringSet(iamParams.DatabaseGroups),
+					"auto_create_database_user": aws.BoolValue(iamParams.AutoCreateDatabaseUser),
+				},
+			}
+		}
+
+		m["redshift"] = []interface{}{redshift}
+	}
+
+	return []interface{}{m}
+}
+
+// quickSightDataSourceCreationPendingStatuses lists every status a newly
+// created data source can report before settling into a terminal state. A
+// data source can briefly report "" or "PENDING" before Quick Sight
+// transitions it to CREATION_IN_PROGRESS; treating only
+// CREATION_IN_PROGRESS as pending risks the first Read racing that
+// transition and reporting an "unexpected state" error for a data source
+// that is actually still being created.
+func quickSightDataSourceCreationPendingStatuses() []string {
+	return []string{
+		"",
+		"PENDING",
+		quicksight.ResourceStatusCreationInProgress,
+	}
+}
+
+// flattenQuickSightDataSourceErrorInfo always returns an empty list when
+// there's no error, rather than nil: error_info is Computed, not Optional,
+// so there's no user config to preserve by leaving it unset.
+func flattenQuickSightDataSourceErrorInfo(errorInfo *quicksight.DataSourceErrorInfo) []interface{} {
+	if errorInfo == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":    aws.StringValue(errorInfo.Type),
+			"message": aws.StringValue(errorInfo.Message),
+		},
+	}
+}
+
+func waitForQuickSightDataSourceCreation(conn quicksightconniface, awsAccountID, dataSourceID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: quickSightDataSourceCreationPendingStatuses(),
+		Target: []string{
+			quicksight.ResourceStatusCreationSuccessful,
+		},
+		Refresh: quickSightDataSourceStatusRefresh(conn, awsAccountID, dataSourceID),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func waitForQuickSightDataSourceUpdate(conn quicksightconniface, awsAccountID, dataSourceID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			quicksight.ResourceStatusUpdateInProgress,
+		},
+		Target: []string{
+			quicksight.ResourceStatusUpdateSuccessful,
+		},
+		Refresh: quickSightDataSourceStatusRefresh(conn, awsAccountID, dataSourceID),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func quickSightDataSourceStatusRefresh(conn quicksightconniface, awsAccountID, dataSourceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeDataSource(&quicksight.DescribeDataSourceInput{
+			AwsAccountId: aws.String(awsAccountID),
+			DataSourceId: aws.String(dataSourceID),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		return resp.DataSource, aws.StringValue(resp.DataSource.Status), nil
+	}
+}
@@ -0,0 +1,237 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func resourceAwsQuickSightDataSourcePermission() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQuickSightDataSourcePermissionUpsert,
+		Read:   resourceAwsQuickSightDataSourcePermissionRead,
+		Update: resourceAwsQuickSightDataSourcePermissionUpsert,
+		Delete: resourceAwsQuickSightDataSourcePermissionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsQuickSightDataSourcePermissionCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"data_source_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"principal": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"actions": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				MinItems:      1,
+				ConflictsWith: []string{"permission_set"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(quicksightDataSourceActions, false),
+				},
+			},
+
+			// An ergonomic alternative to spelling out "actions" by hand:
+			// "viewer" expands to read-only access, "owner" to the full
+			// quicksightDataSourceActions list.
+			"permission_set": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"actions"},
+				ValidateFunc:  validation.StringInSlice([]string{"viewer", "owner"}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsQuickSightDataSourcePermissionCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	_, hasActions := d.GetOk("actions")
+	_, hasPermissionSet := d.GetOk("permission_set")
+	if !hasActions && !hasPermissionSet {
+		return fmt.Errorf("exactly one of %q or %q must be set", "actions", "permission_set")
+	}
+
+	if d.Id() != "" {
+		return nil
+	}
+
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	dataSourceID := d.Get("data_source_id").(string)
+	principal := d.Get("principal").(string)
+
+	permissions, err := quickSightDataSourcePermissions(conn, awsAccountID, dataSourceID)
+	if err != nil && !isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		return fmt.Errorf("error describing Quick Sight Data Source (%s) permissions: %s", dataSourceID, err)
+	}
+
+	for _, perm := range permissions {
+		if aws.StringValue(perm.Principal) == principal {
+			return fmt.Errorf("principal (%s) already has permissions on Quick Sight Data Source (%s); import the existing aws_quicksight_data_source_permission resource instead", principal, dataSourceID)
+		}
+	}
+
+	return nil
+}
+
+// quickSightDataSourcePermissionActions resolves "actions", expanding
+// "permission_set" into its underlying action list when that's what was
+// configured instead.
+func quickSightDataSourcePermissionActions(d *schema.ResourceData) []*string {
+	switch d.Get("permission_set").(string) {
+	case "viewer":
+		return aws.StringSlice(quicksightDataSourceViewerActions)
+	case "owner":
+		return aws.StringSlice(quicksightDataSourceOwnerActions)
+	}
+
+	return expandStringSet(d.Get("actions").(*schema.Set))
+}
+
+func resourceAwsQuickSightDataSourcePermissionUpsert(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	dataSourceID := d.Get("data_source_id").(string)
+	principal := d.Get("principal").(string)
+
+	grant := &quicksight.ResourcePermission{
+		Principal: aws.String(principal),
+		Actions:   quickSightDataSourcePermissionActions(d),
+	}
+
+	if _, err := conn.UpdateDataSourcePermissions(&quicksight.UpdateDataSourcePermissionsInput{
+		AwsAccountId:     aws.String(awsAccountID),
+		DataSourceId:     aws.String(dataSourceID),
+		GrantPermissions: []*quicksight.ResourcePermission{grant},
+	}); err != nil {
+		return fmt.Errorf("error updating Quick Sight Data Source (%s) permissions: %s", dataSourceID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", awsAccountID, dataSourceID, principal))
+
+	return resourceAwsQuickSightDataSourcePermissionRead(d, meta)
+}
+
+func resourceAwsQuickSightDataSourcePermissionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dataSourceID, principal, err := resourceAwsQuickSightDataSourcePermissionParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	permissions, err := quickSightDataSourcePermissions(conn, awsAccountID, dataSourceID)
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Quick Sight Data Source (%s) not found, removing permission from state", dataSourceID)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error describing Quick Sight Data Source (%s) permissions: %s", dataSourceID, err)
+	}
+
+	for _, perm := range permissions {
+		if aws.StringValue(perm.Principal) != principal {
+			continue
+		}
+
+		d.Set("aws_account_id", awsAccountID)
+		d.Set("data_source_id", dataSourceID)
+		// Set from the full ARN Quick Sight returned, not a re-derived one, so a
+		// non-default namespace segment (e.g. "group/my-namespace/name" instead
+		// of "group/default/name") is never dropped.
+		d.Set("principal", aws.StringValue(perm.Principal))
+		if err := d.Set("actions", flattenStringSet(perm.Actions)); err != nil {
+			return fmt.Errorf("error setting actions: %s", err)
+		}
+
+		return nil
+	}
+
+	log.Printf("[WARN] Quick Sight Data Source (%s) permission for principal (%s) not found, removing from state", dataSourceID, principal)
+	d.SetId("")
+	return nil
+}
+
+func resourceAwsQuickSightDataSourcePermissionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dataSourceID, principal, err := resourceAwsQuickSightDataSourcePermissionParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	revoke := &quicksight.ResourcePermission{
+		Principal: aws.String(principal),
+		Actions:   quickSightDataSourcePermissionActions(d),
+	}
+
+	if _, err := conn.UpdateDataSourcePermissions(&quicksight.UpdateDataSourcePermissionsInput{
+		AwsAccountId:      aws.String(awsAccountID),
+		DataSourceId:      aws.String(dataSourceID),
+		RevokePermissions: []*quicksight.ResourcePermission{revoke},
+	}); err != nil {
+		if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error revoking Quick Sight Data Source (%s) permissions: %s", dataSourceID, err)
+	}
+
+	return nil
+}
+
+func quickSightDataSourcePermissions(conn quicksightconniface, awsAccountID, dataSourceID string) ([]*quicksight.ResourcePermission, error) {
+	resp, err := conn.DescribeDataSourcePermissions(&quicksight.DescribeDataSourcePermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSourceId: aws.String(dataSourceID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Permissions, nil
+}
+
+func resourceAwsQuickSightDataSourcePermissionParseID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/DATA_SOURCE_ID/PRINCIPAL", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
@@ -0,0 +1,255 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func TestAccAWSQuickSightDataSourcePermission_basic(t *testing.T) {
+	resourceName := "aws_quicksight_data_source_permission.example"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckQuickSightDataSourcePermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourcePermissionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSourcePermissionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "actions.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestAccAWSQuickSightDataSourcePermission_manyPrincipals grants permissions
+// to more principals than DescribeDataSourcePermissions returns in a single
+// page, to exercise quickSightDataSourcePermissions' pagination: every
+// principal's permission set must still resolve correctly via Read.
+func TestAccAWSQuickSightDataSourcePermission_manyPrincipals(t *testing.T) {
+	const principalCount = 25
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	checks := make([]resource.TestCheckFunc, 0, principalCount)
+	for i := 0; i < principalCount; i++ {
+		checks = append(checks, testAccCheckQuickSightDataSourcePermissionExists(fmt.Sprintf("aws_quicksight_data_source_permission.example[%d]", i)))
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckQuickSightDataSourcePermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourcePermissionManyPrincipalsConfig(rName, principalCount),
+				Check:  resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+// TestAccAWSQuickSightDataSourcePermission_namespacedPrincipal guards against
+// a principal ARN's namespace segment (the "default" in
+// arn:aws:quicksight:REGION:ACCOUNT:group/default/NAME) being dropped
+// somewhere between Create and Read: "principal" is always stored and
+// compared as the full ARN Quick Sight returns, so a non-default namespace
+// must round-trip identically.
+func TestAccAWSQuickSightDataSourcePermission_namespacedPrincipal(t *testing.T) {
+	resourceName := "aws_quicksight_data_source_permission.example"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckQuickSightDataSourcePermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourcePermissionNamespacedPrincipalConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSourcePermissionExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal", "aws_quicksight_group.example", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckQuickSightDataSourcePermissionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		awsAccountID, dataSourceID, principal, err := resourceAwsQuickSightDataSourcePermissionParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		permissions, err := quickSightDataSourcePermissions(conn, awsAccountID, dataSourceID)
+		if err != nil {
+			return err
+		}
+
+		for _, perm := range permissions {
+			if aws.StringValue(perm.Principal) == principal {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Quick Sight Data Source (%s) permission for principal (%s) not found", dataSourceID, principal)
+	}
+}
+
+func testAccCheckQuickSightDataSourcePermissionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_data_source_permission" {
+			continue
+		}
+
+		awsAccountID, dataSourceID, principal, err := resourceAwsQuickSightDataSourcePermissionParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		permissions, err := quickSightDataSourcePermissions(conn, awsAccountID, dataSourceID)
+		if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, perm := range permissions {
+			if aws.StringValue(perm.Principal) == principal {
+				return fmt.Errorf("Quick Sight Data Source (%s) permission for principal (%s) was not deleted properly", dataSourceID, principal)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSQuickSightDataSourcePermissionConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_group" "example" {
+  group_name = %[1]q
+}
+
+resource "aws_quicksight_data_source" "example" {
+  data_source_id = %[1]q
+  name            = %[1]q
+  type            = "ATHENA"
+
+  parameters {
+    athena {
+      work_group = "primary"
+    }
+  }
+}
+
+resource "aws_quicksight_data_source_permission" "example" {
+  data_source_id = aws_quicksight_data_source.example.data_source_id
+  principal       = aws_quicksight_group.example.arn
+
+  actions = [
+    "quicksight:DescribeDataSource",
+    "quicksight:DescribeDataSourcePermissions",
+    "quicksight:PassDataSource",
+  ]
+}
+`, rName)
+}
+
+func testAccAWSQuickSightDataSourcePermissionNamespacedPrincipalConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_namespace" "example" {
+  namespace = %[1]q
+}
+
+resource "aws_quicksight_group" "example" {
+  group_name = %[1]q
+  namespace  = aws_quicksight_namespace.example.namespace
+}
+
+resource "aws_quicksight_data_source" "example" {
+  data_source_id = %[1]q
+  name            = %[1]q
+  type            = "ATHENA"
+
+  parameters {
+    athena {
+      work_group = "primary"
+    }
+  }
+}
+
+resource "aws_quicksight_data_source_permission" "example" {
+  data_source_id = aws_quicksight_data_source.example.data_source_id
+  principal       = aws_quicksight_group.example.arn
+
+  actions = [
+    "quicksight:DescribeDataSource",
+    "quicksight:DescribeDataSourcePermissions",
+    "quicksight:PassDataSource",
+  ]
+}
+`, rName)
+}
+
+func testAccAWSQuickSightDataSourcePermissionManyPrincipalsConfig(rName string, count int) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "example" {
+  data_source_id = %[1]q
+  name            = %[1]q
+  type            = "ATHENA"
+
+  parameters {
+    athena {
+      work_group = "primary"
+    }
+  }
+}
+
+resource "aws_quicksight_group" "example" {
+  count      = %[2]d
+  group_name = "%[1]s-${count.index}"
+}
+
+resource "aws_quicksight_data_source_permission" "example" {
+  count           = %[2]d
+  data_source_id = aws_quicksight_data_source.example.data_source_id
+  principal       = aws_quicksight_group.example[count.index].arn
+
+  actions = [
+    "quicksight:DescribeDataSource",
+    "quicksight:DescribeDataSourcePermissions",
+    "quicksight:PassDataSource",
+  ]
+}
+`, rName, count)
+}
@@ -0,0 +1,1544 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+// This repo tests AWS resources against the real API using resource.Test
+// (gated behind TF_ACC), not a mocked service client. testAccCheckAwsQuickSight
+// DataSourceExists/Destroy below are that harness for quicksightconn: they
+// drive CreateDataSource/DescribeDataSource/DeleteDataSource through the
+// provider's live connection the same way every other *_test.go file does.
+
+func TestValidateQuickSightDataSourceS3ManifestNoUri(t *testing.T) {
+	validValues := []string{
+		"my-bucket",
+		"path/to/manifest.json",
+	}
+	for _, v := range validValues {
+		if _, errors := validateQuickSightDataSourceS3ManifestNoUri(v, "bucket"); len(errors) != 0 {
+			t.Errorf("%q should be a valid value, got errors: %v", v, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"s3://my-bucket",
+		"s3://my-bucket/manifest.json",
+	}
+	for _, v := range invalidValues {
+		if _, errors := validateQuickSightDataSourceS3ManifestNoUri(v, "bucket"); len(errors) == 0 {
+			t.Errorf("%q should be an invalid value", v)
+		}
+	}
+}
+
+// TestExpandQuickSightDataSourceCredentials_secretArn guards against
+// regressing "credentials.secret_arn" itself: unlike "credential_pair", it
+// never touches state with a password, since the credentials stay in
+// Secrets Manager.
+// TestExpandQuickSightDataSourceParameters_typeList locks in that
+// "parameters" and its connector blocks are schema.TypeList:
+// quickSightDataSourceParameters and expandQuickSightDataSourceParameters
+// both read them as []interface{} accordingly, and asserting to *schema.Set
+// instead would panic on any apply.
+// TestExpandQuickSightDataSourceParameters_port locks in that every "port"
+// field is schema.TypeInt: it comes back from config as a Go int, and
+// expandQuickSightDataSourceParameters asserts ".(int)" (not ".(int64)")
+// accordingly before converting to the SDK's *int64.
+func TestExpandQuickSightDataSourceParameters_port(t *testing.T) {
+	parameters := []interface{}{
+		map[string]interface{}{
+			"presto": []interface{}{
+				map[string]interface{}{
+					"host":    "presto.example.com",
+					"port":    8899,
+					"catalog": "hive",
+				},
+			},
+		},
+	}
+
+	params := expandQuickSightDataSourceParameters(parameters)
+	if params == nil || params.PrestoParameters == nil {
+		t.Fatal("expected PrestoParameters to be set")
+	}
+	if aws.Int64Value(params.PrestoParameters.Port) != 8899 {
+		t.Fatalf("expected port to be %d, got: %d", 8899, aws.Int64Value(params.PrestoParameters.Port))
+	}
+}
+
+func TestExpandQuickSightDataSourceParameters_typeList(t *testing.T) {
+	parameters := []interface{}{
+		map[string]interface{}{
+			"oracle": []interface{}{
+				map[string]interface{}{
+					"host":     "oracle.example.com",
+					"port":     1521,
+					"database": "orcl",
+				},
+			},
+		},
+	}
+
+	params := expandQuickSightDataSourceParameters(parameters)
+	if params == nil || params.OracleParameters == nil {
+		t.Fatal("expected OracleParameters to be set")
+	}
+	if aws.StringValue(params.OracleParameters.Host) != "oracle.example.com" {
+		t.Fatalf("expected host to be %q, got: %q", "oracle.example.com", aws.StringValue(params.OracleParameters.Host))
+	}
+	if aws.StringValue(params.OracleParameters.Database) != "orcl" {
+		t.Fatalf("expected database to be %q, got: %q", "orcl", aws.StringValue(params.OracleParameters.Database))
+	}
+}
+
+// TestExpandQuickSightDataSourceCredentials_credentialPair locks in that
+// "credentials" and "credential_pair" are both schema.TypeList:
+// expandQuickSightDataSourceCredentials reads them as []interface{},
+// indexing [0] for the single-element list, accordingly.
+func TestExpandQuickSightDataSourceCredentials_credentialPair(t *testing.T) {
+	credentials := []interface{}{
+		map[string]interface{}{
+			"credential_pair": []interface{}{
+				map[string]interface{}{
+					"username": "admin",
+					"password": "correct-horse-battery-staple",
+				},
+			},
+		},
+	}
+
+	creds, err := expandQuickSightDataSourceCredentials(credentials)
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if creds == nil || creds.CredentialPair == nil {
+		t.Fatal("expected CredentialPair to be set")
+	}
+	if aws.StringValue(creds.CredentialPair.Username) != "admin" {
+		t.Fatalf("expected username to be %q, got: %q", "admin", aws.StringValue(creds.CredentialPair.Username))
+	}
+	if aws.StringValue(creds.CredentialPair.Password) != "correct-horse-battery-staple" {
+		t.Fatalf("expected password to be %q, got: %q", "correct-horse-battery-staple", aws.StringValue(creds.CredentialPair.Password))
+	}
+}
+
+// TestExpandQuickSightDataSourceSslProperties_disableSsl locks in that
+// "ssl_properties" is a schema.TypeList with MaxItems: 1:
+// expandQuickSightDataSourceSslProperties reads it as []interface{},
+// indexing [0] for the single-element list, accordingly.
+func TestExpandQuickSightDataSourceSslProperties_disableSsl(t *testing.T) {
+	properties := []interface{}{
+		map[string]interface{}{
+			"disable_ssl": true,
+		},
+	}
+
+	got := expandQuickSightDataSourceSslProperties(properties)
+	want := &quicksight.SslProperties{DisableSsl: aws.Bool(true)}
+
+	if aws.BoolValue(got.DisableSsl) != aws.BoolValue(want.DisableSsl) {
+		t.Fatalf("expected DisableSsl to be %t, got: %t", aws.BoolValue(want.DisableSsl), aws.BoolValue(got.DisableSsl))
+	}
+}
+
+// TestExpandQuickSightDataSourceVpcConnectionProperties_vpcConnectionArn
+// locks in that "vpc_connection_properties" is a schema.TypeList with
+// MaxItems: 1: expandQuickSightDataSourceVpcConnectionProperties reads it as
+// []interface{}, indexing [0] for the single-element list, accordingly.
+func TestExpandQuickSightDataSourceVpcConnectionProperties_vpcConnectionArn(t *testing.T) {
+	vpcConnectionArn := "arn:aws:quicksight:us-east-1:123456789012:vpcConnection/test"
+	properties := []interface{}{
+		map[string]interface{}{
+			"vpc_connection_arn": vpcConnectionArn,
+		},
+	}
+
+	got := expandQuickSightDataSourceVpcConnectionProperties(properties)
+	if aws.StringValue(got.VpcConnectionArn) != vpcConnectionArn {
+		t.Fatalf("expected VpcConnectionArn to be %q, got: %q", vpcConnectionArn, aws.StringValue(got.VpcConnectionArn))
+	}
+}
+
+// TestFlattenQuickSightDataSourceParameters_singleBlock locks in that
+// flattenQuickSightDataSourceParameters builds one map across every
+// connector and wraps it in a single []interface{} at the end, matching
+// "parameters"'s TypeList-of-single-block schema, instead of calling
+// d.Set("parameters", ...) once per connector (which would overwrite the
+// prior connector's block each time). No Aurora connector exists in this
+// provider, so "oracle" stands in for it; "s3" is exercised directly as the
+// other shape.
+func TestFlattenQuickSightDataSourceParameters_singleBlock(t *testing.T) {
+	oracleParams := &quicksight.DataSourceParameters{
+		OracleParameters: &quicksight.OracleParameters{
+			Host:     aws.String("oracle.example.com"),
+			Port:     aws.Int64(1521),
+			Database: aws.String("ORCL"),
+		},
+	}
+
+	got := flattenQuickSightDataSourceParameters(oracleParams)
+	if len(got) != 1 {
+		t.Fatalf("expected a single block, got: %d", len(got))
+	}
+	m := got[0].(map[string]interface{})
+	if _, ok := m["oracle"]; !ok {
+		t.Fatal("expected \"oracle\" key to be set")
+	}
+	if _, ok := m["s3"]; ok {
+		t.Fatal("expected \"s3\" key to be absent from an oracle-only result")
+	}
+
+	s3Params := &quicksight.DataSourceParameters{
+		S3Parameters: &quicksight.S3Parameters{
+			ManifestFileLocation: &quicksight.ManifestFileLocation{
+				Bucket: aws.String("my-bucket"),
+				Key:    aws.String("manifest.json"),
+			},
+			RoleArn: aws.String("arn:aws:iam::123456789012:role/example"),
+		},
+	}
+
+	got = flattenQuickSightDataSourceParameters(s3Params)
+	if len(got) != 1 {
+		t.Fatalf("expected a single block, got: %d", len(got))
+	}
+	m = got[0].(map[string]interface{})
+	if _, ok := m["s3"]; !ok {
+		t.Fatal("expected \"s3\" key to be set")
+	}
+	if _, ok := m["oracle"]; ok {
+		t.Fatal("expected \"oracle\" key to be absent from an s3-only result")
+	}
+}
+
+// TestAccAWSQuickSightDataSource_noDiffAfterApply locks in that Read sets
+// "name" from the describe response: "name" is Required, so leaving it
+// unset would show a perpetual diff on every refresh. The second, identical
+// step here fails if a refresh ever produces a non-empty plan.
+func TestAccAWSQuickSightDataSource_noDiffAfterApply(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				Config:   testAccAWSQuickSightDataSourceConfig(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// TestFlattenQuickSightDataSourceParameters_unknownConnector confirms that
+// an unrecognized connector type flattens to an empty block, which is the
+// signal resourceAwsQuickSightDataSourceRead uses to refuse to write
+// "parameters" to state and instead return a clear "upgrade the provider"
+// error instead of a destructive plan.
+func TestFlattenQuickSightDataSourceParameters_unknownConnector(t *testing.T) {
+	got := flattenQuickSightDataSourceParameters(&quicksight.DataSourceParameters{})
+	if len(got) != 1 {
+		t.Fatalf("expected a single (empty) block, got: %d", len(got))
+	}
+	if m := got[0].(map[string]interface{}); len(m) != 0 {
+		t.Fatalf("expected an empty block for an unrecognized connector, got: %#v", m)
+	}
+}
+
+// TestWaitForQuickSightDataSourceCreation_pendingStatuses drives
+// waitForQuickSightDataSourceCreation's StateChangeConf through a mocked
+// sequence of statuses ("", then PENDING, then CREATION_IN_PROGRESS, then
+// CREATION_SUCCESSFUL) via a fake refresh func, confirming none of the
+// non-terminal statuses are mistaken for the target or for an unexpected
+// state.
+func TestWaitForQuickSightDataSourceCreation_pendingStatuses(t *testing.T) {
+	statuses := []string{"", "PENDING", quicksight.ResourceStatusCreationInProgress, quicksight.ResourceStatusCreationSuccessful}
+	calls := 0
+
+	stateConf := &resource.StateChangeConf{
+		Pending: quickSightDataSourceCreationPendingStatuses(),
+		Target: []string{
+			quicksight.ResourceStatusCreationSuccessful,
+		},
+		Refresh: func() (interface{}, string, error) {
+			status := statuses[calls]
+			if calls < len(statuses)-1 {
+				calls++
+			}
+			return struct{}{}, status, nil
+		},
+		Timeout:    time.Minute,
+		Delay:      0,
+		MinTimeout: time.Millisecond,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if calls != len(statuses)-1 {
+		t.Fatalf("expected the refresh func to be called through every status, stopped at call %d", calls)
+	}
+}
+
+// TestAccAWSQuickSightDataSource_tagDrift confirms that a tag added
+// out-of-band is detected as drift: Read already lists tags via
+// ListTagsForResource and sets them into state, so a tag Terraform doesn't
+// know about shows up as something the next plan wants to remove.
+func TestAccAWSQuickSightDataSource_tagDrift(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource),
+					testAccCheckAwsQuickSightDataSourceAddTagOutOfBand(&dataSource, "untracked", "drift"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsQuickSightDataSourceAddTagOutOfBand(dataSource *quicksight.DataSource, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		_, err := conn.TagResource(&quicksight.TagResourceInput{
+			ResourceArn: dataSource.Arn,
+			Tags: []*quicksight.Tag{
+				{
+					Key:   aws.String(key),
+					Value: aws.String(value),
+				},
+			},
+		})
+		return err
+	}
+}
+
+// TestAccAWSQuickSightDataSource_vpcConnectionProperties confirms that
+// "vpc_connection_properties" round-trips through create and refresh:
+// resourceAwsQuickSightDataSourceRead already sets it from the describe
+// response on every Read.
+func TestAccAWSQuickSightDataSource_vpcConnectionProperties(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	vpcConnectionArn := fmt.Sprintf("arn:%s:quicksight:%s:%s:vpcConnection/%s", testAccGetPartition(), testAccGetRegion(), "123456789012", rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceVpcConnectionPropertiesConfig(rName, vpcConnectionArn),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "vpc_connection_properties.0.vpc_connection_arn", vpcConnectionArn),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSQuickSightDataSourceVpcConnectionPropertiesConfig(rName, vpcConnectionArn string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name            = %[1]q
+  type            = "ATHENA"
+
+  parameters {
+    athena {
+      work_group = "primary"
+    }
+  }
+
+  vpc_connection_properties {
+    vpc_connection_arn = %[2]q
+  }
+}
+`, rName, vpcConnectionArn)
+}
+
+// TestFlattenQuickSightDataSourceErrorInfo confirms error_info flattens to
+// an empty list when there's no error, and to a single populated block
+// otherwise, matching the shape resourceAwsQuickSightDataSourceRead expects
+// when deciding whether a CREATION_FAILED data source needs re-creating.
+func TestFlattenQuickSightDataSourceErrorInfo(t *testing.T) {
+	if got := flattenQuickSightDataSourceErrorInfo(nil); len(got) != 0 {
+		t.Fatalf("expected an empty list for a nil error, got: %#v", got)
+	}
+
+	errorInfo := &quicksight.DataSourceErrorInfo{
+		Type:    aws.String(quicksight.DataSourceErrorInfoTypeAccessDenied),
+		Message: aws.String("access denied"),
+	}
+
+	got := flattenQuickSightDataSourceErrorInfo(errorInfo)
+	if len(got) != 1 {
+		t.Fatalf("expected a single block, got: %d", len(got))
+	}
+	m := got[0].(map[string]interface{})
+	if m["type"] != quicksight.DataSourceErrorInfoTypeAccessDenied {
+		t.Fatalf("expected type to be %q, got: %q", quicksight.DataSourceErrorInfoTypeAccessDenied, m["type"])
+	}
+	if m["message"] != "access denied" {
+		t.Fatalf("expected message to be %q, got: %q", "access denied", m["message"])
+	}
+}
+
+// TestAccAWSQuickSightDataSource_creationFailedSelfHeals confirms that a
+// data source stuck in CREATION_FAILED (here, simulated with deliberately
+// invalid connector parameters) forces a replacement on the next plan
+// instead of repeatedly failing. CREATION_FAILED is reached asynchronously
+// after CreateDataSource returns, so the check below polls for it instead
+// of asserting it immediately: asserting immediately raced the backend and
+// intermittently saw CREATION_IN_PROGRESS.
+func TestAccAWSQuickSightDataSource_creationFailedSelfHeals(t *testing.T) {
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceCreationFailedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceReachesStatus(resourceName, quicksight.ResourceStatusCreationFailed),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccCheckAwsQuickSightDataSourceReachesStatus polls DescribeDataSource
+// directly rather than relying on a single post-apply refresh, since status
+// transitions (e.g. CREATION_IN_PROGRESS -> CREATION_FAILED) happen
+// asynchronously on Quick Sight's backend after CreateDataSource returns.
+func testAccCheckAwsQuickSightDataSourceReachesStatus(resourceName, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		awsAccountID, dataSourceID, err := resourceAwsQuickSightDataSourceParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		return resource.Retry(5*time.Minute, func() *resource.RetryError {
+			resp, err := conn.DescribeDataSource(&quicksight.DescribeDataSourceInput{
+				AwsAccountId: aws.String(awsAccountID),
+				DataSourceId: aws.String(dataSourceID),
+			})
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			if got := aws.StringValue(resp.DataSource.Status); got != status {
+				return resource.RetryableError(fmt.Errorf("Quick Sight Data Source (%s) has status %s, want %s", rs.Primary.ID, got, status))
+			}
+
+			return nil
+		})
+	}
+}
+
+func testAccAWSQuickSightDataSourceCreationFailedConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id  = %[1]q
+  name            = %[1]q
+  type            = "ATHENA"
+  test_connection = false
+
+  parameters {
+    athena {
+      work_group = "does-not-exist"
+    }
+  }
+}
+`, rName)
+}
+
+func TestExpandQuickSightDataSourceCredentials_secretArn(t *testing.T) {
+	secretArn := "arn:aws:secretsmanager:us-east-1:123456789012:secret:test"
+	credentials := []interface{}{
+		map[string]interface{}{
+			"secret_arn":      secretArn,
+			"credential_pair": []interface{}{},
+		},
+	}
+
+	creds, err := expandQuickSightDataSourceCredentials(credentials)
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if creds == nil || creds.SecretArn == nil || *creds.SecretArn != secretArn {
+		t.Fatalf("expected SecretArn to be set to %q, got: %#v", secretArn, creds)
+	}
+}
+
+func TestExpandQuickSightDataSourceCredentials_mutuallyExclusive(t *testing.T) {
+	credentials := []interface{}{
+		map[string]interface{}{
+			"secret_arn":      "arn:aws:secretsmanager:us-east-1:123456789012:secret:test",
+			"copy_source_arn": "arn:aws:quicksight:us-east-1:123456789012:datasource/other",
+			"credential_pair": []interface{}{},
+		},
+	}
+
+	creds, err := expandQuickSightDataSourceCredentials(credentials)
+	if err == nil {
+		t.Fatal("expected an error when more than one credentials field is set, got none")
+	}
+	if creds != nil {
+		t.Fatalf("expected nil credentials on error, got: %#v", creds)
+	}
+}
+
+func TestAccAWSQuickSightDataSource_basic(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", quicksight.DataSourceTypeAthena),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestAccAWSQuickSightDataSource_athenaRoleArn confirms parameters.0.athena.0.role_arn
+// round-trips through Create/Read, since Athena is the one connector where Quick
+// Sight assumes a role instead of using credentials.
+func TestAccAWSQuickSightDataSource_athenaRoleArn(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	iamRoleResourceName := "aws_iam_role.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceAthenaRoleArnConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttrPair(resourceName, "parameters.0.athena.0.role_arn", iamRoleResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestAccAWSQuickSightDataSource_alternateRegion confirms a data source
+// created via an aliased provider (this resource has no "region" argument of
+// its own) is described from, and tagged with an ARN in, that provider's
+// region rather than the default provider's.
+func TestAccAWSQuickSightDataSource_alternateRegion(t *testing.T) {
+	var providers []*schema.Provider
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionsPreCheck(t)
+			testAccAlternateRegionPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckAwsQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceAlternateRegionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(fmt.Sprintf(":%s:", testAccGetAlternateRegion()))),
+				),
+			},
+		},
+	})
+}
+
+// TestAccAWSQuickSightDataSource_tags asserts that tags set in config are
+// present immediately after the initial create, i.e. that Create sends them
+// on CreateDataSourceInput.Tags itself rather than tagging in a separate
+// follow-up call that would leave a window where the resource is untagged.
+func TestAccAWSQuickSightDataSource_tags(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceTagsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "Value1"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccAWSQuickSightDataSource_presto, _spark, _twitter, _oracle,
+// _databricks, _starburst, _trino, _amazonOpenSearch, _s3, and
+// _redshift round out connector coverage for every type this provider
+// currently implements. PostgreSQL/Snowflake are not yet supported by
+// aws_quicksight_data_source ("type" is no longer restricted to a fixed list
+// of connectors, see quicksightDataSourceDefaultPortPresto and friends, but
+// "parameters" still only has typed blocks for the connectors below), so
+// there's nothing to add test cases for until those connectors get a typed
+// block too.
+
+func TestAccAWSQuickSightDataSource_presto(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourcePrestoConfig(rName, "presto.example.com", 8889),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "type", quicksight.DataSourceTypePresto),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.presto.0.host", "presto.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.presto.0.port", "8889"),
+				),
+			},
+			{
+				Config: testAccAWSQuickSightDataSourcePrestoConfig(rName, "presto.example.com", 8899),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.presto.0.port", "8899"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSource_prestoHostNormalization(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourcePrestoConfig(rName, "https://presto.example.com/", 8889),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.presto.0.host", "presto.example.com"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccAWSQuickSightDataSource_sslProperties confirms that flipping
+// "disable_ssl" goes through UpdateDataSource in place: nothing in
+// "ssl_properties" is ForceNew, so toggling it should never recreate the
+// data source.
+func TestAccAWSQuickSightDataSource_sslProperties(t *testing.T) {
+	var dataSource1, dataSource2 quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceSslPropertiesConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource1),
+					resource.TestCheckResourceAttr(resourceName, "ssl_properties.0.disable_ssl", "false"),
+				),
+			},
+			{
+				Config: testAccAWSQuickSightDataSourceSslPropertiesConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource2),
+					testAccCheckAwsQuickSightDataSourceNotRecreated(&dataSource1, &dataSource2),
+					resource.TestCheckResourceAttr(resourceName, "ssl_properties.0.disable_ssl", "true"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccAWSQuickSightDataSource_sslPropertiesUnrelatedUpdate confirms that
+// an unrelated update (here, a tag change) doesn't re-send "ssl_properties"
+// in the UpdateDataSource call: it's gated behind d.HasChange("ssl_properties")
+// so an unrelated update can't unexpectedly reset it.
+func TestAccAWSQuickSightDataSource_sslPropertiesUnrelatedUpdate(t *testing.T) {
+	var dataSource1, dataSource2 quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceSslPropertiesTaggedConfig(rName, true, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource1),
+					resource.TestCheckResourceAttr(resourceName, "ssl_properties.0.disable_ssl", "true"),
+				),
+			},
+			{
+				Config: testAccAWSQuickSightDataSourceSslPropertiesTaggedConfig(rName, true, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource2),
+					testAccCheckAwsQuickSightDataSourceNotRecreated(&dataSource1, &dataSource2),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value2"),
+					resource.TestCheckResourceAttr(resourceName, "ssl_properties.0.disable_ssl", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSQuickSightDataSourceSslPropertiesTaggedConfig(rName string, disableSsl bool, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name            = %[1]q
+  type            = "ATHENA"
+
+  parameters {
+    athena {
+      work_group = "primary"
+    }
+  }
+
+  ssl_properties {
+    disable_ssl = %[2]t
+  }
+
+  tags = {
+    key1 = %[3]q
+  }
+}
+`, rName, disableSsl, tagValue)
+}
+
+func TestAccAWSQuickSightDataSource_prestoCatalogOmitted(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourcePrestoCatalogOmittedConfig(rName, "presto.example.com", 8889),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.presto.0.catalog", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSource_spark(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceSparkConfig(rName, "spark.example.com", 999),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "type", quicksight.DataSourceTypeSpark),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.spark.0.host", "spark.example.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSource_twitter(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceTwitterConfig(rName, "#terraform", 1000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "type", quicksight.DataSourceTypeTwitter),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.twitter.0.query", "#terraform"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.twitter.0.max_rows", "1000"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSource_oracle(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceOracleConfig(rName, "oracle.example.com", "ORCL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "type", quicksight.DataSourceTypeOracle),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.oracle.0.host", "oracle.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.oracle.0.database", "ORCL"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.oracle.0.port", "1521"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSource_databricks(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceDatabricksConfig(rName, "databricks.example.com", "/sql/1.0/endpoints/abc123"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "type", quicksight.DataSourceTypeDatabricks),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.databricks.0.host", "databricks.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.databricks.0.port", "443"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.databricks.0.sql_endpoint_path", "/sql/1.0/endpoints/abc123"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSource_starburst(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceStarburstConfig(rName, "starburst.example.com", "hive"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "type", "STARBURST"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.starburst.0.host", "starburst.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.starburst.0.catalog", "hive"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.starburst.0.product_type", quicksight.StarburstProductTypeGalaxy),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSource_trinoCatalogOmitted(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceTrinoCatalogOmittedConfig(rName, "trino.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.trino.0.catalog", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSource_trino(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceTrinoConfig(rName, "trino.example.com", "hive"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "type", "TRINO"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.trino.0.host", "trino.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.trino.0.catalog", "hive"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestAccAWSQuickSightDataSource_amazonOpenSearch also exercises the
+// deprecated amazon_elasticsearch alias, since both map into the read path's
+// "populate whichever the API returns" logic.
+func TestAccAWSQuickSightDataSource_amazonOpenSearch(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceAmazonOpenSearchConfig(rName, "my-domain"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "type", quicksight.DataSourceTypeAmazonOpensearch),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.amazon_opensearch.0.domain", "my-domain"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSource_s3(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	iamRoleResourceName := "aws_iam_role.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceS3Config(rName, "my-bucket", "manifest.json"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "type", quicksight.DataSourceTypeS3),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.s3.0.manifest_file_location.0.bucket", "my-bucket"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.s3.0.manifest_file_location.0.key", "manifest.json"),
+					resource.TestCheckResourceAttrPair(resourceName, "parameters.0.s3.0.role_arn", iamRoleResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSource_redshift(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	iamRoleResourceName := "aws_iam_role.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceRedshiftConfig(rName, "my-database", "quicksight_user"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "type", quicksight.DataSourceTypeRedshift),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.redshift.0.database", "my-database"),
+					resource.TestCheckResourceAttrPair(resourceName, "parameters.0.redshift.0.iam_parameters.0.role_arn", iamRoleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.redshift.0.iam_parameters.0.database_user", "quicksight_user"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsQuickSightDataSourceNotRecreated(before, after *quicksight.DataSource) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.TimeValue(before.CreatedTime) != aws.TimeValue(after.CreatedTime) {
+			return fmt.Errorf("Quick Sight Data Source was recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsQuickSightDataSourceExists(resourceName string, dataSource *quicksight.DataSource) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		awsAccountID, dataSourceID, err := resourceAwsQuickSightDataSourceParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		output, err := conn.DescribeDataSource(&quicksight.DescribeDataSourceInput{
+			AwsAccountId: aws.String(awsAccountID),
+			DataSourceId: aws.String(dataSourceID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.DataSource == nil {
+			return fmt.Errorf("Quick Sight Data Source (%s) not found", rs.Primary.ID)
+		}
+
+		*dataSource = *output.DataSource
+
+		return nil
+	}
+}
+
+func testAccCheckAwsQuickSightDataSourceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_data_source" {
+			continue
+		}
+
+		awsAccountID, dataSourceID, err := resourceAwsQuickSightDataSourceParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DescribeDataSource(&quicksight.DescribeDataSourceInput{
+			AwsAccountId: aws.String(awsAccountID),
+			DataSourceId: aws.String(dataSourceID),
+		})
+		if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Quick Sight Data Source '%s' was not deleted properly", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSQuickSightDataSourceAlternateRegionConfig(rName string) string {
+	return testAccAlternateRegionProviderConfig() + fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  provider = "aws.alternate"
+
+  data_source_id = %[1]q
+  name            = %[1]q
+  type            = "ATHENA"
+
+  parameters {
+    athena {
+      work_group = "primary"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSQuickSightDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name            = %[1]q
+  type            = "ATHENA"
+
+  parameters {
+    athena {
+      work_group = "primary"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSQuickSightDataSourceAthenaRoleArnConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "quicksight.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name            = %[1]q
+  type            = "ATHENA"
+
+  parameters {
+    athena {
+      work_group = "primary"
+      role_arn   = aws_iam_role.test.arn
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSQuickSightDataSourceTagsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name            = %[1]q
+  type            = "ATHENA"
+
+  parameters {
+    athena {
+      work_group = "primary"
+    }
+  }
+
+  tags = {
+    Key1 = "Value1"
+  }
+}
+`, rName)
+}
+
+func testAccAWSQuickSightDataSourceSslPropertiesConfig(rName string, disableSsl bool) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name            = %[1]q
+  type            = "ATHENA"
+
+  parameters {
+    athena {
+      work_group = "primary"
+    }
+  }
+
+  ssl_properties {
+    disable_ssl = %[2]t
+  }
+}
+`, rName, disableSsl)
+}
+
+func testAccAWSQuickSightDataSourcePrestoCatalogOmittedConfig(rName, host string, port int) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name            = %[1]q
+  type            = "PRESTO"
+
+  parameters {
+    presto {
+      host = %[2]q
+      port = %[3]d
+    }
+  }
+}
+`, rName, host, port)
+}
+
+func testAccAWSQuickSightDataSourcePrestoConfig(rName, host string, port int) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name            = %[1]q
+  type            = "PRESTO"
+
+  parameters {
+    presto {
+      host    = %[2]q
+      port    = %[3]d
+      catalog = "hive"
+    }
+  }
+}
+`, rName, host, port)
+}
+
+func testAccAWSQuickSightDataSourceSparkConfig(rName, host string, port int) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name            = %[1]q
+  type            = "SPARK"
+
+  parameters {
+    spark {
+      host = %[2]q
+      port = %[3]d
+    }
+  }
+}
+`, rName, host, port)
+}
+
+func testAccAWSQuickSightDataSourceTwitterConfig(rName, query string, maxRows int) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name            = %[1]q
+  type            = "TWITTER"
+
+  parameters {
+    twitter {
+      query    = %[2]q
+      max_rows = %[3]d
+    }
+  }
+}
+`, rName, query, maxRows)
+}
+
+func testAccAWSQuickSightDataSourceOracleConfig(rName, host, database string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name            = %[1]q
+  type            = "ORACLE"
+
+  parameters {
+    oracle {
+      host     = %[2]q
+      database = %[3]q
+    }
+  }
+}
+`, rName, host, database)
+}
+
+func testAccAWSQuickSightDataSourceDatabricksConfig(rName, host, sqlEndpointPath string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name            = %[1]q
+  type            = "DATABRICKS"
+
+  parameters {
+    databricks {
+      host              = %[2]q
+      port              = 443
+      sql_endpoint_path = %[3]q
+    }
+  }
+}
+`, rName, host, sqlEndpointPath)
+}
+
+func testAccAWSQuickSightDataSourceStarburstConfig(rName, host, catalog string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name            = %[1]q
+  type            = "STARBURST"
+
+  parameters {
+    starburst {
+      host         = %[2]q
+      port         = 443
+      catalog      = %[3]q
+      product_type = "GALAXY"
+    }
+  }
+}
+`, rName, host, catalog)
+}
+
+func testAccAWSQuickSightDataSourceAmazonOpenSearchConfig(rName, domain string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name            = %[1]q
+  type            = "AMAZON_OPENSEARCH"
+
+  parameters {
+    amazon_opensearch {
+      domain = %[2]q
+    }
+  }
+}
+`, rName, domain)
+}
+
+func testAccAWSQuickSightDataSourceS3Config(rName, bucket, key string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "quicksight.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name            = %[1]q
+  type            = "S3"
+
+  parameters {
+    s3 {
+      manifest_file_location {
+        bucket = %[2]q
+        key    = %[3]q
+      }
+      role_arn = aws_iam_role.test.arn
+    }
+  }
+}
+`, rName, bucket, key)
+}
+
+func testAccAWSQuickSightDataSourceRedshiftConfig(rName, database, databaseUser string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "quicksight.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name            = %[1]q
+  type            = "REDSHIFT"
+
+  parameters {
+    redshift {
+      database = %[2]q
+
+      iam_parameters {
+        role_arn      = aws_iam_role.test.arn
+        database_user = %[3]q
+      }
+    }
+  }
+}
+`, rName, database, databaseUser)
+}
+
+func testAccAWSQuickSightDataSourceTrinoCatalogOmittedConfig(rName, host string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name            = %[1]q
+  type            = "TRINO"
+
+  parameters {
+    trino {
+      host = %[2]q
+      port = 443
+    }
+  }
+}
+`, rName, host)
+}
+
+func testAccAWSQuickSightDataSourceTrinoConfig(rName, host, catalog string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name            = %[1]q
+  type            = "TRINO"
+
+  parameters {
+    trino {
+      host    = %[2]q
+      port    = 443
+      catalog = %[3]q
+    }
+  }
+}
+`, rName, host, catalog)
+}
@@ -0,0 +1,255 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func resourceAwsQuickSightFolderPermission() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQuickSightFolderPermissionCreate,
+		Read:   resourceAwsQuickSightFolderPermissionRead,
+		Update: resourceAwsQuickSightFolderPermissionUpdate,
+		Delete: resourceAwsQuickSightFolderPermissionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsQuickSightFolderPermissionCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"folder_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"principal": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"actions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(quicksightFolderActions, false),
+				},
+			},
+		},
+	}
+}
+
+// resourceAwsQuickSightFolderPermissionCustomizeDiff catches, at plan time, a
+// second aws_quicksight_folder_permission resource targeting a principal that
+// already has permissions granted on the folder. The UpdateFolderPermissions
+// API merges grants for a principal across calls, so two Terraform resources
+// racing to "own" the same principal silently clobber one another on apply.
+func resourceAwsQuickSightFolderPermissionCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	return quickSightCheckDuplicateFolderPrincipal(conn, awsAccountID, d.Get("folder_id").(string), d.Get("principal").(string))
+}
+
+// quickSightCheckDuplicateFolderPrincipal holds the network-calling half of
+// resourceAwsQuickSightFolderPermissionCustomizeDiff, pulled out so it can be
+// unit tested against a fake quicksightconniface instead of only through
+// TF_ACC.
+func quickSightCheckDuplicateFolderPrincipal(conn quicksightconniface, awsAccountID, folderID, principal string) error {
+	resp, err := conn.DescribeFolderPermissions(&quicksight.DescribeFolderPermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+	})
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return nil
+	}
+
+	if quickSightPermissionHasPrincipal(resp.Permissions, principal) {
+		return fmt.Errorf("principal %s already has permissions granted on Quick Sight Folder %s; import the existing aws_quicksight_folder_permission resource instead of creating a duplicate", principal, folderID)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightFolderPermissionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	folderID := d.Get("folder_id").(string)
+	principal := d.Get("principal").(string)
+
+	_, err := conn.UpdateFolderPermissions(&quicksight.UpdateFolderPermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+		GrantPermissions: []*quicksight.ResourcePermission{
+			{
+				Principal: aws.String(principal),
+				Actions:   expandStringSet(d.Get("actions").(*schema.Set)),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error granting Quick Sight Folder (%s) permissions to %s: %s", folderID, principal, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", awsAccountID, folderID, principal))
+
+	return resourceAwsQuickSightFolderPermissionRead(d, meta)
+}
+
+func resourceAwsQuickSightFolderPermissionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, folderID, principal, err := resourceAwsQuickSightFolderPermissionParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeFolderPermissions(&quicksight.DescribeFolderPermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+	})
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Quick Sight Folder %s is already gone", folderID)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error describing Quick Sight Folder (%s) permissions: %s", folderID, err)
+	}
+
+	var actions []*string
+	found := false
+	for _, perm := range resp.Permissions {
+		if aws.StringValue(perm.Principal) == principal {
+			actions = perm.Actions
+			found = true
+			break
+		}
+	}
+	if !found {
+		log.Printf("[WARN] Quick Sight Folder (%s) permission for %s is already gone", folderID, principal)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("folder_id", folderID)
+	d.Set("principal", principal)
+	d.Set("actions", flattenStringSet(actions))
+
+	return nil
+}
+
+func resourceAwsQuickSightFolderPermissionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, folderID, principal, err := resourceAwsQuickSightFolderPermissionParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	o, n := d.GetChange("actions")
+	oldActions := o.(*schema.Set)
+	newActions := n.(*schema.Set)
+
+	input := &quicksight.UpdateFolderPermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+	}
+
+	if toGrant := newActions.Difference(oldActions); toGrant.Len() > 0 {
+		input.GrantPermissions = []*quicksight.ResourcePermission{
+			{
+				Principal: aws.String(principal),
+				Actions:   expandStringSet(toGrant),
+			},
+		}
+	}
+
+	if toRevoke := oldActions.Difference(newActions); toRevoke.Len() > 0 {
+		input.RevokePermissions = []*quicksight.ResourcePermission{
+			{
+				Principal: aws.String(principal),
+				Actions:   expandStringSet(toRevoke),
+			},
+		}
+	}
+
+	if input.GrantPermissions != nil || input.RevokePermissions != nil {
+		if _, err := conn.UpdateFolderPermissions(input); err != nil {
+			return fmt.Errorf("error updating Quick Sight Folder (%s) permissions for %s: %s", folderID, principal, err)
+		}
+	}
+
+	return resourceAwsQuickSightFolderPermissionRead(d, meta)
+}
+
+func resourceAwsQuickSightFolderPermissionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, folderID, principal, err := resourceAwsQuickSightFolderPermissionParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.UpdateFolderPermissions(&quicksight.UpdateFolderPermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+		RevokePermissions: []*quicksight.ResourcePermission{
+			{
+				Principal: aws.String(principal),
+				Actions:   expandStringSet(d.Get("actions").(*schema.Set)),
+			},
+		},
+	})
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error revoking Quick Sight Folder (%s) permissions for %s: %s", folderID, principal, err)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightFolderPermissionParseID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) < 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/FOLDER_ID/PRINCIPAL_ARN", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
@@ -0,0 +1,69 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func TestQuickSightCheckDuplicateFolderPrincipal(t *testing.T) {
+	const (
+		awsAccountID = "123456789012"
+		folderID     = "test-folder"
+		principal    = "arn:aws:iam::123456789012:user/test"
+	)
+
+	testCases := []struct {
+		name        string
+		permissions []*quicksight.ResourcePermission
+		describeErr error
+		wantErr     bool
+	}{
+		{
+			name: "principal already granted",
+			permissions: []*quicksight.ResourcePermission{
+				{Principal: aws.String(principal)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "principal not yet granted",
+			permissions: []*quicksight.ResourcePermission{
+				{Principal: aws.String("arn:aws:iam::123456789012:user/other")},
+			},
+			wantErr: false,
+		},
+		{
+			name:        "folder not found",
+			describeErr: awserr.New(quicksight.ErrCodeResourceNotFoundException, "not found", nil),
+			wantErr:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			conn := &fakeQuicksightconn{
+				describeFolderPermissions: func(input *quicksight.DescribeFolderPermissionsInput) (*quicksight.DescribeFolderPermissionsOutput, error) {
+					if aws.StringValue(input.AwsAccountId) != awsAccountID || aws.StringValue(input.FolderId) != folderID {
+						t.Errorf("DescribeFolderPermissions called with unexpected input: %+v", input)
+					}
+					if tc.describeErr != nil {
+						return nil, tc.describeErr
+					}
+					return &quicksight.DescribeFolderPermissionsOutput{Permissions: tc.permissions}, nil
+				},
+			}
+
+			err := quickSightCheckDuplicateFolderPrincipal(conn, awsAccountID, folderID, principal)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("quickSightCheckDuplicateFolderPrincipal() error = %v, wantErr %t", err, tc.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), folderID) {
+				t.Errorf("error %q does not mention folder ID %q", err, folderID)
+			}
+		})
+	}
+}
@@ -56,6 +56,29 @@ func resourceAwsQuickSightGroup() *schema.Resource {
 					"default",
 				}, false),
 			},
+
+			// DescribeGroup doesn't return creation/update timestamps, so there's
+			// no created_time/last_updated_time to expose here. principal_id is
+			// the closest thing: the stable identifier Quick Sight uses to
+			// reference this group elsewhere (e.g. in a permission policy), which
+			// otherwise has to be derived by hand from "arn".
+			"principal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// Opt-in: left unset, this never calls CreateGroupMembership/
+			// DeleteGroupMembership and membership can be managed separately
+			// (e.g. with aws_quicksight_group_membership). Set it and Terraform
+			// reconciles the group's full membership to match on every apply,
+			// which conflicts with managing the same group's membership any
+			// other way.
+			"members": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 		},
 	}
 }
@@ -87,6 +110,12 @@ func resourceAwsQuickSightGroupCreate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", awsAccountID, namespace, aws.StringValue(resp.Group.GroupName)))
 
+	if v, ok := d.GetOk("members"); ok {
+		if err := quickSightAddGroupMembers(conn, awsAccountID, namespace, d.Get("group_name").(string), expandStringSet(v.(*schema.Set))); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsQuickSightGroupRead(d, meta)
 }
 
@@ -119,6 +148,21 @@ func resourceAwsQuickSightGroupRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("group_name", resp.Group.GroupName)
 	d.Set("description", resp.Group.Description)
 	d.Set("namespace", namespace)
+	d.Set("principal_id", resp.Group.PrincipalId)
+
+	// Only refreshed when "members" is already in use: a group with
+	// membership managed elsewhere (e.g. aws_quicksight_group_membership)
+	// should never show those members as a pending removal here.
+	if _, ok := d.GetOk("members"); ok {
+		members, err := quickSightGroupMembers(conn, awsAccountID, namespace, groupName)
+		if err != nil {
+			return fmt.Errorf("error listing Quick Sight Group (%s) memberships: %s", d.Id(), err)
+		}
+
+		if err := d.Set("members", members); err != nil {
+			return fmt.Errorf("error setting members: %s", err)
+		}
+	}
 
 	return nil
 }
@@ -151,6 +195,20 @@ func resourceAwsQuickSightGroupUpdate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error updating Quick Sight Group %s: %s", d.Id(), err)
 	}
 
+	if d.HasChange("members") {
+		o, n := d.GetChange("members")
+		remove := expandStringSet(o.(*schema.Set).Difference(n.(*schema.Set)))
+		add := expandStringSet(n.(*schema.Set).Difference(o.(*schema.Set)))
+
+		if err := quickSightRemoveGroupMembers(conn, awsAccountID, namespace, groupName, remove); err != nil {
+			return err
+		}
+
+		if err := quickSightAddGroupMembers(conn, awsAccountID, namespace, groupName, add); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsQuickSightGroupRead(d, meta)
 }
 
@@ -178,6 +236,66 @@ func resourceAwsQuickSightGroupDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+// quickSightGroupMembers lists every member of a group, handling pagination
+// the same way as the *Permissions helpers elsewhere in this package.
+func quickSightGroupMembers(conn quicksightconniface, awsAccountID, namespace, groupName string) ([]*string, error) {
+	var members []*string
+
+	input := &quicksight.ListGroupMembershipsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		Namespace:    aws.String(namespace),
+		GroupName:    aws.String(groupName),
+	}
+
+	for {
+		resp, err := conn.ListGroupMemberships(input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, member := range resp.GroupMemberList {
+			members = append(members, member.MemberName)
+		}
+
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+
+	return members, nil
+}
+
+func quickSightAddGroupMembers(conn quicksightconniface, awsAccountID, namespace, groupName string, memberNames []*string) error {
+	for _, memberName := range memberNames {
+		if _, err := conn.CreateGroupMembership(&quicksight.CreateGroupMembershipInput{
+			AwsAccountId: aws.String(awsAccountID),
+			Namespace:    aws.String(namespace),
+			GroupName:    aws.String(groupName),
+			MemberName:   memberName,
+		}); err != nil {
+			return fmt.Errorf("error adding %q to Quick Sight Group (%s/%s): %s", aws.StringValue(memberName), namespace, groupName, err)
+		}
+	}
+
+	return nil
+}
+
+func quickSightRemoveGroupMembers(conn quicksightconniface, awsAccountID, namespace, groupName string, memberNames []*string) error {
+	for _, memberName := range memberNames {
+		if _, err := conn.DeleteGroupMembership(&quicksight.DeleteGroupMembershipInput{
+			AwsAccountId: aws.String(awsAccountID),
+			Namespace:    aws.String(namespace),
+			GroupName:    aws.String(groupName),
+			MemberName:   memberName,
+		}); err != nil && !isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+			return fmt.Errorf("error removing %q from Quick Sight Group (%s/%s): %s", aws.StringValue(memberName), namespace, groupName, err)
+		}
+	}
+
+	return nil
+}
+
 func resourceAwsQuickSightGroupParseID(id string) (string, string, string, error) {
 	parts := strings.SplitN(id, "/", 3)
 	if len(parts) < 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
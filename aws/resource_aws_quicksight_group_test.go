@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"os"
 	"strings"
 	"testing"
 
@@ -31,6 +32,7 @@ func TestAccAWSQuickSightGroup_basic(t *testing.T) {
 					testAccCheckQuickSightGroupExists(resourceName, &group),
 					resource.TestCheckResourceAttr(resourceName, "group_name", rName1),
 					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "quicksight", fmt.Sprintf("group/default/%s", rName1)),
+					resource.TestCheckResourceAttrSet(resourceName, "principal_id"),
 				),
 			},
 			{
@@ -105,6 +107,49 @@ func TestAccAWSQuickSightGroup_disappears(t *testing.T) {
 	})
 }
 
+// TestAccAWSQuickSightGroup_members requires a QuickSight user to already
+// exist in the account (this provider has no resource to create one), named
+// by the QUICKSIGHT_USER_NAME environment variable.
+func TestAccAWSQuickSightGroup_members(t *testing.T) {
+	memberName := testAccAwsQuickSightUserNameFromEnv(t)
+
+	resourceName := "aws_quicksight_group.default"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckQuickSightGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightGroupConfigWithMembers(rName, memberName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "members.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSQuickSightGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "members.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsQuickSightUserNameFromEnv(t *testing.T) string {
+	userName := os.Getenv("QUICKSIGHT_USER_NAME")
+
+	if userName == "" {
+		t.Skip(
+			"Environment variable QUICKSIGHT_USER_NAME is not set. This must " +
+				"name a QuickSight user that already exists in the test account, " +
+				"since this provider has no resource to create one.")
+	}
+
+	return userName
+}
+
 func testAccCheckQuickSightGroupExists(resourceName string, group *quicksight.Group) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -205,6 +250,15 @@ resource "aws_quicksight_group" "default" {
 `, rName)
 }
 
+func testAccAWSQuickSightGroupConfigWithMembers(rName, memberName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_group" "default" {
+  group_name = %[1]q
+  members    = [%[2]q]
+}
+`, rName, memberName)
+}
+
 func testAccAWSQuickSightGroupConfigWithDescription(rName, description string) string {
 	return fmt.Sprintf(`
 data "aws_caller_identity" "current" {}
@@ -0,0 +1,295 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func resourceAwsQuickSightNamespace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQuickSightNamespaceCreate,
+		Read:   resourceAwsQuickSightNamespaceRead,
+		Delete: resourceAwsQuickSightNamespaceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"identity_store": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  quicksight.IdentityStoreQuicksight,
+			},
+
+			"capacity_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creation_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// Populated only when creation_status is CREATION_FAILED, so
+			// users can diagnose a stuck/failed namespace from Terraform
+			// output instead of having to go look it up in the console.
+			"namespace_error": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			// Group names to seed in the namespace at creation time, so the
+			// namespace is usable for permission grants as soon as it exists
+			// instead of requiring a follow-up aws_quicksight_group apply.
+			// CreateNamespace itself has no notion of groups, so this is a
+			// convenience that calls CreateGroup once per name after the
+			// namespace exists; it deliberately overlaps with but doesn't
+			// replace the standalone aws_quicksight_group resource.
+			"group_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsQuickSightNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	namespace := d.Get("namespace").(string)
+
+	createOpts := &quicksight.CreateNamespaceInput{
+		AwsAccountId:  aws.String(awsAccountID),
+		Namespace:     aws.String(namespace),
+		IdentityStore: aws.String(d.Get("identity_store").(string)),
+	}
+
+	if _, err := conn.CreateNamespace(createOpts); err != nil {
+		return fmt.Errorf("error creating Quick Sight Namespace: %s", quickSightEnterpriseEditionError(err, "additional namespaces"))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", awsAccountID, namespace))
+
+	if v, ok := d.GetOk("group_names"); ok {
+		for _, groupName := range expandStringSet(v.(*schema.Set)) {
+			_, err := conn.CreateGroup(&quicksight.CreateGroupInput{
+				AwsAccountId: aws.String(awsAccountID),
+				Namespace:    aws.String(namespace),
+				GroupName:    groupName,
+			})
+			if err != nil {
+				return fmt.Errorf("error creating Quick Sight Group (%s) in Namespace (%s): %s", aws.StringValue(groupName), d.Id(), err)
+			}
+		}
+	}
+
+	return resourceAwsQuickSightNamespaceRead(d, meta)
+}
+
+func resourceAwsQuickSightNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, namespace, err := resourceAwsQuickSightNamespaceParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeNamespace(&quicksight.DescribeNamespaceInput{
+		AwsAccountId: aws.String(awsAccountID),
+		Namespace:    aws.String(namespace),
+	})
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Quick Sight Namespace (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error describing Quick Sight Namespace (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", resp.Namespace.Arn)
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("namespace", namespace)
+	d.Set("identity_store", resp.Namespace.IdentityStore)
+	d.Set("capacity_region", resp.Namespace.CapacityRegion)
+	d.Set("creation_status", resp.Namespace.CreationStatus)
+
+	if err := d.Set("namespace_error", flattenQuickSightNamespaceError(resp.Namespace.NamespaceError)); err != nil {
+		return fmt.Errorf("error setting namespace_error: %s", err)
+	}
+
+	switch aws.StringValue(resp.Namespace.CreationStatus) {
+	case quicksight.NamespaceStatusRetryableFailure, quicksight.NamespaceStatusNonRetryableFailure:
+		return fmt.Errorf("Quick Sight Namespace (%s) failed to create: %s", d.Id(), quickSightNamespaceErrorMessage(resp.Namespace.NamespaceError))
+	}
+
+	groupNames, err := quickSightNamespaceGroupNames(conn, awsAccountID, namespace)
+	if err != nil {
+		return fmt.Errorf("error listing Quick Sight Groups in Namespace (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("group_names", groupNames); err != nil {
+		return fmt.Errorf("error setting group_names: %s", err)
+	}
+
+	return nil
+}
+
+func quickSightNamespaceGroupNames(conn quicksightconniface, awsAccountID, namespace string) ([]*string, error) {
+	var groupNames []*string
+
+	input := &quicksight.ListGroupsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		Namespace:    aws.String(namespace),
+	}
+
+	err := conn.ListGroupsPages(input, func(page *quicksight.ListGroupsOutput, lastPage bool) bool {
+		for _, group := range page.GroupList {
+			groupNames = append(groupNames, group.GroupName)
+		}
+		return !lastPage
+	})
+
+	return groupNames, err
+}
+
+// flattenQuickSightNamespaceError always returns an empty list when there's
+// no error, rather than nil: namespace_error is Computed, not Optional, so
+// there's no user config to preserve by leaving it unset.
+func flattenQuickSightNamespaceError(namespaceError *quicksight.NamespaceError) []interface{} {
+	if namespaceError == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":    aws.StringValue(namespaceError.Type),
+			"message": aws.StringValue(namespaceError.Message),
+		},
+	}
+}
+
+func quickSightNamespaceErrorMessage(namespaceError *quicksight.NamespaceError) string {
+	if namespaceError == nil {
+		return "unknown error"
+	}
+
+	return fmt.Sprintf("%s: %s", aws.StringValue(namespaceError.Type), aws.StringValue(namespaceError.Message))
+}
+
+func resourceAwsQuickSightNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, namespace, err := resourceAwsQuickSightNamespaceParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.DeleteNamespace(&quicksight.DeleteNamespaceInput{
+		AwsAccountId: aws.String(awsAccountID),
+		Namespace:    aws.String(namespace),
+	})
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Quick Sight Namespace (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForQuickSightNamespaceDeletion(conn, awsAccountID, namespace, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Quick Sight Namespace (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func waitForQuickSightNamespaceDeletion(conn quicksightconniface, awsAccountID, namespace string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			quicksight.NamespaceStatusDeleting,
+		},
+		Target:  []string{},
+		Refresh: quickSightNamespaceDeleteStatusRefresh(conn, awsAccountID, namespace),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func quickSightNamespaceDeleteStatusRefresh(conn quicksightconniface, awsAccountID, namespace string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeNamespace(&quicksight.DescribeNamespaceInput{
+			AwsAccountId: aws.String(awsAccountID),
+			Namespace:    aws.String(namespace),
+		})
+		if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+			return "", "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		return resp.Namespace, aws.StringValue(resp.Namespace.CreationStatus), nil
+	}
+}
+
+func resourceAwsQuickSightNamespaceParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/NAMESPACE", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,95 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func TestAccAWSQuickSightNamespace_basic(t *testing.T) {
+	resourceName := "aws_quicksight_namespace.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckQuickSightNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightNamespaceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightNamespaceExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "capacity_region"),
+					resource.TestCheckResourceAttr(resourceName, "creation_status", quicksight.NamespaceStatusCreated),
+					resource.TestCheckResourceAttr(resourceName, "namespace_error.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckQuickSightNamespaceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		awsAccountID, namespace, err := resourceAwsQuickSightNamespaceParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		_, err = conn.DescribeNamespace(&quicksight.DescribeNamespaceInput{
+			AwsAccountId: aws.String(awsAccountID),
+			Namespace:    aws.String(namespace),
+		})
+		return err
+	}
+}
+
+func testAccCheckQuickSightNamespaceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_namespace" {
+			continue
+		}
+
+		awsAccountID, namespace, err := resourceAwsQuickSightNamespaceParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DescribeNamespace(&quicksight.DescribeNamespaceInput{
+			AwsAccountId: aws.String(awsAccountID),
+			Namespace:    aws.String(namespace),
+		})
+		if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Quick Sight Namespace (%s) was not deleted properly", namespace)
+	}
+
+	return nil
+}
+
+func testAccAWSQuickSightNamespaceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_namespace" "test" {
+  namespace = %[1]q
+}
+`, rName)
+}
@@ -0,0 +1,262 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func resourceAwsQuickSightTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQuickSightTemplateCreate,
+		Read:   resourceAwsQuickSightTemplateRead,
+		Update: resourceAwsQuickSightTemplateUpdate,
+		Delete: resourceAwsQuickSightTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsQuickSightTemplateCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"template_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// source_entity_arn may point at a template owned by a different
+			// AWS account. Quick Sight allows this as long as the source
+			// template's owner has granted this account UpdateTemplatePermissions
+			// on it; that grant lives entirely on the source side, so there's
+			// nothing to validate here beyond ARN shape.
+			"source_entity_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"version_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Exposed so teams can reference a specific published version
+			// (e.g. as a dashboard's source_entity_arn) without re-deriving
+			// it from "arn" themselves.
+			"version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchemaQuickSight(),
+		},
+	}
+}
+
+func resourceAwsQuickSightTemplateCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	return quicksightRequireVersionDescriptionOnSourceEntityChange(d)
+}
+
+func resourceAwsQuickSightTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	templateID := d.Get("template_id").(string)
+
+	createOpts := &quicksight.CreateTemplateInput{
+		AwsAccountId: aws.String(awsAccountID),
+		TemplateId:   aws.String(templateID),
+		Name:         aws.String(d.Get("name").(string)),
+		SourceEntity: &quicksight.TemplateSourceEntity{
+			SourceTemplate: &quicksight.TemplateSourceTemplate{
+				Arn: aws.String(d.Get("source_entity_arn").(string)),
+			},
+		},
+	}
+
+	if v, ok := d.GetOk("version_description"); ok {
+		createOpts.VersionDescription = aws.String(v.(string))
+	}
+
+	if v := tagsFromMapQuickSight(d.Get("tags").(map[string]interface{})); len(v) > 0 {
+		createOpts.Tags = v
+	}
+
+	if _, err := conn.CreateTemplate(createOpts); err != nil {
+		return fmt.Errorf("error creating Quick Sight Template: %s", quickSightSourceEntityCrossAccountError(quickSightInvalidParameterError(err), d.Get("source_entity_arn").(string)))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", awsAccountID, templateID))
+
+	return resourceAwsQuickSightTemplateRead(d, meta)
+}
+
+// resourceAwsQuickSightTemplateRead always describes the template without a
+// Version qualifier, which Quick Sight resolves to the latest version, so
+// import and every refresh see version_number/version_arn/version_description
+// for whatever was most recently published rather than whatever version
+// happened to be in state.
+func resourceAwsQuickSightTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, templateID, err := resourceAwsQuickSightTemplateParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeTemplate(&quicksight.DescribeTemplateInput{
+		AwsAccountId: aws.String(awsAccountID),
+		TemplateId:   aws.String(templateID),
+	})
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Quick Sight Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error describing Quick Sight Template (%s): %s", d.Id(), err)
+	}
+
+	template := resp.Template
+
+	d.Set("arn", template.Arn)
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("template_id", templateID)
+	d.Set("name", template.Name)
+
+	if version := template.Version; version != nil {
+		d.Set("version_description", version.Description)
+		d.Set("version_number", version.VersionNumber)
+		d.Set("version_arn", fmt.Sprintf("%s/version/%d", aws.StringValue(template.Arn), aws.Int64Value(version.VersionNumber)))
+	}
+
+	tagsResp, err := conn.ListTagsForResource(&quicksight.ListTagsForResourceInput{
+		ResourceArn: template.Arn,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for Quick Sight Template (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapQuickSight(tagsResp.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, templateID, err := resourceAwsQuickSightTemplateParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	updateOpts := &quicksight.UpdateTemplateInput{
+		AwsAccountId: aws.String(awsAccountID),
+		TemplateId:   aws.String(templateID),
+		Name:         aws.String(d.Get("name").(string)),
+		SourceEntity: &quicksight.TemplateSourceEntity{
+			SourceTemplate: &quicksight.TemplateSourceTemplate{
+				Arn: aws.String(d.Get("source_entity_arn").(string)),
+			},
+		},
+	}
+
+	if v, ok := d.GetOk("version_description"); ok {
+		updateOpts.VersionDescription = aws.String(v.(string))
+	}
+
+	if _, err := retryOnAwsCode(quicksight.ErrCodeConflictException, func() (interface{}, error) {
+		return conn.UpdateTemplate(updateOpts)
+	}); err != nil {
+		return fmt.Errorf("error updating Quick Sight Template (%s): %s", d.Id(), quickSightSourceEntityCrossAccountError(quickSightInvalidParameterError(err), d.Get("source_entity_arn").(string)))
+	}
+
+	if err := setTagsQuickSight(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating tags: %s", err)
+	}
+
+	return resourceAwsQuickSightTemplateRead(d, meta)
+}
+
+func resourceAwsQuickSightTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, templateID, err := resourceAwsQuickSightTemplateParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := conn.DeleteTemplate(&quicksight.DeleteTemplateInput{
+		AwsAccountId: aws.String(awsAccountID),
+		TemplateId:   aws.String(templateID),
+	}); err != nil {
+		if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Quick Sight Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// quickSightSourceEntityCrossAccountError adds a pointer to the required
+// grant when CreateTemplate/UpdateTemplate fails with AccessDeniedException
+// and source_entity_arn belongs to a different account than this one: the
+// source template's owner must grant this account UpdateTemplatePermissions
+// on it before Quick Sight will allow creating a template from it.
+func quickSightSourceEntityCrossAccountError(err error, sourceEntityArn string) error {
+	if err == nil || !isAWSErr(err, quicksight.ErrCodeAccessDeniedException, "") {
+		return err
+	}
+
+	parsed, parseErr := arn.Parse(sourceEntityArn)
+	if parseErr != nil {
+		return err
+	}
+
+	return fmt.Errorf("%s (source_entity_arn is in account %s; its owner must grant this account UpdateTemplatePermissions on it)", err, parsed.AccountID)
+}
+
+func resourceAwsQuickSightTemplateParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/TEMPLATE_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
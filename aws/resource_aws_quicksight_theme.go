@@ -0,0 +1,245 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+// quicksightBuiltinThemeIds are the theme IDs Quick Sight ships out of the
+// box. A custom theme's base_theme_id must be one of these (or the ARN of
+// another custom theme you have access to), since Quick Sight themes always
+// derive from a parent rather than being defined from scratch.
+var quicksightBuiltinThemeIds = []string{
+	"CLASSIC",
+	"MIDNIGHT",
+	"SEASIDE",
+	"RAINFOREST",
+}
+
+func resourceAwsQuickSightTheme() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQuickSightThemeCreate,
+		Read:   resourceAwsQuickSightThemeRead,
+		Update: resourceAwsQuickSightThemeUpdate,
+		Delete: resourceAwsQuickSightThemeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"theme_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"base_theme_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateQuickSightThemeBaseThemeId,
+			},
+
+			"version_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Quick Sight has no in-place theme update: every CreateTheme or
+			// UpdateTheme call publishes a brand new, immutable version, and
+			// this is simply the version number of the one we last pushed.
+			"version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"tags": tagsSchemaQuickSight(),
+		},
+	}
+}
+
+// validateQuickSightThemeBaseThemeId accepts either one of Quick Sight's
+// built-in theme IDs or the ARN of an existing custom theme, since a custom
+// theme can itself be based on another custom theme.
+func validateQuickSightThemeBaseThemeId(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	for _, builtin := range quicksightBuiltinThemeIds {
+		if value == builtin {
+			return nil, nil
+		}
+	}
+
+	if _, arnErrors := validateArn(value, k); len(arnErrors) == 0 {
+		return nil, nil
+	}
+
+	errors = append(errors, fmt.Errorf(
+		"%q must be one of the built-in Quick Sight theme IDs (%s) or the ARN of an existing theme, got: %s",
+		k, strings.Join(quicksightBuiltinThemeIds, ", "), value))
+	return ws, errors
+}
+
+func resourceAwsQuickSightThemeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	themeID := d.Get("theme_id").(string)
+
+	createOpts := &quicksight.CreateThemeInput{
+		AwsAccountId: aws.String(awsAccountID),
+		ThemeId:      aws.String(themeID),
+		Name:         aws.String(d.Get("name").(string)),
+		BaseThemeId:  aws.String(d.Get("base_theme_id").(string)),
+	}
+
+	if v, ok := d.GetOk("version_description"); ok {
+		createOpts.VersionDescription = aws.String(v.(string))
+	}
+
+	if v := tagsFromMapQuickSight(d.Get("tags").(map[string]interface{})); len(v) > 0 {
+		createOpts.Tags = v
+	}
+
+	if _, err := conn.CreateTheme(createOpts); err != nil {
+		return fmt.Errorf("error creating Quick Sight Theme: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", awsAccountID, themeID))
+
+	return resourceAwsQuickSightThemeRead(d, meta)
+}
+
+func resourceAwsQuickSightThemeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, themeID, err := resourceAwsQuickSightThemeParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeTheme(&quicksight.DescribeThemeInput{
+		AwsAccountId: aws.String(awsAccountID),
+		ThemeId:      aws.String(themeID),
+	})
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Quick Sight Theme (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error describing Quick Sight Theme (%s): %s", d.Id(), err)
+	}
+
+	theme := resp.Theme
+
+	d.Set("arn", theme.Arn)
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("theme_id", themeID)
+	d.Set("name", theme.Name)
+
+	if theme.Version != nil {
+		d.Set("base_theme_id", theme.Version.BaseThemeId)
+		d.Set("version_description", theme.Version.Description)
+		d.Set("version_number", theme.Version.VersionNumber)
+	}
+
+	tagsResp, err := conn.ListTagsForResource(&quicksight.ListTagsForResourceInput{
+		ResourceArn: theme.Arn,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for Quick Sight Theme (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapQuickSight(tagsResp.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightThemeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, themeID, err := resourceAwsQuickSightThemeParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	updateOpts := &quicksight.UpdateThemeInput{
+		AwsAccountId: aws.String(awsAccountID),
+		ThemeId:      aws.String(themeID),
+		Name:         aws.String(d.Get("name").(string)),
+		BaseThemeId:  aws.String(d.Get("base_theme_id").(string)),
+	}
+
+	if v, ok := d.GetOk("version_description"); ok {
+		updateOpts.VersionDescription = aws.String(v.(string))
+	}
+
+	if _, err := conn.UpdateTheme(updateOpts); err != nil {
+		return fmt.Errorf("error updating Quick Sight Theme (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsQuickSight(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating tags: %s", err)
+	}
+
+	return resourceAwsQuickSightThemeRead(d, meta)
+}
+
+func resourceAwsQuickSightThemeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, themeID, err := resourceAwsQuickSightThemeParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := conn.DeleteTheme(&quicksight.DeleteThemeInput{
+		AwsAccountId: aws.String(awsAccountID),
+		ThemeId:      aws.String(themeID),
+	}); err != nil {
+		if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Quick Sight Theme (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightThemeParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/THEME_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func resourceAwsQuickSightThemeAlias() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQuickSightThemeAliasCreate,
+		Read:   resourceAwsQuickSightThemeAliasRead,
+		Update: resourceAwsQuickSightThemeAliasUpdate,
+		Delete: resourceAwsQuickSightThemeAliasDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"theme_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"alias_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"theme_version_number": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsQuickSightThemeAliasCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	themeID := d.Get("theme_id").(string)
+	aliasName := d.Get("alias_name").(string)
+
+	_, err := conn.CreateThemeAlias(&quicksight.CreateThemeAliasInput{
+		AwsAccountId:       aws.String(awsAccountID),
+		ThemeId:            aws.String(themeID),
+		AliasName:          aws.String(aliasName),
+		ThemeVersionNumber: aws.Int64(int64(d.Get("theme_version_number").(int))),
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Quick Sight Theme Alias: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", awsAccountID, themeID, aliasName))
+
+	return resourceAwsQuickSightThemeAliasRead(d, meta)
+}
+
+func resourceAwsQuickSightThemeAliasRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, themeID, aliasName, err := resourceAwsQuickSightThemeAliasParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeThemeAlias(&quicksight.DescribeThemeAliasInput{
+		AwsAccountId: aws.String(awsAccountID),
+		ThemeId:      aws.String(themeID),
+		AliasName:    aws.String(aliasName),
+	})
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Quick Sight Theme Alias (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error describing Quick Sight Theme Alias (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", resp.ThemeAlias.Arn)
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("theme_id", themeID)
+	d.Set("alias_name", aliasName)
+	d.Set("theme_version_number", resp.ThemeAlias.ThemeVersionNumber)
+
+	return nil
+}
+
+func resourceAwsQuickSightThemeAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, themeID, aliasName, err := resourceAwsQuickSightThemeAliasParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := conn.UpdateThemeAlias(&quicksight.UpdateThemeAliasInput{
+		AwsAccountId:       aws.String(awsAccountID),
+		ThemeId:            aws.String(themeID),
+		AliasName:          aws.String(aliasName),
+		ThemeVersionNumber: aws.Int64(int64(d.Get("theme_version_number").(int))),
+	}); err != nil {
+		return fmt.Errorf("error updating Quick Sight Theme Alias (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsQuickSightThemeAliasRead(d, meta)
+}
+
+func resourceAwsQuickSightThemeAliasDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, themeID, aliasName, err := resourceAwsQuickSightThemeAliasParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := conn.DeleteThemeAlias(&quicksight.DeleteThemeAliasInput{
+		AwsAccountId: aws.String(awsAccountID),
+		ThemeId:      aws.String(themeID),
+		AliasName:    aws.String(aliasName),
+	}); err != nil {
+		if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Quick Sight Theme Alias (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightThemeAliasParseID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/THEME_ID/ALIAS_NAME", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
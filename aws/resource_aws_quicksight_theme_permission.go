@@ -0,0 +1,255 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func resourceAwsQuickSightThemePermission() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQuickSightThemePermissionCreate,
+		Read:   resourceAwsQuickSightThemePermissionRead,
+		Update: resourceAwsQuickSightThemePermissionUpdate,
+		Delete: resourceAwsQuickSightThemePermissionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsQuickSightThemePermissionCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"theme_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"principal": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"actions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(quicksightThemeActions, false),
+				},
+			},
+		},
+	}
+}
+
+// resourceAwsQuickSightThemePermissionCustomizeDiff catches, at plan time, a
+// second aws_quicksight_theme_permission resource targeting a principal that
+// already has permissions granted on the theme. The UpdateThemePermissions API
+// merges grants for a principal across calls, so two Terraform resources
+// racing to "own" the same principal silently clobber one another on apply.
+func resourceAwsQuickSightThemePermissionCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	return quickSightCheckDuplicateThemePrincipal(conn, awsAccountID, d.Get("theme_id").(string), d.Get("principal").(string))
+}
+
+// quickSightCheckDuplicateThemePrincipal holds the network-calling half of
+// resourceAwsQuickSightThemePermissionCustomizeDiff, pulled out so it can be
+// unit tested against a fake quicksightconniface instead of only through
+// TF_ACC.
+func quickSightCheckDuplicateThemePrincipal(conn quicksightconniface, awsAccountID, themeID, principal string) error {
+	resp, err := conn.DescribeThemePermissions(&quicksight.DescribeThemePermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		ThemeId:      aws.String(themeID),
+	})
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return nil
+	}
+
+	if quickSightPermissionHasPrincipal(resp.Permissions, principal) {
+		return fmt.Errorf("principal %s already has permissions granted on Quick Sight Theme %s; import the existing aws_quicksight_theme_permission resource instead of creating a duplicate", principal, themeID)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightThemePermissionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	themeID := d.Get("theme_id").(string)
+	principal := d.Get("principal").(string)
+
+	_, err := conn.UpdateThemePermissions(&quicksight.UpdateThemePermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		ThemeId:      aws.String(themeID),
+		GrantPermissions: []*quicksight.ResourcePermission{
+			{
+				Principal: aws.String(principal),
+				Actions:   expandStringSet(d.Get("actions").(*schema.Set)),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error granting Quick Sight Theme (%s) permissions to %s: %s", themeID, principal, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", awsAccountID, themeID, principal))
+
+	return resourceAwsQuickSightThemePermissionRead(d, meta)
+}
+
+func resourceAwsQuickSightThemePermissionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, themeID, principal, err := resourceAwsQuickSightThemePermissionParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeThemePermissions(&quicksight.DescribeThemePermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		ThemeId:      aws.String(themeID),
+	})
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Quick Sight Theme %s is already gone", themeID)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error describing Quick Sight Theme (%s) permissions: %s", themeID, err)
+	}
+
+	var actions []*string
+	found := false
+	for _, perm := range resp.Permissions {
+		if aws.StringValue(perm.Principal) == principal {
+			actions = perm.Actions
+			found = true
+			break
+		}
+	}
+	if !found {
+		log.Printf("[WARN] Quick Sight Theme (%s) permission for %s is already gone", themeID, principal)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("theme_id", themeID)
+	d.Set("principal", principal)
+	d.Set("actions", flattenStringSet(actions))
+
+	return nil
+}
+
+func resourceAwsQuickSightThemePermissionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, themeID, principal, err := resourceAwsQuickSightThemePermissionParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	o, n := d.GetChange("actions")
+	oldActions := o.(*schema.Set)
+	newActions := n.(*schema.Set)
+
+	input := &quicksight.UpdateThemePermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		ThemeId:      aws.String(themeID),
+	}
+
+	if toGrant := newActions.Difference(oldActions); toGrant.Len() > 0 {
+		input.GrantPermissions = []*quicksight.ResourcePermission{
+			{
+				Principal: aws.String(principal),
+				Actions:   expandStringSet(toGrant),
+			},
+		}
+	}
+
+	if toRevoke := oldActions.Difference(newActions); toRevoke.Len() > 0 {
+		input.RevokePermissions = []*quicksight.ResourcePermission{
+			{
+				Principal: aws.String(principal),
+				Actions:   expandStringSet(toRevoke),
+			},
+		}
+	}
+
+	if input.GrantPermissions != nil || input.RevokePermissions != nil {
+		if _, err := conn.UpdateThemePermissions(input); err != nil {
+			return fmt.Errorf("error updating Quick Sight Theme (%s) permissions for %s: %s", themeID, principal, err)
+		}
+	}
+
+	return resourceAwsQuickSightThemePermissionRead(d, meta)
+}
+
+func resourceAwsQuickSightThemePermissionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, themeID, principal, err := resourceAwsQuickSightThemePermissionParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.UpdateThemePermissions(&quicksight.UpdateThemePermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		ThemeId:      aws.String(themeID),
+		RevokePermissions: []*quicksight.ResourcePermission{
+			{
+				Principal: aws.String(principal),
+				Actions:   expandStringSet(d.Get("actions").(*schema.Set)),
+			},
+		},
+	})
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error revoking Quick Sight Theme (%s) permissions for %s: %s", themeID, principal, err)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightThemePermissionParseID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) < 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/THEME_ID/PRINCIPAL_ARN", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
@@ -0,0 +1,69 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func TestQuickSightCheckDuplicateThemePrincipal(t *testing.T) {
+	const (
+		awsAccountID = "123456789012"
+		themeID      = "test-theme"
+		principal    = "arn:aws:iam::123456789012:user/test"
+	)
+
+	testCases := []struct {
+		name        string
+		permissions []*quicksight.ResourcePermission
+		describeErr error
+		wantErr     bool
+	}{
+		{
+			name: "principal already granted",
+			permissions: []*quicksight.ResourcePermission{
+				{Principal: aws.String(principal)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "principal not yet granted",
+			permissions: []*quicksight.ResourcePermission{
+				{Principal: aws.String("arn:aws:iam::123456789012:user/other")},
+			},
+			wantErr: false,
+		},
+		{
+			name:        "theme not found",
+			describeErr: awserr.New(quicksight.ErrCodeResourceNotFoundException, "not found", nil),
+			wantErr:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			conn := &fakeQuicksightconn{
+				describeThemePermissions: func(input *quicksight.DescribeThemePermissionsInput) (*quicksight.DescribeThemePermissionsOutput, error) {
+					if aws.StringValue(input.AwsAccountId) != awsAccountID || aws.StringValue(input.ThemeId) != themeID {
+						t.Errorf("DescribeThemePermissions called with unexpected input: %+v", input)
+					}
+					if tc.describeErr != nil {
+						return nil, tc.describeErr
+					}
+					return &quicksight.DescribeThemePermissionsOutput{Permissions: tc.permissions}, nil
+				},
+			}
+
+			err := quickSightCheckDuplicateThemePrincipal(conn, awsAccountID, themeID, principal)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("quickSightCheckDuplicateThemePrincipal() error = %v, wantErr %t", err, tc.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), themeID) {
+				t.Errorf("error %q does not mention theme ID %q", err, themeID)
+			}
+		})
+	}
+}
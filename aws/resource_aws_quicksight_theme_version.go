@@ -0,0 +1,147 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+// resourceAwsQuickSightThemeVersion manages the lifecycle of a single
+// published theme version, independent of the aws_quicksight_theme
+// resource's "current" version. Quick Sight never deletes old versions on
+// its own, so without this resource they accumulate forever; this lets
+// Terraform prune a specific version (e.g. one superseded by a new
+// version_description) without touching the theme itself or any other
+// version.
+func resourceAwsQuickSightThemeVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQuickSightThemeVersionCreate,
+		Read:   resourceAwsQuickSightThemeVersionRead,
+		Delete: resourceAwsQuickSightThemeVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"theme_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"version_number": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsQuickSightThemeVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	themeID := d.Get("theme_id").(string)
+	versionNumber := d.Get("version_number").(int)
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", awsAccountID, themeID, versionNumber))
+
+	return resourceAwsQuickSightThemeVersionRead(d, meta)
+}
+
+func resourceAwsQuickSightThemeVersionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, themeID, versionNumber, err := resourceAwsQuickSightThemeVersionParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeTheme(&quicksight.DescribeThemeInput{
+		AwsAccountId:  aws.String(awsAccountID),
+		ThemeId:       aws.String(themeID),
+		VersionNumber: aws.Int64(versionNumber),
+	})
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Quick Sight Theme (%s) version (%d) not found, removing from state", themeID, versionNumber)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error describing Quick Sight Theme (%s) version (%d): %s", themeID, versionNumber, err)
+	}
+
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("theme_id", themeID)
+	d.Set("version_number", versionNumber)
+	d.Set("arn", resp.Theme.Arn)
+
+	if resp.Theme.Version != nil {
+		d.Set("status", resp.Theme.Version.Status)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightThemeVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, themeID, versionNumber, err := resourceAwsQuickSightThemeVersionParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := conn.DeleteTheme(&quicksight.DeleteThemeInput{
+		AwsAccountId:  aws.String(awsAccountID),
+		ThemeId:       aws.String(themeID),
+		VersionNumber: aws.Int64(versionNumber),
+	}); err != nil {
+		if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Quick Sight Theme (%s) version (%d): %s", themeID, versionNumber, err)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightThemeVersionParseID(id string) (string, string, int64, error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", 0, fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/THEME_ID/VERSION_NUMBER", id)
+	}
+
+	versionNumber, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return "", "", 0, fmt.Errorf("error parsing version number from ID (%s): %s", id, err)
+	}
+
+	return parts[0], parts[1], versionNumber, nil
+}
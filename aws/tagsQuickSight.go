@@ -0,0 +1,143 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Quick Sight's real-world tagging limits: these are tighter than the
+// generic AWS tagging limits that tagsSchema() (aws/tags.go) is shared
+// across, so they're enforced here instead of there.
+const (
+	quickSightTagsMaxCount    = 128
+	quickSightTagKeyMaxLength = 128
+	quickSightTagValMaxLength = 256
+)
+
+// tagsSchemaQuickSight returns the schema to use for "tags" on Quick Sight
+// resources. It's the same shape as tagsSchema(), plus plan-time validation
+// of Quick Sight's own tagging limits so a bad tag map fails with a clear
+// error instead of an opaque TagResource API rejection during apply.
+func tagsSchemaQuickSight() *schema.Schema {
+	s := tagsSchema()
+	s.ValidateFunc = validateQuickSightTags
+	return s
+}
+
+// validateQuickSightTags enforces Quick Sight's tag count and key/value
+// length limits. schema.Schema.ValidateFunc is invoked once for a TypeMap
+// field, with the entire map passed as v, so there's no separate
+// CustomizeDiff needed to catch the tag count in addition to per-tag
+// lengths.
+func validateQuickSightTags(v interface{}, k string) (ws []string, errors []error) {
+	tags, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	if len(tags) > quickSightTagsMaxCount {
+		errors = append(errors, fmt.Errorf("%q cannot have more than %d tags, got %d", k, quickSightTagsMaxCount, len(tags)))
+	}
+
+	for key, value := range tags {
+		if len(key) > quickSightTagKeyMaxLength {
+			errors = append(errors, fmt.Errorf("%q: tag key %q is %d characters, which is longer than the maximum of %d", k, key, len(key), quickSightTagKeyMaxLength))
+		}
+
+		val, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		if len(val) > quickSightTagValMaxLength {
+			errors = append(errors, fmt.Errorf("%q: value for tag key %q is %d characters, which is longer than the maximum of %d", k, key, len(val), quickSightTagValMaxLength))
+		}
+	}
+
+	return ws, errors
+}
+
+// setTagsQuickSight is a helper to set the tags for a Quick Sight resource. It
+// expects the tags field to be named "tags".
+func setTagsQuickSight(conn quicksightconniface, d *schema.ResourceData, arn string) error {
+	if d.HasChange("tags") {
+		oraw, nraw := d.GetChange("tags")
+		o := oraw.(map[string]interface{})
+		n := nraw.(map[string]interface{})
+		create, remove := diffTagsQuickSight(tagsFromMapQuickSight(o), tagsFromMapQuickSight(n))
+
+		if len(remove) > 0 {
+			log.Printf("[DEBUG] Removing Quick Sight tags: %#v", remove)
+			k := make([]*string, len(remove))
+			for i, t := range remove {
+				k[i] = t.Key
+			}
+
+			_, err := conn.UntagResource(&quicksight.UntagResourceInput{
+				ResourceArn: aws.String(arn),
+				TagKeys:     k,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(create) > 0 {
+			log.Printf("[DEBUG] Creating Quick Sight tags: %#v", create)
+			_, err := conn.TagResource(&quicksight.TagResourceInput{
+				ResourceArn: aws.String(arn),
+				Tags:        create,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// diffTagsQuickSight takes our tags locally and the ones remotely and returns
+// the set of tags that must be created, and the set of tags that must be
+// destroyed.
+func diffTagsQuickSight(oldTags, newTags []*quicksight.Tag) ([]*quicksight.Tag, []*quicksight.Tag) {
+	create := make(map[string]interface{})
+	for _, t := range newTags {
+		create[*t.Key] = *t.Value
+	}
+
+	var remove []*quicksight.Tag
+	for _, t := range oldTags {
+		old, ok := create[*t.Key]
+		if !ok || old != *t.Value {
+			remove = append(remove, t)
+		}
+	}
+
+	return tagsFromMapQuickSight(create), remove
+}
+
+func tagsFromMapQuickSight(m map[string]interface{}) []*quicksight.Tag {
+	result := []*quicksight.Tag{}
+	for k, v := range m {
+		result = append(result, &quicksight.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return result
+}
+
+func tagsToMapQuickSight(ts []*quicksight.Tag) map[string]string {
+	result := map[string]string{}
+	for _, t := range ts {
+		result[*t.Key] = *t.Value
+	}
+
+	return result
+}
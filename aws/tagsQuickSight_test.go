@@ -0,0 +1,61 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestValidateQuickSightTags(t *testing.T) {
+	cases := []struct {
+		name    string
+		tags    map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			tags: map[string]interface{}{
+				"Name": "example",
+			},
+		},
+		{
+			name:    "too many tags",
+			tags:    makeQuickSightTestTags(quickSightTagsMaxCount + 1),
+			wantErr: true,
+		},
+		{
+			name: "key too long",
+			tags: map[string]interface{}{
+				strings.Repeat("k", quickSightTagKeyMaxLength+1): "value",
+			},
+			wantErr: true,
+		},
+		{
+			name: "value too long",
+			tags: map[string]interface{}{
+				"Name": strings.Repeat("v", quickSightTagValMaxLength+1),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, errors := validateQuickSightTags(tc.tags, "tags")
+			if tc.wantErr && len(errors) == 0 {
+				t.Fatalf("expected an error, got none")
+			}
+			if !tc.wantErr && len(errors) > 0 {
+				t.Fatalf("expected no error, got: %v", errors)
+			}
+		})
+	}
+}
+
+func makeQuickSightTestTags(n int) map[string]interface{} {
+	tags := make(map[string]interface{}, n)
+	for i := 0; i < n; i++ {
+		tags[fmt.Sprintf("key-%d", i)] = "value"
+	}
+	return tags
+}
@@ -699,6 +699,20 @@ func validateArn(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+func validateQuickSightVpcConnectionArn(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	// https://docs.aws.amazon.com/quicksight/latest/APIReference/API_VpcConnection.html
+	pattern := `^arn:[\w-]+:quicksight:[\w-]+:\d{12}:vpcConnection/.+$`
+	if !regexp.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q does not look like a Quick Sight VPC connection ARN (%q): %q",
+			k, pattern, value))
+	}
+
+	return
+}
+
 func validateEC2AutomateARN(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -1,3 +1,4 @@
+//go:build go1.10
 // +build go1.10
 
 package sdkmath
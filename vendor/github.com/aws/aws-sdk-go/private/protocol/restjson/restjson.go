@@ -2,30 +2,35 @@
 // requests and responses.
 package restjson
 
-//go:generate go run -tags codegen ../../../models/protocol_tests/generate.go ../../../models/protocol_tests/input/rest-json.json build_test.go
-//go:generate go run -tags codegen ../../../models/protocol_tests/generate.go ../../../models/protocol_tests/output/rest-json.json unmarshal_test.go
+//go:generate go run -tags codegen ../../../private/model/cli/gen-protocol-tests ../../../models/protocol_tests/input/rest-json.json build_test.go
+//go:generate go run -tags codegen ../../../private/model/cli/gen-protocol-tests ../../../models/protocol_tests/output/rest-json.json unmarshal_test.go
 
 import (
-	"strings"
-
-	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
-	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/private/protocol/jsonrpc"
 	"github.com/aws/aws-sdk-go/private/protocol/rest"
 )
 
-// BuildHandler is a named request handler for building restjson protocol requests
-var BuildHandler = request.NamedHandler{Name: "awssdk.restjson.Build", Fn: Build}
-
-// UnmarshalHandler is a named request handler for unmarshaling restjson protocol requests
-var UnmarshalHandler = request.NamedHandler{Name: "awssdk.restjson.Unmarshal", Fn: Unmarshal}
+// BuildHandler is a named request handler for building restjson protocol
+// requests
+var BuildHandler = request.NamedHandler{
+	Name: "awssdk.restjson.Build",
+	Fn:   Build,
+}
 
-// UnmarshalMetaHandler is a named request handler for unmarshaling restjson protocol request metadata
-var UnmarshalMetaHandler = request.NamedHandler{Name: "awssdk.restjson.UnmarshalMeta", Fn: UnmarshalMeta}
+// UnmarshalHandler is a named request handler for unmarshaling restjson
+// protocol requests
+var UnmarshalHandler = request.NamedHandler{
+	Name: "awssdk.restjson.Unmarshal",
+	Fn:   Unmarshal,
+}
 
-// UnmarshalErrorHandler is a named request handler for unmarshaling restjson protocol request errors
-var UnmarshalErrorHandler = request.NamedHandler{Name: "awssdk.restjson.UnmarshalError", Fn: UnmarshalError}
+// UnmarshalMetaHandler is a named request handler for unmarshaling restjson
+// protocol request metadata
+var UnmarshalMetaHandler = request.NamedHandler{
+	Name: "awssdk.restjson.UnmarshalMeta",
+	Fn:   UnmarshalMeta,
+}
 
 // Build builds a request for the REST JSON protocol.
 func Build(r *request.Request) {
@@ -52,37 +57,3 @@ func Unmarshal(r *request.Request) {
 func UnmarshalMeta(r *request.Request) {
 	rest.UnmarshalMeta(r)
 }
-
-// UnmarshalError unmarshals a response error for the REST JSON protocol.
-func UnmarshalError(r *request.Request) {
-	defer r.HTTPResponse.Body.Close()
-
-	var jsonErr jsonErrorResponse
-	err := jsonutil.UnmarshalJSONError(&jsonErr, r.HTTPResponse.Body)
-	if err != nil {
-		r.Error = awserr.NewRequestFailure(
-			awserr.New(request.ErrCodeSerialization,
-				"failed to unmarshal response error", err),
-			r.HTTPResponse.StatusCode,
-			r.RequestID,
-		)
-		return
-	}
-
-	code := r.HTTPResponse.Header.Get("X-Amzn-Errortype")
-	if code == "" {
-		code = jsonErr.Code
-	}
-
-	code = strings.SplitN(code, ":", 2)[0]
-	r.Error = awserr.NewRequestFailure(
-		awserr.New(code, jsonErr.Message, nil),
-		r.HTTPResponse.StatusCode,
-		r.RequestID,
-	)
-}
-
-type jsonErrorResponse struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-}
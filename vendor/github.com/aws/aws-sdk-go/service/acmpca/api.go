@@ -29,14 +29,13 @@ const opCreateCertificateAuthority = "CreateCertificateAuthority"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateCertificateAuthorityRequest method.
+//	req, resp := client.CreateCertificateAuthorityRequest(params)
 //
-//    // Example sending a request using the CreateCertificateAuthorityRequest method.
-//    req, resp := client.CreateCertificateAuthorityRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/CreateCertificateAuthority
 func (c *ACMPCA) CreateCertificateAuthorityRequest(input *CreateCertificateAuthorityInput) (req *request.Request, output *CreateCertificateAuthorityOutput) {
@@ -58,15 +57,26 @@ func (c *ACMPCA) CreateCertificateAuthorityRequest(input *CreateCertificateAutho
 // CreateCertificateAuthority API operation for AWS Certificate Manager Private Certificate Authority.
 //
 // Creates a root or subordinate private certificate authority (CA). You must
-// specify the CA configuration, the certificate revocation list (CRL) configuration,
-// the CA type, and an optional idempotency token to avoid accidental creation
-// of multiple CAs. The CA configuration specifies the name of the algorithm
-// and key size to be used to create the CA private key, the type of signing
-// algorithm that the CA uses, and X.500 subject information. The CRL configuration
-// specifies the CRL expiration period in days (the validity period of the CRL),
-// the Amazon S3 bucket that will contain the CRL, and a CNAME alias for the
-// S3 bucket that is included in certificates issued by the CA. If successful,
-// this action returns the Amazon Resource Name (ARN) of the CA.
+// specify the CA configuration, an optional configuration for Online Certificate
+// Status Protocol (OCSP) and/or a certificate revocation list (CRL), the CA
+// type, and an optional idempotency token to avoid accidental creation of multiple
+// CAs. The CA configuration specifies the name of the algorithm and key size
+// to be used to create the CA private key, the type of signing algorithm that
+// the CA uses, and X.500 subject information. The OCSP configuration can optionally
+// specify a custom URL for the OCSP responder. The CRL configuration specifies
+// the CRL expiration period in days (the validity period of the CRL), the Amazon
+// S3 bucket that will contain the CRL, and a CNAME alias for the S3 bucket
+// that is included in certificates issued by the CA. If successful, this action
+// returns the Amazon Resource Name (ARN) of the CA.
+//
+// Both Amazon Web Services Private CA and the IAM principal must have permission
+// to write to the S3 bucket that you specify. If the IAM principal making the
+// call does not have permission to write to the bucket, then an exception is
+// thrown. For more information, see Access policies for CRLs in Amazon S3 (https://docs.aws.amazon.com/privateca/latest/userguide/crl-planning.html#s3-policies).
+//
+// Amazon Web Services Private CA assets that are stored in Amazon S3 can be
+// protected with encryption. For more information, see Encrypting Your CRLs
+// (https://docs.aws.amazon.com/privateca/latest/userguide/PcaCreateCa.html#crl-encryption).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -75,21 +85,23 @@ func (c *ACMPCA) CreateCertificateAuthorityRequest(input *CreateCertificateAutho
 // See the AWS API reference guide for AWS Certificate Manager Private Certificate Authority's
 // API operation CreateCertificateAuthority for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidArgsException "InvalidArgsException"
-//   One or more of the specified arguments was not valid.
+// Returned Error Types:
+//
+//   - InvalidArgsException
+//     One or more of the specified arguments was not valid.
 //
-//   * ErrCodeInvalidPolicyException "InvalidPolicyException"
-//   The S3 bucket policy is not valid. The policy must give ACM Private CA rights
-//   to read from and write to the bucket and find the bucket location.
+//   - InvalidPolicyException
+//     The resource policy is invalid or is missing a required statement. For general
+//     information about IAM policy and statement structure, see Overview of JSON
+//     Policies (https://docs.aws.amazon.com/IAM/latest/UserGuide/access_policies.html#access_policies-json).
 //
-//   * ErrCodeInvalidTagException "InvalidTagException"
-//   The tag associated with the CA is not valid. The invalid argument is contained
-//   in the message field.
+//   - InvalidTagException
+//     The tag associated with the CA is not valid. The invalid argument is contained
+//     in the message field.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   An ACM Private CA limit has been exceeded. See the exception message returned
-//   to determine the limit that was exceeded.
+//   - LimitExceededException
+//     An Amazon Web Services Private CA quota has been exceeded. See the exception
+//     message returned to determine the quota that was exceeded.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/CreateCertificateAuthority
 func (c *ACMPCA) CreateCertificateAuthority(input *CreateCertificateAuthorityInput) (*CreateCertificateAuthorityOutput, error) {
@@ -129,14 +141,13 @@ const opCreateCertificateAuthorityAuditReport = "CreateCertificateAuthorityAudit
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateCertificateAuthorityAuditReportRequest method.
+//	req, resp := client.CreateCertificateAuthorityAuditReportRequest(params)
 //
-//    // Example sending a request using the CreateCertificateAuthorityAuditReportRequest method.
-//    req, resp := client.CreateCertificateAuthorityAuditReportRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/CreateCertificateAuthorityAuditReport
 func (c *ACMPCA) CreateCertificateAuthorityAuditReportRequest(input *CreateCertificateAuthorityAuditReportInput) (req *request.Request, output *CreateCertificateAuthorityAuditReportOutput) {
@@ -159,7 +170,20 @@ func (c *ACMPCA) CreateCertificateAuthorityAuditReportRequest(input *CreateCerti
 //
 // Creates an audit report that lists every time that your CA private key is
 // used. The report is saved in the Amazon S3 bucket that you specify on input.
-// The IssueCertificate and RevokeCertificate actions use the private key.
+// The IssueCertificate (https://docs.aws.amazon.com/privateca/latest/APIReference/API_IssueCertificate.html)
+// and RevokeCertificate (https://docs.aws.amazon.com/privateca/latest/APIReference/API_RevokeCertificate.html)
+// actions use the private key.
+//
+// Both Amazon Web Services Private CA and the IAM principal must have permission
+// to write to the S3 bucket that you specify. If the IAM principal making the
+// call does not have permission to write to the bucket, then an exception is
+// thrown. For more information, see Access policies for CRLs in Amazon S3 (https://docs.aws.amazon.com/privateca/latest/userguide/crl-planning.html#s3-policies).
+//
+// Amazon Web Services Private CA assets that are stored in Amazon S3 can be
+// protected with encryption. For more information, see Encrypting Your Audit
+// Reports (https://docs.aws.amazon.com/privateca/latest/userguide/PcaAuditReport.html#audit-report-encryption).
+//
+// You can generate a maximum of one report every 30 minutes.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -168,26 +192,26 @@ func (c *ACMPCA) CreateCertificateAuthorityAuditReportRequest(input *CreateCerti
 // See the AWS API reference guide for AWS Certificate Manager Private Certificate Authority's
 // API operation CreateCertificateAuthorityAuditReport for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeRequestInProgressException "RequestInProgressException"
-//   Your request is already in progress.
+// Returned Error Types:
 //
-//   * ErrCodeRequestFailedException "RequestFailedException"
-//   The request has failed for an unspecified reason.
+//   - RequestInProgressException
+//     Your request is already in progress.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource such as a private CA, S3 bucket, certificate, or audit report
-//   cannot be found.
+//   - RequestFailedException
+//     The request has failed for an unspecified reason.
 //
-//   * ErrCodeInvalidArnException "InvalidArnException"
-//   The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+//   - ResourceNotFoundException
+//     A resource such as a private CA, S3 bucket, certificate, audit report, or
+//     policy cannot be found.
 //
-//   * ErrCodeInvalidArgsException "InvalidArgsException"
-//   One or more of the specified arguments was not valid.
+//   - InvalidArnException
+//     The requested Amazon Resource Name (ARN) does not refer to an existing resource.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   The private CA is in a state during which a report or certificate cannot
-//   be generated.
+//   - InvalidArgsException
+//     One or more of the specified arguments was not valid.
+//
+//   - InvalidStateException
+//     The state of the private CA does not allow this action to occur.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/CreateCertificateAuthorityAuditReport
 func (c *ACMPCA) CreateCertificateAuthorityAuditReport(input *CreateCertificateAuthorityAuditReportInput) (*CreateCertificateAuthorityAuditReportOutput, error) {
@@ -227,14 +251,13 @@ const opCreatePermission = "CreatePermission"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreatePermissionRequest method.
+//	req, resp := client.CreatePermissionRequest(params)
 //
-//    // Example sending a request using the CreatePermissionRequest method.
-//    req, resp := client.CreatePermissionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/CreatePermission
 func (c *ACMPCA) CreatePermissionRequest(input *CreatePermissionInput) (req *request.Request, output *CreatePermissionOutput) {
@@ -256,16 +279,29 @@ func (c *ACMPCA) CreatePermissionRequest(input *CreatePermissionInput) (req *req
 
 // CreatePermission API operation for AWS Certificate Manager Private Certificate Authority.
 //
-// Assigns permissions from a private CA to a designated AWS service. Services
-// are specified by their service principals and can be given permission to
-// create and retrieve certificates on a private CA. Services can also be given
-// permission to list the active permissions that the private CA has granted.
-// For ACM to automatically renew your private CA's certificates, you must assign
-// all possible permissions from the CA to the ACM service principal.
+// Grants one or more permissions on a private CA to the Certificate Manager
+// (ACM) service principal (acm.amazonaws.com). These permissions allow ACM
+// to issue and renew ACM certificates that reside in the same Amazon Web Services
+// account as the CA.
 //
-// At this time, you can only assign permissions to ACM (acm.amazonaws.com).
-// Permissions can be revoked with the DeletePermission action and listed with
-// the ListPermissions action.
+// You can list current permissions with the ListPermissions (https://docs.aws.amazon.com/privateca/latest/APIReference/API_ListPermissions.html)
+// action and revoke them with the DeletePermission (https://docs.aws.amazon.com/privateca/latest/APIReference/API_DeletePermission.html)
+// action.
+//
+// About Permissions
+//
+//   - If the private CA and the certificates it issues reside in the same
+//     account, you can use CreatePermission to grant permissions for ACM to
+//     carry out automatic certificate renewals.
+//
+//   - For automatic certificate renewal to succeed, the ACM service principal
+//     needs permissions to create, retrieve, and list certificates.
+//
+//   - If the private CA and the ACM certificates reside in different accounts,
+//     then permissions cannot be used to enable automatic renewals. Instead,
+//     the ACM certificate owner must set up a resource-based policy to enable
+//     cross-account issuance and renewals. For more information, see Using a
+//     Resource Based Policy with Amazon Web Services Private CA (https://docs.aws.amazon.com/privateca/latest/userguide/pca-rbp.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -274,27 +310,27 @@ func (c *ACMPCA) CreatePermissionRequest(input *CreatePermissionInput) (req *req
 // See the AWS API reference guide for AWS Certificate Manager Private Certificate Authority's
 // API operation CreatePermission for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource such as a private CA, S3 bucket, certificate, or audit report
-//   cannot be found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     A resource such as a private CA, S3 bucket, certificate, audit report, or
+//     policy cannot be found.
 //
-//   * ErrCodeInvalidArnException "InvalidArnException"
-//   The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+//   - InvalidArnException
+//     The requested Amazon Resource Name (ARN) does not refer to an existing resource.
 //
-//   * ErrCodePermissionAlreadyExistsException "PermissionAlreadyExistsException"
-//   The designated permission has already been given to the user.
+//   - PermissionAlreadyExistsException
+//     The designated permission has already been given to the user.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   An ACM Private CA limit has been exceeded. See the exception message returned
-//   to determine the limit that was exceeded.
+//   - LimitExceededException
+//     An Amazon Web Services Private CA quota has been exceeded. See the exception
+//     message returned to determine the quota that was exceeded.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   The private CA is in a state during which a report or certificate cannot
-//   be generated.
+//   - InvalidStateException
+//     The state of the private CA does not allow this action to occur.
 //
-//   * ErrCodeRequestFailedException "RequestFailedException"
-//   The request has failed for an unspecified reason.
+//   - RequestFailedException
+//     The request has failed for an unspecified reason.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/CreatePermission
 func (c *ACMPCA) CreatePermission(input *CreatePermissionInput) (*CreatePermissionOutput, error) {
@@ -334,14 +370,13 @@ const opDeleteCertificateAuthority = "DeleteCertificateAuthority"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteCertificateAuthorityRequest method.
+//	req, resp := client.DeleteCertificateAuthorityRequest(params)
 //
-//    // Example sending a request using the DeleteCertificateAuthorityRequest method.
-//    req, resp := client.DeleteCertificateAuthorityRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/DeleteCertificateAuthority
 func (c *ACMPCA) DeleteCertificateAuthorityRequest(input *DeleteCertificateAuthorityInput) (req *request.Request, output *DeleteCertificateAuthorityOutput) {
@@ -365,27 +400,31 @@ func (c *ACMPCA) DeleteCertificateAuthorityRequest(input *DeleteCertificateAutho
 //
 // Deletes a private certificate authority (CA). You must provide the Amazon
 // Resource Name (ARN) of the private CA that you want to delete. You can find
-// the ARN by calling the ListCertificateAuthorities action.
+// the ARN by calling the ListCertificateAuthorities (https://docs.aws.amazon.com/privateca/latest/APIReference/API_ListCertificateAuthorities.html)
+// action.
 //
 // Deleting a CA will invalidate other CAs and certificates below it in your
 // CA hierarchy.
 //
 // Before you can delete a CA that you have created and activated, you must
-// disable it. To do this, call the UpdateCertificateAuthority action and set
-// the CertificateAuthorityStatus parameter to DISABLED.
+// disable it. To do this, call the UpdateCertificateAuthority (https://docs.aws.amazon.com/privateca/latest/APIReference/API_UpdateCertificateAuthority.html)
+// action and set the CertificateAuthorityStatus parameter to DISABLED.
 //
 // Additionally, you can delete a CA if you are waiting for it to be created
 // (that is, the status of the CA is CREATING). You can also delete it if the
 // CA has been created but you haven't yet imported the signed certificate into
-// ACM Private CA (that is, the status of the CA is PENDING_CERTIFICATE).
-//
-// When you successfully call DeleteCertificateAuthority, the CA's status changes
-// to DELETED. However, the CA won't be permanently deleted until the restoration
-// period has passed. By default, if you do not set the PermanentDeletionTimeInDays
-// parameter, the CA remains restorable for 30 days. You can set the parameter
-// from 7 to 30 days. The DescribeCertificateAuthority action returns the time
-// remaining in the restoration window of a private CA in the DELETED state.
-// To restore an eligible CA, call the RestoreCertificateAuthority action.
+// Amazon Web Services Private CA (that is, the status of the CA is PENDING_CERTIFICATE).
+//
+// When you successfully call DeleteCertificateAuthority (https://docs.aws.amazon.com/privateca/latest/APIReference/API_DeleteCertificateAuthority.html),
+// the CA's status changes to DELETED. However, the CA won't be permanently
+// deleted until the restoration period has passed. By default, if you do not
+// set the PermanentDeletionTimeInDays parameter, the CA remains restorable
+// for 30 days. You can set the parameter from 7 to 30 days. The DescribeCertificateAuthority
+// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_DescribeCertificateAuthority.html)
+// action returns the time remaining in the restoration window of a private
+// CA in the DELETED state. To restore an eligible CA, call the RestoreCertificateAuthority
+// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_RestoreCertificateAuthority.html)
+// action.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -394,20 +433,20 @@ func (c *ACMPCA) DeleteCertificateAuthorityRequest(input *DeleteCertificateAutho
 // See the AWS API reference guide for AWS Certificate Manager Private Certificate Authority's
 // API operation DeleteCertificateAuthority for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   A previous update to your private CA is still ongoing.
+// Returned Error Types:
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource such as a private CA, S3 bucket, certificate, or audit report
-//   cannot be found.
+//   - ConcurrentModificationException
+//     A previous update to your private CA is still ongoing.
 //
-//   * ErrCodeInvalidArnException "InvalidArnException"
-//   The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+//   - ResourceNotFoundException
+//     A resource such as a private CA, S3 bucket, certificate, audit report, or
+//     policy cannot be found.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   The private CA is in a state during which a report or certificate cannot
-//   be generated.
+//   - InvalidArnException
+//     The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+//
+//   - InvalidStateException
+//     The state of the private CA does not allow this action to occur.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/DeleteCertificateAuthority
 func (c *ACMPCA) DeleteCertificateAuthority(input *DeleteCertificateAuthorityInput) (*DeleteCertificateAuthorityOutput, error) {
@@ -447,14 +486,13 @@ const opDeletePermission = "DeletePermission"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeletePermissionRequest method.
+//	req, resp := client.DeletePermissionRequest(params)
 //
-//    // Example sending a request using the DeletePermissionRequest method.
-//    req, resp := client.DeletePermissionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/DeletePermission
 func (c *ACMPCA) DeletePermissionRequest(input *DeletePermissionInput) (req *request.Request, output *DeletePermissionOutput) {
@@ -476,9 +514,31 @@ func (c *ACMPCA) DeletePermissionRequest(input *DeletePermissionInput) (req *req
 
 // DeletePermission API operation for AWS Certificate Manager Private Certificate Authority.
 //
-// Revokes permissions that a private CA assigned to a designated AWS service.
-// Permissions can be created with the CreatePermission action and listed with
-// the ListPermissions action.
+// Revokes permissions on a private CA granted to the Certificate Manager (ACM)
+// service principal (acm.amazonaws.com).
+//
+// These permissions allow ACM to issue and renew ACM certificates that reside
+// in the same Amazon Web Services account as the CA. If you revoke these permissions,
+// ACM will no longer renew the affected certificates automatically.
+//
+// Permissions can be granted with the CreatePermission (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreatePermission.html)
+// action and listed with the ListPermissions (https://docs.aws.amazon.com/privateca/latest/APIReference/API_ListPermissions.html)
+// action.
+//
+// About Permissions
+//
+//   - If the private CA and the certificates it issues reside in the same
+//     account, you can use CreatePermission to grant permissions for ACM to
+//     carry out automatic certificate renewals.
+//
+//   - For automatic certificate renewal to succeed, the ACM service principal
+//     needs permissions to create, retrieve, and list certificates.
+//
+//   - If the private CA and the ACM certificates reside in different accounts,
+//     then permissions cannot be used to enable automatic renewals. Instead,
+//     the ACM certificate owner must set up a resource-based policy to enable
+//     cross-account issuance and renewals. For more information, see Using a
+//     Resource Based Policy with Amazon Web Services Private CA (https://docs.aws.amazon.com/privateca/latest/userguide/pca-rbp.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -487,20 +547,20 @@ func (c *ACMPCA) DeletePermissionRequest(input *DeletePermissionInput) (req *req
 // See the AWS API reference guide for AWS Certificate Manager Private Certificate Authority's
 // API operation DeletePermission for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource such as a private CA, S3 bucket, certificate, or audit report
-//   cannot be found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     A resource such as a private CA, S3 bucket, certificate, audit report, or
+//     policy cannot be found.
 //
-//   * ErrCodeInvalidArnException "InvalidArnException"
-//   The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+//   - InvalidArnException
+//     The requested Amazon Resource Name (ARN) does not refer to an existing resource.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   The private CA is in a state during which a report or certificate cannot
-//   be generated.
+//   - InvalidStateException
+//     The state of the private CA does not allow this action to occur.
 //
-//   * ErrCodeRequestFailedException "RequestFailedException"
-//   The request has failed for an unspecified reason.
+//   - RequestFailedException
+//     The request has failed for an unspecified reason.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/DeletePermission
 func (c *ACMPCA) DeletePermission(input *DeletePermissionInput) (*DeletePermissionOutput, error) {
@@ -524,6 +584,137 @@ func (c *ACMPCA) DeletePermissionWithContext(ctx aws.Context, input *DeletePermi
 	return out, req.Send()
 }
 
+const opDeletePolicy = "DeletePolicy"
+
+// DeletePolicyRequest generates a "aws/request.Request" representing the
+// client's request for the DeletePolicy operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See DeletePolicy for more information on using the DeletePolicy
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the DeletePolicyRequest method.
+//	req, resp := client.DeletePolicyRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/DeletePolicy
+func (c *ACMPCA) DeletePolicyRequest(input *DeletePolicyInput) (req *request.Request, output *DeletePolicyOutput) {
+	op := &request.Operation{
+		Name:       opDeletePolicy,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &DeletePolicyInput{}
+	}
+
+	output = &DeletePolicyOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// DeletePolicy API operation for AWS Certificate Manager Private Certificate Authority.
+//
+// Deletes the resource-based policy attached to a private CA. Deletion will
+// remove any access that the policy has granted. If there is no policy attached
+// to the private CA, this action will return successful.
+//
+// If you delete a policy that was applied through Amazon Web Services Resource
+// Access Manager (RAM), the CA will be removed from all shares in which it
+// was included.
+//
+// The Certificate Manager Service Linked Role that the policy supports is not
+// affected when you delete the policy.
+//
+// The current policy can be shown with GetPolicy (https://docs.aws.amazon.com/privateca/latest/APIReference/API_GetPolicy.html)
+// and updated with PutPolicy (https://docs.aws.amazon.com/privateca/latest/APIReference/API_PutPolicy.html).
+//
+// About Policies
+//
+//   - A policy grants access on a private CA to an Amazon Web Services customer
+//     account, to Amazon Web Services Organizations, or to an Amazon Web Services
+//     Organizations unit. Policies are under the control of a CA administrator.
+//     For more information, see Using a Resource Based Policy with Amazon Web
+//     Services Private CA (https://docs.aws.amazon.com/privateca/latest/userguide/pca-rbp.html).
+//
+//   - A policy permits a user of Certificate Manager (ACM) to issue ACM certificates
+//     signed by a CA in another account.
+//
+//   - For ACM to manage automatic renewal of these certificates, the ACM user
+//     must configure a Service Linked Role (SLR). The SLR allows the ACM service
+//     to assume the identity of the user, subject to confirmation against the
+//     Amazon Web Services Private CA policy. For more information, see Using
+//     a Service Linked Role with ACM (https://docs.aws.amazon.com/acm/latest/userguide/acm-slr.html).
+//
+//   - Updates made in Amazon Web Services Resource Manager (RAM) are reflected
+//     in policies. For more information, see Attach a Policy for Cross-Account
+//     Access (https://docs.aws.amazon.com/privateca/latest/userguide/pca-ram.html).
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Certificate Manager Private Certificate Authority's
+// API operation DeletePolicy for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ConcurrentModificationException
+//     A previous update to your private CA is still ongoing.
+//
+//   - InvalidArnException
+//     The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+//
+//   - InvalidStateException
+//     The state of the private CA does not allow this action to occur.
+//
+//   - LockoutPreventedException
+//     The current action was prevented because it would lock the caller out from
+//     performing subsequent actions. Verify that the specified parameters would
+//     not result in the caller being denied access to the resource.
+//
+//   - RequestFailedException
+//     The request has failed for an unspecified reason.
+//
+//   - ResourceNotFoundException
+//     A resource such as a private CA, S3 bucket, certificate, audit report, or
+//     policy cannot be found.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/DeletePolicy
+func (c *ACMPCA) DeletePolicy(input *DeletePolicyInput) (*DeletePolicyOutput, error) {
+	req, out := c.DeletePolicyRequest(input)
+	return out, req.Send()
+}
+
+// DeletePolicyWithContext is the same as DeletePolicy with the addition of
+// the ability to pass a context and additional request options.
+//
+// See DeletePolicy for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *ACMPCA) DeletePolicyWithContext(ctx aws.Context, input *DeletePolicyInput, opts ...request.Option) (*DeletePolicyOutput, error) {
+	req, out := c.DeletePolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opDescribeCertificateAuthority = "DescribeCertificateAuthority"
 
 // DescribeCertificateAuthorityRequest generates a "aws/request.Request" representing the
@@ -540,14 +731,13 @@ const opDescribeCertificateAuthority = "DescribeCertificateAuthority"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeCertificateAuthorityRequest method.
+//	req, resp := client.DescribeCertificateAuthorityRequest(params)
 //
-//    // Example sending a request using the DescribeCertificateAuthorityRequest method.
-//    req, resp := client.DescribeCertificateAuthorityRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/DescribeCertificateAuthority
 func (c *ACMPCA) DescribeCertificateAuthorityRequest(input *DescribeCertificateAuthorityInput) (req *request.Request, output *DescribeCertificateAuthorityOutput) {
@@ -568,29 +758,33 @@ func (c *ACMPCA) DescribeCertificateAuthorityRequest(input *DescribeCertificateA
 
 // DescribeCertificateAuthority API operation for AWS Certificate Manager Private Certificate Authority.
 //
-// Lists information about your private certificate authority (CA). You specify
-// the private CA on input by its ARN (Amazon Resource Name). The output contains
-// the status of your CA. This can be any of the following:
+// Lists information about your private certificate authority (CA) or one that
+// has been shared with you. You specify the private CA on input by its ARN
+// (Amazon Resource Name). The output contains the status of your CA. This can
+// be any of the following:
 //
-//    * CREATING - ACM Private CA is creating your private certificate authority.
+//   - CREATING - Amazon Web Services Private CA is creating your private certificate
+//     authority.
 //
-//    * PENDING_CERTIFICATE - The certificate is pending. You must use your
-//    ACM Private CA-hosted or on-premises root or subordinate CA to sign your
-//    private CA CSR and then import it into PCA.
+//   - PENDING_CERTIFICATE - The certificate is pending. You must use your
+//     Amazon Web Services Private CA-hosted or on-premises root or subordinate
+//     CA to sign your private CA CSR and then import it into Amazon Web Services
+//     Private CA.
 //
-//    * ACTIVE - Your private CA is active.
+//   - ACTIVE - Your private CA is active.
 //
-//    * DISABLED - Your private CA has been disabled.
+//   - DISABLED - Your private CA has been disabled.
 //
-//    * EXPIRED - Your private CA certificate has expired.
+//   - EXPIRED - Your private CA certificate has expired.
 //
-//    * FAILED - Your private CA has failed. Your CA can fail because of problems
-//    such a network outage or backend AWS failure or other errors. A failed
-//    CA can never return to the pending state. You must create a new CA.
+//   - FAILED - Your private CA has failed. Your CA can fail because of problems
+//     such a network outage or back-end Amazon Web Services failure or other
+//     errors. A failed CA can never return to the pending state. You must create
+//     a new CA.
 //
-//    * DELETED - Your private CA is within the restoration period, after which
-//    it is permanently deleted. The length of time remaining in the CA's restoration
-//    period is also included in this action's output.
+//   - DELETED - Your private CA is within the restoration period, after which
+//     it is permanently deleted. The length of time remaining in the CA's restoration
+//     period is also included in this action's output.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -599,13 +793,14 @@ func (c *ACMPCA) DescribeCertificateAuthorityRequest(input *DescribeCertificateA
 // See the AWS API reference guide for AWS Certificate Manager Private Certificate Authority's
 // API operation DescribeCertificateAuthority for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource such as a private CA, S3 bucket, certificate, or audit report
-//   cannot be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidArnException "InvalidArnException"
-//   The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+//   - ResourceNotFoundException
+//     A resource such as a private CA, S3 bucket, certificate, audit report, or
+//     policy cannot be found.
+//
+//   - InvalidArnException
+//     The requested Amazon Resource Name (ARN) does not refer to an existing resource.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/DescribeCertificateAuthority
 func (c *ACMPCA) DescribeCertificateAuthority(input *DescribeCertificateAuthorityInput) (*DescribeCertificateAuthorityOutput, error) {
@@ -645,14 +840,13 @@ const opDescribeCertificateAuthorityAuditReport = "DescribeCertificateAuthorityA
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeCertificateAuthorityAuditReportRequest method.
+//	req, resp := client.DescribeCertificateAuthorityAuditReportRequest(params)
 //
-//    // Example sending a request using the DescribeCertificateAuthorityAuditReportRequest method.
-//    req, resp := client.DescribeCertificateAuthorityAuditReportRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/DescribeCertificateAuthorityAuditReport
 func (c *ACMPCA) DescribeCertificateAuthorityAuditReportRequest(input *DescribeCertificateAuthorityAuditReportInput) (req *request.Request, output *DescribeCertificateAuthorityAuditReportOutput) {
@@ -674,9 +868,12 @@ func (c *ACMPCA) DescribeCertificateAuthorityAuditReportRequest(input *DescribeC
 // DescribeCertificateAuthorityAuditReport API operation for AWS Certificate Manager Private Certificate Authority.
 //
 // Lists information about a specific audit report created by calling the CreateCertificateAuthorityAuditReport
+// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreateCertificateAuthorityAuditReport.html)
 // action. Audit information is created every time the certificate authority
 // (CA) private key is used. The private key is used when you call the IssueCertificate
-// action or the RevokeCertificate action.
+// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_IssueCertificate.html)
+// action or the RevokeCertificate (https://docs.aws.amazon.com/privateca/latest/APIReference/API_RevokeCertificate.html)
+// action.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -685,16 +882,17 @@ func (c *ACMPCA) DescribeCertificateAuthorityAuditReportRequest(input *DescribeC
 // See the AWS API reference guide for AWS Certificate Manager Private Certificate Authority's
 // API operation DescribeCertificateAuthorityAuditReport for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource such as a private CA, S3 bucket, certificate, or audit report
-//   cannot be found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     A resource such as a private CA, S3 bucket, certificate, audit report, or
+//     policy cannot be found.
 //
-//   * ErrCodeInvalidArnException "InvalidArnException"
-//   The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+//   - InvalidArnException
+//     The requested Amazon Resource Name (ARN) does not refer to an existing resource.
 //
-//   * ErrCodeInvalidArgsException "InvalidArgsException"
-//   One or more of the specified arguments was not valid.
+//   - InvalidArgsException
+//     One or more of the specified arguments was not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/DescribeCertificateAuthorityAuditReport
 func (c *ACMPCA) DescribeCertificateAuthorityAuditReport(input *DescribeCertificateAuthorityAuditReportInput) (*DescribeCertificateAuthorityAuditReportOutput, error) {
@@ -734,14 +932,13 @@ const opGetCertificate = "GetCertificate"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetCertificateRequest method.
+//	req, resp := client.GetCertificateRequest(params)
 //
-//    // Example sending a request using the GetCertificateRequest method.
-//    req, resp := client.GetCertificateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/GetCertificate
 func (c *ACMPCA) GetCertificateRequest(input *GetCertificateInput) (req *request.Request, output *GetCertificateOutput) {
@@ -762,12 +959,14 @@ func (c *ACMPCA) GetCertificateRequest(input *GetCertificateInput) (req *request
 
 // GetCertificate API operation for AWS Certificate Manager Private Certificate Authority.
 //
-// Retrieves a certificate from your private CA. The ARN of the certificate
-// is returned when you call the IssueCertificate action. You must specify both
-// the ARN of your private CA and the ARN of the issued certificate when calling
-// the GetCertificate action. You can retrieve the certificate if it is in the
-// ISSUED state. You can call the CreateCertificateAuthorityAuditReport action
-// to create a report that contains information about all of the certificates
+// Retrieves a certificate from your private CA or one that has been shared
+// with you. The ARN of the certificate is returned when you call the IssueCertificate
+// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_IssueCertificate.html)
+// action. You must specify both the ARN of your private CA and the ARN of the
+// issued certificate when calling the GetCertificate action. You can retrieve
+// the certificate if it is in the ISSUED state. You can call the CreateCertificateAuthorityAuditReport
+// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreateCertificateAuthorityAuditReport.html)
+// action to create a report that contains information about all of the certificates
 // issued and revoked by your private CA.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
@@ -777,23 +976,23 @@ func (c *ACMPCA) GetCertificateRequest(input *GetCertificateInput) (req *request
 // See the AWS API reference guide for AWS Certificate Manager Private Certificate Authority's
 // API operation GetCertificate for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeRequestInProgressException "RequestInProgressException"
-//   Your request is already in progress.
+// Returned Error Types:
+//
+//   - RequestInProgressException
+//     Your request is already in progress.
 //
-//   * ErrCodeRequestFailedException "RequestFailedException"
-//   The request has failed for an unspecified reason.
+//   - RequestFailedException
+//     The request has failed for an unspecified reason.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource such as a private CA, S3 bucket, certificate, or audit report
-//   cannot be found.
+//   - ResourceNotFoundException
+//     A resource such as a private CA, S3 bucket, certificate, audit report, or
+//     policy cannot be found.
 //
-//   * ErrCodeInvalidArnException "InvalidArnException"
-//   The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+//   - InvalidArnException
+//     The requested Amazon Resource Name (ARN) does not refer to an existing resource.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   The private CA is in a state during which a report or certificate cannot
-//   be generated.
+//   - InvalidStateException
+//     The state of the private CA does not allow this action to occur.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/GetCertificate
 func (c *ACMPCA) GetCertificate(input *GetCertificateInput) (*GetCertificateOutput, error) {
@@ -833,14 +1032,13 @@ const opGetCertificateAuthorityCertificate = "GetCertificateAuthorityCertificate
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetCertificateAuthorityCertificateRequest method.
+//	req, resp := client.GetCertificateAuthorityCertificateRequest(params)
 //
-//    // Example sending a request using the GetCertificateAuthorityCertificateRequest method.
-//    req, resp := client.GetCertificateAuthorityCertificateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/GetCertificateAuthorityCertificate
 func (c *ACMPCA) GetCertificateAuthorityCertificateRequest(input *GetCertificateAuthorityCertificateInput) (req *request.Request, output *GetCertificateAuthorityCertificateOutput) {
@@ -862,9 +1060,9 @@ func (c *ACMPCA) GetCertificateAuthorityCertificateRequest(input *GetCertificate
 // GetCertificateAuthorityCertificate API operation for AWS Certificate Manager Private Certificate Authority.
 //
 // Retrieves the certificate and certificate chain for your private certificate
-// authority (CA). Both the certificate and the chain are base64 PEM-encoded.
-// The chain does not include the CA certificate. Each certificate in the chain
-// signs the one before it.
+// authority (CA) or one that has been shared with you. Both the certificate
+// and the chain are base64 PEM-encoded. The chain does not include the CA certificate.
+// Each certificate in the chain signs the one before it.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -873,17 +1071,17 @@ func (c *ACMPCA) GetCertificateAuthorityCertificateRequest(input *GetCertificate
 // See the AWS API reference guide for AWS Certificate Manager Private Certificate Authority's
 // API operation GetCertificateAuthorityCertificate for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource such as a private CA, S3 bucket, certificate, or audit report
-//   cannot be found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     A resource such as a private CA, S3 bucket, certificate, audit report, or
+//     policy cannot be found.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   The private CA is in a state during which a report or certificate cannot
-//   be generated.
+//   - InvalidStateException
+//     The state of the private CA does not allow this action to occur.
 //
-//   * ErrCodeInvalidArnException "InvalidArnException"
-//   The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+//   - InvalidArnException
+//     The requested Amazon Resource Name (ARN) does not refer to an existing resource.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/GetCertificateAuthorityCertificate
 func (c *ACMPCA) GetCertificateAuthorityCertificate(input *GetCertificateAuthorityCertificateInput) (*GetCertificateAuthorityCertificateOutput, error) {
@@ -923,14 +1121,13 @@ const opGetCertificateAuthorityCsr = "GetCertificateAuthorityCsr"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetCertificateAuthorityCsrRequest method.
+//	req, resp := client.GetCertificateAuthorityCsrRequest(params)
 //
-//    // Example sending a request using the GetCertificateAuthorityCsrRequest method.
-//    req, resp := client.GetCertificateAuthorityCsrRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/GetCertificateAuthorityCsr
 func (c *ACMPCA) GetCertificateAuthorityCsrRequest(input *GetCertificateAuthorityCsrInput) (req *request.Request, output *GetCertificateAuthorityCsrOutput) {
@@ -953,10 +1150,12 @@ func (c *ACMPCA) GetCertificateAuthorityCsrRequest(input *GetCertificateAuthorit
 //
 // Retrieves the certificate signing request (CSR) for your private certificate
 // authority (CA). The CSR is created when you call the CreateCertificateAuthority
-// action. Sign the CSR with your ACM Private CA-hosted or on-premises root
-// or subordinate CA. Then import the signed certificate back into ACM Private
-// CA by calling the ImportCertificateAuthorityCertificate action. The CSR is
-// returned as a base64 PEM-encoded string.
+// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreateCertificateAuthority.html)
+// action. Sign the CSR with your Amazon Web Services Private CA-hosted or on-premises
+// root or subordinate CA. Then import the signed certificate back into Amazon
+// Web Services Private CA by calling the ImportCertificateAuthorityCertificate
+// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_ImportCertificateAuthorityCertificate.html)
+// action. The CSR is returned as a base64 PEM-encoded string.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -965,23 +1164,23 @@ func (c *ACMPCA) GetCertificateAuthorityCsrRequest(input *GetCertificateAuthorit
 // See the AWS API reference guide for AWS Certificate Manager Private Certificate Authority's
 // API operation GetCertificateAuthorityCsr for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeRequestInProgressException "RequestInProgressException"
-//   Your request is already in progress.
+// Returned Error Types:
+//
+//   - RequestInProgressException
+//     Your request is already in progress.
 //
-//   * ErrCodeRequestFailedException "RequestFailedException"
-//   The request has failed for an unspecified reason.
+//   - RequestFailedException
+//     The request has failed for an unspecified reason.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource such as a private CA, S3 bucket, certificate, or audit report
-//   cannot be found.
+//   - ResourceNotFoundException
+//     A resource such as a private CA, S3 bucket, certificate, audit report, or
+//     policy cannot be found.
 //
-//   * ErrCodeInvalidArnException "InvalidArnException"
-//   The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+//   - InvalidArnException
+//     The requested Amazon Resource Name (ARN) does not refer to an existing resource.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   The private CA is in a state during which a report or certificate cannot
-//   be generated.
+//   - InvalidStateException
+//     The state of the private CA does not allow this action to occur.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/GetCertificateAuthorityCsr
 func (c *ACMPCA) GetCertificateAuthorityCsr(input *GetCertificateAuthorityCsrInput) (*GetCertificateAuthorityCsrOutput, error) {
@@ -1005,6 +1204,121 @@ func (c *ACMPCA) GetCertificateAuthorityCsrWithContext(ctx aws.Context, input *G
 	return out, req.Send()
 }
 
+const opGetPolicy = "GetPolicy"
+
+// GetPolicyRequest generates a "aws/request.Request" representing the
+// client's request for the GetPolicy operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See GetPolicy for more information on using the GetPolicy
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the GetPolicyRequest method.
+//	req, resp := client.GetPolicyRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/GetPolicy
+func (c *ACMPCA) GetPolicyRequest(input *GetPolicyInput) (req *request.Request, output *GetPolicyOutput) {
+	op := &request.Operation{
+		Name:       opGetPolicy,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &GetPolicyInput{}
+	}
+
+	output = &GetPolicyOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// GetPolicy API operation for AWS Certificate Manager Private Certificate Authority.
+//
+// Retrieves the resource-based policy attached to a private CA. If either the
+// private CA resource or the policy cannot be found, this action returns a
+// ResourceNotFoundException.
+//
+// The policy can be attached or updated with PutPolicy (https://docs.aws.amazon.com/privateca/latest/APIReference/API_PutPolicy.html)
+// and removed with DeletePolicy (https://docs.aws.amazon.com/privateca/latest/APIReference/API_DeletePolicy.html).
+//
+// About Policies
+//
+//   - A policy grants access on a private CA to an Amazon Web Services customer
+//     account, to Amazon Web Services Organizations, or to an Amazon Web Services
+//     Organizations unit. Policies are under the control of a CA administrator.
+//     For more information, see Using a Resource Based Policy with Amazon Web
+//     Services Private CA (https://docs.aws.amazon.com/privateca/latest/userguide/pca-rbp.html).
+//
+//   - A policy permits a user of Certificate Manager (ACM) to issue ACM certificates
+//     signed by a CA in another account.
+//
+//   - For ACM to manage automatic renewal of these certificates, the ACM user
+//     must configure a Service Linked Role (SLR). The SLR allows the ACM service
+//     to assume the identity of the user, subject to confirmation against the
+//     Amazon Web Services Private CA policy. For more information, see Using
+//     a Service Linked Role with ACM (https://docs.aws.amazon.com/acm/latest/userguide/acm-slr.html).
+//
+//   - Updates made in Amazon Web Services Resource Manager (RAM) are reflected
+//     in policies. For more information, see Attach a Policy for Cross-Account
+//     Access (https://docs.aws.amazon.com/privateca/latest/userguide/pca-ram.html).
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Certificate Manager Private Certificate Authority's
+// API operation GetPolicy for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidArnException
+//     The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+//
+//   - InvalidStateException
+//     The state of the private CA does not allow this action to occur.
+//
+//   - RequestFailedException
+//     The request has failed for an unspecified reason.
+//
+//   - ResourceNotFoundException
+//     A resource such as a private CA, S3 bucket, certificate, audit report, or
+//     policy cannot be found.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/GetPolicy
+func (c *ACMPCA) GetPolicy(input *GetPolicyInput) (*GetPolicyOutput, error) {
+	req, out := c.GetPolicyRequest(input)
+	return out, req.Send()
+}
+
+// GetPolicyWithContext is the same as GetPolicy with the addition of
+// the ability to pass a context and additional request options.
+//
+// See GetPolicy for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *ACMPCA) GetPolicyWithContext(ctx aws.Context, input *GetPolicyInput, opts ...request.Option) (*GetPolicyOutput, error) {
+	req, out := c.GetPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opImportCertificateAuthorityCertificate = "ImportCertificateAuthorityCertificate"
 
 // ImportCertificateAuthorityCertificateRequest generates a "aws/request.Request" representing the
@@ -1021,14 +1335,13 @@ const opImportCertificateAuthorityCertificate = "ImportCertificateAuthorityCerti
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ImportCertificateAuthorityCertificateRequest method.
+//	req, resp := client.ImportCertificateAuthorityCertificateRequest(params)
 //
-//    // Example sending a request using the ImportCertificateAuthorityCertificateRequest method.
-//    req, resp := client.ImportCertificateAuthorityCertificateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/ImportCertificateAuthorityCertificate
 func (c *ACMPCA) ImportCertificateAuthorityCertificateRequest(input *ImportCertificateAuthorityCertificateInput) (req *request.Request, output *ImportCertificateAuthorityCertificateOutput) {
@@ -1050,40 +1363,98 @@ func (c *ACMPCA) ImportCertificateAuthorityCertificateRequest(input *ImportCerti
 
 // ImportCertificateAuthorityCertificate API operation for AWS Certificate Manager Private Certificate Authority.
 //
-// Imports a signed private CA certificate into ACM Private CA. This action
-// is used when you are using a chain of trust whose root is located outside
-// ACM Private CA. Before you can call this action, the following preparations
-// must in place:
+// Imports a signed private CA certificate into Amazon Web Services Private
+// CA. This action is used when you are using a chain of trust whose root is
+// located outside Amazon Web Services Private CA. Before you can call this
+// action, the following preparations must in place:
 //
-// In ACM Private CA, call the CreateCertificateAuthority action to create the
-// private CA that that you plan to back with the imported certificate.
+// In Amazon Web Services Private CA, call the CreateCertificateAuthority (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreateCertificateAuthority.html)
+// action to create the private CA that you plan to back with the imported certificate.
 //
-// Call the GetCertificateAuthorityCsr action to generate a certificate signing
-// request (CSR).
+// Call the GetCertificateAuthorityCsr (https://docs.aws.amazon.com/privateca/latest/APIReference/API_GetCertificateAuthorityCsr.html)
+// action to generate a certificate signing request (CSR).
 //
-// Sign the CSR using a root or intermediate CA hosted either by an on-premises
-// PKI hierarchy or a commercial CA..
+// Sign the CSR using a root or intermediate CA hosted by either an on-premises
+// PKI hierarchy or by a commercial CA.
 //
 // Create a certificate chain and copy the signed certificate and the certificate
 // chain to your working directory.
 //
-// The following requirements apply when you import a CA certificate.
+// Amazon Web Services Private CA supports three scenarios for installing a
+// CA certificate:
+//
+//   - Installing a certificate for a root CA hosted by Amazon Web Services
+//     Private CA.
+//
+//   - Installing a subordinate CA certificate whose parent authority is hosted
+//     by Amazon Web Services Private CA.
+//
+//   - Installing a subordinate CA certificate whose parent authority is externally
+//     hosted.
+//
+// The following additional requirements apply when you import a CA certificate.
+//
+//   - Only a self-signed certificate can be imported as a root CA.
+//
+//   - A self-signed certificate cannot be imported as a subordinate CA.
+//
+//   - Your certificate chain must not include the private CA certificate that
+//     you are importing.
+//
+//   - Your root CA must be the last certificate in your chain. The subordinate
+//     certificate, if any, that your root CA signed must be next to last. The
+//     subordinate certificate signed by the preceding subordinate CA must come
+//     next, and so on until your chain is built.
+//
+//   - The chain must be PEM-encoded.
+//
+//   - The maximum allowed size of a certificate is 32 KB.
+//
+//   - The maximum allowed size of a certificate chain is 2 MB.
+//
+// # Enforcement of Critical Constraints
+//
+// Amazon Web Services Private CA allows the following extensions to be marked
+// critical in the imported CA certificate or chain.
+//
+//   - Basic constraints (must be marked critical)
+//
+//   - Subject alternative names
+//
+//   - Key usage
+//
+//   - Extended key usage
+//
+//   - Authority key identifier
+//
+//   - Subject key identifier
+//
+//   - Issuer alternative name
+//
+//   - Subject directory attributes
+//
+//   - Subject information access
+//
+//   - Certificate policies
+//
+//   - Policy mappings
+//
+//   - Inhibit anyPolicy
+//
+// Amazon Web Services Private CA rejects the following extensions when they
+// are marked critical in an imported CA certificate or chain.
 //
-//    * You cannot import a non-self-signed certificate for use as a root CA.
+//   - Name constraints
 //
-//    * You cannot import a self-signed certificate for use as a subordinate
-//    CA.
+//   - Policy constraints
 //
-//    * Your certificate chain must not include the private CA certificate that
-//    you are importing.
+//   - CRL distribution points
 //
-//    * Your ACM Private CA-hosted or on-premises CA certificate must be the
-//    last certificate in your chain. The subordinate certificate, if any, that
-//    your root CA signed must be next to last. The subordinate certificate
-//    signed by the preceding subordinate CA must come next, and so on until
-//    your chain is built.
+//   - Authority information access
 //
-//    * The chain must be PEM-encoded.
+//   - Freshest CRL
+//
+//   - Any other extension
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1092,36 +1463,36 @@ func (c *ACMPCA) ImportCertificateAuthorityCertificateRequest(input *ImportCerti
 // See the AWS API reference guide for AWS Certificate Manager Private Certificate Authority's
 // API operation ImportCertificateAuthorityCertificate for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   A previous update to your private CA is still ongoing.
+// Returned Error Types:
+//
+//   - ConcurrentModificationException
+//     A previous update to your private CA is still ongoing.
 //
-//   * ErrCodeRequestInProgressException "RequestInProgressException"
-//   Your request is already in progress.
+//   - RequestInProgressException
+//     Your request is already in progress.
 //
-//   * ErrCodeRequestFailedException "RequestFailedException"
-//   The request has failed for an unspecified reason.
+//   - RequestFailedException
+//     The request has failed for an unspecified reason.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource such as a private CA, S3 bucket, certificate, or audit report
-//   cannot be found.
+//   - ResourceNotFoundException
+//     A resource such as a private CA, S3 bucket, certificate, audit report, or
+//     policy cannot be found.
 //
-//   * ErrCodeInvalidArnException "InvalidArnException"
-//   The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+//   - InvalidArnException
+//     The requested Amazon Resource Name (ARN) does not refer to an existing resource.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request action cannot be performed or is prohibited.
+//   - InvalidRequestException
+//     The request action cannot be performed or is prohibited.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   The private CA is in a state during which a report or certificate cannot
-//   be generated.
+//   - InvalidStateException
+//     The state of the private CA does not allow this action to occur.
 //
-//   * ErrCodeMalformedCertificateException "MalformedCertificateException"
-//   One or more fields in the certificate are invalid.
+//   - MalformedCertificateException
+//     One or more fields in the certificate are invalid.
 //
-//   * ErrCodeCertificateMismatchException "CertificateMismatchException"
-//   The certificate authority certificate you are importing does not comply with
-//   conditions specified in the certificate that signed it.
+//   - CertificateMismatchException
+//     The certificate authority certificate you are importing does not comply with
+//     conditions specified in the certificate that signed it.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/ImportCertificateAuthorityCertificate
 func (c *ACMPCA) ImportCertificateAuthorityCertificate(input *ImportCertificateAuthorityCertificateInput) (*ImportCertificateAuthorityCertificateOutput, error) {
@@ -1161,14 +1532,13 @@ const opIssueCertificate = "IssueCertificate"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the IssueCertificateRequest method.
+//	req, resp := client.IssueCertificateRequest(params)
 //
-//    // Example sending a request using the IssueCertificateRequest method.
-//    req, resp := client.IssueCertificateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/IssueCertificate
 func (c *ACMPCA) IssueCertificateRequest(input *IssueCertificateInput) (req *request.Request, output *IssueCertificateOutput) {
@@ -1189,13 +1559,15 @@ func (c *ACMPCA) IssueCertificateRequest(input *IssueCertificateInput) (req *req
 
 // IssueCertificate API operation for AWS Certificate Manager Private Certificate Authority.
 //
-// Uses your private certificate authority (CA) to issue a client certificate.
-// This action returns the Amazon Resource Name (ARN) of the certificate. You
-// can retrieve the certificate by calling the GetCertificate action and specifying
-// the ARN.
+// Uses your private certificate authority (CA), or one that has been shared
+// with you, to issue a client certificate. This action returns the Amazon Resource
+// Name (ARN) of the certificate. You can retrieve the certificate by calling
+// the GetCertificate (https://docs.aws.amazon.com/privateca/latest/APIReference/API_GetCertificate.html)
+// action and specifying the ARN.
 //
 // You cannot use the ACM ListCertificateAuthorities action to retrieve the
-// ARNs of the certificates that you issue by using ACM Private CA.
+// ARNs of the certificates that you issue by using Amazon Web Services Private
+// CA.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1204,27 +1576,27 @@ func (c *ACMPCA) IssueCertificateRequest(input *IssueCertificateInput) (req *req
 // See the AWS API reference guide for AWS Certificate Manager Private Certificate Authority's
 // API operation IssueCertificate for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   An ACM Private CA limit has been exceeded. See the exception message returned
-//   to determine the limit that was exceeded.
+// Returned Error Types:
+//
+//   - LimitExceededException
+//     An Amazon Web Services Private CA quota has been exceeded. See the exception
+//     message returned to determine the quota that was exceeded.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource such as a private CA, S3 bucket, certificate, or audit report
-//   cannot be found.
+//   - ResourceNotFoundException
+//     A resource such as a private CA, S3 bucket, certificate, audit report, or
+//     policy cannot be found.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   The private CA is in a state during which a report or certificate cannot
-//   be generated.
+//   - InvalidStateException
+//     The state of the private CA does not allow this action to occur.
 //
-//   * ErrCodeInvalidArnException "InvalidArnException"
-//   The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+//   - InvalidArnException
+//     The requested Amazon Resource Name (ARN) does not refer to an existing resource.
 //
-//   * ErrCodeInvalidArgsException "InvalidArgsException"
-//   One or more of the specified arguments was not valid.
+//   - InvalidArgsException
+//     One or more of the specified arguments was not valid.
 //
-//   * ErrCodeMalformedCSRException "MalformedCSRException"
-//   The certificate signing request is invalid.
+//   - MalformedCSRException
+//     The certificate signing request is invalid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/IssueCertificate
 func (c *ACMPCA) IssueCertificate(input *IssueCertificateInput) (*IssueCertificateOutput, error) {
@@ -1264,14 +1636,13 @@ const opListCertificateAuthorities = "ListCertificateAuthorities"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListCertificateAuthoritiesRequest method.
+//	req, resp := client.ListCertificateAuthoritiesRequest(params)
 //
-//    // Example sending a request using the ListCertificateAuthoritiesRequest method.
-//    req, resp := client.ListCertificateAuthoritiesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/ListCertificateAuthorities
 func (c *ACMPCA) ListCertificateAuthoritiesRequest(input *ListCertificateAuthoritiesInput) (req *request.Request, output *ListCertificateAuthoritiesOutput) {
@@ -1299,6 +1670,7 @@ func (c *ACMPCA) ListCertificateAuthoritiesRequest(input *ListCertificateAuthori
 // ListCertificateAuthorities API operation for AWS Certificate Manager Private Certificate Authority.
 //
 // Lists the private certificate authorities that you created by using the CreateCertificateAuthority
+// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreateCertificateAuthority.html)
 // action.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
@@ -1308,10 +1680,10 @@ func (c *ACMPCA) ListCertificateAuthoritiesRequest(input *ListCertificateAuthori
 // See the AWS API reference guide for AWS Certificate Manager Private Certificate Authority's
 // API operation ListCertificateAuthorities for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidNextTokenException "InvalidNextTokenException"
-//   The token specified in the NextToken argument is not valid. Use the token
-//   returned from your previous call to ListCertificateAuthorities.
+// Returned Error Types:
+//   - InvalidNextTokenException
+//     The token specified in the NextToken argument is not valid. Use the token
+//     returned from your previous call to ListCertificateAuthorities (https://docs.aws.amazon.com/privateca/latest/APIReference/API_ListCertificateAuthorities.html).
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/ListCertificateAuthorities
 func (c *ACMPCA) ListCertificateAuthorities(input *ListCertificateAuthoritiesInput) (*ListCertificateAuthoritiesOutput, error) {
@@ -1343,15 +1715,14 @@ func (c *ACMPCA) ListCertificateAuthoritiesWithContext(ctx aws.Context, input *L
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListCertificateAuthorities operation.
-//    pageNum := 0
-//    err := client.ListCertificateAuthoritiesPages(params,
-//        func(page *acmpca.ListCertificateAuthoritiesOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListCertificateAuthorities operation.
+//	pageNum := 0
+//	err := client.ListCertificateAuthoritiesPages(params,
+//	    func(page *acmpca.ListCertificateAuthoritiesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ACMPCA) ListCertificateAuthoritiesPages(input *ListCertificateAuthoritiesInput, fn func(*ListCertificateAuthoritiesOutput, bool) bool) error {
 	return c.ListCertificateAuthoritiesPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1378,10 +1749,12 @@ func (c *ACMPCA) ListCertificateAuthoritiesPagesWithContext(ctx aws.Context, inp
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListCertificateAuthoritiesOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListCertificateAuthoritiesOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -1401,14 +1774,13 @@ const opListPermissions = "ListPermissions"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListPermissionsRequest method.
+//	req, resp := client.ListPermissionsRequest(params)
 //
-//    // Example sending a request using the ListPermissionsRequest method.
-//    req, resp := client.ListPermissionsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/ListPermissions
 func (c *ACMPCA) ListPermissionsRequest(input *ListPermissionsInput) (req *request.Request, output *ListPermissionsOutput) {
@@ -1435,9 +1807,30 @@ func (c *ACMPCA) ListPermissionsRequest(input *ListPermissionsInput) (req *reque
 
 // ListPermissions API operation for AWS Certificate Manager Private Certificate Authority.
 //
-// Lists all the permissions, if any, that have been assigned by a private CA.
-// Permissions can be granted with the CreatePermission action and revoked with
-// the DeletePermission action.
+// List all permissions on a private CA, if any, granted to the Certificate
+// Manager (ACM) service principal (acm.amazonaws.com).
+//
+// These permissions allow ACM to issue and renew ACM certificates that reside
+// in the same Amazon Web Services account as the CA.
+//
+// Permissions can be granted with the CreatePermission (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreatePermission.html)
+// action and revoked with the DeletePermission (https://docs.aws.amazon.com/privateca/latest/APIReference/API_DeletePermission.html)
+// action.
+//
+// About Permissions
+//
+//   - If the private CA and the certificates it issues reside in the same
+//     account, you can use CreatePermission to grant permissions for ACM to
+//     carry out automatic certificate renewals.
+//
+//   - For automatic certificate renewal to succeed, the ACM service principal
+//     needs permissions to create, retrieve, and list certificates.
+//
+//   - If the private CA and the ACM certificates reside in different accounts,
+//     then permissions cannot be used to enable automatic renewals. Instead,
+//     the ACM certificate owner must set up a resource-based policy to enable
+//     cross-account issuance and renewals. For more information, see Using a
+//     Resource Based Policy with Amazon Web Services Private CA (https://docs.aws.amazon.com/privateca/latest/userguide/pca-rbp.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1446,24 +1839,24 @@ func (c *ACMPCA) ListPermissionsRequest(input *ListPermissionsInput) (req *reque
 // See the AWS API reference guide for AWS Certificate Manager Private Certificate Authority's
 // API operation ListPermissions for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource such as a private CA, S3 bucket, certificate, or audit report
-//   cannot be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidArnException "InvalidArnException"
-//   The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+//   - ResourceNotFoundException
+//     A resource such as a private CA, S3 bucket, certificate, audit report, or
+//     policy cannot be found.
 //
-//   * ErrCodeInvalidNextTokenException "InvalidNextTokenException"
-//   The token specified in the NextToken argument is not valid. Use the token
-//   returned from your previous call to ListCertificateAuthorities.
+//   - InvalidArnException
+//     The requested Amazon Resource Name (ARN) does not refer to an existing resource.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   The private CA is in a state during which a report or certificate cannot
-//   be generated.
+//   - InvalidNextTokenException
+//     The token specified in the NextToken argument is not valid. Use the token
+//     returned from your previous call to ListCertificateAuthorities (https://docs.aws.amazon.com/privateca/latest/APIReference/API_ListCertificateAuthorities.html).
 //
-//   * ErrCodeRequestFailedException "RequestFailedException"
-//   The request has failed for an unspecified reason.
+//   - InvalidStateException
+//     The state of the private CA does not allow this action to occur.
+//
+//   - RequestFailedException
+//     The request has failed for an unspecified reason.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/ListPermissions
 func (c *ACMPCA) ListPermissions(input *ListPermissionsInput) (*ListPermissionsOutput, error) {
@@ -1495,15 +1888,14 @@ func (c *ACMPCA) ListPermissionsWithContext(ctx aws.Context, input *ListPermissi
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListPermissions operation.
-//    pageNum := 0
-//    err := client.ListPermissionsPages(params,
-//        func(page *acmpca.ListPermissionsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListPermissions operation.
+//	pageNum := 0
+//	err := client.ListPermissionsPages(params,
+//	    func(page *acmpca.ListPermissionsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ACMPCA) ListPermissionsPages(input *ListPermissionsInput, fn func(*ListPermissionsOutput, bool) bool) error {
 	return c.ListPermissionsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1530,10 +1922,12 @@ func (c *ACMPCA) ListPermissionsPagesWithContext(ctx aws.Context, input *ListPer
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListPermissionsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListPermissionsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -1553,14 +1947,13 @@ const opListTags = "ListTags"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTagsRequest method.
+//	req, resp := client.ListTagsRequest(params)
 //
-//    // Example sending a request using the ListTagsRequest method.
-//    req, resp := client.ListTagsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/ListTags
 func (c *ACMPCA) ListTagsRequest(input *ListTagsInput) (req *request.Request, output *ListTagsOutput) {
@@ -1587,11 +1980,13 @@ func (c *ACMPCA) ListTagsRequest(input *ListTagsInput) (req *request.Request, ou
 
 // ListTags API operation for AWS Certificate Manager Private Certificate Authority.
 //
-// Lists the tags, if any, that are associated with your private CA. Tags are
-// labels that you can use to identify and organize your CAs. Each tag consists
-// of a key and an optional value. Call the TagCertificateAuthority action to
-// add one or more tags to your CA. Call the UntagCertificateAuthority action
-// to remove tags.
+// Lists the tags, if any, that are associated with your private CA or one that
+// has been shared with you. Tags are labels that you can use to identify and
+// organize your CAs. Each tag consists of a key and an optional value. Call
+// the TagCertificateAuthority (https://docs.aws.amazon.com/privateca/latest/APIReference/API_TagCertificateAuthority.html)
+// action to add one or more tags to your CA. Call the UntagCertificateAuthority
+// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_UntagCertificateAuthority.html)
+// action to remove tags.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1600,17 +1995,17 @@ func (c *ACMPCA) ListTagsRequest(input *ListTagsInput) (req *request.Request, ou
 // See the AWS API reference guide for AWS Certificate Manager Private Certificate Authority's
 // API operation ListTags for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource such as a private CA, S3 bucket, certificate, or audit report
-//   cannot be found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     A resource such as a private CA, S3 bucket, certificate, audit report, or
+//     policy cannot be found.
 //
-//   * ErrCodeInvalidArnException "InvalidArnException"
-//   The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+//   - InvalidArnException
+//     The requested Amazon Resource Name (ARN) does not refer to an existing resource.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   The private CA is in a state during which a report or certificate cannot
-//   be generated.
+//   - InvalidStateException
+//     The state of the private CA does not allow this action to occur.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/ListTags
 func (c *ACMPCA) ListTags(input *ListTagsInput) (*ListTagsOutput, error) {
@@ -1642,15 +2037,14 @@ func (c *ACMPCA) ListTagsWithContext(ctx aws.Context, input *ListTagsInput, opts
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListTags operation.
-//    pageNum := 0
-//    err := client.ListTagsPages(params,
-//        func(page *acmpca.ListTagsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListTags operation.
+//	pageNum := 0
+//	err := client.ListTagsPages(params,
+//	    func(page *acmpca.ListTagsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ACMPCA) ListTagsPages(input *ListTagsInput, fn func(*ListTagsOutput, bool) bool) error {
 	return c.ListTagsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1677,114 +2071,249 @@ func (c *ACMPCA) ListTagsPagesWithContext(ctx aws.Context, input *ListTagsInput,
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListTagsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListTagsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
-const opRestoreCertificateAuthority = "RestoreCertificateAuthority"
+const opPutPolicy = "PutPolicy"
 
-// RestoreCertificateAuthorityRequest generates a "aws/request.Request" representing the
-// client's request for the RestoreCertificateAuthority operation. The "output" return
+// PutPolicyRequest generates a "aws/request.Request" representing the
+// client's request for the PutPolicy operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See RestoreCertificateAuthority for more information on using the RestoreCertificateAuthority
+// See PutPolicy for more information on using the PutPolicy
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PutPolicyRequest method.
+//	req, resp := client.PutPolicyRequest(params)
 //
-//    // Example sending a request using the RestoreCertificateAuthorityRequest method.
-//    req, resp := client.RestoreCertificateAuthorityRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/RestoreCertificateAuthority
-func (c *ACMPCA) RestoreCertificateAuthorityRequest(input *RestoreCertificateAuthorityInput) (req *request.Request, output *RestoreCertificateAuthorityOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/PutPolicy
+func (c *ACMPCA) PutPolicyRequest(input *PutPolicyInput) (req *request.Request, output *PutPolicyOutput) {
 	op := &request.Operation{
-		Name:       opRestoreCertificateAuthority,
+		Name:       opPutPolicy,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &RestoreCertificateAuthorityInput{}
+		input = &PutPolicyInput{}
 	}
 
-	output = &RestoreCertificateAuthorityOutput{}
+	output = &PutPolicyOutput{}
 	req = c.newRequest(op, input, output)
 	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// RestoreCertificateAuthority API operation for AWS Certificate Manager Private Certificate Authority.
+// PutPolicy API operation for AWS Certificate Manager Private Certificate Authority.
 //
-// Restores a certificate authority (CA) that is in the DELETED state. You can
-// restore a CA during the period that you defined in the PermanentDeletionTimeInDays
-// parameter of the DeleteCertificateAuthority action. Currently, you can specify
-// 7 to 30 days. If you did not specify a PermanentDeletionTimeInDays value,
-// by default you can restore the CA at any time in a 30 day period. You can
-// check the time remaining in the restoration period of a private CA in the
-// DELETED state by calling the DescribeCertificateAuthority or ListCertificateAuthorities
-// actions. The status of a restored CA is set to its pre-deletion status when
-// the RestoreCertificateAuthority action returns. To change its status to ACTIVE,
-// call the UpdateCertificateAuthority action. If the private CA was in the
-// PENDING_CERTIFICATE state at deletion, you must use the ImportCertificateAuthorityCertificate
-// action to import a certificate authority into the private CA before it can
-// be activated. You cannot restore a CA after the restoration period has ended.
+// Attaches a resource-based policy to a private CA.
+//
+// A policy can also be applied by sharing a private CA through Amazon Web Services
+// Resource Access Manager (RAM). For more information, see Attach a Policy
+// for Cross-Account Access (https://docs.aws.amazon.com/privateca/latest/userguide/pca-ram.html).
+//
+// The policy can be displayed with GetPolicy (https://docs.aws.amazon.com/privateca/latest/APIReference/API_GetPolicy.html)
+// and removed with DeletePolicy (https://docs.aws.amazon.com/privateca/latest/APIReference/API_DeletePolicy.html).
+//
+// About Policies
+//
+//   - A policy grants access on a private CA to an Amazon Web Services customer
+//     account, to Amazon Web Services Organizations, or to an Amazon Web Services
+//     Organizations unit. Policies are under the control of a CA administrator.
+//     For more information, see Using a Resource Based Policy with Amazon Web
+//     Services Private CA (https://docs.aws.amazon.com/privateca/latest/userguide/pca-rbp.html).
+//
+//   - A policy permits a user of Certificate Manager (ACM) to issue ACM certificates
+//     signed by a CA in another account.
+//
+//   - For ACM to manage automatic renewal of these certificates, the ACM user
+//     must configure a Service Linked Role (SLR). The SLR allows the ACM service
+//     to assume the identity of the user, subject to confirmation against the
+//     Amazon Web Services Private CA policy. For more information, see Using
+//     a Service Linked Role with ACM (https://docs.aws.amazon.com/acm/latest/userguide/acm-slr.html).
+//
+//   - Updates made in Amazon Web Services Resource Manager (RAM) are reflected
+//     in policies. For more information, see Attach a Policy for Cross-Account
+//     Access (https://docs.aws.amazon.com/privateca/latest/userguide/pca-ram.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Certificate Manager Private Certificate Authority's
-// API operation RestoreCertificateAuthority for usage and error information.
+// API operation PutPolicy for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource such as a private CA, S3 bucket, certificate, or audit report
-//   cannot be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   The private CA is in a state during which a report or certificate cannot
-//   be generated.
+//   - ConcurrentModificationException
+//     A previous update to your private CA is still ongoing.
 //
-//   * ErrCodeInvalidArnException "InvalidArnException"
-//   The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+//   - InvalidArnException
+//     The requested Amazon Resource Name (ARN) does not refer to an existing resource.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/RestoreCertificateAuthority
-func (c *ACMPCA) RestoreCertificateAuthority(input *RestoreCertificateAuthorityInput) (*RestoreCertificateAuthorityOutput, error) {
-	req, out := c.RestoreCertificateAuthorityRequest(input)
+//   - InvalidStateException
+//     The state of the private CA does not allow this action to occur.
+//
+//   - InvalidPolicyException
+//     The resource policy is invalid or is missing a required statement. For general
+//     information about IAM policy and statement structure, see Overview of JSON
+//     Policies (https://docs.aws.amazon.com/IAM/latest/UserGuide/access_policies.html#access_policies-json).
+//
+//   - LockoutPreventedException
+//     The current action was prevented because it would lock the caller out from
+//     performing subsequent actions. Verify that the specified parameters would
+//     not result in the caller being denied access to the resource.
+//
+//   - RequestFailedException
+//     The request has failed for an unspecified reason.
+//
+//   - ResourceNotFoundException
+//     A resource such as a private CA, S3 bucket, certificate, audit report, or
+//     policy cannot be found.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/PutPolicy
+func (c *ACMPCA) PutPolicy(input *PutPolicyInput) (*PutPolicyOutput, error) {
+	req, out := c.PutPolicyRequest(input)
 	return out, req.Send()
 }
 
-// RestoreCertificateAuthorityWithContext is the same as RestoreCertificateAuthority with the addition of
+// PutPolicyWithContext is the same as PutPolicy with the addition of
 // the ability to pass a context and additional request options.
 //
-// See RestoreCertificateAuthority for details on how to use this API operation.
+// See PutPolicy for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *ACMPCA) RestoreCertificateAuthorityWithContext(ctx aws.Context, input *RestoreCertificateAuthorityInput, opts ...request.Option) (*RestoreCertificateAuthorityOutput, error) {
-	req, out := c.RestoreCertificateAuthorityRequest(input)
+func (c *ACMPCA) PutPolicyWithContext(ctx aws.Context, input *PutPolicyInput, opts ...request.Option) (*PutPolicyOutput, error) {
+	req, out := c.PutPolicyRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opRevokeCertificate = "RevokeCertificate"
+const opRestoreCertificateAuthority = "RestoreCertificateAuthority"
+
+// RestoreCertificateAuthorityRequest generates a "aws/request.Request" representing the
+// client's request for the RestoreCertificateAuthority operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See RestoreCertificateAuthority for more information on using the RestoreCertificateAuthority
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the RestoreCertificateAuthorityRequest method.
+//	req, resp := client.RestoreCertificateAuthorityRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/RestoreCertificateAuthority
+func (c *ACMPCA) RestoreCertificateAuthorityRequest(input *RestoreCertificateAuthorityInput) (req *request.Request, output *RestoreCertificateAuthorityOutput) {
+	op := &request.Operation{
+		Name:       opRestoreCertificateAuthority,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &RestoreCertificateAuthorityInput{}
+	}
+
+	output = &RestoreCertificateAuthorityOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// RestoreCertificateAuthority API operation for AWS Certificate Manager Private Certificate Authority.
+//
+// Restores a certificate authority (CA) that is in the DELETED state. You can
+// restore a CA during the period that you defined in the PermanentDeletionTimeInDays
+// parameter of the DeleteCertificateAuthority (https://docs.aws.amazon.com/privateca/latest/APIReference/API_DeleteCertificateAuthority.html)
+// action. Currently, you can specify 7 to 30 days. If you did not specify a
+// PermanentDeletionTimeInDays value, by default you can restore the CA at any
+// time in a 30 day period. You can check the time remaining in the restoration
+// period of a private CA in the DELETED state by calling the DescribeCertificateAuthority
+// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_DescribeCertificateAuthority.html)
+// or ListCertificateAuthorities (https://docs.aws.amazon.com/privateca/latest/APIReference/API_ListCertificateAuthorities.html)
+// actions. The status of a restored CA is set to its pre-deletion status when
+// the RestoreCertificateAuthority action returns. To change its status to ACTIVE,
+// call the UpdateCertificateAuthority (https://docs.aws.amazon.com/privateca/latest/APIReference/API_UpdateCertificateAuthority.html)
+// action. If the private CA was in the PENDING_CERTIFICATE state at deletion,
+// you must use the ImportCertificateAuthorityCertificate (https://docs.aws.amazon.com/privateca/latest/APIReference/API_ImportCertificateAuthorityCertificate.html)
+// action to import a certificate authority into the private CA before it can
+// be activated. You cannot restore a CA after the restoration period has ended.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Certificate Manager Private Certificate Authority's
+// API operation RestoreCertificateAuthority for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     A resource such as a private CA, S3 bucket, certificate, audit report, or
+//     policy cannot be found.
+//
+//   - InvalidStateException
+//     The state of the private CA does not allow this action to occur.
+//
+//   - InvalidArnException
+//     The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/RestoreCertificateAuthority
+func (c *ACMPCA) RestoreCertificateAuthority(input *RestoreCertificateAuthorityInput) (*RestoreCertificateAuthorityOutput, error) {
+	req, out := c.RestoreCertificateAuthorityRequest(input)
+	return out, req.Send()
+}
+
+// RestoreCertificateAuthorityWithContext is the same as RestoreCertificateAuthority with the addition of
+// the ability to pass a context and additional request options.
+//
+// See RestoreCertificateAuthority for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *ACMPCA) RestoreCertificateAuthorityWithContext(ctx aws.Context, input *RestoreCertificateAuthorityInput, opts ...request.Option) (*RestoreCertificateAuthorityOutput, error) {
+	req, out := c.RestoreCertificateAuthorityRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opRevokeCertificate = "RevokeCertificate"
 
 // RevokeCertificateRequest generates a "aws/request.Request" representing the
 // client's request for the RevokeCertificate operation. The "output" return
@@ -1800,14 +2329,13 @@ const opRevokeCertificate = "RevokeCertificate"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the RevokeCertificateRequest method.
+//	req, resp := client.RevokeCertificateRequest(params)
 //
-//    // Example sending a request using the RevokeCertificateRequest method.
-//    req, resp := client.RevokeCertificateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/RevokeCertificate
 func (c *ACMPCA) RevokeCertificateRequest(input *RevokeCertificateInput) (req *request.Request, output *RevokeCertificateOutput) {
@@ -1829,13 +2357,25 @@ func (c *ACMPCA) RevokeCertificateRequest(input *RevokeCertificateInput) (req *r
 
 // RevokeCertificate API operation for AWS Certificate Manager Private Certificate Authority.
 //
-// Revokes a certificate that was issued inside ACM Private CA. If you enable
-// a certificate revocation list (CRL) when you create or update your private
-// CA, information about the revoked certificates will be included in the CRL.
-// ACM Private CA writes the CRL to an S3 bucket that you specify. For more
-// information about revocation, see the CrlConfiguration structure. ACM Private
-// CA also writes revocation information to the audit report. For more information,
-// see CreateCertificateAuthorityAuditReport.
+// Revokes a certificate that was issued inside Amazon Web Services Private
+// CA. If you enable a certificate revocation list (CRL) when you create or
+// update your private CA, information about the revoked certificates will be
+// included in the CRL. Amazon Web Services Private CA writes the CRL to an
+// S3 bucket that you specify. A CRL is typically updated approximately 30 minutes
+// after a certificate is revoked. If for any reason the CRL update fails, Amazon
+// Web Services Private CA attempts makes further attempts every 15 minutes.
+// With Amazon CloudWatch, you can create alarms for the metrics CRLGenerated
+// and MisconfiguredCRLBucket. For more information, see Supported CloudWatch
+// Metrics (https://docs.aws.amazon.com/privateca/latest/userguide/PcaCloudWatch.html).
+//
+// Both Amazon Web Services Private CA and the IAM principal must have permission
+// to write to the S3 bucket that you specify. If the IAM principal making the
+// call does not have permission to write to the bucket, then an exception is
+// thrown. For more information, see Access policies for CRLs in Amazon S3 (https://docs.aws.amazon.com/privateca/latest/userguide/crl-planning.html#s3-policies).
+//
+// Amazon Web Services Private CA also writes revocation information to the
+// audit report. For more information, see CreateCertificateAuthorityAuditReport
+// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreateCertificateAuthorityAuditReport.html).
 //
 // You cannot revoke a root CA self-signed certificate.
 //
@@ -1846,36 +2386,36 @@ func (c *ACMPCA) RevokeCertificateRequest(input *RevokeCertificateInput) (req *r
 // See the AWS API reference guide for AWS Certificate Manager Private Certificate Authority's
 // API operation RevokeCertificate for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   A previous update to your private CA is still ongoing.
+// Returned Error Types:
+//
+//   - ConcurrentModificationException
+//     A previous update to your private CA is still ongoing.
 //
-//   * ErrCodeInvalidArnException "InvalidArnException"
-//   The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+//   - InvalidArnException
+//     The requested Amazon Resource Name (ARN) does not refer to an existing resource.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request action cannot be performed or is prohibited.
+//   - InvalidRequestException
+//     The request action cannot be performed or is prohibited.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   The private CA is in a state during which a report or certificate cannot
-//   be generated.
+//   - InvalidStateException
+//     The state of the private CA does not allow this action to occur.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   An ACM Private CA limit has been exceeded. See the exception message returned
-//   to determine the limit that was exceeded.
+//   - LimitExceededException
+//     An Amazon Web Services Private CA quota has been exceeded. See the exception
+//     message returned to determine the quota that was exceeded.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource such as a private CA, S3 bucket, certificate, or audit report
-//   cannot be found.
+//   - ResourceNotFoundException
+//     A resource such as a private CA, S3 bucket, certificate, audit report, or
+//     policy cannot be found.
 //
-//   * ErrCodeRequestAlreadyProcessedException "RequestAlreadyProcessedException"
-//   Your request has already been completed.
+//   - RequestAlreadyProcessedException
+//     Your request has already been completed.
 //
-//   * ErrCodeRequestInProgressException "RequestInProgressException"
-//   Your request is already in progress.
+//   - RequestInProgressException
+//     Your request is already in progress.
 //
-//   * ErrCodeRequestFailedException "RequestFailedException"
-//   The request has failed for an unspecified reason.
+//   - RequestFailedException
+//     The request has failed for an unspecified reason.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/RevokeCertificate
 func (c *ACMPCA) RevokeCertificate(input *RevokeCertificateInput) (*RevokeCertificateOutput, error) {
@@ -1915,14 +2455,13 @@ const opTagCertificateAuthority = "TagCertificateAuthority"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the TagCertificateAuthorityRequest method.
+//	req, resp := client.TagCertificateAuthorityRequest(params)
 //
-//    // Example sending a request using the TagCertificateAuthorityRequest method.
-//    req, resp := client.TagCertificateAuthorityRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/TagCertificateAuthority
 func (c *ACMPCA) TagCertificateAuthorityRequest(input *TagCertificateAuthorityInput) (req *request.Request, output *TagCertificateAuthorityOutput) {
@@ -1945,14 +2484,20 @@ func (c *ACMPCA) TagCertificateAuthorityRequest(input *TagCertificateAuthorityIn
 // TagCertificateAuthority API operation for AWS Certificate Manager Private Certificate Authority.
 //
 // Adds one or more tags to your private CA. Tags are labels that you can use
-// to identify and organize your AWS resources. Each tag consists of a key and
-// an optional value. You specify the private CA on input by its Amazon Resource
-// Name (ARN). You specify the tag by using a key-value pair. You can apply
-// a tag to just one private CA if you want to identify a specific characteristic
-// of that CA, or you can apply the same tag to multiple private CAs if you
-// want to filter for a common relationship among those CAs. To remove one or
-// more tags, use the UntagCertificateAuthority action. Call the ListTags action
-// to see what tags are associated with your CA.
+// to identify and organize your Amazon Web Services resources. Each tag consists
+// of a key and an optional value. You specify the private CA on input by its
+// Amazon Resource Name (ARN). You specify the tag by using a key-value pair.
+// You can apply a tag to just one private CA if you want to identify a specific
+// characteristic of that CA, or you can apply the same tag to multiple private
+// CAs if you want to filter for a common relationship among those CAs. To remove
+// one or more tags, use the UntagCertificateAuthority (https://docs.aws.amazon.com/privateca/latest/APIReference/API_UntagCertificateAuthority.html)
+// action. Call the ListTags (https://docs.aws.amazon.com/privateca/latest/APIReference/API_ListTags.html)
+// action to see what tags are associated with your CA.
+//
+// To attach tags to a private CA during the creation procedure, a CA administrator
+// must first associate an inline IAM policy with the CreateCertificateAuthority
+// action and explicitly allow tagging. For more information, see Attaching
+// tags to a CA at the time of creation (https://docs.aws.amazon.com/privateca/latest/userguide/auth-InlinePolicies.html#policy-tag-ca).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1961,25 +2506,25 @@ func (c *ACMPCA) TagCertificateAuthorityRequest(input *TagCertificateAuthorityIn
 // See the AWS API reference guide for AWS Certificate Manager Private Certificate Authority's
 // API operation TagCertificateAuthority for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource such as a private CA, S3 bucket, certificate, or audit report
-//   cannot be found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     A resource such as a private CA, S3 bucket, certificate, audit report, or
+//     policy cannot be found.
 //
-//   * ErrCodeInvalidArnException "InvalidArnException"
-//   The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+//   - InvalidArnException
+//     The requested Amazon Resource Name (ARN) does not refer to an existing resource.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   The private CA is in a state during which a report or certificate cannot
-//   be generated.
+//   - InvalidStateException
+//     The state of the private CA does not allow this action to occur.
 //
-//   * ErrCodeInvalidTagException "InvalidTagException"
-//   The tag associated with the CA is not valid. The invalid argument is contained
-//   in the message field.
+//   - InvalidTagException
+//     The tag associated with the CA is not valid. The invalid argument is contained
+//     in the message field.
 //
-//   * ErrCodeTooManyTagsException "TooManyTagsException"
-//   You can associate up to 50 tags with a private CA. Exception information
-//   is contained in the exception message field.
+//   - TooManyTagsException
+//     You can associate up to 50 tags with a private CA. Exception information
+//     is contained in the exception message field.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/TagCertificateAuthority
 func (c *ACMPCA) TagCertificateAuthority(input *TagCertificateAuthorityInput) (*TagCertificateAuthorityOutput, error) {
@@ -2019,14 +2564,13 @@ const opUntagCertificateAuthority = "UntagCertificateAuthority"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UntagCertificateAuthorityRequest method.
+//	req, resp := client.UntagCertificateAuthorityRequest(params)
 //
-//    // Example sending a request using the UntagCertificateAuthorityRequest method.
-//    req, resp := client.UntagCertificateAuthorityRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/UntagCertificateAuthority
 func (c *ACMPCA) UntagCertificateAuthorityRequest(input *UntagCertificateAuthorityInput) (req *request.Request, output *UntagCertificateAuthorityOutput) {
@@ -2052,7 +2596,8 @@ func (c *ACMPCA) UntagCertificateAuthorityRequest(input *UntagCertificateAuthori
 // pair. If you do not specify the value portion of the tag when calling this
 // action, the tag will be removed regardless of value. If you specify a value,
 // the tag is removed only if it is associated with the specified value. To
-// add tags to a private CA, use the TagCertificateAuthority. Call the ListTags
+// add tags to a private CA, use the TagCertificateAuthority (https://docs.aws.amazon.com/privateca/latest/APIReference/API_TagCertificateAuthority.html).
+// Call the ListTags (https://docs.aws.amazon.com/privateca/latest/APIReference/API_ListTags.html)
 // action to see what tags are associated with your CA.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
@@ -2062,21 +2607,21 @@ func (c *ACMPCA) UntagCertificateAuthorityRequest(input *UntagCertificateAuthori
 // See the AWS API reference guide for AWS Certificate Manager Private Certificate Authority's
 // API operation UntagCertificateAuthority for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource such as a private CA, S3 bucket, certificate, or audit report
-//   cannot be found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     A resource such as a private CA, S3 bucket, certificate, audit report, or
+//     policy cannot be found.
 //
-//   * ErrCodeInvalidArnException "InvalidArnException"
-//   The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+//   - InvalidArnException
+//     The requested Amazon Resource Name (ARN) does not refer to an existing resource.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   The private CA is in a state during which a report or certificate cannot
-//   be generated.
+//   - InvalidStateException
+//     The state of the private CA does not allow this action to occur.
 //
-//   * ErrCodeInvalidTagException "InvalidTagException"
-//   The tag associated with the CA is not valid. The invalid argument is contained
-//   in the message field.
+//   - InvalidTagException
+//     The tag associated with the CA is not valid. The invalid argument is contained
+//     in the message field.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/UntagCertificateAuthority
 func (c *ACMPCA) UntagCertificateAuthority(input *UntagCertificateAuthorityInput) (*UntagCertificateAuthorityOutput, error) {
@@ -2116,14 +2661,13 @@ const opUpdateCertificateAuthority = "UpdateCertificateAuthority"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateCertificateAuthorityRequest method.
+//	req, resp := client.UpdateCertificateAuthorityRequest(params)
 //
-//    // Example sending a request using the UpdateCertificateAuthorityRequest method.
-//    req, resp := client.UpdateCertificateAuthorityRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/UpdateCertificateAuthority
 func (c *ACMPCA) UpdateCertificateAuthorityRequest(input *UpdateCertificateAuthorityInput) (req *request.Request, output *UpdateCertificateAuthorityOutput) {
@@ -2150,6 +2694,11 @@ func (c *ACMPCA) UpdateCertificateAuthorityRequest(input *UpdateCertificateAutho
 // it. You can disable a private CA that is in the ACTIVE state or make a CA
 // that is in the DISABLED state active again.
 //
+// Both Amazon Web Services Private CA and the IAM principal must have permission
+// to write to the S3 bucket that you specify. If the IAM principal making the
+// call does not have permission to write to the bucket, then an exception is
+// thrown. For more information, see Access policies for CRLs in Amazon S3 (https://docs.aws.amazon.com/privateca/latest/userguide/crl-planning.html#s3-policies).
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -2157,27 +2706,28 @@ func (c *ACMPCA) UpdateCertificateAuthorityRequest(input *UpdateCertificateAutho
 // See the AWS API reference guide for AWS Certificate Manager Private Certificate Authority's
 // API operation UpdateCertificateAuthority for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   A previous update to your private CA is still ongoing.
+// Returned Error Types:
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource such as a private CA, S3 bucket, certificate, or audit report
-//   cannot be found.
+//   - ConcurrentModificationException
+//     A previous update to your private CA is still ongoing.
 //
-//   * ErrCodeInvalidArgsException "InvalidArgsException"
-//   One or more of the specified arguments was not valid.
+//   - ResourceNotFoundException
+//     A resource such as a private CA, S3 bucket, certificate, audit report, or
+//     policy cannot be found.
 //
-//   * ErrCodeInvalidArnException "InvalidArnException"
-//   The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+//   - InvalidArgsException
+//     One or more of the specified arguments was not valid.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   The private CA is in a state during which a report or certificate cannot
-//   be generated.
+//   - InvalidArnException
+//     The requested Amazon Resource Name (ARN) does not refer to an existing resource.
 //
-//   * ErrCodeInvalidPolicyException "InvalidPolicyException"
-//   The S3 bucket policy is not valid. The policy must give ACM Private CA rights
-//   to read from and write to the bucket and find the bucket location.
+//   - InvalidStateException
+//     The state of the private CA does not allow this action to occur.
+//
+//   - InvalidPolicyException
+//     The resource policy is invalid or is missing a required statement. For general
+//     information about IAM policy and statement structure, see Overview of JSON
+//     Policies (https://docs.aws.amazon.com/IAM/latest/UserGuide/access_policies.html#access_policies-json).
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/acm-pca-2017-08-22/UpdateCertificateAuthority
 func (c *ACMPCA) UpdateCertificateAuthority(input *UpdateCertificateAuthorityInput) (*UpdateCertificateAuthorityOutput, error) {
@@ -2201,24 +2751,32 @@ func (c *ACMPCA) UpdateCertificateAuthorityWithContext(ctx aws.Context, input *U
 	return out, req.Send()
 }
 
-// Contains information about the certificate subject. The certificate can be
-// one issued by your private certificate authority (CA) or it can be your private
-// CA certificate. The Subject field in the certificate identifies the entity
-// that owns or controls the public key in the certificate. The entity can be
-// a user, computer, device, or service. The Subject must contain an X.500 distinguished
-// name (DN). A DN is a sequence of relative distinguished names (RDNs). The
-// RDNs are separated by commas in the certificate. The DN must be unique for
-// each entity, but your private CA can issue more than one certificate with
-// the same DN to the same entity.
+// Contains information about the certificate subject. The Subject field in
+// the certificate identifies the entity that owns or controls the public key
+// in the certificate. The entity can be a user, computer, device, or service.
+// The Subject must contain an X.500 distinguished name (DN). A DN is a sequence
+// of relative distinguished names (RDNs). The RDNs are separated by commas
+// in the certificate.
 type ASN1Subject struct {
 	_ struct{} `type:"structure"`
 
-	// Fully qualified domain name (FQDN) associated with the certificate subject.
+	// For CA and end-entity certificates in a private PKI, the common name (CN)
+	// can be any string within the length limit.
+	//
+	// Note: In publicly trusted certificates, the common name must be a fully qualified
+	// domain name (FQDN) associated with the certificate subject.
 	CommonName *string `type:"string"`
 
 	// Two-digit code that specifies the country in which the certificate subject
 	// located.
-	Country *string `type:"string"`
+	Country *string `min:"2" type:"string"`
+
+	// Contains a sequence of one or more X.500 relative distinguished names (RDNs),
+	// each of which consists of an object identifier (OID) and a value. For more
+	// information, see NIST’s definition of Object Identifier (OID) (https://csrc.nist.gov/glossary/term/Object_Identifier).
+	//
+	// Custom attributes cannot be used in combination with standard attributes.
+	CustomAttributes []*CustomAttribute `min:"1" type:"list"`
 
 	// Disambiguating information for the certificate subject.
 	DistinguishedNameQualifier *string `type:"string"`
@@ -2232,7 +2790,7 @@ type ASN1Subject struct {
 
 	// Concatenation that typically contains the first letter of the GivenName,
 	// the first letter of the middle name if one exists, and the first letter of
-	// the SurName.
+	// the Surname.
 	Initials *string `type:"string"`
 
 	// The locality (such as a city or town) in which the certificate subject is
@@ -2266,16 +2824,50 @@ type ASN1Subject struct {
 	Title *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ASN1Subject) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ASN1Subject) GoString() string {
 	return s.String()
 }
 
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ASN1Subject) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ASN1Subject"}
+	if s.Country != nil && len(*s.Country) < 2 {
+		invalidParams.Add(request.NewErrParamMinLen("Country", 2))
+	}
+	if s.CustomAttributes != nil && len(s.CustomAttributes) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CustomAttributes", 1))
+	}
+	if s.CustomAttributes != nil {
+		for i, v := range s.CustomAttributes {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "CustomAttributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCommonName sets the CommonName field's value.
 func (s *ASN1Subject) SetCommonName(v string) *ASN1Subject {
 	s.CommonName = &v
@@ -2288,6 +2880,12 @@ func (s *ASN1Subject) SetCountry(v string) *ASN1Subject {
 	return s
 }
 
+// SetCustomAttributes sets the CustomAttributes field's value.
+func (s *ASN1Subject) SetCustomAttributes(v []*CustomAttribute) *ASN1Subject {
+	s.CustomAttributes = v
+	return s
+}
+
 // SetDistinguishedNameQualifier sets the DistinguishedNameQualifier field's value.
 func (s *ASN1Subject) SetDistinguishedNameQualifier(v string) *ASN1Subject {
 	s.DistinguishedNameQualifier = &v
@@ -2360,15 +2958,202 @@ func (s *ASN1Subject) SetTitle(v string) *ASN1Subject {
 	return s
 }
 
+// Provides access information used by the authorityInfoAccess and subjectInfoAccess
+// extensions described in RFC 5280 (https://datatracker.ietf.org/doc/html/rfc5280).
+type AccessDescription struct {
+	_ struct{} `type:"structure"`
+
+	// The location of AccessDescription information.
+	//
+	// AccessLocation is a required field
+	AccessLocation *GeneralName `type:"structure" required:"true"`
+
+	// The type and format of AccessDescription information.
+	//
+	// AccessMethod is a required field
+	AccessMethod *AccessMethod `type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AccessDescription) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AccessDescription) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *AccessDescription) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AccessDescription"}
+	if s.AccessLocation == nil {
+		invalidParams.Add(request.NewErrParamRequired("AccessLocation"))
+	}
+	if s.AccessMethod == nil {
+		invalidParams.Add(request.NewErrParamRequired("AccessMethod"))
+	}
+	if s.AccessLocation != nil {
+		if err := s.AccessLocation.Validate(); err != nil {
+			invalidParams.AddNested("AccessLocation", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAccessLocation sets the AccessLocation field's value.
+func (s *AccessDescription) SetAccessLocation(v *GeneralName) *AccessDescription {
+	s.AccessLocation = v
+	return s
+}
+
+// SetAccessMethod sets the AccessMethod field's value.
+func (s *AccessDescription) SetAccessMethod(v *AccessMethod) *AccessDescription {
+	s.AccessMethod = v
+	return s
+}
+
+// Describes the type and format of extension access. Only one of CustomObjectIdentifier
+// or AccessMethodType may be provided. Providing both results in InvalidArgsException.
+type AccessMethod struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies the AccessMethod.
+	AccessMethodType *string `type:"string" enum:"AccessMethodType"`
+
+	// An object identifier (OID) specifying the AccessMethod. The OID must satisfy
+	// the regular expression shown below. For more information, see NIST's definition
+	// of Object Identifier (OID) (https://csrc.nist.gov/glossary/term/Object_Identifier).
+	CustomObjectIdentifier *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AccessMethod) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AccessMethod) GoString() string {
+	return s.String()
+}
+
+// SetAccessMethodType sets the AccessMethodType field's value.
+func (s *AccessMethod) SetAccessMethodType(v string) *AccessMethod {
+	s.AccessMethodType = &v
+	return s
+}
+
+// SetCustomObjectIdentifier sets the CustomObjectIdentifier field's value.
+func (s *AccessMethod) SetCustomObjectIdentifier(v string) *AccessMethod {
+	s.CustomObjectIdentifier = &v
+	return s
+}
+
+// Contains X.509 certificate information to be placed in an issued certificate.
+// An APIPassthrough or APICSRPassthrough template variant must be selected,
+// or else this parameter is ignored.
+//
+// If conflicting or duplicate certificate information is supplied from other
+// sources, Amazon Web Services Private CA applies order of operation rules
+// (https://docs.aws.amazon.com/privateca/latest/userguide/UsingTemplates.html#template-order-of-operations)
+// to determine what information is used.
+type ApiPassthrough struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies X.509 extension information for a certificate.
+	Extensions *Extensions `type:"structure"`
+
+	// Contains information about the certificate subject. The Subject field in
+	// the certificate identifies the entity that owns or controls the public key
+	// in the certificate. The entity can be a user, computer, device, or service.
+	// The Subject must contain an X.500 distinguished name (DN). A DN is a sequence
+	// of relative distinguished names (RDNs). The RDNs are separated by commas
+	// in the certificate.
+	Subject *ASN1Subject `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ApiPassthrough) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ApiPassthrough) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ApiPassthrough) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ApiPassthrough"}
+	if s.Extensions != nil {
+		if err := s.Extensions.Validate(); err != nil {
+			invalidParams.AddNested("Extensions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Subject != nil {
+		if err := s.Subject.Validate(); err != nil {
+			invalidParams.AddNested("Subject", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetExtensions sets the Extensions field's value.
+func (s *ApiPassthrough) SetExtensions(v *Extensions) *ApiPassthrough {
+	s.Extensions = v
+	return s
+}
+
+// SetSubject sets the Subject field's value.
+func (s *ApiPassthrough) SetSubject(v *ASN1Subject) *ApiPassthrough {
+	s.Subject = v
+	return s
+}
+
 // Contains information about your private certificate authority (CA). Your
 // private CA can issue and revoke X.509 digital certificates. Digital certificates
 // verify that the entity named in the certificate Subject field owns or controls
 // the public key contained in the Subject Public Key Info field. Call the CreateCertificateAuthority
+// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreateCertificateAuthority.html)
 // action to create your private CA. You must then call the GetCertificateAuthorityCertificate
+// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_GetCertificateAuthorityCertificate.html)
 // action to retrieve a private CA certificate signing request (CSR). Sign the
-// CSR with your ACM Private CA-hosted or on-premises root or subordinate CA
-// certificate. Call the ImportCertificateAuthorityCertificate action to import
-// the signed certificate into AWS Certificate Manager (ACM).
+// CSR with your Amazon Web Services Private CA-hosted or on-premises root or
+// subordinate CA certificate. Call the ImportCertificateAuthorityCertificate
+// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_ImportCertificateAuthorityCertificate.html)
+// action to import the signed certificate into Certificate Manager (ACM).
 type CertificateAuthority struct {
 	_ struct{} `type:"structure"`
 
@@ -2385,6 +3170,18 @@ type CertificateAuthority struct {
 	// Reason the request to create your private CA failed.
 	FailureReason *string `type:"string" enum:"FailureReason"`
 
+	// Defines a cryptographic key management compliance standard used for handling
+	// CA keys.
+	//
+	// Default: FIPS_140_2_LEVEL_3_OR_HIGHER
+	//
+	// Note: Amazon Web Services Region ap-northeast-3 supports only FIPS_140_2_LEVEL_2_OR_HIGHER.
+	// You must explicitly specify this parameter and value when creating a CA in
+	// that Region. Specifying a different value (or no value) results in an InvalidArgsException
+	// with the message "A certificate authority cannot be created in this region
+	// with the specified security standard."
+	KeyStorageSecurityStandard *string `type:"string" enum:"KeyStorageSecurityStandard"`
+
 	// Date and time at which your private CA was last updated.
 	LastStateChangeAt *time.Time `type:"timestamp"`
 
@@ -2394,13 +3191,18 @@ type CertificateAuthority struct {
 	// Date and time before which your private CA certificate is not valid.
 	NotBefore *time.Time `type:"timestamp"`
 
+	// The Amazon Web Services account ID that owns the certificate authority.
+	OwnerAccount *string `min:"12" type:"string"`
+
 	// The period during which a deleted CA can be restored. For more information,
 	// see the PermanentDeletionTimeInDays parameter of the DeleteCertificateAuthorityRequest
+	// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_DeleteCertificateAuthorityRequest.html)
 	// action.
 	RestorableUntil *time.Time `type:"timestamp"`
 
-	// Information about the certificate revocation list (CRL) created and maintained
-	// by your private CA.
+	// Information about the Online Certificate Status Protocol (OCSP) configuration
+	// or certificate revocation list (CRL) created and maintained by your private
+	// CA.
 	RevocationConfiguration *RevocationConfiguration `type:"structure"`
 
 	// Serial number of your private CA.
@@ -2411,14 +3213,30 @@ type CertificateAuthority struct {
 
 	// Type of your private CA.
 	Type *string `type:"string" enum:"CertificateAuthorityType"`
+
+	// Specifies whether the CA issues general-purpose certificates that typically
+	// require a revocation mechanism, or short-lived certificates that may optionally
+	// omit revocation because they expire quickly. Short-lived certificate validity
+	// is limited to seven days.
+	//
+	// The default value is GENERAL_PURPOSE.
+	UsageMode *string `type:"string" enum:"CertificateAuthorityUsageMode"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CertificateAuthority) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CertificateAuthority) GoString() string {
 	return s.String()
 }
@@ -2447,6 +3265,12 @@ func (s *CertificateAuthority) SetFailureReason(v string) *CertificateAuthority
 	return s
 }
 
+// SetKeyStorageSecurityStandard sets the KeyStorageSecurityStandard field's value.
+func (s *CertificateAuthority) SetKeyStorageSecurityStandard(v string) *CertificateAuthority {
+	s.KeyStorageSecurityStandard = &v
+	return s
+}
+
 // SetLastStateChangeAt sets the LastStateChangeAt field's value.
 func (s *CertificateAuthority) SetLastStateChangeAt(v time.Time) *CertificateAuthority {
 	s.LastStateChangeAt = &v
@@ -2465,6 +3289,12 @@ func (s *CertificateAuthority) SetNotBefore(v time.Time) *CertificateAuthority {
 	return s
 }
 
+// SetOwnerAccount sets the OwnerAccount field's value.
+func (s *CertificateAuthority) SetOwnerAccount(v string) *CertificateAuthority {
+	s.OwnerAccount = &v
+	return s
+}
+
 // SetRestorableUntil sets the RestorableUntil field's value.
 func (s *CertificateAuthority) SetRestorableUntil(v time.Time) *CertificateAuthority {
 	s.RestorableUntil = &v
@@ -2495,15 +3325,26 @@ func (s *CertificateAuthority) SetType(v string) *CertificateAuthority {
 	return s
 }
 
+// SetUsageMode sets the UsageMode field's value.
+func (s *CertificateAuthority) SetUsageMode(v string) *CertificateAuthority {
+	s.UsageMode = &v
+	return s
+}
+
 // Contains configuration information for your private certificate authority
 // (CA). This includes information about the class of public key algorithm and
 // the key pair that your private CA creates when it issues a certificate. It
 // also includes the signature algorithm that it uses when issuing certificates,
 // and its X.500 distinguished name. You must specify this information when
-// you call the CreateCertificateAuthority action.
+// you call the CreateCertificateAuthority (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreateCertificateAuthority.html)
+// action.
 type CertificateAuthorityConfiguration struct {
 	_ struct{} `type:"structure"`
 
+	// Specifies information to be added to the extension section of the certificate
+	// signing request (CSR).
+	CsrExtensions *CsrExtensions `type:"structure"`
+
 	// Type of the public key algorithm and size, in bits, of the key pair that
 	// your CA creates when it issues a certificate. When you create a subordinate
 	// CA, you must use a key algorithm supported by the parent CA.
@@ -2513,6 +3354,9 @@ type CertificateAuthorityConfiguration struct {
 
 	// Name of the algorithm your private CA uses to sign certificate requests.
 	//
+	// This parameter should not be confused with the SigningAlgorithm parameter
+	// used to sign certificates when they are issued.
+	//
 	// SigningAlgorithm is a required field
 	SigningAlgorithm *string `type:"string" required:"true" enum:"SigningAlgorithm"`
 
@@ -2523,12 +3367,20 @@ type CertificateAuthorityConfiguration struct {
 	Subject *ASN1Subject `type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CertificateAuthorityConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CertificateAuthorityConfiguration) GoString() string {
 	return s.String()
 }
@@ -2545,6 +3397,16 @@ func (s *CertificateAuthorityConfiguration) Validate() error {
 	if s.Subject == nil {
 		invalidParams.Add(request.NewErrParamRequired("Subject"))
 	}
+	if s.CsrExtensions != nil {
+		if err := s.CsrExtensions.Validate(); err != nil {
+			invalidParams.AddNested("CsrExtensions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Subject != nil {
+		if err := s.Subject.Validate(); err != nil {
+			invalidParams.AddNested("Subject", err.(request.ErrInvalidParams))
+		}
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -2552,6 +3414,12 @@ func (s *CertificateAuthorityConfiguration) Validate() error {
 	return nil
 }
 
+// SetCsrExtensions sets the CsrExtensions field's value.
+func (s *CertificateAuthorityConfiguration) SetCsrExtensions(v *CsrExtensions) *CertificateAuthorityConfiguration {
+	s.CsrExtensions = v
+	return s
+}
+
 // SetKeyAlgorithm sets the KeyAlgorithm field's value.
 func (s *CertificateAuthorityConfiguration) SetKeyAlgorithm(v string) *CertificateAuthorityConfiguration {
 	s.KeyAlgorithm = &v
@@ -2570,42 +3438,179 @@ func (s *CertificateAuthorityConfiguration) SetSubject(v *ASN1Subject) *Certific
 	return s
 }
 
-type CreateCertificateAuthorityAuditReportInput struct {
-	_ struct{} `type:"structure"`
-
-	// The format in which to create the report. This can be either JSON or CSV.
-	//
-	// AuditReportResponseFormat is a required field
-	AuditReportResponseFormat *string `type:"string" required:"true" enum:"AuditReportResponseFormat"`
-
-	// The Amazon Resource Name (ARN) of the CA to be audited. This is of the form:
-	//
-	// arn:aws:acm-pca:region:account:certificate-authority/12345678-1234-1234-1234-123456789012 .
-	//
-	// CertificateAuthorityArn is a required field
-	CertificateAuthorityArn *string `min:"5" type:"string" required:"true"`
+// The certificate authority certificate you are importing does not comply with
+// conditions specified in the certificate that signed it.
+type CertificateMismatchException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The name of the S3 bucket that will contain the audit report.
-	//
-	// S3BucketName is a required field
-	S3BucketName *string `type:"string" required:"true"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s CreateCertificateAuthorityAuditReportInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CertificateMismatchException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateCertificateAuthorityAuditReportInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CertificateMismatchException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateCertificateAuthorityAuditReportInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateCertificateAuthorityAuditReportInput"}
-	if s.AuditReportResponseFormat == nil {
-		invalidParams.Add(request.NewErrParamRequired("AuditReportResponseFormat"))
+func newErrorCertificateMismatchException(v protocol.ResponseMetadata) error {
+	return &CertificateMismatchException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *CertificateMismatchException) Code() string {
+	return "CertificateMismatchException"
+}
+
+// Message returns the exception's message.
+func (s *CertificateMismatchException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *CertificateMismatchException) OrigErr() error {
+	return nil
+}
+
+func (s *CertificateMismatchException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *CertificateMismatchException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *CertificateMismatchException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// A previous update to your private CA is still ongoing.
+type ConcurrentModificationException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConcurrentModificationException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConcurrentModificationException) GoString() string {
+	return s.String()
+}
+
+func newErrorConcurrentModificationException(v protocol.ResponseMetadata) error {
+	return &ConcurrentModificationException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ConcurrentModificationException) Code() string {
+	return "ConcurrentModificationException"
+}
+
+// Message returns the exception's message.
+func (s *ConcurrentModificationException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ConcurrentModificationException) OrigErr() error {
+	return nil
+}
+
+func (s *ConcurrentModificationException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ConcurrentModificationException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ConcurrentModificationException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type CreateCertificateAuthorityAuditReportInput struct {
+	_ struct{} `type:"structure"`
+
+	// The format in which to create the report. This can be either JSON or CSV.
+	//
+	// AuditReportResponseFormat is a required field
+	AuditReportResponseFormat *string `type:"string" required:"true" enum:"AuditReportResponseFormat"`
+
+	// The Amazon Resource Name (ARN) of the CA to be audited. This is of the form:
+	//
+	// arn:aws:acm-pca:region:account:certificate-authority/12345678-1234-1234-1234-123456789012 .
+	//
+	// CertificateAuthorityArn is a required field
+	CertificateAuthorityArn *string `min:"5" type:"string" required:"true"`
+
+	// The name of the S3 bucket that will contain the audit report.
+	//
+	// S3BucketName is a required field
+	S3BucketName *string `min:"3" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateCertificateAuthorityAuditReportInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateCertificateAuthorityAuditReportInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateCertificateAuthorityAuditReportInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateCertificateAuthorityAuditReportInput"}
+	if s.AuditReportResponseFormat == nil {
+		invalidParams.Add(request.NewErrParamRequired("AuditReportResponseFormat"))
 	}
 	if s.CertificateAuthorityArn == nil {
 		invalidParams.Add(request.NewErrParamRequired("CertificateAuthorityArn"))
@@ -2616,6 +3621,9 @@ func (s *CreateCertificateAuthorityAuditReportInput) Validate() error {
 	if s.S3BucketName == nil {
 		invalidParams.Add(request.NewErrParamRequired("S3BucketName"))
 	}
+	if s.S3BucketName != nil && len(*s.S3BucketName) < 3 {
+		invalidParams.Add(request.NewErrParamMinLen("S3BucketName", 3))
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -2651,12 +3659,20 @@ type CreateCertificateAuthorityAuditReportOutput struct {
 	S3Key *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateCertificateAuthorityAuditReportOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateCertificateAuthorityAuditReportOutput) GoString() string {
 	return s.String()
 }
@@ -2687,35 +3703,85 @@ type CreateCertificateAuthorityInput struct {
 	// CertificateAuthorityType is a required field
 	CertificateAuthorityType *string `type:"string" required:"true" enum:"CertificateAuthorityType"`
 
-	// Alphanumeric string that can be used to distinguish between calls to CreateCertificateAuthority.
-	// Idempotency tokens time out after five minutes. Therefore, if you call CreateCertificateAuthority
-	// multiple times with the same idempotency token within a five minute period,
-	// ACM Private CA recognizes that you are requesting only one certificate. As
-	// a result, ACM Private CA issues only one. If you change the idempotency token
-	// for each call, however, ACM Private CA recognizes that you are requesting
-	// multiple certificates.
+	// Custom string that can be used to distinguish between calls to the CreateCertificateAuthority
+	// action. Idempotency tokens for CreateCertificateAuthority time out after
+	// five minutes. Therefore, if you call CreateCertificateAuthority multiple
+	// times with the same idempotency token within five minutes, Amazon Web Services
+	// Private CA recognizes that you are requesting only certificate authority
+	// and will issue only one. If you change the idempotency token for each call,
+	// Amazon Web Services Private CA recognizes that you are requesting multiple
+	// certificate authorities.
 	IdempotencyToken *string `min:"1" type:"string"`
 
-	// Contains a Boolean value that you can use to enable a certification revocation
-	// list (CRL) for the CA, the name of the S3 bucket to which ACM Private CA
-	// will write the CRL, and an optional CNAME alias that you can use to hide
-	// the name of your bucket in the CRL Distribution Points extension of your
-	// CA certificate. For more information, see the CrlConfiguration structure.
+	// Specifies a cryptographic key management compliance standard used for handling
+	// CA keys.
+	//
+	// Default: FIPS_140_2_LEVEL_3_OR_HIGHER
+	//
+	// Some Amazon Web Services Regions do not support the default. When creating
+	// a CA in these Regions, you must provide FIPS_140_2_LEVEL_2_OR_HIGHER as the
+	// argument for KeyStorageSecurityStandard. Failure to do this results in an
+	// InvalidArgsException with the message, "A certificate authority cannot be
+	// created in this region with the specified security standard."
+	//
+	// For information about security standard support in various Regions, see Storage
+	// and security compliance of Amazon Web Services Private CA private keys (https://docs.aws.amazon.com/privateca/latest/userguide/data-protection.html#private-keys).
+	KeyStorageSecurityStandard *string `type:"string" enum:"KeyStorageSecurityStandard"`
+
+	// Contains information to enable Online Certificate Status Protocol (OCSP)
+	// support, to enable a certificate revocation list (CRL), to enable both, or
+	// to enable neither. The default is for both certificate validation mechanisms
+	// to be disabled.
+	//
+	// The following requirements apply to revocation configurations.
+	//
+	//    * A configuration disabling CRLs or OCSP must contain only the Enabled=False
+	//    parameter, and will fail if other parameters such as CustomCname or ExpirationInDays
+	//    are included.
+	//
+	//    * In a CRL configuration, the S3BucketName parameter must conform to Amazon
+	//    S3 bucket naming rules (https://docs.aws.amazon.com/AmazonS3/latest/userguide/bucketnamingrules.html).
+	//
+	//    * A configuration containing a custom Canonical Name (CNAME) parameter
+	//    for CRLs or OCSP must conform to RFC2396 (https://www.ietf.org/rfc/rfc2396.txt)
+	//    restrictions on the use of special characters in a CNAME.
+	//
+	//    * In a CRL or OCSP configuration, the value of a CNAME parameter must
+	//    not include a protocol prefix such as "http://" or "https://".
+	//
+	// For more information, see the OcspConfiguration (https://docs.aws.amazon.com/privateca/latest/APIReference/API_OcspConfiguration.html)
+	// and CrlConfiguration (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CrlConfiguration.html)
+	// types.
 	RevocationConfiguration *RevocationConfiguration `type:"structure"`
 
 	// Key-value pairs that will be attached to the new private CA. You can associate
-	// up to 50 tags with a private CA. For information using tags with
-	//
-	// IAM to manage permissions, see Controlling Access Using IAM Tags (https://docs.aws.amazon.com/IAM/latest/UserGuide/access_iam-tags.html).
+	// up to 50 tags with a private CA. For information using tags with IAM to manage
+	// permissions, see Controlling Access Using IAM Tags (https://docs.aws.amazon.com/IAM/latest/UserGuide/access_iam-tags.html).
 	Tags []*Tag `min:"1" type:"list"`
+
+	// Specifies whether the CA issues general-purpose certificates that typically
+	// require a revocation mechanism, or short-lived certificates that may optionally
+	// omit revocation because they expire quickly. Short-lived certificate validity
+	// is limited to seven days.
+	//
+	// The default value is GENERAL_PURPOSE.
+	UsageMode *string `type:"string" enum:"CertificateAuthorityUsageMode"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateCertificateAuthorityInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateCertificateAuthorityInput) GoString() string {
 	return s.String()
 }
@@ -2780,6 +3846,12 @@ func (s *CreateCertificateAuthorityInput) SetIdempotencyToken(v string) *CreateC
 	return s
 }
 
+// SetKeyStorageSecurityStandard sets the KeyStorageSecurityStandard field's value.
+func (s *CreateCertificateAuthorityInput) SetKeyStorageSecurityStandard(v string) *CreateCertificateAuthorityInput {
+	s.KeyStorageSecurityStandard = &v
+	return s
+}
+
 // SetRevocationConfiguration sets the RevocationConfiguration field's value.
 func (s *CreateCertificateAuthorityInput) SetRevocationConfiguration(v *RevocationConfiguration) *CreateCertificateAuthorityInput {
 	s.RevocationConfiguration = v
@@ -2792,6 +3864,12 @@ func (s *CreateCertificateAuthorityInput) SetTags(v []*Tag) *CreateCertificateAu
 	return s
 }
 
+// SetUsageMode sets the UsageMode field's value.
+func (s *CreateCertificateAuthorityInput) SetUsageMode(v string) *CreateCertificateAuthorityInput {
+	s.UsageMode = &v
+	return s
+}
+
 type CreateCertificateAuthorityOutput struct {
 	_ struct{} `type:"structure"`
 
@@ -2802,12 +3880,20 @@ type CreateCertificateAuthorityOutput struct {
 	CertificateAuthorityArn *string `min:"5" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateCertificateAuthorityOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateCertificateAuthorityOutput) GoString() string {
 	return s.String()
 }
@@ -2821,23 +3907,23 @@ func (s *CreateCertificateAuthorityOutput) SetCertificateAuthorityArn(v string)
 type CreatePermissionInput struct {
 	_ struct{} `type:"structure"`
 
-	// The actions that the specified AWS service principal can use. These include
-	// IssueCertificate, GetCertificate, and ListPermissions.
+	// The actions that the specified Amazon Web Services service principal can
+	// use. These include IssueCertificate, GetCertificate, and ListPermissions.
 	//
 	// Actions is a required field
-	Actions []*string `min:"1" type:"list" required:"true"`
+	Actions []*string `min:"1" type:"list" required:"true" enum:"ActionType"`
 
 	// The Amazon Resource Name (ARN) of the CA that grants the permissions. You
-	// can find the ARN by calling the ListCertificateAuthorities action. This must
-	// have the following form:
+	// can find the ARN by calling the ListCertificateAuthorities (https://docs.aws.amazon.com/privateca/latest/APIReference/API_ListCertificateAuthorities.html)
+	// action. This must have the following form:
 	//
 	// arn:aws:acm-pca:region:account:certificate-authority/12345678-1234-1234-1234-123456789012 .
 	//
 	// CertificateAuthorityArn is a required field
 	CertificateAuthorityArn *string `min:"5" type:"string" required:"true"`
 
-	// The AWS service or identity that receives the permission. At this time, the
-	// only valid principal is acm.amazonaws.com.
+	// The Amazon Web Services service or identity that receives the permission.
+	// At this time, the only valid principal is acm.amazonaws.com.
 	//
 	// Principal is a required field
 	Principal *string `type:"string" required:"true"`
@@ -2846,12 +3932,20 @@ type CreatePermissionInput struct {
 	SourceAccount *string `min:"12" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePermissionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePermissionInput) GoString() string {
 	return s.String()
 }
@@ -2912,12 +4006,20 @@ type CreatePermissionOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePermissionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePermissionOutput) GoString() string {
 	return s.String()
 }
@@ -2930,82 +4032,126 @@ func (s CreatePermissionOutput) GoString() string {
 // the name of your bucket by specifying a value for the CustomCname parameter.
 // Your private CA copies the CNAME or the S3 bucket name to the CRL Distribution
 // Points extension of each certificate it issues. Your S3 bucket policy must
-// give write permission to ACM Private CA.
+// give write permission to Amazon Web Services Private CA.
+//
+// Amazon Web Services Private CA assets that are stored in Amazon S3 can be
+// protected with encryption. For more information, see Encrypting Your CRLs
+// (https://docs.aws.amazon.com/privateca/latest/userguide/PcaCreateCa.html#crl-encryption).
 //
 // Your private CA uses the value in the ExpirationInDays parameter to calculate
-// the nextUpdate field in the CRL. The CRL is refreshed at 1/2 the age of next
-// update or when a certificate is revoked. When a certificate is revoked, it
-// is recorded in the next CRL that is generated and in the next audit report.
-// Only time valid certificates are listed in the CRL. Expired certificates
-// are not included.
+// the nextUpdate field in the CRL. The CRL is refreshed prior to a certificate's
+// expiration date or when a certificate is revoked. When a certificate is revoked,
+// it appears in the CRL until the certificate expires, and then in one additional
+// CRL after expiration, and it always appears in the audit report.
+//
+// A CRL is typically updated approximately 30 minutes after a certificate is
+// revoked. If for any reason a CRL update fails, Amazon Web Services Private
+// CA makes further attempts every 15 minutes.
 //
 // CRLs contain the following fields:
 //
-//    * Version: The current version number defined in RFC 5280 is V2. The integer
-//    value is 0x1.
+//   - Version: The current version number defined in RFC 5280 is V2. The integer
+//     value is 0x1.
 //
-//    * Signature Algorithm: The name of the algorithm used to sign the CRL.
+//   - Signature Algorithm: The name of the algorithm used to sign the CRL.
 //
-//    * Issuer: The X.500 distinguished name of your private CA that issued
-//    the CRL.
+//   - Issuer: The X.500 distinguished name of your private CA that issued
+//     the CRL.
 //
-//    * Last Update: The issue date and time of this CRL.
+//   - Last Update: The issue date and time of this CRL.
 //
-//    * Next Update: The day and time by which the next CRL will be issued.
+//   - Next Update: The day and time by which the next CRL will be issued.
 //
-//    * Revoked Certificates: List of revoked certificates. Each list item contains
-//    the following information. Serial Number: The serial number, in hexadecimal
-//    format, of the revoked certificate. Revocation Date: Date and time the
-//    certificate was revoked. CRL Entry Extensions: Optional extensions for
-//    the CRL entry. X509v3 CRL Reason Code: Reason the certificate was revoked.
+//   - Revoked Certificates: List of revoked certificates. Each list item contains
+//     the following information. Serial Number: The serial number, in hexadecimal
+//     format, of the revoked certificate. Revocation Date: Date and time the
+//     certificate was revoked. CRL Entry Extensions: Optional extensions for
+//     the CRL entry. X509v3 CRL Reason Code: Reason the certificate was revoked.
 //
-//    * CRL Extensions: Optional extensions for the CRL. X509v3 Authority Key
-//    Identifier: Identifies the public key associated with the private key
-//    used to sign the certificate. X509v3 CRL Number:: Decimal sequence number
-//    for the CRL.
+//   - CRL Extensions: Optional extensions for the CRL. X509v3 Authority Key
+//     Identifier: Identifies the public key associated with the private key
+//     used to sign the certificate. X509v3 CRL Number:: Decimal sequence number
+//     for the CRL.
 //
-//    * Signature Algorithm: Algorithm used by your private CA to sign the CRL.
+//   - Signature Algorithm: Algorithm used by your private CA to sign the CRL.
 //
-//    * Signature Value: Signature computed over the CRL.
+//   - Signature Value: Signature computed over the CRL.
 //
-// Certificate revocation lists created by ACM Private CA are DER-encoded. You
-// can use the following OpenSSL command to list a CRL.
+// Certificate revocation lists created by Amazon Web Services Private CA are
+// DER-encoded. You can use the following OpenSSL command to list a CRL.
 //
 // openssl crl -inform DER -text -in crl_path -noout
+//
+// For more information, see Planning a certificate revocation list (CRL) (https://docs.aws.amazon.com/privateca/latest/userguide/crl-planning.html)
+// in the Amazon Web Services Private Certificate Authority User Guide
 type CrlConfiguration struct {
 	_ struct{} `type:"structure"`
 
 	// Name inserted into the certificate CRL Distribution Points extension that
 	// enables the use of an alias for the CRL distribution point. Use this value
 	// if you don't want the name of your S3 bucket to be public.
+	//
+	// The content of a Canonical Name (CNAME) record must conform to RFC2396 (https://www.ietf.org/rfc/rfc2396.txt)
+	// restrictions on the use of special characters in URIs. Additionally, the
+	// value of the CNAME must not include a protocol prefix such as "http://" or
+	// "https://".
 	CustomCname *string `type:"string"`
 
 	// Boolean value that specifies whether certificate revocation lists (CRLs)
 	// are enabled. You can use this value to enable certificate revocation for
-	// a new CA when you call the CreateCertificateAuthority action or for an existing
-	// CA when you call the UpdateCertificateAuthority action.
+	// a new CA when you call the CreateCertificateAuthority (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreateCertificateAuthority.html)
+	// action or for an existing CA when you call the UpdateCertificateAuthority
+	// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_UpdateCertificateAuthority.html)
+	// action.
 	//
 	// Enabled is a required field
 	Enabled *bool `type:"boolean" required:"true"`
 
-	// Number of days until a certificate expires.
+	// Validity period of the CRL in days.
 	ExpirationInDays *int64 `min:"1" type:"integer"`
 
 	// Name of the S3 bucket that contains the CRL. If you do not provide a value
 	// for the CustomCname argument, the name of your S3 bucket is placed into the
 	// CRL Distribution Points extension of the issued certificate. You can change
-	// the name of your bucket by calling the UpdateCertificateAuthority action.
-	// You must specify a bucket policy that allows ACM Private CA to write the
-	// CRL to your bucket.
+	// the name of your bucket by calling the UpdateCertificateAuthority (https://docs.aws.amazon.com/privateca/latest/APIReference/API_UpdateCertificateAuthority.html)
+	// operation. You must specify a bucket policy (https://docs.aws.amazon.com/privateca/latest/userguide/PcaCreateCa.html#s3-policies)
+	// that allows Amazon Web Services Private CA to write the CRL to your bucket.
+	//
+	// The S3BucketName parameter must conform to the S3 bucket naming rules (https://docs.aws.amazon.com/AmazonS3/latest/userguide/bucketnamingrules.html).
 	S3BucketName *string `min:"3" type:"string"`
+
+	// Determines whether the CRL will be publicly readable or privately held in
+	// the CRL Amazon S3 bucket. If you choose PUBLIC_READ, the CRL will be accessible
+	// over the public internet. If you choose BUCKET_OWNER_FULL_CONTROL, only the
+	// owner of the CRL S3 bucket can access the CRL, and your PKI clients may need
+	// an alternative method of access.
+	//
+	// If no value is specified, the default is PUBLIC_READ.
+	//
+	// Note: This default can cause CA creation to fail in some circumstances. If
+	// you have have enabled the Block Public Access (BPA) feature in your S3 account,
+	// then you must specify the value of this parameter as BUCKET_OWNER_FULL_CONTROL,
+	// and not doing so results in an error. If you have disabled BPA in S3, then
+	// you can specify either BUCKET_OWNER_FULL_CONTROL or PUBLIC_READ as the value.
+	//
+	// For more information, see Blocking public access to the S3 bucket (https://docs.aws.amazon.com/privateca/latest/userguide/PcaCreateCa.html#s3-bpa).
+	S3ObjectAcl *string `type:"string" enum:"S3ObjectAcl"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CrlConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CrlConfiguration) GoString() string {
 	return s.String()
 }
@@ -3053,10 +4199,225 @@ func (s *CrlConfiguration) SetS3BucketName(v string) *CrlConfiguration {
 	return s
 }
 
+// SetS3ObjectAcl sets the S3ObjectAcl field's value.
+func (s *CrlConfiguration) SetS3ObjectAcl(v string) *CrlConfiguration {
+	s.S3ObjectAcl = &v
+	return s
+}
+
+// Describes the certificate extensions to be added to the certificate signing
+// request (CSR).
+type CsrExtensions struct {
+	_ struct{} `type:"structure"`
+
+	// Indicates the purpose of the certificate and of the key contained in the
+	// certificate.
+	KeyUsage *KeyUsage `type:"structure"`
+
+	// For CA certificates, provides a path to additional information pertaining
+	// to the CA, such as revocation and policy. For more information, see Subject
+	// Information Access (https://datatracker.ietf.org/doc/html/rfc5280#section-4.2.2.2)
+	// in RFC 5280.
+	SubjectInformationAccess []*AccessDescription `type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CsrExtensions) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CsrExtensions) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CsrExtensions) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CsrExtensions"}
+	if s.SubjectInformationAccess != nil {
+		for i, v := range s.SubjectInformationAccess {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "SubjectInformationAccess", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetKeyUsage sets the KeyUsage field's value.
+func (s *CsrExtensions) SetKeyUsage(v *KeyUsage) *CsrExtensions {
+	s.KeyUsage = v
+	return s
+}
+
+// SetSubjectInformationAccess sets the SubjectInformationAccess field's value.
+func (s *CsrExtensions) SetSubjectInformationAccess(v []*AccessDescription) *CsrExtensions {
+	s.SubjectInformationAccess = v
+	return s
+}
+
+// Defines the X.500 relative distinguished name (RDN).
+type CustomAttribute struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies the object identifier (OID) of the attribute type of the relative
+	// distinguished name (RDN).
+	//
+	// ObjectIdentifier is a required field
+	ObjectIdentifier *string `type:"string" required:"true"`
+
+	// Specifies the attribute value of relative distinguished name (RDN).
+	//
+	// Value is a required field
+	Value *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CustomAttribute) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CustomAttribute) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CustomAttribute) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CustomAttribute"}
+	if s.ObjectIdentifier == nil {
+		invalidParams.Add(request.NewErrParamRequired("ObjectIdentifier"))
+	}
+	if s.Value == nil {
+		invalidParams.Add(request.NewErrParamRequired("Value"))
+	}
+	if s.Value != nil && len(*s.Value) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Value", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetObjectIdentifier sets the ObjectIdentifier field's value.
+func (s *CustomAttribute) SetObjectIdentifier(v string) *CustomAttribute {
+	s.ObjectIdentifier = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *CustomAttribute) SetValue(v string) *CustomAttribute {
+	s.Value = &v
+	return s
+}
+
+// Specifies the X.509 extension information for a certificate.
+//
+// Extensions present in CustomExtensions follow the ApiPassthrough template
+// rules (https://docs.aws.amazon.com/privateca/latest/userguide/UsingTemplates.html#template-order-of-operations).
+type CustomExtension struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies the critical flag of the X.509 extension.
+	Critical *bool `type:"boolean"`
+
+	// Specifies the object identifier (OID) of the X.509 extension. For more information,
+	// see the Global OID reference database. (https://oidref.com/2.5.29)
+	//
+	// ObjectIdentifier is a required field
+	ObjectIdentifier *string `type:"string" required:"true"`
+
+	// Specifies the base64-encoded value of the X.509 extension.
+	//
+	// Value is a required field
+	Value *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CustomExtension) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CustomExtension) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CustomExtension) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CustomExtension"}
+	if s.ObjectIdentifier == nil {
+		invalidParams.Add(request.NewErrParamRequired("ObjectIdentifier"))
+	}
+	if s.Value == nil {
+		invalidParams.Add(request.NewErrParamRequired("Value"))
+	}
+	if s.Value != nil && len(*s.Value) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Value", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCritical sets the Critical field's value.
+func (s *CustomExtension) SetCritical(v bool) *CustomExtension {
+	s.Critical = &v
+	return s
+}
+
+// SetObjectIdentifier sets the ObjectIdentifier field's value.
+func (s *CustomExtension) SetObjectIdentifier(v string) *CustomExtension {
+	s.ObjectIdentifier = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *CustomExtension) SetValue(v string) *CustomExtension {
+	s.Value = &v
+	return s
+}
+
 type DeleteCertificateAuthorityInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) that was returned when you called CreateCertificateAuthority.
+	// The Amazon Resource Name (ARN) that was returned when you called CreateCertificateAuthority
+	// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreateCertificateAuthority.html).
 	// This must have the following form:
 	//
 	// arn:aws:acm-pca:region:account:certificate-authority/12345678-1234-1234-1234-123456789012 .
@@ -3069,12 +4430,20 @@ type DeleteCertificateAuthorityInput struct {
 	PermanentDeletionTimeInDays *int64 `min:"7" type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteCertificateAuthorityInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteCertificateAuthorityInput) GoString() string {
 	return s.String()
 }
@@ -3114,12 +4483,20 @@ type DeleteCertificateAuthorityOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteCertificateAuthorityOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteCertificateAuthorityOutput) GoString() string {
 	return s.String()
 }
@@ -3128,30 +4505,38 @@ type DeletePermissionInput struct {
 	_ struct{} `type:"structure"`
 
 	// The Amazon Resource Number (ARN) of the private CA that issued the permissions.
-	// You can find the CA's ARN by calling the ListCertificateAuthorities action.
-	// This must have the following form:
+	// You can find the CA's ARN by calling the ListCertificateAuthorities (https://docs.aws.amazon.com/privateca/latest/APIReference/API_ListCertificateAuthorities.html)
+	// action. This must have the following form:
 	//
 	// arn:aws:acm-pca:region:account:certificate-authority/12345678-1234-1234-1234-123456789012 .
 	//
 	// CertificateAuthorityArn is a required field
 	CertificateAuthorityArn *string `min:"5" type:"string" required:"true"`
 
-	// The AWS service or identity that will have its CA permissions revoked. At
-	// this time, the only valid service principal is acm.amazonaws.com
+	// The Amazon Web Services service or identity that will have its CA permissions
+	// revoked. At this time, the only valid service principal is acm.amazonaws.com
 	//
 	// Principal is a required field
 	Principal *string `type:"string" required:"true"`
 
-	// The AWS account that calls this action.
+	// The Amazon Web Services account that calls this action.
 	SourceAccount *string `min:"12" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeletePermissionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeletePermissionInput) GoString() string {
 	return s.String()
 }
@@ -3200,24 +4585,107 @@ type DeletePermissionOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeletePermissionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeletePermissionOutput) GoString() string {
 	return s.String()
 }
 
-type DescribeCertificateAuthorityAuditReportInput struct {
+type DeletePolicyInput struct {
 	_ struct{} `type:"structure"`
 
-	// The report ID returned by calling the CreateCertificateAuthorityAuditReport
-	// action.
+	// The Amazon Resource Number (ARN) of the private CA that will have its policy
+	// deleted. You can find the CA's ARN by calling the ListCertificateAuthorities
+	// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_ListCertificateAuthorities.html)
+	// action. The ARN value must have the form arn:aws:acm-pca:region:account:certificate-authority/01234567-89ab-cdef-0123-0123456789ab.
 	//
-	// AuditReportId is a required field
-	AuditReportId *string `min:"36" type:"string" required:"true"`
+	// ResourceArn is a required field
+	ResourceArn *string `min:"5" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeletePolicyInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeletePolicyInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeletePolicyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeletePolicyInput"}
+	if s.ResourceArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceArn"))
+	}
+	if s.ResourceArn != nil && len(*s.ResourceArn) < 5 {
+		invalidParams.Add(request.NewErrParamMinLen("ResourceArn", 5))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetResourceArn sets the ResourceArn field's value.
+func (s *DeletePolicyInput) SetResourceArn(v string) *DeletePolicyInput {
+	s.ResourceArn = &v
+	return s
+}
+
+type DeletePolicyOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeletePolicyOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeletePolicyOutput) GoString() string {
+	return s.String()
+}
+
+type DescribeCertificateAuthorityAuditReportInput struct {
+	_ struct{} `type:"structure"`
+
+	// The report ID returned by calling the CreateCertificateAuthorityAuditReport
+	// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreateCertificateAuthorityAuditReport.html)
+	// action.
+	//
+	// AuditReportId is a required field
+	AuditReportId *string `min:"36" type:"string" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the private CA. This must be of the form:
 	//
@@ -3227,12 +4695,20 @@ type DescribeCertificateAuthorityAuditReportInput struct {
 	CertificateAuthorityArn *string `min:"5" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeCertificateAuthorityAuditReportInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeCertificateAuthorityAuditReportInput) GoString() string {
 	return s.String()
 }
@@ -3281,18 +4757,26 @@ type DescribeCertificateAuthorityAuditReportOutput struct {
 	CreatedAt *time.Time `type:"timestamp"`
 
 	// Name of the S3 bucket that contains the report.
-	S3BucketName *string `type:"string"`
+	S3BucketName *string `min:"3" type:"string"`
 
 	// S3 key that uniquely identifies the report file in your S3 bucket.
 	S3Key *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeCertificateAuthorityAuditReportOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeCertificateAuthorityAuditReportOutput) GoString() string {
 	return s.String()
 }
@@ -3324,7 +4808,8 @@ func (s *DescribeCertificateAuthorityAuditReportOutput) SetS3Key(v string) *Desc
 type DescribeCertificateAuthorityInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) that was returned when you called CreateCertificateAuthority.
+	// The Amazon Resource Name (ARN) that was returned when you called CreateCertificateAuthority
+	// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreateCertificateAuthority.html).
 	// This must be of the form:
 	//
 	// arn:aws:acm-pca:region:account:certificate-authority/12345678-1234-1234-1234-123456789012 .
@@ -3333,12 +4818,20 @@ type DescribeCertificateAuthorityInput struct {
 	CertificateAuthorityArn *string `min:"5" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeCertificateAuthorityInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeCertificateAuthorityInput) GoString() string {
 	return s.String()
 }
@@ -3368,17 +4861,25 @@ func (s *DescribeCertificateAuthorityInput) SetCertificateAuthorityArn(v string)
 type DescribeCertificateAuthorityOutput struct {
 	_ struct{} `type:"structure"`
 
-	// A CertificateAuthority structure that contains information about your private
-	// CA.
+	// A CertificateAuthority (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CertificateAuthority.html)
+	// structure that contains information about your private CA.
 	CertificateAuthority *CertificateAuthority `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeCertificateAuthorityOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeCertificateAuthorityOutput) GoString() string {
 	return s.String()
 }
@@ -3389,6 +4890,367 @@ func (s *DescribeCertificateAuthorityOutput) SetCertificateAuthority(v *Certific
 	return s
 }
 
+// Describes an Electronic Data Interchange (EDI) entity as described in as
+// defined in Subject Alternative Name (https://datatracker.ietf.org/doc/html/rfc5280)
+// in RFC 5280.
+type EdiPartyName struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies the name assigner.
+	NameAssigner *string `type:"string"`
+
+	// Specifies the party name.
+	//
+	// PartyName is a required field
+	PartyName *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EdiPartyName) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EdiPartyName) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *EdiPartyName) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "EdiPartyName"}
+	if s.PartyName == nil {
+		invalidParams.Add(request.NewErrParamRequired("PartyName"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetNameAssigner sets the NameAssigner field's value.
+func (s *EdiPartyName) SetNameAssigner(v string) *EdiPartyName {
+	s.NameAssigner = &v
+	return s
+}
+
+// SetPartyName sets the PartyName field's value.
+func (s *EdiPartyName) SetPartyName(v string) *EdiPartyName {
+	s.PartyName = &v
+	return s
+}
+
+// Specifies additional purposes for which the certified public key may be used
+// other than basic purposes indicated in the KeyUsage extension.
+type ExtendedKeyUsage struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies a custom ExtendedKeyUsage with an object identifier (OID).
+	ExtendedKeyUsageObjectIdentifier *string `type:"string"`
+
+	// Specifies a standard ExtendedKeyUsage as defined as in RFC 5280 (https://datatracker.ietf.org/doc/html/rfc5280#section-4.2.1.12).
+	ExtendedKeyUsageType *string `type:"string" enum:"ExtendedKeyUsageType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ExtendedKeyUsage) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ExtendedKeyUsage) GoString() string {
+	return s.String()
+}
+
+// SetExtendedKeyUsageObjectIdentifier sets the ExtendedKeyUsageObjectIdentifier field's value.
+func (s *ExtendedKeyUsage) SetExtendedKeyUsageObjectIdentifier(v string) *ExtendedKeyUsage {
+	s.ExtendedKeyUsageObjectIdentifier = &v
+	return s
+}
+
+// SetExtendedKeyUsageType sets the ExtendedKeyUsageType field's value.
+func (s *ExtendedKeyUsage) SetExtendedKeyUsageType(v string) *ExtendedKeyUsage {
+	s.ExtendedKeyUsageType = &v
+	return s
+}
+
+// Contains X.509 extension information for a certificate.
+type Extensions struct {
+	_ struct{} `type:"structure"`
+
+	// Contains a sequence of one or more policy information terms, each of which
+	// consists of an object identifier (OID) and optional qualifiers. For more
+	// information, see NIST's definition of Object Identifier (OID) (https://csrc.nist.gov/glossary/term/Object_Identifier).
+	//
+	// In an end-entity certificate, these terms indicate the policy under which
+	// the certificate was issued and the purposes for which it may be used. In
+	// a CA certificate, these terms limit the set of policies for certification
+	// paths that include this certificate.
+	CertificatePolicies []*PolicyInformation `min:"1" type:"list"`
+
+	// Contains a sequence of one or more X.509 extensions, each of which consists
+	// of an object identifier (OID), a base64-encoded value, and the critical flag.
+	// For more information, see the Global OID reference database. (https://oidref.com/2.5.29)
+	CustomExtensions []*CustomExtension `min:"1" type:"list"`
+
+	// Specifies additional purposes for which the certified public key may be used
+	// other than basic purposes indicated in the KeyUsage extension.
+	ExtendedKeyUsage []*ExtendedKeyUsage `min:"1" type:"list"`
+
+	// Defines one or more purposes for which the key contained in the certificate
+	// can be used. Default value for each option is false.
+	KeyUsage *KeyUsage `type:"structure"`
+
+	// The subject alternative name extension allows identities to be bound to the
+	// subject of the certificate. These identities may be included in addition
+	// to or in place of the identity in the subject field of the certificate.
+	SubjectAlternativeNames []*GeneralName `min:"1" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Extensions) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Extensions) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *Extensions) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "Extensions"}
+	if s.CertificatePolicies != nil && len(s.CertificatePolicies) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CertificatePolicies", 1))
+	}
+	if s.CustomExtensions != nil && len(s.CustomExtensions) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CustomExtensions", 1))
+	}
+	if s.ExtendedKeyUsage != nil && len(s.ExtendedKeyUsage) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ExtendedKeyUsage", 1))
+	}
+	if s.SubjectAlternativeNames != nil && len(s.SubjectAlternativeNames) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("SubjectAlternativeNames", 1))
+	}
+	if s.CertificatePolicies != nil {
+		for i, v := range s.CertificatePolicies {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "CertificatePolicies", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.CustomExtensions != nil {
+		for i, v := range s.CustomExtensions {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "CustomExtensions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.SubjectAlternativeNames != nil {
+		for i, v := range s.SubjectAlternativeNames {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "SubjectAlternativeNames", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCertificatePolicies sets the CertificatePolicies field's value.
+func (s *Extensions) SetCertificatePolicies(v []*PolicyInformation) *Extensions {
+	s.CertificatePolicies = v
+	return s
+}
+
+// SetCustomExtensions sets the CustomExtensions field's value.
+func (s *Extensions) SetCustomExtensions(v []*CustomExtension) *Extensions {
+	s.CustomExtensions = v
+	return s
+}
+
+// SetExtendedKeyUsage sets the ExtendedKeyUsage field's value.
+func (s *Extensions) SetExtendedKeyUsage(v []*ExtendedKeyUsage) *Extensions {
+	s.ExtendedKeyUsage = v
+	return s
+}
+
+// SetKeyUsage sets the KeyUsage field's value.
+func (s *Extensions) SetKeyUsage(v *KeyUsage) *Extensions {
+	s.KeyUsage = v
+	return s
+}
+
+// SetSubjectAlternativeNames sets the SubjectAlternativeNames field's value.
+func (s *Extensions) SetSubjectAlternativeNames(v []*GeneralName) *Extensions {
+	s.SubjectAlternativeNames = v
+	return s
+}
+
+// Describes an ASN.1 X.400 GeneralName as defined in RFC 5280 (https://datatracker.ietf.org/doc/html/rfc5280).
+// Only one of the following naming options should be provided. Providing more
+// than one option results in an InvalidArgsException error.
+type GeneralName struct {
+	_ struct{} `type:"structure"`
+
+	// Contains information about the certificate subject. The Subject field in
+	// the certificate identifies the entity that owns or controls the public key
+	// in the certificate. The entity can be a user, computer, device, or service.
+	// The Subject must contain an X.500 distinguished name (DN). A DN is a sequence
+	// of relative distinguished names (RDNs). The RDNs are separated by commas
+	// in the certificate.
+	DirectoryName *ASN1Subject `type:"structure"`
+
+	// Represents GeneralName as a DNS name.
+	DnsName *string `type:"string"`
+
+	// Represents GeneralName as an EdiPartyName object.
+	EdiPartyName *EdiPartyName `type:"structure"`
+
+	// Represents GeneralName as an IPv4 or IPv6 address.
+	IpAddress *string `type:"string"`
+
+	// Represents GeneralName using an OtherName object.
+	OtherName *OtherName `type:"structure"`
+
+	// Represents GeneralName as an object identifier (OID).
+	RegisteredId *string `type:"string"`
+
+	// Represents GeneralName as an RFC 822 (https://datatracker.ietf.org/doc/html/rfc822)
+	// email address.
+	Rfc822Name *string `type:"string"`
+
+	// Represents GeneralName as a URI.
+	UniformResourceIdentifier *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GeneralName) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GeneralName) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GeneralName) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GeneralName"}
+	if s.DirectoryName != nil {
+		if err := s.DirectoryName.Validate(); err != nil {
+			invalidParams.AddNested("DirectoryName", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.EdiPartyName != nil {
+		if err := s.EdiPartyName.Validate(); err != nil {
+			invalidParams.AddNested("EdiPartyName", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.OtherName != nil {
+		if err := s.OtherName.Validate(); err != nil {
+			invalidParams.AddNested("OtherName", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDirectoryName sets the DirectoryName field's value.
+func (s *GeneralName) SetDirectoryName(v *ASN1Subject) *GeneralName {
+	s.DirectoryName = v
+	return s
+}
+
+// SetDnsName sets the DnsName field's value.
+func (s *GeneralName) SetDnsName(v string) *GeneralName {
+	s.DnsName = &v
+	return s
+}
+
+// SetEdiPartyName sets the EdiPartyName field's value.
+func (s *GeneralName) SetEdiPartyName(v *EdiPartyName) *GeneralName {
+	s.EdiPartyName = v
+	return s
+}
+
+// SetIpAddress sets the IpAddress field's value.
+func (s *GeneralName) SetIpAddress(v string) *GeneralName {
+	s.IpAddress = &v
+	return s
+}
+
+// SetOtherName sets the OtherName field's value.
+func (s *GeneralName) SetOtherName(v *OtherName) *GeneralName {
+	s.OtherName = v
+	return s
+}
+
+// SetRegisteredId sets the RegisteredId field's value.
+func (s *GeneralName) SetRegisteredId(v string) *GeneralName {
+	s.RegisteredId = &v
+	return s
+}
+
+// SetRfc822Name sets the Rfc822Name field's value.
+func (s *GeneralName) SetRfc822Name(v string) *GeneralName {
+	s.Rfc822Name = &v
+	return s
+}
+
+// SetUniformResourceIdentifier sets the UniformResourceIdentifier field's value.
+func (s *GeneralName) SetUniformResourceIdentifier(v string) *GeneralName {
+	s.UniformResourceIdentifier = &v
+	return s
+}
+
 type GetCertificateAuthorityCertificateInput struct {
 	_ struct{} `type:"structure"`
 
@@ -3400,12 +5262,20 @@ type GetCertificateAuthorityCertificateInput struct {
 	CertificateAuthorityArn *string `min:"5" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCertificateAuthorityCertificateInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCertificateAuthorityCertificateInput) GoString() string {
 	return s.String()
 }
@@ -3439,18 +5309,26 @@ type GetCertificateAuthorityCertificateOutput struct {
 	Certificate *string `type:"string"`
 
 	// Base64-encoded certificate chain that includes any intermediate certificates
-	// and chains up to root on-premises certificate that you used to sign your
-	// private CA certificate. The chain does not include your private CA certificate.
-	// If this is a root CA, the value will be null.
+	// and chains up to root certificate that you used to sign your private CA certificate.
+	// The chain does not include your private CA certificate. If this is a root
+	// CA, the value will be null.
 	CertificateChain *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCertificateAuthorityCertificateOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCertificateAuthorityCertificateOutput) GoString() string {
 	return s.String()
 }
@@ -3471,6 +5349,7 @@ type GetCertificateAuthorityCsrInput struct {
 	_ struct{} `type:"structure"`
 
 	// The Amazon Resource Name (ARN) that was returned when you called the CreateCertificateAuthority
+	// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreateCertificateAuthority.html)
 	// action. This must be of the form:
 	//
 	// arn:aws:acm-pca:region:account:certificate-authority/12345678-1234-1234-1234-123456789012
@@ -3479,12 +5358,20 @@ type GetCertificateAuthorityCsrInput struct {
 	CertificateAuthorityArn *string `min:"5" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCertificateAuthorityCsrInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCertificateAuthorityCsrInput) GoString() string {
 	return s.String()
 }
@@ -3519,12 +5406,20 @@ type GetCertificateAuthorityCsrOutput struct {
 	Csr *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCertificateAuthorityCsrOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCertificateAuthorityCsrOutput) GoString() string {
 	return s.String()
 }
@@ -3546,7 +5441,8 @@ type GetCertificateInput struct {
 	// CertificateArn is a required field
 	CertificateArn *string `min:"5" type:"string" required:"true"`
 
-	// The Amazon Resource Name (ARN) that was returned when you called CreateCertificateAuthority.
+	// The Amazon Resource Name (ARN) that was returned when you called CreateCertificateAuthority
+	// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreateCertificateAuthority.html).
 	// This must be of the form:
 	//
 	// arn:aws:acm-pca:region:account:certificate-authority/12345678-1234-1234-1234-123456789012 .
@@ -3555,12 +5451,20 @@ type GetCertificateInput struct {
 	CertificateAuthorityArn *string `min:"5" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCertificateInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCertificateInput) GoString() string {
 	return s.String()
 }
@@ -3605,17 +5509,25 @@ type GetCertificateOutput struct {
 	// The base64 PEM-encoded certificate specified by the CertificateArn parameter.
 	Certificate *string `type:"string"`
 
-	// The base64 PEM-encoded certificate chain that chains up to the on-premises
-	// root CA certificate that you used to sign your private CA certificate.
+	// The base64 PEM-encoded certificate chain that chains up to the root CA certificate
+	// that you used to sign your private CA certificate.
 	CertificateChain *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCertificateOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCertificateOutput) GoString() string {
 	return s.String()
 }
@@ -3632,43 +5544,132 @@ func (s *GetCertificateOutput) SetCertificateChain(v string) *GetCertificateOutp
 	return s
 }
 
-type ImportCertificateAuthorityCertificateInput struct {
+type GetPolicyInput struct {
 	_ struct{} `type:"structure"`
 
-	// The PEM-encoded certificate for a private CA. This may be a self-signed certificate
-	// in the case of a root CA, or it may be signed by another CA that you control.
-	//
-	// Certificate is automatically base64 encoded/decoded by the SDK.
-	//
-	// Certificate is a required field
-	Certificate []byte `min:"1" type:"blob" required:"true"`
-
-	// The Amazon Resource Name (ARN) that was returned when you called CreateCertificateAuthority.
-	// This must be of the form:
-	//
-	// arn:aws:acm-pca:region:account:certificate-authority/12345678-1234-1234-1234-123456789012
-	//
-	// CertificateAuthorityArn is a required field
-	CertificateAuthorityArn *string `min:"5" type:"string" required:"true"`
-
-	// A PEM-encoded file that contains all of your certificates, other than the
-	// certificate you're importing, chaining up to your root CA. Your ACM Private
-	// CA-hosted or on-premises root certificate is the last in the chain, and each
-	// certificate in the chain signs the one preceding.
-	//
-	// This parameter must be supplied when you import a subordinate CA. When you
-	// import a root CA, there is no chain.
+	// The Amazon Resource Number (ARN) of the private CA that will have its policy
+	// retrieved. You can find the CA's ARN by calling the ListCertificateAuthorities
+	// action.
 	//
-	// CertificateChain is automatically base64 encoded/decoded by the SDK.
-	CertificateChain []byte `type:"blob"`
+	// ResourceArn is a required field
+	ResourceArn *string `min:"5" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetPolicyInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetPolicyInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetPolicyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetPolicyInput"}
+	if s.ResourceArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceArn"))
+	}
+	if s.ResourceArn != nil && len(*s.ResourceArn) < 5 {
+		invalidParams.Add(request.NewErrParamMinLen("ResourceArn", 5))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetResourceArn sets the ResourceArn field's value.
+func (s *GetPolicyInput) SetResourceArn(v string) *GetPolicyInput {
+	s.ResourceArn = &v
+	return s
+}
+
+type GetPolicyOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The policy attached to the private CA as a JSON document.
+	Policy *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetPolicyOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetPolicyOutput) GoString() string {
+	return s.String()
+}
+
+// SetPolicy sets the Policy field's value.
+func (s *GetPolicyOutput) SetPolicy(v string) *GetPolicyOutput {
+	s.Policy = &v
+	return s
+}
+
+type ImportCertificateAuthorityCertificateInput struct {
+	_ struct{} `type:"structure"`
+
+	// The PEM-encoded certificate for a private CA. This may be a self-signed certificate
+	// in the case of a root CA, or it may be signed by another CA that you control.
+	// Certificate is automatically base64 encoded/decoded by the SDK.
+	//
+	// Certificate is a required field
+	Certificate []byte `min:"1" type:"blob" required:"true"`
+
+	// The Amazon Resource Name (ARN) that was returned when you called CreateCertificateAuthority
+	// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreateCertificateAuthority.html).
+	// This must be of the form:
+	//
+	// arn:aws:acm-pca:region:account:certificate-authority/12345678-1234-1234-1234-123456789012
+	//
+	// CertificateAuthorityArn is a required field
+	CertificateAuthorityArn *string `min:"5" type:"string" required:"true"`
+
+	// A PEM-encoded file that contains all of your certificates, other than the
+	// certificate you're importing, chaining up to your root CA. Your Amazon Web
+	// Services Private CA-hosted or on-premises root certificate is the last in
+	// the chain, and each certificate in the chain signs the one preceding.
+	//
+	// This parameter must be supplied when you import a subordinate CA. When you
+	// import a root CA, there is no chain.
+	// CertificateChain is automatically base64 encoded/decoded by the SDK.
+	CertificateChain []byte `type:"blob"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ImportCertificateAuthorityCertificateInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ImportCertificateAuthorityCertificateInput) GoString() string {
 	return s.String()
 }
@@ -3717,232 +5718,1802 @@ type ImportCertificateAuthorityCertificateOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ImportCertificateAuthorityCertificateOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ImportCertificateAuthorityCertificateOutput) GoString() string {
 	return s.String()
 }
 
-type IssueCertificateInput struct {
-	_ struct{} `type:"structure"`
+// One or more of the specified arguments was not valid.
+type InvalidArgsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The Amazon Resource Name (ARN) that was returned when you called CreateCertificateAuthority.
-	// This must be of the form:
-	//
-	// arn:aws:acm-pca:region:account:certificate-authority/12345678-1234-1234-1234-123456789012
-	//
-	// CertificateAuthorityArn is a required field
-	CertificateAuthorityArn *string `min:"5" type:"string" required:"true"`
+	Message_ *string `locationName:"message" type:"string"`
+}
 
-	// The certificate signing request (CSR) for the certificate you want to issue.
-	// You can use the following OpenSSL command to create the CSR and a 2048 bit
-	// RSA private key.
-	//
-	// openssl req -new -newkey rsa:2048 -days 365 -keyout private/test_cert_priv_key.pem
-	// -out csr/test_cert_.csr
-	//
-	// If you have a configuration file, you can use the following OpenSSL command.
-	// The usr_cert block in the configuration file contains your X509 version 3
-	// extensions.
-	//
-	// openssl req -new -config openssl_rsa.cnf -extensions usr_cert -newkey rsa:2048
-	// -days -365 -keyout private/test_cert_priv_key.pem -out csr/test_cert_.csr
-	//
-	// Csr is automatically base64 encoded/decoded by the SDK.
-	//
-	// Csr is a required field
-	Csr []byte `min:"1" type:"blob" required:"true"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidArgsException) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// Custom string that can be used to distinguish between calls to the IssueCertificate
-	// action. Idempotency tokens time out after one hour. Therefore, if you call
-	// IssueCertificate multiple times with the same idempotency token within 5
-	// minutes, ACM Private CA recognizes that you are requesting only one certificate
-	// and will issue only one. If you change the idempotency token for each call,
-	// PCA recognizes that you are requesting multiple certificates.
-	IdempotencyToken *string `min:"1" type:"string"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidArgsException) GoString() string {
+	return s.String()
+}
 
-	// The name of the algorithm that will be used to sign the certificate to be
-	// issued.
-	//
-	// SigningAlgorithm is a required field
-	SigningAlgorithm *string `type:"string" required:"true" enum:"SigningAlgorithm"`
+func newErrorInvalidArgsException(v protocol.ResponseMetadata) error {
+	return &InvalidArgsException{
+		RespMetadata: v,
+	}
+}
 
-	// Specifies a custom configuration template to use when issuing a certificate.
-	// If this parameter is not provided, ACM Private CA defaults to the EndEntityCertificate/V1
-	// template.
-	//
-	// The following service-owned TemplateArn values are supported by ACM Private
-	// CA:
-	//
-	//    * arn:aws:acm-pca:::template/EndEntityCertificate/V1
-	//
-	//    * arn:aws:acm-pca:::template/SubordinateCACertificate_PathLen0/V1
-	//
-	//    * arn:aws:acm-pca:::template/SubordinateCACertificate_PathLen1/V1
-	//
-	//    * arn:aws:acm-pca:::template/SubordinateCACertificate_PathLen2/V1
-	//
-	//    * arn:aws:acm-pca:::template/SubordinateCACertificate_PathLen3/V1
-	//
-	//    * arn:aws:acm-pca:::template/RootCACertificate/V1
-	//
-	// For more information, see Using Templates (https://docs.aws.amazon.com/acm-pca/latest/userguide/UsingTemplates.html).
-	TemplateArn *string `min:"5" type:"string"`
+// Code returns the exception type name.
+func (s *InvalidArgsException) Code() string {
+	return "InvalidArgsException"
+}
 
-	// The type of the validity period.
-	//
-	// Validity is a required field
-	Validity *Validity `type:"structure" required:"true"`
+// Message returns the exception's message.
+func (s *InvalidArgsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// String returns the string representation
-func (s IssueCertificateInput) String() string {
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidArgsException) OrigErr() error {
+	return nil
+}
+
+func (s *InvalidArgsException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidArgsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidArgsException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The requested Amazon Resource Name (ARN) does not refer to an existing resource.
+type InvalidArnException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidArnException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s IssueCertificateInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidArnException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *IssueCertificateInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "IssueCertificateInput"}
-	if s.CertificateAuthorityArn == nil {
-		invalidParams.Add(request.NewErrParamRequired("CertificateAuthorityArn"))
-	}
-	if s.CertificateAuthorityArn != nil && len(*s.CertificateAuthorityArn) < 5 {
-		invalidParams.Add(request.NewErrParamMinLen("CertificateAuthorityArn", 5))
-	}
-	if s.Csr == nil {
-		invalidParams.Add(request.NewErrParamRequired("Csr"))
+func newErrorInvalidArnException(v protocol.ResponseMetadata) error {
+	return &InvalidArnException{
+		RespMetadata: v,
 	}
-	if s.Csr != nil && len(s.Csr) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Csr", 1))
+}
+
+// Code returns the exception type name.
+func (s *InvalidArnException) Code() string {
+	return "InvalidArnException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidArnException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
-	if s.IdempotencyToken != nil && len(*s.IdempotencyToken) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("IdempotencyToken", 1))
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidArnException) OrigErr() error {
+	return nil
+}
+
+func (s *InvalidArnException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidArnException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidArnException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The token specified in the NextToken argument is not valid. Use the token
+// returned from your previous call to ListCertificateAuthorities (https://docs.aws.amazon.com/privateca/latest/APIReference/API_ListCertificateAuthorities.html).
+type InvalidNextTokenException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidNextTokenException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidNextTokenException) GoString() string {
+	return s.String()
+}
+
+func newErrorInvalidNextTokenException(v protocol.ResponseMetadata) error {
+	return &InvalidNextTokenException{
+		RespMetadata: v,
 	}
-	if s.SigningAlgorithm == nil {
-		invalidParams.Add(request.NewErrParamRequired("SigningAlgorithm"))
+}
+
+// Code returns the exception type name.
+func (s *InvalidNextTokenException) Code() string {
+	return "InvalidNextTokenException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidNextTokenException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
-	if s.TemplateArn != nil && len(*s.TemplateArn) < 5 {
-		invalidParams.Add(request.NewErrParamMinLen("TemplateArn", 5))
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidNextTokenException) OrigErr() error {
+	return nil
+}
+
+func (s *InvalidNextTokenException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidNextTokenException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidNextTokenException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The resource policy is invalid or is missing a required statement. For general
+// information about IAM policy and statement structure, see Overview of JSON
+// Policies (https://docs.aws.amazon.com/IAM/latest/UserGuide/access_policies.html#access_policies-json).
+type InvalidPolicyException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidPolicyException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidPolicyException) GoString() string {
+	return s.String()
+}
+
+func newErrorInvalidPolicyException(v protocol.ResponseMetadata) error {
+	return &InvalidPolicyException{
+		RespMetadata: v,
 	}
-	if s.Validity == nil {
-		invalidParams.Add(request.NewErrParamRequired("Validity"))
+}
+
+// Code returns the exception type name.
+func (s *InvalidPolicyException) Code() string {
+	return "InvalidPolicyException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidPolicyException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
-	if s.Validity != nil {
-		if err := s.Validity.Validate(); err != nil {
-			invalidParams.AddNested("Validity", err.(request.ErrInvalidParams))
-		}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidPolicyException) OrigErr() error {
+	return nil
+}
+
+func (s *InvalidPolicyException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidPolicyException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidPolicyException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The request action cannot be performed or is prohibited.
+type InvalidRequestException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidRequestException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidRequestException) GoString() string {
+	return s.String()
+}
+
+func newErrorInvalidRequestException(v protocol.ResponseMetadata) error {
+	return &InvalidRequestException{
+		RespMetadata: v,
 	}
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
+// Code returns the exception type name.
+func (s *InvalidRequestException) Code() string {
+	return "InvalidRequestException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidRequestException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidRequestException) OrigErr() error {
 	return nil
 }
 
-// SetCertificateAuthorityArn sets the CertificateAuthorityArn field's value.
-func (s *IssueCertificateInput) SetCertificateAuthorityArn(v string) *IssueCertificateInput {
-	s.CertificateAuthorityArn = &v
+func (s *InvalidRequestException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidRequestException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidRequestException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The state of the private CA does not allow this action to occur.
+type InvalidStateException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidStateException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidStateException) GoString() string {
+	return s.String()
+}
+
+func newErrorInvalidStateException(v protocol.ResponseMetadata) error {
+	return &InvalidStateException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InvalidStateException) Code() string {
+	return "InvalidStateException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidStateException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidStateException) OrigErr() error {
+	return nil
+}
+
+func (s *InvalidStateException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidStateException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidStateException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The tag associated with the CA is not valid. The invalid argument is contained
+// in the message field.
+type InvalidTagException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidTagException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidTagException) GoString() string {
+	return s.String()
+}
+
+func newErrorInvalidTagException(v protocol.ResponseMetadata) error {
+	return &InvalidTagException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InvalidTagException) Code() string {
+	return "InvalidTagException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidTagException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidTagException) OrigErr() error {
+	return nil
+}
+
+func (s *InvalidTagException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidTagException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidTagException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type IssueCertificateInput struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies X.509 certificate information to be included in the issued certificate.
+	// An APIPassthrough or APICSRPassthrough template variant must be selected,
+	// or else this parameter is ignored. For more information about using these
+	// templates, see Understanding Certificate Templates (https://docs.aws.amazon.com/privateca/latest/userguide/UsingTemplates.html).
+	//
+	// If conflicting or duplicate certificate information is supplied during certificate
+	// issuance, Amazon Web Services Private CA applies order of operation rules
+	// (https://docs.aws.amazon.com/privateca/latest/userguide/UsingTemplates.html#template-order-of-operations)
+	// to determine what information is used.
+	ApiPassthrough *ApiPassthrough `type:"structure"`
+
+	// The Amazon Resource Name (ARN) that was returned when you called CreateCertificateAuthority
+	// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreateCertificateAuthority.html).
+	// This must be of the form:
+	//
+	// arn:aws:acm-pca:region:account:certificate-authority/12345678-1234-1234-1234-123456789012
+	//
+	// CertificateAuthorityArn is a required field
+	CertificateAuthorityArn *string `min:"5" type:"string" required:"true"`
+
+	// The certificate signing request (CSR) for the certificate you want to issue.
+	// As an example, you can use the following OpenSSL command to create the CSR
+	// and a 2048 bit RSA private key.
+	//
+	// openssl req -new -newkey rsa:2048 -days 365 -keyout private/test_cert_priv_key.pem
+	// -out csr/test_cert_.csr
+	//
+	// If you have a configuration file, you can then use the following OpenSSL
+	// command. The usr_cert block in the configuration file contains your X509
+	// version 3 extensions.
+	//
+	// openssl req -new -config openssl_rsa.cnf -extensions usr_cert -newkey rsa:2048
+	// -days 365 -keyout private/test_cert_priv_key.pem -out csr/test_cert_.csr
+	//
+	// Note: A CSR must provide either a subject name or a subject alternative name
+	// or the request will be rejected.
+	// Csr is automatically base64 encoded/decoded by the SDK.
+	//
+	// Csr is a required field
+	Csr []byte `min:"1" type:"blob" required:"true"`
+
+	// Alphanumeric string that can be used to distinguish between calls to the
+	// IssueCertificate action. Idempotency tokens for IssueCertificate time out
+	// after five minutes. Therefore, if you call IssueCertificate multiple times
+	// with the same idempotency token within five minutes, Amazon Web Services
+	// Private CA recognizes that you are requesting only one certificate and will
+	// issue only one. If you change the idempotency token for each call, Amazon
+	// Web Services Private CA recognizes that you are requesting multiple certificates.
+	IdempotencyToken *string `min:"1" type:"string"`
+
+	// The name of the algorithm that will be used to sign the certificate to be
+	// issued.
+	//
+	// This parameter should not be confused with the SigningAlgorithm parameter
+	// used to sign a CSR in the CreateCertificateAuthority action.
+	//
+	// The specified signing algorithm family (RSA or ECDSA) must match the algorithm
+	// family of the CA's secret key.
+	//
+	// SigningAlgorithm is a required field
+	SigningAlgorithm *string `type:"string" required:"true" enum:"SigningAlgorithm"`
+
+	// Specifies a custom configuration template to use when issuing a certificate.
+	// If this parameter is not provided, Amazon Web Services Private CA defaults
+	// to the EndEntityCertificate/V1 template. For CA certificates, you should
+	// choose the shortest path length that meets your needs. The path length is
+	// indicated by the PathLenN portion of the ARN, where N is the CA depth (https://docs.aws.amazon.com/privateca/latest/userguide/PcaTerms.html#terms-cadepth).
+	//
+	// Note: The CA depth configured on a subordinate CA certificate must not exceed
+	// the limit set by its parents in the CA hierarchy.
+	//
+	// For a list of TemplateArn values supported by Amazon Web Services Private
+	// CA, see Understanding Certificate Templates (https://docs.aws.amazon.com/privateca/latest/userguide/UsingTemplates.html).
+	TemplateArn *string `min:"5" type:"string"`
+
+	// Information describing the end of the validity period of the certificate.
+	// This parameter sets the “Not After” date for the certificate.
+	//
+	// Certificate validity is the period of time during which a certificate is
+	// valid. Validity can be expressed as an explicit date and time when the certificate
+	// expires, or as a span of time after issuance, stated in days, months, or
+	// years. For more information, see Validity (https://datatracker.ietf.org/doc/html/rfc5280#section-4.1.2.5)
+	// in RFC 5280.
+	//
+	// This value is unaffected when ValidityNotBefore is also specified. For example,
+	// if Validity is set to 20 days in the future, the certificate will expire
+	// 20 days from issuance time regardless of the ValidityNotBefore value.
+	//
+	// The end of the validity period configured on a certificate must not exceed
+	// the limit set on its parents in the CA hierarchy.
+	//
+	// Validity is a required field
+	Validity *Validity `type:"structure" required:"true"`
+
+	// Information describing the start of the validity period of the certificate.
+	// This parameter sets the “Not Before" date for the certificate.
+	//
+	// By default, when issuing a certificate, Amazon Web Services Private CA sets
+	// the "Not Before" date to the issuance time minus 60 minutes. This compensates
+	// for clock inconsistencies across computer systems. The ValidityNotBefore
+	// parameter can be used to customize the “Not Before” value.
+	//
+	// Unlike the Validity parameter, the ValidityNotBefore parameter is optional.
+	//
+	// The ValidityNotBefore value is expressed as an explicit date and time, using
+	// the Validity type value ABSOLUTE. For more information, see Validity (https://docs.aws.amazon.com/privateca/latest/APIReference/API_Validity.html)
+	// in this API reference and Validity (https://datatracker.ietf.org/doc/html/rfc5280#section-4.1.2.5)
+	// in RFC 5280.
+	ValidityNotBefore *Validity `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IssueCertificateInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IssueCertificateInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *IssueCertificateInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "IssueCertificateInput"}
+	if s.CertificateAuthorityArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("CertificateAuthorityArn"))
+	}
+	if s.CertificateAuthorityArn != nil && len(*s.CertificateAuthorityArn) < 5 {
+		invalidParams.Add(request.NewErrParamMinLen("CertificateAuthorityArn", 5))
+	}
+	if s.Csr == nil {
+		invalidParams.Add(request.NewErrParamRequired("Csr"))
+	}
+	if s.Csr != nil && len(s.Csr) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Csr", 1))
+	}
+	if s.IdempotencyToken != nil && len(*s.IdempotencyToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("IdempotencyToken", 1))
+	}
+	if s.SigningAlgorithm == nil {
+		invalidParams.Add(request.NewErrParamRequired("SigningAlgorithm"))
+	}
+	if s.TemplateArn != nil && len(*s.TemplateArn) < 5 {
+		invalidParams.Add(request.NewErrParamMinLen("TemplateArn", 5))
+	}
+	if s.Validity == nil {
+		invalidParams.Add(request.NewErrParamRequired("Validity"))
+	}
+	if s.ApiPassthrough != nil {
+		if err := s.ApiPassthrough.Validate(); err != nil {
+			invalidParams.AddNested("ApiPassthrough", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Validity != nil {
+		if err := s.Validity.Validate(); err != nil {
+			invalidParams.AddNested("Validity", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ValidityNotBefore != nil {
+		if err := s.ValidityNotBefore.Validate(); err != nil {
+			invalidParams.AddNested("ValidityNotBefore", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetApiPassthrough sets the ApiPassthrough field's value.
+func (s *IssueCertificateInput) SetApiPassthrough(v *ApiPassthrough) *IssueCertificateInput {
+	s.ApiPassthrough = v
+	return s
+}
+
+// SetCertificateAuthorityArn sets the CertificateAuthorityArn field's value.
+func (s *IssueCertificateInput) SetCertificateAuthorityArn(v string) *IssueCertificateInput {
+	s.CertificateAuthorityArn = &v
+	return s
+}
+
+// SetCsr sets the Csr field's value.
+func (s *IssueCertificateInput) SetCsr(v []byte) *IssueCertificateInput {
+	s.Csr = v
+	return s
+}
+
+// SetIdempotencyToken sets the IdempotencyToken field's value.
+func (s *IssueCertificateInput) SetIdempotencyToken(v string) *IssueCertificateInput {
+	s.IdempotencyToken = &v
+	return s
+}
+
+// SetSigningAlgorithm sets the SigningAlgorithm field's value.
+func (s *IssueCertificateInput) SetSigningAlgorithm(v string) *IssueCertificateInput {
+	s.SigningAlgorithm = &v
+	return s
+}
+
+// SetTemplateArn sets the TemplateArn field's value.
+func (s *IssueCertificateInput) SetTemplateArn(v string) *IssueCertificateInput {
+	s.TemplateArn = &v
+	return s
+}
+
+// SetValidity sets the Validity field's value.
+func (s *IssueCertificateInput) SetValidity(v *Validity) *IssueCertificateInput {
+	s.Validity = v
+	return s
+}
+
+// SetValidityNotBefore sets the ValidityNotBefore field's value.
+func (s *IssueCertificateInput) SetValidityNotBefore(v *Validity) *IssueCertificateInput {
+	s.ValidityNotBefore = v
+	return s
+}
+
+type IssueCertificateOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the issued certificate and the certificate
+	// serial number. This is of the form:
+	//
+	// arn:aws:acm-pca:region:account:certificate-authority/12345678-1234-1234-1234-123456789012/certificate/286535153982981100925020015808220737245
+	CertificateArn *string `min:"5" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IssueCertificateOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IssueCertificateOutput) GoString() string {
+	return s.String()
+}
+
+// SetCertificateArn sets the CertificateArn field's value.
+func (s *IssueCertificateOutput) SetCertificateArn(v string) *IssueCertificateOutput {
+	s.CertificateArn = &v
+	return s
+}
+
+// Defines one or more purposes for which the key contained in the certificate
+// can be used. Default value for each option is false.
+type KeyUsage struct {
+	_ struct{} `type:"structure"`
+
+	// Key can be used to sign CRLs.
+	CRLSign *bool `type:"boolean"`
+
+	// Key can be used to decipher data.
+	DataEncipherment *bool `type:"boolean"`
+
+	// Key can be used only to decipher data.
+	DecipherOnly *bool `type:"boolean"`
+
+	// Key can be used for digital signing.
+	DigitalSignature *bool `type:"boolean"`
+
+	// Key can be used only to encipher data.
+	EncipherOnly *bool `type:"boolean"`
+
+	// Key can be used in a key-agreement protocol.
+	KeyAgreement *bool `type:"boolean"`
+
+	// Key can be used to sign certificates.
+	KeyCertSign *bool `type:"boolean"`
+
+	// Key can be used to encipher data.
+	KeyEncipherment *bool `type:"boolean"`
+
+	// Key can be used for non-repudiation.
+	NonRepudiation *bool `type:"boolean"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s KeyUsage) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s KeyUsage) GoString() string {
+	return s.String()
+}
+
+// SetCRLSign sets the CRLSign field's value.
+func (s *KeyUsage) SetCRLSign(v bool) *KeyUsage {
+	s.CRLSign = &v
+	return s
+}
+
+// SetDataEncipherment sets the DataEncipherment field's value.
+func (s *KeyUsage) SetDataEncipherment(v bool) *KeyUsage {
+	s.DataEncipherment = &v
+	return s
+}
+
+// SetDecipherOnly sets the DecipherOnly field's value.
+func (s *KeyUsage) SetDecipherOnly(v bool) *KeyUsage {
+	s.DecipherOnly = &v
+	return s
+}
+
+// SetDigitalSignature sets the DigitalSignature field's value.
+func (s *KeyUsage) SetDigitalSignature(v bool) *KeyUsage {
+	s.DigitalSignature = &v
+	return s
+}
+
+// SetEncipherOnly sets the EncipherOnly field's value.
+func (s *KeyUsage) SetEncipherOnly(v bool) *KeyUsage {
+	s.EncipherOnly = &v
+	return s
+}
+
+// SetKeyAgreement sets the KeyAgreement field's value.
+func (s *KeyUsage) SetKeyAgreement(v bool) *KeyUsage {
+	s.KeyAgreement = &v
+	return s
+}
+
+// SetKeyCertSign sets the KeyCertSign field's value.
+func (s *KeyUsage) SetKeyCertSign(v bool) *KeyUsage {
+	s.KeyCertSign = &v
+	return s
+}
+
+// SetKeyEncipherment sets the KeyEncipherment field's value.
+func (s *KeyUsage) SetKeyEncipherment(v bool) *KeyUsage {
+	s.KeyEncipherment = &v
+	return s
+}
+
+// SetNonRepudiation sets the NonRepudiation field's value.
+func (s *KeyUsage) SetNonRepudiation(v bool) *KeyUsage {
+	s.NonRepudiation = &v
+	return s
+}
+
+// An Amazon Web Services Private CA quota has been exceeded. See the exception
+// message returned to determine the quota that was exceeded.
+type LimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) GoString() string {
+	return s.String()
+}
+
+func newErrorLimitExceededException(v protocol.ResponseMetadata) error {
+	return &LimitExceededException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *LimitExceededException) Code() string {
+	return "LimitExceededException"
+}
+
+// Message returns the exception's message.
+func (s *LimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *LimitExceededException) OrigErr() error {
+	return nil
+}
+
+func (s *LimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *LimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *LimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type ListCertificateAuthoritiesInput struct {
+	_ struct{} `type:"structure"`
+
+	// Use this parameter when paginating results to specify the maximum number
+	// of items to return in the response on each page. If additional items exist
+	// beyond the number you specify, the NextToken element is sent in the response.
+	// Use this NextToken value in a subsequent request to retrieve additional items.
+	MaxResults *int64 `min:"1" type:"integer"`
+
+	// Use this parameter when paginating results in a subsequent request after
+	// you receive a response with truncated results. Set it to the value of the
+	// NextToken parameter from the response you just received.
+	NextToken *string `min:"1" type:"string"`
+
+	// Use this parameter to filter the returned set of certificate authorities
+	// based on their owner. The default is SELF.
+	ResourceOwner *string `type:"string" enum:"ResourceOwner"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCertificateAuthoritiesInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCertificateAuthoritiesInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListCertificateAuthoritiesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListCertificateAuthoritiesInput"}
+	if s.MaxResults != nil && *s.MaxResults < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+	}
+	if s.NextToken != nil && len(*s.NextToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListCertificateAuthoritiesInput) SetMaxResults(v int64) *ListCertificateAuthoritiesInput {
+	s.MaxResults = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListCertificateAuthoritiesInput) SetNextToken(v string) *ListCertificateAuthoritiesInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetResourceOwner sets the ResourceOwner field's value.
+func (s *ListCertificateAuthoritiesInput) SetResourceOwner(v string) *ListCertificateAuthoritiesInput {
+	s.ResourceOwner = &v
+	return s
+}
+
+type ListCertificateAuthoritiesOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Summary information about each certificate authority you have created.
+	CertificateAuthorities []*CertificateAuthority `type:"list"`
+
+	// When the list is truncated, this value is present and should be used for
+	// the NextToken parameter in a subsequent pagination request.
+	NextToken *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCertificateAuthoritiesOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCertificateAuthoritiesOutput) GoString() string {
+	return s.String()
+}
+
+// SetCertificateAuthorities sets the CertificateAuthorities field's value.
+func (s *ListCertificateAuthoritiesOutput) SetCertificateAuthorities(v []*CertificateAuthority) *ListCertificateAuthoritiesOutput {
+	s.CertificateAuthorities = v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListCertificateAuthoritiesOutput) SetNextToken(v string) *ListCertificateAuthoritiesOutput {
+	s.NextToken = &v
+	return s
+}
+
+type ListPermissionsInput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Number (ARN) of the private CA to inspect. You can find
+	// the ARN by calling the ListCertificateAuthorities (https://docs.aws.amazon.com/privateca/latest/APIReference/API_ListCertificateAuthorities.html)
+	// action. This must be of the form: arn:aws:acm-pca:region:account:certificate-authority/12345678-1234-1234-1234-123456789012
+	// You can get a private CA's ARN by running the ListCertificateAuthorities
+	// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_ListCertificateAuthorities.html)
+	// action.
+	//
+	// CertificateAuthorityArn is a required field
+	CertificateAuthorityArn *string `min:"5" type:"string" required:"true"`
+
+	// When paginating results, use this parameter to specify the maximum number
+	// of items to return in the response. If additional items exist beyond the
+	// number you specify, the NextToken element is sent in the response. Use this
+	// NextToken value in a subsequent request to retrieve additional items.
+	MaxResults *int64 `min:"1" type:"integer"`
+
+	// When paginating results, use this parameter in a subsequent request after
+	// you receive a response with truncated results. Set it to the value of NextToken
+	// from the response you just received.
+	NextToken *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListPermissionsInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListPermissionsInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListPermissionsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListPermissionsInput"}
+	if s.CertificateAuthorityArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("CertificateAuthorityArn"))
+	}
+	if s.CertificateAuthorityArn != nil && len(*s.CertificateAuthorityArn) < 5 {
+		invalidParams.Add(request.NewErrParamMinLen("CertificateAuthorityArn", 5))
+	}
+	if s.MaxResults != nil && *s.MaxResults < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+	}
+	if s.NextToken != nil && len(*s.NextToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCertificateAuthorityArn sets the CertificateAuthorityArn field's value.
+func (s *ListPermissionsInput) SetCertificateAuthorityArn(v string) *ListPermissionsInput {
+	s.CertificateAuthorityArn = &v
+	return s
+}
+
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListPermissionsInput) SetMaxResults(v int64) *ListPermissionsInput {
+	s.MaxResults = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListPermissionsInput) SetNextToken(v string) *ListPermissionsInput {
+	s.NextToken = &v
+	return s
+}
+
+type ListPermissionsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// When the list is truncated, this value is present and should be used for
+	// the NextToken parameter in a subsequent pagination request.
+	NextToken *string `min:"1" type:"string"`
+
+	// Summary information about each permission assigned by the specified private
+	// CA, including the action enabled, the policy provided, and the time of creation.
+	Permissions []*Permission `type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListPermissionsOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListPermissionsOutput) GoString() string {
+	return s.String()
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListPermissionsOutput) SetNextToken(v string) *ListPermissionsOutput {
+	s.NextToken = &v
+	return s
+}
+
+// SetPermissions sets the Permissions field's value.
+func (s *ListPermissionsOutput) SetPermissions(v []*Permission) *ListPermissionsOutput {
+	s.Permissions = v
+	return s
+}
+
+type ListTagsInput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) that was returned when you called the CreateCertificateAuthority
+	// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreateCertificateAuthority.html)
+	// action. This must be of the form:
+	//
+	// arn:aws:acm-pca:region:account:certificate-authority/12345678-1234-1234-1234-123456789012
+	//
+	// CertificateAuthorityArn is a required field
+	CertificateAuthorityArn *string `min:"5" type:"string" required:"true"`
+
+	// Use this parameter when paginating results to specify the maximum number
+	// of items to return in the response. If additional items exist beyond the
+	// number you specify, the NextToken element is sent in the response. Use this
+	// NextToken value in a subsequent request to retrieve additional items.
+	MaxResults *int64 `min:"1" type:"integer"`
+
+	// Use this parameter when paginating results in a subsequent request after
+	// you receive a response with truncated results. Set it to the value of NextToken
+	// from the response you just received.
+	NextToken *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListTagsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsInput"}
+	if s.CertificateAuthorityArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("CertificateAuthorityArn"))
+	}
+	if s.CertificateAuthorityArn != nil && len(*s.CertificateAuthorityArn) < 5 {
+		invalidParams.Add(request.NewErrParamMinLen("CertificateAuthorityArn", 5))
+	}
+	if s.MaxResults != nil && *s.MaxResults < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+	}
+	if s.NextToken != nil && len(*s.NextToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCertificateAuthorityArn sets the CertificateAuthorityArn field's value.
+func (s *ListTagsInput) SetCertificateAuthorityArn(v string) *ListTagsInput {
+	s.CertificateAuthorityArn = &v
+	return s
+}
+
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListTagsInput) SetMaxResults(v int64) *ListTagsInput {
+	s.MaxResults = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListTagsInput) SetNextToken(v string) *ListTagsInput {
+	s.NextToken = &v
+	return s
+}
+
+type ListTagsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// When the list is truncated, this value is present and should be used for
+	// the NextToken parameter in a subsequent pagination request.
+	NextToken *string `min:"1" type:"string"`
+
+	// The tags associated with your private CA.
+	Tags []*Tag `min:"1" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsOutput) GoString() string {
+	return s.String()
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListTagsOutput) SetNextToken(v string) *ListTagsOutput {
+	s.NextToken = &v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *ListTagsOutput) SetTags(v []*Tag) *ListTagsOutput {
+	s.Tags = v
+	return s
+}
+
+// The current action was prevented because it would lock the caller out from
+// performing subsequent actions. Verify that the specified parameters would
+// not result in the caller being denied access to the resource.
+type LockoutPreventedException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LockoutPreventedException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LockoutPreventedException) GoString() string {
+	return s.String()
+}
+
+func newErrorLockoutPreventedException(v protocol.ResponseMetadata) error {
+	return &LockoutPreventedException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *LockoutPreventedException) Code() string {
+	return "LockoutPreventedException"
+}
+
+// Message returns the exception's message.
+func (s *LockoutPreventedException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *LockoutPreventedException) OrigErr() error {
+	return nil
+}
+
+func (s *LockoutPreventedException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *LockoutPreventedException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *LockoutPreventedException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The certificate signing request is invalid.
+type MalformedCSRException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MalformedCSRException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MalformedCSRException) GoString() string {
+	return s.String()
+}
+
+func newErrorMalformedCSRException(v protocol.ResponseMetadata) error {
+	return &MalformedCSRException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *MalformedCSRException) Code() string {
+	return "MalformedCSRException"
+}
+
+// Message returns the exception's message.
+func (s *MalformedCSRException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *MalformedCSRException) OrigErr() error {
+	return nil
+}
+
+func (s *MalformedCSRException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *MalformedCSRException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *MalformedCSRException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// One or more fields in the certificate are invalid.
+type MalformedCertificateException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MalformedCertificateException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MalformedCertificateException) GoString() string {
+	return s.String()
+}
+
+func newErrorMalformedCertificateException(v protocol.ResponseMetadata) error {
+	return &MalformedCertificateException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *MalformedCertificateException) Code() string {
+	return "MalformedCertificateException"
+}
+
+// Message returns the exception's message.
+func (s *MalformedCertificateException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *MalformedCertificateException) OrigErr() error {
+	return nil
+}
+
+func (s *MalformedCertificateException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *MalformedCertificateException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *MalformedCertificateException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Contains information to enable and configure Online Certificate Status Protocol
+// (OCSP) for validating certificate revocation status.
+//
+// When you revoke a certificate, OCSP responses may take up to 60 minutes to
+// reflect the new status.
+type OcspConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	// Flag enabling use of the Online Certificate Status Protocol (OCSP) for validating
+	// certificate revocation status.
+	//
+	// Enabled is a required field
+	Enabled *bool `type:"boolean" required:"true"`
+
+	// By default, Amazon Web Services Private CA injects an Amazon Web Services
+	// domain into certificates being validated by the Online Certificate Status
+	// Protocol (OCSP). A customer can alternatively use this object to define a
+	// CNAME specifying a customized OCSP domain.
+	//
+	// The content of a Canonical Name (CNAME) record must conform to RFC2396 (https://www.ietf.org/rfc/rfc2396.txt)
+	// restrictions on the use of special characters in URIs. Additionally, the
+	// value of the CNAME must not include a protocol prefix such as "http://" or
+	// "https://".
+	//
+	// For more information, see Customizing Online Certificate Status Protocol
+	// (OCSP) (https://docs.aws.amazon.com/privateca/latest/userguide/ocsp-customize.html)
+	// in the Amazon Web Services Private Certificate Authority User Guide.
+	OcspCustomCname *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s OcspConfiguration) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s OcspConfiguration) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *OcspConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "OcspConfiguration"}
+	if s.Enabled == nil {
+		invalidParams.Add(request.NewErrParamRequired("Enabled"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetEnabled sets the Enabled field's value.
+func (s *OcspConfiguration) SetEnabled(v bool) *OcspConfiguration {
+	s.Enabled = &v
+	return s
+}
+
+// SetOcspCustomCname sets the OcspCustomCname field's value.
+func (s *OcspConfiguration) SetOcspCustomCname(v string) *OcspConfiguration {
+	s.OcspCustomCname = &v
+	return s
+}
+
+// Defines a custom ASN.1 X.400 GeneralName using an object identifier (OID)
+// and value. The OID must satisfy the regular expression shown below. For more
+// information, see NIST's definition of Object Identifier (OID) (https://csrc.nist.gov/glossary/term/Object_Identifier).
+type OtherName struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies an OID.
+	//
+	// TypeId is a required field
+	TypeId *string `type:"string" required:"true"`
+
+	// Specifies an OID value.
+	//
+	// Value is a required field
+	Value *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s OtherName) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s OtherName) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *OtherName) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "OtherName"}
+	if s.TypeId == nil {
+		invalidParams.Add(request.NewErrParamRequired("TypeId"))
+	}
+	if s.Value == nil {
+		invalidParams.Add(request.NewErrParamRequired("Value"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetTypeId sets the TypeId field's value.
+func (s *OtherName) SetTypeId(v string) *OtherName {
+	s.TypeId = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *OtherName) SetValue(v string) *OtherName {
+	s.Value = &v
+	return s
+}
+
+// Permissions designate which private CA actions can be performed by an Amazon
+// Web Services service or entity. In order for ACM to automatically renew private
+// certificates, you must give the ACM service principal all available permissions
+// (IssueCertificate, GetCertificate, and ListPermissions). Permissions can
+// be assigned with the CreatePermission (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreatePermission.html)
+// action, removed with the DeletePermission (https://docs.aws.amazon.com/privateca/latest/APIReference/API_DeletePermission.html)
+// action, and listed with the ListPermissions (https://docs.aws.amazon.com/privateca/latest/APIReference/API_ListPermissions.html)
+// action.
+type Permission struct {
+	_ struct{} `type:"structure"`
+
+	// The private CA actions that can be performed by the designated Amazon Web
+	// Services service.
+	Actions []*string `min:"1" type:"list" enum:"ActionType"`
+
+	// The Amazon Resource Number (ARN) of the private CA from which the permission
+	// was issued.
+	CertificateAuthorityArn *string `min:"5" type:"string"`
+
+	// The time at which the permission was created.
+	CreatedAt *time.Time `type:"timestamp"`
+
+	// The name of the policy that is associated with the permission.
+	Policy *string `min:"1" type:"string"`
+
+	// The Amazon Web Services service or entity that holds the permission. At this
+	// time, the only valid principal is acm.amazonaws.com.
+	Principal *string `type:"string"`
+
+	// The ID of the account that assigned the permission.
+	SourceAccount *string `min:"12" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Permission) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Permission) GoString() string {
+	return s.String()
+}
+
+// SetActions sets the Actions field's value.
+func (s *Permission) SetActions(v []*string) *Permission {
+	s.Actions = v
+	return s
+}
+
+// SetCertificateAuthorityArn sets the CertificateAuthorityArn field's value.
+func (s *Permission) SetCertificateAuthorityArn(v string) *Permission {
+	s.CertificateAuthorityArn = &v
+	return s
+}
+
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *Permission) SetCreatedAt(v time.Time) *Permission {
+	s.CreatedAt = &v
+	return s
+}
+
+// SetPolicy sets the Policy field's value.
+func (s *Permission) SetPolicy(v string) *Permission {
+	s.Policy = &v
 	return s
 }
 
-// SetCsr sets the Csr field's value.
-func (s *IssueCertificateInput) SetCsr(v []byte) *IssueCertificateInput {
-	s.Csr = v
+// SetPrincipal sets the Principal field's value.
+func (s *Permission) SetPrincipal(v string) *Permission {
+	s.Principal = &v
 	return s
 }
 
-// SetIdempotencyToken sets the IdempotencyToken field's value.
-func (s *IssueCertificateInput) SetIdempotencyToken(v string) *IssueCertificateInput {
-	s.IdempotencyToken = &v
+// SetSourceAccount sets the SourceAccount field's value.
+func (s *Permission) SetSourceAccount(v string) *Permission {
+	s.SourceAccount = &v
 	return s
 }
 
-// SetSigningAlgorithm sets the SigningAlgorithm field's value.
-func (s *IssueCertificateInput) SetSigningAlgorithm(v string) *IssueCertificateInput {
-	s.SigningAlgorithm = &v
-	return s
+// The designated permission has already been given to the user.
+type PermissionAlreadyExistsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// SetTemplateArn sets the TemplateArn field's value.
-func (s *IssueCertificateInput) SetTemplateArn(v string) *IssueCertificateInput {
-	s.TemplateArn = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PermissionAlreadyExistsException) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetValidity sets the Validity field's value.
-func (s *IssueCertificateInput) SetValidity(v *Validity) *IssueCertificateInput {
-	s.Validity = v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PermissionAlreadyExistsException) GoString() string {
+	return s.String()
 }
 
-type IssueCertificateOutput struct {
-	_ struct{} `type:"structure"`
+func newErrorPermissionAlreadyExistsException(v protocol.ResponseMetadata) error {
+	return &PermissionAlreadyExistsException{
+		RespMetadata: v,
+	}
+}
 
-	// The Amazon Resource Name (ARN) of the issued certificate and the certificate
-	// serial number. This is of the form:
-	//
-	// arn:aws:acm-pca:region:account:certificate-authority/12345678-1234-1234-1234-123456789012/certificate/286535153982981100925020015808220737245
-	CertificateArn *string `min:"5" type:"string"`
+// Code returns the exception type name.
+func (s *PermissionAlreadyExistsException) Code() string {
+	return "PermissionAlreadyExistsException"
 }
 
-// String returns the string representation
-func (s IssueCertificateOutput) String() string {
-	return awsutil.Prettify(s)
+// Message returns the exception's message.
+func (s *PermissionAlreadyExistsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// GoString returns the string representation
-func (s IssueCertificateOutput) GoString() string {
-	return s.String()
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *PermissionAlreadyExistsException) OrigErr() error {
+	return nil
 }
 
-// SetCertificateArn sets the CertificateArn field's value.
-func (s *IssueCertificateOutput) SetCertificateArn(v string) *IssueCertificateOutput {
-	s.CertificateArn = &v
-	return s
+func (s *PermissionAlreadyExistsException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-type ListCertificateAuthoritiesInput struct {
+// Status code returns the HTTP status code for the request's response error.
+func (s *PermissionAlreadyExistsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *PermissionAlreadyExistsException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Defines the X.509 CertificatePolicies extension.
+type PolicyInformation struct {
 	_ struct{} `type:"structure"`
 
-	// Use this parameter when paginating results to specify the maximum number
-	// of items to return in the response on each page. If additional items exist
-	// beyond the number you specify, the NextToken element is sent in the response.
-	// Use this NextToken value in a subsequent request to retrieve additional items.
-	MaxResults *int64 `min:"1" type:"integer"`
+	// Specifies the object identifier (OID) of the certificate policy under which
+	// the certificate was issued. For more information, see NIST's definition of
+	// Object Identifier (OID) (https://csrc.nist.gov/glossary/term/Object_Identifier).
+	//
+	// CertPolicyId is a required field
+	CertPolicyId *string `type:"string" required:"true"`
 
-	// Use this parameter when paginating results in a subsequent request after
-	// you receive a response with truncated results. Set it to the value of the
-	// NextToken parameter from the response you just received.
-	NextToken *string `min:"1" type:"string"`
+	// Modifies the given CertPolicyId with a qualifier. Amazon Web Services Private
+	// CA supports the certification practice statement (CPS) qualifier.
+	PolicyQualifiers []*PolicyQualifierInfo `min:"1" type:"list"`
 }
 
-// String returns the string representation
-func (s ListCertificateAuthoritiesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PolicyInformation) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListCertificateAuthoritiesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PolicyInformation) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListCertificateAuthoritiesInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListCertificateAuthoritiesInput"}
-	if s.MaxResults != nil && *s.MaxResults < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+func (s *PolicyInformation) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "PolicyInformation"}
+	if s.CertPolicyId == nil {
+		invalidParams.Add(request.NewErrParamRequired("CertPolicyId"))
 	}
-	if s.NextToken != nil && len(*s.NextToken) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	if s.PolicyQualifiers != nil && len(s.PolicyQualifiers) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("PolicyQualifiers", 1))
+	}
+	if s.PolicyQualifiers != nil {
+		for i, v := range s.PolicyQualifiers {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PolicyQualifiers", i), err.(request.ErrInvalidParams))
+			}
+		}
 	}
 
 	if invalidParams.Len() > 0 {
@@ -3951,99 +7522,140 @@ func (s *ListCertificateAuthoritiesInput) Validate() error {
 	return nil
 }
 
-// SetMaxResults sets the MaxResults field's value.
-func (s *ListCertificateAuthoritiesInput) SetMaxResults(v int64) *ListCertificateAuthoritiesInput {
-	s.MaxResults = &v
+// SetCertPolicyId sets the CertPolicyId field's value.
+func (s *PolicyInformation) SetCertPolicyId(v string) *PolicyInformation {
+	s.CertPolicyId = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListCertificateAuthoritiesInput) SetNextToken(v string) *ListCertificateAuthoritiesInput {
-	s.NextToken = &v
+// SetPolicyQualifiers sets the PolicyQualifiers field's value.
+func (s *PolicyInformation) SetPolicyQualifiers(v []*PolicyQualifierInfo) *PolicyInformation {
+	s.PolicyQualifiers = v
 	return s
 }
 
-type ListCertificateAuthoritiesOutput struct {
+// Modifies the CertPolicyId of a PolicyInformation object with a qualifier.
+// Amazon Web Services Private CA supports the certification practice statement
+// (CPS) qualifier.
+type PolicyQualifierInfo struct {
 	_ struct{} `type:"structure"`
 
-	// Summary information about each certificate authority you have created.
-	CertificateAuthorities []*CertificateAuthority `type:"list"`
+	// Identifies the qualifier modifying a CertPolicyId.
+	//
+	// PolicyQualifierId is a required field
+	PolicyQualifierId *string `type:"string" required:"true" enum:"PolicyQualifierId"`
 
-	// When the list is truncated, this value is present and should be used for
-	// the NextToken parameter in a subsequent pagination request.
-	NextToken *string `min:"1" type:"string"`
+	// Defines the qualifier type. Amazon Web Services Private CA supports the use
+	// of a URI for a CPS qualifier in this field.
+	//
+	// Qualifier is a required field
+	Qualifier *Qualifier `type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s ListCertificateAuthoritiesOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PolicyQualifierInfo) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListCertificateAuthoritiesOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PolicyQualifierInfo) GoString() string {
 	return s.String()
 }
 
-// SetCertificateAuthorities sets the CertificateAuthorities field's value.
-func (s *ListCertificateAuthoritiesOutput) SetCertificateAuthorities(v []*CertificateAuthority) *ListCertificateAuthoritiesOutput {
-	s.CertificateAuthorities = v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *PolicyQualifierInfo) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "PolicyQualifierInfo"}
+	if s.PolicyQualifierId == nil {
+		invalidParams.Add(request.NewErrParamRequired("PolicyQualifierId"))
+	}
+	if s.Qualifier == nil {
+		invalidParams.Add(request.NewErrParamRequired("Qualifier"))
+	}
+	if s.Qualifier != nil {
+		if err := s.Qualifier.Validate(); err != nil {
+			invalidParams.AddNested("Qualifier", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetPolicyQualifierId sets the PolicyQualifierId field's value.
+func (s *PolicyQualifierInfo) SetPolicyQualifierId(v string) *PolicyQualifierInfo {
+	s.PolicyQualifierId = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListCertificateAuthoritiesOutput) SetNextToken(v string) *ListCertificateAuthoritiesOutput {
-	s.NextToken = &v
+// SetQualifier sets the Qualifier field's value.
+func (s *PolicyQualifierInfo) SetQualifier(v *Qualifier) *PolicyQualifierInfo {
+	s.Qualifier = v
 	return s
 }
 
-type ListPermissionsInput struct {
+type PutPolicyInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Number (ARN) of the private CA to inspect. You can find
-	// the ARN by calling the ListCertificateAuthorities action. This must be of
-	// the form: arn:aws:acm-pca:region:account:certificate-authority/12345678-1234-1234-1234-123456789012
-	// You can get a private CA's ARN by running the ListCertificateAuthorities
-	// action.
+	// The path and file name of a JSON-formatted IAM policy to attach to the specified
+	// private CA resource. If this policy does not contain all required statements
+	// or if it includes any statement that is not allowed, the PutPolicy action
+	// returns an InvalidPolicyException. For information about IAM policy and statement
+	// structure, see Overview of JSON Policies (https://docs.aws.amazon.com/IAM/latest/UserGuide/access_policies.html#access_policies-json).
 	//
-	// CertificateAuthorityArn is a required field
-	CertificateAuthorityArn *string `min:"5" type:"string" required:"true"`
-
-	// When paginating results, use this parameter to specify the maximum number
-	// of items to return in the response. If additional items exist beyond the
-	// number you specify, the NextToken element is sent in the response. Use this
-	// NextToken value in a subsequent request to retrieve additional items.
-	MaxResults *int64 `min:"1" type:"integer"`
+	// Policy is a required field
+	Policy *string `min:"1" type:"string" required:"true"`
 
-	// When paginating results, use this parameter in a subsequent request after
-	// you receive a response with truncated results. Set it to the value of NextToken
-	// from the response you just received.
-	NextToken *string `min:"1" type:"string"`
+	// The Amazon Resource Number (ARN) of the private CA to associate with the
+	// policy. The ARN of the CA can be found by calling the ListCertificateAuthorities
+	// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_ListCertificateAuthorities.html)
+	// action.
+	//
+	// ResourceArn is a required field
+	ResourceArn *string `min:"5" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s ListPermissionsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutPolicyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListPermissionsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutPolicyInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListPermissionsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListPermissionsInput"}
-	if s.CertificateAuthorityArn == nil {
-		invalidParams.Add(request.NewErrParamRequired("CertificateAuthorityArn"))
+func (s *PutPolicyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutPolicyInput"}
+	if s.Policy == nil {
+		invalidParams.Add(request.NewErrParamRequired("Policy"))
 	}
-	if s.CertificateAuthorityArn != nil && len(*s.CertificateAuthorityArn) < 5 {
-		invalidParams.Add(request.NewErrParamMinLen("CertificateAuthorityArn", 5))
+	if s.Policy != nil && len(*s.Policy) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Policy", 1))
 	}
-	if s.MaxResults != nil && *s.MaxResults < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+	if s.ResourceArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceArn"))
 	}
-	if s.NextToken != nil && len(*s.NextToken) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	if s.ResourceArn != nil && len(*s.ResourceArn) < 5 {
+		invalidParams.Add(request.NewErrParamMinLen("ResourceArn", 5))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -4052,244 +7664,352 @@ func (s *ListPermissionsInput) Validate() error {
 	return nil
 }
 
-// SetCertificateAuthorityArn sets the CertificateAuthorityArn field's value.
-func (s *ListPermissionsInput) SetCertificateAuthorityArn(v string) *ListPermissionsInput {
-	s.CertificateAuthorityArn = &v
+// SetPolicy sets the Policy field's value.
+func (s *PutPolicyInput) SetPolicy(v string) *PutPolicyInput {
+	s.Policy = &v
 	return s
 }
 
-// SetMaxResults sets the MaxResults field's value.
-func (s *ListPermissionsInput) SetMaxResults(v int64) *ListPermissionsInput {
-	s.MaxResults = &v
+// SetResourceArn sets the ResourceArn field's value.
+func (s *PutPolicyInput) SetResourceArn(v string) *PutPolicyInput {
+	s.ResourceArn = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListPermissionsInput) SetNextToken(v string) *ListPermissionsInput {
-	s.NextToken = &v
-	return s
+type PutPolicyOutput struct {
+	_ struct{} `type:"structure"`
 }
 
-type ListPermissionsOutput struct {
-	_ struct{} `type:"structure"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutPolicyOutput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// When the list is truncated, this value is present and should be used for
-	// the NextToken parameter in a subsequent pagination request.
-	NextToken *string `min:"1" type:"string"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutPolicyOutput) GoString() string {
+	return s.String()
+}
 
-	// Summary information about each permission assigned by the specified private
-	// CA, including the action enabled, the policy provided, and the time of creation.
-	Permissions []*Permission `type:"list"`
+// Defines a PolicyInformation qualifier. Amazon Web Services Private CA supports
+// the certification practice statement (CPS) qualifier (https://datatracker.ietf.org/doc/html/rfc5280#section-4.2.1.4)
+// defined in RFC 5280.
+type Qualifier struct {
+	_ struct{} `type:"structure"`
+
+	// Contains a pointer to a certification practice statement (CPS) published
+	// by the CA.
+	//
+	// CpsUri is a required field
+	CpsUri *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s ListPermissionsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Qualifier) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListPermissionsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Qualifier) GoString() string {
 	return s.String()
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListPermissionsOutput) SetNextToken(v string) *ListPermissionsOutput {
-	s.NextToken = &v
-	return s
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *Qualifier) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "Qualifier"}
+	if s.CpsUri == nil {
+		invalidParams.Add(request.NewErrParamRequired("CpsUri"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetPermissions sets the Permissions field's value.
-func (s *ListPermissionsOutput) SetPermissions(v []*Permission) *ListPermissionsOutput {
-	s.Permissions = v
+// SetCpsUri sets the CpsUri field's value.
+func (s *Qualifier) SetCpsUri(v string) *Qualifier {
+	s.CpsUri = &v
 	return s
 }
 
-type ListTagsInput struct {
-	_ struct{} `type:"structure"`
-
-	// The Amazon Resource Name (ARN) that was returned when you called the CreateCertificateAuthority
-	// action. This must be of the form:
-	//
-	// arn:aws:acm-pca:region:account:certificate-authority/12345678-1234-1234-1234-123456789012
-	//
-	// CertificateAuthorityArn is a required field
-	CertificateAuthorityArn *string `min:"5" type:"string" required:"true"`
-
-	// Use this parameter when paginating results to specify the maximum number
-	// of items to return in the response. If additional items exist beyond the
-	// number you specify, the NextToken element is sent in the response. Use this
-	// NextToken value in a subsequent request to retrieve additional items.
-	MaxResults *int64 `min:"1" type:"integer"`
+// Your request has already been completed.
+type RequestAlreadyProcessedException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// Use this parameter when paginating results in a subsequent request after
-	// you receive a response with truncated results. Set it to the value of NextToken
-	// from the response you just received.
-	NextToken *string `min:"1" type:"string"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s ListTagsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RequestAlreadyProcessedException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListTagsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RequestAlreadyProcessedException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *ListTagsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListTagsInput"}
-	if s.CertificateAuthorityArn == nil {
-		invalidParams.Add(request.NewErrParamRequired("CertificateAuthorityArn"))
-	}
-	if s.CertificateAuthorityArn != nil && len(*s.CertificateAuthorityArn) < 5 {
-		invalidParams.Add(request.NewErrParamMinLen("CertificateAuthorityArn", 5))
+func newErrorRequestAlreadyProcessedException(v protocol.ResponseMetadata) error {
+	return &RequestAlreadyProcessedException{
+		RespMetadata: v,
 	}
-	if s.MaxResults != nil && *s.MaxResults < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+}
+
+// Code returns the exception type name.
+func (s *RequestAlreadyProcessedException) Code() string {
+	return "RequestAlreadyProcessedException"
+}
+
+// Message returns the exception's message.
+func (s *RequestAlreadyProcessedException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
-	if s.NextToken != nil && len(*s.NextToken) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *RequestAlreadyProcessedException) OrigErr() error {
+	return nil
+}
+
+func (s *RequestAlreadyProcessedException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *RequestAlreadyProcessedException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *RequestAlreadyProcessedException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The request has failed for an unspecified reason.
+type RequestFailedException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RequestFailedException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RequestFailedException) GoString() string {
+	return s.String()
+}
+
+func newErrorRequestFailedException(v protocol.ResponseMetadata) error {
+	return &RequestFailedException{
+		RespMetadata: v,
 	}
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
+// Code returns the exception type name.
+func (s *RequestFailedException) Code() string {
+	return "RequestFailedException"
+}
+
+// Message returns the exception's message.
+func (s *RequestFailedException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
-	return nil
+	return ""
 }
 
-// SetCertificateAuthorityArn sets the CertificateAuthorityArn field's value.
-func (s *ListTagsInput) SetCertificateAuthorityArn(v string) *ListTagsInput {
-	s.CertificateAuthorityArn = &v
-	return s
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *RequestFailedException) OrigErr() error {
+	return nil
 }
 
-// SetMaxResults sets the MaxResults field's value.
-func (s *ListTagsInput) SetMaxResults(v int64) *ListTagsInput {
-	s.MaxResults = &v
-	return s
+func (s *RequestFailedException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListTagsInput) SetNextToken(v string) *ListTagsInput {
-	s.NextToken = &v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *RequestFailedException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-type ListTagsOutput struct {
-	_ struct{} `type:"structure"`
+// RequestID returns the service's response RequestID for request.
+func (s *RequestFailedException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// When the list is truncated, this value is present and should be used for
-	// the NextToken parameter in a subsequent pagination request.
-	NextToken *string `min:"1" type:"string"`
+// Your request is already in progress.
+type RequestInProgressException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The tags associated with your private CA.
-	Tags []*Tag `min:"1" type:"list"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s ListTagsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RequestInProgressException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListTagsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RequestInProgressException) GoString() string {
 	return s.String()
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListTagsOutput) SetNextToken(v string) *ListTagsOutput {
-	s.NextToken = &v
-	return s
+func newErrorRequestInProgressException(v protocol.ResponseMetadata) error {
+	return &RequestInProgressException{
+		RespMetadata: v,
+	}
 }
 
-// SetTags sets the Tags field's value.
-func (s *ListTagsOutput) SetTags(v []*Tag) *ListTagsOutput {
-	s.Tags = v
-	return s
+// Code returns the exception type name.
+func (s *RequestInProgressException) Code() string {
+	return "RequestInProgressException"
 }
 
-// Permissions designate which private CA actions can be performed by an AWS
-// service or entity. In order for ACM to automatically renew private certificates,
-// you must give the ACM service principal all available permissions (IssueCertificate,
-// GetCertificate, and ListPermissions). Permissions can be assigned with the
-// CreatePermission action, removed with the DeletePermission action, and listed
-// with the ListPermissions action.
-type Permission struct {
-	_ struct{} `type:"structure"`
+// Message returns the exception's message.
+func (s *RequestInProgressException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
 
-	// The private CA actions that can be performed by the designated AWS service.
-	Actions []*string `min:"1" type:"list"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *RequestInProgressException) OrigErr() error {
+	return nil
+}
 
-	// The Amazon Resource Number (ARN) of the private CA from which the permission
-	// was issued.
-	CertificateAuthorityArn *string `min:"5" type:"string"`
+func (s *RequestInProgressException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
 
-	// The time at which the permission was created.
-	CreatedAt *time.Time `type:"timestamp"`
+// Status code returns the HTTP status code for the request's response error.
+func (s *RequestInProgressException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
 
-	// The name of the policy that is associated with the permission.
-	Policy *string `type:"string"`
+// RequestID returns the service's response RequestID for request.
+func (s *RequestInProgressException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// The AWS service or entity that holds the permission. At this time, the only
-	// valid principal is acm.amazonaws.com.
-	Principal *string `type:"string"`
+// A resource such as a private CA, S3 bucket, certificate, audit report, or
+// policy cannot be found.
+type ResourceNotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The ID of the account that assigned the permission.
-	SourceAccount *string `type:"string"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s Permission) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceNotFoundException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Permission) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceNotFoundException) GoString() string {
 	return s.String()
 }
 
-// SetActions sets the Actions field's value.
-func (s *Permission) SetActions(v []*string) *Permission {
-	s.Actions = v
-	return s
+func newErrorResourceNotFoundException(v protocol.ResponseMetadata) error {
+	return &ResourceNotFoundException{
+		RespMetadata: v,
+	}
 }
 
-// SetCertificateAuthorityArn sets the CertificateAuthorityArn field's value.
-func (s *Permission) SetCertificateAuthorityArn(v string) *Permission {
-	s.CertificateAuthorityArn = &v
-	return s
+// Code returns the exception type name.
+func (s *ResourceNotFoundException) Code() string {
+	return "ResourceNotFoundException"
 }
 
-// SetCreatedAt sets the CreatedAt field's value.
-func (s *Permission) SetCreatedAt(v time.Time) *Permission {
-	s.CreatedAt = &v
-	return s
+// Message returns the exception's message.
+func (s *ResourceNotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// SetPolicy sets the Policy field's value.
-func (s *Permission) SetPolicy(v string) *Permission {
-	s.Policy = &v
-	return s
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ResourceNotFoundException) OrigErr() error {
+	return nil
 }
 
-// SetPrincipal sets the Principal field's value.
-func (s *Permission) SetPrincipal(v string) *Permission {
-	s.Principal = &v
-	return s
+func (s *ResourceNotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// SetSourceAccount sets the SourceAccount field's value.
-func (s *Permission) SetSourceAccount(v string) *Permission {
-	s.SourceAccount = &v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *ResourceNotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ResourceNotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
 type RestoreCertificateAuthorityInput struct {
 	_ struct{} `type:"structure"`
 
 	// The Amazon Resource Name (ARN) that was returned when you called the CreateCertificateAuthority
+	// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreateCertificateAuthority.html)
 	// action. This must be of the form:
 	//
 	// arn:aws:acm-pca:region:account:certificate-authority/12345678-1234-1234-1234-123456789012
@@ -4298,12 +8018,20 @@ type RestoreCertificateAuthorityInput struct {
 	CertificateAuthorityArn *string `min:"5" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RestoreCertificateAuthorityInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RestoreCertificateAuthorityInput) GoString() string {
 	return s.String()
 }
@@ -4334,35 +8062,63 @@ type RestoreCertificateAuthorityOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RestoreCertificateAuthorityOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RestoreCertificateAuthorityOutput) GoString() string {
 	return s.String()
 }
 
 // Certificate revocation information used by the CreateCertificateAuthority
-// and UpdateCertificateAuthority actions. Your private certificate authority
-// (CA) can create and maintain a certificate revocation list (CRL). A CRL contains
-// information about certificates revoked by your CA. For more information,
-// see RevokeCertificate.
+// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreateCertificateAuthority.html)
+// and UpdateCertificateAuthority (https://docs.aws.amazon.com/privateca/latest/APIReference/API_UpdateCertificateAuthority.html)
+// actions. Your private certificate authority (CA) can configure Online Certificate
+// Status Protocol (OCSP) support and/or maintain a certificate revocation list
+// (CRL). OCSP returns validation information about certificates as requested
+// by clients, and a CRL contains an updated list of certificates revoked by
+// your CA. For more information, see RevokeCertificate (https://docs.aws.amazon.com/privateca/latest/APIReference/API_RevokeCertificate.html)
+// and Setting up a certificate revocation method (https://docs.aws.amazon.com/privateca/latest/userguide/revocation-setup.html)
+// in the Amazon Web Services Private Certificate Authority User Guide.
 type RevocationConfiguration struct {
 	_ struct{} `type:"structure"`
 
 	// Configuration of the certificate revocation list (CRL), if any, maintained
-	// by your private CA.
+	// by your private CA. A CRL is typically updated approximately 30 minutes after
+	// a certificate is revoked. If for any reason a CRL update fails, Amazon Web
+	// Services Private CA makes further attempts every 15 minutes.
 	CrlConfiguration *CrlConfiguration `type:"structure"`
+
+	// Configuration of Online Certificate Status Protocol (OCSP) support, if any,
+	// maintained by your private CA. When you revoke a certificate, OCSP responses
+	// may take up to 60 minutes to reflect the new status.
+	OcspConfiguration *OcspConfiguration `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RevocationConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RevocationConfiguration) GoString() string {
 	return s.String()
 }
@@ -4375,6 +8131,11 @@ func (s *RevocationConfiguration) Validate() error {
 			invalidParams.AddNested("CrlConfiguration", err.(request.ErrInvalidParams))
 		}
 	}
+	if s.OcspConfiguration != nil {
+		if err := s.OcspConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("OcspConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -4388,6 +8149,12 @@ func (s *RevocationConfiguration) SetCrlConfiguration(v *CrlConfiguration) *Revo
 	return s
 }
 
+// SetOcspConfiguration sets the OcspConfiguration field's value.
+func (s *RevocationConfiguration) SetOcspConfiguration(v *OcspConfiguration) *RevocationConfiguration {
+	s.OcspConfiguration = v
+	return s
+}
+
 type RevokeCertificateInput struct {
 	_ struct{} `type:"structure"`
 
@@ -4400,17 +8167,17 @@ type RevokeCertificateInput struct {
 	CertificateAuthorityArn *string `min:"5" type:"string" required:"true"`
 
 	// Serial number of the certificate to be revoked. This must be in hexadecimal
-	// format. You can retrieve the serial number by calling GetCertificate with
-	// the Amazon Resource Name (ARN) of the certificate you want and the ARN of
-	// your private CA. The GetCertificate action retrieves the certificate in the
-	// PEM format. You can use the following OpenSSL command to list the certificate
+	// format. You can retrieve the serial number by calling GetCertificate (https://docs.aws.amazon.com/privateca/latest/APIReference/API_GetCertificate.html)
+	// with the Amazon Resource Name (ARN) of the certificate you want and the ARN
+	// of your private CA. The GetCertificate action retrieves the certificate in
+	// the PEM format. You can use the following OpenSSL command to list the certificate
 	// in text format and copy the hexadecimal serial number.
 	//
 	// openssl x509 -in file_path -text -noout
 	//
 	// You can also copy the serial number from the console or use the DescribeCertificate
 	// (https://docs.aws.amazon.com/acm/latest/APIReference/API_DescribeCertificate.html)
-	// action in the AWS Certificate Manager API Reference.
+	// action in the Certificate Manager API Reference.
 	//
 	// CertificateSerial is a required field
 	CertificateSerial *string `type:"string" required:"true"`
@@ -4421,12 +8188,20 @@ type RevokeCertificateInput struct {
 	RevocationReason *string `type:"string" required:"true" enum:"RevocationReason"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RevokeCertificateInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RevokeCertificateInput) GoString() string {
 	return s.String()
 }
@@ -4475,12 +8250,20 @@ type RevokeCertificateOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RevokeCertificateOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RevokeCertificateOutput) GoString() string {
 	return s.String()
 }
@@ -4488,7 +8271,8 @@ func (s RevokeCertificateOutput) GoString() string {
 // Tags are labels that you can use to identify and organize your private CAs.
 // Each tag consists of a key and an optional value. You can associate up to
 // 50 tags with a private CA. To add one or more tags to a private CA, call
-// the TagCertificateAuthority action. To remove a tag, call the UntagCertificateAuthority
+// the TagCertificateAuthority (https://docs.aws.amazon.com/privateca/latest/APIReference/API_TagCertificateAuthority.html)
+// action. To remove a tag, call the UntagCertificateAuthority (https://docs.aws.amazon.com/privateca/latest/APIReference/API_UntagCertificateAuthority.html)
 // action.
 type Tag struct {
 	_ struct{} `type:"structure"`
@@ -4502,12 +8286,20 @@ type Tag struct {
 	Value *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) GoString() string {
 	return s.String()
 }
@@ -4543,7 +8335,8 @@ func (s *Tag) SetValue(v string) *Tag {
 type TagCertificateAuthorityInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) that was returned when you called CreateCertificateAuthority.
+	// The Amazon Resource Name (ARN) that was returned when you called CreateCertificateAuthority
+	// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreateCertificateAuthority.html).
 	// This must be of the form:
 	//
 	// arn:aws:acm-pca:region:account:certificate-authority/12345678-1234-1234-1234-123456789012
@@ -4557,12 +8350,20 @@ type TagCertificateAuthorityInput struct {
 	Tags []*Tag `min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagCertificateAuthorityInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagCertificateAuthorityInput) GoString() string {
 	return s.String()
 }
@@ -4615,20 +8416,94 @@ type TagCertificateAuthorityOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagCertificateAuthorityOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagCertificateAuthorityOutput) GoString() string {
 	return s.String()
 }
 
+// You can associate up to 50 tags with a private CA. Exception information
+// is contained in the exception message field.
+type TooManyTagsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyTagsException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyTagsException) GoString() string {
+	return s.String()
+}
+
+func newErrorTooManyTagsException(v protocol.ResponseMetadata) error {
+	return &TooManyTagsException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *TooManyTagsException) Code() string {
+	return "TooManyTagsException"
+}
+
+// Message returns the exception's message.
+func (s *TooManyTagsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *TooManyTagsException) OrigErr() error {
+	return nil
+}
+
+func (s *TooManyTagsException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *TooManyTagsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *TooManyTagsException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 type UntagCertificateAuthorityInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) that was returned when you called CreateCertificateAuthority.
+	// The Amazon Resource Name (ARN) that was returned when you called CreateCertificateAuthority
+	// (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CreateCertificateAuthority.html).
 	// This must be of the form:
 	//
 	// arn:aws:acm-pca:region:account:certificate-authority/12345678-1234-1234-1234-123456789012
@@ -4642,12 +8517,20 @@ type UntagCertificateAuthorityInput struct {
 	Tags []*Tag `min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagCertificateAuthorityInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagCertificateAuthorityInput) GoString() string {
 	return s.String()
 }
@@ -4700,12 +8583,20 @@ type UntagCertificateAuthorityOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagCertificateAuthorityOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagCertificateAuthorityOutput) GoString() string {
 	return s.String()
 }
@@ -4721,19 +8612,48 @@ type UpdateCertificateAuthorityInput struct {
 	// CertificateAuthorityArn is a required field
 	CertificateAuthorityArn *string `min:"5" type:"string" required:"true"`
 
-	// Revocation information for your private CA.
+	// Contains information to enable Online Certificate Status Protocol (OCSP)
+	// support, to enable a certificate revocation list (CRL), to enable both, or
+	// to enable neither. If this parameter is not supplied, existing capibilites
+	// remain unchanged. For more information, see the OcspConfiguration (https://docs.aws.amazon.com/privateca/latest/APIReference/API_OcspConfiguration.html)
+	// and CrlConfiguration (https://docs.aws.amazon.com/privateca/latest/APIReference/API_CrlConfiguration.html)
+	// types.
+	//
+	// The following requirements apply to revocation configurations.
+	//
+	//    * A configuration disabling CRLs or OCSP must contain only the Enabled=False
+	//    parameter, and will fail if other parameters such as CustomCname or ExpirationInDays
+	//    are included.
+	//
+	//    * In a CRL configuration, the S3BucketName parameter must conform to Amazon
+	//    S3 bucket naming rules (https://docs.aws.amazon.com/AmazonS3/latest/userguide/bucketnamingrules.html).
+	//
+	//    * A configuration containing a custom Canonical Name (CNAME) parameter
+	//    for CRLs or OCSP must conform to RFC2396 (https://www.ietf.org/rfc/rfc2396.txt)
+	//    restrictions on the use of special characters in a CNAME.
+	//
+	//    * In a CRL or OCSP configuration, the value of a CNAME parameter must
+	//    not include a protocol prefix such as "http://" or "https://".
 	RevocationConfiguration *RevocationConfiguration `type:"structure"`
 
 	// Status of your private CA.
 	Status *string `type:"string" enum:"CertificateAuthorityStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateCertificateAuthorityInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateCertificateAuthorityInput) GoString() string {
 	return s.String()
 }
@@ -4781,39 +8701,95 @@ type UpdateCertificateAuthorityOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateCertificateAuthorityOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateCertificateAuthorityOutput) GoString() string {
 	return s.String()
 }
 
-// Length of time for which the certificate issued by your private certificate
-// authority (CA), or by the private CA itself, is valid in days, months, or
-// years. You can issue a certificate by calling the IssueCertificate action.
+// Validity specifies the period of time during which a certificate is valid.
+// Validity can be expressed as an explicit date and time when the validity
+// of a certificate starts or expires, or as a span of time after issuance,
+// stated in days, months, or years. For more information, see Validity (https://tools.ietf.org/html/rfc5280#section-4.1.2.5)
+// in RFC 5280.
+//
+// Amazon Web Services Private CA API consumes the Validity data type differently
+// in two distinct parameters of the IssueCertificate action. The required parameter
+// IssueCertificate:Validity specifies the end of a certificate's validity period.
+// The optional parameter IssueCertificate:ValidityNotBefore specifies a customized
+// starting time for the validity period.
 type Validity struct {
 	_ struct{} `type:"structure"`
 
-	// Specifies whether the Value parameter represents days, months, or years.
+	// Determines how Amazon Web Services Private CA interprets the Value parameter,
+	// an integer. Supported validity types include those listed below. Type definitions
+	// with values include a sample input value and the resulting output.
+	//
+	// END_DATE: The specific date and time when the certificate will expire, expressed
+	// using UTCTime (YYMMDDHHMMSS) or GeneralizedTime (YYYYMMDDHHMMSS) format.
+	// When UTCTime is used, if the year field (YY) is greater than or equal to
+	// 50, the year is interpreted as 19YY. If the year field is less than 50, the
+	// year is interpreted as 20YY.
+	//
+	//    * Sample input value: 491231235959 (UTCTime format)
+	//
+	//    * Output expiration date/time: 12/31/2049 23:59:59
+	//
+	// ABSOLUTE: The specific date and time when the validity of a certificate will
+	// start or expire, expressed in seconds since the Unix Epoch.
+	//
+	//    * Sample input value: 2524608000
+	//
+	//    * Output expiration date/time: 01/01/2050 00:00:00
+	//
+	// DAYS, MONTHS, YEARS: The relative time from the moment of issuance until
+	// the certificate will expire, expressed in days, months, or years.
+	//
+	// Example if DAYS, issued on 10/12/2020 at 12:34:54 UTC:
+	//
+	//    * Sample input value: 90
+	//
+	//    * Output expiration date: 01/10/2020 12:34:54 UTC
+	//
+	// The minimum validity duration for a certificate using relative time (DAYS)
+	// is one day. The minimum validity for a certificate using absolute time (ABSOLUTE
+	// or END_DATE) is one second.
 	//
 	// Type is a required field
 	Type *string `type:"string" required:"true" enum:"ValidityPeriodType"`
 
-	// Time period.
+	// A long integer interpreted according to the value of Type, below.
 	//
 	// Value is a required field
 	Value *int64 `min:"1" type:"long" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Validity) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Validity) GoString() string {
 	return s.String()
 }
@@ -4849,6 +8825,26 @@ func (s *Validity) SetValue(v int64) *Validity {
 	return s
 }
 
+const (
+	// AccessMethodTypeCaRepository is a AccessMethodType enum value
+	AccessMethodTypeCaRepository = "CA_REPOSITORY"
+
+	// AccessMethodTypeResourcePkiManifest is a AccessMethodType enum value
+	AccessMethodTypeResourcePkiManifest = "RESOURCE_PKI_MANIFEST"
+
+	// AccessMethodTypeResourcePkiNotify is a AccessMethodType enum value
+	AccessMethodTypeResourcePkiNotify = "RESOURCE_PKI_NOTIFY"
+)
+
+// AccessMethodType_Values returns all elements of the AccessMethodType enum
+func AccessMethodType_Values() []string {
+	return []string{
+		AccessMethodTypeCaRepository,
+		AccessMethodTypeResourcePkiManifest,
+		AccessMethodTypeResourcePkiNotify,
+	}
+}
+
 const (
 	// ActionTypeIssueCertificate is a ActionType enum value
 	ActionTypeIssueCertificate = "IssueCertificate"
@@ -4860,6 +8856,15 @@ const (
 	ActionTypeListPermissions = "ListPermissions"
 )
 
+// ActionType_Values returns all elements of the ActionType enum
+func ActionType_Values() []string {
+	return []string{
+		ActionTypeIssueCertificate,
+		ActionTypeGetCertificate,
+		ActionTypeListPermissions,
+	}
+}
+
 const (
 	// AuditReportResponseFormatJson is a AuditReportResponseFormat enum value
 	AuditReportResponseFormatJson = "JSON"
@@ -4868,6 +8873,14 @@ const (
 	AuditReportResponseFormatCsv = "CSV"
 )
 
+// AuditReportResponseFormat_Values returns all elements of the AuditReportResponseFormat enum
+func AuditReportResponseFormat_Values() []string {
+	return []string{
+		AuditReportResponseFormatJson,
+		AuditReportResponseFormatCsv,
+	}
+}
+
 const (
 	// AuditReportStatusCreating is a AuditReportStatus enum value
 	AuditReportStatusCreating = "CREATING"
@@ -4879,6 +8892,15 @@ const (
 	AuditReportStatusFailed = "FAILED"
 )
 
+// AuditReportStatus_Values returns all elements of the AuditReportStatus enum
+func AuditReportStatus_Values() []string {
+	return []string{
+		AuditReportStatusCreating,
+		AuditReportStatusSuccess,
+		AuditReportStatusFailed,
+	}
+}
+
 const (
 	// CertificateAuthorityStatusCreating is a CertificateAuthorityStatus enum value
 	CertificateAuthorityStatusCreating = "CREATING"
@@ -4902,6 +8924,19 @@ const (
 	CertificateAuthorityStatusFailed = "FAILED"
 )
 
+// CertificateAuthorityStatus_Values returns all elements of the CertificateAuthorityStatus enum
+func CertificateAuthorityStatus_Values() []string {
+	return []string{
+		CertificateAuthorityStatusCreating,
+		CertificateAuthorityStatusPendingCertificate,
+		CertificateAuthorityStatusActive,
+		CertificateAuthorityStatusDeleted,
+		CertificateAuthorityStatusDisabled,
+		CertificateAuthorityStatusExpired,
+		CertificateAuthorityStatusFailed,
+	}
+}
+
 const (
 	// CertificateAuthorityTypeRoot is a CertificateAuthorityType enum value
 	CertificateAuthorityTypeRoot = "ROOT"
@@ -4910,6 +8945,74 @@ const (
 	CertificateAuthorityTypeSubordinate = "SUBORDINATE"
 )
 
+// CertificateAuthorityType_Values returns all elements of the CertificateAuthorityType enum
+func CertificateAuthorityType_Values() []string {
+	return []string{
+		CertificateAuthorityTypeRoot,
+		CertificateAuthorityTypeSubordinate,
+	}
+}
+
+const (
+	// CertificateAuthorityUsageModeGeneralPurpose is a CertificateAuthorityUsageMode enum value
+	CertificateAuthorityUsageModeGeneralPurpose = "GENERAL_PURPOSE"
+
+	// CertificateAuthorityUsageModeShortLivedCertificate is a CertificateAuthorityUsageMode enum value
+	CertificateAuthorityUsageModeShortLivedCertificate = "SHORT_LIVED_CERTIFICATE"
+)
+
+// CertificateAuthorityUsageMode_Values returns all elements of the CertificateAuthorityUsageMode enum
+func CertificateAuthorityUsageMode_Values() []string {
+	return []string{
+		CertificateAuthorityUsageModeGeneralPurpose,
+		CertificateAuthorityUsageModeShortLivedCertificate,
+	}
+}
+
+const (
+	// ExtendedKeyUsageTypeServerAuth is a ExtendedKeyUsageType enum value
+	ExtendedKeyUsageTypeServerAuth = "SERVER_AUTH"
+
+	// ExtendedKeyUsageTypeClientAuth is a ExtendedKeyUsageType enum value
+	ExtendedKeyUsageTypeClientAuth = "CLIENT_AUTH"
+
+	// ExtendedKeyUsageTypeCodeSigning is a ExtendedKeyUsageType enum value
+	ExtendedKeyUsageTypeCodeSigning = "CODE_SIGNING"
+
+	// ExtendedKeyUsageTypeEmailProtection is a ExtendedKeyUsageType enum value
+	ExtendedKeyUsageTypeEmailProtection = "EMAIL_PROTECTION"
+
+	// ExtendedKeyUsageTypeTimeStamping is a ExtendedKeyUsageType enum value
+	ExtendedKeyUsageTypeTimeStamping = "TIME_STAMPING"
+
+	// ExtendedKeyUsageTypeOcspSigning is a ExtendedKeyUsageType enum value
+	ExtendedKeyUsageTypeOcspSigning = "OCSP_SIGNING"
+
+	// ExtendedKeyUsageTypeSmartCardLogin is a ExtendedKeyUsageType enum value
+	ExtendedKeyUsageTypeSmartCardLogin = "SMART_CARD_LOGIN"
+
+	// ExtendedKeyUsageTypeDocumentSigning is a ExtendedKeyUsageType enum value
+	ExtendedKeyUsageTypeDocumentSigning = "DOCUMENT_SIGNING"
+
+	// ExtendedKeyUsageTypeCertificateTransparency is a ExtendedKeyUsageType enum value
+	ExtendedKeyUsageTypeCertificateTransparency = "CERTIFICATE_TRANSPARENCY"
+)
+
+// ExtendedKeyUsageType_Values returns all elements of the ExtendedKeyUsageType enum
+func ExtendedKeyUsageType_Values() []string {
+	return []string{
+		ExtendedKeyUsageTypeServerAuth,
+		ExtendedKeyUsageTypeClientAuth,
+		ExtendedKeyUsageTypeCodeSigning,
+		ExtendedKeyUsageTypeEmailProtection,
+		ExtendedKeyUsageTypeTimeStamping,
+		ExtendedKeyUsageTypeOcspSigning,
+		ExtendedKeyUsageTypeSmartCardLogin,
+		ExtendedKeyUsageTypeDocumentSigning,
+		ExtendedKeyUsageTypeCertificateTransparency,
+	}
+}
+
 const (
 	// FailureReasonRequestTimedOut is a FailureReason enum value
 	FailureReasonRequestTimedOut = "REQUEST_TIMED_OUT"
@@ -4921,6 +9024,15 @@ const (
 	FailureReasonOther = "OTHER"
 )
 
+// FailureReason_Values returns all elements of the FailureReason enum
+func FailureReason_Values() []string {
+	return []string{
+		FailureReasonRequestTimedOut,
+		FailureReasonUnsupportedAlgorithm,
+		FailureReasonOther,
+	}
+}
+
 const (
 	// KeyAlgorithmRsa2048 is a KeyAlgorithm enum value
 	KeyAlgorithmRsa2048 = "RSA_2048"
@@ -4935,6 +9047,60 @@ const (
 	KeyAlgorithmEcSecp384r1 = "EC_secp384r1"
 )
 
+// KeyAlgorithm_Values returns all elements of the KeyAlgorithm enum
+func KeyAlgorithm_Values() []string {
+	return []string{
+		KeyAlgorithmRsa2048,
+		KeyAlgorithmRsa4096,
+		KeyAlgorithmEcPrime256v1,
+		KeyAlgorithmEcSecp384r1,
+	}
+}
+
+const (
+	// KeyStorageSecurityStandardFips1402Level2OrHigher is a KeyStorageSecurityStandard enum value
+	KeyStorageSecurityStandardFips1402Level2OrHigher = "FIPS_140_2_LEVEL_2_OR_HIGHER"
+
+	// KeyStorageSecurityStandardFips1402Level3OrHigher is a KeyStorageSecurityStandard enum value
+	KeyStorageSecurityStandardFips1402Level3OrHigher = "FIPS_140_2_LEVEL_3_OR_HIGHER"
+)
+
+// KeyStorageSecurityStandard_Values returns all elements of the KeyStorageSecurityStandard enum
+func KeyStorageSecurityStandard_Values() []string {
+	return []string{
+		KeyStorageSecurityStandardFips1402Level2OrHigher,
+		KeyStorageSecurityStandardFips1402Level3OrHigher,
+	}
+}
+
+const (
+	// PolicyQualifierIdCps is a PolicyQualifierId enum value
+	PolicyQualifierIdCps = "CPS"
+)
+
+// PolicyQualifierId_Values returns all elements of the PolicyQualifierId enum
+func PolicyQualifierId_Values() []string {
+	return []string{
+		PolicyQualifierIdCps,
+	}
+}
+
+const (
+	// ResourceOwnerSelf is a ResourceOwner enum value
+	ResourceOwnerSelf = "SELF"
+
+	// ResourceOwnerOtherAccounts is a ResourceOwner enum value
+	ResourceOwnerOtherAccounts = "OTHER_ACCOUNTS"
+)
+
+// ResourceOwner_Values returns all elements of the ResourceOwner enum
+func ResourceOwner_Values() []string {
+	return []string{
+		ResourceOwnerSelf,
+		ResourceOwnerOtherAccounts,
+	}
+}
+
 const (
 	// RevocationReasonUnspecified is a RevocationReason enum value
 	RevocationReasonUnspecified = "UNSPECIFIED"
@@ -4961,6 +9127,36 @@ const (
 	RevocationReasonAACompromise = "A_A_COMPROMISE"
 )
 
+// RevocationReason_Values returns all elements of the RevocationReason enum
+func RevocationReason_Values() []string {
+	return []string{
+		RevocationReasonUnspecified,
+		RevocationReasonKeyCompromise,
+		RevocationReasonCertificateAuthorityCompromise,
+		RevocationReasonAffiliationChanged,
+		RevocationReasonSuperseded,
+		RevocationReasonCessationOfOperation,
+		RevocationReasonPrivilegeWithdrawn,
+		RevocationReasonAACompromise,
+	}
+}
+
+const (
+	// S3ObjectAclPublicRead is a S3ObjectAcl enum value
+	S3ObjectAclPublicRead = "PUBLIC_READ"
+
+	// S3ObjectAclBucketOwnerFullControl is a S3ObjectAcl enum value
+	S3ObjectAclBucketOwnerFullControl = "BUCKET_OWNER_FULL_CONTROL"
+)
+
+// S3ObjectAcl_Values returns all elements of the S3ObjectAcl enum
+func S3ObjectAcl_Values() []string {
+	return []string{
+		S3ObjectAclPublicRead,
+		S3ObjectAclBucketOwnerFullControl,
+	}
+}
+
 const (
 	// SigningAlgorithmSha256withecdsa is a SigningAlgorithm enum value
 	SigningAlgorithmSha256withecdsa = "SHA256WITHECDSA"
@@ -4981,6 +9177,18 @@ const (
 	SigningAlgorithmSha512withrsa = "SHA512WITHRSA"
 )
 
+// SigningAlgorithm_Values returns all elements of the SigningAlgorithm enum
+func SigningAlgorithm_Values() []string {
+	return []string{
+		SigningAlgorithmSha256withecdsa,
+		SigningAlgorithmSha384withecdsa,
+		SigningAlgorithmSha512withecdsa,
+		SigningAlgorithmSha256withrsa,
+		SigningAlgorithmSha384withrsa,
+		SigningAlgorithmSha512withrsa,
+	}
+}
+
 const (
 	// ValidityPeriodTypeEndDate is a ValidityPeriodType enum value
 	ValidityPeriodTypeEndDate = "END_DATE"
@@ -4997,3 +9205,14 @@ const (
 	// ValidityPeriodTypeYears is a ValidityPeriodType enum value
 	ValidityPeriodTypeYears = "YEARS"
 )
+
+// ValidityPeriodType_Values returns all elements of the ValidityPeriodType enum
+func ValidityPeriodType_Values() []string {
+	return []string{
+		ValidityPeriodTypeEndDate,
+		ValidityPeriodTypeAbsolute,
+		ValidityPeriodTypeDays,
+		ValidityPeriodTypeMonths,
+		ValidityPeriodTypeYears,
+	}
+}
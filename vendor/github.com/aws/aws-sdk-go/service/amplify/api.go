@@ -29,14 +29,13 @@ const opCreateApp = "CreateApp"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateAppRequest method.
+//	req, resp := client.CreateAppRequest(params)
 //
-//    // Example sending a request using the CreateAppRequest method.
-//    req, resp := client.CreateAppRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/CreateApp
 func (c *Amplify) CreateAppRequest(input *CreateAppInput) (req *request.Request, output *CreateAppOutput) {
@@ -57,7 +56,7 @@ func (c *Amplify) CreateAppRequest(input *CreateAppInput) (req *request.Request,
 
 // CreateApp API operation for AWS Amplify.
 //
-// Creates a new Amplify App.
+// Creates a new Amplify app.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -66,24 +65,22 @@ func (c *Amplify) CreateAppRequest(input *CreateAppInput) (req *request.Request,
 // See the AWS API reference guide for AWS Amplify's
 // API operation CreateApp for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
+//
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   Exception thrown when a resource could not be created because of service
-//   limits.
+//   - LimitExceededException
+//     A resource could not be created because service quotas were exceeded.
 //
-//   * ErrCodeDependentServiceFailureException "DependentServiceFailureException"
-//   Exception thrown when an operation fails due to a dependent service throwing
-//   an exception.
+//   - DependentServiceFailureException
+//     An operation failed because a dependent service threw an exception.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/CreateApp
 func (c *Amplify) CreateApp(input *CreateAppInput) (*CreateAppOutput, error) {
@@ -107,6 +104,97 @@ func (c *Amplify) CreateAppWithContext(ctx aws.Context, input *CreateAppInput, o
 	return out, req.Send()
 }
 
+const opCreateBackendEnvironment = "CreateBackendEnvironment"
+
+// CreateBackendEnvironmentRequest generates a "aws/request.Request" representing the
+// client's request for the CreateBackendEnvironment operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See CreateBackendEnvironment for more information on using the CreateBackendEnvironment
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the CreateBackendEnvironmentRequest method.
+//	req, resp := client.CreateBackendEnvironmentRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/CreateBackendEnvironment
+func (c *Amplify) CreateBackendEnvironmentRequest(input *CreateBackendEnvironmentInput) (req *request.Request, output *CreateBackendEnvironmentOutput) {
+	op := &request.Operation{
+		Name:       opCreateBackendEnvironment,
+		HTTPMethod: "POST",
+		HTTPPath:   "/apps/{appId}/backendenvironments",
+	}
+
+	if input == nil {
+		input = &CreateBackendEnvironmentInput{}
+	}
+
+	output = &CreateBackendEnvironmentOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// CreateBackendEnvironment API operation for AWS Amplify.
+//
+// Creates a new backend environment for an Amplify app.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Amplify's
+// API operation CreateBackendEnvironment for usage and error information.
+//
+// Returned Error Types:
+//
+//   - BadRequestException
+//     A request contains unexpected data.
+//
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
+//
+//   - NotFoundException
+//     An entity was not found during an operation.
+//
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
+//
+//   - LimitExceededException
+//     A resource could not be created because service quotas were exceeded.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/CreateBackendEnvironment
+func (c *Amplify) CreateBackendEnvironment(input *CreateBackendEnvironmentInput) (*CreateBackendEnvironmentOutput, error) {
+	req, out := c.CreateBackendEnvironmentRequest(input)
+	return out, req.Send()
+}
+
+// CreateBackendEnvironmentWithContext is the same as CreateBackendEnvironment with the addition of
+// the ability to pass a context and additional request options.
+//
+// See CreateBackendEnvironment for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Amplify) CreateBackendEnvironmentWithContext(ctx aws.Context, input *CreateBackendEnvironmentInput, opts ...request.Option) (*CreateBackendEnvironmentOutput, error) {
+	req, out := c.CreateBackendEnvironmentRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opCreateBranch = "CreateBranch"
 
 // CreateBranchRequest generates a "aws/request.Request" representing the
@@ -123,14 +211,13 @@ const opCreateBranch = "CreateBranch"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateBranchRequest method.
+//	req, resp := client.CreateBranchRequest(params)
 //
-//    // Example sending a request using the CreateBranchRequest method.
-//    req, resp := client.CreateBranchRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/CreateBranch
 func (c *Amplify) CreateBranchRequest(input *CreateBranchInput) (req *request.Request, output *CreateBranchOutput) {
@@ -151,7 +238,7 @@ func (c *Amplify) CreateBranchRequest(input *CreateBranchInput) (req *request.Re
 
 // CreateBranch API operation for AWS Amplify.
 //
-// Creates a new Branch for an Amplify App.
+// Creates a new branch for an Amplify app.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -160,27 +247,25 @@ func (c *Amplify) CreateBranchRequest(input *CreateBranchInput) (req *request.Re
 // See the AWS API reference guide for AWS Amplify's
 // API operation CreateBranch for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
+//
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Exception thrown when an entity has not been found during an operation.
+//   - NotFoundException
+//     An entity was not found during an operation.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   Exception thrown when a resource could not be created because of service
-//   limits.
+//   - LimitExceededException
+//     A resource could not be created because service quotas were exceeded.
 //
-//   * ErrCodeDependentServiceFailureException "DependentServiceFailureException"
-//   Exception thrown when an operation fails due to a dependent service throwing
-//   an exception.
+//   - DependentServiceFailureException
+//     An operation failed because a dependent service threw an exception.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/CreateBranch
 func (c *Amplify) CreateBranch(input *CreateBranchInput) (*CreateBranchOutput, error) {
@@ -220,14 +305,13 @@ const opCreateDeployment = "CreateDeployment"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateDeploymentRequest method.
+//	req, resp := client.CreateDeploymentRequest(params)
 //
-//    // Example sending a request using the CreateDeploymentRequest method.
-//    req, resp := client.CreateDeploymentRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/CreateDeployment
 func (c *Amplify) CreateDeploymentRequest(input *CreateDeploymentInput) (req *request.Request, output *CreateDeploymentOutput) {
@@ -248,7 +332,8 @@ func (c *Amplify) CreateDeploymentRequest(input *CreateDeploymentInput) (req *re
 
 // CreateDeployment API operation for AWS Amplify.
 //
-// Create a deployment for manual deploy apps. (Apps are not connected to repository)
+// Creates a deployment for a manually deployed Amplify app. Manually deployed
+// apps are not connected to a repository.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -257,20 +342,19 @@ func (c *Amplify) CreateDeploymentRequest(input *CreateDeploymentInput) (req *re
 // See the AWS API reference guide for AWS Amplify's
 // API operation CreateDeployment for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
+//
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   Exception thrown when a resource could not be created because of service
-//   limits.
+//   - LimitExceededException
+//     A resource could not be created because service quotas were exceeded.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/CreateDeployment
 func (c *Amplify) CreateDeployment(input *CreateDeploymentInput) (*CreateDeploymentOutput, error) {
@@ -310,14 +394,13 @@ const opCreateDomainAssociation = "CreateDomainAssociation"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateDomainAssociationRequest method.
+//	req, resp := client.CreateDomainAssociationRequest(params)
 //
-//    // Example sending a request using the CreateDomainAssociationRequest method.
-//    req, resp := client.CreateDomainAssociationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/CreateDomainAssociation
 func (c *Amplify) CreateDomainAssociationRequest(input *CreateDomainAssociationInput) (req *request.Request, output *CreateDomainAssociationOutput) {
@@ -338,7 +421,8 @@ func (c *Amplify) CreateDomainAssociationRequest(input *CreateDomainAssociationI
 
 // CreateDomainAssociation API operation for AWS Amplify.
 //
-// Create a new DomainAssociation on an App
+// Creates a new domain association for an Amplify app. This action associates
+// a custom domain with the Amplify app
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -347,27 +431,25 @@ func (c *Amplify) CreateDomainAssociationRequest(input *CreateDomainAssociationI
 // See the AWS API reference guide for AWS Amplify's
 // API operation CreateDomainAssociation for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
+//
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Exception thrown when an entity has not been found during an operation.
+//   - NotFoundException
+//     An entity was not found during an operation.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   Exception thrown when a resource could not be created because of service
-//   limits.
+//   - LimitExceededException
+//     A resource could not be created because service quotas were exceeded.
 //
-//   * ErrCodeDependentServiceFailureException "DependentServiceFailureException"
-//   Exception thrown when an operation fails due to a dependent service throwing
-//   an exception.
+//   - DependentServiceFailureException
+//     An operation failed because a dependent service threw an exception.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/CreateDomainAssociation
 func (c *Amplify) CreateDomainAssociation(input *CreateDomainAssociationInput) (*CreateDomainAssociationOutput, error) {
@@ -407,14 +489,13 @@ const opCreateWebhook = "CreateWebhook"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateWebhookRequest method.
+//	req, resp := client.CreateWebhookRequest(params)
 //
-//    // Example sending a request using the CreateWebhookRequest method.
-//    req, resp := client.CreateWebhookRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/CreateWebhook
 func (c *Amplify) CreateWebhookRequest(input *CreateWebhookInput) (req *request.Request, output *CreateWebhookOutput) {
@@ -435,7 +516,7 @@ func (c *Amplify) CreateWebhookRequest(input *CreateWebhookInput) (req *request.
 
 // CreateWebhook API operation for AWS Amplify.
 //
-// Create a new webhook on an App.
+// Creates a new webhook on an Amplify app.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -444,27 +525,25 @@ func (c *Amplify) CreateWebhookRequest(input *CreateWebhookInput) (req *request.
 // See the AWS API reference guide for AWS Amplify's
 // API operation CreateWebhook for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
+//
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Exception thrown when an entity has not been found during an operation.
+//   - NotFoundException
+//     An entity was not found during an operation.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   Exception thrown when a resource could not be created because of service
-//   limits.
+//   - LimitExceededException
+//     A resource could not be created because service quotas were exceeded.
 //
-//   * ErrCodeDependentServiceFailureException "DependentServiceFailureException"
-//   Exception thrown when an operation fails due to a dependent service throwing
-//   an exception.
+//   - DependentServiceFailureException
+//     An operation failed because a dependent service threw an exception.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/CreateWebhook
 func (c *Amplify) CreateWebhook(input *CreateWebhookInput) (*CreateWebhookOutput, error) {
@@ -504,14 +583,13 @@ const opDeleteApp = "DeleteApp"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteAppRequest method.
+//	req, resp := client.DeleteAppRequest(params)
 //
-//    // Example sending a request using the DeleteAppRequest method.
-//    req, resp := client.DeleteAppRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/DeleteApp
 func (c *Amplify) DeleteAppRequest(input *DeleteAppInput) (req *request.Request, output *DeleteAppOutput) {
@@ -532,7 +610,7 @@ func (c *Amplify) DeleteAppRequest(input *DeleteAppInput) (req *request.Request,
 
 // DeleteApp API operation for AWS Amplify.
 //
-// Delete an existing Amplify App by appId.
+// Deletes an existing Amplify app specified by an app ID.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -541,23 +619,22 @@ func (c *Amplify) DeleteAppRequest(input *DeleteAppInput) (req *request.Request,
 // See the AWS API reference guide for AWS Amplify's
 // API operation DeleteApp for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
+//
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Exception thrown when an entity has not been found during an operation.
+//   - NotFoundException
+//     An entity was not found during an operation.
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
-//   * ErrCodeDependentServiceFailureException "DependentServiceFailureException"
-//   Exception thrown when an operation fails due to a dependent service throwing
-//   an exception.
+//   - DependentServiceFailureException
+//     An operation failed because a dependent service threw an exception.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/DeleteApp
 func (c *Amplify) DeleteApp(input *DeleteAppInput) (*DeleteAppOutput, error) {
@@ -581,6 +658,97 @@ func (c *Amplify) DeleteAppWithContext(ctx aws.Context, input *DeleteAppInput, o
 	return out, req.Send()
 }
 
+const opDeleteBackendEnvironment = "DeleteBackendEnvironment"
+
+// DeleteBackendEnvironmentRequest generates a "aws/request.Request" representing the
+// client's request for the DeleteBackendEnvironment operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See DeleteBackendEnvironment for more information on using the DeleteBackendEnvironment
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the DeleteBackendEnvironmentRequest method.
+//	req, resp := client.DeleteBackendEnvironmentRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/DeleteBackendEnvironment
+func (c *Amplify) DeleteBackendEnvironmentRequest(input *DeleteBackendEnvironmentInput) (req *request.Request, output *DeleteBackendEnvironmentOutput) {
+	op := &request.Operation{
+		Name:       opDeleteBackendEnvironment,
+		HTTPMethod: "DELETE",
+		HTTPPath:   "/apps/{appId}/backendenvironments/{environmentName}",
+	}
+
+	if input == nil {
+		input = &DeleteBackendEnvironmentInput{}
+	}
+
+	output = &DeleteBackendEnvironmentOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// DeleteBackendEnvironment API operation for AWS Amplify.
+//
+// Deletes a backend environment for an Amplify app.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Amplify's
+// API operation DeleteBackendEnvironment for usage and error information.
+//
+// Returned Error Types:
+//
+//   - BadRequestException
+//     A request contains unexpected data.
+//
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
+//
+//   - NotFoundException
+//     An entity was not found during an operation.
+//
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
+//
+//   - DependentServiceFailureException
+//     An operation failed because a dependent service threw an exception.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/DeleteBackendEnvironment
+func (c *Amplify) DeleteBackendEnvironment(input *DeleteBackendEnvironmentInput) (*DeleteBackendEnvironmentOutput, error) {
+	req, out := c.DeleteBackendEnvironmentRequest(input)
+	return out, req.Send()
+}
+
+// DeleteBackendEnvironmentWithContext is the same as DeleteBackendEnvironment with the addition of
+// the ability to pass a context and additional request options.
+//
+// See DeleteBackendEnvironment for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Amplify) DeleteBackendEnvironmentWithContext(ctx aws.Context, input *DeleteBackendEnvironmentInput, opts ...request.Option) (*DeleteBackendEnvironmentOutput, error) {
+	req, out := c.DeleteBackendEnvironmentRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opDeleteBranch = "DeleteBranch"
 
 // DeleteBranchRequest generates a "aws/request.Request" representing the
@@ -597,14 +765,13 @@ const opDeleteBranch = "DeleteBranch"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteBranchRequest method.
+//	req, resp := client.DeleteBranchRequest(params)
 //
-//    // Example sending a request using the DeleteBranchRequest method.
-//    req, resp := client.DeleteBranchRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/DeleteBranch
 func (c *Amplify) DeleteBranchRequest(input *DeleteBranchInput) (req *request.Request, output *DeleteBranchOutput) {
@@ -625,7 +792,7 @@ func (c *Amplify) DeleteBranchRequest(input *DeleteBranchInput) (req *request.Re
 
 // DeleteBranch API operation for AWS Amplify.
 //
-// Deletes a branch for an Amplify App.
+// Deletes a branch for an Amplify app.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -634,23 +801,22 @@ func (c *Amplify) DeleteBranchRequest(input *DeleteBranchInput) (req *request.Re
 // See the AWS API reference guide for AWS Amplify's
 // API operation DeleteBranch for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
+//
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Exception thrown when an entity has not been found during an operation.
+//   - NotFoundException
+//     An entity was not found during an operation.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
-//   * ErrCodeDependentServiceFailureException "DependentServiceFailureException"
-//   Exception thrown when an operation fails due to a dependent service throwing
-//   an exception.
+//   - DependentServiceFailureException
+//     An operation failed because a dependent service threw an exception.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/DeleteBranch
 func (c *Amplify) DeleteBranch(input *DeleteBranchInput) (*DeleteBranchOutput, error) {
@@ -690,14 +856,13 @@ const opDeleteDomainAssociation = "DeleteDomainAssociation"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteDomainAssociationRequest method.
+//	req, resp := client.DeleteDomainAssociationRequest(params)
 //
-//    // Example sending a request using the DeleteDomainAssociationRequest method.
-//    req, resp := client.DeleteDomainAssociationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/DeleteDomainAssociation
 func (c *Amplify) DeleteDomainAssociationRequest(input *DeleteDomainAssociationInput) (req *request.Request, output *DeleteDomainAssociationOutput) {
@@ -718,7 +883,7 @@ func (c *Amplify) DeleteDomainAssociationRequest(input *DeleteDomainAssociationI
 
 // DeleteDomainAssociation API operation for AWS Amplify.
 //
-// Deletes a DomainAssociation.
+// Deletes a domain association for an Amplify app.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -727,23 +892,22 @@ func (c *Amplify) DeleteDomainAssociationRequest(input *DeleteDomainAssociationI
 // See the AWS API reference guide for AWS Amplify's
 // API operation DeleteDomainAssociation for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
+//
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Exception thrown when an entity has not been found during an operation.
+//   - NotFoundException
+//     An entity was not found during an operation.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
-//   * ErrCodeDependentServiceFailureException "DependentServiceFailureException"
-//   Exception thrown when an operation fails due to a dependent service throwing
-//   an exception.
+//   - DependentServiceFailureException
+//     An operation failed because a dependent service threw an exception.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/DeleteDomainAssociation
 func (c *Amplify) DeleteDomainAssociation(input *DeleteDomainAssociationInput) (*DeleteDomainAssociationOutput, error) {
@@ -783,14 +947,13 @@ const opDeleteJob = "DeleteJob"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteJobRequest method.
+//	req, resp := client.DeleteJobRequest(params)
 //
-//    // Example sending a request using the DeleteJobRequest method.
-//    req, resp := client.DeleteJobRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/DeleteJob
 func (c *Amplify) DeleteJobRequest(input *DeleteJobInput) (req *request.Request, output *DeleteJobOutput) {
@@ -811,7 +974,7 @@ func (c *Amplify) DeleteJobRequest(input *DeleteJobInput) (req *request.Request,
 
 // DeleteJob API operation for AWS Amplify.
 //
-// Delete a job, for an Amplify branch, part of Amplify App.
+// Deletes a job for a branch of an Amplify app.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -820,23 +983,22 @@ func (c *Amplify) DeleteJobRequest(input *DeleteJobInput) (req *request.Request,
 // See the AWS API reference guide for AWS Amplify's
 // API operation DeleteJob for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
+//
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Exception thrown when an entity has not been found during an operation.
+//   - NotFoundException
+//     An entity was not found during an operation.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   Exception thrown when a resource could not be created because of service
-//   limits.
+//   - LimitExceededException
+//     A resource could not be created because service quotas were exceeded.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/DeleteJob
 func (c *Amplify) DeleteJob(input *DeleteJobInput) (*DeleteJobOutput, error) {
@@ -876,14 +1038,13 @@ const opDeleteWebhook = "DeleteWebhook"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteWebhookRequest method.
+//	req, resp := client.DeleteWebhookRequest(params)
 //
-//    // Example sending a request using the DeleteWebhookRequest method.
-//    req, resp := client.DeleteWebhookRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/DeleteWebhook
 func (c *Amplify) DeleteWebhookRequest(input *DeleteWebhookInput) (req *request.Request, output *DeleteWebhookOutput) {
@@ -913,23 +1074,22 @@ func (c *Amplify) DeleteWebhookRequest(input *DeleteWebhookInput) (req *request.
 // See the AWS API reference guide for AWS Amplify's
 // API operation DeleteWebhook for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
+//
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Exception thrown when an entity has not been found during an operation.
+//   - NotFoundException
+//     An entity was not found during an operation.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   Exception thrown when a resource could not be created because of service
-//   limits.
+//   - LimitExceededException
+//     A resource could not be created because service quotas were exceeded.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/DeleteWebhook
 func (c *Amplify) DeleteWebhook(input *DeleteWebhookInput) (*DeleteWebhookOutput, error) {
@@ -969,14 +1129,13 @@ const opGenerateAccessLogs = "GenerateAccessLogs"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GenerateAccessLogsRequest method.
+//	req, resp := client.GenerateAccessLogsRequest(params)
 //
-//    // Example sending a request using the GenerateAccessLogsRequest method.
-//    req, resp := client.GenerateAccessLogsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/GenerateAccessLogs
 func (c *Amplify) GenerateAccessLogsRequest(input *GenerateAccessLogsInput) (req *request.Request, output *GenerateAccessLogsOutput) {
@@ -997,8 +1156,8 @@ func (c *Amplify) GenerateAccessLogsRequest(input *GenerateAccessLogsInput) (req
 
 // GenerateAccessLogs API operation for AWS Amplify.
 //
-// Retrieve website access logs for a specific time range via a pre-signed URL.
-// Optionally, deliver the logs to a given S3 bucket.
+// Returns the website access logs for a specific time range using a presigned
+// URL.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1007,19 +1166,19 @@ func (c *Amplify) GenerateAccessLogsRequest(input *GenerateAccessLogsInput) (req
 // See the AWS API reference guide for AWS Amplify's
 // API operation GenerateAccessLogs for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Exception thrown when an entity has not been found during an operation.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     An entity was not found during an operation.
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/GenerateAccessLogs
 func (c *Amplify) GenerateAccessLogs(input *GenerateAccessLogsInput) (*GenerateAccessLogsOutput, error) {
@@ -1059,14 +1218,13 @@ const opGetApp = "GetApp"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetAppRequest method.
+//	req, resp := client.GetAppRequest(params)
 //
-//    // Example sending a request using the GetAppRequest method.
-//    req, resp := client.GetAppRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/GetApp
 func (c *Amplify) GetAppRequest(input *GetAppInput) (req *request.Request, output *GetAppOutput) {
@@ -1087,7 +1245,7 @@ func (c *Amplify) GetAppRequest(input *GetAppInput) (req *request.Request, outpu
 
 // GetApp API operation for AWS Amplify.
 //
-// Retrieves an existing Amplify App by appId.
+// Returns an existing Amplify app by appID.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1096,19 +1254,19 @@ func (c *Amplify) GetAppRequest(input *GetAppInput) (req *request.Request, outpu
 // See the AWS API reference guide for AWS Amplify's
 // API operation GetApp for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
+//
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Exception thrown when an entity has not been found during an operation.
+//   - NotFoundException
+//     An entity was not found during an operation.
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/GetApp
 func (c *Amplify) GetApp(input *GetAppInput) (*GetAppOutput, error) {
@@ -1148,14 +1306,13 @@ const opGetArtifactUrl = "GetArtifactUrl"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetArtifactUrlRequest method.
+//	req, resp := client.GetArtifactUrlRequest(params)
 //
-//    // Example sending a request using the GetArtifactUrlRequest method.
-//    req, resp := client.GetArtifactUrlRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/GetArtifactUrl
 func (c *Amplify) GetArtifactUrlRequest(input *GetArtifactUrlInput) (req *request.Request, output *GetArtifactUrlOutput) {
@@ -1176,7 +1333,7 @@ func (c *Amplify) GetArtifactUrlRequest(input *GetArtifactUrlInput) (req *reques
 
 // GetArtifactUrl API operation for AWS Amplify.
 //
-// Retrieves artifact info that corresponds to a artifactId.
+// Returns the artifact info that corresponds to an artifact id.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1185,23 +1342,22 @@ func (c *Amplify) GetArtifactUrlRequest(input *GetArtifactUrlInput) (req *reques
 // See the AWS API reference guide for AWS Amplify's
 // API operation GetArtifactUrl for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
+//
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Exception thrown when an entity has not been found during an operation.
+//   - NotFoundException
+//     An entity was not found during an operation.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   Exception thrown when a resource could not be created because of service
-//   limits.
+//   - LimitExceededException
+//     A resource could not be created because service quotas were exceeded.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/GetArtifactUrl
 func (c *Amplify) GetArtifactUrl(input *GetArtifactUrlInput) (*GetArtifactUrlOutput, error) {
@@ -1225,6 +1381,94 @@ func (c *Amplify) GetArtifactUrlWithContext(ctx aws.Context, input *GetArtifactU
 	return out, req.Send()
 }
 
+const opGetBackendEnvironment = "GetBackendEnvironment"
+
+// GetBackendEnvironmentRequest generates a "aws/request.Request" representing the
+// client's request for the GetBackendEnvironment operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See GetBackendEnvironment for more information on using the GetBackendEnvironment
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the GetBackendEnvironmentRequest method.
+//	req, resp := client.GetBackendEnvironmentRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/GetBackendEnvironment
+func (c *Amplify) GetBackendEnvironmentRequest(input *GetBackendEnvironmentInput) (req *request.Request, output *GetBackendEnvironmentOutput) {
+	op := &request.Operation{
+		Name:       opGetBackendEnvironment,
+		HTTPMethod: "GET",
+		HTTPPath:   "/apps/{appId}/backendenvironments/{environmentName}",
+	}
+
+	if input == nil {
+		input = &GetBackendEnvironmentInput{}
+	}
+
+	output = &GetBackendEnvironmentOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// GetBackendEnvironment API operation for AWS Amplify.
+//
+// Returns a backend environment for an Amplify app.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Amplify's
+// API operation GetBackendEnvironment for usage and error information.
+//
+// Returned Error Types:
+//
+//   - BadRequestException
+//     A request contains unexpected data.
+//
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
+//
+//   - NotFoundException
+//     An entity was not found during an operation.
+//
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/GetBackendEnvironment
+func (c *Amplify) GetBackendEnvironment(input *GetBackendEnvironmentInput) (*GetBackendEnvironmentOutput, error) {
+	req, out := c.GetBackendEnvironmentRequest(input)
+	return out, req.Send()
+}
+
+// GetBackendEnvironmentWithContext is the same as GetBackendEnvironment with the addition of
+// the ability to pass a context and additional request options.
+//
+// See GetBackendEnvironment for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Amplify) GetBackendEnvironmentWithContext(ctx aws.Context, input *GetBackendEnvironmentInput, opts ...request.Option) (*GetBackendEnvironmentOutput, error) {
+	req, out := c.GetBackendEnvironmentRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opGetBranch = "GetBranch"
 
 // GetBranchRequest generates a "aws/request.Request" representing the
@@ -1241,14 +1485,13 @@ const opGetBranch = "GetBranch"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetBranchRequest method.
+//	req, resp := client.GetBranchRequest(params)
 //
-//    // Example sending a request using the GetBranchRequest method.
-//    req, resp := client.GetBranchRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/GetBranch
 func (c *Amplify) GetBranchRequest(input *GetBranchInput) (req *request.Request, output *GetBranchOutput) {
@@ -1269,7 +1512,7 @@ func (c *Amplify) GetBranchRequest(input *GetBranchInput) (req *request.Request,
 
 // GetBranch API operation for AWS Amplify.
 //
-// Retrieves a branch for an Amplify App.
+// Returns a branch for an Amplify app.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1278,19 +1521,19 @@ func (c *Amplify) GetBranchRequest(input *GetBranchInput) (req *request.Request,
 // See the AWS API reference guide for AWS Amplify's
 // API operation GetBranch for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Exception thrown when an entity has not been found during an operation.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - NotFoundException
+//     An entity was not found during an operation.
+//
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/GetBranch
 func (c *Amplify) GetBranch(input *GetBranchInput) (*GetBranchOutput, error) {
@@ -1330,14 +1573,13 @@ const opGetDomainAssociation = "GetDomainAssociation"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetDomainAssociationRequest method.
+//	req, resp := client.GetDomainAssociationRequest(params)
 //
-//    // Example sending a request using the GetDomainAssociationRequest method.
-//    req, resp := client.GetDomainAssociationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/GetDomainAssociation
 func (c *Amplify) GetDomainAssociationRequest(input *GetDomainAssociationInput) (req *request.Request, output *GetDomainAssociationOutput) {
@@ -1358,7 +1600,7 @@ func (c *Amplify) GetDomainAssociationRequest(input *GetDomainAssociationInput)
 
 // GetDomainAssociation API operation for AWS Amplify.
 //
-// Retrieves domain info that corresponds to an appId and domainName.
+// Returns the domain information for an Amplify app.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1367,19 +1609,19 @@ func (c *Amplify) GetDomainAssociationRequest(input *GetDomainAssociationInput)
 // See the AWS API reference guide for AWS Amplify's
 // API operation GetDomainAssociation for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Exception thrown when an entity has not been found during an operation.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - NotFoundException
+//     An entity was not found during an operation.
+//
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/GetDomainAssociation
 func (c *Amplify) GetDomainAssociation(input *GetDomainAssociationInput) (*GetDomainAssociationOutput, error) {
@@ -1419,14 +1661,13 @@ const opGetJob = "GetJob"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetJobRequest method.
+//	req, resp := client.GetJobRequest(params)
 //
-//    // Example sending a request using the GetJobRequest method.
-//    req, resp := client.GetJobRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/GetJob
 func (c *Amplify) GetJobRequest(input *GetJobInput) (req *request.Request, output *GetJobOutput) {
@@ -1447,7 +1688,7 @@ func (c *Amplify) GetJobRequest(input *GetJobInput) (req *request.Request, outpu
 
 // GetJob API operation for AWS Amplify.
 //
-// Get a job for a branch, part of an Amplify App.
+// Returns a job for a branch of an Amplify app.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1456,23 +1697,22 @@ func (c *Amplify) GetJobRequest(input *GetJobInput) (req *request.Request, outpu
 // See the AWS API reference guide for AWS Amplify's
 // API operation GetJob for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Exception thrown when an entity has not been found during an operation.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   Exception thrown when a resource could not be created because of service
-//   limits.
+//   - NotFoundException
+//     An entity was not found during an operation.
+//
+//   - LimitExceededException
+//     A resource could not be created because service quotas were exceeded.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/GetJob
 func (c *Amplify) GetJob(input *GetJobInput) (*GetJobOutput, error) {
@@ -1512,14 +1752,13 @@ const opGetWebhook = "GetWebhook"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetWebhookRequest method.
+//	req, resp := client.GetWebhookRequest(params)
 //
-//    // Example sending a request using the GetWebhookRequest method.
-//    req, resp := client.GetWebhookRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/GetWebhook
 func (c *Amplify) GetWebhookRequest(input *GetWebhookInput) (req *request.Request, output *GetWebhookOutput) {
@@ -1540,7 +1779,7 @@ func (c *Amplify) GetWebhookRequest(input *GetWebhookInput) (req *request.Reques
 
 // GetWebhook API operation for AWS Amplify.
 //
-// Retrieves webhook info that corresponds to a webhookId.
+// Returns the webhook information that corresponds to a specified webhook ID.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1549,23 +1788,22 @@ func (c *Amplify) GetWebhookRequest(input *GetWebhookInput) (req *request.Reques
 // See the AWS API reference guide for AWS Amplify's
 // API operation GetWebhook for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Exception thrown when an entity has not been found during an operation.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   Exception thrown when a resource could not be created because of service
-//   limits.
+//   - NotFoundException
+//     An entity was not found during an operation.
+//
+//   - LimitExceededException
+//     A resource could not be created because service quotas were exceeded.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/GetWebhook
 func (c *Amplify) GetWebhook(input *GetWebhookInput) (*GetWebhookOutput, error) {
@@ -1605,14 +1843,13 @@ const opListApps = "ListApps"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListAppsRequest method.
+//	req, resp := client.ListAppsRequest(params)
 //
-//    // Example sending a request using the ListAppsRequest method.
-//    req, resp := client.ListAppsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/ListApps
 func (c *Amplify) ListAppsRequest(input *ListAppsInput) (req *request.Request, output *ListAppsOutput) {
@@ -1633,7 +1870,7 @@ func (c *Amplify) ListAppsRequest(input *ListAppsInput) (req *request.Request, o
 
 // ListApps API operation for AWS Amplify.
 //
-// Lists existing Amplify Apps.
+// Returns a list of the existing Amplify apps.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1642,16 +1879,16 @@ func (c *Amplify) ListAppsRequest(input *ListAppsInput) (req *request.Request, o
 // See the AWS API reference guide for AWS Amplify's
 // API operation ListApps for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
+//
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/ListApps
 func (c *Amplify) ListApps(input *ListAppsInput) (*ListAppsOutput, error) {
@@ -1691,14 +1928,13 @@ const opListArtifacts = "ListArtifacts"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListArtifactsRequest method.
+//	req, resp := client.ListArtifactsRequest(params)
 //
-//    // Example sending a request using the ListArtifactsRequest method.
-//    req, resp := client.ListArtifactsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/ListArtifacts
 func (c *Amplify) ListArtifactsRequest(input *ListArtifactsInput) (req *request.Request, output *ListArtifactsOutput) {
@@ -1719,7 +1955,7 @@ func (c *Amplify) ListArtifactsRequest(input *ListArtifactsInput) (req *request.
 
 // ListArtifacts API operation for AWS Amplify.
 //
-// List artifacts with an app, a branch, a job and an artifact type.
+// Returns a list of artifacts for a specified app, branch, and job.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1728,20 +1964,19 @@ func (c *Amplify) ListArtifactsRequest(input *ListArtifactsInput) (req *request.
 // See the AWS API reference guide for AWS Amplify's
 // API operation ListArtifacts for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   Exception thrown when a resource could not be created because of service
-//   limits.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
+//
+//   - LimitExceededException
+//     A resource could not be created because service quotas were exceeded.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/ListArtifacts
 func (c *Amplify) ListArtifacts(input *ListArtifactsInput) (*ListArtifactsOutput, error) {
@@ -1765,6 +2000,91 @@ func (c *Amplify) ListArtifactsWithContext(ctx aws.Context, input *ListArtifacts
 	return out, req.Send()
 }
 
+const opListBackendEnvironments = "ListBackendEnvironments"
+
+// ListBackendEnvironmentsRequest generates a "aws/request.Request" representing the
+// client's request for the ListBackendEnvironments operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListBackendEnvironments for more information on using the ListBackendEnvironments
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListBackendEnvironmentsRequest method.
+//	req, resp := client.ListBackendEnvironmentsRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/ListBackendEnvironments
+func (c *Amplify) ListBackendEnvironmentsRequest(input *ListBackendEnvironmentsInput) (req *request.Request, output *ListBackendEnvironmentsOutput) {
+	op := &request.Operation{
+		Name:       opListBackendEnvironments,
+		HTTPMethod: "GET",
+		HTTPPath:   "/apps/{appId}/backendenvironments",
+	}
+
+	if input == nil {
+		input = &ListBackendEnvironmentsInput{}
+	}
+
+	output = &ListBackendEnvironmentsOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListBackendEnvironments API operation for AWS Amplify.
+//
+// Lists the backend environments for an Amplify app.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Amplify's
+// API operation ListBackendEnvironments for usage and error information.
+//
+// Returned Error Types:
+//
+//   - BadRequestException
+//     A request contains unexpected data.
+//
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
+//
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/ListBackendEnvironments
+func (c *Amplify) ListBackendEnvironments(input *ListBackendEnvironmentsInput) (*ListBackendEnvironmentsOutput, error) {
+	req, out := c.ListBackendEnvironmentsRequest(input)
+	return out, req.Send()
+}
+
+// ListBackendEnvironmentsWithContext is the same as ListBackendEnvironments with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListBackendEnvironments for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Amplify) ListBackendEnvironmentsWithContext(ctx aws.Context, input *ListBackendEnvironmentsInput, opts ...request.Option) (*ListBackendEnvironmentsOutput, error) {
+	req, out := c.ListBackendEnvironmentsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opListBranches = "ListBranches"
 
 // ListBranchesRequest generates a "aws/request.Request" representing the
@@ -1781,14 +2101,13 @@ const opListBranches = "ListBranches"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListBranchesRequest method.
+//	req, resp := client.ListBranchesRequest(params)
 //
-//    // Example sending a request using the ListBranchesRequest method.
-//    req, resp := client.ListBranchesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/ListBranches
 func (c *Amplify) ListBranchesRequest(input *ListBranchesInput) (req *request.Request, output *ListBranchesOutput) {
@@ -1809,7 +2128,7 @@ func (c *Amplify) ListBranchesRequest(input *ListBranchesInput) (req *request.Re
 
 // ListBranches API operation for AWS Amplify.
 //
-// Lists branches for an Amplify App.
+// Lists the branches of an Amplify app.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1818,16 +2137,16 @@ func (c *Amplify) ListBranchesRequest(input *ListBranchesInput) (req *request.Re
 // See the AWS API reference guide for AWS Amplify's
 // API operation ListBranches for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
+//
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/ListBranches
 func (c *Amplify) ListBranches(input *ListBranchesInput) (*ListBranchesOutput, error) {
@@ -1867,14 +2186,13 @@ const opListDomainAssociations = "ListDomainAssociations"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListDomainAssociationsRequest method.
+//	req, resp := client.ListDomainAssociationsRequest(params)
 //
-//    // Example sending a request using the ListDomainAssociationsRequest method.
-//    req, resp := client.ListDomainAssociationsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/ListDomainAssociations
 func (c *Amplify) ListDomainAssociationsRequest(input *ListDomainAssociationsInput) (req *request.Request, output *ListDomainAssociationsOutput) {
@@ -1895,7 +2213,7 @@ func (c *Amplify) ListDomainAssociationsRequest(input *ListDomainAssociationsInp
 
 // ListDomainAssociations API operation for AWS Amplify.
 //
-// List domains with an app
+// Returns the domain associations for an Amplify app.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1904,16 +2222,16 @@ func (c *Amplify) ListDomainAssociationsRequest(input *ListDomainAssociationsInp
 // See the AWS API reference guide for AWS Amplify's
 // API operation ListDomainAssociations for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
+//
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/ListDomainAssociations
 func (c *Amplify) ListDomainAssociations(input *ListDomainAssociationsInput) (*ListDomainAssociationsOutput, error) {
@@ -1953,14 +2271,13 @@ const opListJobs = "ListJobs"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListJobsRequest method.
+//	req, resp := client.ListJobsRequest(params)
 //
-//    // Example sending a request using the ListJobsRequest method.
-//    req, resp := client.ListJobsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/ListJobs
 func (c *Amplify) ListJobsRequest(input *ListJobsInput) (req *request.Request, output *ListJobsOutput) {
@@ -1981,7 +2298,7 @@ func (c *Amplify) ListJobsRequest(input *ListJobsInput) (req *request.Request, o
 
 // ListJobs API operation for AWS Amplify.
 //
-// List Jobs for a branch, part of an Amplify App.
+// Lists the jobs for a branch of an Amplify app.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1990,20 +2307,19 @@ func (c *Amplify) ListJobsRequest(input *ListJobsInput) (req *request.Request, o
 // See the AWS API reference guide for AWS Amplify's
 // API operation ListJobs for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   Exception thrown when a resource could not be created because of service
-//   limits.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
+//
+//   - LimitExceededException
+//     A resource could not be created because service quotas were exceeded.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/ListJobs
 func (c *Amplify) ListJobs(input *ListJobsInput) (*ListJobsOutput, error) {
@@ -2043,14 +2359,13 @@ const opListTagsForResource = "ListTagsForResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTagsForResourceRequest method.
+//	req, resp := client.ListTagsForResourceRequest(params)
 //
-//    // Example sending a request using the ListTagsForResourceRequest method.
-//    req, resp := client.ListTagsForResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/ListTagsForResource
 func (c *Amplify) ListTagsForResourceRequest(input *ListTagsForResourceInput) (req *request.Request, output *ListTagsForResourceOutput) {
@@ -2071,7 +2386,7 @@ func (c *Amplify) ListTagsForResourceRequest(input *ListTagsForResourceInput) (r
 
 // ListTagsForResource API operation for AWS Amplify.
 //
-// List tags for resource.
+// Returns a list of tags for a specified Amazon Resource Name (ARN).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2080,16 +2395,16 @@ func (c *Amplify) ListTagsForResourceRequest(input *ListTagsForResourceInput) (r
 // See the AWS API reference guide for AWS Amplify's
 // API operation ListTagsForResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+// Returned Error Types:
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Exception thrown when an operation fails due to non-existent resource.
+//   - BadRequestException
+//     A request contains unexpected data.
+//
+//   - ResourceNotFoundException
+//     An operation failed due to a non-existent resource.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/ListTagsForResource
 func (c *Amplify) ListTagsForResource(input *ListTagsForResourceInput) (*ListTagsForResourceOutput, error) {
@@ -2129,14 +2444,13 @@ const opListWebhooks = "ListWebhooks"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListWebhooksRequest method.
+//	req, resp := client.ListWebhooksRequest(params)
 //
-//    // Example sending a request using the ListWebhooksRequest method.
-//    req, resp := client.ListWebhooksRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/ListWebhooks
 func (c *Amplify) ListWebhooksRequest(input *ListWebhooksInput) (req *request.Request, output *ListWebhooksOutput) {
@@ -2157,7 +2471,7 @@ func (c *Amplify) ListWebhooksRequest(input *ListWebhooksInput) (req *request.Re
 
 // ListWebhooks API operation for AWS Amplify.
 //
-// List webhooks with an app.
+// Returns a list of webhooks for an Amplify app.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2166,20 +2480,19 @@ func (c *Amplify) ListWebhooksRequest(input *ListWebhooksInput) (req *request.Re
 // See the AWS API reference guide for AWS Amplify's
 // API operation ListWebhooks for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   Exception thrown when a resource could not be created because of service
-//   limits.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
+//
+//   - LimitExceededException
+//     A resource could not be created because service quotas were exceeded.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/ListWebhooks
 func (c *Amplify) ListWebhooks(input *ListWebhooksInput) (*ListWebhooksOutput, error) {
@@ -2219,14 +2532,13 @@ const opStartDeployment = "StartDeployment"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the StartDeploymentRequest method.
+//	req, resp := client.StartDeploymentRequest(params)
 //
-//    // Example sending a request using the StartDeploymentRequest method.
-//    req, resp := client.StartDeploymentRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/StartDeployment
 func (c *Amplify) StartDeploymentRequest(input *StartDeploymentInput) (req *request.Request, output *StartDeploymentOutput) {
@@ -2247,7 +2559,8 @@ func (c *Amplify) StartDeploymentRequest(input *StartDeploymentInput) (req *requ
 
 // StartDeployment API operation for AWS Amplify.
 //
-// Start a deployment for manual deploy apps. (Apps are not connected to repository)
+// Starts a deployment for a manually deployed app. Manually deployed apps are
+// not connected to a repository.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2256,23 +2569,22 @@ func (c *Amplify) StartDeploymentRequest(input *StartDeploymentInput) (req *requ
 // See the AWS API reference guide for AWS Amplify's
 // API operation StartDeployment for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Exception thrown when an entity has not been found during an operation.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   Exception thrown when a resource could not be created because of service
-//   limits.
+//   - NotFoundException
+//     An entity was not found during an operation.
+//
+//   - LimitExceededException
+//     A resource could not be created because service quotas were exceeded.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/StartDeployment
 func (c *Amplify) StartDeployment(input *StartDeploymentInput) (*StartDeploymentOutput, error) {
@@ -2312,14 +2624,13 @@ const opStartJob = "StartJob"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the StartJobRequest method.
+//	req, resp := client.StartJobRequest(params)
 //
-//    // Example sending a request using the StartJobRequest method.
-//    req, resp := client.StartJobRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/StartJob
 func (c *Amplify) StartJobRequest(input *StartJobInput) (req *request.Request, output *StartJobOutput) {
@@ -2340,7 +2651,7 @@ func (c *Amplify) StartJobRequest(input *StartJobInput) (req *request.Request, o
 
 // StartJob API operation for AWS Amplify.
 //
-// Starts a new job for a branch, part of an Amplify App.
+// Starts a new job for a branch of an Amplify app.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2349,23 +2660,22 @@ func (c *Amplify) StartJobRequest(input *StartJobInput) (req *request.Request, o
 // See the AWS API reference guide for AWS Amplify's
 // API operation StartJob for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Exception thrown when an entity has not been found during an operation.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   Exception thrown when a resource could not be created because of service
-//   limits.
+//   - NotFoundException
+//     An entity was not found during an operation.
+//
+//   - LimitExceededException
+//     A resource could not be created because service quotas were exceeded.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/StartJob
 func (c *Amplify) StartJob(input *StartJobInput) (*StartJobOutput, error) {
@@ -2405,14 +2715,13 @@ const opStopJob = "StopJob"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the StopJobRequest method.
+//	req, resp := client.StopJobRequest(params)
 //
-//    // Example sending a request using the StopJobRequest method.
-//    req, resp := client.StopJobRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/StopJob
 func (c *Amplify) StopJobRequest(input *StopJobInput) (req *request.Request, output *StopJobOutput) {
@@ -2433,7 +2742,7 @@ func (c *Amplify) StopJobRequest(input *StopJobInput) (req *request.Request, out
 
 // StopJob API operation for AWS Amplify.
 //
-// Stop a job that is in progress, for an Amplify branch, part of Amplify App.
+// Stops a job that is in progress for a branch of an Amplify app.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2442,23 +2751,22 @@ func (c *Amplify) StopJobRequest(input *StopJobInput) (req *request.Request, out
 // See the AWS API reference guide for AWS Amplify's
 // API operation StopJob for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Exception thrown when an entity has not been found during an operation.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   Exception thrown when a resource could not be created because of service
-//   limits.
+//   - NotFoundException
+//     An entity was not found during an operation.
+//
+//   - LimitExceededException
+//     A resource could not be created because service quotas were exceeded.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/StopJob
 func (c *Amplify) StopJob(input *StopJobInput) (*StopJobOutput, error) {
@@ -2498,14 +2806,13 @@ const opTagResource = "TagResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the TagResourceRequest method.
+//	req, resp := client.TagResourceRequest(params)
 //
-//    // Example sending a request using the TagResourceRequest method.
-//    req, resp := client.TagResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/TagResource
 func (c *Amplify) TagResourceRequest(input *TagResourceInput) (req *request.Request, output *TagResourceOutput) {
@@ -2527,7 +2834,7 @@ func (c *Amplify) TagResourceRequest(input *TagResourceInput) (req *request.Requ
 
 // TagResource API operation for AWS Amplify.
 //
-// Tag resource with tag key and value.
+// Tags the resource with a tag key and value.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2536,16 +2843,16 @@ func (c *Amplify) TagResourceRequest(input *TagResourceInput) (req *request.Requ
 // See the AWS API reference guide for AWS Amplify's
 // API operation TagResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+// Returned Error Types:
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Exception thrown when an operation fails due to non-existent resource.
+//   - BadRequestException
+//     A request contains unexpected data.
+//
+//   - ResourceNotFoundException
+//     An operation failed due to a non-existent resource.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/TagResource
 func (c *Amplify) TagResource(input *TagResourceInput) (*TagResourceOutput, error) {
@@ -2585,14 +2892,13 @@ const opUntagResource = "UntagResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UntagResourceRequest method.
+//	req, resp := client.UntagResourceRequest(params)
 //
-//    // Example sending a request using the UntagResourceRequest method.
-//    req, resp := client.UntagResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/UntagResource
 func (c *Amplify) UntagResourceRequest(input *UntagResourceInput) (req *request.Request, output *UntagResourceOutput) {
@@ -2614,7 +2920,7 @@ func (c *Amplify) UntagResourceRequest(input *UntagResourceInput) (req *request.
 
 // UntagResource API operation for AWS Amplify.
 //
-// Untag resource with resourceArn.
+// Untags a resource with a specified Amazon Resource Name (ARN).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2623,16 +2929,16 @@ func (c *Amplify) UntagResourceRequest(input *UntagResourceInput) (req *request.
 // See the AWS API reference guide for AWS Amplify's
 // API operation UntagResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+// Returned Error Types:
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Exception thrown when an operation fails due to non-existent resource.
+//   - BadRequestException
+//     A request contains unexpected data.
+//
+//   - ResourceNotFoundException
+//     An operation failed due to a non-existent resource.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/UntagResource
 func (c *Amplify) UntagResource(input *UntagResourceInput) (*UntagResourceOutput, error) {
@@ -2672,14 +2978,13 @@ const opUpdateApp = "UpdateApp"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateAppRequest method.
+//	req, resp := client.UpdateAppRequest(params)
 //
-//    // Example sending a request using the UpdateAppRequest method.
-//    req, resp := client.UpdateAppRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/UpdateApp
 func (c *Amplify) UpdateAppRequest(input *UpdateAppInput) (req *request.Request, output *UpdateAppOutput) {
@@ -2700,7 +3005,7 @@ func (c *Amplify) UpdateAppRequest(input *UpdateAppInput) (req *request.Request,
 
 // UpdateApp API operation for AWS Amplify.
 //
-// Updates an existing Amplify App.
+// Updates an existing Amplify app.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2709,19 +3014,19 @@ func (c *Amplify) UpdateAppRequest(input *UpdateAppInput) (req *request.Request,
 // See the AWS API reference guide for AWS Amplify's
 // API operation UpdateApp for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Exception thrown when an entity has not been found during an operation.
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - NotFoundException
+//     An entity was not found during an operation.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
+//
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/UpdateApp
 func (c *Amplify) UpdateApp(input *UpdateAppInput) (*UpdateAppOutput, error) {
@@ -2761,14 +3066,13 @@ const opUpdateBranch = "UpdateBranch"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateBranchRequest method.
+//	req, resp := client.UpdateBranchRequest(params)
 //
-//    // Example sending a request using the UpdateBranchRequest method.
-//    req, resp := client.UpdateBranchRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/UpdateBranch
 func (c *Amplify) UpdateBranchRequest(input *UpdateBranchInput) (req *request.Request, output *UpdateBranchOutput) {
@@ -2789,7 +3093,7 @@ func (c *Amplify) UpdateBranchRequest(input *UpdateBranchInput) (req *request.Re
 
 // UpdateBranch API operation for AWS Amplify.
 //
-// Updates a branch for an Amplify App.
+// Updates a branch for an Amplify app.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2798,23 +3102,22 @@ func (c *Amplify) UpdateBranchRequest(input *UpdateBranchInput) (req *request.Re
 // See the AWS API reference guide for AWS Amplify's
 // API operation UpdateBranch for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Exception thrown when an entity has not been found during an operation.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - NotFoundException
+//     An entity was not found during an operation.
 //
-//   * ErrCodeDependentServiceFailureException "DependentServiceFailureException"
-//   Exception thrown when an operation fails due to a dependent service throwing
-//   an exception.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
+//
+//   - DependentServiceFailureException
+//     An operation failed because a dependent service threw an exception.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/UpdateBranch
 func (c *Amplify) UpdateBranch(input *UpdateBranchInput) (*UpdateBranchOutput, error) {
@@ -2854,14 +3157,13 @@ const opUpdateDomainAssociation = "UpdateDomainAssociation"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateDomainAssociationRequest method.
+//	req, resp := client.UpdateDomainAssociationRequest(params)
 //
-//    // Example sending a request using the UpdateDomainAssociationRequest method.
-//    req, resp := client.UpdateDomainAssociationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/UpdateDomainAssociation
 func (c *Amplify) UpdateDomainAssociationRequest(input *UpdateDomainAssociationInput) (req *request.Request, output *UpdateDomainAssociationOutput) {
@@ -2882,7 +3184,7 @@ func (c *Amplify) UpdateDomainAssociationRequest(input *UpdateDomainAssociationI
 
 // UpdateDomainAssociation API operation for AWS Amplify.
 //
-// Create a new DomainAssociation on an App
+// Creates a new domain association for an Amplify app.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2891,23 +3193,22 @@ func (c *Amplify) UpdateDomainAssociationRequest(input *UpdateDomainAssociationI
 // See the AWS API reference guide for AWS Amplify's
 // API operation UpdateDomainAssociation for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Exception thrown when an entity has not been found during an operation.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - NotFoundException
+//     An entity was not found during an operation.
 //
-//   * ErrCodeDependentServiceFailureException "DependentServiceFailureException"
-//   Exception thrown when an operation fails due to a dependent service throwing
-//   an exception.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
+//
+//   - DependentServiceFailureException
+//     An operation failed because a dependent service threw an exception.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/UpdateDomainAssociation
 func (c *Amplify) UpdateDomainAssociation(input *UpdateDomainAssociationInput) (*UpdateDomainAssociationOutput, error) {
@@ -2947,14 +3248,13 @@ const opUpdateWebhook = "UpdateWebhook"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateWebhookRequest method.
+//	req, resp := client.UpdateWebhookRequest(params)
 //
-//    // Example sending a request using the UpdateWebhookRequest method.
-//    req, resp := client.UpdateWebhookRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/UpdateWebhook
 func (c *Amplify) UpdateWebhookRequest(input *UpdateWebhookInput) (req *request.Request, output *UpdateWebhookOutput) {
@@ -2975,7 +3275,7 @@ func (c *Amplify) UpdateWebhookRequest(input *UpdateWebhookInput) (req *request.
 
 // UpdateWebhook API operation for AWS Amplify.
 //
-// Update a webhook.
+// Updates a webhook.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2984,23 +3284,22 @@ func (c *Amplify) UpdateWebhookRequest(input *UpdateWebhookInput) (req *request.
 // See the AWS API reference guide for AWS Amplify's
 // API operation UpdateWebhook for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Exception thrown when a request contains unexpected data.
+// Returned Error Types:
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Exception thrown when an operation fails due to a lack of access.
+//   - BadRequestException
+//     A request contains unexpected data.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Exception thrown when an entity has not been found during an operation.
+//   - UnauthorizedException
+//     An operation failed due to a lack of access.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   Exception thrown when the service fails to perform an operation due to an
-//   internal issue.
+//   - NotFoundException
+//     An entity was not found during an operation.
 //
-//   * ErrCodeDependentServiceFailureException "DependentServiceFailureException"
-//   Exception thrown when an operation fails due to a dependent service throwing
-//   an exception.
+//   - InternalFailureException
+//     The service failed to perform an operation due to an internal issue.
+//
+//   - DependentServiceFailureException
+//     An operation failed because a dependent service threw an exception.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25/UpdateWebhook
 func (c *Amplify) UpdateWebhook(input *UpdateWebhookInput) (*UpdateWebhookOutput, error) {
@@ -3024,105 +3323,144 @@ func (c *Amplify) UpdateWebhookWithContext(ctx aws.Context, input *UpdateWebhook
 	return out, req.Send()
 }
 
-// Amplify App represents different branches of a repository for building, deploying,
-// and hosting.
+// Represents the different branches of a repository for building, deploying,
+// and hosting an Amplify app.
 type App struct {
 	_ struct{} `type:"structure"`
 
-	// ARN for the Amplify App.
+	// The Amazon Resource Name (ARN) of the Amplify app.
 	//
 	// AppArn is a required field
 	AppArn *string `locationName:"appArn" type:"string" required:"true"`
 
-	// Unique Id for the Amplify App.
+	// The unique ID of the Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `locationName:"appId" min:"1" type:"string" required:"true"`
 
-	// Automated branch creation config for the Amplify App.
+	// Describes the automated branch creation configuration for the Amplify app.
 	AutoBranchCreationConfig *AutoBranchCreationConfig `locationName:"autoBranchCreationConfig" type:"structure"`
 
-	// Automated branch creation glob patterns for the Amplify App.
+	// Describes the automated branch creation glob patterns for the Amplify app.
 	AutoBranchCreationPatterns []*string `locationName:"autoBranchCreationPatterns" type:"list"`
 
-	// Basic Authorization credentials for branches for the Amplify App.
-	BasicAuthCredentials *string `locationName:"basicAuthCredentials" type:"string"`
+	// The basic authorization credentials for branches for the Amplify app. You
+	// must base64-encode the authorization credentials and provide them in the
+	// format user:password.
+	//
+	// BasicAuthCredentials is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by App's
+	// String and GoString methods.
+	BasicAuthCredentials *string `locationName:"basicAuthCredentials" type:"string" sensitive:"true"`
 
-	// BuildSpec content for Amplify App.
-	BuildSpec *string `locationName:"buildSpec" min:"1" type:"string"`
+	// Describes the content of the build specification (build spec) for the Amplify
+	// app.
+	//
+	// BuildSpec is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by App's
+	// String and GoString methods.
+	BuildSpec *string `locationName:"buildSpec" min:"1" type:"string" sensitive:"true"`
 
-	// Create date / time for the Amplify App.
+	// Creates a date and time for the Amplify app.
 	//
 	// CreateTime is a required field
 	CreateTime *time.Time `locationName:"createTime" type:"timestamp" required:"true"`
 
-	// Custom redirect / rewrite rules for the Amplify App.
+	// Describes the custom HTTP headers for the Amplify app.
+	CustomHeaders *string `locationName:"customHeaders" type:"string"`
+
+	// Describes the custom redirect and rewrite rules for the Amplify app.
 	CustomRules []*CustomRule `locationName:"customRules" type:"list"`
 
-	// Default domain for the Amplify App.
+	// The default domain for the Amplify app.
 	//
 	// DefaultDomain is a required field
 	DefaultDomain *string `locationName:"defaultDomain" min:"1" type:"string" required:"true"`
 
-	// Description for the Amplify App.
+	// The description for the Amplify app.
 	//
 	// Description is a required field
 	Description *string `locationName:"description" type:"string" required:"true"`
 
-	// Enables automated branch creation for the Amplify App.
+	// Enables automated branch creation for the Amplify app.
 	EnableAutoBranchCreation *bool `locationName:"enableAutoBranchCreation" type:"boolean"`
 
-	// Enables Basic Authorization for branches for the Amplify App.
+	// Enables basic authorization for the Amplify app's branches.
 	//
 	// EnableBasicAuth is a required field
 	EnableBasicAuth *bool `locationName:"enableBasicAuth" type:"boolean" required:"true"`
 
-	// Enables auto-building of branches for the Amplify App.
+	// Enables the auto-building of branches for the Amplify app.
 	//
 	// EnableBranchAutoBuild is a required field
 	EnableBranchAutoBuild *bool `locationName:"enableBranchAutoBuild" type:"boolean" required:"true"`
 
-	// Environment Variables for the Amplify App.
+	// Automatically disconnect a branch in the Amplify Console when you delete
+	// a branch from your Git repository.
+	EnableBranchAutoDeletion *bool `locationName:"enableBranchAutoDeletion" type:"boolean"`
+
+	// The environment variables for the Amplify app.
 	//
 	// EnvironmentVariables is a required field
 	EnvironmentVariables map[string]*string `locationName:"environmentVariables" type:"map" required:"true"`
 
-	// IAM service role ARN for the Amplify App.
-	IamServiceRoleArn *string `locationName:"iamServiceRoleArn" min:"1" type:"string"`
+	// The AWS Identity and Access Management (IAM) service role for the Amazon
+	// Resource Name (ARN) of the Amplify app.
+	IamServiceRoleArn *string `locationName:"iamServiceRoleArn" type:"string"`
 
-	// Name for the Amplify App.
+	// The name for the Amplify app.
 	//
 	// Name is a required field
 	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
 
-	// Platform for the Amplify App.
+	// The platform for the Amplify app. For a static app, set the platform type
+	// to WEB. For a dynamic server-side rendered (SSR) app, set the platform type
+	// to WEB_COMPUTE. For an app requiring Amplify Hosting's original SSR support
+	// only, set the platform type to WEB_DYNAMIC.
 	//
 	// Platform is a required field
 	Platform *string `locationName:"platform" type:"string" required:"true" enum:"Platform"`
 
-	// Structure with Production Branch information.
+	// Describes the information about a production branch of the Amplify app.
 	ProductionBranch *ProductionBranch `locationName:"productionBranch" type:"structure"`
 
-	// Repository for the Amplify App.
+	// The Git repository for the Amplify app.
 	//
 	// Repository is a required field
 	Repository *string `locationName:"repository" type:"string" required:"true"`
 
-	// Tag for Amplify App.
-	Tags map[string]*string `locationName:"tags" min:"1" type:"map"`
+	//
+	// This is for internal use.
+	//
+	// The Amplify service uses this parameter to specify the authentication protocol
+	// to use to access the Git repository for an Amplify app. Amplify specifies
+	// TOKEN for a GitHub repository, SIGV4 for an Amazon Web Services CodeCommit
+	// repository, and SSH for GitLab and Bitbucket repositories.
+	RepositoryCloneMethod *string `locationName:"repositoryCloneMethod" type:"string" enum:"RepositoryCloneMethod"`
+
+	// The tag for the Amplify app.
+	Tags map[string]*string `locationName:"tags" type:"map"`
 
-	// Update date / time for the Amplify App.
+	// Updates the date and time for the Amplify app.
 	//
 	// UpdateTime is a required field
 	UpdateTime *time.Time `locationName:"updateTime" type:"timestamp" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s App) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s App) GoString() string {
 	return s.String()
 }
@@ -3169,6 +3507,12 @@ func (s *App) SetCreateTime(v time.Time) *App {
 	return s
 }
 
+// SetCustomHeaders sets the CustomHeaders field's value.
+func (s *App) SetCustomHeaders(v string) *App {
+	s.CustomHeaders = &v
+	return s
+}
+
 // SetCustomRules sets the CustomRules field's value.
 func (s *App) SetCustomRules(v []*CustomRule) *App {
 	s.CustomRules = v
@@ -3205,6 +3549,12 @@ func (s *App) SetEnableBranchAutoBuild(v bool) *App {
 	return s
 }
 
+// SetEnableBranchAutoDeletion sets the EnableBranchAutoDeletion field's value.
+func (s *App) SetEnableBranchAutoDeletion(v bool) *App {
+	s.EnableBranchAutoDeletion = &v
+	return s
+}
+
 // SetEnvironmentVariables sets the EnvironmentVariables field's value.
 func (s *App) SetEnvironmentVariables(v map[string]*string) *App {
 	s.EnvironmentVariables = v
@@ -3241,6 +3591,12 @@ func (s *App) SetRepository(v string) *App {
 	return s
 }
 
+// SetRepositoryCloneMethod sets the RepositoryCloneMethod field's value.
+func (s *App) SetRepositoryCloneMethod(v string) *App {
+	s.RepositoryCloneMethod = &v
+	return s
+}
+
 // SetTags sets the Tags field's value.
 func (s *App) SetTags(v map[string]*string) *App {
 	s.Tags = v
@@ -3253,27 +3609,35 @@ func (s *App) SetUpdateTime(v time.Time) *App {
 	return s
 }
 
-// Structure for artifact.
+// Describes an artifact.
 type Artifact struct {
 	_ struct{} `type:"structure"`
 
-	// File name for the artifact.
+	// The file name for the artifact.
 	//
 	// ArtifactFileName is a required field
 	ArtifactFileName *string `locationName:"artifactFileName" type:"string" required:"true"`
 
-	// Unique Id for a artifact.
+	// The unique ID for the artifact.
 	//
 	// ArtifactId is a required field
 	ArtifactId *string `locationName:"artifactId" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Artifact) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Artifact) GoString() string {
 	return s.String()
 }
@@ -3290,41 +3654,69 @@ func (s *Artifact) SetArtifactId(v string) *Artifact {
 	return s
 }
 
-// Structure with auto branch creation config.
+// Describes the automated branch creation configuration.
 type AutoBranchCreationConfig struct {
 	_ struct{} `type:"structure"`
 
-	// Basic Authorization credentials for the auto created branch.
-	BasicAuthCredentials *string `locationName:"basicAuthCredentials" type:"string"`
+	// The basic authorization credentials for the autocreated branch. You must
+	// base64-encode the authorization credentials and provide them in the format
+	// user:password.
+	//
+	// BasicAuthCredentials is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by AutoBranchCreationConfig's
+	// String and GoString methods.
+	BasicAuthCredentials *string `locationName:"basicAuthCredentials" type:"string" sensitive:"true"`
 
-	// BuildSpec for the auto created branch.
-	BuildSpec *string `locationName:"buildSpec" min:"1" type:"string"`
+	// The build specification (build spec) for the autocreated branch.
+	//
+	// BuildSpec is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by AutoBranchCreationConfig's
+	// String and GoString methods.
+	BuildSpec *string `locationName:"buildSpec" min:"1" type:"string" sensitive:"true"`
 
-	// Enables auto building for the auto created branch.
+	// Enables auto building for the autocreated branch.
 	EnableAutoBuild *bool `locationName:"enableAutoBuild" type:"boolean"`
 
-	// Enables Basic Auth for the auto created branch.
+	// Enables basic authorization for the autocreated branch.
 	EnableBasicAuth *bool `locationName:"enableBasicAuth" type:"boolean"`
 
-	// Enables Pull Request Preview for auto created branch.
+	// Enables performance mode for the branch.
+	//
+	// Performance mode optimizes for faster hosting performance by keeping content
+	// cached at the edge for a longer interval. When performance mode is enabled,
+	// hosting configuration or code changes can take up to 10 minutes to roll out.
+	EnablePerformanceMode *bool `locationName:"enablePerformanceMode" type:"boolean"`
+
+	// Enables pull request previews for the autocreated branch.
 	EnablePullRequestPreview *bool `locationName:"enablePullRequestPreview" type:"boolean"`
 
-	// Environment Variables for the auto created branch.
+	// The environment variables for the autocreated branch.
 	EnvironmentVariables map[string]*string `locationName:"environmentVariables" type:"map"`
 
-	// Framework for the auto created branch.
+	// The framework for the autocreated branch.
 	Framework *string `locationName:"framework" type:"string"`
 
-	// Stage for the auto created branch.
+	// The Amplify environment name for the pull request.
+	PullRequestEnvironmentName *string `locationName:"pullRequestEnvironmentName" type:"string"`
+
+	// Describes the current stage for the autocreated branch.
 	Stage *string `locationName:"stage" type:"string" enum:"Stage"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AutoBranchCreationConfig) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AutoBranchCreationConfig) GoString() string {
 	return s.String()
 }
@@ -3366,6 +3758,12 @@ func (s *AutoBranchCreationConfig) SetEnableBasicAuth(v bool) *AutoBranchCreatio
 	return s
 }
 
+// SetEnablePerformanceMode sets the EnablePerformanceMode field's value.
+func (s *AutoBranchCreationConfig) SetEnablePerformanceMode(v bool) *AutoBranchCreationConfig {
+	s.EnablePerformanceMode = &v
+	return s
+}
+
 // SetEnablePullRequestPreview sets the EnablePullRequestPreview field's value.
 func (s *AutoBranchCreationConfig) SetEnablePullRequestPreview(v bool) *AutoBranchCreationConfig {
 	s.EnablePullRequestPreview = &v
@@ -3384,51 +3782,224 @@ func (s *AutoBranchCreationConfig) SetFramework(v string) *AutoBranchCreationCon
 	return s
 }
 
+// SetPullRequestEnvironmentName sets the PullRequestEnvironmentName field's value.
+func (s *AutoBranchCreationConfig) SetPullRequestEnvironmentName(v string) *AutoBranchCreationConfig {
+	s.PullRequestEnvironmentName = &v
+	return s
+}
+
 // SetStage sets the Stage field's value.
 func (s *AutoBranchCreationConfig) SetStage(v string) *AutoBranchCreationConfig {
 	s.Stage = &v
 	return s
 }
 
-// Branch for an Amplify App, which maps to a 3rd party repository branch.
+// Describes the backend environment for an Amplify app.
+type BackendEnvironment struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) for a backend environment that is part of
+	// an Amplify app.
+	//
+	// BackendEnvironmentArn is a required field
+	BackendEnvironmentArn *string `locationName:"backendEnvironmentArn" type:"string" required:"true"`
+
+	// The creation date and time for a backend environment that is part of an Amplify
+	// app.
+	//
+	// CreateTime is a required field
+	CreateTime *time.Time `locationName:"createTime" type:"timestamp" required:"true"`
+
+	// The name of deployment artifacts.
+	DeploymentArtifacts *string `locationName:"deploymentArtifacts" min:"1" type:"string"`
+
+	// The name for a backend environment that is part of an Amplify app.
+	//
+	// EnvironmentName is a required field
+	EnvironmentName *string `locationName:"environmentName" min:"1" type:"string" required:"true"`
+
+	// The AWS CloudFormation stack name of a backend environment.
+	StackName *string `locationName:"stackName" min:"1" type:"string"`
+
+	// The last updated date and time for a backend environment that is part of
+	// an Amplify app.
+	//
+	// UpdateTime is a required field
+	UpdateTime *time.Time `locationName:"updateTime" type:"timestamp" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BackendEnvironment) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BackendEnvironment) GoString() string {
+	return s.String()
+}
+
+// SetBackendEnvironmentArn sets the BackendEnvironmentArn field's value.
+func (s *BackendEnvironment) SetBackendEnvironmentArn(v string) *BackendEnvironment {
+	s.BackendEnvironmentArn = &v
+	return s
+}
+
+// SetCreateTime sets the CreateTime field's value.
+func (s *BackendEnvironment) SetCreateTime(v time.Time) *BackendEnvironment {
+	s.CreateTime = &v
+	return s
+}
+
+// SetDeploymentArtifacts sets the DeploymentArtifacts field's value.
+func (s *BackendEnvironment) SetDeploymentArtifacts(v string) *BackendEnvironment {
+	s.DeploymentArtifacts = &v
+	return s
+}
+
+// SetEnvironmentName sets the EnvironmentName field's value.
+func (s *BackendEnvironment) SetEnvironmentName(v string) *BackendEnvironment {
+	s.EnvironmentName = &v
+	return s
+}
+
+// SetStackName sets the StackName field's value.
+func (s *BackendEnvironment) SetStackName(v string) *BackendEnvironment {
+	s.StackName = &v
+	return s
+}
+
+// SetUpdateTime sets the UpdateTime field's value.
+func (s *BackendEnvironment) SetUpdateTime(v time.Time) *BackendEnvironment {
+	s.UpdateTime = &v
+	return s
+}
+
+// A request contains unexpected data.
+type BadRequestException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BadRequestException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BadRequestException) GoString() string {
+	return s.String()
+}
+
+func newErrorBadRequestException(v protocol.ResponseMetadata) error {
+	return &BadRequestException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *BadRequestException) Code() string {
+	return "BadRequestException"
+}
+
+// Message returns the exception's message.
+func (s *BadRequestException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *BadRequestException) OrigErr() error {
+	return nil
+}
+
+func (s *BadRequestException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *BadRequestException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *BadRequestException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The branch for an Amplify app, which maps to a third-party repository branch.
 type Branch struct {
 	_ struct{} `type:"structure"`
 
-	// Id of the active job for a branch, part of an Amplify App.
+	// The ID of the active job for a branch of an Amplify app.
 	//
 	// ActiveJobId is a required field
 	ActiveJobId *string `locationName:"activeJobId" type:"string" required:"true"`
 
-	// List of custom resources that are linked to this branch.
+	// A list of custom resources that are linked to this branch.
 	AssociatedResources []*string `locationName:"associatedResources" type:"list"`
 
-	// Basic Authorization credentials for a branch, part of an Amplify App.
-	BasicAuthCredentials *string `locationName:"basicAuthCredentials" type:"string"`
+	// The Amazon Resource Name (ARN) for a backend environment that is part of
+	// an Amplify app.
+	BackendEnvironmentArn *string `locationName:"backendEnvironmentArn" type:"string"`
+
+	// The basic authorization credentials for a branch of an Amplify app. You must
+	// base64-encode the authorization credentials and provide them in the format
+	// user:password.
+	//
+	// BasicAuthCredentials is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by Branch's
+	// String and GoString methods.
+	BasicAuthCredentials *string `locationName:"basicAuthCredentials" type:"string" sensitive:"true"`
 
-	// ARN for a branch, part of an Amplify App.
+	// The Amazon Resource Name (ARN) for a branch that is part of an Amplify app.
 	//
 	// BranchArn is a required field
 	BranchArn *string `locationName:"branchArn" type:"string" required:"true"`
 
-	// Name for a branch, part of an Amplify App.
+	// The name for the branch that is part of an Amplify app.
 	//
 	// BranchName is a required field
 	BranchName *string `locationName:"branchName" min:"1" type:"string" required:"true"`
 
-	// BuildSpec content for branch for Amplify App.
-	BuildSpec *string `locationName:"buildSpec" min:"1" type:"string"`
+	// The build specification (build spec) content for the branch of an Amplify
+	// app.
+	//
+	// BuildSpec is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by Branch's
+	// String and GoString methods.
+	BuildSpec *string `locationName:"buildSpec" min:"1" type:"string" sensitive:"true"`
 
-	// Creation date and time for a branch, part of an Amplify App.
+	// The creation date and time for a branch that is part of an Amplify app.
 	//
 	// CreateTime is a required field
 	CreateTime *time.Time `locationName:"createTime" type:"timestamp" required:"true"`
 
-	// Custom domains for a branch, part of an Amplify App.
+	// The custom domains for a branch of an Amplify app.
 	//
 	// CustomDomains is a required field
 	CustomDomains []*string `locationName:"customDomains" type:"list" required:"true"`
 
-	// Description for a branch, part of an Amplify App.
+	// The description for the branch that is part of an Amplify app.
 	//
 	// Description is a required field
 	Description *string `locationName:"description" type:"string" required:"true"`
@@ -3436,77 +4007,95 @@ type Branch struct {
 	// The destination branch if the branch is a pull request branch.
 	DestinationBranch *string `locationName:"destinationBranch" min:"1" type:"string"`
 
-	// Display name for a branch, will use as the default domain prefix.
+	// The display name for the branch. This is used as the default domain prefix.
 	//
 	// DisplayName is a required field
 	DisplayName *string `locationName:"displayName" type:"string" required:"true"`
 
-	// Enables auto-building on push for a branch, part of an Amplify App.
+	// Enables auto-building on push for a branch of an Amplify app.
 	//
 	// EnableAutoBuild is a required field
 	EnableAutoBuild *bool `locationName:"enableAutoBuild" type:"boolean" required:"true"`
 
-	// Enables Basic Authorization for a branch, part of an Amplify App.
+	// Enables basic authorization for a branch of an Amplify app.
 	//
 	// EnableBasicAuth is a required field
 	EnableBasicAuth *bool `locationName:"enableBasicAuth" type:"boolean" required:"true"`
 
-	// Enables notifications for a branch, part of an Amplify App.
+	// Enables notifications for a branch that is part of an Amplify app.
 	//
 	// EnableNotification is a required field
 	EnableNotification *bool `locationName:"enableNotification" type:"boolean" required:"true"`
 
-	// Enables Pull Request Preview for this branch.
+	// Enables performance mode for the branch.
+	//
+	// Performance mode optimizes for faster hosting performance by keeping content
+	// cached at the edge for a longer interval. When performance mode is enabled,
+	// hosting configuration or code changes can take up to 10 minutes to roll out.
+	EnablePerformanceMode *bool `locationName:"enablePerformanceMode" type:"boolean"`
+
+	// Enables pull request previews for the branch.
 	//
 	// EnablePullRequestPreview is a required field
 	EnablePullRequestPreview *bool `locationName:"enablePullRequestPreview" type:"boolean" required:"true"`
 
-	// Environment Variables specific to a branch, part of an Amplify App.
+	// The environment variables specific to a branch of an Amplify app.
 	//
 	// EnvironmentVariables is a required field
 	EnvironmentVariables map[string]*string `locationName:"environmentVariables" type:"map" required:"true"`
 
-	// Framework for a branch, part of an Amplify App.
+	// The framework for a branch of an Amplify app.
 	//
 	// Framework is a required field
 	Framework *string `locationName:"framework" type:"string" required:"true"`
 
+	// The Amplify environment name for the pull request.
+	PullRequestEnvironmentName *string `locationName:"pullRequestEnvironmentName" type:"string"`
+
 	// The source branch if the branch is a pull request branch.
 	SourceBranch *string `locationName:"sourceBranch" min:"1" type:"string"`
 
-	// Stage for a branch, part of an Amplify App.
+	// The current stage for the branch that is part of an Amplify app.
 	//
 	// Stage is a required field
 	Stage *string `locationName:"stage" type:"string" required:"true" enum:"Stage"`
 
-	// Tag for branch for Amplify App.
-	Tags map[string]*string `locationName:"tags" min:"1" type:"map"`
+	// The tag for the branch of an Amplify app.
+	Tags map[string]*string `locationName:"tags" type:"map"`
 
-	// Thumbnail URL for the branch.
+	// The thumbnail URL for the branch of an Amplify app.
 	ThumbnailUrl *string `locationName:"thumbnailUrl" min:"1" type:"string"`
 
-	// Total number of Jobs part of an Amplify App.
+	// The total number of jobs that are part of an Amplify app.
 	//
 	// TotalNumberOfJobs is a required field
 	TotalNumberOfJobs *string `locationName:"totalNumberOfJobs" type:"string" required:"true"`
 
-	// The content TTL for the website in seconds.
+	// The content Time to Live (TTL) for the website in seconds.
 	//
 	// Ttl is a required field
 	Ttl *string `locationName:"ttl" type:"string" required:"true"`
 
-	// Last updated date and time for a branch, part of an Amplify App.
+	// The last updated date and time for a branch that is part of an Amplify app.
 	//
 	// UpdateTime is a required field
 	UpdateTime *time.Time `locationName:"updateTime" type:"timestamp" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Branch) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Branch) GoString() string {
 	return s.String()
 }
@@ -3523,6 +4112,12 @@ func (s *Branch) SetAssociatedResources(v []*string) *Branch {
 	return s
 }
 
+// SetBackendEnvironmentArn sets the BackendEnvironmentArn field's value.
+func (s *Branch) SetBackendEnvironmentArn(v string) *Branch {
+	s.BackendEnvironmentArn = &v
+	return s
+}
+
 // SetBasicAuthCredentials sets the BasicAuthCredentials field's value.
 func (s *Branch) SetBasicAuthCredentials(v string) *Branch {
 	s.BasicAuthCredentials = &v
@@ -3595,6 +4190,12 @@ func (s *Branch) SetEnableNotification(v bool) *Branch {
 	return s
 }
 
+// SetEnablePerformanceMode sets the EnablePerformanceMode field's value.
+func (s *Branch) SetEnablePerformanceMode(v bool) *Branch {
+	s.EnablePerformanceMode = &v
+	return s
+}
+
 // SetEnablePullRequestPreview sets the EnablePullRequestPreview field's value.
 func (s *Branch) SetEnablePullRequestPreview(v bool) *Branch {
 	s.EnablePullRequestPreview = &v
@@ -3613,6 +4214,12 @@ func (s *Branch) SetFramework(v string) *Branch {
 	return s
 }
 
+// SetPullRequestEnvironmentName sets the PullRequestEnvironmentName field's value.
+func (s *Branch) SetPullRequestEnvironmentName(v string) *Branch {
+	s.PullRequestEnvironmentName = &v
+	return s
+}
+
 // SetSourceBranch sets the SourceBranch field's value.
 func (s *Branch) SetSourceBranch(v string) *Branch {
 	s.SourceBranch = &v
@@ -3655,73 +4262,134 @@ func (s *Branch) SetUpdateTime(v time.Time) *Branch {
 	return s
 }
 
-// Request structure used to create Apps in Amplify.
+// The request structure used to create apps in Amplify.
 type CreateAppInput struct {
 	_ struct{} `type:"structure"`
 
-	// Personal Access token for 3rd party source control system for an Amplify
-	// App, used to create webhook and read-only deploy key. Token is not stored.
-	AccessToken *string `locationName:"accessToken" min:"1" type:"string"`
+	// The personal access token for a GitHub repository for an Amplify app. The
+	// personal access token is used to authorize access to a GitHub repository
+	// using the Amplify GitHub App. The token is not stored.
+	//
+	// Use accessToken for GitHub repositories only. To authorize access to a repository
+	// provider such as Bitbucket or CodeCommit, use oauthToken.
+	//
+	// You must specify either accessToken or oauthToken when you create a new app.
+	//
+	// Existing Amplify apps deployed from a GitHub repository using OAuth continue
+	// to work with CI/CD. However, we strongly recommend that you migrate these
+	// apps to use the GitHub App. For more information, see Migrating an existing
+	// OAuth app to the Amplify GitHub App (https://docs.aws.amazon.com/amplify/latest/UserGuide/setting-up-GitHub-access.html#migrating-to-github-app-auth)
+	// in the Amplify User Guide .
+	//
+	// AccessToken is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by CreateAppInput's
+	// String and GoString methods.
+	AccessToken *string `locationName:"accessToken" min:"1" type:"string" sensitive:"true"`
 
-	// Automated branch creation config for the Amplify App.
+	// The automated branch creation configuration for an Amplify app.
 	AutoBranchCreationConfig *AutoBranchCreationConfig `locationName:"autoBranchCreationConfig" type:"structure"`
 
-	// Automated branch creation glob patterns for the Amplify App.
+	// The automated branch creation glob patterns for an Amplify app.
 	AutoBranchCreationPatterns []*string `locationName:"autoBranchCreationPatterns" type:"list"`
 
-	// Credentials for Basic Authorization for an Amplify App.
-	BasicAuthCredentials *string `locationName:"basicAuthCredentials" type:"string"`
+	// The credentials for basic authorization for an Amplify app. You must base64-encode
+	// the authorization credentials and provide them in the format user:password.
+	//
+	// BasicAuthCredentials is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by CreateAppInput's
+	// String and GoString methods.
+	BasicAuthCredentials *string `locationName:"basicAuthCredentials" type:"string" sensitive:"true"`
+
+	// The build specification (build spec) for an Amplify app.
+	//
+	// BuildSpec is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by CreateAppInput's
+	// String and GoString methods.
+	BuildSpec *string `locationName:"buildSpec" min:"1" type:"string" sensitive:"true"`
 
-	// BuildSpec for an Amplify App
-	BuildSpec *string `locationName:"buildSpec" min:"1" type:"string"`
+	// The custom HTTP headers for an Amplify app.
+	CustomHeaders *string `locationName:"customHeaders" type:"string"`
 
-	// Custom rewrite / redirect rules for an Amplify App.
+	// The custom rewrite and redirect rules for an Amplify app.
 	CustomRules []*CustomRule `locationName:"customRules" type:"list"`
 
-	// Description for an Amplify App
+	// The description for an Amplify app.
 	Description *string `locationName:"description" type:"string"`
 
-	// Enables automated branch creation for the Amplify App.
+	// Enables automated branch creation for an Amplify app.
 	EnableAutoBranchCreation *bool `locationName:"enableAutoBranchCreation" type:"boolean"`
 
-	// Enable Basic Authorization for an Amplify App, this will apply to all branches
-	// part of this App.
+	// Enables basic authorization for an Amplify app. This will apply to all branches
+	// that are part of this app.
 	EnableBasicAuth *bool `locationName:"enableBasicAuth" type:"boolean"`
 
-	// Enable the auto building of branches for an Amplify App.
+	// Enables the auto building of branches for an Amplify app.
 	EnableBranchAutoBuild *bool `locationName:"enableBranchAutoBuild" type:"boolean"`
 
-	// Environment variables map for an Amplify App.
+	// Automatically disconnects a branch in the Amplify Console when you delete
+	// a branch from your Git repository.
+	EnableBranchAutoDeletion *bool `locationName:"enableBranchAutoDeletion" type:"boolean"`
+
+	// The environment variables map for an Amplify app.
 	EnvironmentVariables map[string]*string `locationName:"environmentVariables" type:"map"`
 
-	// AWS IAM service role for an Amplify App
-	IamServiceRoleArn *string `locationName:"iamServiceRoleArn" min:"1" type:"string"`
+	// The AWS Identity and Access Management (IAM) service role for an Amplify
+	// app.
+	IamServiceRoleArn *string `locationName:"iamServiceRoleArn" type:"string"`
 
-	// Name for the Amplify App
+	// The name for an Amplify app.
 	//
 	// Name is a required field
 	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
 
-	// OAuth token for 3rd party source control system for an Amplify App, used
-	// to create webhook and read-only deploy key. OAuth token is not stored.
-	OauthToken *string `locationName:"oauthToken" type:"string"`
+	// The OAuth token for a third-party source control system for an Amplify app.
+	// The OAuth token is used to create a webhook and a read-only deploy key using
+	// SSH cloning. The OAuth token is not stored.
+	//
+	// Use oauthToken for repository providers other than GitHub, such as Bitbucket
+	// or CodeCommit. To authorize access to GitHub as your repository provider,
+	// use accessToken.
+	//
+	// You must specify either oauthToken or accessToken when you create a new app.
+	//
+	// Existing Amplify apps deployed from a GitHub repository using OAuth continue
+	// to work with CI/CD. However, we strongly recommend that you migrate these
+	// apps to use the GitHub App. For more information, see Migrating an existing
+	// OAuth app to the Amplify GitHub App (https://docs.aws.amazon.com/amplify/latest/UserGuide/setting-up-GitHub-access.html#migrating-to-github-app-auth)
+	// in the Amplify User Guide .
+	//
+	// OauthToken is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by CreateAppInput's
+	// String and GoString methods.
+	OauthToken *string `locationName:"oauthToken" type:"string" sensitive:"true"`
 
-	// Platform / framework for an Amplify App
+	// The platform for the Amplify app. For a static app, set the platform type
+	// to WEB. For a dynamic server-side rendered (SSR) app, set the platform type
+	// to WEB_COMPUTE. For an app requiring Amplify Hosting's original SSR support
+	// only, set the platform type to WEB_DYNAMIC.
 	Platform *string `locationName:"platform" type:"string" enum:"Platform"`
 
-	// Repository for an Amplify App
+	// The repository for an Amplify app.
 	Repository *string `locationName:"repository" type:"string"`
 
-	// Tag for an Amplify App
-	Tags map[string]*string `locationName:"tags" min:"1" type:"map"`
+	// The tag for an Amplify app.
+	Tags map[string]*string `locationName:"tags" type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateAppInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateAppInput) GoString() string {
 	return s.String()
 }
@@ -3735,18 +4403,12 @@ func (s *CreateAppInput) Validate() error {
 	if s.BuildSpec != nil && len(*s.BuildSpec) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("BuildSpec", 1))
 	}
-	if s.IamServiceRoleArn != nil && len(*s.IamServiceRoleArn) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("IamServiceRoleArn", 1))
-	}
 	if s.Name == nil {
 		invalidParams.Add(request.NewErrParamRequired("Name"))
 	}
 	if s.Name != nil && len(*s.Name) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
 	}
-	if s.Tags != nil && len(s.Tags) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
-	}
 	if s.AutoBranchCreationConfig != nil {
 		if err := s.AutoBranchCreationConfig.Validate(); err != nil {
 			invalidParams.AddNested("AutoBranchCreationConfig", err.(request.ErrInvalidParams))
@@ -3799,6 +4461,12 @@ func (s *CreateAppInput) SetBuildSpec(v string) *CreateAppInput {
 	return s
 }
 
+// SetCustomHeaders sets the CustomHeaders field's value.
+func (s *CreateAppInput) SetCustomHeaders(v string) *CreateAppInput {
+	s.CustomHeaders = &v
+	return s
+}
+
 // SetCustomRules sets the CustomRules field's value.
 func (s *CreateAppInput) SetCustomRules(v []*CustomRule) *CreateAppInput {
 	s.CustomRules = v
@@ -3829,6 +4497,12 @@ func (s *CreateAppInput) SetEnableBranchAutoBuild(v bool) *CreateAppInput {
 	return s
 }
 
+// SetEnableBranchAutoDeletion sets the EnableBranchAutoDeletion field's value.
+func (s *CreateAppInput) SetEnableBranchAutoDeletion(v bool) *CreateAppInput {
+	s.EnableBranchAutoDeletion = &v
+	return s
+}
+
 // SetEnvironmentVariables sets the EnvironmentVariables field's value.
 func (s *CreateAppInput) SetEnvironmentVariables(v map[string]*string) *CreateAppInput {
 	s.EnvironmentVariables = v
@@ -3874,19 +4548,27 @@ func (s *CreateAppInput) SetTags(v map[string]*string) *CreateAppInput {
 type CreateAppOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Amplify App represents different branches of a repository for building, deploying,
-	// and hosting.
+	// Represents the different branches of a repository for building, deploying,
+	// and hosting an Amplify app.
 	//
 	// App is a required field
 	App *App `locationName:"app" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateAppOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateAppOutput) GoString() string {
 	return s.String()
 }
@@ -3897,66 +4579,222 @@ func (s *CreateAppOutput) SetApp(v *App) *CreateAppOutput {
 	return s
 }
 
-// Request structure for a branch create request.
+// The request structure for the backend environment create request.
+type CreateBackendEnvironmentInput struct {
+	_ struct{} `type:"structure"`
+
+	// The unique ID for an Amplify app.
+	//
+	// AppId is a required field
+	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
+
+	// The name of deployment artifacts.
+	DeploymentArtifacts *string `locationName:"deploymentArtifacts" min:"1" type:"string"`
+
+	// The name for the backend environment.
+	//
+	// EnvironmentName is a required field
+	EnvironmentName *string `locationName:"environmentName" min:"1" type:"string" required:"true"`
+
+	// The AWS CloudFormation stack name of a backend environment.
+	StackName *string `locationName:"stackName" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateBackendEnvironmentInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateBackendEnvironmentInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateBackendEnvironmentInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateBackendEnvironmentInput"}
+	if s.AppId == nil {
+		invalidParams.Add(request.NewErrParamRequired("AppId"))
+	}
+	if s.AppId != nil && len(*s.AppId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("AppId", 1))
+	}
+	if s.DeploymentArtifacts != nil && len(*s.DeploymentArtifacts) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("DeploymentArtifacts", 1))
+	}
+	if s.EnvironmentName == nil {
+		invalidParams.Add(request.NewErrParamRequired("EnvironmentName"))
+	}
+	if s.EnvironmentName != nil && len(*s.EnvironmentName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EnvironmentName", 1))
+	}
+	if s.StackName != nil && len(*s.StackName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("StackName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAppId sets the AppId field's value.
+func (s *CreateBackendEnvironmentInput) SetAppId(v string) *CreateBackendEnvironmentInput {
+	s.AppId = &v
+	return s
+}
+
+// SetDeploymentArtifacts sets the DeploymentArtifacts field's value.
+func (s *CreateBackendEnvironmentInput) SetDeploymentArtifacts(v string) *CreateBackendEnvironmentInput {
+	s.DeploymentArtifacts = &v
+	return s
+}
+
+// SetEnvironmentName sets the EnvironmentName field's value.
+func (s *CreateBackendEnvironmentInput) SetEnvironmentName(v string) *CreateBackendEnvironmentInput {
+	s.EnvironmentName = &v
+	return s
+}
+
+// SetStackName sets the StackName field's value.
+func (s *CreateBackendEnvironmentInput) SetStackName(v string) *CreateBackendEnvironmentInput {
+	s.StackName = &v
+	return s
+}
+
+// The result structure for the create backend environment request.
+type CreateBackendEnvironmentOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Describes the backend environment for an Amplify app.
+	//
+	// BackendEnvironment is a required field
+	BackendEnvironment *BackendEnvironment `locationName:"backendEnvironment" type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateBackendEnvironmentOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateBackendEnvironmentOutput) GoString() string {
+	return s.String()
+}
+
+// SetBackendEnvironment sets the BackendEnvironment field's value.
+func (s *CreateBackendEnvironmentOutput) SetBackendEnvironment(v *BackendEnvironment) *CreateBackendEnvironmentOutput {
+	s.BackendEnvironment = v
+	return s
+}
+
+// The request structure for the create branch request.
 type CreateBranchInput struct {
 	_ struct{} `type:"structure"`
 
-	// Unique Id for an Amplify App.
+	// The unique ID for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 
-	// Basic Authorization credentials for the branch.
-	BasicAuthCredentials *string `locationName:"basicAuthCredentials" type:"string"`
+	// The Amazon Resource Name (ARN) for a backend environment that is part of
+	// an Amplify app.
+	BackendEnvironmentArn *string `locationName:"backendEnvironmentArn" type:"string"`
+
+	// The basic authorization credentials for the branch. You must base64-encode
+	// the authorization credentials and provide them in the format user:password.
+	//
+	// BasicAuthCredentials is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by CreateBranchInput's
+	// String and GoString methods.
+	BasicAuthCredentials *string `locationName:"basicAuthCredentials" type:"string" sensitive:"true"`
 
-	// Name for the branch.
+	// The name for the branch.
 	//
 	// BranchName is a required field
 	BranchName *string `locationName:"branchName" min:"1" type:"string" required:"true"`
 
-	// BuildSpec for the branch.
-	BuildSpec *string `locationName:"buildSpec" min:"1" type:"string"`
+	// The build specification (build spec) for the branch.
+	//
+	// BuildSpec is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by CreateBranchInput's
+	// String and GoString methods.
+	BuildSpec *string `locationName:"buildSpec" min:"1" type:"string" sensitive:"true"`
 
-	// Description for the branch.
+	// The description for the branch.
 	Description *string `locationName:"description" type:"string"`
 
-	// Display name for a branch, will use as the default domain prefix.
+	// The display name for a branch. This is used as the default domain prefix.
 	DisplayName *string `locationName:"displayName" type:"string"`
 
 	// Enables auto building for the branch.
 	EnableAutoBuild *bool `locationName:"enableAutoBuild" type:"boolean"`
 
-	// Enables Basic Auth for the branch.
+	// Enables basic authorization for the branch.
 	EnableBasicAuth *bool `locationName:"enableBasicAuth" type:"boolean"`
 
 	// Enables notifications for the branch.
 	EnableNotification *bool `locationName:"enableNotification" type:"boolean"`
 
-	// Enables Pull Request Preview for this branch.
+	// Enables performance mode for the branch.
+	//
+	// Performance mode optimizes for faster hosting performance by keeping content
+	// cached at the edge for a longer interval. When performance mode is enabled,
+	// hosting configuration or code changes can take up to 10 minutes to roll out.
+	EnablePerformanceMode *bool `locationName:"enablePerformanceMode" type:"boolean"`
+
+	// Enables pull request previews for this branch.
 	EnablePullRequestPreview *bool `locationName:"enablePullRequestPreview" type:"boolean"`
 
-	// Environment Variables for the branch.
+	// The environment variables for the branch.
 	EnvironmentVariables map[string]*string `locationName:"environmentVariables" type:"map"`
 
-	// Framework for the branch.
+	// The framework for the branch.
 	Framework *string `locationName:"framework" type:"string"`
 
-	// Stage for the branch.
+	// The Amplify environment name for the pull request.
+	PullRequestEnvironmentName *string `locationName:"pullRequestEnvironmentName" type:"string"`
+
+	// Describes the current stage for the branch.
 	Stage *string `locationName:"stage" type:"string" enum:"Stage"`
 
-	// Tag for the branch.
-	Tags map[string]*string `locationName:"tags" min:"1" type:"map"`
+	// The tag for the branch.
+	Tags map[string]*string `locationName:"tags" type:"map"`
 
-	// The content TTL for the website in seconds.
+	// The content Time To Live (TTL) for the website in seconds.
 	Ttl *string `locationName:"ttl" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateBranchInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateBranchInput) GoString() string {
 	return s.String()
 }
@@ -3979,9 +4817,6 @@ func (s *CreateBranchInput) Validate() error {
 	if s.BuildSpec != nil && len(*s.BuildSpec) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("BuildSpec", 1))
 	}
-	if s.Tags != nil && len(s.Tags) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
-	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -3995,6 +4830,12 @@ func (s *CreateBranchInput) SetAppId(v string) *CreateBranchInput {
 	return s
 }
 
+// SetBackendEnvironmentArn sets the BackendEnvironmentArn field's value.
+func (s *CreateBranchInput) SetBackendEnvironmentArn(v string) *CreateBranchInput {
+	s.BackendEnvironmentArn = &v
+	return s
+}
+
 // SetBasicAuthCredentials sets the BasicAuthCredentials field's value.
 func (s *CreateBranchInput) SetBasicAuthCredentials(v string) *CreateBranchInput {
 	s.BasicAuthCredentials = &v
@@ -4043,6 +4884,12 @@ func (s *CreateBranchInput) SetEnableNotification(v bool) *CreateBranchInput {
 	return s
 }
 
+// SetEnablePerformanceMode sets the EnablePerformanceMode field's value.
+func (s *CreateBranchInput) SetEnablePerformanceMode(v bool) *CreateBranchInput {
+	s.EnablePerformanceMode = &v
+	return s
+}
+
 // SetEnablePullRequestPreview sets the EnablePullRequestPreview field's value.
 func (s *CreateBranchInput) SetEnablePullRequestPreview(v bool) *CreateBranchInput {
 	s.EnablePullRequestPreview = &v
@@ -4061,8 +4908,14 @@ func (s *CreateBranchInput) SetFramework(v string) *CreateBranchInput {
 	return s
 }
 
-// SetStage sets the Stage field's value.
-func (s *CreateBranchInput) SetStage(v string) *CreateBranchInput {
+// SetPullRequestEnvironmentName sets the PullRequestEnvironmentName field's value.
+func (s *CreateBranchInput) SetPullRequestEnvironmentName(v string) *CreateBranchInput {
+	s.PullRequestEnvironmentName = &v
+	return s
+}
+
+// SetStage sets the Stage field's value.
+func (s *CreateBranchInput) SetStage(v string) *CreateBranchInput {
 	s.Stage = &v
 	return s
 }
@@ -4079,22 +4932,31 @@ func (s *CreateBranchInput) SetTtl(v string) *CreateBranchInput {
 	return s
 }
 
-// Result structure for create branch request.
+// The result structure for create branch request.
 type CreateBranchOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Branch structure for an Amplify App.
+	// Describes the branch for an Amplify app, which maps to a third-party repository
+	// branch.
 	//
 	// Branch is a required field
 	Branch *Branch `locationName:"branch" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateBranchOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateBranchOutput) GoString() string {
 	return s.String()
 }
@@ -4105,33 +4967,41 @@ func (s *CreateBranchOutput) SetBranch(v *Branch) *CreateBranchOutput {
 	return s
 }
 
-// Request structure for create a new deployment.
+// The request structure for the create a new deployment request.
 type CreateDeploymentInput struct {
 	_ struct{} `type:"structure"`
 
-	// Unique Id for an Amplify App.
+	// The unique ID for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 
-	// Name for the branch, for the Job.
+	// The name for the branch, for the job.
 	//
 	// BranchName is a required field
 	BranchName *string `location:"uri" locationName:"branchName" min:"1" type:"string" required:"true"`
 
-	// Optional file map that contains file name as the key and file content md5
-	// hash as the value. If this argument is provided, the service will generate
-	// different upload url per file. Otherwise, the service will only generate
-	// a single upload url for the zipped files.
+	// An optional file map that contains the file name as the key and the file
+	// content md5 hash as the value. If this argument is provided, the service
+	// will generate a unique upload URL per file. Otherwise, the service will only
+	// generate a single upload URL for the zipped files.
 	FileMap map[string]*string `locationName:"fileMap" type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateDeploymentInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateDeploymentInput) GoString() string {
 	return s.String()
 }
@@ -4176,31 +5046,40 @@ func (s *CreateDeploymentInput) SetFileMap(v map[string]*string) *CreateDeployme
 	return s
 }
 
-// Result structure for create a new deployment.
+// The result structure for the create a new deployment request.
 type CreateDeploymentOutput struct {
 	_ struct{} `type:"structure"`
 
-	// When the fileMap argument is provided in the request, the fileUploadUrls
-	// will contain a map of file names to upload url.
+	// When the fileMap argument is provided in the request, fileUploadUrls will
+	// contain a map of file names to upload URLs.
 	//
 	// FileUploadUrls is a required field
 	FileUploadUrls map[string]*string `locationName:"fileUploadUrls" type:"map" required:"true"`
 
-	// The jobId for this deployment, will supply to start deployment api.
+	// The job ID for this deployment. will supply to start deployment api.
 	JobId *string `locationName:"jobId" type:"string"`
 
-	// When the fileMap argument is NOT provided. This zipUploadUrl will be returned.
+	// When the fileMap argument is not provided in the request, this zipUploadUrl
+	// is returned.
 	//
 	// ZipUploadUrl is a required field
 	ZipUploadUrl *string `locationName:"zipUploadUrl" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateDeploymentOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateDeploymentOutput) GoString() string {
 	return s.String()
 }
@@ -4223,35 +5102,50 @@ func (s *CreateDeploymentOutput) SetZipUploadUrl(v string) *CreateDeploymentOutp
 	return s
 }
 
-// Request structure for create Domain Association request.
+// The request structure for the create domain association request.
 type CreateDomainAssociationInput struct {
 	_ struct{} `type:"structure"`
 
-	// Unique Id for an Amplify App.
+	// The unique ID for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 
-	// Domain name for the Domain Association.
+	// Sets the branch patterns for automatic subdomain creation.
+	AutoSubDomainCreationPatterns []*string `locationName:"autoSubDomainCreationPatterns" type:"list"`
+
+	// The required AWS Identity and Access Management (IAM) service role for the
+	// Amazon Resource Name (ARN) for automatically creating subdomains.
+	AutoSubDomainIAMRole *string `locationName:"autoSubDomainIAMRole" type:"string"`
+
+	// The domain name for the domain association.
 	//
 	// DomainName is a required field
 	DomainName *string `locationName:"domainName" type:"string" required:"true"`
 
-	// Enables automated creation of Subdomains for branches.
+	// Enables the automated creation of subdomains for branches.
 	EnableAutoSubDomain *bool `locationName:"enableAutoSubDomain" type:"boolean"`
 
-	// Setting structure for the Subdomain.
+	// The setting for the subdomain.
 	//
 	// SubDomainSettings is a required field
 	SubDomainSettings []*SubDomainSetting `locationName:"subDomainSettings" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateDomainAssociationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateDomainAssociationInput) GoString() string {
 	return s.String()
 }
@@ -4294,6 +5188,18 @@ func (s *CreateDomainAssociationInput) SetAppId(v string) *CreateDomainAssociati
 	return s
 }
 
+// SetAutoSubDomainCreationPatterns sets the AutoSubDomainCreationPatterns field's value.
+func (s *CreateDomainAssociationInput) SetAutoSubDomainCreationPatterns(v []*string) *CreateDomainAssociationInput {
+	s.AutoSubDomainCreationPatterns = v
+	return s
+}
+
+// SetAutoSubDomainIAMRole sets the AutoSubDomainIAMRole field's value.
+func (s *CreateDomainAssociationInput) SetAutoSubDomainIAMRole(v string) *CreateDomainAssociationInput {
+	s.AutoSubDomainIAMRole = &v
+	return s
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *CreateDomainAssociationInput) SetDomainName(v string) *CreateDomainAssociationInput {
 	s.DomainName = &v
@@ -4312,22 +5218,31 @@ func (s *CreateDomainAssociationInput) SetSubDomainSettings(v []*SubDomainSettin
 	return s
 }
 
-// Result structure for the create Domain Association request.
+// The result structure for the create domain association request.
 type CreateDomainAssociationOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Domain Association structure.
+	// Describes the structure of a domain association, which associates a custom
+	// domain with an Amplify app.
 	//
 	// DomainAssociation is a required field
 	DomainAssociation *DomainAssociation `locationName:"domainAssociation" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateDomainAssociationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateDomainAssociationOutput) GoString() string {
 	return s.String()
 }
@@ -4338,30 +5253,38 @@ func (s *CreateDomainAssociationOutput) SetDomainAssociation(v *DomainAssociatio
 	return s
 }
 
-// Request structure for create webhook request.
+// The request structure for the create webhook request.
 type CreateWebhookInput struct {
 	_ struct{} `type:"structure"`
 
-	// Unique Id for an Amplify App.
+	// The unique ID for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 
-	// Name for a branch, part of an Amplify App.
+	// The name for a branch that is part of an Amplify app.
 	//
 	// BranchName is a required field
 	BranchName *string `locationName:"branchName" min:"1" type:"string" required:"true"`
 
-	// Description for a webhook.
+	// The description for a webhook.
 	Description *string `locationName:"description" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateWebhookInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateWebhookInput) GoString() string {
 	return s.String()
 }
@@ -4406,22 +5329,30 @@ func (s *CreateWebhookInput) SetDescription(v string) *CreateWebhookInput {
 	return s
 }
 
-// Result structure for the create webhook request.
+// The result structure for the create webhook request.
 type CreateWebhookOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Webhook structure.
+	// Describes a webhook that connects repository events to an Amplify app.
 	//
 	// Webhook is a required field
 	Webhook *Webhook `locationName:"webhook" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateWebhookOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateWebhookOutput) GoString() string {
 	return s.String()
 }
@@ -4432,12 +5363,12 @@ func (s *CreateWebhookOutput) SetWebhook(v *Webhook) *CreateWebhookOutput {
 	return s
 }
 
-// Custom rewrite / redirect rule.
+// Describes a custom rewrite or redirect rule.
 type CustomRule struct {
 	_ struct{} `type:"structure"`
 
-	// The condition for a URL rewrite or redirect rule, e.g. country code.
-	Condition *string `locationName:"condition" min:"1" type:"string"`
+	// The condition for a URL rewrite or redirect rule, such as a country code.
+	Condition *string `locationName:"condition" type:"string"`
 
 	// The source pattern for a URL rewrite or redirect rule.
 	//
@@ -4445,6 +5376,27 @@ type CustomRule struct {
 	Source *string `locationName:"source" min:"1" type:"string" required:"true"`
 
 	// The status code for a URL rewrite or redirect rule.
+	//
+	// 200
+	//
+	// Represents a 200 rewrite rule.
+	//
+	// 301
+	//
+	// Represents a 301 (moved pemanently) redirect rule. This and all future requests
+	// should be directed to the target URL.
+	//
+	// 302
+	//
+	// Represents a 302 temporary redirect rule.
+	//
+	// 404
+	//
+	// Represents a 404 redirect rule.
+	//
+	// 404-200
+	//
+	// Represents a 404 rewrite rule.
 	Status *string `locationName:"status" min:"3" type:"string"`
 
 	// The target pattern for a URL rewrite or redirect rule.
@@ -4453,12 +5405,20 @@ type CustomRule struct {
 	Target *string `locationName:"target" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CustomRule) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CustomRule) GoString() string {
 	return s.String()
 }
@@ -4466,9 +5426,6 @@ func (s CustomRule) GoString() string {
 // Validate inspects the fields of the type to determine if they are valid.
 func (s *CustomRule) Validate() error {
 	invalidParams := request.ErrInvalidParams{Context: "CustomRule"}
-	if s.Condition != nil && len(*s.Condition) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Condition", 1))
-	}
 	if s.Source == nil {
 		invalidParams.Add(request.NewErrParamRequired("Source"))
 	}
@@ -4515,22 +5472,30 @@ func (s *CustomRule) SetTarget(v string) *CustomRule {
 	return s
 }
 
-// Request structure for an Amplify App delete request.
+// Describes the request structure for the delete app request.
 type DeleteAppInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// Unique Id for an Amplify App.
+	// The unique ID for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteAppInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteAppInput) GoString() string {
 	return s.String()
 }
@@ -4557,23 +5522,31 @@ func (s *DeleteAppInput) SetAppId(v string) *DeleteAppInput {
 	return s
 }
 
-// Result structure for an Amplify App delete request.
+// The result structure for the delete app request.
 type DeleteAppOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Amplify App represents different branches of a repository for building, deploying,
-	// and hosting.
+	// Represents the different branches of a repository for building, deploying,
+	// and hosting an Amplify app.
 	//
 	// App is a required field
 	App *App `locationName:"app" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteAppOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteAppOutput) GoString() string {
 	return s.String()
 }
@@ -4584,27 +5557,136 @@ func (s *DeleteAppOutput) SetApp(v *App) *DeleteAppOutput {
 	return s
 }
 
-// Request structure for delete branch request.
-type DeleteBranchInput struct {
+// The request structure for the delete backend environment request.
+type DeleteBackendEnvironmentInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
+	// The unique ID of an Amplify app.
+	//
+	// AppId is a required field
+	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
+
+	// The name of a backend environment of an Amplify app.
+	//
+	// EnvironmentName is a required field
+	EnvironmentName *string `location:"uri" locationName:"environmentName" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteBackendEnvironmentInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteBackendEnvironmentInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteBackendEnvironmentInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteBackendEnvironmentInput"}
+	if s.AppId == nil {
+		invalidParams.Add(request.NewErrParamRequired("AppId"))
+	}
+	if s.AppId != nil && len(*s.AppId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("AppId", 1))
+	}
+	if s.EnvironmentName == nil {
+		invalidParams.Add(request.NewErrParamRequired("EnvironmentName"))
+	}
+	if s.EnvironmentName != nil && len(*s.EnvironmentName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EnvironmentName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAppId sets the AppId field's value.
+func (s *DeleteBackendEnvironmentInput) SetAppId(v string) *DeleteBackendEnvironmentInput {
+	s.AppId = &v
+	return s
+}
+
+// SetEnvironmentName sets the EnvironmentName field's value.
+func (s *DeleteBackendEnvironmentInput) SetEnvironmentName(v string) *DeleteBackendEnvironmentInput {
+	s.EnvironmentName = &v
+	return s
+}
+
+// The result structure of the delete backend environment result.
+type DeleteBackendEnvironmentOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Unique Id for an Amplify App.
+	// Describes the backend environment for an Amplify app.
+	//
+	// BackendEnvironment is a required field
+	BackendEnvironment *BackendEnvironment `locationName:"backendEnvironment" type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteBackendEnvironmentOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteBackendEnvironmentOutput) GoString() string {
+	return s.String()
+}
+
+// SetBackendEnvironment sets the BackendEnvironment field's value.
+func (s *DeleteBackendEnvironmentOutput) SetBackendEnvironment(v *BackendEnvironment) *DeleteBackendEnvironmentOutput {
+	s.BackendEnvironment = v
+	return s
+}
+
+// The request structure for the delete branch request.
+type DeleteBranchInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
+	// The unique ID for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 
-	// Name for the branch.
+	// The name for the branch.
 	//
 	// BranchName is a required field
 	BranchName *string `location:"uri" locationName:"branchName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteBranchInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteBranchInput) GoString() string {
 	return s.String()
 }
@@ -4643,22 +5725,30 @@ func (s *DeleteBranchInput) SetBranchName(v string) *DeleteBranchInput {
 	return s
 }
 
-// Result structure for delete branch request.
+// The result structure for the delete branch request.
 type DeleteBranchOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Branch structure for an Amplify App.
+	// The branch for an Amplify app, which maps to a third-party repository branch.
 	//
 	// Branch is a required field
 	Branch *Branch `locationName:"branch" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteBranchOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteBranchOutput) GoString() string {
 	return s.String()
 }
@@ -4669,27 +5759,35 @@ func (s *DeleteBranchOutput) SetBranch(v *Branch) *DeleteBranchOutput {
 	return s
 }
 
-// Request structure for the delete Domain Association request.
+// The request structure for the delete domain association request.
 type DeleteDomainAssociationInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// Unique Id for an Amplify App.
+	// The unique id for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 
-	// Name of the domain.
+	// The name of the domain.
 	//
 	// DomainName is a required field
 	DomainName *string `location:"uri" locationName:"domainName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteDomainAssociationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteDomainAssociationInput) GoString() string {
 	return s.String()
 }
@@ -4731,19 +5829,27 @@ func (s *DeleteDomainAssociationInput) SetDomainName(v string) *DeleteDomainAsso
 type DeleteDomainAssociationOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Structure for Domain Association, which associates a custom domain with an
-	// Amplify App.
+	// Describes a domain association that associates a custom domain with an Amplify
+	// app.
 	//
 	// DomainAssociation is a required field
 	DomainAssociation *DomainAssociation `locationName:"domainAssociation" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteDomainAssociationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteDomainAssociationOutput) GoString() string {
 	return s.String()
 }
@@ -4754,32 +5860,40 @@ func (s *DeleteDomainAssociationOutput) SetDomainAssociation(v *DomainAssociatio
 	return s
 }
 
-// Request structure for delete job request.
+// The request structure for the delete job request.
 type DeleteJobInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// Unique Id for an Amplify App.
+	// The unique ID for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 
-	// Name for the branch, for the Job.
+	// The name for the branch, for the job.
 	//
 	// BranchName is a required field
 	BranchName *string `location:"uri" locationName:"branchName" min:"1" type:"string" required:"true"`
 
-	// Unique Id for the Job.
+	// The unique ID for the job.
 	//
 	// JobId is a required field
 	JobId *string `location:"uri" locationName:"jobId" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteJobInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteJobInput) GoString() string {
 	return s.String()
 }
@@ -4830,22 +5944,30 @@ func (s *DeleteJobInput) SetJobId(v string) *DeleteJobInput {
 	return s
 }
 
-// Result structure for the delete job request.
+// The result structure for the delete job request.
 type DeleteJobOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Structure for the summary of a Job.
+	// Describes the summary for an execution job for an Amplify app.
 	//
 	// JobSummary is a required field
 	JobSummary *JobSummary `locationName:"jobSummary" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteJobOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteJobOutput) GoString() string {
 	return s.String()
 }
@@ -4856,22 +5978,30 @@ func (s *DeleteJobOutput) SetJobSummary(v *JobSummary) *DeleteJobOutput {
 	return s
 }
 
-// Request structure for the delete webhook request.
+// The request structure for the delete webhook request.
 type DeleteWebhookInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// Unique Id for a webhook.
+	// The unique ID for a webhook.
 	//
 	// WebhookId is a required field
 	WebhookId *string `location:"uri" locationName:"webhookId" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteWebhookInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteWebhookInput) GoString() string {
 	return s.String()
 }
@@ -4898,22 +6028,30 @@ func (s *DeleteWebhookInput) SetWebhookId(v string) *DeleteWebhookInput {
 	return s
 }
 
-// Result structure for the delete webhook request.
+// The result structure for the delete webhook request.
 type DeleteWebhookOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Webhook structure.
+	// Describes a webhook that connects repository events to an Amplify app.
 	//
 	// Webhook is a required field
 	Webhook *Webhook `locationName:"webhook" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteWebhookOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteWebhookOutput) GoString() string {
 	return s.String()
 }
@@ -4924,55 +6062,146 @@ func (s *DeleteWebhookOutput) SetWebhook(v *Webhook) *DeleteWebhookOutput {
 	return s
 }
 
-// Structure for Domain Association, which associates a custom domain with an
-// Amplify App.
+// An operation failed because a dependent service threw an exception.
+type DependentServiceFailureException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DependentServiceFailureException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DependentServiceFailureException) GoString() string {
+	return s.String()
+}
+
+func newErrorDependentServiceFailureException(v protocol.ResponseMetadata) error {
+	return &DependentServiceFailureException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *DependentServiceFailureException) Code() string {
+	return "DependentServiceFailureException"
+}
+
+// Message returns the exception's message.
+func (s *DependentServiceFailureException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *DependentServiceFailureException) OrigErr() error {
+	return nil
+}
+
+func (s *DependentServiceFailureException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *DependentServiceFailureException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *DependentServiceFailureException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Describes a domain association that associates a custom domain with an Amplify
+// app.
 type DomainAssociation struct {
 	_ struct{} `type:"structure"`
 
-	// DNS Record for certificate verification.
+	// Sets branch patterns for automatic subdomain creation.
+	AutoSubDomainCreationPatterns []*string `locationName:"autoSubDomainCreationPatterns" type:"list"`
+
+	// The required AWS Identity and Access Management (IAM) service role for the
+	// Amazon Resource Name (ARN) for automatically creating subdomains.
+	AutoSubDomainIAMRole *string `locationName:"autoSubDomainIAMRole" type:"string"`
+
+	// The DNS record for certificate verification.
 	CertificateVerificationDNSRecord *string `locationName:"certificateVerificationDNSRecord" type:"string"`
 
-	// ARN for the Domain Association.
+	// The Amazon Resource Name (ARN) for the domain association.
 	//
 	// DomainAssociationArn is a required field
 	DomainAssociationArn *string `locationName:"domainAssociationArn" type:"string" required:"true"`
 
-	// Name of the domain.
+	// The name of the domain.
 	//
 	// DomainName is a required field
 	DomainName *string `locationName:"domainName" type:"string" required:"true"`
 
-	// Status fo the Domain Association.
+	// The current status of the domain association.
 	//
 	// DomainStatus is a required field
 	DomainStatus *string `locationName:"domainStatus" type:"string" required:"true" enum:"DomainStatus"`
 
-	// Enables automated creation of Subdomains for branches.
+	// Enables the automated creation of subdomains for branches.
 	//
 	// EnableAutoSubDomain is a required field
 	EnableAutoSubDomain *bool `locationName:"enableAutoSubDomain" type:"boolean" required:"true"`
 
-	// Reason for the current status of the Domain Association.
+	// The reason for the current status of the domain association.
 	//
 	// StatusReason is a required field
 	StatusReason *string `locationName:"statusReason" type:"string" required:"true"`
 
-	// Subdomains for the Domain Association.
+	// The subdomains for the domain association.
 	//
 	// SubDomains is a required field
 	SubDomains []*SubDomain `locationName:"subDomains" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DomainAssociation) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DomainAssociation) GoString() string {
 	return s.String()
 }
 
+// SetAutoSubDomainCreationPatterns sets the AutoSubDomainCreationPatterns field's value.
+func (s *DomainAssociation) SetAutoSubDomainCreationPatterns(v []*string) *DomainAssociation {
+	s.AutoSubDomainCreationPatterns = v
+	return s
+}
+
+// SetAutoSubDomainIAMRole sets the AutoSubDomainIAMRole field's value.
+func (s *DomainAssociation) SetAutoSubDomainIAMRole(v string) *DomainAssociation {
+	s.AutoSubDomainIAMRole = &v
+	return s
+}
+
 // SetCertificateVerificationDNSRecord sets the CertificateVerificationDNSRecord field's value.
 func (s *DomainAssociation) SetCertificateVerificationDNSRecord(v string) *DomainAssociation {
 	s.CertificateVerificationDNSRecord = &v
@@ -5015,33 +6244,43 @@ func (s *DomainAssociation) SetSubDomains(v []*SubDomain) *DomainAssociation {
 	return s
 }
 
-// Request structure for the generate access logs request.
+// The request structure for the generate access logs request.
 type GenerateAccessLogsInput struct {
 	_ struct{} `type:"structure"`
 
-	// Unique Id for an Amplify App.
+	// The unique ID for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 
-	// Name of the domain.
+	// The name of the domain.
 	//
 	// DomainName is a required field
 	DomainName *string `locationName:"domainName" type:"string" required:"true"`
 
-	// The time at which the logs should end, inclusive.
+	// The time at which the logs should end. The time range specified is inclusive
+	// of the end time.
 	EndTime *time.Time `locationName:"endTime" type:"timestamp"`
 
-	// The time at which the logs should start, inclusive.
+	// The time at which the logs should start. The time range specified is inclusive
+	// of the start time.
 	StartTime *time.Time `locationName:"startTime" type:"timestamp"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GenerateAccessLogsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GenerateAccessLogsInput) GoString() string {
 	return s.String()
 }
@@ -5089,20 +6328,28 @@ func (s *GenerateAccessLogsInput) SetStartTime(v time.Time) *GenerateAccessLogsI
 	return s
 }
 
-// Result structure for the generate access logs request.
+// The result structure for the generate access logs request.
 type GenerateAccessLogsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Pre-signed URL for the requested access logs.
+	// The pre-signed URL for the requested access logs.
 	LogUrl *string `locationName:"logUrl" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GenerateAccessLogsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GenerateAccessLogsOutput) GoString() string {
 	return s.String()
 }
@@ -5113,22 +6360,30 @@ func (s *GenerateAccessLogsOutput) SetLogUrl(v string) *GenerateAccessLogsOutput
 	return s
 }
 
-// Request structure for get App request.
+// The request structure for the get app request.
 type GetAppInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// Unique Id for an Amplify App.
+	// The unique ID for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetAppInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetAppInput) GoString() string {
 	return s.String()
 }
@@ -5158,19 +6413,27 @@ func (s *GetAppInput) SetAppId(v string) *GetAppInput {
 type GetAppOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Amplify App represents different branches of a repository for building, deploying,
-	// and hosting.
+	// Represents the different branches of a repository for building, deploying,
+	// and hosting an Amplify app.
 	//
 	// App is a required field
 	App *App `locationName:"app" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetAppOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetAppOutput) GoString() string {
 	return s.String()
 }
@@ -5181,22 +6444,30 @@ func (s *GetAppOutput) SetApp(v *App) *GetAppOutput {
 	return s
 }
 
-// Request structure for the get artifact request.
+// Returns the request structure for the get artifact request.
 type GetArtifactUrlInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// Unique Id for a artifact.
+	// The unique ID for an artifact.
 	//
 	// ArtifactId is a required field
 	ArtifactId *string `location:"uri" locationName:"artifactId" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetArtifactUrlInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetArtifactUrlInput) GoString() string {
 	return s.String()
 }
@@ -5223,27 +6494,35 @@ func (s *GetArtifactUrlInput) SetArtifactId(v string) *GetArtifactUrlInput {
 	return s
 }
 
-// Result structure for the get artifact request.
+// Returns the result structure for the get artifact request.
 type GetArtifactUrlOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Unique Id for a artifact.
+	// The unique ID for an artifact.
 	//
 	// ArtifactId is a required field
 	ArtifactId *string `locationName:"artifactId" type:"string" required:"true"`
 
-	// Presigned url for the artifact.
+	// The presigned URL for the artifact.
 	//
 	// ArtifactUrl is a required field
 	ArtifactUrl *string `locationName:"artifactUrl" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetArtifactUrlOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetArtifactUrlOutput) GoString() string {
 	return s.String()
 }
@@ -5260,27 +6539,136 @@ func (s *GetArtifactUrlOutput) SetArtifactUrl(v string) *GetArtifactUrlOutput {
 	return s
 }
 
-// Result structure for get branch request.
-type GetBranchInput struct {
+// The request structure for the get backend environment request.
+type GetBackendEnvironmentInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
+	// The unique id for an Amplify app.
+	//
+	// AppId is a required field
+	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
+
+	// The name for the backend environment.
+	//
+	// EnvironmentName is a required field
+	EnvironmentName *string `location:"uri" locationName:"environmentName" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetBackendEnvironmentInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetBackendEnvironmentInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetBackendEnvironmentInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetBackendEnvironmentInput"}
+	if s.AppId == nil {
+		invalidParams.Add(request.NewErrParamRequired("AppId"))
+	}
+	if s.AppId != nil && len(*s.AppId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("AppId", 1))
+	}
+	if s.EnvironmentName == nil {
+		invalidParams.Add(request.NewErrParamRequired("EnvironmentName"))
+	}
+	if s.EnvironmentName != nil && len(*s.EnvironmentName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EnvironmentName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAppId sets the AppId field's value.
+func (s *GetBackendEnvironmentInput) SetAppId(v string) *GetBackendEnvironmentInput {
+	s.AppId = &v
+	return s
+}
+
+// SetEnvironmentName sets the EnvironmentName field's value.
+func (s *GetBackendEnvironmentInput) SetEnvironmentName(v string) *GetBackendEnvironmentInput {
+	s.EnvironmentName = &v
+	return s
+}
+
+// The result structure for the get backend environment result.
+type GetBackendEnvironmentOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Unique Id for an Amplify App.
+	// Describes the backend environment for an Amplify app.
+	//
+	// BackendEnvironment is a required field
+	BackendEnvironment *BackendEnvironment `locationName:"backendEnvironment" type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetBackendEnvironmentOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetBackendEnvironmentOutput) GoString() string {
+	return s.String()
+}
+
+// SetBackendEnvironment sets the BackendEnvironment field's value.
+func (s *GetBackendEnvironmentOutput) SetBackendEnvironment(v *BackendEnvironment) *GetBackendEnvironmentOutput {
+	s.BackendEnvironment = v
+	return s
+}
+
+// The request structure for the get branch request.
+type GetBranchInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
+	// The unique ID for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 
-	// Name for the branch.
+	// The name for the branch.
 	//
 	// BranchName is a required field
 	BranchName *string `location:"uri" locationName:"branchName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetBranchInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetBranchInput) GoString() string {
 	return s.String()
 }
@@ -5322,18 +6710,26 @@ func (s *GetBranchInput) SetBranchName(v string) *GetBranchInput {
 type GetBranchOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Branch for an Amplify App, which maps to a 3rd party repository branch.
+	// The branch for an Amplify app, which maps to a third-party repository branch.
 	//
 	// Branch is a required field
 	Branch *Branch `locationName:"branch" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetBranchOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetBranchOutput) GoString() string {
 	return s.String()
 }
@@ -5344,27 +6740,35 @@ func (s *GetBranchOutput) SetBranch(v *Branch) *GetBranchOutput {
 	return s
 }
 
-// Request structure for the get Domain Association request.
+// The request structure for the get domain association request.
 type GetDomainAssociationInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// Unique Id for an Amplify App.
+	// The unique id for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 
-	// Name of the domain.
+	// The name of the domain.
 	//
 	// DomainName is a required field
 	DomainName *string `location:"uri" locationName:"domainName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetDomainAssociationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetDomainAssociationInput) GoString() string {
 	return s.String()
 }
@@ -5403,22 +6807,31 @@ func (s *GetDomainAssociationInput) SetDomainName(v string) *GetDomainAssociatio
 	return s
 }
 
-// Result structure for the get Domain Association request.
+// The result structure for the get domain association request.
 type GetDomainAssociationOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Domain Association structure.
+	// Describes the structure of a domain association, which associates a custom
+	// domain with an Amplify app.
 	//
 	// DomainAssociation is a required field
 	DomainAssociation *DomainAssociation `locationName:"domainAssociation" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetDomainAssociationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetDomainAssociationOutput) GoString() string {
 	return s.String()
 }
@@ -5429,32 +6842,40 @@ func (s *GetDomainAssociationOutput) SetDomainAssociation(v *DomainAssociation)
 	return s
 }
 
-// Request structure for get job request.
+// The request structure for the get job request.
 type GetJobInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// Unique Id for an Amplify App.
+	// The unique ID for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 
-	// Name for the branch, for the Job.
+	// The branch name for the job.
 	//
 	// BranchName is a required field
 	BranchName *string `location:"uri" locationName:"branchName" min:"1" type:"string" required:"true"`
 
-	// Unique Id for the Job.
+	// The unique ID for the job.
 	//
 	// JobId is a required field
 	JobId *string `location:"uri" locationName:"jobId" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetJobInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetJobInput) GoString() string {
 	return s.String()
 }
@@ -5508,18 +6929,26 @@ func (s *GetJobInput) SetJobId(v string) *GetJobInput {
 type GetJobOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Structure for an execution job for an Amplify App.
+	// Describes an execution job for an Amplify app.
 	//
 	// Job is a required field
 	Job *Job `locationName:"job" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetJobOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetJobOutput) GoString() string {
 	return s.String()
 }
@@ -5530,22 +6959,30 @@ func (s *GetJobOutput) SetJob(v *Job) *GetJobOutput {
 	return s
 }
 
-// Request structure for the get webhook request.
+// The request structure for the get webhook request.
 type GetWebhookInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// Unique Id for a webhook.
+	// The unique ID for a webhook.
 	//
 	// WebhookId is a required field
 	WebhookId *string `location:"uri" locationName:"webhookId" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetWebhookInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetWebhookInput) GoString() string {
 	return s.String()
 }
@@ -5572,22 +7009,30 @@ func (s *GetWebhookInput) SetWebhookId(v string) *GetWebhookInput {
 	return s
 }
 
-// Result structure for the get webhook request.
+// The result structure for the get webhook request.
 type GetWebhookOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Webhook structure.
+	// Describes the structure of a webhook.
 	//
 	// Webhook is a required field
 	Webhook *Webhook `locationName:"webhook" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetWebhookOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetWebhookOutput) GoString() string {
 	return s.String()
 }
@@ -5598,27 +7043,99 @@ func (s *GetWebhookOutput) SetWebhook(v *Webhook) *GetWebhookOutput {
 	return s
 }
 
-// Structure for an execution job for an Amplify App.
+// The service failed to perform an operation due to an internal issue.
+type InternalFailureException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InternalFailureException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InternalFailureException) GoString() string {
+	return s.String()
+}
+
+func newErrorInternalFailureException(v protocol.ResponseMetadata) error {
+	return &InternalFailureException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InternalFailureException) Code() string {
+	return "InternalFailureException"
+}
+
+// Message returns the exception's message.
+func (s *InternalFailureException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InternalFailureException) OrigErr() error {
+	return nil
+}
+
+func (s *InternalFailureException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InternalFailureException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InternalFailureException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Describes an execution job for an Amplify app.
 type Job struct {
 	_ struct{} `type:"structure"`
 
-	// Execution steps for an execution job, for an Amplify App.
+	// The execution steps for an execution job, for an Amplify app.
 	//
 	// Steps is a required field
 	Steps []*Step `locationName:"steps" type:"list" required:"true"`
 
-	// Summary for an execution job for an Amplify App.
+	// Describes the summary for an execution job for an Amplify app.
 	//
 	// Summary is a required field
 	Summary *JobSummary `locationName:"summary" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Job) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Job) GoString() string {
 	return s.String()
 }
@@ -5635,62 +7152,71 @@ func (s *Job) SetSummary(v *JobSummary) *Job {
 	return s
 }
 
-// Structure for the summary of a Job.
+// Describes the summary for an execution job for an Amplify app.
 type JobSummary struct {
 	_ struct{} `type:"structure"`
 
-	// Commit Id from 3rd party repository provider for the Job.
+	// The commit ID from a third-party repository provider for the job.
 	//
 	// CommitId is a required field
 	CommitId *string `locationName:"commitId" type:"string" required:"true"`
 
-	// Commit message from 3rd party repository provider for the Job.
+	// The commit message from a third-party repository provider for the job.
 	//
 	// CommitMessage is a required field
 	CommitMessage *string `locationName:"commitMessage" type:"string" required:"true"`
 
-	// Commit date / time for the Job.
+	// The commit date and time for the job.
 	//
 	// CommitTime is a required field
 	CommitTime *time.Time `locationName:"commitTime" type:"timestamp" required:"true"`
 
-	// End date / time for the Job.
+	// The end date and time for the job.
 	EndTime *time.Time `locationName:"endTime" type:"timestamp"`
 
-	// Arn for the Job.
+	// The Amazon Resource Name (ARN) for the job.
 	//
 	// JobArn is a required field
 	JobArn *string `locationName:"jobArn" type:"string" required:"true"`
 
-	// Unique Id for the Job.
+	// The unique ID for the job.
 	//
 	// JobId is a required field
 	JobId *string `locationName:"jobId" type:"string" required:"true"`
 
-	// Type for the Job. \n "RELEASE": Manually released from source by using StartJob
-	// API. "RETRY": Manually retried by using StartJob API. "WEB_HOOK": Automatically
-	// triggered by WebHooks.
+	// The type for the job. If the value is RELEASE, the job was manually released
+	// from its source by using the StartJob API. If the value is RETRY, the job
+	// was manually retried using the StartJob API. If the value is WEB_HOOK, the
+	// job was automatically triggered by webhooks.
 	//
 	// JobType is a required field
 	JobType *string `locationName:"jobType" type:"string" required:"true" enum:"JobType"`
 
-	// Start date / time for the Job.
+	// The start date and time for the job.
 	//
 	// StartTime is a required field
 	StartTime *time.Time `locationName:"startTime" type:"timestamp" required:"true"`
 
-	// Status for the Job.
+	// The current status for the job.
 	//
 	// Status is a required field
 	Status *string `locationName:"status" type:"string" required:"true" enum:"JobStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s JobSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s JobSummary) GoString() string {
 	return s.String()
 }
@@ -5749,41 +7275,100 @@ func (s *JobSummary) SetStatus(v string) *JobSummary {
 	return s
 }
 
-// Request structure for an Amplify App list request.
-type ListAppsInput struct {
-	_ struct{} `type:"structure"`
-
-	// Maximum number of records to list in a single response.
-	MaxResults *int64 `location:"querystring" locationName:"maxResults" min:"1" type:"integer"`
+// A resource could not be created because service quotas were exceeded.
+type LimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// Pagination token. If non-null pagination token is returned in a result, then
-	// pass its value in another request to fetch more entries.
-	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s ListAppsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListAppsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *ListAppsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListAppsInput"}
-	if s.MaxResults != nil && *s.MaxResults < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+func newErrorLimitExceededException(v protocol.ResponseMetadata) error {
+	return &LimitExceededException{
+		RespMetadata: v,
 	}
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
+// Code returns the exception type name.
+func (s *LimitExceededException) Code() string {
+	return "LimitExceededException"
+}
+
+// Message returns the exception's message.
+func (s *LimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *LimitExceededException) OrigErr() error {
 	return nil
 }
 
+func (s *LimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *LimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *LimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The request structure for the list apps request.
+type ListAppsInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
+	// The maximum number of records to list in a single response.
+	MaxResults *int64 `location:"querystring" locationName:"maxResults" type:"integer"`
+
+	// A pagination token. If non-null, the pagination token is returned in a result.
+	// Pass its value in another request to retrieve more entries.
+	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListAppsInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListAppsInput) GoString() string {
+	return s.String()
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListAppsInput) SetMaxResults(v int64) *ListAppsInput {
 	s.MaxResults = &v
@@ -5796,27 +7381,35 @@ func (s *ListAppsInput) SetNextToken(v string) *ListAppsInput {
 	return s
 }
 
-// Result structure for an Amplify App list request.
+// The result structure for an Amplify app list request.
 type ListAppsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// List of Amplify Apps.
+	// A list of Amplify apps.
 	//
 	// Apps is a required field
 	Apps []*App `locationName:"apps" type:"list" required:"true"`
 
-	// Pagination token. Set to null to start listing Apps from start. If non-null
-	// pagination token is returned in a result, then pass its value in here to
-	// list more projects.
+	// A pagination token. Set to null to start listing apps from start. If non-null,
+	// the pagination token is returned in a result. Pass its value in here to list
+	// more projects.
 	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListAppsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListAppsOutput) GoString() string {
 	return s.String()
 }
@@ -5833,43 +7426,48 @@ func (s *ListAppsOutput) SetNextToken(v string) *ListAppsOutput {
 	return s
 }
 
-// Request structure for the list artifacts request.
+// Describes the request structure for the list artifacts request.
 type ListArtifactsInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// Unique Id for an Amplify App.
+	// The unique ID for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 
-	// Type for an artifact.
-	ArtifactType *string `locationName:"artifactType" type:"string" enum:"ArtifactType"`
-
-	// Name for a branch, part of an Amplify App.
+	// The name of a branch that is part of an Amplify app.
 	//
 	// BranchName is a required field
 	BranchName *string `location:"uri" locationName:"branchName" min:"1" type:"string" required:"true"`
 
-	// Unique Id for an Job.
+	// The unique ID for a job.
 	//
 	// JobId is a required field
 	JobId *string `location:"uri" locationName:"jobId" type:"string" required:"true"`
 
-	// Maximum number of records to list in a single response.
-	MaxResults *int64 `location:"querystring" locationName:"maxResults" min:"1" type:"integer"`
+	// The maximum number of records to list in a single response.
+	MaxResults *int64 `location:"querystring" locationName:"maxResults" type:"integer"`
 
-	// Pagination token. Set to null to start listing artifacts from start. If non-null
-	// pagination token is returned in a result, then pass its value in here to
-	// list more artifacts.
+	// A pagination token. Set to null to start listing artifacts from start. If
+	// a non-null pagination token is returned in a result, pass its value in here
+	// to list more artifacts.
 	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListArtifactsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListArtifactsInput) GoString() string {
 	return s.String()
 }
@@ -5895,9 +7493,6 @@ func (s *ListArtifactsInput) Validate() error {
 	if s.JobId != nil && len(*s.JobId) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("JobId", 1))
 	}
-	if s.MaxResults != nil && *s.MaxResults < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
-	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -5911,96 +7506,232 @@ func (s *ListArtifactsInput) SetAppId(v string) *ListArtifactsInput {
 	return s
 }
 
-// SetArtifactType sets the ArtifactType field's value.
-func (s *ListArtifactsInput) SetArtifactType(v string) *ListArtifactsInput {
-	s.ArtifactType = &v
-	return s
-}
-
 // SetBranchName sets the BranchName field's value.
 func (s *ListArtifactsInput) SetBranchName(v string) *ListArtifactsInput {
 	s.BranchName = &v
 	return s
 }
 
-// SetJobId sets the JobId field's value.
-func (s *ListArtifactsInput) SetJobId(v string) *ListArtifactsInput {
-	s.JobId = &v
+// SetJobId sets the JobId field's value.
+func (s *ListArtifactsInput) SetJobId(v string) *ListArtifactsInput {
+	s.JobId = &v
+	return s
+}
+
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListArtifactsInput) SetMaxResults(v int64) *ListArtifactsInput {
+	s.MaxResults = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListArtifactsInput) SetNextToken(v string) *ListArtifactsInput {
+	s.NextToken = &v
+	return s
+}
+
+// The result structure for the list artifacts request.
+type ListArtifactsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A list of artifacts.
+	//
+	// Artifacts is a required field
+	Artifacts []*Artifact `locationName:"artifacts" type:"list" required:"true"`
+
+	// A pagination token. If a non-null pagination token is returned in a result,
+	// pass its value in another request to retrieve more entries.
+	NextToken *string `locationName:"nextToken" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListArtifactsOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListArtifactsOutput) GoString() string {
+	return s.String()
+}
+
+// SetArtifacts sets the Artifacts field's value.
+func (s *ListArtifactsOutput) SetArtifacts(v []*Artifact) *ListArtifactsOutput {
+	s.Artifacts = v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListArtifactsOutput) SetNextToken(v string) *ListArtifactsOutput {
+	s.NextToken = &v
+	return s
+}
+
+// The request structure for the list backend environments request.
+type ListBackendEnvironmentsInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
+	// The unique ID for an Amplify app.
+	//
+	// AppId is a required field
+	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
+
+	// The name of the backend environment
+	EnvironmentName *string `location:"querystring" locationName:"environmentName" min:"1" type:"string"`
+
+	// The maximum number of records to list in a single response.
+	MaxResults *int64 `location:"querystring" locationName:"maxResults" type:"integer"`
+
+	// A pagination token. Set to null to start listing backend environments from
+	// the start. If a non-null pagination token is returned in a result, pass its
+	// value in here to list more backend environments.
+	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListBackendEnvironmentsInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListBackendEnvironmentsInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListBackendEnvironmentsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListBackendEnvironmentsInput"}
+	if s.AppId == nil {
+		invalidParams.Add(request.NewErrParamRequired("AppId"))
+	}
+	if s.AppId != nil && len(*s.AppId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("AppId", 1))
+	}
+	if s.EnvironmentName != nil && len(*s.EnvironmentName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EnvironmentName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAppId sets the AppId field's value.
+func (s *ListBackendEnvironmentsInput) SetAppId(v string) *ListBackendEnvironmentsInput {
+	s.AppId = &v
+	return s
+}
+
+// SetEnvironmentName sets the EnvironmentName field's value.
+func (s *ListBackendEnvironmentsInput) SetEnvironmentName(v string) *ListBackendEnvironmentsInput {
+	s.EnvironmentName = &v
 	return s
 }
 
 // SetMaxResults sets the MaxResults field's value.
-func (s *ListArtifactsInput) SetMaxResults(v int64) *ListArtifactsInput {
+func (s *ListBackendEnvironmentsInput) SetMaxResults(v int64) *ListBackendEnvironmentsInput {
 	s.MaxResults = &v
 	return s
 }
 
 // SetNextToken sets the NextToken field's value.
-func (s *ListArtifactsInput) SetNextToken(v string) *ListArtifactsInput {
+func (s *ListBackendEnvironmentsInput) SetNextToken(v string) *ListBackendEnvironmentsInput {
 	s.NextToken = &v
 	return s
 }
 
-// Result structure for the list artifacts request.
-type ListArtifactsOutput struct {
+// The result structure for the list backend environments result.
+type ListBackendEnvironmentsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// List of artifacts.
+	// The list of backend environments for an Amplify app.
 	//
-	// Artifacts is a required field
-	Artifacts []*Artifact `locationName:"artifacts" type:"list" required:"true"`
+	// BackendEnvironments is a required field
+	BackendEnvironments []*BackendEnvironment `locationName:"backendEnvironments" type:"list" required:"true"`
 
-	// Pagination token. If non-null pagination token is returned in a result, then
-	// pass its value in another request to fetch more entries.
+	// A pagination token. If a non-null pagination token is returned in a result,
+	// pass its value in another request to retrieve more entries.
 	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
-func (s ListArtifactsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListBackendEnvironmentsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListArtifactsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListBackendEnvironmentsOutput) GoString() string {
 	return s.String()
 }
 
-// SetArtifacts sets the Artifacts field's value.
-func (s *ListArtifactsOutput) SetArtifacts(v []*Artifact) *ListArtifactsOutput {
-	s.Artifacts = v
+// SetBackendEnvironments sets the BackendEnvironments field's value.
+func (s *ListBackendEnvironmentsOutput) SetBackendEnvironments(v []*BackendEnvironment) *ListBackendEnvironmentsOutput {
+	s.BackendEnvironments = v
 	return s
 }
 
 // SetNextToken sets the NextToken field's value.
-func (s *ListArtifactsOutput) SetNextToken(v string) *ListArtifactsOutput {
+func (s *ListBackendEnvironmentsOutput) SetNextToken(v string) *ListBackendEnvironmentsOutput {
 	s.NextToken = &v
 	return s
 }
 
-// Request structure for list branches request.
+// The request structure for the list branches request.
 type ListBranchesInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// Unique Id for an Amplify App.
+	// The unique ID for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 
-	// Maximum number of records to list in a single response.
-	MaxResults *int64 `location:"querystring" locationName:"maxResults" min:"1" type:"integer"`
+	// The maximum number of records to list in a single response.
+	MaxResults *int64 `location:"querystring" locationName:"maxResults" type:"integer"`
 
-	// Pagination token. Set to null to start listing branches from start. If a
-	// non-null pagination token is returned in a result, then pass its value in
+	// A pagination token. Set to null to start listing branches from the start.
+	// If a non-null pagination token is returned in a result, pass its value in
 	// here to list more branches.
 	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListBranchesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListBranchesInput) GoString() string {
 	return s.String()
 }
@@ -6014,9 +7745,6 @@ func (s *ListBranchesInput) Validate() error {
 	if s.AppId != nil && len(*s.AppId) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("AppId", 1))
 	}
-	if s.MaxResults != nil && *s.MaxResults < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
-	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -6042,26 +7770,34 @@ func (s *ListBranchesInput) SetNextToken(v string) *ListBranchesInput {
 	return s
 }
 
-// Result structure for list branches request.
+// The result structure for the list branches request.
 type ListBranchesOutput struct {
 	_ struct{} `type:"structure"`
 
-	// List of branches for an Amplify App.
+	// A list of branches for an Amplify app.
 	//
 	// Branches is a required field
 	Branches []*Branch `locationName:"branches" type:"list" required:"true"`
 
-	// Pagination token. If non-null pagination token is returned in a result, then
-	// pass its value in another request to fetch more entries.
+	// A pagination token. If a non-null pagination token is returned in a result,
+	// pass its value in another request to retrieve more entries.
 	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListBranchesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListBranchesOutput) GoString() string {
 	return s.String()
 }
@@ -6078,30 +7814,38 @@ func (s *ListBranchesOutput) SetNextToken(v string) *ListBranchesOutput {
 	return s
 }
 
-// Request structure for the list Domain Associations request.
+// The request structure for the list domain associations request.
 type ListDomainAssociationsInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// Unique Id for an Amplify App.
+	// The unique ID for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 
-	// Maximum number of records to list in a single response.
-	MaxResults *int64 `location:"querystring" locationName:"maxResults" min:"1" type:"integer"`
+	// The maximum number of records to list in a single response.
+	MaxResults *int64 `location:"querystring" locationName:"maxResults" type:"integer"`
 
-	// Pagination token. Set to null to start listing Apps from start. If non-null
-	// pagination token is returned in a result, then pass its value in here to
-	// list more projects.
+	// A pagination token. Set to null to start listing apps from the start. If
+	// non-null, a pagination token is returned in a result. Pass its value in here
+	// to list more projects.
 	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDomainAssociationsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDomainAssociationsInput) GoString() string {
 	return s.String()
 }
@@ -6115,9 +7859,6 @@ func (s *ListDomainAssociationsInput) Validate() error {
 	if s.AppId != nil && len(*s.AppId) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("AppId", 1))
 	}
-	if s.MaxResults != nil && *s.MaxResults < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
-	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -6143,26 +7884,34 @@ func (s *ListDomainAssociationsInput) SetNextToken(v string) *ListDomainAssociat
 	return s
 }
 
-// Result structure for the list Domain Association request.
+// The result structure for the list domain association request.
 type ListDomainAssociationsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// List of Domain Associations.
+	// A list of domain associations.
 	//
 	// DomainAssociations is a required field
 	DomainAssociations []*DomainAssociation `locationName:"domainAssociations" type:"list" required:"true"`
 
-	// Pagination token. If non-null pagination token is returned in a result, then
-	// pass its value in another request to fetch more entries.
+	// A pagination token. If non-null, a pagination token is returned in a result.
+	// Pass its value in another request to retrieve more entries.
 	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDomainAssociationsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDomainAssociationsOutput) GoString() string {
 	return s.String()
 }
@@ -6179,35 +7928,43 @@ func (s *ListDomainAssociationsOutput) SetNextToken(v string) *ListDomainAssocia
 	return s
 }
 
-// Request structure for list job request.
+// The request structure for the list jobs request.
 type ListJobsInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// Unique Id for an Amplify App.
+	// The unique ID for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 
-	// Name for a branch.
+	// The name for a branch.
 	//
 	// BranchName is a required field
 	BranchName *string `location:"uri" locationName:"branchName" min:"1" type:"string" required:"true"`
 
-	// Maximum number of records to list in a single response.
-	MaxResults *int64 `location:"querystring" locationName:"maxResults" min:"1" type:"integer"`
+	// The maximum number of records to list in a single response.
+	MaxResults *int64 `location:"querystring" locationName:"maxResults" type:"integer"`
 
-	// Pagination token. Set to null to start listing steps from start. If a non-null
-	// pagination token is returned in a result, then pass its value in here to
-	// list more steps.
+	// A pagination token. Set to null to start listing steps from the start. If
+	// a non-null pagination token is returned in a result, pass its value in here
+	// to list more steps.
 	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListJobsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListJobsInput) GoString() string {
 	return s.String()
 }
@@ -6227,9 +7984,6 @@ func (s *ListJobsInput) Validate() error {
 	if s.BranchName != nil && len(*s.BranchName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("BranchName", 1))
 	}
-	if s.MaxResults != nil && *s.MaxResults < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
-	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -6261,26 +8015,34 @@ func (s *ListJobsInput) SetNextToken(v string) *ListJobsInput {
 	return s
 }
 
-// Maximum number of records to list in a single response.
+// The maximum number of records to list in a single response.
 type ListJobsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Result structure for list job result request.
+	// The result structure for the list job result request.
 	//
 	// JobSummaries is a required field
 	JobSummaries []*JobSummary `locationName:"jobSummaries" type:"list" required:"true"`
 
-	// Pagination token. If non-null pagination token is returned in a result, then
-	// pass its value in another request to fetch more entries.
+	// A pagination token. If non-null the pagination token is returned in a result.
+	// Pass its value in another request to retrieve more entries.
 	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListJobsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListJobsOutput) GoString() string {
 	return s.String()
 }
@@ -6297,22 +8059,30 @@ func (s *ListJobsOutput) SetNextToken(v string) *ListJobsOutput {
 	return s
 }
 
-// Request structure used to list tags for resource.
+// The request structure to use to list tags for a resource.
 type ListTagsForResourceInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// Resource arn used to list tags.
+	// The Amazon Resource Name (ARN) to use to list tags.
 	//
 	// ResourceArn is a required field
 	ResourceArn *string `location:"uri" locationName:"resourceArn" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceInput) GoString() string {
 	return s.String()
 }
@@ -6339,20 +8109,28 @@ func (s *ListTagsForResourceInput) SetResourceArn(v string) *ListTagsForResource
 	return s
 }
 
-// Response for list tags.
+// The response for the list tags for resource request.
 type ListTagsForResourceOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Tags result for response.
-	Tags map[string]*string `locationName:"tags" min:"1" type:"map"`
+	// A list of tags for the specified The Amazon Resource Name (ARN).
+	Tags map[string]*string `locationName:"tags" type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceOutput) GoString() string {
 	return s.String()
 }
@@ -6363,30 +8141,38 @@ func (s *ListTagsForResourceOutput) SetTags(v map[string]*string) *ListTagsForRe
 	return s
 }
 
-// Request structure for the list webhooks request.
+// The request structure for the list webhooks request.
 type ListWebhooksInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// Unique Id for an Amplify App.
+	// The unique ID for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 
-	// Maximum number of records to list in a single response.
-	MaxResults *int64 `location:"querystring" locationName:"maxResults" min:"1" type:"integer"`
+	// The maximum number of records to list in a single response.
+	MaxResults *int64 `location:"querystring" locationName:"maxResults" type:"integer"`
 
-	// Pagination token. Set to null to start listing webhooks from start. If non-null
-	// pagination token is returned in a result, then pass its value in here to
-	// list more webhooks.
+	// A pagination token. Set to null to start listing webhooks from the start.
+	// If non-null,the pagination token is returned in a result. Pass its value
+	// in here to list more webhooks.
 	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListWebhooksInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListWebhooksInput) GoString() string {
 	return s.String()
 }
@@ -6400,9 +8186,6 @@ func (s *ListWebhooksInput) Validate() error {
 	if s.AppId != nil && len(*s.AppId) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("AppId", 1))
 	}
-	if s.MaxResults != nil && *s.MaxResults < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
-	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -6428,26 +8211,34 @@ func (s *ListWebhooksInput) SetNextToken(v string) *ListWebhooksInput {
 	return s
 }
 
-// Result structure for the list webhooks request.
+// The result structure for the list webhooks request.
 type ListWebhooksOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Pagination token. If non-null pagination token is returned in a result, then
-	// pass its value in another request to fetch more entries.
+	// A pagination token. If non-null, the pagination token is returned in a result.
+	// Pass its value in another request to retrieve more entries.
 	NextToken *string `locationName:"nextToken" type:"string"`
 
-	// List of webhooks.
+	// A list of webhooks.
 	//
 	// Webhooks is a required field
 	Webhooks []*Webhook `locationName:"webhooks" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListWebhooksOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListWebhooksOutput) GoString() string {
 	return s.String()
 }
@@ -6464,29 +8255,101 @@ func (s *ListWebhooksOutput) SetWebhooks(v []*Webhook) *ListWebhooksOutput {
 	return s
 }
 
-// Structure with Production Branch information.
+// An entity was not found during an operation.
+type NotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorNotFoundException(v protocol.ResponseMetadata) error {
+	return &NotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *NotFoundException) Code() string {
+	return "NotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *NotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *NotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *NotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *NotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *NotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Describes the information about a production branch for an Amplify app.
 type ProductionBranch struct {
 	_ struct{} `type:"structure"`
 
-	// Branch Name for Production Branch.
+	// The branch name for the production branch.
 	BranchName *string `locationName:"branchName" min:"1" type:"string"`
 
-	// Last Deploy Time of Production Branch.
+	// The last deploy time of the production branch.
 	LastDeployTime *time.Time `locationName:"lastDeployTime" type:"timestamp"`
 
-	// Status of Production Branch.
+	// The status of the production branch.
 	Status *string `locationName:"status" min:"3" type:"string"`
 
-	// Thumbnail URL for Production Branch.
+	// The thumbnail URL for the production branch.
 	ThumbnailUrl *string `locationName:"thumbnailUrl" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProductionBranch) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProductionBranch) GoString() string {
 	return s.String()
 }
@@ -6515,35 +8378,109 @@ func (s *ProductionBranch) SetThumbnailUrl(v string) *ProductionBranch {
 	return s
 }
 
-// Request structure for start a deployment.
+// An operation failed due to a non-existent resource.
+type ResourceNotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Code_ *string `locationName:"code" type:"string"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceNotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceNotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorResourceNotFoundException(v protocol.ResponseMetadata) error {
+	return &ResourceNotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ResourceNotFoundException) Code() string {
+	return "ResourceNotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *ResourceNotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ResourceNotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *ResourceNotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ResourceNotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ResourceNotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The request structure for the start a deployment request.
 type StartDeploymentInput struct {
 	_ struct{} `type:"structure"`
 
-	// Unique Id for an Amplify App.
+	// The unique ID for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 
-	// Name for the branch, for the Job.
+	// The name for the branch, for the job.
 	//
 	// BranchName is a required field
 	BranchName *string `location:"uri" locationName:"branchName" min:"1" type:"string" required:"true"`
 
-	// The job id for this deployment, generated by create deployment request.
+	// The job ID for this deployment, generated by the create deployment request.
 	JobId *string `locationName:"jobId" type:"string"`
 
-	// The sourceUrl for this deployment, used when calling start deployment without
-	// create deployment. SourceUrl can be any HTTP GET url that is public accessible
-	// and downloads a single zip.
+	// The source URL for this deployment, used when calling start deployment without
+	// create deployment. The source URL can be any HTTP GET URL that is publicly
+	// accessible and downloads a single .zip file.
 	SourceUrl *string `locationName:"sourceUrl" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartDeploymentInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartDeploymentInput) GoString() string {
 	return s.String()
 }
@@ -6594,22 +8531,30 @@ func (s *StartDeploymentInput) SetSourceUrl(v string) *StartDeploymentInput {
 	return s
 }
 
-// Result structure for start a deployment.
+// The result structure for the start a deployment request.
 type StartDeploymentOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Summary for the Job.
+	// The summary for the job.
 	//
 	// JobSummary is a required field
 	JobSummary *JobSummary `locationName:"jobSummary" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartDeploymentOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartDeploymentOutput) GoString() string {
 	return s.String()
 }
@@ -6620,50 +8565,59 @@ func (s *StartDeploymentOutput) SetJobSummary(v *JobSummary) *StartDeploymentOut
 	return s
 }
 
-// Request structure for Start job request.
+// The request structure for the start job request.
 type StartJobInput struct {
 	_ struct{} `type:"structure"`
 
-	// Unique Id for an Amplify App.
+	// The unique ID for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 
-	// Name for the branch, for the Job.
+	// The branch name for the job.
 	//
 	// BranchName is a required field
 	BranchName *string `location:"uri" locationName:"branchName" min:"1" type:"string" required:"true"`
 
-	// Commit Id from 3rd party repository provider for the Job.
+	// The commit ID from a third-party repository provider for the job.
 	CommitId *string `locationName:"commitId" type:"string"`
 
-	// Commit message from 3rd party repository provider for the Job.
+	// The commit message from a third-party repository provider for the job.
 	CommitMessage *string `locationName:"commitMessage" type:"string"`
 
-	// Commit date / time for the Job.
+	// The commit date and time for the job.
 	CommitTime *time.Time `locationName:"commitTime" type:"timestamp"`
 
-	// Unique Id for an existing job. Required for "RETRY" JobType.
+	// The unique ID for an existing job. This is required if the value of jobType
+	// is RETRY.
 	JobId *string `locationName:"jobId" type:"string"`
 
-	// Descriptive reason for starting this job.
+	// A descriptive reason for starting this job.
 	JobReason *string `locationName:"jobReason" type:"string"`
 
-	// Type for the Job. Available JobTypes are: \n "RELEASE": Start a new job with
-	// the latest change from the specified branch. Only available for apps that
-	// have connected to a repository. "RETRY": Retry an existing job. JobId is
-	// required for this type of job.
+	// Describes the type for the job. The job type RELEASE starts a new job with
+	// the latest change from the specified branch. This value is available only
+	// for apps that are connected to a repository. The job type RETRY retries an
+	// existing job. If the job type value is RETRY, the jobId is also required.
 	//
 	// JobType is a required field
 	JobType *string `locationName:"jobType" type:"string" required:"true" enum:"JobType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartJobInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartJobInput) GoString() string {
 	return s.String()
 }
@@ -6741,22 +8695,30 @@ func (s *StartJobInput) SetJobType(v string) *StartJobInput {
 	return s
 }
 
-// Result structure for run job request.
+// The result structure for the run job request.
 type StartJobOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Summary for the Job.
+	// The summary for the job.
 	//
 	// JobSummary is a required field
 	JobSummary *JobSummary `locationName:"jobSummary" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartJobOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartJobOutput) GoString() string {
 	return s.String()
 }
@@ -6767,58 +8729,66 @@ func (s *StartJobOutput) SetJobSummary(v *JobSummary) *StartJobOutput {
 	return s
 }
 
-// Structure for an execution step for an execution job, for an Amplify App.
+// Describes an execution step, for an execution job, for an Amplify app.
 type Step struct {
 	_ struct{} `type:"structure"`
 
-	// URL to the artifact for the execution step.
+	// The URL to the artifact for the execution step.
 	ArtifactsUrl *string `locationName:"artifactsUrl" type:"string"`
 
-	// The context for current step, will include build image if step is build.
+	// The context for the current step. Includes a build image if the step is build.
 	Context *string `locationName:"context" type:"string"`
 
-	// End date/ time of the execution step.
+	// The end date and time of the execution step.
 	//
 	// EndTime is a required field
 	EndTime *time.Time `locationName:"endTime" type:"timestamp" required:"true"`
 
-	// URL to the logs for the execution step.
+	// The URL to the logs for the execution step.
 	LogUrl *string `locationName:"logUrl" type:"string"`
 
-	// List of screenshot URLs for the execution step, if relevant.
+	// The list of screenshot URLs for the execution step, if relevant.
 	Screenshots map[string]*string `locationName:"screenshots" type:"map"`
 
-	// Start date/ time of the execution step.
+	// The start date and time of the execution step.
 	//
 	// StartTime is a required field
 	StartTime *time.Time `locationName:"startTime" type:"timestamp" required:"true"`
 
-	// Status of the execution step.
+	// The status of the execution step.
 	//
 	// Status is a required field
 	Status *string `locationName:"status" type:"string" required:"true" enum:"JobStatus"`
 
-	// The reason for current step status.
+	// The reason for the current step status.
 	StatusReason *string `locationName:"statusReason" type:"string"`
 
-	// Name of the execution step.
+	// The name of the execution step.
 	//
 	// StepName is a required field
 	StepName *string `locationName:"stepName" type:"string" required:"true"`
 
-	// URL to the test artifact for the execution step.
+	// The URL to the test artifact for the execution step.
 	TestArtifactsUrl *string `locationName:"testArtifactsUrl" type:"string"`
 
-	// URL to the test config for the execution step.
+	// The URL to the test configuration for the execution step.
 	TestConfigUrl *string `locationName:"testConfigUrl" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Step) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Step) GoString() string {
 	return s.String()
 }
@@ -6889,32 +8859,40 @@ func (s *Step) SetTestConfigUrl(v string) *Step {
 	return s
 }
 
-// Request structure for stop job request.
+// The request structure for the stop job request.
 type StopJobInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// Unique Id for an Amplify App.
+	// The unique ID for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 
-	// Name for the branch, for the Job.
+	// The name for the branch, for the job.
 	//
 	// BranchName is a required field
 	BranchName *string `location:"uri" locationName:"branchName" min:"1" type:"string" required:"true"`
 
-	// Unique Id for the Job.
+	// The unique id for the job.
 	//
 	// JobId is a required field
 	JobId *string `location:"uri" locationName:"jobId" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopJobInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopJobInput) GoString() string {
 	return s.String()
 }
@@ -6965,22 +8943,30 @@ func (s *StopJobInput) SetJobId(v string) *StopJobInput {
 	return s
 }
 
-// Result structure for the stop job request.
+// The result structure for the stop job request.
 type StopJobOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Summary for the Job.
+	// The summary for the job.
 	//
 	// JobSummary is a required field
 	JobSummary *JobSummary `locationName:"jobSummary" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopJobOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopJobOutput) GoString() string {
 	return s.String()
 }
@@ -6991,32 +8977,40 @@ func (s *StopJobOutput) SetJobSummary(v *JobSummary) *StopJobOutput {
 	return s
 }
 
-// Subdomain for the Domain Association.
+// The subdomain for the domain association.
 type SubDomain struct {
 	_ struct{} `type:"structure"`
 
-	// DNS record for the Subdomain.
+	// The DNS record for the subdomain.
 	//
 	// DnsRecord is a required field
 	DnsRecord *string `locationName:"dnsRecord" type:"string" required:"true"`
 
-	// Setting structure for the Subdomain.
+	// Describes the settings for the subdomain.
 	//
 	// SubDomainSetting is a required field
 	SubDomainSetting *SubDomainSetting `locationName:"subDomainSetting" type:"structure" required:"true"`
 
-	// Verified status of the Subdomain
+	// The verified status of the subdomain
 	//
 	// Verified is a required field
 	Verified *bool `locationName:"verified" type:"boolean" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SubDomain) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SubDomain) GoString() string {
 	return s.String()
 }
@@ -7039,27 +9033,35 @@ func (s *SubDomain) SetVerified(v bool) *SubDomain {
 	return s
 }
 
-// Setting for the Subdomain.
+// Describes the settings for the subdomain.
 type SubDomainSetting struct {
 	_ struct{} `type:"structure"`
 
-	// Branch name setting for the Subdomain.
+	// The branch name setting for the subdomain.
 	//
 	// BranchName is a required field
 	BranchName *string `locationName:"branchName" min:"1" type:"string" required:"true"`
 
-	// Prefix setting for the Subdomain.
+	// The prefix setting for the subdomain.
 	//
 	// Prefix is a required field
 	Prefix *string `locationName:"prefix" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SubDomainSetting) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SubDomainSetting) GoString() string {
 	return s.String()
 }
@@ -7095,27 +9097,35 @@ func (s *SubDomainSetting) SetPrefix(v string) *SubDomainSetting {
 	return s
 }
 
-// Request structure used to tag resource.
+// The request structure to tag a resource with a tag key and value.
 type TagResourceInput struct {
 	_ struct{} `type:"structure"`
 
-	// Resource arn used to tag resource.
+	// The Amazon Resource Name (ARN) to use to tag a resource.
 	//
 	// ResourceArn is a required field
 	ResourceArn *string `location:"uri" locationName:"resourceArn" type:"string" required:"true"`
 
-	// Tags used to tag resource.
+	// The tags used to tag the resource.
 	//
 	// Tags is a required field
-	Tags map[string]*string `locationName:"tags" min:"1" type:"map" required:"true"`
+	Tags map[string]*string `locationName:"tags" type:"map" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceInput) GoString() string {
 	return s.String()
 }
@@ -7132,9 +9142,6 @@ func (s *TagResourceInput) Validate() error {
 	if s.Tags == nil {
 		invalidParams.Add(request.NewErrParamRequired("Tags"))
 	}
-	if s.Tags != nil && len(s.Tags) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
-	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -7154,42 +9161,122 @@ func (s *TagResourceInput) SetTags(v map[string]*string) *TagResourceInput {
 	return s
 }
 
-// Response for tag resource.
+// The response for the tag resource request.
 type TagResourceOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceOutput) GoString() string {
 	return s.String()
 }
 
-// Request structure used to untag resource.
+// An operation failed due to a lack of access.
+type UnauthorizedException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UnauthorizedException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UnauthorizedException) GoString() string {
+	return s.String()
+}
+
+func newErrorUnauthorizedException(v protocol.ResponseMetadata) error {
+	return &UnauthorizedException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *UnauthorizedException) Code() string {
+	return "UnauthorizedException"
+}
+
+// Message returns the exception's message.
+func (s *UnauthorizedException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *UnauthorizedException) OrigErr() error {
+	return nil
+}
+
+func (s *UnauthorizedException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *UnauthorizedException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *UnauthorizedException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The request structure for the untag resource request.
 type UntagResourceInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// Resource arn used to untag resource.
+	// The Amazon Resource Name (ARN) to use to untag a resource.
 	//
 	// ResourceArn is a required field
 	ResourceArn *string `location:"uri" locationName:"resourceArn" type:"string" required:"true"`
 
-	// Tag keys used to untag resource.
+	// The tag keys to use to untag a resource.
 	//
 	// TagKeys is a required field
 	TagKeys []*string `location:"querystring" locationName:"tagKeys" min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceInput) GoString() string {
 	return s.String()
 }
@@ -7228,87 +9315,157 @@ func (s *UntagResourceInput) SetTagKeys(v []*string) *UntagResourceInput {
 	return s
 }
 
-// Response for untag resource.
+// The response for the untag resource request.
 type UntagResourceOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceOutput) GoString() string {
 	return s.String()
 }
 
-// Request structure for update App request.
+// The request structure for the update app request.
 type UpdateAppInput struct {
 	_ struct{} `type:"structure"`
 
-	// Personal Access token for 3rd party source control system for an Amplify
-	// App, used to create webhook and read-only deploy key. Token is not stored.
-	AccessToken *string `locationName:"accessToken" min:"1" type:"string"`
+	// The personal access token for a GitHub repository for an Amplify app. The
+	// personal access token is used to authorize access to a GitHub repository
+	// using the Amplify GitHub App. The token is not stored.
+	//
+	// Use accessToken for GitHub repositories only. To authorize access to a repository
+	// provider such as Bitbucket or CodeCommit, use oauthToken.
+	//
+	// You must specify either accessToken or oauthToken when you update an app.
+	//
+	// Existing Amplify apps deployed from a GitHub repository using OAuth continue
+	// to work with CI/CD. However, we strongly recommend that you migrate these
+	// apps to use the GitHub App. For more information, see Migrating an existing
+	// OAuth app to the Amplify GitHub App (https://docs.aws.amazon.com/amplify/latest/UserGuide/setting-up-GitHub-access.html#migrating-to-github-app-auth)
+	// in the Amplify User Guide .
+	//
+	// AccessToken is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by UpdateAppInput's
+	// String and GoString methods.
+	AccessToken *string `locationName:"accessToken" min:"1" type:"string" sensitive:"true"`
 
-	// Unique Id for an Amplify App.
+	// The unique ID for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 
-	// Automated branch creation branchConfig for the Amplify App.
+	// The automated branch creation configuration for an Amplify app.
 	AutoBranchCreationConfig *AutoBranchCreationConfig `locationName:"autoBranchCreationConfig" type:"structure"`
 
-	// Automated branch creation glob patterns for the Amplify App.
+	// Describes the automated branch creation glob patterns for an Amplify app.
 	AutoBranchCreationPatterns []*string `locationName:"autoBranchCreationPatterns" type:"list"`
 
-	// Basic Authorization credentials for an Amplify App.
-	BasicAuthCredentials *string `locationName:"basicAuthCredentials" type:"string"`
+	// The basic authorization credentials for an Amplify app. You must base64-encode
+	// the authorization credentials and provide them in the format user:password.
+	//
+	// BasicAuthCredentials is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by UpdateAppInput's
+	// String and GoString methods.
+	BasicAuthCredentials *string `locationName:"basicAuthCredentials" type:"string" sensitive:"true"`
+
+	// The build specification (build spec) for an Amplify app.
+	//
+	// BuildSpec is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by UpdateAppInput's
+	// String and GoString methods.
+	BuildSpec *string `locationName:"buildSpec" min:"1" type:"string" sensitive:"true"`
 
-	// BuildSpec for an Amplify App.
-	BuildSpec *string `locationName:"buildSpec" min:"1" type:"string"`
+	// The custom HTTP headers for an Amplify app.
+	CustomHeaders *string `locationName:"customHeaders" type:"string"`
 
-	// Custom redirect / rewrite rules for an Amplify App.
+	// The custom redirect and rewrite rules for an Amplify app.
 	CustomRules []*CustomRule `locationName:"customRules" type:"list"`
 
-	// Description for an Amplify App.
+	// The description for an Amplify app.
 	Description *string `locationName:"description" type:"string"`
 
-	// Enables automated branch creation for the Amplify App.
+	// Enables automated branch creation for an Amplify app.
 	EnableAutoBranchCreation *bool `locationName:"enableAutoBranchCreation" type:"boolean"`
 
-	// Enables Basic Authorization for an Amplify App.
+	// Enables basic authorization for an Amplify app.
 	EnableBasicAuth *bool `locationName:"enableBasicAuth" type:"boolean"`
 
-	// Enables branch auto-building for an Amplify App.
+	// Enables branch auto-building for an Amplify app.
 	EnableBranchAutoBuild *bool `locationName:"enableBranchAutoBuild" type:"boolean"`
 
-	// Environment Variables for an Amplify App.
+	// Automatically disconnects a branch in the Amplify Console when you delete
+	// a branch from your Git repository.
+	EnableBranchAutoDeletion *bool `locationName:"enableBranchAutoDeletion" type:"boolean"`
+
+	// The environment variables for an Amplify app.
 	EnvironmentVariables map[string]*string `locationName:"environmentVariables" type:"map"`
 
-	// IAM service role for an Amplify App.
-	IamServiceRoleArn *string `locationName:"iamServiceRoleArn" min:"1" type:"string"`
+	// The AWS Identity and Access Management (IAM) service role for an Amplify
+	// app.
+	IamServiceRoleArn *string `locationName:"iamServiceRoleArn" type:"string"`
 
-	// Name for an Amplify App.
+	// The name for an Amplify app.
 	Name *string `locationName:"name" min:"1" type:"string"`
 
-	// OAuth token for 3rd party source control system for an Amplify App, used
-	// to create webhook and read-only deploy key. OAuth token is not stored.
-	OauthToken *string `locationName:"oauthToken" type:"string"`
+	// The OAuth token for a third-party source control system for an Amplify app.
+	// The OAuth token is used to create a webhook and a read-only deploy key using
+	// SSH cloning. The OAuth token is not stored.
+	//
+	// Use oauthToken for repository providers other than GitHub, such as Bitbucket
+	// or CodeCommit.
+	//
+	// To authorize access to GitHub as your repository provider, use accessToken.
+	//
+	// You must specify either oauthToken or accessToken when you update an app.
+	//
+	// Existing Amplify apps deployed from a GitHub repository using OAuth continue
+	// to work with CI/CD. However, we strongly recommend that you migrate these
+	// apps to use the GitHub App. For more information, see Migrating an existing
+	// OAuth app to the Amplify GitHub App (https://docs.aws.amazon.com/amplify/latest/UserGuide/setting-up-GitHub-access.html#migrating-to-github-app-auth)
+	// in the Amplify User Guide .
+	//
+	// OauthToken is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by UpdateAppInput's
+	// String and GoString methods.
+	OauthToken *string `locationName:"oauthToken" type:"string" sensitive:"true"`
 
-	// Platform for an Amplify App.
+	// The platform for the Amplify app. For a static app, set the platform type
+	// to WEB. For a dynamic server-side rendered (SSR) app, set the platform type
+	// to WEB_COMPUTE. For an app requiring Amplify Hosting's original SSR support
+	// only, set the platform type to WEB_DYNAMIC.
 	Platform *string `locationName:"platform" type:"string" enum:"Platform"`
 
-	// Repository for an Amplify App
+	// The name of the repository for an Amplify app
 	Repository *string `locationName:"repository" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateAppInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateAppInput) GoString() string {
 	return s.String()
 }
@@ -7328,9 +9485,6 @@ func (s *UpdateAppInput) Validate() error {
 	if s.BuildSpec != nil && len(*s.BuildSpec) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("BuildSpec", 1))
 	}
-	if s.IamServiceRoleArn != nil && len(*s.IamServiceRoleArn) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("IamServiceRoleArn", 1))
-	}
 	if s.Name != nil && len(*s.Name) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
 	}
@@ -7392,6 +9546,12 @@ func (s *UpdateAppInput) SetBuildSpec(v string) *UpdateAppInput {
 	return s
 }
 
+// SetCustomHeaders sets the CustomHeaders field's value.
+func (s *UpdateAppInput) SetCustomHeaders(v string) *UpdateAppInput {
+	s.CustomHeaders = &v
+	return s
+}
+
 // SetCustomRules sets the CustomRules field's value.
 func (s *UpdateAppInput) SetCustomRules(v []*CustomRule) *UpdateAppInput {
 	s.CustomRules = v
@@ -7422,6 +9582,12 @@ func (s *UpdateAppInput) SetEnableBranchAutoBuild(v bool) *UpdateAppInput {
 	return s
 }
 
+// SetEnableBranchAutoDeletion sets the EnableBranchAutoDeletion field's value.
+func (s *UpdateAppInput) SetEnableBranchAutoDeletion(v bool) *UpdateAppInput {
+	s.EnableBranchAutoDeletion = &v
+	return s
+}
+
 // SetEnvironmentVariables sets the EnvironmentVariables field's value.
 func (s *UpdateAppInput) SetEnvironmentVariables(v map[string]*string) *UpdateAppInput {
 	s.EnvironmentVariables = v
@@ -7458,22 +9624,30 @@ func (s *UpdateAppInput) SetRepository(v string) *UpdateAppInput {
 	return s
 }
 
-// Result structure for an Amplify App update request.
+// The result structure for an Amplify app update request.
 type UpdateAppOutput struct {
 	_ struct{} `type:"structure"`
 
-	// App structure for the updated App.
+	// Represents the updated Amplify app.
 	//
 	// App is a required field
 	App *App `locationName:"app" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateAppOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateAppOutput) GoString() string {
 	return s.String()
 }
@@ -7484,63 +9658,94 @@ func (s *UpdateAppOutput) SetApp(v *App) *UpdateAppOutput {
 	return s
 }
 
-// Request structure for update branch request.
+// The request structure for the update branch request.
 type UpdateBranchInput struct {
 	_ struct{} `type:"structure"`
 
-	// Unique Id for an Amplify App.
+	// The unique ID for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 
-	// Basic Authorization credentials for the branch.
-	BasicAuthCredentials *string `locationName:"basicAuthCredentials" type:"string"`
+	// The Amazon Resource Name (ARN) for a backend environment that is part of
+	// an Amplify app.
+	BackendEnvironmentArn *string `locationName:"backendEnvironmentArn" type:"string"`
+
+	// The basic authorization credentials for the branch. You must base64-encode
+	// the authorization credentials and provide them in the format user:password.
+	//
+	// BasicAuthCredentials is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by UpdateBranchInput's
+	// String and GoString methods.
+	BasicAuthCredentials *string `locationName:"basicAuthCredentials" type:"string" sensitive:"true"`
 
-	// Name for the branch.
+	// The name for the branch.
 	//
 	// BranchName is a required field
 	BranchName *string `location:"uri" locationName:"branchName" min:"1" type:"string" required:"true"`
 
-	// BuildSpec for the branch.
-	BuildSpec *string `locationName:"buildSpec" min:"1" type:"string"`
+	// The build specification (build spec) for the branch.
+	//
+	// BuildSpec is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by UpdateBranchInput's
+	// String and GoString methods.
+	BuildSpec *string `locationName:"buildSpec" min:"1" type:"string" sensitive:"true"`
 
-	// Description for the branch.
+	// The description for the branch.
 	Description *string `locationName:"description" type:"string"`
 
-	// Display name for a branch, will use as the default domain prefix.
+	// The display name for a branch. This is used as the default domain prefix.
 	DisplayName *string `locationName:"displayName" type:"string"`
 
 	// Enables auto building for the branch.
 	EnableAutoBuild *bool `locationName:"enableAutoBuild" type:"boolean"`
 
-	// Enables Basic Auth for the branch.
+	// Enables basic authorization for the branch.
 	EnableBasicAuth *bool `locationName:"enableBasicAuth" type:"boolean"`
 
 	// Enables notifications for the branch.
 	EnableNotification *bool `locationName:"enableNotification" type:"boolean"`
 
-	// Enables Pull Request Preview for this branch.
+	// Enables performance mode for the branch.
+	//
+	// Performance mode optimizes for faster hosting performance by keeping content
+	// cached at the edge for a longer interval. When performance mode is enabled,
+	// hosting configuration or code changes can take up to 10 minutes to roll out.
+	EnablePerformanceMode *bool `locationName:"enablePerformanceMode" type:"boolean"`
+
+	// Enables pull request previews for this branch.
 	EnablePullRequestPreview *bool `locationName:"enablePullRequestPreview" type:"boolean"`
 
-	// Environment Variables for the branch.
+	// The environment variables for the branch.
 	EnvironmentVariables map[string]*string `locationName:"environmentVariables" type:"map"`
 
-	// Framework for the branch.
+	// The framework for the branch.
 	Framework *string `locationName:"framework" type:"string"`
 
-	// Stage for the branch.
+	// The Amplify environment name for the pull request.
+	PullRequestEnvironmentName *string `locationName:"pullRequestEnvironmentName" type:"string"`
+
+	// Describes the current stage for the branch.
 	Stage *string `locationName:"stage" type:"string" enum:"Stage"`
 
-	// The content TTL for the website in seconds.
+	// The content Time to Live (TTL) for the website in seconds.
 	Ttl *string `locationName:"ttl" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateBranchInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateBranchInput) GoString() string {
 	return s.String()
 }
@@ -7576,6 +9781,12 @@ func (s *UpdateBranchInput) SetAppId(v string) *UpdateBranchInput {
 	return s
 }
 
+// SetBackendEnvironmentArn sets the BackendEnvironmentArn field's value.
+func (s *UpdateBranchInput) SetBackendEnvironmentArn(v string) *UpdateBranchInput {
+	s.BackendEnvironmentArn = &v
+	return s
+}
+
 // SetBasicAuthCredentials sets the BasicAuthCredentials field's value.
 func (s *UpdateBranchInput) SetBasicAuthCredentials(v string) *UpdateBranchInput {
 	s.BasicAuthCredentials = &v
@@ -7624,6 +9835,12 @@ func (s *UpdateBranchInput) SetEnableNotification(v bool) *UpdateBranchInput {
 	return s
 }
 
+// SetEnablePerformanceMode sets the EnablePerformanceMode field's value.
+func (s *UpdateBranchInput) SetEnablePerformanceMode(v bool) *UpdateBranchInput {
+	s.EnablePerformanceMode = &v
+	return s
+}
+
 // SetEnablePullRequestPreview sets the EnablePullRequestPreview field's value.
 func (s *UpdateBranchInput) SetEnablePullRequestPreview(v bool) *UpdateBranchInput {
 	s.EnablePullRequestPreview = &v
@@ -7642,6 +9859,12 @@ func (s *UpdateBranchInput) SetFramework(v string) *UpdateBranchInput {
 	return s
 }
 
+// SetPullRequestEnvironmentName sets the PullRequestEnvironmentName field's value.
+func (s *UpdateBranchInput) SetPullRequestEnvironmentName(v string) *UpdateBranchInput {
+	s.PullRequestEnvironmentName = &v
+	return s
+}
+
 // SetStage sets the Stage field's value.
 func (s *UpdateBranchInput) SetStage(v string) *UpdateBranchInput {
 	s.Stage = &v
@@ -7654,22 +9877,30 @@ func (s *UpdateBranchInput) SetTtl(v string) *UpdateBranchInput {
 	return s
 }
 
-// Result structure for update branch request.
+// The result structure for the update branch request.
 type UpdateBranchOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Branch structure for an Amplify App.
+	// The branch for an Amplify app, which maps to a third-party repository branch.
 	//
 	// Branch is a required field
 	Branch *Branch `locationName:"branch" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateBranchOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateBranchOutput) GoString() string {
 	return s.String()
 }
@@ -7680,35 +9911,48 @@ func (s *UpdateBranchOutput) SetBranch(v *Branch) *UpdateBranchOutput {
 	return s
 }
 
-// Request structure for update Domain Association request.
+// The request structure for the update domain association request.
 type UpdateDomainAssociationInput struct {
 	_ struct{} `type:"structure"`
 
-	// Unique Id for an Amplify App.
+	// The unique ID for an Amplify app.
 	//
 	// AppId is a required field
 	AppId *string `location:"uri" locationName:"appId" min:"1" type:"string" required:"true"`
 
-	// Name of the domain.
+	// Sets the branch patterns for automatic subdomain creation.
+	AutoSubDomainCreationPatterns []*string `locationName:"autoSubDomainCreationPatterns" type:"list"`
+
+	// The required AWS Identity and Access Management (IAM) service role for the
+	// Amazon Resource Name (ARN) for automatically creating subdomains.
+	AutoSubDomainIAMRole *string `locationName:"autoSubDomainIAMRole" type:"string"`
+
+	// The name of the domain.
 	//
 	// DomainName is a required field
 	DomainName *string `location:"uri" locationName:"domainName" type:"string" required:"true"`
 
-	// Enables automated creation of Subdomains for branches.
+	// Enables the automated creation of subdomains for branches.
 	EnableAutoSubDomain *bool `locationName:"enableAutoSubDomain" type:"boolean"`
 
-	// Setting structure for the Subdomain.
-	//
-	// SubDomainSettings is a required field
-	SubDomainSettings []*SubDomainSetting `locationName:"subDomainSettings" type:"list" required:"true"`
+	// Describes the settings for the subdomain.
+	SubDomainSettings []*SubDomainSetting `locationName:"subDomainSettings" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDomainAssociationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDomainAssociationInput) GoString() string {
 	return s.String()
 }
@@ -7728,9 +9972,6 @@ func (s *UpdateDomainAssociationInput) Validate() error {
 	if s.DomainName != nil && len(*s.DomainName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("DomainName", 1))
 	}
-	if s.SubDomainSettings == nil {
-		invalidParams.Add(request.NewErrParamRequired("SubDomainSettings"))
-	}
 	if s.SubDomainSettings != nil {
 		for i, v := range s.SubDomainSettings {
 			if v == nil {
@@ -7754,6 +9995,18 @@ func (s *UpdateDomainAssociationInput) SetAppId(v string) *UpdateDomainAssociati
 	return s
 }
 
+// SetAutoSubDomainCreationPatterns sets the AutoSubDomainCreationPatterns field's value.
+func (s *UpdateDomainAssociationInput) SetAutoSubDomainCreationPatterns(v []*string) *UpdateDomainAssociationInput {
+	s.AutoSubDomainCreationPatterns = v
+	return s
+}
+
+// SetAutoSubDomainIAMRole sets the AutoSubDomainIAMRole field's value.
+func (s *UpdateDomainAssociationInput) SetAutoSubDomainIAMRole(v string) *UpdateDomainAssociationInput {
+	s.AutoSubDomainIAMRole = &v
+	return s
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *UpdateDomainAssociationInput) SetDomainName(v string) *UpdateDomainAssociationInput {
 	s.DomainName = &v
@@ -7772,22 +10025,31 @@ func (s *UpdateDomainAssociationInput) SetSubDomainSettings(v []*SubDomainSettin
 	return s
 }
 
-// Result structure for the update Domain Association request.
+// The result structure for the update domain association request.
 type UpdateDomainAssociationOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Domain Association structure.
+	// Describes a domain association, which associates a custom domain with an
+	// Amplify app.
 	//
 	// DomainAssociation is a required field
 	DomainAssociation *DomainAssociation `locationName:"domainAssociation" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDomainAssociationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDomainAssociationOutput) GoString() string {
 	return s.String()
 }
@@ -7798,28 +10060,36 @@ func (s *UpdateDomainAssociationOutput) SetDomainAssociation(v *DomainAssociatio
 	return s
 }
 
-// Request structure for update webhook request.
+// The request structure for the update webhook request.
 type UpdateWebhookInput struct {
 	_ struct{} `type:"structure"`
 
-	// Name for a branch, part of an Amplify App.
+	// The name for a branch that is part of an Amplify app.
 	BranchName *string `locationName:"branchName" min:"1" type:"string"`
 
-	// Description for a webhook.
+	// The description for a webhook.
 	Description *string `locationName:"description" type:"string"`
 
-	// Unique Id for a webhook.
+	// The unique ID for a webhook.
 	//
 	// WebhookId is a required field
 	WebhookId *string `location:"uri" locationName:"webhookId" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateWebhookInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateWebhookInput) GoString() string {
 	return s.String()
 }
@@ -7861,22 +10131,30 @@ func (s *UpdateWebhookInput) SetWebhookId(v string) *UpdateWebhookInput {
 	return s
 }
 
-// Result structure for the update webhook request.
+// The result structure for the update webhook request.
 type UpdateWebhookOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Webhook structure.
+	// Describes a webhook that connects repository events to an Amplify app.
 	//
 	// Webhook is a required field
 	Webhook *Webhook `locationName:"webhook" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateWebhookOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateWebhookOutput) GoString() string {
 	return s.String()
 }
@@ -7887,52 +10165,60 @@ func (s *UpdateWebhookOutput) SetWebhook(v *Webhook) *UpdateWebhookOutput {
 	return s
 }
 
-// Structure for webhook, which associates a webhook with an Amplify App.
+// Describes a webhook that connects repository events to an Amplify app.
 type Webhook struct {
 	_ struct{} `type:"structure"`
 
-	// Name for a branch, part of an Amplify App.
+	// The name for a branch that is part of an Amplify app.
 	//
 	// BranchName is a required field
 	BranchName *string `locationName:"branchName" min:"1" type:"string" required:"true"`
 
-	// Create date / time for a webhook.
+	// The create date and time for a webhook.
 	//
 	// CreateTime is a required field
 	CreateTime *time.Time `locationName:"createTime" type:"timestamp" required:"true"`
 
-	// Description for a webhook.
+	// The description for a webhook.
 	//
 	// Description is a required field
 	Description *string `locationName:"description" type:"string" required:"true"`
 
-	// Update date / time for a webhook.
+	// Updates the date and time for a webhook.
 	//
 	// UpdateTime is a required field
 	UpdateTime *time.Time `locationName:"updateTime" type:"timestamp" required:"true"`
 
-	// ARN for the webhook.
+	// The Amazon Resource Name (ARN) for the webhook.
 	//
 	// WebhookArn is a required field
 	WebhookArn *string `locationName:"webhookArn" type:"string" required:"true"`
 
-	// Id of the webhook.
+	// The ID of the webhook.
 	//
 	// WebhookId is a required field
 	WebhookId *string `locationName:"webhookId" type:"string" required:"true"`
 
-	// Url of the webhook.
+	// The URL of the webhook.
 	//
 	// WebhookUrl is a required field
 	WebhookUrl *string `locationName:"webhookUrl" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Webhook) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Webhook) GoString() string {
 	return s.String()
 }
@@ -7979,11 +10265,6 @@ func (s *Webhook) SetWebhookUrl(v string) *Webhook {
 	return s
 }
 
-const (
-	// ArtifactTypeTest is a ArtifactType enum value
-	ArtifactTypeTest = "TEST"
-)
-
 const (
 	// DomainStatusPendingVerification is a DomainStatus enum value
 	DomainStatusPendingVerification = "PENDING_VERIFICATION"
@@ -8010,6 +10291,20 @@ const (
 	DomainStatusUpdating = "UPDATING"
 )
 
+// DomainStatus_Values returns all elements of the DomainStatus enum
+func DomainStatus_Values() []string {
+	return []string{
+		DomainStatusPendingVerification,
+		DomainStatusInProgress,
+		DomainStatusAvailable,
+		DomainStatusPendingDeployment,
+		DomainStatusFailed,
+		DomainStatusCreating,
+		DomainStatusRequestingCertificate,
+		DomainStatusUpdating,
+	}
+}
+
 const (
 	// JobStatusPending is a JobStatus enum value
 	JobStatusPending = "PENDING"
@@ -8033,6 +10328,19 @@ const (
 	JobStatusCancelled = "CANCELLED"
 )
 
+// JobStatus_Values returns all elements of the JobStatus enum
+func JobStatus_Values() []string {
+	return []string{
+		JobStatusPending,
+		JobStatusProvisioning,
+		JobStatusRunning,
+		JobStatusFailed,
+		JobStatusSucceed,
+		JobStatusCancelling,
+		JobStatusCancelled,
+	}
+}
+
 const (
 	// JobTypeRelease is a JobType enum value
 	JobTypeRelease = "RELEASE"
@@ -8047,11 +10355,56 @@ const (
 	JobTypeWebHook = "WEB_HOOK"
 )
 
+// JobType_Values returns all elements of the JobType enum
+func JobType_Values() []string {
+	return []string{
+		JobTypeRelease,
+		JobTypeRetry,
+		JobTypeManual,
+		JobTypeWebHook,
+	}
+}
+
 const (
 	// PlatformWeb is a Platform enum value
 	PlatformWeb = "WEB"
+
+	// PlatformWebDynamic is a Platform enum value
+	PlatformWebDynamic = "WEB_DYNAMIC"
+
+	// PlatformWebCompute is a Platform enum value
+	PlatformWebCompute = "WEB_COMPUTE"
+)
+
+// Platform_Values returns all elements of the Platform enum
+func Platform_Values() []string {
+	return []string{
+		PlatformWeb,
+		PlatformWebDynamic,
+		PlatformWebCompute,
+	}
+}
+
+const (
+	// RepositoryCloneMethodSsh is a RepositoryCloneMethod enum value
+	RepositoryCloneMethodSsh = "SSH"
+
+	// RepositoryCloneMethodToken is a RepositoryCloneMethod enum value
+	RepositoryCloneMethodToken = "TOKEN"
+
+	// RepositoryCloneMethodSigv4 is a RepositoryCloneMethod enum value
+	RepositoryCloneMethodSigv4 = "SIGV4"
 )
 
+// RepositoryCloneMethod_Values returns all elements of the RepositoryCloneMethod enum
+func RepositoryCloneMethod_Values() []string {
+	return []string{
+		RepositoryCloneMethodSsh,
+		RepositoryCloneMethodToken,
+		RepositoryCloneMethodSigv4,
+	}
+}
+
 const (
 	// StageProduction is a Stage enum value
 	StageProduction = "PRODUCTION"
@@ -8068,3 +10421,14 @@ const (
 	// StagePullRequest is a Stage enum value
 	StagePullRequest = "PULL_REQUEST"
 )
+
+// Stage_Values returns all elements of the Stage enum
+func Stage_Values() []string {
+	return []string{
+		StageProduction,
+		StageBeta,
+		StageDevelopment,
+		StageExperimental,
+		StagePullRequest,
+	}
+}
@@ -3,15 +3,20 @@
 // Package amplify provides the client and types for making API
 // requests to AWS Amplify.
 //
-// Amplify is a fully managed continuous deployment and hosting service for
-// modern web apps.
+// Amplify enables developers to develop and deploy cloud-powered mobile and
+// web apps. The Amplify Console provides a continuous delivery and hosting
+// service for web applications. For more information, see the Amplify Console
+// User Guide (https://docs.aws.amazon.com/amplify/latest/userguide/welcome.html).
+// The Amplify Framework is a comprehensive set of SDKs, libraries, tools, and
+// documentation for client app development. For more information, see the Amplify
+// Framework. (https://docs.amplify.aws/)
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/amplify-2017-07-25 for more information on this service.
 //
 // See amplify package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/amplify/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact AWS Amplify with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -6,52 +6,66 @@
 // With Application Auto Scaling, you can configure automatic scaling for the
 // following resources:
 //
-//    * Amazon ECS services
+//   - Amazon AppStream 2.0 fleets
 //
-//    * Amazon EC2 Spot Fleet requests
+//   - Amazon Aurora Replicas
 //
-//    * Amazon EMR clusters
+//   - Amazon Comprehend document classification and entity recognizer endpoints
 //
-//    * Amazon AppStream 2.0 fleets
+//   - Amazon DynamoDB tables and global secondary indexes throughput capacity
 //
-//    * Amazon DynamoDB tables and global secondary indexes throughput capacity
+//   - Amazon ECS services
 //
-//    * Amazon Aurora Replicas
+//   - Amazon ElastiCache for Redis clusters (replication groups)
 //
-//    * Amazon SageMaker endpoint variants
+//   - Amazon EMR clusters
 //
-//    * Custom resources provided by your own applications or services
+//   - Amazon Keyspaces (for Apache Cassandra) tables
 //
-// API Summary
+//   - Lambda function provisioned concurrency
 //
-// The Application Auto Scaling service API includes three key sets of actions:
+//   - Amazon Managed Streaming for Apache Kafka broker storage
+//
+//   - Amazon Neptune clusters
+//
+//   - Amazon SageMaker endpoint variants
+//
+//   - Amazon SageMaker Serverless endpoint provisioned concurrency
+//
+//   - Spot Fleets (Amazon EC2)
 //
-//    * Register and manage scalable targets - Register AWS or custom resources
-//    as scalable targets (a resource that Application Auto Scaling can scale),
-//    set minimum and maximum capacity limits, and retrieve information on existing
-//    scalable targets.
+//   - Custom resources provided by your own applications or services
+//
+// To learn more about Application Auto Scaling, see the Application Auto Scaling
+// User Guide (https://docs.aws.amazon.com/autoscaling/application/userguide/what-is-application-auto-scaling.html).
+//
+// # API Summary
+//
+// The Application Auto Scaling service API includes three key sets of actions:
 //
-//    * Configure and manage automatic scaling - Define scaling policies to
-//    dynamically scale your resources in response to CloudWatch alarms, schedule
-//    one-time or recurring scaling actions, and retrieve your recent scaling
-//    activity history.
+//   - Register and manage scalable targets - Register Amazon Web Services
+//     or custom resources as scalable targets (a resource that Application Auto
+//     Scaling can scale), set minimum and maximum capacity limits, and retrieve
+//     information on existing scalable targets.
 //
-//    * Suspend and resume scaling - Temporarily suspend and later resume automatic
-//    scaling by calling the RegisterScalableTarget action for any Application
-//    Auto Scaling scalable target. You can suspend and resume, individually
-//    or in combination, scale-out activities triggered by a scaling policy,
-//    scale-in activities triggered by a scaling policy, and scheduled scaling.
+//   - Configure and manage automatic scaling - Define scaling policies to
+//     dynamically scale your resources in response to CloudWatch alarms, schedule
+//     one-time or recurring scaling actions, and retrieve your recent scaling
+//     activity history.
 //
-// To learn more about Application Auto Scaling, including information about
-// granting IAM users required permissions for Application Auto Scaling actions,
-// see the Application Auto Scaling User Guide (https://docs.aws.amazon.com/autoscaling/application/userguide/what-is-application-auto-scaling.html).
+//   - Suspend and resume scaling - Temporarily suspend and later resume automatic
+//     scaling by calling the RegisterScalableTarget (https://docs.aws.amazon.com/autoscaling/application/APIReference/API_RegisterScalableTarget.html)
+//     API action for any Application Auto Scaling scalable target. You can suspend
+//     and resume (individually or in combination) scale-out activities that
+//     are triggered by a scaling policy, scale-in activities that are triggered
+//     by a scaling policy, and scheduled scaling.
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/application-autoscaling-2016-02-06 for more information on this service.
 //
 // See applicationautoscaling package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/applicationautoscaling/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Application Auto Scaling with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -2,6 +2,10 @@
 
 package applicationautoscaling
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeConcurrentUpdateException for service response error code
@@ -38,7 +42,7 @@ const (
 	// "LimitExceededException".
 	//
 	// A per-account resource limit is exceeded. For more information, see Application
-	// Auto Scaling Limits (https://docs.aws.amazon.com/ApplicationAutoScaling/latest/userguide/application-auto-scaling-limits.html).
+	// Auto Scaling service quotas (https://docs.aws.amazon.com/autoscaling/application/userguide/application-auto-scaling-limits.html).
 	ErrCodeLimitExceededException = "LimitExceededException"
 
 	// ErrCodeObjectNotFoundException for service response error code
@@ -51,6 +55,18 @@ const (
 	// this exception is thrown if the resource cannot be found.
 	ErrCodeObjectNotFoundException = "ObjectNotFoundException"
 
+	// ErrCodeResourceNotFoundException for service response error code
+	// "ResourceNotFoundException".
+	//
+	// The specified resource doesn't exist.
+	ErrCodeResourceNotFoundException = "ResourceNotFoundException"
+
+	// ErrCodeTooManyTagsException for service response error code
+	// "TooManyTagsException".
+	//
+	// The request contains too many tags. Try the request again with fewer tags.
+	ErrCodeTooManyTagsException = "TooManyTagsException"
+
 	// ErrCodeValidationException for service response error code
 	// "ValidationException".
 	//
@@ -58,3 +74,15 @@ const (
 	// for the API request.
 	ErrCodeValidationException = "ValidationException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"ConcurrentUpdateException":     newErrorConcurrentUpdateException,
+	"FailedResourceAccessException": newErrorFailedResourceAccessException,
+	"InternalServiceException":      newErrorInternalServiceException,
+	"InvalidNextTokenException":     newErrorInvalidNextTokenException,
+	"LimitExceededException":        newErrorLimitExceededException,
+	"ObjectNotFoundException":       newErrorObjectNotFoundException,
+	"ResourceNotFoundException":     newErrorResourceNotFoundException,
+	"TooManyTagsException":          newErrorTooManyTagsException,
+	"ValidationException":           newErrorValidationException,
+}
@@ -13,6 +13,119 @@ import (
 	"github.com/aws/aws-sdk-go/private/protocol/restjson"
 )
 
+const opCreateGatewayRoute = "CreateGatewayRoute"
+
+// CreateGatewayRouteRequest generates a "aws/request.Request" representing the
+// client's request for the CreateGatewayRoute operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See CreateGatewayRoute for more information on using the CreateGatewayRoute
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the CreateGatewayRouteRequest method.
+//	req, resp := client.CreateGatewayRouteRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/CreateGatewayRoute
+func (c *AppMesh) CreateGatewayRouteRequest(input *CreateGatewayRouteInput) (req *request.Request, output *CreateGatewayRouteOutput) {
+	op := &request.Operation{
+		Name:       opCreateGatewayRoute,
+		HTTPMethod: "PUT",
+		HTTPPath:   "/v20190125/meshes/{meshName}/virtualGateway/{virtualGatewayName}/gatewayRoutes",
+	}
+
+	if input == nil {
+		input = &CreateGatewayRouteInput{}
+	}
+
+	output = &CreateGatewayRouteOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// CreateGatewayRoute API operation for AWS App Mesh.
+//
+// Creates a gateway route.
+//
+// A gateway route is attached to a virtual gateway and routes traffic to an
+// existing virtual service. If a route matches a request, it can distribute
+// traffic to a target virtual service.
+//
+// For more information about gateway routes, see Gateway routes (https://docs.aws.amazon.com/app-mesh/latest/userguide/gateway-routes.html).
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS App Mesh's
+// API operation CreateGatewayRoute for usage and error information.
+//
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
+//
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
+//
+//   - ConflictException
+//     The request contains a client token that was used for a previous update resource
+//     call with different specifications. Try the request again with a new client
+//     token.
+//
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
+//
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
+//
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
+//
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
+//
+//   - LimitExceededException
+//     You have exceeded a service limit for your account. For more information,
+//     see Service Limits (https://docs.aws.amazon.com/app-mesh/latest/userguide/service-quotas.html)
+//     in the App Mesh User Guide.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/CreateGatewayRoute
+func (c *AppMesh) CreateGatewayRoute(input *CreateGatewayRouteInput) (*CreateGatewayRouteOutput, error) {
+	req, out := c.CreateGatewayRouteRequest(input)
+	return out, req.Send()
+}
+
+// CreateGatewayRouteWithContext is the same as CreateGatewayRoute with the addition of
+// the ability to pass a context and additional request options.
+//
+// See CreateGatewayRoute for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *AppMesh) CreateGatewayRouteWithContext(ctx aws.Context, input *CreateGatewayRouteInput, opts ...request.Option) (*CreateGatewayRouteOutput, error) {
+	req, out := c.CreateGatewayRouteRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opCreateMesh = "CreateMesh"
 
 // CreateMeshRequest generates a "aws/request.Request" representing the
@@ -29,14 +142,13 @@ const opCreateMesh = "CreateMesh"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateMeshRequest method.
+//	req, resp := client.CreateMeshRequest(params)
 //
-//    // Example sending a request using the CreateMeshRequest method.
-//    req, resp := client.CreateMeshRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/CreateMesh
 func (c *AppMesh) CreateMeshRequest(input *CreateMeshInput) (req *request.Request, output *CreateMeshOutput) {
@@ -57,12 +169,14 @@ func (c *AppMesh) CreateMeshRequest(input *CreateMeshInput) (req *request.Reques
 
 // CreateMesh API operation for AWS App Mesh.
 //
-// Creates a service mesh. A service mesh is a logical boundary for network
-// traffic between the services that reside within it.
+// Creates a service mesh.
 //
-// After you create your service mesh, you can create virtual services, virtual
-// nodes, virtual routers, and routes to distribute traffic between the applications
-// in your mesh.
+// A service mesh is a logical boundary for network traffic between services
+// that are represented by resources within the mesh. After you create your
+// service mesh, you can create virtual services, virtual nodes, virtual routers,
+// and routes to distribute traffic between the applications in your mesh.
+//
+// For more information about service meshes, see Service meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/meshes.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -71,37 +185,38 @@ func (c *AppMesh) CreateMeshRequest(input *CreateMeshInput) (req *request.Reques
 // See the AWS API reference guide for AWS App Mesh's
 // API operation CreateMesh for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeConflictException "ConflictException"
-//   The request contains a client token that was used for a previous update resource
-//   call with different specifications. Try the request again with a new client
-//   token.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - ConflictException
+//     The request contains a client token that was used for a previous update resource
+//     call with different specifications. Try the request again with a new client
+//     token.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   You have exceeded a service limit for your account. For more information,
-//   see Service Limits (https://docs.aws.amazon.com/app-mesh/latest/userguide/service_limits.html)
-//   in the AWS App Mesh User Guide.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - LimitExceededException
+//     You have exceeded a service limit for your account. For more information,
+//     see Service Limits (https://docs.aws.amazon.com/app-mesh/latest/userguide/service-quotas.html)
+//     in the App Mesh User Guide.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/CreateMesh
 func (c *AppMesh) CreateMesh(input *CreateMeshInput) (*CreateMeshOutput, error) {
@@ -141,14 +256,13 @@ const opCreateRoute = "CreateRoute"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateRouteRequest method.
+//	req, resp := client.CreateRouteRequest(params)
 //
-//    // Example sending a request using the CreateRouteRequest method.
-//    req, resp := client.CreateRouteRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/CreateRoute
 func (c *AppMesh) CreateRouteRequest(input *CreateRouteInput) (req *request.Request, output *CreateRouteOutput) {
@@ -171,13 +285,10 @@ func (c *AppMesh) CreateRouteRequest(input *CreateRouteInput) (req *request.Requ
 //
 // Creates a route that is associated with a virtual router.
 //
-// You can use the prefix parameter in your route specification for path-based
-// routing of requests. For example, if your virtual service name is my-service.local
-// and you want the route to match requests to my-service.local/metrics, your
-// prefix should be /metrics.
+// You can route several different protocols and define a retry policy for a
+// route. Traffic can be routed to one or more virtual nodes.
 //
-// If your route matches a request, you can distribute traffic to one or more
-// target virtual nodes with relative weighting.
+// For more information about routes, see Routes (https://docs.aws.amazon.com/app-mesh/latest/userguide/routes.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -186,37 +297,38 @@ func (c *AppMesh) CreateRouteRequest(input *CreateRouteInput) (req *request.Requ
 // See the AWS API reference guide for AWS App Mesh's
 // API operation CreateRoute for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeConflictException "ConflictException"
-//   The request contains a client token that was used for a previous update resource
-//   call with different specifications. Try the request again with a new client
-//   token.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - ConflictException
+//     The request contains a client token that was used for a previous update resource
+//     call with different specifications. Try the request again with a new client
+//     token.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   You have exceeded a service limit for your account. For more information,
-//   see Service Limits (https://docs.aws.amazon.com/app-mesh/latest/userguide/service_limits.html)
-//   in the AWS App Mesh User Guide.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - LimitExceededException
+//     You have exceeded a service limit for your account. For more information,
+//     see Service Limits (https://docs.aws.amazon.com/app-mesh/latest/userguide/service-quotas.html)
+//     in the App Mesh User Guide.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/CreateRoute
 func (c *AppMesh) CreateRoute(input *CreateRouteInput) (*CreateRouteOutput, error) {
@@ -240,6 +352,121 @@ func (c *AppMesh) CreateRouteWithContext(ctx aws.Context, input *CreateRouteInpu
 	return out, req.Send()
 }
 
+const opCreateVirtualGateway = "CreateVirtualGateway"
+
+// CreateVirtualGatewayRequest generates a "aws/request.Request" representing the
+// client's request for the CreateVirtualGateway operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See CreateVirtualGateway for more information on using the CreateVirtualGateway
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the CreateVirtualGatewayRequest method.
+//	req, resp := client.CreateVirtualGatewayRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/CreateVirtualGateway
+func (c *AppMesh) CreateVirtualGatewayRequest(input *CreateVirtualGatewayInput) (req *request.Request, output *CreateVirtualGatewayOutput) {
+	op := &request.Operation{
+		Name:       opCreateVirtualGateway,
+		HTTPMethod: "PUT",
+		HTTPPath:   "/v20190125/meshes/{meshName}/virtualGateways",
+	}
+
+	if input == nil {
+		input = &CreateVirtualGatewayInput{}
+	}
+
+	output = &CreateVirtualGatewayOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// CreateVirtualGateway API operation for AWS App Mesh.
+//
+// Creates a virtual gateway.
+//
+// A virtual gateway allows resources outside your mesh to communicate to resources
+// that are inside your mesh. The virtual gateway represents an Envoy proxy
+// running in an Amazon ECS task, in a Kubernetes service, or on an Amazon EC2
+// instance. Unlike a virtual node, which represents an Envoy running with an
+// application, a virtual gateway represents Envoy deployed by itself.
+//
+// For more information about virtual gateways, see Virtual gateways (https://docs.aws.amazon.com/app-mesh/latest/userguide/virtual_gateways.html).
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS App Mesh's
+// API operation CreateVirtualGateway for usage and error information.
+//
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
+//
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
+//
+//   - ConflictException
+//     The request contains a client token that was used for a previous update resource
+//     call with different specifications. Try the request again with a new client
+//     token.
+//
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
+//
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
+//
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
+//
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
+//
+//   - LimitExceededException
+//     You have exceeded a service limit for your account. For more information,
+//     see Service Limits (https://docs.aws.amazon.com/app-mesh/latest/userguide/service-quotas.html)
+//     in the App Mesh User Guide.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/CreateVirtualGateway
+func (c *AppMesh) CreateVirtualGateway(input *CreateVirtualGatewayInput) (*CreateVirtualGatewayOutput, error) {
+	req, out := c.CreateVirtualGatewayRequest(input)
+	return out, req.Send()
+}
+
+// CreateVirtualGatewayWithContext is the same as CreateVirtualGateway with the addition of
+// the ability to pass a context and additional request options.
+//
+// See CreateVirtualGateway for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *AppMesh) CreateVirtualGatewayWithContext(ctx aws.Context, input *CreateVirtualGatewayInput, opts ...request.Option) (*CreateVirtualGatewayOutput, error) {
+	req, out := c.CreateVirtualGatewayRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opCreateVirtualNode = "CreateVirtualNode"
 
 // CreateVirtualNodeRequest generates a "aws/request.Request" representing the
@@ -256,14 +483,13 @@ const opCreateVirtualNode = "CreateVirtualNode"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateVirtualNodeRequest method.
+//	req, resp := client.CreateVirtualNodeRequest(params)
 //
-//    // Example sending a request using the CreateVirtualNodeRequest method.
-//    req, resp := client.CreateVirtualNodeRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/CreateVirtualNode
 func (c *AppMesh) CreateVirtualNodeRequest(input *CreateVirtualNodeInput) (req *request.Request, output *CreateVirtualNodeOutput) {
@@ -288,22 +514,30 @@ func (c *AppMesh) CreateVirtualNodeRequest(input *CreateVirtualNodeInput) (req *
 //
 // A virtual node acts as a logical pointer to a particular task group, such
 // as an Amazon ECS service or a Kubernetes deployment. When you create a virtual
-// node, you can specify the service discovery information for your task group.
+// node, you can specify the service discovery information for your task group,
+// and whether the proxy running in a task group will communicate with other
+// proxies using Transport Layer Security (TLS).
 //
-// Any inbound traffic that your virtual node expects should be specified as
-// a listener. Any outbound traffic that your virtual node expects to reach
-// should be specified as a backend.
+// You define a listener for any inbound traffic that your virtual node expects.
+// Any virtual service that your virtual node expects to communicate to is specified
+// as a backend.
 //
 // The response metadata for your new virtual node contains the arn that is
-// associated with the virtual node. Set this value (either the full ARN or
-// the truncated resource name: for example, mesh/default/virtualNode/simpleapp)
-// as the APPMESH_VIRTUAL_NODE_NAME environment variable for your task group's
-// Envoy proxy container in your task definition or pod spec. This is then mapped
+// associated with the virtual node. Set this value to the full ARN; for example,
+// arn:aws:appmesh:us-west-2:123456789012:myMesh/default/virtualNode/myApp)
+// as the APPMESH_RESOURCE_ARN environment variable for your task group's Envoy
+// proxy container in your task definition or pod spec. This is then mapped
 // to the node.id and node.cluster Envoy parameters.
 //
-// If you require your Envoy stats or tracing to use a different name, you can
-// override the node.cluster value that is set by APPMESH_VIRTUAL_NODE_NAME
-// with the APPMESH_VIRTUAL_NODE_CLUSTER environment variable.
+// By default, App Mesh uses the name of the resource you specified in APPMESH_RESOURCE_ARN
+// when Envoy is referring to itself in metrics and traces. You can override
+// this behavior by setting the APPMESH_RESOURCE_CLUSTER environment variable
+// with your own name.
+//
+// For more information about virtual nodes, see Virtual nodes (https://docs.aws.amazon.com/app-mesh/latest/userguide/virtual_nodes.html).
+// You must be using 1.15.0 or later of the Envoy image when setting these variables.
+// For more information aboutApp Mesh Envoy variables, see Envoy image (https://docs.aws.amazon.com/app-mesh/latest/userguide/envoy.html)
+// in the App Mesh User Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -312,37 +546,38 @@ func (c *AppMesh) CreateVirtualNodeRequest(input *CreateVirtualNodeInput) (req *
 // See the AWS API reference guide for AWS App Mesh's
 // API operation CreateVirtualNode for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeConflictException "ConflictException"
-//   The request contains a client token that was used for a previous update resource
-//   call with different specifications. Try the request again with a new client
-//   token.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - ConflictException
+//     The request contains a client token that was used for a previous update resource
+//     call with different specifications. Try the request again with a new client
+//     token.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   You have exceeded a service limit for your account. For more information,
-//   see Service Limits (https://docs.aws.amazon.com/app-mesh/latest/userguide/service_limits.html)
-//   in the AWS App Mesh User Guide.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - LimitExceededException
+//     You have exceeded a service limit for your account. For more information,
+//     see Service Limits (https://docs.aws.amazon.com/app-mesh/latest/userguide/service-quotas.html)
+//     in the App Mesh User Guide.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/CreateVirtualNode
 func (c *AppMesh) CreateVirtualNode(input *CreateVirtualNodeInput) (*CreateVirtualNodeOutput, error) {
@@ -382,14 +617,13 @@ const opCreateVirtualRouter = "CreateVirtualRouter"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateVirtualRouterRequest method.
+//	req, resp := client.CreateVirtualRouterRequest(params)
 //
-//    // Example sending a request using the CreateVirtualRouterRequest method.
-//    req, resp := client.CreateVirtualRouterRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/CreateVirtualRouter
 func (c *AppMesh) CreateVirtualRouterRequest(input *CreateVirtualRouterInput) (req *request.Request, output *CreateVirtualRouterOutput) {
@@ -412,13 +646,14 @@ func (c *AppMesh) CreateVirtualRouterRequest(input *CreateVirtualRouterInput) (r
 //
 // Creates a virtual router within a service mesh.
 //
-// Any inbound traffic that your virtual router expects should be specified
-// as a listener.
-//
+// Specify a listener for any inbound traffic that your virtual router receives.
+// Create a virtual router for each protocol and port that you need to route.
 // Virtual routers handle traffic for one or more virtual services within your
 // mesh. After you create your virtual router, create and associate routes for
 // your virtual router that direct incoming requests to different virtual nodes.
 //
+// For more information about virtual routers, see Virtual routers (https://docs.aws.amazon.com/app-mesh/latest/userguide/virtual_routers.html).
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -426,37 +661,38 @@ func (c *AppMesh) CreateVirtualRouterRequest(input *CreateVirtualRouterInput) (r
 // See the AWS API reference guide for AWS App Mesh's
 // API operation CreateVirtualRouter for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeConflictException "ConflictException"
-//   The request contains a client token that was used for a previous update resource
-//   call with different specifications. Try the request again with a new client
-//   token.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - ConflictException
+//     The request contains a client token that was used for a previous update resource
+//     call with different specifications. Try the request again with a new client
+//     token.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   You have exceeded a service limit for your account. For more information,
-//   see Service Limits (https://docs.aws.amazon.com/app-mesh/latest/userguide/service_limits.html)
-//   in the AWS App Mesh User Guide.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - LimitExceededException
+//     You have exceeded a service limit for your account. For more information,
+//     see Service Limits (https://docs.aws.amazon.com/app-mesh/latest/userguide/service-quotas.html)
+//     in the App Mesh User Guide.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/CreateVirtualRouter
 func (c *AppMesh) CreateVirtualRouter(input *CreateVirtualRouterInput) (*CreateVirtualRouterOutput, error) {
@@ -496,14 +732,13 @@ const opCreateVirtualService = "CreateVirtualService"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateVirtualServiceRequest method.
+//	req, resp := client.CreateVirtualServiceRequest(params)
 //
-//    // Example sending a request using the CreateVirtualServiceRequest method.
-//    req, resp := client.CreateVirtualServiceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/CreateVirtualService
 func (c *AppMesh) CreateVirtualServiceRequest(input *CreateVirtualServiceInput) (req *request.Request, output *CreateVirtualServiceOutput) {
@@ -532,6 +767,8 @@ func (c *AppMesh) CreateVirtualServiceRequest(input *CreateVirtualServiceInput)
 // are routed to the virtual node or virtual router that is specified as the
 // provider for the virtual service.
 //
+// For more information about virtual services, see Virtual services (https://docs.aws.amazon.com/app-mesh/latest/userguide/virtual_services.html).
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -539,37 +776,38 @@ func (c *AppMesh) CreateVirtualServiceRequest(input *CreateVirtualServiceInput)
 // See the AWS API reference guide for AWS App Mesh's
 // API operation CreateVirtualService for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
 //
-//   * ErrCodeConflictException "ConflictException"
-//   The request contains a client token that was used for a previous update resource
-//   call with different specifications. Try the request again with a new client
-//   token.
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - ConflictException
+//     The request contains a client token that was used for a previous update resource
+//     call with different specifications. Try the request again with a new client
+//     token.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   You have exceeded a service limit for your account. For more information,
-//   see Service Limits (https://docs.aws.amazon.com/app-mesh/latest/userguide/service_limits.html)
-//   in the AWS App Mesh User Guide.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
+//
+//   - LimitExceededException
+//     You have exceeded a service limit for your account. For more information,
+//     see Service Limits (https://docs.aws.amazon.com/app-mesh/latest/userguide/service-quotas.html)
+//     in the App Mesh User Guide.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/CreateVirtualService
 func (c *AppMesh) CreateVirtualService(input *CreateVirtualServiceInput) (*CreateVirtualServiceOutput, error) {
@@ -593,6 +831,107 @@ func (c *AppMesh) CreateVirtualServiceWithContext(ctx aws.Context, input *Create
 	return out, req.Send()
 }
 
+const opDeleteGatewayRoute = "DeleteGatewayRoute"
+
+// DeleteGatewayRouteRequest generates a "aws/request.Request" representing the
+// client's request for the DeleteGatewayRoute operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See DeleteGatewayRoute for more information on using the DeleteGatewayRoute
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the DeleteGatewayRouteRequest method.
+//	req, resp := client.DeleteGatewayRouteRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DeleteGatewayRoute
+func (c *AppMesh) DeleteGatewayRouteRequest(input *DeleteGatewayRouteInput) (req *request.Request, output *DeleteGatewayRouteOutput) {
+	op := &request.Operation{
+		Name:       opDeleteGatewayRoute,
+		HTTPMethod: "DELETE",
+		HTTPPath:   "/v20190125/meshes/{meshName}/virtualGateway/{virtualGatewayName}/gatewayRoutes/{gatewayRouteName}",
+	}
+
+	if input == nil {
+		input = &DeleteGatewayRouteInput{}
+	}
+
+	output = &DeleteGatewayRouteOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// DeleteGatewayRoute API operation for AWS App Mesh.
+//
+// Deletes an existing gateway route.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS App Mesh's
+// API operation DeleteGatewayRoute for usage and error information.
+//
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
+//
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
+//
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
+//
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
+//
+//   - ResourceInUseException
+//     You can't delete the specified resource because it's in use or required by
+//     another resource.
+//
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
+//
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DeleteGatewayRoute
+func (c *AppMesh) DeleteGatewayRoute(input *DeleteGatewayRouteInput) (*DeleteGatewayRouteOutput, error) {
+	req, out := c.DeleteGatewayRouteRequest(input)
+	return out, req.Send()
+}
+
+// DeleteGatewayRouteWithContext is the same as DeleteGatewayRoute with the addition of
+// the ability to pass a context and additional request options.
+//
+// See DeleteGatewayRoute for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *AppMesh) DeleteGatewayRouteWithContext(ctx aws.Context, input *DeleteGatewayRouteInput, opts ...request.Option) (*DeleteGatewayRouteOutput, error) {
+	req, out := c.DeleteGatewayRouteRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opDeleteMesh = "DeleteMesh"
 
 // DeleteMeshRequest generates a "aws/request.Request" representing the
@@ -609,14 +948,13 @@ const opDeleteMesh = "DeleteMesh"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteMeshRequest method.
+//	req, resp := client.DeleteMeshRequest(params)
 //
-//    // Example sending a request using the DeleteMeshRequest method.
-//    req, resp := client.DeleteMeshRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DeleteMesh
 func (c *AppMesh) DeleteMeshRequest(input *DeleteMeshInput) (req *request.Request, output *DeleteMeshOutput) {
@@ -649,31 +987,32 @@ func (c *AppMesh) DeleteMeshRequest(input *DeleteMeshInput) (req *request.Reques
 // See the AWS API reference guide for AWS App Mesh's
 // API operation DeleteMesh for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeResourceInUseException "ResourceInUseException"
-//   You can't delete the specified resource because it's in use or required by
-//   another resource.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - ResourceInUseException
+//     You can't delete the specified resource because it's in use or required by
+//     another resource.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
+//
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DeleteMesh
 func (c *AppMesh) DeleteMesh(input *DeleteMeshInput) (*DeleteMeshOutput, error) {
@@ -713,14 +1052,13 @@ const opDeleteRoute = "DeleteRoute"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteRouteRequest method.
+//	req, resp := client.DeleteRouteRequest(params)
 //
-//    // Example sending a request using the DeleteRouteRequest method.
-//    req, resp := client.DeleteRouteRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DeleteRoute
 func (c *AppMesh) DeleteRouteRequest(input *DeleteRouteInput) (req *request.Request, output *DeleteRouteOutput) {
@@ -750,31 +1088,32 @@ func (c *AppMesh) DeleteRouteRequest(input *DeleteRouteInput) (req *request.Requ
 // See the AWS API reference guide for AWS App Mesh's
 // API operation DeleteRoute for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeResourceInUseException "ResourceInUseException"
-//   You can't delete the specified resource because it's in use or required by
-//   another resource.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - ResourceInUseException
+//     You can't delete the specified resource because it's in use or required by
+//     another resource.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
+//
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DeleteRoute
 func (c *AppMesh) DeleteRoute(input *DeleteRouteInput) (*DeleteRouteOutput, error) {
@@ -798,6 +1137,108 @@ func (c *AppMesh) DeleteRouteWithContext(ctx aws.Context, input *DeleteRouteInpu
 	return out, req.Send()
 }
 
+const opDeleteVirtualGateway = "DeleteVirtualGateway"
+
+// DeleteVirtualGatewayRequest generates a "aws/request.Request" representing the
+// client's request for the DeleteVirtualGateway operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See DeleteVirtualGateway for more information on using the DeleteVirtualGateway
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the DeleteVirtualGatewayRequest method.
+//	req, resp := client.DeleteVirtualGatewayRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DeleteVirtualGateway
+func (c *AppMesh) DeleteVirtualGatewayRequest(input *DeleteVirtualGatewayInput) (req *request.Request, output *DeleteVirtualGatewayOutput) {
+	op := &request.Operation{
+		Name:       opDeleteVirtualGateway,
+		HTTPMethod: "DELETE",
+		HTTPPath:   "/v20190125/meshes/{meshName}/virtualGateways/{virtualGatewayName}",
+	}
+
+	if input == nil {
+		input = &DeleteVirtualGatewayInput{}
+	}
+
+	output = &DeleteVirtualGatewayOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// DeleteVirtualGateway API operation for AWS App Mesh.
+//
+// Deletes an existing virtual gateway. You cannot delete a virtual gateway
+// if any gateway routes are associated to it.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS App Mesh's
+// API operation DeleteVirtualGateway for usage and error information.
+//
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
+//
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
+//
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
+//
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
+//
+//   - ResourceInUseException
+//     You can't delete the specified resource because it's in use or required by
+//     another resource.
+//
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
+//
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DeleteVirtualGateway
+func (c *AppMesh) DeleteVirtualGateway(input *DeleteVirtualGatewayInput) (*DeleteVirtualGatewayOutput, error) {
+	req, out := c.DeleteVirtualGatewayRequest(input)
+	return out, req.Send()
+}
+
+// DeleteVirtualGatewayWithContext is the same as DeleteVirtualGateway with the addition of
+// the ability to pass a context and additional request options.
+//
+// See DeleteVirtualGateway for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *AppMesh) DeleteVirtualGatewayWithContext(ctx aws.Context, input *DeleteVirtualGatewayInput, opts ...request.Option) (*DeleteVirtualGatewayOutput, error) {
+	req, out := c.DeleteVirtualGatewayRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opDeleteVirtualNode = "DeleteVirtualNode"
 
 // DeleteVirtualNodeRequest generates a "aws/request.Request" representing the
@@ -814,14 +1255,13 @@ const opDeleteVirtualNode = "DeleteVirtualNode"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteVirtualNodeRequest method.
+//	req, resp := client.DeleteVirtualNodeRequest(params)
 //
-//    // Example sending a request using the DeleteVirtualNodeRequest method.
-//    req, resp := client.DeleteVirtualNodeRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DeleteVirtualNode
 func (c *AppMesh) DeleteVirtualNodeRequest(input *DeleteVirtualNodeInput) (req *request.Request, output *DeleteVirtualNodeOutput) {
@@ -854,31 +1294,32 @@ func (c *AppMesh) DeleteVirtualNodeRequest(input *DeleteVirtualNodeInput) (req *
 // See the AWS API reference guide for AWS App Mesh's
 // API operation DeleteVirtualNode for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeResourceInUseException "ResourceInUseException"
-//   You can't delete the specified resource because it's in use or required by
-//   another resource.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - ResourceInUseException
+//     You can't delete the specified resource because it's in use or required by
+//     another resource.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
+//
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DeleteVirtualNode
 func (c *AppMesh) DeleteVirtualNode(input *DeleteVirtualNodeInput) (*DeleteVirtualNodeOutput, error) {
@@ -918,14 +1359,13 @@ const opDeleteVirtualRouter = "DeleteVirtualRouter"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteVirtualRouterRequest method.
+//	req, resp := client.DeleteVirtualRouterRequest(params)
 //
-//    // Example sending a request using the DeleteVirtualRouterRequest method.
-//    req, resp := client.DeleteVirtualRouterRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DeleteVirtualRouter
 func (c *AppMesh) DeleteVirtualRouterRequest(input *DeleteVirtualRouterInput) (req *request.Request, output *DeleteVirtualRouterOutput) {
@@ -958,31 +1398,32 @@ func (c *AppMesh) DeleteVirtualRouterRequest(input *DeleteVirtualRouterInput) (r
 // See the AWS API reference guide for AWS App Mesh's
 // API operation DeleteVirtualRouter for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeResourceInUseException "ResourceInUseException"
-//   You can't delete the specified resource because it's in use or required by
-//   another resource.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - ResourceInUseException
+//     You can't delete the specified resource because it's in use or required by
+//     another resource.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
+//
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DeleteVirtualRouter
 func (c *AppMesh) DeleteVirtualRouter(input *DeleteVirtualRouterInput) (*DeleteVirtualRouterOutput, error) {
@@ -1022,14 +1463,13 @@ const opDeleteVirtualService = "DeleteVirtualService"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteVirtualServiceRequest method.
+//	req, resp := client.DeleteVirtualServiceRequest(params)
 //
-//    // Example sending a request using the DeleteVirtualServiceRequest method.
-//    req, resp := client.DeleteVirtualServiceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DeleteVirtualService
 func (c *AppMesh) DeleteVirtualServiceRequest(input *DeleteVirtualServiceInput) (req *request.Request, output *DeleteVirtualServiceOutput) {
@@ -1059,27 +1499,32 @@ func (c *AppMesh) DeleteVirtualServiceRequest(input *DeleteVirtualServiceInput)
 // See the AWS API reference guide for AWS App Mesh's
 // API operation DeleteVirtualService for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
+//
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - ResourceInUseException
+//     You can't delete the specified resource because it's in use or required by
+//     another resource.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DeleteVirtualService
 func (c *AppMesh) DeleteVirtualService(input *DeleteVirtualServiceInput) (*DeleteVirtualServiceOutput, error) {
@@ -1103,6 +1548,103 @@ func (c *AppMesh) DeleteVirtualServiceWithContext(ctx aws.Context, input *Delete
 	return out, req.Send()
 }
 
+const opDescribeGatewayRoute = "DescribeGatewayRoute"
+
+// DescribeGatewayRouteRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeGatewayRoute operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See DescribeGatewayRoute for more information on using the DescribeGatewayRoute
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the DescribeGatewayRouteRequest method.
+//	req, resp := client.DescribeGatewayRouteRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DescribeGatewayRoute
+func (c *AppMesh) DescribeGatewayRouteRequest(input *DescribeGatewayRouteInput) (req *request.Request, output *DescribeGatewayRouteOutput) {
+	op := &request.Operation{
+		Name:       opDescribeGatewayRoute,
+		HTTPMethod: "GET",
+		HTTPPath:   "/v20190125/meshes/{meshName}/virtualGateway/{virtualGatewayName}/gatewayRoutes/{gatewayRouteName}",
+	}
+
+	if input == nil {
+		input = &DescribeGatewayRouteInput{}
+	}
+
+	output = &DescribeGatewayRouteOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// DescribeGatewayRoute API operation for AWS App Mesh.
+//
+// Describes an existing gateway route.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS App Mesh's
+// API operation DescribeGatewayRoute for usage and error information.
+//
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
+//
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
+//
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
+//
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
+//
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
+//
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DescribeGatewayRoute
+func (c *AppMesh) DescribeGatewayRoute(input *DescribeGatewayRouteInput) (*DescribeGatewayRouteOutput, error) {
+	req, out := c.DescribeGatewayRouteRequest(input)
+	return out, req.Send()
+}
+
+// DescribeGatewayRouteWithContext is the same as DescribeGatewayRoute with the addition of
+// the ability to pass a context and additional request options.
+//
+// See DescribeGatewayRoute for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *AppMesh) DescribeGatewayRouteWithContext(ctx aws.Context, input *DescribeGatewayRouteInput, opts ...request.Option) (*DescribeGatewayRouteOutput, error) {
+	req, out := c.DescribeGatewayRouteRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opDescribeMesh = "DescribeMesh"
 
 // DescribeMeshRequest generates a "aws/request.Request" representing the
@@ -1119,14 +1661,13 @@ const opDescribeMesh = "DescribeMesh"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeMeshRequest method.
+//	req, resp := client.DescribeMeshRequest(params)
 //
-//    // Example sending a request using the DescribeMeshRequest method.
-//    req, resp := client.DescribeMeshRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DescribeMesh
 func (c *AppMesh) DescribeMeshRequest(input *DescribeMeshInput) (req *request.Request, output *DescribeMeshOutput) {
@@ -1156,27 +1697,28 @@ func (c *AppMesh) DescribeMeshRequest(input *DescribeMeshInput) (req *request.Re
 // See the AWS API reference guide for AWS App Mesh's
 // API operation DescribeMesh for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
+//
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DescribeMesh
 func (c *AppMesh) DescribeMesh(input *DescribeMeshInput) (*DescribeMeshOutput, error) {
@@ -1216,14 +1758,13 @@ const opDescribeRoute = "DescribeRoute"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeRouteRequest method.
+//	req, resp := client.DescribeRouteRequest(params)
 //
-//    // Example sending a request using the DescribeRouteRequest method.
-//    req, resp := client.DescribeRouteRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DescribeRoute
 func (c *AppMesh) DescribeRouteRequest(input *DescribeRouteInput) (req *request.Request, output *DescribeRouteOutput) {
@@ -1253,27 +1794,28 @@ func (c *AppMesh) DescribeRouteRequest(input *DescribeRouteInput) (req *request.
 // See the AWS API reference guide for AWS App Mesh's
 // API operation DescribeRoute for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
+//
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DescribeRoute
 func (c *AppMesh) DescribeRoute(input *DescribeRouteInput) (*DescribeRouteOutput, error) {
@@ -1297,6 +1839,103 @@ func (c *AppMesh) DescribeRouteWithContext(ctx aws.Context, input *DescribeRoute
 	return out, req.Send()
 }
 
+const opDescribeVirtualGateway = "DescribeVirtualGateway"
+
+// DescribeVirtualGatewayRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeVirtualGateway operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See DescribeVirtualGateway for more information on using the DescribeVirtualGateway
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the DescribeVirtualGatewayRequest method.
+//	req, resp := client.DescribeVirtualGatewayRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DescribeVirtualGateway
+func (c *AppMesh) DescribeVirtualGatewayRequest(input *DescribeVirtualGatewayInput) (req *request.Request, output *DescribeVirtualGatewayOutput) {
+	op := &request.Operation{
+		Name:       opDescribeVirtualGateway,
+		HTTPMethod: "GET",
+		HTTPPath:   "/v20190125/meshes/{meshName}/virtualGateways/{virtualGatewayName}",
+	}
+
+	if input == nil {
+		input = &DescribeVirtualGatewayInput{}
+	}
+
+	output = &DescribeVirtualGatewayOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// DescribeVirtualGateway API operation for AWS App Mesh.
+//
+// Describes an existing virtual gateway.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS App Mesh's
+// API operation DescribeVirtualGateway for usage and error information.
+//
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
+//
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
+//
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
+//
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
+//
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
+//
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DescribeVirtualGateway
+func (c *AppMesh) DescribeVirtualGateway(input *DescribeVirtualGatewayInput) (*DescribeVirtualGatewayOutput, error) {
+	req, out := c.DescribeVirtualGatewayRequest(input)
+	return out, req.Send()
+}
+
+// DescribeVirtualGatewayWithContext is the same as DescribeVirtualGateway with the addition of
+// the ability to pass a context and additional request options.
+//
+// See DescribeVirtualGateway for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *AppMesh) DescribeVirtualGatewayWithContext(ctx aws.Context, input *DescribeVirtualGatewayInput, opts ...request.Option) (*DescribeVirtualGatewayOutput, error) {
+	req, out := c.DescribeVirtualGatewayRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opDescribeVirtualNode = "DescribeVirtualNode"
 
 // DescribeVirtualNodeRequest generates a "aws/request.Request" representing the
@@ -1313,14 +1952,13 @@ const opDescribeVirtualNode = "DescribeVirtualNode"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeVirtualNodeRequest method.
+//	req, resp := client.DescribeVirtualNodeRequest(params)
 //
-//    // Example sending a request using the DescribeVirtualNodeRequest method.
-//    req, resp := client.DescribeVirtualNodeRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DescribeVirtualNode
 func (c *AppMesh) DescribeVirtualNodeRequest(input *DescribeVirtualNodeInput) (req *request.Request, output *DescribeVirtualNodeOutput) {
@@ -1350,27 +1988,28 @@ func (c *AppMesh) DescribeVirtualNodeRequest(input *DescribeVirtualNodeInput) (r
 // See the AWS API reference guide for AWS App Mesh's
 // API operation DescribeVirtualNode for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DescribeVirtualNode
 func (c *AppMesh) DescribeVirtualNode(input *DescribeVirtualNodeInput) (*DescribeVirtualNodeOutput, error) {
@@ -1410,14 +2049,13 @@ const opDescribeVirtualRouter = "DescribeVirtualRouter"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeVirtualRouterRequest method.
+//	req, resp := client.DescribeVirtualRouterRequest(params)
 //
-//    // Example sending a request using the DescribeVirtualRouterRequest method.
-//    req, resp := client.DescribeVirtualRouterRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DescribeVirtualRouter
 func (c *AppMesh) DescribeVirtualRouterRequest(input *DescribeVirtualRouterInput) (req *request.Request, output *DescribeVirtualRouterOutput) {
@@ -1447,27 +2085,28 @@ func (c *AppMesh) DescribeVirtualRouterRequest(input *DescribeVirtualRouterInput
 // See the AWS API reference guide for AWS App Mesh's
 // API operation DescribeVirtualRouter for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DescribeVirtualRouter
 func (c *AppMesh) DescribeVirtualRouter(input *DescribeVirtualRouterInput) (*DescribeVirtualRouterOutput, error) {
@@ -1507,14 +2146,13 @@ const opDescribeVirtualService = "DescribeVirtualService"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeVirtualServiceRequest method.
+//	req, resp := client.DescribeVirtualServiceRequest(params)
 //
-//    // Example sending a request using the DescribeVirtualServiceRequest method.
-//    req, resp := client.DescribeVirtualServiceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DescribeVirtualService
 func (c *AppMesh) DescribeVirtualServiceRequest(input *DescribeVirtualServiceInput) (req *request.Request, output *DescribeVirtualServiceOutput) {
@@ -1544,27 +2182,28 @@ func (c *AppMesh) DescribeVirtualServiceRequest(input *DescribeVirtualServiceInp
 // See the AWS API reference guide for AWS App Mesh's
 // API operation DescribeVirtualService for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/DescribeVirtualService
 func (c *AppMesh) DescribeVirtualService(input *DescribeVirtualServiceInput) (*DescribeVirtualServiceOutput, error) {
@@ -1588,6 +2227,161 @@ func (c *AppMesh) DescribeVirtualServiceWithContext(ctx aws.Context, input *Desc
 	return out, req.Send()
 }
 
+const opListGatewayRoutes = "ListGatewayRoutes"
+
+// ListGatewayRoutesRequest generates a "aws/request.Request" representing the
+// client's request for the ListGatewayRoutes operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListGatewayRoutes for more information on using the ListGatewayRoutes
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListGatewayRoutesRequest method.
+//	req, resp := client.ListGatewayRoutesRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/ListGatewayRoutes
+func (c *AppMesh) ListGatewayRoutesRequest(input *ListGatewayRoutesInput) (req *request.Request, output *ListGatewayRoutesOutput) {
+	op := &request.Operation{
+		Name:       opListGatewayRoutes,
+		HTTPMethod: "GET",
+		HTTPPath:   "/v20190125/meshes/{meshName}/virtualGateway/{virtualGatewayName}/gatewayRoutes",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"nextToken"},
+			OutputTokens:    []string{"nextToken"},
+			LimitToken:      "limit",
+			TruncationToken: "",
+		},
+	}
+
+	if input == nil {
+		input = &ListGatewayRoutesInput{}
+	}
+
+	output = &ListGatewayRoutesOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListGatewayRoutes API operation for AWS App Mesh.
+//
+// Returns a list of existing gateway routes that are associated to a virtual
+// gateway.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS App Mesh's
+// API operation ListGatewayRoutes for usage and error information.
+//
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
+//
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
+//
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
+//
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
+//
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
+//
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/ListGatewayRoutes
+func (c *AppMesh) ListGatewayRoutes(input *ListGatewayRoutesInput) (*ListGatewayRoutesOutput, error) {
+	req, out := c.ListGatewayRoutesRequest(input)
+	return out, req.Send()
+}
+
+// ListGatewayRoutesWithContext is the same as ListGatewayRoutes with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListGatewayRoutes for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *AppMesh) ListGatewayRoutesWithContext(ctx aws.Context, input *ListGatewayRoutesInput, opts ...request.Option) (*ListGatewayRoutesOutput, error) {
+	req, out := c.ListGatewayRoutesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+// ListGatewayRoutesPages iterates over the pages of a ListGatewayRoutes operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListGatewayRoutes method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListGatewayRoutes operation.
+//	pageNum := 0
+//	err := client.ListGatewayRoutesPages(params,
+//	    func(page *appmesh.ListGatewayRoutesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *AppMesh) ListGatewayRoutesPages(input *ListGatewayRoutesInput, fn func(*ListGatewayRoutesOutput, bool) bool) error {
+	return c.ListGatewayRoutesPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListGatewayRoutesPagesWithContext same as ListGatewayRoutesPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *AppMesh) ListGatewayRoutesPagesWithContext(ctx aws.Context, input *ListGatewayRoutesInput, fn func(*ListGatewayRoutesOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListGatewayRoutesInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListGatewayRoutesRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListGatewayRoutesOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
 const opListMeshes = "ListMeshes"
 
 // ListMeshesRequest generates a "aws/request.Request" representing the
@@ -1604,14 +2398,13 @@ const opListMeshes = "ListMeshes"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListMeshesRequest method.
+//	req, resp := client.ListMeshesRequest(params)
 //
-//    // Example sending a request using the ListMeshesRequest method.
-//    req, resp := client.ListMeshesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/ListMeshes
 func (c *AppMesh) ListMeshesRequest(input *ListMeshesInput) (req *request.Request, output *ListMeshesOutput) {
@@ -1647,27 +2440,28 @@ func (c *AppMesh) ListMeshesRequest(input *ListMeshesInput) (req *request.Reques
 // See the AWS API reference guide for AWS App Mesh's
 // API operation ListMeshes for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
+//
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/ListMeshes
 func (c *AppMesh) ListMeshes(input *ListMeshesInput) (*ListMeshesOutput, error) {
@@ -1699,15 +2493,14 @@ func (c *AppMesh) ListMeshesWithContext(ctx aws.Context, input *ListMeshesInput,
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListMeshes operation.
-//    pageNum := 0
-//    err := client.ListMeshesPages(params,
-//        func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListMeshes operation.
+//	pageNum := 0
+//	err := client.ListMeshesPages(params,
+//	    func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *AppMesh) ListMeshesPages(input *ListMeshesInput, fn func(*ListMeshesOutput, bool) bool) error {
 	return c.ListMeshesPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1734,10 +2527,12 @@ func (c *AppMesh) ListMeshesPagesWithContext(ctx aws.Context, input *ListMeshesI
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListMeshesOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListMeshesOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -1757,14 +2552,13 @@ const opListRoutes = "ListRoutes"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListRoutesRequest method.
+//	req, resp := client.ListRoutesRequest(params)
 //
-//    // Example sending a request using the ListRoutesRequest method.
-//    req, resp := client.ListRoutesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/ListRoutes
 func (c *AppMesh) ListRoutesRequest(input *ListRoutesInput) (req *request.Request, output *ListRoutesOutput) {
@@ -1800,27 +2594,28 @@ func (c *AppMesh) ListRoutesRequest(input *ListRoutesInput) (req *request.Reques
 // See the AWS API reference guide for AWS App Mesh's
 // API operation ListRoutes for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/ListRoutes
 func (c *AppMesh) ListRoutes(input *ListRoutesInput) (*ListRoutesOutput, error) {
@@ -1852,15 +2647,14 @@ func (c *AppMesh) ListRoutesWithContext(ctx aws.Context, input *ListRoutesInput,
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListRoutes operation.
-//    pageNum := 0
-//    err := client.ListRoutesPages(params,
-//        func(page *appmesh.ListRoutesOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListRoutes operation.
+//	pageNum := 0
+//	err := client.ListRoutesPages(params,
+//	    func(page *appmesh.ListRoutesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *AppMesh) ListRoutesPages(input *ListRoutesInput, fn func(*ListRoutesOutput, bool) bool) error {
 	return c.ListRoutesPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1887,10 +2681,12 @@ func (c *AppMesh) ListRoutesPagesWithContext(ctx aws.Context, input *ListRoutesI
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListRoutesOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListRoutesOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -1910,14 +2706,13 @@ const opListTagsForResource = "ListTagsForResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTagsForResourceRequest method.
+//	req, resp := client.ListTagsForResourceRequest(params)
 //
-//    // Example sending a request using the ListTagsForResourceRequest method.
-//    req, resp := client.ListTagsForResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/ListTagsForResource
 func (c *AppMesh) ListTagsForResourceRequest(input *ListTagsForResourceInput) (req *request.Request, output *ListTagsForResourceOutput) {
@@ -1953,27 +2748,28 @@ func (c *AppMesh) ListTagsForResourceRequest(input *ListTagsForResourceInput) (r
 // See the AWS API reference guide for AWS App Mesh's
 // API operation ListTagsForResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
+//
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/ListTagsForResource
 func (c *AppMesh) ListTagsForResource(input *ListTagsForResourceInput) (*ListTagsForResourceOutput, error) {
@@ -2005,15 +2801,14 @@ func (c *AppMesh) ListTagsForResourceWithContext(ctx aws.Context, input *ListTag
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListTagsForResource operation.
-//    pageNum := 0
-//    err := client.ListTagsForResourcePages(params,
-//        func(page *appmesh.ListTagsForResourceOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListTagsForResource operation.
+//	pageNum := 0
+//	err := client.ListTagsForResourcePages(params,
+//	    func(page *appmesh.ListTagsForResourceOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *AppMesh) ListTagsForResourcePages(input *ListTagsForResourceInput, fn func(*ListTagsForResourceOutput, bool) bool) error {
 	return c.ListTagsForResourcePagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -2040,44 +2835,45 @@ func (c *AppMesh) ListTagsForResourcePagesWithContext(ctx aws.Context, input *Li
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListTagsForResourceOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListTagsForResourceOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
-const opListVirtualNodes = "ListVirtualNodes"
+const opListVirtualGateways = "ListVirtualGateways"
 
-// ListVirtualNodesRequest generates a "aws/request.Request" representing the
-// client's request for the ListVirtualNodes operation. The "output" return
+// ListVirtualGatewaysRequest generates a "aws/request.Request" representing the
+// client's request for the ListVirtualGateways operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListVirtualNodes for more information on using the ListVirtualNodes
+// See ListVirtualGateways for more information on using the ListVirtualGateways
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListVirtualGatewaysRequest method.
+//	req, resp := client.ListVirtualGatewaysRequest(params)
 //
-//    // Example sending a request using the ListVirtualNodesRequest method.
-//    req, resp := client.ListVirtualNodesRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/ListVirtualNodes
-func (c *AppMesh) ListVirtualNodesRequest(input *ListVirtualNodesInput) (req *request.Request, output *ListVirtualNodesOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/ListVirtualGateways
+func (c *AppMesh) ListVirtualGatewaysRequest(input *ListVirtualGatewaysInput) (req *request.Request, output *ListVirtualGatewaysOutput) {
 	op := &request.Operation{
-		Name:       opListVirtualNodes,
+		Name:       opListVirtualGateways,
 		HTTPMethod: "GET",
-		HTTPPath:   "/v20190125/meshes/{meshName}/virtualNodes",
+		HTTPPath:   "/v20190125/meshes/{meshName}/virtualGateways",
 		Paginator: &request.Paginator{
 			InputTokens:     []string{"nextToken"},
 			OutputTokens:    []string{"nextToken"},
@@ -2087,91 +2883,245 @@ func (c *AppMesh) ListVirtualNodesRequest(input *ListVirtualNodesInput) (req *re
 	}
 
 	if input == nil {
-		input = &ListVirtualNodesInput{}
+		input = &ListVirtualGatewaysInput{}
 	}
 
-	output = &ListVirtualNodesOutput{}
+	output = &ListVirtualGatewaysOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListVirtualNodes API operation for AWS App Mesh.
+// ListVirtualGateways API operation for AWS App Mesh.
 //
-// Returns a list of existing virtual nodes.
+// Returns a list of existing virtual gateways in a service mesh.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS App Mesh's
-// API operation ListVirtualNodes for usage and error information.
+// API operation ListVirtualGateways for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/ListVirtualNodes
-func (c *AppMesh) ListVirtualNodes(input *ListVirtualNodesInput) (*ListVirtualNodesOutput, error) {
-	req, out := c.ListVirtualNodesRequest(input)
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/ListVirtualGateways
+func (c *AppMesh) ListVirtualGateways(input *ListVirtualGatewaysInput) (*ListVirtualGatewaysOutput, error) {
+	req, out := c.ListVirtualGatewaysRequest(input)
 	return out, req.Send()
 }
 
-// ListVirtualNodesWithContext is the same as ListVirtualNodes with the addition of
+// ListVirtualGatewaysWithContext is the same as ListVirtualGateways with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListVirtualNodes for details on how to use this API operation.
+// See ListVirtualGateways for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *AppMesh) ListVirtualNodesWithContext(ctx aws.Context, input *ListVirtualNodesInput, opts ...request.Option) (*ListVirtualNodesOutput, error) {
-	req, out := c.ListVirtualNodesRequest(input)
+func (c *AppMesh) ListVirtualGatewaysWithContext(ctx aws.Context, input *ListVirtualGatewaysInput, opts ...request.Option) (*ListVirtualGatewaysOutput, error) {
+	req, out := c.ListVirtualGatewaysRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-// ListVirtualNodesPages iterates over the pages of a ListVirtualNodes operation,
+// ListVirtualGatewaysPages iterates over the pages of a ListVirtualGateways operation,
 // calling the "fn" function with the response data for each page. To stop
 // iterating, return false from the fn function.
 //
-// See ListVirtualNodes method for more information on how to use this operation.
+// See ListVirtualGateways method for more information on how to use this operation.
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListVirtualNodes operation.
-//    pageNum := 0
-//    err := client.ListVirtualNodesPages(params,
-//        func(page *appmesh.ListVirtualNodesOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
-func (c *AppMesh) ListVirtualNodesPages(input *ListVirtualNodesInput, fn func(*ListVirtualNodesOutput, bool) bool) error {
-	return c.ListVirtualNodesPagesWithContext(aws.BackgroundContext(), input, fn)
+//	// Example iterating over at most 3 pages of a ListVirtualGateways operation.
+//	pageNum := 0
+//	err := client.ListVirtualGatewaysPages(params,
+//	    func(page *appmesh.ListVirtualGatewaysOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *AppMesh) ListVirtualGatewaysPages(input *ListVirtualGatewaysInput, fn func(*ListVirtualGatewaysOutput, bool) bool) error {
+	return c.ListVirtualGatewaysPagesWithContext(aws.BackgroundContext(), input, fn)
 }
 
-// ListVirtualNodesPagesWithContext same as ListVirtualNodesPages except
+// ListVirtualGatewaysPagesWithContext same as ListVirtualGatewaysPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *AppMesh) ListVirtualGatewaysPagesWithContext(ctx aws.Context, input *ListVirtualGatewaysInput, fn func(*ListVirtualGatewaysOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListVirtualGatewaysInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListVirtualGatewaysRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListVirtualGatewaysOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListVirtualNodes = "ListVirtualNodes"
+
+// ListVirtualNodesRequest generates a "aws/request.Request" representing the
+// client's request for the ListVirtualNodes operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListVirtualNodes for more information on using the ListVirtualNodes
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListVirtualNodesRequest method.
+//	req, resp := client.ListVirtualNodesRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/ListVirtualNodes
+func (c *AppMesh) ListVirtualNodesRequest(input *ListVirtualNodesInput) (req *request.Request, output *ListVirtualNodesOutput) {
+	op := &request.Operation{
+		Name:       opListVirtualNodes,
+		HTTPMethod: "GET",
+		HTTPPath:   "/v20190125/meshes/{meshName}/virtualNodes",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"nextToken"},
+			OutputTokens:    []string{"nextToken"},
+			LimitToken:      "limit",
+			TruncationToken: "",
+		},
+	}
+
+	if input == nil {
+		input = &ListVirtualNodesInput{}
+	}
+
+	output = &ListVirtualNodesOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListVirtualNodes API operation for AWS App Mesh.
+//
+// Returns a list of existing virtual nodes.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS App Mesh's
+// API operation ListVirtualNodes for usage and error information.
+//
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
+//
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
+//
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
+//
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
+//
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
+//
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/ListVirtualNodes
+func (c *AppMesh) ListVirtualNodes(input *ListVirtualNodesInput) (*ListVirtualNodesOutput, error) {
+	req, out := c.ListVirtualNodesRequest(input)
+	return out, req.Send()
+}
+
+// ListVirtualNodesWithContext is the same as ListVirtualNodes with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListVirtualNodes for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *AppMesh) ListVirtualNodesWithContext(ctx aws.Context, input *ListVirtualNodesInput, opts ...request.Option) (*ListVirtualNodesOutput, error) {
+	req, out := c.ListVirtualNodesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+// ListVirtualNodesPages iterates over the pages of a ListVirtualNodes operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListVirtualNodes method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListVirtualNodes operation.
+//	pageNum := 0
+//	err := client.ListVirtualNodesPages(params,
+//	    func(page *appmesh.ListVirtualNodesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *AppMesh) ListVirtualNodesPages(input *ListVirtualNodesInput, fn func(*ListVirtualNodesOutput, bool) bool) error {
+	return c.ListVirtualNodesPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListVirtualNodesPagesWithContext same as ListVirtualNodesPages except
 // it takes a Context and allows setting request options on the pages.
 //
 // The context must be non-nil and will be used for request cancellation. If
@@ -2193,10 +3143,12 @@ func (c *AppMesh) ListVirtualNodesPagesWithContext(ctx aws.Context, input *ListV
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListVirtualNodesOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListVirtualNodesOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -2216,14 +3168,13 @@ const opListVirtualRouters = "ListVirtualRouters"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListVirtualRoutersRequest method.
+//	req, resp := client.ListVirtualRoutersRequest(params)
 //
-//    // Example sending a request using the ListVirtualRoutersRequest method.
-//    req, resp := client.ListVirtualRoutersRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/ListVirtualRouters
 func (c *AppMesh) ListVirtualRoutersRequest(input *ListVirtualRoutersInput) (req *request.Request, output *ListVirtualRoutersOutput) {
@@ -2259,27 +3210,28 @@ func (c *AppMesh) ListVirtualRoutersRequest(input *ListVirtualRoutersInput) (req
 // See the AWS API reference guide for AWS App Mesh's
 // API operation ListVirtualRouters for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/ListVirtualRouters
 func (c *AppMesh) ListVirtualRouters(input *ListVirtualRoutersInput) (*ListVirtualRoutersOutput, error) {
@@ -2311,15 +3263,14 @@ func (c *AppMesh) ListVirtualRoutersWithContext(ctx aws.Context, input *ListVirt
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListVirtualRouters operation.
-//    pageNum := 0
-//    err := client.ListVirtualRoutersPages(params,
-//        func(page *appmesh.ListVirtualRoutersOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListVirtualRouters operation.
+//	pageNum := 0
+//	err := client.ListVirtualRoutersPages(params,
+//	    func(page *appmesh.ListVirtualRoutersOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *AppMesh) ListVirtualRoutersPages(input *ListVirtualRoutersInput, fn func(*ListVirtualRoutersOutput, bool) bool) error {
 	return c.ListVirtualRoutersPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -2346,10 +3297,12 @@ func (c *AppMesh) ListVirtualRoutersPagesWithContext(ctx aws.Context, input *Lis
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListVirtualRoutersOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListVirtualRoutersOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -2369,14 +3322,13 @@ const opListVirtualServices = "ListVirtualServices"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListVirtualServicesRequest method.
+//	req, resp := client.ListVirtualServicesRequest(params)
 //
-//    // Example sending a request using the ListVirtualServicesRequest method.
-//    req, resp := client.ListVirtualServicesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/ListVirtualServices
 func (c *AppMesh) ListVirtualServicesRequest(input *ListVirtualServicesInput) (req *request.Request, output *ListVirtualServicesOutput) {
@@ -2412,27 +3364,28 @@ func (c *AppMesh) ListVirtualServicesRequest(input *ListVirtualServicesInput) (r
 // See the AWS API reference guide for AWS App Mesh's
 // API operation ListVirtualServices for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
+//
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/ListVirtualServices
 func (c *AppMesh) ListVirtualServices(input *ListVirtualServicesInput) (*ListVirtualServicesOutput, error) {
@@ -2464,15 +3417,14 @@ func (c *AppMesh) ListVirtualServicesWithContext(ctx aws.Context, input *ListVir
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListVirtualServices operation.
-//    pageNum := 0
-//    err := client.ListVirtualServicesPages(params,
-//        func(page *appmesh.ListVirtualServicesOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListVirtualServices operation.
+//	pageNum := 0
+//	err := client.ListVirtualServicesPages(params,
+//	    func(page *appmesh.ListVirtualServicesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *AppMesh) ListVirtualServicesPages(input *ListVirtualServicesInput, fn func(*ListVirtualServicesOutput, bool) bool) error {
 	return c.ListVirtualServicesPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -2499,10 +3451,12 @@ func (c *AppMesh) ListVirtualServicesPagesWithContext(ctx aws.Context, input *Li
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListVirtualServicesOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListVirtualServicesOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -2522,14 +3476,13 @@ const opTagResource = "TagResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the TagResourceRequest method.
+//	req, resp := client.TagResourceRequest(params)
 //
-//    // Example sending a request using the TagResourceRequest method.
-//    req, resp := client.TagResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/TagResource
 func (c *AppMesh) TagResourceRequest(input *TagResourceInput) (req *request.Request, output *TagResourceOutput) {
@@ -2563,32 +3516,33 @@ func (c *AppMesh) TagResourceRequest(input *TagResourceInput) (req *request.Requ
 // See the AWS API reference guide for AWS App Mesh's
 // API operation TagResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - TooManyTagsException
+//     The request exceeds the maximum allowed number of tags allowed per resource.
+//     The current limit is 50 user tags per resource. You must reduce the number
+//     of tags in the request. None of the tags in this request were applied.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
 //
-//   * ErrCodeTooManyTagsException "TooManyTagsException"
-//   The request exceeds the maximum allowed number of tags allowed per resource.
-//   The current limit is 50 user tags per resource. You must reduce the number
-//   of tags in the request. None of the tags in this request were applied.
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/TagResource
 func (c *AppMesh) TagResource(input *TagResourceInput) (*TagResourceOutput, error) {
@@ -2628,14 +3582,13 @@ const opUntagResource = "UntagResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UntagResourceRequest method.
+//	req, resp := client.UntagResourceRequest(params)
 //
-//    // Example sending a request using the UntagResourceRequest method.
-//    req, resp := client.UntagResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/UntagResource
 func (c *AppMesh) UntagResourceRequest(input *UntagResourceInput) (req *request.Request, output *UntagResourceOutput) {
@@ -2666,27 +3619,28 @@ func (c *AppMesh) UntagResourceRequest(input *UntagResourceInput) (req *request.
 // See the AWS API reference guide for AWS App Mesh's
 // API operation UntagResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/UntagResource
 func (c *AppMesh) UntagResource(input *UntagResourceInput) (*UntagResourceOutput, error) {
@@ -2710,6 +3664,114 @@ func (c *AppMesh) UntagResourceWithContext(ctx aws.Context, input *UntagResource
 	return out, req.Send()
 }
 
+const opUpdateGatewayRoute = "UpdateGatewayRoute"
+
+// UpdateGatewayRouteRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateGatewayRoute operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateGatewayRoute for more information on using the UpdateGatewayRoute
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateGatewayRouteRequest method.
+//	req, resp := client.UpdateGatewayRouteRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/UpdateGatewayRoute
+func (c *AppMesh) UpdateGatewayRouteRequest(input *UpdateGatewayRouteInput) (req *request.Request, output *UpdateGatewayRouteOutput) {
+	op := &request.Operation{
+		Name:       opUpdateGatewayRoute,
+		HTTPMethod: "PUT",
+		HTTPPath:   "/v20190125/meshes/{meshName}/virtualGateway/{virtualGatewayName}/gatewayRoutes/{gatewayRouteName}",
+	}
+
+	if input == nil {
+		input = &UpdateGatewayRouteInput{}
+	}
+
+	output = &UpdateGatewayRouteOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UpdateGatewayRoute API operation for AWS App Mesh.
+//
+// Updates an existing gateway route that is associated to a specified virtual
+// gateway in a service mesh.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS App Mesh's
+// API operation UpdateGatewayRoute for usage and error information.
+//
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
+//
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
+//
+//   - ConflictException
+//     The request contains a client token that was used for a previous update resource
+//     call with different specifications. Try the request again with a new client
+//     token.
+//
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
+//
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
+//
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
+//
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
+//
+//   - LimitExceededException
+//     You have exceeded a service limit for your account. For more information,
+//     see Service Limits (https://docs.aws.amazon.com/app-mesh/latest/userguide/service-quotas.html)
+//     in the App Mesh User Guide.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/UpdateGatewayRoute
+func (c *AppMesh) UpdateGatewayRoute(input *UpdateGatewayRouteInput) (*UpdateGatewayRouteOutput, error) {
+	req, out := c.UpdateGatewayRouteRequest(input)
+	return out, req.Send()
+}
+
+// UpdateGatewayRouteWithContext is the same as UpdateGatewayRoute with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateGatewayRoute for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *AppMesh) UpdateGatewayRouteWithContext(ctx aws.Context, input *UpdateGatewayRouteInput, opts ...request.Option) (*UpdateGatewayRouteOutput, error) {
+	req, out := c.UpdateGatewayRouteRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opUpdateMesh = "UpdateMesh"
 
 // UpdateMeshRequest generates a "aws/request.Request" representing the
@@ -2726,14 +3788,13 @@ const opUpdateMesh = "UpdateMesh"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateMeshRequest method.
+//	req, resp := client.UpdateMeshRequest(params)
 //
-//    // Example sending a request using the UpdateMeshRequest method.
-//    req, resp := client.UpdateMeshRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/UpdateMesh
 func (c *AppMesh) UpdateMeshRequest(input *UpdateMeshInput) (req *request.Request, output *UpdateMeshOutput) {
@@ -2763,32 +3824,33 @@ func (c *AppMesh) UpdateMeshRequest(input *UpdateMeshInput) (req *request.Reques
 // See the AWS API reference guide for AWS App Mesh's
 // API operation UpdateMesh for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
 //
-//   * ErrCodeConflictException "ConflictException"
-//   The request contains a client token that was used for a previous update resource
-//   call with different specifications. Try the request again with a new client
-//   token.
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - ConflictException
+//     The request contains a client token that was used for a previous update resource
+//     call with different specifications. Try the request again with a new client
+//     token.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
+//
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/UpdateMesh
 func (c *AppMesh) UpdateMesh(input *UpdateMeshInput) (*UpdateMeshOutput, error) {
@@ -2828,14 +3890,13 @@ const opUpdateRoute = "UpdateRoute"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateRouteRequest method.
+//	req, resp := client.UpdateRouteRequest(params)
 //
-//    // Example sending a request using the UpdateRouteRequest method.
-//    req, resp := client.UpdateRouteRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/UpdateRoute
 func (c *AppMesh) UpdateRouteRequest(input *UpdateRouteInput) (req *request.Request, output *UpdateRouteOutput) {
@@ -2865,37 +3926,38 @@ func (c *AppMesh) UpdateRouteRequest(input *UpdateRouteInput) (req *request.Requ
 // See the AWS API reference guide for AWS App Mesh's
 // API operation UpdateRoute for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
 //
-//   * ErrCodeConflictException "ConflictException"
-//   The request contains a client token that was used for a previous update resource
-//   call with different specifications. Try the request again with a new client
-//   token.
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - ConflictException
+//     The request contains a client token that was used for a previous update resource
+//     call with different specifications. Try the request again with a new client
+//     token.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   You have exceeded a service limit for your account. For more information,
-//   see Service Limits (https://docs.aws.amazon.com/app-mesh/latest/userguide/service_limits.html)
-//   in the AWS App Mesh User Guide.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
+//
+//   - LimitExceededException
+//     You have exceeded a service limit for your account. For more information,
+//     see Service Limits (https://docs.aws.amazon.com/app-mesh/latest/userguide/service-quotas.html)
+//     in the App Mesh User Guide.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/UpdateRoute
 func (c *AppMesh) UpdateRoute(input *UpdateRouteInput) (*UpdateRouteOutput, error) {
@@ -2919,6 +3981,113 @@ func (c *AppMesh) UpdateRouteWithContext(ctx aws.Context, input *UpdateRouteInpu
 	return out, req.Send()
 }
 
+const opUpdateVirtualGateway = "UpdateVirtualGateway"
+
+// UpdateVirtualGatewayRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateVirtualGateway operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateVirtualGateway for more information on using the UpdateVirtualGateway
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateVirtualGatewayRequest method.
+//	req, resp := client.UpdateVirtualGatewayRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/UpdateVirtualGateway
+func (c *AppMesh) UpdateVirtualGatewayRequest(input *UpdateVirtualGatewayInput) (req *request.Request, output *UpdateVirtualGatewayOutput) {
+	op := &request.Operation{
+		Name:       opUpdateVirtualGateway,
+		HTTPMethod: "PUT",
+		HTTPPath:   "/v20190125/meshes/{meshName}/virtualGateways/{virtualGatewayName}",
+	}
+
+	if input == nil {
+		input = &UpdateVirtualGatewayInput{}
+	}
+
+	output = &UpdateVirtualGatewayOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UpdateVirtualGateway API operation for AWS App Mesh.
+//
+// Updates an existing virtual gateway in a specified service mesh.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS App Mesh's
+// API operation UpdateVirtualGateway for usage and error information.
+//
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
+//
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
+//
+//   - ConflictException
+//     The request contains a client token that was used for a previous update resource
+//     call with different specifications. Try the request again with a new client
+//     token.
+//
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
+//
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
+//
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
+//
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
+//
+//   - LimitExceededException
+//     You have exceeded a service limit for your account. For more information,
+//     see Service Limits (https://docs.aws.amazon.com/app-mesh/latest/userguide/service-quotas.html)
+//     in the App Mesh User Guide.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/UpdateVirtualGateway
+func (c *AppMesh) UpdateVirtualGateway(input *UpdateVirtualGatewayInput) (*UpdateVirtualGatewayOutput, error) {
+	req, out := c.UpdateVirtualGatewayRequest(input)
+	return out, req.Send()
+}
+
+// UpdateVirtualGatewayWithContext is the same as UpdateVirtualGateway with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateVirtualGateway for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *AppMesh) UpdateVirtualGatewayWithContext(ctx aws.Context, input *UpdateVirtualGatewayInput, opts ...request.Option) (*UpdateVirtualGatewayOutput, error) {
+	req, out := c.UpdateVirtualGatewayRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opUpdateVirtualNode = "UpdateVirtualNode"
 
 // UpdateVirtualNodeRequest generates a "aws/request.Request" representing the
@@ -2935,14 +4104,13 @@ const opUpdateVirtualNode = "UpdateVirtualNode"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateVirtualNodeRequest method.
+//	req, resp := client.UpdateVirtualNodeRequest(params)
 //
-//    // Example sending a request using the UpdateVirtualNodeRequest method.
-//    req, resp := client.UpdateVirtualNodeRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/UpdateVirtualNode
 func (c *AppMesh) UpdateVirtualNodeRequest(input *UpdateVirtualNodeInput) (req *request.Request, output *UpdateVirtualNodeOutput) {
@@ -2972,37 +4140,38 @@ func (c *AppMesh) UpdateVirtualNodeRequest(input *UpdateVirtualNodeInput) (req *
 // See the AWS API reference guide for AWS App Mesh's
 // API operation UpdateVirtualNode for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeConflictException "ConflictException"
-//   The request contains a client token that was used for a previous update resource
-//   call with different specifications. Try the request again with a new client
-//   token.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - ConflictException
+//     The request contains a client token that was used for a previous update resource
+//     call with different specifications. Try the request again with a new client
+//     token.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   You have exceeded a service limit for your account. For more information,
-//   see Service Limits (https://docs.aws.amazon.com/app-mesh/latest/userguide/service_limits.html)
-//   in the AWS App Mesh User Guide.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - LimitExceededException
+//     You have exceeded a service limit for your account. For more information,
+//     see Service Limits (https://docs.aws.amazon.com/app-mesh/latest/userguide/service-quotas.html)
+//     in the App Mesh User Guide.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/UpdateVirtualNode
 func (c *AppMesh) UpdateVirtualNode(input *UpdateVirtualNodeInput) (*UpdateVirtualNodeOutput, error) {
@@ -3042,14 +4211,13 @@ const opUpdateVirtualRouter = "UpdateVirtualRouter"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateVirtualRouterRequest method.
+//	req, resp := client.UpdateVirtualRouterRequest(params)
 //
-//    // Example sending a request using the UpdateVirtualRouterRequest method.
-//    req, resp := client.UpdateVirtualRouterRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/UpdateVirtualRouter
 func (c *AppMesh) UpdateVirtualRouterRequest(input *UpdateVirtualRouterInput) (req *request.Request, output *UpdateVirtualRouterOutput) {
@@ -3079,37 +4247,38 @@ func (c *AppMesh) UpdateVirtualRouterRequest(input *UpdateVirtualRouterInput) (r
 // See the AWS API reference guide for AWS App Mesh's
 // API operation UpdateVirtualRouter for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeConflictException "ConflictException"
-//   The request contains a client token that was used for a previous update resource
-//   call with different specifications. Try the request again with a new client
-//   token.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - ConflictException
+//     The request contains a client token that was used for a previous update resource
+//     call with different specifications. Try the request again with a new client
+//     token.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   You have exceeded a service limit for your account. For more information,
-//   see Service Limits (https://docs.aws.amazon.com/app-mesh/latest/userguide/service_limits.html)
-//   in the AWS App Mesh User Guide.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - LimitExceededException
+//     You have exceeded a service limit for your account. For more information,
+//     see Service Limits (https://docs.aws.amazon.com/app-mesh/latest/userguide/service-quotas.html)
+//     in the App Mesh User Guide.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/UpdateVirtualRouter
 func (c *AppMesh) UpdateVirtualRouter(input *UpdateVirtualRouterInput) (*UpdateVirtualRouterOutput, error) {
@@ -3149,14 +4318,13 @@ const opUpdateVirtualService = "UpdateVirtualService"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateVirtualServiceRequest method.
+//	req, resp := client.UpdateVirtualServiceRequest(params)
 //
-//    // Example sending a request using the UpdateVirtualServiceRequest method.
-//    req, resp := client.UpdateVirtualServiceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/UpdateVirtualService
 func (c *AppMesh) UpdateVirtualServiceRequest(input *UpdateVirtualServiceInput) (req *request.Request, output *UpdateVirtualServiceOutput) {
@@ -3186,37 +4354,38 @@ func (c *AppMesh) UpdateVirtualServiceRequest(input *UpdateVirtualServiceInput)
 // See the AWS API reference guide for AWS App Mesh's
 // API operation UpdateVirtualService for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The request syntax was malformed. Check your request syntax and try again.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified resource doesn't exist. Check your request syntax and try again.
 //
-//   * ErrCodeConflictException "ConflictException"
-//   The request contains a client token that was used for a previous update resource
-//   call with different specifications. Try the request again with a new client
-//   token.
+//   - BadRequestException
+//     The request syntax was malformed. Check your request syntax and try again.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   You don't have permissions to perform this action.
+//   - ConflictException
+//     The request contains a client token that was used for a previous update resource
+//     call with different specifications. Try the request again with a new client
+//     token.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The request processing has failed because of an unknown error, exception,
-//   or failure.
+//   - TooManyRequestsException
+//     The maximum request rate permitted by the App Mesh APIs has been exceeded
+//     for your account. For best results, use an increasing or variable sleep interval
+//     between requests.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   You have exceeded a service limit for your account. For more information,
-//   see Service Limits (https://docs.aws.amazon.com/app-mesh/latest/userguide/service_limits.html)
-//   in the AWS App Mesh User Guide.
+//   - ForbiddenException
+//     You don't have permissions to perform this action.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified resource doesn't exist. Check your request syntax and try again.
+//   - ServiceUnavailableException
+//     The request has failed due to a temporary failure of the service.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The request has failed due to a temporary failure of the service.
+//   - InternalServerErrorException
+//     The request processing has failed because of an unknown error, exception,
+//     or failure.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The maximum request rate permitted by the App Mesh APIs has been exceeded
-//   for your account. For best results, use an increasing or variable sleep interval
-//   between requests.
+//   - LimitExceededException
+//     You have exceeded a service limit for your account. For more information,
+//     see Service Limits (https://docs.aws.amazon.com/app-mesh/latest/userguide/service-quotas.html)
+//     in the App Mesh User Guide.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/appmesh-2019-01-25/UpdateVirtualService
 func (c *AppMesh) UpdateVirtualService(input *UpdateVirtualServiceInput) (*UpdateVirtualServiceOutput, error) {
@@ -3240,20 +4409,28 @@ func (c *AppMesh) UpdateVirtualServiceWithContext(ctx aws.Context, input *Update
 	return out, req.Send()
 }
 
-// An object representing the access logging information for a virtual node.
+// An object that represents the access logging information for a virtual node.
 type AccessLog struct {
 	_ struct{} `type:"structure"`
 
-	// An object representing an access log file.
+	// The file object to send virtual node access logs to.
 	File *FileAccessLog `locationName:"file" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AccessLog) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AccessLog) GoString() string {
 	return s.String()
 }
@@ -3279,24 +4456,40 @@ func (s *AccessLog) SetFile(v *FileAccessLog) *AccessLog {
 	return s
 }
 
-// An object representing the AWS Cloud Map attribute information for your virtual
+// An object that represents the Cloud Map attribute information for your virtual
 // node.
+//
+// Cloud Map is not available in the eu-south-1 Region.
 type AwsCloudMapInstanceAttribute struct {
 	_ struct{} `type:"structure"`
 
+	// The name of an Cloud Map service instance attribute key. Any Cloud Map service
+	// instance that contains the specified key and value is returned.
+	//
 	// Key is a required field
 	Key *string `locationName:"key" min:"1" type:"string" required:"true"`
 
+	// The value of an Cloud Map service instance attribute key. Any Cloud Map service
+	// instance that contains the specified key and value is returned.
+	//
 	// Value is a required field
 	Value *string `locationName:"value" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AwsCloudMapInstanceAttribute) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AwsCloudMapInstanceAttribute) GoString() string {
 	return s.String()
 }
@@ -3335,26 +4528,49 @@ func (s *AwsCloudMapInstanceAttribute) SetValue(v string) *AwsCloudMapInstanceAt
 	return s
 }
 
-// An object representing the AWS Cloud Map service discovery information for
+// An object that represents the Cloud Map service discovery information for
 // your virtual node.
+//
+// Cloud Map is not available in the eu-south-1 Region.
 type AwsCloudMapServiceDiscovery struct {
 	_ struct{} `type:"structure"`
 
+	// A string map that contains attributes with values that you can use to filter
+	// instances by any custom attribute that you specified when you registered
+	// the instance. Only instances that match all of the specified key/value pairs
+	// will be returned.
 	Attributes []*AwsCloudMapInstanceAttribute `locationName:"attributes" type:"list"`
 
+	// The preferred IP version that this virtual node uses. Setting the IP preference
+	// on the virtual node only overrides the IP preference set for the mesh on
+	// this specific node.
+	IpPreference *string `locationName:"ipPreference" type:"string" enum:"IpPreference"`
+
+	// The name of the Cloud Map namespace to use.
+	//
 	// NamespaceName is a required field
 	NamespaceName *string `locationName:"namespaceName" min:"1" type:"string" required:"true"`
 
+	// The name of the Cloud Map service to use.
+	//
 	// ServiceName is a required field
 	ServiceName *string `locationName:"serviceName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AwsCloudMapServiceDiscovery) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AwsCloudMapServiceDiscovery) GoString() string {
 	return s.String()
 }
@@ -3397,6 +4613,12 @@ func (s *AwsCloudMapServiceDiscovery) SetAttributes(v []*AwsCloudMapInstanceAttr
 	return s
 }
 
+// SetIpPreference sets the IpPreference field's value.
+func (s *AwsCloudMapServiceDiscovery) SetIpPreference(v string) *AwsCloudMapServiceDiscovery {
+	s.IpPreference = &v
+	return s
+}
+
 // SetNamespaceName sets the NamespaceName field's value.
 func (s *AwsCloudMapServiceDiscovery) SetNamespaceName(v string) *AwsCloudMapServiceDiscovery {
 	s.NamespaceName = &v
@@ -3409,21 +4631,29 @@ func (s *AwsCloudMapServiceDiscovery) SetServiceName(v string) *AwsCloudMapServi
 	return s
 }
 
-// An object representing the backends that a virtual node is expected to send
-// outbound traffic to.
+// An object that represents the backends that a virtual node is expected to
+// send outbound traffic to.
 type Backend struct {
 	_ struct{} `type:"structure"`
 
-	// An object representing a virtual service backend for a virtual node.
+	// Specifies a virtual service to use as a backend.
 	VirtualService *VirtualServiceBackend `locationName:"virtualService" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Backend) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Backend) GoString() string {
 	return s.String()
 }
@@ -3449,52 +4679,217 @@ func (s *Backend) SetVirtualService(v *VirtualServiceBackend) *Backend {
 	return s
 }
 
-type CreateMeshInput struct {
+// An object that represents the default properties for a backend.
+type BackendDefaults struct {
 	_ struct{} `type:"structure"`
 
-	ClientToken *string `locationName:"clientToken" type:"string" idempotencyToken:"true"`
+	// A reference to an object that represents a client policy.
+	ClientPolicy *ClientPolicy `locationName:"clientPolicy" type:"structure"`
+}
 
-	// MeshName is a required field
-	MeshName *string `locationName:"meshName" min:"1" type:"string" required:"true"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BackendDefaults) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// An object representing the specification of a service mesh.
-	Spec *MeshSpec `locationName:"spec" type:"structure"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BackendDefaults) GoString() string {
+	return s.String()
+}
 
-	Tags []*TagRef `locationName:"tags" type:"list"`
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *BackendDefaults) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "BackendDefaults"}
+	if s.ClientPolicy != nil {
+		if err := s.ClientPolicy.Validate(); err != nil {
+			invalidParams.AddNested("ClientPolicy", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// String returns the string representation
-func (s CreateMeshInput) String() string {
+// SetClientPolicy sets the ClientPolicy field's value.
+func (s *BackendDefaults) SetClientPolicy(v *ClientPolicy) *BackendDefaults {
+	s.ClientPolicy = v
+	return s
+}
+
+// The request syntax was malformed. Check your request syntax and try again.
+type BadRequestException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BadRequestException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateMeshInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BadRequestException) GoString() string {
+	return s.String()
+}
+
+func newErrorBadRequestException(v protocol.ResponseMetadata) error {
+	return &BadRequestException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *BadRequestException) Code() string {
+	return "BadRequestException"
+}
+
+// Message returns the exception's message.
+func (s *BadRequestException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *BadRequestException) OrigErr() error {
+	return nil
+}
+
+func (s *BadRequestException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *BadRequestException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *BadRequestException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// An object that represents a client policy.
+type ClientPolicy struct {
+	_ struct{} `type:"structure"`
+
+	// A reference to an object that represents a Transport Layer Security (TLS)
+	// client policy.
+	Tls *ClientPolicyTls `locationName:"tls" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ClientPolicy) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ClientPolicy) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateMeshInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateMeshInput"}
-	if s.MeshName == nil {
-		invalidParams.Add(request.NewErrParamRequired("MeshName"))
+func (s *ClientPolicy) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ClientPolicy"}
+	if s.Tls != nil {
+		if err := s.Tls.Validate(); err != nil {
+			invalidParams.AddNested("Tls", err.(request.ErrInvalidParams))
+		}
 	}
-	if s.MeshName != nil && len(*s.MeshName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
 	}
-	if s.Spec != nil {
-		if err := s.Spec.Validate(); err != nil {
-			invalidParams.AddNested("Spec", err.(request.ErrInvalidParams))
+	return nil
+}
+
+// SetTls sets the Tls field's value.
+func (s *ClientPolicy) SetTls(v *ClientPolicyTls) *ClientPolicy {
+	s.Tls = v
+	return s
+}
+
+// A reference to an object that represents a Transport Layer Security (TLS)
+// client policy.
+type ClientPolicyTls struct {
+	_ struct{} `type:"structure"`
+
+	// A reference to an object that represents a client's TLS certificate.
+	Certificate *ClientTlsCertificate `locationName:"certificate" type:"structure"`
+
+	// Whether the policy is enforced. The default is True, if a value isn't specified.
+	Enforce *bool `locationName:"enforce" type:"boolean"`
+
+	// One or more ports that the policy is enforced for.
+	Ports []*int64 `locationName:"ports" type:"list"`
+
+	// A reference to an object that represents a TLS validation context.
+	//
+	// Validation is a required field
+	Validation *TlsValidationContext `locationName:"validation" type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ClientPolicyTls) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ClientPolicyTls) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ClientPolicyTls) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ClientPolicyTls"}
+	if s.Validation == nil {
+		invalidParams.Add(request.NewErrParamRequired("Validation"))
+	}
+	if s.Certificate != nil {
+		if err := s.Certificate.Validate(); err != nil {
+			invalidParams.AddNested("Certificate", err.(request.ErrInvalidParams))
 		}
 	}
-	if s.Tags != nil {
-		for i, v := range s.Tags {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
-			}
+	if s.Validation != nil {
+		if err := s.Validation.Validate(); err != nil {
+			invalidParams.AddNested("Validation", err.(request.ErrInvalidParams))
 		}
 	}
 
@@ -3504,110 +4899,247 @@ func (s *CreateMeshInput) Validate() error {
 	return nil
 }
 
-// SetClientToken sets the ClientToken field's value.
-func (s *CreateMeshInput) SetClientToken(v string) *CreateMeshInput {
-	s.ClientToken = &v
+// SetCertificate sets the Certificate field's value.
+func (s *ClientPolicyTls) SetCertificate(v *ClientTlsCertificate) *ClientPolicyTls {
+	s.Certificate = v
 	return s
 }
 
-// SetMeshName sets the MeshName field's value.
-func (s *CreateMeshInput) SetMeshName(v string) *CreateMeshInput {
-	s.MeshName = &v
+// SetEnforce sets the Enforce field's value.
+func (s *ClientPolicyTls) SetEnforce(v bool) *ClientPolicyTls {
+	s.Enforce = &v
 	return s
 }
 
-// SetSpec sets the Spec field's value.
-func (s *CreateMeshInput) SetSpec(v *MeshSpec) *CreateMeshInput {
-	s.Spec = v
+// SetPorts sets the Ports field's value.
+func (s *ClientPolicyTls) SetPorts(v []*int64) *ClientPolicyTls {
+	s.Ports = v
 	return s
 }
 
-// SetTags sets the Tags field's value.
-func (s *CreateMeshInput) SetTags(v []*TagRef) *CreateMeshInput {
-	s.Tags = v
+// SetValidation sets the Validation field's value.
+func (s *ClientPolicyTls) SetValidation(v *TlsValidationContext) *ClientPolicyTls {
+	s.Validation = v
 	return s
 }
 
-type CreateMeshOutput struct {
-	_ struct{} `type:"structure" payload:"Mesh"`
+// An object that represents the client's certificate.
+type ClientTlsCertificate struct {
+	_ struct{} `type:"structure"`
 
-	// An object representing a service mesh returned by a describe operation.
-	//
-	// Mesh is a required field
-	Mesh *MeshData `locationName:"mesh" type:"structure" required:"true"`
+	// An object that represents a local file certificate. The certificate must
+	// meet specific requirements and you must have proxy authorization enabled.
+	// For more information, see Transport Layer Security (TLS) (https://docs.aws.amazon.com/app-mesh/latest/userguide/tls.html).
+	File *ListenerTlsFileCertificate `locationName:"file" type:"structure"`
+
+	// A reference to an object that represents a client's TLS Secret Discovery
+	// Service certificate.
+	Sds *ListenerTlsSdsCertificate `locationName:"sds" type:"structure"`
 }
 
-// String returns the string representation
-func (s CreateMeshOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ClientTlsCertificate) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateMeshOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ClientTlsCertificate) GoString() string {
 	return s.String()
 }
 
-// SetMesh sets the Mesh field's value.
-func (s *CreateMeshOutput) SetMesh(v *MeshData) *CreateMeshOutput {
-	s.Mesh = v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ClientTlsCertificate) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ClientTlsCertificate"}
+	if s.File != nil {
+		if err := s.File.Validate(); err != nil {
+			invalidParams.AddNested("File", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Sds != nil {
+		if err := s.Sds.Validate(); err != nil {
+			invalidParams.AddNested("Sds", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetFile sets the File field's value.
+func (s *ClientTlsCertificate) SetFile(v *ListenerTlsFileCertificate) *ClientTlsCertificate {
+	s.File = v
 	return s
 }
 
-type CreateRouteInput struct {
+// SetSds sets the Sds field's value.
+func (s *ClientTlsCertificate) SetSds(v *ListenerTlsSdsCertificate) *ClientTlsCertificate {
+	s.Sds = v
+	return s
+}
+
+// The request contains a client token that was used for a previous update resource
+// call with different specifications. Try the request again with a new client
+// token.
+type ConflictException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConflictException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConflictException) GoString() string {
+	return s.String()
+}
+
+func newErrorConflictException(v protocol.ResponseMetadata) error {
+	return &ConflictException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ConflictException) Code() string {
+	return "ConflictException"
+}
+
+// Message returns the exception's message.
+func (s *ConflictException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ConflictException) OrigErr() error {
+	return nil
+}
+
+func (s *ConflictException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ConflictException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ConflictException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type CreateGatewayRouteInput struct {
 	_ struct{} `type:"structure"`
 
+	// Unique, case-sensitive identifier that you provide to ensure the idempotency
+	// of the request. Up to 36 letters, numbers, hyphens, and underscores are allowed.
 	ClientToken *string `locationName:"clientToken" type:"string" idempotencyToken:"true"`
 
+	// The name to use for the gateway route.
+	//
+	// GatewayRouteName is a required field
+	GatewayRouteName *string `locationName:"gatewayRouteName" min:"1" type:"string" required:"true"`
+
+	// The name of the service mesh to create the gateway route in.
+	//
 	// MeshName is a required field
 	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
 
-	// RouteName is a required field
-	RouteName *string `locationName:"routeName" min:"1" type:"string" required:"true"`
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then the account that you specify must share
+	// the mesh with your account before you can create the resource in the service
+	// mesh. For more information about mesh sharing, see Working with shared meshes
+	// (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
 
-	// An object representing the specification of a route.
+	// The gateway route specification to apply.
 	//
 	// Spec is a required field
-	Spec *RouteSpec `locationName:"spec" type:"structure" required:"true"`
+	Spec *GatewayRouteSpec `locationName:"spec" type:"structure" required:"true"`
 
+	// Optional metadata that you can apply to the gateway route to assist with
+	// categorization and organization. Each tag consists of a key and an optional
+	// value, both of which you define. Tag keys can have a maximum character length
+	// of 128 characters, and tag values can have a maximum length of 256 characters.
 	Tags []*TagRef `locationName:"tags" type:"list"`
 
-	// VirtualRouterName is a required field
-	VirtualRouterName *string `location:"uri" locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
+	// The name of the virtual gateway to associate the gateway route with. If the
+	// virtual gateway is in a shared mesh, then you must be the owner of the virtual
+	// gateway resource.
+	//
+	// VirtualGatewayName is a required field
+	VirtualGatewayName *string `location:"uri" locationName:"virtualGatewayName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateRouteInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateGatewayRouteInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateRouteInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateGatewayRouteInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateRouteInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateRouteInput"}
+func (s *CreateGatewayRouteInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateGatewayRouteInput"}
+	if s.GatewayRouteName == nil {
+		invalidParams.Add(request.NewErrParamRequired("GatewayRouteName"))
+	}
+	if s.GatewayRouteName != nil && len(*s.GatewayRouteName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("GatewayRouteName", 1))
+	}
 	if s.MeshName == nil {
 		invalidParams.Add(request.NewErrParamRequired("MeshName"))
 	}
 	if s.MeshName != nil && len(*s.MeshName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
 	}
-	if s.RouteName == nil {
-		invalidParams.Add(request.NewErrParamRequired("RouteName"))
-	}
-	if s.RouteName != nil && len(*s.RouteName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("RouteName", 1))
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
 	}
 	if s.Spec == nil {
 		invalidParams.Add(request.NewErrParamRequired("Spec"))
 	}
-	if s.VirtualRouterName == nil {
-		invalidParams.Add(request.NewErrParamRequired("VirtualRouterName"))
+	if s.VirtualGatewayName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualGatewayName"))
 	}
-	if s.VirtualRouterName != nil && len(*s.VirtualRouterName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("VirtualRouterName", 1))
+	if s.VirtualGatewayName != nil && len(*s.VirtualGatewayName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VirtualGatewayName", 1))
 	}
 	if s.Spec != nil {
 		if err := s.Spec.Validate(); err != nil {
@@ -3632,113 +5164,129 @@ func (s *CreateRouteInput) Validate() error {
 }
 
 // SetClientToken sets the ClientToken field's value.
-func (s *CreateRouteInput) SetClientToken(v string) *CreateRouteInput {
+func (s *CreateGatewayRouteInput) SetClientToken(v string) *CreateGatewayRouteInput {
 	s.ClientToken = &v
 	return s
 }
 
+// SetGatewayRouteName sets the GatewayRouteName field's value.
+func (s *CreateGatewayRouteInput) SetGatewayRouteName(v string) *CreateGatewayRouteInput {
+	s.GatewayRouteName = &v
+	return s
+}
+
 // SetMeshName sets the MeshName field's value.
-func (s *CreateRouteInput) SetMeshName(v string) *CreateRouteInput {
+func (s *CreateGatewayRouteInput) SetMeshName(v string) *CreateGatewayRouteInput {
 	s.MeshName = &v
 	return s
 }
 
-// SetRouteName sets the RouteName field's value.
-func (s *CreateRouteInput) SetRouteName(v string) *CreateRouteInput {
-	s.RouteName = &v
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *CreateGatewayRouteInput) SetMeshOwner(v string) *CreateGatewayRouteInput {
+	s.MeshOwner = &v
 	return s
 }
 
 // SetSpec sets the Spec field's value.
-func (s *CreateRouteInput) SetSpec(v *RouteSpec) *CreateRouteInput {
+func (s *CreateGatewayRouteInput) SetSpec(v *GatewayRouteSpec) *CreateGatewayRouteInput {
 	s.Spec = v
 	return s
 }
 
 // SetTags sets the Tags field's value.
-func (s *CreateRouteInput) SetTags(v []*TagRef) *CreateRouteInput {
+func (s *CreateGatewayRouteInput) SetTags(v []*TagRef) *CreateGatewayRouteInput {
 	s.Tags = v
 	return s
 }
 
-// SetVirtualRouterName sets the VirtualRouterName field's value.
-func (s *CreateRouteInput) SetVirtualRouterName(v string) *CreateRouteInput {
-	s.VirtualRouterName = &v
+// SetVirtualGatewayName sets the VirtualGatewayName field's value.
+func (s *CreateGatewayRouteInput) SetVirtualGatewayName(v string) *CreateGatewayRouteInput {
+	s.VirtualGatewayName = &v
 	return s
 }
 
-type CreateRouteOutput struct {
-	_ struct{} `type:"structure" payload:"Route"`
+type CreateGatewayRouteOutput struct {
+	_ struct{} `type:"structure" payload:"GatewayRoute"`
 
-	// An object representing a route returned by a describe operation.
+	// The full description of your gateway route following the create call.
 	//
-	// Route is a required field
-	Route *RouteData `locationName:"route" type:"structure" required:"true"`
+	// GatewayRoute is a required field
+	GatewayRoute *GatewayRouteData `locationName:"gatewayRoute" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateRouteOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateGatewayRouteOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateRouteOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateGatewayRouteOutput) GoString() string {
 	return s.String()
 }
 
-// SetRoute sets the Route field's value.
-func (s *CreateRouteOutput) SetRoute(v *RouteData) *CreateRouteOutput {
-	s.Route = v
+// SetGatewayRoute sets the GatewayRoute field's value.
+func (s *CreateGatewayRouteOutput) SetGatewayRoute(v *GatewayRouteData) *CreateGatewayRouteOutput {
+	s.GatewayRoute = v
 	return s
 }
 
-type CreateVirtualNodeInput struct {
+type CreateMeshInput struct {
 	_ struct{} `type:"structure"`
 
+	// Unique, case-sensitive identifier that you provide to ensure the idempotency
+	// of the request. Up to 36 letters, numbers, hyphens, and underscores are allowed.
 	ClientToken *string `locationName:"clientToken" type:"string" idempotencyToken:"true"`
 
+	// The name to use for the service mesh.
+	//
 	// MeshName is a required field
-	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
+	MeshName *string `locationName:"meshName" min:"1" type:"string" required:"true"`
 
-	// An object representing the specification of a virtual node.
-	//
-	// Spec is a required field
-	Spec *VirtualNodeSpec `locationName:"spec" type:"structure" required:"true"`
+	// The service mesh specification to apply.
+	Spec *MeshSpec `locationName:"spec" type:"structure"`
 
+	// Optional metadata that you can apply to the service mesh to assist with categorization
+	// and organization. Each tag consists of a key and an optional value, both
+	// of which you define. Tag keys can have a maximum character length of 128
+	// characters, and tag values can have a maximum length of 256 characters.
 	Tags []*TagRef `locationName:"tags" type:"list"`
-
-	// VirtualNodeName is a required field
-	VirtualNodeName *string `locationName:"virtualNodeName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateVirtualNodeInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateMeshInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateVirtualNodeInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateMeshInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateVirtualNodeInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateVirtualNodeInput"}
+func (s *CreateMeshInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateMeshInput"}
 	if s.MeshName == nil {
 		invalidParams.Add(request.NewErrParamRequired("MeshName"))
 	}
 	if s.MeshName != nil && len(*s.MeshName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
 	}
-	if s.Spec == nil {
-		invalidParams.Add(request.NewErrParamRequired("Spec"))
-	}
-	if s.VirtualNodeName == nil {
-		invalidParams.Add(request.NewErrParamRequired("VirtualNodeName"))
-	}
-	if s.VirtualNodeName != nil && len(*s.VirtualNodeName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("VirtualNodeName", 1))
-	}
 	if s.Spec != nil {
 		if err := s.Spec.Validate(); err != nil {
 			invalidParams.AddNested("Spec", err.(request.ErrInvalidParams))
@@ -3762,98 +5310,141 @@ func (s *CreateVirtualNodeInput) Validate() error {
 }
 
 // SetClientToken sets the ClientToken field's value.
-func (s *CreateVirtualNodeInput) SetClientToken(v string) *CreateVirtualNodeInput {
+func (s *CreateMeshInput) SetClientToken(v string) *CreateMeshInput {
 	s.ClientToken = &v
 	return s
 }
 
 // SetMeshName sets the MeshName field's value.
-func (s *CreateVirtualNodeInput) SetMeshName(v string) *CreateVirtualNodeInput {
+func (s *CreateMeshInput) SetMeshName(v string) *CreateMeshInput {
 	s.MeshName = &v
 	return s
 }
 
 // SetSpec sets the Spec field's value.
-func (s *CreateVirtualNodeInput) SetSpec(v *VirtualNodeSpec) *CreateVirtualNodeInput {
+func (s *CreateMeshInput) SetSpec(v *MeshSpec) *CreateMeshInput {
 	s.Spec = v
 	return s
 }
 
 // SetTags sets the Tags field's value.
-func (s *CreateVirtualNodeInput) SetTags(v []*TagRef) *CreateVirtualNodeInput {
+func (s *CreateMeshInput) SetTags(v []*TagRef) *CreateMeshInput {
 	s.Tags = v
 	return s
 }
 
-// SetVirtualNodeName sets the VirtualNodeName field's value.
-func (s *CreateVirtualNodeInput) SetVirtualNodeName(v string) *CreateVirtualNodeInput {
-	s.VirtualNodeName = &v
-	return s
-}
-
-type CreateVirtualNodeOutput struct {
-	_ struct{} `type:"structure" payload:"VirtualNode"`
+type CreateMeshOutput struct {
+	_ struct{} `type:"structure" payload:"Mesh"`
 
-	// An object representing a virtual node returned by a describe operation.
+	// The full description of your service mesh following the create call.
 	//
-	// VirtualNode is a required field
-	VirtualNode *VirtualNodeData `locationName:"virtualNode" type:"structure" required:"true"`
+	// Mesh is a required field
+	Mesh *MeshData `locationName:"mesh" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateVirtualNodeOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateMeshOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateVirtualNodeOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateMeshOutput) GoString() string {
 	return s.String()
 }
 
-// SetVirtualNode sets the VirtualNode field's value.
-func (s *CreateVirtualNodeOutput) SetVirtualNode(v *VirtualNodeData) *CreateVirtualNodeOutput {
-	s.VirtualNode = v
+// SetMesh sets the Mesh field's value.
+func (s *CreateMeshOutput) SetMesh(v *MeshData) *CreateMeshOutput {
+	s.Mesh = v
 	return s
 }
 
-type CreateVirtualRouterInput struct {
+type CreateRouteInput struct {
 	_ struct{} `type:"structure"`
 
+	// Unique, case-sensitive identifier that you provide to ensure the idempotency
+	// of the request. Up to 36 letters, numbers, hyphens, and underscores are allowed.
 	ClientToken *string `locationName:"clientToken" type:"string" idempotencyToken:"true"`
 
+	// The name of the service mesh to create the route in.
+	//
 	// MeshName is a required field
 	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
 
-	// An object representing the specification of a virtual router.
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then the account that you specify must share
+	// the mesh with your account before you can create the resource in the service
+	// mesh. For more information about mesh sharing, see Working with shared meshes
+	// (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
+
+	// The name to use for the route.
+	//
+	// RouteName is a required field
+	RouteName *string `locationName:"routeName" min:"1" type:"string" required:"true"`
+
+	// The route specification to apply.
 	//
 	// Spec is a required field
-	Spec *VirtualRouterSpec `locationName:"spec" type:"structure" required:"true"`
+	Spec *RouteSpec `locationName:"spec" type:"structure" required:"true"`
 
+	// Optional metadata that you can apply to the route to assist with categorization
+	// and organization. Each tag consists of a key and an optional value, both
+	// of which you define. Tag keys can have a maximum character length of 128
+	// characters, and tag values can have a maximum length of 256 characters.
 	Tags []*TagRef `locationName:"tags" type:"list"`
 
+	// The name of the virtual router in which to create the route. If the virtual
+	// router is in a shared mesh, then you must be the owner of the virtual router
+	// resource.
+	//
 	// VirtualRouterName is a required field
-	VirtualRouterName *string `locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
+	VirtualRouterName *string `location:"uri" locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateVirtualRouterInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateRouteInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateVirtualRouterInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateRouteInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateVirtualRouterInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateVirtualRouterInput"}
+func (s *CreateRouteInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateRouteInput"}
 	if s.MeshName == nil {
 		invalidParams.Add(request.NewErrParamRequired("MeshName"))
 	}
 	if s.MeshName != nil && len(*s.MeshName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
 	}
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
+	}
+	if s.RouteName == nil {
+		invalidParams.Add(request.NewErrParamRequired("RouteName"))
+	}
+	if s.RouteName != nil && len(*s.RouteName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RouteName", 1))
+	}
 	if s.Spec == nil {
 		invalidParams.Add(request.NewErrParamRequired("Spec"))
 	}
@@ -3886,103 +5477,154 @@ func (s *CreateVirtualRouterInput) Validate() error {
 }
 
 // SetClientToken sets the ClientToken field's value.
-func (s *CreateVirtualRouterInput) SetClientToken(v string) *CreateVirtualRouterInput {
+func (s *CreateRouteInput) SetClientToken(v string) *CreateRouteInput {
 	s.ClientToken = &v
 	return s
 }
 
 // SetMeshName sets the MeshName field's value.
-func (s *CreateVirtualRouterInput) SetMeshName(v string) *CreateVirtualRouterInput {
+func (s *CreateRouteInput) SetMeshName(v string) *CreateRouteInput {
 	s.MeshName = &v
 	return s
 }
 
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *CreateRouteInput) SetMeshOwner(v string) *CreateRouteInput {
+	s.MeshOwner = &v
+	return s
+}
+
+// SetRouteName sets the RouteName field's value.
+func (s *CreateRouteInput) SetRouteName(v string) *CreateRouteInput {
+	s.RouteName = &v
+	return s
+}
+
 // SetSpec sets the Spec field's value.
-func (s *CreateVirtualRouterInput) SetSpec(v *VirtualRouterSpec) *CreateVirtualRouterInput {
+func (s *CreateRouteInput) SetSpec(v *RouteSpec) *CreateRouteInput {
 	s.Spec = v
 	return s
 }
 
 // SetTags sets the Tags field's value.
-func (s *CreateVirtualRouterInput) SetTags(v []*TagRef) *CreateVirtualRouterInput {
+func (s *CreateRouteInput) SetTags(v []*TagRef) *CreateRouteInput {
 	s.Tags = v
 	return s
 }
 
 // SetVirtualRouterName sets the VirtualRouterName field's value.
-func (s *CreateVirtualRouterInput) SetVirtualRouterName(v string) *CreateVirtualRouterInput {
+func (s *CreateRouteInput) SetVirtualRouterName(v string) *CreateRouteInput {
 	s.VirtualRouterName = &v
 	return s
 }
 
-type CreateVirtualRouterOutput struct {
-	_ struct{} `type:"structure" payload:"VirtualRouter"`
+type CreateRouteOutput struct {
+	_ struct{} `type:"structure" payload:"Route"`
 
-	// An object representing a virtual router returned by a describe operation.
+	// The full description of your mesh following the create call.
 	//
-	// VirtualRouter is a required field
-	VirtualRouter *VirtualRouterData `locationName:"virtualRouter" type:"structure" required:"true"`
+	// Route is a required field
+	Route *RouteData `locationName:"route" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateVirtualRouterOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateRouteOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateVirtualRouterOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateRouteOutput) GoString() string {
 	return s.String()
 }
 
-// SetVirtualRouter sets the VirtualRouter field's value.
-func (s *CreateVirtualRouterOutput) SetVirtualRouter(v *VirtualRouterData) *CreateVirtualRouterOutput {
-	s.VirtualRouter = v
+// SetRoute sets the Route field's value.
+func (s *CreateRouteOutput) SetRoute(v *RouteData) *CreateRouteOutput {
+	s.Route = v
 	return s
 }
 
-type CreateVirtualServiceInput struct {
+type CreateVirtualGatewayInput struct {
 	_ struct{} `type:"structure"`
 
+	// Unique, case-sensitive identifier that you provide to ensure the idempotency
+	// of the request. Up to 36 letters, numbers, hyphens, and underscores are allowed.
 	ClientToken *string `locationName:"clientToken" type:"string" idempotencyToken:"true"`
 
+	// The name of the service mesh to create the virtual gateway in.
+	//
 	// MeshName is a required field
 	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
 
-	// An object representing the specification of a virtual service.
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then the account that you specify must share
+	// the mesh with your account before you can create the resource in the service
+	// mesh. For more information about mesh sharing, see Working with shared meshes
+	// (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
+
+	// The virtual gateway specification to apply.
 	//
 	// Spec is a required field
-	Spec *VirtualServiceSpec `locationName:"spec" type:"structure" required:"true"`
+	Spec *VirtualGatewaySpec `locationName:"spec" type:"structure" required:"true"`
 
+	// Optional metadata that you can apply to the virtual gateway to assist with
+	// categorization and organization. Each tag consists of a key and an optional
+	// value, both of which you define. Tag keys can have a maximum character length
+	// of 128 characters, and tag values can have a maximum length of 256 characters.
 	Tags []*TagRef `locationName:"tags" type:"list"`
 
-	// VirtualServiceName is a required field
-	VirtualServiceName *string `locationName:"virtualServiceName" type:"string" required:"true"`
+	// The name to use for the virtual gateway.
+	//
+	// VirtualGatewayName is a required field
+	VirtualGatewayName *string `locationName:"virtualGatewayName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateVirtualServiceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVirtualGatewayInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateVirtualServiceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVirtualGatewayInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateVirtualServiceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateVirtualServiceInput"}
+func (s *CreateVirtualGatewayInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateVirtualGatewayInput"}
 	if s.MeshName == nil {
 		invalidParams.Add(request.NewErrParamRequired("MeshName"))
 	}
 	if s.MeshName != nil && len(*s.MeshName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
 	}
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
+	}
 	if s.Spec == nil {
 		invalidParams.Add(request.NewErrParamRequired("Spec"))
 	}
-	if s.VirtualServiceName == nil {
-		invalidParams.Add(request.NewErrParamRequired("VirtualServiceName"))
+	if s.VirtualGatewayName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualGatewayName"))
+	}
+	if s.VirtualGatewayName != nil && len(*s.VirtualGatewayName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VirtualGatewayName", 1))
 	}
 	if s.Spec != nil {
 		if err := s.Spec.Validate(); err != nil {
@@ -4007,86 +5649,164 @@ func (s *CreateVirtualServiceInput) Validate() error {
 }
 
 // SetClientToken sets the ClientToken field's value.
-func (s *CreateVirtualServiceInput) SetClientToken(v string) *CreateVirtualServiceInput {
+func (s *CreateVirtualGatewayInput) SetClientToken(v string) *CreateVirtualGatewayInput {
 	s.ClientToken = &v
 	return s
 }
 
 // SetMeshName sets the MeshName field's value.
-func (s *CreateVirtualServiceInput) SetMeshName(v string) *CreateVirtualServiceInput {
+func (s *CreateVirtualGatewayInput) SetMeshName(v string) *CreateVirtualGatewayInput {
 	s.MeshName = &v
 	return s
 }
 
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *CreateVirtualGatewayInput) SetMeshOwner(v string) *CreateVirtualGatewayInput {
+	s.MeshOwner = &v
+	return s
+}
+
 // SetSpec sets the Spec field's value.
-func (s *CreateVirtualServiceInput) SetSpec(v *VirtualServiceSpec) *CreateVirtualServiceInput {
+func (s *CreateVirtualGatewayInput) SetSpec(v *VirtualGatewaySpec) *CreateVirtualGatewayInput {
 	s.Spec = v
 	return s
 }
 
 // SetTags sets the Tags field's value.
-func (s *CreateVirtualServiceInput) SetTags(v []*TagRef) *CreateVirtualServiceInput {
+func (s *CreateVirtualGatewayInput) SetTags(v []*TagRef) *CreateVirtualGatewayInput {
 	s.Tags = v
 	return s
 }
 
-// SetVirtualServiceName sets the VirtualServiceName field's value.
-func (s *CreateVirtualServiceInput) SetVirtualServiceName(v string) *CreateVirtualServiceInput {
-	s.VirtualServiceName = &v
+// SetVirtualGatewayName sets the VirtualGatewayName field's value.
+func (s *CreateVirtualGatewayInput) SetVirtualGatewayName(v string) *CreateVirtualGatewayInput {
+	s.VirtualGatewayName = &v
 	return s
 }
 
-type CreateVirtualServiceOutput struct {
-	_ struct{} `type:"structure" payload:"VirtualService"`
+type CreateVirtualGatewayOutput struct {
+	_ struct{} `type:"structure" payload:"VirtualGateway"`
 
-	// An object representing a virtual service returned by a describe operation.
+	// The full description of your virtual gateway following the create call.
 	//
-	// VirtualService is a required field
-	VirtualService *VirtualServiceData `locationName:"virtualService" type:"structure" required:"true"`
+	// VirtualGateway is a required field
+	VirtualGateway *VirtualGatewayData `locationName:"virtualGateway" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateVirtualServiceOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVirtualGatewayOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateVirtualServiceOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVirtualGatewayOutput) GoString() string {
 	return s.String()
 }
 
-// SetVirtualService sets the VirtualService field's value.
-func (s *CreateVirtualServiceOutput) SetVirtualService(v *VirtualServiceData) *CreateVirtualServiceOutput {
-	s.VirtualService = v
+// SetVirtualGateway sets the VirtualGateway field's value.
+func (s *CreateVirtualGatewayOutput) SetVirtualGateway(v *VirtualGatewayData) *CreateVirtualGatewayOutput {
+	s.VirtualGateway = v
 	return s
 }
 
-type DeleteMeshInput struct {
+type CreateVirtualNodeInput struct {
 	_ struct{} `type:"structure"`
 
+	// Unique, case-sensitive identifier that you provide to ensure the idempotency
+	// of the request. Up to 36 letters, numbers, hyphens, and underscores are allowed.
+	ClientToken *string `locationName:"clientToken" type:"string" idempotencyToken:"true"`
+
+	// The name of the service mesh to create the virtual node in.
+	//
 	// MeshName is a required field
 	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then the account that you specify must share
+	// the mesh with your account before you can create the resource in the service
+	// mesh. For more information about mesh sharing, see Working with shared meshes
+	// (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
+
+	// The virtual node specification to apply.
+	//
+	// Spec is a required field
+	Spec *VirtualNodeSpec `locationName:"spec" type:"structure" required:"true"`
+
+	// Optional metadata that you can apply to the virtual node to assist with categorization
+	// and organization. Each tag consists of a key and an optional value, both
+	// of which you define. Tag keys can have a maximum character length of 128
+	// characters, and tag values can have a maximum length of 256 characters.
+	Tags []*TagRef `locationName:"tags" type:"list"`
+
+	// The name to use for the virtual node.
+	//
+	// VirtualNodeName is a required field
+	VirtualNodeName *string `locationName:"virtualNodeName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteMeshInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVirtualNodeInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteMeshInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVirtualNodeInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteMeshInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteMeshInput"}
+func (s *CreateVirtualNodeInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateVirtualNodeInput"}
 	if s.MeshName == nil {
 		invalidParams.Add(request.NewErrParamRequired("MeshName"))
 	}
 	if s.MeshName != nil && len(*s.MeshName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
 	}
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
+	}
+	if s.Spec == nil {
+		invalidParams.Add(request.NewErrParamRequired("Spec"))
+	}
+	if s.VirtualNodeName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualNodeName"))
+	}
+	if s.VirtualNodeName != nil && len(*s.VirtualNodeName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VirtualNodeName", 1))
+	}
+	if s.Spec != nil {
+		if err := s.Spec.Validate(); err != nil {
+			invalidParams.AddNested("Spec", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -4094,74 +5814,143 @@ func (s *DeleteMeshInput) Validate() error {
 	return nil
 }
 
+// SetClientToken sets the ClientToken field's value.
+func (s *CreateVirtualNodeInput) SetClientToken(v string) *CreateVirtualNodeInput {
+	s.ClientToken = &v
+	return s
+}
+
 // SetMeshName sets the MeshName field's value.
-func (s *DeleteMeshInput) SetMeshName(v string) *DeleteMeshInput {
+func (s *CreateVirtualNodeInput) SetMeshName(v string) *CreateVirtualNodeInput {
 	s.MeshName = &v
 	return s
 }
 
-type DeleteMeshOutput struct {
-	_ struct{} `type:"structure" payload:"Mesh"`
-
-	// An object representing a service mesh returned by a describe operation.
-	//
-	// Mesh is a required field
-	Mesh *MeshData `locationName:"mesh" type:"structure" required:"true"`
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *CreateVirtualNodeInput) SetMeshOwner(v string) *CreateVirtualNodeInput {
+	s.MeshOwner = &v
+	return s
 }
 
-// String returns the string representation
-func (s DeleteMeshOutput) String() string {
-	return awsutil.Prettify(s)
+// SetSpec sets the Spec field's value.
+func (s *CreateVirtualNodeInput) SetSpec(v *VirtualNodeSpec) *CreateVirtualNodeInput {
+	s.Spec = v
+	return s
 }
 
-// GoString returns the string representation
-func (s DeleteMeshOutput) GoString() string {
-	return s.String()
+// SetTags sets the Tags field's value.
+func (s *CreateVirtualNodeInput) SetTags(v []*TagRef) *CreateVirtualNodeInput {
+	s.Tags = v
+	return s
 }
 
-// SetMesh sets the Mesh field's value.
-func (s *DeleteMeshOutput) SetMesh(v *MeshData) *DeleteMeshOutput {
-	s.Mesh = v
+// SetVirtualNodeName sets the VirtualNodeName field's value.
+func (s *CreateVirtualNodeInput) SetVirtualNodeName(v string) *CreateVirtualNodeInput {
+	s.VirtualNodeName = &v
 	return s
 }
 
-type DeleteRouteInput struct {
-	_ struct{} `type:"structure"`
-
-	// MeshName is a required field
-	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
+type CreateVirtualNodeOutput struct {
+	_ struct{} `type:"structure" payload:"VirtualNode"`
 
-	// RouteName is a required field
-	RouteName *string `location:"uri" locationName:"routeName" min:"1" type:"string" required:"true"`
+	// The full description of your virtual node following the create call.
+	//
+	// VirtualNode is a required field
+	VirtualNode *VirtualNodeData `locationName:"virtualNode" type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVirtualNodeOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVirtualNodeOutput) GoString() string {
+	return s.String()
+}
+
+// SetVirtualNode sets the VirtualNode field's value.
+func (s *CreateVirtualNodeOutput) SetVirtualNode(v *VirtualNodeData) *CreateVirtualNodeOutput {
+	s.VirtualNode = v
+	return s
+}
+
+type CreateVirtualRouterInput struct {
+	_ struct{} `type:"structure"`
+
+	// Unique, case-sensitive identifier that you provide to ensure the idempotency
+	// of the request. Up to 36 letters, numbers, hyphens, and underscores are allowed.
+	ClientToken *string `locationName:"clientToken" type:"string" idempotencyToken:"true"`
+
+	// The name of the service mesh to create the virtual router in.
+	//
+	// MeshName is a required field
+	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then the account that you specify must share
+	// the mesh with your account before you can create the resource in the service
+	// mesh. For more information about mesh sharing, see Working with shared meshes
+	// (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
 
+	// The virtual router specification to apply.
+	//
+	// Spec is a required field
+	Spec *VirtualRouterSpec `locationName:"spec" type:"structure" required:"true"`
+
+	// Optional metadata that you can apply to the virtual router to assist with
+	// categorization and organization. Each tag consists of a key and an optional
+	// value, both of which you define. Tag keys can have a maximum character length
+	// of 128 characters, and tag values can have a maximum length of 256 characters.
+	Tags []*TagRef `locationName:"tags" type:"list"`
+
+	// The name to use for the virtual router.
+	//
 	// VirtualRouterName is a required field
-	VirtualRouterName *string `location:"uri" locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
+	VirtualRouterName *string `locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteRouteInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVirtualRouterInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteRouteInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVirtualRouterInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteRouteInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteRouteInput"}
+func (s *CreateVirtualRouterInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateVirtualRouterInput"}
 	if s.MeshName == nil {
 		invalidParams.Add(request.NewErrParamRequired("MeshName"))
 	}
 	if s.MeshName != nil && len(*s.MeshName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
 	}
-	if s.RouteName == nil {
-		invalidParams.Add(request.NewErrParamRequired("RouteName"))
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
 	}
-	if s.RouteName != nil && len(*s.RouteName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("RouteName", 1))
+	if s.Spec == nil {
+		invalidParams.Add(request.NewErrParamRequired("Spec"))
 	}
 	if s.VirtualRouterName == nil {
 		invalidParams.Add(request.NewErrParamRequired("VirtualRouterName"))
@@ -4169,6 +5958,21 @@ func (s *DeleteRouteInput) Validate() error {
 	if s.VirtualRouterName != nil && len(*s.VirtualRouterName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("VirtualRouterName", 1))
 	}
+	if s.Spec != nil {
+		if err := s.Spec.Validate(); err != nil {
+			invalidParams.AddNested("Spec", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -4176,83 +5980,161 @@ func (s *DeleteRouteInput) Validate() error {
 	return nil
 }
 
+// SetClientToken sets the ClientToken field's value.
+func (s *CreateVirtualRouterInput) SetClientToken(v string) *CreateVirtualRouterInput {
+	s.ClientToken = &v
+	return s
+}
+
 // SetMeshName sets the MeshName field's value.
-func (s *DeleteRouteInput) SetMeshName(v string) *DeleteRouteInput {
+func (s *CreateVirtualRouterInput) SetMeshName(v string) *CreateVirtualRouterInput {
 	s.MeshName = &v
 	return s
 }
 
-// SetRouteName sets the RouteName field's value.
-func (s *DeleteRouteInput) SetRouteName(v string) *DeleteRouteInput {
-	s.RouteName = &v
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *CreateVirtualRouterInput) SetMeshOwner(v string) *CreateVirtualRouterInput {
+	s.MeshOwner = &v
+	return s
+}
+
+// SetSpec sets the Spec field's value.
+func (s *CreateVirtualRouterInput) SetSpec(v *VirtualRouterSpec) *CreateVirtualRouterInput {
+	s.Spec = v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *CreateVirtualRouterInput) SetTags(v []*TagRef) *CreateVirtualRouterInput {
+	s.Tags = v
 	return s
 }
 
 // SetVirtualRouterName sets the VirtualRouterName field's value.
-func (s *DeleteRouteInput) SetVirtualRouterName(v string) *DeleteRouteInput {
+func (s *CreateVirtualRouterInput) SetVirtualRouterName(v string) *CreateVirtualRouterInput {
 	s.VirtualRouterName = &v
 	return s
 }
 
-type DeleteRouteOutput struct {
-	_ struct{} `type:"structure" payload:"Route"`
+type CreateVirtualRouterOutput struct {
+	_ struct{} `type:"structure" payload:"VirtualRouter"`
 
-	// An object representing a route returned by a describe operation.
+	// The full description of your virtual router following the create call.
 	//
-	// Route is a required field
-	Route *RouteData `locationName:"route" type:"structure" required:"true"`
+	// VirtualRouter is a required field
+	VirtualRouter *VirtualRouterData `locationName:"virtualRouter" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteRouteOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVirtualRouterOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteRouteOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVirtualRouterOutput) GoString() string {
 	return s.String()
 }
 
-// SetRoute sets the Route field's value.
-func (s *DeleteRouteOutput) SetRoute(v *RouteData) *DeleteRouteOutput {
-	s.Route = v
+// SetVirtualRouter sets the VirtualRouter field's value.
+func (s *CreateVirtualRouterOutput) SetVirtualRouter(v *VirtualRouterData) *CreateVirtualRouterOutput {
+	s.VirtualRouter = v
 	return s
 }
 
-type DeleteVirtualNodeInput struct {
+type CreateVirtualServiceInput struct {
 	_ struct{} `type:"structure"`
 
+	// Unique, case-sensitive identifier that you provide to ensure the idempotency
+	// of the request. Up to 36 letters, numbers, hyphens, and underscores are allowed.
+	ClientToken *string `locationName:"clientToken" type:"string" idempotencyToken:"true"`
+
+	// The name of the service mesh to create the virtual service in.
+	//
 	// MeshName is a required field
 	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
 
-	// VirtualNodeName is a required field
-	VirtualNodeName *string `location:"uri" locationName:"virtualNodeName" min:"1" type:"string" required:"true"`
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then the account that you specify must share
+	// the mesh with your account before you can create the resource in the service
+	// mesh. For more information about mesh sharing, see Working with shared meshes
+	// (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
+
+	// The virtual service specification to apply.
+	//
+	// Spec is a required field
+	Spec *VirtualServiceSpec `locationName:"spec" type:"structure" required:"true"`
+
+	// Optional metadata that you can apply to the virtual service to assist with
+	// categorization and organization. Each tag consists of a key and an optional
+	// value, both of which you define. Tag keys can have a maximum character length
+	// of 128 characters, and tag values can have a maximum length of 256 characters.
+	Tags []*TagRef `locationName:"tags" type:"list"`
+
+	// The name to use for the virtual service.
+	//
+	// VirtualServiceName is a required field
+	VirtualServiceName *string `locationName:"virtualServiceName" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteVirtualNodeInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVirtualServiceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteVirtualNodeInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVirtualServiceInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteVirtualNodeInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteVirtualNodeInput"}
+func (s *CreateVirtualServiceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateVirtualServiceInput"}
 	if s.MeshName == nil {
 		invalidParams.Add(request.NewErrParamRequired("MeshName"))
 	}
 	if s.MeshName != nil && len(*s.MeshName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
 	}
-	if s.VirtualNodeName == nil {
-		invalidParams.Add(request.NewErrParamRequired("VirtualNodeName"))
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
 	}
-	if s.VirtualNodeName != nil && len(*s.VirtualNodeName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("VirtualNodeName", 1))
+	if s.Spec == nil {
+		invalidParams.Add(request.NewErrParamRequired("Spec"))
+	}
+	if s.VirtualServiceName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualServiceName"))
+	}
+	if s.Spec != nil {
+		if err := s.Spec.Validate(); err != nil {
+			invalidParams.AddNested("Spec", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
 	}
 
 	if invalidParams.Len() > 0 {
@@ -4261,77 +6143,141 @@ func (s *DeleteVirtualNodeInput) Validate() error {
 	return nil
 }
 
+// SetClientToken sets the ClientToken field's value.
+func (s *CreateVirtualServiceInput) SetClientToken(v string) *CreateVirtualServiceInput {
+	s.ClientToken = &v
+	return s
+}
+
 // SetMeshName sets the MeshName field's value.
-func (s *DeleteVirtualNodeInput) SetMeshName(v string) *DeleteVirtualNodeInput {
+func (s *CreateVirtualServiceInput) SetMeshName(v string) *CreateVirtualServiceInput {
 	s.MeshName = &v
 	return s
 }
 
-// SetVirtualNodeName sets the VirtualNodeName field's value.
-func (s *DeleteVirtualNodeInput) SetVirtualNodeName(v string) *DeleteVirtualNodeInput {
-	s.VirtualNodeName = &v
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *CreateVirtualServiceInput) SetMeshOwner(v string) *CreateVirtualServiceInput {
+	s.MeshOwner = &v
 	return s
 }
 
-type DeleteVirtualNodeOutput struct {
-	_ struct{} `type:"structure" payload:"VirtualNode"`
+// SetSpec sets the Spec field's value.
+func (s *CreateVirtualServiceInput) SetSpec(v *VirtualServiceSpec) *CreateVirtualServiceInput {
+	s.Spec = v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *CreateVirtualServiceInput) SetTags(v []*TagRef) *CreateVirtualServiceInput {
+	s.Tags = v
+	return s
+}
 
-	// An object representing a virtual node returned by a describe operation.
+// SetVirtualServiceName sets the VirtualServiceName field's value.
+func (s *CreateVirtualServiceInput) SetVirtualServiceName(v string) *CreateVirtualServiceInput {
+	s.VirtualServiceName = &v
+	return s
+}
+
+type CreateVirtualServiceOutput struct {
+	_ struct{} `type:"structure" payload:"VirtualService"`
+
+	// The full description of your virtual service following the create call.
 	//
-	// VirtualNode is a required field
-	VirtualNode *VirtualNodeData `locationName:"virtualNode" type:"structure" required:"true"`
+	// VirtualService is a required field
+	VirtualService *VirtualServiceData `locationName:"virtualService" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteVirtualNodeOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVirtualServiceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteVirtualNodeOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVirtualServiceOutput) GoString() string {
 	return s.String()
 }
 
-// SetVirtualNode sets the VirtualNode field's value.
-func (s *DeleteVirtualNodeOutput) SetVirtualNode(v *VirtualNodeData) *DeleteVirtualNodeOutput {
-	s.VirtualNode = v
+// SetVirtualService sets the VirtualService field's value.
+func (s *CreateVirtualServiceOutput) SetVirtualService(v *VirtualServiceData) *CreateVirtualServiceOutput {
+	s.VirtualService = v
 	return s
 }
 
-type DeleteVirtualRouterInput struct {
-	_ struct{} `type:"structure"`
+type DeleteGatewayRouteInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
+	// The name of the gateway route to delete.
+	//
+	// GatewayRouteName is a required field
+	GatewayRouteName *string `location:"uri" locationName:"gatewayRouteName" min:"1" type:"string" required:"true"`
 
+	// The name of the service mesh to delete the gateway route from.
+	//
 	// MeshName is a required field
 	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
 
-	// VirtualRouterName is a required field
-	VirtualRouterName *string `location:"uri" locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
+
+	// The name of the virtual gateway to delete the route from.
+	//
+	// VirtualGatewayName is a required field
+	VirtualGatewayName *string `location:"uri" locationName:"virtualGatewayName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteVirtualRouterInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteGatewayRouteInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteVirtualRouterInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteGatewayRouteInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteVirtualRouterInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteVirtualRouterInput"}
+func (s *DeleteGatewayRouteInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteGatewayRouteInput"}
+	if s.GatewayRouteName == nil {
+		invalidParams.Add(request.NewErrParamRequired("GatewayRouteName"))
+	}
+	if s.GatewayRouteName != nil && len(*s.GatewayRouteName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("GatewayRouteName", 1))
+	}
 	if s.MeshName == nil {
 		invalidParams.Add(request.NewErrParamRequired("MeshName"))
 	}
 	if s.MeshName != nil && len(*s.MeshName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
 	}
-	if s.VirtualRouterName == nil {
-		invalidParams.Add(request.NewErrParamRequired("VirtualRouterName"))
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
 	}
-	if s.VirtualRouterName != nil && len(*s.VirtualRouterName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("VirtualRouterName", 1))
+	if s.VirtualGatewayName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualGatewayName"))
+	}
+	if s.VirtualGatewayName != nil && len(*s.VirtualGatewayName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VirtualGatewayName", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -4340,78 +6286,99 @@ func (s *DeleteVirtualRouterInput) Validate() error {
 	return nil
 }
 
+// SetGatewayRouteName sets the GatewayRouteName field's value.
+func (s *DeleteGatewayRouteInput) SetGatewayRouteName(v string) *DeleteGatewayRouteInput {
+	s.GatewayRouteName = &v
+	return s
+}
+
 // SetMeshName sets the MeshName field's value.
-func (s *DeleteVirtualRouterInput) SetMeshName(v string) *DeleteVirtualRouterInput {
+func (s *DeleteGatewayRouteInput) SetMeshName(v string) *DeleteGatewayRouteInput {
 	s.MeshName = &v
 	return s
 }
 
-// SetVirtualRouterName sets the VirtualRouterName field's value.
-func (s *DeleteVirtualRouterInput) SetVirtualRouterName(v string) *DeleteVirtualRouterInput {
-	s.VirtualRouterName = &v
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *DeleteGatewayRouteInput) SetMeshOwner(v string) *DeleteGatewayRouteInput {
+	s.MeshOwner = &v
 	return s
 }
 
-type DeleteVirtualRouterOutput struct {
-	_ struct{} `type:"structure" payload:"VirtualRouter"`
+// SetVirtualGatewayName sets the VirtualGatewayName field's value.
+func (s *DeleteGatewayRouteInput) SetVirtualGatewayName(v string) *DeleteGatewayRouteInput {
+	s.VirtualGatewayName = &v
+	return s
+}
 
-	// An object representing a virtual router returned by a describe operation.
+type DeleteGatewayRouteOutput struct {
+	_ struct{} `type:"structure" payload:"GatewayRoute"`
+
+	// The gateway route that was deleted.
 	//
-	// VirtualRouter is a required field
-	VirtualRouter *VirtualRouterData `locationName:"virtualRouter" type:"structure" required:"true"`
+	// GatewayRoute is a required field
+	GatewayRoute *GatewayRouteData `locationName:"gatewayRoute" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteVirtualRouterOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteGatewayRouteOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteVirtualRouterOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteGatewayRouteOutput) GoString() string {
 	return s.String()
 }
 
-// SetVirtualRouter sets the VirtualRouter field's value.
-func (s *DeleteVirtualRouterOutput) SetVirtualRouter(v *VirtualRouterData) *DeleteVirtualRouterOutput {
-	s.VirtualRouter = v
+// SetGatewayRoute sets the GatewayRoute field's value.
+func (s *DeleteGatewayRouteOutput) SetGatewayRoute(v *GatewayRouteData) *DeleteGatewayRouteOutput {
+	s.GatewayRoute = v
 	return s
 }
 
-type DeleteVirtualServiceInput struct {
-	_ struct{} `type:"structure"`
+type DeleteMeshInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
 
+	// The name of the service mesh to delete.
+	//
 	// MeshName is a required field
 	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
-
-	// VirtualServiceName is a required field
-	VirtualServiceName *string `location:"uri" locationName:"virtualServiceName" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteVirtualServiceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteMeshInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteVirtualServiceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteMeshInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteVirtualServiceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteVirtualServiceInput"}
+func (s *DeleteMeshInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteMeshInput"}
 	if s.MeshName == nil {
 		invalidParams.Add(request.NewErrParamRequired("MeshName"))
 	}
 	if s.MeshName != nil && len(*s.MeshName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
 	}
-	if s.VirtualServiceName == nil {
-		invalidParams.Add(request.NewErrParamRequired("VirtualServiceName"))
-	}
-	if s.VirtualServiceName != nil && len(*s.VirtualServiceName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("VirtualServiceName", 1))
-	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -4420,68 +6387,111 @@ func (s *DeleteVirtualServiceInput) Validate() error {
 }
 
 // SetMeshName sets the MeshName field's value.
-func (s *DeleteVirtualServiceInput) SetMeshName(v string) *DeleteVirtualServiceInput {
+func (s *DeleteMeshInput) SetMeshName(v string) *DeleteMeshInput {
 	s.MeshName = &v
 	return s
 }
 
-// SetVirtualServiceName sets the VirtualServiceName field's value.
-func (s *DeleteVirtualServiceInput) SetVirtualServiceName(v string) *DeleteVirtualServiceInput {
-	s.VirtualServiceName = &v
-	return s
-}
-
-type DeleteVirtualServiceOutput struct {
-	_ struct{} `type:"structure" payload:"VirtualService"`
+type DeleteMeshOutput struct {
+	_ struct{} `type:"structure" payload:"Mesh"`
 
-	// An object representing a virtual service returned by a describe operation.
+	// The service mesh that was deleted.
 	//
-	// VirtualService is a required field
-	VirtualService *VirtualServiceData `locationName:"virtualService" type:"structure" required:"true"`
+	// Mesh is a required field
+	Mesh *MeshData `locationName:"mesh" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteVirtualServiceOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteMeshOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteVirtualServiceOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteMeshOutput) GoString() string {
 	return s.String()
 }
 
-// SetVirtualService sets the VirtualService field's value.
-func (s *DeleteVirtualServiceOutput) SetVirtualService(v *VirtualServiceData) *DeleteVirtualServiceOutput {
-	s.VirtualService = v
+// SetMesh sets the Mesh field's value.
+func (s *DeleteMeshOutput) SetMesh(v *MeshData) *DeleteMeshOutput {
+	s.Mesh = v
 	return s
 }
 
-type DescribeMeshInput struct {
-	_ struct{} `type:"structure"`
+type DeleteRouteInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
 
+	// The name of the service mesh to delete the route in.
+	//
 	// MeshName is a required field
 	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
+
+	// The name of the route to delete.
+	//
+	// RouteName is a required field
+	RouteName *string `location:"uri" locationName:"routeName" min:"1" type:"string" required:"true"`
+
+	// The name of the virtual router to delete the route in.
+	//
+	// VirtualRouterName is a required field
+	VirtualRouterName *string `location:"uri" locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DescribeMeshInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteRouteInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeMeshInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteRouteInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DescribeMeshInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DescribeMeshInput"}
+func (s *DeleteRouteInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteRouteInput"}
 	if s.MeshName == nil {
 		invalidParams.Add(request.NewErrParamRequired("MeshName"))
 	}
 	if s.MeshName != nil && len(*s.MeshName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
 	}
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
+	}
+	if s.RouteName == nil {
+		invalidParams.Add(request.NewErrParamRequired("RouteName"))
+	}
+	if s.RouteName != nil && len(*s.RouteName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RouteName", 1))
+	}
+	if s.VirtualRouterName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualRouterName"))
+	}
+	if s.VirtualRouterName != nil && len(*s.VirtualRouterName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VirtualRouterName", 1))
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -4490,79 +6500,117 @@ func (s *DescribeMeshInput) Validate() error {
 }
 
 // SetMeshName sets the MeshName field's value.
-func (s *DescribeMeshInput) SetMeshName(v string) *DescribeMeshInput {
+func (s *DeleteRouteInput) SetMeshName(v string) *DeleteRouteInput {
 	s.MeshName = &v
 	return s
 }
 
-type DescribeMeshOutput struct {
-	_ struct{} `type:"structure" payload:"Mesh"`
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *DeleteRouteInput) SetMeshOwner(v string) *DeleteRouteInput {
+	s.MeshOwner = &v
+	return s
+}
+
+// SetRouteName sets the RouteName field's value.
+func (s *DeleteRouteInput) SetRouteName(v string) *DeleteRouteInput {
+	s.RouteName = &v
+	return s
+}
+
+// SetVirtualRouterName sets the VirtualRouterName field's value.
+func (s *DeleteRouteInput) SetVirtualRouterName(v string) *DeleteRouteInput {
+	s.VirtualRouterName = &v
+	return s
+}
+
+type DeleteRouteOutput struct {
+	_ struct{} `type:"structure" payload:"Route"`
 
-	// An object representing a service mesh returned by a describe operation.
+	// The route that was deleted.
 	//
-	// Mesh is a required field
-	Mesh *MeshData `locationName:"mesh" type:"structure" required:"true"`
+	// Route is a required field
+	Route *RouteData `locationName:"route" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s DescribeMeshOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteRouteOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeMeshOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteRouteOutput) GoString() string {
 	return s.String()
 }
 
-// SetMesh sets the Mesh field's value.
-func (s *DescribeMeshOutput) SetMesh(v *MeshData) *DescribeMeshOutput {
-	s.Mesh = v
+// SetRoute sets the Route field's value.
+func (s *DeleteRouteOutput) SetRoute(v *RouteData) *DeleteRouteOutput {
+	s.Route = v
 	return s
 }
 
-type DescribeRouteInput struct {
-	_ struct{} `type:"structure"`
+type DeleteVirtualGatewayInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
 
+	// The name of the service mesh to delete the virtual gateway from.
+	//
 	// MeshName is a required field
 	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
 
-	// RouteName is a required field
-	RouteName *string `location:"uri" locationName:"routeName" min:"1" type:"string" required:"true"`
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
 
-	// VirtualRouterName is a required field
-	VirtualRouterName *string `location:"uri" locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
+	// The name of the virtual gateway to delete.
+	//
+	// VirtualGatewayName is a required field
+	VirtualGatewayName *string `location:"uri" locationName:"virtualGatewayName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DescribeRouteInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVirtualGatewayInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeRouteInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVirtualGatewayInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DescribeRouteInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DescribeRouteInput"}
+func (s *DeleteVirtualGatewayInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteVirtualGatewayInput"}
 	if s.MeshName == nil {
 		invalidParams.Add(request.NewErrParamRequired("MeshName"))
 	}
 	if s.MeshName != nil && len(*s.MeshName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
 	}
-	if s.RouteName == nil {
-		invalidParams.Add(request.NewErrParamRequired("RouteName"))
-	}
-	if s.RouteName != nil && len(*s.RouteName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("RouteName", 1))
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
 	}
-	if s.VirtualRouterName == nil {
-		invalidParams.Add(request.NewErrParamRequired("VirtualRouterName"))
+	if s.VirtualGatewayName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualGatewayName"))
 	}
-	if s.VirtualRouterName != nil && len(*s.VirtualRouterName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("VirtualRouterName", 1))
+	if s.VirtualGatewayName != nil && len(*s.VirtualGatewayName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VirtualGatewayName", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -4572,77 +6620,107 @@ func (s *DescribeRouteInput) Validate() error {
 }
 
 // SetMeshName sets the MeshName field's value.
-func (s *DescribeRouteInput) SetMeshName(v string) *DescribeRouteInput {
+func (s *DeleteVirtualGatewayInput) SetMeshName(v string) *DeleteVirtualGatewayInput {
 	s.MeshName = &v
 	return s
 }
 
-// SetRouteName sets the RouteName field's value.
-func (s *DescribeRouteInput) SetRouteName(v string) *DescribeRouteInput {
-	s.RouteName = &v
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *DeleteVirtualGatewayInput) SetMeshOwner(v string) *DeleteVirtualGatewayInput {
+	s.MeshOwner = &v
 	return s
 }
 
-// SetVirtualRouterName sets the VirtualRouterName field's value.
-func (s *DescribeRouteInput) SetVirtualRouterName(v string) *DescribeRouteInput {
-	s.VirtualRouterName = &v
+// SetVirtualGatewayName sets the VirtualGatewayName field's value.
+func (s *DeleteVirtualGatewayInput) SetVirtualGatewayName(v string) *DeleteVirtualGatewayInput {
+	s.VirtualGatewayName = &v
 	return s
 }
 
-type DescribeRouteOutput struct {
-	_ struct{} `type:"structure" payload:"Route"`
+type DeleteVirtualGatewayOutput struct {
+	_ struct{} `type:"structure" payload:"VirtualGateway"`
 
-	// An object representing a route returned by a describe operation.
+	// The virtual gateway that was deleted.
 	//
-	// Route is a required field
-	Route *RouteData `locationName:"route" type:"structure" required:"true"`
+	// VirtualGateway is a required field
+	VirtualGateway *VirtualGatewayData `locationName:"virtualGateway" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s DescribeRouteOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVirtualGatewayOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeRouteOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVirtualGatewayOutput) GoString() string {
 	return s.String()
 }
 
-// SetRoute sets the Route field's value.
-func (s *DescribeRouteOutput) SetRoute(v *RouteData) *DescribeRouteOutput {
-	s.Route = v
+// SetVirtualGateway sets the VirtualGateway field's value.
+func (s *DeleteVirtualGatewayOutput) SetVirtualGateway(v *VirtualGatewayData) *DeleteVirtualGatewayOutput {
+	s.VirtualGateway = v
 	return s
 }
 
-type DescribeVirtualNodeInput struct {
-	_ struct{} `type:"structure"`
+// Deletes a virtual node input.
+type DeleteVirtualNodeInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
 
+	// The name of the service mesh to delete the virtual node in.
+	//
 	// MeshName is a required field
 	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
 
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
+
+	// The name of the virtual node to delete.
+	//
 	// VirtualNodeName is a required field
 	VirtualNodeName *string `location:"uri" locationName:"virtualNodeName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DescribeVirtualNodeInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVirtualNodeInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeVirtualNodeInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVirtualNodeInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DescribeVirtualNodeInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DescribeVirtualNodeInput"}
+func (s *DeleteVirtualNodeInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteVirtualNodeInput"}
 	if s.MeshName == nil {
 		invalidParams.Add(request.NewErrParamRequired("MeshName"))
 	}
 	if s.MeshName != nil && len(*s.MeshName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
 	}
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
+	}
 	if s.VirtualNodeName == nil {
 		invalidParams.Add(request.NewErrParamRequired("VirtualNodeName"))
 	}
@@ -4657,71 +6735,106 @@ func (s *DescribeVirtualNodeInput) Validate() error {
 }
 
 // SetMeshName sets the MeshName field's value.
-func (s *DescribeVirtualNodeInput) SetMeshName(v string) *DescribeVirtualNodeInput {
+func (s *DeleteVirtualNodeInput) SetMeshName(v string) *DeleteVirtualNodeInput {
 	s.MeshName = &v
 	return s
 }
 
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *DeleteVirtualNodeInput) SetMeshOwner(v string) *DeleteVirtualNodeInput {
+	s.MeshOwner = &v
+	return s
+}
+
 // SetVirtualNodeName sets the VirtualNodeName field's value.
-func (s *DescribeVirtualNodeInput) SetVirtualNodeName(v string) *DescribeVirtualNodeInput {
+func (s *DeleteVirtualNodeInput) SetVirtualNodeName(v string) *DeleteVirtualNodeInput {
 	s.VirtualNodeName = &v
 	return s
 }
 
-type DescribeVirtualNodeOutput struct {
+type DeleteVirtualNodeOutput struct {
 	_ struct{} `type:"structure" payload:"VirtualNode"`
 
-	// An object representing a virtual node returned by a describe operation.
+	// The virtual node that was deleted.
 	//
 	// VirtualNode is a required field
 	VirtualNode *VirtualNodeData `locationName:"virtualNode" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s DescribeVirtualNodeOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVirtualNodeOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeVirtualNodeOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVirtualNodeOutput) GoString() string {
 	return s.String()
 }
 
 // SetVirtualNode sets the VirtualNode field's value.
-func (s *DescribeVirtualNodeOutput) SetVirtualNode(v *VirtualNodeData) *DescribeVirtualNodeOutput {
+func (s *DeleteVirtualNodeOutput) SetVirtualNode(v *VirtualNodeData) *DeleteVirtualNodeOutput {
 	s.VirtualNode = v
 	return s
 }
 
-type DescribeVirtualRouterInput struct {
-	_ struct{} `type:"structure"`
+type DeleteVirtualRouterInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
 
+	// The name of the service mesh to delete the virtual router in.
+	//
 	// MeshName is a required field
 	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
 
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
+
+	// The name of the virtual router to delete.
+	//
 	// VirtualRouterName is a required field
 	VirtualRouterName *string `location:"uri" locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DescribeVirtualRouterInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVirtualRouterInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeVirtualRouterInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVirtualRouterInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DescribeVirtualRouterInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DescribeVirtualRouterInput"}
+func (s *DeleteVirtualRouterInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteVirtualRouterInput"}
 	if s.MeshName == nil {
 		invalidParams.Add(request.NewErrParamRequired("MeshName"))
 	}
 	if s.MeshName != nil && len(*s.MeshName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
 	}
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
+	}
 	if s.VirtualRouterName == nil {
 		invalidParams.Add(request.NewErrParamRequired("VirtualRouterName"))
 	}
@@ -4736,71 +6849,106 @@ func (s *DescribeVirtualRouterInput) Validate() error {
 }
 
 // SetMeshName sets the MeshName field's value.
-func (s *DescribeVirtualRouterInput) SetMeshName(v string) *DescribeVirtualRouterInput {
+func (s *DeleteVirtualRouterInput) SetMeshName(v string) *DeleteVirtualRouterInput {
 	s.MeshName = &v
 	return s
 }
 
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *DeleteVirtualRouterInput) SetMeshOwner(v string) *DeleteVirtualRouterInput {
+	s.MeshOwner = &v
+	return s
+}
+
 // SetVirtualRouterName sets the VirtualRouterName field's value.
-func (s *DescribeVirtualRouterInput) SetVirtualRouterName(v string) *DescribeVirtualRouterInput {
+func (s *DeleteVirtualRouterInput) SetVirtualRouterName(v string) *DeleteVirtualRouterInput {
 	s.VirtualRouterName = &v
 	return s
 }
 
-type DescribeVirtualRouterOutput struct {
+type DeleteVirtualRouterOutput struct {
 	_ struct{} `type:"structure" payload:"VirtualRouter"`
 
-	// An object representing a virtual router returned by a describe operation.
+	// The virtual router that was deleted.
 	//
 	// VirtualRouter is a required field
 	VirtualRouter *VirtualRouterData `locationName:"virtualRouter" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s DescribeVirtualRouterOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVirtualRouterOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeVirtualRouterOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVirtualRouterOutput) GoString() string {
 	return s.String()
 }
 
 // SetVirtualRouter sets the VirtualRouter field's value.
-func (s *DescribeVirtualRouterOutput) SetVirtualRouter(v *VirtualRouterData) *DescribeVirtualRouterOutput {
+func (s *DeleteVirtualRouterOutput) SetVirtualRouter(v *VirtualRouterData) *DeleteVirtualRouterOutput {
 	s.VirtualRouter = v
 	return s
 }
 
-type DescribeVirtualServiceInput struct {
-	_ struct{} `type:"structure"`
+type DeleteVirtualServiceInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
 
+	// The name of the service mesh to delete the virtual service in.
+	//
 	// MeshName is a required field
 	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
 
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
+
+	// The name of the virtual service to delete.
+	//
 	// VirtualServiceName is a required field
 	VirtualServiceName *string `location:"uri" locationName:"virtualServiceName" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DescribeVirtualServiceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVirtualServiceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeVirtualServiceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVirtualServiceInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DescribeVirtualServiceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DescribeVirtualServiceInput"}
+func (s *DeleteVirtualServiceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteVirtualServiceInput"}
 	if s.MeshName == nil {
 		invalidParams.Add(request.NewErrParamRequired("MeshName"))
 	}
 	if s.MeshName != nil && len(*s.MeshName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
 	}
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
+	}
 	if s.VirtualServiceName == nil {
 		invalidParams.Add(request.NewErrParamRequired("VirtualServiceName"))
 	}
@@ -4815,66 +6963,122 @@ func (s *DescribeVirtualServiceInput) Validate() error {
 }
 
 // SetMeshName sets the MeshName field's value.
-func (s *DescribeVirtualServiceInput) SetMeshName(v string) *DescribeVirtualServiceInput {
+func (s *DeleteVirtualServiceInput) SetMeshName(v string) *DeleteVirtualServiceInput {
 	s.MeshName = &v
 	return s
 }
 
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *DeleteVirtualServiceInput) SetMeshOwner(v string) *DeleteVirtualServiceInput {
+	s.MeshOwner = &v
+	return s
+}
+
 // SetVirtualServiceName sets the VirtualServiceName field's value.
-func (s *DescribeVirtualServiceInput) SetVirtualServiceName(v string) *DescribeVirtualServiceInput {
+func (s *DeleteVirtualServiceInput) SetVirtualServiceName(v string) *DeleteVirtualServiceInput {
 	s.VirtualServiceName = &v
 	return s
 }
 
-type DescribeVirtualServiceOutput struct {
+type DeleteVirtualServiceOutput struct {
 	_ struct{} `type:"structure" payload:"VirtualService"`
 
-	// An object representing a virtual service returned by a describe operation.
+	// The virtual service that was deleted.
 	//
 	// VirtualService is a required field
 	VirtualService *VirtualServiceData `locationName:"virtualService" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s DescribeVirtualServiceOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVirtualServiceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeVirtualServiceOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVirtualServiceOutput) GoString() string {
 	return s.String()
 }
 
 // SetVirtualService sets the VirtualService field's value.
-func (s *DescribeVirtualServiceOutput) SetVirtualService(v *VirtualServiceData) *DescribeVirtualServiceOutput {
+func (s *DeleteVirtualServiceOutput) SetVirtualService(v *VirtualServiceData) *DeleteVirtualServiceOutput {
 	s.VirtualService = v
 	return s
 }
 
-// An object representing the DNS service discovery information for your virtual
-// node.
-type DnsServiceDiscovery struct {
-	_ struct{} `type:"structure"`
+type DescribeGatewayRouteInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// Hostname is a required field
-	Hostname *string `locationName:"hostname" type:"string" required:"true"`
+	// The name of the gateway route to describe.
+	//
+	// GatewayRouteName is a required field
+	GatewayRouteName *string `location:"uri" locationName:"gatewayRouteName" min:"1" type:"string" required:"true"`
+
+	// The name of the service mesh that the gateway route resides in.
+	//
+	// MeshName is a required field
+	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
+
+	// The name of the virtual gateway that the gateway route is associated with.
+	//
+	// VirtualGatewayName is a required field
+	VirtualGatewayName *string `location:"uri" locationName:"virtualGatewayName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DnsServiceDiscovery) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeGatewayRouteInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DnsServiceDiscovery) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeGatewayRouteInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DnsServiceDiscovery) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DnsServiceDiscovery"}
-	if s.Hostname == nil {
-		invalidParams.Add(request.NewErrParamRequired("Hostname"))
+func (s *DescribeGatewayRouteInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeGatewayRouteInput"}
+	if s.GatewayRouteName == nil {
+		invalidParams.Add(request.NewErrParamRequired("GatewayRouteName"))
+	}
+	if s.GatewayRouteName != nil && len(*s.GatewayRouteName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("GatewayRouteName", 1))
+	}
+	if s.MeshName == nil {
+		invalidParams.Add(request.NewErrParamRequired("MeshName"))
+	}
+	if s.MeshName != nil && len(*s.MeshName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
+	}
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
+	}
+	if s.VirtualGatewayName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualGatewayName"))
+	}
+	if s.VirtualGatewayName != nil && len(*s.VirtualGatewayName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VirtualGatewayName", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -4883,66 +7087,107 @@ func (s *DnsServiceDiscovery) Validate() error {
 	return nil
 }
 
-// SetHostname sets the Hostname field's value.
-func (s *DnsServiceDiscovery) SetHostname(v string) *DnsServiceDiscovery {
-	s.Hostname = &v
+// SetGatewayRouteName sets the GatewayRouteName field's value.
+func (s *DescribeGatewayRouteInput) SetGatewayRouteName(v string) *DescribeGatewayRouteInput {
+	s.GatewayRouteName = &v
 	return s
 }
 
-// An object representing the duration between retry attempts.
-type Duration struct {
-	_ struct{} `type:"structure"`
+// SetMeshName sets the MeshName field's value.
+func (s *DescribeGatewayRouteInput) SetMeshName(v string) *DescribeGatewayRouteInput {
+	s.MeshName = &v
+	return s
+}
 
-	Unit *string `locationName:"unit" type:"string" enum:"DurationUnit"`
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *DescribeGatewayRouteInput) SetMeshOwner(v string) *DescribeGatewayRouteInput {
+	s.MeshOwner = &v
+	return s
+}
 
-	Value *int64 `locationName:"value" type:"long"`
+// SetVirtualGatewayName sets the VirtualGatewayName field's value.
+func (s *DescribeGatewayRouteInput) SetVirtualGatewayName(v string) *DescribeGatewayRouteInput {
+	s.VirtualGatewayName = &v
+	return s
 }
 
-// String returns the string representation
-func (s Duration) String() string {
+type DescribeGatewayRouteOutput struct {
+	_ struct{} `type:"structure" payload:"GatewayRoute"`
+
+	// The full description of your gateway route.
+	//
+	// GatewayRoute is a required field
+	GatewayRoute *GatewayRouteData `locationName:"gatewayRoute" type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeGatewayRouteOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Duration) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeGatewayRouteOutput) GoString() string {
 	return s.String()
 }
 
-// SetUnit sets the Unit field's value.
-func (s *Duration) SetUnit(v string) *Duration {
-	s.Unit = &v
+// SetGatewayRoute sets the GatewayRoute field's value.
+func (s *DescribeGatewayRouteOutput) SetGatewayRoute(v *GatewayRouteData) *DescribeGatewayRouteOutput {
+	s.GatewayRoute = v
 	return s
 }
 
-// SetValue sets the Value field's value.
-func (s *Duration) SetValue(v int64) *Duration {
-	s.Value = &v
-	return s
-}
+type DescribeMeshInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
 
-// An object representing the egress filter rules for a service mesh.
-type EgressFilter struct {
-	_ struct{} `type:"structure"`
+	// The name of the service mesh to describe.
+	//
+	// MeshName is a required field
+	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
 
-	// Type is a required field
-	Type *string `locationName:"type" type:"string" required:"true" enum:"EgressFilterType"`
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
 }
 
-// String returns the string representation
-func (s EgressFilter) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeMeshInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s EgressFilter) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeMeshInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *EgressFilter) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "EgressFilter"}
-	if s.Type == nil {
-		invalidParams.Add(request.NewErrParamRequired("Type"))
+func (s *DescribeMeshInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeMeshInput"}
+	if s.MeshName == nil {
+		invalidParams.Add(request.NewErrParamRequired("MeshName"))
+	}
+	if s.MeshName != nil && len(*s.MeshName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
+	}
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -4951,100 +7196,117 @@ func (s *EgressFilter) Validate() error {
 	return nil
 }
 
-// SetType sets the Type field's value.
-func (s *EgressFilter) SetType(v string) *EgressFilter {
-	s.Type = &v
+// SetMeshName sets the MeshName field's value.
+func (s *DescribeMeshInput) SetMeshName(v string) *DescribeMeshInput {
+	s.MeshName = &v
 	return s
 }
 
-// An object representing an access log file.
-type FileAccessLog struct {
-	_ struct{} `type:"structure"`
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *DescribeMeshInput) SetMeshOwner(v string) *DescribeMeshInput {
+	s.MeshOwner = &v
+	return s
+}
 
-	// Path is a required field
-	Path *string `locationName:"path" min:"1" type:"string" required:"true"`
+type DescribeMeshOutput struct {
+	_ struct{} `type:"structure" payload:"Mesh"`
+
+	// The full description of your service mesh.
+	//
+	// Mesh is a required field
+	Mesh *MeshData `locationName:"mesh" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s FileAccessLog) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeMeshOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s FileAccessLog) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeMeshOutput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *FileAccessLog) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "FileAccessLog"}
-	if s.Path == nil {
-		invalidParams.Add(request.NewErrParamRequired("Path"))
-	}
-	if s.Path != nil && len(*s.Path) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Path", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
-// SetPath sets the Path field's value.
-func (s *FileAccessLog) SetPath(v string) *FileAccessLog {
-	s.Path = &v
+// SetMesh sets the Mesh field's value.
+func (s *DescribeMeshOutput) SetMesh(v *MeshData) *DescribeMeshOutput {
+	s.Mesh = v
 	return s
 }
 
-// An object representing the method and value to match the header value sent
-// with a request. Specify one match method.
-type HeaderMatchMethod struct {
-	_ struct{} `type:"structure"`
-
-	Exact *string `locationName:"exact" min:"1" type:"string"`
+type DescribeRouteInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	Prefix *string `locationName:"prefix" min:"1" type:"string"`
+	// The name of the service mesh that the route resides in.
+	//
+	// MeshName is a required field
+	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
 
-	// The range of values to match on. The first character of the range is included
-	// in the range, though the last character is not. For example, if the range
-	// specified were 1-100, only values 1-99 would be matched.
-	Range *MatchRange `locationName:"range" type:"structure"`
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
 
-	Regex *string `locationName:"regex" min:"1" type:"string"`
+	// The name of the route to describe.
+	//
+	// RouteName is a required field
+	RouteName *string `location:"uri" locationName:"routeName" min:"1" type:"string" required:"true"`
 
-	Suffix *string `locationName:"suffix" min:"1" type:"string"`
+	// The name of the virtual router that the route is associated with.
+	//
+	// VirtualRouterName is a required field
+	VirtualRouterName *string `location:"uri" locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s HeaderMatchMethod) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeRouteInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s HeaderMatchMethod) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeRouteInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *HeaderMatchMethod) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "HeaderMatchMethod"}
-	if s.Exact != nil && len(*s.Exact) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Exact", 1))
+func (s *DescribeRouteInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeRouteInput"}
+	if s.MeshName == nil {
+		invalidParams.Add(request.NewErrParamRequired("MeshName"))
 	}
-	if s.Prefix != nil && len(*s.Prefix) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Prefix", 1))
+	if s.MeshName != nil && len(*s.MeshName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
 	}
-	if s.Regex != nil && len(*s.Regex) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Regex", 1))
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
 	}
-	if s.Suffix != nil && len(*s.Suffix) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Suffix", 1))
+	if s.RouteName == nil {
+		invalidParams.Add(request.NewErrParamRequired("RouteName"))
 	}
-	if s.Range != nil {
-		if err := s.Range.Validate(); err != nil {
-			invalidParams.AddNested("Range", err.(request.ErrInvalidParams))
-		}
+	if s.RouteName != nil && len(*s.RouteName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RouteName", 1))
+	}
+	if s.VirtualRouterName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualRouterName"))
+	}
+	if s.VirtualRouterName != nil && len(*s.VirtualRouterName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VirtualRouterName", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5053,102 +7315,118 @@ func (s *HeaderMatchMethod) Validate() error {
 	return nil
 }
 
-// SetExact sets the Exact field's value.
-func (s *HeaderMatchMethod) SetExact(v string) *HeaderMatchMethod {
-	s.Exact = &v
+// SetMeshName sets the MeshName field's value.
+func (s *DescribeRouteInput) SetMeshName(v string) *DescribeRouteInput {
+	s.MeshName = &v
 	return s
 }
 
-// SetPrefix sets the Prefix field's value.
-func (s *HeaderMatchMethod) SetPrefix(v string) *HeaderMatchMethod {
-	s.Prefix = &v
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *DescribeRouteInput) SetMeshOwner(v string) *DescribeRouteInput {
+	s.MeshOwner = &v
 	return s
 }
 
-// SetRange sets the Range field's value.
-func (s *HeaderMatchMethod) SetRange(v *MatchRange) *HeaderMatchMethod {
-	s.Range = v
+// SetRouteName sets the RouteName field's value.
+func (s *DescribeRouteInput) SetRouteName(v string) *DescribeRouteInput {
+	s.RouteName = &v
 	return s
 }
 
-// SetRegex sets the Regex field's value.
-func (s *HeaderMatchMethod) SetRegex(v string) *HeaderMatchMethod {
-	s.Regex = &v
+// SetVirtualRouterName sets the VirtualRouterName field's value.
+func (s *DescribeRouteInput) SetVirtualRouterName(v string) *DescribeRouteInput {
+	s.VirtualRouterName = &v
 	return s
 }
 
-// SetSuffix sets the Suffix field's value.
-func (s *HeaderMatchMethod) SetSuffix(v string) *HeaderMatchMethod {
-	s.Suffix = &v
-	return s
-}
+type DescribeRouteOutput struct {
+	_ struct{} `type:"structure" payload:"Route"`
 
-// An object representing the health check policy for a virtual node's listener.
-type HealthCheckPolicy struct {
-	_ struct{} `type:"structure"`
+	// The full description of your route.
+	//
+	// Route is a required field
+	Route *RouteData `locationName:"route" type:"structure" required:"true"`
+}
 
-	// HealthyThreshold is a required field
-	HealthyThreshold *int64 `locationName:"healthyThreshold" min:"2" type:"integer" required:"true"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeRouteOutput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// IntervalMillis is a required field
-	IntervalMillis *int64 `locationName:"intervalMillis" min:"5000" type:"long" required:"true"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeRouteOutput) GoString() string {
+	return s.String()
+}
 
-	Path *string `locationName:"path" type:"string"`
+// SetRoute sets the Route field's value.
+func (s *DescribeRouteOutput) SetRoute(v *RouteData) *DescribeRouteOutput {
+	s.Route = v
+	return s
+}
 
-	Port *int64 `locationName:"port" min:"1" type:"integer"`
+type DescribeVirtualGatewayInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// Protocol is a required field
-	Protocol *string `locationName:"protocol" type:"string" required:"true" enum:"PortProtocol"`
+	// The name of the service mesh that the gateway route resides in.
+	//
+	// MeshName is a required field
+	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
 
-	// TimeoutMillis is a required field
-	TimeoutMillis *int64 `locationName:"timeoutMillis" min:"2000" type:"long" required:"true"`
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
 
-	// UnhealthyThreshold is a required field
-	UnhealthyThreshold *int64 `locationName:"unhealthyThreshold" min:"2" type:"integer" required:"true"`
+	// The name of the virtual gateway to describe.
+	//
+	// VirtualGatewayName is a required field
+	VirtualGatewayName *string `location:"uri" locationName:"virtualGatewayName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s HealthCheckPolicy) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualGatewayInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s HealthCheckPolicy) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualGatewayInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *HealthCheckPolicy) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "HealthCheckPolicy"}
-	if s.HealthyThreshold == nil {
-		invalidParams.Add(request.NewErrParamRequired("HealthyThreshold"))
-	}
-	if s.HealthyThreshold != nil && *s.HealthyThreshold < 2 {
-		invalidParams.Add(request.NewErrParamMinValue("HealthyThreshold", 2))
-	}
-	if s.IntervalMillis == nil {
-		invalidParams.Add(request.NewErrParamRequired("IntervalMillis"))
-	}
-	if s.IntervalMillis != nil && *s.IntervalMillis < 5000 {
-		invalidParams.Add(request.NewErrParamMinValue("IntervalMillis", 5000))
-	}
-	if s.Port != nil && *s.Port < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("Port", 1))
-	}
-	if s.Protocol == nil {
-		invalidParams.Add(request.NewErrParamRequired("Protocol"))
+func (s *DescribeVirtualGatewayInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeVirtualGatewayInput"}
+	if s.MeshName == nil {
+		invalidParams.Add(request.NewErrParamRequired("MeshName"))
 	}
-	if s.TimeoutMillis == nil {
-		invalidParams.Add(request.NewErrParamRequired("TimeoutMillis"))
+	if s.MeshName != nil && len(*s.MeshName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
 	}
-	if s.TimeoutMillis != nil && *s.TimeoutMillis < 2000 {
-		invalidParams.Add(request.NewErrParamMinValue("TimeoutMillis", 2000))
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
 	}
-	if s.UnhealthyThreshold == nil {
-		invalidParams.Add(request.NewErrParamRequired("UnhealthyThreshold"))
+	if s.VirtualGatewayName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualGatewayName"))
 	}
-	if s.UnhealthyThreshold != nil && *s.UnhealthyThreshold < 2 {
-		invalidParams.Add(request.NewErrParamMinValue("UnhealthyThreshold", 2))
+	if s.VirtualGatewayName != nil && len(*s.VirtualGatewayName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VirtualGatewayName", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5157,89 +7435,112 @@ func (s *HealthCheckPolicy) Validate() error {
 	return nil
 }
 
-// SetHealthyThreshold sets the HealthyThreshold field's value.
-func (s *HealthCheckPolicy) SetHealthyThreshold(v int64) *HealthCheckPolicy {
-	s.HealthyThreshold = &v
+// SetMeshName sets the MeshName field's value.
+func (s *DescribeVirtualGatewayInput) SetMeshName(v string) *DescribeVirtualGatewayInput {
+	s.MeshName = &v
 	return s
 }
 
-// SetIntervalMillis sets the IntervalMillis field's value.
-func (s *HealthCheckPolicy) SetIntervalMillis(v int64) *HealthCheckPolicy {
-	s.IntervalMillis = &v
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *DescribeVirtualGatewayInput) SetMeshOwner(v string) *DescribeVirtualGatewayInput {
+	s.MeshOwner = &v
 	return s
 }
 
-// SetPath sets the Path field's value.
-func (s *HealthCheckPolicy) SetPath(v string) *HealthCheckPolicy {
-	s.Path = &v
+// SetVirtualGatewayName sets the VirtualGatewayName field's value.
+func (s *DescribeVirtualGatewayInput) SetVirtualGatewayName(v string) *DescribeVirtualGatewayInput {
+	s.VirtualGatewayName = &v
 	return s
 }
 
-// SetPort sets the Port field's value.
-func (s *HealthCheckPolicy) SetPort(v int64) *HealthCheckPolicy {
-	s.Port = &v
-	return s
+type DescribeVirtualGatewayOutput struct {
+	_ struct{} `type:"structure" payload:"VirtualGateway"`
+
+	// The full description of your virtual gateway.
+	//
+	// VirtualGateway is a required field
+	VirtualGateway *VirtualGatewayData `locationName:"virtualGateway" type:"structure" required:"true"`
 }
 
-// SetProtocol sets the Protocol field's value.
-func (s *HealthCheckPolicy) SetProtocol(v string) *HealthCheckPolicy {
-	s.Protocol = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualGatewayOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetTimeoutMillis sets the TimeoutMillis field's value.
-func (s *HealthCheckPolicy) SetTimeoutMillis(v int64) *HealthCheckPolicy {
-	s.TimeoutMillis = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualGatewayOutput) GoString() string {
+	return s.String()
 }
 
-// SetUnhealthyThreshold sets the UnhealthyThreshold field's value.
-func (s *HealthCheckPolicy) SetUnhealthyThreshold(v int64) *HealthCheckPolicy {
-	s.UnhealthyThreshold = &v
+// SetVirtualGateway sets the VirtualGateway field's value.
+func (s *DescribeVirtualGatewayOutput) SetVirtualGateway(v *VirtualGatewayData) *DescribeVirtualGatewayOutput {
+	s.VirtualGateway = v
 	return s
 }
 
-// An object that represents a retry policy.
-type HttpRetryPolicy struct {
-	_ struct{} `type:"structure"`
+type DescribeVirtualNodeInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	HttpRetryEvents []*string `locationName:"httpRetryEvents" min:"1" type:"list"`
+	// The name of the service mesh that the virtual node resides in.
+	//
+	// MeshName is a required field
+	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
 
-	// MaxRetries is a required field
-	MaxRetries *int64 `locationName:"maxRetries" type:"long" required:"true"`
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
 
-	// An object representing the duration between retry attempts.
+	// The name of the virtual node to describe.
 	//
-	// PerRetryTimeout is a required field
-	PerRetryTimeout *Duration `locationName:"perRetryTimeout" type:"structure" required:"true"`
-
-	TcpRetryEvents []*string `locationName:"tcpRetryEvents" min:"1" type:"list"`
+	// VirtualNodeName is a required field
+	VirtualNodeName *string `location:"uri" locationName:"virtualNodeName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s HttpRetryPolicy) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualNodeInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s HttpRetryPolicy) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualNodeInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *HttpRetryPolicy) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "HttpRetryPolicy"}
-	if s.HttpRetryEvents != nil && len(s.HttpRetryEvents) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("HttpRetryEvents", 1))
+func (s *DescribeVirtualNodeInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeVirtualNodeInput"}
+	if s.MeshName == nil {
+		invalidParams.Add(request.NewErrParamRequired("MeshName"))
 	}
-	if s.MaxRetries == nil {
-		invalidParams.Add(request.NewErrParamRequired("MaxRetries"))
+	if s.MeshName != nil && len(*s.MeshName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
 	}
-	if s.PerRetryTimeout == nil {
-		invalidParams.Add(request.NewErrParamRequired("PerRetryTimeout"))
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
 	}
-	if s.TcpRetryEvents != nil && len(s.TcpRetryEvents) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("TcpRetryEvents", 1))
+	if s.VirtualNodeName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualNodeName"))
+	}
+	if s.VirtualNodeName != nil && len(*s.VirtualNodeName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VirtualNodeName", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5248,83 +7549,112 @@ func (s *HttpRetryPolicy) Validate() error {
 	return nil
 }
 
-// SetHttpRetryEvents sets the HttpRetryEvents field's value.
-func (s *HttpRetryPolicy) SetHttpRetryEvents(v []*string) *HttpRetryPolicy {
-	s.HttpRetryEvents = v
+// SetMeshName sets the MeshName field's value.
+func (s *DescribeVirtualNodeInput) SetMeshName(v string) *DescribeVirtualNodeInput {
+	s.MeshName = &v
 	return s
 }
 
-// SetMaxRetries sets the MaxRetries field's value.
-func (s *HttpRetryPolicy) SetMaxRetries(v int64) *HttpRetryPolicy {
-	s.MaxRetries = &v
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *DescribeVirtualNodeInput) SetMeshOwner(v string) *DescribeVirtualNodeInput {
+	s.MeshOwner = &v
 	return s
 }
 
-// SetPerRetryTimeout sets the PerRetryTimeout field's value.
-func (s *HttpRetryPolicy) SetPerRetryTimeout(v *Duration) *HttpRetryPolicy {
-	s.PerRetryTimeout = v
+// SetVirtualNodeName sets the VirtualNodeName field's value.
+func (s *DescribeVirtualNodeInput) SetVirtualNodeName(v string) *DescribeVirtualNodeInput {
+	s.VirtualNodeName = &v
 	return s
 }
 
-// SetTcpRetryEvents sets the TcpRetryEvents field's value.
-func (s *HttpRetryPolicy) SetTcpRetryEvents(v []*string) *HttpRetryPolicy {
-	s.TcpRetryEvents = v
+type DescribeVirtualNodeOutput struct {
+	_ struct{} `type:"structure" payload:"VirtualNode"`
+
+	// The full description of your virtual node.
+	//
+	// VirtualNode is a required field
+	VirtualNode *VirtualNodeData `locationName:"virtualNode" type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualNodeOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualNodeOutput) GoString() string {
+	return s.String()
+}
+
+// SetVirtualNode sets the VirtualNode field's value.
+func (s *DescribeVirtualNodeOutput) SetVirtualNode(v *VirtualNodeData) *DescribeVirtualNodeOutput {
+	s.VirtualNode = v
 	return s
 }
 
-// An object representing the HTTP routing specification for a route.
-type HttpRoute struct {
-	_ struct{} `type:"structure"`
+type DescribeVirtualRouterInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// An object representing the traffic distribution requirements for matched
-	// HTTP requests.
+	// The name of the service mesh that the virtual router resides in.
 	//
-	// Action is a required field
-	Action *HttpRouteAction `locationName:"action" type:"structure" required:"true"`
+	// MeshName is a required field
+	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
 
-	// An object representing the requirements for a route to match HTTP requests
-	// for a virtual router.
-	//
-	// Match is a required field
-	Match *HttpRouteMatch `locationName:"match" type:"structure" required:"true"`
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
 
-	// An object that represents a retry policy.
-	RetryPolicy *HttpRetryPolicy `locationName:"retryPolicy" type:"structure"`
+	// The name of the virtual router to describe.
+	//
+	// VirtualRouterName is a required field
+	VirtualRouterName *string `location:"uri" locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s HttpRoute) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualRouterInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s HttpRoute) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualRouterInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *HttpRoute) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "HttpRoute"}
-	if s.Action == nil {
-		invalidParams.Add(request.NewErrParamRequired("Action"))
+func (s *DescribeVirtualRouterInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeVirtualRouterInput"}
+	if s.MeshName == nil {
+		invalidParams.Add(request.NewErrParamRequired("MeshName"))
 	}
-	if s.Match == nil {
-		invalidParams.Add(request.NewErrParamRequired("Match"))
+	if s.MeshName != nil && len(*s.MeshName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
 	}
-	if s.Action != nil {
-		if err := s.Action.Validate(); err != nil {
-			invalidParams.AddNested("Action", err.(request.ErrInvalidParams))
-		}
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
 	}
-	if s.Match != nil {
-		if err := s.Match.Validate(); err != nil {
-			invalidParams.AddNested("Match", err.(request.ErrInvalidParams))
-		}
+	if s.VirtualRouterName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualRouterName"))
 	}
-	if s.RetryPolicy != nil {
-		if err := s.RetryPolicy.Validate(); err != nil {
-			invalidParams.AddNested("RetryPolicy", err.(request.ErrInvalidParams))
-		}
+	if s.VirtualRouterName != nil && len(*s.VirtualRouterName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VirtualRouterName", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5333,112 +7663,112 @@ func (s *HttpRoute) Validate() error {
 	return nil
 }
 
-// SetAction sets the Action field's value.
-func (s *HttpRoute) SetAction(v *HttpRouteAction) *HttpRoute {
-	s.Action = v
+// SetMeshName sets the MeshName field's value.
+func (s *DescribeVirtualRouterInput) SetMeshName(v string) *DescribeVirtualRouterInput {
+	s.MeshName = &v
 	return s
 }
 
-// SetMatch sets the Match field's value.
-func (s *HttpRoute) SetMatch(v *HttpRouteMatch) *HttpRoute {
-	s.Match = v
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *DescribeVirtualRouterInput) SetMeshOwner(v string) *DescribeVirtualRouterInput {
+	s.MeshOwner = &v
 	return s
 }
 
-// SetRetryPolicy sets the RetryPolicy field's value.
-func (s *HttpRoute) SetRetryPolicy(v *HttpRetryPolicy) *HttpRoute {
-	s.RetryPolicy = v
+// SetVirtualRouterName sets the VirtualRouterName field's value.
+func (s *DescribeVirtualRouterInput) SetVirtualRouterName(v string) *DescribeVirtualRouterInput {
+	s.VirtualRouterName = &v
 	return s
 }
 
-// An object representing the traffic distribution requirements for matched
-// HTTP requests.
-type HttpRouteAction struct {
-	_ struct{} `type:"structure"`
+type DescribeVirtualRouterOutput struct {
+	_ struct{} `type:"structure" payload:"VirtualRouter"`
 
-	// WeightedTargets is a required field
-	WeightedTargets []*WeightedTarget `locationName:"weightedTargets" min:"1" type:"list" required:"true"`
+	// The full description of your virtual router.
+	//
+	// VirtualRouter is a required field
+	VirtualRouter *VirtualRouterData `locationName:"virtualRouter" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s HttpRouteAction) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualRouterOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s HttpRouteAction) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualRouterOutput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *HttpRouteAction) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "HttpRouteAction"}
-	if s.WeightedTargets == nil {
-		invalidParams.Add(request.NewErrParamRequired("WeightedTargets"))
-	}
-	if s.WeightedTargets != nil && len(s.WeightedTargets) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("WeightedTargets", 1))
-	}
-	if s.WeightedTargets != nil {
-		for i, v := range s.WeightedTargets {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "WeightedTargets", i), err.(request.ErrInvalidParams))
-			}
-		}
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
-// SetWeightedTargets sets the WeightedTargets field's value.
-func (s *HttpRouteAction) SetWeightedTargets(v []*WeightedTarget) *HttpRouteAction {
-	s.WeightedTargets = v
+// SetVirtualRouter sets the VirtualRouter field's value.
+func (s *DescribeVirtualRouterOutput) SetVirtualRouter(v *VirtualRouterData) *DescribeVirtualRouterOutput {
+	s.VirtualRouter = v
 	return s
 }
 
-// An object representing the HTTP header in the request.
-type HttpRouteHeader struct {
-	_ struct{} `type:"structure"`
+type DescribeVirtualServiceInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	Invert *bool `locationName:"invert" type:"boolean"`
+	// The name of the service mesh that the virtual service resides in.
+	//
+	// MeshName is a required field
+	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
 
-	// An object representing the method and value to match the header value sent
-	// with a request. Specify one match method.
-	Match *HeaderMatchMethod `locationName:"match" type:"structure"`
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
 
-	// Name is a required field
-	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
+	// The name of the virtual service to describe.
+	//
+	// VirtualServiceName is a required field
+	VirtualServiceName *string `location:"uri" locationName:"virtualServiceName" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s HttpRouteHeader) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualServiceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s HttpRouteHeader) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualServiceInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *HttpRouteHeader) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "HttpRouteHeader"}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
+func (s *DescribeVirtualServiceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeVirtualServiceInput"}
+	if s.MeshName == nil {
+		invalidParams.Add(request.NewErrParamRequired("MeshName"))
 	}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	if s.MeshName != nil && len(*s.MeshName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
 	}
-	if s.Match != nil {
-		if err := s.Match.Validate(); err != nil {
-			invalidParams.AddNested("Match", err.(request.ErrInvalidParams))
-		}
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
+	}
+	if s.VirtualServiceName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualServiceName"))
+	}
+	if s.VirtualServiceName != nil && len(*s.VirtualServiceName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VirtualServiceName", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5447,67 +7777,99 @@ func (s *HttpRouteHeader) Validate() error {
 	return nil
 }
 
-// SetInvert sets the Invert field's value.
-func (s *HttpRouteHeader) SetInvert(v bool) *HttpRouteHeader {
-	s.Invert = &v
+// SetMeshName sets the MeshName field's value.
+func (s *DescribeVirtualServiceInput) SetMeshName(v string) *DescribeVirtualServiceInput {
+	s.MeshName = &v
 	return s
 }
 
-// SetMatch sets the Match field's value.
-func (s *HttpRouteHeader) SetMatch(v *HeaderMatchMethod) *HttpRouteHeader {
-	s.Match = v
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *DescribeVirtualServiceInput) SetMeshOwner(v string) *DescribeVirtualServiceInput {
+	s.MeshOwner = &v
 	return s
 }
 
-// SetName sets the Name field's value.
-func (s *HttpRouteHeader) SetName(v string) *HttpRouteHeader {
-	s.Name = &v
+// SetVirtualServiceName sets the VirtualServiceName field's value.
+func (s *DescribeVirtualServiceInput) SetVirtualServiceName(v string) *DescribeVirtualServiceInput {
+	s.VirtualServiceName = &v
 	return s
 }
 
-// An object representing the requirements for a route to match HTTP requests
-// for a virtual router.
-type HttpRouteMatch struct {
-	_ struct{} `type:"structure"`
+type DescribeVirtualServiceOutput struct {
+	_ struct{} `type:"structure" payload:"VirtualService"`
 
-	Headers []*HttpRouteHeader `locationName:"headers" min:"1" type:"list"`
+	// The full description of your virtual service.
+	//
+	// VirtualService is a required field
+	VirtualService *VirtualServiceData `locationName:"virtualService" type:"structure" required:"true"`
+}
 
-	Method *string `locationName:"method" type:"string" enum:"HttpMethod"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualServiceOutput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// Prefix is a required field
-	Prefix *string `locationName:"prefix" type:"string" required:"true"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualServiceOutput) GoString() string {
+	return s.String()
+}
 
-	Scheme *string `locationName:"scheme" type:"string" enum:"HttpScheme"`
+// SetVirtualService sets the VirtualService field's value.
+func (s *DescribeVirtualServiceOutput) SetVirtualService(v *VirtualServiceData) *DescribeVirtualServiceOutput {
+	s.VirtualService = v
+	return s
 }
 
-// String returns the string representation
-func (s HttpRouteMatch) String() string {
+// An object that represents the DNS service discovery information for your
+// virtual node.
+type DnsServiceDiscovery struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies the DNS service discovery hostname for the virtual node.
+	//
+	// Hostname is a required field
+	Hostname *string `locationName:"hostname" type:"string" required:"true"`
+
+	// The preferred IP version that this virtual node uses. Setting the IP preference
+	// on the virtual node only overrides the IP preference set for the mesh on
+	// this specific node.
+	IpPreference *string `locationName:"ipPreference" type:"string" enum:"IpPreference"`
+
+	// Specifies the DNS response type for the virtual node.
+	ResponseType *string `locationName:"responseType" type:"string" enum:"DnsResponseType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DnsServiceDiscovery) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s HttpRouteMatch) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DnsServiceDiscovery) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *HttpRouteMatch) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "HttpRouteMatch"}
-	if s.Headers != nil && len(s.Headers) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Headers", 1))
-	}
-	if s.Prefix == nil {
-		invalidParams.Add(request.NewErrParamRequired("Prefix"))
-	}
-	if s.Headers != nil {
-		for i, v := range s.Headers {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Headers", i), err.(request.ErrInvalidParams))
-			}
-		}
+func (s *DnsServiceDiscovery) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DnsServiceDiscovery"}
+	if s.Hostname == nil {
+		invalidParams.Add(request.NewErrParamRequired("Hostname"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5516,53 +7878,102 @@ func (s *HttpRouteMatch) Validate() error {
 	return nil
 }
 
-// SetHeaders sets the Headers field's value.
-func (s *HttpRouteMatch) SetHeaders(v []*HttpRouteHeader) *HttpRouteMatch {
-	s.Headers = v
+// SetHostname sets the Hostname field's value.
+func (s *DnsServiceDiscovery) SetHostname(v string) *DnsServiceDiscovery {
+	s.Hostname = &v
 	return s
 }
 
-// SetMethod sets the Method field's value.
-func (s *HttpRouteMatch) SetMethod(v string) *HttpRouteMatch {
-	s.Method = &v
+// SetIpPreference sets the IpPreference field's value.
+func (s *DnsServiceDiscovery) SetIpPreference(v string) *DnsServiceDiscovery {
+	s.IpPreference = &v
 	return s
 }
 
-// SetPrefix sets the Prefix field's value.
-func (s *HttpRouteMatch) SetPrefix(v string) *HttpRouteMatch {
-	s.Prefix = &v
+// SetResponseType sets the ResponseType field's value.
+func (s *DnsServiceDiscovery) SetResponseType(v string) *DnsServiceDiscovery {
+	s.ResponseType = &v
 	return s
 }
 
-// SetScheme sets the Scheme field's value.
-func (s *HttpRouteMatch) SetScheme(v string) *HttpRouteMatch {
-	s.Scheme = &v
+// An object that represents a duration of time.
+type Duration struct {
+	_ struct{} `type:"structure"`
+
+	// A unit of time.
+	Unit *string `locationName:"unit" type:"string" enum:"DurationUnit"`
+
+	// A number of time units.
+	Value *int64 `locationName:"value" type:"long"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Duration) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Duration) GoString() string {
+	return s.String()
+}
+
+// SetUnit sets the Unit field's value.
+func (s *Duration) SetUnit(v string) *Duration {
+	s.Unit = &v
 	return s
 }
 
-type ListMeshesInput struct {
-	_ struct{} `type:"structure"`
+// SetValue sets the Value field's value.
+func (s *Duration) SetValue(v int64) *Duration {
+	s.Value = &v
+	return s
+}
 
-	Limit *int64 `location:"querystring" locationName:"limit" min:"1" type:"integer"`
+// An object that represents the egress filter rules for a service mesh.
+type EgressFilter struct {
+	_ struct{} `type:"structure"`
 
-	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
+	// The egress filter type. By default, the type is DROP_ALL, which allows egress
+	// only from virtual nodes to other defined resources in the service mesh (and
+	// any traffic to *.amazonaws.com for Amazon Web Services API calls). You can
+	// set the egress filter type to ALLOW_ALL to allow egress to any endpoint inside
+	// or outside of the service mesh.
+	//
+	// Type is a required field
+	Type *string `locationName:"type" type:"string" required:"true" enum:"EgressFilterType"`
 }
 
-// String returns the string representation
-func (s ListMeshesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EgressFilter) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListMeshesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EgressFilter) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListMeshesInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListMeshesInput"}
-	if s.Limit != nil && *s.Limit < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+func (s *EgressFilter) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "EgressFilter"}
+	if s.Type == nil {
+		invalidParams.Add(request.NewErrParamRequired("Type"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5571,90 +7982,7886 @@ func (s *ListMeshesInput) Validate() error {
 	return nil
 }
 
-// SetLimit sets the Limit field's value.
-func (s *ListMeshesInput) SetLimit(v int64) *ListMeshesInput {
-	s.Limit = &v
+// SetType sets the Type field's value.
+func (s *EgressFilter) SetType(v string) *EgressFilter {
+	s.Type = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListMeshesInput) SetNextToken(v string) *ListMeshesInput {
-	s.NextToken = &v
+// An object that represents an access log file.
+type FileAccessLog struct {
+	_ struct{} `type:"structure"`
+
+	// The specified format for the logs. The format is either json_format or text_format.
+	Format *LoggingFormat `locationName:"format" type:"structure"`
+
+	// The file path to write access logs to. You can use /dev/stdout to send access
+	// logs to standard out and configure your Envoy container to use a log driver,
+	// such as awslogs, to export the access logs to a log storage service such
+	// as Amazon CloudWatch Logs. You can also specify a path in the Envoy container's
+	// file system to write the files to disk.
+	//
+	//    <note> <p>The Envoy process must have write permissions to the path that
+	//    you specify here. Otherwise, Envoy fails to bootstrap properly.</p> </note>
+	//
+	// Path is a required field
+	Path *string `locationName:"path" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s FileAccessLog) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s FileAccessLog) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *FileAccessLog) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "FileAccessLog"}
+	if s.Path == nil {
+		invalidParams.Add(request.NewErrParamRequired("Path"))
+	}
+	if s.Path != nil && len(*s.Path) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Path", 1))
+	}
+	if s.Format != nil {
+		if err := s.Format.Validate(); err != nil {
+			invalidParams.AddNested("Format", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetFormat sets the Format field's value.
+func (s *FileAccessLog) SetFormat(v *LoggingFormat) *FileAccessLog {
+	s.Format = v
 	return s
 }
 
-type ListMeshesOutput struct {
-	_ struct{} `type:"structure"`
+// SetPath sets the Path field's value.
+func (s *FileAccessLog) SetPath(v string) *FileAccessLog {
+	s.Path = &v
+	return s
+}
 
-	// Meshes is a required field
-	Meshes []*MeshRef `locationName:"meshes" type:"list" required:"true"`
+// You don't have permissions to perform this action.
+type ForbiddenException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	NextToken *string `locationName:"nextToken" type:"string"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s ListMeshesOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ForbiddenException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListMeshesOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ForbiddenException) GoString() string {
 	return s.String()
 }
 
-// SetMeshes sets the Meshes field's value.
-func (s *ListMeshesOutput) SetMeshes(v []*MeshRef) *ListMeshesOutput {
-	s.Meshes = v
-	return s
+func newErrorForbiddenException(v protocol.ResponseMetadata) error {
+	return &ForbiddenException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ForbiddenException) Code() string {
+	return "ForbiddenException"
+}
+
+// Message returns the exception's message.
+func (s *ForbiddenException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ForbiddenException) OrigErr() error {
+	return nil
+}
+
+func (s *ForbiddenException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ForbiddenException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ForbiddenException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// An object that represents a gateway route returned by a describe operation.
+type GatewayRouteData struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the gateway route.
+	//
+	// GatewayRouteName is a required field
+	GatewayRouteName *string `locationName:"gatewayRouteName" min:"1" type:"string" required:"true"`
+
+	// The name of the service mesh that the resource resides in.
+	//
+	// MeshName is a required field
+	MeshName *string `locationName:"meshName" min:"1" type:"string" required:"true"`
+
+	// An object that represents metadata for a resource.
+	//
+	// Metadata is a required field
+	Metadata *ResourceMetadata `locationName:"metadata" type:"structure" required:"true"`
+
+	// The specifications of the gateway route.
+	//
+	// Spec is a required field
+	Spec *GatewayRouteSpec `locationName:"spec" type:"structure" required:"true"`
+
+	// The status of the gateway route.
+	//
+	// Status is a required field
+	Status *GatewayRouteStatus `locationName:"status" type:"structure" required:"true"`
+
+	// The virtual gateway that the gateway route is associated with.
+	//
+	// VirtualGatewayName is a required field
+	VirtualGatewayName *string `locationName:"virtualGatewayName" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GatewayRouteData) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GatewayRouteData) GoString() string {
+	return s.String()
+}
+
+// SetGatewayRouteName sets the GatewayRouteName field's value.
+func (s *GatewayRouteData) SetGatewayRouteName(v string) *GatewayRouteData {
+	s.GatewayRouteName = &v
+	return s
+}
+
+// SetMeshName sets the MeshName field's value.
+func (s *GatewayRouteData) SetMeshName(v string) *GatewayRouteData {
+	s.MeshName = &v
+	return s
+}
+
+// SetMetadata sets the Metadata field's value.
+func (s *GatewayRouteData) SetMetadata(v *ResourceMetadata) *GatewayRouteData {
+	s.Metadata = v
+	return s
+}
+
+// SetSpec sets the Spec field's value.
+func (s *GatewayRouteData) SetSpec(v *GatewayRouteSpec) *GatewayRouteData {
+	s.Spec = v
+	return s
+}
+
+// SetStatus sets the Status field's value.
+func (s *GatewayRouteData) SetStatus(v *GatewayRouteStatus) *GatewayRouteData {
+	s.Status = v
+	return s
+}
+
+// SetVirtualGatewayName sets the VirtualGatewayName field's value.
+func (s *GatewayRouteData) SetVirtualGatewayName(v string) *GatewayRouteData {
+	s.VirtualGatewayName = &v
+	return s
+}
+
+// An object representing the gateway route host name to match.
+type GatewayRouteHostnameMatch struct {
+	_ struct{} `type:"structure"`
+
+	// The exact host name to match on.
+	Exact *string `locationName:"exact" min:"1" type:"string"`
+
+	// The specified ending characters of the host name to match on.
+	Suffix *string `locationName:"suffix" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GatewayRouteHostnameMatch) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GatewayRouteHostnameMatch) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GatewayRouteHostnameMatch) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GatewayRouteHostnameMatch"}
+	if s.Exact != nil && len(*s.Exact) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Exact", 1))
+	}
+	if s.Suffix != nil && len(*s.Suffix) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Suffix", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetExact sets the Exact field's value.
+func (s *GatewayRouteHostnameMatch) SetExact(v string) *GatewayRouteHostnameMatch {
+	s.Exact = &v
+	return s
+}
+
+// SetSuffix sets the Suffix field's value.
+func (s *GatewayRouteHostnameMatch) SetSuffix(v string) *GatewayRouteHostnameMatch {
+	s.Suffix = &v
+	return s
+}
+
+// An object representing the gateway route host name to rewrite.
+type GatewayRouteHostnameRewrite struct {
+	_ struct{} `type:"structure"`
+
+	// The default target host name to write to.
+	DefaultTargetHostname *string `locationName:"defaultTargetHostname" type:"string" enum:"DefaultGatewayRouteRewrite"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GatewayRouteHostnameRewrite) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GatewayRouteHostnameRewrite) GoString() string {
+	return s.String()
+}
+
+// SetDefaultTargetHostname sets the DefaultTargetHostname field's value.
+func (s *GatewayRouteHostnameRewrite) SetDefaultTargetHostname(v string) *GatewayRouteHostnameRewrite {
+	s.DefaultTargetHostname = &v
+	return s
+}
+
+// An object that represents a gateway route returned by a list operation.
+type GatewayRouteRef struct {
+	_ struct{} `type:"structure"`
+
+	// The full Amazon Resource Name (ARN) for the gateway route.
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" type:"string" required:"true"`
+
+	// The Unix epoch timestamp in seconds for when the resource was created.
+	//
+	// CreatedAt is a required field
+	CreatedAt *time.Time `locationName:"createdAt" type:"timestamp" required:"true"`
+
+	// The name of the gateway route.
+	//
+	// GatewayRouteName is a required field
+	GatewayRouteName *string `locationName:"gatewayRouteName" min:"1" type:"string" required:"true"`
+
+	// The Unix epoch timestamp in seconds for when the resource was last updated.
+	//
+	// LastUpdatedAt is a required field
+	LastUpdatedAt *time.Time `locationName:"lastUpdatedAt" type:"timestamp" required:"true"`
+
+	// The name of the service mesh that the resource resides in.
+	//
+	// MeshName is a required field
+	MeshName *string `locationName:"meshName" min:"1" type:"string" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	//
+	// MeshOwner is a required field
+	MeshOwner *string `locationName:"meshOwner" min:"12" type:"string" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the resource owner. If the account
+	// ID is not your own, then it's the ID of the mesh owner or of another account
+	// that the mesh is shared with. For more information about mesh sharing, see
+	// Working with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	//
+	// ResourceOwner is a required field
+	ResourceOwner *string `locationName:"resourceOwner" min:"12" type:"string" required:"true"`
+
+	// The version of the resource. Resources are created at version 1, and this
+	// version is incremented each time that they're updated.
+	//
+	// Version is a required field
+	Version *int64 `locationName:"version" type:"long" required:"true"`
+
+	// The virtual gateway that the gateway route is associated with.
+	//
+	// VirtualGatewayName is a required field
+	VirtualGatewayName *string `locationName:"virtualGatewayName" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GatewayRouteRef) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GatewayRouteRef) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *GatewayRouteRef) SetArn(v string) *GatewayRouteRef {
+	s.Arn = &v
+	return s
+}
+
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *GatewayRouteRef) SetCreatedAt(v time.Time) *GatewayRouteRef {
+	s.CreatedAt = &v
+	return s
+}
+
+// SetGatewayRouteName sets the GatewayRouteName field's value.
+func (s *GatewayRouteRef) SetGatewayRouteName(v string) *GatewayRouteRef {
+	s.GatewayRouteName = &v
+	return s
+}
+
+// SetLastUpdatedAt sets the LastUpdatedAt field's value.
+func (s *GatewayRouteRef) SetLastUpdatedAt(v time.Time) *GatewayRouteRef {
+	s.LastUpdatedAt = &v
+	return s
+}
+
+// SetMeshName sets the MeshName field's value.
+func (s *GatewayRouteRef) SetMeshName(v string) *GatewayRouteRef {
+	s.MeshName = &v
+	return s
+}
+
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *GatewayRouteRef) SetMeshOwner(v string) *GatewayRouteRef {
+	s.MeshOwner = &v
+	return s
+}
+
+// SetResourceOwner sets the ResourceOwner field's value.
+func (s *GatewayRouteRef) SetResourceOwner(v string) *GatewayRouteRef {
+	s.ResourceOwner = &v
+	return s
+}
+
+// SetVersion sets the Version field's value.
+func (s *GatewayRouteRef) SetVersion(v int64) *GatewayRouteRef {
+	s.Version = &v
+	return s
+}
+
+// SetVirtualGatewayName sets the VirtualGatewayName field's value.
+func (s *GatewayRouteRef) SetVirtualGatewayName(v string) *GatewayRouteRef {
+	s.VirtualGatewayName = &v
+	return s
+}
+
+// An object that represents a gateway route specification. Specify one gateway
+// route type.
+type GatewayRouteSpec struct {
+	_ struct{} `type:"structure"`
+
+	// An object that represents the specification of a gRPC gateway route.
+	GrpcRoute *GrpcGatewayRoute `locationName:"grpcRoute" type:"structure"`
+
+	// An object that represents the specification of an HTTP/2 gateway route.
+	Http2Route *HttpGatewayRoute `locationName:"http2Route" type:"structure"`
+
+	// An object that represents the specification of an HTTP gateway route.
+	HttpRoute *HttpGatewayRoute `locationName:"httpRoute" type:"structure"`
+
+	// The ordering of the gateway routes spec.
+	Priority *int64 `locationName:"priority" type:"integer"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GatewayRouteSpec) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GatewayRouteSpec) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GatewayRouteSpec) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GatewayRouteSpec"}
+	if s.GrpcRoute != nil {
+		if err := s.GrpcRoute.Validate(); err != nil {
+			invalidParams.AddNested("GrpcRoute", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Http2Route != nil {
+		if err := s.Http2Route.Validate(); err != nil {
+			invalidParams.AddNested("Http2Route", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.HttpRoute != nil {
+		if err := s.HttpRoute.Validate(); err != nil {
+			invalidParams.AddNested("HttpRoute", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetGrpcRoute sets the GrpcRoute field's value.
+func (s *GatewayRouteSpec) SetGrpcRoute(v *GrpcGatewayRoute) *GatewayRouteSpec {
+	s.GrpcRoute = v
+	return s
+}
+
+// SetHttp2Route sets the Http2Route field's value.
+func (s *GatewayRouteSpec) SetHttp2Route(v *HttpGatewayRoute) *GatewayRouteSpec {
+	s.Http2Route = v
+	return s
+}
+
+// SetHttpRoute sets the HttpRoute field's value.
+func (s *GatewayRouteSpec) SetHttpRoute(v *HttpGatewayRoute) *GatewayRouteSpec {
+	s.HttpRoute = v
+	return s
+}
+
+// SetPriority sets the Priority field's value.
+func (s *GatewayRouteSpec) SetPriority(v int64) *GatewayRouteSpec {
+	s.Priority = &v
+	return s
+}
+
+// An object that represents the current status of a gateway route.
+type GatewayRouteStatus struct {
+	_ struct{} `type:"structure"`
+
+	// The current status for the gateway route.
+	//
+	// Status is a required field
+	Status *string `locationName:"status" type:"string" required:"true" enum:"GatewayRouteStatusCode"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GatewayRouteStatus) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GatewayRouteStatus) GoString() string {
+	return s.String()
+}
+
+// SetStatus sets the Status field's value.
+func (s *GatewayRouteStatus) SetStatus(v string) *GatewayRouteStatus {
+	s.Status = &v
+	return s
+}
+
+// An object that represents a gateway route target.
+type GatewayRouteTarget struct {
+	_ struct{} `type:"structure"`
+
+	// The port number of the gateway route target.
+	Port *int64 `locationName:"port" min:"1" type:"integer"`
+
+	// An object that represents a virtual service gateway route target.
+	//
+	// VirtualService is a required field
+	VirtualService *GatewayRouteVirtualService `locationName:"virtualService" type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GatewayRouteTarget) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GatewayRouteTarget) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GatewayRouteTarget) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GatewayRouteTarget"}
+	if s.Port != nil && *s.Port < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Port", 1))
+	}
+	if s.VirtualService == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualService"))
+	}
+	if s.VirtualService != nil {
+		if err := s.VirtualService.Validate(); err != nil {
+			invalidParams.AddNested("VirtualService", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetPort sets the Port field's value.
+func (s *GatewayRouteTarget) SetPort(v int64) *GatewayRouteTarget {
+	s.Port = &v
+	return s
+}
+
+// SetVirtualService sets the VirtualService field's value.
+func (s *GatewayRouteTarget) SetVirtualService(v *GatewayRouteVirtualService) *GatewayRouteTarget {
+	s.VirtualService = v
+	return s
+}
+
+// An object that represents the virtual service that traffic is routed to.
+type GatewayRouteVirtualService struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the virtual service that traffic is routed to.
+	//
+	// VirtualServiceName is a required field
+	VirtualServiceName *string `locationName:"virtualServiceName" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GatewayRouteVirtualService) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GatewayRouteVirtualService) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GatewayRouteVirtualService) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GatewayRouteVirtualService"}
+	if s.VirtualServiceName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualServiceName"))
+	}
+	if s.VirtualServiceName != nil && len(*s.VirtualServiceName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VirtualServiceName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetVirtualServiceName sets the VirtualServiceName field's value.
+func (s *GatewayRouteVirtualService) SetVirtualServiceName(v string) *GatewayRouteVirtualService {
+	s.VirtualServiceName = &v
+	return s
+}
+
+// An object that represents a gRPC gateway route.
+type GrpcGatewayRoute struct {
+	_ struct{} `type:"structure"`
+
+	// An object that represents the action to take if a match is determined.
+	//
+	// Action is a required field
+	Action *GrpcGatewayRouteAction `locationName:"action" type:"structure" required:"true"`
+
+	// An object that represents the criteria for determining a request match.
+	//
+	// Match is a required field
+	Match *GrpcGatewayRouteMatch `locationName:"match" type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcGatewayRoute) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcGatewayRoute) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GrpcGatewayRoute) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GrpcGatewayRoute"}
+	if s.Action == nil {
+		invalidParams.Add(request.NewErrParamRequired("Action"))
+	}
+	if s.Match == nil {
+		invalidParams.Add(request.NewErrParamRequired("Match"))
+	}
+	if s.Action != nil {
+		if err := s.Action.Validate(); err != nil {
+			invalidParams.AddNested("Action", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Match != nil {
+		if err := s.Match.Validate(); err != nil {
+			invalidParams.AddNested("Match", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAction sets the Action field's value.
+func (s *GrpcGatewayRoute) SetAction(v *GrpcGatewayRouteAction) *GrpcGatewayRoute {
+	s.Action = v
+	return s
+}
+
+// SetMatch sets the Match field's value.
+func (s *GrpcGatewayRoute) SetMatch(v *GrpcGatewayRouteMatch) *GrpcGatewayRoute {
+	s.Match = v
+	return s
+}
+
+// An object that represents the action to take if a match is determined.
+type GrpcGatewayRouteAction struct {
+	_ struct{} `type:"structure"`
+
+	// The gateway route action to rewrite.
+	Rewrite *GrpcGatewayRouteRewrite `locationName:"rewrite" type:"structure"`
+
+	// An object that represents the target that traffic is routed to when a request
+	// matches the gateway route.
+	//
+	// Target is a required field
+	Target *GatewayRouteTarget `locationName:"target" type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcGatewayRouteAction) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcGatewayRouteAction) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GrpcGatewayRouteAction) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GrpcGatewayRouteAction"}
+	if s.Target == nil {
+		invalidParams.Add(request.NewErrParamRequired("Target"))
+	}
+	if s.Target != nil {
+		if err := s.Target.Validate(); err != nil {
+			invalidParams.AddNested("Target", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetRewrite sets the Rewrite field's value.
+func (s *GrpcGatewayRouteAction) SetRewrite(v *GrpcGatewayRouteRewrite) *GrpcGatewayRouteAction {
+	s.Rewrite = v
+	return s
+}
+
+// SetTarget sets the Target field's value.
+func (s *GrpcGatewayRouteAction) SetTarget(v *GatewayRouteTarget) *GrpcGatewayRouteAction {
+	s.Target = v
+	return s
+}
+
+// An object that represents the criteria for determining a request match.
+type GrpcGatewayRouteMatch struct {
+	_ struct{} `type:"structure"`
+
+	// The gateway route host name to be matched on.
+	Hostname *GatewayRouteHostnameMatch `locationName:"hostname" type:"structure"`
+
+	// The gateway route metadata to be matched on.
+	Metadata []*GrpcGatewayRouteMetadata `locationName:"metadata" min:"1" type:"list"`
+
+	// The port number to match from the request.
+	Port *int64 `locationName:"port" min:"1" type:"integer"`
+
+	// The fully qualified domain name for the service to match from the request.
+	ServiceName *string `locationName:"serviceName" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcGatewayRouteMatch) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcGatewayRouteMatch) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GrpcGatewayRouteMatch) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GrpcGatewayRouteMatch"}
+	if s.Metadata != nil && len(s.Metadata) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Metadata", 1))
+	}
+	if s.Port != nil && *s.Port < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Port", 1))
+	}
+	if s.Hostname != nil {
+		if err := s.Hostname.Validate(); err != nil {
+			invalidParams.AddNested("Hostname", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Metadata != nil {
+		for i, v := range s.Metadata {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Metadata", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetHostname sets the Hostname field's value.
+func (s *GrpcGatewayRouteMatch) SetHostname(v *GatewayRouteHostnameMatch) *GrpcGatewayRouteMatch {
+	s.Hostname = v
+	return s
+}
+
+// SetMetadata sets the Metadata field's value.
+func (s *GrpcGatewayRouteMatch) SetMetadata(v []*GrpcGatewayRouteMetadata) *GrpcGatewayRouteMatch {
+	s.Metadata = v
+	return s
+}
+
+// SetPort sets the Port field's value.
+func (s *GrpcGatewayRouteMatch) SetPort(v int64) *GrpcGatewayRouteMatch {
+	s.Port = &v
+	return s
+}
+
+// SetServiceName sets the ServiceName field's value.
+func (s *GrpcGatewayRouteMatch) SetServiceName(v string) *GrpcGatewayRouteMatch {
+	s.ServiceName = &v
+	return s
+}
+
+// An object representing the metadata of the gateway route.
+type GrpcGatewayRouteMetadata struct {
+	_ struct{} `type:"structure"`
+
+	// Specify True to match anything except the match criteria. The default value
+	// is False.
+	Invert *bool `locationName:"invert" type:"boolean"`
+
+	// The criteria for determining a metadata match.
+	Match *GrpcMetadataMatchMethod `locationName:"match" type:"structure"`
+
+	// A name for the gateway route metadata.
+	//
+	// Name is a required field
+	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcGatewayRouteMetadata) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcGatewayRouteMetadata) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GrpcGatewayRouteMetadata) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GrpcGatewayRouteMetadata"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+	if s.Match != nil {
+		if err := s.Match.Validate(); err != nil {
+			invalidParams.AddNested("Match", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetInvert sets the Invert field's value.
+func (s *GrpcGatewayRouteMetadata) SetInvert(v bool) *GrpcGatewayRouteMetadata {
+	s.Invert = &v
+	return s
+}
+
+// SetMatch sets the Match field's value.
+func (s *GrpcGatewayRouteMetadata) SetMatch(v *GrpcMetadataMatchMethod) *GrpcGatewayRouteMetadata {
+	s.Match = v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *GrpcGatewayRouteMetadata) SetName(v string) *GrpcGatewayRouteMetadata {
+	s.Name = &v
+	return s
+}
+
+// An object that represents the gateway route to rewrite.
+type GrpcGatewayRouteRewrite struct {
+	_ struct{} `type:"structure"`
+
+	// The host name of the gateway route to rewrite.
+	Hostname *GatewayRouteHostnameRewrite `locationName:"hostname" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcGatewayRouteRewrite) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcGatewayRouteRewrite) GoString() string {
+	return s.String()
+}
+
+// SetHostname sets the Hostname field's value.
+func (s *GrpcGatewayRouteRewrite) SetHostname(v *GatewayRouteHostnameRewrite) *GrpcGatewayRouteRewrite {
+	s.Hostname = v
+	return s
+}
+
+// An object representing the method header to be matched.
+type GrpcMetadataMatchMethod struct {
+	_ struct{} `type:"structure"`
+
+	// The exact method header to be matched on.
+	Exact *string `locationName:"exact" min:"1" type:"string"`
+
+	// The specified beginning characters of the method header to be matched on.
+	Prefix *string `locationName:"prefix" min:"1" type:"string"`
+
+	// An object that represents the range of values to match on. The first character
+	// of the range is included in the range, though the last character is not.
+	// For example, if the range specified were 1-100, only values 1-99 would be
+	// matched.
+	Range *MatchRange `locationName:"range" type:"structure"`
+
+	// The regex used to match the method header.
+	Regex *string `locationName:"regex" min:"1" type:"string"`
+
+	// The specified ending characters of the method header to match on.
+	Suffix *string `locationName:"suffix" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcMetadataMatchMethod) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcMetadataMatchMethod) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GrpcMetadataMatchMethod) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GrpcMetadataMatchMethod"}
+	if s.Exact != nil && len(*s.Exact) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Exact", 1))
+	}
+	if s.Prefix != nil && len(*s.Prefix) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Prefix", 1))
+	}
+	if s.Regex != nil && len(*s.Regex) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Regex", 1))
+	}
+	if s.Suffix != nil && len(*s.Suffix) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Suffix", 1))
+	}
+	if s.Range != nil {
+		if err := s.Range.Validate(); err != nil {
+			invalidParams.AddNested("Range", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetExact sets the Exact field's value.
+func (s *GrpcMetadataMatchMethod) SetExact(v string) *GrpcMetadataMatchMethod {
+	s.Exact = &v
+	return s
+}
+
+// SetPrefix sets the Prefix field's value.
+func (s *GrpcMetadataMatchMethod) SetPrefix(v string) *GrpcMetadataMatchMethod {
+	s.Prefix = &v
+	return s
+}
+
+// SetRange sets the Range field's value.
+func (s *GrpcMetadataMatchMethod) SetRange(v *MatchRange) *GrpcMetadataMatchMethod {
+	s.Range = v
+	return s
+}
+
+// SetRegex sets the Regex field's value.
+func (s *GrpcMetadataMatchMethod) SetRegex(v string) *GrpcMetadataMatchMethod {
+	s.Regex = &v
+	return s
+}
+
+// SetSuffix sets the Suffix field's value.
+func (s *GrpcMetadataMatchMethod) SetSuffix(v string) *GrpcMetadataMatchMethod {
+	s.Suffix = &v
+	return s
+}
+
+// An object that represents a retry policy. Specify at least one value for
+// at least one of the types of RetryEvents, a value for maxRetries, and a value
+// for perRetryTimeout. Both server-error and gateway-error under httpRetryEvents
+// include the Envoy reset policy. For more information on the reset policy,
+// see the Envoy documentation (https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/router_filter#x-envoy-retry-on).
+type GrpcRetryPolicy struct {
+	_ struct{} `type:"structure"`
+
+	// Specify at least one of the valid values.
+	GrpcRetryEvents []*string `locationName:"grpcRetryEvents" min:"1" type:"list" enum:"GrpcRetryPolicyEvent"`
+
+	// Specify at least one of the following values.
+	//
+	//    * server-error – HTTP status codes 500, 501, 502, 503, 504, 505, 506,
+	//    507, 508, 510, and 511
+	//
+	//    * gateway-error – HTTP status codes 502, 503, and 504
+	//
+	//    * client-error – HTTP status code 409
+	//
+	//    * stream-error – Retry on refused stream
+	HttpRetryEvents []*string `locationName:"httpRetryEvents" min:"1" type:"list"`
+
+	// The maximum number of retry attempts.
+	//
+	// MaxRetries is a required field
+	MaxRetries *int64 `locationName:"maxRetries" type:"long" required:"true"`
+
+	// The timeout for each retry attempt.
+	//
+	// PerRetryTimeout is a required field
+	PerRetryTimeout *Duration `locationName:"perRetryTimeout" type:"structure" required:"true"`
+
+	// Specify a valid value. The event occurs before any processing of a request
+	// has started and is encountered when the upstream is temporarily or permanently
+	// unavailable.
+	TcpRetryEvents []*string `locationName:"tcpRetryEvents" min:"1" type:"list" enum:"TcpRetryPolicyEvent"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcRetryPolicy) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcRetryPolicy) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GrpcRetryPolicy) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GrpcRetryPolicy"}
+	if s.GrpcRetryEvents != nil && len(s.GrpcRetryEvents) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("GrpcRetryEvents", 1))
+	}
+	if s.HttpRetryEvents != nil && len(s.HttpRetryEvents) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("HttpRetryEvents", 1))
+	}
+	if s.MaxRetries == nil {
+		invalidParams.Add(request.NewErrParamRequired("MaxRetries"))
+	}
+	if s.PerRetryTimeout == nil {
+		invalidParams.Add(request.NewErrParamRequired("PerRetryTimeout"))
+	}
+	if s.TcpRetryEvents != nil && len(s.TcpRetryEvents) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("TcpRetryEvents", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetGrpcRetryEvents sets the GrpcRetryEvents field's value.
+func (s *GrpcRetryPolicy) SetGrpcRetryEvents(v []*string) *GrpcRetryPolicy {
+	s.GrpcRetryEvents = v
+	return s
+}
+
+// SetHttpRetryEvents sets the HttpRetryEvents field's value.
+func (s *GrpcRetryPolicy) SetHttpRetryEvents(v []*string) *GrpcRetryPolicy {
+	s.HttpRetryEvents = v
+	return s
+}
+
+// SetMaxRetries sets the MaxRetries field's value.
+func (s *GrpcRetryPolicy) SetMaxRetries(v int64) *GrpcRetryPolicy {
+	s.MaxRetries = &v
+	return s
+}
+
+// SetPerRetryTimeout sets the PerRetryTimeout field's value.
+func (s *GrpcRetryPolicy) SetPerRetryTimeout(v *Duration) *GrpcRetryPolicy {
+	s.PerRetryTimeout = v
+	return s
+}
+
+// SetTcpRetryEvents sets the TcpRetryEvents field's value.
+func (s *GrpcRetryPolicy) SetTcpRetryEvents(v []*string) *GrpcRetryPolicy {
+	s.TcpRetryEvents = v
+	return s
+}
+
+// An object that represents a gRPC route type.
+type GrpcRoute struct {
+	_ struct{} `type:"structure"`
+
+	// An object that represents the action to take if a match is determined.
+	//
+	// Action is a required field
+	Action *GrpcRouteAction `locationName:"action" type:"structure" required:"true"`
+
+	// An object that represents the criteria for determining a request match.
+	//
+	// Match is a required field
+	Match *GrpcRouteMatch `locationName:"match" type:"structure" required:"true"`
+
+	// An object that represents a retry policy.
+	RetryPolicy *GrpcRetryPolicy `locationName:"retryPolicy" type:"structure"`
+
+	// An object that represents types of timeouts.
+	Timeout *GrpcTimeout `locationName:"timeout" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcRoute) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcRoute) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GrpcRoute) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GrpcRoute"}
+	if s.Action == nil {
+		invalidParams.Add(request.NewErrParamRequired("Action"))
+	}
+	if s.Match == nil {
+		invalidParams.Add(request.NewErrParamRequired("Match"))
+	}
+	if s.Action != nil {
+		if err := s.Action.Validate(); err != nil {
+			invalidParams.AddNested("Action", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Match != nil {
+		if err := s.Match.Validate(); err != nil {
+			invalidParams.AddNested("Match", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.RetryPolicy != nil {
+		if err := s.RetryPolicy.Validate(); err != nil {
+			invalidParams.AddNested("RetryPolicy", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAction sets the Action field's value.
+func (s *GrpcRoute) SetAction(v *GrpcRouteAction) *GrpcRoute {
+	s.Action = v
+	return s
+}
+
+// SetMatch sets the Match field's value.
+func (s *GrpcRoute) SetMatch(v *GrpcRouteMatch) *GrpcRoute {
+	s.Match = v
+	return s
+}
+
+// SetRetryPolicy sets the RetryPolicy field's value.
+func (s *GrpcRoute) SetRetryPolicy(v *GrpcRetryPolicy) *GrpcRoute {
+	s.RetryPolicy = v
+	return s
+}
+
+// SetTimeout sets the Timeout field's value.
+func (s *GrpcRoute) SetTimeout(v *GrpcTimeout) *GrpcRoute {
+	s.Timeout = v
+	return s
+}
+
+// An object that represents the action to take if a match is determined.
+type GrpcRouteAction struct {
+	_ struct{} `type:"structure"`
+
+	// An object that represents the targets that traffic is routed to when a request
+	// matches the route.
+	//
+	// WeightedTargets is a required field
+	WeightedTargets []*WeightedTarget `locationName:"weightedTargets" min:"1" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcRouteAction) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcRouteAction) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GrpcRouteAction) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GrpcRouteAction"}
+	if s.WeightedTargets == nil {
+		invalidParams.Add(request.NewErrParamRequired("WeightedTargets"))
+	}
+	if s.WeightedTargets != nil && len(s.WeightedTargets) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("WeightedTargets", 1))
+	}
+	if s.WeightedTargets != nil {
+		for i, v := range s.WeightedTargets {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "WeightedTargets", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetWeightedTargets sets the WeightedTargets field's value.
+func (s *GrpcRouteAction) SetWeightedTargets(v []*WeightedTarget) *GrpcRouteAction {
+	s.WeightedTargets = v
+	return s
+}
+
+// An object that represents the criteria for determining a request match.
+type GrpcRouteMatch struct {
+	_ struct{} `type:"structure"`
+
+	// An object that represents the data to match from the request.
+	Metadata []*GrpcRouteMetadata `locationName:"metadata" min:"1" type:"list"`
+
+	// The method name to match from the request. If you specify a name, you must
+	// also specify a serviceName.
+	MethodName *string `locationName:"methodName" min:"1" type:"string"`
+
+	// The port number to match on.
+	Port *int64 `locationName:"port" min:"1" type:"integer"`
+
+	// The fully qualified domain name for the service to match from the request.
+	ServiceName *string `locationName:"serviceName" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcRouteMatch) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcRouteMatch) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GrpcRouteMatch) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GrpcRouteMatch"}
+	if s.Metadata != nil && len(s.Metadata) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Metadata", 1))
+	}
+	if s.MethodName != nil && len(*s.MethodName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("MethodName", 1))
+	}
+	if s.Port != nil && *s.Port < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Port", 1))
+	}
+	if s.Metadata != nil {
+		for i, v := range s.Metadata {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Metadata", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetMetadata sets the Metadata field's value.
+func (s *GrpcRouteMatch) SetMetadata(v []*GrpcRouteMetadata) *GrpcRouteMatch {
+	s.Metadata = v
+	return s
+}
+
+// SetMethodName sets the MethodName field's value.
+func (s *GrpcRouteMatch) SetMethodName(v string) *GrpcRouteMatch {
+	s.MethodName = &v
+	return s
+}
+
+// SetPort sets the Port field's value.
+func (s *GrpcRouteMatch) SetPort(v int64) *GrpcRouteMatch {
+	s.Port = &v
+	return s
+}
+
+// SetServiceName sets the ServiceName field's value.
+func (s *GrpcRouteMatch) SetServiceName(v string) *GrpcRouteMatch {
+	s.ServiceName = &v
+	return s
+}
+
+// An object that represents the match metadata for the route.
+type GrpcRouteMetadata struct {
+	_ struct{} `type:"structure"`
+
+	// Specify True to match anything except the match criteria. The default value
+	// is False.
+	Invert *bool `locationName:"invert" type:"boolean"`
+
+	// An object that represents the data to match from the request.
+	Match *GrpcRouteMetadataMatchMethod `locationName:"match" type:"structure"`
+
+	// The name of the route.
+	//
+	// Name is a required field
+	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcRouteMetadata) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcRouteMetadata) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GrpcRouteMetadata) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GrpcRouteMetadata"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+	if s.Match != nil {
+		if err := s.Match.Validate(); err != nil {
+			invalidParams.AddNested("Match", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetInvert sets the Invert field's value.
+func (s *GrpcRouteMetadata) SetInvert(v bool) *GrpcRouteMetadata {
+	s.Invert = &v
+	return s
+}
+
+// SetMatch sets the Match field's value.
+func (s *GrpcRouteMetadata) SetMatch(v *GrpcRouteMetadataMatchMethod) *GrpcRouteMetadata {
+	s.Match = v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *GrpcRouteMetadata) SetName(v string) *GrpcRouteMetadata {
+	s.Name = &v
+	return s
+}
+
+// An object that represents the match method. Specify one of the match values.
+type GrpcRouteMetadataMatchMethod struct {
+	_ struct{} `type:"structure"`
+
+	// The value sent by the client must match the specified value exactly.
+	Exact *string `locationName:"exact" min:"1" type:"string"`
+
+	// The value sent by the client must begin with the specified characters.
+	Prefix *string `locationName:"prefix" min:"1" type:"string"`
+
+	// An object that represents the range of values to match on.
+	Range *MatchRange `locationName:"range" type:"structure"`
+
+	// The value sent by the client must include the specified characters.
+	Regex *string `locationName:"regex" min:"1" type:"string"`
+
+	// The value sent by the client must end with the specified characters.
+	Suffix *string `locationName:"suffix" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcRouteMetadataMatchMethod) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcRouteMetadataMatchMethod) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GrpcRouteMetadataMatchMethod) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GrpcRouteMetadataMatchMethod"}
+	if s.Exact != nil && len(*s.Exact) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Exact", 1))
+	}
+	if s.Prefix != nil && len(*s.Prefix) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Prefix", 1))
+	}
+	if s.Regex != nil && len(*s.Regex) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Regex", 1))
+	}
+	if s.Suffix != nil && len(*s.Suffix) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Suffix", 1))
+	}
+	if s.Range != nil {
+		if err := s.Range.Validate(); err != nil {
+			invalidParams.AddNested("Range", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetExact sets the Exact field's value.
+func (s *GrpcRouteMetadataMatchMethod) SetExact(v string) *GrpcRouteMetadataMatchMethod {
+	s.Exact = &v
+	return s
+}
+
+// SetPrefix sets the Prefix field's value.
+func (s *GrpcRouteMetadataMatchMethod) SetPrefix(v string) *GrpcRouteMetadataMatchMethod {
+	s.Prefix = &v
+	return s
+}
+
+// SetRange sets the Range field's value.
+func (s *GrpcRouteMetadataMatchMethod) SetRange(v *MatchRange) *GrpcRouteMetadataMatchMethod {
+	s.Range = v
+	return s
+}
+
+// SetRegex sets the Regex field's value.
+func (s *GrpcRouteMetadataMatchMethod) SetRegex(v string) *GrpcRouteMetadataMatchMethod {
+	s.Regex = &v
+	return s
+}
+
+// SetSuffix sets the Suffix field's value.
+func (s *GrpcRouteMetadataMatchMethod) SetSuffix(v string) *GrpcRouteMetadataMatchMethod {
+	s.Suffix = &v
+	return s
+}
+
+// An object that represents types of timeouts.
+type GrpcTimeout struct {
+	_ struct{} `type:"structure"`
+
+	// An object that represents an idle timeout. An idle timeout bounds the amount
+	// of time that a connection may be idle. The default value is none.
+	Idle *Duration `locationName:"idle" type:"structure"`
+
+	// An object that represents a per request timeout. The default value is 15
+	// seconds. If you set a higher timeout, then make sure that the higher value
+	// is set for each App Mesh resource in a conversation. For example, if a virtual
+	// node backend uses a virtual router provider to route to another virtual node,
+	// then the timeout should be greater than 15 seconds for the source and destination
+	// virtual node and the route.
+	PerRequest *Duration `locationName:"perRequest" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcTimeout) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GrpcTimeout) GoString() string {
+	return s.String()
+}
+
+// SetIdle sets the Idle field's value.
+func (s *GrpcTimeout) SetIdle(v *Duration) *GrpcTimeout {
+	s.Idle = v
+	return s
+}
+
+// SetPerRequest sets the PerRequest field's value.
+func (s *GrpcTimeout) SetPerRequest(v *Duration) *GrpcTimeout {
+	s.PerRequest = v
+	return s
+}
+
+// An object that represents the method and value to match with the header value
+// sent in a request. Specify one match method.
+type HeaderMatchMethod struct {
+	_ struct{} `type:"structure"`
+
+	// The value sent by the client must match the specified value exactly.
+	Exact *string `locationName:"exact" min:"1" type:"string"`
+
+	// The value sent by the client must begin with the specified characters.
+	Prefix *string `locationName:"prefix" min:"1" type:"string"`
+
+	// An object that represents the range of values to match on.
+	Range *MatchRange `locationName:"range" type:"structure"`
+
+	// The value sent by the client must include the specified characters.
+	Regex *string `locationName:"regex" min:"1" type:"string"`
+
+	// The value sent by the client must end with the specified characters.
+	Suffix *string `locationName:"suffix" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HeaderMatchMethod) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HeaderMatchMethod) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *HeaderMatchMethod) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "HeaderMatchMethod"}
+	if s.Exact != nil && len(*s.Exact) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Exact", 1))
+	}
+	if s.Prefix != nil && len(*s.Prefix) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Prefix", 1))
+	}
+	if s.Regex != nil && len(*s.Regex) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Regex", 1))
+	}
+	if s.Suffix != nil && len(*s.Suffix) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Suffix", 1))
+	}
+	if s.Range != nil {
+		if err := s.Range.Validate(); err != nil {
+			invalidParams.AddNested("Range", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetExact sets the Exact field's value.
+func (s *HeaderMatchMethod) SetExact(v string) *HeaderMatchMethod {
+	s.Exact = &v
+	return s
+}
+
+// SetPrefix sets the Prefix field's value.
+func (s *HeaderMatchMethod) SetPrefix(v string) *HeaderMatchMethod {
+	s.Prefix = &v
+	return s
+}
+
+// SetRange sets the Range field's value.
+func (s *HeaderMatchMethod) SetRange(v *MatchRange) *HeaderMatchMethod {
+	s.Range = v
+	return s
+}
+
+// SetRegex sets the Regex field's value.
+func (s *HeaderMatchMethod) SetRegex(v string) *HeaderMatchMethod {
+	s.Regex = &v
+	return s
+}
+
+// SetSuffix sets the Suffix field's value.
+func (s *HeaderMatchMethod) SetSuffix(v string) *HeaderMatchMethod {
+	s.Suffix = &v
+	return s
+}
+
+// An object that represents the health check policy for a virtual node's listener.
+type HealthCheckPolicy struct {
+	_ struct{} `type:"structure"`
+
+	// The number of consecutive successful health checks that must occur before
+	// declaring listener healthy.
+	//
+	// HealthyThreshold is a required field
+	HealthyThreshold *int64 `locationName:"healthyThreshold" min:"2" type:"integer" required:"true"`
+
+	// The time period in milliseconds between each health check execution.
+	//
+	// IntervalMillis is a required field
+	IntervalMillis *int64 `locationName:"intervalMillis" min:"5000" type:"long" required:"true"`
+
+	// The destination path for the health check request. This value is only used
+	// if the specified protocol is HTTP or HTTP/2. For any other protocol, this
+	// value is ignored.
+	Path *string `locationName:"path" type:"string"`
+
+	// The destination port for the health check request. This port must match the
+	// port defined in the PortMapping for the listener.
+	Port *int64 `locationName:"port" min:"1" type:"integer"`
+
+	// The protocol for the health check request. If you specify grpc, then your
+	// service must conform to the GRPC Health Checking Protocol (https://github.com/grpc/grpc/blob/master/doc/health-checking.md).
+	//
+	// Protocol is a required field
+	Protocol *string `locationName:"protocol" type:"string" required:"true" enum:"PortProtocol"`
+
+	// The amount of time to wait when receiving a response from the health check,
+	// in milliseconds.
+	//
+	// TimeoutMillis is a required field
+	TimeoutMillis *int64 `locationName:"timeoutMillis" min:"2000" type:"long" required:"true"`
+
+	// The number of consecutive failed health checks that must occur before declaring
+	// a virtual node unhealthy.
+	//
+	// UnhealthyThreshold is a required field
+	UnhealthyThreshold *int64 `locationName:"unhealthyThreshold" min:"2" type:"integer" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HealthCheckPolicy) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HealthCheckPolicy) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *HealthCheckPolicy) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "HealthCheckPolicy"}
+	if s.HealthyThreshold == nil {
+		invalidParams.Add(request.NewErrParamRequired("HealthyThreshold"))
+	}
+	if s.HealthyThreshold != nil && *s.HealthyThreshold < 2 {
+		invalidParams.Add(request.NewErrParamMinValue("HealthyThreshold", 2))
+	}
+	if s.IntervalMillis == nil {
+		invalidParams.Add(request.NewErrParamRequired("IntervalMillis"))
+	}
+	if s.IntervalMillis != nil && *s.IntervalMillis < 5000 {
+		invalidParams.Add(request.NewErrParamMinValue("IntervalMillis", 5000))
+	}
+	if s.Port != nil && *s.Port < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Port", 1))
+	}
+	if s.Protocol == nil {
+		invalidParams.Add(request.NewErrParamRequired("Protocol"))
+	}
+	if s.TimeoutMillis == nil {
+		invalidParams.Add(request.NewErrParamRequired("TimeoutMillis"))
+	}
+	if s.TimeoutMillis != nil && *s.TimeoutMillis < 2000 {
+		invalidParams.Add(request.NewErrParamMinValue("TimeoutMillis", 2000))
+	}
+	if s.UnhealthyThreshold == nil {
+		invalidParams.Add(request.NewErrParamRequired("UnhealthyThreshold"))
+	}
+	if s.UnhealthyThreshold != nil && *s.UnhealthyThreshold < 2 {
+		invalidParams.Add(request.NewErrParamMinValue("UnhealthyThreshold", 2))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetHealthyThreshold sets the HealthyThreshold field's value.
+func (s *HealthCheckPolicy) SetHealthyThreshold(v int64) *HealthCheckPolicy {
+	s.HealthyThreshold = &v
+	return s
+}
+
+// SetIntervalMillis sets the IntervalMillis field's value.
+func (s *HealthCheckPolicy) SetIntervalMillis(v int64) *HealthCheckPolicy {
+	s.IntervalMillis = &v
+	return s
+}
+
+// SetPath sets the Path field's value.
+func (s *HealthCheckPolicy) SetPath(v string) *HealthCheckPolicy {
+	s.Path = &v
+	return s
+}
+
+// SetPort sets the Port field's value.
+func (s *HealthCheckPolicy) SetPort(v int64) *HealthCheckPolicy {
+	s.Port = &v
+	return s
+}
+
+// SetProtocol sets the Protocol field's value.
+func (s *HealthCheckPolicy) SetProtocol(v string) *HealthCheckPolicy {
+	s.Protocol = &v
+	return s
+}
+
+// SetTimeoutMillis sets the TimeoutMillis field's value.
+func (s *HealthCheckPolicy) SetTimeoutMillis(v int64) *HealthCheckPolicy {
+	s.TimeoutMillis = &v
+	return s
+}
+
+// SetUnhealthyThreshold sets the UnhealthyThreshold field's value.
+func (s *HealthCheckPolicy) SetUnhealthyThreshold(v int64) *HealthCheckPolicy {
+	s.UnhealthyThreshold = &v
+	return s
+}
+
+// An object that represents an HTTP gateway route.
+type HttpGatewayRoute struct {
+	_ struct{} `type:"structure"`
+
+	// An object that represents the action to take if a match is determined.
+	//
+	// Action is a required field
+	Action *HttpGatewayRouteAction `locationName:"action" type:"structure" required:"true"`
+
+	// An object that represents the criteria for determining a request match.
+	//
+	// Match is a required field
+	Match *HttpGatewayRouteMatch `locationName:"match" type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpGatewayRoute) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpGatewayRoute) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *HttpGatewayRoute) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "HttpGatewayRoute"}
+	if s.Action == nil {
+		invalidParams.Add(request.NewErrParamRequired("Action"))
+	}
+	if s.Match == nil {
+		invalidParams.Add(request.NewErrParamRequired("Match"))
+	}
+	if s.Action != nil {
+		if err := s.Action.Validate(); err != nil {
+			invalidParams.AddNested("Action", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Match != nil {
+		if err := s.Match.Validate(); err != nil {
+			invalidParams.AddNested("Match", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAction sets the Action field's value.
+func (s *HttpGatewayRoute) SetAction(v *HttpGatewayRouteAction) *HttpGatewayRoute {
+	s.Action = v
+	return s
+}
+
+// SetMatch sets the Match field's value.
+func (s *HttpGatewayRoute) SetMatch(v *HttpGatewayRouteMatch) *HttpGatewayRoute {
+	s.Match = v
+	return s
+}
+
+// An object that represents the action to take if a match is determined.
+type HttpGatewayRouteAction struct {
+	_ struct{} `type:"structure"`
+
+	// The gateway route action to rewrite.
+	Rewrite *HttpGatewayRouteRewrite `locationName:"rewrite" type:"structure"`
+
+	// An object that represents the target that traffic is routed to when a request
+	// matches the gateway route.
+	//
+	// Target is a required field
+	Target *GatewayRouteTarget `locationName:"target" type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpGatewayRouteAction) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpGatewayRouteAction) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *HttpGatewayRouteAction) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "HttpGatewayRouteAction"}
+	if s.Target == nil {
+		invalidParams.Add(request.NewErrParamRequired("Target"))
+	}
+	if s.Rewrite != nil {
+		if err := s.Rewrite.Validate(); err != nil {
+			invalidParams.AddNested("Rewrite", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Target != nil {
+		if err := s.Target.Validate(); err != nil {
+			invalidParams.AddNested("Target", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetRewrite sets the Rewrite field's value.
+func (s *HttpGatewayRouteAction) SetRewrite(v *HttpGatewayRouteRewrite) *HttpGatewayRouteAction {
+	s.Rewrite = v
+	return s
+}
+
+// SetTarget sets the Target field's value.
+func (s *HttpGatewayRouteAction) SetTarget(v *GatewayRouteTarget) *HttpGatewayRouteAction {
+	s.Target = v
+	return s
+}
+
+// An object that represents the HTTP header in the gateway route.
+type HttpGatewayRouteHeader struct {
+	_ struct{} `type:"structure"`
+
+	// Specify True to match anything except the match criteria. The default value
+	// is False.
+	Invert *bool `locationName:"invert" type:"boolean"`
+
+	// An object that represents the method and value to match with the header value
+	// sent in a request. Specify one match method.
+	Match *HeaderMatchMethod `locationName:"match" type:"structure"`
+
+	// A name for the HTTP header in the gateway route that will be matched on.
+	//
+	// Name is a required field
+	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpGatewayRouteHeader) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpGatewayRouteHeader) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *HttpGatewayRouteHeader) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "HttpGatewayRouteHeader"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+	if s.Match != nil {
+		if err := s.Match.Validate(); err != nil {
+			invalidParams.AddNested("Match", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetInvert sets the Invert field's value.
+func (s *HttpGatewayRouteHeader) SetInvert(v bool) *HttpGatewayRouteHeader {
+	s.Invert = &v
+	return s
+}
+
+// SetMatch sets the Match field's value.
+func (s *HttpGatewayRouteHeader) SetMatch(v *HeaderMatchMethod) *HttpGatewayRouteHeader {
+	s.Match = v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *HttpGatewayRouteHeader) SetName(v string) *HttpGatewayRouteHeader {
+	s.Name = &v
+	return s
+}
+
+// An object that represents the criteria for determining a request match.
+type HttpGatewayRouteMatch struct {
+	_ struct{} `type:"structure"`
+
+	// The client request headers to match on.
+	Headers []*HttpGatewayRouteHeader `locationName:"headers" min:"1" type:"list"`
+
+	// The host name to match on.
+	Hostname *GatewayRouteHostnameMatch `locationName:"hostname" type:"structure"`
+
+	// The method to match on.
+	Method *string `locationName:"method" type:"string" enum:"HttpMethod"`
+
+	// The path to match on.
+	Path *HttpPathMatch `locationName:"path" type:"structure"`
+
+	// The port number to match on.
+	Port *int64 `locationName:"port" min:"1" type:"integer"`
+
+	// Specifies the path to match requests with. This parameter must always start
+	// with /, which by itself matches all requests to the virtual service name.
+	// You can also match for path-based routing of requests. For example, if your
+	// virtual service name is my-service.local and you want the route to match
+	// requests to my-service.local/metrics, your prefix should be /metrics.
+	Prefix *string `locationName:"prefix" type:"string"`
+
+	// The query parameter to match on.
+	QueryParameters []*HttpQueryParameter `locationName:"queryParameters" min:"1" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpGatewayRouteMatch) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpGatewayRouteMatch) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *HttpGatewayRouteMatch) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "HttpGatewayRouteMatch"}
+	if s.Headers != nil && len(s.Headers) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Headers", 1))
+	}
+	if s.Port != nil && *s.Port < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Port", 1))
+	}
+	if s.QueryParameters != nil && len(s.QueryParameters) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("QueryParameters", 1))
+	}
+	if s.Headers != nil {
+		for i, v := range s.Headers {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Headers", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Hostname != nil {
+		if err := s.Hostname.Validate(); err != nil {
+			invalidParams.AddNested("Hostname", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Path != nil {
+		if err := s.Path.Validate(); err != nil {
+			invalidParams.AddNested("Path", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.QueryParameters != nil {
+		for i, v := range s.QueryParameters {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "QueryParameters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetHeaders sets the Headers field's value.
+func (s *HttpGatewayRouteMatch) SetHeaders(v []*HttpGatewayRouteHeader) *HttpGatewayRouteMatch {
+	s.Headers = v
+	return s
+}
+
+// SetHostname sets the Hostname field's value.
+func (s *HttpGatewayRouteMatch) SetHostname(v *GatewayRouteHostnameMatch) *HttpGatewayRouteMatch {
+	s.Hostname = v
+	return s
+}
+
+// SetMethod sets the Method field's value.
+func (s *HttpGatewayRouteMatch) SetMethod(v string) *HttpGatewayRouteMatch {
+	s.Method = &v
+	return s
+}
+
+// SetPath sets the Path field's value.
+func (s *HttpGatewayRouteMatch) SetPath(v *HttpPathMatch) *HttpGatewayRouteMatch {
+	s.Path = v
+	return s
+}
+
+// SetPort sets the Port field's value.
+func (s *HttpGatewayRouteMatch) SetPort(v int64) *HttpGatewayRouteMatch {
+	s.Port = &v
+	return s
+}
+
+// SetPrefix sets the Prefix field's value.
+func (s *HttpGatewayRouteMatch) SetPrefix(v string) *HttpGatewayRouteMatch {
+	s.Prefix = &v
+	return s
+}
+
+// SetQueryParameters sets the QueryParameters field's value.
+func (s *HttpGatewayRouteMatch) SetQueryParameters(v []*HttpQueryParameter) *HttpGatewayRouteMatch {
+	s.QueryParameters = v
+	return s
+}
+
+// An object that represents the path to rewrite.
+type HttpGatewayRoutePathRewrite struct {
+	_ struct{} `type:"structure"`
+
+	// The exact path to rewrite.
+	Exact *string `locationName:"exact" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpGatewayRoutePathRewrite) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpGatewayRoutePathRewrite) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *HttpGatewayRoutePathRewrite) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "HttpGatewayRoutePathRewrite"}
+	if s.Exact != nil && len(*s.Exact) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Exact", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetExact sets the Exact field's value.
+func (s *HttpGatewayRoutePathRewrite) SetExact(v string) *HttpGatewayRoutePathRewrite {
+	s.Exact = &v
+	return s
+}
+
+// An object representing the beginning characters of the route to rewrite.
+type HttpGatewayRoutePrefixRewrite struct {
+	_ struct{} `type:"structure"`
+
+	// The default prefix used to replace the incoming route prefix when rewritten.
+	DefaultPrefix *string `locationName:"defaultPrefix" type:"string" enum:"DefaultGatewayRouteRewrite"`
+
+	// The value used to replace the incoming route prefix when rewritten.
+	Value *string `locationName:"value" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpGatewayRoutePrefixRewrite) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpGatewayRoutePrefixRewrite) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *HttpGatewayRoutePrefixRewrite) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "HttpGatewayRoutePrefixRewrite"}
+	if s.Value != nil && len(*s.Value) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Value", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDefaultPrefix sets the DefaultPrefix field's value.
+func (s *HttpGatewayRoutePrefixRewrite) SetDefaultPrefix(v string) *HttpGatewayRoutePrefixRewrite {
+	s.DefaultPrefix = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *HttpGatewayRoutePrefixRewrite) SetValue(v string) *HttpGatewayRoutePrefixRewrite {
+	s.Value = &v
+	return s
+}
+
+// An object representing the gateway route to rewrite.
+type HttpGatewayRouteRewrite struct {
+	_ struct{} `type:"structure"`
+
+	// The host name to rewrite.
+	Hostname *GatewayRouteHostnameRewrite `locationName:"hostname" type:"structure"`
+
+	// The path to rewrite.
+	Path *HttpGatewayRoutePathRewrite `locationName:"path" type:"structure"`
+
+	// The specified beginning characters to rewrite.
+	Prefix *HttpGatewayRoutePrefixRewrite `locationName:"prefix" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpGatewayRouteRewrite) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpGatewayRouteRewrite) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *HttpGatewayRouteRewrite) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "HttpGatewayRouteRewrite"}
+	if s.Path != nil {
+		if err := s.Path.Validate(); err != nil {
+			invalidParams.AddNested("Path", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Prefix != nil {
+		if err := s.Prefix.Validate(); err != nil {
+			invalidParams.AddNested("Prefix", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetHostname sets the Hostname field's value.
+func (s *HttpGatewayRouteRewrite) SetHostname(v *GatewayRouteHostnameRewrite) *HttpGatewayRouteRewrite {
+	s.Hostname = v
+	return s
+}
+
+// SetPath sets the Path field's value.
+func (s *HttpGatewayRouteRewrite) SetPath(v *HttpGatewayRoutePathRewrite) *HttpGatewayRouteRewrite {
+	s.Path = v
+	return s
+}
+
+// SetPrefix sets the Prefix field's value.
+func (s *HttpGatewayRouteRewrite) SetPrefix(v *HttpGatewayRoutePrefixRewrite) *HttpGatewayRouteRewrite {
+	s.Prefix = v
+	return s
+}
+
+// An object representing the path to match in the request.
+type HttpPathMatch struct {
+	_ struct{} `type:"structure"`
+
+	// The exact path to match on.
+	Exact *string `locationName:"exact" min:"1" type:"string"`
+
+	// The regex used to match the path.
+	Regex *string `locationName:"regex" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpPathMatch) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpPathMatch) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *HttpPathMatch) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "HttpPathMatch"}
+	if s.Exact != nil && len(*s.Exact) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Exact", 1))
+	}
+	if s.Regex != nil && len(*s.Regex) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Regex", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetExact sets the Exact field's value.
+func (s *HttpPathMatch) SetExact(v string) *HttpPathMatch {
+	s.Exact = &v
+	return s
+}
+
+// SetRegex sets the Regex field's value.
+func (s *HttpPathMatch) SetRegex(v string) *HttpPathMatch {
+	s.Regex = &v
+	return s
+}
+
+// An object that represents the query parameter in the request.
+type HttpQueryParameter struct {
+	_ struct{} `type:"structure"`
+
+	// The query parameter to match on.
+	Match *QueryParameterMatch `locationName:"match" type:"structure"`
+
+	// A name for the query parameter that will be matched on.
+	//
+	// Name is a required field
+	Name *string `locationName:"name" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpQueryParameter) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpQueryParameter) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *HttpQueryParameter) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "HttpQueryParameter"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetMatch sets the Match field's value.
+func (s *HttpQueryParameter) SetMatch(v *QueryParameterMatch) *HttpQueryParameter {
+	s.Match = v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *HttpQueryParameter) SetName(v string) *HttpQueryParameter {
+	s.Name = &v
+	return s
+}
+
+// An object that represents a retry policy. Specify at least one value for
+// at least one of the types of RetryEvents, a value for maxRetries, and a value
+// for perRetryTimeout. Both server-error and gateway-error under httpRetryEvents
+// include the Envoy reset policy. For more information on the reset policy,
+// see the Envoy documentation (https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/router_filter#x-envoy-retry-on).
+type HttpRetryPolicy struct {
+	_ struct{} `type:"structure"`
+
+	// Specify at least one of the following values.
+	//
+	//    * server-error – HTTP status codes 500, 501, 502, 503, 504, 505, 506,
+	//    507, 508, 510, and 511
+	//
+	//    * gateway-error – HTTP status codes 502, 503, and 504
+	//
+	//    * client-error – HTTP status code 409
+	//
+	//    * stream-error – Retry on refused stream
+	HttpRetryEvents []*string `locationName:"httpRetryEvents" min:"1" type:"list"`
+
+	// The maximum number of retry attempts.
+	//
+	// MaxRetries is a required field
+	MaxRetries *int64 `locationName:"maxRetries" type:"long" required:"true"`
+
+	// The timeout for each retry attempt.
+	//
+	// PerRetryTimeout is a required field
+	PerRetryTimeout *Duration `locationName:"perRetryTimeout" type:"structure" required:"true"`
+
+	// Specify a valid value. The event occurs before any processing of a request
+	// has started and is encountered when the upstream is temporarily or permanently
+	// unavailable.
+	TcpRetryEvents []*string `locationName:"tcpRetryEvents" min:"1" type:"list" enum:"TcpRetryPolicyEvent"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpRetryPolicy) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpRetryPolicy) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *HttpRetryPolicy) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "HttpRetryPolicy"}
+	if s.HttpRetryEvents != nil && len(s.HttpRetryEvents) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("HttpRetryEvents", 1))
+	}
+	if s.MaxRetries == nil {
+		invalidParams.Add(request.NewErrParamRequired("MaxRetries"))
+	}
+	if s.PerRetryTimeout == nil {
+		invalidParams.Add(request.NewErrParamRequired("PerRetryTimeout"))
+	}
+	if s.TcpRetryEvents != nil && len(s.TcpRetryEvents) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("TcpRetryEvents", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetHttpRetryEvents sets the HttpRetryEvents field's value.
+func (s *HttpRetryPolicy) SetHttpRetryEvents(v []*string) *HttpRetryPolicy {
+	s.HttpRetryEvents = v
+	return s
+}
+
+// SetMaxRetries sets the MaxRetries field's value.
+func (s *HttpRetryPolicy) SetMaxRetries(v int64) *HttpRetryPolicy {
+	s.MaxRetries = &v
+	return s
+}
+
+// SetPerRetryTimeout sets the PerRetryTimeout field's value.
+func (s *HttpRetryPolicy) SetPerRetryTimeout(v *Duration) *HttpRetryPolicy {
+	s.PerRetryTimeout = v
+	return s
+}
+
+// SetTcpRetryEvents sets the TcpRetryEvents field's value.
+func (s *HttpRetryPolicy) SetTcpRetryEvents(v []*string) *HttpRetryPolicy {
+	s.TcpRetryEvents = v
+	return s
+}
+
+// An object that represents an HTTP or HTTP/2 route type.
+type HttpRoute struct {
+	_ struct{} `type:"structure"`
+
+	// An object that represents the action to take if a match is determined.
+	//
+	// Action is a required field
+	Action *HttpRouteAction `locationName:"action" type:"structure" required:"true"`
+
+	// An object that represents the criteria for determining a request match.
+	//
+	// Match is a required field
+	Match *HttpRouteMatch `locationName:"match" type:"structure" required:"true"`
+
+	// An object that represents a retry policy.
+	RetryPolicy *HttpRetryPolicy `locationName:"retryPolicy" type:"structure"`
+
+	// An object that represents types of timeouts.
+	Timeout *HttpTimeout `locationName:"timeout" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpRoute) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpRoute) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *HttpRoute) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "HttpRoute"}
+	if s.Action == nil {
+		invalidParams.Add(request.NewErrParamRequired("Action"))
+	}
+	if s.Match == nil {
+		invalidParams.Add(request.NewErrParamRequired("Match"))
+	}
+	if s.Action != nil {
+		if err := s.Action.Validate(); err != nil {
+			invalidParams.AddNested("Action", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Match != nil {
+		if err := s.Match.Validate(); err != nil {
+			invalidParams.AddNested("Match", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.RetryPolicy != nil {
+		if err := s.RetryPolicy.Validate(); err != nil {
+			invalidParams.AddNested("RetryPolicy", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAction sets the Action field's value.
+func (s *HttpRoute) SetAction(v *HttpRouteAction) *HttpRoute {
+	s.Action = v
+	return s
+}
+
+// SetMatch sets the Match field's value.
+func (s *HttpRoute) SetMatch(v *HttpRouteMatch) *HttpRoute {
+	s.Match = v
+	return s
+}
+
+// SetRetryPolicy sets the RetryPolicy field's value.
+func (s *HttpRoute) SetRetryPolicy(v *HttpRetryPolicy) *HttpRoute {
+	s.RetryPolicy = v
+	return s
+}
+
+// SetTimeout sets the Timeout field's value.
+func (s *HttpRoute) SetTimeout(v *HttpTimeout) *HttpRoute {
+	s.Timeout = v
+	return s
+}
+
+// An object that represents the action to take if a match is determined.
+type HttpRouteAction struct {
+	_ struct{} `type:"structure"`
+
+	// An object that represents the targets that traffic is routed to when a request
+	// matches the route.
+	//
+	// WeightedTargets is a required field
+	WeightedTargets []*WeightedTarget `locationName:"weightedTargets" min:"1" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpRouteAction) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpRouteAction) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *HttpRouteAction) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "HttpRouteAction"}
+	if s.WeightedTargets == nil {
+		invalidParams.Add(request.NewErrParamRequired("WeightedTargets"))
+	}
+	if s.WeightedTargets != nil && len(s.WeightedTargets) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("WeightedTargets", 1))
+	}
+	if s.WeightedTargets != nil {
+		for i, v := range s.WeightedTargets {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "WeightedTargets", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetWeightedTargets sets the WeightedTargets field's value.
+func (s *HttpRouteAction) SetWeightedTargets(v []*WeightedTarget) *HttpRouteAction {
+	s.WeightedTargets = v
+	return s
+}
+
+// An object that represents the HTTP header in the request.
+type HttpRouteHeader struct {
+	_ struct{} `type:"structure"`
+
+	// Specify True to match anything except the match criteria. The default value
+	// is False.
+	Invert *bool `locationName:"invert" type:"boolean"`
+
+	// The HeaderMatchMethod object.
+	Match *HeaderMatchMethod `locationName:"match" type:"structure"`
+
+	// A name for the HTTP header in the client request that will be matched on.
+	//
+	// Name is a required field
+	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpRouteHeader) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpRouteHeader) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *HttpRouteHeader) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "HttpRouteHeader"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+	if s.Match != nil {
+		if err := s.Match.Validate(); err != nil {
+			invalidParams.AddNested("Match", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetInvert sets the Invert field's value.
+func (s *HttpRouteHeader) SetInvert(v bool) *HttpRouteHeader {
+	s.Invert = &v
+	return s
+}
+
+// SetMatch sets the Match field's value.
+func (s *HttpRouteHeader) SetMatch(v *HeaderMatchMethod) *HttpRouteHeader {
+	s.Match = v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *HttpRouteHeader) SetName(v string) *HttpRouteHeader {
+	s.Name = &v
+	return s
+}
+
+// An object that represents the requirements for a route to match HTTP requests
+// for a virtual router.
+type HttpRouteMatch struct {
+	_ struct{} `type:"structure"`
+
+	// The client request headers to match on.
+	Headers []*HttpRouteHeader `locationName:"headers" min:"1" type:"list"`
+
+	// The client request method to match on. Specify only one.
+	Method *string `locationName:"method" type:"string" enum:"HttpMethod"`
+
+	// The client request path to match on.
+	Path *HttpPathMatch `locationName:"path" type:"structure"`
+
+	// The port number to match on.
+	Port *int64 `locationName:"port" min:"1" type:"integer"`
+
+	// Specifies the path to match requests with. This parameter must always start
+	// with /, which by itself matches all requests to the virtual service name.
+	// You can also match for path-based routing of requests. For example, if your
+	// virtual service name is my-service.local and you want the route to match
+	// requests to my-service.local/metrics, your prefix should be /metrics.
+	Prefix *string `locationName:"prefix" type:"string"`
+
+	// The client request query parameters to match on.
+	QueryParameters []*HttpQueryParameter `locationName:"queryParameters" min:"1" type:"list"`
+
+	// The client request scheme to match on. Specify only one. Applicable only
+	// for HTTP2 routes.
+	Scheme *string `locationName:"scheme" type:"string" enum:"HttpScheme"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpRouteMatch) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpRouteMatch) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *HttpRouteMatch) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "HttpRouteMatch"}
+	if s.Headers != nil && len(s.Headers) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Headers", 1))
+	}
+	if s.Port != nil && *s.Port < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Port", 1))
+	}
+	if s.QueryParameters != nil && len(s.QueryParameters) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("QueryParameters", 1))
+	}
+	if s.Headers != nil {
+		for i, v := range s.Headers {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Headers", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Path != nil {
+		if err := s.Path.Validate(); err != nil {
+			invalidParams.AddNested("Path", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.QueryParameters != nil {
+		for i, v := range s.QueryParameters {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "QueryParameters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetHeaders sets the Headers field's value.
+func (s *HttpRouteMatch) SetHeaders(v []*HttpRouteHeader) *HttpRouteMatch {
+	s.Headers = v
+	return s
+}
+
+// SetMethod sets the Method field's value.
+func (s *HttpRouteMatch) SetMethod(v string) *HttpRouteMatch {
+	s.Method = &v
+	return s
+}
+
+// SetPath sets the Path field's value.
+func (s *HttpRouteMatch) SetPath(v *HttpPathMatch) *HttpRouteMatch {
+	s.Path = v
+	return s
+}
+
+// SetPort sets the Port field's value.
+func (s *HttpRouteMatch) SetPort(v int64) *HttpRouteMatch {
+	s.Port = &v
+	return s
+}
+
+// SetPrefix sets the Prefix field's value.
+func (s *HttpRouteMatch) SetPrefix(v string) *HttpRouteMatch {
+	s.Prefix = &v
+	return s
+}
+
+// SetQueryParameters sets the QueryParameters field's value.
+func (s *HttpRouteMatch) SetQueryParameters(v []*HttpQueryParameter) *HttpRouteMatch {
+	s.QueryParameters = v
+	return s
+}
+
+// SetScheme sets the Scheme field's value.
+func (s *HttpRouteMatch) SetScheme(v string) *HttpRouteMatch {
+	s.Scheme = &v
+	return s
+}
+
+// An object that represents types of timeouts.
+type HttpTimeout struct {
+	_ struct{} `type:"structure"`
+
+	// An object that represents an idle timeout. An idle timeout bounds the amount
+	// of time that a connection may be idle. The default value is none.
+	Idle *Duration `locationName:"idle" type:"structure"`
+
+	// An object that represents a per request timeout. The default value is 15
+	// seconds. If you set a higher timeout, then make sure that the higher value
+	// is set for each App Mesh resource in a conversation. For example, if a virtual
+	// node backend uses a virtual router provider to route to another virtual node,
+	// then the timeout should be greater than 15 seconds for the source and destination
+	// virtual node and the route.
+	PerRequest *Duration `locationName:"perRequest" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpTimeout) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpTimeout) GoString() string {
+	return s.String()
+}
+
+// SetIdle sets the Idle field's value.
+func (s *HttpTimeout) SetIdle(v *Duration) *HttpTimeout {
+	s.Idle = v
+	return s
+}
+
+// SetPerRequest sets the PerRequest field's value.
+func (s *HttpTimeout) SetPerRequest(v *Duration) *HttpTimeout {
+	s.PerRequest = v
+	return s
+}
+
+// The request processing has failed because of an unknown error, exception,
+// or failure.
+type InternalServerErrorException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InternalServerErrorException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InternalServerErrorException) GoString() string {
+	return s.String()
+}
+
+func newErrorInternalServerErrorException(v protocol.ResponseMetadata) error {
+	return &InternalServerErrorException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InternalServerErrorException) Code() string {
+	return "InternalServerErrorException"
+}
+
+// Message returns the exception's message.
+func (s *InternalServerErrorException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InternalServerErrorException) OrigErr() error {
+	return nil
+}
+
+func (s *InternalServerErrorException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InternalServerErrorException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InternalServerErrorException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// An object that represents the key value pairs for the JSON.
+type JsonFormatRef struct {
+	_ struct{} `type:"structure"`
+
+	// The specified key for the JSON.
+	//
+	// Key is a required field
+	Key *string `locationName:"key" min:"1" type:"string" required:"true"`
+
+	// The specified value for the JSON.
+	//
+	// Value is a required field
+	Value *string `locationName:"value" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s JsonFormatRef) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s JsonFormatRef) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *JsonFormatRef) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "JsonFormatRef"}
+	if s.Key == nil {
+		invalidParams.Add(request.NewErrParamRequired("Key"))
+	}
+	if s.Key != nil && len(*s.Key) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Key", 1))
+	}
+	if s.Value == nil {
+		invalidParams.Add(request.NewErrParamRequired("Value"))
+	}
+	if s.Value != nil && len(*s.Value) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Value", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetKey sets the Key field's value.
+func (s *JsonFormatRef) SetKey(v string) *JsonFormatRef {
+	s.Key = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *JsonFormatRef) SetValue(v string) *JsonFormatRef {
+	s.Value = &v
+	return s
+}
+
+// You have exceeded a service limit for your account. For more information,
+// see Service Limits (https://docs.aws.amazon.com/app-mesh/latest/userguide/service-quotas.html)
+// in the App Mesh User Guide.
+type LimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) GoString() string {
+	return s.String()
+}
+
+func newErrorLimitExceededException(v protocol.ResponseMetadata) error {
+	return &LimitExceededException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *LimitExceededException) Code() string {
+	return "LimitExceededException"
+}
+
+// Message returns the exception's message.
+func (s *LimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *LimitExceededException) OrigErr() error {
+	return nil
+}
+
+func (s *LimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *LimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *LimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type ListGatewayRoutesInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
+	// The maximum number of results returned by ListGatewayRoutes in paginated
+	// output. When you use this parameter, ListGatewayRoutes returns only limit
+	// results in a single page along with a nextToken response element. You can
+	// see the remaining results of the initial request by sending another ListGatewayRoutes
+	// request with the returned nextToken value. This value can be between 1 and
+	// 100. If you don't use this parameter, ListGatewayRoutes returns up to 100
+	// results and a nextToken value if applicable.
+	Limit *int64 `location:"querystring" locationName:"limit" min:"1" type:"integer"`
+
+	// The name of the service mesh to list gateway routes in.
+	//
+	// MeshName is a required field
+	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
+
+	// The nextToken value returned from a previous paginated ListGatewayRoutes
+	// request where limit was used and the results exceeded the value of that parameter.
+	// Pagination continues from the end of the previous results that returned the
+	// nextToken value.
+	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
+
+	// The name of the virtual gateway to list gateway routes in.
+	//
+	// VirtualGatewayName is a required field
+	VirtualGatewayName *string `location:"uri" locationName:"virtualGatewayName" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListGatewayRoutesInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListGatewayRoutesInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListGatewayRoutesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListGatewayRoutesInput"}
+	if s.Limit != nil && *s.Limit < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+	}
+	if s.MeshName == nil {
+		invalidParams.Add(request.NewErrParamRequired("MeshName"))
+	}
+	if s.MeshName != nil && len(*s.MeshName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
+	}
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
+	}
+	if s.VirtualGatewayName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualGatewayName"))
+	}
+	if s.VirtualGatewayName != nil && len(*s.VirtualGatewayName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VirtualGatewayName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetLimit sets the Limit field's value.
+func (s *ListGatewayRoutesInput) SetLimit(v int64) *ListGatewayRoutesInput {
+	s.Limit = &v
+	return s
+}
+
+// SetMeshName sets the MeshName field's value.
+func (s *ListGatewayRoutesInput) SetMeshName(v string) *ListGatewayRoutesInput {
+	s.MeshName = &v
+	return s
+}
+
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *ListGatewayRoutesInput) SetMeshOwner(v string) *ListGatewayRoutesInput {
+	s.MeshOwner = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListGatewayRoutesInput) SetNextToken(v string) *ListGatewayRoutesInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetVirtualGatewayName sets the VirtualGatewayName field's value.
+func (s *ListGatewayRoutesInput) SetVirtualGatewayName(v string) *ListGatewayRoutesInput {
+	s.VirtualGatewayName = &v
+	return s
+}
+
+type ListGatewayRoutesOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The list of existing gateway routes for the specified service mesh and virtual
+	// gateway.
+	//
+	// GatewayRoutes is a required field
+	GatewayRoutes []*GatewayRouteRef `locationName:"gatewayRoutes" type:"list" required:"true"`
+
+	// The nextToken value to include in a future ListGatewayRoutes request. When
+	// the results of a ListGatewayRoutes request exceed limit, you can use this
+	// value to retrieve the next page of results. This value is null when there
+	// are no more results to return.
+	NextToken *string `locationName:"nextToken" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListGatewayRoutesOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListGatewayRoutesOutput) GoString() string {
+	return s.String()
+}
+
+// SetGatewayRoutes sets the GatewayRoutes field's value.
+func (s *ListGatewayRoutesOutput) SetGatewayRoutes(v []*GatewayRouteRef) *ListGatewayRoutesOutput {
+	s.GatewayRoutes = v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListGatewayRoutesOutput) SetNextToken(v string) *ListGatewayRoutesOutput {
+	s.NextToken = &v
+	return s
+}
+
+type ListMeshesInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
+	// The maximum number of results returned by ListMeshes in paginated output.
+	// When you use this parameter, ListMeshes returns only limit results in a single
+	// page along with a nextToken response element. You can see the remaining results
+	// of the initial request by sending another ListMeshes request with the returned
+	// nextToken value. This value can be between 1 and 100. If you don't use this
+	// parameter, ListMeshes returns up to 100 results and a nextToken value if
+	// applicable.
+	Limit *int64 `location:"querystring" locationName:"limit" min:"1" type:"integer"`
+
+	// The nextToken value returned from a previous paginated ListMeshes request
+	// where limit was used and the results exceeded the value of that parameter.
+	// Pagination continues from the end of the previous results that returned the
+	// nextToken value.
+	//
+	// This token should be treated as an opaque identifier that is used only to
+	// retrieve the next items in a list and not for other programmatic purposes.
+	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListMeshesInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListMeshesInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListMeshesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListMeshesInput"}
+	if s.Limit != nil && *s.Limit < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetLimit sets the Limit field's value.
+func (s *ListMeshesInput) SetLimit(v int64) *ListMeshesInput {
+	s.Limit = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListMeshesInput) SetNextToken(v string) *ListMeshesInput {
+	s.NextToken = &v
+	return s
+}
+
+type ListMeshesOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The list of existing service meshes.
+	//
+	// Meshes is a required field
+	Meshes []*MeshRef `locationName:"meshes" type:"list" required:"true"`
+
+	// The nextToken value to include in a future ListMeshes request. When the results
+	// of a ListMeshes request exceed limit, you can use this value to retrieve
+	// the next page of results. This value is null when there are no more results
+	// to return.
+	NextToken *string `locationName:"nextToken" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListMeshesOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListMeshesOutput) GoString() string {
+	return s.String()
+}
+
+// SetMeshes sets the Meshes field's value.
+func (s *ListMeshesOutput) SetMeshes(v []*MeshRef) *ListMeshesOutput {
+	s.Meshes = v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListMeshesOutput) SetNextToken(v string) *ListMeshesOutput {
+	s.NextToken = &v
+	return s
+}
+
+type ListRoutesInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
+	// The maximum number of results returned by ListRoutes in paginated output.
+	// When you use this parameter, ListRoutes returns only limit results in a single
+	// page along with a nextToken response element. You can see the remaining results
+	// of the initial request by sending another ListRoutes request with the returned
+	// nextToken value. This value can be between 1 and 100. If you don't use this
+	// parameter, ListRoutes returns up to 100 results and a nextToken value if
+	// applicable.
+	Limit *int64 `location:"querystring" locationName:"limit" min:"1" type:"integer"`
+
+	// The name of the service mesh to list routes in.
+	//
+	// MeshName is a required field
+	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
+
+	// The nextToken value returned from a previous paginated ListRoutes request
+	// where limit was used and the results exceeded the value of that parameter.
+	// Pagination continues from the end of the previous results that returned the
+	// nextToken value.
+	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
+
+	// The name of the virtual router to list routes in.
+	//
+	// VirtualRouterName is a required field
+	VirtualRouterName *string `location:"uri" locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListRoutesInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListRoutesInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListRoutesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListRoutesInput"}
+	if s.Limit != nil && *s.Limit < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+	}
+	if s.MeshName == nil {
+		invalidParams.Add(request.NewErrParamRequired("MeshName"))
+	}
+	if s.MeshName != nil && len(*s.MeshName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
+	}
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
+	}
+	if s.VirtualRouterName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualRouterName"))
+	}
+	if s.VirtualRouterName != nil && len(*s.VirtualRouterName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VirtualRouterName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetLimit sets the Limit field's value.
+func (s *ListRoutesInput) SetLimit(v int64) *ListRoutesInput {
+	s.Limit = &v
+	return s
+}
+
+// SetMeshName sets the MeshName field's value.
+func (s *ListRoutesInput) SetMeshName(v string) *ListRoutesInput {
+	s.MeshName = &v
+	return s
+}
+
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *ListRoutesInput) SetMeshOwner(v string) *ListRoutesInput {
+	s.MeshOwner = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListRoutesInput) SetNextToken(v string) *ListRoutesInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetVirtualRouterName sets the VirtualRouterName field's value.
+func (s *ListRoutesInput) SetVirtualRouterName(v string) *ListRoutesInput {
+	s.VirtualRouterName = &v
+	return s
+}
+
+type ListRoutesOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The nextToken value to include in a future ListRoutes request. When the results
+	// of a ListRoutes request exceed limit, you can use this value to retrieve
+	// the next page of results. This value is null when there are no more results
+	// to return.
+	NextToken *string `locationName:"nextToken" type:"string"`
+
+	// The list of existing routes for the specified service mesh and virtual router.
+	//
+	// Routes is a required field
+	Routes []*RouteRef `locationName:"routes" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListRoutesOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListRoutesOutput) GoString() string {
+	return s.String()
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListRoutesOutput) SetNextToken(v string) *ListRoutesOutput {
+	s.NextToken = &v
+	return s
+}
+
+// SetRoutes sets the Routes field's value.
+func (s *ListRoutesOutput) SetRoutes(v []*RouteRef) *ListRoutesOutput {
+	s.Routes = v
+	return s
+}
+
+type ListTagsForResourceInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
+	// The maximum number of tag results returned by ListTagsForResource in paginated
+	// output. When this parameter is used, ListTagsForResource returns only limit
+	// results in a single page along with a nextToken response element. You can
+	// see the remaining results of the initial request by sending another ListTagsForResource
+	// request with the returned nextToken value. This value can be between 1 and
+	// 100. If you don't use this parameter, ListTagsForResource returns up to 100
+	// results and a nextToken value if applicable.
+	Limit *int64 `location:"querystring" locationName:"limit" min:"1" type:"integer"`
+
+	// The nextToken value returned from a previous paginated ListTagsForResource
+	// request where limit was used and the results exceeded the value of that parameter.
+	// Pagination continues from the end of the previous results that returned the
+	// nextToken value.
+	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
+
+	// The Amazon Resource Name (ARN) that identifies the resource to list the tags
+	// for.
+	//
+	// ResourceArn is a required field
+	ResourceArn *string `location:"querystring" locationName:"resourceArn" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListTagsForResourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsForResourceInput"}
+	if s.Limit != nil && *s.Limit < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+	}
+	if s.ResourceArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceArn"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetLimit sets the Limit field's value.
+func (s *ListTagsForResourceInput) SetLimit(v int64) *ListTagsForResourceInput {
+	s.Limit = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListTagsForResourceInput) SetNextToken(v string) *ListTagsForResourceInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetResourceArn sets the ResourceArn field's value.
+func (s *ListTagsForResourceInput) SetResourceArn(v string) *ListTagsForResourceInput {
+	s.ResourceArn = &v
+	return s
+}
+
+type ListTagsForResourceOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The nextToken value to include in a future ListTagsForResource request. When
+	// the results of a ListTagsForResource request exceed limit, you can use this
+	// value to retrieve the next page of results. This value is null when there
+	// are no more results to return.
+	NextToken *string `locationName:"nextToken" type:"string"`
+
+	// The tags for the resource.
+	//
+	// Tags is a required field
+	Tags []*TagRef `locationName:"tags" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceOutput) GoString() string {
+	return s.String()
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListTagsForResourceOutput) SetNextToken(v string) *ListTagsForResourceOutput {
+	s.NextToken = &v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *ListTagsForResourceOutput) SetTags(v []*TagRef) *ListTagsForResourceOutput {
+	s.Tags = v
+	return s
+}
+
+type ListVirtualGatewaysInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
+	// The maximum number of results returned by ListVirtualGateways in paginated
+	// output. When you use this parameter, ListVirtualGateways returns only limit
+	// results in a single page along with a nextToken response element. You can
+	// see the remaining results of the initial request by sending another ListVirtualGateways
+	// request with the returned nextToken value. This value can be between 1 and
+	// 100. If you don't use this parameter, ListVirtualGateways returns up to 100
+	// results and a nextToken value if applicable.
+	Limit *int64 `location:"querystring" locationName:"limit" min:"1" type:"integer"`
+
+	// The name of the service mesh to list virtual gateways in.
+	//
+	// MeshName is a required field
+	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
+
+	// The nextToken value returned from a previous paginated ListVirtualGateways
+	// request where limit was used and the results exceeded the value of that parameter.
+	// Pagination continues from the end of the previous results that returned the
+	// nextToken value.
+	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListVirtualGatewaysInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListVirtualGatewaysInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListVirtualGatewaysInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListVirtualGatewaysInput"}
+	if s.Limit != nil && *s.Limit < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+	}
+	if s.MeshName == nil {
+		invalidParams.Add(request.NewErrParamRequired("MeshName"))
+	}
+	if s.MeshName != nil && len(*s.MeshName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
+	}
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetLimit sets the Limit field's value.
+func (s *ListVirtualGatewaysInput) SetLimit(v int64) *ListVirtualGatewaysInput {
+	s.Limit = &v
+	return s
+}
+
+// SetMeshName sets the MeshName field's value.
+func (s *ListVirtualGatewaysInput) SetMeshName(v string) *ListVirtualGatewaysInput {
+	s.MeshName = &v
+	return s
+}
+
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *ListVirtualGatewaysInput) SetMeshOwner(v string) *ListVirtualGatewaysInput {
+	s.MeshOwner = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListVirtualGatewaysInput) SetNextToken(v string) *ListVirtualGatewaysInput {
+	s.NextToken = &v
+	return s
+}
+
+type ListVirtualGatewaysOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The nextToken value to include in a future ListVirtualGateways request. When
+	// the results of a ListVirtualGateways request exceed limit, you can use this
+	// value to retrieve the next page of results. This value is null when there
+	// are no more results to return.
+	NextToken *string `locationName:"nextToken" type:"string"`
+
+	// The list of existing virtual gateways for the specified service mesh.
+	//
+	// VirtualGateways is a required field
+	VirtualGateways []*VirtualGatewayRef `locationName:"virtualGateways" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListVirtualGatewaysOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListVirtualGatewaysOutput) GoString() string {
+	return s.String()
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListVirtualGatewaysOutput) SetNextToken(v string) *ListVirtualGatewaysOutput {
+	s.NextToken = &v
+	return s
+}
+
+// SetVirtualGateways sets the VirtualGateways field's value.
+func (s *ListVirtualGatewaysOutput) SetVirtualGateways(v []*VirtualGatewayRef) *ListVirtualGatewaysOutput {
+	s.VirtualGateways = v
+	return s
+}
+
+type ListVirtualNodesInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
+	// The maximum number of results returned by ListVirtualNodes in paginated output.
+	// When you use this parameter, ListVirtualNodes returns only limit results
+	// in a single page along with a nextToken response element. You can see the
+	// remaining results of the initial request by sending another ListVirtualNodes
+	// request with the returned nextToken value. This value can be between 1 and
+	// 100. If you don't use this parameter, ListVirtualNodes returns up to 100
+	// results and a nextToken value if applicable.
+	Limit *int64 `location:"querystring" locationName:"limit" min:"1" type:"integer"`
+
+	// The name of the service mesh to list virtual nodes in.
+	//
+	// MeshName is a required field
+	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
+
+	// The nextToken value returned from a previous paginated ListVirtualNodes request
+	// where limit was used and the results exceeded the value of that parameter.
+	// Pagination continues from the end of the previous results that returned the
+	// nextToken value.
+	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListVirtualNodesInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListVirtualNodesInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListVirtualNodesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListVirtualNodesInput"}
+	if s.Limit != nil && *s.Limit < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+	}
+	if s.MeshName == nil {
+		invalidParams.Add(request.NewErrParamRequired("MeshName"))
+	}
+	if s.MeshName != nil && len(*s.MeshName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
+	}
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetLimit sets the Limit field's value.
+func (s *ListVirtualNodesInput) SetLimit(v int64) *ListVirtualNodesInput {
+	s.Limit = &v
+	return s
+}
+
+// SetMeshName sets the MeshName field's value.
+func (s *ListVirtualNodesInput) SetMeshName(v string) *ListVirtualNodesInput {
+	s.MeshName = &v
+	return s
+}
+
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *ListVirtualNodesInput) SetMeshOwner(v string) *ListVirtualNodesInput {
+	s.MeshOwner = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListVirtualNodesInput) SetNextToken(v string) *ListVirtualNodesInput {
+	s.NextToken = &v
+	return s
+}
+
+type ListVirtualNodesOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The nextToken value to include in a future ListVirtualNodes request. When
+	// the results of a ListVirtualNodes request exceed limit, you can use this
+	// value to retrieve the next page of results. This value is null when there
+	// are no more results to return.
+	NextToken *string `locationName:"nextToken" type:"string"`
+
+	// The list of existing virtual nodes for the specified service mesh.
+	//
+	// VirtualNodes is a required field
+	VirtualNodes []*VirtualNodeRef `locationName:"virtualNodes" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListVirtualNodesOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListVirtualNodesOutput) GoString() string {
+	return s.String()
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListVirtualNodesOutput) SetNextToken(v string) *ListVirtualNodesOutput {
+	s.NextToken = &v
+	return s
+}
+
+// SetVirtualNodes sets the VirtualNodes field's value.
+func (s *ListVirtualNodesOutput) SetVirtualNodes(v []*VirtualNodeRef) *ListVirtualNodesOutput {
+	s.VirtualNodes = v
+	return s
+}
+
+type ListVirtualRoutersInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
+	// The maximum number of results returned by ListVirtualRouters in paginated
+	// output. When you use this parameter, ListVirtualRouters returns only limit
+	// results in a single page along with a nextToken response element. You can
+	// see the remaining results of the initial request by sending another ListVirtualRouters
+	// request with the returned nextToken value. This value can be between 1 and
+	// 100. If you don't use this parameter, ListVirtualRouters returns up to 100
+	// results and a nextToken value if applicable.
+	Limit *int64 `location:"querystring" locationName:"limit" min:"1" type:"integer"`
+
+	// The name of the service mesh to list virtual routers in.
+	//
+	// MeshName is a required field
+	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
+
+	// The nextToken value returned from a previous paginated ListVirtualRouters
+	// request where limit was used and the results exceeded the value of that parameter.
+	// Pagination continues from the end of the previous results that returned the
+	// nextToken value.
+	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListVirtualRoutersInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListVirtualRoutersInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListVirtualRoutersInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListVirtualRoutersInput"}
+	if s.Limit != nil && *s.Limit < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+	}
+	if s.MeshName == nil {
+		invalidParams.Add(request.NewErrParamRequired("MeshName"))
+	}
+	if s.MeshName != nil && len(*s.MeshName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
+	}
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetLimit sets the Limit field's value.
+func (s *ListVirtualRoutersInput) SetLimit(v int64) *ListVirtualRoutersInput {
+	s.Limit = &v
+	return s
+}
+
+// SetMeshName sets the MeshName field's value.
+func (s *ListVirtualRoutersInput) SetMeshName(v string) *ListVirtualRoutersInput {
+	s.MeshName = &v
+	return s
+}
+
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *ListVirtualRoutersInput) SetMeshOwner(v string) *ListVirtualRoutersInput {
+	s.MeshOwner = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListVirtualRoutersInput) SetNextToken(v string) *ListVirtualRoutersInput {
+	s.NextToken = &v
+	return s
+}
+
+type ListVirtualRoutersOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The nextToken value to include in a future ListVirtualRouters request. When
+	// the results of a ListVirtualRouters request exceed limit, you can use this
+	// value to retrieve the next page of results. This value is null when there
+	// are no more results to return.
+	NextToken *string `locationName:"nextToken" type:"string"`
+
+	// The list of existing virtual routers for the specified service mesh.
+	//
+	// VirtualRouters is a required field
+	VirtualRouters []*VirtualRouterRef `locationName:"virtualRouters" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListVirtualRoutersOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListVirtualRoutersOutput) GoString() string {
+	return s.String()
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListVirtualRoutersOutput) SetNextToken(v string) *ListVirtualRoutersOutput {
+	s.NextToken = &v
+	return s
+}
+
+// SetVirtualRouters sets the VirtualRouters field's value.
+func (s *ListVirtualRoutersOutput) SetVirtualRouters(v []*VirtualRouterRef) *ListVirtualRoutersOutput {
+	s.VirtualRouters = v
+	return s
+}
+
+type ListVirtualServicesInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
+	// The maximum number of results returned by ListVirtualServices in paginated
+	// output. When you use this parameter, ListVirtualServices returns only limit
+	// results in a single page along with a nextToken response element. You can
+	// see the remaining results of the initial request by sending another ListVirtualServices
+	// request with the returned nextToken value. This value can be between 1 and
+	// 100. If you don't use this parameter, ListVirtualServices returns up to 100
+	// results and a nextToken value if applicable.
+	Limit *int64 `location:"querystring" locationName:"limit" min:"1" type:"integer"`
+
+	// The name of the service mesh to list virtual services in.
+	//
+	// MeshName is a required field
+	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
+
+	// The nextToken value returned from a previous paginated ListVirtualServices
+	// request where limit was used and the results exceeded the value of that parameter.
+	// Pagination continues from the end of the previous results that returned the
+	// nextToken value.
+	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListVirtualServicesInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListVirtualServicesInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListVirtualServicesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListVirtualServicesInput"}
+	if s.Limit != nil && *s.Limit < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+	}
+	if s.MeshName == nil {
+		invalidParams.Add(request.NewErrParamRequired("MeshName"))
+	}
+	if s.MeshName != nil && len(*s.MeshName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
+	}
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetLimit sets the Limit field's value.
+func (s *ListVirtualServicesInput) SetLimit(v int64) *ListVirtualServicesInput {
+	s.Limit = &v
+	return s
+}
+
+// SetMeshName sets the MeshName field's value.
+func (s *ListVirtualServicesInput) SetMeshName(v string) *ListVirtualServicesInput {
+	s.MeshName = &v
+	return s
+}
+
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *ListVirtualServicesInput) SetMeshOwner(v string) *ListVirtualServicesInput {
+	s.MeshOwner = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListVirtualServicesInput) SetNextToken(v string) *ListVirtualServicesInput {
+	s.NextToken = &v
+	return s
+}
+
+type ListVirtualServicesOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The nextToken value to include in a future ListVirtualServices request. When
+	// the results of a ListVirtualServices request exceed limit, you can use this
+	// value to retrieve the next page of results. This value is null when there
+	// are no more results to return.
+	NextToken *string `locationName:"nextToken" type:"string"`
+
+	// The list of existing virtual services for the specified service mesh.
+	//
+	// VirtualServices is a required field
+	VirtualServices []*VirtualServiceRef `locationName:"virtualServices" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListVirtualServicesOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListVirtualServicesOutput) GoString() string {
+	return s.String()
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListVirtualServicesOutput) SetNextToken(v string) *ListVirtualServicesOutput {
+	s.NextToken = &v
+	return s
+}
+
+// SetVirtualServices sets the VirtualServices field's value.
+func (s *ListVirtualServicesOutput) SetVirtualServices(v []*VirtualServiceRef) *ListVirtualServicesOutput {
+	s.VirtualServices = v
+	return s
+}
+
+// An object that represents a listener for a virtual node.
+type Listener struct {
+	_ struct{} `type:"structure"`
+
+	// The connection pool information for the listener.
+	ConnectionPool *VirtualNodeConnectionPool `locationName:"connectionPool" type:"structure"`
+
+	// The health check information for the listener.
+	HealthCheck *HealthCheckPolicy `locationName:"healthCheck" type:"structure"`
+
+	// The outlier detection information for the listener.
+	OutlierDetection *OutlierDetection `locationName:"outlierDetection" type:"structure"`
+
+	// The port mapping information for the listener.
+	//
+	// PortMapping is a required field
+	PortMapping *PortMapping `locationName:"portMapping" type:"structure" required:"true"`
+
+	// An object that represents timeouts for different protocols.
+	Timeout *ListenerTimeout `locationName:"timeout" type:"structure"`
+
+	// A reference to an object that represents the Transport Layer Security (TLS)
+	// properties for a listener.
+	Tls *ListenerTls `locationName:"tls" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Listener) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Listener) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *Listener) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "Listener"}
+	if s.PortMapping == nil {
+		invalidParams.Add(request.NewErrParamRequired("PortMapping"))
+	}
+	if s.ConnectionPool != nil {
+		if err := s.ConnectionPool.Validate(); err != nil {
+			invalidParams.AddNested("ConnectionPool", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.HealthCheck != nil {
+		if err := s.HealthCheck.Validate(); err != nil {
+			invalidParams.AddNested("HealthCheck", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.OutlierDetection != nil {
+		if err := s.OutlierDetection.Validate(); err != nil {
+			invalidParams.AddNested("OutlierDetection", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.PortMapping != nil {
+		if err := s.PortMapping.Validate(); err != nil {
+			invalidParams.AddNested("PortMapping", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tls != nil {
+		if err := s.Tls.Validate(); err != nil {
+			invalidParams.AddNested("Tls", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetConnectionPool sets the ConnectionPool field's value.
+func (s *Listener) SetConnectionPool(v *VirtualNodeConnectionPool) *Listener {
+	s.ConnectionPool = v
+	return s
+}
+
+// SetHealthCheck sets the HealthCheck field's value.
+func (s *Listener) SetHealthCheck(v *HealthCheckPolicy) *Listener {
+	s.HealthCheck = v
+	return s
+}
+
+// SetOutlierDetection sets the OutlierDetection field's value.
+func (s *Listener) SetOutlierDetection(v *OutlierDetection) *Listener {
+	s.OutlierDetection = v
+	return s
+}
+
+// SetPortMapping sets the PortMapping field's value.
+func (s *Listener) SetPortMapping(v *PortMapping) *Listener {
+	s.PortMapping = v
+	return s
+}
+
+// SetTimeout sets the Timeout field's value.
+func (s *Listener) SetTimeout(v *ListenerTimeout) *Listener {
+	s.Timeout = v
+	return s
+}
+
+// SetTls sets the Tls field's value.
+func (s *Listener) SetTls(v *ListenerTls) *Listener {
+	s.Tls = v
+	return s
+}
+
+// An object that represents timeouts for different protocols.
+type ListenerTimeout struct {
+	_ struct{} `type:"structure"`
+
+	// An object that represents types of timeouts.
+	Grpc *GrpcTimeout `locationName:"grpc" type:"structure"`
+
+	// An object that represents types of timeouts.
+	Http *HttpTimeout `locationName:"http" type:"structure"`
+
+	// An object that represents types of timeouts.
+	Http2 *HttpTimeout `locationName:"http2" type:"structure"`
+
+	// An object that represents types of timeouts.
+	Tcp *TcpTimeout `locationName:"tcp" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListenerTimeout) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListenerTimeout) GoString() string {
+	return s.String()
+}
+
+// SetGrpc sets the Grpc field's value.
+func (s *ListenerTimeout) SetGrpc(v *GrpcTimeout) *ListenerTimeout {
+	s.Grpc = v
+	return s
+}
+
+// SetHttp sets the Http field's value.
+func (s *ListenerTimeout) SetHttp(v *HttpTimeout) *ListenerTimeout {
+	s.Http = v
+	return s
+}
+
+// SetHttp2 sets the Http2 field's value.
+func (s *ListenerTimeout) SetHttp2(v *HttpTimeout) *ListenerTimeout {
+	s.Http2 = v
+	return s
+}
+
+// SetTcp sets the Tcp field's value.
+func (s *ListenerTimeout) SetTcp(v *TcpTimeout) *ListenerTimeout {
+	s.Tcp = v
+	return s
+}
+
+// An object that represents the Transport Layer Security (TLS) properties for
+// a listener.
+type ListenerTls struct {
+	_ struct{} `type:"structure"`
+
+	// A reference to an object that represents a listener's Transport Layer Security
+	// (TLS) certificate.
+	//
+	// Certificate is a required field
+	Certificate *ListenerTlsCertificate `locationName:"certificate" type:"structure" required:"true"`
+
+	// Specify one of the following modes.
+	//
+	//    * STRICT – Listener only accepts connections with TLS enabled.
+	//
+	//    * PERMISSIVE – Listener accepts connections with or without TLS enabled.
+	//
+	//    * DISABLED – Listener only accepts connections without TLS.
+	//
+	// Mode is a required field
+	Mode *string `locationName:"mode" type:"string" required:"true" enum:"ListenerTlsMode"`
+
+	// A reference to an object that represents a listener's Transport Layer Security
+	// (TLS) validation context.
+	Validation *ListenerTlsValidationContext `locationName:"validation" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListenerTls) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListenerTls) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListenerTls) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListenerTls"}
+	if s.Certificate == nil {
+		invalidParams.Add(request.NewErrParamRequired("Certificate"))
+	}
+	if s.Mode == nil {
+		invalidParams.Add(request.NewErrParamRequired("Mode"))
+	}
+	if s.Certificate != nil {
+		if err := s.Certificate.Validate(); err != nil {
+			invalidParams.AddNested("Certificate", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Validation != nil {
+		if err := s.Validation.Validate(); err != nil {
+			invalidParams.AddNested("Validation", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCertificate sets the Certificate field's value.
+func (s *ListenerTls) SetCertificate(v *ListenerTlsCertificate) *ListenerTls {
+	s.Certificate = v
+	return s
+}
+
+// SetMode sets the Mode field's value.
+func (s *ListenerTls) SetMode(v string) *ListenerTls {
+	s.Mode = &v
+	return s
+}
+
+// SetValidation sets the Validation field's value.
+func (s *ListenerTls) SetValidation(v *ListenerTlsValidationContext) *ListenerTls {
+	s.Validation = v
+	return s
+}
+
+// An object that represents an Certificate Manager certificate.
+type ListenerTlsAcmCertificate struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) for the certificate. The certificate must
+	// meet specific requirements and you must have proxy authorization enabled.
+	// For more information, see Transport Layer Security (TLS) (https://docs.aws.amazon.com/app-mesh/latest/userguide/tls.html#virtual-node-tls-prerequisites).
+	//
+	// CertificateArn is a required field
+	CertificateArn *string `locationName:"certificateArn" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListenerTlsAcmCertificate) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListenerTlsAcmCertificate) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListenerTlsAcmCertificate) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListenerTlsAcmCertificate"}
+	if s.CertificateArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("CertificateArn"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCertificateArn sets the CertificateArn field's value.
+func (s *ListenerTlsAcmCertificate) SetCertificateArn(v string) *ListenerTlsAcmCertificate {
+	s.CertificateArn = &v
+	return s
+}
+
+// An object that represents a listener's Transport Layer Security (TLS) certificate.
+type ListenerTlsCertificate struct {
+	_ struct{} `type:"structure"`
+
+	// A reference to an object that represents an Certificate Manager certificate.
+	Acm *ListenerTlsAcmCertificate `locationName:"acm" type:"structure"`
+
+	// A reference to an object that represents a local file certificate.
+	File *ListenerTlsFileCertificate `locationName:"file" type:"structure"`
+
+	// A reference to an object that represents a listener's Secret Discovery Service
+	// certificate.
+	Sds *ListenerTlsSdsCertificate `locationName:"sds" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListenerTlsCertificate) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListenerTlsCertificate) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListenerTlsCertificate) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListenerTlsCertificate"}
+	if s.Acm != nil {
+		if err := s.Acm.Validate(); err != nil {
+			invalidParams.AddNested("Acm", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.File != nil {
+		if err := s.File.Validate(); err != nil {
+			invalidParams.AddNested("File", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Sds != nil {
+		if err := s.Sds.Validate(); err != nil {
+			invalidParams.AddNested("Sds", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAcm sets the Acm field's value.
+func (s *ListenerTlsCertificate) SetAcm(v *ListenerTlsAcmCertificate) *ListenerTlsCertificate {
+	s.Acm = v
+	return s
+}
+
+// SetFile sets the File field's value.
+func (s *ListenerTlsCertificate) SetFile(v *ListenerTlsFileCertificate) *ListenerTlsCertificate {
+	s.File = v
+	return s
+}
+
+// SetSds sets the Sds field's value.
+func (s *ListenerTlsCertificate) SetSds(v *ListenerTlsSdsCertificate) *ListenerTlsCertificate {
+	s.Sds = v
+	return s
+}
+
+// An object that represents a local file certificate. The certificate must
+// meet specific requirements and you must have proxy authorization enabled.
+// For more information, see Transport Layer Security (TLS) (https://docs.aws.amazon.com/app-mesh/latest/userguide/tls.html#virtual-node-tls-prerequisites).
+type ListenerTlsFileCertificate struct {
+	_ struct{} `type:"structure"`
+
+	// The certificate chain for the certificate.
+	//
+	// CertificateChain is a required field
+	CertificateChain *string `locationName:"certificateChain" min:"1" type:"string" required:"true"`
+
+	// The private key for a certificate stored on the file system of the virtual
+	// node that the proxy is running on.
+	//
+	// PrivateKey is a required field
+	PrivateKey *string `locationName:"privateKey" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListenerTlsFileCertificate) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListenerTlsFileCertificate) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListenerTlsFileCertificate) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListenerTlsFileCertificate"}
+	if s.CertificateChain == nil {
+		invalidParams.Add(request.NewErrParamRequired("CertificateChain"))
+	}
+	if s.CertificateChain != nil && len(*s.CertificateChain) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CertificateChain", 1))
+	}
+	if s.PrivateKey == nil {
+		invalidParams.Add(request.NewErrParamRequired("PrivateKey"))
+	}
+	if s.PrivateKey != nil && len(*s.PrivateKey) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("PrivateKey", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCertificateChain sets the CertificateChain field's value.
+func (s *ListenerTlsFileCertificate) SetCertificateChain(v string) *ListenerTlsFileCertificate {
+	s.CertificateChain = &v
+	return s
+}
+
+// SetPrivateKey sets the PrivateKey field's value.
+func (s *ListenerTlsFileCertificate) SetPrivateKey(v string) *ListenerTlsFileCertificate {
+	s.PrivateKey = &v
+	return s
+}
+
+// An object that represents the listener's Secret Discovery Service certificate.
+// The proxy must be configured with a local SDS provider via a Unix Domain
+// Socket. See App Mesh TLS documentation (https://docs.aws.amazon.com/app-mesh/latest/userguide/tls.html)
+// for more info.
+type ListenerTlsSdsCertificate struct {
+	_ struct{} `type:"structure"`
+
+	// A reference to an object that represents the name of the secret requested
+	// from the Secret Discovery Service provider representing Transport Layer Security
+	// (TLS) materials like a certificate or certificate chain.
+	//
+	// SecretName is a required field
+	SecretName *string `locationName:"secretName" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListenerTlsSdsCertificate) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListenerTlsSdsCertificate) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListenerTlsSdsCertificate) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListenerTlsSdsCertificate"}
+	if s.SecretName == nil {
+		invalidParams.Add(request.NewErrParamRequired("SecretName"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetSecretName sets the SecretName field's value.
+func (s *ListenerTlsSdsCertificate) SetSecretName(v string) *ListenerTlsSdsCertificate {
+	s.SecretName = &v
+	return s
+}
+
+// An object that represents a listener's Transport Layer Security (TLS) validation
+// context.
+type ListenerTlsValidationContext struct {
+	_ struct{} `type:"structure"`
+
+	// A reference to an object that represents the SANs for a listener's Transport
+	// Layer Security (TLS) validation context.
+	SubjectAlternativeNames *SubjectAlternativeNames `locationName:"subjectAlternativeNames" type:"structure"`
+
+	// A reference to where to retrieve the trust chain when validating a peer’s
+	// Transport Layer Security (TLS) certificate.
+	//
+	// Trust is a required field
+	Trust *ListenerTlsValidationContextTrust `locationName:"trust" type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListenerTlsValidationContext) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListenerTlsValidationContext) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListenerTlsValidationContext) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListenerTlsValidationContext"}
+	if s.Trust == nil {
+		invalidParams.Add(request.NewErrParamRequired("Trust"))
+	}
+	if s.SubjectAlternativeNames != nil {
+		if err := s.SubjectAlternativeNames.Validate(); err != nil {
+			invalidParams.AddNested("SubjectAlternativeNames", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Trust != nil {
+		if err := s.Trust.Validate(); err != nil {
+			invalidParams.AddNested("Trust", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetSubjectAlternativeNames sets the SubjectAlternativeNames field's value.
+func (s *ListenerTlsValidationContext) SetSubjectAlternativeNames(v *SubjectAlternativeNames) *ListenerTlsValidationContext {
+	s.SubjectAlternativeNames = v
+	return s
+}
+
+// SetTrust sets the Trust field's value.
+func (s *ListenerTlsValidationContext) SetTrust(v *ListenerTlsValidationContextTrust) *ListenerTlsValidationContext {
+	s.Trust = v
+	return s
+}
+
+// An object that represents a listener's Transport Layer Security (TLS) validation
+// context trust.
+type ListenerTlsValidationContextTrust struct {
+	_ struct{} `type:"structure"`
+
+	// An object that represents a Transport Layer Security (TLS) validation context
+	// trust for a local file.
+	File *TlsValidationContextFileTrust `locationName:"file" type:"structure"`
+
+	// A reference to an object that represents a listener's Transport Layer Security
+	// (TLS) Secret Discovery Service validation context trust.
+	Sds *TlsValidationContextSdsTrust `locationName:"sds" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListenerTlsValidationContextTrust) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListenerTlsValidationContextTrust) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListenerTlsValidationContextTrust) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListenerTlsValidationContextTrust"}
+	if s.File != nil {
+		if err := s.File.Validate(); err != nil {
+			invalidParams.AddNested("File", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Sds != nil {
+		if err := s.Sds.Validate(); err != nil {
+			invalidParams.AddNested("Sds", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetFile sets the File field's value.
+func (s *ListenerTlsValidationContextTrust) SetFile(v *TlsValidationContextFileTrust) *ListenerTlsValidationContextTrust {
+	s.File = v
+	return s
+}
+
+// SetSds sets the Sds field's value.
+func (s *ListenerTlsValidationContextTrust) SetSds(v *TlsValidationContextSdsTrust) *ListenerTlsValidationContextTrust {
+	s.Sds = v
+	return s
+}
+
+// An object that represents the logging information for a virtual node.
+type Logging struct {
+	_ struct{} `type:"structure"`
+
+	// The access log configuration for a virtual node.
+	AccessLog *AccessLog `locationName:"accessLog" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Logging) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Logging) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *Logging) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "Logging"}
+	if s.AccessLog != nil {
+		if err := s.AccessLog.Validate(); err != nil {
+			invalidParams.AddNested("AccessLog", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAccessLog sets the AccessLog field's value.
+func (s *Logging) SetAccessLog(v *AccessLog) *Logging {
+	s.AccessLog = v
+	return s
+}
+
+// An object that represents the format for the logs.
+type LoggingFormat struct {
+	_ struct{} `type:"structure"`
+
+	Json []*JsonFormatRef `locationName:"json" type:"list"`
+
+	Text *string `locationName:"text" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LoggingFormat) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LoggingFormat) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *LoggingFormat) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "LoggingFormat"}
+	if s.Text != nil && len(*s.Text) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Text", 1))
+	}
+	if s.Json != nil {
+		for i, v := range s.Json {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Json", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetJson sets the Json field's value.
+func (s *LoggingFormat) SetJson(v []*JsonFormatRef) *LoggingFormat {
+	s.Json = v
+	return s
+}
+
+// SetText sets the Text field's value.
+func (s *LoggingFormat) SetText(v string) *LoggingFormat {
+	s.Text = &v
+	return s
+}
+
+// An object that represents the range of values to match on. The first character
+// of the range is included in the range, though the last character is not.
+// For example, if the range specified were 1-100, only values 1-99 would be
+// matched.
+type MatchRange struct {
+	_ struct{} `type:"structure"`
+
+	// The end of the range.
+	//
+	// End is a required field
+	End *int64 `locationName:"end" type:"long" required:"true"`
+
+	// The start of the range.
+	//
+	// Start is a required field
+	Start *int64 `locationName:"start" type:"long" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MatchRange) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MatchRange) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *MatchRange) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "MatchRange"}
+	if s.End == nil {
+		invalidParams.Add(request.NewErrParamRequired("End"))
+	}
+	if s.Start == nil {
+		invalidParams.Add(request.NewErrParamRequired("Start"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetEnd sets the End field's value.
+func (s *MatchRange) SetEnd(v int64) *MatchRange {
+	s.End = &v
+	return s
+}
+
+// SetStart sets the Start field's value.
+func (s *MatchRange) SetStart(v int64) *MatchRange {
+	s.Start = &v
+	return s
+}
+
+// An object that represents a service mesh returned by a describe operation.
+type MeshData struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the service mesh.
+	//
+	// MeshName is a required field
+	MeshName *string `locationName:"meshName" min:"1" type:"string" required:"true"`
+
+	// The associated metadata for the service mesh.
+	//
+	// Metadata is a required field
+	Metadata *ResourceMetadata `locationName:"metadata" type:"structure" required:"true"`
+
+	// The associated specification for the service mesh.
+	//
+	// Spec is a required field
+	Spec *MeshSpec `locationName:"spec" type:"structure" required:"true"`
+
+	// The status of the service mesh.
+	//
+	// Status is a required field
+	Status *MeshStatus `locationName:"status" type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MeshData) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MeshData) GoString() string {
+	return s.String()
+}
+
+// SetMeshName sets the MeshName field's value.
+func (s *MeshData) SetMeshName(v string) *MeshData {
+	s.MeshName = &v
+	return s
+}
+
+// SetMetadata sets the Metadata field's value.
+func (s *MeshData) SetMetadata(v *ResourceMetadata) *MeshData {
+	s.Metadata = v
+	return s
+}
+
+// SetSpec sets the Spec field's value.
+func (s *MeshData) SetSpec(v *MeshSpec) *MeshData {
+	s.Spec = v
+	return s
+}
+
+// SetStatus sets the Status field's value.
+func (s *MeshData) SetStatus(v *MeshStatus) *MeshData {
+	s.Status = v
+	return s
+}
+
+// An object that represents a service mesh returned by a list operation.
+type MeshRef struct {
+	_ struct{} `type:"structure"`
+
+	// The full Amazon Resource Name (ARN) of the service mesh.
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" type:"string" required:"true"`
+
+	// The Unix epoch timestamp in seconds for when the resource was created.
+	//
+	// CreatedAt is a required field
+	CreatedAt *time.Time `locationName:"createdAt" type:"timestamp" required:"true"`
+
+	// The Unix epoch timestamp in seconds for when the resource was last updated.
+	//
+	// LastUpdatedAt is a required field
+	LastUpdatedAt *time.Time `locationName:"lastUpdatedAt" type:"timestamp" required:"true"`
+
+	// The name of the service mesh.
+	//
+	// MeshName is a required field
+	MeshName *string `locationName:"meshName" min:"1" type:"string" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	//
+	// MeshOwner is a required field
+	MeshOwner *string `locationName:"meshOwner" min:"12" type:"string" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the resource owner. If the account
+	// ID is not your own, then it's the ID of the mesh owner or of another account
+	// that the mesh is shared with. For more information about mesh sharing, see
+	// Working with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	//
+	// ResourceOwner is a required field
+	ResourceOwner *string `locationName:"resourceOwner" min:"12" type:"string" required:"true"`
+
+	// The version of the resource. Resources are created at version 1, and this
+	// version is incremented each time that they're updated.
+	//
+	// Version is a required field
+	Version *int64 `locationName:"version" type:"long" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MeshRef) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MeshRef) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *MeshRef) SetArn(v string) *MeshRef {
+	s.Arn = &v
+	return s
+}
+
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *MeshRef) SetCreatedAt(v time.Time) *MeshRef {
+	s.CreatedAt = &v
+	return s
+}
+
+// SetLastUpdatedAt sets the LastUpdatedAt field's value.
+func (s *MeshRef) SetLastUpdatedAt(v time.Time) *MeshRef {
+	s.LastUpdatedAt = &v
+	return s
+}
+
+// SetMeshName sets the MeshName field's value.
+func (s *MeshRef) SetMeshName(v string) *MeshRef {
+	s.MeshName = &v
+	return s
+}
+
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *MeshRef) SetMeshOwner(v string) *MeshRef {
+	s.MeshOwner = &v
+	return s
+}
+
+// SetResourceOwner sets the ResourceOwner field's value.
+func (s *MeshRef) SetResourceOwner(v string) *MeshRef {
+	s.ResourceOwner = &v
+	return s
+}
+
+// SetVersion sets the Version field's value.
+func (s *MeshRef) SetVersion(v int64) *MeshRef {
+	s.Version = &v
+	return s
+}
+
+// An object that represents the service discovery information for a service
+// mesh.
+type MeshServiceDiscovery struct {
+	_ struct{} `type:"structure"`
+
+	// The IP version to use to control traffic within the mesh.
+	IpPreference *string `locationName:"ipPreference" type:"string" enum:"IpPreference"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MeshServiceDiscovery) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MeshServiceDiscovery) GoString() string {
+	return s.String()
+}
+
+// SetIpPreference sets the IpPreference field's value.
+func (s *MeshServiceDiscovery) SetIpPreference(v string) *MeshServiceDiscovery {
+	s.IpPreference = &v
+	return s
+}
+
+// An object that represents the specification of a service mesh.
+type MeshSpec struct {
+	_ struct{} `type:"structure"`
+
+	// The egress filter rules for the service mesh.
+	EgressFilter *EgressFilter `locationName:"egressFilter" type:"structure"`
+
+	// An object that represents the service discovery information for a service
+	// mesh.
+	ServiceDiscovery *MeshServiceDiscovery `locationName:"serviceDiscovery" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MeshSpec) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MeshSpec) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *MeshSpec) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "MeshSpec"}
+	if s.EgressFilter != nil {
+		if err := s.EgressFilter.Validate(); err != nil {
+			invalidParams.AddNested("EgressFilter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetEgressFilter sets the EgressFilter field's value.
+func (s *MeshSpec) SetEgressFilter(v *EgressFilter) *MeshSpec {
+	s.EgressFilter = v
+	return s
+}
+
+// SetServiceDiscovery sets the ServiceDiscovery field's value.
+func (s *MeshSpec) SetServiceDiscovery(v *MeshServiceDiscovery) *MeshSpec {
+	s.ServiceDiscovery = v
+	return s
+}
+
+// An object that represents the status of a service mesh.
+type MeshStatus struct {
+	_ struct{} `type:"structure"`
+
+	// The current mesh status.
+	Status *string `locationName:"status" type:"string" enum:"MeshStatusCode"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MeshStatus) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MeshStatus) GoString() string {
+	return s.String()
+}
+
+// SetStatus sets the Status field's value.
+func (s *MeshStatus) SetStatus(v string) *MeshStatus {
+	s.Status = &v
+	return s
+}
+
+// The specified resource doesn't exist. Check your request syntax and try again.
+type NotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorNotFoundException(v protocol.ResponseMetadata) error {
+	return &NotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *NotFoundException) Code() string {
+	return "NotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *NotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *NotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *NotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *NotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *NotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// An object that represents the outlier detection for a virtual node's listener.
+type OutlierDetection struct {
+	_ struct{} `type:"structure"`
+
+	// The base amount of time for which a host is ejected.
+	//
+	// BaseEjectionDuration is a required field
+	BaseEjectionDuration *Duration `locationName:"baseEjectionDuration" type:"structure" required:"true"`
+
+	// The time interval between ejection sweep analysis.
+	//
+	// Interval is a required field
+	Interval *Duration `locationName:"interval" type:"structure" required:"true"`
+
+	// Maximum percentage of hosts in load balancing pool for upstream service that
+	// can be ejected. Will eject at least one host regardless of the value.
+	//
+	// MaxEjectionPercent is a required field
+	MaxEjectionPercent *int64 `locationName:"maxEjectionPercent" type:"integer" required:"true"`
+
+	// Number of consecutive 5xx errors required for ejection.
+	//
+	// MaxServerErrors is a required field
+	MaxServerErrors *int64 `locationName:"maxServerErrors" min:"1" type:"long" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s OutlierDetection) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s OutlierDetection) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *OutlierDetection) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "OutlierDetection"}
+	if s.BaseEjectionDuration == nil {
+		invalidParams.Add(request.NewErrParamRequired("BaseEjectionDuration"))
+	}
+	if s.Interval == nil {
+		invalidParams.Add(request.NewErrParamRequired("Interval"))
+	}
+	if s.MaxEjectionPercent == nil {
+		invalidParams.Add(request.NewErrParamRequired("MaxEjectionPercent"))
+	}
+	if s.MaxServerErrors == nil {
+		invalidParams.Add(request.NewErrParamRequired("MaxServerErrors"))
+	}
+	if s.MaxServerErrors != nil && *s.MaxServerErrors < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxServerErrors", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetBaseEjectionDuration sets the BaseEjectionDuration field's value.
+func (s *OutlierDetection) SetBaseEjectionDuration(v *Duration) *OutlierDetection {
+	s.BaseEjectionDuration = v
+	return s
+}
+
+// SetInterval sets the Interval field's value.
+func (s *OutlierDetection) SetInterval(v *Duration) *OutlierDetection {
+	s.Interval = v
+	return s
+}
+
+// SetMaxEjectionPercent sets the MaxEjectionPercent field's value.
+func (s *OutlierDetection) SetMaxEjectionPercent(v int64) *OutlierDetection {
+	s.MaxEjectionPercent = &v
+	return s
+}
+
+// SetMaxServerErrors sets the MaxServerErrors field's value.
+func (s *OutlierDetection) SetMaxServerErrors(v int64) *OutlierDetection {
+	s.MaxServerErrors = &v
+	return s
+}
+
+// An object that represents a port mapping.
+type PortMapping struct {
+	_ struct{} `type:"structure"`
+
+	// The port used for the port mapping.
+	//
+	// Port is a required field
+	Port *int64 `locationName:"port" min:"1" type:"integer" required:"true"`
+
+	// The protocol used for the port mapping. Specify one protocol.
+	//
+	// Protocol is a required field
+	Protocol *string `locationName:"protocol" type:"string" required:"true" enum:"PortProtocol"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PortMapping) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PortMapping) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *PortMapping) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "PortMapping"}
+	if s.Port == nil {
+		invalidParams.Add(request.NewErrParamRequired("Port"))
+	}
+	if s.Port != nil && *s.Port < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Port", 1))
+	}
+	if s.Protocol == nil {
+		invalidParams.Add(request.NewErrParamRequired("Protocol"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetPort sets the Port field's value.
+func (s *PortMapping) SetPort(v int64) *PortMapping {
+	s.Port = &v
+	return s
+}
+
+// SetProtocol sets the Protocol field's value.
+func (s *PortMapping) SetProtocol(v string) *PortMapping {
+	s.Protocol = &v
+	return s
+}
+
+// An object representing the query parameter to match.
+type QueryParameterMatch struct {
+	_ struct{} `type:"structure"`
+
+	// The exact query parameter to match on.
+	Exact *string `locationName:"exact" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s QueryParameterMatch) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s QueryParameterMatch) GoString() string {
+	return s.String()
+}
+
+// SetExact sets the Exact field's value.
+func (s *QueryParameterMatch) SetExact(v string) *QueryParameterMatch {
+	s.Exact = &v
+	return s
+}
+
+// You can't delete the specified resource because it's in use or required by
+// another resource.
+type ResourceInUseException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceInUseException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceInUseException) GoString() string {
+	return s.String()
+}
+
+func newErrorResourceInUseException(v protocol.ResponseMetadata) error {
+	return &ResourceInUseException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ResourceInUseException) Code() string {
+	return "ResourceInUseException"
+}
+
+// Message returns the exception's message.
+func (s *ResourceInUseException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ResourceInUseException) OrigErr() error {
+	return nil
+}
+
+func (s *ResourceInUseException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ResourceInUseException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ResourceInUseException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// An object that represents metadata for a resource.
+type ResourceMetadata struct {
+	_ struct{} `type:"structure"`
+
+	// The full Amazon Resource Name (ARN) for the resource.
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" type:"string" required:"true"`
+
+	// The Unix epoch timestamp in seconds for when the resource was created.
+	//
+	// CreatedAt is a required field
+	CreatedAt *time.Time `locationName:"createdAt" type:"timestamp" required:"true"`
+
+	// The Unix epoch timestamp in seconds for when the resource was last updated.
+	//
+	// LastUpdatedAt is a required field
+	LastUpdatedAt *time.Time `locationName:"lastUpdatedAt" type:"timestamp" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	//
+	// MeshOwner is a required field
+	MeshOwner *string `locationName:"meshOwner" min:"12" type:"string" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the resource owner. If the account
+	// ID is not your own, then it's the ID of the mesh owner or of another account
+	// that the mesh is shared with. For more information about mesh sharing, see
+	// Working with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	//
+	// ResourceOwner is a required field
+	ResourceOwner *string `locationName:"resourceOwner" min:"12" type:"string" required:"true"`
+
+	// The unique identifier for the resource.
+	//
+	// Uid is a required field
+	Uid *string `locationName:"uid" type:"string" required:"true"`
+
+	// The version of the resource. Resources are created at version 1, and this
+	// version is incremented each time that they're updated.
+	//
+	// Version is a required field
+	Version *int64 `locationName:"version" type:"long" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceMetadata) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceMetadata) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *ResourceMetadata) SetArn(v string) *ResourceMetadata {
+	s.Arn = &v
+	return s
+}
+
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *ResourceMetadata) SetCreatedAt(v time.Time) *ResourceMetadata {
+	s.CreatedAt = &v
+	return s
+}
+
+// SetLastUpdatedAt sets the LastUpdatedAt field's value.
+func (s *ResourceMetadata) SetLastUpdatedAt(v time.Time) *ResourceMetadata {
+	s.LastUpdatedAt = &v
+	return s
+}
+
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *ResourceMetadata) SetMeshOwner(v string) *ResourceMetadata {
+	s.MeshOwner = &v
+	return s
+}
+
+// SetResourceOwner sets the ResourceOwner field's value.
+func (s *ResourceMetadata) SetResourceOwner(v string) *ResourceMetadata {
+	s.ResourceOwner = &v
+	return s
+}
+
+// SetUid sets the Uid field's value.
+func (s *ResourceMetadata) SetUid(v string) *ResourceMetadata {
+	s.Uid = &v
+	return s
+}
+
+// SetVersion sets the Version field's value.
+func (s *ResourceMetadata) SetVersion(v int64) *ResourceMetadata {
+	s.Version = &v
+	return s
+}
+
+// An object that represents a route returned by a describe operation.
+type RouteData struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the service mesh that the route resides in.
+	//
+	// MeshName is a required field
+	MeshName *string `locationName:"meshName" min:"1" type:"string" required:"true"`
+
+	// The associated metadata for the route.
+	//
+	// Metadata is a required field
+	Metadata *ResourceMetadata `locationName:"metadata" type:"structure" required:"true"`
+
+	// The name of the route.
+	//
+	// RouteName is a required field
+	RouteName *string `locationName:"routeName" min:"1" type:"string" required:"true"`
+
+	// The specifications of the route.
+	//
+	// Spec is a required field
+	Spec *RouteSpec `locationName:"spec" type:"structure" required:"true"`
+
+	// The status of the route.
+	//
+	// Status is a required field
+	Status *RouteStatus `locationName:"status" type:"structure" required:"true"`
+
+	// The virtual router that the route is associated with.
+	//
+	// VirtualRouterName is a required field
+	VirtualRouterName *string `locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RouteData) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RouteData) GoString() string {
+	return s.String()
+}
+
+// SetMeshName sets the MeshName field's value.
+func (s *RouteData) SetMeshName(v string) *RouteData {
+	s.MeshName = &v
+	return s
+}
+
+// SetMetadata sets the Metadata field's value.
+func (s *RouteData) SetMetadata(v *ResourceMetadata) *RouteData {
+	s.Metadata = v
+	return s
+}
+
+// SetRouteName sets the RouteName field's value.
+func (s *RouteData) SetRouteName(v string) *RouteData {
+	s.RouteName = &v
+	return s
+}
+
+// SetSpec sets the Spec field's value.
+func (s *RouteData) SetSpec(v *RouteSpec) *RouteData {
+	s.Spec = v
+	return s
+}
+
+// SetStatus sets the Status field's value.
+func (s *RouteData) SetStatus(v *RouteStatus) *RouteData {
+	s.Status = v
+	return s
+}
+
+// SetVirtualRouterName sets the VirtualRouterName field's value.
+func (s *RouteData) SetVirtualRouterName(v string) *RouteData {
+	s.VirtualRouterName = &v
+	return s
+}
+
+// An object that represents a route returned by a list operation.
+type RouteRef struct {
+	_ struct{} `type:"structure"`
+
+	// The full Amazon Resource Name (ARN) for the route.
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" type:"string" required:"true"`
+
+	// The Unix epoch timestamp in seconds for when the resource was created.
+	//
+	// CreatedAt is a required field
+	CreatedAt *time.Time `locationName:"createdAt" type:"timestamp" required:"true"`
+
+	// The Unix epoch timestamp in seconds for when the resource was last updated.
+	//
+	// LastUpdatedAt is a required field
+	LastUpdatedAt *time.Time `locationName:"lastUpdatedAt" type:"timestamp" required:"true"`
+
+	// The name of the service mesh that the route resides in.
+	//
+	// MeshName is a required field
+	MeshName *string `locationName:"meshName" min:"1" type:"string" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	//
+	// MeshOwner is a required field
+	MeshOwner *string `locationName:"meshOwner" min:"12" type:"string" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the resource owner. If the account
+	// ID is not your own, then it's the ID of the mesh owner or of another account
+	// that the mesh is shared with. For more information about mesh sharing, see
+	// Working with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	//
+	// ResourceOwner is a required field
+	ResourceOwner *string `locationName:"resourceOwner" min:"12" type:"string" required:"true"`
+
+	// The name of the route.
+	//
+	// RouteName is a required field
+	RouteName *string `locationName:"routeName" min:"1" type:"string" required:"true"`
+
+	// The version of the resource. Resources are created at version 1, and this
+	// version is incremented each time that they're updated.
+	//
+	// Version is a required field
+	Version *int64 `locationName:"version" type:"long" required:"true"`
+
+	// The virtual router that the route is associated with.
+	//
+	// VirtualRouterName is a required field
+	VirtualRouterName *string `locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RouteRef) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RouteRef) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *RouteRef) SetArn(v string) *RouteRef {
+	s.Arn = &v
+	return s
+}
+
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *RouteRef) SetCreatedAt(v time.Time) *RouteRef {
+	s.CreatedAt = &v
+	return s
+}
+
+// SetLastUpdatedAt sets the LastUpdatedAt field's value.
+func (s *RouteRef) SetLastUpdatedAt(v time.Time) *RouteRef {
+	s.LastUpdatedAt = &v
+	return s
+}
+
+// SetMeshName sets the MeshName field's value.
+func (s *RouteRef) SetMeshName(v string) *RouteRef {
+	s.MeshName = &v
+	return s
+}
+
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *RouteRef) SetMeshOwner(v string) *RouteRef {
+	s.MeshOwner = &v
+	return s
+}
+
+// SetResourceOwner sets the ResourceOwner field's value.
+func (s *RouteRef) SetResourceOwner(v string) *RouteRef {
+	s.ResourceOwner = &v
+	return s
+}
+
+// SetRouteName sets the RouteName field's value.
+func (s *RouteRef) SetRouteName(v string) *RouteRef {
+	s.RouteName = &v
+	return s
+}
+
+// SetVersion sets the Version field's value.
+func (s *RouteRef) SetVersion(v int64) *RouteRef {
+	s.Version = &v
+	return s
+}
+
+// SetVirtualRouterName sets the VirtualRouterName field's value.
+func (s *RouteRef) SetVirtualRouterName(v string) *RouteRef {
+	s.VirtualRouterName = &v
+	return s
+}
+
+// An object that represents a route specification. Specify one route type.
+type RouteSpec struct {
+	_ struct{} `type:"structure"`
+
+	// An object that represents the specification of a gRPC route.
+	GrpcRoute *GrpcRoute `locationName:"grpcRoute" type:"structure"`
+
+	// An object that represents the specification of an HTTP/2 route.
+	Http2Route *HttpRoute `locationName:"http2Route" type:"structure"`
+
+	// An object that represents the specification of an HTTP route.
+	HttpRoute *HttpRoute `locationName:"httpRoute" type:"structure"`
+
+	// The priority for the route. Routes are matched based on the specified value,
+	// where 0 is the highest priority.
+	Priority *int64 `locationName:"priority" type:"integer"`
+
+	// An object that represents the specification of a TCP route.
+	TcpRoute *TcpRoute `locationName:"tcpRoute" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RouteSpec) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RouteSpec) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *RouteSpec) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "RouteSpec"}
+	if s.GrpcRoute != nil {
+		if err := s.GrpcRoute.Validate(); err != nil {
+			invalidParams.AddNested("GrpcRoute", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Http2Route != nil {
+		if err := s.Http2Route.Validate(); err != nil {
+			invalidParams.AddNested("Http2Route", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.HttpRoute != nil {
+		if err := s.HttpRoute.Validate(); err != nil {
+			invalidParams.AddNested("HttpRoute", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TcpRoute != nil {
+		if err := s.TcpRoute.Validate(); err != nil {
+			invalidParams.AddNested("TcpRoute", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetGrpcRoute sets the GrpcRoute field's value.
+func (s *RouteSpec) SetGrpcRoute(v *GrpcRoute) *RouteSpec {
+	s.GrpcRoute = v
+	return s
+}
+
+// SetHttp2Route sets the Http2Route field's value.
+func (s *RouteSpec) SetHttp2Route(v *HttpRoute) *RouteSpec {
+	s.Http2Route = v
+	return s
+}
+
+// SetHttpRoute sets the HttpRoute field's value.
+func (s *RouteSpec) SetHttpRoute(v *HttpRoute) *RouteSpec {
+	s.HttpRoute = v
+	return s
+}
+
+// SetPriority sets the Priority field's value.
+func (s *RouteSpec) SetPriority(v int64) *RouteSpec {
+	s.Priority = &v
+	return s
+}
+
+// SetTcpRoute sets the TcpRoute field's value.
+func (s *RouteSpec) SetTcpRoute(v *TcpRoute) *RouteSpec {
+	s.TcpRoute = v
+	return s
+}
+
+// An object that represents the current status of a route.
+type RouteStatus struct {
+	_ struct{} `type:"structure"`
+
+	// The current status for the route.
+	//
+	// Status is a required field
+	Status *string `locationName:"status" type:"string" required:"true" enum:"RouteStatusCode"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RouteStatus) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RouteStatus) GoString() string {
+	return s.String()
+}
+
+// SetStatus sets the Status field's value.
+func (s *RouteStatus) SetStatus(v string) *RouteStatus {
+	s.Status = &v
+	return s
+}
+
+// An object that represents the service discovery information for a virtual
+// node.
+type ServiceDiscovery struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies any Cloud Map information for the virtual node.
+	AwsCloudMap *AwsCloudMapServiceDiscovery `locationName:"awsCloudMap" type:"structure"`
+
+	// Specifies the DNS information for the virtual node.
+	Dns *DnsServiceDiscovery `locationName:"dns" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceDiscovery) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceDiscovery) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ServiceDiscovery) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ServiceDiscovery"}
+	if s.AwsCloudMap != nil {
+		if err := s.AwsCloudMap.Validate(); err != nil {
+			invalidParams.AddNested("AwsCloudMap", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Dns != nil {
+		if err := s.Dns.Validate(); err != nil {
+			invalidParams.AddNested("Dns", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAwsCloudMap sets the AwsCloudMap field's value.
+func (s *ServiceDiscovery) SetAwsCloudMap(v *AwsCloudMapServiceDiscovery) *ServiceDiscovery {
+	s.AwsCloudMap = v
+	return s
+}
+
+// SetDns sets the Dns field's value.
+func (s *ServiceDiscovery) SetDns(v *DnsServiceDiscovery) *ServiceDiscovery {
+	s.Dns = v
+	return s
+}
+
+// The request has failed due to a temporary failure of the service.
+type ServiceUnavailableException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceUnavailableException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceUnavailableException) GoString() string {
+	return s.String()
+}
+
+func newErrorServiceUnavailableException(v protocol.ResponseMetadata) error {
+	return &ServiceUnavailableException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ServiceUnavailableException) Code() string {
+	return "ServiceUnavailableException"
+}
+
+// Message returns the exception's message.
+func (s *ServiceUnavailableException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ServiceUnavailableException) OrigErr() error {
+	return nil
+}
+
+func (s *ServiceUnavailableException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ServiceUnavailableException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ServiceUnavailableException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// An object that represents the methods by which a subject alternative name
+// on a peer Transport Layer Security (TLS) certificate can be matched.
+type SubjectAlternativeNameMatchers struct {
+	_ struct{} `type:"structure"`
+
+	// The values sent must match the specified values exactly.
+	//
+	// Exact is a required field
+	Exact []*string `locationName:"exact" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SubjectAlternativeNameMatchers) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SubjectAlternativeNameMatchers) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *SubjectAlternativeNameMatchers) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "SubjectAlternativeNameMatchers"}
+	if s.Exact == nil {
+		invalidParams.Add(request.NewErrParamRequired("Exact"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetExact sets the Exact field's value.
+func (s *SubjectAlternativeNameMatchers) SetExact(v []*string) *SubjectAlternativeNameMatchers {
+	s.Exact = v
+	return s
+}
+
+// An object that represents the subject alternative names secured by the certificate.
+type SubjectAlternativeNames struct {
+	_ struct{} `type:"structure"`
+
+	// An object that represents the criteria for determining a SANs match.
+	//
+	// Match is a required field
+	Match *SubjectAlternativeNameMatchers `locationName:"match" type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SubjectAlternativeNames) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SubjectAlternativeNames) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *SubjectAlternativeNames) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "SubjectAlternativeNames"}
+	if s.Match == nil {
+		invalidParams.Add(request.NewErrParamRequired("Match"))
+	}
+	if s.Match != nil {
+		if err := s.Match.Validate(); err != nil {
+			invalidParams.AddNested("Match", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetMatch sets the Match field's value.
+func (s *SubjectAlternativeNames) SetMatch(v *SubjectAlternativeNameMatchers) *SubjectAlternativeNames {
+	s.Match = v
+	return s
+}
+
+// Optional metadata that you apply to a resource to assist with categorization
+// and organization. Each tag consists of a key and an optional value, both
+// of which you define. Tag keys can have a maximum character length of 128
+// characters, and tag values can have a maximum length of 256 characters.
+type TagRef struct {
+	_ struct{} `type:"structure"`
+
+	// One part of a key-value pair that make up a tag. A key is a general label
+	// that acts like a category for more specific tag values.
+	//
+	// Key is a required field
+	Key *string `locationName:"key" min:"1" type:"string" required:"true"`
+
+	// The optional part of a key-value pair that make up a tag. A value acts as
+	// a descriptor within a tag category (key).
+	//
+	// Value is a required field
+	Value *string `locationName:"value" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagRef) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagRef) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *TagRef) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "TagRef"}
+	if s.Key == nil {
+		invalidParams.Add(request.NewErrParamRequired("Key"))
+	}
+	if s.Key != nil && len(*s.Key) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Key", 1))
+	}
+	if s.Value == nil {
+		invalidParams.Add(request.NewErrParamRequired("Value"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetKey sets the Key field's value.
+func (s *TagRef) SetKey(v string) *TagRef {
+	s.Key = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *TagRef) SetValue(v string) *TagRef {
+	s.Value = &v
+	return s
+}
+
+type TagResourceInput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the resource to add tags to.
+	//
+	// ResourceArn is a required field
+	ResourceArn *string `location:"querystring" locationName:"resourceArn" type:"string" required:"true"`
+
+	// The tags to add to the resource. A tag is an array of key-value pairs. Tag
+	// keys can have a maximum character length of 128 characters, and tag values
+	// can have a maximum length of 256 characters.
+	//
+	// Tags is a required field
+	Tags []*TagRef `locationName:"tags" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *TagResourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "TagResourceInput"}
+	if s.ResourceArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceArn"))
+	}
+	if s.Tags == nil {
+		invalidParams.Add(request.NewErrParamRequired("Tags"))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetResourceArn sets the ResourceArn field's value.
+func (s *TagResourceInput) SetResourceArn(v string) *TagResourceInput {
+	s.ResourceArn = &v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *TagResourceInput) SetTags(v []*TagRef) *TagResourceInput {
+	s.Tags = v
+	return s
+}
+
+type TagResourceOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceOutput) GoString() string {
+	return s.String()
+}
+
+// An object that represents a TCP route type.
+type TcpRoute struct {
+	_ struct{} `type:"structure"`
+
+	// The action to take if a match is determined.
+	//
+	// Action is a required field
+	Action *TcpRouteAction `locationName:"action" type:"structure" required:"true"`
+
+	// An object that represents the criteria for determining a request match.
+	Match *TcpRouteMatch `locationName:"match" type:"structure"`
+
+	// An object that represents types of timeouts.
+	Timeout *TcpTimeout `locationName:"timeout" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TcpRoute) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TcpRoute) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *TcpRoute) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "TcpRoute"}
+	if s.Action == nil {
+		invalidParams.Add(request.NewErrParamRequired("Action"))
+	}
+	if s.Action != nil {
+		if err := s.Action.Validate(); err != nil {
+			invalidParams.AddNested("Action", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Match != nil {
+		if err := s.Match.Validate(); err != nil {
+			invalidParams.AddNested("Match", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAction sets the Action field's value.
+func (s *TcpRoute) SetAction(v *TcpRouteAction) *TcpRoute {
+	s.Action = v
+	return s
+}
+
+// SetMatch sets the Match field's value.
+func (s *TcpRoute) SetMatch(v *TcpRouteMatch) *TcpRoute {
+	s.Match = v
+	return s
+}
+
+// SetTimeout sets the Timeout field's value.
+func (s *TcpRoute) SetTimeout(v *TcpTimeout) *TcpRoute {
+	s.Timeout = v
+	return s
+}
+
+// An object that represents the action to take if a match is determined.
+type TcpRouteAction struct {
+	_ struct{} `type:"structure"`
+
+	// An object that represents the targets that traffic is routed to when a request
+	// matches the route.
+	//
+	// WeightedTargets is a required field
+	WeightedTargets []*WeightedTarget `locationName:"weightedTargets" min:"1" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TcpRouteAction) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TcpRouteAction) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *TcpRouteAction) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "TcpRouteAction"}
+	if s.WeightedTargets == nil {
+		invalidParams.Add(request.NewErrParamRequired("WeightedTargets"))
+	}
+	if s.WeightedTargets != nil && len(s.WeightedTargets) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("WeightedTargets", 1))
+	}
+	if s.WeightedTargets != nil {
+		for i, v := range s.WeightedTargets {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "WeightedTargets", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetWeightedTargets sets the WeightedTargets field's value.
+func (s *TcpRouteAction) SetWeightedTargets(v []*WeightedTarget) *TcpRouteAction {
+	s.WeightedTargets = v
+	return s
+}
+
+// An object representing the TCP route to match.
+type TcpRouteMatch struct {
+	_ struct{} `type:"structure"`
+
+	// The port number to match on.
+	Port *int64 `locationName:"port" min:"1" type:"integer"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TcpRouteMatch) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TcpRouteMatch) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *TcpRouteMatch) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "TcpRouteMatch"}
+	if s.Port != nil && *s.Port < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Port", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetPort sets the Port field's value.
+func (s *TcpRouteMatch) SetPort(v int64) *TcpRouteMatch {
+	s.Port = &v
+	return s
+}
+
+// An object that represents types of timeouts.
+type TcpTimeout struct {
+	_ struct{} `type:"structure"`
+
+	// An object that represents an idle timeout. An idle timeout bounds the amount
+	// of time that a connection may be idle. The default value is none.
+	Idle *Duration `locationName:"idle" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TcpTimeout) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TcpTimeout) GoString() string {
+	return s.String()
+}
+
+// SetIdle sets the Idle field's value.
+func (s *TcpTimeout) SetIdle(v *Duration) *TcpTimeout {
+	s.Idle = v
+	return s
+}
+
+// An object that represents how the proxy will validate its peer during Transport
+// Layer Security (TLS) negotiation.
+type TlsValidationContext struct {
+	_ struct{} `type:"structure"`
+
+	// A reference to an object that represents the SANs for a Transport Layer Security
+	// (TLS) validation context. If you don't specify SANs on the terminating mesh
+	// endpoint, the Envoy proxy for that node doesn't verify the SAN on a peer
+	// client certificate. If you don't specify SANs on the originating mesh endpoint,
+	// the SAN on the certificate provided by the terminating endpoint must match
+	// the mesh endpoint service discovery configuration. Since SPIRE vended certificates
+	// have a SPIFFE ID as a name, you must set the SAN since the name doesn't match
+	// the service discovery name.
+	SubjectAlternativeNames *SubjectAlternativeNames `locationName:"subjectAlternativeNames" type:"structure"`
+
+	// A reference to where to retrieve the trust chain when validating a peer’s
+	// Transport Layer Security (TLS) certificate.
+	//
+	// Trust is a required field
+	Trust *TlsValidationContextTrust `locationName:"trust" type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TlsValidationContext) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TlsValidationContext) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *TlsValidationContext) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "TlsValidationContext"}
+	if s.Trust == nil {
+		invalidParams.Add(request.NewErrParamRequired("Trust"))
+	}
+	if s.SubjectAlternativeNames != nil {
+		if err := s.SubjectAlternativeNames.Validate(); err != nil {
+			invalidParams.AddNested("SubjectAlternativeNames", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Trust != nil {
+		if err := s.Trust.Validate(); err != nil {
+			invalidParams.AddNested("Trust", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetSubjectAlternativeNames sets the SubjectAlternativeNames field's value.
+func (s *TlsValidationContext) SetSubjectAlternativeNames(v *SubjectAlternativeNames) *TlsValidationContext {
+	s.SubjectAlternativeNames = v
+	return s
+}
+
+// SetTrust sets the Trust field's value.
+func (s *TlsValidationContext) SetTrust(v *TlsValidationContextTrust) *TlsValidationContext {
+	s.Trust = v
+	return s
+}
+
+// An object that represents a Transport Layer Security (TLS) validation context
+// trust for an Certificate Manager certificate.
+type TlsValidationContextAcmTrust struct {
+	_ struct{} `type:"structure"`
+
+	// One or more ACM Amazon Resource Name (ARN)s.
+	//
+	// CertificateAuthorityArns is a required field
+	CertificateAuthorityArns []*string `locationName:"certificateAuthorityArns" min:"1" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TlsValidationContextAcmTrust) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TlsValidationContextAcmTrust) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *TlsValidationContextAcmTrust) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "TlsValidationContextAcmTrust"}
+	if s.CertificateAuthorityArns == nil {
+		invalidParams.Add(request.NewErrParamRequired("CertificateAuthorityArns"))
+	}
+	if s.CertificateAuthorityArns != nil && len(s.CertificateAuthorityArns) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CertificateAuthorityArns", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCertificateAuthorityArns sets the CertificateAuthorityArns field's value.
+func (s *TlsValidationContextAcmTrust) SetCertificateAuthorityArns(v []*string) *TlsValidationContextAcmTrust {
+	s.CertificateAuthorityArns = v
+	return s
+}
+
+// An object that represents a Transport Layer Security (TLS) validation context
+// trust for a local file.
+type TlsValidationContextFileTrust struct {
+	_ struct{} `type:"structure"`
+
+	// The certificate trust chain for a certificate stored on the file system of
+	// the virtual node that the proxy is running on.
+	//
+	// CertificateChain is a required field
+	CertificateChain *string `locationName:"certificateChain" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TlsValidationContextFileTrust) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TlsValidationContextFileTrust) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *TlsValidationContextFileTrust) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "TlsValidationContextFileTrust"}
+	if s.CertificateChain == nil {
+		invalidParams.Add(request.NewErrParamRequired("CertificateChain"))
+	}
+	if s.CertificateChain != nil && len(*s.CertificateChain) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CertificateChain", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCertificateChain sets the CertificateChain field's value.
+func (s *TlsValidationContextFileTrust) SetCertificateChain(v string) *TlsValidationContextFileTrust {
+	s.CertificateChain = &v
+	return s
+}
+
+// An object that represents a Transport Layer Security (TLS) Secret Discovery
+// Service validation context trust. The proxy must be configured with a local
+// SDS provider via a Unix Domain Socket. See App Mesh TLS documentation (https://docs.aws.amazon.com/app-mesh/latest/userguide/tls.html)
+// for more info.
+type TlsValidationContextSdsTrust struct {
+	_ struct{} `type:"structure"`
+
+	// A reference to an object that represents the name of the secret for a Transport
+	// Layer Security (TLS) Secret Discovery Service validation context trust.
+	//
+	// SecretName is a required field
+	SecretName *string `locationName:"secretName" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TlsValidationContextSdsTrust) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TlsValidationContextSdsTrust) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *TlsValidationContextSdsTrust) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "TlsValidationContextSdsTrust"}
+	if s.SecretName == nil {
+		invalidParams.Add(request.NewErrParamRequired("SecretName"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetSecretName sets the SecretName field's value.
+func (s *TlsValidationContextSdsTrust) SetSecretName(v string) *TlsValidationContextSdsTrust {
+	s.SecretName = &v
+	return s
+}
+
+// An object that represents a Transport Layer Security (TLS) validation context
+// trust.
+type TlsValidationContextTrust struct {
+	_ struct{} `type:"structure"`
+
+	// A reference to an object that represents a Transport Layer Security (TLS)
+	// validation context trust for an Certificate Manager certificate.
+	Acm *TlsValidationContextAcmTrust `locationName:"acm" type:"structure"`
+
+	// An object that represents a Transport Layer Security (TLS) validation context
+	// trust for a local file.
+	File *TlsValidationContextFileTrust `locationName:"file" type:"structure"`
+
+	// A reference to an object that represents a Transport Layer Security (TLS)
+	// Secret Discovery Service validation context trust.
+	Sds *TlsValidationContextSdsTrust `locationName:"sds" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TlsValidationContextTrust) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TlsValidationContextTrust) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *TlsValidationContextTrust) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "TlsValidationContextTrust"}
+	if s.Acm != nil {
+		if err := s.Acm.Validate(); err != nil {
+			invalidParams.AddNested("Acm", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.File != nil {
+		if err := s.File.Validate(); err != nil {
+			invalidParams.AddNested("File", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Sds != nil {
+		if err := s.Sds.Validate(); err != nil {
+			invalidParams.AddNested("Sds", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAcm sets the Acm field's value.
+func (s *TlsValidationContextTrust) SetAcm(v *TlsValidationContextAcmTrust) *TlsValidationContextTrust {
+	s.Acm = v
+	return s
+}
+
+// SetFile sets the File field's value.
+func (s *TlsValidationContextTrust) SetFile(v *TlsValidationContextFileTrust) *TlsValidationContextTrust {
+	s.File = v
+	return s
+}
+
+// SetSds sets the Sds field's value.
+func (s *TlsValidationContextTrust) SetSds(v *TlsValidationContextSdsTrust) *TlsValidationContextTrust {
+	s.Sds = v
+	return s
+}
+
+// The maximum request rate permitted by the App Mesh APIs has been exceeded
+// for your account. For best results, use an increasing or variable sleep interval
+// between requests.
+type TooManyRequestsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyRequestsException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyRequestsException) GoString() string {
+	return s.String()
+}
+
+func newErrorTooManyRequestsException(v protocol.ResponseMetadata) error {
+	return &TooManyRequestsException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *TooManyRequestsException) Code() string {
+	return "TooManyRequestsException"
+}
+
+// Message returns the exception's message.
+func (s *TooManyRequestsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *TooManyRequestsException) OrigErr() error {
+	return nil
+}
+
+func (s *TooManyRequestsException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *TooManyRequestsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *TooManyRequestsException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The request exceeds the maximum allowed number of tags allowed per resource.
+// The current limit is 50 user tags per resource. You must reduce the number
+// of tags in the request. None of the tags in this request were applied.
+type TooManyTagsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyTagsException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyTagsException) GoString() string {
+	return s.String()
+}
+
+func newErrorTooManyTagsException(v protocol.ResponseMetadata) error {
+	return &TooManyTagsException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *TooManyTagsException) Code() string {
+	return "TooManyTagsException"
+}
+
+// Message returns the exception's message.
+func (s *TooManyTagsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *TooManyTagsException) OrigErr() error {
+	return nil
+}
+
+func (s *TooManyTagsException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *TooManyTagsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *TooManyTagsException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type UntagResourceInput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the resource to delete tags from.
+	//
+	// ResourceArn is a required field
+	ResourceArn *string `location:"querystring" locationName:"resourceArn" type:"string" required:"true"`
+
+	// The keys of the tags to be removed.
+	//
+	// TagKeys is a required field
+	TagKeys []*string `locationName:"tagKeys" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *UntagResourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UntagResourceInput"}
+	if s.ResourceArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceArn"))
+	}
+	if s.TagKeys == nil {
+		invalidParams.Add(request.NewErrParamRequired("TagKeys"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetResourceArn sets the ResourceArn field's value.
+func (s *UntagResourceInput) SetResourceArn(v string) *UntagResourceInput {
+	s.ResourceArn = &v
+	return s
+}
+
+// SetTagKeys sets the TagKeys field's value.
+func (s *UntagResourceInput) SetTagKeys(v []*string) *UntagResourceInput {
+	s.TagKeys = v
+	return s
+}
+
+type UntagResourceOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceOutput) GoString() string {
+	return s.String()
+}
+
+type UpdateGatewayRouteInput struct {
+	_ struct{} `type:"structure"`
+
+	// Unique, case-sensitive identifier that you provide to ensure the idempotency
+	// of the request. Up to 36 letters, numbers, hyphens, and underscores are allowed.
+	ClientToken *string `locationName:"clientToken" type:"string" idempotencyToken:"true"`
+
+	// The name of the gateway route to update.
+	//
+	// GatewayRouteName is a required field
+	GatewayRouteName *string `location:"uri" locationName:"gatewayRouteName" min:"1" type:"string" required:"true"`
+
+	// The name of the service mesh that the gateway route resides in.
+	//
+	// MeshName is a required field
+	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
+
+	// The new gateway route specification to apply. This overwrites the existing
+	// data.
+	//
+	// Spec is a required field
+	Spec *GatewayRouteSpec `locationName:"spec" type:"structure" required:"true"`
+
+	// The name of the virtual gateway that the gateway route is associated with.
+	//
+	// VirtualGatewayName is a required field
+	VirtualGatewayName *string `location:"uri" locationName:"virtualGatewayName" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateGatewayRouteInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateGatewayRouteInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *UpdateGatewayRouteInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateGatewayRouteInput"}
+	if s.GatewayRouteName == nil {
+		invalidParams.Add(request.NewErrParamRequired("GatewayRouteName"))
+	}
+	if s.GatewayRouteName != nil && len(*s.GatewayRouteName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("GatewayRouteName", 1))
+	}
+	if s.MeshName == nil {
+		invalidParams.Add(request.NewErrParamRequired("MeshName"))
+	}
+	if s.MeshName != nil && len(*s.MeshName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
+	}
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
+	}
+	if s.Spec == nil {
+		invalidParams.Add(request.NewErrParamRequired("Spec"))
+	}
+	if s.VirtualGatewayName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualGatewayName"))
+	}
+	if s.VirtualGatewayName != nil && len(*s.VirtualGatewayName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VirtualGatewayName", 1))
+	}
+	if s.Spec != nil {
+		if err := s.Spec.Validate(); err != nil {
+			invalidParams.AddNested("Spec", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetClientToken sets the ClientToken field's value.
+func (s *UpdateGatewayRouteInput) SetClientToken(v string) *UpdateGatewayRouteInput {
+	s.ClientToken = &v
+	return s
+}
+
+// SetGatewayRouteName sets the GatewayRouteName field's value.
+func (s *UpdateGatewayRouteInput) SetGatewayRouteName(v string) *UpdateGatewayRouteInput {
+	s.GatewayRouteName = &v
+	return s
+}
+
+// SetMeshName sets the MeshName field's value.
+func (s *UpdateGatewayRouteInput) SetMeshName(v string) *UpdateGatewayRouteInput {
+	s.MeshName = &v
+	return s
+}
+
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *UpdateGatewayRouteInput) SetMeshOwner(v string) *UpdateGatewayRouteInput {
+	s.MeshOwner = &v
+	return s
+}
+
+// SetSpec sets the Spec field's value.
+func (s *UpdateGatewayRouteInput) SetSpec(v *GatewayRouteSpec) *UpdateGatewayRouteInput {
+	s.Spec = v
+	return s
+}
+
+// SetVirtualGatewayName sets the VirtualGatewayName field's value.
+func (s *UpdateGatewayRouteInput) SetVirtualGatewayName(v string) *UpdateGatewayRouteInput {
+	s.VirtualGatewayName = &v
+	return s
+}
+
+type UpdateGatewayRouteOutput struct {
+	_ struct{} `type:"structure" payload:"GatewayRoute"`
+
+	// A full description of the gateway route that was updated.
+	//
+	// GatewayRoute is a required field
+	GatewayRoute *GatewayRouteData `locationName:"gatewayRoute" type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateGatewayRouteOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateGatewayRouteOutput) GoString() string {
+	return s.String()
+}
+
+// SetGatewayRoute sets the GatewayRoute field's value.
+func (s *UpdateGatewayRouteOutput) SetGatewayRoute(v *GatewayRouteData) *UpdateGatewayRouteOutput {
+	s.GatewayRoute = v
+	return s
+}
+
+type UpdateMeshInput struct {
+	_ struct{} `type:"structure"`
+
+	// Unique, case-sensitive identifier that you provide to ensure the idempotency
+	// of the request. Up to 36 letters, numbers, hyphens, and underscores are allowed.
+	ClientToken *string `locationName:"clientToken" type:"string" idempotencyToken:"true"`
+
+	// The name of the service mesh to update.
+	//
+	// MeshName is a required field
+	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
+
+	// The service mesh specification to apply.
+	Spec *MeshSpec `locationName:"spec" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateMeshInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateMeshInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *UpdateMeshInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateMeshInput"}
+	if s.MeshName == nil {
+		invalidParams.Add(request.NewErrParamRequired("MeshName"))
+	}
+	if s.MeshName != nil && len(*s.MeshName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
+	}
+	if s.Spec != nil {
+		if err := s.Spec.Validate(); err != nil {
+			invalidParams.AddNested("Spec", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetClientToken sets the ClientToken field's value.
+func (s *UpdateMeshInput) SetClientToken(v string) *UpdateMeshInput {
+	s.ClientToken = &v
+	return s
+}
+
+// SetMeshName sets the MeshName field's value.
+func (s *UpdateMeshInput) SetMeshName(v string) *UpdateMeshInput {
+	s.MeshName = &v
+	return s
+}
+
+// SetSpec sets the Spec field's value.
+func (s *UpdateMeshInput) SetSpec(v *MeshSpec) *UpdateMeshInput {
+	s.Spec = v
+	return s
+}
+
+type UpdateMeshOutput struct {
+	_ struct{} `type:"structure" payload:"Mesh"`
+
+	// An object that represents a service mesh returned by a describe operation.
+	//
+	// Mesh is a required field
+	Mesh *MeshData `locationName:"mesh" type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateMeshOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateMeshOutput) GoString() string {
+	return s.String()
+}
+
+// SetMesh sets the Mesh field's value.
+func (s *UpdateMeshOutput) SetMesh(v *MeshData) *UpdateMeshOutput {
+	s.Mesh = v
+	return s
+}
+
+type UpdateRouteInput struct {
+	_ struct{} `type:"structure"`
+
+	// Unique, case-sensitive identifier that you provide to ensure the idempotency
+	// of the request. Up to 36 letters, numbers, hyphens, and underscores are allowed.
+	ClientToken *string `locationName:"clientToken" type:"string" idempotencyToken:"true"`
+
+	// The name of the service mesh that the route resides in.
+	//
+	// MeshName is a required field
+	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
+
+	// The name of the route to update.
+	//
+	// RouteName is a required field
+	RouteName *string `location:"uri" locationName:"routeName" min:"1" type:"string" required:"true"`
+
+	// The new route specification to apply. This overwrites the existing data.
+	//
+	// Spec is a required field
+	Spec *RouteSpec `locationName:"spec" type:"structure" required:"true"`
+
+	// The name of the virtual router that the route is associated with.
+	//
+	// VirtualRouterName is a required field
+	VirtualRouterName *string `location:"uri" locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateRouteInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateRouteInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *UpdateRouteInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateRouteInput"}
+	if s.MeshName == nil {
+		invalidParams.Add(request.NewErrParamRequired("MeshName"))
+	}
+	if s.MeshName != nil && len(*s.MeshName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
+	}
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
+	}
+	if s.RouteName == nil {
+		invalidParams.Add(request.NewErrParamRequired("RouteName"))
+	}
+	if s.RouteName != nil && len(*s.RouteName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RouteName", 1))
+	}
+	if s.Spec == nil {
+		invalidParams.Add(request.NewErrParamRequired("Spec"))
+	}
+	if s.VirtualRouterName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualRouterName"))
+	}
+	if s.VirtualRouterName != nil && len(*s.VirtualRouterName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VirtualRouterName", 1))
+	}
+	if s.Spec != nil {
+		if err := s.Spec.Validate(); err != nil {
+			invalidParams.AddNested("Spec", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetClientToken sets the ClientToken field's value.
+func (s *UpdateRouteInput) SetClientToken(v string) *UpdateRouteInput {
+	s.ClientToken = &v
+	return s
+}
+
+// SetMeshName sets the MeshName field's value.
+func (s *UpdateRouteInput) SetMeshName(v string) *UpdateRouteInput {
+	s.MeshName = &v
+	return s
+}
+
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *UpdateRouteInput) SetMeshOwner(v string) *UpdateRouteInput {
+	s.MeshOwner = &v
+	return s
+}
+
+// SetRouteName sets the RouteName field's value.
+func (s *UpdateRouteInput) SetRouteName(v string) *UpdateRouteInput {
+	s.RouteName = &v
+	return s
+}
+
+// SetSpec sets the Spec field's value.
+func (s *UpdateRouteInput) SetSpec(v *RouteSpec) *UpdateRouteInput {
+	s.Spec = v
+	return s
+}
+
+// SetVirtualRouterName sets the VirtualRouterName field's value.
+func (s *UpdateRouteInput) SetVirtualRouterName(v string) *UpdateRouteInput {
+	s.VirtualRouterName = &v
+	return s
+}
+
+type UpdateRouteOutput struct {
+	_ struct{} `type:"structure" payload:"Route"`
+
+	// A full description of the route that was updated.
+	//
+	// Route is a required field
+	Route *RouteData `locationName:"route" type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateRouteOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateRouteOutput) GoString() string {
+	return s.String()
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListMeshesOutput) SetNextToken(v string) *ListMeshesOutput {
-	s.NextToken = &v
+// SetRoute sets the Route field's value.
+func (s *UpdateRouteOutput) SetRoute(v *RouteData) *UpdateRouteOutput {
+	s.Route = v
 	return s
 }
 
-type ListRoutesInput struct {
+type UpdateVirtualGatewayInput struct {
 	_ struct{} `type:"structure"`
 
-	Limit *int64 `location:"querystring" locationName:"limit" min:"1" type:"integer"`
+	// Unique, case-sensitive identifier that you provide to ensure the idempotency
+	// of the request. Up to 36 letters, numbers, hyphens, and underscores are allowed.
+	ClientToken *string `locationName:"clientToken" type:"string" idempotencyToken:"true"`
 
+	// The name of the service mesh that the virtual gateway resides in.
+	//
 	// MeshName is a required field
 	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
 
-	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
 
-	// VirtualRouterName is a required field
-	VirtualRouterName *string `location:"uri" locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
+	// The new virtual gateway specification to apply. This overwrites the existing
+	// data.
+	//
+	// Spec is a required field
+	Spec *VirtualGatewaySpec `locationName:"spec" type:"structure" required:"true"`
+
+	// The name of the virtual gateway to update.
+	//
+	// VirtualGatewayName is a required field
+	VirtualGatewayName *string `location:"uri" locationName:"virtualGatewayName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s ListRoutesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateVirtualGatewayInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListRoutesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateVirtualGatewayInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListRoutesInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListRoutesInput"}
-	if s.Limit != nil && *s.Limit < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
-	}
+func (s *UpdateVirtualGatewayInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateVirtualGatewayInput"}
 	if s.MeshName == nil {
 		invalidParams.Add(request.NewErrParamRequired("MeshName"))
 	}
 	if s.MeshName != nil && len(*s.MeshName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
 	}
-	if s.VirtualRouterName == nil {
-		invalidParams.Add(request.NewErrParamRequired("VirtualRouterName"))
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
 	}
-	if s.VirtualRouterName != nil && len(*s.VirtualRouterName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("VirtualRouterName", 1))
+	if s.Spec == nil {
+		invalidParams.Add(request.NewErrParamRequired("Spec"))
+	}
+	if s.VirtualGatewayName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualGatewayName"))
+	}
+	if s.VirtualGatewayName != nil && len(*s.VirtualGatewayName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VirtualGatewayName", 1))
+	}
+	if s.Spec != nil {
+		if err := s.Spec.Validate(); err != nil {
+			invalidParams.AddNested("Spec", err.(request.ErrInvalidParams))
+		}
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5663,90 +15870,142 @@ func (s *ListRoutesInput) Validate() error {
 	return nil
 }
 
-// SetLimit sets the Limit field's value.
-func (s *ListRoutesInput) SetLimit(v int64) *ListRoutesInput {
-	s.Limit = &v
+// SetClientToken sets the ClientToken field's value.
+func (s *UpdateVirtualGatewayInput) SetClientToken(v string) *UpdateVirtualGatewayInput {
+	s.ClientToken = &v
 	return s
 }
 
 // SetMeshName sets the MeshName field's value.
-func (s *ListRoutesInput) SetMeshName(v string) *ListRoutesInput {
+func (s *UpdateVirtualGatewayInput) SetMeshName(v string) *UpdateVirtualGatewayInput {
 	s.MeshName = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListRoutesInput) SetNextToken(v string) *ListRoutesInput {
-	s.NextToken = &v
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *UpdateVirtualGatewayInput) SetMeshOwner(v string) *UpdateVirtualGatewayInput {
+	s.MeshOwner = &v
 	return s
 }
 
-// SetVirtualRouterName sets the VirtualRouterName field's value.
-func (s *ListRoutesInput) SetVirtualRouterName(v string) *ListRoutesInput {
-	s.VirtualRouterName = &v
+// SetSpec sets the Spec field's value.
+func (s *UpdateVirtualGatewayInput) SetSpec(v *VirtualGatewaySpec) *UpdateVirtualGatewayInput {
+	s.Spec = v
 	return s
 }
 
-type ListRoutesOutput struct {
-	_ struct{} `type:"structure"`
+// SetVirtualGatewayName sets the VirtualGatewayName field's value.
+func (s *UpdateVirtualGatewayInput) SetVirtualGatewayName(v string) *UpdateVirtualGatewayInput {
+	s.VirtualGatewayName = &v
+	return s
+}
 
-	NextToken *string `locationName:"nextToken" type:"string"`
+type UpdateVirtualGatewayOutput struct {
+	_ struct{} `type:"structure" payload:"VirtualGateway"`
 
-	// Routes is a required field
-	Routes []*RouteRef `locationName:"routes" type:"list" required:"true"`
+	// A full description of the virtual gateway that was updated.
+	//
+	// VirtualGateway is a required field
+	VirtualGateway *VirtualGatewayData `locationName:"virtualGateway" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s ListRoutesOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateVirtualGatewayOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListRoutesOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateVirtualGatewayOutput) GoString() string {
 	return s.String()
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListRoutesOutput) SetNextToken(v string) *ListRoutesOutput {
-	s.NextToken = &v
-	return s
-}
-
-// SetRoutes sets the Routes field's value.
-func (s *ListRoutesOutput) SetRoutes(v []*RouteRef) *ListRoutesOutput {
-	s.Routes = v
+// SetVirtualGateway sets the VirtualGateway field's value.
+func (s *UpdateVirtualGatewayOutput) SetVirtualGateway(v *VirtualGatewayData) *UpdateVirtualGatewayOutput {
+	s.VirtualGateway = v
 	return s
 }
 
-type ListTagsForResourceInput struct {
+type UpdateVirtualNodeInput struct {
 	_ struct{} `type:"structure"`
 
-	Limit *int64 `location:"querystring" locationName:"limit" min:"1" type:"integer"`
+	// Unique, case-sensitive identifier that you provide to ensure the idempotency
+	// of the request. Up to 36 letters, numbers, hyphens, and underscores are allowed.
+	ClientToken *string `locationName:"clientToken" type:"string" idempotencyToken:"true"`
 
-	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
+	// The name of the service mesh that the virtual node resides in.
+	//
+	// MeshName is a required field
+	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
 
-	// ResourceArn is a required field
-	ResourceArn *string `location:"querystring" locationName:"resourceArn" type:"string" required:"true"`
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
+
+	// The new virtual node specification to apply. This overwrites the existing
+	// data.
+	//
+	// Spec is a required field
+	Spec *VirtualNodeSpec `locationName:"spec" type:"structure" required:"true"`
+
+	// The name of the virtual node to update.
+	//
+	// VirtualNodeName is a required field
+	VirtualNodeName *string `location:"uri" locationName:"virtualNodeName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s ListTagsForResourceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateVirtualNodeInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListTagsForResourceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateVirtualNodeInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListTagsForResourceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListTagsForResourceInput"}
-	if s.Limit != nil && *s.Limit < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+func (s *UpdateVirtualNodeInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateVirtualNodeInput"}
+	if s.MeshName == nil {
+		invalidParams.Add(request.NewErrParamRequired("MeshName"))
 	}
-	if s.ResourceArn == nil {
-		invalidParams.Add(request.NewErrParamRequired("ResourceArn"))
+	if s.MeshName != nil && len(*s.MeshName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
+	}
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
+	}
+	if s.Spec == nil {
+		invalidParams.Add(request.NewErrParamRequired("Spec"))
+	}
+	if s.VirtualNodeName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualNodeName"))
+	}
+	if s.VirtualNodeName != nil && len(*s.VirtualNodeName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VirtualNodeName", 1))
+	}
+	if s.Spec != nil {
+		if err := s.Spec.Validate(); err != nil {
+			invalidParams.AddNested("Spec", err.(request.ErrInvalidParams))
+		}
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5755,88 +16014,143 @@ func (s *ListTagsForResourceInput) Validate() error {
 	return nil
 }
 
-// SetLimit sets the Limit field's value.
-func (s *ListTagsForResourceInput) SetLimit(v int64) *ListTagsForResourceInput {
-	s.Limit = &v
+// SetClientToken sets the ClientToken field's value.
+func (s *UpdateVirtualNodeInput) SetClientToken(v string) *UpdateVirtualNodeInput {
+	s.ClientToken = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListTagsForResourceInput) SetNextToken(v string) *ListTagsForResourceInput {
-	s.NextToken = &v
+// SetMeshName sets the MeshName field's value.
+func (s *UpdateVirtualNodeInput) SetMeshName(v string) *UpdateVirtualNodeInput {
+	s.MeshName = &v
 	return s
 }
 
-// SetResourceArn sets the ResourceArn field's value.
-func (s *ListTagsForResourceInput) SetResourceArn(v string) *ListTagsForResourceInput {
-	s.ResourceArn = &v
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *UpdateVirtualNodeInput) SetMeshOwner(v string) *UpdateVirtualNodeInput {
+	s.MeshOwner = &v
 	return s
 }
 
-type ListTagsForResourceOutput struct {
-	_ struct{} `type:"structure"`
+// SetSpec sets the Spec field's value.
+func (s *UpdateVirtualNodeInput) SetSpec(v *VirtualNodeSpec) *UpdateVirtualNodeInput {
+	s.Spec = v
+	return s
+}
 
-	NextToken *string `locationName:"nextToken" type:"string"`
+// SetVirtualNodeName sets the VirtualNodeName field's value.
+func (s *UpdateVirtualNodeInput) SetVirtualNodeName(v string) *UpdateVirtualNodeInput {
+	s.VirtualNodeName = &v
+	return s
+}
 
-	// Tags is a required field
-	Tags []*TagRef `locationName:"tags" type:"list" required:"true"`
+type UpdateVirtualNodeOutput struct {
+	_ struct{} `type:"structure" payload:"VirtualNode"`
+
+	// A full description of the virtual node that was updated.
+	//
+	// VirtualNode is a required field
+	VirtualNode *VirtualNodeData `locationName:"virtualNode" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s ListTagsForResourceOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateVirtualNodeOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListTagsForResourceOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateVirtualNodeOutput) GoString() string {
 	return s.String()
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListTagsForResourceOutput) SetNextToken(v string) *ListTagsForResourceOutput {
-	s.NextToken = &v
-	return s
-}
-
-// SetTags sets the Tags field's value.
-func (s *ListTagsForResourceOutput) SetTags(v []*TagRef) *ListTagsForResourceOutput {
-	s.Tags = v
+// SetVirtualNode sets the VirtualNode field's value.
+func (s *UpdateVirtualNodeOutput) SetVirtualNode(v *VirtualNodeData) *UpdateVirtualNodeOutput {
+	s.VirtualNode = v
 	return s
 }
 
-type ListVirtualNodesInput struct {
+type UpdateVirtualRouterInput struct {
 	_ struct{} `type:"structure"`
 
-	Limit *int64 `location:"querystring" locationName:"limit" min:"1" type:"integer"`
+	// Unique, case-sensitive identifier that you provide to ensure the idempotency
+	// of the request. Up to 36 letters, numbers, hyphens, and underscores are allowed.
+	ClientToken *string `locationName:"clientToken" type:"string" idempotencyToken:"true"`
 
+	// The name of the service mesh that the virtual router resides in.
+	//
 	// MeshName is a required field
 	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
 
-	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
+
+	// The new virtual router specification to apply. This overwrites the existing
+	// data.
+	//
+	// Spec is a required field
+	Spec *VirtualRouterSpec `locationName:"spec" type:"structure" required:"true"`
+
+	// The name of the virtual router to update.
+	//
+	// VirtualRouterName is a required field
+	VirtualRouterName *string `location:"uri" locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s ListVirtualNodesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateVirtualRouterInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListVirtualNodesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateVirtualRouterInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListVirtualNodesInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListVirtualNodesInput"}
-	if s.Limit != nil && *s.Limit < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
-	}
+func (s *UpdateVirtualRouterInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateVirtualRouterInput"}
 	if s.MeshName == nil {
 		invalidParams.Add(request.NewErrParamRequired("MeshName"))
 	}
 	if s.MeshName != nil && len(*s.MeshName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
 	}
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
+	}
+	if s.Spec == nil {
+		invalidParams.Add(request.NewErrParamRequired("Spec"))
+	}
+	if s.VirtualRouterName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualRouterName"))
+	}
+	if s.VirtualRouterName != nil && len(*s.VirtualRouterName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VirtualRouterName", 1))
+	}
+	if s.Spec != nil {
+		if err := s.Spec.Validate(); err != nil {
+			invalidParams.AddNested("Spec", err.(request.ErrInvalidParams))
+		}
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -5844,88 +16158,143 @@ func (s *ListVirtualNodesInput) Validate() error {
 	return nil
 }
 
-// SetLimit sets the Limit field's value.
-func (s *ListVirtualNodesInput) SetLimit(v int64) *ListVirtualNodesInput {
-	s.Limit = &v
+// SetClientToken sets the ClientToken field's value.
+func (s *UpdateVirtualRouterInput) SetClientToken(v string) *UpdateVirtualRouterInput {
+	s.ClientToken = &v
 	return s
 }
 
 // SetMeshName sets the MeshName field's value.
-func (s *ListVirtualNodesInput) SetMeshName(v string) *ListVirtualNodesInput {
+func (s *UpdateVirtualRouterInput) SetMeshName(v string) *UpdateVirtualRouterInput {
 	s.MeshName = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListVirtualNodesInput) SetNextToken(v string) *ListVirtualNodesInput {
-	s.NextToken = &v
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *UpdateVirtualRouterInput) SetMeshOwner(v string) *UpdateVirtualRouterInput {
+	s.MeshOwner = &v
 	return s
 }
 
-type ListVirtualNodesOutput struct {
-	_ struct{} `type:"structure"`
+// SetSpec sets the Spec field's value.
+func (s *UpdateVirtualRouterInput) SetSpec(v *VirtualRouterSpec) *UpdateVirtualRouterInput {
+	s.Spec = v
+	return s
+}
 
-	NextToken *string `locationName:"nextToken" type:"string"`
+// SetVirtualRouterName sets the VirtualRouterName field's value.
+func (s *UpdateVirtualRouterInput) SetVirtualRouterName(v string) *UpdateVirtualRouterInput {
+	s.VirtualRouterName = &v
+	return s
+}
 
-	// VirtualNodes is a required field
-	VirtualNodes []*VirtualNodeRef `locationName:"virtualNodes" type:"list" required:"true"`
+type UpdateVirtualRouterOutput struct {
+	_ struct{} `type:"structure" payload:"VirtualRouter"`
+
+	// A full description of the virtual router that was updated.
+	//
+	// VirtualRouter is a required field
+	VirtualRouter *VirtualRouterData `locationName:"virtualRouter" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s ListVirtualNodesOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateVirtualRouterOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListVirtualNodesOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateVirtualRouterOutput) GoString() string {
 	return s.String()
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListVirtualNodesOutput) SetNextToken(v string) *ListVirtualNodesOutput {
-	s.NextToken = &v
-	return s
-}
-
-// SetVirtualNodes sets the VirtualNodes field's value.
-func (s *ListVirtualNodesOutput) SetVirtualNodes(v []*VirtualNodeRef) *ListVirtualNodesOutput {
-	s.VirtualNodes = v
+// SetVirtualRouter sets the VirtualRouter field's value.
+func (s *UpdateVirtualRouterOutput) SetVirtualRouter(v *VirtualRouterData) *UpdateVirtualRouterOutput {
+	s.VirtualRouter = v
 	return s
 }
 
-type ListVirtualRoutersInput struct {
+type UpdateVirtualServiceInput struct {
 	_ struct{} `type:"structure"`
 
-	Limit *int64 `location:"querystring" locationName:"limit" min:"1" type:"integer"`
+	// Unique, case-sensitive identifier that you provide to ensure the idempotency
+	// of the request. Up to 36 letters, numbers, hyphens, and underscores are allowed.
+	ClientToken *string `locationName:"clientToken" type:"string" idempotencyToken:"true"`
 
+	// The name of the service mesh that the virtual service resides in.
+	//
 	// MeshName is a required field
 	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
 
-	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	MeshOwner *string `location:"querystring" locationName:"meshOwner" min:"12" type:"string"`
+
+	// The new virtual service specification to apply. This overwrites the existing
+	// data.
+	//
+	// Spec is a required field
+	Spec *VirtualServiceSpec `locationName:"spec" type:"structure" required:"true"`
+
+	// The name of the virtual service to update.
+	//
+	// VirtualServiceName is a required field
+	VirtualServiceName *string `location:"uri" locationName:"virtualServiceName" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s ListVirtualRoutersInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateVirtualServiceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListVirtualRoutersInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateVirtualServiceInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListVirtualRoutersInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListVirtualRoutersInput"}
-	if s.Limit != nil && *s.Limit < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
-	}
+func (s *UpdateVirtualServiceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateVirtualServiceInput"}
 	if s.MeshName == nil {
 		invalidParams.Add(request.NewErrParamRequired("MeshName"))
 	}
 	if s.MeshName != nil && len(*s.MeshName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
 	}
+	if s.MeshOwner != nil && len(*s.MeshOwner) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("MeshOwner", 12))
+	}
+	if s.Spec == nil {
+		invalidParams.Add(request.NewErrParamRequired("Spec"))
+	}
+	if s.VirtualServiceName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualServiceName"))
+	}
+	if s.VirtualServiceName != nil && len(*s.VirtualServiceName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VirtualServiceName", 1))
+	}
+	if s.Spec != nil {
+		if err := s.Spec.Validate(); err != nil {
+			invalidParams.AddNested("Spec", err.(request.ErrInvalidParams))
+		}
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -5933,87 +16302,102 @@ func (s *ListVirtualRoutersInput) Validate() error {
 	return nil
 }
 
-// SetLimit sets the Limit field's value.
-func (s *ListVirtualRoutersInput) SetLimit(v int64) *ListVirtualRoutersInput {
-	s.Limit = &v
+// SetClientToken sets the ClientToken field's value.
+func (s *UpdateVirtualServiceInput) SetClientToken(v string) *UpdateVirtualServiceInput {
+	s.ClientToken = &v
 	return s
 }
 
 // SetMeshName sets the MeshName field's value.
-func (s *ListVirtualRoutersInput) SetMeshName(v string) *ListVirtualRoutersInput {
+func (s *UpdateVirtualServiceInput) SetMeshName(v string) *UpdateVirtualServiceInput {
 	s.MeshName = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListVirtualRoutersInput) SetNextToken(v string) *ListVirtualRoutersInput {
-	s.NextToken = &v
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *UpdateVirtualServiceInput) SetMeshOwner(v string) *UpdateVirtualServiceInput {
+	s.MeshOwner = &v
 	return s
 }
 
-type ListVirtualRoutersOutput struct {
-	_ struct{} `type:"structure"`
+// SetSpec sets the Spec field's value.
+func (s *UpdateVirtualServiceInput) SetSpec(v *VirtualServiceSpec) *UpdateVirtualServiceInput {
+	s.Spec = v
+	return s
+}
 
-	NextToken *string `locationName:"nextToken" type:"string"`
+// SetVirtualServiceName sets the VirtualServiceName field's value.
+func (s *UpdateVirtualServiceInput) SetVirtualServiceName(v string) *UpdateVirtualServiceInput {
+	s.VirtualServiceName = &v
+	return s
+}
 
-	// VirtualRouters is a required field
-	VirtualRouters []*VirtualRouterRef `locationName:"virtualRouters" type:"list" required:"true"`
+type UpdateVirtualServiceOutput struct {
+	_ struct{} `type:"structure" payload:"VirtualService"`
+
+	// A full description of the virtual service that was updated.
+	//
+	// VirtualService is a required field
+	VirtualService *VirtualServiceData `locationName:"virtualService" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s ListVirtualRoutersOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateVirtualServiceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListVirtualRoutersOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateVirtualServiceOutput) GoString() string {
 	return s.String()
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListVirtualRoutersOutput) SetNextToken(v string) *ListVirtualRoutersOutput {
-	s.NextToken = &v
-	return s
-}
-
-// SetVirtualRouters sets the VirtualRouters field's value.
-func (s *ListVirtualRoutersOutput) SetVirtualRouters(v []*VirtualRouterRef) *ListVirtualRoutersOutput {
-	s.VirtualRouters = v
+// SetVirtualService sets the VirtualService field's value.
+func (s *UpdateVirtualServiceOutput) SetVirtualService(v *VirtualServiceData) *UpdateVirtualServiceOutput {
+	s.VirtualService = v
 	return s
 }
 
-type ListVirtualServicesInput struct {
+// The access log configuration for a virtual gateway.
+type VirtualGatewayAccessLog struct {
 	_ struct{} `type:"structure"`
 
-	Limit *int64 `location:"querystring" locationName:"limit" min:"1" type:"integer"`
-
-	// MeshName is a required field
-	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
-
-	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
+	// The file object to send virtual gateway access logs to.
+	File *VirtualGatewayFileAccessLog `locationName:"file" type:"structure"`
 }
 
-// String returns the string representation
-func (s ListVirtualServicesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayAccessLog) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListVirtualServicesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayAccessLog) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListVirtualServicesInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListVirtualServicesInput"}
-	if s.Limit != nil && *s.Limit < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
-	}
-	if s.MeshName == nil {
-		invalidParams.Add(request.NewErrParamRequired("MeshName"))
-	}
-	if s.MeshName != nil && len(*s.MeshName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
+func (s *VirtualGatewayAccessLog) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayAccessLog"}
+	if s.File != nil {
+		if err := s.File.Validate(); err != nil {
+			invalidParams.AddNested("File", err.(request.ErrInvalidParams))
+		}
 	}
 
 	if invalidParams.Len() > 0 {
@@ -6022,92 +16406,160 @@ func (s *ListVirtualServicesInput) Validate() error {
 	return nil
 }
 
-// SetLimit sets the Limit field's value.
-func (s *ListVirtualServicesInput) SetLimit(v int64) *ListVirtualServicesInput {
-	s.Limit = &v
+// SetFile sets the File field's value.
+func (s *VirtualGatewayAccessLog) SetFile(v *VirtualGatewayFileAccessLog) *VirtualGatewayAccessLog {
+	s.File = v
 	return s
 }
 
-// SetMeshName sets the MeshName field's value.
-func (s *ListVirtualServicesInput) SetMeshName(v string) *ListVirtualServicesInput {
-	s.MeshName = &v
-	return s
+// An object that represents the default properties for a backend.
+type VirtualGatewayBackendDefaults struct {
+	_ struct{} `type:"structure"`
+
+	// A reference to an object that represents a client policy.
+	ClientPolicy *VirtualGatewayClientPolicy `locationName:"clientPolicy" type:"structure"`
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListVirtualServicesInput) SetNextToken(v string) *ListVirtualServicesInput {
-	s.NextToken = &v
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayBackendDefaults) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayBackendDefaults) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *VirtualGatewayBackendDefaults) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayBackendDefaults"}
+	if s.ClientPolicy != nil {
+		if err := s.ClientPolicy.Validate(); err != nil {
+			invalidParams.AddNested("ClientPolicy", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetClientPolicy sets the ClientPolicy field's value.
+func (s *VirtualGatewayBackendDefaults) SetClientPolicy(v *VirtualGatewayClientPolicy) *VirtualGatewayBackendDefaults {
+	s.ClientPolicy = v
 	return s
 }
 
-type ListVirtualServicesOutput struct {
+// An object that represents a client policy.
+type VirtualGatewayClientPolicy struct {
 	_ struct{} `type:"structure"`
 
-	NextToken *string `locationName:"nextToken" type:"string"`
-
-	// VirtualServices is a required field
-	VirtualServices []*VirtualServiceRef `locationName:"virtualServices" type:"list" required:"true"`
+	// A reference to an object that represents a Transport Layer Security (TLS)
+	// client policy.
+	Tls *VirtualGatewayClientPolicyTls `locationName:"tls" type:"structure"`
 }
 
-// String returns the string representation
-func (s ListVirtualServicesOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayClientPolicy) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListVirtualServicesOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayClientPolicy) GoString() string {
 	return s.String()
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListVirtualServicesOutput) SetNextToken(v string) *ListVirtualServicesOutput {
-	s.NextToken = &v
-	return s
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *VirtualGatewayClientPolicy) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayClientPolicy"}
+	if s.Tls != nil {
+		if err := s.Tls.Validate(); err != nil {
+			invalidParams.AddNested("Tls", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetVirtualServices sets the VirtualServices field's value.
-func (s *ListVirtualServicesOutput) SetVirtualServices(v []*VirtualServiceRef) *ListVirtualServicesOutput {
-	s.VirtualServices = v
+// SetTls sets the Tls field's value.
+func (s *VirtualGatewayClientPolicy) SetTls(v *VirtualGatewayClientPolicyTls) *VirtualGatewayClientPolicy {
+	s.Tls = v
 	return s
 }
 
-// An object representing a listener for a virtual node.
-type Listener struct {
+// An object that represents a Transport Layer Security (TLS) client policy.
+type VirtualGatewayClientPolicyTls struct {
 	_ struct{} `type:"structure"`
 
-	// An object representing the health check policy for a virtual node's listener.
-	HealthCheck *HealthCheckPolicy `locationName:"healthCheck" type:"structure"`
+	// A reference to an object that represents a virtual gateway's client's Transport
+	// Layer Security (TLS) certificate.
+	Certificate *VirtualGatewayClientTlsCertificate `locationName:"certificate" type:"structure"`
 
-	// An object representing a virtual node or virtual router listener port mapping.
+	// Whether the policy is enforced. The default is True, if a value isn't specified.
+	Enforce *bool `locationName:"enforce" type:"boolean"`
+
+	// One or more ports that the policy is enforced for.
+	Ports []*int64 `locationName:"ports" type:"list"`
+
+	// A reference to an object that represents a Transport Layer Security (TLS)
+	// validation context.
 	//
-	// PortMapping is a required field
-	PortMapping *PortMapping `locationName:"portMapping" type:"structure" required:"true"`
+	// Validation is a required field
+	Validation *VirtualGatewayTlsValidationContext `locationName:"validation" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s Listener) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayClientPolicyTls) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Listener) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayClientPolicyTls) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *Listener) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "Listener"}
-	if s.PortMapping == nil {
-		invalidParams.Add(request.NewErrParamRequired("PortMapping"))
-	}
-	if s.HealthCheck != nil {
-		if err := s.HealthCheck.Validate(); err != nil {
-			invalidParams.AddNested("HealthCheck", err.(request.ErrInvalidParams))
+func (s *VirtualGatewayClientPolicyTls) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayClientPolicyTls"}
+	if s.Validation == nil {
+		invalidParams.Add(request.NewErrParamRequired("Validation"))
+	}
+	if s.Certificate != nil {
+		if err := s.Certificate.Validate(); err != nil {
+			invalidParams.AddNested("Certificate", err.(request.ErrInvalidParams))
 		}
 	}
-	if s.PortMapping != nil {
-		if err := s.PortMapping.Validate(); err != nil {
-			invalidParams.AddNested("PortMapping", err.(request.ErrInvalidParams))
+	if s.Validation != nil {
+		if err := s.Validation.Validate(); err != nil {
+			invalidParams.AddNested("Validation", err.(request.ErrInvalidParams))
 		}
 	}
 
@@ -6117,42 +16569,74 @@ func (s *Listener) Validate() error {
 	return nil
 }
 
-// SetHealthCheck sets the HealthCheck field's value.
-func (s *Listener) SetHealthCheck(v *HealthCheckPolicy) *Listener {
-	s.HealthCheck = v
+// SetCertificate sets the Certificate field's value.
+func (s *VirtualGatewayClientPolicyTls) SetCertificate(v *VirtualGatewayClientTlsCertificate) *VirtualGatewayClientPolicyTls {
+	s.Certificate = v
 	return s
 }
 
-// SetPortMapping sets the PortMapping field's value.
-func (s *Listener) SetPortMapping(v *PortMapping) *Listener {
-	s.PortMapping = v
+// SetEnforce sets the Enforce field's value.
+func (s *VirtualGatewayClientPolicyTls) SetEnforce(v bool) *VirtualGatewayClientPolicyTls {
+	s.Enforce = &v
 	return s
 }
 
-// An object representing the logging information for a virtual node.
-type Logging struct {
+// SetPorts sets the Ports field's value.
+func (s *VirtualGatewayClientPolicyTls) SetPorts(v []*int64) *VirtualGatewayClientPolicyTls {
+	s.Ports = v
+	return s
+}
+
+// SetValidation sets the Validation field's value.
+func (s *VirtualGatewayClientPolicyTls) SetValidation(v *VirtualGatewayTlsValidationContext) *VirtualGatewayClientPolicyTls {
+	s.Validation = v
+	return s
+}
+
+// An object that represents the virtual gateway's client's Transport Layer
+// Security (TLS) certificate.
+type VirtualGatewayClientTlsCertificate struct {
 	_ struct{} `type:"structure"`
 
-	// An object representing the access logging information for a virtual node.
-	AccessLog *AccessLog `locationName:"accessLog" type:"structure"`
+	// An object that represents a local file certificate. The certificate must
+	// meet specific requirements and you must have proxy authorization enabled.
+	// For more information, see Transport Layer Security (TLS) (https://docs.aws.amazon.com/app-mesh/latest/userguide/tls.html).
+	File *VirtualGatewayListenerTlsFileCertificate `locationName:"file" type:"structure"`
+
+	// A reference to an object that represents a virtual gateway's client's Secret
+	// Discovery Service certificate.
+	Sds *VirtualGatewayListenerTlsSdsCertificate `locationName:"sds" type:"structure"`
 }
 
-// String returns the string representation
-func (s Logging) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayClientTlsCertificate) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Logging) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayClientTlsCertificate) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *Logging) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "Logging"}
-	if s.AccessLog != nil {
-		if err := s.AccessLog.Validate(); err != nil {
-			invalidParams.AddNested("AccessLog", err.(request.ErrInvalidParams))
+func (s *VirtualGatewayClientTlsCertificate) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayClientTlsCertificate"}
+	if s.File != nil {
+		if err := s.File.Validate(); err != nil {
+			invalidParams.AddNested("File", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Sds != nil {
+		if err := s.Sds.Validate(); err != nil {
+			invalidParams.AddNested("Sds", err.(request.ErrInvalidParams))
 		}
 	}
 
@@ -6162,43 +16646,72 @@ func (s *Logging) Validate() error {
 	return nil
 }
 
-// SetAccessLog sets the AccessLog field's value.
-func (s *Logging) SetAccessLog(v *AccessLog) *Logging {
-	s.AccessLog = v
+// SetFile sets the File field's value.
+func (s *VirtualGatewayClientTlsCertificate) SetFile(v *VirtualGatewayListenerTlsFileCertificate) *VirtualGatewayClientTlsCertificate {
+	s.File = v
 	return s
 }
 
-// The range of values to match on. The first character of the range is included
-// in the range, though the last character is not. For example, if the range
-// specified were 1-100, only values 1-99 would be matched.
-type MatchRange struct {
+// SetSds sets the Sds field's value.
+func (s *VirtualGatewayClientTlsCertificate) SetSds(v *VirtualGatewayListenerTlsSdsCertificate) *VirtualGatewayClientTlsCertificate {
+	s.Sds = v
+	return s
+}
+
+// An object that represents the type of virtual gateway connection pool.
+//
+// Only one protocol is used at a time and should be the same protocol as the
+// one chosen under port mapping.
+//
+// If not present the default value for maxPendingRequests is 2147483647.
+type VirtualGatewayConnectionPool struct {
 	_ struct{} `type:"structure"`
 
-	// End is a required field
-	End *int64 `locationName:"end" type:"long" required:"true"`
+	// An object that represents a type of connection pool.
+	Grpc *VirtualGatewayGrpcConnectionPool `locationName:"grpc" type:"structure"`
 
-	// Start is a required field
-	Start *int64 `locationName:"start" type:"long" required:"true"`
+	// An object that represents a type of connection pool.
+	Http *VirtualGatewayHttpConnectionPool `locationName:"http" type:"structure"`
+
+	// An object that represents a type of connection pool.
+	Http2 *VirtualGatewayHttp2ConnectionPool `locationName:"http2" type:"structure"`
 }
 
-// String returns the string representation
-func (s MatchRange) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayConnectionPool) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s MatchRange) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayConnectionPool) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *MatchRange) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "MatchRange"}
-	if s.End == nil {
-		invalidParams.Add(request.NewErrParamRequired("End"))
+func (s *VirtualGatewayConnectionPool) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayConnectionPool"}
+	if s.Grpc != nil {
+		if err := s.Grpc.Validate(); err != nil {
+			invalidParams.AddNested("Grpc", err.(request.ErrInvalidParams))
+		}
 	}
-	if s.Start == nil {
-		invalidParams.Add(request.NewErrParamRequired("Start"))
+	if s.Http != nil {
+		if err := s.Http.Validate(); err != nil {
+			invalidParams.AddNested("Http", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Http2 != nil {
+		if err := s.Http2.Validate(); err != nil {
+			invalidParams.AddNested("Http2", err.(request.ErrInvalidParams))
+		}
 	}
 
 	if invalidParams.Len() > 0 {
@@ -6207,133 +16720,208 @@ func (s *MatchRange) Validate() error {
 	return nil
 }
 
-// SetEnd sets the End field's value.
-func (s *MatchRange) SetEnd(v int64) *MatchRange {
-	s.End = &v
+// SetGrpc sets the Grpc field's value.
+func (s *VirtualGatewayConnectionPool) SetGrpc(v *VirtualGatewayGrpcConnectionPool) *VirtualGatewayConnectionPool {
+	s.Grpc = v
 	return s
 }
 
-// SetStart sets the Start field's value.
-func (s *MatchRange) SetStart(v int64) *MatchRange {
-	s.Start = &v
+// SetHttp sets the Http field's value.
+func (s *VirtualGatewayConnectionPool) SetHttp(v *VirtualGatewayHttpConnectionPool) *VirtualGatewayConnectionPool {
+	s.Http = v
 	return s
 }
 
-// An object representing a service mesh returned by a describe operation.
-type MeshData struct {
+// SetHttp2 sets the Http2 field's value.
+func (s *VirtualGatewayConnectionPool) SetHttp2(v *VirtualGatewayHttp2ConnectionPool) *VirtualGatewayConnectionPool {
+	s.Http2 = v
+	return s
+}
+
+// An object that represents a virtual gateway returned by a describe operation.
+type VirtualGatewayData struct {
 	_ struct{} `type:"structure"`
 
+	// The name of the service mesh that the virtual gateway resides in.
+	//
 	// MeshName is a required field
 	MeshName *string `locationName:"meshName" min:"1" type:"string" required:"true"`
 
-	// An object representing metadata for a resource.
+	// An object that represents metadata for a resource.
 	//
 	// Metadata is a required field
 	Metadata *ResourceMetadata `locationName:"metadata" type:"structure" required:"true"`
 
-	// An object representing the specification of a service mesh.
+	// The specifications of the virtual gateway.
 	//
 	// Spec is a required field
-	Spec *MeshSpec `locationName:"spec" type:"structure" required:"true"`
+	Spec *VirtualGatewaySpec `locationName:"spec" type:"structure" required:"true"`
 
-	// An object representing the status of a service mesh.
+	// The current status of the virtual gateway.
 	//
 	// Status is a required field
-	Status *MeshStatus `locationName:"status" type:"structure" required:"true"`
+	Status *VirtualGatewayStatus `locationName:"status" type:"structure" required:"true"`
+
+	// The name of the virtual gateway.
+	//
+	// VirtualGatewayName is a required field
+	VirtualGatewayName *string `locationName:"virtualGatewayName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s MeshData) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayData) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s MeshData) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayData) GoString() string {
 	return s.String()
 }
 
 // SetMeshName sets the MeshName field's value.
-func (s *MeshData) SetMeshName(v string) *MeshData {
+func (s *VirtualGatewayData) SetMeshName(v string) *VirtualGatewayData {
 	s.MeshName = &v
 	return s
 }
 
 // SetMetadata sets the Metadata field's value.
-func (s *MeshData) SetMetadata(v *ResourceMetadata) *MeshData {
+func (s *VirtualGatewayData) SetMetadata(v *ResourceMetadata) *VirtualGatewayData {
 	s.Metadata = v
 	return s
 }
 
 // SetSpec sets the Spec field's value.
-func (s *MeshData) SetSpec(v *MeshSpec) *MeshData {
+func (s *VirtualGatewayData) SetSpec(v *VirtualGatewaySpec) *VirtualGatewayData {
 	s.Spec = v
 	return s
 }
 
 // SetStatus sets the Status field's value.
-func (s *MeshData) SetStatus(v *MeshStatus) *MeshData {
+func (s *VirtualGatewayData) SetStatus(v *VirtualGatewayStatus) *VirtualGatewayData {
 	s.Status = v
 	return s
 }
 
-// An object representing a service mesh returned by a list operation.
-type MeshRef struct {
+// SetVirtualGatewayName sets the VirtualGatewayName field's value.
+func (s *VirtualGatewayData) SetVirtualGatewayName(v string) *VirtualGatewayData {
+	s.VirtualGatewayName = &v
+	return s
+}
+
+// An object that represents an access log file.
+type VirtualGatewayFileAccessLog struct {
 	_ struct{} `type:"structure"`
 
-	// Arn is a required field
-	Arn *string `locationName:"arn" type:"string" required:"true"`
+	// The specified format for the virtual gateway access logs. It can be either
+	// json_format or text_format.
+	Format *LoggingFormat `locationName:"format" type:"structure"`
 
-	// MeshName is a required field
-	MeshName *string `locationName:"meshName" min:"1" type:"string" required:"true"`
+	// The file path to write access logs to. You can use /dev/stdout to send access
+	// logs to standard out and configure your Envoy container to use a log driver,
+	// such as awslogs, to export the access logs to a log storage service such
+	// as Amazon CloudWatch Logs. You can also specify a path in the Envoy container's
+	// file system to write the files to disk.
+	//
+	// Path is a required field
+	Path *string `locationName:"path" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s MeshRef) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayFileAccessLog) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s MeshRef) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayFileAccessLog) GoString() string {
 	return s.String()
 }
 
-// SetArn sets the Arn field's value.
-func (s *MeshRef) SetArn(v string) *MeshRef {
-	s.Arn = &v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *VirtualGatewayFileAccessLog) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayFileAccessLog"}
+	if s.Path == nil {
+		invalidParams.Add(request.NewErrParamRequired("Path"))
+	}
+	if s.Path != nil && len(*s.Path) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Path", 1))
+	}
+	if s.Format != nil {
+		if err := s.Format.Validate(); err != nil {
+			invalidParams.AddNested("Format", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetFormat sets the Format field's value.
+func (s *VirtualGatewayFileAccessLog) SetFormat(v *LoggingFormat) *VirtualGatewayFileAccessLog {
+	s.Format = v
 	return s
 }
 
-// SetMeshName sets the MeshName field's value.
-func (s *MeshRef) SetMeshName(v string) *MeshRef {
-	s.MeshName = &v
+// SetPath sets the Path field's value.
+func (s *VirtualGatewayFileAccessLog) SetPath(v string) *VirtualGatewayFileAccessLog {
+	s.Path = &v
 	return s
 }
 
-// An object representing the specification of a service mesh.
-type MeshSpec struct {
+// An object that represents a type of connection pool.
+type VirtualGatewayGrpcConnectionPool struct {
 	_ struct{} `type:"structure"`
 
-	// An object representing the egress filter rules for a service mesh.
-	EgressFilter *EgressFilter `locationName:"egressFilter" type:"structure"`
+	// Maximum number of inflight requests Envoy can concurrently support across
+	// hosts in upstream cluster.
+	//
+	// MaxRequests is a required field
+	MaxRequests *int64 `locationName:"maxRequests" min:"1" type:"integer" required:"true"`
 }
 
-// String returns the string representation
-func (s MeshSpec) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayGrpcConnectionPool) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s MeshSpec) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayGrpcConnectionPool) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *MeshSpec) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "MeshSpec"}
-	if s.EgressFilter != nil {
-		if err := s.EgressFilter.Validate(); err != nil {
-			invalidParams.AddNested("EgressFilter", err.(request.ErrInvalidParams))
-		}
+func (s *VirtualGatewayGrpcConnectionPool) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayGrpcConnectionPool"}
+	if s.MaxRequests == nil {
+		invalidParams.Add(request.NewErrParamRequired("MaxRequests"))
+	}
+	if s.MaxRequests != nil && *s.MaxRequests < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxRequests", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -6342,61 +16930,88 @@ func (s *MeshSpec) Validate() error {
 	return nil
 }
 
-// SetEgressFilter sets the EgressFilter field's value.
-func (s *MeshSpec) SetEgressFilter(v *EgressFilter) *MeshSpec {
-	s.EgressFilter = v
+// SetMaxRequests sets the MaxRequests field's value.
+func (s *VirtualGatewayGrpcConnectionPool) SetMaxRequests(v int64) *VirtualGatewayGrpcConnectionPool {
+	s.MaxRequests = &v
 	return s
 }
 
-// An object representing the status of a service mesh.
-type MeshStatus struct {
+// An object that represents the health check policy for a virtual gateway's
+// listener.
+type VirtualGatewayHealthCheckPolicy struct {
 	_ struct{} `type:"structure"`
 
-	Status *string `locationName:"status" type:"string" enum:"MeshStatusCode"`
-}
+	// The number of consecutive successful health checks that must occur before
+	// declaring the listener healthy.
+	//
+	// HealthyThreshold is a required field
+	HealthyThreshold *int64 `locationName:"healthyThreshold" min:"2" type:"integer" required:"true"`
 
-// String returns the string representation
-func (s MeshStatus) String() string {
-	return awsutil.Prettify(s)
-}
+	// The time period in milliseconds between each health check execution.
+	//
+	// IntervalMillis is a required field
+	IntervalMillis *int64 `locationName:"intervalMillis" min:"5000" type:"long" required:"true"`
 
-// GoString returns the string representation
-func (s MeshStatus) GoString() string {
-	return s.String()
-}
+	// The destination path for the health check request. This value is only used
+	// if the specified protocol is HTTP or HTTP/2. For any other protocol, this
+	// value is ignored.
+	Path *string `locationName:"path" type:"string"`
 
-// SetStatus sets the Status field's value.
-func (s *MeshStatus) SetStatus(v string) *MeshStatus {
-	s.Status = &v
-	return s
-}
+	// The destination port for the health check request. This port must match the
+	// port defined in the PortMapping for the listener.
+	Port *int64 `locationName:"port" min:"1" type:"integer"`
 
-// An object representing a virtual node or virtual router listener port mapping.
-type PortMapping struct {
-	_ struct{} `type:"structure"`
+	// The protocol for the health check request. If you specify grpc, then your
+	// service must conform to the GRPC Health Checking Protocol (https://github.com/grpc/grpc/blob/master/doc/health-checking.md).
+	//
+	// Protocol is a required field
+	Protocol *string `locationName:"protocol" type:"string" required:"true" enum:"VirtualGatewayPortProtocol"`
 
-	// Port is a required field
-	Port *int64 `locationName:"port" min:"1" type:"integer" required:"true"`
+	// The amount of time to wait when receiving a response from the health check,
+	// in milliseconds.
+	//
+	// TimeoutMillis is a required field
+	TimeoutMillis *int64 `locationName:"timeoutMillis" min:"2000" type:"long" required:"true"`
 
-	// Protocol is a required field
-	Protocol *string `locationName:"protocol" type:"string" required:"true" enum:"PortProtocol"`
+	// The number of consecutive failed health checks that must occur before declaring
+	// a virtual gateway unhealthy.
+	//
+	// UnhealthyThreshold is a required field
+	UnhealthyThreshold *int64 `locationName:"unhealthyThreshold" min:"2" type:"integer" required:"true"`
 }
 
-// String returns the string representation
-func (s PortMapping) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayHealthCheckPolicy) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s PortMapping) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayHealthCheckPolicy) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *PortMapping) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "PortMapping"}
-	if s.Port == nil {
-		invalidParams.Add(request.NewErrParamRequired("Port"))
+func (s *VirtualGatewayHealthCheckPolicy) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayHealthCheckPolicy"}
+	if s.HealthyThreshold == nil {
+		invalidParams.Add(request.NewErrParamRequired("HealthyThreshold"))
+	}
+	if s.HealthyThreshold != nil && *s.HealthyThreshold < 2 {
+		invalidParams.Add(request.NewErrParamMinValue("HealthyThreshold", 2))
+	}
+	if s.IntervalMillis == nil {
+		invalidParams.Add(request.NewErrParamRequired("IntervalMillis"))
+	}
+	if s.IntervalMillis != nil && *s.IntervalMillis < 5000 {
+		invalidParams.Add(request.NewErrParamMinValue("IntervalMillis", 5000))
 	}
 	if s.Port != nil && *s.Port < 1 {
 		invalidParams.Add(request.NewErrParamMinValue("Port", 1))
@@ -6404,6 +17019,18 @@ func (s *PortMapping) Validate() error {
 	if s.Protocol == nil {
 		invalidParams.Add(request.NewErrParamRequired("Protocol"))
 	}
+	if s.TimeoutMillis == nil {
+		invalidParams.Add(request.NewErrParamRequired("TimeoutMillis"))
+	}
+	if s.TimeoutMillis != nil && *s.TimeoutMillis < 2000 {
+		invalidParams.Add(request.NewErrParamMinValue("TimeoutMillis", 2000))
+	}
+	if s.UnhealthyThreshold == nil {
+		invalidParams.Add(request.NewErrParamRequired("UnhealthyThreshold"))
+	}
+	if s.UnhealthyThreshold != nil && *s.UnhealthyThreshold < 2 {
+		invalidParams.Add(request.NewErrParamMinValue("UnhealthyThreshold", 2))
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -6411,238 +17038,319 @@ func (s *PortMapping) Validate() error {
 	return nil
 }
 
-// SetPort sets the Port field's value.
-func (s *PortMapping) SetPort(v int64) *PortMapping {
-	s.Port = &v
+// SetHealthyThreshold sets the HealthyThreshold field's value.
+func (s *VirtualGatewayHealthCheckPolicy) SetHealthyThreshold(v int64) *VirtualGatewayHealthCheckPolicy {
+	s.HealthyThreshold = &v
 	return s
 }
 
-// SetProtocol sets the Protocol field's value.
-func (s *PortMapping) SetProtocol(v string) *PortMapping {
-	s.Protocol = &v
+// SetIntervalMillis sets the IntervalMillis field's value.
+func (s *VirtualGatewayHealthCheckPolicy) SetIntervalMillis(v int64) *VirtualGatewayHealthCheckPolicy {
+	s.IntervalMillis = &v
 	return s
 }
 
-// An object representing metadata for a resource.
-type ResourceMetadata struct {
-	_ struct{} `type:"structure"`
-
-	// Arn is a required field
-	Arn *string `locationName:"arn" type:"string" required:"true"`
-
-	// CreatedAt is a required field
-	CreatedAt *time.Time `locationName:"createdAt" type:"timestamp" required:"true"`
-
-	// LastUpdatedAt is a required field
-	LastUpdatedAt *time.Time `locationName:"lastUpdatedAt" type:"timestamp" required:"true"`
-
-	// Uid is a required field
-	Uid *string `locationName:"uid" type:"string" required:"true"`
-
-	// Version is a required field
-	Version *int64 `locationName:"version" type:"long" required:"true"`
-}
-
-// String returns the string representation
-func (s ResourceMetadata) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s ResourceMetadata) GoString() string {
-	return s.String()
-}
-
-// SetArn sets the Arn field's value.
-func (s *ResourceMetadata) SetArn(v string) *ResourceMetadata {
-	s.Arn = &v
+// SetPath sets the Path field's value.
+func (s *VirtualGatewayHealthCheckPolicy) SetPath(v string) *VirtualGatewayHealthCheckPolicy {
+	s.Path = &v
 	return s
 }
 
-// SetCreatedAt sets the CreatedAt field's value.
-func (s *ResourceMetadata) SetCreatedAt(v time.Time) *ResourceMetadata {
-	s.CreatedAt = &v
+// SetPort sets the Port field's value.
+func (s *VirtualGatewayHealthCheckPolicy) SetPort(v int64) *VirtualGatewayHealthCheckPolicy {
+	s.Port = &v
 	return s
 }
 
-// SetLastUpdatedAt sets the LastUpdatedAt field's value.
-func (s *ResourceMetadata) SetLastUpdatedAt(v time.Time) *ResourceMetadata {
-	s.LastUpdatedAt = &v
+// SetProtocol sets the Protocol field's value.
+func (s *VirtualGatewayHealthCheckPolicy) SetProtocol(v string) *VirtualGatewayHealthCheckPolicy {
+	s.Protocol = &v
 	return s
 }
 
-// SetUid sets the Uid field's value.
-func (s *ResourceMetadata) SetUid(v string) *ResourceMetadata {
-	s.Uid = &v
+// SetTimeoutMillis sets the TimeoutMillis field's value.
+func (s *VirtualGatewayHealthCheckPolicy) SetTimeoutMillis(v int64) *VirtualGatewayHealthCheckPolicy {
+	s.TimeoutMillis = &v
 	return s
 }
 
-// SetVersion sets the Version field's value.
-func (s *ResourceMetadata) SetVersion(v int64) *ResourceMetadata {
-	s.Version = &v
+// SetUnhealthyThreshold sets the UnhealthyThreshold field's value.
+func (s *VirtualGatewayHealthCheckPolicy) SetUnhealthyThreshold(v int64) *VirtualGatewayHealthCheckPolicy {
+	s.UnhealthyThreshold = &v
 	return s
 }
 
-// An object representing a route returned by a describe operation.
-type RouteData struct {
+// An object that represents a type of connection pool.
+type VirtualGatewayHttp2ConnectionPool struct {
 	_ struct{} `type:"structure"`
 
-	// MeshName is a required field
-	MeshName *string `locationName:"meshName" min:"1" type:"string" required:"true"`
-
-	// An object representing metadata for a resource.
-	//
-	// Metadata is a required field
-	Metadata *ResourceMetadata `locationName:"metadata" type:"structure" required:"true"`
-
-	// RouteName is a required field
-	RouteName *string `locationName:"routeName" min:"1" type:"string" required:"true"`
-
-	// An object representing the specification of a route.
-	//
-	// Spec is a required field
-	Spec *RouteSpec `locationName:"spec" type:"structure" required:"true"`
-
-	// An object representing the current status of a route.
+	// Maximum number of inflight requests Envoy can concurrently support across
+	// hosts in upstream cluster.
 	//
-	// Status is a required field
-	Status *RouteStatus `locationName:"status" type:"structure" required:"true"`
-
-	// VirtualRouterName is a required field
-	VirtualRouterName *string `locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
+	// MaxRequests is a required field
+	MaxRequests *int64 `locationName:"maxRequests" min:"1" type:"integer" required:"true"`
 }
 
-// String returns the string representation
-func (s RouteData) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayHttp2ConnectionPool) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s RouteData) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayHttp2ConnectionPool) GoString() string {
 	return s.String()
 }
 
-// SetMeshName sets the MeshName field's value.
-func (s *RouteData) SetMeshName(v string) *RouteData {
-	s.MeshName = &v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *VirtualGatewayHttp2ConnectionPool) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayHttp2ConnectionPool"}
+	if s.MaxRequests == nil {
+		invalidParams.Add(request.NewErrParamRequired("MaxRequests"))
+	}
+	if s.MaxRequests != nil && *s.MaxRequests < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxRequests", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetMaxRequests sets the MaxRequests field's value.
+func (s *VirtualGatewayHttp2ConnectionPool) SetMaxRequests(v int64) *VirtualGatewayHttp2ConnectionPool {
+	s.MaxRequests = &v
 	return s
 }
 
-// SetMetadata sets the Metadata field's value.
-func (s *RouteData) SetMetadata(v *ResourceMetadata) *RouteData {
-	s.Metadata = v
-	return s
+// An object that represents a type of connection pool.
+type VirtualGatewayHttpConnectionPool struct {
+	_ struct{} `type:"structure"`
+
+	// Maximum number of outbound TCP connections Envoy can establish concurrently
+	// with all hosts in upstream cluster.
+	//
+	// MaxConnections is a required field
+	MaxConnections *int64 `locationName:"maxConnections" min:"1" type:"integer" required:"true"`
+
+	// Number of overflowing requests after max_connections Envoy will queue to
+	// upstream cluster.
+	MaxPendingRequests *int64 `locationName:"maxPendingRequests" min:"1" type:"integer"`
 }
 
-// SetRouteName sets the RouteName field's value.
-func (s *RouteData) SetRouteName(v string) *RouteData {
-	s.RouteName = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayHttpConnectionPool) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetSpec sets the Spec field's value.
-func (s *RouteData) SetSpec(v *RouteSpec) *RouteData {
-	s.Spec = v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayHttpConnectionPool) GoString() string {
+	return s.String()
 }
 
-// SetStatus sets the Status field's value.
-func (s *RouteData) SetStatus(v *RouteStatus) *RouteData {
-	s.Status = v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *VirtualGatewayHttpConnectionPool) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayHttpConnectionPool"}
+	if s.MaxConnections == nil {
+		invalidParams.Add(request.NewErrParamRequired("MaxConnections"))
+	}
+	if s.MaxConnections != nil && *s.MaxConnections < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxConnections", 1))
+	}
+	if s.MaxPendingRequests != nil && *s.MaxPendingRequests < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxPendingRequests", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetMaxConnections sets the MaxConnections field's value.
+func (s *VirtualGatewayHttpConnectionPool) SetMaxConnections(v int64) *VirtualGatewayHttpConnectionPool {
+	s.MaxConnections = &v
 	return s
 }
 
-// SetVirtualRouterName sets the VirtualRouterName field's value.
-func (s *RouteData) SetVirtualRouterName(v string) *RouteData {
-	s.VirtualRouterName = &v
+// SetMaxPendingRequests sets the MaxPendingRequests field's value.
+func (s *VirtualGatewayHttpConnectionPool) SetMaxPendingRequests(v int64) *VirtualGatewayHttpConnectionPool {
+	s.MaxPendingRequests = &v
 	return s
 }
 
-// An object representing a route returned by a list operation.
-type RouteRef struct {
+// An object that represents a listener for a virtual gateway.
+type VirtualGatewayListener struct {
 	_ struct{} `type:"structure"`
 
-	// Arn is a required field
-	Arn *string `locationName:"arn" type:"string" required:"true"`
+	// The connection pool information for the virtual gateway listener.
+	ConnectionPool *VirtualGatewayConnectionPool `locationName:"connectionPool" type:"structure"`
 
-	// MeshName is a required field
-	MeshName *string `locationName:"meshName" min:"1" type:"string" required:"true"`
+	// The health check information for the listener.
+	HealthCheck *VirtualGatewayHealthCheckPolicy `locationName:"healthCheck" type:"structure"`
 
-	// RouteName is a required field
-	RouteName *string `locationName:"routeName" min:"1" type:"string" required:"true"`
+	// The port mapping information for the listener.
+	//
+	// PortMapping is a required field
+	PortMapping *VirtualGatewayPortMapping `locationName:"portMapping" type:"structure" required:"true"`
 
-	// VirtualRouterName is a required field
-	VirtualRouterName *string `locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
+	// A reference to an object that represents the Transport Layer Security (TLS)
+	// properties for the listener.
+	Tls *VirtualGatewayListenerTls `locationName:"tls" type:"structure"`
 }
 
-// String returns the string representation
-func (s RouteRef) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayListener) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s RouteRef) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayListener) GoString() string {
 	return s.String()
 }
 
-// SetArn sets the Arn field's value.
-func (s *RouteRef) SetArn(v string) *RouteRef {
-	s.Arn = &v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *VirtualGatewayListener) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayListener"}
+	if s.PortMapping == nil {
+		invalidParams.Add(request.NewErrParamRequired("PortMapping"))
+	}
+	if s.ConnectionPool != nil {
+		if err := s.ConnectionPool.Validate(); err != nil {
+			invalidParams.AddNested("ConnectionPool", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.HealthCheck != nil {
+		if err := s.HealthCheck.Validate(); err != nil {
+			invalidParams.AddNested("HealthCheck", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.PortMapping != nil {
+		if err := s.PortMapping.Validate(); err != nil {
+			invalidParams.AddNested("PortMapping", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tls != nil {
+		if err := s.Tls.Validate(); err != nil {
+			invalidParams.AddNested("Tls", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetConnectionPool sets the ConnectionPool field's value.
+func (s *VirtualGatewayListener) SetConnectionPool(v *VirtualGatewayConnectionPool) *VirtualGatewayListener {
+	s.ConnectionPool = v
 	return s
 }
 
-// SetMeshName sets the MeshName field's value.
-func (s *RouteRef) SetMeshName(v string) *RouteRef {
-	s.MeshName = &v
+// SetHealthCheck sets the HealthCheck field's value.
+func (s *VirtualGatewayListener) SetHealthCheck(v *VirtualGatewayHealthCheckPolicy) *VirtualGatewayListener {
+	s.HealthCheck = v
 	return s
 }
 
-// SetRouteName sets the RouteName field's value.
-func (s *RouteRef) SetRouteName(v string) *RouteRef {
-	s.RouteName = &v
+// SetPortMapping sets the PortMapping field's value.
+func (s *VirtualGatewayListener) SetPortMapping(v *VirtualGatewayPortMapping) *VirtualGatewayListener {
+	s.PortMapping = v
 	return s
 }
 
-// SetVirtualRouterName sets the VirtualRouterName field's value.
-func (s *RouteRef) SetVirtualRouterName(v string) *RouteRef {
-	s.VirtualRouterName = &v
+// SetTls sets the Tls field's value.
+func (s *VirtualGatewayListener) SetTls(v *VirtualGatewayListenerTls) *VirtualGatewayListener {
+	s.Tls = v
 	return s
 }
 
-// An object representing the specification of a route.
-type RouteSpec struct {
+// An object that represents the Transport Layer Security (TLS) properties for
+// a listener.
+type VirtualGatewayListenerTls struct {
 	_ struct{} `type:"structure"`
 
-	// An object representing the HTTP routing specification for a route.
-	HttpRoute *HttpRoute `locationName:"httpRoute" type:"structure"`
+	// An object that represents a Transport Layer Security (TLS) certificate.
+	//
+	// Certificate is a required field
+	Certificate *VirtualGatewayListenerTlsCertificate `locationName:"certificate" type:"structure" required:"true"`
 
-	Priority *int64 `locationName:"priority" type:"integer"`
+	// Specify one of the following modes.
+	//
+	//    * STRICT – Listener only accepts connections with TLS enabled.
+	//
+	//    * PERMISSIVE – Listener accepts connections with or without TLS enabled.
+	//
+	//    * DISABLED – Listener only accepts connections without TLS.
+	//
+	// Mode is a required field
+	Mode *string `locationName:"mode" type:"string" required:"true" enum:"VirtualGatewayListenerTlsMode"`
 
-	// An object representing the TCP routing specification for a route.
-	TcpRoute *TcpRoute `locationName:"tcpRoute" type:"structure"`
+	// A reference to an object that represents a virtual gateway's listener's Transport
+	// Layer Security (TLS) validation context.
+	Validation *VirtualGatewayListenerTlsValidationContext `locationName:"validation" type:"structure"`
 }
 
-// String returns the string representation
-func (s RouteSpec) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayListenerTls) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s RouteSpec) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayListenerTls) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *RouteSpec) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "RouteSpec"}
-	if s.HttpRoute != nil {
-		if err := s.HttpRoute.Validate(); err != nil {
-			invalidParams.AddNested("HttpRoute", err.(request.ErrInvalidParams))
+func (s *VirtualGatewayListenerTls) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayListenerTls"}
+	if s.Certificate == nil {
+		invalidParams.Add(request.NewErrParamRequired("Certificate"))
+	}
+	if s.Mode == nil {
+		invalidParams.Add(request.NewErrParamRequired("Mode"))
+	}
+	if s.Certificate != nil {
+		if err := s.Certificate.Validate(); err != nil {
+			invalidParams.AddNested("Certificate", err.(request.ErrInvalidParams))
 		}
 	}
-	if s.TcpRoute != nil {
-		if err := s.TcpRoute.Validate(); err != nil {
-			invalidParams.AddNested("TcpRoute", err.(request.ErrInvalidParams))
+	if s.Validation != nil {
+		if err := s.Validation.Validate(); err != nil {
+			invalidParams.AddNested("Validation", err.(request.ErrInvalidParams))
 		}
 	}
 
@@ -6652,82 +17360,122 @@ func (s *RouteSpec) Validate() error {
 	return nil
 }
 
-// SetHttpRoute sets the HttpRoute field's value.
-func (s *RouteSpec) SetHttpRoute(v *HttpRoute) *RouteSpec {
-	s.HttpRoute = v
+// SetCertificate sets the Certificate field's value.
+func (s *VirtualGatewayListenerTls) SetCertificate(v *VirtualGatewayListenerTlsCertificate) *VirtualGatewayListenerTls {
+	s.Certificate = v
 	return s
 }
 
-// SetPriority sets the Priority field's value.
-func (s *RouteSpec) SetPriority(v int64) *RouteSpec {
-	s.Priority = &v
+// SetMode sets the Mode field's value.
+func (s *VirtualGatewayListenerTls) SetMode(v string) *VirtualGatewayListenerTls {
+	s.Mode = &v
 	return s
 }
 
-// SetTcpRoute sets the TcpRoute field's value.
-func (s *RouteSpec) SetTcpRoute(v *TcpRoute) *RouteSpec {
-	s.TcpRoute = v
+// SetValidation sets the Validation field's value.
+func (s *VirtualGatewayListenerTls) SetValidation(v *VirtualGatewayListenerTlsValidationContext) *VirtualGatewayListenerTls {
+	s.Validation = v
 	return s
 }
 
-// An object representing the current status of a route.
-type RouteStatus struct {
+// An object that represents an Certificate Manager certificate.
+type VirtualGatewayListenerTlsAcmCertificate struct {
 	_ struct{} `type:"structure"`
 
-	// Status is a required field
-	Status *string `locationName:"status" type:"string" required:"true" enum:"RouteStatusCode"`
+	// The Amazon Resource Name (ARN) for the certificate. The certificate must
+	// meet specific requirements and you must have proxy authorization enabled.
+	// For more information, see Transport Layer Security (TLS) (https://docs.aws.amazon.com/app-mesh/latest/userguide/tls.html#virtual-node-tls-prerequisites).
+	//
+	// CertificateArn is a required field
+	CertificateArn *string `locationName:"certificateArn" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s RouteStatus) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayListenerTlsAcmCertificate) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s RouteStatus) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayListenerTlsAcmCertificate) GoString() string {
 	return s.String()
 }
 
-// SetStatus sets the Status field's value.
-func (s *RouteStatus) SetStatus(v string) *RouteStatus {
-	s.Status = &v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *VirtualGatewayListenerTlsAcmCertificate) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayListenerTlsAcmCertificate"}
+	if s.CertificateArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("CertificateArn"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCertificateArn sets the CertificateArn field's value.
+func (s *VirtualGatewayListenerTlsAcmCertificate) SetCertificateArn(v string) *VirtualGatewayListenerTlsAcmCertificate {
+	s.CertificateArn = &v
 	return s
 }
 
-// An object representing the service discovery information for a virtual node.
-type ServiceDiscovery struct {
+// An object that represents a listener's Transport Layer Security (TLS) certificate.
+type VirtualGatewayListenerTlsCertificate struct {
 	_ struct{} `type:"structure"`
 
-	// An object representing the AWS Cloud Map service discovery information for
-	// your virtual node.
-	AwsCloudMap *AwsCloudMapServiceDiscovery `locationName:"awsCloudMap" type:"structure"`
+	// A reference to an object that represents an Certificate Manager certificate.
+	Acm *VirtualGatewayListenerTlsAcmCertificate `locationName:"acm" type:"structure"`
 
-	// An object representing the DNS service discovery information for your virtual
-	// node.
-	Dns *DnsServiceDiscovery `locationName:"dns" type:"structure"`
+	// A reference to an object that represents a local file certificate.
+	File *VirtualGatewayListenerTlsFileCertificate `locationName:"file" type:"structure"`
+
+	// A reference to an object that represents a virtual gateway's listener's Secret
+	// Discovery Service certificate.
+	Sds *VirtualGatewayListenerTlsSdsCertificate `locationName:"sds" type:"structure"`
 }
 
-// String returns the string representation
-func (s ServiceDiscovery) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayListenerTlsCertificate) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ServiceDiscovery) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayListenerTlsCertificate) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ServiceDiscovery) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ServiceDiscovery"}
-	if s.AwsCloudMap != nil {
-		if err := s.AwsCloudMap.Validate(); err != nil {
-			invalidParams.AddNested("AwsCloudMap", err.(request.ErrInvalidParams))
+func (s *VirtualGatewayListenerTlsCertificate) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayListenerTlsCertificate"}
+	if s.Acm != nil {
+		if err := s.Acm.Validate(); err != nil {
+			invalidParams.AddNested("Acm", err.(request.ErrInvalidParams))
 		}
 	}
-	if s.Dns != nil {
-		if err := s.Dns.Validate(); err != nil {
-			invalidParams.AddNested("Dns", err.(request.ErrInvalidParams))
+	if s.File != nil {
+		if err := s.File.Validate(); err != nil {
+			invalidParams.AddNested("File", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Sds != nil {
+		if err := s.Sds.Validate(); err != nil {
+			invalidParams.AddNested("Sds", err.(request.ErrInvalidParams))
 		}
 	}
 
@@ -6737,49 +17485,74 @@ func (s *ServiceDiscovery) Validate() error {
 	return nil
 }
 
-// SetAwsCloudMap sets the AwsCloudMap field's value.
-func (s *ServiceDiscovery) SetAwsCloudMap(v *AwsCloudMapServiceDiscovery) *ServiceDiscovery {
-	s.AwsCloudMap = v
+// SetAcm sets the Acm field's value.
+func (s *VirtualGatewayListenerTlsCertificate) SetAcm(v *VirtualGatewayListenerTlsAcmCertificate) *VirtualGatewayListenerTlsCertificate {
+	s.Acm = v
 	return s
 }
 
-// SetDns sets the Dns field's value.
-func (s *ServiceDiscovery) SetDns(v *DnsServiceDiscovery) *ServiceDiscovery {
-	s.Dns = v
+// SetFile sets the File field's value.
+func (s *VirtualGatewayListenerTlsCertificate) SetFile(v *VirtualGatewayListenerTlsFileCertificate) *VirtualGatewayListenerTlsCertificate {
+	s.File = v
 	return s
 }
 
-// Optional metadata that you apply to a resource to assist with categorization
-// and organization. Each tag consists of a key and an optional value, both
-// of which you define. Tag keys can have a maximum character length of 128
-// characters, and tag values can have a maximum length of 256 characters.
-type TagRef struct {
+// SetSds sets the Sds field's value.
+func (s *VirtualGatewayListenerTlsCertificate) SetSds(v *VirtualGatewayListenerTlsSdsCertificate) *VirtualGatewayListenerTlsCertificate {
+	s.Sds = v
+	return s
+}
+
+// An object that represents a local file certificate. The certificate must
+// meet specific requirements and you must have proxy authorization enabled.
+// For more information, see Transport Layer Security (TLS) (https://docs.aws.amazon.com/app-mesh/latest/userguide/tls.html#virtual-node-tls-prerequisites).
+type VirtualGatewayListenerTlsFileCertificate struct {
 	_ struct{} `type:"structure"`
 
-	// Key is a required field
-	Key *string `locationName:"key" min:"1" type:"string" required:"true"`
+	// The certificate chain for the certificate.
+	//
+	// CertificateChain is a required field
+	CertificateChain *string `locationName:"certificateChain" min:"1" type:"string" required:"true"`
 
-	Value *string `locationName:"value" type:"string"`
+	// The private key for a certificate stored on the file system of the mesh endpoint
+	// that the proxy is running on.
+	//
+	// PrivateKey is a required field
+	PrivateKey *string `locationName:"privateKey" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s TagRef) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayListenerTlsFileCertificate) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s TagRef) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayListenerTlsFileCertificate) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *TagRef) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "TagRef"}
-	if s.Key == nil {
-		invalidParams.Add(request.NewErrParamRequired("Key"))
+func (s *VirtualGatewayListenerTlsFileCertificate) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayListenerTlsFileCertificate"}
+	if s.CertificateChain == nil {
+		invalidParams.Add(request.NewErrParamRequired("CertificateChain"))
 	}
-	if s.Key != nil && len(*s.Key) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Key", 1))
+	if s.CertificateChain != nil && len(*s.CertificateChain) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CertificateChain", 1))
+	}
+	if s.PrivateKey == nil {
+		invalidParams.Add(request.NewErrParamRequired("PrivateKey"))
+	}
+	if s.PrivateKey != nil && len(*s.PrivateKey) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("PrivateKey", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -6788,55 +17561,118 @@ func (s *TagRef) Validate() error {
 	return nil
 }
 
-// SetKey sets the Key field's value.
-func (s *TagRef) SetKey(v string) *TagRef {
-	s.Key = &v
+// SetCertificateChain sets the CertificateChain field's value.
+func (s *VirtualGatewayListenerTlsFileCertificate) SetCertificateChain(v string) *VirtualGatewayListenerTlsFileCertificate {
+	s.CertificateChain = &v
 	return s
 }
 
-// SetValue sets the Value field's value.
-func (s *TagRef) SetValue(v string) *TagRef {
-	s.Value = &v
+// SetPrivateKey sets the PrivateKey field's value.
+func (s *VirtualGatewayListenerTlsFileCertificate) SetPrivateKey(v string) *VirtualGatewayListenerTlsFileCertificate {
+	s.PrivateKey = &v
 	return s
 }
 
-type TagResourceInput struct {
+// An object that represents the virtual gateway's listener's Secret Discovery
+// Service certificate.The proxy must be configured with a local SDS provider
+// via a Unix Domain Socket. See App MeshTLS documentation (https://docs.aws.amazon.com/app-mesh/latest/userguide/tls.html)
+// for more info.
+type VirtualGatewayListenerTlsSdsCertificate struct {
 	_ struct{} `type:"structure"`
 
-	// ResourceArn is a required field
-	ResourceArn *string `location:"querystring" locationName:"resourceArn" type:"string" required:"true"`
-
-	// Tags is a required field
-	Tags []*TagRef `locationName:"tags" type:"list" required:"true"`
+	// A reference to an object that represents the name of the secret secret requested
+	// from the Secret Discovery Service provider representing Transport Layer Security
+	// (TLS) materials like a certificate or certificate chain.
+	//
+	// SecretName is a required field
+	SecretName *string `locationName:"secretName" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s TagResourceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayListenerTlsSdsCertificate) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s TagResourceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayListenerTlsSdsCertificate) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *TagResourceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "TagResourceInput"}
-	if s.ResourceArn == nil {
-		invalidParams.Add(request.NewErrParamRequired("ResourceArn"))
+func (s *VirtualGatewayListenerTlsSdsCertificate) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayListenerTlsSdsCertificate"}
+	if s.SecretName == nil {
+		invalidParams.Add(request.NewErrParamRequired("SecretName"))
 	}
-	if s.Tags == nil {
-		invalidParams.Add(request.NewErrParamRequired("Tags"))
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
 	}
-	if s.Tags != nil {
-		for i, v := range s.Tags {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
-			}
+	return nil
+}
+
+// SetSecretName sets the SecretName field's value.
+func (s *VirtualGatewayListenerTlsSdsCertificate) SetSecretName(v string) *VirtualGatewayListenerTlsSdsCertificate {
+	s.SecretName = &v
+	return s
+}
+
+// An object that represents a virtual gateway's listener's Transport Layer
+// Security (TLS) validation context.
+type VirtualGatewayListenerTlsValidationContext struct {
+	_ struct{} `type:"structure"`
+
+	// A reference to an object that represents the SANs for a virtual gateway listener's
+	// Transport Layer Security (TLS) validation context.
+	SubjectAlternativeNames *SubjectAlternativeNames `locationName:"subjectAlternativeNames" type:"structure"`
+
+	// A reference to where to retrieve the trust chain when validating a peer’s
+	// Transport Layer Security (TLS) certificate.
+	//
+	// Trust is a required field
+	Trust *VirtualGatewayListenerTlsValidationContextTrust `locationName:"trust" type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayListenerTlsValidationContext) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayListenerTlsValidationContext) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *VirtualGatewayListenerTlsValidationContext) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayListenerTlsValidationContext"}
+	if s.Trust == nil {
+		invalidParams.Add(request.NewErrParamRequired("Trust"))
+	}
+	if s.SubjectAlternativeNames != nil {
+		if err := s.SubjectAlternativeNames.Validate(); err != nil {
+			invalidParams.AddNested("SubjectAlternativeNames", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Trust != nil {
+		if err := s.Trust.Validate(); err != nil {
+			invalidParams.AddNested("Trust", err.(request.ErrInvalidParams))
 		}
 	}
 
@@ -6846,62 +17682,61 @@ func (s *TagResourceInput) Validate() error {
 	return nil
 }
 
-// SetResourceArn sets the ResourceArn field's value.
-func (s *TagResourceInput) SetResourceArn(v string) *TagResourceInput {
-	s.ResourceArn = &v
+// SetSubjectAlternativeNames sets the SubjectAlternativeNames field's value.
+func (s *VirtualGatewayListenerTlsValidationContext) SetSubjectAlternativeNames(v *SubjectAlternativeNames) *VirtualGatewayListenerTlsValidationContext {
+	s.SubjectAlternativeNames = v
 	return s
 }
 
-// SetTags sets the Tags field's value.
-func (s *TagResourceInput) SetTags(v []*TagRef) *TagResourceInput {
-	s.Tags = v
+// SetTrust sets the Trust field's value.
+func (s *VirtualGatewayListenerTlsValidationContext) SetTrust(v *VirtualGatewayListenerTlsValidationContextTrust) *VirtualGatewayListenerTlsValidationContext {
+	s.Trust = v
 	return s
 }
 
-type TagResourceOutput struct {
+// An object that represents a virtual gateway's listener's Transport Layer
+// Security (TLS) validation context trust.
+type VirtualGatewayListenerTlsValidationContextTrust struct {
 	_ struct{} `type:"structure"`
-}
-
-// String returns the string representation
-func (s TagResourceOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s TagResourceOutput) GoString() string {
-	return s.String()
-}
 
-// An object representing the TCP routing specification for a route.
-type TcpRoute struct {
-	_ struct{} `type:"structure"`
+	// An object that represents a Transport Layer Security (TLS) validation context
+	// trust for a local file.
+	File *VirtualGatewayTlsValidationContextFileTrust `locationName:"file" type:"structure"`
 
-	// An object representing the traffic distribution requirements for matched
-	// TCP requests.
-	//
-	// Action is a required field
-	Action *TcpRouteAction `locationName:"action" type:"structure" required:"true"`
+	// A reference to an object that represents a virtual gateway's listener's Transport
+	// Layer Security (TLS) Secret Discovery Service validation context trust.
+	Sds *VirtualGatewayTlsValidationContextSdsTrust `locationName:"sds" type:"structure"`
 }
 
-// String returns the string representation
-func (s TcpRoute) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayListenerTlsValidationContextTrust) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s TcpRoute) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayListenerTlsValidationContextTrust) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *TcpRoute) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "TcpRoute"}
-	if s.Action == nil {
-		invalidParams.Add(request.NewErrParamRequired("Action"))
+func (s *VirtualGatewayListenerTlsValidationContextTrust) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayListenerTlsValidationContextTrust"}
+	if s.File != nil {
+		if err := s.File.Validate(); err != nil {
+			invalidParams.AddNested("File", err.(request.ErrInvalidParams))
+		}
 	}
-	if s.Action != nil {
-		if err := s.Action.Validate(); err != nil {
-			invalidParams.AddNested("Action", err.(request.ErrInvalidParams))
+	if s.Sds != nil {
+		if err := s.Sds.Validate(); err != nil {
+			invalidParams.AddNested("Sds", err.(request.ErrInvalidParams))
 		}
 	}
 
@@ -6911,48 +17746,50 @@ func (s *TcpRoute) Validate() error {
 	return nil
 }
 
-// SetAction sets the Action field's value.
-func (s *TcpRoute) SetAction(v *TcpRouteAction) *TcpRoute {
-	s.Action = v
+// SetFile sets the File field's value.
+func (s *VirtualGatewayListenerTlsValidationContextTrust) SetFile(v *VirtualGatewayTlsValidationContextFileTrust) *VirtualGatewayListenerTlsValidationContextTrust {
+	s.File = v
 	return s
 }
 
-// An object representing the traffic distribution requirements for matched
-// TCP requests.
-type TcpRouteAction struct {
+// SetSds sets the Sds field's value.
+func (s *VirtualGatewayListenerTlsValidationContextTrust) SetSds(v *VirtualGatewayTlsValidationContextSdsTrust) *VirtualGatewayListenerTlsValidationContextTrust {
+	s.Sds = v
+	return s
+}
+
+// An object that represents logging information.
+type VirtualGatewayLogging struct {
 	_ struct{} `type:"structure"`
 
-	// WeightedTargets is a required field
-	WeightedTargets []*WeightedTarget `locationName:"weightedTargets" min:"1" type:"list" required:"true"`
+	// The access log configuration.
+	AccessLog *VirtualGatewayAccessLog `locationName:"accessLog" type:"structure"`
 }
 
-// String returns the string representation
-func (s TcpRouteAction) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayLogging) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s TcpRouteAction) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayLogging) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *TcpRouteAction) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "TcpRouteAction"}
-	if s.WeightedTargets == nil {
-		invalidParams.Add(request.NewErrParamRequired("WeightedTargets"))
-	}
-	if s.WeightedTargets != nil && len(s.WeightedTargets) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("WeightedTargets", 1))
-	}
-	if s.WeightedTargets != nil {
-		for i, v := range s.WeightedTargets {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "WeightedTargets", i), err.(request.ErrInvalidParams))
-			}
+func (s *VirtualGatewayLogging) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayLogging"}
+	if s.AccessLog != nil {
+		if err := s.AccessLog.Validate(); err != nil {
+			invalidParams.AddNested("AccessLog", err.(request.ErrInvalidParams))
 		}
 	}
 
@@ -6962,40 +17799,56 @@ func (s *TcpRouteAction) Validate() error {
 	return nil
 }
 
-// SetWeightedTargets sets the WeightedTargets field's value.
-func (s *TcpRouteAction) SetWeightedTargets(v []*WeightedTarget) *TcpRouteAction {
-	s.WeightedTargets = v
+// SetAccessLog sets the AccessLog field's value.
+func (s *VirtualGatewayLogging) SetAccessLog(v *VirtualGatewayAccessLog) *VirtualGatewayLogging {
+	s.AccessLog = v
 	return s
 }
 
-type UntagResourceInput struct {
+// An object that represents a port mapping.
+type VirtualGatewayPortMapping struct {
 	_ struct{} `type:"structure"`
 
-	// ResourceArn is a required field
-	ResourceArn *string `location:"querystring" locationName:"resourceArn" type:"string" required:"true"`
+	// The port used for the port mapping. Specify one protocol.
+	//
+	// Port is a required field
+	Port *int64 `locationName:"port" min:"1" type:"integer" required:"true"`
 
-	// TagKeys is a required field
-	TagKeys []*string `locationName:"tagKeys" type:"list" required:"true"`
+	// The protocol used for the port mapping.
+	//
+	// Protocol is a required field
+	Protocol *string `locationName:"protocol" type:"string" required:"true" enum:"VirtualGatewayPortProtocol"`
 }
 
-// String returns the string representation
-func (s UntagResourceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayPortMapping) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s UntagResourceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayPortMapping) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *UntagResourceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "UntagResourceInput"}
-	if s.ResourceArn == nil {
-		invalidParams.Add(request.NewErrParamRequired("ResourceArn"))
+func (s *VirtualGatewayPortMapping) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayPortMapping"}
+	if s.Port == nil {
+		invalidParams.Add(request.NewErrParamRequired("Port"))
 	}
-	if s.TagKeys == nil {
-		invalidParams.Add(request.NewErrParamRequired("TagKeys"))
+	if s.Port != nil && *s.Port < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Port", 1))
+	}
+	if s.Protocol == nil {
+		invalidParams.Add(request.NewErrParamRequired("Protocol"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -7004,175 +17857,195 @@ func (s *UntagResourceInput) Validate() error {
 	return nil
 }
 
-// SetResourceArn sets the ResourceArn field's value.
-func (s *UntagResourceInput) SetResourceArn(v string) *UntagResourceInput {
-	s.ResourceArn = &v
+// SetPort sets the Port field's value.
+func (s *VirtualGatewayPortMapping) SetPort(v int64) *VirtualGatewayPortMapping {
+	s.Port = &v
 	return s
 }
 
-// SetTagKeys sets the TagKeys field's value.
-func (s *UntagResourceInput) SetTagKeys(v []*string) *UntagResourceInput {
-	s.TagKeys = v
+// SetProtocol sets the Protocol field's value.
+func (s *VirtualGatewayPortMapping) SetProtocol(v string) *VirtualGatewayPortMapping {
+	s.Protocol = &v
 	return s
 }
 
-type UntagResourceOutput struct {
+// An object that represents a virtual gateway returned by a list operation.
+type VirtualGatewayRef struct {
 	_ struct{} `type:"structure"`
-}
-
-// String returns the string representation
-func (s UntagResourceOutput) String() string {
-	return awsutil.Prettify(s)
-}
 
-// GoString returns the string representation
-func (s UntagResourceOutput) GoString() string {
-	return s.String()
-}
+	// The full Amazon Resource Name (ARN) for the resource.
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" type:"string" required:"true"`
 
-type UpdateMeshInput struct {
-	_ struct{} `type:"structure"`
+	// The Unix epoch timestamp in seconds for when the resource was created.
+	//
+	// CreatedAt is a required field
+	CreatedAt *time.Time `locationName:"createdAt" type:"timestamp" required:"true"`
 
-	ClientToken *string `locationName:"clientToken" type:"string" idempotencyToken:"true"`
+	// The Unix epoch timestamp in seconds for when the resource was last updated.
+	//
+	// LastUpdatedAt is a required field
+	LastUpdatedAt *time.Time `locationName:"lastUpdatedAt" type:"timestamp" required:"true"`
 
+	// The name of the service mesh that the resource resides in.
+	//
 	// MeshName is a required field
-	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
+	MeshName *string `locationName:"meshName" min:"1" type:"string" required:"true"`
 
-	// An object representing the specification of a service mesh.
-	Spec *MeshSpec `locationName:"spec" type:"structure"`
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	//
+	// MeshOwner is a required field
+	MeshOwner *string `locationName:"meshOwner" min:"12" type:"string" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the resource owner. If the account
+	// ID is not your own, then it's the ID of the mesh owner or of another account
+	// that the mesh is shared with. For more information about mesh sharing, see
+	// Working with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	//
+	// ResourceOwner is a required field
+	ResourceOwner *string `locationName:"resourceOwner" min:"12" type:"string" required:"true"`
+
+	// The version of the resource. Resources are created at version 1, and this
+	// version is incremented each time that they're updated.
+	//
+	// Version is a required field
+	Version *int64 `locationName:"version" type:"long" required:"true"`
+
+	// The name of the resource.
+	//
+	// VirtualGatewayName is a required field
+	VirtualGatewayName *string `locationName:"virtualGatewayName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s UpdateMeshInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayRef) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s UpdateMeshInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayRef) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *UpdateMeshInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "UpdateMeshInput"}
-	if s.MeshName == nil {
-		invalidParams.Add(request.NewErrParamRequired("MeshName"))
-	}
-	if s.MeshName != nil && len(*s.MeshName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
-	}
-	if s.Spec != nil {
-		if err := s.Spec.Validate(); err != nil {
-			invalidParams.AddNested("Spec", err.(request.ErrInvalidParams))
-		}
-	}
+// SetArn sets the Arn field's value.
+func (s *VirtualGatewayRef) SetArn(v string) *VirtualGatewayRef {
+	s.Arn = &v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *VirtualGatewayRef) SetCreatedAt(v time.Time) *VirtualGatewayRef {
+	s.CreatedAt = &v
+	return s
 }
 
-// SetClientToken sets the ClientToken field's value.
-func (s *UpdateMeshInput) SetClientToken(v string) *UpdateMeshInput {
-	s.ClientToken = &v
+// SetLastUpdatedAt sets the LastUpdatedAt field's value.
+func (s *VirtualGatewayRef) SetLastUpdatedAt(v time.Time) *VirtualGatewayRef {
+	s.LastUpdatedAt = &v
 	return s
 }
 
 // SetMeshName sets the MeshName field's value.
-func (s *UpdateMeshInput) SetMeshName(v string) *UpdateMeshInput {
+func (s *VirtualGatewayRef) SetMeshName(v string) *VirtualGatewayRef {
 	s.MeshName = &v
 	return s
 }
 
-// SetSpec sets the Spec field's value.
-func (s *UpdateMeshInput) SetSpec(v *MeshSpec) *UpdateMeshInput {
-	s.Spec = v
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *VirtualGatewayRef) SetMeshOwner(v string) *VirtualGatewayRef {
+	s.MeshOwner = &v
 	return s
 }
 
-type UpdateMeshOutput struct {
-	_ struct{} `type:"structure" payload:"Mesh"`
-
-	// An object representing a service mesh returned by a describe operation.
-	//
-	// Mesh is a required field
-	Mesh *MeshData `locationName:"mesh" type:"structure" required:"true"`
-}
-
-// String returns the string representation
-func (s UpdateMeshOutput) String() string {
-	return awsutil.Prettify(s)
+// SetResourceOwner sets the ResourceOwner field's value.
+func (s *VirtualGatewayRef) SetResourceOwner(v string) *VirtualGatewayRef {
+	s.ResourceOwner = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s UpdateMeshOutput) GoString() string {
-	return s.String()
+// SetVersion sets the Version field's value.
+func (s *VirtualGatewayRef) SetVersion(v int64) *VirtualGatewayRef {
+	s.Version = &v
+	return s
 }
 
-// SetMesh sets the Mesh field's value.
-func (s *UpdateMeshOutput) SetMesh(v *MeshData) *UpdateMeshOutput {
-	s.Mesh = v
+// SetVirtualGatewayName sets the VirtualGatewayName field's value.
+func (s *VirtualGatewayRef) SetVirtualGatewayName(v string) *VirtualGatewayRef {
+	s.VirtualGatewayName = &v
 	return s
 }
 
-type UpdateRouteInput struct {
+// An object that represents the specification of a service mesh resource.
+type VirtualGatewaySpec struct {
 	_ struct{} `type:"structure"`
 
-	ClientToken *string `locationName:"clientToken" type:"string" idempotencyToken:"true"`
-
-	// MeshName is a required field
-	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
-
-	// RouteName is a required field
-	RouteName *string `location:"uri" locationName:"routeName" min:"1" type:"string" required:"true"`
+	// A reference to an object that represents the defaults for backends.
+	BackendDefaults *VirtualGatewayBackendDefaults `locationName:"backendDefaults" type:"structure"`
 
-	// An object representing the specification of a route.
+	// The listeners that the mesh endpoint is expected to receive inbound traffic
+	// from. You can specify one listener.
 	//
-	// Spec is a required field
-	Spec *RouteSpec `locationName:"spec" type:"structure" required:"true"`
+	// Listeners is a required field
+	Listeners []*VirtualGatewayListener `locationName:"listeners" type:"list" required:"true"`
 
-	// VirtualRouterName is a required field
-	VirtualRouterName *string `location:"uri" locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
+	// An object that represents logging information.
+	Logging *VirtualGatewayLogging `locationName:"logging" type:"structure"`
 }
 
-// String returns the string representation
-func (s UpdateRouteInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewaySpec) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s UpdateRouteInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewaySpec) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *UpdateRouteInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "UpdateRouteInput"}
-	if s.MeshName == nil {
-		invalidParams.Add(request.NewErrParamRequired("MeshName"))
-	}
-	if s.MeshName != nil && len(*s.MeshName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
-	}
-	if s.RouteName == nil {
-		invalidParams.Add(request.NewErrParamRequired("RouteName"))
-	}
-	if s.RouteName != nil && len(*s.RouteName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("RouteName", 1))
-	}
-	if s.Spec == nil {
-		invalidParams.Add(request.NewErrParamRequired("Spec"))
-	}
-	if s.VirtualRouterName == nil {
-		invalidParams.Add(request.NewErrParamRequired("VirtualRouterName"))
+func (s *VirtualGatewaySpec) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewaySpec"}
+	if s.Listeners == nil {
+		invalidParams.Add(request.NewErrParamRequired("Listeners"))
+	}
+	if s.BackendDefaults != nil {
+		if err := s.BackendDefaults.Validate(); err != nil {
+			invalidParams.AddNested("BackendDefaults", err.(request.ErrInvalidParams))
+		}
 	}
-	if s.VirtualRouterName != nil && len(*s.VirtualRouterName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("VirtualRouterName", 1))
+	if s.Listeners != nil {
+		for i, v := range s.Listeners {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Listeners", i), err.(request.ErrInvalidParams))
+			}
+		}
 	}
-	if s.Spec != nil {
-		if err := s.Spec.Validate(); err != nil {
-			invalidParams.AddNested("Spec", err.(request.ErrInvalidParams))
+	if s.Logging != nil {
+		if err := s.Logging.Validate(); err != nil {
+			invalidParams.AddNested("Logging", err.(request.ErrInvalidParams))
 		}
 	}
 
@@ -7182,109 +18055,105 @@ func (s *UpdateRouteInput) Validate() error {
 	return nil
 }
 
-// SetClientToken sets the ClientToken field's value.
-func (s *UpdateRouteInput) SetClientToken(v string) *UpdateRouteInput {
-	s.ClientToken = &v
-	return s
-}
-
-// SetMeshName sets the MeshName field's value.
-func (s *UpdateRouteInput) SetMeshName(v string) *UpdateRouteInput {
-	s.MeshName = &v
-	return s
-}
-
-// SetRouteName sets the RouteName field's value.
-func (s *UpdateRouteInput) SetRouteName(v string) *UpdateRouteInput {
-	s.RouteName = &v
+// SetBackendDefaults sets the BackendDefaults field's value.
+func (s *VirtualGatewaySpec) SetBackendDefaults(v *VirtualGatewayBackendDefaults) *VirtualGatewaySpec {
+	s.BackendDefaults = v
 	return s
 }
 
-// SetSpec sets the Spec field's value.
-func (s *UpdateRouteInput) SetSpec(v *RouteSpec) *UpdateRouteInput {
-	s.Spec = v
+// SetListeners sets the Listeners field's value.
+func (s *VirtualGatewaySpec) SetListeners(v []*VirtualGatewayListener) *VirtualGatewaySpec {
+	s.Listeners = v
 	return s
 }
 
-// SetVirtualRouterName sets the VirtualRouterName field's value.
-func (s *UpdateRouteInput) SetVirtualRouterName(v string) *UpdateRouteInput {
-	s.VirtualRouterName = &v
+// SetLogging sets the Logging field's value.
+func (s *VirtualGatewaySpec) SetLogging(v *VirtualGatewayLogging) *VirtualGatewaySpec {
+	s.Logging = v
 	return s
 }
 
-type UpdateRouteOutput struct {
-	_ struct{} `type:"structure" payload:"Route"`
+// An object that represents the status of the mesh resource.
+type VirtualGatewayStatus struct {
+	_ struct{} `type:"structure"`
 
-	// An object representing a route returned by a describe operation.
+	// The current status.
 	//
-	// Route is a required field
-	Route *RouteData `locationName:"route" type:"structure" required:"true"`
+	// Status is a required field
+	Status *string `locationName:"status" type:"string" required:"true" enum:"VirtualGatewayStatusCode"`
 }
 
-// String returns the string representation
-func (s UpdateRouteOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayStatus) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s UpdateRouteOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayStatus) GoString() string {
 	return s.String()
 }
 
-// SetRoute sets the Route field's value.
-func (s *UpdateRouteOutput) SetRoute(v *RouteData) *UpdateRouteOutput {
-	s.Route = v
+// SetStatus sets the Status field's value.
+func (s *VirtualGatewayStatus) SetStatus(v string) *VirtualGatewayStatus {
+	s.Status = &v
 	return s
 }
 
-type UpdateVirtualNodeInput struct {
+// An object that represents a Transport Layer Security (TLS) validation context.
+type VirtualGatewayTlsValidationContext struct {
 	_ struct{} `type:"structure"`
 
-	ClientToken *string `locationName:"clientToken" type:"string" idempotencyToken:"true"`
-
-	// MeshName is a required field
-	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
+	// A reference to an object that represents the SANs for a virtual gateway's
+	// listener's Transport Layer Security (TLS) validation context.
+	SubjectAlternativeNames *SubjectAlternativeNames `locationName:"subjectAlternativeNames" type:"structure"`
 
-	// An object representing the specification of a virtual node.
+	// A reference to where to retrieve the trust chain when validating a peer’s
+	// Transport Layer Security (TLS) certificate.
 	//
-	// Spec is a required field
-	Spec *VirtualNodeSpec `locationName:"spec" type:"structure" required:"true"`
-
-	// VirtualNodeName is a required field
-	VirtualNodeName *string `location:"uri" locationName:"virtualNodeName" min:"1" type:"string" required:"true"`
+	// Trust is a required field
+	Trust *VirtualGatewayTlsValidationContextTrust `locationName:"trust" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s UpdateVirtualNodeInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayTlsValidationContext) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s UpdateVirtualNodeInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayTlsValidationContext) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *UpdateVirtualNodeInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "UpdateVirtualNodeInput"}
-	if s.MeshName == nil {
-		invalidParams.Add(request.NewErrParamRequired("MeshName"))
-	}
-	if s.MeshName != nil && len(*s.MeshName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
-	}
-	if s.Spec == nil {
-		invalidParams.Add(request.NewErrParamRequired("Spec"))
-	}
-	if s.VirtualNodeName == nil {
-		invalidParams.Add(request.NewErrParamRequired("VirtualNodeName"))
-	}
-	if s.VirtualNodeName != nil && len(*s.VirtualNodeName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("VirtualNodeName", 1))
+func (s *VirtualGatewayTlsValidationContext) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayTlsValidationContext"}
+	if s.Trust == nil {
+		invalidParams.Add(request.NewErrParamRequired("Trust"))
+	}
+	if s.SubjectAlternativeNames != nil {
+		if err := s.SubjectAlternativeNames.Validate(); err != nil {
+			invalidParams.AddNested("SubjectAlternativeNames", err.(request.ErrInvalidParams))
+		}
 	}
-	if s.Spec != nil {
-		if err := s.Spec.Validate(); err != nil {
-			invalidParams.AddNested("Spec", err.(request.ErrInvalidParams))
+	if s.Trust != nil {
+		if err := s.Trust.Validate(); err != nil {
+			invalidParams.AddNested("Trust", err.(request.ErrInvalidParams))
 		}
 	}
 
@@ -7294,104 +18163,160 @@ func (s *UpdateVirtualNodeInput) Validate() error {
 	return nil
 }
 
-// SetClientToken sets the ClientToken field's value.
-func (s *UpdateVirtualNodeInput) SetClientToken(v string) *UpdateVirtualNodeInput {
-	s.ClientToken = &v
+// SetSubjectAlternativeNames sets the SubjectAlternativeNames field's value.
+func (s *VirtualGatewayTlsValidationContext) SetSubjectAlternativeNames(v *SubjectAlternativeNames) *VirtualGatewayTlsValidationContext {
+	s.SubjectAlternativeNames = v
 	return s
 }
 
-// SetMeshName sets the MeshName field's value.
-func (s *UpdateVirtualNodeInput) SetMeshName(v string) *UpdateVirtualNodeInput {
-	s.MeshName = &v
+// SetTrust sets the Trust field's value.
+func (s *VirtualGatewayTlsValidationContext) SetTrust(v *VirtualGatewayTlsValidationContextTrust) *VirtualGatewayTlsValidationContext {
+	s.Trust = v
 	return s
 }
 
-// SetSpec sets the Spec field's value.
-func (s *UpdateVirtualNodeInput) SetSpec(v *VirtualNodeSpec) *UpdateVirtualNodeInput {
-	s.Spec = v
-	return s
+// An object that represents a Transport Layer Security (TLS) validation context
+// trust for an Certificate Manager certificate.
+type VirtualGatewayTlsValidationContextAcmTrust struct {
+	_ struct{} `type:"structure"`
+
+	// One or more ACM Amazon Resource Name (ARN)s.
+	//
+	// CertificateAuthorityArns is a required field
+	CertificateAuthorityArns []*string `locationName:"certificateAuthorityArns" min:"1" type:"list" required:"true"`
 }
 
-// SetVirtualNodeName sets the VirtualNodeName field's value.
-func (s *UpdateVirtualNodeInput) SetVirtualNodeName(v string) *UpdateVirtualNodeInput {
-	s.VirtualNodeName = &v
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayTlsValidationContextAcmTrust) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayTlsValidationContextAcmTrust) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *VirtualGatewayTlsValidationContextAcmTrust) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayTlsValidationContextAcmTrust"}
+	if s.CertificateAuthorityArns == nil {
+		invalidParams.Add(request.NewErrParamRequired("CertificateAuthorityArns"))
+	}
+	if s.CertificateAuthorityArns != nil && len(s.CertificateAuthorityArns) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CertificateAuthorityArns", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCertificateAuthorityArns sets the CertificateAuthorityArns field's value.
+func (s *VirtualGatewayTlsValidationContextAcmTrust) SetCertificateAuthorityArns(v []*string) *VirtualGatewayTlsValidationContextAcmTrust {
+	s.CertificateAuthorityArns = v
 	return s
 }
 
-type UpdateVirtualNodeOutput struct {
-	_ struct{} `type:"structure" payload:"VirtualNode"`
+// An object that represents a Transport Layer Security (TLS) validation context
+// trust for a local file.
+type VirtualGatewayTlsValidationContextFileTrust struct {
+	_ struct{} `type:"structure"`
 
-	// An object representing a virtual node returned by a describe operation.
+	// The certificate trust chain for a certificate stored on the file system of
+	// the virtual node that the proxy is running on.
 	//
-	// VirtualNode is a required field
-	VirtualNode *VirtualNodeData `locationName:"virtualNode" type:"structure" required:"true"`
+	// CertificateChain is a required field
+	CertificateChain *string `locationName:"certificateChain" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s UpdateVirtualNodeOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayTlsValidationContextFileTrust) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s UpdateVirtualNodeOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayTlsValidationContextFileTrust) GoString() string {
 	return s.String()
 }
 
-// SetVirtualNode sets the VirtualNode field's value.
-func (s *UpdateVirtualNodeOutput) SetVirtualNode(v *VirtualNodeData) *UpdateVirtualNodeOutput {
-	s.VirtualNode = v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *VirtualGatewayTlsValidationContextFileTrust) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayTlsValidationContextFileTrust"}
+	if s.CertificateChain == nil {
+		invalidParams.Add(request.NewErrParamRequired("CertificateChain"))
+	}
+	if s.CertificateChain != nil && len(*s.CertificateChain) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CertificateChain", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCertificateChain sets the CertificateChain field's value.
+func (s *VirtualGatewayTlsValidationContextFileTrust) SetCertificateChain(v string) *VirtualGatewayTlsValidationContextFileTrust {
+	s.CertificateChain = &v
 	return s
 }
 
-type UpdateVirtualRouterInput struct {
+// An object that represents a virtual gateway's listener's Transport Layer
+// Security (TLS) Secret Discovery Service validation context trust. The proxy
+// must be configured with a local SDS provider via a Unix Domain Socket. See
+// App Mesh TLS documentation (https://docs.aws.amazon.com/app-mesh/latest/userguide/tls.html)
+// for more info.
+type VirtualGatewayTlsValidationContextSdsTrust struct {
 	_ struct{} `type:"structure"`
 
-	ClientToken *string `locationName:"clientToken" type:"string" idempotencyToken:"true"`
-
-	// MeshName is a required field
-	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
-
-	// An object representing the specification of a virtual router.
+	// A reference to an object that represents the name of the secret for a virtual
+	// gateway's Transport Layer Security (TLS) Secret Discovery Service validation
+	// context trust.
 	//
-	// Spec is a required field
-	Spec *VirtualRouterSpec `locationName:"spec" type:"structure" required:"true"`
-
-	// VirtualRouterName is a required field
-	VirtualRouterName *string `location:"uri" locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
+	// SecretName is a required field
+	SecretName *string `locationName:"secretName" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s UpdateVirtualRouterInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayTlsValidationContextSdsTrust) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s UpdateVirtualRouterInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayTlsValidationContextSdsTrust) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *UpdateVirtualRouterInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "UpdateVirtualRouterInput"}
-	if s.MeshName == nil {
-		invalidParams.Add(request.NewErrParamRequired("MeshName"))
-	}
-	if s.MeshName != nil && len(*s.MeshName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
-	}
-	if s.Spec == nil {
-		invalidParams.Add(request.NewErrParamRequired("Spec"))
-	}
-	if s.VirtualRouterName == nil {
-		invalidParams.Add(request.NewErrParamRequired("VirtualRouterName"))
-	}
-	if s.VirtualRouterName != nil && len(*s.VirtualRouterName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("VirtualRouterName", 1))
-	}
-	if s.Spec != nil {
-		if err := s.Spec.Validate(); err != nil {
-			invalidParams.AddNested("Spec", err.(request.ErrInvalidParams))
-		}
+func (s *VirtualGatewayTlsValidationContextSdsTrust) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayTlsValidationContextSdsTrust"}
+	if s.SecretName == nil {
+		invalidParams.Add(request.NewErrParamRequired("SecretName"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -7400,103 +18325,152 @@ func (s *UpdateVirtualRouterInput) Validate() error {
 	return nil
 }
 
-// SetClientToken sets the ClientToken field's value.
-func (s *UpdateVirtualRouterInput) SetClientToken(v string) *UpdateVirtualRouterInput {
-	s.ClientToken = &v
+// SetSecretName sets the SecretName field's value.
+func (s *VirtualGatewayTlsValidationContextSdsTrust) SetSecretName(v string) *VirtualGatewayTlsValidationContextSdsTrust {
+	s.SecretName = &v
 	return s
 }
 
-// SetMeshName sets the MeshName field's value.
-func (s *UpdateVirtualRouterInput) SetMeshName(v string) *UpdateVirtualRouterInput {
-	s.MeshName = &v
-	return s
+// An object that represents a Transport Layer Security (TLS) validation context
+// trust.
+type VirtualGatewayTlsValidationContextTrust struct {
+	_ struct{} `type:"structure"`
+
+	// A reference to an object that represents a Transport Layer Security (TLS)
+	// validation context trust for an Certificate Manager certificate.
+	Acm *VirtualGatewayTlsValidationContextAcmTrust `locationName:"acm" type:"structure"`
+
+	// An object that represents a Transport Layer Security (TLS) validation context
+	// trust for a local file.
+	File *VirtualGatewayTlsValidationContextFileTrust `locationName:"file" type:"structure"`
+
+	// A reference to an object that represents a virtual gateway's Transport Layer
+	// Security (TLS) Secret Discovery Service validation context trust.
+	Sds *VirtualGatewayTlsValidationContextSdsTrust `locationName:"sds" type:"structure"`
 }
 
-// SetSpec sets the Spec field's value.
-func (s *UpdateVirtualRouterInput) SetSpec(v *VirtualRouterSpec) *UpdateVirtualRouterInput {
-	s.Spec = v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayTlsValidationContextTrust) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetVirtualRouterName sets the VirtualRouterName field's value.
-func (s *UpdateVirtualRouterInput) SetVirtualRouterName(v string) *UpdateVirtualRouterInput {
-	s.VirtualRouterName = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualGatewayTlsValidationContextTrust) GoString() string {
+	return s.String()
 }
 
-type UpdateVirtualRouterOutput struct {
-	_ struct{} `type:"structure" payload:"VirtualRouter"`
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *VirtualGatewayTlsValidationContextTrust) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualGatewayTlsValidationContextTrust"}
+	if s.Acm != nil {
+		if err := s.Acm.Validate(); err != nil {
+			invalidParams.AddNested("Acm", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.File != nil {
+		if err := s.File.Validate(); err != nil {
+			invalidParams.AddNested("File", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Sds != nil {
+		if err := s.Sds.Validate(); err != nil {
+			invalidParams.AddNested("Sds", err.(request.ErrInvalidParams))
+		}
+	}
 
-	// An object representing a virtual router returned by a describe operation.
-	//
-	// VirtualRouter is a required field
-	VirtualRouter *VirtualRouterData `locationName:"virtualRouter" type:"structure" required:"true"`
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// String returns the string representation
-func (s UpdateVirtualRouterOutput) String() string {
-	return awsutil.Prettify(s)
+// SetAcm sets the Acm field's value.
+func (s *VirtualGatewayTlsValidationContextTrust) SetAcm(v *VirtualGatewayTlsValidationContextAcmTrust) *VirtualGatewayTlsValidationContextTrust {
+	s.Acm = v
+	return s
 }
 
-// GoString returns the string representation
-func (s UpdateVirtualRouterOutput) GoString() string {
-	return s.String()
+// SetFile sets the File field's value.
+func (s *VirtualGatewayTlsValidationContextTrust) SetFile(v *VirtualGatewayTlsValidationContextFileTrust) *VirtualGatewayTlsValidationContextTrust {
+	s.File = v
+	return s
 }
 
-// SetVirtualRouter sets the VirtualRouter field's value.
-func (s *UpdateVirtualRouterOutput) SetVirtualRouter(v *VirtualRouterData) *UpdateVirtualRouterOutput {
-	s.VirtualRouter = v
+// SetSds sets the Sds field's value.
+func (s *VirtualGatewayTlsValidationContextTrust) SetSds(v *VirtualGatewayTlsValidationContextSdsTrust) *VirtualGatewayTlsValidationContextTrust {
+	s.Sds = v
 	return s
 }
 
-type UpdateVirtualServiceInput struct {
+// An object that represents the type of virtual node connection pool.
+//
+// Only one protocol is used at a time and should be the same protocol as the
+// one chosen under port mapping.
+//
+// If not present the default value for maxPendingRequests is 2147483647.
+type VirtualNodeConnectionPool struct {
 	_ struct{} `type:"structure"`
 
-	ClientToken *string `locationName:"clientToken" type:"string" idempotencyToken:"true"`
+	// An object that represents a type of connection pool.
+	Grpc *VirtualNodeGrpcConnectionPool `locationName:"grpc" type:"structure"`
 
-	// MeshName is a required field
-	MeshName *string `location:"uri" locationName:"meshName" min:"1" type:"string" required:"true"`
+	// An object that represents a type of connection pool.
+	Http *VirtualNodeHttpConnectionPool `locationName:"http" type:"structure"`
 
-	// An object representing the specification of a virtual service.
-	//
-	// Spec is a required field
-	Spec *VirtualServiceSpec `locationName:"spec" type:"structure" required:"true"`
+	// An object that represents a type of connection pool.
+	Http2 *VirtualNodeHttp2ConnectionPool `locationName:"http2" type:"structure"`
 
-	// VirtualServiceName is a required field
-	VirtualServiceName *string `location:"uri" locationName:"virtualServiceName" type:"string" required:"true"`
+	// An object that represents a type of connection pool.
+	Tcp *VirtualNodeTcpConnectionPool `locationName:"tcp" type:"structure"`
 }
 
-// String returns the string representation
-func (s UpdateVirtualServiceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualNodeConnectionPool) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s UpdateVirtualServiceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualNodeConnectionPool) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *UpdateVirtualServiceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "UpdateVirtualServiceInput"}
-	if s.MeshName == nil {
-		invalidParams.Add(request.NewErrParamRequired("MeshName"))
-	}
-	if s.MeshName != nil && len(*s.MeshName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("MeshName", 1))
-	}
-	if s.Spec == nil {
-		invalidParams.Add(request.NewErrParamRequired("Spec"))
-	}
-	if s.VirtualServiceName == nil {
-		invalidParams.Add(request.NewErrParamRequired("VirtualServiceName"))
+func (s *VirtualNodeConnectionPool) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualNodeConnectionPool"}
+	if s.Grpc != nil {
+		if err := s.Grpc.Validate(); err != nil {
+			invalidParams.AddNested("Grpc", err.(request.ErrInvalidParams))
+		}
 	}
-	if s.VirtualServiceName != nil && len(*s.VirtualServiceName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("VirtualServiceName", 1))
+	if s.Http != nil {
+		if err := s.Http.Validate(); err != nil {
+			invalidParams.AddNested("Http", err.(request.ErrInvalidParams))
+		}
 	}
-	if s.Spec != nil {
-		if err := s.Spec.Validate(); err != nil {
-			invalidParams.AddNested("Spec", err.(request.ErrInvalidParams))
+	if s.Http2 != nil {
+		if err := s.Http2.Validate(); err != nil {
+			invalidParams.AddNested("Http2", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tcp != nil {
+		if err := s.Tcp.Validate(); err != nil {
+			invalidParams.AddNested("Tcp", err.(request.ErrInvalidParams))
 		}
 	}
 
@@ -7506,87 +18480,74 @@ func (s *UpdateVirtualServiceInput) Validate() error {
 	return nil
 }
 
-// SetClientToken sets the ClientToken field's value.
-func (s *UpdateVirtualServiceInput) SetClientToken(v string) *UpdateVirtualServiceInput {
-	s.ClientToken = &v
-	return s
-}
-
-// SetMeshName sets the MeshName field's value.
-func (s *UpdateVirtualServiceInput) SetMeshName(v string) *UpdateVirtualServiceInput {
-	s.MeshName = &v
+// SetGrpc sets the Grpc field's value.
+func (s *VirtualNodeConnectionPool) SetGrpc(v *VirtualNodeGrpcConnectionPool) *VirtualNodeConnectionPool {
+	s.Grpc = v
 	return s
 }
 
-// SetSpec sets the Spec field's value.
-func (s *UpdateVirtualServiceInput) SetSpec(v *VirtualServiceSpec) *UpdateVirtualServiceInput {
-	s.Spec = v
+// SetHttp sets the Http field's value.
+func (s *VirtualNodeConnectionPool) SetHttp(v *VirtualNodeHttpConnectionPool) *VirtualNodeConnectionPool {
+	s.Http = v
 	return s
 }
 
-// SetVirtualServiceName sets the VirtualServiceName field's value.
-func (s *UpdateVirtualServiceInput) SetVirtualServiceName(v string) *UpdateVirtualServiceInput {
-	s.VirtualServiceName = &v
+// SetHttp2 sets the Http2 field's value.
+func (s *VirtualNodeConnectionPool) SetHttp2(v *VirtualNodeHttp2ConnectionPool) *VirtualNodeConnectionPool {
+	s.Http2 = v
 	return s
 }
 
-type UpdateVirtualServiceOutput struct {
-	_ struct{} `type:"structure" payload:"VirtualService"`
-
-	// An object representing a virtual service returned by a describe operation.
-	//
-	// VirtualService is a required field
-	VirtualService *VirtualServiceData `locationName:"virtualService" type:"structure" required:"true"`
-}
-
-// String returns the string representation
-func (s UpdateVirtualServiceOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s UpdateVirtualServiceOutput) GoString() string {
-	return s.String()
-}
-
-// SetVirtualService sets the VirtualService field's value.
-func (s *UpdateVirtualServiceOutput) SetVirtualService(v *VirtualServiceData) *UpdateVirtualServiceOutput {
-	s.VirtualService = v
+// SetTcp sets the Tcp field's value.
+func (s *VirtualNodeConnectionPool) SetTcp(v *VirtualNodeTcpConnectionPool) *VirtualNodeConnectionPool {
+	s.Tcp = v
 	return s
 }
 
-// An object representing a virtual node returned by a describe operation.
+// An object that represents a virtual node returned by a describe operation.
 type VirtualNodeData struct {
 	_ struct{} `type:"structure"`
 
+	// The name of the service mesh that the virtual node resides in.
+	//
 	// MeshName is a required field
 	MeshName *string `locationName:"meshName" min:"1" type:"string" required:"true"`
 
-	// An object representing metadata for a resource.
+	// The associated metadata for the virtual node.
 	//
 	// Metadata is a required field
 	Metadata *ResourceMetadata `locationName:"metadata" type:"structure" required:"true"`
 
-	// An object representing the specification of a virtual node.
+	// The specifications of the virtual node.
 	//
 	// Spec is a required field
 	Spec *VirtualNodeSpec `locationName:"spec" type:"structure" required:"true"`
 
-	// An object representing the current status of the virtual node.
+	// The current status for the virtual node.
 	//
 	// Status is a required field
 	Status *VirtualNodeStatus `locationName:"status" type:"structure" required:"true"`
 
+	// The name of the virtual node.
+	//
 	// VirtualNodeName is a required field
 	VirtualNodeName *string `locationName:"virtualNodeName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualNodeData) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualNodeData) GoString() string {
 	return s.String()
 }
@@ -7621,26 +18582,238 @@ func (s *VirtualNodeData) SetVirtualNodeName(v string) *VirtualNodeData {
 	return s
 }
 
-// An object representing a virtual node returned by a list operation.
+// An object that represents a type of connection pool.
+type VirtualNodeGrpcConnectionPool struct {
+	_ struct{} `type:"structure"`
+
+	// Maximum number of inflight requests Envoy can concurrently support across
+	// hosts in upstream cluster.
+	//
+	// MaxRequests is a required field
+	MaxRequests *int64 `locationName:"maxRequests" min:"1" type:"integer" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualNodeGrpcConnectionPool) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualNodeGrpcConnectionPool) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *VirtualNodeGrpcConnectionPool) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualNodeGrpcConnectionPool"}
+	if s.MaxRequests == nil {
+		invalidParams.Add(request.NewErrParamRequired("MaxRequests"))
+	}
+	if s.MaxRequests != nil && *s.MaxRequests < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxRequests", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetMaxRequests sets the MaxRequests field's value.
+func (s *VirtualNodeGrpcConnectionPool) SetMaxRequests(v int64) *VirtualNodeGrpcConnectionPool {
+	s.MaxRequests = &v
+	return s
+}
+
+// An object that represents a type of connection pool.
+type VirtualNodeHttp2ConnectionPool struct {
+	_ struct{} `type:"structure"`
+
+	// Maximum number of inflight requests Envoy can concurrently support across
+	// hosts in upstream cluster.
+	//
+	// MaxRequests is a required field
+	MaxRequests *int64 `locationName:"maxRequests" min:"1" type:"integer" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualNodeHttp2ConnectionPool) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualNodeHttp2ConnectionPool) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *VirtualNodeHttp2ConnectionPool) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualNodeHttp2ConnectionPool"}
+	if s.MaxRequests == nil {
+		invalidParams.Add(request.NewErrParamRequired("MaxRequests"))
+	}
+	if s.MaxRequests != nil && *s.MaxRequests < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxRequests", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetMaxRequests sets the MaxRequests field's value.
+func (s *VirtualNodeHttp2ConnectionPool) SetMaxRequests(v int64) *VirtualNodeHttp2ConnectionPool {
+	s.MaxRequests = &v
+	return s
+}
+
+// An object that represents a type of connection pool.
+type VirtualNodeHttpConnectionPool struct {
+	_ struct{} `type:"structure"`
+
+	// Maximum number of outbound TCP connections Envoy can establish concurrently
+	// with all hosts in upstream cluster.
+	//
+	// MaxConnections is a required field
+	MaxConnections *int64 `locationName:"maxConnections" min:"1" type:"integer" required:"true"`
+
+	// Number of overflowing requests after max_connections Envoy will queue to
+	// upstream cluster.
+	MaxPendingRequests *int64 `locationName:"maxPendingRequests" min:"1" type:"integer"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualNodeHttpConnectionPool) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualNodeHttpConnectionPool) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *VirtualNodeHttpConnectionPool) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualNodeHttpConnectionPool"}
+	if s.MaxConnections == nil {
+		invalidParams.Add(request.NewErrParamRequired("MaxConnections"))
+	}
+	if s.MaxConnections != nil && *s.MaxConnections < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxConnections", 1))
+	}
+	if s.MaxPendingRequests != nil && *s.MaxPendingRequests < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxPendingRequests", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetMaxConnections sets the MaxConnections field's value.
+func (s *VirtualNodeHttpConnectionPool) SetMaxConnections(v int64) *VirtualNodeHttpConnectionPool {
+	s.MaxConnections = &v
+	return s
+}
+
+// SetMaxPendingRequests sets the MaxPendingRequests field's value.
+func (s *VirtualNodeHttpConnectionPool) SetMaxPendingRequests(v int64) *VirtualNodeHttpConnectionPool {
+	s.MaxPendingRequests = &v
+	return s
+}
+
+// An object that represents a virtual node returned by a list operation.
 type VirtualNodeRef struct {
 	_ struct{} `type:"structure"`
 
+	// The full Amazon Resource Name (ARN) for the virtual node.
+	//
 	// Arn is a required field
 	Arn *string `locationName:"arn" type:"string" required:"true"`
 
+	// The Unix epoch timestamp in seconds for when the resource was created.
+	//
+	// CreatedAt is a required field
+	CreatedAt *time.Time `locationName:"createdAt" type:"timestamp" required:"true"`
+
+	// The Unix epoch timestamp in seconds for when the resource was last updated.
+	//
+	// LastUpdatedAt is a required field
+	LastUpdatedAt *time.Time `locationName:"lastUpdatedAt" type:"timestamp" required:"true"`
+
+	// The name of the service mesh that the virtual node resides in.
+	//
 	// MeshName is a required field
 	MeshName *string `locationName:"meshName" min:"1" type:"string" required:"true"`
 
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	//
+	// MeshOwner is a required field
+	MeshOwner *string `locationName:"meshOwner" min:"12" type:"string" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the resource owner. If the account
+	// ID is not your own, then it's the ID of the mesh owner or of another account
+	// that the mesh is shared with. For more information about mesh sharing, see
+	// Working with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	//
+	// ResourceOwner is a required field
+	ResourceOwner *string `locationName:"resourceOwner" min:"12" type:"string" required:"true"`
+
+	// The version of the resource. Resources are created at version 1, and this
+	// version is incremented each time that they're updated.
+	//
+	// Version is a required field
+	Version *int64 `locationName:"version" type:"long" required:"true"`
+
+	// The name of the virtual node.
+	//
 	// VirtualNodeName is a required field
 	VirtualNodeName *string `locationName:"virtualNodeName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualNodeRef) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualNodeRef) GoString() string {
 	return s.String()
 }
@@ -7651,32 +18824,72 @@ func (s *VirtualNodeRef) SetArn(v string) *VirtualNodeRef {
 	return s
 }
 
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *VirtualNodeRef) SetCreatedAt(v time.Time) *VirtualNodeRef {
+	s.CreatedAt = &v
+	return s
+}
+
+// SetLastUpdatedAt sets the LastUpdatedAt field's value.
+func (s *VirtualNodeRef) SetLastUpdatedAt(v time.Time) *VirtualNodeRef {
+	s.LastUpdatedAt = &v
+	return s
+}
+
 // SetMeshName sets the MeshName field's value.
 func (s *VirtualNodeRef) SetMeshName(v string) *VirtualNodeRef {
 	s.MeshName = &v
 	return s
 }
 
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *VirtualNodeRef) SetMeshOwner(v string) *VirtualNodeRef {
+	s.MeshOwner = &v
+	return s
+}
+
+// SetResourceOwner sets the ResourceOwner field's value.
+func (s *VirtualNodeRef) SetResourceOwner(v string) *VirtualNodeRef {
+	s.ResourceOwner = &v
+	return s
+}
+
+// SetVersion sets the Version field's value.
+func (s *VirtualNodeRef) SetVersion(v int64) *VirtualNodeRef {
+	s.Version = &v
+	return s
+}
+
 // SetVirtualNodeName sets the VirtualNodeName field's value.
 func (s *VirtualNodeRef) SetVirtualNodeName(v string) *VirtualNodeRef {
 	s.VirtualNodeName = &v
 	return s
 }
 
-// An object representing a virtual node service provider.
+// An object that represents a virtual node service provider.
 type VirtualNodeServiceProvider struct {
 	_ struct{} `type:"structure"`
 
+	// The name of the virtual node that is acting as a service provider.
+	//
 	// VirtualNodeName is a required field
 	VirtualNodeName *string `locationName:"virtualNodeName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualNodeServiceProvider) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualNodeServiceProvider) GoString() string {
 	return s.String()
 }
@@ -7703,27 +18916,43 @@ func (s *VirtualNodeServiceProvider) SetVirtualNodeName(v string) *VirtualNodeSe
 	return s
 }
 
-// An object representing the specification of a virtual node.
+// An object that represents the specification of a virtual node.
 type VirtualNodeSpec struct {
 	_ struct{} `type:"structure"`
 
+	// A reference to an object that represents the defaults for backends.
+	BackendDefaults *BackendDefaults `locationName:"backendDefaults" type:"structure"`
+
+	// The backends that the virtual node is expected to send outbound traffic to.
 	Backends []*Backend `locationName:"backends" type:"list"`
 
+	// The listener that the virtual node is expected to receive inbound traffic
+	// from. You can specify one listener.
 	Listeners []*Listener `locationName:"listeners" type:"list"`
 
-	// An object representing the logging information for a virtual node.
+	// The inbound and outbound access logging information for the virtual node.
 	Logging *Logging `locationName:"logging" type:"structure"`
 
-	// An object representing the service discovery information for a virtual node.
+	// The service discovery information for the virtual node. If your virtual node
+	// does not expect ingress traffic, you can omit this parameter. If you specify
+	// a listener, then you must specify service discovery information.
 	ServiceDiscovery *ServiceDiscovery `locationName:"serviceDiscovery" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualNodeSpec) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualNodeSpec) GoString() string {
 	return s.String()
 }
@@ -7731,6 +18960,11 @@ func (s VirtualNodeSpec) GoString() string {
 // Validate inspects the fields of the type to determine if they are valid.
 func (s *VirtualNodeSpec) Validate() error {
 	invalidParams := request.ErrInvalidParams{Context: "VirtualNodeSpec"}
+	if s.BackendDefaults != nil {
+		if err := s.BackendDefaults.Validate(); err != nil {
+			invalidParams.AddNested("BackendDefaults", err.(request.ErrInvalidParams))
+		}
+	}
 	if s.Backends != nil {
 		for i, v := range s.Backends {
 			if v == nil {
@@ -7768,6 +19002,12 @@ func (s *VirtualNodeSpec) Validate() error {
 	return nil
 }
 
+// SetBackendDefaults sets the BackendDefaults field's value.
+func (s *VirtualNodeSpec) SetBackendDefaults(v *BackendDefaults) *VirtualNodeSpec {
+	s.BackendDefaults = v
+	return s
+}
+
 // SetBackends sets the Backends field's value.
 func (s *VirtualNodeSpec) SetBackends(v []*Backend) *VirtualNodeSpec {
 	s.Backends = v
@@ -7792,20 +19032,30 @@ func (s *VirtualNodeSpec) SetServiceDiscovery(v *ServiceDiscovery) *VirtualNodeS
 	return s
 }
 
-// An object representing the current status of the virtual node.
+// An object that represents the current status of the virtual node.
 type VirtualNodeStatus struct {
 	_ struct{} `type:"structure"`
 
+	// The current status of the virtual node.
+	//
 	// Status is a required field
 	Status *string `locationName:"status" type:"string" required:"true" enum:"VirtualNodeStatusCode"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualNodeStatus) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualNodeStatus) GoString() string {
 	return s.String()
 }
@@ -7816,38 +19066,101 @@ func (s *VirtualNodeStatus) SetStatus(v string) *VirtualNodeStatus {
 	return s
 }
 
-// An object representing a virtual router returned by a describe operation.
+// An object that represents a type of connection pool.
+type VirtualNodeTcpConnectionPool struct {
+	_ struct{} `type:"structure"`
+
+	// Maximum number of outbound TCP connections Envoy can establish concurrently
+	// with all hosts in upstream cluster.
+	//
+	// MaxConnections is a required field
+	MaxConnections *int64 `locationName:"maxConnections" min:"1" type:"integer" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualNodeTcpConnectionPool) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualNodeTcpConnectionPool) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *VirtualNodeTcpConnectionPool) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VirtualNodeTcpConnectionPool"}
+	if s.MaxConnections == nil {
+		invalidParams.Add(request.NewErrParamRequired("MaxConnections"))
+	}
+	if s.MaxConnections != nil && *s.MaxConnections < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxConnections", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetMaxConnections sets the MaxConnections field's value.
+func (s *VirtualNodeTcpConnectionPool) SetMaxConnections(v int64) *VirtualNodeTcpConnectionPool {
+	s.MaxConnections = &v
+	return s
+}
+
+// An object that represents a virtual router returned by a describe operation.
 type VirtualRouterData struct {
 	_ struct{} `type:"structure"`
 
+	// The name of the service mesh that the virtual router resides in.
+	//
 	// MeshName is a required field
 	MeshName *string `locationName:"meshName" min:"1" type:"string" required:"true"`
 
-	// An object representing metadata for a resource.
+	// The associated metadata for the virtual router.
 	//
 	// Metadata is a required field
 	Metadata *ResourceMetadata `locationName:"metadata" type:"structure" required:"true"`
 
-	// An object representing the specification of a virtual router.
+	// The specifications of the virtual router.
 	//
 	// Spec is a required field
 	Spec *VirtualRouterSpec `locationName:"spec" type:"structure" required:"true"`
 
-	// An object representing the status of a virtual router.
+	// The current status of the virtual router.
 	//
 	// Status is a required field
 	Status *VirtualRouterStatus `locationName:"status" type:"structure" required:"true"`
 
+	// The name of the virtual router.
+	//
 	// VirtualRouterName is a required field
 	VirtualRouterName *string `locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualRouterData) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualRouterData) GoString() string {
 	return s.String()
 }
@@ -7882,22 +19195,30 @@ func (s *VirtualRouterData) SetVirtualRouterName(v string) *VirtualRouterData {
 	return s
 }
 
-// An object representing a virtual router listener.
+// An object that represents a virtual router listener.
 type VirtualRouterListener struct {
 	_ struct{} `type:"structure"`
 
-	// An object representing a virtual node or virtual router listener port mapping.
+	// An object that represents a port mapping.
 	//
 	// PortMapping is a required field
 	PortMapping *PortMapping `locationName:"portMapping" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualRouterListener) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualRouterListener) GoString() string {
 	return s.String()
 }
@@ -7926,39 +19247,115 @@ func (s *VirtualRouterListener) SetPortMapping(v *PortMapping) *VirtualRouterLis
 	return s
 }
 
-// An object representing a virtual router returned by a list operation.
+// An object that represents a virtual router returned by a list operation.
 type VirtualRouterRef struct {
 	_ struct{} `type:"structure"`
 
+	// The full Amazon Resource Name (ARN) for the virtual router.
+	//
 	// Arn is a required field
 	Arn *string `locationName:"arn" type:"string" required:"true"`
 
+	// The Unix epoch timestamp in seconds for when the resource was created.
+	//
+	// CreatedAt is a required field
+	CreatedAt *time.Time `locationName:"createdAt" type:"timestamp" required:"true"`
+
+	// The Unix epoch timestamp in seconds for when the resource was last updated.
+	//
+	// LastUpdatedAt is a required field
+	LastUpdatedAt *time.Time `locationName:"lastUpdatedAt" type:"timestamp" required:"true"`
+
+	// The name of the service mesh that the virtual router resides in.
+	//
 	// MeshName is a required field
 	MeshName *string `locationName:"meshName" min:"1" type:"string" required:"true"`
 
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	//
+	// MeshOwner is a required field
+	MeshOwner *string `locationName:"meshOwner" min:"12" type:"string" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the resource owner. If the account
+	// ID is not your own, then it's the ID of the mesh owner or of another account
+	// that the mesh is shared with. For more information about mesh sharing, see
+	// Working with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	//
+	// ResourceOwner is a required field
+	ResourceOwner *string `locationName:"resourceOwner" min:"12" type:"string" required:"true"`
+
+	// The version of the resource. Resources are created at version 1, and this
+	// version is incremented each time that they're updated.
+	//
+	// Version is a required field
+	Version *int64 `locationName:"version" type:"long" required:"true"`
+
+	// The name of the virtual router.
+	//
 	// VirtualRouterName is a required field
 	VirtualRouterName *string `locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualRouterRef) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualRouterRef) GoString() string {
 	return s.String()
 }
 
-// SetArn sets the Arn field's value.
-func (s *VirtualRouterRef) SetArn(v string) *VirtualRouterRef {
-	s.Arn = &v
+// SetArn sets the Arn field's value.
+func (s *VirtualRouterRef) SetArn(v string) *VirtualRouterRef {
+	s.Arn = &v
+	return s
+}
+
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *VirtualRouterRef) SetCreatedAt(v time.Time) *VirtualRouterRef {
+	s.CreatedAt = &v
+	return s
+}
+
+// SetLastUpdatedAt sets the LastUpdatedAt field's value.
+func (s *VirtualRouterRef) SetLastUpdatedAt(v time.Time) *VirtualRouterRef {
+	s.LastUpdatedAt = &v
+	return s
+}
+
+// SetMeshName sets the MeshName field's value.
+func (s *VirtualRouterRef) SetMeshName(v string) *VirtualRouterRef {
+	s.MeshName = &v
+	return s
+}
+
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *VirtualRouterRef) SetMeshOwner(v string) *VirtualRouterRef {
+	s.MeshOwner = &v
+	return s
+}
+
+// SetResourceOwner sets the ResourceOwner field's value.
+func (s *VirtualRouterRef) SetResourceOwner(v string) *VirtualRouterRef {
+	s.ResourceOwner = &v
 	return s
 }
 
-// SetMeshName sets the MeshName field's value.
-func (s *VirtualRouterRef) SetMeshName(v string) *VirtualRouterRef {
-	s.MeshName = &v
+// SetVersion sets the Version field's value.
+func (s *VirtualRouterRef) SetVersion(v int64) *VirtualRouterRef {
+	s.Version = &v
 	return s
 }
 
@@ -7968,20 +19365,30 @@ func (s *VirtualRouterRef) SetVirtualRouterName(v string) *VirtualRouterRef {
 	return s
 }
 
-// An object representing a virtual node service provider.
+// An object that represents a virtual node service provider.
 type VirtualRouterServiceProvider struct {
 	_ struct{} `type:"structure"`
 
+	// The name of the virtual router that is acting as a service provider.
+	//
 	// VirtualRouterName is a required field
 	VirtualRouterName *string `locationName:"virtualRouterName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualRouterServiceProvider) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualRouterServiceProvider) GoString() string {
 	return s.String()
 }
@@ -8008,19 +19415,29 @@ func (s *VirtualRouterServiceProvider) SetVirtualRouterName(v string) *VirtualRo
 	return s
 }
 
-// An object representing the specification of a virtual router.
+// An object that represents the specification of a virtual router.
 type VirtualRouterSpec struct {
 	_ struct{} `type:"structure"`
 
-	Listeners []*VirtualRouterListener `locationName:"listeners" min:"1" type:"list"`
+	// The listeners that the virtual router is expected to receive inbound traffic
+	// from. You can specify one listener.
+	Listeners []*VirtualRouterListener `locationName:"listeners" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualRouterSpec) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualRouterSpec) GoString() string {
 	return s.String()
 }
@@ -8028,9 +19445,6 @@ func (s VirtualRouterSpec) GoString() string {
 // Validate inspects the fields of the type to determine if they are valid.
 func (s *VirtualRouterSpec) Validate() error {
 	invalidParams := request.ErrInvalidParams{Context: "VirtualRouterSpec"}
-	if s.Listeners != nil && len(s.Listeners) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Listeners", 1))
-	}
 	if s.Listeners != nil {
 		for i, v := range s.Listeners {
 			if v == nil {
@@ -8054,20 +19468,30 @@ func (s *VirtualRouterSpec) SetListeners(v []*VirtualRouterListener) *VirtualRou
 	return s
 }
 
-// An object representing the status of a virtual router.
+// An object that represents the status of a virtual router.
 type VirtualRouterStatus struct {
 	_ struct{} `type:"structure"`
 
+	// The current status of the virtual router.
+	//
 	// Status is a required field
 	Status *string `locationName:"status" type:"string" required:"true" enum:"VirtualRouterStatusCode"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualRouterStatus) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualRouterStatus) GoString() string {
 	return s.String()
 }
@@ -8078,20 +19502,33 @@ func (s *VirtualRouterStatus) SetStatus(v string) *VirtualRouterStatus {
 	return s
 }
 
-// An object representing a virtual service backend for a virtual node.
+// An object that represents a virtual service backend for a virtual node.
 type VirtualServiceBackend struct {
 	_ struct{} `type:"structure"`
 
+	// A reference to an object that represents the client policy for a backend.
+	ClientPolicy *ClientPolicy `locationName:"clientPolicy" type:"structure"`
+
+	// The name of the virtual service that is acting as a virtual node backend.
+	//
 	// VirtualServiceName is a required field
 	VirtualServiceName *string `locationName:"virtualServiceName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualServiceBackend) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualServiceBackend) GoString() string {
 	return s.String()
 }
@@ -8102,6 +19539,11 @@ func (s *VirtualServiceBackend) Validate() error {
 	if s.VirtualServiceName == nil {
 		invalidParams.Add(request.NewErrParamRequired("VirtualServiceName"))
 	}
+	if s.ClientPolicy != nil {
+		if err := s.ClientPolicy.Validate(); err != nil {
+			invalidParams.AddNested("ClientPolicy", err.(request.ErrInvalidParams))
+		}
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -8109,44 +19551,62 @@ func (s *VirtualServiceBackend) Validate() error {
 	return nil
 }
 
+// SetClientPolicy sets the ClientPolicy field's value.
+func (s *VirtualServiceBackend) SetClientPolicy(v *ClientPolicy) *VirtualServiceBackend {
+	s.ClientPolicy = v
+	return s
+}
+
 // SetVirtualServiceName sets the VirtualServiceName field's value.
 func (s *VirtualServiceBackend) SetVirtualServiceName(v string) *VirtualServiceBackend {
 	s.VirtualServiceName = &v
 	return s
 }
 
-// An object representing a virtual service returned by a describe operation.
+// An object that represents a virtual service returned by a describe operation.
 type VirtualServiceData struct {
 	_ struct{} `type:"structure"`
 
+	// The name of the service mesh that the virtual service resides in.
+	//
 	// MeshName is a required field
 	MeshName *string `locationName:"meshName" min:"1" type:"string" required:"true"`
 
-	// An object representing metadata for a resource.
+	// An object that represents metadata for a resource.
 	//
 	// Metadata is a required field
 	Metadata *ResourceMetadata `locationName:"metadata" type:"structure" required:"true"`
 
-	// An object representing the specification of a virtual service.
+	// The specifications of the virtual service.
 	//
 	// Spec is a required field
 	Spec *VirtualServiceSpec `locationName:"spec" type:"structure" required:"true"`
 
-	// An object representing the status of a virtual service.
+	// The current status of the virtual service.
 	//
 	// Status is a required field
 	Status *VirtualServiceStatus `locationName:"status" type:"structure" required:"true"`
 
+	// The name of the virtual service.
+	//
 	// VirtualServiceName is a required field
 	VirtualServiceName *string `locationName:"virtualServiceName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualServiceData) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualServiceData) GoString() string {
 	return s.String()
 }
@@ -8181,23 +19641,31 @@ func (s *VirtualServiceData) SetVirtualServiceName(v string) *VirtualServiceData
 	return s
 }
 
-// An object representing the provider for a virtual service.
+// An object that represents the provider for a virtual service.
 type VirtualServiceProvider struct {
 	_ struct{} `type:"structure"`
 
-	// An object representing a virtual node service provider.
+	// The virtual node associated with a virtual service.
 	VirtualNode *VirtualNodeServiceProvider `locationName:"virtualNode" type:"structure"`
 
-	// An object representing a virtual node service provider.
+	// The virtual router associated with a virtual service.
 	VirtualRouter *VirtualRouterServiceProvider `locationName:"virtualRouter" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualServiceProvider) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualServiceProvider) GoString() string {
 	return s.String()
 }
@@ -8234,26 +19702,72 @@ func (s *VirtualServiceProvider) SetVirtualRouter(v *VirtualRouterServiceProvide
 	return s
 }
 
-// An object representing a virtual service returned by a list operation.
+// An object that represents a virtual service returned by a list operation.
 type VirtualServiceRef struct {
 	_ struct{} `type:"structure"`
 
+	// The full Amazon Resource Name (ARN) for the virtual service.
+	//
 	// Arn is a required field
 	Arn *string `locationName:"arn" type:"string" required:"true"`
 
+	// The Unix epoch timestamp in seconds for when the resource was created.
+	//
+	// CreatedAt is a required field
+	CreatedAt *time.Time `locationName:"createdAt" type:"timestamp" required:"true"`
+
+	// The Unix epoch timestamp in seconds for when the resource was last updated.
+	//
+	// LastUpdatedAt is a required field
+	LastUpdatedAt *time.Time `locationName:"lastUpdatedAt" type:"timestamp" required:"true"`
+
+	// The name of the service mesh that the virtual service resides in.
+	//
 	// MeshName is a required field
 	MeshName *string `locationName:"meshName" min:"1" type:"string" required:"true"`
 
+	// The Amazon Web Services IAM account ID of the service mesh owner. If the
+	// account ID is not your own, then it's the ID of the account that shared the
+	// mesh with your account. For more information about mesh sharing, see Working
+	// with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	//
+	// MeshOwner is a required field
+	MeshOwner *string `locationName:"meshOwner" min:"12" type:"string" required:"true"`
+
+	// The Amazon Web Services IAM account ID of the resource owner. If the account
+	// ID is not your own, then it's the ID of the mesh owner or of another account
+	// that the mesh is shared with. For more information about mesh sharing, see
+	// Working with shared meshes (https://docs.aws.amazon.com/app-mesh/latest/userguide/sharing.html).
+	//
+	// ResourceOwner is a required field
+	ResourceOwner *string `locationName:"resourceOwner" min:"12" type:"string" required:"true"`
+
+	// The version of the resource. Resources are created at version 1, and this
+	// version is incremented each time that they're updated.
+	//
+	// Version is a required field
+	Version *int64 `locationName:"version" type:"long" required:"true"`
+
+	// The name of the virtual service.
+	//
 	// VirtualServiceName is a required field
 	VirtualServiceName *string `locationName:"virtualServiceName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualServiceRef) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualServiceRef) GoString() string {
 	return s.String()
 }
@@ -8264,32 +19778,71 @@ func (s *VirtualServiceRef) SetArn(v string) *VirtualServiceRef {
 	return s
 }
 
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *VirtualServiceRef) SetCreatedAt(v time.Time) *VirtualServiceRef {
+	s.CreatedAt = &v
+	return s
+}
+
+// SetLastUpdatedAt sets the LastUpdatedAt field's value.
+func (s *VirtualServiceRef) SetLastUpdatedAt(v time.Time) *VirtualServiceRef {
+	s.LastUpdatedAt = &v
+	return s
+}
+
 // SetMeshName sets the MeshName field's value.
 func (s *VirtualServiceRef) SetMeshName(v string) *VirtualServiceRef {
 	s.MeshName = &v
 	return s
 }
 
+// SetMeshOwner sets the MeshOwner field's value.
+func (s *VirtualServiceRef) SetMeshOwner(v string) *VirtualServiceRef {
+	s.MeshOwner = &v
+	return s
+}
+
+// SetResourceOwner sets the ResourceOwner field's value.
+func (s *VirtualServiceRef) SetResourceOwner(v string) *VirtualServiceRef {
+	s.ResourceOwner = &v
+	return s
+}
+
+// SetVersion sets the Version field's value.
+func (s *VirtualServiceRef) SetVersion(v int64) *VirtualServiceRef {
+	s.Version = &v
+	return s
+}
+
 // SetVirtualServiceName sets the VirtualServiceName field's value.
 func (s *VirtualServiceRef) SetVirtualServiceName(v string) *VirtualServiceRef {
 	s.VirtualServiceName = &v
 	return s
 }
 
-// An object representing the specification of a virtual service.
+// An object that represents the specification of a virtual service.
 type VirtualServiceSpec struct {
 	_ struct{} `type:"structure"`
 
-	// An object representing the provider for a virtual service.
+	// The App Mesh object that is acting as the provider for a virtual service.
+	// You can specify a single virtual node or virtual router.
 	Provider *VirtualServiceProvider `locationName:"provider" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualServiceSpec) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualServiceSpec) GoString() string {
 	return s.String()
 }
@@ -8315,20 +19868,30 @@ func (s *VirtualServiceSpec) SetProvider(v *VirtualServiceProvider) *VirtualServ
 	return s
 }
 
-// An object representing the status of a virtual service.
+// An object that represents the status of a virtual service.
 type VirtualServiceStatus struct {
 	_ struct{} `type:"structure"`
 
+	// The current status of the virtual service.
+	//
 	// Status is a required field
 	Status *string `locationName:"status" type:"string" required:"true" enum:"VirtualServiceStatusCode"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualServiceStatus) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualServiceStatus) GoString() string {
 	return s.String()
 }
@@ -8339,26 +19902,42 @@ func (s *VirtualServiceStatus) SetStatus(v string) *VirtualServiceStatus {
 	return s
 }
 
-// An object representing a target and its relative weight. Traffic is distributed
+// An object that represents a target and its relative weight. Traffic is distributed
 // across targets according to their relative weight. For example, a weighted
 // target with a relative weight of 50 receives five times as much traffic as
-// one with a relative weight of 10.
+// one with a relative weight of 10. The total weight for all targets combined
+// must be less than or equal to 100.
 type WeightedTarget struct {
 	_ struct{} `type:"structure"`
 
+	// The targeted port of the weighted object.
+	Port *int64 `locationName:"port" min:"1" type:"integer"`
+
+	// The virtual node to associate with the weighted target.
+	//
 	// VirtualNode is a required field
 	VirtualNode *string `locationName:"virtualNode" min:"1" type:"string" required:"true"`
 
+	// The relative weight of the weighted target.
+	//
 	// Weight is a required field
 	Weight *int64 `locationName:"weight" type:"integer" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s WeightedTarget) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s WeightedTarget) GoString() string {
 	return s.String()
 }
@@ -8366,6 +19945,9 @@ func (s WeightedTarget) GoString() string {
 // Validate inspects the fields of the type to determine if they are valid.
 func (s *WeightedTarget) Validate() error {
 	invalidParams := request.ErrInvalidParams{Context: "WeightedTarget"}
+	if s.Port != nil && *s.Port < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Port", 1))
+	}
 	if s.VirtualNode == nil {
 		invalidParams.Add(request.NewErrParamRequired("VirtualNode"))
 	}
@@ -8382,6 +19964,12 @@ func (s *WeightedTarget) Validate() error {
 	return nil
 }
 
+// SetPort sets the Port field's value.
+func (s *WeightedTarget) SetPort(v int64) *WeightedTarget {
+	s.Port = &v
+	return s
+}
+
 // SetVirtualNode sets the VirtualNode field's value.
 func (s *WeightedTarget) SetVirtualNode(v string) *WeightedTarget {
 	s.VirtualNode = &v
@@ -8395,13 +19983,53 @@ func (s *WeightedTarget) SetWeight(v int64) *WeightedTarget {
 }
 
 const (
-	// DurationUnitMs is a DurationUnit enum value
-	DurationUnitMs = "ms"
+	// DefaultGatewayRouteRewriteEnabled is a DefaultGatewayRouteRewrite enum value
+	DefaultGatewayRouteRewriteEnabled = "ENABLED"
 
+	// DefaultGatewayRouteRewriteDisabled is a DefaultGatewayRouteRewrite enum value
+	DefaultGatewayRouteRewriteDisabled = "DISABLED"
+)
+
+// DefaultGatewayRouteRewrite_Values returns all elements of the DefaultGatewayRouteRewrite enum
+func DefaultGatewayRouteRewrite_Values() []string {
+	return []string{
+		DefaultGatewayRouteRewriteEnabled,
+		DefaultGatewayRouteRewriteDisabled,
+	}
+}
+
+const (
+	// DnsResponseTypeLoadbalancer is a DnsResponseType enum value
+	DnsResponseTypeLoadbalancer = "LOADBALANCER"
+
+	// DnsResponseTypeEndpoints is a DnsResponseType enum value
+	DnsResponseTypeEndpoints = "ENDPOINTS"
+)
+
+// DnsResponseType_Values returns all elements of the DnsResponseType enum
+func DnsResponseType_Values() []string {
+	return []string{
+		DnsResponseTypeLoadbalancer,
+		DnsResponseTypeEndpoints,
+	}
+}
+
+const (
 	// DurationUnitS is a DurationUnit enum value
 	DurationUnitS = "s"
+
+	// DurationUnitMs is a DurationUnit enum value
+	DurationUnitMs = "ms"
 )
 
+// DurationUnit_Values returns all elements of the DurationUnit enum
+func DurationUnit_Values() []string {
+	return []string{
+		DurationUnitS,
+		DurationUnitMs,
+	}
+}
+
 const (
 	// EgressFilterTypeAllowAll is a EgressFilterType enum value
 	EgressFilterTypeAllowAll = "ALLOW_ALL"
@@ -8410,35 +20038,106 @@ const (
 	EgressFilterTypeDropAll = "DROP_ALL"
 )
 
+// EgressFilterType_Values returns all elements of the EgressFilterType enum
+func EgressFilterType_Values() []string {
+	return []string{
+		EgressFilterTypeAllowAll,
+		EgressFilterTypeDropAll,
+	}
+}
+
 const (
-	// HttpMethodConnect is a HttpMethod enum value
-	HttpMethodConnect = "CONNECT"
+	// GatewayRouteStatusCodeActive is a GatewayRouteStatusCode enum value
+	GatewayRouteStatusCodeActive = "ACTIVE"
 
-	// HttpMethodDelete is a HttpMethod enum value
-	HttpMethodDelete = "DELETE"
+	// GatewayRouteStatusCodeInactive is a GatewayRouteStatusCode enum value
+	GatewayRouteStatusCodeInactive = "INACTIVE"
+
+	// GatewayRouteStatusCodeDeleted is a GatewayRouteStatusCode enum value
+	GatewayRouteStatusCodeDeleted = "DELETED"
+)
+
+// GatewayRouteStatusCode_Values returns all elements of the GatewayRouteStatusCode enum
+func GatewayRouteStatusCode_Values() []string {
+	return []string{
+		GatewayRouteStatusCodeActive,
+		GatewayRouteStatusCodeInactive,
+		GatewayRouteStatusCodeDeleted,
+	}
+}
+
+const (
+	// GrpcRetryPolicyEventCancelled is a GrpcRetryPolicyEvent enum value
+	GrpcRetryPolicyEventCancelled = "cancelled"
+
+	// GrpcRetryPolicyEventDeadlineExceeded is a GrpcRetryPolicyEvent enum value
+	GrpcRetryPolicyEventDeadlineExceeded = "deadline-exceeded"
+
+	// GrpcRetryPolicyEventInternal is a GrpcRetryPolicyEvent enum value
+	GrpcRetryPolicyEventInternal = "internal"
+
+	// GrpcRetryPolicyEventResourceExhausted is a GrpcRetryPolicyEvent enum value
+	GrpcRetryPolicyEventResourceExhausted = "resource-exhausted"
+
+	// GrpcRetryPolicyEventUnavailable is a GrpcRetryPolicyEvent enum value
+	GrpcRetryPolicyEventUnavailable = "unavailable"
+)
+
+// GrpcRetryPolicyEvent_Values returns all elements of the GrpcRetryPolicyEvent enum
+func GrpcRetryPolicyEvent_Values() []string {
+	return []string{
+		GrpcRetryPolicyEventCancelled,
+		GrpcRetryPolicyEventDeadlineExceeded,
+		GrpcRetryPolicyEventInternal,
+		GrpcRetryPolicyEventResourceExhausted,
+		GrpcRetryPolicyEventUnavailable,
+	}
+}
 
+const (
 	// HttpMethodGet is a HttpMethod enum value
 	HttpMethodGet = "GET"
 
 	// HttpMethodHead is a HttpMethod enum value
 	HttpMethodHead = "HEAD"
 
-	// HttpMethodOptions is a HttpMethod enum value
-	HttpMethodOptions = "OPTIONS"
-
-	// HttpMethodPatch is a HttpMethod enum value
-	HttpMethodPatch = "PATCH"
-
 	// HttpMethodPost is a HttpMethod enum value
 	HttpMethodPost = "POST"
 
 	// HttpMethodPut is a HttpMethod enum value
 	HttpMethodPut = "PUT"
 
+	// HttpMethodDelete is a HttpMethod enum value
+	HttpMethodDelete = "DELETE"
+
+	// HttpMethodConnect is a HttpMethod enum value
+	HttpMethodConnect = "CONNECT"
+
+	// HttpMethodOptions is a HttpMethod enum value
+	HttpMethodOptions = "OPTIONS"
+
 	// HttpMethodTrace is a HttpMethod enum value
 	HttpMethodTrace = "TRACE"
+
+	// HttpMethodPatch is a HttpMethod enum value
+	HttpMethodPatch = "PATCH"
 )
 
+// HttpMethod_Values returns all elements of the HttpMethod enum
+func HttpMethod_Values() []string {
+	return []string{
+		HttpMethodGet,
+		HttpMethodHead,
+		HttpMethodPost,
+		HttpMethodPut,
+		HttpMethodDelete,
+		HttpMethodConnect,
+		HttpMethodOptions,
+		HttpMethodTrace,
+		HttpMethodPatch,
+	}
+}
+
 const (
 	// HttpSchemeHttp is a HttpScheme enum value
 	HttpSchemeHttp = "http"
@@ -8447,70 +20146,250 @@ const (
 	HttpSchemeHttps = "https"
 )
 
+// HttpScheme_Values returns all elements of the HttpScheme enum
+func HttpScheme_Values() []string {
+	return []string{
+		HttpSchemeHttp,
+		HttpSchemeHttps,
+	}
+}
+
+const (
+	// IpPreferenceIpv6Preferred is a IpPreference enum value
+	IpPreferenceIpv6Preferred = "IPv6_PREFERRED"
+
+	// IpPreferenceIpv4Preferred is a IpPreference enum value
+	IpPreferenceIpv4Preferred = "IPv4_PREFERRED"
+
+	// IpPreferenceIpv4Only is a IpPreference enum value
+	IpPreferenceIpv4Only = "IPv4_ONLY"
+
+	// IpPreferenceIpv6Only is a IpPreference enum value
+	IpPreferenceIpv6Only = "IPv6_ONLY"
+)
+
+// IpPreference_Values returns all elements of the IpPreference enum
+func IpPreference_Values() []string {
+	return []string{
+		IpPreferenceIpv6Preferred,
+		IpPreferenceIpv4Preferred,
+		IpPreferenceIpv4Only,
+		IpPreferenceIpv6Only,
+	}
+}
+
+const (
+	// ListenerTlsModeStrict is a ListenerTlsMode enum value
+	ListenerTlsModeStrict = "STRICT"
+
+	// ListenerTlsModePermissive is a ListenerTlsMode enum value
+	ListenerTlsModePermissive = "PERMISSIVE"
+
+	// ListenerTlsModeDisabled is a ListenerTlsMode enum value
+	ListenerTlsModeDisabled = "DISABLED"
+)
+
+// ListenerTlsMode_Values returns all elements of the ListenerTlsMode enum
+func ListenerTlsMode_Values() []string {
+	return []string{
+		ListenerTlsModeStrict,
+		ListenerTlsModePermissive,
+		ListenerTlsModeDisabled,
+	}
+}
+
 const (
 	// MeshStatusCodeActive is a MeshStatusCode enum value
 	MeshStatusCodeActive = "ACTIVE"
 
-	// MeshStatusCodeDeleted is a MeshStatusCode enum value
-	MeshStatusCodeDeleted = "DELETED"
-
 	// MeshStatusCodeInactive is a MeshStatusCode enum value
 	MeshStatusCodeInactive = "INACTIVE"
+
+	// MeshStatusCodeDeleted is a MeshStatusCode enum value
+	MeshStatusCodeDeleted = "DELETED"
 )
 
+// MeshStatusCode_Values returns all elements of the MeshStatusCode enum
+func MeshStatusCode_Values() []string {
+	return []string{
+		MeshStatusCodeActive,
+		MeshStatusCodeInactive,
+		MeshStatusCodeDeleted,
+	}
+}
+
 const (
 	// PortProtocolHttp is a PortProtocol enum value
 	PortProtocolHttp = "http"
 
 	// PortProtocolTcp is a PortProtocol enum value
 	PortProtocolTcp = "tcp"
+
+	// PortProtocolHttp2 is a PortProtocol enum value
+	PortProtocolHttp2 = "http2"
+
+	// PortProtocolGrpc is a PortProtocol enum value
+	PortProtocolGrpc = "grpc"
 )
 
+// PortProtocol_Values returns all elements of the PortProtocol enum
+func PortProtocol_Values() []string {
+	return []string{
+		PortProtocolHttp,
+		PortProtocolTcp,
+		PortProtocolHttp2,
+		PortProtocolGrpc,
+	}
+}
+
 const (
 	// RouteStatusCodeActive is a RouteStatusCode enum value
 	RouteStatusCodeActive = "ACTIVE"
 
-	// RouteStatusCodeDeleted is a RouteStatusCode enum value
-	RouteStatusCodeDeleted = "DELETED"
-
 	// RouteStatusCodeInactive is a RouteStatusCode enum value
 	RouteStatusCodeInactive = "INACTIVE"
+
+	// RouteStatusCodeDeleted is a RouteStatusCode enum value
+	RouteStatusCodeDeleted = "DELETED"
 )
 
+// RouteStatusCode_Values returns all elements of the RouteStatusCode enum
+func RouteStatusCode_Values() []string {
+	return []string{
+		RouteStatusCodeActive,
+		RouteStatusCodeInactive,
+		RouteStatusCodeDeleted,
+	}
+}
+
 const (
 	// TcpRetryPolicyEventConnectionError is a TcpRetryPolicyEvent enum value
 	TcpRetryPolicyEventConnectionError = "connection-error"
 )
 
+// TcpRetryPolicyEvent_Values returns all elements of the TcpRetryPolicyEvent enum
+func TcpRetryPolicyEvent_Values() []string {
+	return []string{
+		TcpRetryPolicyEventConnectionError,
+	}
+}
+
+const (
+	// VirtualGatewayListenerTlsModeStrict is a VirtualGatewayListenerTlsMode enum value
+	VirtualGatewayListenerTlsModeStrict = "STRICT"
+
+	// VirtualGatewayListenerTlsModePermissive is a VirtualGatewayListenerTlsMode enum value
+	VirtualGatewayListenerTlsModePermissive = "PERMISSIVE"
+
+	// VirtualGatewayListenerTlsModeDisabled is a VirtualGatewayListenerTlsMode enum value
+	VirtualGatewayListenerTlsModeDisabled = "DISABLED"
+)
+
+// VirtualGatewayListenerTlsMode_Values returns all elements of the VirtualGatewayListenerTlsMode enum
+func VirtualGatewayListenerTlsMode_Values() []string {
+	return []string{
+		VirtualGatewayListenerTlsModeStrict,
+		VirtualGatewayListenerTlsModePermissive,
+		VirtualGatewayListenerTlsModeDisabled,
+	}
+}
+
+const (
+	// VirtualGatewayPortProtocolHttp is a VirtualGatewayPortProtocol enum value
+	VirtualGatewayPortProtocolHttp = "http"
+
+	// VirtualGatewayPortProtocolHttp2 is a VirtualGatewayPortProtocol enum value
+	VirtualGatewayPortProtocolHttp2 = "http2"
+
+	// VirtualGatewayPortProtocolGrpc is a VirtualGatewayPortProtocol enum value
+	VirtualGatewayPortProtocolGrpc = "grpc"
+)
+
+// VirtualGatewayPortProtocol_Values returns all elements of the VirtualGatewayPortProtocol enum
+func VirtualGatewayPortProtocol_Values() []string {
+	return []string{
+		VirtualGatewayPortProtocolHttp,
+		VirtualGatewayPortProtocolHttp2,
+		VirtualGatewayPortProtocolGrpc,
+	}
+}
+
+const (
+	// VirtualGatewayStatusCodeActive is a VirtualGatewayStatusCode enum value
+	VirtualGatewayStatusCodeActive = "ACTIVE"
+
+	// VirtualGatewayStatusCodeInactive is a VirtualGatewayStatusCode enum value
+	VirtualGatewayStatusCodeInactive = "INACTIVE"
+
+	// VirtualGatewayStatusCodeDeleted is a VirtualGatewayStatusCode enum value
+	VirtualGatewayStatusCodeDeleted = "DELETED"
+)
+
+// VirtualGatewayStatusCode_Values returns all elements of the VirtualGatewayStatusCode enum
+func VirtualGatewayStatusCode_Values() []string {
+	return []string{
+		VirtualGatewayStatusCodeActive,
+		VirtualGatewayStatusCodeInactive,
+		VirtualGatewayStatusCodeDeleted,
+	}
+}
+
 const (
 	// VirtualNodeStatusCodeActive is a VirtualNodeStatusCode enum value
 	VirtualNodeStatusCodeActive = "ACTIVE"
 
-	// VirtualNodeStatusCodeDeleted is a VirtualNodeStatusCode enum value
-	VirtualNodeStatusCodeDeleted = "DELETED"
-
 	// VirtualNodeStatusCodeInactive is a VirtualNodeStatusCode enum value
 	VirtualNodeStatusCodeInactive = "INACTIVE"
+
+	// VirtualNodeStatusCodeDeleted is a VirtualNodeStatusCode enum value
+	VirtualNodeStatusCodeDeleted = "DELETED"
 )
 
+// VirtualNodeStatusCode_Values returns all elements of the VirtualNodeStatusCode enum
+func VirtualNodeStatusCode_Values() []string {
+	return []string{
+		VirtualNodeStatusCodeActive,
+		VirtualNodeStatusCodeInactive,
+		VirtualNodeStatusCodeDeleted,
+	}
+}
+
 const (
 	// VirtualRouterStatusCodeActive is a VirtualRouterStatusCode enum value
 	VirtualRouterStatusCodeActive = "ACTIVE"
 
-	// VirtualRouterStatusCodeDeleted is a VirtualRouterStatusCode enum value
-	VirtualRouterStatusCodeDeleted = "DELETED"
-
 	// VirtualRouterStatusCodeInactive is a VirtualRouterStatusCode enum value
 	VirtualRouterStatusCodeInactive = "INACTIVE"
+
+	// VirtualRouterStatusCodeDeleted is a VirtualRouterStatusCode enum value
+	VirtualRouterStatusCodeDeleted = "DELETED"
 )
 
+// VirtualRouterStatusCode_Values returns all elements of the VirtualRouterStatusCode enum
+func VirtualRouterStatusCode_Values() []string {
+	return []string{
+		VirtualRouterStatusCodeActive,
+		VirtualRouterStatusCodeInactive,
+		VirtualRouterStatusCodeDeleted,
+	}
+}
+
 const (
 	// VirtualServiceStatusCodeActive is a VirtualServiceStatusCode enum value
 	VirtualServiceStatusCodeActive = "ACTIVE"
 
-	// VirtualServiceStatusCodeDeleted is a VirtualServiceStatusCode enum value
-	VirtualServiceStatusCodeDeleted = "DELETED"
-
 	// VirtualServiceStatusCodeInactive is a VirtualServiceStatusCode enum value
 	VirtualServiceStatusCodeInactive = "INACTIVE"
+
+	// VirtualServiceStatusCodeDeleted is a VirtualServiceStatusCode enum value
+	VirtualServiceStatusCodeDeleted = "DELETED"
 )
+
+// VirtualServiceStatusCode_Values returns all elements of the VirtualServiceStatusCode enum
+func VirtualServiceStatusCode_Values() []string {
+	return []string{
+		VirtualServiceStatusCodeActive,
+		VirtualServiceStatusCodeInactive,
+		VirtualServiceStatusCodeDeleted,
+	}
+}
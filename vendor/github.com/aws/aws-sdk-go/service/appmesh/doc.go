@@ -3,18 +3,19 @@
 // Package appmesh provides the client and types for making API
 // requests to AWS App Mesh.
 //
-// AWS App Mesh is a service mesh based on the Envoy proxy that makes it easy
-// to monitor and control microservices. App Mesh standardizes how your microservices
+// App Mesh is a service mesh based on the Envoy proxy that makes it easy to
+// monitor and control microservices. App Mesh standardizes how your microservices
 // communicate, giving you end-to-end visibility and helping to ensure high
 // availability for your applications.
 //
 // App Mesh gives you consistent visibility and network traffic controls for
-// every microservice in an application. You can use App Mesh with AWS Fargate,
-// Amazon ECS, Amazon EKS, Kubernetes on AWS, and Amazon EC2.
+// every microservice in an application. You can use App Mesh with Amazon Web
+// Services Fargate, Amazon ECS, Amazon EKS, Kubernetes on Amazon Web Services,
+// and Amazon EC2.
 //
 // App Mesh supports microservice applications that use service discovery naming
 // for their components. For more information about service discovery on Amazon
-// ECS, see Service Discovery (http://docs.aws.amazon.com/AmazonECS/latest/developerguide/service-discovery.html)
+// ECS, see Service Discovery (https://docs.aws.amazon.com/AmazonECS/latest/developerguide/service-discovery.html)
 // in the Amazon Elastic Container Service Developer Guide. Kubernetes kube-dns
 // and coredns are supported. For more information, see DNS for Services and
 // Pods (https://kubernetes.io/docs/concepts/services-networking/dns-pod-service/)
@@ -25,7 +26,7 @@
 // See appmesh package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/appmesh/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact AWS App Mesh with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
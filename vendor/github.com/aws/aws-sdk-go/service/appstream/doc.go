@@ -18,16 +18,16 @@
 //
 // To learn more about AppStream 2.0, see the following resources:
 //
-//    * Amazon AppStream 2.0 product page (http://aws.amazon.com/appstream2)
+//   - Amazon AppStream 2.0 product page (http://aws.amazon.com/appstream2)
 //
-//    * Amazon AppStream 2.0 documentation (http://aws.amazon.com/documentation/appstream2)
+//   - Amazon AppStream 2.0 documentation (http://aws.amazon.com/documentation/appstream2)
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/appstream-2016-12-01 for more information on this service.
 //
 // See appstream package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/appstream/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon AppStream with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -17,16 +17,16 @@
 // support the API. For more information and to download the driver, see Accessing
 // Amazon Athena with JDBC (https://docs.aws.amazon.com/athena/latest/ug/connect-with-jdbc.html).
 //
-// For code samples using the AWS SDK for Java, see Examples and Code Samples
-// (https://docs.aws.amazon.com/athena/latest/ug/code-samples.html) in the Amazon
-// Athena User Guide.
+// For code samples using the Amazon Web Services SDK for Java, see Examples
+// and Code Samples (https://docs.aws.amazon.com/athena/latest/ug/code-samples.html)
+// in the Amazon Athena User Guide.
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/athena-2017-05-18 for more information on this service.
 //
 // See athena package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/athena/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon Athena with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -3,21 +3,19 @@
 // Package autoscaling provides the client and types for making API
 // requests to Auto Scaling.
 //
-// Amazon EC2 Auto Scaling is designed to automatically launch or terminate
+// Amazon EC2 Auto Scaling is designed to automatically launch and terminate
 // EC2 instances based on user-defined scaling policies, scheduled actions,
-// and health checks. Use this service with AWS Auto Scaling, Amazon CloudWatch,
-// and Elastic Load Balancing.
+// and health checks.
 //
-// For more information, including information about granting IAM users required
-// permissions for Amazon EC2 Auto Scaling actions, see the Amazon EC2 Auto
-// Scaling User Guide (https://docs.aws.amazon.com/autoscaling/ec2/userguide/what-is-amazon-ec2-auto-scaling.html).
+// For more information, see the Amazon EC2 Auto Scaling User Guide (https://docs.aws.amazon.com/autoscaling/ec2/userguide/)
+// and the Amazon EC2 Auto Scaling API Reference (https://docs.aws.amazon.com/autoscaling/ec2/APIReference/Welcome.html).
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/autoscaling-2011-01-01 for more information on this service.
 //
 // See autoscaling package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/autoscaling/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Auto Scaling with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -4,6 +4,13 @@ package autoscaling
 
 const (
 
+	// ErrCodeActiveInstanceRefreshNotFoundFault for service response error code
+	// "ActiveInstanceRefreshNotFound".
+	//
+	// The request failed because an active instance refresh or rollback for the
+	// specified Auto Scaling group was not found.
+	ErrCodeActiveInstanceRefreshNotFoundFault = "ActiveInstanceRefreshNotFound"
+
 	// ErrCodeAlreadyExistsFault for service response error code
 	// "AlreadyExists".
 	//
@@ -11,18 +18,35 @@ const (
 	// name.
 	ErrCodeAlreadyExistsFault = "AlreadyExists"
 
+	// ErrCodeInstanceRefreshInProgressFault for service response error code
+	// "InstanceRefreshInProgress".
+	//
+	// The request failed because an active instance refresh already exists for
+	// the specified Auto Scaling group.
+	ErrCodeInstanceRefreshInProgressFault = "InstanceRefreshInProgress"
+
 	// ErrCodeInvalidNextToken for service response error code
 	// "InvalidNextToken".
 	//
 	// The NextToken value is not valid.
 	ErrCodeInvalidNextToken = "InvalidNextToken"
 
+	// ErrCodeIrreversibleInstanceRefreshFault for service response error code
+	// "IrreversibleInstanceRefresh".
+	//
+	// The request failed because a desired configuration was not found or an incompatible
+	// launch template (uses a Systems Manager parameter instead of an AMI ID) or
+	// launch template version ($Latest or $Default) is present on the Auto Scaling
+	// group.
+	ErrCodeIrreversibleInstanceRefreshFault = "IrreversibleInstanceRefresh"
+
 	// ErrCodeLimitExceededFault for service response error code
 	// "LimitExceeded".
 	//
 	// You have already reached a limit for your Amazon EC2 Auto Scaling resources
 	// (for example, Auto Scaling groups, launch configurations, or lifecycle hooks).
-	// For more information, see DescribeAccountLimits.
+	// For more information, see DescribeAccountLimits (https://docs.aws.amazon.com/autoscaling/ec2/APIReference/API_DescribeAccountLimits.html)
+	// in the Amazon EC2 Auto Scaling API Reference.
 	ErrCodeLimitExceededFault = "LimitExceeded"
 
 	// ErrCodeResourceContentionFault for service response error code
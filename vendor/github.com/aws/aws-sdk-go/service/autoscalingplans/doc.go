@@ -3,25 +3,39 @@
 // Package autoscalingplans provides the client and types for making API
 // requests to AWS Auto Scaling Plans.
 //
-// Use AWS Auto Scaling to quickly discover all the scalable AWS resources for
-// your application and configure dynamic scaling and predictive scaling for
-// your resources using scaling plans. Use this service in conjunction with
-// the Amazon EC2 Auto Scaling, Application Auto Scaling, Amazon CloudWatch,
-// and AWS CloudFormation services.
+// Use AWS Auto Scaling to create scaling plans for your applications to automatically
+// scale your scalable AWS resources.
 //
-// Currently, predictive scaling is only available for Amazon EC2 Auto Scaling
-// groups.
+// # API Summary
 //
-// For more information about AWS Auto Scaling, including information about
-// granting IAM users required permissions for AWS Auto Scaling actions, see
-// the AWS Auto Scaling User Guide (https://docs.aws.amazon.com/autoscaling/plans/userguide/what-is-aws-auto-scaling.html).
+// You can use the AWS Auto Scaling service API to accomplish the following
+// tasks:
+//
+//   - Create and manage scaling plans
+//
+//   - Define target tracking scaling policies to dynamically scale your resources
+//     based on utilization
+//
+//   - Scale Amazon EC2 Auto Scaling groups using predictive scaling and dynamic
+//     scaling to scale your Amazon EC2 capacity faster
+//
+//   - Set minimum and maximum capacity limits
+//
+//   - Retrieve information on existing scaling plans
+//
+//   - Access current forecast data and historical forecast data for up to
+//     56 days previous
+//
+// To learn more about AWS Auto Scaling, including information about granting
+// IAM users required permissions for AWS Auto Scaling actions, see the AWS
+// Auto Scaling User Guide (https://docs.aws.amazon.com/autoscaling/plans/userguide/what-is-aws-auto-scaling.html).
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/autoscaling-plans-2018-01-06 for more information on this service.
 //
 // See autoscalingplans package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/autoscalingplans/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact AWS Auto Scaling Plans with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
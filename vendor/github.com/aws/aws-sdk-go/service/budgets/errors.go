@@ -2,8 +2,18 @@
 
 package budgets
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
+	// ErrCodeAccessDeniedException for service response error code
+	// "AccessDeniedException".
+	//
+	// You are not authorized to use this operation with the given parameters.
+	ErrCodeAccessDeniedException = "AccessDeniedException"
+
 	// ErrCodeCreationLimitExceededException for service response error code
 	// "CreationLimitExceededException".
 	//
@@ -47,4 +57,31 @@ const (
 	//
 	// We can’t locate the resource that you specified.
 	ErrCodeNotFoundException = "NotFoundException"
+
+	// ErrCodeResourceLockedException for service response error code
+	// "ResourceLockedException".
+	//
+	// The request was received and recognized by the server, but the server rejected
+	// that particular method for the requested resource.
+	ErrCodeResourceLockedException = "ResourceLockedException"
+
+	// ErrCodeThrottlingException for service response error code
+	// "ThrottlingException".
+	//
+	// The number of API requests has exceeded the maximum allowed API request throttling
+	// limit for the account.
+	ErrCodeThrottlingException = "ThrottlingException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"AccessDeniedException":          newErrorAccessDeniedException,
+	"CreationLimitExceededException": newErrorCreationLimitExceededException,
+	"DuplicateRecordException":       newErrorDuplicateRecordException,
+	"ExpiredNextTokenException":      newErrorExpiredNextTokenException,
+	"InternalErrorException":         newErrorInternalErrorException,
+	"InvalidNextTokenException":      newErrorInvalidNextTokenException,
+	"InvalidParameterException":      newErrorInvalidParameterException,
+	"NotFoundException":              newErrorNotFoundException,
+	"ResourceLockedException":        newErrorResourceLockedException,
+	"ThrottlingException":            newErrorThrottlingException,
+}
@@ -3,6 +3,7 @@
 package cloud9
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -28,14 +29,13 @@ const opCreateEnvironmentEC2 = "CreateEnvironmentEC2"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateEnvironmentEC2Request method.
+//	req, resp := client.CreateEnvironmentEC2Request(params)
 //
-//    // Example sending a request using the CreateEnvironmentEC2Request method.
-//    req, resp := client.CreateEnvironmentEC2Request(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/CreateEnvironmentEC2
 func (c *Cloud9) CreateEnvironmentEC2Request(input *CreateEnvironmentEC2Input) (req *request.Request, output *CreateEnvironmentEC2Output) {
@@ -56,9 +56,8 @@ func (c *Cloud9) CreateEnvironmentEC2Request(input *CreateEnvironmentEC2Input) (
 
 // CreateEnvironmentEC2 API operation for AWS Cloud9.
 //
-// Creates an AWS Cloud9 development environment, launches an Amazon Elastic
-// Compute Cloud (Amazon EC2) instance, and then connects from the instance
-// to the environment.
+// Creates an Cloud9 development environment, launches an Amazon Elastic Compute
+// Cloud (Amazon EC2) instance, and then connects from the instance to the environment.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -67,27 +66,28 @@ func (c *Cloud9) CreateEnvironmentEC2Request(input *CreateEnvironmentEC2Input) (
 // See the AWS API reference guide for AWS Cloud9's
 // API operation CreateEnvironmentEC2 for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The target request is invalid.
+// Returned Error Types:
+//
+//   - BadRequestException
+//     The target request is invalid.
 //
-//   * ErrCodeConflictException "ConflictException"
-//   A conflict occurred.
+//   - ConflictException
+//     A conflict occurred.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The target resource cannot be found.
+//   - NotFoundException
+//     The target resource cannot be found.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   An access permissions issue occurred.
+//   - ForbiddenException
+//     An access permissions issue occurred.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Too many service requests were made over the given time period.
+//   - TooManyRequestsException
+//     Too many service requests were made over the given time period.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A service limit was exceeded.
+//   - LimitExceededException
+//     A service limit was exceeded.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   An internal server error occurred.
+//   - InternalServerErrorException
+//     An internal server error occurred.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/CreateEnvironmentEC2
 func (c *Cloud9) CreateEnvironmentEC2(input *CreateEnvironmentEC2Input) (*CreateEnvironmentEC2Output, error) {
@@ -127,14 +127,13 @@ const opCreateEnvironmentMembership = "CreateEnvironmentMembership"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateEnvironmentMembershipRequest method.
+//	req, resp := client.CreateEnvironmentMembershipRequest(params)
 //
-//    // Example sending a request using the CreateEnvironmentMembershipRequest method.
-//    req, resp := client.CreateEnvironmentMembershipRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/CreateEnvironmentMembership
 func (c *Cloud9) CreateEnvironmentMembershipRequest(input *CreateEnvironmentMembershipInput) (req *request.Request, output *CreateEnvironmentMembershipOutput) {
@@ -155,7 +154,7 @@ func (c *Cloud9) CreateEnvironmentMembershipRequest(input *CreateEnvironmentMemb
 
 // CreateEnvironmentMembership API operation for AWS Cloud9.
 //
-// Adds an environment member to an AWS Cloud9 development environment.
+// Adds an environment member to an Cloud9 development environment.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -164,27 +163,28 @@ func (c *Cloud9) CreateEnvironmentMembershipRequest(input *CreateEnvironmentMemb
 // See the AWS API reference guide for AWS Cloud9's
 // API operation CreateEnvironmentMembership for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The target request is invalid.
+// Returned Error Types:
+//
+//   - BadRequestException
+//     The target request is invalid.
 //
-//   * ErrCodeConflictException "ConflictException"
-//   A conflict occurred.
+//   - ConflictException
+//     A conflict occurred.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The target resource cannot be found.
+//   - NotFoundException
+//     The target resource cannot be found.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   An access permissions issue occurred.
+//   - ForbiddenException
+//     An access permissions issue occurred.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Too many service requests were made over the given time period.
+//   - TooManyRequestsException
+//     Too many service requests were made over the given time period.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A service limit was exceeded.
+//   - LimitExceededException
+//     A service limit was exceeded.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   An internal server error occurred.
+//   - InternalServerErrorException
+//     An internal server error occurred.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/CreateEnvironmentMembership
 func (c *Cloud9) CreateEnvironmentMembership(input *CreateEnvironmentMembershipInput) (*CreateEnvironmentMembershipOutput, error) {
@@ -224,14 +224,13 @@ const opDeleteEnvironment = "DeleteEnvironment"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteEnvironmentRequest method.
+//	req, resp := client.DeleteEnvironmentRequest(params)
 //
-//    // Example sending a request using the DeleteEnvironmentRequest method.
-//    req, resp := client.DeleteEnvironmentRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/DeleteEnvironment
 func (c *Cloud9) DeleteEnvironmentRequest(input *DeleteEnvironmentInput) (req *request.Request, output *DeleteEnvironmentOutput) {
@@ -253,8 +252,8 @@ func (c *Cloud9) DeleteEnvironmentRequest(input *DeleteEnvironmentInput) (req *r
 
 // DeleteEnvironment API operation for AWS Cloud9.
 //
-// Deletes an AWS Cloud9 development environment. If an Amazon EC2 instance
-// is connected to the environment, also terminates the instance.
+// Deletes an Cloud9 development environment. If an Amazon EC2 instance is connected
+// to the environment, also terminates the instance.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -263,27 +262,28 @@ func (c *Cloud9) DeleteEnvironmentRequest(input *DeleteEnvironmentInput) (req *r
 // See the AWS API reference guide for AWS Cloud9's
 // API operation DeleteEnvironment for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The target request is invalid.
+// Returned Error Types:
+//
+//   - BadRequestException
+//     The target request is invalid.
 //
-//   * ErrCodeConflictException "ConflictException"
-//   A conflict occurred.
+//   - ConflictException
+//     A conflict occurred.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The target resource cannot be found.
+//   - NotFoundException
+//     The target resource cannot be found.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   An access permissions issue occurred.
+//   - ForbiddenException
+//     An access permissions issue occurred.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Too many service requests were made over the given time period.
+//   - TooManyRequestsException
+//     Too many service requests were made over the given time period.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A service limit was exceeded.
+//   - LimitExceededException
+//     A service limit was exceeded.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   An internal server error occurred.
+//   - InternalServerErrorException
+//     An internal server error occurred.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/DeleteEnvironment
 func (c *Cloud9) DeleteEnvironment(input *DeleteEnvironmentInput) (*DeleteEnvironmentOutput, error) {
@@ -323,14 +323,13 @@ const opDeleteEnvironmentMembership = "DeleteEnvironmentMembership"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteEnvironmentMembershipRequest method.
+//	req, resp := client.DeleteEnvironmentMembershipRequest(params)
 //
-//    // Example sending a request using the DeleteEnvironmentMembershipRequest method.
-//    req, resp := client.DeleteEnvironmentMembershipRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/DeleteEnvironmentMembership
 func (c *Cloud9) DeleteEnvironmentMembershipRequest(input *DeleteEnvironmentMembershipInput) (req *request.Request, output *DeleteEnvironmentMembershipOutput) {
@@ -352,7 +351,7 @@ func (c *Cloud9) DeleteEnvironmentMembershipRequest(input *DeleteEnvironmentMemb
 
 // DeleteEnvironmentMembership API operation for AWS Cloud9.
 //
-// Deletes an environment member from an AWS Cloud9 development environment.
+// Deletes an environment member from a development environment.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -361,27 +360,28 @@ func (c *Cloud9) DeleteEnvironmentMembershipRequest(input *DeleteEnvironmentMemb
 // See the AWS API reference guide for AWS Cloud9's
 // API operation DeleteEnvironmentMembership for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The target request is invalid.
+// Returned Error Types:
+//
+//   - BadRequestException
+//     The target request is invalid.
 //
-//   * ErrCodeConflictException "ConflictException"
-//   A conflict occurred.
+//   - ConflictException
+//     A conflict occurred.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The target resource cannot be found.
+//   - NotFoundException
+//     The target resource cannot be found.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   An access permissions issue occurred.
+//   - ForbiddenException
+//     An access permissions issue occurred.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Too many service requests were made over the given time period.
+//   - TooManyRequestsException
+//     Too many service requests were made over the given time period.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A service limit was exceeded.
+//   - LimitExceededException
+//     A service limit was exceeded.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   An internal server error occurred.
+//   - InternalServerErrorException
+//     An internal server error occurred.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/DeleteEnvironmentMembership
 func (c *Cloud9) DeleteEnvironmentMembership(input *DeleteEnvironmentMembershipInput) (*DeleteEnvironmentMembershipOutput, error) {
@@ -421,14 +421,13 @@ const opDescribeEnvironmentMemberships = "DescribeEnvironmentMemberships"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeEnvironmentMembershipsRequest method.
+//	req, resp := client.DescribeEnvironmentMembershipsRequest(params)
 //
-//    // Example sending a request using the DescribeEnvironmentMembershipsRequest method.
-//    req, resp := client.DescribeEnvironmentMembershipsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/DescribeEnvironmentMemberships
 func (c *Cloud9) DescribeEnvironmentMembershipsRequest(input *DescribeEnvironmentMembershipsInput) (req *request.Request, output *DescribeEnvironmentMembershipsOutput) {
@@ -455,8 +454,7 @@ func (c *Cloud9) DescribeEnvironmentMembershipsRequest(input *DescribeEnvironmen
 
 // DescribeEnvironmentMemberships API operation for AWS Cloud9.
 //
-// Gets information about environment members for an AWS Cloud9 development
-// environment.
+// Gets information about environment members for an Cloud9 development environment.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -465,27 +463,28 @@ func (c *Cloud9) DescribeEnvironmentMembershipsRequest(input *DescribeEnvironmen
 // See the AWS API reference guide for AWS Cloud9's
 // API operation DescribeEnvironmentMemberships for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The target request is invalid.
+// Returned Error Types:
 //
-//   * ErrCodeConflictException "ConflictException"
-//   A conflict occurred.
+//   - BadRequestException
+//     The target request is invalid.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The target resource cannot be found.
+//   - ConflictException
+//     A conflict occurred.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   An access permissions issue occurred.
+//   - NotFoundException
+//     The target resource cannot be found.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Too many service requests were made over the given time period.
+//   - ForbiddenException
+//     An access permissions issue occurred.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A service limit was exceeded.
+//   - TooManyRequestsException
+//     Too many service requests were made over the given time period.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   An internal server error occurred.
+//   - LimitExceededException
+//     A service limit was exceeded.
+//
+//   - InternalServerErrorException
+//     An internal server error occurred.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/DescribeEnvironmentMemberships
 func (c *Cloud9) DescribeEnvironmentMemberships(input *DescribeEnvironmentMembershipsInput) (*DescribeEnvironmentMembershipsOutput, error) {
@@ -517,15 +516,14 @@ func (c *Cloud9) DescribeEnvironmentMembershipsWithContext(ctx aws.Context, inpu
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a DescribeEnvironmentMemberships operation.
-//    pageNum := 0
-//    err := client.DescribeEnvironmentMembershipsPages(params,
-//        func(page *cloud9.DescribeEnvironmentMembershipsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a DescribeEnvironmentMemberships operation.
+//	pageNum := 0
+//	err := client.DescribeEnvironmentMembershipsPages(params,
+//	    func(page *cloud9.DescribeEnvironmentMembershipsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *Cloud9) DescribeEnvironmentMembershipsPages(input *DescribeEnvironmentMembershipsInput, fn func(*DescribeEnvironmentMembershipsOutput, bool) bool) error {
 	return c.DescribeEnvironmentMembershipsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -552,10 +550,12 @@ func (c *Cloud9) DescribeEnvironmentMembershipsPagesWithContext(ctx aws.Context,
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*DescribeEnvironmentMembershipsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*DescribeEnvironmentMembershipsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -575,14 +575,13 @@ const opDescribeEnvironmentStatus = "DescribeEnvironmentStatus"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeEnvironmentStatusRequest method.
+//	req, resp := client.DescribeEnvironmentStatusRequest(params)
 //
-//    // Example sending a request using the DescribeEnvironmentStatusRequest method.
-//    req, resp := client.DescribeEnvironmentStatusRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/DescribeEnvironmentStatus
 func (c *Cloud9) DescribeEnvironmentStatusRequest(input *DescribeEnvironmentStatusInput) (req *request.Request, output *DescribeEnvironmentStatusOutput) {
@@ -603,7 +602,7 @@ func (c *Cloud9) DescribeEnvironmentStatusRequest(input *DescribeEnvironmentStat
 
 // DescribeEnvironmentStatus API operation for AWS Cloud9.
 //
-// Gets status information for an AWS Cloud9 development environment.
+// Gets status information for an Cloud9 development environment.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -612,27 +611,28 @@ func (c *Cloud9) DescribeEnvironmentStatusRequest(input *DescribeEnvironmentStat
 // See the AWS API reference guide for AWS Cloud9's
 // API operation DescribeEnvironmentStatus for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The target request is invalid.
+// Returned Error Types:
 //
-//   * ErrCodeConflictException "ConflictException"
-//   A conflict occurred.
+//   - BadRequestException
+//     The target request is invalid.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The target resource cannot be found.
+//   - ConflictException
+//     A conflict occurred.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   An access permissions issue occurred.
+//   - NotFoundException
+//     The target resource cannot be found.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Too many service requests were made over the given time period.
+//   - ForbiddenException
+//     An access permissions issue occurred.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A service limit was exceeded.
+//   - TooManyRequestsException
+//     Too many service requests were made over the given time period.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   An internal server error occurred.
+//   - LimitExceededException
+//     A service limit was exceeded.
+//
+//   - InternalServerErrorException
+//     An internal server error occurred.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/DescribeEnvironmentStatus
 func (c *Cloud9) DescribeEnvironmentStatus(input *DescribeEnvironmentStatusInput) (*DescribeEnvironmentStatusOutput, error) {
@@ -672,14 +672,13 @@ const opDescribeEnvironments = "DescribeEnvironments"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeEnvironmentsRequest method.
+//	req, resp := client.DescribeEnvironmentsRequest(params)
 //
-//    // Example sending a request using the DescribeEnvironmentsRequest method.
-//    req, resp := client.DescribeEnvironmentsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/DescribeEnvironments
 func (c *Cloud9) DescribeEnvironmentsRequest(input *DescribeEnvironmentsInput) (req *request.Request, output *DescribeEnvironmentsOutput) {
@@ -700,7 +699,7 @@ func (c *Cloud9) DescribeEnvironmentsRequest(input *DescribeEnvironmentsInput) (
 
 // DescribeEnvironments API operation for AWS Cloud9.
 //
-// Gets information about AWS Cloud9 development environments.
+// Gets information about Cloud9 development environments.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -709,27 +708,28 @@ func (c *Cloud9) DescribeEnvironmentsRequest(input *DescribeEnvironmentsInput) (
 // See the AWS API reference guide for AWS Cloud9's
 // API operation DescribeEnvironments for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The target request is invalid.
+// Returned Error Types:
 //
-//   * ErrCodeConflictException "ConflictException"
-//   A conflict occurred.
+//   - BadRequestException
+//     The target request is invalid.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The target resource cannot be found.
+//   - ConflictException
+//     A conflict occurred.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   An access permissions issue occurred.
+//   - NotFoundException
+//     The target resource cannot be found.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Too many service requests were made over the given time period.
+//   - ForbiddenException
+//     An access permissions issue occurred.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A service limit was exceeded.
+//   - TooManyRequestsException
+//     Too many service requests were made over the given time period.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   An internal server error occurred.
+//   - LimitExceededException
+//     A service limit was exceeded.
+//
+//   - InternalServerErrorException
+//     An internal server error occurred.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/DescribeEnvironments
 func (c *Cloud9) DescribeEnvironments(input *DescribeEnvironmentsInput) (*DescribeEnvironmentsOutput, error) {
@@ -769,14 +769,13 @@ const opListEnvironments = "ListEnvironments"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListEnvironmentsRequest method.
+//	req, resp := client.ListEnvironmentsRequest(params)
 //
-//    // Example sending a request using the ListEnvironmentsRequest method.
-//    req, resp := client.ListEnvironmentsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/ListEnvironments
 func (c *Cloud9) ListEnvironmentsRequest(input *ListEnvironmentsInput) (req *request.Request, output *ListEnvironmentsOutput) {
@@ -803,7 +802,7 @@ func (c *Cloud9) ListEnvironmentsRequest(input *ListEnvironmentsInput) (req *req
 
 // ListEnvironments API operation for AWS Cloud9.
 //
-// Gets a list of AWS Cloud9 development environment identifiers.
+// Gets a list of Cloud9 development environment identifiers.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -812,27 +811,28 @@ func (c *Cloud9) ListEnvironmentsRequest(input *ListEnvironmentsInput) (req *req
 // See the AWS API reference guide for AWS Cloud9's
 // API operation ListEnvironments for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The target request is invalid.
+// Returned Error Types:
 //
-//   * ErrCodeConflictException "ConflictException"
-//   A conflict occurred.
+//   - BadRequestException
+//     The target request is invalid.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The target resource cannot be found.
+//   - ConflictException
+//     A conflict occurred.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   An access permissions issue occurred.
+//   - NotFoundException
+//     The target resource cannot be found.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Too many service requests were made over the given time period.
+//   - ForbiddenException
+//     An access permissions issue occurred.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A service limit was exceeded.
+//   - TooManyRequestsException
+//     Too many service requests were made over the given time period.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   An internal server error occurred.
+//   - LimitExceededException
+//     A service limit was exceeded.
+//
+//   - InternalServerErrorException
+//     An internal server error occurred.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/ListEnvironments
 func (c *Cloud9) ListEnvironments(input *ListEnvironmentsInput) (*ListEnvironmentsOutput, error) {
@@ -864,15 +864,14 @@ func (c *Cloud9) ListEnvironmentsWithContext(ctx aws.Context, input *ListEnviron
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListEnvironments operation.
-//    pageNum := 0
-//    err := client.ListEnvironmentsPages(params,
-//        func(page *cloud9.ListEnvironmentsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListEnvironments operation.
+//	pageNum := 0
+//	err := client.ListEnvironmentsPages(params,
+//	    func(page *cloud9.ListEnvironmentsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *Cloud9) ListEnvironmentsPages(input *ListEnvironmentsInput, fn func(*ListEnvironmentsOutput, bool) bool) error {
 	return c.ListEnvironmentsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -899,13 +898,281 @@ func (c *Cloud9) ListEnvironmentsPagesWithContext(ctx aws.Context, input *ListEn
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListEnvironmentsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListEnvironmentsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
+const opListTagsForResource = "ListTagsForResource"
+
+// ListTagsForResourceRequest generates a "aws/request.Request" representing the
+// client's request for the ListTagsForResource operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListTagsForResource for more information on using the ListTagsForResource
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListTagsForResourceRequest method.
+//	req, resp := client.ListTagsForResourceRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/ListTagsForResource
+func (c *Cloud9) ListTagsForResourceRequest(input *ListTagsForResourceInput) (req *request.Request, output *ListTagsForResourceOutput) {
+	op := &request.Operation{
+		Name:       opListTagsForResource,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &ListTagsForResourceInput{}
+	}
+
+	output = &ListTagsForResourceOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListTagsForResource API operation for AWS Cloud9.
+//
+// Gets a list of the tags associated with an Cloud9 development environment.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Cloud9's
+// API operation ListTagsForResource for usage and error information.
+//
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The target resource cannot be found.
+//
+//   - InternalServerErrorException
+//     An internal server error occurred.
+//
+//   - BadRequestException
+//     The target request is invalid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/ListTagsForResource
+func (c *Cloud9) ListTagsForResource(input *ListTagsForResourceInput) (*ListTagsForResourceOutput, error) {
+	req, out := c.ListTagsForResourceRequest(input)
+	return out, req.Send()
+}
+
+// ListTagsForResourceWithContext is the same as ListTagsForResource with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListTagsForResource for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Cloud9) ListTagsForResourceWithContext(ctx aws.Context, input *ListTagsForResourceInput, opts ...request.Option) (*ListTagsForResourceOutput, error) {
+	req, out := c.ListTagsForResourceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opTagResource = "TagResource"
+
+// TagResourceRequest generates a "aws/request.Request" representing the
+// client's request for the TagResource operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See TagResource for more information on using the TagResource
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the TagResourceRequest method.
+//	req, resp := client.TagResourceRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/TagResource
+func (c *Cloud9) TagResourceRequest(input *TagResourceInput) (req *request.Request, output *TagResourceOutput) {
+	op := &request.Operation{
+		Name:       opTagResource,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &TagResourceInput{}
+	}
+
+	output = &TagResourceOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// TagResource API operation for AWS Cloud9.
+//
+// Adds tags to an Cloud9 development environment.
+//
+// Tags that you add to an Cloud9 environment by using this method will NOT
+// be automatically propagated to underlying resources.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Cloud9's
+// API operation TagResource for usage and error information.
+//
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The target resource cannot be found.
+//
+//   - InternalServerErrorException
+//     An internal server error occurred.
+//
+//   - BadRequestException
+//     The target request is invalid.
+//
+//   - ConcurrentAccessException
+//     A concurrent access issue occurred.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/TagResource
+func (c *Cloud9) TagResource(input *TagResourceInput) (*TagResourceOutput, error) {
+	req, out := c.TagResourceRequest(input)
+	return out, req.Send()
+}
+
+// TagResourceWithContext is the same as TagResource with the addition of
+// the ability to pass a context and additional request options.
+//
+// See TagResource for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Cloud9) TagResourceWithContext(ctx aws.Context, input *TagResourceInput, opts ...request.Option) (*TagResourceOutput, error) {
+	req, out := c.TagResourceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUntagResource = "UntagResource"
+
+// UntagResourceRequest generates a "aws/request.Request" representing the
+// client's request for the UntagResource operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UntagResource for more information on using the UntagResource
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UntagResourceRequest method.
+//	req, resp := client.UntagResourceRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/UntagResource
+func (c *Cloud9) UntagResourceRequest(input *UntagResourceInput) (req *request.Request, output *UntagResourceOutput) {
+	op := &request.Operation{
+		Name:       opUntagResource,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UntagResourceInput{}
+	}
+
+	output = &UntagResourceOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// UntagResource API operation for AWS Cloud9.
+//
+// Removes tags from an Cloud9 development environment.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Cloud9's
+// API operation UntagResource for usage and error information.
+//
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The target resource cannot be found.
+//
+//   - InternalServerErrorException
+//     An internal server error occurred.
+//
+//   - BadRequestException
+//     The target request is invalid.
+//
+//   - ConcurrentAccessException
+//     A concurrent access issue occurred.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/UntagResource
+func (c *Cloud9) UntagResource(input *UntagResourceInput) (*UntagResourceOutput, error) {
+	req, out := c.UntagResourceRequest(input)
+	return out, req.Send()
+}
+
+// UntagResourceWithContext is the same as UntagResource with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UntagResource for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Cloud9) UntagResourceWithContext(ctx aws.Context, input *UntagResourceInput, opts ...request.Option) (*UntagResourceOutput, error) {
+	req, out := c.UntagResourceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opUpdateEnvironment = "UpdateEnvironment"
 
 // UpdateEnvironmentRequest generates a "aws/request.Request" representing the
@@ -922,14 +1189,13 @@ const opUpdateEnvironment = "UpdateEnvironment"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateEnvironmentRequest method.
+//	req, resp := client.UpdateEnvironmentRequest(params)
 //
-//    // Example sending a request using the UpdateEnvironmentRequest method.
-//    req, resp := client.UpdateEnvironmentRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/UpdateEnvironment
 func (c *Cloud9) UpdateEnvironmentRequest(input *UpdateEnvironmentInput) (req *request.Request, output *UpdateEnvironmentOutput) {
@@ -951,7 +1217,7 @@ func (c *Cloud9) UpdateEnvironmentRequest(input *UpdateEnvironmentInput) (req *r
 
 // UpdateEnvironment API operation for AWS Cloud9.
 //
-// Changes the settings of an existing AWS Cloud9 development environment.
+// Changes the settings of an existing Cloud9 development environment.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -960,27 +1226,28 @@ func (c *Cloud9) UpdateEnvironmentRequest(input *UpdateEnvironmentInput) (req *r
 // See the AWS API reference guide for AWS Cloud9's
 // API operation UpdateEnvironment for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The target request is invalid.
+// Returned Error Types:
 //
-//   * ErrCodeConflictException "ConflictException"
-//   A conflict occurred.
+//   - BadRequestException
+//     The target request is invalid.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The target resource cannot be found.
+//   - ConflictException
+//     A conflict occurred.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   An access permissions issue occurred.
+//   - NotFoundException
+//     The target resource cannot be found.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Too many service requests were made over the given time period.
+//   - ForbiddenException
+//     An access permissions issue occurred.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A service limit was exceeded.
+//   - TooManyRequestsException
+//     Too many service requests were made over the given time period.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   An internal server error occurred.
+//   - LimitExceededException
+//     A service limit was exceeded.
+//
+//   - InternalServerErrorException
+//     An internal server error occurred.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/UpdateEnvironment
 func (c *Cloud9) UpdateEnvironment(input *UpdateEnvironmentInput) (*UpdateEnvironmentOutput, error) {
@@ -1020,14 +1287,13 @@ const opUpdateEnvironmentMembership = "UpdateEnvironmentMembership"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateEnvironmentMembershipRequest method.
+//	req, resp := client.UpdateEnvironmentMembershipRequest(params)
 //
-//    // Example sending a request using the UpdateEnvironmentMembershipRequest method.
-//    req, resp := client.UpdateEnvironmentMembershipRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/UpdateEnvironmentMembership
 func (c *Cloud9) UpdateEnvironmentMembershipRequest(input *UpdateEnvironmentMembershipInput) (req *request.Request, output *UpdateEnvironmentMembershipOutput) {
@@ -1048,8 +1314,8 @@ func (c *Cloud9) UpdateEnvironmentMembershipRequest(input *UpdateEnvironmentMemb
 
 // UpdateEnvironmentMembership API operation for AWS Cloud9.
 //
-// Changes the settings of an existing environment member for an AWS Cloud9
-// development environment.
+// Changes the settings of an existing environment member for an Cloud9 development
+// environment.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1058,27 +1324,28 @@ func (c *Cloud9) UpdateEnvironmentMembershipRequest(input *UpdateEnvironmentMemb
 // See the AWS API reference guide for AWS Cloud9's
 // API operation UpdateEnvironmentMembership for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   The target request is invalid.
+// Returned Error Types:
+//
+//   - BadRequestException
+//     The target request is invalid.
 //
-//   * ErrCodeConflictException "ConflictException"
-//   A conflict occurred.
+//   - ConflictException
+//     A conflict occurred.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The target resource cannot be found.
+//   - NotFoundException
+//     The target resource cannot be found.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   An access permissions issue occurred.
+//   - ForbiddenException
+//     An access permissions issue occurred.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Too many service requests were made over the given time period.
+//   - TooManyRequestsException
+//     Too many service requests were made over the given time period.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A service limit was exceeded.
+//   - LimitExceededException
+//     A service limit was exceeded.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   An internal server error occurred.
+//   - InternalServerErrorException
+//     An internal server error occurred.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloud9-2017-09-23/UpdateEnvironmentMembership
 func (c *Cloud9) UpdateEnvironmentMembership(input *UpdateEnvironmentMembershipInput) (*UpdateEnvironmentMembershipOutput, error) {
@@ -1102,96 +1369,392 @@ func (c *Cloud9) UpdateEnvironmentMembershipWithContext(ctx aws.Context, input *
 	return out, req.Send()
 }
 
-type CreateEnvironmentEC2Input struct {
-	_ struct{} `type:"structure"`
-
-	// The number of minutes until the running instance is shut down after the environment
-	// has last been used.
-	AutomaticStopTimeMinutes *int64 `locationName:"automaticStopTimeMinutes" type:"integer"`
-
-	// A unique, case-sensitive string that helps AWS Cloud9 to ensure this operation
-	// completes no more than one time.
-	//
-	// For more information, see Client Tokens (http://docs.aws.amazon.com/AWSEC2/latest/APIReference/Run_Instance_Idempotency.html)
-	// in the Amazon EC2 API Reference.
-	ClientRequestToken *string `locationName:"clientRequestToken" type:"string"`
-
-	// The description of the environment to create.
-	Description *string `locationName:"description" type:"string" sensitive:"true"`
-
-	// The type of instance to connect to the environment (for example, t2.micro).
-	//
-	// InstanceType is a required field
-	InstanceType *string `locationName:"instanceType" min:"5" type:"string" required:"true"`
-
-	// The name of the environment to create.
-	//
-	// This name is visible to other AWS IAM users in the same AWS account.
-	//
-	// Name is a required field
-	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
-
-	// The Amazon Resource Name (ARN) of the environment owner. This ARN can be
-	// the ARN of any AWS IAM principal. If this value is not specified, the ARN
-	// defaults to this environment's creator.
-	OwnerArn *string `locationName:"ownerArn" type:"string"`
+// The target request is invalid.
+type BadRequestException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The ID of the subnet in Amazon VPC that AWS Cloud9 will use to communicate
-	// with the Amazon EC2 instance.
-	SubnetId *string `locationName:"subnetId" min:"5" type:"string"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s CreateEnvironmentEC2Input) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BadRequestException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateEnvironmentEC2Input) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BadRequestException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateEnvironmentEC2Input) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateEnvironmentEC2Input"}
-	if s.InstanceType == nil {
-		invalidParams.Add(request.NewErrParamRequired("InstanceType"))
-	}
-	if s.InstanceType != nil && len(*s.InstanceType) < 5 {
-		invalidParams.Add(request.NewErrParamMinLen("InstanceType", 5))
-	}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
-	}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
-	}
-	if s.SubnetId != nil && len(*s.SubnetId) < 5 {
-		invalidParams.Add(request.NewErrParamMinLen("SubnetId", 5))
+func newErrorBadRequestException(v protocol.ResponseMetadata) error {
+	return &BadRequestException{
+		RespMetadata: v,
 	}
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
+// Code returns the exception type name.
+func (s *BadRequestException) Code() string {
+	return "BadRequestException"
+}
+
+// Message returns the exception's message.
+func (s *BadRequestException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *BadRequestException) OrigErr() error {
 	return nil
 }
 
-// SetAutomaticStopTimeMinutes sets the AutomaticStopTimeMinutes field's value.
-func (s *CreateEnvironmentEC2Input) SetAutomaticStopTimeMinutes(v int64) *CreateEnvironmentEC2Input {
-	s.AutomaticStopTimeMinutes = &v
-	return s
+func (s *BadRequestException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// SetClientRequestToken sets the ClientRequestToken field's value.
-func (s *CreateEnvironmentEC2Input) SetClientRequestToken(v string) *CreateEnvironmentEC2Input {
-	s.ClientRequestToken = &v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *BadRequestException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// SetDescription sets the Description field's value.
-func (s *CreateEnvironmentEC2Input) SetDescription(v string) *CreateEnvironmentEC2Input {
-	s.Description = &v
-	return s
+// RequestID returns the service's response RequestID for request.
+func (s *BadRequestException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// A concurrent access issue occurred.
+type ConcurrentAccessException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConcurrentAccessException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConcurrentAccessException) GoString() string {
+	return s.String()
+}
+
+func newErrorConcurrentAccessException(v protocol.ResponseMetadata) error {
+	return &ConcurrentAccessException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ConcurrentAccessException) Code() string {
+	return "ConcurrentAccessException"
+}
+
+// Message returns the exception's message.
+func (s *ConcurrentAccessException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ConcurrentAccessException) OrigErr() error {
+	return nil
+}
+
+func (s *ConcurrentAccessException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ConcurrentAccessException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ConcurrentAccessException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// A conflict occurred.
+type ConflictException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConflictException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConflictException) GoString() string {
+	return s.String()
+}
+
+func newErrorConflictException(v protocol.ResponseMetadata) error {
+	return &ConflictException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ConflictException) Code() string {
+	return "ConflictException"
+}
+
+// Message returns the exception's message.
+func (s *ConflictException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ConflictException) OrigErr() error {
+	return nil
+}
+
+func (s *ConflictException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ConflictException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ConflictException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type CreateEnvironmentEC2Input struct {
+	_ struct{} `type:"structure"`
+
+	// The number of minutes until the running instance is shut down after the environment
+	// has last been used.
+	AutomaticStopTimeMinutes *int64 `locationName:"automaticStopTimeMinutes" type:"integer"`
+
+	// A unique, case-sensitive string that helps Cloud9 to ensure this operation
+	// completes no more than one time.
+	//
+	// For more information, see Client Tokens (https://docs.aws.amazon.com/AWSEC2/latest/APIReference/Run_Instance_Idempotency.html)
+	// in the Amazon EC2 API Reference.
+	ClientRequestToken *string `locationName:"clientRequestToken" type:"string"`
+
+	// The connection type used for connecting to an Amazon EC2 environment. Valid
+	// values are CONNECT_SSH (default) and CONNECT_SSM (connected through Amazon
+	// EC2 Systems Manager).
+	//
+	// For more information, see Accessing no-ingress EC2 instances with Amazon
+	// EC2 Systems Manager (https://docs.aws.amazon.com/cloud9/latest/user-guide/ec2-ssm.html)
+	// in the Cloud9 User Guide.
+	ConnectionType *string `locationName:"connectionType" type:"string" enum:"ConnectionType"`
+
+	// The description of the environment to create.
+	//
+	// Description is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by CreateEnvironmentEC2Input's
+	// String and GoString methods.
+	Description *string `locationName:"description" type:"string" sensitive:"true"`
+
+	// Checks whether you have the required permissions for the action, without
+	// actually making the request, and provides an error response. If you have
+	// the required permissions, the error response is DryRunOperation. Otherwise,
+	// it is UnauthorizedOperation.
+	DryRun *bool `locationName:"dryRun" type:"boolean"`
+
+	// The identifier for the Amazon Machine Image (AMI) that's used to create the
+	// EC2 instance. To choose an AMI for the instance, you must specify a valid
+	// AMI alias or a valid Amazon EC2 Systems Manager (SSM) path.
+	//
+	// From November 20, 2023, you will be required to include the imageId parameter
+	// for the CreateEnvironmentEC2 action. This change will be reflected across
+	// all direct methods of communicating with the API, such as Amazon Web Services
+	// SDK, Amazon Web Services CLI and Amazon Web Services CloudFormation. This
+	// change will only affect direct API consumers, and not Cloud9 console users.
+	//
+	// From January 22, 2024, Amazon Linux (AL1) will be removed from the list of
+	// available image IDs for Cloud9. This is necessary as AL1 will reach the end
+	// of maintenance support in December 2023, and as a result will no longer receive
+	// security updates. We recommend using Amazon Linux 2 as the AMI to create
+	// your environment as it is fully supported. This change will only affect direct
+	// API consumers, and not Cloud9 console users.
+	//
+	// Since Ubuntu 18.04 has ended standard support as of May 31, 2023, we recommend
+	// you choose Ubuntu 22.04.
+	//
+	// AMI aliases
+	//
+	//    * Amazon Linux (default): amazonlinux-1-x86_64
+	//
+	//    * Amazon Linux 2: amazonlinux-2-x86_64
+	//
+	//    * Ubuntu 18.04: ubuntu-18.04-x86_64
+	//
+	//    * Ubuntu 22.04: ubuntu-22.04-x86_64
+	//
+	// SSM paths
+	//
+	//    * Amazon Linux (default): resolve:ssm:/aws/service/cloud9/amis/amazonlinux-1-x86_64
+	//
+	//    * Amazon Linux 2: resolve:ssm:/aws/service/cloud9/amis/amazonlinux-2-x86_64
+	//
+	//    * Ubuntu 18.04: resolve:ssm:/aws/service/cloud9/amis/ubuntu-18.04-x86_64
+	//
+	//    * Ubuntu 22.04: resolve:ssm:/aws/service/cloud9/amis/ubuntu-22.04-x86_64
+	ImageId *string `locationName:"imageId" type:"string"`
+
+	// The type of instance to connect to the environment (for example, t2.micro).
+	//
+	// InstanceType is a required field
+	InstanceType *string `locationName:"instanceType" min:"5" type:"string" required:"true"`
+
+	// The name of the environment to create.
+	//
+	// This name is visible to other IAM users in the same Amazon Web Services account.
+	//
+	// Name is a required field
+	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
+
+	// The Amazon Resource Name (ARN) of the environment owner. This ARN can be
+	// the ARN of any IAM principal. If this value is not specified, the ARN defaults
+	// to this environment's creator.
+	OwnerArn *string `locationName:"ownerArn" type:"string"`
+
+	// The ID of the subnet in Amazon VPC that Cloud9 will use to communicate with
+	// the Amazon EC2 instance.
+	SubnetId *string `locationName:"subnetId" min:"15" type:"string"`
+
+	// An array of key-value pairs that will be associated with the new Cloud9 development
+	// environment.
+	//
+	// Tags is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by CreateEnvironmentEC2Input's
+	// String and GoString methods.
+	Tags []*Tag `locationName:"tags" type:"list" sensitive:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateEnvironmentEC2Input) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateEnvironmentEC2Input) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateEnvironmentEC2Input) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateEnvironmentEC2Input"}
+	if s.InstanceType == nil {
+		invalidParams.Add(request.NewErrParamRequired("InstanceType"))
+	}
+	if s.InstanceType != nil && len(*s.InstanceType) < 5 {
+		invalidParams.Add(request.NewErrParamMinLen("InstanceType", 5))
+	}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+	if s.SubnetId != nil && len(*s.SubnetId) < 15 {
+		invalidParams.Add(request.NewErrParamMinLen("SubnetId", 15))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAutomaticStopTimeMinutes sets the AutomaticStopTimeMinutes field's value.
+func (s *CreateEnvironmentEC2Input) SetAutomaticStopTimeMinutes(v int64) *CreateEnvironmentEC2Input {
+	s.AutomaticStopTimeMinutes = &v
+	return s
+}
+
+// SetClientRequestToken sets the ClientRequestToken field's value.
+func (s *CreateEnvironmentEC2Input) SetClientRequestToken(v string) *CreateEnvironmentEC2Input {
+	s.ClientRequestToken = &v
+	return s
+}
+
+// SetConnectionType sets the ConnectionType field's value.
+func (s *CreateEnvironmentEC2Input) SetConnectionType(v string) *CreateEnvironmentEC2Input {
+	s.ConnectionType = &v
+	return s
+}
+
+// SetDescription sets the Description field's value.
+func (s *CreateEnvironmentEC2Input) SetDescription(v string) *CreateEnvironmentEC2Input {
+	s.Description = &v
+	return s
+}
+
+// SetDryRun sets the DryRun field's value.
+func (s *CreateEnvironmentEC2Input) SetDryRun(v bool) *CreateEnvironmentEC2Input {
+	s.DryRun = &v
+	return s
+}
+
+// SetImageId sets the ImageId field's value.
+func (s *CreateEnvironmentEC2Input) SetImageId(v string) *CreateEnvironmentEC2Input {
+	s.ImageId = &v
+	return s
 }
 
 // SetInstanceType sets the InstanceType field's value.
@@ -1218,6 +1781,12 @@ func (s *CreateEnvironmentEC2Input) SetSubnetId(v string) *CreateEnvironmentEC2I
 	return s
 }
 
+// SetTags sets the Tags field's value.
+func (s *CreateEnvironmentEC2Input) SetTags(v []*Tag) *CreateEnvironmentEC2Input {
+	s.Tags = v
+	return s
+}
+
 type CreateEnvironmentEC2Output struct {
 	_ struct{} `type:"structure"`
 
@@ -1225,12 +1794,20 @@ type CreateEnvironmentEC2Output struct {
 	EnvironmentId *string `locationName:"environmentId" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateEnvironmentEC2Output) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateEnvironmentEC2Output) GoString() string {
 	return s.String()
 }
@@ -1266,12 +1843,20 @@ type CreateEnvironmentMembershipInput struct {
 	UserArn *string `locationName:"userArn" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateEnvironmentMembershipInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateEnvironmentMembershipInput) GoString() string {
 	return s.String()
 }
@@ -1317,15 +1902,25 @@ type CreateEnvironmentMembershipOutput struct {
 	_ struct{} `type:"structure"`
 
 	// Information about the environment member that was added.
-	Membership *EnvironmentMember `locationName:"membership" type:"structure"`
+	//
+	// Membership is a required field
+	Membership *EnvironmentMember `locationName:"membership" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateEnvironmentMembershipOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateEnvironmentMembershipOutput) GoString() string {
 	return s.String()
 }
@@ -1345,12 +1940,20 @@ type DeleteEnvironmentInput struct {
 	EnvironmentId *string `locationName:"environmentId" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteEnvironmentInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteEnvironmentInput) GoString() string {
 	return s.String()
 }
@@ -1389,12 +1992,20 @@ type DeleteEnvironmentMembershipInput struct {
 	UserArn *string `locationName:"userArn" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteEnvironmentMembershipInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteEnvironmentMembershipInput) GoString() string {
 	return s.String()
 }
@@ -1431,12 +2042,20 @@ type DeleteEnvironmentMembershipOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteEnvironmentMembershipOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteEnvironmentMembershipOutput) GoString() string {
 	return s.String()
 }
@@ -1445,12 +2064,20 @@ type DeleteEnvironmentOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteEnvironmentOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteEnvironmentOutput) GoString() string {
 	return s.String()
 }
@@ -1482,7 +2109,7 @@ type DescribeEnvironmentMembershipsInput struct {
 	//    * read-write: Has read-write access to the environment.
 	//
 	// If no value is specified, information about all environment members are returned.
-	Permissions []*string `locationName:"permissions" type:"list"`
+	Permissions []*string `locationName:"permissions" type:"list" enum:"Permissions"`
 
 	// The Amazon Resource Name (ARN) of an individual environment member to get
 	// information about. If no value is specified, information about all environment
@@ -1490,12 +2117,20 @@ type DescribeEnvironmentMembershipsInput struct {
 	UserArn *string `locationName:"userArn" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeEnvironmentMembershipsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeEnvironmentMembershipsInput) GoString() string {
 	return s.String()
 }
@@ -1543,12 +2178,20 @@ type DescribeEnvironmentMembershipsOutput struct {
 	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeEnvironmentMembershipsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeEnvironmentMembershipsOutput) GoString() string {
 	return s.String()
 }
@@ -1574,12 +2217,20 @@ type DescribeEnvironmentStatusInput struct {
 	EnvironmentId *string `locationName:"environmentId" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeEnvironmentStatusInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeEnvironmentStatusInput) GoString() string {
 	return s.String()
 }
@@ -1607,7 +2258,9 @@ type DescribeEnvironmentStatusOutput struct {
 	_ struct{} `type:"structure"`
 
 	// Any informational message about the status of the environment.
-	Message *string `locationName:"message" type:"string"`
+	//
+	// Message is a required field
+	Message *string `locationName:"message" type:"string" required:"true"`
 
 	// The status of the environment. Available values include:
 	//
@@ -1624,15 +2277,25 @@ type DescribeEnvironmentStatusOutput struct {
 	//    * stopped: The environment is stopped.
 	//
 	//    * stopping: The environment is stopping.
-	Status *string `locationName:"status" type:"string" enum:"EnvironmentStatus"`
+	//
+	// Status is a required field
+	Status *string `locationName:"status" type:"string" required:"true" enum:"EnvironmentStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeEnvironmentStatusOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeEnvironmentStatusOutput) GoString() string {
 	return s.String()
 }
@@ -1658,12 +2321,20 @@ type DescribeEnvironmentsInput struct {
 	EnvironmentIds []*string `locationName:"environmentIds" min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeEnvironmentsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeEnvironmentsInput) GoString() string {
 	return s.String()
 }
@@ -1697,12 +2368,20 @@ type DescribeEnvironmentsOutput struct {
 	Environments []*Environment `locationName:"environments" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeEnvironmentsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeEnvironmentsOutput) GoString() string {
 	return s.String()
 }
@@ -1713,14 +2392,24 @@ func (s *DescribeEnvironmentsOutput) SetEnvironments(v []*Environment) *Describe
 	return s
 }
 
-// Information about an AWS Cloud9 development environment.
+// Information about an Cloud9 development environment.
 type Environment struct {
 	_ struct{} `type:"structure"`
 
 	// The Amazon Resource Name (ARN) of the environment.
-	Arn *string `locationName:"arn" type:"string"`
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" type:"string" required:"true"`
+
+	// The connection type used for connecting to an Amazon EC2 environment. CONNECT_SSH
+	// is selected by default.
+	ConnectionType *string `locationName:"connectionType" type:"string" enum:"ConnectionType"`
 
 	// The description for the environment.
+	//
+	// Description is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by Environment's
+	// String and GoString methods.
 	Description *string `locationName:"description" type:"string" sensitive:"true"`
 
 	// The ID of the environment.
@@ -1729,11 +2418,37 @@ type Environment struct {
 	// The state of the environment in its creation or deletion lifecycle.
 	Lifecycle *EnvironmentLifecycle `locationName:"lifecycle" type:"structure"`
 
+	// Describes the status of Amazon Web Services managed temporary credentials
+	// for the Cloud9 environment. Available values are:
+	//
+	//    * ENABLED_ON_CREATE
+	//
+	//    * ENABLED_BY_OWNER
+	//
+	//    * DISABLED_BY_DEFAULT
+	//
+	//    * DISABLED_BY_OWNER
+	//
+	//    * DISABLED_BY_COLLABORATOR
+	//
+	//    * PENDING_REMOVAL_BY_COLLABORATOR
+	//
+	//    * PENDING_REMOVAL_BY_OWNER
+	//
+	//    * FAILED_REMOVAL_BY_COLLABORATOR
+	//
+	//    * ENABLED_BY_OWNER
+	//
+	//    * DISABLED_BY_DEFAULT
+	ManagedCredentialsStatus *string `locationName:"managedCredentialsStatus" type:"string" enum:"ManagedCredentialsStatus"`
+
 	// The name of the environment.
 	Name *string `locationName:"name" min:"1" type:"string"`
 
 	// The Amazon Resource Name (ARN) of the environment owner.
-	OwnerArn *string `locationName:"ownerArn" type:"string"`
+	//
+	// OwnerArn is a required field
+	OwnerArn *string `locationName:"ownerArn" type:"string" required:"true"`
 
 	// The type of environment. Valid values include the following:
 	//
@@ -1741,15 +2456,25 @@ type Environment struct {
 	//    to the environment.
 	//
 	//    * ssh: Your own server connects to the environment.
-	Type *string `locationName:"type" type:"string" enum:"EnvironmentType"`
+	//
+	// Type is a required field
+	Type *string `locationName:"type" type:"string" required:"true" enum:"EnvironmentType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Environment) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Environment) GoString() string {
 	return s.String()
 }
@@ -1760,6 +2485,12 @@ func (s *Environment) SetArn(v string) *Environment {
 	return s
 }
 
+// SetConnectionType sets the ConnectionType field's value.
+func (s *Environment) SetConnectionType(v string) *Environment {
+	s.ConnectionType = &v
+	return s
+}
+
 // SetDescription sets the Description field's value.
 func (s *Environment) SetDescription(v string) *Environment {
 	s.Description = &v
@@ -1778,6 +2509,12 @@ func (s *Environment) SetLifecycle(v *EnvironmentLifecycle) *Environment {
 	return s
 }
 
+// SetManagedCredentialsStatus sets the ManagedCredentialsStatus field's value.
+func (s *Environment) SetManagedCredentialsStatus(v string) *Environment {
+	s.ManagedCredentialsStatus = &v
+	return s
+}
+
 // SetName sets the Name field's value.
 func (s *Environment) SetName(v string) *Environment {
 	s.Name = &v
@@ -1797,12 +2534,12 @@ func (s *Environment) SetType(v string) *Environment {
 }
 
 // Information about the current creation or deletion lifecycle state of an
-// AWS Cloud9 development environment.
+// Cloud9 development environment.
 type EnvironmentLifecycle struct {
 	_ struct{} `type:"structure"`
 
 	// If the environment failed to delete, the Amazon Resource Name (ARN) of the
-	// related AWS resource.
+	// related Amazon Web Services resource.
 	FailureResource *string `locationName:"failureResource" type:"string"`
 
 	// Any informational message about the lifecycle state of the environment.
@@ -1810,20 +2547,32 @@ type EnvironmentLifecycle struct {
 
 	// The current creation or deletion lifecycle state of the environment.
 	//
+	//    * CREATING: The environment is in the process of being created.
+	//
 	//    * CREATED: The environment was successfully created.
 	//
-	//    * DELETE_FAILED: The environment failed to delete.
+	//    * CREATE_FAILED: The environment failed to be created.
 	//
 	//    * DELETING: The environment is in the process of being deleted.
+	//
+	//    * DELETE_FAILED: The environment failed to delete.
 	Status *string `locationName:"status" type:"string" enum:"EnvironmentLifecycleStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s EnvironmentLifecycle) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s EnvironmentLifecycle) GoString() string {
 	return s.String()
 }
@@ -1846,12 +2595,14 @@ func (s *EnvironmentLifecycle) SetStatus(v string) *EnvironmentLifecycle {
 	return s
 }
 
-// Information about an environment member for an AWS Cloud9 development environment.
+// Information about an environment member for an Cloud9 development environment.
 type EnvironmentMember struct {
 	_ struct{} `type:"structure"`
 
 	// The ID of the environment for the environment member.
-	EnvironmentId *string `locationName:"environmentId" type:"string"`
+	//
+	// EnvironmentId is a required field
+	EnvironmentId *string `locationName:"environmentId" type:"string" required:"true"`
 
 	// The time, expressed in epoch time format, when the environment member last
 	// opened the environment.
@@ -1865,22 +2616,35 @@ type EnvironmentMember struct {
 	//    * read-only: Has read-only access to the environment.
 	//
 	//    * read-write: Has read-write access to the environment.
-	Permissions *string `locationName:"permissions" type:"string" enum:"Permissions"`
+	//
+	// Permissions is a required field
+	Permissions *string `locationName:"permissions" type:"string" required:"true" enum:"Permissions"`
 
 	// The Amazon Resource Name (ARN) of the environment member.
-	UserArn *string `locationName:"userArn" type:"string"`
+	//
+	// UserArn is a required field
+	UserArn *string `locationName:"userArn" type:"string" required:"true"`
 
-	// The user ID in AWS Identity and Access Management (AWS IAM) of the environment
-	// member.
-	UserId *string `locationName:"userId" type:"string"`
+	// The user ID in Identity and Access Management (IAM) of the environment member.
+	//
+	// UserId is a required field
+	UserId *string `locationName:"userId" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s EnvironmentMember) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s EnvironmentMember) GoString() string {
 	return s.String()
 }
@@ -1915,27 +2679,227 @@ func (s *EnvironmentMember) SetUserId(v string) *EnvironmentMember {
 	return s
 }
 
-type ListEnvironmentsInput struct {
-	_ struct{} `type:"structure"`
+// An access permissions issue occurred.
+type ForbiddenException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The maximum number of environments to get identifiers for.
-	MaxResults *int64 `locationName:"maxResults" type:"integer"`
+	Message_ *string `locationName:"message" type:"string"`
+}
 
-	// During a previous call, if there are more than 25 items in the list, only
-	// the first 25 items are returned, along with a unique string called a next
-	// token. To get the next batch of items in the list, call this operation again,
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ForbiddenException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ForbiddenException) GoString() string {
+	return s.String()
+}
+
+func newErrorForbiddenException(v protocol.ResponseMetadata) error {
+	return &ForbiddenException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ForbiddenException) Code() string {
+	return "ForbiddenException"
+}
+
+// Message returns the exception's message.
+func (s *ForbiddenException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ForbiddenException) OrigErr() error {
+	return nil
+}
+
+func (s *ForbiddenException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ForbiddenException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ForbiddenException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// An internal server error occurred.
+type InternalServerErrorException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InternalServerErrorException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InternalServerErrorException) GoString() string {
+	return s.String()
+}
+
+func newErrorInternalServerErrorException(v protocol.ResponseMetadata) error {
+	return &InternalServerErrorException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InternalServerErrorException) Code() string {
+	return "InternalServerErrorException"
+}
+
+// Message returns the exception's message.
+func (s *InternalServerErrorException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InternalServerErrorException) OrigErr() error {
+	return nil
+}
+
+func (s *InternalServerErrorException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InternalServerErrorException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InternalServerErrorException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// A service limit was exceeded.
+type LimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) GoString() string {
+	return s.String()
+}
+
+func newErrorLimitExceededException(v protocol.ResponseMetadata) error {
+	return &LimitExceededException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *LimitExceededException) Code() string {
+	return "LimitExceededException"
+}
+
+// Message returns the exception's message.
+func (s *LimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *LimitExceededException) OrigErr() error {
+	return nil
+}
+
+func (s *LimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *LimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *LimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type ListEnvironmentsInput struct {
+	_ struct{} `type:"structure"`
+
+	// The maximum number of environments to get identifiers for.
+	MaxResults *int64 `locationName:"maxResults" type:"integer"`
+
+	// During a previous call, if there are more than 25 items in the list, only
+	// the first 25 items are returned, along with a unique string called a next
+	// token. To get the next batch of items in the list, call this operation again,
 	// adding the next token to the call. To get all of the items in the list, keep
 	// calling this operation with each subsequent next token that is returned,
 	// until no more next tokens are returned.
 	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListEnvironmentsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListEnvironmentsInput) GoString() string {
 	return s.String()
 }
@@ -1965,12 +2929,20 @@ type ListEnvironmentsOutput struct {
 	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListEnvironmentsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListEnvironmentsOutput) GoString() string {
 	return s.String()
 }
@@ -1987,10 +2959,485 @@ func (s *ListEnvironmentsOutput) SetNextToken(v string) *ListEnvironmentsOutput
 	return s
 }
 
+type ListTagsForResourceInput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the Cloud9 development environment to get
+	// the tags for.
+	//
+	// ResourceARN is a required field
+	ResourceARN *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListTagsForResourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsForResourceInput"}
+	if s.ResourceARN == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceARN"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetResourceARN sets the ResourceARN field's value.
+func (s *ListTagsForResourceInput) SetResourceARN(v string) *ListTagsForResourceInput {
+	s.ResourceARN = &v
+	return s
+}
+
+type ListTagsForResourceOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The list of tags associated with the Cloud9 development environment.
+	//
+	// Tags is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by ListTagsForResourceOutput's
+	// String and GoString methods.
+	Tags []*Tag `type:"list" sensitive:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceOutput) GoString() string {
+	return s.String()
+}
+
+// SetTags sets the Tags field's value.
+func (s *ListTagsForResourceOutput) SetTags(v []*Tag) *ListTagsForResourceOutput {
+	s.Tags = v
+	return s
+}
+
+// The target resource cannot be found.
+type NotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorNotFoundException(v protocol.ResponseMetadata) error {
+	return &NotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *NotFoundException) Code() string {
+	return "NotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *NotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *NotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *NotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *NotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *NotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Metadata that is associated with Amazon Web Services resources. In particular,
+// a name-value pair that can be associated with an Cloud9 development environment.
+// There are two types of tags: user tags and system tags. A user tag is created
+// by the user. A system tag is automatically created by Amazon Web Services
+// services. A system tag is prefixed with "aws:" and cannot be modified by
+// the user.
+type Tag struct {
+	_ struct{} `type:"structure" sensitive:"true"`
+
+	// The name part of a tag.
+	//
+	// Key is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by Tag's
+	// String and GoString methods.
+	//
+	// Key is a required field
+	Key *string `min:"1" type:"string" required:"true" sensitive:"true"`
+
+	// The value part of a tag.
+	//
+	// Value is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by Tag's
+	// String and GoString methods.
+	//
+	// Value is a required field
+	Value *string `type:"string" required:"true" sensitive:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Tag) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Tag) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *Tag) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key == nil {
+		invalidParams.Add(request.NewErrParamRequired("Key"))
+	}
+	if s.Key != nil && len(*s.Key) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Key", 1))
+	}
+	if s.Value == nil {
+		invalidParams.Add(request.NewErrParamRequired("Value"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetKey sets the Key field's value.
+func (s *Tag) SetKey(v string) *Tag {
+	s.Key = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *Tag) SetValue(v string) *Tag {
+	s.Value = &v
+	return s
+}
+
+type TagResourceInput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the Cloud9 development environment to add
+	// tags to.
+	//
+	// ResourceARN is a required field
+	ResourceARN *string `type:"string" required:"true"`
+
+	// The list of tags to add to the given Cloud9 development environment.
+	//
+	// Tags is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by TagResourceInput's
+	// String and GoString methods.
+	//
+	// Tags is a required field
+	Tags []*Tag `type:"list" required:"true" sensitive:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *TagResourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "TagResourceInput"}
+	if s.ResourceARN == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceARN"))
+	}
+	if s.Tags == nil {
+		invalidParams.Add(request.NewErrParamRequired("Tags"))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetResourceARN sets the ResourceARN field's value.
+func (s *TagResourceInput) SetResourceARN(v string) *TagResourceInput {
+	s.ResourceARN = &v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *TagResourceInput) SetTags(v []*Tag) *TagResourceInput {
+	s.Tags = v
+	return s
+}
+
+type TagResourceOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceOutput) GoString() string {
+	return s.String()
+}
+
+// Too many service requests were made over the given time period.
+type TooManyRequestsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyRequestsException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyRequestsException) GoString() string {
+	return s.String()
+}
+
+func newErrorTooManyRequestsException(v protocol.ResponseMetadata) error {
+	return &TooManyRequestsException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *TooManyRequestsException) Code() string {
+	return "TooManyRequestsException"
+}
+
+// Message returns the exception's message.
+func (s *TooManyRequestsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *TooManyRequestsException) OrigErr() error {
+	return nil
+}
+
+func (s *TooManyRequestsException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *TooManyRequestsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *TooManyRequestsException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type UntagResourceInput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the Cloud9 development environment to remove
+	// tags from.
+	//
+	// ResourceARN is a required field
+	ResourceARN *string `type:"string" required:"true"`
+
+	// The tag names of the tags to remove from the given Cloud9 development environment.
+	//
+	// TagKeys is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by UntagResourceInput's
+	// String and GoString methods.
+	//
+	// TagKeys is a required field
+	TagKeys []*string `type:"list" required:"true" sensitive:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *UntagResourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UntagResourceInput"}
+	if s.ResourceARN == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceARN"))
+	}
+	if s.TagKeys == nil {
+		invalidParams.Add(request.NewErrParamRequired("TagKeys"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetResourceARN sets the ResourceARN field's value.
+func (s *UntagResourceInput) SetResourceARN(v string) *UntagResourceInput {
+	s.ResourceARN = &v
+	return s
+}
+
+// SetTagKeys sets the TagKeys field's value.
+func (s *UntagResourceInput) SetTagKeys(v []*string) *UntagResourceInput {
+	s.TagKeys = v
+	return s
+}
+
+type UntagResourceOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateEnvironmentInput struct {
 	_ struct{} `type:"structure"`
 
 	// Any new or replacement description for the environment.
+	//
+	// Description is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by UpdateEnvironmentInput's
+	// String and GoString methods.
 	Description *string `locationName:"description" type:"string" sensitive:"true"`
 
 	// The ID of the environment to change settings.
@@ -1998,16 +3445,37 @@ type UpdateEnvironmentInput struct {
 	// EnvironmentId is a required field
 	EnvironmentId *string `locationName:"environmentId" type:"string" required:"true"`
 
+	// Allows the environment owner to turn on or turn off the Amazon Web Services
+	// managed temporary credentials for an Cloud9 environment by using one of the
+	// following values:
+	//
+	//    * ENABLE
+	//
+	//    * DISABLE
+	//
+	// Only the environment owner can change the status of managed temporary credentials.
+	// An AccessDeniedException is thrown if an attempt to turn on or turn off managed
+	// temporary credentials is made by an account that's not the environment owner.
+	ManagedCredentialsAction *string `locationName:"managedCredentialsAction" type:"string" enum:"ManagedCredentialsAction"`
+
 	// A replacement name for the environment.
 	Name *string `locationName:"name" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateEnvironmentInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateEnvironmentInput) GoString() string {
 	return s.String()
 }
@@ -2040,6 +3508,12 @@ func (s *UpdateEnvironmentInput) SetEnvironmentId(v string) *UpdateEnvironmentIn
 	return s
 }
 
+// SetManagedCredentialsAction sets the ManagedCredentialsAction field's value.
+func (s *UpdateEnvironmentInput) SetManagedCredentialsAction(v string) *UpdateEnvironmentInput {
+	s.ManagedCredentialsAction = &v
+	return s
+}
+
 // SetName sets the Name field's value.
 func (s *UpdateEnvironmentInput) SetName(v string) *UpdateEnvironmentInput {
 	s.Name = &v
@@ -2072,12 +3546,20 @@ type UpdateEnvironmentMembershipInput struct {
 	UserArn *string `locationName:"userArn" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateEnvironmentMembershipInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateEnvironmentMembershipInput) GoString() string {
 	return s.String()
 }
@@ -2126,12 +3608,20 @@ type UpdateEnvironmentMembershipOutput struct {
 	Membership *EnvironmentMember `locationName:"membership" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateEnvironmentMembershipOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateEnvironmentMembershipOutput) GoString() string {
 	return s.String()
 }
@@ -2146,20 +3636,50 @@ type UpdateEnvironmentOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateEnvironmentOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateEnvironmentOutput) GoString() string {
 	return s.String()
 }
 
 const (
+	// ConnectionTypeConnectSsh is a ConnectionType enum value
+	ConnectionTypeConnectSsh = "CONNECT_SSH"
+
+	// ConnectionTypeConnectSsm is a ConnectionType enum value
+	ConnectionTypeConnectSsm = "CONNECT_SSM"
+)
+
+// ConnectionType_Values returns all elements of the ConnectionType enum
+func ConnectionType_Values() []string {
+	return []string{
+		ConnectionTypeConnectSsh,
+		ConnectionTypeConnectSsm,
+	}
+}
+
+const (
+	// EnvironmentLifecycleStatusCreating is a EnvironmentLifecycleStatus enum value
+	EnvironmentLifecycleStatusCreating = "CREATING"
+
 	// EnvironmentLifecycleStatusCreated is a EnvironmentLifecycleStatus enum value
 	EnvironmentLifecycleStatusCreated = "CREATED"
 
+	// EnvironmentLifecycleStatusCreateFailed is a EnvironmentLifecycleStatus enum value
+	EnvironmentLifecycleStatusCreateFailed = "CREATE_FAILED"
+
 	// EnvironmentLifecycleStatusDeleting is a EnvironmentLifecycleStatus enum value
 	EnvironmentLifecycleStatusDeleting = "DELETING"
 
@@ -2167,6 +3687,17 @@ const (
 	EnvironmentLifecycleStatusDeleteFailed = "DELETE_FAILED"
 )
 
+// EnvironmentLifecycleStatus_Values returns all elements of the EnvironmentLifecycleStatus enum
+func EnvironmentLifecycleStatus_Values() []string {
+	return []string{
+		EnvironmentLifecycleStatusCreating,
+		EnvironmentLifecycleStatusCreated,
+		EnvironmentLifecycleStatusCreateFailed,
+		EnvironmentLifecycleStatusDeleting,
+		EnvironmentLifecycleStatusDeleteFailed,
+	}
+}
+
 const (
 	// EnvironmentStatusError is a EnvironmentStatus enum value
 	EnvironmentStatusError = "error"
@@ -2190,6 +3721,19 @@ const (
 	EnvironmentStatusDeleting = "deleting"
 )
 
+// EnvironmentStatus_Values returns all elements of the EnvironmentStatus enum
+func EnvironmentStatus_Values() []string {
+	return []string{
+		EnvironmentStatusError,
+		EnvironmentStatusCreating,
+		EnvironmentStatusConnecting,
+		EnvironmentStatusReady,
+		EnvironmentStatusStopping,
+		EnvironmentStatusStopped,
+		EnvironmentStatusDeleting,
+	}
+}
+
 const (
 	// EnvironmentTypeSsh is a EnvironmentType enum value
 	EnvironmentTypeSsh = "ssh"
@@ -2198,6 +3742,82 @@ const (
 	EnvironmentTypeEc2 = "ec2"
 )
 
+// EnvironmentType_Values returns all elements of the EnvironmentType enum
+func EnvironmentType_Values() []string {
+	return []string{
+		EnvironmentTypeSsh,
+		EnvironmentTypeEc2,
+	}
+}
+
+const (
+	// ManagedCredentialsActionEnable is a ManagedCredentialsAction enum value
+	ManagedCredentialsActionEnable = "ENABLE"
+
+	// ManagedCredentialsActionDisable is a ManagedCredentialsAction enum value
+	ManagedCredentialsActionDisable = "DISABLE"
+)
+
+// ManagedCredentialsAction_Values returns all elements of the ManagedCredentialsAction enum
+func ManagedCredentialsAction_Values() []string {
+	return []string{
+		ManagedCredentialsActionEnable,
+		ManagedCredentialsActionDisable,
+	}
+}
+
+const (
+	// ManagedCredentialsStatusEnabledOnCreate is a ManagedCredentialsStatus enum value
+	ManagedCredentialsStatusEnabledOnCreate = "ENABLED_ON_CREATE"
+
+	// ManagedCredentialsStatusEnabledByOwner is a ManagedCredentialsStatus enum value
+	ManagedCredentialsStatusEnabledByOwner = "ENABLED_BY_OWNER"
+
+	// ManagedCredentialsStatusDisabledByDefault is a ManagedCredentialsStatus enum value
+	ManagedCredentialsStatusDisabledByDefault = "DISABLED_BY_DEFAULT"
+
+	// ManagedCredentialsStatusDisabledByOwner is a ManagedCredentialsStatus enum value
+	ManagedCredentialsStatusDisabledByOwner = "DISABLED_BY_OWNER"
+
+	// ManagedCredentialsStatusDisabledByCollaborator is a ManagedCredentialsStatus enum value
+	ManagedCredentialsStatusDisabledByCollaborator = "DISABLED_BY_COLLABORATOR"
+
+	// ManagedCredentialsStatusPendingRemovalByCollaborator is a ManagedCredentialsStatus enum value
+	ManagedCredentialsStatusPendingRemovalByCollaborator = "PENDING_REMOVAL_BY_COLLABORATOR"
+
+	// ManagedCredentialsStatusPendingStartRemovalByCollaborator is a ManagedCredentialsStatus enum value
+	ManagedCredentialsStatusPendingStartRemovalByCollaborator = "PENDING_START_REMOVAL_BY_COLLABORATOR"
+
+	// ManagedCredentialsStatusPendingRemovalByOwner is a ManagedCredentialsStatus enum value
+	ManagedCredentialsStatusPendingRemovalByOwner = "PENDING_REMOVAL_BY_OWNER"
+
+	// ManagedCredentialsStatusPendingStartRemovalByOwner is a ManagedCredentialsStatus enum value
+	ManagedCredentialsStatusPendingStartRemovalByOwner = "PENDING_START_REMOVAL_BY_OWNER"
+
+	// ManagedCredentialsStatusFailedRemovalByCollaborator is a ManagedCredentialsStatus enum value
+	ManagedCredentialsStatusFailedRemovalByCollaborator = "FAILED_REMOVAL_BY_COLLABORATOR"
+
+	// ManagedCredentialsStatusFailedRemovalByOwner is a ManagedCredentialsStatus enum value
+	ManagedCredentialsStatusFailedRemovalByOwner = "FAILED_REMOVAL_BY_OWNER"
+)
+
+// ManagedCredentialsStatus_Values returns all elements of the ManagedCredentialsStatus enum
+func ManagedCredentialsStatus_Values() []string {
+	return []string{
+		ManagedCredentialsStatusEnabledOnCreate,
+		ManagedCredentialsStatusEnabledByOwner,
+		ManagedCredentialsStatusDisabledByDefault,
+		ManagedCredentialsStatusDisabledByOwner,
+		ManagedCredentialsStatusDisabledByCollaborator,
+		ManagedCredentialsStatusPendingRemovalByCollaborator,
+		ManagedCredentialsStatusPendingStartRemovalByCollaborator,
+		ManagedCredentialsStatusPendingRemovalByOwner,
+		ManagedCredentialsStatusPendingStartRemovalByOwner,
+		ManagedCredentialsStatusFailedRemovalByCollaborator,
+		ManagedCredentialsStatusFailedRemovalByOwner,
+	}
+}
+
 const (
 	// MemberPermissionsReadWrite is a MemberPermissions enum value
 	MemberPermissionsReadWrite = "read-write"
@@ -2206,6 +3826,14 @@ const (
 	MemberPermissionsReadOnly = "read-only"
 )
 
+// MemberPermissions_Values returns all elements of the MemberPermissions enum
+func MemberPermissions_Values() []string {
+	return []string{
+		MemberPermissionsReadWrite,
+		MemberPermissionsReadOnly,
+	}
+}
+
 const (
 	// PermissionsOwner is a Permissions enum value
 	PermissionsOwner = "owner"
@@ -2216,3 +3844,12 @@ const (
 	// PermissionsReadOnly is a Permissions enum value
 	PermissionsReadOnly = "read-only"
 )
+
+// Permissions_Values returns all elements of the Permissions enum
+func Permissions_Values() []string {
+	return []string{
+		PermissionsOwner,
+		PermissionsReadWrite,
+		PermissionsReadOnly,
+	}
+}
@@ -3,32 +3,34 @@
 // Package cloudformation provides the client and types for making API
 // requests to AWS CloudFormation.
 //
-// AWS CloudFormation allows you to create and manage AWS infrastructure deployments
-// predictably and repeatedly. You can use AWS CloudFormation to leverage AWS
-// products, such as Amazon Elastic Compute Cloud, Amazon Elastic Block Store,
-// Amazon Simple Notification Service, Elastic Load Balancing, and Auto Scaling
-// to build highly-reliable, highly scalable, cost-effective applications without
-// creating or configuring the underlying AWS infrastructure.
-//
-// With AWS CloudFormation, you declare all of your resources and dependencies
-// in a template file. The template defines a collection of resources as a single
-// unit called a stack. AWS CloudFormation creates and deletes all member resources
+// CloudFormation allows you to create and manage Amazon Web Services infrastructure
+// deployments predictably and repeatedly. You can use CloudFormation to leverage
+// Amazon Web Services products, such as Amazon Elastic Compute Cloud, Amazon
+// Elastic Block Store, Amazon Simple Notification Service, Elastic Load Balancing,
+// and Auto Scaling to build highly reliable, highly scalable, cost-effective
+// applications without creating or configuring the underlying Amazon Web Services
+// infrastructure.
+//
+// With CloudFormation, you declare all your resources and dependencies in a
+// template file. The template defines a collection of resources as a single
+// unit called a stack. CloudFormation creates and deletes all member resources
 // of the stack together and manages all dependencies between the resources
 // for you.
 //
-// For more information about AWS CloudFormation, see the AWS CloudFormation
-// Product Page (http://aws.amazon.com/cloudformation/).
+// For more information about CloudFormation, see the CloudFormation product
+// page (http://aws.amazon.com/cloudformation/).
 //
-// Amazon CloudFormation makes use of other AWS products. If you need additional
-// technical information about a specific AWS product, you can find the product's
-// technical documentation at docs.aws.amazon.com (https://docs.aws.amazon.com/).
+// CloudFormation makes use of other Amazon Web Services products. If you need
+// additional technical information about a specific Amazon Web Services product,
+// you can find the product's technical documentation at docs.aws.amazon.com
+// (https://docs.aws.amazon.com/).
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/cloudformation-2010-05-15 for more information on this service.
 //
 // See cloudformation package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/cloudformation/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact AWS CloudFormation with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
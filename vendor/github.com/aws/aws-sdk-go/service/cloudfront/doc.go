@@ -8,12 +8,12 @@
 // errors. For detailed information about CloudFront features, see the Amazon
 // CloudFront Developer Guide.
 //
-// See https://docs.aws.amazon.com/goto/WebAPI/cloudfront-2019-03-26 for more information on this service.
+// See https://docs.aws.amazon.com/goto/WebAPI/cloudfront-2020-05-31 for more information on this service.
 //
 // See cloudfront package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/cloudfront/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon CloudFront with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
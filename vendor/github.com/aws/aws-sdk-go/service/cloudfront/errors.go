@@ -22,12 +22,39 @@ const (
 	// The CNAME specified is already defined for CloudFront.
 	ErrCodeCNAMEAlreadyExists = "CNAMEAlreadyExists"
 
+	// ErrCodeCachePolicyAlreadyExists for service response error code
+	// "CachePolicyAlreadyExists".
+	//
+	// A cache policy with this name already exists. You must provide a unique name.
+	// To modify an existing cache policy, use UpdateCachePolicy.
+	ErrCodeCachePolicyAlreadyExists = "CachePolicyAlreadyExists"
+
+	// ErrCodeCachePolicyInUse for service response error code
+	// "CachePolicyInUse".
+	//
+	// Cannot delete the cache policy because it is attached to one or more cache
+	// behaviors.
+	ErrCodeCachePolicyInUse = "CachePolicyInUse"
+
 	// ErrCodeCannotChangeImmutablePublicKeyFields for service response error code
 	// "CannotChangeImmutablePublicKeyFields".
 	//
 	// You can't change the value of a public key.
 	ErrCodeCannotChangeImmutablePublicKeyFields = "CannotChangeImmutablePublicKeyFields"
 
+	// ErrCodeContinuousDeploymentPolicyAlreadyExists for service response error code
+	// "ContinuousDeploymentPolicyAlreadyExists".
+	//
+	// A continuous deployment policy with this configuration already exists.
+	ErrCodeContinuousDeploymentPolicyAlreadyExists = "ContinuousDeploymentPolicyAlreadyExists"
+
+	// ErrCodeContinuousDeploymentPolicyInUse for service response error code
+	// "ContinuousDeploymentPolicyInUse".
+	//
+	// You cannot delete a continuous deployment policy that is associated with
+	// a primary distribution.
+	ErrCodeContinuousDeploymentPolicyInUse = "ContinuousDeploymentPolicyInUse"
+
 	// ErrCodeDistributionAlreadyExists for service response error code
 	// "DistributionAlreadyExists".
 	//
@@ -72,6 +99,33 @@ const (
 	// The maximum size of a profile for field-level encryption was exceeded.
 	ErrCodeFieldLevelEncryptionProfileSizeExceeded = "FieldLevelEncryptionProfileSizeExceeded"
 
+	// ErrCodeFunctionAlreadyExists for service response error code
+	// "FunctionAlreadyExists".
+	//
+	// A function with the same name already exists in this Amazon Web Services
+	// account. To create a function, you must provide a unique name. To update
+	// an existing function, use UpdateFunction.
+	ErrCodeFunctionAlreadyExists = "FunctionAlreadyExists"
+
+	// ErrCodeFunctionInUse for service response error code
+	// "FunctionInUse".
+	//
+	// Cannot delete the function because it's attached to one or more cache behaviors.
+	ErrCodeFunctionInUse = "FunctionInUse"
+
+	// ErrCodeFunctionSizeLimitExceeded for service response error code
+	// "FunctionSizeLimitExceeded".
+	//
+	// The function is too large. For more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeFunctionSizeLimitExceeded = "FunctionSizeLimitExceeded"
+
+	// ErrCodeIllegalDelete for service response error code
+	// "IllegalDelete".
+	//
+	// You cannot delete a managed policy.
+	ErrCodeIllegalDelete = "IllegalDelete"
+
 	// ErrCodeIllegalFieldLevelEncryptionConfigAssociationWithCacheBehavior for service response error code
 	// "IllegalFieldLevelEncryptionConfigAssociationWithCacheBehavior".
 	//
@@ -79,10 +133,17 @@ const (
 	// with the specified cache behavior.
 	ErrCodeIllegalFieldLevelEncryptionConfigAssociationWithCacheBehavior = "IllegalFieldLevelEncryptionConfigAssociationWithCacheBehavior"
 
+	// ErrCodeIllegalOriginAccessConfiguration for service response error code
+	// "IllegalOriginAccessConfiguration".
+	//
+	// An origin cannot contain both an origin access control (OAC) and an origin
+	// access identity (OAI).
+	ErrCodeIllegalOriginAccessConfiguration = "IllegalOriginAccessConfiguration"
+
 	// ErrCodeIllegalUpdate for service response error code
 	// "IllegalUpdate".
 	//
-	// Origin and CallerReference cannot be updated.
+	// The update contains modifications that are not allowed.
 	ErrCodeIllegalUpdate = "IllegalUpdate"
 
 	// ErrCodeInconsistentQuantities for service response error code
@@ -94,7 +155,7 @@ const (
 	// ErrCodeInvalidArgument for service response error code
 	// "InvalidArgument".
 	//
-	// The argument is invalid.
+	// An argument is invalid.
 	ErrCodeInvalidArgument = "InvalidArgument"
 
 	// ErrCodeInvalidDefaultRootObject for service response error code
@@ -103,6 +164,13 @@ const (
 	// The default root object file name is too big or contains an invalid character.
 	ErrCodeInvalidDefaultRootObject = "InvalidDefaultRootObject"
 
+	// ErrCodeInvalidDomainNameForOriginAccessControl for service response error code
+	// "InvalidDomainNameForOriginAccessControl".
+	//
+	// An origin access control is associated with an origin whose domain name is
+	// not supported.
+	ErrCodeInvalidDomainNameForOriginAccessControl = "InvalidDomainNameForOriginAccessControl"
+
 	// ErrCodeInvalidErrorCode for service response error code
 	// "InvalidErrorCode".
 	//
@@ -118,6 +186,12 @@ const (
 	// when expected.
 	ErrCodeInvalidForwardCookies = "InvalidForwardCookies"
 
+	// ErrCodeInvalidFunctionAssociation for service response error code
+	// "InvalidFunctionAssociation".
+	//
+	// A CloudFront function association is invalid.
+	ErrCodeInvalidFunctionAssociation = "InvalidFunctionAssociation"
+
 	// ErrCodeInvalidGeoRestrictionParameter for service response error code
 	// "InvalidGeoRestrictionParameter".
 	//
@@ -133,13 +207,13 @@ const (
 	// ErrCodeInvalidIfMatchVersion for service response error code
 	// "InvalidIfMatchVersion".
 	//
-	// The If-Match version is missing or not valid for the distribution.
+	// The If-Match version is missing or not valid.
 	ErrCodeInvalidIfMatchVersion = "InvalidIfMatchVersion"
 
 	// ErrCodeInvalidLambdaFunctionAssociation for service response error code
 	// "InvalidLambdaFunctionAssociation".
 	//
-	// The specified Lambda function association is invalid.
+	// The specified Lambda@Edge function association is invalid.
 	ErrCodeInvalidLambdaFunctionAssociation = "InvalidLambdaFunctionAssociation"
 
 	// ErrCodeInvalidLocationCode for service response error code
@@ -161,6 +235,12 @@ const (
 	// bucket.
 	ErrCodeInvalidOrigin = "InvalidOrigin"
 
+	// ErrCodeInvalidOriginAccessControl for service response error code
+	// "InvalidOriginAccessControl".
+	//
+	// The origin access control is not valid.
+	ErrCodeInvalidOriginAccessControl = "InvalidOriginAccessControl"
+
 	// ErrCodeInvalidOriginAccessIdentity for service response error code
 	// "InvalidOriginAccessIdentity".
 	//
@@ -189,7 +269,7 @@ const (
 	// ErrCodeInvalidQueryStringParameters for service response error code
 	// "InvalidQueryStringParameters".
 	//
-	// Query string parameters specified in the response body are not valid.
+	// The query string parameters specified are not valid.
 	ErrCodeInvalidQueryStringParameters = "InvalidQueryStringParameters"
 
 	// ErrCodeInvalidRelativePath for service response error code
@@ -210,33 +290,43 @@ const (
 	// ErrCodeInvalidResponseCode for service response error code
 	// "InvalidResponseCode".
 	//
-	// A response code specified in the response body is not valid.
+	// A response code is not valid.
 	ErrCodeInvalidResponseCode = "InvalidResponseCode"
 
 	// ErrCodeInvalidTTLOrder for service response error code
 	// "InvalidTTLOrder".
 	//
-	// TTL order specified in the response body is not valid.
+	// The TTL order specified is not valid.
 	ErrCodeInvalidTTLOrder = "InvalidTTLOrder"
 
 	// ErrCodeInvalidTagging for service response error code
 	// "InvalidTagging".
 	//
-	// Tagging specified in the response body is not valid.
+	// The tagging specified is not valid.
 	ErrCodeInvalidTagging = "InvalidTagging"
 
 	// ErrCodeInvalidViewerCertificate for service response error code
 	// "InvalidViewerCertificate".
 	//
-	// A viewer certificate specified in the response body is not valid.
+	// A viewer certificate specified is not valid.
 	ErrCodeInvalidViewerCertificate = "InvalidViewerCertificate"
 
 	// ErrCodeInvalidWebACLId for service response error code
 	// "InvalidWebACLId".
 	//
-	// A web ACL id specified in the response body is not valid.
+	// A web ACL ID specified is not valid. To specify a web ACL created using the
+	// latest version of WAF, use the ACL ARN, for example arn:aws:wafv2:us-east-1:123456789012:global/webacl/ExampleWebACL/473e64fd-f30b-4765-81a0-62ad96dd167a.
+	// To specify a web ACL created using WAF Classic, use the ACL ID, for example
+	// 473e64fd-f30b-4765-81a0-62ad96dd167a.
 	ErrCodeInvalidWebACLId = "InvalidWebACLId"
 
+	// ErrCodeKeyGroupAlreadyExists for service response error code
+	// "KeyGroupAlreadyExists".
+	//
+	// A key group with this name already exists. You must provide a unique name.
+	// To modify an existing key group, use UpdateKeyGroup.
+	ErrCodeKeyGroupAlreadyExists = "KeyGroupAlreadyExists"
+
 	// ErrCodeMissingBody for service response error code
 	// "MissingBody".
 	//
@@ -244,12 +334,30 @@ const (
 	// header is set.
 	ErrCodeMissingBody = "MissingBody"
 
+	// ErrCodeMonitoringSubscriptionAlreadyExists for service response error code
+	// "MonitoringSubscriptionAlreadyExists".
+	//
+	// A monitoring subscription already exists for the specified distribution.
+	ErrCodeMonitoringSubscriptionAlreadyExists = "MonitoringSubscriptionAlreadyExists"
+
+	// ErrCodeNoSuchCachePolicy for service response error code
+	// "NoSuchCachePolicy".
+	//
+	// The cache policy does not exist.
+	ErrCodeNoSuchCachePolicy = "NoSuchCachePolicy"
+
 	// ErrCodeNoSuchCloudFrontOriginAccessIdentity for service response error code
 	// "NoSuchCloudFrontOriginAccessIdentity".
 	//
 	// The specified origin access identity does not exist.
 	ErrCodeNoSuchCloudFrontOriginAccessIdentity = "NoSuchCloudFrontOriginAccessIdentity"
 
+	// ErrCodeNoSuchContinuousDeploymentPolicy for service response error code
+	// "NoSuchContinuousDeploymentPolicy".
+	//
+	// The continuous deployment policy doesn't exist.
+	ErrCodeNoSuchContinuousDeploymentPolicy = "NoSuchContinuousDeploymentPolicy"
+
 	// ErrCodeNoSuchDistribution for service response error code
 	// "NoSuchDistribution".
 	//
@@ -268,36 +376,85 @@ const (
 	// The specified profile for field-level encryption doesn't exist.
 	ErrCodeNoSuchFieldLevelEncryptionProfile = "NoSuchFieldLevelEncryptionProfile"
 
+	// ErrCodeNoSuchFunctionExists for service response error code
+	// "NoSuchFunctionExists".
+	//
+	// The function does not exist.
+	ErrCodeNoSuchFunctionExists = "NoSuchFunctionExists"
+
 	// ErrCodeNoSuchInvalidation for service response error code
 	// "NoSuchInvalidation".
 	//
 	// The specified invalidation does not exist.
 	ErrCodeNoSuchInvalidation = "NoSuchInvalidation"
 
+	// ErrCodeNoSuchMonitoringSubscription for service response error code
+	// "NoSuchMonitoringSubscription".
+	//
+	// A monitoring subscription does not exist for the specified distribution.
+	ErrCodeNoSuchMonitoringSubscription = "NoSuchMonitoringSubscription"
+
 	// ErrCodeNoSuchOrigin for service response error code
 	// "NoSuchOrigin".
 	//
 	// No origin exists with the specified Origin Id.
 	ErrCodeNoSuchOrigin = "NoSuchOrigin"
 
+	// ErrCodeNoSuchOriginAccessControl for service response error code
+	// "NoSuchOriginAccessControl".
+	//
+	// The origin access control does not exist.
+	ErrCodeNoSuchOriginAccessControl = "NoSuchOriginAccessControl"
+
+	// ErrCodeNoSuchOriginRequestPolicy for service response error code
+	// "NoSuchOriginRequestPolicy".
+	//
+	// The origin request policy does not exist.
+	ErrCodeNoSuchOriginRequestPolicy = "NoSuchOriginRequestPolicy"
+
 	// ErrCodeNoSuchPublicKey for service response error code
 	// "NoSuchPublicKey".
 	//
 	// The specified public key doesn't exist.
 	ErrCodeNoSuchPublicKey = "NoSuchPublicKey"
 
+	// ErrCodeNoSuchRealtimeLogConfig for service response error code
+	// "NoSuchRealtimeLogConfig".
+	//
+	// The real-time log configuration does not exist.
+	ErrCodeNoSuchRealtimeLogConfig = "NoSuchRealtimeLogConfig"
+
 	// ErrCodeNoSuchResource for service response error code
 	// "NoSuchResource".
 	//
 	// A resource that was specified is not valid.
 	ErrCodeNoSuchResource = "NoSuchResource"
 
+	// ErrCodeNoSuchResponseHeadersPolicy for service response error code
+	// "NoSuchResponseHeadersPolicy".
+	//
+	// The response headers policy does not exist.
+	ErrCodeNoSuchResponseHeadersPolicy = "NoSuchResponseHeadersPolicy"
+
 	// ErrCodeNoSuchStreamingDistribution for service response error code
 	// "NoSuchStreamingDistribution".
 	//
 	// The specified streaming distribution does not exist.
 	ErrCodeNoSuchStreamingDistribution = "NoSuchStreamingDistribution"
 
+	// ErrCodeOriginAccessControlAlreadyExists for service response error code
+	// "OriginAccessControlAlreadyExists".
+	//
+	// An origin access control with the specified parameters already exists.
+	ErrCodeOriginAccessControlAlreadyExists = "OriginAccessControlAlreadyExists"
+
+	// ErrCodeOriginAccessControlInUse for service response error code
+	// "OriginAccessControlInUse".
+	//
+	// Cannot delete the origin access control because it's in use by one or more
+	// distributions.
+	ErrCodeOriginAccessControlInUse = "OriginAccessControlInUse"
+
 	// ErrCodeOriginAccessIdentityAlreadyExists for service response error code
 	// "CloudFrontOriginAccessIdentityAlreadyExists".
 	//
@@ -313,11 +470,24 @@ const (
 	// The Origin Access Identity specified is already in use.
 	ErrCodeOriginAccessIdentityInUse = "CloudFrontOriginAccessIdentityInUse"
 
+	// ErrCodeOriginRequestPolicyAlreadyExists for service response error code
+	// "OriginRequestPolicyAlreadyExists".
+	//
+	// An origin request policy with this name already exists. You must provide
+	// a unique name. To modify an existing origin request policy, use UpdateOriginRequestPolicy.
+	ErrCodeOriginRequestPolicyAlreadyExists = "OriginRequestPolicyAlreadyExists"
+
+	// ErrCodeOriginRequestPolicyInUse for service response error code
+	// "OriginRequestPolicyInUse".
+	//
+	// Cannot delete the origin request policy because it is attached to one or
+	// more cache behaviors.
+	ErrCodeOriginRequestPolicyInUse = "OriginRequestPolicyInUse"
+
 	// ErrCodePreconditionFailed for service response error code
 	// "PreconditionFailed".
 	//
-	// The precondition given in one or more of the request-header fields evaluated
-	// to false.
+	// The precondition in one or more of the request fields evaluated to false.
 	ErrCodePreconditionFailed = "PreconditionFailed"
 
 	// ErrCodePublicKeyAlreadyExists for service response error code
@@ -338,6 +508,53 @@ const (
 	// No profile specified for the field-level encryption query argument.
 	ErrCodeQueryArgProfileEmpty = "QueryArgProfileEmpty"
 
+	// ErrCodeRealtimeLogConfigAlreadyExists for service response error code
+	// "RealtimeLogConfigAlreadyExists".
+	//
+	// A real-time log configuration with this name already exists. You must provide
+	// a unique name. To modify an existing real-time log configuration, use UpdateRealtimeLogConfig.
+	ErrCodeRealtimeLogConfigAlreadyExists = "RealtimeLogConfigAlreadyExists"
+
+	// ErrCodeRealtimeLogConfigInUse for service response error code
+	// "RealtimeLogConfigInUse".
+	//
+	// Cannot delete the real-time log configuration because it is attached to one
+	// or more cache behaviors.
+	ErrCodeRealtimeLogConfigInUse = "RealtimeLogConfigInUse"
+
+	// ErrCodeRealtimeLogConfigOwnerMismatch for service response error code
+	// "RealtimeLogConfigOwnerMismatch".
+	//
+	// The specified real-time log configuration belongs to a different Amazon Web
+	// Services account.
+	ErrCodeRealtimeLogConfigOwnerMismatch = "RealtimeLogConfigOwnerMismatch"
+
+	// ErrCodeResourceInUse for service response error code
+	// "ResourceInUse".
+	//
+	// Cannot delete this resource because it is in use.
+	ErrCodeResourceInUse = "ResourceInUse"
+
+	// ErrCodeResponseHeadersPolicyAlreadyExists for service response error code
+	// "ResponseHeadersPolicyAlreadyExists".
+	//
+	// A response headers policy with this name already exists. You must provide
+	// a unique name. To modify an existing response headers policy, use UpdateResponseHeadersPolicy.
+	ErrCodeResponseHeadersPolicyAlreadyExists = "ResponseHeadersPolicyAlreadyExists"
+
+	// ErrCodeResponseHeadersPolicyInUse for service response error code
+	// "ResponseHeadersPolicyInUse".
+	//
+	// Cannot delete the response headers policy because it is attached to one or
+	// more cache behaviors in a CloudFront distribution.
+	ErrCodeResponseHeadersPolicyInUse = "ResponseHeadersPolicyInUse"
+
+	// ErrCodeStagingDistributionInUse for service response error code
+	// "StagingDistributionInUse".
+	//
+	// A continuous deployment policy for this staging distribution already exists.
+	ErrCodeStagingDistributionInUse = "StagingDistributionInUse"
+
 	// ErrCodeStreamingDistributionAlreadyExists for service response error code
 	// "StreamingDistributionAlreadyExists".
 	//
@@ -352,12 +569,36 @@ const (
 	// distribution before you can delete it.
 	ErrCodeStreamingDistributionNotDisabled = "StreamingDistributionNotDisabled"
 
+	// ErrCodeTestFunctionFailed for service response error code
+	// "TestFunctionFailed".
+	//
+	// The CloudFront function failed.
+	ErrCodeTestFunctionFailed = "TestFunctionFailed"
+
+	// ErrCodeTooLongCSPInResponseHeadersPolicy for service response error code
+	// "TooLongCSPInResponseHeadersPolicy".
+	//
+	// The length of the Content-Security-Policy header value in the response headers
+	// policy exceeds the maximum.
+	//
+	// For more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooLongCSPInResponseHeadersPolicy = "TooLongCSPInResponseHeadersPolicy"
+
 	// ErrCodeTooManyCacheBehaviors for service response error code
 	// "TooManyCacheBehaviors".
 	//
 	// You cannot create more cache behaviors for the distribution.
 	ErrCodeTooManyCacheBehaviors = "TooManyCacheBehaviors"
 
+	// ErrCodeTooManyCachePolicies for service response error code
+	// "TooManyCachePolicies".
+	//
+	// You have reached the maximum number of cache policies for this Amazon Web
+	// Services account. For more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyCachePolicies = "TooManyCachePolicies"
+
 	// ErrCodeTooManyCertificates for service response error code
 	// "TooManyCertificates".
 	//
@@ -371,6 +612,13 @@ const (
 	// access identities allowed.
 	ErrCodeTooManyCloudFrontOriginAccessIdentities = "TooManyCloudFrontOriginAccessIdentities"
 
+	// ErrCodeTooManyContinuousDeploymentPolicies for service response error code
+	// "TooManyContinuousDeploymentPolicies".
+	//
+	// You have reached the maximum number of continuous deployment policies for
+	// this Amazon Web Services account.
+	ErrCodeTooManyContinuousDeploymentPolicies = "TooManyContinuousDeploymentPolicies"
+
 	// ErrCodeTooManyCookieNamesInWhiteList for service response error code
 	// "TooManyCookieNamesInWhiteList".
 	//
@@ -378,6 +626,31 @@ const (
 	// per cache behavior.
 	ErrCodeTooManyCookieNamesInWhiteList = "TooManyCookieNamesInWhiteList"
 
+	// ErrCodeTooManyCookiesInCachePolicy for service response error code
+	// "TooManyCookiesInCachePolicy".
+	//
+	// The number of cookies in the cache policy exceeds the maximum. For more information,
+	// see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyCookiesInCachePolicy = "TooManyCookiesInCachePolicy"
+
+	// ErrCodeTooManyCookiesInOriginRequestPolicy for service response error code
+	// "TooManyCookiesInOriginRequestPolicy".
+	//
+	// The number of cookies in the origin request policy exceeds the maximum. For
+	// more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyCookiesInOriginRequestPolicy = "TooManyCookiesInOriginRequestPolicy"
+
+	// ErrCodeTooManyCustomHeadersInResponseHeadersPolicy for service response error code
+	// "TooManyCustomHeadersInResponseHeadersPolicy".
+	//
+	// The number of custom headers in the response headers policy exceeds the maximum.
+	//
+	// For more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyCustomHeadersInResponseHeadersPolicy = "TooManyCustomHeadersInResponseHeadersPolicy"
+
 	// ErrCodeTooManyDistributionCNAMEs for service response error code
 	// "TooManyDistributionCNAMEs".
 	//
@@ -391,6 +664,14 @@ const (
 	// allowed.
 	ErrCodeTooManyDistributions = "TooManyDistributions"
 
+	// ErrCodeTooManyDistributionsAssociatedToCachePolicy for service response error code
+	// "TooManyDistributionsAssociatedToCachePolicy".
+	//
+	// The maximum number of distributions have been associated with the specified
+	// cache policy. For more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyDistributionsAssociatedToCachePolicy = "TooManyDistributionsAssociatedToCachePolicy"
+
 	// ErrCodeTooManyDistributionsAssociatedToFieldLevelEncryptionConfig for service response error code
 	// "TooManyDistributionsAssociatedToFieldLevelEncryptionConfig".
 	//
@@ -398,13 +679,64 @@ const (
 	// configuration for field-level encryption.
 	ErrCodeTooManyDistributionsAssociatedToFieldLevelEncryptionConfig = "TooManyDistributionsAssociatedToFieldLevelEncryptionConfig"
 
+	// ErrCodeTooManyDistributionsAssociatedToKeyGroup for service response error code
+	// "TooManyDistributionsAssociatedToKeyGroup".
+	//
+	// The number of distributions that reference this key group is more than the
+	// maximum allowed. For more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyDistributionsAssociatedToKeyGroup = "TooManyDistributionsAssociatedToKeyGroup"
+
+	// ErrCodeTooManyDistributionsAssociatedToOriginAccessControl for service response error code
+	// "TooManyDistributionsAssociatedToOriginAccessControl".
+	//
+	// The maximum number of distributions have been associated with the specified
+	// origin access control.
+	//
+	// For more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyDistributionsAssociatedToOriginAccessControl = "TooManyDistributionsAssociatedToOriginAccessControl"
+
+	// ErrCodeTooManyDistributionsAssociatedToOriginRequestPolicy for service response error code
+	// "TooManyDistributionsAssociatedToOriginRequestPolicy".
+	//
+	// The maximum number of distributions have been associated with the specified
+	// origin request policy. For more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyDistributionsAssociatedToOriginRequestPolicy = "TooManyDistributionsAssociatedToOriginRequestPolicy"
+
+	// ErrCodeTooManyDistributionsAssociatedToResponseHeadersPolicy for service response error code
+	// "TooManyDistributionsAssociatedToResponseHeadersPolicy".
+	//
+	// The maximum number of distributions have been associated with the specified
+	// response headers policy.
+	//
+	// For more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyDistributionsAssociatedToResponseHeadersPolicy = "TooManyDistributionsAssociatedToResponseHeadersPolicy"
+
+	// ErrCodeTooManyDistributionsWithFunctionAssociations for service response error code
+	// "TooManyDistributionsWithFunctionAssociations".
+	//
+	// You have reached the maximum number of distributions that are associated
+	// with a CloudFront function. For more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyDistributionsWithFunctionAssociations = "TooManyDistributionsWithFunctionAssociations"
+
 	// ErrCodeTooManyDistributionsWithLambdaAssociations for service response error code
 	// "TooManyDistributionsWithLambdaAssociations".
 	//
 	// Processing your request would cause the maximum number of distributions with
-	// Lambda function associations per owner to be exceeded.
+	// Lambda@Edge function associations per owner to be exceeded.
 	ErrCodeTooManyDistributionsWithLambdaAssociations = "TooManyDistributionsWithLambdaAssociations"
 
+	// ErrCodeTooManyDistributionsWithSingleFunctionARN for service response error code
+	// "TooManyDistributionsWithSingleFunctionARN".
+	//
+	// The maximum number of distributions have been associated with the specified
+	// Lambda@Edge function.
+	ErrCodeTooManyDistributionsWithSingleFunctionARN = "TooManyDistributionsWithSingleFunctionARN"
+
 	// ErrCodeTooManyFieldLevelEncryptionConfigs for service response error code
 	// "TooManyFieldLevelEncryptionConfigs".
 	//
@@ -446,12 +778,44 @@ const (
 	// been created.
 	ErrCodeTooManyFieldLevelEncryptionQueryArgProfiles = "TooManyFieldLevelEncryptionQueryArgProfiles"
 
+	// ErrCodeTooManyFunctionAssociations for service response error code
+	// "TooManyFunctionAssociations".
+	//
+	// You have reached the maximum number of CloudFront function associations for
+	// this distribution. For more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyFunctionAssociations = "TooManyFunctionAssociations"
+
+	// ErrCodeTooManyFunctions for service response error code
+	// "TooManyFunctions".
+	//
+	// You have reached the maximum number of CloudFront functions for this Amazon
+	// Web Services account. For more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyFunctions = "TooManyFunctions"
+
+	// ErrCodeTooManyHeadersInCachePolicy for service response error code
+	// "TooManyHeadersInCachePolicy".
+	//
+	// The number of headers in the cache policy exceeds the maximum. For more information,
+	// see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyHeadersInCachePolicy = "TooManyHeadersInCachePolicy"
+
 	// ErrCodeTooManyHeadersInForwardedValues for service response error code
 	// "TooManyHeadersInForwardedValues".
 	//
 	// Your request contains too many headers in forwarded values.
 	ErrCodeTooManyHeadersInForwardedValues = "TooManyHeadersInForwardedValues"
 
+	// ErrCodeTooManyHeadersInOriginRequestPolicy for service response error code
+	// "TooManyHeadersInOriginRequestPolicy".
+	//
+	// The number of headers in the origin request policy exceeds the maximum. For
+	// more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyHeadersInOriginRequestPolicy = "TooManyHeadersInOriginRequestPolicy"
+
 	// ErrCodeTooManyInvalidationsInProgress for service response error code
 	// "TooManyInvalidationsInProgress".
 	//
@@ -459,13 +823,39 @@ const (
 	// batch requests, or invalidation objects.
 	ErrCodeTooManyInvalidationsInProgress = "TooManyInvalidationsInProgress"
 
+	// ErrCodeTooManyKeyGroups for service response error code
+	// "TooManyKeyGroups".
+	//
+	// You have reached the maximum number of key groups for this Amazon Web Services
+	// account. For more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyKeyGroups = "TooManyKeyGroups"
+
+	// ErrCodeTooManyKeyGroupsAssociatedToDistribution for service response error code
+	// "TooManyKeyGroupsAssociatedToDistribution".
+	//
+	// The number of key groups referenced by this distribution is more than the
+	// maximum allowed. For more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyKeyGroupsAssociatedToDistribution = "TooManyKeyGroupsAssociatedToDistribution"
+
 	// ErrCodeTooManyLambdaFunctionAssociations for service response error code
 	// "TooManyLambdaFunctionAssociations".
 	//
-	// Your request contains more Lambda function associations than are allowed
+	// Your request contains more Lambda@Edge function associations than are allowed
 	// per distribution.
 	ErrCodeTooManyLambdaFunctionAssociations = "TooManyLambdaFunctionAssociations"
 
+	// ErrCodeTooManyOriginAccessControls for service response error code
+	// "TooManyOriginAccessControls".
+	//
+	// The number of origin access controls in your Amazon Web Services account
+	// exceeds the maximum allowed.
+	//
+	// For more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyOriginAccessControls = "TooManyOriginAccessControls"
+
 	// ErrCodeTooManyOriginCustomHeaders for service response error code
 	// "TooManyOriginCustomHeaders".
 	//
@@ -479,6 +869,14 @@ const (
 	// groups allowed.
 	ErrCodeTooManyOriginGroupsPerDistribution = "TooManyOriginGroupsPerDistribution"
 
+	// ErrCodeTooManyOriginRequestPolicies for service response error code
+	// "TooManyOriginRequestPolicies".
+	//
+	// You have reached the maximum number of origin request policies for this Amazon
+	// Web Services account. For more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyOriginRequestPolicies = "TooManyOriginRequestPolicies"
+
 	// ErrCodeTooManyOrigins for service response error code
 	// "TooManyOrigins".
 	//
@@ -492,12 +890,64 @@ const (
 	// To create a new public key, delete one of the existing keys.
 	ErrCodeTooManyPublicKeys = "TooManyPublicKeys"
 
+	// ErrCodeTooManyPublicKeysInKeyGroup for service response error code
+	// "TooManyPublicKeysInKeyGroup".
+	//
+	// The number of public keys in this key group is more than the maximum allowed.
+	// For more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyPublicKeysInKeyGroup = "TooManyPublicKeysInKeyGroup"
+
 	// ErrCodeTooManyQueryStringParameters for service response error code
 	// "TooManyQueryStringParameters".
 	//
 	// Your request contains too many query string parameters.
 	ErrCodeTooManyQueryStringParameters = "TooManyQueryStringParameters"
 
+	// ErrCodeTooManyQueryStringsInCachePolicy for service response error code
+	// "TooManyQueryStringsInCachePolicy".
+	//
+	// The number of query strings in the cache policy exceeds the maximum. For
+	// more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyQueryStringsInCachePolicy = "TooManyQueryStringsInCachePolicy"
+
+	// ErrCodeTooManyQueryStringsInOriginRequestPolicy for service response error code
+	// "TooManyQueryStringsInOriginRequestPolicy".
+	//
+	// The number of query strings in the origin request policy exceeds the maximum.
+	// For more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyQueryStringsInOriginRequestPolicy = "TooManyQueryStringsInOriginRequestPolicy"
+
+	// ErrCodeTooManyRealtimeLogConfigs for service response error code
+	// "TooManyRealtimeLogConfigs".
+	//
+	// You have reached the maximum number of real-time log configurations for this
+	// Amazon Web Services account. For more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyRealtimeLogConfigs = "TooManyRealtimeLogConfigs"
+
+	// ErrCodeTooManyRemoveHeadersInResponseHeadersPolicy for service response error code
+	// "TooManyRemoveHeadersInResponseHeadersPolicy".
+	//
+	// The number of headers in RemoveHeadersConfig in the response headers policy
+	// exceeds the maximum.
+	//
+	// For more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyRemoveHeadersInResponseHeadersPolicy = "TooManyRemoveHeadersInResponseHeadersPolicy"
+
+	// ErrCodeTooManyResponseHeadersPolicies for service response error code
+	// "TooManyResponseHeadersPolicies".
+	//
+	// You have reached the maximum number of response headers policies for this
+	// Amazon Web Services account.
+	//
+	// For more information, see Quotas (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html)
+	// (formerly known as limits) in the Amazon CloudFront Developer Guide.
+	ErrCodeTooManyResponseHeadersPolicies = "TooManyResponseHeadersPolicies"
+
 	// ErrCodeTooManyStreamingDistributionCNAMEs for service response error code
 	// "TooManyStreamingDistributionCNAMEs".
 	//
@@ -517,9 +967,21 @@ const (
 	// Your request contains more trusted signers than are allowed per distribution.
 	ErrCodeTooManyTrustedSigners = "TooManyTrustedSigners"
 
+	// ErrCodeTrustedKeyGroupDoesNotExist for service response error code
+	// "TrustedKeyGroupDoesNotExist".
+	//
+	// The specified key group does not exist.
+	ErrCodeTrustedKeyGroupDoesNotExist = "TrustedKeyGroupDoesNotExist"
+
 	// ErrCodeTrustedSignerDoesNotExist for service response error code
 	// "TrustedSignerDoesNotExist".
 	//
 	// One or more of your trusted signers don't exist.
 	ErrCodeTrustedSignerDoesNotExist = "TrustedSignerDoesNotExist"
+
+	// ErrCodeUnsupportedOperation for service response error code
+	// "UnsupportedOperation".
+	//
+	// This operation is not supported in this region.
+	ErrCodeUnsupportedOperation = "UnsupportedOperation"
 )
@@ -29,14 +29,13 @@ const opCopyBackupToRegion = "CopyBackupToRegion"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CopyBackupToRegionRequest method.
+//	req, resp := client.CopyBackupToRegionRequest(params)
 //
-//    // Example sending a request using the CopyBackupToRegionRequest method.
-//    req, resp := client.CopyBackupToRegionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/CopyBackupToRegion
 func (c *CloudHSMV2) CopyBackupToRegionRequest(input *CopyBackupToRegionInput) (req *request.Request, output *CopyBackupToRegionOutput) {
@@ -66,23 +65,28 @@ func (c *CloudHSMV2) CopyBackupToRegionRequest(input *CopyBackupToRegionInput) (
 // See the AWS API reference guide for AWS CloudHSM V2's
 // API operation CopyBackupToRegion for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeCloudHsmInternalFailureException "CloudHsmInternalFailureException"
-//   The request was rejected because of an AWS CloudHSM internal failure. The
-//   request can be retried.
+// Returned Error Types:
+//
+//   - CloudHsmAccessDeniedException
+//     The request was rejected because the requester does not have permission to
+//     perform the requested operation.
+//
+//   - CloudHsmInternalFailureException
+//     The request was rejected because of an AWS CloudHSM internal failure. The
+//     request can be retried.
 //
-//   * ErrCodeCloudHsmServiceException "CloudHsmServiceException"
-//   The request was rejected because an error occurred.
+//   - CloudHsmInvalidRequestException
+//     The request was rejected because it is not a valid request.
 //
-//   * ErrCodeCloudHsmResourceNotFoundException "CloudHsmResourceNotFoundException"
-//   The request was rejected because it refers to a resource that cannot be found.
+//   - CloudHsmResourceNotFoundException
+//     The request was rejected because it refers to a resource that cannot be found.
 //
-//   * ErrCodeCloudHsmInvalidRequestException "CloudHsmInvalidRequestException"
-//   The request was rejected because it is not a valid request.
+//   - CloudHsmServiceException
+//     The request was rejected because an error occurred.
 //
-//   * ErrCodeCloudHsmAccessDeniedException "CloudHsmAccessDeniedException"
-//   The request was rejected because the requester does not have permission to
-//   perform the requested operation.
+//   - CloudHsmTagException
+//     The request was rejected because of a tagging failure. Verify the tag conditions
+//     in all applicable policies, and then retry the request.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/CopyBackupToRegion
 func (c *CloudHSMV2) CopyBackupToRegion(input *CopyBackupToRegionInput) (*CopyBackupToRegionOutput, error) {
@@ -122,14 +126,13 @@ const opCreateCluster = "CreateCluster"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateClusterRequest method.
+//	req, resp := client.CreateClusterRequest(params)
 //
-//    // Example sending a request using the CreateClusterRequest method.
-//    req, resp := client.CreateClusterRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/CreateCluster
 func (c *CloudHSMV2) CreateClusterRequest(input *CreateClusterInput) (req *request.Request, output *CreateClusterOutput) {
@@ -159,23 +162,28 @@ func (c *CloudHSMV2) CreateClusterRequest(input *CreateClusterInput) (req *reque
 // See the AWS API reference guide for AWS CloudHSM V2's
 // API operation CreateCluster for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeCloudHsmInternalFailureException "CloudHsmInternalFailureException"
-//   The request was rejected because of an AWS CloudHSM internal failure. The
-//   request can be retried.
+// Returned Error Types:
+//
+//   - CloudHsmAccessDeniedException
+//     The request was rejected because the requester does not have permission to
+//     perform the requested operation.
+//
+//   - CloudHsmInternalFailureException
+//     The request was rejected because of an AWS CloudHSM internal failure. The
+//     request can be retried.
 //
-//   * ErrCodeCloudHsmServiceException "CloudHsmServiceException"
-//   The request was rejected because an error occurred.
+//   - CloudHsmInvalidRequestException
+//     The request was rejected because it is not a valid request.
 //
-//   * ErrCodeCloudHsmResourceNotFoundException "CloudHsmResourceNotFoundException"
-//   The request was rejected because it refers to a resource that cannot be found.
+//   - CloudHsmResourceNotFoundException
+//     The request was rejected because it refers to a resource that cannot be found.
 //
-//   * ErrCodeCloudHsmInvalidRequestException "CloudHsmInvalidRequestException"
-//   The request was rejected because it is not a valid request.
+//   - CloudHsmServiceException
+//     The request was rejected because an error occurred.
 //
-//   * ErrCodeCloudHsmAccessDeniedException "CloudHsmAccessDeniedException"
-//   The request was rejected because the requester does not have permission to
-//   perform the requested operation.
+//   - CloudHsmTagException
+//     The request was rejected because of a tagging failure. Verify the tag conditions
+//     in all applicable policies, and then retry the request.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/CreateCluster
 func (c *CloudHSMV2) CreateCluster(input *CreateClusterInput) (*CreateClusterOutput, error) {
@@ -215,14 +223,13 @@ const opCreateHsm = "CreateHsm"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateHsmRequest method.
+//	req, resp := client.CreateHsmRequest(params)
 //
-//    // Example sending a request using the CreateHsmRequest method.
-//    req, resp := client.CreateHsmRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/CreateHsm
 func (c *CloudHSMV2) CreateHsmRequest(input *CreateHsmInput) (req *request.Request, output *CreateHsmOutput) {
@@ -253,23 +260,24 @@ func (c *CloudHSMV2) CreateHsmRequest(input *CreateHsmInput) (req *request.Reque
 // See the AWS API reference guide for AWS CloudHSM V2's
 // API operation CreateHsm for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeCloudHsmInternalFailureException "CloudHsmInternalFailureException"
-//   The request was rejected because of an AWS CloudHSM internal failure. The
-//   request can be retried.
+// Returned Error Types:
+//
+//   - CloudHsmInternalFailureException
+//     The request was rejected because of an AWS CloudHSM internal failure. The
+//     request can be retried.
 //
-//   * ErrCodeCloudHsmServiceException "CloudHsmServiceException"
-//   The request was rejected because an error occurred.
+//   - CloudHsmServiceException
+//     The request was rejected because an error occurred.
 //
-//   * ErrCodeCloudHsmInvalidRequestException "CloudHsmInvalidRequestException"
-//   The request was rejected because it is not a valid request.
+//   - CloudHsmInvalidRequestException
+//     The request was rejected because it is not a valid request.
 //
-//   * ErrCodeCloudHsmResourceNotFoundException "CloudHsmResourceNotFoundException"
-//   The request was rejected because it refers to a resource that cannot be found.
+//   - CloudHsmResourceNotFoundException
+//     The request was rejected because it refers to a resource that cannot be found.
 //
-//   * ErrCodeCloudHsmAccessDeniedException "CloudHsmAccessDeniedException"
-//   The request was rejected because the requester does not have permission to
-//   perform the requested operation.
+//   - CloudHsmAccessDeniedException
+//     The request was rejected because the requester does not have permission to
+//     perform the requested operation.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/CreateHsm
 func (c *CloudHSMV2) CreateHsm(input *CreateHsmInput) (*CreateHsmOutput, error) {
@@ -309,14 +317,13 @@ const opDeleteBackup = "DeleteBackup"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteBackupRequest method.
+//	req, resp := client.DeleteBackupRequest(params)
 //
-//    // Example sending a request using the DeleteBackupRequest method.
-//    req, resp := client.DeleteBackupRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/DeleteBackup
 func (c *CloudHSMV2) DeleteBackupRequest(input *DeleteBackupInput) (req *request.Request, output *DeleteBackupOutput) {
@@ -338,8 +345,8 @@ func (c *CloudHSMV2) DeleteBackupRequest(input *DeleteBackupInput) (req *request
 // DeleteBackup API operation for AWS CloudHSM V2.
 //
 // Deletes a specified AWS CloudHSM backup. A backup can be restored up to 7
-// days after the DeleteBackup request. For more information on restoring a
-// backup, see RestoreBackup
+// days after the DeleteBackup request is made. For more information on restoring
+// a backup, see RestoreBackup.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -348,23 +355,24 @@ func (c *CloudHSMV2) DeleteBackupRequest(input *DeleteBackupInput) (req *request
 // See the AWS API reference guide for AWS CloudHSM V2's
 // API operation DeleteBackup for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeCloudHsmInternalFailureException "CloudHsmInternalFailureException"
-//   The request was rejected because of an AWS CloudHSM internal failure. The
-//   request can be retried.
+// Returned Error Types:
 //
-//   * ErrCodeCloudHsmServiceException "CloudHsmServiceException"
-//   The request was rejected because an error occurred.
+//   - CloudHsmAccessDeniedException
+//     The request was rejected because the requester does not have permission to
+//     perform the requested operation.
 //
-//   * ErrCodeCloudHsmResourceNotFoundException "CloudHsmResourceNotFoundException"
-//   The request was rejected because it refers to a resource that cannot be found.
+//   - CloudHsmInternalFailureException
+//     The request was rejected because of an AWS CloudHSM internal failure. The
+//     request can be retried.
 //
-//   * ErrCodeCloudHsmInvalidRequestException "CloudHsmInvalidRequestException"
-//   The request was rejected because it is not a valid request.
+//   - CloudHsmInvalidRequestException
+//     The request was rejected because it is not a valid request.
 //
-//   * ErrCodeCloudHsmAccessDeniedException "CloudHsmAccessDeniedException"
-//   The request was rejected because the requester does not have permission to
-//   perform the requested operation.
+//   - CloudHsmResourceNotFoundException
+//     The request was rejected because it refers to a resource that cannot be found.
+//
+//   - CloudHsmServiceException
+//     The request was rejected because an error occurred.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/DeleteBackup
 func (c *CloudHSMV2) DeleteBackup(input *DeleteBackupInput) (*DeleteBackupOutput, error) {
@@ -404,14 +412,13 @@ const opDeleteCluster = "DeleteCluster"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteClusterRequest method.
+//	req, resp := client.DeleteClusterRequest(params)
 //
-//    // Example sending a request using the DeleteClusterRequest method.
-//    req, resp := client.DeleteClusterRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/DeleteCluster
 func (c *CloudHSMV2) DeleteClusterRequest(input *DeleteClusterInput) (req *request.Request, output *DeleteClusterOutput) {
@@ -443,23 +450,28 @@ func (c *CloudHSMV2) DeleteClusterRequest(input *DeleteClusterInput) (req *reque
 // See the AWS API reference guide for AWS CloudHSM V2's
 // API operation DeleteCluster for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeCloudHsmInternalFailureException "CloudHsmInternalFailureException"
-//   The request was rejected because of an AWS CloudHSM internal failure. The
-//   request can be retried.
+// Returned Error Types:
+//
+//   - CloudHsmAccessDeniedException
+//     The request was rejected because the requester does not have permission to
+//     perform the requested operation.
 //
-//   * ErrCodeCloudHsmServiceException "CloudHsmServiceException"
-//   The request was rejected because an error occurred.
+//   - CloudHsmInternalFailureException
+//     The request was rejected because of an AWS CloudHSM internal failure. The
+//     request can be retried.
 //
-//   * ErrCodeCloudHsmResourceNotFoundException "CloudHsmResourceNotFoundException"
-//   The request was rejected because it refers to a resource that cannot be found.
+//   - CloudHsmInvalidRequestException
+//     The request was rejected because it is not a valid request.
 //
-//   * ErrCodeCloudHsmInvalidRequestException "CloudHsmInvalidRequestException"
-//   The request was rejected because it is not a valid request.
+//   - CloudHsmResourceNotFoundException
+//     The request was rejected because it refers to a resource that cannot be found.
 //
-//   * ErrCodeCloudHsmAccessDeniedException "CloudHsmAccessDeniedException"
-//   The request was rejected because the requester does not have permission to
-//   perform the requested operation.
+//   - CloudHsmServiceException
+//     The request was rejected because an error occurred.
+//
+//   - CloudHsmTagException
+//     The request was rejected because of a tagging failure. Verify the tag conditions
+//     in all applicable policies, and then retry the request.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/DeleteCluster
 func (c *CloudHSMV2) DeleteCluster(input *DeleteClusterInput) (*DeleteClusterOutput, error) {
@@ -499,14 +511,13 @@ const opDeleteHsm = "DeleteHsm"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteHsmRequest method.
+//	req, resp := client.DeleteHsmRequest(params)
 //
-//    // Example sending a request using the DeleteHsmRequest method.
-//    req, resp := client.DeleteHsmRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/DeleteHsm
 func (c *CloudHSMV2) DeleteHsmRequest(input *DeleteHsmInput) (req *request.Request, output *DeleteHsmOutput) {
@@ -539,23 +550,24 @@ func (c *CloudHSMV2) DeleteHsmRequest(input *DeleteHsmInput) (req *request.Reque
 // See the AWS API reference guide for AWS CloudHSM V2's
 // API operation DeleteHsm for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeCloudHsmInternalFailureException "CloudHsmInternalFailureException"
-//   The request was rejected because of an AWS CloudHSM internal failure. The
-//   request can be retried.
+// Returned Error Types:
+//
+//   - CloudHsmInternalFailureException
+//     The request was rejected because of an AWS CloudHSM internal failure. The
+//     request can be retried.
 //
-//   * ErrCodeCloudHsmServiceException "CloudHsmServiceException"
-//   The request was rejected because an error occurred.
+//   - CloudHsmServiceException
+//     The request was rejected because an error occurred.
 //
-//   * ErrCodeCloudHsmResourceNotFoundException "CloudHsmResourceNotFoundException"
-//   The request was rejected because it refers to a resource that cannot be found.
+//   - CloudHsmResourceNotFoundException
+//     The request was rejected because it refers to a resource that cannot be found.
 //
-//   * ErrCodeCloudHsmInvalidRequestException "CloudHsmInvalidRequestException"
-//   The request was rejected because it is not a valid request.
+//   - CloudHsmInvalidRequestException
+//     The request was rejected because it is not a valid request.
 //
-//   * ErrCodeCloudHsmAccessDeniedException "CloudHsmAccessDeniedException"
-//   The request was rejected because the requester does not have permission to
-//   perform the requested operation.
+//   - CloudHsmAccessDeniedException
+//     The request was rejected because the requester does not have permission to
+//     perform the requested operation.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/DeleteHsm
 func (c *CloudHSMV2) DeleteHsm(input *DeleteHsmInput) (*DeleteHsmOutput, error) {
@@ -595,14 +607,13 @@ const opDescribeBackups = "DescribeBackups"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeBackupsRequest method.
+//	req, resp := client.DescribeBackupsRequest(params)
 //
-//    // Example sending a request using the DescribeBackupsRequest method.
-//    req, resp := client.DescribeBackupsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/DescribeBackups
 func (c *CloudHSMV2) DescribeBackupsRequest(input *DescribeBackupsInput) (req *request.Request, output *DescribeBackupsOutput) {
@@ -645,23 +656,28 @@ func (c *CloudHSMV2) DescribeBackupsRequest(input *DescribeBackupsInput) (req *r
 // See the AWS API reference guide for AWS CloudHSM V2's
 // API operation DescribeBackups for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeCloudHsmInternalFailureException "CloudHsmInternalFailureException"
-//   The request was rejected because of an AWS CloudHSM internal failure. The
-//   request can be retried.
+// Returned Error Types:
+//
+//   - CloudHsmAccessDeniedException
+//     The request was rejected because the requester does not have permission to
+//     perform the requested operation.
+//
+//   - CloudHsmInternalFailureException
+//     The request was rejected because of an AWS CloudHSM internal failure. The
+//     request can be retried.
 //
-//   * ErrCodeCloudHsmServiceException "CloudHsmServiceException"
-//   The request was rejected because an error occurred.
+//   - CloudHsmInvalidRequestException
+//     The request was rejected because it is not a valid request.
 //
-//   * ErrCodeCloudHsmResourceNotFoundException "CloudHsmResourceNotFoundException"
-//   The request was rejected because it refers to a resource that cannot be found.
+//   - CloudHsmResourceNotFoundException
+//     The request was rejected because it refers to a resource that cannot be found.
 //
-//   * ErrCodeCloudHsmInvalidRequestException "CloudHsmInvalidRequestException"
-//   The request was rejected because it is not a valid request.
+//   - CloudHsmServiceException
+//     The request was rejected because an error occurred.
 //
-//   * ErrCodeCloudHsmAccessDeniedException "CloudHsmAccessDeniedException"
-//   The request was rejected because the requester does not have permission to
-//   perform the requested operation.
+//   - CloudHsmTagException
+//     The request was rejected because of a tagging failure. Verify the tag conditions
+//     in all applicable policies, and then retry the request.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/DescribeBackups
 func (c *CloudHSMV2) DescribeBackups(input *DescribeBackupsInput) (*DescribeBackupsOutput, error) {
@@ -693,15 +709,14 @@ func (c *CloudHSMV2) DescribeBackupsWithContext(ctx aws.Context, input *Describe
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a DescribeBackups operation.
-//    pageNum := 0
-//    err := client.DescribeBackupsPages(params,
-//        func(page *cloudhsmv2.DescribeBackupsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a DescribeBackups operation.
+//	pageNum := 0
+//	err := client.DescribeBackupsPages(params,
+//	    func(page *cloudhsmv2.DescribeBackupsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *CloudHSMV2) DescribeBackupsPages(input *DescribeBackupsInput, fn func(*DescribeBackupsOutput, bool) bool) error {
 	return c.DescribeBackupsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -728,10 +743,12 @@ func (c *CloudHSMV2) DescribeBackupsPagesWithContext(ctx aws.Context, input *Des
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*DescribeBackupsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*DescribeBackupsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -751,14 +768,13 @@ const opDescribeClusters = "DescribeClusters"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeClustersRequest method.
+//	req, resp := client.DescribeClustersRequest(params)
 //
-//    // Example sending a request using the DescribeClustersRequest method.
-//    req, resp := client.DescribeClustersRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/DescribeClusters
 func (c *CloudHSMV2) DescribeClustersRequest(input *DescribeClustersInput) (req *request.Request, output *DescribeClustersOutput) {
@@ -801,20 +817,25 @@ func (c *CloudHSMV2) DescribeClustersRequest(input *DescribeClustersInput) (req
 // See the AWS API reference guide for AWS CloudHSM V2's
 // API operation DescribeClusters for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeCloudHsmInternalFailureException "CloudHsmInternalFailureException"
-//   The request was rejected because of an AWS CloudHSM internal failure. The
-//   request can be retried.
+// Returned Error Types:
+//
+//   - CloudHsmAccessDeniedException
+//     The request was rejected because the requester does not have permission to
+//     perform the requested operation.
+//
+//   - CloudHsmInternalFailureException
+//     The request was rejected because of an AWS CloudHSM internal failure. The
+//     request can be retried.
 //
-//   * ErrCodeCloudHsmServiceException "CloudHsmServiceException"
-//   The request was rejected because an error occurred.
+//   - CloudHsmInvalidRequestException
+//     The request was rejected because it is not a valid request.
 //
-//   * ErrCodeCloudHsmInvalidRequestException "CloudHsmInvalidRequestException"
-//   The request was rejected because it is not a valid request.
+//   - CloudHsmServiceException
+//     The request was rejected because an error occurred.
 //
-//   * ErrCodeCloudHsmAccessDeniedException "CloudHsmAccessDeniedException"
-//   The request was rejected because the requester does not have permission to
-//   perform the requested operation.
+//   - CloudHsmTagException
+//     The request was rejected because of a tagging failure. Verify the tag conditions
+//     in all applicable policies, and then retry the request.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/DescribeClusters
 func (c *CloudHSMV2) DescribeClusters(input *DescribeClustersInput) (*DescribeClustersOutput, error) {
@@ -846,15 +867,14 @@ func (c *CloudHSMV2) DescribeClustersWithContext(ctx aws.Context, input *Describ
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a DescribeClusters operation.
-//    pageNum := 0
-//    err := client.DescribeClustersPages(params,
-//        func(page *cloudhsmv2.DescribeClustersOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a DescribeClusters operation.
+//	pageNum := 0
+//	err := client.DescribeClustersPages(params,
+//	    func(page *cloudhsmv2.DescribeClustersOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *CloudHSMV2) DescribeClustersPages(input *DescribeClustersInput, fn func(*DescribeClustersOutput, bool) bool) error {
 	return c.DescribeClustersPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -881,10 +901,12 @@ func (c *CloudHSMV2) DescribeClustersPagesWithContext(ctx aws.Context, input *De
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*DescribeClustersOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*DescribeClustersOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -904,14 +926,13 @@ const opInitializeCluster = "InitializeCluster"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the InitializeClusterRequest method.
+//	req, resp := client.InitializeClusterRequest(params)
 //
-//    // Example sending a request using the InitializeClusterRequest method.
-//    req, resp := client.InitializeClusterRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/InitializeCluster
 func (c *CloudHSMV2) InitializeClusterRequest(input *InitializeClusterInput) (req *request.Request, output *InitializeClusterOutput) {
@@ -944,23 +965,24 @@ func (c *CloudHSMV2) InitializeClusterRequest(input *InitializeClusterInput) (re
 // See the AWS API reference guide for AWS CloudHSM V2's
 // API operation InitializeCluster for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeCloudHsmInternalFailureException "CloudHsmInternalFailureException"
-//   The request was rejected because of an AWS CloudHSM internal failure. The
-//   request can be retried.
+// Returned Error Types:
+//
+//   - CloudHsmAccessDeniedException
+//     The request was rejected because the requester does not have permission to
+//     perform the requested operation.
 //
-//   * ErrCodeCloudHsmServiceException "CloudHsmServiceException"
-//   The request was rejected because an error occurred.
+//   - CloudHsmInternalFailureException
+//     The request was rejected because of an AWS CloudHSM internal failure. The
+//     request can be retried.
 //
-//   * ErrCodeCloudHsmResourceNotFoundException "CloudHsmResourceNotFoundException"
-//   The request was rejected because it refers to a resource that cannot be found.
+//   - CloudHsmInvalidRequestException
+//     The request was rejected because it is not a valid request.
 //
-//   * ErrCodeCloudHsmInvalidRequestException "CloudHsmInvalidRequestException"
-//   The request was rejected because it is not a valid request.
+//   - CloudHsmResourceNotFoundException
+//     The request was rejected because it refers to a resource that cannot be found.
 //
-//   * ErrCodeCloudHsmAccessDeniedException "CloudHsmAccessDeniedException"
-//   The request was rejected because the requester does not have permission to
-//   perform the requested operation.
+//   - CloudHsmServiceException
+//     The request was rejected because an error occurred.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/InitializeCluster
 func (c *CloudHSMV2) InitializeCluster(input *InitializeClusterInput) (*InitializeClusterOutput, error) {
@@ -1000,14 +1022,13 @@ const opListTags = "ListTags"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTagsRequest method.
+//	req, resp := client.ListTagsRequest(params)
 //
-//    // Example sending a request using the ListTagsRequest method.
-//    req, resp := client.ListTagsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/ListTags
 func (c *CloudHSMV2) ListTagsRequest(input *ListTagsInput) (req *request.Request, output *ListTagsOutput) {
@@ -1049,23 +1070,28 @@ func (c *CloudHSMV2) ListTagsRequest(input *ListTagsInput) (req *request.Request
 // See the AWS API reference guide for AWS CloudHSM V2's
 // API operation ListTags for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeCloudHsmInternalFailureException "CloudHsmInternalFailureException"
-//   The request was rejected because of an AWS CloudHSM internal failure. The
-//   request can be retried.
+// Returned Error Types:
 //
-//   * ErrCodeCloudHsmServiceException "CloudHsmServiceException"
-//   The request was rejected because an error occurred.
+//   - CloudHsmAccessDeniedException
+//     The request was rejected because the requester does not have permission to
+//     perform the requested operation.
 //
-//   * ErrCodeCloudHsmResourceNotFoundException "CloudHsmResourceNotFoundException"
-//   The request was rejected because it refers to a resource that cannot be found.
+//   - CloudHsmInternalFailureException
+//     The request was rejected because of an AWS CloudHSM internal failure. The
+//     request can be retried.
 //
-//   * ErrCodeCloudHsmInvalidRequestException "CloudHsmInvalidRequestException"
-//   The request was rejected because it is not a valid request.
+//   - CloudHsmInvalidRequestException
+//     The request was rejected because it is not a valid request.
 //
-//   * ErrCodeCloudHsmAccessDeniedException "CloudHsmAccessDeniedException"
-//   The request was rejected because the requester does not have permission to
-//   perform the requested operation.
+//   - CloudHsmResourceNotFoundException
+//     The request was rejected because it refers to a resource that cannot be found.
+//
+//   - CloudHsmServiceException
+//     The request was rejected because an error occurred.
+//
+//   - CloudHsmTagException
+//     The request was rejected because of a tagging failure. Verify the tag conditions
+//     in all applicable policies, and then retry the request.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/ListTags
 func (c *CloudHSMV2) ListTags(input *ListTagsInput) (*ListTagsOutput, error) {
@@ -1097,15 +1123,14 @@ func (c *CloudHSMV2) ListTagsWithContext(ctx aws.Context, input *ListTagsInput,
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListTags operation.
-//    pageNum := 0
-//    err := client.ListTagsPages(params,
-//        func(page *cloudhsmv2.ListTagsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListTags operation.
+//	pageNum := 0
+//	err := client.ListTagsPages(params,
+//	    func(page *cloudhsmv2.ListTagsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *CloudHSMV2) ListTagsPages(input *ListTagsInput, fn func(*ListTagsOutput, bool) bool) error {
 	return c.ListTagsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1132,13 +1157,201 @@ func (c *CloudHSMV2) ListTagsPagesWithContext(ctx aws.Context, input *ListTagsIn
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListTagsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListTagsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
+const opModifyBackupAttributes = "ModifyBackupAttributes"
+
+// ModifyBackupAttributesRequest generates a "aws/request.Request" representing the
+// client's request for the ModifyBackupAttributes operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ModifyBackupAttributes for more information on using the ModifyBackupAttributes
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ModifyBackupAttributesRequest method.
+//	req, resp := client.ModifyBackupAttributesRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/ModifyBackupAttributes
+func (c *CloudHSMV2) ModifyBackupAttributesRequest(input *ModifyBackupAttributesInput) (req *request.Request, output *ModifyBackupAttributesOutput) {
+	op := &request.Operation{
+		Name:       opModifyBackupAttributes,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &ModifyBackupAttributesInput{}
+	}
+
+	output = &ModifyBackupAttributesOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ModifyBackupAttributes API operation for AWS CloudHSM V2.
+//
+// Modifies attributes for AWS CloudHSM backup.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CloudHSM V2's
+// API operation ModifyBackupAttributes for usage and error information.
+//
+// Returned Error Types:
+//
+//   - CloudHsmAccessDeniedException
+//     The request was rejected because the requester does not have permission to
+//     perform the requested operation.
+//
+//   - CloudHsmInternalFailureException
+//     The request was rejected because of an AWS CloudHSM internal failure. The
+//     request can be retried.
+//
+//   - CloudHsmInvalidRequestException
+//     The request was rejected because it is not a valid request.
+//
+//   - CloudHsmResourceNotFoundException
+//     The request was rejected because it refers to a resource that cannot be found.
+//
+//   - CloudHsmServiceException
+//     The request was rejected because an error occurred.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/ModifyBackupAttributes
+func (c *CloudHSMV2) ModifyBackupAttributes(input *ModifyBackupAttributesInput) (*ModifyBackupAttributesOutput, error) {
+	req, out := c.ModifyBackupAttributesRequest(input)
+	return out, req.Send()
+}
+
+// ModifyBackupAttributesWithContext is the same as ModifyBackupAttributes with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ModifyBackupAttributes for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CloudHSMV2) ModifyBackupAttributesWithContext(ctx aws.Context, input *ModifyBackupAttributesInput, opts ...request.Option) (*ModifyBackupAttributesOutput, error) {
+	req, out := c.ModifyBackupAttributesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opModifyCluster = "ModifyCluster"
+
+// ModifyClusterRequest generates a "aws/request.Request" representing the
+// client's request for the ModifyCluster operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ModifyCluster for more information on using the ModifyCluster
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ModifyClusterRequest method.
+//	req, resp := client.ModifyClusterRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/ModifyCluster
+func (c *CloudHSMV2) ModifyClusterRequest(input *ModifyClusterInput) (req *request.Request, output *ModifyClusterOutput) {
+	op := &request.Operation{
+		Name:       opModifyCluster,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &ModifyClusterInput{}
+	}
+
+	output = &ModifyClusterOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ModifyCluster API operation for AWS CloudHSM V2.
+//
+// Modifies AWS CloudHSM cluster.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CloudHSM V2's
+// API operation ModifyCluster for usage and error information.
+//
+// Returned Error Types:
+//
+//   - CloudHsmAccessDeniedException
+//     The request was rejected because the requester does not have permission to
+//     perform the requested operation.
+//
+//   - CloudHsmInternalFailureException
+//     The request was rejected because of an AWS CloudHSM internal failure. The
+//     request can be retried.
+//
+//   - CloudHsmInvalidRequestException
+//     The request was rejected because it is not a valid request.
+//
+//   - CloudHsmResourceNotFoundException
+//     The request was rejected because it refers to a resource that cannot be found.
+//
+//   - CloudHsmServiceException
+//     The request was rejected because an error occurred.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/ModifyCluster
+func (c *CloudHSMV2) ModifyCluster(input *ModifyClusterInput) (*ModifyClusterOutput, error) {
+	req, out := c.ModifyClusterRequest(input)
+	return out, req.Send()
+}
+
+// ModifyClusterWithContext is the same as ModifyCluster with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ModifyCluster for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CloudHSMV2) ModifyClusterWithContext(ctx aws.Context, input *ModifyClusterInput, opts ...request.Option) (*ModifyClusterOutput, error) {
+	req, out := c.ModifyClusterRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opRestoreBackup = "RestoreBackup"
 
 // RestoreBackupRequest generates a "aws/request.Request" representing the
@@ -1155,14 +1368,13 @@ const opRestoreBackup = "RestoreBackup"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the RestoreBackupRequest method.
+//	req, resp := client.RestoreBackupRequest(params)
 //
-//    // Example sending a request using the RestoreBackupRequest method.
-//    req, resp := client.RestoreBackupRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/RestoreBackup
 func (c *CloudHSMV2) RestoreBackupRequest(input *RestoreBackupInput) (req *request.Request, output *RestoreBackupOutput) {
@@ -1184,7 +1396,7 @@ func (c *CloudHSMV2) RestoreBackupRequest(input *RestoreBackupInput) (req *reque
 // RestoreBackup API operation for AWS CloudHSM V2.
 //
 // Restores a specified AWS CloudHSM backup that is in the PENDING_DELETION
-// state. For more information on deleting a backup, see DeleteBackup.
+// state. For mor information on deleting a backup, see DeleteBackup.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1193,23 +1405,24 @@ func (c *CloudHSMV2) RestoreBackupRequest(input *RestoreBackupInput) (req *reque
 // See the AWS API reference guide for AWS CloudHSM V2's
 // API operation RestoreBackup for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeCloudHsmInternalFailureException "CloudHsmInternalFailureException"
-//   The request was rejected because of an AWS CloudHSM internal failure. The
-//   request can be retried.
+// Returned Error Types:
 //
-//   * ErrCodeCloudHsmServiceException "CloudHsmServiceException"
-//   The request was rejected because an error occurred.
+//   - CloudHsmAccessDeniedException
+//     The request was rejected because the requester does not have permission to
+//     perform the requested operation.
 //
-//   * ErrCodeCloudHsmResourceNotFoundException "CloudHsmResourceNotFoundException"
-//   The request was rejected because it refers to a resource that cannot be found.
+//   - CloudHsmInternalFailureException
+//     The request was rejected because of an AWS CloudHSM internal failure. The
+//     request can be retried.
 //
-//   * ErrCodeCloudHsmInvalidRequestException "CloudHsmInvalidRequestException"
-//   The request was rejected because it is not a valid request.
+//   - CloudHsmInvalidRequestException
+//     The request was rejected because it is not a valid request.
 //
-//   * ErrCodeCloudHsmAccessDeniedException "CloudHsmAccessDeniedException"
-//   The request was rejected because the requester does not have permission to
-//   perform the requested operation.
+//   - CloudHsmResourceNotFoundException
+//     The request was rejected because it refers to a resource that cannot be found.
+//
+//   - CloudHsmServiceException
+//     The request was rejected because an error occurred.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/RestoreBackup
 func (c *CloudHSMV2) RestoreBackup(input *RestoreBackupInput) (*RestoreBackupOutput, error) {
@@ -1249,14 +1462,13 @@ const opTagResource = "TagResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the TagResourceRequest method.
+//	req, resp := client.TagResourceRequest(params)
 //
-//    // Example sending a request using the TagResourceRequest method.
-//    req, resp := client.TagResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/TagResource
 func (c *CloudHSMV2) TagResourceRequest(input *TagResourceInput) (req *request.Request, output *TagResourceOutput) {
@@ -1287,23 +1499,28 @@ func (c *CloudHSMV2) TagResourceRequest(input *TagResourceInput) (req *request.R
 // See the AWS API reference guide for AWS CloudHSM V2's
 // API operation TagResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeCloudHsmInternalFailureException "CloudHsmInternalFailureException"
-//   The request was rejected because of an AWS CloudHSM internal failure. The
-//   request can be retried.
+// Returned Error Types:
+//
+//   - CloudHsmAccessDeniedException
+//     The request was rejected because the requester does not have permission to
+//     perform the requested operation.
 //
-//   * ErrCodeCloudHsmServiceException "CloudHsmServiceException"
-//   The request was rejected because an error occurred.
+//   - CloudHsmInternalFailureException
+//     The request was rejected because of an AWS CloudHSM internal failure. The
+//     request can be retried.
 //
-//   * ErrCodeCloudHsmResourceNotFoundException "CloudHsmResourceNotFoundException"
-//   The request was rejected because it refers to a resource that cannot be found.
+//   - CloudHsmInvalidRequestException
+//     The request was rejected because it is not a valid request.
 //
-//   * ErrCodeCloudHsmInvalidRequestException "CloudHsmInvalidRequestException"
-//   The request was rejected because it is not a valid request.
+//   - CloudHsmResourceNotFoundException
+//     The request was rejected because it refers to a resource that cannot be found.
 //
-//   * ErrCodeCloudHsmAccessDeniedException "CloudHsmAccessDeniedException"
-//   The request was rejected because the requester does not have permission to
-//   perform the requested operation.
+//   - CloudHsmServiceException
+//     The request was rejected because an error occurred.
+//
+//   - CloudHsmTagException
+//     The request was rejected because of a tagging failure. Verify the tag conditions
+//     in all applicable policies, and then retry the request.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/TagResource
 func (c *CloudHSMV2) TagResource(input *TagResourceInput) (*TagResourceOutput, error) {
@@ -1343,14 +1560,13 @@ const opUntagResource = "UntagResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UntagResourceRequest method.
+//	req, resp := client.UntagResourceRequest(params)
 //
-//    // Example sending a request using the UntagResourceRequest method.
-//    req, resp := client.UntagResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/UntagResource
 func (c *CloudHSMV2) UntagResourceRequest(input *UntagResourceInput) (req *request.Request, output *UntagResourceOutput) {
@@ -1381,23 +1597,28 @@ func (c *CloudHSMV2) UntagResourceRequest(input *UntagResourceInput) (req *reque
 // See the AWS API reference guide for AWS CloudHSM V2's
 // API operation UntagResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeCloudHsmInternalFailureException "CloudHsmInternalFailureException"
-//   The request was rejected because of an AWS CloudHSM internal failure. The
-//   request can be retried.
+// Returned Error Types:
+//
+//   - CloudHsmAccessDeniedException
+//     The request was rejected because the requester does not have permission to
+//     perform the requested operation.
 //
-//   * ErrCodeCloudHsmServiceException "CloudHsmServiceException"
-//   The request was rejected because an error occurred.
+//   - CloudHsmInternalFailureException
+//     The request was rejected because of an AWS CloudHSM internal failure. The
+//     request can be retried.
 //
-//   * ErrCodeCloudHsmResourceNotFoundException "CloudHsmResourceNotFoundException"
-//   The request was rejected because it refers to a resource that cannot be found.
+//   - CloudHsmInvalidRequestException
+//     The request was rejected because it is not a valid request.
 //
-//   * ErrCodeCloudHsmInvalidRequestException "CloudHsmInvalidRequestException"
-//   The request was rejected because it is not a valid request.
+//   - CloudHsmResourceNotFoundException
+//     The request was rejected because it refers to a resource that cannot be found.
 //
-//   * ErrCodeCloudHsmAccessDeniedException "CloudHsmAccessDeniedException"
-//   The request was rejected because the requester does not have permission to
-//   perform the requested operation.
+//   - CloudHsmServiceException
+//     The request was rejected because an error occurred.
+//
+//   - CloudHsmTagException
+//     The request was rejected because of a tagging failure. Verify the tag conditions
+//     in all applicable policies, and then retry the request.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28/UntagResource
 func (c *CloudHSMV2) UntagResource(input *UntagResourceInput) (*UntagResourceOutput, error) {
@@ -1421,7 +1642,12 @@ func (c *CloudHSMV2) UntagResourceWithContext(ctx aws.Context, input *UntagResou
 	return out, req.Send()
 }
 
-// Contains information about a backup of an AWS CloudHSM cluster.
+// Contains information about a backup of an AWS CloudHSM cluster. All backup
+// objects contain the BackupId, BackupState, ClusterId, and CreateTimestamp
+// parameters. Backups that were copied into a destination region additionally
+// contain the CopyTimestamp, SourceBackup, SourceCluster, and SourceRegion
+// parameters. A backup that is pending deletion will include the DeleteTimestamp
+// parameter.
 type Backup struct {
 	_ struct{} `type:"structure"`
 
@@ -1436,6 +1662,7 @@ type Backup struct {
 	// The identifier (ID) of the cluster that was backed up.
 	ClusterId *string `type:"string"`
 
+	// The date and time when the backup was copied from a source backup.
 	CopyTimestamp *time.Time `type:"timestamp"`
 
 	// The date and time when the backup was created.
@@ -1444,19 +1671,40 @@ type Backup struct {
 	// The date and time when the backup will be permanently deleted.
 	DeleteTimestamp *time.Time `type:"timestamp"`
 
+	// Specifies whether the service should exempt a backup from the retention policy
+	// for the cluster. True exempts a backup from the retention policy. False means
+	// the service applies the backup retention policy defined at the cluster.
+	NeverExpires *bool `type:"boolean"`
+
+	// The identifier (ID) of the source backup from which the new backup was copied.
 	SourceBackup *string `type:"string"`
 
+	// The identifier (ID) of the cluster containing the source backup from which
+	// the new backup was copied.
 	SourceCluster *string `type:"string"`
 
+	// The AWS Region that contains the source backup from which the new backup
+	// was copied.
 	SourceRegion *string `type:"string"`
+
+	// The list of tags for the backup.
+	TagList []*Tag `min:"1" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Backup) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Backup) GoString() string {
 	return s.String()
 }
@@ -1497,6 +1745,12 @@ func (s *Backup) SetDeleteTimestamp(v time.Time) *Backup {
 	return s
 }
 
+// SetNeverExpires sets the NeverExpires field's value.
+func (s *Backup) SetNeverExpires(v bool) *Backup {
+	s.NeverExpires = &v
+	return s
+}
+
 // SetSourceBackup sets the SourceBackup field's value.
 func (s *Backup) SetSourceBackup(v string) *Backup {
 	s.SourceBackup = &v
@@ -1515,6 +1769,67 @@ func (s *Backup) SetSourceRegion(v string) *Backup {
 	return s
 }
 
+// SetTagList sets the TagList field's value.
+func (s *Backup) SetTagList(v []*Tag) *Backup {
+	s.TagList = v
+	return s
+}
+
+// A policy that defines the number of days to retain backups.
+type BackupRetentionPolicy struct {
+	_ struct{} `type:"structure"`
+
+	// The type of backup retention policy. For the DAYS type, the value is the
+	// number of days to retain backups.
+	Type *string `type:"string" enum:"BackupRetentionType"`
+
+	// Use a value between 7 - 379.
+	Value *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BackupRetentionPolicy) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BackupRetentionPolicy) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *BackupRetentionPolicy) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "BackupRetentionPolicy"}
+	if s.Value != nil && len(*s.Value) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Value", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetType sets the Type field's value.
+func (s *BackupRetentionPolicy) SetType(v string) *BackupRetentionPolicy {
+	s.Type = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *BackupRetentionPolicy) SetValue(v string) *BackupRetentionPolicy {
+	s.Value = &v
+	return s
+}
+
 // Contains one or more certificates or a certificate signing request (CSR).
 type Certificates struct {
 	_ struct{} `type:"structure"`
@@ -1537,12 +1852,20 @@ type Certificates struct {
 	ManufacturerHardwareCertificate *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Certificates) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Certificates) GoString() string {
 	return s.String()
 }
@@ -1565,16 +1888,403 @@ func (s *Certificates) SetClusterCsr(v string) *Certificates {
 	return s
 }
 
-// SetHsmCertificate sets the HsmCertificate field's value.
-func (s *Certificates) SetHsmCertificate(v string) *Certificates {
-	s.HsmCertificate = &v
-	return s
+// SetHsmCertificate sets the HsmCertificate field's value.
+func (s *Certificates) SetHsmCertificate(v string) *Certificates {
+	s.HsmCertificate = &v
+	return s
+}
+
+// SetManufacturerHardwareCertificate sets the ManufacturerHardwareCertificate field's value.
+func (s *Certificates) SetManufacturerHardwareCertificate(v string) *Certificates {
+	s.ManufacturerHardwareCertificate = &v
+	return s
+}
+
+// The request was rejected because the requester does not have permission to
+// perform the requested operation.
+type CloudHsmAccessDeniedException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CloudHsmAccessDeniedException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CloudHsmAccessDeniedException) GoString() string {
+	return s.String()
+}
+
+func newErrorCloudHsmAccessDeniedException(v protocol.ResponseMetadata) error {
+	return &CloudHsmAccessDeniedException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *CloudHsmAccessDeniedException) Code() string {
+	return "CloudHsmAccessDeniedException"
+}
+
+// Message returns the exception's message.
+func (s *CloudHsmAccessDeniedException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *CloudHsmAccessDeniedException) OrigErr() error {
+	return nil
+}
+
+func (s *CloudHsmAccessDeniedException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *CloudHsmAccessDeniedException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *CloudHsmAccessDeniedException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The request was rejected because of an AWS CloudHSM internal failure. The
+// request can be retried.
+type CloudHsmInternalFailureException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CloudHsmInternalFailureException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CloudHsmInternalFailureException) GoString() string {
+	return s.String()
+}
+
+func newErrorCloudHsmInternalFailureException(v protocol.ResponseMetadata) error {
+	return &CloudHsmInternalFailureException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *CloudHsmInternalFailureException) Code() string {
+	return "CloudHsmInternalFailureException"
+}
+
+// Message returns the exception's message.
+func (s *CloudHsmInternalFailureException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *CloudHsmInternalFailureException) OrigErr() error {
+	return nil
+}
+
+func (s *CloudHsmInternalFailureException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *CloudHsmInternalFailureException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *CloudHsmInternalFailureException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The request was rejected because it is not a valid request.
+type CloudHsmInvalidRequestException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CloudHsmInvalidRequestException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CloudHsmInvalidRequestException) GoString() string {
+	return s.String()
+}
+
+func newErrorCloudHsmInvalidRequestException(v protocol.ResponseMetadata) error {
+	return &CloudHsmInvalidRequestException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *CloudHsmInvalidRequestException) Code() string {
+	return "CloudHsmInvalidRequestException"
+}
+
+// Message returns the exception's message.
+func (s *CloudHsmInvalidRequestException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *CloudHsmInvalidRequestException) OrigErr() error {
+	return nil
+}
+
+func (s *CloudHsmInvalidRequestException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *CloudHsmInvalidRequestException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *CloudHsmInvalidRequestException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The request was rejected because it refers to a resource that cannot be found.
+type CloudHsmResourceNotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CloudHsmResourceNotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CloudHsmResourceNotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorCloudHsmResourceNotFoundException(v protocol.ResponseMetadata) error {
+	return &CloudHsmResourceNotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *CloudHsmResourceNotFoundException) Code() string {
+	return "CloudHsmResourceNotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *CloudHsmResourceNotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *CloudHsmResourceNotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *CloudHsmResourceNotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *CloudHsmResourceNotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *CloudHsmResourceNotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The request was rejected because an error occurred.
+type CloudHsmServiceException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CloudHsmServiceException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CloudHsmServiceException) GoString() string {
+	return s.String()
+}
+
+func newErrorCloudHsmServiceException(v protocol.ResponseMetadata) error {
+	return &CloudHsmServiceException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *CloudHsmServiceException) Code() string {
+	return "CloudHsmServiceException"
+}
+
+// Message returns the exception's message.
+func (s *CloudHsmServiceException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *CloudHsmServiceException) OrigErr() error {
+	return nil
+}
+
+func (s *CloudHsmServiceException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *CloudHsmServiceException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *CloudHsmServiceException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The request was rejected because of a tagging failure. Verify the tag conditions
+// in all applicable policies, and then retry the request.
+type CloudHsmTagException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CloudHsmTagException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CloudHsmTagException) GoString() string {
+	return s.String()
+}
+
+func newErrorCloudHsmTagException(v protocol.ResponseMetadata) error {
+	return &CloudHsmTagException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *CloudHsmTagException) Code() string {
+	return "CloudHsmTagException"
+}
+
+// Message returns the exception's message.
+func (s *CloudHsmTagException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *CloudHsmTagException) OrigErr() error {
+	return nil
+}
+
+func (s *CloudHsmTagException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// SetManufacturerHardwareCertificate sets the ManufacturerHardwareCertificate field's value.
-func (s *Certificates) SetManufacturerHardwareCertificate(v string) *Certificates {
-	s.ManufacturerHardwareCertificate = &v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *CloudHsmTagException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *CloudHsmTagException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
 // Contains information about an AWS CloudHSM cluster.
@@ -1584,6 +2294,9 @@ type Cluster struct {
 	// The cluster's backup policy.
 	BackupPolicy *string `type:"string" enum:"BackupPolicy"`
 
+	// A policy that defines how the service retains backups.
+	BackupRetentionPolicy *BackupRetentionPolicy `type:"structure"`
+
 	// Contains one or more certificates or a certificate signing request (CSR).
 	Certificates *Certificates `type:"structure"`
 
@@ -1615,20 +2328,32 @@ type Cluster struct {
 	// A description of the cluster's state.
 	StateMessage *string `type:"string"`
 
-	// A map of the cluster's subnets and their corresponding Availability Zones.
+	// A map from availability zone to the cluster’s subnet in that availability
+	// zone.
 	SubnetMapping map[string]*string `type:"map"`
 
+	// The list of tags for the cluster.
+	TagList []*Tag `min:"1" type:"list"`
+
 	// The identifier (ID) of the virtual private cloud (VPC) that contains the
 	// cluster.
 	VpcId *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Cluster) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Cluster) GoString() string {
 	return s.String()
 }
@@ -1639,6 +2364,12 @@ func (s *Cluster) SetBackupPolicy(v string) *Cluster {
 	return s
 }
 
+// SetBackupRetentionPolicy sets the BackupRetentionPolicy field's value.
+func (s *Cluster) SetBackupRetentionPolicy(v *BackupRetentionPolicy) *Cluster {
+	s.BackupRetentionPolicy = v
+	return s
+}
+
 // SetCertificates sets the Certificates field's value.
 func (s *Cluster) SetCertificates(v *Certificates) *Cluster {
 	s.Certificates = v
@@ -1705,6 +2436,12 @@ func (s *Cluster) SetSubnetMapping(v map[string]*string) *Cluster {
 	return s
 }
 
+// SetTagList sets the TagList field's value.
+func (s *Cluster) SetTagList(v []*Tag) *Cluster {
+	s.TagList = v
+	return s
+}
+
 // SetVpcId sets the VpcId field's value.
 func (s *Cluster) SetVpcId(v string) *Cluster {
 	s.VpcId = &v
@@ -1723,14 +2460,28 @@ type CopyBackupToRegionInput struct {
 	//
 	// DestinationRegion is a required field
 	DestinationRegion *string `type:"string" required:"true"`
+
+	// Tags to apply to the destination backup during creation. If you specify tags,
+	// only these tags will be applied to the destination backup. If you do not
+	// specify tags, the service copies tags from the source backup to the destination
+	// backup.
+	TagList []*Tag `min:"1" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CopyBackupToRegionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CopyBackupToRegionInput) GoString() string {
 	return s.String()
 }
@@ -1744,6 +2495,19 @@ func (s *CopyBackupToRegionInput) Validate() error {
 	if s.DestinationRegion == nil {
 		invalidParams.Add(request.NewErrParamRequired("DestinationRegion"))
 	}
+	if s.TagList != nil && len(s.TagList) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("TagList", 1))
+	}
+	if s.TagList != nil {
+		for i, v := range s.TagList {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "TagList", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -1763,6 +2527,12 @@ func (s *CopyBackupToRegionInput) SetDestinationRegion(v string) *CopyBackupToRe
 	return s
 }
 
+// SetTagList sets the TagList field's value.
+func (s *CopyBackupToRegionInput) SetTagList(v []*Tag) *CopyBackupToRegionInput {
+	s.TagList = v
+	return s
+}
+
 type CopyBackupToRegionOutput struct {
 	_ struct{} `type:"structure"`
 
@@ -1777,12 +2547,20 @@ type CopyBackupToRegionOutput struct {
 	DestinationBackup *DestinationBackup `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CopyBackupToRegionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CopyBackupToRegionOutput) GoString() string {
 	return s.String()
 }
@@ -1796,6 +2574,9 @@ func (s *CopyBackupToRegionOutput) SetDestinationBackup(v *DestinationBackup) *C
 type CreateClusterInput struct {
 	_ struct{} `type:"structure"`
 
+	// A policy that defines how the service retains backups.
+	BackupRetentionPolicy *BackupRetentionPolicy `type:"structure"`
+
 	// The type of HSM to use in the cluster. Currently the only allowed value is
 	// hsm1.medium.
 	//
@@ -1817,14 +2598,25 @@ type CreateClusterInput struct {
 	//
 	// SubnetIds is a required field
 	SubnetIds []*string `min:"1" type:"list" required:"true"`
+
+	// Tags to apply to the CloudHSM cluster during creation.
+	TagList []*Tag `min:"1" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateClusterInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateClusterInput) GoString() string {
 	return s.String()
 }
@@ -1841,6 +2633,24 @@ func (s *CreateClusterInput) Validate() error {
 	if s.SubnetIds != nil && len(s.SubnetIds) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("SubnetIds", 1))
 	}
+	if s.TagList != nil && len(s.TagList) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("TagList", 1))
+	}
+	if s.BackupRetentionPolicy != nil {
+		if err := s.BackupRetentionPolicy.Validate(); err != nil {
+			invalidParams.AddNested("BackupRetentionPolicy", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TagList != nil {
+		for i, v := range s.TagList {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "TagList", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -1848,6 +2658,12 @@ func (s *CreateClusterInput) Validate() error {
 	return nil
 }
 
+// SetBackupRetentionPolicy sets the BackupRetentionPolicy field's value.
+func (s *CreateClusterInput) SetBackupRetentionPolicy(v *BackupRetentionPolicy) *CreateClusterInput {
+	s.BackupRetentionPolicy = v
+	return s
+}
+
 // SetHsmType sets the HsmType field's value.
 func (s *CreateClusterInput) SetHsmType(v string) *CreateClusterInput {
 	s.HsmType = &v
@@ -1866,6 +2682,12 @@ func (s *CreateClusterInput) SetSubnetIds(v []*string) *CreateClusterInput {
 	return s
 }
 
+// SetTagList sets the TagList field's value.
+func (s *CreateClusterInput) SetTagList(v []*Tag) *CreateClusterInput {
+	s.TagList = v
+	return s
+}
+
 type CreateClusterOutput struct {
 	_ struct{} `type:"structure"`
 
@@ -1873,12 +2695,20 @@ type CreateClusterOutput struct {
 	Cluster *Cluster `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateClusterOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateClusterOutput) GoString() string {
 	return s.String()
 }
@@ -1910,12 +2740,20 @@ type CreateHsmInput struct {
 	IpAddress *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateHsmInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateHsmInput) GoString() string {
 	return s.String()
 }
@@ -1961,12 +2799,20 @@ type CreateHsmOutput struct {
 	Hsm *Hsm `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateHsmOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateHsmOutput) GoString() string {
 	return s.String()
 }
@@ -1987,12 +2833,20 @@ type DeleteBackupInput struct {
 	BackupId *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteBackupInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteBackupInput) GoString() string {
 	return s.String()
 }
@@ -2023,12 +2877,20 @@ type DeleteBackupOutput struct {
 	Backup *Backup `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteBackupOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteBackupOutput) GoString() string {
 	return s.String()
 }
@@ -2049,12 +2911,20 @@ type DeleteClusterInput struct {
 	ClusterId *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteClusterInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteClusterInput) GoString() string {
 	return s.String()
 }
@@ -2085,12 +2955,20 @@ type DeleteClusterOutput struct {
 	Cluster *Cluster `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteClusterOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteClusterOutput) GoString() string {
 	return s.String()
 }
@@ -2121,12 +2999,20 @@ type DeleteHsmInput struct {
 	HsmId *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteHsmInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteHsmInput) GoString() string {
 	return s.String()
 }
@@ -2175,12 +3061,20 @@ type DeleteHsmOutput struct {
 	HsmId *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteHsmOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteHsmOutput) GoString() string {
 	return s.String()
 }
@@ -2207,6 +3101,11 @@ type DescribeBackupsInput struct {
 	// Specify clusters by their cluster identifier (ID).
 	//
 	// Use the states filter to return only backups that match the specified state.
+	//
+	// Use the neverExpires filter to return backups filtered by the value in the
+	// neverExpires parameter. True returns all backups exempt from the backup retention
+	// policy. False returns all backups with a backup retention policy defined
+	// at the cluster.
 	Filters map[string][]*string `type:"map"`
 
 	// The maximum number of backups to return in the response. When there are more
@@ -2217,15 +3116,25 @@ type DescribeBackupsInput struct {
 	// value to get more backups.
 	NextToken *string `type:"string"`
 
+	// Designates whether or not to sort the return backups by ascending chronological
+	// order of generation.
 	SortAscending *bool `type:"boolean"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeBackupsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeBackupsInput) GoString() string {
 	return s.String()
 }
@@ -2279,12 +3188,20 @@ type DescribeBackupsOutput struct {
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeBackupsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeBackupsOutput) GoString() string {
 	return s.String()
 }
@@ -2325,12 +3242,20 @@ type DescribeClustersInput struct {
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeClustersInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeClustersInput) GoString() string {
 	return s.String()
 }
@@ -2378,12 +3303,20 @@ type DescribeClustersOutput struct {
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeClustersOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeClustersOutput) GoString() string {
 	return s.String()
 }
@@ -2400,24 +3333,40 @@ func (s *DescribeClustersOutput) SetNextToken(v string) *DescribeClustersOutput
 	return s
 }
 
+// Contains information about the backup that will be copied and created by
+// the CopyBackupToRegion operation.
 type DestinationBackup struct {
 	_ struct{} `type:"structure"`
 
+	// The date and time when both the source backup was created.
 	CreateTimestamp *time.Time `type:"timestamp"`
 
+	// The identifier (ID) of the source backup from which the new backup was copied.
 	SourceBackup *string `type:"string"`
 
+	// The identifier (ID) of the cluster containing the source backup from which
+	// the new backup was copied.
 	SourceCluster *string `type:"string"`
 
+	// The AWS region that contains the source backup from which the new backup
+	// was copied.
 	SourceRegion *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DestinationBackup) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DestinationBackup) GoString() string {
 	return s.String()
 }
@@ -2478,12 +3427,20 @@ type Hsm struct {
 	SubnetId *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Hsm) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Hsm) GoString() string {
 	return s.String()
 }
@@ -2553,21 +3510,29 @@ type InitializeClusterInput struct {
 	SignedCert *string `type:"string" required:"true"`
 
 	// The issuing certificate of the issuing certificate authority (CA) that issued
-	// (signed) the cluster certificate. This can be a root (self-signed) certificate
-	// or a certificate chain that begins with the certificate that issued the cluster
-	// certificate and ends with a root certificate. The certificate or certificate
-	// chain must be in PEM format and can contain a maximum of 5000 characters.
+	// (signed) the cluster certificate. You must use a self-signed certificate.
+	// The certificate used to sign the HSM CSR must be directly available, and
+	// thus must be the root certificate. The certificate must be in PEM format
+	// and can contain a maximum of 5000 characters.
 	//
 	// TrustAnchor is a required field
 	TrustAnchor *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InitializeClusterInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InitializeClusterInput) GoString() string {
 	return s.String()
 }
@@ -2619,12 +3584,20 @@ type InitializeClusterOutput struct {
 	StateMessage *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InitializeClusterOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InitializeClusterOutput) GoString() string {
 	return s.String()
 }
@@ -2659,12 +3632,20 @@ type ListTagsInput struct {
 	ResourceId *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsInput) GoString() string {
 	return s.String()
 }
@@ -2716,12 +3697,20 @@ type ListTagsOutput struct {
 	TagList []*Tag `min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsOutput) GoString() string {
 	return s.String()
 }
@@ -2738,6 +3727,202 @@ func (s *ListTagsOutput) SetTagList(v []*Tag) *ListTagsOutput {
 	return s
 }
 
+type ModifyBackupAttributesInput struct {
+	_ struct{} `type:"structure"`
+
+	// The identifier (ID) of the backup to modify. To find the ID of a backup,
+	// use the DescribeBackups operation.
+	//
+	// BackupId is a required field
+	BackupId *string `type:"string" required:"true"`
+
+	// Specifies whether the service should exempt a backup from the retention policy
+	// for the cluster. True exempts a backup from the retention policy. False means
+	// the service applies the backup retention policy defined at the cluster.
+	//
+	// NeverExpires is a required field
+	NeverExpires *bool `type:"boolean" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ModifyBackupAttributesInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ModifyBackupAttributesInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ModifyBackupAttributesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyBackupAttributesInput"}
+	if s.BackupId == nil {
+		invalidParams.Add(request.NewErrParamRequired("BackupId"))
+	}
+	if s.NeverExpires == nil {
+		invalidParams.Add(request.NewErrParamRequired("NeverExpires"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetBackupId sets the BackupId field's value.
+func (s *ModifyBackupAttributesInput) SetBackupId(v string) *ModifyBackupAttributesInput {
+	s.BackupId = &v
+	return s
+}
+
+// SetNeverExpires sets the NeverExpires field's value.
+func (s *ModifyBackupAttributesInput) SetNeverExpires(v bool) *ModifyBackupAttributesInput {
+	s.NeverExpires = &v
+	return s
+}
+
+type ModifyBackupAttributesOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Contains information about a backup of an AWS CloudHSM cluster. All backup
+	// objects contain the BackupId, BackupState, ClusterId, and CreateTimestamp
+	// parameters. Backups that were copied into a destination region additionally
+	// contain the CopyTimestamp, SourceBackup, SourceCluster, and SourceRegion
+	// parameters. A backup that is pending deletion will include the DeleteTimestamp
+	// parameter.
+	Backup *Backup `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ModifyBackupAttributesOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ModifyBackupAttributesOutput) GoString() string {
+	return s.String()
+}
+
+// SetBackup sets the Backup field's value.
+func (s *ModifyBackupAttributesOutput) SetBackup(v *Backup) *ModifyBackupAttributesOutput {
+	s.Backup = v
+	return s
+}
+
+type ModifyClusterInput struct {
+	_ struct{} `type:"structure"`
+
+	// A policy that defines how the service retains backups.
+	//
+	// BackupRetentionPolicy is a required field
+	BackupRetentionPolicy *BackupRetentionPolicy `type:"structure" required:"true"`
+
+	// The identifier (ID) of the cluster that you want to modify. To find the cluster
+	// ID, use DescribeClusters.
+	//
+	// ClusterId is a required field
+	ClusterId *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ModifyClusterInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ModifyClusterInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ModifyClusterInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyClusterInput"}
+	if s.BackupRetentionPolicy == nil {
+		invalidParams.Add(request.NewErrParamRequired("BackupRetentionPolicy"))
+	}
+	if s.ClusterId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ClusterId"))
+	}
+	if s.BackupRetentionPolicy != nil {
+		if err := s.BackupRetentionPolicy.Validate(); err != nil {
+			invalidParams.AddNested("BackupRetentionPolicy", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetBackupRetentionPolicy sets the BackupRetentionPolicy field's value.
+func (s *ModifyClusterInput) SetBackupRetentionPolicy(v *BackupRetentionPolicy) *ModifyClusterInput {
+	s.BackupRetentionPolicy = v
+	return s
+}
+
+// SetClusterId sets the ClusterId field's value.
+func (s *ModifyClusterInput) SetClusterId(v string) *ModifyClusterInput {
+	s.ClusterId = &v
+	return s
+}
+
+type ModifyClusterOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Contains information about an AWS CloudHSM cluster.
+	Cluster *Cluster `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ModifyClusterOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ModifyClusterOutput) GoString() string {
+	return s.String()
+}
+
+// SetCluster sets the Cluster field's value.
+func (s *ModifyClusterOutput) SetCluster(v *Cluster) *ModifyClusterOutput {
+	s.Cluster = v
+	return s
+}
+
 type RestoreBackupInput struct {
 	_ struct{} `type:"structure"`
 
@@ -2748,12 +3933,20 @@ type RestoreBackupInput struct {
 	BackupId *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RestoreBackupInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RestoreBackupInput) GoString() string {
 	return s.String()
 }
@@ -2784,12 +3977,20 @@ type RestoreBackupOutput struct {
 	Backup *Backup `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RestoreBackupOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RestoreBackupOutput) GoString() string {
 	return s.String()
 }
@@ -2815,12 +4016,20 @@ type Tag struct {
 	Value *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) GoString() string {
 	return s.String()
 }
@@ -2871,12 +4080,20 @@ type TagResourceInput struct {
 	TagList []*Tag `min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceInput) GoString() string {
 	return s.String()
 }
@@ -2926,12 +4143,20 @@ type TagResourceOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceOutput) GoString() string {
 	return s.String()
 }
@@ -2952,12 +4177,20 @@ type UntagResourceInput struct {
 	TagKeyList []*string `min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceInput) GoString() string {
 	return s.String()
 }
@@ -2997,12 +4230,20 @@ type UntagResourceOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceOutput) GoString() string {
 	return s.String()
 }
@@ -3012,6 +4253,25 @@ const (
 	BackupPolicyDefault = "DEFAULT"
 )
 
+// BackupPolicy_Values returns all elements of the BackupPolicy enum
+func BackupPolicy_Values() []string {
+	return []string{
+		BackupPolicyDefault,
+	}
+}
+
+const (
+	// BackupRetentionTypeDays is a BackupRetentionType enum value
+	BackupRetentionTypeDays = "DAYS"
+)
+
+// BackupRetentionType_Values returns all elements of the BackupRetentionType enum
+func BackupRetentionType_Values() []string {
+	return []string{
+		BackupRetentionTypeDays,
+	}
+}
+
 const (
 	// BackupStateCreateInProgress is a BackupState enum value
 	BackupStateCreateInProgress = "CREATE_IN_PROGRESS"
@@ -3026,6 +4286,16 @@ const (
 	BackupStatePendingDeletion = "PENDING_DELETION"
 )
 
+// BackupState_Values returns all elements of the BackupState enum
+func BackupState_Values() []string {
+	return []string{
+		BackupStateCreateInProgress,
+		BackupStateReady,
+		BackupStateDeleted,
+		BackupStatePendingDeletion,
+	}
+}
+
 const (
 	// ClusterStateCreateInProgress is a ClusterState enum value
 	ClusterStateCreateInProgress = "CREATE_IN_PROGRESS"
@@ -3055,6 +4325,21 @@ const (
 	ClusterStateDegraded = "DEGRADED"
 )
 
+// ClusterState_Values returns all elements of the ClusterState enum
+func ClusterState_Values() []string {
+	return []string{
+		ClusterStateCreateInProgress,
+		ClusterStateUninitialized,
+		ClusterStateInitializeInProgress,
+		ClusterStateInitialized,
+		ClusterStateActive,
+		ClusterStateUpdateInProgress,
+		ClusterStateDeleteInProgress,
+		ClusterStateDeleted,
+		ClusterStateDegraded,
+	}
+}
+
 const (
 	// HsmStateCreateInProgress is a HsmState enum value
 	HsmStateCreateInProgress = "CREATE_IN_PROGRESS"
@@ -3071,3 +4356,14 @@ const (
 	// HsmStateDeleted is a HsmState enum value
 	HsmStateDeleted = "DELETED"
 )
+
+// HsmState_Values returns all elements of the HsmState enum
+func HsmState_Values() []string {
+	return []string{
+		HsmStateCreateInProgress,
+		HsmStateActive,
+		HsmStateDegraded,
+		HsmStateDeleteInProgress,
+		HsmStateDeleted,
+	}
+}
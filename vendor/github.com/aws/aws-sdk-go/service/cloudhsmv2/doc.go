@@ -4,14 +4,14 @@
 // requests to AWS CloudHSM V2.
 //
 // For more information about AWS CloudHSM, see AWS CloudHSM (http://aws.amazon.com/cloudhsm/)
-// and the AWS CloudHSM User Guide (http://docs.aws.amazon.com/cloudhsm/latest/userguide/).
+// and the AWS CloudHSM User Guide (https://docs.aws.amazon.com/cloudhsm/latest/userguide/).
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/cloudhsmv2-2017-04-28 for more information on this service.
 //
 // See cloudhsmv2 package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/cloudhsmv2/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact AWS CloudHSM V2 with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
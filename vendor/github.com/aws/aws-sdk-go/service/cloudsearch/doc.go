@@ -15,7 +15,7 @@
 // See cloudsearch package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/cloudsearch/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon CloudSearch with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
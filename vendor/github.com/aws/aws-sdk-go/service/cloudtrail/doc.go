@@ -6,31 +6,30 @@
 // This is the CloudTrail API Reference. It provides descriptions of actions,
 // data types, common parameters, and common errors for CloudTrail.
 //
-// CloudTrail is a web service that records AWS API calls for your AWS account
-// and delivers log files to an Amazon S3 bucket. The recorded information includes
-// the identity of the user, the start time of the AWS API call, the source
-// IP address, the request parameters, and the response elements returned by
-// the service.
-//
-// As an alternative to the API, you can use one of the AWS SDKs, which consist
-// of libraries and sample code for various programming languages and platforms
-// (Java, Ruby, .NET, iOS, Android, etc.). The SDKs provide a convenient way
-// to create programmatic access to AWSCloudTrail. For example, the SDKs take
-// care of cryptographically signing requests, managing errors, and retrying
-// requests automatically. For information about the AWS SDKs, including how
-// to download and install them, see the Tools for Amazon Web Services page
-// (http://aws.amazon.com/tools/).
-//
-// See the AWS CloudTrail User Guide (http://docs.aws.amazon.com/awscloudtrail/latest/userguide/cloudtrail-user-guide.html)
-// for information about the data that is included with each AWS API call listed
-// in the log files.
+// CloudTrail is a web service that records Amazon Web Services API calls for
+// your Amazon Web Services account and delivers log files to an Amazon S3 bucket.
+// The recorded information includes the identity of the user, the start time
+// of the Amazon Web Services API call, the source IP address, the request parameters,
+// and the response elements returned by the service.
+//
+// As an alternative to the API, you can use one of the Amazon Web Services
+// SDKs, which consist of libraries and sample code for various programming
+// languages and platforms (Java, Ruby, .NET, iOS, Android, etc.). The SDKs
+// provide programmatic access to CloudTrail. For example, the SDKs handle cryptographically
+// signing requests, managing errors, and retrying requests automatically. For
+// more information about the Amazon Web Services SDKs, including how to download
+// and install them, see Tools to Build on Amazon Web Services (http://aws.amazon.com/tools/).
+//
+// See the CloudTrail User Guide (https://docs.aws.amazon.com/awscloudtrail/latest/userguide/cloudtrail-user-guide.html)
+// for information about the data that is included with each Amazon Web Services
+// API call listed in the log files.
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/cloudtrail-2013-11-01 for more information on this service.
 //
 // See cloudtrail package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/cloudtrail/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact AWS CloudTrail with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -29,14 +29,13 @@ const opActivateEventSource = "ActivateEventSource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ActivateEventSourceRequest method.
+//	req, resp := client.ActivateEventSourceRequest(params)
 //
-//    // Example sending a request using the ActivateEventSourceRequest method.
-//    req, resp := client.ActivateEventSourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ActivateEventSource
 func (c *CloudWatchEvents) ActivateEventSourceRequest(input *ActivateEventSourceInput) (req *request.Request, output *ActivateEventSourceOutput) {
@@ -61,8 +60,6 @@ func (c *CloudWatchEvents) ActivateEventSourceRequest(input *ActivateEventSource
 // Activates a partner event source that has been deactivated. Once activated,
 // your matching event bus will start receiving events from the event source.
 //
-// This operation is performed by AWS customers, not by SaaS partners.
-//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -70,15 +67,22 @@ func (c *CloudWatchEvents) ActivateEventSourceRequest(input *ActivateEventSource
 // See the AWS API reference guide for Amazon CloudWatch Events's
 // API operation ActivateEventSource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   An entity that you specified doesn't exist.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   The specified state isn't a valid state for an event source.
+//   - InvalidStateException
+//     The specified state is not a valid state for an event source.
 //
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+//   - OperationDisabledException
+//     The operation you are attempting is not available in this region.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ActivateEventSource
 func (c *CloudWatchEvents) ActivateEventSource(input *ActivateEventSourceInput) (*ActivateEventSourceOutput, error) {
@@ -102,2985 +106,10814 @@ func (c *CloudWatchEvents) ActivateEventSourceWithContext(ctx aws.Context, input
 	return out, req.Send()
 }
 
-const opCreateEventBus = "CreateEventBus"
+const opCancelReplay = "CancelReplay"
 
-// CreateEventBusRequest generates a "aws/request.Request" representing the
-// client's request for the CreateEventBus operation. The "output" return
+// CancelReplayRequest generates a "aws/request.Request" representing the
+// client's request for the CancelReplay operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See CreateEventBus for more information on using the CreateEventBus
+// See CancelReplay for more information on using the CancelReplay
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CancelReplayRequest method.
+//	req, resp := client.CancelReplayRequest(params)
 //
-//    // Example sending a request using the CreateEventBusRequest method.
-//    req, resp := client.CreateEventBusRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/CreateEventBus
-func (c *CloudWatchEvents) CreateEventBusRequest(input *CreateEventBusInput) (req *request.Request, output *CreateEventBusOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/CancelReplay
+func (c *CloudWatchEvents) CancelReplayRequest(input *CancelReplayInput) (req *request.Request, output *CancelReplayOutput) {
 	op := &request.Operation{
-		Name:       opCreateEventBus,
+		Name:       opCancelReplay,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &CreateEventBusInput{}
+		input = &CancelReplayInput{}
 	}
 
-	output = &CreateEventBusOutput{}
+	output = &CancelReplayOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// CreateEventBus API operation for Amazon CloudWatch Events.
+// CancelReplay API operation for Amazon CloudWatch Events.
 //
-// Creates a new event bus within your account. This can be a custom event bus
-// which you can use to receive events from your own custom applications and
-// services, or it can be a partner event bus which can be matched to a partner
-// event source.
-//
-// This operation is used by AWS customers, not by SaaS partners.
+// Cancels the specified replay.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation CreateEventBus for usage and error information.
-//
-// Returned Error Codes:
-//   * ErrCodeResourceAlreadyExistsException "ResourceAlreadyExistsException"
-//   The resource that you're trying to create already exists.
+// API operation CancelReplay for usage and error information.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   An entity that you specified doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   The specified state isn't a valid state for an event source.
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
 //
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
 //
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   There is concurrent modification on a resource.
+//   - IllegalStatusException
+//     An error occurred because a replay can be canceled only when the state is
+//     Running or Starting.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   You tried to create more resources than is allowed.
+//   - InternalException
+//     This exception occurs due to unexpected causes.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/CreateEventBus
-func (c *CloudWatchEvents) CreateEventBus(input *CreateEventBusInput) (*CreateEventBusOutput, error) {
-	req, out := c.CreateEventBusRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/CancelReplay
+func (c *CloudWatchEvents) CancelReplay(input *CancelReplayInput) (*CancelReplayOutput, error) {
+	req, out := c.CancelReplayRequest(input)
 	return out, req.Send()
 }
 
-// CreateEventBusWithContext is the same as CreateEventBus with the addition of
+// CancelReplayWithContext is the same as CancelReplay with the addition of
 // the ability to pass a context and additional request options.
 //
-// See CreateEventBus for details on how to use this API operation.
+// See CancelReplay for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) CreateEventBusWithContext(ctx aws.Context, input *CreateEventBusInput, opts ...request.Option) (*CreateEventBusOutput, error) {
-	req, out := c.CreateEventBusRequest(input)
+func (c *CloudWatchEvents) CancelReplayWithContext(ctx aws.Context, input *CancelReplayInput, opts ...request.Option) (*CancelReplayOutput, error) {
+	req, out := c.CancelReplayRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opCreatePartnerEventSource = "CreatePartnerEventSource"
+const opCreateApiDestination = "CreateApiDestination"
 
-// CreatePartnerEventSourceRequest generates a "aws/request.Request" representing the
-// client's request for the CreatePartnerEventSource operation. The "output" return
+// CreateApiDestinationRequest generates a "aws/request.Request" representing the
+// client's request for the CreateApiDestination operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See CreatePartnerEventSource for more information on using the CreatePartnerEventSource
+// See CreateApiDestination for more information on using the CreateApiDestination
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateApiDestinationRequest method.
+//	req, resp := client.CreateApiDestinationRequest(params)
 //
-//    // Example sending a request using the CreatePartnerEventSourceRequest method.
-//    req, resp := client.CreatePartnerEventSourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/CreatePartnerEventSource
-func (c *CloudWatchEvents) CreatePartnerEventSourceRequest(input *CreatePartnerEventSourceInput) (req *request.Request, output *CreatePartnerEventSourceOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/CreateApiDestination
+func (c *CloudWatchEvents) CreateApiDestinationRequest(input *CreateApiDestinationInput) (req *request.Request, output *CreateApiDestinationOutput) {
 	op := &request.Operation{
-		Name:       opCreatePartnerEventSource,
+		Name:       opCreateApiDestination,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &CreatePartnerEventSourceInput{}
+		input = &CreateApiDestinationInput{}
 	}
 
-	output = &CreatePartnerEventSourceOutput{}
+	output = &CreateApiDestinationOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// CreatePartnerEventSource API operation for Amazon CloudWatch Events.
-//
-// Called by an SaaS partner to create a partner event source.
-//
-// This operation is not used by AWS customers.
-//
-// Each partner event source can be used by one AWS account to create a matching
-// partner event bus in that AWS account. A SaaS partner must create one partner
-// event source for each AWS account that wants to receive those event types.
-//
-// A partner event source creates events based on resources in the SaaS partner's
-// service or application.
-//
-// An AWS account that creates a partner event bus that matches the partner
-// event source can use that event bus to receive events from the partner, and
-// then process them using AWS Events rules and targets.
-//
-// Partner event source names follow this format:
-//
-// aws.partner/partner_name/event_namespace/event_name
-//
-//    * partner_name is determined during partner registration and identifies
-//    the partner to AWS customers.
-//
-//    * For event_namespace, we recommend that partners use a string that identifies
-//    the AWS customer within the partner's system. This should not be the customer's
-//    AWS account ID.
+// CreateApiDestination API operation for Amazon CloudWatch Events.
 //
-//    * event_name is determined by the partner, and should uniquely identify
-//    an event-generating resource within the partner system. This should help
-//    AWS customers decide whether to create an event bus to receive these events.
+// Creates an API destination, which is an HTTP invocation endpoint configured
+// as a target for events.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation CreatePartnerEventSource for usage and error information.
+// API operation CreateApiDestination for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceAlreadyExistsException "ResourceAlreadyExistsException"
-//   The resource that you're trying to create already exists.
+// Returned Error Types:
 //
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+//   - ResourceAlreadyExistsException
+//     The resource you are trying to create already exists.
 //
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   There is concurrent modification on a resource.
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   You tried to create more resources than is allowed.
+//   - LimitExceededException
+//     The request failed because it attempted to create resource beyond the allowed
+//     service quota.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/CreatePartnerEventSource
-func (c *CloudWatchEvents) CreatePartnerEventSource(input *CreatePartnerEventSourceInput) (*CreatePartnerEventSourceOutput, error) {
-	req, out := c.CreatePartnerEventSourceRequest(input)
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/CreateApiDestination
+func (c *CloudWatchEvents) CreateApiDestination(input *CreateApiDestinationInput) (*CreateApiDestinationOutput, error) {
+	req, out := c.CreateApiDestinationRequest(input)
 	return out, req.Send()
 }
 
-// CreatePartnerEventSourceWithContext is the same as CreatePartnerEventSource with the addition of
+// CreateApiDestinationWithContext is the same as CreateApiDestination with the addition of
 // the ability to pass a context and additional request options.
 //
-// See CreatePartnerEventSource for details on how to use this API operation.
+// See CreateApiDestination for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) CreatePartnerEventSourceWithContext(ctx aws.Context, input *CreatePartnerEventSourceInput, opts ...request.Option) (*CreatePartnerEventSourceOutput, error) {
-	req, out := c.CreatePartnerEventSourceRequest(input)
+func (c *CloudWatchEvents) CreateApiDestinationWithContext(ctx aws.Context, input *CreateApiDestinationInput, opts ...request.Option) (*CreateApiDestinationOutput, error) {
+	req, out := c.CreateApiDestinationRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opDeactivateEventSource = "DeactivateEventSource"
+const opCreateArchive = "CreateArchive"
 
-// DeactivateEventSourceRequest generates a "aws/request.Request" representing the
-// client's request for the DeactivateEventSource operation. The "output" return
+// CreateArchiveRequest generates a "aws/request.Request" representing the
+// client's request for the CreateArchive operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See DeactivateEventSource for more information on using the DeactivateEventSource
+// See CreateArchive for more information on using the CreateArchive
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateArchiveRequest method.
+//	req, resp := client.CreateArchiveRequest(params)
 //
-//    // Example sending a request using the DeactivateEventSourceRequest method.
-//    req, resp := client.DeactivateEventSourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeactivateEventSource
-func (c *CloudWatchEvents) DeactivateEventSourceRequest(input *DeactivateEventSourceInput) (req *request.Request, output *DeactivateEventSourceOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/CreateArchive
+func (c *CloudWatchEvents) CreateArchiveRequest(input *CreateArchiveInput) (req *request.Request, output *CreateArchiveOutput) {
 	op := &request.Operation{
-		Name:       opDeactivateEventSource,
+		Name:       opCreateArchive,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &DeactivateEventSourceInput{}
+		input = &CreateArchiveInput{}
 	}
 
-	output = &DeactivateEventSourceOutput{}
+	output = &CreateArchiveOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// DeactivateEventSource API operation for Amazon CloudWatch Events.
-//
-// An AWS customer uses this operation to temporarily stop receiving events
-// from the specified partner event source. The matching event bus isn't deleted.
-//
-// When you deactivate a partner event source, the source goes into PENDING
-// state. If it remains in PENDING state for more than two weeks, it's deleted.
+// CreateArchive API operation for Amazon CloudWatch Events.
 //
-// To activate a deactivated partner event source, use ActivateEventSource.
+// Creates an archive of events with the specified settings. When you create
+// an archive, incoming events might not immediately start being sent to the
+// archive. Allow a short period of time for changes to take effect. If you
+// do not specify a pattern to filter events sent to the archive, all events
+// are sent to the archive except replayed events. Replayed events are not sent
+// to an archive.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation DeactivateEventSource for usage and error information.
+// API operation CreateArchive for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   An entity that you specified doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   The specified state isn't a valid state for an event source.
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
 //
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+//   - ResourceAlreadyExistsException
+//     The resource you are trying to create already exists.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeactivateEventSource
-func (c *CloudWatchEvents) DeactivateEventSource(input *DeactivateEventSourceInput) (*DeactivateEventSourceOutput, error) {
-	req, out := c.DeactivateEventSourceRequest(input)
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+//   - LimitExceededException
+//     The request failed because it attempted to create resource beyond the allowed
+//     service quota.
+//
+//   - InvalidEventPatternException
+//     The event pattern is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/CreateArchive
+func (c *CloudWatchEvents) CreateArchive(input *CreateArchiveInput) (*CreateArchiveOutput, error) {
+	req, out := c.CreateArchiveRequest(input)
 	return out, req.Send()
 }
 
-// DeactivateEventSourceWithContext is the same as DeactivateEventSource with the addition of
+// CreateArchiveWithContext is the same as CreateArchive with the addition of
 // the ability to pass a context and additional request options.
 //
-// See DeactivateEventSource for details on how to use this API operation.
+// See CreateArchive for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) DeactivateEventSourceWithContext(ctx aws.Context, input *DeactivateEventSourceInput, opts ...request.Option) (*DeactivateEventSourceOutput, error) {
-	req, out := c.DeactivateEventSourceRequest(input)
+func (c *CloudWatchEvents) CreateArchiveWithContext(ctx aws.Context, input *CreateArchiveInput, opts ...request.Option) (*CreateArchiveOutput, error) {
+	req, out := c.CreateArchiveRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opDeleteEventBus = "DeleteEventBus"
+const opCreateConnection = "CreateConnection"
 
-// DeleteEventBusRequest generates a "aws/request.Request" representing the
-// client's request for the DeleteEventBus operation. The "output" return
+// CreateConnectionRequest generates a "aws/request.Request" representing the
+// client's request for the CreateConnection operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See DeleteEventBus for more information on using the DeleteEventBus
+// See CreateConnection for more information on using the CreateConnection
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateConnectionRequest method.
+//	req, resp := client.CreateConnectionRequest(params)
 //
-//    // Example sending a request using the DeleteEventBusRequest method.
-//    req, resp := client.DeleteEventBusRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeleteEventBus
-func (c *CloudWatchEvents) DeleteEventBusRequest(input *DeleteEventBusInput) (req *request.Request, output *DeleteEventBusOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/CreateConnection
+func (c *CloudWatchEvents) CreateConnectionRequest(input *CreateConnectionInput) (req *request.Request, output *CreateConnectionOutput) {
 	op := &request.Operation{
-		Name:       opDeleteEventBus,
+		Name:       opCreateConnection,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &DeleteEventBusInput{}
+		input = &CreateConnectionInput{}
 	}
 
-	output = &DeleteEventBusOutput{}
+	output = &CreateConnectionOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// DeleteEventBus API operation for Amazon CloudWatch Events.
+// CreateConnection API operation for Amazon CloudWatch Events.
 //
-// Deletes the specified custom event bus or partner event bus. All rules associated
-// with this event bus are also deleted. You can't delete your account's default
-// event bus.
-//
-// This operation is performed by AWS customers, not by SaaS partners.
+// Creates a connection. A connection defines the authorization type and credentials
+// to use for authorization with an API destination HTTP endpoint.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation DeleteEventBus for usage and error information.
+// API operation CreateConnection for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+// Returned Error Types:
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeleteEventBus
-func (c *CloudWatchEvents) DeleteEventBus(input *DeleteEventBusInput) (*DeleteEventBusOutput, error) {
-	req, out := c.DeleteEventBusRequest(input)
+//   - ResourceAlreadyExistsException
+//     The resource you are trying to create already exists.
+//
+//   - LimitExceededException
+//     The request failed because it attempted to create resource beyond the allowed
+//     service quota.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/CreateConnection
+func (c *CloudWatchEvents) CreateConnection(input *CreateConnectionInput) (*CreateConnectionOutput, error) {
+	req, out := c.CreateConnectionRequest(input)
 	return out, req.Send()
 }
 
-// DeleteEventBusWithContext is the same as DeleteEventBus with the addition of
+// CreateConnectionWithContext is the same as CreateConnection with the addition of
 // the ability to pass a context and additional request options.
 //
-// See DeleteEventBus for details on how to use this API operation.
+// See CreateConnection for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) DeleteEventBusWithContext(ctx aws.Context, input *DeleteEventBusInput, opts ...request.Option) (*DeleteEventBusOutput, error) {
-	req, out := c.DeleteEventBusRequest(input)
+func (c *CloudWatchEvents) CreateConnectionWithContext(ctx aws.Context, input *CreateConnectionInput, opts ...request.Option) (*CreateConnectionOutput, error) {
+	req, out := c.CreateConnectionRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opDeletePartnerEventSource = "DeletePartnerEventSource"
+const opCreateEventBus = "CreateEventBus"
 
-// DeletePartnerEventSourceRequest generates a "aws/request.Request" representing the
-// client's request for the DeletePartnerEventSource operation. The "output" return
+// CreateEventBusRequest generates a "aws/request.Request" representing the
+// client's request for the CreateEventBus operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See DeletePartnerEventSource for more information on using the DeletePartnerEventSource
+// See CreateEventBus for more information on using the CreateEventBus
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateEventBusRequest method.
+//	req, resp := client.CreateEventBusRequest(params)
 //
-//    // Example sending a request using the DeletePartnerEventSourceRequest method.
-//    req, resp := client.DeletePartnerEventSourceRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeletePartnerEventSource
-func (c *CloudWatchEvents) DeletePartnerEventSourceRequest(input *DeletePartnerEventSourceInput) (req *request.Request, output *DeletePartnerEventSourceOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/CreateEventBus
+func (c *CloudWatchEvents) CreateEventBusRequest(input *CreateEventBusInput) (req *request.Request, output *CreateEventBusOutput) {
 	op := &request.Operation{
-		Name:       opDeletePartnerEventSource,
+		Name:       opCreateEventBus,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &DeletePartnerEventSourceInput{}
+		input = &CreateEventBusInput{}
 	}
 
-	output = &DeletePartnerEventSourceOutput{}
+	output = &CreateEventBusOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// DeletePartnerEventSource API operation for Amazon CloudWatch Events.
-//
-// This operation is used by SaaS partners to delete a partner event source.
-// AWS customers don't use this operation.
+// CreateEventBus API operation for Amazon CloudWatch Events.
 //
-// When you delete an event source, the status of the corresponding partner
-// event bus in the AWS customer account becomes DELETED.
+// Creates a new event bus within your account. This can be a custom event bus
+// which you can use to receive events from your custom applications and services,
+// or it can be a partner event bus which can be matched to a partner event
+// source.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation DeletePartnerEventSource for usage and error information.
+// API operation CreateEventBus for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+// Returned Error Types:
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeletePartnerEventSource
-func (c *CloudWatchEvents) DeletePartnerEventSource(input *DeletePartnerEventSourceInput) (*DeletePartnerEventSourceOutput, error) {
-	req, out := c.DeletePartnerEventSourceRequest(input)
+//   - ResourceAlreadyExistsException
+//     The resource you are trying to create already exists.
+//
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+//   - InvalidStateException
+//     The specified state is not a valid state for an event source.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
+//
+//   - LimitExceededException
+//     The request failed because it attempted to create resource beyond the allowed
+//     service quota.
+//
+//   - OperationDisabledException
+//     The operation you are attempting is not available in this region.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/CreateEventBus
+func (c *CloudWatchEvents) CreateEventBus(input *CreateEventBusInput) (*CreateEventBusOutput, error) {
+	req, out := c.CreateEventBusRequest(input)
 	return out, req.Send()
 }
 
-// DeletePartnerEventSourceWithContext is the same as DeletePartnerEventSource with the addition of
+// CreateEventBusWithContext is the same as CreateEventBus with the addition of
 // the ability to pass a context and additional request options.
 //
-// See DeletePartnerEventSource for details on how to use this API operation.
+// See CreateEventBus for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) DeletePartnerEventSourceWithContext(ctx aws.Context, input *DeletePartnerEventSourceInput, opts ...request.Option) (*DeletePartnerEventSourceOutput, error) {
-	req, out := c.DeletePartnerEventSourceRequest(input)
+func (c *CloudWatchEvents) CreateEventBusWithContext(ctx aws.Context, input *CreateEventBusInput, opts ...request.Option) (*CreateEventBusOutput, error) {
+	req, out := c.CreateEventBusRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opDeleteRule = "DeleteRule"
+const opCreatePartnerEventSource = "CreatePartnerEventSource"
 
-// DeleteRuleRequest generates a "aws/request.Request" representing the
-// client's request for the DeleteRule operation. The "output" return
+// CreatePartnerEventSourceRequest generates a "aws/request.Request" representing the
+// client's request for the CreatePartnerEventSource operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See DeleteRule for more information on using the DeleteRule
+// See CreatePartnerEventSource for more information on using the CreatePartnerEventSource
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreatePartnerEventSourceRequest method.
+//	req, resp := client.CreatePartnerEventSourceRequest(params)
 //
-//    // Example sending a request using the DeleteRuleRequest method.
-//    req, resp := client.DeleteRuleRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeleteRule
-func (c *CloudWatchEvents) DeleteRuleRequest(input *DeleteRuleInput) (req *request.Request, output *DeleteRuleOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/CreatePartnerEventSource
+func (c *CloudWatchEvents) CreatePartnerEventSourceRequest(input *CreatePartnerEventSourceInput) (req *request.Request, output *CreatePartnerEventSourceOutput) {
 	op := &request.Operation{
-		Name:       opDeleteRule,
+		Name:       opCreatePartnerEventSource,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &DeleteRuleInput{}
+		input = &CreatePartnerEventSourceInput{}
 	}
 
-	output = &DeleteRuleOutput{}
+	output = &CreatePartnerEventSourceOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// DeleteRule API operation for Amazon CloudWatch Events.
+// CreatePartnerEventSource API operation for Amazon CloudWatch Events.
 //
-// Deletes the specified rule.
+// Called by an SaaS partner to create a partner event source. This operation
+// is not used by Amazon Web Services customers.
 //
-// Before you can delete the rule, you must remove all targets, using RemoveTargets.
+// Each partner event source can be used by one Amazon Web Services account
+// to create a matching partner event bus in that Amazon Web Services account.
+// A SaaS partner must create one partner event source for each Amazon Web Services
+// account that wants to receive those event types.
 //
-// When you delete a rule, incoming events might continue to match to the deleted
-// rule. Allow a short period of time for changes to take effect.
+// A partner event source creates events based on resources within the SaaS
+// partner's service or application.
 //
-// Managed rules are rules created and managed by another AWS service on your
-// behalf. These rules are created by those other AWS services to support functionality
-// in those services. You can delete these rules using the Force option, but
-// you should do so only if you're sure that the other service isn't still using
-// that rule.
+// An Amazon Web Services account that creates a partner event bus that matches
+// the partner event source can use that event bus to receive events from the
+// partner, and then process them using Amazon Web Services Events rules and
+// targets.
+//
+// Partner event source names follow this format:
+//
+// partner_name/event_namespace/event_name
+//
+// partner_name is determined during partner registration and identifies the
+// partner to Amazon Web Services customers. event_namespace is determined by
+// the partner and is a way for the partner to categorize their events. event_name
+// is determined by the partner, and should uniquely identify an event-generating
+// resource within the partner system. The combination of event_namespace and
+// event_name should help Amazon Web Services customers decide whether to create
+// an event bus to receive these events.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation DeleteRule for usage and error information.
+// API operation CreatePartnerEventSource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   There is concurrent modification on a resource.
+// Returned Error Types:
 //
-//   * ErrCodeManagedRuleException "ManagedRuleException"
-//   An AWS service created this rule on behalf of your account. That service
-//   manages it. If you see this error in response to DeleteRule or RemoveTargets,
-//   you can use the Force parameter in those calls to delete the rule or remove
-//   targets from the rule. You can't modify these managed rules by using DisableRule,
-//   EnableRule, PutTargets, PutRule, TagResource, or UntagResource.
+//   - ResourceAlreadyExistsException
+//     The resource you are trying to create already exists.
 //
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+//   - InternalException
+//     This exception occurs due to unexpected causes.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   An entity that you specified doesn't exist.
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeleteRule
-func (c *CloudWatchEvents) DeleteRule(input *DeleteRuleInput) (*DeleteRuleOutput, error) {
-	req, out := c.DeleteRuleRequest(input)
+//   - LimitExceededException
+//     The request failed because it attempted to create resource beyond the allowed
+//     service quota.
+//
+//   - OperationDisabledException
+//     The operation you are attempting is not available in this region.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/CreatePartnerEventSource
+func (c *CloudWatchEvents) CreatePartnerEventSource(input *CreatePartnerEventSourceInput) (*CreatePartnerEventSourceOutput, error) {
+	req, out := c.CreatePartnerEventSourceRequest(input)
 	return out, req.Send()
 }
 
-// DeleteRuleWithContext is the same as DeleteRule with the addition of
+// CreatePartnerEventSourceWithContext is the same as CreatePartnerEventSource with the addition of
 // the ability to pass a context and additional request options.
 //
-// See DeleteRule for details on how to use this API operation.
+// See CreatePartnerEventSource for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) DeleteRuleWithContext(ctx aws.Context, input *DeleteRuleInput, opts ...request.Option) (*DeleteRuleOutput, error) {
-	req, out := c.DeleteRuleRequest(input)
+func (c *CloudWatchEvents) CreatePartnerEventSourceWithContext(ctx aws.Context, input *CreatePartnerEventSourceInput, opts ...request.Option) (*CreatePartnerEventSourceOutput, error) {
+	req, out := c.CreatePartnerEventSourceRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opDescribeEventBus = "DescribeEventBus"
+const opDeactivateEventSource = "DeactivateEventSource"
 
-// DescribeEventBusRequest generates a "aws/request.Request" representing the
-// client's request for the DescribeEventBus operation. The "output" return
+// DeactivateEventSourceRequest generates a "aws/request.Request" representing the
+// client's request for the DeactivateEventSource operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See DescribeEventBus for more information on using the DescribeEventBus
+// See DeactivateEventSource for more information on using the DeactivateEventSource
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeactivateEventSourceRequest method.
+//	req, resp := client.DeactivateEventSourceRequest(params)
 //
-//    // Example sending a request using the DescribeEventBusRequest method.
-//    req, resp := client.DescribeEventBusRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribeEventBus
-func (c *CloudWatchEvents) DescribeEventBusRequest(input *DescribeEventBusInput) (req *request.Request, output *DescribeEventBusOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeactivateEventSource
+func (c *CloudWatchEvents) DeactivateEventSourceRequest(input *DeactivateEventSourceInput) (req *request.Request, output *DeactivateEventSourceOutput) {
 	op := &request.Operation{
-		Name:       opDescribeEventBus,
+		Name:       opDeactivateEventSource,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &DescribeEventBusInput{}
+		input = &DeactivateEventSourceInput{}
 	}
 
-	output = &DescribeEventBusOutput{}
+	output = &DeactivateEventSourceOutput{}
 	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// DescribeEventBus API operation for Amazon CloudWatch Events.
+// DeactivateEventSource API operation for Amazon CloudWatch Events.
 //
-// Displays details about an event bus in your account. This can include the
-// external AWS accounts that are permitted to write events to your default
-// event bus, and the associated policy. For custom event buses and partner
-// event buses, it displays the name, ARN, policy, state, and creation time.
+// You can use this operation to temporarily stop receiving events from the
+// specified partner event source. The matching event bus is not deleted.
 //
-// To enable your account to receive events from other accounts on its default
-// event bus, use PutPermission.
+// When you deactivate a partner event source, the source goes into PENDING
+// state. If it remains in PENDING state for more than two weeks, it is deleted.
 //
-// For more information about partner event buses, see CreateEventBus.
+// To activate a deactivated partner event source, use ActivateEventSource (https://docs.aws.amazon.com/eventbridge/latest/APIReference/API_ActivateEventSource.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation DescribeEventBus for usage and error information.
+// API operation DeactivateEventSource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   An entity that you specified doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribeEventBus
-func (c *CloudWatchEvents) DescribeEventBus(input *DescribeEventBusInput) (*DescribeEventBusOutput, error) {
-	req, out := c.DescribeEventBusRequest(input)
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
+//
+//   - InvalidStateException
+//     The specified state is not a valid state for an event source.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+//   - OperationDisabledException
+//     The operation you are attempting is not available in this region.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeactivateEventSource
+func (c *CloudWatchEvents) DeactivateEventSource(input *DeactivateEventSourceInput) (*DeactivateEventSourceOutput, error) {
+	req, out := c.DeactivateEventSourceRequest(input)
 	return out, req.Send()
 }
 
-// DescribeEventBusWithContext is the same as DescribeEventBus with the addition of
+// DeactivateEventSourceWithContext is the same as DeactivateEventSource with the addition of
 // the ability to pass a context and additional request options.
 //
-// See DescribeEventBus for details on how to use this API operation.
+// See DeactivateEventSource for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) DescribeEventBusWithContext(ctx aws.Context, input *DescribeEventBusInput, opts ...request.Option) (*DescribeEventBusOutput, error) {
-	req, out := c.DescribeEventBusRequest(input)
+func (c *CloudWatchEvents) DeactivateEventSourceWithContext(ctx aws.Context, input *DeactivateEventSourceInput, opts ...request.Option) (*DeactivateEventSourceOutput, error) {
+	req, out := c.DeactivateEventSourceRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opDescribeEventSource = "DescribeEventSource"
+const opDeauthorizeConnection = "DeauthorizeConnection"
 
-// DescribeEventSourceRequest generates a "aws/request.Request" representing the
-// client's request for the DescribeEventSource operation. The "output" return
+// DeauthorizeConnectionRequest generates a "aws/request.Request" representing the
+// client's request for the DeauthorizeConnection operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See DescribeEventSource for more information on using the DescribeEventSource
+// See DeauthorizeConnection for more information on using the DeauthorizeConnection
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeauthorizeConnectionRequest method.
+//	req, resp := client.DeauthorizeConnectionRequest(params)
 //
-//    // Example sending a request using the DescribeEventSourceRequest method.
-//    req, resp := client.DescribeEventSourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribeEventSource
-func (c *CloudWatchEvents) DescribeEventSourceRequest(input *DescribeEventSourceInput) (req *request.Request, output *DescribeEventSourceOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeauthorizeConnection
+func (c *CloudWatchEvents) DeauthorizeConnectionRequest(input *DeauthorizeConnectionInput) (req *request.Request, output *DeauthorizeConnectionOutput) {
 	op := &request.Operation{
-		Name:       opDescribeEventSource,
+		Name:       opDeauthorizeConnection,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &DescribeEventSourceInput{}
+		input = &DeauthorizeConnectionInput{}
 	}
 
-	output = &DescribeEventSourceOutput{}
+	output = &DeauthorizeConnectionOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// DescribeEventSource API operation for Amazon CloudWatch Events.
-//
-// This operation lists details about a partner event source that is shared
-// with your account.
+// DeauthorizeConnection API operation for Amazon CloudWatch Events.
 //
-// This operation is run by AWS customers, not by SaaS partners.
+// Removes all authorization parameters from the connection. This lets you remove
+// the secret from the connection so you can reuse it without having to create
+// a new connection.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation DescribeEventSource for usage and error information.
+// API operation DeauthorizeConnection for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   An entity that you specified doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribeEventSource
-func (c *CloudWatchEvents) DescribeEventSource(input *DescribeEventSourceInput) (*DescribeEventSourceOutput, error) {
-	req, out := c.DescribeEventSourceRequest(input)
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeauthorizeConnection
+func (c *CloudWatchEvents) DeauthorizeConnection(input *DeauthorizeConnectionInput) (*DeauthorizeConnectionOutput, error) {
+	req, out := c.DeauthorizeConnectionRequest(input)
 	return out, req.Send()
 }
 
-// DescribeEventSourceWithContext is the same as DescribeEventSource with the addition of
+// DeauthorizeConnectionWithContext is the same as DeauthorizeConnection with the addition of
 // the ability to pass a context and additional request options.
 //
-// See DescribeEventSource for details on how to use this API operation.
+// See DeauthorizeConnection for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) DescribeEventSourceWithContext(ctx aws.Context, input *DescribeEventSourceInput, opts ...request.Option) (*DescribeEventSourceOutput, error) {
-	req, out := c.DescribeEventSourceRequest(input)
+func (c *CloudWatchEvents) DeauthorizeConnectionWithContext(ctx aws.Context, input *DeauthorizeConnectionInput, opts ...request.Option) (*DeauthorizeConnectionOutput, error) {
+	req, out := c.DeauthorizeConnectionRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opDescribePartnerEventSource = "DescribePartnerEventSource"
+const opDeleteApiDestination = "DeleteApiDestination"
 
-// DescribePartnerEventSourceRequest generates a "aws/request.Request" representing the
-// client's request for the DescribePartnerEventSource operation. The "output" return
+// DeleteApiDestinationRequest generates a "aws/request.Request" representing the
+// client's request for the DeleteApiDestination operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See DescribePartnerEventSource for more information on using the DescribePartnerEventSource
+// See DeleteApiDestination for more information on using the DeleteApiDestination
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteApiDestinationRequest method.
+//	req, resp := client.DeleteApiDestinationRequest(params)
 //
-//    // Example sending a request using the DescribePartnerEventSourceRequest method.
-//    req, resp := client.DescribePartnerEventSourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribePartnerEventSource
-func (c *CloudWatchEvents) DescribePartnerEventSourceRequest(input *DescribePartnerEventSourceInput) (req *request.Request, output *DescribePartnerEventSourceOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeleteApiDestination
+func (c *CloudWatchEvents) DeleteApiDestinationRequest(input *DeleteApiDestinationInput) (req *request.Request, output *DeleteApiDestinationOutput) {
 	op := &request.Operation{
-		Name:       opDescribePartnerEventSource,
+		Name:       opDeleteApiDestination,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &DescribePartnerEventSourceInput{}
+		input = &DeleteApiDestinationInput{}
 	}
 
-	output = &DescribePartnerEventSourceOutput{}
+	output = &DeleteApiDestinationOutput{}
 	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// DescribePartnerEventSource API operation for Amazon CloudWatch Events.
-//
-// An SaaS partner can use this operation to list details about a partner event
-// source that they have created.
+// DeleteApiDestination API operation for Amazon CloudWatch Events.
 //
-// AWS customers do not use this operation. Instead, AWS customers can use DescribeEventSource
-// to see details about a partner event source that is shared with them.
+// Deletes the specified API destination.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation DescribePartnerEventSource for usage and error information.
+// API operation DeleteApiDestination for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   An entity that you specified doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribePartnerEventSource
-func (c *CloudWatchEvents) DescribePartnerEventSource(input *DescribePartnerEventSourceInput) (*DescribePartnerEventSourceOutput, error) {
-	req, out := c.DescribePartnerEventSourceRequest(input)
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeleteApiDestination
+func (c *CloudWatchEvents) DeleteApiDestination(input *DeleteApiDestinationInput) (*DeleteApiDestinationOutput, error) {
+	req, out := c.DeleteApiDestinationRequest(input)
 	return out, req.Send()
 }
 
-// DescribePartnerEventSourceWithContext is the same as DescribePartnerEventSource with the addition of
+// DeleteApiDestinationWithContext is the same as DeleteApiDestination with the addition of
 // the ability to pass a context and additional request options.
 //
-// See DescribePartnerEventSource for details on how to use this API operation.
+// See DeleteApiDestination for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) DescribePartnerEventSourceWithContext(ctx aws.Context, input *DescribePartnerEventSourceInput, opts ...request.Option) (*DescribePartnerEventSourceOutput, error) {
-	req, out := c.DescribePartnerEventSourceRequest(input)
+func (c *CloudWatchEvents) DeleteApiDestinationWithContext(ctx aws.Context, input *DeleteApiDestinationInput, opts ...request.Option) (*DeleteApiDestinationOutput, error) {
+	req, out := c.DeleteApiDestinationRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opDescribeRule = "DescribeRule"
+const opDeleteArchive = "DeleteArchive"
 
-// DescribeRuleRequest generates a "aws/request.Request" representing the
-// client's request for the DescribeRule operation. The "output" return
+// DeleteArchiveRequest generates a "aws/request.Request" representing the
+// client's request for the DeleteArchive operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See DescribeRule for more information on using the DescribeRule
+// See DeleteArchive for more information on using the DeleteArchive
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteArchiveRequest method.
+//	req, resp := client.DeleteArchiveRequest(params)
 //
-//    // Example sending a request using the DescribeRuleRequest method.
-//    req, resp := client.DescribeRuleRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribeRule
-func (c *CloudWatchEvents) DescribeRuleRequest(input *DescribeRuleInput) (req *request.Request, output *DescribeRuleOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeleteArchive
+func (c *CloudWatchEvents) DeleteArchiveRequest(input *DeleteArchiveInput) (req *request.Request, output *DeleteArchiveOutput) {
 	op := &request.Operation{
-		Name:       opDescribeRule,
+		Name:       opDeleteArchive,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &DescribeRuleInput{}
+		input = &DeleteArchiveInput{}
 	}
 
-	output = &DescribeRuleOutput{}
+	output = &DeleteArchiveOutput{}
 	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// DescribeRule API operation for Amazon CloudWatch Events.
+// DeleteArchive API operation for Amazon CloudWatch Events.
 //
-// Describes the specified rule.
-//
-// DescribeRule doesn't list the targets of a rule. To see the targets associated
-// with a rule, use ListTargetsByRule.
+// Deletes the specified archive.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation DescribeRule for usage and error information.
+// API operation DeleteArchive for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   An entity that you specified doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribeRule
-func (c *CloudWatchEvents) DescribeRule(input *DescribeRuleInput) (*DescribeRuleOutput, error) {
-	req, out := c.DescribeRuleRequest(input)
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeleteArchive
+func (c *CloudWatchEvents) DeleteArchive(input *DeleteArchiveInput) (*DeleteArchiveOutput, error) {
+	req, out := c.DeleteArchiveRequest(input)
 	return out, req.Send()
 }
 
-// DescribeRuleWithContext is the same as DescribeRule with the addition of
+// DeleteArchiveWithContext is the same as DeleteArchive with the addition of
 // the ability to pass a context and additional request options.
 //
-// See DescribeRule for details on how to use this API operation.
+// See DeleteArchive for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) DescribeRuleWithContext(ctx aws.Context, input *DescribeRuleInput, opts ...request.Option) (*DescribeRuleOutput, error) {
-	req, out := c.DescribeRuleRequest(input)
+func (c *CloudWatchEvents) DeleteArchiveWithContext(ctx aws.Context, input *DeleteArchiveInput, opts ...request.Option) (*DeleteArchiveOutput, error) {
+	req, out := c.DeleteArchiveRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opDisableRule = "DisableRule"
+const opDeleteConnection = "DeleteConnection"
 
-// DisableRuleRequest generates a "aws/request.Request" representing the
-// client's request for the DisableRule operation. The "output" return
+// DeleteConnectionRequest generates a "aws/request.Request" representing the
+// client's request for the DeleteConnection operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See DisableRule for more information on using the DisableRule
+// See DeleteConnection for more information on using the DeleteConnection
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteConnectionRequest method.
+//	req, resp := client.DeleteConnectionRequest(params)
 //
-//    // Example sending a request using the DisableRuleRequest method.
-//    req, resp := client.DisableRuleRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DisableRule
-func (c *CloudWatchEvents) DisableRuleRequest(input *DisableRuleInput) (req *request.Request, output *DisableRuleOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeleteConnection
+func (c *CloudWatchEvents) DeleteConnectionRequest(input *DeleteConnectionInput) (req *request.Request, output *DeleteConnectionOutput) {
 	op := &request.Operation{
-		Name:       opDisableRule,
+		Name:       opDeleteConnection,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &DisableRuleInput{}
+		input = &DeleteConnectionInput{}
 	}
 
-	output = &DisableRuleOutput{}
+	output = &DeleteConnectionOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// DisableRule API operation for Amazon CloudWatch Events.
+// DeleteConnection API operation for Amazon CloudWatch Events.
 //
-// Disables the specified rule. A disabled rule won't match any events and won't
-// self-trigger if it has a schedule expression.
-//
-// When you disable a rule, incoming events might continue to match to the disabled
-// rule. Allow a short period of time for changes to take effect.
+// Deletes a connection.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation DisableRule for usage and error information.
+// API operation DeleteConnection for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   An entity that you specified doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   There is concurrent modification on a resource.
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
 //
-//   * ErrCodeManagedRuleException "ManagedRuleException"
-//   An AWS service created this rule on behalf of your account. That service
-//   manages it. If you see this error in response to DeleteRule or RemoveTargets,
-//   you can use the Force parameter in those calls to delete the rule or remove
-//   targets from the rule. You can't modify these managed rules by using DisableRule,
-//   EnableRule, PutTargets, PutRule, TagResource, or UntagResource.
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
 //
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+//   - InternalException
+//     This exception occurs due to unexpected causes.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DisableRule
-func (c *CloudWatchEvents) DisableRule(input *DisableRuleInput) (*DisableRuleOutput, error) {
-	req, out := c.DisableRuleRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeleteConnection
+func (c *CloudWatchEvents) DeleteConnection(input *DeleteConnectionInput) (*DeleteConnectionOutput, error) {
+	req, out := c.DeleteConnectionRequest(input)
 	return out, req.Send()
 }
 
-// DisableRuleWithContext is the same as DisableRule with the addition of
+// DeleteConnectionWithContext is the same as DeleteConnection with the addition of
 // the ability to pass a context and additional request options.
 //
-// See DisableRule for details on how to use this API operation.
+// See DeleteConnection for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) DisableRuleWithContext(ctx aws.Context, input *DisableRuleInput, opts ...request.Option) (*DisableRuleOutput, error) {
-	req, out := c.DisableRuleRequest(input)
+func (c *CloudWatchEvents) DeleteConnectionWithContext(ctx aws.Context, input *DeleteConnectionInput, opts ...request.Option) (*DeleteConnectionOutput, error) {
+	req, out := c.DeleteConnectionRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opEnableRule = "EnableRule"
+const opDeleteEventBus = "DeleteEventBus"
 
-// EnableRuleRequest generates a "aws/request.Request" representing the
-// client's request for the EnableRule operation. The "output" return
+// DeleteEventBusRequest generates a "aws/request.Request" representing the
+// client's request for the DeleteEventBus operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See EnableRule for more information on using the EnableRule
+// See DeleteEventBus for more information on using the DeleteEventBus
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteEventBusRequest method.
+//	req, resp := client.DeleteEventBusRequest(params)
 //
-//    // Example sending a request using the EnableRuleRequest method.
-//    req, resp := client.EnableRuleRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/EnableRule
-func (c *CloudWatchEvents) EnableRuleRequest(input *EnableRuleInput) (req *request.Request, output *EnableRuleOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeleteEventBus
+func (c *CloudWatchEvents) DeleteEventBusRequest(input *DeleteEventBusInput) (req *request.Request, output *DeleteEventBusOutput) {
 	op := &request.Operation{
-		Name:       opEnableRule,
+		Name:       opDeleteEventBus,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &EnableRuleInput{}
+		input = &DeleteEventBusInput{}
 	}
 
-	output = &EnableRuleOutput{}
+	output = &DeleteEventBusOutput{}
 	req = c.newRequest(op, input, output)
 	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// EnableRule API operation for Amazon CloudWatch Events.
-//
-// Enables the specified rule. If the rule doesn't exist, the operation fails.
+// DeleteEventBus API operation for Amazon CloudWatch Events.
 //
-// When you enable a rule, incoming events might not immediately start matching
-// to a newly enabled rule. Allow a short period of time for changes to take
-// effect.
+// Deletes the specified custom event bus or partner event bus. All rules associated
+// with this event bus need to be deleted. You can't delete your account's default
+// event bus.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation EnableRule for usage and error information.
-//
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   An entity that you specified doesn't exist.
+// API operation DeleteEventBus for usage and error information.
 //
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   There is concurrent modification on a resource.
+// Returned Error Types:
 //
-//   * ErrCodeManagedRuleException "ManagedRuleException"
-//   An AWS service created this rule on behalf of your account. That service
-//   manages it. If you see this error in response to DeleteRule or RemoveTargets,
-//   you can use the Force parameter in those calls to delete the rule or remove
-//   targets from the rule. You can't modify these managed rules by using DisableRule,
-//   EnableRule, PutTargets, PutRule, TagResource, or UntagResource.
+//   - InternalException
+//     This exception occurs due to unexpected causes.
 //
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/EnableRule
-func (c *CloudWatchEvents) EnableRule(input *EnableRuleInput) (*EnableRuleOutput, error) {
-	req, out := c.EnableRuleRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeleteEventBus
+func (c *CloudWatchEvents) DeleteEventBus(input *DeleteEventBusInput) (*DeleteEventBusOutput, error) {
+	req, out := c.DeleteEventBusRequest(input)
 	return out, req.Send()
 }
 
-// EnableRuleWithContext is the same as EnableRule with the addition of
+// DeleteEventBusWithContext is the same as DeleteEventBus with the addition of
 // the ability to pass a context and additional request options.
 //
-// See EnableRule for details on how to use this API operation.
+// See DeleteEventBus for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) EnableRuleWithContext(ctx aws.Context, input *EnableRuleInput, opts ...request.Option) (*EnableRuleOutput, error) {
-	req, out := c.EnableRuleRequest(input)
+func (c *CloudWatchEvents) DeleteEventBusWithContext(ctx aws.Context, input *DeleteEventBusInput, opts ...request.Option) (*DeleteEventBusOutput, error) {
+	req, out := c.DeleteEventBusRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListEventBuses = "ListEventBuses"
+const opDeletePartnerEventSource = "DeletePartnerEventSource"
 
-// ListEventBusesRequest generates a "aws/request.Request" representing the
-// client's request for the ListEventBuses operation. The "output" return
+// DeletePartnerEventSourceRequest generates a "aws/request.Request" representing the
+// client's request for the DeletePartnerEventSource operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListEventBuses for more information on using the ListEventBuses
+// See DeletePartnerEventSource for more information on using the DeletePartnerEventSource
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeletePartnerEventSourceRequest method.
+//	req, resp := client.DeletePartnerEventSourceRequest(params)
 //
-//    // Example sending a request using the ListEventBusesRequest method.
-//    req, resp := client.ListEventBusesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListEventBuses
-func (c *CloudWatchEvents) ListEventBusesRequest(input *ListEventBusesInput) (req *request.Request, output *ListEventBusesOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeletePartnerEventSource
+func (c *CloudWatchEvents) DeletePartnerEventSourceRequest(input *DeletePartnerEventSourceInput) (req *request.Request, output *DeletePartnerEventSourceOutput) {
 	op := &request.Operation{
-		Name:       opListEventBuses,
+		Name:       opDeletePartnerEventSource,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &ListEventBusesInput{}
+		input = &DeletePartnerEventSourceInput{}
 	}
 
-	output = &ListEventBusesOutput{}
+	output = &DeletePartnerEventSourceOutput{}
 	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// ListEventBuses API operation for Amazon CloudWatch Events.
+// DeletePartnerEventSource API operation for Amazon CloudWatch Events.
 //
-// Lists all the event buses in your account, including the default event bus,
-// custom event buses, and partner event buses.
+// This operation is used by SaaS partners to delete a partner event source.
+// This operation is not used by Amazon Web Services customers.
 //
-// This operation is run by AWS customers, not by SaaS partners.
+// When you delete an event source, the status of the corresponding partner
+// event bus in the Amazon Web Services customer account becomes DELETED.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation ListEventBuses for usage and error information.
+// API operation DeletePartnerEventSource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+// Returned Error Types:
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListEventBuses
-func (c *CloudWatchEvents) ListEventBuses(input *ListEventBusesInput) (*ListEventBusesOutput, error) {
-	req, out := c.ListEventBusesRequest(input)
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
+//
+//   - OperationDisabledException
+//     The operation you are attempting is not available in this region.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeletePartnerEventSource
+func (c *CloudWatchEvents) DeletePartnerEventSource(input *DeletePartnerEventSourceInput) (*DeletePartnerEventSourceOutput, error) {
+	req, out := c.DeletePartnerEventSourceRequest(input)
 	return out, req.Send()
 }
 
-// ListEventBusesWithContext is the same as ListEventBuses with the addition of
+// DeletePartnerEventSourceWithContext is the same as DeletePartnerEventSource with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListEventBuses for details on how to use this API operation.
+// See DeletePartnerEventSource for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) ListEventBusesWithContext(ctx aws.Context, input *ListEventBusesInput, opts ...request.Option) (*ListEventBusesOutput, error) {
-	req, out := c.ListEventBusesRequest(input)
+func (c *CloudWatchEvents) DeletePartnerEventSourceWithContext(ctx aws.Context, input *DeletePartnerEventSourceInput, opts ...request.Option) (*DeletePartnerEventSourceOutput, error) {
+	req, out := c.DeletePartnerEventSourceRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListEventSources = "ListEventSources"
+const opDeleteRule = "DeleteRule"
 
-// ListEventSourcesRequest generates a "aws/request.Request" representing the
-// client's request for the ListEventSources operation. The "output" return
+// DeleteRuleRequest generates a "aws/request.Request" representing the
+// client's request for the DeleteRule operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListEventSources for more information on using the ListEventSources
+// See DeleteRule for more information on using the DeleteRule
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteRuleRequest method.
+//	req, resp := client.DeleteRuleRequest(params)
 //
-//    // Example sending a request using the ListEventSourcesRequest method.
-//    req, resp := client.ListEventSourcesRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListEventSources
-func (c *CloudWatchEvents) ListEventSourcesRequest(input *ListEventSourcesInput) (req *request.Request, output *ListEventSourcesOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeleteRule
+func (c *CloudWatchEvents) DeleteRuleRequest(input *DeleteRuleInput) (req *request.Request, output *DeleteRuleOutput) {
 	op := &request.Operation{
-		Name:       opListEventSources,
+		Name:       opDeleteRule,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &ListEventSourcesInput{}
+		input = &DeleteRuleInput{}
 	}
 
-	output = &ListEventSourcesOutput{}
+	output = &DeleteRuleOutput{}
 	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// ListEventSources API operation for Amazon CloudWatch Events.
+// DeleteRule API operation for Amazon CloudWatch Events.
 //
-// You can use this to see all the partner event sources that have been shared
-// with your AWS account. For more information about partner event sources,
-// see CreateEventBus.
+// Deletes the specified rule.
+//
+// Before you can delete the rule, you must remove all targets, using RemoveTargets
+// (https://docs.aws.amazon.com/eventbridge/latest/APIReference/API_RemoveTargets.html).
+//
+// When you delete a rule, incoming events might continue to match to the deleted
+// rule. Allow a short period of time for changes to take effect.
 //
-// This operation is run by AWS customers, not by SaaS partners.
+// If you call delete rule multiple times for the same rule, all calls will
+// succeed. When you call delete rule for a non-existent custom eventbus, ResourceNotFoundException
+// is returned.
+//
+// Managed rules are rules created and managed by another Amazon Web Services
+// service on your behalf. These rules are created by those other Amazon Web
+// Services services to support functionality in those services. You can delete
+// these rules using the Force option, but you should do so only if you are
+// sure the other service is not still using that rule.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation ListEventSources for usage and error information.
+// API operation DeleteRule for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+// Returned Error Types:
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListEventSources
-func (c *CloudWatchEvents) ListEventSources(input *ListEventSourcesInput) (*ListEventSourcesOutput, error) {
-	req, out := c.ListEventSourcesRequest(input)
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
+//
+//   - ManagedRuleException
+//     This rule was created by an Amazon Web Services service on behalf of your
+//     account. It is managed by that service. If you see this error in response
+//     to DeleteRule or RemoveTargets, you can use the Force parameter in those
+//     calls to delete the rule or remove targets from the rule. You cannot modify
+//     these managed rules by using DisableRule, EnableRule, PutTargets, PutRule,
+//     TagResource, or UntagResource.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DeleteRule
+func (c *CloudWatchEvents) DeleteRule(input *DeleteRuleInput) (*DeleteRuleOutput, error) {
+	req, out := c.DeleteRuleRequest(input)
 	return out, req.Send()
 }
 
-// ListEventSourcesWithContext is the same as ListEventSources with the addition of
+// DeleteRuleWithContext is the same as DeleteRule with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListEventSources for details on how to use this API operation.
+// See DeleteRule for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) ListEventSourcesWithContext(ctx aws.Context, input *ListEventSourcesInput, opts ...request.Option) (*ListEventSourcesOutput, error) {
-	req, out := c.ListEventSourcesRequest(input)
+func (c *CloudWatchEvents) DeleteRuleWithContext(ctx aws.Context, input *DeleteRuleInput, opts ...request.Option) (*DeleteRuleOutput, error) {
+	req, out := c.DeleteRuleRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListPartnerEventSourceAccounts = "ListPartnerEventSourceAccounts"
+const opDescribeApiDestination = "DescribeApiDestination"
 
-// ListPartnerEventSourceAccountsRequest generates a "aws/request.Request" representing the
-// client's request for the ListPartnerEventSourceAccounts operation. The "output" return
+// DescribeApiDestinationRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeApiDestination operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListPartnerEventSourceAccounts for more information on using the ListPartnerEventSourceAccounts
+// See DescribeApiDestination for more information on using the DescribeApiDestination
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeApiDestinationRequest method.
+//	req, resp := client.DescribeApiDestinationRequest(params)
 //
-//    // Example sending a request using the ListPartnerEventSourceAccountsRequest method.
-//    req, resp := client.ListPartnerEventSourceAccountsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListPartnerEventSourceAccounts
-func (c *CloudWatchEvents) ListPartnerEventSourceAccountsRequest(input *ListPartnerEventSourceAccountsInput) (req *request.Request, output *ListPartnerEventSourceAccountsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribeApiDestination
+func (c *CloudWatchEvents) DescribeApiDestinationRequest(input *DescribeApiDestinationInput) (req *request.Request, output *DescribeApiDestinationOutput) {
 	op := &request.Operation{
-		Name:       opListPartnerEventSourceAccounts,
+		Name:       opDescribeApiDestination,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &ListPartnerEventSourceAccountsInput{}
+		input = &DescribeApiDestinationInput{}
 	}
 
-	output = &ListPartnerEventSourceAccountsOutput{}
+	output = &DescribeApiDestinationOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListPartnerEventSourceAccounts API operation for Amazon CloudWatch Events.
+// DescribeApiDestination API operation for Amazon CloudWatch Events.
 //
-// An SaaS partner can use this operation to display the AWS account ID that
-// a particular partner event source name is associated with.
-//
-// This operation is used by SaaS partners, not by AWS customers.
+// Retrieves details about an API destination.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation ListPartnerEventSourceAccounts for usage and error information.
+// API operation DescribeApiDestination for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   An entity that you specified doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListPartnerEventSourceAccounts
-func (c *CloudWatchEvents) ListPartnerEventSourceAccounts(input *ListPartnerEventSourceAccountsInput) (*ListPartnerEventSourceAccountsOutput, error) {
-	req, out := c.ListPartnerEventSourceAccountsRequest(input)
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribeApiDestination
+func (c *CloudWatchEvents) DescribeApiDestination(input *DescribeApiDestinationInput) (*DescribeApiDestinationOutput, error) {
+	req, out := c.DescribeApiDestinationRequest(input)
 	return out, req.Send()
 }
 
-// ListPartnerEventSourceAccountsWithContext is the same as ListPartnerEventSourceAccounts with the addition of
+// DescribeApiDestinationWithContext is the same as DescribeApiDestination with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListPartnerEventSourceAccounts for details on how to use this API operation.
+// See DescribeApiDestination for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) ListPartnerEventSourceAccountsWithContext(ctx aws.Context, input *ListPartnerEventSourceAccountsInput, opts ...request.Option) (*ListPartnerEventSourceAccountsOutput, error) {
-	req, out := c.ListPartnerEventSourceAccountsRequest(input)
+func (c *CloudWatchEvents) DescribeApiDestinationWithContext(ctx aws.Context, input *DescribeApiDestinationInput, opts ...request.Option) (*DescribeApiDestinationOutput, error) {
+	req, out := c.DescribeApiDestinationRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListPartnerEventSources = "ListPartnerEventSources"
+const opDescribeArchive = "DescribeArchive"
 
-// ListPartnerEventSourcesRequest generates a "aws/request.Request" representing the
-// client's request for the ListPartnerEventSources operation. The "output" return
+// DescribeArchiveRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeArchive operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListPartnerEventSources for more information on using the ListPartnerEventSources
+// See DescribeArchive for more information on using the DescribeArchive
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeArchiveRequest method.
+//	req, resp := client.DescribeArchiveRequest(params)
 //
-//    // Example sending a request using the ListPartnerEventSourcesRequest method.
-//    req, resp := client.ListPartnerEventSourcesRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListPartnerEventSources
-func (c *CloudWatchEvents) ListPartnerEventSourcesRequest(input *ListPartnerEventSourcesInput) (req *request.Request, output *ListPartnerEventSourcesOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribeArchive
+func (c *CloudWatchEvents) DescribeArchiveRequest(input *DescribeArchiveInput) (req *request.Request, output *DescribeArchiveOutput) {
 	op := &request.Operation{
-		Name:       opListPartnerEventSources,
+		Name:       opDescribeArchive,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &ListPartnerEventSourcesInput{}
+		input = &DescribeArchiveInput{}
 	}
 
-	output = &ListPartnerEventSourcesOutput{}
+	output = &DescribeArchiveOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListPartnerEventSources API operation for Amazon CloudWatch Events.
-//
-// An SaaS partner can use this operation to list all the partner event source
-// names that they have created.
+// DescribeArchive API operation for Amazon CloudWatch Events.
 //
-// This operation is not used by AWS customers.
+// Retrieves details about an archive.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation ListPartnerEventSources for usage and error information.
+// API operation DescribeArchive for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+// Returned Error Types:
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListPartnerEventSources
-func (c *CloudWatchEvents) ListPartnerEventSources(input *ListPartnerEventSourcesInput) (*ListPartnerEventSourcesOutput, error) {
-	req, out := c.ListPartnerEventSourcesRequest(input)
+//   - ResourceAlreadyExistsException
+//     The resource you are trying to create already exists.
+//
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribeArchive
+func (c *CloudWatchEvents) DescribeArchive(input *DescribeArchiveInput) (*DescribeArchiveOutput, error) {
+	req, out := c.DescribeArchiveRequest(input)
 	return out, req.Send()
 }
 
-// ListPartnerEventSourcesWithContext is the same as ListPartnerEventSources with the addition of
+// DescribeArchiveWithContext is the same as DescribeArchive with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListPartnerEventSources for details on how to use this API operation.
+// See DescribeArchive for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) ListPartnerEventSourcesWithContext(ctx aws.Context, input *ListPartnerEventSourcesInput, opts ...request.Option) (*ListPartnerEventSourcesOutput, error) {
-	req, out := c.ListPartnerEventSourcesRequest(input)
+func (c *CloudWatchEvents) DescribeArchiveWithContext(ctx aws.Context, input *DescribeArchiveInput, opts ...request.Option) (*DescribeArchiveOutput, error) {
+	req, out := c.DescribeArchiveRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListRuleNamesByTarget = "ListRuleNamesByTarget"
+const opDescribeConnection = "DescribeConnection"
 
-// ListRuleNamesByTargetRequest generates a "aws/request.Request" representing the
-// client's request for the ListRuleNamesByTarget operation. The "output" return
+// DescribeConnectionRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeConnection operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListRuleNamesByTarget for more information on using the ListRuleNamesByTarget
+// See DescribeConnection for more information on using the DescribeConnection
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeConnectionRequest method.
+//	req, resp := client.DescribeConnectionRequest(params)
 //
-//    // Example sending a request using the ListRuleNamesByTargetRequest method.
-//    req, resp := client.ListRuleNamesByTargetRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListRuleNamesByTarget
-func (c *CloudWatchEvents) ListRuleNamesByTargetRequest(input *ListRuleNamesByTargetInput) (req *request.Request, output *ListRuleNamesByTargetOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribeConnection
+func (c *CloudWatchEvents) DescribeConnectionRequest(input *DescribeConnectionInput) (req *request.Request, output *DescribeConnectionOutput) {
 	op := &request.Operation{
-		Name:       opListRuleNamesByTarget,
+		Name:       opDescribeConnection,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &ListRuleNamesByTargetInput{}
+		input = &DescribeConnectionInput{}
 	}
 
-	output = &ListRuleNamesByTargetOutput{}
+	output = &DescribeConnectionOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListRuleNamesByTarget API operation for Amazon CloudWatch Events.
+// DescribeConnection API operation for Amazon CloudWatch Events.
 //
-// Lists the rules for the specified target. You can see which rules can invoke
-// a specific target in your account.
+// Retrieves details about a connection.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation ListRuleNamesByTarget for usage and error information.
+// API operation DescribeConnection for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+// Returned Error Types:
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   An entity that you specified doesn't exist.
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListRuleNamesByTarget
-func (c *CloudWatchEvents) ListRuleNamesByTarget(input *ListRuleNamesByTargetInput) (*ListRuleNamesByTargetOutput, error) {
-	req, out := c.ListRuleNamesByTargetRequest(input)
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribeConnection
+func (c *CloudWatchEvents) DescribeConnection(input *DescribeConnectionInput) (*DescribeConnectionOutput, error) {
+	req, out := c.DescribeConnectionRequest(input)
 	return out, req.Send()
 }
 
-// ListRuleNamesByTargetWithContext is the same as ListRuleNamesByTarget with the addition of
+// DescribeConnectionWithContext is the same as DescribeConnection with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListRuleNamesByTarget for details on how to use this API operation.
+// See DescribeConnection for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) ListRuleNamesByTargetWithContext(ctx aws.Context, input *ListRuleNamesByTargetInput, opts ...request.Option) (*ListRuleNamesByTargetOutput, error) {
-	req, out := c.ListRuleNamesByTargetRequest(input)
+func (c *CloudWatchEvents) DescribeConnectionWithContext(ctx aws.Context, input *DescribeConnectionInput, opts ...request.Option) (*DescribeConnectionOutput, error) {
+	req, out := c.DescribeConnectionRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListRules = "ListRules"
+const opDescribeEventBus = "DescribeEventBus"
 
-// ListRulesRequest generates a "aws/request.Request" representing the
-// client's request for the ListRules operation. The "output" return
+// DescribeEventBusRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeEventBus operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListRules for more information on using the ListRules
+// See DescribeEventBus for more information on using the DescribeEventBus
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeEventBusRequest method.
+//	req, resp := client.DescribeEventBusRequest(params)
 //
-//    // Example sending a request using the ListRulesRequest method.
-//    req, resp := client.ListRulesRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListRules
-func (c *CloudWatchEvents) ListRulesRequest(input *ListRulesInput) (req *request.Request, output *ListRulesOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribeEventBus
+func (c *CloudWatchEvents) DescribeEventBusRequest(input *DescribeEventBusInput) (req *request.Request, output *DescribeEventBusOutput) {
 	op := &request.Operation{
-		Name:       opListRules,
+		Name:       opDescribeEventBus,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &ListRulesInput{}
+		input = &DescribeEventBusInput{}
 	}
 
-	output = &ListRulesOutput{}
+	output = &DescribeEventBusOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListRules API operation for Amazon CloudWatch Events.
+// DescribeEventBus API operation for Amazon CloudWatch Events.
+//
+// Displays details about an event bus in your account. This can include the
+// external Amazon Web Services accounts that are permitted to write events
+// to your default event bus, and the associated policy. For custom event buses
+// and partner event buses, it displays the name, ARN, policy, state, and creation
+// time.
 //
-// Lists your EventBridge rules. You can either list all the rules or provide
-// a prefix to match to the rule names.
+// To enable your account to receive events from other accounts on its default
+// event bus, use PutPermission (https://docs.aws.amazon.com/eventbridge/latest/APIReference/API_PutPermission.html).
 //
-// ListRules doesn't list the targets of a rule. To see the targets associated
-// with a rule, use ListTargetsByRule.
+// For more information about partner event buses, see CreateEventBus (https://docs.aws.amazon.com/eventbridge/latest/APIReference/API_CreateEventBus.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation ListRules for usage and error information.
+// API operation DescribeEventBus for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+// Returned Error Types:
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   An entity that you specified doesn't exist.
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListRules
-func (c *CloudWatchEvents) ListRules(input *ListRulesInput) (*ListRulesOutput, error) {
-	req, out := c.ListRulesRequest(input)
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribeEventBus
+func (c *CloudWatchEvents) DescribeEventBus(input *DescribeEventBusInput) (*DescribeEventBusOutput, error) {
+	req, out := c.DescribeEventBusRequest(input)
 	return out, req.Send()
 }
 
-// ListRulesWithContext is the same as ListRules with the addition of
+// DescribeEventBusWithContext is the same as DescribeEventBus with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListRules for details on how to use this API operation.
+// See DescribeEventBus for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) ListRulesWithContext(ctx aws.Context, input *ListRulesInput, opts ...request.Option) (*ListRulesOutput, error) {
-	req, out := c.ListRulesRequest(input)
+func (c *CloudWatchEvents) DescribeEventBusWithContext(ctx aws.Context, input *DescribeEventBusInput, opts ...request.Option) (*DescribeEventBusOutput, error) {
+	req, out := c.DescribeEventBusRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListTagsForResource = "ListTagsForResource"
+const opDescribeEventSource = "DescribeEventSource"
 
-// ListTagsForResourceRequest generates a "aws/request.Request" representing the
-// client's request for the ListTagsForResource operation. The "output" return
+// DescribeEventSourceRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeEventSource operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListTagsForResource for more information on using the ListTagsForResource
+// See DescribeEventSource for more information on using the DescribeEventSource
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeEventSourceRequest method.
+//	req, resp := client.DescribeEventSourceRequest(params)
 //
-//    // Example sending a request using the ListTagsForResourceRequest method.
-//    req, resp := client.ListTagsForResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListTagsForResource
-func (c *CloudWatchEvents) ListTagsForResourceRequest(input *ListTagsForResourceInput) (req *request.Request, output *ListTagsForResourceOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribeEventSource
+func (c *CloudWatchEvents) DescribeEventSourceRequest(input *DescribeEventSourceInput) (req *request.Request, output *DescribeEventSourceOutput) {
 	op := &request.Operation{
-		Name:       opListTagsForResource,
+		Name:       opDescribeEventSource,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &ListTagsForResourceInput{}
+		input = &DescribeEventSourceInput{}
 	}
 
-	output = &ListTagsForResourceOutput{}
+	output = &DescribeEventSourceOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListTagsForResource API operation for Amazon CloudWatch Events.
+// DescribeEventSource API operation for Amazon CloudWatch Events.
 //
-// Displays the tags associated with an EventBridge resource. In EventBridge,
-// rules can be tagged.
+// This operation lists details about a partner event source that is shared
+// with your account.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation ListTagsForResource for usage and error information.
+// API operation DescribeEventSource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   An entity that you specified doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListTagsForResource
-func (c *CloudWatchEvents) ListTagsForResource(input *ListTagsForResourceInput) (*ListTagsForResourceOutput, error) {
-	req, out := c.ListTagsForResourceRequest(input)
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+//   - OperationDisabledException
+//     The operation you are attempting is not available in this region.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribeEventSource
+func (c *CloudWatchEvents) DescribeEventSource(input *DescribeEventSourceInput) (*DescribeEventSourceOutput, error) {
+	req, out := c.DescribeEventSourceRequest(input)
 	return out, req.Send()
 }
 
-// ListTagsForResourceWithContext is the same as ListTagsForResource with the addition of
+// DescribeEventSourceWithContext is the same as DescribeEventSource with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListTagsForResource for details on how to use this API operation.
+// See DescribeEventSource for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) ListTagsForResourceWithContext(ctx aws.Context, input *ListTagsForResourceInput, opts ...request.Option) (*ListTagsForResourceOutput, error) {
-	req, out := c.ListTagsForResourceRequest(input)
+func (c *CloudWatchEvents) DescribeEventSourceWithContext(ctx aws.Context, input *DescribeEventSourceInput, opts ...request.Option) (*DescribeEventSourceOutput, error) {
+	req, out := c.DescribeEventSourceRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListTargetsByRule = "ListTargetsByRule"
+const opDescribePartnerEventSource = "DescribePartnerEventSource"
 
-// ListTargetsByRuleRequest generates a "aws/request.Request" representing the
-// client's request for the ListTargetsByRule operation. The "output" return
+// DescribePartnerEventSourceRequest generates a "aws/request.Request" representing the
+// client's request for the DescribePartnerEventSource operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListTargetsByRule for more information on using the ListTargetsByRule
+// See DescribePartnerEventSource for more information on using the DescribePartnerEventSource
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribePartnerEventSourceRequest method.
+//	req, resp := client.DescribePartnerEventSourceRequest(params)
 //
-//    // Example sending a request using the ListTargetsByRuleRequest method.
-//    req, resp := client.ListTargetsByRuleRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListTargetsByRule
-func (c *CloudWatchEvents) ListTargetsByRuleRequest(input *ListTargetsByRuleInput) (req *request.Request, output *ListTargetsByRuleOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribePartnerEventSource
+func (c *CloudWatchEvents) DescribePartnerEventSourceRequest(input *DescribePartnerEventSourceInput) (req *request.Request, output *DescribePartnerEventSourceOutput) {
 	op := &request.Operation{
-		Name:       opListTargetsByRule,
+		Name:       opDescribePartnerEventSource,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &ListTargetsByRuleInput{}
+		input = &DescribePartnerEventSourceInput{}
 	}
 
-	output = &ListTargetsByRuleOutput{}
+	output = &DescribePartnerEventSourceOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListTargetsByRule API operation for Amazon CloudWatch Events.
+// DescribePartnerEventSource API operation for Amazon CloudWatch Events.
 //
-// Lists the targets assigned to the specified rule.
+// An SaaS partner can use this operation to list details about a partner event
+// source that they have created. Amazon Web Services customers do not use this
+// operation. Instead, Amazon Web Services customers can use DescribeEventSource
+// (https://docs.aws.amazon.com/eventbridge/latest/APIReference/API_DescribeEventSource.html)
+// to see details about a partner event source that is shared with them.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation ListTargetsByRule for usage and error information.
+// API operation DescribePartnerEventSource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   An entity that you specified doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListTargetsByRule
-func (c *CloudWatchEvents) ListTargetsByRule(input *ListTargetsByRuleInput) (*ListTargetsByRuleOutput, error) {
-	req, out := c.ListTargetsByRuleRequest(input)
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+//   - OperationDisabledException
+//     The operation you are attempting is not available in this region.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribePartnerEventSource
+func (c *CloudWatchEvents) DescribePartnerEventSource(input *DescribePartnerEventSourceInput) (*DescribePartnerEventSourceOutput, error) {
+	req, out := c.DescribePartnerEventSourceRequest(input)
 	return out, req.Send()
 }
 
-// ListTargetsByRuleWithContext is the same as ListTargetsByRule with the addition of
+// DescribePartnerEventSourceWithContext is the same as DescribePartnerEventSource with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListTargetsByRule for details on how to use this API operation.
+// See DescribePartnerEventSource for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) ListTargetsByRuleWithContext(ctx aws.Context, input *ListTargetsByRuleInput, opts ...request.Option) (*ListTargetsByRuleOutput, error) {
-	req, out := c.ListTargetsByRuleRequest(input)
+func (c *CloudWatchEvents) DescribePartnerEventSourceWithContext(ctx aws.Context, input *DescribePartnerEventSourceInput, opts ...request.Option) (*DescribePartnerEventSourceOutput, error) {
+	req, out := c.DescribePartnerEventSourceRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opPutEvents = "PutEvents"
+const opDescribeReplay = "DescribeReplay"
 
-// PutEventsRequest generates a "aws/request.Request" representing the
-// client's request for the PutEvents operation. The "output" return
+// DescribeReplayRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeReplay operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See PutEvents for more information on using the PutEvents
+// See DescribeReplay for more information on using the DescribeReplay
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeReplayRequest method.
+//	req, resp := client.DescribeReplayRequest(params)
 //
-//    // Example sending a request using the PutEventsRequest method.
-//    req, resp := client.PutEventsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/PutEvents
-func (c *CloudWatchEvents) PutEventsRequest(input *PutEventsInput) (req *request.Request, output *PutEventsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribeReplay
+func (c *CloudWatchEvents) DescribeReplayRequest(input *DescribeReplayInput) (req *request.Request, output *DescribeReplayOutput) {
 	op := &request.Operation{
-		Name:       opPutEvents,
+		Name:       opDescribeReplay,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &PutEventsInput{}
+		input = &DescribeReplayInput{}
 	}
 
-	output = &PutEventsOutput{}
+	output = &DescribeReplayOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// PutEvents API operation for Amazon CloudWatch Events.
+// DescribeReplay API operation for Amazon CloudWatch Events.
 //
-// Sends custom events to EventBridge so that they can be matched to rules.
-// These events can be from your custom applications and services.
+// Retrieves details about a replay. Use DescribeReplay to determine the progress
+// of a running replay. A replay processes events to replay based on the time
+// in the event, and replays them using 1 minute intervals. If you use StartReplay
+// and specify an EventStartTime and an EventEndTime that covers a 20 minute
+// time range, the events are replayed from the first minute of that 20 minute
+// range first. Then the events from the second minute are replayed. You can
+// use DescribeReplay to determine the progress of a replay. The value returned
+// for EventLastReplayedTime indicates the time within the specified time range
+// associated with the last event replayed.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation PutEvents for usage and error information.
+// API operation DescribeReplay for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+// Returned Error Types:
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/PutEvents
-func (c *CloudWatchEvents) PutEvents(input *PutEventsInput) (*PutEventsOutput, error) {
-	req, out := c.PutEventsRequest(input)
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribeReplay
+func (c *CloudWatchEvents) DescribeReplay(input *DescribeReplayInput) (*DescribeReplayOutput, error) {
+	req, out := c.DescribeReplayRequest(input)
 	return out, req.Send()
 }
 
-// PutEventsWithContext is the same as PutEvents with the addition of
+// DescribeReplayWithContext is the same as DescribeReplay with the addition of
 // the ability to pass a context and additional request options.
 //
-// See PutEvents for details on how to use this API operation.
+// See DescribeReplay for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) PutEventsWithContext(ctx aws.Context, input *PutEventsInput, opts ...request.Option) (*PutEventsOutput, error) {
-	req, out := c.PutEventsRequest(input)
+func (c *CloudWatchEvents) DescribeReplayWithContext(ctx aws.Context, input *DescribeReplayInput, opts ...request.Option) (*DescribeReplayOutput, error) {
+	req, out := c.DescribeReplayRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opPutPartnerEvents = "PutPartnerEvents"
+const opDescribeRule = "DescribeRule"
 
-// PutPartnerEventsRequest generates a "aws/request.Request" representing the
-// client's request for the PutPartnerEvents operation. The "output" return
+// DescribeRuleRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeRule operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See PutPartnerEvents for more information on using the PutPartnerEvents
+// See DescribeRule for more information on using the DescribeRule
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeRuleRequest method.
+//	req, resp := client.DescribeRuleRequest(params)
 //
-//    // Example sending a request using the PutPartnerEventsRequest method.
-//    req, resp := client.PutPartnerEventsRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/PutPartnerEvents
-func (c *CloudWatchEvents) PutPartnerEventsRequest(input *PutPartnerEventsInput) (req *request.Request, output *PutPartnerEventsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribeRule
+func (c *CloudWatchEvents) DescribeRuleRequest(input *DescribeRuleInput) (req *request.Request, output *DescribeRuleOutput) {
 	op := &request.Operation{
-		Name:       opPutPartnerEvents,
+		Name:       opDescribeRule,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &PutPartnerEventsInput{}
+		input = &DescribeRuleInput{}
 	}
 
-	output = &PutPartnerEventsOutput{}
+	output = &DescribeRuleOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// PutPartnerEvents API operation for Amazon CloudWatch Events.
+// DescribeRule API operation for Amazon CloudWatch Events.
 //
-// This is used by SaaS partners to write events to a customer's partner event
-// bus.
+// Describes the specified rule.
 //
-// AWS customers do not use this operation. Instead, AWS customers can use PutEvents
-// to write custom events from their own applications to an event bus.
+// DescribeRule does not list the targets of a rule. To see the targets associated
+// with a rule, use ListTargetsByRule (https://docs.aws.amazon.com/eventbridge/latest/APIReference/API_ListTargetsByRule.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation PutPartnerEvents for usage and error information.
+// API operation DescribeRule for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+// Returned Error Types:
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/PutPartnerEvents
-func (c *CloudWatchEvents) PutPartnerEvents(input *PutPartnerEventsInput) (*PutPartnerEventsOutput, error) {
-	req, out := c.PutPartnerEventsRequest(input)
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DescribeRule
+func (c *CloudWatchEvents) DescribeRule(input *DescribeRuleInput) (*DescribeRuleOutput, error) {
+	req, out := c.DescribeRuleRequest(input)
 	return out, req.Send()
 }
 
-// PutPartnerEventsWithContext is the same as PutPartnerEvents with the addition of
+// DescribeRuleWithContext is the same as DescribeRule with the addition of
 // the ability to pass a context and additional request options.
 //
-// See PutPartnerEvents for details on how to use this API operation.
+// See DescribeRule for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) PutPartnerEventsWithContext(ctx aws.Context, input *PutPartnerEventsInput, opts ...request.Option) (*PutPartnerEventsOutput, error) {
-	req, out := c.PutPartnerEventsRequest(input)
+func (c *CloudWatchEvents) DescribeRuleWithContext(ctx aws.Context, input *DescribeRuleInput, opts ...request.Option) (*DescribeRuleOutput, error) {
+	req, out := c.DescribeRuleRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opPutPermission = "PutPermission"
+const opDisableRule = "DisableRule"
 
-// PutPermissionRequest generates a "aws/request.Request" representing the
-// client's request for the PutPermission operation. The "output" return
+// DisableRuleRequest generates a "aws/request.Request" representing the
+// client's request for the DisableRule operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See PutPermission for more information on using the PutPermission
+// See DisableRule for more information on using the DisableRule
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DisableRuleRequest method.
+//	req, resp := client.DisableRuleRequest(params)
 //
-//    // Example sending a request using the PutPermissionRequest method.
-//    req, resp := client.PutPermissionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/PutPermission
-func (c *CloudWatchEvents) PutPermissionRequest(input *PutPermissionInput) (req *request.Request, output *PutPermissionOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DisableRule
+func (c *CloudWatchEvents) DisableRuleRequest(input *DisableRuleInput) (req *request.Request, output *DisableRuleOutput) {
 	op := &request.Operation{
-		Name:       opPutPermission,
+		Name:       opDisableRule,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &PutPermissionInput{}
+		input = &DisableRuleInput{}
 	}
 
-	output = &PutPermissionOutput{}
+	output = &DisableRuleOutput{}
 	req = c.newRequest(op, input, output)
 	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// PutPermission API operation for Amazon CloudWatch Events.
+// DisableRule API operation for Amazon CloudWatch Events.
 //
-// Running PutPermission permits the specified AWS account or AWS organization
-// to put events to the specified event bus. Rules in your account are triggered
-// by these events arriving to an event bus in your account.
+// Disables the specified rule. A disabled rule won't match any events, and
+// won't self-trigger if it has a schedule expression.
 //
-// For another account to send events to your account, that external account
-// must have a rule with your account's event bus as a target.
+// When you disable a rule, incoming events might continue to match to the disabled
+// rule. Allow a short period of time for changes to take effect.
 //
-// To enable multiple AWS accounts to put events to an event bus, run PutPermission
-// once for each of these accounts. Or, if all the accounts are members of the
-// same AWS organization, you can run PutPermission once specifying Principal
-// as "*" and specifying the AWS organization ID in Condition, to grant permissions
-// to all accounts in that organization.
-//
-// If you grant permissions using an organization, then accounts in that organization
-// must specify a RoleArn with proper permissions when they use PutTarget to
-// add your account's event bus as a target. For more information, see Sending
-// and Receiving Events Between AWS Accounts (https://docs.aws.amazon.com/eventbridge/latest/userguide/eventbridge-cross-account-event-delivery.html)
-// in the Amazon EventBridge User Guide.
-//
-// The permission policy on an event bus can't exceed 10 KB in size.
-//
-// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
-// with awserr.Error's Code and Message methods to get detailed information about
-// the error.
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation PutPermission for usage and error information.
+// API operation DisableRule for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   An entity that you specified doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodePolicyLengthExceededException "PolicyLengthExceededException"
-//   The event bus policy is too long. For more information, see the limits.
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
 //
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
 //
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   There is concurrent modification on a resource.
+//   - ManagedRuleException
+//     This rule was created by an Amazon Web Services service on behalf of your
+//     account. It is managed by that service. If you see this error in response
+//     to DeleteRule or RemoveTargets, you can use the Force parameter in those
+//     calls to delete the rule or remove targets from the rule. You cannot modify
+//     these managed rules by using DisableRule, EnableRule, PutTargets, PutRule,
+//     TagResource, or UntagResource.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/PutPermission
-func (c *CloudWatchEvents) PutPermission(input *PutPermissionInput) (*PutPermissionOutput, error) {
-	req, out := c.PutPermissionRequest(input)
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/DisableRule
+func (c *CloudWatchEvents) DisableRule(input *DisableRuleInput) (*DisableRuleOutput, error) {
+	req, out := c.DisableRuleRequest(input)
 	return out, req.Send()
 }
 
-// PutPermissionWithContext is the same as PutPermission with the addition of
+// DisableRuleWithContext is the same as DisableRule with the addition of
 // the ability to pass a context and additional request options.
 //
-// See PutPermission for details on how to use this API operation.
+// See DisableRule for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) PutPermissionWithContext(ctx aws.Context, input *PutPermissionInput, opts ...request.Option) (*PutPermissionOutput, error) {
-	req, out := c.PutPermissionRequest(input)
+func (c *CloudWatchEvents) DisableRuleWithContext(ctx aws.Context, input *DisableRuleInput, opts ...request.Option) (*DisableRuleOutput, error) {
+	req, out := c.DisableRuleRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opPutRule = "PutRule"
+const opEnableRule = "EnableRule"
 
-// PutRuleRequest generates a "aws/request.Request" representing the
-// client's request for the PutRule operation. The "output" return
+// EnableRuleRequest generates a "aws/request.Request" representing the
+// client's request for the EnableRule operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See PutRule for more information on using the PutRule
+// See EnableRule for more information on using the EnableRule
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the EnableRuleRequest method.
+//	req, resp := client.EnableRuleRequest(params)
 //
-//    // Example sending a request using the PutRuleRequest method.
-//    req, resp := client.PutRuleRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/PutRule
-func (c *CloudWatchEvents) PutRuleRequest(input *PutRuleInput) (req *request.Request, output *PutRuleOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/EnableRule
+func (c *CloudWatchEvents) EnableRuleRequest(input *EnableRuleInput) (req *request.Request, output *EnableRuleOutput) {
 	op := &request.Operation{
-		Name:       opPutRule,
+		Name:       opEnableRule,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &PutRuleInput{}
+		input = &EnableRuleInput{}
 	}
 
-	output = &PutRuleOutput{}
+	output = &EnableRuleOutput{}
 	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// PutRule API operation for Amazon CloudWatch Events.
-//
-// Creates or updates the specified rule. Rules are enabled by default or based
-// on value of the state. You can disable a rule using DisableRule.
-//
-// A single rule watches for events from a single event bus. Events generated
-// by AWS services go to your account's default event bus. Events generated
-// by SaaS partner services or applications go to the matching partner event
-// bus. If you have custom applications or services, you can specify whether
-// their events go to your default event bus or a custom event bus that you
-// have created. For more information, see CreateEventBus.
-//
-// If you're updating an existing rule, the rule is replaced with what you specify
-// in this PutRule command. If you omit arguments in PutRule, the old values
-// for those arguments aren't kept. Instead, they're replaced with null values.
-//
-// When you create or update a rule, incoming events might not immediately start
-// matching to new or updated rules. Allow a short period of time for changes
-// to take effect.
-//
-// A rule must contain at least an EventPattern or ScheduleExpression. Rules
-// with EventPatterns are triggered when a matching event is observed. Rules
-// with ScheduleExpressions self-trigger based on the given schedule. A rule
-// can have both an EventPattern and a ScheduleExpression, in which case the
-// rule triggers on matching events as well as on a schedule.
-//
-// When you initially create a rule, you can optionally assign one or more tags
-// to the rule. Tags can help you organize and categorize your resources. You
-// can also use them to scope user permissions, by granting a user permission
-// to access or change only rules with certain tag values. To use the PutRule
-// operation and assign tags, you must have both the events:PutRule and events:TagResource
-// permissions.
-//
-// If you are updating an existing rule, any tags you specify in the PutRule
-// operation are ignored. To update the tags of an existing rule, use TagResource
-// and UntagResource.
-//
-// Most services in AWS treat : or / as the same character in Amazon Resource
-// Names (ARNs). However, EventBridge uses an exact match in event patterns
-// and rules. Be sure to use the correct ARN characters when creating event
-// patterns so that they match the ARN syntax in the event that you want to
-// match.
-//
-// In EventBridge, you could create rules that lead to infinite loops, where
-// a rule is fired repeatedly. For example, a rule might detect that ACLs have
-// changed on an S3 bucket, and trigger software to change them to the desired
-// state. If you don't write the rule carefully, the subsequent change to the
-// ACLs fires the rule again, creating an infinite loop.
+// EnableRule API operation for Amazon CloudWatch Events.
 //
-// To prevent this, write the rules so that the triggered actions don't refire
-// the same rule. For example, your rule could fire only if ACLs are found to
-// be in a bad state, instead of after any change.
+// Enables the specified rule. If the rule does not exist, the operation fails.
 //
-// An infinite loop can quickly cause higher than expected charges. We recommend
-// that you use budgeting, which alerts you when charges exceed your specified
-// limit. For more information, see Managing Your Costs with Budgets (https://docs.aws.amazon.com/awsaccountbilling/latest/aboutv2/budgets-managing-costs.html).
+// When you enable a rule, incoming events might not immediately start matching
+// to a newly enabled rule. Allow a short period of time for changes to take
+// effect.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation PutRule for usage and error information.
-//
-// Returned Error Codes:
-//   * ErrCodeInvalidEventPatternException "InvalidEventPatternException"
-//   The event pattern isn't valid.
+// API operation EnableRule for usage and error information.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   You tried to create more resources than is allowed.
+// Returned Error Types:
 //
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   There is concurrent modification on a resource.
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
 //
-//   * ErrCodeManagedRuleException "ManagedRuleException"
-//   An AWS service created this rule on behalf of your account. That service
-//   manages it. If you see this error in response to DeleteRule or RemoveTargets,
-//   you can use the Force parameter in those calls to delete the rule or remove
-//   targets from the rule. You can't modify these managed rules by using DisableRule,
-//   EnableRule, PutTargets, PutRule, TagResource, or UntagResource.
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
 //
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+//   - ManagedRuleException
+//     This rule was created by an Amazon Web Services service on behalf of your
+//     account. It is managed by that service. If you see this error in response
+//     to DeleteRule or RemoveTargets, you can use the Force parameter in those
+//     calls to delete the rule or remove targets from the rule. You cannot modify
+//     these managed rules by using DisableRule, EnableRule, PutTargets, PutRule,
+//     TagResource, or UntagResource.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   An entity that you specified doesn't exist.
+//   - InternalException
+//     This exception occurs due to unexpected causes.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/PutRule
-func (c *CloudWatchEvents) PutRule(input *PutRuleInput) (*PutRuleOutput, error) {
-	req, out := c.PutRuleRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/EnableRule
+func (c *CloudWatchEvents) EnableRule(input *EnableRuleInput) (*EnableRuleOutput, error) {
+	req, out := c.EnableRuleRequest(input)
 	return out, req.Send()
 }
 
-// PutRuleWithContext is the same as PutRule with the addition of
+// EnableRuleWithContext is the same as EnableRule with the addition of
 // the ability to pass a context and additional request options.
 //
-// See PutRule for details on how to use this API operation.
+// See EnableRule for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) PutRuleWithContext(ctx aws.Context, input *PutRuleInput, opts ...request.Option) (*PutRuleOutput, error) {
-	req, out := c.PutRuleRequest(input)
+func (c *CloudWatchEvents) EnableRuleWithContext(ctx aws.Context, input *EnableRuleInput, opts ...request.Option) (*EnableRuleOutput, error) {
+	req, out := c.EnableRuleRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opPutTargets = "PutTargets"
+const opListApiDestinations = "ListApiDestinations"
 
-// PutTargetsRequest generates a "aws/request.Request" representing the
-// client's request for the PutTargets operation. The "output" return
+// ListApiDestinationsRequest generates a "aws/request.Request" representing the
+// client's request for the ListApiDestinations operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See PutTargets for more information on using the PutTargets
+// See ListApiDestinations for more information on using the ListApiDestinations
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListApiDestinationsRequest method.
+//	req, resp := client.ListApiDestinationsRequest(params)
 //
-//    // Example sending a request using the PutTargetsRequest method.
-//    req, resp := client.PutTargetsRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/PutTargets
-func (c *CloudWatchEvents) PutTargetsRequest(input *PutTargetsInput) (req *request.Request, output *PutTargetsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListApiDestinations
+func (c *CloudWatchEvents) ListApiDestinationsRequest(input *ListApiDestinationsInput) (req *request.Request, output *ListApiDestinationsOutput) {
 	op := &request.Operation{
-		Name:       opPutTargets,
+		Name:       opListApiDestinations,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &PutTargetsInput{}
+		input = &ListApiDestinationsInput{}
 	}
 
-	output = &PutTargetsOutput{}
+	output = &ListApiDestinationsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// PutTargets API operation for Amazon CloudWatch Events.
-//
-// Adds the specified targets to the specified rule, or updates the targets
-// if they're already associated with the rule.
-//
-// Targets are the resources that are invoked when a rule is triggered.
-//
-// You can configure the following as targets in EventBridge:
-//
-//    * EC2 instances
-//
-//    * SSM Run Command
-//
-//    * SSM Automation
-//
-//    * AWS Lambda functions
-//
-//    * Data streams in Amazon Kinesis Data Streams
-//
-//    * Data delivery streams in Amazon Kinesis Data Firehose
-//
-//    * Amazon ECS tasks
-//
-//    * AWS Step Functions state machines
-//
-//    * AWS Batch jobs
-//
-//    * AWS CodeBuild projects
-//
-//    * Pipelines in AWS CodePipeline
-//
-//    * Amazon Inspector assessment templates
-//
-//    * Amazon SNS topics
-//
-//    * Amazon SQS queues, including FIFO queues
+// ListApiDestinations API operation for Amazon CloudWatch Events.
 //
-//    * The default event bus of another AWS account
-//
-// Creating rules with built-in targets is supported only on the AWS Management
-// Console. The built-in targets are EC2 CreateSnapshot API call, EC2 RebootInstances
-// API call, EC2 StopInstances API call, and EC2 TerminateInstances API call.
-//
-// For some target types, PutTargets provides target-specific parameters. If
-// the target is a Kinesis data stream, you can optionally specify which shard
-// the event goes to by using the KinesisParameters argument. To invoke a command
-// on multiple EC2 instances with one rule, you can use the RunCommandParameters
-// field.
-//
-// To be able to make API calls against the resources that you own, Amazon EventBridge
-// needs the appropriate permissions. For AWS Lambda and Amazon SNS resources,
-// EventBridge relies on resource-based policies. For EC2 instances, Kinesis
-// data streams, and AWS Step Functions state machines, EventBridge relies on
-// IAM roles that you specify in the RoleARN argument in PutTargets. For more
-// information, see Authentication and Access Control (https://docs.aws.amazon.com/eventbridge/latest/userguide/auth-and-access-control-eventbridge.html)
-// in the Amazon EventBridge User Guide.
-//
-// If another AWS account is in the same Region and has granted you permission
-// (using PutPermission), you can send events to that account. Set that account's
-// event bus as a target of the rules in your account. To send the matched events
-// to the other account, specify that account's event bus as the Arn value when
-// you run PutTargets. If your account sends events to another account, your
-// account is charged for each sent event. Each event sent to another account
-// is charged as a custom event. The account receiving the event isn't charged.
-// For more information, see Amazon EventBridge Pricing (https://aws.amazon.com/eventbridge/pricing/).
-//
-// If you're setting an event bus in another account as the target and that
-// account granted permission to your account through an organization instead
-// of directly by the account ID, you must specify a RoleArn with proper permissions
-// in the Target structure. For more information, see Sending and Receiving
-// Events Between AWS Accounts (https://docs.aws.amazon.com/eventbridge/latest/userguide/eventbridge-cross-account-event-delivery.html)
-// in the Amazon EventBridge User Guide.
-//
-// For more information about enabling cross-account events, see PutPermission.
-//
-// Input, InputPath, and InputTransformer are mutually exclusive and optional
-// parameters of a target. When a rule is triggered due to a matched event:
-//
-//    * If none of the following arguments are specified for a target, the entire
-//    event is passed to the target in JSON format (unless the target is Amazon
-//    EC2 Run Command or Amazon ECS task, in which case nothing from the event
-//    is passed to the target).
-//
-//    * If Input is specified in the form of valid JSON, then the matched event
-//    is overridden with this constant.
-//
-//    * If InputPath is specified in the form of JSONPath (for example, $.detail),
-//    only the part of the event specified in the path is passed to the target
-//    (for example, only the detail part of the event is passed).
-//
-//    * If InputTransformer is specified, one or more specified JSONPaths are
-//    extracted from the event and used as values in a template that you specify
-//    as the input to the target.
-//
-// When you specify InputPath or InputTransformer, you must use JSON dot notation,
-// not bracket notation.
-//
-// When you add targets to a rule and the associated rule triggers soon after,
-// new or updated targets might not be immediately invoked. Allow a short period
-// of time for changes to take effect.
-//
-// This action can partially fail if too many requests are made at the same
-// time. If that happens, FailedEntryCount is nonzero in the response, and each
-// entry in FailedEntries provides the ID of the failed target and the error
-// code.
+// Retrieves a list of API destination in the account in the current Region.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation PutTargets for usage and error information.
-//
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   An entity that you specified doesn't exist.
-//
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   There is concurrent modification on a resource.
+// API operation ListApiDestinations for usage and error information.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   You tried to create more resources than is allowed.
+// Returned Error Types:
+//   - InternalException
+//     This exception occurs due to unexpected causes.
 //
-//   * ErrCodeManagedRuleException "ManagedRuleException"
-//   An AWS service created this rule on behalf of your account. That service
-//   manages it. If you see this error in response to DeleteRule or RemoveTargets,
-//   you can use the Force parameter in those calls to delete the rule or remove
-//   targets from the rule. You can't modify these managed rules by using DisableRule,
-//   EnableRule, PutTargets, PutRule, TagResource, or UntagResource.
-//
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/PutTargets
-func (c *CloudWatchEvents) PutTargets(input *PutTargetsInput) (*PutTargetsOutput, error) {
-	req, out := c.PutTargetsRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListApiDestinations
+func (c *CloudWatchEvents) ListApiDestinations(input *ListApiDestinationsInput) (*ListApiDestinationsOutput, error) {
+	req, out := c.ListApiDestinationsRequest(input)
 	return out, req.Send()
 }
 
-// PutTargetsWithContext is the same as PutTargets with the addition of
+// ListApiDestinationsWithContext is the same as ListApiDestinations with the addition of
 // the ability to pass a context and additional request options.
 //
-// See PutTargets for details on how to use this API operation.
+// See ListApiDestinations for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) PutTargetsWithContext(ctx aws.Context, input *PutTargetsInput, opts ...request.Option) (*PutTargetsOutput, error) {
-	req, out := c.PutTargetsRequest(input)
+func (c *CloudWatchEvents) ListApiDestinationsWithContext(ctx aws.Context, input *ListApiDestinationsInput, opts ...request.Option) (*ListApiDestinationsOutput, error) {
+	req, out := c.ListApiDestinationsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opRemovePermission = "RemovePermission"
+const opListArchives = "ListArchives"
 
-// RemovePermissionRequest generates a "aws/request.Request" representing the
-// client's request for the RemovePermission operation. The "output" return
+// ListArchivesRequest generates a "aws/request.Request" representing the
+// client's request for the ListArchives operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See RemovePermission for more information on using the RemovePermission
+// See ListArchives for more information on using the ListArchives
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListArchivesRequest method.
+//	req, resp := client.ListArchivesRequest(params)
 //
-//    // Example sending a request using the RemovePermissionRequest method.
-//    req, resp := client.RemovePermissionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/RemovePermission
-func (c *CloudWatchEvents) RemovePermissionRequest(input *RemovePermissionInput) (req *request.Request, output *RemovePermissionOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListArchives
+func (c *CloudWatchEvents) ListArchivesRequest(input *ListArchivesInput) (req *request.Request, output *ListArchivesOutput) {
 	op := &request.Operation{
-		Name:       opRemovePermission,
+		Name:       opListArchives,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &RemovePermissionInput{}
+		input = &ListArchivesInput{}
 	}
 
-	output = &RemovePermissionOutput{}
+	output = &ListArchivesOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// RemovePermission API operation for Amazon CloudWatch Events.
+// ListArchives API operation for Amazon CloudWatch Events.
 //
-// Revokes the permission of another AWS account to be able to put events to
-// the specified event bus. Specify the account to revoke by the StatementId
-// value that you associated with the account when you granted it permission
-// with PutPermission. You can find the StatementId by using DescribeEventBus.
+// Lists your archives. You can either list all the archives or you can provide
+// a prefix to match to the archive names. Filter parameters are exclusive.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation RemovePermission for usage and error information.
+// API operation ListArchives for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   An entity that you specified doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
 //
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   There is concurrent modification on a resource.
+//   - InternalException
+//     This exception occurs due to unexpected causes.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/RemovePermission
-func (c *CloudWatchEvents) RemovePermission(input *RemovePermissionInput) (*RemovePermissionOutput, error) {
-	req, out := c.RemovePermissionRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListArchives
+func (c *CloudWatchEvents) ListArchives(input *ListArchivesInput) (*ListArchivesOutput, error) {
+	req, out := c.ListArchivesRequest(input)
 	return out, req.Send()
 }
 
-// RemovePermissionWithContext is the same as RemovePermission with the addition of
+// ListArchivesWithContext is the same as ListArchives with the addition of
 // the ability to pass a context and additional request options.
 //
-// See RemovePermission for details on how to use this API operation.
+// See ListArchives for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) RemovePermissionWithContext(ctx aws.Context, input *RemovePermissionInput, opts ...request.Option) (*RemovePermissionOutput, error) {
-	req, out := c.RemovePermissionRequest(input)
+func (c *CloudWatchEvents) ListArchivesWithContext(ctx aws.Context, input *ListArchivesInput, opts ...request.Option) (*ListArchivesOutput, error) {
+	req, out := c.ListArchivesRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opRemoveTargets = "RemoveTargets"
+const opListConnections = "ListConnections"
 
-// RemoveTargetsRequest generates a "aws/request.Request" representing the
-// client's request for the RemoveTargets operation. The "output" return
+// ListConnectionsRequest generates a "aws/request.Request" representing the
+// client's request for the ListConnections operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See RemoveTargets for more information on using the RemoveTargets
+// See ListConnections for more information on using the ListConnections
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListConnectionsRequest method.
+//	req, resp := client.ListConnectionsRequest(params)
 //
-//    // Example sending a request using the RemoveTargetsRequest method.
-//    req, resp := client.RemoveTargetsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/RemoveTargets
-func (c *CloudWatchEvents) RemoveTargetsRequest(input *RemoveTargetsInput) (req *request.Request, output *RemoveTargetsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListConnections
+func (c *CloudWatchEvents) ListConnectionsRequest(input *ListConnectionsInput) (req *request.Request, output *ListConnectionsOutput) {
 	op := &request.Operation{
-		Name:       opRemoveTargets,
+		Name:       opListConnections,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &RemoveTargetsInput{}
+		input = &ListConnectionsInput{}
 	}
 
-	output = &RemoveTargetsOutput{}
+	output = &ListConnectionsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// RemoveTargets API operation for Amazon CloudWatch Events.
-//
-// Removes the specified targets from the specified rule. When the rule is triggered,
-// those targets are no longer be invoked.
-//
-// When you remove a target, when the associated rule triggers, removed targets
-// might continue to be invoked. Allow a short period of time for changes to
-// take effect.
+// ListConnections API operation for Amazon CloudWatch Events.
 //
-// This action can partially fail if too many requests are made at the same
-// time. If that happens, FailedEntryCount is non-zero in the response and each
-// entry in FailedEntries provides the ID of the failed target and the error
-// code.
+// Retrieves a list of connections from the account.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation RemoveTargets for usage and error information.
+// API operation ListConnections for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   An entity that you specified doesn't exist.
+// Returned Error Types:
+//   - InternalException
+//     This exception occurs due to unexpected causes.
 //
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   There is concurrent modification on a resource.
-//
-//   * ErrCodeManagedRuleException "ManagedRuleException"
-//   An AWS service created this rule on behalf of your account. That service
-//   manages it. If you see this error in response to DeleteRule or RemoveTargets,
-//   you can use the Force parameter in those calls to delete the rule or remove
-//   targets from the rule. You can't modify these managed rules by using DisableRule,
-//   EnableRule, PutTargets, PutRule, TagResource, or UntagResource.
-//
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/RemoveTargets
-func (c *CloudWatchEvents) RemoveTargets(input *RemoveTargetsInput) (*RemoveTargetsOutput, error) {
-	req, out := c.RemoveTargetsRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListConnections
+func (c *CloudWatchEvents) ListConnections(input *ListConnectionsInput) (*ListConnectionsOutput, error) {
+	req, out := c.ListConnectionsRequest(input)
 	return out, req.Send()
 }
 
-// RemoveTargetsWithContext is the same as RemoveTargets with the addition of
+// ListConnectionsWithContext is the same as ListConnections with the addition of
 // the ability to pass a context and additional request options.
 //
-// See RemoveTargets for details on how to use this API operation.
+// See ListConnections for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) RemoveTargetsWithContext(ctx aws.Context, input *RemoveTargetsInput, opts ...request.Option) (*RemoveTargetsOutput, error) {
-	req, out := c.RemoveTargetsRequest(input)
+func (c *CloudWatchEvents) ListConnectionsWithContext(ctx aws.Context, input *ListConnectionsInput, opts ...request.Option) (*ListConnectionsOutput, error) {
+	req, out := c.ListConnectionsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opTagResource = "TagResource"
+const opListEventBuses = "ListEventBuses"
 
-// TagResourceRequest generates a "aws/request.Request" representing the
-// client's request for the TagResource operation. The "output" return
+// ListEventBusesRequest generates a "aws/request.Request" representing the
+// client's request for the ListEventBuses operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See TagResource for more information on using the TagResource
+// See ListEventBuses for more information on using the ListEventBuses
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListEventBusesRequest method.
+//	req, resp := client.ListEventBusesRequest(params)
 //
-//    // Example sending a request using the TagResourceRequest method.
-//    req, resp := client.TagResourceRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/TagResource
-func (c *CloudWatchEvents) TagResourceRequest(input *TagResourceInput) (req *request.Request, output *TagResourceOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListEventBuses
+func (c *CloudWatchEvents) ListEventBusesRequest(input *ListEventBusesInput) (req *request.Request, output *ListEventBusesOutput) {
 	op := &request.Operation{
-		Name:       opTagResource,
+		Name:       opListEventBuses,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &TagResourceInput{}
+		input = &ListEventBusesInput{}
 	}
 
-	output = &TagResourceOutput{}
+	output = &ListEventBusesOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// TagResource API operation for Amazon CloudWatch Events.
+// ListEventBuses API operation for Amazon CloudWatch Events.
 //
-// Assigns one or more tags (key-value pairs) to the specified EventBridge resource.
-// Tags can help you organize and categorize your resources. You can also use
-// them to scope user permissions by granting a user permission to access or
-// change only resources with certain tag values. In EventBridge, rules can
-// be tagged.
+// Lists all the event buses in your account, including the default event bus,
+// custom event buses, and partner event buses.
 //
-// Tags don't have any semantic meaning to AWS and are interpreted strictly
-// as strings of characters.
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
 //
-// You can use the TagResource action with a rule that already has tags. If
-// you specify a new tag key for the rule, this tag is appended to the list
-// of tags associated with the rule. If you specify a tag key that is already
-// associated with the rule, the new tag value that you specify replaces the
-// previous value for that tag.
+// See the AWS API reference guide for Amazon CloudWatch Events's
+// API operation ListEventBuses for usage and error information.
 //
-// You can associate as many as 50 tags with a resource.
+// Returned Error Types:
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListEventBuses
+func (c *CloudWatchEvents) ListEventBuses(input *ListEventBusesInput) (*ListEventBusesOutput, error) {
+	req, out := c.ListEventBusesRequest(input)
+	return out, req.Send()
+}
+
+// ListEventBusesWithContext is the same as ListEventBuses with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListEventBuses for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CloudWatchEvents) ListEventBusesWithContext(ctx aws.Context, input *ListEventBusesInput, opts ...request.Option) (*ListEventBusesOutput, error) {
+	req, out := c.ListEventBusesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opListEventSources = "ListEventSources"
+
+// ListEventSourcesRequest generates a "aws/request.Request" representing the
+// client's request for the ListEventSources operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListEventSources for more information on using the ListEventSources
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListEventSourcesRequest method.
+//	req, resp := client.ListEventSourcesRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListEventSources
+func (c *CloudWatchEvents) ListEventSourcesRequest(input *ListEventSourcesInput) (req *request.Request, output *ListEventSourcesOutput) {
+	op := &request.Operation{
+		Name:       opListEventSources,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &ListEventSourcesInput{}
+	}
+
+	output = &ListEventSourcesOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListEventSources API operation for Amazon CloudWatch Events.
+//
+// You can use this to see all the partner event sources that have been shared
+// with your Amazon Web Services account. For more information about partner
+// event sources, see CreateEventBus (https://docs.aws.amazon.com/eventbridge/latest/APIReference/API_CreateEventBus.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation TagResource for usage and error information.
-//
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   An entity that you specified doesn't exist.
+// API operation ListEventSources for usage and error information.
 //
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   There is concurrent modification on a resource.
+// Returned Error Types:
 //
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+//   - InternalException
+//     This exception occurs due to unexpected causes.
 //
-//   * ErrCodeManagedRuleException "ManagedRuleException"
-//   An AWS service created this rule on behalf of your account. That service
-//   manages it. If you see this error in response to DeleteRule or RemoveTargets,
-//   you can use the Force parameter in those calls to delete the rule or remove
-//   targets from the rule. You can't modify these managed rules by using DisableRule,
-//   EnableRule, PutTargets, PutRule, TagResource, or UntagResource.
+//   - OperationDisabledException
+//     The operation you are attempting is not available in this region.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/TagResource
-func (c *CloudWatchEvents) TagResource(input *TagResourceInput) (*TagResourceOutput, error) {
-	req, out := c.TagResourceRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListEventSources
+func (c *CloudWatchEvents) ListEventSources(input *ListEventSourcesInput) (*ListEventSourcesOutput, error) {
+	req, out := c.ListEventSourcesRequest(input)
 	return out, req.Send()
 }
 
-// TagResourceWithContext is the same as TagResource with the addition of
+// ListEventSourcesWithContext is the same as ListEventSources with the addition of
 // the ability to pass a context and additional request options.
 //
-// See TagResource for details on how to use this API operation.
+// See ListEventSources for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) TagResourceWithContext(ctx aws.Context, input *TagResourceInput, opts ...request.Option) (*TagResourceOutput, error) {
-	req, out := c.TagResourceRequest(input)
+func (c *CloudWatchEvents) ListEventSourcesWithContext(ctx aws.Context, input *ListEventSourcesInput, opts ...request.Option) (*ListEventSourcesOutput, error) {
+	req, out := c.ListEventSourcesRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opTestEventPattern = "TestEventPattern"
+const opListPartnerEventSourceAccounts = "ListPartnerEventSourceAccounts"
 
-// TestEventPatternRequest generates a "aws/request.Request" representing the
-// client's request for the TestEventPattern operation. The "output" return
+// ListPartnerEventSourceAccountsRequest generates a "aws/request.Request" representing the
+// client's request for the ListPartnerEventSourceAccounts operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See TestEventPattern for more information on using the TestEventPattern
+// See ListPartnerEventSourceAccounts for more information on using the ListPartnerEventSourceAccounts
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListPartnerEventSourceAccountsRequest method.
+//	req, resp := client.ListPartnerEventSourceAccountsRequest(params)
 //
-//    // Example sending a request using the TestEventPatternRequest method.
-//    req, resp := client.TestEventPatternRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/TestEventPattern
-func (c *CloudWatchEvents) TestEventPatternRequest(input *TestEventPatternInput) (req *request.Request, output *TestEventPatternOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListPartnerEventSourceAccounts
+func (c *CloudWatchEvents) ListPartnerEventSourceAccountsRequest(input *ListPartnerEventSourceAccountsInput) (req *request.Request, output *ListPartnerEventSourceAccountsOutput) {
 	op := &request.Operation{
-		Name:       opTestEventPattern,
+		Name:       opListPartnerEventSourceAccounts,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &TestEventPatternInput{}
+		input = &ListPartnerEventSourceAccountsInput{}
 	}
 
-	output = &TestEventPatternOutput{}
+	output = &ListPartnerEventSourceAccountsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// TestEventPattern API operation for Amazon CloudWatch Events.
-//
-// Tests whether the specified event pattern matches the provided event.
+// ListPartnerEventSourceAccounts API operation for Amazon CloudWatch Events.
 //
-// Most services in AWS treat : or / as the same character in Amazon Resource
-// Names (ARNs). However, EventBridge uses an exact match in event patterns
-// and rules. Be sure to use the correct ARN characters when creating event
-// patterns so that they match the ARN syntax in the event that you want to
-// match.
+// An SaaS partner can use this operation to display the Amazon Web Services
+// account ID that a particular partner event source name is associated with.
+// This operation is not used by Amazon Web Services customers.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation TestEventPattern for usage and error information.
+// API operation ListPartnerEventSourceAccounts for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidEventPatternException "InvalidEventPatternException"
-//   The event pattern isn't valid.
+// Returned Error Types:
 //
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/TestEventPattern
-func (c *CloudWatchEvents) TestEventPattern(input *TestEventPatternInput) (*TestEventPatternOutput, error) {
-	req, out := c.TestEventPatternRequest(input)
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+//   - OperationDisabledException
+//     The operation you are attempting is not available in this region.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListPartnerEventSourceAccounts
+func (c *CloudWatchEvents) ListPartnerEventSourceAccounts(input *ListPartnerEventSourceAccountsInput) (*ListPartnerEventSourceAccountsOutput, error) {
+	req, out := c.ListPartnerEventSourceAccountsRequest(input)
 	return out, req.Send()
 }
 
-// TestEventPatternWithContext is the same as TestEventPattern with the addition of
+// ListPartnerEventSourceAccountsWithContext is the same as ListPartnerEventSourceAccounts with the addition of
 // the ability to pass a context and additional request options.
 //
-// See TestEventPattern for details on how to use this API operation.
+// See ListPartnerEventSourceAccounts for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) TestEventPatternWithContext(ctx aws.Context, input *TestEventPatternInput, opts ...request.Option) (*TestEventPatternOutput, error) {
-	req, out := c.TestEventPatternRequest(input)
+func (c *CloudWatchEvents) ListPartnerEventSourceAccountsWithContext(ctx aws.Context, input *ListPartnerEventSourceAccountsInput, opts ...request.Option) (*ListPartnerEventSourceAccountsOutput, error) {
+	req, out := c.ListPartnerEventSourceAccountsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUntagResource = "UntagResource"
+const opListPartnerEventSources = "ListPartnerEventSources"
 
-// UntagResourceRequest generates a "aws/request.Request" representing the
-// client's request for the UntagResource operation. The "output" return
+// ListPartnerEventSourcesRequest generates a "aws/request.Request" representing the
+// client's request for the ListPartnerEventSources operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UntagResource for more information on using the UntagResource
+// See ListPartnerEventSources for more information on using the ListPartnerEventSources
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListPartnerEventSourcesRequest method.
+//	req, resp := client.ListPartnerEventSourcesRequest(params)
 //
-//    // Example sending a request using the UntagResourceRequest method.
-//    req, resp := client.UntagResourceRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/UntagResource
-func (c *CloudWatchEvents) UntagResourceRequest(input *UntagResourceInput) (req *request.Request, output *UntagResourceOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListPartnerEventSources
+func (c *CloudWatchEvents) ListPartnerEventSourcesRequest(input *ListPartnerEventSourcesInput) (req *request.Request, output *ListPartnerEventSourcesOutput) {
 	op := &request.Operation{
-		Name:       opUntagResource,
+		Name:       opListPartnerEventSources,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &UntagResourceInput{}
+		input = &ListPartnerEventSourcesInput{}
 	}
 
-	output = &UntagResourceOutput{}
+	output = &ListPartnerEventSourcesOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// UntagResource API operation for Amazon CloudWatch Events.
+// ListPartnerEventSources API operation for Amazon CloudWatch Events.
 //
-// Removes one or more tags from the specified EventBridge resource. In EventBridge,
-// rules can be tagged.
+// An SaaS partner can use this operation to list all the partner event source
+// names that they have created. This operation is not used by Amazon Web Services
+// customers.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon CloudWatch Events's
-// API operation UntagResource for usage and error information.
-//
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   An entity that you specified doesn't exist.
+// API operation ListPartnerEventSources for usage and error information.
 //
-//   * ErrCodeInternalException "InternalException"
-//   This exception occurs due to unexpected causes.
+// Returned Error Types:
 //
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   There is concurrent modification on a resource.
+//   - InternalException
+//     This exception occurs due to unexpected causes.
 //
-//   * ErrCodeManagedRuleException "ManagedRuleException"
-//   An AWS service created this rule on behalf of your account. That service
-//   manages it. If you see this error in response to DeleteRule or RemoveTargets,
-//   you can use the Force parameter in those calls to delete the rule or remove
-//   targets from the rule. You can't modify these managed rules by using DisableRule,
-//   EnableRule, PutTargets, PutRule, TagResource, or UntagResource.
+//   - OperationDisabledException
+//     The operation you are attempting is not available in this region.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/UntagResource
-func (c *CloudWatchEvents) UntagResource(input *UntagResourceInput) (*UntagResourceOutput, error) {
-	req, out := c.UntagResourceRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListPartnerEventSources
+func (c *CloudWatchEvents) ListPartnerEventSources(input *ListPartnerEventSourcesInput) (*ListPartnerEventSourcesOutput, error) {
+	req, out := c.ListPartnerEventSourcesRequest(input)
 	return out, req.Send()
 }
 
-// UntagResourceWithContext is the same as UntagResource with the addition of
+// ListPartnerEventSourcesWithContext is the same as ListPartnerEventSources with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UntagResource for details on how to use this API operation.
+// See ListPartnerEventSources for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CloudWatchEvents) UntagResourceWithContext(ctx aws.Context, input *UntagResourceInput, opts ...request.Option) (*UntagResourceOutput, error) {
-	req, out := c.UntagResourceRequest(input)
+func (c *CloudWatchEvents) ListPartnerEventSourcesWithContext(ctx aws.Context, input *ListPartnerEventSourcesInput, opts ...request.Option) (*ListPartnerEventSourcesOutput, error) {
+	req, out := c.ListPartnerEventSourcesRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-type ActivateEventSourceInput struct {
-	_ struct{} `type:"structure"`
-
-	// The name of the partner event source to activate.
-	//
-	// Name is a required field
-	Name *string `min:"1" type:"string" required:"true"`
-}
-
-// String returns the string representation
-func (s ActivateEventSourceInput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s ActivateEventSourceInput) GoString() string {
-	return s.String()
-}
+const opListReplays = "ListReplays"
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *ActivateEventSourceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ActivateEventSourceInput"}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
-	}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+// ListReplaysRequest generates a "aws/request.Request" representing the
+// client's request for the ListReplays operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListReplays for more information on using the ListReplays
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListReplaysRequest method.
+//	req, resp := client.ListReplaysRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListReplays
+func (c *CloudWatchEvents) ListReplaysRequest(input *ListReplaysInput) (req *request.Request, output *ListReplaysOutput) {
+	op := &request.Operation{
+		Name:       opListReplays,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
 	}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
+	if input == nil {
+		input = &ListReplaysInput{}
 	}
-	return nil
-}
-
-// SetName sets the Name field's value.
-func (s *ActivateEventSourceInput) SetName(v string) *ActivateEventSourceInput {
-	s.Name = &v
-	return s
-}
 
-type ActivateEventSourceOutput struct {
-	_ struct{} `type:"structure"`
+	output = &ListReplaysOutput{}
+	req = c.newRequest(op, input, output)
+	return
 }
 
-// String returns the string representation
-func (s ActivateEventSourceOutput) String() string {
-	return awsutil.Prettify(s)
+// ListReplays API operation for Amazon CloudWatch Events.
+//
+// Lists your replays. You can either list all the replays or you can provide
+// a prefix to match to the replay names. Filter parameters are exclusive.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon CloudWatch Events's
+// API operation ListReplays for usage and error information.
+//
+// Returned Error Types:
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListReplays
+func (c *CloudWatchEvents) ListReplays(input *ListReplaysInput) (*ListReplaysOutput, error) {
+	req, out := c.ListReplaysRequest(input)
+	return out, req.Send()
 }
 
-// GoString returns the string representation
-func (s ActivateEventSourceOutput) GoString() string {
-	return s.String()
+// ListReplaysWithContext is the same as ListReplays with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListReplays for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CloudWatchEvents) ListReplaysWithContext(ctx aws.Context, input *ListReplaysInput, opts ...request.Option) (*ListReplaysOutput, error) {
+	req, out := c.ListReplaysRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
 }
 
-// This structure specifies the VPC subnets and security groups for the task
-// and whether a public IP address is to be used. This structure is relevant
-// only for ECS tasks that use the awsvpc network mode.
-type AwsVpcConfiguration struct {
-	_ struct{} `type:"structure"`
-
-	// Specifies whether the task's elastic network interface receives a public
-	// IP address. You can specify ENABLED only when LaunchType in EcsParameters
-	// is set to FARGATE.
-	AssignPublicIp *string `type:"string" enum:"AssignPublicIp"`
-
-	// Specifies the security groups associated with the task. These security groups
-	// must all be in the same VPC. You can specify as many as five security groups.
-	// If you don't specify a security group, the default security group for the
-	// VPC is used.
-	SecurityGroups []*string `type:"list"`
+const opListRuleNamesByTarget = "ListRuleNamesByTarget"
+
+// ListRuleNamesByTargetRequest generates a "aws/request.Request" representing the
+// client's request for the ListRuleNamesByTarget operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListRuleNamesByTarget for more information on using the ListRuleNamesByTarget
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListRuleNamesByTargetRequest method.
+//	req, resp := client.ListRuleNamesByTargetRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListRuleNamesByTarget
+func (c *CloudWatchEvents) ListRuleNamesByTargetRequest(input *ListRuleNamesByTargetInput) (req *request.Request, output *ListRuleNamesByTargetOutput) {
+	op := &request.Operation{
+		Name:       opListRuleNamesByTarget,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &ListRuleNamesByTargetInput{}
+	}
+
+	output = &ListRuleNamesByTargetOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListRuleNamesByTarget API operation for Amazon CloudWatch Events.
+//
+// Lists the rules for the specified target. You can see which of the rules
+// in Amazon EventBridge can invoke a specific target in your account.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon CloudWatch Events's
+// API operation ListRuleNamesByTarget for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListRuleNamesByTarget
+func (c *CloudWatchEvents) ListRuleNamesByTarget(input *ListRuleNamesByTargetInput) (*ListRuleNamesByTargetOutput, error) {
+	req, out := c.ListRuleNamesByTargetRequest(input)
+	return out, req.Send()
+}
+
+// ListRuleNamesByTargetWithContext is the same as ListRuleNamesByTarget with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListRuleNamesByTarget for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CloudWatchEvents) ListRuleNamesByTargetWithContext(ctx aws.Context, input *ListRuleNamesByTargetInput, opts ...request.Option) (*ListRuleNamesByTargetOutput, error) {
+	req, out := c.ListRuleNamesByTargetRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opListRules = "ListRules"
+
+// ListRulesRequest generates a "aws/request.Request" representing the
+// client's request for the ListRules operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListRules for more information on using the ListRules
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListRulesRequest method.
+//	req, resp := client.ListRulesRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListRules
+func (c *CloudWatchEvents) ListRulesRequest(input *ListRulesInput) (req *request.Request, output *ListRulesOutput) {
+	op := &request.Operation{
+		Name:       opListRules,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &ListRulesInput{}
+	}
+
+	output = &ListRulesOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListRules API operation for Amazon CloudWatch Events.
+//
+// Lists your Amazon EventBridge rules. You can either list all the rules or
+// you can provide a prefix to match to the rule names.
+//
+// ListRules does not list the targets of a rule. To see the targets associated
+// with a rule, use ListTargetsByRule (https://docs.aws.amazon.com/eventbridge/latest/APIReference/API_ListTargetsByRule.html).
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon CloudWatch Events's
+// API operation ListRules for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListRules
+func (c *CloudWatchEvents) ListRules(input *ListRulesInput) (*ListRulesOutput, error) {
+	req, out := c.ListRulesRequest(input)
+	return out, req.Send()
+}
+
+// ListRulesWithContext is the same as ListRules with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListRules for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CloudWatchEvents) ListRulesWithContext(ctx aws.Context, input *ListRulesInput, opts ...request.Option) (*ListRulesOutput, error) {
+	req, out := c.ListRulesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opListTagsForResource = "ListTagsForResource"
+
+// ListTagsForResourceRequest generates a "aws/request.Request" representing the
+// client's request for the ListTagsForResource operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListTagsForResource for more information on using the ListTagsForResource
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListTagsForResourceRequest method.
+//	req, resp := client.ListTagsForResourceRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListTagsForResource
+func (c *CloudWatchEvents) ListTagsForResourceRequest(input *ListTagsForResourceInput) (req *request.Request, output *ListTagsForResourceOutput) {
+	op := &request.Operation{
+		Name:       opListTagsForResource,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &ListTagsForResourceInput{}
+	}
+
+	output = &ListTagsForResourceOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListTagsForResource API operation for Amazon CloudWatch Events.
+//
+// Displays the tags associated with an EventBridge resource. In EventBridge,
+// rules and event buses can be tagged.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon CloudWatch Events's
+// API operation ListTagsForResource for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListTagsForResource
+func (c *CloudWatchEvents) ListTagsForResource(input *ListTagsForResourceInput) (*ListTagsForResourceOutput, error) {
+	req, out := c.ListTagsForResourceRequest(input)
+	return out, req.Send()
+}
+
+// ListTagsForResourceWithContext is the same as ListTagsForResource with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListTagsForResource for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CloudWatchEvents) ListTagsForResourceWithContext(ctx aws.Context, input *ListTagsForResourceInput, opts ...request.Option) (*ListTagsForResourceOutput, error) {
+	req, out := c.ListTagsForResourceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opListTargetsByRule = "ListTargetsByRule"
+
+// ListTargetsByRuleRequest generates a "aws/request.Request" representing the
+// client's request for the ListTargetsByRule operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListTargetsByRule for more information on using the ListTargetsByRule
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListTargetsByRuleRequest method.
+//	req, resp := client.ListTargetsByRuleRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListTargetsByRule
+func (c *CloudWatchEvents) ListTargetsByRuleRequest(input *ListTargetsByRuleInput) (req *request.Request, output *ListTargetsByRuleOutput) {
+	op := &request.Operation{
+		Name:       opListTargetsByRule,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &ListTargetsByRuleInput{}
+	}
+
+	output = &ListTargetsByRuleOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListTargetsByRule API operation for Amazon CloudWatch Events.
+//
+// Lists the targets assigned to the specified rule.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon CloudWatch Events's
+// API operation ListTargetsByRule for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/ListTargetsByRule
+func (c *CloudWatchEvents) ListTargetsByRule(input *ListTargetsByRuleInput) (*ListTargetsByRuleOutput, error) {
+	req, out := c.ListTargetsByRuleRequest(input)
+	return out, req.Send()
+}
+
+// ListTargetsByRuleWithContext is the same as ListTargetsByRule with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListTargetsByRule for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CloudWatchEvents) ListTargetsByRuleWithContext(ctx aws.Context, input *ListTargetsByRuleInput, opts ...request.Option) (*ListTargetsByRuleOutput, error) {
+	req, out := c.ListTargetsByRuleRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opPutEvents = "PutEvents"
+
+// PutEventsRequest generates a "aws/request.Request" representing the
+// client's request for the PutEvents operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See PutEvents for more information on using the PutEvents
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the PutEventsRequest method.
+//	req, resp := client.PutEventsRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/PutEvents
+func (c *CloudWatchEvents) PutEventsRequest(input *PutEventsInput) (req *request.Request, output *PutEventsOutput) {
+	op := &request.Operation{
+		Name:       opPutEvents,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &PutEventsInput{}
+	}
+
+	output = &PutEventsOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// PutEvents API operation for Amazon CloudWatch Events.
+//
+// Sends custom events to Amazon EventBridge so that they can be matched to
+// rules.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon CloudWatch Events's
+// API operation PutEvents for usage and error information.
+//
+// Returned Error Types:
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/PutEvents
+func (c *CloudWatchEvents) PutEvents(input *PutEventsInput) (*PutEventsOutput, error) {
+	req, out := c.PutEventsRequest(input)
+	return out, req.Send()
+}
+
+// PutEventsWithContext is the same as PutEvents with the addition of
+// the ability to pass a context and additional request options.
+//
+// See PutEvents for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CloudWatchEvents) PutEventsWithContext(ctx aws.Context, input *PutEventsInput, opts ...request.Option) (*PutEventsOutput, error) {
+	req, out := c.PutEventsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opPutPartnerEvents = "PutPartnerEvents"
+
+// PutPartnerEventsRequest generates a "aws/request.Request" representing the
+// client's request for the PutPartnerEvents operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See PutPartnerEvents for more information on using the PutPartnerEvents
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the PutPartnerEventsRequest method.
+//	req, resp := client.PutPartnerEventsRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/PutPartnerEvents
+func (c *CloudWatchEvents) PutPartnerEventsRequest(input *PutPartnerEventsInput) (req *request.Request, output *PutPartnerEventsOutput) {
+	op := &request.Operation{
+		Name:       opPutPartnerEvents,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &PutPartnerEventsInput{}
+	}
+
+	output = &PutPartnerEventsOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// PutPartnerEvents API operation for Amazon CloudWatch Events.
+//
+// This is used by SaaS partners to write events to a customer's partner event
+// bus. Amazon Web Services customers do not use this operation.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon CloudWatch Events's
+// API operation PutPartnerEvents for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+//   - OperationDisabledException
+//     The operation you are attempting is not available in this region.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/PutPartnerEvents
+func (c *CloudWatchEvents) PutPartnerEvents(input *PutPartnerEventsInput) (*PutPartnerEventsOutput, error) {
+	req, out := c.PutPartnerEventsRequest(input)
+	return out, req.Send()
+}
+
+// PutPartnerEventsWithContext is the same as PutPartnerEvents with the addition of
+// the ability to pass a context and additional request options.
+//
+// See PutPartnerEvents for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CloudWatchEvents) PutPartnerEventsWithContext(ctx aws.Context, input *PutPartnerEventsInput, opts ...request.Option) (*PutPartnerEventsOutput, error) {
+	req, out := c.PutPartnerEventsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opPutPermission = "PutPermission"
+
+// PutPermissionRequest generates a "aws/request.Request" representing the
+// client's request for the PutPermission operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See PutPermission for more information on using the PutPermission
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the PutPermissionRequest method.
+//	req, resp := client.PutPermissionRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/PutPermission
+func (c *CloudWatchEvents) PutPermissionRequest(input *PutPermissionInput) (req *request.Request, output *PutPermissionOutput) {
+	op := &request.Operation{
+		Name:       opPutPermission,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &PutPermissionInput{}
+	}
+
+	output = &PutPermissionOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// PutPermission API operation for Amazon CloudWatch Events.
+//
+// Running PutPermission permits the specified Amazon Web Services account or
+// Amazon Web Services organization to put events to the specified event bus.
+// Amazon EventBridge (CloudWatch Events) rules in your account are triggered
+// by these events arriving to an event bus in your account.
+//
+// For another account to send events to your account, that external account
+// must have an EventBridge rule with your account's event bus as a target.
+//
+// To enable multiple Amazon Web Services accounts to put events to your event
+// bus, run PutPermission once for each of these accounts. Or, if all the accounts
+// are members of the same Amazon Web Services organization, you can run PutPermission
+// once specifying Principal as "*" and specifying the Amazon Web Services organization
+// ID in Condition, to grant permissions to all accounts in that organization.
+//
+// If you grant permissions using an organization, then accounts in that organization
+// must specify a RoleArn with proper permissions when they use PutTarget to
+// add your account's event bus as a target. For more information, see Sending
+// and Receiving Events Between Amazon Web Services Accounts (https://docs.aws.amazon.com/eventbridge/latest/userguide/eventbridge-cross-account-event-delivery.html)
+// in the Amazon EventBridge User Guide.
+//
+// The permission policy on the event bus cannot exceed 10 KB in size.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon CloudWatch Events's
+// API operation PutPermission for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+//   - PolicyLengthExceededException
+//     The event bus policy is too long. For more information, see the limits.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
+//
+//   - OperationDisabledException
+//     The operation you are attempting is not available in this region.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/PutPermission
+func (c *CloudWatchEvents) PutPermission(input *PutPermissionInput) (*PutPermissionOutput, error) {
+	req, out := c.PutPermissionRequest(input)
+	return out, req.Send()
+}
+
+// PutPermissionWithContext is the same as PutPermission with the addition of
+// the ability to pass a context and additional request options.
+//
+// See PutPermission for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CloudWatchEvents) PutPermissionWithContext(ctx aws.Context, input *PutPermissionInput, opts ...request.Option) (*PutPermissionOutput, error) {
+	req, out := c.PutPermissionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opPutRule = "PutRule"
+
+// PutRuleRequest generates a "aws/request.Request" representing the
+// client's request for the PutRule operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See PutRule for more information on using the PutRule
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the PutRuleRequest method.
+//	req, resp := client.PutRuleRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/PutRule
+func (c *CloudWatchEvents) PutRuleRequest(input *PutRuleInput) (req *request.Request, output *PutRuleOutput) {
+	op := &request.Operation{
+		Name:       opPutRule,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &PutRuleInput{}
+	}
+
+	output = &PutRuleOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// PutRule API operation for Amazon CloudWatch Events.
+//
+// Creates or updates the specified rule. Rules are enabled by default, or based
+// on value of the state. You can disable a rule using DisableRule (https://docs.aws.amazon.com/eventbridge/latest/APIReference/API_DisableRule.html).
+//
+// A single rule watches for events from a single event bus. Events generated
+// by Amazon Web Services services go to your account's default event bus. Events
+// generated by SaaS partner services or applications go to the matching partner
+// event bus. If you have custom applications or services, you can specify whether
+// their events go to your default event bus or a custom event bus that you
+// have created. For more information, see CreateEventBus (https://docs.aws.amazon.com/eventbridge/latest/APIReference/API_CreateEventBus.html).
+//
+// If you are updating an existing rule, the rule is replaced with what you
+// specify in this PutRule command. If you omit arguments in PutRule, the old
+// values for those arguments are not kept. Instead, they are replaced with
+// null values.
+//
+// When you create or update a rule, incoming events might not immediately start
+// matching to new or updated rules. Allow a short period of time for changes
+// to take effect.
+//
+// A rule must contain at least an EventPattern or ScheduleExpression. Rules
+// with EventPatterns are triggered when a matching event is observed. Rules
+// with ScheduleExpressions self-trigger based on the given schedule. A rule
+// can have both an EventPattern and a ScheduleExpression, in which case the
+// rule triggers on matching events as well as on a schedule.
+//
+// When you initially create a rule, you can optionally assign one or more tags
+// to the rule. Tags can help you organize and categorize your resources. You
+// can also use them to scope user permissions, by granting a user permission
+// to access or change only rules with certain tag values. To use the PutRule
+// operation and assign tags, you must have both the events:PutRule and events:TagResource
+// permissions.
+//
+// If you are updating an existing rule, any tags you specify in the PutRule
+// operation are ignored. To update the tags of an existing rule, use TagResource
+// (https://docs.aws.amazon.com/eventbridge/latest/APIReference/API_TagResource.html)
+// and UntagResource (https://docs.aws.amazon.com/eventbridge/latest/APIReference/API_UntagResource.html).
+//
+// Most services in Amazon Web Services treat : or / as the same character in
+// Amazon Resource Names (ARNs). However, EventBridge uses an exact match in
+// event patterns and rules. Be sure to use the correct ARN characters when
+// creating event patterns so that they match the ARN syntax in the event you
+// want to match.
+//
+// In EventBridge, it is possible to create rules that lead to infinite loops,
+// where a rule is fired repeatedly. For example, a rule might detect that ACLs
+// have changed on an S3 bucket, and trigger software to change them to the
+// desired state. If the rule is not written carefully, the subsequent change
+// to the ACLs fires the rule again, creating an infinite loop.
+//
+// To prevent this, write the rules so that the triggered actions do not re-fire
+// the same rule. For example, your rule could fire only if ACLs are found to
+// be in a bad state, instead of after any change.
+//
+// An infinite loop can quickly cause higher than expected charges. We recommend
+// that you use budgeting, which alerts you when charges exceed your specified
+// limit. For more information, see Managing Your Costs with Budgets (https://docs.aws.amazon.com/awsaccountbilling/latest/aboutv2/budgets-managing-costs.html).
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon CloudWatch Events's
+// API operation PutRule for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidEventPatternException
+//     The event pattern is not valid.
+//
+//   - LimitExceededException
+//     The request failed because it attempted to create resource beyond the allowed
+//     service quota.
+//
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
+//
+//   - ManagedRuleException
+//     This rule was created by an Amazon Web Services service on behalf of your
+//     account. It is managed by that service. If you see this error in response
+//     to DeleteRule or RemoveTargets, you can use the Force parameter in those
+//     calls to delete the rule or remove targets from the rule. You cannot modify
+//     these managed rules by using DisableRule, EnableRule, PutTargets, PutRule,
+//     TagResource, or UntagResource.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/PutRule
+func (c *CloudWatchEvents) PutRule(input *PutRuleInput) (*PutRuleOutput, error) {
+	req, out := c.PutRuleRequest(input)
+	return out, req.Send()
+}
+
+// PutRuleWithContext is the same as PutRule with the addition of
+// the ability to pass a context and additional request options.
+//
+// See PutRule for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CloudWatchEvents) PutRuleWithContext(ctx aws.Context, input *PutRuleInput, opts ...request.Option) (*PutRuleOutput, error) {
+	req, out := c.PutRuleRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opPutTargets = "PutTargets"
+
+// PutTargetsRequest generates a "aws/request.Request" representing the
+// client's request for the PutTargets operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See PutTargets for more information on using the PutTargets
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the PutTargetsRequest method.
+//	req, resp := client.PutTargetsRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/PutTargets
+func (c *CloudWatchEvents) PutTargetsRequest(input *PutTargetsInput) (req *request.Request, output *PutTargetsOutput) {
+	op := &request.Operation{
+		Name:       opPutTargets,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &PutTargetsInput{}
+	}
+
+	output = &PutTargetsOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// PutTargets API operation for Amazon CloudWatch Events.
+//
+// Adds the specified targets to the specified rule, or updates the targets
+// if they are already associated with the rule.
+//
+// Targets are the resources that are invoked when a rule is triggered.
+//
+// You can configure the following as targets for Events:
+//
+//   - API destination (https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-api-destinations.html)
+//
+//   - Amazon API Gateway REST API endpoints
+//
+//   - API Gateway
+//
+//   - Batch job queue
+//
+//   - CloudWatch Logs group
+//
+//   - CodeBuild project
+//
+//   - CodePipeline
+//
+//   - Amazon EC2 CreateSnapshot API call
+//
+//   - Amazon EC2 RebootInstances API call
+//
+//   - Amazon EC2 StopInstances API call
+//
+//   - Amazon EC2 TerminateInstances API call
+//
+//   - Amazon ECS tasks
+//
+//   - Event bus in a different Amazon Web Services account or Region. You
+//     can use an event bus in the US East (N. Virginia) us-east-1, US West (Oregon)
+//     us-west-2, or Europe (Ireland) eu-west-1 Regions as a target for a rule.
+//
+//   - Firehose delivery stream (Kinesis Data Firehose)
+//
+//   - Inspector assessment template (Amazon Inspector)
+//
+//   - Kinesis stream (Kinesis Data Stream)
+//
+//   - Lambda function
+//
+//   - Redshift clusters (Data API statement execution)
+//
+//   - Amazon SNS topic
+//
+//   - Amazon SQS queues (includes FIFO queues
+//
+//   - SSM Automation
+//
+//   - SSM OpsItem
+//
+//   - SSM Run Command
+//
+//   - Step Functions state machines
+//
+// Creating rules with built-in targets is supported only in the Amazon Web
+// Services Management Console. The built-in targets are EC2 CreateSnapshot
+// API call, EC2 RebootInstances API call, EC2 StopInstances API call, and EC2
+// TerminateInstances API call.
+//
+// For some target types, PutTargets provides target-specific parameters. If
+// the target is a Kinesis data stream, you can optionally specify which shard
+// the event goes to by using the KinesisParameters argument. To invoke a command
+// on multiple EC2 instances with one rule, you can use the RunCommandParameters
+// field.
+//
+// To be able to make API calls against the resources that you own, Amazon EventBridge
+// needs the appropriate permissions. For Lambda and Amazon SNS resources, EventBridge
+// relies on resource-based policies. For EC2 instances, Kinesis Data Streams,
+// Step Functions state machines and API Gateway REST APIs, EventBridge relies
+// on IAM roles that you specify in the RoleARN argument in PutTargets. For
+// more information, see Authentication and Access Control (https://docs.aws.amazon.com/eventbridge/latest/userguide/auth-and-access-control-eventbridge.html)
+// in the Amazon EventBridge User Guide.
+//
+// If another Amazon Web Services account is in the same region and has granted
+// you permission (using PutPermission), you can send events to that account.
+// Set that account's event bus as a target of the rules in your account. To
+// send the matched events to the other account, specify that account's event
+// bus as the Arn value when you run PutTargets. If your account sends events
+// to another account, your account is charged for each sent event. Each event
+// sent to another account is charged as a custom event. The account receiving
+// the event is not charged. For more information, see Amazon EventBridge Pricing
+// (http://aws.amazon.com/eventbridge/pricing/).
+//
+// Input, InputPath, and InputTransformer are not available with PutTarget if
+// the target is an event bus of a different Amazon Web Services account.
+//
+// If you are setting the event bus of another account as the target, and that
+// account granted permission to your account through an organization instead
+// of directly by the account ID, then you must specify a RoleArn with proper
+// permissions in the Target structure. For more information, see Sending and
+// Receiving Events Between Amazon Web Services Accounts (https://docs.aws.amazon.com/eventbridge/latest/userguide/eventbridge-cross-account-event-delivery.html)
+// in the Amazon EventBridge User Guide.
+//
+// For more information about enabling cross-account events, see PutPermission
+// (https://docs.aws.amazon.com/eventbridge/latest/APIReference/API_PutPermission.html).
+//
+// Input, InputPath, and InputTransformer are mutually exclusive and optional
+// parameters of a target. When a rule is triggered due to a matched event:
+//
+//   - If none of the following arguments are specified for a target, then
+//     the entire event is passed to the target in JSON format (unless the target
+//     is Amazon EC2 Run Command or Amazon ECS task, in which case nothing from
+//     the event is passed to the target).
+//
+//   - If Input is specified in the form of valid JSON, then the matched event
+//     is overridden with this constant.
+//
+//   - If InputPath is specified in the form of JSONPath (for example, $.detail),
+//     then only the part of the event specified in the path is passed to the
+//     target (for example, only the detail part of the event is passed).
+//
+//   - If InputTransformer is specified, then one or more specified JSONPaths
+//     are extracted from the event and used as values in a template that you
+//     specify as the input to the target.
+//
+// When you specify InputPath or InputTransformer, you must use JSON dot notation,
+// not bracket notation.
+//
+// When you add targets to a rule and the associated rule triggers soon after,
+// new or updated targets might not be immediately invoked. Allow a short period
+// of time for changes to take effect.
+//
+// This action can partially fail if too many requests are made at the same
+// time. If that happens, FailedEntryCount is non-zero in the response and each
+// entry in FailedEntries provides the ID of the failed target and the error
+// code.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon CloudWatch Events's
+// API operation PutTargets for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
+//
+//   - LimitExceededException
+//     The request failed because it attempted to create resource beyond the allowed
+//     service quota.
+//
+//   - ManagedRuleException
+//     This rule was created by an Amazon Web Services service on behalf of your
+//     account. It is managed by that service. If you see this error in response
+//     to DeleteRule or RemoveTargets, you can use the Force parameter in those
+//     calls to delete the rule or remove targets from the rule. You cannot modify
+//     these managed rules by using DisableRule, EnableRule, PutTargets, PutRule,
+//     TagResource, or UntagResource.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/PutTargets
+func (c *CloudWatchEvents) PutTargets(input *PutTargetsInput) (*PutTargetsOutput, error) {
+	req, out := c.PutTargetsRequest(input)
+	return out, req.Send()
+}
+
+// PutTargetsWithContext is the same as PutTargets with the addition of
+// the ability to pass a context and additional request options.
+//
+// See PutTargets for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CloudWatchEvents) PutTargetsWithContext(ctx aws.Context, input *PutTargetsInput, opts ...request.Option) (*PutTargetsOutput, error) {
+	req, out := c.PutTargetsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opRemovePermission = "RemovePermission"
+
+// RemovePermissionRequest generates a "aws/request.Request" representing the
+// client's request for the RemovePermission operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See RemovePermission for more information on using the RemovePermission
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the RemovePermissionRequest method.
+//	req, resp := client.RemovePermissionRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/RemovePermission
+func (c *CloudWatchEvents) RemovePermissionRequest(input *RemovePermissionInput) (req *request.Request, output *RemovePermissionOutput) {
+	op := &request.Operation{
+		Name:       opRemovePermission,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &RemovePermissionInput{}
+	}
+
+	output = &RemovePermissionOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// RemovePermission API operation for Amazon CloudWatch Events.
+//
+// Revokes the permission of another Amazon Web Services account to be able
+// to put events to the specified event bus. Specify the account to revoke by
+// the StatementId value that you associated with the account when you granted
+// it permission with PutPermission. You can find the StatementId by using DescribeEventBus
+// (https://docs.aws.amazon.com/eventbridge/latest/APIReference/API_DescribeEventBus.html).
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon CloudWatch Events's
+// API operation RemovePermission for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
+//
+//   - OperationDisabledException
+//     The operation you are attempting is not available in this region.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/RemovePermission
+func (c *CloudWatchEvents) RemovePermission(input *RemovePermissionInput) (*RemovePermissionOutput, error) {
+	req, out := c.RemovePermissionRequest(input)
+	return out, req.Send()
+}
+
+// RemovePermissionWithContext is the same as RemovePermission with the addition of
+// the ability to pass a context and additional request options.
+//
+// See RemovePermission for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CloudWatchEvents) RemovePermissionWithContext(ctx aws.Context, input *RemovePermissionInput, opts ...request.Option) (*RemovePermissionOutput, error) {
+	req, out := c.RemovePermissionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opRemoveTargets = "RemoveTargets"
+
+// RemoveTargetsRequest generates a "aws/request.Request" representing the
+// client's request for the RemoveTargets operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See RemoveTargets for more information on using the RemoveTargets
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the RemoveTargetsRequest method.
+//	req, resp := client.RemoveTargetsRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/RemoveTargets
+func (c *CloudWatchEvents) RemoveTargetsRequest(input *RemoveTargetsInput) (req *request.Request, output *RemoveTargetsOutput) {
+	op := &request.Operation{
+		Name:       opRemoveTargets,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &RemoveTargetsInput{}
+	}
+
+	output = &RemoveTargetsOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// RemoveTargets API operation for Amazon CloudWatch Events.
+//
+// Removes the specified targets from the specified rule. When the rule is triggered,
+// those targets are no longer be invoked.
+//
+// When you remove a target, when the associated rule triggers, removed targets
+// might continue to be invoked. Allow a short period of time for changes to
+// take effect.
+//
+// This action can partially fail if too many requests are made at the same
+// time. If that happens, FailedEntryCount is non-zero in the response and each
+// entry in FailedEntries provides the ID of the failed target and the error
+// code.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon CloudWatch Events's
+// API operation RemoveTargets for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
+//
+//   - ManagedRuleException
+//     This rule was created by an Amazon Web Services service on behalf of your
+//     account. It is managed by that service. If you see this error in response
+//     to DeleteRule or RemoveTargets, you can use the Force parameter in those
+//     calls to delete the rule or remove targets from the rule. You cannot modify
+//     these managed rules by using DisableRule, EnableRule, PutTargets, PutRule,
+//     TagResource, or UntagResource.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/RemoveTargets
+func (c *CloudWatchEvents) RemoveTargets(input *RemoveTargetsInput) (*RemoveTargetsOutput, error) {
+	req, out := c.RemoveTargetsRequest(input)
+	return out, req.Send()
+}
+
+// RemoveTargetsWithContext is the same as RemoveTargets with the addition of
+// the ability to pass a context and additional request options.
+//
+// See RemoveTargets for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CloudWatchEvents) RemoveTargetsWithContext(ctx aws.Context, input *RemoveTargetsInput, opts ...request.Option) (*RemoveTargetsOutput, error) {
+	req, out := c.RemoveTargetsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opStartReplay = "StartReplay"
+
+// StartReplayRequest generates a "aws/request.Request" representing the
+// client's request for the StartReplay operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See StartReplay for more information on using the StartReplay
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the StartReplayRequest method.
+//	req, resp := client.StartReplayRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/StartReplay
+func (c *CloudWatchEvents) StartReplayRequest(input *StartReplayInput) (req *request.Request, output *StartReplayOutput) {
+	op := &request.Operation{
+		Name:       opStartReplay,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &StartReplayInput{}
+	}
+
+	output = &StartReplayOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// StartReplay API operation for Amazon CloudWatch Events.
+//
+// Starts the specified replay. Events are not necessarily replayed in the exact
+// same order that they were added to the archive. A replay processes events
+// to replay based on the time in the event, and replays them using 1 minute
+// intervals. If you specify an EventStartTime and an EventEndTime that covers
+// a 20 minute time range, the events are replayed from the first minute of
+// that 20 minute range first. Then the events from the second minute are replayed.
+// You can use DescribeReplay to determine the progress of a replay. The value
+// returned for EventLastReplayedTime indicates the time within the specified
+// time range associated with the last event replayed.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon CloudWatch Events's
+// API operation StartReplay for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+//   - ResourceAlreadyExistsException
+//     The resource you are trying to create already exists.
+//
+//   - InvalidEventPatternException
+//     The event pattern is not valid.
+//
+//   - LimitExceededException
+//     The request failed because it attempted to create resource beyond the allowed
+//     service quota.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/StartReplay
+func (c *CloudWatchEvents) StartReplay(input *StartReplayInput) (*StartReplayOutput, error) {
+	req, out := c.StartReplayRequest(input)
+	return out, req.Send()
+}
+
+// StartReplayWithContext is the same as StartReplay with the addition of
+// the ability to pass a context and additional request options.
+//
+// See StartReplay for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CloudWatchEvents) StartReplayWithContext(ctx aws.Context, input *StartReplayInput, opts ...request.Option) (*StartReplayOutput, error) {
+	req, out := c.StartReplayRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opTagResource = "TagResource"
+
+// TagResourceRequest generates a "aws/request.Request" representing the
+// client's request for the TagResource operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See TagResource for more information on using the TagResource
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the TagResourceRequest method.
+//	req, resp := client.TagResourceRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/TagResource
+func (c *CloudWatchEvents) TagResourceRequest(input *TagResourceInput) (req *request.Request, output *TagResourceOutput) {
+	op := &request.Operation{
+		Name:       opTagResource,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &TagResourceInput{}
+	}
+
+	output = &TagResourceOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// TagResource API operation for Amazon CloudWatch Events.
+//
+// Assigns one or more tags (key-value pairs) to the specified EventBridge resource.
+// Tags can help you organize and categorize your resources. You can also use
+// them to scope user permissions by granting a user permission to access or
+// change only resources with certain tag values. In EventBridge, rules and
+// event buses can be tagged.
+//
+// Tags don't have any semantic meaning to Amazon Web Services and are interpreted
+// strictly as strings of characters.
+//
+// You can use the TagResource action with a resource that already has tags.
+// If you specify a new tag key, this tag is appended to the list of tags associated
+// with the resource. If you specify a tag key that is already associated with
+// the resource, the new tag value that you specify replaces the previous value
+// for that tag.
+//
+// You can associate as many as 50 tags with a resource.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon CloudWatch Events's
+// API operation TagResource for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+//   - ManagedRuleException
+//     This rule was created by an Amazon Web Services service on behalf of your
+//     account. It is managed by that service. If you see this error in response
+//     to DeleteRule or RemoveTargets, you can use the Force parameter in those
+//     calls to delete the rule or remove targets from the rule. You cannot modify
+//     these managed rules by using DisableRule, EnableRule, PutTargets, PutRule,
+//     TagResource, or UntagResource.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/TagResource
+func (c *CloudWatchEvents) TagResource(input *TagResourceInput) (*TagResourceOutput, error) {
+	req, out := c.TagResourceRequest(input)
+	return out, req.Send()
+}
+
+// TagResourceWithContext is the same as TagResource with the addition of
+// the ability to pass a context and additional request options.
+//
+// See TagResource for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CloudWatchEvents) TagResourceWithContext(ctx aws.Context, input *TagResourceInput, opts ...request.Option) (*TagResourceOutput, error) {
+	req, out := c.TagResourceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opTestEventPattern = "TestEventPattern"
+
+// TestEventPatternRequest generates a "aws/request.Request" representing the
+// client's request for the TestEventPattern operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See TestEventPattern for more information on using the TestEventPattern
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the TestEventPatternRequest method.
+//	req, resp := client.TestEventPatternRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/TestEventPattern
+func (c *CloudWatchEvents) TestEventPatternRequest(input *TestEventPatternInput) (req *request.Request, output *TestEventPatternOutput) {
+	op := &request.Operation{
+		Name:       opTestEventPattern,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &TestEventPatternInput{}
+	}
+
+	output = &TestEventPatternOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// TestEventPattern API operation for Amazon CloudWatch Events.
+//
+// Tests whether the specified event pattern matches the provided event.
+//
+// Most services in Amazon Web Services treat : or / as the same character in
+// Amazon Resource Names (ARNs). However, EventBridge uses an exact match in
+// event patterns and rules. Be sure to use the correct ARN characters when
+// creating event patterns so that they match the ARN syntax in the event you
+// want to match.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon CloudWatch Events's
+// API operation TestEventPattern for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidEventPatternException
+//     The event pattern is not valid.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/TestEventPattern
+func (c *CloudWatchEvents) TestEventPattern(input *TestEventPatternInput) (*TestEventPatternOutput, error) {
+	req, out := c.TestEventPatternRequest(input)
+	return out, req.Send()
+}
+
+// TestEventPatternWithContext is the same as TestEventPattern with the addition of
+// the ability to pass a context and additional request options.
+//
+// See TestEventPattern for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CloudWatchEvents) TestEventPatternWithContext(ctx aws.Context, input *TestEventPatternInput, opts ...request.Option) (*TestEventPatternOutput, error) {
+	req, out := c.TestEventPatternRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUntagResource = "UntagResource"
+
+// UntagResourceRequest generates a "aws/request.Request" representing the
+// client's request for the UntagResource operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UntagResource for more information on using the UntagResource
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UntagResourceRequest method.
+//	req, resp := client.UntagResourceRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/UntagResource
+func (c *CloudWatchEvents) UntagResourceRequest(input *UntagResourceInput) (req *request.Request, output *UntagResourceOutput) {
+	op := &request.Operation{
+		Name:       opUntagResource,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UntagResourceInput{}
+	}
+
+	output = &UntagResourceOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// UntagResource API operation for Amazon CloudWatch Events.
+//
+// Removes one or more tags from the specified EventBridge resource. In Amazon
+// EventBridge (CloudWatch Events), rules and event buses can be tagged.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon CloudWatch Events's
+// API operation UntagResource for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
+//
+//   - ManagedRuleException
+//     This rule was created by an Amazon Web Services service on behalf of your
+//     account. It is managed by that service. If you see this error in response
+//     to DeleteRule or RemoveTargets, you can use the Force parameter in those
+//     calls to delete the rule or remove targets from the rule. You cannot modify
+//     these managed rules by using DisableRule, EnableRule, PutTargets, PutRule,
+//     TagResource, or UntagResource.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/UntagResource
+func (c *CloudWatchEvents) UntagResource(input *UntagResourceInput) (*UntagResourceOutput, error) {
+	req, out := c.UntagResourceRequest(input)
+	return out, req.Send()
+}
+
+// UntagResourceWithContext is the same as UntagResource with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UntagResource for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CloudWatchEvents) UntagResourceWithContext(ctx aws.Context, input *UntagResourceInput, opts ...request.Option) (*UntagResourceOutput, error) {
+	req, out := c.UntagResourceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateApiDestination = "UpdateApiDestination"
+
+// UpdateApiDestinationRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateApiDestination operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateApiDestination for more information on using the UpdateApiDestination
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateApiDestinationRequest method.
+//	req, resp := client.UpdateApiDestinationRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/UpdateApiDestination
+func (c *CloudWatchEvents) UpdateApiDestinationRequest(input *UpdateApiDestinationInput) (req *request.Request, output *UpdateApiDestinationOutput) {
+	op := &request.Operation{
+		Name:       opUpdateApiDestination,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UpdateApiDestinationInput{}
+	}
+
+	output = &UpdateApiDestinationOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UpdateApiDestination API operation for Amazon CloudWatch Events.
+//
+// Updates an API destination.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon CloudWatch Events's
+// API operation UpdateApiDestination for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
+//
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+//   - LimitExceededException
+//     The request failed because it attempted to create resource beyond the allowed
+//     service quota.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/UpdateApiDestination
+func (c *CloudWatchEvents) UpdateApiDestination(input *UpdateApiDestinationInput) (*UpdateApiDestinationOutput, error) {
+	req, out := c.UpdateApiDestinationRequest(input)
+	return out, req.Send()
+}
+
+// UpdateApiDestinationWithContext is the same as UpdateApiDestination with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateApiDestination for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CloudWatchEvents) UpdateApiDestinationWithContext(ctx aws.Context, input *UpdateApiDestinationInput, opts ...request.Option) (*UpdateApiDestinationOutput, error) {
+	req, out := c.UpdateApiDestinationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateArchive = "UpdateArchive"
+
+// UpdateArchiveRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateArchive operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateArchive for more information on using the UpdateArchive
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateArchiveRequest method.
+//	req, resp := client.UpdateArchiveRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/UpdateArchive
+func (c *CloudWatchEvents) UpdateArchiveRequest(input *UpdateArchiveInput) (req *request.Request, output *UpdateArchiveOutput) {
+	op := &request.Operation{
+		Name:       opUpdateArchive,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UpdateArchiveInput{}
+	}
+
+	output = &UpdateArchiveOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UpdateArchive API operation for Amazon CloudWatch Events.
+//
+// Updates the specified archive.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon CloudWatch Events's
+// API operation UpdateArchive for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
+//
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+//   - LimitExceededException
+//     The request failed because it attempted to create resource beyond the allowed
+//     service quota.
+//
+//   - InvalidEventPatternException
+//     The event pattern is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/UpdateArchive
+func (c *CloudWatchEvents) UpdateArchive(input *UpdateArchiveInput) (*UpdateArchiveOutput, error) {
+	req, out := c.UpdateArchiveRequest(input)
+	return out, req.Send()
+}
+
+// UpdateArchiveWithContext is the same as UpdateArchive with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateArchive for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CloudWatchEvents) UpdateArchiveWithContext(ctx aws.Context, input *UpdateArchiveInput, opts ...request.Option) (*UpdateArchiveOutput, error) {
+	req, out := c.UpdateArchiveRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateConnection = "UpdateConnection"
+
+// UpdateConnectionRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateConnection operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateConnection for more information on using the UpdateConnection
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateConnectionRequest method.
+//	req, resp := client.UpdateConnectionRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/UpdateConnection
+func (c *CloudWatchEvents) UpdateConnectionRequest(input *UpdateConnectionInput) (req *request.Request, output *UpdateConnectionOutput) {
+	op := &request.Operation{
+		Name:       opUpdateConnection,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UpdateConnectionInput{}
+	}
+
+	output = &UpdateConnectionOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UpdateConnection API operation for Amazon CloudWatch Events.
+//
+// Updates settings for a connection.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon CloudWatch Events's
+// API operation UpdateConnection for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ConcurrentModificationException
+//     There is concurrent modification on a rule, target, archive, or replay.
+//
+//   - ResourceNotFoundException
+//     An entity that you specified does not exist.
+//
+//   - InternalException
+//     This exception occurs due to unexpected causes.
+//
+//   - LimitExceededException
+//     The request failed because it attempted to create resource beyond the allowed
+//     service quota.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/events-2015-10-07/UpdateConnection
+func (c *CloudWatchEvents) UpdateConnection(input *UpdateConnectionInput) (*UpdateConnectionOutput, error) {
+	req, out := c.UpdateConnectionRequest(input)
+	return out, req.Send()
+}
+
+// UpdateConnectionWithContext is the same as UpdateConnection with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateConnection for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CloudWatchEvents) UpdateConnectionWithContext(ctx aws.Context, input *UpdateConnectionInput, opts ...request.Option) (*UpdateConnectionOutput, error) {
+	req, out := c.UpdateConnectionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+type ActivateEventSourceInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the partner event source to activate.
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActivateEventSourceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActivateEventSourceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ActivateEventSourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ActivateEventSourceInput"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetName sets the Name field's value.
+func (s *ActivateEventSourceInput) SetName(v string) *ActivateEventSourceInput {
+	s.Name = &v
+	return s
+}
+
+type ActivateEventSourceOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActivateEventSourceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActivateEventSourceOutput) GoString() string {
+	return s.String()
+}
+
+// Contains details about an API destination.
+type ApiDestination struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the API destination.
+	ApiDestinationArn *string `min:"1" type:"string"`
+
+	// The state of the API destination.
+	ApiDestinationState *string `type:"string" enum:"ApiDestinationState"`
+
+	// The ARN of the connection specified for the API destination.
+	ConnectionArn *string `min:"1" type:"string"`
+
+	// A time stamp for the time that the API destination was created.
+	CreationTime *time.Time `type:"timestamp"`
+
+	// The method to use to connect to the HTTP endpoint.
+	HttpMethod *string `type:"string" enum:"ApiDestinationHttpMethod"`
+
+	// The URL to the endpoint for the API destination.
+	InvocationEndpoint *string `min:"1" type:"string"`
+
+	// The maximum number of invocations per second to send to the HTTP endpoint.
+	InvocationRateLimitPerSecond *int64 `min:"1" type:"integer"`
+
+	// A time stamp for the time that the API destination was last modified.
+	LastModifiedTime *time.Time `type:"timestamp"`
+
+	// The name of the API destination.
+	Name *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ApiDestination) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ApiDestination) GoString() string {
+	return s.String()
+}
+
+// SetApiDestinationArn sets the ApiDestinationArn field's value.
+func (s *ApiDestination) SetApiDestinationArn(v string) *ApiDestination {
+	s.ApiDestinationArn = &v
+	return s
+}
+
+// SetApiDestinationState sets the ApiDestinationState field's value.
+func (s *ApiDestination) SetApiDestinationState(v string) *ApiDestination {
+	s.ApiDestinationState = &v
+	return s
+}
+
+// SetConnectionArn sets the ConnectionArn field's value.
+func (s *ApiDestination) SetConnectionArn(v string) *ApiDestination {
+	s.ConnectionArn = &v
+	return s
+}
+
+// SetCreationTime sets the CreationTime field's value.
+func (s *ApiDestination) SetCreationTime(v time.Time) *ApiDestination {
+	s.CreationTime = &v
+	return s
+}
+
+// SetHttpMethod sets the HttpMethod field's value.
+func (s *ApiDestination) SetHttpMethod(v string) *ApiDestination {
+	s.HttpMethod = &v
+	return s
+}
+
+// SetInvocationEndpoint sets the InvocationEndpoint field's value.
+func (s *ApiDestination) SetInvocationEndpoint(v string) *ApiDestination {
+	s.InvocationEndpoint = &v
+	return s
+}
+
+// SetInvocationRateLimitPerSecond sets the InvocationRateLimitPerSecond field's value.
+func (s *ApiDestination) SetInvocationRateLimitPerSecond(v int64) *ApiDestination {
+	s.InvocationRateLimitPerSecond = &v
+	return s
+}
+
+// SetLastModifiedTime sets the LastModifiedTime field's value.
+func (s *ApiDestination) SetLastModifiedTime(v time.Time) *ApiDestination {
+	s.LastModifiedTime = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *ApiDestination) SetName(v string) *ApiDestination {
+	s.Name = &v
+	return s
+}
+
+// An Archive object that contains details about an archive.
+type Archive struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the archive.
+	ArchiveName *string `min:"1" type:"string"`
+
+	// The time stamp for the time that the archive was created.
+	CreationTime *time.Time `type:"timestamp"`
+
+	// The number of events in the archive.
+	EventCount *int64 `type:"long"`
+
+	// The ARN of the event bus associated with the archive. Only events from this
+	// event bus are sent to the archive.
+	EventSourceArn *string `min:"1" type:"string"`
+
+	// The number of days to retain events in the archive before they are deleted.
+	RetentionDays *int64 `type:"integer"`
+
+	// The size of the archive, in bytes.
+	SizeBytes *int64 `type:"long"`
+
+	// The current state of the archive.
+	State *string `type:"string" enum:"ArchiveState"`
+
+	// A description for the reason that the archive is in the current state.
+	StateReason *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Archive) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Archive) GoString() string {
+	return s.String()
+}
+
+// SetArchiveName sets the ArchiveName field's value.
+func (s *Archive) SetArchiveName(v string) *Archive {
+	s.ArchiveName = &v
+	return s
+}
+
+// SetCreationTime sets the CreationTime field's value.
+func (s *Archive) SetCreationTime(v time.Time) *Archive {
+	s.CreationTime = &v
+	return s
+}
+
+// SetEventCount sets the EventCount field's value.
+func (s *Archive) SetEventCount(v int64) *Archive {
+	s.EventCount = &v
+	return s
+}
+
+// SetEventSourceArn sets the EventSourceArn field's value.
+func (s *Archive) SetEventSourceArn(v string) *Archive {
+	s.EventSourceArn = &v
+	return s
+}
+
+// SetRetentionDays sets the RetentionDays field's value.
+func (s *Archive) SetRetentionDays(v int64) *Archive {
+	s.RetentionDays = &v
+	return s
+}
+
+// SetSizeBytes sets the SizeBytes field's value.
+func (s *Archive) SetSizeBytes(v int64) *Archive {
+	s.SizeBytes = &v
+	return s
+}
+
+// SetState sets the State field's value.
+func (s *Archive) SetState(v string) *Archive {
+	s.State = &v
+	return s
+}
+
+// SetStateReason sets the StateReason field's value.
+func (s *Archive) SetStateReason(v string) *Archive {
+	s.StateReason = &v
+	return s
+}
+
+// This structure specifies the VPC subnets and security groups for the task,
+// and whether a public IP address is to be used. This structure is relevant
+// only for ECS tasks that use the awsvpc network mode.
+type AwsVpcConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies whether the task's elastic network interface receives a public
+	// IP address. You can specify ENABLED only when LaunchType in EcsParameters
+	// is set to FARGATE.
+	AssignPublicIp *string `type:"string" enum:"AssignPublicIp"`
+
+	// Specifies the security groups associated with the task. These security groups
+	// must all be in the same VPC. You can specify as many as five security groups.
+	// If you do not specify a security group, the default security group for the
+	// VPC is used.
+	SecurityGroups []*string `type:"list"`
 
 	// Specifies the subnets associated with the task. These subnets must all be
 	// in the same VPC. You can specify as many as 16 subnets.
 	//
-	// Subnets is a required field
-	Subnets []*string `type:"list" required:"true"`
+	// Subnets is a required field
+	Subnets []*string `type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AwsVpcConfiguration) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AwsVpcConfiguration) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *AwsVpcConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AwsVpcConfiguration"}
+	if s.Subnets == nil {
+		invalidParams.Add(request.NewErrParamRequired("Subnets"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAssignPublicIp sets the AssignPublicIp field's value.
+func (s *AwsVpcConfiguration) SetAssignPublicIp(v string) *AwsVpcConfiguration {
+	s.AssignPublicIp = &v
+	return s
+}
+
+// SetSecurityGroups sets the SecurityGroups field's value.
+func (s *AwsVpcConfiguration) SetSecurityGroups(v []*string) *AwsVpcConfiguration {
+	s.SecurityGroups = v
+	return s
+}
+
+// SetSubnets sets the Subnets field's value.
+func (s *AwsVpcConfiguration) SetSubnets(v []*string) *AwsVpcConfiguration {
+	s.Subnets = v
+	return s
+}
+
+// The array properties for the submitted job, such as the size of the array.
+// The array size can be between 2 and 10,000. If you specify array properties
+// for a job, it becomes an array job. This parameter is used only if the target
+// is an Batch job.
+type BatchArrayProperties struct {
+	_ struct{} `type:"structure"`
+
+	// The size of the array, if this is an array batch job. Valid values are integers
+	// between 2 and 10,000.
+	Size *int64 `type:"integer"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchArrayProperties) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchArrayProperties) GoString() string {
+	return s.String()
+}
+
+// SetSize sets the Size field's value.
+func (s *BatchArrayProperties) SetSize(v int64) *BatchArrayProperties {
+	s.Size = &v
+	return s
+}
+
+// The custom parameters to be used when the target is an Batch job.
+type BatchParameters struct {
+	_ struct{} `type:"structure"`
+
+	// The array properties for the submitted job, such as the size of the array.
+	// The array size can be between 2 and 10,000. If you specify array properties
+	// for a job, it becomes an array job. This parameter is used only if the target
+	// is an Batch job.
+	ArrayProperties *BatchArrayProperties `type:"structure"`
+
+	// The ARN or name of the job definition to use if the event target is an Batch
+	// job. This job definition must already exist.
+	//
+	// JobDefinition is a required field
+	JobDefinition *string `type:"string" required:"true"`
+
+	// The name to use for this execution of the job, if the target is an Batch
+	// job.
+	//
+	// JobName is a required field
+	JobName *string `type:"string" required:"true"`
+
+	// The retry strategy to use for failed jobs, if the target is an Batch job.
+	// The retry strategy is the number of times to retry the failed job execution.
+	// Valid values are 1–10. When you specify a retry strategy here, it overrides
+	// the retry strategy defined in the job definition.
+	RetryStrategy *BatchRetryStrategy `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchParameters) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchParameters) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *BatchParameters) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchParameters"}
+	if s.JobDefinition == nil {
+		invalidParams.Add(request.NewErrParamRequired("JobDefinition"))
+	}
+	if s.JobName == nil {
+		invalidParams.Add(request.NewErrParamRequired("JobName"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetArrayProperties sets the ArrayProperties field's value.
+func (s *BatchParameters) SetArrayProperties(v *BatchArrayProperties) *BatchParameters {
+	s.ArrayProperties = v
+	return s
+}
+
+// SetJobDefinition sets the JobDefinition field's value.
+func (s *BatchParameters) SetJobDefinition(v string) *BatchParameters {
+	s.JobDefinition = &v
+	return s
+}
+
+// SetJobName sets the JobName field's value.
+func (s *BatchParameters) SetJobName(v string) *BatchParameters {
+	s.JobName = &v
+	return s
+}
+
+// SetRetryStrategy sets the RetryStrategy field's value.
+func (s *BatchParameters) SetRetryStrategy(v *BatchRetryStrategy) *BatchParameters {
+	s.RetryStrategy = v
+	return s
+}
+
+// The retry strategy to use for failed jobs, if the target is an Batch job.
+// If you specify a retry strategy here, it overrides the retry strategy defined
+// in the job definition.
+type BatchRetryStrategy struct {
+	_ struct{} `type:"structure"`
+
+	// The number of times to attempt to retry, if the job fails. Valid values are
+	// 1–10.
+	Attempts *int64 `type:"integer"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchRetryStrategy) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchRetryStrategy) GoString() string {
+	return s.String()
+}
+
+// SetAttempts sets the Attempts field's value.
+func (s *BatchRetryStrategy) SetAttempts(v int64) *BatchRetryStrategy {
+	s.Attempts = &v
+	return s
+}
+
+type CancelReplayInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the replay to cancel.
+	//
+	// ReplayName is a required field
+	ReplayName *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CancelReplayInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CancelReplayInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CancelReplayInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CancelReplayInput"}
+	if s.ReplayName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ReplayName"))
+	}
+	if s.ReplayName != nil && len(*s.ReplayName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ReplayName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetReplayName sets the ReplayName field's value.
+func (s *CancelReplayInput) SetReplayName(v string) *CancelReplayInput {
+	s.ReplayName = &v
+	return s
+}
+
+type CancelReplayOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the replay to cancel.
+	ReplayArn *string `min:"1" type:"string"`
+
+	// The current state of the replay.
+	State *string `type:"string" enum:"ReplayState"`
+
+	// The reason that the replay is in the current state.
+	StateReason *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CancelReplayOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CancelReplayOutput) GoString() string {
+	return s.String()
+}
+
+// SetReplayArn sets the ReplayArn field's value.
+func (s *CancelReplayOutput) SetReplayArn(v string) *CancelReplayOutput {
+	s.ReplayArn = &v
+	return s
+}
+
+// SetState sets the State field's value.
+func (s *CancelReplayOutput) SetState(v string) *CancelReplayOutput {
+	s.State = &v
+	return s
+}
+
+// SetStateReason sets the StateReason field's value.
+func (s *CancelReplayOutput) SetStateReason(v string) *CancelReplayOutput {
+	s.StateReason = &v
+	return s
+}
+
+// The details of a capacity provider strategy. To learn more, see CapacityProviderStrategyItem
+// (https://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_CapacityProviderStrategyItem.html)
+// in the Amazon ECS API Reference.
+type CapacityProviderStrategyItem struct {
+	_ struct{} `type:"structure"`
+
+	// The base value designates how many tasks, at a minimum, to run on the specified
+	// capacity provider. Only one capacity provider in a capacity provider strategy
+	// can have a base defined. If no value is specified, the default value of 0
+	// is used.
+	Base *int64 `locationName:"base" type:"integer"`
+
+	// The short name of the capacity provider.
+	//
+	// CapacityProvider is a required field
+	CapacityProvider *string `locationName:"capacityProvider" min:"1" type:"string" required:"true"`
+
+	// The weight value designates the relative percentage of the total number of
+	// tasks launched that should use the specified capacity provider. The weight
+	// value is taken into consideration after the base value, if defined, is satisfied.
+	Weight *int64 `locationName:"weight" type:"integer"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CapacityProviderStrategyItem) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CapacityProviderStrategyItem) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CapacityProviderStrategyItem) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CapacityProviderStrategyItem"}
+	if s.CapacityProvider == nil {
+		invalidParams.Add(request.NewErrParamRequired("CapacityProvider"))
+	}
+	if s.CapacityProvider != nil && len(*s.CapacityProvider) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CapacityProvider", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetBase sets the Base field's value.
+func (s *CapacityProviderStrategyItem) SetBase(v int64) *CapacityProviderStrategyItem {
+	s.Base = &v
+	return s
+}
+
+// SetCapacityProvider sets the CapacityProvider field's value.
+func (s *CapacityProviderStrategyItem) SetCapacityProvider(v string) *CapacityProviderStrategyItem {
+	s.CapacityProvider = &v
+	return s
+}
+
+// SetWeight sets the Weight field's value.
+func (s *CapacityProviderStrategyItem) SetWeight(v int64) *CapacityProviderStrategyItem {
+	s.Weight = &v
+	return s
+}
+
+// There is concurrent modification on a rule, target, archive, or replay.
+type ConcurrentModificationException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConcurrentModificationException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConcurrentModificationException) GoString() string {
+	return s.String()
+}
+
+func newErrorConcurrentModificationException(v protocol.ResponseMetadata) error {
+	return &ConcurrentModificationException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ConcurrentModificationException) Code() string {
+	return "ConcurrentModificationException"
+}
+
+// Message returns the exception's message.
+func (s *ConcurrentModificationException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ConcurrentModificationException) OrigErr() error {
+	return nil
+}
+
+func (s *ConcurrentModificationException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ConcurrentModificationException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ConcurrentModificationException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// A JSON string which you can use to limit the event bus permissions you are
+// granting to only accounts that fulfill the condition. Currently, the only
+// supported condition is membership in a certain Amazon Web Services organization.
+// The string must contain Type, Key, and Value fields. The Value field specifies
+// the ID of the Amazon Web Services organization. Following is an example value
+// for Condition:
+//
+// '{"Type" : "StringEquals", "Key": "aws:PrincipalOrgID", "Value": "o-1234567890"}'
+type Condition struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies the key for the condition. Currently the only supported key is
+	// aws:PrincipalOrgID.
+	//
+	// Key is a required field
+	Key *string `type:"string" required:"true"`
+
+	// Specifies the type of condition. Currently the only supported value is StringEquals.
+	//
+	// Type is a required field
+	Type *string `type:"string" required:"true"`
+
+	// Specifies the value for the key. Currently, this must be the ID of the organization.
+	//
+	// Value is a required field
+	Value *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Condition) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Condition) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *Condition) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "Condition"}
+	if s.Key == nil {
+		invalidParams.Add(request.NewErrParamRequired("Key"))
+	}
+	if s.Type == nil {
+		invalidParams.Add(request.NewErrParamRequired("Type"))
+	}
+	if s.Value == nil {
+		invalidParams.Add(request.NewErrParamRequired("Value"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetKey sets the Key field's value.
+func (s *Condition) SetKey(v string) *Condition {
+	s.Key = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *Condition) SetType(v string) *Condition {
+	s.Type = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *Condition) SetValue(v string) *Condition {
+	s.Value = &v
+	return s
+}
+
+// Contains information about a connection.
+type Connection struct {
+	_ struct{} `type:"structure"`
+
+	// The authorization type specified for the connection.
+	AuthorizationType *string `type:"string" enum:"ConnectionAuthorizationType"`
+
+	// The ARN of the connection.
+	ConnectionArn *string `min:"1" type:"string"`
+
+	// The state of the connection.
+	ConnectionState *string `type:"string" enum:"ConnectionState"`
+
+	// A time stamp for the time that the connection was created.
+	CreationTime *time.Time `type:"timestamp"`
+
+	// A time stamp for the time that the connection was last authorized.
+	LastAuthorizedTime *time.Time `type:"timestamp"`
+
+	// A time stamp for the time that the connection was last modified.
+	LastModifiedTime *time.Time `type:"timestamp"`
+
+	// The name of the connection.
+	Name *string `min:"1" type:"string"`
+
+	// The reason that the connection is in the connection state.
+	StateReason *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Connection) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Connection) GoString() string {
+	return s.String()
+}
+
+// SetAuthorizationType sets the AuthorizationType field's value.
+func (s *Connection) SetAuthorizationType(v string) *Connection {
+	s.AuthorizationType = &v
+	return s
+}
+
+// SetConnectionArn sets the ConnectionArn field's value.
+func (s *Connection) SetConnectionArn(v string) *Connection {
+	s.ConnectionArn = &v
+	return s
+}
+
+// SetConnectionState sets the ConnectionState field's value.
+func (s *Connection) SetConnectionState(v string) *Connection {
+	s.ConnectionState = &v
+	return s
+}
+
+// SetCreationTime sets the CreationTime field's value.
+func (s *Connection) SetCreationTime(v time.Time) *Connection {
+	s.CreationTime = &v
+	return s
+}
+
+// SetLastAuthorizedTime sets the LastAuthorizedTime field's value.
+func (s *Connection) SetLastAuthorizedTime(v time.Time) *Connection {
+	s.LastAuthorizedTime = &v
+	return s
+}
+
+// SetLastModifiedTime sets the LastModifiedTime field's value.
+func (s *Connection) SetLastModifiedTime(v time.Time) *Connection {
+	s.LastModifiedTime = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *Connection) SetName(v string) *Connection {
+	s.Name = &v
+	return s
+}
+
+// SetStateReason sets the StateReason field's value.
+func (s *Connection) SetStateReason(v string) *Connection {
+	s.StateReason = &v
+	return s
+}
+
+// Contains the authorization parameters for the connection if API Key is specified
+// as the authorization type.
+type ConnectionApiKeyAuthResponseParameters struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the header to use for the APIKeyValue used for authorization.
+	ApiKeyName *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConnectionApiKeyAuthResponseParameters) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConnectionApiKeyAuthResponseParameters) GoString() string {
+	return s.String()
+}
+
+// SetApiKeyName sets the ApiKeyName field's value.
+func (s *ConnectionApiKeyAuthResponseParameters) SetApiKeyName(v string) *ConnectionApiKeyAuthResponseParameters {
+	s.ApiKeyName = &v
+	return s
+}
+
+// Contains the authorization parameters to use for the connection.
+type ConnectionAuthResponseParameters struct {
+	_ struct{} `type:"structure"`
+
+	// The API Key parameters to use for authorization.
+	ApiKeyAuthParameters *ConnectionApiKeyAuthResponseParameters `type:"structure"`
+
+	// The authorization parameters for Basic authorization.
+	BasicAuthParameters *ConnectionBasicAuthResponseParameters `type:"structure"`
+
+	// Additional parameters for the connection that are passed through with every
+	// invocation to the HTTP endpoint.
+	InvocationHttpParameters *ConnectionHttpParameters `type:"structure"`
+
+	// The OAuth parameters to use for authorization.
+	OAuthParameters *ConnectionOAuthResponseParameters `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConnectionAuthResponseParameters) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConnectionAuthResponseParameters) GoString() string {
+	return s.String()
+}
+
+// SetApiKeyAuthParameters sets the ApiKeyAuthParameters field's value.
+func (s *ConnectionAuthResponseParameters) SetApiKeyAuthParameters(v *ConnectionApiKeyAuthResponseParameters) *ConnectionAuthResponseParameters {
+	s.ApiKeyAuthParameters = v
+	return s
+}
+
+// SetBasicAuthParameters sets the BasicAuthParameters field's value.
+func (s *ConnectionAuthResponseParameters) SetBasicAuthParameters(v *ConnectionBasicAuthResponseParameters) *ConnectionAuthResponseParameters {
+	s.BasicAuthParameters = v
+	return s
+}
+
+// SetInvocationHttpParameters sets the InvocationHttpParameters field's value.
+func (s *ConnectionAuthResponseParameters) SetInvocationHttpParameters(v *ConnectionHttpParameters) *ConnectionAuthResponseParameters {
+	s.InvocationHttpParameters = v
+	return s
+}
+
+// SetOAuthParameters sets the OAuthParameters field's value.
+func (s *ConnectionAuthResponseParameters) SetOAuthParameters(v *ConnectionOAuthResponseParameters) *ConnectionAuthResponseParameters {
+	s.OAuthParameters = v
+	return s
+}
+
+// Contains the authorization parameters for the connection if Basic is specified
+// as the authorization type.
+type ConnectionBasicAuthResponseParameters struct {
+	_ struct{} `type:"structure"`
+
+	// The user name to use for Basic authorization.
+	Username *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConnectionBasicAuthResponseParameters) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConnectionBasicAuthResponseParameters) GoString() string {
+	return s.String()
+}
+
+// SetUsername sets the Username field's value.
+func (s *ConnectionBasicAuthResponseParameters) SetUsername(v string) *ConnectionBasicAuthResponseParameters {
+	s.Username = &v
+	return s
+}
+
+// Additional parameter included in the body. You can include up to 100 additional
+// body parameters per request. An event payload cannot exceed 64 KB.
+type ConnectionBodyParameter struct {
+	_ struct{} `type:"structure"`
+
+	// Specified whether the value is secret.
+	IsValueSecret *bool `type:"boolean"`
+
+	// The key for the parameter.
+	Key *string `type:"string"`
+
+	// The value associated with the key.
+	//
+	// Value is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by ConnectionBodyParameter's
+	// String and GoString methods.
+	Value *string `type:"string" sensitive:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConnectionBodyParameter) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConnectionBodyParameter) GoString() string {
+	return s.String()
+}
+
+// SetIsValueSecret sets the IsValueSecret field's value.
+func (s *ConnectionBodyParameter) SetIsValueSecret(v bool) *ConnectionBodyParameter {
+	s.IsValueSecret = &v
+	return s
+}
+
+// SetKey sets the Key field's value.
+func (s *ConnectionBodyParameter) SetKey(v string) *ConnectionBodyParameter {
+	s.Key = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *ConnectionBodyParameter) SetValue(v string) *ConnectionBodyParameter {
+	s.Value = &v
+	return s
+}
+
+// Additional parameter included in the header. You can include up to 100 additional
+// header parameters per request. An event payload cannot exceed 64 KB.
+type ConnectionHeaderParameter struct {
+	_ struct{} `type:"structure"`
+
+	// Specified whether the value is a secret.
+	IsValueSecret *bool `type:"boolean"`
+
+	// The key for the parameter.
+	Key *string `type:"string"`
+
+	// The value associated with the key.
+	//
+	// Value is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by ConnectionHeaderParameter's
+	// String and GoString methods.
+	Value *string `type:"string" sensitive:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConnectionHeaderParameter) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConnectionHeaderParameter) GoString() string {
+	return s.String()
+}
+
+// SetIsValueSecret sets the IsValueSecret field's value.
+func (s *ConnectionHeaderParameter) SetIsValueSecret(v bool) *ConnectionHeaderParameter {
+	s.IsValueSecret = &v
+	return s
+}
+
+// SetKey sets the Key field's value.
+func (s *ConnectionHeaderParameter) SetKey(v string) *ConnectionHeaderParameter {
+	s.Key = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *ConnectionHeaderParameter) SetValue(v string) *ConnectionHeaderParameter {
+	s.Value = &v
+	return s
+}
+
+// Contains additional parameters for the connection.
+type ConnectionHttpParameters struct {
+	_ struct{} `type:"structure"`
+
+	// Contains additional body string parameters for the connection.
+	BodyParameters []*ConnectionBodyParameter `type:"list"`
+
+	// Contains additional header parameters for the connection.
+	HeaderParameters []*ConnectionHeaderParameter `type:"list"`
+
+	// Contains additional query string parameters for the connection.
+	QueryStringParameters []*ConnectionQueryStringParameter `type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConnectionHttpParameters) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConnectionHttpParameters) GoString() string {
+	return s.String()
+}
+
+// SetBodyParameters sets the BodyParameters field's value.
+func (s *ConnectionHttpParameters) SetBodyParameters(v []*ConnectionBodyParameter) *ConnectionHttpParameters {
+	s.BodyParameters = v
+	return s
+}
+
+// SetHeaderParameters sets the HeaderParameters field's value.
+func (s *ConnectionHttpParameters) SetHeaderParameters(v []*ConnectionHeaderParameter) *ConnectionHttpParameters {
+	s.HeaderParameters = v
+	return s
+}
+
+// SetQueryStringParameters sets the QueryStringParameters field's value.
+func (s *ConnectionHttpParameters) SetQueryStringParameters(v []*ConnectionQueryStringParameter) *ConnectionHttpParameters {
+	s.QueryStringParameters = v
+	return s
+}
+
+// Contains the client response parameters for the connection when OAuth is
+// specified as the authorization type.
+type ConnectionOAuthClientResponseParameters struct {
+	_ struct{} `type:"structure"`
+
+	// The client ID associated with the response to the connection request.
+	ClientID *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConnectionOAuthClientResponseParameters) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConnectionOAuthClientResponseParameters) GoString() string {
+	return s.String()
+}
+
+// SetClientID sets the ClientID field's value.
+func (s *ConnectionOAuthClientResponseParameters) SetClientID(v string) *ConnectionOAuthClientResponseParameters {
+	s.ClientID = &v
+	return s
+}
+
+// Contains the response parameters when OAuth is specified as the authorization
+// type.
+type ConnectionOAuthResponseParameters struct {
+	_ struct{} `type:"structure"`
+
+	// The URL to the HTTP endpoint that authorized the request.
+	AuthorizationEndpoint *string `min:"1" type:"string"`
+
+	// A ConnectionOAuthClientResponseParameters object that contains details about
+	// the client parameters returned when OAuth is specified as the authorization
+	// type.
+	ClientParameters *ConnectionOAuthClientResponseParameters `type:"structure"`
+
+	// The method used to connect to the HTTP endpoint.
+	HttpMethod *string `type:"string" enum:"ConnectionOAuthHttpMethod"`
+
+	// The additional HTTP parameters used for the OAuth authorization request.
+	OAuthHttpParameters *ConnectionHttpParameters `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConnectionOAuthResponseParameters) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConnectionOAuthResponseParameters) GoString() string {
+	return s.String()
+}
+
+// SetAuthorizationEndpoint sets the AuthorizationEndpoint field's value.
+func (s *ConnectionOAuthResponseParameters) SetAuthorizationEndpoint(v string) *ConnectionOAuthResponseParameters {
+	s.AuthorizationEndpoint = &v
+	return s
+}
+
+// SetClientParameters sets the ClientParameters field's value.
+func (s *ConnectionOAuthResponseParameters) SetClientParameters(v *ConnectionOAuthClientResponseParameters) *ConnectionOAuthResponseParameters {
+	s.ClientParameters = v
+	return s
+}
+
+// SetHttpMethod sets the HttpMethod field's value.
+func (s *ConnectionOAuthResponseParameters) SetHttpMethod(v string) *ConnectionOAuthResponseParameters {
+	s.HttpMethod = &v
+	return s
+}
+
+// SetOAuthHttpParameters sets the OAuthHttpParameters field's value.
+func (s *ConnectionOAuthResponseParameters) SetOAuthHttpParameters(v *ConnectionHttpParameters) *ConnectionOAuthResponseParameters {
+	s.OAuthHttpParameters = v
+	return s
+}
+
+// Additional query string parameter for the connection. You can include up
+// to 100 additional query string parameters per request. Each additional parameter
+// counts towards the event payload size, which cannot exceed 64 KB.
+type ConnectionQueryStringParameter struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies whether the value is secret.
+	IsValueSecret *bool `type:"boolean"`
+
+	// The key for a query string parameter.
+	Key *string `type:"string"`
+
+	// The value associated with the key for the query string parameter.
+	//
+	// Value is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by ConnectionQueryStringParameter's
+	// String and GoString methods.
+	Value *string `type:"string" sensitive:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConnectionQueryStringParameter) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConnectionQueryStringParameter) GoString() string {
+	return s.String()
+}
+
+// SetIsValueSecret sets the IsValueSecret field's value.
+func (s *ConnectionQueryStringParameter) SetIsValueSecret(v bool) *ConnectionQueryStringParameter {
+	s.IsValueSecret = &v
+	return s
+}
+
+// SetKey sets the Key field's value.
+func (s *ConnectionQueryStringParameter) SetKey(v string) *ConnectionQueryStringParameter {
+	s.Key = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *ConnectionQueryStringParameter) SetValue(v string) *ConnectionQueryStringParameter {
+	s.Value = &v
+	return s
+}
+
+type CreateApiDestinationInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the connection to use for the API destination. The destination
+	// endpoint must support the authorization type specified for the connection.
+	//
+	// ConnectionArn is a required field
+	ConnectionArn *string `min:"1" type:"string" required:"true"`
+
+	// A description for the API destination to create.
+	Description *string `type:"string"`
+
+	// The method to use for the request to the HTTP invocation endpoint.
+	//
+	// HttpMethod is a required field
+	HttpMethod *string `type:"string" required:"true" enum:"ApiDestinationHttpMethod"`
+
+	// The URL to the HTTP invocation endpoint for the API destination.
+	//
+	// InvocationEndpoint is a required field
+	InvocationEndpoint *string `min:"1" type:"string" required:"true"`
+
+	// The maximum number of requests per second to send to the HTTP invocation
+	// endpoint.
+	InvocationRateLimitPerSecond *int64 `min:"1" type:"integer"`
+
+	// The name for the API destination to create.
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateApiDestinationInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateApiDestinationInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateApiDestinationInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateApiDestinationInput"}
+	if s.ConnectionArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionArn"))
+	}
+	if s.ConnectionArn != nil && len(*s.ConnectionArn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ConnectionArn", 1))
+	}
+	if s.HttpMethod == nil {
+		invalidParams.Add(request.NewErrParamRequired("HttpMethod"))
+	}
+	if s.InvocationEndpoint == nil {
+		invalidParams.Add(request.NewErrParamRequired("InvocationEndpoint"))
+	}
+	if s.InvocationEndpoint != nil && len(*s.InvocationEndpoint) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("InvocationEndpoint", 1))
+	}
+	if s.InvocationRateLimitPerSecond != nil && *s.InvocationRateLimitPerSecond < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("InvocationRateLimitPerSecond", 1))
+	}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetConnectionArn sets the ConnectionArn field's value.
+func (s *CreateApiDestinationInput) SetConnectionArn(v string) *CreateApiDestinationInput {
+	s.ConnectionArn = &v
+	return s
+}
+
+// SetDescription sets the Description field's value.
+func (s *CreateApiDestinationInput) SetDescription(v string) *CreateApiDestinationInput {
+	s.Description = &v
+	return s
+}
+
+// SetHttpMethod sets the HttpMethod field's value.
+func (s *CreateApiDestinationInput) SetHttpMethod(v string) *CreateApiDestinationInput {
+	s.HttpMethod = &v
+	return s
+}
+
+// SetInvocationEndpoint sets the InvocationEndpoint field's value.
+func (s *CreateApiDestinationInput) SetInvocationEndpoint(v string) *CreateApiDestinationInput {
+	s.InvocationEndpoint = &v
+	return s
+}
+
+// SetInvocationRateLimitPerSecond sets the InvocationRateLimitPerSecond field's value.
+func (s *CreateApiDestinationInput) SetInvocationRateLimitPerSecond(v int64) *CreateApiDestinationInput {
+	s.InvocationRateLimitPerSecond = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *CreateApiDestinationInput) SetName(v string) *CreateApiDestinationInput {
+	s.Name = &v
+	return s
+}
+
+type CreateApiDestinationOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the API destination that was created by the request.
+	ApiDestinationArn *string `min:"1" type:"string"`
+
+	// The state of the API destination that was created by the request.
+	ApiDestinationState *string `type:"string" enum:"ApiDestinationState"`
+
+	// A time stamp indicating the time that the API destination was created.
+	CreationTime *time.Time `type:"timestamp"`
+
+	// A time stamp indicating the time that the API destination was last modified.
+	LastModifiedTime *time.Time `type:"timestamp"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateApiDestinationOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateApiDestinationOutput) GoString() string {
+	return s.String()
+}
+
+// SetApiDestinationArn sets the ApiDestinationArn field's value.
+func (s *CreateApiDestinationOutput) SetApiDestinationArn(v string) *CreateApiDestinationOutput {
+	s.ApiDestinationArn = &v
+	return s
+}
+
+// SetApiDestinationState sets the ApiDestinationState field's value.
+func (s *CreateApiDestinationOutput) SetApiDestinationState(v string) *CreateApiDestinationOutput {
+	s.ApiDestinationState = &v
+	return s
+}
+
+// SetCreationTime sets the CreationTime field's value.
+func (s *CreateApiDestinationOutput) SetCreationTime(v time.Time) *CreateApiDestinationOutput {
+	s.CreationTime = &v
+	return s
+}
+
+// SetLastModifiedTime sets the LastModifiedTime field's value.
+func (s *CreateApiDestinationOutput) SetLastModifiedTime(v time.Time) *CreateApiDestinationOutput {
+	s.LastModifiedTime = &v
+	return s
+}
+
+type CreateArchiveInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name for the archive to create.
+	//
+	// ArchiveName is a required field
+	ArchiveName *string `min:"1" type:"string" required:"true"`
+
+	// A description for the archive.
+	Description *string `type:"string"`
+
+	// An event pattern to use to filter events sent to the archive.
+	EventPattern *string `type:"string"`
+
+	// The ARN of the event bus that sends events to the archive.
+	//
+	// EventSourceArn is a required field
+	EventSourceArn *string `min:"1" type:"string" required:"true"`
+
+	// The number of days to retain events for. Default value is 0. If set to 0,
+	// events are retained indefinitely
+	RetentionDays *int64 `type:"integer"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateArchiveInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateArchiveInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateArchiveInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateArchiveInput"}
+	if s.ArchiveName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ArchiveName"))
+	}
+	if s.ArchiveName != nil && len(*s.ArchiveName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ArchiveName", 1))
+	}
+	if s.EventSourceArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("EventSourceArn"))
+	}
+	if s.EventSourceArn != nil && len(*s.EventSourceArn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EventSourceArn", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetArchiveName sets the ArchiveName field's value.
+func (s *CreateArchiveInput) SetArchiveName(v string) *CreateArchiveInput {
+	s.ArchiveName = &v
+	return s
+}
+
+// SetDescription sets the Description field's value.
+func (s *CreateArchiveInput) SetDescription(v string) *CreateArchiveInput {
+	s.Description = &v
+	return s
+}
+
+// SetEventPattern sets the EventPattern field's value.
+func (s *CreateArchiveInput) SetEventPattern(v string) *CreateArchiveInput {
+	s.EventPattern = &v
+	return s
+}
+
+// SetEventSourceArn sets the EventSourceArn field's value.
+func (s *CreateArchiveInput) SetEventSourceArn(v string) *CreateArchiveInput {
+	s.EventSourceArn = &v
+	return s
+}
+
+// SetRetentionDays sets the RetentionDays field's value.
+func (s *CreateArchiveInput) SetRetentionDays(v int64) *CreateArchiveInput {
+	s.RetentionDays = &v
+	return s
+}
+
+type CreateArchiveOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the archive that was created.
+	ArchiveArn *string `min:"1" type:"string"`
+
+	// The time at which the archive was created.
+	CreationTime *time.Time `type:"timestamp"`
+
+	// The state of the archive that was created.
+	State *string `type:"string" enum:"ArchiveState"`
+
+	// The reason that the archive is in the state.
+	StateReason *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateArchiveOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateArchiveOutput) GoString() string {
+	return s.String()
+}
+
+// SetArchiveArn sets the ArchiveArn field's value.
+func (s *CreateArchiveOutput) SetArchiveArn(v string) *CreateArchiveOutput {
+	s.ArchiveArn = &v
+	return s
+}
+
+// SetCreationTime sets the CreationTime field's value.
+func (s *CreateArchiveOutput) SetCreationTime(v time.Time) *CreateArchiveOutput {
+	s.CreationTime = &v
+	return s
+}
+
+// SetState sets the State field's value.
+func (s *CreateArchiveOutput) SetState(v string) *CreateArchiveOutput {
+	s.State = &v
+	return s
+}
+
+// SetStateReason sets the StateReason field's value.
+func (s *CreateArchiveOutput) SetStateReason(v string) *CreateArchiveOutput {
+	s.StateReason = &v
+	return s
+}
+
+// Contains the API key authorization parameters for the connection.
+type CreateConnectionApiKeyAuthRequestParameters struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the API key to use for authorization.
+	//
+	// ApiKeyName is a required field
+	ApiKeyName *string `min:"1" type:"string" required:"true"`
+
+	// The value for the API key to use for authorization.
+	//
+	// ApiKeyValue is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by CreateConnectionApiKeyAuthRequestParameters's
+	// String and GoString methods.
+	//
+	// ApiKeyValue is a required field
+	ApiKeyValue *string `min:"1" type:"string" required:"true" sensitive:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateConnectionApiKeyAuthRequestParameters) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateConnectionApiKeyAuthRequestParameters) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateConnectionApiKeyAuthRequestParameters) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateConnectionApiKeyAuthRequestParameters"}
+	if s.ApiKeyName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ApiKeyName"))
+	}
+	if s.ApiKeyName != nil && len(*s.ApiKeyName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ApiKeyName", 1))
+	}
+	if s.ApiKeyValue == nil {
+		invalidParams.Add(request.NewErrParamRequired("ApiKeyValue"))
+	}
+	if s.ApiKeyValue != nil && len(*s.ApiKeyValue) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ApiKeyValue", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetApiKeyName sets the ApiKeyName field's value.
+func (s *CreateConnectionApiKeyAuthRequestParameters) SetApiKeyName(v string) *CreateConnectionApiKeyAuthRequestParameters {
+	s.ApiKeyName = &v
+	return s
+}
+
+// SetApiKeyValue sets the ApiKeyValue field's value.
+func (s *CreateConnectionApiKeyAuthRequestParameters) SetApiKeyValue(v string) *CreateConnectionApiKeyAuthRequestParameters {
+	s.ApiKeyValue = &v
+	return s
+}
+
+// Contains the authorization parameters for the connection.
+type CreateConnectionAuthRequestParameters struct {
+	_ struct{} `type:"structure"`
+
+	// A CreateConnectionApiKeyAuthRequestParameters object that contains the API
+	// key authorization parameters to use for the connection.
+	ApiKeyAuthParameters *CreateConnectionApiKeyAuthRequestParameters `type:"structure"`
+
+	// A CreateConnectionBasicAuthRequestParameters object that contains the Basic
+	// authorization parameters to use for the connection.
+	BasicAuthParameters *CreateConnectionBasicAuthRequestParameters `type:"structure"`
+
+	// A ConnectionHttpParameters object that contains the API key authorization
+	// parameters to use for the connection. Note that if you include additional
+	// parameters for the target of a rule via HttpParameters, including query strings,
+	// the parameters added for the connection take precedence.
+	InvocationHttpParameters *ConnectionHttpParameters `type:"structure"`
+
+	// A CreateConnectionOAuthRequestParameters object that contains the OAuth authorization
+	// parameters to use for the connection.
+	OAuthParameters *CreateConnectionOAuthRequestParameters `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateConnectionAuthRequestParameters) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateConnectionAuthRequestParameters) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateConnectionAuthRequestParameters) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateConnectionAuthRequestParameters"}
+	if s.ApiKeyAuthParameters != nil {
+		if err := s.ApiKeyAuthParameters.Validate(); err != nil {
+			invalidParams.AddNested("ApiKeyAuthParameters", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.BasicAuthParameters != nil {
+		if err := s.BasicAuthParameters.Validate(); err != nil {
+			invalidParams.AddNested("BasicAuthParameters", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.OAuthParameters != nil {
+		if err := s.OAuthParameters.Validate(); err != nil {
+			invalidParams.AddNested("OAuthParameters", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetApiKeyAuthParameters sets the ApiKeyAuthParameters field's value.
+func (s *CreateConnectionAuthRequestParameters) SetApiKeyAuthParameters(v *CreateConnectionApiKeyAuthRequestParameters) *CreateConnectionAuthRequestParameters {
+	s.ApiKeyAuthParameters = v
+	return s
+}
+
+// SetBasicAuthParameters sets the BasicAuthParameters field's value.
+func (s *CreateConnectionAuthRequestParameters) SetBasicAuthParameters(v *CreateConnectionBasicAuthRequestParameters) *CreateConnectionAuthRequestParameters {
+	s.BasicAuthParameters = v
+	return s
+}
+
+// SetInvocationHttpParameters sets the InvocationHttpParameters field's value.
+func (s *CreateConnectionAuthRequestParameters) SetInvocationHttpParameters(v *ConnectionHttpParameters) *CreateConnectionAuthRequestParameters {
+	s.InvocationHttpParameters = v
+	return s
+}
+
+// SetOAuthParameters sets the OAuthParameters field's value.
+func (s *CreateConnectionAuthRequestParameters) SetOAuthParameters(v *CreateConnectionOAuthRequestParameters) *CreateConnectionAuthRequestParameters {
+	s.OAuthParameters = v
+	return s
+}
+
+// Contains the Basic authorization parameters to use for the connection.
+type CreateConnectionBasicAuthRequestParameters struct {
+	_ struct{} `type:"structure"`
+
+	// The password associated with the user name to use for Basic authorization.
+	//
+	// Password is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by CreateConnectionBasicAuthRequestParameters's
+	// String and GoString methods.
+	//
+	// Password is a required field
+	Password *string `min:"1" type:"string" required:"true" sensitive:"true"`
+
+	// The user name to use for Basic authorization.
+	//
+	// Username is a required field
+	Username *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateConnectionBasicAuthRequestParameters) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateConnectionBasicAuthRequestParameters) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateConnectionBasicAuthRequestParameters) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateConnectionBasicAuthRequestParameters"}
+	if s.Password == nil {
+		invalidParams.Add(request.NewErrParamRequired("Password"))
+	}
+	if s.Password != nil && len(*s.Password) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Password", 1))
+	}
+	if s.Username == nil {
+		invalidParams.Add(request.NewErrParamRequired("Username"))
+	}
+	if s.Username != nil && len(*s.Username) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Username", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetPassword sets the Password field's value.
+func (s *CreateConnectionBasicAuthRequestParameters) SetPassword(v string) *CreateConnectionBasicAuthRequestParameters {
+	s.Password = &v
+	return s
+}
+
+// SetUsername sets the Username field's value.
+func (s *CreateConnectionBasicAuthRequestParameters) SetUsername(v string) *CreateConnectionBasicAuthRequestParameters {
+	s.Username = &v
+	return s
+}
+
+type CreateConnectionInput struct {
+	_ struct{} `type:"structure"`
+
+	// A CreateConnectionAuthRequestParameters object that contains the authorization
+	// parameters to use to authorize with the endpoint.
+	//
+	// AuthParameters is a required field
+	AuthParameters *CreateConnectionAuthRequestParameters `type:"structure" required:"true"`
+
+	// The type of authorization to use for the connection.
+	//
+	// AuthorizationType is a required field
+	AuthorizationType *string `type:"string" required:"true" enum:"ConnectionAuthorizationType"`
+
+	// A description for the connection to create.
+	Description *string `type:"string"`
+
+	// The name for the connection to create.
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateConnectionInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateConnectionInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateConnectionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateConnectionInput"}
+	if s.AuthParameters == nil {
+		invalidParams.Add(request.NewErrParamRequired("AuthParameters"))
+	}
+	if s.AuthorizationType == nil {
+		invalidParams.Add(request.NewErrParamRequired("AuthorizationType"))
+	}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+	if s.AuthParameters != nil {
+		if err := s.AuthParameters.Validate(); err != nil {
+			invalidParams.AddNested("AuthParameters", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAuthParameters sets the AuthParameters field's value.
+func (s *CreateConnectionInput) SetAuthParameters(v *CreateConnectionAuthRequestParameters) *CreateConnectionInput {
+	s.AuthParameters = v
+	return s
+}
+
+// SetAuthorizationType sets the AuthorizationType field's value.
+func (s *CreateConnectionInput) SetAuthorizationType(v string) *CreateConnectionInput {
+	s.AuthorizationType = &v
+	return s
+}
+
+// SetDescription sets the Description field's value.
+func (s *CreateConnectionInput) SetDescription(v string) *CreateConnectionInput {
+	s.Description = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *CreateConnectionInput) SetName(v string) *CreateConnectionInput {
+	s.Name = &v
+	return s
+}
+
+// Contains the Basic authorization parameters to use for the connection.
+type CreateConnectionOAuthClientRequestParameters struct {
+	_ struct{} `type:"structure"`
+
+	// The client ID to use for OAuth authorization for the connection.
+	//
+	// ClientID is a required field
+	ClientID *string `min:"1" type:"string" required:"true"`
+
+	// The client secret associated with the client ID to use for OAuth authorization
+	// for the connection.
+	//
+	// ClientSecret is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by CreateConnectionOAuthClientRequestParameters's
+	// String and GoString methods.
+	//
+	// ClientSecret is a required field
+	ClientSecret *string `min:"1" type:"string" required:"true" sensitive:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateConnectionOAuthClientRequestParameters) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateConnectionOAuthClientRequestParameters) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateConnectionOAuthClientRequestParameters) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateConnectionOAuthClientRequestParameters"}
+	if s.ClientID == nil {
+		invalidParams.Add(request.NewErrParamRequired("ClientID"))
+	}
+	if s.ClientID != nil && len(*s.ClientID) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ClientID", 1))
+	}
+	if s.ClientSecret == nil {
+		invalidParams.Add(request.NewErrParamRequired("ClientSecret"))
+	}
+	if s.ClientSecret != nil && len(*s.ClientSecret) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ClientSecret", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetClientID sets the ClientID field's value.
+func (s *CreateConnectionOAuthClientRequestParameters) SetClientID(v string) *CreateConnectionOAuthClientRequestParameters {
+	s.ClientID = &v
+	return s
+}
+
+// SetClientSecret sets the ClientSecret field's value.
+func (s *CreateConnectionOAuthClientRequestParameters) SetClientSecret(v string) *CreateConnectionOAuthClientRequestParameters {
+	s.ClientSecret = &v
+	return s
+}
+
+// Contains the OAuth authorization parameters to use for the connection.
+type CreateConnectionOAuthRequestParameters struct {
+	_ struct{} `type:"structure"`
+
+	// The URL to the authorization endpoint when OAuth is specified as the authorization
+	// type.
+	//
+	// AuthorizationEndpoint is a required field
+	AuthorizationEndpoint *string `min:"1" type:"string" required:"true"`
+
+	// A CreateConnectionOAuthClientRequestParameters object that contains the client
+	// parameters for OAuth authorization.
+	//
+	// ClientParameters is a required field
+	ClientParameters *CreateConnectionOAuthClientRequestParameters `type:"structure" required:"true"`
+
+	// The method to use for the authorization request.
+	//
+	// HttpMethod is a required field
+	HttpMethod *string `type:"string" required:"true" enum:"ConnectionOAuthHttpMethod"`
+
+	// A ConnectionHttpParameters object that contains details about the additional
+	// parameters to use for the connection.
+	OAuthHttpParameters *ConnectionHttpParameters `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateConnectionOAuthRequestParameters) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateConnectionOAuthRequestParameters) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateConnectionOAuthRequestParameters) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateConnectionOAuthRequestParameters"}
+	if s.AuthorizationEndpoint == nil {
+		invalidParams.Add(request.NewErrParamRequired("AuthorizationEndpoint"))
+	}
+	if s.AuthorizationEndpoint != nil && len(*s.AuthorizationEndpoint) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("AuthorizationEndpoint", 1))
+	}
+	if s.ClientParameters == nil {
+		invalidParams.Add(request.NewErrParamRequired("ClientParameters"))
+	}
+	if s.HttpMethod == nil {
+		invalidParams.Add(request.NewErrParamRequired("HttpMethod"))
+	}
+	if s.ClientParameters != nil {
+		if err := s.ClientParameters.Validate(); err != nil {
+			invalidParams.AddNested("ClientParameters", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAuthorizationEndpoint sets the AuthorizationEndpoint field's value.
+func (s *CreateConnectionOAuthRequestParameters) SetAuthorizationEndpoint(v string) *CreateConnectionOAuthRequestParameters {
+	s.AuthorizationEndpoint = &v
+	return s
+}
+
+// SetClientParameters sets the ClientParameters field's value.
+func (s *CreateConnectionOAuthRequestParameters) SetClientParameters(v *CreateConnectionOAuthClientRequestParameters) *CreateConnectionOAuthRequestParameters {
+	s.ClientParameters = v
+	return s
+}
+
+// SetHttpMethod sets the HttpMethod field's value.
+func (s *CreateConnectionOAuthRequestParameters) SetHttpMethod(v string) *CreateConnectionOAuthRequestParameters {
+	s.HttpMethod = &v
+	return s
+}
+
+// SetOAuthHttpParameters sets the OAuthHttpParameters field's value.
+func (s *CreateConnectionOAuthRequestParameters) SetOAuthHttpParameters(v *ConnectionHttpParameters) *CreateConnectionOAuthRequestParameters {
+	s.OAuthHttpParameters = v
+	return s
+}
+
+type CreateConnectionOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the connection that was created by the request.
+	ConnectionArn *string `min:"1" type:"string"`
+
+	// The state of the connection that was created by the request.
+	ConnectionState *string `type:"string" enum:"ConnectionState"`
+
+	// A time stamp for the time that the connection was created.
+	CreationTime *time.Time `type:"timestamp"`
+
+	// A time stamp for the time that the connection was last updated.
+	LastModifiedTime *time.Time `type:"timestamp"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateConnectionOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateConnectionOutput) GoString() string {
+	return s.String()
+}
+
+// SetConnectionArn sets the ConnectionArn field's value.
+func (s *CreateConnectionOutput) SetConnectionArn(v string) *CreateConnectionOutput {
+	s.ConnectionArn = &v
+	return s
+}
+
+// SetConnectionState sets the ConnectionState field's value.
+func (s *CreateConnectionOutput) SetConnectionState(v string) *CreateConnectionOutput {
+	s.ConnectionState = &v
+	return s
+}
+
+// SetCreationTime sets the CreationTime field's value.
+func (s *CreateConnectionOutput) SetCreationTime(v time.Time) *CreateConnectionOutput {
+	s.CreationTime = &v
+	return s
+}
+
+// SetLastModifiedTime sets the LastModifiedTime field's value.
+func (s *CreateConnectionOutput) SetLastModifiedTime(v time.Time) *CreateConnectionOutput {
+	s.LastModifiedTime = &v
+	return s
+}
+
+type CreateEventBusInput struct {
+	_ struct{} `type:"structure"`
+
+	// If you are creating a partner event bus, this specifies the partner event
+	// source that the new event bus will be matched with.
+	EventSourceName *string `min:"1" type:"string"`
+
+	// The name of the new event bus.
+	//
+	// Event bus names cannot contain the / character. You can't use the name default
+	// for a custom event bus, as this name is already used for your account's default
+	// event bus.
+	//
+	// If this is a partner event bus, the name must exactly match the name of the
+	// partner event source that this event bus is matched to.
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
+
+	// Tags to associate with the event bus.
+	Tags []*Tag `type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateEventBusInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateEventBusInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateEventBusInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateEventBusInput"}
+	if s.EventSourceName != nil && len(*s.EventSourceName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EventSourceName", 1))
+	}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetEventSourceName sets the EventSourceName field's value.
+func (s *CreateEventBusInput) SetEventSourceName(v string) *CreateEventBusInput {
+	s.EventSourceName = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *CreateEventBusInput) SetName(v string) *CreateEventBusInput {
+	s.Name = &v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *CreateEventBusInput) SetTags(v []*Tag) *CreateEventBusInput {
+	s.Tags = v
+	return s
+}
+
+type CreateEventBusOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the new event bus.
+	EventBusArn *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateEventBusOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateEventBusOutput) GoString() string {
+	return s.String()
+}
+
+// SetEventBusArn sets the EventBusArn field's value.
+func (s *CreateEventBusOutput) SetEventBusArn(v string) *CreateEventBusOutput {
+	s.EventBusArn = &v
+	return s
+}
+
+type CreatePartnerEventSourceInput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Web Services account ID that is permitted to create a matching
+	// partner event bus for this partner event source.
+	//
+	// Account is a required field
+	Account *string `min:"12" type:"string" required:"true"`
+
+	// The name of the partner event source. This name must be unique and must be
+	// in the format partner_name/event_namespace/event_name . The Amazon Web Services
+	// account that wants to use this partner event source must create a partner
+	// event bus with a name that matches the name of the partner event source.
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreatePartnerEventSourceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreatePartnerEventSourceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreatePartnerEventSourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatePartnerEventSourceInput"}
+	if s.Account == nil {
+		invalidParams.Add(request.NewErrParamRequired("Account"))
+	}
+	if s.Account != nil && len(*s.Account) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("Account", 12))
+	}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAccount sets the Account field's value.
+func (s *CreatePartnerEventSourceInput) SetAccount(v string) *CreatePartnerEventSourceInput {
+	s.Account = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *CreatePartnerEventSourceInput) SetName(v string) *CreatePartnerEventSourceInput {
+	s.Name = &v
+	return s
+}
+
+type CreatePartnerEventSourceOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the partner event source.
+	EventSourceArn *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreatePartnerEventSourceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreatePartnerEventSourceOutput) GoString() string {
+	return s.String()
+}
+
+// SetEventSourceArn sets the EventSourceArn field's value.
+func (s *CreatePartnerEventSourceOutput) SetEventSourceArn(v string) *CreatePartnerEventSourceOutput {
+	s.EventSourceArn = &v
+	return s
+}
+
+type DeactivateEventSourceInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the partner event source to deactivate.
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeactivateEventSourceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeactivateEventSourceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeactivateEventSourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeactivateEventSourceInput"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetName sets the Name field's value.
+func (s *DeactivateEventSourceInput) SetName(v string) *DeactivateEventSourceInput {
+	s.Name = &v
+	return s
+}
+
+type DeactivateEventSourceOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeactivateEventSourceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeactivateEventSourceOutput) GoString() string {
+	return s.String()
+}
+
+// A DeadLetterConfig object that contains information about a dead-letter queue
+// configuration.
+type DeadLetterConfig struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the SQS queue specified as the target for the dead-letter queue.
+	Arn *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeadLetterConfig) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeadLetterConfig) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeadLetterConfig) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeadLetterConfig"}
+	if s.Arn != nil && len(*s.Arn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetArn sets the Arn field's value.
+func (s *DeadLetterConfig) SetArn(v string) *DeadLetterConfig {
+	s.Arn = &v
+	return s
+}
+
+type DeauthorizeConnectionInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the connection to remove authorization from.
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeauthorizeConnectionInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeauthorizeConnectionInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeauthorizeConnectionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeauthorizeConnectionInput"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetName sets the Name field's value.
+func (s *DeauthorizeConnectionInput) SetName(v string) *DeauthorizeConnectionInput {
+	s.Name = &v
+	return s
+}
+
+type DeauthorizeConnectionOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the connection that authorization was removed from.
+	ConnectionArn *string `min:"1" type:"string"`
+
+	// The state of the connection.
+	ConnectionState *string `type:"string" enum:"ConnectionState"`
+
+	// A time stamp for the time that the connection was created.
+	CreationTime *time.Time `type:"timestamp"`
+
+	// A time stamp for the time that the connection was last authorized.
+	LastAuthorizedTime *time.Time `type:"timestamp"`
+
+	// A time stamp for the time that the connection was last updated.
+	LastModifiedTime *time.Time `type:"timestamp"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeauthorizeConnectionOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeauthorizeConnectionOutput) GoString() string {
+	return s.String()
+}
+
+// SetConnectionArn sets the ConnectionArn field's value.
+func (s *DeauthorizeConnectionOutput) SetConnectionArn(v string) *DeauthorizeConnectionOutput {
+	s.ConnectionArn = &v
+	return s
+}
+
+// SetConnectionState sets the ConnectionState field's value.
+func (s *DeauthorizeConnectionOutput) SetConnectionState(v string) *DeauthorizeConnectionOutput {
+	s.ConnectionState = &v
+	return s
+}
+
+// SetCreationTime sets the CreationTime field's value.
+func (s *DeauthorizeConnectionOutput) SetCreationTime(v time.Time) *DeauthorizeConnectionOutput {
+	s.CreationTime = &v
+	return s
+}
+
+// SetLastAuthorizedTime sets the LastAuthorizedTime field's value.
+func (s *DeauthorizeConnectionOutput) SetLastAuthorizedTime(v time.Time) *DeauthorizeConnectionOutput {
+	s.LastAuthorizedTime = &v
+	return s
+}
+
+// SetLastModifiedTime sets the LastModifiedTime field's value.
+func (s *DeauthorizeConnectionOutput) SetLastModifiedTime(v time.Time) *DeauthorizeConnectionOutput {
+	s.LastModifiedTime = &v
+	return s
+}
+
+type DeleteApiDestinationInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the destination to delete.
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteApiDestinationInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteApiDestinationInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteApiDestinationInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteApiDestinationInput"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetName sets the Name field's value.
+func (s *DeleteApiDestinationInput) SetName(v string) *DeleteApiDestinationInput {
+	s.Name = &v
+	return s
+}
+
+type DeleteApiDestinationOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteApiDestinationOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteApiDestinationOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteArchiveInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the archive to delete.
+	//
+	// ArchiveName is a required field
+	ArchiveName *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteArchiveInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteArchiveInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteArchiveInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteArchiveInput"}
+	if s.ArchiveName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ArchiveName"))
+	}
+	if s.ArchiveName != nil && len(*s.ArchiveName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ArchiveName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetArchiveName sets the ArchiveName field's value.
+func (s *DeleteArchiveInput) SetArchiveName(v string) *DeleteArchiveInput {
+	s.ArchiveName = &v
+	return s
+}
+
+type DeleteArchiveOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteArchiveOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteArchiveOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteConnectionInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the connection to delete.
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteConnectionInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteConnectionInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteConnectionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteConnectionInput"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetName sets the Name field's value.
+func (s *DeleteConnectionInput) SetName(v string) *DeleteConnectionInput {
+	s.Name = &v
+	return s
+}
+
+type DeleteConnectionOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the connection that was deleted.
+	ConnectionArn *string `min:"1" type:"string"`
+
+	// The state of the connection before it was deleted.
+	ConnectionState *string `type:"string" enum:"ConnectionState"`
+
+	// A time stamp for the time that the connection was created.
+	CreationTime *time.Time `type:"timestamp"`
+
+	// A time stamp for the time that the connection was last authorized before
+	// it wa deleted.
+	LastAuthorizedTime *time.Time `type:"timestamp"`
+
+	// A time stamp for the time that the connection was last modified before it
+	// was deleted.
+	LastModifiedTime *time.Time `type:"timestamp"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteConnectionOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteConnectionOutput) GoString() string {
+	return s.String()
+}
+
+// SetConnectionArn sets the ConnectionArn field's value.
+func (s *DeleteConnectionOutput) SetConnectionArn(v string) *DeleteConnectionOutput {
+	s.ConnectionArn = &v
+	return s
+}
+
+// SetConnectionState sets the ConnectionState field's value.
+func (s *DeleteConnectionOutput) SetConnectionState(v string) *DeleteConnectionOutput {
+	s.ConnectionState = &v
+	return s
+}
+
+// SetCreationTime sets the CreationTime field's value.
+func (s *DeleteConnectionOutput) SetCreationTime(v time.Time) *DeleteConnectionOutput {
+	s.CreationTime = &v
+	return s
+}
+
+// SetLastAuthorizedTime sets the LastAuthorizedTime field's value.
+func (s *DeleteConnectionOutput) SetLastAuthorizedTime(v time.Time) *DeleteConnectionOutput {
+	s.LastAuthorizedTime = &v
+	return s
+}
+
+// SetLastModifiedTime sets the LastModifiedTime field's value.
+func (s *DeleteConnectionOutput) SetLastModifiedTime(v time.Time) *DeleteConnectionOutput {
+	s.LastModifiedTime = &v
+	return s
+}
+
+type DeleteEventBusInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the event bus to delete.
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteEventBusInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteEventBusInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteEventBusInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteEventBusInput"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetName sets the Name field's value.
+func (s *DeleteEventBusInput) SetName(v string) *DeleteEventBusInput {
+	s.Name = &v
+	return s
+}
+
+type DeleteEventBusOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteEventBusOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteEventBusOutput) GoString() string {
+	return s.String()
+}
+
+type DeletePartnerEventSourceInput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Web Services account ID of the Amazon Web Services customer that
+	// the event source was created for.
+	//
+	// Account is a required field
+	Account *string `min:"12" type:"string" required:"true"`
+
+	// The name of the event source to delete.
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeletePartnerEventSourceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeletePartnerEventSourceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeletePartnerEventSourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeletePartnerEventSourceInput"}
+	if s.Account == nil {
+		invalidParams.Add(request.NewErrParamRequired("Account"))
+	}
+	if s.Account != nil && len(*s.Account) < 12 {
+		invalidParams.Add(request.NewErrParamMinLen("Account", 12))
+	}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAccount sets the Account field's value.
+func (s *DeletePartnerEventSourceInput) SetAccount(v string) *DeletePartnerEventSourceInput {
+	s.Account = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *DeletePartnerEventSourceInput) SetName(v string) *DeletePartnerEventSourceInput {
+	s.Name = &v
+	return s
+}
+
+type DeletePartnerEventSourceOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeletePartnerEventSourceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeletePartnerEventSourceOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteRuleInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name or ARN of the event bus associated with the rule. If you omit this,
+	// the default event bus is used.
+	EventBusName *string `min:"1" type:"string"`
+
+	// If this is a managed rule, created by an Amazon Web Services service on your
+	// behalf, you must specify Force as True to delete the rule. This parameter
+	// is ignored for rules that are not managed rules. You can check whether a
+	// rule is a managed rule by using DescribeRule or ListRules and checking the
+	// ManagedBy field of the response.
+	Force *bool `type:"boolean"`
+
+	// The name of the rule.
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteRuleInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteRuleInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteRuleInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteRuleInput"}
+	if s.EventBusName != nil && len(*s.EventBusName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EventBusName", 1))
+	}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetEventBusName sets the EventBusName field's value.
+func (s *DeleteRuleInput) SetEventBusName(v string) *DeleteRuleInput {
+	s.EventBusName = &v
+	return s
+}
+
+// SetForce sets the Force field's value.
+func (s *DeleteRuleInput) SetForce(v bool) *DeleteRuleInput {
+	s.Force = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *DeleteRuleInput) SetName(v string) *DeleteRuleInput {
+	s.Name = &v
+	return s
+}
+
+type DeleteRuleOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteRuleOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteRuleOutput) GoString() string {
+	return s.String()
+}
+
+type DescribeApiDestinationInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the API destination to retrieve.
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeApiDestinationInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeApiDestinationInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DescribeApiDestinationInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeApiDestinationInput"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetName sets the Name field's value.
+func (s *DescribeApiDestinationInput) SetName(v string) *DescribeApiDestinationInput {
+	s.Name = &v
+	return s
+}
+
+type DescribeApiDestinationOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the API destination retrieved.
+	ApiDestinationArn *string `min:"1" type:"string"`
+
+	// The state of the API destination retrieved.
+	ApiDestinationState *string `type:"string" enum:"ApiDestinationState"`
+
+	// The ARN of the connection specified for the API destination retrieved.
+	ConnectionArn *string `min:"1" type:"string"`
+
+	// A time stamp for the time that the API destination was created.
+	CreationTime *time.Time `type:"timestamp"`
+
+	// The description for the API destination retrieved.
+	Description *string `type:"string"`
+
+	// The method to use to connect to the HTTP endpoint.
+	HttpMethod *string `type:"string" enum:"ApiDestinationHttpMethod"`
+
+	// The URL to use to connect to the HTTP endpoint.
+	InvocationEndpoint *string `min:"1" type:"string"`
+
+	// The maximum number of invocations per second to specified for the API destination.
+	// Note that if you set the invocation rate maximum to a value lower the rate
+	// necessary to send all events received on to the destination HTTP endpoint,
+	// some events may not be delivered within the 24-hour retry window. If you
+	// plan to set the rate lower than the rate necessary to deliver all events,
+	// consider using a dead-letter queue to catch events that are not delivered
+	// within 24 hours.
+	InvocationRateLimitPerSecond *int64 `min:"1" type:"integer"`
+
+	// A time stamp for the time that the API destination was last modified.
+	LastModifiedTime *time.Time `type:"timestamp"`
+
+	// The name of the API destination retrieved.
+	Name *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeApiDestinationOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeApiDestinationOutput) GoString() string {
+	return s.String()
+}
+
+// SetApiDestinationArn sets the ApiDestinationArn field's value.
+func (s *DescribeApiDestinationOutput) SetApiDestinationArn(v string) *DescribeApiDestinationOutput {
+	s.ApiDestinationArn = &v
+	return s
+}
+
+// SetApiDestinationState sets the ApiDestinationState field's value.
+func (s *DescribeApiDestinationOutput) SetApiDestinationState(v string) *DescribeApiDestinationOutput {
+	s.ApiDestinationState = &v
+	return s
+}
+
+// SetConnectionArn sets the ConnectionArn field's value.
+func (s *DescribeApiDestinationOutput) SetConnectionArn(v string) *DescribeApiDestinationOutput {
+	s.ConnectionArn = &v
+	return s
+}
+
+// SetCreationTime sets the CreationTime field's value.
+func (s *DescribeApiDestinationOutput) SetCreationTime(v time.Time) *DescribeApiDestinationOutput {
+	s.CreationTime = &v
+	return s
+}
+
+// SetDescription sets the Description field's value.
+func (s *DescribeApiDestinationOutput) SetDescription(v string) *DescribeApiDestinationOutput {
+	s.Description = &v
+	return s
+}
+
+// SetHttpMethod sets the HttpMethod field's value.
+func (s *DescribeApiDestinationOutput) SetHttpMethod(v string) *DescribeApiDestinationOutput {
+	s.HttpMethod = &v
+	return s
+}
+
+// SetInvocationEndpoint sets the InvocationEndpoint field's value.
+func (s *DescribeApiDestinationOutput) SetInvocationEndpoint(v string) *DescribeApiDestinationOutput {
+	s.InvocationEndpoint = &v
+	return s
+}
+
+// SetInvocationRateLimitPerSecond sets the InvocationRateLimitPerSecond field's value.
+func (s *DescribeApiDestinationOutput) SetInvocationRateLimitPerSecond(v int64) *DescribeApiDestinationOutput {
+	s.InvocationRateLimitPerSecond = &v
+	return s
+}
+
+// SetLastModifiedTime sets the LastModifiedTime field's value.
+func (s *DescribeApiDestinationOutput) SetLastModifiedTime(v time.Time) *DescribeApiDestinationOutput {
+	s.LastModifiedTime = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *DescribeApiDestinationOutput) SetName(v string) *DescribeApiDestinationOutput {
+	s.Name = &v
+	return s
+}
+
+type DescribeArchiveInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the archive to retrieve.
+	//
+	// ArchiveName is a required field
+	ArchiveName *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeArchiveInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeArchiveInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DescribeArchiveInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeArchiveInput"}
+	if s.ArchiveName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ArchiveName"))
+	}
+	if s.ArchiveName != nil && len(*s.ArchiveName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ArchiveName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetArchiveName sets the ArchiveName field's value.
+func (s *DescribeArchiveInput) SetArchiveName(v string) *DescribeArchiveInput {
+	s.ArchiveName = &v
+	return s
+}
+
+type DescribeArchiveOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the archive.
+	ArchiveArn *string `min:"1" type:"string"`
+
+	// The name of the archive.
+	ArchiveName *string `min:"1" type:"string"`
+
+	// The time at which the archive was created.
+	CreationTime *time.Time `type:"timestamp"`
+
+	// The description of the archive.
+	Description *string `type:"string"`
+
+	// The number of events in the archive.
+	EventCount *int64 `type:"long"`
+
+	// The event pattern used to filter events sent to the archive.
+	EventPattern *string `type:"string"`
+
+	// The ARN of the event source associated with the archive.
+	EventSourceArn *string `min:"1" type:"string"`
+
+	// The number of days to retain events for in the archive.
+	RetentionDays *int64 `type:"integer"`
+
+	// The size of the archive in bytes.
+	SizeBytes *int64 `type:"long"`
+
+	// The state of the archive.
+	State *string `type:"string" enum:"ArchiveState"`
+
+	// The reason that the archive is in the state.
+	StateReason *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeArchiveOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeArchiveOutput) GoString() string {
+	return s.String()
+}
+
+// SetArchiveArn sets the ArchiveArn field's value.
+func (s *DescribeArchiveOutput) SetArchiveArn(v string) *DescribeArchiveOutput {
+	s.ArchiveArn = &v
+	return s
+}
+
+// SetArchiveName sets the ArchiveName field's value.
+func (s *DescribeArchiveOutput) SetArchiveName(v string) *DescribeArchiveOutput {
+	s.ArchiveName = &v
+	return s
+}
+
+// SetCreationTime sets the CreationTime field's value.
+func (s *DescribeArchiveOutput) SetCreationTime(v time.Time) *DescribeArchiveOutput {
+	s.CreationTime = &v
+	return s
+}
+
+// SetDescription sets the Description field's value.
+func (s *DescribeArchiveOutput) SetDescription(v string) *DescribeArchiveOutput {
+	s.Description = &v
+	return s
+}
+
+// SetEventCount sets the EventCount field's value.
+func (s *DescribeArchiveOutput) SetEventCount(v int64) *DescribeArchiveOutput {
+	s.EventCount = &v
+	return s
+}
+
+// SetEventPattern sets the EventPattern field's value.
+func (s *DescribeArchiveOutput) SetEventPattern(v string) *DescribeArchiveOutput {
+	s.EventPattern = &v
+	return s
+}
+
+// SetEventSourceArn sets the EventSourceArn field's value.
+func (s *DescribeArchiveOutput) SetEventSourceArn(v string) *DescribeArchiveOutput {
+	s.EventSourceArn = &v
+	return s
+}
+
+// SetRetentionDays sets the RetentionDays field's value.
+func (s *DescribeArchiveOutput) SetRetentionDays(v int64) *DescribeArchiveOutput {
+	s.RetentionDays = &v
+	return s
+}
+
+// SetSizeBytes sets the SizeBytes field's value.
+func (s *DescribeArchiveOutput) SetSizeBytes(v int64) *DescribeArchiveOutput {
+	s.SizeBytes = &v
+	return s
+}
+
+// SetState sets the State field's value.
+func (s *DescribeArchiveOutput) SetState(v string) *DescribeArchiveOutput {
+	s.State = &v
+	return s
+}
+
+// SetStateReason sets the StateReason field's value.
+func (s *DescribeArchiveOutput) SetStateReason(v string) *DescribeArchiveOutput {
+	s.StateReason = &v
+	return s
+}
+
+type DescribeConnectionInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the connection to retrieve.
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeConnectionInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeConnectionInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DescribeConnectionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeConnectionInput"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetName sets the Name field's value.
+func (s *DescribeConnectionInput) SetName(v string) *DescribeConnectionInput {
+	s.Name = &v
+	return s
+}
+
+type DescribeConnectionOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The parameters to use for authorization for the connection.
+	AuthParameters *ConnectionAuthResponseParameters `type:"structure"`
+
+	// The type of authorization specified for the connection.
+	AuthorizationType *string `type:"string" enum:"ConnectionAuthorizationType"`
+
+	// The ARN of the connection retrieved.
+	ConnectionArn *string `min:"1" type:"string"`
+
+	// The state of the connection retrieved.
+	ConnectionState *string `type:"string" enum:"ConnectionState"`
+
+	// A time stamp for the time that the connection was created.
+	CreationTime *time.Time `type:"timestamp"`
+
+	// The description for the connection retrieved.
+	Description *string `type:"string"`
+
+	// A time stamp for the time that the connection was last authorized.
+	LastAuthorizedTime *time.Time `type:"timestamp"`
+
+	// A time stamp for the time that the connection was last modified.
+	LastModifiedTime *time.Time `type:"timestamp"`
+
+	// The name of the connection retrieved.
+	Name *string `min:"1" type:"string"`
+
+	// The ARN of the secret created from the authorization parameters specified
+	// for the connection.
+	SecretArn *string `min:"20" type:"string"`
+
+	// The reason that the connection is in the current connection state.
+	StateReason *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeConnectionOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeConnectionOutput) GoString() string {
+	return s.String()
+}
+
+// SetAuthParameters sets the AuthParameters field's value.
+func (s *DescribeConnectionOutput) SetAuthParameters(v *ConnectionAuthResponseParameters) *DescribeConnectionOutput {
+	s.AuthParameters = v
+	return s
+}
+
+// SetAuthorizationType sets the AuthorizationType field's value.
+func (s *DescribeConnectionOutput) SetAuthorizationType(v string) *DescribeConnectionOutput {
+	s.AuthorizationType = &v
+	return s
+}
+
+// SetConnectionArn sets the ConnectionArn field's value.
+func (s *DescribeConnectionOutput) SetConnectionArn(v string) *DescribeConnectionOutput {
+	s.ConnectionArn = &v
+	return s
+}
+
+// SetConnectionState sets the ConnectionState field's value.
+func (s *DescribeConnectionOutput) SetConnectionState(v string) *DescribeConnectionOutput {
+	s.ConnectionState = &v
+	return s
+}
+
+// SetCreationTime sets the CreationTime field's value.
+func (s *DescribeConnectionOutput) SetCreationTime(v time.Time) *DescribeConnectionOutput {
+	s.CreationTime = &v
+	return s
+}
+
+// SetDescription sets the Description field's value.
+func (s *DescribeConnectionOutput) SetDescription(v string) *DescribeConnectionOutput {
+	s.Description = &v
+	return s
+}
+
+// SetLastAuthorizedTime sets the LastAuthorizedTime field's value.
+func (s *DescribeConnectionOutput) SetLastAuthorizedTime(v time.Time) *DescribeConnectionOutput {
+	s.LastAuthorizedTime = &v
+	return s
+}
+
+// SetLastModifiedTime sets the LastModifiedTime field's value.
+func (s *DescribeConnectionOutput) SetLastModifiedTime(v time.Time) *DescribeConnectionOutput {
+	s.LastModifiedTime = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *DescribeConnectionOutput) SetName(v string) *DescribeConnectionOutput {
+	s.Name = &v
+	return s
+}
+
+// SetSecretArn sets the SecretArn field's value.
+func (s *DescribeConnectionOutput) SetSecretArn(v string) *DescribeConnectionOutput {
+	s.SecretArn = &v
+	return s
+}
+
+// SetStateReason sets the StateReason field's value.
+func (s *DescribeConnectionOutput) SetStateReason(v string) *DescribeConnectionOutput {
+	s.StateReason = &v
+	return s
+}
+
+type DescribeEventBusInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name or ARN of the event bus to show details for. If you omit this, the
+	// default event bus is displayed.
+	Name *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeEventBusInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeEventBusInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DescribeEventBusInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeEventBusInput"}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetName sets the Name field's value.
+func (s *DescribeEventBusInput) SetName(v string) *DescribeEventBusInput {
+	s.Name = &v
+	return s
+}
+
+type DescribeEventBusOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the account permitted to write events to
+	// the current account.
+	Arn *string `type:"string"`
+
+	// The name of the event bus. Currently, this is always default.
+	Name *string `type:"string"`
+
+	// The policy that enables the external account to send events to your account.
+	Policy *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeEventBusOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeEventBusOutput) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *DescribeEventBusOutput) SetArn(v string) *DescribeEventBusOutput {
+	s.Arn = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *DescribeEventBusOutput) SetName(v string) *DescribeEventBusOutput {
+	s.Name = &v
+	return s
+}
+
+// SetPolicy sets the Policy field's value.
+func (s *DescribeEventBusOutput) SetPolicy(v string) *DescribeEventBusOutput {
+	s.Policy = &v
+	return s
+}
+
+type DescribeEventSourceInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the partner event source to display the details of.
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeEventSourceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeEventSourceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DescribeEventSourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeEventSourceInput"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetName sets the Name field's value.
+func (s *DescribeEventSourceInput) SetName(v string) *DescribeEventSourceInput {
+	s.Name = &v
+	return s
+}
+
+type DescribeEventSourceOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the partner event source.
+	Arn *string `type:"string"`
+
+	// The name of the SaaS partner that created the event source.
+	CreatedBy *string `type:"string"`
+
+	// The date and time that the event source was created.
+	CreationTime *time.Time `type:"timestamp"`
+
+	// The date and time that the event source will expire if you do not create
+	// a matching event bus.
+	ExpirationTime *time.Time `type:"timestamp"`
+
+	// The name of the partner event source.
+	Name *string `type:"string"`
+
+	// The state of the event source. If it is ACTIVE, you have already created
+	// a matching event bus for this event source, and that event bus is active.
+	// If it is PENDING, either you haven't yet created a matching event bus, or
+	// that event bus is deactivated. If it is DELETED, you have created a matching
+	// event bus, but the event source has since been deleted.
+	State *string `type:"string" enum:"EventSourceState"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeEventSourceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeEventSourceOutput) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *DescribeEventSourceOutput) SetArn(v string) *DescribeEventSourceOutput {
+	s.Arn = &v
+	return s
+}
+
+// SetCreatedBy sets the CreatedBy field's value.
+func (s *DescribeEventSourceOutput) SetCreatedBy(v string) *DescribeEventSourceOutput {
+	s.CreatedBy = &v
+	return s
+}
+
+// SetCreationTime sets the CreationTime field's value.
+func (s *DescribeEventSourceOutput) SetCreationTime(v time.Time) *DescribeEventSourceOutput {
+	s.CreationTime = &v
+	return s
+}
+
+// SetExpirationTime sets the ExpirationTime field's value.
+func (s *DescribeEventSourceOutput) SetExpirationTime(v time.Time) *DescribeEventSourceOutput {
+	s.ExpirationTime = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *DescribeEventSourceOutput) SetName(v string) *DescribeEventSourceOutput {
+	s.Name = &v
+	return s
+}
+
+// SetState sets the State field's value.
+func (s *DescribeEventSourceOutput) SetState(v string) *DescribeEventSourceOutput {
+	s.State = &v
+	return s
+}
+
+type DescribePartnerEventSourceInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the event source to display.
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribePartnerEventSourceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribePartnerEventSourceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DescribePartnerEventSourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribePartnerEventSourceInput"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetName sets the Name field's value.
+func (s *DescribePartnerEventSourceInput) SetName(v string) *DescribePartnerEventSourceInput {
+	s.Name = &v
+	return s
+}
+
+type DescribePartnerEventSourceOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the event source.
+	Arn *string `type:"string"`
+
+	// The name of the event source.
+	Name *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribePartnerEventSourceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribePartnerEventSourceOutput) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *DescribePartnerEventSourceOutput) SetArn(v string) *DescribePartnerEventSourceOutput {
+	s.Arn = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *DescribePartnerEventSourceOutput) SetName(v string) *DescribePartnerEventSourceOutput {
+	s.Name = &v
+	return s
+}
+
+type DescribeReplayInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the replay to retrieve.
+	//
+	// ReplayName is a required field
+	ReplayName *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeReplayInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeReplayInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DescribeReplayInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeReplayInput"}
+	if s.ReplayName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ReplayName"))
+	}
+	if s.ReplayName != nil && len(*s.ReplayName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ReplayName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetReplayName sets the ReplayName field's value.
+func (s *DescribeReplayInput) SetReplayName(v string) *DescribeReplayInput {
+	s.ReplayName = &v
+	return s
+}
+
+type DescribeReplayOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The description of the replay.
+	Description *string `type:"string"`
+
+	// A ReplayDestination object that contains details about the replay.
+	Destination *ReplayDestination `type:"structure"`
+
+	// The time stamp for the last event that was replayed from the archive.
+	EventEndTime *time.Time `type:"timestamp"`
+
+	// The time that the event was last replayed.
+	EventLastReplayedTime *time.Time `type:"timestamp"`
+
+	// The ARN of the archive events were replayed from.
+	EventSourceArn *string `min:"1" type:"string"`
+
+	// The time stamp of the first event that was last replayed from the archive.
+	EventStartTime *time.Time `type:"timestamp"`
+
+	// The ARN of the replay.
+	ReplayArn *string `min:"1" type:"string"`
+
+	// A time stamp for the time that the replay stopped.
+	ReplayEndTime *time.Time `type:"timestamp"`
+
+	// The name of the replay.
+	ReplayName *string `min:"1" type:"string"`
+
+	// A time stamp for the time that the replay started.
+	ReplayStartTime *time.Time `type:"timestamp"`
+
+	// The current state of the replay.
+	State *string `type:"string" enum:"ReplayState"`
+
+	// The reason that the replay is in the current state.
+	StateReason *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeReplayOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeReplayOutput) GoString() string {
+	return s.String()
+}
+
+// SetDescription sets the Description field's value.
+func (s *DescribeReplayOutput) SetDescription(v string) *DescribeReplayOutput {
+	s.Description = &v
+	return s
+}
+
+// SetDestination sets the Destination field's value.
+func (s *DescribeReplayOutput) SetDestination(v *ReplayDestination) *DescribeReplayOutput {
+	s.Destination = v
+	return s
+}
+
+// SetEventEndTime sets the EventEndTime field's value.
+func (s *DescribeReplayOutput) SetEventEndTime(v time.Time) *DescribeReplayOutput {
+	s.EventEndTime = &v
+	return s
+}
+
+// SetEventLastReplayedTime sets the EventLastReplayedTime field's value.
+func (s *DescribeReplayOutput) SetEventLastReplayedTime(v time.Time) *DescribeReplayOutput {
+	s.EventLastReplayedTime = &v
+	return s
+}
+
+// SetEventSourceArn sets the EventSourceArn field's value.
+func (s *DescribeReplayOutput) SetEventSourceArn(v string) *DescribeReplayOutput {
+	s.EventSourceArn = &v
+	return s
+}
+
+// SetEventStartTime sets the EventStartTime field's value.
+func (s *DescribeReplayOutput) SetEventStartTime(v time.Time) *DescribeReplayOutput {
+	s.EventStartTime = &v
+	return s
+}
+
+// SetReplayArn sets the ReplayArn field's value.
+func (s *DescribeReplayOutput) SetReplayArn(v string) *DescribeReplayOutput {
+	s.ReplayArn = &v
+	return s
+}
+
+// SetReplayEndTime sets the ReplayEndTime field's value.
+func (s *DescribeReplayOutput) SetReplayEndTime(v time.Time) *DescribeReplayOutput {
+	s.ReplayEndTime = &v
+	return s
+}
+
+// SetReplayName sets the ReplayName field's value.
+func (s *DescribeReplayOutput) SetReplayName(v string) *DescribeReplayOutput {
+	s.ReplayName = &v
+	return s
+}
+
+// SetReplayStartTime sets the ReplayStartTime field's value.
+func (s *DescribeReplayOutput) SetReplayStartTime(v time.Time) *DescribeReplayOutput {
+	s.ReplayStartTime = &v
+	return s
+}
+
+// SetState sets the State field's value.
+func (s *DescribeReplayOutput) SetState(v string) *DescribeReplayOutput {
+	s.State = &v
+	return s
+}
+
+// SetStateReason sets the StateReason field's value.
+func (s *DescribeReplayOutput) SetStateReason(v string) *DescribeReplayOutput {
+	s.StateReason = &v
+	return s
+}
+
+type DescribeRuleInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name or ARN of the event bus associated with the rule. If you omit this,
+	// the default event bus is used.
+	EventBusName *string `min:"1" type:"string"`
+
+	// The name of the rule.
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeRuleInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeRuleInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DescribeRuleInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeRuleInput"}
+	if s.EventBusName != nil && len(*s.EventBusName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EventBusName", 1))
+	}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetEventBusName sets the EventBusName field's value.
+func (s *DescribeRuleInput) SetEventBusName(v string) *DescribeRuleInput {
+	s.EventBusName = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *DescribeRuleInput) SetName(v string) *DescribeRuleInput {
+	s.Name = &v
+	return s
+}
+
+type DescribeRuleOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the rule.
+	Arn *string `min:"1" type:"string"`
+
+	// The account ID of the user that created the rule. If you use PutRule to put
+	// a rule on an event bus in another account, the other account is the owner
+	// of the rule, and the rule ARN includes the account ID for that account. However,
+	// the value for CreatedBy is the account ID as the account that created the
+	// rule in the other account.
+	CreatedBy *string `min:"1" type:"string"`
+
+	// The description of the rule.
+	Description *string `type:"string"`
+
+	// The name of the event bus associated with the rule.
+	EventBusName *string `min:"1" type:"string"`
+
+	// The event pattern. For more information, see Events and Event Patterns (https://docs.aws.amazon.com/eventbridge/latest/userguide/eventbridge-and-event-patterns.html)
+	// in the Amazon EventBridge User Guide.
+	EventPattern *string `type:"string"`
+
+	// If this is a managed rule, created by an Amazon Web Services service on your
+	// behalf, this field displays the principal name of the Amazon Web Services
+	// service that created the rule.
+	ManagedBy *string `min:"1" type:"string"`
+
+	// The name of the rule.
+	Name *string `min:"1" type:"string"`
+
+	// The Amazon Resource Name (ARN) of the IAM role associated with the rule.
+	RoleArn *string `min:"1" type:"string"`
+
+	// The scheduling expression. For example, "cron(0 20 * * ? *)", "rate(5 minutes)".
+	ScheduleExpression *string `type:"string"`
+
+	// Specifies whether the rule is enabled or disabled.
+	State *string `type:"string" enum:"RuleState"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeRuleOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeRuleOutput) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *DescribeRuleOutput) SetArn(v string) *DescribeRuleOutput {
+	s.Arn = &v
+	return s
+}
+
+// SetCreatedBy sets the CreatedBy field's value.
+func (s *DescribeRuleOutput) SetCreatedBy(v string) *DescribeRuleOutput {
+	s.CreatedBy = &v
+	return s
+}
+
+// SetDescription sets the Description field's value.
+func (s *DescribeRuleOutput) SetDescription(v string) *DescribeRuleOutput {
+	s.Description = &v
+	return s
+}
+
+// SetEventBusName sets the EventBusName field's value.
+func (s *DescribeRuleOutput) SetEventBusName(v string) *DescribeRuleOutput {
+	s.EventBusName = &v
+	return s
+}
+
+// SetEventPattern sets the EventPattern field's value.
+func (s *DescribeRuleOutput) SetEventPattern(v string) *DescribeRuleOutput {
+	s.EventPattern = &v
+	return s
+}
+
+// SetManagedBy sets the ManagedBy field's value.
+func (s *DescribeRuleOutput) SetManagedBy(v string) *DescribeRuleOutput {
+	s.ManagedBy = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *DescribeRuleOutput) SetName(v string) *DescribeRuleOutput {
+	s.Name = &v
+	return s
+}
+
+// SetRoleArn sets the RoleArn field's value.
+func (s *DescribeRuleOutput) SetRoleArn(v string) *DescribeRuleOutput {
+	s.RoleArn = &v
+	return s
+}
+
+// SetScheduleExpression sets the ScheduleExpression field's value.
+func (s *DescribeRuleOutput) SetScheduleExpression(v string) *DescribeRuleOutput {
+	s.ScheduleExpression = &v
+	return s
+}
+
+// SetState sets the State field's value.
+func (s *DescribeRuleOutput) SetState(v string) *DescribeRuleOutput {
+	s.State = &v
+	return s
+}
+
+type DisableRuleInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name or ARN of the event bus associated with the rule. If you omit this,
+	// the default event bus is used.
+	EventBusName *string `min:"1" type:"string"`
+
+	// The name of the rule.
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableRuleInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableRuleInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DisableRuleInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisableRuleInput"}
+	if s.EventBusName != nil && len(*s.EventBusName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EventBusName", 1))
+	}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetEventBusName sets the EventBusName field's value.
+func (s *DisableRuleInput) SetEventBusName(v string) *DisableRuleInput {
+	s.EventBusName = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *DisableRuleInput) SetName(v string) *DisableRuleInput {
+	s.Name = &v
+	return s
+}
+
+type DisableRuleOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableRuleOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableRuleOutput) GoString() string {
+	return s.String()
+}
+
+// The custom parameters to be used when the target is an Amazon ECS task.
+type EcsParameters struct {
+	_ struct{} `type:"structure"`
+
+	// The capacity provider strategy to use for the task.
+	//
+	// If a capacityProviderStrategy is specified, the launchType parameter must
+	// be omitted. If no capacityProviderStrategy or launchType is specified, the
+	// defaultCapacityProviderStrategy for the cluster is used.
+	CapacityProviderStrategy []*CapacityProviderStrategyItem `type:"list"`
+
+	// Specifies whether to enable Amazon ECS managed tags for the task. For more
+	// information, see Tagging Your Amazon ECS Resources (https://docs.aws.amazon.com/AmazonECS/latest/developerguide/ecs-using-tags.html)
+	// in the Amazon Elastic Container Service Developer Guide.
+	EnableECSManagedTags *bool `type:"boolean"`
+
+	// Whether or not to enable the execute command functionality for the containers
+	// in this task. If true, this enables execute command functionality on all
+	// containers in the task.
+	EnableExecuteCommand *bool `type:"boolean"`
+
+	// Specifies an ECS task group for the task. The maximum length is 255 characters.
+	Group *string `type:"string"`
+
+	// Specifies the launch type on which your task is running. The launch type
+	// that you specify here must match one of the launch type (compatibilities)
+	// of the target task. The FARGATE value is supported only in the Regions where
+	// Fargate witt Amazon ECS is supported. For more information, see Fargate on
+	// Amazon ECS (https://docs.aws.amazon.com/AmazonECS/latest/developerguide/AWS-Fargate.html)
+	// in the Amazon Elastic Container Service Developer Guide.
+	LaunchType *string `type:"string" enum:"LaunchType"`
+
+	// Use this structure if the Amazon ECS task uses the awsvpc network mode. This
+	// structure specifies the VPC subnets and security groups associated with the
+	// task, and whether a public IP address is to be used. This structure is required
+	// if LaunchType is FARGATE because the awsvpc mode is required for Fargate
+	// tasks.
+	//
+	// If you specify NetworkConfiguration when the target ECS task does not use
+	// the awsvpc network mode, the task fails.
+	NetworkConfiguration *NetworkConfiguration `type:"structure"`
+
+	// An array of placement constraint objects to use for the task. You can specify
+	// up to 10 constraints per task (including constraints in the task definition
+	// and those specified at runtime).
+	PlacementConstraints []*PlacementConstraint `type:"list"`
+
+	// The placement strategy objects to use for the task. You can specify a maximum
+	// of five strategy rules per task.
+	PlacementStrategy []*PlacementStrategy `type:"list"`
+
+	// Specifies the platform version for the task. Specify only the numeric portion
+	// of the platform version, such as 1.1.0.
+	//
+	// This structure is used only if LaunchType is FARGATE. For more information
+	// about valid platform versions, see Fargate Platform Versions (https://docs.aws.amazon.com/AmazonECS/latest/developerguide/platform_versions.html)
+	// in the Amazon Elastic Container Service Developer Guide.
+	PlatformVersion *string `type:"string"`
+
+	// Specifies whether to propagate the tags from the task definition to the task.
+	// If no value is specified, the tags are not propagated. Tags can only be propagated
+	// to the task during task creation. To add tags to a task after task creation,
+	// use the TagResource API action.
+	PropagateTags *string `type:"string" enum:"PropagateTags"`
+
+	// The reference ID to use for the task.
+	ReferenceId *string `type:"string"`
+
+	// The metadata that you apply to the task to help you categorize and organize
+	// them. Each tag consists of a key and an optional value, both of which you
+	// define. To learn more, see RunTask (https://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_RunTask.html#ECS-RunTask-request-tags)
+	// in the Amazon ECS API Reference.
+	Tags []*Tag `type:"list"`
+
+	// The number of tasks to create based on TaskDefinition. The default is 1.
+	TaskCount *int64 `min:"1" type:"integer"`
+
+	// The ARN of the task definition to use if the event target is an Amazon ECS
+	// task.
+	//
+	// TaskDefinitionArn is a required field
+	TaskDefinitionArn *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EcsParameters) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EcsParameters) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *EcsParameters) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "EcsParameters"}
+	if s.TaskCount != nil && *s.TaskCount < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("TaskCount", 1))
+	}
+	if s.TaskDefinitionArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("TaskDefinitionArn"))
+	}
+	if s.TaskDefinitionArn != nil && len(*s.TaskDefinitionArn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("TaskDefinitionArn", 1))
+	}
+	if s.CapacityProviderStrategy != nil {
+		for i, v := range s.CapacityProviderStrategy {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "CapacityProviderStrategy", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.NetworkConfiguration != nil {
+		if err := s.NetworkConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("NetworkConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCapacityProviderStrategy sets the CapacityProviderStrategy field's value.
+func (s *EcsParameters) SetCapacityProviderStrategy(v []*CapacityProviderStrategyItem) *EcsParameters {
+	s.CapacityProviderStrategy = v
+	return s
+}
+
+// SetEnableECSManagedTags sets the EnableECSManagedTags field's value.
+func (s *EcsParameters) SetEnableECSManagedTags(v bool) *EcsParameters {
+	s.EnableECSManagedTags = &v
+	return s
+}
+
+// SetEnableExecuteCommand sets the EnableExecuteCommand field's value.
+func (s *EcsParameters) SetEnableExecuteCommand(v bool) *EcsParameters {
+	s.EnableExecuteCommand = &v
+	return s
+}
+
+// SetGroup sets the Group field's value.
+func (s *EcsParameters) SetGroup(v string) *EcsParameters {
+	s.Group = &v
+	return s
+}
+
+// SetLaunchType sets the LaunchType field's value.
+func (s *EcsParameters) SetLaunchType(v string) *EcsParameters {
+	s.LaunchType = &v
+	return s
+}
+
+// SetNetworkConfiguration sets the NetworkConfiguration field's value.
+func (s *EcsParameters) SetNetworkConfiguration(v *NetworkConfiguration) *EcsParameters {
+	s.NetworkConfiguration = v
+	return s
+}
+
+// SetPlacementConstraints sets the PlacementConstraints field's value.
+func (s *EcsParameters) SetPlacementConstraints(v []*PlacementConstraint) *EcsParameters {
+	s.PlacementConstraints = v
+	return s
+}
+
+// SetPlacementStrategy sets the PlacementStrategy field's value.
+func (s *EcsParameters) SetPlacementStrategy(v []*PlacementStrategy) *EcsParameters {
+	s.PlacementStrategy = v
+	return s
+}
+
+// SetPlatformVersion sets the PlatformVersion field's value.
+func (s *EcsParameters) SetPlatformVersion(v string) *EcsParameters {
+	s.PlatformVersion = &v
+	return s
+}
+
+// SetPropagateTags sets the PropagateTags field's value.
+func (s *EcsParameters) SetPropagateTags(v string) *EcsParameters {
+	s.PropagateTags = &v
+	return s
+}
+
+// SetReferenceId sets the ReferenceId field's value.
+func (s *EcsParameters) SetReferenceId(v string) *EcsParameters {
+	s.ReferenceId = &v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *EcsParameters) SetTags(v []*Tag) *EcsParameters {
+	s.Tags = v
+	return s
+}
+
+// SetTaskCount sets the TaskCount field's value.
+func (s *EcsParameters) SetTaskCount(v int64) *EcsParameters {
+	s.TaskCount = &v
+	return s
+}
+
+// SetTaskDefinitionArn sets the TaskDefinitionArn field's value.
+func (s *EcsParameters) SetTaskDefinitionArn(v string) *EcsParameters {
+	s.TaskDefinitionArn = &v
+	return s
+}
+
+type EnableRuleInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name or ARN of the event bus associated with the rule. If you omit this,
+	// the default event bus is used.
+	EventBusName *string `min:"1" type:"string"`
+
+	// The name of the rule.
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableRuleInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableRuleInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *EnableRuleInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnableRuleInput"}
+	if s.EventBusName != nil && len(*s.EventBusName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EventBusName", 1))
+	}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetEventBusName sets the EventBusName field's value.
+func (s *EnableRuleInput) SetEventBusName(v string) *EnableRuleInput {
+	s.EventBusName = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *EnableRuleInput) SetName(v string) *EnableRuleInput {
+	s.Name = &v
+	return s
+}
+
+type EnableRuleOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableRuleOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableRuleOutput) GoString() string {
+	return s.String()
+}
+
+// An event bus receives events from a source and routes them to rules associated
+// with that event bus. Your account's default event bus receives events from
+// Amazon Web Services services. A custom event bus can receive events from
+// your custom applications and services. A partner event bus receives events
+// from an event source created by an SaaS partner. These events come from the
+// partners services or applications.
+type EventBus struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the event bus.
+	Arn *string `type:"string"`
+
+	// The name of the event bus.
+	Name *string `type:"string"`
+
+	// The permissions policy of the event bus, describing which other Amazon Web
+	// Services accounts can write events to this event bus.
+	Policy *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EventBus) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EventBus) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *EventBus) SetArn(v string) *EventBus {
+	s.Arn = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *EventBus) SetName(v string) *EventBus {
+	s.Name = &v
+	return s
+}
+
+// SetPolicy sets the Policy field's value.
+func (s *EventBus) SetPolicy(v string) *EventBus {
+	s.Policy = &v
+	return s
+}
+
+// A partner event source is created by an SaaS partner. If a customer creates
+// a partner event bus that matches this event source, that Amazon Web Services
+// account can receive events from the partner's applications or services.
+type EventSource struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the event source.
+	Arn *string `type:"string"`
+
+	// The name of the partner that created the event source.
+	CreatedBy *string `type:"string"`
+
+	// The date and time the event source was created.
+	CreationTime *time.Time `type:"timestamp"`
+
+	// The date and time that the event source will expire, if the Amazon Web Services
+	// account doesn't create a matching event bus for it.
+	ExpirationTime *time.Time `type:"timestamp"`
+
+	// The name of the event source.
+	Name *string `type:"string"`
+
+	// The state of the event source. If it is ACTIVE, you have already created
+	// a matching event bus for this event source, and that event bus is active.
+	// If it is PENDING, either you haven't yet created a matching event bus, or
+	// that event bus is deactivated. If it is DELETED, you have created a matching
+	// event bus, but the event source has since been deleted.
+	State *string `type:"string" enum:"EventSourceState"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EventSource) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EventSource) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *EventSource) SetArn(v string) *EventSource {
+	s.Arn = &v
+	return s
+}
+
+// SetCreatedBy sets the CreatedBy field's value.
+func (s *EventSource) SetCreatedBy(v string) *EventSource {
+	s.CreatedBy = &v
+	return s
+}
+
+// SetCreationTime sets the CreationTime field's value.
+func (s *EventSource) SetCreationTime(v time.Time) *EventSource {
+	s.CreationTime = &v
+	return s
+}
+
+// SetExpirationTime sets the ExpirationTime field's value.
+func (s *EventSource) SetExpirationTime(v time.Time) *EventSource {
+	s.ExpirationTime = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *EventSource) SetName(v string) *EventSource {
+	s.Name = &v
+	return s
+}
+
+// SetState sets the State field's value.
+func (s *EventSource) SetState(v string) *EventSource {
+	s.State = &v
+	return s
+}
+
+// These are custom parameter to be used when the target is an API Gateway REST
+// APIs or EventBridge ApiDestinations. In the latter case, these are merged
+// with any InvocationParameters specified on the Connection, with any values
+// from the Connection taking precedence.
+type HttpParameters struct {
+	_ struct{} `type:"structure"`
+
+	// The headers that need to be sent as part of request invoking the API Gateway
+	// REST API or EventBridge ApiDestination.
+	HeaderParameters map[string]*string `type:"map"`
+
+	// The path parameter values to be used to populate API Gateway REST API or
+	// EventBridge ApiDestination path wildcards ("*").
+	PathParameterValues []*string `type:"list"`
+
+	// The query string keys/values that need to be sent as part of request invoking
+	// the API Gateway REST API or EventBridge ApiDestination.
+	QueryStringParameters map[string]*string `type:"map"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpParameters) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpParameters) GoString() string {
+	return s.String()
+}
+
+// SetHeaderParameters sets the HeaderParameters field's value.
+func (s *HttpParameters) SetHeaderParameters(v map[string]*string) *HttpParameters {
+	s.HeaderParameters = v
+	return s
+}
+
+// SetPathParameterValues sets the PathParameterValues field's value.
+func (s *HttpParameters) SetPathParameterValues(v []*string) *HttpParameters {
+	s.PathParameterValues = v
+	return s
+}
+
+// SetQueryStringParameters sets the QueryStringParameters field's value.
+func (s *HttpParameters) SetQueryStringParameters(v map[string]*string) *HttpParameters {
+	s.QueryStringParameters = v
+	return s
+}
+
+// An error occurred because a replay can be canceled only when the state is
+// Running or Starting.
+type IllegalStatusException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IllegalStatusException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IllegalStatusException) GoString() string {
+	return s.String()
+}
+
+func newErrorIllegalStatusException(v protocol.ResponseMetadata) error {
+	return &IllegalStatusException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *IllegalStatusException) Code() string {
+	return "IllegalStatusException"
+}
+
+// Message returns the exception's message.
+func (s *IllegalStatusException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *IllegalStatusException) OrigErr() error {
+	return nil
+}
+
+func (s *IllegalStatusException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *IllegalStatusException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *IllegalStatusException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Contains the parameters needed for you to provide custom input to a target
+// based on one or more pieces of data extracted from the event.
+type InputTransformer struct {
+	_ struct{} `type:"structure"`
+
+	// Map of JSON paths to be extracted from the event. You can then insert these
+	// in the template in InputTemplate to produce the output you want to be sent
+	// to the target.
+	//
+	// InputPathsMap is an array key-value pairs, where each value is a valid JSON
+	// path. You can have as many as 100 key-value pairs. You must use JSON dot
+	// notation, not bracket notation.
+	//
+	// The keys cannot start with "Amazon Web Services."
+	InputPathsMap map[string]*string `type:"map"`
+
+	// Input template where you specify placeholders that will be filled with the
+	// values of the keys from InputPathsMap to customize the data sent to the target.
+	// Enclose each InputPathsMaps value in brackets: <value> The InputTemplate
+	// must be valid JSON.
+	//
+	// If InputTemplate is a JSON object (surrounded by curly braces), the following
+	// restrictions apply:
+	//
+	//    * The placeholder cannot be used as an object key.
+	//
+	// The following example shows the syntax for using InputPathsMap and InputTemplate.
+	//
+	// "InputTransformer":
+	//
+	// {
+	//
+	// "InputPathsMap": {"instance": "$.detail.instance","status": "$.detail.status"},
+	//
+	// "InputTemplate": "<instance> is in state <status>"
+	//
+	// }
+	//
+	// To have the InputTemplate include quote marks within a JSON string, escape
+	// each quote marks with a slash, as in the following example:
+	//
+	// "InputTransformer":
+	//
+	// {
+	//
+	// "InputPathsMap": {"instance": "$.detail.instance","status": "$.detail.status"},
+	//
+	// "InputTemplate": "<instance> is in state \"<status>\""
+	//
+	// }
+	//
+	// The InputTemplate can also be valid JSON with varibles in quotes or out,
+	// as in the following example:
+	//
+	// "InputTransformer":
+	//
+	// {
+	//
+	// "InputPathsMap": {"instance": "$.detail.instance","status": "$.detail.status"},
+	//
+	// "InputTemplate": '{"myInstance": <instance>,"myStatus": "<instance> is in
+	// state \"<status>\""}'
+	//
+	// }
+	//
+	// InputTemplate is a required field
+	InputTemplate *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InputTransformer) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InputTransformer) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *InputTransformer) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "InputTransformer"}
+	if s.InputTemplate == nil {
+		invalidParams.Add(request.NewErrParamRequired("InputTemplate"))
+	}
+	if s.InputTemplate != nil && len(*s.InputTemplate) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("InputTemplate", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetInputPathsMap sets the InputPathsMap field's value.
+func (s *InputTransformer) SetInputPathsMap(v map[string]*string) *InputTransformer {
+	s.InputPathsMap = v
+	return s
+}
+
+// SetInputTemplate sets the InputTemplate field's value.
+func (s *InputTransformer) SetInputTemplate(v string) *InputTransformer {
+	s.InputTemplate = &v
+	return s
+}
+
+// This exception occurs due to unexpected causes.
+type InternalException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InternalException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InternalException) GoString() string {
+	return s.String()
+}
+
+func newErrorInternalException(v protocol.ResponseMetadata) error {
+	return &InternalException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InternalException) Code() string {
+	return "InternalException"
+}
+
+// Message returns the exception's message.
+func (s *InternalException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InternalException) OrigErr() error {
+	return nil
+}
+
+func (s *InternalException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InternalException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InternalException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The event pattern is not valid.
+type InvalidEventPatternException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidEventPatternException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidEventPatternException) GoString() string {
+	return s.String()
+}
+
+func newErrorInvalidEventPatternException(v protocol.ResponseMetadata) error {
+	return &InvalidEventPatternException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InvalidEventPatternException) Code() string {
+	return "InvalidEventPatternException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidEventPatternException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidEventPatternException) OrigErr() error {
+	return nil
+}
+
+func (s *InvalidEventPatternException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidEventPatternException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidEventPatternException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The specified state is not a valid state for an event source.
+type InvalidStateException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidStateException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidStateException) GoString() string {
+	return s.String()
+}
+
+func newErrorInvalidStateException(v protocol.ResponseMetadata) error {
+	return &InvalidStateException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InvalidStateException) Code() string {
+	return "InvalidStateException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidStateException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidStateException) OrigErr() error {
+	return nil
+}
+
+func (s *InvalidStateException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidStateException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidStateException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// This object enables you to specify a JSON path to extract from the event
+// and use as the partition key for the Amazon Kinesis data stream, so that
+// you can control the shard to which the event goes. If you do not include
+// this parameter, the default is to use the eventId as the partition key.
+type KinesisParameters struct {
+	_ struct{} `type:"structure"`
+
+	// The JSON path to be extracted from the event and used as the partition key.
+	// For more information, see Amazon Kinesis Streams Key Concepts (https://docs.aws.amazon.com/streams/latest/dev/key-concepts.html#partition-key)
+	// in the Amazon Kinesis Streams Developer Guide.
+	//
+	// PartitionKeyPath is a required field
+	PartitionKeyPath *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s KinesisParameters) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s KinesisParameters) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *KinesisParameters) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "KinesisParameters"}
+	if s.PartitionKeyPath == nil {
+		invalidParams.Add(request.NewErrParamRequired("PartitionKeyPath"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetPartitionKeyPath sets the PartitionKeyPath field's value.
+func (s *KinesisParameters) SetPartitionKeyPath(v string) *KinesisParameters {
+	s.PartitionKeyPath = &v
+	return s
+}
+
+// The request failed because it attempted to create resource beyond the allowed
+// service quota.
+type LimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) GoString() string {
+	return s.String()
+}
+
+func newErrorLimitExceededException(v protocol.ResponseMetadata) error {
+	return &LimitExceededException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *LimitExceededException) Code() string {
+	return "LimitExceededException"
+}
+
+// Message returns the exception's message.
+func (s *LimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *LimitExceededException) OrigErr() error {
+	return nil
+}
+
+func (s *LimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *LimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *LimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type ListApiDestinationsInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the connection specified for the API destination.
+	ConnectionArn *string `min:"1" type:"string"`
+
+	// The maximum number of API destinations to include in the response.
+	Limit *int64 `min:"1" type:"integer"`
+
+	// A name prefix to filter results returned. Only API destinations with a name
+	// that starts with the prefix are returned.
+	NamePrefix *string `min:"1" type:"string"`
+
+	// The token returned by a previous call to retrieve the next set of results.
+	NextToken *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListApiDestinationsInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListApiDestinationsInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListApiDestinationsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListApiDestinationsInput"}
+	if s.ConnectionArn != nil && len(*s.ConnectionArn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ConnectionArn", 1))
+	}
+	if s.Limit != nil && *s.Limit < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+	}
+	if s.NamePrefix != nil && len(*s.NamePrefix) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NamePrefix", 1))
+	}
+	if s.NextToken != nil && len(*s.NextToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetConnectionArn sets the ConnectionArn field's value.
+func (s *ListApiDestinationsInput) SetConnectionArn(v string) *ListApiDestinationsInput {
+	s.ConnectionArn = &v
+	return s
+}
+
+// SetLimit sets the Limit field's value.
+func (s *ListApiDestinationsInput) SetLimit(v int64) *ListApiDestinationsInput {
+	s.Limit = &v
+	return s
+}
+
+// SetNamePrefix sets the NamePrefix field's value.
+func (s *ListApiDestinationsInput) SetNamePrefix(v string) *ListApiDestinationsInput {
+	s.NamePrefix = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListApiDestinationsInput) SetNextToken(v string) *ListApiDestinationsInput {
+	s.NextToken = &v
+	return s
+}
+
+type ListApiDestinationsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// An array of ApiDestination objects that include information about an API
+	// destination.
+	ApiDestinations []*ApiDestination `type:"list"`
+
+	// A token you can use in a subsequent request to retrieve the next set of results.
+	NextToken *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListApiDestinationsOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListApiDestinationsOutput) GoString() string {
+	return s.String()
+}
+
+// SetApiDestinations sets the ApiDestinations field's value.
+func (s *ListApiDestinationsOutput) SetApiDestinations(v []*ApiDestination) *ListApiDestinationsOutput {
+	s.ApiDestinations = v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListApiDestinationsOutput) SetNextToken(v string) *ListApiDestinationsOutput {
+	s.NextToken = &v
+	return s
+}
+
+type ListArchivesInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the event source associated with the archive.
+	EventSourceArn *string `min:"1" type:"string"`
+
+	// The maximum number of results to return.
+	Limit *int64 `min:"1" type:"integer"`
+
+	// A name prefix to filter the archives returned. Only archives with name that
+	// match the prefix are returned.
+	NamePrefix *string `min:"1" type:"string"`
+
+	// The token returned by a previous call to retrieve the next set of results.
+	NextToken *string `min:"1" type:"string"`
+
+	// The state of the archive.
+	State *string `type:"string" enum:"ArchiveState"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListArchivesInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListArchivesInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListArchivesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListArchivesInput"}
+	if s.EventSourceArn != nil && len(*s.EventSourceArn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EventSourceArn", 1))
+	}
+	if s.Limit != nil && *s.Limit < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+	}
+	if s.NamePrefix != nil && len(*s.NamePrefix) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NamePrefix", 1))
+	}
+	if s.NextToken != nil && len(*s.NextToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetEventSourceArn sets the EventSourceArn field's value.
+func (s *ListArchivesInput) SetEventSourceArn(v string) *ListArchivesInput {
+	s.EventSourceArn = &v
+	return s
+}
+
+// SetLimit sets the Limit field's value.
+func (s *ListArchivesInput) SetLimit(v int64) *ListArchivesInput {
+	s.Limit = &v
+	return s
+}
+
+// SetNamePrefix sets the NamePrefix field's value.
+func (s *ListArchivesInput) SetNamePrefix(v string) *ListArchivesInput {
+	s.NamePrefix = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListArchivesInput) SetNextToken(v string) *ListArchivesInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetState sets the State field's value.
+func (s *ListArchivesInput) SetState(v string) *ListArchivesInput {
+	s.State = &v
+	return s
+}
+
+type ListArchivesOutput struct {
+	_ struct{} `type:"structure"`
+
+	// An array of Archive objects that include details about an archive.
+	Archives []*Archive `type:"list"`
+
+	// The token returned by a previous call to retrieve the next set of results.
+	NextToken *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListArchivesOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListArchivesOutput) GoString() string {
+	return s.String()
+}
+
+// SetArchives sets the Archives field's value.
+func (s *ListArchivesOutput) SetArchives(v []*Archive) *ListArchivesOutput {
+	s.Archives = v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListArchivesOutput) SetNextToken(v string) *ListArchivesOutput {
+	s.NextToken = &v
+	return s
+}
+
+type ListConnectionsInput struct {
+	_ struct{} `type:"structure"`
+
+	// The state of the connection.
+	ConnectionState *string `type:"string" enum:"ConnectionState"`
+
+	// The maximum number of connections to return.
+	Limit *int64 `min:"1" type:"integer"`
+
+	// A name prefix to filter results returned. Only connections with a name that
+	// starts with the prefix are returned.
+	NamePrefix *string `min:"1" type:"string"`
+
+	// The token returned by a previous call to retrieve the next set of results.
+	NextToken *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListConnectionsInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListConnectionsInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListConnectionsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListConnectionsInput"}
+	if s.Limit != nil && *s.Limit < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+	}
+	if s.NamePrefix != nil && len(*s.NamePrefix) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NamePrefix", 1))
+	}
+	if s.NextToken != nil && len(*s.NextToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetConnectionState sets the ConnectionState field's value.
+func (s *ListConnectionsInput) SetConnectionState(v string) *ListConnectionsInput {
+	s.ConnectionState = &v
+	return s
+}
+
+// SetLimit sets the Limit field's value.
+func (s *ListConnectionsInput) SetLimit(v int64) *ListConnectionsInput {
+	s.Limit = &v
+	return s
+}
+
+// SetNamePrefix sets the NamePrefix field's value.
+func (s *ListConnectionsInput) SetNamePrefix(v string) *ListConnectionsInput {
+	s.NamePrefix = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListConnectionsInput) SetNextToken(v string) *ListConnectionsInput {
+	s.NextToken = &v
+	return s
+}
+
+type ListConnectionsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// An array of connections objects that include details about the connections.
+	Connections []*Connection `type:"list"`
+
+	// A token you can use in a subsequent request to retrieve the next set of results.
+	NextToken *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListConnectionsOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListConnectionsOutput) GoString() string {
+	return s.String()
+}
+
+// SetConnections sets the Connections field's value.
+func (s *ListConnectionsOutput) SetConnections(v []*Connection) *ListConnectionsOutput {
+	s.Connections = v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListConnectionsOutput) SetNextToken(v string) *ListConnectionsOutput {
+	s.NextToken = &v
+	return s
+}
+
+type ListEventBusesInput struct {
+	_ struct{} `type:"structure"`
+
+	// Specifying this limits the number of results returned by this operation.
+	// The operation also returns a NextToken which you can use in a subsequent
+	// operation to retrieve the next set of results.
+	Limit *int64 `min:"1" type:"integer"`
+
+	// Specifying this limits the results to only those event buses with names that
+	// start with the specified prefix.
+	NamePrefix *string `min:"1" type:"string"`
+
+	// The token returned by a previous call to retrieve the next set of results.
+	NextToken *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListEventBusesInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListEventBusesInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListEventBusesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListEventBusesInput"}
+	if s.Limit != nil && *s.Limit < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+	}
+	if s.NamePrefix != nil && len(*s.NamePrefix) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NamePrefix", 1))
+	}
+	if s.NextToken != nil && len(*s.NextToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetLimit sets the Limit field's value.
+func (s *ListEventBusesInput) SetLimit(v int64) *ListEventBusesInput {
+	s.Limit = &v
+	return s
+}
+
+// SetNamePrefix sets the NamePrefix field's value.
+func (s *ListEventBusesInput) SetNamePrefix(v string) *ListEventBusesInput {
+	s.NamePrefix = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListEventBusesInput) SetNextToken(v string) *ListEventBusesInput {
+	s.NextToken = &v
+	return s
+}
+
+type ListEventBusesOutput struct {
+	_ struct{} `type:"structure"`
+
+	// This list of event buses.
+	EventBuses []*EventBus `type:"list"`
+
+	// A token you can use in a subsequent operation to retrieve the next set of
+	// results.
+	NextToken *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListEventBusesOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListEventBusesOutput) GoString() string {
+	return s.String()
+}
+
+// SetEventBuses sets the EventBuses field's value.
+func (s *ListEventBusesOutput) SetEventBuses(v []*EventBus) *ListEventBusesOutput {
+	s.EventBuses = v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListEventBusesOutput) SetNextToken(v string) *ListEventBusesOutput {
+	s.NextToken = &v
+	return s
+}
+
+type ListEventSourcesInput struct {
+	_ struct{} `type:"structure"`
+
+	// Specifying this limits the number of results returned by this operation.
+	// The operation also returns a NextToken which you can use in a subsequent
+	// operation to retrieve the next set of results.
+	Limit *int64 `min:"1" type:"integer"`
+
+	// Specifying this limits the results to only those partner event sources with
+	// names that start with the specified prefix.
+	NamePrefix *string `min:"1" type:"string"`
+
+	// The token returned by a previous call to retrieve the next set of results.
+	NextToken *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListEventSourcesInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListEventSourcesInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListEventSourcesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListEventSourcesInput"}
+	if s.Limit != nil && *s.Limit < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+	}
+	if s.NamePrefix != nil && len(*s.NamePrefix) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NamePrefix", 1))
+	}
+	if s.NextToken != nil && len(*s.NextToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetLimit sets the Limit field's value.
+func (s *ListEventSourcesInput) SetLimit(v int64) *ListEventSourcesInput {
+	s.Limit = &v
+	return s
+}
+
+// SetNamePrefix sets the NamePrefix field's value.
+func (s *ListEventSourcesInput) SetNamePrefix(v string) *ListEventSourcesInput {
+	s.NamePrefix = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListEventSourcesInput) SetNextToken(v string) *ListEventSourcesInput {
+	s.NextToken = &v
+	return s
+}
+
+type ListEventSourcesOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The list of event sources.
+	EventSources []*EventSource `type:"list"`
+
+	// A token you can use in a subsequent operation to retrieve the next set of
+	// results.
+	NextToken *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListEventSourcesOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListEventSourcesOutput) GoString() string {
+	return s.String()
+}
+
+// SetEventSources sets the EventSources field's value.
+func (s *ListEventSourcesOutput) SetEventSources(v []*EventSource) *ListEventSourcesOutput {
+	s.EventSources = v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListEventSourcesOutput) SetNextToken(v string) *ListEventSourcesOutput {
+	s.NextToken = &v
+	return s
+}
+
+type ListPartnerEventSourceAccountsInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the partner event source to display account information about.
+	//
+	// EventSourceName is a required field
+	EventSourceName *string `min:"1" type:"string" required:"true"`
+
+	// Specifying this limits the number of results returned by this operation.
+	// The operation also returns a NextToken which you can use in a subsequent
+	// operation to retrieve the next set of results.
+	Limit *int64 `min:"1" type:"integer"`
+
+	// The token returned by a previous call to this operation. Specifying this
+	// retrieves the next set of results.
+	NextToken *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s AwsVpcConfiguration) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListPartnerEventSourceAccountsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s AwsVpcConfiguration) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListPartnerEventSourceAccountsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *AwsVpcConfiguration) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "AwsVpcConfiguration"}
-	if s.Subnets == nil {
-		invalidParams.Add(request.NewErrParamRequired("Subnets"))
+func (s *ListPartnerEventSourceAccountsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListPartnerEventSourceAccountsInput"}
+	if s.EventSourceName == nil {
+		invalidParams.Add(request.NewErrParamRequired("EventSourceName"))
+	}
+	if s.EventSourceName != nil && len(*s.EventSourceName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EventSourceName", 1))
+	}
+	if s.Limit != nil && *s.Limit < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+	}
+	if s.NextToken != nil && len(*s.NextToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -3089,99 +10922,116 @@ func (s *AwsVpcConfiguration) Validate() error {
 	return nil
 }
 
-// SetAssignPublicIp sets the AssignPublicIp field's value.
-func (s *AwsVpcConfiguration) SetAssignPublicIp(v string) *AwsVpcConfiguration {
-	s.AssignPublicIp = &v
+// SetEventSourceName sets the EventSourceName field's value.
+func (s *ListPartnerEventSourceAccountsInput) SetEventSourceName(v string) *ListPartnerEventSourceAccountsInput {
+	s.EventSourceName = &v
 	return s
 }
 
-// SetSecurityGroups sets the SecurityGroups field's value.
-func (s *AwsVpcConfiguration) SetSecurityGroups(v []*string) *AwsVpcConfiguration {
-	s.SecurityGroups = v
+// SetLimit sets the Limit field's value.
+func (s *ListPartnerEventSourceAccountsInput) SetLimit(v int64) *ListPartnerEventSourceAccountsInput {
+	s.Limit = &v
 	return s
 }
 
-// SetSubnets sets the Subnets field's value.
-func (s *AwsVpcConfiguration) SetSubnets(v []*string) *AwsVpcConfiguration {
-	s.Subnets = v
+// SetNextToken sets the NextToken field's value.
+func (s *ListPartnerEventSourceAccountsInput) SetNextToken(v string) *ListPartnerEventSourceAccountsInput {
+	s.NextToken = &v
 	return s
 }
 
-// The array properties for the submitted job, such as the size of the array.
-// The array size can be between 2 and 10,000. If you specify array properties
-// for a job, it becomes an array job. This parameter is used only if the target
-// is an AWS Batch job.
-type BatchArrayProperties struct {
+type ListPartnerEventSourceAccountsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The size of the array, if this is an array batch job. Valid values are integers
-	// between 2 and 10,000.
-	Size *int64 `type:"integer"`
+	// A token you can use in a subsequent operation to retrieve the next set of
+	// results.
+	NextToken *string `min:"1" type:"string"`
+
+	// The list of partner event sources returned by the operation.
+	PartnerEventSourceAccounts []*PartnerEventSourceAccount `type:"list"`
 }
 
-// String returns the string representation
-func (s BatchArrayProperties) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListPartnerEventSourceAccountsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s BatchArrayProperties) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListPartnerEventSourceAccountsOutput) GoString() string {
 	return s.String()
 }
 
-// SetSize sets the Size field's value.
-func (s *BatchArrayProperties) SetSize(v int64) *BatchArrayProperties {
-	s.Size = &v
+// SetNextToken sets the NextToken field's value.
+func (s *ListPartnerEventSourceAccountsOutput) SetNextToken(v string) *ListPartnerEventSourceAccountsOutput {
+	s.NextToken = &v
 	return s
 }
 
-// The custom parameters to be used when the target is an AWS Batch job.
-type BatchParameters struct {
-	_ struct{} `type:"structure"`
+// SetPartnerEventSourceAccounts sets the PartnerEventSourceAccounts field's value.
+func (s *ListPartnerEventSourceAccountsOutput) SetPartnerEventSourceAccounts(v []*PartnerEventSourceAccount) *ListPartnerEventSourceAccountsOutput {
+	s.PartnerEventSourceAccounts = v
+	return s
+}
 
-	// The array properties for the submitted job, such as the size of the array.
-	// The array size can be between 2 and 10,000. If you specify array properties
-	// for a job, it becomes an array job. This parameter is used only if the target
-	// is an AWS Batch job.
-	ArrayProperties *BatchArrayProperties `type:"structure"`
+type ListPartnerEventSourcesInput struct {
+	_ struct{} `type:"structure"`
 
-	// The ARN or name of the job definition to use if the event target is an AWS
-	// Batch job. This job definition must already exist.
-	//
-	// JobDefinition is a required field
-	JobDefinition *string `type:"string" required:"true"`
+	// pecifying this limits the number of results returned by this operation. The
+	// operation also returns a NextToken which you can use in a subsequent operation
+	// to retrieve the next set of results.
+	Limit *int64 `min:"1" type:"integer"`
 
-	// The name to use for this execution of the job, if the target is an AWS Batch
-	// job.
+	// If you specify this, the results are limited to only those partner event
+	// sources that start with the string you specify.
 	//
-	// JobName is a required field
-	JobName *string `type:"string" required:"true"`
+	// NamePrefix is a required field
+	NamePrefix *string `min:"1" type:"string" required:"true"`
 
-	// The retry strategy to use for failed jobs if the target is an AWS Batch job.
-	// The retry strategy is the number of times to retry the failed job execution.
-	// Valid values are 1–10. When you specify a retry strategy here, it overrides
-	// the retry strategy defined in the job definition.
-	RetryStrategy *BatchRetryStrategy `type:"structure"`
+	// The token returned by a previous call to this operation. Specifying this
+	// retrieves the next set of results.
+	NextToken *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s BatchParameters) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListPartnerEventSourcesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s BatchParameters) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListPartnerEventSourcesInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *BatchParameters) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "BatchParameters"}
-	if s.JobDefinition == nil {
-		invalidParams.Add(request.NewErrParamRequired("JobDefinition"))
+func (s *ListPartnerEventSourcesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListPartnerEventSourcesInput"}
+	if s.Limit != nil && *s.Limit < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
 	}
-	if s.JobName == nil {
-		invalidParams.Add(request.NewErrParamRequired("JobName"))
+	if s.NamePrefix == nil {
+		invalidParams.Add(request.NewErrParamRequired("NamePrefix"))
+	}
+	if s.NamePrefix != nil && len(*s.NamePrefix) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NamePrefix", 1))
+	}
+	if s.NextToken != nil && len(*s.NextToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -3190,104 +11040,117 @@ func (s *BatchParameters) Validate() error {
 	return nil
 }
 
-// SetArrayProperties sets the ArrayProperties field's value.
-func (s *BatchParameters) SetArrayProperties(v *BatchArrayProperties) *BatchParameters {
-	s.ArrayProperties = v
-	return s
-}
-
-// SetJobDefinition sets the JobDefinition field's value.
-func (s *BatchParameters) SetJobDefinition(v string) *BatchParameters {
-	s.JobDefinition = &v
+// SetLimit sets the Limit field's value.
+func (s *ListPartnerEventSourcesInput) SetLimit(v int64) *ListPartnerEventSourcesInput {
+	s.Limit = &v
 	return s
 }
 
-// SetJobName sets the JobName field's value.
-func (s *BatchParameters) SetJobName(v string) *BatchParameters {
-	s.JobName = &v
+// SetNamePrefix sets the NamePrefix field's value.
+func (s *ListPartnerEventSourcesInput) SetNamePrefix(v string) *ListPartnerEventSourcesInput {
+	s.NamePrefix = &v
 	return s
 }
 
-// SetRetryStrategy sets the RetryStrategy field's value.
-func (s *BatchParameters) SetRetryStrategy(v *BatchRetryStrategy) *BatchParameters {
-	s.RetryStrategy = v
+// SetNextToken sets the NextToken field's value.
+func (s *ListPartnerEventSourcesInput) SetNextToken(v string) *ListPartnerEventSourcesInput {
+	s.NextToken = &v
 	return s
 }
 
-// The retry strategy to use for failed jobs if the target is an AWS Batch job.
-// If you specify a retry strategy here, it overrides the retry strategy defined
-// in the job definition.
-type BatchRetryStrategy struct {
+type ListPartnerEventSourcesOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The number of times to attempt to retry, if the job fails. Valid values are
-	// 1–10.
-	Attempts *int64 `type:"integer"`
+	// A token you can use in a subsequent operation to retrieve the next set of
+	// results.
+	NextToken *string `min:"1" type:"string"`
+
+	// The list of partner event sources returned by the operation.
+	PartnerEventSources []*PartnerEventSource `type:"list"`
 }
 
-// String returns the string representation
-func (s BatchRetryStrategy) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListPartnerEventSourcesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s BatchRetryStrategy) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListPartnerEventSourcesOutput) GoString() string {
 	return s.String()
 }
 
-// SetAttempts sets the Attempts field's value.
-func (s *BatchRetryStrategy) SetAttempts(v int64) *BatchRetryStrategy {
-	s.Attempts = &v
+// SetNextToken sets the NextToken field's value.
+func (s *ListPartnerEventSourcesOutput) SetNextToken(v string) *ListPartnerEventSourcesOutput {
+	s.NextToken = &v
 	return s
 }
 
-// A JSON string that you can use to limit the event bus permissions that you're
-// granting to only accounts that fulfill the condition. Currently, the only
-// supported condition is membership in a certain AWS organization. The string
-// must contain Type, Key, and Value fields. The Value field specifies the ID
-// of the AWS organization. The following is an example value for Condition:
-//
-// '{"Type" : "StringEquals", "Key": "aws:PrincipalOrgID", "Value": "o-1234567890"}'
-type Condition struct {
+// SetPartnerEventSources sets the PartnerEventSources field's value.
+func (s *ListPartnerEventSourcesOutput) SetPartnerEventSources(v []*PartnerEventSource) *ListPartnerEventSourcesOutput {
+	s.PartnerEventSources = v
+	return s
+}
+
+type ListReplaysInput struct {
 	_ struct{} `type:"structure"`
 
-	// The key for the condition. Currently, the only supported key is aws:PrincipalOrgID.
-	//
-	// Key is a required field
-	Key *string `type:"string" required:"true"`
+	// The ARN of the archive from which the events are replayed.
+	EventSourceArn *string `min:"1" type:"string"`
 
-	// The type of condition. Currently, the only supported value is StringEquals.
-	//
-	// Type is a required field
-	Type *string `type:"string" required:"true"`
+	// The maximum number of replays to retrieve.
+	Limit *int64 `min:"1" type:"integer"`
 
-	// The value for the key. Currently, this must be the ID of the organization.
-	//
-	// Value is a required field
-	Value *string `type:"string" required:"true"`
+	// A name prefix to filter the replays returned. Only replays with name that
+	// match the prefix are returned.
+	NamePrefix *string `min:"1" type:"string"`
+
+	// The token returned by a previous call to retrieve the next set of results.
+	NextToken *string `min:"1" type:"string"`
+
+	// The state of the replay.
+	State *string `type:"string" enum:"ReplayState"`
 }
 
-// String returns the string representation
-func (s Condition) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListReplaysInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Condition) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListReplaysInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *Condition) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "Condition"}
-	if s.Key == nil {
-		invalidParams.Add(request.NewErrParamRequired("Key"))
+func (s *ListReplaysInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListReplaysInput"}
+	if s.EventSourceArn != nil && len(*s.EventSourceArn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EventSourceArn", 1))
 	}
-	if s.Type == nil {
-		invalidParams.Add(request.NewErrParamRequired("Type"))
+	if s.Limit != nil && *s.Limit < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
 	}
-	if s.Value == nil {
-		invalidParams.Add(request.NewErrParamRequired("Value"))
+	if s.NamePrefix != nil && len(*s.NamePrefix) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NamePrefix", 1))
+	}
+	if s.NextToken != nil && len(*s.NextToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -3296,151 +11159,130 @@ func (s *Condition) Validate() error {
 	return nil
 }
 
-// SetKey sets the Key field's value.
-func (s *Condition) SetKey(v string) *Condition {
-	s.Key = &v
+// SetEventSourceArn sets the EventSourceArn field's value.
+func (s *ListReplaysInput) SetEventSourceArn(v string) *ListReplaysInput {
+	s.EventSourceArn = &v
 	return s
 }
 
-// SetType sets the Type field's value.
-func (s *Condition) SetType(v string) *Condition {
-	s.Type = &v
+// SetLimit sets the Limit field's value.
+func (s *ListReplaysInput) SetLimit(v int64) *ListReplaysInput {
+	s.Limit = &v
 	return s
 }
 
-// SetValue sets the Value field's value.
-func (s *Condition) SetValue(v string) *Condition {
-	s.Value = &v
+// SetNamePrefix sets the NamePrefix field's value.
+func (s *ListReplaysInput) SetNamePrefix(v string) *ListReplaysInput {
+	s.NamePrefix = &v
 	return s
 }
 
-type CreateEventBusInput struct {
+// SetNextToken sets the NextToken field's value.
+func (s *ListReplaysInput) SetNextToken(v string) *ListReplaysInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetState sets the State field's value.
+func (s *ListReplaysInput) SetState(v string) *ListReplaysInput {
+	s.State = &v
+	return s
+}
+
+type ListReplaysOutput struct {
 	_ struct{} `type:"structure"`
 
-	// If you're creating a partner event bus, this specifies the partner event
-	// source that the new event bus will be matched with.
-	EventSourceName *string `min:"1" type:"string"`
+	// The token returned by a previous call to retrieve the next set of results.
+	NextToken *string `min:"1" type:"string"`
 
-	// The name of the new event bus.
-	//
-	// The names of custom event buses can't contain the / character. You can't
-	// use the name default for a custom event bus because this name is already
-	// used for your account's default event bus.
-	//
-	// If this is a partner event bus, the name must exactly match the name of the
-	// partner event source that this event bus is matched to. This name will include
-	// the / character.
-	//
-	// Name is a required field
-	Name *string `min:"1" type:"string" required:"true"`
+	// An array of Replay objects that contain information about the replay.
+	Replays []*Replay `type:"list"`
 }
 
-// String returns the string representation
-func (s CreateEventBusInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListReplaysOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateEventBusInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListReplaysOutput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateEventBusInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateEventBusInput"}
-	if s.EventSourceName != nil && len(*s.EventSourceName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("EventSourceName", 1))
-	}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
-	}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
-// SetEventSourceName sets the EventSourceName field's value.
-func (s *CreateEventBusInput) SetEventSourceName(v string) *CreateEventBusInput {
-	s.EventSourceName = &v
+// SetNextToken sets the NextToken field's value.
+func (s *ListReplaysOutput) SetNextToken(v string) *ListReplaysOutput {
+	s.NextToken = &v
 	return s
 }
 
-// SetName sets the Name field's value.
-func (s *CreateEventBusInput) SetName(v string) *CreateEventBusInput {
-	s.Name = &v
+// SetReplays sets the Replays field's value.
+func (s *ListReplaysOutput) SetReplays(v []*Replay) *ListReplaysOutput {
+	s.Replays = v
 	return s
 }
 
-type CreateEventBusOutput struct {
+type ListRuleNamesByTargetInput struct {
 	_ struct{} `type:"structure"`
 
-	// The ARN of the new event bus.
-	EventBusArn *string `type:"string"`
-}
-
-// String returns the string representation
-func (s CreateEventBusOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s CreateEventBusOutput) GoString() string {
-	return s.String()
-}
-
-// SetEventBusArn sets the EventBusArn field's value.
-func (s *CreateEventBusOutput) SetEventBusArn(v string) *CreateEventBusOutput {
-	s.EventBusArn = &v
-	return s
-}
+	// The name or ARN of the event bus to list rules for. If you omit this, the
+	// default event bus is used.
+	EventBusName *string `min:"1" type:"string"`
 
-type CreatePartnerEventSourceInput struct {
-	_ struct{} `type:"structure"`
+	// The maximum number of results to return.
+	Limit *int64 `min:"1" type:"integer"`
 
-	// The AWS account ID of the customer who is permitted to create a matching
-	// partner event bus for this partner event source.
-	//
-	// Account is a required field
-	Account *string `min:"12" type:"string" required:"true"`
+	// The token returned by a previous call to retrieve the next set of results.
+	NextToken *string `min:"1" type:"string"`
 
-	// The name of the partner event source. This name must be unique and must be
-	// in the format partner_name/event_namespace/event_name . The AWS account that
-	// wants to use this partner event source must create a partner event bus with
-	// a name that matches the name of the partner event source.
+	// The Amazon Resource Name (ARN) of the target resource.
 	//
-	// Name is a required field
-	Name *string `min:"1" type:"string" required:"true"`
+	// TargetArn is a required field
+	TargetArn *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreatePartnerEventSourceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListRuleNamesByTargetInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreatePartnerEventSourceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListRuleNamesByTargetInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreatePartnerEventSourceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreatePartnerEventSourceInput"}
-	if s.Account == nil {
-		invalidParams.Add(request.NewErrParamRequired("Account"))
+func (s *ListRuleNamesByTargetInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListRuleNamesByTargetInput"}
+	if s.EventBusName != nil && len(*s.EventBusName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EventBusName", 1))
 	}
-	if s.Account != nil && len(*s.Account) < 12 {
-		invalidParams.Add(request.NewErrParamMinLen("Account", 12))
+	if s.Limit != nil && *s.Limit < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
 	}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
+	if s.NextToken != nil && len(*s.NextToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
 	}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	if s.TargetArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("TargetArn"))
+	}
+	if s.TargetArn != nil && len(*s.TargetArn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("TargetArn", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -3449,68 +11291,120 @@ func (s *CreatePartnerEventSourceInput) Validate() error {
 	return nil
 }
 
-// SetAccount sets the Account field's value.
-func (s *CreatePartnerEventSourceInput) SetAccount(v string) *CreatePartnerEventSourceInput {
-	s.Account = &v
+// SetEventBusName sets the EventBusName field's value.
+func (s *ListRuleNamesByTargetInput) SetEventBusName(v string) *ListRuleNamesByTargetInput {
+	s.EventBusName = &v
 	return s
 }
 
-// SetName sets the Name field's value.
-func (s *CreatePartnerEventSourceInput) SetName(v string) *CreatePartnerEventSourceInput {
-	s.Name = &v
+// SetLimit sets the Limit field's value.
+func (s *ListRuleNamesByTargetInput) SetLimit(v int64) *ListRuleNamesByTargetInput {
+	s.Limit = &v
 	return s
 }
 
-type CreatePartnerEventSourceOutput struct {
+// SetNextToken sets the NextToken field's value.
+func (s *ListRuleNamesByTargetInput) SetNextToken(v string) *ListRuleNamesByTargetInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetTargetArn sets the TargetArn field's value.
+func (s *ListRuleNamesByTargetInput) SetTargetArn(v string) *ListRuleNamesByTargetInput {
+	s.TargetArn = &v
+	return s
+}
+
+type ListRuleNamesByTargetOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The ARN of the partner event source.
-	EventSourceArn *string `type:"string"`
+	// Indicates whether there are additional results to retrieve. If there are
+	// no more results, the value is null.
+	NextToken *string `min:"1" type:"string"`
+
+	// The names of the rules that can invoke the given target.
+	RuleNames []*string `type:"list"`
 }
 
-// String returns the string representation
-func (s CreatePartnerEventSourceOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListRuleNamesByTargetOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreatePartnerEventSourceOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListRuleNamesByTargetOutput) GoString() string {
 	return s.String()
 }
 
-// SetEventSourceArn sets the EventSourceArn field's value.
-func (s *CreatePartnerEventSourceOutput) SetEventSourceArn(v string) *CreatePartnerEventSourceOutput {
-	s.EventSourceArn = &v
+// SetNextToken sets the NextToken field's value.
+func (s *ListRuleNamesByTargetOutput) SetNextToken(v string) *ListRuleNamesByTargetOutput {
+	s.NextToken = &v
 	return s
 }
 
-type DeactivateEventSourceInput struct {
+// SetRuleNames sets the RuleNames field's value.
+func (s *ListRuleNamesByTargetOutput) SetRuleNames(v []*string) *ListRuleNamesByTargetOutput {
+	s.RuleNames = v
+	return s
+}
+
+type ListRulesInput struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the partner event source to deactivate.
-	//
-	// Name is a required field
-	Name *string `min:"1" type:"string" required:"true"`
+	// The name or ARN of the event bus to list the rules for. If you omit this,
+	// the default event bus is used.
+	EventBusName *string `min:"1" type:"string"`
+
+	// The maximum number of results to return.
+	Limit *int64 `min:"1" type:"integer"`
+
+	// The prefix matching the rule name.
+	NamePrefix *string `min:"1" type:"string"`
+
+	// The token returned by a previous call to retrieve the next set of results.
+	NextToken *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s DeactivateEventSourceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListRulesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeactivateEventSourceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListRulesInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeactivateEventSourceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeactivateEventSourceInput"}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
+func (s *ListRulesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListRulesInput"}
+	if s.EventBusName != nil && len(*s.EventBusName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EventBusName", 1))
 	}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	if s.Limit != nil && *s.Limit < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+	}
+	if s.NamePrefix != nil && len(*s.NamePrefix) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NamePrefix", 1))
+	}
+	if s.NextToken != nil && len(*s.NextToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -3519,120 +11413,106 @@ func (s *DeactivateEventSourceInput) Validate() error {
 	return nil
 }
 
-// SetName sets the Name field's value.
-func (s *DeactivateEventSourceInput) SetName(v string) *DeactivateEventSourceInput {
-	s.Name = &v
+// SetEventBusName sets the EventBusName field's value.
+func (s *ListRulesInput) SetEventBusName(v string) *ListRulesInput {
+	s.EventBusName = &v
 	return s
 }
 
-type DeactivateEventSourceOutput struct {
-	_ struct{} `type:"structure"`
+// SetLimit sets the Limit field's value.
+func (s *ListRulesInput) SetLimit(v int64) *ListRulesInput {
+	s.Limit = &v
+	return s
 }
 
-// String returns the string representation
-func (s DeactivateEventSourceOutput) String() string {
-	return awsutil.Prettify(s)
+// SetNamePrefix sets the NamePrefix field's value.
+func (s *ListRulesInput) SetNamePrefix(v string) *ListRulesInput {
+	s.NamePrefix = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s DeactivateEventSourceOutput) GoString() string {
-	return s.String()
+// SetNextToken sets the NextToken field's value.
+func (s *ListRulesInput) SetNextToken(v string) *ListRulesInput {
+	s.NextToken = &v
+	return s
 }
 
-type DeleteEventBusInput struct {
+type ListRulesOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the event bus to delete.
-	//
-	// Name is a required field
-	Name *string `min:"1" type:"string" required:"true"`
+	// Indicates whether there are additional results to retrieve. If there are
+	// no more results, the value is null.
+	NextToken *string `min:"1" type:"string"`
+
+	// The rules that match the specified criteria.
+	Rules []*Rule `type:"list"`
 }
 
-// String returns the string representation
-func (s DeleteEventBusInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListRulesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteEventBusInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListRulesOutput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteEventBusInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteEventBusInput"}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
-	}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
-// SetName sets the Name field's value.
-func (s *DeleteEventBusInput) SetName(v string) *DeleteEventBusInput {
-	s.Name = &v
+// SetNextToken sets the NextToken field's value.
+func (s *ListRulesOutput) SetNextToken(v string) *ListRulesOutput {
+	s.NextToken = &v
 	return s
 }
 
-type DeleteEventBusOutput struct {
-	_ struct{} `type:"structure"`
-}
-
-// String returns the string representation
-func (s DeleteEventBusOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s DeleteEventBusOutput) GoString() string {
-	return s.String()
+// SetRules sets the Rules field's value.
+func (s *ListRulesOutput) SetRules(v []*Rule) *ListRulesOutput {
+	s.Rules = v
+	return s
 }
 
-type DeletePartnerEventSourceInput struct {
+type ListTagsForResourceInput struct {
 	_ struct{} `type:"structure"`
 
-	// The AWS account ID of the AWS customer that the event source was created
-	// for.
-	//
-	// Account is a required field
-	Account *string `min:"12" type:"string" required:"true"`
-
-	// The name of the event source to delete.
+	// The ARN of the EventBridge resource for which you want to view tags.
 	//
-	// Name is a required field
-	Name *string `min:"1" type:"string" required:"true"`
+	// ResourceARN is a required field
+	ResourceARN *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DeletePartnerEventSourceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeletePartnerEventSourceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeletePartnerEventSourceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeletePartnerEventSourceInput"}
-	if s.Account == nil {
-		invalidParams.Add(request.NewErrParamRequired("Account"))
-	}
-	if s.Account != nil && len(*s.Account) < 12 {
-		invalidParams.Add(request.NewErrParamMinLen("Account", 12))
-	}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
+func (s *ListTagsForResourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsForResourceInput"}
+	if s.ResourceARN == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceARN"))
 	}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	if s.ResourceARN != nil && len(*s.ResourceARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ResourceARN", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -3641,73 +11521,97 @@ func (s *DeletePartnerEventSourceInput) Validate() error {
 	return nil
 }
 
-// SetAccount sets the Account field's value.
-func (s *DeletePartnerEventSourceInput) SetAccount(v string) *DeletePartnerEventSourceInput {
-	s.Account = &v
-	return s
-}
-
-// SetName sets the Name field's value.
-func (s *DeletePartnerEventSourceInput) SetName(v string) *DeletePartnerEventSourceInput {
-	s.Name = &v
+// SetResourceARN sets the ResourceARN field's value.
+func (s *ListTagsForResourceInput) SetResourceARN(v string) *ListTagsForResourceInput {
+	s.ResourceARN = &v
 	return s
 }
 
-type DeletePartnerEventSourceOutput struct {
+type ListTagsForResourceOutput struct {
 	_ struct{} `type:"structure"`
+
+	// The list of tag keys and values associated with the resource you specified
+	Tags []*Tag `type:"list"`
 }
 
-// String returns the string representation
-func (s DeletePartnerEventSourceOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeletePartnerEventSourceOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceOutput) GoString() string {
 	return s.String()
 }
 
-type DeleteRuleInput struct {
+// SetTags sets the Tags field's value.
+func (s *ListTagsForResourceOutput) SetTags(v []*Tag) *ListTagsForResourceOutput {
+	s.Tags = v
+	return s
+}
+
+type ListTargetsByRuleInput struct {
 	_ struct{} `type:"structure"`
 
-	// The event bus associated with the rule. If you omit this, the default event
-	// bus is used.
+	// The name or ARN of the event bus associated with the rule. If you omit this,
+	// the default event bus is used.
 	EventBusName *string `min:"1" type:"string"`
 
-	// If this is a managed rule, created by an AWS service on your behalf, you
-	// must specify Force as True to delete the rule. This parameter is ignored
-	// for rules that are not managed rules. You can check whether a rule is a managed
-	// rule by using DescribeRule or ListRules and checking the ManagedBy field
-	// of the response.
-	Force *bool `type:"boolean"`
+	// The maximum number of results to return.
+	Limit *int64 `min:"1" type:"integer"`
+
+	// The token returned by a previous call to retrieve the next set of results.
+	NextToken *string `min:"1" type:"string"`
 
 	// The name of the rule.
 	//
-	// Name is a required field
-	Name *string `min:"1" type:"string" required:"true"`
+	// Rule is a required field
+	Rule *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteRuleInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTargetsByRuleInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteRuleInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTargetsByRuleInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteRuleInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteRuleInput"}
+func (s *ListTargetsByRuleInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTargetsByRuleInput"}
 	if s.EventBusName != nil && len(*s.EventBusName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("EventBusName", 1))
 	}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
+	if s.Limit != nil && *s.Limit < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
 	}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	if s.NextToken != nil && len(*s.NextToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	}
+	if s.Rule == nil {
+		invalidParams.Add(request.NewErrParamRequired("Rule"))
+	}
+	if s.Rule != nil && len(*s.Rule) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Rule", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -3717,488 +11621,692 @@ func (s *DeleteRuleInput) Validate() error {
 }
 
 // SetEventBusName sets the EventBusName field's value.
-func (s *DeleteRuleInput) SetEventBusName(v string) *DeleteRuleInput {
+func (s *ListTargetsByRuleInput) SetEventBusName(v string) *ListTargetsByRuleInput {
 	s.EventBusName = &v
 	return s
 }
 
-// SetForce sets the Force field's value.
-func (s *DeleteRuleInput) SetForce(v bool) *DeleteRuleInput {
-	s.Force = &v
+// SetLimit sets the Limit field's value.
+func (s *ListTargetsByRuleInput) SetLimit(v int64) *ListTargetsByRuleInput {
+	s.Limit = &v
 	return s
 }
 
-// SetName sets the Name field's value.
-func (s *DeleteRuleInput) SetName(v string) *DeleteRuleInput {
-	s.Name = &v
+// SetNextToken sets the NextToken field's value.
+func (s *ListTargetsByRuleInput) SetNextToken(v string) *ListTargetsByRuleInput {
+	s.NextToken = &v
 	return s
 }
 
-type DeleteRuleOutput struct {
+// SetRule sets the Rule field's value.
+func (s *ListTargetsByRuleInput) SetRule(v string) *ListTargetsByRuleInput {
+	s.Rule = &v
+	return s
+}
+
+type ListTargetsByRuleOutput struct {
 	_ struct{} `type:"structure"`
+
+	// Indicates whether there are additional results to retrieve. If there are
+	// no more results, the value is null.
+	NextToken *string `min:"1" type:"string"`
+
+	// The targets assigned to the rule.
+	Targets []*Target `min:"1" type:"list"`
 }
 
-// String returns the string representation
-func (s DeleteRuleOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTargetsByRuleOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteRuleOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTargetsByRuleOutput) GoString() string {
 	return s.String()
 }
 
-type DescribeEventBusInput struct {
-	_ struct{} `type:"structure"`
+// SetNextToken sets the NextToken field's value.
+func (s *ListTargetsByRuleOutput) SetNextToken(v string) *ListTargetsByRuleOutput {
+	s.NextToken = &v
+	return s
+}
 
-	// The name of the event bus to show details for. If you omit this, the default
-	// event bus is displayed.
-	Name *string `min:"1" type:"string"`
+// SetTargets sets the Targets field's value.
+func (s *ListTargetsByRuleOutput) SetTargets(v []*Target) *ListTargetsByRuleOutput {
+	s.Targets = v
+	return s
 }
 
-// String returns the string representation
-func (s DescribeEventBusInput) String() string {
+// This rule was created by an Amazon Web Services service on behalf of your
+// account. It is managed by that service. If you see this error in response
+// to DeleteRule or RemoveTargets, you can use the Force parameter in those
+// calls to delete the rule or remove targets from the rule. You cannot modify
+// these managed rules by using DisableRule, EnableRule, PutTargets, PutRule,
+// TagResource, or UntagResource.
+type ManagedRuleException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ManagedRuleException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeEventBusInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ManagedRuleException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DescribeEventBusInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DescribeEventBusInput"}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+func newErrorManagedRuleException(v protocol.ResponseMetadata) error {
+	return &ManagedRuleException{
+		RespMetadata: v,
 	}
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
+// Code returns the exception type name.
+func (s *ManagedRuleException) Code() string {
+	return "ManagedRuleException"
+}
+
+// Message returns the exception's message.
+func (s *ManagedRuleException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ManagedRuleException) OrigErr() error {
 	return nil
 }
 
-// SetName sets the Name field's value.
-func (s *DescribeEventBusInput) SetName(v string) *DescribeEventBusInput {
-	s.Name = &v
-	return s
+func (s *ManagedRuleException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-type DescribeEventBusOutput struct {
-	_ struct{} `type:"structure"`
+// Status code returns the HTTP status code for the request's response error.
+func (s *ManagedRuleException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
 
-	// The Amazon Resource Name (ARN) of the account permitted to write events to
-	// the current account.
-	Arn *string `type:"string"`
+// RequestID returns the service's response RequestID for request.
+func (s *ManagedRuleException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// The name of the event bus. Currently, this is always default.
-	Name *string `type:"string"`
+// This structure specifies the network configuration for an ECS task.
+type NetworkConfiguration struct {
+	_ struct{} `type:"structure"`
 
-	// The policy that enables the external account to send events to your account.
-	Policy *string `type:"string"`
+	// Use this structure to specify the VPC subnets and security groups for the
+	// task, and whether a public IP address is to be used. This structure is relevant
+	// only for ECS tasks that use the awsvpc network mode.
+	AwsvpcConfiguration *AwsVpcConfiguration `locationName:"awsvpcConfiguration" type:"structure"`
 }
 
-// String returns the string representation
-func (s DescribeEventBusOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NetworkConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeEventBusOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NetworkConfiguration) GoString() string {
 	return s.String()
 }
 
-// SetArn sets the Arn field's value.
-func (s *DescribeEventBusOutput) SetArn(v string) *DescribeEventBusOutput {
-	s.Arn = &v
-	return s
-}
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *NetworkConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "NetworkConfiguration"}
+	if s.AwsvpcConfiguration != nil {
+		if err := s.AwsvpcConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("AwsvpcConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
 
-// SetName sets the Name field's value.
-func (s *DescribeEventBusOutput) SetName(v string) *DescribeEventBusOutput {
-	s.Name = &v
-	return s
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetPolicy sets the Policy field's value.
-func (s *DescribeEventBusOutput) SetPolicy(v string) *DescribeEventBusOutput {
-	s.Policy = &v
+// SetAwsvpcConfiguration sets the AwsvpcConfiguration field's value.
+func (s *NetworkConfiguration) SetAwsvpcConfiguration(v *AwsVpcConfiguration) *NetworkConfiguration {
+	s.AwsvpcConfiguration = v
 	return s
 }
 
-type DescribeEventSourceInput struct {
-	_ struct{} `type:"structure"`
+// The operation you are attempting is not available in this region.
+type OperationDisabledException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The name of the partner event source to display the details of.
-	//
-	// Name is a required field
-	Name *string `min:"1" type:"string" required:"true"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s DescribeEventSourceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s OperationDisabledException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeEventSourceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s OperationDisabledException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DescribeEventSourceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DescribeEventSourceInput"}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
-	}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+func newErrorOperationDisabledException(v protocol.ResponseMetadata) error {
+	return &OperationDisabledException{
+		RespMetadata: v,
 	}
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
+// Code returns the exception type name.
+func (s *OperationDisabledException) Code() string {
+	return "OperationDisabledException"
+}
+
+// Message returns the exception's message.
+func (s *OperationDisabledException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *OperationDisabledException) OrigErr() error {
 	return nil
 }
 
-// SetName sets the Name field's value.
-func (s *DescribeEventSourceInput) SetName(v string) *DescribeEventSourceInput {
-	s.Name = &v
-	return s
+func (s *OperationDisabledException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-type DescribeEventSourceOutput struct {
+// Status code returns the HTTP status code for the request's response error.
+func (s *OperationDisabledException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *OperationDisabledException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// A partner event source is created by an SaaS partner. If a customer creates
+// a partner event bus that matches this event source, that Amazon Web Services
+// account can receive events from the partner's applications or services.
+type PartnerEventSource struct {
 	_ struct{} `type:"structure"`
 
 	// The ARN of the partner event source.
 	Arn *string `type:"string"`
 
-	// The name of the SaaS partner that created the event source.
-	CreatedBy *string `type:"string"`
-
-	// The date and time that the event source was created.
-	CreationTime *time.Time `type:"timestamp"`
-
-	// The date and time that the event source will expire if you don't create a
-	// matching event bus.
-	ExpirationTime *time.Time `type:"timestamp"`
-
 	// The name of the partner event source.
 	Name *string `type:"string"`
-
-	// The state of the event source. If it's ACTIVE, you have already created a
-	// matching event bus for this event source, and that event bus is active. If
-	// it's PENDING, either you haven't yet created a matching event bus, or that
-	// event bus is deactivated. If it's DELETED, you have created a matching event
-	// bus, but the event source has since been deleted.
-	State *string `type:"string" enum:"EventSourceState"`
 }
 
-// String returns the string representation
-func (s DescribeEventSourceOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PartnerEventSource) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeEventSourceOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PartnerEventSource) GoString() string {
 	return s.String()
 }
 
 // SetArn sets the Arn field's value.
-func (s *DescribeEventSourceOutput) SetArn(v string) *DescribeEventSourceOutput {
+func (s *PartnerEventSource) SetArn(v string) *PartnerEventSource {
 	s.Arn = &v
 	return s
 }
 
-// SetCreatedBy sets the CreatedBy field's value.
-func (s *DescribeEventSourceOutput) SetCreatedBy(v string) *DescribeEventSourceOutput {
-	s.CreatedBy = &v
+// SetName sets the Name field's value.
+func (s *PartnerEventSource) SetName(v string) *PartnerEventSource {
+	s.Name = &v
+	return s
+}
+
+// The Amazon Web Services account that a partner event source has been offered
+// to.
+type PartnerEventSourceAccount struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Web Services account ID that the partner event source was offered
+	// to.
+	Account *string `min:"12" type:"string"`
+
+	// The date and time the event source was created.
+	CreationTime *time.Time `type:"timestamp"`
+
+	// The date and time that the event source will expire, if the Amazon Web Services
+	// account doesn't create a matching event bus for it.
+	ExpirationTime *time.Time `type:"timestamp"`
+
+	// The state of the event source. If it is ACTIVE, you have already created
+	// a matching event bus for this event source, and that event bus is active.
+	// If it is PENDING, either you haven't yet created a matching event bus, or
+	// that event bus is deactivated. If it is DELETED, you have created a matching
+	// event bus, but the event source has since been deleted.
+	State *string `type:"string" enum:"EventSourceState"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PartnerEventSourceAccount) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PartnerEventSourceAccount) GoString() string {
+	return s.String()
+}
+
+// SetAccount sets the Account field's value.
+func (s *PartnerEventSourceAccount) SetAccount(v string) *PartnerEventSourceAccount {
+	s.Account = &v
 	return s
 }
 
 // SetCreationTime sets the CreationTime field's value.
-func (s *DescribeEventSourceOutput) SetCreationTime(v time.Time) *DescribeEventSourceOutput {
+func (s *PartnerEventSourceAccount) SetCreationTime(v time.Time) *PartnerEventSourceAccount {
 	s.CreationTime = &v
 	return s
 }
 
 // SetExpirationTime sets the ExpirationTime field's value.
-func (s *DescribeEventSourceOutput) SetExpirationTime(v time.Time) *DescribeEventSourceOutput {
+func (s *PartnerEventSourceAccount) SetExpirationTime(v time.Time) *PartnerEventSourceAccount {
 	s.ExpirationTime = &v
 	return s
 }
 
-// SetName sets the Name field's value.
-func (s *DescribeEventSourceOutput) SetName(v string) *DescribeEventSourceOutput {
-	s.Name = &v
-	return s
-}
-
 // SetState sets the State field's value.
-func (s *DescribeEventSourceOutput) SetState(v string) *DescribeEventSourceOutput {
+func (s *PartnerEventSourceAccount) SetState(v string) *PartnerEventSourceAccount {
 	s.State = &v
 	return s
 }
 
-type DescribePartnerEventSourceInput struct {
+// An object representing a constraint on task placement. To learn more, see
+// Task Placement Constraints (https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task-placement-constraints.html)
+// in the Amazon Elastic Container Service Developer Guide.
+type PlacementConstraint struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the event source to display.
-	//
-	// Name is a required field
-	Name *string `min:"1" type:"string" required:"true"`
+	// A cluster query language expression to apply to the constraint. You cannot
+	// specify an expression if the constraint type is distinctInstance. To learn
+	// more, see Cluster Query Language (https://docs.aws.amazon.com/AmazonECS/latest/developerguide/cluster-query-language.html)
+	// in the Amazon Elastic Container Service Developer Guide.
+	Expression *string `locationName:"expression" type:"string"`
+
+	// The type of constraint. Use distinctInstance to ensure that each task in
+	// a particular group is running on a different container instance. Use memberOf
+	// to restrict the selection to a group of valid candidates.
+	Type *string `locationName:"type" type:"string" enum:"PlacementConstraintType"`
 }
 
-// String returns the string representation
-func (s DescribePartnerEventSourceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PlacementConstraint) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribePartnerEventSourceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PlacementConstraint) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DescribePartnerEventSourceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DescribePartnerEventSourceInput"}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
-	}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetExpression sets the Expression field's value.
+func (s *PlacementConstraint) SetExpression(v string) *PlacementConstraint {
+	s.Expression = &v
+	return s
 }
 
-// SetName sets the Name field's value.
-func (s *DescribePartnerEventSourceInput) SetName(v string) *DescribePartnerEventSourceInput {
-	s.Name = &v
+// SetType sets the Type field's value.
+func (s *PlacementConstraint) SetType(v string) *PlacementConstraint {
+	s.Type = &v
 	return s
 }
 
-type DescribePartnerEventSourceOutput struct {
+// The task placement strategy for a task or service. To learn more, see Task
+// Placement Strategies (https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task-placement-strategies.html)
+// in the Amazon Elastic Container Service Service Developer Guide.
+type PlacementStrategy struct {
 	_ struct{} `type:"structure"`
 
-	// The ARN of the event source.
-	Arn *string `type:"string"`
-
-	// The name of the event source.
-	Name *string `type:"string"`
-}
-
-// String returns the string representation
-func (s DescribePartnerEventSourceOutput) String() string {
+	// The field to apply the placement strategy against. For the spread placement
+	// strategy, valid values are instanceId (or host, which has the same effect),
+	// or any platform or custom attribute that is applied to a container instance,
+	// such as attribute:ecs.availability-zone. For the binpack placement strategy,
+	// valid values are cpu and memory. For the random placement strategy, this
+	// field is not used.
+	Field *string `locationName:"field" type:"string"`
+
+	// The type of placement strategy. The random placement strategy randomly places
+	// tasks on available candidates. The spread placement strategy spreads placement
+	// across available candidates evenly based on the field parameter. The binpack
+	// strategy places tasks on available candidates that have the least available
+	// amount of the resource that is specified with the field parameter. For example,
+	// if you binpack on memory, a task is placed on the instance with the least
+	// amount of remaining memory (but still enough to run the task).
+	Type *string `locationName:"type" type:"string" enum:"PlacementStrategyType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PlacementStrategy) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribePartnerEventSourceOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PlacementStrategy) GoString() string {
 	return s.String()
 }
 
-// SetArn sets the Arn field's value.
-func (s *DescribePartnerEventSourceOutput) SetArn(v string) *DescribePartnerEventSourceOutput {
-	s.Arn = &v
+// SetField sets the Field field's value.
+func (s *PlacementStrategy) SetField(v string) *PlacementStrategy {
+	s.Field = &v
 	return s
 }
 
-// SetName sets the Name field's value.
-func (s *DescribePartnerEventSourceOutput) SetName(v string) *DescribePartnerEventSourceOutput {
-	s.Name = &v
+// SetType sets the Type field's value.
+func (s *PlacementStrategy) SetType(v string) *PlacementStrategy {
+	s.Type = &v
 	return s
 }
 
-type DescribeRuleInput struct {
-	_ struct{} `type:"structure"`
-
-	// The event bus associated with the rule. If you omit this, the default event
-	// bus is used.
-	EventBusName *string `min:"1" type:"string"`
+// The event bus policy is too long. For more information, see the limits.
+type PolicyLengthExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The name of the rule.
-	//
-	// Name is a required field
-	Name *string `min:"1" type:"string" required:"true"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s DescribeRuleInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PolicyLengthExceededException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeRuleInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PolicyLengthExceededException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DescribeRuleInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DescribeRuleInput"}
-	if s.EventBusName != nil && len(*s.EventBusName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("EventBusName", 1))
-	}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
-	}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
+func newErrorPolicyLengthExceededException(v protocol.ResponseMetadata) error {
+	return &PolicyLengthExceededException{
+		RespMetadata: v,
 	}
-	return nil
 }
 
-// SetEventBusName sets the EventBusName field's value.
-func (s *DescribeRuleInput) SetEventBusName(v string) *DescribeRuleInput {
-	s.EventBusName = &v
-	return s
+// Code returns the exception type name.
+func (s *PolicyLengthExceededException) Code() string {
+	return "PolicyLengthExceededException"
 }
 
-// SetName sets the Name field's value.
-func (s *DescribeRuleInput) SetName(v string) *DescribeRuleInput {
-	s.Name = &v
-	return s
+// Message returns the exception's message.
+func (s *PolicyLengthExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-type DescribeRuleOutput struct {
-	_ struct{} `type:"structure"`
-
-	// The Amazon Resource Name (ARN) of the rule.
-	Arn *string `min:"1" type:"string"`
-
-	// The description of the rule.
-	Description *string `type:"string"`
-
-	// The event bus associated with the rule.
-	EventBusName *string `min:"1" type:"string"`
-
-	// The event pattern. For more information, see Event Patterns (https://docs.aws.amazon.com/eventbridge/latest/userguide/eventbridge-and-event-patterns.html)
-	// in the Amazon EventBridge User Guide.
-	EventPattern *string `type:"string"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *PolicyLengthExceededException) OrigErr() error {
+	return nil
+}
 
-	// If this is a managed rule, created by an AWS service on your behalf, this
-	// field displays the principal name of the AWS service that created the rule.
-	ManagedBy *string `min:"1" type:"string"`
+func (s *PolicyLengthExceededException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
 
-	// The name of the rule.
-	Name *string `min:"1" type:"string"`
+// Status code returns the HTTP status code for the request's response error.
+func (s *PolicyLengthExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
 
-	// The Amazon Resource Name (ARN) of the IAM role associated with the rule.
-	RoleArn *string `min:"1" type:"string"`
+// RequestID returns the service's response RequestID for request.
+func (s *PolicyLengthExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// The scheduling expression: for example, "cron(0 20 * * ? *)" or "rate(5 minutes)".
-	ScheduleExpression *string `type:"string"`
+type PutEventsInput struct {
+	_ struct{} `type:"structure"`
 
-	// Specifies whether the rule is enabled or disabled.
-	State *string `type:"string" enum:"RuleState"`
+	// The entry that defines an event in your system. You can specify several parameters
+	// for the entry such as the source and type of the event, resources associated
+	// with the event, and so on.
+	//
+	// Entries is a required field
+	Entries []*PutEventsRequestEntry `min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
-func (s DescribeRuleOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutEventsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeRuleOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutEventsInput) GoString() string {
 	return s.String()
 }
 
-// SetArn sets the Arn field's value.
-func (s *DescribeRuleOutput) SetArn(v string) *DescribeRuleOutput {
-	s.Arn = &v
-	return s
-}
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *PutEventsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutEventsInput"}
+	if s.Entries == nil {
+		invalidParams.Add(request.NewErrParamRequired("Entries"))
+	}
+	if s.Entries != nil && len(s.Entries) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Entries", 1))
+	}
+	if s.Entries != nil {
+		for i, v := range s.Entries {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Entries", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
 
-// SetDescription sets the Description field's value.
-func (s *DescribeRuleOutput) SetDescription(v string) *DescribeRuleOutput {
-	s.Description = &v
-	return s
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetEventBusName sets the EventBusName field's value.
-func (s *DescribeRuleOutput) SetEventBusName(v string) *DescribeRuleOutput {
-	s.EventBusName = &v
+// SetEntries sets the Entries field's value.
+func (s *PutEventsInput) SetEntries(v []*PutEventsRequestEntry) *PutEventsInput {
+	s.Entries = v
 	return s
 }
 
-// SetEventPattern sets the EventPattern field's value.
-func (s *DescribeRuleOutput) SetEventPattern(v string) *DescribeRuleOutput {
-	s.EventPattern = &v
-	return s
-}
+type PutEventsOutput struct {
+	_ struct{} `type:"structure"`
 
-// SetManagedBy sets the ManagedBy field's value.
-func (s *DescribeRuleOutput) SetManagedBy(v string) *DescribeRuleOutput {
-	s.ManagedBy = &v
-	return s
+	// The successfully and unsuccessfully ingested events results. If the ingestion
+	// was successful, the entry has the event ID in it. Otherwise, you can use
+	// the error code and error message to identify the problem with the entry.
+	Entries []*PutEventsResultEntry `type:"list"`
+
+	// The number of failed entries.
+	FailedEntryCount *int64 `type:"integer"`
 }
 
-// SetName sets the Name field's value.
-func (s *DescribeRuleOutput) SetName(v string) *DescribeRuleOutput {
-	s.Name = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutEventsOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetRoleArn sets the RoleArn field's value.
-func (s *DescribeRuleOutput) SetRoleArn(v string) *DescribeRuleOutput {
-	s.RoleArn = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutEventsOutput) GoString() string {
+	return s.String()
 }
 
-// SetScheduleExpression sets the ScheduleExpression field's value.
-func (s *DescribeRuleOutput) SetScheduleExpression(v string) *DescribeRuleOutput {
-	s.ScheduleExpression = &v
+// SetEntries sets the Entries field's value.
+func (s *PutEventsOutput) SetEntries(v []*PutEventsResultEntry) *PutEventsOutput {
+	s.Entries = v
 	return s
 }
 
-// SetState sets the State field's value.
-func (s *DescribeRuleOutput) SetState(v string) *DescribeRuleOutput {
-	s.State = &v
+// SetFailedEntryCount sets the FailedEntryCount field's value.
+func (s *PutEventsOutput) SetFailedEntryCount(v int64) *PutEventsOutput {
+	s.FailedEntryCount = &v
 	return s
 }
 
-type DisableRuleInput struct {
+// Represents an event to be submitted.
+type PutEventsRequestEntry struct {
 	_ struct{} `type:"structure"`
 
-	// The event bus associated with the rule. If you omit this, the default event
-	// bus is used.
+	// A valid JSON string. There is no other schema imposed. The JSON string may
+	// contain fields and nested subobjects.
+	Detail *string `type:"string"`
+
+	// Free-form string used to decide what fields to expect in the event detail.
+	DetailType *string `type:"string"`
+
+	// The name or ARN of the event bus to receive the event. Only the rules that
+	// are associated with this event bus are used to match the event. If you omit
+	// this, the default event bus is used.
 	EventBusName *string `min:"1" type:"string"`
 
-	// The name of the rule.
+	// Amazon Web Services resources, identified by Amazon Resource Name (ARN),
+	// which the event primarily concerns. Any number, including zero, may be present.
+	Resources []*string `type:"list"`
+
+	// The source of the event.
+	Source *string `type:"string"`
+
+	// The time stamp of the event, per RFC3339 (https://www.rfc-editor.org/rfc/rfc3339.txt).
+	// If no time stamp is provided, the time stamp of the PutEvents (https://docs.aws.amazon.com/eventbridge/latest/APIReference/API_PutEvents.html)
+	// call is used.
+	Time *time.Time `type:"timestamp"`
+
+	// An X-Ray trade header, which is an http header (X-Amzn-Trace-Id) that contains
+	// the trace-id associated with the event.
 	//
-	// Name is a required field
-	Name *string `min:"1" type:"string" required:"true"`
+	// To learn more about X-Ray trace headers, see Tracing header (https://docs.aws.amazon.com/xray/latest/devguide/xray-concepts.html#xray-concepts-tracingheader)
+	// in the X-Ray Developer Guide.
+	TraceHeader *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s DisableRuleInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutEventsRequestEntry) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DisableRuleInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutEventsRequestEntry) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DisableRuleInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DisableRuleInput"}
+func (s *PutEventsRequestEntry) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutEventsRequestEntry"}
 	if s.EventBusName != nil && len(*s.EventBusName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("EventBusName", 1))
 	}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
-	}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	if s.TraceHeader != nil && len(*s.TraceHeader) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("TraceHeader", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -4207,99 +12315,142 @@ func (s *DisableRuleInput) Validate() error {
 	return nil
 }
 
+// SetDetail sets the Detail field's value.
+func (s *PutEventsRequestEntry) SetDetail(v string) *PutEventsRequestEntry {
+	s.Detail = &v
+	return s
+}
+
+// SetDetailType sets the DetailType field's value.
+func (s *PutEventsRequestEntry) SetDetailType(v string) *PutEventsRequestEntry {
+	s.DetailType = &v
+	return s
+}
+
 // SetEventBusName sets the EventBusName field's value.
-func (s *DisableRuleInput) SetEventBusName(v string) *DisableRuleInput {
+func (s *PutEventsRequestEntry) SetEventBusName(v string) *PutEventsRequestEntry {
 	s.EventBusName = &v
 	return s
 }
 
-// SetName sets the Name field's value.
-func (s *DisableRuleInput) SetName(v string) *DisableRuleInput {
-	s.Name = &v
+// SetResources sets the Resources field's value.
+func (s *PutEventsRequestEntry) SetResources(v []*string) *PutEventsRequestEntry {
+	s.Resources = v
 	return s
 }
 
-type DisableRuleOutput struct {
-	_ struct{} `type:"structure"`
+// SetSource sets the Source field's value.
+func (s *PutEventsRequestEntry) SetSource(v string) *PutEventsRequestEntry {
+	s.Source = &v
+	return s
 }
 
-// String returns the string representation
-func (s DisableRuleOutput) String() string {
-	return awsutil.Prettify(s)
+// SetTime sets the Time field's value.
+func (s *PutEventsRequestEntry) SetTime(v time.Time) *PutEventsRequestEntry {
+	s.Time = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s DisableRuleOutput) GoString() string {
-	return s.String()
+// SetTraceHeader sets the TraceHeader field's value.
+func (s *PutEventsRequestEntry) SetTraceHeader(v string) *PutEventsRequestEntry {
+	s.TraceHeader = &v
+	return s
 }
 
-// The custom parameters to be used when the target is an Amazon ECS task.
-type EcsParameters struct {
+// Represents an event that failed to be submitted.
+type PutEventsResultEntry struct {
 	_ struct{} `type:"structure"`
 
-	// Specifies an ECS task group for the task. The maximum length is 255 characters.
-	Group *string `type:"string"`
+	// The error code that indicates why the event submission failed.
+	ErrorCode *string `type:"string"`
 
-	// Specifies the launch type on which your task is running. The launch type
-	// that you specify here must match one of the launch type (compatibilities)
-	// of the target task. The FARGATE value is supported only in the Regions where
-	// AWS Fargate with Amazon ECS is supported. For more information, see AWS Fargate
-	// on Amazon ECS (https://docs.aws.amazon.com/AmazonECS/latest/developerguide/AWS-Fargate.html)
-	// in the Amazon Elastic Container Service Developer Guide.
-	LaunchType *string `type:"string" enum:"LaunchType"`
+	// The error message that explains why the event submission failed.
+	ErrorMessage *string `type:"string"`
 
-	// Use this structure if the ECS task uses the awsvpc network mode. This structure
-	// specifies the VPC subnets and security groups associated with the task and
-	// whether a public IP address is to be used. This structure is required if
-	// LaunchType is FARGATE because the awsvpc mode is required for Fargate tasks.
-	//
-	// If you specify NetworkConfiguration when the target ECS task doesn't use
-	// the awsvpc network mode, the task fails.
-	NetworkConfiguration *NetworkConfiguration `type:"structure"`
+	// The ID of the event.
+	EventId *string `type:"string"`
+}
 
-	// Specifies the platform version for the task. Specify only the numeric portion
-	// of the platform version, such as 1.1.0.
-	//
-	// This structure is used only if LaunchType is FARGATE. For more information
-	// about valid platform versions, see AWS Fargate Platform Versions (https://docs.aws.amazon.com/AmazonECS/latest/developerguide/platform_versions.html)
-	// in the Amazon Elastic Container Service Developer Guide.
-	PlatformVersion *string `type:"string"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutEventsResultEntry) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// The number of tasks to create based on TaskDefinition. The default is 1.
-	TaskCount *int64 `min:"1" type:"integer"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutEventsResultEntry) GoString() string {
+	return s.String()
+}
 
-	// The ARN of the task definition to use if the event target is an Amazon ECS
-	// task.
+// SetErrorCode sets the ErrorCode field's value.
+func (s *PutEventsResultEntry) SetErrorCode(v string) *PutEventsResultEntry {
+	s.ErrorCode = &v
+	return s
+}
+
+// SetErrorMessage sets the ErrorMessage field's value.
+func (s *PutEventsResultEntry) SetErrorMessage(v string) *PutEventsResultEntry {
+	s.ErrorMessage = &v
+	return s
+}
+
+// SetEventId sets the EventId field's value.
+func (s *PutEventsResultEntry) SetEventId(v string) *PutEventsResultEntry {
+	s.EventId = &v
+	return s
+}
+
+type PutPartnerEventsInput struct {
+	_ struct{} `type:"structure"`
+
+	// The list of events to write to the event bus.
 	//
-	// TaskDefinitionArn is a required field
-	TaskDefinitionArn *string `min:"1" type:"string" required:"true"`
+	// Entries is a required field
+	Entries []*PutPartnerEventsRequestEntry `min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
-func (s EcsParameters) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutPartnerEventsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s EcsParameters) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutPartnerEventsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *EcsParameters) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "EcsParameters"}
-	if s.TaskCount != nil && *s.TaskCount < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("TaskCount", 1))
-	}
-	if s.TaskDefinitionArn == nil {
-		invalidParams.Add(request.NewErrParamRequired("TaskDefinitionArn"))
+func (s *PutPartnerEventsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutPartnerEventsInput"}
+	if s.Entries == nil {
+		invalidParams.Add(request.NewErrParamRequired("Entries"))
 	}
-	if s.TaskDefinitionArn != nil && len(*s.TaskDefinitionArn) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("TaskDefinitionArn", 1))
+	if s.Entries != nil && len(s.Entries) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Entries", 1))
 	}
-	if s.NetworkConfiguration != nil {
-		if err := s.NetworkConfiguration.Validate(); err != nil {
-			invalidParams.AddNested("NetworkConfiguration", err.(request.ErrInvalidParams))
+	if s.Entries != nil {
+		for i, v := range s.Entries {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Entries", i), err.(request.ErrInvalidParams))
+			}
 		}
 	}
 
@@ -4309,76 +12460,99 @@ func (s *EcsParameters) Validate() error {
 	return nil
 }
 
-// SetGroup sets the Group field's value.
-func (s *EcsParameters) SetGroup(v string) *EcsParameters {
-	s.Group = &v
+// SetEntries sets the Entries field's value.
+func (s *PutPartnerEventsInput) SetEntries(v []*PutPartnerEventsRequestEntry) *PutPartnerEventsInput {
+	s.Entries = v
 	return s
 }
 
-// SetLaunchType sets the LaunchType field's value.
-func (s *EcsParameters) SetLaunchType(v string) *EcsParameters {
-	s.LaunchType = &v
-	return s
+type PutPartnerEventsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The list of events from this operation that were successfully written to
+	// the partner event bus.
+	Entries []*PutPartnerEventsResultEntry `type:"list"`
+
+	// The number of events from this operation that could not be written to the
+	// partner event bus.
+	FailedEntryCount *int64 `type:"integer"`
 }
 
-// SetNetworkConfiguration sets the NetworkConfiguration field's value.
-func (s *EcsParameters) SetNetworkConfiguration(v *NetworkConfiguration) *EcsParameters {
-	s.NetworkConfiguration = v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutPartnerEventsOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetPlatformVersion sets the PlatformVersion field's value.
-func (s *EcsParameters) SetPlatformVersion(v string) *EcsParameters {
-	s.PlatformVersion = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutPartnerEventsOutput) GoString() string {
+	return s.String()
 }
 
-// SetTaskCount sets the TaskCount field's value.
-func (s *EcsParameters) SetTaskCount(v int64) *EcsParameters {
-	s.TaskCount = &v
+// SetEntries sets the Entries field's value.
+func (s *PutPartnerEventsOutput) SetEntries(v []*PutPartnerEventsResultEntry) *PutPartnerEventsOutput {
+	s.Entries = v
 	return s
 }
 
-// SetTaskDefinitionArn sets the TaskDefinitionArn field's value.
-func (s *EcsParameters) SetTaskDefinitionArn(v string) *EcsParameters {
-	s.TaskDefinitionArn = &v
+// SetFailedEntryCount sets the FailedEntryCount field's value.
+func (s *PutPartnerEventsOutput) SetFailedEntryCount(v int64) *PutPartnerEventsOutput {
+	s.FailedEntryCount = &v
 	return s
 }
 
-type EnableRuleInput struct {
+// The details about an event generated by an SaaS partner.
+type PutPartnerEventsRequestEntry struct {
 	_ struct{} `type:"structure"`
 
-	// The event bus associated with the rule. If you omit this, the default event
-	// bus is used.
-	EventBusName *string `min:"1" type:"string"`
+	// A valid JSON string. There is no other schema imposed. The JSON string may
+	// contain fields and nested subobjects.
+	Detail *string `type:"string"`
 
-	// The name of the rule.
-	//
-	// Name is a required field
-	Name *string `min:"1" type:"string" required:"true"`
+	// A free-form string used to decide what fields to expect in the event detail.
+	DetailType *string `type:"string"`
+
+	// Amazon Web Services resources, identified by Amazon Resource Name (ARN),
+	// which the event primarily concerns. Any number, including zero, may be present.
+	Resources []*string `type:"list"`
+
+	// The event source that is generating the entry.
+	Source *string `min:"1" type:"string"`
+
+	// The date and time of the event.
+	Time *time.Time `type:"timestamp"`
 }
 
-// String returns the string representation
-func (s EnableRuleInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutPartnerEventsRequestEntry) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s EnableRuleInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutPartnerEventsRequestEntry) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *EnableRuleInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "EnableRuleInput"}
-	if s.EventBusName != nil && len(*s.EventBusName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("EventBusName", 1))
-	}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
-	}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+func (s *PutPartnerEventsRequestEntry) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutPartnerEventsRequestEntry"}
+	if s.Source != nil && len(*s.Source) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Source", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -4387,231 +12561,168 @@ func (s *EnableRuleInput) Validate() error {
 	return nil
 }
 
-// SetEventBusName sets the EventBusName field's value.
-func (s *EnableRuleInput) SetEventBusName(v string) *EnableRuleInput {
-	s.EventBusName = &v
-	return s
-}
-
-// SetName sets the Name field's value.
-func (s *EnableRuleInput) SetName(v string) *EnableRuleInput {
-	s.Name = &v
-	return s
-}
-
-type EnableRuleOutput struct {
-	_ struct{} `type:"structure"`
-}
-
-// String returns the string representation
-func (s EnableRuleOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s EnableRuleOutput) GoString() string {
-	return s.String()
-}
-
-// An event bus receives events from a source and routes them to rules associated
-// with that event bus. Your account's default event bus receives rules from
-// AWS services. A custom event bus can receive rules from AWS services as well
-// as your custom applications and services. A partner event bus receives events
-// from an event source created by an SaaS partner. These events come from the
-// partners services or applications.
-type EventBus struct {
-	_ struct{} `type:"structure"`
-
-	// The ARN of the event bus.
-	Arn *string `type:"string"`
-
-	// The name of the event bus.
-	Name *string `type:"string"`
-
-	// The permissions policy of the event bus, describing which other AWS accounts
-	// can write events to this event bus.
-	Policy *string `type:"string"`
-}
-
-// String returns the string representation
-func (s EventBus) String() string {
-	return awsutil.Prettify(s)
+// SetDetail sets the Detail field's value.
+func (s *PutPartnerEventsRequestEntry) SetDetail(v string) *PutPartnerEventsRequestEntry {
+	s.Detail = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s EventBus) GoString() string {
-	return s.String()
+// SetDetailType sets the DetailType field's value.
+func (s *PutPartnerEventsRequestEntry) SetDetailType(v string) *PutPartnerEventsRequestEntry {
+	s.DetailType = &v
+	return s
 }
 
-// SetArn sets the Arn field's value.
-func (s *EventBus) SetArn(v string) *EventBus {
-	s.Arn = &v
+// SetResources sets the Resources field's value.
+func (s *PutPartnerEventsRequestEntry) SetResources(v []*string) *PutPartnerEventsRequestEntry {
+	s.Resources = v
 	return s
 }
 
-// SetName sets the Name field's value.
-func (s *EventBus) SetName(v string) *EventBus {
-	s.Name = &v
+// SetSource sets the Source field's value.
+func (s *PutPartnerEventsRequestEntry) SetSource(v string) *PutPartnerEventsRequestEntry {
+	s.Source = &v
 	return s
 }
 
-// SetPolicy sets the Policy field's value.
-func (s *EventBus) SetPolicy(v string) *EventBus {
-	s.Policy = &v
+// SetTime sets the Time field's value.
+func (s *PutPartnerEventsRequestEntry) SetTime(v time.Time) *PutPartnerEventsRequestEntry {
+	s.Time = &v
 	return s
 }
 
-// A partner event source is created by an SaaS partner. If a customer creates
-// a partner event bus that matches this event source, that AWS account can
-// receive events from the partner's applications or services.
-type EventSource struct {
+// Represents an event that a partner tried to generate, but failed.
+type PutPartnerEventsResultEntry struct {
 	_ struct{} `type:"structure"`
 
-	// The ARN of the event source.
-	Arn *string `type:"string"`
-
-	// The name of the partner that created the event source.
-	CreatedBy *string `type:"string"`
-
-	// The date and time when the event source was created.
-	CreationTime *time.Time `type:"timestamp"`
-
-	// The date and time when the event source will expire if the AWS account doesn't
-	// create a matching event bus for it.
-	ExpirationTime *time.Time `type:"timestamp"`
+	// The error code that indicates why the event submission failed.
+	ErrorCode *string `type:"string"`
 
-	// The name of the event source.
-	Name *string `type:"string"`
+	// The error message that explains why the event submission failed.
+	ErrorMessage *string `type:"string"`
 
-	// The state of the event source. If it's ACTIVE, you have already created a
-	// matching event bus for this event source, and that event bus is active. If
-	// it's PENDING, either you haven't yet created a matching event bus, or that
-	// event bus is deactivated. If it's DELETED, you have created a matching event
-	// bus, but the event source has since been deleted.
-	State *string `type:"string" enum:"EventSourceState"`
+	// The ID of the event.
+	EventId *string `type:"string"`
 }
 
-// String returns the string representation
-func (s EventSource) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutPartnerEventsResultEntry) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s EventSource) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutPartnerEventsResultEntry) GoString() string {
 	return s.String()
 }
 
-// SetArn sets the Arn field's value.
-func (s *EventSource) SetArn(v string) *EventSource {
-	s.Arn = &v
-	return s
-}
-
-// SetCreatedBy sets the CreatedBy field's value.
-func (s *EventSource) SetCreatedBy(v string) *EventSource {
-	s.CreatedBy = &v
-	return s
-}
-
-// SetCreationTime sets the CreationTime field's value.
-func (s *EventSource) SetCreationTime(v time.Time) *EventSource {
-	s.CreationTime = &v
-	return s
-}
-
-// SetExpirationTime sets the ExpirationTime field's value.
-func (s *EventSource) SetExpirationTime(v time.Time) *EventSource {
-	s.ExpirationTime = &v
+// SetErrorCode sets the ErrorCode field's value.
+func (s *PutPartnerEventsResultEntry) SetErrorCode(v string) *PutPartnerEventsResultEntry {
+	s.ErrorCode = &v
 	return s
 }
 
-// SetName sets the Name field's value.
-func (s *EventSource) SetName(v string) *EventSource {
-	s.Name = &v
+// SetErrorMessage sets the ErrorMessage field's value.
+func (s *PutPartnerEventsResultEntry) SetErrorMessage(v string) *PutPartnerEventsResultEntry {
+	s.ErrorMessage = &v
 	return s
 }
 
-// SetState sets the State field's value.
-func (s *EventSource) SetState(v string) *EventSource {
-	s.State = &v
+// SetEventId sets the EventId field's value.
+func (s *PutPartnerEventsResultEntry) SetEventId(v string) *PutPartnerEventsResultEntry {
+	s.EventId = &v
 	return s
 }
 
-// Contains the parameters needed for you to provide custom input to a target
-// based on one or more pieces of data extracted from the event.
-type InputTransformer struct {
+type PutPermissionInput struct {
 	_ struct{} `type:"structure"`
 
-	// Map of JSON paths to be extracted from the event. You can then insert these
-	// in the template in InputTemplate to produce the output to be sent to the
-	// target.
-	//
-	// InputPathsMap is an array key-value pairs, where each value is a valid JSON
-	// path. You can have as many as 10 key-value pairs. You must use JSON dot notation,
-	// not bracket notation.
-	//
-	// The keys can't start with "AWS".
-	InputPathsMap map[string]*string `type:"map"`
+	// The action that you are enabling the other account to perform.
+	Action *string `min:"1" type:"string"`
 
-	// Input template where you specify placeholders that will be filled with the
-	// values of the keys from InputPathsMap to customize the data sent to the target.
-	// Enclose each InputPathsMaps value in brackets: <value>. The InputTemplate
-	// must be valid JSON.
-	//
-	// If InputTemplate is a JSON object (surrounded by curly braces), the following
-	// restrictions apply:
-	//
-	//    * The placeholder can't be used as an object key
-	//
-	//    * Object values can't include quote marks
-	//
-	// The following example shows the syntax for using InputPathsMap and InputTemplate.
-	//
-	// "InputTransformer":
-	//
-	// {
-	//
-	// "InputPathsMap": {"instance": "$.detail.instance","status": "$.detail.status"},
-	//
-	// "InputTemplate": "<instance> is in state <status>"
-	//
-	// }
-	//
-	// To have the InputTemplate include quote marks within a JSON string, escape
-	// each quote marks with a slash, as in the following example:
-	//
-	// "InputTransformer":
-	//
-	// {
-	//
-	// "InputPathsMap": {"instance": "$.detail.instance","status": "$.detail.status"},
+	// This parameter enables you to limit the permission to accounts that fulfill
+	// a certain condition, such as being a member of a certain Amazon Web Services
+	// organization. For more information about Amazon Web Services Organizations,
+	// see What Is Amazon Web Services Organizations (https://docs.aws.amazon.com/organizations/latest/userguide/orgs_introduction.html)
+	// in the Amazon Web Services Organizations User Guide.
 	//
-	// "InputTemplate": "<instance> is in state \"<status>\""
+	// If you specify Condition with an Amazon Web Services organization ID, and
+	// specify "*" as the value for Principal, you grant permission to all the accounts
+	// in the named organization.
 	//
-	// }
+	// The Condition is a JSON string which must contain Type, Key, and Value fields.
+	Condition *Condition `type:"structure"`
+
+	// The name of the event bus associated with the rule. If you omit this, the
+	// default event bus is used.
+	EventBusName *string `min:"1" type:"string"`
+
+	// A JSON string that describes the permission policy statement. You can include
+	// a Policy parameter in the request instead of using the StatementId, Action,
+	// Principal, or Condition parameters.
+	Policy *string `type:"string"`
+
+	// The 12-digit Amazon Web Services account ID that you are permitting to put
+	// events to your default event bus. Specify "*" to permit any account to put
+	// events to your default event bus.
 	//
-	// InputTemplate is a required field
-	InputTemplate *string `min:"1" type:"string" required:"true"`
+	// If you specify "*" without specifying Condition, avoid creating rules that
+	// may match undesirable events. To create more secure rules, make sure that
+	// the event pattern for each rule contains an account field with a specific
+	// account ID from which to receive events. Rules with an account field do not
+	// match any events sent from other accounts.
+	Principal *string `min:"1" type:"string"`
+
+	// An identifier string for the external account that you are granting permissions
+	// to. If you later want to revoke the permission for this external account,
+	// specify this StatementId when you run RemovePermission (https://docs.aws.amazon.com/eventbridge/latest/APIReference/API_RemovePermission.html).
+	StatementId *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s InputTransformer) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutPermissionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s InputTransformer) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutPermissionInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *InputTransformer) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "InputTransformer"}
-	if s.InputTemplate == nil {
-		invalidParams.Add(request.NewErrParamRequired("InputTemplate"))
+func (s *PutPermissionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutPermissionInput"}
+	if s.Action != nil && len(*s.Action) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Action", 1))
 	}
-	if s.InputTemplate != nil && len(*s.InputTemplate) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("InputTemplate", 1))
+	if s.EventBusName != nil && len(*s.EventBusName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EventBusName", 1))
+	}
+	if s.Principal != nil && len(*s.Principal) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Principal", 1))
+	}
+	if s.StatementId != nil && len(*s.StatementId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("StatementId", 1))
+	}
+	if s.Condition != nil {
+		if err := s.Condition.Validate(); err != nil {
+			invalidParams.AddNested("Condition", err.(request.ErrInvalidParams))
+		}
 	}
 
 	if invalidParams.Len() > 0 {
@@ -4620,99 +12731,143 @@ func (s *InputTransformer) Validate() error {
 	return nil
 }
 
-// SetInputPathsMap sets the InputPathsMap field's value.
-func (s *InputTransformer) SetInputPathsMap(v map[string]*string) *InputTransformer {
-	s.InputPathsMap = v
+// SetAction sets the Action field's value.
+func (s *PutPermissionInput) SetAction(v string) *PutPermissionInput {
+	s.Action = &v
 	return s
 }
 
-// SetInputTemplate sets the InputTemplate field's value.
-func (s *InputTransformer) SetInputTemplate(v string) *InputTransformer {
-	s.InputTemplate = &v
+// SetCondition sets the Condition field's value.
+func (s *PutPermissionInput) SetCondition(v *Condition) *PutPermissionInput {
+	s.Condition = v
 	return s
 }
 
-// This object enables you to specify a JSON path to extract from the event
-// and use as the partition key for the Amazon Kinesis data stream so that you
-// can control the shard that the event goes to. If you don't include this parameter,
-// the default is to use the eventId as the partition key.
-type KinesisParameters struct {
-	_ struct{} `type:"structure"`
+// SetEventBusName sets the EventBusName field's value.
+func (s *PutPermissionInput) SetEventBusName(v string) *PutPermissionInput {
+	s.EventBusName = &v
+	return s
+}
 
-	// The JSON path to be extracted from the event and used as the partition key.
-	// For more information, see Amazon Kinesis Streams Key Concepts (https://docs.aws.amazon.com/streams/latest/dev/key-concepts.html#partition-key)
-	// in the Amazon Kinesis Streams Developer Guide.
-	//
-	// PartitionKeyPath is a required field
-	PartitionKeyPath *string `type:"string" required:"true"`
+// SetPolicy sets the Policy field's value.
+func (s *PutPermissionInput) SetPolicy(v string) *PutPermissionInput {
+	s.Policy = &v
+	return s
 }
 
-// String returns the string representation
-func (s KinesisParameters) String() string {
-	return awsutil.Prettify(s)
+// SetPrincipal sets the Principal field's value.
+func (s *PutPermissionInput) SetPrincipal(v string) *PutPermissionInput {
+	s.Principal = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s KinesisParameters) GoString() string {
-	return s.String()
+// SetStatementId sets the StatementId field's value.
+func (s *PutPermissionInput) SetStatementId(v string) *PutPermissionInput {
+	s.StatementId = &v
+	return s
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *KinesisParameters) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "KinesisParameters"}
-	if s.PartitionKeyPath == nil {
-		invalidParams.Add(request.NewErrParamRequired("PartitionKeyPath"))
-	}
+type PutPermissionOutput struct {
+	_ struct{} `type:"structure"`
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutPermissionOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetPartitionKeyPath sets the PartitionKeyPath field's value.
-func (s *KinesisParameters) SetPartitionKeyPath(v string) *KinesisParameters {
-	s.PartitionKeyPath = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutPermissionOutput) GoString() string {
+	return s.String()
 }
 
-type ListEventBusesInput struct {
-	_ struct{} `type:"structure"`
+type PutRuleInput struct {
+	_ struct{} `type:"structure"`
+
+	// A description of the rule.
+	Description *string `type:"string"`
+
+	// The name or ARN of the event bus to associate with this rule. If you omit
+	// this, the default event bus is used.
+	EventBusName *string `min:"1" type:"string"`
+
+	// The event pattern. For more information, see Events and Event Patterns (https://docs.aws.amazon.com/eventbridge/latest/userguide/eventbridge-and-event-patterns.html)
+	// in the Amazon EventBridge User Guide.
+	EventPattern *string `type:"string"`
+
+	// The name of the rule that you are creating or updating.
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
+
+	// The Amazon Resource Name (ARN) of the IAM role associated with the rule.
+	//
+	// If you're setting an event bus in another account as the target and that
+	// account granted permission to your account through an organization instead
+	// of directly by the account ID, you must specify a RoleArn with proper permissions
+	// in the Target structure, instead of here in this parameter.
+	RoleArn *string `min:"1" type:"string"`
 
-	// Specifying this limits the number of results returned by this operation.
-	// The operation also returns a NextToken that you can use in a subsequent operation
-	// to retrieve the next set of results.
-	Limit *int64 `min:"1" type:"integer"`
+	// The scheduling expression. For example, "cron(0 20 * * ? *)" or "rate(5 minutes)".
+	ScheduleExpression *string `type:"string"`
 
-	// Specifying this limits the results to only those event buses with names that
-	// start with the specified prefix.
-	NamePrefix *string `min:"1" type:"string"`
+	// Indicates whether the rule is enabled or disabled.
+	State *string `type:"string" enum:"RuleState"`
 
-	// The token returned by a previous call to retrieve the next set of results.
-	NextToken *string `min:"1" type:"string"`
+	// The list of key-value pairs to associate with the rule.
+	Tags []*Tag `type:"list"`
 }
 
-// String returns the string representation
-func (s ListEventBusesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutRuleInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListEventBusesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutRuleInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListEventBusesInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListEventBusesInput"}
-	if s.Limit != nil && *s.Limit < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+func (s *PutRuleInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutRuleInput"}
+	if s.EventBusName != nil && len(*s.EventBusName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EventBusName", 1))
 	}
-	if s.NamePrefix != nil && len(*s.NamePrefix) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("NamePrefix", 1))
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
 	}
-	if s.NextToken != nil && len(*s.NextToken) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+	if s.RoleArn != nil && len(*s.RoleArn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RoleArn", 1))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
 	}
 
 	if invalidParams.Len() > 0 {
@@ -4721,94 +12876,148 @@ func (s *ListEventBusesInput) Validate() error {
 	return nil
 }
 
-// SetLimit sets the Limit field's value.
-func (s *ListEventBusesInput) SetLimit(v int64) *ListEventBusesInput {
-	s.Limit = &v
+// SetDescription sets the Description field's value.
+func (s *PutRuleInput) SetDescription(v string) *PutRuleInput {
+	s.Description = &v
 	return s
 }
 
-// SetNamePrefix sets the NamePrefix field's value.
-func (s *ListEventBusesInput) SetNamePrefix(v string) *ListEventBusesInput {
-	s.NamePrefix = &v
+// SetEventBusName sets the EventBusName field's value.
+func (s *PutRuleInput) SetEventBusName(v string) *PutRuleInput {
+	s.EventBusName = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListEventBusesInput) SetNextToken(v string) *ListEventBusesInput {
-	s.NextToken = &v
+// SetEventPattern sets the EventPattern field's value.
+func (s *PutRuleInput) SetEventPattern(v string) *PutRuleInput {
+	s.EventPattern = &v
 	return s
 }
 
-type ListEventBusesOutput struct {
-	_ struct{} `type:"structure"`
-
-	// This list of event buses.
-	EventBuses []*EventBus `type:"list"`
+// SetName sets the Name field's value.
+func (s *PutRuleInput) SetName(v string) *PutRuleInput {
+	s.Name = &v
+	return s
+}
 
-	// A token you can use in a subsequent operation to retrieve the next set of
-	// results.
-	NextToken *string `min:"1" type:"string"`
+// SetRoleArn sets the RoleArn field's value.
+func (s *PutRuleInput) SetRoleArn(v string) *PutRuleInput {
+	s.RoleArn = &v
+	return s
 }
 
-// String returns the string representation
-func (s ListEventBusesOutput) String() string {
-	return awsutil.Prettify(s)
+// SetScheduleExpression sets the ScheduleExpression field's value.
+func (s *PutRuleInput) SetScheduleExpression(v string) *PutRuleInput {
+	s.ScheduleExpression = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s ListEventBusesOutput) GoString() string {
-	return s.String()
+// SetState sets the State field's value.
+func (s *PutRuleInput) SetState(v string) *PutRuleInput {
+	s.State = &v
+	return s
 }
 
-// SetEventBuses sets the EventBuses field's value.
-func (s *ListEventBusesOutput) SetEventBuses(v []*EventBus) *ListEventBusesOutput {
-	s.EventBuses = v
+// SetTags sets the Tags field's value.
+func (s *PutRuleInput) SetTags(v []*Tag) *PutRuleInput {
+	s.Tags = v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListEventBusesOutput) SetNextToken(v string) *ListEventBusesOutput {
-	s.NextToken = &v
+type PutRuleOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the rule.
+	RuleArn *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutRuleOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutRuleOutput) GoString() string {
+	return s.String()
+}
+
+// SetRuleArn sets the RuleArn field's value.
+func (s *PutRuleOutput) SetRuleArn(v string) *PutRuleOutput {
+	s.RuleArn = &v
 	return s
 }
 
-type ListEventSourcesInput struct {
+type PutTargetsInput struct {
 	_ struct{} `type:"structure"`
 
-	// Specifying this limits the number of results returned by this operation.
-	// The operation also returns a NextToken that you can use in a subsequent operation
-	// to retrieve the next set of results.
-	Limit *int64 `min:"1" type:"integer"`
+	// The name or ARN of the event bus associated with the rule. If you omit this,
+	// the default event bus is used.
+	EventBusName *string `min:"1" type:"string"`
 
-	// Specifying this limits the results to only those partner event sources with
-	// names that start with the specified prefix.
-	NamePrefix *string `min:"1" type:"string"`
+	// The name of the rule.
+	//
+	// Rule is a required field
+	Rule *string `min:"1" type:"string" required:"true"`
 
-	// The token returned by a previous call to retrieve the next set of results.
-	NextToken *string `min:"1" type:"string"`
+	// The targets to update or add to the rule.
+	//
+	// Targets is a required field
+	Targets []*Target `min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
-func (s ListEventSourcesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutTargetsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListEventSourcesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutTargetsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListEventSourcesInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListEventSourcesInput"}
-	if s.Limit != nil && *s.Limit < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+func (s *PutTargetsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutTargetsInput"}
+	if s.EventBusName != nil && len(*s.EventBusName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EventBusName", 1))
 	}
-	if s.NamePrefix != nil && len(*s.NamePrefix) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("NamePrefix", 1))
+	if s.Rule == nil {
+		invalidParams.Add(request.NewErrParamRequired("Rule"))
 	}
-	if s.NextToken != nil && len(*s.NextToken) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	if s.Rule != nil && len(*s.Rule) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Rule", 1))
+	}
+	if s.Targets == nil {
+		invalidParams.Add(request.NewErrParamRequired("Targets"))
+	}
+	if s.Targets != nil && len(s.Targets) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Targets", 1))
+	}
+	if s.Targets != nil {
+		for i, v := range s.Targets {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Targets", i), err.(request.ErrInvalidParams))
+			}
+		}
 	}
 
 	if invalidParams.Len() > 0 {
@@ -4817,99 +13026,189 @@ func (s *ListEventSourcesInput) Validate() error {
 	return nil
 }
 
-// SetLimit sets the Limit field's value.
-func (s *ListEventSourcesInput) SetLimit(v int64) *ListEventSourcesInput {
-	s.Limit = &v
+// SetEventBusName sets the EventBusName field's value.
+func (s *PutTargetsInput) SetEventBusName(v string) *PutTargetsInput {
+	s.EventBusName = &v
 	return s
 }
 
-// SetNamePrefix sets the NamePrefix field's value.
-func (s *ListEventSourcesInput) SetNamePrefix(v string) *ListEventSourcesInput {
-	s.NamePrefix = &v
+// SetRule sets the Rule field's value.
+func (s *PutTargetsInput) SetRule(v string) *PutTargetsInput {
+	s.Rule = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListEventSourcesInput) SetNextToken(v string) *ListEventSourcesInput {
-	s.NextToken = &v
+// SetTargets sets the Targets field's value.
+func (s *PutTargetsInput) SetTargets(v []*Target) *PutTargetsInput {
+	s.Targets = v
 	return s
 }
 
-type ListEventSourcesOutput struct {
+type PutTargetsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The list of event sources.
-	EventSources []*EventSource `type:"list"`
+	// The failed target entries.
+	FailedEntries []*PutTargetsResultEntry `type:"list"`
 
-	// A token you can use in a subsequent operation to retrieve the next set of
-	// results.
-	NextToken *string `min:"1" type:"string"`
+	// The number of failed entries.
+	FailedEntryCount *int64 `type:"integer"`
 }
 
-// String returns the string representation
-func (s ListEventSourcesOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutTargetsOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutTargetsOutput) GoString() string {
+	return s.String()
+}
+
+// SetFailedEntries sets the FailedEntries field's value.
+func (s *PutTargetsOutput) SetFailedEntries(v []*PutTargetsResultEntry) *PutTargetsOutput {
+	s.FailedEntries = v
+	return s
+}
+
+// SetFailedEntryCount sets the FailedEntryCount field's value.
+func (s *PutTargetsOutput) SetFailedEntryCount(v int64) *PutTargetsOutput {
+	s.FailedEntryCount = &v
+	return s
+}
+
+// Represents a target that failed to be added to a rule.
+type PutTargetsResultEntry struct {
+	_ struct{} `type:"structure"`
+
+	// The error code that indicates why the target addition failed. If the value
+	// is ConcurrentModificationException, too many requests were made at the same
+	// time.
+	ErrorCode *string `type:"string"`
+
+	// The error message that explains why the target addition failed.
+	ErrorMessage *string `type:"string"`
+
+	// The ID of the target.
+	TargetId *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutTargetsResultEntry) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListEventSourcesOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutTargetsResultEntry) GoString() string {
 	return s.String()
 }
 
-// SetEventSources sets the EventSources field's value.
-func (s *ListEventSourcesOutput) SetEventSources(v []*EventSource) *ListEventSourcesOutput {
-	s.EventSources = v
+// SetErrorCode sets the ErrorCode field's value.
+func (s *PutTargetsResultEntry) SetErrorCode(v string) *PutTargetsResultEntry {
+	s.ErrorCode = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListEventSourcesOutput) SetNextToken(v string) *ListEventSourcesOutput {
-	s.NextToken = &v
+// SetErrorMessage sets the ErrorMessage field's value.
+func (s *PutTargetsResultEntry) SetErrorMessage(v string) *PutTargetsResultEntry {
+	s.ErrorMessage = &v
 	return s
 }
 
-type ListPartnerEventSourceAccountsInput struct {
+// SetTargetId sets the TargetId field's value.
+func (s *PutTargetsResultEntry) SetTargetId(v string) *PutTargetsResultEntry {
+	s.TargetId = &v
+	return s
+}
+
+// These are custom parameters to be used when the target is a Amazon Redshift
+// cluster to invoke the Amazon Redshift Data API ExecuteStatement based on
+// EventBridge events.
+type RedshiftDataParameters struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the partner event source to display account information about.
+	// The name of the database. Required when authenticating using temporary credentials.
 	//
-	// EventSourceName is a required field
-	EventSourceName *string `min:"1" type:"string" required:"true"`
+	// Database is a required field
+	Database *string `min:"1" type:"string" required:"true"`
 
-	// Specifying this limits the number of results returned by this operation.
-	// The operation also returns a NextToken that you can use in a subsequent operation
-	// to retrieve the next set of results.
-	Limit *int64 `min:"1" type:"integer"`
+	// The database user name. Required when authenticating using temporary credentials.
+	DbUser *string `min:"1" type:"string"`
 
-	// The token returned by a previous call to this operation. Specifying this
-	// retrieves the next set of results.
-	NextToken *string `min:"1" type:"string"`
+	// The name or ARN of the secret that enables access to the database. Required
+	// when authenticating using Amazon Web Services Secrets Manager.
+	SecretManagerArn *string `min:"1" type:"string"`
+
+	// The SQL statement text to run.
+	//
+	// Sql is a required field
+	Sql *string `min:"1" type:"string" required:"true"`
+
+	// The name of the SQL statement. You can name the SQL statement when you create
+	// it to identify the query.
+	StatementName *string `min:"1" type:"string"`
+
+	// Indicates whether to send an event back to EventBridge after the SQL statement
+	// runs.
+	WithEvent *bool `type:"boolean"`
 }
 
-// String returns the string representation
-func (s ListPartnerEventSourceAccountsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RedshiftDataParameters) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListPartnerEventSourceAccountsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RedshiftDataParameters) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListPartnerEventSourceAccountsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListPartnerEventSourceAccountsInput"}
-	if s.EventSourceName == nil {
-		invalidParams.Add(request.NewErrParamRequired("EventSourceName"))
+func (s *RedshiftDataParameters) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "RedshiftDataParameters"}
+	if s.Database == nil {
+		invalidParams.Add(request.NewErrParamRequired("Database"))
 	}
-	if s.EventSourceName != nil && len(*s.EventSourceName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("EventSourceName", 1))
+	if s.Database != nil && len(*s.Database) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Database", 1))
 	}
-	if s.Limit != nil && *s.Limit < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+	if s.DbUser != nil && len(*s.DbUser) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("DbUser", 1))
 	}
-	if s.NextToken != nil && len(*s.NextToken) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	if s.SecretManagerArn != nil && len(*s.SecretManagerArn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("SecretManagerArn", 1))
+	}
+	if s.Sql == nil {
+		invalidParams.Add(request.NewErrParamRequired("Sql"))
+	}
+	if s.Sql != nil && len(*s.Sql) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Sql", 1))
+	}
+	if s.StatementName != nil && len(*s.StatementName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("StatementName", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -4918,100 +13217,83 @@ func (s *ListPartnerEventSourceAccountsInput) Validate() error {
 	return nil
 }
 
-// SetEventSourceName sets the EventSourceName field's value.
-func (s *ListPartnerEventSourceAccountsInput) SetEventSourceName(v string) *ListPartnerEventSourceAccountsInput {
-	s.EventSourceName = &v
+// SetDatabase sets the Database field's value.
+func (s *RedshiftDataParameters) SetDatabase(v string) *RedshiftDataParameters {
+	s.Database = &v
 	return s
 }
 
-// SetLimit sets the Limit field's value.
-func (s *ListPartnerEventSourceAccountsInput) SetLimit(v int64) *ListPartnerEventSourceAccountsInput {
-	s.Limit = &v
+// SetDbUser sets the DbUser field's value.
+func (s *RedshiftDataParameters) SetDbUser(v string) *RedshiftDataParameters {
+	s.DbUser = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListPartnerEventSourceAccountsInput) SetNextToken(v string) *ListPartnerEventSourceAccountsInput {
-	s.NextToken = &v
+// SetSecretManagerArn sets the SecretManagerArn field's value.
+func (s *RedshiftDataParameters) SetSecretManagerArn(v string) *RedshiftDataParameters {
+	s.SecretManagerArn = &v
 	return s
 }
 
-type ListPartnerEventSourceAccountsOutput struct {
-	_ struct{} `type:"structure"`
-
-	// A token you can use in a subsequent operation to retrieve the next set of
-	// results.
-	NextToken *string `min:"1" type:"string"`
-
-	// The list of partner event sources returned by the operation.
-	PartnerEventSourceAccounts []*PartnerEventSourceAccount `type:"list"`
-}
-
-// String returns the string representation
-func (s ListPartnerEventSourceAccountsOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s ListPartnerEventSourceAccountsOutput) GoString() string {
-	return s.String()
+// SetSql sets the Sql field's value.
+func (s *RedshiftDataParameters) SetSql(v string) *RedshiftDataParameters {
+	s.Sql = &v
+	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListPartnerEventSourceAccountsOutput) SetNextToken(v string) *ListPartnerEventSourceAccountsOutput {
-	s.NextToken = &v
+// SetStatementName sets the StatementName field's value.
+func (s *RedshiftDataParameters) SetStatementName(v string) *RedshiftDataParameters {
+	s.StatementName = &v
 	return s
 }
 
-// SetPartnerEventSourceAccounts sets the PartnerEventSourceAccounts field's value.
-func (s *ListPartnerEventSourceAccountsOutput) SetPartnerEventSourceAccounts(v []*PartnerEventSourceAccount) *ListPartnerEventSourceAccountsOutput {
-	s.PartnerEventSourceAccounts = v
+// SetWithEvent sets the WithEvent field's value.
+func (s *RedshiftDataParameters) SetWithEvent(v bool) *RedshiftDataParameters {
+	s.WithEvent = &v
 	return s
 }
 
-type ListPartnerEventSourcesInput struct {
+type RemovePermissionInput struct {
 	_ struct{} `type:"structure"`
 
-	// pecifying this limits the number of results returned by this operation. The
-	// operation also returns a NextToken that you can use in a subsequent operation
-	// to retrieve the next set of results.
-	Limit *int64 `min:"1" type:"integer"`
+	// The name of the event bus to revoke permissions for. If you omit this, the
+	// default event bus is used.
+	EventBusName *string `min:"1" type:"string"`
 
-	// If you specify this, the results are limited to only those partner event
-	// sources that start with the string you specify.
-	//
-	// NamePrefix is a required field
-	NamePrefix *string `min:"1" type:"string" required:"true"`
+	// Specifies whether to remove all permissions.
+	RemoveAllPermissions *bool `type:"boolean"`
 
-	// The token returned by a previous call to this operation. Specifying this
-	// retrieves the next set of results.
-	NextToken *string `min:"1" type:"string"`
+	// The statement ID corresponding to the account that is no longer allowed to
+	// put events to the default event bus.
+	StatementId *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s ListPartnerEventSourcesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RemovePermissionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListPartnerEventSourcesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RemovePermissionInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListPartnerEventSourcesInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListPartnerEventSourcesInput"}
-	if s.Limit != nil && *s.Limit < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
-	}
-	if s.NamePrefix == nil {
-		invalidParams.Add(request.NewErrParamRequired("NamePrefix"))
-	}
-	if s.NamePrefix != nil && len(*s.NamePrefix) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("NamePrefix", 1))
+func (s *RemovePermissionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "RemovePermissionInput"}
+	if s.EventBusName != nil && len(*s.EventBusName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EventBusName", 1))
 	}
-	if s.NextToken != nil && len(*s.NextToken) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	if s.StatementId != nil && len(*s.StatementId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("StatementId", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5020,103 +13302,106 @@ func (s *ListPartnerEventSourcesInput) Validate() error {
 	return nil
 }
 
-// SetLimit sets the Limit field's value.
-func (s *ListPartnerEventSourcesInput) SetLimit(v int64) *ListPartnerEventSourcesInput {
-	s.Limit = &v
+// SetEventBusName sets the EventBusName field's value.
+func (s *RemovePermissionInput) SetEventBusName(v string) *RemovePermissionInput {
+	s.EventBusName = &v
 	return s
 }
 
-// SetNamePrefix sets the NamePrefix field's value.
-func (s *ListPartnerEventSourcesInput) SetNamePrefix(v string) *ListPartnerEventSourcesInput {
-	s.NamePrefix = &v
+// SetRemoveAllPermissions sets the RemoveAllPermissions field's value.
+func (s *RemovePermissionInput) SetRemoveAllPermissions(v bool) *RemovePermissionInput {
+	s.RemoveAllPermissions = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListPartnerEventSourcesInput) SetNextToken(v string) *ListPartnerEventSourcesInput {
-	s.NextToken = &v
+// SetStatementId sets the StatementId field's value.
+func (s *RemovePermissionInput) SetStatementId(v string) *RemovePermissionInput {
+	s.StatementId = &v
 	return s
 }
 
-type ListPartnerEventSourcesOutput struct {
+type RemovePermissionOutput struct {
 	_ struct{} `type:"structure"`
-
-	// A token you can use in a subsequent operation to retrieve the next set of
-	// results.
-	NextToken *string `min:"1" type:"string"`
-
-	// The list of partner event sources returned by the operation.
-	PartnerEventSources []*PartnerEventSource `type:"list"`
 }
 
-// String returns the string representation
-func (s ListPartnerEventSourcesOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RemovePermissionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListPartnerEventSourcesOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RemovePermissionOutput) GoString() string {
 	return s.String()
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListPartnerEventSourcesOutput) SetNextToken(v string) *ListPartnerEventSourcesOutput {
-	s.NextToken = &v
-	return s
-}
-
-// SetPartnerEventSources sets the PartnerEventSources field's value.
-func (s *ListPartnerEventSourcesOutput) SetPartnerEventSources(v []*PartnerEventSource) *ListPartnerEventSourcesOutput {
-	s.PartnerEventSources = v
-	return s
-}
-
-type ListRuleNamesByTargetInput struct {
+type RemoveTargetsInput struct {
 	_ struct{} `type:"structure"`
 
-	// Limits the results to show only the rules associated with the specified event
-	// bus.
+	// The name or ARN of the event bus associated with the rule. If you omit this,
+	// the default event bus is used.
 	EventBusName *string `min:"1" type:"string"`
 
-	// The maximum number of results to return.
-	Limit *int64 `min:"1" type:"integer"`
+	// If this is a managed rule, created by an Amazon Web Services service on your
+	// behalf, you must specify Force as True to remove targets. This parameter
+	// is ignored for rules that are not managed rules. You can check whether a
+	// rule is a managed rule by using DescribeRule or ListRules and checking the
+	// ManagedBy field of the response.
+	Force *bool `type:"boolean"`
 
-	// The token returned by a previous call to retrieve the next set of results.
-	NextToken *string `min:"1" type:"string"`
+	// The IDs of the targets to remove from the rule.
+	//
+	// Ids is a required field
+	Ids []*string `min:"1" type:"list" required:"true"`
 
-	// The Amazon Resource Name (ARN) of the target resource.
+	// The name of the rule.
 	//
-	// TargetArn is a required field
-	TargetArn *string `min:"1" type:"string" required:"true"`
+	// Rule is a required field
+	Rule *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s ListRuleNamesByTargetInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RemoveTargetsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListRuleNamesByTargetInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RemoveTargetsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListRuleNamesByTargetInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListRuleNamesByTargetInput"}
+func (s *RemoveTargetsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "RemoveTargetsInput"}
 	if s.EventBusName != nil && len(*s.EventBusName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("EventBusName", 1))
 	}
-	if s.Limit != nil && *s.Limit < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+	if s.Ids == nil {
+		invalidParams.Add(request.NewErrParamRequired("Ids"))
 	}
-	if s.NextToken != nil && len(*s.NextToken) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	if s.Ids != nil && len(s.Ids) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Ids", 1))
 	}
-	if s.TargetArn == nil {
-		invalidParams.Add(request.NewErrParamRequired("TargetArn"))
+	if s.Rule == nil {
+		invalidParams.Add(request.NewErrParamRequired("Rule"))
 	}
-	if s.TargetArn != nil && len(*s.TargetArn) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("TargetArn", 1))
+	if s.Rule != nil && len(*s.Rule) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Rule", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5126,195 +13411,267 @@ func (s *ListRuleNamesByTargetInput) Validate() error {
 }
 
 // SetEventBusName sets the EventBusName field's value.
-func (s *ListRuleNamesByTargetInput) SetEventBusName(v string) *ListRuleNamesByTargetInput {
+func (s *RemoveTargetsInput) SetEventBusName(v string) *RemoveTargetsInput {
 	s.EventBusName = &v
 	return s
 }
 
-// SetLimit sets the Limit field's value.
-func (s *ListRuleNamesByTargetInput) SetLimit(v int64) *ListRuleNamesByTargetInput {
-	s.Limit = &v
+// SetForce sets the Force field's value.
+func (s *RemoveTargetsInput) SetForce(v bool) *RemoveTargetsInput {
+	s.Force = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListRuleNamesByTargetInput) SetNextToken(v string) *ListRuleNamesByTargetInput {
-	s.NextToken = &v
+// SetIds sets the Ids field's value.
+func (s *RemoveTargetsInput) SetIds(v []*string) *RemoveTargetsInput {
+	s.Ids = v
+	return s
+}
+
+// SetRule sets the Rule field's value.
+func (s *RemoveTargetsInput) SetRule(v string) *RemoveTargetsInput {
+	s.Rule = &v
+	return s
+}
+
+type RemoveTargetsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The failed target entries.
+	FailedEntries []*RemoveTargetsResultEntry `type:"list"`
+
+	// The number of failed entries.
+	FailedEntryCount *int64 `type:"integer"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RemoveTargetsOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RemoveTargetsOutput) GoString() string {
+	return s.String()
+}
+
+// SetFailedEntries sets the FailedEntries field's value.
+func (s *RemoveTargetsOutput) SetFailedEntries(v []*RemoveTargetsResultEntry) *RemoveTargetsOutput {
+	s.FailedEntries = v
 	return s
 }
 
-// SetTargetArn sets the TargetArn field's value.
-func (s *ListRuleNamesByTargetInput) SetTargetArn(v string) *ListRuleNamesByTargetInput {
-	s.TargetArn = &v
+// SetFailedEntryCount sets the FailedEntryCount field's value.
+func (s *RemoveTargetsOutput) SetFailedEntryCount(v int64) *RemoveTargetsOutput {
+	s.FailedEntryCount = &v
 	return s
 }
 
-type ListRuleNamesByTargetOutput struct {
+// Represents a target that failed to be removed from a rule.
+type RemoveTargetsResultEntry struct {
 	_ struct{} `type:"structure"`
 
-	// Indicates whether there are additional results to retrieve. If there are
-	// no more results, the value is null.
-	NextToken *string `min:"1" type:"string"`
+	// The error code that indicates why the target removal failed. If the value
+	// is ConcurrentModificationException, too many requests were made at the same
+	// time.
+	ErrorCode *string `type:"string"`
 
-	// The names of the rules that can invoke the given target.
-	RuleNames []*string `type:"list"`
+	// The error message that explains why the target removal failed.
+	ErrorMessage *string `type:"string"`
+
+	// The ID of the target.
+	TargetId *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s ListRuleNamesByTargetOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RemoveTargetsResultEntry) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListRuleNamesByTargetOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RemoveTargetsResultEntry) GoString() string {
 	return s.String()
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListRuleNamesByTargetOutput) SetNextToken(v string) *ListRuleNamesByTargetOutput {
-	s.NextToken = &v
+// SetErrorCode sets the ErrorCode field's value.
+func (s *RemoveTargetsResultEntry) SetErrorCode(v string) *RemoveTargetsResultEntry {
+	s.ErrorCode = &v
 	return s
 }
 
-// SetRuleNames sets the RuleNames field's value.
-func (s *ListRuleNamesByTargetOutput) SetRuleNames(v []*string) *ListRuleNamesByTargetOutput {
-	s.RuleNames = v
+// SetErrorMessage sets the ErrorMessage field's value.
+func (s *RemoveTargetsResultEntry) SetErrorMessage(v string) *RemoveTargetsResultEntry {
+	s.ErrorMessage = &v
 	return s
 }
 
-type ListRulesInput struct {
+// SetTargetId sets the TargetId field's value.
+func (s *RemoveTargetsResultEntry) SetTargetId(v string) *RemoveTargetsResultEntry {
+	s.TargetId = &v
+	return s
+}
+
+// A Replay object that contains details about a replay.
+type Replay struct {
 	_ struct{} `type:"structure"`
 
-	// Limits the results to show only the rules associated with the specified event
-	// bus.
-	EventBusName *string `min:"1" type:"string"`
+	// A time stamp for the time to start replaying events. Any event with a creation
+	// time prior to the EventEndTime specified is replayed.
+	EventEndTime *time.Time `type:"timestamp"`
 
-	// The maximum number of results to return.
-	Limit *int64 `min:"1" type:"integer"`
+	// A time stamp for the time that the last event was replayed.
+	EventLastReplayedTime *time.Time `type:"timestamp"`
 
-	// The prefix matching the rule name.
-	NamePrefix *string `min:"1" type:"string"`
+	// The ARN of the archive to replay event from.
+	EventSourceArn *string `min:"1" type:"string"`
 
-	// The token returned by a previous call to retrieve the next set of results.
-	NextToken *string `min:"1" type:"string"`
+	// A time stamp for the time to start replaying events. This is determined by
+	// the time in the event as described in Time (https://docs.aws.amazon.com/eventbridge/latest/APIReference/API_PutEventsRequestEntry.html#eventbridge-Type-PutEventsRequestEntry-Time).
+	EventStartTime *time.Time `type:"timestamp"`
+
+	// A time stamp for the time that the replay completed.
+	ReplayEndTime *time.Time `type:"timestamp"`
+
+	// The name of the replay.
+	ReplayName *string `min:"1" type:"string"`
+
+	// A time stamp for the time that the replay started.
+	ReplayStartTime *time.Time `type:"timestamp"`
+
+	// The current state of the replay.
+	State *string `type:"string" enum:"ReplayState"`
+
+	// A description of why the replay is in the current state.
+	StateReason *string `type:"string"`
 }
 
-// String returns the string representation
-func (s ListRulesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Replay) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListRulesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Replay) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *ListRulesInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListRulesInput"}
-	if s.EventBusName != nil && len(*s.EventBusName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("EventBusName", 1))
-	}
-	if s.Limit != nil && *s.Limit < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
-	}
-	if s.NamePrefix != nil && len(*s.NamePrefix) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("NamePrefix", 1))
-	}
-	if s.NextToken != nil && len(*s.NextToken) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
-// SetEventBusName sets the EventBusName field's value.
-func (s *ListRulesInput) SetEventBusName(v string) *ListRulesInput {
-	s.EventBusName = &v
+// SetEventEndTime sets the EventEndTime field's value.
+func (s *Replay) SetEventEndTime(v time.Time) *Replay {
+	s.EventEndTime = &v
 	return s
 }
 
-// SetLimit sets the Limit field's value.
-func (s *ListRulesInput) SetLimit(v int64) *ListRulesInput {
-	s.Limit = &v
+// SetEventLastReplayedTime sets the EventLastReplayedTime field's value.
+func (s *Replay) SetEventLastReplayedTime(v time.Time) *Replay {
+	s.EventLastReplayedTime = &v
 	return s
 }
 
-// SetNamePrefix sets the NamePrefix field's value.
-func (s *ListRulesInput) SetNamePrefix(v string) *ListRulesInput {
-	s.NamePrefix = &v
+// SetEventSourceArn sets the EventSourceArn field's value.
+func (s *Replay) SetEventSourceArn(v string) *Replay {
+	s.EventSourceArn = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListRulesInput) SetNextToken(v string) *ListRulesInput {
-	s.NextToken = &v
+// SetEventStartTime sets the EventStartTime field's value.
+func (s *Replay) SetEventStartTime(v time.Time) *Replay {
+	s.EventStartTime = &v
 	return s
 }
 
-type ListRulesOutput struct {
-	_ struct{} `type:"structure"`
-
-	// Indicates whether there are additional results to retrieve. If there are
-	// no more results, the value is null.
-	NextToken *string `min:"1" type:"string"`
-
-	// The rules that match the specified criteria.
-	Rules []*Rule `type:"list"`
+// SetReplayEndTime sets the ReplayEndTime field's value.
+func (s *Replay) SetReplayEndTime(v time.Time) *Replay {
+	s.ReplayEndTime = &v
+	return s
 }
 
-// String returns the string representation
-func (s ListRulesOutput) String() string {
-	return awsutil.Prettify(s)
+// SetReplayName sets the ReplayName field's value.
+func (s *Replay) SetReplayName(v string) *Replay {
+	s.ReplayName = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s ListRulesOutput) GoString() string {
-	return s.String()
+// SetReplayStartTime sets the ReplayStartTime field's value.
+func (s *Replay) SetReplayStartTime(v time.Time) *Replay {
+	s.ReplayStartTime = &v
+	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListRulesOutput) SetNextToken(v string) *ListRulesOutput {
-	s.NextToken = &v
+// SetState sets the State field's value.
+func (s *Replay) SetState(v string) *Replay {
+	s.State = &v
 	return s
 }
 
-// SetRules sets the Rules field's value.
-func (s *ListRulesOutput) SetRules(v []*Rule) *ListRulesOutput {
-	s.Rules = v
+// SetStateReason sets the StateReason field's value.
+func (s *Replay) SetStateReason(v string) *Replay {
+	s.StateReason = &v
 	return s
 }
 
-type ListTagsForResourceInput struct {
+// A ReplayDestination object that contains details about a replay.
+type ReplayDestination struct {
 	_ struct{} `type:"structure"`
 
-	// The ARN of the rule for which you want to view tags.
+	// The ARN of the event bus to replay event to. You can replay events only to
+	// the event bus specified to create the archive.
 	//
-	// ResourceARN is a required field
-	ResourceARN *string `min:"1" type:"string" required:"true"`
+	// Arn is a required field
+	Arn *string `min:"1" type:"string" required:"true"`
+
+	// A list of ARNs for rules to replay events to.
+	FilterArns []*string `type:"list"`
 }
 
-// String returns the string representation
-func (s ListTagsForResourceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ReplayDestination) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListTagsForResourceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ReplayDestination) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListTagsForResourceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListTagsForResourceInput"}
-	if s.ResourceARN == nil {
-		invalidParams.Add(request.NewErrParamRequired("ResourceARN"))
+func (s *ReplayDestination) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ReplayDestination"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
 	}
-	if s.ResourceARN != nil && len(*s.ResourceARN) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("ResourceARN", 1))
+	if s.Arn != nil && len(*s.Arn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5323,173 +13680,182 @@ func (s *ListTagsForResourceInput) Validate() error {
 	return nil
 }
 
-// SetResourceARN sets the ResourceARN field's value.
-func (s *ListTagsForResourceInput) SetResourceARN(v string) *ListTagsForResourceInput {
-	s.ResourceARN = &v
+// SetArn sets the Arn field's value.
+func (s *ReplayDestination) SetArn(v string) *ReplayDestination {
+	s.Arn = &v
 	return s
 }
 
-type ListTagsForResourceOutput struct {
-	_ struct{} `type:"structure"`
+// SetFilterArns sets the FilterArns field's value.
+func (s *ReplayDestination) SetFilterArns(v []*string) *ReplayDestination {
+	s.FilterArns = v
+	return s
+}
 
-	// The list of tag keys and values associated with the rule that you specified.
-	Tags []*Tag `type:"list"`
+// The resource you are trying to create already exists.
+type ResourceAlreadyExistsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s ListTagsForResourceOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceAlreadyExistsException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListTagsForResourceOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceAlreadyExistsException) GoString() string {
 	return s.String()
 }
 
-// SetTags sets the Tags field's value.
-func (s *ListTagsForResourceOutput) SetTags(v []*Tag) *ListTagsForResourceOutput {
-	s.Tags = v
-	return s
+func newErrorResourceAlreadyExistsException(v protocol.ResponseMetadata) error {
+	return &ResourceAlreadyExistsException{
+		RespMetadata: v,
+	}
 }
 
-type ListTargetsByRuleInput struct {
-	_ struct{} `type:"structure"`
-
-	// The event bus associated with the rule. If you omit this, the default event
-	// bus is used.
-	EventBusName *string `min:"1" type:"string"`
+// Code returns the exception type name.
+func (s *ResourceAlreadyExistsException) Code() string {
+	return "ResourceAlreadyExistsException"
+}
 
-	// The maximum number of results to return.
-	Limit *int64 `min:"1" type:"integer"`
+// Message returns the exception's message.
+func (s *ResourceAlreadyExistsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
 
-	// The token returned by a previous call to retrieve the next set of results.
-	NextToken *string `min:"1" type:"string"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ResourceAlreadyExistsException) OrigErr() error {
+	return nil
+}
 
-	// The name of the rule.
-	//
-	// Rule is a required field
-	Rule *string `min:"1" type:"string" required:"true"`
+func (s *ResourceAlreadyExistsException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// String returns the string representation
-func (s ListTargetsByRuleInput) String() string {
-	return awsutil.Prettify(s)
+// Status code returns the HTTP status code for the request's response error.
+func (s *ResourceAlreadyExistsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// GoString returns the string representation
-func (s ListTargetsByRuleInput) GoString() string {
-	return s.String()
+// RequestID returns the service's response RequestID for request.
+func (s *ResourceAlreadyExistsException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *ListTargetsByRuleInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListTargetsByRuleInput"}
-	if s.EventBusName != nil && len(*s.EventBusName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("EventBusName", 1))
-	}
-	if s.Limit != nil && *s.Limit < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
-	}
-	if s.NextToken != nil && len(*s.NextToken) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
-	}
-	if s.Rule == nil {
-		invalidParams.Add(request.NewErrParamRequired("Rule"))
-	}
-	if s.Rule != nil && len(*s.Rule) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Rule", 1))
-	}
+// An entity that you specified does not exist.
+type ResourceNotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// SetEventBusName sets the EventBusName field's value.
-func (s *ListTargetsByRuleInput) SetEventBusName(v string) *ListTargetsByRuleInput {
-	s.EventBusName = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceNotFoundException) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetLimit sets the Limit field's value.
-func (s *ListTargetsByRuleInput) SetLimit(v int64) *ListTargetsByRuleInput {
-	s.Limit = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceNotFoundException) GoString() string {
+	return s.String()
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListTargetsByRuleInput) SetNextToken(v string) *ListTargetsByRuleInput {
-	s.NextToken = &v
-	return s
+func newErrorResourceNotFoundException(v protocol.ResponseMetadata) error {
+	return &ResourceNotFoundException{
+		RespMetadata: v,
+	}
 }
 
-// SetRule sets the Rule field's value.
-func (s *ListTargetsByRuleInput) SetRule(v string) *ListTargetsByRuleInput {
-	s.Rule = &v
-	return s
+// Code returns the exception type name.
+func (s *ResourceNotFoundException) Code() string {
+	return "ResourceNotFoundException"
 }
 
-type ListTargetsByRuleOutput struct {
-	_ struct{} `type:"structure"`
-
-	// Indicates whether there are additional results to retrieve. If there are
-	// no more results, the value is null.
-	NextToken *string `min:"1" type:"string"`
-
-	// The targets assigned to the rule.
-	Targets []*Target `min:"1" type:"list"`
+// Message returns the exception's message.
+func (s *ResourceNotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// String returns the string representation
-func (s ListTargetsByRuleOutput) String() string {
-	return awsutil.Prettify(s)
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ResourceNotFoundException) OrigErr() error {
+	return nil
 }
 
-// GoString returns the string representation
-func (s ListTargetsByRuleOutput) GoString() string {
-	return s.String()
+func (s *ResourceNotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListTargetsByRuleOutput) SetNextToken(v string) *ListTargetsByRuleOutput {
-	s.NextToken = &v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *ResourceNotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// SetTargets sets the Targets field's value.
-func (s *ListTargetsByRuleOutput) SetTargets(v []*Target) *ListTargetsByRuleOutput {
-	s.Targets = v
-	return s
+// RequestID returns the service's response RequestID for request.
+func (s *ResourceNotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
-
-// This structure specifies the network configuration for an ECS task.
-type NetworkConfiguration struct {
+
+// A RetryPolicy object that includes information about the retry policy settings.
+type RetryPolicy struct {
 	_ struct{} `type:"structure"`
 
-	// Use this structure to specify the VPC subnets and security groups for the
-	// task and whether a public IP address is to be used. This structure is relevant
-	// only for ECS tasks that use the awsvpc network mode.
-	AwsvpcConfiguration *AwsVpcConfiguration `locationName:"awsvpcConfiguration" type:"structure"`
+	// The maximum amount of time, in seconds, to continue to make retry attempts.
+	MaximumEventAgeInSeconds *int64 `min:"60" type:"integer"`
+
+	// The maximum number of retry attempts to make before the request fails. Retry
+	// attempts continue until either the maximum number of attempts is made or
+	// until the duration of the MaximumEventAgeInSeconds is met.
+	MaximumRetryAttempts *int64 `type:"integer"`
 }
 
-// String returns the string representation
-func (s NetworkConfiguration) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RetryPolicy) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s NetworkConfiguration) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RetryPolicy) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *NetworkConfiguration) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "NetworkConfiguration"}
-	if s.AwsvpcConfiguration != nil {
-		if err := s.AwsvpcConfiguration.Validate(); err != nil {
-			invalidParams.AddNested("AwsvpcConfiguration", err.(request.ErrInvalidParams))
-		}
+func (s *RetryPolicy) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "RetryPolicy"}
+	if s.MaximumEventAgeInSeconds != nil && *s.MaximumEventAgeInSeconds < 60 {
+		invalidParams.Add(request.NewErrParamMinValue("MaximumEventAgeInSeconds", 60))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5498,140 +13864,180 @@ func (s *NetworkConfiguration) Validate() error {
 	return nil
 }
 
-// SetAwsvpcConfiguration sets the AwsvpcConfiguration field's value.
-func (s *NetworkConfiguration) SetAwsvpcConfiguration(v *AwsVpcConfiguration) *NetworkConfiguration {
-	s.AwsvpcConfiguration = v
+// SetMaximumEventAgeInSeconds sets the MaximumEventAgeInSeconds field's value.
+func (s *RetryPolicy) SetMaximumEventAgeInSeconds(v int64) *RetryPolicy {
+	s.MaximumEventAgeInSeconds = &v
 	return s
 }
 
-// A partner event source is created by an SaaS partner. If a customer creates
-// a partner event bus that matches this event source, that AWS account can
-// receive events from the partner's applications or services.
-type PartnerEventSource struct {
+// SetMaximumRetryAttempts sets the MaximumRetryAttempts field's value.
+func (s *RetryPolicy) SetMaximumRetryAttempts(v int64) *RetryPolicy {
+	s.MaximumRetryAttempts = &v
+	return s
+}
+
+// Contains information about a rule in Amazon EventBridge.
+type Rule struct {
 	_ struct{} `type:"structure"`
 
-	// The ARN of the partner event source.
-	Arn *string `type:"string"`
+	// The Amazon Resource Name (ARN) of the rule.
+	Arn *string `min:"1" type:"string"`
 
-	// The name of the partner event source.
-	Name *string `type:"string"`
+	// The description of the rule.
+	Description *string `type:"string"`
+
+	// The name or ARN of the event bus associated with the rule. If you omit this,
+	// the default event bus is used.
+	EventBusName *string `min:"1" type:"string"`
+
+	// The event pattern of the rule. For more information, see Events and Event
+	// Patterns (https://docs.aws.amazon.com/eventbridge/latest/userguide/eventbridge-and-event-patterns.html)
+	// in the Amazon EventBridge User Guide.
+	EventPattern *string `type:"string"`
+
+	// If the rule was created on behalf of your account by an Amazon Web Services
+	// service, this field displays the principal name of the service that created
+	// the rule.
+	ManagedBy *string `min:"1" type:"string"`
+
+	// The name of the rule.
+	Name *string `min:"1" type:"string"`
+
+	// The Amazon Resource Name (ARN) of the role that is used for target invocation.
+	//
+	// If you're setting an event bus in another account as the target and that
+	// account granted permission to your account through an organization instead
+	// of directly by the account ID, you must specify a RoleArn with proper permissions
+	// in the Target structure, instead of here in this parameter.
+	RoleArn *string `min:"1" type:"string"`
+
+	// The scheduling expression. For example, "cron(0 20 * * ? *)", "rate(5 minutes)".
+	// For more information, see Creating an Amazon EventBridge rule that runs on
+	// a schedule (https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-create-rule-schedule.html).
+	ScheduleExpression *string `type:"string"`
+
+	// The state of the rule.
+	State *string `type:"string" enum:"RuleState"`
 }
 
-// String returns the string representation
-func (s PartnerEventSource) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Rule) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s PartnerEventSource) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Rule) GoString() string {
 	return s.String()
 }
 
 // SetArn sets the Arn field's value.
-func (s *PartnerEventSource) SetArn(v string) *PartnerEventSource {
+func (s *Rule) SetArn(v string) *Rule {
 	s.Arn = &v
 	return s
 }
 
-// SetName sets the Name field's value.
-func (s *PartnerEventSource) SetName(v string) *PartnerEventSource {
-	s.Name = &v
+// SetDescription sets the Description field's value.
+func (s *Rule) SetDescription(v string) *Rule {
+	s.Description = &v
 	return s
 }
 
-// The AWS account that a partner event source has been offered to.
-type PartnerEventSourceAccount struct {
-	_ struct{} `type:"structure"`
-
-	// The AWS account ID that the partner event source was offered to.
-	Account *string `min:"12" type:"string"`
-
-	// The date and time when the event source was created.
-	CreationTime *time.Time `type:"timestamp"`
-
-	// The date and time when the event source will expire if the AWS account doesn't
-	// create a matching event bus for it.
-	ExpirationTime *time.Time `type:"timestamp"`
-
-	// The state of the event source. If it's ACTIVE, you have already created a
-	// matching event bus for this event source, and that event bus is active. If
-	// it's PENDING, either you haven't yet created a matching event bus, or that
-	// event bus is deactivated. If it's DELETED, you have created a matching event
-	// bus, but the event source has since been deleted.
-	State *string `type:"string" enum:"EventSourceState"`
+// SetEventBusName sets the EventBusName field's value.
+func (s *Rule) SetEventBusName(v string) *Rule {
+	s.EventBusName = &v
+	return s
 }
 
-// String returns the string representation
-func (s PartnerEventSourceAccount) String() string {
-	return awsutil.Prettify(s)
+// SetEventPattern sets the EventPattern field's value.
+func (s *Rule) SetEventPattern(v string) *Rule {
+	s.EventPattern = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s PartnerEventSourceAccount) GoString() string {
-	return s.String()
+// SetManagedBy sets the ManagedBy field's value.
+func (s *Rule) SetManagedBy(v string) *Rule {
+	s.ManagedBy = &v
+	return s
 }
 
-// SetAccount sets the Account field's value.
-func (s *PartnerEventSourceAccount) SetAccount(v string) *PartnerEventSourceAccount {
-	s.Account = &v
+// SetName sets the Name field's value.
+func (s *Rule) SetName(v string) *Rule {
+	s.Name = &v
 	return s
 }
 
-// SetCreationTime sets the CreationTime field's value.
-func (s *PartnerEventSourceAccount) SetCreationTime(v time.Time) *PartnerEventSourceAccount {
-	s.CreationTime = &v
+// SetRoleArn sets the RoleArn field's value.
+func (s *Rule) SetRoleArn(v string) *Rule {
+	s.RoleArn = &v
 	return s
 }
 
-// SetExpirationTime sets the ExpirationTime field's value.
-func (s *PartnerEventSourceAccount) SetExpirationTime(v time.Time) *PartnerEventSourceAccount {
-	s.ExpirationTime = &v
+// SetScheduleExpression sets the ScheduleExpression field's value.
+func (s *Rule) SetScheduleExpression(v string) *Rule {
+	s.ScheduleExpression = &v
 	return s
 }
 
 // SetState sets the State field's value.
-func (s *PartnerEventSourceAccount) SetState(v string) *PartnerEventSourceAccount {
+func (s *Rule) SetState(v string) *Rule {
 	s.State = &v
 	return s
 }
 
-type PutEventsInput struct {
+// This parameter contains the criteria (either InstanceIds or a tag) used to
+// specify which EC2 instances are to be sent the command.
+type RunCommandParameters struct {
 	_ struct{} `type:"structure"`
 
-	// The entry that defines an event in your system. You can specify several parameters
-	// for the entry such as the source and type of the event, resources associated
-	// with the event, and so on.
+	// Currently, we support including only one RunCommandTarget block, which specifies
+	// either an array of InstanceIds or a tag.
 	//
-	// Entries is a required field
-	Entries []*PutEventsRequestEntry `min:"1" type:"list" required:"true"`
+	// RunCommandTargets is a required field
+	RunCommandTargets []*RunCommandTarget `min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
-func (s PutEventsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RunCommandParameters) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s PutEventsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RunCommandParameters) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *PutEventsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "PutEventsInput"}
-	if s.Entries == nil {
-		invalidParams.Add(request.NewErrParamRequired("Entries"))
+func (s *RunCommandParameters) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "RunCommandParameters"}
+	if s.RunCommandTargets == nil {
+		invalidParams.Add(request.NewErrParamRequired("RunCommandTargets"))
 	}
-	if s.Entries != nil && len(s.Entries) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Entries", 1))
+	if s.RunCommandTargets != nil && len(s.RunCommandTargets) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RunCommandTargets", 1))
 	}
-	if s.Entries != nil {
-		for i, v := range s.Entries {
+	if s.RunCommandTargets != nil {
+		for i, v := range s.RunCommandTargets {
 			if v == nil {
 				continue
 			}
 			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Entries", i), err.(request.ErrInvalidParams))
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "RunCommandTargets", i), err.(request.ErrInvalidParams))
 			}
 		}
 	}
@@ -5642,201 +14048,187 @@ func (s *PutEventsInput) Validate() error {
 	return nil
 }
 
-// SetEntries sets the Entries field's value.
-func (s *PutEventsInput) SetEntries(v []*PutEventsRequestEntry) *PutEventsInput {
-	s.Entries = v
-	return s
-}
-
-type PutEventsOutput struct {
-	_ struct{} `type:"structure"`
-
-	// The successfully and unsuccessfully ingested events results. If the ingestion
-	// was successful, the entry has the event ID in it. Otherwise, you can use
-	// the error code and error message to identify the problem with the entry.
-	Entries []*PutEventsResultEntry `type:"list"`
-
-	// The number of failed entries.
-	FailedEntryCount *int64 `type:"integer"`
-}
-
-// String returns the string representation
-func (s PutEventsOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s PutEventsOutput) GoString() string {
-	return s.String()
-}
-
-// SetEntries sets the Entries field's value.
-func (s *PutEventsOutput) SetEntries(v []*PutEventsResultEntry) *PutEventsOutput {
-	s.Entries = v
-	return s
-}
-
-// SetFailedEntryCount sets the FailedEntryCount field's value.
-func (s *PutEventsOutput) SetFailedEntryCount(v int64) *PutEventsOutput {
-	s.FailedEntryCount = &v
+// SetRunCommandTargets sets the RunCommandTargets field's value.
+func (s *RunCommandParameters) SetRunCommandTargets(v []*RunCommandTarget) *RunCommandParameters {
+	s.RunCommandTargets = v
 	return s
 }
 
-// Represents an event to be submitted.
-type PutEventsRequestEntry struct {
+// Information about the EC2 instances that are to be sent the command, specified
+// as key-value pairs. Each RunCommandTarget block can include only one key,
+// but this key may specify multiple values.
+type RunCommandTarget struct {
 	_ struct{} `type:"structure"`
 
-	// A valid JSON string. There is no other schema imposed. The JSON string can
-	// contain fields and nested subobjects.
-	Detail *string `type:"string"`
-
-	// Free-form string used to decide which fields to expect in the event detail.
-	DetailType *string `type:"string"`
-
-	// The event bus that will receive the event. Only the rules that are associated
-	// with this event bus can match the event.
-	EventBusName *string `min:"1" type:"string"`
-
-	// AWS resources, identified by Amazon Resource Name (ARN), that the event primarily
-	// concerns. Any number, including zero, can be present.
-	Resources []*string `type:"list"`
-
-	// The source of the event. This field is required.
-	Source *string `type:"string"`
+	// Can be either tag: tag-key or InstanceIds.
+	//
+	// Key is a required field
+	Key *string `min:"1" type:"string" required:"true"`
 
-	// The timestamp of the event, per RFC3339 (https://www.rfc-editor.org/rfc/rfc3339.txt).
-	// If no timestamp is provided, the timestamp of the PutEvents call is used.
-	Time *time.Time `type:"timestamp"`
+	// If Key is tag: tag-key, Values is a list of tag values. If Key is InstanceIds,
+	// Values is a list of Amazon EC2 instance IDs.
+	//
+	// Values is a required field
+	Values []*string `min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
-func (s PutEventsRequestEntry) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RunCommandTarget) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s PutEventsRequestEntry) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RunCommandTarget) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *PutEventsRequestEntry) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "PutEventsRequestEntry"}
-	if s.EventBusName != nil && len(*s.EventBusName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("EventBusName", 1))
+func (s *RunCommandTarget) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "RunCommandTarget"}
+	if s.Key == nil {
+		invalidParams.Add(request.NewErrParamRequired("Key"))
 	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
+	if s.Key != nil && len(*s.Key) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Key", 1))
+	}
+	if s.Values == nil {
+		invalidParams.Add(request.NewErrParamRequired("Values"))
+	}
+	if s.Values != nil && len(s.Values) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Values", 1))
 	}
-	return nil
-}
-
-// SetDetail sets the Detail field's value.
-func (s *PutEventsRequestEntry) SetDetail(v string) *PutEventsRequestEntry {
-	s.Detail = &v
-	return s
-}
-
-// SetDetailType sets the DetailType field's value.
-func (s *PutEventsRequestEntry) SetDetailType(v string) *PutEventsRequestEntry {
-	s.DetailType = &v
-	return s
-}
-
-// SetEventBusName sets the EventBusName field's value.
-func (s *PutEventsRequestEntry) SetEventBusName(v string) *PutEventsRequestEntry {
-	s.EventBusName = &v
-	return s
-}
 
-// SetResources sets the Resources field's value.
-func (s *PutEventsRequestEntry) SetResources(v []*string) *PutEventsRequestEntry {
-	s.Resources = v
-	return s
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetSource sets the Source field's value.
-func (s *PutEventsRequestEntry) SetSource(v string) *PutEventsRequestEntry {
-	s.Source = &v
+// SetKey sets the Key field's value.
+func (s *RunCommandTarget) SetKey(v string) *RunCommandTarget {
+	s.Key = &v
 	return s
 }
 
-// SetTime sets the Time field's value.
-func (s *PutEventsRequestEntry) SetTime(v time.Time) *PutEventsRequestEntry {
-	s.Time = &v
+// SetValues sets the Values field's value.
+func (s *RunCommandTarget) SetValues(v []*string) *RunCommandTarget {
+	s.Values = v
 	return s
 }
 
-// Represents an event that failed to be submitted.
-type PutEventsResultEntry struct {
+// Name/Value pair of a parameter to start execution of a SageMaker Model Building
+// Pipeline.
+type SageMakerPipelineParameter struct {
 	_ struct{} `type:"structure"`
 
-	// The error code that indicates why the event submission failed.
-	ErrorCode *string `type:"string"`
-
-	// The error message that explains why the event submission failed.
-	ErrorMessage *string `type:"string"`
+	// Name of parameter to start execution of a SageMaker Model Building Pipeline.
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
 
-	// The ID of the event.
-	EventId *string `type:"string"`
+	// Value of parameter to start execution of a SageMaker Model Building Pipeline.
+	//
+	// Value is a required field
+	Value *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s PutEventsResultEntry) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SageMakerPipelineParameter) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s PutEventsResultEntry) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SageMakerPipelineParameter) GoString() string {
 	return s.String()
 }
 
-// SetErrorCode sets the ErrorCode field's value.
-func (s *PutEventsResultEntry) SetErrorCode(v string) *PutEventsResultEntry {
-	s.ErrorCode = &v
-	return s
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *SageMakerPipelineParameter) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "SageMakerPipelineParameter"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+	if s.Value == nil {
+		invalidParams.Add(request.NewErrParamRequired("Value"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetErrorMessage sets the ErrorMessage field's value.
-func (s *PutEventsResultEntry) SetErrorMessage(v string) *PutEventsResultEntry {
-	s.ErrorMessage = &v
+// SetName sets the Name field's value.
+func (s *SageMakerPipelineParameter) SetName(v string) *SageMakerPipelineParameter {
+	s.Name = &v
 	return s
 }
 
-// SetEventId sets the EventId field's value.
-func (s *PutEventsResultEntry) SetEventId(v string) *PutEventsResultEntry {
-	s.EventId = &v
+// SetValue sets the Value field's value.
+func (s *SageMakerPipelineParameter) SetValue(v string) *SageMakerPipelineParameter {
+	s.Value = &v
 	return s
 }
 
-type PutPartnerEventsInput struct {
+// These are custom parameters to use when the target is a SageMaker Model Building
+// Pipeline that starts based on EventBridge events.
+type SageMakerPipelineParameters struct {
 	_ struct{} `type:"structure"`
 
-	// The list of events to write to the event bus.
-	//
-	// Entries is a required field
-	Entries []*PutPartnerEventsRequestEntry `min:"1" type:"list" required:"true"`
+	// List of Parameter names and values for SageMaker Model Building Pipeline
+	// execution.
+	PipelineParameterList []*SageMakerPipelineParameter `type:"list"`
 }
 
-// String returns the string representation
-func (s PutPartnerEventsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SageMakerPipelineParameters) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s PutPartnerEventsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SageMakerPipelineParameters) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *PutPartnerEventsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "PutPartnerEventsInput"}
-	if s.Entries == nil {
-		invalidParams.Add(request.NewErrParamRequired("Entries"))
-	}
-	if s.Entries != nil && len(s.Entries) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Entries", 1))
+func (s *SageMakerPipelineParameters) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "SageMakerPipelineParameters"}
+	if s.PipelineParameterList != nil {
+		for i, v := range s.PipelineParameterList {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PipelineParameterList", i), err.(request.ErrInvalidParams))
+			}
+		}
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5845,343 +14237,337 @@ func (s *PutPartnerEventsInput) Validate() error {
 	return nil
 }
 
-// SetEntries sets the Entries field's value.
-func (s *PutPartnerEventsInput) SetEntries(v []*PutPartnerEventsRequestEntry) *PutPartnerEventsInput {
-	s.Entries = v
+// SetPipelineParameterList sets the PipelineParameterList field's value.
+func (s *SageMakerPipelineParameters) SetPipelineParameterList(v []*SageMakerPipelineParameter) *SageMakerPipelineParameters {
+	s.PipelineParameterList = v
 	return s
 }
 
-type PutPartnerEventsOutput struct {
+// This structure includes the custom parameter to be used when the target is
+// an SQS FIFO queue.
+type SqsParameters struct {
 	_ struct{} `type:"structure"`
 
-	// The list of events from this operation that were successfully written to
-	// the partner event bus.
-	Entries []*PutPartnerEventsResultEntry `type:"list"`
-
-	// The number of events from this operation that couldn't be written to the
-	// partner event bus.
-	FailedEntryCount *int64 `type:"integer"`
+	// The FIFO message group ID to use as the target.
+	MessageGroupId *string `type:"string"`
 }
 
-// String returns the string representation
-func (s PutPartnerEventsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SqsParameters) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s PutPartnerEventsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SqsParameters) GoString() string {
 	return s.String()
 }
 
-// SetEntries sets the Entries field's value.
-func (s *PutPartnerEventsOutput) SetEntries(v []*PutPartnerEventsResultEntry) *PutPartnerEventsOutput {
-	s.Entries = v
-	return s
-}
-
-// SetFailedEntryCount sets the FailedEntryCount field's value.
-func (s *PutPartnerEventsOutput) SetFailedEntryCount(v int64) *PutPartnerEventsOutput {
-	s.FailedEntryCount = &v
+// SetMessageGroupId sets the MessageGroupId field's value.
+func (s *SqsParameters) SetMessageGroupId(v string) *SqsParameters {
+	s.MessageGroupId = &v
 	return s
 }
 
-// The details about an event generated by an SaaS partner.
-type PutPartnerEventsRequestEntry struct {
+type StartReplayInput struct {
 	_ struct{} `type:"structure"`
 
-	// A valid JSON string. There is no other schema imposed. The JSON string can
-	// contain fields and nested subobjects.
-	Detail *string `type:"string"`
+	// A description for the replay to start.
+	Description *string `type:"string"`
 
-	// A free-form string used to decide which fields to expect in the event detail.
-	DetailType *string `type:"string"`
+	// A ReplayDestination object that includes details about the destination for
+	// the replay.
+	//
+	// Destination is a required field
+	Destination *ReplayDestination `type:"structure" required:"true"`
 
-	// AWS resources, identified by Amazon Resource Name (ARN), that the event primarily
-	// concerns. Any number, including zero, can be present.
-	Resources []*string `type:"list"`
+	// A time stamp for the time to stop replaying events. Only events that occurred
+	// between the EventStartTime and EventEndTime are replayed.
+	//
+	// EventEndTime is a required field
+	EventEndTime *time.Time `type:"timestamp" required:"true"`
 
-	// The event source that is generating the evntry.
-	Source *string `type:"string"`
+	// The ARN of the archive to replay events from.
+	//
+	// EventSourceArn is a required field
+	EventSourceArn *string `min:"1" type:"string" required:"true"`
 
-	// The date and time of the event.
-	Time *time.Time `type:"timestamp"`
+	// A time stamp for the time to start replaying events. Only events that occurred
+	// between the EventStartTime and EventEndTime are replayed.
+	//
+	// EventStartTime is a required field
+	EventStartTime *time.Time `type:"timestamp" required:"true"`
+
+	// The name of the replay to start.
+	//
+	// ReplayName is a required field
+	ReplayName *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s PutPartnerEventsRequestEntry) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StartReplayInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s PutPartnerEventsRequestEntry) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StartReplayInput) GoString() string {
 	return s.String()
 }
 
-// SetDetail sets the Detail field's value.
-func (s *PutPartnerEventsRequestEntry) SetDetail(v string) *PutPartnerEventsRequestEntry {
-	s.Detail = &v
-	return s
-}
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *StartReplayInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartReplayInput"}
+	if s.Destination == nil {
+		invalidParams.Add(request.NewErrParamRequired("Destination"))
+	}
+	if s.EventEndTime == nil {
+		invalidParams.Add(request.NewErrParamRequired("EventEndTime"))
+	}
+	if s.EventSourceArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("EventSourceArn"))
+	}
+	if s.EventSourceArn != nil && len(*s.EventSourceArn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EventSourceArn", 1))
+	}
+	if s.EventStartTime == nil {
+		invalidParams.Add(request.NewErrParamRequired("EventStartTime"))
+	}
+	if s.ReplayName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ReplayName"))
+	}
+	if s.ReplayName != nil && len(*s.ReplayName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ReplayName", 1))
+	}
+	if s.Destination != nil {
+		if err := s.Destination.Validate(); err != nil {
+			invalidParams.AddNested("Destination", err.(request.ErrInvalidParams))
+		}
+	}
 
-// SetDetailType sets the DetailType field's value.
-func (s *PutPartnerEventsRequestEntry) SetDetailType(v string) *PutPartnerEventsRequestEntry {
-	s.DetailType = &v
-	return s
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetResources sets the Resources field's value.
-func (s *PutPartnerEventsRequestEntry) SetResources(v []*string) *PutPartnerEventsRequestEntry {
-	s.Resources = v
+// SetDescription sets the Description field's value.
+func (s *StartReplayInput) SetDescription(v string) *StartReplayInput {
+	s.Description = &v
 	return s
 }
 
-// SetSource sets the Source field's value.
-func (s *PutPartnerEventsRequestEntry) SetSource(v string) *PutPartnerEventsRequestEntry {
-	s.Source = &v
+// SetDestination sets the Destination field's value.
+func (s *StartReplayInput) SetDestination(v *ReplayDestination) *StartReplayInput {
+	s.Destination = v
 	return s
 }
 
-// SetTime sets the Time field's value.
-func (s *PutPartnerEventsRequestEntry) SetTime(v time.Time) *PutPartnerEventsRequestEntry {
-	s.Time = &v
+// SetEventEndTime sets the EventEndTime field's value.
+func (s *StartReplayInput) SetEventEndTime(v time.Time) *StartReplayInput {
+	s.EventEndTime = &v
 	return s
 }
 
-// Represents an event that a partner tried to generate but failed.
-type PutPartnerEventsResultEntry struct {
-	_ struct{} `type:"structure"`
-
-	// The error code that indicates why the event submission failed.
-	ErrorCode *string `type:"string"`
-
-	// The error message that explains why the event submission failed.
-	ErrorMessage *string `type:"string"`
-
-	// The ID of the event.
-	EventId *string `type:"string"`
-}
-
-// String returns the string representation
-func (s PutPartnerEventsResultEntry) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s PutPartnerEventsResultEntry) GoString() string {
-	return s.String()
-}
-
-// SetErrorCode sets the ErrorCode field's value.
-func (s *PutPartnerEventsResultEntry) SetErrorCode(v string) *PutPartnerEventsResultEntry {
-	s.ErrorCode = &v
+// SetEventSourceArn sets the EventSourceArn field's value.
+func (s *StartReplayInput) SetEventSourceArn(v string) *StartReplayInput {
+	s.EventSourceArn = &v
 	return s
 }
 
-// SetErrorMessage sets the ErrorMessage field's value.
-func (s *PutPartnerEventsResultEntry) SetErrorMessage(v string) *PutPartnerEventsResultEntry {
-	s.ErrorMessage = &v
+// SetEventStartTime sets the EventStartTime field's value.
+func (s *StartReplayInput) SetEventStartTime(v time.Time) *StartReplayInput {
+	s.EventStartTime = &v
 	return s
 }
 
-// SetEventId sets the EventId field's value.
-func (s *PutPartnerEventsResultEntry) SetEventId(v string) *PutPartnerEventsResultEntry {
-	s.EventId = &v
+// SetReplayName sets the ReplayName field's value.
+func (s *StartReplayInput) SetReplayName(v string) *StartReplayInput {
+	s.ReplayName = &v
 	return s
 }
 
-type PutPermissionInput struct {
+type StartReplayOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The action that you're enabling the other account to perform. Currently,
-	// this must be events:PutEvents.
-	//
-	// Action is a required field
-	Action *string `min:"1" type:"string" required:"true"`
-
-	// This parameter enables you to limit the permission to accounts that fulfill
-	// a certain condition, such as being a member of a certain AWS organization.
-	// For more information about AWS Organizations, see What Is AWS Organizations?
-	// (https://docs.aws.amazon.com/organizations/latest/userguide/orgs_introduction.html)
-	// in the AWS Organizations User Guide.
-	//
-	// If you specify Condition with an AWS organization ID and specify "*" as the
-	// value for Principal, you grant permission to all the accounts in the named
-	// organization.
-	//
-	// The Condition is a JSON string that must contain Type, Key, and Value fields.
-	Condition *Condition `type:"structure"`
-
-	// The event bus associated with the rule. If you omit this, the default event
-	// bus is used.
-	EventBusName *string `min:"1" type:"string"`
-
-	// The 12-digit AWS account ID that you are permitting to put events to your
-	// default event bus. Specify "*" to permit any account to put events to your
-	// default event bus.
-	//
-	// If you specify "*" without specifying Condition, avoid creating rules that
-	// might match undesirable events. To create more secure rules, make sure that
-	// the event pattern for each rule contains an account field with a specific
-	// account ID to receive events from. Rules with an account field don't match
-	// any events sent from other accounts.
-	//
-	// Principal is a required field
-	Principal *string `min:"1" type:"string" required:"true"`
+	// The ARN of the replay.
+	ReplayArn *string `min:"1" type:"string"`
 
-	// An identifier string for the external account that you're granting permissions
-	// to. If you later want to revoke the permission for this external account,
-	// specify this StatementId when you run RemovePermission.
-	//
-	// StatementId is a required field
-	StatementId *string `min:"1" type:"string" required:"true"`
-}
+	// The time at which the replay started.
+	ReplayStartTime *time.Time `type:"timestamp"`
 
-// String returns the string representation
-func (s PutPermissionInput) String() string {
-	return awsutil.Prettify(s)
-}
+	// The state of the replay.
+	State *string `type:"string" enum:"ReplayState"`
 
-// GoString returns the string representation
-func (s PutPermissionInput) GoString() string {
-	return s.String()
+	// The reason that the replay is in the state.
+	StateReason *string `type:"string"`
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *PutPermissionInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "PutPermissionInput"}
-	if s.Action == nil {
-		invalidParams.Add(request.NewErrParamRequired("Action"))
-	}
-	if s.Action != nil && len(*s.Action) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Action", 1))
-	}
-	if s.EventBusName != nil && len(*s.EventBusName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("EventBusName", 1))
-	}
-	if s.Principal == nil {
-		invalidParams.Add(request.NewErrParamRequired("Principal"))
-	}
-	if s.Principal != nil && len(*s.Principal) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Principal", 1))
-	}
-	if s.StatementId == nil {
-		invalidParams.Add(request.NewErrParamRequired("StatementId"))
-	}
-	if s.StatementId != nil && len(*s.StatementId) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("StatementId", 1))
-	}
-	if s.Condition != nil {
-		if err := s.Condition.Validate(); err != nil {
-			invalidParams.AddNested("Condition", err.(request.ErrInvalidParams))
-		}
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StartReplayOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetAction sets the Action field's value.
-func (s *PutPermissionInput) SetAction(v string) *PutPermissionInput {
-	s.Action = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StartReplayOutput) GoString() string {
+	return s.String()
 }
 
-// SetCondition sets the Condition field's value.
-func (s *PutPermissionInput) SetCondition(v *Condition) *PutPermissionInput {
-	s.Condition = v
+// SetReplayArn sets the ReplayArn field's value.
+func (s *StartReplayOutput) SetReplayArn(v string) *StartReplayOutput {
+	s.ReplayArn = &v
 	return s
 }
 
-// SetEventBusName sets the EventBusName field's value.
-func (s *PutPermissionInput) SetEventBusName(v string) *PutPermissionInput {
-	s.EventBusName = &v
+// SetReplayStartTime sets the ReplayStartTime field's value.
+func (s *StartReplayOutput) SetReplayStartTime(v time.Time) *StartReplayOutput {
+	s.ReplayStartTime = &v
 	return s
 }
 
-// SetPrincipal sets the Principal field's value.
-func (s *PutPermissionInput) SetPrincipal(v string) *PutPermissionInput {
-	s.Principal = &v
+// SetState sets the State field's value.
+func (s *StartReplayOutput) SetState(v string) *StartReplayOutput {
+	s.State = &v
 	return s
 }
 
-// SetStatementId sets the StatementId field's value.
-func (s *PutPermissionInput) SetStatementId(v string) *PutPermissionInput {
-	s.StatementId = &v
+// SetStateReason sets the StateReason field's value.
+func (s *StartReplayOutput) SetStateReason(v string) *StartReplayOutput {
+	s.StateReason = &v
 	return s
 }
 
-type PutPermissionOutput struct {
+// A key-value pair associated with an Amazon Web Services resource. In EventBridge,
+// rules and event buses support tagging.
+type Tag struct {
 	_ struct{} `type:"structure"`
+
+	// A string you can use to assign a value. The combination of tag keys and values
+	// can help you organize and categorize your resources.
+	//
+	// Key is a required field
+	Key *string `min:"1" type:"string" required:"true"`
+
+	// The value for the specified tag key.
+	//
+	// Value is a required field
+	Value *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s PutPermissionOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Tag) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s PutPermissionOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Tag) GoString() string {
 	return s.String()
 }
 
-type PutRuleInput struct {
-	_ struct{} `type:"structure"`
-
-	// A description of the rule.
-	Description *string `type:"string"`
-
-	// The event bus to associate with this rule. If you omit this, the default
-	// event bus is used.
-	EventBusName *string `min:"1" type:"string"`
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *Tag) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key == nil {
+		invalidParams.Add(request.NewErrParamRequired("Key"))
+	}
+	if s.Key != nil && len(*s.Key) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Key", 1))
+	}
+	if s.Value == nil {
+		invalidParams.Add(request.NewErrParamRequired("Value"))
+	}
 
-	// The event pattern. For more information, see Event Patterns (https://docs.aws.amazon.com/eventbridge/latest/userguide/eventbridge-and-event-patterns.html)
-	// in the Amazon EventBridge User Guide.
-	EventPattern *string `type:"string"`
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
 
-	// The name of the rule that you're creating or updating.
-	//
-	// Name is a required field
-	Name *string `min:"1" type:"string" required:"true"`
+// SetKey sets the Key field's value.
+func (s *Tag) SetKey(v string) *Tag {
+	s.Key = &v
+	return s
+}
 
-	// The Amazon Resource Name (ARN) of the IAM role associated with the rule.
-	RoleArn *string `min:"1" type:"string"`
+// SetValue sets the Value field's value.
+func (s *Tag) SetValue(v string) *Tag {
+	s.Value = &v
+	return s
+}
 
-	// The scheduling expression: for example, "cron(0 20 * * ? *)" or "rate(5 minutes)".
-	ScheduleExpression *string `type:"string"`
+type TagResourceInput struct {
+	_ struct{} `type:"structure"`
 
-	// Indicates whether the rule is enabled or disabled.
-	State *string `type:"string" enum:"RuleState"`
+	// The ARN of the EventBridge resource that you're adding tags to.
+	//
+	// ResourceARN is a required field
+	ResourceARN *string `min:"1" type:"string" required:"true"`
 
-	// The list of key-value pairs to associate with the rule.
-	Tags []*Tag `type:"list"`
+	// The list of key-value pairs to associate with the resource.
+	//
+	// Tags is a required field
+	Tags []*Tag `type:"list" required:"true"`
 }
 
-// String returns the string representation
-func (s PutRuleInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s PutRuleInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *PutRuleInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "PutRuleInput"}
-	if s.EventBusName != nil && len(*s.EventBusName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("EventBusName", 1))
-	}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
+func (s *TagResourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "TagResourceInput"}
+	if s.ResourceARN == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceARN"))
 	}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	if s.ResourceARN != nil && len(*s.ResourceARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ResourceARN", 1))
 	}
-	if s.RoleArn != nil && len(*s.RoleArn) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("RoleArn", 1))
+	if s.Tags == nil {
+		invalidParams.Add(request.NewErrParamRequired("Tags"))
 	}
 	if s.Tags != nil {
 		for i, v := range s.Tags {
@@ -6200,131 +14586,223 @@ func (s *PutRuleInput) Validate() error {
 	return nil
 }
 
-// SetDescription sets the Description field's value.
-func (s *PutRuleInput) SetDescription(v string) *PutRuleInput {
-	s.Description = &v
+// SetResourceARN sets the ResourceARN field's value.
+func (s *TagResourceInput) SetResourceARN(v string) *TagResourceInput {
+	s.ResourceARN = &v
 	return s
 }
 
-// SetEventBusName sets the EventBusName field's value.
-func (s *PutRuleInput) SetEventBusName(v string) *PutRuleInput {
-	s.EventBusName = &v
+// SetTags sets the Tags field's value.
+func (s *TagResourceInput) SetTags(v []*Tag) *TagResourceInput {
+	s.Tags = v
 	return s
 }
 
-// SetEventPattern sets the EventPattern field's value.
-func (s *PutRuleInput) SetEventPattern(v string) *PutRuleInput {
-	s.EventPattern = &v
-	return s
+type TagResourceOutput struct {
+	_ struct{} `type:"structure"`
 }
 
-// SetName sets the Name field's value.
-func (s *PutRuleInput) SetName(v string) *PutRuleInput {
-	s.Name = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetRoleArn sets the RoleArn field's value.
-func (s *PutRuleInput) SetRoleArn(v string) *PutRuleInput {
-	s.RoleArn = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceOutput) GoString() string {
+	return s.String()
 }
 
-// SetScheduleExpression sets the ScheduleExpression field's value.
-func (s *PutRuleInput) SetScheduleExpression(v string) *PutRuleInput {
-	s.ScheduleExpression = &v
-	return s
-}
+// Targets are the resources to be invoked when a rule is triggered. For a complete
+// list of services and resources that can be set as a target, see PutTargets
+// (https://docs.aws.amazon.com/eventbridge/latest/APIReference/API_PutTargets.html).
+//
+// If you are setting the event bus of another account as the target, and that
+// account granted permission to your account through an organization instead
+// of directly by the account ID, then you must specify a RoleArn with proper
+// permissions in the Target structure. For more information, see Sending and
+// Receiving Events Between Amazon Web Services Accounts (https://docs.aws.amazon.com/eventbridge/latest/userguide/eventbridge-cross-account-event-delivery.html)
+// in the Amazon EventBridge User Guide.
+type Target struct {
+	_ struct{} `type:"structure"`
 
-// SetState sets the State field's value.
-func (s *PutRuleInput) SetState(v string) *PutRuleInput {
-	s.State = &v
-	return s
-}
+	// The Amazon Resource Name (ARN) of the target.
+	//
+	// Arn is a required field
+	Arn *string `min:"1" type:"string" required:"true"`
 
-// SetTags sets the Tags field's value.
-func (s *PutRuleInput) SetTags(v []*Tag) *PutRuleInput {
-	s.Tags = v
-	return s
-}
+	// If the event target is an Batch job, this contains the job definition, job
+	// name, and other parameters. For more information, see Jobs (https://docs.aws.amazon.com/batch/latest/userguide/jobs.html)
+	// in the Batch User Guide.
+	BatchParameters *BatchParameters `type:"structure"`
 
-type PutRuleOutput struct {
-	_ struct{} `type:"structure"`
+	// The DeadLetterConfig that defines the target queue to send dead-letter queue
+	// events to.
+	DeadLetterConfig *DeadLetterConfig `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the rule.
-	RuleArn *string `min:"1" type:"string"`
-}
+	// Contains the Amazon ECS task definition and task count to be used, if the
+	// event target is an Amazon ECS task. For more information about Amazon ECS
+	// tasks, see Task Definitions (https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task_defintions.html)
+	// in the Amazon EC2 Container Service Developer Guide.
+	EcsParameters *EcsParameters `type:"structure"`
 
-// String returns the string representation
-func (s PutRuleOutput) String() string {
-	return awsutil.Prettify(s)
-}
+	// Contains the HTTP parameters to use when the target is a API Gateway REST
+	// endpoint or EventBridge ApiDestination.
+	//
+	// If you specify an API Gateway REST API or EventBridge ApiDestination as a
+	// target, you can use this parameter to specify headers, path parameters, and
+	// query string keys/values as part of your target invoking request. If you're
+	// using ApiDestinations, the corresponding Connection can also have these values
+	// configured. In case of any conflicting keys, values from the Connection take
+	// precedence.
+	HttpParameters *HttpParameters `type:"structure"`
+
+	// The ID of the target. We recommend using a memorable and unique string.
+	//
+	// Id is a required field
+	Id *string `min:"1" type:"string" required:"true"`
 
-// GoString returns the string representation
-func (s PutRuleOutput) GoString() string {
-	return s.String()
-}
+	// Valid JSON text passed to the target. In this case, nothing from the event
+	// itself is passed to the target. For more information, see The JavaScript
+	// Object Notation (JSON) Data Interchange Format (http://www.rfc-editor.org/rfc/rfc7159.txt).
+	Input *string `type:"string"`
 
-// SetRuleArn sets the RuleArn field's value.
-func (s *PutRuleOutput) SetRuleArn(v string) *PutRuleOutput {
-	s.RuleArn = &v
-	return s
-}
+	// The value of the JSONPath that is used for extracting part of the matched
+	// event when passing it to the target. You must use JSON dot notation, not
+	// bracket notation. For more information about JSON paths, see JSONPath (http://goessner.net/articles/JsonPath/).
+	InputPath *string `type:"string"`
 
-type PutTargetsInput struct {
-	_ struct{} `type:"structure"`
+	// Settings to enable you to provide custom input to a target based on certain
+	// event data. You can extract one or more key-value pairs from the event and
+	// then use that data to send customized input to the target.
+	InputTransformer *InputTransformer `type:"structure"`
 
-	// The name of the event bus associated with the rule. If you omit this, the
-	// default event bus is used.
-	EventBusName *string `min:"1" type:"string"`
+	// The custom parameter you can use to control the shard assignment, when the
+	// target is a Kinesis data stream. If you do not include this parameter, the
+	// default is to use the eventId as the partition key.
+	KinesisParameters *KinesisParameters `type:"structure"`
 
-	// The name of the rule.
+	// Contains the Amazon Redshift Data API parameters to use when the target is
+	// a Amazon Redshift cluster.
 	//
-	// Rule is a required field
-	Rule *string `min:"1" type:"string" required:"true"`
+	// If you specify a Amazon Redshift Cluster as a Target, you can use this to
+	// specify parameters to invoke the Amazon Redshift Data API ExecuteStatement
+	// based on EventBridge events.
+	RedshiftDataParameters *RedshiftDataParameters `type:"structure"`
 
-	// The targets to update or add to the rule.
+	// The RetryPolicy object that contains the retry policy configuration to use
+	// for the dead-letter queue.
+	RetryPolicy *RetryPolicy `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the IAM role to be used for this target
+	// when the rule is triggered. If one rule triggers multiple targets, you can
+	// use a different IAM role for each target.
+	RoleArn *string `min:"1" type:"string"`
+
+	// Parameters used when you are using the rule to invoke Amazon EC2 Run Command.
+	RunCommandParameters *RunCommandParameters `type:"structure"`
+
+	// Contains the SageMaker Model Building Pipeline parameters to start execution
+	// of a SageMaker Model Building Pipeline.
 	//
-	// Targets is a required field
-	Targets []*Target `min:"1" type:"list" required:"true"`
+	// If you specify a SageMaker Model Building Pipeline as a target, you can use
+	// this to specify parameters to start a pipeline execution based on EventBridge
+	// events.
+	SageMakerPipelineParameters *SageMakerPipelineParameters `type:"structure"`
+
+	// Contains the message group ID to use when the target is a FIFO queue.
+	//
+	// If you specify an SQS FIFO queue as a target, the queue must have content-based
+	// deduplication enabled.
+	SqsParameters *SqsParameters `type:"structure"`
 }
 
-// String returns the string representation
-func (s PutTargetsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Target) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s PutTargetsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Target) GoString() string {
 	return s.String()
-}
-
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *PutTargetsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "PutTargetsInput"}
-	if s.EventBusName != nil && len(*s.EventBusName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("EventBusName", 1))
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *Target) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "Target"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
 	}
-	if s.Rule == nil {
-		invalidParams.Add(request.NewErrParamRequired("Rule"))
+	if s.Arn != nil && len(*s.Arn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 1))
 	}
-	if s.Rule != nil && len(*s.Rule) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Rule", 1))
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
 	}
-	if s.Targets == nil {
-		invalidParams.Add(request.NewErrParamRequired("Targets"))
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
 	}
-	if s.Targets != nil && len(s.Targets) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Targets", 1))
+	if s.RoleArn != nil && len(*s.RoleArn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RoleArn", 1))
 	}
-	if s.Targets != nil {
-		for i, v := range s.Targets {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Targets", i), err.(request.ErrInvalidParams))
-			}
+	if s.BatchParameters != nil {
+		if err := s.BatchParameters.Validate(); err != nil {
+			invalidParams.AddNested("BatchParameters", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.DeadLetterConfig != nil {
+		if err := s.DeadLetterConfig.Validate(); err != nil {
+			invalidParams.AddNested("DeadLetterConfig", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.EcsParameters != nil {
+		if err := s.EcsParameters.Validate(); err != nil {
+			invalidParams.AddNested("EcsParameters", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.InputTransformer != nil {
+		if err := s.InputTransformer.Validate(); err != nil {
+			invalidParams.AddNested("InputTransformer", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.KinesisParameters != nil {
+		if err := s.KinesisParameters.Validate(); err != nil {
+			invalidParams.AddNested("KinesisParameters", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.RedshiftDataParameters != nil {
+		if err := s.RedshiftDataParameters.Validate(); err != nil {
+			invalidParams.AddNested("RedshiftDataParameters", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.RetryPolicy != nil {
+		if err := s.RetryPolicy.Validate(); err != nil {
+			invalidParams.AddNested("RetryPolicy", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.RunCommandParameters != nil {
+		if err := s.RunCommandParameters.Validate(); err != nil {
+			invalidParams.AddNested("RunCommandParameters", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.SageMakerPipelineParameters != nil {
+		if err := s.SageMakerPipelineParameters.Validate(); err != nil {
+			invalidParams.AddNested("SageMakerPipelineParameters", err.(request.ErrInvalidParams))
 		}
 	}
 
@@ -6334,220 +14812,159 @@ func (s *PutTargetsInput) Validate() error {
 	return nil
 }
 
-// SetEventBusName sets the EventBusName field's value.
-func (s *PutTargetsInput) SetEventBusName(v string) *PutTargetsInput {
-	s.EventBusName = &v
+// SetArn sets the Arn field's value.
+func (s *Target) SetArn(v string) *Target {
+	s.Arn = &v
 	return s
 }
 
-// SetRule sets the Rule field's value.
-func (s *PutTargetsInput) SetRule(v string) *PutTargetsInput {
-	s.Rule = &v
+// SetBatchParameters sets the BatchParameters field's value.
+func (s *Target) SetBatchParameters(v *BatchParameters) *Target {
+	s.BatchParameters = v
 	return s
 }
 
-// SetTargets sets the Targets field's value.
-func (s *PutTargetsInput) SetTargets(v []*Target) *PutTargetsInput {
-	s.Targets = v
+// SetDeadLetterConfig sets the DeadLetterConfig field's value.
+func (s *Target) SetDeadLetterConfig(v *DeadLetterConfig) *Target {
+	s.DeadLetterConfig = v
 	return s
 }
 
-type PutTargetsOutput struct {
-	_ struct{} `type:"structure"`
-
-	// The failed target entries.
-	FailedEntries []*PutTargetsResultEntry `type:"list"`
-
-	// The number of failed entries.
-	FailedEntryCount *int64 `type:"integer"`
-}
-
-// String returns the string representation
-func (s PutTargetsOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s PutTargetsOutput) GoString() string {
-	return s.String()
-}
-
-// SetFailedEntries sets the FailedEntries field's value.
-func (s *PutTargetsOutput) SetFailedEntries(v []*PutTargetsResultEntry) *PutTargetsOutput {
-	s.FailedEntries = v
+// SetEcsParameters sets the EcsParameters field's value.
+func (s *Target) SetEcsParameters(v *EcsParameters) *Target {
+	s.EcsParameters = v
 	return s
 }
 
-// SetFailedEntryCount sets the FailedEntryCount field's value.
-func (s *PutTargetsOutput) SetFailedEntryCount(v int64) *PutTargetsOutput {
-	s.FailedEntryCount = &v
+// SetHttpParameters sets the HttpParameters field's value.
+func (s *Target) SetHttpParameters(v *HttpParameters) *Target {
+	s.HttpParameters = v
 	return s
 }
 
-// Represents a target that failed to be added to a rule.
-type PutTargetsResultEntry struct {
-	_ struct{} `type:"structure"`
-
-	// The error code that indicates why the target addition failed. If the value
-	// is ConcurrentModificationException, too many requests were made at the same
-	// time.
-	ErrorCode *string `type:"string"`
-
-	// The error message that explains why the target addition failed.
-	ErrorMessage *string `type:"string"`
-
-	// The ID of the target.
-	TargetId *string `min:"1" type:"string"`
-}
-
-// String returns the string representation
-func (s PutTargetsResultEntry) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s PutTargetsResultEntry) GoString() string {
-	return s.String()
-}
-
-// SetErrorCode sets the ErrorCode field's value.
-func (s *PutTargetsResultEntry) SetErrorCode(v string) *PutTargetsResultEntry {
-	s.ErrorCode = &v
+// SetId sets the Id field's value.
+func (s *Target) SetId(v string) *Target {
+	s.Id = &v
 	return s
 }
 
-// SetErrorMessage sets the ErrorMessage field's value.
-func (s *PutTargetsResultEntry) SetErrorMessage(v string) *PutTargetsResultEntry {
-	s.ErrorMessage = &v
+// SetInput sets the Input field's value.
+func (s *Target) SetInput(v string) *Target {
+	s.Input = &v
 	return s
 }
 
-// SetTargetId sets the TargetId field's value.
-func (s *PutTargetsResultEntry) SetTargetId(v string) *PutTargetsResultEntry {
-	s.TargetId = &v
+// SetInputPath sets the InputPath field's value.
+func (s *Target) SetInputPath(v string) *Target {
+	s.InputPath = &v
 	return s
 }
 
-type RemovePermissionInput struct {
-	_ struct{} `type:"structure"`
-
-	// The name of the event bus to revoke permissions for. If you omit this, the
-	// default event bus is used.
-	EventBusName *string `min:"1" type:"string"`
-
-	// The statement ID corresponding to the account that is no longer allowed to
-	// put events to the default event bus.
-	//
-	// StatementId is a required field
-	StatementId *string `min:"1" type:"string" required:"true"`
-}
-
-// String returns the string representation
-func (s RemovePermissionInput) String() string {
-	return awsutil.Prettify(s)
+// SetInputTransformer sets the InputTransformer field's value.
+func (s *Target) SetInputTransformer(v *InputTransformer) *Target {
+	s.InputTransformer = v
+	return s
 }
 
-// GoString returns the string representation
-func (s RemovePermissionInput) GoString() string {
-	return s.String()
+// SetKinesisParameters sets the KinesisParameters field's value.
+func (s *Target) SetKinesisParameters(v *KinesisParameters) *Target {
+	s.KinesisParameters = v
+	return s
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *RemovePermissionInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "RemovePermissionInput"}
-	if s.EventBusName != nil && len(*s.EventBusName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("EventBusName", 1))
-	}
-	if s.StatementId == nil {
-		invalidParams.Add(request.NewErrParamRequired("StatementId"))
-	}
-	if s.StatementId != nil && len(*s.StatementId) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("StatementId", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetRedshiftDataParameters sets the RedshiftDataParameters field's value.
+func (s *Target) SetRedshiftDataParameters(v *RedshiftDataParameters) *Target {
+	s.RedshiftDataParameters = v
+	return s
 }
 
-// SetEventBusName sets the EventBusName field's value.
-func (s *RemovePermissionInput) SetEventBusName(v string) *RemovePermissionInput {
-	s.EventBusName = &v
+// SetRetryPolicy sets the RetryPolicy field's value.
+func (s *Target) SetRetryPolicy(v *RetryPolicy) *Target {
+	s.RetryPolicy = v
 	return s
 }
 
-// SetStatementId sets the StatementId field's value.
-func (s *RemovePermissionInput) SetStatementId(v string) *RemovePermissionInput {
-	s.StatementId = &v
+// SetRoleArn sets the RoleArn field's value.
+func (s *Target) SetRoleArn(v string) *Target {
+	s.RoleArn = &v
 	return s
 }
 
-type RemovePermissionOutput struct {
-	_ struct{} `type:"structure"`
+// SetRunCommandParameters sets the RunCommandParameters field's value.
+func (s *Target) SetRunCommandParameters(v *RunCommandParameters) *Target {
+	s.RunCommandParameters = v
+	return s
 }
 
-// String returns the string representation
-func (s RemovePermissionOutput) String() string {
-	return awsutil.Prettify(s)
+// SetSageMakerPipelineParameters sets the SageMakerPipelineParameters field's value.
+func (s *Target) SetSageMakerPipelineParameters(v *SageMakerPipelineParameters) *Target {
+	s.SageMakerPipelineParameters = v
+	return s
 }
 
-// GoString returns the string representation
-func (s RemovePermissionOutput) GoString() string {
-	return s.String()
+// SetSqsParameters sets the SqsParameters field's value.
+func (s *Target) SetSqsParameters(v *SqsParameters) *Target {
+	s.SqsParameters = v
+	return s
 }
 
-type RemoveTargetsInput struct {
+type TestEventPatternInput struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the event bus associated with the rule.
-	EventBusName *string `min:"1" type:"string"`
-
-	// If this is a managed rule created by an AWS service on your behalf, you must
-	// specify Force as True to remove targets. This parameter is ignored for rules
-	// that aren't managed rules. You can check whether a rule is a managed rule
-	// by using DescribeRule or ListRules and checking the ManagedBy field of the
-	// response.
-	Force *bool `type:"boolean"`
-
-	// The IDs of the targets to remove from the rule.
+	// The event, in JSON format, to test against the event pattern. The JSON must
+	// follow the format specified in Amazon Web Services Events (https://docs.aws.amazon.com/eventbridge/latest/userguide/aws-events.html),
+	// and the following fields are mandatory:
 	//
-	// Ids is a required field
-	Ids []*string `min:"1" type:"list" required:"true"`
+	//    * id
+	//
+	//    * account
+	//
+	//    * source
+	//
+	//    * time
+	//
+	//    * region
+	//
+	//    * resources
+	//
+	//    * detail-type
+	//
+	// Event is a required field
+	Event *string `type:"string" required:"true"`
 
-	// The name of the rule.
+	// The event pattern. For more information, see Events and Event Patterns (https://docs.aws.amazon.com/eventbridge/latest/userguide/eventbridge-and-event-patterns.html)
+	// in the Amazon EventBridge User Guide.
 	//
-	// Rule is a required field
-	Rule *string `min:"1" type:"string" required:"true"`
+	// EventPattern is a required field
+	EventPattern *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s RemoveTargetsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TestEventPatternInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s RemoveTargetsInput) GoString() string {
-	return s.String()
-}
-
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *RemoveTargetsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "RemoveTargetsInput"}
-	if s.EventBusName != nil && len(*s.EventBusName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("EventBusName", 1))
-	}
-	if s.Ids == nil {
-		invalidParams.Add(request.NewErrParamRequired("Ids"))
-	}
-	if s.Ids != nil && len(s.Ids) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Ids", 1))
-	}
-	if s.Rule == nil {
-		invalidParams.Add(request.NewErrParamRequired("Rule"))
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TestEventPatternInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *TestEventPatternInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "TestEventPatternInput"}
+	if s.Event == nil {
+		invalidParams.Add(request.NewErrParamRequired("Event"))
 	}
-	if s.Rule != nil && len(*s.Rule) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Rule", 1))
+	if s.EventPattern == nil {
+		invalidParams.Add(request.NewErrParamRequired("EventPattern"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -6556,300 +14973,339 @@ func (s *RemoveTargetsInput) Validate() error {
 	return nil
 }
 
-// SetEventBusName sets the EventBusName field's value.
-func (s *RemoveTargetsInput) SetEventBusName(v string) *RemoveTargetsInput {
-	s.EventBusName = &v
-	return s
-}
-
-// SetForce sets the Force field's value.
-func (s *RemoveTargetsInput) SetForce(v bool) *RemoveTargetsInput {
-	s.Force = &v
-	return s
-}
-
-// SetIds sets the Ids field's value.
-func (s *RemoveTargetsInput) SetIds(v []*string) *RemoveTargetsInput {
-	s.Ids = v
+// SetEvent sets the Event field's value.
+func (s *TestEventPatternInput) SetEvent(v string) *TestEventPatternInput {
+	s.Event = &v
 	return s
 }
 
-// SetRule sets the Rule field's value.
-func (s *RemoveTargetsInput) SetRule(v string) *RemoveTargetsInput {
-	s.Rule = &v
+// SetEventPattern sets the EventPattern field's value.
+func (s *TestEventPatternInput) SetEventPattern(v string) *TestEventPatternInput {
+	s.EventPattern = &v
 	return s
 }
 
-type RemoveTargetsOutput struct {
+type TestEventPatternOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The failed target entries.
-	FailedEntries []*RemoveTargetsResultEntry `type:"list"`
-
-	// The number of failed entries.
-	FailedEntryCount *int64 `type:"integer"`
+	// Indicates whether the event matches the event pattern.
+	Result *bool `type:"boolean"`
 }
 
-// String returns the string representation
-func (s RemoveTargetsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TestEventPatternOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s RemoveTargetsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TestEventPatternOutput) GoString() string {
 	return s.String()
 }
 
-// SetFailedEntries sets the FailedEntries field's value.
-func (s *RemoveTargetsOutput) SetFailedEntries(v []*RemoveTargetsResultEntry) *RemoveTargetsOutput {
-	s.FailedEntries = v
-	return s
-}
-
-// SetFailedEntryCount sets the FailedEntryCount field's value.
-func (s *RemoveTargetsOutput) SetFailedEntryCount(v int64) *RemoveTargetsOutput {
-	s.FailedEntryCount = &v
+// SetResult sets the Result field's value.
+func (s *TestEventPatternOutput) SetResult(v bool) *TestEventPatternOutput {
+	s.Result = &v
 	return s
 }
 
-// Represents a target that failed to be removed from a rule.
-type RemoveTargetsResultEntry struct {
+type UntagResourceInput struct {
 	_ struct{} `type:"structure"`
 
-	// The error code that indicates why the target removal failed. If the value
-	// is ConcurrentModificationException, too many requests were made at the same
-	// time.
-	ErrorCode *string `type:"string"`
-
-	// The error message that explains why the target removal failed.
-	ErrorMessage *string `type:"string"`
+	// The ARN of the EventBridge resource from which you are removing tags.
+	//
+	// ResourceARN is a required field
+	ResourceARN *string `min:"1" type:"string" required:"true"`
 
-	// The ID of the target.
-	TargetId *string `min:"1" type:"string"`
+	// The list of tag keys to remove from the resource.
+	//
+	// TagKeys is a required field
+	TagKeys []*string `type:"list" required:"true"`
 }
 
-// String returns the string representation
-func (s RemoveTargetsResultEntry) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s RemoveTargetsResultEntry) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceInput) GoString() string {
 	return s.String()
 }
 
-// SetErrorCode sets the ErrorCode field's value.
-func (s *RemoveTargetsResultEntry) SetErrorCode(v string) *RemoveTargetsResultEntry {
-	s.ErrorCode = &v
-	return s
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *UntagResourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UntagResourceInput"}
+	if s.ResourceARN == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceARN"))
+	}
+	if s.ResourceARN != nil && len(*s.ResourceARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ResourceARN", 1))
+	}
+	if s.TagKeys == nil {
+		invalidParams.Add(request.NewErrParamRequired("TagKeys"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetErrorMessage sets the ErrorMessage field's value.
-func (s *RemoveTargetsResultEntry) SetErrorMessage(v string) *RemoveTargetsResultEntry {
-	s.ErrorMessage = &v
+// SetResourceARN sets the ResourceARN field's value.
+func (s *UntagResourceInput) SetResourceARN(v string) *UntagResourceInput {
+	s.ResourceARN = &v
 	return s
 }
 
-// SetTargetId sets the TargetId field's value.
-func (s *RemoveTargetsResultEntry) SetTargetId(v string) *RemoveTargetsResultEntry {
-	s.TargetId = &v
+// SetTagKeys sets the TagKeys field's value.
+func (s *UntagResourceInput) SetTagKeys(v []*string) *UntagResourceInput {
+	s.TagKeys = v
 	return s
 }
 
-// Contains information about a rule in Amazon EventBridge.
-type Rule struct {
+type UntagResourceOutput struct {
 	_ struct{} `type:"structure"`
+}
 
-	// The Amazon Resource Name (ARN) of the rule.
-	Arn *string `min:"1" type:"string"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceOutput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// The description of the rule.
-	Description *string `type:"string"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceOutput) GoString() string {
+	return s.String()
+}
 
-	// The event bus associated with the rule.
-	EventBusName *string `min:"1" type:"string"`
+type UpdateApiDestinationInput struct {
+	_ struct{} `type:"structure"`
 
-	// The event pattern of the rule. For more information, see Event Patterns (https://docs.aws.amazon.com/eventbridge/latest/userguide/eventbridge-and-event-patterns.html)
-	// in the Amazon EventBridge User Guide.
-	EventPattern *string `type:"string"`
+	// The ARN of the connection to use for the API destination.
+	ConnectionArn *string `min:"1" type:"string"`
 
-	// If an AWS service created the rule on behalf of your account, this field
-	// displays the principal name of the service that created the rule.
-	ManagedBy *string `min:"1" type:"string"`
+	// The name of the API destination to update.
+	Description *string `type:"string"`
 
-	// The name of the rule.
-	Name *string `min:"1" type:"string"`
+	// The method to use for the API destination.
+	HttpMethod *string `type:"string" enum:"ApiDestinationHttpMethod"`
 
-	// The Amazon Resource Name (ARN) of the role that is used for target invocation.
-	RoleArn *string `min:"1" type:"string"`
+	// The URL to the endpoint to use for the API destination.
+	InvocationEndpoint *string `min:"1" type:"string"`
 
-	// The scheduling expression: for example, "cron(0 20 * * ? *)" or "rate(5 minutes)".
-	ScheduleExpression *string `type:"string"`
+	// The maximum number of invocations per second to send to the API destination.
+	InvocationRateLimitPerSecond *int64 `min:"1" type:"integer"`
 
-	// The state of the rule.
-	State *string `type:"string" enum:"RuleState"`
+	// The name of the API destination to update.
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s Rule) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateApiDestinationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Rule) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateApiDestinationInput) GoString() string {
 	return s.String()
 }
 
-// SetArn sets the Arn field's value.
-func (s *Rule) SetArn(v string) *Rule {
-	s.Arn = &v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *UpdateApiDestinationInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateApiDestinationInput"}
+	if s.ConnectionArn != nil && len(*s.ConnectionArn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ConnectionArn", 1))
+	}
+	if s.InvocationEndpoint != nil && len(*s.InvocationEndpoint) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("InvocationEndpoint", 1))
+	}
+	if s.InvocationRateLimitPerSecond != nil && *s.InvocationRateLimitPerSecond < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("InvocationRateLimitPerSecond", 1))
+	}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetConnectionArn sets the ConnectionArn field's value.
+func (s *UpdateApiDestinationInput) SetConnectionArn(v string) *UpdateApiDestinationInput {
+	s.ConnectionArn = &v
 	return s
 }
 
 // SetDescription sets the Description field's value.
-func (s *Rule) SetDescription(v string) *Rule {
+func (s *UpdateApiDestinationInput) SetDescription(v string) *UpdateApiDestinationInput {
 	s.Description = &v
 	return s
 }
 
-// SetEventBusName sets the EventBusName field's value.
-func (s *Rule) SetEventBusName(v string) *Rule {
-	s.EventBusName = &v
+// SetHttpMethod sets the HttpMethod field's value.
+func (s *UpdateApiDestinationInput) SetHttpMethod(v string) *UpdateApiDestinationInput {
+	s.HttpMethod = &v
 	return s
 }
 
-// SetEventPattern sets the EventPattern field's value.
-func (s *Rule) SetEventPattern(v string) *Rule {
-	s.EventPattern = &v
+// SetInvocationEndpoint sets the InvocationEndpoint field's value.
+func (s *UpdateApiDestinationInput) SetInvocationEndpoint(v string) *UpdateApiDestinationInput {
+	s.InvocationEndpoint = &v
 	return s
 }
 
-// SetManagedBy sets the ManagedBy field's value.
-func (s *Rule) SetManagedBy(v string) *Rule {
-	s.ManagedBy = &v
+// SetInvocationRateLimitPerSecond sets the InvocationRateLimitPerSecond field's value.
+func (s *UpdateApiDestinationInput) SetInvocationRateLimitPerSecond(v int64) *UpdateApiDestinationInput {
+	s.InvocationRateLimitPerSecond = &v
 	return s
 }
 
 // SetName sets the Name field's value.
-func (s *Rule) SetName(v string) *Rule {
+func (s *UpdateApiDestinationInput) SetName(v string) *UpdateApiDestinationInput {
 	s.Name = &v
 	return s
 }
 
-// SetRoleArn sets the RoleArn field's value.
-func (s *Rule) SetRoleArn(v string) *Rule {
-	s.RoleArn = &v
-	return s
-}
+type UpdateApiDestinationOutput struct {
+	_ struct{} `type:"structure"`
 
-// SetScheduleExpression sets the ScheduleExpression field's value.
-func (s *Rule) SetScheduleExpression(v string) *Rule {
-	s.ScheduleExpression = &v
-	return s
-}
+	// The ARN of the API destination that was updated.
+	ApiDestinationArn *string `min:"1" type:"string"`
 
-// SetState sets the State field's value.
-func (s *Rule) SetState(v string) *Rule {
-	s.State = &v
-	return s
-}
+	// The state of the API destination that was updated.
+	ApiDestinationState *string `type:"string" enum:"ApiDestinationState"`
 
-// This parameter contains the criteria (either InstanceIds or a tag) used to
-// specify which EC2 instances are to be sent the command.
-type RunCommandParameters struct {
-	_ struct{} `type:"structure"`
+	// A time stamp for the time that the API destination was created.
+	CreationTime *time.Time `type:"timestamp"`
 
-	// Currently, we support including only one RunCommandTarget block, which specifies
-	// either an array of InstanceIds or a tag.
-	//
-	// RunCommandTargets is a required field
-	RunCommandTargets []*RunCommandTarget `min:"1" type:"list" required:"true"`
+	// A time stamp for the time that the API destination was last modified.
+	LastModifiedTime *time.Time `type:"timestamp"`
 }
 
-// String returns the string representation
-func (s RunCommandParameters) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateApiDestinationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s RunCommandParameters) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateApiDestinationOutput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *RunCommandParameters) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "RunCommandParameters"}
-	if s.RunCommandTargets == nil {
-		invalidParams.Add(request.NewErrParamRequired("RunCommandTargets"))
-	}
-	if s.RunCommandTargets != nil && len(s.RunCommandTargets) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("RunCommandTargets", 1))
-	}
-	if s.RunCommandTargets != nil {
-		for i, v := range s.RunCommandTargets {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "RunCommandTargets", i), err.(request.ErrInvalidParams))
-			}
-		}
-	}
+// SetApiDestinationArn sets the ApiDestinationArn field's value.
+func (s *UpdateApiDestinationOutput) SetApiDestinationArn(v string) *UpdateApiDestinationOutput {
+	s.ApiDestinationArn = &v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetApiDestinationState sets the ApiDestinationState field's value.
+func (s *UpdateApiDestinationOutput) SetApiDestinationState(v string) *UpdateApiDestinationOutput {
+	s.ApiDestinationState = &v
+	return s
 }
 
-// SetRunCommandTargets sets the RunCommandTargets field's value.
-func (s *RunCommandParameters) SetRunCommandTargets(v []*RunCommandTarget) *RunCommandParameters {
-	s.RunCommandTargets = v
+// SetCreationTime sets the CreationTime field's value.
+func (s *UpdateApiDestinationOutput) SetCreationTime(v time.Time) *UpdateApiDestinationOutput {
+	s.CreationTime = &v
 	return s
 }
 
-// Information about the EC2 instances that are to be sent the command, specified
-// as key-value pairs. Each RunCommandTarget block can include only one key,
-// but this key can specify multiple values.
-type RunCommandTarget struct {
+// SetLastModifiedTime sets the LastModifiedTime field's value.
+func (s *UpdateApiDestinationOutput) SetLastModifiedTime(v time.Time) *UpdateApiDestinationOutput {
+	s.LastModifiedTime = &v
+	return s
+}
+
+type UpdateArchiveInput struct {
 	_ struct{} `type:"structure"`
 
-	// Can be either tag: tag-key or InstanceIds.
+	// The name of the archive to update.
 	//
-	// Key is a required field
-	Key *string `min:"1" type:"string" required:"true"`
+	// ArchiveName is a required field
+	ArchiveName *string `min:"1" type:"string" required:"true"`
 
-	// If Key is tag: tag-key, Values is a list of tag values. If Key is InstanceIds,
-	// Values is a list of Amazon EC2 instance IDs.
-	//
-	// Values is a required field
-	Values []*string `min:"1" type:"list" required:"true"`
+	// The description for the archive.
+	Description *string `type:"string"`
+
+	// The event pattern to use to filter events sent to the archive.
+	EventPattern *string `type:"string"`
+
+	// The number of days to retain events in the archive.
+	RetentionDays *int64 `type:"integer"`
 }
 
-// String returns the string representation
-func (s RunCommandTarget) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateArchiveInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s RunCommandTarget) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateArchiveInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *RunCommandTarget) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "RunCommandTarget"}
-	if s.Key == nil {
-		invalidParams.Add(request.NewErrParamRequired("Key"))
-	}
-	if s.Key != nil && len(*s.Key) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Key", 1))
+func (s *UpdateArchiveInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateArchiveInput"}
+	if s.ArchiveName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ArchiveName"))
 	}
-	if s.Values == nil {
-		invalidParams.Add(request.NewErrParamRequired("Values"))
-	}
-	if s.Values != nil && len(s.Values) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Values", 1))
+	if s.ArchiveName != nil && len(*s.ArchiveName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ArchiveName", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -6858,81 +15314,129 @@ func (s *RunCommandTarget) Validate() error {
 	return nil
 }
 
-// SetKey sets the Key field's value.
-func (s *RunCommandTarget) SetKey(v string) *RunCommandTarget {
-	s.Key = &v
+// SetArchiveName sets the ArchiveName field's value.
+func (s *UpdateArchiveInput) SetArchiveName(v string) *UpdateArchiveInput {
+	s.ArchiveName = &v
 	return s
 }
 
-// SetValues sets the Values field's value.
-func (s *RunCommandTarget) SetValues(v []*string) *RunCommandTarget {
-	s.Values = v
+// SetDescription sets the Description field's value.
+func (s *UpdateArchiveInput) SetDescription(v string) *UpdateArchiveInput {
+	s.Description = &v
 	return s
 }
 
-// This structure includes the custom parameter to be used when the target is
-// an SQS FIFO queue.
-type SqsParameters struct {
+// SetEventPattern sets the EventPattern field's value.
+func (s *UpdateArchiveInput) SetEventPattern(v string) *UpdateArchiveInput {
+	s.EventPattern = &v
+	return s
+}
+
+// SetRetentionDays sets the RetentionDays field's value.
+func (s *UpdateArchiveInput) SetRetentionDays(v int64) *UpdateArchiveInput {
+	s.RetentionDays = &v
+	return s
+}
+
+type UpdateArchiveOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The FIFO message group ID to use as the target.
-	MessageGroupId *string `type:"string"`
+	// The ARN of the archive.
+	ArchiveArn *string `min:"1" type:"string"`
+
+	// The time at which the archive was updated.
+	CreationTime *time.Time `type:"timestamp"`
+
+	// The state of the archive.
+	State *string `type:"string" enum:"ArchiveState"`
+
+	// The reason that the archive is in the current state.
+	StateReason *string `type:"string"`
 }
 
-// String returns the string representation
-func (s SqsParameters) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateArchiveOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s SqsParameters) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateArchiveOutput) GoString() string {
 	return s.String()
 }
 
-// SetMessageGroupId sets the MessageGroupId field's value.
-func (s *SqsParameters) SetMessageGroupId(v string) *SqsParameters {
-	s.MessageGroupId = &v
+// SetArchiveArn sets the ArchiveArn field's value.
+func (s *UpdateArchiveOutput) SetArchiveArn(v string) *UpdateArchiveOutput {
+	s.ArchiveArn = &v
 	return s
 }
 
-// A key-value pair associated with an AWS resource. In EventBridge, rules support
-// tagging.
-type Tag struct {
+// SetCreationTime sets the CreationTime field's value.
+func (s *UpdateArchiveOutput) SetCreationTime(v time.Time) *UpdateArchiveOutput {
+	s.CreationTime = &v
+	return s
+}
+
+// SetState sets the State field's value.
+func (s *UpdateArchiveOutput) SetState(v string) *UpdateArchiveOutput {
+	s.State = &v
+	return s
+}
+
+// SetStateReason sets the StateReason field's value.
+func (s *UpdateArchiveOutput) SetStateReason(v string) *UpdateArchiveOutput {
+	s.StateReason = &v
+	return s
+}
+
+// Contains the API key authorization parameters to use to update the connection.
+type UpdateConnectionApiKeyAuthRequestParameters struct {
 	_ struct{} `type:"structure"`
 
-	// A string that you can use to assign a value. The combination of tag keys
-	// and values can help you organize and categorize your resources.
-	//
-	// Key is a required field
-	Key *string `min:"1" type:"string" required:"true"`
+	// The name of the API key to use for authorization.
+	ApiKeyName *string `min:"1" type:"string"`
 
-	// The value for the specified tag key.
+	// The value associated with teh API key to use for authorization.
 	//
-	// Value is a required field
-	Value *string `type:"string" required:"true"`
+	// ApiKeyValue is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by UpdateConnectionApiKeyAuthRequestParameters's
+	// String and GoString methods.
+	ApiKeyValue *string `min:"1" type:"string" sensitive:"true"`
 }
 
-// String returns the string representation
-func (s Tag) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateConnectionApiKeyAuthRequestParameters) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Tag) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateConnectionApiKeyAuthRequestParameters) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *Tag) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "Tag"}
-	if s.Key == nil {
-		invalidParams.Add(request.NewErrParamRequired("Key"))
-	}
-	if s.Key != nil && len(*s.Key) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Key", 1))
+func (s *UpdateConnectionApiKeyAuthRequestParameters) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateConnectionApiKeyAuthRequestParameters"}
+	if s.ApiKeyName != nil && len(*s.ApiKeyName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ApiKeyName", 1))
 	}
-	if s.Value == nil {
-		invalidParams.Add(request.NewErrParamRequired("Value"))
+	if s.ApiKeyValue != nil && len(*s.ApiKeyValue) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ApiKeyValue", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -6941,62 +15445,73 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
-// SetKey sets the Key field's value.
-func (s *Tag) SetKey(v string) *Tag {
-	s.Key = &v
+// SetApiKeyName sets the ApiKeyName field's value.
+func (s *UpdateConnectionApiKeyAuthRequestParameters) SetApiKeyName(v string) *UpdateConnectionApiKeyAuthRequestParameters {
+	s.ApiKeyName = &v
 	return s
 }
 
-// SetValue sets the Value field's value.
-func (s *Tag) SetValue(v string) *Tag {
-	s.Value = &v
+// SetApiKeyValue sets the ApiKeyValue field's value.
+func (s *UpdateConnectionApiKeyAuthRequestParameters) SetApiKeyValue(v string) *UpdateConnectionApiKeyAuthRequestParameters {
+	s.ApiKeyValue = &v
 	return s
 }
 
-type TagResourceInput struct {
+// Contains the additional parameters to use for the connection.
+type UpdateConnectionAuthRequestParameters struct {
 	_ struct{} `type:"structure"`
 
-	// The ARN of the rule that you're adding tags to.
-	//
-	// ResourceARN is a required field
-	ResourceARN *string `min:"1" type:"string" required:"true"`
+	// A UpdateConnectionApiKeyAuthRequestParameters object that contains the authorization
+	// parameters for API key authorization.
+	ApiKeyAuthParameters *UpdateConnectionApiKeyAuthRequestParameters `type:"structure"`
 
-	// The list of key-value pairs to associate with the rule.
-	//
-	// Tags is a required field
-	Tags []*Tag `type:"list" required:"true"`
+	// A UpdateConnectionBasicAuthRequestParameters object that contains the authorization
+	// parameters for Basic authorization.
+	BasicAuthParameters *UpdateConnectionBasicAuthRequestParameters `type:"structure"`
+
+	// A ConnectionHttpParameters object that contains the additional parameters
+	// to use for the connection.
+	InvocationHttpParameters *ConnectionHttpParameters `type:"structure"`
+
+	// A UpdateConnectionOAuthRequestParameters object that contains the authorization
+	// parameters for OAuth authorization.
+	OAuthParameters *UpdateConnectionOAuthRequestParameters `type:"structure"`
 }
 
-// String returns the string representation
-func (s TagResourceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateConnectionAuthRequestParameters) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s TagResourceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateConnectionAuthRequestParameters) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *TagResourceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "TagResourceInput"}
-	if s.ResourceARN == nil {
-		invalidParams.Add(request.NewErrParamRequired("ResourceARN"))
-	}
-	if s.ResourceARN != nil && len(*s.ResourceARN) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("ResourceARN", 1))
+func (s *UpdateConnectionAuthRequestParameters) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateConnectionAuthRequestParameters"}
+	if s.ApiKeyAuthParameters != nil {
+		if err := s.ApiKeyAuthParameters.Validate(); err != nil {
+			invalidParams.AddNested("ApiKeyAuthParameters", err.(request.ErrInvalidParams))
+		}
 	}
-	if s.Tags == nil {
-		invalidParams.Add(request.NewErrParamRequired("Tags"))
+	if s.BasicAuthParameters != nil {
+		if err := s.BasicAuthParameters.Validate(); err != nil {
+			invalidParams.AddNested("BasicAuthParameters", err.(request.ErrInvalidParams))
+		}
 	}
-	if s.Tags != nil {
-		for i, v := range s.Tags {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
-			}
+	if s.OAuthParameters != nil {
+		if err := s.OAuthParameters.Validate(); err != nil {
+			invalidParams.AddNested("OAuthParameters", err.(request.ErrInvalidParams))
 		}
 	}
 
@@ -7006,151 +15521,139 @@ func (s *TagResourceInput) Validate() error {
 	return nil
 }
 
-// SetResourceARN sets the ResourceARN field's value.
-func (s *TagResourceInput) SetResourceARN(v string) *TagResourceInput {
-	s.ResourceARN = &v
+// SetApiKeyAuthParameters sets the ApiKeyAuthParameters field's value.
+func (s *UpdateConnectionAuthRequestParameters) SetApiKeyAuthParameters(v *UpdateConnectionApiKeyAuthRequestParameters) *UpdateConnectionAuthRequestParameters {
+	s.ApiKeyAuthParameters = v
 	return s
 }
 
-// SetTags sets the Tags field's value.
-func (s *TagResourceInput) SetTags(v []*Tag) *TagResourceInput {
-	s.Tags = v
+// SetBasicAuthParameters sets the BasicAuthParameters field's value.
+func (s *UpdateConnectionAuthRequestParameters) SetBasicAuthParameters(v *UpdateConnectionBasicAuthRequestParameters) *UpdateConnectionAuthRequestParameters {
+	s.BasicAuthParameters = v
 	return s
 }
 
-type TagResourceOutput struct {
-	_ struct{} `type:"structure"`
-}
-
-// String returns the string representation
-func (s TagResourceOutput) String() string {
-	return awsutil.Prettify(s)
+// SetInvocationHttpParameters sets the InvocationHttpParameters field's value.
+func (s *UpdateConnectionAuthRequestParameters) SetInvocationHttpParameters(v *ConnectionHttpParameters) *UpdateConnectionAuthRequestParameters {
+	s.InvocationHttpParameters = v
+	return s
 }
 
-// GoString returns the string representation
-func (s TagResourceOutput) GoString() string {
-	return s.String()
+// SetOAuthParameters sets the OAuthParameters field's value.
+func (s *UpdateConnectionAuthRequestParameters) SetOAuthParameters(v *UpdateConnectionOAuthRequestParameters) *UpdateConnectionAuthRequestParameters {
+	s.OAuthParameters = v
+	return s
 }
 
-// Targets are the resources to be invoked when a rule is triggered. For a complete
-// list of services and resources that can be set as a target, see PutTargets.
-//
-// If you're setting the event bus of another account as the target and that
-// account granted permission to your account through an organization instead
-// of directly by the account ID, you must specify a RoleArn with proper permissions
-// in the Target structure. For more information, see Sending and Receiving
-// Events Between AWS Accounts (https://docs.aws.amazon.com/eventbridge/latest/userguide/eventbridge-cross-account-event-delivery.html)
-// in the Amazon EventBridge User Guide.
-type Target struct {
+// Contains the Basic authorization parameters for the connection.
+type UpdateConnectionBasicAuthRequestParameters struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the target.
+	// The password associated with the user name to use for Basic authorization.
 	//
-	// Arn is a required field
-	Arn *string `min:"1" type:"string" required:"true"`
+	// Password is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by UpdateConnectionBasicAuthRequestParameters's
+	// String and GoString methods.
+	Password *string `min:"1" type:"string" sensitive:"true"`
 
-	// If the event target is an AWS Batch job, this contains the job definition,
-	// job name, and other parameters. For more information, see Jobs (https://docs.aws.amazon.com/batch/latest/userguide/jobs.html)
-	// in the AWS Batch User Guide.
-	BatchParameters *BatchParameters `type:"structure"`
+	// The user name to use for Basic authorization.
+	Username *string `min:"1" type:"string"`
+}
 
-	// Contains the Amazon ECS task definition and task count to be used if the
-	// event target is an Amazon ECS task. For more information about Amazon ECS
-	// tasks, see Task Definitions (https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task_defintions.html)
-	// in the Amazon EC2 Container Service Developer Guide.
-	EcsParameters *EcsParameters `type:"structure"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateConnectionBasicAuthRequestParameters) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// The ID of the target.
-	//
-	// Id is a required field
-	Id *string `min:"1" type:"string" required:"true"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateConnectionBasicAuthRequestParameters) GoString() string {
+	return s.String()
+}
 
-	// Valid JSON text passed to the target. In this case, nothing from the event
-	// itself is passed to the target. For more information, see The JavaScript
-	// Object Notation (JSON) Data Interchange Format (http://www.rfc-editor.org/rfc/rfc7159.txt).
-	Input *string `type:"string"`
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *UpdateConnectionBasicAuthRequestParameters) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateConnectionBasicAuthRequestParameters"}
+	if s.Password != nil && len(*s.Password) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Password", 1))
+	}
+	if s.Username != nil && len(*s.Username) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Username", 1))
+	}
 
-	// The value of the JSONPath that is used for extracting part of the matched
-	// event when passing it to the target. You must use JSON dot notation, not
-	// bracket notation. For more information about JSON paths, see JSONPath (http://goessner.net/articles/JsonPath/).
-	InputPath *string `type:"string"`
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
 
-	// Settings to enable you to provide custom input to a target based on certain
-	// event data. You can extract one or more key-value pairs from the event and
-	// then use that data to send customized input to the target.
-	InputTransformer *InputTransformer `type:"structure"`
+// SetPassword sets the Password field's value.
+func (s *UpdateConnectionBasicAuthRequestParameters) SetPassword(v string) *UpdateConnectionBasicAuthRequestParameters {
+	s.Password = &v
+	return s
+}
 
-	// The custom parameter that you can use to control the shard assignment when
-	// the target is a Kinesis data stream. If you don't include this parameter,
-	// the default is to use the eventId as the partition key.
-	KinesisParameters *KinesisParameters `type:"structure"`
+// SetUsername sets the Username field's value.
+func (s *UpdateConnectionBasicAuthRequestParameters) SetUsername(v string) *UpdateConnectionBasicAuthRequestParameters {
+	s.Username = &v
+	return s
+}
 
-	// The Amazon Resource Name (ARN) of the IAM role to be used for this target
-	// when the rule is triggered. If one rule triggers multiple targets, you can
-	// use a different IAM role for each target.
-	RoleArn *string `min:"1" type:"string"`
+type UpdateConnectionInput struct {
+	_ struct{} `type:"structure"`
 
-	// Parameters used when you are using the rule to invoke Amazon EC2 Run Command.
-	RunCommandParameters *RunCommandParameters `type:"structure"`
+	// The authorization parameters to use for the connection.
+	AuthParameters *UpdateConnectionAuthRequestParameters `type:"structure"`
 
-	// Contains the message group ID to use when the target is a FIFO queue.
+	// The type of authorization to use for the connection.
+	AuthorizationType *string `type:"string" enum:"ConnectionAuthorizationType"`
+
+	// A description for the connection.
+	Description *string `type:"string"`
+
+	// The name of the connection to update.
 	//
-	// If you specify an SQS FIFO queue as a target, the queue must have content-based
-	// deduplication enabled.
-	SqsParameters *SqsParameters `type:"structure"`
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s Target) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateConnectionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Target) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateConnectionInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *Target) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "Target"}
-	if s.Arn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Arn"))
-	}
-	if s.Arn != nil && len(*s.Arn) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Arn", 1))
-	}
-	if s.Id == nil {
-		invalidParams.Add(request.NewErrParamRequired("Id"))
-	}
-	if s.Id != nil && len(*s.Id) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
-	}
-	if s.RoleArn != nil && len(*s.RoleArn) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("RoleArn", 1))
-	}
-	if s.BatchParameters != nil {
-		if err := s.BatchParameters.Validate(); err != nil {
-			invalidParams.AddNested("BatchParameters", err.(request.ErrInvalidParams))
-		}
-	}
-	if s.EcsParameters != nil {
-		if err := s.EcsParameters.Validate(); err != nil {
-			invalidParams.AddNested("EcsParameters", err.(request.ErrInvalidParams))
-		}
-	}
-	if s.InputTransformer != nil {
-		if err := s.InputTransformer.Validate(); err != nil {
-			invalidParams.AddNested("InputTransformer", err.(request.ErrInvalidParams))
-		}
+func (s *UpdateConnectionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateConnectionInput"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
 	}
-	if s.KinesisParameters != nil {
-		if err := s.KinesisParameters.Validate(); err != nil {
-			invalidParams.AddNested("KinesisParameters", err.(request.ErrInvalidParams))
-		}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
 	}
-	if s.RunCommandParameters != nil {
-		if err := s.RunCommandParameters.Validate(); err != nil {
-			invalidParams.AddNested("RunCommandParameters", err.(request.ErrInvalidParams))
+	if s.AuthParameters != nil {
+		if err := s.AuthParameters.Validate(); err != nil {
+			invalidParams.AddNested("AuthParameters", err.(request.ErrInvalidParams))
 		}
 	}
 
@@ -7160,105 +15663,139 @@ func (s *Target) Validate() error {
 	return nil
 }
 
-// SetArn sets the Arn field's value.
-func (s *Target) SetArn(v string) *Target {
-	s.Arn = &v
+// SetAuthParameters sets the AuthParameters field's value.
+func (s *UpdateConnectionInput) SetAuthParameters(v *UpdateConnectionAuthRequestParameters) *UpdateConnectionInput {
+	s.AuthParameters = v
 	return s
 }
 
-// SetBatchParameters sets the BatchParameters field's value.
-func (s *Target) SetBatchParameters(v *BatchParameters) *Target {
-	s.BatchParameters = v
+// SetAuthorizationType sets the AuthorizationType field's value.
+func (s *UpdateConnectionInput) SetAuthorizationType(v string) *UpdateConnectionInput {
+	s.AuthorizationType = &v
 	return s
 }
 
-// SetEcsParameters sets the EcsParameters field's value.
-func (s *Target) SetEcsParameters(v *EcsParameters) *Target {
-	s.EcsParameters = v
+// SetDescription sets the Description field's value.
+func (s *UpdateConnectionInput) SetDescription(v string) *UpdateConnectionInput {
+	s.Description = &v
 	return s
 }
 
-// SetId sets the Id field's value.
-func (s *Target) SetId(v string) *Target {
-	s.Id = &v
+// SetName sets the Name field's value.
+func (s *UpdateConnectionInput) SetName(v string) *UpdateConnectionInput {
+	s.Name = &v
 	return s
 }
 
-// SetInput sets the Input field's value.
-func (s *Target) SetInput(v string) *Target {
-	s.Input = &v
-	return s
-}
+// Contains the OAuth authorization parameters to use for the connection.
+type UpdateConnectionOAuthClientRequestParameters struct {
+	_ struct{} `type:"structure"`
 
-// SetInputPath sets the InputPath field's value.
-func (s *Target) SetInputPath(v string) *Target {
-	s.InputPath = &v
-	return s
+	// The client ID to use for OAuth authorization.
+	ClientID *string `min:"1" type:"string"`
+
+	// The client secret assciated with the client ID to use for OAuth authorization.
+	//
+	// ClientSecret is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by UpdateConnectionOAuthClientRequestParameters's
+	// String and GoString methods.
+	ClientSecret *string `min:"1" type:"string" sensitive:"true"`
 }
 
-// SetInputTransformer sets the InputTransformer field's value.
-func (s *Target) SetInputTransformer(v *InputTransformer) *Target {
-	s.InputTransformer = v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateConnectionOAuthClientRequestParameters) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetKinesisParameters sets the KinesisParameters field's value.
-func (s *Target) SetKinesisParameters(v *KinesisParameters) *Target {
-	s.KinesisParameters = v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateConnectionOAuthClientRequestParameters) GoString() string {
+	return s.String()
 }
 
-// SetRoleArn sets the RoleArn field's value.
-func (s *Target) SetRoleArn(v string) *Target {
-	s.RoleArn = &v
-	return s
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *UpdateConnectionOAuthClientRequestParameters) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateConnectionOAuthClientRequestParameters"}
+	if s.ClientID != nil && len(*s.ClientID) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ClientID", 1))
+	}
+	if s.ClientSecret != nil && len(*s.ClientSecret) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ClientSecret", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetRunCommandParameters sets the RunCommandParameters field's value.
-func (s *Target) SetRunCommandParameters(v *RunCommandParameters) *Target {
-	s.RunCommandParameters = v
+// SetClientID sets the ClientID field's value.
+func (s *UpdateConnectionOAuthClientRequestParameters) SetClientID(v string) *UpdateConnectionOAuthClientRequestParameters {
+	s.ClientID = &v
 	return s
 }
 
-// SetSqsParameters sets the SqsParameters field's value.
-func (s *Target) SetSqsParameters(v *SqsParameters) *Target {
-	s.SqsParameters = v
+// SetClientSecret sets the ClientSecret field's value.
+func (s *UpdateConnectionOAuthClientRequestParameters) SetClientSecret(v string) *UpdateConnectionOAuthClientRequestParameters {
+	s.ClientSecret = &v
 	return s
 }
 
-type TestEventPatternInput struct {
+// Contains the OAuth request parameters to use for the connection.
+type UpdateConnectionOAuthRequestParameters struct {
 	_ struct{} `type:"structure"`
 
-	// The event, in JSON format, to test against the event pattern.
-	//
-	// Event is a required field
-	Event *string `type:"string" required:"true"`
+	// The URL to the authorization endpoint when OAuth is specified as the authorization
+	// type.
+	AuthorizationEndpoint *string `min:"1" type:"string"`
 
-	// The event pattern. For more information, see Event Patterns (https://docs.aws.amazon.com/eventbridge/latest/userguide/eventbridge-and-event-patterns.html)
-	// in the Amazon EventBridge User Guide.
-	//
-	// EventPattern is a required field
-	EventPattern *string `type:"string" required:"true"`
+	// A UpdateConnectionOAuthClientRequestParameters object that contains the client
+	// parameters to use for the connection when OAuth is specified as the authorization
+	// type.
+	ClientParameters *UpdateConnectionOAuthClientRequestParameters `type:"structure"`
+
+	// The method used to connect to the HTTP endpoint.
+	HttpMethod *string `type:"string" enum:"ConnectionOAuthHttpMethod"`
+
+	// The additional HTTP parameters used for the OAuth authorization request.
+	OAuthHttpParameters *ConnectionHttpParameters `type:"structure"`
 }
 
-// String returns the string representation
-func (s TestEventPatternInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateConnectionOAuthRequestParameters) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s TestEventPatternInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateConnectionOAuthRequestParameters) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *TestEventPatternInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "TestEventPatternInput"}
-	if s.Event == nil {
-		invalidParams.Add(request.NewErrParamRequired("Event"))
-	}
-	if s.EventPattern == nil {
-		invalidParams.Add(request.NewErrParamRequired("EventPattern"))
+func (s *UpdateConnectionOAuthRequestParameters) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateConnectionOAuthRequestParameters"}
+	if s.AuthorizationEndpoint != nil && len(*s.AuthorizationEndpoint) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("AuthorizationEndpoint", 1))
+	}
+	if s.ClientParameters != nil {
+		if err := s.ClientParameters.Validate(); err != nil {
+			invalidParams.AddNested("ClientParameters", err.(request.ErrInvalidParams))
+		}
 	}
 
 	if invalidParams.Len() > 0 {
@@ -7267,108 +15804,179 @@ func (s *TestEventPatternInput) Validate() error {
 	return nil
 }
 
-// SetEvent sets the Event field's value.
-func (s *TestEventPatternInput) SetEvent(v string) *TestEventPatternInput {
-	s.Event = &v
+// SetAuthorizationEndpoint sets the AuthorizationEndpoint field's value.
+func (s *UpdateConnectionOAuthRequestParameters) SetAuthorizationEndpoint(v string) *UpdateConnectionOAuthRequestParameters {
+	s.AuthorizationEndpoint = &v
 	return s
 }
 
-// SetEventPattern sets the EventPattern field's value.
-func (s *TestEventPatternInput) SetEventPattern(v string) *TestEventPatternInput {
-	s.EventPattern = &v
+// SetClientParameters sets the ClientParameters field's value.
+func (s *UpdateConnectionOAuthRequestParameters) SetClientParameters(v *UpdateConnectionOAuthClientRequestParameters) *UpdateConnectionOAuthRequestParameters {
+	s.ClientParameters = v
 	return s
 }
 
-type TestEventPatternOutput struct {
-	_ struct{} `type:"structure"`
-
-	// Indicates whether the event matches the event pattern.
-	Result *bool `type:"boolean"`
-}
-
-// String returns the string representation
-func (s TestEventPatternOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s TestEventPatternOutput) GoString() string {
-	return s.String()
+// SetHttpMethod sets the HttpMethod field's value.
+func (s *UpdateConnectionOAuthRequestParameters) SetHttpMethod(v string) *UpdateConnectionOAuthRequestParameters {
+	s.HttpMethod = &v
+	return s
 }
 
-// SetResult sets the Result field's value.
-func (s *TestEventPatternOutput) SetResult(v bool) *TestEventPatternOutput {
-	s.Result = &v
+// SetOAuthHttpParameters sets the OAuthHttpParameters field's value.
+func (s *UpdateConnectionOAuthRequestParameters) SetOAuthHttpParameters(v *ConnectionHttpParameters) *UpdateConnectionOAuthRequestParameters {
+	s.OAuthHttpParameters = v
 	return s
 }
 
-type UntagResourceInput struct {
+type UpdateConnectionOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The ARN of the rule that you're removing tags from.
-	//
-	// ResourceARN is a required field
-	ResourceARN *string `min:"1" type:"string" required:"true"`
+	// The ARN of the connection that was updated.
+	ConnectionArn *string `min:"1" type:"string"`
 
-	// The list of tag keys to remove from the resource.
-	//
-	// TagKeys is a required field
-	TagKeys []*string `type:"list" required:"true"`
+	// The state of the connection that was updated.
+	ConnectionState *string `type:"string" enum:"ConnectionState"`
+
+	// A time stamp for the time that the connection was created.
+	CreationTime *time.Time `type:"timestamp"`
+
+	// A time stamp for the time that the connection was last authorized.
+	LastAuthorizedTime *time.Time `type:"timestamp"`
+
+	// A time stamp for the time that the connection was last modified.
+	LastModifiedTime *time.Time `type:"timestamp"`
 }
 
-// String returns the string representation
-func (s UntagResourceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateConnectionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s UntagResourceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateConnectionOutput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *UntagResourceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "UntagResourceInput"}
-	if s.ResourceARN == nil {
-		invalidParams.Add(request.NewErrParamRequired("ResourceARN"))
-	}
-	if s.ResourceARN != nil && len(*s.ResourceARN) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("ResourceARN", 1))
-	}
-	if s.TagKeys == nil {
-		invalidParams.Add(request.NewErrParamRequired("TagKeys"))
-	}
+// SetConnectionArn sets the ConnectionArn field's value.
+func (s *UpdateConnectionOutput) SetConnectionArn(v string) *UpdateConnectionOutput {
+	s.ConnectionArn = &v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetConnectionState sets the ConnectionState field's value.
+func (s *UpdateConnectionOutput) SetConnectionState(v string) *UpdateConnectionOutput {
+	s.ConnectionState = &v
+	return s
 }
 
-// SetResourceARN sets the ResourceARN field's value.
-func (s *UntagResourceInput) SetResourceARN(v string) *UntagResourceInput {
-	s.ResourceARN = &v
+// SetCreationTime sets the CreationTime field's value.
+func (s *UpdateConnectionOutput) SetCreationTime(v time.Time) *UpdateConnectionOutput {
+	s.CreationTime = &v
 	return s
 }
 
-// SetTagKeys sets the TagKeys field's value.
-func (s *UntagResourceInput) SetTagKeys(v []*string) *UntagResourceInput {
-	s.TagKeys = v
+// SetLastAuthorizedTime sets the LastAuthorizedTime field's value.
+func (s *UpdateConnectionOutput) SetLastAuthorizedTime(v time.Time) *UpdateConnectionOutput {
+	s.LastAuthorizedTime = &v
 	return s
 }
 
-type UntagResourceOutput struct {
-	_ struct{} `type:"structure"`
+// SetLastModifiedTime sets the LastModifiedTime field's value.
+func (s *UpdateConnectionOutput) SetLastModifiedTime(v time.Time) *UpdateConnectionOutput {
+	s.LastModifiedTime = &v
+	return s
 }
 
-// String returns the string representation
-func (s UntagResourceOutput) String() string {
-	return awsutil.Prettify(s)
+const (
+	// ApiDestinationHttpMethodPost is a ApiDestinationHttpMethod enum value
+	ApiDestinationHttpMethodPost = "POST"
+
+	// ApiDestinationHttpMethodGet is a ApiDestinationHttpMethod enum value
+	ApiDestinationHttpMethodGet = "GET"
+
+	// ApiDestinationHttpMethodHead is a ApiDestinationHttpMethod enum value
+	ApiDestinationHttpMethodHead = "HEAD"
+
+	// ApiDestinationHttpMethodOptions is a ApiDestinationHttpMethod enum value
+	ApiDestinationHttpMethodOptions = "OPTIONS"
+
+	// ApiDestinationHttpMethodPut is a ApiDestinationHttpMethod enum value
+	ApiDestinationHttpMethodPut = "PUT"
+
+	// ApiDestinationHttpMethodPatch is a ApiDestinationHttpMethod enum value
+	ApiDestinationHttpMethodPatch = "PATCH"
+
+	// ApiDestinationHttpMethodDelete is a ApiDestinationHttpMethod enum value
+	ApiDestinationHttpMethodDelete = "DELETE"
+)
+
+// ApiDestinationHttpMethod_Values returns all elements of the ApiDestinationHttpMethod enum
+func ApiDestinationHttpMethod_Values() []string {
+	return []string{
+		ApiDestinationHttpMethodPost,
+		ApiDestinationHttpMethodGet,
+		ApiDestinationHttpMethodHead,
+		ApiDestinationHttpMethodOptions,
+		ApiDestinationHttpMethodPut,
+		ApiDestinationHttpMethodPatch,
+		ApiDestinationHttpMethodDelete,
+	}
 }
 
-// GoString returns the string representation
-func (s UntagResourceOutput) GoString() string {
-	return s.String()
+const (
+	// ApiDestinationStateActive is a ApiDestinationState enum value
+	ApiDestinationStateActive = "ACTIVE"
+
+	// ApiDestinationStateInactive is a ApiDestinationState enum value
+	ApiDestinationStateInactive = "INACTIVE"
+)
+
+// ApiDestinationState_Values returns all elements of the ApiDestinationState enum
+func ApiDestinationState_Values() []string {
+	return []string{
+		ApiDestinationStateActive,
+		ApiDestinationStateInactive,
+	}
+}
+
+const (
+	// ArchiveStateEnabled is a ArchiveState enum value
+	ArchiveStateEnabled = "ENABLED"
+
+	// ArchiveStateDisabled is a ArchiveState enum value
+	ArchiveStateDisabled = "DISABLED"
+
+	// ArchiveStateCreating is a ArchiveState enum value
+	ArchiveStateCreating = "CREATING"
+
+	// ArchiveStateUpdating is a ArchiveState enum value
+	ArchiveStateUpdating = "UPDATING"
+
+	// ArchiveStateCreateFailed is a ArchiveState enum value
+	ArchiveStateCreateFailed = "CREATE_FAILED"
+
+	// ArchiveStateUpdateFailed is a ArchiveState enum value
+	ArchiveStateUpdateFailed = "UPDATE_FAILED"
+)
+
+// ArchiveState_Values returns all elements of the ArchiveState enum
+func ArchiveState_Values() []string {
+	return []string{
+		ArchiveStateEnabled,
+		ArchiveStateDisabled,
+		ArchiveStateCreating,
+		ArchiveStateUpdating,
+		ArchiveStateCreateFailed,
+		ArchiveStateUpdateFailed,
+	}
 }
 
 const (
@@ -7379,6 +15987,90 @@ const (
 	AssignPublicIpDisabled = "DISABLED"
 )
 
+// AssignPublicIp_Values returns all elements of the AssignPublicIp enum
+func AssignPublicIp_Values() []string {
+	return []string{
+		AssignPublicIpEnabled,
+		AssignPublicIpDisabled,
+	}
+}
+
+const (
+	// ConnectionAuthorizationTypeBasic is a ConnectionAuthorizationType enum value
+	ConnectionAuthorizationTypeBasic = "BASIC"
+
+	// ConnectionAuthorizationTypeOauthClientCredentials is a ConnectionAuthorizationType enum value
+	ConnectionAuthorizationTypeOauthClientCredentials = "OAUTH_CLIENT_CREDENTIALS"
+
+	// ConnectionAuthorizationTypeApiKey is a ConnectionAuthorizationType enum value
+	ConnectionAuthorizationTypeApiKey = "API_KEY"
+)
+
+// ConnectionAuthorizationType_Values returns all elements of the ConnectionAuthorizationType enum
+func ConnectionAuthorizationType_Values() []string {
+	return []string{
+		ConnectionAuthorizationTypeBasic,
+		ConnectionAuthorizationTypeOauthClientCredentials,
+		ConnectionAuthorizationTypeApiKey,
+	}
+}
+
+const (
+	// ConnectionOAuthHttpMethodGet is a ConnectionOAuthHttpMethod enum value
+	ConnectionOAuthHttpMethodGet = "GET"
+
+	// ConnectionOAuthHttpMethodPost is a ConnectionOAuthHttpMethod enum value
+	ConnectionOAuthHttpMethodPost = "POST"
+
+	// ConnectionOAuthHttpMethodPut is a ConnectionOAuthHttpMethod enum value
+	ConnectionOAuthHttpMethodPut = "PUT"
+)
+
+// ConnectionOAuthHttpMethod_Values returns all elements of the ConnectionOAuthHttpMethod enum
+func ConnectionOAuthHttpMethod_Values() []string {
+	return []string{
+		ConnectionOAuthHttpMethodGet,
+		ConnectionOAuthHttpMethodPost,
+		ConnectionOAuthHttpMethodPut,
+	}
+}
+
+const (
+	// ConnectionStateCreating is a ConnectionState enum value
+	ConnectionStateCreating = "CREATING"
+
+	// ConnectionStateUpdating is a ConnectionState enum value
+	ConnectionStateUpdating = "UPDATING"
+
+	// ConnectionStateDeleting is a ConnectionState enum value
+	ConnectionStateDeleting = "DELETING"
+
+	// ConnectionStateAuthorized is a ConnectionState enum value
+	ConnectionStateAuthorized = "AUTHORIZED"
+
+	// ConnectionStateDeauthorized is a ConnectionState enum value
+	ConnectionStateDeauthorized = "DEAUTHORIZED"
+
+	// ConnectionStateAuthorizing is a ConnectionState enum value
+	ConnectionStateAuthorizing = "AUTHORIZING"
+
+	// ConnectionStateDeauthorizing is a ConnectionState enum value
+	ConnectionStateDeauthorizing = "DEAUTHORIZING"
+)
+
+// ConnectionState_Values returns all elements of the ConnectionState enum
+func ConnectionState_Values() []string {
+	return []string{
+		ConnectionStateCreating,
+		ConnectionStateUpdating,
+		ConnectionStateDeleting,
+		ConnectionStateAuthorized,
+		ConnectionStateDeauthorized,
+		ConnectionStateAuthorizing,
+		ConnectionStateDeauthorizing,
+	}
+}
+
 const (
 	// EventSourceStatePending is a EventSourceState enum value
 	EventSourceStatePending = "PENDING"
@@ -7390,14 +16082,115 @@ const (
 	EventSourceStateDeleted = "DELETED"
 )
 
+// EventSourceState_Values returns all elements of the EventSourceState enum
+func EventSourceState_Values() []string {
+	return []string{
+		EventSourceStatePending,
+		EventSourceStateActive,
+		EventSourceStateDeleted,
+	}
+}
+
 const (
 	// LaunchTypeEc2 is a LaunchType enum value
 	LaunchTypeEc2 = "EC2"
 
 	// LaunchTypeFargate is a LaunchType enum value
 	LaunchTypeFargate = "FARGATE"
+
+	// LaunchTypeExternal is a LaunchType enum value
+	LaunchTypeExternal = "EXTERNAL"
+)
+
+// LaunchType_Values returns all elements of the LaunchType enum
+func LaunchType_Values() []string {
+	return []string{
+		LaunchTypeEc2,
+		LaunchTypeFargate,
+		LaunchTypeExternal,
+	}
+}
+
+const (
+	// PlacementConstraintTypeDistinctInstance is a PlacementConstraintType enum value
+	PlacementConstraintTypeDistinctInstance = "distinctInstance"
+
+	// PlacementConstraintTypeMemberOf is a PlacementConstraintType enum value
+	PlacementConstraintTypeMemberOf = "memberOf"
+)
+
+// PlacementConstraintType_Values returns all elements of the PlacementConstraintType enum
+func PlacementConstraintType_Values() []string {
+	return []string{
+		PlacementConstraintTypeDistinctInstance,
+		PlacementConstraintTypeMemberOf,
+	}
+}
+
+const (
+	// PlacementStrategyTypeRandom is a PlacementStrategyType enum value
+	PlacementStrategyTypeRandom = "random"
+
+	// PlacementStrategyTypeSpread is a PlacementStrategyType enum value
+	PlacementStrategyTypeSpread = "spread"
+
+	// PlacementStrategyTypeBinpack is a PlacementStrategyType enum value
+	PlacementStrategyTypeBinpack = "binpack"
+)
+
+// PlacementStrategyType_Values returns all elements of the PlacementStrategyType enum
+func PlacementStrategyType_Values() []string {
+	return []string{
+		PlacementStrategyTypeRandom,
+		PlacementStrategyTypeSpread,
+		PlacementStrategyTypeBinpack,
+	}
+}
+
+const (
+	// PropagateTagsTaskDefinition is a PropagateTags enum value
+	PropagateTagsTaskDefinition = "TASK_DEFINITION"
+)
+
+// PropagateTags_Values returns all elements of the PropagateTags enum
+func PropagateTags_Values() []string {
+	return []string{
+		PropagateTagsTaskDefinition,
+	}
+}
+
+const (
+	// ReplayStateStarting is a ReplayState enum value
+	ReplayStateStarting = "STARTING"
+
+	// ReplayStateRunning is a ReplayState enum value
+	ReplayStateRunning = "RUNNING"
+
+	// ReplayStateCancelling is a ReplayState enum value
+	ReplayStateCancelling = "CANCELLING"
+
+	// ReplayStateCompleted is a ReplayState enum value
+	ReplayStateCompleted = "COMPLETED"
+
+	// ReplayStateCancelled is a ReplayState enum value
+	ReplayStateCancelled = "CANCELLED"
+
+	// ReplayStateFailed is a ReplayState enum value
+	ReplayStateFailed = "FAILED"
 )
 
+// ReplayState_Values returns all elements of the ReplayState enum
+func ReplayState_Values() []string {
+	return []string{
+		ReplayStateStarting,
+		ReplayStateRunning,
+		ReplayStateCancelling,
+		ReplayStateCompleted,
+		ReplayStateCancelled,
+		ReplayStateFailed,
+	}
+}
+
 const (
 	// RuleStateEnabled is a RuleState enum value
 	RuleStateEnabled = "ENABLED"
@@ -7405,3 +16198,11 @@ const (
 	// RuleStateDisabled is a RuleState enum value
 	RuleStateDisabled = "DISABLED"
 )
+
+// RuleState_Values returns all elements of the RuleState enum
+func RuleState_Values() []string {
+	return []string{
+		RuleStateEnabled,
+		RuleStateDisabled,
+	}
+}
@@ -2,12 +2,19 @@
 
 package cloudwatchlogs
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeDataAlreadyAcceptedException for service response error code
 	// "DataAlreadyAcceptedException".
 	//
 	// The event was already logged.
+	//
+	// PutLogEvents actions are now always accepted and never return DataAlreadyAcceptedException
+	// regardless of whether a given batch of log events has already been accepted.
 	ErrCodeDataAlreadyAcceptedException = "DataAlreadyAcceptedException"
 
 	// ErrCodeInvalidOperationException for service response error code
@@ -25,7 +32,11 @@ const (
 	// ErrCodeInvalidSequenceTokenException for service response error code
 	// "InvalidSequenceTokenException".
 	//
-	// The sequence token is not valid.
+	// The sequence token is not valid. You can get the correct sequence token in
+	// the expectedSequenceToken field in the InvalidSequenceTokenException message.
+	//
+	// PutLogEvents actions are now always accepted and never return InvalidSequenceTokenException
+	// regardless of receiving an invalid sequence token.
 	ErrCodeInvalidSequenceTokenException = "InvalidSequenceTokenException"
 
 	// ErrCodeLimitExceededException for service response error code
@@ -38,7 +49,7 @@ const (
 	// "MalformedQueryException".
 	//
 	// The query string is not valid. Details about this error are displayed in
-	// a QueryCompileError object. For more information, see .
+	// a QueryCompileError object. For more information, see QueryCompileError (https://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_QueryCompileError.html).
 	//
 	// For more information about valid query syntax, see CloudWatch Logs Insights
 	// Query Syntax (https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/CWL_QuerySyntax.html).
@@ -47,7 +58,7 @@ const (
 	// ErrCodeOperationAbortedException for service response error code
 	// "OperationAbortedException".
 	//
-	// Multiple requests to update the same resource were in conflict.
+	// Multiple concurrent requests to update the same resource were in conflict.
 	ErrCodeOperationAbortedException = "OperationAbortedException"
 
 	// ErrCodeResourceAlreadyExistsException for service response error code
@@ -68,9 +79,31 @@ const (
 	// The service cannot complete the request.
 	ErrCodeServiceUnavailableException = "ServiceUnavailableException"
 
+	// ErrCodeTooManyTagsException for service response error code
+	// "TooManyTagsException".
+	//
+	// A resource can have no more than 50 tags.
+	ErrCodeTooManyTagsException = "TooManyTagsException"
+
 	// ErrCodeUnrecognizedClientException for service response error code
 	// "UnrecognizedClientException".
 	//
-	// The most likely cause is an invalid AWS access key ID or secret key.
+	// The most likely cause is an Amazon Web Services access key ID or secret key
+	// that's not valid.
 	ErrCodeUnrecognizedClientException = "UnrecognizedClientException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"DataAlreadyAcceptedException":   newErrorDataAlreadyAcceptedException,
+	"InvalidOperationException":      newErrorInvalidOperationException,
+	"InvalidParameterException":      newErrorInvalidParameterException,
+	"InvalidSequenceTokenException":  newErrorInvalidSequenceTokenException,
+	"LimitExceededException":         newErrorLimitExceededException,
+	"MalformedQueryException":        newErrorMalformedQueryException,
+	"OperationAbortedException":      newErrorOperationAbortedException,
+	"ResourceAlreadyExistsException": newErrorResourceAlreadyExistsException,
+	"ResourceNotFoundException":      newErrorResourceNotFoundException,
+	"ServiceUnavailableException":    newErrorServiceUnavailableException,
+	"TooManyTagsException":           newErrorTooManyTagsException,
+	"UnrecognizedClientException":    newErrorUnrecognizedClientException,
+}
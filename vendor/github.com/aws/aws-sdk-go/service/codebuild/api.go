@@ -29,14 +29,13 @@ const opBatchDeleteBuilds = "BatchDeleteBuilds"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the BatchDeleteBuildsRequest method.
+//	req, resp := client.BatchDeleteBuildsRequest(params)
 //
-//    // Example sending a request using the BatchDeleteBuildsRequest method.
-//    req, resp := client.BatchDeleteBuildsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/BatchDeleteBuilds
 func (c *CodeBuild) BatchDeleteBuildsRequest(input *BatchDeleteBuildsInput) (req *request.Request, output *BatchDeleteBuildsOutput) {
@@ -66,9 +65,9 @@ func (c *CodeBuild) BatchDeleteBuildsRequest(input *BatchDeleteBuildsInput) (req
 // See the AWS API reference guide for AWS CodeBuild's
 // API operation BatchDeleteBuilds for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidInputException "InvalidInputException"
-//   The input value that was provided is not valid.
+// Returned Error Types:
+//   - InvalidInputException
+//     The input value that was provided is not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/BatchDeleteBuilds
 func (c *CodeBuild) BatchDeleteBuilds(input *BatchDeleteBuildsInput) (*BatchDeleteBuildsOutput, error) {
@@ -92,6 +91,84 @@ func (c *CodeBuild) BatchDeleteBuildsWithContext(ctx aws.Context, input *BatchDe
 	return out, req.Send()
 }
 
+const opBatchGetBuildBatches = "BatchGetBuildBatches"
+
+// BatchGetBuildBatchesRequest generates a "aws/request.Request" representing the
+// client's request for the BatchGetBuildBatches operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See BatchGetBuildBatches for more information on using the BatchGetBuildBatches
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the BatchGetBuildBatchesRequest method.
+//	req, resp := client.BatchGetBuildBatchesRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/BatchGetBuildBatches
+func (c *CodeBuild) BatchGetBuildBatchesRequest(input *BatchGetBuildBatchesInput) (req *request.Request, output *BatchGetBuildBatchesOutput) {
+	op := &request.Operation{
+		Name:       opBatchGetBuildBatches,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &BatchGetBuildBatchesInput{}
+	}
+
+	output = &BatchGetBuildBatchesOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// BatchGetBuildBatches API operation for AWS CodeBuild.
+//
+// Retrieves information about one or more batch builds.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation BatchGetBuildBatches for usage and error information.
+//
+// Returned Error Types:
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/BatchGetBuildBatches
+func (c *CodeBuild) BatchGetBuildBatches(input *BatchGetBuildBatchesInput) (*BatchGetBuildBatchesOutput, error) {
+	req, out := c.BatchGetBuildBatchesRequest(input)
+	return out, req.Send()
+}
+
+// BatchGetBuildBatchesWithContext is the same as BatchGetBuildBatches with the addition of
+// the ability to pass a context and additional request options.
+//
+// See BatchGetBuildBatches for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) BatchGetBuildBatchesWithContext(ctx aws.Context, input *BatchGetBuildBatchesInput, opts ...request.Option) (*BatchGetBuildBatchesOutput, error) {
+	req, out := c.BatchGetBuildBatchesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opBatchGetBuilds = "BatchGetBuilds"
 
 // BatchGetBuildsRequest generates a "aws/request.Request" representing the
@@ -108,14 +185,13 @@ const opBatchGetBuilds = "BatchGetBuilds"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the BatchGetBuildsRequest method.
+//	req, resp := client.BatchGetBuildsRequest(params)
 //
-//    // Example sending a request using the BatchGetBuildsRequest method.
-//    req, resp := client.BatchGetBuildsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/BatchGetBuilds
 func (c *CodeBuild) BatchGetBuildsRequest(input *BatchGetBuildsInput) (req *request.Request, output *BatchGetBuildsOutput) {
@@ -136,7 +212,7 @@ func (c *CodeBuild) BatchGetBuildsRequest(input *BatchGetBuildsInput) (req *requ
 
 // BatchGetBuilds API operation for AWS CodeBuild.
 //
-// Gets information about builds.
+// Gets information about one or more builds.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -145,9 +221,9 @@ func (c *CodeBuild) BatchGetBuildsRequest(input *BatchGetBuildsInput) (req *requ
 // See the AWS API reference guide for AWS CodeBuild's
 // API operation BatchGetBuilds for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidInputException "InvalidInputException"
-//   The input value that was provided is not valid.
+// Returned Error Types:
+//   - InvalidInputException
+//     The input value that was provided is not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/BatchGetBuilds
 func (c *CodeBuild) BatchGetBuilds(input *BatchGetBuildsInput) (*BatchGetBuildsOutput, error) {
@@ -187,14 +263,13 @@ const opBatchGetProjects = "BatchGetProjects"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the BatchGetProjectsRequest method.
+//	req, resp := client.BatchGetProjectsRequest(params)
 //
-//    // Example sending a request using the BatchGetProjectsRequest method.
-//    req, resp := client.BatchGetProjectsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/BatchGetProjects
 func (c *CodeBuild) BatchGetProjectsRequest(input *BatchGetProjectsInput) (req *request.Request, output *BatchGetProjectsOutput) {
@@ -215,7 +290,7 @@ func (c *CodeBuild) BatchGetProjectsRequest(input *BatchGetProjectsInput) (req *
 
 // BatchGetProjects API operation for AWS CodeBuild.
 //
-// Gets information about build projects.
+// Gets information about one or more build projects.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -224,9 +299,9 @@ func (c *CodeBuild) BatchGetProjectsRequest(input *BatchGetProjectsInput) (req *
 // See the AWS API reference guide for AWS CodeBuild's
 // API operation BatchGetProjects for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidInputException "InvalidInputException"
-//   The input value that was provided is not valid.
+// Returned Error Types:
+//   - InvalidInputException
+//     The input value that was provided is not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/BatchGetProjects
 func (c *CodeBuild) BatchGetProjects(input *BatchGetProjectsInput) (*BatchGetProjectsOutput, error) {
@@ -250,1438 +325,9529 @@ func (c *CodeBuild) BatchGetProjectsWithContext(ctx aws.Context, input *BatchGet
 	return out, req.Send()
 }
 
-const opCreateProject = "CreateProject"
+const opBatchGetReportGroups = "BatchGetReportGroups"
 
-// CreateProjectRequest generates a "aws/request.Request" representing the
-// client's request for the CreateProject operation. The "output" return
+// BatchGetReportGroupsRequest generates a "aws/request.Request" representing the
+// client's request for the BatchGetReportGroups operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See CreateProject for more information on using the CreateProject
+// See BatchGetReportGroups for more information on using the BatchGetReportGroups
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the BatchGetReportGroupsRequest method.
+//	req, resp := client.BatchGetReportGroupsRequest(params)
 //
-//    // Example sending a request using the CreateProjectRequest method.
-//    req, resp := client.CreateProjectRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/CreateProject
-func (c *CodeBuild) CreateProjectRequest(input *CreateProjectInput) (req *request.Request, output *CreateProjectOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/BatchGetReportGroups
+func (c *CodeBuild) BatchGetReportGroupsRequest(input *BatchGetReportGroupsInput) (req *request.Request, output *BatchGetReportGroupsOutput) {
 	op := &request.Operation{
-		Name:       opCreateProject,
+		Name:       opBatchGetReportGroups,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &CreateProjectInput{}
+		input = &BatchGetReportGroupsInput{}
 	}
 
-	output = &CreateProjectOutput{}
+	output = &BatchGetReportGroupsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// CreateProject API operation for AWS CodeBuild.
+// BatchGetReportGroups API operation for AWS CodeBuild.
 //
-// Creates a build project.
+// Returns an array of report groups.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS CodeBuild's
-// API operation CreateProject for usage and error information.
-//
-// Returned Error Codes:
-//   * ErrCodeInvalidInputException "InvalidInputException"
-//   The input value that was provided is not valid.
-//
-//   * ErrCodeResourceAlreadyExistsException "ResourceAlreadyExistsException"
-//   The specified AWS resource cannot be created, because an AWS resource with
-//   the same settings already exists.
+// API operation BatchGetReportGroups for usage and error information.
 //
-//   * ErrCodeAccountLimitExceededException "AccountLimitExceededException"
-//   An AWS service limit was exceeded for the calling AWS account.
+// Returned Error Types:
+//   - InvalidInputException
+//     The input value that was provided is not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/CreateProject
-func (c *CodeBuild) CreateProject(input *CreateProjectInput) (*CreateProjectOutput, error) {
-	req, out := c.CreateProjectRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/BatchGetReportGroups
+func (c *CodeBuild) BatchGetReportGroups(input *BatchGetReportGroupsInput) (*BatchGetReportGroupsOutput, error) {
+	req, out := c.BatchGetReportGroupsRequest(input)
 	return out, req.Send()
 }
 
-// CreateProjectWithContext is the same as CreateProject with the addition of
+// BatchGetReportGroupsWithContext is the same as BatchGetReportGroups with the addition of
 // the ability to pass a context and additional request options.
 //
-// See CreateProject for details on how to use this API operation.
+// See BatchGetReportGroups for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CodeBuild) CreateProjectWithContext(ctx aws.Context, input *CreateProjectInput, opts ...request.Option) (*CreateProjectOutput, error) {
-	req, out := c.CreateProjectRequest(input)
+func (c *CodeBuild) BatchGetReportGroupsWithContext(ctx aws.Context, input *BatchGetReportGroupsInput, opts ...request.Option) (*BatchGetReportGroupsOutput, error) {
+	req, out := c.BatchGetReportGroupsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opCreateWebhook = "CreateWebhook"
+const opBatchGetReports = "BatchGetReports"
 
-// CreateWebhookRequest generates a "aws/request.Request" representing the
-// client's request for the CreateWebhook operation. The "output" return
+// BatchGetReportsRequest generates a "aws/request.Request" representing the
+// client's request for the BatchGetReports operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See CreateWebhook for more information on using the CreateWebhook
+// See BatchGetReports for more information on using the BatchGetReports
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the BatchGetReportsRequest method.
+//	req, resp := client.BatchGetReportsRequest(params)
 //
-//    // Example sending a request using the CreateWebhookRequest method.
-//    req, resp := client.CreateWebhookRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/CreateWebhook
-func (c *CodeBuild) CreateWebhookRequest(input *CreateWebhookInput) (req *request.Request, output *CreateWebhookOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/BatchGetReports
+func (c *CodeBuild) BatchGetReportsRequest(input *BatchGetReportsInput) (req *request.Request, output *BatchGetReportsOutput) {
 	op := &request.Operation{
-		Name:       opCreateWebhook,
+		Name:       opBatchGetReports,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &CreateWebhookInput{}
+		input = &BatchGetReportsInput{}
 	}
 
-	output = &CreateWebhookOutput{}
+	output = &BatchGetReportsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// CreateWebhook API operation for AWS CodeBuild.
+// BatchGetReports API operation for AWS CodeBuild.
 //
-// For an existing AWS CodeBuild build project that has its source code stored
-// in a GitHub or Bitbucket repository, enables AWS CodeBuild to start rebuilding
-// the source code every time a code change is pushed to the repository.
-//
-// If you enable webhooks for an AWS CodeBuild project, and the project is used
-// as a build step in AWS CodePipeline, then two identical builds are created
-// for each commit. One build is triggered through webhooks, and one through
-// AWS CodePipeline. Because billing is on a per-build basis, you are billed
-// for both builds. Therefore, if you are using AWS CodePipeline, we recommend
-// that you disable webhooks in AWS CodeBuild. In the AWS CodeBuild console,
-// clear the Webhook box. For more information, see step 5 in Change a Build
-// Project's Settings (https://docs.aws.amazon.com/codebuild/latest/userguide/change-project.html#change-project-console).
+// Returns an array of reports.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS CodeBuild's
-// API operation CreateWebhook for usage and error information.
+// API operation BatchGetReports for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidInputException "InvalidInputException"
-//   The input value that was provided is not valid.
+// Returned Error Types:
+//   - InvalidInputException
+//     The input value that was provided is not valid.
 //
-//   * ErrCodeOAuthProviderException "OAuthProviderException"
-//   There was a problem with the underlying OAuth provider.
-//
-//   * ErrCodeResourceAlreadyExistsException "ResourceAlreadyExistsException"
-//   The specified AWS resource cannot be created, because an AWS resource with
-//   the same settings already exists.
-//
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified AWS resource cannot be found.
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/CreateWebhook
-func (c *CodeBuild) CreateWebhook(input *CreateWebhookInput) (*CreateWebhookOutput, error) {
-	req, out := c.CreateWebhookRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/BatchGetReports
+func (c *CodeBuild) BatchGetReports(input *BatchGetReportsInput) (*BatchGetReportsOutput, error) {
+	req, out := c.BatchGetReportsRequest(input)
 	return out, req.Send()
 }
 
-// CreateWebhookWithContext is the same as CreateWebhook with the addition of
+// BatchGetReportsWithContext is the same as BatchGetReports with the addition of
 // the ability to pass a context and additional request options.
 //
-// See CreateWebhook for details on how to use this API operation.
+// See BatchGetReports for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CodeBuild) CreateWebhookWithContext(ctx aws.Context, input *CreateWebhookInput, opts ...request.Option) (*CreateWebhookOutput, error) {
-	req, out := c.CreateWebhookRequest(input)
+func (c *CodeBuild) BatchGetReportsWithContext(ctx aws.Context, input *BatchGetReportsInput, opts ...request.Option) (*BatchGetReportsOutput, error) {
+	req, out := c.BatchGetReportsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opDeleteProject = "DeleteProject"
+const opCreateProject = "CreateProject"
 
-// DeleteProjectRequest generates a "aws/request.Request" representing the
-// client's request for the DeleteProject operation. The "output" return
+// CreateProjectRequest generates a "aws/request.Request" representing the
+// client's request for the CreateProject operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See DeleteProject for more information on using the DeleteProject
+// See CreateProject for more information on using the CreateProject
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateProjectRequest method.
+//	req, resp := client.CreateProjectRequest(params)
 //
-//    // Example sending a request using the DeleteProjectRequest method.
-//    req, resp := client.DeleteProjectRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DeleteProject
-func (c *CodeBuild) DeleteProjectRequest(input *DeleteProjectInput) (req *request.Request, output *DeleteProjectOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/CreateProject
+func (c *CodeBuild) CreateProjectRequest(input *CreateProjectInput) (req *request.Request, output *CreateProjectOutput) {
 	op := &request.Operation{
-		Name:       opDeleteProject,
+		Name:       opCreateProject,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &DeleteProjectInput{}
+		input = &CreateProjectInput{}
 	}
 
-	output = &DeleteProjectOutput{}
+	output = &CreateProjectOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// DeleteProject API operation for AWS CodeBuild.
+// CreateProject API operation for AWS CodeBuild.
 //
-// Deletes a build project.
+// Creates a build project.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS CodeBuild's
-// API operation DeleteProject for usage and error information.
+// API operation CreateProject for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidInputException "InvalidInputException"
-//   The input value that was provided is not valid.
+// Returned Error Types:
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DeleteProject
-func (c *CodeBuild) DeleteProject(input *DeleteProjectInput) (*DeleteProjectOutput, error) {
-	req, out := c.DeleteProjectRequest(input)
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+//   - ResourceAlreadyExistsException
+//     The specified Amazon Web Services resource cannot be created, because an
+//     Amazon Web Services resource with the same settings already exists.
+//
+//   - AccountLimitExceededException
+//     An Amazon Web Services service limit was exceeded for the calling Amazon
+//     Web Services account.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/CreateProject
+func (c *CodeBuild) CreateProject(input *CreateProjectInput) (*CreateProjectOutput, error) {
+	req, out := c.CreateProjectRequest(input)
 	return out, req.Send()
 }
 
-// DeleteProjectWithContext is the same as DeleteProject with the addition of
+// CreateProjectWithContext is the same as CreateProject with the addition of
 // the ability to pass a context and additional request options.
 //
-// See DeleteProject for details on how to use this API operation.
+// See CreateProject for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CodeBuild) DeleteProjectWithContext(ctx aws.Context, input *DeleteProjectInput, opts ...request.Option) (*DeleteProjectOutput, error) {
-	req, out := c.DeleteProjectRequest(input)
+func (c *CodeBuild) CreateProjectWithContext(ctx aws.Context, input *CreateProjectInput, opts ...request.Option) (*CreateProjectOutput, error) {
+	req, out := c.CreateProjectRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opDeleteSourceCredentials = "DeleteSourceCredentials"
+const opCreateReportGroup = "CreateReportGroup"
 
-// DeleteSourceCredentialsRequest generates a "aws/request.Request" representing the
-// client's request for the DeleteSourceCredentials operation. The "output" return
+// CreateReportGroupRequest generates a "aws/request.Request" representing the
+// client's request for the CreateReportGroup operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See DeleteSourceCredentials for more information on using the DeleteSourceCredentials
+// See CreateReportGroup for more information on using the CreateReportGroup
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateReportGroupRequest method.
+//	req, resp := client.CreateReportGroupRequest(params)
 //
-//    // Example sending a request using the DeleteSourceCredentialsRequest method.
-//    req, resp := client.DeleteSourceCredentialsRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DeleteSourceCredentials
-func (c *CodeBuild) DeleteSourceCredentialsRequest(input *DeleteSourceCredentialsInput) (req *request.Request, output *DeleteSourceCredentialsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/CreateReportGroup
+func (c *CodeBuild) CreateReportGroupRequest(input *CreateReportGroupInput) (req *request.Request, output *CreateReportGroupOutput) {
 	op := &request.Operation{
-		Name:       opDeleteSourceCredentials,
+		Name:       opCreateReportGroup,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &DeleteSourceCredentialsInput{}
+		input = &CreateReportGroupInput{}
 	}
 
-	output = &DeleteSourceCredentialsOutput{}
+	output = &CreateReportGroupOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// DeleteSourceCredentials API operation for AWS CodeBuild.
+// CreateReportGroup API operation for AWS CodeBuild.
 //
-// Deletes a set of GitHub, GitHub Enterprise, or Bitbucket source credentials.
+// Creates a report group. A report group contains a collection of reports.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS CodeBuild's
-// API operation DeleteSourceCredentials for usage and error information.
+// API operation CreateReportGroup for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidInputException "InvalidInputException"
-//   The input value that was provided is not valid.
+// Returned Error Types:
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified AWS resource cannot be found.
+//   - InvalidInputException
+//     The input value that was provided is not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DeleteSourceCredentials
-func (c *CodeBuild) DeleteSourceCredentials(input *DeleteSourceCredentialsInput) (*DeleteSourceCredentialsOutput, error) {
-	req, out := c.DeleteSourceCredentialsRequest(input)
+//   - ResourceAlreadyExistsException
+//     The specified Amazon Web Services resource cannot be created, because an
+//     Amazon Web Services resource with the same settings already exists.
+//
+//   - AccountLimitExceededException
+//     An Amazon Web Services service limit was exceeded for the calling Amazon
+//     Web Services account.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/CreateReportGroup
+func (c *CodeBuild) CreateReportGroup(input *CreateReportGroupInput) (*CreateReportGroupOutput, error) {
+	req, out := c.CreateReportGroupRequest(input)
 	return out, req.Send()
 }
 
-// DeleteSourceCredentialsWithContext is the same as DeleteSourceCredentials with the addition of
+// CreateReportGroupWithContext is the same as CreateReportGroup with the addition of
 // the ability to pass a context and additional request options.
 //
-// See DeleteSourceCredentials for details on how to use this API operation.
+// See CreateReportGroup for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CodeBuild) DeleteSourceCredentialsWithContext(ctx aws.Context, input *DeleteSourceCredentialsInput, opts ...request.Option) (*DeleteSourceCredentialsOutput, error) {
-	req, out := c.DeleteSourceCredentialsRequest(input)
+func (c *CodeBuild) CreateReportGroupWithContext(ctx aws.Context, input *CreateReportGroupInput, opts ...request.Option) (*CreateReportGroupOutput, error) {
+	req, out := c.CreateReportGroupRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opDeleteWebhook = "DeleteWebhook"
+const opCreateWebhook = "CreateWebhook"
 
-// DeleteWebhookRequest generates a "aws/request.Request" representing the
-// client's request for the DeleteWebhook operation. The "output" return
+// CreateWebhookRequest generates a "aws/request.Request" representing the
+// client's request for the CreateWebhook operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See DeleteWebhook for more information on using the DeleteWebhook
+// See CreateWebhook for more information on using the CreateWebhook
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateWebhookRequest method.
+//	req, resp := client.CreateWebhookRequest(params)
 //
-//    // Example sending a request using the DeleteWebhookRequest method.
-//    req, resp := client.DeleteWebhookRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DeleteWebhook
-func (c *CodeBuild) DeleteWebhookRequest(input *DeleteWebhookInput) (req *request.Request, output *DeleteWebhookOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/CreateWebhook
+func (c *CodeBuild) CreateWebhookRequest(input *CreateWebhookInput) (req *request.Request, output *CreateWebhookOutput) {
 	op := &request.Operation{
-		Name:       opDeleteWebhook,
+		Name:       opCreateWebhook,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &DeleteWebhookInput{}
+		input = &CreateWebhookInput{}
 	}
 
-	output = &DeleteWebhookOutput{}
+	output = &CreateWebhookOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// DeleteWebhook API operation for AWS CodeBuild.
+// CreateWebhook API operation for AWS CodeBuild.
 //
-// For an existing AWS CodeBuild build project that has its source code stored
-// in a GitHub or Bitbucket repository, stops AWS CodeBuild from rebuilding
-// the source code every time a code change is pushed to the repository.
+// For an existing CodeBuild build project that has its source code stored in
+// a GitHub or Bitbucket repository, enables CodeBuild to start rebuilding the
+// source code every time a code change is pushed to the repository.
+//
+// If you enable webhooks for an CodeBuild project, and the project is used
+// as a build step in CodePipeline, then two identical builds are created for
+// each commit. One build is triggered through webhooks, and one through CodePipeline.
+// Because billing is on a per-build basis, you are billed for both builds.
+// Therefore, if you are using CodePipeline, we recommend that you disable webhooks
+// in CodeBuild. In the CodeBuild console, clear the Webhook box. For more information,
+// see step 5 in Change a Build Project's Settings (https://docs.aws.amazon.com/codebuild/latest/userguide/change-project.html#change-project-console).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS CodeBuild's
-// API operation DeleteWebhook for usage and error information.
+// API operation CreateWebhook for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidInputException "InvalidInputException"
-//   The input value that was provided is not valid.
+// Returned Error Types:
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified AWS resource cannot be found.
+//   - InvalidInputException
+//     The input value that was provided is not valid.
 //
-//   * ErrCodeOAuthProviderException "OAuthProviderException"
-//   There was a problem with the underlying OAuth provider.
+//   - OAuthProviderException
+//     There was a problem with the underlying OAuth provider.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DeleteWebhook
-func (c *CodeBuild) DeleteWebhook(input *DeleteWebhookInput) (*DeleteWebhookOutput, error) {
-	req, out := c.DeleteWebhookRequest(input)
+//   - ResourceAlreadyExistsException
+//     The specified Amazon Web Services resource cannot be created, because an
+//     Amazon Web Services resource with the same settings already exists.
+//
+//   - ResourceNotFoundException
+//     The specified Amazon Web Services resource cannot be found.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/CreateWebhook
+func (c *CodeBuild) CreateWebhook(input *CreateWebhookInput) (*CreateWebhookOutput, error) {
+	req, out := c.CreateWebhookRequest(input)
 	return out, req.Send()
 }
 
-// DeleteWebhookWithContext is the same as DeleteWebhook with the addition of
+// CreateWebhookWithContext is the same as CreateWebhook with the addition of
 // the ability to pass a context and additional request options.
 //
-// See DeleteWebhook for details on how to use this API operation.
+// See CreateWebhook for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CodeBuild) DeleteWebhookWithContext(ctx aws.Context, input *DeleteWebhookInput, opts ...request.Option) (*DeleteWebhookOutput, error) {
-	req, out := c.DeleteWebhookRequest(input)
+func (c *CodeBuild) CreateWebhookWithContext(ctx aws.Context, input *CreateWebhookInput, opts ...request.Option) (*CreateWebhookOutput, error) {
+	req, out := c.CreateWebhookRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opImportSourceCredentials = "ImportSourceCredentials"
+const opDeleteBuildBatch = "DeleteBuildBatch"
 
-// ImportSourceCredentialsRequest generates a "aws/request.Request" representing the
-// client's request for the ImportSourceCredentials operation. The "output" return
+// DeleteBuildBatchRequest generates a "aws/request.Request" representing the
+// client's request for the DeleteBuildBatch operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ImportSourceCredentials for more information on using the ImportSourceCredentials
+// See DeleteBuildBatch for more information on using the DeleteBuildBatch
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteBuildBatchRequest method.
+//	req, resp := client.DeleteBuildBatchRequest(params)
 //
-//    // Example sending a request using the ImportSourceCredentialsRequest method.
-//    req, resp := client.ImportSourceCredentialsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ImportSourceCredentials
-func (c *CodeBuild) ImportSourceCredentialsRequest(input *ImportSourceCredentialsInput) (req *request.Request, output *ImportSourceCredentialsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DeleteBuildBatch
+func (c *CodeBuild) DeleteBuildBatchRequest(input *DeleteBuildBatchInput) (req *request.Request, output *DeleteBuildBatchOutput) {
 	op := &request.Operation{
-		Name:       opImportSourceCredentials,
+		Name:       opDeleteBuildBatch,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &ImportSourceCredentialsInput{}
+		input = &DeleteBuildBatchInput{}
 	}
 
-	output = &ImportSourceCredentialsOutput{}
+	output = &DeleteBuildBatchOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ImportSourceCredentials API operation for AWS CodeBuild.
+// DeleteBuildBatch API operation for AWS CodeBuild.
 //
-// Imports the source repository credentials for an AWS CodeBuild project that
-// has its source code stored in a GitHub, GitHub Enterprise, or Bitbucket repository.
+// Deletes a batch build.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS CodeBuild's
-// API operation ImportSourceCredentials for usage and error information.
-//
-// Returned Error Codes:
-//   * ErrCodeInvalidInputException "InvalidInputException"
-//   The input value that was provided is not valid.
+// API operation DeleteBuildBatch for usage and error information.
 //
-//   * ErrCodeAccountLimitExceededException "AccountLimitExceededException"
-//   An AWS service limit was exceeded for the calling AWS account.
+// Returned Error Types:
+//   - InvalidInputException
+//     The input value that was provided is not valid.
 //
-//   * ErrCodeResourceAlreadyExistsException "ResourceAlreadyExistsException"
-//   The specified AWS resource cannot be created, because an AWS resource with
-//   the same settings already exists.
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ImportSourceCredentials
-func (c *CodeBuild) ImportSourceCredentials(input *ImportSourceCredentialsInput) (*ImportSourceCredentialsOutput, error) {
-	req, out := c.ImportSourceCredentialsRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DeleteBuildBatch
+func (c *CodeBuild) DeleteBuildBatch(input *DeleteBuildBatchInput) (*DeleteBuildBatchOutput, error) {
+	req, out := c.DeleteBuildBatchRequest(input)
 	return out, req.Send()
 }
 
-// ImportSourceCredentialsWithContext is the same as ImportSourceCredentials with the addition of
+// DeleteBuildBatchWithContext is the same as DeleteBuildBatch with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ImportSourceCredentials for details on how to use this API operation.
+// See DeleteBuildBatch for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CodeBuild) ImportSourceCredentialsWithContext(ctx aws.Context, input *ImportSourceCredentialsInput, opts ...request.Option) (*ImportSourceCredentialsOutput, error) {
-	req, out := c.ImportSourceCredentialsRequest(input)
+func (c *CodeBuild) DeleteBuildBatchWithContext(ctx aws.Context, input *DeleteBuildBatchInput, opts ...request.Option) (*DeleteBuildBatchOutput, error) {
+	req, out := c.DeleteBuildBatchRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opInvalidateProjectCache = "InvalidateProjectCache"
+const opDeleteProject = "DeleteProject"
 
-// InvalidateProjectCacheRequest generates a "aws/request.Request" representing the
-// client's request for the InvalidateProjectCache operation. The "output" return
+// DeleteProjectRequest generates a "aws/request.Request" representing the
+// client's request for the DeleteProject operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See InvalidateProjectCache for more information on using the InvalidateProjectCache
+// See DeleteProject for more information on using the DeleteProject
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteProjectRequest method.
+//	req, resp := client.DeleteProjectRequest(params)
 //
-//    // Example sending a request using the InvalidateProjectCacheRequest method.
-//    req, resp := client.InvalidateProjectCacheRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/InvalidateProjectCache
-func (c *CodeBuild) InvalidateProjectCacheRequest(input *InvalidateProjectCacheInput) (req *request.Request, output *InvalidateProjectCacheOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DeleteProject
+func (c *CodeBuild) DeleteProjectRequest(input *DeleteProjectInput) (req *request.Request, output *DeleteProjectOutput) {
 	op := &request.Operation{
-		Name:       opInvalidateProjectCache,
+		Name:       opDeleteProject,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &InvalidateProjectCacheInput{}
+		input = &DeleteProjectInput{}
 	}
 
-	output = &InvalidateProjectCacheOutput{}
+	output = &DeleteProjectOutput{}
 	req = c.newRequest(op, input, output)
 	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// InvalidateProjectCache API operation for AWS CodeBuild.
+// DeleteProject API operation for AWS CodeBuild.
 //
-// Resets the cache for a project.
+// Deletes a build project. When you delete a project, its builds are not deleted.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS CodeBuild's
-// API operation InvalidateProjectCache for usage and error information.
-//
-// Returned Error Codes:
-//   * ErrCodeInvalidInputException "InvalidInputException"
-//   The input value that was provided is not valid.
+// API operation DeleteProject for usage and error information.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified AWS resource cannot be found.
+// Returned Error Types:
+//   - InvalidInputException
+//     The input value that was provided is not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/InvalidateProjectCache
-func (c *CodeBuild) InvalidateProjectCache(input *InvalidateProjectCacheInput) (*InvalidateProjectCacheOutput, error) {
-	req, out := c.InvalidateProjectCacheRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DeleteProject
+func (c *CodeBuild) DeleteProject(input *DeleteProjectInput) (*DeleteProjectOutput, error) {
+	req, out := c.DeleteProjectRequest(input)
 	return out, req.Send()
 }
 
-// InvalidateProjectCacheWithContext is the same as InvalidateProjectCache with the addition of
+// DeleteProjectWithContext is the same as DeleteProject with the addition of
 // the ability to pass a context and additional request options.
 //
-// See InvalidateProjectCache for details on how to use this API operation.
+// See DeleteProject for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CodeBuild) InvalidateProjectCacheWithContext(ctx aws.Context, input *InvalidateProjectCacheInput, opts ...request.Option) (*InvalidateProjectCacheOutput, error) {
-	req, out := c.InvalidateProjectCacheRequest(input)
+func (c *CodeBuild) DeleteProjectWithContext(ctx aws.Context, input *DeleteProjectInput, opts ...request.Option) (*DeleteProjectOutput, error) {
+	req, out := c.DeleteProjectRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListBuilds = "ListBuilds"
+const opDeleteReport = "DeleteReport"
 
-// ListBuildsRequest generates a "aws/request.Request" representing the
-// client's request for the ListBuilds operation. The "output" return
+// DeleteReportRequest generates a "aws/request.Request" representing the
+// client's request for the DeleteReport operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListBuilds for more information on using the ListBuilds
+// See DeleteReport for more information on using the DeleteReport
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteReportRequest method.
+//	req, resp := client.DeleteReportRequest(params)
 //
-//    // Example sending a request using the ListBuildsRequest method.
-//    req, resp := client.ListBuildsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListBuilds
-func (c *CodeBuild) ListBuildsRequest(input *ListBuildsInput) (req *request.Request, output *ListBuildsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DeleteReport
+func (c *CodeBuild) DeleteReportRequest(input *DeleteReportInput) (req *request.Request, output *DeleteReportOutput) {
 	op := &request.Operation{
-		Name:       opListBuilds,
+		Name:       opDeleteReport,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &ListBuildsInput{}
+		input = &DeleteReportInput{}
 	}
 
-	output = &ListBuildsOutput{}
+	output = &DeleteReportOutput{}
 	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// ListBuilds API operation for AWS CodeBuild.
+// DeleteReport API operation for AWS CodeBuild.
 //
-// Gets a list of build IDs, with each build ID representing a single build.
+// Deletes a report.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS CodeBuild's
-// API operation ListBuilds for usage and error information.
+// API operation DeleteReport for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidInputException "InvalidInputException"
-//   The input value that was provided is not valid.
+// Returned Error Types:
+//   - InvalidInputException
+//     The input value that was provided is not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListBuilds
-func (c *CodeBuild) ListBuilds(input *ListBuildsInput) (*ListBuildsOutput, error) {
-	req, out := c.ListBuildsRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DeleteReport
+func (c *CodeBuild) DeleteReport(input *DeleteReportInput) (*DeleteReportOutput, error) {
+	req, out := c.DeleteReportRequest(input)
 	return out, req.Send()
 }
 
-// ListBuildsWithContext is the same as ListBuilds with the addition of
+// DeleteReportWithContext is the same as DeleteReport with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListBuilds for details on how to use this API operation.
+// See DeleteReport for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CodeBuild) ListBuildsWithContext(ctx aws.Context, input *ListBuildsInput, opts ...request.Option) (*ListBuildsOutput, error) {
-	req, out := c.ListBuildsRequest(input)
+func (c *CodeBuild) DeleteReportWithContext(ctx aws.Context, input *DeleteReportInput, opts ...request.Option) (*DeleteReportOutput, error) {
+	req, out := c.DeleteReportRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListBuildsForProject = "ListBuildsForProject"
+const opDeleteReportGroup = "DeleteReportGroup"
 
-// ListBuildsForProjectRequest generates a "aws/request.Request" representing the
-// client's request for the ListBuildsForProject operation. The "output" return
+// DeleteReportGroupRequest generates a "aws/request.Request" representing the
+// client's request for the DeleteReportGroup operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListBuildsForProject for more information on using the ListBuildsForProject
+// See DeleteReportGroup for more information on using the DeleteReportGroup
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteReportGroupRequest method.
+//	req, resp := client.DeleteReportGroupRequest(params)
 //
-//    // Example sending a request using the ListBuildsForProjectRequest method.
-//    req, resp := client.ListBuildsForProjectRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListBuildsForProject
-func (c *CodeBuild) ListBuildsForProjectRequest(input *ListBuildsForProjectInput) (req *request.Request, output *ListBuildsForProjectOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DeleteReportGroup
+func (c *CodeBuild) DeleteReportGroupRequest(input *DeleteReportGroupInput) (req *request.Request, output *DeleteReportGroupOutput) {
 	op := &request.Operation{
-		Name:       opListBuildsForProject,
+		Name:       opDeleteReportGroup,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &ListBuildsForProjectInput{}
+		input = &DeleteReportGroupInput{}
 	}
 
-	output = &ListBuildsForProjectOutput{}
+	output = &DeleteReportGroupOutput{}
 	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// ListBuildsForProject API operation for AWS CodeBuild.
+// DeleteReportGroup API operation for AWS CodeBuild.
 //
-// Gets a list of build IDs for the specified build project, with each build
-// ID representing a single build.
+// Deletes a report group. Before you delete a report group, you must delete
+// its reports.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS CodeBuild's
-// API operation ListBuildsForProject for usage and error information.
-//
-// Returned Error Codes:
-//   * ErrCodeInvalidInputException "InvalidInputException"
-//   The input value that was provided is not valid.
+// API operation DeleteReportGroup for usage and error information.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified AWS resource cannot be found.
+// Returned Error Types:
+//   - InvalidInputException
+//     The input value that was provided is not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListBuildsForProject
-func (c *CodeBuild) ListBuildsForProject(input *ListBuildsForProjectInput) (*ListBuildsForProjectOutput, error) {
-	req, out := c.ListBuildsForProjectRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DeleteReportGroup
+func (c *CodeBuild) DeleteReportGroup(input *DeleteReportGroupInput) (*DeleteReportGroupOutput, error) {
+	req, out := c.DeleteReportGroupRequest(input)
 	return out, req.Send()
 }
 
-// ListBuildsForProjectWithContext is the same as ListBuildsForProject with the addition of
+// DeleteReportGroupWithContext is the same as DeleteReportGroup with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListBuildsForProject for details on how to use this API operation.
+// See DeleteReportGroup for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CodeBuild) ListBuildsForProjectWithContext(ctx aws.Context, input *ListBuildsForProjectInput, opts ...request.Option) (*ListBuildsForProjectOutput, error) {
-	req, out := c.ListBuildsForProjectRequest(input)
+func (c *CodeBuild) DeleteReportGroupWithContext(ctx aws.Context, input *DeleteReportGroupInput, opts ...request.Option) (*DeleteReportGroupOutput, error) {
+	req, out := c.DeleteReportGroupRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListCuratedEnvironmentImages = "ListCuratedEnvironmentImages"
+const opDeleteResourcePolicy = "DeleteResourcePolicy"
 
-// ListCuratedEnvironmentImagesRequest generates a "aws/request.Request" representing the
-// client's request for the ListCuratedEnvironmentImages operation. The "output" return
+// DeleteResourcePolicyRequest generates a "aws/request.Request" representing the
+// client's request for the DeleteResourcePolicy operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListCuratedEnvironmentImages for more information on using the ListCuratedEnvironmentImages
+// See DeleteResourcePolicy for more information on using the DeleteResourcePolicy
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteResourcePolicyRequest method.
+//	req, resp := client.DeleteResourcePolicyRequest(params)
 //
-//    // Example sending a request using the ListCuratedEnvironmentImagesRequest method.
-//    req, resp := client.ListCuratedEnvironmentImagesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListCuratedEnvironmentImages
-func (c *CodeBuild) ListCuratedEnvironmentImagesRequest(input *ListCuratedEnvironmentImagesInput) (req *request.Request, output *ListCuratedEnvironmentImagesOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DeleteResourcePolicy
+func (c *CodeBuild) DeleteResourcePolicyRequest(input *DeleteResourcePolicyInput) (req *request.Request, output *DeleteResourcePolicyOutput) {
 	op := &request.Operation{
-		Name:       opListCuratedEnvironmentImages,
+		Name:       opDeleteResourcePolicy,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &ListCuratedEnvironmentImagesInput{}
+		input = &DeleteResourcePolicyInput{}
 	}
 
-	output = &ListCuratedEnvironmentImagesOutput{}
+	output = &DeleteResourcePolicyOutput{}
 	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// ListCuratedEnvironmentImages API operation for AWS CodeBuild.
+// DeleteResourcePolicy API operation for AWS CodeBuild.
 //
-// Gets information about Docker images that are managed by AWS CodeBuild.
+// Deletes a resource policy that is identified by its resource ARN.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS CodeBuild's
-// API operation ListCuratedEnvironmentImages for usage and error information.
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListCuratedEnvironmentImages
-func (c *CodeBuild) ListCuratedEnvironmentImages(input *ListCuratedEnvironmentImagesInput) (*ListCuratedEnvironmentImagesOutput, error) {
-	req, out := c.ListCuratedEnvironmentImagesRequest(input)
+// API operation DeleteResourcePolicy for usage and error information.
+//
+// Returned Error Types:
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DeleteResourcePolicy
+func (c *CodeBuild) DeleteResourcePolicy(input *DeleteResourcePolicyInput) (*DeleteResourcePolicyOutput, error) {
+	req, out := c.DeleteResourcePolicyRequest(input)
 	return out, req.Send()
 }
 
-// ListCuratedEnvironmentImagesWithContext is the same as ListCuratedEnvironmentImages with the addition of
+// DeleteResourcePolicyWithContext is the same as DeleteResourcePolicy with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListCuratedEnvironmentImages for details on how to use this API operation.
+// See DeleteResourcePolicy for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CodeBuild) ListCuratedEnvironmentImagesWithContext(ctx aws.Context, input *ListCuratedEnvironmentImagesInput, opts ...request.Option) (*ListCuratedEnvironmentImagesOutput, error) {
-	req, out := c.ListCuratedEnvironmentImagesRequest(input)
+func (c *CodeBuild) DeleteResourcePolicyWithContext(ctx aws.Context, input *DeleteResourcePolicyInput, opts ...request.Option) (*DeleteResourcePolicyOutput, error) {
+	req, out := c.DeleteResourcePolicyRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListProjects = "ListProjects"
+const opDeleteSourceCredentials = "DeleteSourceCredentials"
 
-// ListProjectsRequest generates a "aws/request.Request" representing the
-// client's request for the ListProjects operation. The "output" return
+// DeleteSourceCredentialsRequest generates a "aws/request.Request" representing the
+// client's request for the DeleteSourceCredentials operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListProjects for more information on using the ListProjects
+// See DeleteSourceCredentials for more information on using the DeleteSourceCredentials
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteSourceCredentialsRequest method.
+//	req, resp := client.DeleteSourceCredentialsRequest(params)
 //
-//    // Example sending a request using the ListProjectsRequest method.
-//    req, resp := client.ListProjectsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListProjects
-func (c *CodeBuild) ListProjectsRequest(input *ListProjectsInput) (req *request.Request, output *ListProjectsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DeleteSourceCredentials
+func (c *CodeBuild) DeleteSourceCredentialsRequest(input *DeleteSourceCredentialsInput) (req *request.Request, output *DeleteSourceCredentialsOutput) {
 	op := &request.Operation{
-		Name:       opListProjects,
+		Name:       opDeleteSourceCredentials,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &ListProjectsInput{}
+		input = &DeleteSourceCredentialsInput{}
 	}
 
-	output = &ListProjectsOutput{}
+	output = &DeleteSourceCredentialsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListProjects API operation for AWS CodeBuild.
+// DeleteSourceCredentials API operation for AWS CodeBuild.
 //
-// Gets a list of build project names, with each build project name representing
-// a single build project.
+// Deletes a set of GitHub, GitHub Enterprise, or Bitbucket source credentials.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS CodeBuild's
-// API operation ListProjects for usage and error information.
+// API operation DeleteSourceCredentials for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidInputException "InvalidInputException"
-//   The input value that was provided is not valid.
+// Returned Error Types:
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListProjects
-func (c *CodeBuild) ListProjects(input *ListProjectsInput) (*ListProjectsOutput, error) {
-	req, out := c.ListProjectsRequest(input)
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+//   - ResourceNotFoundException
+//     The specified Amazon Web Services resource cannot be found.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DeleteSourceCredentials
+func (c *CodeBuild) DeleteSourceCredentials(input *DeleteSourceCredentialsInput) (*DeleteSourceCredentialsOutput, error) {
+	req, out := c.DeleteSourceCredentialsRequest(input)
 	return out, req.Send()
 }
 
-// ListProjectsWithContext is the same as ListProjects with the addition of
+// DeleteSourceCredentialsWithContext is the same as DeleteSourceCredentials with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListProjects for details on how to use this API operation.
+// See DeleteSourceCredentials for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CodeBuild) ListProjectsWithContext(ctx aws.Context, input *ListProjectsInput, opts ...request.Option) (*ListProjectsOutput, error) {
-	req, out := c.ListProjectsRequest(input)
+func (c *CodeBuild) DeleteSourceCredentialsWithContext(ctx aws.Context, input *DeleteSourceCredentialsInput, opts ...request.Option) (*DeleteSourceCredentialsOutput, error) {
+	req, out := c.DeleteSourceCredentialsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListSourceCredentials = "ListSourceCredentials"
+const opDeleteWebhook = "DeleteWebhook"
 
-// ListSourceCredentialsRequest generates a "aws/request.Request" representing the
-// client's request for the ListSourceCredentials operation. The "output" return
+// DeleteWebhookRequest generates a "aws/request.Request" representing the
+// client's request for the DeleteWebhook operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListSourceCredentials for more information on using the ListSourceCredentials
+// See DeleteWebhook for more information on using the DeleteWebhook
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteWebhookRequest method.
+//	req, resp := client.DeleteWebhookRequest(params)
 //
-//    // Example sending a request using the ListSourceCredentialsRequest method.
-//    req, resp := client.ListSourceCredentialsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListSourceCredentials
-func (c *CodeBuild) ListSourceCredentialsRequest(input *ListSourceCredentialsInput) (req *request.Request, output *ListSourceCredentialsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DeleteWebhook
+func (c *CodeBuild) DeleteWebhookRequest(input *DeleteWebhookInput) (req *request.Request, output *DeleteWebhookOutput) {
 	op := &request.Operation{
-		Name:       opListSourceCredentials,
+		Name:       opDeleteWebhook,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &ListSourceCredentialsInput{}
+		input = &DeleteWebhookInput{}
 	}
 
-	output = &ListSourceCredentialsOutput{}
+	output = &DeleteWebhookOutput{}
 	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// ListSourceCredentials API operation for AWS CodeBuild.
+// DeleteWebhook API operation for AWS CodeBuild.
 //
-// Returns a list of SourceCredentialsInfo objects.
+// For an existing CodeBuild build project that has its source code stored in
+// a GitHub or Bitbucket repository, stops CodeBuild from rebuilding the source
+// code every time a code change is pushed to the repository.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS CodeBuild's
-// API operation ListSourceCredentials for usage and error information.
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListSourceCredentials
-func (c *CodeBuild) ListSourceCredentials(input *ListSourceCredentialsInput) (*ListSourceCredentialsOutput, error) {
-	req, out := c.ListSourceCredentialsRequest(input)
+// API operation DeleteWebhook for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+//   - ResourceNotFoundException
+//     The specified Amazon Web Services resource cannot be found.
+//
+//   - OAuthProviderException
+//     There was a problem with the underlying OAuth provider.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DeleteWebhook
+func (c *CodeBuild) DeleteWebhook(input *DeleteWebhookInput) (*DeleteWebhookOutput, error) {
+	req, out := c.DeleteWebhookRequest(input)
 	return out, req.Send()
 }
 
-// ListSourceCredentialsWithContext is the same as ListSourceCredentials with the addition of
+// DeleteWebhookWithContext is the same as DeleteWebhook with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListSourceCredentials for details on how to use this API operation.
+// See DeleteWebhook for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CodeBuild) ListSourceCredentialsWithContext(ctx aws.Context, input *ListSourceCredentialsInput, opts ...request.Option) (*ListSourceCredentialsOutput, error) {
-	req, out := c.ListSourceCredentialsRequest(input)
+func (c *CodeBuild) DeleteWebhookWithContext(ctx aws.Context, input *DeleteWebhookInput, opts ...request.Option) (*DeleteWebhookOutput, error) {
+	req, out := c.DeleteWebhookRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opStartBuild = "StartBuild"
+const opDescribeCodeCoverages = "DescribeCodeCoverages"
 
-// StartBuildRequest generates a "aws/request.Request" representing the
-// client's request for the StartBuild operation. The "output" return
+// DescribeCodeCoveragesRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeCodeCoverages operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See StartBuild for more information on using the StartBuild
+// See DescribeCodeCoverages for more information on using the DescribeCodeCoverages
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeCodeCoveragesRequest method.
+//	req, resp := client.DescribeCodeCoveragesRequest(params)
 //
-//    // Example sending a request using the StartBuildRequest method.
-//    req, resp := client.StartBuildRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/StartBuild
-func (c *CodeBuild) StartBuildRequest(input *StartBuildInput) (req *request.Request, output *StartBuildOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DescribeCodeCoverages
+func (c *CodeBuild) DescribeCodeCoveragesRequest(input *DescribeCodeCoveragesInput) (req *request.Request, output *DescribeCodeCoveragesOutput) {
 	op := &request.Operation{
-		Name:       opStartBuild,
+		Name:       opDescribeCodeCoverages,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"nextToken"},
+			OutputTokens:    []string{"nextToken"},
+			LimitToken:      "maxResults",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &StartBuildInput{}
+		input = &DescribeCodeCoveragesInput{}
 	}
 
-	output = &StartBuildOutput{}
+	output = &DescribeCodeCoveragesOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// StartBuild API operation for AWS CodeBuild.
+// DescribeCodeCoverages API operation for AWS CodeBuild.
 //
-// Starts running a build.
+// Retrieves one or more code coverage reports.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS CodeBuild's
-// API operation StartBuild for usage and error information.
-//
-// Returned Error Codes:
-//   * ErrCodeInvalidInputException "InvalidInputException"
-//   The input value that was provided is not valid.
-//
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified AWS resource cannot be found.
+// API operation DescribeCodeCoverages for usage and error information.
 //
-//   * ErrCodeAccountLimitExceededException "AccountLimitExceededException"
-//   An AWS service limit was exceeded for the calling AWS account.
+// Returned Error Types:
+//   - InvalidInputException
+//     The input value that was provided is not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/StartBuild
-func (c *CodeBuild) StartBuild(input *StartBuildInput) (*StartBuildOutput, error) {
-	req, out := c.StartBuildRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DescribeCodeCoverages
+func (c *CodeBuild) DescribeCodeCoverages(input *DescribeCodeCoveragesInput) (*DescribeCodeCoveragesOutput, error) {
+	req, out := c.DescribeCodeCoveragesRequest(input)
 	return out, req.Send()
 }
 
-// StartBuildWithContext is the same as StartBuild with the addition of
+// DescribeCodeCoveragesWithContext is the same as DescribeCodeCoverages with the addition of
 // the ability to pass a context and additional request options.
 //
-// See StartBuild for details on how to use this API operation.
+// See DescribeCodeCoverages for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CodeBuild) StartBuildWithContext(ctx aws.Context, input *StartBuildInput, opts ...request.Option) (*StartBuildOutput, error) {
-	req, out := c.StartBuildRequest(input)
+func (c *CodeBuild) DescribeCodeCoveragesWithContext(ctx aws.Context, input *DescribeCodeCoveragesInput, opts ...request.Option) (*DescribeCodeCoveragesOutput, error) {
+	req, out := c.DescribeCodeCoveragesRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opStopBuild = "StopBuild"
+// DescribeCodeCoveragesPages iterates over the pages of a DescribeCodeCoverages operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See DescribeCodeCoverages method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a DescribeCodeCoverages operation.
+//	pageNum := 0
+//	err := client.DescribeCodeCoveragesPages(params,
+//	    func(page *codebuild.DescribeCodeCoveragesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *CodeBuild) DescribeCodeCoveragesPages(input *DescribeCodeCoveragesInput, fn func(*DescribeCodeCoveragesOutput, bool) bool) error {
+	return c.DescribeCodeCoveragesPagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-// StopBuildRequest generates a "aws/request.Request" representing the
-// client's request for the StopBuild operation. The "output" return
+// DescribeCodeCoveragesPagesWithContext same as DescribeCodeCoveragesPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) DescribeCodeCoveragesPagesWithContext(ctx aws.Context, input *DescribeCodeCoveragesInput, fn func(*DescribeCodeCoveragesOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *DescribeCodeCoveragesInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.DescribeCodeCoveragesRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*DescribeCodeCoveragesOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opDescribeTestCases = "DescribeTestCases"
+
+// DescribeTestCasesRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeTestCases operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See StopBuild for more information on using the StopBuild
+// See DescribeTestCases for more information on using the DescribeTestCases
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeTestCasesRequest method.
+//	req, resp := client.DescribeTestCasesRequest(params)
 //
-//    // Example sending a request using the StopBuildRequest method.
-//    req, resp := client.StopBuildRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/StopBuild
-func (c *CodeBuild) StopBuildRequest(input *StopBuildInput) (req *request.Request, output *StopBuildOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DescribeTestCases
+func (c *CodeBuild) DescribeTestCasesRequest(input *DescribeTestCasesInput) (req *request.Request, output *DescribeTestCasesOutput) {
 	op := &request.Operation{
-		Name:       opStopBuild,
+		Name:       opDescribeTestCases,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"nextToken"},
+			OutputTokens:    []string{"nextToken"},
+			LimitToken:      "maxResults",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &StopBuildInput{}
+		input = &DescribeTestCasesInput{}
 	}
 
-	output = &StopBuildOutput{}
+	output = &DescribeTestCasesOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// StopBuild API operation for AWS CodeBuild.
+// DescribeTestCases API operation for AWS CodeBuild.
 //
-// Attempts to stop running a build.
+// Returns a list of details about test cases for a report.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS CodeBuild's
-// API operation StopBuild for usage and error information.
+// API operation DescribeTestCases for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidInputException "InvalidInputException"
-//   The input value that was provided is not valid.
+// Returned Error Types:
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified AWS resource cannot be found.
+//   - InvalidInputException
+//     The input value that was provided is not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/StopBuild
-func (c *CodeBuild) StopBuild(input *StopBuildInput) (*StopBuildOutput, error) {
-	req, out := c.StopBuildRequest(input)
+//   - ResourceNotFoundException
+//     The specified Amazon Web Services resource cannot be found.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/DescribeTestCases
+func (c *CodeBuild) DescribeTestCases(input *DescribeTestCasesInput) (*DescribeTestCasesOutput, error) {
+	req, out := c.DescribeTestCasesRequest(input)
 	return out, req.Send()
 }
 
-// StopBuildWithContext is the same as StopBuild with the addition of
+// DescribeTestCasesWithContext is the same as DescribeTestCases with the addition of
 // the ability to pass a context and additional request options.
 //
-// See StopBuild for details on how to use this API operation.
+// See DescribeTestCases for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CodeBuild) StopBuildWithContext(ctx aws.Context, input *StopBuildInput, opts ...request.Option) (*StopBuildOutput, error) {
-	req, out := c.StopBuildRequest(input)
+func (c *CodeBuild) DescribeTestCasesWithContext(ctx aws.Context, input *DescribeTestCasesInput, opts ...request.Option) (*DescribeTestCasesOutput, error) {
+	req, out := c.DescribeTestCasesRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateProject = "UpdateProject"
+// DescribeTestCasesPages iterates over the pages of a DescribeTestCases operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See DescribeTestCases method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a DescribeTestCases operation.
+//	pageNum := 0
+//	err := client.DescribeTestCasesPages(params,
+//	    func(page *codebuild.DescribeTestCasesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *CodeBuild) DescribeTestCasesPages(input *DescribeTestCasesInput, fn func(*DescribeTestCasesOutput, bool) bool) error {
+	return c.DescribeTestCasesPagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-// UpdateProjectRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateProject operation. The "output" return
+// DescribeTestCasesPagesWithContext same as DescribeTestCasesPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) DescribeTestCasesPagesWithContext(ctx aws.Context, input *DescribeTestCasesInput, fn func(*DescribeTestCasesOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *DescribeTestCasesInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.DescribeTestCasesRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*DescribeTestCasesOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opGetReportGroupTrend = "GetReportGroupTrend"
+
+// GetReportGroupTrendRequest generates a "aws/request.Request" representing the
+// client's request for the GetReportGroupTrend operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateProject for more information on using the UpdateProject
+// See GetReportGroupTrend for more information on using the GetReportGroupTrend
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetReportGroupTrendRequest method.
+//	req, resp := client.GetReportGroupTrendRequest(params)
 //
-//    // Example sending a request using the UpdateProjectRequest method.
-//    req, resp := client.UpdateProjectRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/UpdateProject
-func (c *CodeBuild) UpdateProjectRequest(input *UpdateProjectInput) (req *request.Request, output *UpdateProjectOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/GetReportGroupTrend
+func (c *CodeBuild) GetReportGroupTrendRequest(input *GetReportGroupTrendInput) (req *request.Request, output *GetReportGroupTrendOutput) {
 	op := &request.Operation{
-		Name:       opUpdateProject,
+		Name:       opGetReportGroupTrend,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &UpdateProjectInput{}
+		input = &GetReportGroupTrendInput{}
 	}
 
-	output = &UpdateProjectOutput{}
+	output = &GetReportGroupTrendOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// UpdateProject API operation for AWS CodeBuild.
+// GetReportGroupTrend API operation for AWS CodeBuild.
 //
-// Changes the settings of a build project.
+// Analyzes and accumulates test report values for the specified test reports.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS CodeBuild's
-// API operation UpdateProject for usage and error information.
+// API operation GetReportGroupTrend for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidInputException "InvalidInputException"
-//   The input value that was provided is not valid.
+// Returned Error Types:
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified AWS resource cannot be found.
+//   - InvalidInputException
+//     The input value that was provided is not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/UpdateProject
-func (c *CodeBuild) UpdateProject(input *UpdateProjectInput) (*UpdateProjectOutput, error) {
-	req, out := c.UpdateProjectRequest(input)
+//   - ResourceNotFoundException
+//     The specified Amazon Web Services resource cannot be found.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/GetReportGroupTrend
+func (c *CodeBuild) GetReportGroupTrend(input *GetReportGroupTrendInput) (*GetReportGroupTrendOutput, error) {
+	req, out := c.GetReportGroupTrendRequest(input)
 	return out, req.Send()
 }
 
-// UpdateProjectWithContext is the same as UpdateProject with the addition of
+// GetReportGroupTrendWithContext is the same as GetReportGroupTrend with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateProject for details on how to use this API operation.
+// See GetReportGroupTrend for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CodeBuild) UpdateProjectWithContext(ctx aws.Context, input *UpdateProjectInput, opts ...request.Option) (*UpdateProjectOutput, error) {
-	req, out := c.UpdateProjectRequest(input)
+func (c *CodeBuild) GetReportGroupTrendWithContext(ctx aws.Context, input *GetReportGroupTrendInput, opts ...request.Option) (*GetReportGroupTrendOutput, error) {
+	req, out := c.GetReportGroupTrendRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateWebhook = "UpdateWebhook"
+const opGetResourcePolicy = "GetResourcePolicy"
 
-// UpdateWebhookRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateWebhook operation. The "output" return
+// GetResourcePolicyRequest generates a "aws/request.Request" representing the
+// client's request for the GetResourcePolicy operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateWebhook for more information on using the UpdateWebhook
+// See GetResourcePolicy for more information on using the GetResourcePolicy
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetResourcePolicyRequest method.
+//	req, resp := client.GetResourcePolicyRequest(params)
 //
-//    // Example sending a request using the UpdateWebhookRequest method.
-//    req, resp := client.UpdateWebhookRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/UpdateWebhook
-func (c *CodeBuild) UpdateWebhookRequest(input *UpdateWebhookInput) (req *request.Request, output *UpdateWebhookOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/GetResourcePolicy
+func (c *CodeBuild) GetResourcePolicyRequest(input *GetResourcePolicyInput) (req *request.Request, output *GetResourcePolicyOutput) {
 	op := &request.Operation{
-		Name:       opUpdateWebhook,
+		Name:       opGetResourcePolicy,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &UpdateWebhookInput{}
+		input = &GetResourcePolicyInput{}
 	}
 
-	output = &UpdateWebhookOutput{}
+	output = &GetResourcePolicyOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// UpdateWebhook API operation for AWS CodeBuild.
+// GetResourcePolicy API operation for AWS CodeBuild.
 //
-// Updates the webhook associated with an AWS CodeBuild build project.
-//
-// If you use Bitbucket for your repository, rotateSecret is ignored.
+// Gets a resource policy that is identified by its resource ARN.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS CodeBuild's
-// API operation UpdateWebhook for usage and error information.
+// API operation GetResourcePolicy for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidInputException "InvalidInputException"
-//   The input value that was provided is not valid.
+// Returned Error Types:
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified AWS resource cannot be found.
+//   - ResourceNotFoundException
+//     The specified Amazon Web Services resource cannot be found.
 //
-//   * ErrCodeOAuthProviderException "OAuthProviderException"
-//   There was a problem with the underlying OAuth provider.
+//   - InvalidInputException
+//     The input value that was provided is not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/UpdateWebhook
-func (c *CodeBuild) UpdateWebhook(input *UpdateWebhookInput) (*UpdateWebhookOutput, error) {
-	req, out := c.UpdateWebhookRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/GetResourcePolicy
+func (c *CodeBuild) GetResourcePolicy(input *GetResourcePolicyInput) (*GetResourcePolicyOutput, error) {
+	req, out := c.GetResourcePolicyRequest(input)
 	return out, req.Send()
 }
 
-// UpdateWebhookWithContext is the same as UpdateWebhook with the addition of
+// GetResourcePolicyWithContext is the same as GetResourcePolicy with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateWebhook for details on how to use this API operation.
+// See GetResourcePolicy for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *CodeBuild) UpdateWebhookWithContext(ctx aws.Context, input *UpdateWebhookInput, opts ...request.Option) (*UpdateWebhookOutput, error) {
-	req, out := c.UpdateWebhookRequest(input)
+func (c *CodeBuild) GetResourcePolicyWithContext(ctx aws.Context, input *GetResourcePolicyInput, opts ...request.Option) (*GetResourcePolicyOutput, error) {
+	req, out := c.GetResourcePolicyRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-type BatchDeleteBuildsInput struct {
-	_ struct{} `type:"structure"`
+const opImportSourceCredentials = "ImportSourceCredentials"
 
-	// The IDs of the builds to delete.
-	//
-	// Ids is a required field
-	Ids []*string `locationName:"ids" min:"1" type:"list" required:"true"`
+// ImportSourceCredentialsRequest generates a "aws/request.Request" representing the
+// client's request for the ImportSourceCredentials operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ImportSourceCredentials for more information on using the ImportSourceCredentials
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ImportSourceCredentialsRequest method.
+//	req, resp := client.ImportSourceCredentialsRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ImportSourceCredentials
+func (c *CodeBuild) ImportSourceCredentialsRequest(input *ImportSourceCredentialsInput) (req *request.Request, output *ImportSourceCredentialsOutput) {
+	op := &request.Operation{
+		Name:       opImportSourceCredentials,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &ImportSourceCredentialsInput{}
+	}
+
+	output = &ImportSourceCredentialsOutput{}
+	req = c.newRequest(op, input, output)
+	return
 }
 
-// String returns the string representation
-func (s BatchDeleteBuildsInput) String() string {
-	return awsutil.Prettify(s)
+// ImportSourceCredentials API operation for AWS CodeBuild.
+//
+// Imports the source repository credentials for an CodeBuild project that has
+// its source code stored in a GitHub, GitHub Enterprise, or Bitbucket repository.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation ImportSourceCredentials for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+//   - AccountLimitExceededException
+//     An Amazon Web Services service limit was exceeded for the calling Amazon
+//     Web Services account.
+//
+//   - ResourceAlreadyExistsException
+//     The specified Amazon Web Services resource cannot be created, because an
+//     Amazon Web Services resource with the same settings already exists.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ImportSourceCredentials
+func (c *CodeBuild) ImportSourceCredentials(input *ImportSourceCredentialsInput) (*ImportSourceCredentialsOutput, error) {
+	req, out := c.ImportSourceCredentialsRequest(input)
+	return out, req.Send()
 }
 
-// GoString returns the string representation
-func (s BatchDeleteBuildsInput) GoString() string {
-	return s.String()
+// ImportSourceCredentialsWithContext is the same as ImportSourceCredentials with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ImportSourceCredentials for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) ImportSourceCredentialsWithContext(ctx aws.Context, input *ImportSourceCredentialsInput, opts ...request.Option) (*ImportSourceCredentialsOutput, error) {
+	req, out := c.ImportSourceCredentialsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *BatchDeleteBuildsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "BatchDeleteBuildsInput"}
-	if s.Ids == nil {
-		invalidParams.Add(request.NewErrParamRequired("Ids"))
-	}
-	if s.Ids != nil && len(s.Ids) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Ids", 1))
+const opInvalidateProjectCache = "InvalidateProjectCache"
+
+// InvalidateProjectCacheRequest generates a "aws/request.Request" representing the
+// client's request for the InvalidateProjectCache operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See InvalidateProjectCache for more information on using the InvalidateProjectCache
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the InvalidateProjectCacheRequest method.
+//	req, resp := client.InvalidateProjectCacheRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/InvalidateProjectCache
+func (c *CodeBuild) InvalidateProjectCacheRequest(input *InvalidateProjectCacheInput) (req *request.Request, output *InvalidateProjectCacheOutput) {
+	op := &request.Operation{
+		Name:       opInvalidateProjectCache,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
 	}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
+	if input == nil {
+		input = &InvalidateProjectCacheInput{}
 	}
-	return nil
+
+	output = &InvalidateProjectCacheOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
 }
 
-// SetIds sets the Ids field's value.
-func (s *BatchDeleteBuildsInput) SetIds(v []*string) *BatchDeleteBuildsInput {
-	s.Ids = v
-	return s
+// InvalidateProjectCache API operation for AWS CodeBuild.
+//
+// Resets the cache for a project.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation InvalidateProjectCache for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+//   - ResourceNotFoundException
+//     The specified Amazon Web Services resource cannot be found.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/InvalidateProjectCache
+func (c *CodeBuild) InvalidateProjectCache(input *InvalidateProjectCacheInput) (*InvalidateProjectCacheOutput, error) {
+	req, out := c.InvalidateProjectCacheRequest(input)
+	return out, req.Send()
 }
 
-type BatchDeleteBuildsOutput struct {
-	_ struct{} `type:"structure"`
+// InvalidateProjectCacheWithContext is the same as InvalidateProjectCache with the addition of
+// the ability to pass a context and additional request options.
+//
+// See InvalidateProjectCache for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) InvalidateProjectCacheWithContext(ctx aws.Context, input *InvalidateProjectCacheInput, opts ...request.Option) (*InvalidateProjectCacheOutput, error) {
+	req, out := c.InvalidateProjectCacheRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
 
-	// The IDs of the builds that were successfully deleted.
-	BuildsDeleted []*string `locationName:"buildsDeleted" min:"1" type:"list"`
+const opListBuildBatches = "ListBuildBatches"
 
-	// Information about any builds that could not be successfully deleted.
-	BuildsNotDeleted []*BuildNotDeleted `locationName:"buildsNotDeleted" type:"list"`
-}
+// ListBuildBatchesRequest generates a "aws/request.Request" representing the
+// client's request for the ListBuildBatches operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListBuildBatches for more information on using the ListBuildBatches
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListBuildBatchesRequest method.
+//	req, resp := client.ListBuildBatchesRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListBuildBatches
+func (c *CodeBuild) ListBuildBatchesRequest(input *ListBuildBatchesInput) (req *request.Request, output *ListBuildBatchesOutput) {
+	op := &request.Operation{
+		Name:       opListBuildBatches,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"nextToken"},
+			OutputTokens:    []string{"nextToken"},
+			LimitToken:      "maxResults",
+			TruncationToken: "",
+		},
+	}
 
-// String returns the string representation
-func (s BatchDeleteBuildsOutput) String() string {
-	return awsutil.Prettify(s)
+	if input == nil {
+		input = &ListBuildBatchesInput{}
+	}
+
+	output = &ListBuildBatchesOutput{}
+	req = c.newRequest(op, input, output)
+	return
 }
 
-// GoString returns the string representation
-func (s BatchDeleteBuildsOutput) GoString() string {
-	return s.String()
+// ListBuildBatches API operation for AWS CodeBuild.
+//
+// Retrieves the identifiers of your build batches in the current region.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation ListBuildBatches for usage and error information.
+//
+// Returned Error Types:
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListBuildBatches
+func (c *CodeBuild) ListBuildBatches(input *ListBuildBatchesInput) (*ListBuildBatchesOutput, error) {
+	req, out := c.ListBuildBatchesRequest(input)
+	return out, req.Send()
 }
 
-// SetBuildsDeleted sets the BuildsDeleted field's value.
-func (s *BatchDeleteBuildsOutput) SetBuildsDeleted(v []*string) *BatchDeleteBuildsOutput {
-	s.BuildsDeleted = v
-	return s
+// ListBuildBatchesWithContext is the same as ListBuildBatches with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListBuildBatches for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) ListBuildBatchesWithContext(ctx aws.Context, input *ListBuildBatchesInput, opts ...request.Option) (*ListBuildBatchesOutput, error) {
+	req, out := c.ListBuildBatchesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
 }
 
-// SetBuildsNotDeleted sets the BuildsNotDeleted field's value.
-func (s *BatchDeleteBuildsOutput) SetBuildsNotDeleted(v []*BuildNotDeleted) *BatchDeleteBuildsOutput {
-	s.BuildsNotDeleted = v
-	return s
+// ListBuildBatchesPages iterates over the pages of a ListBuildBatches operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListBuildBatches method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListBuildBatches operation.
+//	pageNum := 0
+//	err := client.ListBuildBatchesPages(params,
+//	    func(page *codebuild.ListBuildBatchesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *CodeBuild) ListBuildBatchesPages(input *ListBuildBatchesInput, fn func(*ListBuildBatchesOutput, bool) bool) error {
+	return c.ListBuildBatchesPagesWithContext(aws.BackgroundContext(), input, fn)
 }
 
-type BatchGetBuildsInput struct {
-	_ struct{} `type:"structure"`
+// ListBuildBatchesPagesWithContext same as ListBuildBatchesPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) ListBuildBatchesPagesWithContext(ctx aws.Context, input *ListBuildBatchesInput, fn func(*ListBuildBatchesOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListBuildBatchesInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListBuildBatchesRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
 
-	// The IDs of the builds.
-	//
-	// Ids is a required field
-	Ids []*string `locationName:"ids" min:"1" type:"list" required:"true"`
+	for p.Next() {
+		if !fn(p.Page().(*ListBuildBatchesOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
 }
 
-// String returns the string representation
-func (s BatchGetBuildsInput) String() string {
+const opListBuildBatchesForProject = "ListBuildBatchesForProject"
+
+// ListBuildBatchesForProjectRequest generates a "aws/request.Request" representing the
+// client's request for the ListBuildBatchesForProject operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListBuildBatchesForProject for more information on using the ListBuildBatchesForProject
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListBuildBatchesForProjectRequest method.
+//	req, resp := client.ListBuildBatchesForProjectRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListBuildBatchesForProject
+func (c *CodeBuild) ListBuildBatchesForProjectRequest(input *ListBuildBatchesForProjectInput) (req *request.Request, output *ListBuildBatchesForProjectOutput) {
+	op := &request.Operation{
+		Name:       opListBuildBatchesForProject,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"nextToken"},
+			OutputTokens:    []string{"nextToken"},
+			LimitToken:      "maxResults",
+			TruncationToken: "",
+		},
+	}
+
+	if input == nil {
+		input = &ListBuildBatchesForProjectInput{}
+	}
+
+	output = &ListBuildBatchesForProjectOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListBuildBatchesForProject API operation for AWS CodeBuild.
+//
+// Retrieves the identifiers of the build batches for a specific project.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation ListBuildBatchesForProject for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+//   - ResourceNotFoundException
+//     The specified Amazon Web Services resource cannot be found.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListBuildBatchesForProject
+func (c *CodeBuild) ListBuildBatchesForProject(input *ListBuildBatchesForProjectInput) (*ListBuildBatchesForProjectOutput, error) {
+	req, out := c.ListBuildBatchesForProjectRequest(input)
+	return out, req.Send()
+}
+
+// ListBuildBatchesForProjectWithContext is the same as ListBuildBatchesForProject with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListBuildBatchesForProject for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) ListBuildBatchesForProjectWithContext(ctx aws.Context, input *ListBuildBatchesForProjectInput, opts ...request.Option) (*ListBuildBatchesForProjectOutput, error) {
+	req, out := c.ListBuildBatchesForProjectRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+// ListBuildBatchesForProjectPages iterates over the pages of a ListBuildBatchesForProject operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListBuildBatchesForProject method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListBuildBatchesForProject operation.
+//	pageNum := 0
+//	err := client.ListBuildBatchesForProjectPages(params,
+//	    func(page *codebuild.ListBuildBatchesForProjectOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *CodeBuild) ListBuildBatchesForProjectPages(input *ListBuildBatchesForProjectInput, fn func(*ListBuildBatchesForProjectOutput, bool) bool) error {
+	return c.ListBuildBatchesForProjectPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListBuildBatchesForProjectPagesWithContext same as ListBuildBatchesForProjectPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) ListBuildBatchesForProjectPagesWithContext(ctx aws.Context, input *ListBuildBatchesForProjectInput, fn func(*ListBuildBatchesForProjectOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListBuildBatchesForProjectInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListBuildBatchesForProjectRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListBuildBatchesForProjectOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListBuilds = "ListBuilds"
+
+// ListBuildsRequest generates a "aws/request.Request" representing the
+// client's request for the ListBuilds operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListBuilds for more information on using the ListBuilds
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListBuildsRequest method.
+//	req, resp := client.ListBuildsRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListBuilds
+func (c *CodeBuild) ListBuildsRequest(input *ListBuildsInput) (req *request.Request, output *ListBuildsOutput) {
+	op := &request.Operation{
+		Name:       opListBuilds,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"nextToken"},
+			OutputTokens:    []string{"nextToken"},
+			LimitToken:      "",
+			TruncationToken: "",
+		},
+	}
+
+	if input == nil {
+		input = &ListBuildsInput{}
+	}
+
+	output = &ListBuildsOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListBuilds API operation for AWS CodeBuild.
+//
+// Gets a list of build IDs, with each build ID representing a single build.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation ListBuilds for usage and error information.
+//
+// Returned Error Types:
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListBuilds
+func (c *CodeBuild) ListBuilds(input *ListBuildsInput) (*ListBuildsOutput, error) {
+	req, out := c.ListBuildsRequest(input)
+	return out, req.Send()
+}
+
+// ListBuildsWithContext is the same as ListBuilds with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListBuilds for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) ListBuildsWithContext(ctx aws.Context, input *ListBuildsInput, opts ...request.Option) (*ListBuildsOutput, error) {
+	req, out := c.ListBuildsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+// ListBuildsPages iterates over the pages of a ListBuilds operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListBuilds method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListBuilds operation.
+//	pageNum := 0
+//	err := client.ListBuildsPages(params,
+//	    func(page *codebuild.ListBuildsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *CodeBuild) ListBuildsPages(input *ListBuildsInput, fn func(*ListBuildsOutput, bool) bool) error {
+	return c.ListBuildsPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListBuildsPagesWithContext same as ListBuildsPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) ListBuildsPagesWithContext(ctx aws.Context, input *ListBuildsInput, fn func(*ListBuildsOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListBuildsInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListBuildsRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListBuildsOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListBuildsForProject = "ListBuildsForProject"
+
+// ListBuildsForProjectRequest generates a "aws/request.Request" representing the
+// client's request for the ListBuildsForProject operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListBuildsForProject for more information on using the ListBuildsForProject
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListBuildsForProjectRequest method.
+//	req, resp := client.ListBuildsForProjectRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListBuildsForProject
+func (c *CodeBuild) ListBuildsForProjectRequest(input *ListBuildsForProjectInput) (req *request.Request, output *ListBuildsForProjectOutput) {
+	op := &request.Operation{
+		Name:       opListBuildsForProject,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"nextToken"},
+			OutputTokens:    []string{"nextToken"},
+			LimitToken:      "",
+			TruncationToken: "",
+		},
+	}
+
+	if input == nil {
+		input = &ListBuildsForProjectInput{}
+	}
+
+	output = &ListBuildsForProjectOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListBuildsForProject API operation for AWS CodeBuild.
+//
+// Gets a list of build identifiers for the specified build project, with each
+// build identifier representing a single build.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation ListBuildsForProject for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+//   - ResourceNotFoundException
+//     The specified Amazon Web Services resource cannot be found.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListBuildsForProject
+func (c *CodeBuild) ListBuildsForProject(input *ListBuildsForProjectInput) (*ListBuildsForProjectOutput, error) {
+	req, out := c.ListBuildsForProjectRequest(input)
+	return out, req.Send()
+}
+
+// ListBuildsForProjectWithContext is the same as ListBuildsForProject with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListBuildsForProject for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) ListBuildsForProjectWithContext(ctx aws.Context, input *ListBuildsForProjectInput, opts ...request.Option) (*ListBuildsForProjectOutput, error) {
+	req, out := c.ListBuildsForProjectRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+// ListBuildsForProjectPages iterates over the pages of a ListBuildsForProject operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListBuildsForProject method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListBuildsForProject operation.
+//	pageNum := 0
+//	err := client.ListBuildsForProjectPages(params,
+//	    func(page *codebuild.ListBuildsForProjectOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *CodeBuild) ListBuildsForProjectPages(input *ListBuildsForProjectInput, fn func(*ListBuildsForProjectOutput, bool) bool) error {
+	return c.ListBuildsForProjectPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListBuildsForProjectPagesWithContext same as ListBuildsForProjectPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) ListBuildsForProjectPagesWithContext(ctx aws.Context, input *ListBuildsForProjectInput, fn func(*ListBuildsForProjectOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListBuildsForProjectInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListBuildsForProjectRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListBuildsForProjectOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListCuratedEnvironmentImages = "ListCuratedEnvironmentImages"
+
+// ListCuratedEnvironmentImagesRequest generates a "aws/request.Request" representing the
+// client's request for the ListCuratedEnvironmentImages operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListCuratedEnvironmentImages for more information on using the ListCuratedEnvironmentImages
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListCuratedEnvironmentImagesRequest method.
+//	req, resp := client.ListCuratedEnvironmentImagesRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListCuratedEnvironmentImages
+func (c *CodeBuild) ListCuratedEnvironmentImagesRequest(input *ListCuratedEnvironmentImagesInput) (req *request.Request, output *ListCuratedEnvironmentImagesOutput) {
+	op := &request.Operation{
+		Name:       opListCuratedEnvironmentImages,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &ListCuratedEnvironmentImagesInput{}
+	}
+
+	output = &ListCuratedEnvironmentImagesOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListCuratedEnvironmentImages API operation for AWS CodeBuild.
+//
+// Gets information about Docker images that are managed by CodeBuild.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation ListCuratedEnvironmentImages for usage and error information.
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListCuratedEnvironmentImages
+func (c *CodeBuild) ListCuratedEnvironmentImages(input *ListCuratedEnvironmentImagesInput) (*ListCuratedEnvironmentImagesOutput, error) {
+	req, out := c.ListCuratedEnvironmentImagesRequest(input)
+	return out, req.Send()
+}
+
+// ListCuratedEnvironmentImagesWithContext is the same as ListCuratedEnvironmentImages with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListCuratedEnvironmentImages for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) ListCuratedEnvironmentImagesWithContext(ctx aws.Context, input *ListCuratedEnvironmentImagesInput, opts ...request.Option) (*ListCuratedEnvironmentImagesOutput, error) {
+	req, out := c.ListCuratedEnvironmentImagesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opListProjects = "ListProjects"
+
+// ListProjectsRequest generates a "aws/request.Request" representing the
+// client's request for the ListProjects operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListProjects for more information on using the ListProjects
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListProjectsRequest method.
+//	req, resp := client.ListProjectsRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListProjects
+func (c *CodeBuild) ListProjectsRequest(input *ListProjectsInput) (req *request.Request, output *ListProjectsOutput) {
+	op := &request.Operation{
+		Name:       opListProjects,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"nextToken"},
+			OutputTokens:    []string{"nextToken"},
+			LimitToken:      "",
+			TruncationToken: "",
+		},
+	}
+
+	if input == nil {
+		input = &ListProjectsInput{}
+	}
+
+	output = &ListProjectsOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListProjects API operation for AWS CodeBuild.
+//
+// Gets a list of build project names, with each build project name representing
+// a single build project.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation ListProjects for usage and error information.
+//
+// Returned Error Types:
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListProjects
+func (c *CodeBuild) ListProjects(input *ListProjectsInput) (*ListProjectsOutput, error) {
+	req, out := c.ListProjectsRequest(input)
+	return out, req.Send()
+}
+
+// ListProjectsWithContext is the same as ListProjects with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListProjects for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) ListProjectsWithContext(ctx aws.Context, input *ListProjectsInput, opts ...request.Option) (*ListProjectsOutput, error) {
+	req, out := c.ListProjectsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+// ListProjectsPages iterates over the pages of a ListProjects operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListProjects method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListProjects operation.
+//	pageNum := 0
+//	err := client.ListProjectsPages(params,
+//	    func(page *codebuild.ListProjectsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *CodeBuild) ListProjectsPages(input *ListProjectsInput, fn func(*ListProjectsOutput, bool) bool) error {
+	return c.ListProjectsPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListProjectsPagesWithContext same as ListProjectsPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) ListProjectsPagesWithContext(ctx aws.Context, input *ListProjectsInput, fn func(*ListProjectsOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListProjectsInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListProjectsRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListProjectsOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListReportGroups = "ListReportGroups"
+
+// ListReportGroupsRequest generates a "aws/request.Request" representing the
+// client's request for the ListReportGroups operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListReportGroups for more information on using the ListReportGroups
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListReportGroupsRequest method.
+//	req, resp := client.ListReportGroupsRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListReportGroups
+func (c *CodeBuild) ListReportGroupsRequest(input *ListReportGroupsInput) (req *request.Request, output *ListReportGroupsOutput) {
+	op := &request.Operation{
+		Name:       opListReportGroups,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"nextToken"},
+			OutputTokens:    []string{"nextToken"},
+			LimitToken:      "maxResults",
+			TruncationToken: "",
+		},
+	}
+
+	if input == nil {
+		input = &ListReportGroupsInput{}
+	}
+
+	output = &ListReportGroupsOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListReportGroups API operation for AWS CodeBuild.
+//
+// Gets a list ARNs for the report groups in the current Amazon Web Services
+// account.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation ListReportGroups for usage and error information.
+//
+// Returned Error Types:
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListReportGroups
+func (c *CodeBuild) ListReportGroups(input *ListReportGroupsInput) (*ListReportGroupsOutput, error) {
+	req, out := c.ListReportGroupsRequest(input)
+	return out, req.Send()
+}
+
+// ListReportGroupsWithContext is the same as ListReportGroups with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListReportGroups for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) ListReportGroupsWithContext(ctx aws.Context, input *ListReportGroupsInput, opts ...request.Option) (*ListReportGroupsOutput, error) {
+	req, out := c.ListReportGroupsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+// ListReportGroupsPages iterates over the pages of a ListReportGroups operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListReportGroups method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListReportGroups operation.
+//	pageNum := 0
+//	err := client.ListReportGroupsPages(params,
+//	    func(page *codebuild.ListReportGroupsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *CodeBuild) ListReportGroupsPages(input *ListReportGroupsInput, fn func(*ListReportGroupsOutput, bool) bool) error {
+	return c.ListReportGroupsPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListReportGroupsPagesWithContext same as ListReportGroupsPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) ListReportGroupsPagesWithContext(ctx aws.Context, input *ListReportGroupsInput, fn func(*ListReportGroupsOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListReportGroupsInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListReportGroupsRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListReportGroupsOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListReports = "ListReports"
+
+// ListReportsRequest generates a "aws/request.Request" representing the
+// client's request for the ListReports operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListReports for more information on using the ListReports
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListReportsRequest method.
+//	req, resp := client.ListReportsRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListReports
+func (c *CodeBuild) ListReportsRequest(input *ListReportsInput) (req *request.Request, output *ListReportsOutput) {
+	op := &request.Operation{
+		Name:       opListReports,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"nextToken"},
+			OutputTokens:    []string{"nextToken"},
+			LimitToken:      "maxResults",
+			TruncationToken: "",
+		},
+	}
+
+	if input == nil {
+		input = &ListReportsInput{}
+	}
+
+	output = &ListReportsOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListReports API operation for AWS CodeBuild.
+//
+// Returns a list of ARNs for the reports in the current Amazon Web Services
+// account.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation ListReports for usage and error information.
+//
+// Returned Error Types:
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListReports
+func (c *CodeBuild) ListReports(input *ListReportsInput) (*ListReportsOutput, error) {
+	req, out := c.ListReportsRequest(input)
+	return out, req.Send()
+}
+
+// ListReportsWithContext is the same as ListReports with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListReports for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) ListReportsWithContext(ctx aws.Context, input *ListReportsInput, opts ...request.Option) (*ListReportsOutput, error) {
+	req, out := c.ListReportsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+// ListReportsPages iterates over the pages of a ListReports operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListReports method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListReports operation.
+//	pageNum := 0
+//	err := client.ListReportsPages(params,
+//	    func(page *codebuild.ListReportsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *CodeBuild) ListReportsPages(input *ListReportsInput, fn func(*ListReportsOutput, bool) bool) error {
+	return c.ListReportsPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListReportsPagesWithContext same as ListReportsPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) ListReportsPagesWithContext(ctx aws.Context, input *ListReportsInput, fn func(*ListReportsOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListReportsInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListReportsRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListReportsOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListReportsForReportGroup = "ListReportsForReportGroup"
+
+// ListReportsForReportGroupRequest generates a "aws/request.Request" representing the
+// client's request for the ListReportsForReportGroup operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListReportsForReportGroup for more information on using the ListReportsForReportGroup
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListReportsForReportGroupRequest method.
+//	req, resp := client.ListReportsForReportGroupRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListReportsForReportGroup
+func (c *CodeBuild) ListReportsForReportGroupRequest(input *ListReportsForReportGroupInput) (req *request.Request, output *ListReportsForReportGroupOutput) {
+	op := &request.Operation{
+		Name:       opListReportsForReportGroup,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"nextToken"},
+			OutputTokens:    []string{"nextToken"},
+			LimitToken:      "maxResults",
+			TruncationToken: "",
+		},
+	}
+
+	if input == nil {
+		input = &ListReportsForReportGroupInput{}
+	}
+
+	output = &ListReportsForReportGroupOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListReportsForReportGroup API operation for AWS CodeBuild.
+//
+// Returns a list of ARNs for the reports that belong to a ReportGroup.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation ListReportsForReportGroup for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+//   - ResourceNotFoundException
+//     The specified Amazon Web Services resource cannot be found.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListReportsForReportGroup
+func (c *CodeBuild) ListReportsForReportGroup(input *ListReportsForReportGroupInput) (*ListReportsForReportGroupOutput, error) {
+	req, out := c.ListReportsForReportGroupRequest(input)
+	return out, req.Send()
+}
+
+// ListReportsForReportGroupWithContext is the same as ListReportsForReportGroup with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListReportsForReportGroup for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) ListReportsForReportGroupWithContext(ctx aws.Context, input *ListReportsForReportGroupInput, opts ...request.Option) (*ListReportsForReportGroupOutput, error) {
+	req, out := c.ListReportsForReportGroupRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+// ListReportsForReportGroupPages iterates over the pages of a ListReportsForReportGroup operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListReportsForReportGroup method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListReportsForReportGroup operation.
+//	pageNum := 0
+//	err := client.ListReportsForReportGroupPages(params,
+//	    func(page *codebuild.ListReportsForReportGroupOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *CodeBuild) ListReportsForReportGroupPages(input *ListReportsForReportGroupInput, fn func(*ListReportsForReportGroupOutput, bool) bool) error {
+	return c.ListReportsForReportGroupPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListReportsForReportGroupPagesWithContext same as ListReportsForReportGroupPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) ListReportsForReportGroupPagesWithContext(ctx aws.Context, input *ListReportsForReportGroupInput, fn func(*ListReportsForReportGroupOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListReportsForReportGroupInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListReportsForReportGroupRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListReportsForReportGroupOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListSharedProjects = "ListSharedProjects"
+
+// ListSharedProjectsRequest generates a "aws/request.Request" representing the
+// client's request for the ListSharedProjects operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListSharedProjects for more information on using the ListSharedProjects
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListSharedProjectsRequest method.
+//	req, resp := client.ListSharedProjectsRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListSharedProjects
+func (c *CodeBuild) ListSharedProjectsRequest(input *ListSharedProjectsInput) (req *request.Request, output *ListSharedProjectsOutput) {
+	op := &request.Operation{
+		Name:       opListSharedProjects,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"nextToken"},
+			OutputTokens:    []string{"nextToken"},
+			LimitToken:      "maxResults",
+			TruncationToken: "",
+		},
+	}
+
+	if input == nil {
+		input = &ListSharedProjectsInput{}
+	}
+
+	output = &ListSharedProjectsOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListSharedProjects API operation for AWS CodeBuild.
+//
+// Gets a list of projects that are shared with other Amazon Web Services accounts
+// or users.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation ListSharedProjects for usage and error information.
+//
+// Returned Error Types:
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListSharedProjects
+func (c *CodeBuild) ListSharedProjects(input *ListSharedProjectsInput) (*ListSharedProjectsOutput, error) {
+	req, out := c.ListSharedProjectsRequest(input)
+	return out, req.Send()
+}
+
+// ListSharedProjectsWithContext is the same as ListSharedProjects with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListSharedProjects for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) ListSharedProjectsWithContext(ctx aws.Context, input *ListSharedProjectsInput, opts ...request.Option) (*ListSharedProjectsOutput, error) {
+	req, out := c.ListSharedProjectsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+// ListSharedProjectsPages iterates over the pages of a ListSharedProjects operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListSharedProjects method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListSharedProjects operation.
+//	pageNum := 0
+//	err := client.ListSharedProjectsPages(params,
+//	    func(page *codebuild.ListSharedProjectsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *CodeBuild) ListSharedProjectsPages(input *ListSharedProjectsInput, fn func(*ListSharedProjectsOutput, bool) bool) error {
+	return c.ListSharedProjectsPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListSharedProjectsPagesWithContext same as ListSharedProjectsPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) ListSharedProjectsPagesWithContext(ctx aws.Context, input *ListSharedProjectsInput, fn func(*ListSharedProjectsOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListSharedProjectsInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListSharedProjectsRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListSharedProjectsOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListSharedReportGroups = "ListSharedReportGroups"
+
+// ListSharedReportGroupsRequest generates a "aws/request.Request" representing the
+// client's request for the ListSharedReportGroups operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListSharedReportGroups for more information on using the ListSharedReportGroups
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListSharedReportGroupsRequest method.
+//	req, resp := client.ListSharedReportGroupsRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListSharedReportGroups
+func (c *CodeBuild) ListSharedReportGroupsRequest(input *ListSharedReportGroupsInput) (req *request.Request, output *ListSharedReportGroupsOutput) {
+	op := &request.Operation{
+		Name:       opListSharedReportGroups,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"nextToken"},
+			OutputTokens:    []string{"nextToken"},
+			LimitToken:      "maxResults",
+			TruncationToken: "",
+		},
+	}
+
+	if input == nil {
+		input = &ListSharedReportGroupsInput{}
+	}
+
+	output = &ListSharedReportGroupsOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListSharedReportGroups API operation for AWS CodeBuild.
+//
+// Gets a list of report groups that are shared with other Amazon Web Services
+// accounts or users.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation ListSharedReportGroups for usage and error information.
+//
+// Returned Error Types:
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListSharedReportGroups
+func (c *CodeBuild) ListSharedReportGroups(input *ListSharedReportGroupsInput) (*ListSharedReportGroupsOutput, error) {
+	req, out := c.ListSharedReportGroupsRequest(input)
+	return out, req.Send()
+}
+
+// ListSharedReportGroupsWithContext is the same as ListSharedReportGroups with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListSharedReportGroups for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) ListSharedReportGroupsWithContext(ctx aws.Context, input *ListSharedReportGroupsInput, opts ...request.Option) (*ListSharedReportGroupsOutput, error) {
+	req, out := c.ListSharedReportGroupsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+// ListSharedReportGroupsPages iterates over the pages of a ListSharedReportGroups operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListSharedReportGroups method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListSharedReportGroups operation.
+//	pageNum := 0
+//	err := client.ListSharedReportGroupsPages(params,
+//	    func(page *codebuild.ListSharedReportGroupsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *CodeBuild) ListSharedReportGroupsPages(input *ListSharedReportGroupsInput, fn func(*ListSharedReportGroupsOutput, bool) bool) error {
+	return c.ListSharedReportGroupsPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListSharedReportGroupsPagesWithContext same as ListSharedReportGroupsPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) ListSharedReportGroupsPagesWithContext(ctx aws.Context, input *ListSharedReportGroupsInput, fn func(*ListSharedReportGroupsOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListSharedReportGroupsInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListSharedReportGroupsRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListSharedReportGroupsOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListSourceCredentials = "ListSourceCredentials"
+
+// ListSourceCredentialsRequest generates a "aws/request.Request" representing the
+// client's request for the ListSourceCredentials operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListSourceCredentials for more information on using the ListSourceCredentials
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListSourceCredentialsRequest method.
+//	req, resp := client.ListSourceCredentialsRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListSourceCredentials
+func (c *CodeBuild) ListSourceCredentialsRequest(input *ListSourceCredentialsInput) (req *request.Request, output *ListSourceCredentialsOutput) {
+	op := &request.Operation{
+		Name:       opListSourceCredentials,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &ListSourceCredentialsInput{}
+	}
+
+	output = &ListSourceCredentialsOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListSourceCredentials API operation for AWS CodeBuild.
+//
+// Returns a list of SourceCredentialsInfo objects.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation ListSourceCredentials for usage and error information.
+//
+// Returned Error Types:
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/ListSourceCredentials
+func (c *CodeBuild) ListSourceCredentials(input *ListSourceCredentialsInput) (*ListSourceCredentialsOutput, error) {
+	req, out := c.ListSourceCredentialsRequest(input)
+	return out, req.Send()
+}
+
+// ListSourceCredentialsWithContext is the same as ListSourceCredentials with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListSourceCredentials for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) ListSourceCredentialsWithContext(ctx aws.Context, input *ListSourceCredentialsInput, opts ...request.Option) (*ListSourceCredentialsOutput, error) {
+	req, out := c.ListSourceCredentialsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opPutResourcePolicy = "PutResourcePolicy"
+
+// PutResourcePolicyRequest generates a "aws/request.Request" representing the
+// client's request for the PutResourcePolicy operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See PutResourcePolicy for more information on using the PutResourcePolicy
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the PutResourcePolicyRequest method.
+//	req, resp := client.PutResourcePolicyRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/PutResourcePolicy
+func (c *CodeBuild) PutResourcePolicyRequest(input *PutResourcePolicyInput) (req *request.Request, output *PutResourcePolicyOutput) {
+	op := &request.Operation{
+		Name:       opPutResourcePolicy,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &PutResourcePolicyInput{}
+	}
+
+	output = &PutResourcePolicyOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// PutResourcePolicy API operation for AWS CodeBuild.
+//
+// Stores a resource policy for the ARN of a Project or ReportGroup object.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation PutResourcePolicy for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified Amazon Web Services resource cannot be found.
+//
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/PutResourcePolicy
+func (c *CodeBuild) PutResourcePolicy(input *PutResourcePolicyInput) (*PutResourcePolicyOutput, error) {
+	req, out := c.PutResourcePolicyRequest(input)
+	return out, req.Send()
+}
+
+// PutResourcePolicyWithContext is the same as PutResourcePolicy with the addition of
+// the ability to pass a context and additional request options.
+//
+// See PutResourcePolicy for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) PutResourcePolicyWithContext(ctx aws.Context, input *PutResourcePolicyInput, opts ...request.Option) (*PutResourcePolicyOutput, error) {
+	req, out := c.PutResourcePolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opRetryBuild = "RetryBuild"
+
+// RetryBuildRequest generates a "aws/request.Request" representing the
+// client's request for the RetryBuild operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See RetryBuild for more information on using the RetryBuild
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the RetryBuildRequest method.
+//	req, resp := client.RetryBuildRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/RetryBuild
+func (c *CodeBuild) RetryBuildRequest(input *RetryBuildInput) (req *request.Request, output *RetryBuildOutput) {
+	op := &request.Operation{
+		Name:       opRetryBuild,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &RetryBuildInput{}
+	}
+
+	output = &RetryBuildOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// RetryBuild API operation for AWS CodeBuild.
+//
+// Restarts a build.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation RetryBuild for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+//   - ResourceNotFoundException
+//     The specified Amazon Web Services resource cannot be found.
+//
+//   - AccountLimitExceededException
+//     An Amazon Web Services service limit was exceeded for the calling Amazon
+//     Web Services account.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/RetryBuild
+func (c *CodeBuild) RetryBuild(input *RetryBuildInput) (*RetryBuildOutput, error) {
+	req, out := c.RetryBuildRequest(input)
+	return out, req.Send()
+}
+
+// RetryBuildWithContext is the same as RetryBuild with the addition of
+// the ability to pass a context and additional request options.
+//
+// See RetryBuild for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) RetryBuildWithContext(ctx aws.Context, input *RetryBuildInput, opts ...request.Option) (*RetryBuildOutput, error) {
+	req, out := c.RetryBuildRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opRetryBuildBatch = "RetryBuildBatch"
+
+// RetryBuildBatchRequest generates a "aws/request.Request" representing the
+// client's request for the RetryBuildBatch operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See RetryBuildBatch for more information on using the RetryBuildBatch
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the RetryBuildBatchRequest method.
+//	req, resp := client.RetryBuildBatchRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/RetryBuildBatch
+func (c *CodeBuild) RetryBuildBatchRequest(input *RetryBuildBatchInput) (req *request.Request, output *RetryBuildBatchOutput) {
+	op := &request.Operation{
+		Name:       opRetryBuildBatch,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &RetryBuildBatchInput{}
+	}
+
+	output = &RetryBuildBatchOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// RetryBuildBatch API operation for AWS CodeBuild.
+//
+// Restarts a failed batch build. Only batch builds that have failed can be
+// retried.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation RetryBuildBatch for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+//   - ResourceNotFoundException
+//     The specified Amazon Web Services resource cannot be found.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/RetryBuildBatch
+func (c *CodeBuild) RetryBuildBatch(input *RetryBuildBatchInput) (*RetryBuildBatchOutput, error) {
+	req, out := c.RetryBuildBatchRequest(input)
+	return out, req.Send()
+}
+
+// RetryBuildBatchWithContext is the same as RetryBuildBatch with the addition of
+// the ability to pass a context and additional request options.
+//
+// See RetryBuildBatch for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) RetryBuildBatchWithContext(ctx aws.Context, input *RetryBuildBatchInput, opts ...request.Option) (*RetryBuildBatchOutput, error) {
+	req, out := c.RetryBuildBatchRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opStartBuild = "StartBuild"
+
+// StartBuildRequest generates a "aws/request.Request" representing the
+// client's request for the StartBuild operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See StartBuild for more information on using the StartBuild
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the StartBuildRequest method.
+//	req, resp := client.StartBuildRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/StartBuild
+func (c *CodeBuild) StartBuildRequest(input *StartBuildInput) (req *request.Request, output *StartBuildOutput) {
+	op := &request.Operation{
+		Name:       opStartBuild,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &StartBuildInput{}
+	}
+
+	output = &StartBuildOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// StartBuild API operation for AWS CodeBuild.
+//
+// Starts running a build.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation StartBuild for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+//   - ResourceNotFoundException
+//     The specified Amazon Web Services resource cannot be found.
+//
+//   - AccountLimitExceededException
+//     An Amazon Web Services service limit was exceeded for the calling Amazon
+//     Web Services account.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/StartBuild
+func (c *CodeBuild) StartBuild(input *StartBuildInput) (*StartBuildOutput, error) {
+	req, out := c.StartBuildRequest(input)
+	return out, req.Send()
+}
+
+// StartBuildWithContext is the same as StartBuild with the addition of
+// the ability to pass a context and additional request options.
+//
+// See StartBuild for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) StartBuildWithContext(ctx aws.Context, input *StartBuildInput, opts ...request.Option) (*StartBuildOutput, error) {
+	req, out := c.StartBuildRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opStartBuildBatch = "StartBuildBatch"
+
+// StartBuildBatchRequest generates a "aws/request.Request" representing the
+// client's request for the StartBuildBatch operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See StartBuildBatch for more information on using the StartBuildBatch
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the StartBuildBatchRequest method.
+//	req, resp := client.StartBuildBatchRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/StartBuildBatch
+func (c *CodeBuild) StartBuildBatchRequest(input *StartBuildBatchInput) (req *request.Request, output *StartBuildBatchOutput) {
+	op := &request.Operation{
+		Name:       opStartBuildBatch,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &StartBuildBatchInput{}
+	}
+
+	output = &StartBuildBatchOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// StartBuildBatch API operation for AWS CodeBuild.
+//
+// Starts a batch build for a project.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation StartBuildBatch for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+//   - ResourceNotFoundException
+//     The specified Amazon Web Services resource cannot be found.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/StartBuildBatch
+func (c *CodeBuild) StartBuildBatch(input *StartBuildBatchInput) (*StartBuildBatchOutput, error) {
+	req, out := c.StartBuildBatchRequest(input)
+	return out, req.Send()
+}
+
+// StartBuildBatchWithContext is the same as StartBuildBatch with the addition of
+// the ability to pass a context and additional request options.
+//
+// See StartBuildBatch for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) StartBuildBatchWithContext(ctx aws.Context, input *StartBuildBatchInput, opts ...request.Option) (*StartBuildBatchOutput, error) {
+	req, out := c.StartBuildBatchRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opStopBuild = "StopBuild"
+
+// StopBuildRequest generates a "aws/request.Request" representing the
+// client's request for the StopBuild operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See StopBuild for more information on using the StopBuild
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the StopBuildRequest method.
+//	req, resp := client.StopBuildRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/StopBuild
+func (c *CodeBuild) StopBuildRequest(input *StopBuildInput) (req *request.Request, output *StopBuildOutput) {
+	op := &request.Operation{
+		Name:       opStopBuild,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &StopBuildInput{}
+	}
+
+	output = &StopBuildOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// StopBuild API operation for AWS CodeBuild.
+//
+// Attempts to stop running a build.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation StopBuild for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+//   - ResourceNotFoundException
+//     The specified Amazon Web Services resource cannot be found.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/StopBuild
+func (c *CodeBuild) StopBuild(input *StopBuildInput) (*StopBuildOutput, error) {
+	req, out := c.StopBuildRequest(input)
+	return out, req.Send()
+}
+
+// StopBuildWithContext is the same as StopBuild with the addition of
+// the ability to pass a context and additional request options.
+//
+// See StopBuild for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) StopBuildWithContext(ctx aws.Context, input *StopBuildInput, opts ...request.Option) (*StopBuildOutput, error) {
+	req, out := c.StopBuildRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opStopBuildBatch = "StopBuildBatch"
+
+// StopBuildBatchRequest generates a "aws/request.Request" representing the
+// client's request for the StopBuildBatch operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See StopBuildBatch for more information on using the StopBuildBatch
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the StopBuildBatchRequest method.
+//	req, resp := client.StopBuildBatchRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/StopBuildBatch
+func (c *CodeBuild) StopBuildBatchRequest(input *StopBuildBatchInput) (req *request.Request, output *StopBuildBatchOutput) {
+	op := &request.Operation{
+		Name:       opStopBuildBatch,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &StopBuildBatchInput{}
+	}
+
+	output = &StopBuildBatchOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// StopBuildBatch API operation for AWS CodeBuild.
+//
+// Stops a running batch build.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation StopBuildBatch for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+//   - ResourceNotFoundException
+//     The specified Amazon Web Services resource cannot be found.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/StopBuildBatch
+func (c *CodeBuild) StopBuildBatch(input *StopBuildBatchInput) (*StopBuildBatchOutput, error) {
+	req, out := c.StopBuildBatchRequest(input)
+	return out, req.Send()
+}
+
+// StopBuildBatchWithContext is the same as StopBuildBatch with the addition of
+// the ability to pass a context and additional request options.
+//
+// See StopBuildBatch for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) StopBuildBatchWithContext(ctx aws.Context, input *StopBuildBatchInput, opts ...request.Option) (*StopBuildBatchOutput, error) {
+	req, out := c.StopBuildBatchRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateProject = "UpdateProject"
+
+// UpdateProjectRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateProject operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateProject for more information on using the UpdateProject
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateProjectRequest method.
+//	req, resp := client.UpdateProjectRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/UpdateProject
+func (c *CodeBuild) UpdateProjectRequest(input *UpdateProjectInput) (req *request.Request, output *UpdateProjectOutput) {
+	op := &request.Operation{
+		Name:       opUpdateProject,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UpdateProjectInput{}
+	}
+
+	output = &UpdateProjectOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UpdateProject API operation for AWS CodeBuild.
+//
+// Changes the settings of a build project.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation UpdateProject for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+//   - ResourceNotFoundException
+//     The specified Amazon Web Services resource cannot be found.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/UpdateProject
+func (c *CodeBuild) UpdateProject(input *UpdateProjectInput) (*UpdateProjectOutput, error) {
+	req, out := c.UpdateProjectRequest(input)
+	return out, req.Send()
+}
+
+// UpdateProjectWithContext is the same as UpdateProject with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateProject for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) UpdateProjectWithContext(ctx aws.Context, input *UpdateProjectInput, opts ...request.Option) (*UpdateProjectOutput, error) {
+	req, out := c.UpdateProjectRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateProjectVisibility = "UpdateProjectVisibility"
+
+// UpdateProjectVisibilityRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateProjectVisibility operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateProjectVisibility for more information on using the UpdateProjectVisibility
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateProjectVisibilityRequest method.
+//	req, resp := client.UpdateProjectVisibilityRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/UpdateProjectVisibility
+func (c *CodeBuild) UpdateProjectVisibilityRequest(input *UpdateProjectVisibilityInput) (req *request.Request, output *UpdateProjectVisibilityOutput) {
+	op := &request.Operation{
+		Name:       opUpdateProjectVisibility,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UpdateProjectVisibilityInput{}
+	}
+
+	output = &UpdateProjectVisibilityOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UpdateProjectVisibility API operation for AWS CodeBuild.
+//
+// Changes the public visibility for a project. The project's build results,
+// logs, and artifacts are available to the general public. For more information,
+// see Public build projects (https://docs.aws.amazon.com/codebuild/latest/userguide/public-builds.html)
+// in the CodeBuild User Guide.
+//
+// The following should be kept in mind when making your projects public:
+//
+//   - All of a project's build results, logs, and artifacts, including builds
+//     that were run when the project was private, are available to the general
+//     public.
+//
+//   - All build logs and artifacts are available to the public. Environment
+//     variables, source code, and other sensitive information may have been
+//     output to the build logs and artifacts. You must be careful about what
+//     information is output to the build logs. Some best practice are: Do not
+//     store sensitive values, especially Amazon Web Services access key IDs
+//     and secret access keys, in environment variables. We recommend that you
+//     use an Amazon EC2 Systems Manager Parameter Store or Secrets Manager to
+//     store sensitive values. Follow Best practices for using webhooks (https://docs.aws.amazon.com/codebuild/latest/userguide/webhooks.html#webhook-best-practices)
+//     in the CodeBuild User Guide to limit which entities can trigger a build,
+//     and do not store the buildspec in the project itself, to ensure that your
+//     webhooks are as secure as possible.
+//
+//   - A malicious user can use public builds to distribute malicious artifacts.
+//     We recommend that you review all pull requests to verify that the pull
+//     request is a legitimate change. We also recommend that you validate any
+//     artifacts with their checksums to make sure that the correct artifacts
+//     are being downloaded.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation UpdateProjectVisibility for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+//   - ResourceNotFoundException
+//     The specified Amazon Web Services resource cannot be found.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/UpdateProjectVisibility
+func (c *CodeBuild) UpdateProjectVisibility(input *UpdateProjectVisibilityInput) (*UpdateProjectVisibilityOutput, error) {
+	req, out := c.UpdateProjectVisibilityRequest(input)
+	return out, req.Send()
+}
+
+// UpdateProjectVisibilityWithContext is the same as UpdateProjectVisibility with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateProjectVisibility for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) UpdateProjectVisibilityWithContext(ctx aws.Context, input *UpdateProjectVisibilityInput, opts ...request.Option) (*UpdateProjectVisibilityOutput, error) {
+	req, out := c.UpdateProjectVisibilityRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateReportGroup = "UpdateReportGroup"
+
+// UpdateReportGroupRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateReportGroup operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateReportGroup for more information on using the UpdateReportGroup
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateReportGroupRequest method.
+//	req, resp := client.UpdateReportGroupRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/UpdateReportGroup
+func (c *CodeBuild) UpdateReportGroupRequest(input *UpdateReportGroupInput) (req *request.Request, output *UpdateReportGroupOutput) {
+	op := &request.Operation{
+		Name:       opUpdateReportGroup,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UpdateReportGroupInput{}
+	}
+
+	output = &UpdateReportGroupOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UpdateReportGroup API operation for AWS CodeBuild.
+//
+// Updates a report group.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation UpdateReportGroup for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+//   - ResourceNotFoundException
+//     The specified Amazon Web Services resource cannot be found.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/UpdateReportGroup
+func (c *CodeBuild) UpdateReportGroup(input *UpdateReportGroupInput) (*UpdateReportGroupOutput, error) {
+	req, out := c.UpdateReportGroupRequest(input)
+	return out, req.Send()
+}
+
+// UpdateReportGroupWithContext is the same as UpdateReportGroup with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateReportGroup for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) UpdateReportGroupWithContext(ctx aws.Context, input *UpdateReportGroupInput, opts ...request.Option) (*UpdateReportGroupOutput, error) {
+	req, out := c.UpdateReportGroupRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateWebhook = "UpdateWebhook"
+
+// UpdateWebhookRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateWebhook operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateWebhook for more information on using the UpdateWebhook
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateWebhookRequest method.
+//	req, resp := client.UpdateWebhookRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/UpdateWebhook
+func (c *CodeBuild) UpdateWebhookRequest(input *UpdateWebhookInput) (req *request.Request, output *UpdateWebhookOutput) {
+	op := &request.Operation{
+		Name:       opUpdateWebhook,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UpdateWebhookInput{}
+	}
+
+	output = &UpdateWebhookOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UpdateWebhook API operation for AWS CodeBuild.
+//
+// Updates the webhook associated with an CodeBuild build project.
+//
+// If you use Bitbucket for your repository, rotateSecret is ignored.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodeBuild's
+// API operation UpdateWebhook for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidInputException
+//     The input value that was provided is not valid.
+//
+//   - ResourceNotFoundException
+//     The specified Amazon Web Services resource cannot be found.
+//
+//   - OAuthProviderException
+//     There was a problem with the underlying OAuth provider.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codebuild-2016-10-06/UpdateWebhook
+func (c *CodeBuild) UpdateWebhook(input *UpdateWebhookInput) (*UpdateWebhookOutput, error) {
+	req, out := c.UpdateWebhookRequest(input)
+	return out, req.Send()
+}
+
+// UpdateWebhookWithContext is the same as UpdateWebhook with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateWebhook for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodeBuild) UpdateWebhookWithContext(ctx aws.Context, input *UpdateWebhookInput, opts ...request.Option) (*UpdateWebhookOutput, error) {
+	req, out := c.UpdateWebhookRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+// An Amazon Web Services service limit was exceeded for the calling Amazon
+// Web Services account.
+type AccountLimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AccountLimitExceededException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AccountLimitExceededException) GoString() string {
+	return s.String()
+}
+
+func newErrorAccountLimitExceededException(v protocol.ResponseMetadata) error {
+	return &AccountLimitExceededException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *AccountLimitExceededException) Code() string {
+	return "AccountLimitExceededException"
+}
+
+// Message returns the exception's message.
+func (s *AccountLimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *AccountLimitExceededException) OrigErr() error {
+	return nil
+}
+
+func (s *AccountLimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *AccountLimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *AccountLimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type BatchDeleteBuildsInput struct {
+	_ struct{} `type:"structure"`
+
+	// The IDs of the builds to delete.
+	//
+	// Ids is a required field
+	Ids []*string `locationName:"ids" min:"1" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchDeleteBuildsInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchDeleteBuildsInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *BatchDeleteBuildsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchDeleteBuildsInput"}
+	if s.Ids == nil {
+		invalidParams.Add(request.NewErrParamRequired("Ids"))
+	}
+	if s.Ids != nil && len(s.Ids) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Ids", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetIds sets the Ids field's value.
+func (s *BatchDeleteBuildsInput) SetIds(v []*string) *BatchDeleteBuildsInput {
+	s.Ids = v
+	return s
+}
+
+type BatchDeleteBuildsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The IDs of the builds that were successfully deleted.
+	BuildsDeleted []*string `locationName:"buildsDeleted" min:"1" type:"list"`
+
+	// Information about any builds that could not be successfully deleted.
+	BuildsNotDeleted []*BuildNotDeleted `locationName:"buildsNotDeleted" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchDeleteBuildsOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchDeleteBuildsOutput) GoString() string {
+	return s.String()
+}
+
+// SetBuildsDeleted sets the BuildsDeleted field's value.
+func (s *BatchDeleteBuildsOutput) SetBuildsDeleted(v []*string) *BatchDeleteBuildsOutput {
+	s.BuildsDeleted = v
+	return s
+}
+
+// SetBuildsNotDeleted sets the BuildsNotDeleted field's value.
+func (s *BatchDeleteBuildsOutput) SetBuildsNotDeleted(v []*BuildNotDeleted) *BatchDeleteBuildsOutput {
+	s.BuildsNotDeleted = v
+	return s
+}
+
+type BatchGetBuildBatchesInput struct {
+	_ struct{} `type:"structure"`
+
+	// An array that contains the batch build identifiers to retrieve.
+	//
+	// Ids is a required field
+	Ids []*string `locationName:"ids" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetBuildBatchesInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetBuildBatchesInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *BatchGetBuildBatchesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchGetBuildBatchesInput"}
+	if s.Ids == nil {
+		invalidParams.Add(request.NewErrParamRequired("Ids"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetIds sets the Ids field's value.
+func (s *BatchGetBuildBatchesInput) SetIds(v []*string) *BatchGetBuildBatchesInput {
+	s.Ids = v
+	return s
+}
+
+type BatchGetBuildBatchesOutput struct {
+	_ struct{} `type:"structure"`
+
+	// An array of BuildBatch objects that represent the retrieved batch builds.
+	BuildBatches []*BuildBatch `locationName:"buildBatches" type:"list"`
+
+	// An array that contains the identifiers of any batch builds that are not found.
+	BuildBatchesNotFound []*string `locationName:"buildBatchesNotFound" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetBuildBatchesOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetBuildBatchesOutput) GoString() string {
+	return s.String()
+}
+
+// SetBuildBatches sets the BuildBatches field's value.
+func (s *BatchGetBuildBatchesOutput) SetBuildBatches(v []*BuildBatch) *BatchGetBuildBatchesOutput {
+	s.BuildBatches = v
+	return s
+}
+
+// SetBuildBatchesNotFound sets the BuildBatchesNotFound field's value.
+func (s *BatchGetBuildBatchesOutput) SetBuildBatchesNotFound(v []*string) *BatchGetBuildBatchesOutput {
+	s.BuildBatchesNotFound = v
+	return s
+}
+
+type BatchGetBuildsInput struct {
+	_ struct{} `type:"structure"`
+
+	// The IDs of the builds.
+	//
+	// Ids is a required field
+	Ids []*string `locationName:"ids" min:"1" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetBuildsInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetBuildsInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *BatchGetBuildsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchGetBuildsInput"}
+	if s.Ids == nil {
+		invalidParams.Add(request.NewErrParamRequired("Ids"))
+	}
+	if s.Ids != nil && len(s.Ids) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Ids", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetIds sets the Ids field's value.
+func (s *BatchGetBuildsInput) SetIds(v []*string) *BatchGetBuildsInput {
+	s.Ids = v
+	return s
+}
+
+type BatchGetBuildsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Information about the requested builds.
+	Builds []*Build `locationName:"builds" type:"list"`
+
+	// The IDs of builds for which information could not be found.
+	BuildsNotFound []*string `locationName:"buildsNotFound" min:"1" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetBuildsOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetBuildsOutput) GoString() string {
+	return s.String()
+}
+
+// SetBuilds sets the Builds field's value.
+func (s *BatchGetBuildsOutput) SetBuilds(v []*Build) *BatchGetBuildsOutput {
+	s.Builds = v
+	return s
+}
+
+// SetBuildsNotFound sets the BuildsNotFound field's value.
+func (s *BatchGetBuildsOutput) SetBuildsNotFound(v []*string) *BatchGetBuildsOutput {
+	s.BuildsNotFound = v
+	return s
+}
+
+type BatchGetProjectsInput struct {
+	_ struct{} `type:"structure"`
+
+	// The names or ARNs of the build projects. To get information about a project
+	// shared with your Amazon Web Services account, its ARN must be specified.
+	// You cannot specify a shared project using its name.
+	//
+	// Names is a required field
+	Names []*string `locationName:"names" min:"1" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetProjectsInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetProjectsInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *BatchGetProjectsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchGetProjectsInput"}
+	if s.Names == nil {
+		invalidParams.Add(request.NewErrParamRequired("Names"))
+	}
+	if s.Names != nil && len(s.Names) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Names", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetNames sets the Names field's value.
+func (s *BatchGetProjectsInput) SetNames(v []*string) *BatchGetProjectsInput {
+	s.Names = v
+	return s
+}
+
+type BatchGetProjectsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Information about the requested build projects.
+	Projects []*Project `locationName:"projects" type:"list"`
+
+	// The names of build projects for which information could not be found.
+	ProjectsNotFound []*string `locationName:"projectsNotFound" min:"1" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetProjectsOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetProjectsOutput) GoString() string {
+	return s.String()
+}
+
+// SetProjects sets the Projects field's value.
+func (s *BatchGetProjectsOutput) SetProjects(v []*Project) *BatchGetProjectsOutput {
+	s.Projects = v
+	return s
+}
+
+// SetProjectsNotFound sets the ProjectsNotFound field's value.
+func (s *BatchGetProjectsOutput) SetProjectsNotFound(v []*string) *BatchGetProjectsOutput {
+	s.ProjectsNotFound = v
+	return s
+}
+
+type BatchGetReportGroupsInput struct {
+	_ struct{} `type:"structure"`
+
+	// An array of report group ARNs that identify the report groups to return.
+	//
+	// ReportGroupArns is a required field
+	ReportGroupArns []*string `locationName:"reportGroupArns" min:"1" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetReportGroupsInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetReportGroupsInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *BatchGetReportGroupsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchGetReportGroupsInput"}
+	if s.ReportGroupArns == nil {
+		invalidParams.Add(request.NewErrParamRequired("ReportGroupArns"))
+	}
+	if s.ReportGroupArns != nil && len(s.ReportGroupArns) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ReportGroupArns", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetReportGroupArns sets the ReportGroupArns field's value.
+func (s *BatchGetReportGroupsInput) SetReportGroupArns(v []*string) *BatchGetReportGroupsInput {
+	s.ReportGroupArns = v
+	return s
+}
+
+type BatchGetReportGroupsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The array of report groups returned by BatchGetReportGroups.
+	ReportGroups []*ReportGroup `locationName:"reportGroups" min:"1" type:"list"`
+
+	// An array of ARNs passed to BatchGetReportGroups that are not associated with
+	// a ReportGroup.
+	ReportGroupsNotFound []*string `locationName:"reportGroupsNotFound" min:"1" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetReportGroupsOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetReportGroupsOutput) GoString() string {
+	return s.String()
+}
+
+// SetReportGroups sets the ReportGroups field's value.
+func (s *BatchGetReportGroupsOutput) SetReportGroups(v []*ReportGroup) *BatchGetReportGroupsOutput {
+	s.ReportGroups = v
+	return s
+}
+
+// SetReportGroupsNotFound sets the ReportGroupsNotFound field's value.
+func (s *BatchGetReportGroupsOutput) SetReportGroupsNotFound(v []*string) *BatchGetReportGroupsOutput {
+	s.ReportGroupsNotFound = v
+	return s
+}
+
+type BatchGetReportsInput struct {
+	_ struct{} `type:"structure"`
+
+	// An array of ARNs that identify the Report objects to return.
+	//
+	// ReportArns is a required field
+	ReportArns []*string `locationName:"reportArns" min:"1" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetReportsInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetReportsInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *BatchGetReportsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchGetReportsInput"}
+	if s.ReportArns == nil {
+		invalidParams.Add(request.NewErrParamRequired("ReportArns"))
+	}
+	if s.ReportArns != nil && len(s.ReportArns) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ReportArns", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetReportArns sets the ReportArns field's value.
+func (s *BatchGetReportsInput) SetReportArns(v []*string) *BatchGetReportsInput {
+	s.ReportArns = v
+	return s
+}
+
+type BatchGetReportsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The array of Report objects returned by BatchGetReports.
+	Reports []*Report `locationName:"reports" min:"1" type:"list"`
+
+	// An array of ARNs passed to BatchGetReportGroups that are not associated with
+	// a Report.
+	ReportsNotFound []*string `locationName:"reportsNotFound" min:"1" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetReportsOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetReportsOutput) GoString() string {
+	return s.String()
+}
+
+// SetReports sets the Reports field's value.
+func (s *BatchGetReportsOutput) SetReports(v []*Report) *BatchGetReportsOutput {
+	s.Reports = v
+	return s
+}
+
+// SetReportsNotFound sets the ReportsNotFound field's value.
+func (s *BatchGetReportsOutput) SetReportsNotFound(v []*string) *BatchGetReportsOutput {
+	s.ReportsNotFound = v
+	return s
+}
+
+// Specifies restrictions for the batch build.
+type BatchRestrictions struct {
+	_ struct{} `type:"structure"`
+
+	// An array of strings that specify the compute types that are allowed for the
+	// batch build. See Build environment compute types (https://docs.aws.amazon.com/codebuild/latest/userguide/build-env-ref-compute-types.html)
+	// in the CodeBuild User Guide for these values.
+	ComputeTypesAllowed []*string `locationName:"computeTypesAllowed" type:"list"`
+
+	// Specifies the maximum number of builds allowed.
+	MaximumBuildsAllowed *int64 `locationName:"maximumBuildsAllowed" type:"integer"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchRestrictions) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchRestrictions) GoString() string {
+	return s.String()
+}
+
+// SetComputeTypesAllowed sets the ComputeTypesAllowed field's value.
+func (s *BatchRestrictions) SetComputeTypesAllowed(v []*string) *BatchRestrictions {
+	s.ComputeTypesAllowed = v
+	return s
+}
+
+// SetMaximumBuildsAllowed sets the MaximumBuildsAllowed field's value.
+func (s *BatchRestrictions) SetMaximumBuildsAllowed(v int64) *BatchRestrictions {
+	s.MaximumBuildsAllowed = &v
+	return s
+}
+
+// Information about a build.
+type Build struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the build.
+	Arn *string `locationName:"arn" min:"1" type:"string"`
+
+	// Information about the output artifacts for the build.
+	Artifacts *BuildArtifacts `locationName:"artifacts" type:"structure"`
+
+	// The ARN of the batch build that this build is a member of, if applicable.
+	BuildBatchArn *string `locationName:"buildBatchArn" type:"string"`
+
+	// Whether the build is complete. True if complete; otherwise, false.
+	BuildComplete *bool `locationName:"buildComplete" type:"boolean"`
+
+	// The number of the build. For each project, the buildNumber of its first build
+	// is 1. The buildNumber of each subsequent build is incremented by 1. If a
+	// build is deleted, the buildNumber of other builds does not change.
+	BuildNumber *int64 `locationName:"buildNumber" type:"long"`
+
+	// The current status of the build. Valid values include:
+	//
+	//    * FAILED: The build failed.
+	//
+	//    * FAULT: The build faulted.
+	//
+	//    * IN_PROGRESS: The build is still in progress.
+	//
+	//    * STOPPED: The build stopped.
+	//
+	//    * SUCCEEDED: The build succeeded.
+	//
+	//    * TIMED_OUT: The build timed out.
+	BuildStatus *string `locationName:"buildStatus" type:"string" enum:"StatusType"`
+
+	// Information about the cache for the build.
+	Cache *ProjectCache `locationName:"cache" type:"structure"`
+
+	// The current build phase.
+	CurrentPhase *string `locationName:"currentPhase" type:"string"`
+
+	// Contains information about the debug session for this build.
+	DebugSession *DebugSession `locationName:"debugSession" type:"structure"`
+
+	// The Key Management Service customer master key (CMK) to be used for encrypting
+	// the build output artifacts.
+	//
+	// You can use a cross-account KMS key to encrypt the build output artifacts
+	// if your service role has permission to that key.
+	//
+	// You can specify either the Amazon Resource Name (ARN) of the CMK or, if available,
+	// the CMK's alias (using the format alias/<alias-name>).
+	EncryptionKey *string `locationName:"encryptionKey" min:"1" type:"string"`
+
+	// When the build process ended, expressed in Unix time format.
+	EndTime *time.Time `locationName:"endTime" type:"timestamp"`
+
+	// Information about the build environment for this build.
+	Environment *ProjectEnvironment `locationName:"environment" type:"structure"`
+
+	// A list of exported environment variables for this build.
+	//
+	// Exported environment variables are used in conjunction with CodePipeline
+	// to export environment variables from the current build stage to subsequent
+	// stages in the pipeline. For more information, see Working with variables
+	// (https://docs.aws.amazon.com/codepipeline/latest/userguide/actions-variables.html)
+	// in the CodePipeline User Guide.
+	ExportedEnvironmentVariables []*ExportedEnvironmentVariable `locationName:"exportedEnvironmentVariables" type:"list"`
+
+	// An array of ProjectFileSystemLocation objects for a CodeBuild build project.
+	// A ProjectFileSystemLocation object specifies the identifier, location, mountOptions,
+	// mountPoint, and type of a file system created using Amazon Elastic File System.
+	FileSystemLocations []*ProjectFileSystemLocation `locationName:"fileSystemLocations" type:"list"`
+
+	// The unique ID for the build.
+	Id *string `locationName:"id" min:"1" type:"string"`
+
+	// The entity that started the build. Valid values include:
+	//
+	//    * If CodePipeline started the build, the pipeline's name (for example,
+	//    codepipeline/my-demo-pipeline).
+	//
+	//    * If an IAM user started the build, the user's name (for example, MyUserName).
+	//
+	//    * If the Jenkins plugin for CodeBuild started the build, the string CodeBuild-Jenkins-Plugin.
+	Initiator *string `locationName:"initiator" type:"string"`
+
+	// Information about the build's logs in CloudWatch Logs.
+	Logs *LogsLocation `locationName:"logs" type:"structure"`
+
+	// Describes a network interface.
+	NetworkInterface *NetworkInterface `locationName:"networkInterface" type:"structure"`
+
+	// Information about all previous build phases that are complete and information
+	// about any current build phase that is not yet complete.
+	Phases []*BuildPhase `locationName:"phases" type:"list"`
+
+	// The name of the CodeBuild project.
+	ProjectName *string `locationName:"projectName" min:"1" type:"string"`
+
+	// The number of minutes a build is allowed to be queued before it times out.
+	QueuedTimeoutInMinutes *int64 `locationName:"queuedTimeoutInMinutes" type:"integer"`
+
+	// An array of the ARNs associated with this build's reports.
+	ReportArns []*string `locationName:"reportArns" type:"list"`
+
+	// An identifier for the version of this build's source code.
+	//
+	//    * For CodeCommit, GitHub, GitHub Enterprise, and BitBucket, the commit
+	//    ID.
+	//
+	//    * For CodePipeline, the source revision provided by CodePipeline.
+	//
+	//    * For Amazon S3, this does not apply.
+	ResolvedSourceVersion *string `locationName:"resolvedSourceVersion" min:"1" type:"string"`
+
+	// An array of ProjectArtifacts objects.
+	SecondaryArtifacts []*BuildArtifacts `locationName:"secondaryArtifacts" type:"list"`
+
+	// An array of ProjectSourceVersion objects. Each ProjectSourceVersion must
+	// be one of:
+	//
+	//    * For CodeCommit: the commit ID, branch, or Git tag to use.
+	//
+	//    * For GitHub: the commit ID, pull request ID, branch name, or tag name
+	//    that corresponds to the version of the source code you want to build.
+	//    If a pull request ID is specified, it must use the format pr/pull-request-ID
+	//    (for example, pr/25). If a branch name is specified, the branch's HEAD
+	//    commit ID is used. If not specified, the default branch's HEAD commit
+	//    ID is used.
+	//
+	//    * For Bitbucket: the commit ID, branch name, or tag name that corresponds
+	//    to the version of the source code you want to build. If a branch name
+	//    is specified, the branch's HEAD commit ID is used. If not specified, the
+	//    default branch's HEAD commit ID is used.
+	//
+	//    * For Amazon S3: the version ID of the object that represents the build
+	//    input ZIP file to use.
+	SecondarySourceVersions []*ProjectSourceVersion `locationName:"secondarySourceVersions" type:"list"`
+
+	// An array of ProjectSource objects.
+	SecondarySources []*ProjectSource `locationName:"secondarySources" type:"list"`
+
+	// The name of a service role used for this build.
+	ServiceRole *string `locationName:"serviceRole" min:"1" type:"string"`
+
+	// Information about the source code to be built.
+	Source *ProjectSource `locationName:"source" type:"structure"`
+
+	// Any version identifier for the version of the source code to be built. If
+	// sourceVersion is specified at the project level, then this sourceVersion
+	// (at the build level) takes precedence.
+	//
+	// For more information, see Source Version Sample with CodeBuild (https://docs.aws.amazon.com/codebuild/latest/userguide/sample-source-version.html)
+	// in the CodeBuild User Guide.
+	SourceVersion *string `locationName:"sourceVersion" min:"1" type:"string"`
+
+	// When the build process started, expressed in Unix time format.
+	StartTime *time.Time `locationName:"startTime" type:"timestamp"`
+
+	// How long, in minutes, for CodeBuild to wait before timing out this build
+	// if it does not get marked as completed.
+	TimeoutInMinutes *int64 `locationName:"timeoutInMinutes" type:"integer"`
+
+	// If your CodeBuild project accesses resources in an Amazon VPC, you provide
+	// this parameter that identifies the VPC ID and the list of security group
+	// IDs and subnet IDs. The security groups and subnets must belong to the same
+	// VPC. You must provide at least one security group and one subnet ID.
+	VpcConfig *VpcConfig `locationName:"vpcConfig" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Build) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Build) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *Build) SetArn(v string) *Build {
+	s.Arn = &v
+	return s
+}
+
+// SetArtifacts sets the Artifacts field's value.
+func (s *Build) SetArtifacts(v *BuildArtifacts) *Build {
+	s.Artifacts = v
+	return s
+}
+
+// SetBuildBatchArn sets the BuildBatchArn field's value.
+func (s *Build) SetBuildBatchArn(v string) *Build {
+	s.BuildBatchArn = &v
+	return s
+}
+
+// SetBuildComplete sets the BuildComplete field's value.
+func (s *Build) SetBuildComplete(v bool) *Build {
+	s.BuildComplete = &v
+	return s
+}
+
+// SetBuildNumber sets the BuildNumber field's value.
+func (s *Build) SetBuildNumber(v int64) *Build {
+	s.BuildNumber = &v
+	return s
+}
+
+// SetBuildStatus sets the BuildStatus field's value.
+func (s *Build) SetBuildStatus(v string) *Build {
+	s.BuildStatus = &v
+	return s
+}
+
+// SetCache sets the Cache field's value.
+func (s *Build) SetCache(v *ProjectCache) *Build {
+	s.Cache = v
+	return s
+}
+
+// SetCurrentPhase sets the CurrentPhase field's value.
+func (s *Build) SetCurrentPhase(v string) *Build {
+	s.CurrentPhase = &v
+	return s
+}
+
+// SetDebugSession sets the DebugSession field's value.
+func (s *Build) SetDebugSession(v *DebugSession) *Build {
+	s.DebugSession = v
+	return s
+}
+
+// SetEncryptionKey sets the EncryptionKey field's value.
+func (s *Build) SetEncryptionKey(v string) *Build {
+	s.EncryptionKey = &v
+	return s
+}
+
+// SetEndTime sets the EndTime field's value.
+func (s *Build) SetEndTime(v time.Time) *Build {
+	s.EndTime = &v
+	return s
+}
+
+// SetEnvironment sets the Environment field's value.
+func (s *Build) SetEnvironment(v *ProjectEnvironment) *Build {
+	s.Environment = v
+	return s
+}
+
+// SetExportedEnvironmentVariables sets the ExportedEnvironmentVariables field's value.
+func (s *Build) SetExportedEnvironmentVariables(v []*ExportedEnvironmentVariable) *Build {
+	s.ExportedEnvironmentVariables = v
+	return s
+}
+
+// SetFileSystemLocations sets the FileSystemLocations field's value.
+func (s *Build) SetFileSystemLocations(v []*ProjectFileSystemLocation) *Build {
+	s.FileSystemLocations = v
+	return s
+}
+
+// SetId sets the Id field's value.
+func (s *Build) SetId(v string) *Build {
+	s.Id = &v
+	return s
+}
+
+// SetInitiator sets the Initiator field's value.
+func (s *Build) SetInitiator(v string) *Build {
+	s.Initiator = &v
+	return s
+}
+
+// SetLogs sets the Logs field's value.
+func (s *Build) SetLogs(v *LogsLocation) *Build {
+	s.Logs = v
+	return s
+}
+
+// SetNetworkInterface sets the NetworkInterface field's value.
+func (s *Build) SetNetworkInterface(v *NetworkInterface) *Build {
+	s.NetworkInterface = v
+	return s
+}
+
+// SetPhases sets the Phases field's value.
+func (s *Build) SetPhases(v []*BuildPhase) *Build {
+	s.Phases = v
+	return s
+}
+
+// SetProjectName sets the ProjectName field's value.
+func (s *Build) SetProjectName(v string) *Build {
+	s.ProjectName = &v
+	return s
+}
+
+// SetQueuedTimeoutInMinutes sets the QueuedTimeoutInMinutes field's value.
+func (s *Build) SetQueuedTimeoutInMinutes(v int64) *Build {
+	s.QueuedTimeoutInMinutes = &v
+	return s
+}
+
+// SetReportArns sets the ReportArns field's value.
+func (s *Build) SetReportArns(v []*string) *Build {
+	s.ReportArns = v
+	return s
+}
+
+// SetResolvedSourceVersion sets the ResolvedSourceVersion field's value.
+func (s *Build) SetResolvedSourceVersion(v string) *Build {
+	s.ResolvedSourceVersion = &v
+	return s
+}
+
+// SetSecondaryArtifacts sets the SecondaryArtifacts field's value.
+func (s *Build) SetSecondaryArtifacts(v []*BuildArtifacts) *Build {
+	s.SecondaryArtifacts = v
+	return s
+}
+
+// SetSecondarySourceVersions sets the SecondarySourceVersions field's value.
+func (s *Build) SetSecondarySourceVersions(v []*ProjectSourceVersion) *Build {
+	s.SecondarySourceVersions = v
+	return s
+}
+
+// SetSecondarySources sets the SecondarySources field's value.
+func (s *Build) SetSecondarySources(v []*ProjectSource) *Build {
+	s.SecondarySources = v
+	return s
+}
+
+// SetServiceRole sets the ServiceRole field's value.
+func (s *Build) SetServiceRole(v string) *Build {
+	s.ServiceRole = &v
+	return s
+}
+
+// SetSource sets the Source field's value.
+func (s *Build) SetSource(v *ProjectSource) *Build {
+	s.Source = v
+	return s
+}
+
+// SetSourceVersion sets the SourceVersion field's value.
+func (s *Build) SetSourceVersion(v string) *Build {
+	s.SourceVersion = &v
+	return s
+}
+
+// SetStartTime sets the StartTime field's value.
+func (s *Build) SetStartTime(v time.Time) *Build {
+	s.StartTime = &v
+	return s
+}
+
+// SetTimeoutInMinutes sets the TimeoutInMinutes field's value.
+func (s *Build) SetTimeoutInMinutes(v int64) *Build {
+	s.TimeoutInMinutes = &v
+	return s
+}
+
+// SetVpcConfig sets the VpcConfig field's value.
+func (s *Build) SetVpcConfig(v *VpcConfig) *Build {
+	s.VpcConfig = v
+	return s
+}
+
+// Information about build output artifacts.
+type BuildArtifacts struct {
+	_ struct{} `type:"structure"`
+
+	// An identifier for this artifact definition.
+	ArtifactIdentifier *string `locationName:"artifactIdentifier" type:"string"`
+
+	// Specifies the bucket owner's access for objects that another account uploads
+	// to their Amazon S3 bucket. By default, only the account that uploads the
+	// objects to the bucket has access to these objects. This property allows you
+	// to give the bucket owner access to these objects.
+	//
+	// To use this property, your CodeBuild service role must have the s3:PutBucketAcl
+	// permission. This permission allows CodeBuild to modify the access control
+	// list for the bucket.
+	//
+	// This property can be one of the following values:
+	//
+	// NONE
+	//
+	// The bucket owner does not have access to the objects. This is the default.
+	//
+	// READ_ONLY
+	//
+	// The bucket owner has read-only access to the objects. The uploading account
+	// retains ownership of the objects.
+	//
+	// FULL
+	//
+	// The bucket owner has full access to the objects. Object ownership is determined
+	// by the following criteria:
+	//
+	//    * If the bucket is configured with the Bucket owner preferred setting,
+	//    the bucket owner owns the objects. The uploading account will have object
+	//    access as specified by the bucket's policy.
+	//
+	//    * Otherwise, the uploading account retains ownership of the objects.
+	//
+	// For more information about Amazon S3 object ownership, see Controlling ownership
+	// of uploaded objects using S3 Object Ownership (https://docs.aws.amazon.com/AmazonS3/latest/userguide/about-object-ownership.html)
+	// in the Amazon Simple Storage Service User Guide.
+	BucketOwnerAccess *string `locationName:"bucketOwnerAccess" type:"string" enum:"BucketOwnerAccess"`
+
+	// Information that tells you if encryption for build artifacts is disabled.
+	EncryptionDisabled *bool `locationName:"encryptionDisabled" type:"boolean"`
+
+	// Information about the location of the build artifacts.
+	Location *string `locationName:"location" type:"string"`
+
+	// The MD5 hash of the build artifact.
+	//
+	// You can use this hash along with a checksum tool to confirm file integrity
+	// and authenticity.
+	//
+	// This value is available only if the build project's packaging value is set
+	// to ZIP.
+	Md5sum *string `locationName:"md5sum" type:"string"`
+
+	// If this flag is set, a name specified in the buildspec file overrides the
+	// artifact name. The name specified in a buildspec file is calculated at build
+	// time and uses the Shell Command Language. For example, you can append a date
+	// and time to your artifact name so that it is always unique.
+	OverrideArtifactName *bool `locationName:"overrideArtifactName" type:"boolean"`
+
+	// The SHA-256 hash of the build artifact.
+	//
+	// You can use this hash along with a checksum tool to confirm file integrity
+	// and authenticity.
+	//
+	// This value is available only if the build project's packaging value is set
+	// to ZIP.
+	Sha256sum *string `locationName:"sha256sum" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BuildArtifacts) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BuildArtifacts) GoString() string {
+	return s.String()
+}
+
+// SetArtifactIdentifier sets the ArtifactIdentifier field's value.
+func (s *BuildArtifacts) SetArtifactIdentifier(v string) *BuildArtifacts {
+	s.ArtifactIdentifier = &v
+	return s
+}
+
+// SetBucketOwnerAccess sets the BucketOwnerAccess field's value.
+func (s *BuildArtifacts) SetBucketOwnerAccess(v string) *BuildArtifacts {
+	s.BucketOwnerAccess = &v
+	return s
+}
+
+// SetEncryptionDisabled sets the EncryptionDisabled field's value.
+func (s *BuildArtifacts) SetEncryptionDisabled(v bool) *BuildArtifacts {
+	s.EncryptionDisabled = &v
+	return s
+}
+
+// SetLocation sets the Location field's value.
+func (s *BuildArtifacts) SetLocation(v string) *BuildArtifacts {
+	s.Location = &v
+	return s
+}
+
+// SetMd5sum sets the Md5sum field's value.
+func (s *BuildArtifacts) SetMd5sum(v string) *BuildArtifacts {
+	s.Md5sum = &v
+	return s
+}
+
+// SetOverrideArtifactName sets the OverrideArtifactName field's value.
+func (s *BuildArtifacts) SetOverrideArtifactName(v bool) *BuildArtifacts {
+	s.OverrideArtifactName = &v
+	return s
+}
+
+// SetSha256sum sets the Sha256sum field's value.
+func (s *BuildArtifacts) SetSha256sum(v string) *BuildArtifacts {
+	s.Sha256sum = &v
+	return s
+}
+
+// Contains information about a batch build.
+type BuildBatch struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the batch build.
+	Arn *string `locationName:"arn" min:"1" type:"string"`
+
+	// A BuildArtifacts object the defines the build artifacts for this batch build.
+	Artifacts *BuildArtifacts `locationName:"artifacts" type:"structure"`
+
+	// Contains configuration information about a batch build project.
+	BuildBatchConfig *ProjectBuildBatchConfig `locationName:"buildBatchConfig" type:"structure"`
+
+	// The number of the batch build. For each project, the buildBatchNumber of
+	// its first batch build is 1. The buildBatchNumber of each subsequent batch
+	// build is incremented by 1. If a batch build is deleted, the buildBatchNumber
+	// of other batch builds does not change.
+	BuildBatchNumber *int64 `locationName:"buildBatchNumber" type:"long"`
+
+	// The status of the batch build.
+	BuildBatchStatus *string `locationName:"buildBatchStatus" type:"string" enum:"StatusType"`
+
+	// An array of BuildGroup objects that define the build groups for the batch
+	// build.
+	BuildGroups []*BuildGroup `locationName:"buildGroups" type:"list"`
+
+	// Specifies the maximum amount of time, in minutes, that the build in a batch
+	// must be completed in.
+	BuildTimeoutInMinutes *int64 `locationName:"buildTimeoutInMinutes" type:"integer"`
+
+	// Information about the cache for the build project.
+	Cache *ProjectCache `locationName:"cache" type:"structure"`
+
+	// Indicates if the batch build is complete.
+	Complete *bool `locationName:"complete" type:"boolean"`
+
+	// The current phase of the batch build.
+	CurrentPhase *string `locationName:"currentPhase" type:"string"`
+
+	// Specifies if session debugging is enabled for this batch build. For more
+	// information, see Viewing a running build in Session Manager (https://docs.aws.amazon.com/codebuild/latest/userguide/session-manager.html).
+	// Batch session debugging is not supported for matrix batch builds.
+	DebugSessionEnabled *bool `locationName:"debugSessionEnabled" type:"boolean"`
+
+	// The Key Management Service customer master key (CMK) to be used for encrypting
+	// the batch build output artifacts.
+	//
+	// You can use a cross-account KMS key to encrypt the build output artifacts
+	// if your service role has permission to that key.
+	//
+	// You can specify either the Amazon Resource Name (ARN) of the CMK or, if available,
+	// the CMK's alias (using the format alias/<alias-name>).
+	EncryptionKey *string `locationName:"encryptionKey" min:"1" type:"string"`
+
+	// The date and time that the batch build ended.
+	EndTime *time.Time `locationName:"endTime" type:"timestamp"`
+
+	// Information about the build environment of the build project.
+	Environment *ProjectEnvironment `locationName:"environment" type:"structure"`
+
+	// An array of ProjectFileSystemLocation objects for the batch build project.
+	// A ProjectFileSystemLocation object specifies the identifier, location, mountOptions,
+	// mountPoint, and type of a file system created using Amazon Elastic File System.
+	FileSystemLocations []*ProjectFileSystemLocation `locationName:"fileSystemLocations" type:"list"`
+
+	// The identifier of the batch build.
+	Id *string `locationName:"id" min:"1" type:"string"`
+
+	// The entity that started the batch build. Valid values include:
+	//
+	//    * If CodePipeline started the build, the pipeline's name (for example,
+	//    codepipeline/my-demo-pipeline).
+	//
+	//    * If an IAM user started the build, the user's name.
+	//
+	//    * If the Jenkins plugin for CodeBuild started the build, the string CodeBuild-Jenkins-Plugin.
+	Initiator *string `locationName:"initiator" type:"string"`
+
+	// Information about logs for a build project. These can be logs in CloudWatch
+	// Logs, built in a specified S3 bucket, or both.
+	LogConfig *LogsConfig `locationName:"logConfig" type:"structure"`
+
+	// An array of BuildBatchPhase objects the specify the phases of the batch build.
+	Phases []*BuildBatchPhase `locationName:"phases" type:"list"`
+
+	// The name of the batch build project.
+	ProjectName *string `locationName:"projectName" min:"1" type:"string"`
+
+	// Specifies the amount of time, in minutes, that the batch build is allowed
+	// to be queued before it times out.
+	QueuedTimeoutInMinutes *int64 `locationName:"queuedTimeoutInMinutes" type:"integer"`
+
+	// The identifier of the resolved version of this batch build's source code.
+	//
+	//    * For CodeCommit, GitHub, GitHub Enterprise, and BitBucket, the commit
+	//    ID.
+	//
+	//    * For CodePipeline, the source revision provided by CodePipeline.
+	//
+	//    * For Amazon S3, this does not apply.
+	ResolvedSourceVersion *string `locationName:"resolvedSourceVersion" min:"1" type:"string"`
+
+	// An array of BuildArtifacts objects the define the build artifacts for this
+	// batch build.
+	SecondaryArtifacts []*BuildArtifacts `locationName:"secondaryArtifacts" type:"list"`
+
+	// An array of ProjectSourceVersion objects. Each ProjectSourceVersion must
+	// be one of:
+	//
+	//    * For CodeCommit: the commit ID, branch, or Git tag to use.
+	//
+	//    * For GitHub: the commit ID, pull request ID, branch name, or tag name
+	//    that corresponds to the version of the source code you want to build.
+	//    If a pull request ID is specified, it must use the format pr/pull-request-ID
+	//    (for example, pr/25). If a branch name is specified, the branch's HEAD
+	//    commit ID is used. If not specified, the default branch's HEAD commit
+	//    ID is used.
+	//
+	//    * For Bitbucket: the commit ID, branch name, or tag name that corresponds
+	//    to the version of the source code you want to build. If a branch name
+	//    is specified, the branch's HEAD commit ID is used. If not specified, the
+	//    default branch's HEAD commit ID is used.
+	//
+	//    * For Amazon S3: the version ID of the object that represents the build
+	//    input ZIP file to use.
+	SecondarySourceVersions []*ProjectSourceVersion `locationName:"secondarySourceVersions" type:"list"`
+
+	// An array of ProjectSource objects that define the sources for the batch build.
+	SecondarySources []*ProjectSource `locationName:"secondarySources" type:"list"`
+
+	// The name of a service role used for builds in the batch.
+	ServiceRole *string `locationName:"serviceRole" min:"1" type:"string"`
+
+	// Information about the build input source code for the build project.
+	Source *ProjectSource `locationName:"source" type:"structure"`
+
+	// The identifier of the version of the source code to be built.
+	SourceVersion *string `locationName:"sourceVersion" min:"1" type:"string"`
+
+	// The date and time that the batch build started.
+	StartTime *time.Time `locationName:"startTime" type:"timestamp"`
+
+	// Information about the VPC configuration that CodeBuild accesses.
+	VpcConfig *VpcConfig `locationName:"vpcConfig" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BuildBatch) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BuildBatch) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *BuildBatch) SetArn(v string) *BuildBatch {
+	s.Arn = &v
+	return s
+}
+
+// SetArtifacts sets the Artifacts field's value.
+func (s *BuildBatch) SetArtifacts(v *BuildArtifacts) *BuildBatch {
+	s.Artifacts = v
+	return s
+}
+
+// SetBuildBatchConfig sets the BuildBatchConfig field's value.
+func (s *BuildBatch) SetBuildBatchConfig(v *ProjectBuildBatchConfig) *BuildBatch {
+	s.BuildBatchConfig = v
+	return s
+}
+
+// SetBuildBatchNumber sets the BuildBatchNumber field's value.
+func (s *BuildBatch) SetBuildBatchNumber(v int64) *BuildBatch {
+	s.BuildBatchNumber = &v
+	return s
+}
+
+// SetBuildBatchStatus sets the BuildBatchStatus field's value.
+func (s *BuildBatch) SetBuildBatchStatus(v string) *BuildBatch {
+	s.BuildBatchStatus = &v
+	return s
+}
+
+// SetBuildGroups sets the BuildGroups field's value.
+func (s *BuildBatch) SetBuildGroups(v []*BuildGroup) *BuildBatch {
+	s.BuildGroups = v
+	return s
+}
+
+// SetBuildTimeoutInMinutes sets the BuildTimeoutInMinutes field's value.
+func (s *BuildBatch) SetBuildTimeoutInMinutes(v int64) *BuildBatch {
+	s.BuildTimeoutInMinutes = &v
+	return s
+}
+
+// SetCache sets the Cache field's value.
+func (s *BuildBatch) SetCache(v *ProjectCache) *BuildBatch {
+	s.Cache = v
+	return s
+}
+
+// SetComplete sets the Complete field's value.
+func (s *BuildBatch) SetComplete(v bool) *BuildBatch {
+	s.Complete = &v
+	return s
+}
+
+// SetCurrentPhase sets the CurrentPhase field's value.
+func (s *BuildBatch) SetCurrentPhase(v string) *BuildBatch {
+	s.CurrentPhase = &v
+	return s
+}
+
+// SetDebugSessionEnabled sets the DebugSessionEnabled field's value.
+func (s *BuildBatch) SetDebugSessionEnabled(v bool) *BuildBatch {
+	s.DebugSessionEnabled = &v
+	return s
+}
+
+// SetEncryptionKey sets the EncryptionKey field's value.
+func (s *BuildBatch) SetEncryptionKey(v string) *BuildBatch {
+	s.EncryptionKey = &v
+	return s
+}
+
+// SetEndTime sets the EndTime field's value.
+func (s *BuildBatch) SetEndTime(v time.Time) *BuildBatch {
+	s.EndTime = &v
+	return s
+}
+
+// SetEnvironment sets the Environment field's value.
+func (s *BuildBatch) SetEnvironment(v *ProjectEnvironment) *BuildBatch {
+	s.Environment = v
+	return s
+}
+
+// SetFileSystemLocations sets the FileSystemLocations field's value.
+func (s *BuildBatch) SetFileSystemLocations(v []*ProjectFileSystemLocation) *BuildBatch {
+	s.FileSystemLocations = v
+	return s
+}
+
+// SetId sets the Id field's value.
+func (s *BuildBatch) SetId(v string) *BuildBatch {
+	s.Id = &v
+	return s
+}
+
+// SetInitiator sets the Initiator field's value.
+func (s *BuildBatch) SetInitiator(v string) *BuildBatch {
+	s.Initiator = &v
+	return s
+}
+
+// SetLogConfig sets the LogConfig field's value.
+func (s *BuildBatch) SetLogConfig(v *LogsConfig) *BuildBatch {
+	s.LogConfig = v
+	return s
+}
+
+// SetPhases sets the Phases field's value.
+func (s *BuildBatch) SetPhases(v []*BuildBatchPhase) *BuildBatch {
+	s.Phases = v
+	return s
+}
+
+// SetProjectName sets the ProjectName field's value.
+func (s *BuildBatch) SetProjectName(v string) *BuildBatch {
+	s.ProjectName = &v
+	return s
+}
+
+// SetQueuedTimeoutInMinutes sets the QueuedTimeoutInMinutes field's value.
+func (s *BuildBatch) SetQueuedTimeoutInMinutes(v int64) *BuildBatch {
+	s.QueuedTimeoutInMinutes = &v
+	return s
+}
+
+// SetResolvedSourceVersion sets the ResolvedSourceVersion field's value.
+func (s *BuildBatch) SetResolvedSourceVersion(v string) *BuildBatch {
+	s.ResolvedSourceVersion = &v
+	return s
+}
+
+// SetSecondaryArtifacts sets the SecondaryArtifacts field's value.
+func (s *BuildBatch) SetSecondaryArtifacts(v []*BuildArtifacts) *BuildBatch {
+	s.SecondaryArtifacts = v
+	return s
+}
+
+// SetSecondarySourceVersions sets the SecondarySourceVersions field's value.
+func (s *BuildBatch) SetSecondarySourceVersions(v []*ProjectSourceVersion) *BuildBatch {
+	s.SecondarySourceVersions = v
+	return s
+}
+
+// SetSecondarySources sets the SecondarySources field's value.
+func (s *BuildBatch) SetSecondarySources(v []*ProjectSource) *BuildBatch {
+	s.SecondarySources = v
+	return s
+}
+
+// SetServiceRole sets the ServiceRole field's value.
+func (s *BuildBatch) SetServiceRole(v string) *BuildBatch {
+	s.ServiceRole = &v
+	return s
+}
+
+// SetSource sets the Source field's value.
+func (s *BuildBatch) SetSource(v *ProjectSource) *BuildBatch {
+	s.Source = v
+	return s
+}
+
+// SetSourceVersion sets the SourceVersion field's value.
+func (s *BuildBatch) SetSourceVersion(v string) *BuildBatch {
+	s.SourceVersion = &v
+	return s
+}
+
+// SetStartTime sets the StartTime field's value.
+func (s *BuildBatch) SetStartTime(v time.Time) *BuildBatch {
+	s.StartTime = &v
+	return s
+}
+
+// SetVpcConfig sets the VpcConfig field's value.
+func (s *BuildBatch) SetVpcConfig(v *VpcConfig) *BuildBatch {
+	s.VpcConfig = v
+	return s
+}
+
+// Specifies filters when retrieving batch builds.
+type BuildBatchFilter struct {
+	_ struct{} `type:"structure"`
+
+	// The status of the batch builds to retrieve. Only batch builds that have this
+	// status will be retrieved.
+	Status *string `locationName:"status" type:"string" enum:"StatusType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BuildBatchFilter) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BuildBatchFilter) GoString() string {
+	return s.String()
+}
+
+// SetStatus sets the Status field's value.
+func (s *BuildBatchFilter) SetStatus(v string) *BuildBatchFilter {
+	s.Status = &v
+	return s
+}
+
+// Contains information about a stage for a batch build.
+type BuildBatchPhase struct {
+	_ struct{} `type:"structure"`
+
+	// Additional information about the batch build phase. Especially to help troubleshoot
+	// a failed batch build.
+	Contexts []*PhaseContext `locationName:"contexts" type:"list"`
+
+	// How long, in seconds, between the starting and ending times of the batch
+	// build's phase.
+	DurationInSeconds *int64 `locationName:"durationInSeconds" type:"long"`
+
+	// When the batch build phase ended, expressed in Unix time format.
+	EndTime *time.Time `locationName:"endTime" type:"timestamp"`
+
+	// The current status of the batch build phase. Valid values include:
+	//
+	// FAILED
+	//
+	// The build phase failed.
+	//
+	// FAULT
+	//
+	// The build phase faulted.
+	//
+	// IN_PROGRESS
+	//
+	// The build phase is still in progress.
+	//
+	// STOPPED
+	//
+	// The build phase stopped.
+	//
+	// SUCCEEDED
+	//
+	// The build phase succeeded.
+	//
+	// TIMED_OUT
+	//
+	// The build phase timed out.
+	PhaseStatus *string `locationName:"phaseStatus" type:"string" enum:"StatusType"`
+
+	// The name of the batch build phase. Valid values include:
+	//
+	// COMBINE_ARTIFACTS
+	//
+	// Build output artifacts are being combined and uploaded to the output location.
+	//
+	// DOWNLOAD_BATCHSPEC
+	//
+	// The batch build specification is being downloaded.
+	//
+	// FAILED
+	//
+	// One or more of the builds failed.
+	//
+	// IN_PROGRESS
+	//
+	// The batch build is in progress.
+	//
+	// STOPPED
+	//
+	// The batch build was stopped.
+	//
+	// SUBMITTED
+	//
+	// The btach build has been submitted.
+	//
+	// SUCCEEDED
+	//
+	// The batch build succeeded.
+	PhaseType *string `locationName:"phaseType" type:"string" enum:"BuildBatchPhaseType"`
+
+	// When the batch build phase started, expressed in Unix time format.
+	StartTime *time.Time `locationName:"startTime" type:"timestamp"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BuildBatchPhase) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BuildBatchPhase) GoString() string {
+	return s.String()
+}
+
+// SetContexts sets the Contexts field's value.
+func (s *BuildBatchPhase) SetContexts(v []*PhaseContext) *BuildBatchPhase {
+	s.Contexts = v
+	return s
+}
+
+// SetDurationInSeconds sets the DurationInSeconds field's value.
+func (s *BuildBatchPhase) SetDurationInSeconds(v int64) *BuildBatchPhase {
+	s.DurationInSeconds = &v
+	return s
+}
+
+// SetEndTime sets the EndTime field's value.
+func (s *BuildBatchPhase) SetEndTime(v time.Time) *BuildBatchPhase {
+	s.EndTime = &v
+	return s
+}
+
+// SetPhaseStatus sets the PhaseStatus field's value.
+func (s *BuildBatchPhase) SetPhaseStatus(v string) *BuildBatchPhase {
+	s.PhaseStatus = &v
+	return s
+}
+
+// SetPhaseType sets the PhaseType field's value.
+func (s *BuildBatchPhase) SetPhaseType(v string) *BuildBatchPhase {
+	s.PhaseType = &v
+	return s
+}
+
+// SetStartTime sets the StartTime field's value.
+func (s *BuildBatchPhase) SetStartTime(v time.Time) *BuildBatchPhase {
+	s.StartTime = &v
+	return s
+}
+
+// Contains information about a batch build build group. Build groups are used
+// to combine builds that can run in parallel, while still being able to set
+// dependencies on other build groups.
+type BuildGroup struct {
+	_ struct{} `type:"structure"`
+
+	// A BuildSummary object that contains a summary of the current build group.
+	CurrentBuildSummary *BuildSummary `locationName:"currentBuildSummary" type:"structure"`
+
+	// An array of strings that contain the identifiers of the build groups that
+	// this build group depends on.
+	DependsOn []*string `locationName:"dependsOn" type:"list"`
+
+	// Contains the identifier of the build group.
+	Identifier *string `locationName:"identifier" type:"string"`
+
+	// Specifies if failures in this build group can be ignored.
+	IgnoreFailure *bool `locationName:"ignoreFailure" type:"boolean"`
+
+	// An array of BuildSummary objects that contain summaries of previous build
+	// groups.
+	PriorBuildSummaryList []*BuildSummary `locationName:"priorBuildSummaryList" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BuildGroup) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BuildGroup) GoString() string {
+	return s.String()
+}
+
+// SetCurrentBuildSummary sets the CurrentBuildSummary field's value.
+func (s *BuildGroup) SetCurrentBuildSummary(v *BuildSummary) *BuildGroup {
+	s.CurrentBuildSummary = v
+	return s
+}
+
+// SetDependsOn sets the DependsOn field's value.
+func (s *BuildGroup) SetDependsOn(v []*string) *BuildGroup {
+	s.DependsOn = v
+	return s
+}
+
+// SetIdentifier sets the Identifier field's value.
+func (s *BuildGroup) SetIdentifier(v string) *BuildGroup {
+	s.Identifier = &v
+	return s
+}
+
+// SetIgnoreFailure sets the IgnoreFailure field's value.
+func (s *BuildGroup) SetIgnoreFailure(v bool) *BuildGroup {
+	s.IgnoreFailure = &v
+	return s
+}
+
+// SetPriorBuildSummaryList sets the PriorBuildSummaryList field's value.
+func (s *BuildGroup) SetPriorBuildSummaryList(v []*BuildSummary) *BuildGroup {
+	s.PriorBuildSummaryList = v
+	return s
+}
+
+// Information about a build that could not be successfully deleted.
+type BuildNotDeleted struct {
+	_ struct{} `type:"structure"`
+
+	// The ID of the build that could not be successfully deleted.
+	Id *string `locationName:"id" min:"1" type:"string"`
+
+	// Additional information about the build that could not be successfully deleted.
+	StatusCode *string `locationName:"statusCode" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BuildNotDeleted) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BuildNotDeleted) GoString() string {
+	return s.String()
+}
+
+// SetId sets the Id field's value.
+func (s *BuildNotDeleted) SetId(v string) *BuildNotDeleted {
+	s.Id = &v
+	return s
+}
+
+// SetStatusCode sets the StatusCode field's value.
+func (s *BuildNotDeleted) SetStatusCode(v string) *BuildNotDeleted {
+	s.StatusCode = &v
+	return s
+}
+
+// Information about a stage for a build.
+type BuildPhase struct {
+	_ struct{} `type:"structure"`
+
+	// Additional information about a build phase, especially to help troubleshoot
+	// a failed build.
+	Contexts []*PhaseContext `locationName:"contexts" type:"list"`
+
+	// How long, in seconds, between the starting and ending times of the build's
+	// phase.
+	DurationInSeconds *int64 `locationName:"durationInSeconds" type:"long"`
+
+	// When the build phase ended, expressed in Unix time format.
+	EndTime *time.Time `locationName:"endTime" type:"timestamp"`
+
+	// The current status of the build phase. Valid values include:
+	//
+	// FAILED
+	//
+	// The build phase failed.
+	//
+	// FAULT
+	//
+	// The build phase faulted.
+	//
+	// IN_PROGRESS
+	//
+	// The build phase is still in progress.
+	//
+	// STOPPED
+	//
+	// The build phase stopped.
+	//
+	// SUCCEEDED
+	//
+	// The build phase succeeded.
+	//
+	// TIMED_OUT
+	//
+	// The build phase timed out.
+	PhaseStatus *string `locationName:"phaseStatus" type:"string" enum:"StatusType"`
+
+	// The name of the build phase. Valid values include:
+	//
+	// BUILD
+	//
+	// Core build activities typically occur in this build phase.
+	//
+	// COMPLETED
+	//
+	// The build has been completed.
+	//
+	// DOWNLOAD_SOURCE
+	//
+	// Source code is being downloaded in this build phase.
+	//
+	// FINALIZING
+	//
+	// The build process is completing in this build phase.
+	//
+	// INSTALL
+	//
+	// Installation activities typically occur in this build phase.
+	//
+	// POST_BUILD
+	//
+	// Post-build activities typically occur in this build phase.
+	//
+	// PRE_BUILD
+	//
+	// Pre-build activities typically occur in this build phase.
+	//
+	// PROVISIONING
+	//
+	// The build environment is being set up.
+	//
+	// QUEUED
+	//
+	// The build has been submitted and is queued behind other submitted builds.
+	//
+	// SUBMITTED
+	//
+	// The build has been submitted.
+	//
+	// UPLOAD_ARTIFACTS
+	//
+	// Build output artifacts are being uploaded to the output location.
+	PhaseType *string `locationName:"phaseType" type:"string" enum:"BuildPhaseType"`
+
+	// When the build phase started, expressed in Unix time format.
+	StartTime *time.Time `locationName:"startTime" type:"timestamp"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BuildPhase) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BuildPhase) GoString() string {
+	return s.String()
+}
+
+// SetContexts sets the Contexts field's value.
+func (s *BuildPhase) SetContexts(v []*PhaseContext) *BuildPhase {
+	s.Contexts = v
+	return s
+}
+
+// SetDurationInSeconds sets the DurationInSeconds field's value.
+func (s *BuildPhase) SetDurationInSeconds(v int64) *BuildPhase {
+	s.DurationInSeconds = &v
+	return s
+}
+
+// SetEndTime sets the EndTime field's value.
+func (s *BuildPhase) SetEndTime(v time.Time) *BuildPhase {
+	s.EndTime = &v
+	return s
+}
+
+// SetPhaseStatus sets the PhaseStatus field's value.
+func (s *BuildPhase) SetPhaseStatus(v string) *BuildPhase {
+	s.PhaseStatus = &v
+	return s
+}
+
+// SetPhaseType sets the PhaseType field's value.
+func (s *BuildPhase) SetPhaseType(v string) *BuildPhase {
+	s.PhaseType = &v
+	return s
+}
+
+// SetStartTime sets the StartTime field's value.
+func (s *BuildPhase) SetStartTime(v time.Time) *BuildPhase {
+	s.StartTime = &v
+	return s
+}
+
+// Contains information that defines how the CodeBuild build project reports
+// the build status to the source provider.
+type BuildStatusConfig struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies the context of the build status CodeBuild sends to the source provider.
+	// The usage of this parameter depends on the source provider.
+	//
+	// Bitbucket
+	//
+	// This parameter is used for the name parameter in the Bitbucket commit status.
+	// For more information, see build (https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Bworkspace%7D/%7Brepo_slug%7D/commit/%7Bnode%7D/statuses/build)
+	// in the Bitbucket API documentation.
+	//
+	// GitHub/GitHub Enterprise Server
+	//
+	// This parameter is used for the context parameter in the GitHub commit status.
+	// For more information, see Create a commit status (https://developer.github.com/v3/repos/statuses/#create-a-commit-status)
+	// in the GitHub developer guide.
+	Context *string `locationName:"context" type:"string"`
+
+	// Specifies the target url of the build status CodeBuild sends to the source
+	// provider. The usage of this parameter depends on the source provider.
+	//
+	// Bitbucket
+	//
+	// This parameter is used for the url parameter in the Bitbucket commit status.
+	// For more information, see build (https://developer.atlassian.com/bitbucket/api/2/reference/resource/repositories/%7Bworkspace%7D/%7Brepo_slug%7D/commit/%7Bnode%7D/statuses/build)
+	// in the Bitbucket API documentation.
+	//
+	// GitHub/GitHub Enterprise Server
+	//
+	// This parameter is used for the target_url parameter in the GitHub commit
+	// status. For more information, see Create a commit status (https://developer.github.com/v3/repos/statuses/#create-a-commit-status)
+	// in the GitHub developer guide.
+	TargetUrl *string `locationName:"targetUrl" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BuildStatusConfig) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BuildStatusConfig) GoString() string {
+	return s.String()
+}
+
+// SetContext sets the Context field's value.
+func (s *BuildStatusConfig) SetContext(v string) *BuildStatusConfig {
+	s.Context = &v
+	return s
+}
+
+// SetTargetUrl sets the TargetUrl field's value.
+func (s *BuildStatusConfig) SetTargetUrl(v string) *BuildStatusConfig {
+	s.TargetUrl = &v
+	return s
+}
+
+// Contains summary information about a batch build group.
+type BuildSummary struct {
+	_ struct{} `type:"structure"`
+
+	// The batch build ARN.
+	Arn *string `locationName:"arn" type:"string"`
+
+	// The status of the build group.
+	//
+	// FAILED
+	//
+	// The build group failed.
+	//
+	// FAULT
+	//
+	// The build group faulted.
+	//
+	// IN_PROGRESS
+	//
+	// The build group is still in progress.
+	//
+	// STOPPED
+	//
+	// The build group stopped.
+	//
+	// SUCCEEDED
+	//
+	// The build group succeeded.
+	//
+	// TIMED_OUT
+	//
+	// The build group timed out.
+	BuildStatus *string `locationName:"buildStatus" type:"string" enum:"StatusType"`
+
+	// A ResolvedArtifact object that represents the primary build artifacts for
+	// the build group.
+	PrimaryArtifact *ResolvedArtifact `locationName:"primaryArtifact" type:"structure"`
+
+	// When the build was started, expressed in Unix time format.
+	RequestedOn *time.Time `locationName:"requestedOn" type:"timestamp"`
+
+	// An array of ResolvedArtifact objects that represents the secondary build
+	// artifacts for the build group.
+	SecondaryArtifacts []*ResolvedArtifact `locationName:"secondaryArtifacts" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BuildSummary) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BuildSummary) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *BuildSummary) SetArn(v string) *BuildSummary {
+	s.Arn = &v
+	return s
+}
+
+// SetBuildStatus sets the BuildStatus field's value.
+func (s *BuildSummary) SetBuildStatus(v string) *BuildSummary {
+	s.BuildStatus = &v
+	return s
+}
+
+// SetPrimaryArtifact sets the PrimaryArtifact field's value.
+func (s *BuildSummary) SetPrimaryArtifact(v *ResolvedArtifact) *BuildSummary {
+	s.PrimaryArtifact = v
+	return s
+}
+
+// SetRequestedOn sets the RequestedOn field's value.
+func (s *BuildSummary) SetRequestedOn(v time.Time) *BuildSummary {
+	s.RequestedOn = &v
+	return s
+}
+
+// SetSecondaryArtifacts sets the SecondaryArtifacts field's value.
+func (s *BuildSummary) SetSecondaryArtifacts(v []*ResolvedArtifact) *BuildSummary {
+	s.SecondaryArtifacts = v
+	return s
+}
+
+// Information about CloudWatch Logs for a build project.
+type CloudWatchLogsConfig struct {
+	_ struct{} `type:"structure"`
+
+	// The group name of the logs in CloudWatch Logs. For more information, see
+	// Working with Log Groups and Log Streams (https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/Working-with-log-groups-and-streams.html).
+	GroupName *string `locationName:"groupName" type:"string"`
+
+	// The current status of the logs in CloudWatch Logs for a build project. Valid
+	// values are:
+	//
+	//    * ENABLED: CloudWatch Logs are enabled for this build project.
+	//
+	//    * DISABLED: CloudWatch Logs are not enabled for this build project.
+	//
+	// Status is a required field
+	Status *string `locationName:"status" type:"string" required:"true" enum:"LogsConfigStatusType"`
+
+	// The prefix of the stream name of the CloudWatch Logs. For more information,
+	// see Working with Log Groups and Log Streams (https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/Working-with-log-groups-and-streams.html).
+	StreamName *string `locationName:"streamName" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CloudWatchLogsConfig) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CloudWatchLogsConfig) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CloudWatchLogsConfig) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CloudWatchLogsConfig"}
+	if s.Status == nil {
+		invalidParams.Add(request.NewErrParamRequired("Status"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetGroupName sets the GroupName field's value.
+func (s *CloudWatchLogsConfig) SetGroupName(v string) *CloudWatchLogsConfig {
+	s.GroupName = &v
+	return s
+}
+
+// SetStatus sets the Status field's value.
+func (s *CloudWatchLogsConfig) SetStatus(v string) *CloudWatchLogsConfig {
+	s.Status = &v
+	return s
+}
+
+// SetStreamName sets the StreamName field's value.
+func (s *CloudWatchLogsConfig) SetStreamName(v string) *CloudWatchLogsConfig {
+	s.StreamName = &v
+	return s
+}
+
+// Contains code coverage report information.
+//
+// Line coverage measures how many statements your tests cover. A statement
+// is a single instruction, not including comments, conditionals, etc.
+//
+// Branch coverage determines if your tests cover every possible branch of a
+// control structure, such as an if or case statement.
+type CodeCoverage struct {
+	_ struct{} `type:"structure"`
+
+	// The percentage of branches that are covered by your tests.
+	BranchCoveragePercentage *float64 `locationName:"branchCoveragePercentage" type:"double"`
+
+	// The number of conditional branches that are covered by your tests.
+	BranchesCovered *int64 `locationName:"branchesCovered" type:"integer"`
+
+	// The number of conditional branches that are not covered by your tests.
+	BranchesMissed *int64 `locationName:"branchesMissed" type:"integer"`
+
+	// The date and time that the tests were run.
+	Expired *time.Time `locationName:"expired" type:"timestamp"`
+
+	// The path of the test report file.
+	FilePath *string `locationName:"filePath" min:"1" type:"string"`
+
+	// The identifier of the code coverage report.
+	Id *string `locationName:"id" min:"1" type:"string"`
+
+	// The percentage of lines that are covered by your tests.
+	LineCoveragePercentage *float64 `locationName:"lineCoveragePercentage" type:"double"`
+
+	// The number of lines that are covered by your tests.
+	LinesCovered *int64 `locationName:"linesCovered" type:"integer"`
+
+	// The number of lines that are not covered by your tests.
+	LinesMissed *int64 `locationName:"linesMissed" type:"integer"`
+
+	// The ARN of the report.
+	ReportARN *string `locationName:"reportARN" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CodeCoverage) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CodeCoverage) GoString() string {
+	return s.String()
+}
+
+// SetBranchCoveragePercentage sets the BranchCoveragePercentage field's value.
+func (s *CodeCoverage) SetBranchCoveragePercentage(v float64) *CodeCoverage {
+	s.BranchCoveragePercentage = &v
+	return s
+}
+
+// SetBranchesCovered sets the BranchesCovered field's value.
+func (s *CodeCoverage) SetBranchesCovered(v int64) *CodeCoverage {
+	s.BranchesCovered = &v
+	return s
+}
+
+// SetBranchesMissed sets the BranchesMissed field's value.
+func (s *CodeCoverage) SetBranchesMissed(v int64) *CodeCoverage {
+	s.BranchesMissed = &v
+	return s
+}
+
+// SetExpired sets the Expired field's value.
+func (s *CodeCoverage) SetExpired(v time.Time) *CodeCoverage {
+	s.Expired = &v
+	return s
+}
+
+// SetFilePath sets the FilePath field's value.
+func (s *CodeCoverage) SetFilePath(v string) *CodeCoverage {
+	s.FilePath = &v
+	return s
+}
+
+// SetId sets the Id field's value.
+func (s *CodeCoverage) SetId(v string) *CodeCoverage {
+	s.Id = &v
+	return s
+}
+
+// SetLineCoveragePercentage sets the LineCoveragePercentage field's value.
+func (s *CodeCoverage) SetLineCoveragePercentage(v float64) *CodeCoverage {
+	s.LineCoveragePercentage = &v
+	return s
+}
+
+// SetLinesCovered sets the LinesCovered field's value.
+func (s *CodeCoverage) SetLinesCovered(v int64) *CodeCoverage {
+	s.LinesCovered = &v
+	return s
+}
+
+// SetLinesMissed sets the LinesMissed field's value.
+func (s *CodeCoverage) SetLinesMissed(v int64) *CodeCoverage {
+	s.LinesMissed = &v
+	return s
+}
+
+// SetReportARN sets the ReportARN field's value.
+func (s *CodeCoverage) SetReportARN(v string) *CodeCoverage {
+	s.ReportARN = &v
+	return s
+}
+
+// Contains a summary of a code coverage report.
+//
+// Line coverage measures how many statements your tests cover. A statement
+// is a single instruction, not including comments, conditionals, etc.
+//
+// Branch coverage determines if your tests cover every possible branch of a
+// control structure, such as an if or case statement.
+type CodeCoverageReportSummary struct {
+	_ struct{} `type:"structure"`
+
+	// The percentage of branches that are covered by your tests.
+	BranchCoveragePercentage *float64 `locationName:"branchCoveragePercentage" type:"double"`
+
+	// The number of conditional branches that are covered by your tests.
+	BranchesCovered *int64 `locationName:"branchesCovered" type:"integer"`
+
+	// The number of conditional branches that are not covered by your tests.
+	BranchesMissed *int64 `locationName:"branchesMissed" type:"integer"`
+
+	// The percentage of lines that are covered by your tests.
+	LineCoveragePercentage *float64 `locationName:"lineCoveragePercentage" type:"double"`
+
+	// The number of lines that are covered by your tests.
+	LinesCovered *int64 `locationName:"linesCovered" type:"integer"`
+
+	// The number of lines that are not covered by your tests.
+	LinesMissed *int64 `locationName:"linesMissed" type:"integer"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CodeCoverageReportSummary) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CodeCoverageReportSummary) GoString() string {
+	return s.String()
+}
+
+// SetBranchCoveragePercentage sets the BranchCoveragePercentage field's value.
+func (s *CodeCoverageReportSummary) SetBranchCoveragePercentage(v float64) *CodeCoverageReportSummary {
+	s.BranchCoveragePercentage = &v
+	return s
+}
+
+// SetBranchesCovered sets the BranchesCovered field's value.
+func (s *CodeCoverageReportSummary) SetBranchesCovered(v int64) *CodeCoverageReportSummary {
+	s.BranchesCovered = &v
+	return s
+}
+
+// SetBranchesMissed sets the BranchesMissed field's value.
+func (s *CodeCoverageReportSummary) SetBranchesMissed(v int64) *CodeCoverageReportSummary {
+	s.BranchesMissed = &v
+	return s
+}
+
+// SetLineCoveragePercentage sets the LineCoveragePercentage field's value.
+func (s *CodeCoverageReportSummary) SetLineCoveragePercentage(v float64) *CodeCoverageReportSummary {
+	s.LineCoveragePercentage = &v
+	return s
+}
+
+// SetLinesCovered sets the LinesCovered field's value.
+func (s *CodeCoverageReportSummary) SetLinesCovered(v int64) *CodeCoverageReportSummary {
+	s.LinesCovered = &v
+	return s
+}
+
+// SetLinesMissed sets the LinesMissed field's value.
+func (s *CodeCoverageReportSummary) SetLinesMissed(v int64) *CodeCoverageReportSummary {
+	s.LinesMissed = &v
+	return s
+}
+
+type CreateProjectInput struct {
+	_ struct{} `type:"structure"`
+
+	// Information about the build output artifacts for the build project.
+	//
+	// Artifacts is a required field
+	Artifacts *ProjectArtifacts `locationName:"artifacts" type:"structure" required:"true"`
+
+	// Set this to true to generate a publicly accessible URL for your project's
+	// build badge.
+	BadgeEnabled *bool `locationName:"badgeEnabled" type:"boolean"`
+
+	// A ProjectBuildBatchConfig object that defines the batch build options for
+	// the project.
+	BuildBatchConfig *ProjectBuildBatchConfig `locationName:"buildBatchConfig" type:"structure"`
+
+	// Stores recently used information so that it can be quickly accessed at a
+	// later time.
+	Cache *ProjectCache `locationName:"cache" type:"structure"`
+
+	// The maximum number of concurrent builds that are allowed for this project.
+	//
+	// New builds are only started if the current number of builds is less than
+	// or equal to this limit. If the current build count meets this limit, new
+	// builds are throttled and are not run.
+	ConcurrentBuildLimit *int64 `locationName:"concurrentBuildLimit" type:"integer"`
+
+	// A description that makes the build project easy to identify.
+	Description *string `locationName:"description" type:"string"`
+
+	// The Key Management Service customer master key (CMK) to be used for encrypting
+	// the build output artifacts.
+	//
+	// You can use a cross-account KMS key to encrypt the build output artifacts
+	// if your service role has permission to that key.
+	//
+	// You can specify either the Amazon Resource Name (ARN) of the CMK or, if available,
+	// the CMK's alias (using the format alias/<alias-name>).
+	EncryptionKey *string `locationName:"encryptionKey" min:"1" type:"string"`
+
+	// Information about the build environment for the build project.
+	//
+	// Environment is a required field
+	Environment *ProjectEnvironment `locationName:"environment" type:"structure" required:"true"`
+
+	// An array of ProjectFileSystemLocation objects for a CodeBuild build project.
+	// A ProjectFileSystemLocation object specifies the identifier, location, mountOptions,
+	// mountPoint, and type of a file system created using Amazon Elastic File System.
+	FileSystemLocations []*ProjectFileSystemLocation `locationName:"fileSystemLocations" type:"list"`
+
+	// Information about logs for the build project. These can be logs in CloudWatch
+	// Logs, logs uploaded to a specified S3 bucket, or both.
+	LogsConfig *LogsConfig `locationName:"logsConfig" type:"structure"`
+
+	// The name of the build project.
+	//
+	// Name is a required field
+	Name *string `locationName:"name" min:"2" type:"string" required:"true"`
+
+	// The number of minutes a build is allowed to be queued before it times out.
+	QueuedTimeoutInMinutes *int64 `locationName:"queuedTimeoutInMinutes" min:"5" type:"integer"`
+
+	// An array of ProjectArtifacts objects.
+	SecondaryArtifacts []*ProjectArtifacts `locationName:"secondaryArtifacts" type:"list"`
+
+	// An array of ProjectSourceVersion objects. If secondarySourceVersions is specified
+	// at the build level, then they take precedence over these secondarySourceVersions
+	// (at the project level).
+	SecondarySourceVersions []*ProjectSourceVersion `locationName:"secondarySourceVersions" type:"list"`
+
+	// An array of ProjectSource objects.
+	SecondarySources []*ProjectSource `locationName:"secondarySources" type:"list"`
+
+	// The ARN of the IAM role that enables CodeBuild to interact with dependent
+	// Amazon Web Services services on behalf of the Amazon Web Services account.
+	//
+	// ServiceRole is a required field
+	ServiceRole *string `locationName:"serviceRole" min:"1" type:"string" required:"true"`
+
+	// Information about the build input source code for the build project.
+	//
+	// Source is a required field
+	Source *ProjectSource `locationName:"source" type:"structure" required:"true"`
+
+	// A version of the build input to be built for this project. If not specified,
+	// the latest version is used. If specified, it must be one of:
+	//
+	//    * For CodeCommit: the commit ID, branch, or Git tag to use.
+	//
+	//    * For GitHub: the commit ID, pull request ID, branch name, or tag name
+	//    that corresponds to the version of the source code you want to build.
+	//    If a pull request ID is specified, it must use the format pr/pull-request-ID
+	//    (for example pr/25). If a branch name is specified, the branch's HEAD
+	//    commit ID is used. If not specified, the default branch's HEAD commit
+	//    ID is used.
+	//
+	//    * For Bitbucket: the commit ID, branch name, or tag name that corresponds
+	//    to the version of the source code you want to build. If a branch name
+	//    is specified, the branch's HEAD commit ID is used. If not specified, the
+	//    default branch's HEAD commit ID is used.
+	//
+	//    * For Amazon S3: the version ID of the object that represents the build
+	//    input ZIP file to use.
+	//
+	// If sourceVersion is specified at the build level, then that version takes
+	// precedence over this sourceVersion (at the project level).
+	//
+	// For more information, see Source Version Sample with CodeBuild (https://docs.aws.amazon.com/codebuild/latest/userguide/sample-source-version.html)
+	// in the CodeBuild User Guide.
+	SourceVersion *string `locationName:"sourceVersion" type:"string"`
+
+	// A list of tag key and value pairs associated with this build project.
+	//
+	// These tags are available for use by Amazon Web Services services that support
+	// CodeBuild build project tags.
+	Tags []*Tag `locationName:"tags" type:"list"`
+
+	// How long, in minutes, from 5 to 480 (8 hours), for CodeBuild to wait before
+	// it times out any build that has not been marked as completed. The default
+	// is 60 minutes.
+	TimeoutInMinutes *int64 `locationName:"timeoutInMinutes" min:"5" type:"integer"`
+
+	// VpcConfig enables CodeBuild to access resources in an Amazon VPC.
+	VpcConfig *VpcConfig `locationName:"vpcConfig" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateProjectInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateProjectInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateProjectInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateProjectInput"}
+	if s.Artifacts == nil {
+		invalidParams.Add(request.NewErrParamRequired("Artifacts"))
+	}
+	if s.EncryptionKey != nil && len(*s.EncryptionKey) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EncryptionKey", 1))
+	}
+	if s.Environment == nil {
+		invalidParams.Add(request.NewErrParamRequired("Environment"))
+	}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 2 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 2))
+	}
+	if s.QueuedTimeoutInMinutes != nil && *s.QueuedTimeoutInMinutes < 5 {
+		invalidParams.Add(request.NewErrParamMinValue("QueuedTimeoutInMinutes", 5))
+	}
+	if s.ServiceRole == nil {
+		invalidParams.Add(request.NewErrParamRequired("ServiceRole"))
+	}
+	if s.ServiceRole != nil && len(*s.ServiceRole) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ServiceRole", 1))
+	}
+	if s.Source == nil {
+		invalidParams.Add(request.NewErrParamRequired("Source"))
+	}
+	if s.TimeoutInMinutes != nil && *s.TimeoutInMinutes < 5 {
+		invalidParams.Add(request.NewErrParamMinValue("TimeoutInMinutes", 5))
+	}
+	if s.Artifacts != nil {
+		if err := s.Artifacts.Validate(); err != nil {
+			invalidParams.AddNested("Artifacts", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.BuildBatchConfig != nil {
+		if err := s.BuildBatchConfig.Validate(); err != nil {
+			invalidParams.AddNested("BuildBatchConfig", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Validate(); err != nil {
+			invalidParams.AddNested("Cache", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Environment != nil {
+		if err := s.Environment.Validate(); err != nil {
+			invalidParams.AddNested("Environment", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.LogsConfig != nil {
+		if err := s.LogsConfig.Validate(); err != nil {
+			invalidParams.AddNested("LogsConfig", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.SecondaryArtifacts != nil {
+		for i, v := range s.SecondaryArtifacts {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "SecondaryArtifacts", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.SecondarySourceVersions != nil {
+		for i, v := range s.SecondarySourceVersions {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "SecondarySourceVersions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.SecondarySources != nil {
+		for i, v := range s.SecondarySources {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "SecondarySources", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Source != nil {
+		if err := s.Source.Validate(); err != nil {
+			invalidParams.AddNested("Source", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.VpcConfig != nil {
+		if err := s.VpcConfig.Validate(); err != nil {
+			invalidParams.AddNested("VpcConfig", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetArtifacts sets the Artifacts field's value.
+func (s *CreateProjectInput) SetArtifacts(v *ProjectArtifacts) *CreateProjectInput {
+	s.Artifacts = v
+	return s
+}
+
+// SetBadgeEnabled sets the BadgeEnabled field's value.
+func (s *CreateProjectInput) SetBadgeEnabled(v bool) *CreateProjectInput {
+	s.BadgeEnabled = &v
+	return s
+}
+
+// SetBuildBatchConfig sets the BuildBatchConfig field's value.
+func (s *CreateProjectInput) SetBuildBatchConfig(v *ProjectBuildBatchConfig) *CreateProjectInput {
+	s.BuildBatchConfig = v
+	return s
+}
+
+// SetCache sets the Cache field's value.
+func (s *CreateProjectInput) SetCache(v *ProjectCache) *CreateProjectInput {
+	s.Cache = v
+	return s
+}
+
+// SetConcurrentBuildLimit sets the ConcurrentBuildLimit field's value.
+func (s *CreateProjectInput) SetConcurrentBuildLimit(v int64) *CreateProjectInput {
+	s.ConcurrentBuildLimit = &v
+	return s
+}
+
+// SetDescription sets the Description field's value.
+func (s *CreateProjectInput) SetDescription(v string) *CreateProjectInput {
+	s.Description = &v
+	return s
+}
+
+// SetEncryptionKey sets the EncryptionKey field's value.
+func (s *CreateProjectInput) SetEncryptionKey(v string) *CreateProjectInput {
+	s.EncryptionKey = &v
+	return s
+}
+
+// SetEnvironment sets the Environment field's value.
+func (s *CreateProjectInput) SetEnvironment(v *ProjectEnvironment) *CreateProjectInput {
+	s.Environment = v
+	return s
+}
+
+// SetFileSystemLocations sets the FileSystemLocations field's value.
+func (s *CreateProjectInput) SetFileSystemLocations(v []*ProjectFileSystemLocation) *CreateProjectInput {
+	s.FileSystemLocations = v
+	return s
+}
+
+// SetLogsConfig sets the LogsConfig field's value.
+func (s *CreateProjectInput) SetLogsConfig(v *LogsConfig) *CreateProjectInput {
+	s.LogsConfig = v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *CreateProjectInput) SetName(v string) *CreateProjectInput {
+	s.Name = &v
+	return s
+}
+
+// SetQueuedTimeoutInMinutes sets the QueuedTimeoutInMinutes field's value.
+func (s *CreateProjectInput) SetQueuedTimeoutInMinutes(v int64) *CreateProjectInput {
+	s.QueuedTimeoutInMinutes = &v
+	return s
+}
+
+// SetSecondaryArtifacts sets the SecondaryArtifacts field's value.
+func (s *CreateProjectInput) SetSecondaryArtifacts(v []*ProjectArtifacts) *CreateProjectInput {
+	s.SecondaryArtifacts = v
+	return s
+}
+
+// SetSecondarySourceVersions sets the SecondarySourceVersions field's value.
+func (s *CreateProjectInput) SetSecondarySourceVersions(v []*ProjectSourceVersion) *CreateProjectInput {
+	s.SecondarySourceVersions = v
+	return s
+}
+
+// SetSecondarySources sets the SecondarySources field's value.
+func (s *CreateProjectInput) SetSecondarySources(v []*ProjectSource) *CreateProjectInput {
+	s.SecondarySources = v
+	return s
+}
+
+// SetServiceRole sets the ServiceRole field's value.
+func (s *CreateProjectInput) SetServiceRole(v string) *CreateProjectInput {
+	s.ServiceRole = &v
+	return s
+}
+
+// SetSource sets the Source field's value.
+func (s *CreateProjectInput) SetSource(v *ProjectSource) *CreateProjectInput {
+	s.Source = v
+	return s
+}
+
+// SetSourceVersion sets the SourceVersion field's value.
+func (s *CreateProjectInput) SetSourceVersion(v string) *CreateProjectInput {
+	s.SourceVersion = &v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *CreateProjectInput) SetTags(v []*Tag) *CreateProjectInput {
+	s.Tags = v
+	return s
+}
+
+// SetTimeoutInMinutes sets the TimeoutInMinutes field's value.
+func (s *CreateProjectInput) SetTimeoutInMinutes(v int64) *CreateProjectInput {
+	s.TimeoutInMinutes = &v
+	return s
+}
+
+// SetVpcConfig sets the VpcConfig field's value.
+func (s *CreateProjectInput) SetVpcConfig(v *VpcConfig) *CreateProjectInput {
+	s.VpcConfig = v
+	return s
+}
+
+type CreateProjectOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Information about the build project that was created.
+	Project *Project `locationName:"project" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateProjectOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateProjectOutput) GoString() string {
+	return s.String()
+}
+
+// SetProject sets the Project field's value.
+func (s *CreateProjectOutput) SetProject(v *Project) *CreateProjectOutput {
+	s.Project = v
+	return s
+}
+
+type CreateReportGroupInput struct {
+	_ struct{} `type:"structure"`
+
+	// A ReportExportConfig object that contains information about where the report
+	// group test results are exported.
+	//
+	// ExportConfig is a required field
+	ExportConfig *ReportExportConfig `locationName:"exportConfig" type:"structure" required:"true"`
+
+	// The name of the report group.
+	//
+	// Name is a required field
+	Name *string `locationName:"name" min:"2" type:"string" required:"true"`
+
+	// A list of tag key and value pairs associated with this report group.
+	//
+	// These tags are available for use by Amazon Web Services services that support
+	// CodeBuild report group tags.
+	Tags []*Tag `locationName:"tags" type:"list"`
+
+	// The type of report group.
+	//
+	// Type is a required field
+	Type *string `locationName:"type" type:"string" required:"true" enum:"ReportType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateReportGroupInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateReportGroupInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateReportGroupInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateReportGroupInput"}
+	if s.ExportConfig == nil {
+		invalidParams.Add(request.NewErrParamRequired("ExportConfig"))
+	}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 2 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 2))
+	}
+	if s.Type == nil {
+		invalidParams.Add(request.NewErrParamRequired("Type"))
+	}
+	if s.ExportConfig != nil {
+		if err := s.ExportConfig.Validate(); err != nil {
+			invalidParams.AddNested("ExportConfig", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetExportConfig sets the ExportConfig field's value.
+func (s *CreateReportGroupInput) SetExportConfig(v *ReportExportConfig) *CreateReportGroupInput {
+	s.ExportConfig = v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *CreateReportGroupInput) SetName(v string) *CreateReportGroupInput {
+	s.Name = &v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *CreateReportGroupInput) SetTags(v []*Tag) *CreateReportGroupInput {
+	s.Tags = v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *CreateReportGroupInput) SetType(v string) *CreateReportGroupInput {
+	s.Type = &v
+	return s
+}
+
+type CreateReportGroupOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Information about the report group that was created.
+	ReportGroup *ReportGroup `locationName:"reportGroup" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateReportGroupOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateReportGroupOutput) GoString() string {
+	return s.String()
+}
+
+// SetReportGroup sets the ReportGroup field's value.
+func (s *CreateReportGroupOutput) SetReportGroup(v *ReportGroup) *CreateReportGroupOutput {
+	s.ReportGroup = v
+	return s
+}
+
+type CreateWebhookInput struct {
+	_ struct{} `type:"structure"`
+
+	// A regular expression used to determine which repository branches are built
+	// when a webhook is triggered. If the name of a branch matches the regular
+	// expression, then it is built. If branchFilter is empty, then all branches
+	// are built.
+	//
+	// It is recommended that you use filterGroups instead of branchFilter.
+	BranchFilter *string `locationName:"branchFilter" type:"string"`
+
+	// Specifies the type of build this webhook will trigger.
+	BuildType *string `locationName:"buildType" type:"string" enum:"WebhookBuildType"`
+
+	// An array of arrays of WebhookFilter objects used to determine which webhooks
+	// are triggered. At least one WebhookFilter in the array must specify EVENT
+	// as its type.
+	//
+	// For a build to be triggered, at least one filter group in the filterGroups
+	// array must pass. For a filter group to pass, each of its filters must pass.
+	FilterGroups [][]*WebhookFilter `locationName:"filterGroups" type:"list"`
+
+	// The name of the CodeBuild project.
+	//
+	// ProjectName is a required field
+	ProjectName *string `locationName:"projectName" min:"2" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateWebhookInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateWebhookInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateWebhookInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateWebhookInput"}
+	if s.ProjectName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ProjectName"))
+	}
+	if s.ProjectName != nil && len(*s.ProjectName) < 2 {
+		invalidParams.Add(request.NewErrParamMinLen("ProjectName", 2))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetBranchFilter sets the BranchFilter field's value.
+func (s *CreateWebhookInput) SetBranchFilter(v string) *CreateWebhookInput {
+	s.BranchFilter = &v
+	return s
+}
+
+// SetBuildType sets the BuildType field's value.
+func (s *CreateWebhookInput) SetBuildType(v string) *CreateWebhookInput {
+	s.BuildType = &v
+	return s
+}
+
+// SetFilterGroups sets the FilterGroups field's value.
+func (s *CreateWebhookInput) SetFilterGroups(v [][]*WebhookFilter) *CreateWebhookInput {
+	s.FilterGroups = v
+	return s
+}
+
+// SetProjectName sets the ProjectName field's value.
+func (s *CreateWebhookInput) SetProjectName(v string) *CreateWebhookInput {
+	s.ProjectName = &v
+	return s
+}
+
+type CreateWebhookOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Information about a webhook that connects repository events to a build project
+	// in CodeBuild.
+	Webhook *Webhook `locationName:"webhook" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateWebhookOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateWebhookOutput) GoString() string {
+	return s.String()
+}
+
+// SetWebhook sets the Webhook field's value.
+func (s *CreateWebhookOutput) SetWebhook(v *Webhook) *CreateWebhookOutput {
+	s.Webhook = v
+	return s
+}
+
+// Contains information about the debug session for a build. For more information,
+// see Viewing a running build in Session Manager (https://docs.aws.amazon.com/codebuild/latest/userguide/session-manager.html).
+type DebugSession struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies if session debugging is enabled for this build.
+	SessionEnabled *bool `locationName:"sessionEnabled" type:"boolean"`
+
+	// Contains the identifier of the Session Manager session used for the build.
+	// To work with the paused build, you open this session to examine, control,
+	// and resume the build.
+	SessionTarget *string `locationName:"sessionTarget" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DebugSession) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DebugSession) GoString() string {
+	return s.String()
+}
+
+// SetSessionEnabled sets the SessionEnabled field's value.
+func (s *DebugSession) SetSessionEnabled(v bool) *DebugSession {
+	s.SessionEnabled = &v
+	return s
+}
+
+// SetSessionTarget sets the SessionTarget field's value.
+func (s *DebugSession) SetSessionTarget(v string) *DebugSession {
+	s.SessionTarget = &v
+	return s
+}
+
+type DeleteBuildBatchInput struct {
+	_ struct{} `type:"structure"`
+
+	// The identifier of the batch build to delete.
+	//
+	// Id is a required field
+	Id *string `locationName:"id" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteBuildBatchInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteBuildBatchInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteBuildBatchInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteBuildBatchInput"}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
+	}
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetId sets the Id field's value.
+func (s *DeleteBuildBatchInput) SetId(v string) *DeleteBuildBatchInput {
+	s.Id = &v
+	return s
+}
+
+type DeleteBuildBatchOutput struct {
+	_ struct{} `type:"structure"`
+
+	// An array of strings that contain the identifiers of the builds that were
+	// deleted.
+	BuildsDeleted []*string `locationName:"buildsDeleted" min:"1" type:"list"`
+
+	// An array of BuildNotDeleted objects that specify the builds that could not
+	// be deleted.
+	BuildsNotDeleted []*BuildNotDeleted `locationName:"buildsNotDeleted" type:"list"`
+
+	// The status code.
+	StatusCode *string `locationName:"statusCode" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteBuildBatchOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteBuildBatchOutput) GoString() string {
+	return s.String()
+}
+
+// SetBuildsDeleted sets the BuildsDeleted field's value.
+func (s *DeleteBuildBatchOutput) SetBuildsDeleted(v []*string) *DeleteBuildBatchOutput {
+	s.BuildsDeleted = v
+	return s
+}
+
+// SetBuildsNotDeleted sets the BuildsNotDeleted field's value.
+func (s *DeleteBuildBatchOutput) SetBuildsNotDeleted(v []*BuildNotDeleted) *DeleteBuildBatchOutput {
+	s.BuildsNotDeleted = v
+	return s
+}
+
+// SetStatusCode sets the StatusCode field's value.
+func (s *DeleteBuildBatchOutput) SetStatusCode(v string) *DeleteBuildBatchOutput {
+	s.StatusCode = &v
+	return s
+}
+
+type DeleteProjectInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the build project.
+	//
+	// Name is a required field
+	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteProjectInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteProjectInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteProjectInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteProjectInput"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetName sets the Name field's value.
+func (s *DeleteProjectInput) SetName(v string) *DeleteProjectInput {
+	s.Name = &v
+	return s
+}
+
+type DeleteProjectOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteProjectOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteProjectOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteReportGroupInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the report group to delete.
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"1" type:"string" required:"true"`
+
+	// If true, deletes any reports that belong to a report group before deleting
+	// the report group.
+	//
+	// If false, you must delete any reports in the report group. Use ListReportsForReportGroup
+	// (https://docs.aws.amazon.com/codebuild/latest/APIReference/API_ListReportsForReportGroup.html)
+	// to get the reports in a report group. Use DeleteReport (https://docs.aws.amazon.com/codebuild/latest/APIReference/API_DeleteReport.html)
+	// to delete the reports. If you call DeleteReportGroup for a report group that
+	// contains one or more reports, an exception is thrown.
+	DeleteReports *bool `locationName:"deleteReports" type:"boolean"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteReportGroupInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteReportGroupInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteReportGroupInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteReportGroupInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
+	}
+	if s.Arn != nil && len(*s.Arn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetArn sets the Arn field's value.
+func (s *DeleteReportGroupInput) SetArn(v string) *DeleteReportGroupInput {
+	s.Arn = &v
+	return s
+}
+
+// SetDeleteReports sets the DeleteReports field's value.
+func (s *DeleteReportGroupInput) SetDeleteReports(v bool) *DeleteReportGroupInput {
+	s.DeleteReports = &v
+	return s
+}
+
+type DeleteReportGroupOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteReportGroupOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteReportGroupOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteReportInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the report to delete.
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteReportInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteReportInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteReportInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteReportInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
+	}
+	if s.Arn != nil && len(*s.Arn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetArn sets the Arn field's value.
+func (s *DeleteReportInput) SetArn(v string) *DeleteReportInput {
+	s.Arn = &v
+	return s
+}
+
+type DeleteReportOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteReportOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteReportOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteResourcePolicyInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the resource that is associated with the resource policy.
+	//
+	// ResourceArn is a required field
+	ResourceArn *string `locationName:"resourceArn" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteResourcePolicyInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteResourcePolicyInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteResourcePolicyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteResourcePolicyInput"}
+	if s.ResourceArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceArn"))
+	}
+	if s.ResourceArn != nil && len(*s.ResourceArn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ResourceArn", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetResourceArn sets the ResourceArn field's value.
+func (s *DeleteResourcePolicyInput) SetResourceArn(v string) *DeleteResourcePolicyInput {
+	s.ResourceArn = &v
+	return s
+}
+
+type DeleteResourcePolicyOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteResourcePolicyOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteResourcePolicyOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteSourceCredentialsInput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the token.
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteSourceCredentialsInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteSourceCredentialsInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteSourceCredentialsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteSourceCredentialsInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
+	}
+	if s.Arn != nil && len(*s.Arn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetArn sets the Arn field's value.
+func (s *DeleteSourceCredentialsInput) SetArn(v string) *DeleteSourceCredentialsInput {
+	s.Arn = &v
+	return s
+}
+
+type DeleteSourceCredentialsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the token.
+	Arn *string `locationName:"arn" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteSourceCredentialsOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteSourceCredentialsOutput) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *DeleteSourceCredentialsOutput) SetArn(v string) *DeleteSourceCredentialsOutput {
+	s.Arn = &v
+	return s
+}
+
+type DeleteWebhookInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the CodeBuild project.
+	//
+	// ProjectName is a required field
+	ProjectName *string `locationName:"projectName" min:"2" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteWebhookInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteWebhookInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteWebhookInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteWebhookInput"}
+	if s.ProjectName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ProjectName"))
+	}
+	if s.ProjectName != nil && len(*s.ProjectName) < 2 {
+		invalidParams.Add(request.NewErrParamMinLen("ProjectName", 2))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetProjectName sets the ProjectName field's value.
+func (s *DeleteWebhookInput) SetProjectName(v string) *DeleteWebhookInput {
+	s.ProjectName = &v
+	return s
+}
+
+type DeleteWebhookOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteWebhookOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteWebhookOutput) GoString() string {
+	return s.String()
+}
+
+type DescribeCodeCoveragesInput struct {
+	_ struct{} `type:"structure"`
+
+	// The maximum line coverage percentage to report.
+	MaxLineCoveragePercentage *float64 `locationName:"maxLineCoveragePercentage" type:"double"`
+
+	// The maximum number of results to return.
+	MaxResults *int64 `locationName:"maxResults" min:"1" type:"integer"`
+
+	// The minimum line coverage percentage to report.
+	MinLineCoveragePercentage *float64 `locationName:"minLineCoveragePercentage" type:"double"`
+
+	// The nextToken value returned from a previous call to DescribeCodeCoverages.
+	// This specifies the next item to return. To return the beginning of the list,
+	// exclude this parameter.
+	NextToken *string `locationName:"nextToken" type:"string"`
+
+	// The ARN of the report for which test cases are returned.
+	//
+	// ReportArn is a required field
+	ReportArn *string `locationName:"reportArn" min:"1" type:"string" required:"true"`
+
+	// Specifies how the results are sorted. Possible values are:
+	//
+	// FILE_PATH
+	//
+	// The results are sorted by file path.
+	//
+	// LINE_COVERAGE_PERCENTAGE
+	//
+	// The results are sorted by the percentage of lines that are covered.
+	SortBy *string `locationName:"sortBy" type:"string" enum:"ReportCodeCoverageSortByType"`
+
+	// Specifies if the results are sorted in ascending or descending order.
+	SortOrder *string `locationName:"sortOrder" type:"string" enum:"SortOrderType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeCodeCoveragesInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeCodeCoveragesInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DescribeCodeCoveragesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeCodeCoveragesInput"}
+	if s.MaxResults != nil && *s.MaxResults < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+	}
+	if s.ReportArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ReportArn"))
+	}
+	if s.ReportArn != nil && len(*s.ReportArn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ReportArn", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetMaxLineCoveragePercentage sets the MaxLineCoveragePercentage field's value.
+func (s *DescribeCodeCoveragesInput) SetMaxLineCoveragePercentage(v float64) *DescribeCodeCoveragesInput {
+	s.MaxLineCoveragePercentage = &v
+	return s
+}
+
+// SetMaxResults sets the MaxResults field's value.
+func (s *DescribeCodeCoveragesInput) SetMaxResults(v int64) *DescribeCodeCoveragesInput {
+	s.MaxResults = &v
+	return s
+}
+
+// SetMinLineCoveragePercentage sets the MinLineCoveragePercentage field's value.
+func (s *DescribeCodeCoveragesInput) SetMinLineCoveragePercentage(v float64) *DescribeCodeCoveragesInput {
+	s.MinLineCoveragePercentage = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeCodeCoveragesInput) SetNextToken(v string) *DescribeCodeCoveragesInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetReportArn sets the ReportArn field's value.
+func (s *DescribeCodeCoveragesInput) SetReportArn(v string) *DescribeCodeCoveragesInput {
+	s.ReportArn = &v
+	return s
+}
+
+// SetSortBy sets the SortBy field's value.
+func (s *DescribeCodeCoveragesInput) SetSortBy(v string) *DescribeCodeCoveragesInput {
+	s.SortBy = &v
+	return s
+}
+
+// SetSortOrder sets the SortOrder field's value.
+func (s *DescribeCodeCoveragesInput) SetSortOrder(v string) *DescribeCodeCoveragesInput {
+	s.SortOrder = &v
+	return s
+}
+
+type DescribeCodeCoveragesOutput struct {
+	_ struct{} `type:"structure"`
+
+	// An array of CodeCoverage objects that contain the results.
+	CodeCoverages []*CodeCoverage `locationName:"codeCoverages" type:"list"`
+
+	// If there are more items to return, this contains a token that is passed to
+	// a subsequent call to DescribeCodeCoverages to retrieve the next set of items.
+	NextToken *string `locationName:"nextToken" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeCodeCoveragesOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeCodeCoveragesOutput) GoString() string {
+	return s.String()
+}
+
+// SetCodeCoverages sets the CodeCoverages field's value.
+func (s *DescribeCodeCoveragesOutput) SetCodeCoverages(v []*CodeCoverage) *DescribeCodeCoveragesOutput {
+	s.CodeCoverages = v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeCodeCoveragesOutput) SetNextToken(v string) *DescribeCodeCoveragesOutput {
+	s.NextToken = &v
+	return s
+}
+
+type DescribeTestCasesInput struct {
+	_ struct{} `type:"structure"`
+
+	// A TestCaseFilter object used to filter the returned reports.
+	Filter *TestCaseFilter `locationName:"filter" type:"structure"`
+
+	// The maximum number of paginated test cases returned per response. Use nextToken
+	// to iterate pages in the list of returned TestCase objects. The default value
+	// is 100.
+	MaxResults *int64 `locationName:"maxResults" min:"1" type:"integer"`
+
+	// During a previous call, the maximum number of items that can be returned
+	// is the value specified in maxResults. If there more items in the list, then
+	// a unique string called a nextToken is returned. To get the next batch of
+	// items in the list, call this operation again, adding the next token to the
+	// call. To get all of the items in the list, keep calling this operation with
+	// each subsequent next token that is returned, until no more next tokens are
+	// returned.
+	NextToken *string `locationName:"nextToken" type:"string"`
+
+	// The ARN of the report for which test cases are returned.
+	//
+	// ReportArn is a required field
+	ReportArn *string `locationName:"reportArn" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeTestCasesInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeTestCasesInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DescribeTestCasesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeTestCasesInput"}
+	if s.MaxResults != nil && *s.MaxResults < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+	}
+	if s.ReportArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ReportArn"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetFilter sets the Filter field's value.
+func (s *DescribeTestCasesInput) SetFilter(v *TestCaseFilter) *DescribeTestCasesInput {
+	s.Filter = v
+	return s
+}
+
+// SetMaxResults sets the MaxResults field's value.
+func (s *DescribeTestCasesInput) SetMaxResults(v int64) *DescribeTestCasesInput {
+	s.MaxResults = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeTestCasesInput) SetNextToken(v string) *DescribeTestCasesInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetReportArn sets the ReportArn field's value.
+func (s *DescribeTestCasesInput) SetReportArn(v string) *DescribeTestCasesInput {
+	s.ReportArn = &v
+	return s
+}
+
+type DescribeTestCasesOutput struct {
+	_ struct{} `type:"structure"`
+
+	// During a previous call, the maximum number of items that can be returned
+	// is the value specified in maxResults. If there more items in the list, then
+	// a unique string called a nextToken is returned. To get the next batch of
+	// items in the list, call this operation again, adding the next token to the
+	// call. To get all of the items in the list, keep calling this operation with
+	// each subsequent next token that is returned, until no more next tokens are
+	// returned.
+	NextToken *string `locationName:"nextToken" type:"string"`
+
+	// The returned list of test cases.
+	TestCases []*TestCase `locationName:"testCases" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeTestCasesOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeTestCasesOutput) GoString() string {
+	return s.String()
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeTestCasesOutput) SetNextToken(v string) *DescribeTestCasesOutput {
+	s.NextToken = &v
+	return s
+}
+
+// SetTestCases sets the TestCases field's value.
+func (s *DescribeTestCasesOutput) SetTestCases(v []*TestCase) *DescribeTestCasesOutput {
+	s.TestCases = v
+	return s
+}
+
+// Information about a Docker image that is managed by CodeBuild.
+type EnvironmentImage struct {
+	_ struct{} `type:"structure"`
+
+	// The description of the Docker image.
+	Description *string `locationName:"description" type:"string"`
+
+	// The name of the Docker image.
+	Name *string `locationName:"name" type:"string"`
+
+	// A list of environment image versions.
+	Versions []*string `locationName:"versions" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnvironmentImage) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnvironmentImage) GoString() string {
+	return s.String()
+}
+
+// SetDescription sets the Description field's value.
+func (s *EnvironmentImage) SetDescription(v string) *EnvironmentImage {
+	s.Description = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *EnvironmentImage) SetName(v string) *EnvironmentImage {
+	s.Name = &v
+	return s
+}
+
+// SetVersions sets the Versions field's value.
+func (s *EnvironmentImage) SetVersions(v []*string) *EnvironmentImage {
+	s.Versions = v
+	return s
+}
+
+// A set of Docker images that are related by programming language and are managed
+// by CodeBuild.
+type EnvironmentLanguage struct {
+	_ struct{} `type:"structure"`
+
+	// The list of Docker images that are related by the specified programming language.
+	Images []*EnvironmentImage `locationName:"images" type:"list"`
+
+	// The programming language for the Docker images.
+	Language *string `locationName:"language" type:"string" enum:"LanguageType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnvironmentLanguage) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnvironmentLanguage) GoString() string {
+	return s.String()
+}
+
+// SetImages sets the Images field's value.
+func (s *EnvironmentLanguage) SetImages(v []*EnvironmentImage) *EnvironmentLanguage {
+	s.Images = v
+	return s
+}
+
+// SetLanguage sets the Language field's value.
+func (s *EnvironmentLanguage) SetLanguage(v string) *EnvironmentLanguage {
+	s.Language = &v
+	return s
+}
+
+// A set of Docker images that are related by platform and are managed by CodeBuild.
+type EnvironmentPlatform struct {
+	_ struct{} `type:"structure"`
+
+	// The list of programming languages that are available for the specified platform.
+	Languages []*EnvironmentLanguage `locationName:"languages" type:"list"`
+
+	// The platform's name.
+	Platform *string `locationName:"platform" type:"string" enum:"PlatformType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnvironmentPlatform) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnvironmentPlatform) GoString() string {
+	return s.String()
+}
+
+// SetLanguages sets the Languages field's value.
+func (s *EnvironmentPlatform) SetLanguages(v []*EnvironmentLanguage) *EnvironmentPlatform {
+	s.Languages = v
+	return s
+}
+
+// SetPlatform sets the Platform field's value.
+func (s *EnvironmentPlatform) SetPlatform(v string) *EnvironmentPlatform {
+	s.Platform = &v
+	return s
+}
+
+// Information about an environment variable for a build project or a build.
+type EnvironmentVariable struct {
+	_ struct{} `type:"structure"`
+
+	// The name or key of the environment variable.
+	//
+	// Name is a required field
+	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
+
+	// The type of environment variable. Valid values include:
+	//
+	//    * PARAMETER_STORE: An environment variable stored in Systems Manager Parameter
+	//    Store. To learn how to specify a parameter store environment variable,
+	//    see env/parameter-store (https://docs.aws.amazon.com/codebuild/latest/userguide/build-spec-ref.html#build-spec.env.parameter-store)
+	//    in the CodeBuild User Guide.
+	//
+	//    * PLAINTEXT: An environment variable in plain text format. This is the
+	//    default value.
+	//
+	//    * SECRETS_MANAGER: An environment variable stored in Secrets Manager.
+	//    To learn how to specify a secrets manager environment variable, see env/secrets-manager
+	//    (https://docs.aws.amazon.com/codebuild/latest/userguide/build-spec-ref.html#build-spec.env.secrets-manager)
+	//    in the CodeBuild User Guide.
+	Type *string `locationName:"type" type:"string" enum:"EnvironmentVariableType"`
+
+	// The value of the environment variable.
+	//
+	// We strongly discourage the use of PLAINTEXT environment variables to store
+	// sensitive values, especially Amazon Web Services secret key IDs and secret
+	// access keys. PLAINTEXT environment variables can be displayed in plain text
+	// using the CodeBuild console and the CLI. For sensitive values, we recommend
+	// you use an environment variable of type PARAMETER_STORE or SECRETS_MANAGER.
+	//
+	// Value is a required field
+	Value *string `locationName:"value" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnvironmentVariable) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnvironmentVariable) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *EnvironmentVariable) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnvironmentVariable"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+	if s.Value == nil {
+		invalidParams.Add(request.NewErrParamRequired("Value"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetName sets the Name field's value.
+func (s *EnvironmentVariable) SetName(v string) *EnvironmentVariable {
+	s.Name = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *EnvironmentVariable) SetType(v string) *EnvironmentVariable {
+	s.Type = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *EnvironmentVariable) SetValue(v string) *EnvironmentVariable {
+	s.Value = &v
+	return s
+}
+
+// Contains information about an exported environment variable.
+//
+// Exported environment variables are used in conjunction with CodePipeline
+// to export environment variables from the current build stage to subsequent
+// stages in the pipeline. For more information, see Working with variables
+// (https://docs.aws.amazon.com/codepipeline/latest/userguide/actions-variables.html)
+// in the CodePipeline User Guide.
+//
+// During a build, the value of a variable is available starting with the install
+// phase. It can be updated between the start of the install phase and the end
+// of the post_build phase. After the post_build phase ends, the value of exported
+// variables cannot change.
+type ExportedEnvironmentVariable struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the exported environment variable.
+	Name *string `locationName:"name" min:"1" type:"string"`
+
+	// The value assigned to the exported environment variable.
+	Value *string `locationName:"value" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ExportedEnvironmentVariable) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ExportedEnvironmentVariable) GoString() string {
+	return s.String()
+}
+
+// SetName sets the Name field's value.
+func (s *ExportedEnvironmentVariable) SetName(v string) *ExportedEnvironmentVariable {
+	s.Name = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *ExportedEnvironmentVariable) SetValue(v string) *ExportedEnvironmentVariable {
+	s.Value = &v
+	return s
+}
+
+type GetReportGroupTrendInput struct {
+	_ struct{} `type:"structure"`
+
+	// The number of reports to analyze. This operation always retrieves the most
+	// recent reports.
+	//
+	// If this parameter is omitted, the most recent 100 reports are analyzed.
+	NumOfReports *int64 `locationName:"numOfReports" min:"1" type:"integer"`
+
+	// The ARN of the report group that contains the reports to analyze.
+	//
+	// ReportGroupArn is a required field
+	ReportGroupArn *string `locationName:"reportGroupArn" min:"1" type:"string" required:"true"`
+
+	// The test report value to accumulate. This must be one of the following values:
+	//
+	// Test reports:
+	//
+	// DURATION
+	//
+	// Accumulate the test run times for the specified reports.
+	//
+	// PASS_RATE
+	//
+	// Accumulate the percentage of tests that passed for the specified test reports.
+	//
+	// TOTAL
+	//
+	// Accumulate the total number of tests for the specified test reports.
+	//
+	// Code coverage reports:
+	//
+	// BRANCH_COVERAGE
+	//
+	// Accumulate the branch coverage percentages for the specified test reports.
+	//
+	// BRANCHES_COVERED
+	//
+	// Accumulate the branches covered values for the specified test reports.
+	//
+	// BRANCHES_MISSED
+	//
+	// Accumulate the branches missed values for the specified test reports.
+	//
+	// LINE_COVERAGE
+	//
+	// Accumulate the line coverage percentages for the specified test reports.
+	//
+	// LINES_COVERED
+	//
+	// Accumulate the lines covered values for the specified test reports.
+	//
+	// LINES_MISSED
+	//
+	// Accumulate the lines not covered values for the specified test reports.
+	//
+	// TrendField is a required field
+	TrendField *string `locationName:"trendField" type:"string" required:"true" enum:"ReportGroupTrendFieldType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetReportGroupTrendInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetReportGroupTrendInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetReportGroupTrendInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetReportGroupTrendInput"}
+	if s.NumOfReports != nil && *s.NumOfReports < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("NumOfReports", 1))
+	}
+	if s.ReportGroupArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ReportGroupArn"))
+	}
+	if s.ReportGroupArn != nil && len(*s.ReportGroupArn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ReportGroupArn", 1))
+	}
+	if s.TrendField == nil {
+		invalidParams.Add(request.NewErrParamRequired("TrendField"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetNumOfReports sets the NumOfReports field's value.
+func (s *GetReportGroupTrendInput) SetNumOfReports(v int64) *GetReportGroupTrendInput {
+	s.NumOfReports = &v
+	return s
+}
+
+// SetReportGroupArn sets the ReportGroupArn field's value.
+func (s *GetReportGroupTrendInput) SetReportGroupArn(v string) *GetReportGroupTrendInput {
+	s.ReportGroupArn = &v
+	return s
+}
+
+// SetTrendField sets the TrendField field's value.
+func (s *GetReportGroupTrendInput) SetTrendField(v string) *GetReportGroupTrendInput {
+	s.TrendField = &v
+	return s
+}
+
+type GetReportGroupTrendOutput struct {
+	_ struct{} `type:"structure"`
+
+	// An array that contains the raw data for each report.
+	RawData []*ReportWithRawData `locationName:"rawData" type:"list"`
+
+	// Contains the accumulated trend data.
+	Stats *ReportGroupTrendStats `locationName:"stats" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetReportGroupTrendOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetReportGroupTrendOutput) GoString() string {
+	return s.String()
+}
+
+// SetRawData sets the RawData field's value.
+func (s *GetReportGroupTrendOutput) SetRawData(v []*ReportWithRawData) *GetReportGroupTrendOutput {
+	s.RawData = v
+	return s
+}
+
+// SetStats sets the Stats field's value.
+func (s *GetReportGroupTrendOutput) SetStats(v *ReportGroupTrendStats) *GetReportGroupTrendOutput {
+	s.Stats = v
+	return s
+}
+
+type GetResourcePolicyInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the resource that is associated with the resource policy.
+	//
+	// ResourceArn is a required field
+	ResourceArn *string `locationName:"resourceArn" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetResourcePolicyInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetResourcePolicyInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetResourcePolicyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetResourcePolicyInput"}
+	if s.ResourceArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceArn"))
+	}
+	if s.ResourceArn != nil && len(*s.ResourceArn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ResourceArn", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetResourceArn sets the ResourceArn field's value.
+func (s *GetResourcePolicyInput) SetResourceArn(v string) *GetResourcePolicyInput {
+	s.ResourceArn = &v
+	return s
+}
+
+type GetResourcePolicyOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The resource policy for the resource identified by the input ARN parameter.
+	Policy *string `locationName:"policy" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetResourcePolicyOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetResourcePolicyOutput) GoString() string {
+	return s.String()
+}
+
+// SetPolicy sets the Policy field's value.
+func (s *GetResourcePolicyOutput) SetPolicy(v string) *GetResourcePolicyOutput {
+	s.Policy = &v
+	return s
+}
+
+// Information about the Git submodules configuration for an CodeBuild build
+// project.
+type GitSubmodulesConfig struct {
+	_ struct{} `type:"structure"`
+
+	// Set to true to fetch Git submodules for your CodeBuild build project.
+	//
+	// FetchSubmodules is a required field
+	FetchSubmodules *bool `locationName:"fetchSubmodules" type:"boolean" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GitSubmodulesConfig) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GitSubmodulesConfig) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GitSubmodulesConfig) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GitSubmodulesConfig"}
+	if s.FetchSubmodules == nil {
+		invalidParams.Add(request.NewErrParamRequired("FetchSubmodules"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetFetchSubmodules sets the FetchSubmodules field's value.
+func (s *GitSubmodulesConfig) SetFetchSubmodules(v bool) *GitSubmodulesConfig {
+	s.FetchSubmodules = &v
+	return s
+}
+
+type ImportSourceCredentialsInput struct {
+	_ struct{} `type:"structure"`
+
+	// The type of authentication used to connect to a GitHub, GitHub Enterprise,
+	// or Bitbucket repository. An OAUTH connection is not supported by the API
+	// and must be created using the CodeBuild console.
+	//
+	// AuthType is a required field
+	AuthType *string `locationName:"authType" type:"string" required:"true" enum:"AuthType"`
+
+	// The source provider used for this project.
+	//
+	// ServerType is a required field
+	ServerType *string `locationName:"serverType" type:"string" required:"true" enum:"ServerType"`
+
+	// Set to false to prevent overwriting the repository source credentials. Set
+	// to true to overwrite the repository source credentials. The default value
+	// is true.
+	ShouldOverwrite *bool `locationName:"shouldOverwrite" type:"boolean"`
+
+	// For GitHub or GitHub Enterprise, this is the personal access token. For Bitbucket,
+	// this is the app password.
+	//
+	// Token is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by ImportSourceCredentialsInput's
+	// String and GoString methods.
+	//
+	// Token is a required field
+	Token *string `locationName:"token" min:"1" type:"string" required:"true" sensitive:"true"`
+
+	// The Bitbucket username when the authType is BASIC_AUTH. This parameter is
+	// not valid for other types of source providers or connections.
+	Username *string `locationName:"username" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ImportSourceCredentialsInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ImportSourceCredentialsInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ImportSourceCredentialsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ImportSourceCredentialsInput"}
+	if s.AuthType == nil {
+		invalidParams.Add(request.NewErrParamRequired("AuthType"))
+	}
+	if s.ServerType == nil {
+		invalidParams.Add(request.NewErrParamRequired("ServerType"))
+	}
+	if s.Token == nil {
+		invalidParams.Add(request.NewErrParamRequired("Token"))
+	}
+	if s.Token != nil && len(*s.Token) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Token", 1))
+	}
+	if s.Username != nil && len(*s.Username) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Username", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAuthType sets the AuthType field's value.
+func (s *ImportSourceCredentialsInput) SetAuthType(v string) *ImportSourceCredentialsInput {
+	s.AuthType = &v
+	return s
+}
+
+// SetServerType sets the ServerType field's value.
+func (s *ImportSourceCredentialsInput) SetServerType(v string) *ImportSourceCredentialsInput {
+	s.ServerType = &v
+	return s
+}
+
+// SetShouldOverwrite sets the ShouldOverwrite field's value.
+func (s *ImportSourceCredentialsInput) SetShouldOverwrite(v bool) *ImportSourceCredentialsInput {
+	s.ShouldOverwrite = &v
+	return s
+}
+
+// SetToken sets the Token field's value.
+func (s *ImportSourceCredentialsInput) SetToken(v string) *ImportSourceCredentialsInput {
+	s.Token = &v
+	return s
+}
+
+// SetUsername sets the Username field's value.
+func (s *ImportSourceCredentialsInput) SetUsername(v string) *ImportSourceCredentialsInput {
+	s.Username = &v
+	return s
+}
+
+type ImportSourceCredentialsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the token.
+	Arn *string `locationName:"arn" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ImportSourceCredentialsOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ImportSourceCredentialsOutput) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *ImportSourceCredentialsOutput) SetArn(v string) *ImportSourceCredentialsOutput {
+	s.Arn = &v
+	return s
+}
+
+// The input value that was provided is not valid.
+type InvalidInputException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidInputException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidInputException) GoString() string {
+	return s.String()
+}
+
+func newErrorInvalidInputException(v protocol.ResponseMetadata) error {
+	return &InvalidInputException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InvalidInputException) Code() string {
+	return "InvalidInputException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidInputException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidInputException) OrigErr() error {
+	return nil
+}
+
+func (s *InvalidInputException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidInputException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidInputException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type InvalidateProjectCacheInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the CodeBuild build project that the cache is reset for.
+	//
+	// ProjectName is a required field
+	ProjectName *string `locationName:"projectName" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidateProjectCacheInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidateProjectCacheInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *InvalidateProjectCacheInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "InvalidateProjectCacheInput"}
+	if s.ProjectName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ProjectName"))
+	}
+	if s.ProjectName != nil && len(*s.ProjectName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ProjectName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetProjectName sets the ProjectName field's value.
+func (s *InvalidateProjectCacheInput) SetProjectName(v string) *InvalidateProjectCacheInput {
+	s.ProjectName = &v
+	return s
+}
+
+type InvalidateProjectCacheOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidateProjectCacheOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidateProjectCacheOutput) GoString() string {
+	return s.String()
+}
+
+type ListBuildBatchesForProjectInput struct {
+	_ struct{} `type:"structure"`
+
+	// A BuildBatchFilter object that specifies the filters for the search.
+	Filter *BuildBatchFilter `locationName:"filter" type:"structure"`
+
+	// The maximum number of results to return.
+	MaxResults *int64 `locationName:"maxResults" min:"1" type:"integer"`
+
+	// The nextToken value returned from a previous call to ListBuildBatchesForProject.
+	// This specifies the next item to return. To return the beginning of the list,
+	// exclude this parameter.
+	NextToken *string `locationName:"nextToken" type:"string"`
+
+	// The name of the project.
+	ProjectName *string `locationName:"projectName" min:"1" type:"string"`
+
+	// Specifies the sort order of the returned items. Valid values include:
+	//
+	//    * ASCENDING: List the batch build identifiers in ascending order by identifier.
+	//
+	//    * DESCENDING: List the batch build identifiers in descending order by
+	//    identifier.
+	SortOrder *string `locationName:"sortOrder" type:"string" enum:"SortOrderType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListBuildBatchesForProjectInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListBuildBatchesForProjectInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListBuildBatchesForProjectInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListBuildBatchesForProjectInput"}
+	if s.MaxResults != nil && *s.MaxResults < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+	}
+	if s.ProjectName != nil && len(*s.ProjectName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ProjectName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetFilter sets the Filter field's value.
+func (s *ListBuildBatchesForProjectInput) SetFilter(v *BuildBatchFilter) *ListBuildBatchesForProjectInput {
+	s.Filter = v
+	return s
+}
+
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListBuildBatchesForProjectInput) SetMaxResults(v int64) *ListBuildBatchesForProjectInput {
+	s.MaxResults = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListBuildBatchesForProjectInput) SetNextToken(v string) *ListBuildBatchesForProjectInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetProjectName sets the ProjectName field's value.
+func (s *ListBuildBatchesForProjectInput) SetProjectName(v string) *ListBuildBatchesForProjectInput {
+	s.ProjectName = &v
+	return s
+}
+
+// SetSortOrder sets the SortOrder field's value.
+func (s *ListBuildBatchesForProjectInput) SetSortOrder(v string) *ListBuildBatchesForProjectInput {
+	s.SortOrder = &v
+	return s
+}
+
+type ListBuildBatchesForProjectOutput struct {
+	_ struct{} `type:"structure"`
+
+	// An array of strings that contains the batch build identifiers.
+	Ids []*string `locationName:"ids" type:"list"`
+
+	// If there are more items to return, this contains a token that is passed to
+	// a subsequent call to ListBuildBatchesForProject to retrieve the next set
+	// of items.
+	NextToken *string `locationName:"nextToken" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListBuildBatchesForProjectOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListBuildBatchesForProjectOutput) GoString() string {
+	return s.String()
+}
+
+// SetIds sets the Ids field's value.
+func (s *ListBuildBatchesForProjectOutput) SetIds(v []*string) *ListBuildBatchesForProjectOutput {
+	s.Ids = v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListBuildBatchesForProjectOutput) SetNextToken(v string) *ListBuildBatchesForProjectOutput {
+	s.NextToken = &v
+	return s
+}
+
+type ListBuildBatchesInput struct {
+	_ struct{} `type:"structure"`
+
+	// A BuildBatchFilter object that specifies the filters for the search.
+	Filter *BuildBatchFilter `locationName:"filter" type:"structure"`
+
+	// The maximum number of results to return.
+	MaxResults *int64 `locationName:"maxResults" min:"1" type:"integer"`
+
+	// The nextToken value returned from a previous call to ListBuildBatches. This
+	// specifies the next item to return. To return the beginning of the list, exclude
+	// this parameter.
+	NextToken *string `locationName:"nextToken" type:"string"`
+
+	// Specifies the sort order of the returned items. Valid values include:
+	//
+	//    * ASCENDING: List the batch build identifiers in ascending order by identifier.
+	//
+	//    * DESCENDING: List the batch build identifiers in descending order by
+	//    identifier.
+	SortOrder *string `locationName:"sortOrder" type:"string" enum:"SortOrderType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListBuildBatchesInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListBuildBatchesInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListBuildBatchesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListBuildBatchesInput"}
+	if s.MaxResults != nil && *s.MaxResults < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetFilter sets the Filter field's value.
+func (s *ListBuildBatchesInput) SetFilter(v *BuildBatchFilter) *ListBuildBatchesInput {
+	s.Filter = v
+	return s
+}
+
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListBuildBatchesInput) SetMaxResults(v int64) *ListBuildBatchesInput {
+	s.MaxResults = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListBuildBatchesInput) SetNextToken(v string) *ListBuildBatchesInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetSortOrder sets the SortOrder field's value.
+func (s *ListBuildBatchesInput) SetSortOrder(v string) *ListBuildBatchesInput {
+	s.SortOrder = &v
+	return s
+}
+
+type ListBuildBatchesOutput struct {
+	_ struct{} `type:"structure"`
+
+	// An array of strings that contains the batch build identifiers.
+	Ids []*string `locationName:"ids" type:"list"`
+
+	// If there are more items to return, this contains a token that is passed to
+	// a subsequent call to ListBuildBatches to retrieve the next set of items.
+	NextToken *string `locationName:"nextToken" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListBuildBatchesOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListBuildBatchesOutput) GoString() string {
+	return s.String()
+}
+
+// SetIds sets the Ids field's value.
+func (s *ListBuildBatchesOutput) SetIds(v []*string) *ListBuildBatchesOutput {
+	s.Ids = v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListBuildBatchesOutput) SetNextToken(v string) *ListBuildBatchesOutput {
+	s.NextToken = &v
+	return s
+}
+
+type ListBuildsForProjectInput struct {
+	_ struct{} `type:"structure"`
+
+	// During a previous call, if there are more than 100 items in the list, only
+	// the first 100 items are returned, along with a unique string called a nextToken.
+	// To get the next batch of items in the list, call this operation again, adding
+	// the next token to the call. To get all of the items in the list, keep calling
+	// this operation with each subsequent next token that is returned, until no
+	// more next tokens are returned.
+	NextToken *string `locationName:"nextToken" type:"string"`
+
+	// The name of the CodeBuild project.
+	//
+	// ProjectName is a required field
+	ProjectName *string `locationName:"projectName" min:"1" type:"string" required:"true"`
+
+	// The order to sort the results in. The results are sorted by build number,
+	// not the build identifier. If this is not specified, the results are sorted
+	// in descending order.
+	//
+	// Valid values include:
+	//
+	//    * ASCENDING: List the build identifiers in ascending order, by build number.
+	//
+	//    * DESCENDING: List the build identifiers in descending order, by build
+	//    number.
+	//
+	// If the project has more than 100 builds, setting the sort order will result
+	// in an error.
+	SortOrder *string `locationName:"sortOrder" type:"string" enum:"SortOrderType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListBuildsForProjectInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListBuildsForProjectInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListBuildsForProjectInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListBuildsForProjectInput"}
+	if s.ProjectName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ProjectName"))
+	}
+	if s.ProjectName != nil && len(*s.ProjectName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ProjectName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListBuildsForProjectInput) SetNextToken(v string) *ListBuildsForProjectInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetProjectName sets the ProjectName field's value.
+func (s *ListBuildsForProjectInput) SetProjectName(v string) *ListBuildsForProjectInput {
+	s.ProjectName = &v
+	return s
+}
+
+// SetSortOrder sets the SortOrder field's value.
+func (s *ListBuildsForProjectInput) SetSortOrder(v string) *ListBuildsForProjectInput {
+	s.SortOrder = &v
+	return s
+}
+
+type ListBuildsForProjectOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A list of build identifiers for the specified build project, with each build
+	// ID representing a single build.
+	Ids []*string `locationName:"ids" min:"1" type:"list"`
+
+	// If there are more than 100 items in the list, only the first 100 items are
+	// returned, along with a unique string called a nextToken. To get the next
+	// batch of items in the list, call this operation again, adding the next token
+	// to the call.
+	NextToken *string `locationName:"nextToken" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListBuildsForProjectOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListBuildsForProjectOutput) GoString() string {
+	return s.String()
+}
+
+// SetIds sets the Ids field's value.
+func (s *ListBuildsForProjectOutput) SetIds(v []*string) *ListBuildsForProjectOutput {
+	s.Ids = v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListBuildsForProjectOutput) SetNextToken(v string) *ListBuildsForProjectOutput {
+	s.NextToken = &v
+	return s
+}
+
+type ListBuildsInput struct {
+	_ struct{} `type:"structure"`
+
+	// During a previous call, if there are more than 100 items in the list, only
+	// the first 100 items are returned, along with a unique string called a nextToken.
+	// To get the next batch of items in the list, call this operation again, adding
+	// the next token to the call. To get all of the items in the list, keep calling
+	// this operation with each subsequent next token that is returned, until no
+	// more next tokens are returned.
+	NextToken *string `locationName:"nextToken" type:"string"`
+
+	// The order to list build IDs. Valid values include:
+	//
+	//    * ASCENDING: List the build IDs in ascending order by build ID.
+	//
+	//    * DESCENDING: List the build IDs in descending order by build ID.
+	SortOrder *string `locationName:"sortOrder" type:"string" enum:"SortOrderType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListBuildsInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListBuildsInput) GoString() string {
+	return s.String()
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListBuildsInput) SetNextToken(v string) *ListBuildsInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetSortOrder sets the SortOrder field's value.
+func (s *ListBuildsInput) SetSortOrder(v string) *ListBuildsInput {
+	s.SortOrder = &v
+	return s
+}
+
+type ListBuildsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A list of build IDs, with each build ID representing a single build.
+	Ids []*string `locationName:"ids" min:"1" type:"list"`
+
+	// If there are more than 100 items in the list, only the first 100 items are
+	// returned, along with a unique string called a nextToken. To get the next
+	// batch of items in the list, call this operation again, adding the next token
+	// to the call.
+	NextToken *string `locationName:"nextToken" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListBuildsOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListBuildsOutput) GoString() string {
+	return s.String()
+}
+
+// SetIds sets the Ids field's value.
+func (s *ListBuildsOutput) SetIds(v []*string) *ListBuildsOutput {
+	s.Ids = v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListBuildsOutput) SetNextToken(v string) *ListBuildsOutput {
+	s.NextToken = &v
+	return s
+}
+
+type ListCuratedEnvironmentImagesInput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCuratedEnvironmentImagesInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCuratedEnvironmentImagesInput) GoString() string {
+	return s.String()
+}
+
+type ListCuratedEnvironmentImagesOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Information about supported platforms for Docker images that are managed
+	// by CodeBuild.
+	Platforms []*EnvironmentPlatform `locationName:"platforms" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCuratedEnvironmentImagesOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCuratedEnvironmentImagesOutput) GoString() string {
+	return s.String()
+}
+
+// SetPlatforms sets the Platforms field's value.
+func (s *ListCuratedEnvironmentImagesOutput) SetPlatforms(v []*EnvironmentPlatform) *ListCuratedEnvironmentImagesOutput {
+	s.Platforms = v
+	return s
+}
+
+type ListProjectsInput struct {
+	_ struct{} `type:"structure"`
+
+	// During a previous call, if there are more than 100 items in the list, only
+	// the first 100 items are returned, along with a unique string called a nextToken.
+	// To get the next batch of items in the list, call this operation again, adding
+	// the next token to the call. To get all of the items in the list, keep calling
+	// this operation with each subsequent next token that is returned, until no
+	// more next tokens are returned.
+	NextToken *string `locationName:"nextToken" min:"1" type:"string"`
+
+	// The criterion to be used to list build project names. Valid values include:
+	//
+	//    * CREATED_TIME: List based on when each build project was created.
+	//
+	//    * LAST_MODIFIED_TIME: List based on when information about each build
+	//    project was last changed.
+	//
+	//    * NAME: List based on each build project's name.
+	//
+	// Use sortOrder to specify in what order to list the build project names based
+	// on the preceding criteria.
+	SortBy *string `locationName:"sortBy" type:"string" enum:"ProjectSortByType"`
+
+	// The order in which to list build projects. Valid values include:
+	//
+	//    * ASCENDING: List in ascending order.
+	//
+	//    * DESCENDING: List in descending order.
+	//
+	// Use sortBy to specify the criterion to be used to list build project names.
+	SortOrder *string `locationName:"sortOrder" type:"string" enum:"SortOrderType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListProjectsInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListProjectsInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListProjectsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListProjectsInput"}
+	if s.NextToken != nil && len(*s.NextToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListProjectsInput) SetNextToken(v string) *ListProjectsInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetSortBy sets the SortBy field's value.
+func (s *ListProjectsInput) SetSortBy(v string) *ListProjectsInput {
+	s.SortBy = &v
+	return s
+}
+
+// SetSortOrder sets the SortOrder field's value.
+func (s *ListProjectsInput) SetSortOrder(v string) *ListProjectsInput {
+	s.SortOrder = &v
+	return s
+}
+
+type ListProjectsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// If there are more than 100 items in the list, only the first 100 items are
+	// returned, along with a unique string called a nextToken. To get the next
+	// batch of items in the list, call this operation again, adding the next token
+	// to the call.
+	NextToken *string `locationName:"nextToken" type:"string"`
+
+	// The list of build project names, with each build project name representing
+	// a single build project.
+	Projects []*string `locationName:"projects" min:"1" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListProjectsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s BatchGetBuildsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListProjectsOutput) GoString() string {
+	return s.String()
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListProjectsOutput) SetNextToken(v string) *ListProjectsOutput {
+	s.NextToken = &v
+	return s
+}
+
+// SetProjects sets the Projects field's value.
+func (s *ListProjectsOutput) SetProjects(v []*string) *ListProjectsOutput {
+	s.Projects = v
+	return s
+}
+
+type ListReportGroupsInput struct {
+	_ struct{} `type:"structure"`
+
+	// The maximum number of paginated report groups returned per response. Use
+	// nextToken to iterate pages in the list of returned ReportGroup objects. The
+	// default value is 100.
+	MaxResults *int64 `locationName:"maxResults" min:"1" type:"integer"`
+
+	// During a previous call, the maximum number of items that can be returned
+	// is the value specified in maxResults. If there more items in the list, then
+	// a unique string called a nextToken is returned. To get the next batch of
+	// items in the list, call this operation again, adding the next token to the
+	// call. To get all of the items in the list, keep calling this operation with
+	// each subsequent next token that is returned, until no more next tokens are
+	// returned.
+	NextToken *string `locationName:"nextToken" type:"string"`
+
+	// The criterion to be used to list build report groups. Valid values include:
+	//
+	//    * CREATED_TIME: List based on when each report group was created.
+	//
+	//    * LAST_MODIFIED_TIME: List based on when each report group was last changed.
+	//
+	//    * NAME: List based on each report group's name.
+	SortBy *string `locationName:"sortBy" type:"string" enum:"ReportGroupSortByType"`
+
+	// Used to specify the order to sort the list of returned report groups. Valid
+	// values are ASCENDING and DESCENDING.
+	SortOrder *string `locationName:"sortOrder" type:"string" enum:"SortOrderType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListReportGroupsInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListReportGroupsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *BatchGetBuildsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "BatchGetBuildsInput"}
-	if s.Ids == nil {
-		invalidParams.Add(request.NewErrParamRequired("Ids"))
-	}
-	if s.Ids != nil && len(s.Ids) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Ids", 1))
+func (s *ListReportGroupsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListReportGroupsInput"}
+	if s.MaxResults != nil && *s.MaxResults < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -1690,71 +9856,133 @@ func (s *BatchGetBuildsInput) Validate() error {
 	return nil
 }
 
-// SetIds sets the Ids field's value.
-func (s *BatchGetBuildsInput) SetIds(v []*string) *BatchGetBuildsInput {
-	s.Ids = v
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListReportGroupsInput) SetMaxResults(v int64) *ListReportGroupsInput {
+	s.MaxResults = &v
 	return s
 }
 
-type BatchGetBuildsOutput struct {
+// SetNextToken sets the NextToken field's value.
+func (s *ListReportGroupsInput) SetNextToken(v string) *ListReportGroupsInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetSortBy sets the SortBy field's value.
+func (s *ListReportGroupsInput) SetSortBy(v string) *ListReportGroupsInput {
+	s.SortBy = &v
+	return s
+}
+
+// SetSortOrder sets the SortOrder field's value.
+func (s *ListReportGroupsInput) SetSortOrder(v string) *ListReportGroupsInput {
+	s.SortOrder = &v
+	return s
+}
+
+type ListReportGroupsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Information about the requested builds.
-	Builds []*Build `locationName:"builds" type:"list"`
+	// During a previous call, the maximum number of items that can be returned
+	// is the value specified in maxResults. If there more items in the list, then
+	// a unique string called a nextToken is returned. To get the next batch of
+	// items in the list, call this operation again, adding the next token to the
+	// call. To get all of the items in the list, keep calling this operation with
+	// each subsequent next token that is returned, until no more next tokens are
+	// returned.
+	NextToken *string `locationName:"nextToken" type:"string"`
 
-	// The IDs of builds for which information could not be found.
-	BuildsNotFound []*string `locationName:"buildsNotFound" min:"1" type:"list"`
+	// The list of ARNs for the report groups in the current Amazon Web Services
+	// account.
+	ReportGroups []*string `locationName:"reportGroups" min:"1" type:"list"`
 }
 
-// String returns the string representation
-func (s BatchGetBuildsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListReportGroupsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s BatchGetBuildsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListReportGroupsOutput) GoString() string {
 	return s.String()
 }
 
-// SetBuilds sets the Builds field's value.
-func (s *BatchGetBuildsOutput) SetBuilds(v []*Build) *BatchGetBuildsOutput {
-	s.Builds = v
+// SetNextToken sets the NextToken field's value.
+func (s *ListReportGroupsOutput) SetNextToken(v string) *ListReportGroupsOutput {
+	s.NextToken = &v
 	return s
 }
 
-// SetBuildsNotFound sets the BuildsNotFound field's value.
-func (s *BatchGetBuildsOutput) SetBuildsNotFound(v []*string) *BatchGetBuildsOutput {
-	s.BuildsNotFound = v
+// SetReportGroups sets the ReportGroups field's value.
+func (s *ListReportGroupsOutput) SetReportGroups(v []*string) *ListReportGroupsOutput {
+	s.ReportGroups = v
 	return s
 }
 
-type BatchGetProjectsInput struct {
+type ListReportsForReportGroupInput struct {
 	_ struct{} `type:"structure"`
 
-	// The names of the build projects.
+	// A ReportFilter object used to filter the returned reports.
+	Filter *ReportFilter `locationName:"filter" type:"structure"`
+
+	// The maximum number of paginated reports in this report group returned per
+	// response. Use nextToken to iterate pages in the list of returned Report objects.
+	// The default value is 100.
+	MaxResults *int64 `locationName:"maxResults" min:"1" type:"integer"`
+
+	// During a previous call, the maximum number of items that can be returned
+	// is the value specified in maxResults. If there more items in the list, then
+	// a unique string called a nextToken is returned. To get the next batch of
+	// items in the list, call this operation again, adding the next token to the
+	// call. To get all of the items in the list, keep calling this operation with
+	// each subsequent next token that is returned, until no more next tokens are
+	// returned.
+	NextToken *string `locationName:"nextToken" type:"string"`
+
+	// The ARN of the report group for which you want to return report ARNs.
 	//
-	// Names is a required field
-	Names []*string `locationName:"names" min:"1" type:"list" required:"true"`
+	// ReportGroupArn is a required field
+	ReportGroupArn *string `locationName:"reportGroupArn" type:"string" required:"true"`
+
+	// Use to specify whether the results are returned in ascending or descending
+	// order.
+	SortOrder *string `locationName:"sortOrder" type:"string" enum:"SortOrderType"`
 }
 
-// String returns the string representation
-func (s BatchGetProjectsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListReportsForReportGroupInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s BatchGetProjectsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListReportsForReportGroupInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *BatchGetProjectsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "BatchGetProjectsInput"}
-	if s.Names == nil {
-		invalidParams.Add(request.NewErrParamRequired("Names"))
+func (s *ListReportsForReportGroupInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListReportsForReportGroupInput"}
+	if s.MaxResults != nil && *s.MaxResults < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
 	}
-	if s.Names != nil && len(s.Names) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Names", 1))
+	if s.ReportGroupArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ReportGroupArn"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -1763,720 +9991,960 @@ func (s *BatchGetProjectsInput) Validate() error {
 	return nil
 }
 
-// SetNames sets the Names field's value.
-func (s *BatchGetProjectsInput) SetNames(v []*string) *BatchGetProjectsInput {
-	s.Names = v
+// SetFilter sets the Filter field's value.
+func (s *ListReportsForReportGroupInput) SetFilter(v *ReportFilter) *ListReportsForReportGroupInput {
+	s.Filter = v
 	return s
 }
 
-type BatchGetProjectsOutput struct {
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListReportsForReportGroupInput) SetMaxResults(v int64) *ListReportsForReportGroupInput {
+	s.MaxResults = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListReportsForReportGroupInput) SetNextToken(v string) *ListReportsForReportGroupInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetReportGroupArn sets the ReportGroupArn field's value.
+func (s *ListReportsForReportGroupInput) SetReportGroupArn(v string) *ListReportsForReportGroupInput {
+	s.ReportGroupArn = &v
+	return s
+}
+
+// SetSortOrder sets the SortOrder field's value.
+func (s *ListReportsForReportGroupInput) SetSortOrder(v string) *ListReportsForReportGroupInput {
+	s.SortOrder = &v
+	return s
+}
+
+type ListReportsForReportGroupOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Information about the requested build projects.
-	Projects []*Project `locationName:"projects" type:"list"`
+	// During a previous call, the maximum number of items that can be returned
+	// is the value specified in maxResults. If there more items in the list, then
+	// a unique string called a nextToken is returned. To get the next batch of
+	// items in the list, call this operation again, adding the next token to the
+	// call. To get all of the items in the list, keep calling this operation with
+	// each subsequent next token that is returned, until no more next tokens are
+	// returned.
+	NextToken *string `locationName:"nextToken" type:"string"`
 
-	// The names of build projects for which information could not be found.
-	ProjectsNotFound []*string `locationName:"projectsNotFound" min:"1" type:"list"`
+	// The list of report ARNs.
+	Reports []*string `locationName:"reports" min:"1" type:"list"`
 }
 
-// String returns the string representation
-func (s BatchGetProjectsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListReportsForReportGroupOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s BatchGetProjectsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListReportsForReportGroupOutput) GoString() string {
 	return s.String()
 }
 
-// SetProjects sets the Projects field's value.
-func (s *BatchGetProjectsOutput) SetProjects(v []*Project) *BatchGetProjectsOutput {
-	s.Projects = v
+// SetNextToken sets the NextToken field's value.
+func (s *ListReportsForReportGroupOutput) SetNextToken(v string) *ListReportsForReportGroupOutput {
+	s.NextToken = &v
 	return s
 }
 
-// SetProjectsNotFound sets the ProjectsNotFound field's value.
-func (s *BatchGetProjectsOutput) SetProjectsNotFound(v []*string) *BatchGetProjectsOutput {
-	s.ProjectsNotFound = v
+// SetReports sets the Reports field's value.
+func (s *ListReportsForReportGroupOutput) SetReports(v []*string) *ListReportsForReportGroupOutput {
+	s.Reports = v
 	return s
 }
 
-// Information about a build.
-type Build struct {
+type ListReportsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the build.
-	Arn *string `locationName:"arn" min:"1" type:"string"`
-
-	// Information about the output artifacts for the build.
-	Artifacts *BuildArtifacts `locationName:"artifacts" type:"structure"`
-
-	// Whether the build is complete. True if complete; otherwise, false.
-	BuildComplete *bool `locationName:"buildComplete" type:"boolean"`
+	// A ReportFilter object used to filter the returned reports.
+	Filter *ReportFilter `locationName:"filter" type:"structure"`
+
+	// The maximum number of paginated reports returned per response. Use nextToken
+	// to iterate pages in the list of returned Report objects. The default value
+	// is 100.
+	MaxResults *int64 `locationName:"maxResults" min:"1" type:"integer"`
+
+	// During a previous call, the maximum number of items that can be returned
+	// is the value specified in maxResults. If there more items in the list, then
+	// a unique string called a nextToken is returned. To get the next batch of
+	// items in the list, call this operation again, adding the next token to the
+	// call. To get all of the items in the list, keep calling this operation with
+	// each subsequent next token that is returned, until no more next tokens are
+	// returned.
+	NextToken *string `locationName:"nextToken" type:"string"`
 
-	// The current status of the build. Valid values include:
-	//
-	//    * FAILED: The build failed.
-	//
-	//    * FAULT: The build faulted.
-	//
-	//    * IN_PROGRESS: The build is still in progress.
-	//
-	//    * STOPPED: The build stopped.
+	// Specifies the sort order for the list of returned reports. Valid values are:
 	//
-	//    * SUCCEEDED: The build succeeded.
+	//    * ASCENDING: return reports in chronological order based on their creation
+	//    date.
 	//
-	//    * TIMED_OUT: The build timed out.
-	BuildStatus *string `locationName:"buildStatus" type:"string" enum:"StatusType"`
+	//    * DESCENDING: return reports in the reverse chronological order based
+	//    on their creation date.
+	SortOrder *string `locationName:"sortOrder" type:"string" enum:"SortOrderType"`
+}
 
-	// Information about the cache for the build.
-	Cache *ProjectCache `locationName:"cache" type:"structure"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListReportsInput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// The current build phase.
-	CurrentPhase *string `locationName:"currentPhase" type:"string"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListReportsInput) GoString() string {
+	return s.String()
+}
 
-	// The AWS Key Management Service (AWS KMS) customer master key (CMK) to be
-	// used for encrypting the build output artifacts.
-	//
-	// You can use a cross-account KMS key to encrypt the build output artifacts
-	// if your service role has permission to that key.
-	//
-	// You can specify either the Amazon Resource Name (ARN) of the CMK or, if available,
-	// the CMK's alias (using the format alias/alias-name ).
-	EncryptionKey *string `locationName:"encryptionKey" min:"1" type:"string"`
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListReportsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListReportsInput"}
+	if s.MaxResults != nil && *s.MaxResults < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+	}
 
-	// When the build process ended, expressed in Unix time format.
-	EndTime *time.Time `locationName:"endTime" type:"timestamp"`
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
 
-	// Information about the build environment for this build.
-	Environment *ProjectEnvironment `locationName:"environment" type:"structure"`
+// SetFilter sets the Filter field's value.
+func (s *ListReportsInput) SetFilter(v *ReportFilter) *ListReportsInput {
+	s.Filter = v
+	return s
+}
 
-	// The unique ID for the build.
-	Id *string `locationName:"id" min:"1" type:"string"`
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListReportsInput) SetMaxResults(v int64) *ListReportsInput {
+	s.MaxResults = &v
+	return s
+}
 
-	// The entity that started the build. Valid values include:
-	//
-	//    * If AWS CodePipeline started the build, the pipeline's name (for example,
-	//    codepipeline/my-demo-pipeline).
-	//
-	//    * If an AWS Identity and Access Management (IAM) user started the build,
-	//    the user's name (for example, MyUserName).
-	//
-	//    * If the Jenkins plugin for AWS CodeBuild started the build, the string
-	//    CodeBuild-Jenkins-Plugin.
-	Initiator *string `locationName:"initiator" type:"string"`
+// SetNextToken sets the NextToken field's value.
+func (s *ListReportsInput) SetNextToken(v string) *ListReportsInput {
+	s.NextToken = &v
+	return s
+}
 
-	// Information about the build's logs in Amazon CloudWatch Logs.
-	Logs *LogsLocation `locationName:"logs" type:"structure"`
+// SetSortOrder sets the SortOrder field's value.
+func (s *ListReportsInput) SetSortOrder(v string) *ListReportsInput {
+	s.SortOrder = &v
+	return s
+}
 
-	// Describes a network interface.
-	NetworkInterface *NetworkInterface `locationName:"networkInterface" type:"structure"`
+type ListReportsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// During a previous call, the maximum number of items that can be returned
+	// is the value specified in maxResults. If there more items in the list, then
+	// a unique string called a nextToken is returned. To get the next batch of
+	// items in the list, call this operation again, adding the next token to the
+	// call. To get all of the items in the list, keep calling this operation with
+	// each subsequent next token that is returned, until no more next tokens are
+	// returned.
+	NextToken *string `locationName:"nextToken" type:"string"`
+
+	// The list of returned ARNs for the reports in the current Amazon Web Services
+	// account.
+	Reports []*string `locationName:"reports" min:"1" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListReportsOutput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// Information about all previous build phases that are complete and information
-	// about any current build phase that is not yet complete.
-	Phases []*BuildPhase `locationName:"phases" type:"list"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListReportsOutput) GoString() string {
+	return s.String()
+}
 
-	// The name of the AWS CodeBuild project.
-	ProjectName *string `locationName:"projectName" min:"1" type:"string"`
+// SetNextToken sets the NextToken field's value.
+func (s *ListReportsOutput) SetNextToken(v string) *ListReportsOutput {
+	s.NextToken = &v
+	return s
+}
 
-	// The number of minutes a build is allowed to be queued before it times out.
-	QueuedTimeoutInMinutes *int64 `locationName:"queuedTimeoutInMinutes" type:"integer"`
+// SetReports sets the Reports field's value.
+func (s *ListReportsOutput) SetReports(v []*string) *ListReportsOutput {
+	s.Reports = v
+	return s
+}
 
-	// An identifier for the version of this build's source code.
-	//
-	//    * For AWS CodeCommit, GitHub, GitHub Enterprise, and BitBucket, the commit
-	//    ID.
-	//
-	//    * For AWS CodePipeline, the source revision provided by AWS CodePipeline.
-	//
-	//    * For Amazon Simple Storage Service (Amazon S3), this does not apply.
-	ResolvedSourceVersion *string `locationName:"resolvedSourceVersion" min:"1" type:"string"`
+type ListSharedProjectsInput struct {
+	_ struct{} `type:"structure"`
 
-	// An array of ProjectArtifacts objects.
-	SecondaryArtifacts []*BuildArtifacts `locationName:"secondaryArtifacts" type:"list"`
+	// The maximum number of paginated shared build projects returned per response.
+	// Use nextToken to iterate pages in the list of returned Project objects. The
+	// default value is 100.
+	MaxResults *int64 `locationName:"maxResults" min:"1" type:"integer"`
+
+	// During a previous call, the maximum number of items that can be returned
+	// is the value specified in maxResults. If there more items in the list, then
+	// a unique string called a nextToken is returned. To get the next batch of
+	// items in the list, call this operation again, adding the next token to the
+	// call. To get all of the items in the list, keep calling this operation with
+	// each subsequent next token that is returned, until no more next tokens are
+	// returned.
+	NextToken *string `locationName:"nextToken" min:"1" type:"string"`
 
-	// An array of ProjectSourceVersion objects. Each ProjectSourceVersion must
-	// be one of:
+	// The criterion to be used to list build projects shared with the current Amazon
+	// Web Services account or user. Valid values include:
 	//
-	//    * For AWS CodeCommit: the commit ID to use.
+	//    * ARN: List based on the ARN.
 	//
-	//    * For GitHub: the commit ID, pull request ID, branch name, or tag name
-	//    that corresponds to the version of the source code you want to build.
-	//    If a pull request ID is specified, it must use the format pr/pull-request-ID
-	//    (for example, pr/25). If a branch name is specified, the branch's HEAD
-	//    commit ID is used. If not specified, the default branch's HEAD commit
-	//    ID is used.
+	//    * MODIFIED_TIME: List based on when information about the shared project
+	//    was last changed.
+	SortBy *string `locationName:"sortBy" type:"string" enum:"SharedResourceSortByType"`
+
+	// The order in which to list shared build projects. Valid values include:
 	//
-	//    * For Bitbucket: the commit ID, branch name, or tag name that corresponds
-	//    to the version of the source code you want to build. If a branch name
-	//    is specified, the branch's HEAD commit ID is used. If not specified, the
-	//    default branch's HEAD commit ID is used.
+	//    * ASCENDING: List in ascending order.
 	//
-	//    * For Amazon Simple Storage Service (Amazon S3): the version ID of the
-	//    object that represents the build input ZIP file to use.
-	SecondarySourceVersions []*ProjectSourceVersion `locationName:"secondarySourceVersions" type:"list"`
+	//    * DESCENDING: List in descending order.
+	SortOrder *string `locationName:"sortOrder" type:"string" enum:"SortOrderType"`
+}
 
-	// An array of ProjectSource objects.
-	SecondarySources []*ProjectSource `locationName:"secondarySources" type:"list"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSharedProjectsInput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// The name of a service role used for this build.
-	ServiceRole *string `locationName:"serviceRole" min:"1" type:"string"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSharedProjectsInput) GoString() string {
+	return s.String()
+}
 
-	// Information about the source code to be built.
-	Source *ProjectSource `locationName:"source" type:"structure"`
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListSharedProjectsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListSharedProjectsInput"}
+	if s.MaxResults != nil && *s.MaxResults < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+	}
+	if s.NextToken != nil && len(*s.NextToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	}
 
-	// Any version identifier for the version of the source code to be built. If
-	// sourceVersion is specified at the project level, then this sourceVersion
-	// (at the build level) takes precedence.
-	//
-	// For more information, see Source Version Sample with CodeBuild (https://docs.aws.amazon.com/codebuild/latest/userguide/sample-source-version.html)
-	// in the AWS CodeBuild User Guide.
-	SourceVersion *string `locationName:"sourceVersion" min:"1" type:"string"`
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
 
-	// When the build process started, expressed in Unix time format.
-	StartTime *time.Time `locationName:"startTime" type:"timestamp"`
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListSharedProjectsInput) SetMaxResults(v int64) *ListSharedProjectsInput {
+	s.MaxResults = &v
+	return s
+}
 
-	// How long, in minutes, for AWS CodeBuild to wait before timing out this build
-	// if it does not get marked as completed.
-	TimeoutInMinutes *int64 `locationName:"timeoutInMinutes" type:"integer"`
+// SetNextToken sets the NextToken field's value.
+func (s *ListSharedProjectsInput) SetNextToken(v string) *ListSharedProjectsInput {
+	s.NextToken = &v
+	return s
+}
 
-	// If your AWS CodeBuild project accesses resources in an Amazon VPC, you provide
-	// this parameter that identifies the VPC ID and the list of security group
-	// IDs and subnet IDs. The security groups and subnets must belong to the same
-	// VPC. You must provide at least one security group and one subnet ID.
-	VpcConfig *VpcConfig `locationName:"vpcConfig" type:"structure"`
+// SetSortBy sets the SortBy field's value.
+func (s *ListSharedProjectsInput) SetSortBy(v string) *ListSharedProjectsInput {
+	s.SortBy = &v
+	return s
 }
 
-// String returns the string representation
-func (s Build) String() string {
+// SetSortOrder sets the SortOrder field's value.
+func (s *ListSharedProjectsInput) SetSortOrder(v string) *ListSharedProjectsInput {
+	s.SortOrder = &v
+	return s
+}
+
+type ListSharedProjectsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// During a previous call, the maximum number of items that can be returned
+	// is the value specified in maxResults. If there more items in the list, then
+	// a unique string called a nextToken is returned. To get the next batch of
+	// items in the list, call this operation again, adding the next token to the
+	// call. To get all of the items in the list, keep calling this operation with
+	// each subsequent next token that is returned, until no more next tokens are
+	// returned.
+	NextToken *string `locationName:"nextToken" type:"string"`
+
+	// The list of ARNs for the build projects shared with the current Amazon Web
+	// Services account or user.
+	Projects []*string `locationName:"projects" min:"1" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSharedProjectsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Build) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSharedProjectsOutput) GoString() string {
 	return s.String()
 }
 
-// SetArn sets the Arn field's value.
-func (s *Build) SetArn(v string) *Build {
-	s.Arn = &v
+// SetNextToken sets the NextToken field's value.
+func (s *ListSharedProjectsOutput) SetNextToken(v string) *ListSharedProjectsOutput {
+	s.NextToken = &v
 	return s
 }
 
-// SetArtifacts sets the Artifacts field's value.
-func (s *Build) SetArtifacts(v *BuildArtifacts) *Build {
-	s.Artifacts = v
+// SetProjects sets the Projects field's value.
+func (s *ListSharedProjectsOutput) SetProjects(v []*string) *ListSharedProjectsOutput {
+	s.Projects = v
 	return s
 }
 
-// SetBuildComplete sets the BuildComplete field's value.
-func (s *Build) SetBuildComplete(v bool) *Build {
-	s.BuildComplete = &v
-	return s
+type ListSharedReportGroupsInput struct {
+	_ struct{} `type:"structure"`
+
+	// The maximum number of paginated shared report groups per response. Use nextToken
+	// to iterate pages in the list of returned ReportGroup objects. The default
+	// value is 100.
+	MaxResults *int64 `locationName:"maxResults" min:"1" type:"integer"`
+
+	// During a previous call, the maximum number of items that can be returned
+	// is the value specified in maxResults. If there more items in the list, then
+	// a unique string called a nextToken is returned. To get the next batch of
+	// items in the list, call this operation again, adding the next token to the
+	// call. To get all of the items in the list, keep calling this operation with
+	// each subsequent next token that is returned, until no more next tokens are
+	// returned.
+	NextToken *string `locationName:"nextToken" type:"string"`
+
+	// The criterion to be used to list report groups shared with the current Amazon
+	// Web Services account or user. Valid values include:
+	//
+	//    * ARN: List based on the ARN.
+	//
+	//    * MODIFIED_TIME: List based on when information about the shared report
+	//    group was last changed.
+	SortBy *string `locationName:"sortBy" type:"string" enum:"SharedResourceSortByType"`
+
+	// The order in which to list shared report groups. Valid values include:
+	//
+	//    * ASCENDING: List in ascending order.
+	//
+	//    * DESCENDING: List in descending order.
+	SortOrder *string `locationName:"sortOrder" type:"string" enum:"SortOrderType"`
 }
 
-// SetBuildStatus sets the BuildStatus field's value.
-func (s *Build) SetBuildStatus(v string) *Build {
-	s.BuildStatus = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSharedReportGroupsInput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetCache sets the Cache field's value.
-func (s *Build) SetCache(v *ProjectCache) *Build {
-	s.Cache = v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSharedReportGroupsInput) GoString() string {
+	return s.String()
 }
 
-// SetCurrentPhase sets the CurrentPhase field's value.
-func (s *Build) SetCurrentPhase(v string) *Build {
-	s.CurrentPhase = &v
-	return s
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListSharedReportGroupsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListSharedReportGroupsInput"}
+	if s.MaxResults != nil && *s.MaxResults < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetEncryptionKey sets the EncryptionKey field's value.
-func (s *Build) SetEncryptionKey(v string) *Build {
-	s.EncryptionKey = &v
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListSharedReportGroupsInput) SetMaxResults(v int64) *ListSharedReportGroupsInput {
+	s.MaxResults = &v
 	return s
 }
 
-// SetEndTime sets the EndTime field's value.
-func (s *Build) SetEndTime(v time.Time) *Build {
-	s.EndTime = &v
+// SetNextToken sets the NextToken field's value.
+func (s *ListSharedReportGroupsInput) SetNextToken(v string) *ListSharedReportGroupsInput {
+	s.NextToken = &v
 	return s
 }
 
-// SetEnvironment sets the Environment field's value.
-func (s *Build) SetEnvironment(v *ProjectEnvironment) *Build {
-	s.Environment = v
+// SetSortBy sets the SortBy field's value.
+func (s *ListSharedReportGroupsInput) SetSortBy(v string) *ListSharedReportGroupsInput {
+	s.SortBy = &v
 	return s
 }
 
-// SetId sets the Id field's value.
-func (s *Build) SetId(v string) *Build {
-	s.Id = &v
+// SetSortOrder sets the SortOrder field's value.
+func (s *ListSharedReportGroupsInput) SetSortOrder(v string) *ListSharedReportGroupsInput {
+	s.SortOrder = &v
 	return s
 }
 
-// SetInitiator sets the Initiator field's value.
-func (s *Build) SetInitiator(v string) *Build {
-	s.Initiator = &v
-	return s
+type ListSharedReportGroupsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// During a previous call, the maximum number of items that can be returned
+	// is the value specified in maxResults. If there more items in the list, then
+	// a unique string called a nextToken is returned. To get the next batch of
+	// items in the list, call this operation again, adding the next token to the
+	// call. To get all of the items in the list, keep calling this operation with
+	// each subsequent next token that is returned, until no more next tokens are
+	// returned.
+	NextToken *string `locationName:"nextToken" type:"string"`
+
+	// The list of ARNs for the report groups shared with the current Amazon Web
+	// Services account or user.
+	ReportGroups []*string `locationName:"reportGroups" min:"1" type:"list"`
 }
 
-// SetLogs sets the Logs field's value.
-func (s *Build) SetLogs(v *LogsLocation) *Build {
-	s.Logs = v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSharedReportGroupsOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetNetworkInterface sets the NetworkInterface field's value.
-func (s *Build) SetNetworkInterface(v *NetworkInterface) *Build {
-	s.NetworkInterface = v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSharedReportGroupsOutput) GoString() string {
+	return s.String()
 }
 
-// SetPhases sets the Phases field's value.
-func (s *Build) SetPhases(v []*BuildPhase) *Build {
-	s.Phases = v
+// SetNextToken sets the NextToken field's value.
+func (s *ListSharedReportGroupsOutput) SetNextToken(v string) *ListSharedReportGroupsOutput {
+	s.NextToken = &v
 	return s
 }
 
-// SetProjectName sets the ProjectName field's value.
-func (s *Build) SetProjectName(v string) *Build {
-	s.ProjectName = &v
+// SetReportGroups sets the ReportGroups field's value.
+func (s *ListSharedReportGroupsOutput) SetReportGroups(v []*string) *ListSharedReportGroupsOutput {
+	s.ReportGroups = v
 	return s
 }
 
-// SetQueuedTimeoutInMinutes sets the QueuedTimeoutInMinutes field's value.
-func (s *Build) SetQueuedTimeoutInMinutes(v int64) *Build {
-	s.QueuedTimeoutInMinutes = &v
-	return s
+type ListSourceCredentialsInput struct {
+	_ struct{} `type:"structure"`
 }
 
-// SetResolvedSourceVersion sets the ResolvedSourceVersion field's value.
-func (s *Build) SetResolvedSourceVersion(v string) *Build {
-	s.ResolvedSourceVersion = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSourceCredentialsInput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetSecondaryArtifacts sets the SecondaryArtifacts field's value.
-func (s *Build) SetSecondaryArtifacts(v []*BuildArtifacts) *Build {
-	s.SecondaryArtifacts = v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSourceCredentialsInput) GoString() string {
+	return s.String()
 }
 
-// SetSecondarySourceVersions sets the SecondarySourceVersions field's value.
-func (s *Build) SetSecondarySourceVersions(v []*ProjectSourceVersion) *Build {
-	s.SecondarySourceVersions = v
-	return s
+type ListSourceCredentialsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A list of SourceCredentialsInfo objects. Each SourceCredentialsInfo object
+	// includes the authentication type, token ARN, and type of source provider
+	// for one set of credentials.
+	SourceCredentialsInfos []*SourceCredentialsInfo `locationName:"sourceCredentialsInfos" type:"list"`
 }
 
-// SetSecondarySources sets the SecondarySources field's value.
-func (s *Build) SetSecondarySources(v []*ProjectSource) *Build {
-	s.SecondarySources = v
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSourceCredentialsOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSourceCredentialsOutput) GoString() string {
+	return s.String()
+}
+
+// SetSourceCredentialsInfos sets the SourceCredentialsInfos field's value.
+func (s *ListSourceCredentialsOutput) SetSourceCredentialsInfos(v []*SourceCredentialsInfo) *ListSourceCredentialsOutput {
+	s.SourceCredentialsInfos = v
 	return s
 }
 
-// SetServiceRole sets the ServiceRole field's value.
-func (s *Build) SetServiceRole(v string) *Build {
-	s.ServiceRole = &v
-	return s
+// Information about logs for a build project. These can be logs in CloudWatch
+// Logs, built in a specified S3 bucket, or both.
+type LogsConfig struct {
+	_ struct{} `type:"structure"`
+
+	// Information about CloudWatch Logs for a build project. CloudWatch Logs are
+	// enabled by default.
+	CloudWatchLogs *CloudWatchLogsConfig `locationName:"cloudWatchLogs" type:"structure"`
+
+	// Information about logs built to an S3 bucket for a build project. S3 logs
+	// are not enabled by default.
+	S3Logs *S3LogsConfig `locationName:"s3Logs" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LogsConfig) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetSource sets the Source field's value.
-func (s *Build) SetSource(v *ProjectSource) *Build {
-	s.Source = v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LogsConfig) GoString() string {
+	return s.String()
 }
 
-// SetSourceVersion sets the SourceVersion field's value.
-func (s *Build) SetSourceVersion(v string) *Build {
-	s.SourceVersion = &v
-	return s
-}
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *LogsConfig) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "LogsConfig"}
+	if s.CloudWatchLogs != nil {
+		if err := s.CloudWatchLogs.Validate(); err != nil {
+			invalidParams.AddNested("CloudWatchLogs", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3Logs != nil {
+		if err := s.S3Logs.Validate(); err != nil {
+			invalidParams.AddNested("S3Logs", err.(request.ErrInvalidParams))
+		}
+	}
 
-// SetStartTime sets the StartTime field's value.
-func (s *Build) SetStartTime(v time.Time) *Build {
-	s.StartTime = &v
-	return s
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetTimeoutInMinutes sets the TimeoutInMinutes field's value.
-func (s *Build) SetTimeoutInMinutes(v int64) *Build {
-	s.TimeoutInMinutes = &v
+// SetCloudWatchLogs sets the CloudWatchLogs field's value.
+func (s *LogsConfig) SetCloudWatchLogs(v *CloudWatchLogsConfig) *LogsConfig {
+	s.CloudWatchLogs = v
 	return s
 }
 
-// SetVpcConfig sets the VpcConfig field's value.
-func (s *Build) SetVpcConfig(v *VpcConfig) *Build {
-	s.VpcConfig = v
+// SetS3Logs sets the S3Logs field's value.
+func (s *LogsConfig) SetS3Logs(v *S3LogsConfig) *LogsConfig {
+	s.S3Logs = v
 	return s
 }
 
-// Information about build output artifacts.
-type BuildArtifacts struct {
+// Information about build logs in CloudWatch Logs.
+type LogsLocation struct {
 	_ struct{} `type:"structure"`
 
-	// An identifier for this artifact definition.
-	ArtifactIdentifier *string `locationName:"artifactIdentifier" type:"string"`
+	// Information about CloudWatch Logs for a build project.
+	CloudWatchLogs *CloudWatchLogsConfig `locationName:"cloudWatchLogs" type:"structure"`
 
-	// Information that tells you if encryption for build artifacts is disabled.
-	EncryptionDisabled *bool `locationName:"encryptionDisabled" type:"boolean"`
+	// The ARN of CloudWatch Logs for a build project. Its format is arn:${Partition}:logs:${Region}:${Account}:log-group:${LogGroupName}:log-stream:${LogStreamName}.
+	// For more information, see Resources Defined by CloudWatch Logs (https://docs.aws.amazon.com/IAM/latest/UserGuide/list_amazoncloudwatchlogs.html#amazoncloudwatchlogs-resources-for-iam-policies).
+	CloudWatchLogsArn *string `locationName:"cloudWatchLogsArn" type:"string"`
 
-	// Information about the location of the build artifacts.
-	Location *string `locationName:"location" type:"string"`
+	// The URL to an individual build log in CloudWatch Logs.
+	DeepLink *string `locationName:"deepLink" type:"string"`
 
-	// The MD5 hash of the build artifact.
-	//
-	// You can use this hash along with a checksum tool to confirm file integrity
-	// and authenticity.
-	//
-	// This value is available only if the build project's packaging value is set
-	// to ZIP.
-	Md5sum *string `locationName:"md5sum" type:"string"`
+	// The name of the CloudWatch Logs group for the build logs.
+	GroupName *string `locationName:"groupName" type:"string"`
 
-	// If this flag is set, a name specified in the build spec file overrides the
-	// artifact name. The name specified in a build spec file is calculated at build
-	// time and uses the Shell Command Language. For example, you can append a date
-	// and time to your artifact name so that it is always unique.
-	OverrideArtifactName *bool `locationName:"overrideArtifactName" type:"boolean"`
+	// The URL to a build log in an S3 bucket.
+	S3DeepLink *string `locationName:"s3DeepLink" type:"string"`
 
-	// The SHA-256 hash of the build artifact.
-	//
-	// You can use this hash along with a checksum tool to confirm file integrity
-	// and authenticity.
-	//
-	// This value is available only if the build project's packaging value is set
-	// to ZIP.
-	Sha256sum *string `locationName:"sha256sum" type:"string"`
+	// Information about S3 logs for a build project.
+	S3Logs *S3LogsConfig `locationName:"s3Logs" type:"structure"`
+
+	// The ARN of S3 logs for a build project. Its format is arn:${Partition}:s3:::${BucketName}/${ObjectName}.
+	// For more information, see Resources Defined by Amazon S3 (https://docs.aws.amazon.com/IAM/latest/UserGuide/list_amazons3.html#amazons3-resources-for-iam-policies).
+	S3LogsArn *string `locationName:"s3LogsArn" type:"string"`
+
+	// The name of the CloudWatch Logs stream for the build logs.
+	StreamName *string `locationName:"streamName" type:"string"`
 }
 
-// String returns the string representation
-func (s BuildArtifacts) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LogsLocation) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s BuildArtifacts) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LogsLocation) GoString() string {
 	return s.String()
 }
 
-// SetArtifactIdentifier sets the ArtifactIdentifier field's value.
-func (s *BuildArtifacts) SetArtifactIdentifier(v string) *BuildArtifacts {
-	s.ArtifactIdentifier = &v
+// SetCloudWatchLogs sets the CloudWatchLogs field's value.
+func (s *LogsLocation) SetCloudWatchLogs(v *CloudWatchLogsConfig) *LogsLocation {
+	s.CloudWatchLogs = v
 	return s
 }
 
-// SetEncryptionDisabled sets the EncryptionDisabled field's value.
-func (s *BuildArtifacts) SetEncryptionDisabled(v bool) *BuildArtifacts {
-	s.EncryptionDisabled = &v
+// SetCloudWatchLogsArn sets the CloudWatchLogsArn field's value.
+func (s *LogsLocation) SetCloudWatchLogsArn(v string) *LogsLocation {
+	s.CloudWatchLogsArn = &v
 	return s
 }
 
-// SetLocation sets the Location field's value.
-func (s *BuildArtifacts) SetLocation(v string) *BuildArtifacts {
-	s.Location = &v
+// SetDeepLink sets the DeepLink field's value.
+func (s *LogsLocation) SetDeepLink(v string) *LogsLocation {
+	s.DeepLink = &v
 	return s
 }
 
-// SetMd5sum sets the Md5sum field's value.
-func (s *BuildArtifacts) SetMd5sum(v string) *BuildArtifacts {
-	s.Md5sum = &v
+// SetGroupName sets the GroupName field's value.
+func (s *LogsLocation) SetGroupName(v string) *LogsLocation {
+	s.GroupName = &v
 	return s
 }
 
-// SetOverrideArtifactName sets the OverrideArtifactName field's value.
-func (s *BuildArtifacts) SetOverrideArtifactName(v bool) *BuildArtifacts {
-	s.OverrideArtifactName = &v
+// SetS3DeepLink sets the S3DeepLink field's value.
+func (s *LogsLocation) SetS3DeepLink(v string) *LogsLocation {
+	s.S3DeepLink = &v
 	return s
 }
 
-// SetSha256sum sets the Sha256sum field's value.
-func (s *BuildArtifacts) SetSha256sum(v string) *BuildArtifacts {
-	s.Sha256sum = &v
+// SetS3Logs sets the S3Logs field's value.
+func (s *LogsLocation) SetS3Logs(v *S3LogsConfig) *LogsLocation {
+	s.S3Logs = v
 	return s
 }
 
-// Information about a build that could not be successfully deleted.
-type BuildNotDeleted struct {
-	_ struct{} `type:"structure"`
-
-	// The ID of the build that could not be successfully deleted.
-	Id *string `locationName:"id" min:"1" type:"string"`
-
-	// Additional information about the build that could not be successfully deleted.
-	StatusCode *string `locationName:"statusCode" type:"string"`
-}
-
-// String returns the string representation
-func (s BuildNotDeleted) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s BuildNotDeleted) GoString() string {
-	return s.String()
-}
-
-// SetId sets the Id field's value.
-func (s *BuildNotDeleted) SetId(v string) *BuildNotDeleted {
-	s.Id = &v
+// SetS3LogsArn sets the S3LogsArn field's value.
+func (s *LogsLocation) SetS3LogsArn(v string) *LogsLocation {
+	s.S3LogsArn = &v
 	return s
 }
 
-// SetStatusCode sets the StatusCode field's value.
-func (s *BuildNotDeleted) SetStatusCode(v string) *BuildNotDeleted {
-	s.StatusCode = &v
+// SetStreamName sets the StreamName field's value.
+func (s *LogsLocation) SetStreamName(v string) *LogsLocation {
+	s.StreamName = &v
 	return s
 }
 
-// Information about a stage for a build.
-type BuildPhase struct {
+// Describes a network interface.
+type NetworkInterface struct {
 	_ struct{} `type:"structure"`
 
-	// Additional information about a build phase, especially to help troubleshoot
-	// a failed build.
-	Contexts []*PhaseContext `locationName:"contexts" type:"list"`
-
-	// How long, in seconds, between the starting and ending times of the build's
-	// phase.
-	DurationInSeconds *int64 `locationName:"durationInSeconds" type:"long"`
-
-	// When the build phase ended, expressed in Unix time format.
-	EndTime *time.Time `locationName:"endTime" type:"timestamp"`
-
-	// The current status of the build phase. Valid values include:
-	//
-	//    * FAILED: The build phase failed.
-	//
-	//    * FAULT: The build phase faulted.
-	//
-	//    * IN_PROGRESS: The build phase is still in progress.
-	//
-	//    * QUEUED: The build has been submitted and is queued behind other submitted
-	//    builds.
-	//
-	//    * STOPPED: The build phase stopped.
-	//
-	//    * SUCCEEDED: The build phase succeeded.
-	//
-	//    * TIMED_OUT: The build phase timed out.
-	PhaseStatus *string `locationName:"phaseStatus" type:"string" enum:"StatusType"`
-
-	// The name of the build phase. Valid values include:
-	//
-	//    * BUILD: Core build activities typically occur in this build phase.
-	//
-	//    * COMPLETED: The build has been completed.
-	//
-	//    * DOWNLOAD_SOURCE: Source code is being downloaded in this build phase.
-	//
-	//    * FINALIZING: The build process is completing in this build phase.
-	//
-	//    * INSTALL: Installation activities typically occur in this build phase.
-	//
-	//    * POST_BUILD: Post-build activities typically occur in this build phase.
-	//
-	//    * PRE_BUILD: Pre-build activities typically occur in this build phase.
-	//
-	//    * PROVISIONING: The build environment is being set up.
-	//
-	//    * QUEUED: The build has been submitted and is queued behind other submitted
-	//    builds.
-	//
-	//    * SUBMITTED: The build has been submitted.
-	//
-	//    * UPLOAD_ARTIFACTS: Build output artifacts are being uploaded to the output
-	//    location.
-	PhaseType *string `locationName:"phaseType" type:"string" enum:"BuildPhaseType"`
+	// The ID of the network interface.
+	NetworkInterfaceId *string `locationName:"networkInterfaceId" min:"1" type:"string"`
 
-	// When the build phase started, expressed in Unix time format.
-	StartTime *time.Time `locationName:"startTime" type:"timestamp"`
+	// The ID of the subnet.
+	SubnetId *string `locationName:"subnetId" min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s BuildPhase) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NetworkInterface) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s BuildPhase) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NetworkInterface) GoString() string {
 	return s.String()
 }
 
-// SetContexts sets the Contexts field's value.
-func (s *BuildPhase) SetContexts(v []*PhaseContext) *BuildPhase {
-	s.Contexts = v
+// SetNetworkInterfaceId sets the NetworkInterfaceId field's value.
+func (s *NetworkInterface) SetNetworkInterfaceId(v string) *NetworkInterface {
+	s.NetworkInterfaceId = &v
 	return s
 }
 
-// SetDurationInSeconds sets the DurationInSeconds field's value.
-func (s *BuildPhase) SetDurationInSeconds(v int64) *BuildPhase {
-	s.DurationInSeconds = &v
+// SetSubnetId sets the SubnetId field's value.
+func (s *NetworkInterface) SetSubnetId(v string) *NetworkInterface {
+	s.SubnetId = &v
 	return s
 }
 
-// SetEndTime sets the EndTime field's value.
-func (s *BuildPhase) SetEndTime(v time.Time) *BuildPhase {
-	s.EndTime = &v
-	return s
+// There was a problem with the underlying OAuth provider.
+type OAuthProviderException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// SetPhaseStatus sets the PhaseStatus field's value.
-func (s *BuildPhase) SetPhaseStatus(v string) *BuildPhase {
-	s.PhaseStatus = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s OAuthProviderException) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetPhaseType sets the PhaseType field's value.
-func (s *BuildPhase) SetPhaseType(v string) *BuildPhase {
-	s.PhaseType = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s OAuthProviderException) GoString() string {
+	return s.String()
 }
 
-// SetStartTime sets the StartTime field's value.
-func (s *BuildPhase) SetStartTime(v time.Time) *BuildPhase {
-	s.StartTime = &v
-	return s
+func newErrorOAuthProviderException(v protocol.ResponseMetadata) error {
+	return &OAuthProviderException{
+		RespMetadata: v,
+	}
 }
 
-// Information about Amazon CloudWatch Logs for a build project.
-type CloudWatchLogsConfig struct {
-	_ struct{} `type:"structure"`
+// Code returns the exception type name.
+func (s *OAuthProviderException) Code() string {
+	return "OAuthProviderException"
+}
 
-	// The group name of the logs in Amazon CloudWatch Logs. For more information,
-	// see Working with Log Groups and Log Streams (https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/Working-with-log-groups-and-streams.html).
-	GroupName *string `locationName:"groupName" type:"string"`
+// Message returns the exception's message.
+func (s *OAuthProviderException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
 
-	// The current status of the logs in Amazon CloudWatch Logs for a build project.
-	// Valid values are:
-	//
-	//    * ENABLED: Amazon CloudWatch Logs are enabled for this build project.
-	//
-	//    * DISABLED: Amazon CloudWatch Logs are not enabled for this build project.
-	//
-	// Status is a required field
-	Status *string `locationName:"status" type:"string" required:"true" enum:"LogsConfigStatusType"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *OAuthProviderException) OrigErr() error {
+	return nil
+}
 
-	// The prefix of the stream name of the Amazon CloudWatch Logs. For more information,
-	// see Working with Log Groups and Log Streams (https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/Working-with-log-groups-and-streams.html).
-	StreamName *string `locationName:"streamName" type:"string"`
+func (s *OAuthProviderException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// String returns the string representation
-func (s CloudWatchLogsConfig) String() string {
-	return awsutil.Prettify(s)
+// Status code returns the HTTP status code for the request's response error.
+func (s *OAuthProviderException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// GoString returns the string representation
-func (s CloudWatchLogsConfig) GoString() string {
-	return s.String()
+// RequestID returns the service's response RequestID for request.
+func (s *OAuthProviderException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *CloudWatchLogsConfig) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CloudWatchLogsConfig"}
-	if s.Status == nil {
-		invalidParams.Add(request.NewErrParamRequired("Status"))
-	}
+// Additional information about a build phase that has an error. You can use
+// this information for troubleshooting.
+type PhaseContext struct {
+	_ struct{} `type:"structure"`
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+	// An explanation of the build phase's context. This might include a command
+	// ID and an exit code.
+	Message *string `locationName:"message" type:"string"`
+
+	// The status code for the context of the build phase.
+	StatusCode *string `locationName:"statusCode" type:"string"`
 }
 
-// SetGroupName sets the GroupName field's value.
-func (s *CloudWatchLogsConfig) SetGroupName(v string) *CloudWatchLogsConfig {
-	s.GroupName = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PhaseContext) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetStatus sets the Status field's value.
-func (s *CloudWatchLogsConfig) SetStatus(v string) *CloudWatchLogsConfig {
-	s.Status = &v
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PhaseContext) GoString() string {
+	return s.String()
+}
+
+// SetMessage sets the Message field's value.
+func (s *PhaseContext) SetMessage(v string) *PhaseContext {
+	s.Message = &v
 	return s
 }
 
-// SetStreamName sets the StreamName field's value.
-func (s *CloudWatchLogsConfig) SetStreamName(v string) *CloudWatchLogsConfig {
-	s.StreamName = &v
+// SetStatusCode sets the StatusCode field's value.
+func (s *PhaseContext) SetStatusCode(v string) *PhaseContext {
+	s.StatusCode = &v
 	return s
 }
 
-type CreateProjectInput struct {
+// Information about a build project.
+type Project struct {
 	_ struct{} `type:"structure"`
 
+	// The Amazon Resource Name (ARN) of the build project.
+	Arn *string `locationName:"arn" type:"string"`
+
 	// Information about the build output artifacts for the build project.
-	//
-	// Artifacts is a required field
-	Artifacts *ProjectArtifacts `locationName:"artifacts" type:"structure" required:"true"`
+	Artifacts *ProjectArtifacts `locationName:"artifacts" type:"structure"`
 
-	// Set this to true to generate a publicly accessible URL for your project's
-	// build badge.
-	BadgeEnabled *bool `locationName:"badgeEnabled" type:"boolean"`
+	// Information about the build badge for the build project.
+	Badge *ProjectBadge `locationName:"badge" type:"structure"`
 
-	// Stores recently used information so that it can be quickly accessed at a
-	// later time.
+	// A ProjectBuildBatchConfig object that defines the batch build options for
+	// the project.
+	BuildBatchConfig *ProjectBuildBatchConfig `locationName:"buildBatchConfig" type:"structure"`
+
+	// Information about the cache for the build project.
 	Cache *ProjectCache `locationName:"cache" type:"structure"`
 
+	// The maximum number of concurrent builds that are allowed for this project.
+	//
+	// New builds are only started if the current number of builds is less than
+	// or equal to this limit. If the current build count meets this limit, new
+	// builds are throttled and are not run.
+	ConcurrentBuildLimit *int64 `locationName:"concurrentBuildLimit" type:"integer"`
+
+	// When the build project was created, expressed in Unix time format.
+	Created *time.Time `locationName:"created" type:"timestamp"`
+
 	// A description that makes the build project easy to identify.
 	Description *string `locationName:"description" type:"string"`
 
-	// The AWS Key Management Service (AWS KMS) customer master key (CMK) to be
-	// used for encrypting the build output artifacts.
+	// The Key Management Service customer master key (CMK) to be used for encrypting
+	// the build output artifacts.
 	//
 	// You can use a cross-account KMS key to encrypt the build output artifacts
 	// if your service role has permission to that key.
 	//
 	// You can specify either the Amazon Resource Name (ARN) of the CMK or, if available,
-	// the CMK's alias (using the format alias/alias-name ).
+	// the CMK's alias (using the format alias/<alias-name>). If you don't specify
+	// a value, CodeBuild uses the managed CMK for Amazon Simple Storage Service
+	// (Amazon S3).
 	EncryptionKey *string `locationName:"encryptionKey" min:"1" type:"string"`
 
-	// Information about the build environment for the build project.
-	//
-	// Environment is a required field
-	Environment *ProjectEnvironment `locationName:"environment" type:"structure" required:"true"`
+	// Information about the build environment for this build project.
+	Environment *ProjectEnvironment `locationName:"environment" type:"structure"`
+
+	// An array of ProjectFileSystemLocation objects for a CodeBuild build project.
+	// A ProjectFileSystemLocation object specifies the identifier, location, mountOptions,
+	// mountPoint, and type of a file system created using Amazon Elastic File System.
+	FileSystemLocations []*ProjectFileSystemLocation `locationName:"fileSystemLocations" type:"list"`
+
+	// When the build project's settings were last modified, expressed in Unix time
+	// format.
+	LastModified *time.Time `locationName:"lastModified" type:"timestamp"`
 
-	// Information about logs for the build project. These can be logs in Amazon
-	// CloudWatch Logs, logs uploaded to a specified S3 bucket, or both.
+	// Information about logs for the build project. A project can create logs in
+	// CloudWatch Logs, an S3 bucket, or both.
 	LogsConfig *LogsConfig `locationName:"logsConfig" type:"structure"`
 
 	// The name of the build project.
+	Name *string `locationName:"name" min:"2" type:"string"`
+
+	// Specifies the visibility of the project's builds. Possible values are:
 	//
-	// Name is a required field
-	Name *string `locationName:"name" min:"2" type:"string" required:"true"`
+	// PUBLIC_READ
+	//
+	// The project builds are visible to the public.
+	//
+	// PRIVATE
+	//
+	// The project builds are not visible to the public.
+	ProjectVisibility *string `locationName:"projectVisibility" type:"string" enum:"ProjectVisibilityType"`
+
+	// Contains the project identifier used with the public build APIs.
+	PublicProjectAlias *string `locationName:"publicProjectAlias" min:"1" type:"string"`
 
 	// The number of minutes a build is allowed to be queued before it times out.
 	QueuedTimeoutInMinutes *int64 `locationName:"queuedTimeoutInMinutes" min:"5" type:"integer"`
 
+	// The ARN of the IAM role that enables CodeBuild to access the CloudWatch Logs
+	// and Amazon S3 artifacts for the project's builds.
+	ResourceAccessRole *string `locationName:"resourceAccessRole" min:"1" type:"string"`
+
 	// An array of ProjectArtifacts objects.
 	SecondaryArtifacts []*ProjectArtifacts `locationName:"secondaryArtifacts" type:"list"`
 
 	// An array of ProjectSourceVersion objects. If secondarySourceVersions is specified
-	// at the build level, then they take precedence over these secondarySourceVersions
-	// (at the project level).
+	// at the build level, then they take over these secondarySourceVersions (at
+	// the project level).
 	SecondarySourceVersions []*ProjectSourceVersion `locationName:"secondarySourceVersions" type:"list"`
 
 	// An array of ProjectSource objects.
 	SecondarySources []*ProjectSource `locationName:"secondarySources" type:"list"`
 
-	// The ARN of the AWS Identity and Access Management (IAM) role that enables
-	// AWS CodeBuild to interact with dependent AWS services on behalf of the AWS
-	// account.
-	//
-	// ServiceRole is a required field
-	ServiceRole *string `locationName:"serviceRole" min:"1" type:"string" required:"true"`
+	// The ARN of the IAM role that enables CodeBuild to interact with dependent
+	// Amazon Web Services services on behalf of the Amazon Web Services account.
+	ServiceRole *string `locationName:"serviceRole" min:"1" type:"string"`
 
-	// Information about the build input source code for the build project.
-	//
-	// Source is a required field
-	Source *ProjectSource `locationName:"source" type:"structure" required:"true"`
+	// Information about the build input source code for this build project.
+	Source *ProjectSource `locationName:"source" type:"structure"`
 
 	// A version of the build input to be built for this project. If not specified,
 	// the latest version is used. If specified, it must be one of:
 	//
-	//    * For AWS CodeCommit: the commit ID to use.
+	//    * For CodeCommit: the commit ID, branch, or Git tag to use.
 	//
 	//    * For GitHub: the commit ID, pull request ID, branch name, or tag name
 	//    that corresponds to the version of the source code you want to build.
@@ -2490,521 +10958,400 @@ type CreateProjectInput struct {
 	//    is specified, the branch's HEAD commit ID is used. If not specified, the
 	//    default branch's HEAD commit ID is used.
 	//
-	//    * For Amazon Simple Storage Service (Amazon S3): the version ID of the
-	//    object that represents the build input ZIP file to use.
+	//    * For Amazon S3: the version ID of the object that represents the build
+	//    input ZIP file to use.
 	//
 	// If sourceVersion is specified at the build level, then that version takes
 	// precedence over this sourceVersion (at the project level).
 	//
 	// For more information, see Source Version Sample with CodeBuild (https://docs.aws.amazon.com/codebuild/latest/userguide/sample-source-version.html)
-	// in the AWS CodeBuild User Guide.
+	// in the CodeBuild User Guide.
 	SourceVersion *string `locationName:"sourceVersion" type:"string"`
 
-	// A set of tags for this build project.
+	// A list of tag key and value pairs associated with this build project.
 	//
-	// These tags are available for use by AWS services that support AWS CodeBuild
-	// build project tags.
+	// These tags are available for use by Amazon Web Services services that support
+	// CodeBuild build project tags.
 	Tags []*Tag `locationName:"tags" type:"list"`
 
-	// How long, in minutes, from 5 to 480 (8 hours), for AWS CodeBuild to wait
-	// before it times out any build that has not been marked as completed. The
-	// default is 60 minutes.
+	// How long, in minutes, from 5 to 480 (8 hours), for CodeBuild to wait before
+	// timing out any related build that did not get marked as completed. The default
+	// is 60 minutes.
 	TimeoutInMinutes *int64 `locationName:"timeoutInMinutes" min:"5" type:"integer"`
 
-	// VpcConfig enables AWS CodeBuild to access resources in an Amazon VPC.
+	// Information about the VPC configuration that CodeBuild accesses.
 	VpcConfig *VpcConfig `locationName:"vpcConfig" type:"structure"`
+
+	// Information about a webhook that connects repository events to a build project
+	// in CodeBuild.
+	Webhook *Webhook `locationName:"webhook" type:"structure"`
 }
 
-// String returns the string representation
-func (s CreateProjectInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Project) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateProjectInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Project) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateProjectInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateProjectInput"}
-	if s.Artifacts == nil {
-		invalidParams.Add(request.NewErrParamRequired("Artifacts"))
-	}
-	if s.EncryptionKey != nil && len(*s.EncryptionKey) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("EncryptionKey", 1))
-	}
-	if s.Environment == nil {
-		invalidParams.Add(request.NewErrParamRequired("Environment"))
-	}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
-	}
-	if s.Name != nil && len(*s.Name) < 2 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 2))
-	}
-	if s.QueuedTimeoutInMinutes != nil && *s.QueuedTimeoutInMinutes < 5 {
-		invalidParams.Add(request.NewErrParamMinValue("QueuedTimeoutInMinutes", 5))
-	}
-	if s.ServiceRole == nil {
-		invalidParams.Add(request.NewErrParamRequired("ServiceRole"))
-	}
-	if s.ServiceRole != nil && len(*s.ServiceRole) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("ServiceRole", 1))
-	}
-	if s.Source == nil {
-		invalidParams.Add(request.NewErrParamRequired("Source"))
-	}
-	if s.TimeoutInMinutes != nil && *s.TimeoutInMinutes < 5 {
-		invalidParams.Add(request.NewErrParamMinValue("TimeoutInMinutes", 5))
-	}
-	if s.Artifacts != nil {
-		if err := s.Artifacts.Validate(); err != nil {
-			invalidParams.AddNested("Artifacts", err.(request.ErrInvalidParams))
-		}
-	}
-	if s.Cache != nil {
-		if err := s.Cache.Validate(); err != nil {
-			invalidParams.AddNested("Cache", err.(request.ErrInvalidParams))
-		}
-	}
-	if s.Environment != nil {
-		if err := s.Environment.Validate(); err != nil {
-			invalidParams.AddNested("Environment", err.(request.ErrInvalidParams))
-		}
-	}
-	if s.LogsConfig != nil {
-		if err := s.LogsConfig.Validate(); err != nil {
-			invalidParams.AddNested("LogsConfig", err.(request.ErrInvalidParams))
-		}
-	}
-	if s.SecondaryArtifacts != nil {
-		for i, v := range s.SecondaryArtifacts {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "SecondaryArtifacts", i), err.(request.ErrInvalidParams))
-			}
-		}
-	}
-	if s.SecondarySourceVersions != nil {
-		for i, v := range s.SecondarySourceVersions {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "SecondarySourceVersions", i), err.(request.ErrInvalidParams))
-			}
-		}
-	}
-	if s.SecondarySources != nil {
-		for i, v := range s.SecondarySources {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "SecondarySources", i), err.(request.ErrInvalidParams))
-			}
-		}
-	}
-	if s.Source != nil {
-		if err := s.Source.Validate(); err != nil {
-			invalidParams.AddNested("Source", err.(request.ErrInvalidParams))
-		}
-	}
-	if s.Tags != nil {
-		for i, v := range s.Tags {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
-			}
-		}
-	}
-	if s.VpcConfig != nil {
-		if err := s.VpcConfig.Validate(); err != nil {
-			invalidParams.AddNested("VpcConfig", err.(request.ErrInvalidParams))
-		}
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetArn sets the Arn field's value.
+func (s *Project) SetArn(v string) *Project {
+	s.Arn = &v
+	return s
 }
 
 // SetArtifacts sets the Artifacts field's value.
-func (s *CreateProjectInput) SetArtifacts(v *ProjectArtifacts) *CreateProjectInput {
+func (s *Project) SetArtifacts(v *ProjectArtifacts) *Project {
 	s.Artifacts = v
 	return s
 }
 
-// SetBadgeEnabled sets the BadgeEnabled field's value.
-func (s *CreateProjectInput) SetBadgeEnabled(v bool) *CreateProjectInput {
-	s.BadgeEnabled = &v
+// SetBadge sets the Badge field's value.
+func (s *Project) SetBadge(v *ProjectBadge) *Project {
+	s.Badge = v
+	return s
+}
+
+// SetBuildBatchConfig sets the BuildBatchConfig field's value.
+func (s *Project) SetBuildBatchConfig(v *ProjectBuildBatchConfig) *Project {
+	s.BuildBatchConfig = v
 	return s
 }
 
 // SetCache sets the Cache field's value.
-func (s *CreateProjectInput) SetCache(v *ProjectCache) *CreateProjectInput {
+func (s *Project) SetCache(v *ProjectCache) *Project {
 	s.Cache = v
 	return s
 }
 
+// SetConcurrentBuildLimit sets the ConcurrentBuildLimit field's value.
+func (s *Project) SetConcurrentBuildLimit(v int64) *Project {
+	s.ConcurrentBuildLimit = &v
+	return s
+}
+
+// SetCreated sets the Created field's value.
+func (s *Project) SetCreated(v time.Time) *Project {
+	s.Created = &v
+	return s
+}
+
 // SetDescription sets the Description field's value.
-func (s *CreateProjectInput) SetDescription(v string) *CreateProjectInput {
+func (s *Project) SetDescription(v string) *Project {
 	s.Description = &v
 	return s
 }
 
 // SetEncryptionKey sets the EncryptionKey field's value.
-func (s *CreateProjectInput) SetEncryptionKey(v string) *CreateProjectInput {
+func (s *Project) SetEncryptionKey(v string) *Project {
 	s.EncryptionKey = &v
 	return s
 }
 
 // SetEnvironment sets the Environment field's value.
-func (s *CreateProjectInput) SetEnvironment(v *ProjectEnvironment) *CreateProjectInput {
+func (s *Project) SetEnvironment(v *ProjectEnvironment) *Project {
 	s.Environment = v
 	return s
 }
 
+// SetFileSystemLocations sets the FileSystemLocations field's value.
+func (s *Project) SetFileSystemLocations(v []*ProjectFileSystemLocation) *Project {
+	s.FileSystemLocations = v
+	return s
+}
+
+// SetLastModified sets the LastModified field's value.
+func (s *Project) SetLastModified(v time.Time) *Project {
+	s.LastModified = &v
+	return s
+}
+
 // SetLogsConfig sets the LogsConfig field's value.
-func (s *CreateProjectInput) SetLogsConfig(v *LogsConfig) *CreateProjectInput {
+func (s *Project) SetLogsConfig(v *LogsConfig) *Project {
 	s.LogsConfig = v
 	return s
 }
 
 // SetName sets the Name field's value.
-func (s *CreateProjectInput) SetName(v string) *CreateProjectInput {
+func (s *Project) SetName(v string) *Project {
 	s.Name = &v
 	return s
 }
 
+// SetProjectVisibility sets the ProjectVisibility field's value.
+func (s *Project) SetProjectVisibility(v string) *Project {
+	s.ProjectVisibility = &v
+	return s
+}
+
+// SetPublicProjectAlias sets the PublicProjectAlias field's value.
+func (s *Project) SetPublicProjectAlias(v string) *Project {
+	s.PublicProjectAlias = &v
+	return s
+}
+
 // SetQueuedTimeoutInMinutes sets the QueuedTimeoutInMinutes field's value.
-func (s *CreateProjectInput) SetQueuedTimeoutInMinutes(v int64) *CreateProjectInput {
+func (s *Project) SetQueuedTimeoutInMinutes(v int64) *Project {
 	s.QueuedTimeoutInMinutes = &v
 	return s
 }
 
+// SetResourceAccessRole sets the ResourceAccessRole field's value.
+func (s *Project) SetResourceAccessRole(v string) *Project {
+	s.ResourceAccessRole = &v
+	return s
+}
+
 // SetSecondaryArtifacts sets the SecondaryArtifacts field's value.
-func (s *CreateProjectInput) SetSecondaryArtifacts(v []*ProjectArtifacts) *CreateProjectInput {
+func (s *Project) SetSecondaryArtifacts(v []*ProjectArtifacts) *Project {
 	s.SecondaryArtifacts = v
 	return s
 }
 
 // SetSecondarySourceVersions sets the SecondarySourceVersions field's value.
-func (s *CreateProjectInput) SetSecondarySourceVersions(v []*ProjectSourceVersion) *CreateProjectInput {
+func (s *Project) SetSecondarySourceVersions(v []*ProjectSourceVersion) *Project {
 	s.SecondarySourceVersions = v
 	return s
 }
 
 // SetSecondarySources sets the SecondarySources field's value.
-func (s *CreateProjectInput) SetSecondarySources(v []*ProjectSource) *CreateProjectInput {
+func (s *Project) SetSecondarySources(v []*ProjectSource) *Project {
 	s.SecondarySources = v
 	return s
 }
 
 // SetServiceRole sets the ServiceRole field's value.
-func (s *CreateProjectInput) SetServiceRole(v string) *CreateProjectInput {
+func (s *Project) SetServiceRole(v string) *Project {
 	s.ServiceRole = &v
 	return s
 }
 
 // SetSource sets the Source field's value.
-func (s *CreateProjectInput) SetSource(v *ProjectSource) *CreateProjectInput {
+func (s *Project) SetSource(v *ProjectSource) *Project {
 	s.Source = v
 	return s
 }
 
 // SetSourceVersion sets the SourceVersion field's value.
-func (s *CreateProjectInput) SetSourceVersion(v string) *CreateProjectInput {
+func (s *Project) SetSourceVersion(v string) *Project {
 	s.SourceVersion = &v
 	return s
 }
 
 // SetTags sets the Tags field's value.
-func (s *CreateProjectInput) SetTags(v []*Tag) *CreateProjectInput {
+func (s *Project) SetTags(v []*Tag) *Project {
 	s.Tags = v
 	return s
 }
 
 // SetTimeoutInMinutes sets the TimeoutInMinutes field's value.
-func (s *CreateProjectInput) SetTimeoutInMinutes(v int64) *CreateProjectInput {
+func (s *Project) SetTimeoutInMinutes(v int64) *Project {
 	s.TimeoutInMinutes = &v
 	return s
 }
 
 // SetVpcConfig sets the VpcConfig field's value.
-func (s *CreateProjectInput) SetVpcConfig(v *VpcConfig) *CreateProjectInput {
+func (s *Project) SetVpcConfig(v *VpcConfig) *Project {
 	s.VpcConfig = v
 	return s
 }
 
-type CreateProjectOutput struct {
-	_ struct{} `type:"structure"`
-
-	// Information about the build project that was created.
-	Project *Project `locationName:"project" type:"structure"`
-}
-
-// String returns the string representation
-func (s CreateProjectOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s CreateProjectOutput) GoString() string {
-	return s.String()
-}
-
-// SetProject sets the Project field's value.
-func (s *CreateProjectOutput) SetProject(v *Project) *CreateProjectOutput {
-	s.Project = v
+// SetWebhook sets the Webhook field's value.
+func (s *Project) SetWebhook(v *Webhook) *Project {
+	s.Webhook = v
 	return s
 }
 
-type CreateWebhookInput struct {
+// Information about the build output artifacts for the build project.
+type ProjectArtifacts struct {
 	_ struct{} `type:"structure"`
 
-	// A regular expression used to determine which repository branches are built
-	// when a webhook is triggered. If the name of a branch matches the regular
-	// expression, then it is built. If branchFilter is empty, then all branches
-	// are built.
-	//
-	// It is recommended that you use filterGroups instead of branchFilter.
-	BranchFilter *string `locationName:"branchFilter" type:"string"`
+	// An identifier for this artifact definition.
+	ArtifactIdentifier *string `locationName:"artifactIdentifier" type:"string"`
 
-	// An array of arrays of WebhookFilter objects used to determine which webhooks
-	// are triggered. At least one WebhookFilter in the array must specify EVENT
-	// as its type.
+	// Specifies the bucket owner's access for objects that another account uploads
+	// to their Amazon S3 bucket. By default, only the account that uploads the
+	// objects to the bucket has access to these objects. This property allows you
+	// to give the bucket owner access to these objects.
 	//
-	// For a build to be triggered, at least one filter group in the filterGroups
-	// array must pass. For a filter group to pass, each of its filters must pass.
-	FilterGroups [][]*WebhookFilter `locationName:"filterGroups" type:"list"`
-
-	// The name of the AWS CodeBuild project.
+	// To use this property, your CodeBuild service role must have the s3:PutBucketAcl
+	// permission. This permission allows CodeBuild to modify the access control
+	// list for the bucket.
 	//
-	// ProjectName is a required field
-	ProjectName *string `locationName:"projectName" min:"2" type:"string" required:"true"`
-}
-
-// String returns the string representation
-func (s CreateWebhookInput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s CreateWebhookInput) GoString() string {
-	return s.String()
-}
-
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateWebhookInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateWebhookInput"}
-	if s.ProjectName == nil {
-		invalidParams.Add(request.NewErrParamRequired("ProjectName"))
-	}
-	if s.ProjectName != nil && len(*s.ProjectName) < 2 {
-		invalidParams.Add(request.NewErrParamMinLen("ProjectName", 2))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
-// SetBranchFilter sets the BranchFilter field's value.
-func (s *CreateWebhookInput) SetBranchFilter(v string) *CreateWebhookInput {
-	s.BranchFilter = &v
-	return s
-}
-
-// SetFilterGroups sets the FilterGroups field's value.
-func (s *CreateWebhookInput) SetFilterGroups(v [][]*WebhookFilter) *CreateWebhookInput {
-	s.FilterGroups = v
-	return s
-}
-
-// SetProjectName sets the ProjectName field's value.
-func (s *CreateWebhookInput) SetProjectName(v string) *CreateWebhookInput {
-	s.ProjectName = &v
-	return s
-}
-
-type CreateWebhookOutput struct {
-	_ struct{} `type:"structure"`
-
-	// Information about a webhook that connects repository events to a build project
-	// in AWS CodeBuild.
-	Webhook *Webhook `locationName:"webhook" type:"structure"`
-}
-
-// String returns the string representation
-func (s CreateWebhookOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s CreateWebhookOutput) GoString() string {
-	return s.String()
-}
-
-// SetWebhook sets the Webhook field's value.
-func (s *CreateWebhookOutput) SetWebhook(v *Webhook) *CreateWebhookOutput {
-	s.Webhook = v
-	return s
-}
-
-type DeleteProjectInput struct {
-	_ struct{} `type:"structure"`
-
-	// The name of the build project.
+	// This property can be one of the following values:
 	//
-	// Name is a required field
-	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
-}
-
-// String returns the string representation
-func (s DeleteProjectInput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s DeleteProjectInput) GoString() string {
-	return s.String()
-}
-
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteProjectInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteProjectInput"}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
-	}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
-// SetName sets the Name field's value.
-func (s *DeleteProjectInput) SetName(v string) *DeleteProjectInput {
-	s.Name = &v
-	return s
-}
-
-type DeleteProjectOutput struct {
-	_ struct{} `type:"structure"`
-}
-
-// String returns the string representation
-func (s DeleteProjectOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s DeleteProjectOutput) GoString() string {
-	return s.String()
-}
-
-type DeleteSourceCredentialsInput struct {
-	_ struct{} `type:"structure"`
-
-	// The Amazon Resource Name (ARN) of the token.
+	// NONE
 	//
-	// Arn is a required field
-	Arn *string `locationName:"arn" min:"1" type:"string" required:"true"`
-}
-
-// String returns the string representation
-func (s DeleteSourceCredentialsInput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s DeleteSourceCredentialsInput) GoString() string {
-	return s.String()
-}
-
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteSourceCredentialsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteSourceCredentialsInput"}
-	if s.Arn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Arn"))
-	}
-	if s.Arn != nil && len(*s.Arn) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Arn", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
+	// The bucket owner does not have access to the objects. This is the default.
+	//
+	// READ_ONLY
+	//
+	// The bucket owner has read-only access to the objects. The uploading account
+	// retains ownership of the objects.
+	//
+	// FULL
+	//
+	// The bucket owner has full access to the objects. Object ownership is determined
+	// by the following criteria:
+	//
+	//    * If the bucket is configured with the Bucket owner preferred setting,
+	//    the bucket owner owns the objects. The uploading account will have object
+	//    access as specified by the bucket's policy.
+	//
+	//    * Otherwise, the uploading account retains ownership of the objects.
+	//
+	// For more information about Amazon S3 object ownership, see Controlling ownership
+	// of uploaded objects using S3 Object Ownership (https://docs.aws.amazon.com/AmazonS3/latest/userguide/about-object-ownership.html)
+	// in the Amazon Simple Storage Service User Guide.
+	BucketOwnerAccess *string `locationName:"bucketOwnerAccess" type:"string" enum:"BucketOwnerAccess"`
 
-// SetArn sets the Arn field's value.
-func (s *DeleteSourceCredentialsInput) SetArn(v string) *DeleteSourceCredentialsInput {
-	s.Arn = &v
-	return s
-}
+	// Set to true if you do not want your output artifacts encrypted. This option
+	// is valid only if your artifacts type is Amazon S3. If this is set with another
+	// artifacts type, an invalidInputException is thrown.
+	EncryptionDisabled *bool `locationName:"encryptionDisabled" type:"boolean"`
 
-type DeleteSourceCredentialsOutput struct {
-	_ struct{} `type:"structure"`
+	// Information about the build output artifact location:
+	//
+	//    * If type is set to CODEPIPELINE, CodePipeline ignores this value if specified.
+	//    This is because CodePipeline manages its build output locations instead
+	//    of CodeBuild.
+	//
+	//    * If type is set to NO_ARTIFACTS, this value is ignored if specified,
+	//    because no build output is produced.
+	//
+	//    * If type is set to S3, this is the name of the output bucket.
+	Location *string `locationName:"location" type:"string"`
 
-	// The Amazon Resource Name (ARN) of the token.
-	Arn *string `locationName:"arn" min:"1" type:"string"`
-}
+	// Along with path and namespaceType, the pattern that CodeBuild uses to name
+	// and store the output artifact:
+	//
+	//    * If type is set to CODEPIPELINE, CodePipeline ignores this value if specified.
+	//    This is because CodePipeline manages its build output names instead of
+	//    CodeBuild.
+	//
+	//    * If type is set to NO_ARTIFACTS, this value is ignored if specified,
+	//    because no build output is produced.
+	//
+	//    * If type is set to S3, this is the name of the output artifact object.
+	//    If you set the name to be a forward slash ("/"), the artifact is stored
+	//    in the root of the output bucket.
+	//
+	// For example:
+	//
+	//    * If path is set to MyArtifacts, namespaceType is set to BUILD_ID, and
+	//    name is set to MyArtifact.zip, then the output artifact is stored in MyArtifacts/<build-ID>/MyArtifact.zip.
+	//
+	//    * If path is empty, namespaceType is set to NONE, and name is set to "/",
+	//    the output artifact is stored in the root of the output bucket.
+	//
+	//    * If path is set to MyArtifacts, namespaceType is set to BUILD_ID, and
+	//    name is set to "/", the output artifact is stored in MyArtifacts/<build-ID>.
+	Name *string `locationName:"name" type:"string"`
 
-// String returns the string representation
-func (s DeleteSourceCredentialsOutput) String() string {
-	return awsutil.Prettify(s)
-}
+	// Along with path and name, the pattern that CodeBuild uses to determine the
+	// name and location to store the output artifact:
+	//
+	//    * If type is set to CODEPIPELINE, CodePipeline ignores this value if specified.
+	//    This is because CodePipeline manages its build output names instead of
+	//    CodeBuild.
+	//
+	//    * If type is set to NO_ARTIFACTS, this value is ignored if specified,
+	//    because no build output is produced.
+	//
+	//    * If type is set to S3, valid values include: BUILD_ID: Include the build
+	//    ID in the location of the build output artifact. NONE: Do not include
+	//    the build ID. This is the default if namespaceType is not specified.
+	//
+	// For example, if path is set to MyArtifacts, namespaceType is set to BUILD_ID,
+	// and name is set to MyArtifact.zip, the output artifact is stored in MyArtifacts/<build-ID>/MyArtifact.zip.
+	NamespaceType *string `locationName:"namespaceType" type:"string" enum:"ArtifactNamespace"`
 
-// GoString returns the string representation
-func (s DeleteSourceCredentialsOutput) GoString() string {
-	return s.String()
-}
+	// If this flag is set, a name specified in the buildspec file overrides the
+	// artifact name. The name specified in a buildspec file is calculated at build
+	// time and uses the Shell Command Language. For example, you can append a date
+	// and time to your artifact name so that it is always unique.
+	OverrideArtifactName *bool `locationName:"overrideArtifactName" type:"boolean"`
 
-// SetArn sets the Arn field's value.
-func (s *DeleteSourceCredentialsOutput) SetArn(v string) *DeleteSourceCredentialsOutput {
-	s.Arn = &v
-	return s
-}
+	// The type of build output artifact to create:
+	//
+	//    * If type is set to CODEPIPELINE, CodePipeline ignores this value if specified.
+	//    This is because CodePipeline manages its build output artifacts instead
+	//    of CodeBuild.
+	//
+	//    * If type is set to NO_ARTIFACTS, this value is ignored if specified,
+	//    because no build output is produced.
+	//
+	//    * If type is set to S3, valid values include: NONE: CodeBuild creates
+	//    in the output bucket a folder that contains the build output. This is
+	//    the default if packaging is not specified. ZIP: CodeBuild creates in the
+	//    output bucket a ZIP file that contains the build output.
+	Packaging *string `locationName:"packaging" type:"string" enum:"ArtifactPackaging"`
 
-type DeleteWebhookInput struct {
-	_ struct{} `type:"structure"`
+	// Along with namespaceType and name, the pattern that CodeBuild uses to name
+	// and store the output artifact:
+	//
+	//    * If type is set to CODEPIPELINE, CodePipeline ignores this value if specified.
+	//    This is because CodePipeline manages its build output names instead of
+	//    CodeBuild.
+	//
+	//    * If type is set to NO_ARTIFACTS, this value is ignored if specified,
+	//    because no build output is produced.
+	//
+	//    * If type is set to S3, this is the path to the output artifact. If path
+	//    is not specified, path is not used.
+	//
+	// For example, if path is set to MyArtifacts, namespaceType is set to NONE,
+	// and name is set to MyArtifact.zip, the output artifact is stored in the output
+	// bucket at MyArtifacts/MyArtifact.zip.
+	Path *string `locationName:"path" type:"string"`
 
-	// The name of the AWS CodeBuild project.
+	// The type of build output artifact. Valid values include:
 	//
-	// ProjectName is a required field
-	ProjectName *string `locationName:"projectName" min:"2" type:"string" required:"true"`
+	//    * CODEPIPELINE: The build project has build output generated through CodePipeline.
+	//    The CODEPIPELINE type is not supported for secondaryArtifacts.
+	//
+	//    * NO_ARTIFACTS: The build project does not produce any build output.
+	//
+	//    * S3: The build project stores build output in Amazon S3.
+	//
+	// Type is a required field
+	Type *string `locationName:"type" type:"string" required:"true" enum:"ArtifactsType"`
 }
 
-// String returns the string representation
-func (s DeleteWebhookInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ProjectArtifacts) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteWebhookInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ProjectArtifacts) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteWebhookInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteWebhookInput"}
-	if s.ProjectName == nil {
-		invalidParams.Add(request.NewErrParamRequired("ProjectName"))
-	}
-	if s.ProjectName != nil && len(*s.ProjectName) < 2 {
-		invalidParams.Add(request.NewErrParamMinLen("ProjectName", 2))
+func (s *ProjectArtifacts) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ProjectArtifacts"}
+	if s.Type == nil {
+		invalidParams.Add(request.NewErrParamRequired("Type"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -3013,185 +11360,166 @@ func (s *DeleteWebhookInput) Validate() error {
 	return nil
 }
 
-// SetProjectName sets the ProjectName field's value.
-func (s *DeleteWebhookInput) SetProjectName(v string) *DeleteWebhookInput {
-	s.ProjectName = &v
+// SetArtifactIdentifier sets the ArtifactIdentifier field's value.
+func (s *ProjectArtifacts) SetArtifactIdentifier(v string) *ProjectArtifacts {
+	s.ArtifactIdentifier = &v
 	return s
 }
 
-type DeleteWebhookOutput struct {
-	_ struct{} `type:"structure"`
-}
-
-// String returns the string representation
-func (s DeleteWebhookOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s DeleteWebhookOutput) GoString() string {
-	return s.String()
-}
-
-// Information about a Docker image that is managed by AWS CodeBuild.
-type EnvironmentImage struct {
-	_ struct{} `type:"structure"`
-
-	// The description of the Docker image.
-	Description *string `locationName:"description" type:"string"`
-
-	// The name of the Docker image.
-	Name *string `locationName:"name" type:"string"`
-
-	// A list of environment image versions.
-	Versions []*string `locationName:"versions" type:"list"`
-}
-
-// String returns the string representation
-func (s EnvironmentImage) String() string {
-	return awsutil.Prettify(s)
+// SetBucketOwnerAccess sets the BucketOwnerAccess field's value.
+func (s *ProjectArtifacts) SetBucketOwnerAccess(v string) *ProjectArtifacts {
+	s.BucketOwnerAccess = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s EnvironmentImage) GoString() string {
-	return s.String()
+// SetEncryptionDisabled sets the EncryptionDisabled field's value.
+func (s *ProjectArtifacts) SetEncryptionDisabled(v bool) *ProjectArtifacts {
+	s.EncryptionDisabled = &v
+	return s
 }
 
-// SetDescription sets the Description field's value.
-func (s *EnvironmentImage) SetDescription(v string) *EnvironmentImage {
-	s.Description = &v
+// SetLocation sets the Location field's value.
+func (s *ProjectArtifacts) SetLocation(v string) *ProjectArtifacts {
+	s.Location = &v
 	return s
 }
 
 // SetName sets the Name field's value.
-func (s *EnvironmentImage) SetName(v string) *EnvironmentImage {
+func (s *ProjectArtifacts) SetName(v string) *ProjectArtifacts {
 	s.Name = &v
 	return s
 }
 
-// SetVersions sets the Versions field's value.
-func (s *EnvironmentImage) SetVersions(v []*string) *EnvironmentImage {
-	s.Versions = v
+// SetNamespaceType sets the NamespaceType field's value.
+func (s *ProjectArtifacts) SetNamespaceType(v string) *ProjectArtifacts {
+	s.NamespaceType = &v
 	return s
 }
 
-// A set of Docker images that are related by programming language and are managed
-// by AWS CodeBuild.
-type EnvironmentLanguage struct {
-	_ struct{} `type:"structure"`
-
-	// The list of Docker images that are related by the specified programming language.
-	Images []*EnvironmentImage `locationName:"images" type:"list"`
-
-	// The programming language for the Docker images.
-	Language *string `locationName:"language" type:"string" enum:"LanguageType"`
-}
-
-// String returns the string representation
-func (s EnvironmentLanguage) String() string {
-	return awsutil.Prettify(s)
+// SetOverrideArtifactName sets the OverrideArtifactName field's value.
+func (s *ProjectArtifacts) SetOverrideArtifactName(v bool) *ProjectArtifacts {
+	s.OverrideArtifactName = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s EnvironmentLanguage) GoString() string {
-	return s.String()
+// SetPackaging sets the Packaging field's value.
+func (s *ProjectArtifacts) SetPackaging(v string) *ProjectArtifacts {
+	s.Packaging = &v
+	return s
 }
 
-// SetImages sets the Images field's value.
-func (s *EnvironmentLanguage) SetImages(v []*EnvironmentImage) *EnvironmentLanguage {
-	s.Images = v
+// SetPath sets the Path field's value.
+func (s *ProjectArtifacts) SetPath(v string) *ProjectArtifacts {
+	s.Path = &v
 	return s
 }
 
-// SetLanguage sets the Language field's value.
-func (s *EnvironmentLanguage) SetLanguage(v string) *EnvironmentLanguage {
-	s.Language = &v
+// SetType sets the Type field's value.
+func (s *ProjectArtifacts) SetType(v string) *ProjectArtifacts {
+	s.Type = &v
 	return s
 }
 
-// A set of Docker images that are related by platform and are managed by AWS
-// CodeBuild.
-type EnvironmentPlatform struct {
+// Information about the build badge for the build project.
+type ProjectBadge struct {
 	_ struct{} `type:"structure"`
 
-	// The list of programming languages that are available for the specified platform.
-	Languages []*EnvironmentLanguage `locationName:"languages" type:"list"`
+	// Set this to true to generate a publicly accessible URL for your project's
+	// build badge.
+	BadgeEnabled *bool `locationName:"badgeEnabled" type:"boolean"`
 
-	// The platform's name.
-	Platform *string `locationName:"platform" type:"string" enum:"PlatformType"`
+	// The publicly-accessible URL through which you can access the build badge
+	// for your project.
+	BadgeRequestUrl *string `locationName:"badgeRequestUrl" type:"string"`
 }
 
-// String returns the string representation
-func (s EnvironmentPlatform) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ProjectBadge) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s EnvironmentPlatform) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ProjectBadge) GoString() string {
 	return s.String()
 }
 
-// SetLanguages sets the Languages field's value.
-func (s *EnvironmentPlatform) SetLanguages(v []*EnvironmentLanguage) *EnvironmentPlatform {
-	s.Languages = v
+// SetBadgeEnabled sets the BadgeEnabled field's value.
+func (s *ProjectBadge) SetBadgeEnabled(v bool) *ProjectBadge {
+	s.BadgeEnabled = &v
 	return s
 }
 
-// SetPlatform sets the Platform field's value.
-func (s *EnvironmentPlatform) SetPlatform(v string) *EnvironmentPlatform {
-	s.Platform = &v
+// SetBadgeRequestUrl sets the BadgeRequestUrl field's value.
+func (s *ProjectBadge) SetBadgeRequestUrl(v string) *ProjectBadge {
+	s.BadgeRequestUrl = &v
 	return s
 }
 
-// Information about an environment variable for a build project or a build.
-type EnvironmentVariable struct {
+// Contains configuration information about a batch build project.
+type ProjectBuildBatchConfig struct {
 	_ struct{} `type:"structure"`
 
-	// The name or key of the environment variable.
-	//
-	// Name is a required field
-	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
-
-	// The type of environment variable. Valid values include:
+	// Specifies how build status reports are sent to the source provider for the
+	// batch build. This property is only used when the source provider for your
+	// project is Bitbucket, GitHub, or GitHub Enterprise, and your project is configured
+	// to report build statuses to the source provider.
 	//
-	//    * PARAMETER_STORE: An environment variable stored in Amazon EC2 Systems
-	//    Manager Parameter Store.
+	// REPORT_AGGREGATED_BATCH
 	//
-	//    * PLAINTEXT: An environment variable in plaintext format.
-	Type *string `locationName:"type" type:"string" enum:"EnvironmentVariableType"`
-
-	// The value of the environment variable.
+	// (Default) Aggregate all of the build statuses into a single status report.
 	//
-	// We strongly discourage the use of environment variables to store sensitive
-	// values, especially AWS secret key IDs and secret access keys. Environment
-	// variables can be displayed in plain text using the AWS CodeBuild console
-	// and the AWS Command Line Interface (AWS CLI).
+	// REPORT_INDIVIDUAL_BUILDS
 	//
-	// Value is a required field
-	Value *string `locationName:"value" type:"string" required:"true"`
+	// Send a separate status report for each individual build.
+	BatchReportMode *string `locationName:"batchReportMode" type:"string" enum:"BatchReportModeType"`
+
+	// Specifies if the build artifacts for the batch build should be combined into
+	// a single artifact location.
+	CombineArtifacts *bool `locationName:"combineArtifacts" type:"boolean"`
+
+	// A BatchRestrictions object that specifies the restrictions for the batch
+	// build.
+	Restrictions *BatchRestrictions `locationName:"restrictions" type:"structure"`
+
+	// Specifies the service role ARN for the batch build project.
+	ServiceRole *string `locationName:"serviceRole" min:"1" type:"string"`
+
+	// Specifies the maximum amount of time, in minutes, that the batch build must
+	// be completed in.
+	TimeoutInMins *int64 `locationName:"timeoutInMins" type:"integer"`
 }
 
-// String returns the string representation
-func (s EnvironmentVariable) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ProjectBuildBatchConfig) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s EnvironmentVariable) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ProjectBuildBatchConfig) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *EnvironmentVariable) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "EnvironmentVariable"}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
-	}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
-	}
-	if s.Value == nil {
-		invalidParams.Add(request.NewErrParamRequired("Value"))
+func (s *ProjectBuildBatchConfig) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ProjectBuildBatchConfig"}
+	if s.ServiceRole != nil && len(*s.ServiceRole) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ServiceRole", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -3200,50 +11528,129 @@ func (s *EnvironmentVariable) Validate() error {
 	return nil
 }
 
-// SetName sets the Name field's value.
-func (s *EnvironmentVariable) SetName(v string) *EnvironmentVariable {
-	s.Name = &v
+// SetBatchReportMode sets the BatchReportMode field's value.
+func (s *ProjectBuildBatchConfig) SetBatchReportMode(v string) *ProjectBuildBatchConfig {
+	s.BatchReportMode = &v
+	return s
+}
+
+// SetCombineArtifacts sets the CombineArtifacts field's value.
+func (s *ProjectBuildBatchConfig) SetCombineArtifacts(v bool) *ProjectBuildBatchConfig {
+	s.CombineArtifacts = &v
+	return s
+}
+
+// SetRestrictions sets the Restrictions field's value.
+func (s *ProjectBuildBatchConfig) SetRestrictions(v *BatchRestrictions) *ProjectBuildBatchConfig {
+	s.Restrictions = v
 	return s
 }
 
-// SetType sets the Type field's value.
-func (s *EnvironmentVariable) SetType(v string) *EnvironmentVariable {
-	s.Type = &v
+// SetServiceRole sets the ServiceRole field's value.
+func (s *ProjectBuildBatchConfig) SetServiceRole(v string) *ProjectBuildBatchConfig {
+	s.ServiceRole = &v
 	return s
 }
 
-// SetValue sets the Value field's value.
-func (s *EnvironmentVariable) SetValue(v string) *EnvironmentVariable {
-	s.Value = &v
+// SetTimeoutInMins sets the TimeoutInMins field's value.
+func (s *ProjectBuildBatchConfig) SetTimeoutInMins(v int64) *ProjectBuildBatchConfig {
+	s.TimeoutInMins = &v
 	return s
 }
 
-// Information about the Git submodules configuration for an AWS CodeBuild build
-// project.
-type GitSubmodulesConfig struct {
+// Information about the cache for the build project.
+type ProjectCache struct {
 	_ struct{} `type:"structure"`
 
-	// Set to true to fetch Git submodules for your AWS CodeBuild build project.
+	// Information about the cache location:
+	//
+	//    * NO_CACHE or LOCAL: This value is ignored.
+	//
+	//    * S3: This is the S3 bucket name/prefix.
+	Location *string `locationName:"location" type:"string"`
+
+	// An array of strings that specify the local cache modes. You can use one or
+	// more local cache modes at the same time. This is only used for LOCAL cache
+	// types.
 	//
-	// FetchSubmodules is a required field
-	FetchSubmodules *bool `locationName:"fetchSubmodules" type:"boolean" required:"true"`
+	// Possible values are:
+	//
+	// LOCAL_SOURCE_CACHE
+	//
+	// Caches Git metadata for primary and secondary sources. After the cache is
+	// created, subsequent builds pull only the change between commits. This mode
+	// is a good choice for projects with a clean working directory and a source
+	// that is a large Git repository. If you choose this option and your project
+	// does not use a Git repository (GitHub, GitHub Enterprise, or Bitbucket),
+	// the option is ignored.
+	//
+	// LOCAL_DOCKER_LAYER_CACHE
+	//
+	// Caches existing Docker layers. This mode is a good choice for projects that
+	// build or pull large Docker images. It can prevent the performance issues
+	// caused by pulling large Docker images down from the network.
+	//
+	//    * You can use a Docker layer cache in the Linux environment only.
+	//
+	//    * The privileged flag must be set so that your project has the required
+	//    Docker permissions.
+	//
+	//    * You should consider the security implications before you use a Docker
+	//    layer cache.
+	//
+	// LOCAL_CUSTOM_CACHE
+	//
+	// Caches directories you specify in the buildspec file. This mode is a good
+	// choice if your build scenario is not suited to one of the other three local
+	// cache modes. If you use a custom cache:
+	//
+	//    * Only directories can be specified for caching. You cannot specify individual
+	//    files.
+	//
+	//    * Symlinks are used to reference cached directories.
+	//
+	//    * Cached directories are linked to your build before it downloads its
+	//    project sources. Cached items are overridden if a source item has the
+	//    same name. Directories are specified using cache paths in the buildspec
+	//    file.
+	Modes []*string `locationName:"modes" type:"list" enum:"CacheMode"`
+
+	// The type of cache used by the build project. Valid values include:
+	//
+	//    * NO_CACHE: The build project does not use any cache.
+	//
+	//    * S3: The build project reads and writes from and to S3.
+	//
+	//    * LOCAL: The build project stores a cache locally on a build host that
+	//    is only available to that build host.
+	//
+	// Type is a required field
+	Type *string `locationName:"type" type:"string" required:"true" enum:"CacheType"`
 }
 
-// String returns the string representation
-func (s GitSubmodulesConfig) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ProjectCache) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GitSubmodulesConfig) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ProjectCache) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *GitSubmodulesConfig) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GitSubmodulesConfig"}
-	if s.FetchSubmodules == nil {
-		invalidParams.Add(request.NewErrParamRequired("FetchSubmodules"))
+func (s *ProjectCache) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ProjectCache"}
+	if s.Type == nil {
+		invalidParams.Add(request.NewErrParamRequired("Type"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -3252,70 +11659,205 @@ func (s *GitSubmodulesConfig) Validate() error {
 	return nil
 }
 
-// SetFetchSubmodules sets the FetchSubmodules field's value.
-func (s *GitSubmodulesConfig) SetFetchSubmodules(v bool) *GitSubmodulesConfig {
-	s.FetchSubmodules = &v
+// SetLocation sets the Location field's value.
+func (s *ProjectCache) SetLocation(v string) *ProjectCache {
+	s.Location = &v
 	return s
 }
 
-type ImportSourceCredentialsInput struct {
+// SetModes sets the Modes field's value.
+func (s *ProjectCache) SetModes(v []*string) *ProjectCache {
+	s.Modes = v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *ProjectCache) SetType(v string) *ProjectCache {
+	s.Type = &v
+	return s
+}
+
+// Information about the build environment of the build project.
+type ProjectEnvironment struct {
 	_ struct{} `type:"structure"`
 
-	// The type of authentication used to connect to a GitHub, GitHub Enterprise,
-	// or Bitbucket repository. An OAUTH connection is not supported by the API
-	// and must be created using the AWS CodeBuild console.
+	// The ARN of the Amazon S3 bucket, path prefix, and object key that contains
+	// the PEM-encoded certificate for the build project. For more information,
+	// see certificate (https://docs.aws.amazon.com/codebuild/latest/userguide/create-project-cli.html#cli.environment.certificate)
+	// in the CodeBuild User Guide.
+	Certificate *string `locationName:"certificate" type:"string"`
+
+	// Information about the compute resources the build project uses. Available
+	// values include:
 	//
-	// AuthType is a required field
-	AuthType *string `locationName:"authType" type:"string" required:"true" enum:"AuthType"`
+	//    * BUILD_GENERAL1_SMALL: Use up to 3 GB memory and 2 vCPUs for builds.
+	//
+	//    * BUILD_GENERAL1_MEDIUM: Use up to 7 GB memory and 4 vCPUs for builds.
+	//
+	//    * BUILD_GENERAL1_LARGE: Use up to 16 GB memory and 8 vCPUs for builds,
+	//    depending on your environment type.
+	//
+	//    * BUILD_GENERAL1_2XLARGE: Use up to 145 GB memory, 72 vCPUs, and 824 GB
+	//    of SSD storage for builds. This compute type supports Docker images up
+	//    to 100 GB uncompressed.
+	//
+	// If you use BUILD_GENERAL1_LARGE:
+	//
+	//    * For environment type LINUX_CONTAINER, you can use up to 15 GB memory
+	//    and 8 vCPUs for builds.
+	//
+	//    * For environment type LINUX_GPU_CONTAINER, you can use up to 255 GB memory,
+	//    32 vCPUs, and 4 NVIDIA Tesla V100 GPUs for builds.
+	//
+	//    * For environment type ARM_CONTAINER, you can use up to 16 GB memory and
+	//    8 vCPUs on ARM-based processors for builds.
+	//
+	// For more information, see Build Environment Compute Types (https://docs.aws.amazon.com/codebuild/latest/userguide/build-env-ref-compute-types.html)
+	// in the CodeBuild User Guide.
+	//
+	// ComputeType is a required field
+	ComputeType *string `locationName:"computeType" type:"string" required:"true" enum:"ComputeType"`
 
-	// The source provider used for this project.
+	// A set of environment variables to make available to builds for this build
+	// project.
+	EnvironmentVariables []*EnvironmentVariable `locationName:"environmentVariables" type:"list"`
+
+	// The image tag or image digest that identifies the Docker image to use for
+	// this build project. Use the following formats:
 	//
-	// ServerType is a required field
-	ServerType *string `locationName:"serverType" type:"string" required:"true" enum:"ServerType"`
+	//    * For an image tag: <registry>/<repository>:<tag>. For example, in the
+	//    Docker repository that CodeBuild uses to manage its Docker images, this
+	//    would be aws/codebuild/standard:4.0.
+	//
+	//    * For an image digest: <registry>/<repository>@<digest>. For example,
+	//    to specify an image with the digest "sha256:cbbf2f9a99b47fc460d422812b6a5adff7dfee951d8fa2e4a98caa0382cfbdbf,"
+	//    use <registry>/<repository>@sha256:cbbf2f9a99b47fc460d422812b6a5adff7dfee951d8fa2e4a98caa0382cfbdbf.
+	//
+	// For more information, see Docker images provided by CodeBuild (https://docs.aws.amazon.com/codebuild/latest/userguide/build-env-ref-available.html)
+	// in the CodeBuild user guide.
+	//
+	// Image is a required field
+	Image *string `locationName:"image" min:"1" type:"string" required:"true"`
 
-	// Set to false to prevent overwriting the repository source credentials. Set
-	// to true to overwrite the repository source credentials. The default value
-	// is true.
-	ShouldOverwrite *bool `locationName:"shouldOverwrite" type:"boolean"`
+	// The type of credentials CodeBuild uses to pull images in your build. There
+	// are two valid values:
+	//
+	//    * CODEBUILD specifies that CodeBuild uses its own credentials. This requires
+	//    that you modify your ECR repository policy to trust CodeBuild service
+	//    principal.
+	//
+	//    * SERVICE_ROLE specifies that CodeBuild uses your build project's service
+	//    role.
+	//
+	// When you use a cross-account or private registry image, you must use SERVICE_ROLE
+	// credentials. When you use an CodeBuild curated image, you must use CODEBUILD
+	// credentials.
+	ImagePullCredentialsType *string `locationName:"imagePullCredentialsType" type:"string" enum:"ImagePullCredentialsType"`
 
-	// For GitHub or GitHub Enterprise, this is the personal access token. For Bitbucket,
-	// this is the app password.
+	// Enables running the Docker daemon inside a Docker container. Set to true
+	// only if the build project is used to build Docker images. Otherwise, a build
+	// that attempts to interact with the Docker daemon fails. The default setting
+	// is false.
 	//
-	// Token is a required field
-	Token *string `locationName:"token" min:"1" type:"string" required:"true" sensitive:"true"`
+	// You can initialize the Docker daemon during the install phase of your build
+	// by adding one of the following sets of commands to the install phase of your
+	// buildspec file:
+	//
+	// If the operating system's base image is Ubuntu Linux:
+	//
+	// - nohup /usr/local/bin/dockerd --host=unix:///var/run/docker.sock --host=tcp://0.0.0.0:2375
+	// --storage-driver=overlay&
+	//
+	// - timeout 15 sh -c "until docker info; do echo .; sleep 1; done"
+	//
+	// If the operating system's base image is Alpine Linux and the previous command
+	// does not work, add the -t argument to timeout:
+	//
+	// - nohup /usr/local/bin/dockerd --host=unix:///var/run/docker.sock --host=tcp://0.0.0.0:2375
+	// --storage-driver=overlay&
+	//
+	// - timeout -t 15 sh -c "until docker info; do echo .; sleep 1; done"
+	PrivilegedMode *bool `locationName:"privilegedMode" type:"boolean"`
 
-	// The Bitbucket username when the authType is BASIC_AUTH. This parameter is
-	// not valid for other types of source providers or connections.
-	Username *string `locationName:"username" min:"1" type:"string"`
+	// The credentials for access to a private registry.
+	RegistryCredential *RegistryCredential `locationName:"registryCredential" type:"structure"`
+
+	// The type of build environment to use for related builds.
+	//
+	//    * The environment type ARM_CONTAINER is available only in regions US East
+	//    (N. Virginia), US East (Ohio), US West (Oregon), EU (Ireland), Asia Pacific
+	//    (Mumbai), Asia Pacific (Tokyo), Asia Pacific (Sydney), and EU (Frankfurt).
+	//
+	//    * The environment type LINUX_CONTAINER with compute type build.general1.2xlarge
+	//    is available only in regions US East (N. Virginia), US East (Ohio), US
+	//    West (Oregon), Canada (Central), EU (Ireland), EU (London), EU (Frankfurt),
+	//    Asia Pacific (Tokyo), Asia Pacific (Seoul), Asia Pacific (Singapore),
+	//    Asia Pacific (Sydney), China (Beijing), and China (Ningxia).
+	//
+	//    * The environment type LINUX_GPU_CONTAINER is available only in regions
+	//    US East (N. Virginia), US East (Ohio), US West (Oregon), Canada (Central),
+	//    EU (Ireland), EU (London), EU (Frankfurt), Asia Pacific (Tokyo), Asia
+	//    Pacific (Seoul), Asia Pacific (Singapore), Asia Pacific (Sydney) , China
+	//    (Beijing), and China (Ningxia).
+	//
+	//    * The environment types WINDOWS_CONTAINER and WINDOWS_SERVER_2019_CONTAINER
+	//    are available only in regions US East (N. Virginia), US East (Ohio), US
+	//    West (Oregon), and EU (Ireland).
+	//
+	// For more information, see Build environment compute types (https://docs.aws.amazon.com/codebuild/latest/userguide/build-env-ref-compute-types.html)
+	// in the CodeBuild user guide.
+	//
+	// Type is a required field
+	Type *string `locationName:"type" type:"string" required:"true" enum:"EnvironmentType"`
 }
 
-// String returns the string representation
-func (s ImportSourceCredentialsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ProjectEnvironment) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ImportSourceCredentialsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ProjectEnvironment) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ImportSourceCredentialsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ImportSourceCredentialsInput"}
-	if s.AuthType == nil {
-		invalidParams.Add(request.NewErrParamRequired("AuthType"))
+func (s *ProjectEnvironment) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ProjectEnvironment"}
+	if s.ComputeType == nil {
+		invalidParams.Add(request.NewErrParamRequired("ComputeType"))
 	}
-	if s.ServerType == nil {
-		invalidParams.Add(request.NewErrParamRequired("ServerType"))
+	if s.Image == nil {
+		invalidParams.Add(request.NewErrParamRequired("Image"))
 	}
-	if s.Token == nil {
-		invalidParams.Add(request.NewErrParamRequired("Token"))
+	if s.Image != nil && len(*s.Image) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Image", 1))
 	}
-	if s.Token != nil && len(*s.Token) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Token", 1))
+	if s.Type == nil {
+		invalidParams.Add(request.NewErrParamRequired("Type"))
 	}
-	if s.Username != nil && len(*s.Username) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Username", 1))
+	if s.EnvironmentVariables != nil {
+		for i, v := range s.EnvironmentVariables {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "EnvironmentVariables", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.RegistryCredential != nil {
+		if err := s.RegistryCredential.Validate(); err != nil {
+			invalidParams.AddNested("RegistryCredential", err.(request.ErrInvalidParams))
+		}
 	}
 
 	if invalidParams.Len() > 0 {
@@ -3324,156 +11866,298 @@ func (s *ImportSourceCredentialsInput) Validate() error {
 	return nil
 }
 
-// SetAuthType sets the AuthType field's value.
-func (s *ImportSourceCredentialsInput) SetAuthType(v string) *ImportSourceCredentialsInput {
-	s.AuthType = &v
+// SetCertificate sets the Certificate field's value.
+func (s *ProjectEnvironment) SetCertificate(v string) *ProjectEnvironment {
+	s.Certificate = &v
 	return s
 }
 
-// SetServerType sets the ServerType field's value.
-func (s *ImportSourceCredentialsInput) SetServerType(v string) *ImportSourceCredentialsInput {
-	s.ServerType = &v
+// SetComputeType sets the ComputeType field's value.
+func (s *ProjectEnvironment) SetComputeType(v string) *ProjectEnvironment {
+	s.ComputeType = &v
 	return s
 }
 
-// SetShouldOverwrite sets the ShouldOverwrite field's value.
-func (s *ImportSourceCredentialsInput) SetShouldOverwrite(v bool) *ImportSourceCredentialsInput {
-	s.ShouldOverwrite = &v
+// SetEnvironmentVariables sets the EnvironmentVariables field's value.
+func (s *ProjectEnvironment) SetEnvironmentVariables(v []*EnvironmentVariable) *ProjectEnvironment {
+	s.EnvironmentVariables = v
 	return s
 }
 
-// SetToken sets the Token field's value.
-func (s *ImportSourceCredentialsInput) SetToken(v string) *ImportSourceCredentialsInput {
-	s.Token = &v
+// SetImage sets the Image field's value.
+func (s *ProjectEnvironment) SetImage(v string) *ProjectEnvironment {
+	s.Image = &v
 	return s
 }
 
-// SetUsername sets the Username field's value.
-func (s *ImportSourceCredentialsInput) SetUsername(v string) *ImportSourceCredentialsInput {
-	s.Username = &v
+// SetImagePullCredentialsType sets the ImagePullCredentialsType field's value.
+func (s *ProjectEnvironment) SetImagePullCredentialsType(v string) *ProjectEnvironment {
+	s.ImagePullCredentialsType = &v
 	return s
 }
 
-type ImportSourceCredentialsOutput struct {
-	_ struct{} `type:"structure"`
-
-	// The Amazon Resource Name (ARN) of the token.
-	Arn *string `locationName:"arn" min:"1" type:"string"`
-}
-
-// String returns the string representation
-func (s ImportSourceCredentialsOutput) String() string {
-	return awsutil.Prettify(s)
+// SetPrivilegedMode sets the PrivilegedMode field's value.
+func (s *ProjectEnvironment) SetPrivilegedMode(v bool) *ProjectEnvironment {
+	s.PrivilegedMode = &v
+	return s
 }
-
-// GoString returns the string representation
-func (s ImportSourceCredentialsOutput) GoString() string {
-	return s.String()
+
+// SetRegistryCredential sets the RegistryCredential field's value.
+func (s *ProjectEnvironment) SetRegistryCredential(v *RegistryCredential) *ProjectEnvironment {
+	s.RegistryCredential = v
+	return s
 }
 
-// SetArn sets the Arn field's value.
-func (s *ImportSourceCredentialsOutput) SetArn(v string) *ImportSourceCredentialsOutput {
-	s.Arn = &v
+// SetType sets the Type field's value.
+func (s *ProjectEnvironment) SetType(v string) *ProjectEnvironment {
+	s.Type = &v
 	return s
 }
 
-type InvalidateProjectCacheInput struct {
+// Information about a file system created by Amazon Elastic File System (EFS).
+// For more information, see What Is Amazon Elastic File System? (https://docs.aws.amazon.com/efs/latest/ug/whatisefs.html)
+type ProjectFileSystemLocation struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the AWS CodeBuild build project that the cache is reset for.
+	// The name used to access a file system created by Amazon EFS. CodeBuild creates
+	// an environment variable by appending the identifier in all capital letters
+	// to CODEBUILD_. For example, if you specify my_efs for identifier, a new environment
+	// variable is create named CODEBUILD_MY_EFS.
 	//
-	// ProjectName is a required field
-	ProjectName *string `locationName:"projectName" min:"1" type:"string" required:"true"`
+	// The identifier is used to mount your file system.
+	Identifier *string `locationName:"identifier" type:"string"`
+
+	// A string that specifies the location of the file system created by Amazon
+	// EFS. Its format is efs-dns-name:/directory-path. You can find the DNS name
+	// of file system when you view it in the Amazon EFS console. The directory
+	// path is a path to a directory in the file system that CodeBuild mounts. For
+	// example, if the DNS name of a file system is fs-abcd1234.efs.us-west-2.amazonaws.com,
+	// and its mount directory is my-efs-mount-directory, then the location is fs-abcd1234.efs.us-west-2.amazonaws.com:/my-efs-mount-directory.
+	//
+	// The directory path in the format efs-dns-name:/directory-path is optional.
+	// If you do not specify a directory path, the location is only the DNS name
+	// and CodeBuild mounts the entire file system.
+	Location *string `locationName:"location" type:"string"`
+
+	// The mount options for a file system created by Amazon EFS. The default mount
+	// options used by CodeBuild are nfsvers=4.1,rsize=1048576,wsize=1048576,hard,timeo=600,retrans=2.
+	// For more information, see Recommended NFS Mount Options (https://docs.aws.amazon.com/efs/latest/ug/mounting-fs-nfs-mount-settings.html).
+	MountOptions *string `locationName:"mountOptions" type:"string"`
+
+	// The location in the container where you mount the file system.
+	MountPoint *string `locationName:"mountPoint" type:"string"`
+
+	// The type of the file system. The one supported type is EFS.
+	Type *string `locationName:"type" type:"string" enum:"FileSystemType"`
 }
 
-// String returns the string representation
-func (s InvalidateProjectCacheInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ProjectFileSystemLocation) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s InvalidateProjectCacheInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ProjectFileSystemLocation) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *InvalidateProjectCacheInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "InvalidateProjectCacheInput"}
-	if s.ProjectName == nil {
-		invalidParams.Add(request.NewErrParamRequired("ProjectName"))
-	}
-	if s.ProjectName != nil && len(*s.ProjectName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("ProjectName", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetIdentifier sets the Identifier field's value.
+func (s *ProjectFileSystemLocation) SetIdentifier(v string) *ProjectFileSystemLocation {
+	s.Identifier = &v
+	return s
 }
 
-// SetProjectName sets the ProjectName field's value.
-func (s *InvalidateProjectCacheInput) SetProjectName(v string) *InvalidateProjectCacheInput {
-	s.ProjectName = &v
+// SetLocation sets the Location field's value.
+func (s *ProjectFileSystemLocation) SetLocation(v string) *ProjectFileSystemLocation {
+	s.Location = &v
 	return s
 }
 
-type InvalidateProjectCacheOutput struct {
-	_ struct{} `type:"structure"`
+// SetMountOptions sets the MountOptions field's value.
+func (s *ProjectFileSystemLocation) SetMountOptions(v string) *ProjectFileSystemLocation {
+	s.MountOptions = &v
+	return s
 }
 
-// String returns the string representation
-func (s InvalidateProjectCacheOutput) String() string {
-	return awsutil.Prettify(s)
+// SetMountPoint sets the MountPoint field's value.
+func (s *ProjectFileSystemLocation) SetMountPoint(v string) *ProjectFileSystemLocation {
+	s.MountPoint = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s InvalidateProjectCacheOutput) GoString() string {
-	return s.String()
+// SetType sets the Type field's value.
+func (s *ProjectFileSystemLocation) SetType(v string) *ProjectFileSystemLocation {
+	s.Type = &v
+	return s
 }
 
-type ListBuildsForProjectInput struct {
+// Information about the build input source code for the build project.
+type ProjectSource struct {
 	_ struct{} `type:"structure"`
 
-	// During a previous call, if there are more than 100 items in the list, only
-	// the first 100 items are returned, along with a unique string called a next
-	// token. To get the next batch of items in the list, call this operation again,
-	// adding the next token to the call. To get all of the items in the list, keep
-	// calling this operation with each subsequent next token that is returned,
-	// until no more next tokens are returned.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	// Information about the authorization settings for CodeBuild to access the
+	// source code to be built.
+	//
+	// This information is for the CodeBuild console's use only. Your code should
+	// not get or set this information directly.
+	Auth *SourceAuth `locationName:"auth" type:"structure"`
 
-	// The name of the AWS CodeBuild project.
+	// Contains information that defines how the build project reports the build
+	// status to the source provider. This option is only used when the source provider
+	// is GITHUB, GITHUB_ENTERPRISE, or BITBUCKET.
+	BuildStatusConfig *BuildStatusConfig `locationName:"buildStatusConfig" type:"structure"`
+
+	// The buildspec file declaration to use for the builds in this build project.
 	//
-	// ProjectName is a required field
-	ProjectName *string `locationName:"projectName" min:"1" type:"string" required:"true"`
+	// If this value is set, it can be either an inline buildspec definition, the
+	// path to an alternate buildspec file relative to the value of the built-in
+	// CODEBUILD_SRC_DIR environment variable, or the path to an S3 bucket. The
+	// bucket must be in the same Amazon Web Services Region as the build project.
+	// Specify the buildspec file using its ARN (for example, arn:aws:s3:::my-codebuild-sample2/buildspec.yml).
+	// If this value is not provided or is set to an empty string, the source code
+	// must contain a buildspec file in its root directory. For more information,
+	// see Buildspec File Name and Storage Location (https://docs.aws.amazon.com/codebuild/latest/userguide/build-spec-ref.html#build-spec-ref-name-storage).
+	Buildspec *string `locationName:"buildspec" type:"string"`
 
-	// The order to list build IDs. Valid values include:
+	// Information about the Git clone depth for the build project.
+	GitCloneDepth *int64 `locationName:"gitCloneDepth" type:"integer"`
+
+	// Information about the Git submodules configuration for the build project.
+	GitSubmodulesConfig *GitSubmodulesConfig `locationName:"gitSubmodulesConfig" type:"structure"`
+
+	// Enable this flag to ignore SSL warnings while connecting to the project source
+	// code.
+	InsecureSsl *bool `locationName:"insecureSsl" type:"boolean"`
+
+	// Information about the location of the source code to be built. Valid values
+	// include:
 	//
-	//    * ASCENDING: List the build IDs in ascending order by build ID.
+	//    * For source code settings that are specified in the source action of
+	//    a pipeline in CodePipeline, location should not be specified. If it is
+	//    specified, CodePipeline ignores it. This is because CodePipeline uses
+	//    the settings in a pipeline's source action instead of this value.
 	//
-	//    * DESCENDING: List the build IDs in descending order by build ID.
-	SortOrder *string `locationName:"sortOrder" type:"string" enum:"SortOrderType"`
+	//    * For source code in an CodeCommit repository, the HTTPS clone URL to
+	//    the repository that contains the source code and the buildspec file (for
+	//    example, https://git-codecommit.<region-ID>.amazonaws.com/v1/repos/<repo-name>).
+	//
+	//    * For source code in an Amazon S3 input bucket, one of the following.
+	//    The path to the ZIP file that contains the source code (for example, <bucket-name>/<path>/<object-name>.zip).
+	//    The path to the folder that contains the source code (for example, <bucket-name>/<path-to-source-code>/<folder>/).
+	//
+	//    * For source code in a GitHub repository, the HTTPS clone URL to the repository
+	//    that contains the source and the buildspec file. You must connect your
+	//    Amazon Web Services account to your GitHub account. Use the CodeBuild
+	//    console to start creating a build project. When you use the console to
+	//    connect (or reconnect) with GitHub, on the GitHub Authorize application
+	//    page, for Organization access, choose Request access next to each repository
+	//    you want to allow CodeBuild to have access to, and then choose Authorize
+	//    application. (After you have connected to your GitHub account, you do
+	//    not need to finish creating the build project. You can leave the CodeBuild
+	//    console.) To instruct CodeBuild to use this connection, in the source
+	//    object, set the auth object's type value to OAUTH.
+	//
+	//    * For source code in a Bitbucket repository, the HTTPS clone URL to the
+	//    repository that contains the source and the buildspec file. You must connect
+	//    your Amazon Web Services account to your Bitbucket account. Use the CodeBuild
+	//    console to start creating a build project. When you use the console to
+	//    connect (or reconnect) with Bitbucket, on the Bitbucket Confirm access
+	//    to your account page, choose Grant access. (After you have connected to
+	//    your Bitbucket account, you do not need to finish creating the build project.
+	//    You can leave the CodeBuild console.) To instruct CodeBuild to use this
+	//    connection, in the source object, set the auth object's type value to
+	//    OAUTH.
+	//
+	// If you specify CODEPIPELINE for the Type property, don't specify this property.
+	// For all of the other types, you must specify Location.
+	Location *string `locationName:"location" type:"string"`
+
+	// Set to true to report the status of a build's start and finish to your source
+	// provider. This option is valid only when your source provider is GitHub,
+	// GitHub Enterprise, or Bitbucket. If this is set and you use a different source
+	// provider, an invalidInputException is thrown.
+	//
+	// To be able to report the build status to the source provider, the user associated
+	// with the source provider must have write access to the repo. If the user
+	// does not have write access, the build status cannot be updated. For more
+	// information, see Source provider access (https://docs.aws.amazon.com/codebuild/latest/userguide/access-tokens.html)
+	// in the CodeBuild User Guide.
+	//
+	// The status of a build triggered by a webhook is always reported to your source
+	// provider.
+	//
+	// If your project's builds are triggered by a webhook, you must push a new
+	// commit to the repo for a change to this property to take effect.
+	ReportBuildStatus *bool `locationName:"reportBuildStatus" type:"boolean"`
+
+	// An identifier for this project source. The identifier can only contain alphanumeric
+	// characters and underscores, and must be less than 128 characters in length.
+	SourceIdentifier *string `locationName:"sourceIdentifier" type:"string"`
+
+	// The type of repository that contains the source code to be built. Valid values
+	// include:
+	//
+	//    * BITBUCKET: The source code is in a Bitbucket repository.
+	//
+	//    * CODECOMMIT: The source code is in an CodeCommit repository.
+	//
+	//    * CODEPIPELINE: The source code settings are specified in the source action
+	//    of a pipeline in CodePipeline.
+	//
+	//    * GITHUB: The source code is in a GitHub or GitHub Enterprise Cloud repository.
+	//
+	//    * GITHUB_ENTERPRISE: The source code is in a GitHub Enterprise Server
+	//    repository.
+	//
+	//    * NO_SOURCE: The project does not have input source code.
+	//
+	//    * S3: The source code is in an Amazon S3 bucket.
+	//
+	// Type is a required field
+	Type *string `locationName:"type" type:"string" required:"true" enum:"SourceType"`
 }
 
-// String returns the string representation
-func (s ListBuildsForProjectInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ProjectSource) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListBuildsForProjectInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ProjectSource) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListBuildsForProjectInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListBuildsForProjectInput"}
-	if s.ProjectName == nil {
-		invalidParams.Add(request.NewErrParamRequired("ProjectName"))
+func (s *ProjectSource) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ProjectSource"}
+	if s.Type == nil {
+		invalidParams.Add(request.NewErrParamRequired("Type"))
 	}
-	if s.ProjectName != nil && len(*s.ProjectName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("ProjectName", 1))
+	if s.Auth != nil {
+		if err := s.Auth.Validate(); err != nil {
+			invalidParams.AddNested("Auth", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.GitSubmodulesConfig != nil {
+		if err := s.GitSubmodulesConfig.Validate(); err != nil {
+			invalidParams.AddNested("GitSubmodulesConfig", err.(request.ErrInvalidParams))
+		}
 	}
 
 	if invalidParams.Len() > 0 {
@@ -3482,223 +12166,309 @@ func (s *ListBuildsForProjectInput) Validate() error {
 	return nil
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListBuildsForProjectInput) SetNextToken(v string) *ListBuildsForProjectInput {
-	s.NextToken = &v
+// SetAuth sets the Auth field's value.
+func (s *ProjectSource) SetAuth(v *SourceAuth) *ProjectSource {
+	s.Auth = v
 	return s
 }
 
-// SetProjectName sets the ProjectName field's value.
-func (s *ListBuildsForProjectInput) SetProjectName(v string) *ListBuildsForProjectInput {
-	s.ProjectName = &v
+// SetBuildStatusConfig sets the BuildStatusConfig field's value.
+func (s *ProjectSource) SetBuildStatusConfig(v *BuildStatusConfig) *ProjectSource {
+	s.BuildStatusConfig = v
 	return s
 }
 
-// SetSortOrder sets the SortOrder field's value.
-func (s *ListBuildsForProjectInput) SetSortOrder(v string) *ListBuildsForProjectInput {
-	s.SortOrder = &v
+// SetBuildspec sets the Buildspec field's value.
+func (s *ProjectSource) SetBuildspec(v string) *ProjectSource {
+	s.Buildspec = &v
 	return s
 }
 
-type ListBuildsForProjectOutput struct {
-	_ struct{} `type:"structure"`
+// SetGitCloneDepth sets the GitCloneDepth field's value.
+func (s *ProjectSource) SetGitCloneDepth(v int64) *ProjectSource {
+	s.GitCloneDepth = &v
+	return s
+}
 
-	// A list of build IDs for the specified build project, with each build ID representing
-	// a single build.
-	Ids []*string `locationName:"ids" min:"1" type:"list"`
+// SetGitSubmodulesConfig sets the GitSubmodulesConfig field's value.
+func (s *ProjectSource) SetGitSubmodulesConfig(v *GitSubmodulesConfig) *ProjectSource {
+	s.GitSubmodulesConfig = v
+	return s
+}
 
-	// If there are more than 100 items in the list, only the first 100 items are
-	// returned, along with a unique string called a next token. To get the next
-	// batch of items in the list, call this operation again, adding the next token
-	// to the call.
-	NextToken *string `locationName:"nextToken" type:"string"`
+// SetInsecureSsl sets the InsecureSsl field's value.
+func (s *ProjectSource) SetInsecureSsl(v bool) *ProjectSource {
+	s.InsecureSsl = &v
+	return s
 }
 
-// String returns the string representation
-func (s ListBuildsForProjectOutput) String() string {
-	return awsutil.Prettify(s)
+// SetLocation sets the Location field's value.
+func (s *ProjectSource) SetLocation(v string) *ProjectSource {
+	s.Location = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s ListBuildsForProjectOutput) GoString() string {
-	return s.String()
+// SetReportBuildStatus sets the ReportBuildStatus field's value.
+func (s *ProjectSource) SetReportBuildStatus(v bool) *ProjectSource {
+	s.ReportBuildStatus = &v
+	return s
 }
 
-// SetIds sets the Ids field's value.
-func (s *ListBuildsForProjectOutput) SetIds(v []*string) *ListBuildsForProjectOutput {
-	s.Ids = v
+// SetSourceIdentifier sets the SourceIdentifier field's value.
+func (s *ProjectSource) SetSourceIdentifier(v string) *ProjectSource {
+	s.SourceIdentifier = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListBuildsForProjectOutput) SetNextToken(v string) *ListBuildsForProjectOutput {
-	s.NextToken = &v
+// SetType sets the Type field's value.
+func (s *ProjectSource) SetType(v string) *ProjectSource {
+	s.Type = &v
 	return s
 }
 
-type ListBuildsInput struct {
+// A source identifier and its corresponding version.
+type ProjectSourceVersion struct {
 	_ struct{} `type:"structure"`
 
-	// During a previous call, if there are more than 100 items in the list, only
-	// the first 100 items are returned, along with a unique string called a next
-	// token. To get the next batch of items in the list, call this operation again,
-	// adding the next token to the call. To get all of the items in the list, keep
-	// calling this operation with each subsequent next token that is returned,
-	// until no more next tokens are returned.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	// An identifier for a source in the build project. The identifier can only
+	// contain alphanumeric characters and underscores, and must be less than 128
+	// characters in length.
+	//
+	// SourceIdentifier is a required field
+	SourceIdentifier *string `locationName:"sourceIdentifier" type:"string" required:"true"`
 
-	// The order to list build IDs. Valid values include:
+	// The source version for the corresponding source identifier. If specified,
+	// must be one of:
 	//
-	//    * ASCENDING: List the build IDs in ascending order by build ID.
+	//    * For CodeCommit: the commit ID, branch, or Git tag to use.
 	//
-	//    * DESCENDING: List the build IDs in descending order by build ID.
-	SortOrder *string `locationName:"sortOrder" type:"string" enum:"SortOrderType"`
+	//    * For GitHub: the commit ID, pull request ID, branch name, or tag name
+	//    that corresponds to the version of the source code you want to build.
+	//    If a pull request ID is specified, it must use the format pr/pull-request-ID
+	//    (for example, pr/25). If a branch name is specified, the branch's HEAD
+	//    commit ID is used. If not specified, the default branch's HEAD commit
+	//    ID is used.
+	//
+	//    * For Bitbucket: the commit ID, branch name, or tag name that corresponds
+	//    to the version of the source code you want to build. If a branch name
+	//    is specified, the branch's HEAD commit ID is used. If not specified, the
+	//    default branch's HEAD commit ID is used.
+	//
+	//    * For Amazon S3: the version ID of the object that represents the build
+	//    input ZIP file to use.
+	//
+	// For more information, see Source Version Sample with CodeBuild (https://docs.aws.amazon.com/codebuild/latest/userguide/sample-source-version.html)
+	// in the CodeBuild User Guide.
+	//
+	// SourceVersion is a required field
+	SourceVersion *string `locationName:"sourceVersion" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s ListBuildsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ProjectSourceVersion) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListBuildsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ProjectSourceVersion) GoString() string {
 	return s.String()
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListBuildsInput) SetNextToken(v string) *ListBuildsInput {
-	s.NextToken = &v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ProjectSourceVersion) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ProjectSourceVersion"}
+	if s.SourceIdentifier == nil {
+		invalidParams.Add(request.NewErrParamRequired("SourceIdentifier"))
+	}
+	if s.SourceVersion == nil {
+		invalidParams.Add(request.NewErrParamRequired("SourceVersion"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetSourceIdentifier sets the SourceIdentifier field's value.
+func (s *ProjectSourceVersion) SetSourceIdentifier(v string) *ProjectSourceVersion {
+	s.SourceIdentifier = &v
 	return s
 }
 
-// SetSortOrder sets the SortOrder field's value.
-func (s *ListBuildsInput) SetSortOrder(v string) *ListBuildsInput {
-	s.SortOrder = &v
+// SetSourceVersion sets the SourceVersion field's value.
+func (s *ProjectSourceVersion) SetSourceVersion(v string) *ProjectSourceVersion {
+	s.SourceVersion = &v
 	return s
 }
 
-type ListBuildsOutput struct {
+type PutResourcePolicyInput struct {
 	_ struct{} `type:"structure"`
 
-	// A list of build IDs, with each build ID representing a single build.
-	Ids []*string `locationName:"ids" min:"1" type:"list"`
+	// A JSON-formatted resource policy. For more information, see Sharing a Project
+	// (https://docs.aws.amazon.com/codebuild/latest/userguide/project-sharing.html#project-sharing-share)
+	// and Sharing a Report Group (https://docs.aws.amazon.com/codebuild/latest/userguide/report-groups-sharing.html#report-groups-sharing-share)
+	// in the CodeBuild User Guide.
+	//
+	// Policy is a required field
+	Policy *string `locationName:"policy" min:"1" type:"string" required:"true"`
 
-	// If there are more than 100 items in the list, only the first 100 items are
-	// returned, along with a unique string called a next token. To get the next
-	// batch of items in the list, call this operation again, adding the next token
-	// to the call.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	// The ARN of the Project or ReportGroup resource you want to associate with
+	// a resource policy.
+	//
+	// ResourceArn is a required field
+	ResourceArn *string `locationName:"resourceArn" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s ListBuildsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutResourcePolicyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListBuildsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutResourcePolicyInput) GoString() string {
 	return s.String()
 }
 
-// SetIds sets the Ids field's value.
-func (s *ListBuildsOutput) SetIds(v []*string) *ListBuildsOutput {
-	s.Ids = v
-	return s
-}
-
-// SetNextToken sets the NextToken field's value.
-func (s *ListBuildsOutput) SetNextToken(v string) *ListBuildsOutput {
-	s.NextToken = &v
-	return s
-}
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *PutResourcePolicyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutResourcePolicyInput"}
+	if s.Policy == nil {
+		invalidParams.Add(request.NewErrParamRequired("Policy"))
+	}
+	if s.Policy != nil && len(*s.Policy) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Policy", 1))
+	}
+	if s.ResourceArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceArn"))
+	}
+	if s.ResourceArn != nil && len(*s.ResourceArn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ResourceArn", 1))
+	}
 
-type ListCuratedEnvironmentImagesInput struct {
-	_ struct{} `type:"structure"`
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// String returns the string representation
-func (s ListCuratedEnvironmentImagesInput) String() string {
-	return awsutil.Prettify(s)
+// SetPolicy sets the Policy field's value.
+func (s *PutResourcePolicyInput) SetPolicy(v string) *PutResourcePolicyInput {
+	s.Policy = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s ListCuratedEnvironmentImagesInput) GoString() string {
-	return s.String()
+// SetResourceArn sets the ResourceArn field's value.
+func (s *PutResourcePolicyInput) SetResourceArn(v string) *PutResourcePolicyInput {
+	s.ResourceArn = &v
+	return s
 }
 
-type ListCuratedEnvironmentImagesOutput struct {
+type PutResourcePolicyOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Information about supported platforms for Docker images that are managed
-	// by AWS CodeBuild.
-	Platforms []*EnvironmentPlatform `locationName:"platforms" type:"list"`
+	// The ARN of the Project or ReportGroup resource that is associated with a
+	// resource policy.
+	ResourceArn *string `locationName:"resourceArn" min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s ListCuratedEnvironmentImagesOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutResourcePolicyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListCuratedEnvironmentImagesOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutResourcePolicyOutput) GoString() string {
 	return s.String()
 }
 
-// SetPlatforms sets the Platforms field's value.
-func (s *ListCuratedEnvironmentImagesOutput) SetPlatforms(v []*EnvironmentPlatform) *ListCuratedEnvironmentImagesOutput {
-	s.Platforms = v
+// SetResourceArn sets the ResourceArn field's value.
+func (s *PutResourcePolicyOutput) SetResourceArn(v string) *PutResourcePolicyOutput {
+	s.ResourceArn = &v
 	return s
 }
 
-type ListProjectsInput struct {
+// Information about credentials that provide access to a private Docker registry.
+// When this is set:
+//
+//   - imagePullCredentialsType must be set to SERVICE_ROLE.
+//
+//   - images cannot be curated or an Amazon ECR image.
+//
+// For more information, see Private Registry with Secrets Manager Sample for
+// CodeBuild (https://docs.aws.amazon.com/codebuild/latest/userguide/sample-private-registry.html).
+type RegistryCredential struct {
 	_ struct{} `type:"structure"`
 
-	// During a previous call, if there are more than 100 items in the list, only
-	// the first 100 items are returned, along with a unique string called a next
-	// token. To get the next batch of items in the list, call this operation again,
-	// adding the next token to the call. To get all of the items in the list, keep
-	// calling this operation with each subsequent next token that is returned,
-	// until no more next tokens are returned.
-	NextToken *string `locationName:"nextToken" min:"1" type:"string"`
-
-	// The criterion to be used to list build project names. Valid values include:
-	//
-	//    * CREATED_TIME: List based on when each build project was created.
-	//
-	//    * LAST_MODIFIED_TIME: List based on when information about each build
-	//    project was last changed.
+	// The Amazon Resource Name (ARN) or name of credentials created using Secrets
+	// Manager.
 	//
-	//    * NAME: List based on each build project's name.
+	// The credential can use the name of the credentials only if they exist in
+	// your current Amazon Web Services Region.
 	//
-	// Use sortOrder to specify in what order to list the build project names based
-	// on the preceding criteria.
-	SortBy *string `locationName:"sortBy" type:"string" enum:"ProjectSortByType"`
+	// Credential is a required field
+	Credential *string `locationName:"credential" min:"1" type:"string" required:"true"`
 
-	// The order in which to list build projects. Valid values include:
-	//
-	//    * ASCENDING: List in ascending order.
-	//
-	//    * DESCENDING: List in descending order.
+	// The service that created the credentials to access a private Docker registry.
+	// The valid value, SECRETS_MANAGER, is for Secrets Manager.
 	//
-	// Use sortBy to specify the criterion to be used to list build project names.
-	SortOrder *string `locationName:"sortOrder" type:"string" enum:"SortOrderType"`
+	// CredentialProvider is a required field
+	CredentialProvider *string `locationName:"credentialProvider" type:"string" required:"true" enum:"CredentialProviderType"`
 }
 
-// String returns the string representation
-func (s ListProjectsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RegistryCredential) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListProjectsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RegistryCredential) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListProjectsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListProjectsInput"}
-	if s.NextToken != nil && len(*s.NextToken) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+func (s *RegistryCredential) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "RegistryCredential"}
+	if s.Credential == nil {
+		invalidParams.Add(request.NewErrParamRequired("Credential"))
+	}
+	if s.Credential != nil && len(*s.Credential) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Credential", 1))
+	}
+	if s.CredentialProvider == nil {
+		invalidParams.Add(request.NewErrParamRequired("CredentialProvider"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -3707,134 +12477,204 @@ func (s *ListProjectsInput) Validate() error {
 	return nil
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListProjectsInput) SetNextToken(v string) *ListProjectsInput {
-	s.NextToken = &v
-	return s
-}
-
-// SetSortBy sets the SortBy field's value.
-func (s *ListProjectsInput) SetSortBy(v string) *ListProjectsInput {
-	s.SortBy = &v
+// SetCredential sets the Credential field's value.
+func (s *RegistryCredential) SetCredential(v string) *RegistryCredential {
+	s.Credential = &v
 	return s
 }
 
-// SetSortOrder sets the SortOrder field's value.
-func (s *ListProjectsInput) SetSortOrder(v string) *ListProjectsInput {
-	s.SortOrder = &v
+// SetCredentialProvider sets the CredentialProvider field's value.
+func (s *RegistryCredential) SetCredentialProvider(v string) *RegistryCredential {
+	s.CredentialProvider = &v
 	return s
 }
 
-type ListProjectsOutput struct {
+// Information about the results from running a series of test cases during
+// the run of a build project. The test cases are specified in the buildspec
+// for the build project using one or more paths to the test case files. You
+// can specify any type of tests you want, such as unit tests, integration tests,
+// and functional tests.
+type Report struct {
 	_ struct{} `type:"structure"`
 
-	// If there are more than 100 items in the list, only the first 100 items are
-	// returned, along with a unique string called a next token. To get the next
-	// batch of items in the list, call this operation again, adding the next token
-	// to the call.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	// The ARN of the report run.
+	Arn *string `locationName:"arn" min:"1" type:"string"`
 
-	// The list of build project names, with each build project name representing
-	// a single build project.
-	Projects []*string `locationName:"projects" min:"1" type:"list"`
+	// A CodeCoverageReportSummary object that contains a code coverage summary
+	// for this report.
+	CodeCoverageSummary *CodeCoverageReportSummary `locationName:"codeCoverageSummary" type:"structure"`
+
+	// The date and time this report run occurred.
+	Created *time.Time `locationName:"created" type:"timestamp"`
+
+	// The ARN of the build run that generated this report.
+	ExecutionId *string `locationName:"executionId" type:"string"`
+
+	// The date and time a report expires. A report expires 30 days after it is
+	// created. An expired report is not available to view in CodeBuild.
+	Expired *time.Time `locationName:"expired" type:"timestamp"`
+
+	// Information about where the raw data used to generate this report was exported.
+	ExportConfig *ReportExportConfig `locationName:"exportConfig" type:"structure"`
+
+	// The name of the report that was run.
+	Name *string `locationName:"name" type:"string"`
+
+	// The ARN of the report group associated with this report.
+	ReportGroupArn *string `locationName:"reportGroupArn" min:"1" type:"string"`
+
+	// The status of this report.
+	Status *string `locationName:"status" type:"string" enum:"ReportStatusType"`
+
+	// A TestReportSummary object that contains information about this test report.
+	TestSummary *TestReportSummary `locationName:"testSummary" type:"structure"`
+
+	// A boolean that specifies if this report run is truncated. The list of test
+	// cases is truncated after the maximum number of test cases is reached.
+	Truncated *bool `locationName:"truncated" type:"boolean"`
+
+	// The type of the report that was run.
+	//
+	// CODE_COVERAGE
+	//
+	// A code coverage report.
+	//
+	// TEST
+	//
+	// A test report.
+	Type *string `locationName:"type" type:"string" enum:"ReportType"`
 }
 
-// String returns the string representation
-func (s ListProjectsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Report) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListProjectsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Report) GoString() string {
 	return s.String()
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListProjectsOutput) SetNextToken(v string) *ListProjectsOutput {
-	s.NextToken = &v
+// SetArn sets the Arn field's value.
+func (s *Report) SetArn(v string) *Report {
+	s.Arn = &v
 	return s
 }
 
-// SetProjects sets the Projects field's value.
-func (s *ListProjectsOutput) SetProjects(v []*string) *ListProjectsOutput {
-	s.Projects = v
+// SetCodeCoverageSummary sets the CodeCoverageSummary field's value.
+func (s *Report) SetCodeCoverageSummary(v *CodeCoverageReportSummary) *Report {
+	s.CodeCoverageSummary = v
 	return s
 }
 
-type ListSourceCredentialsInput struct {
-	_ struct{} `type:"structure"`
+// SetCreated sets the Created field's value.
+func (s *Report) SetCreated(v time.Time) *Report {
+	s.Created = &v
+	return s
 }
 
-// String returns the string representation
-func (s ListSourceCredentialsInput) String() string {
-	return awsutil.Prettify(s)
+// SetExecutionId sets the ExecutionId field's value.
+func (s *Report) SetExecutionId(v string) *Report {
+	s.ExecutionId = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s ListSourceCredentialsInput) GoString() string {
-	return s.String()
+// SetExpired sets the Expired field's value.
+func (s *Report) SetExpired(v time.Time) *Report {
+	s.Expired = &v
+	return s
 }
 
-type ListSourceCredentialsOutput struct {
-	_ struct{} `type:"structure"`
+// SetExportConfig sets the ExportConfig field's value.
+func (s *Report) SetExportConfig(v *ReportExportConfig) *Report {
+	s.ExportConfig = v
+	return s
+}
 
-	// A list of SourceCredentialsInfo objects. Each SourceCredentialsInfo object
-	// includes the authentication type, token ARN, and type of source provider
-	// for one set of credentials.
-	SourceCredentialsInfos []*SourceCredentialsInfo `locationName:"sourceCredentialsInfos" type:"list"`
+// SetName sets the Name field's value.
+func (s *Report) SetName(v string) *Report {
+	s.Name = &v
+	return s
 }
 
-// String returns the string representation
-func (s ListSourceCredentialsOutput) String() string {
-	return awsutil.Prettify(s)
+// SetReportGroupArn sets the ReportGroupArn field's value.
+func (s *Report) SetReportGroupArn(v string) *Report {
+	s.ReportGroupArn = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s ListSourceCredentialsOutput) GoString() string {
-	return s.String()
+// SetStatus sets the Status field's value.
+func (s *Report) SetStatus(v string) *Report {
+	s.Status = &v
+	return s
 }
 
-// SetSourceCredentialsInfos sets the SourceCredentialsInfos field's value.
-func (s *ListSourceCredentialsOutput) SetSourceCredentialsInfos(v []*SourceCredentialsInfo) *ListSourceCredentialsOutput {
-	s.SourceCredentialsInfos = v
+// SetTestSummary sets the TestSummary field's value.
+func (s *Report) SetTestSummary(v *TestReportSummary) *Report {
+	s.TestSummary = v
 	return s
 }
 
-// Information about logs for a build project. These can be logs in Amazon CloudWatch
-// Logs, built in a specified S3 bucket, or both.
-type LogsConfig struct {
+// SetTruncated sets the Truncated field's value.
+func (s *Report) SetTruncated(v bool) *Report {
+	s.Truncated = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *Report) SetType(v string) *Report {
+	s.Type = &v
+	return s
+}
+
+// Information about the location where the run of a report is exported.
+type ReportExportConfig struct {
 	_ struct{} `type:"structure"`
 
-	// Information about Amazon CloudWatch Logs for a build project. Amazon CloudWatch
-	// Logs are enabled by default.
-	CloudWatchLogs *CloudWatchLogsConfig `locationName:"cloudWatchLogs" type:"structure"`
+	// The export configuration type. Valid values are:
+	//
+	//    * S3: The report results are exported to an S3 bucket.
+	//
+	//    * NO_EXPORT: The report results are not exported.
+	ExportConfigType *string `locationName:"exportConfigType" type:"string" enum:"ReportExportConfigType"`
 
-	// Information about logs built to an S3 bucket for a build project. S3 logs
-	// are not enabled by default.
-	S3Logs *S3LogsConfig `locationName:"s3Logs" type:"structure"`
+	// A S3ReportExportConfig object that contains information about the S3 bucket
+	// where the run of a report is exported.
+	S3Destination *S3ReportExportConfig `locationName:"s3Destination" type:"structure"`
 }
 
-// String returns the string representation
-func (s LogsConfig) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ReportExportConfig) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s LogsConfig) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ReportExportConfig) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *LogsConfig) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "LogsConfig"}
-	if s.CloudWatchLogs != nil {
-		if err := s.CloudWatchLogs.Validate(); err != nil {
-			invalidParams.AddNested("CloudWatchLogs", err.(request.ErrInvalidParams))
-		}
-	}
-	if s.S3Logs != nil {
-		if err := s.S3Logs.Validate(); err != nil {
-			invalidParams.AddNested("S3Logs", err.(request.ErrInvalidParams))
+func (s *ReportExportConfig) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ReportExportConfig"}
+	if s.S3Destination != nil {
+		if err := s.S3Destination.Validate(); err != nil {
+			invalidParams.AddNested("S3Destination", err.(request.ErrInvalidParams))
 		}
 	}
 
@@ -3844,548 +12684,483 @@ func (s *LogsConfig) Validate() error {
 	return nil
 }
 
-// SetCloudWatchLogs sets the CloudWatchLogs field's value.
-func (s *LogsConfig) SetCloudWatchLogs(v *CloudWatchLogsConfig) *LogsConfig {
-	s.CloudWatchLogs = v
+// SetExportConfigType sets the ExportConfigType field's value.
+func (s *ReportExportConfig) SetExportConfigType(v string) *ReportExportConfig {
+	s.ExportConfigType = &v
 	return s
 }
 
-// SetS3Logs sets the S3Logs field's value.
-func (s *LogsConfig) SetS3Logs(v *S3LogsConfig) *LogsConfig {
-	s.S3Logs = v
+// SetS3Destination sets the S3Destination field's value.
+func (s *ReportExportConfig) SetS3Destination(v *S3ReportExportConfig) *ReportExportConfig {
+	s.S3Destination = v
+	return s
+}
+
+// A filter used to return reports with the status specified by the input status
+// parameter.
+type ReportFilter struct {
+	_ struct{} `type:"structure"`
+
+	// The status used to filter reports. You can filter using one status only.
+	Status *string `locationName:"status" type:"string" enum:"ReportStatusType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ReportFilter) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ReportFilter) GoString() string {
+	return s.String()
+}
+
+// SetStatus sets the Status field's value.
+func (s *ReportFilter) SetStatus(v string) *ReportFilter {
+	s.Status = &v
 	return s
 }
 
-// Information about build logs in Amazon CloudWatch Logs.
-type LogsLocation struct {
+// A series of reports. Each report contains information about the results from
+// running a series of test cases. You specify the test cases for a report group
+// in the buildspec for a build project using one or more paths to the test
+// case files.
+type ReportGroup struct {
 	_ struct{} `type:"structure"`
 
-	// Information about Amazon CloudWatch Logs for a build project.
-	CloudWatchLogs *CloudWatchLogsConfig `locationName:"cloudWatchLogs" type:"structure"`
+	// The ARN of the ReportGroup.
+	Arn *string `locationName:"arn" min:"1" type:"string"`
 
-	// The URL to an individual build log in Amazon CloudWatch Logs.
-	DeepLink *string `locationName:"deepLink" type:"string"`
+	// The date and time this ReportGroup was created.
+	Created *time.Time `locationName:"created" type:"timestamp"`
 
-	// The name of the Amazon CloudWatch Logs group for the build logs.
-	GroupName *string `locationName:"groupName" type:"string"`
+	// Information about the destination where the raw data of this ReportGroup
+	// is exported.
+	ExportConfig *ReportExportConfig `locationName:"exportConfig" type:"structure"`
 
-	// The URL to a build log in an S3 bucket.
-	S3DeepLink *string `locationName:"s3DeepLink" type:"string"`
+	// The date and time this ReportGroup was last modified.
+	LastModified *time.Time `locationName:"lastModified" type:"timestamp"`
 
-	// Information about S3 logs for a build project.
-	S3Logs *S3LogsConfig `locationName:"s3Logs" type:"structure"`
+	// The name of the ReportGroup.
+	Name *string `locationName:"name" min:"2" type:"string"`
 
-	// The name of the Amazon CloudWatch Logs stream for the build logs.
-	StreamName *string `locationName:"streamName" type:"string"`
+	// The status of the report group. This property is read-only.
+	//
+	// This can be one of the following values:
+	//
+	// ACTIVE
+	//
+	// The report group is active.
+	//
+	// DELETING
+	//
+	// The report group is in the process of being deleted.
+	Status *string `locationName:"status" type:"string" enum:"ReportGroupStatusType"`
+
+	// A list of tag key and value pairs associated with this report group.
+	//
+	// These tags are available for use by Amazon Web Services services that support
+	// CodeBuild report group tags.
+	Tags []*Tag `locationName:"tags" type:"list"`
+
+	// The type of the ReportGroup. This can be one of the following values:
+	//
+	// CODE_COVERAGE
+	//
+	// The report group contains code coverage reports.
+	//
+	// TEST
+	//
+	// The report group contains test reports.
+	Type *string `locationName:"type" type:"string" enum:"ReportType"`
 }
 
-// String returns the string representation
-func (s LogsLocation) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ReportGroup) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s LogsLocation) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ReportGroup) GoString() string {
 	return s.String()
 }
 
-// SetCloudWatchLogs sets the CloudWatchLogs field's value.
-func (s *LogsLocation) SetCloudWatchLogs(v *CloudWatchLogsConfig) *LogsLocation {
-	s.CloudWatchLogs = v
+// SetArn sets the Arn field's value.
+func (s *ReportGroup) SetArn(v string) *ReportGroup {
+	s.Arn = &v
 	return s
 }
 
-// SetDeepLink sets the DeepLink field's value.
-func (s *LogsLocation) SetDeepLink(v string) *LogsLocation {
-	s.DeepLink = &v
+// SetCreated sets the Created field's value.
+func (s *ReportGroup) SetCreated(v time.Time) *ReportGroup {
+	s.Created = &v
 	return s
 }
 
-// SetGroupName sets the GroupName field's value.
-func (s *LogsLocation) SetGroupName(v string) *LogsLocation {
-	s.GroupName = &v
+// SetExportConfig sets the ExportConfig field's value.
+func (s *ReportGroup) SetExportConfig(v *ReportExportConfig) *ReportGroup {
+	s.ExportConfig = v
 	return s
 }
 
-// SetS3DeepLink sets the S3DeepLink field's value.
-func (s *LogsLocation) SetS3DeepLink(v string) *LogsLocation {
-	s.S3DeepLink = &v
+// SetLastModified sets the LastModified field's value.
+func (s *ReportGroup) SetLastModified(v time.Time) *ReportGroup {
+	s.LastModified = &v
 	return s
 }
 
-// SetS3Logs sets the S3Logs field's value.
-func (s *LogsLocation) SetS3Logs(v *S3LogsConfig) *LogsLocation {
-	s.S3Logs = v
+// SetName sets the Name field's value.
+func (s *ReportGroup) SetName(v string) *ReportGroup {
+	s.Name = &v
 	return s
 }
 
-// SetStreamName sets the StreamName field's value.
-func (s *LogsLocation) SetStreamName(v string) *LogsLocation {
-	s.StreamName = &v
+// SetStatus sets the Status field's value.
+func (s *ReportGroup) SetStatus(v string) *ReportGroup {
+	s.Status = &v
 	return s
 }
 
-// Describes a network interface.
-type NetworkInterface struct {
+// SetTags sets the Tags field's value.
+func (s *ReportGroup) SetTags(v []*Tag) *ReportGroup {
+	s.Tags = v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *ReportGroup) SetType(v string) *ReportGroup {
+	s.Type = &v
+	return s
+}
+
+// Contains trend statistics for a set of reports. The actual values depend
+// on the type of trend being collected. For more information, see .
+type ReportGroupTrendStats struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the network interface.
-	NetworkInterfaceId *string `locationName:"networkInterfaceId" min:"1" type:"string"`
+	// Contains the average of all values analyzed.
+	Average *string `locationName:"average" type:"string"`
 
-	// The ID of the subnet.
-	SubnetId *string `locationName:"subnetId" min:"1" type:"string"`
+	// Contains the maximum value analyzed.
+	Max *string `locationName:"max" type:"string"`
+
+	// Contains the minimum value analyzed.
+	Min *string `locationName:"min" type:"string"`
 }
 
-// String returns the string representation
-func (s NetworkInterface) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ReportGroupTrendStats) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s NetworkInterface) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ReportGroupTrendStats) GoString() string {
 	return s.String()
 }
 
-// SetNetworkInterfaceId sets the NetworkInterfaceId field's value.
-func (s *NetworkInterface) SetNetworkInterfaceId(v string) *NetworkInterface {
-	s.NetworkInterfaceId = &v
+// SetAverage sets the Average field's value.
+func (s *ReportGroupTrendStats) SetAverage(v string) *ReportGroupTrendStats {
+	s.Average = &v
 	return s
 }
 
-// SetSubnetId sets the SubnetId field's value.
-func (s *NetworkInterface) SetSubnetId(v string) *NetworkInterface {
-	s.SubnetId = &v
+// SetMax sets the Max field's value.
+func (s *ReportGroupTrendStats) SetMax(v string) *ReportGroupTrendStats {
+	s.Max = &v
 	return s
 }
 
-// Additional information about a build phase that has an error. You can use
-// this information for troubleshooting.
-type PhaseContext struct {
+// SetMin sets the Min field's value.
+func (s *ReportGroupTrendStats) SetMin(v string) *ReportGroupTrendStats {
+	s.Min = &v
+	return s
+}
+
+// Contains the unmodified data for the report. For more information, see .
+type ReportWithRawData struct {
 	_ struct{} `type:"structure"`
 
-	// An explanation of the build phase's context. This might include a command
-	// ID and an exit code.
-	Message *string `locationName:"message" type:"string"`
+	// The value of the requested data field from the report.
+	Data *string `locationName:"data" type:"string"`
 
-	// The status code for the context of the build phase.
-	StatusCode *string `locationName:"statusCode" type:"string"`
+	// The ARN of the report.
+	ReportArn *string `locationName:"reportArn" min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s PhaseContext) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ReportWithRawData) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s PhaseContext) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ReportWithRawData) GoString() string {
 	return s.String()
 }
 
-// SetMessage sets the Message field's value.
-func (s *PhaseContext) SetMessage(v string) *PhaseContext {
-	s.Message = &v
+// SetData sets the Data field's value.
+func (s *ReportWithRawData) SetData(v string) *ReportWithRawData {
+	s.Data = &v
 	return s
 }
 
-// SetStatusCode sets the StatusCode field's value.
-func (s *PhaseContext) SetStatusCode(v string) *PhaseContext {
-	s.StatusCode = &v
+// SetReportArn sets the ReportArn field's value.
+func (s *ReportWithRawData) SetReportArn(v string) *ReportWithRawData {
+	s.ReportArn = &v
 	return s
 }
 
-// Information about a build project.
-type Project struct {
+// Represents a resolved build artifact. A resolved artifact is an artifact
+// that is built and deployed to the destination, such as Amazon S3.
+type ResolvedArtifact struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the build project.
-	Arn *string `locationName:"arn" type:"string"`
-
-	// Information about the build output artifacts for the build project.
-	Artifacts *ProjectArtifacts `locationName:"artifacts" type:"structure"`
-
-	// Information about the build badge for the build project.
-	Badge *ProjectBadge `locationName:"badge" type:"structure"`
-
-	// Information about the cache for the build project.
-	Cache *ProjectCache `locationName:"cache" type:"structure"`
-
-	// When the build project was created, expressed in Unix time format.
-	Created *time.Time `locationName:"created" type:"timestamp"`
-
-	// A description that makes the build project easy to identify.
-	Description *string `locationName:"description" type:"string"`
-
-	// The AWS Key Management Service (AWS KMS) customer master key (CMK) to be
-	// used for encrypting the build output artifacts.
-	//
-	// You can use a cross-account KMS key to encrypt the build output artifacts
-	// if your service role has permission to that key.
-	//
-	// You can specify either the Amazon Resource Name (ARN) of the CMK or, if available,
-	// the CMK's alias (using the format alias/alias-name ).
-	EncryptionKey *string `locationName:"encryptionKey" min:"1" type:"string"`
-
-	// Information about the build environment for this build project.
-	Environment *ProjectEnvironment `locationName:"environment" type:"structure"`
-
-	// When the build project's settings were last modified, expressed in Unix time
-	// format.
-	LastModified *time.Time `locationName:"lastModified" type:"timestamp"`
-
-	// Information about logs for the build project. A project can create logs in
-	// Amazon CloudWatch Logs, an S3 bucket, or both.
-	LogsConfig *LogsConfig `locationName:"logsConfig" type:"structure"`
-
-	// The name of the build project.
-	Name *string `locationName:"name" min:"2" type:"string"`
-
-	// The number of minutes a build is allowed to be queued before it times out.
-	QueuedTimeoutInMinutes *int64 `locationName:"queuedTimeoutInMinutes" min:"5" type:"integer"`
-
-	// An array of ProjectArtifacts objects.
-	SecondaryArtifacts []*ProjectArtifacts `locationName:"secondaryArtifacts" type:"list"`
-
-	// An array of ProjectSourceVersion objects. If secondarySourceVersions is specified
-	// at the build level, then they take over these secondarySourceVersions (at
-	// the project level).
-	SecondarySourceVersions []*ProjectSourceVersion `locationName:"secondarySourceVersions" type:"list"`
-
-	// An array of ProjectSource objects.
-	SecondarySources []*ProjectSource `locationName:"secondarySources" type:"list"`
-
-	// The ARN of the AWS Identity and Access Management (IAM) role that enables
-	// AWS CodeBuild to interact with dependent AWS services on behalf of the AWS
-	// account.
-	ServiceRole *string `locationName:"serviceRole" min:"1" type:"string"`
-
-	// Information about the build input source code for this build project.
-	Source *ProjectSource `locationName:"source" type:"structure"`
-
-	// A version of the build input to be built for this project. If not specified,
-	// the latest version is used. If specified, it must be one of:
-	//
-	//    * For AWS CodeCommit: the commit ID to use.
-	//
-	//    * For GitHub: the commit ID, pull request ID, branch name, or tag name
-	//    that corresponds to the version of the source code you want to build.
-	//    If a pull request ID is specified, it must use the format pr/pull-request-ID
-	//    (for example pr/25). If a branch name is specified, the branch's HEAD
-	//    commit ID is used. If not specified, the default branch's HEAD commit
-	//    ID is used.
-	//
-	//    * For Bitbucket: the commit ID, branch name, or tag name that corresponds
-	//    to the version of the source code you want to build. If a branch name
-	//    is specified, the branch's HEAD commit ID is used. If not specified, the
-	//    default branch's HEAD commit ID is used.
-	//
-	//    * For Amazon Simple Storage Service (Amazon S3): the version ID of the
-	//    object that represents the build input ZIP file to use.
-	//
-	// If sourceVersion is specified at the build level, then that version takes
-	// precedence over this sourceVersion (at the project level).
-	//
-	// For more information, see Source Version Sample with CodeBuild (https://docs.aws.amazon.com/codebuild/latest/userguide/sample-source-version.html)
-	// in the AWS CodeBuild User Guide.
-	SourceVersion *string `locationName:"sourceVersion" type:"string"`
-
-	// The tags for this build project.
-	//
-	// These tags are available for use by AWS services that support AWS CodeBuild
-	// build project tags.
-	Tags []*Tag `locationName:"tags" type:"list"`
-
-	// How long, in minutes, from 5 to 480 (8 hours), for AWS CodeBuild to wait
-	// before timing out any related build that did not get marked as completed.
-	// The default is 60 minutes.
-	TimeoutInMinutes *int64 `locationName:"timeoutInMinutes" min:"5" type:"integer"`
+	// The identifier of the artifact.
+	Identifier *string `locationName:"identifier" type:"string"`
 
-	// Information about the VPC configuration that AWS CodeBuild accesses.
-	VpcConfig *VpcConfig `locationName:"vpcConfig" type:"structure"`
+	// The location of the artifact.
+	Location *string `locationName:"location" type:"string"`
 
-	// Information about a webhook that connects repository events to a build project
-	// in AWS CodeBuild.
-	Webhook *Webhook `locationName:"webhook" type:"structure"`
+	// Specifies the type of artifact.
+	Type *string `locationName:"type" type:"string" enum:"ArtifactsType"`
 }
 
-// String returns the string representation
-func (s Project) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResolvedArtifact) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Project) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResolvedArtifact) GoString() string {
 	return s.String()
 }
 
-// SetArn sets the Arn field's value.
-func (s *Project) SetArn(v string) *Project {
-	s.Arn = &v
-	return s
-}
-
-// SetArtifacts sets the Artifacts field's value.
-func (s *Project) SetArtifacts(v *ProjectArtifacts) *Project {
-	s.Artifacts = v
-	return s
-}
-
-// SetBadge sets the Badge field's value.
-func (s *Project) SetBadge(v *ProjectBadge) *Project {
-	s.Badge = v
-	return s
-}
-
-// SetCache sets the Cache field's value.
-func (s *Project) SetCache(v *ProjectCache) *Project {
-	s.Cache = v
+// SetIdentifier sets the Identifier field's value.
+func (s *ResolvedArtifact) SetIdentifier(v string) *ResolvedArtifact {
+	s.Identifier = &v
 	return s
 }
 
-// SetCreated sets the Created field's value.
-func (s *Project) SetCreated(v time.Time) *Project {
-	s.Created = &v
+// SetLocation sets the Location field's value.
+func (s *ResolvedArtifact) SetLocation(v string) *ResolvedArtifact {
+	s.Location = &v
 	return s
 }
 
-// SetDescription sets the Description field's value.
-func (s *Project) SetDescription(v string) *Project {
-	s.Description = &v
+// SetType sets the Type field's value.
+func (s *ResolvedArtifact) SetType(v string) *ResolvedArtifact {
+	s.Type = &v
 	return s
 }
 
-// SetEncryptionKey sets the EncryptionKey field's value.
-func (s *Project) SetEncryptionKey(v string) *Project {
-	s.EncryptionKey = &v
-	return s
-}
+// The specified Amazon Web Services resource cannot be created, because an
+// Amazon Web Services resource with the same settings already exists.
+type ResourceAlreadyExistsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-// SetEnvironment sets the Environment field's value.
-func (s *Project) SetEnvironment(v *ProjectEnvironment) *Project {
-	s.Environment = v
-	return s
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// SetLastModified sets the LastModified field's value.
-func (s *Project) SetLastModified(v time.Time) *Project {
-	s.LastModified = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceAlreadyExistsException) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetLogsConfig sets the LogsConfig field's value.
-func (s *Project) SetLogsConfig(v *LogsConfig) *Project {
-	s.LogsConfig = v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceAlreadyExistsException) GoString() string {
+	return s.String()
 }
 
-// SetName sets the Name field's value.
-func (s *Project) SetName(v string) *Project {
-	s.Name = &v
-	return s
+func newErrorResourceAlreadyExistsException(v protocol.ResponseMetadata) error {
+	return &ResourceAlreadyExistsException{
+		RespMetadata: v,
+	}
 }
 
-// SetQueuedTimeoutInMinutes sets the QueuedTimeoutInMinutes field's value.
-func (s *Project) SetQueuedTimeoutInMinutes(v int64) *Project {
-	s.QueuedTimeoutInMinutes = &v
-	return s
+// Code returns the exception type name.
+func (s *ResourceAlreadyExistsException) Code() string {
+	return "ResourceAlreadyExistsException"
 }
 
-// SetSecondaryArtifacts sets the SecondaryArtifacts field's value.
-func (s *Project) SetSecondaryArtifacts(v []*ProjectArtifacts) *Project {
-	s.SecondaryArtifacts = v
-	return s
+// Message returns the exception's message.
+func (s *ResourceAlreadyExistsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// SetSecondarySourceVersions sets the SecondarySourceVersions field's value.
-func (s *Project) SetSecondarySourceVersions(v []*ProjectSourceVersion) *Project {
-	s.SecondarySourceVersions = v
-	return s
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ResourceAlreadyExistsException) OrigErr() error {
+	return nil
 }
 
-// SetSecondarySources sets the SecondarySources field's value.
-func (s *Project) SetSecondarySources(v []*ProjectSource) *Project {
-	s.SecondarySources = v
-	return s
+func (s *ResourceAlreadyExistsException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// SetServiceRole sets the ServiceRole field's value.
-func (s *Project) SetServiceRole(v string) *Project {
-	s.ServiceRole = &v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *ResourceAlreadyExistsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// SetSource sets the Source field's value.
-func (s *Project) SetSource(v *ProjectSource) *Project {
-	s.Source = v
-	return s
+// RequestID returns the service's response RequestID for request.
+func (s *ResourceAlreadyExistsException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// SetSourceVersion sets the SourceVersion field's value.
-func (s *Project) SetSourceVersion(v string) *Project {
-	s.SourceVersion = &v
-	return s
-}
+// The specified Amazon Web Services resource cannot be found.
+type ResourceNotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-// SetTags sets the Tags field's value.
-func (s *Project) SetTags(v []*Tag) *Project {
-	s.Tags = v
-	return s
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// SetTimeoutInMinutes sets the TimeoutInMinutes field's value.
-func (s *Project) SetTimeoutInMinutes(v int64) *Project {
-	s.TimeoutInMinutes = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceNotFoundException) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetVpcConfig sets the VpcConfig field's value.
-func (s *Project) SetVpcConfig(v *VpcConfig) *Project {
-	s.VpcConfig = v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceNotFoundException) GoString() string {
+	return s.String()
 }
 
-// SetWebhook sets the Webhook field's value.
-func (s *Project) SetWebhook(v *Webhook) *Project {
-	s.Webhook = v
-	return s
+func newErrorResourceNotFoundException(v protocol.ResponseMetadata) error {
+	return &ResourceNotFoundException{
+		RespMetadata: v,
+	}
 }
 
-// Information about the build output artifacts for the build project.
-type ProjectArtifacts struct {
-	_ struct{} `type:"structure"`
+// Code returns the exception type name.
+func (s *ResourceNotFoundException) Code() string {
+	return "ResourceNotFoundException"
+}
 
-	// An identifier for this artifact definition.
-	ArtifactIdentifier *string `locationName:"artifactIdentifier" type:"string"`
+// Message returns the exception's message.
+func (s *ResourceNotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
 
-	// Set to true if you do not want your output artifacts encrypted. This option
-	// is valid only if your artifacts type is Amazon Simple Storage Service (Amazon
-	// S3). If this is set with another artifacts type, an invalidInputException
-	// is thrown.
-	EncryptionDisabled *bool `locationName:"encryptionDisabled" type:"boolean"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ResourceNotFoundException) OrigErr() error {
+	return nil
+}
 
-	// Information about the build output artifact location:
-	//
-	//    * If type is set to CODEPIPELINE, AWS CodePipeline ignores this value
-	//    if specified. This is because AWS CodePipeline manages its build output
-	//    locations instead of AWS CodeBuild.
-	//
-	//    * If type is set to NO_ARTIFACTS, this value is ignored if specified,
-	//    because no build output is produced.
-	//
-	//    * If type is set to S3, this is the name of the output bucket.
-	Location *string `locationName:"location" type:"string"`
+func (s *ResourceNotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
 
-	// Along with path and namespaceType, the pattern that AWS CodeBuild uses to
-	// name and store the output artifact:
-	//
-	//    * If type is set to CODEPIPELINE, AWS CodePipeline ignores this value
-	//    if specified. This is because AWS CodePipeline manages its build output
-	//    names instead of AWS CodeBuild.
-	//
-	//    * If type is set to NO_ARTIFACTS, this value is ignored if specified,
-	//    because no build output is produced.
-	//
-	//    * If type is set to S3, this is the name of the output artifact object.
-	//    If you set the name to be a forward slash ("/"), the artifact is stored
-	//    in the root of the output bucket.
-	//
-	// For example:
-	//
-	//    * If path is set to MyArtifacts, namespaceType is set to BUILD_ID, and
-	//    name is set to MyArtifact.zip, then the output artifact is stored in MyArtifacts/build-ID/MyArtifact.zip.
-	//
-	//    * If path is empty, namespaceType is set to NONE, and name is set to "/",
-	//    the output artifact is stored in the root of the output bucket.
-	//
-	//    * If path is set to MyArtifacts, namespaceType is set to BUILD_ID, and
-	//    name is set to "/", the output artifact is stored in MyArtifacts/build-ID .
-	Name *string `locationName:"name" type:"string"`
+// Status code returns the HTTP status code for the request's response error.
+func (s *ResourceNotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
 
-	// Along with path and name, the pattern that AWS CodeBuild uses to determine
-	// the name and location to store the output artifact:
-	//
-	//    * If type is set to CODEPIPELINE, AWS CodePipeline ignores this value
-	//    if specified. This is because AWS CodePipeline manages its build output
-	//    names instead of AWS CodeBuild.
-	//
-	//    * If type is set to NO_ARTIFACTS, this value is ignored if specified,
-	//    because no build output is produced.
-	//
-	//    * If type is set to S3, valid values include: BUILD_ID: Include the build
-	//    ID in the location of the build output artifact. NONE: Do not include
-	//    the build ID. This is the default if namespaceType is not specified.
-	//
-	// For example, if path is set to MyArtifacts, namespaceType is set to BUILD_ID,
-	// and name is set to MyArtifact.zip, the output artifact is stored in MyArtifacts/build-ID/MyArtifact.zip.
-	NamespaceType *string `locationName:"namespaceType" type:"string" enum:"ArtifactNamespace"`
+// RequestID returns the service's response RequestID for request.
+func (s *ResourceNotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// If this flag is set, a name specified in the build spec file overrides the
-	// artifact name. The name specified in a build spec file is calculated at build
-	// time and uses the Shell Command Language. For example, you can append a date
-	// and time to your artifact name so that it is always unique.
-	OverrideArtifactName *bool `locationName:"overrideArtifactName" type:"boolean"`
+type RetryBuildBatchInput struct {
+	_ struct{} `type:"structure"`
 
-	// The type of build output artifact to create:
-	//
-	//    * If type is set to CODEPIPELINE, AWS CodePipeline ignores this value
-	//    if specified. This is because AWS CodePipeline manages its build output
-	//    artifacts instead of AWS CodeBuild.
-	//
-	//    * If type is set to NO_ARTIFACTS, this value is ignored if specified,
-	//    because no build output is produced.
-	//
-	//    * If type is set to S3, valid values include: NONE: AWS CodeBuild creates
-	//    in the output bucket a folder that contains the build output. This is
-	//    the default if packaging is not specified. ZIP: AWS CodeBuild creates
-	//    in the output bucket a ZIP file that contains the build output.
-	Packaging *string `locationName:"packaging" type:"string" enum:"ArtifactPackaging"`
+	// Specifies the identifier of the batch build to restart.
+	Id *string `locationName:"id" min:"1" type:"string"`
 
-	// Along with namespaceType and name, the pattern that AWS CodeBuild uses to
-	// name and store the output artifact:
-	//
-	//    * If type is set to CODEPIPELINE, AWS CodePipeline ignores this value
-	//    if specified. This is because AWS CodePipeline manages its build output
-	//    names instead of AWS CodeBuild.
-	//
-	//    * If type is set to NO_ARTIFACTS, this value is ignored if specified,
-	//    because no build output is produced.
-	//
-	//    * If type is set to S3, this is the path to the output artifact. If path
-	//    is not specified, path is not used.
-	//
-	// For example, if path is set to MyArtifacts, namespaceType is set to NONE,
-	// and name is set to MyArtifact.zip, the output artifact is stored in the output
-	// bucket at MyArtifacts/MyArtifact.zip.
-	Path *string `locationName:"path" type:"string"`
+	// A unique, case sensitive identifier you provide to ensure the idempotency
+	// of the RetryBuildBatch request. The token is included in the RetryBuildBatch
+	// request and is valid for five minutes. If you repeat the RetryBuildBatch
+	// request with the same token, but change a parameter, CodeBuild returns a
+	// parameter mismatch error.
+	IdempotencyToken *string `locationName:"idempotencyToken" type:"string"`
 
-	// The type of build output artifact. Valid values include:
-	//
-	//    * CODEPIPELINE: The build project has build output generated through AWS
-	//    CodePipeline.
-	//
-	//    * NO_ARTIFACTS: The build project does not produce any build output.
-	//
-	//    * S3: The build project stores build output in Amazon Simple Storage Service
-	//    (Amazon S3).
-	//
-	// Type is a required field
-	Type *string `locationName:"type" type:"string" required:"true" enum:"ArtifactsType"`
+	// Specifies the type of retry to perform.
+	RetryType *string `locationName:"retryType" type:"string" enum:"RetryBuildBatchType"`
 }
 
-// String returns the string representation
-func (s ProjectArtifacts) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RetryBuildBatchInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ProjectArtifacts) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RetryBuildBatchInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ProjectArtifacts) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ProjectArtifacts"}
-	if s.Type == nil {
-		invalidParams.Add(request.NewErrParamRequired("Type"))
+func (s *RetryBuildBatchInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "RetryBuildBatchInput"}
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -4394,165 +13169,225 @@ func (s *ProjectArtifacts) Validate() error {
 	return nil
 }
 
-// SetArtifactIdentifier sets the ArtifactIdentifier field's value.
-func (s *ProjectArtifacts) SetArtifactIdentifier(v string) *ProjectArtifacts {
-	s.ArtifactIdentifier = &v
-	return s
-}
-
-// SetEncryptionDisabled sets the EncryptionDisabled field's value.
-func (s *ProjectArtifacts) SetEncryptionDisabled(v bool) *ProjectArtifacts {
-	s.EncryptionDisabled = &v
+// SetId sets the Id field's value.
+func (s *RetryBuildBatchInput) SetId(v string) *RetryBuildBatchInput {
+	s.Id = &v
 	return s
 }
 
-// SetLocation sets the Location field's value.
-func (s *ProjectArtifacts) SetLocation(v string) *ProjectArtifacts {
-	s.Location = &v
+// SetIdempotencyToken sets the IdempotencyToken field's value.
+func (s *RetryBuildBatchInput) SetIdempotencyToken(v string) *RetryBuildBatchInput {
+	s.IdempotencyToken = &v
 	return s
 }
 
-// SetName sets the Name field's value.
-func (s *ProjectArtifacts) SetName(v string) *ProjectArtifacts {
-	s.Name = &v
+// SetRetryType sets the RetryType field's value.
+func (s *RetryBuildBatchInput) SetRetryType(v string) *RetryBuildBatchInput {
+	s.RetryType = &v
 	return s
 }
 
-// SetNamespaceType sets the NamespaceType field's value.
-func (s *ProjectArtifacts) SetNamespaceType(v string) *ProjectArtifacts {
-	s.NamespaceType = &v
-	return s
-}
+type RetryBuildBatchOutput struct {
+	_ struct{} `type:"structure"`
 
-// SetOverrideArtifactName sets the OverrideArtifactName field's value.
-func (s *ProjectArtifacts) SetOverrideArtifactName(v bool) *ProjectArtifacts {
-	s.OverrideArtifactName = &v
-	return s
+	// Contains information about a batch build.
+	BuildBatch *BuildBatch `locationName:"buildBatch" type:"structure"`
 }
 
-// SetPackaging sets the Packaging field's value.
-func (s *ProjectArtifacts) SetPackaging(v string) *ProjectArtifacts {
-	s.Packaging = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RetryBuildBatchOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetPath sets the Path field's value.
-func (s *ProjectArtifacts) SetPath(v string) *ProjectArtifacts {
-	s.Path = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RetryBuildBatchOutput) GoString() string {
+	return s.String()
 }
 
-// SetType sets the Type field's value.
-func (s *ProjectArtifacts) SetType(v string) *ProjectArtifacts {
-	s.Type = &v
+// SetBuildBatch sets the BuildBatch field's value.
+func (s *RetryBuildBatchOutput) SetBuildBatch(v *BuildBatch) *RetryBuildBatchOutput {
+	s.BuildBatch = v
 	return s
 }
 
-// Information about the build badge for the build project.
-type ProjectBadge struct {
+type RetryBuildInput struct {
 	_ struct{} `type:"structure"`
 
-	// Set this to true to generate a publicly accessible URL for your project's
-	// build badge.
-	BadgeEnabled *bool `locationName:"badgeEnabled" type:"boolean"`
+	// Specifies the identifier of the build to restart.
+	Id *string `locationName:"id" min:"1" type:"string"`
 
-	// The publicly-accessible URL through which you can access the build badge
-	// for your project.
-	//
-	// The publicly accessible URL through which you can access the build badge
-	// for your project.
-	BadgeRequestUrl *string `locationName:"badgeRequestUrl" type:"string"`
+	// A unique, case sensitive identifier you provide to ensure the idempotency
+	// of the RetryBuild request. The token is included in the RetryBuild request
+	// and is valid for five minutes. If you repeat the RetryBuild request with
+	// the same token, but change a parameter, CodeBuild returns a parameter mismatch
+	// error.
+	IdempotencyToken *string `locationName:"idempotencyToken" type:"string"`
 }
 
-// String returns the string representation
-func (s ProjectBadge) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RetryBuildInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ProjectBadge) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RetryBuildInput) GoString() string {
 	return s.String()
 }
 
-// SetBadgeEnabled sets the BadgeEnabled field's value.
-func (s *ProjectBadge) SetBadgeEnabled(v bool) *ProjectBadge {
-	s.BadgeEnabled = &v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *RetryBuildInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "RetryBuildInput"}
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetId sets the Id field's value.
+func (s *RetryBuildInput) SetId(v string) *RetryBuildInput {
+	s.Id = &v
 	return s
 }
 
-// SetBadgeRequestUrl sets the BadgeRequestUrl field's value.
-func (s *ProjectBadge) SetBadgeRequestUrl(v string) *ProjectBadge {
-	s.BadgeRequestUrl = &v
+// SetIdempotencyToken sets the IdempotencyToken field's value.
+func (s *RetryBuildInput) SetIdempotencyToken(v string) *RetryBuildInput {
+	s.IdempotencyToken = &v
 	return s
 }
 
-// Information about the cache for the build project.
-type ProjectCache struct {
+type RetryBuildOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Information about a build.
+	Build *Build `locationName:"build" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RetryBuildOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RetryBuildOutput) GoString() string {
+	return s.String()
+}
+
+// SetBuild sets the Build field's value.
+func (s *RetryBuildOutput) SetBuild(v *Build) *RetryBuildOutput {
+	s.Build = v
+	return s
+}
+
+// Information about S3 logs for a build project.
+type S3LogsConfig struct {
 	_ struct{} `type:"structure"`
 
-	// Information about the cache location:
+	// Specifies the bucket owner's access for objects that another account uploads
+	// to their Amazon S3 bucket. By default, only the account that uploads the
+	// objects to the bucket has access to these objects. This property allows you
+	// to give the bucket owner access to these objects.
 	//
-	//    * NO_CACHE or LOCAL: This value is ignored.
+	// To use this property, your CodeBuild service role must have the s3:PutBucketAcl
+	// permission. This permission allows CodeBuild to modify the access control
+	// list for the bucket.
 	//
-	//    * S3: This is the S3 bucket name/prefix.
-	Location *string `locationName:"location" type:"string"`
+	// This property can be one of the following values:
+	//
+	// NONE
+	//
+	// The bucket owner does not have access to the objects. This is the default.
+	//
+	// READ_ONLY
+	//
+	// The bucket owner has read-only access to the objects. The uploading account
+	// retains ownership of the objects.
+	//
+	// FULL
+	//
+	// The bucket owner has full access to the objects. Object ownership is determined
+	// by the following criteria:
+	//
+	//    * If the bucket is configured with the Bucket owner preferred setting,
+	//    the bucket owner owns the objects. The uploading account will have object
+	//    access as specified by the bucket's policy.
+	//
+	//    * Otherwise, the uploading account retains ownership of the objects.
+	//
+	// For more information about Amazon S3 object ownership, see Controlling ownership
+	// of uploaded objects using S3 Object Ownership (https://docs.aws.amazon.com/AmazonS3/latest/userguide/about-object-ownership.html)
+	// in the Amazon Simple Storage Service User Guide.
+	BucketOwnerAccess *string `locationName:"bucketOwnerAccess" type:"string" enum:"BucketOwnerAccess"`
 
-	// If you use a LOCAL cache, the local cache mode. You can use one or more local
-	// cache modes at the same time.
-	//
-	//    * LOCAL_SOURCE_CACHE mode caches Git metadata for primary and secondary
-	//    sources. After the cache is created, subsequent builds pull only the change
-	//    between commits. This mode is a good choice for projects with a clean
-	//    working directory and a source that is a large Git repository. If you
-	//    choose this option and your project does not use a Git repository (GitHub,
-	//    GitHub Enterprise, or Bitbucket), the option is ignored.
-	//
-	//    * LOCAL_DOCKER_LAYER_CACHE mode caches existing Docker layers. This mode
-	//    is a good choice for projects that build or pull large Docker images.
-	//    It can prevent the performance issues caused by pulling large Docker images
-	//    down from the network. You can use a Docker layer cache in the Linux environment
-	//    only. The privileged flag must be set so that your project has the required
-	//    Docker permissions. You should consider the security implications before
-	//    you use a Docker layer cache.
-	//
-	//    * LOCAL_CUSTOM_CACHE mode caches directories you specify in the buildspec
-	//    file. This mode is a good choice if your build scenario is not suited
-	//    to one of the other three local cache modes. If you use a custom cache:
-	//    Only directories can be specified for caching. You cannot specify individual
-	//    files. Symlinks are used to reference cached directories. Cached directories
-	//    are linked to your build before it downloads its project sources. Cached
-	//    items are overriden if a source item has the same name. Directories are
-	//    specified using cache paths in the buildspec file.
-	Modes []*string `locationName:"modes" type:"list"`
+	// Set to true if you do not want your S3 build log output encrypted. By default
+	// S3 build logs are encrypted.
+	EncryptionDisabled *bool `locationName:"encryptionDisabled" type:"boolean"`
 
-	// The type of cache used by the build project. Valid values include:
-	//
-	//    * NO_CACHE: The build project does not use any cache.
+	// The ARN of an S3 bucket and the path prefix for S3 logs. If your Amazon S3
+	// bucket name is my-bucket, and your path prefix is build-log, then acceptable
+	// formats are my-bucket/build-log or arn:aws:s3:::my-bucket/build-log.
+	Location *string `locationName:"location" type:"string"`
+
+	// The current status of the S3 build logs. Valid values are:
 	//
-	//    * S3: The build project reads and writes from and to S3.
+	//    * ENABLED: S3 build logs are enabled for this build project.
 	//
-	//    * LOCAL: The build project stores a cache locally on a build host that
-	//    is only available to that build host.
+	//    * DISABLED: S3 build logs are not enabled for this build project.
 	//
-	// Type is a required field
-	Type *string `locationName:"type" type:"string" required:"true" enum:"CacheType"`
+	// Status is a required field
+	Status *string `locationName:"status" type:"string" required:"true" enum:"LogsConfigStatusType"`
 }
 
-// String returns the string representation
-func (s ProjectCache) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s S3LogsConfig) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ProjectCache) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s S3LogsConfig) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ProjectCache) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ProjectCache"}
-	if s.Type == nil {
-		invalidParams.Add(request.NewErrParamRequired("Type"))
+func (s *S3LogsConfig) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "S3LogsConfig"}
+	if s.Status == nil {
+		invalidParams.Add(request.NewErrParamRequired("Status"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -4561,147 +13396,86 @@ func (s *ProjectCache) Validate() error {
 	return nil
 }
 
-// SetLocation sets the Location field's value.
-func (s *ProjectCache) SetLocation(v string) *ProjectCache {
-	s.Location = &v
+// SetBucketOwnerAccess sets the BucketOwnerAccess field's value.
+func (s *S3LogsConfig) SetBucketOwnerAccess(v string) *S3LogsConfig {
+	s.BucketOwnerAccess = &v
 	return s
 }
 
-// SetModes sets the Modes field's value.
-func (s *ProjectCache) SetModes(v []*string) *ProjectCache {
-	s.Modes = v
+// SetEncryptionDisabled sets the EncryptionDisabled field's value.
+func (s *S3LogsConfig) SetEncryptionDisabled(v bool) *S3LogsConfig {
+	s.EncryptionDisabled = &v
 	return s
 }
 
-// SetType sets the Type field's value.
-func (s *ProjectCache) SetType(v string) *ProjectCache {
-	s.Type = &v
+// SetLocation sets the Location field's value.
+func (s *S3LogsConfig) SetLocation(v string) *S3LogsConfig {
+	s.Location = &v
 	return s
 }
 
-// Information about the build environment of the build project.
-type ProjectEnvironment struct {
-	_ struct{} `type:"structure"`
+// SetStatus sets the Status field's value.
+func (s *S3LogsConfig) SetStatus(v string) *S3LogsConfig {
+	s.Status = &v
+	return s
+}
 
-	// The certificate to use with this build project.
-	Certificate *string `locationName:"certificate" type:"string"`
+// Information about the S3 bucket where the raw data of a report are exported.
+type S3ReportExportConfig struct {
+	_ struct{} `type:"structure"`
 
-	// Information about the compute resources the build project uses. Available
-	// values include:
-	//
-	//    * BUILD_GENERAL1_SMALL: Use up to 3 GB memory and 2 vCPUs for builds.
-	//
-	//    * BUILD_GENERAL1_MEDIUM: Use up to 7 GB memory and 4 vCPUs for builds.
-	//
-	//    * BUILD_GENERAL1_LARGE: Use up to 15 GB memory and 8 vCPUs for builds.
-	//
-	// ComputeType is a required field
-	ComputeType *string `locationName:"computeType" type:"string" required:"true" enum:"ComputeType"`
+	// The name of the S3 bucket where the raw data of a report are exported.
+	Bucket *string `locationName:"bucket" min:"1" type:"string"`
 
-	// A set of environment variables to make available to builds for this build
-	// project.
-	EnvironmentVariables []*EnvironmentVariable `locationName:"environmentVariables" type:"list"`
+	// The Amazon Web Services account identifier of the owner of the Amazon S3
+	// bucket. This allows report data to be exported to an Amazon S3 bucket that
+	// is owned by an account other than the account running the build.
+	BucketOwner *string `locationName:"bucketOwner" type:"string"`
 
-	// The image tag or image digest that identifies the Docker image to use for
-	// this build project. Use the following formats:
-	//
-	//    * For an image tag: registry/repository:tag. For example, to specify an
-	//    image with the tag "latest," use registry/repository:latest.
-	//
-	//    * For an image digest: registry/repository@digest. For example, to specify
-	//    an image with the digest "sha256:cbbf2f9a99b47fc460d422812b6a5adff7dfee951d8fa2e4a98caa0382cfbdbf,"
-	//    use registry/repository@sha256:cbbf2f9a99b47fc460d422812b6a5adff7dfee951d8fa2e4a98caa0382cfbdbf.
-	//
-	// Image is a required field
-	Image *string `locationName:"image" min:"1" type:"string" required:"true"`
+	// A boolean value that specifies if the results of a report are encrypted.
+	EncryptionDisabled *bool `locationName:"encryptionDisabled" type:"boolean"`
 
-	// The type of credentials AWS CodeBuild uses to pull images in your build.
-	// There are two valid values:
-	//
-	//    * CODEBUILD specifies that AWS CodeBuild uses its own credentials. This
-	//    requires that you modify your ECR repository policy to trust AWS CodeBuild's
-	//    service principal.
-	//
-	//    * SERVICE_ROLE specifies that AWS CodeBuild uses your build project's
-	//    service role.
-	//
-	// When you use a cross-account or private registry image, you must use SERVICE_ROLE
-	// credentials. When you use an AWS CodeBuild curated image, you must use CODEBUILD
-	// credentials.
-	ImagePullCredentialsType *string `locationName:"imagePullCredentialsType" type:"string" enum:"ImagePullCredentialsType"`
+	// The encryption key for the report's encrypted raw data.
+	EncryptionKey *string `locationName:"encryptionKey" min:"1" type:"string"`
 
-	// Enables running the Docker daemon inside a Docker container. Set to true
-	// only if the build project is used to build Docker images. Otherwise, a build
-	// that attempts to interact with the Docker daemon fails.
-	//
-	// You can initialize the Docker daemon during the install phase of your build
-	// by adding one of the following sets of commands to the install phase of your
-	// buildspec file:
-	//
-	// If the operating system's base image is Ubuntu Linux:
-	//
-	// - nohup /usr/local/bin/dockerd --host=unix:///var/run/docker.sock --host=tcp://0.0.0.0:2375
-	// --storage-driver=overlay&
-	//
-	// - timeout 15 sh -c "until docker info; do echo .; sleep 1; done"
-	//
-	// If the operating system's base image is Alpine Linux and the previous command
-	// does not work, add the -t argument to timeout:
+	// The type of build output artifact to create. Valid values include:
 	//
-	// - nohup /usr/local/bin/dockerd --host=unix:///var/run/docker.sock --host=tcp://0.0.0.0:2375
-	// --storage-driver=overlay&
+	//    * NONE: CodeBuild creates the raw data in the output bucket. This is the
+	//    default if packaging is not specified.
 	//
-	// - timeout -t 15 sh -c "until docker info; do echo .; sleep 1; done"
-	PrivilegedMode *bool `locationName:"privilegedMode" type:"boolean"`
-
-	// The credentials for access to a private registry.
-	RegistryCredential *RegistryCredential `locationName:"registryCredential" type:"structure"`
+	//    * ZIP: CodeBuild creates a ZIP file with the raw data in the output bucket.
+	Packaging *string `locationName:"packaging" type:"string" enum:"ReportPackagingType"`
 
-	// The type of build environment to use for related builds.
-	//
-	// Type is a required field
-	Type *string `locationName:"type" type:"string" required:"true" enum:"EnvironmentType"`
+	// The path to the exported report's raw data results.
+	Path *string `locationName:"path" type:"string"`
 }
 
-// String returns the string representation
-func (s ProjectEnvironment) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s S3ReportExportConfig) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ProjectEnvironment) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s S3ReportExportConfig) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ProjectEnvironment) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ProjectEnvironment"}
-	if s.ComputeType == nil {
-		invalidParams.Add(request.NewErrParamRequired("ComputeType"))
-	}
-	if s.Image == nil {
-		invalidParams.Add(request.NewErrParamRequired("Image"))
-	}
-	if s.Image != nil && len(*s.Image) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Image", 1))
-	}
-	if s.Type == nil {
-		invalidParams.Add(request.NewErrParamRequired("Type"))
-	}
-	if s.EnvironmentVariables != nil {
-		for i, v := range s.EnvironmentVariables {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "EnvironmentVariables", i), err.(request.ErrInvalidParams))
-			}
-		}
+func (s *S3ReportExportConfig) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "S3ReportExportConfig"}
+	if s.Bucket != nil && len(*s.Bucket) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Bucket", 1))
 	}
-	if s.RegistryCredential != nil {
-		if err := s.RegistryCredential.Validate(); err != nil {
-			invalidParams.AddNested("RegistryCredential", err.(request.ErrInvalidParams))
-		}
+	if s.EncryptionKey != nil && len(*s.EncryptionKey) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EncryptionKey", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -4710,179 +13484,87 @@ func (s *ProjectEnvironment) Validate() error {
 	return nil
 }
 
-// SetCertificate sets the Certificate field's value.
-func (s *ProjectEnvironment) SetCertificate(v string) *ProjectEnvironment {
-	s.Certificate = &v
-	return s
-}
-
-// SetComputeType sets the ComputeType field's value.
-func (s *ProjectEnvironment) SetComputeType(v string) *ProjectEnvironment {
-	s.ComputeType = &v
-	return s
-}
-
-// SetEnvironmentVariables sets the EnvironmentVariables field's value.
-func (s *ProjectEnvironment) SetEnvironmentVariables(v []*EnvironmentVariable) *ProjectEnvironment {
-	s.EnvironmentVariables = v
+// SetBucket sets the Bucket field's value.
+func (s *S3ReportExportConfig) SetBucket(v string) *S3ReportExportConfig {
+	s.Bucket = &v
 	return s
 }
 
-// SetImage sets the Image field's value.
-func (s *ProjectEnvironment) SetImage(v string) *ProjectEnvironment {
-	s.Image = &v
+// SetBucketOwner sets the BucketOwner field's value.
+func (s *S3ReportExportConfig) SetBucketOwner(v string) *S3ReportExportConfig {
+	s.BucketOwner = &v
 	return s
 }
 
-// SetImagePullCredentialsType sets the ImagePullCredentialsType field's value.
-func (s *ProjectEnvironment) SetImagePullCredentialsType(v string) *ProjectEnvironment {
-	s.ImagePullCredentialsType = &v
+// SetEncryptionDisabled sets the EncryptionDisabled field's value.
+func (s *S3ReportExportConfig) SetEncryptionDisabled(v bool) *S3ReportExportConfig {
+	s.EncryptionDisabled = &v
 	return s
 }
 
-// SetPrivilegedMode sets the PrivilegedMode field's value.
-func (s *ProjectEnvironment) SetPrivilegedMode(v bool) *ProjectEnvironment {
-	s.PrivilegedMode = &v
+// SetEncryptionKey sets the EncryptionKey field's value.
+func (s *S3ReportExportConfig) SetEncryptionKey(v string) *S3ReportExportConfig {
+	s.EncryptionKey = &v
 	return s
 }
 
-// SetRegistryCredential sets the RegistryCredential field's value.
-func (s *ProjectEnvironment) SetRegistryCredential(v *RegistryCredential) *ProjectEnvironment {
-	s.RegistryCredential = v
+// SetPackaging sets the Packaging field's value.
+func (s *S3ReportExportConfig) SetPackaging(v string) *S3ReportExportConfig {
+	s.Packaging = &v
 	return s
 }
 
-// SetType sets the Type field's value.
-func (s *ProjectEnvironment) SetType(v string) *ProjectEnvironment {
-	s.Type = &v
+// SetPath sets the Path field's value.
+func (s *S3ReportExportConfig) SetPath(v string) *S3ReportExportConfig {
+	s.Path = &v
 	return s
 }
 
-// Information about the build input source code for the build project.
-type ProjectSource struct {
+// Information about the authorization settings for CodeBuild to access the
+// source code to be built.
+//
+// This information is for the CodeBuild console's use only. Your code should
+// not get or set this information directly.
+type SourceAuth struct {
 	_ struct{} `type:"structure"`
 
-	// Information about the authorization settings for AWS CodeBuild to access
-	// the source code to be built.
-	//
-	// This information is for the AWS CodeBuild console's use only. Your code should
-	// not get or set this information directly.
-	Auth *SourceAuth `locationName:"auth" type:"structure"`
-
-	// The build spec declaration to use for the builds in this build project.
-	//
-	// If this value is not specified, a build spec must be included along with
-	// the source code to be built.
-	Buildspec *string `locationName:"buildspec" type:"string"`
-
-	// Information about the Git clone depth for the build project.
-	GitCloneDepth *int64 `locationName:"gitCloneDepth" type:"integer"`
-
-	// Information about the Git submodules configuration for the build project.
-	GitSubmodulesConfig *GitSubmodulesConfig `locationName:"gitSubmodulesConfig" type:"structure"`
-
-	// Enable this flag to ignore SSL warnings while connecting to the project source
-	// code.
-	InsecureSsl *bool `locationName:"insecureSsl" type:"boolean"`
-
-	// Information about the location of the source code to be built. Valid values
-	// include:
-	//
-	//    * For source code settings that are specified in the source action of
-	//    a pipeline in AWS CodePipeline, location should not be specified. If it
-	//    is specified, AWS CodePipeline ignores it. This is because AWS CodePipeline
-	//    uses the settings in a pipeline's source action instead of this value.
-	//
-	//    * For source code in an AWS CodeCommit repository, the HTTPS clone URL
-	//    to the repository that contains the source code and the build spec (for
-	//    example, https://git-codecommit.region-ID.amazonaws.com/v1/repos/repo-name
-	//    ).
-	//
-	//    * For source code in an Amazon Simple Storage Service (Amazon S3) input
-	//    bucket, one of the following. The path to the ZIP file that contains the
-	//    source code (for example, bucket-name/path/to/object-name.zip). The path
-	//    to the folder that contains the source code (for example, bucket-name/path/to/source-code/folder/).
-	//
-	//    * For source code in a GitHub repository, the HTTPS clone URL to the repository
-	//    that contains the source and the build spec. You must connect your AWS
-	//    account to your GitHub account. Use the AWS CodeBuild console to start
-	//    creating a build project. When you use the console to connect (or reconnect)
-	//    with GitHub, on the GitHub Authorize application page, for Organization
-	//    access, choose Request access next to each repository you want to allow
-	//    AWS CodeBuild to have access to, and then choose Authorize application.
-	//    (After you have connected to your GitHub account, you do not need to finish
-	//    creating the build project. You can leave the AWS CodeBuild console.)
-	//    To instruct AWS CodeBuild to use this connection, in the source object,
-	//    set the auth object's type value to OAUTH.
-	//
-	//    * For source code in a Bitbucket repository, the HTTPS clone URL to the
-	//    repository that contains the source and the build spec. You must connect
-	//    your AWS account to your Bitbucket account. Use the AWS CodeBuild console
-	//    to start creating a build project. When you use the console to connect
-	//    (or reconnect) with Bitbucket, on the Bitbucket Confirm access to your
-	//    account page, choose Grant access. (After you have connected to your Bitbucket
-	//    account, you do not need to finish creating the build project. You can
-	//    leave the AWS CodeBuild console.) To instruct AWS CodeBuild to use this
-	//    connection, in the source object, set the auth object's type value to
-	//    OAUTH.
-	Location *string `locationName:"location" type:"string"`
-
-	// Set to true to report the status of a build's start and finish to your source
-	// provider. This option is valid only when your source provider is GitHub,
-	// GitHub Enterprise, or Bitbucket. If this is set and you use a different source
-	// provider, an invalidInputException is thrown.
-	ReportBuildStatus *bool `locationName:"reportBuildStatus" type:"boolean"`
-
-	// An identifier for this project source.
-	SourceIdentifier *string `locationName:"sourceIdentifier" type:"string"`
-
-	// The type of repository that contains the source code to be built. Valid values
-	// include:
-	//
-	//    * BITBUCKET: The source code is in a Bitbucket repository.
-	//
-	//    * CODECOMMIT: The source code is in an AWS CodeCommit repository.
-	//
-	//    * CODEPIPELINE: The source code settings are specified in the source action
-	//    of a pipeline in AWS CodePipeline.
-	//
-	//    * GITHUB: The source code is in a GitHub repository.
+	// The resource value that applies to the specified authorization type.
+	Resource *string `locationName:"resource" type:"string"`
+
 	//
-	//    * NO_SOURCE: The project does not have input source code.
+	// This data type is deprecated and is no longer accurate or used.
 	//
-	//    * S3: The source code is in an Amazon Simple Storage Service (Amazon S3)
-	//    input bucket.
+	// The authorization type to use. The only valid value is OAUTH, which represents
+	// the OAuth authorization type.
 	//
 	// Type is a required field
-	Type *string `locationName:"type" type:"string" required:"true" enum:"SourceType"`
+	Type *string `locationName:"type" type:"string" required:"true" enum:"SourceAuthType"`
 }
 
-// String returns the string representation
-func (s ProjectSource) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SourceAuth) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ProjectSource) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SourceAuth) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ProjectSource) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ProjectSource"}
+func (s *SourceAuth) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "SourceAuth"}
 	if s.Type == nil {
 		invalidParams.Add(request.NewErrParamRequired("Type"))
 	}
-	if s.Auth != nil {
-		if err := s.Auth.Validate(); err != nil {
-			invalidParams.AddNested("Auth", err.(request.ErrInvalidParams))
-		}
-	}
-	if s.GitSubmodulesConfig != nil {
-		if err := s.GitSubmodulesConfig.Validate(); err != nil {
-			invalidParams.AddNested("GitSubmodulesConfig", err.(request.ErrInvalidParams))
-		}
-	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -4890,114 +13572,386 @@ func (s *ProjectSource) Validate() error {
 	return nil
 }
 
-// SetAuth sets the Auth field's value.
-func (s *ProjectSource) SetAuth(v *SourceAuth) *ProjectSource {
-	s.Auth = v
+// SetResource sets the Resource field's value.
+func (s *SourceAuth) SetResource(v string) *SourceAuth {
+	s.Resource = &v
 	return s
 }
 
-// SetBuildspec sets the Buildspec field's value.
-func (s *ProjectSource) SetBuildspec(v string) *ProjectSource {
-	s.Buildspec = &v
+// SetType sets the Type field's value.
+func (s *SourceAuth) SetType(v string) *SourceAuth {
+	s.Type = &v
 	return s
 }
 
-// SetGitCloneDepth sets the GitCloneDepth field's value.
-func (s *ProjectSource) SetGitCloneDepth(v int64) *ProjectSource {
-	s.GitCloneDepth = &v
-	return s
-}
+// Information about the credentials for a GitHub, GitHub Enterprise, or Bitbucket
+// repository.
+type SourceCredentialsInfo struct {
+	_ struct{} `type:"structure"`
 
-// SetGitSubmodulesConfig sets the GitSubmodulesConfig field's value.
-func (s *ProjectSource) SetGitSubmodulesConfig(v *GitSubmodulesConfig) *ProjectSource {
-	s.GitSubmodulesConfig = v
-	return s
+	// The Amazon Resource Name (ARN) of the token.
+	Arn *string `locationName:"arn" min:"1" type:"string"`
+
+	// The type of authentication used by the credentials. Valid options are OAUTH,
+	// BASIC_AUTH, or PERSONAL_ACCESS_TOKEN.
+	AuthType *string `locationName:"authType" type:"string" enum:"AuthType"`
+
+	// The type of source provider. The valid options are GITHUB, GITHUB_ENTERPRISE,
+	// or BITBUCKET.
+	ServerType *string `locationName:"serverType" type:"string" enum:"ServerType"`
 }
 
-// SetInsecureSsl sets the InsecureSsl field's value.
-func (s *ProjectSource) SetInsecureSsl(v bool) *ProjectSource {
-	s.InsecureSsl = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SourceCredentialsInfo) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetLocation sets the Location field's value.
-func (s *ProjectSource) SetLocation(v string) *ProjectSource {
-	s.Location = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SourceCredentialsInfo) GoString() string {
+	return s.String()
 }
 
-// SetReportBuildStatus sets the ReportBuildStatus field's value.
-func (s *ProjectSource) SetReportBuildStatus(v bool) *ProjectSource {
-	s.ReportBuildStatus = &v
+// SetArn sets the Arn field's value.
+func (s *SourceCredentialsInfo) SetArn(v string) *SourceCredentialsInfo {
+	s.Arn = &v
 	return s
 }
 
-// SetSourceIdentifier sets the SourceIdentifier field's value.
-func (s *ProjectSource) SetSourceIdentifier(v string) *ProjectSource {
-	s.SourceIdentifier = &v
+// SetAuthType sets the AuthType field's value.
+func (s *SourceCredentialsInfo) SetAuthType(v string) *SourceCredentialsInfo {
+	s.AuthType = &v
 	return s
 }
 
-// SetType sets the Type field's value.
-func (s *ProjectSource) SetType(v string) *ProjectSource {
-	s.Type = &v
+// SetServerType sets the ServerType field's value.
+func (s *SourceCredentialsInfo) SetServerType(v string) *SourceCredentialsInfo {
+	s.ServerType = &v
 	return s
 }
 
-// A source identifier and its corresponding version.
-type ProjectSourceVersion struct {
+type StartBuildBatchInput struct {
 	_ struct{} `type:"structure"`
 
-	// An identifier for a source in the build project.
+	// An array of ProjectArtifacts objects that contains information about the
+	// build output artifact overrides for the build project.
+	ArtifactsOverride *ProjectArtifacts `locationName:"artifactsOverride" type:"structure"`
+
+	// A BuildBatchConfigOverride object that contains batch build configuration
+	// overrides.
+	BuildBatchConfigOverride *ProjectBuildBatchConfig `locationName:"buildBatchConfigOverride" type:"structure"`
+
+	// Overrides the build timeout specified in the batch build project.
+	BuildTimeoutInMinutesOverride *int64 `locationName:"buildTimeoutInMinutesOverride" min:"5" type:"integer"`
+
+	// A buildspec file declaration that overrides, for this build only, the latest
+	// one already defined in the build project.
 	//
-	// SourceIdentifier is a required field
-	SourceIdentifier *string `locationName:"sourceIdentifier" type:"string" required:"true"`
+	// If this value is set, it can be either an inline buildspec definition, the
+	// path to an alternate buildspec file relative to the value of the built-in
+	// CODEBUILD_SRC_DIR environment variable, or the path to an S3 bucket. The
+	// bucket must be in the same Amazon Web Services Region as the build project.
+	// Specify the buildspec file using its ARN (for example, arn:aws:s3:::my-codebuild-sample2/buildspec.yml).
+	// If this value is not provided or is set to an empty string, the source code
+	// must contain a buildspec file in its root directory. For more information,
+	// see Buildspec File Name and Storage Location (https://docs.aws.amazon.com/codebuild/latest/userguide/build-spec-ref.html#build-spec-ref-name-storage).
+	BuildspecOverride *string `locationName:"buildspecOverride" type:"string"`
 
-	// The source version for the corresponding source identifier. If specified,
-	// must be one of:
+	// A ProjectCache object that specifies cache overrides.
+	CacheOverride *ProjectCache `locationName:"cacheOverride" type:"structure"`
+
+	// The name of a certificate for this batch build that overrides the one specified
+	// in the batch build project.
+	CertificateOverride *string `locationName:"certificateOverride" type:"string"`
+
+	// The name of a compute type for this batch build that overrides the one specified
+	// in the batch build project.
+	ComputeTypeOverride *string `locationName:"computeTypeOverride" type:"string" enum:"ComputeType"`
+
+	// Specifies if session debugging is enabled for this batch build. For more
+	// information, see Viewing a running build in Session Manager (https://docs.aws.amazon.com/codebuild/latest/userguide/session-manager.html).
+	// Batch session debugging is not supported for matrix batch builds.
+	DebugSessionEnabled *bool `locationName:"debugSessionEnabled" type:"boolean"`
+
+	// The Key Management Service customer master key (CMK) that overrides the one
+	// specified in the batch build project. The CMK key encrypts the build output
+	// artifacts.
 	//
-	//    * For AWS CodeCommit: the commit ID to use.
+	// You can use a cross-account KMS key to encrypt the build output artifacts
+	// if your service role has permission to that key.
 	//
-	//    * For GitHub: the commit ID, pull request ID, branch name, or tag name
-	//    that corresponds to the version of the source code you want to build.
-	//    If a pull request ID is specified, it must use the format pr/pull-request-ID
-	//    (for example, pr/25). If a branch name is specified, the branch's HEAD
-	//    commit ID is used. If not specified, the default branch's HEAD commit
-	//    ID is used.
+	// You can specify either the Amazon Resource Name (ARN) of the CMK or, if available,
+	// the CMK's alias (using the format alias/<alias-name>).
+	EncryptionKeyOverride *string `locationName:"encryptionKeyOverride" min:"1" type:"string"`
+
+	// A container type for this batch build that overrides the one specified in
+	// the batch build project.
+	EnvironmentTypeOverride *string `locationName:"environmentTypeOverride" type:"string" enum:"EnvironmentType"`
+
+	// An array of EnvironmentVariable objects that override, or add to, the environment
+	// variables defined in the batch build project.
+	EnvironmentVariablesOverride []*EnvironmentVariable `locationName:"environmentVariablesOverride" type:"list"`
+
+	// The user-defined depth of history, with a minimum value of 0, that overrides,
+	// for this batch build only, any previous depth of history defined in the batch
+	// build project.
+	GitCloneDepthOverride *int64 `locationName:"gitCloneDepthOverride" type:"integer"`
+
+	// A GitSubmodulesConfig object that overrides the Git submodules configuration
+	// for this batch build.
+	GitSubmodulesConfigOverride *GitSubmodulesConfig `locationName:"gitSubmodulesConfigOverride" type:"structure"`
+
+	// A unique, case sensitive identifier you provide to ensure the idempotency
+	// of the StartBuildBatch request. The token is included in the StartBuildBatch
+	// request and is valid for five minutes. If you repeat the StartBuildBatch
+	// request with the same token, but change a parameter, CodeBuild returns a
+	// parameter mismatch error.
+	IdempotencyToken *string `locationName:"idempotencyToken" type:"string"`
+
+	// The name of an image for this batch build that overrides the one specified
+	// in the batch build project.
+	ImageOverride *string `locationName:"imageOverride" min:"1" type:"string"`
+
+	// The type of credentials CodeBuild uses to pull images in your batch build.
+	// There are two valid values:
 	//
-	//    * For Bitbucket: the commit ID, branch name, or tag name that corresponds
-	//    to the version of the source code you want to build. If a branch name
-	//    is specified, the branch's HEAD commit ID is used. If not specified, the
-	//    default branch's HEAD commit ID is used.
+	// CODEBUILD
 	//
-	//    * For Amazon Simple Storage Service (Amazon S3): the version ID of the
-	//    object that represents the build input ZIP file to use.
+	// Specifies that CodeBuild uses its own credentials. This requires that you
+	// modify your ECR repository policy to trust CodeBuild's service principal.
 	//
-	// For more information, see Source Version Sample with CodeBuild (https://docs.aws.amazon.com/codebuild/latest/userguide/sample-source-version.html)
-	// in the AWS CodeBuild User Guide.
+	// SERVICE_ROLE
 	//
-	// SourceVersion is a required field
-	SourceVersion *string `locationName:"sourceVersion" type:"string" required:"true"`
+	// Specifies that CodeBuild uses your build project's service role.
+	//
+	// When using a cross-account or private registry image, you must use SERVICE_ROLE
+	// credentials. When using an CodeBuild curated image, you must use CODEBUILD
+	// credentials.
+	ImagePullCredentialsTypeOverride *string `locationName:"imagePullCredentialsTypeOverride" type:"string" enum:"ImagePullCredentialsType"`
+
+	// Enable this flag to override the insecure SSL setting that is specified in
+	// the batch build project. The insecure SSL setting determines whether to ignore
+	// SSL warnings while connecting to the project source code. This override applies
+	// only if the build's source is GitHub Enterprise.
+	InsecureSslOverride *bool `locationName:"insecureSslOverride" type:"boolean"`
+
+	// A LogsConfig object that override the log settings defined in the batch build
+	// project.
+	LogsConfigOverride *LogsConfig `locationName:"logsConfigOverride" type:"structure"`
+
+	// Enable this flag to override privileged mode in the batch build project.
+	PrivilegedModeOverride *bool `locationName:"privilegedModeOverride" type:"boolean"`
+
+	// The name of the project.
+	//
+	// ProjectName is a required field
+	ProjectName *string `locationName:"projectName" min:"1" type:"string" required:"true"`
+
+	// The number of minutes a batch build is allowed to be queued before it times
+	// out.
+	QueuedTimeoutInMinutesOverride *int64 `locationName:"queuedTimeoutInMinutesOverride" min:"5" type:"integer"`
+
+	// A RegistryCredential object that overrides credentials for access to a private
+	// registry.
+	RegistryCredentialOverride *RegistryCredential `locationName:"registryCredentialOverride" type:"structure"`
+
+	// Set to true to report to your source provider the status of a batch build's
+	// start and completion. If you use this option with a source provider other
+	// than GitHub, GitHub Enterprise, or Bitbucket, an invalidInputException is
+	// thrown.
+	//
+	// The status of a build triggered by a webhook is always reported to your source
+	// provider.
+	ReportBuildBatchStatusOverride *bool `locationName:"reportBuildBatchStatusOverride" type:"boolean"`
+
+	// An array of ProjectArtifacts objects that override the secondary artifacts
+	// defined in the batch build project.
+	SecondaryArtifactsOverride []*ProjectArtifacts `locationName:"secondaryArtifactsOverride" type:"list"`
+
+	// An array of ProjectSource objects that override the secondary sources defined
+	// in the batch build project.
+	SecondarySourcesOverride []*ProjectSource `locationName:"secondarySourcesOverride" type:"list"`
+
+	// An array of ProjectSourceVersion objects that override the secondary source
+	// versions in the batch build project.
+	SecondarySourcesVersionOverride []*ProjectSourceVersion `locationName:"secondarySourcesVersionOverride" type:"list"`
+
+	// The name of a service role for this batch build that overrides the one specified
+	// in the batch build project.
+	ServiceRoleOverride *string `locationName:"serviceRoleOverride" min:"1" type:"string"`
+
+	// A SourceAuth object that overrides the one defined in the batch build project.
+	// This override applies only if the build project's source is BitBucket or
+	// GitHub.
+	SourceAuthOverride *SourceAuth `locationName:"sourceAuthOverride" type:"structure"`
+
+	// A location that overrides, for this batch build, the source location defined
+	// in the batch build project.
+	SourceLocationOverride *string `locationName:"sourceLocationOverride" type:"string"`
+
+	// The source input type that overrides the source input defined in the batch
+	// build project.
+	SourceTypeOverride *string `locationName:"sourceTypeOverride" type:"string" enum:"SourceType"`
+
+	// The version of the batch build input to be built, for this build only. If
+	// not specified, the latest version is used. If specified, the contents depends
+	// on the source provider:
+	//
+	// CodeCommit
+	//
+	// The commit ID, branch, or Git tag to use.
+	//
+	// GitHub
+	//
+	// The commit ID, pull request ID, branch name, or tag name that corresponds
+	// to the version of the source code you want to build. If a pull request ID
+	// is specified, it must use the format pr/pull-request-ID (for example pr/25).
+	// If a branch name is specified, the branch's HEAD commit ID is used. If not
+	// specified, the default branch's HEAD commit ID is used.
+	//
+	// Bitbucket
+	//
+	// The commit ID, branch name, or tag name that corresponds to the version of
+	// the source code you want to build. If a branch name is specified, the branch's
+	// HEAD commit ID is used. If not specified, the default branch's HEAD commit
+	// ID is used.
+	//
+	// Amazon S3
+	//
+	// The version ID of the object that represents the build input ZIP file to
+	// use.
+	//
+	// If sourceVersion is specified at the project level, then this sourceVersion
+	// (at the build level) takes precedence.
+	//
+	// For more information, see Source Version Sample with CodeBuild (https://docs.aws.amazon.com/codebuild/latest/userguide/sample-source-version.html)
+	// in the CodeBuild User Guide.
+	SourceVersion *string `locationName:"sourceVersion" type:"string"`
 }
 
-// String returns the string representation
-func (s ProjectSourceVersion) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StartBuildBatchInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ProjectSourceVersion) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StartBuildBatchInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ProjectSourceVersion) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ProjectSourceVersion"}
-	if s.SourceIdentifier == nil {
-		invalidParams.Add(request.NewErrParamRequired("SourceIdentifier"))
+func (s *StartBuildBatchInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartBuildBatchInput"}
+	if s.BuildTimeoutInMinutesOverride != nil && *s.BuildTimeoutInMinutesOverride < 5 {
+		invalidParams.Add(request.NewErrParamMinValue("BuildTimeoutInMinutesOverride", 5))
+	}
+	if s.EncryptionKeyOverride != nil && len(*s.EncryptionKeyOverride) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EncryptionKeyOverride", 1))
+	}
+	if s.ImageOverride != nil && len(*s.ImageOverride) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ImageOverride", 1))
+	}
+	if s.ProjectName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ProjectName"))
+	}
+	if s.ProjectName != nil && len(*s.ProjectName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ProjectName", 1))
+	}
+	if s.QueuedTimeoutInMinutesOverride != nil && *s.QueuedTimeoutInMinutesOverride < 5 {
+		invalidParams.Add(request.NewErrParamMinValue("QueuedTimeoutInMinutesOverride", 5))
+	}
+	if s.ServiceRoleOverride != nil && len(*s.ServiceRoleOverride) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ServiceRoleOverride", 1))
+	}
+	if s.ArtifactsOverride != nil {
+		if err := s.ArtifactsOverride.Validate(); err != nil {
+			invalidParams.AddNested("ArtifactsOverride", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.BuildBatchConfigOverride != nil {
+		if err := s.BuildBatchConfigOverride.Validate(); err != nil {
+			invalidParams.AddNested("BuildBatchConfigOverride", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.CacheOverride != nil {
+		if err := s.CacheOverride.Validate(); err != nil {
+			invalidParams.AddNested("CacheOverride", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.EnvironmentVariablesOverride != nil {
+		for i, v := range s.EnvironmentVariablesOverride {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "EnvironmentVariablesOverride", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.GitSubmodulesConfigOverride != nil {
+		if err := s.GitSubmodulesConfigOverride.Validate(); err != nil {
+			invalidParams.AddNested("GitSubmodulesConfigOverride", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.LogsConfigOverride != nil {
+		if err := s.LogsConfigOverride.Validate(); err != nil {
+			invalidParams.AddNested("LogsConfigOverride", err.(request.ErrInvalidParams))
+		}
 	}
-	if s.SourceVersion == nil {
-		invalidParams.Add(request.NewErrParamRequired("SourceVersion"))
+	if s.RegistryCredentialOverride != nil {
+		if err := s.RegistryCredentialOverride.Validate(); err != nil {
+			invalidParams.AddNested("RegistryCredentialOverride", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.SecondaryArtifactsOverride != nil {
+		for i, v := range s.SecondaryArtifactsOverride {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "SecondaryArtifactsOverride", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.SecondarySourcesOverride != nil {
+		for i, v := range s.SecondarySourcesOverride {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "SecondarySourcesOverride", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.SecondarySourcesVersionOverride != nil {
+		for i, v := range s.SecondarySourcesVersionOverride {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "SecondarySourcesVersionOverride", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.SourceAuthOverride != nil {
+		if err := s.SourceAuthOverride.Validate(); err != nil {
+			invalidParams.AddNested("SourceAuthOverride", err.(request.ErrInvalidParams))
+		}
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5006,249 +13960,220 @@ func (s *ProjectSourceVersion) Validate() error {
 	return nil
 }
 
-// SetSourceIdentifier sets the SourceIdentifier field's value.
-func (s *ProjectSourceVersion) SetSourceIdentifier(v string) *ProjectSourceVersion {
-	s.SourceIdentifier = &v
+// SetArtifactsOverride sets the ArtifactsOverride field's value.
+func (s *StartBuildBatchInput) SetArtifactsOverride(v *ProjectArtifacts) *StartBuildBatchInput {
+	s.ArtifactsOverride = v
 	return s
 }
 
-// SetSourceVersion sets the SourceVersion field's value.
-func (s *ProjectSourceVersion) SetSourceVersion(v string) *ProjectSourceVersion {
-	s.SourceVersion = &v
+// SetBuildBatchConfigOverride sets the BuildBatchConfigOverride field's value.
+func (s *StartBuildBatchInput) SetBuildBatchConfigOverride(v *ProjectBuildBatchConfig) *StartBuildBatchInput {
+	s.BuildBatchConfigOverride = v
 	return s
 }
 
-// Information about credentials that provide access to a private Docker registry.
-// When this is set:
-//
-//    * imagePullCredentialsType must be set to SERVICE_ROLE.
-//
-//    * images cannot be curated or an Amazon ECR image.
-//
-// For more information, see Private Registry with AWS Secrets Manager Sample
-// for AWS CodeBuild (https://docs.aws.amazon.com/codebuild/latest/userguide/sample-private-registry.html).
-type RegistryCredential struct {
-	_ struct{} `type:"structure"`
-
-	// The Amazon Resource Name (ARN) or name of credentials created using AWS Secrets
-	// Manager.
-	//
-	// The credential can use the name of the credentials only if they exist in
-	// your current region.
-	//
-	// Credential is a required field
-	Credential *string `locationName:"credential" min:"1" type:"string" required:"true"`
-
-	// The service that created the credentials to access a private Docker registry.
-	// The valid value, SECRETS_MANAGER, is for AWS Secrets Manager.
-	//
-	// CredentialProvider is a required field
-	CredentialProvider *string `locationName:"credentialProvider" type:"string" required:"true" enum:"CredentialProviderType"`
+// SetBuildTimeoutInMinutesOverride sets the BuildTimeoutInMinutesOverride field's value.
+func (s *StartBuildBatchInput) SetBuildTimeoutInMinutesOverride(v int64) *StartBuildBatchInput {
+	s.BuildTimeoutInMinutesOverride = &v
+	return s
 }
 
-// String returns the string representation
-func (s RegistryCredential) String() string {
-	return awsutil.Prettify(s)
+// SetBuildspecOverride sets the BuildspecOverride field's value.
+func (s *StartBuildBatchInput) SetBuildspecOverride(v string) *StartBuildBatchInput {
+	s.BuildspecOverride = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s RegistryCredential) GoString() string {
-	return s.String()
+// SetCacheOverride sets the CacheOverride field's value.
+func (s *StartBuildBatchInput) SetCacheOverride(v *ProjectCache) *StartBuildBatchInput {
+	s.CacheOverride = v
+	return s
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *RegistryCredential) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "RegistryCredential"}
-	if s.Credential == nil {
-		invalidParams.Add(request.NewErrParamRequired("Credential"))
-	}
-	if s.Credential != nil && len(*s.Credential) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Credential", 1))
-	}
-	if s.CredentialProvider == nil {
-		invalidParams.Add(request.NewErrParamRequired("CredentialProvider"))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetCertificateOverride sets the CertificateOverride field's value.
+func (s *StartBuildBatchInput) SetCertificateOverride(v string) *StartBuildBatchInput {
+	s.CertificateOverride = &v
+	return s
 }
 
-// SetCredential sets the Credential field's value.
-func (s *RegistryCredential) SetCredential(v string) *RegistryCredential {
-	s.Credential = &v
+// SetComputeTypeOverride sets the ComputeTypeOverride field's value.
+func (s *StartBuildBatchInput) SetComputeTypeOverride(v string) *StartBuildBatchInput {
+	s.ComputeTypeOverride = &v
 	return s
 }
 
-// SetCredentialProvider sets the CredentialProvider field's value.
-func (s *RegistryCredential) SetCredentialProvider(v string) *RegistryCredential {
-	s.CredentialProvider = &v
+// SetDebugSessionEnabled sets the DebugSessionEnabled field's value.
+func (s *StartBuildBatchInput) SetDebugSessionEnabled(v bool) *StartBuildBatchInput {
+	s.DebugSessionEnabled = &v
 	return s
 }
 
-// Information about S3 logs for a build project.
-type S3LogsConfig struct {
-	_ struct{} `type:"structure"`
-
-	// Set to true if you do not want your S3 build log output encrypted. By default
-	// S3 build logs are encrypted.
-	EncryptionDisabled *bool `locationName:"encryptionDisabled" type:"boolean"`
-
-	// The ARN of an S3 bucket and the path prefix for S3 logs. If your Amazon S3
-	// bucket name is my-bucket, and your path prefix is build-log, then acceptable
-	// formats are my-bucket/build-log or arn:aws:s3:::my-bucket/build-log.
-	Location *string `locationName:"location" type:"string"`
+// SetEncryptionKeyOverride sets the EncryptionKeyOverride field's value.
+func (s *StartBuildBatchInput) SetEncryptionKeyOverride(v string) *StartBuildBatchInput {
+	s.EncryptionKeyOverride = &v
+	return s
+}
 
-	// The current status of the S3 build logs. Valid values are:
-	//
-	//    * ENABLED: S3 build logs are enabled for this build project.
-	//
-	//    * DISABLED: S3 build logs are not enabled for this build project.
-	//
-	// Status is a required field
-	Status *string `locationName:"status" type:"string" required:"true" enum:"LogsConfigStatusType"`
+// SetEnvironmentTypeOverride sets the EnvironmentTypeOverride field's value.
+func (s *StartBuildBatchInput) SetEnvironmentTypeOverride(v string) *StartBuildBatchInput {
+	s.EnvironmentTypeOverride = &v
+	return s
 }
 
-// String returns the string representation
-func (s S3LogsConfig) String() string {
-	return awsutil.Prettify(s)
+// SetEnvironmentVariablesOverride sets the EnvironmentVariablesOverride field's value.
+func (s *StartBuildBatchInput) SetEnvironmentVariablesOverride(v []*EnvironmentVariable) *StartBuildBatchInput {
+	s.EnvironmentVariablesOverride = v
+	return s
 }
 
-// GoString returns the string representation
-func (s S3LogsConfig) GoString() string {
-	return s.String()
+// SetGitCloneDepthOverride sets the GitCloneDepthOverride field's value.
+func (s *StartBuildBatchInput) SetGitCloneDepthOverride(v int64) *StartBuildBatchInput {
+	s.GitCloneDepthOverride = &v
+	return s
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *S3LogsConfig) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "S3LogsConfig"}
-	if s.Status == nil {
-		invalidParams.Add(request.NewErrParamRequired("Status"))
-	}
+// SetGitSubmodulesConfigOverride sets the GitSubmodulesConfigOverride field's value.
+func (s *StartBuildBatchInput) SetGitSubmodulesConfigOverride(v *GitSubmodulesConfig) *StartBuildBatchInput {
+	s.GitSubmodulesConfigOverride = v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetIdempotencyToken sets the IdempotencyToken field's value.
+func (s *StartBuildBatchInput) SetIdempotencyToken(v string) *StartBuildBatchInput {
+	s.IdempotencyToken = &v
+	return s
 }
 
-// SetEncryptionDisabled sets the EncryptionDisabled field's value.
-func (s *S3LogsConfig) SetEncryptionDisabled(v bool) *S3LogsConfig {
-	s.EncryptionDisabled = &v
+// SetImageOverride sets the ImageOverride field's value.
+func (s *StartBuildBatchInput) SetImageOverride(v string) *StartBuildBatchInput {
+	s.ImageOverride = &v
 	return s
 }
 
-// SetLocation sets the Location field's value.
-func (s *S3LogsConfig) SetLocation(v string) *S3LogsConfig {
-	s.Location = &v
+// SetImagePullCredentialsTypeOverride sets the ImagePullCredentialsTypeOverride field's value.
+func (s *StartBuildBatchInput) SetImagePullCredentialsTypeOverride(v string) *StartBuildBatchInput {
+	s.ImagePullCredentialsTypeOverride = &v
 	return s
 }
 
-// SetStatus sets the Status field's value.
-func (s *S3LogsConfig) SetStatus(v string) *S3LogsConfig {
-	s.Status = &v
+// SetInsecureSslOverride sets the InsecureSslOverride field's value.
+func (s *StartBuildBatchInput) SetInsecureSslOverride(v bool) *StartBuildBatchInput {
+	s.InsecureSslOverride = &v
 	return s
 }
 
-// Information about the authorization settings for AWS CodeBuild to access
-// the source code to be built.
-//
-// This information is for the AWS CodeBuild console's use only. Your code should
-// not get or set this information directly.
-type SourceAuth struct {
-	_ struct{} `type:"structure"`
+// SetLogsConfigOverride sets the LogsConfigOverride field's value.
+func (s *StartBuildBatchInput) SetLogsConfigOverride(v *LogsConfig) *StartBuildBatchInput {
+	s.LogsConfigOverride = v
+	return s
+}
 
-	// The resource value that applies to the specified authorization type.
-	Resource *string `locationName:"resource" type:"string"`
+// SetPrivilegedModeOverride sets the PrivilegedModeOverride field's value.
+func (s *StartBuildBatchInput) SetPrivilegedModeOverride(v bool) *StartBuildBatchInput {
+	s.PrivilegedModeOverride = &v
+	return s
+}
 
-	//
-	// This data type is deprecated and is no longer accurate or used.
-	//
-	// The authorization type to use. The only valid value is OAUTH, which represents
-	// the OAuth authorization type.
-	//
-	// Type is a required field
-	Type *string `locationName:"type" type:"string" required:"true" enum:"SourceAuthType"`
+// SetProjectName sets the ProjectName field's value.
+func (s *StartBuildBatchInput) SetProjectName(v string) *StartBuildBatchInput {
+	s.ProjectName = &v
+	return s
 }
 
-// String returns the string representation
-func (s SourceAuth) String() string {
-	return awsutil.Prettify(s)
+// SetQueuedTimeoutInMinutesOverride sets the QueuedTimeoutInMinutesOverride field's value.
+func (s *StartBuildBatchInput) SetQueuedTimeoutInMinutesOverride(v int64) *StartBuildBatchInput {
+	s.QueuedTimeoutInMinutesOverride = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s SourceAuth) GoString() string {
-	return s.String()
+// SetRegistryCredentialOverride sets the RegistryCredentialOverride field's value.
+func (s *StartBuildBatchInput) SetRegistryCredentialOverride(v *RegistryCredential) *StartBuildBatchInput {
+	s.RegistryCredentialOverride = v
+	return s
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *SourceAuth) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "SourceAuth"}
-	if s.Type == nil {
-		invalidParams.Add(request.NewErrParamRequired("Type"))
-	}
+// SetReportBuildBatchStatusOverride sets the ReportBuildBatchStatusOverride field's value.
+func (s *StartBuildBatchInput) SetReportBuildBatchStatusOverride(v bool) *StartBuildBatchInput {
+	s.ReportBuildBatchStatusOverride = &v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetSecondaryArtifactsOverride sets the SecondaryArtifactsOverride field's value.
+func (s *StartBuildBatchInput) SetSecondaryArtifactsOverride(v []*ProjectArtifacts) *StartBuildBatchInput {
+	s.SecondaryArtifactsOverride = v
+	return s
 }
 
-// SetResource sets the Resource field's value.
-func (s *SourceAuth) SetResource(v string) *SourceAuth {
-	s.Resource = &v
+// SetSecondarySourcesOverride sets the SecondarySourcesOverride field's value.
+func (s *StartBuildBatchInput) SetSecondarySourcesOverride(v []*ProjectSource) *StartBuildBatchInput {
+	s.SecondarySourcesOverride = v
 	return s
 }
 
-// SetType sets the Type field's value.
-func (s *SourceAuth) SetType(v string) *SourceAuth {
-	s.Type = &v
+// SetSecondarySourcesVersionOverride sets the SecondarySourcesVersionOverride field's value.
+func (s *StartBuildBatchInput) SetSecondarySourcesVersionOverride(v []*ProjectSourceVersion) *StartBuildBatchInput {
+	s.SecondarySourcesVersionOverride = v
 	return s
 }
 
-// Information about the credentials for a GitHub, GitHub Enterprise, or Bitbucket
-// repository.
-type SourceCredentialsInfo struct {
-	_ struct{} `type:"structure"`
+// SetServiceRoleOverride sets the ServiceRoleOverride field's value.
+func (s *StartBuildBatchInput) SetServiceRoleOverride(v string) *StartBuildBatchInput {
+	s.ServiceRoleOverride = &v
+	return s
+}
 
-	// The Amazon Resource Name (ARN) of the token.
-	Arn *string `locationName:"arn" min:"1" type:"string"`
+// SetSourceAuthOverride sets the SourceAuthOverride field's value.
+func (s *StartBuildBatchInput) SetSourceAuthOverride(v *SourceAuth) *StartBuildBatchInput {
+	s.SourceAuthOverride = v
+	return s
+}
 
-	// The type of authentication used by the credentials. Valid options are OAUTH,
-	// BASIC_AUTH, or PERSONAL_ACCESS_TOKEN.
-	AuthType *string `locationName:"authType" type:"string" enum:"AuthType"`
+// SetSourceLocationOverride sets the SourceLocationOverride field's value.
+func (s *StartBuildBatchInput) SetSourceLocationOverride(v string) *StartBuildBatchInput {
+	s.SourceLocationOverride = &v
+	return s
+}
 
-	// The type of source provider. The valid options are GITHUB, GITHUB_ENTERPRISE,
-	// or BITBUCKET.
-	ServerType *string `locationName:"serverType" type:"string" enum:"ServerType"`
+// SetSourceTypeOverride sets the SourceTypeOverride field's value.
+func (s *StartBuildBatchInput) SetSourceTypeOverride(v string) *StartBuildBatchInput {
+	s.SourceTypeOverride = &v
+	return s
 }
 
-// String returns the string representation
-func (s SourceCredentialsInfo) String() string {
-	return awsutil.Prettify(s)
+// SetSourceVersion sets the SourceVersion field's value.
+func (s *StartBuildBatchInput) SetSourceVersion(v string) *StartBuildBatchInput {
+	s.SourceVersion = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s SourceCredentialsInfo) GoString() string {
-	return s.String()
+type StartBuildBatchOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A BuildBatch object that contains information about the batch build.
+	BuildBatch *BuildBatch `locationName:"buildBatch" type:"structure"`
 }
 
-// SetArn sets the Arn field's value.
-func (s *SourceCredentialsInfo) SetArn(v string) *SourceCredentialsInfo {
-	s.Arn = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StartBuildBatchOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetAuthType sets the AuthType field's value.
-func (s *SourceCredentialsInfo) SetAuthType(v string) *SourceCredentialsInfo {
-	s.AuthType = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StartBuildBatchOutput) GoString() string {
+	return s.String()
 }
 
-// SetServerType sets the ServerType field's value.
-func (s *SourceCredentialsInfo) SetServerType(v string) *SourceCredentialsInfo {
-	s.ServerType = &v
+// SetBuildBatch sets the BuildBatch field's value.
+func (s *StartBuildBatchOutput) SetBuildBatch(v *BuildBatch) *StartBuildBatchOutput {
+	s.BuildBatch = v
 	return s
 }
 
@@ -5259,8 +14184,22 @@ type StartBuildInput struct {
 	// ones already defined in the build project.
 	ArtifactsOverride *ProjectArtifacts `locationName:"artifactsOverride" type:"structure"`
 
-	// A build spec declaration that overrides, for this build only, the latest
+	// Contains information that defines how the build project reports the build
+	// status to the source provider. This option is only used when the source provider
+	// is GITHUB, GITHUB_ENTERPRISE, or BITBUCKET.
+	BuildStatusConfigOverride *BuildStatusConfig `locationName:"buildStatusConfigOverride" type:"structure"`
+
+	// A buildspec file declaration that overrides, for this build only, the latest
 	// one already defined in the build project.
+	//
+	// If this value is set, it can be either an inline buildspec definition, the
+	// path to an alternate buildspec file relative to the value of the built-in
+	// CODEBUILD_SRC_DIR environment variable, or the path to an S3 bucket. The
+	// bucket must be in the same Amazon Web Services Region as the build project.
+	// Specify the buildspec file using its ARN (for example, arn:aws:s3:::my-codebuild-sample2/buildspec.yml).
+	// If this value is not provided or is set to an empty string, the source code
+	// must contain a buildspec file in its root directory. For more information,
+	// see Buildspec File Name and Storage Location (https://docs.aws.amazon.com/codebuild/latest/userguide/build-spec-ref.html#build-spec-ref-name-storage).
 	BuildspecOverride *string `locationName:"buildspecOverride" type:"string"`
 
 	// A ProjectCache object specified for this build that overrides the one defined
@@ -5275,6 +14214,20 @@ type StartBuildInput struct {
 	// in the build project.
 	ComputeTypeOverride *string `locationName:"computeTypeOverride" type:"string" enum:"ComputeType"`
 
+	// Specifies if session debugging is enabled for this build. For more information,
+	// see Viewing a running build in Session Manager (https://docs.aws.amazon.com/codebuild/latest/userguide/session-manager.html).
+	DebugSessionEnabled *bool `locationName:"debugSessionEnabled" type:"boolean"`
+
+	// The Key Management Service customer master key (CMK) that overrides the one
+	// specified in the build project. The CMK key encrypts the build output artifacts.
+	//
+	// You can use a cross-account KMS key to encrypt the build output artifacts
+	// if your service role has permission to that key.
+	//
+	// You can specify either the Amazon Resource Name (ARN) of the CMK or, if available,
+	// the CMK's alias (using the format alias/<alias-name>).
+	EncryptionKeyOverride *string `locationName:"encryptionKeyOverride" min:"1" type:"string"`
+
 	// A container type for this build that overrides the one specified in the build
 	// project.
 	EnvironmentTypeOverride *string `locationName:"environmentTypeOverride" type:"string" enum:"EnvironmentType"`
@@ -5287,14 +14240,14 @@ type StartBuildInput struct {
 	// for this build only, any previous depth of history defined in the build project.
 	GitCloneDepthOverride *int64 `locationName:"gitCloneDepthOverride" type:"integer"`
 
-	// Information about the Git submodules configuration for this build of an AWS
-	// CodeBuild build project.
+	// Information about the Git submodules configuration for this build of an CodeBuild
+	// build project.
 	GitSubmodulesConfigOverride *GitSubmodulesConfig `locationName:"gitSubmodulesConfigOverride" type:"structure"`
 
 	// A unique, case sensitive identifier you provide to ensure the idempotency
 	// of the StartBuild request. The token is included in the StartBuild request
-	// and is valid for 12 hours. If you repeat the StartBuild request with the
-	// same token, but change a parameter, AWS CodeBuild returns a parameter mismatch
+	// and is valid for 5 minutes. If you repeat the StartBuild request with the
+	// same token, but change a parameter, CodeBuild returns a parameter mismatch
 	// error.
 	IdempotencyToken *string `locationName:"idempotencyToken" type:"string"`
 
@@ -5302,18 +14255,20 @@ type StartBuildInput struct {
 	// build project.
 	ImageOverride *string `locationName:"imageOverride" min:"1" type:"string"`
 
-	// The type of credentials AWS CodeBuild uses to pull images in your build.
-	// There are two valid values:
+	// The type of credentials CodeBuild uses to pull images in your build. There
+	// are two valid values:
+	//
+	// CODEBUILD
+	//
+	// Specifies that CodeBuild uses its own credentials. This requires that you
+	// modify your ECR repository policy to trust CodeBuild's service principal.
 	//
-	//    * CODEBUILD specifies that AWS CodeBuild uses its own credentials. This
-	//    requires that you modify your ECR repository policy to trust AWS CodeBuild's
-	//    service principal.
+	// SERVICE_ROLE
 	//
-	//    * SERVICE_ROLE specifies that AWS CodeBuild uses your build project's
-	//    service role.
+	// Specifies that CodeBuild uses your build project's service role.
 	//
 	// When using a cross-account or private registry image, you must use SERVICE_ROLE
-	// credentials. When using an AWS CodeBuild curated image, you must use CODEBUILD
+	// credentials. When using an CodeBuild curated image, you must use CODEBUILD
 	// credentials.
 	ImagePullCredentialsTypeOverride *string `locationName:"imagePullCredentialsTypeOverride" type:"string" enum:"ImagePullCredentialsType"`
 
@@ -5330,7 +14285,7 @@ type StartBuildInput struct {
 	// Enable this flag to override privileged mode in the build project.
 	PrivilegedModeOverride *bool `locationName:"privilegedModeOverride" type:"boolean"`
 
-	// The name of the AWS CodeBuild build project to start running a build.
+	// The name of the CodeBuild build project to start running a build.
 	//
 	// ProjectName is a required field
 	ProjectName *string `locationName:"projectName" min:"1" type:"string" required:"true"`
@@ -5344,6 +14299,15 @@ type StartBuildInput struct {
 	// Set to true to report to your source provider the status of a build's start
 	// and completion. If you use this option with a source provider other than
 	// GitHub, GitHub Enterprise, or Bitbucket, an invalidInputException is thrown.
+	//
+	// To be able to report the build status to the source provider, the user associated
+	// with the source provider must have write access to the repo. If the user
+	// does not have write access, the build status cannot be updated. For more
+	// information, see Source provider access (https://docs.aws.amazon.com/codebuild/latest/userguide/access-tokens.html)
+	// in the CodeBuild User Guide.
+	//
+	// The status of a build triggered by a webhook is always reported to your source
+	// provider.
 	ReportBuildStatusOverride *bool `locationName:"reportBuildStatusOverride" type:"boolean"`
 
 	// An array of ProjectArtifacts objects.
@@ -5373,31 +14337,39 @@ type StartBuildInput struct {
 	// in the build project.
 	SourceTypeOverride *string `locationName:"sourceTypeOverride" type:"string" enum:"SourceType"`
 
-	// A version of the build input to be built, for this build only. If not specified,
-	// the latest version is used. If specified, must be one of:
+	// The version of the build input to be built, for this build only. If not specified,
+	// the latest version is used. If specified, the contents depends on the source
+	// provider:
 	//
-	//    * For AWS CodeCommit: the commit ID to use.
+	// CodeCommit
 	//
-	//    * For GitHub: the commit ID, pull request ID, branch name, or tag name
-	//    that corresponds to the version of the source code you want to build.
-	//    If a pull request ID is specified, it must use the format pr/pull-request-ID
-	//    (for example pr/25). If a branch name is specified, the branch's HEAD
-	//    commit ID is used. If not specified, the default branch's HEAD commit
-	//    ID is used.
+	// The commit ID, branch, or Git tag to use.
 	//
-	//    * For Bitbucket: the commit ID, branch name, or tag name that corresponds
-	//    to the version of the source code you want to build. If a branch name
-	//    is specified, the branch's HEAD commit ID is used. If not specified, the
-	//    default branch's HEAD commit ID is used.
+	// GitHub
 	//
-	//    * For Amazon Simple Storage Service (Amazon S3): the version ID of the
-	//    object that represents the build input ZIP file to use.
+	// The commit ID, pull request ID, branch name, or tag name that corresponds
+	// to the version of the source code you want to build. If a pull request ID
+	// is specified, it must use the format pr/pull-request-ID (for example pr/25).
+	// If a branch name is specified, the branch's HEAD commit ID is used. If not
+	// specified, the default branch's HEAD commit ID is used.
+	//
+	// Bitbucket
+	//
+	// The commit ID, branch name, or tag name that corresponds to the version of
+	// the source code you want to build. If a branch name is specified, the branch's
+	// HEAD commit ID is used. If not specified, the default branch's HEAD commit
+	// ID is used.
+	//
+	// Amazon S3
+	//
+	// The version ID of the object that represents the build input ZIP file to
+	// use.
 	//
 	// If sourceVersion is specified at the project level, then this sourceVersion
 	// (at the build level) takes precedence.
 	//
 	// For more information, see Source Version Sample with CodeBuild (https://docs.aws.amazon.com/codebuild/latest/userguide/sample-source-version.html)
-	// in the AWS CodeBuild User Guide.
+	// in the CodeBuild User Guide.
 	SourceVersion *string `locationName:"sourceVersion" type:"string"`
 
 	// The number of build timeout minutes, from 5 to 480 (8 hours), that overrides,
@@ -5405,12 +14377,20 @@ type StartBuildInput struct {
 	TimeoutInMinutesOverride *int64 `locationName:"timeoutInMinutesOverride" min:"5" type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartBuildInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartBuildInput) GoString() string {
 	return s.String()
 }
@@ -5418,6 +14398,9 @@ func (s StartBuildInput) GoString() string {
 // Validate inspects the fields of the type to determine if they are valid.
 func (s *StartBuildInput) Validate() error {
 	invalidParams := request.ErrInvalidParams{Context: "StartBuildInput"}
+	if s.EncryptionKeyOverride != nil && len(*s.EncryptionKeyOverride) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EncryptionKeyOverride", 1))
+	}
 	if s.ImageOverride != nil && len(*s.ImageOverride) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("ImageOverride", 1))
 	}
@@ -5519,6 +14502,12 @@ func (s *StartBuildInput) SetArtifactsOverride(v *ProjectArtifacts) *StartBuildI
 	return s
 }
 
+// SetBuildStatusConfigOverride sets the BuildStatusConfigOverride field's value.
+func (s *StartBuildInput) SetBuildStatusConfigOverride(v *BuildStatusConfig) *StartBuildInput {
+	s.BuildStatusConfigOverride = v
+	return s
+}
+
 // SetBuildspecOverride sets the BuildspecOverride field's value.
 func (s *StartBuildInput) SetBuildspecOverride(v string) *StartBuildInput {
 	s.BuildspecOverride = &v
@@ -5543,6 +14532,18 @@ func (s *StartBuildInput) SetComputeTypeOverride(v string) *StartBuildInput {
 	return s
 }
 
+// SetDebugSessionEnabled sets the DebugSessionEnabled field's value.
+func (s *StartBuildInput) SetDebugSessionEnabled(v bool) *StartBuildInput {
+	s.DebugSessionEnabled = &v
+	return s
+}
+
+// SetEncryptionKeyOverride sets the EncryptionKeyOverride field's value.
+func (s *StartBuildInput) SetEncryptionKeyOverride(v string) *StartBuildInput {
+	s.EncryptionKeyOverride = &v
+	return s
+}
+
 // SetEnvironmentTypeOverride sets the EnvironmentTypeOverride field's value.
 func (s *StartBuildInput) SetEnvironmentTypeOverride(v string) *StartBuildInput {
 	s.EnvironmentTypeOverride = &v
@@ -5688,12 +14689,20 @@ type StartBuildOutput struct {
 	Build *Build `locationName:"build" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartBuildOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartBuildOutput) GoString() string {
 	return s.String()
 }
@@ -5704,6 +14713,86 @@ func (s *StartBuildOutput) SetBuild(v *Build) *StartBuildOutput {
 	return s
 }
 
+type StopBuildBatchInput struct {
+	_ struct{} `type:"structure"`
+
+	// The identifier of the batch build to stop.
+	//
+	// Id is a required field
+	Id *string `locationName:"id" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StopBuildBatchInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StopBuildBatchInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *StopBuildBatchInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "StopBuildBatchInput"}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
+	}
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetId sets the Id field's value.
+func (s *StopBuildBatchInput) SetId(v string) *StopBuildBatchInput {
+	s.Id = &v
+	return s
+}
+
+type StopBuildBatchOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Contains information about a batch build.
+	BuildBatch *BuildBatch `locationName:"buildBatch" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StopBuildBatchOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StopBuildBatchOutput) GoString() string {
+	return s.String()
+}
+
+// SetBuildBatch sets the BuildBatch field's value.
+func (s *StopBuildBatchOutput) SetBuildBatch(v *BuildBatch) *StopBuildBatchOutput {
+	s.BuildBatch = v
+	return s
+}
+
 type StopBuildInput struct {
 	_ struct{} `type:"structure"`
 
@@ -5713,109 +14802,344 @@ type StopBuildInput struct {
 	Id *string `locationName:"id" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopBuildInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s StopBuildInput) GoString() string {
-	return s.String()
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StopBuildInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *StopBuildInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "StopBuildInput"}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
+	}
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetId sets the Id field's value.
+func (s *StopBuildInput) SetId(v string) *StopBuildInput {
+	s.Id = &v
+	return s
+}
+
+type StopBuildOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Information about the build.
+	Build *Build `locationName:"build" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StopBuildOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StopBuildOutput) GoString() string {
+	return s.String()
+}
+
+// SetBuild sets the Build field's value.
+func (s *StopBuildOutput) SetBuild(v *Build) *StopBuildOutput {
+	s.Build = v
+	return s
+}
+
+// A tag, consisting of a key and a value.
+//
+// This tag is available for use by Amazon Web Services services that support
+// tags in CodeBuild.
+type Tag struct {
+	_ struct{} `type:"structure"`
+
+	// The tag's key.
+	Key *string `locationName:"key" min:"1" type:"string"`
+
+	// The tag's value.
+	Value *string `locationName:"value" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Tag) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Tag) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *Tag) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Key", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetKey sets the Key field's value.
+func (s *Tag) SetKey(v string) *Tag {
+	s.Key = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *Tag) SetValue(v string) *Tag {
+	s.Value = &v
+	return s
+}
+
+// Information about a test case created using a framework such as NUnit or
+// Cucumber. A test case might be a unit test or a configuration test.
+type TestCase struct {
+	_ struct{} `type:"structure"`
+
+	// The number of nanoseconds it took to run this test case.
+	DurationInNanoSeconds *int64 `locationName:"durationInNanoSeconds" type:"long"`
+
+	// The date and time a test case expires. A test case expires 30 days after
+	// it is created. An expired test case is not available to view in CodeBuild.
+	Expired *time.Time `locationName:"expired" type:"timestamp"`
+
+	// A message associated with a test case. For example, an error message or stack
+	// trace.
+	Message *string `locationName:"message" type:"string"`
+
+	// The name of the test case.
+	Name *string `locationName:"name" type:"string"`
+
+	// A string that is applied to a series of related test cases. CodeBuild generates
+	// the prefix. The prefix depends on the framework used to generate the tests.
+	Prefix *string `locationName:"prefix" type:"string"`
+
+	// The ARN of the report to which the test case belongs.
+	ReportArn *string `locationName:"reportArn" min:"1" type:"string"`
+
+	// The status returned by the test case after it was run. Valid statuses are
+	// SUCCEEDED, FAILED, ERROR, SKIPPED, and UNKNOWN.
+	Status *string `locationName:"status" type:"string"`
+
+	// The path to the raw data file that contains the test result.
+	TestRawDataPath *string `locationName:"testRawDataPath" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TestCase) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TestCase) GoString() string {
+	return s.String()
+}
+
+// SetDurationInNanoSeconds sets the DurationInNanoSeconds field's value.
+func (s *TestCase) SetDurationInNanoSeconds(v int64) *TestCase {
+	s.DurationInNanoSeconds = &v
+	return s
+}
+
+// SetExpired sets the Expired field's value.
+func (s *TestCase) SetExpired(v time.Time) *TestCase {
+	s.Expired = &v
+	return s
+}
+
+// SetMessage sets the Message field's value.
+func (s *TestCase) SetMessage(v string) *TestCase {
+	s.Message = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *TestCase) SetName(v string) *TestCase {
+	s.Name = &v
+	return s
+}
+
+// SetPrefix sets the Prefix field's value.
+func (s *TestCase) SetPrefix(v string) *TestCase {
+	s.Prefix = &v
+	return s
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *StopBuildInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "StopBuildInput"}
-	if s.Id == nil {
-		invalidParams.Add(request.NewErrParamRequired("Id"))
-	}
-	if s.Id != nil && len(*s.Id) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
-	}
+// SetReportArn sets the ReportArn field's value.
+func (s *TestCase) SetReportArn(v string) *TestCase {
+	s.ReportArn = &v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetStatus sets the Status field's value.
+func (s *TestCase) SetStatus(v string) *TestCase {
+	s.Status = &v
+	return s
 }
 
-// SetId sets the Id field's value.
-func (s *StopBuildInput) SetId(v string) *StopBuildInput {
-	s.Id = &v
+// SetTestRawDataPath sets the TestRawDataPath field's value.
+func (s *TestCase) SetTestRawDataPath(v string) *TestCase {
+	s.TestRawDataPath = &v
 	return s
 }
 
-type StopBuildOutput struct {
+// A filter used to return specific types of test cases. In order to pass the
+// filter, the report must meet all of the filter properties.
+type TestCaseFilter struct {
 	_ struct{} `type:"structure"`
 
-	// Information about the build.
-	Build *Build `locationName:"build" type:"structure"`
+	// A keyword that is used to filter on the name or the prefix of the test cases.
+	// Only test cases where the keyword is a substring of the name or the prefix
+	// will be returned.
+	Keyword *string `locationName:"keyword" type:"string"`
+
+	// The status used to filter test cases. A TestCaseFilter can have one status.
+	// Valid values are:
+	//
+	//    * SUCCEEDED
+	//
+	//    * FAILED
+	//
+	//    * ERROR
+	//
+	//    * SKIPPED
+	//
+	//    * UNKNOWN
+	Status *string `locationName:"status" type:"string"`
 }
 
-// String returns the string representation
-func (s StopBuildOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TestCaseFilter) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s StopBuildOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TestCaseFilter) GoString() string {
 	return s.String()
 }
 
-// SetBuild sets the Build field's value.
-func (s *StopBuildOutput) SetBuild(v *Build) *StopBuildOutput {
-	s.Build = v
+// SetKeyword sets the Keyword field's value.
+func (s *TestCaseFilter) SetKeyword(v string) *TestCaseFilter {
+	s.Keyword = &v
 	return s
 }
 
-// A tag, consisting of a key and a value.
-//
-// This tag is available for use by AWS services that support tags in AWS CodeBuild.
-type Tag struct {
+// SetStatus sets the Status field's value.
+func (s *TestCaseFilter) SetStatus(v string) *TestCaseFilter {
+	s.Status = &v
+	return s
+}
+
+// Information about a test report.
+type TestReportSummary struct {
 	_ struct{} `type:"structure"`
 
-	// The tag's key.
-	Key *string `locationName:"key" min:"1" type:"string"`
+	// The number of nanoseconds it took to run all of the test cases in this report.
+	//
+	// DurationInNanoSeconds is a required field
+	DurationInNanoSeconds *int64 `locationName:"durationInNanoSeconds" type:"long" required:"true"`
 
-	// The tag's value.
-	Value *string `locationName:"value" min:"1" type:"string"`
+	// A map that contains the number of each type of status returned by the test
+	// results in this TestReportSummary.
+	//
+	// StatusCounts is a required field
+	StatusCounts map[string]*int64 `locationName:"statusCounts" type:"map" required:"true"`
+
+	// The number of test cases in this TestReportSummary. The total includes truncated
+	// test cases.
+	//
+	// Total is a required field
+	Total *int64 `locationName:"total" type:"integer" required:"true"`
 }
 
-// String returns the string representation
-func (s Tag) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TestReportSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Tag) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TestReportSummary) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *Tag) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "Tag"}
-	if s.Key != nil && len(*s.Key) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Key", 1))
-	}
-	if s.Value != nil && len(*s.Value) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Value", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetDurationInNanoSeconds sets the DurationInNanoSeconds field's value.
+func (s *TestReportSummary) SetDurationInNanoSeconds(v int64) *TestReportSummary {
+	s.DurationInNanoSeconds = &v
+	return s
 }
 
-// SetKey sets the Key field's value.
-func (s *Tag) SetKey(v string) *Tag {
-	s.Key = &v
+// SetStatusCounts sets the StatusCounts field's value.
+func (s *TestReportSummary) SetStatusCounts(v map[string]*int64) *TestReportSummary {
+	s.StatusCounts = v
 	return s
 }
 
-// SetValue sets the Value field's value.
-func (s *Tag) SetValue(v string) *Tag {
-	s.Value = &v
+// SetTotal sets the Total field's value.
+func (s *TestReportSummary) SetTotal(v int64) *TestReportSummary {
+	s.Total = &v
 	return s
 }
 
@@ -5830,28 +15154,45 @@ type UpdateProjectInput struct {
 	// build badge.
 	BadgeEnabled *bool `locationName:"badgeEnabled" type:"boolean"`
 
+	// Contains configuration information about a batch build project.
+	BuildBatchConfig *ProjectBuildBatchConfig `locationName:"buildBatchConfig" type:"structure"`
+
 	// Stores recently used information so that it can be quickly accessed at a
 	// later time.
 	Cache *ProjectCache `locationName:"cache" type:"structure"`
 
+	// The maximum number of concurrent builds that are allowed for this project.
+	//
+	// New builds are only started if the current number of builds is less than
+	// or equal to this limit. If the current build count meets this limit, new
+	// builds are throttled and are not run.
+	//
+	// To remove this limit, set this value to -1.
+	ConcurrentBuildLimit *int64 `locationName:"concurrentBuildLimit" type:"integer"`
+
 	// A new or replacement description of the build project.
 	Description *string `locationName:"description" type:"string"`
 
-	// The AWS Key Management Service (AWS KMS) customer master key (CMK) to be
-	// used for encrypting the build output artifacts.
+	// The Key Management Service customer master key (CMK) to be used for encrypting
+	// the build output artifacts.
 	//
 	// You can use a cross-account KMS key to encrypt the build output artifacts
 	// if your service role has permission to that key.
 	//
 	// You can specify either the Amazon Resource Name (ARN) of the CMK or, if available,
-	// the CMK's alias (using the format alias/alias-name ).
+	// the CMK's alias (using the format alias/<alias-name>).
 	EncryptionKey *string `locationName:"encryptionKey" min:"1" type:"string"`
 
 	// Information to be changed about the build environment for the build project.
 	Environment *ProjectEnvironment `locationName:"environment" type:"structure"`
 
+	// An array of ProjectFileSystemLocation objects for a CodeBuild build project.
+	// A ProjectFileSystemLocation object specifies the identifier, location, mountOptions,
+	// mountPoint, and type of a file system created using Amazon Elastic File System.
+	FileSystemLocations []*ProjectFileSystemLocation `locationName:"fileSystemLocations" type:"list"`
+
 	// Information about logs for the build project. A project can create logs in
-	// Amazon CloudWatch Logs, logs in an S3 bucket, or both.
+	// CloudWatch Logs, logs in an S3 bucket, or both.
 	LogsConfig *LogsConfig `locationName:"logsConfig" type:"structure"`
 
 	// The name of the build project.
@@ -5864,7 +15205,7 @@ type UpdateProjectInput struct {
 	// The number of minutes a build is allowed to be queued before it times out.
 	QueuedTimeoutInMinutes *int64 `locationName:"queuedTimeoutInMinutes" min:"5" type:"integer"`
 
-	// An array of ProjectSource objects.
+	// An array of ProjectArtifact objects.
 	SecondaryArtifacts []*ProjectArtifacts `locationName:"secondaryArtifacts" type:"list"`
 
 	// An array of ProjectSourceVersion objects. If secondarySourceVersions is specified
@@ -5875,9 +15216,9 @@ type UpdateProjectInput struct {
 	// An array of ProjectSource objects.
 	SecondarySources []*ProjectSource `locationName:"secondarySources" type:"list"`
 
-	// The replacement ARN of the AWS Identity and Access Management (IAM) role
-	// that enables AWS CodeBuild to interact with dependent AWS services on behalf
-	// of the AWS account.
+	// The replacement ARN of the IAM role that enables CodeBuild to interact with
+	// dependent Amazon Web Services services on behalf of the Amazon Web Services
+	// account.
 	ServiceRole *string `locationName:"serviceRole" min:"1" type:"string"`
 
 	// Information to be changed about the build input source code for the build
@@ -5887,7 +15228,7 @@ type UpdateProjectInput struct {
 	// A version of the build input to be built for this project. If not specified,
 	// the latest version is used. If specified, it must be one of:
 	//
-	//    * For AWS CodeCommit: the commit ID to use.
+	//    * For CodeCommit: the commit ID, branch, or Git tag to use.
 	//
 	//    * For GitHub: the commit ID, pull request ID, branch name, or tag name
 	//    that corresponds to the version of the source code you want to build.
@@ -5901,36 +15242,44 @@ type UpdateProjectInput struct {
 	//    is specified, the branch's HEAD commit ID is used. If not specified, the
 	//    default branch's HEAD commit ID is used.
 	//
-	//    * For Amazon Simple Storage Service (Amazon S3): the version ID of the
-	//    object that represents the build input ZIP file to use.
+	//    * For Amazon S3: the version ID of the object that represents the build
+	//    input ZIP file to use.
 	//
 	// If sourceVersion is specified at the build level, then that version takes
 	// precedence over this sourceVersion (at the project level).
 	//
 	// For more information, see Source Version Sample with CodeBuild (https://docs.aws.amazon.com/codebuild/latest/userguide/sample-source-version.html)
-	// in the AWS CodeBuild User Guide.
+	// in the CodeBuild User Guide.
 	SourceVersion *string `locationName:"sourceVersion" type:"string"`
 
-	// The replacement set of tags for this build project.
+	// An updated list of tag key and value pairs associated with this build project.
 	//
-	// These tags are available for use by AWS services that support AWS CodeBuild
-	// build project tags.
+	// These tags are available for use by Amazon Web Services services that support
+	// CodeBuild build project tags.
 	Tags []*Tag `locationName:"tags" type:"list"`
 
-	// The replacement value in minutes, from 5 to 480 (8 hours), for AWS CodeBuild
+	// The replacement value in minutes, from 5 to 480 (8 hours), for CodeBuild
 	// to wait before timing out any related build that did not get marked as completed.
 	TimeoutInMinutes *int64 `locationName:"timeoutInMinutes" min:"5" type:"integer"`
 
-	// VpcConfig enables AWS CodeBuild to access resources in an Amazon VPC.
+	// VpcConfig enables CodeBuild to access resources in an Amazon VPC.
 	VpcConfig *VpcConfig `locationName:"vpcConfig" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProjectInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProjectInput) GoString() string {
 	return s.String()
 }
@@ -5961,6 +15310,11 @@ func (s *UpdateProjectInput) Validate() error {
 			invalidParams.AddNested("Artifacts", err.(request.ErrInvalidParams))
 		}
 	}
+	if s.BuildBatchConfig != nil {
+		if err := s.BuildBatchConfig.Validate(); err != nil {
+			invalidParams.AddNested("BuildBatchConfig", err.(request.ErrInvalidParams))
+		}
+	}
 	if s.Cache != nil {
 		if err := s.Cache.Validate(); err != nil {
 			invalidParams.AddNested("Cache", err.(request.ErrInvalidParams))
@@ -6045,12 +15399,24 @@ func (s *UpdateProjectInput) SetBadgeEnabled(v bool) *UpdateProjectInput {
 	return s
 }
 
+// SetBuildBatchConfig sets the BuildBatchConfig field's value.
+func (s *UpdateProjectInput) SetBuildBatchConfig(v *ProjectBuildBatchConfig) *UpdateProjectInput {
+	s.BuildBatchConfig = v
+	return s
+}
+
 // SetCache sets the Cache field's value.
 func (s *UpdateProjectInput) SetCache(v *ProjectCache) *UpdateProjectInput {
 	s.Cache = v
 	return s
 }
 
+// SetConcurrentBuildLimit sets the ConcurrentBuildLimit field's value.
+func (s *UpdateProjectInput) SetConcurrentBuildLimit(v int64) *UpdateProjectInput {
+	s.ConcurrentBuildLimit = &v
+	return s
+}
+
 // SetDescription sets the Description field's value.
 func (s *UpdateProjectInput) SetDescription(v string) *UpdateProjectInput {
 	s.Description = &v
@@ -6069,6 +15435,12 @@ func (s *UpdateProjectInput) SetEnvironment(v *ProjectEnvironment) *UpdateProjec
 	return s
 }
 
+// SetFileSystemLocations sets the FileSystemLocations field's value.
+func (s *UpdateProjectInput) SetFileSystemLocations(v []*ProjectFileSystemLocation) *UpdateProjectInput {
+	s.FileSystemLocations = v
+	return s
+}
+
 // SetLogsConfig sets the LogsConfig field's value.
 func (s *UpdateProjectInput) SetLogsConfig(v *LogsConfig) *UpdateProjectInput {
 	s.LogsConfig = v
@@ -6129,38 +15501,307 @@ func (s *UpdateProjectInput) SetTags(v []*Tag) *UpdateProjectInput {
 	return s
 }
 
-// SetTimeoutInMinutes sets the TimeoutInMinutes field's value.
-func (s *UpdateProjectInput) SetTimeoutInMinutes(v int64) *UpdateProjectInput {
-	s.TimeoutInMinutes = &v
+// SetTimeoutInMinutes sets the TimeoutInMinutes field's value.
+func (s *UpdateProjectInput) SetTimeoutInMinutes(v int64) *UpdateProjectInput {
+	s.TimeoutInMinutes = &v
+	return s
+}
+
+// SetVpcConfig sets the VpcConfig field's value.
+func (s *UpdateProjectInput) SetVpcConfig(v *VpcConfig) *UpdateProjectInput {
+	s.VpcConfig = v
+	return s
+}
+
+type UpdateProjectOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Information about the build project that was changed.
+	Project *Project `locationName:"project" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateProjectOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateProjectOutput) GoString() string {
+	return s.String()
+}
+
+// SetProject sets the Project field's value.
+func (s *UpdateProjectOutput) SetProject(v *Project) *UpdateProjectOutput {
+	s.Project = v
+	return s
+}
+
+type UpdateProjectVisibilityInput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the build project.
+	//
+	// ProjectArn is a required field
+	ProjectArn *string `locationName:"projectArn" min:"1" type:"string" required:"true"`
+
+	// Specifies the visibility of the project's builds. Possible values are:
+	//
+	// PUBLIC_READ
+	//
+	// The project builds are visible to the public.
+	//
+	// PRIVATE
+	//
+	// The project builds are not visible to the public.
+	//
+	// ProjectVisibility is a required field
+	ProjectVisibility *string `locationName:"projectVisibility" type:"string" required:"true" enum:"ProjectVisibilityType"`
+
+	// The ARN of the IAM role that enables CodeBuild to access the CloudWatch Logs
+	// and Amazon S3 artifacts for the project's builds.
+	ResourceAccessRole *string `locationName:"resourceAccessRole" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateProjectVisibilityInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateProjectVisibilityInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *UpdateProjectVisibilityInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateProjectVisibilityInput"}
+	if s.ProjectArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ProjectArn"))
+	}
+	if s.ProjectArn != nil && len(*s.ProjectArn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ProjectArn", 1))
+	}
+	if s.ProjectVisibility == nil {
+		invalidParams.Add(request.NewErrParamRequired("ProjectVisibility"))
+	}
+	if s.ResourceAccessRole != nil && len(*s.ResourceAccessRole) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ResourceAccessRole", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetProjectArn sets the ProjectArn field's value.
+func (s *UpdateProjectVisibilityInput) SetProjectArn(v string) *UpdateProjectVisibilityInput {
+	s.ProjectArn = &v
+	return s
+}
+
+// SetProjectVisibility sets the ProjectVisibility field's value.
+func (s *UpdateProjectVisibilityInput) SetProjectVisibility(v string) *UpdateProjectVisibilityInput {
+	s.ProjectVisibility = &v
+	return s
+}
+
+// SetResourceAccessRole sets the ResourceAccessRole field's value.
+func (s *UpdateProjectVisibilityInput) SetResourceAccessRole(v string) *UpdateProjectVisibilityInput {
+	s.ResourceAccessRole = &v
+	return s
+}
+
+type UpdateProjectVisibilityOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the build project.
+	ProjectArn *string `locationName:"projectArn" min:"1" type:"string"`
+
+	// Specifies the visibility of the project's builds. Possible values are:
+	//
+	// PUBLIC_READ
+	//
+	// The project builds are visible to the public.
+	//
+	// PRIVATE
+	//
+	// The project builds are not visible to the public.
+	ProjectVisibility *string `locationName:"projectVisibility" type:"string" enum:"ProjectVisibilityType"`
+
+	// Contains the project identifier used with the public build APIs.
+	PublicProjectAlias *string `locationName:"publicProjectAlias" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateProjectVisibilityOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateProjectVisibilityOutput) GoString() string {
+	return s.String()
+}
+
+// SetProjectArn sets the ProjectArn field's value.
+func (s *UpdateProjectVisibilityOutput) SetProjectArn(v string) *UpdateProjectVisibilityOutput {
+	s.ProjectArn = &v
+	return s
+}
+
+// SetProjectVisibility sets the ProjectVisibility field's value.
+func (s *UpdateProjectVisibilityOutput) SetProjectVisibility(v string) *UpdateProjectVisibilityOutput {
+	s.ProjectVisibility = &v
+	return s
+}
+
+// SetPublicProjectAlias sets the PublicProjectAlias field's value.
+func (s *UpdateProjectVisibilityOutput) SetPublicProjectAlias(v string) *UpdateProjectVisibilityOutput {
+	s.PublicProjectAlias = &v
+	return s
+}
+
+type UpdateReportGroupInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the report group to update.
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"1" type:"string" required:"true"`
+
+	// Used to specify an updated export type. Valid values are:
+	//
+	//    * S3: The report results are exported to an S3 bucket.
+	//
+	//    * NO_EXPORT: The report results are not exported.
+	ExportConfig *ReportExportConfig `locationName:"exportConfig" type:"structure"`
+
+	// An updated list of tag key and value pairs associated with this report group.
+	//
+	// These tags are available for use by Amazon Web Services services that support
+	// CodeBuild report group tags.
+	Tags []*Tag `locationName:"tags" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateReportGroupInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateReportGroupInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *UpdateReportGroupInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateReportGroupInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
+	}
+	if s.Arn != nil && len(*s.Arn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 1))
+	}
+	if s.ExportConfig != nil {
+		if err := s.ExportConfig.Validate(); err != nil {
+			invalidParams.AddNested("ExportConfig", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetArn sets the Arn field's value.
+func (s *UpdateReportGroupInput) SetArn(v string) *UpdateReportGroupInput {
+	s.Arn = &v
+	return s
+}
+
+// SetExportConfig sets the ExportConfig field's value.
+func (s *UpdateReportGroupInput) SetExportConfig(v *ReportExportConfig) *UpdateReportGroupInput {
+	s.ExportConfig = v
 	return s
 }
 
-// SetVpcConfig sets the VpcConfig field's value.
-func (s *UpdateProjectInput) SetVpcConfig(v *VpcConfig) *UpdateProjectInput {
-	s.VpcConfig = v
+// SetTags sets the Tags field's value.
+func (s *UpdateReportGroupInput) SetTags(v []*Tag) *UpdateReportGroupInput {
+	s.Tags = v
 	return s
 }
 
-type UpdateProjectOutput struct {
+type UpdateReportGroupOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Information about the build project that was changed.
-	Project *Project `locationName:"project" type:"structure"`
+	// Information about the updated report group.
+	ReportGroup *ReportGroup `locationName:"reportGroup" type:"structure"`
 }
 
-// String returns the string representation
-func (s UpdateProjectOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateReportGroupOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s UpdateProjectOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateReportGroupOutput) GoString() string {
 	return s.String()
 }
 
-// SetProject sets the Project field's value.
-func (s *UpdateProjectOutput) SetProject(v *Project) *UpdateProjectOutput {
-	s.Project = v
+// SetReportGroup sets the ReportGroup field's value.
+func (s *UpdateReportGroupOutput) SetReportGroup(v *ReportGroup) *UpdateReportGroupOutput {
+	s.ReportGroup = v
 	return s
 }
 
@@ -6175,12 +15816,15 @@ type UpdateWebhookInput struct {
 	// It is recommended that you use filterGroups instead of branchFilter.
 	BranchFilter *string `locationName:"branchFilter" type:"string"`
 
+	// Specifies the type of build this webhook will trigger.
+	BuildType *string `locationName:"buildType" type:"string" enum:"WebhookBuildType"`
+
 	// An array of arrays of WebhookFilter objects used to determine if a webhook
-	// event can trigger a build. A filter group must pcontain at least one EVENT
+	// event can trigger a build. A filter group must contain at least one EVENT
 	// WebhookFilter.
 	FilterGroups [][]*WebhookFilter `locationName:"filterGroups" type:"list"`
 
-	// The name of the AWS CodeBuild project.
+	// The name of the CodeBuild project.
 	//
 	// ProjectName is a required field
 	ProjectName *string `locationName:"projectName" min:"2" type:"string" required:"true"`
@@ -6191,12 +15835,20 @@ type UpdateWebhookInput struct {
 	RotateSecret *bool `locationName:"rotateSecret" type:"boolean"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateWebhookInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateWebhookInput) GoString() string {
 	return s.String()
 }
@@ -6223,6 +15875,12 @@ func (s *UpdateWebhookInput) SetBranchFilter(v string) *UpdateWebhookInput {
 	return s
 }
 
+// SetBuildType sets the BuildType field's value.
+func (s *UpdateWebhookInput) SetBuildType(v string) *UpdateWebhookInput {
+	s.BuildType = &v
+	return s
+}
+
 // SetFilterGroups sets the FilterGroups field's value.
 func (s *UpdateWebhookInput) SetFilterGroups(v [][]*WebhookFilter) *UpdateWebhookInput {
 	s.FilterGroups = v
@@ -6245,16 +15903,24 @@ type UpdateWebhookOutput struct {
 	_ struct{} `type:"structure"`
 
 	// Information about a repository's webhook that is associated with a project
-	// in AWS CodeBuild.
+	// in CodeBuild.
 	Webhook *Webhook `locationName:"webhook" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateWebhookOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateWebhookOutput) GoString() string {
 	return s.String()
 }
@@ -6265,7 +15931,7 @@ func (s *UpdateWebhookOutput) SetWebhook(v *Webhook) *UpdateWebhookOutput {
 	return s
 }
 
-// Information about the VPC configuration that AWS CodeBuild accesses.
+// Information about the VPC configuration that CodeBuild accesses.
 type VpcConfig struct {
 	_ struct{} `type:"structure"`
 
@@ -6279,12 +15945,20 @@ type VpcConfig struct {
 	VpcId *string `locationName:"vpcId" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VpcConfig) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VpcConfig) GoString() string {
 	return s.String()
 }
@@ -6321,7 +15995,7 @@ func (s *VpcConfig) SetVpcId(v string) *VpcConfig {
 }
 
 // Information about a webhook that connects repository events to a build project
-// in AWS CodeBuild.
+// in CodeBuild.
 type Webhook struct {
 	_ struct{} `type:"structure"`
 
@@ -6333,6 +16007,9 @@ type Webhook struct {
 	// It is recommended that you use filterGroups instead of branchFilter.
 	BranchFilter *string `locationName:"branchFilter" type:"string"`
 
+	// Specifies the type of build this webhook will trigger.
+	BuildType *string `locationName:"buildType" type:"string" enum:"WebhookBuildType"`
+
 	// An array of arrays of WebhookFilter objects used to determine which webhooks
 	// are triggered. At least one WebhookFilter in the array must specify EVENT
 	// as its type.
@@ -6345,7 +16022,7 @@ type Webhook struct {
 	// modified.
 	LastModifiedSecret *time.Time `locationName:"lastModifiedSecret" type:"timestamp"`
 
-	// The AWS CodeBuild endpoint where webhook events are sent.
+	// The CodeBuild endpoint where webhook events are sent.
 	PayloadUrl *string `locationName:"payloadUrl" min:"1" type:"string"`
 
 	// The secret token of the associated repository.
@@ -6357,12 +16034,20 @@ type Webhook struct {
 	Url *string `locationName:"url" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Webhook) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Webhook) GoString() string {
 	return s.String()
 }
@@ -6373,6 +16058,12 @@ func (s *Webhook) SetBranchFilter(v string) *Webhook {
 	return s
 }
 
+// SetBuildType sets the BuildType field's value.
+func (s *Webhook) SetBuildType(v string) *Webhook {
+	s.BuildType = &v
+	return s
+}
+
 // SetFilterGroups sets the FilterGroups field's value.
 func (s *Webhook) SetFilterGroups(v [][]*WebhookFilter) *Webhook {
 	s.FilterGroups = v
@@ -6426,16 +16117,16 @@ type WebhookFilter struct {
 	// Pattern is a required field
 	Pattern *string `locationName:"pattern" type:"string" required:"true"`
 
-	// The type of webhook filter. There are five webhook filter types: EVENT, ACTOR_ACCOUNT_ID,
-	// HEAD_REF, BASE_REF, and FILE_PATH.
+	// The type of webhook filter. There are six webhook filter types: EVENT, ACTOR_ACCOUNT_ID,
+	// HEAD_REF, BASE_REF, FILE_PATH, and COMMIT_MESSAGE.
 	//
 	// EVENT
 	//
 	// A webhook event triggers a build when the provided pattern matches one of
-	// four event types: PUSH, PULL_REQUEST_CREATED, PULL_REQUEST_UPDATED, and PULL_REQUEST_REOPENED.
-	// The EVENT patterns are specified as a comma-separated string. For example,
-	// PUSH, PULL_REQUEST_CREATED, PULL_REQUEST_UPDATED filters all push, pull request
-	// created, and pull request updated events.
+	// five event types: PUSH, PULL_REQUEST_CREATED, PULL_REQUEST_UPDATED, PULL_REQUEST_REOPENED,
+	// and PULL_REQUEST_MERGED. The EVENT patterns are specified as a comma-separated
+	// string. For example, PUSH, PULL_REQUEST_CREATED, PULL_REQUEST_UPDATED filters
+	// all push, pull request created, and pull request updated events.
 	//
 	// The PULL_REQUEST_REOPENED works with GitHub and GitHub Enterprise only.
 	//
@@ -6464,18 +16155,37 @@ type WebhookFilter struct {
 	// A webhook triggers a build when the path of a changed file matches the regular
 	// expression pattern.
 	//
-	// Works with GitHub and GitHub Enterprise push events only.
+	// Works with GitHub and Bitbucket events push and pull requests events. Also
+	// works with GitHub Enterprise push events, but does not work with GitHub Enterprise
+	// pull request events.
+	//
+	// COMMIT_MESSAGE
+	//
+	// A webhook triggers a build when the head commit message matches the regular
+	// expression pattern.
+	//
+	// Works with GitHub and Bitbucket events push and pull requests events. Also
+	// works with GitHub Enterprise push events, but does not work with GitHub Enterprise
+	// pull request events.
 	//
 	// Type is a required field
 	Type *string `locationName:"type" type:"string" required:"true" enum:"WebhookFilterType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s WebhookFilter) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s WebhookFilter) GoString() string {
 	return s.String()
 }
@@ -6506,6 +16216,14 @@ const (
 	ArtifactNamespaceBuildId = "BUILD_ID"
 )
 
+// ArtifactNamespace_Values returns all elements of the ArtifactNamespace enum
+func ArtifactNamespace_Values() []string {
+	return []string{
+		ArtifactNamespaceNone,
+		ArtifactNamespaceBuildId,
+	}
+}
+
 const (
 	// ArtifactPackagingNone is a ArtifactPackaging enum value
 	ArtifactPackagingNone = "NONE"
@@ -6514,6 +16232,14 @@ const (
 	ArtifactPackagingZip = "ZIP"
 )
 
+// ArtifactPackaging_Values returns all elements of the ArtifactPackaging enum
+func ArtifactPackaging_Values() []string {
+	return []string{
+		ArtifactPackagingNone,
+		ArtifactPackagingZip,
+	}
+}
+
 const (
 	// ArtifactsTypeCodepipeline is a ArtifactsType enum value
 	ArtifactsTypeCodepipeline = "CODEPIPELINE"
@@ -6525,6 +16251,15 @@ const (
 	ArtifactsTypeNoArtifacts = "NO_ARTIFACTS"
 )
 
+// ArtifactsType_Values returns all elements of the ArtifactsType enum
+func ArtifactsType_Values() []string {
+	return []string{
+		ArtifactsTypeCodepipeline,
+		ArtifactsTypeS3,
+		ArtifactsTypeNoArtifacts,
+	}
+}
+
 const (
 	// AuthTypeOauth is a AuthType enum value
 	AuthTypeOauth = "OAUTH"
@@ -6536,6 +16271,121 @@ const (
 	AuthTypePersonalAccessToken = "PERSONAL_ACCESS_TOKEN"
 )
 
+// AuthType_Values returns all elements of the AuthType enum
+func AuthType_Values() []string {
+	return []string{
+		AuthTypeOauth,
+		AuthTypeBasicAuth,
+		AuthTypePersonalAccessToken,
+	}
+}
+
+const (
+	// BatchReportModeTypeReportIndividualBuilds is a BatchReportModeType enum value
+	BatchReportModeTypeReportIndividualBuilds = "REPORT_INDIVIDUAL_BUILDS"
+
+	// BatchReportModeTypeReportAggregatedBatch is a BatchReportModeType enum value
+	BatchReportModeTypeReportAggregatedBatch = "REPORT_AGGREGATED_BATCH"
+)
+
+// BatchReportModeType_Values returns all elements of the BatchReportModeType enum
+func BatchReportModeType_Values() []string {
+	return []string{
+		BatchReportModeTypeReportIndividualBuilds,
+		BatchReportModeTypeReportAggregatedBatch,
+	}
+}
+
+// Specifies the bucket owner's access for objects that another account uploads
+// to their Amazon S3 bucket. By default, only the account that uploads the
+// objects to the bucket has access to these objects. This property allows you
+// to give the bucket owner access to these objects.
+//
+// To use this property, your CodeBuild service role must have the s3:PutBucketAcl
+// permission. This permission allows CodeBuild to modify the access control
+// list for the bucket.
+//
+// This property can be one of the following values:
+//
+// # NONE
+//
+// The bucket owner does not have access to the objects. This is the default.
+//
+// READ_ONLY
+//
+// The bucket owner has read-only access to the objects. The uploading account
+// retains ownership of the objects.
+//
+// # FULL
+//
+// The bucket owner has full access to the objects. Object ownership is determined
+// by the following criteria:
+//
+//   - If the bucket is configured with the Bucket owner preferred setting,
+//     the bucket owner owns the objects. The uploading account will have object
+//     access as specified by the bucket's policy.
+//
+//   - Otherwise, the uploading account retains ownership of the objects.
+//
+// For more information about Amazon S3 object ownership, see Controlling ownership
+// of uploaded objects using S3 Object Ownership (https://docs.aws.amazon.com/AmazonS3/latest/userguide/about-object-ownership.html)
+// in the Amazon Simple Storage Service User Guide.
+const (
+	// BucketOwnerAccessNone is a BucketOwnerAccess enum value
+	BucketOwnerAccessNone = "NONE"
+
+	// BucketOwnerAccessReadOnly is a BucketOwnerAccess enum value
+	BucketOwnerAccessReadOnly = "READ_ONLY"
+
+	// BucketOwnerAccessFull is a BucketOwnerAccess enum value
+	BucketOwnerAccessFull = "FULL"
+)
+
+// BucketOwnerAccess_Values returns all elements of the BucketOwnerAccess enum
+func BucketOwnerAccess_Values() []string {
+	return []string{
+		BucketOwnerAccessNone,
+		BucketOwnerAccessReadOnly,
+		BucketOwnerAccessFull,
+	}
+}
+
+const (
+	// BuildBatchPhaseTypeSubmitted is a BuildBatchPhaseType enum value
+	BuildBatchPhaseTypeSubmitted = "SUBMITTED"
+
+	// BuildBatchPhaseTypeDownloadBatchspec is a BuildBatchPhaseType enum value
+	BuildBatchPhaseTypeDownloadBatchspec = "DOWNLOAD_BATCHSPEC"
+
+	// BuildBatchPhaseTypeInProgress is a BuildBatchPhaseType enum value
+	BuildBatchPhaseTypeInProgress = "IN_PROGRESS"
+
+	// BuildBatchPhaseTypeCombineArtifacts is a BuildBatchPhaseType enum value
+	BuildBatchPhaseTypeCombineArtifacts = "COMBINE_ARTIFACTS"
+
+	// BuildBatchPhaseTypeSucceeded is a BuildBatchPhaseType enum value
+	BuildBatchPhaseTypeSucceeded = "SUCCEEDED"
+
+	// BuildBatchPhaseTypeFailed is a BuildBatchPhaseType enum value
+	BuildBatchPhaseTypeFailed = "FAILED"
+
+	// BuildBatchPhaseTypeStopped is a BuildBatchPhaseType enum value
+	BuildBatchPhaseTypeStopped = "STOPPED"
+)
+
+// BuildBatchPhaseType_Values returns all elements of the BuildBatchPhaseType enum
+func BuildBatchPhaseType_Values() []string {
+	return []string{
+		BuildBatchPhaseTypeSubmitted,
+		BuildBatchPhaseTypeDownloadBatchspec,
+		BuildBatchPhaseTypeInProgress,
+		BuildBatchPhaseTypeCombineArtifacts,
+		BuildBatchPhaseTypeSucceeded,
+		BuildBatchPhaseTypeFailed,
+		BuildBatchPhaseTypeStopped,
+	}
+}
+
 const (
 	// BuildPhaseTypeSubmitted is a BuildPhaseType enum value
 	BuildPhaseTypeSubmitted = "SUBMITTED"
@@ -6571,6 +16421,23 @@ const (
 	BuildPhaseTypeCompleted = "COMPLETED"
 )
 
+// BuildPhaseType_Values returns all elements of the BuildPhaseType enum
+func BuildPhaseType_Values() []string {
+	return []string{
+		BuildPhaseTypeSubmitted,
+		BuildPhaseTypeQueued,
+		BuildPhaseTypeProvisioning,
+		BuildPhaseTypeDownloadSource,
+		BuildPhaseTypeInstall,
+		BuildPhaseTypePreBuild,
+		BuildPhaseTypeBuild,
+		BuildPhaseTypePostBuild,
+		BuildPhaseTypeUploadArtifacts,
+		BuildPhaseTypeFinalizing,
+		BuildPhaseTypeCompleted,
+	}
+}
+
 const (
 	// CacheModeLocalDockerLayerCache is a CacheMode enum value
 	CacheModeLocalDockerLayerCache = "LOCAL_DOCKER_LAYER_CACHE"
@@ -6582,6 +16449,15 @@ const (
 	CacheModeLocalCustomCache = "LOCAL_CUSTOM_CACHE"
 )
 
+// CacheMode_Values returns all elements of the CacheMode enum
+func CacheMode_Values() []string {
+	return []string{
+		CacheModeLocalDockerLayerCache,
+		CacheModeLocalSourceCache,
+		CacheModeLocalCustomCache,
+	}
+}
+
 const (
 	// CacheTypeNoCache is a CacheType enum value
 	CacheTypeNoCache = "NO_CACHE"
@@ -6593,6 +16469,15 @@ const (
 	CacheTypeLocal = "LOCAL"
 )
 
+// CacheType_Values returns all elements of the CacheType enum
+func CacheType_Values() []string {
+	return []string{
+		CacheTypeNoCache,
+		CacheTypeS3,
+		CacheTypeLocal,
+	}
+}
+
 const (
 	// ComputeTypeBuildGeneral1Small is a ComputeType enum value
 	ComputeTypeBuildGeneral1Small = "BUILD_GENERAL1_SMALL"
@@ -6602,29 +16487,93 @@ const (
 
 	// ComputeTypeBuildGeneral1Large is a ComputeType enum value
 	ComputeTypeBuildGeneral1Large = "BUILD_GENERAL1_LARGE"
+
+	// ComputeTypeBuildGeneral12xlarge is a ComputeType enum value
+	ComputeTypeBuildGeneral12xlarge = "BUILD_GENERAL1_2XLARGE"
 )
 
+// ComputeType_Values returns all elements of the ComputeType enum
+func ComputeType_Values() []string {
+	return []string{
+		ComputeTypeBuildGeneral1Small,
+		ComputeTypeBuildGeneral1Medium,
+		ComputeTypeBuildGeneral1Large,
+		ComputeTypeBuildGeneral12xlarge,
+	}
+}
+
 const (
 	// CredentialProviderTypeSecretsManager is a CredentialProviderType enum value
 	CredentialProviderTypeSecretsManager = "SECRETS_MANAGER"
 )
 
+// CredentialProviderType_Values returns all elements of the CredentialProviderType enum
+func CredentialProviderType_Values() []string {
+	return []string{
+		CredentialProviderTypeSecretsManager,
+	}
+}
+
 const (
 	// EnvironmentTypeWindowsContainer is a EnvironmentType enum value
 	EnvironmentTypeWindowsContainer = "WINDOWS_CONTAINER"
 
 	// EnvironmentTypeLinuxContainer is a EnvironmentType enum value
 	EnvironmentTypeLinuxContainer = "LINUX_CONTAINER"
+
+	// EnvironmentTypeLinuxGpuContainer is a EnvironmentType enum value
+	EnvironmentTypeLinuxGpuContainer = "LINUX_GPU_CONTAINER"
+
+	// EnvironmentTypeArmContainer is a EnvironmentType enum value
+	EnvironmentTypeArmContainer = "ARM_CONTAINER"
+
+	// EnvironmentTypeWindowsServer2019Container is a EnvironmentType enum value
+	EnvironmentTypeWindowsServer2019Container = "WINDOWS_SERVER_2019_CONTAINER"
 )
 
+// EnvironmentType_Values returns all elements of the EnvironmentType enum
+func EnvironmentType_Values() []string {
+	return []string{
+		EnvironmentTypeWindowsContainer,
+		EnvironmentTypeLinuxContainer,
+		EnvironmentTypeLinuxGpuContainer,
+		EnvironmentTypeArmContainer,
+		EnvironmentTypeWindowsServer2019Container,
+	}
+}
+
 const (
 	// EnvironmentVariableTypePlaintext is a EnvironmentVariableType enum value
 	EnvironmentVariableTypePlaintext = "PLAINTEXT"
 
 	// EnvironmentVariableTypeParameterStore is a EnvironmentVariableType enum value
 	EnvironmentVariableTypeParameterStore = "PARAMETER_STORE"
+
+	// EnvironmentVariableTypeSecretsManager is a EnvironmentVariableType enum value
+	EnvironmentVariableTypeSecretsManager = "SECRETS_MANAGER"
+)
+
+// EnvironmentVariableType_Values returns all elements of the EnvironmentVariableType enum
+func EnvironmentVariableType_Values() []string {
+	return []string{
+		EnvironmentVariableTypePlaintext,
+		EnvironmentVariableTypeParameterStore,
+		EnvironmentVariableTypeSecretsManager,
+	}
+}
+
+const (
+	// FileSystemTypeEfs is a FileSystemType enum value
+	FileSystemTypeEfs = "EFS"
 )
 
+// FileSystemType_Values returns all elements of the FileSystemType enum
+func FileSystemType_Values() []string {
+	return []string{
+		FileSystemTypeEfs,
+	}
+}
+
 const (
 	// ImagePullCredentialsTypeCodebuild is a ImagePullCredentialsType enum value
 	ImagePullCredentialsTypeCodebuild = "CODEBUILD"
@@ -6633,6 +16582,14 @@ const (
 	ImagePullCredentialsTypeServiceRole = "SERVICE_ROLE"
 )
 
+// ImagePullCredentialsType_Values returns all elements of the ImagePullCredentialsType enum
+func ImagePullCredentialsType_Values() []string {
+	return []string{
+		ImagePullCredentialsTypeCodebuild,
+		ImagePullCredentialsTypeServiceRole,
+	}
+}
+
 const (
 	// LanguageTypeJava is a LanguageType enum value
 	LanguageTypeJava = "JAVA"
@@ -6665,6 +16622,22 @@ const (
 	LanguageTypePhp = "PHP"
 )
 
+// LanguageType_Values returns all elements of the LanguageType enum
+func LanguageType_Values() []string {
+	return []string{
+		LanguageTypeJava,
+		LanguageTypePython,
+		LanguageTypeNodeJs,
+		LanguageTypeRuby,
+		LanguageTypeGolang,
+		LanguageTypeDocker,
+		LanguageTypeAndroid,
+		LanguageTypeDotnet,
+		LanguageTypeBase,
+		LanguageTypePhp,
+	}
+}
+
 const (
 	// LogsConfigStatusTypeEnabled is a LogsConfigStatusType enum value
 	LogsConfigStatusTypeEnabled = "ENABLED"
@@ -6673,6 +16646,14 @@ const (
 	LogsConfigStatusTypeDisabled = "DISABLED"
 )
 
+// LogsConfigStatusType_Values returns all elements of the LogsConfigStatusType enum
+func LogsConfigStatusType_Values() []string {
+	return []string{
+		LogsConfigStatusTypeEnabled,
+		LogsConfigStatusTypeDisabled,
+	}
+}
+
 const (
 	// PlatformTypeDebian is a PlatformType enum value
 	PlatformTypeDebian = "DEBIAN"
@@ -6687,6 +16668,16 @@ const (
 	PlatformTypeWindowsServer = "WINDOWS_SERVER"
 )
 
+// PlatformType_Values returns all elements of the PlatformType enum
+func PlatformType_Values() []string {
+	return []string{
+		PlatformTypeDebian,
+		PlatformTypeAmazonLinux,
+		PlatformTypeUbuntu,
+		PlatformTypeWindowsServer,
+	}
+}
+
 const (
 	// ProjectSortByTypeName is a ProjectSortByType enum value
 	ProjectSortByTypeName = "NAME"
@@ -6698,6 +16689,228 @@ const (
 	ProjectSortByTypeLastModifiedTime = "LAST_MODIFIED_TIME"
 )
 
+// ProjectSortByType_Values returns all elements of the ProjectSortByType enum
+func ProjectSortByType_Values() []string {
+	return []string{
+		ProjectSortByTypeName,
+		ProjectSortByTypeCreatedTime,
+		ProjectSortByTypeLastModifiedTime,
+	}
+}
+
+// Specifies the visibility of the project's builds. Possible values are:
+//
+// PUBLIC_READ
+//
+// The project builds are visible to the public.
+//
+// # PRIVATE
+//
+// The project builds are not visible to the public.
+const (
+	// ProjectVisibilityTypePublicRead is a ProjectVisibilityType enum value
+	ProjectVisibilityTypePublicRead = "PUBLIC_READ"
+
+	// ProjectVisibilityTypePrivate is a ProjectVisibilityType enum value
+	ProjectVisibilityTypePrivate = "PRIVATE"
+)
+
+// ProjectVisibilityType_Values returns all elements of the ProjectVisibilityType enum
+func ProjectVisibilityType_Values() []string {
+	return []string{
+		ProjectVisibilityTypePublicRead,
+		ProjectVisibilityTypePrivate,
+	}
+}
+
+const (
+	// ReportCodeCoverageSortByTypeLineCoveragePercentage is a ReportCodeCoverageSortByType enum value
+	ReportCodeCoverageSortByTypeLineCoveragePercentage = "LINE_COVERAGE_PERCENTAGE"
+
+	// ReportCodeCoverageSortByTypeFilePath is a ReportCodeCoverageSortByType enum value
+	ReportCodeCoverageSortByTypeFilePath = "FILE_PATH"
+)
+
+// ReportCodeCoverageSortByType_Values returns all elements of the ReportCodeCoverageSortByType enum
+func ReportCodeCoverageSortByType_Values() []string {
+	return []string{
+		ReportCodeCoverageSortByTypeLineCoveragePercentage,
+		ReportCodeCoverageSortByTypeFilePath,
+	}
+}
+
+const (
+	// ReportExportConfigTypeS3 is a ReportExportConfigType enum value
+	ReportExportConfigTypeS3 = "S3"
+
+	// ReportExportConfigTypeNoExport is a ReportExportConfigType enum value
+	ReportExportConfigTypeNoExport = "NO_EXPORT"
+)
+
+// ReportExportConfigType_Values returns all elements of the ReportExportConfigType enum
+func ReportExportConfigType_Values() []string {
+	return []string{
+		ReportExportConfigTypeS3,
+		ReportExportConfigTypeNoExport,
+	}
+}
+
+const (
+	// ReportGroupSortByTypeName is a ReportGroupSortByType enum value
+	ReportGroupSortByTypeName = "NAME"
+
+	// ReportGroupSortByTypeCreatedTime is a ReportGroupSortByType enum value
+	ReportGroupSortByTypeCreatedTime = "CREATED_TIME"
+
+	// ReportGroupSortByTypeLastModifiedTime is a ReportGroupSortByType enum value
+	ReportGroupSortByTypeLastModifiedTime = "LAST_MODIFIED_TIME"
+)
+
+// ReportGroupSortByType_Values returns all elements of the ReportGroupSortByType enum
+func ReportGroupSortByType_Values() []string {
+	return []string{
+		ReportGroupSortByTypeName,
+		ReportGroupSortByTypeCreatedTime,
+		ReportGroupSortByTypeLastModifiedTime,
+	}
+}
+
+const (
+	// ReportGroupStatusTypeActive is a ReportGroupStatusType enum value
+	ReportGroupStatusTypeActive = "ACTIVE"
+
+	// ReportGroupStatusTypeDeleting is a ReportGroupStatusType enum value
+	ReportGroupStatusTypeDeleting = "DELETING"
+)
+
+// ReportGroupStatusType_Values returns all elements of the ReportGroupStatusType enum
+func ReportGroupStatusType_Values() []string {
+	return []string{
+		ReportGroupStatusTypeActive,
+		ReportGroupStatusTypeDeleting,
+	}
+}
+
+const (
+	// ReportGroupTrendFieldTypePassRate is a ReportGroupTrendFieldType enum value
+	ReportGroupTrendFieldTypePassRate = "PASS_RATE"
+
+	// ReportGroupTrendFieldTypeDuration is a ReportGroupTrendFieldType enum value
+	ReportGroupTrendFieldTypeDuration = "DURATION"
+
+	// ReportGroupTrendFieldTypeTotal is a ReportGroupTrendFieldType enum value
+	ReportGroupTrendFieldTypeTotal = "TOTAL"
+
+	// ReportGroupTrendFieldTypeLineCoverage is a ReportGroupTrendFieldType enum value
+	ReportGroupTrendFieldTypeLineCoverage = "LINE_COVERAGE"
+
+	// ReportGroupTrendFieldTypeLinesCovered is a ReportGroupTrendFieldType enum value
+	ReportGroupTrendFieldTypeLinesCovered = "LINES_COVERED"
+
+	// ReportGroupTrendFieldTypeLinesMissed is a ReportGroupTrendFieldType enum value
+	ReportGroupTrendFieldTypeLinesMissed = "LINES_MISSED"
+
+	// ReportGroupTrendFieldTypeBranchCoverage is a ReportGroupTrendFieldType enum value
+	ReportGroupTrendFieldTypeBranchCoverage = "BRANCH_COVERAGE"
+
+	// ReportGroupTrendFieldTypeBranchesCovered is a ReportGroupTrendFieldType enum value
+	ReportGroupTrendFieldTypeBranchesCovered = "BRANCHES_COVERED"
+
+	// ReportGroupTrendFieldTypeBranchesMissed is a ReportGroupTrendFieldType enum value
+	ReportGroupTrendFieldTypeBranchesMissed = "BRANCHES_MISSED"
+)
+
+// ReportGroupTrendFieldType_Values returns all elements of the ReportGroupTrendFieldType enum
+func ReportGroupTrendFieldType_Values() []string {
+	return []string{
+		ReportGroupTrendFieldTypePassRate,
+		ReportGroupTrendFieldTypeDuration,
+		ReportGroupTrendFieldTypeTotal,
+		ReportGroupTrendFieldTypeLineCoverage,
+		ReportGroupTrendFieldTypeLinesCovered,
+		ReportGroupTrendFieldTypeLinesMissed,
+		ReportGroupTrendFieldTypeBranchCoverage,
+		ReportGroupTrendFieldTypeBranchesCovered,
+		ReportGroupTrendFieldTypeBranchesMissed,
+	}
+}
+
+const (
+	// ReportPackagingTypeZip is a ReportPackagingType enum value
+	ReportPackagingTypeZip = "ZIP"
+
+	// ReportPackagingTypeNone is a ReportPackagingType enum value
+	ReportPackagingTypeNone = "NONE"
+)
+
+// ReportPackagingType_Values returns all elements of the ReportPackagingType enum
+func ReportPackagingType_Values() []string {
+	return []string{
+		ReportPackagingTypeZip,
+		ReportPackagingTypeNone,
+	}
+}
+
+const (
+	// ReportStatusTypeGenerating is a ReportStatusType enum value
+	ReportStatusTypeGenerating = "GENERATING"
+
+	// ReportStatusTypeSucceeded is a ReportStatusType enum value
+	ReportStatusTypeSucceeded = "SUCCEEDED"
+
+	// ReportStatusTypeFailed is a ReportStatusType enum value
+	ReportStatusTypeFailed = "FAILED"
+
+	// ReportStatusTypeIncomplete is a ReportStatusType enum value
+	ReportStatusTypeIncomplete = "INCOMPLETE"
+
+	// ReportStatusTypeDeleting is a ReportStatusType enum value
+	ReportStatusTypeDeleting = "DELETING"
+)
+
+// ReportStatusType_Values returns all elements of the ReportStatusType enum
+func ReportStatusType_Values() []string {
+	return []string{
+		ReportStatusTypeGenerating,
+		ReportStatusTypeSucceeded,
+		ReportStatusTypeFailed,
+		ReportStatusTypeIncomplete,
+		ReportStatusTypeDeleting,
+	}
+}
+
+const (
+	// ReportTypeTest is a ReportType enum value
+	ReportTypeTest = "TEST"
+
+	// ReportTypeCodeCoverage is a ReportType enum value
+	ReportTypeCodeCoverage = "CODE_COVERAGE"
+)
+
+// ReportType_Values returns all elements of the ReportType enum
+func ReportType_Values() []string {
+	return []string{
+		ReportTypeTest,
+		ReportTypeCodeCoverage,
+	}
+}
+
+const (
+	// RetryBuildBatchTypeRetryAllBuilds is a RetryBuildBatchType enum value
+	RetryBuildBatchTypeRetryAllBuilds = "RETRY_ALL_BUILDS"
+
+	// RetryBuildBatchTypeRetryFailedBuilds is a RetryBuildBatchType enum value
+	RetryBuildBatchTypeRetryFailedBuilds = "RETRY_FAILED_BUILDS"
+)
+
+// RetryBuildBatchType_Values returns all elements of the RetryBuildBatchType enum
+func RetryBuildBatchType_Values() []string {
+	return []string{
+		RetryBuildBatchTypeRetryAllBuilds,
+		RetryBuildBatchTypeRetryFailedBuilds,
+	}
+}
+
 const (
 	// ServerTypeGithub is a ServerType enum value
 	ServerTypeGithub = "GITHUB"
@@ -6709,6 +16922,31 @@ const (
 	ServerTypeGithubEnterprise = "GITHUB_ENTERPRISE"
 )
 
+// ServerType_Values returns all elements of the ServerType enum
+func ServerType_Values() []string {
+	return []string{
+		ServerTypeGithub,
+		ServerTypeBitbucket,
+		ServerTypeGithubEnterprise,
+	}
+}
+
+const (
+	// SharedResourceSortByTypeArn is a SharedResourceSortByType enum value
+	SharedResourceSortByTypeArn = "ARN"
+
+	// SharedResourceSortByTypeModifiedTime is a SharedResourceSortByType enum value
+	SharedResourceSortByTypeModifiedTime = "MODIFIED_TIME"
+)
+
+// SharedResourceSortByType_Values returns all elements of the SharedResourceSortByType enum
+func SharedResourceSortByType_Values() []string {
+	return []string{
+		SharedResourceSortByTypeArn,
+		SharedResourceSortByTypeModifiedTime,
+	}
+}
+
 const (
 	// SortOrderTypeAscending is a SortOrderType enum value
 	SortOrderTypeAscending = "ASCENDING"
@@ -6717,11 +16955,26 @@ const (
 	SortOrderTypeDescending = "DESCENDING"
 )
 
+// SortOrderType_Values returns all elements of the SortOrderType enum
+func SortOrderType_Values() []string {
+	return []string{
+		SortOrderTypeAscending,
+		SortOrderTypeDescending,
+	}
+}
+
 const (
 	// SourceAuthTypeOauth is a SourceAuthType enum value
 	SourceAuthTypeOauth = "OAUTH"
 )
 
+// SourceAuthType_Values returns all elements of the SourceAuthType enum
+func SourceAuthType_Values() []string {
+	return []string{
+		SourceAuthTypeOauth,
+	}
+}
+
 const (
 	// SourceTypeCodecommit is a SourceType enum value
 	SourceTypeCodecommit = "CODECOMMIT"
@@ -6745,6 +16998,19 @@ const (
 	SourceTypeNoSource = "NO_SOURCE"
 )
 
+// SourceType_Values returns all elements of the SourceType enum
+func SourceType_Values() []string {
+	return []string{
+		SourceTypeCodecommit,
+		SourceTypeCodepipeline,
+		SourceTypeGithub,
+		SourceTypeS3,
+		SourceTypeBitbucket,
+		SourceTypeGithubEnterprise,
+		SourceTypeNoSource,
+	}
+}
+
 const (
 	// StatusTypeSucceeded is a StatusType enum value
 	StatusTypeSucceeded = "SUCCEEDED"
@@ -6765,6 +17031,34 @@ const (
 	StatusTypeStopped = "STOPPED"
 )
 
+// StatusType_Values returns all elements of the StatusType enum
+func StatusType_Values() []string {
+	return []string{
+		StatusTypeSucceeded,
+		StatusTypeFailed,
+		StatusTypeFault,
+		StatusTypeTimedOut,
+		StatusTypeInProgress,
+		StatusTypeStopped,
+	}
+}
+
+const (
+	// WebhookBuildTypeBuild is a WebhookBuildType enum value
+	WebhookBuildTypeBuild = "BUILD"
+
+	// WebhookBuildTypeBuildBatch is a WebhookBuildType enum value
+	WebhookBuildTypeBuildBatch = "BUILD_BATCH"
+)
+
+// WebhookBuildType_Values returns all elements of the WebhookBuildType enum
+func WebhookBuildType_Values() []string {
+	return []string{
+		WebhookBuildTypeBuild,
+		WebhookBuildTypeBuildBatch,
+	}
+}
+
 const (
 	// WebhookFilterTypeEvent is a WebhookFilterType enum value
 	WebhookFilterTypeEvent = "EVENT"
@@ -6780,4 +17074,19 @@ const (
 
 	// WebhookFilterTypeFilePath is a WebhookFilterType enum value
 	WebhookFilterTypeFilePath = "FILE_PATH"
+
+	// WebhookFilterTypeCommitMessage is a WebhookFilterType enum value
+	WebhookFilterTypeCommitMessage = "COMMIT_MESSAGE"
 )
+
+// WebhookFilterType_Values returns all elements of the WebhookFilterType enum
+func WebhookFilterType_Values() []string {
+	return []string{
+		WebhookFilterTypeEvent,
+		WebhookFilterTypeBaseRef,
+		WebhookFilterTypeHeadRef,
+		WebhookFilterTypeActorAccountId,
+		WebhookFilterTypeFilePath,
+		WebhookFilterTypeCommitMessage,
+	}
+}
@@ -29,14 +29,13 @@ const opAcknowledgeJob = "AcknowledgeJob"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AcknowledgeJobRequest method.
+//	req, resp := client.AcknowledgeJobRequest(params)
 //
-//    // Example sending a request using the AcknowledgeJobRequest method.
-//    req, resp := client.AcknowledgeJobRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/AcknowledgeJob
 func (c *CodePipeline) AcknowledgeJobRequest(input *AcknowledgeJobInput) (req *request.Request, output *AcknowledgeJobOutput) {
@@ -67,15 +66,16 @@ func (c *CodePipeline) AcknowledgeJobRequest(input *AcknowledgeJobInput) (req *r
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation AcknowledgeJob for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodeInvalidNonceException "InvalidNonceException"
-//   The nonce was specified in an invalid format.
+//   - InvalidNonceException
+//     The nonce was specified in an invalid format.
 //
-//   * ErrCodeJobNotFoundException "JobNotFoundException"
-//   The job was specified in an invalid format or cannot be found.
+//   - JobNotFoundException
+//     The job was specified in an invalid format or cannot be found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/AcknowledgeJob
 func (c *CodePipeline) AcknowledgeJob(input *AcknowledgeJobInput) (*AcknowledgeJobOutput, error) {
@@ -115,14 +115,13 @@ const opAcknowledgeThirdPartyJob = "AcknowledgeThirdPartyJob"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AcknowledgeThirdPartyJobRequest method.
+//	req, resp := client.AcknowledgeThirdPartyJobRequest(params)
 //
-//    // Example sending a request using the AcknowledgeThirdPartyJobRequest method.
-//    req, resp := client.AcknowledgeThirdPartyJobRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/AcknowledgeThirdPartyJob
 func (c *CodePipeline) AcknowledgeThirdPartyJobRequest(input *AcknowledgeThirdPartyJobInput) (req *request.Request, output *AcknowledgeThirdPartyJobOutput) {
@@ -153,18 +152,19 @@ func (c *CodePipeline) AcknowledgeThirdPartyJobRequest(input *AcknowledgeThirdPa
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation AcknowledgeThirdPartyJob for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodeInvalidNonceException "InvalidNonceException"
-//   The nonce was specified in an invalid format.
+//   - InvalidNonceException
+//     The nonce was specified in an invalid format.
 //
-//   * ErrCodeJobNotFoundException "JobNotFoundException"
-//   The job was specified in an invalid format or cannot be found.
+//   - JobNotFoundException
+//     The job was specified in an invalid format or cannot be found.
 //
-//   * ErrCodeInvalidClientTokenException "InvalidClientTokenException"
-//   The client token was specified in an invalid format
+//   - InvalidClientTokenException
+//     The client token was specified in an invalid format
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/AcknowledgeThirdPartyJob
 func (c *CodePipeline) AcknowledgeThirdPartyJob(input *AcknowledgeThirdPartyJobInput) (*AcknowledgeThirdPartyJobOutput, error) {
@@ -204,14 +204,13 @@ const opCreateCustomActionType = "CreateCustomActionType"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateCustomActionTypeRequest method.
+//	req, resp := client.CreateCustomActionTypeRequest(params)
 //
-//    // Example sending a request using the CreateCustomActionTypeRequest method.
-//    req, resp := client.CreateCustomActionTypeRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/CreateCustomActionType
 func (c *CodePipeline) CreateCustomActionTypeRequest(input *CreateCustomActionTypeInput) (req *request.Request, output *CreateCustomActionTypeOutput) {
@@ -233,7 +232,7 @@ func (c *CodePipeline) CreateCustomActionTypeRequest(input *CreateCustomActionTy
 // CreateCustomActionType API operation for AWS CodePipeline.
 //
 // Creates a new custom action that can be used in all pipelines associated
-// with the AWS account. Only used for custom actions.
+// with the Amazon Web Services account. Only used for custom actions.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -242,22 +241,23 @@ func (c *CodePipeline) CreateCustomActionTypeRequest(input *CreateCustomActionTy
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation CreateCustomActionType for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The number of pipelines associated with the AWS account has exceeded the
-//   limit allowed for the account.
+//   - LimitExceededException
+//     The number of pipelines associated with the Amazon Web Services account has
+//     exceeded the limit allowed for the account.
 //
-//   * ErrCodeTooManyTagsException "TooManyTagsException"
-//   The tags limit for a resource has been exceeded.
+//   - TooManyTagsException
+//     The tags limit for a resource has been exceeded.
 //
-//   * ErrCodeInvalidTagsException "InvalidTagsException"
-//   The specified resource tags are invalid.
+//   - InvalidTagsException
+//     The specified resource tags are invalid.
 //
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   Unable to modify the tag due to a simultaneous update request.
+//   - ConcurrentModificationException
+//     Unable to modify the tag due to a simultaneous update request.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/CreateCustomActionType
 func (c *CodePipeline) CreateCustomActionType(input *CreateCustomActionTypeInput) (*CreateCustomActionTypeOutput, error) {
@@ -297,14 +297,13 @@ const opCreatePipeline = "CreatePipeline"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreatePipelineRequest method.
+//	req, resp := client.CreatePipelineRequest(params)
 //
-//    // Example sending a request using the CreatePipelineRequest method.
-//    req, resp := client.CreatePipelineRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/CreatePipeline
 func (c *CodePipeline) CreatePipelineRequest(input *CreatePipelineInput) (req *request.Request, output *CreatePipelineOutput) {
@@ -338,37 +337,38 @@ func (c *CodePipeline) CreatePipelineRequest(input *CreatePipelineInput) (req *r
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation CreatePipeline for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodePipelineNameInUseException "PipelineNameInUseException"
-//   The specified pipeline name is already in use.
+//   - PipelineNameInUseException
+//     The specified pipeline name is already in use.
 //
-//   * ErrCodeInvalidStageDeclarationException "InvalidStageDeclarationException"
-//   The stage declaration was specified in an invalid format.
+//   - InvalidStageDeclarationException
+//     The stage declaration was specified in an invalid format.
 //
-//   * ErrCodeInvalidActionDeclarationException "InvalidActionDeclarationException"
-//   The action declaration was specified in an invalid format.
+//   - InvalidActionDeclarationException
+//     The action declaration was specified in an invalid format.
 //
-//   * ErrCodeInvalidBlockerDeclarationException "InvalidBlockerDeclarationException"
-//   Reserved for future use.
+//   - InvalidBlockerDeclarationException
+//     Reserved for future use.
 //
-//   * ErrCodeInvalidStructureException "InvalidStructureException"
-//   The structure was specified in an invalid format.
+//   - InvalidStructureException
+//     The structure was specified in an invalid format.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The number of pipelines associated with the AWS account has exceeded the
-//   limit allowed for the account.
+//   - LimitExceededException
+//     The number of pipelines associated with the Amazon Web Services account has
+//     exceeded the limit allowed for the account.
 //
-//   * ErrCodeTooManyTagsException "TooManyTagsException"
-//   The tags limit for a resource has been exceeded.
+//   - TooManyTagsException
+//     The tags limit for a resource has been exceeded.
 //
-//   * ErrCodeInvalidTagsException "InvalidTagsException"
-//   The specified resource tags are invalid.
+//   - InvalidTagsException
+//     The specified resource tags are invalid.
 //
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   Unable to modify the tag due to a simultaneous update request.
+//   - ConcurrentModificationException
+//     Unable to modify the tag due to a simultaneous update request.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/CreatePipeline
 func (c *CodePipeline) CreatePipeline(input *CreatePipelineInput) (*CreatePipelineOutput, error) {
@@ -408,14 +408,13 @@ const opDeleteCustomActionType = "DeleteCustomActionType"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteCustomActionTypeRequest method.
+//	req, resp := client.DeleteCustomActionTypeRequest(params)
 //
-//    // Example sending a request using the DeleteCustomActionTypeRequest method.
-//    req, resp := client.DeleteCustomActionTypeRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/DeleteCustomActionType
 func (c *CodePipeline) DeleteCustomActionTypeRequest(input *DeleteCustomActionTypeInput) (req *request.Request, output *DeleteCustomActionTypeOutput) {
@@ -453,12 +452,13 @@ func (c *CodePipeline) DeleteCustomActionTypeRequest(input *DeleteCustomActionTy
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation DeleteCustomActionType for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   Unable to modify the tag due to a simultaneous update request.
+//   - ConcurrentModificationException
+//     Unable to modify the tag due to a simultaneous update request.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/DeleteCustomActionType
 func (c *CodePipeline) DeleteCustomActionType(input *DeleteCustomActionTypeInput) (*DeleteCustomActionTypeOutput, error) {
@@ -498,14 +498,13 @@ const opDeletePipeline = "DeletePipeline"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeletePipelineRequest method.
+//	req, resp := client.DeletePipelineRequest(params)
 //
-//    // Example sending a request using the DeletePipelineRequest method.
-//    req, resp := client.DeletePipelineRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/DeletePipeline
 func (c *CodePipeline) DeletePipelineRequest(input *DeletePipelineInput) (req *request.Request, output *DeletePipelineOutput) {
@@ -536,12 +535,13 @@ func (c *CodePipeline) DeletePipelineRequest(input *DeletePipelineInput) (req *r
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation DeletePipeline for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   Unable to modify the tag due to a simultaneous update request.
+//   - ConcurrentModificationException
+//     Unable to modify the tag due to a simultaneous update request.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/DeletePipeline
 func (c *CodePipeline) DeletePipeline(input *DeletePipelineInput) (*DeletePipelineOutput, error) {
@@ -581,14 +581,13 @@ const opDeleteWebhook = "DeleteWebhook"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteWebhookRequest method.
+//	req, resp := client.DeleteWebhookRequest(params)
 //
-//    // Example sending a request using the DeleteWebhookRequest method.
-//    req, resp := client.DeleteWebhookRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/DeleteWebhook
 func (c *CodePipeline) DeleteWebhookRequest(input *DeleteWebhookInput) (req *request.Request, output *DeleteWebhookOutput) {
@@ -611,7 +610,7 @@ func (c *CodePipeline) DeleteWebhookRequest(input *DeleteWebhookInput) (req *req
 // DeleteWebhook API operation for AWS CodePipeline.
 //
 // Deletes a previously created webhook by name. Deleting the webhook stops
-// AWS CodePipeline from starting a pipeline every time an external event occurs.
+// CodePipeline from starting a pipeline every time an external event occurs.
 // The API returns successfully when trying to delete a webhook that is already
 // deleted. If a deleted webhook is re-created by calling PutWebhook with the
 // same name, it will have a different URL.
@@ -623,12 +622,13 @@ func (c *CodePipeline) DeleteWebhookRequest(input *DeleteWebhookInput) (req *req
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation DeleteWebhook for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   Unable to modify the tag due to a simultaneous update request.
+//   - ConcurrentModificationException
+//     Unable to modify the tag due to a simultaneous update request.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/DeleteWebhook
 func (c *CodePipeline) DeleteWebhook(input *DeleteWebhookInput) (*DeleteWebhookOutput, error) {
@@ -668,14 +668,13 @@ const opDeregisterWebhookWithThirdParty = "DeregisterWebhookWithThirdParty"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeregisterWebhookWithThirdPartyRequest method.
+//	req, resp := client.DeregisterWebhookWithThirdPartyRequest(params)
 //
-//    // Example sending a request using the DeregisterWebhookWithThirdPartyRequest method.
-//    req, resp := client.DeregisterWebhookWithThirdPartyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/DeregisterWebhookWithThirdParty
 func (c *CodePipeline) DeregisterWebhookWithThirdPartyRequest(input *DeregisterWebhookWithThirdPartyInput) (req *request.Request, output *DeregisterWebhookWithThirdPartyOutput) {
@@ -708,12 +707,13 @@ func (c *CodePipeline) DeregisterWebhookWithThirdPartyRequest(input *DeregisterW
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation DeregisterWebhookWithThirdParty for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodeWebhookNotFoundException "WebhookNotFoundException"
-//   The specified webhook was entered in an invalid format or cannot be found.
+//   - WebhookNotFoundException
+//     The specified webhook was entered in an invalid format or cannot be found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/DeregisterWebhookWithThirdParty
 func (c *CodePipeline) DeregisterWebhookWithThirdParty(input *DeregisterWebhookWithThirdPartyInput) (*DeregisterWebhookWithThirdPartyOutput, error) {
@@ -753,14 +753,13 @@ const opDisableStageTransition = "DisableStageTransition"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DisableStageTransitionRequest method.
+//	req, resp := client.DisableStageTransitionRequest(params)
 //
-//    // Example sending a request using the DisableStageTransitionRequest method.
-//    req, resp := client.DisableStageTransitionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/DisableStageTransition
 func (c *CodePipeline) DisableStageTransitionRequest(input *DisableStageTransitionInput) (req *request.Request, output *DisableStageTransitionOutput) {
@@ -792,15 +791,16 @@ func (c *CodePipeline) DisableStageTransitionRequest(input *DisableStageTransiti
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation DisableStageTransition for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodePipelineNotFoundException "PipelineNotFoundException"
-//   The pipeline was specified in an invalid format or cannot be found.
+//   - PipelineNotFoundException
+//     The pipeline was specified in an invalid format or cannot be found.
 //
-//   * ErrCodeStageNotFoundException "StageNotFoundException"
-//   The stage was specified in an invalid format or cannot be found.
+//   - StageNotFoundException
+//     The stage was specified in an invalid format or cannot be found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/DisableStageTransition
 func (c *CodePipeline) DisableStageTransition(input *DisableStageTransitionInput) (*DisableStageTransitionOutput, error) {
@@ -840,14 +840,13 @@ const opEnableStageTransition = "EnableStageTransition"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the EnableStageTransitionRequest method.
+//	req, resp := client.EnableStageTransitionRequest(params)
 //
-//    // Example sending a request using the EnableStageTransitionRequest method.
-//    req, resp := client.EnableStageTransitionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/EnableStageTransition
 func (c *CodePipeline) EnableStageTransitionRequest(input *EnableStageTransitionInput) (req *request.Request, output *EnableStageTransitionOutput) {
@@ -878,15 +877,16 @@ func (c *CodePipeline) EnableStageTransitionRequest(input *EnableStageTransition
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation EnableStageTransition for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodePipelineNotFoundException "PipelineNotFoundException"
-//   The pipeline was specified in an invalid format or cannot be found.
+//   - PipelineNotFoundException
+//     The pipeline was specified in an invalid format or cannot be found.
 //
-//   * ErrCodeStageNotFoundException "StageNotFoundException"
-//   The stage was specified in an invalid format or cannot be found.
+//   - StageNotFoundException
+//     The stage was specified in an invalid format or cannot be found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/EnableStageTransition
 func (c *CodePipeline) EnableStageTransition(input *EnableStageTransitionInput) (*EnableStageTransitionOutput, error) {
@@ -910,6 +910,90 @@ func (c *CodePipeline) EnableStageTransitionWithContext(ctx aws.Context, input *
 	return out, req.Send()
 }
 
+const opGetActionType = "GetActionType"
+
+// GetActionTypeRequest generates a "aws/request.Request" representing the
+// client's request for the GetActionType operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See GetActionType for more information on using the GetActionType
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the GetActionTypeRequest method.
+//	req, resp := client.GetActionTypeRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/GetActionType
+func (c *CodePipeline) GetActionTypeRequest(input *GetActionTypeInput) (req *request.Request, output *GetActionTypeOutput) {
+	op := &request.Operation{
+		Name:       opGetActionType,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &GetActionTypeInput{}
+	}
+
+	output = &GetActionTypeOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// GetActionType API operation for AWS CodePipeline.
+//
+// Returns information about an action type created for an external provider,
+// where the action is to be used by customers of the external provider. The
+// action can be created with any supported integration model.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodePipeline's
+// API operation GetActionType for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ActionTypeNotFoundException
+//     The specified action type cannot be found.
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/GetActionType
+func (c *CodePipeline) GetActionType(input *GetActionTypeInput) (*GetActionTypeOutput, error) {
+	req, out := c.GetActionTypeRequest(input)
+	return out, req.Send()
+}
+
+// GetActionTypeWithContext is the same as GetActionType with the addition of
+// the ability to pass a context and additional request options.
+//
+// See GetActionType for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodePipeline) GetActionTypeWithContext(ctx aws.Context, input *GetActionTypeInput, opts ...request.Option) (*GetActionTypeOutput, error) {
+	req, out := c.GetActionTypeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opGetJobDetails = "GetJobDetails"
 
 // GetJobDetailsRequest generates a "aws/request.Request" representing the
@@ -926,14 +1010,13 @@ const opGetJobDetails = "GetJobDetails"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetJobDetailsRequest method.
+//	req, resp := client.GetJobDetailsRequest(params)
 //
-//    // Example sending a request using the GetJobDetailsRequest method.
-//    req, resp := client.GetJobDetailsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/GetJobDetails
 func (c *CodePipeline) GetJobDetailsRequest(input *GetJobDetailsInput) (req *request.Request, output *GetJobDetailsOutput) {
@@ -956,10 +1039,10 @@ func (c *CodePipeline) GetJobDetailsRequest(input *GetJobDetailsInput) (req *req
 //
 // Returns information about a job. Used for custom actions only.
 //
-// When this API is called, AWS CodePipeline returns temporary credentials for
-// the Amazon S3 bucket used to store artifacts for the pipeline, if the action
-// requires access to that Amazon S3 bucket for input or output artifacts. This
-// API also returns any secret values defined for the action.
+// When this API is called, CodePipeline returns temporary credentials for the
+// S3 bucket used to store artifacts for the pipeline, if the action requires
+// access to that S3 bucket for input or output artifacts. This API also returns
+// any secret values defined for the action.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -968,12 +1051,13 @@ func (c *CodePipeline) GetJobDetailsRequest(input *GetJobDetailsInput) (req *req
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation GetJobDetails for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodeJobNotFoundException "JobNotFoundException"
-//   The job was specified in an invalid format or cannot be found.
+//   - JobNotFoundException
+//     The job was specified in an invalid format or cannot be found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/GetJobDetails
 func (c *CodePipeline) GetJobDetails(input *GetJobDetailsInput) (*GetJobDetailsOutput, error) {
@@ -1013,14 +1097,13 @@ const opGetPipeline = "GetPipeline"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetPipelineRequest method.
+//	req, resp := client.GetPipelineRequest(params)
 //
-//    // Example sending a request using the GetPipelineRequest method.
-//    req, resp := client.GetPipelineRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/GetPipeline
 func (c *CodePipeline) GetPipelineRequest(input *GetPipelineInput) (req *request.Request, output *GetPipelineOutput) {
@@ -1052,15 +1135,16 @@ func (c *CodePipeline) GetPipelineRequest(input *GetPipelineInput) (req *request
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation GetPipeline for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodePipelineNotFoundException "PipelineNotFoundException"
-//   The pipeline was specified in an invalid format or cannot be found.
+//   - PipelineNotFoundException
+//     The pipeline was specified in an invalid format or cannot be found.
 //
-//   * ErrCodePipelineVersionNotFoundException "PipelineVersionNotFoundException"
-//   The pipeline version was specified in an invalid format or cannot be found.
+//   - PipelineVersionNotFoundException
+//     The pipeline version was specified in an invalid format or cannot be found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/GetPipeline
 func (c *CodePipeline) GetPipeline(input *GetPipelineInput) (*GetPipelineOutput, error) {
@@ -1100,14 +1184,13 @@ const opGetPipelineExecution = "GetPipelineExecution"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetPipelineExecutionRequest method.
+//	req, resp := client.GetPipelineExecutionRequest(params)
 //
-//    // Example sending a request using the GetPipelineExecutionRequest method.
-//    req, resp := client.GetPipelineExecutionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/GetPipelineExecution
 func (c *CodePipeline) GetPipelineExecutionRequest(input *GetPipelineExecutionInput) (req *request.Request, output *GetPipelineExecutionOutput) {
@@ -1139,16 +1222,17 @@ func (c *CodePipeline) GetPipelineExecutionRequest(input *GetPipelineExecutionIn
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation GetPipelineExecution for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodePipelineNotFoundException "PipelineNotFoundException"
-//   The pipeline was specified in an invalid format or cannot be found.
+//   - PipelineNotFoundException
+//     The pipeline was specified in an invalid format or cannot be found.
 //
-//   * ErrCodePipelineExecutionNotFoundException "PipelineExecutionNotFoundException"
-//   The pipeline execution was specified in an invalid format or cannot be found,
-//   or an execution ID does not belong to the specified pipeline.
+//   - PipelineExecutionNotFoundException
+//     The pipeline execution was specified in an invalid format or cannot be found,
+//     or an execution ID does not belong to the specified pipeline.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/GetPipelineExecution
 func (c *CodePipeline) GetPipelineExecution(input *GetPipelineExecutionInput) (*GetPipelineExecutionOutput, error) {
@@ -1188,14 +1272,13 @@ const opGetPipelineState = "GetPipelineState"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetPipelineStateRequest method.
+//	req, resp := client.GetPipelineStateRequest(params)
 //
-//    // Example sending a request using the GetPipelineStateRequest method.
-//    req, resp := client.GetPipelineStateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/GetPipelineState
 func (c *CodePipeline) GetPipelineStateRequest(input *GetPipelineStateInput) (req *request.Request, output *GetPipelineStateOutput) {
@@ -1229,12 +1312,13 @@ func (c *CodePipeline) GetPipelineStateRequest(input *GetPipelineStateInput) (re
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation GetPipelineState for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodePipelineNotFoundException "PipelineNotFoundException"
-//   The pipeline was specified in an invalid format or cannot be found.
+//   - PipelineNotFoundException
+//     The pipeline was specified in an invalid format or cannot be found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/GetPipelineState
 func (c *CodePipeline) GetPipelineState(input *GetPipelineStateInput) (*GetPipelineStateOutput, error) {
@@ -1274,14 +1358,13 @@ const opGetThirdPartyJobDetails = "GetThirdPartyJobDetails"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetThirdPartyJobDetailsRequest method.
+//	req, resp := client.GetThirdPartyJobDetailsRequest(params)
 //
-//    // Example sending a request using the GetThirdPartyJobDetailsRequest method.
-//    req, resp := client.GetThirdPartyJobDetailsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/GetThirdPartyJobDetails
 func (c *CodePipeline) GetThirdPartyJobDetailsRequest(input *GetThirdPartyJobDetailsInput) (req *request.Request, output *GetThirdPartyJobDetailsOutput) {
@@ -1305,10 +1388,10 @@ func (c *CodePipeline) GetThirdPartyJobDetailsRequest(input *GetThirdPartyJobDet
 // Requests the details of a job for a third party action. Used for partner
 // actions only.
 //
-// When this API is called, AWS CodePipeline returns temporary credentials for
-// the Amazon S3 bucket used to store artifacts for the pipeline, if the action
-// requires access to that Amazon S3 bucket for input or output artifacts. This
-// API also returns any secret values defined for the action.
+// When this API is called, CodePipeline returns temporary credentials for the
+// S3 bucket used to store artifacts for the pipeline, if the action requires
+// access to that S3 bucket for input or output artifacts. This API also returns
+// any secret values defined for the action.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1317,18 +1400,19 @@ func (c *CodePipeline) GetThirdPartyJobDetailsRequest(input *GetThirdPartyJobDet
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation GetThirdPartyJobDetails for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeJobNotFoundException "JobNotFoundException"
-//   The job was specified in an invalid format or cannot be found.
+// Returned Error Types:
+//
+//   - JobNotFoundException
+//     The job was specified in an invalid format or cannot be found.
 //
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodeInvalidClientTokenException "InvalidClientTokenException"
-//   The client token was specified in an invalid format
+//   - InvalidClientTokenException
+//     The client token was specified in an invalid format
 //
-//   * ErrCodeInvalidJobException "InvalidJobException"
-//   The job was specified in an invalid format or cannot be found.
+//   - InvalidJobException
+//     The job was specified in an invalid format or cannot be found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/GetThirdPartyJobDetails
 func (c *CodePipeline) GetThirdPartyJobDetails(input *GetThirdPartyJobDetailsInput) (*GetThirdPartyJobDetailsOutput, error) {
@@ -1368,14 +1452,13 @@ const opListActionExecutions = "ListActionExecutions"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListActionExecutionsRequest method.
+//	req, resp := client.ListActionExecutionsRequest(params)
 //
-//    // Example sending a request using the ListActionExecutionsRequest method.
-//    req, resp := client.ListActionExecutionsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/ListActionExecutions
 func (c *CodePipeline) ListActionExecutionsRequest(input *ListActionExecutionsInput) (req *request.Request, output *ListActionExecutionsOutput) {
@@ -1411,20 +1494,21 @@ func (c *CodePipeline) ListActionExecutionsRequest(input *ListActionExecutionsIn
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation ListActionExecutions for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodePipelineNotFoundException "PipelineNotFoundException"
-//   The pipeline was specified in an invalid format or cannot be found.
+//   - PipelineNotFoundException
+//     The pipeline was specified in an invalid format or cannot be found.
 //
-//   * ErrCodeInvalidNextTokenException "InvalidNextTokenException"
-//   The next token was specified in an invalid format. Make sure that the next
-//   token you provide is the token returned by a previous call.
+//   - InvalidNextTokenException
+//     The next token was specified in an invalid format. Make sure that the next
+//     token you provide is the token returned by a previous call.
 //
-//   * ErrCodePipelineExecutionNotFoundException "PipelineExecutionNotFoundException"
-//   The pipeline execution was specified in an invalid format or cannot be found,
-//   or an execution ID does not belong to the specified pipeline.
+//   - PipelineExecutionNotFoundException
+//     The pipeline execution was specified in an invalid format or cannot be found,
+//     or an execution ID does not belong to the specified pipeline.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/ListActionExecutions
 func (c *CodePipeline) ListActionExecutions(input *ListActionExecutionsInput) (*ListActionExecutionsOutput, error) {
@@ -1456,15 +1540,14 @@ func (c *CodePipeline) ListActionExecutionsWithContext(ctx aws.Context, input *L
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListActionExecutions operation.
-//    pageNum := 0
-//    err := client.ListActionExecutionsPages(params,
-//        func(page *codepipeline.ListActionExecutionsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListActionExecutions operation.
+//	pageNum := 0
+//	err := client.ListActionExecutionsPages(params,
+//	    func(page *codepipeline.ListActionExecutionsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *CodePipeline) ListActionExecutionsPages(input *ListActionExecutionsInput, fn func(*ListActionExecutionsOutput, bool) bool) error {
 	return c.ListActionExecutionsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1491,10 +1574,12 @@ func (c *CodePipeline) ListActionExecutionsPagesWithContext(ctx aws.Context, inp
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListActionExecutionsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListActionExecutionsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -1514,14 +1599,13 @@ const opListActionTypes = "ListActionTypes"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListActionTypesRequest method.
+//	req, resp := client.ListActionTypesRequest(params)
 //
-//    // Example sending a request using the ListActionTypesRequest method.
-//    req, resp := client.ListActionTypesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/ListActionTypes
 func (c *CodePipeline) ListActionTypesRequest(input *ListActionTypesInput) (req *request.Request, output *ListActionTypesOutput) {
@@ -1548,8 +1632,7 @@ func (c *CodePipeline) ListActionTypesRequest(input *ListActionTypesInput) (req
 
 // ListActionTypes API operation for AWS CodePipeline.
 //
-// Gets a summary of all AWS CodePipeline action types associated with your
-// account.
+// Gets a summary of all CodePipeline action types associated with your account.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1558,13 +1641,14 @@ func (c *CodePipeline) ListActionTypesRequest(input *ListActionTypesInput) (req
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation ListActionTypes for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidNextTokenException "InvalidNextTokenException"
-//   The next token was specified in an invalid format. Make sure that the next
-//   token you provide is the token returned by a previous call.
+//   - ValidationException
+//     The validation was specified in an invalid format.
+//
+//   - InvalidNextTokenException
+//     The next token was specified in an invalid format. Make sure that the next
+//     token you provide is the token returned by a previous call.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/ListActionTypes
 func (c *CodePipeline) ListActionTypes(input *ListActionTypesInput) (*ListActionTypesOutput, error) {
@@ -1596,15 +1680,14 @@ func (c *CodePipeline) ListActionTypesWithContext(ctx aws.Context, input *ListAc
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListActionTypes operation.
-//    pageNum := 0
-//    err := client.ListActionTypesPages(params,
-//        func(page *codepipeline.ListActionTypesOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListActionTypes operation.
+//	pageNum := 0
+//	err := client.ListActionTypesPages(params,
+//	    func(page *codepipeline.ListActionTypesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *CodePipeline) ListActionTypesPages(input *ListActionTypesInput, fn func(*ListActionTypesOutput, bool) bool) error {
 	return c.ListActionTypesPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1631,10 +1714,12 @@ func (c *CodePipeline) ListActionTypesPagesWithContext(ctx aws.Context, input *L
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListActionTypesOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListActionTypesOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -1654,14 +1739,13 @@ const opListPipelineExecutions = "ListPipelineExecutions"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListPipelineExecutionsRequest method.
+//	req, resp := client.ListPipelineExecutionsRequest(params)
 //
-//    // Example sending a request using the ListPipelineExecutionsRequest method.
-//    req, resp := client.ListPipelineExecutionsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/ListPipelineExecutions
 func (c *CodePipeline) ListPipelineExecutionsRequest(input *ListPipelineExecutionsInput) (req *request.Request, output *ListPipelineExecutionsOutput) {
@@ -1697,16 +1781,17 @@ func (c *CodePipeline) ListPipelineExecutionsRequest(input *ListPipelineExecutio
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation ListPipelineExecutions for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodePipelineNotFoundException "PipelineNotFoundException"
-//   The pipeline was specified in an invalid format or cannot be found.
+//   - PipelineNotFoundException
+//     The pipeline was specified in an invalid format or cannot be found.
 //
-//   * ErrCodeInvalidNextTokenException "InvalidNextTokenException"
-//   The next token was specified in an invalid format. Make sure that the next
-//   token you provide is the token returned by a previous call.
+//   - InvalidNextTokenException
+//     The next token was specified in an invalid format. Make sure that the next
+//     token you provide is the token returned by a previous call.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/ListPipelineExecutions
 func (c *CodePipeline) ListPipelineExecutions(input *ListPipelineExecutionsInput) (*ListPipelineExecutionsOutput, error) {
@@ -1738,15 +1823,14 @@ func (c *CodePipeline) ListPipelineExecutionsWithContext(ctx aws.Context, input
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListPipelineExecutions operation.
-//    pageNum := 0
-//    err := client.ListPipelineExecutionsPages(params,
-//        func(page *codepipeline.ListPipelineExecutionsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListPipelineExecutions operation.
+//	pageNum := 0
+//	err := client.ListPipelineExecutionsPages(params,
+//	    func(page *codepipeline.ListPipelineExecutionsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *CodePipeline) ListPipelineExecutionsPages(input *ListPipelineExecutionsInput, fn func(*ListPipelineExecutionsOutput, bool) bool) error {
 	return c.ListPipelineExecutionsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1773,10 +1857,12 @@ func (c *CodePipeline) ListPipelineExecutionsPagesWithContext(ctx aws.Context, i
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListPipelineExecutionsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListPipelineExecutionsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -1796,14 +1882,13 @@ const opListPipelines = "ListPipelines"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListPipelinesRequest method.
+//	req, resp := client.ListPipelinesRequest(params)
 //
-//    // Example sending a request using the ListPipelinesRequest method.
-//    req, resp := client.ListPipelinesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/ListPipelines
 func (c *CodePipeline) ListPipelinesRequest(input *ListPipelinesInput) (req *request.Request, output *ListPipelinesOutput) {
@@ -1814,7 +1899,7 @@ func (c *CodePipeline) ListPipelinesRequest(input *ListPipelinesInput) (req *req
 		Paginator: &request.Paginator{
 			InputTokens:     []string{"nextToken"},
 			OutputTokens:    []string{"nextToken"},
-			LimitToken:      "",
+			LimitToken:      "maxResults",
 			TruncationToken: "",
 		},
 	}
@@ -1839,13 +1924,14 @@ func (c *CodePipeline) ListPipelinesRequest(input *ListPipelinesInput) (req *req
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation ListPipelines for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidNextTokenException "InvalidNextTokenException"
-//   The next token was specified in an invalid format. Make sure that the next
-//   token you provide is the token returned by a previous call.
+//   - ValidationException
+//     The validation was specified in an invalid format.
+//
+//   - InvalidNextTokenException
+//     The next token was specified in an invalid format. Make sure that the next
+//     token you provide is the token returned by a previous call.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/ListPipelines
 func (c *CodePipeline) ListPipelines(input *ListPipelinesInput) (*ListPipelinesOutput, error) {
@@ -1877,15 +1963,14 @@ func (c *CodePipeline) ListPipelinesWithContext(ctx aws.Context, input *ListPipe
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListPipelines operation.
-//    pageNum := 0
-//    err := client.ListPipelinesPages(params,
-//        func(page *codepipeline.ListPipelinesOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListPipelines operation.
+//	pageNum := 0
+//	err := client.ListPipelinesPages(params,
+//	    func(page *codepipeline.ListPipelinesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *CodePipeline) ListPipelinesPages(input *ListPipelinesInput, fn func(*ListPipelinesOutput, bool) bool) error {
 	return c.ListPipelinesPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1912,10 +1997,12 @@ func (c *CodePipeline) ListPipelinesPagesWithContext(ctx aws.Context, input *Lis
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListPipelinesOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListPipelinesOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -1935,14 +2022,13 @@ const opListTagsForResource = "ListTagsForResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTagsForResourceRequest method.
+//	req, resp := client.ListTagsForResourceRequest(params)
 //
-//    // Example sending a request using the ListTagsForResourceRequest method.
-//    req, resp := client.ListTagsForResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/ListTagsForResource
 func (c *CodePipeline) ListTagsForResourceRequest(input *ListTagsForResourceInput) (req *request.Request, output *ListTagsForResourceOutput) {
@@ -1978,19 +2064,20 @@ func (c *CodePipeline) ListTagsForResourceRequest(input *ListTagsForResourceInpu
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation ListTagsForResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The resource was specified in an invalid format.
+//   - ResourceNotFoundException
+//     The resource was specified in an invalid format.
 //
-//   * ErrCodeInvalidNextTokenException "InvalidNextTokenException"
-//   The next token was specified in an invalid format. Make sure that the next
-//   token you provide is the token returned by a previous call.
+//   - InvalidNextTokenException
+//     The next token was specified in an invalid format. Make sure that the next
+//     token you provide is the token returned by a previous call.
 //
-//   * ErrCodeInvalidArnException "InvalidArnException"
-//   The specified resource ARN is invalid.
+//   - InvalidArnException
+//     The specified resource ARN is invalid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/ListTagsForResource
 func (c *CodePipeline) ListTagsForResource(input *ListTagsForResourceInput) (*ListTagsForResourceOutput, error) {
@@ -2022,15 +2109,14 @@ func (c *CodePipeline) ListTagsForResourceWithContext(ctx aws.Context, input *Li
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListTagsForResource operation.
-//    pageNum := 0
-//    err := client.ListTagsForResourcePages(params,
-//        func(page *codepipeline.ListTagsForResourceOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListTagsForResource operation.
+//	pageNum := 0
+//	err := client.ListTagsForResourcePages(params,
+//	    func(page *codepipeline.ListTagsForResourceOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *CodePipeline) ListTagsForResourcePages(input *ListTagsForResourceInput, fn func(*ListTagsForResourceOutput, bool) bool) error {
 	return c.ListTagsForResourcePagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -2057,10 +2143,12 @@ func (c *CodePipeline) ListTagsForResourcePagesWithContext(ctx aws.Context, inpu
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListTagsForResourceOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListTagsForResourceOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -2080,14 +2168,13 @@ const opListWebhooks = "ListWebhooks"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListWebhooksRequest method.
+//	req, resp := client.ListWebhooksRequest(params)
 //
-//    // Example sending a request using the ListWebhooksRequest method.
-//    req, resp := client.ListWebhooksRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/ListWebhooks
 func (c *CodePipeline) ListWebhooksRequest(input *ListWebhooksInput) (req *request.Request, output *ListWebhooksOutput) {
@@ -2114,9 +2201,9 @@ func (c *CodePipeline) ListWebhooksRequest(input *ListWebhooksInput) (req *reque
 
 // ListWebhooks API operation for AWS CodePipeline.
 //
-// Gets a listing of all the webhooks in this AWS Region for this account. The
-// output lists all webhooks and includes the webhook URL and ARN and the configuration
-// for each webhook.
+// Gets a listing of all the webhooks in this Amazon Web Services Region for
+// this account. The output lists all webhooks and includes the webhook URL
+// and ARN and the configuration for each webhook.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2125,13 +2212,14 @@ func (c *CodePipeline) ListWebhooksRequest(input *ListWebhooksInput) (req *reque
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation ListWebhooks for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidNextTokenException "InvalidNextTokenException"
-//   The next token was specified in an invalid format. Make sure that the next
-//   token you provide is the token returned by a previous call.
+//   - ValidationException
+//     The validation was specified in an invalid format.
+//
+//   - InvalidNextTokenException
+//     The next token was specified in an invalid format. Make sure that the next
+//     token you provide is the token returned by a previous call.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/ListWebhooks
 func (c *CodePipeline) ListWebhooks(input *ListWebhooksInput) (*ListWebhooksOutput, error) {
@@ -2163,15 +2251,14 @@ func (c *CodePipeline) ListWebhooksWithContext(ctx aws.Context, input *ListWebho
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListWebhooks operation.
-//    pageNum := 0
-//    err := client.ListWebhooksPages(params,
-//        func(page *codepipeline.ListWebhooksOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListWebhooks operation.
+//	pageNum := 0
+//	err := client.ListWebhooksPages(params,
+//	    func(page *codepipeline.ListWebhooksOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *CodePipeline) ListWebhooksPages(input *ListWebhooksInput, fn func(*ListWebhooksOutput, bool) bool) error {
 	return c.ListWebhooksPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -2198,10 +2285,12 @@ func (c *CodePipeline) ListWebhooksPagesWithContext(ctx aws.Context, input *List
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListWebhooksOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListWebhooksOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -2221,14 +2310,13 @@ const opPollForJobs = "PollForJobs"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PollForJobsRequest method.
+//	req, resp := client.PollForJobsRequest(params)
 //
-//    // Example sending a request using the PollForJobsRequest method.
-//    req, resp := client.PollForJobsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/PollForJobs
 func (c *CodePipeline) PollForJobsRequest(input *PollForJobsInput) (req *request.Request, output *PollForJobsOutput) {
@@ -2249,15 +2337,15 @@ func (c *CodePipeline) PollForJobsRequest(input *PollForJobsInput) (req *request
 
 // PollForJobs API operation for AWS CodePipeline.
 //
-// Returns information about any jobs for AWS CodePipeline to act on. PollForJobs
+// Returns information about any jobs for CodePipeline to act on. PollForJobs
 // is valid only for action types with "Custom" in the owner field. If the action
-// type contains "AWS" or "ThirdParty" in the owner field, the PollForJobs action
+// type contains AWS or ThirdParty in the owner field, the PollForJobs action
 // returns an error.
 //
-// When this API is called, AWS CodePipeline returns temporary credentials for
-// the Amazon S3 bucket used to store artifacts for the pipeline, if the action
-// requires access to that Amazon S3 bucket for input or output artifacts. This
-// API also returns any secret values defined for the action.
+// When this API is called, CodePipeline returns temporary credentials for the
+// S3 bucket used to store artifacts for the pipeline, if the action requires
+// access to that S3 bucket for input or output artifacts. This API also returns
+// any secret values defined for the action.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2266,12 +2354,13 @@ func (c *CodePipeline) PollForJobsRequest(input *PollForJobsInput) (req *request
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation PollForJobs for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodeActionTypeNotFoundException "ActionTypeNotFoundException"
-//   The specified action type cannot be found.
+//   - ActionTypeNotFoundException
+//     The specified action type cannot be found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/PollForJobs
 func (c *CodePipeline) PollForJobs(input *PollForJobsInput) (*PollForJobsOutput, error) {
@@ -2311,14 +2400,13 @@ const opPollForThirdPartyJobs = "PollForThirdPartyJobs"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PollForThirdPartyJobsRequest method.
+//	req, resp := client.PollForThirdPartyJobsRequest(params)
 //
-//    // Example sending a request using the PollForThirdPartyJobsRequest method.
-//    req, resp := client.PollForThirdPartyJobsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/PollForThirdPartyJobs
 func (c *CodePipeline) PollForThirdPartyJobsRequest(input *PollForThirdPartyJobsInput) (req *request.Request, output *PollForThirdPartyJobsOutput) {
@@ -2342,9 +2430,9 @@ func (c *CodePipeline) PollForThirdPartyJobsRequest(input *PollForThirdPartyJobs
 // Determines whether there are any third party jobs for a job worker to act
 // on. Used for partner actions only.
 //
-// When this API is called, AWS CodePipeline returns temporary credentials for
-// the Amazon S3 bucket used to store artifacts for the pipeline, if the action
-// requires access to that Amazon S3 bucket for input or output artifacts.
+// When this API is called, CodePipeline returns temporary credentials for the
+// S3 bucket used to store artifacts for the pipeline, if the action requires
+// access to that S3 bucket for input or output artifacts.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2353,12 +2441,13 @@ func (c *CodePipeline) PollForThirdPartyJobsRequest(input *PollForThirdPartyJobs
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation PollForThirdPartyJobs for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeActionTypeNotFoundException "ActionTypeNotFoundException"
-//   The specified action type cannot be found.
+// Returned Error Types:
+//
+//   - ActionTypeNotFoundException
+//     The specified action type cannot be found.
 //
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/PollForThirdPartyJobs
 func (c *CodePipeline) PollForThirdPartyJobs(input *PollForThirdPartyJobsInput) (*PollForThirdPartyJobsOutput, error) {
@@ -2398,14 +2487,13 @@ const opPutActionRevision = "PutActionRevision"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PutActionRevisionRequest method.
+//	req, resp := client.PutActionRevisionRequest(params)
 //
-//    // Example sending a request using the PutActionRevisionRequest method.
-//    req, resp := client.PutActionRevisionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/PutActionRevision
 func (c *CodePipeline) PutActionRevisionRequest(input *PutActionRevisionInput) (req *request.Request, output *PutActionRevisionOutput) {
@@ -2426,7 +2514,7 @@ func (c *CodePipeline) PutActionRevisionRequest(input *PutActionRevisionInput) (
 
 // PutActionRevision API operation for AWS CodePipeline.
 //
-// Provides information to AWS CodePipeline about new revisions to a source.
+// Provides information to CodePipeline about new revisions to a source.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2435,18 +2523,19 @@ func (c *CodePipeline) PutActionRevisionRequest(input *PutActionRevisionInput) (
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation PutActionRevision for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodePipelineNotFoundException "PipelineNotFoundException"
-//   The pipeline was specified in an invalid format or cannot be found.
+// Returned Error Types:
+//
+//   - PipelineNotFoundException
+//     The pipeline was specified in an invalid format or cannot be found.
 //
-//   * ErrCodeStageNotFoundException "StageNotFoundException"
-//   The stage was specified in an invalid format or cannot be found.
+//   - StageNotFoundException
+//     The stage was specified in an invalid format or cannot be found.
 //
-//   * ErrCodeActionNotFoundException "ActionNotFoundException"
-//   The specified action cannot be found.
+//   - ActionNotFoundException
+//     The specified action cannot be found.
 //
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/PutActionRevision
 func (c *CodePipeline) PutActionRevision(input *PutActionRevisionInput) (*PutActionRevisionOutput, error) {
@@ -2486,14 +2575,13 @@ const opPutApprovalResult = "PutApprovalResult"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PutApprovalResultRequest method.
+//	req, resp := client.PutApprovalResultRequest(params)
 //
-//    // Example sending a request using the PutApprovalResultRequest method.
-//    req, resp := client.PutApprovalResultRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/PutApprovalResult
 func (c *CodePipeline) PutApprovalResultRequest(input *PutApprovalResultInput) (req *request.Request, output *PutApprovalResultOutput) {
@@ -2514,7 +2602,7 @@ func (c *CodePipeline) PutApprovalResultRequest(input *PutApprovalResultInput) (
 
 // PutApprovalResult API operation for AWS CodePipeline.
 //
-// Provides the response to a manual approval request to AWS CodePipeline. Valid
+// Provides the response to a manual approval request to CodePipeline. Valid
 // responses include Approved and Rejected.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
@@ -2524,24 +2612,25 @@ func (c *CodePipeline) PutApprovalResultRequest(input *PutApprovalResultInput) (
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation PutApprovalResult for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidApprovalTokenException "InvalidApprovalTokenException"
-//   The approval request already received a response or has expired.
+// Returned Error Types:
+//
+//   - InvalidApprovalTokenException
+//     The approval request already received a response or has expired.
 //
-//   * ErrCodeApprovalAlreadyCompletedException "ApprovalAlreadyCompletedException"
-//   The approval action has already been approved or rejected.
+//   - ApprovalAlreadyCompletedException
+//     The approval action has already been approved or rejected.
 //
-//   * ErrCodePipelineNotFoundException "PipelineNotFoundException"
-//   The pipeline was specified in an invalid format or cannot be found.
+//   - PipelineNotFoundException
+//     The pipeline was specified in an invalid format or cannot be found.
 //
-//   * ErrCodeStageNotFoundException "StageNotFoundException"
-//   The stage was specified in an invalid format or cannot be found.
+//   - StageNotFoundException
+//     The stage was specified in an invalid format or cannot be found.
 //
-//   * ErrCodeActionNotFoundException "ActionNotFoundException"
-//   The specified action cannot be found.
+//   - ActionNotFoundException
+//     The specified action cannot be found.
 //
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/PutApprovalResult
 func (c *CodePipeline) PutApprovalResult(input *PutApprovalResultInput) (*PutApprovalResultOutput, error) {
@@ -2581,14 +2670,13 @@ const opPutJobFailureResult = "PutJobFailureResult"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PutJobFailureResultRequest method.
+//	req, resp := client.PutJobFailureResultRequest(params)
 //
-//    // Example sending a request using the PutJobFailureResultRequest method.
-//    req, resp := client.PutJobFailureResultRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/PutJobFailureResult
 func (c *CodePipeline) PutJobFailureResultRequest(input *PutJobFailureResultInput) (req *request.Request, output *PutJobFailureResultOutput) {
@@ -2620,15 +2708,16 @@ func (c *CodePipeline) PutJobFailureResultRequest(input *PutJobFailureResultInpu
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation PutJobFailureResult for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodeJobNotFoundException "JobNotFoundException"
-//   The job was specified in an invalid format or cannot be found.
+//   - JobNotFoundException
+//     The job was specified in an invalid format or cannot be found.
 //
-//   * ErrCodeInvalidJobStateException "InvalidJobStateException"
-//   The job state was specified in an invalid format.
+//   - InvalidJobStateException
+//     The job state was specified in an invalid format.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/PutJobFailureResult
 func (c *CodePipeline) PutJobFailureResult(input *PutJobFailureResultInput) (*PutJobFailureResultOutput, error) {
@@ -2668,14 +2757,13 @@ const opPutJobSuccessResult = "PutJobSuccessResult"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PutJobSuccessResultRequest method.
+//	req, resp := client.PutJobSuccessResultRequest(params)
 //
-//    // Example sending a request using the PutJobSuccessResultRequest method.
-//    req, resp := client.PutJobSuccessResultRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/PutJobSuccessResult
 func (c *CodePipeline) PutJobSuccessResultRequest(input *PutJobSuccessResultInput) (req *request.Request, output *PutJobSuccessResultOutput) {
@@ -2707,15 +2795,19 @@ func (c *CodePipeline) PutJobSuccessResultRequest(input *PutJobSuccessResultInpu
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation PutJobSuccessResult for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodeJobNotFoundException "JobNotFoundException"
-//   The job was specified in an invalid format or cannot be found.
+//   - JobNotFoundException
+//     The job was specified in an invalid format or cannot be found.
 //
-//   * ErrCodeInvalidJobStateException "InvalidJobStateException"
-//   The job state was specified in an invalid format.
+//   - InvalidJobStateException
+//     The job state was specified in an invalid format.
+//
+//   - OutputVariablesSizeExceededException
+//     Exceeded the total size limit for all variables in the pipeline.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/PutJobSuccessResult
 func (c *CodePipeline) PutJobSuccessResult(input *PutJobSuccessResultInput) (*PutJobSuccessResultOutput, error) {
@@ -2755,14 +2847,13 @@ const opPutThirdPartyJobFailureResult = "PutThirdPartyJobFailureResult"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PutThirdPartyJobFailureResultRequest method.
+//	req, resp := client.PutThirdPartyJobFailureResultRequest(params)
 //
-//    // Example sending a request using the PutThirdPartyJobFailureResultRequest method.
-//    req, resp := client.PutThirdPartyJobFailureResultRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/PutThirdPartyJobFailureResult
 func (c *CodePipeline) PutThirdPartyJobFailureResultRequest(input *PutThirdPartyJobFailureResultInput) (req *request.Request, output *PutThirdPartyJobFailureResultOutput) {
@@ -2794,18 +2885,19 @@ func (c *CodePipeline) PutThirdPartyJobFailureResultRequest(input *PutThirdParty
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation PutThirdPartyJobFailureResult for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
 //
-//   * ErrCodeJobNotFoundException "JobNotFoundException"
-//   The job was specified in an invalid format or cannot be found.
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodeInvalidJobStateException "InvalidJobStateException"
-//   The job state was specified in an invalid format.
+//   - JobNotFoundException
+//     The job was specified in an invalid format or cannot be found.
 //
-//   * ErrCodeInvalidClientTokenException "InvalidClientTokenException"
-//   The client token was specified in an invalid format
+//   - InvalidJobStateException
+//     The job state was specified in an invalid format.
+//
+//   - InvalidClientTokenException
+//     The client token was specified in an invalid format
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/PutThirdPartyJobFailureResult
 func (c *CodePipeline) PutThirdPartyJobFailureResult(input *PutThirdPartyJobFailureResultInput) (*PutThirdPartyJobFailureResultOutput, error) {
@@ -2845,14 +2937,13 @@ const opPutThirdPartyJobSuccessResult = "PutThirdPartyJobSuccessResult"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PutThirdPartyJobSuccessResultRequest method.
+//	req, resp := client.PutThirdPartyJobSuccessResultRequest(params)
 //
-//    // Example sending a request using the PutThirdPartyJobSuccessResultRequest method.
-//    req, resp := client.PutThirdPartyJobSuccessResultRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/PutThirdPartyJobSuccessResult
 func (c *CodePipeline) PutThirdPartyJobSuccessResultRequest(input *PutThirdPartyJobSuccessResultInput) (req *request.Request, output *PutThirdPartyJobSuccessResultOutput) {
@@ -2884,18 +2975,19 @@ func (c *CodePipeline) PutThirdPartyJobSuccessResultRequest(input *PutThirdParty
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation PutThirdPartyJobSuccessResult for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
 //
-//   * ErrCodeJobNotFoundException "JobNotFoundException"
-//   The job was specified in an invalid format or cannot be found.
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodeInvalidJobStateException "InvalidJobStateException"
-//   The job state was specified in an invalid format.
+//   - JobNotFoundException
+//     The job was specified in an invalid format or cannot be found.
 //
-//   * ErrCodeInvalidClientTokenException "InvalidClientTokenException"
-//   The client token was specified in an invalid format
+//   - InvalidJobStateException
+//     The job state was specified in an invalid format.
+//
+//   - InvalidClientTokenException
+//     The client token was specified in an invalid format
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/PutThirdPartyJobSuccessResult
 func (c *CodePipeline) PutThirdPartyJobSuccessResult(input *PutThirdPartyJobSuccessResultInput) (*PutThirdPartyJobSuccessResultOutput, error) {
@@ -2935,14 +3027,13 @@ const opPutWebhook = "PutWebhook"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PutWebhookRequest method.
+//	req, resp := client.PutWebhookRequest(params)
 //
-//    // Example sending a request using the PutWebhookRequest method.
-//    req, resp := client.PutWebhookRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/PutWebhook
 func (c *CodePipeline) PutWebhookRequest(input *PutWebhookInput) (req *request.Request, output *PutWebhookOutput) {
@@ -2979,31 +3070,32 @@ func (c *CodePipeline) PutWebhookRequest(input *PutWebhookInput) (req *request.R
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation PutWebhook for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The number of pipelines associated with the AWS account has exceeded the
-//   limit allowed for the account.
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodeInvalidWebhookFilterPatternException "InvalidWebhookFilterPatternException"
-//   The specified event filter rule is in an invalid format.
+//   - LimitExceededException
+//     The number of pipelines associated with the Amazon Web Services account has
+//     exceeded the limit allowed for the account.
 //
-//   * ErrCodeInvalidWebhookAuthenticationParametersException "InvalidWebhookAuthenticationParametersException"
-//   The specified authentication type is in an invalid format.
+//   - InvalidWebhookFilterPatternException
+//     The specified event filter rule is in an invalid format.
 //
-//   * ErrCodePipelineNotFoundException "PipelineNotFoundException"
-//   The pipeline was specified in an invalid format or cannot be found.
+//   - InvalidWebhookAuthenticationParametersException
+//     The specified authentication type is in an invalid format.
 //
-//   * ErrCodeTooManyTagsException "TooManyTagsException"
-//   The tags limit for a resource has been exceeded.
+//   - PipelineNotFoundException
+//     The pipeline was specified in an invalid format or cannot be found.
 //
-//   * ErrCodeInvalidTagsException "InvalidTagsException"
-//   The specified resource tags are invalid.
+//   - TooManyTagsException
+//     The tags limit for a resource has been exceeded.
 //
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   Unable to modify the tag due to a simultaneous update request.
+//   - InvalidTagsException
+//     The specified resource tags are invalid.
+//
+//   - ConcurrentModificationException
+//     Unable to modify the tag due to a simultaneous update request.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/PutWebhook
 func (c *CodePipeline) PutWebhook(input *PutWebhookInput) (*PutWebhookOutput, error) {
@@ -3043,14 +3135,13 @@ const opRegisterWebhookWithThirdParty = "RegisterWebhookWithThirdParty"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the RegisterWebhookWithThirdPartyRequest method.
+//	req, resp := client.RegisterWebhookWithThirdPartyRequest(params)
 //
-//    // Example sending a request using the RegisterWebhookWithThirdPartyRequest method.
-//    req, resp := client.RegisterWebhookWithThirdPartyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/RegisterWebhookWithThirdParty
 func (c *CodePipeline) RegisterWebhookWithThirdPartyRequest(input *RegisterWebhookWithThirdPartyInput) (req *request.Request, output *RegisterWebhookWithThirdPartyOutput) {
@@ -3082,12 +3173,13 @@ func (c *CodePipeline) RegisterWebhookWithThirdPartyRequest(input *RegisterWebho
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation RegisterWebhookWithThirdParty for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
 //
-//   * ErrCodeWebhookNotFoundException "WebhookNotFoundException"
-//   The specified webhook was entered in an invalid format or cannot be found.
+//   - ValidationException
+//     The validation was specified in an invalid format.
+//
+//   - WebhookNotFoundException
+//     The specified webhook was entered in an invalid format or cannot be found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/RegisterWebhookWithThirdParty
 func (c *CodePipeline) RegisterWebhookWithThirdParty(input *RegisterWebhookWithThirdPartyInput) (*RegisterWebhookWithThirdPartyOutput, error) {
@@ -3127,14 +3219,13 @@ const opRetryStageExecution = "RetryStageExecution"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the RetryStageExecutionRequest method.
+//	req, resp := client.RetryStageExecutionRequest(params)
 //
-//    // Example sending a request using the RetryStageExecutionRequest method.
-//    req, resp := client.RetryStageExecutionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/RetryStageExecution
 func (c *CodePipeline) RetryStageExecutionRequest(input *RetryStageExecutionInput) (req *request.Request, output *RetryStageExecutionOutput) {
@@ -3155,10 +3246,14 @@ func (c *CodePipeline) RetryStageExecutionRequest(input *RetryStageExecutionInpu
 
 // RetryStageExecution API operation for AWS CodePipeline.
 //
-// Resumes the pipeline execution by retrying the last failed actions in a stage.
-// You can retry a stage immediately if any of the actions in the stage fail.
-// When you retry, all actions that are still in progress continue working,
-// and failed actions are triggered again.
+// You can retry a stage that has failed without having to run a pipeline again
+// from the beginning. You do this by either retrying the failed actions in
+// a stage or by retrying all actions in the stage starting from the first action
+// in the stage. When you retry the failed actions in a stage, all actions that
+// are still in progress continue working, and failed actions are triggered
+// again. When you retry a failed stage from the first action in the stage,
+// the stage cannot have any actions in progress. Before a stage can be retried,
+// it must either have all actions failed or some actions failed and some succeeded.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -3167,23 +3262,28 @@ func (c *CodePipeline) RetryStageExecutionRequest(input *RetryStageExecutionInpu
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation RetryStageExecution for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
 //
-//   * ErrCodePipelineNotFoundException "PipelineNotFoundException"
-//   The pipeline was specified in an invalid format or cannot be found.
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodeStageNotFoundException "StageNotFoundException"
-//   The stage was specified in an invalid format or cannot be found.
+//   - ConflictException
+//     Your request cannot be handled because the pipeline is busy handling ongoing
+//     activities. Try again later.
 //
-//   * ErrCodeStageNotRetryableException "StageNotRetryableException"
-//   Unable to retry. The pipeline structure or stage state might have changed
-//   while actions awaited retry, or the stage contains no failed actions.
+//   - PipelineNotFoundException
+//     The pipeline was specified in an invalid format or cannot be found.
 //
-//   * ErrCodeNotLatestPipelineExecutionException "NotLatestPipelineExecutionException"
-//   The stage has failed in a later run of the pipeline and the pipelineExecutionId
-//   associated with the request is out of date.
+//   - StageNotFoundException
+//     The stage was specified in an invalid format or cannot be found.
+//
+//   - StageNotRetryableException
+//     Unable to retry. The pipeline structure or stage state might have changed
+//     while actions awaited retry, or the stage contains no failed actions.
+//
+//   - NotLatestPipelineExecutionException
+//     The stage has failed in a later run of the pipeline and the pipelineExecutionId
+//     associated with the request is out of date.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/RetryStageExecution
 func (c *CodePipeline) RetryStageExecution(input *RetryStageExecutionInput) (*RetryStageExecutionOutput, error) {
@@ -3223,14 +3323,13 @@ const opStartPipelineExecution = "StartPipelineExecution"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the StartPipelineExecutionRequest method.
+//	req, resp := client.StartPipelineExecutionRequest(params)
 //
-//    // Example sending a request using the StartPipelineExecutionRequest method.
-//    req, resp := client.StartPipelineExecutionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/StartPipelineExecution
 func (c *CodePipeline) StartPipelineExecutionRequest(input *StartPipelineExecutionInput) (req *request.Request, output *StartPipelineExecutionOutput) {
@@ -3261,12 +3360,17 @@ func (c *CodePipeline) StartPipelineExecutionRequest(input *StartPipelineExecuti
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation StartPipelineExecution for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodePipelineNotFoundException "PipelineNotFoundException"
-//   The pipeline was specified in an invalid format or cannot be found.
+//   - ConflictException
+//     Your request cannot be handled because the pipeline is busy handling ongoing
+//     activities. Try again later.
+//
+//   - PipelineNotFoundException
+//     The pipeline was specified in an invalid format or cannot be found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/StartPipelineExecution
 func (c *CodePipeline) StartPipelineExecution(input *StartPipelineExecutionInput) (*StartPipelineExecutionOutput, error) {
@@ -3290,6 +3394,108 @@ func (c *CodePipeline) StartPipelineExecutionWithContext(ctx aws.Context, input
 	return out, req.Send()
 }
 
+const opStopPipelineExecution = "StopPipelineExecution"
+
+// StopPipelineExecutionRequest generates a "aws/request.Request" representing the
+// client's request for the StopPipelineExecution operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See StopPipelineExecution for more information on using the StopPipelineExecution
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the StopPipelineExecutionRequest method.
+//	req, resp := client.StopPipelineExecutionRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/StopPipelineExecution
+func (c *CodePipeline) StopPipelineExecutionRequest(input *StopPipelineExecutionInput) (req *request.Request, output *StopPipelineExecutionOutput) {
+	op := &request.Operation{
+		Name:       opStopPipelineExecution,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &StopPipelineExecutionInput{}
+	}
+
+	output = &StopPipelineExecutionOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// StopPipelineExecution API operation for AWS CodePipeline.
+//
+// Stops the specified pipeline execution. You choose to either stop the pipeline
+// execution by completing in-progress actions without starting subsequent actions,
+// or by abandoning in-progress actions. While completing or abandoning in-progress
+// actions, the pipeline execution is in a Stopping state. After all in-progress
+// actions are completed or abandoned, the pipeline execution is in a Stopped
+// state.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodePipeline's
+// API operation StopPipelineExecution for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
+//
+//   - ConflictException
+//     Your request cannot be handled because the pipeline is busy handling ongoing
+//     activities. Try again later.
+//
+//   - PipelineNotFoundException
+//     The pipeline was specified in an invalid format or cannot be found.
+//
+//   - PipelineExecutionNotStoppableException
+//     Unable to stop the pipeline execution. The execution might already be in
+//     a Stopped state, or it might no longer be in progress.
+//
+//   - DuplicatedStopRequestException
+//     The pipeline execution is already in a Stopping state. If you already chose
+//     to stop and wait, you cannot make that request again. You can choose to stop
+//     and abandon now, but be aware that this option can lead to failed tasks or
+//     out of sequence tasks. If you already chose to stop and abandon, you cannot
+//     make that request again.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/StopPipelineExecution
+func (c *CodePipeline) StopPipelineExecution(input *StopPipelineExecutionInput) (*StopPipelineExecutionOutput, error) {
+	req, out := c.StopPipelineExecutionRequest(input)
+	return out, req.Send()
+}
+
+// StopPipelineExecutionWithContext is the same as StopPipelineExecution with the addition of
+// the ability to pass a context and additional request options.
+//
+// See StopPipelineExecution for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodePipeline) StopPipelineExecutionWithContext(ctx aws.Context, input *StopPipelineExecutionInput, opts ...request.Option) (*StopPipelineExecutionOutput, error) {
+	req, out := c.StopPipelineExecutionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opTagResource = "TagResource"
 
 // TagResourceRequest generates a "aws/request.Request" representing the
@@ -3306,14 +3512,13 @@ const opTagResource = "TagResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the TagResourceRequest method.
+//	req, resp := client.TagResourceRequest(params)
 //
-//    // Example sending a request using the TagResourceRequest method.
-//    req, resp := client.TagResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/TagResource
 func (c *CodePipeline) TagResourceRequest(input *TagResourceInput) (req *request.Request, output *TagResourceOutput) {
@@ -3345,24 +3550,25 @@ func (c *CodePipeline) TagResourceRequest(input *TagResourceInput) (req *request
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation TagResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The resource was specified in an invalid format.
+//   - ResourceNotFoundException
+//     The resource was specified in an invalid format.
 //
-//   * ErrCodeInvalidArnException "InvalidArnException"
-//   The specified resource ARN is invalid.
+//   - InvalidArnException
+//     The specified resource ARN is invalid.
 //
-//   * ErrCodeTooManyTagsException "TooManyTagsException"
-//   The tags limit for a resource has been exceeded.
+//   - TooManyTagsException
+//     The tags limit for a resource has been exceeded.
 //
-//   * ErrCodeInvalidTagsException "InvalidTagsException"
-//   The specified resource tags are invalid.
+//   - InvalidTagsException
+//     The specified resource tags are invalid.
 //
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   Unable to modify the tag due to a simultaneous update request.
+//   - ConcurrentModificationException
+//     Unable to modify the tag due to a simultaneous update request.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/TagResource
 func (c *CodePipeline) TagResource(input *TagResourceInput) (*TagResourceOutput, error) {
@@ -3402,14 +3608,13 @@ const opUntagResource = "UntagResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UntagResourceRequest method.
+//	req, resp := client.UntagResourceRequest(params)
 //
-//    // Example sending a request using the UntagResourceRequest method.
-//    req, resp := client.UntagResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/UntagResource
 func (c *CodePipeline) UntagResourceRequest(input *UntagResourceInput) (req *request.Request, output *UntagResourceOutput) {
@@ -3431,7 +3636,7 @@ func (c *CodePipeline) UntagResourceRequest(input *UntagResourceInput) (req *req
 
 // UntagResource API operation for AWS CodePipeline.
 //
-// Removes tags from an AWS resource.
+// Removes tags from an Amazon Web Services resource.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -3440,21 +3645,22 @@ func (c *CodePipeline) UntagResourceRequest(input *UntagResourceInput) (req *req
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation UntagResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The resource was specified in an invalid format.
+//   - ResourceNotFoundException
+//     The resource was specified in an invalid format.
 //
-//   * ErrCodeInvalidArnException "InvalidArnException"
-//   The specified resource ARN is invalid.
+//   - InvalidArnException
+//     The specified resource ARN is invalid.
 //
-//   * ErrCodeInvalidTagsException "InvalidTagsException"
-//   The specified resource tags are invalid.
+//   - InvalidTagsException
+//     The specified resource tags are invalid.
 //
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   Unable to modify the tag due to a simultaneous update request.
+//   - ConcurrentModificationException
+//     Unable to modify the tag due to a simultaneous update request.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/UntagResource
 func (c *CodePipeline) UntagResource(input *UntagResourceInput) (*UntagResourceOutput, error) {
@@ -3478,6 +3684,95 @@ func (c *CodePipeline) UntagResourceWithContext(ctx aws.Context, input *UntagRes
 	return out, req.Send()
 }
 
+const opUpdateActionType = "UpdateActionType"
+
+// UpdateActionTypeRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateActionType operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateActionType for more information on using the UpdateActionType
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateActionTypeRequest method.
+//	req, resp := client.UpdateActionTypeRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/UpdateActionType
+func (c *CodePipeline) UpdateActionTypeRequest(input *UpdateActionTypeInput) (req *request.Request, output *UpdateActionTypeOutput) {
+	op := &request.Operation{
+		Name:       opUpdateActionType,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UpdateActionTypeInput{}
+	}
+
+	output = &UpdateActionTypeOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// UpdateActionType API operation for AWS CodePipeline.
+//
+// Updates an action type that was created with any supported integration model,
+// where the action type is to be used by customers of the action type provider.
+// Use a JSON file with the action definition and UpdateActionType to provide
+// the full structure.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS CodePipeline's
+// API operation UpdateActionType for usage and error information.
+//
+// Returned Error Types:
+//
+//   - RequestFailedException
+//     The request failed because of an unknown error, exception, or failure.
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
+//
+//   - ActionTypeNotFoundException
+//     The specified action type cannot be found.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/UpdateActionType
+func (c *CodePipeline) UpdateActionType(input *UpdateActionTypeInput) (*UpdateActionTypeOutput, error) {
+	req, out := c.UpdateActionTypeRequest(input)
+	return out, req.Send()
+}
+
+// UpdateActionTypeWithContext is the same as UpdateActionType with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateActionType for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *CodePipeline) UpdateActionTypeWithContext(ctx aws.Context, input *UpdateActionTypeInput, opts ...request.Option) (*UpdateActionTypeOutput, error) {
+	req, out := c.UpdateActionTypeRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opUpdatePipeline = "UpdatePipeline"
 
 // UpdatePipelineRequest generates a "aws/request.Request" representing the
@@ -3494,14 +3789,13 @@ const opUpdatePipeline = "UpdatePipeline"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdatePipelineRequest method.
+//	req, resp := client.UpdatePipelineRequest(params)
 //
-//    // Example sending a request using the UpdatePipelineRequest method.
-//    req, resp := client.UpdatePipelineRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/UpdatePipeline
 func (c *CodePipeline) UpdatePipelineRequest(input *UpdatePipelineInput) (req *request.Request, output *UpdatePipelineOutput) {
@@ -3534,25 +3828,26 @@ func (c *CodePipeline) UpdatePipelineRequest(input *UpdatePipelineInput) (req *r
 // See the AWS API reference guide for AWS CodePipeline's
 // API operation UpdatePipeline for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeValidationException "ValidationException"
-//   The validation was specified in an invalid format.
+// Returned Error Types:
+//
+//   - ValidationException
+//     The validation was specified in an invalid format.
 //
-//   * ErrCodeInvalidStageDeclarationException "InvalidStageDeclarationException"
-//   The stage declaration was specified in an invalid format.
+//   - InvalidStageDeclarationException
+//     The stage declaration was specified in an invalid format.
 //
-//   * ErrCodeInvalidActionDeclarationException "InvalidActionDeclarationException"
-//   The action declaration was specified in an invalid format.
+//   - InvalidActionDeclarationException
+//     The action declaration was specified in an invalid format.
 //
-//   * ErrCodeInvalidBlockerDeclarationException "InvalidBlockerDeclarationException"
-//   Reserved for future use.
+//   - InvalidBlockerDeclarationException
+//     Reserved for future use.
 //
-//   * ErrCodeInvalidStructureException "InvalidStructureException"
-//   The structure was specified in an invalid format.
+//   - InvalidStructureException
+//     The structure was specified in an invalid format.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The number of pipelines associated with the AWS account has exceeded the
-//   limit allowed for the account.
+//   - LimitExceededException
+//     The number of pipelines associated with the Amazon Web Services account has
+//     exceeded the limit allowed for the account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/UpdatePipeline
 func (c *CodePipeline) UpdatePipeline(input *UpdatePipelineInput) (*UpdatePipelineOutput, error) {
@@ -3576,35 +3871,55 @@ func (c *CodePipeline) UpdatePipelineWithContext(ctx aws.Context, input *UpdateP
 	return out, req.Send()
 }
 
-// Represents an AWS session credentials object. These credentials are temporary
-// credentials that are issued by AWS Secure Token Service (STS). They can be
-// used to access input and output artifacts in the Amazon S3 bucket used to
-// store artifact for the pipeline in AWS CodePipeline.
+// Represents an Amazon Web Services session credentials object. These credentials
+// are temporary credentials that are issued by Amazon Web Services Secure Token
+// Service (STS). They can be used to access input and output artifacts in the
+// S3 bucket used to store artifact for the pipeline in CodePipeline.
 type AWSSessionCredentials struct {
 	_ struct{} `type:"structure" sensitive:"true"`
 
 	// The access key for the session.
 	//
+	// AccessKeyId is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by AWSSessionCredentials's
+	// String and GoString methods.
+	//
 	// AccessKeyId is a required field
-	AccessKeyId *string `locationName:"accessKeyId" type:"string" required:"true"`
+	AccessKeyId *string `locationName:"accessKeyId" type:"string" required:"true" sensitive:"true"`
 
 	// The secret access key for the session.
 	//
+	// SecretAccessKey is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by AWSSessionCredentials's
+	// String and GoString methods.
+	//
 	// SecretAccessKey is a required field
-	SecretAccessKey *string `locationName:"secretAccessKey" type:"string" required:"true"`
+	SecretAccessKey *string `locationName:"secretAccessKey" type:"string" required:"true" sensitive:"true"`
 
 	// The token for the session.
 	//
+	// SessionToken is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by AWSSessionCredentials's
+	// String and GoString methods.
+	//
 	// SessionToken is a required field
-	SessionToken *string `locationName:"sessionToken" type:"string" required:"true"`
+	SessionToken *string `locationName:"sessionToken" type:"string" required:"true" sensitive:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AWSSessionCredentials) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AWSSessionCredentials) GoString() string {
 	return s.String()
 }
@@ -3636,20 +3951,28 @@ type AcknowledgeJobInput struct {
 	// JobId is a required field
 	JobId *string `locationName:"jobId" type:"string" required:"true"`
 
-	// A system-generated random number that AWS CodePipeline uses to ensure that
-	// the job is being worked on by only one job worker. Get this number from the
-	// response of the PollForJobs request that returned this job.
+	// A system-generated random number that CodePipeline uses to ensure that the
+	// job is being worked on by only one job worker. Get this number from the response
+	// of the PollForJobs request that returned this job.
 	//
 	// Nonce is a required field
 	Nonce *string `locationName:"nonce" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AcknowledgeJobInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AcknowledgeJobInput) GoString() string {
 	return s.String()
 }
@@ -3693,12 +4016,20 @@ type AcknowledgeJobOutput struct {
 	Status *string `locationName:"status" type:"string" enum:"JobStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AcknowledgeJobOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AcknowledgeJobOutput) GoString() string {
 	return s.String()
 }
@@ -3724,20 +4055,28 @@ type AcknowledgeThirdPartyJobInput struct {
 	// JobId is a required field
 	JobId *string `locationName:"jobId" min:"1" type:"string" required:"true"`
 
-	// A system-generated random number that AWS CodePipeline uses to ensure that
-	// the job is being worked on by only one job worker. Get this number from the
-	// response to a GetThirdPartyJobDetails request.
+	// A system-generated random number that CodePipeline uses to ensure that the
+	// job is being worked on by only one job worker. Get this number from the response
+	// to a GetThirdPartyJobDetails request.
 	//
 	// Nonce is a required field
 	Nonce *string `locationName:"nonce" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AcknowledgeThirdPartyJobInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AcknowledgeThirdPartyJobInput) GoString() string {
 	return s.String()
 }
@@ -3796,12 +4135,20 @@ type AcknowledgeThirdPartyJobOutput struct {
 	Status *string `locationName:"status" type:"string" enum:"JobStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AcknowledgeThirdPartyJobOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AcknowledgeThirdPartyJobOutput) GoString() string {
 	return s.String()
 }
@@ -3820,12 +4167,20 @@ type ActionConfiguration struct {
 	Configuration map[string]*string `locationName:"configuration" type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionConfiguration) GoString() string {
 	return s.String()
 }
@@ -3884,12 +4239,20 @@ type ActionConfigurationProperty struct {
 	Type *string `locationName:"type" type:"string" enum:"ActionConfigurationPropertyType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionConfigurationProperty) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionConfigurationProperty) GoString() string {
 	return s.String()
 }
@@ -3975,12 +4338,20 @@ type ActionContext struct {
 	Name *string `locationName:"name" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionContext) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionContext) GoString() string {
 	return s.String()
 }
@@ -4009,11 +4380,11 @@ type ActionDeclaration struct {
 	// The action's configuration. These are key-value pairs that specify input
 	// values for an action. For more information, see Action Structure Requirements
 	// in CodePipeline (https://docs.aws.amazon.com/codepipeline/latest/userguide/reference-pipeline-structure.html#action-requirements).
-	// For the list of configuration properties for the AWS CloudFormation action
-	// type in CodePipeline, see Configuration Properties Reference (https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/continuous-delivery-codepipeline-action-reference.html)
-	// in the AWS CloudFormation User Guide. For template snippets with examples,
-	// see Using Parameter Override Functions with CodePipeline Pipelines (https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/continuous-delivery-codepipeline-parameter-override-functions.html)
-	// in the AWS CloudFormation User Guide.
+	// For the list of configuration properties for the CloudFormation action type
+	// in CodePipeline, see Configuration Properties Reference (https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/continuous-delivery-codepipeline-action-reference.html)
+	// in the CloudFormation User Guide. For template snippets with examples, see
+	// Using Parameter Override Functions with CodePipeline Pipelines (https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/continuous-delivery-codepipeline-parameter-override-functions.html)
+	// in the CloudFormation User Guide.
 	//
 	// The values can be represented in either JSON or YAML format. For example,
 	// the JSON configuration item format is as follows:
@@ -4032,11 +4403,15 @@ type ActionDeclaration struct {
 	// Name is a required field
 	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
 
+	// The variable namespace associated with the action. All variables produced
+	// as output by this action fall under this namespace.
+	Namespace *string `locationName:"namespace" min:"1" type:"string"`
+
 	// The name or ID of the result of the action declaration, such as a test or
 	// build artifact.
 	OutputArtifacts []*OutputArtifact `locationName:"outputArtifacts" type:"list"`
 
-	// The action declaration's AWS Region, such as us-east-1.
+	// The action declaration's Amazon Web Services Region, such as us-east-1.
 	Region *string `locationName:"region" min:"4" type:"string"`
 
 	// The ARN of the IAM service role that performs the declared action. This is
@@ -4047,12 +4422,20 @@ type ActionDeclaration struct {
 	RunOrder *int64 `locationName:"runOrder" min:"1" type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionDeclaration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionDeclaration) GoString() string {
 	return s.String()
 }
@@ -4069,6 +4452,9 @@ func (s *ActionDeclaration) Validate() error {
 	if s.Name != nil && len(*s.Name) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
 	}
+	if s.Namespace != nil && len(*s.Namespace) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Namespace", 1))
+	}
 	if s.Region != nil && len(*s.Region) < 4 {
 		invalidParams.Add(request.NewErrParamMinLen("Region", 4))
 	}
@@ -4131,6 +4517,12 @@ func (s *ActionDeclaration) SetName(v string) *ActionDeclaration {
 	return s
 }
 
+// SetNamespace sets the Namespace field's value.
+func (s *ActionDeclaration) SetNamespace(v string) *ActionDeclaration {
+	s.Namespace = &v
+	return s
+}
+
 // SetOutputArtifacts sets the OutputArtifacts field's value.
 func (s *ActionDeclaration) SetOutputArtifacts(v []*OutputArtifact) *ActionDeclaration {
 	s.OutputArtifacts = v
@@ -4159,14 +4551,21 @@ func (s *ActionDeclaration) SetRunOrder(v int64) *ActionDeclaration {
 type ActionExecution struct {
 	_ struct{} `type:"structure"`
 
-	// The details of an error returned by a URL external to AWS.
+	// ID of the workflow action execution in the current stage. Use the GetPipelineState
+	// action to retrieve the current action execution details of the current stage.
+	//
+	// For older executions, this field might be empty. The action execution ID
+	// is available for executions run on or after March 2020.
+	ActionExecutionId *string `locationName:"actionExecutionId" type:"string"`
+
+	// The details of an error returned by a URL external to Amazon Web Services.
 	ErrorDetails *ErrorDetails `locationName:"errorDetails" type:"structure"`
 
 	// The external ID of the run of the action.
 	ExternalExecutionId *string `locationName:"externalExecutionId" min:"1" type:"string"`
 
-	// The URL of a resource external to AWS that is used when running the action
-	// (for example, an external repository URL).
+	// The URL of a resource external to Amazon Web Services that is used when running
+	// the action (for example, an external repository URL).
 	ExternalExecutionUrl *string `locationName:"externalExecutionUrl" min:"1" type:"string"`
 
 	// The last status change of the action.
@@ -4192,16 +4591,30 @@ type ActionExecution struct {
 	Token *string `locationName:"token" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionExecution) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionExecution) GoString() string {
 	return s.String()
 }
 
+// SetActionExecutionId sets the ActionExecutionId field's value.
+func (s *ActionExecution) SetActionExecutionId(v string) *ActionExecution {
+	s.ActionExecutionId = &v
+	return s
+}
+
 // SetErrorDetails sets the ErrorDetails field's value.
 func (s *ActionExecution) SetErrorDetails(v *ErrorDetails) *ActionExecution {
 	s.ErrorDetails = v
@@ -4294,12 +4707,20 @@ type ActionExecutionDetail struct {
 	Status *string `locationName:"status" type:"string" enum:"ActionExecutionStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionExecutionDetail) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionExecutionDetail) GoString() string {
 	return s.String()
 }
@@ -4372,12 +4793,20 @@ type ActionExecutionFilter struct {
 	PipelineExecutionId *string `locationName:"pipelineExecutionId" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionExecutionFilter) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionExecutionFilter) GoString() string {
 	return s.String()
 }
@@ -4401,20 +4830,36 @@ type ActionExecutionInput struct {
 	// Details of input artifacts of the action that correspond to the action execution.
 	InputArtifacts []*ArtifactDetail `locationName:"inputArtifacts" type:"list"`
 
-	// The AWS Region for the action, such as us-east-1.
+	// The variable namespace associated with the action. All variables produced
+	// as output by this action fall under this namespace.
+	Namespace *string `locationName:"namespace" min:"1" type:"string"`
+
+	// The Amazon Web Services Region for the action, such as us-east-1.
 	Region *string `locationName:"region" min:"4" type:"string"`
 
+	// Configuration data for an action execution with all variable references replaced
+	// with their real values for the execution.
+	ResolvedConfiguration map[string]*string `locationName:"resolvedConfiguration" type:"map"`
+
 	// The ARN of the IAM service role that performs the declared action. This is
 	// assumed through the roleArn for the pipeline.
 	RoleArn *string `locationName:"roleArn" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionExecutionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionExecutionInput) GoString() string {
 	return s.String()
 }
@@ -4437,12 +4882,24 @@ func (s *ActionExecutionInput) SetInputArtifacts(v []*ArtifactDetail) *ActionExe
 	return s
 }
 
+// SetNamespace sets the Namespace field's value.
+func (s *ActionExecutionInput) SetNamespace(v string) *ActionExecutionInput {
+	s.Namespace = &v
+	return s
+}
+
 // SetRegion sets the Region field's value.
 func (s *ActionExecutionInput) SetRegion(v string) *ActionExecutionInput {
 	s.Region = &v
 	return s
 }
 
+// SetResolvedConfiguration sets the ResolvedConfiguration field's value.
+func (s *ActionExecutionInput) SetResolvedConfiguration(v map[string]*string) *ActionExecutionInput {
+	s.ResolvedConfiguration = v
+	return s
+}
+
 // SetRoleArn sets the RoleArn field's value.
 func (s *ActionExecutionInput) SetRoleArn(v string) *ActionExecutionInput {
 	s.RoleArn = &v
@@ -4459,14 +4916,26 @@ type ActionExecutionOutput struct {
 
 	// Details of output artifacts of the action that correspond to the action execution.
 	OutputArtifacts []*ArtifactDetail `locationName:"outputArtifacts" type:"list"`
+
+	// The outputVariables field shows the key-value pairs that were output as part
+	// of that execution.
+	OutputVariables map[string]*string `locationName:"outputVariables" type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionExecutionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionExecutionOutput) GoString() string {
 	return s.String()
 }
@@ -4483,6 +4952,12 @@ func (s *ActionExecutionOutput) SetOutputArtifacts(v []*ArtifactDetail) *ActionE
 	return s
 }
 
+// SetOutputVariables sets the OutputVariables field's value.
+func (s *ActionExecutionOutput) SetOutputVariables(v map[string]*string) *ActionExecutionOutput {
+	s.OutputVariables = v
+	return s
+}
+
 // Execution result information, such as the external execution ID.
 type ActionExecutionResult struct {
 	_ struct{} `type:"structure"`
@@ -4498,12 +4973,20 @@ type ActionExecutionResult struct {
 	ExternalExecutionUrl *string `locationName:"externalExecutionUrl" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionExecutionResult) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionExecutionResult) GoString() string {
 	return s.String()
 }
@@ -4526,6 +5009,70 @@ func (s *ActionExecutionResult) SetExternalExecutionUrl(v string) *ActionExecuti
 	return s
 }
 
+// The specified action cannot be found.
+type ActionNotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionNotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionNotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorActionNotFoundException(v protocol.ResponseMetadata) error {
+	return &ActionNotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ActionNotFoundException) Code() string {
+	return "ActionNotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *ActionNotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ActionNotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *ActionNotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ActionNotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ActionNotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Represents information about the version (or revision) of an action.
 type ActionRevision struct {
 	_ struct{} `type:"structure"`
@@ -4549,12 +5096,20 @@ type ActionRevision struct {
 	RevisionId *string `locationName:"revisionId" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionRevision) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionRevision) GoString() string {
 	return s.String()
 }
@@ -4624,12 +5179,20 @@ type ActionState struct {
 	RevisionUrl *string `locationName:"revisionUrl" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionState) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionState) GoString() string {
 	return s.String()
 }
@@ -4690,12 +5253,20 @@ type ActionType struct {
 	Settings *ActionTypeSettings `locationName:"settings" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionType) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ActionType) GoString() string {
 	return s.String()
 }
@@ -4730,67 +5301,52 @@ func (s *ActionType) SetSettings(v *ActionTypeSettings) *ActionType {
 	return s
 }
 
-// Represents information about an action type.
-type ActionTypeId struct {
+// Information about parameters for artifacts associated with the action type,
+// such as the minimum and maximum artifacts allowed.
+type ActionTypeArtifactDetails struct {
 	_ struct{} `type:"structure"`
 
-	// A category defines what kind of action can be taken in the stage, and constrains
-	// the provider type for the action. Valid categories are limited to one of
-	// the following values.
-	//
-	// Category is a required field
-	Category *string `locationName:"category" type:"string" required:"true" enum:"ActionCategory"`
-
-	// The creator of the action being called.
-	//
-	// Owner is a required field
-	Owner *string `locationName:"owner" type:"string" required:"true" enum:"ActionOwner"`
-
-	// The provider of the service being called by the action. Valid providers are
-	// determined by the action category. For example, an action in the Deploy category
-	// type might have a provider of AWS CodeDeploy, which would be specified as
-	// CodeDeploy. For more information, see Valid Action Types and Providers in
-	// CodePipeline (https://docs.aws.amazon.com/codepipeline/latest/userguide/reference-pipeline-structure.html#actions-valid-providers).
+	// The maximum number of artifacts that can be used with the actiontype. For
+	// example, you should specify a minimum and maximum of zero input artifacts
+	// for an action type with a category of source.
 	//
-	// Provider is a required field
-	Provider *string `locationName:"provider" min:"1" type:"string" required:"true"`
+	// MaximumCount is a required field
+	MaximumCount *int64 `locationName:"maximumCount" type:"integer" required:"true"`
 
-	// A string that describes the action version.
+	// The minimum number of artifacts that can be used with the action type. For
+	// example, you should specify a minimum and maximum of zero input artifacts
+	// for an action type with a category of source.
 	//
-	// Version is a required field
-	Version *string `locationName:"version" min:"1" type:"string" required:"true"`
+	// MinimumCount is a required field
+	MinimumCount *int64 `locationName:"minimumCount" type:"integer" required:"true"`
 }
 
-// String returns the string representation
-func (s ActionTypeId) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionTypeArtifactDetails) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ActionTypeId) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionTypeArtifactDetails) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ActionTypeId) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ActionTypeId"}
-	if s.Category == nil {
-		invalidParams.Add(request.NewErrParamRequired("Category"))
-	}
-	if s.Owner == nil {
-		invalidParams.Add(request.NewErrParamRequired("Owner"))
-	}
-	if s.Provider == nil {
-		invalidParams.Add(request.NewErrParamRequired("Provider"))
-	}
-	if s.Provider != nil && len(*s.Provider) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Provider", 1))
-	}
-	if s.Version == nil {
-		invalidParams.Add(request.NewErrParamRequired("Version"))
+func (s *ActionTypeArtifactDetails) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ActionTypeArtifactDetails"}
+	if s.MaximumCount == nil {
+		invalidParams.Add(request.NewErrParamRequired("MaximumCount"))
 	}
-	if s.Version != nil && len(*s.Version) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Version", 1))
+	if s.MinimumCount == nil {
+		invalidParams.Add(request.NewErrParamRequired("MinimumCount"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -4799,81 +5355,137 @@ func (s *ActionTypeId) Validate() error {
 	return nil
 }
 
-// SetCategory sets the Category field's value.
-func (s *ActionTypeId) SetCategory(v string) *ActionTypeId {
-	s.Category = &v
+// SetMaximumCount sets the MaximumCount field's value.
+func (s *ActionTypeArtifactDetails) SetMaximumCount(v int64) *ActionTypeArtifactDetails {
+	s.MaximumCount = &v
 	return s
 }
 
-// SetOwner sets the Owner field's value.
-func (s *ActionTypeId) SetOwner(v string) *ActionTypeId {
-	s.Owner = &v
+// SetMinimumCount sets the MinimumCount field's value.
+func (s *ActionTypeArtifactDetails) SetMinimumCount(v int64) *ActionTypeArtifactDetails {
+	s.MinimumCount = &v
 	return s
 }
 
-// SetProvider sets the Provider field's value.
-func (s *ActionTypeId) SetProvider(v string) *ActionTypeId {
-	s.Provider = &v
-	return s
-}
+// The parameters for the action type definition that are provided when the
+// action type is created or updated.
+type ActionTypeDeclaration struct {
+	_ struct{} `type:"structure"`
 
-// SetVersion sets the Version field's value.
-func (s *ActionTypeId) SetVersion(v string) *ActionTypeId {
-	s.Version = &v
-	return s
-}
+	// The description for the action type to be updated.
+	Description *string `locationName:"description" min:"1" type:"string"`
 
-// Returns information about the settings for an action type.
-type ActionTypeSettings struct {
-	_ struct{} `type:"structure"`
+	// Information about the executor for an action type that was created with any
+	// supported integration model.
+	//
+	// Executor is a required field
+	Executor *ActionTypeExecutor `locationName:"executor" type:"structure" required:"true"`
 
-	// The URL returned to the AWS CodePipeline console that provides a deep link
-	// to the resources of the external system, such as the configuration page for
-	// an AWS CodeDeploy deployment group. This link is provided as part of the
-	// action display in the pipeline.
-	EntityUrlTemplate *string `locationName:"entityUrlTemplate" min:"1" type:"string"`
+	// The action category, owner, provider, and version of the action type to be
+	// updated.
+	//
+	// Id is a required field
+	Id *ActionTypeIdentifier `locationName:"id" type:"structure" required:"true"`
 
-	// The URL returned to the AWS CodePipeline console that contains a link to
-	// the top-level landing page for the external system, such as the console page
-	// for AWS CodeDeploy. This link is shown on the pipeline view page in the AWS
-	// CodePipeline console and provides a link to the execution entity of the external
-	// action.
-	ExecutionUrlTemplate *string `locationName:"executionUrlTemplate" min:"1" type:"string"`
+	// Details for the artifacts, such as application files, to be worked on by
+	// the action. For example, the minimum and maximum number of input artifacts
+	// allowed.
+	//
+	// InputArtifactDetails is a required field
+	InputArtifactDetails *ActionTypeArtifactDetails `locationName:"inputArtifactDetails" type:"structure" required:"true"`
 
-	// The URL returned to the AWS CodePipeline console that contains a link to
-	// the page where customers can update or change the configuration of the external
-	// action.
-	RevisionUrlTemplate *string `locationName:"revisionUrlTemplate" min:"1" type:"string"`
+	// Details for the output artifacts, such as a built application, that are the
+	// result of the action. For example, the minimum and maximum number of output
+	// artifacts allowed.
+	//
+	// OutputArtifactDetails is a required field
+	OutputArtifactDetails *ActionTypeArtifactDetails `locationName:"outputArtifactDetails" type:"structure" required:"true"`
 
-	// The URL of a sign-up page where users can sign up for an external service
-	// and perform initial configuration of the action provided by that service.
-	ThirdPartyConfigurationUrl *string `locationName:"thirdPartyConfigurationUrl" min:"1" type:"string"`
+	// Details identifying the accounts with permissions to use the action type.
+	Permissions *ActionTypePermissions `locationName:"permissions" type:"structure"`
+
+	// The properties of the action type to be updated.
+	Properties []*ActionTypeProperty `locationName:"properties" type:"list"`
+
+	// The links associated with the action type to be updated.
+	Urls *ActionTypeUrls `locationName:"urls" type:"structure"`
 }
 
-// String returns the string representation
-func (s ActionTypeSettings) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionTypeDeclaration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ActionTypeSettings) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionTypeDeclaration) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ActionTypeSettings) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ActionTypeSettings"}
-	if s.EntityUrlTemplate != nil && len(*s.EntityUrlTemplate) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("EntityUrlTemplate", 1))
+func (s *ActionTypeDeclaration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ActionTypeDeclaration"}
+	if s.Description != nil && len(*s.Description) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Description", 1))
 	}
-	if s.ExecutionUrlTemplate != nil && len(*s.ExecutionUrlTemplate) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("ExecutionUrlTemplate", 1))
+	if s.Executor == nil {
+		invalidParams.Add(request.NewErrParamRequired("Executor"))
 	}
-	if s.RevisionUrlTemplate != nil && len(*s.RevisionUrlTemplate) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("RevisionUrlTemplate", 1))
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
 	}
-	if s.ThirdPartyConfigurationUrl != nil && len(*s.ThirdPartyConfigurationUrl) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("ThirdPartyConfigurationUrl", 1))
+	if s.InputArtifactDetails == nil {
+		invalidParams.Add(request.NewErrParamRequired("InputArtifactDetails"))
+	}
+	if s.OutputArtifactDetails == nil {
+		invalidParams.Add(request.NewErrParamRequired("OutputArtifactDetails"))
+	}
+	if s.Executor != nil {
+		if err := s.Executor.Validate(); err != nil {
+			invalidParams.AddNested("Executor", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Id != nil {
+		if err := s.Id.Validate(); err != nil {
+			invalidParams.AddNested("Id", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.InputArtifactDetails != nil {
+		if err := s.InputArtifactDetails.Validate(); err != nil {
+			invalidParams.AddNested("InputArtifactDetails", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.OutputArtifactDetails != nil {
+		if err := s.OutputArtifactDetails.Validate(); err != nil {
+			invalidParams.AddNested("OutputArtifactDetails", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Permissions != nil {
+		if err := s.Permissions.Validate(); err != nil {
+			invalidParams.AddNested("Permissions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Properties != nil {
+		for i, v := range s.Properties {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Properties", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Urls != nil {
+		if err := s.Urls.Validate(); err != nil {
+			invalidParams.AddNested("Urls", err.(request.ErrInvalidParams))
+		}
 	}
 
 	if invalidParams.Len() > 0 {
@@ -4882,63 +5494,2288 @@ func (s *ActionTypeSettings) Validate() error {
 	return nil
 }
 
-// SetEntityUrlTemplate sets the EntityUrlTemplate field's value.
-func (s *ActionTypeSettings) SetEntityUrlTemplate(v string) *ActionTypeSettings {
-	s.EntityUrlTemplate = &v
+// SetDescription sets the Description field's value.
+func (s *ActionTypeDeclaration) SetDescription(v string) *ActionTypeDeclaration {
+	s.Description = &v
 	return s
 }
 
-// SetExecutionUrlTemplate sets the ExecutionUrlTemplate field's value.
-func (s *ActionTypeSettings) SetExecutionUrlTemplate(v string) *ActionTypeSettings {
-	s.ExecutionUrlTemplate = &v
+// SetExecutor sets the Executor field's value.
+func (s *ActionTypeDeclaration) SetExecutor(v *ActionTypeExecutor) *ActionTypeDeclaration {
+	s.Executor = v
 	return s
 }
 
-// SetRevisionUrlTemplate sets the RevisionUrlTemplate field's value.
-func (s *ActionTypeSettings) SetRevisionUrlTemplate(v string) *ActionTypeSettings {
-	s.RevisionUrlTemplate = &v
+// SetId sets the Id field's value.
+func (s *ActionTypeDeclaration) SetId(v *ActionTypeIdentifier) *ActionTypeDeclaration {
+	s.Id = v
 	return s
 }
 
-// SetThirdPartyConfigurationUrl sets the ThirdPartyConfigurationUrl field's value.
-func (s *ActionTypeSettings) SetThirdPartyConfigurationUrl(v string) *ActionTypeSettings {
-	s.ThirdPartyConfigurationUrl = &v
+// SetInputArtifactDetails sets the InputArtifactDetails field's value.
+func (s *ActionTypeDeclaration) SetInputArtifactDetails(v *ActionTypeArtifactDetails) *ActionTypeDeclaration {
+	s.InputArtifactDetails = v
 	return s
 }
 
-// Represents information about the result of an approval request.
-type ApprovalResult struct {
-	_ struct{} `type:"structure"`
-
-	// The response submitted by a reviewer assigned to an approval action request.
-	//
+// SetOutputArtifactDetails sets the OutputArtifactDetails field's value.
+func (s *ActionTypeDeclaration) SetOutputArtifactDetails(v *ActionTypeArtifactDetails) *ActionTypeDeclaration {
+	s.OutputArtifactDetails = v
+	return s
+}
+
+// SetPermissions sets the Permissions field's value.
+func (s *ActionTypeDeclaration) SetPermissions(v *ActionTypePermissions) *ActionTypeDeclaration {
+	s.Permissions = v
+	return s
+}
+
+// SetProperties sets the Properties field's value.
+func (s *ActionTypeDeclaration) SetProperties(v []*ActionTypeProperty) *ActionTypeDeclaration {
+	s.Properties = v
+	return s
+}
+
+// SetUrls sets the Urls field's value.
+func (s *ActionTypeDeclaration) SetUrls(v *ActionTypeUrls) *ActionTypeDeclaration {
+	s.Urls = v
+	return s
+}
+
+// The action engine, or executor, for an action type created for a provider,
+// where the action is to be used by customers of the provider. The action engine
+// is associated with the model used to create and update the action, such as
+// the Lambda integration model.
+type ActionTypeExecutor struct {
+	_ struct{} `type:"structure"`
+
+	// The action configuration properties for the action type. These properties
+	// are specified in the action definition when the action type is created.
+	//
+	// Configuration is a required field
+	Configuration *ExecutorConfiguration `locationName:"configuration" type:"structure" required:"true"`
+
+	// The timeout in seconds for the job. An action execution can have multiple
+	// jobs. This is the timeout for a single job, not the entire action execution.
+	JobTimeout *int64 `locationName:"jobTimeout" min:"60" type:"integer"`
+
+	// The policy statement that specifies the permissions in the CodePipeline customer
+	// account that are needed to successfully run an action.
+	//
+	// To grant permission to another account, specify the account ID as the Principal,
+	// a domain-style identifier defined by the service, for example codepipeline.amazonaws.com.
+	//
+	// The size of the passed JSON policy document cannot exceed 2048 characters.
+	PolicyStatementsTemplate *string `locationName:"policyStatementsTemplate" min:"1" type:"string"`
+
+	// The integration model used to create and update the action type, Lambda or
+	// JobWorker.
+	//
+	// Type is a required field
+	Type *string `locationName:"type" type:"string" required:"true" enum:"ExecutorType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionTypeExecutor) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionTypeExecutor) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ActionTypeExecutor) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ActionTypeExecutor"}
+	if s.Configuration == nil {
+		invalidParams.Add(request.NewErrParamRequired("Configuration"))
+	}
+	if s.JobTimeout != nil && *s.JobTimeout < 60 {
+		invalidParams.Add(request.NewErrParamMinValue("JobTimeout", 60))
+	}
+	if s.PolicyStatementsTemplate != nil && len(*s.PolicyStatementsTemplate) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("PolicyStatementsTemplate", 1))
+	}
+	if s.Type == nil {
+		invalidParams.Add(request.NewErrParamRequired("Type"))
+	}
+	if s.Configuration != nil {
+		if err := s.Configuration.Validate(); err != nil {
+			invalidParams.AddNested("Configuration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetConfiguration sets the Configuration field's value.
+func (s *ActionTypeExecutor) SetConfiguration(v *ExecutorConfiguration) *ActionTypeExecutor {
+	s.Configuration = v
+	return s
+}
+
+// SetJobTimeout sets the JobTimeout field's value.
+func (s *ActionTypeExecutor) SetJobTimeout(v int64) *ActionTypeExecutor {
+	s.JobTimeout = &v
+	return s
+}
+
+// SetPolicyStatementsTemplate sets the PolicyStatementsTemplate field's value.
+func (s *ActionTypeExecutor) SetPolicyStatementsTemplate(v string) *ActionTypeExecutor {
+	s.PolicyStatementsTemplate = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *ActionTypeExecutor) SetType(v string) *ActionTypeExecutor {
+	s.Type = &v
+	return s
+}
+
+// Represents information about an action type.
+type ActionTypeId struct {
+	_ struct{} `type:"structure"`
+
+	// A category defines what kind of action can be taken in the stage, and constrains
+	// the provider type for the action. Valid categories are limited to one of
+	// the following values.
+	//
+	//    * Source
+	//
+	//    * Build
+	//
+	//    * Test
+	//
+	//    * Deploy
+	//
+	//    * Invoke
+	//
+	//    * Approval
+	//
+	// Category is a required field
+	Category *string `locationName:"category" type:"string" required:"true" enum:"ActionCategory"`
+
+	// The creator of the action being called. There are three valid values for
+	// the Owner field in the action category section within your pipeline structure:
+	// AWS, ThirdParty, and Custom. For more information, see Valid Action Types
+	// and Providers in CodePipeline (https://docs.aws.amazon.com/codepipeline/latest/userguide/reference-pipeline-structure.html#actions-valid-providers).
+	//
+	// Owner is a required field
+	Owner *string `locationName:"owner" type:"string" required:"true" enum:"ActionOwner"`
+
+	// The provider of the service being called by the action. Valid providers are
+	// determined by the action category. For example, an action in the Deploy category
+	// type might have a provider of CodeDeploy, which would be specified as CodeDeploy.
+	// For more information, see Valid Action Types and Providers in CodePipeline
+	// (https://docs.aws.amazon.com/codepipeline/latest/userguide/reference-pipeline-structure.html#actions-valid-providers).
+	//
+	// Provider is a required field
+	Provider *string `locationName:"provider" min:"1" type:"string" required:"true"`
+
+	// A string that describes the action version.
+	//
+	// Version is a required field
+	Version *string `locationName:"version" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionTypeId) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionTypeId) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ActionTypeId) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ActionTypeId"}
+	if s.Category == nil {
+		invalidParams.Add(request.NewErrParamRequired("Category"))
+	}
+	if s.Owner == nil {
+		invalidParams.Add(request.NewErrParamRequired("Owner"))
+	}
+	if s.Provider == nil {
+		invalidParams.Add(request.NewErrParamRequired("Provider"))
+	}
+	if s.Provider != nil && len(*s.Provider) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Provider", 1))
+	}
+	if s.Version == nil {
+		invalidParams.Add(request.NewErrParamRequired("Version"))
+	}
+	if s.Version != nil && len(*s.Version) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Version", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCategory sets the Category field's value.
+func (s *ActionTypeId) SetCategory(v string) *ActionTypeId {
+	s.Category = &v
+	return s
+}
+
+// SetOwner sets the Owner field's value.
+func (s *ActionTypeId) SetOwner(v string) *ActionTypeId {
+	s.Owner = &v
+	return s
+}
+
+// SetProvider sets the Provider field's value.
+func (s *ActionTypeId) SetProvider(v string) *ActionTypeId {
+	s.Provider = &v
+	return s
+}
+
+// SetVersion sets the Version field's value.
+func (s *ActionTypeId) SetVersion(v string) *ActionTypeId {
+	s.Version = &v
+	return s
+}
+
+// Specifies the category, owner, provider, and version of the action type.
+type ActionTypeIdentifier struct {
+	_ struct{} `type:"structure"`
+
+	// Defines what kind of action can be taken in the stage, one of the following:
+	//
+	//    * Source
+	//
+	//    * Build
+	//
+	//    * Test
+	//
+	//    * Deploy
+	//
+	//    * Approval
+	//
+	//    * Invoke
+	//
+	// Category is a required field
+	Category *string `locationName:"category" type:"string" required:"true" enum:"ActionCategory"`
+
+	// The creator of the action type being called: AWS or ThirdParty.
+	//
+	// Owner is a required field
+	Owner *string `locationName:"owner" type:"string" required:"true"`
+
+	// The provider of the action type being called. The provider name is supplied
+	// when the action type is created.
+	//
+	// Provider is a required field
+	Provider *string `locationName:"provider" min:"1" type:"string" required:"true"`
+
+	// A string that describes the action type version.
+	//
+	// Version is a required field
+	Version *string `locationName:"version" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionTypeIdentifier) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionTypeIdentifier) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ActionTypeIdentifier) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ActionTypeIdentifier"}
+	if s.Category == nil {
+		invalidParams.Add(request.NewErrParamRequired("Category"))
+	}
+	if s.Owner == nil {
+		invalidParams.Add(request.NewErrParamRequired("Owner"))
+	}
+	if s.Provider == nil {
+		invalidParams.Add(request.NewErrParamRequired("Provider"))
+	}
+	if s.Provider != nil && len(*s.Provider) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Provider", 1))
+	}
+	if s.Version == nil {
+		invalidParams.Add(request.NewErrParamRequired("Version"))
+	}
+	if s.Version != nil && len(*s.Version) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Version", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCategory sets the Category field's value.
+func (s *ActionTypeIdentifier) SetCategory(v string) *ActionTypeIdentifier {
+	s.Category = &v
+	return s
+}
+
+// SetOwner sets the Owner field's value.
+func (s *ActionTypeIdentifier) SetOwner(v string) *ActionTypeIdentifier {
+	s.Owner = &v
+	return s
+}
+
+// SetProvider sets the Provider field's value.
+func (s *ActionTypeIdentifier) SetProvider(v string) *ActionTypeIdentifier {
+	s.Provider = &v
+	return s
+}
+
+// SetVersion sets the Version field's value.
+func (s *ActionTypeIdentifier) SetVersion(v string) *ActionTypeIdentifier {
+	s.Version = &v
+	return s
+}
+
+// The specified action type cannot be found.
+type ActionTypeNotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionTypeNotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionTypeNotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorActionTypeNotFoundException(v protocol.ResponseMetadata) error {
+	return &ActionTypeNotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ActionTypeNotFoundException) Code() string {
+	return "ActionTypeNotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *ActionTypeNotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ActionTypeNotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *ActionTypeNotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ActionTypeNotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ActionTypeNotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Details identifying the users with permissions to use the action type.
+type ActionTypePermissions struct {
+	_ struct{} `type:"structure"`
+
+	// A list of Amazon Web Services account IDs with access to use the action type
+	// in their pipelines.
+	//
+	// AllowedAccounts is a required field
+	AllowedAccounts []*string `locationName:"allowedAccounts" min:"1" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionTypePermissions) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionTypePermissions) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ActionTypePermissions) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ActionTypePermissions"}
+	if s.AllowedAccounts == nil {
+		invalidParams.Add(request.NewErrParamRequired("AllowedAccounts"))
+	}
+	if s.AllowedAccounts != nil && len(s.AllowedAccounts) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("AllowedAccounts", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAllowedAccounts sets the AllowedAccounts field's value.
+func (s *ActionTypePermissions) SetAllowedAccounts(v []*string) *ActionTypePermissions {
+	s.AllowedAccounts = v
+	return s
+}
+
+// Represents information about each property specified in the action configuration,
+// such as the description and key name that display for the customer using
+// the action type.
+type ActionTypeProperty struct {
+	_ struct{} `type:"structure"`
+
+	// The description of the property that is displayed to users.
+	Description *string `locationName:"description" min:"1" type:"string"`
+
+	// Whether the configuration property is a key.
+	//
+	// Key is a required field
+	Key *bool `locationName:"key" type:"boolean" required:"true"`
+
+	// The property name that is displayed to users.
+	//
+	// Name is a required field
+	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
+
+	// Whether to omit the field value entered by the customer in the log. If true,
+	// the value is not saved in CloudTrail logs for the action execution.
+	//
+	// NoEcho is a required field
+	NoEcho *bool `locationName:"noEcho" type:"boolean" required:"true"`
+
+	// Whether the configuration property is an optional value.
+	//
+	// Optional is a required field
+	Optional *bool `locationName:"optional" type:"boolean" required:"true"`
+
+	// Indicates that the property is used with polling. An action type can have
+	// up to one queryable property. If it has one, that property must be both required
+	// and not secret.
+	Queryable *bool `locationName:"queryable" type:"boolean"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionTypeProperty) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionTypeProperty) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ActionTypeProperty) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ActionTypeProperty"}
+	if s.Description != nil && len(*s.Description) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Description", 1))
+	}
+	if s.Key == nil {
+		invalidParams.Add(request.NewErrParamRequired("Key"))
+	}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+	if s.NoEcho == nil {
+		invalidParams.Add(request.NewErrParamRequired("NoEcho"))
+	}
+	if s.Optional == nil {
+		invalidParams.Add(request.NewErrParamRequired("Optional"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDescription sets the Description field's value.
+func (s *ActionTypeProperty) SetDescription(v string) *ActionTypeProperty {
+	s.Description = &v
+	return s
+}
+
+// SetKey sets the Key field's value.
+func (s *ActionTypeProperty) SetKey(v bool) *ActionTypeProperty {
+	s.Key = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *ActionTypeProperty) SetName(v string) *ActionTypeProperty {
+	s.Name = &v
+	return s
+}
+
+// SetNoEcho sets the NoEcho field's value.
+func (s *ActionTypeProperty) SetNoEcho(v bool) *ActionTypeProperty {
+	s.NoEcho = &v
+	return s
+}
+
+// SetOptional sets the Optional field's value.
+func (s *ActionTypeProperty) SetOptional(v bool) *ActionTypeProperty {
+	s.Optional = &v
+	return s
+}
+
+// SetQueryable sets the Queryable field's value.
+func (s *ActionTypeProperty) SetQueryable(v bool) *ActionTypeProperty {
+	s.Queryable = &v
+	return s
+}
+
+// Returns information about the settings for an action type.
+type ActionTypeSettings struct {
+	_ struct{} `type:"structure"`
+
+	// The URL returned to the CodePipeline console that provides a deep link to
+	// the resources of the external system, such as the configuration page for
+	// a CodeDeploy deployment group. This link is provided as part of the action
+	// display in the pipeline.
+	EntityUrlTemplate *string `locationName:"entityUrlTemplate" min:"1" type:"string"`
+
+	// The URL returned to the CodePipeline console that contains a link to the
+	// top-level landing page for the external system, such as the console page
+	// for CodeDeploy. This link is shown on the pipeline view page in the CodePipeline
+	// console and provides a link to the execution entity of the external action.
+	ExecutionUrlTemplate *string `locationName:"executionUrlTemplate" min:"1" type:"string"`
+
+	// The URL returned to the CodePipeline console that contains a link to the
+	// page where customers can update or change the configuration of the external
+	// action.
+	RevisionUrlTemplate *string `locationName:"revisionUrlTemplate" min:"1" type:"string"`
+
+	// The URL of a sign-up page where users can sign up for an external service
+	// and perform initial configuration of the action provided by that service.
+	ThirdPartyConfigurationUrl *string `locationName:"thirdPartyConfigurationUrl" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionTypeSettings) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionTypeSettings) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ActionTypeSettings) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ActionTypeSettings"}
+	if s.EntityUrlTemplate != nil && len(*s.EntityUrlTemplate) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EntityUrlTemplate", 1))
+	}
+	if s.ExecutionUrlTemplate != nil && len(*s.ExecutionUrlTemplate) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ExecutionUrlTemplate", 1))
+	}
+	if s.RevisionUrlTemplate != nil && len(*s.RevisionUrlTemplate) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RevisionUrlTemplate", 1))
+	}
+	if s.ThirdPartyConfigurationUrl != nil && len(*s.ThirdPartyConfigurationUrl) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ThirdPartyConfigurationUrl", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetEntityUrlTemplate sets the EntityUrlTemplate field's value.
+func (s *ActionTypeSettings) SetEntityUrlTemplate(v string) *ActionTypeSettings {
+	s.EntityUrlTemplate = &v
+	return s
+}
+
+// SetExecutionUrlTemplate sets the ExecutionUrlTemplate field's value.
+func (s *ActionTypeSettings) SetExecutionUrlTemplate(v string) *ActionTypeSettings {
+	s.ExecutionUrlTemplate = &v
+	return s
+}
+
+// SetRevisionUrlTemplate sets the RevisionUrlTemplate field's value.
+func (s *ActionTypeSettings) SetRevisionUrlTemplate(v string) *ActionTypeSettings {
+	s.RevisionUrlTemplate = &v
+	return s
+}
+
+// SetThirdPartyConfigurationUrl sets the ThirdPartyConfigurationUrl field's value.
+func (s *ActionTypeSettings) SetThirdPartyConfigurationUrl(v string) *ActionTypeSettings {
+	s.ThirdPartyConfigurationUrl = &v
+	return s
+}
+
+// Returns information about URLs for web pages that display to customers as
+// links on the pipeline view, such as an external configuration page for the
+// action type.
+type ActionTypeUrls struct {
+	_ struct{} `type:"structure"`
+
+	// The URL returned to the CodePipeline console that contains a link to the
+	// page where customers can configure the external action.
+	ConfigurationUrl *string `locationName:"configurationUrl" min:"1" type:"string"`
+
+	// The URL returned to the CodePipeline console that provides a deep link to
+	// the resources of the external system, such as a status page. This link is
+	// provided as part of the action display in the pipeline.
+	EntityUrlTemplate *string `locationName:"entityUrlTemplate" min:"1" type:"string"`
+
+	// The link to an execution page for the action type in progress. For example,
+	// for a CodeDeploy action, this link is shown on the pipeline view page in
+	// the CodePipeline console, and it links to a CodeDeploy status page.
+	ExecutionUrlTemplate *string `locationName:"executionUrlTemplate" min:"1" type:"string"`
+
+	// The URL returned to the CodePipeline console that contains a link to the
+	// page where customers can update or change the configuration of the external
+	// action.
+	RevisionUrlTemplate *string `locationName:"revisionUrlTemplate" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionTypeUrls) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionTypeUrls) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ActionTypeUrls) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ActionTypeUrls"}
+	if s.ConfigurationUrl != nil && len(*s.ConfigurationUrl) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ConfigurationUrl", 1))
+	}
+	if s.EntityUrlTemplate != nil && len(*s.EntityUrlTemplate) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EntityUrlTemplate", 1))
+	}
+	if s.ExecutionUrlTemplate != nil && len(*s.ExecutionUrlTemplate) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ExecutionUrlTemplate", 1))
+	}
+	if s.RevisionUrlTemplate != nil && len(*s.RevisionUrlTemplate) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RevisionUrlTemplate", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetConfigurationUrl sets the ConfigurationUrl field's value.
+func (s *ActionTypeUrls) SetConfigurationUrl(v string) *ActionTypeUrls {
+	s.ConfigurationUrl = &v
+	return s
+}
+
+// SetEntityUrlTemplate sets the EntityUrlTemplate field's value.
+func (s *ActionTypeUrls) SetEntityUrlTemplate(v string) *ActionTypeUrls {
+	s.EntityUrlTemplate = &v
+	return s
+}
+
+// SetExecutionUrlTemplate sets the ExecutionUrlTemplate field's value.
+func (s *ActionTypeUrls) SetExecutionUrlTemplate(v string) *ActionTypeUrls {
+	s.ExecutionUrlTemplate = &v
+	return s
+}
+
+// SetRevisionUrlTemplate sets the RevisionUrlTemplate field's value.
+func (s *ActionTypeUrls) SetRevisionUrlTemplate(v string) *ActionTypeUrls {
+	s.RevisionUrlTemplate = &v
+	return s
+}
+
+// The approval action has already been approved or rejected.
+type ApprovalAlreadyCompletedException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ApprovalAlreadyCompletedException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ApprovalAlreadyCompletedException) GoString() string {
+	return s.String()
+}
+
+func newErrorApprovalAlreadyCompletedException(v protocol.ResponseMetadata) error {
+	return &ApprovalAlreadyCompletedException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ApprovalAlreadyCompletedException) Code() string {
+	return "ApprovalAlreadyCompletedException"
+}
+
+// Message returns the exception's message.
+func (s *ApprovalAlreadyCompletedException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ApprovalAlreadyCompletedException) OrigErr() error {
+	return nil
+}
+
+func (s *ApprovalAlreadyCompletedException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ApprovalAlreadyCompletedException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ApprovalAlreadyCompletedException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Represents information about the result of an approval request.
+type ApprovalResult struct {
+	_ struct{} `type:"structure"`
+
+	// The response submitted by a reviewer assigned to an approval action request.
+	//
 	// Status is a required field
 	Status *string `locationName:"status" type:"string" required:"true" enum:"ApprovalStatus"`
 
-	// The summary of the current status of the approval request.
+	// The summary of the current status of the approval request.
+	//
+	// Summary is a required field
+	Summary *string `locationName:"summary" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ApprovalResult) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ApprovalResult) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ApprovalResult) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ApprovalResult"}
+	if s.Status == nil {
+		invalidParams.Add(request.NewErrParamRequired("Status"))
+	}
+	if s.Summary == nil {
+		invalidParams.Add(request.NewErrParamRequired("Summary"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetStatus sets the Status field's value.
+func (s *ApprovalResult) SetStatus(v string) *ApprovalResult {
+	s.Status = &v
+	return s
+}
+
+// SetSummary sets the Summary field's value.
+func (s *ApprovalResult) SetSummary(v string) *ApprovalResult {
+	s.Summary = &v
+	return s
+}
+
+// Artifacts are the files that are worked on by actions in the pipeline. See
+// the action configuration for each action for details about artifact parameters.
+// For example, the S3 source action artifact is a file name (or file path),
+// and the files are generally provided as a ZIP file. Example artifact name:
+// SampleApp_Windows.zip
+type Artifact struct {
+	_ struct{} `type:"structure"`
+
+	// The location of an artifact.
+	Location *ArtifactLocation `locationName:"location" type:"structure"`
+
+	// The artifact's name.
+	Name *string `locationName:"name" min:"1" type:"string"`
+
+	// The artifact's revision ID. Depending on the type of object, this could be
+	// a commit ID (GitHub) or a revision ID (Amazon S3).
+	Revision *string `locationName:"revision" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Artifact) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Artifact) GoString() string {
+	return s.String()
+}
+
+// SetLocation sets the Location field's value.
+func (s *Artifact) SetLocation(v *ArtifactLocation) *Artifact {
+	s.Location = v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *Artifact) SetName(v string) *Artifact {
+	s.Name = &v
+	return s
+}
+
+// SetRevision sets the Revision field's value.
+func (s *Artifact) SetRevision(v string) *Artifact {
+	s.Revision = &v
+	return s
+}
+
+// Artifact details for the action execution, such as the artifact location.
+type ArtifactDetail struct {
+	_ struct{} `type:"structure"`
+
+	// The artifact object name for the action execution.
+	Name *string `locationName:"name" min:"1" type:"string"`
+
+	// The Amazon S3 artifact location for the action execution.
+	S3location *S3Location `locationName:"s3location" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ArtifactDetail) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ArtifactDetail) GoString() string {
+	return s.String()
+}
+
+// SetName sets the Name field's value.
+func (s *ArtifactDetail) SetName(v string) *ArtifactDetail {
+	s.Name = &v
+	return s
+}
+
+// SetS3location sets the S3location field's value.
+func (s *ArtifactDetail) SetS3location(v *S3Location) *ArtifactDetail {
+	s.S3location = v
+	return s
+}
+
+// Returns information about the details of an artifact.
+type ArtifactDetails struct {
+	_ struct{} `type:"structure"`
+
+	// The maximum number of artifacts allowed for the action type.
+	//
+	// MaximumCount is a required field
+	MaximumCount *int64 `locationName:"maximumCount" type:"integer" required:"true"`
+
+	// The minimum number of artifacts allowed for the action type.
+	//
+	// MinimumCount is a required field
+	MinimumCount *int64 `locationName:"minimumCount" type:"integer" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ArtifactDetails) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ArtifactDetails) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ArtifactDetails) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ArtifactDetails"}
+	if s.MaximumCount == nil {
+		invalidParams.Add(request.NewErrParamRequired("MaximumCount"))
+	}
+	if s.MinimumCount == nil {
+		invalidParams.Add(request.NewErrParamRequired("MinimumCount"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetMaximumCount sets the MaximumCount field's value.
+func (s *ArtifactDetails) SetMaximumCount(v int64) *ArtifactDetails {
+	s.MaximumCount = &v
+	return s
+}
+
+// SetMinimumCount sets the MinimumCount field's value.
+func (s *ArtifactDetails) SetMinimumCount(v int64) *ArtifactDetails {
+	s.MinimumCount = &v
+	return s
+}
+
+// Represents information about the location of an artifact.
+type ArtifactLocation struct {
+	_ struct{} `type:"structure"`
+
+	// The S3 bucket that contains the artifact.
+	S3Location *S3ArtifactLocation `locationName:"s3Location" type:"structure"`
+
+	// The type of artifact in the location.
+	Type *string `locationName:"type" type:"string" enum:"ArtifactLocationType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ArtifactLocation) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ArtifactLocation) GoString() string {
+	return s.String()
+}
+
+// SetS3Location sets the S3Location field's value.
+func (s *ArtifactLocation) SetS3Location(v *S3ArtifactLocation) *ArtifactLocation {
+	s.S3Location = v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *ArtifactLocation) SetType(v string) *ArtifactLocation {
+	s.Type = &v
+	return s
+}
+
+// Represents revision details of an artifact.
+type ArtifactRevision struct {
+	_ struct{} `type:"structure"`
+
+	// The date and time when the most recent revision of the artifact was created,
+	// in timestamp format.
+	Created *time.Time `locationName:"created" type:"timestamp"`
+
+	// The name of an artifact. This name might be system-generated, such as "MyApp",
+	// or defined by the user when an action is created.
+	Name *string `locationName:"name" min:"1" type:"string"`
+
+	// An additional identifier for a revision, such as a commit date or, for artifacts
+	// stored in Amazon S3 buckets, the ETag value.
+	RevisionChangeIdentifier *string `locationName:"revisionChangeIdentifier" min:"1" type:"string"`
+
+	// The revision ID of the artifact.
+	RevisionId *string `locationName:"revisionId" min:"1" type:"string"`
+
+	// Summary information about the most recent revision of the artifact. For GitHub
+	// and CodeCommit repositories, the commit message. For Amazon S3 buckets or
+	// actions, the user-provided content of a codepipeline-artifact-revision-summary
+	// key specified in the object metadata.
+	RevisionSummary *string `locationName:"revisionSummary" min:"1" type:"string"`
+
+	// The commit ID for the artifact revision. For artifacts stored in GitHub or
+	// CodeCommit repositories, the commit ID is linked to a commit details page.
+	RevisionUrl *string `locationName:"revisionUrl" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ArtifactRevision) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ArtifactRevision) GoString() string {
+	return s.String()
+}
+
+// SetCreated sets the Created field's value.
+func (s *ArtifactRevision) SetCreated(v time.Time) *ArtifactRevision {
+	s.Created = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *ArtifactRevision) SetName(v string) *ArtifactRevision {
+	s.Name = &v
+	return s
+}
+
+// SetRevisionChangeIdentifier sets the RevisionChangeIdentifier field's value.
+func (s *ArtifactRevision) SetRevisionChangeIdentifier(v string) *ArtifactRevision {
+	s.RevisionChangeIdentifier = &v
+	return s
+}
+
+// SetRevisionId sets the RevisionId field's value.
+func (s *ArtifactRevision) SetRevisionId(v string) *ArtifactRevision {
+	s.RevisionId = &v
+	return s
+}
+
+// SetRevisionSummary sets the RevisionSummary field's value.
+func (s *ArtifactRevision) SetRevisionSummary(v string) *ArtifactRevision {
+	s.RevisionSummary = &v
+	return s
+}
+
+// SetRevisionUrl sets the RevisionUrl field's value.
+func (s *ArtifactRevision) SetRevisionUrl(v string) *ArtifactRevision {
+	s.RevisionUrl = &v
+	return s
+}
+
+// The S3 bucket where artifacts for the pipeline are stored.
+//
+// You must include either artifactStore or artifactStores in your pipeline,
+// but you cannot use both. If you create a cross-region action in your pipeline,
+// you must use artifactStores.
+type ArtifactStore struct {
+	_ struct{} `type:"structure"`
+
+	// The encryption key used to encrypt the data in the artifact store, such as
+	// an Amazon Web Services Key Management Service key. If this is undefined,
+	// the default key for Amazon S3 is used.
+	EncryptionKey *EncryptionKey `locationName:"encryptionKey" type:"structure"`
+
+	// The S3 bucket used for storing the artifacts for a pipeline. You can specify
+	// the name of an S3 bucket but not a folder in the bucket. A folder to contain
+	// the pipeline artifacts is created for you based on the name of the pipeline.
+	// You can use any S3 bucket in the same Amazon Web Services Region as the pipeline
+	// to store your pipeline artifacts.
+	//
+	// Location is a required field
+	Location *string `locationName:"location" min:"3" type:"string" required:"true"`
+
+	// The type of the artifact store, such as S3.
+	//
+	// Type is a required field
+	Type *string `locationName:"type" type:"string" required:"true" enum:"ArtifactStoreType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ArtifactStore) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ArtifactStore) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ArtifactStore) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ArtifactStore"}
+	if s.Location == nil {
+		invalidParams.Add(request.NewErrParamRequired("Location"))
+	}
+	if s.Location != nil && len(*s.Location) < 3 {
+		invalidParams.Add(request.NewErrParamMinLen("Location", 3))
+	}
+	if s.Type == nil {
+		invalidParams.Add(request.NewErrParamRequired("Type"))
+	}
+	if s.EncryptionKey != nil {
+		if err := s.EncryptionKey.Validate(); err != nil {
+			invalidParams.AddNested("EncryptionKey", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetEncryptionKey sets the EncryptionKey field's value.
+func (s *ArtifactStore) SetEncryptionKey(v *EncryptionKey) *ArtifactStore {
+	s.EncryptionKey = v
+	return s
+}
+
+// SetLocation sets the Location field's value.
+func (s *ArtifactStore) SetLocation(v string) *ArtifactStore {
+	s.Location = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *ArtifactStore) SetType(v string) *ArtifactStore {
+	s.Type = &v
+	return s
+}
+
+// Reserved for future use.
+type BlockerDeclaration struct {
+	_ struct{} `type:"structure"`
+
+	// Reserved for future use.
+	//
+	// Name is a required field
+	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
+
+	// Reserved for future use.
+	//
+	// Type is a required field
+	Type *string `locationName:"type" type:"string" required:"true" enum:"BlockerType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BlockerDeclaration) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BlockerDeclaration) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *BlockerDeclaration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "BlockerDeclaration"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+	if s.Type == nil {
+		invalidParams.Add(request.NewErrParamRequired("Type"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetName sets the Name field's value.
+func (s *BlockerDeclaration) SetName(v string) *BlockerDeclaration {
+	s.Name = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *BlockerDeclaration) SetType(v string) *BlockerDeclaration {
+	s.Type = &v
+	return s
+}
+
+// Unable to modify the tag due to a simultaneous update request.
+type ConcurrentModificationException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConcurrentModificationException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConcurrentModificationException) GoString() string {
+	return s.String()
+}
+
+func newErrorConcurrentModificationException(v protocol.ResponseMetadata) error {
+	return &ConcurrentModificationException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ConcurrentModificationException) Code() string {
+	return "ConcurrentModificationException"
+}
+
+// Message returns the exception's message.
+func (s *ConcurrentModificationException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ConcurrentModificationException) OrigErr() error {
+	return nil
+}
+
+func (s *ConcurrentModificationException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ConcurrentModificationException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ConcurrentModificationException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Your request cannot be handled because the pipeline is busy handling ongoing
+// activities. Try again later.
+type ConflictException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConflictException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConflictException) GoString() string {
+	return s.String()
+}
+
+func newErrorConflictException(v protocol.ResponseMetadata) error {
+	return &ConflictException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ConflictException) Code() string {
+	return "ConflictException"
+}
+
+// Message returns the exception's message.
+func (s *ConflictException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ConflictException) OrigErr() error {
+	return nil
+}
+
+func (s *ConflictException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ConflictException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ConflictException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Represents the input of a CreateCustomActionType operation.
+type CreateCustomActionTypeInput struct {
+	_ struct{} `type:"structure"`
+
+	// The category of the custom action, such as a build action or a test action.
+	//
+	// Category is a required field
+	Category *string `locationName:"category" type:"string" required:"true" enum:"ActionCategory"`
+
+	// The configuration properties for the custom action.
+	//
+	// You can refer to a name in the configuration properties of the custom action
+	// within the URL templates by following the format of {Config:name}, as long
+	// as the configuration property is both required and not secret. For more information,
+	// see Create a Custom Action for a Pipeline (https://docs.aws.amazon.com/codepipeline/latest/userguide/how-to-create-custom-action.html).
+	ConfigurationProperties []*ActionConfigurationProperty `locationName:"configurationProperties" type:"list"`
+
+	// The details of the input artifact for the action, such as its commit ID.
+	//
+	// InputArtifactDetails is a required field
+	InputArtifactDetails *ArtifactDetails `locationName:"inputArtifactDetails" type:"structure" required:"true"`
+
+	// The details of the output artifact of the action, such as its commit ID.
+	//
+	// OutputArtifactDetails is a required field
+	OutputArtifactDetails *ArtifactDetails `locationName:"outputArtifactDetails" type:"structure" required:"true"`
+
+	// The provider of the service used in the custom action, such as CodeDeploy.
+	//
+	// Provider is a required field
+	Provider *string `locationName:"provider" min:"1" type:"string" required:"true"`
+
+	// URLs that provide users information about this custom action.
+	Settings *ActionTypeSettings `locationName:"settings" type:"structure"`
+
+	// The tags for the custom action.
+	Tags []*Tag `locationName:"tags" type:"list"`
+
+	// The version identifier of the custom action.
+	//
+	// Version is a required field
+	Version *string `locationName:"version" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateCustomActionTypeInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateCustomActionTypeInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateCustomActionTypeInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateCustomActionTypeInput"}
+	if s.Category == nil {
+		invalidParams.Add(request.NewErrParamRequired("Category"))
+	}
+	if s.InputArtifactDetails == nil {
+		invalidParams.Add(request.NewErrParamRequired("InputArtifactDetails"))
+	}
+	if s.OutputArtifactDetails == nil {
+		invalidParams.Add(request.NewErrParamRequired("OutputArtifactDetails"))
+	}
+	if s.Provider == nil {
+		invalidParams.Add(request.NewErrParamRequired("Provider"))
+	}
+	if s.Provider != nil && len(*s.Provider) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Provider", 1))
+	}
+	if s.Version == nil {
+		invalidParams.Add(request.NewErrParamRequired("Version"))
+	}
+	if s.Version != nil && len(*s.Version) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Version", 1))
+	}
+	if s.ConfigurationProperties != nil {
+		for i, v := range s.ConfigurationProperties {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ConfigurationProperties", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.InputArtifactDetails != nil {
+		if err := s.InputArtifactDetails.Validate(); err != nil {
+			invalidParams.AddNested("InputArtifactDetails", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.OutputArtifactDetails != nil {
+		if err := s.OutputArtifactDetails.Validate(); err != nil {
+			invalidParams.AddNested("OutputArtifactDetails", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Settings != nil {
+		if err := s.Settings.Validate(); err != nil {
+			invalidParams.AddNested("Settings", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCategory sets the Category field's value.
+func (s *CreateCustomActionTypeInput) SetCategory(v string) *CreateCustomActionTypeInput {
+	s.Category = &v
+	return s
+}
+
+// SetConfigurationProperties sets the ConfigurationProperties field's value.
+func (s *CreateCustomActionTypeInput) SetConfigurationProperties(v []*ActionConfigurationProperty) *CreateCustomActionTypeInput {
+	s.ConfigurationProperties = v
+	return s
+}
+
+// SetInputArtifactDetails sets the InputArtifactDetails field's value.
+func (s *CreateCustomActionTypeInput) SetInputArtifactDetails(v *ArtifactDetails) *CreateCustomActionTypeInput {
+	s.InputArtifactDetails = v
+	return s
+}
+
+// SetOutputArtifactDetails sets the OutputArtifactDetails field's value.
+func (s *CreateCustomActionTypeInput) SetOutputArtifactDetails(v *ArtifactDetails) *CreateCustomActionTypeInput {
+	s.OutputArtifactDetails = v
+	return s
+}
+
+// SetProvider sets the Provider field's value.
+func (s *CreateCustomActionTypeInput) SetProvider(v string) *CreateCustomActionTypeInput {
+	s.Provider = &v
+	return s
+}
+
+// SetSettings sets the Settings field's value.
+func (s *CreateCustomActionTypeInput) SetSettings(v *ActionTypeSettings) *CreateCustomActionTypeInput {
+	s.Settings = v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *CreateCustomActionTypeInput) SetTags(v []*Tag) *CreateCustomActionTypeInput {
+	s.Tags = v
+	return s
+}
+
+// SetVersion sets the Version field's value.
+func (s *CreateCustomActionTypeInput) SetVersion(v string) *CreateCustomActionTypeInput {
+	s.Version = &v
+	return s
+}
+
+// Represents the output of a CreateCustomActionType operation.
+type CreateCustomActionTypeOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Returns information about the details of an action type.
+	//
+	// ActionType is a required field
+	ActionType *ActionType `locationName:"actionType" type:"structure" required:"true"`
+
+	// Specifies the tags applied to the custom action.
+	Tags []*Tag `locationName:"tags" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateCustomActionTypeOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateCustomActionTypeOutput) GoString() string {
+	return s.String()
+}
+
+// SetActionType sets the ActionType field's value.
+func (s *CreateCustomActionTypeOutput) SetActionType(v *ActionType) *CreateCustomActionTypeOutput {
+	s.ActionType = v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *CreateCustomActionTypeOutput) SetTags(v []*Tag) *CreateCustomActionTypeOutput {
+	s.Tags = v
+	return s
+}
+
+// Represents the input of a CreatePipeline action.
+type CreatePipelineInput struct {
+	_ struct{} `type:"structure"`
+
+	// Represents the structure of actions and stages to be performed in the pipeline.
+	//
+	// Pipeline is a required field
+	Pipeline *PipelineDeclaration `locationName:"pipeline" type:"structure" required:"true"`
+
+	// The tags for the pipeline.
+	Tags []*Tag `locationName:"tags" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreatePipelineInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreatePipelineInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreatePipelineInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatePipelineInput"}
+	if s.Pipeline == nil {
+		invalidParams.Add(request.NewErrParamRequired("Pipeline"))
+	}
+	if s.Pipeline != nil {
+		if err := s.Pipeline.Validate(); err != nil {
+			invalidParams.AddNested("Pipeline", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetPipeline sets the Pipeline field's value.
+func (s *CreatePipelineInput) SetPipeline(v *PipelineDeclaration) *CreatePipelineInput {
+	s.Pipeline = v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *CreatePipelineInput) SetTags(v []*Tag) *CreatePipelineInput {
+	s.Tags = v
+	return s
+}
+
+// Represents the output of a CreatePipeline action.
+type CreatePipelineOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Represents the structure of actions and stages to be performed in the pipeline.
+	Pipeline *PipelineDeclaration `locationName:"pipeline" type:"structure"`
+
+	// Specifies the tags applied to the pipeline.
+	Tags []*Tag `locationName:"tags" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreatePipelineOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreatePipelineOutput) GoString() string {
+	return s.String()
+}
+
+// SetPipeline sets the Pipeline field's value.
+func (s *CreatePipelineOutput) SetPipeline(v *PipelineDeclaration) *CreatePipelineOutput {
+	s.Pipeline = v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *CreatePipelineOutput) SetTags(v []*Tag) *CreatePipelineOutput {
+	s.Tags = v
+	return s
+}
+
+// Represents information about a current revision.
+type CurrentRevision struct {
+	_ struct{} `type:"structure"`
+
+	// The change identifier for the current revision.
+	//
+	// ChangeIdentifier is a required field
+	ChangeIdentifier *string `locationName:"changeIdentifier" min:"1" type:"string" required:"true"`
+
+	// The date and time when the most recent revision of the artifact was created,
+	// in timestamp format.
+	Created *time.Time `locationName:"created" type:"timestamp"`
+
+	// The revision ID of the current version of an artifact.
+	//
+	// Revision is a required field
+	Revision *string `locationName:"revision" min:"1" type:"string" required:"true"`
+
+	// The summary of the most recent revision of the artifact.
+	RevisionSummary *string `locationName:"revisionSummary" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CurrentRevision) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CurrentRevision) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CurrentRevision) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CurrentRevision"}
+	if s.ChangeIdentifier == nil {
+		invalidParams.Add(request.NewErrParamRequired("ChangeIdentifier"))
+	}
+	if s.ChangeIdentifier != nil && len(*s.ChangeIdentifier) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ChangeIdentifier", 1))
+	}
+	if s.Revision == nil {
+		invalidParams.Add(request.NewErrParamRequired("Revision"))
+	}
+	if s.Revision != nil && len(*s.Revision) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Revision", 1))
+	}
+	if s.RevisionSummary != nil && len(*s.RevisionSummary) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RevisionSummary", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetChangeIdentifier sets the ChangeIdentifier field's value.
+func (s *CurrentRevision) SetChangeIdentifier(v string) *CurrentRevision {
+	s.ChangeIdentifier = &v
+	return s
+}
+
+// SetCreated sets the Created field's value.
+func (s *CurrentRevision) SetCreated(v time.Time) *CurrentRevision {
+	s.Created = &v
+	return s
+}
+
+// SetRevision sets the Revision field's value.
+func (s *CurrentRevision) SetRevision(v string) *CurrentRevision {
+	s.Revision = &v
+	return s
+}
+
+// SetRevisionSummary sets the RevisionSummary field's value.
+func (s *CurrentRevision) SetRevisionSummary(v string) *CurrentRevision {
+	s.RevisionSummary = &v
+	return s
+}
+
+// Represents the input of a DeleteCustomActionType operation. The custom action
+// will be marked as deleted.
+type DeleteCustomActionTypeInput struct {
+	_ struct{} `type:"structure"`
+
+	// The category of the custom action that you want to delete, such as source
+	// or deploy.
+	//
+	// Category is a required field
+	Category *string `locationName:"category" type:"string" required:"true" enum:"ActionCategory"`
+
+	// The provider of the service used in the custom action, such as CodeDeploy.
+	//
+	// Provider is a required field
+	Provider *string `locationName:"provider" min:"1" type:"string" required:"true"`
+
+	// The version of the custom action to delete.
+	//
+	// Version is a required field
+	Version *string `locationName:"version" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteCustomActionTypeInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteCustomActionTypeInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteCustomActionTypeInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteCustomActionTypeInput"}
+	if s.Category == nil {
+		invalidParams.Add(request.NewErrParamRequired("Category"))
+	}
+	if s.Provider == nil {
+		invalidParams.Add(request.NewErrParamRequired("Provider"))
+	}
+	if s.Provider != nil && len(*s.Provider) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Provider", 1))
+	}
+	if s.Version == nil {
+		invalidParams.Add(request.NewErrParamRequired("Version"))
+	}
+	if s.Version != nil && len(*s.Version) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Version", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCategory sets the Category field's value.
+func (s *DeleteCustomActionTypeInput) SetCategory(v string) *DeleteCustomActionTypeInput {
+	s.Category = &v
+	return s
+}
+
+// SetProvider sets the Provider field's value.
+func (s *DeleteCustomActionTypeInput) SetProvider(v string) *DeleteCustomActionTypeInput {
+	s.Provider = &v
+	return s
+}
+
+// SetVersion sets the Version field's value.
+func (s *DeleteCustomActionTypeInput) SetVersion(v string) *DeleteCustomActionTypeInput {
+	s.Version = &v
+	return s
+}
+
+type DeleteCustomActionTypeOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteCustomActionTypeOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteCustomActionTypeOutput) GoString() string {
+	return s.String()
+}
+
+// Represents the input of a DeletePipeline action.
+type DeletePipelineInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the pipeline to be deleted.
+	//
+	// Name is a required field
+	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeletePipelineInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeletePipelineInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeletePipelineInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeletePipelineInput"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetName sets the Name field's value.
+func (s *DeletePipelineInput) SetName(v string) *DeletePipelineInput {
+	s.Name = &v
+	return s
+}
+
+type DeletePipelineOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeletePipelineOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeletePipelineOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteWebhookInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the webhook you want to delete.
+	//
+	// Name is a required field
+	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteWebhookInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteWebhookInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteWebhookInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteWebhookInput"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetName sets the Name field's value.
+func (s *DeleteWebhookInput) SetName(v string) *DeleteWebhookInput {
+	s.Name = &v
+	return s
+}
+
+type DeleteWebhookOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteWebhookOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteWebhookOutput) GoString() string {
+	return s.String()
+}
+
+type DeregisterWebhookWithThirdPartyInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the webhook you want to deregister.
+	WebhookName *string `locationName:"webhookName" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeregisterWebhookWithThirdPartyInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeregisterWebhookWithThirdPartyInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeregisterWebhookWithThirdPartyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeregisterWebhookWithThirdPartyInput"}
+	if s.WebhookName != nil && len(*s.WebhookName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("WebhookName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetWebhookName sets the WebhookName field's value.
+func (s *DeregisterWebhookWithThirdPartyInput) SetWebhookName(v string) *DeregisterWebhookWithThirdPartyInput {
+	s.WebhookName = &v
+	return s
+}
+
+type DeregisterWebhookWithThirdPartyOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeregisterWebhookWithThirdPartyOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeregisterWebhookWithThirdPartyOutput) GoString() string {
+	return s.String()
+}
+
+// Represents the input of a DisableStageTransition action.
+type DisableStageTransitionInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the pipeline in which you want to disable the flow of artifacts
+	// from one stage to another.
 	//
-	// Summary is a required field
-	Summary *string `locationName:"summary" type:"string" required:"true"`
+	// PipelineName is a required field
+	PipelineName *string `locationName:"pipelineName" min:"1" type:"string" required:"true"`
+
+	// The reason given to the user that a stage is disabled, such as waiting for
+	// manual approval or manual tests. This message is displayed in the pipeline
+	// console UI.
+	//
+	// Reason is a required field
+	Reason *string `locationName:"reason" min:"1" type:"string" required:"true"`
+
+	// The name of the stage where you want to disable the inbound or outbound transition
+	// of artifacts.
+	//
+	// StageName is a required field
+	StageName *string `locationName:"stageName" min:"1" type:"string" required:"true"`
+
+	// Specifies whether artifacts are prevented from transitioning into the stage
+	// and being processed by the actions in that stage (inbound), or prevented
+	// from transitioning from the stage after they have been processed by the actions
+	// in that stage (outbound).
+	//
+	// TransitionType is a required field
+	TransitionType *string `locationName:"transitionType" type:"string" required:"true" enum:"StageTransitionType"`
 }
 
-// String returns the string representation
-func (s ApprovalResult) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableStageTransitionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ApprovalResult) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableStageTransitionInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ApprovalResult) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ApprovalResult"}
-	if s.Status == nil {
-		invalidParams.Add(request.NewErrParamRequired("Status"))
+func (s *DisableStageTransitionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisableStageTransitionInput"}
+	if s.PipelineName == nil {
+		invalidParams.Add(request.NewErrParamRequired("PipelineName"))
 	}
-	if s.Summary == nil {
-		invalidParams.Add(request.NewErrParamRequired("Summary"))
+	if s.PipelineName != nil && len(*s.PipelineName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("PipelineName", 1))
+	}
+	if s.Reason == nil {
+		invalidParams.Add(request.NewErrParamRequired("Reason"))
+	}
+	if s.Reason != nil && len(*s.Reason) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Reason", 1))
+	}
+	if s.StageName == nil {
+		invalidParams.Add(request.NewErrParamRequired("StageName"))
+	}
+	if s.StageName != nil && len(*s.StageName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("StageName", 1))
+	}
+	if s.TransitionType == nil {
+		invalidParams.Add(request.NewErrParamRequired("TransitionType"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -4947,128 +7784,281 @@ func (s *ApprovalResult) Validate() error {
 	return nil
 }
 
-// SetStatus sets the Status field's value.
-func (s *ApprovalResult) SetStatus(v string) *ApprovalResult {
-	s.Status = &v
+// SetPipelineName sets the PipelineName field's value.
+func (s *DisableStageTransitionInput) SetPipelineName(v string) *DisableStageTransitionInput {
+	s.PipelineName = &v
 	return s
 }
 
-// SetSummary sets the Summary field's value.
-func (s *ApprovalResult) SetSummary(v string) *ApprovalResult {
-	s.Summary = &v
+// SetReason sets the Reason field's value.
+func (s *DisableStageTransitionInput) SetReason(v string) *DisableStageTransitionInput {
+	s.Reason = &v
 	return s
 }
 
-// Represents information about an artifact that is worked on by actions in
-// the pipeline.
-type Artifact struct {
+// SetStageName sets the StageName field's value.
+func (s *DisableStageTransitionInput) SetStageName(v string) *DisableStageTransitionInput {
+	s.StageName = &v
+	return s
+}
+
+// SetTransitionType sets the TransitionType field's value.
+func (s *DisableStageTransitionInput) SetTransitionType(v string) *DisableStageTransitionInput {
+	s.TransitionType = &v
+	return s
+}
+
+type DisableStageTransitionOutput struct {
 	_ struct{} `type:"structure"`
+}
 
-	// The location of an artifact.
-	Location *ArtifactLocation `locationName:"location" type:"structure"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableStageTransitionOutput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// The artifact's name.
-	Name *string `locationName:"name" min:"1" type:"string"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableStageTransitionOutput) GoString() string {
+	return s.String()
+}
 
-	// The artifact's revision ID. Depending on the type of object, this could be
-	// a commit ID (GitHub) or a revision ID (Amazon S3).
-	Revision *string `locationName:"revision" min:"1" type:"string"`
+// The pipeline execution is already in a Stopping state. If you already chose
+// to stop and wait, you cannot make that request again. You can choose to stop
+// and abandon now, but be aware that this option can lead to failed tasks or
+// out of sequence tasks. If you already chose to stop and abandon, you cannot
+// make that request again.
+type DuplicatedStopRequestException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s Artifact) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DuplicatedStopRequestException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Artifact) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DuplicatedStopRequestException) GoString() string {
 	return s.String()
 }
 
-// SetLocation sets the Location field's value.
-func (s *Artifact) SetLocation(v *ArtifactLocation) *Artifact {
-	s.Location = v
+func newErrorDuplicatedStopRequestException(v protocol.ResponseMetadata) error {
+	return &DuplicatedStopRequestException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *DuplicatedStopRequestException) Code() string {
+	return "DuplicatedStopRequestException"
+}
+
+// Message returns the exception's message.
+func (s *DuplicatedStopRequestException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *DuplicatedStopRequestException) OrigErr() error {
+	return nil
+}
+
+func (s *DuplicatedStopRequestException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *DuplicatedStopRequestException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *DuplicatedStopRequestException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Represents the input of an EnableStageTransition action.
+type EnableStageTransitionInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the pipeline in which you want to enable the flow of artifacts
+	// from one stage to another.
+	//
+	// PipelineName is a required field
+	PipelineName *string `locationName:"pipelineName" min:"1" type:"string" required:"true"`
+
+	// The name of the stage where you want to enable the transition of artifacts,
+	// either into the stage (inbound) or from that stage to the next stage (outbound).
+	//
+	// StageName is a required field
+	StageName *string `locationName:"stageName" min:"1" type:"string" required:"true"`
+
+	// Specifies whether artifacts are allowed to enter the stage and be processed
+	// by the actions in that stage (inbound) or whether already processed artifacts
+	// are allowed to transition to the next stage (outbound).
+	//
+	// TransitionType is a required field
+	TransitionType *string `locationName:"transitionType" type:"string" required:"true" enum:"StageTransitionType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableStageTransitionInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableStageTransitionInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *EnableStageTransitionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnableStageTransitionInput"}
+	if s.PipelineName == nil {
+		invalidParams.Add(request.NewErrParamRequired("PipelineName"))
+	}
+	if s.PipelineName != nil && len(*s.PipelineName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("PipelineName", 1))
+	}
+	if s.StageName == nil {
+		invalidParams.Add(request.NewErrParamRequired("StageName"))
+	}
+	if s.StageName != nil && len(*s.StageName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("StageName", 1))
+	}
+	if s.TransitionType == nil {
+		invalidParams.Add(request.NewErrParamRequired("TransitionType"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetPipelineName sets the PipelineName field's value.
+func (s *EnableStageTransitionInput) SetPipelineName(v string) *EnableStageTransitionInput {
+	s.PipelineName = &v
 	return s
 }
 
-// SetName sets the Name field's value.
-func (s *Artifact) SetName(v string) *Artifact {
-	s.Name = &v
+// SetStageName sets the StageName field's value.
+func (s *EnableStageTransitionInput) SetStageName(v string) *EnableStageTransitionInput {
+	s.StageName = &v
 	return s
 }
 
-// SetRevision sets the Revision field's value.
-func (s *Artifact) SetRevision(v string) *Artifact {
-	s.Revision = &v
+// SetTransitionType sets the TransitionType field's value.
+func (s *EnableStageTransitionInput) SetTransitionType(v string) *EnableStageTransitionInput {
+	s.TransitionType = &v
 	return s
 }
 
-// Artifact details for the action execution, such as the artifact location.
-type ArtifactDetail struct {
+type EnableStageTransitionOutput struct {
 	_ struct{} `type:"structure"`
-
-	// The artifact object name for the action execution.
-	Name *string `locationName:"name" min:"1" type:"string"`
-
-	// The Amazon S3 artifact location for the action execution.
-	S3location *S3Location `locationName:"s3location" type:"structure"`
 }
 
-// String returns the string representation
-func (s ArtifactDetail) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableStageTransitionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ArtifactDetail) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableStageTransitionOutput) GoString() string {
 	return s.String()
 }
 
-// SetName sets the Name field's value.
-func (s *ArtifactDetail) SetName(v string) *ArtifactDetail {
-	s.Name = &v
-	return s
-}
-
-// SetS3location sets the S3location field's value.
-func (s *ArtifactDetail) SetS3location(v *S3Location) *ArtifactDetail {
-	s.S3location = v
-	return s
-}
-
-// Returns information about the details of an artifact.
-type ArtifactDetails struct {
+// Represents information about the key used to encrypt data in the artifact
+// store, such as an Amazon Web Services Key Management Service (Key Management
+// Service) key.
+type EncryptionKey struct {
 	_ struct{} `type:"structure"`
 
-	// The maximum number of artifacts allowed for the action type.
+	// The ID used to identify the key. For an Amazon Web Services KMS key, you
+	// can use the key ID, the key ARN, or the alias ARN.
 	//
-	// MaximumCount is a required field
-	MaximumCount *int64 `locationName:"maximumCount" type:"integer" required:"true"`
+	// Aliases are recognized only in the account that created the KMS key. For
+	// cross-account actions, you can only use the key ID or key ARN to identify
+	// the key. Cross-account actions involve using the role from the other account
+	// (AccountB), so specifying the key ID will use the key from the other account
+	// (AccountB).
+	//
+	// Id is a required field
+	Id *string `locationName:"id" min:"1" type:"string" required:"true"`
 
-	// The minimum number of artifacts allowed for the action type.
+	// The type of encryption key, such as an Amazon Web Services KMS key. When
+	// creating or updating a pipeline, the value must be set to 'KMS'.
 	//
-	// MinimumCount is a required field
-	MinimumCount *int64 `locationName:"minimumCount" type:"integer" required:"true"`
+	// Type is a required field
+	Type *string `locationName:"type" type:"string" required:"true" enum:"EncryptionKeyType"`
 }
 
-// String returns the string representation
-func (s ArtifactDetails) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EncryptionKey) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ArtifactDetails) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EncryptionKey) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ArtifactDetails) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ArtifactDetails"}
-	if s.MaximumCount == nil {
-		invalidParams.Add(request.NewErrParamRequired("MaximumCount"))
+func (s *EncryptionKey) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "EncryptionKey"}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
 	}
-	if s.MinimumCount == nil {
-		invalidParams.Add(request.NewErrParamRequired("MinimumCount"))
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
+	}
+	if s.Type == nil {
+		invalidParams.Add(request.NewErrParamRequired("Type"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5077,181 +8067,214 @@ func (s *ArtifactDetails) Validate() error {
 	return nil
 }
 
-// SetMaximumCount sets the MaximumCount field's value.
-func (s *ArtifactDetails) SetMaximumCount(v int64) *ArtifactDetails {
-	s.MaximumCount = &v
+// SetId sets the Id field's value.
+func (s *EncryptionKey) SetId(v string) *EncryptionKey {
+	s.Id = &v
 	return s
 }
 
-// SetMinimumCount sets the MinimumCount field's value.
-func (s *ArtifactDetails) SetMinimumCount(v int64) *ArtifactDetails {
-	s.MinimumCount = &v
+// SetType sets the Type field's value.
+func (s *EncryptionKey) SetType(v string) *EncryptionKey {
+	s.Type = &v
 	return s
 }
 
-// Represents information about the location of an artifact.
-type ArtifactLocation struct {
+// Represents information about an error in CodePipeline.
+type ErrorDetails struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon S3 bucket that contains the artifact.
-	S3Location *S3ArtifactLocation `locationName:"s3Location" type:"structure"`
+	// The system ID or number code of the error.
+	Code *string `locationName:"code" type:"string"`
 
-	// The type of artifact in the location.
-	Type *string `locationName:"type" type:"string" enum:"ArtifactLocationType"`
+	// The text of the error message.
+	Message *string `locationName:"message" min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s ArtifactLocation) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ErrorDetails) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ArtifactLocation) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ErrorDetails) GoString() string {
 	return s.String()
 }
 
-// SetS3Location sets the S3Location field's value.
-func (s *ArtifactLocation) SetS3Location(v *S3ArtifactLocation) *ArtifactLocation {
-	s.S3Location = v
+// SetCode sets the Code field's value.
+func (s *ErrorDetails) SetCode(v string) *ErrorDetails {
+	s.Code = &v
 	return s
 }
 
-// SetType sets the Type field's value.
-func (s *ArtifactLocation) SetType(v string) *ArtifactLocation {
-	s.Type = &v
+// SetMessage sets the Message field's value.
+func (s *ErrorDetails) SetMessage(v string) *ErrorDetails {
+	s.Message = &v
 	return s
 }
 
-// Represents revision details of an artifact.
-type ArtifactRevision struct {
+// The details of the actions taken and results produced on an artifact as it
+// passes through stages in the pipeline.
+type ExecutionDetails struct {
 	_ struct{} `type:"structure"`
 
-	// The date and time when the most recent revision of the artifact was created,
-	// in timestamp format.
-	Created *time.Time `locationName:"created" type:"timestamp"`
-
-	// The name of an artifact. This name might be system-generated, such as "MyApp",
-	// or defined by the user when an action is created.
-	Name *string `locationName:"name" min:"1" type:"string"`
-
-	// An additional identifier for a revision, such as a commit date or, for artifacts
-	// stored in Amazon S3 buckets, the ETag value.
-	RevisionChangeIdentifier *string `locationName:"revisionChangeIdentifier" min:"1" type:"string"`
-
-	// The revision ID of the artifact.
-	RevisionId *string `locationName:"revisionId" min:"1" type:"string"`
+	// The system-generated unique ID of this action used to identify this job worker
+	// in any external systems, such as CodeDeploy.
+	ExternalExecutionId *string `locationName:"externalExecutionId" min:"1" type:"string"`
 
-	// Summary information about the most recent revision of the artifact. For GitHub
-	// and AWS CodeCommit repositories, the commit message. For Amazon S3 buckets
-	// or actions, the user-provided content of a codepipeline-artifact-revision-summary
-	// key specified in the object metadata.
-	RevisionSummary *string `locationName:"revisionSummary" min:"1" type:"string"`
+	// The percentage of work completed on the action, represented on a scale of
+	// 0 to 100 percent.
+	PercentComplete *int64 `locationName:"percentComplete" type:"integer"`
 
-	// The commit ID for the artifact revision. For artifacts stored in GitHub or
-	// AWS CodeCommit repositories, the commit ID is linked to a commit details
-	// page.
-	RevisionUrl *string `locationName:"revisionUrl" min:"1" type:"string"`
+	// The summary of the current status of the actions.
+	Summary *string `locationName:"summary" min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s ArtifactRevision) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ExecutionDetails) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ArtifactRevision) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ExecutionDetails) GoString() string {
 	return s.String()
 }
 
-// SetCreated sets the Created field's value.
-func (s *ArtifactRevision) SetCreated(v time.Time) *ArtifactRevision {
-	s.Created = &v
-	return s
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ExecutionDetails) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ExecutionDetails"}
+	if s.ExternalExecutionId != nil && len(*s.ExternalExecutionId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ExternalExecutionId", 1))
+	}
+	if s.Summary != nil && len(*s.Summary) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Summary", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetName sets the Name field's value.
-func (s *ArtifactRevision) SetName(v string) *ArtifactRevision {
-	s.Name = &v
+// SetExternalExecutionId sets the ExternalExecutionId field's value.
+func (s *ExecutionDetails) SetExternalExecutionId(v string) *ExecutionDetails {
+	s.ExternalExecutionId = &v
 	return s
 }
 
-// SetRevisionChangeIdentifier sets the RevisionChangeIdentifier field's value.
-func (s *ArtifactRevision) SetRevisionChangeIdentifier(v string) *ArtifactRevision {
-	s.RevisionChangeIdentifier = &v
+// SetPercentComplete sets the PercentComplete field's value.
+func (s *ExecutionDetails) SetPercentComplete(v int64) *ExecutionDetails {
+	s.PercentComplete = &v
 	return s
 }
 
-// SetRevisionId sets the RevisionId field's value.
-func (s *ArtifactRevision) SetRevisionId(v string) *ArtifactRevision {
-	s.RevisionId = &v
+// SetSummary sets the Summary field's value.
+func (s *ExecutionDetails) SetSummary(v string) *ExecutionDetails {
+	s.Summary = &v
 	return s
 }
 
-// SetRevisionSummary sets the RevisionSummary field's value.
-func (s *ArtifactRevision) SetRevisionSummary(v string) *ArtifactRevision {
-	s.RevisionSummary = &v
+// The interaction or event that started a pipeline execution.
+type ExecutionTrigger struct {
+	_ struct{} `type:"structure"`
+
+	// Detail related to the event that started a pipeline execution, such as the
+	// webhook ARN of the webhook that triggered the pipeline execution or the user
+	// ARN for a user-initiated start-pipeline-execution CLI command.
+	TriggerDetail *string `locationName:"triggerDetail" type:"string"`
+
+	// The type of change-detection method, command, or user interaction that started
+	// a pipeline execution.
+	TriggerType *string `locationName:"triggerType" type:"string" enum:"TriggerType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ExecutionTrigger) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ExecutionTrigger) GoString() string {
+	return s.String()
+}
+
+// SetTriggerDetail sets the TriggerDetail field's value.
+func (s *ExecutionTrigger) SetTriggerDetail(v string) *ExecutionTrigger {
+	s.TriggerDetail = &v
 	return s
 }
 
-// SetRevisionUrl sets the RevisionUrl field's value.
-func (s *ArtifactRevision) SetRevisionUrl(v string) *ArtifactRevision {
-	s.RevisionUrl = &v
+// SetTriggerType sets the TriggerType field's value.
+func (s *ExecutionTrigger) SetTriggerType(v string) *ExecutionTrigger {
+	s.TriggerType = &v
 	return s
 }
 
-// The Amazon S3 bucket where artifacts for the pipeline are stored.
-//
-// You must include either artifactStore or artifactStores in your pipeline,
-// but you cannot use both. If you create a cross-region action in your pipeline,
-// you must use artifactStores.
-type ArtifactStore struct {
+// The action engine, or executor, related to the supported integration model
+// used to create and update the action type. The available executor types are
+// Lambda and JobWorker.
+type ExecutorConfiguration struct {
 	_ struct{} `type:"structure"`
 
-	// The encryption key used to encrypt the data in the artifact store, such as
-	// an AWS Key Management Service (AWS KMS) key. If this is undefined, the default
-	// key for Amazon S3 is used.
-	EncryptionKey *EncryptionKey `locationName:"encryptionKey" type:"structure"`
-
-	// The Amazon S3 bucket used for storing the artifacts for a pipeline. You can
-	// specify the name of an S3 bucket but not a folder in the bucket. A folder
-	// to contain the pipeline artifacts is created for you based on the name of
-	// the pipeline. You can use any Amazon S3 bucket in the same AWS Region as
-	// the pipeline to store your pipeline artifacts.
-	//
-	// Location is a required field
-	Location *string `locationName:"location" min:"3" type:"string" required:"true"`
+	// Details about the JobWorker executor of the action type.
+	JobWorkerExecutorConfiguration *JobWorkerExecutorConfiguration `locationName:"jobWorkerExecutorConfiguration" type:"structure"`
 
-	// The type of the artifact store, such as S3.
-	//
-	// Type is a required field
-	Type *string `locationName:"type" type:"string" required:"true" enum:"ArtifactStoreType"`
+	// Details about the Lambda executor of the action type.
+	LambdaExecutorConfiguration *LambdaExecutorConfiguration `locationName:"lambdaExecutorConfiguration" type:"structure"`
 }
 
-// String returns the string representation
-func (s ArtifactStore) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ExecutorConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ArtifactStore) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ExecutorConfiguration) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ArtifactStore) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ArtifactStore"}
-	if s.Location == nil {
-		invalidParams.Add(request.NewErrParamRequired("Location"))
-	}
-	if s.Location != nil && len(*s.Location) < 3 {
-		invalidParams.Add(request.NewErrParamMinLen("Location", 3))
-	}
-	if s.Type == nil {
-		invalidParams.Add(request.NewErrParamRequired("Type"))
+func (s *ExecutorConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ExecutorConfiguration"}
+	if s.JobWorkerExecutorConfiguration != nil {
+		if err := s.JobWorkerExecutorConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("JobWorkerExecutorConfiguration", err.(request.ErrInvalidParams))
+		}
 	}
-	if s.EncryptionKey != nil {
-		if err := s.EncryptionKey.Validate(); err != nil {
-			invalidParams.AddNested("EncryptionKey", err.(request.ErrInvalidParams))
+	if s.LambdaExecutorConfiguration != nil {
+		if err := s.LambdaExecutorConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("LambdaExecutorConfiguration", err.(request.ErrInvalidParams))
 		}
 	}
 
@@ -5261,57 +8284,65 @@ func (s *ArtifactStore) Validate() error {
 	return nil
 }
 
-// SetEncryptionKey sets the EncryptionKey field's value.
-func (s *ArtifactStore) SetEncryptionKey(v *EncryptionKey) *ArtifactStore {
-	s.EncryptionKey = v
-	return s
-}
-
-// SetLocation sets the Location field's value.
-func (s *ArtifactStore) SetLocation(v string) *ArtifactStore {
-	s.Location = &v
+// SetJobWorkerExecutorConfiguration sets the JobWorkerExecutorConfiguration field's value.
+func (s *ExecutorConfiguration) SetJobWorkerExecutorConfiguration(v *JobWorkerExecutorConfiguration) *ExecutorConfiguration {
+	s.JobWorkerExecutorConfiguration = v
 	return s
 }
 
-// SetType sets the Type field's value.
-func (s *ArtifactStore) SetType(v string) *ArtifactStore {
-	s.Type = &v
+// SetLambdaExecutorConfiguration sets the LambdaExecutorConfiguration field's value.
+func (s *ExecutorConfiguration) SetLambdaExecutorConfiguration(v *LambdaExecutorConfiguration) *ExecutorConfiguration {
+	s.LambdaExecutorConfiguration = v
 	return s
 }
 
-// Reserved for future use.
-type BlockerDeclaration struct {
+// Represents information about failure details.
+type FailureDetails struct {
 	_ struct{} `type:"structure"`
 
-	// Reserved for future use.
+	// The external ID of the run of the action that failed.
+	ExternalExecutionId *string `locationName:"externalExecutionId" min:"1" type:"string"`
+
+	// The message about the failure.
 	//
-	// Name is a required field
-	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
+	// Message is a required field
+	Message *string `locationName:"message" min:"1" type:"string" required:"true"`
 
-	// Reserved for future use.
+	// The type of the failure.
 	//
 	// Type is a required field
-	Type *string `locationName:"type" type:"string" required:"true" enum:"BlockerType"`
+	Type *string `locationName:"type" type:"string" required:"true" enum:"FailureType"`
 }
 
-// String returns the string representation
-func (s BlockerDeclaration) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s FailureDetails) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s BlockerDeclaration) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s FailureDetails) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *BlockerDeclaration) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "BlockerDeclaration"}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
+func (s *FailureDetails) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "FailureDetails"}
+	if s.ExternalExecutionId != nil && len(*s.ExternalExecutionId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ExternalExecutionId", 1))
 	}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	if s.Message == nil {
+		invalidParams.Add(request.NewErrParamRequired("Message"))
+	}
+	if s.Message != nil && len(*s.Message) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Message", 1))
 	}
 	if s.Type == nil {
 		invalidParams.Add(request.NewErrParamRequired("Type"))
@@ -5323,86 +8354,89 @@ func (s *BlockerDeclaration) Validate() error {
 	return nil
 }
 
-// SetName sets the Name field's value.
-func (s *BlockerDeclaration) SetName(v string) *BlockerDeclaration {
-	s.Name = &v
+// SetExternalExecutionId sets the ExternalExecutionId field's value.
+func (s *FailureDetails) SetExternalExecutionId(v string) *FailureDetails {
+	s.ExternalExecutionId = &v
+	return s
+}
+
+// SetMessage sets the Message field's value.
+func (s *FailureDetails) SetMessage(v string) *FailureDetails {
+	s.Message = &v
 	return s
 }
 
 // SetType sets the Type field's value.
-func (s *BlockerDeclaration) SetType(v string) *BlockerDeclaration {
+func (s *FailureDetails) SetType(v string) *FailureDetails {
 	s.Type = &v
 	return s
 }
 
-// Represents the input of a CreateCustomActionType operation.
-type CreateCustomActionTypeInput struct {
+type GetActionTypeInput struct {
 	_ struct{} `type:"structure"`
 
-	// The category of the custom action, such as a build action or a test action.
+	// Defines what kind of action can be taken in the stage. The following are
+	// the valid values:
 	//
-	// Although Source and Approval are listed as valid values, they are not currently
-	// functional. These values are reserved for future use.
+	//    * Source
 	//
-	// Category is a required field
-	Category *string `locationName:"category" type:"string" required:"true" enum:"ActionCategory"`
-
-	// The configuration properties for the custom action.
+	//    * Build
 	//
-	// You can refer to a name in the configuration properties of the custom action
-	// within the URL templates by following the format of {Config:name}, as long
-	// as the configuration property is both required and not secret. For more information,
-	// see Create a Custom Action for a Pipeline (https://docs.aws.amazon.com/codepipeline/latest/userguide/how-to-create-custom-action.html).
-	ConfigurationProperties []*ActionConfigurationProperty `locationName:"configurationProperties" type:"list"`
-
-	// The details of the input artifact for the action, such as its commit ID.
+	//    * Test
 	//
-	// InputArtifactDetails is a required field
-	InputArtifactDetails *ArtifactDetails `locationName:"inputArtifactDetails" type:"structure" required:"true"`
+	//    * Deploy
+	//
+	//    * Approval
+	//
+	//    * Invoke
+	//
+	// Category is a required field
+	Category *string `locationName:"category" type:"string" required:"true" enum:"ActionCategory"`
 
-	// The details of the output artifact of the action, such as its commit ID.
+	// The creator of an action type that was created with any supported integration
+	// model. There are two valid values: AWS and ThirdParty.
 	//
-	// OutputArtifactDetails is a required field
-	OutputArtifactDetails *ArtifactDetails `locationName:"outputArtifactDetails" type:"structure" required:"true"`
+	// Owner is a required field
+	Owner *string `locationName:"owner" type:"string" required:"true"`
 
-	// The provider of the service used in the custom action, such as AWS CodeDeploy.
+	// The provider of the action type being called. The provider name is specified
+	// when the action type is created.
 	//
 	// Provider is a required field
 	Provider *string `locationName:"provider" min:"1" type:"string" required:"true"`
 
-	// URLs that provide users information about this custom action.
-	Settings *ActionTypeSettings `locationName:"settings" type:"structure"`
-
-	// The tags for the custom action.
-	Tags []*Tag `locationName:"tags" type:"list"`
-
-	// The version identifier of the custom action.
+	// A string that describes the action type version.
 	//
 	// Version is a required field
 	Version *string `locationName:"version" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateCustomActionTypeInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetActionTypeInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateCustomActionTypeInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetActionTypeInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateCustomActionTypeInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateCustomActionTypeInput"}
+func (s *GetActionTypeInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetActionTypeInput"}
 	if s.Category == nil {
 		invalidParams.Add(request.NewErrParamRequired("Category"))
 	}
-	if s.InputArtifactDetails == nil {
-		invalidParams.Add(request.NewErrParamRequired("InputArtifactDetails"))
-	}
-	if s.OutputArtifactDetails == nil {
-		invalidParams.Add(request.NewErrParamRequired("OutputArtifactDetails"))
+	if s.Owner == nil {
+		invalidParams.Add(request.NewErrParamRequired("Owner"))
 	}
 	if s.Provider == nil {
 		invalidParams.Add(request.NewErrParamRequired("Provider"))
@@ -5416,41 +8450,6 @@ func (s *CreateCustomActionTypeInput) Validate() error {
 	if s.Version != nil && len(*s.Version) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("Version", 1))
 	}
-	if s.ConfigurationProperties != nil {
-		for i, v := range s.ConfigurationProperties {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ConfigurationProperties", i), err.(request.ErrInvalidParams))
-			}
-		}
-	}
-	if s.InputArtifactDetails != nil {
-		if err := s.InputArtifactDetails.Validate(); err != nil {
-			invalidParams.AddNested("InputArtifactDetails", err.(request.ErrInvalidParams))
-		}
-	}
-	if s.OutputArtifactDetails != nil {
-		if err := s.OutputArtifactDetails.Validate(); err != nil {
-			invalidParams.AddNested("OutputArtifactDetails", err.(request.ErrInvalidParams))
-		}
-	}
-	if s.Settings != nil {
-		if err := s.Settings.Validate(); err != nil {
-			invalidParams.AddNested("Settings", err.(request.ErrInvalidParams))
-		}
-	}
-	if s.Tags != nil {
-		for i, v := range s.Tags {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
-			}
-		}
-	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -5459,233 +8458,94 @@ func (s *CreateCustomActionTypeInput) Validate() error {
 }
 
 // SetCategory sets the Category field's value.
-func (s *CreateCustomActionTypeInput) SetCategory(v string) *CreateCustomActionTypeInput {
+func (s *GetActionTypeInput) SetCategory(v string) *GetActionTypeInput {
 	s.Category = &v
-	return s
-}
-
-// SetConfigurationProperties sets the ConfigurationProperties field's value.
-func (s *CreateCustomActionTypeInput) SetConfigurationProperties(v []*ActionConfigurationProperty) *CreateCustomActionTypeInput {
-	s.ConfigurationProperties = v
-	return s
-}
-
-// SetInputArtifactDetails sets the InputArtifactDetails field's value.
-func (s *CreateCustomActionTypeInput) SetInputArtifactDetails(v *ArtifactDetails) *CreateCustomActionTypeInput {
-	s.InputArtifactDetails = v
-	return s
-}
-
-// SetOutputArtifactDetails sets the OutputArtifactDetails field's value.
-func (s *CreateCustomActionTypeInput) SetOutputArtifactDetails(v *ArtifactDetails) *CreateCustomActionTypeInput {
-	s.OutputArtifactDetails = v
-	return s
-}
-
-// SetProvider sets the Provider field's value.
-func (s *CreateCustomActionTypeInput) SetProvider(v string) *CreateCustomActionTypeInput {
-	s.Provider = &v
-	return s
-}
-
-// SetSettings sets the Settings field's value.
-func (s *CreateCustomActionTypeInput) SetSettings(v *ActionTypeSettings) *CreateCustomActionTypeInput {
-	s.Settings = v
-	return s
-}
-
-// SetTags sets the Tags field's value.
-func (s *CreateCustomActionTypeInput) SetTags(v []*Tag) *CreateCustomActionTypeInput {
-	s.Tags = v
-	return s
-}
-
-// SetVersion sets the Version field's value.
-func (s *CreateCustomActionTypeInput) SetVersion(v string) *CreateCustomActionTypeInput {
-	s.Version = &v
-	return s
-}
-
-// Represents the output of a CreateCustomActionType operation.
-type CreateCustomActionTypeOutput struct {
-	_ struct{} `type:"structure"`
-
-	// Returns information about the details of an action type.
-	//
-	// ActionType is a required field
-	ActionType *ActionType `locationName:"actionType" type:"structure" required:"true"`
-
-	// Specifies the tags applied to the custom action.
-	Tags []*Tag `locationName:"tags" type:"list"`
-}
-
-// String returns the string representation
-func (s CreateCustomActionTypeOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s CreateCustomActionTypeOutput) GoString() string {
-	return s.String()
-}
-
-// SetActionType sets the ActionType field's value.
-func (s *CreateCustomActionTypeOutput) SetActionType(v *ActionType) *CreateCustomActionTypeOutput {
-	s.ActionType = v
-	return s
-}
-
-// SetTags sets the Tags field's value.
-func (s *CreateCustomActionTypeOutput) SetTags(v []*Tag) *CreateCustomActionTypeOutput {
-	s.Tags = v
-	return s
-}
-
-// Represents the input of a CreatePipeline action.
-type CreatePipelineInput struct {
-	_ struct{} `type:"structure"`
-
-	// Represents the structure of actions and stages to be performed in the pipeline.
-	//
-	// Pipeline is a required field
-	Pipeline *PipelineDeclaration `locationName:"pipeline" type:"structure" required:"true"`
-
-	// The tags for the pipeline.
-	Tags []*Tag `locationName:"tags" type:"list"`
-}
-
-// String returns the string representation
-func (s CreatePipelineInput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s CreatePipelineInput) GoString() string {
-	return s.String()
-}
-
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *CreatePipelineInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreatePipelineInput"}
-	if s.Pipeline == nil {
-		invalidParams.Add(request.NewErrParamRequired("Pipeline"))
-	}
-	if s.Pipeline != nil {
-		if err := s.Pipeline.Validate(); err != nil {
-			invalidParams.AddNested("Pipeline", err.(request.ErrInvalidParams))
-		}
-	}
-	if s.Tags != nil {
-		for i, v := range s.Tags {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
-			}
-		}
-	}
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetOwner sets the Owner field's value.
+func (s *GetActionTypeInput) SetOwner(v string) *GetActionTypeInput {
+	s.Owner = &v
+	return s
 }
 
-// SetPipeline sets the Pipeline field's value.
-func (s *CreatePipelineInput) SetPipeline(v *PipelineDeclaration) *CreatePipelineInput {
-	s.Pipeline = v
+// SetProvider sets the Provider field's value.
+func (s *GetActionTypeInput) SetProvider(v string) *GetActionTypeInput {
+	s.Provider = &v
 	return s
 }
 
-// SetTags sets the Tags field's value.
-func (s *CreatePipelineInput) SetTags(v []*Tag) *CreatePipelineInput {
-	s.Tags = v
+// SetVersion sets the Version field's value.
+func (s *GetActionTypeInput) SetVersion(v string) *GetActionTypeInput {
+	s.Version = &v
 	return s
 }
 
-// Represents the output of a CreatePipeline action.
-type CreatePipelineOutput struct {
+type GetActionTypeOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Represents the structure of actions and stages to be performed in the pipeline.
-	Pipeline *PipelineDeclaration `locationName:"pipeline" type:"structure"`
-
-	// Specifies the tags applied to the pipeline.
-	Tags []*Tag `locationName:"tags" type:"list"`
+	// The action type information for the requested action type, such as the action
+	// type ID.
+	ActionType *ActionTypeDeclaration `locationName:"actionType" type:"structure"`
 }
 
-// String returns the string representation
-func (s CreatePipelineOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetActionTypeOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreatePipelineOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetActionTypeOutput) GoString() string {
 	return s.String()
 }
 
-// SetPipeline sets the Pipeline field's value.
-func (s *CreatePipelineOutput) SetPipeline(v *PipelineDeclaration) *CreatePipelineOutput {
-	s.Pipeline = v
-	return s
-}
-
-// SetTags sets the Tags field's value.
-func (s *CreatePipelineOutput) SetTags(v []*Tag) *CreatePipelineOutput {
-	s.Tags = v
+// SetActionType sets the ActionType field's value.
+func (s *GetActionTypeOutput) SetActionType(v *ActionTypeDeclaration) *GetActionTypeOutput {
+	s.ActionType = v
 	return s
 }
 
-// Represents information about a current revision.
-type CurrentRevision struct {
+// Represents the input of a GetJobDetails action.
+type GetJobDetailsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The change identifier for the current revision.
-	//
-	// ChangeIdentifier is a required field
-	ChangeIdentifier *string `locationName:"changeIdentifier" min:"1" type:"string" required:"true"`
-
-	// The date and time when the most recent revision of the artifact was created,
-	// in timestamp format.
-	Created *time.Time `locationName:"created" type:"timestamp"`
-
-	// The revision ID of the current version of an artifact.
+	// The unique system-generated ID for the job.
 	//
-	// Revision is a required field
-	Revision *string `locationName:"revision" min:"1" type:"string" required:"true"`
-
-	// The summary of the most recent revision of the artifact.
-	RevisionSummary *string `locationName:"revisionSummary" min:"1" type:"string"`
+	// JobId is a required field
+	JobId *string `locationName:"jobId" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CurrentRevision) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetJobDetailsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CurrentRevision) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetJobDetailsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CurrentRevision) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CurrentRevision"}
-	if s.ChangeIdentifier == nil {
-		invalidParams.Add(request.NewErrParamRequired("ChangeIdentifier"))
-	}
-	if s.ChangeIdentifier != nil && len(*s.ChangeIdentifier) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("ChangeIdentifier", 1))
-	}
-	if s.Revision == nil {
-		invalidParams.Add(request.NewErrParamRequired("Revision"))
-	}
-	if s.Revision != nil && len(*s.Revision) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Revision", 1))
-	}
-	if s.RevisionSummary != nil && len(*s.RevisionSummary) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("RevisionSummary", 1))
+func (s *GetJobDetailsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetJobDetailsInput"}
+	if s.JobId == nil {
+		invalidParams.Add(request.NewErrParamRequired("JobId"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5694,79 +8554,91 @@ func (s *CurrentRevision) Validate() error {
 	return nil
 }
 
-// SetChangeIdentifier sets the ChangeIdentifier field's value.
-func (s *CurrentRevision) SetChangeIdentifier(v string) *CurrentRevision {
-	s.ChangeIdentifier = &v
+// SetJobId sets the JobId field's value.
+func (s *GetJobDetailsInput) SetJobId(v string) *GetJobDetailsInput {
+	s.JobId = &v
 	return s
 }
 
-// SetCreated sets the Created field's value.
-func (s *CurrentRevision) SetCreated(v time.Time) *CurrentRevision {
-	s.Created = &v
-	return s
+// Represents the output of a GetJobDetails action.
+type GetJobDetailsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The details of the job.
+	//
+	// If AWSSessionCredentials is used, a long-running job can call GetJobDetails
+	// again to obtain new credentials.
+	JobDetails *JobDetails `locationName:"jobDetails" type:"structure"`
 }
 
-// SetRevision sets the Revision field's value.
-func (s *CurrentRevision) SetRevision(v string) *CurrentRevision {
-	s.Revision = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetJobDetailsOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetRevisionSummary sets the RevisionSummary field's value.
-func (s *CurrentRevision) SetRevisionSummary(v string) *CurrentRevision {
-	s.RevisionSummary = &v
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetJobDetailsOutput) GoString() string {
+	return s.String()
+}
+
+// SetJobDetails sets the JobDetails field's value.
+func (s *GetJobDetailsOutput) SetJobDetails(v *JobDetails) *GetJobDetailsOutput {
+	s.JobDetails = v
 	return s
 }
 
-// Represents the input of a DeleteCustomActionType operation. The custom action
-// will be marked as deleted.
-type DeleteCustomActionTypeInput struct {
+// Represents the input of a GetPipelineExecution action.
+type GetPipelineExecutionInput struct {
 	_ struct{} `type:"structure"`
 
-	// The category of the custom action that you want to delete, such as source
-	// or deploy.
-	//
-	// Category is a required field
-	Category *string `locationName:"category" type:"string" required:"true" enum:"ActionCategory"`
-
-	// The provider of the service used in the custom action, such as AWS CodeDeploy.
+	// The ID of the pipeline execution about which you want to get execution details.
 	//
-	// Provider is a required field
-	Provider *string `locationName:"provider" min:"1" type:"string" required:"true"`
+	// PipelineExecutionId is a required field
+	PipelineExecutionId *string `locationName:"pipelineExecutionId" type:"string" required:"true"`
 
-	// The version of the custom action to delete.
+	// The name of the pipeline about which you want to get execution details.
 	//
-	// Version is a required field
-	Version *string `locationName:"version" min:"1" type:"string" required:"true"`
+	// PipelineName is a required field
+	PipelineName *string `locationName:"pipelineName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteCustomActionTypeInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetPipelineExecutionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteCustomActionTypeInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetPipelineExecutionInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteCustomActionTypeInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteCustomActionTypeInput"}
-	if s.Category == nil {
-		invalidParams.Add(request.NewErrParamRequired("Category"))
-	}
-	if s.Provider == nil {
-		invalidParams.Add(request.NewErrParamRequired("Provider"))
-	}
-	if s.Provider != nil && len(*s.Provider) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Provider", 1))
+func (s *GetPipelineExecutionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetPipelineExecutionInput"}
+	if s.PipelineExecutionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("PipelineExecutionId"))
 	}
-	if s.Version == nil {
-		invalidParams.Add(request.NewErrParamRequired("Version"))
+	if s.PipelineName == nil {
+		invalidParams.Add(request.NewErrParamRequired("PipelineName"))
 	}
-	if s.Version != nil && len(*s.Version) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Version", 1))
+	if s.PipelineName != nil && len(*s.PipelineName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("PipelineName", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5775,67 +8647,95 @@ func (s *DeleteCustomActionTypeInput) Validate() error {
 	return nil
 }
 
-// SetCategory sets the Category field's value.
-func (s *DeleteCustomActionTypeInput) SetCategory(v string) *DeleteCustomActionTypeInput {
-	s.Category = &v
-	return s
-}
-
-// SetProvider sets the Provider field's value.
-func (s *DeleteCustomActionTypeInput) SetProvider(v string) *DeleteCustomActionTypeInput {
-	s.Provider = &v
+// SetPipelineExecutionId sets the PipelineExecutionId field's value.
+func (s *GetPipelineExecutionInput) SetPipelineExecutionId(v string) *GetPipelineExecutionInput {
+	s.PipelineExecutionId = &v
 	return s
 }
 
-// SetVersion sets the Version field's value.
-func (s *DeleteCustomActionTypeInput) SetVersion(v string) *DeleteCustomActionTypeInput {
-	s.Version = &v
+// SetPipelineName sets the PipelineName field's value.
+func (s *GetPipelineExecutionInput) SetPipelineName(v string) *GetPipelineExecutionInput {
+	s.PipelineName = &v
 	return s
 }
 
-type DeleteCustomActionTypeOutput struct {
+// Represents the output of a GetPipelineExecution action.
+type GetPipelineExecutionOutput struct {
 	_ struct{} `type:"structure"`
+
+	// Represents information about the execution of a pipeline.
+	PipelineExecution *PipelineExecution `locationName:"pipelineExecution" type:"structure"`
 }
 
-// String returns the string representation
-func (s DeleteCustomActionTypeOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetPipelineExecutionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteCustomActionTypeOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetPipelineExecutionOutput) GoString() string {
 	return s.String()
 }
 
-// Represents the input of a DeletePipeline action.
-type DeletePipelineInput struct {
+// SetPipelineExecution sets the PipelineExecution field's value.
+func (s *GetPipelineExecutionOutput) SetPipelineExecution(v *PipelineExecution) *GetPipelineExecutionOutput {
+	s.PipelineExecution = v
+	return s
+}
+
+// Represents the input of a GetPipeline action.
+type GetPipelineInput struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the pipeline to be deleted.
+	// The name of the pipeline for which you want to get information. Pipeline
+	// names must be unique in an Amazon Web Services account.
 	//
 	// Name is a required field
 	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
+
+	// The version number of the pipeline. If you do not specify a version, defaults
+	// to the current version.
+	Version *int64 `locationName:"version" min:"1" type:"integer"`
 }
 
-// String returns the string representation
-func (s DeletePipelineInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetPipelineInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeletePipelineInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetPipelineInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeletePipelineInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeletePipelineInput"}
+func (s *GetPipelineInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetPipelineInput"}
 	if s.Name == nil {
 		invalidParams.Add(request.NewErrParamRequired("Name"))
 	}
 	if s.Name != nil && len(*s.Name) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
 	}
+	if s.Version != nil && *s.Version < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Version", 1))
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -5843,48 +8743,91 @@ func (s *DeletePipelineInput) Validate() error {
 	return nil
 }
 
-// SetName sets the Name field's value.
-func (s *DeletePipelineInput) SetName(v string) *DeletePipelineInput {
-	s.Name = &v
-	return s
+// SetName sets the Name field's value.
+func (s *GetPipelineInput) SetName(v string) *GetPipelineInput {
+	s.Name = &v
+	return s
+}
+
+// SetVersion sets the Version field's value.
+func (s *GetPipelineInput) SetVersion(v int64) *GetPipelineInput {
+	s.Version = &v
+	return s
+}
+
+// Represents the output of a GetPipeline action.
+type GetPipelineOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Represents the pipeline metadata information returned as part of the output
+	// of a GetPipeline action.
+	Metadata *PipelineMetadata `locationName:"metadata" type:"structure"`
+
+	// Represents the structure of actions and stages to be performed in the pipeline.
+	Pipeline *PipelineDeclaration `locationName:"pipeline" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetPipelineOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-type DeletePipelineOutput struct {
-	_ struct{} `type:"structure"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetPipelineOutput) GoString() string {
+	return s.String()
 }
 
-// String returns the string representation
-func (s DeletePipelineOutput) String() string {
-	return awsutil.Prettify(s)
+// SetMetadata sets the Metadata field's value.
+func (s *GetPipelineOutput) SetMetadata(v *PipelineMetadata) *GetPipelineOutput {
+	s.Metadata = v
+	return s
 }
 
-// GoString returns the string representation
-func (s DeletePipelineOutput) GoString() string {
-	return s.String()
+// SetPipeline sets the Pipeline field's value.
+func (s *GetPipelineOutput) SetPipeline(v *PipelineDeclaration) *GetPipelineOutput {
+	s.Pipeline = v
+	return s
 }
 
-type DeleteWebhookInput struct {
+// Represents the input of a GetPipelineState action.
+type GetPipelineStateInput struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the webhook you want to delete.
+	// The name of the pipeline about which you want to get information.
 	//
 	// Name is a required field
 	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteWebhookInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetPipelineStateInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteWebhookInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetPipelineStateInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteWebhookInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteWebhookInput"}
+func (s *GetPipelineStateInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetPipelineStateInput"}
 	if s.Name == nil {
 		invalidParams.Add(request.NewErrParamRequired("Name"))
 	}
@@ -5899,140 +8842,130 @@ func (s *DeleteWebhookInput) Validate() error {
 }
 
 // SetName sets the Name field's value.
-func (s *DeleteWebhookInput) SetName(v string) *DeleteWebhookInput {
+func (s *GetPipelineStateInput) SetName(v string) *GetPipelineStateInput {
 	s.Name = &v
 	return s
 }
 
-type DeleteWebhookOutput struct {
+// Represents the output of a GetPipelineState action.
+type GetPipelineStateOutput struct {
 	_ struct{} `type:"structure"`
-}
 
-// String returns the string representation
-func (s DeleteWebhookOutput) String() string {
-	return awsutil.Prettify(s)
-}
+	// The date and time the pipeline was created, in timestamp format.
+	Created *time.Time `locationName:"created" type:"timestamp"`
 
-// GoString returns the string representation
-func (s DeleteWebhookOutput) GoString() string {
-	return s.String()
-}
+	// The name of the pipeline for which you want to get the state.
+	PipelineName *string `locationName:"pipelineName" min:"1" type:"string"`
 
-type DeregisterWebhookWithThirdPartyInput struct {
-	_ struct{} `type:"structure"`
+	// The version number of the pipeline.
+	//
+	// A newly created pipeline is always assigned a version number of 1.
+	PipelineVersion *int64 `locationName:"pipelineVersion" min:"1" type:"integer"`
 
-	// The name of the webhook you want to deregister.
-	WebhookName *string `locationName:"webhookName" min:"1" type:"string"`
+	// A list of the pipeline stage output information, including stage name, state,
+	// most recent run details, whether the stage is disabled, and other data.
+	StageStates []*StageState `locationName:"stageStates" type:"list"`
+
+	// The date and time the pipeline was last updated, in timestamp format.
+	Updated *time.Time `locationName:"updated" type:"timestamp"`
 }
 
-// String returns the string representation
-func (s DeregisterWebhookWithThirdPartyInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetPipelineStateOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeregisterWebhookWithThirdPartyInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetPipelineStateOutput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeregisterWebhookWithThirdPartyInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeregisterWebhookWithThirdPartyInput"}
-	if s.WebhookName != nil && len(*s.WebhookName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("WebhookName", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetCreated sets the Created field's value.
+func (s *GetPipelineStateOutput) SetCreated(v time.Time) *GetPipelineStateOutput {
+	s.Created = &v
+	return s
 }
 
-// SetWebhookName sets the WebhookName field's value.
-func (s *DeregisterWebhookWithThirdPartyInput) SetWebhookName(v string) *DeregisterWebhookWithThirdPartyInput {
-	s.WebhookName = &v
+// SetPipelineName sets the PipelineName field's value.
+func (s *GetPipelineStateOutput) SetPipelineName(v string) *GetPipelineStateOutput {
+	s.PipelineName = &v
 	return s
 }
 
-type DeregisterWebhookWithThirdPartyOutput struct {
-	_ struct{} `type:"structure"`
+// SetPipelineVersion sets the PipelineVersion field's value.
+func (s *GetPipelineStateOutput) SetPipelineVersion(v int64) *GetPipelineStateOutput {
+	s.PipelineVersion = &v
+	return s
 }
 
-// String returns the string representation
-func (s DeregisterWebhookWithThirdPartyOutput) String() string {
-	return awsutil.Prettify(s)
+// SetStageStates sets the StageStates field's value.
+func (s *GetPipelineStateOutput) SetStageStates(v []*StageState) *GetPipelineStateOutput {
+	s.StageStates = v
+	return s
 }
 
-// GoString returns the string representation
-func (s DeregisterWebhookWithThirdPartyOutput) GoString() string {
-	return s.String()
+// SetUpdated sets the Updated field's value.
+func (s *GetPipelineStateOutput) SetUpdated(v time.Time) *GetPipelineStateOutput {
+	s.Updated = &v
+	return s
 }
 
-// Represents the input of a DisableStageTransition action.
-type DisableStageTransitionInput struct {
+// Represents the input of a GetThirdPartyJobDetails action.
+type GetThirdPartyJobDetailsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the pipeline in which you want to disable the flow of artifacts
-	// from one stage to another.
-	//
-	// PipelineName is a required field
-	PipelineName *string `locationName:"pipelineName" min:"1" type:"string" required:"true"`
-
-	// The reason given to the user that a stage is disabled, such as waiting for
-	// manual approval or manual tests. This message is displayed in the pipeline
-	// console UI.
-	//
-	// Reason is a required field
-	Reason *string `locationName:"reason" min:"1" type:"string" required:"true"`
-
-	// The name of the stage where you want to disable the inbound or outbound transition
-	// of artifacts.
+	// The clientToken portion of the clientId and clientToken pair used to verify
+	// that the calling entity is allowed access to the job and its details.
 	//
-	// StageName is a required field
-	StageName *string `locationName:"stageName" min:"1" type:"string" required:"true"`
+	// ClientToken is a required field
+	ClientToken *string `locationName:"clientToken" min:"1" type:"string" required:"true"`
 
-	// Specifies whether artifacts are prevented from transitioning into the stage
-	// and being processed by the actions in that stage (inbound), or prevented
-	// from transitioning from the stage after they have been processed by the actions
-	// in that stage (outbound).
+	// The unique system-generated ID used for identifying the job.
 	//
-	// TransitionType is a required field
-	TransitionType *string `locationName:"transitionType" type:"string" required:"true" enum:"StageTransitionType"`
+	// JobId is a required field
+	JobId *string `locationName:"jobId" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DisableStageTransitionInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetThirdPartyJobDetailsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DisableStageTransitionInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetThirdPartyJobDetailsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DisableStageTransitionInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DisableStageTransitionInput"}
-	if s.PipelineName == nil {
-		invalidParams.Add(request.NewErrParamRequired("PipelineName"))
-	}
-	if s.PipelineName != nil && len(*s.PipelineName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("PipelineName", 1))
-	}
-	if s.Reason == nil {
-		invalidParams.Add(request.NewErrParamRequired("Reason"))
-	}
-	if s.Reason != nil && len(*s.Reason) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Reason", 1))
+func (s *GetThirdPartyJobDetailsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetThirdPartyJobDetailsInput"}
+	if s.ClientToken == nil {
+		invalidParams.Add(request.NewErrParamRequired("ClientToken"))
 	}
-	if s.StageName == nil {
-		invalidParams.Add(request.NewErrParamRequired("StageName"))
+	if s.ClientToken != nil && len(*s.ClientToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ClientToken", 1))
 	}
-	if s.StageName != nil && len(*s.StageName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("StageName", 1))
+	if s.JobId == nil {
+		invalidParams.Add(request.NewErrParamRequired("JobId"))
 	}
-	if s.TransitionType == nil {
-		invalidParams.Add(request.NewErrParamRequired("TransitionType"))
+	if s.JobId != nil && len(*s.JobId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("JobId", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -6041,95 +8974,99 @@ func (s *DisableStageTransitionInput) Validate() error {
 	return nil
 }
 
-// SetPipelineName sets the PipelineName field's value.
-func (s *DisableStageTransitionInput) SetPipelineName(v string) *DisableStageTransitionInput {
-	s.PipelineName = &v
-	return s
-}
-
-// SetReason sets the Reason field's value.
-func (s *DisableStageTransitionInput) SetReason(v string) *DisableStageTransitionInput {
-	s.Reason = &v
-	return s
-}
-
-// SetStageName sets the StageName field's value.
-func (s *DisableStageTransitionInput) SetStageName(v string) *DisableStageTransitionInput {
-	s.StageName = &v
+// SetClientToken sets the ClientToken field's value.
+func (s *GetThirdPartyJobDetailsInput) SetClientToken(v string) *GetThirdPartyJobDetailsInput {
+	s.ClientToken = &v
 	return s
 }
 
-// SetTransitionType sets the TransitionType field's value.
-func (s *DisableStageTransitionInput) SetTransitionType(v string) *DisableStageTransitionInput {
-	s.TransitionType = &v
+// SetJobId sets the JobId field's value.
+func (s *GetThirdPartyJobDetailsInput) SetJobId(v string) *GetThirdPartyJobDetailsInput {
+	s.JobId = &v
 	return s
 }
 
-type DisableStageTransitionOutput struct {
+// Represents the output of a GetThirdPartyJobDetails action.
+type GetThirdPartyJobDetailsOutput struct {
 	_ struct{} `type:"structure"`
+
+	// The details of the job, including any protected values defined for the job.
+	JobDetails *ThirdPartyJobDetails `locationName:"jobDetails" type:"structure"`
 }
 
-// String returns the string representation
-func (s DisableStageTransitionOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetThirdPartyJobDetailsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DisableStageTransitionOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetThirdPartyJobDetailsOutput) GoString() string {
 	return s.String()
 }
 
-// Represents the input of an EnableStageTransition action.
-type EnableStageTransitionInput struct {
+// SetJobDetails sets the JobDetails field's value.
+func (s *GetThirdPartyJobDetailsOutput) SetJobDetails(v *ThirdPartyJobDetails) *GetThirdPartyJobDetailsOutput {
+	s.JobDetails = v
+	return s
+}
+
+// Represents information about an artifact to be worked on, such as a test
+// or build artifact.
+type InputArtifact struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the pipeline in which you want to enable the flow of artifacts
-	// from one stage to another.
+	// The name of the artifact to be worked on (for example, "My App").
 	//
-	// PipelineName is a required field
-	PipelineName *string `locationName:"pipelineName" min:"1" type:"string" required:"true"`
-
-	// The name of the stage where you want to enable the transition of artifacts,
-	// either into the stage (inbound) or from that stage to the next stage (outbound).
+	// Artifacts are the files that are worked on by actions in the pipeline. See
+	// the action configuration for each action for details about artifact parameters.
+	// For example, the S3 source action input artifact is a file name (or file
+	// path), and the files are generally provided as a ZIP file. Example artifact
+	// name: SampleApp_Windows.zip
 	//
-	// StageName is a required field
-	StageName *string `locationName:"stageName" min:"1" type:"string" required:"true"`
-
-	// Specifies whether artifacts are allowed to enter the stage and be processed
-	// by the actions in that stage (inbound) or whether already processed artifacts
-	// are allowed to transition to the next stage (outbound).
+	// The input artifact of an action must exactly match the output artifact declared
+	// in a preceding action, but the input artifact does not have to be the next
+	// action in strict sequence from the action that provided the output artifact.
+	// Actions in parallel can declare different output artifacts, which are in
+	// turn consumed by different following actions.
 	//
-	// TransitionType is a required field
-	TransitionType *string `locationName:"transitionType" type:"string" required:"true" enum:"StageTransitionType"`
+	// Name is a required field
+	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s EnableStageTransitionInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InputArtifact) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s EnableStageTransitionInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InputArtifact) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *EnableStageTransitionInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "EnableStageTransitionInput"}
-	if s.PipelineName == nil {
-		invalidParams.Add(request.NewErrParamRequired("PipelineName"))
-	}
-	if s.PipelineName != nil && len(*s.PipelineName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("PipelineName", 1))
-	}
-	if s.StageName == nil {
-		invalidParams.Add(request.NewErrParamRequired("StageName"))
-	}
-	if s.StageName != nil && len(*s.StageName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("StageName", 1))
+func (s *InputArtifact) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "InputArtifact"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
 	}
-	if s.TransitionType == nil {
-		invalidParams.Add(request.NewErrParamRequired("TransitionType"))
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -6138,778 +9075,914 @@ func (s *EnableStageTransitionInput) Validate() error {
 	return nil
 }
 
-// SetPipelineName sets the PipelineName field's value.
-func (s *EnableStageTransitionInput) SetPipelineName(v string) *EnableStageTransitionInput {
-	s.PipelineName = &v
-	return s
-}
-
-// SetStageName sets the StageName field's value.
-func (s *EnableStageTransitionInput) SetStageName(v string) *EnableStageTransitionInput {
-	s.StageName = &v
+// SetName sets the Name field's value.
+func (s *InputArtifact) SetName(v string) *InputArtifact {
+	s.Name = &v
 	return s
 }
 
-// SetTransitionType sets the TransitionType field's value.
-func (s *EnableStageTransitionInput) SetTransitionType(v string) *EnableStageTransitionInput {
-	s.TransitionType = &v
-	return s
-}
+// The action declaration was specified in an invalid format.
+type InvalidActionDeclarationException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-type EnableStageTransitionOutput struct {
-	_ struct{} `type:"structure"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s EnableStageTransitionOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidActionDeclarationException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s EnableStageTransitionOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidActionDeclarationException) GoString() string {
 	return s.String()
 }
 
-// Represents information about the key used to encrypt data in the artifact
-// store, such as an AWS Key Management Service (AWS KMS) key.
-type EncryptionKey struct {
-	_ struct{} `type:"structure"`
-
-	// The ID used to identify the key. For an AWS KMS key, you can use the key
-	// ID, the key ARN, or the alias ARN.
-	//
-	// Aliases are recognized only in the account that created the customer master
-	// key (CMK). For cross-account actions, you can only use the key ID or key
-	// ARN to identify the key.
-	//
-	// Id is a required field
-	Id *string `locationName:"id" min:"1" type:"string" required:"true"`
-
-	// The type of encryption key, such as an AWS Key Management Service (AWS KMS)
-	// key. When creating or updating a pipeline, the value must be set to 'KMS'.
-	//
-	// Type is a required field
-	Type *string `locationName:"type" type:"string" required:"true" enum:"EncryptionKeyType"`
-}
-
-// String returns the string representation
-func (s EncryptionKey) String() string {
-	return awsutil.Prettify(s)
+func newErrorInvalidActionDeclarationException(v protocol.ResponseMetadata) error {
+	return &InvalidActionDeclarationException{
+		RespMetadata: v,
+	}
 }
 
-// GoString returns the string representation
-func (s EncryptionKey) GoString() string {
-	return s.String()
+// Code returns the exception type name.
+func (s *InvalidActionDeclarationException) Code() string {
+	return "InvalidActionDeclarationException"
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *EncryptionKey) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "EncryptionKey"}
-	if s.Id == nil {
-		invalidParams.Add(request.NewErrParamRequired("Id"))
-	}
-	if s.Id != nil && len(*s.Id) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
-	}
-	if s.Type == nil {
-		invalidParams.Add(request.NewErrParamRequired("Type"))
+// Message returns the exception's message.
+func (s *InvalidActionDeclarationException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
+	return ""
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidActionDeclarationException) OrigErr() error {
 	return nil
 }
 
-// SetId sets the Id field's value.
-func (s *EncryptionKey) SetId(v string) *EncryptionKey {
-	s.Id = &v
-	return s
+func (s *InvalidActionDeclarationException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// SetType sets the Type field's value.
-func (s *EncryptionKey) SetType(v string) *EncryptionKey {
-	s.Type = &v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidActionDeclarationException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// Represents information about an error in AWS CodePipeline.
-type ErrorDetails struct {
-	_ struct{} `type:"structure"`
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidActionDeclarationException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// The system ID or number code of the error.
-	Code *string `locationName:"code" type:"string"`
+// The approval request already received a response or has expired.
+type InvalidApprovalTokenException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The text of the error message.
-	Message *string `locationName:"message" min:"1" type:"string"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s ErrorDetails) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidApprovalTokenException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ErrorDetails) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidApprovalTokenException) GoString() string {
 	return s.String()
 }
 
-// SetCode sets the Code field's value.
-func (s *ErrorDetails) SetCode(v string) *ErrorDetails {
-	s.Code = &v
-	return s
+func newErrorInvalidApprovalTokenException(v protocol.ResponseMetadata) error {
+	return &InvalidApprovalTokenException{
+		RespMetadata: v,
+	}
 }
 
-// SetMessage sets the Message field's value.
-func (s *ErrorDetails) SetMessage(v string) *ErrorDetails {
-	s.Message = &v
-	return s
+// Code returns the exception type name.
+func (s *InvalidApprovalTokenException) Code() string {
+	return "InvalidApprovalTokenException"
 }
 
-// The details of the actions taken and results produced on an artifact as it
-// passes through stages in the pipeline.
-type ExecutionDetails struct {
-	_ struct{} `type:"structure"`
+// Message returns the exception's message.
+func (s *InvalidApprovalTokenException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
 
-	// The system-generated unique ID of this action used to identify this job worker
-	// in any external systems, such as AWS CodeDeploy.
-	ExternalExecutionId *string `locationName:"externalExecutionId" min:"1" type:"string"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidApprovalTokenException) OrigErr() error {
+	return nil
+}
 
-	// The percentage of work completed on the action, represented on a scale of
-	// 0 to 100 percent.
-	PercentComplete *int64 `locationName:"percentComplete" type:"integer"`
+func (s *InvalidApprovalTokenException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
 
-	// The summary of the current status of the actions.
-	Summary *string `locationName:"summary" min:"1" type:"string"`
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidApprovalTokenException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// String returns the string representation
-func (s ExecutionDetails) String() string {
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidApprovalTokenException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The specified resource ARN is invalid.
+type InvalidArnException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidArnException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ExecutionDetails) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidArnException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *ExecutionDetails) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ExecutionDetails"}
-	if s.ExternalExecutionId != nil && len(*s.ExternalExecutionId) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("ExternalExecutionId", 1))
-	}
-	if s.Summary != nil && len(*s.Summary) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Summary", 1))
+func newErrorInvalidArnException(v protocol.ResponseMetadata) error {
+	return &InvalidArnException{
+		RespMetadata: v,
 	}
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
+// Code returns the exception type name.
+func (s *InvalidArnException) Code() string {
+	return "InvalidArnException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidArnException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
-	return nil
+	return ""
 }
 
-// SetExternalExecutionId sets the ExternalExecutionId field's value.
-func (s *ExecutionDetails) SetExternalExecutionId(v string) *ExecutionDetails {
-	s.ExternalExecutionId = &v
-	return s
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidArnException) OrigErr() error {
+	return nil
 }
 
-// SetPercentComplete sets the PercentComplete field's value.
-func (s *ExecutionDetails) SetPercentComplete(v int64) *ExecutionDetails {
-	s.PercentComplete = &v
-	return s
+func (s *InvalidArnException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// SetSummary sets the Summary field's value.
-func (s *ExecutionDetails) SetSummary(v string) *ExecutionDetails {
-	s.Summary = &v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidArnException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// The interaction or event that started a pipeline execution.
-type ExecutionTrigger struct {
-	_ struct{} `type:"structure"`
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidArnException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// Detail related to the event that started a pipeline execution, such as the
-	// webhook ARN of the webhook that triggered the pipeline execution or the user
-	// ARN for a user-initiated start-pipeline-execution CLI command.
-	TriggerDetail *string `locationName:"triggerDetail" type:"string"`
+// Reserved for future use.
+type InvalidBlockerDeclarationException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The type of change-detection method, command, or user interaction that started
-	// a pipeline execution.
-	TriggerType *string `locationName:"triggerType" type:"string" enum:"TriggerType"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s ExecutionTrigger) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidBlockerDeclarationException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ExecutionTrigger) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidBlockerDeclarationException) GoString() string {
 	return s.String()
 }
 
-// SetTriggerDetail sets the TriggerDetail field's value.
-func (s *ExecutionTrigger) SetTriggerDetail(v string) *ExecutionTrigger {
-	s.TriggerDetail = &v
-	return s
+func newErrorInvalidBlockerDeclarationException(v protocol.ResponseMetadata) error {
+	return &InvalidBlockerDeclarationException{
+		RespMetadata: v,
+	}
 }
 
-// SetTriggerType sets the TriggerType field's value.
-func (s *ExecutionTrigger) SetTriggerType(v string) *ExecutionTrigger {
-	s.TriggerType = &v
-	return s
+// Code returns the exception type name.
+func (s *InvalidBlockerDeclarationException) Code() string {
+	return "InvalidBlockerDeclarationException"
 }
 
-// Represents information about failure details.
-type FailureDetails struct {
-	_ struct{} `type:"structure"`
+// Message returns the exception's message.
+func (s *InvalidBlockerDeclarationException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
 
-	// The external ID of the run of the action that failed.
-	ExternalExecutionId *string `locationName:"externalExecutionId" min:"1" type:"string"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidBlockerDeclarationException) OrigErr() error {
+	return nil
+}
 
-	// The message about the failure.
-	//
-	// Message is a required field
-	Message *string `locationName:"message" min:"1" type:"string" required:"true"`
+func (s *InvalidBlockerDeclarationException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
 
-	// The type of the failure.
-	//
-	// Type is a required field
-	Type *string `locationName:"type" type:"string" required:"true" enum:"FailureType"`
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidBlockerDeclarationException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// String returns the string representation
-func (s FailureDetails) String() string {
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidBlockerDeclarationException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The client token was specified in an invalid format
+type InvalidClientTokenException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidClientTokenException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s FailureDetails) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidClientTokenException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *FailureDetails) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "FailureDetails"}
-	if s.ExternalExecutionId != nil && len(*s.ExternalExecutionId) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("ExternalExecutionId", 1))
-	}
-	if s.Message == nil {
-		invalidParams.Add(request.NewErrParamRequired("Message"))
-	}
-	if s.Message != nil && len(*s.Message) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Message", 1))
-	}
-	if s.Type == nil {
-		invalidParams.Add(request.NewErrParamRequired("Type"))
+func newErrorInvalidClientTokenException(v protocol.ResponseMetadata) error {
+	return &InvalidClientTokenException{
+		RespMetadata: v,
 	}
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
+// Code returns the exception type name.
+func (s *InvalidClientTokenException) Code() string {
+	return "InvalidClientTokenException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidClientTokenException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidClientTokenException) OrigErr() error {
 	return nil
 }
 
-// SetExternalExecutionId sets the ExternalExecutionId field's value.
-func (s *FailureDetails) SetExternalExecutionId(v string) *FailureDetails {
-	s.ExternalExecutionId = &v
-	return s
+func (s *InvalidClientTokenException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// SetMessage sets the Message field's value.
-func (s *FailureDetails) SetMessage(v string) *FailureDetails {
-	s.Message = &v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidClientTokenException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// SetType sets the Type field's value.
-func (s *FailureDetails) SetType(v string) *FailureDetails {
-	s.Type = &v
-	return s
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidClientTokenException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// Represents the input of a GetJobDetails action.
-type GetJobDetailsInput struct {
-	_ struct{} `type:"structure"`
+// The job was specified in an invalid format or cannot be found.
+type InvalidJobException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The unique system-generated ID for the job.
-	//
-	// JobId is a required field
-	JobId *string `locationName:"jobId" type:"string" required:"true"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s GetJobDetailsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidJobException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetJobDetailsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidJobException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetJobDetailsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetJobDetailsInput"}
-	if s.JobId == nil {
-		invalidParams.Add(request.NewErrParamRequired("JobId"))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
+func newErrorInvalidJobException(v protocol.ResponseMetadata) error {
+	return &InvalidJobException{
+		RespMetadata: v,
 	}
-	return nil
 }
 
-// SetJobId sets the JobId field's value.
-func (s *GetJobDetailsInput) SetJobId(v string) *GetJobDetailsInput {
-	s.JobId = &v
-	return s
+// Code returns the exception type name.
+func (s *InvalidJobException) Code() string {
+	return "InvalidJobException"
 }
 
-// Represents the output of a GetJobDetails action.
-type GetJobDetailsOutput struct {
-	_ struct{} `type:"structure"`
-
-	// The details of the job.
-	//
-	// If AWSSessionCredentials is used, a long-running job can call GetJobDetails
-	// again to obtain new credentials.
-	JobDetails *JobDetails `locationName:"jobDetails" type:"structure"`
+// Message returns the exception's message.
+func (s *InvalidJobException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// String returns the string representation
-func (s GetJobDetailsOutput) String() string {
-	return awsutil.Prettify(s)
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidJobException) OrigErr() error {
+	return nil
 }
 
-// GoString returns the string representation
-func (s GetJobDetailsOutput) GoString() string {
-	return s.String()
+func (s *InvalidJobException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// SetJobDetails sets the JobDetails field's value.
-func (s *GetJobDetailsOutput) SetJobDetails(v *JobDetails) *GetJobDetailsOutput {
-	s.JobDetails = v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidJobException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// Represents the input of a GetPipelineExecution action.
-type GetPipelineExecutionInput struct {
-	_ struct{} `type:"structure"`
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidJobException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// The ID of the pipeline execution about which you want to get execution details.
-	//
-	// PipelineExecutionId is a required field
-	PipelineExecutionId *string `locationName:"pipelineExecutionId" type:"string" required:"true"`
+// The job state was specified in an invalid format.
+type InvalidJobStateException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The name of the pipeline about which you want to get execution details.
-	//
-	// PipelineName is a required field
-	PipelineName *string `locationName:"pipelineName" min:"1" type:"string" required:"true"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s GetPipelineExecutionInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidJobStateException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetPipelineExecutionInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidJobStateException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetPipelineExecutionInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetPipelineExecutionInput"}
-	if s.PipelineExecutionId == nil {
-		invalidParams.Add(request.NewErrParamRequired("PipelineExecutionId"))
-	}
-	if s.PipelineName == nil {
-		invalidParams.Add(request.NewErrParamRequired("PipelineName"))
-	}
-	if s.PipelineName != nil && len(*s.PipelineName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("PipelineName", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
+func newErrorInvalidJobStateException(v protocol.ResponseMetadata) error {
+	return &InvalidJobStateException{
+		RespMetadata: v,
 	}
-	return nil
 }
 
-// SetPipelineExecutionId sets the PipelineExecutionId field's value.
-func (s *GetPipelineExecutionInput) SetPipelineExecutionId(v string) *GetPipelineExecutionInput {
-	s.PipelineExecutionId = &v
-	return s
+// Code returns the exception type name.
+func (s *InvalidJobStateException) Code() string {
+	return "InvalidJobStateException"
 }
 
-// SetPipelineName sets the PipelineName field's value.
-func (s *GetPipelineExecutionInput) SetPipelineName(v string) *GetPipelineExecutionInput {
-	s.PipelineName = &v
-	return s
+// Message returns the exception's message.
+func (s *InvalidJobStateException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// Represents the output of a GetPipelineExecution action.
-type GetPipelineExecutionOutput struct {
-	_ struct{} `type:"structure"`
-
-	// Represents information about the execution of a pipeline.
-	PipelineExecution *PipelineExecution `locationName:"pipelineExecution" type:"structure"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidJobStateException) OrigErr() error {
+	return nil
 }
 
-// String returns the string representation
-func (s GetPipelineExecutionOutput) String() string {
-	return awsutil.Prettify(s)
+func (s *InvalidJobStateException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// GoString returns the string representation
-func (s GetPipelineExecutionOutput) GoString() string {
-	return s.String()
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidJobStateException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// SetPipelineExecution sets the PipelineExecution field's value.
-func (s *GetPipelineExecutionOutput) SetPipelineExecution(v *PipelineExecution) *GetPipelineExecutionOutput {
-	s.PipelineExecution = v
-	return s
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidJobStateException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// Represents the input of a GetPipeline action.
-type GetPipelineInput struct {
-	_ struct{} `type:"structure"`
-
-	// The name of the pipeline for which you want to get information. Pipeline
-	// names must be unique under an AWS user account.
-	//
-	// Name is a required field
-	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
+// The next token was specified in an invalid format. Make sure that the next
+// token you provide is the token returned by a previous call.
+type InvalidNextTokenException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The version number of the pipeline. If you do not specify a version, defaults
-	// to the current version.
-	Version *int64 `locationName:"version" min:"1" type:"integer"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s GetPipelineInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidNextTokenException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetPipelineInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidNextTokenException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetPipelineInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetPipelineInput"}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
-	}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
-	}
-	if s.Version != nil && *s.Version < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("Version", 1))
+func newErrorInvalidNextTokenException(v protocol.ResponseMetadata) error {
+	return &InvalidNextTokenException{
+		RespMetadata: v,
 	}
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
+// Code returns the exception type name.
+func (s *InvalidNextTokenException) Code() string {
+	return "InvalidNextTokenException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidNextTokenException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidNextTokenException) OrigErr() error {
 	return nil
 }
 
-// SetName sets the Name field's value.
-func (s *GetPipelineInput) SetName(v string) *GetPipelineInput {
-	s.Name = &v
-	return s
+func (s *InvalidNextTokenException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// SetVersion sets the Version field's value.
-func (s *GetPipelineInput) SetVersion(v int64) *GetPipelineInput {
-	s.Version = &v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidNextTokenException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// Represents the output of a GetPipeline action.
-type GetPipelineOutput struct {
-	_ struct{} `type:"structure"`
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidNextTokenException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// Represents the pipeline metadata information returned as part of the output
-	// of a GetPipeline action.
-	Metadata *PipelineMetadata `locationName:"metadata" type:"structure"`
+// The nonce was specified in an invalid format.
+type InvalidNonceException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// Represents the structure of actions and stages to be performed in the pipeline.
-	Pipeline *PipelineDeclaration `locationName:"pipeline" type:"structure"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s GetPipelineOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidNonceException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetPipelineOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidNonceException) GoString() string {
 	return s.String()
 }
 
-// SetMetadata sets the Metadata field's value.
-func (s *GetPipelineOutput) SetMetadata(v *PipelineMetadata) *GetPipelineOutput {
-	s.Metadata = v
-	return s
+func newErrorInvalidNonceException(v protocol.ResponseMetadata) error {
+	return &InvalidNonceException{
+		RespMetadata: v,
+	}
 }
 
-// SetPipeline sets the Pipeline field's value.
-func (s *GetPipelineOutput) SetPipeline(v *PipelineDeclaration) *GetPipelineOutput {
-	s.Pipeline = v
-	return s
+// Code returns the exception type name.
+func (s *InvalidNonceException) Code() string {
+	return "InvalidNonceException"
 }
 
-// Represents the input of a GetPipelineState action.
-type GetPipelineStateInput struct {
-	_ struct{} `type:"structure"`
+// Message returns the exception's message.
+func (s *InvalidNonceException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
 
-	// The name of the pipeline about which you want to get information.
-	//
-	// Name is a required field
-	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidNonceException) OrigErr() error {
+	return nil
 }
 
-// String returns the string representation
-func (s GetPipelineStateInput) String() string {
+func (s *InvalidNonceException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidNonceException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidNonceException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The stage declaration was specified in an invalid format.
+type InvalidStageDeclarationException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidStageDeclarationException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetPipelineStateInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidStageDeclarationException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetPipelineStateInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetPipelineStateInput"}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
-	}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+func newErrorInvalidStageDeclarationException(v protocol.ResponseMetadata) error {
+	return &InvalidStageDeclarationException{
+		RespMetadata: v,
 	}
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// Code returns the exception type name.
+func (s *InvalidStageDeclarationException) Code() string {
+	return "InvalidStageDeclarationException"
 }
 
-// SetName sets the Name field's value.
-func (s *GetPipelineStateInput) SetName(v string) *GetPipelineStateInput {
-	s.Name = &v
-	return s
+// Message returns the exception's message.
+func (s *InvalidStageDeclarationException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// Represents the output of a GetPipelineState action.
-type GetPipelineStateOutput struct {
-	_ struct{} `type:"structure"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidStageDeclarationException) OrigErr() error {
+	return nil
+}
 
-	// The date and time the pipeline was created, in timestamp format.
-	Created *time.Time `locationName:"created" type:"timestamp"`
+func (s *InvalidStageDeclarationException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
 
-	// The name of the pipeline for which you want to get the state.
-	PipelineName *string `locationName:"pipelineName" min:"1" type:"string"`
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidStageDeclarationException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
 
-	// The version number of the pipeline.
-	//
-	// A newly created pipeline is always assigned a version number of 1.
-	PipelineVersion *int64 `locationName:"pipelineVersion" min:"1" type:"integer"`
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidStageDeclarationException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// A list of the pipeline stage output information, including stage name, state,
-	// most recent run details, whether the stage is disabled, and other data.
-	StageStates []*StageState `locationName:"stageStates" type:"list"`
+// The structure was specified in an invalid format.
+type InvalidStructureException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The date and time the pipeline was last updated, in timestamp format.
-	Updated *time.Time `locationName:"updated" type:"timestamp"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s GetPipelineStateOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidStructureException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetPipelineStateOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidStructureException) GoString() string {
 	return s.String()
 }
 
-// SetCreated sets the Created field's value.
-func (s *GetPipelineStateOutput) SetCreated(v time.Time) *GetPipelineStateOutput {
-	s.Created = &v
-	return s
+func newErrorInvalidStructureException(v protocol.ResponseMetadata) error {
+	return &InvalidStructureException{
+		RespMetadata: v,
+	}
 }
 
-// SetPipelineName sets the PipelineName field's value.
-func (s *GetPipelineStateOutput) SetPipelineName(v string) *GetPipelineStateOutput {
-	s.PipelineName = &v
-	return s
+// Code returns the exception type name.
+func (s *InvalidStructureException) Code() string {
+	return "InvalidStructureException"
 }
 
-// SetPipelineVersion sets the PipelineVersion field's value.
-func (s *GetPipelineStateOutput) SetPipelineVersion(v int64) *GetPipelineStateOutput {
-	s.PipelineVersion = &v
-	return s
+// Message returns the exception's message.
+func (s *InvalidStructureException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// SetStageStates sets the StageStates field's value.
-func (s *GetPipelineStateOutput) SetStageStates(v []*StageState) *GetPipelineStateOutput {
-	s.StageStates = v
-	return s
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidStructureException) OrigErr() error {
+	return nil
 }
 
-// SetUpdated sets the Updated field's value.
-func (s *GetPipelineStateOutput) SetUpdated(v time.Time) *GetPipelineStateOutput {
-	s.Updated = &v
-	return s
+func (s *InvalidStructureException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// Represents the input of a GetThirdPartyJobDetails action.
-type GetThirdPartyJobDetailsInput struct {
-	_ struct{} `type:"structure"`
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidStructureException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
 
-	// The clientToken portion of the clientId and clientToken pair used to verify
-	// that the calling entity is allowed access to the job and its details.
-	//
-	// ClientToken is a required field
-	ClientToken *string `locationName:"clientToken" min:"1" type:"string" required:"true"`
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidStructureException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The specified resource tags are invalid.
+type InvalidTagsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The unique system-generated ID used for identifying the job.
-	//
-	// JobId is a required field
-	JobId *string `locationName:"jobId" min:"1" type:"string" required:"true"`
+	Message_ *string `locationName:"message" min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s GetThirdPartyJobDetailsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidTagsException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetThirdPartyJobDetailsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidTagsException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetThirdPartyJobDetailsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetThirdPartyJobDetailsInput"}
-	if s.ClientToken == nil {
-		invalidParams.Add(request.NewErrParamRequired("ClientToken"))
-	}
-	if s.ClientToken != nil && len(*s.ClientToken) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("ClientToken", 1))
-	}
-	if s.JobId == nil {
-		invalidParams.Add(request.NewErrParamRequired("JobId"))
-	}
-	if s.JobId != nil && len(*s.JobId) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("JobId", 1))
+func newErrorInvalidTagsException(v protocol.ResponseMetadata) error {
+	return &InvalidTagsException{
+		RespMetadata: v,
 	}
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
+// Code returns the exception type name.
+func (s *InvalidTagsException) Code() string {
+	return "InvalidTagsException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidTagsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidTagsException) OrigErr() error {
 	return nil
 }
 
-// SetClientToken sets the ClientToken field's value.
-func (s *GetThirdPartyJobDetailsInput) SetClientToken(v string) *GetThirdPartyJobDetailsInput {
-	s.ClientToken = &v
-	return s
+func (s *InvalidTagsException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// SetJobId sets the JobId field's value.
-func (s *GetThirdPartyJobDetailsInput) SetJobId(v string) *GetThirdPartyJobDetailsInput {
-	s.JobId = &v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidTagsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// Represents the output of a GetThirdPartyJobDetails action.
-type GetThirdPartyJobDetailsOutput struct {
-	_ struct{} `type:"structure"`
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidTagsException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// The details of the job, including any protected values defined for the job.
-	JobDetails *ThirdPartyJobDetails `locationName:"jobDetails" type:"structure"`
+// The specified authentication type is in an invalid format.
+type InvalidWebhookAuthenticationParametersException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s GetThirdPartyJobDetailsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidWebhookAuthenticationParametersException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetThirdPartyJobDetailsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidWebhookAuthenticationParametersException) GoString() string {
 	return s.String()
 }
 
-// SetJobDetails sets the JobDetails field's value.
-func (s *GetThirdPartyJobDetailsOutput) SetJobDetails(v *ThirdPartyJobDetails) *GetThirdPartyJobDetailsOutput {
-	s.JobDetails = v
-	return s
+func newErrorInvalidWebhookAuthenticationParametersException(v protocol.ResponseMetadata) error {
+	return &InvalidWebhookAuthenticationParametersException{
+		RespMetadata: v,
+	}
 }
 
-// Represents information about an artifact to be worked on, such as a test
-// or build artifact.
-type InputArtifact struct {
-	_ struct{} `type:"structure"`
+// Code returns the exception type name.
+func (s *InvalidWebhookAuthenticationParametersException) Code() string {
+	return "InvalidWebhookAuthenticationParametersException"
+}
 
-	// The name of the artifact to be worked on (for example, "My App").
-	//
-	// The input artifact of an action must exactly match the output artifact declared
-	// in a preceding action, but the input artifact does not have to be the next
-	// action in strict sequence from the action that provided the output artifact.
-	// Actions in parallel can declare different output artifacts, which are in
-	// turn consumed by different following actions.
-	//
-	// Name is a required field
-	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
+// Message returns the exception's message.
+func (s *InvalidWebhookAuthenticationParametersException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// String returns the string representation
-func (s InputArtifact) String() string {
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidWebhookAuthenticationParametersException) OrigErr() error {
+	return nil
+}
+
+func (s *InvalidWebhookAuthenticationParametersException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidWebhookAuthenticationParametersException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidWebhookAuthenticationParametersException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The specified event filter rule is in an invalid format.
+type InvalidWebhookFilterPatternException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidWebhookFilterPatternException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s InputArtifact) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidWebhookFilterPatternException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *InputArtifact) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "InputArtifact"}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
-	}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+func newErrorInvalidWebhookFilterPatternException(v protocol.ResponseMetadata) error {
+	return &InvalidWebhookFilterPatternException{
+		RespMetadata: v,
 	}
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
+// Code returns the exception type name.
+func (s *InvalidWebhookFilterPatternException) Code() string {
+	return "InvalidWebhookFilterPatternException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidWebhookFilterPatternException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidWebhookFilterPatternException) OrigErr() error {
 	return nil
 }
 
-// SetName sets the Name field's value.
-func (s *InputArtifact) SetName(v string) *InputArtifact {
-	s.Name = &v
-	return s
+func (s *InvalidWebhookFilterPatternException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidWebhookFilterPatternException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidWebhookFilterPatternException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
 // Represents information about a job.
 type Job struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the AWS account to use when performing the job.
+	// The ID of the Amazon Web Services account to use when performing the job.
 	AccountId *string `locationName:"accountId" type:"string"`
 
 	// Other data about a job.
@@ -6918,18 +9991,26 @@ type Job struct {
 	// The unique system-generated ID of the job.
 	Id *string `locationName:"id" type:"string"`
 
-	// A system-generated random number that AWS CodePipeline uses to ensure that
-	// the job is being worked on by only one job worker. Use this number in an
-	// AcknowledgeJob request.
+	// A system-generated random number that CodePipeline uses to ensure that the
+	// job is being worked on by only one job worker. Use this number in an AcknowledgeJob
+	// request.
 	Nonce *string `locationName:"nonce" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Job) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Job) GoString() string {
 	return s.String()
 }
@@ -6969,18 +10050,22 @@ type JobData struct {
 	// Represents information about an action type.
 	ActionTypeId *ActionTypeId `locationName:"actionTypeId" type:"structure"`
 
-	// Represents an AWS session credentials object. These credentials are temporary
-	// credentials that are issued by AWS Secure Token Service (STS). They can be
-	// used to access input and output artifacts in the Amazon S3 bucket used to
-	// store artifacts for the pipeline in AWS CodePipeline.
+	// Represents an Amazon Web Services session credentials object. These credentials
+	// are temporary credentials that are issued by Amazon Web Services Secure Token
+	// Service (STS). They can be used to access input and output artifacts in the
+	// S3 bucket used to store artifacts for the pipeline in CodePipeline.
+	//
+	// ArtifactCredentials is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by JobData's
+	// String and GoString methods.
 	ArtifactCredentials *AWSSessionCredentials `locationName:"artifactCredentials" type:"structure" sensitive:"true"`
 
-	// A system-generated token, such as a AWS CodeDeploy deployment ID, required
-	// by a job to continue the job asynchronously.
+	// A system-generated token, such as a deployment ID, required by a job to continue
+	// the job asynchronously.
 	ContinuationToken *string `locationName:"continuationToken" min:"1" type:"string"`
 
 	// Represents information about the key used to encrypt data in the artifact
-	// store, such as an AWS Key Management Service (AWS KMS) key.
+	// store, such as an KMS key.
 	EncryptionKey *EncryptionKey `locationName:"encryptionKey" type:"structure"`
 
 	// The artifact supplied to the job.
@@ -6995,12 +10080,20 @@ type JobData struct {
 	PipelineContext *PipelineContext `locationName:"pipelineContext" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s JobData) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s JobData) GoString() string {
 	return s.String()
 }
@@ -7057,7 +10150,7 @@ func (s *JobData) SetPipelineContext(v *PipelineContext) *JobData {
 type JobDetails struct {
 	_ struct{} `type:"structure"`
 
-	// The AWS account ID associated with the job.
+	// The Amazon Web Services account ID associated with the job.
 	AccountId *string `locationName:"accountId" type:"string"`
 
 	// Represents other information about a job required for a job worker to complete
@@ -7068,12 +10161,20 @@ type JobDetails struct {
 	Id *string `locationName:"id" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s JobDetails) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s JobDetails) GoString() string {
 	return s.String()
 }
@@ -7096,6 +10197,245 @@ func (s *JobDetails) SetId(v string) *JobDetails {
 	return s
 }
 
+// The job was specified in an invalid format or cannot be found.
+type JobNotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s JobNotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s JobNotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorJobNotFoundException(v protocol.ResponseMetadata) error {
+	return &JobNotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *JobNotFoundException) Code() string {
+	return "JobNotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *JobNotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *JobNotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *JobNotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *JobNotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *JobNotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Details about the polling configuration for the JobWorker action engine,
+// or executor.
+type JobWorkerExecutorConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	// The accounts in which the job worker is configured and might poll for jobs
+	// as part of the action execution.
+	PollingAccounts []*string `locationName:"pollingAccounts" min:"1" type:"list"`
+
+	// The service Principals in which the job worker is configured and might poll
+	// for jobs as part of the action execution.
+	PollingServicePrincipals []*string `locationName:"pollingServicePrincipals" min:"1" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s JobWorkerExecutorConfiguration) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s JobWorkerExecutorConfiguration) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *JobWorkerExecutorConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "JobWorkerExecutorConfiguration"}
+	if s.PollingAccounts != nil && len(s.PollingAccounts) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("PollingAccounts", 1))
+	}
+	if s.PollingServicePrincipals != nil && len(s.PollingServicePrincipals) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("PollingServicePrincipals", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetPollingAccounts sets the PollingAccounts field's value.
+func (s *JobWorkerExecutorConfiguration) SetPollingAccounts(v []*string) *JobWorkerExecutorConfiguration {
+	s.PollingAccounts = v
+	return s
+}
+
+// SetPollingServicePrincipals sets the PollingServicePrincipals field's value.
+func (s *JobWorkerExecutorConfiguration) SetPollingServicePrincipals(v []*string) *JobWorkerExecutorConfiguration {
+	s.PollingServicePrincipals = v
+	return s
+}
+
+// Details about the configuration for the Lambda action engine, or executor.
+type LambdaExecutorConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the Lambda function used by the action engine.
+	//
+	// LambdaFunctionArn is a required field
+	LambdaFunctionArn *string `locationName:"lambdaFunctionArn" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LambdaExecutorConfiguration) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LambdaExecutorConfiguration) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *LambdaExecutorConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "LambdaExecutorConfiguration"}
+	if s.LambdaFunctionArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("LambdaFunctionArn"))
+	}
+	if s.LambdaFunctionArn != nil && len(*s.LambdaFunctionArn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("LambdaFunctionArn", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetLambdaFunctionArn sets the LambdaFunctionArn field's value.
+func (s *LambdaExecutorConfiguration) SetLambdaFunctionArn(v string) *LambdaExecutorConfiguration {
+	s.LambdaFunctionArn = &v
+	return s
+}
+
+// The number of pipelines associated with the Amazon Web Services account has
+// exceeded the limit allowed for the account.
+type LimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) GoString() string {
+	return s.String()
+}
+
+func newErrorLimitExceededException(v protocol.ResponseMetadata) error {
+	return &LimitExceededException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *LimitExceededException) Code() string {
+	return "LimitExceededException"
+}
+
+// Message returns the exception's message.
+func (s *LimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *LimitExceededException) OrigErr() error {
+	return nil
+}
+
+func (s *LimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *LimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *LimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 type ListActionExecutionsInput struct {
 	_ struct{} `type:"structure"`
 
@@ -7121,12 +10461,20 @@ type ListActionExecutionsInput struct {
 	PipelineName *string `locationName:"pipelineName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListActionExecutionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListActionExecutionsInput) GoString() string {
 	return s.String()
 }
@@ -7189,12 +10537,20 @@ type ListActionExecutionsOutput struct {
 	NextToken *string `locationName:"nextToken" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListActionExecutionsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListActionExecutionsOutput) GoString() string {
 	return s.String()
 }
@@ -7221,14 +10577,25 @@ type ListActionTypesInput struct {
 	// An identifier that was returned from the previous list action types call,
 	// which can be used to return the next set of action types in the list.
 	NextToken *string `locationName:"nextToken" min:"1" type:"string"`
+
+	// The Region to filter on for the list of action types.
+	RegionFilter *string `locationName:"regionFilter" min:"4" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListActionTypesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListActionTypesInput) GoString() string {
 	return s.String()
 }
@@ -7239,6 +10606,9 @@ func (s *ListActionTypesInput) Validate() error {
 	if s.NextToken != nil && len(*s.NextToken) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
 	}
+	if s.RegionFilter != nil && len(*s.RegionFilter) < 4 {
+		invalidParams.Add(request.NewErrParamMinLen("RegionFilter", 4))
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -7258,6 +10628,12 @@ func (s *ListActionTypesInput) SetNextToken(v string) *ListActionTypesInput {
 	return s
 }
 
+// SetRegionFilter sets the RegionFilter field's value.
+func (s *ListActionTypesInput) SetRegionFilter(v string) *ListActionTypesInput {
+	s.RegionFilter = &v
+	return s
+}
+
 // Represents the output of a ListActionTypes action.
 type ListActionTypesOutput struct {
 	_ struct{} `type:"structure"`
@@ -7273,12 +10649,20 @@ type ListActionTypesOutput struct {
 	NextToken *string `locationName:"nextToken" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListActionTypesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListActionTypesOutput) GoString() string {
 	return s.String()
 }
@@ -7315,12 +10699,20 @@ type ListPipelineExecutionsInput struct {
 	PipelineName *string `locationName:"pipelineName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPipelineExecutionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPipelineExecutionsInput) GoString() string {
 	return s.String()
 }
@@ -7378,12 +10770,20 @@ type ListPipelineExecutionsOutput struct {
 	PipelineExecutionSummaries []*PipelineExecutionSummary `locationName:"pipelineExecutionSummaries" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPipelineExecutionsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPipelineExecutionsOutput) GoString() string {
 	return s.String()
 }
@@ -7404,17 +10804,30 @@ func (s *ListPipelineExecutionsOutput) SetPipelineExecutionSummaries(v []*Pipeli
 type ListPipelinesInput struct {
 	_ struct{} `type:"structure"`
 
+	// The maximum number of pipelines to return in a single call. To retrieve the
+	// remaining pipelines, make another call with the returned nextToken value.
+	// The minimum value you can specify is 1. The maximum accepted value is 1000.
+	MaxResults *int64 `locationName:"maxResults" min:"1" type:"integer"`
+
 	// An identifier that was returned from the previous list pipelines call. It
 	// can be used to return the next set of pipelines in the list.
 	NextToken *string `locationName:"nextToken" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPipelinesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPipelinesInput) GoString() string {
 	return s.String()
 }
@@ -7422,6 +10835,9 @@ func (s ListPipelinesInput) GoString() string {
 // Validate inspects the fields of the type to determine if they are valid.
 func (s *ListPipelinesInput) Validate() error {
 	invalidParams := request.ErrInvalidParams{Context: "ListPipelinesInput"}
+	if s.MaxResults != nil && *s.MaxResults < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+	}
 	if s.NextToken != nil && len(*s.NextToken) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
 	}
@@ -7432,6 +10848,12 @@ func (s *ListPipelinesInput) Validate() error {
 	return nil
 }
 
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListPipelinesInput) SetMaxResults(v int64) *ListPipelinesInput {
+	s.MaxResults = &v
+	return s
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *ListPipelinesInput) SetNextToken(v string) *ListPipelinesInput {
 	s.NextToken = &v
@@ -7451,12 +10873,20 @@ type ListPipelinesOutput struct {
 	Pipelines []*PipelineSummary `locationName:"pipelines" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPipelinesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPipelinesOutput) GoString() string {
 	return s.String()
 }
@@ -7490,12 +10920,20 @@ type ListTagsForResourceInput struct {
 	ResourceArn *string `locationName:"resourceArn" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceInput) GoString() string {
 	return s.String()
 }
@@ -7550,12 +10988,20 @@ type ListTagsForResourceOutput struct {
 	Tags []*Tag `locationName:"tags" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceOutput) GoString() string {
 	return s.String()
 }
@@ -7608,12 +11054,20 @@ type ListWebhookItem struct {
 	Url *string `locationName:"url" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListWebhookItem) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListWebhookItem) GoString() string {
 	return s.String()
 }
@@ -7672,12 +11126,20 @@ type ListWebhooksInput struct {
 	NextToken *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListWebhooksInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListWebhooksInput) GoString() string {
 	return s.String()
 }
@@ -7723,12 +11185,20 @@ type ListWebhooksOutput struct {
 	Webhooks []*ListWebhookItem `locationName:"webhooks" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListWebhooksOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListWebhooksOutput) GoString() string {
 	return s.String()
 }
@@ -7745,6 +11215,71 @@ func (s *ListWebhooksOutput) SetWebhooks(v []*ListWebhookItem) *ListWebhooksOutp
 	return s
 }
 
+// The stage has failed in a later run of the pipeline and the pipelineExecutionId
+// associated with the request is out of date.
+type NotLatestPipelineExecutionException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotLatestPipelineExecutionException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotLatestPipelineExecutionException) GoString() string {
+	return s.String()
+}
+
+func newErrorNotLatestPipelineExecutionException(v protocol.ResponseMetadata) error {
+	return &NotLatestPipelineExecutionException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *NotLatestPipelineExecutionException) Code() string {
+	return "NotLatestPipelineExecutionException"
+}
+
+// Message returns the exception's message.
+func (s *NotLatestPipelineExecutionException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *NotLatestPipelineExecutionException) OrigErr() error {
+	return nil
+}
+
+func (s *NotLatestPipelineExecutionException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *NotLatestPipelineExecutionException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *NotLatestPipelineExecutionException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Represents information about the output of an action.
 type OutputArtifact struct {
 	_ struct{} `type:"structure"`
@@ -7763,12 +11298,20 @@ type OutputArtifact struct {
 	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OutputArtifact) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OutputArtifact) GoString() string {
 	return s.String()
 }
@@ -7795,6 +11338,70 @@ func (s *OutputArtifact) SetName(v string) *OutputArtifact {
 	return s
 }
 
+// Exceeded the total size limit for all variables in the pipeline.
+type OutputVariablesSizeExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s OutputVariablesSizeExceededException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s OutputVariablesSizeExceededException) GoString() string {
+	return s.String()
+}
+
+func newErrorOutputVariablesSizeExceededException(v protocol.ResponseMetadata) error {
+	return &OutputVariablesSizeExceededException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *OutputVariablesSizeExceededException) Code() string {
+	return "OutputVariablesSizeExceededException"
+}
+
+// Message returns the exception's message.
+func (s *OutputVariablesSizeExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *OutputVariablesSizeExceededException) OrigErr() error {
+	return nil
+}
+
+func (s *OutputVariablesSizeExceededException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *OutputVariablesSizeExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *OutputVariablesSizeExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Represents information about a pipeline to a job worker.
 //
 // PipelineContext contains pipelineArn and pipelineExecutionId for custom action
@@ -7820,12 +11427,20 @@ type PipelineContext struct {
 	Stage *StageContext `locationName:"stage" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PipelineContext) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PipelineContext) GoString() string {
 	return s.String()
 }
@@ -7864,29 +11479,29 @@ func (s *PipelineContext) SetStage(v *StageContext) *PipelineContext {
 type PipelineDeclaration struct {
 	_ struct{} `type:"structure"`
 
-	// Represents information about the Amazon S3 bucket where artifacts are stored
-	// for the pipeline.
+	// Represents information about the S3 bucket where artifacts are stored for
+	// the pipeline.
 	//
 	// You must include either artifactStore or artifactStores in your pipeline,
 	// but you cannot use both. If you create a cross-region action in your pipeline,
 	// you must use artifactStores.
 	ArtifactStore *ArtifactStore `locationName:"artifactStore" type:"structure"`
 
-	// A mapping of artifactStore objects and their corresponding AWS Regions. There
-	// must be an artifact store for the pipeline Region and for each cross-region
-	// action in the pipeline.
+	// A mapping of artifactStore objects and their corresponding Amazon Web Services
+	// Regions. There must be an artifact store for the pipeline Region and for
+	// each cross-region action in the pipeline.
 	//
 	// You must include either artifactStore or artifactStores in your pipeline,
 	// but you cannot use both. If you create a cross-region action in your pipeline,
 	// you must use artifactStores.
 	ArtifactStores map[string]*ArtifactStore `locationName:"artifactStores" type:"map"`
 
-	// The name of the action to be performed.
+	// The name of the pipeline.
 	//
 	// Name is a required field
 	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
 
-	// The Amazon Resource Name (ARN) for AWS CodePipeline to use to either perform
+	// The Amazon Resource Name (ARN) for CodePipeline to use to either perform
 	// actions with no actionRoleArn, or to use to assume roles for actions with
 	// an actionRoleArn.
 	//
@@ -7903,12 +11518,20 @@ type PipelineDeclaration struct {
 	Version *int64 `locationName:"version" min:"1" type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PipelineDeclaration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PipelineDeclaration) GoString() string {
 	return s.String()
 }
@@ -8009,32 +11632,55 @@ type PipelineExecution struct {
 	// The ID of the pipeline execution.
 	PipelineExecutionId *string `locationName:"pipelineExecutionId" type:"string"`
 
-	// The name of the pipeline that was executed.
+	// The name of the pipeline with the specified pipeline execution.
 	PipelineName *string `locationName:"pipelineName" min:"1" type:"string"`
 
-	// The version number of the pipeline that was executed.
+	// The version number of the pipeline with the specified pipeline execution.
 	PipelineVersion *int64 `locationName:"pipelineVersion" min:"1" type:"integer"`
 
 	// The status of the pipeline execution.
 	//
+	//    * Cancelled: The pipeline’s definition was updated before the pipeline
+	//    execution could be completed.
+	//
 	//    * InProgress: The pipeline execution is currently running.
 	//
+	//    * Stopped: The pipeline execution was manually stopped. For more information,
+	//    see Stopped Executions (https://docs.aws.amazon.com/codepipeline/latest/userguide/concepts.html#concepts-executions-stopped).
+	//
+	//    * Stopping: The pipeline execution received a request to be manually stopped.
+	//    Depending on the selected stop mode, the execution is either completing
+	//    or abandoning in-progress actions. For more information, see Stopped Executions
+	//    (https://docs.aws.amazon.com/codepipeline/latest/userguide/concepts.html#concepts-executions-stopped).
+	//
 	//    * Succeeded: The pipeline execution was completed successfully.
 	//
 	//    * Superseded: While this pipeline execution was waiting for the next stage
 	//    to be completed, a newer pipeline execution advanced and continued through
-	//    the pipeline instead.
+	//    the pipeline instead. For more information, see Superseded Executions
+	//    (https://docs.aws.amazon.com/codepipeline/latest/userguide/concepts.html#concepts-superseded).
 	//
 	//    * Failed: The pipeline execution was not completed successfully.
 	Status *string `locationName:"status" type:"string" enum:"PipelineExecutionStatus"`
+
+	// A summary that contains a description of the pipeline execution status.
+	StatusSummary *string `locationName:"statusSummary" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PipelineExecution) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PipelineExecution) GoString() string {
 	return s.String()
 }
@@ -8069,6 +11715,142 @@ func (s *PipelineExecution) SetStatus(v string) *PipelineExecution {
 	return s
 }
 
+// SetStatusSummary sets the StatusSummary field's value.
+func (s *PipelineExecution) SetStatusSummary(v string) *PipelineExecution {
+	s.StatusSummary = &v
+	return s
+}
+
+// The pipeline execution was specified in an invalid format or cannot be found,
+// or an execution ID does not belong to the specified pipeline.
+type PipelineExecutionNotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PipelineExecutionNotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PipelineExecutionNotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorPipelineExecutionNotFoundException(v protocol.ResponseMetadata) error {
+	return &PipelineExecutionNotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *PipelineExecutionNotFoundException) Code() string {
+	return "PipelineExecutionNotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *PipelineExecutionNotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *PipelineExecutionNotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *PipelineExecutionNotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *PipelineExecutionNotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *PipelineExecutionNotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Unable to stop the pipeline execution. The execution might already be in
+// a Stopped state, or it might no longer be in progress.
+type PipelineExecutionNotStoppableException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PipelineExecutionNotStoppableException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PipelineExecutionNotStoppableException) GoString() string {
+	return s.String()
+}
+
+func newErrorPipelineExecutionNotStoppableException(v protocol.ResponseMetadata) error {
+	return &PipelineExecutionNotStoppableException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *PipelineExecutionNotStoppableException) Code() string {
+	return "PipelineExecutionNotStoppableException"
+}
+
+// Message returns the exception's message.
+func (s *PipelineExecutionNotStoppableException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *PipelineExecutionNotStoppableException) OrigErr() error {
+	return nil
+}
+
+func (s *PipelineExecutionNotStoppableException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *PipelineExecutionNotStoppableException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *PipelineExecutionNotStoppableException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Summary information about a pipeline execution.
 type PipelineExecutionSummary struct {
 	_ struct{} `type:"structure"`
@@ -8090,26 +11872,46 @@ type PipelineExecutionSummary struct {
 	//
 	//    * InProgress: The pipeline execution is currently running.
 	//
+	//    * Stopped: The pipeline execution was manually stopped. For more information,
+	//    see Stopped Executions (https://docs.aws.amazon.com/codepipeline/latest/userguide/concepts.html#concepts-executions-stopped).
+	//
+	//    * Stopping: The pipeline execution received a request to be manually stopped.
+	//    Depending on the selected stop mode, the execution is either completing
+	//    or abandoning in-progress actions. For more information, see Stopped Executions
+	//    (https://docs.aws.amazon.com/codepipeline/latest/userguide/concepts.html#concepts-executions-stopped).
+	//
 	//    * Succeeded: The pipeline execution was completed successfully.
 	//
 	//    * Superseded: While this pipeline execution was waiting for the next stage
 	//    to be completed, a newer pipeline execution advanced and continued through
-	//    the pipeline instead.
+	//    the pipeline instead. For more information, see Superseded Executions
+	//    (https://docs.aws.amazon.com/codepipeline/latest/userguide/concepts.html#concepts-superseded).
 	//
 	//    * Failed: The pipeline execution was not completed successfully.
 	Status *string `locationName:"status" type:"string" enum:"PipelineExecutionStatus"`
 
+	// The interaction that stopped a pipeline execution.
+	StopTrigger *StopExecutionTrigger `locationName:"stopTrigger" type:"structure"`
+
 	// The interaction or event that started a pipeline execution, such as automated
 	// change detection or a StartPipelineExecution API call.
 	Trigger *ExecutionTrigger `locationName:"trigger" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PipelineExecutionSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PipelineExecutionSummary) GoString() string {
 	return s.String()
 }
@@ -8144,6 +11946,12 @@ func (s *PipelineExecutionSummary) SetStatus(v string) *PipelineExecutionSummary
 	return s
 }
 
+// SetStopTrigger sets the StopTrigger field's value.
+func (s *PipelineExecutionSummary) SetStopTrigger(v *StopExecutionTrigger) *PipelineExecutionSummary {
+	s.StopTrigger = v
+	return s
+}
+
 // SetTrigger sets the Trigger field's value.
 func (s *PipelineExecutionSummary) SetTrigger(v *ExecutionTrigger) *PipelineExecutionSummary {
 	s.Trigger = v
@@ -8160,16 +11968,33 @@ type PipelineMetadata struct {
 	// The Amazon Resource Name (ARN) of the pipeline.
 	PipelineArn *string `locationName:"pipelineArn" type:"string"`
 
+	// The date and time that polling for source changes (periodic checks) was stopped
+	// for the pipeline, in timestamp format. You can migrate (update) a polling
+	// pipeline to use event-based change detection. For example, for a pipeline
+	// with a CodeCommit source, we recommend you migrate (update) your pipeline
+	// to use CloudWatch Events. To learn more, see Migrate polling pipelines to
+	// use event-based change detection (https://docs.aws.amazon.com/codepipeline/latest/userguide/update-change-detection.html)
+	// in the CodePipeline User Guide.
+	PollingDisabledAt *time.Time `locationName:"pollingDisabledAt" type:"timestamp"`
+
 	// The date and time the pipeline was last updated, in timestamp format.
 	Updated *time.Time `locationName:"updated" type:"timestamp"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PipelineMetadata) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PipelineMetadata) GoString() string {
 	return s.String()
 }
@@ -8186,12 +12011,146 @@ func (s *PipelineMetadata) SetPipelineArn(v string) *PipelineMetadata {
 	return s
 }
 
+// SetPollingDisabledAt sets the PollingDisabledAt field's value.
+func (s *PipelineMetadata) SetPollingDisabledAt(v time.Time) *PipelineMetadata {
+	s.PollingDisabledAt = &v
+	return s
+}
+
 // SetUpdated sets the Updated field's value.
 func (s *PipelineMetadata) SetUpdated(v time.Time) *PipelineMetadata {
 	s.Updated = &v
 	return s
 }
 
+// The specified pipeline name is already in use.
+type PipelineNameInUseException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PipelineNameInUseException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PipelineNameInUseException) GoString() string {
+	return s.String()
+}
+
+func newErrorPipelineNameInUseException(v protocol.ResponseMetadata) error {
+	return &PipelineNameInUseException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *PipelineNameInUseException) Code() string {
+	return "PipelineNameInUseException"
+}
+
+// Message returns the exception's message.
+func (s *PipelineNameInUseException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *PipelineNameInUseException) OrigErr() error {
+	return nil
+}
+
+func (s *PipelineNameInUseException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *PipelineNameInUseException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *PipelineNameInUseException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The pipeline was specified in an invalid format or cannot be found.
+type PipelineNotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PipelineNotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PipelineNotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorPipelineNotFoundException(v protocol.ResponseMetadata) error {
+	return &PipelineNotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *PipelineNotFoundException) Code() string {
+	return "PipelineNotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *PipelineNotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *PipelineNotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *PipelineNotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *PipelineNotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *PipelineNotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Returns a summary of a pipeline.
 type PipelineSummary struct {
 	_ struct{} `type:"structure"`
@@ -8209,12 +12168,20 @@ type PipelineSummary struct {
 	Version *int64 `locationName:"version" min:"1" type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PipelineSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PipelineSummary) GoString() string {
 	return s.String()
 }
@@ -8243,6 +12210,70 @@ func (s *PipelineSummary) SetVersion(v int64) *PipelineSummary {
 	return s
 }
 
+// The pipeline version was specified in an invalid format or cannot be found.
+type PipelineVersionNotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PipelineVersionNotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PipelineVersionNotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorPipelineVersionNotFoundException(v protocol.ResponseMetadata) error {
+	return &PipelineVersionNotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *PipelineVersionNotFoundException) Code() string {
+	return "PipelineVersionNotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *PipelineVersionNotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *PipelineVersionNotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *PipelineVersionNotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *PipelineVersionNotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *PipelineVersionNotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Represents the input of a PollForJobs action.
 type PollForJobsInput struct {
 	_ struct{} `type:"structure"`
@@ -8262,12 +12293,20 @@ type PollForJobsInput struct {
 	QueryParam map[string]*string `locationName:"queryParam" type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PollForJobsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PollForJobsInput) GoString() string {
 	return s.String()
 }
@@ -8319,12 +12358,20 @@ type PollForJobsOutput struct {
 	Jobs []*Job `locationName:"jobs" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PollForJobsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PollForJobsOutput) GoString() string {
 	return s.String()
 }
@@ -8348,12 +12395,20 @@ type PollForThirdPartyJobsInput struct {
 	MaxBatchSize *int64 `locationName:"maxBatchSize" min:"1" type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PollForThirdPartyJobsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PollForThirdPartyJobsInput) GoString() string {
 	return s.String()
 }
@@ -8399,12 +12454,20 @@ type PollForThirdPartyJobsOutput struct {
 	Jobs []*ThirdPartyJob `locationName:"jobs" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PollForThirdPartyJobsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PollForThirdPartyJobsOutput) GoString() string {
 	return s.String()
 }
@@ -8440,12 +12503,20 @@ type PutActionRevisionInput struct {
 	StageName *string `locationName:"stageName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutActionRevisionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutActionRevisionInput) GoString() string {
 	return s.String()
 }
@@ -8522,12 +12593,20 @@ type PutActionRevisionOutput struct {
 	PipelineExecutionId *string `locationName:"pipelineExecutionId" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutActionRevisionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutActionRevisionOutput) GoString() string {
 	return s.String()
 }
@@ -8577,12 +12656,20 @@ type PutApprovalResultInput struct {
 	Token *string `locationName:"token" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutApprovalResultInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutApprovalResultInput) GoString() string {
 	return s.String()
 }
@@ -8664,12 +12751,20 @@ type PutApprovalResultOutput struct {
 	ApprovedAt *time.Time `locationName:"approvedAt" type:"timestamp"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutApprovalResultOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutApprovalResultOutput) GoString() string {
 	return s.String()
 }
@@ -8696,12 +12791,20 @@ type PutJobFailureResultInput struct {
 	JobId *string `locationName:"jobId" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutJobFailureResultInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutJobFailureResultInput) GoString() string {
 	return s.String()
 }
@@ -8743,12 +12846,20 @@ type PutJobFailureResultOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutJobFailureResultOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutJobFailureResultOutput) GoString() string {
 	return s.String()
 }
@@ -8757,8 +12868,8 @@ func (s PutJobFailureResultOutput) GoString() string {
 type PutJobSuccessResultInput struct {
 	_ struct{} `type:"structure"`
 
-	// A token generated by a job worker, such as an AWS CodeDeploy deployment ID,
-	// that a successful job provides to identify a custom action in progress. Future
+	// A token generated by a job worker, such as a CodeDeploy deployment ID, that
+	// a successful job provides to identify a custom action in progress. Future
 	// jobs use this token to identify the running instance of the action. It can
 	// be reused to return more information about the progress of the custom action.
 	// When the action is complete, no continuation token should be supplied.
@@ -8777,14 +12888,27 @@ type PutJobSuccessResultInput struct {
 	//
 	// JobId is a required field
 	JobId *string `locationName:"jobId" type:"string" required:"true"`
+
+	// Key-value pairs produced as output by a job worker that can be made available
+	// to a downstream action configuration. outputVariables can be included only
+	// when there is no continuation token on the request.
+	OutputVariables map[string]*string `locationName:"outputVariables" type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutJobSuccessResultInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutJobSuccessResultInput) GoString() string {
 	return s.String()
 }
@@ -8839,16 +12963,30 @@ func (s *PutJobSuccessResultInput) SetJobId(v string) *PutJobSuccessResultInput
 	return s
 }
 
+// SetOutputVariables sets the OutputVariables field's value.
+func (s *PutJobSuccessResultInput) SetOutputVariables(v map[string]*string) *PutJobSuccessResultInput {
+	s.OutputVariables = v
+	return s
+}
+
 type PutJobSuccessResultOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutJobSuccessResultOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutJobSuccessResultOutput) GoString() string {
 	return s.String()
 }
@@ -8874,12 +13012,20 @@ type PutThirdPartyJobFailureResultInput struct {
 	JobId *string `locationName:"jobId" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutThirdPartyJobFailureResultInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutThirdPartyJobFailureResultInput) GoString() string {
 	return s.String()
 }
@@ -8936,12 +13082,20 @@ type PutThirdPartyJobFailureResultOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutThirdPartyJobFailureResultOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutThirdPartyJobFailureResultOutput) GoString() string {
 	return s.String()
 }
@@ -8956,11 +13110,11 @@ type PutThirdPartyJobSuccessResultInput struct {
 	// ClientToken is a required field
 	ClientToken *string `locationName:"clientToken" min:"1" type:"string" required:"true"`
 
-	// A token generated by a job worker, such as an AWS CodeDeploy deployment ID,
-	// that a successful job provides to identify a partner action in progress.
-	// Future jobs use this token to identify the running instance of the action.
-	// It can be reused to return more information about the progress of the partner
-	// action. When the action is complete, no continuation token should be supplied.
+	// A token generated by a job worker, such as a CodeDeploy deployment ID, that
+	// a successful job provides to identify a partner action in progress. Future
+	// jobs use this token to identify the running instance of the action. It can
+	// be reused to return more information about the progress of the partner action.
+	// When the action is complete, no continuation token should be supplied.
 	ContinuationToken *string `locationName:"continuationToken" min:"1" type:"string"`
 
 	// Represents information about a current revision.
@@ -8977,12 +13131,20 @@ type PutThirdPartyJobSuccessResultInput struct {
 	JobId *string `locationName:"jobId" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutThirdPartyJobSuccessResultInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutThirdPartyJobSuccessResultInput) GoString() string {
 	return s.String()
 }
@@ -9056,12 +13218,20 @@ type PutThirdPartyJobSuccessResultOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutThirdPartyJobSuccessResultOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutThirdPartyJobSuccessResultOutput) GoString() string {
 	return s.String()
 }
@@ -9082,12 +13252,20 @@ type PutWebhookInput struct {
 	Webhook *WebhookDefinition `locationName:"webhook" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutWebhookInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutWebhookInput) GoString() string {
 	return s.String()
 }
@@ -9140,12 +13318,20 @@ type PutWebhookOutput struct {
 	Webhook *ListWebhookItem `locationName:"webhook" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutWebhookOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutWebhookOutput) GoString() string {
 	return s.String()
 }
@@ -9164,12 +13350,20 @@ type RegisterWebhookWithThirdPartyInput struct {
 	WebhookName *string `locationName:"webhookName" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RegisterWebhookWithThirdPartyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RegisterWebhookWithThirdPartyInput) GoString() string {
 	return s.String()
 }
@@ -9197,16 +13391,152 @@ type RegisterWebhookWithThirdPartyOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RegisterWebhookWithThirdPartyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RegisterWebhookWithThirdPartyOutput) GoString() string {
 	return s.String()
 }
 
+// The request failed because of an unknown error, exception, or failure.
+type RequestFailedException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RequestFailedException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RequestFailedException) GoString() string {
+	return s.String()
+}
+
+func newErrorRequestFailedException(v protocol.ResponseMetadata) error {
+	return &RequestFailedException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *RequestFailedException) Code() string {
+	return "RequestFailedException"
+}
+
+// Message returns the exception's message.
+func (s *RequestFailedException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *RequestFailedException) OrigErr() error {
+	return nil
+}
+
+func (s *RequestFailedException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *RequestFailedException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *RequestFailedException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The resource was specified in an invalid format.
+type ResourceNotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceNotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceNotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorResourceNotFoundException(v protocol.ResponseMetadata) error {
+	return &ResourceNotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ResourceNotFoundException) Code() string {
+	return "ResourceNotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *ResourceNotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ResourceNotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *ResourceNotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ResourceNotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ResourceNotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Represents the input of a RetryStageExecution action.
 type RetryStageExecutionInput struct {
 	_ struct{} `type:"structure"`
@@ -9223,7 +13553,7 @@ type RetryStageExecutionInput struct {
 	// PipelineName is a required field
 	PipelineName *string `locationName:"pipelineName" min:"1" type:"string" required:"true"`
 
-	// The scope of the retry attempt. Currently, the only supported value is FAILED_ACTIONS.
+	// The scope of the retry attempt.
 	//
 	// RetryMode is a required field
 	RetryMode *string `locationName:"retryMode" type:"string" required:"true" enum:"StageRetryMode"`
@@ -9234,12 +13564,20 @@ type RetryStageExecutionInput struct {
 	StageName *string `locationName:"stageName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RetryStageExecutionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RetryStageExecutionInput) GoString() string {
 	return s.String()
 }
@@ -9304,12 +13642,20 @@ type RetryStageExecutionOutput struct {
 	PipelineExecutionId *string `locationName:"pipelineExecutionId" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RetryStageExecutionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RetryStageExecutionOutput) GoString() string {
 	return s.String()
 }
@@ -9320,28 +13666,36 @@ func (s *RetryStageExecutionOutput) SetPipelineExecutionId(v string) *RetryStage
 	return s
 }
 
-// The location of the Amazon S3 bucket that contains a revision.
+// The location of the S3 bucket that contains a revision.
 type S3ArtifactLocation struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the Amazon S3 bucket.
+	// The name of the S3 bucket.
 	//
 	// BucketName is a required field
 	BucketName *string `locationName:"bucketName" type:"string" required:"true"`
 
-	// The key of the object in the Amazon S3 bucket, which uniquely identifies
-	// the object in the bucket.
+	// The key of the object in the S3 bucket, which uniquely identifies the object
+	// in the bucket.
 	//
 	// ObjectKey is a required field
 	ObjectKey *string `locationName:"objectKey" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s S3ArtifactLocation) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s S3ArtifactLocation) GoString() string {
 	return s.String()
 }
@@ -9369,12 +13723,20 @@ type S3Location struct {
 	Key *string `locationName:"key" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s S3Location) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s S3Location) GoString() string {
 	return s.String()
 }
@@ -9406,23 +13768,30 @@ type SourceRevision struct {
 	RevisionId *string `locationName:"revisionId" min:"1" type:"string"`
 
 	// Summary information about the most recent revision of the artifact. For GitHub
-	// and AWS CodeCommit repositories, the commit message. For Amazon S3 buckets
-	// or actions, the user-provided content of a codepipeline-artifact-revision-summary
+	// and CodeCommit repositories, the commit message. For Amazon S3 buckets or
+	// actions, the user-provided content of a codepipeline-artifact-revision-summary
 	// key specified in the object metadata.
 	RevisionSummary *string `locationName:"revisionSummary" min:"1" type:"string"`
 
 	// The commit ID for the artifact revision. For artifacts stored in GitHub or
-	// AWS CodeCommit repositories, the commit ID is linked to a commit details
-	// page.
+	// CodeCommit repositories, the commit ID is linked to a commit details page.
 	RevisionUrl *string `locationName:"revisionUrl" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SourceRevision) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SourceRevision) GoString() string {
 	return s.String()
 }
@@ -9459,12 +13828,20 @@ type StageContext struct {
 	Name *string `locationName:"name" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StageContext) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StageContext) GoString() string {
 	return s.String()
 }
@@ -9493,12 +13870,20 @@ type StageDeclaration struct {
 	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StageDeclaration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StageDeclaration) GoString() string {
 	return s.String()
 }
@@ -9572,16 +13957,27 @@ type StageExecution struct {
 	// The status of the stage, or for a completed stage, the last status of the
 	// stage.
 	//
+	// A status of cancelled means that the pipeline’s definition was updated
+	// before the stage execution could be completed.
+	//
 	// Status is a required field
 	Status *string `locationName:"status" type:"string" required:"true" enum:"StageExecutionStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StageExecution) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StageExecution) GoString() string {
 	return s.String()
 }
@@ -9598,6 +13994,135 @@ func (s *StageExecution) SetStatus(v string) *StageExecution {
 	return s
 }
 
+// The stage was specified in an invalid format or cannot be found.
+type StageNotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StageNotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StageNotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorStageNotFoundException(v protocol.ResponseMetadata) error {
+	return &StageNotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *StageNotFoundException) Code() string {
+	return "StageNotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *StageNotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *StageNotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *StageNotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *StageNotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *StageNotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Unable to retry. The pipeline structure or stage state might have changed
+// while actions awaited retry, or the stage contains no failed actions.
+type StageNotRetryableException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StageNotRetryableException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StageNotRetryableException) GoString() string {
+	return s.String()
+}
+
+func newErrorStageNotRetryableException(v protocol.ResponseMetadata) error {
+	return &StageNotRetryableException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *StageNotRetryableException) Code() string {
+	return "StageNotRetryableException"
+}
+
+// Message returns the exception's message.
+func (s *StageNotRetryableException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *StageNotRetryableException) OrigErr() error {
+	return nil
+}
+
+func (s *StageNotRetryableException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *StageNotRetryableException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *StageNotRetryableException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Represents information about the state of the stage.
 type StageState struct {
 	_ struct{} `type:"structure"`
@@ -9605,6 +14130,9 @@ type StageState struct {
 	// The state of the stage.
 	ActionStates []*ActionState `locationName:"actionStates" type:"list"`
 
+	// Represents information about the run of a stage.
+	InboundExecution *StageExecution `locationName:"inboundExecution" type:"structure"`
+
 	// The state of the inbound transition, which is either enabled or disabled.
 	InboundTransitionState *TransitionState `locationName:"inboundTransitionState" type:"structure"`
 
@@ -9616,12 +14144,20 @@ type StageState struct {
 	StageName *string `locationName:"stageName" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StageState) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StageState) GoString() string {
 	return s.String()
 }
@@ -9632,6 +14168,12 @@ func (s *StageState) SetActionStates(v []*ActionState) *StageState {
 	return s
 }
 
+// SetInboundExecution sets the InboundExecution field's value.
+func (s *StageState) SetInboundExecution(v *StageExecution) *StageState {
+	s.InboundExecution = v
+	return s
+}
+
 // SetInboundTransitionState sets the InboundTransitionState field's value.
 func (s *StageState) SetInboundTransitionState(v *TransitionState) *StageState {
 	s.InboundTransitionState = v
@@ -9663,12 +14205,20 @@ type StartPipelineExecutionInput struct {
 	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartPipelineExecutionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartPipelineExecutionInput) GoString() string {
 	return s.String()
 }
@@ -9679,11 +14229,148 @@ func (s *StartPipelineExecutionInput) Validate() error {
 	if s.ClientRequestToken != nil && len(*s.ClientRequestToken) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("ClientRequestToken", 1))
 	}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetClientRequestToken sets the ClientRequestToken field's value.
+func (s *StartPipelineExecutionInput) SetClientRequestToken(v string) *StartPipelineExecutionInput {
+	s.ClientRequestToken = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *StartPipelineExecutionInput) SetName(v string) *StartPipelineExecutionInput {
+	s.Name = &v
+	return s
+}
+
+// Represents the output of a StartPipelineExecution action.
+type StartPipelineExecutionOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The unique system-generated ID of the pipeline execution that was started.
+	PipelineExecutionId *string `locationName:"pipelineExecutionId" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StartPipelineExecutionOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StartPipelineExecutionOutput) GoString() string {
+	return s.String()
+}
+
+// SetPipelineExecutionId sets the PipelineExecutionId field's value.
+func (s *StartPipelineExecutionOutput) SetPipelineExecutionId(v string) *StartPipelineExecutionOutput {
+	s.PipelineExecutionId = &v
+	return s
+}
+
+// The interaction that stopped a pipeline execution.
+type StopExecutionTrigger struct {
+	_ struct{} `type:"structure"`
+
+	// The user-specified reason the pipeline was stopped.
+	Reason *string `locationName:"reason" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StopExecutionTrigger) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StopExecutionTrigger) GoString() string {
+	return s.String()
+}
+
+// SetReason sets the Reason field's value.
+func (s *StopExecutionTrigger) SetReason(v string) *StopExecutionTrigger {
+	s.Reason = &v
+	return s
+}
+
+type StopPipelineExecutionInput struct {
+	_ struct{} `type:"structure"`
+
+	// Use this option to stop the pipeline execution by abandoning, rather than
+	// finishing, in-progress actions.
+	//
+	// This option can lead to failed or out-of-sequence tasks.
+	Abandon *bool `locationName:"abandon" type:"boolean"`
+
+	// The ID of the pipeline execution to be stopped in the current stage. Use
+	// the GetPipelineState action to retrieve the current pipelineExecutionId.
+	//
+	// PipelineExecutionId is a required field
+	PipelineExecutionId *string `locationName:"pipelineExecutionId" type:"string" required:"true"`
+
+	// The name of the pipeline to stop.
+	//
+	// PipelineName is a required field
+	PipelineName *string `locationName:"pipelineName" min:"1" type:"string" required:"true"`
+
+	// Use this option to enter comments, such as the reason the pipeline was stopped.
+	Reason *string `locationName:"reason" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StopPipelineExecutionInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StopPipelineExecutionInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *StopPipelineExecutionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "StopPipelineExecutionInput"}
+	if s.PipelineExecutionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("PipelineExecutionId"))
+	}
+	if s.PipelineName == nil {
+		invalidParams.Add(request.NewErrParamRequired("PipelineName"))
 	}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	if s.PipelineName != nil && len(*s.PipelineName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("PipelineName", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -9692,38 +14379,57 @@ func (s *StartPipelineExecutionInput) Validate() error {
 	return nil
 }
 
-// SetClientRequestToken sets the ClientRequestToken field's value.
-func (s *StartPipelineExecutionInput) SetClientRequestToken(v string) *StartPipelineExecutionInput {
-	s.ClientRequestToken = &v
+// SetAbandon sets the Abandon field's value.
+func (s *StopPipelineExecutionInput) SetAbandon(v bool) *StopPipelineExecutionInput {
+	s.Abandon = &v
 	return s
 }
 
-// SetName sets the Name field's value.
-func (s *StartPipelineExecutionInput) SetName(v string) *StartPipelineExecutionInput {
-	s.Name = &v
+// SetPipelineExecutionId sets the PipelineExecutionId field's value.
+func (s *StopPipelineExecutionInput) SetPipelineExecutionId(v string) *StopPipelineExecutionInput {
+	s.PipelineExecutionId = &v
 	return s
 }
 
-// Represents the output of a StartPipelineExecution action.
-type StartPipelineExecutionOutput struct {
+// SetPipelineName sets the PipelineName field's value.
+func (s *StopPipelineExecutionInput) SetPipelineName(v string) *StopPipelineExecutionInput {
+	s.PipelineName = &v
+	return s
+}
+
+// SetReason sets the Reason field's value.
+func (s *StopPipelineExecutionInput) SetReason(v string) *StopPipelineExecutionInput {
+	s.Reason = &v
+	return s
+}
+
+type StopPipelineExecutionOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The unique system-generated ID of the pipeline execution that was started.
+	// The unique system-generated ID of the pipeline execution that was stopped.
 	PipelineExecutionId *string `locationName:"pipelineExecutionId" type:"string"`
 }
 
-// String returns the string representation
-func (s StartPipelineExecutionOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StopPipelineExecutionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s StartPipelineExecutionOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StopPipelineExecutionOutput) GoString() string {
 	return s.String()
 }
 
 // SetPipelineExecutionId sets the PipelineExecutionId field's value.
-func (s *StartPipelineExecutionOutput) SetPipelineExecutionId(v string) *StartPipelineExecutionOutput {
+func (s *StopPipelineExecutionOutput) SetPipelineExecutionId(v string) *StopPipelineExecutionOutput {
 	s.PipelineExecutionId = &v
 	return s
 }
@@ -9743,12 +14449,20 @@ type Tag struct {
 	Value *string `locationName:"value" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) GoString() string {
 	return s.String()
 }
@@ -9798,12 +14512,20 @@ type TagResourceInput struct {
 	Tags []*Tag `locationName:"tags" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceInput) GoString() string {
 	return s.String()
 }
@@ -9850,18 +14572,26 @@ type TagResourceOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceOutput) GoString() string {
 	return s.String()
 }
 
-// A response to a PollForThirdPartyJobs request returned by AWS CodePipeline
-// when there is a job to be worked on by a partner action.
+// A response to a PollForThirdPartyJobs request returned by CodePipeline when
+// there is a job to be worked on by a partner action.
 type ThirdPartyJob struct {
 	_ struct{} `type:"structure"`
 
@@ -9869,16 +14599,24 @@ type ThirdPartyJob struct {
 	// that the calling entity is allowed access to the job and its details.
 	ClientId *string `locationName:"clientId" type:"string"`
 
-	// The identifier used to identify the job in AWS CodePipeline.
+	// The identifier used to identify the job in CodePipeline.
 	JobId *string `locationName:"jobId" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ThirdPartyJob) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ThirdPartyJob) GoString() string {
 	return s.String()
 }
@@ -9905,19 +14643,23 @@ type ThirdPartyJobData struct {
 	// Represents information about an action type.
 	ActionTypeId *ActionTypeId `locationName:"actionTypeId" type:"structure"`
 
-	// Represents an AWS session credentials object. These credentials are temporary
-	// credentials that are issued by AWS Secure Token Service (STS). They can be
-	// used to access input and output artifacts in the Amazon S3 bucket used to
-	// store artifact for the pipeline in AWS CodePipeline.
+	// Represents an Amazon Web Services session credentials object. These credentials
+	// are temporary credentials that are issued by Amazon Web Services Secure Token
+	// Service (STS). They can be used to access input and output artifacts in the
+	// S3 bucket used to store artifact for the pipeline in CodePipeline.
+	//
+	// ArtifactCredentials is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by ThirdPartyJobData's
+	// String and GoString methods.
 	ArtifactCredentials *AWSSessionCredentials `locationName:"artifactCredentials" type:"structure" sensitive:"true"`
 
-	// A system-generated token, such as a AWS CodeDeploy deployment ID, that a
-	// job requires to continue the job asynchronously.
+	// A system-generated token, such as a CodeDeploy deployment ID, that a job
+	// requires to continue the job asynchronously.
 	ContinuationToken *string `locationName:"continuationToken" min:"1" type:"string"`
 
 	// The encryption key used to encrypt and decrypt data in the artifact store
-	// for the pipeline, such as an AWS Key Management Service (AWS KMS) key. This
-	// is optional and might not be present.
+	// for the pipeline, such as an Amazon Web Services Key Management Service (Amazon
+	// Web Services KMS) key. This is optional and might not be present.
 	EncryptionKey *EncryptionKey `locationName:"encryptionKey" type:"structure"`
 
 	// The name of the artifact that is worked on by the action, if any. This name
@@ -9938,12 +14680,20 @@ type ThirdPartyJobData struct {
 	PipelineContext *PipelineContext `locationName:"pipelineContext" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ThirdPartyJobData) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ThirdPartyJobData) GoString() string {
 	return s.String()
 }
@@ -10003,21 +14753,29 @@ type ThirdPartyJobDetails struct {
 	// The data to be returned by the third party job worker.
 	Data *ThirdPartyJobData `locationName:"data" type:"structure"`
 
-	// The identifier used to identify the job details in AWS CodePipeline.
+	// The identifier used to identify the job details in CodePipeline.
 	Id *string `locationName:"id" min:"1" type:"string"`
 
-	// A system-generated random number that AWS CodePipeline uses to ensure that
-	// the job is being worked on by only one job worker. Use this number in an
-	// AcknowledgeThirdPartyJob request.
+	// A system-generated random number that CodePipeline uses to ensure that the
+	// job is being worked on by only one job worker. Use this number in an AcknowledgeThirdPartyJob
+	// request.
 	Nonce *string `locationName:"nonce" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ThirdPartyJobDetails) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ThirdPartyJobDetails) GoString() string {
 	return s.String()
 }
@@ -10040,6 +14798,70 @@ func (s *ThirdPartyJobDetails) SetNonce(v string) *ThirdPartyJobDetails {
 	return s
 }
 
+// The tags limit for a resource has been exceeded.
+type TooManyTagsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyTagsException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyTagsException) GoString() string {
+	return s.String()
+}
+
+func newErrorTooManyTagsException(v protocol.ResponseMetadata) error {
+	return &TooManyTagsException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *TooManyTagsException) Code() string {
+	return "TooManyTagsException"
+}
+
+// Message returns the exception's message.
+func (s *TooManyTagsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *TooManyTagsException) OrigErr() error {
+	return nil
+}
+
+func (s *TooManyTagsException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *TooManyTagsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *TooManyTagsException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Represents information about the state of transitions between one stage and
 // another stage.
 type TransitionState struct {
@@ -10059,12 +14881,20 @@ type TransitionState struct {
 	LastChangedBy *string `locationName:"lastChangedBy" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TransitionState) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TransitionState) GoString() string {
 	return s.String()
 }
@@ -10107,12 +14937,20 @@ type UntagResourceInput struct {
 	TagKeys []*string `locationName:"tagKeys" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceInput) GoString() string {
 	return s.String()
 }
@@ -10149,16 +14987,97 @@ type UntagResourceOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceOutput) GoString() string {
 	return s.String()
 }
 
+type UpdateActionTypeInput struct {
+	_ struct{} `type:"structure"`
+
+	// The action type definition for the action type to be updated.
+	//
+	// ActionType is a required field
+	ActionType *ActionTypeDeclaration `locationName:"actionType" type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateActionTypeInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateActionTypeInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *UpdateActionTypeInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateActionTypeInput"}
+	if s.ActionType == nil {
+		invalidParams.Add(request.NewErrParamRequired("ActionType"))
+	}
+	if s.ActionType != nil {
+		if err := s.ActionType.Validate(); err != nil {
+			invalidParams.AddNested("ActionType", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetActionType sets the ActionType field's value.
+func (s *UpdateActionTypeInput) SetActionType(v *ActionTypeDeclaration) *UpdateActionTypeInput {
+	s.ActionType = v
+	return s
+}
+
+type UpdateActionTypeOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateActionTypeOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateActionTypeOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of an UpdatePipeline action.
 type UpdatePipelineInput struct {
 	_ struct{} `type:"structure"`
@@ -10169,12 +15088,20 @@ type UpdatePipelineInput struct {
 	Pipeline *PipelineDeclaration `locationName:"pipeline" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdatePipelineInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdatePipelineInput) GoString() string {
 	return s.String()
 }
@@ -10211,12 +15138,20 @@ type UpdatePipelineOutput struct {
 	Pipeline *PipelineDeclaration `locationName:"pipeline" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdatePipelineOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdatePipelineOutput) GoString() string {
 	return s.String()
 }
@@ -10227,6 +15162,70 @@ func (s *UpdatePipelineOutput) SetPipeline(v *PipelineDeclaration) *UpdatePipeli
 	return s
 }
 
+// The validation was specified in an invalid format.
+type ValidationException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ValidationException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ValidationException) GoString() string {
+	return s.String()
+}
+
+func newErrorValidationException(v protocol.ResponseMetadata) error {
+	return &ValidationException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ValidationException) Code() string {
+	return "ValidationException"
+}
+
+// Message returns the exception's message.
+func (s *ValidationException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ValidationException) OrigErr() error {
+	return nil
+}
+
+func (s *ValidationException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ValidationException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ValidationException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // The authentication applied to incoming webhook trigger requests.
 type WebhookAuthConfiguration struct {
 	_ struct{} `type:"structure"`
@@ -10241,12 +15240,20 @@ type WebhookAuthConfiguration struct {
 	SecretToken *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s WebhookAuthConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s WebhookAuthConfiguration) GoString() string {
 	return s.String()
 }
@@ -10330,12 +15337,20 @@ type WebhookDefinition struct {
 	TargetPipeline *string `locationName:"targetPipeline" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s WebhookDefinition) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s WebhookDefinition) GoString() string {
 	return s.String()
 }
@@ -10447,19 +15462,27 @@ type WebhookFilterRule struct {
 	// the target action configuration can be included as placeholders in this value
 	// by surrounding the action configuration key with curly brackets. For example,
 	// if the value supplied here is "refs/heads/{Branch}" and the target action
-	// has an action configuration property called "Branch" with a value of "master",
-	// the MatchEquals value is evaluated as "refs/heads/master". For a list of
-	// action configuration properties for built-in action types, see Pipeline Structure
+	// has an action configuration property called "Branch" with a value of "main",
+	// the MatchEquals value is evaluated as "refs/heads/main". For a list of action
+	// configuration properties for built-in action types, see Pipeline Structure
 	// Reference Action Requirements (https://docs.aws.amazon.com/codepipeline/latest/userguide/reference-pipeline-structure.html#action-requirements).
 	MatchEquals *string `locationName:"matchEquals" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s WebhookFilterRule) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s WebhookFilterRule) GoString() string {
 	return s.String()
 }
@@ -10495,6 +15518,70 @@ func (s *WebhookFilterRule) SetMatchEquals(v string) *WebhookFilterRule {
 	return s
 }
 
+// The specified webhook was entered in an invalid format or cannot be found.
+type WebhookNotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s WebhookNotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s WebhookNotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorWebhookNotFoundException(v protocol.ResponseMetadata) error {
+	return &WebhookNotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *WebhookNotFoundException) Code() string {
+	return "WebhookNotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *WebhookNotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *WebhookNotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *WebhookNotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *WebhookNotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *WebhookNotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 const (
 	// ActionCategorySource is a ActionCategory enum value
 	ActionCategorySource = "Source"
@@ -10515,6 +15602,18 @@ const (
 	ActionCategoryApproval = "Approval"
 )
 
+// ActionCategory_Values returns all elements of the ActionCategory enum
+func ActionCategory_Values() []string {
+	return []string{
+		ActionCategorySource,
+		ActionCategoryBuild,
+		ActionCategoryDeploy,
+		ActionCategoryTest,
+		ActionCategoryInvoke,
+		ActionCategoryApproval,
+	}
+}
+
 const (
 	// ActionConfigurationPropertyTypeString is a ActionConfigurationPropertyType enum value
 	ActionConfigurationPropertyTypeString = "String"
@@ -10526,10 +15625,22 @@ const (
 	ActionConfigurationPropertyTypeBoolean = "Boolean"
 )
 
+// ActionConfigurationPropertyType_Values returns all elements of the ActionConfigurationPropertyType enum
+func ActionConfigurationPropertyType_Values() []string {
+	return []string{
+		ActionConfigurationPropertyTypeString,
+		ActionConfigurationPropertyTypeNumber,
+		ActionConfigurationPropertyTypeBoolean,
+	}
+}
+
 const (
 	// ActionExecutionStatusInProgress is a ActionExecutionStatus enum value
 	ActionExecutionStatusInProgress = "InProgress"
 
+	// ActionExecutionStatusAbandoned is a ActionExecutionStatus enum value
+	ActionExecutionStatusAbandoned = "Abandoned"
+
 	// ActionExecutionStatusSucceeded is a ActionExecutionStatus enum value
 	ActionExecutionStatusSucceeded = "Succeeded"
 
@@ -10537,6 +15648,16 @@ const (
 	ActionExecutionStatusFailed = "Failed"
 )
 
+// ActionExecutionStatus_Values returns all elements of the ActionExecutionStatus enum
+func ActionExecutionStatus_Values() []string {
+	return []string{
+		ActionExecutionStatusInProgress,
+		ActionExecutionStatusAbandoned,
+		ActionExecutionStatusSucceeded,
+		ActionExecutionStatusFailed,
+	}
+}
+
 const (
 	// ActionOwnerAws is a ActionOwner enum value
 	ActionOwnerAws = "AWS"
@@ -10548,6 +15669,15 @@ const (
 	ActionOwnerCustom = "Custom"
 )
 
+// ActionOwner_Values returns all elements of the ActionOwner enum
+func ActionOwner_Values() []string {
+	return []string{
+		ActionOwnerAws,
+		ActionOwnerThirdParty,
+		ActionOwnerCustom,
+	}
+}
+
 const (
 	// ApprovalStatusApproved is a ApprovalStatus enum value
 	ApprovalStatusApproved = "Approved"
@@ -10556,26 +15686,78 @@ const (
 	ApprovalStatusRejected = "Rejected"
 )
 
+// ApprovalStatus_Values returns all elements of the ApprovalStatus enum
+func ApprovalStatus_Values() []string {
+	return []string{
+		ApprovalStatusApproved,
+		ApprovalStatusRejected,
+	}
+}
+
 const (
 	// ArtifactLocationTypeS3 is a ArtifactLocationType enum value
 	ArtifactLocationTypeS3 = "S3"
 )
 
+// ArtifactLocationType_Values returns all elements of the ArtifactLocationType enum
+func ArtifactLocationType_Values() []string {
+	return []string{
+		ArtifactLocationTypeS3,
+	}
+}
+
 const (
 	// ArtifactStoreTypeS3 is a ArtifactStoreType enum value
 	ArtifactStoreTypeS3 = "S3"
 )
 
+// ArtifactStoreType_Values returns all elements of the ArtifactStoreType enum
+func ArtifactStoreType_Values() []string {
+	return []string{
+		ArtifactStoreTypeS3,
+	}
+}
+
 const (
 	// BlockerTypeSchedule is a BlockerType enum value
 	BlockerTypeSchedule = "Schedule"
 )
 
+// BlockerType_Values returns all elements of the BlockerType enum
+func BlockerType_Values() []string {
+	return []string{
+		BlockerTypeSchedule,
+	}
+}
+
 const (
 	// EncryptionKeyTypeKms is a EncryptionKeyType enum value
 	EncryptionKeyTypeKms = "KMS"
 )
 
+// EncryptionKeyType_Values returns all elements of the EncryptionKeyType enum
+func EncryptionKeyType_Values() []string {
+	return []string{
+		EncryptionKeyTypeKms,
+	}
+}
+
+const (
+	// ExecutorTypeJobWorker is a ExecutorType enum value
+	ExecutorTypeJobWorker = "JobWorker"
+
+	// ExecutorTypeLambda is a ExecutorType enum value
+	ExecutorTypeLambda = "Lambda"
+)
+
+// ExecutorType_Values returns all elements of the ExecutorType enum
+func ExecutorType_Values() []string {
+	return []string{
+		ExecutorTypeJobWorker,
+		ExecutorTypeLambda,
+	}
+}
+
 const (
 	// FailureTypeJobFailed is a FailureType enum value
 	FailureTypeJobFailed = "JobFailed"
@@ -10596,6 +15778,18 @@ const (
 	FailureTypeSystemUnavailable = "SystemUnavailable"
 )
 
+// FailureType_Values returns all elements of the FailureType enum
+func FailureType_Values() []string {
+	return []string{
+		FailureTypeJobFailed,
+		FailureTypeConfigurationError,
+		FailureTypePermissionError,
+		FailureTypeRevisionOutOfSync,
+		FailureTypeRevisionUnavailable,
+		FailureTypeSystemUnavailable,
+	}
+}
+
 const (
 	// JobStatusCreated is a JobStatus enum value
 	JobStatusCreated = "Created"
@@ -10619,10 +15813,32 @@ const (
 	JobStatusFailed = "Failed"
 )
 
+// JobStatus_Values returns all elements of the JobStatus enum
+func JobStatus_Values() []string {
+	return []string{
+		JobStatusCreated,
+		JobStatusQueued,
+		JobStatusDispatched,
+		JobStatusInProgress,
+		JobStatusTimedOut,
+		JobStatusSucceeded,
+		JobStatusFailed,
+	}
+}
+
 const (
+	// PipelineExecutionStatusCancelled is a PipelineExecutionStatus enum value
+	PipelineExecutionStatusCancelled = "Cancelled"
+
 	// PipelineExecutionStatusInProgress is a PipelineExecutionStatus enum value
 	PipelineExecutionStatusInProgress = "InProgress"
 
+	// PipelineExecutionStatusStopped is a PipelineExecutionStatus enum value
+	PipelineExecutionStatusStopped = "Stopped"
+
+	// PipelineExecutionStatusStopping is a PipelineExecutionStatus enum value
+	PipelineExecutionStatusStopping = "Stopping"
+
 	// PipelineExecutionStatusSucceeded is a PipelineExecutionStatus enum value
 	PipelineExecutionStatusSucceeded = "Succeeded"
 
@@ -10633,22 +15849,67 @@ const (
 	PipelineExecutionStatusFailed = "Failed"
 )
 
+// PipelineExecutionStatus_Values returns all elements of the PipelineExecutionStatus enum
+func PipelineExecutionStatus_Values() []string {
+	return []string{
+		PipelineExecutionStatusCancelled,
+		PipelineExecutionStatusInProgress,
+		PipelineExecutionStatusStopped,
+		PipelineExecutionStatusStopping,
+		PipelineExecutionStatusSucceeded,
+		PipelineExecutionStatusSuperseded,
+		PipelineExecutionStatusFailed,
+	}
+}
+
 const (
+	// StageExecutionStatusCancelled is a StageExecutionStatus enum value
+	StageExecutionStatusCancelled = "Cancelled"
+
 	// StageExecutionStatusInProgress is a StageExecutionStatus enum value
 	StageExecutionStatusInProgress = "InProgress"
 
 	// StageExecutionStatusFailed is a StageExecutionStatus enum value
 	StageExecutionStatusFailed = "Failed"
 
+	// StageExecutionStatusStopped is a StageExecutionStatus enum value
+	StageExecutionStatusStopped = "Stopped"
+
+	// StageExecutionStatusStopping is a StageExecutionStatus enum value
+	StageExecutionStatusStopping = "Stopping"
+
 	// StageExecutionStatusSucceeded is a StageExecutionStatus enum value
 	StageExecutionStatusSucceeded = "Succeeded"
 )
 
+// StageExecutionStatus_Values returns all elements of the StageExecutionStatus enum
+func StageExecutionStatus_Values() []string {
+	return []string{
+		StageExecutionStatusCancelled,
+		StageExecutionStatusInProgress,
+		StageExecutionStatusFailed,
+		StageExecutionStatusStopped,
+		StageExecutionStatusStopping,
+		StageExecutionStatusSucceeded,
+	}
+}
+
 const (
 	// StageRetryModeFailedActions is a StageRetryMode enum value
 	StageRetryModeFailedActions = "FAILED_ACTIONS"
+
+	// StageRetryModeAllActions is a StageRetryMode enum value
+	StageRetryModeAllActions = "ALL_ACTIONS"
 )
 
+// StageRetryMode_Values returns all elements of the StageRetryMode enum
+func StageRetryMode_Values() []string {
+	return []string{
+		StageRetryModeFailedActions,
+		StageRetryModeAllActions,
+	}
+}
+
 const (
 	// StageTransitionTypeInbound is a StageTransitionType enum value
 	StageTransitionTypeInbound = "Inbound"
@@ -10657,6 +15918,14 @@ const (
 	StageTransitionTypeOutbound = "Outbound"
 )
 
+// StageTransitionType_Values returns all elements of the StageTransitionType enum
+func StageTransitionType_Values() []string {
+	return []string{
+		StageTransitionTypeInbound,
+		StageTransitionTypeOutbound,
+	}
+}
+
 const (
 	// TriggerTypeCreatePipeline is a TriggerType enum value
 	TriggerTypeCreatePipeline = "CreatePipeline"
@@ -10677,6 +15946,18 @@ const (
 	TriggerTypePutActionRevision = "PutActionRevision"
 )
 
+// TriggerType_Values returns all elements of the TriggerType enum
+func TriggerType_Values() []string {
+	return []string{
+		TriggerTypeCreatePipeline,
+		TriggerTypeStartPipelineExecution,
+		TriggerTypePollForSourceChanges,
+		TriggerTypeWebhook,
+		TriggerTypeCloudWatchEvent,
+		TriggerTypePutActionRevision,
+	}
+}
+
 const (
 	// WebhookAuthenticationTypeGithubHmac is a WebhookAuthenticationType enum value
 	WebhookAuthenticationTypeGithubHmac = "GITHUB_HMAC"
@@ -10687,3 +15968,12 @@ const (
 	// WebhookAuthenticationTypeUnauthenticated is a WebhookAuthenticationType enum value
 	WebhookAuthenticationTypeUnauthenticated = "UNAUTHENTICATED"
 )
+
+// WebhookAuthenticationType_Values returns all elements of the WebhookAuthenticationType enum
+func WebhookAuthenticationType_Values() []string {
+	return []string{
+		WebhookAuthenticationTypeGithubHmac,
+		WebhookAuthenticationTypeIp,
+		WebhookAuthenticationTypeUnauthenticated,
+	}
+}
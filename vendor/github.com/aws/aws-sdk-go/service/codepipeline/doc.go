@@ -3,14 +3,14 @@
 // Package codepipeline provides the client and types for making API
 // requests to AWS CodePipeline.
 //
-// Overview
+// # Overview
 //
-// This is the AWS CodePipeline API Reference. This guide provides descriptions
-// of the actions and data types for AWS CodePipeline. Some functionality for
-// your pipeline can only be configured through the API. For more information,
-// see the AWS CodePipeline User Guide (https://docs.aws.amazon.com/codepipeline/latest/userguide/welcome.html).
+// This is the CodePipeline API Reference. This guide provides descriptions
+// of the actions and data types for CodePipeline. Some functionality for your
+// pipeline can only be configured through the API. For more information, see
+// the CodePipeline User Guide (https://docs.aws.amazon.com/codepipeline/latest/userguide/welcome.html).
 //
-// You can use the AWS CodePipeline API to work with pipelines, stages, actions,
+// You can use the CodePipeline API to work with pipelines, stages, actions,
 // and transitions.
 //
 // Pipelines are models of automated release processes. Each pipeline is uniquely
@@ -18,35 +18,38 @@
 //
 // You can work with pipelines by calling:
 //
-//    * CreatePipeline, which creates a uniquely named pipeline.
+//   - CreatePipeline, which creates a uniquely named pipeline.
 //
-//    * DeletePipeline, which deletes the specified pipeline.
+//   - DeletePipeline, which deletes the specified pipeline.
 //
-//    * GetPipeline, which returns information about the pipeline structure
-//    and pipeline metadata, including the pipeline Amazon Resource Name (ARN).
+//   - GetPipeline, which returns information about the pipeline structure
+//     and pipeline metadata, including the pipeline Amazon Resource Name (ARN).
 //
-//    * GetPipelineExecution, which returns information about a specific execution
-//    of a pipeline.
+//   - GetPipelineExecution, which returns information about a specific execution
+//     of a pipeline.
 //
-//    * GetPipelineState, which returns information about the current state
-//    of the stages and actions of a pipeline.
+//   - GetPipelineState, which returns information about the current state
+//     of the stages and actions of a pipeline.
 //
-//    * ListActionExecutions, which returns action-level details for past executions.
-//    The details include full stage and action-level details, including individual
-//    action duration, status, any errors that occurred during the execution,
-//    and input and output artifact location details.
+//   - ListActionExecutions, which returns action-level details for past executions.
+//     The details include full stage and action-level details, including individual
+//     action duration, status, any errors that occurred during the execution,
+//     and input and output artifact location details.
 //
-//    * ListPipelines, which gets a summary of all of the pipelines associated
-//    with your account.
+//   - ListPipelines, which gets a summary of all of the pipelines associated
+//     with your account.
 //
-//    * ListPipelineExecutions, which gets a summary of the most recent executions
-//    for a pipeline.
+//   - ListPipelineExecutions, which gets a summary of the most recent executions
+//     for a pipeline.
 //
-//    * StartPipelineExecution, which runs the most recent revision of an artifact
-//    through the pipeline.
+//   - StartPipelineExecution, which runs the most recent revision of an artifact
+//     through the pipeline.
 //
-//    * UpdatePipeline, which updates a pipeline with edits or changes to the
-//    structure of the pipeline.
+//   - StopPipelineExecution, which stops the specified pipeline execution
+//     from continuing through the pipeline.
+//
+//   - UpdatePipeline, which updates a pipeline with edits or changes to the
+//     structure of the pipeline.
 //
 // Pipelines include stages. Each stage contains one or more actions that must
 // complete before the next stage begins. A stage results in success or failure.
@@ -56,8 +59,8 @@
 // You can call GetPipelineState, which displays the status of a pipeline, including
 // the status of stages in the pipeline, or GetPipeline, which returns the entire
 // structure of the pipeline, including the stages of that pipeline. For more
-// information about the structure of stages and actions, see AWS CodePipeline
-// Pipeline Structure Reference (https://docs.aws.amazon.com/codepipeline/latest/userguide/pipeline-structure.html).
+// information about the structure of stages and actions, see CodePipeline Pipeline
+// Structure Reference (https://docs.aws.amazon.com/codepipeline/latest/userguide/pipeline-structure.html).
 //
 // Pipeline stages include actions that are categorized into categories such
 // as source or build actions performed in a stage of a pipeline. For example,
@@ -67,77 +70,77 @@
 // pipeline operations such as CreatePipeline and GetPipelineState. Valid action
 // categories are:
 //
-//    * Source
+//   - Source
 //
-//    * Build
+//   - Build
 //
-//    * Test
+//   - Test
 //
-//    * Deploy
+//   - Deploy
 //
-//    * Approval
+//   - Approval
 //
-//    * Invoke
+//   - Invoke
 //
 // Pipelines also include transitions, which allow the transition of artifacts
 // from one stage to the next in a pipeline after the actions in one stage complete.
 //
 // You can work with transitions by calling:
 //
-//    * DisableStageTransition, which prevents artifacts from transitioning
-//    to the next stage in a pipeline.
+//   - DisableStageTransition, which prevents artifacts from transitioning
+//     to the next stage in a pipeline.
 //
-//    * EnableStageTransition, which enables transition of artifacts between
-//    stages in a pipeline.
+//   - EnableStageTransition, which enables transition of artifacts between
+//     stages in a pipeline.
 //
-// Using the API to integrate with AWS CodePipeline
+// # Using the API to integrate with CodePipeline
 //
 // For third-party integrators or developers who want to create their own integrations
-// with AWS CodePipeline, the expected sequence varies from the standard API
-// user. To integrate with AWS CodePipeline, developers need to work with the
-// following items:
+// with CodePipeline, the expected sequence varies from the standard API user.
+// To integrate with CodePipeline, developers need to work with the following
+// items:
 //
 // Jobs, which are instances of an action. For example, a job for a source action
 // might import a revision of an artifact from a source.
 //
 // You can work with jobs by calling:
 //
-//    * AcknowledgeJob, which confirms whether a job worker has received the
-//    specified job.
+//   - AcknowledgeJob, which confirms whether a job worker has received the
+//     specified job.
 //
-//    * GetJobDetails, which returns the details of a job.
+//   - GetJobDetails, which returns the details of a job.
 //
-//    * PollForJobs, which determines whether there are any jobs to act on.
+//   - PollForJobs, which determines whether there are any jobs to act on.
 //
-//    * PutJobFailureResult, which provides details of a job failure.
+//   - PutJobFailureResult, which provides details of a job failure.
 //
-//    * PutJobSuccessResult, which provides details of a job success.
+//   - PutJobSuccessResult, which provides details of a job success.
 //
 // Third party jobs, which are instances of an action created by a partner action
-// and integrated into AWS CodePipeline. Partner actions are created by members
-// of the AWS Partner Network.
+// and integrated into CodePipeline. Partner actions are created by members
+// of the Amazon Web Services Partner Network.
 //
 // You can work with third party jobs by calling:
 //
-//    * AcknowledgeThirdPartyJob, which confirms whether a job worker has received
-//    the specified job.
+//   - AcknowledgeThirdPartyJob, which confirms whether a job worker has received
+//     the specified job.
 //
-//    * GetThirdPartyJobDetails, which requests the details of a job for a partner
-//    action.
+//   - GetThirdPartyJobDetails, which requests the details of a job for a partner
+//     action.
 //
-//    * PollForThirdPartyJobs, which determines whether there are any jobs to
-//    act on.
+//   - PollForThirdPartyJobs, which determines whether there are any jobs to
+//     act on.
 //
-//    * PutThirdPartyJobFailureResult, which provides details of a job failure.
+//   - PutThirdPartyJobFailureResult, which provides details of a job failure.
 //
-//    * PutThirdPartyJobSuccessResult, which provides details of a job success.
+//   - PutThirdPartyJobSuccessResult, which provides details of a job success.
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09 for more information on this service.
 //
 // See codepipeline package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/codepipeline/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact AWS CodePipeline with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -2,6 +2,10 @@
 
 package codepipeline
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeActionNotFoundException for service response error code
@@ -28,6 +32,23 @@ const (
 	// Unable to modify the tag due to a simultaneous update request.
 	ErrCodeConcurrentModificationException = "ConcurrentModificationException"
 
+	// ErrCodeConflictException for service response error code
+	// "ConflictException".
+	//
+	// Your request cannot be handled because the pipeline is busy handling ongoing
+	// activities. Try again later.
+	ErrCodeConflictException = "ConflictException"
+
+	// ErrCodeDuplicatedStopRequestException for service response error code
+	// "DuplicatedStopRequestException".
+	//
+	// The pipeline execution is already in a Stopping state. If you already chose
+	// to stop and wait, you cannot make that request again. You can choose to stop
+	// and abandon now, but be aware that this option can lead to failed tasks or
+	// out of sequence tasks. If you already chose to stop and abandon, you cannot
+	// make that request again.
+	ErrCodeDuplicatedStopRequestException = "DuplicatedStopRequestException"
+
 	// ErrCodeInvalidActionDeclarationException for service response error code
 	// "InvalidActionDeclarationException".
 	//
@@ -122,8 +143,8 @@ const (
 	// ErrCodeLimitExceededException for service response error code
 	// "LimitExceededException".
 	//
-	// The number of pipelines associated with the AWS account has exceeded the
-	// limit allowed for the account.
+	// The number of pipelines associated with the Amazon Web Services account has
+	// exceeded the limit allowed for the account.
 	ErrCodeLimitExceededException = "LimitExceededException"
 
 	// ErrCodeNotLatestPipelineExecutionException for service response error code
@@ -133,6 +154,12 @@ const (
 	// associated with the request is out of date.
 	ErrCodeNotLatestPipelineExecutionException = "NotLatestPipelineExecutionException"
 
+	// ErrCodeOutputVariablesSizeExceededException for service response error code
+	// "OutputVariablesSizeExceededException".
+	//
+	// Exceeded the total size limit for all variables in the pipeline.
+	ErrCodeOutputVariablesSizeExceededException = "OutputVariablesSizeExceededException"
+
 	// ErrCodePipelineExecutionNotFoundException for service response error code
 	// "PipelineExecutionNotFoundException".
 	//
@@ -140,6 +167,13 @@ const (
 	// or an execution ID does not belong to the specified pipeline.
 	ErrCodePipelineExecutionNotFoundException = "PipelineExecutionNotFoundException"
 
+	// ErrCodePipelineExecutionNotStoppableException for service response error code
+	// "PipelineExecutionNotStoppableException".
+	//
+	// Unable to stop the pipeline execution. The execution might already be in
+	// a Stopped state, or it might no longer be in progress.
+	ErrCodePipelineExecutionNotStoppableException = "PipelineExecutionNotStoppableException"
+
 	// ErrCodePipelineNameInUseException for service response error code
 	// "PipelineNameInUseException".
 	//
@@ -158,6 +192,12 @@ const (
 	// The pipeline version was specified in an invalid format or cannot be found.
 	ErrCodePipelineVersionNotFoundException = "PipelineVersionNotFoundException"
 
+	// ErrCodeRequestFailedException for service response error code
+	// "RequestFailedException".
+	//
+	// The request failed because of an unknown error, exception, or failure.
+	ErrCodeRequestFailedException = "RequestFailedException"
+
 	// ErrCodeResourceNotFoundException for service response error code
 	// "ResourceNotFoundException".
 	//
@@ -195,3 +235,42 @@ const (
 	// The specified webhook was entered in an invalid format or cannot be found.
 	ErrCodeWebhookNotFoundException = "WebhookNotFoundException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"ActionNotFoundException":                         newErrorActionNotFoundException,
+	"ActionTypeNotFoundException":                     newErrorActionTypeNotFoundException,
+	"ApprovalAlreadyCompletedException":               newErrorApprovalAlreadyCompletedException,
+	"ConcurrentModificationException":                 newErrorConcurrentModificationException,
+	"ConflictException":                               newErrorConflictException,
+	"DuplicatedStopRequestException":                  newErrorDuplicatedStopRequestException,
+	"InvalidActionDeclarationException":               newErrorInvalidActionDeclarationException,
+	"InvalidApprovalTokenException":                   newErrorInvalidApprovalTokenException,
+	"InvalidArnException":                             newErrorInvalidArnException,
+	"InvalidBlockerDeclarationException":              newErrorInvalidBlockerDeclarationException,
+	"InvalidClientTokenException":                     newErrorInvalidClientTokenException,
+	"InvalidJobException":                             newErrorInvalidJobException,
+	"InvalidJobStateException":                        newErrorInvalidJobStateException,
+	"InvalidNextTokenException":                       newErrorInvalidNextTokenException,
+	"InvalidNonceException":                           newErrorInvalidNonceException,
+	"InvalidStageDeclarationException":                newErrorInvalidStageDeclarationException,
+	"InvalidStructureException":                       newErrorInvalidStructureException,
+	"InvalidTagsException":                            newErrorInvalidTagsException,
+	"InvalidWebhookAuthenticationParametersException": newErrorInvalidWebhookAuthenticationParametersException,
+	"InvalidWebhookFilterPatternException":            newErrorInvalidWebhookFilterPatternException,
+	"JobNotFoundException":                            newErrorJobNotFoundException,
+	"LimitExceededException":                          newErrorLimitExceededException,
+	"NotLatestPipelineExecutionException":             newErrorNotLatestPipelineExecutionException,
+	"OutputVariablesSizeExceededException":            newErrorOutputVariablesSizeExceededException,
+	"PipelineExecutionNotFoundException":              newErrorPipelineExecutionNotFoundException,
+	"PipelineExecutionNotStoppableException":          newErrorPipelineExecutionNotStoppableException,
+	"PipelineNameInUseException":                      newErrorPipelineNameInUseException,
+	"PipelineNotFoundException":                       newErrorPipelineNotFoundException,
+	"PipelineVersionNotFoundException":                newErrorPipelineVersionNotFoundException,
+	"RequestFailedException":                          newErrorRequestFailedException,
+	"ResourceNotFoundException":                       newErrorResourceNotFoundException,
+	"StageNotFoundException":                          newErrorStageNotFoundException,
+	"StageNotRetryableException":                      newErrorStageNotRetryableException,
+	"TooManyTagsException":                            newErrorTooManyTagsException,
+	"ValidationException":                             newErrorValidationException,
+	"WebhookNotFoundException":                        newErrorWebhookNotFoundException,
+}
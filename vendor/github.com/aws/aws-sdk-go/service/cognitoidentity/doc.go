@@ -25,7 +25,7 @@
 // See cognitoidentity package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/cognitoidentity/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon Cognito Identity with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -467,11 +467,6 @@ func (c *DatabaseMigrationService) WaitUntilReplicationTaskStoppedWithContext(ct
 				Matcher: request.PathAnyWaiterMatch, Argument: "ReplicationTasks[].Status",
 				Expected: "starting",
 			},
-			{
-				State:   request.FailureWaiterState,
-				Matcher: request.PathAnyWaiterMatch, Argument: "ReplicationTasks[].Status",
-				Expected: "running",
-			},
 			{
 				State:   request.FailureWaiterState,
 				Matcher: request.PathAnyWaiterMatch, Argument: "ReplicationTasks[].Status",
@@ -31,7 +31,7 @@
 // See datapipeline package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/datapipeline/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact AWS Data Pipeline with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
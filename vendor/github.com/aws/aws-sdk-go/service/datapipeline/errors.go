@@ -2,6 +2,10 @@
 
 package datapipeline
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeInternalServiceError for service response error code
@@ -37,3 +41,11 @@ const (
 	// The specified task was not found.
 	ErrCodeTaskNotFoundException = "TaskNotFoundException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"InternalServiceError":      newErrorInternalServiceError,
+	"InvalidRequestException":   newErrorInvalidRequestException,
+	"PipelineDeletedException":  newErrorPipelineDeletedException,
+	"PipelineNotFoundException": newErrorPipelineNotFoundException,
+	"TaskNotFoundException":     newErrorTaskNotFoundException,
+}
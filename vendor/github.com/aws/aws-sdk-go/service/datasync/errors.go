@@ -2,12 +2,16 @@
 
 package datasync
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeInternalException for service response error code
 	// "InternalException".
 	//
-	// This exception is thrown when an error occurs in the AWS DataSync service.
+	// This exception is thrown when an error occurs in the DataSync service.
 	ErrCodeInternalException = "InternalException"
 
 	// ErrCodeInvalidRequestException for service response error code
@@ -16,3 +20,8 @@ const (
 	// This exception is thrown when the client submits a malformed request.
 	ErrCodeInvalidRequestException = "InvalidRequestException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"InternalException":       newErrorInternalException,
+	"InvalidRequestException": newErrorInvalidRequestException,
+}
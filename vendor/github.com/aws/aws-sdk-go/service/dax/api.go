@@ -3,11 +3,13 @@
 package dax
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol"
 )
 
 const opCreateCluster = "CreateCluster"
@@ -26,14 +28,13 @@ const opCreateCluster = "CreateCluster"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateClusterRequest method.
+//	req, resp := client.CreateClusterRequest(params)
 //
-//    // Example sending a request using the CreateClusterRequest method.
-//    req, resp := client.CreateClusterRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/CreateCluster
 func (c *DAX) CreateClusterRequest(input *CreateClusterInput) (req *request.Request, output *CreateClusterOutput) {
@@ -64,49 +65,56 @@ func (c *DAX) CreateClusterRequest(input *CreateClusterInput) (req *request.Requ
 // See the AWS API reference guide for Amazon DynamoDB Accelerator (DAX)'s
 // API operation CreateCluster for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeClusterAlreadyExistsFault "ClusterAlreadyExistsFault"
-//   You already have a DAX cluster with the given identifier.
+// Returned Error Types:
+//
+//   - ClusterAlreadyExistsFault
+//     You already have a DAX cluster with the given identifier.
 //
-//   * ErrCodeInvalidClusterStateFault "InvalidClusterStateFault"
-//   The requested DAX cluster is not in the available state.
+//   - InvalidClusterStateFault
+//     The requested DAX cluster is not in the available state.
 //
-//   * ErrCodeInsufficientClusterCapacityFault "InsufficientClusterCapacityFault"
-//   There are not enough system resources to create the cluster you requested
-//   (or to resize an already-existing cluster).
+//   - InsufficientClusterCapacityFault
+//     There are not enough system resources to create the cluster you requested
+//     (or to resize an already-existing cluster).
 //
-//   * ErrCodeSubnetGroupNotFoundFault "SubnetGroupNotFoundFault"
-//   The requested subnet group name does not refer to an existing subnet group.
+//   - SubnetGroupNotFoundFault
+//     The requested subnet group name does not refer to an existing subnet group.
 //
-//   * ErrCodeInvalidParameterGroupStateFault "InvalidParameterGroupStateFault"
-//   One or more parameters in a parameter group are in an invalid state.
+//   - InvalidParameterGroupStateFault
+//     One or more parameters in a parameter group are in an invalid state.
 //
-//   * ErrCodeParameterGroupNotFoundFault "ParameterGroupNotFoundFault"
-//   The specified parameter group does not exist.
+//   - ParameterGroupNotFoundFault
+//     The specified parameter group does not exist.
 //
-//   * ErrCodeClusterQuotaForCustomerExceededFault "ClusterQuotaForCustomerExceededFault"
-//   You have attempted to exceed the maximum number of DAX clusters for your
-//   AWS account.
+//   - ClusterQuotaForCustomerExceededFault
+//     You have attempted to exceed the maximum number of DAX clusters for your
+//     AWS account.
 //
-//   * ErrCodeNodeQuotaForClusterExceededFault "NodeQuotaForClusterExceededFault"
-//   You have attempted to exceed the maximum number of nodes for a DAX cluster.
+//   - NodeQuotaForClusterExceededFault
+//     You have attempted to exceed the maximum number of nodes for a DAX cluster.
 //
-//   * ErrCodeNodeQuotaForCustomerExceededFault "NodeQuotaForCustomerExceededFault"
-//   You have attempted to exceed the maximum number of nodes for your AWS account.
+//   - NodeQuotaForCustomerExceededFault
+//     You have attempted to exceed the maximum number of nodes for your AWS account.
 //
-//   * ErrCodeInvalidVPCNetworkStateFault "InvalidVPCNetworkStateFault"
-//   The VPC network is in an invalid state.
+//   - InvalidVPCNetworkStateFault
+//     The VPC network is in an invalid state.
 //
-//   * ErrCodeTagQuotaPerResourceExceeded "TagQuotaPerResourceExceeded"
-//   You have exceeded the maximum number of tags for this DAX cluster.
+//   - TagQuotaPerResourceExceeded
+//     You have exceeded the maximum number of tags for this DAX cluster.
 //
-//   * ErrCodeServiceLinkedRoleNotFoundFault "ServiceLinkedRoleNotFoundFault"
+//   - ServiceLinkedRoleNotFoundFault
+//     The specified service linked role (SLR) was not found.
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   The value for a parameter is invalid.
+//   - InvalidParameterValueException
+//     The value for a parameter is invalid.
 //
-//   * ErrCodeInvalidParameterCombinationException "InvalidParameterCombinationException"
-//   Two or more incompatible parameters were specified.
+//   - InvalidParameterCombinationException
+//     Two or more incompatible parameters were specified.
+//
+//   - ServiceQuotaExceededException
+//     You have reached the maximum number of x509 certificates that can be created
+//     for encrypted clusters in a 30 day period. Contact AWS customer support to
+//     discuss options for continuing to create encrypted clusters.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/CreateCluster
 func (c *DAX) CreateCluster(input *CreateClusterInput) (*CreateClusterOutput, error) {
@@ -146,14 +154,13 @@ const opCreateParameterGroup = "CreateParameterGroup"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateParameterGroupRequest method.
+//	req, resp := client.CreateParameterGroupRequest(params)
 //
-//    // Example sending a request using the CreateParameterGroupRequest method.
-//    req, resp := client.CreateParameterGroupRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/CreateParameterGroup
 func (c *DAX) CreateParameterGroupRequest(input *CreateParameterGroupInput) (req *request.Request, output *CreateParameterGroupOutput) {
@@ -184,23 +191,25 @@ func (c *DAX) CreateParameterGroupRequest(input *CreateParameterGroupInput) (req
 // See the AWS API reference guide for Amazon DynamoDB Accelerator (DAX)'s
 // API operation CreateParameterGroup for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeParameterGroupQuotaExceededFault "ParameterGroupQuotaExceededFault"
-//   You have attempted to exceed the maximum number of parameter groups.
+// Returned Error Types:
 //
-//   * ErrCodeParameterGroupAlreadyExistsFault "ParameterGroupAlreadyExistsFault"
-//   The specified parameter group already exists.
+//   - ParameterGroupQuotaExceededFault
+//     You have attempted to exceed the maximum number of parameter groups.
 //
-//   * ErrCodeInvalidParameterGroupStateFault "InvalidParameterGroupStateFault"
-//   One or more parameters in a parameter group are in an invalid state.
+//   - ParameterGroupAlreadyExistsFault
+//     The specified parameter group already exists.
 //
-//   * ErrCodeServiceLinkedRoleNotFoundFault "ServiceLinkedRoleNotFoundFault"
+//   - InvalidParameterGroupStateFault
+//     One or more parameters in a parameter group are in an invalid state.
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   The value for a parameter is invalid.
+//   - ServiceLinkedRoleNotFoundFault
+//     The specified service linked role (SLR) was not found.
 //
-//   * ErrCodeInvalidParameterCombinationException "InvalidParameterCombinationException"
-//   Two or more incompatible parameters were specified.
+//   - InvalidParameterValueException
+//     The value for a parameter is invalid.
+//
+//   - InvalidParameterCombinationException
+//     Two or more incompatible parameters were specified.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/CreateParameterGroup
 func (c *DAX) CreateParameterGroup(input *CreateParameterGroupInput) (*CreateParameterGroupOutput, error) {
@@ -240,14 +249,13 @@ const opCreateSubnetGroup = "CreateSubnetGroup"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateSubnetGroupRequest method.
+//	req, resp := client.CreateSubnetGroupRequest(params)
 //
-//    // Example sending a request using the CreateSubnetGroupRequest method.
-//    req, resp := client.CreateSubnetGroupRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/CreateSubnetGroup
 func (c *DAX) CreateSubnetGroupRequest(input *CreateSubnetGroupInput) (req *request.Request, output *CreateSubnetGroupOutput) {
@@ -277,22 +285,24 @@ func (c *DAX) CreateSubnetGroupRequest(input *CreateSubnetGroupInput) (req *requ
 // See the AWS API reference guide for Amazon DynamoDB Accelerator (DAX)'s
 // API operation CreateSubnetGroup for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeSubnetGroupAlreadyExistsFault "SubnetGroupAlreadyExistsFault"
-//   The specified subnet group already exists.
+// Returned Error Types:
 //
-//   * ErrCodeSubnetGroupQuotaExceededFault "SubnetGroupQuotaExceededFault"
-//   The request cannot be processed because it would exceed the allowed number
-//   of subnets in a subnet group.
+//   - SubnetGroupAlreadyExistsFault
+//     The specified subnet group already exists.
 //
-//   * ErrCodeSubnetQuotaExceededFault "SubnetQuotaExceededFault"
-//   The request cannot be processed because it would exceed the allowed number
-//   of subnets in a subnet group.
+//   - SubnetGroupQuotaExceededFault
+//     The request cannot be processed because it would exceed the allowed number
+//     of subnets in a subnet group.
 //
-//   * ErrCodeInvalidSubnet "InvalidSubnet"
-//   An invalid subnet identifier was specified.
+//   - SubnetQuotaExceededFault
+//     The request cannot be processed because it would exceed the allowed number
+//     of subnets in a subnet group.
 //
-//   * ErrCodeServiceLinkedRoleNotFoundFault "ServiceLinkedRoleNotFoundFault"
+//   - InvalidSubnet
+//     An invalid subnet identifier was specified.
+//
+//   - ServiceLinkedRoleNotFoundFault
+//     The specified service linked role (SLR) was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/CreateSubnetGroup
 func (c *DAX) CreateSubnetGroup(input *CreateSubnetGroupInput) (*CreateSubnetGroupOutput, error) {
@@ -332,14 +342,13 @@ const opDecreaseReplicationFactor = "DecreaseReplicationFactor"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DecreaseReplicationFactorRequest method.
+//	req, resp := client.DecreaseReplicationFactorRequest(params)
 //
-//    // Example sending a request using the DecreaseReplicationFactorRequest method.
-//    req, resp := client.DecreaseReplicationFactorRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/DecreaseReplicationFactor
 func (c *DAX) DecreaseReplicationFactorRequest(input *DecreaseReplicationFactorInput) (req *request.Request, output *DecreaseReplicationFactorOutput) {
@@ -372,23 +381,25 @@ func (c *DAX) DecreaseReplicationFactorRequest(input *DecreaseReplicationFactorI
 // See the AWS API reference guide for Amazon DynamoDB Accelerator (DAX)'s
 // API operation DecreaseReplicationFactor for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeClusterNotFoundFault "ClusterNotFoundFault"
-//   The requested cluster ID does not refer to an existing DAX cluster.
+// Returned Error Types:
 //
-//   * ErrCodeNodeNotFoundFault "NodeNotFoundFault"
-//   None of the nodes in the cluster have the given node ID.
+//   - ClusterNotFoundFault
+//     The requested cluster ID does not refer to an existing DAX cluster.
 //
-//   * ErrCodeInvalidClusterStateFault "InvalidClusterStateFault"
-//   The requested DAX cluster is not in the available state.
+//   - NodeNotFoundFault
+//     None of the nodes in the cluster have the given node ID.
 //
-//   * ErrCodeServiceLinkedRoleNotFoundFault "ServiceLinkedRoleNotFoundFault"
+//   - InvalidClusterStateFault
+//     The requested DAX cluster is not in the available state.
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   The value for a parameter is invalid.
+//   - ServiceLinkedRoleNotFoundFault
+//     The specified service linked role (SLR) was not found.
 //
-//   * ErrCodeInvalidParameterCombinationException "InvalidParameterCombinationException"
-//   Two or more incompatible parameters were specified.
+//   - InvalidParameterValueException
+//     The value for a parameter is invalid.
+//
+//   - InvalidParameterCombinationException
+//     Two or more incompatible parameters were specified.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/DecreaseReplicationFactor
 func (c *DAX) DecreaseReplicationFactor(input *DecreaseReplicationFactorInput) (*DecreaseReplicationFactorOutput, error) {
@@ -428,14 +439,13 @@ const opDeleteCluster = "DeleteCluster"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteClusterRequest method.
+//	req, resp := client.DeleteClusterRequest(params)
 //
-//    // Example sending a request using the DeleteClusterRequest method.
-//    req, resp := client.DeleteClusterRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/DeleteCluster
 func (c *DAX) DeleteClusterRequest(input *DeleteClusterInput) (req *request.Request, output *DeleteClusterOutput) {
@@ -468,20 +478,22 @@ func (c *DAX) DeleteClusterRequest(input *DeleteClusterInput) (req *request.Requ
 // See the AWS API reference guide for Amazon DynamoDB Accelerator (DAX)'s
 // API operation DeleteCluster for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeClusterNotFoundFault "ClusterNotFoundFault"
-//   The requested cluster ID does not refer to an existing DAX cluster.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidClusterStateFault "InvalidClusterStateFault"
-//   The requested DAX cluster is not in the available state.
+//   - ClusterNotFoundFault
+//     The requested cluster ID does not refer to an existing DAX cluster.
 //
-//   * ErrCodeServiceLinkedRoleNotFoundFault "ServiceLinkedRoleNotFoundFault"
+//   - InvalidClusterStateFault
+//     The requested DAX cluster is not in the available state.
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   The value for a parameter is invalid.
+//   - ServiceLinkedRoleNotFoundFault
+//     The specified service linked role (SLR) was not found.
 //
-//   * ErrCodeInvalidParameterCombinationException "InvalidParameterCombinationException"
-//   Two or more incompatible parameters were specified.
+//   - InvalidParameterValueException
+//     The value for a parameter is invalid.
+//
+//   - InvalidParameterCombinationException
+//     Two or more incompatible parameters were specified.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/DeleteCluster
 func (c *DAX) DeleteCluster(input *DeleteClusterInput) (*DeleteClusterOutput, error) {
@@ -521,14 +533,13 @@ const opDeleteParameterGroup = "DeleteParameterGroup"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteParameterGroupRequest method.
+//	req, resp := client.DeleteParameterGroupRequest(params)
 //
-//    // Example sending a request using the DeleteParameterGroupRequest method.
-//    req, resp := client.DeleteParameterGroupRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/DeleteParameterGroup
 func (c *DAX) DeleteParameterGroupRequest(input *DeleteParameterGroupInput) (req *request.Request, output *DeleteParameterGroupOutput) {
@@ -559,20 +570,22 @@ func (c *DAX) DeleteParameterGroupRequest(input *DeleteParameterGroupInput) (req
 // See the AWS API reference guide for Amazon DynamoDB Accelerator (DAX)'s
 // API operation DeleteParameterGroup for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParameterGroupStateFault "InvalidParameterGroupStateFault"
-//   One or more parameters in a parameter group are in an invalid state.
+// Returned Error Types:
 //
-//   * ErrCodeParameterGroupNotFoundFault "ParameterGroupNotFoundFault"
-//   The specified parameter group does not exist.
+//   - InvalidParameterGroupStateFault
+//     One or more parameters in a parameter group are in an invalid state.
 //
-//   * ErrCodeServiceLinkedRoleNotFoundFault "ServiceLinkedRoleNotFoundFault"
+//   - ParameterGroupNotFoundFault
+//     The specified parameter group does not exist.
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   The value for a parameter is invalid.
+//   - ServiceLinkedRoleNotFoundFault
+//     The specified service linked role (SLR) was not found.
 //
-//   * ErrCodeInvalidParameterCombinationException "InvalidParameterCombinationException"
-//   Two or more incompatible parameters were specified.
+//   - InvalidParameterValueException
+//     The value for a parameter is invalid.
+//
+//   - InvalidParameterCombinationException
+//     Two or more incompatible parameters were specified.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/DeleteParameterGroup
 func (c *DAX) DeleteParameterGroup(input *DeleteParameterGroupInput) (*DeleteParameterGroupOutput, error) {
@@ -612,14 +625,13 @@ const opDeleteSubnetGroup = "DeleteSubnetGroup"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteSubnetGroupRequest method.
+//	req, resp := client.DeleteSubnetGroupRequest(params)
 //
-//    // Example sending a request using the DeleteSubnetGroupRequest method.
-//    req, resp := client.DeleteSubnetGroupRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/DeleteSubnetGroup
 func (c *DAX) DeleteSubnetGroupRequest(input *DeleteSubnetGroupInput) (req *request.Request, output *DeleteSubnetGroupOutput) {
@@ -651,14 +663,16 @@ func (c *DAX) DeleteSubnetGroupRequest(input *DeleteSubnetGroupInput) (req *requ
 // See the AWS API reference guide for Amazon DynamoDB Accelerator (DAX)'s
 // API operation DeleteSubnetGroup for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeSubnetGroupInUseFault "SubnetGroupInUseFault"
-//   The specified subnet group is currently in use.
+// Returned Error Types:
 //
-//   * ErrCodeSubnetGroupNotFoundFault "SubnetGroupNotFoundFault"
-//   The requested subnet group name does not refer to an existing subnet group.
+//   - SubnetGroupInUseFault
+//     The specified subnet group is currently in use.
 //
-//   * ErrCodeServiceLinkedRoleNotFoundFault "ServiceLinkedRoleNotFoundFault"
+//   - SubnetGroupNotFoundFault
+//     The requested subnet group name does not refer to an existing subnet group.
+//
+//   - ServiceLinkedRoleNotFoundFault
+//     The specified service linked role (SLR) was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/DeleteSubnetGroup
 func (c *DAX) DeleteSubnetGroup(input *DeleteSubnetGroupInput) (*DeleteSubnetGroupOutput, error) {
@@ -698,14 +712,13 @@ const opDescribeClusters = "DescribeClusters"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeClustersRequest method.
+//	req, resp := client.DescribeClustersRequest(params)
 //
-//    // Example sending a request using the DescribeClustersRequest method.
-//    req, resp := client.DescribeClustersRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/DescribeClusters
 func (c *DAX) DescribeClustersRequest(input *DescribeClustersInput) (req *request.Request, output *DescribeClustersOutput) {
@@ -751,17 +764,19 @@ func (c *DAX) DescribeClustersRequest(input *DescribeClustersInput) (req *reques
 // See the AWS API reference guide for Amazon DynamoDB Accelerator (DAX)'s
 // API operation DescribeClusters for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeClusterNotFoundFault "ClusterNotFoundFault"
-//   The requested cluster ID does not refer to an existing DAX cluster.
+// Returned Error Types:
 //
-//   * ErrCodeServiceLinkedRoleNotFoundFault "ServiceLinkedRoleNotFoundFault"
+//   - ClusterNotFoundFault
+//     The requested cluster ID does not refer to an existing DAX cluster.
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   The value for a parameter is invalid.
+//   - ServiceLinkedRoleNotFoundFault
+//     The specified service linked role (SLR) was not found.
 //
-//   * ErrCodeInvalidParameterCombinationException "InvalidParameterCombinationException"
-//   Two or more incompatible parameters were specified.
+//   - InvalidParameterValueException
+//     The value for a parameter is invalid.
+//
+//   - InvalidParameterCombinationException
+//     Two or more incompatible parameters were specified.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/DescribeClusters
 func (c *DAX) DescribeClusters(input *DescribeClustersInput) (*DescribeClustersOutput, error) {
@@ -801,14 +816,13 @@ const opDescribeDefaultParameters = "DescribeDefaultParameters"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeDefaultParametersRequest method.
+//	req, resp := client.DescribeDefaultParametersRequest(params)
 //
-//    // Example sending a request using the DescribeDefaultParametersRequest method.
-//    req, resp := client.DescribeDefaultParametersRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/DescribeDefaultParameters
 func (c *DAX) DescribeDefaultParametersRequest(input *DescribeDefaultParametersInput) (req *request.Request, output *DescribeDefaultParametersOutput) {
@@ -838,14 +852,16 @@ func (c *DAX) DescribeDefaultParametersRequest(input *DescribeDefaultParametersI
 // See the AWS API reference guide for Amazon DynamoDB Accelerator (DAX)'s
 // API operation DescribeDefaultParameters for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServiceLinkedRoleNotFoundFault "ServiceLinkedRoleNotFoundFault"
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   The value for a parameter is invalid.
+//   - ServiceLinkedRoleNotFoundFault
+//     The specified service linked role (SLR) was not found.
 //
-//   * ErrCodeInvalidParameterCombinationException "InvalidParameterCombinationException"
-//   Two or more incompatible parameters were specified.
+//   - InvalidParameterValueException
+//     The value for a parameter is invalid.
+//
+//   - InvalidParameterCombinationException
+//     Two or more incompatible parameters were specified.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/DescribeDefaultParameters
 func (c *DAX) DescribeDefaultParameters(input *DescribeDefaultParametersInput) (*DescribeDefaultParametersOutput, error) {
@@ -885,14 +901,13 @@ const opDescribeEvents = "DescribeEvents"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeEventsRequest method.
+//	req, resp := client.DescribeEventsRequest(params)
 //
-//    // Example sending a request using the DescribeEventsRequest method.
-//    req, resp := client.DescribeEventsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/DescribeEvents
 func (c *DAX) DescribeEventsRequest(input *DescribeEventsInput) (req *request.Request, output *DescribeEventsOutput) {
@@ -917,7 +932,7 @@ func (c *DAX) DescribeEventsRequest(input *DescribeEventsInput) (req *request.Re
 // events specific to a particular DAX cluster or parameter group by providing
 // the name as a parameter.
 //
-// By default, only the events occurring within the last hour are returned;
+// By default, only the events occurring within the last 24 hours are returned;
 // however, you can retrieve up to 14 days' worth of events if necessary.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
@@ -927,14 +942,16 @@ func (c *DAX) DescribeEventsRequest(input *DescribeEventsInput) (req *request.Re
 // See the AWS API reference guide for Amazon DynamoDB Accelerator (DAX)'s
 // API operation DescribeEvents for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServiceLinkedRoleNotFoundFault "ServiceLinkedRoleNotFoundFault"
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   The value for a parameter is invalid.
+//   - ServiceLinkedRoleNotFoundFault
+//     The specified service linked role (SLR) was not found.
 //
-//   * ErrCodeInvalidParameterCombinationException "InvalidParameterCombinationException"
-//   Two or more incompatible parameters were specified.
+//   - InvalidParameterValueException
+//     The value for a parameter is invalid.
+//
+//   - InvalidParameterCombinationException
+//     Two or more incompatible parameters were specified.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/DescribeEvents
 func (c *DAX) DescribeEvents(input *DescribeEventsInput) (*DescribeEventsOutput, error) {
@@ -974,14 +991,13 @@ const opDescribeParameterGroups = "DescribeParameterGroups"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeParameterGroupsRequest method.
+//	req, resp := client.DescribeParameterGroupsRequest(params)
 //
-//    // Example sending a request using the DescribeParameterGroupsRequest method.
-//    req, resp := client.DescribeParameterGroupsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/DescribeParameterGroups
 func (c *DAX) DescribeParameterGroupsRequest(input *DescribeParameterGroupsInput) (req *request.Request, output *DescribeParameterGroupsOutput) {
@@ -1012,17 +1028,19 @@ func (c *DAX) DescribeParameterGroupsRequest(input *DescribeParameterGroupsInput
 // See the AWS API reference guide for Amazon DynamoDB Accelerator (DAX)'s
 // API operation DescribeParameterGroups for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeParameterGroupNotFoundFault "ParameterGroupNotFoundFault"
-//   The specified parameter group does not exist.
+// Returned Error Types:
 //
-//   * ErrCodeServiceLinkedRoleNotFoundFault "ServiceLinkedRoleNotFoundFault"
+//   - ParameterGroupNotFoundFault
+//     The specified parameter group does not exist.
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   The value for a parameter is invalid.
+//   - ServiceLinkedRoleNotFoundFault
+//     The specified service linked role (SLR) was not found.
 //
-//   * ErrCodeInvalidParameterCombinationException "InvalidParameterCombinationException"
-//   Two or more incompatible parameters were specified.
+//   - InvalidParameterValueException
+//     The value for a parameter is invalid.
+//
+//   - InvalidParameterCombinationException
+//     Two or more incompatible parameters were specified.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/DescribeParameterGroups
 func (c *DAX) DescribeParameterGroups(input *DescribeParameterGroupsInput) (*DescribeParameterGroupsOutput, error) {
@@ -1062,14 +1080,13 @@ const opDescribeParameters = "DescribeParameters"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeParametersRequest method.
+//	req, resp := client.DescribeParametersRequest(params)
 //
-//    // Example sending a request using the DescribeParametersRequest method.
-//    req, resp := client.DescribeParametersRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/DescribeParameters
 func (c *DAX) DescribeParametersRequest(input *DescribeParametersInput) (req *request.Request, output *DescribeParametersOutput) {
@@ -1099,17 +1116,19 @@ func (c *DAX) DescribeParametersRequest(input *DescribeParametersInput) (req *re
 // See the AWS API reference guide for Amazon DynamoDB Accelerator (DAX)'s
 // API operation DescribeParameters for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeParameterGroupNotFoundFault "ParameterGroupNotFoundFault"
-//   The specified parameter group does not exist.
+// Returned Error Types:
 //
-//   * ErrCodeServiceLinkedRoleNotFoundFault "ServiceLinkedRoleNotFoundFault"
+//   - ParameterGroupNotFoundFault
+//     The specified parameter group does not exist.
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   The value for a parameter is invalid.
+//   - ServiceLinkedRoleNotFoundFault
+//     The specified service linked role (SLR) was not found.
 //
-//   * ErrCodeInvalidParameterCombinationException "InvalidParameterCombinationException"
-//   Two or more incompatible parameters were specified.
+//   - InvalidParameterValueException
+//     The value for a parameter is invalid.
+//
+//   - InvalidParameterCombinationException
+//     Two or more incompatible parameters were specified.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/DescribeParameters
 func (c *DAX) DescribeParameters(input *DescribeParametersInput) (*DescribeParametersOutput, error) {
@@ -1149,14 +1168,13 @@ const opDescribeSubnetGroups = "DescribeSubnetGroups"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeSubnetGroupsRequest method.
+//	req, resp := client.DescribeSubnetGroupsRequest(params)
 //
-//    // Example sending a request using the DescribeSubnetGroupsRequest method.
-//    req, resp := client.DescribeSubnetGroupsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/DescribeSubnetGroups
 func (c *DAX) DescribeSubnetGroupsRequest(input *DescribeSubnetGroupsInput) (req *request.Request, output *DescribeSubnetGroupsOutput) {
@@ -1187,11 +1205,13 @@ func (c *DAX) DescribeSubnetGroupsRequest(input *DescribeSubnetGroupsInput) (req
 // See the AWS API reference guide for Amazon DynamoDB Accelerator (DAX)'s
 // API operation DescribeSubnetGroups for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeSubnetGroupNotFoundFault "SubnetGroupNotFoundFault"
-//   The requested subnet group name does not refer to an existing subnet group.
+// Returned Error Types:
 //
-//   * ErrCodeServiceLinkedRoleNotFoundFault "ServiceLinkedRoleNotFoundFault"
+//   - SubnetGroupNotFoundFault
+//     The requested subnet group name does not refer to an existing subnet group.
+//
+//   - ServiceLinkedRoleNotFoundFault
+//     The specified service linked role (SLR) was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/DescribeSubnetGroups
 func (c *DAX) DescribeSubnetGroups(input *DescribeSubnetGroupsInput) (*DescribeSubnetGroupsOutput, error) {
@@ -1231,14 +1251,13 @@ const opIncreaseReplicationFactor = "IncreaseReplicationFactor"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the IncreaseReplicationFactorRequest method.
+//	req, resp := client.IncreaseReplicationFactorRequest(params)
 //
-//    // Example sending a request using the IncreaseReplicationFactorRequest method.
-//    req, resp := client.IncreaseReplicationFactorRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/IncreaseReplicationFactor
 func (c *DAX) IncreaseReplicationFactorRequest(input *IncreaseReplicationFactorInput) (req *request.Request, output *IncreaseReplicationFactorOutput) {
@@ -1268,33 +1287,35 @@ func (c *DAX) IncreaseReplicationFactorRequest(input *IncreaseReplicationFactorI
 // See the AWS API reference guide for Amazon DynamoDB Accelerator (DAX)'s
 // API operation IncreaseReplicationFactor for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeClusterNotFoundFault "ClusterNotFoundFault"
-//   The requested cluster ID does not refer to an existing DAX cluster.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidClusterStateFault "InvalidClusterStateFault"
-//   The requested DAX cluster is not in the available state.
+//   - ClusterNotFoundFault
+//     The requested cluster ID does not refer to an existing DAX cluster.
 //
-//   * ErrCodeInsufficientClusterCapacityFault "InsufficientClusterCapacityFault"
-//   There are not enough system resources to create the cluster you requested
-//   (or to resize an already-existing cluster).
+//   - InvalidClusterStateFault
+//     The requested DAX cluster is not in the available state.
 //
-//   * ErrCodeInvalidVPCNetworkStateFault "InvalidVPCNetworkStateFault"
-//   The VPC network is in an invalid state.
+//   - InsufficientClusterCapacityFault
+//     There are not enough system resources to create the cluster you requested
+//     (or to resize an already-existing cluster).
 //
-//   * ErrCodeNodeQuotaForClusterExceededFault "NodeQuotaForClusterExceededFault"
-//   You have attempted to exceed the maximum number of nodes for a DAX cluster.
+//   - InvalidVPCNetworkStateFault
+//     The VPC network is in an invalid state.
 //
-//   * ErrCodeNodeQuotaForCustomerExceededFault "NodeQuotaForCustomerExceededFault"
-//   You have attempted to exceed the maximum number of nodes for your AWS account.
+//   - NodeQuotaForClusterExceededFault
+//     You have attempted to exceed the maximum number of nodes for a DAX cluster.
 //
-//   * ErrCodeServiceLinkedRoleNotFoundFault "ServiceLinkedRoleNotFoundFault"
+//   - NodeQuotaForCustomerExceededFault
+//     You have attempted to exceed the maximum number of nodes for your AWS account.
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   The value for a parameter is invalid.
+//   - ServiceLinkedRoleNotFoundFault
+//     The specified service linked role (SLR) was not found.
 //
-//   * ErrCodeInvalidParameterCombinationException "InvalidParameterCombinationException"
-//   Two or more incompatible parameters were specified.
+//   - InvalidParameterValueException
+//     The value for a parameter is invalid.
+//
+//   - InvalidParameterCombinationException
+//     Two or more incompatible parameters were specified.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/IncreaseReplicationFactor
 func (c *DAX) IncreaseReplicationFactor(input *IncreaseReplicationFactorInput) (*IncreaseReplicationFactorOutput, error) {
@@ -1334,14 +1355,13 @@ const opListTags = "ListTags"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTagsRequest method.
+//	req, resp := client.ListTagsRequest(params)
 //
-//    // Example sending a request using the ListTagsRequest method.
-//    req, resp := client.ListTagsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/ListTags
 func (c *DAX) ListTagsRequest(input *ListTagsInput) (req *request.Request, output *ListTagsOutput) {
@@ -1372,23 +1392,25 @@ func (c *DAX) ListTagsRequest(input *ListTagsInput) (req *request.Request, outpu
 // See the AWS API reference guide for Amazon DynamoDB Accelerator (DAX)'s
 // API operation ListTags for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeClusterNotFoundFault "ClusterNotFoundFault"
-//   The requested cluster ID does not refer to an existing DAX cluster.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidARNFault "InvalidARNFault"
-//   The Amazon Resource Name (ARN) supplied in the request is not valid.
+//   - ClusterNotFoundFault
+//     The requested cluster ID does not refer to an existing DAX cluster.
 //
-//   * ErrCodeInvalidClusterStateFault "InvalidClusterStateFault"
-//   The requested DAX cluster is not in the available state.
+//   - InvalidARNFault
+//     The Amazon Resource Name (ARN) supplied in the request is not valid.
 //
-//   * ErrCodeServiceLinkedRoleNotFoundFault "ServiceLinkedRoleNotFoundFault"
+//   - InvalidClusterStateFault
+//     The requested DAX cluster is not in the available state.
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   The value for a parameter is invalid.
+//   - ServiceLinkedRoleNotFoundFault
+//     The specified service linked role (SLR) was not found.
 //
-//   * ErrCodeInvalidParameterCombinationException "InvalidParameterCombinationException"
-//   Two or more incompatible parameters were specified.
+//   - InvalidParameterValueException
+//     The value for a parameter is invalid.
+//
+//   - InvalidParameterCombinationException
+//     Two or more incompatible parameters were specified.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/ListTags
 func (c *DAX) ListTags(input *ListTagsInput) (*ListTagsOutput, error) {
@@ -1428,14 +1450,13 @@ const opRebootNode = "RebootNode"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the RebootNodeRequest method.
+//	req, resp := client.RebootNodeRequest(params)
 //
-//    // Example sending a request using the RebootNodeRequest method.
-//    req, resp := client.RebootNodeRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/RebootNode
 func (c *DAX) RebootNodeRequest(input *RebootNodeInput) (req *request.Request, output *RebootNodeOutput) {
@@ -1459,6 +1480,9 @@ func (c *DAX) RebootNodeRequest(input *RebootNodeInput) (req *request.Request, o
 // Reboots a single node of a DAX cluster. The reboot action takes place as
 // soon as possible. During the reboot, the node status is set to REBOOTING.
 //
+// RebootNode restarts the DAX engine process and does not remove the contents
+// of the cache.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -1466,23 +1490,25 @@ func (c *DAX) RebootNodeRequest(input *RebootNodeInput) (req *request.Request, o
 // See the AWS API reference guide for Amazon DynamoDB Accelerator (DAX)'s
 // API operation RebootNode for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeClusterNotFoundFault "ClusterNotFoundFault"
-//   The requested cluster ID does not refer to an existing DAX cluster.
+// Returned Error Types:
+//
+//   - ClusterNotFoundFault
+//     The requested cluster ID does not refer to an existing DAX cluster.
 //
-//   * ErrCodeNodeNotFoundFault "NodeNotFoundFault"
-//   None of the nodes in the cluster have the given node ID.
+//   - NodeNotFoundFault
+//     None of the nodes in the cluster have the given node ID.
 //
-//   * ErrCodeInvalidClusterStateFault "InvalidClusterStateFault"
-//   The requested DAX cluster is not in the available state.
+//   - InvalidClusterStateFault
+//     The requested DAX cluster is not in the available state.
 //
-//   * ErrCodeServiceLinkedRoleNotFoundFault "ServiceLinkedRoleNotFoundFault"
+//   - ServiceLinkedRoleNotFoundFault
+//     The specified service linked role (SLR) was not found.
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   The value for a parameter is invalid.
+//   - InvalidParameterValueException
+//     The value for a parameter is invalid.
 //
-//   * ErrCodeInvalidParameterCombinationException "InvalidParameterCombinationException"
-//   Two or more incompatible parameters were specified.
+//   - InvalidParameterCombinationException
+//     Two or more incompatible parameters were specified.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/RebootNode
 func (c *DAX) RebootNode(input *RebootNodeInput) (*RebootNodeOutput, error) {
@@ -1522,14 +1548,13 @@ const opTagResource = "TagResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the TagResourceRequest method.
+//	req, resp := client.TagResourceRequest(params)
 //
-//    // Example sending a request using the TagResourceRequest method.
-//    req, resp := client.TagResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/TagResource
 func (c *DAX) TagResourceRequest(input *TagResourceInput) (req *request.Request, output *TagResourceOutput) {
@@ -1560,26 +1585,28 @@ func (c *DAX) TagResourceRequest(input *TagResourceInput) (req *request.Request,
 // See the AWS API reference guide for Amazon DynamoDB Accelerator (DAX)'s
 // API operation TagResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeClusterNotFoundFault "ClusterNotFoundFault"
-//   The requested cluster ID does not refer to an existing DAX cluster.
+// Returned Error Types:
+//
+//   - ClusterNotFoundFault
+//     The requested cluster ID does not refer to an existing DAX cluster.
 //
-//   * ErrCodeTagQuotaPerResourceExceeded "TagQuotaPerResourceExceeded"
-//   You have exceeded the maximum number of tags for this DAX cluster.
+//   - TagQuotaPerResourceExceeded
+//     You have exceeded the maximum number of tags for this DAX cluster.
 //
-//   * ErrCodeInvalidARNFault "InvalidARNFault"
-//   The Amazon Resource Name (ARN) supplied in the request is not valid.
+//   - InvalidARNFault
+//     The Amazon Resource Name (ARN) supplied in the request is not valid.
 //
-//   * ErrCodeInvalidClusterStateFault "InvalidClusterStateFault"
-//   The requested DAX cluster is not in the available state.
+//   - InvalidClusterStateFault
+//     The requested DAX cluster is not in the available state.
 //
-//   * ErrCodeServiceLinkedRoleNotFoundFault "ServiceLinkedRoleNotFoundFault"
+//   - ServiceLinkedRoleNotFoundFault
+//     The specified service linked role (SLR) was not found.
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   The value for a parameter is invalid.
+//   - InvalidParameterValueException
+//     The value for a parameter is invalid.
 //
-//   * ErrCodeInvalidParameterCombinationException "InvalidParameterCombinationException"
-//   Two or more incompatible parameters were specified.
+//   - InvalidParameterCombinationException
+//     Two or more incompatible parameters were specified.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/TagResource
 func (c *DAX) TagResource(input *TagResourceInput) (*TagResourceOutput, error) {
@@ -1619,14 +1646,13 @@ const opUntagResource = "UntagResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UntagResourceRequest method.
+//	req, resp := client.UntagResourceRequest(params)
 //
-//    // Example sending a request using the UntagResourceRequest method.
-//    req, resp := client.UntagResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/UntagResource
 func (c *DAX) UntagResourceRequest(input *UntagResourceInput) (req *request.Request, output *UntagResourceOutput) {
@@ -1657,26 +1683,28 @@ func (c *DAX) UntagResourceRequest(input *UntagResourceInput) (req *request.Requ
 // See the AWS API reference guide for Amazon DynamoDB Accelerator (DAX)'s
 // API operation UntagResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeClusterNotFoundFault "ClusterNotFoundFault"
-//   The requested cluster ID does not refer to an existing DAX cluster.
+// Returned Error Types:
+//
+//   - ClusterNotFoundFault
+//     The requested cluster ID does not refer to an existing DAX cluster.
 //
-//   * ErrCodeInvalidARNFault "InvalidARNFault"
-//   The Amazon Resource Name (ARN) supplied in the request is not valid.
+//   - InvalidARNFault
+//     The Amazon Resource Name (ARN) supplied in the request is not valid.
 //
-//   * ErrCodeTagNotFoundFault "TagNotFoundFault"
-//   The tag does not exist.
+//   - TagNotFoundFault
+//     The tag does not exist.
 //
-//   * ErrCodeInvalidClusterStateFault "InvalidClusterStateFault"
-//   The requested DAX cluster is not in the available state.
+//   - InvalidClusterStateFault
+//     The requested DAX cluster is not in the available state.
 //
-//   * ErrCodeServiceLinkedRoleNotFoundFault "ServiceLinkedRoleNotFoundFault"
+//   - ServiceLinkedRoleNotFoundFault
+//     The specified service linked role (SLR) was not found.
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   The value for a parameter is invalid.
+//   - InvalidParameterValueException
+//     The value for a parameter is invalid.
 //
-//   * ErrCodeInvalidParameterCombinationException "InvalidParameterCombinationException"
-//   Two or more incompatible parameters were specified.
+//   - InvalidParameterCombinationException
+//     Two or more incompatible parameters were specified.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/UntagResource
 func (c *DAX) UntagResource(input *UntagResourceInput) (*UntagResourceOutput, error) {
@@ -1716,14 +1744,13 @@ const opUpdateCluster = "UpdateCluster"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateClusterRequest method.
+//	req, resp := client.UpdateClusterRequest(params)
 //
-//    // Example sending a request using the UpdateClusterRequest method.
-//    req, resp := client.UpdateClusterRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/UpdateCluster
 func (c *DAX) UpdateClusterRequest(input *UpdateClusterInput) (req *request.Request, output *UpdateClusterOutput) {
@@ -1755,26 +1782,28 @@ func (c *DAX) UpdateClusterRequest(input *UpdateClusterInput) (req *request.Requ
 // See the AWS API reference guide for Amazon DynamoDB Accelerator (DAX)'s
 // API operation UpdateCluster for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidClusterStateFault "InvalidClusterStateFault"
-//   The requested DAX cluster is not in the available state.
+// Returned Error Types:
+//
+//   - InvalidClusterStateFault
+//     The requested DAX cluster is not in the available state.
 //
-//   * ErrCodeClusterNotFoundFault "ClusterNotFoundFault"
-//   The requested cluster ID does not refer to an existing DAX cluster.
+//   - ClusterNotFoundFault
+//     The requested cluster ID does not refer to an existing DAX cluster.
 //
-//   * ErrCodeInvalidParameterGroupStateFault "InvalidParameterGroupStateFault"
-//   One or more parameters in a parameter group are in an invalid state.
+//   - InvalidParameterGroupStateFault
+//     One or more parameters in a parameter group are in an invalid state.
 //
-//   * ErrCodeParameterGroupNotFoundFault "ParameterGroupNotFoundFault"
-//   The specified parameter group does not exist.
+//   - ParameterGroupNotFoundFault
+//     The specified parameter group does not exist.
 //
-//   * ErrCodeServiceLinkedRoleNotFoundFault "ServiceLinkedRoleNotFoundFault"
+//   - ServiceLinkedRoleNotFoundFault
+//     The specified service linked role (SLR) was not found.
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   The value for a parameter is invalid.
+//   - InvalidParameterValueException
+//     The value for a parameter is invalid.
 //
-//   * ErrCodeInvalidParameterCombinationException "InvalidParameterCombinationException"
-//   Two or more incompatible parameters were specified.
+//   - InvalidParameterCombinationException
+//     Two or more incompatible parameters were specified.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/UpdateCluster
 func (c *DAX) UpdateCluster(input *UpdateClusterInput) (*UpdateClusterOutput, error) {
@@ -1814,14 +1843,13 @@ const opUpdateParameterGroup = "UpdateParameterGroup"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateParameterGroupRequest method.
+//	req, resp := client.UpdateParameterGroupRequest(params)
 //
-//    // Example sending a request using the UpdateParameterGroupRequest method.
-//    req, resp := client.UpdateParameterGroupRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/UpdateParameterGroup
 func (c *DAX) UpdateParameterGroupRequest(input *UpdateParameterGroupInput) (req *request.Request, output *UpdateParameterGroupOutput) {
@@ -1852,20 +1880,22 @@ func (c *DAX) UpdateParameterGroupRequest(input *UpdateParameterGroupInput) (req
 // See the AWS API reference guide for Amazon DynamoDB Accelerator (DAX)'s
 // API operation UpdateParameterGroup for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParameterGroupStateFault "InvalidParameterGroupStateFault"
-//   One or more parameters in a parameter group are in an invalid state.
+// Returned Error Types:
+//
+//   - InvalidParameterGroupStateFault
+//     One or more parameters in a parameter group are in an invalid state.
 //
-//   * ErrCodeParameterGroupNotFoundFault "ParameterGroupNotFoundFault"
-//   The specified parameter group does not exist.
+//   - ParameterGroupNotFoundFault
+//     The specified parameter group does not exist.
 //
-//   * ErrCodeServiceLinkedRoleNotFoundFault "ServiceLinkedRoleNotFoundFault"
+//   - ServiceLinkedRoleNotFoundFault
+//     The specified service linked role (SLR) was not found.
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   The value for a parameter is invalid.
+//   - InvalidParameterValueException
+//     The value for a parameter is invalid.
 //
-//   * ErrCodeInvalidParameterCombinationException "InvalidParameterCombinationException"
-//   Two or more incompatible parameters were specified.
+//   - InvalidParameterCombinationException
+//     Two or more incompatible parameters were specified.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/UpdateParameterGroup
 func (c *DAX) UpdateParameterGroup(input *UpdateParameterGroupInput) (*UpdateParameterGroupOutput, error) {
@@ -1905,14 +1935,13 @@ const opUpdateSubnetGroup = "UpdateSubnetGroup"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateSubnetGroupRequest method.
+//	req, resp := client.UpdateSubnetGroupRequest(params)
 //
-//    // Example sending a request using the UpdateSubnetGroupRequest method.
-//    req, resp := client.UpdateSubnetGroupRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/UpdateSubnetGroup
 func (c *DAX) UpdateSubnetGroupRequest(input *UpdateSubnetGroupInput) (req *request.Request, output *UpdateSubnetGroupOutput) {
@@ -1942,21 +1971,23 @@ func (c *DAX) UpdateSubnetGroupRequest(input *UpdateSubnetGroupInput) (req *requ
 // See the AWS API reference guide for Amazon DynamoDB Accelerator (DAX)'s
 // API operation UpdateSubnetGroup for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeSubnetGroupNotFoundFault "SubnetGroupNotFoundFault"
-//   The requested subnet group name does not refer to an existing subnet group.
+// Returned Error Types:
+//
+//   - SubnetGroupNotFoundFault
+//     The requested subnet group name does not refer to an existing subnet group.
 //
-//   * ErrCodeSubnetQuotaExceededFault "SubnetQuotaExceededFault"
-//   The request cannot be processed because it would exceed the allowed number
-//   of subnets in a subnet group.
+//   - SubnetQuotaExceededFault
+//     The request cannot be processed because it would exceed the allowed number
+//     of subnets in a subnet group.
 //
-//   * ErrCodeSubnetInUse "SubnetInUse"
-//   The requested subnet is being used by another subnet group.
+//   - SubnetInUse
+//     The requested subnet is being used by another subnet group.
 //
-//   * ErrCodeInvalidSubnet "InvalidSubnet"
-//   An invalid subnet identifier was specified.
+//   - InvalidSubnet
+//     An invalid subnet identifier was specified.
 //
-//   * ErrCodeServiceLinkedRoleNotFoundFault "ServiceLinkedRoleNotFoundFault"
+//   - ServiceLinkedRoleNotFoundFault
+//     The specified service linked role (SLR) was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/dax-2017-04-19/UpdateSubnetGroup
 func (c *DAX) UpdateSubnetGroup(input *UpdateSubnetGroupInput) (*UpdateSubnetGroupOutput, error) {
@@ -1991,12 +2022,16 @@ type Cluster struct {
 	// The Amazon Resource Name (ARN) that uniquely identifies the cluster.
 	ClusterArn *string `type:"string"`
 
-	// The configuration endpoint for this DAX cluster, consisting of a DNS name
-	// and a port number. Client applications can specify this endpoint, rather
-	// than an individual node endpoint, and allow the DAX client software to intelligently
-	// route requests and responses to nodes in the DAX cluster.
+	// The endpoint for this DAX cluster, consisting of a DNS name, a port number,
+	// and a URL. Applications should use the URL to configure the DAX client to
+	// find their cluster.
 	ClusterDiscoveryEndpoint *Endpoint `type:"structure"`
 
+	// The type of encryption supported by the cluster's endpoint. Values are:
+	//
+	//    * NONE for no encryption TLS for Transport Layer Security
+	ClusterEndpointEncryptionType *string `type:"string" enum:"ClusterEndpointEncryptionType"`
+
 	// The name of the DAX cluster.
 	ClusterName *string `type:"string"`
 
@@ -2048,12 +2083,20 @@ type Cluster struct {
 	TotalNodes *int64 `type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Cluster) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Cluster) GoString() string {
 	return s.String()
 }
@@ -2076,6 +2119,12 @@ func (s *Cluster) SetClusterDiscoveryEndpoint(v *Endpoint) *Cluster {
 	return s
 }
 
+// SetClusterEndpointEncryptionType sets the ClusterEndpointEncryptionType field's value.
+func (s *Cluster) SetClusterEndpointEncryptionType(v string) *Cluster {
+	s.ClusterEndpointEncryptionType = &v
+	return s
+}
+
 // SetClusterName sets the ClusterName field's value.
 func (s *Cluster) SetClusterName(v string) *Cluster {
 	s.ClusterName = &v
@@ -2160,14 +2209,215 @@ func (s *Cluster) SetTotalNodes(v int64) *Cluster {
 	return s
 }
 
+// You already have a DAX cluster with the given identifier.
+type ClusterAlreadyExistsFault struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ClusterAlreadyExistsFault) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ClusterAlreadyExistsFault) GoString() string {
+	return s.String()
+}
+
+func newErrorClusterAlreadyExistsFault(v protocol.ResponseMetadata) error {
+	return &ClusterAlreadyExistsFault{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ClusterAlreadyExistsFault) Code() string {
+	return "ClusterAlreadyExistsFault"
+}
+
+// Message returns the exception's message.
+func (s *ClusterAlreadyExistsFault) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ClusterAlreadyExistsFault) OrigErr() error {
+	return nil
+}
+
+func (s *ClusterAlreadyExistsFault) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ClusterAlreadyExistsFault) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ClusterAlreadyExistsFault) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The requested cluster ID does not refer to an existing DAX cluster.
+type ClusterNotFoundFault struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ClusterNotFoundFault) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ClusterNotFoundFault) GoString() string {
+	return s.String()
+}
+
+func newErrorClusterNotFoundFault(v protocol.ResponseMetadata) error {
+	return &ClusterNotFoundFault{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ClusterNotFoundFault) Code() string {
+	return "ClusterNotFoundFault"
+}
+
+// Message returns the exception's message.
+func (s *ClusterNotFoundFault) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ClusterNotFoundFault) OrigErr() error {
+	return nil
+}
+
+func (s *ClusterNotFoundFault) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ClusterNotFoundFault) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ClusterNotFoundFault) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// You have attempted to exceed the maximum number of DAX clusters for your
+// AWS account.
+type ClusterQuotaForCustomerExceededFault struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ClusterQuotaForCustomerExceededFault) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ClusterQuotaForCustomerExceededFault) GoString() string {
+	return s.String()
+}
+
+func newErrorClusterQuotaForCustomerExceededFault(v protocol.ResponseMetadata) error {
+	return &ClusterQuotaForCustomerExceededFault{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ClusterQuotaForCustomerExceededFault) Code() string {
+	return "ClusterQuotaForCustomerExceededFault"
+}
+
+// Message returns the exception's message.
+func (s *ClusterQuotaForCustomerExceededFault) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ClusterQuotaForCustomerExceededFault) OrigErr() error {
+	return nil
+}
+
+func (s *ClusterQuotaForCustomerExceededFault) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ClusterQuotaForCustomerExceededFault) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ClusterQuotaForCustomerExceededFault) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 type CreateClusterInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Availability Zones (AZs) in which the cluster nodes will be created.
-	// All nodes belonging to the cluster are placed in these Availability Zones.
-	// Use this parameter if you want to distribute the nodes across multiple AZs.
+	// The Availability Zones (AZs) in which the cluster nodes will reside after
+	// the cluster has been created or updated. If provided, the length of this
+	// list must equal the ReplicationFactor parameter. If you omit this parameter,
+	// DAX will spread the nodes across Availability Zones for the highest availability.
 	AvailabilityZones []*string `type:"list"`
 
+	// The type of encryption the cluster's endpoint should support. Values are:
+	//
+	//    * NONE for no encryption
+	//
+	//    * TLS for Transport Layer Security
+	ClusterEndpointEncryptionType *string `type:"string" enum:"ClusterEndpointEncryptionType"`
+
 	// The cluster identifier. This parameter is stored as a lowercase string.
 	//
 	// Constraints:
@@ -2234,7 +2484,9 @@ type CreateClusterInput struct {
 	// The number of nodes in the DAX cluster. A replication factor of 1 will create
 	// a single-node cluster, without any read replicas. For additional fault tolerance,
 	// you can create a multiple node cluster with one or more read replicas. To
-	// do this, set ReplicationFactor to 2 or more.
+	// do this, set ReplicationFactor to a number between 3 (one primary and two
+	// read replicas) and 10 (one primary and nine read replicas). If the AvailabilityZones
+	// parameter is provided, its length must equal the ReplicationFactor.
 	//
 	// AWS recommends that you have at least two read replicas per cluster.
 	//
@@ -2261,12 +2513,20 @@ type CreateClusterInput struct {
 	Tags []*Tag `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateClusterInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateClusterInput) GoString() string {
 	return s.String()
 }
@@ -2304,6 +2564,12 @@ func (s *CreateClusterInput) SetAvailabilityZones(v []*string) *CreateClusterInp
 	return s
 }
 
+// SetClusterEndpointEncryptionType sets the ClusterEndpointEncryptionType field's value.
+func (s *CreateClusterInput) SetClusterEndpointEncryptionType(v string) *CreateClusterInput {
+	s.ClusterEndpointEncryptionType = &v
+	return s
+}
+
 // SetClusterName sets the ClusterName field's value.
 func (s *CreateClusterInput) SetClusterName(v string) *CreateClusterInput {
 	s.ClusterName = &v
@@ -2383,12 +2649,20 @@ type CreateClusterOutput struct {
 	Cluster *Cluster `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateClusterOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateClusterOutput) GoString() string {
 	return s.String()
 }
@@ -2412,12 +2686,20 @@ type CreateParameterGroupInput struct {
 	ParameterGroupName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateParameterGroupInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateParameterGroupInput) GoString() string {
 	return s.String()
 }
@@ -2454,12 +2736,20 @@ type CreateParameterGroupOutput struct {
 	ParameterGroup *ParameterGroup `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateParameterGroupOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateParameterGroupOutput) GoString() string {
 	return s.String()
 }
@@ -2487,12 +2777,20 @@ type CreateSubnetGroupInput struct {
 	SubnetIds []*string `type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateSubnetGroupInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateSubnetGroupInput) GoString() string {
 	return s.String()
 }
@@ -2538,12 +2836,20 @@ type CreateSubnetGroupOutput struct {
 	SubnetGroup *SubnetGroup `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateSubnetGroupOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateSubnetGroupOutput) GoString() string {
 	return s.String()
 }
@@ -2574,12 +2880,20 @@ type DecreaseReplicationFactorInput struct {
 	NodeIdsToRemove []*string `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DecreaseReplicationFactorInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DecreaseReplicationFactorInput) GoString() string {
 	return s.String()
 }
@@ -2632,12 +2946,20 @@ type DecreaseReplicationFactorOutput struct {
 	Cluster *Cluster `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DecreaseReplicationFactorOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DecreaseReplicationFactorOutput) GoString() string {
 	return s.String()
 }
@@ -2657,12 +2979,20 @@ type DeleteClusterInput struct {
 	ClusterName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteClusterInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteClusterInput) GoString() string {
 	return s.String()
 }
@@ -2693,12 +3023,20 @@ type DeleteClusterOutput struct {
 	Cluster *Cluster `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteClusterOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteClusterOutput) GoString() string {
 	return s.String()
 }
@@ -2718,12 +3056,20 @@ type DeleteParameterGroupInput struct {
 	ParameterGroupName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteParameterGroupInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteParameterGroupInput) GoString() string {
 	return s.String()
 }
@@ -2755,12 +3101,20 @@ type DeleteParameterGroupOutput struct {
 	DeletionMessage *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteParameterGroupOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteParameterGroupOutput) GoString() string {
 	return s.String()
 }
@@ -2780,12 +3134,20 @@ type DeleteSubnetGroupInput struct {
 	SubnetGroupName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteSubnetGroupInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteSubnetGroupInput) GoString() string {
 	return s.String()
 }
@@ -2817,12 +3179,20 @@ type DeleteSubnetGroupOutput struct {
 	DeletionMessage *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteSubnetGroupOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteSubnetGroupOutput) GoString() string {
 	return s.String()
 }
@@ -2852,12 +3222,20 @@ type DescribeClustersInput struct {
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeClustersInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeClustersInput) GoString() string {
 	return s.String()
 }
@@ -2891,12 +3269,20 @@ type DescribeClustersOutput struct {
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeClustersOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeClustersOutput) GoString() string {
 	return s.String()
 }
@@ -2929,12 +3315,20 @@ type DescribeDefaultParametersInput struct {
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDefaultParametersInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDefaultParametersInput) GoString() string {
 	return s.String()
 }
@@ -2961,12 +3355,20 @@ type DescribeDefaultParametersOutput struct {
 	Parameters []*Parameter `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDefaultParametersOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDefaultParametersOutput) GoString() string {
 	return s.String()
 }
@@ -3018,12 +3420,20 @@ type DescribeEventsInput struct {
 	StartTime *time.Time `type:"timestamp"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeEventsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeEventsInput) GoString() string {
 	return s.String()
 }
@@ -3080,12 +3490,20 @@ type DescribeEventsOutput struct {
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeEventsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeEventsOutput) GoString() string {
 	return s.String()
 }
@@ -3121,12 +3539,20 @@ type DescribeParameterGroupsInput struct {
 	ParameterGroupNames []*string `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeParameterGroupsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeParameterGroupsInput) GoString() string {
 	return s.String()
 }
@@ -3160,12 +3586,20 @@ type DescribeParameterGroupsOutput struct {
 	ParameterGroups []*ParameterGroup `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeParameterGroupsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeParameterGroupsOutput) GoString() string {
 	return s.String()
 }
@@ -3207,12 +3641,20 @@ type DescribeParametersInput struct {
 	Source *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeParametersInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeParametersInput) GoString() string {
 	return s.String()
 }
@@ -3265,12 +3707,20 @@ type DescribeParametersOutput struct {
 	Parameters []*Parameter `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeParametersOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeParametersOutput) GoString() string {
 	return s.String()
 }
@@ -3306,12 +3756,20 @@ type DescribeSubnetGroupsInput struct {
 	SubnetGroupNames []*string `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeSubnetGroupsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeSubnetGroupsInput) GoString() string {
 	return s.String()
 }
@@ -3345,12 +3803,20 @@ type DescribeSubnetGroupsOutput struct {
 	SubnetGroups []*SubnetGroup `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeSubnetGroupsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeSubnetGroupsOutput) GoString() string {
 	return s.String()
 }
@@ -3368,8 +3834,7 @@ func (s *DescribeSubnetGroupsOutput) SetSubnetGroups(v []*SubnetGroup) *Describe
 }
 
 // Represents the information required for client programs to connect to the
-// configuration endpoint for a DAX cluster, or to an individual node within
-// the cluster.
+// endpoint for a DAX cluster.
 type Endpoint struct {
 	_ struct{} `type:"structure"`
 
@@ -3378,14 +3843,26 @@ type Endpoint struct {
 
 	// The port number that applications should use to connect to the endpoint.
 	Port *int64 `type:"integer"`
+
+	// The URL that applications should use to connect to the endpoint. The default
+	// ports are 8111 for the "dax" protocol and 9111 for the "daxs" protocol.
+	URL *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Endpoint) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Endpoint) GoString() string {
 	return s.String()
 }
@@ -3402,6 +3879,12 @@ func (s *Endpoint) SetPort(v int64) *Endpoint {
 	return s
 }
 
+// SetURL sets the URL field's value.
+func (s *Endpoint) SetURL(v string) *Endpoint {
+	s.URL = &v
+	return s
+}
+
 // Represents a single occurrence of something interesting within the system.
 // Some examples of events are creating a DAX cluster, adding or removing a
 // node, or rebooting a node.
@@ -3423,12 +3906,20 @@ type Event struct {
 	SourceType *string `type:"string" enum:"SourceType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Event) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Event) GoString() string {
 	return s.String()
 }
@@ -3476,12 +3967,20 @@ type IncreaseReplicationFactorInput struct {
 	NewReplicationFactor *int64 `type:"integer" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s IncreaseReplicationFactorInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s IncreaseReplicationFactorInput) GoString() string {
 	return s.String()
 }
@@ -3527,12 +4026,20 @@ type IncreaseReplicationFactorOutput struct {
 	Cluster *Cluster `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s IncreaseReplicationFactorOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s IncreaseReplicationFactorOutput) GoString() string {
 	return s.String()
 }
@@ -3543,715 +4050,2258 @@ func (s *IncreaseReplicationFactorOutput) SetCluster(v *Cluster) *IncreaseReplic
 	return s
 }
 
-type ListTagsInput struct {
-	_ struct{} `type:"structure"`
-
-	// An optional token returned from a prior request. Use this token for pagination
-	// of results from this action. If this parameter is specified, the response
-	// includes only results beyond the token.
-	NextToken *string `type:"string"`
+// There are not enough system resources to create the cluster you requested
+// (or to resize an already-existing cluster).
+type InsufficientClusterCapacityFault struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The name of the DAX resource to which the tags belong.
-	//
-	// ResourceName is a required field
-	ResourceName *string `type:"string" required:"true"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s ListTagsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InsufficientClusterCapacityFault) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListTagsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InsufficientClusterCapacityFault) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *ListTagsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListTagsInput"}
-	if s.ResourceName == nil {
-		invalidParams.Add(request.NewErrParamRequired("ResourceName"))
+func newErrorInsufficientClusterCapacityFault(v protocol.ResponseMetadata) error {
+	return &InsufficientClusterCapacityFault{
+		RespMetadata: v,
 	}
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
+// Code returns the exception type name.
+func (s *InsufficientClusterCapacityFault) Code() string {
+	return "InsufficientClusterCapacityFault"
+}
+
+// Message returns the exception's message.
+func (s *InsufficientClusterCapacityFault) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InsufficientClusterCapacityFault) OrigErr() error {
 	return nil
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListTagsInput) SetNextToken(v string) *ListTagsInput {
-	s.NextToken = &v
-	return s
+func (s *InsufficientClusterCapacityFault) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// SetResourceName sets the ResourceName field's value.
-func (s *ListTagsInput) SetResourceName(v string) *ListTagsInput {
-	s.ResourceName = &v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *InsufficientClusterCapacityFault) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-type ListTagsOutput struct {
-	_ struct{} `type:"structure"`
+// RequestID returns the service's response RequestID for request.
+func (s *InsufficientClusterCapacityFault) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// If this value is present, there are additional results to be displayed. To
-	// retrieve them, call ListTags again, with NextToken set to this value.
-	NextToken *string `type:"string"`
+// The Amazon Resource Name (ARN) supplied in the request is not valid.
+type InvalidARNFault struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// A list of tags currently associated with the DAX cluster.
-	Tags []*Tag `type:"list"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s ListTagsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidARNFault) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListTagsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidARNFault) GoString() string {
 	return s.String()
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListTagsOutput) SetNextToken(v string) *ListTagsOutput {
-	s.NextToken = &v
-	return s
+func newErrorInvalidARNFault(v protocol.ResponseMetadata) error {
+	return &InvalidARNFault{
+		RespMetadata: v,
+	}
 }
 
-// SetTags sets the Tags field's value.
-func (s *ListTagsOutput) SetTags(v []*Tag) *ListTagsOutput {
-	s.Tags = v
-	return s
+// Code returns the exception type name.
+func (s *InvalidARNFault) Code() string {
+	return "InvalidARNFault"
 }
 
-// Represents an individual node within a DAX cluster.
-type Node struct {
-	_ struct{} `type:"structure"`
+// Message returns the exception's message.
+func (s *InvalidARNFault) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
 
-	// The Availability Zone (AZ) in which the node has been deployed.
-	AvailabilityZone *string `type:"string"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidARNFault) OrigErr() error {
+	return nil
+}
 
-	// The endpoint for the node, consisting of a DNS name and a port number. Client
-	// applications can connect directly to a node endpoint, if desired (as an alternative
-	// to allowing DAX client software to intelligently route requests and responses
-	// to nodes in the DAX cluster.
-	Endpoint *Endpoint `type:"structure"`
+func (s *InvalidARNFault) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
 
-	// The date and time (in UNIX epoch format) when the node was launched.
-	NodeCreateTime *time.Time `type:"timestamp"`
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidARNFault) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
 
-	// A system-generated identifier for the node.
-	NodeId *string `type:"string"`
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidARNFault) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// The current status of the node. For example: available.
-	NodeStatus *string `type:"string"`
+// The requested DAX cluster is not in the available state.
+type InvalidClusterStateFault struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The status of the parameter group associated with this node. For example,
-	// in-sync.
-	ParameterGroupStatus *string `type:"string"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s Node) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidClusterStateFault) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Node) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidClusterStateFault) GoString() string {
 	return s.String()
 }
 
-// SetAvailabilityZone sets the AvailabilityZone field's value.
-func (s *Node) SetAvailabilityZone(v string) *Node {
-	s.AvailabilityZone = &v
-	return s
+func newErrorInvalidClusterStateFault(v protocol.ResponseMetadata) error {
+	return &InvalidClusterStateFault{
+		RespMetadata: v,
+	}
 }
 
-// SetEndpoint sets the Endpoint field's value.
-func (s *Node) SetEndpoint(v *Endpoint) *Node {
-	s.Endpoint = v
-	return s
+// Code returns the exception type name.
+func (s *InvalidClusterStateFault) Code() string {
+	return "InvalidClusterStateFault"
 }
 
-// SetNodeCreateTime sets the NodeCreateTime field's value.
-func (s *Node) SetNodeCreateTime(v time.Time) *Node {
-	s.NodeCreateTime = &v
-	return s
+// Message returns the exception's message.
+func (s *InvalidClusterStateFault) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// SetNodeId sets the NodeId field's value.
-func (s *Node) SetNodeId(v string) *Node {
-	s.NodeId = &v
-	return s
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidClusterStateFault) OrigErr() error {
+	return nil
 }
 
-// SetNodeStatus sets the NodeStatus field's value.
-func (s *Node) SetNodeStatus(v string) *Node {
-	s.NodeStatus = &v
-	return s
+func (s *InvalidClusterStateFault) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// SetParameterGroupStatus sets the ParameterGroupStatus field's value.
-func (s *Node) SetParameterGroupStatus(v string) *Node {
-	s.ParameterGroupStatus = &v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidClusterStateFault) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// Represents a parameter value that is applicable to a particular node type.
-type NodeTypeSpecificValue struct {
-	_ struct{} `type:"structure"`
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidClusterStateFault) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// A node type to which the parameter value applies.
-	NodeType *string `type:"string"`
+// Two or more incompatible parameters were specified.
+type InvalidParameterCombinationException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The parameter value for this node type.
-	Value *string `type:"string"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s NodeTypeSpecificValue) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidParameterCombinationException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s NodeTypeSpecificValue) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidParameterCombinationException) GoString() string {
 	return s.String()
 }
 
-// SetNodeType sets the NodeType field's value.
-func (s *NodeTypeSpecificValue) SetNodeType(v string) *NodeTypeSpecificValue {
-	s.NodeType = &v
-	return s
+func newErrorInvalidParameterCombinationException(v protocol.ResponseMetadata) error {
+	return &InvalidParameterCombinationException{
+		RespMetadata: v,
+	}
 }
 
-// SetValue sets the Value field's value.
-func (s *NodeTypeSpecificValue) SetValue(v string) *NodeTypeSpecificValue {
-	s.Value = &v
-	return s
+// Code returns the exception type name.
+func (s *InvalidParameterCombinationException) Code() string {
+	return "InvalidParameterCombinationException"
 }
 
-// Describes a notification topic and its status. Notification topics are used
-// for publishing DAX events to subscribers using Amazon Simple Notification
-// Service (SNS).
-type NotificationConfiguration struct {
-	_ struct{} `type:"structure"`
-
-	// The Amazon Resource Name (ARN) that identifies the topic.
-	TopicArn *string `type:"string"`
+// Message returns the exception's message.
+func (s *InvalidParameterCombinationException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
 
-	// The current state of the topic.
-	TopicStatus *string `type:"string"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidParameterCombinationException) OrigErr() error {
+	return nil
 }
 
-// String returns the string representation
-func (s NotificationConfiguration) String() string {
-	return awsutil.Prettify(s)
+func (s *InvalidParameterCombinationException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// GoString returns the string representation
-func (s NotificationConfiguration) GoString() string {
-	return s.String()
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidParameterCombinationException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// SetTopicArn sets the TopicArn field's value.
-func (s *NotificationConfiguration) SetTopicArn(v string) *NotificationConfiguration {
-	s.TopicArn = &v
-	return s
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidParameterCombinationException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// SetTopicStatus sets the TopicStatus field's value.
-func (s *NotificationConfiguration) SetTopicStatus(v string) *NotificationConfiguration {
-	s.TopicStatus = &v
-	return s
+// One or more parameters in a parameter group are in an invalid state.
+type InvalidParameterGroupStateFault struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// Describes an individual setting that controls some aspect of DAX behavior.
-type Parameter struct {
-	_ struct{} `type:"structure"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidParameterGroupStateFault) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// A range of values within which the parameter can be set.
-	AllowedValues *string `type:"string"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidParameterGroupStateFault) GoString() string {
+	return s.String()
+}
 
-	// The conditions under which changes to this parameter can be applied. For
-	// example, requires-reboot indicates that a new value for this parameter will
-	// only take effect if a node is rebooted.
-	ChangeType *string `type:"string" enum:"ChangeType"`
+func newErrorInvalidParameterGroupStateFault(v protocol.ResponseMetadata) error {
+	return &InvalidParameterGroupStateFault{
+		RespMetadata: v,
+	}
+}
 
-	// The data type of the parameter. For example, integer:
-	DataType *string `type:"string"`
+// Code returns the exception type name.
+func (s *InvalidParameterGroupStateFault) Code() string {
+	return "InvalidParameterGroupStateFault"
+}
 
-	// A description of the parameter
-	Description *string `type:"string"`
+// Message returns the exception's message.
+func (s *InvalidParameterGroupStateFault) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
 
-	// Whether the customer is allowed to modify the parameter.
-	IsModifiable *string `type:"string" enum:"IsModifiable"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidParameterGroupStateFault) OrigErr() error {
+	return nil
+}
 
-	// A list of node types, and specific parameter values for each node.
-	NodeTypeSpecificValues []*NodeTypeSpecificValue `type:"list"`
+func (s *InvalidParameterGroupStateFault) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
 
-	// The name of the parameter.
-	ParameterName *string `type:"string"`
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidParameterGroupStateFault) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
 
-	// Determines whether the parameter can be applied to any nodes, or only nodes
-	// of a particular type.
-	ParameterType *string `type:"string" enum:"ParameterType"`
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidParameterGroupStateFault) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// The value for the parameter.
-	ParameterValue *string `type:"string"`
+// The value for a parameter is invalid.
+type InvalidParameterValueException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// How the parameter is defined. For example, system denotes a system-defined
-	// parameter.
-	Source *string `type:"string"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s Parameter) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidParameterValueException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Parameter) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidParameterValueException) GoString() string {
 	return s.String()
 }
 
-// SetAllowedValues sets the AllowedValues field's value.
-func (s *Parameter) SetAllowedValues(v string) *Parameter {
-	s.AllowedValues = &v
-	return s
+func newErrorInvalidParameterValueException(v protocol.ResponseMetadata) error {
+	return &InvalidParameterValueException{
+		RespMetadata: v,
+	}
 }
 
-// SetChangeType sets the ChangeType field's value.
-func (s *Parameter) SetChangeType(v string) *Parameter {
-	s.ChangeType = &v
-	return s
+// Code returns the exception type name.
+func (s *InvalidParameterValueException) Code() string {
+	return "InvalidParameterValueException"
 }
 
-// SetDataType sets the DataType field's value.
-func (s *Parameter) SetDataType(v string) *Parameter {
-	s.DataType = &v
-	return s
+// Message returns the exception's message.
+func (s *InvalidParameterValueException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// SetDescription sets the Description field's value.
-func (s *Parameter) SetDescription(v string) *Parameter {
-	s.Description = &v
-	return s
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidParameterValueException) OrigErr() error {
+	return nil
 }
 
-// SetIsModifiable sets the IsModifiable field's value.
-func (s *Parameter) SetIsModifiable(v string) *Parameter {
-	s.IsModifiable = &v
-	return s
+func (s *InvalidParameterValueException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// SetNodeTypeSpecificValues sets the NodeTypeSpecificValues field's value.
-func (s *Parameter) SetNodeTypeSpecificValues(v []*NodeTypeSpecificValue) *Parameter {
-	s.NodeTypeSpecificValues = v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidParameterValueException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// SetParameterName sets the ParameterName field's value.
-func (s *Parameter) SetParameterName(v string) *Parameter {
-	s.ParameterName = &v
-	return s
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidParameterValueException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// SetParameterType sets the ParameterType field's value.
-func (s *Parameter) SetParameterType(v string) *Parameter {
-	s.ParameterType = &v
-	return s
-}
+// An invalid subnet identifier was specified.
+type InvalidSubnet struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-// SetParameterValue sets the ParameterValue field's value.
-func (s *Parameter) SetParameterValue(v string) *Parameter {
-	s.ParameterValue = &v
-	return s
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// SetSource sets the Source field's value.
-func (s *Parameter) SetSource(v string) *Parameter {
-	s.Source = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidSubnet) String() string {
+	return awsutil.Prettify(s)
 }
 
-// A named set of parameters that are applied to all of the nodes in a DAX cluster.
-type ParameterGroup struct {
-	_ struct{} `type:"structure"`
-
-	// A description of the parameter group.
-	Description *string `type:"string"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidSubnet) GoString() string {
+	return s.String()
+}
 
-	// The name of the parameter group.
-	ParameterGroupName *string `type:"string"`
+func newErrorInvalidSubnet(v protocol.ResponseMetadata) error {
+	return &InvalidSubnet{
+		RespMetadata: v,
+	}
 }
 
-// String returns the string representation
-func (s ParameterGroup) String() string {
-	return awsutil.Prettify(s)
+// Code returns the exception type name.
+func (s *InvalidSubnet) Code() string {
+	return "InvalidSubnet"
 }
 
-// GoString returns the string representation
-func (s ParameterGroup) GoString() string {
-	return s.String()
+// Message returns the exception's message.
+func (s *InvalidSubnet) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// SetDescription sets the Description field's value.
-func (s *ParameterGroup) SetDescription(v string) *ParameterGroup {
-	s.Description = &v
-	return s
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidSubnet) OrigErr() error {
+	return nil
 }
 
-// SetParameterGroupName sets the ParameterGroupName field's value.
-func (s *ParameterGroup) SetParameterGroupName(v string) *ParameterGroup {
-	s.ParameterGroupName = &v
-	return s
+func (s *InvalidSubnet) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// The status of a parameter group.
-type ParameterGroupStatus struct {
-	_ struct{} `type:"structure"`
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidSubnet) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
 
-	// The node IDs of one or more nodes to be rebooted.
-	NodeIdsToReboot []*string `type:"list"`
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidSubnet) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// The status of parameter updates.
-	ParameterApplyStatus *string `type:"string"`
+// The VPC network is in an invalid state.
+type InvalidVPCNetworkStateFault struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The name of the parameter group.
-	ParameterGroupName *string `type:"string"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s ParameterGroupStatus) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidVPCNetworkStateFault) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ParameterGroupStatus) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidVPCNetworkStateFault) GoString() string {
 	return s.String()
 }
 
-// SetNodeIdsToReboot sets the NodeIdsToReboot field's value.
-func (s *ParameterGroupStatus) SetNodeIdsToReboot(v []*string) *ParameterGroupStatus {
-	s.NodeIdsToReboot = v
-	return s
+func newErrorInvalidVPCNetworkStateFault(v protocol.ResponseMetadata) error {
+	return &InvalidVPCNetworkStateFault{
+		RespMetadata: v,
+	}
 }
 
-// SetParameterApplyStatus sets the ParameterApplyStatus field's value.
-func (s *ParameterGroupStatus) SetParameterApplyStatus(v string) *ParameterGroupStatus {
-	s.ParameterApplyStatus = &v
-	return s
+// Code returns the exception type name.
+func (s *InvalidVPCNetworkStateFault) Code() string {
+	return "InvalidVPCNetworkStateFault"
 }
 
-// SetParameterGroupName sets the ParameterGroupName field's value.
-func (s *ParameterGroupStatus) SetParameterGroupName(v string) *ParameterGroupStatus {
+// Message returns the exception's message.
+func (s *InvalidVPCNetworkStateFault) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidVPCNetworkStateFault) OrigErr() error {
+	return nil
+}
+
+func (s *InvalidVPCNetworkStateFault) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidVPCNetworkStateFault) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidVPCNetworkStateFault) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type ListTagsInput struct {
+	_ struct{} `type:"structure"`
+
+	// An optional token returned from a prior request. Use this token for pagination
+	// of results from this action. If this parameter is specified, the response
+	// includes only results beyond the token.
+	NextToken *string `type:"string"`
+
+	// The name of the DAX resource to which the tags belong.
+	//
+	// ResourceName is a required field
+	ResourceName *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListTagsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsInput"}
+	if s.ResourceName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceName"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListTagsInput) SetNextToken(v string) *ListTagsInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetResourceName sets the ResourceName field's value.
+func (s *ListTagsInput) SetResourceName(v string) *ListTagsInput {
+	s.ResourceName = &v
+	return s
+}
+
+type ListTagsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// If this value is present, there are additional results to be displayed. To
+	// retrieve them, call ListTags again, with NextToken set to this value.
+	NextToken *string `type:"string"`
+
+	// A list of tags currently associated with the DAX cluster.
+	Tags []*Tag `type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsOutput) GoString() string {
+	return s.String()
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListTagsOutput) SetNextToken(v string) *ListTagsOutput {
+	s.NextToken = &v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *ListTagsOutput) SetTags(v []*Tag) *ListTagsOutput {
+	s.Tags = v
+	return s
+}
+
+// Represents an individual node within a DAX cluster.
+type Node struct {
+	_ struct{} `type:"structure"`
+
+	// The Availability Zone (AZ) in which the node has been deployed.
+	AvailabilityZone *string `type:"string"`
+
+	// The endpoint for the node, consisting of a DNS name and a port number. Client
+	// applications can connect directly to a node endpoint, if desired (as an alternative
+	// to allowing DAX client software to intelligently route requests and responses
+	// to nodes in the DAX cluster.
+	Endpoint *Endpoint `type:"structure"`
+
+	// The date and time (in UNIX epoch format) when the node was launched.
+	NodeCreateTime *time.Time `type:"timestamp"`
+
+	// A system-generated identifier for the node.
+	NodeId *string `type:"string"`
+
+	// The current status of the node. For example: available.
+	NodeStatus *string `type:"string"`
+
+	// The status of the parameter group associated with this node. For example,
+	// in-sync.
+	ParameterGroupStatus *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Node) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Node) GoString() string {
+	return s.String()
+}
+
+// SetAvailabilityZone sets the AvailabilityZone field's value.
+func (s *Node) SetAvailabilityZone(v string) *Node {
+	s.AvailabilityZone = &v
+	return s
+}
+
+// SetEndpoint sets the Endpoint field's value.
+func (s *Node) SetEndpoint(v *Endpoint) *Node {
+	s.Endpoint = v
+	return s
+}
+
+// SetNodeCreateTime sets the NodeCreateTime field's value.
+func (s *Node) SetNodeCreateTime(v time.Time) *Node {
+	s.NodeCreateTime = &v
+	return s
+}
+
+// SetNodeId sets the NodeId field's value.
+func (s *Node) SetNodeId(v string) *Node {
+	s.NodeId = &v
+	return s
+}
+
+// SetNodeStatus sets the NodeStatus field's value.
+func (s *Node) SetNodeStatus(v string) *Node {
+	s.NodeStatus = &v
+	return s
+}
+
+// SetParameterGroupStatus sets the ParameterGroupStatus field's value.
+func (s *Node) SetParameterGroupStatus(v string) *Node {
+	s.ParameterGroupStatus = &v
+	return s
+}
+
+// None of the nodes in the cluster have the given node ID.
+type NodeNotFoundFault struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NodeNotFoundFault) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NodeNotFoundFault) GoString() string {
+	return s.String()
+}
+
+func newErrorNodeNotFoundFault(v protocol.ResponseMetadata) error {
+	return &NodeNotFoundFault{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *NodeNotFoundFault) Code() string {
+	return "NodeNotFoundFault"
+}
+
+// Message returns the exception's message.
+func (s *NodeNotFoundFault) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *NodeNotFoundFault) OrigErr() error {
+	return nil
+}
+
+func (s *NodeNotFoundFault) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *NodeNotFoundFault) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *NodeNotFoundFault) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// You have attempted to exceed the maximum number of nodes for a DAX cluster.
+type NodeQuotaForClusterExceededFault struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NodeQuotaForClusterExceededFault) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NodeQuotaForClusterExceededFault) GoString() string {
+	return s.String()
+}
+
+func newErrorNodeQuotaForClusterExceededFault(v protocol.ResponseMetadata) error {
+	return &NodeQuotaForClusterExceededFault{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *NodeQuotaForClusterExceededFault) Code() string {
+	return "NodeQuotaForClusterExceededFault"
+}
+
+// Message returns the exception's message.
+func (s *NodeQuotaForClusterExceededFault) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *NodeQuotaForClusterExceededFault) OrigErr() error {
+	return nil
+}
+
+func (s *NodeQuotaForClusterExceededFault) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *NodeQuotaForClusterExceededFault) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *NodeQuotaForClusterExceededFault) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// You have attempted to exceed the maximum number of nodes for your AWS account.
+type NodeQuotaForCustomerExceededFault struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NodeQuotaForCustomerExceededFault) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NodeQuotaForCustomerExceededFault) GoString() string {
+	return s.String()
+}
+
+func newErrorNodeQuotaForCustomerExceededFault(v protocol.ResponseMetadata) error {
+	return &NodeQuotaForCustomerExceededFault{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *NodeQuotaForCustomerExceededFault) Code() string {
+	return "NodeQuotaForCustomerExceededFault"
+}
+
+// Message returns the exception's message.
+func (s *NodeQuotaForCustomerExceededFault) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *NodeQuotaForCustomerExceededFault) OrigErr() error {
+	return nil
+}
+
+func (s *NodeQuotaForCustomerExceededFault) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *NodeQuotaForCustomerExceededFault) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *NodeQuotaForCustomerExceededFault) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Represents a parameter value that is applicable to a particular node type.
+type NodeTypeSpecificValue struct {
+	_ struct{} `type:"structure"`
+
+	// A node type to which the parameter value applies.
+	NodeType *string `type:"string"`
+
+	// The parameter value for this node type.
+	Value *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NodeTypeSpecificValue) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NodeTypeSpecificValue) GoString() string {
+	return s.String()
+}
+
+// SetNodeType sets the NodeType field's value.
+func (s *NodeTypeSpecificValue) SetNodeType(v string) *NodeTypeSpecificValue {
+	s.NodeType = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *NodeTypeSpecificValue) SetValue(v string) *NodeTypeSpecificValue {
+	s.Value = &v
+	return s
+}
+
+// Describes a notification topic and its status. Notification topics are used
+// for publishing DAX events to subscribers using Amazon Simple Notification
+// Service (SNS).
+type NotificationConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) that identifies the topic.
+	TopicArn *string `type:"string"`
+
+	// The current state of the topic. A value of “active” means that notifications
+	// will be sent to the topic. A value of “inactive” means that notifications
+	// will not be sent to the topic.
+	TopicStatus *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotificationConfiguration) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotificationConfiguration) GoString() string {
+	return s.String()
+}
+
+// SetTopicArn sets the TopicArn field's value.
+func (s *NotificationConfiguration) SetTopicArn(v string) *NotificationConfiguration {
+	s.TopicArn = &v
+	return s
+}
+
+// SetTopicStatus sets the TopicStatus field's value.
+func (s *NotificationConfiguration) SetTopicStatus(v string) *NotificationConfiguration {
+	s.TopicStatus = &v
+	return s
+}
+
+// Describes an individual setting that controls some aspect of DAX behavior.
+type Parameter struct {
+	_ struct{} `type:"structure"`
+
+	// A range of values within which the parameter can be set.
+	AllowedValues *string `type:"string"`
+
+	// The conditions under which changes to this parameter can be applied. For
+	// example, requires-reboot indicates that a new value for this parameter will
+	// only take effect if a node is rebooted.
+	ChangeType *string `type:"string" enum:"ChangeType"`
+
+	// The data type of the parameter. For example, integer:
+	DataType *string `type:"string"`
+
+	// A description of the parameter
+	Description *string `type:"string"`
+
+	// Whether the customer is allowed to modify the parameter.
+	IsModifiable *string `type:"string" enum:"IsModifiable"`
+
+	// A list of node types, and specific parameter values for each node.
+	NodeTypeSpecificValues []*NodeTypeSpecificValue `type:"list"`
+
+	// The name of the parameter.
+	ParameterName *string `type:"string"`
+
+	// Determines whether the parameter can be applied to any nodes, or only nodes
+	// of a particular type.
+	ParameterType *string `type:"string" enum:"ParameterType"`
+
+	// The value for the parameter.
+	ParameterValue *string `type:"string"`
+
+	// How the parameter is defined. For example, system denotes a system-defined
+	// parameter.
+	Source *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Parameter) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Parameter) GoString() string {
+	return s.String()
+}
+
+// SetAllowedValues sets the AllowedValues field's value.
+func (s *Parameter) SetAllowedValues(v string) *Parameter {
+	s.AllowedValues = &v
+	return s
+}
+
+// SetChangeType sets the ChangeType field's value.
+func (s *Parameter) SetChangeType(v string) *Parameter {
+	s.ChangeType = &v
+	return s
+}
+
+// SetDataType sets the DataType field's value.
+func (s *Parameter) SetDataType(v string) *Parameter {
+	s.DataType = &v
+	return s
+}
+
+// SetDescription sets the Description field's value.
+func (s *Parameter) SetDescription(v string) *Parameter {
+	s.Description = &v
+	return s
+}
+
+// SetIsModifiable sets the IsModifiable field's value.
+func (s *Parameter) SetIsModifiable(v string) *Parameter {
+	s.IsModifiable = &v
+	return s
+}
+
+// SetNodeTypeSpecificValues sets the NodeTypeSpecificValues field's value.
+func (s *Parameter) SetNodeTypeSpecificValues(v []*NodeTypeSpecificValue) *Parameter {
+	s.NodeTypeSpecificValues = v
+	return s
+}
+
+// SetParameterName sets the ParameterName field's value.
+func (s *Parameter) SetParameterName(v string) *Parameter {
+	s.ParameterName = &v
+	return s
+}
+
+// SetParameterType sets the ParameterType field's value.
+func (s *Parameter) SetParameterType(v string) *Parameter {
+	s.ParameterType = &v
+	return s
+}
+
+// SetParameterValue sets the ParameterValue field's value.
+func (s *Parameter) SetParameterValue(v string) *Parameter {
+	s.ParameterValue = &v
+	return s
+}
+
+// SetSource sets the Source field's value.
+func (s *Parameter) SetSource(v string) *Parameter {
+	s.Source = &v
+	return s
+}
+
+// A named set of parameters that are applied to all of the nodes in a DAX cluster.
+type ParameterGroup struct {
+	_ struct{} `type:"structure"`
+
+	// A description of the parameter group.
+	Description *string `type:"string"`
+
+	// The name of the parameter group.
+	ParameterGroupName *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ParameterGroup) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ParameterGroup) GoString() string {
+	return s.String()
+}
+
+// SetDescription sets the Description field's value.
+func (s *ParameterGroup) SetDescription(v string) *ParameterGroup {
+	s.Description = &v
+	return s
+}
+
+// SetParameterGroupName sets the ParameterGroupName field's value.
+func (s *ParameterGroup) SetParameterGroupName(v string) *ParameterGroup {
+	s.ParameterGroupName = &v
+	return s
+}
+
+// The specified parameter group already exists.
+type ParameterGroupAlreadyExistsFault struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ParameterGroupAlreadyExistsFault) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ParameterGroupAlreadyExistsFault) GoString() string {
+	return s.String()
+}
+
+func newErrorParameterGroupAlreadyExistsFault(v protocol.ResponseMetadata) error {
+	return &ParameterGroupAlreadyExistsFault{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ParameterGroupAlreadyExistsFault) Code() string {
+	return "ParameterGroupAlreadyExistsFault"
+}
+
+// Message returns the exception's message.
+func (s *ParameterGroupAlreadyExistsFault) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ParameterGroupAlreadyExistsFault) OrigErr() error {
+	return nil
+}
+
+func (s *ParameterGroupAlreadyExistsFault) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ParameterGroupAlreadyExistsFault) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ParameterGroupAlreadyExistsFault) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The specified parameter group does not exist.
+type ParameterGroupNotFoundFault struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ParameterGroupNotFoundFault) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ParameterGroupNotFoundFault) GoString() string {
+	return s.String()
+}
+
+func newErrorParameterGroupNotFoundFault(v protocol.ResponseMetadata) error {
+	return &ParameterGroupNotFoundFault{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ParameterGroupNotFoundFault) Code() string {
+	return "ParameterGroupNotFoundFault"
+}
+
+// Message returns the exception's message.
+func (s *ParameterGroupNotFoundFault) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ParameterGroupNotFoundFault) OrigErr() error {
+	return nil
+}
+
+func (s *ParameterGroupNotFoundFault) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ParameterGroupNotFoundFault) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ParameterGroupNotFoundFault) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// You have attempted to exceed the maximum number of parameter groups.
+type ParameterGroupQuotaExceededFault struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ParameterGroupQuotaExceededFault) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ParameterGroupQuotaExceededFault) GoString() string {
+	return s.String()
+}
+
+func newErrorParameterGroupQuotaExceededFault(v protocol.ResponseMetadata) error {
+	return &ParameterGroupQuotaExceededFault{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ParameterGroupQuotaExceededFault) Code() string {
+	return "ParameterGroupQuotaExceededFault"
+}
+
+// Message returns the exception's message.
+func (s *ParameterGroupQuotaExceededFault) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ParameterGroupQuotaExceededFault) OrigErr() error {
+	return nil
+}
+
+func (s *ParameterGroupQuotaExceededFault) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ParameterGroupQuotaExceededFault) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ParameterGroupQuotaExceededFault) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The status of a parameter group.
+type ParameterGroupStatus struct {
+	_ struct{} `type:"structure"`
+
+	// The node IDs of one or more nodes to be rebooted.
+	NodeIdsToReboot []*string `type:"list"`
+
+	// The status of parameter updates.
+	ParameterApplyStatus *string `type:"string"`
+
+	// The name of the parameter group.
+	ParameterGroupName *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ParameterGroupStatus) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ParameterGroupStatus) GoString() string {
+	return s.String()
+}
+
+// SetNodeIdsToReboot sets the NodeIdsToReboot field's value.
+func (s *ParameterGroupStatus) SetNodeIdsToReboot(v []*string) *ParameterGroupStatus {
+	s.NodeIdsToReboot = v
+	return s
+}
+
+// SetParameterApplyStatus sets the ParameterApplyStatus field's value.
+func (s *ParameterGroupStatus) SetParameterApplyStatus(v string) *ParameterGroupStatus {
+	s.ParameterApplyStatus = &v
+	return s
+}
+
+// SetParameterGroupName sets the ParameterGroupName field's value.
+func (s *ParameterGroupStatus) SetParameterGroupName(v string) *ParameterGroupStatus {
 	s.ParameterGroupName = &v
 	return s
 }
 
-// An individual DAX parameter.
-type ParameterNameValue struct {
+// An individual DAX parameter.
+type ParameterNameValue struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the parameter.
+	ParameterName *string `type:"string"`
+
+	// The value of the parameter.
+	ParameterValue *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ParameterNameValue) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ParameterNameValue) GoString() string {
+	return s.String()
+}
+
+// SetParameterName sets the ParameterName field's value.
+func (s *ParameterNameValue) SetParameterName(v string) *ParameterNameValue {
+	s.ParameterName = &v
+	return s
+}
+
+// SetParameterValue sets the ParameterValue field's value.
+func (s *ParameterNameValue) SetParameterValue(v string) *ParameterNameValue {
+	s.ParameterValue = &v
+	return s
+}
+
+type RebootNodeInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the DAX cluster containing the node to be rebooted.
+	//
+	// ClusterName is a required field
+	ClusterName *string `type:"string" required:"true"`
+
+	// The system-assigned ID of the node to be rebooted.
+	//
+	// NodeId is a required field
+	NodeId *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RebootNodeInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RebootNodeInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *RebootNodeInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "RebootNodeInput"}
+	if s.ClusterName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ClusterName"))
+	}
+	if s.NodeId == nil {
+		invalidParams.Add(request.NewErrParamRequired("NodeId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetClusterName sets the ClusterName field's value.
+func (s *RebootNodeInput) SetClusterName(v string) *RebootNodeInput {
+	s.ClusterName = &v
+	return s
+}
+
+// SetNodeId sets the NodeId field's value.
+func (s *RebootNodeInput) SetNodeId(v string) *RebootNodeInput {
+	s.NodeId = &v
+	return s
+}
+
+type RebootNodeOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A description of the DAX cluster after a node has been rebooted.
+	Cluster *Cluster `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RebootNodeOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RebootNodeOutput) GoString() string {
+	return s.String()
+}
+
+// SetCluster sets the Cluster field's value.
+func (s *RebootNodeOutput) SetCluster(v *Cluster) *RebootNodeOutput {
+	s.Cluster = v
+	return s
+}
+
+// The description of the server-side encryption status on the specified DAX
+// cluster.
+type SSEDescription struct {
+	_ struct{} `type:"structure"`
+
+	// The current state of server-side encryption:
+	//
+	//    * ENABLING - Server-side encryption is being enabled.
+	//
+	//    * ENABLED - Server-side encryption is enabled.
+	//
+	//    * DISABLING - Server-side encryption is being disabled.
+	//
+	//    * DISABLED - Server-side encryption is disabled.
+	Status *string `type:"string" enum:"SSEStatus"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SSEDescription) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SSEDescription) GoString() string {
+	return s.String()
+}
+
+// SetStatus sets the Status field's value.
+func (s *SSEDescription) SetStatus(v string) *SSEDescription {
+	s.Status = &v
+	return s
+}
+
+// Represents the settings used to enable server-side encryption.
+type SSESpecification struct {
+	_ struct{} `type:"structure"`
+
+	// Indicates whether server-side encryption is enabled (true) or disabled (false)
+	// on the cluster.
+	//
+	// Enabled is a required field
+	Enabled *bool `type:"boolean" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SSESpecification) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SSESpecification) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *SSESpecification) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "SSESpecification"}
+	if s.Enabled == nil {
+		invalidParams.Add(request.NewErrParamRequired("Enabled"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetEnabled sets the Enabled field's value.
+func (s *SSESpecification) SetEnabled(v bool) *SSESpecification {
+	s.Enabled = &v
+	return s
+}
+
+// An individual VPC security group and its status.
+type SecurityGroupMembership struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the parameter.
-	ParameterName *string `type:"string"`
+	// The unique ID for this security group.
+	SecurityGroupIdentifier *string `type:"string"`
 
-	// The value of the parameter.
-	ParameterValue *string `type:"string"`
+	// The status of this security group.
+	Status *string `type:"string"`
 }
 
-// String returns the string representation
-func (s ParameterNameValue) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SecurityGroupMembership) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ParameterNameValue) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SecurityGroupMembership) GoString() string {
 	return s.String()
 }
 
-// SetParameterName sets the ParameterName field's value.
-func (s *ParameterNameValue) SetParameterName(v string) *ParameterNameValue {
-	s.ParameterName = &v
+// SetSecurityGroupIdentifier sets the SecurityGroupIdentifier field's value.
+func (s *SecurityGroupMembership) SetSecurityGroupIdentifier(v string) *SecurityGroupMembership {
+	s.SecurityGroupIdentifier = &v
 	return s
 }
 
-// SetParameterValue sets the ParameterValue field's value.
-func (s *ParameterNameValue) SetParameterValue(v string) *ParameterNameValue {
-	s.ParameterValue = &v
+// SetStatus sets the Status field's value.
+func (s *SecurityGroupMembership) SetStatus(v string) *SecurityGroupMembership {
+	s.Status = &v
 	return s
 }
 
-type RebootNodeInput struct {
-	_ struct{} `type:"structure"`
-
-	// The name of the DAX cluster containing the node to be rebooted.
-	//
-	// ClusterName is a required field
-	ClusterName *string `type:"string" required:"true"`
+// The specified service linked role (SLR) was not found.
+type ServiceLinkedRoleNotFoundFault struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The system-assigned ID of the node to be rebooted.
-	//
-	// NodeId is a required field
-	NodeId *string `type:"string" required:"true"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s RebootNodeInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceLinkedRoleNotFoundFault) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s RebootNodeInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceLinkedRoleNotFoundFault) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *RebootNodeInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "RebootNodeInput"}
-	if s.ClusterName == nil {
-		invalidParams.Add(request.NewErrParamRequired("ClusterName"))
+func newErrorServiceLinkedRoleNotFoundFault(v protocol.ResponseMetadata) error {
+	return &ServiceLinkedRoleNotFoundFault{
+		RespMetadata: v,
 	}
-	if s.NodeId == nil {
-		invalidParams.Add(request.NewErrParamRequired("NodeId"))
+}
+
+// Code returns the exception type name.
+func (s *ServiceLinkedRoleNotFoundFault) Code() string {
+	return "ServiceLinkedRoleNotFoundFault"
+}
+
+// Message returns the exception's message.
+func (s *ServiceLinkedRoleNotFoundFault) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
+	return ""
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ServiceLinkedRoleNotFoundFault) OrigErr() error {
+	return nil
+}
+
+func (s *ServiceLinkedRoleNotFoundFault) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ServiceLinkedRoleNotFoundFault) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ServiceLinkedRoleNotFoundFault) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// You have reached the maximum number of x509 certificates that can be created
+// for encrypted clusters in a 30 day period. Contact AWS customer support to
+// discuss options for continuing to create encrypted clusters.
+type ServiceQuotaExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceQuotaExceededException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceQuotaExceededException) GoString() string {
+	return s.String()
+}
+
+func newErrorServiceQuotaExceededException(v protocol.ResponseMetadata) error {
+	return &ServiceQuotaExceededException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ServiceQuotaExceededException) Code() string {
+	return "ServiceQuotaExceededException"
+}
+
+// Message returns the exception's message.
+func (s *ServiceQuotaExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ServiceQuotaExceededException) OrigErr() error {
 	return nil
 }
 
-// SetClusterName sets the ClusterName field's value.
-func (s *RebootNodeInput) SetClusterName(v string) *RebootNodeInput {
-	s.ClusterName = &v
+func (s *ServiceQuotaExceededException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ServiceQuotaExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ServiceQuotaExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Represents the subnet associated with a DAX cluster. This parameter refers
+// to subnets defined in Amazon Virtual Private Cloud (Amazon VPC) and used
+// with DAX.
+type Subnet struct {
+	_ struct{} `type:"structure"`
+
+	// The Availability Zone (AZ) for the subnet.
+	SubnetAvailabilityZone *string `type:"string"`
+
+	// The system-assigned identifier for the subnet.
+	SubnetIdentifier *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Subnet) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Subnet) GoString() string {
+	return s.String()
+}
+
+// SetSubnetAvailabilityZone sets the SubnetAvailabilityZone field's value.
+func (s *Subnet) SetSubnetAvailabilityZone(v string) *Subnet {
+	s.SubnetAvailabilityZone = &v
 	return s
 }
 
-// SetNodeId sets the NodeId field's value.
-func (s *RebootNodeInput) SetNodeId(v string) *RebootNodeInput {
-	s.NodeId = &v
+// SetSubnetIdentifier sets the SubnetIdentifier field's value.
+func (s *Subnet) SetSubnetIdentifier(v string) *Subnet {
+	s.SubnetIdentifier = &v
 	return s
 }
 
-type RebootNodeOutput struct {
+// Represents the output of one of the following actions:
+//
+//   - CreateSubnetGroup
+//
+//   - ModifySubnetGroup
+type SubnetGroup struct {
 	_ struct{} `type:"structure"`
 
-	// A description of the DAX cluster after a node has been rebooted.
-	Cluster *Cluster `type:"structure"`
+	// The description of the subnet group.
+	Description *string `type:"string"`
+
+	// The name of the subnet group.
+	SubnetGroupName *string `type:"string"`
+
+	// A list of subnets associated with the subnet group.
+	Subnets []*Subnet `type:"list"`
+
+	// The Amazon Virtual Private Cloud identifier (VPC ID) of the subnet group.
+	VpcId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SubnetGroup) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SubnetGroup) GoString() string {
+	return s.String()
+}
+
+// SetDescription sets the Description field's value.
+func (s *SubnetGroup) SetDescription(v string) *SubnetGroup {
+	s.Description = &v
+	return s
+}
+
+// SetSubnetGroupName sets the SubnetGroupName field's value.
+func (s *SubnetGroup) SetSubnetGroupName(v string) *SubnetGroup {
+	s.SubnetGroupName = &v
+	return s
+}
+
+// SetSubnets sets the Subnets field's value.
+func (s *SubnetGroup) SetSubnets(v []*Subnet) *SubnetGroup {
+	s.Subnets = v
+	return s
+}
+
+// SetVpcId sets the VpcId field's value.
+func (s *SubnetGroup) SetVpcId(v string) *SubnetGroup {
+	s.VpcId = &v
+	return s
+}
+
+// The specified subnet group already exists.
+type SubnetGroupAlreadyExistsFault struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SubnetGroupAlreadyExistsFault) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SubnetGroupAlreadyExistsFault) GoString() string {
+	return s.String()
+}
+
+func newErrorSubnetGroupAlreadyExistsFault(v protocol.ResponseMetadata) error {
+	return &SubnetGroupAlreadyExistsFault{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *SubnetGroupAlreadyExistsFault) Code() string {
+	return "SubnetGroupAlreadyExistsFault"
+}
+
+// Message returns the exception's message.
+func (s *SubnetGroupAlreadyExistsFault) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *SubnetGroupAlreadyExistsFault) OrigErr() error {
+	return nil
+}
+
+func (s *SubnetGroupAlreadyExistsFault) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *SubnetGroupAlreadyExistsFault) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *SubnetGroupAlreadyExistsFault) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The specified subnet group is currently in use.
+type SubnetGroupInUseFault struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SubnetGroupInUseFault) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SubnetGroupInUseFault) GoString() string {
+	return s.String()
+}
+
+func newErrorSubnetGroupInUseFault(v protocol.ResponseMetadata) error {
+	return &SubnetGroupInUseFault{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *SubnetGroupInUseFault) Code() string {
+	return "SubnetGroupInUseFault"
+}
+
+// Message returns the exception's message.
+func (s *SubnetGroupInUseFault) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *SubnetGroupInUseFault) OrigErr() error {
+	return nil
+}
+
+func (s *SubnetGroupInUseFault) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *SubnetGroupInUseFault) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *SubnetGroupInUseFault) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The requested subnet group name does not refer to an existing subnet group.
+type SubnetGroupNotFoundFault struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s RebootNodeOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SubnetGroupNotFoundFault) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s RebootNodeOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SubnetGroupNotFoundFault) GoString() string {
 	return s.String()
 }
 
-// SetCluster sets the Cluster field's value.
-func (s *RebootNodeOutput) SetCluster(v *Cluster) *RebootNodeOutput {
-	s.Cluster = v
-	return s
+func newErrorSubnetGroupNotFoundFault(v protocol.ResponseMetadata) error {
+	return &SubnetGroupNotFoundFault{
+		RespMetadata: v,
+	}
 }
 
-// The description of the server-side encryption status on the specified DAX
-// cluster.
-type SSEDescription struct {
-	_ struct{} `type:"structure"`
+// Code returns the exception type name.
+func (s *SubnetGroupNotFoundFault) Code() string {
+	return "SubnetGroupNotFoundFault"
+}
 
-	// The current state of server-side encryption:
-	//
-	//    * ENABLING - Server-side encryption is being enabled.
-	//
-	//    * ENABLED - Server-side encryption is enabled.
-	//
-	//    * DISABLING - Server-side encryption is being disabled.
-	//
-	//    * DISABLED - Server-side encryption is disabled.
-	Status *string `type:"string" enum:"SSEStatus"`
+// Message returns the exception's message.
+func (s *SubnetGroupNotFoundFault) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// String returns the string representation
-func (s SSEDescription) String() string {
-	return awsutil.Prettify(s)
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *SubnetGroupNotFoundFault) OrigErr() error {
+	return nil
 }
 
-// GoString returns the string representation
-func (s SSEDescription) GoString() string {
-	return s.String()
+func (s *SubnetGroupNotFoundFault) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// SetStatus sets the Status field's value.
-func (s *SSEDescription) SetStatus(v string) *SSEDescription {
-	s.Status = &v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *SubnetGroupNotFoundFault) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// Represents the settings used to enable server-side encryption.
-type SSESpecification struct {
-	_ struct{} `type:"structure"`
+// RequestID returns the service's response RequestID for request.
+func (s *SubnetGroupNotFoundFault) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// Indicates whether server-side encryption is enabled (true) or disabled (false)
-	// on the cluster.
-	//
-	// Enabled is a required field
-	Enabled *bool `type:"boolean" required:"true"`
+// The request cannot be processed because it would exceed the allowed number
+// of subnets in a subnet group.
+type SubnetGroupQuotaExceededFault struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s SSESpecification) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SubnetGroupQuotaExceededFault) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s SSESpecification) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SubnetGroupQuotaExceededFault) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *SSESpecification) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "SSESpecification"}
-	if s.Enabled == nil {
-		invalidParams.Add(request.NewErrParamRequired("Enabled"))
+func newErrorSubnetGroupQuotaExceededFault(v protocol.ResponseMetadata) error {
+	return &SubnetGroupQuotaExceededFault{
+		RespMetadata: v,
 	}
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
+// Code returns the exception type name.
+func (s *SubnetGroupQuotaExceededFault) Code() string {
+	return "SubnetGroupQuotaExceededFault"
+}
+
+// Message returns the exception's message.
+func (s *SubnetGroupQuotaExceededFault) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *SubnetGroupQuotaExceededFault) OrigErr() error {
 	return nil
 }
 
-// SetEnabled sets the Enabled field's value.
-func (s *SSESpecification) SetEnabled(v bool) *SSESpecification {
-	s.Enabled = &v
-	return s
+func (s *SubnetGroupQuotaExceededFault) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// An individual VPC security group and its status.
-type SecurityGroupMembership struct {
-	_ struct{} `type:"structure"`
+// Status code returns the HTTP status code for the request's response error.
+func (s *SubnetGroupQuotaExceededFault) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
 
-	// The unique ID for this security group.
-	SecurityGroupIdentifier *string `type:"string"`
+// RequestID returns the service's response RequestID for request.
+func (s *SubnetGroupQuotaExceededFault) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// The status of this security group.
-	Status *string `type:"string"`
+// The requested subnet is being used by another subnet group.
+type SubnetInUse struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s SecurityGroupMembership) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SubnetInUse) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s SecurityGroupMembership) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SubnetInUse) GoString() string {
 	return s.String()
 }
 
-// SetSecurityGroupIdentifier sets the SecurityGroupIdentifier field's value.
-func (s *SecurityGroupMembership) SetSecurityGroupIdentifier(v string) *SecurityGroupMembership {
-	s.SecurityGroupIdentifier = &v
-	return s
+func newErrorSubnetInUse(v protocol.ResponseMetadata) error {
+	return &SubnetInUse{
+		RespMetadata: v,
+	}
 }
 
-// SetStatus sets the Status field's value.
-func (s *SecurityGroupMembership) SetStatus(v string) *SecurityGroupMembership {
-	s.Status = &v
-	return s
+// Code returns the exception type name.
+func (s *SubnetInUse) Code() string {
+	return "SubnetInUse"
 }
 
-// Represents the subnet associated with a DAX cluster. This parameter refers
-// to subnets defined in Amazon Virtual Private Cloud (Amazon VPC) and used
-// with DAX.
-type Subnet struct {
-	_ struct{} `type:"structure"`
-
-	// The Availability Zone (AZ) for subnet subnet.
-	SubnetAvailabilityZone *string `type:"string"`
-
-	// The system-assigned identifier for the subnet.
-	SubnetIdentifier *string `type:"string"`
+// Message returns the exception's message.
+func (s *SubnetInUse) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// String returns the string representation
-func (s Subnet) String() string {
-	return awsutil.Prettify(s)
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *SubnetInUse) OrigErr() error {
+	return nil
 }
 
-// GoString returns the string representation
-func (s Subnet) GoString() string {
-	return s.String()
+func (s *SubnetInUse) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// SetSubnetAvailabilityZone sets the SubnetAvailabilityZone field's value.
-func (s *Subnet) SetSubnetAvailabilityZone(v string) *Subnet {
-	s.SubnetAvailabilityZone = &v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *SubnetInUse) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// SetSubnetIdentifier sets the SubnetIdentifier field's value.
-func (s *Subnet) SetSubnetIdentifier(v string) *Subnet {
-	s.SubnetIdentifier = &v
-	return s
+// RequestID returns the service's response RequestID for request.
+func (s *SubnetInUse) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// Represents the output of one of the following actions:
-//
-//    * CreateSubnetGroup
-//
-//    * ModifySubnetGroup
-type SubnetGroup struct {
-	_ struct{} `type:"structure"`
+// The request cannot be processed because it would exceed the allowed number
+// of subnets in a subnet group.
+type SubnetQuotaExceededFault struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The description of the subnet group.
-	Description *string `type:"string"`
+	Message_ *string `locationName:"message" type:"string"`
+}
 
-	// The name of the subnet group.
-	SubnetGroupName *string `type:"string"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SubnetQuotaExceededFault) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// A list of subnets associated with the subnet group.
-	Subnets []*Subnet `type:"list"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SubnetQuotaExceededFault) GoString() string {
+	return s.String()
+}
 
-	// The Amazon Virtual Private Cloud identifier (VPC ID) of the subnet group.
-	VpcId *string `type:"string"`
+func newErrorSubnetQuotaExceededFault(v protocol.ResponseMetadata) error {
+	return &SubnetQuotaExceededFault{
+		RespMetadata: v,
+	}
 }
 
-// String returns the string representation
-func (s SubnetGroup) String() string {
-	return awsutil.Prettify(s)
+// Code returns the exception type name.
+func (s *SubnetQuotaExceededFault) Code() string {
+	return "SubnetQuotaExceededFault"
 }
 
-// GoString returns the string representation
-func (s SubnetGroup) GoString() string {
-	return s.String()
+// Message returns the exception's message.
+func (s *SubnetQuotaExceededFault) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// SetDescription sets the Description field's value.
-func (s *SubnetGroup) SetDescription(v string) *SubnetGroup {
-	s.Description = &v
-	return s
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *SubnetQuotaExceededFault) OrigErr() error {
+	return nil
 }
 
-// SetSubnetGroupName sets the SubnetGroupName field's value.
-func (s *SubnetGroup) SetSubnetGroupName(v string) *SubnetGroup {
-	s.SubnetGroupName = &v
-	return s
+func (s *SubnetQuotaExceededFault) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// SetSubnets sets the Subnets field's value.
-func (s *SubnetGroup) SetSubnets(v []*Subnet) *SubnetGroup {
-	s.Subnets = v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *SubnetQuotaExceededFault) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// SetVpcId sets the VpcId field's value.
-func (s *SubnetGroup) SetVpcId(v string) *SubnetGroup {
-	s.VpcId = &v
-	return s
+// RequestID returns the service's response RequestID for request.
+func (s *SubnetQuotaExceededFault) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
 // A description of a tag. Every tag is a key-value pair. You can add up to
@@ -4274,12 +6324,20 @@ type Tag struct {
 	Value *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) GoString() string {
 	return s.String()
 }
@@ -4296,6 +6354,134 @@ func (s *Tag) SetValue(v string) *Tag {
 	return s
 }
 
+// The tag does not exist.
+type TagNotFoundFault struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagNotFoundFault) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagNotFoundFault) GoString() string {
+	return s.String()
+}
+
+func newErrorTagNotFoundFault(v protocol.ResponseMetadata) error {
+	return &TagNotFoundFault{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *TagNotFoundFault) Code() string {
+	return "TagNotFoundFault"
+}
+
+// Message returns the exception's message.
+func (s *TagNotFoundFault) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *TagNotFoundFault) OrigErr() error {
+	return nil
+}
+
+func (s *TagNotFoundFault) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *TagNotFoundFault) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *TagNotFoundFault) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// You have exceeded the maximum number of tags for this DAX cluster.
+type TagQuotaPerResourceExceeded struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagQuotaPerResourceExceeded) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagQuotaPerResourceExceeded) GoString() string {
+	return s.String()
+}
+
+func newErrorTagQuotaPerResourceExceeded(v protocol.ResponseMetadata) error {
+	return &TagQuotaPerResourceExceeded{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *TagQuotaPerResourceExceeded) Code() string {
+	return "TagQuotaPerResourceExceeded"
+}
+
+// Message returns the exception's message.
+func (s *TagQuotaPerResourceExceeded) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *TagQuotaPerResourceExceeded) OrigErr() error {
+	return nil
+}
+
+func (s *TagQuotaPerResourceExceeded) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *TagQuotaPerResourceExceeded) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *TagQuotaPerResourceExceeded) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 type TagResourceInput struct {
 	_ struct{} `type:"structure"`
 
@@ -4310,12 +6496,20 @@ type TagResourceInput struct {
 	Tags []*Tag `type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceInput) GoString() string {
 	return s.String()
 }
@@ -4355,12 +6549,20 @@ type TagResourceOutput struct {
 	Tags []*Tag `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceOutput) GoString() string {
 	return s.String()
 }
@@ -4386,12 +6588,20 @@ type UntagResourceInput struct {
 	TagKeys []*string `type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceInput) GoString() string {
 	return s.String()
 }
@@ -4431,12 +6641,20 @@ type UntagResourceOutput struct {
 	Tags []*Tag `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceOutput) GoString() string {
 	return s.String()
 }
@@ -4461,7 +6679,9 @@ type UpdateClusterInput struct {
 	// The Amazon Resource Name (ARN) that identifies the topic.
 	NotificationTopicArn *string `type:"string"`
 
-	// The current state of the topic.
+	// The current state of the topic. A value of “active” means that notifications
+	// will be sent to the topic. A value of “inactive” means that notifications
+	// will not be sent to the topic.
 	NotificationTopicStatus *string `type:"string"`
 
 	// The name of a parameter group for this cluster.
@@ -4478,12 +6698,20 @@ type UpdateClusterInput struct {
 	SecurityGroupIds []*string `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateClusterInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateClusterInput) GoString() string {
 	return s.String()
 }
@@ -4550,12 +6778,20 @@ type UpdateClusterOutput struct {
 	Cluster *Cluster `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateClusterOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateClusterOutput) GoString() string {
 	return s.String()
 }
@@ -4577,16 +6813,27 @@ type UpdateParameterGroupInput struct {
 	// An array of name-value pairs for the parameters in the group. Each element
 	// in the array represents a single parameter.
 	//
+	// record-ttl-millis and query-ttl-millis are the only supported parameter names.
+	// For more details, see Configuring TTL Settings (https://docs.aws.amazon.com/amazondynamodb/latest/developerguide/DAX.cluster-management.html#DAX.cluster-management.custom-settings.ttl).
+	//
 	// ParameterNameValues is a required field
 	ParameterNameValues []*ParameterNameValue `type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateParameterGroupInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateParameterGroupInput) GoString() string {
 	return s.String()
 }
@@ -4626,12 +6873,20 @@ type UpdateParameterGroupOutput struct {
 	ParameterGroup *ParameterGroup `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateParameterGroupOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateParameterGroupOutput) GoString() string {
 	return s.String()
 }
@@ -4657,12 +6912,20 @@ type UpdateSubnetGroupInput struct {
 	SubnetIds []*string `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateSubnetGroupInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateSubnetGroupInput) GoString() string {
 	return s.String()
 }
@@ -4705,12 +6968,20 @@ type UpdateSubnetGroupOutput struct {
 	SubnetGroup *SubnetGroup `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateSubnetGroupOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateSubnetGroupOutput) GoString() string {
 	return s.String()
 }
@@ -4729,6 +7000,30 @@ const (
 	ChangeTypeRequiresReboot = "REQUIRES_REBOOT"
 )
 
+// ChangeType_Values returns all elements of the ChangeType enum
+func ChangeType_Values() []string {
+	return []string{
+		ChangeTypeImmediate,
+		ChangeTypeRequiresReboot,
+	}
+}
+
+const (
+	// ClusterEndpointEncryptionTypeNone is a ClusterEndpointEncryptionType enum value
+	ClusterEndpointEncryptionTypeNone = "NONE"
+
+	// ClusterEndpointEncryptionTypeTls is a ClusterEndpointEncryptionType enum value
+	ClusterEndpointEncryptionTypeTls = "TLS"
+)
+
+// ClusterEndpointEncryptionType_Values returns all elements of the ClusterEndpointEncryptionType enum
+func ClusterEndpointEncryptionType_Values() []string {
+	return []string{
+		ClusterEndpointEncryptionTypeNone,
+		ClusterEndpointEncryptionTypeTls,
+	}
+}
+
 const (
 	// IsModifiableTrue is a IsModifiable enum value
 	IsModifiableTrue = "TRUE"
@@ -4740,6 +7035,15 @@ const (
 	IsModifiableConditional = "CONDITIONAL"
 )
 
+// IsModifiable_Values returns all elements of the IsModifiable enum
+func IsModifiable_Values() []string {
+	return []string{
+		IsModifiableTrue,
+		IsModifiableFalse,
+		IsModifiableConditional,
+	}
+}
+
 const (
 	// ParameterTypeDefault is a ParameterType enum value
 	ParameterTypeDefault = "DEFAULT"
@@ -4748,6 +7052,14 @@ const (
 	ParameterTypeNodeTypeSpecific = "NODE_TYPE_SPECIFIC"
 )
 
+// ParameterType_Values returns all elements of the ParameterType enum
+func ParameterType_Values() []string {
+	return []string{
+		ParameterTypeDefault,
+		ParameterTypeNodeTypeSpecific,
+	}
+}
+
 const (
 	// SSEStatusEnabling is a SSEStatus enum value
 	SSEStatusEnabling = "ENABLING"
@@ -4762,6 +7074,16 @@ const (
 	SSEStatusDisabled = "DISABLED"
 )
 
+// SSEStatus_Values returns all elements of the SSEStatus enum
+func SSEStatus_Values() []string {
+	return []string{
+		SSEStatusEnabling,
+		SSEStatusEnabled,
+		SSEStatusDisabling,
+		SSEStatusDisabled,
+	}
+}
+
 const (
 	// SourceTypeCluster is a SourceType enum value
 	SourceTypeCluster = "CLUSTER"
@@ -4772,3 +7094,12 @@ const (
 	// SourceTypeSubnetGroup is a SourceType enum value
 	SourceTypeSubnetGroup = "SUBNET_GROUP"
 )
+
+// SourceType_Values returns all elements of the SourceType enum
+func SourceType_Values() []string {
+	return []string{
+		SourceTypeCluster,
+		SourceTypeParameterGroup,
+		SourceTypeSubnetGroup,
+	}
+}
@@ -15,7 +15,7 @@
 // See dax package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/dax/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon DynamoDB Accelerator (DAX) with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
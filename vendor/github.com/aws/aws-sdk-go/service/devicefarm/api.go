@@ -29,14 +29,13 @@ const opCreateDevicePool = "CreateDevicePool"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateDevicePoolRequest method.
+//	req, resp := client.CreateDevicePoolRequest(params)
 //
-//    // Example sending a request using the CreateDevicePoolRequest method.
-//    req, resp := client.CreateDevicePoolRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/CreateDevicePool
 func (c *DeviceFarm) CreateDevicePoolRequest(input *CreateDevicePoolInput) (req *request.Request, output *CreateDevicePoolOutput) {
@@ -66,18 +65,19 @@ func (c *DeviceFarm) CreateDevicePoolRequest(input *CreateDevicePoolInput) (req
 // See the AWS API reference guide for AWS Device Farm's
 // API operation CreateDevicePool for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/CreateDevicePool
 func (c *DeviceFarm) CreateDevicePool(input *CreateDevicePoolInput) (*CreateDevicePoolOutput, error) {
@@ -117,14 +117,13 @@ const opCreateInstanceProfile = "CreateInstanceProfile"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateInstanceProfileRequest method.
+//	req, resp := client.CreateInstanceProfileRequest(params)
 //
-//    // Example sending a request using the CreateInstanceProfileRequest method.
-//    req, resp := client.CreateInstanceProfileRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/CreateInstanceProfile
 func (c *DeviceFarm) CreateInstanceProfileRequest(input *CreateInstanceProfileInput) (req *request.Request, output *CreateInstanceProfileOutput) {
@@ -155,18 +154,19 @@ func (c *DeviceFarm) CreateInstanceProfileRequest(input *CreateInstanceProfileIn
 // See the AWS API reference guide for AWS Device Farm's
 // API operation CreateInstanceProfile for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/CreateInstanceProfile
 func (c *DeviceFarm) CreateInstanceProfile(input *CreateInstanceProfileInput) (*CreateInstanceProfileOutput, error) {
@@ -206,14 +206,13 @@ const opCreateNetworkProfile = "CreateNetworkProfile"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateNetworkProfileRequest method.
+//	req, resp := client.CreateNetworkProfileRequest(params)
 //
-//    // Example sending a request using the CreateNetworkProfileRequest method.
-//    req, resp := client.CreateNetworkProfileRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/CreateNetworkProfile
 func (c *DeviceFarm) CreateNetworkProfileRequest(input *CreateNetworkProfileInput) (req *request.Request, output *CreateNetworkProfileOutput) {
@@ -243,18 +242,19 @@ func (c *DeviceFarm) CreateNetworkProfileRequest(input *CreateNetworkProfileInpu
 // See the AWS API reference guide for AWS Device Farm's
 // API operation CreateNetworkProfile for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/CreateNetworkProfile
 func (c *DeviceFarm) CreateNetworkProfile(input *CreateNetworkProfileInput) (*CreateNetworkProfileOutput, error) {
@@ -294,14 +294,13 @@ const opCreateProject = "CreateProject"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateProjectRequest method.
+//	req, resp := client.CreateProjectRequest(params)
 //
-//    // Example sending a request using the CreateProjectRequest method.
-//    req, resp := client.CreateProjectRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/CreateProject
 func (c *DeviceFarm) CreateProjectRequest(input *CreateProjectInput) (req *request.Request, output *CreateProjectOutput) {
@@ -322,7 +321,7 @@ func (c *DeviceFarm) CreateProjectRequest(input *CreateProjectInput) (req *reque
 
 // CreateProject API operation for AWS Device Farm.
 //
-// Creates a new project.
+// Creates a project.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -331,21 +330,22 @@ func (c *DeviceFarm) CreateProjectRequest(input *CreateProjectInput) (req *reque
 // See the AWS API reference guide for AWS Device Farm's
 // API operation CreateProject for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
-//   * ErrCodeTagOperationException "TagOperationException"
-//   The operation was not successful. Try again.
+//   - TagOperationException
+//     The operation was not successful. Try again.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/CreateProject
 func (c *DeviceFarm) CreateProject(input *CreateProjectInput) (*CreateProjectOutput, error) {
@@ -385,14 +385,13 @@ const opCreateRemoteAccessSession = "CreateRemoteAccessSession"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateRemoteAccessSessionRequest method.
+//	req, resp := client.CreateRemoteAccessSessionRequest(params)
 //
-//    // Example sending a request using the CreateRemoteAccessSessionRequest method.
-//    req, resp := client.CreateRemoteAccessSessionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/CreateRemoteAccessSession
 func (c *DeviceFarm) CreateRemoteAccessSessionRequest(input *CreateRemoteAccessSessionInput) (req *request.Request, output *CreateRemoteAccessSessionOutput) {
@@ -422,18 +421,19 @@ func (c *DeviceFarm) CreateRemoteAccessSessionRequest(input *CreateRemoteAccessS
 // See the AWS API reference guide for AWS Device Farm's
 // API operation CreateRemoteAccessSession for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/CreateRemoteAccessSession
 func (c *DeviceFarm) CreateRemoteAccessSession(input *CreateRemoteAccessSessionInput) (*CreateRemoteAccessSessionOutput, error) {
@@ -457,6 +457,180 @@ func (c *DeviceFarm) CreateRemoteAccessSessionWithContext(ctx aws.Context, input
 	return out, req.Send()
 }
 
+const opCreateTestGridProject = "CreateTestGridProject"
+
+// CreateTestGridProjectRequest generates a "aws/request.Request" representing the
+// client's request for the CreateTestGridProject operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See CreateTestGridProject for more information on using the CreateTestGridProject
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the CreateTestGridProjectRequest method.
+//	req, resp := client.CreateTestGridProjectRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/CreateTestGridProject
+func (c *DeviceFarm) CreateTestGridProjectRequest(input *CreateTestGridProjectInput) (req *request.Request, output *CreateTestGridProjectOutput) {
+	op := &request.Operation{
+		Name:       opCreateTestGridProject,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &CreateTestGridProjectInput{}
+	}
+
+	output = &CreateTestGridProjectOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// CreateTestGridProject API operation for AWS Device Farm.
+//
+// Creates a Selenium testing project. Projects are used to track TestGridSession
+// instances.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Device Farm's
+// API operation CreateTestGridProject for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
+//
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - InternalServiceException
+//     An internal exception was raised in the service. Contact aws-devicefarm-support@amazon.com
+//     (mailto:aws-devicefarm-support@amazon.com) if you see this error.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/CreateTestGridProject
+func (c *DeviceFarm) CreateTestGridProject(input *CreateTestGridProjectInput) (*CreateTestGridProjectOutput, error) {
+	req, out := c.CreateTestGridProjectRequest(input)
+	return out, req.Send()
+}
+
+// CreateTestGridProjectWithContext is the same as CreateTestGridProject with the addition of
+// the ability to pass a context and additional request options.
+//
+// See CreateTestGridProject for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DeviceFarm) CreateTestGridProjectWithContext(ctx aws.Context, input *CreateTestGridProjectInput, opts ...request.Option) (*CreateTestGridProjectOutput, error) {
+	req, out := c.CreateTestGridProjectRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opCreateTestGridUrl = "CreateTestGridUrl"
+
+// CreateTestGridUrlRequest generates a "aws/request.Request" representing the
+// client's request for the CreateTestGridUrl operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See CreateTestGridUrl for more information on using the CreateTestGridUrl
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the CreateTestGridUrlRequest method.
+//	req, resp := client.CreateTestGridUrlRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/CreateTestGridUrl
+func (c *DeviceFarm) CreateTestGridUrlRequest(input *CreateTestGridUrlInput) (req *request.Request, output *CreateTestGridUrlOutput) {
+	op := &request.Operation{
+		Name:       opCreateTestGridUrl,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &CreateTestGridUrlInput{}
+	}
+
+	output = &CreateTestGridUrlOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// CreateTestGridUrl API operation for AWS Device Farm.
+//
+// Creates a signed, short-term URL that can be passed to a Selenium RemoteWebDriver
+// constructor.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Device Farm's
+// API operation CreateTestGridUrl for usage and error information.
+//
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified entity was not found.
+//
+//   - ArgumentException
+//     An invalid argument was specified.
+//
+//   - InternalServiceException
+//     An internal exception was raised in the service. Contact aws-devicefarm-support@amazon.com
+//     (mailto:aws-devicefarm-support@amazon.com) if you see this error.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/CreateTestGridUrl
+func (c *DeviceFarm) CreateTestGridUrl(input *CreateTestGridUrlInput) (*CreateTestGridUrlOutput, error) {
+	req, out := c.CreateTestGridUrlRequest(input)
+	return out, req.Send()
+}
+
+// CreateTestGridUrlWithContext is the same as CreateTestGridUrl with the addition of
+// the ability to pass a context and additional request options.
+//
+// See CreateTestGridUrl for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DeviceFarm) CreateTestGridUrlWithContext(ctx aws.Context, input *CreateTestGridUrlInput, opts ...request.Option) (*CreateTestGridUrlOutput, error) {
+	req, out := c.CreateTestGridUrlRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opCreateUpload = "CreateUpload"
 
 // CreateUploadRequest generates a "aws/request.Request" representing the
@@ -473,14 +647,13 @@ const opCreateUpload = "CreateUpload"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateUploadRequest method.
+//	req, resp := client.CreateUploadRequest(params)
 //
-//    // Example sending a request using the CreateUploadRequest method.
-//    req, resp := client.CreateUploadRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/CreateUpload
 func (c *DeviceFarm) CreateUploadRequest(input *CreateUploadInput) (req *request.Request, output *CreateUploadOutput) {
@@ -510,18 +683,19 @@ func (c *DeviceFarm) CreateUploadRequest(input *CreateUploadInput) (req *request
 // See the AWS API reference guide for AWS Device Farm's
 // API operation CreateUpload for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/CreateUpload
 func (c *DeviceFarm) CreateUpload(input *CreateUploadInput) (*CreateUploadOutput, error) {
@@ -561,14 +735,13 @@ const opCreateVPCEConfiguration = "CreateVPCEConfiguration"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateVPCEConfigurationRequest method.
+//	req, resp := client.CreateVPCEConfigurationRequest(params)
 //
-//    // Example sending a request using the CreateVPCEConfigurationRequest method.
-//    req, resp := client.CreateVPCEConfigurationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/CreateVPCEConfiguration
 func (c *DeviceFarm) CreateVPCEConfigurationRequest(input *CreateVPCEConfigurationInput) (req *request.Request, output *CreateVPCEConfigurationOutput) {
@@ -599,15 +772,16 @@ func (c *DeviceFarm) CreateVPCEConfigurationRequest(input *CreateVPCEConfigurati
 // See the AWS API reference guide for AWS Device Farm's
 // API operation CreateVPCEConfiguration for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/CreateVPCEConfiguration
 func (c *DeviceFarm) CreateVPCEConfiguration(input *CreateVPCEConfigurationInput) (*CreateVPCEConfigurationOutput, error) {
@@ -647,14 +821,13 @@ const opDeleteDevicePool = "DeleteDevicePool"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteDevicePoolRequest method.
+//	req, resp := client.DeleteDevicePoolRequest(params)
 //
-//    // Example sending a request using the DeleteDevicePoolRequest method.
-//    req, resp := client.DeleteDevicePoolRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/DeleteDevicePool
 func (c *DeviceFarm) DeleteDevicePoolRequest(input *DeleteDevicePoolInput) (req *request.Request, output *DeleteDevicePoolOutput) {
@@ -686,18 +859,19 @@ func (c *DeviceFarm) DeleteDevicePoolRequest(input *DeleteDevicePoolInput) (req
 // See the AWS API reference guide for AWS Device Farm's
 // API operation DeleteDevicePool for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/DeleteDevicePool
 func (c *DeviceFarm) DeleteDevicePool(input *DeleteDevicePoolInput) (*DeleteDevicePoolOutput, error) {
@@ -737,14 +911,13 @@ const opDeleteInstanceProfile = "DeleteInstanceProfile"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteInstanceProfileRequest method.
+//	req, resp := client.DeleteInstanceProfileRequest(params)
 //
-//    // Example sending a request using the DeleteInstanceProfileRequest method.
-//    req, resp := client.DeleteInstanceProfileRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/DeleteInstanceProfile
 func (c *DeviceFarm) DeleteInstanceProfileRequest(input *DeleteInstanceProfileInput) (req *request.Request, output *DeleteInstanceProfileOutput) {
@@ -775,18 +948,19 @@ func (c *DeviceFarm) DeleteInstanceProfileRequest(input *DeleteInstanceProfileIn
 // See the AWS API reference guide for AWS Device Farm's
 // API operation DeleteInstanceProfile for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/DeleteInstanceProfile
 func (c *DeviceFarm) DeleteInstanceProfile(input *DeleteInstanceProfileInput) (*DeleteInstanceProfileOutput, error) {
@@ -826,14 +1000,13 @@ const opDeleteNetworkProfile = "DeleteNetworkProfile"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteNetworkProfileRequest method.
+//	req, resp := client.DeleteNetworkProfileRequest(params)
 //
-//    // Example sending a request using the DeleteNetworkProfileRequest method.
-//    req, resp := client.DeleteNetworkProfileRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/DeleteNetworkProfile
 func (c *DeviceFarm) DeleteNetworkProfileRequest(input *DeleteNetworkProfileInput) (req *request.Request, output *DeleteNetworkProfileOutput) {
@@ -864,18 +1037,19 @@ func (c *DeviceFarm) DeleteNetworkProfileRequest(input *DeleteNetworkProfileInpu
 // See the AWS API reference guide for AWS Device Farm's
 // API operation DeleteNetworkProfile for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/DeleteNetworkProfile
 func (c *DeviceFarm) DeleteNetworkProfile(input *DeleteNetworkProfileInput) (*DeleteNetworkProfileOutput, error) {
@@ -915,14 +1089,13 @@ const opDeleteProject = "DeleteProject"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteProjectRequest method.
+//	req, resp := client.DeleteProjectRequest(params)
 //
-//    // Example sending a request using the DeleteProjectRequest method.
-//    req, resp := client.DeleteProjectRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/DeleteProject
 func (c *DeviceFarm) DeleteProjectRequest(input *DeleteProjectInput) (req *request.Request, output *DeleteProjectOutput) {
@@ -946,7 +1119,7 @@ func (c *DeviceFarm) DeleteProjectRequest(input *DeleteProjectInput) (req *reque
 //
 // Deletes an AWS Device Farm project, given the project ARN.
 //
-// Note Deleting this resource does not stop an in-progress run.
+// Deleting this resource does not stop an in-progress run.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -955,18 +1128,19 @@ func (c *DeviceFarm) DeleteProjectRequest(input *DeleteProjectInput) (req *reque
 // See the AWS API reference guide for AWS Device Farm's
 // API operation DeleteProject for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/DeleteProject
 func (c *DeviceFarm) DeleteProject(input *DeleteProjectInput) (*DeleteProjectOutput, error) {
@@ -1006,14 +1180,13 @@ const opDeleteRemoteAccessSession = "DeleteRemoteAccessSession"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteRemoteAccessSessionRequest method.
+//	req, resp := client.DeleteRemoteAccessSessionRequest(params)
 //
-//    // Example sending a request using the DeleteRemoteAccessSessionRequest method.
-//    req, resp := client.DeleteRemoteAccessSessionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/DeleteRemoteAccessSession
 func (c *DeviceFarm) DeleteRemoteAccessSessionRequest(input *DeleteRemoteAccessSessionInput) (req *request.Request, output *DeleteRemoteAccessSessionOutput) {
@@ -1044,18 +1217,19 @@ func (c *DeviceFarm) DeleteRemoteAccessSessionRequest(input *DeleteRemoteAccessS
 // See the AWS API reference guide for AWS Device Farm's
 // API operation DeleteRemoteAccessSession for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/DeleteRemoteAccessSession
 func (c *DeviceFarm) DeleteRemoteAccessSession(input *DeleteRemoteAccessSessionInput) (*DeleteRemoteAccessSessionOutput, error) {
@@ -1095,14 +1269,13 @@ const opDeleteRun = "DeleteRun"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteRunRequest method.
+//	req, resp := client.DeleteRunRequest(params)
 //
-//    // Example sending a request using the DeleteRunRequest method.
-//    req, resp := client.DeleteRunRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/DeleteRun
 func (c *DeviceFarm) DeleteRunRequest(input *DeleteRunInput) (req *request.Request, output *DeleteRunOutput) {
@@ -1126,7 +1299,7 @@ func (c *DeviceFarm) DeleteRunRequest(input *DeleteRunInput) (req *request.Reque
 //
 // Deletes the run, given the run ARN.
 //
-// Note Deleting this resource does not stop an in-progress run.
+// Deleting this resource does not stop an in-progress run.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1135,18 +1308,19 @@ func (c *DeviceFarm) DeleteRunRequest(input *DeleteRunInput) (req *request.Reque
 // See the AWS API reference guide for AWS Device Farm's
 // API operation DeleteRun for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/DeleteRun
 func (c *DeviceFarm) DeleteRun(input *DeleteRunInput) (*DeleteRunOutput, error) {
@@ -1170,6 +1344,100 @@ func (c *DeviceFarm) DeleteRunWithContext(ctx aws.Context, input *DeleteRunInput
 	return out, req.Send()
 }
 
+const opDeleteTestGridProject = "DeleteTestGridProject"
+
+// DeleteTestGridProjectRequest generates a "aws/request.Request" representing the
+// client's request for the DeleteTestGridProject operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See DeleteTestGridProject for more information on using the DeleteTestGridProject
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the DeleteTestGridProjectRequest method.
+//	req, resp := client.DeleteTestGridProjectRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/DeleteTestGridProject
+func (c *DeviceFarm) DeleteTestGridProjectRequest(input *DeleteTestGridProjectInput) (req *request.Request, output *DeleteTestGridProjectOutput) {
+	op := &request.Operation{
+		Name:       opDeleteTestGridProject,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &DeleteTestGridProjectInput{}
+	}
+
+	output = &DeleteTestGridProjectOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// DeleteTestGridProject API operation for AWS Device Farm.
+//
+// Deletes a Selenium testing project and all content generated under it.
+//
+// You cannot undo this operation.
+//
+// You cannot delete a project if it has active sessions.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Device Farm's
+// API operation DeleteTestGridProject for usage and error information.
+//
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified entity was not found.
+//
+//   - ArgumentException
+//     An invalid argument was specified.
+//
+//   - CannotDeleteException
+//     The requested object could not be deleted.
+//
+//   - InternalServiceException
+//     An internal exception was raised in the service. Contact aws-devicefarm-support@amazon.com
+//     (mailto:aws-devicefarm-support@amazon.com) if you see this error.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/DeleteTestGridProject
+func (c *DeviceFarm) DeleteTestGridProject(input *DeleteTestGridProjectInput) (*DeleteTestGridProjectOutput, error) {
+	req, out := c.DeleteTestGridProjectRequest(input)
+	return out, req.Send()
+}
+
+// DeleteTestGridProjectWithContext is the same as DeleteTestGridProject with the addition of
+// the ability to pass a context and additional request options.
+//
+// See DeleteTestGridProject for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DeviceFarm) DeleteTestGridProjectWithContext(ctx aws.Context, input *DeleteTestGridProjectInput, opts ...request.Option) (*DeleteTestGridProjectOutput, error) {
+	req, out := c.DeleteTestGridProjectRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opDeleteUpload = "DeleteUpload"
 
 // DeleteUploadRequest generates a "aws/request.Request" representing the
@@ -1186,14 +1454,13 @@ const opDeleteUpload = "DeleteUpload"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteUploadRequest method.
+//	req, resp := client.DeleteUploadRequest(params)
 //
-//    // Example sending a request using the DeleteUploadRequest method.
-//    req, resp := client.DeleteUploadRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/DeleteUpload
 func (c *DeviceFarm) DeleteUploadRequest(input *DeleteUploadInput) (req *request.Request, output *DeleteUploadOutput) {
@@ -1224,18 +1491,19 @@ func (c *DeviceFarm) DeleteUploadRequest(input *DeleteUploadInput) (req *request
 // See the AWS API reference guide for AWS Device Farm's
 // API operation DeleteUpload for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/DeleteUpload
 func (c *DeviceFarm) DeleteUpload(input *DeleteUploadInput) (*DeleteUploadOutput, error) {
@@ -1275,14 +1543,13 @@ const opDeleteVPCEConfiguration = "DeleteVPCEConfiguration"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteVPCEConfigurationRequest method.
+//	req, resp := client.DeleteVPCEConfigurationRequest(params)
 //
-//    // Example sending a request using the DeleteVPCEConfigurationRequest method.
-//    req, resp := client.DeleteVPCEConfigurationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/DeleteVPCEConfiguration
 func (c *DeviceFarm) DeleteVPCEConfigurationRequest(input *DeleteVPCEConfigurationInput) (req *request.Request, output *DeleteVPCEConfigurationOutput) {
@@ -1313,19 +1580,20 @@ func (c *DeviceFarm) DeleteVPCEConfigurationRequest(input *DeleteVPCEConfigurati
 // See the AWS API reference guide for AWS Device Farm's
 // API operation DeleteVPCEConfiguration for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
-//   * ErrCodeInvalidOperationException "InvalidOperationException"
-//   There was an error with the update request, or you do not have sufficient
-//   permissions to update this VPC endpoint configuration.
+//   - InvalidOperationException
+//     There was an error with the update request, or you do not have sufficient
+//     permissions to update this VPC endpoint configuration.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/DeleteVPCEConfiguration
 func (c *DeviceFarm) DeleteVPCEConfiguration(input *DeleteVPCEConfigurationInput) (*DeleteVPCEConfigurationOutput, error) {
@@ -1365,14 +1633,13 @@ const opGetAccountSettings = "GetAccountSettings"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetAccountSettingsRequest method.
+//	req, resp := client.GetAccountSettingsRequest(params)
 //
-//    // Example sending a request using the GetAccountSettingsRequest method.
-//    req, resp := client.GetAccountSettingsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetAccountSettings
 func (c *DeviceFarm) GetAccountSettingsRequest(input *GetAccountSettingsInput) (req *request.Request, output *GetAccountSettingsOutput) {
@@ -1393,8 +1660,8 @@ func (c *DeviceFarm) GetAccountSettingsRequest(input *GetAccountSettingsInput) (
 
 // GetAccountSettings API operation for AWS Device Farm.
 //
-// Returns the number of unmetered iOS and/or unmetered Android devices that
-// have been purchased by the account.
+// Returns the number of unmetered iOS or unmetered Android devices that have
+// been purchased by the account.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1403,18 +1670,19 @@ func (c *DeviceFarm) GetAccountSettingsRequest(input *GetAccountSettingsInput) (
 // See the AWS API reference guide for AWS Device Farm's
 // API operation GetAccountSettings for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetAccountSettings
 func (c *DeviceFarm) GetAccountSettings(input *GetAccountSettingsInput) (*GetAccountSettingsOutput, error) {
@@ -1454,14 +1722,13 @@ const opGetDevice = "GetDevice"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetDeviceRequest method.
+//	req, resp := client.GetDeviceRequest(params)
 //
-//    // Example sending a request using the GetDeviceRequest method.
-//    req, resp := client.GetDeviceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetDevice
 func (c *DeviceFarm) GetDeviceRequest(input *GetDeviceInput) (req *request.Request, output *GetDeviceOutput) {
@@ -1491,18 +1758,19 @@ func (c *DeviceFarm) GetDeviceRequest(input *GetDeviceInput) (req *request.Reque
 // See the AWS API reference guide for AWS Device Farm's
 // API operation GetDevice for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetDevice
 func (c *DeviceFarm) GetDevice(input *GetDeviceInput) (*GetDeviceOutput, error) {
@@ -1542,14 +1810,13 @@ const opGetDeviceInstance = "GetDeviceInstance"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetDeviceInstanceRequest method.
+//	req, resp := client.GetDeviceInstanceRequest(params)
 //
-//    // Example sending a request using the GetDeviceInstanceRequest method.
-//    req, resp := client.GetDeviceInstanceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetDeviceInstance
 func (c *DeviceFarm) GetDeviceInstanceRequest(input *GetDeviceInstanceInput) (req *request.Request, output *GetDeviceInstanceOutput) {
@@ -1570,7 +1837,7 @@ func (c *DeviceFarm) GetDeviceInstanceRequest(input *GetDeviceInstanceInput) (re
 
 // GetDeviceInstance API operation for AWS Device Farm.
 //
-// Returns information about a device instance belonging to a private device
+// Returns information about a device instance that belongs to a private device
 // fleet.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
@@ -1580,18 +1847,19 @@ func (c *DeviceFarm) GetDeviceInstanceRequest(input *GetDeviceInstanceInput) (re
 // See the AWS API reference guide for AWS Device Farm's
 // API operation GetDeviceInstance for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetDeviceInstance
 func (c *DeviceFarm) GetDeviceInstance(input *GetDeviceInstanceInput) (*GetDeviceInstanceOutput, error) {
@@ -1631,14 +1899,13 @@ const opGetDevicePool = "GetDevicePool"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetDevicePoolRequest method.
+//	req, resp := client.GetDevicePoolRequest(params)
 //
-//    // Example sending a request using the GetDevicePoolRequest method.
-//    req, resp := client.GetDevicePoolRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetDevicePool
 func (c *DeviceFarm) GetDevicePoolRequest(input *GetDevicePoolInput) (req *request.Request, output *GetDevicePoolOutput) {
@@ -1668,18 +1935,19 @@ func (c *DeviceFarm) GetDevicePoolRequest(input *GetDevicePoolInput) (req *reque
 // See the AWS API reference guide for AWS Device Farm's
 // API operation GetDevicePool for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetDevicePool
 func (c *DeviceFarm) GetDevicePool(input *GetDevicePoolInput) (*GetDevicePoolOutput, error) {
@@ -1719,14 +1987,13 @@ const opGetDevicePoolCompatibility = "GetDevicePoolCompatibility"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetDevicePoolCompatibilityRequest method.
+//	req, resp := client.GetDevicePoolCompatibilityRequest(params)
 //
-//    // Example sending a request using the GetDevicePoolCompatibilityRequest method.
-//    req, resp := client.GetDevicePoolCompatibilityRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetDevicePoolCompatibility
 func (c *DeviceFarm) GetDevicePoolCompatibilityRequest(input *GetDevicePoolCompatibilityInput) (req *request.Request, output *GetDevicePoolCompatibilityOutput) {
@@ -1756,18 +2023,19 @@ func (c *DeviceFarm) GetDevicePoolCompatibilityRequest(input *GetDevicePoolCompa
 // See the AWS API reference guide for AWS Device Farm's
 // API operation GetDevicePoolCompatibility for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetDevicePoolCompatibility
 func (c *DeviceFarm) GetDevicePoolCompatibility(input *GetDevicePoolCompatibilityInput) (*GetDevicePoolCompatibilityOutput, error) {
@@ -1807,14 +2075,13 @@ const opGetInstanceProfile = "GetInstanceProfile"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetInstanceProfileRequest method.
+//	req, resp := client.GetInstanceProfileRequest(params)
 //
-//    // Example sending a request using the GetInstanceProfileRequest method.
-//    req, resp := client.GetInstanceProfileRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetInstanceProfile
 func (c *DeviceFarm) GetInstanceProfileRequest(input *GetInstanceProfileInput) (req *request.Request, output *GetInstanceProfileOutput) {
@@ -1844,18 +2111,19 @@ func (c *DeviceFarm) GetInstanceProfileRequest(input *GetInstanceProfileInput) (
 // See the AWS API reference guide for AWS Device Farm's
 // API operation GetInstanceProfile for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetInstanceProfile
 func (c *DeviceFarm) GetInstanceProfile(input *GetInstanceProfileInput) (*GetInstanceProfileOutput, error) {
@@ -1895,14 +2163,13 @@ const opGetJob = "GetJob"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetJobRequest method.
+//	req, resp := client.GetJobRequest(params)
 //
-//    // Example sending a request using the GetJobRequest method.
-//    req, resp := client.GetJobRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetJob
 func (c *DeviceFarm) GetJobRequest(input *GetJobInput) (req *request.Request, output *GetJobOutput) {
@@ -1932,18 +2199,19 @@ func (c *DeviceFarm) GetJobRequest(input *GetJobInput) (req *request.Request, ou
 // See the AWS API reference guide for AWS Device Farm's
 // API operation GetJob for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetJob
 func (c *DeviceFarm) GetJob(input *GetJobInput) (*GetJobOutput, error) {
@@ -1983,14 +2251,13 @@ const opGetNetworkProfile = "GetNetworkProfile"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetNetworkProfileRequest method.
+//	req, resp := client.GetNetworkProfileRequest(params)
 //
-//    // Example sending a request using the GetNetworkProfileRequest method.
-//    req, resp := client.GetNetworkProfileRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetNetworkProfile
 func (c *DeviceFarm) GetNetworkProfileRequest(input *GetNetworkProfileInput) (req *request.Request, output *GetNetworkProfileOutput) {
@@ -2020,18 +2287,19 @@ func (c *DeviceFarm) GetNetworkProfileRequest(input *GetNetworkProfileInput) (re
 // See the AWS API reference guide for AWS Device Farm's
 // API operation GetNetworkProfile for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetNetworkProfile
 func (c *DeviceFarm) GetNetworkProfile(input *GetNetworkProfileInput) (*GetNetworkProfileOutput, error) {
@@ -2071,14 +2339,13 @@ const opGetOfferingStatus = "GetOfferingStatus"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetOfferingStatusRequest method.
+//	req, resp := client.GetOfferingStatusRequest(params)
 //
-//    // Example sending a request using the GetOfferingStatusRequest method.
-//    req, resp := client.GetOfferingStatusRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetOfferingStatus
 func (c *DeviceFarm) GetOfferingStatusRequest(input *GetOfferingStatusInput) (req *request.Request, output *GetOfferingStatusOutput) {
@@ -2109,8 +2376,8 @@ func (c *DeviceFarm) GetOfferingStatusRequest(input *GetOfferingStatusInput) (re
 // AWS account. The response indicates how many offerings are currently available
 // and the offerings that will be available in the next period. The API returns
 // a NotEligible error if the user is not permitted to invoke the operation.
-// Please contact aws-devicefarm-support@amazon.com (mailto:aws-devicefarm-support@amazon.com)
-// if you believe that you should be able to invoke this operation.
+// If you must be able to invoke this operation, contact aws-devicefarm-support@amazon.com
+// (mailto:aws-devicefarm-support@amazon.com).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2119,22 +2386,23 @@ func (c *DeviceFarm) GetOfferingStatusRequest(input *GetOfferingStatusInput) (re
 // See the AWS API reference guide for AWS Device Farm's
 // API operation GetOfferingStatus for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeNotEligibleException "NotEligibleException"
-//   Exception gets thrown when a user is not eligible to perform the specified
-//   transaction.
+//   - NotEligibleException
+//     Exception gets thrown when a user is not eligible to perform the specified
+//     transaction.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetOfferingStatus
 func (c *DeviceFarm) GetOfferingStatus(input *GetOfferingStatusInput) (*GetOfferingStatusOutput, error) {
@@ -2166,15 +2434,14 @@ func (c *DeviceFarm) GetOfferingStatusWithContext(ctx aws.Context, input *GetOff
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a GetOfferingStatus operation.
-//    pageNum := 0
-//    err := client.GetOfferingStatusPages(params,
-//        func(page *devicefarm.GetOfferingStatusOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a GetOfferingStatus operation.
+//	pageNum := 0
+//	err := client.GetOfferingStatusPages(params,
+//	    func(page *devicefarm.GetOfferingStatusOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *DeviceFarm) GetOfferingStatusPages(input *GetOfferingStatusInput, fn func(*GetOfferingStatusOutput, bool) bool) error {
 	return c.GetOfferingStatusPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -2201,10 +2468,12 @@ func (c *DeviceFarm) GetOfferingStatusPagesWithContext(ctx aws.Context, input *G
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*GetOfferingStatusOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*GetOfferingStatusOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -2224,14 +2493,13 @@ const opGetProject = "GetProject"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetProjectRequest method.
+//	req, resp := client.GetProjectRequest(params)
 //
-//    // Example sending a request using the GetProjectRequest method.
-//    req, resp := client.GetProjectRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetProject
 func (c *DeviceFarm) GetProjectRequest(input *GetProjectInput) (req *request.Request, output *GetProjectOutput) {
@@ -2261,18 +2529,19 @@ func (c *DeviceFarm) GetProjectRequest(input *GetProjectInput) (req *request.Req
 // See the AWS API reference guide for AWS Device Farm's
 // API operation GetProject for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetProject
 func (c *DeviceFarm) GetProject(input *GetProjectInput) (*GetProjectOutput, error) {
@@ -2312,14 +2581,13 @@ const opGetRemoteAccessSession = "GetRemoteAccessSession"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetRemoteAccessSessionRequest method.
+//	req, resp := client.GetRemoteAccessSessionRequest(params)
 //
-//    // Example sending a request using the GetRemoteAccessSessionRequest method.
-//    req, resp := client.GetRemoteAccessSessionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetRemoteAccessSession
 func (c *DeviceFarm) GetRemoteAccessSessionRequest(input *GetRemoteAccessSessionInput) (req *request.Request, output *GetRemoteAccessSessionOutput) {
@@ -2349,18 +2617,19 @@ func (c *DeviceFarm) GetRemoteAccessSessionRequest(input *GetRemoteAccessSession
 // See the AWS API reference guide for AWS Device Farm's
 // API operation GetRemoteAccessSession for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetRemoteAccessSession
 func (c *DeviceFarm) GetRemoteAccessSession(input *GetRemoteAccessSessionInput) (*GetRemoteAccessSessionOutput, error) {
@@ -2400,14 +2669,13 @@ const opGetRun = "GetRun"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetRunRequest method.
+//	req, resp := client.GetRunRequest(params)
 //
-//    // Example sending a request using the GetRunRequest method.
-//    req, resp := client.GetRunRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetRun
 func (c *DeviceFarm) GetRunRequest(input *GetRunInput) (req *request.Request, output *GetRunOutput) {
@@ -2437,18 +2705,19 @@ func (c *DeviceFarm) GetRunRequest(input *GetRunInput) (req *request.Request, ou
 // See the AWS API reference guide for AWS Device Farm's
 // API operation GetRun for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetRun
 func (c *DeviceFarm) GetRun(input *GetRunInput) (*GetRunOutput, error) {
@@ -2488,14 +2757,13 @@ const opGetSuite = "GetSuite"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetSuiteRequest method.
+//	req, resp := client.GetSuiteRequest(params)
 //
-//    // Example sending a request using the GetSuiteRequest method.
-//    req, resp := client.GetSuiteRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetSuite
 func (c *DeviceFarm) GetSuiteRequest(input *GetSuiteInput) (req *request.Request, output *GetSuiteOutput) {
@@ -2525,18 +2793,19 @@ func (c *DeviceFarm) GetSuiteRequest(input *GetSuiteInput) (req *request.Request
 // See the AWS API reference guide for AWS Device Farm's
 // API operation GetSuite for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetSuite
 func (c *DeviceFarm) GetSuite(input *GetSuiteInput) (*GetSuiteOutput, error) {
@@ -2576,14 +2845,13 @@ const opGetTest = "GetTest"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetTestRequest method.
+//	req, resp := client.GetTestRequest(params)
 //
-//    // Example sending a request using the GetTestRequest method.
-//    req, resp := client.GetTestRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetTest
 func (c *DeviceFarm) GetTestRequest(input *GetTestInput) (req *request.Request, output *GetTestOutput) {
@@ -2613,18 +2881,19 @@ func (c *DeviceFarm) GetTestRequest(input *GetTestInput) (req *request.Request,
 // See the AWS API reference guide for AWS Device Farm's
 // API operation GetTest for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetTest
 func (c *DeviceFarm) GetTest(input *GetTestInput) (*GetTestOutput, error) {
@@ -2648,137 +2917,315 @@ func (c *DeviceFarm) GetTestWithContext(ctx aws.Context, input *GetTestInput, op
 	return out, req.Send()
 }
 
-const opGetUpload = "GetUpload"
+const opGetTestGridProject = "GetTestGridProject"
 
-// GetUploadRequest generates a "aws/request.Request" representing the
-// client's request for the GetUpload operation. The "output" return
+// GetTestGridProjectRequest generates a "aws/request.Request" representing the
+// client's request for the GetTestGridProject operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See GetUpload for more information on using the GetUpload
+// See GetTestGridProject for more information on using the GetTestGridProject
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetTestGridProjectRequest method.
+//	req, resp := client.GetTestGridProjectRequest(params)
 //
-//    // Example sending a request using the GetUploadRequest method.
-//    req, resp := client.GetUploadRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetUpload
-func (c *DeviceFarm) GetUploadRequest(input *GetUploadInput) (req *request.Request, output *GetUploadOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetTestGridProject
+func (c *DeviceFarm) GetTestGridProjectRequest(input *GetTestGridProjectInput) (req *request.Request, output *GetTestGridProjectOutput) {
 	op := &request.Operation{
-		Name:       opGetUpload,
+		Name:       opGetTestGridProject,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &GetUploadInput{}
+		input = &GetTestGridProjectInput{}
 	}
 
-	output = &GetUploadOutput{}
+	output = &GetTestGridProjectOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// GetUpload API operation for AWS Device Farm.
+// GetTestGridProject API operation for AWS Device Farm.
 //
-// Gets information about an upload.
+// Retrieves information about a Selenium testing project.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Device Farm's
-// API operation GetUpload for usage and error information.
+// API operation GetTestGridProject for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - InternalServiceException
+//     An internal exception was raised in the service. Contact aws-devicefarm-support@amazon.com
+//     (mailto:aws-devicefarm-support@amazon.com) if you see this error.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetUpload
-func (c *DeviceFarm) GetUpload(input *GetUploadInput) (*GetUploadOutput, error) {
-	req, out := c.GetUploadRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetTestGridProject
+func (c *DeviceFarm) GetTestGridProject(input *GetTestGridProjectInput) (*GetTestGridProjectOutput, error) {
+	req, out := c.GetTestGridProjectRequest(input)
 	return out, req.Send()
 }
 
-// GetUploadWithContext is the same as GetUpload with the addition of
+// GetTestGridProjectWithContext is the same as GetTestGridProject with the addition of
 // the ability to pass a context and additional request options.
 //
-// See GetUpload for details on how to use this API operation.
+// See GetTestGridProject for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DeviceFarm) GetUploadWithContext(ctx aws.Context, input *GetUploadInput, opts ...request.Option) (*GetUploadOutput, error) {
-	req, out := c.GetUploadRequest(input)
+func (c *DeviceFarm) GetTestGridProjectWithContext(ctx aws.Context, input *GetTestGridProjectInput, opts ...request.Option) (*GetTestGridProjectOutput, error) {
+	req, out := c.GetTestGridProjectRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opGetVPCEConfiguration = "GetVPCEConfiguration"
+const opGetTestGridSession = "GetTestGridSession"
 
-// GetVPCEConfigurationRequest generates a "aws/request.Request" representing the
-// client's request for the GetVPCEConfiguration operation. The "output" return
+// GetTestGridSessionRequest generates a "aws/request.Request" representing the
+// client's request for the GetTestGridSession operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See GetVPCEConfiguration for more information on using the GetVPCEConfiguration
+// See GetTestGridSession for more information on using the GetTestGridSession
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetTestGridSessionRequest method.
+//	req, resp := client.GetTestGridSessionRequest(params)
 //
-//    // Example sending a request using the GetVPCEConfigurationRequest method.
-//    req, resp := client.GetVPCEConfigurationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetVPCEConfiguration
-func (c *DeviceFarm) GetVPCEConfigurationRequest(input *GetVPCEConfigurationInput) (req *request.Request, output *GetVPCEConfigurationOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetTestGridSession
+func (c *DeviceFarm) GetTestGridSessionRequest(input *GetTestGridSessionInput) (req *request.Request, output *GetTestGridSessionOutput) {
 	op := &request.Operation{
-		Name:       opGetVPCEConfiguration,
+		Name:       opGetTestGridSession,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &GetVPCEConfigurationInput{}
+		input = &GetTestGridSessionInput{}
 	}
 
-	output = &GetVPCEConfigurationOutput{}
+	output = &GetTestGridSessionOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// GetVPCEConfiguration API operation for AWS Device Farm.
+// GetTestGridSession API operation for AWS Device Farm.
+//
+// A session is an instance of a browser created through a RemoteWebDriver with
+// the URL from CreateTestGridUrlResult$url. You can use the following to look
+// up sessions:
+//
+//   - The session ARN (GetTestGridSessionRequest$sessionArn).
+//
+//   - The project ARN and a session ID (GetTestGridSessionRequest$projectArn
+//     and GetTestGridSessionRequest$sessionId).
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Device Farm's
+// API operation GetTestGridSession for usage and error information.
+//
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified entity was not found.
+//
+//   - ArgumentException
+//     An invalid argument was specified.
+//
+//   - InternalServiceException
+//     An internal exception was raised in the service. Contact aws-devicefarm-support@amazon.com
+//     (mailto:aws-devicefarm-support@amazon.com) if you see this error.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetTestGridSession
+func (c *DeviceFarm) GetTestGridSession(input *GetTestGridSessionInput) (*GetTestGridSessionOutput, error) {
+	req, out := c.GetTestGridSessionRequest(input)
+	return out, req.Send()
+}
+
+// GetTestGridSessionWithContext is the same as GetTestGridSession with the addition of
+// the ability to pass a context and additional request options.
+//
+// See GetTestGridSession for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DeviceFarm) GetTestGridSessionWithContext(ctx aws.Context, input *GetTestGridSessionInput, opts ...request.Option) (*GetTestGridSessionOutput, error) {
+	req, out := c.GetTestGridSessionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opGetUpload = "GetUpload"
+
+// GetUploadRequest generates a "aws/request.Request" representing the
+// client's request for the GetUpload operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See GetUpload for more information on using the GetUpload
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the GetUploadRequest method.
+//	req, resp := client.GetUploadRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetUpload
+func (c *DeviceFarm) GetUploadRequest(input *GetUploadInput) (req *request.Request, output *GetUploadOutput) {
+	op := &request.Operation{
+		Name:       opGetUpload,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &GetUploadInput{}
+	}
+
+	output = &GetUploadOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// GetUpload API operation for AWS Device Farm.
+//
+// Gets information about an upload.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Device Farm's
+// API operation GetUpload for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
+//
+//   - NotFoundException
+//     The specified entity was not found.
+//
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetUpload
+func (c *DeviceFarm) GetUpload(input *GetUploadInput) (*GetUploadOutput, error) {
+	req, out := c.GetUploadRequest(input)
+	return out, req.Send()
+}
+
+// GetUploadWithContext is the same as GetUpload with the addition of
+// the ability to pass a context and additional request options.
+//
+// See GetUpload for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DeviceFarm) GetUploadWithContext(ctx aws.Context, input *GetUploadInput, opts ...request.Option) (*GetUploadOutput, error) {
+	req, out := c.GetUploadRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opGetVPCEConfiguration = "GetVPCEConfiguration"
+
+// GetVPCEConfigurationRequest generates a "aws/request.Request" representing the
+// client's request for the GetVPCEConfiguration operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See GetVPCEConfiguration for more information on using the GetVPCEConfiguration
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the GetVPCEConfigurationRequest method.
+//	req, resp := client.GetVPCEConfigurationRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetVPCEConfiguration
+func (c *DeviceFarm) GetVPCEConfigurationRequest(input *GetVPCEConfigurationInput) (req *request.Request, output *GetVPCEConfigurationOutput) {
+	op := &request.Operation{
+		Name:       opGetVPCEConfiguration,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &GetVPCEConfigurationInput{}
+	}
+
+	output = &GetVPCEConfigurationOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// GetVPCEConfiguration API operation for AWS Device Farm.
 //
 // Returns information about the configuration settings for your Amazon Virtual
 // Private Cloud (VPC) endpoint.
@@ -2790,15 +3237,16 @@ func (c *DeviceFarm) GetVPCEConfigurationRequest(input *GetVPCEConfigurationInpu
 // See the AWS API reference guide for AWS Device Farm's
 // API operation GetVPCEConfiguration for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - NotFoundException
+//     The specified entity was not found.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/GetVPCEConfiguration
 func (c *DeviceFarm) GetVPCEConfiguration(input *GetVPCEConfigurationInput) (*GetVPCEConfigurationOutput, error) {
@@ -2838,14 +3286,13 @@ const opInstallToRemoteAccessSession = "InstallToRemoteAccessSession"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the InstallToRemoteAccessSessionRequest method.
+//	req, resp := client.InstallToRemoteAccessSessionRequest(params)
 //
-//    // Example sending a request using the InstallToRemoteAccessSessionRequest method.
-//    req, resp := client.InstallToRemoteAccessSessionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/InstallToRemoteAccessSession
 func (c *DeviceFarm) InstallToRemoteAccessSessionRequest(input *InstallToRemoteAccessSessionInput) (req *request.Request, output *InstallToRemoteAccessSessionOutput) {
@@ -2877,18 +3324,19 @@ func (c *DeviceFarm) InstallToRemoteAccessSessionRequest(input *InstallToRemoteA
 // See the AWS API reference guide for AWS Device Farm's
 // API operation InstallToRemoteAccessSession for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/InstallToRemoteAccessSession
 func (c *DeviceFarm) InstallToRemoteAccessSession(input *InstallToRemoteAccessSessionInput) (*InstallToRemoteAccessSessionOutput, error) {
@@ -2928,14 +3376,13 @@ const opListArtifacts = "ListArtifacts"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListArtifactsRequest method.
+//	req, resp := client.ListArtifactsRequest(params)
 //
-//    // Example sending a request using the ListArtifactsRequest method.
-//    req, resp := client.ListArtifactsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListArtifacts
 func (c *DeviceFarm) ListArtifactsRequest(input *ListArtifactsInput) (req *request.Request, output *ListArtifactsOutput) {
@@ -2971,18 +3418,19 @@ func (c *DeviceFarm) ListArtifactsRequest(input *ListArtifactsInput) (req *reque
 // See the AWS API reference guide for AWS Device Farm's
 // API operation ListArtifacts for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListArtifacts
 func (c *DeviceFarm) ListArtifacts(input *ListArtifactsInput) (*ListArtifactsOutput, error) {
@@ -3014,15 +3462,14 @@ func (c *DeviceFarm) ListArtifactsWithContext(ctx aws.Context, input *ListArtifa
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListArtifacts operation.
-//    pageNum := 0
-//    err := client.ListArtifactsPages(params,
-//        func(page *devicefarm.ListArtifactsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListArtifacts operation.
+//	pageNum := 0
+//	err := client.ListArtifactsPages(params,
+//	    func(page *devicefarm.ListArtifactsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *DeviceFarm) ListArtifactsPages(input *ListArtifactsInput, fn func(*ListArtifactsOutput, bool) bool) error {
 	return c.ListArtifactsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -3049,10 +3496,12 @@ func (c *DeviceFarm) ListArtifactsPagesWithContext(ctx aws.Context, input *ListA
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListArtifactsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListArtifactsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -3072,14 +3521,13 @@ const opListDeviceInstances = "ListDeviceInstances"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListDeviceInstancesRequest method.
+//	req, resp := client.ListDeviceInstancesRequest(params)
 //
-//    // Example sending a request using the ListDeviceInstancesRequest method.
-//    req, resp := client.ListDeviceInstancesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListDeviceInstances
 func (c *DeviceFarm) ListDeviceInstancesRequest(input *ListDeviceInstancesInput) (req *request.Request, output *ListDeviceInstancesOutput) {
@@ -3110,18 +3558,19 @@ func (c *DeviceFarm) ListDeviceInstancesRequest(input *ListDeviceInstancesInput)
 // See the AWS API reference guide for AWS Device Farm's
 // API operation ListDeviceInstances for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListDeviceInstances
 func (c *DeviceFarm) ListDeviceInstances(input *ListDeviceInstancesInput) (*ListDeviceInstancesOutput, error) {
@@ -3161,14 +3610,13 @@ const opListDevicePools = "ListDevicePools"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListDevicePoolsRequest method.
+//	req, resp := client.ListDevicePoolsRequest(params)
 //
-//    // Example sending a request using the ListDevicePoolsRequest method.
-//    req, resp := client.ListDevicePoolsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListDevicePools
 func (c *DeviceFarm) ListDevicePoolsRequest(input *ListDevicePoolsInput) (req *request.Request, output *ListDevicePoolsOutput) {
@@ -3204,18 +3652,19 @@ func (c *DeviceFarm) ListDevicePoolsRequest(input *ListDevicePoolsInput) (req *r
 // See the AWS API reference guide for AWS Device Farm's
 // API operation ListDevicePools for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListDevicePools
 func (c *DeviceFarm) ListDevicePools(input *ListDevicePoolsInput) (*ListDevicePoolsOutput, error) {
@@ -3247,15 +3696,14 @@ func (c *DeviceFarm) ListDevicePoolsWithContext(ctx aws.Context, input *ListDevi
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListDevicePools operation.
-//    pageNum := 0
-//    err := client.ListDevicePoolsPages(params,
-//        func(page *devicefarm.ListDevicePoolsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListDevicePools operation.
+//	pageNum := 0
+//	err := client.ListDevicePoolsPages(params,
+//	    func(page *devicefarm.ListDevicePoolsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *DeviceFarm) ListDevicePoolsPages(input *ListDevicePoolsInput, fn func(*ListDevicePoolsOutput, bool) bool) error {
 	return c.ListDevicePoolsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -3282,10 +3730,12 @@ func (c *DeviceFarm) ListDevicePoolsPagesWithContext(ctx aws.Context, input *Lis
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListDevicePoolsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListDevicePoolsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -3305,14 +3755,13 @@ const opListDevices = "ListDevices"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListDevicesRequest method.
+//	req, resp := client.ListDevicesRequest(params)
 //
-//    // Example sending a request using the ListDevicesRequest method.
-//    req, resp := client.ListDevicesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListDevices
 func (c *DeviceFarm) ListDevicesRequest(input *ListDevicesInput) (req *request.Request, output *ListDevicesOutput) {
@@ -3348,18 +3797,19 @@ func (c *DeviceFarm) ListDevicesRequest(input *ListDevicesInput) (req *request.R
 // See the AWS API reference guide for AWS Device Farm's
 // API operation ListDevices for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListDevices
 func (c *DeviceFarm) ListDevices(input *ListDevicesInput) (*ListDevicesOutput, error) {
@@ -3391,15 +3841,14 @@ func (c *DeviceFarm) ListDevicesWithContext(ctx aws.Context, input *ListDevicesI
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListDevices operation.
-//    pageNum := 0
-//    err := client.ListDevicesPages(params,
-//        func(page *devicefarm.ListDevicesOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListDevices operation.
+//	pageNum := 0
+//	err := client.ListDevicesPages(params,
+//	    func(page *devicefarm.ListDevicesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *DeviceFarm) ListDevicesPages(input *ListDevicesInput, fn func(*ListDevicesOutput, bool) bool) error {
 	return c.ListDevicesPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -3426,10 +3875,12 @@ func (c *DeviceFarm) ListDevicesPagesWithContext(ctx aws.Context, input *ListDev
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListDevicesOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListDevicesOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -3449,14 +3900,13 @@ const opListInstanceProfiles = "ListInstanceProfiles"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListInstanceProfilesRequest method.
+//	req, resp := client.ListInstanceProfilesRequest(params)
 //
-//    // Example sending a request using the ListInstanceProfilesRequest method.
-//    req, resp := client.ListInstanceProfilesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListInstanceProfiles
 func (c *DeviceFarm) ListInstanceProfilesRequest(input *ListInstanceProfilesInput) (req *request.Request, output *ListInstanceProfilesOutput) {
@@ -3486,18 +3936,19 @@ func (c *DeviceFarm) ListInstanceProfilesRequest(input *ListInstanceProfilesInpu
 // See the AWS API reference guide for AWS Device Farm's
 // API operation ListInstanceProfiles for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListInstanceProfiles
 func (c *DeviceFarm) ListInstanceProfiles(input *ListInstanceProfilesInput) (*ListInstanceProfilesOutput, error) {
@@ -3537,14 +3988,13 @@ const opListJobs = "ListJobs"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListJobsRequest method.
+//	req, resp := client.ListJobsRequest(params)
 //
-//    // Example sending a request using the ListJobsRequest method.
-//    req, resp := client.ListJobsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListJobs
 func (c *DeviceFarm) ListJobsRequest(input *ListJobsInput) (req *request.Request, output *ListJobsOutput) {
@@ -3580,18 +4030,19 @@ func (c *DeviceFarm) ListJobsRequest(input *ListJobsInput) (req *request.Request
 // See the AWS API reference guide for AWS Device Farm's
 // API operation ListJobs for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListJobs
 func (c *DeviceFarm) ListJobs(input *ListJobsInput) (*ListJobsOutput, error) {
@@ -3623,15 +4074,14 @@ func (c *DeviceFarm) ListJobsWithContext(ctx aws.Context, input *ListJobsInput,
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListJobs operation.
-//    pageNum := 0
-//    err := client.ListJobsPages(params,
-//        func(page *devicefarm.ListJobsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListJobs operation.
+//	pageNum := 0
+//	err := client.ListJobsPages(params,
+//	    func(page *devicefarm.ListJobsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *DeviceFarm) ListJobsPages(input *ListJobsInput, fn func(*ListJobsOutput, bool) bool) error {
 	return c.ListJobsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -3658,10 +4108,12 @@ func (c *DeviceFarm) ListJobsPagesWithContext(ctx aws.Context, input *ListJobsIn
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListJobsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListJobsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -3681,14 +4133,13 @@ const opListNetworkProfiles = "ListNetworkProfiles"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListNetworkProfilesRequest method.
+//	req, resp := client.ListNetworkProfilesRequest(params)
 //
-//    // Example sending a request using the ListNetworkProfilesRequest method.
-//    req, resp := client.ListNetworkProfilesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListNetworkProfiles
 func (c *DeviceFarm) ListNetworkProfilesRequest(input *ListNetworkProfilesInput) (req *request.Request, output *ListNetworkProfilesOutput) {
@@ -3718,18 +4169,19 @@ func (c *DeviceFarm) ListNetworkProfilesRequest(input *ListNetworkProfilesInput)
 // See the AWS API reference guide for AWS Device Farm's
 // API operation ListNetworkProfiles for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListNetworkProfiles
 func (c *DeviceFarm) ListNetworkProfiles(input *ListNetworkProfilesInput) (*ListNetworkProfilesOutput, error) {
@@ -3769,14 +4221,13 @@ const opListOfferingPromotions = "ListOfferingPromotions"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListOfferingPromotionsRequest method.
+//	req, resp := client.ListOfferingPromotionsRequest(params)
 //
-//    // Example sending a request using the ListOfferingPromotionsRequest method.
-//    req, resp := client.ListOfferingPromotionsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListOfferingPromotions
 func (c *DeviceFarm) ListOfferingPromotionsRequest(input *ListOfferingPromotionsInput) (req *request.Request, output *ListOfferingPromotionsOutput) {
@@ -3800,8 +4251,8 @@ func (c *DeviceFarm) ListOfferingPromotionsRequest(input *ListOfferingPromotions
 // Returns a list of offering promotions. Each offering promotion record contains
 // the ID and description of the promotion. The API returns a NotEligible error
 // if the caller is not permitted to invoke the operation. Contact aws-devicefarm-support@amazon.com
-// (mailto:aws-devicefarm-support@amazon.com) if you believe that you should
-// be able to invoke this operation.
+// (mailto:aws-devicefarm-support@amazon.com) if you must be able to invoke
+// this operation.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -3810,22 +4261,23 @@ func (c *DeviceFarm) ListOfferingPromotionsRequest(input *ListOfferingPromotions
 // See the AWS API reference guide for AWS Device Farm's
 // API operation ListOfferingPromotions for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotEligibleException "NotEligibleException"
-//   Exception gets thrown when a user is not eligible to perform the specified
-//   transaction.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotEligibleException
+//     Exception gets thrown when a user is not eligible to perform the specified
+//     transaction.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListOfferingPromotions
 func (c *DeviceFarm) ListOfferingPromotions(input *ListOfferingPromotionsInput) (*ListOfferingPromotionsOutput, error) {
@@ -3865,14 +4317,13 @@ const opListOfferingTransactions = "ListOfferingTransactions"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListOfferingTransactionsRequest method.
+//	req, resp := client.ListOfferingTransactionsRequest(params)
 //
-//    // Example sending a request using the ListOfferingTransactionsRequest method.
-//    req, resp := client.ListOfferingTransactionsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListOfferingTransactions
 func (c *DeviceFarm) ListOfferingTransactionsRequest(input *ListOfferingTransactionsInput) (req *request.Request, output *ListOfferingTransactionsOutput) {
@@ -3902,9 +4353,9 @@ func (c *DeviceFarm) ListOfferingTransactionsRequest(input *ListOfferingTransact
 // Returns a list of all historical purchases, renewals, and system renewal
 // transactions for an AWS account. The list is paginated and ordered by a descending
 // timestamp (most recent transactions are first). The API returns a NotEligible
-// error if the user is not permitted to invoke the operation. Please contact
-// aws-devicefarm-support@amazon.com (mailto:aws-devicefarm-support@amazon.com)
-// if you believe that you should be able to invoke this operation.
+// error if the user is not permitted to invoke the operation. If you must be
+// able to invoke this operation, contact aws-devicefarm-support@amazon.com
+// (mailto:aws-devicefarm-support@amazon.com).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -3913,22 +4364,23 @@ func (c *DeviceFarm) ListOfferingTransactionsRequest(input *ListOfferingTransact
 // See the AWS API reference guide for AWS Device Farm's
 // API operation ListOfferingTransactions for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotEligibleException "NotEligibleException"
-//   Exception gets thrown when a user is not eligible to perform the specified
-//   transaction.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotEligibleException
+//     Exception gets thrown when a user is not eligible to perform the specified
+//     transaction.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListOfferingTransactions
 func (c *DeviceFarm) ListOfferingTransactions(input *ListOfferingTransactionsInput) (*ListOfferingTransactionsOutput, error) {
@@ -3960,15 +4412,14 @@ func (c *DeviceFarm) ListOfferingTransactionsWithContext(ctx aws.Context, input
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListOfferingTransactions operation.
-//    pageNum := 0
-//    err := client.ListOfferingTransactionsPages(params,
-//        func(page *devicefarm.ListOfferingTransactionsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListOfferingTransactions operation.
+//	pageNum := 0
+//	err := client.ListOfferingTransactionsPages(params,
+//	    func(page *devicefarm.ListOfferingTransactionsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *DeviceFarm) ListOfferingTransactionsPages(input *ListOfferingTransactionsInput, fn func(*ListOfferingTransactionsOutput, bool) bool) error {
 	return c.ListOfferingTransactionsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -3995,10 +4446,12 @@ func (c *DeviceFarm) ListOfferingTransactionsPagesWithContext(ctx aws.Context, i
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListOfferingTransactionsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListOfferingTransactionsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -4018,14 +4471,13 @@ const opListOfferings = "ListOfferings"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListOfferingsRequest method.
+//	req, resp := client.ListOfferingsRequest(params)
 //
-//    // Example sending a request using the ListOfferingsRequest method.
-//    req, resp := client.ListOfferingsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListOfferings
 func (c *DeviceFarm) ListOfferingsRequest(input *ListOfferingsInput) (req *request.Request, output *ListOfferingsOutput) {
@@ -4055,9 +4507,8 @@ func (c *DeviceFarm) ListOfferingsRequest(input *ListOfferingsInput) (req *reque
 // Returns a list of products or offerings that the user can manage through
 // the API. Each offering record indicates the recurring price per unit and
 // the frequency for that offering. The API returns a NotEligible error if the
-// user is not permitted to invoke the operation. Please contact aws-devicefarm-support@amazon.com
-// (mailto:aws-devicefarm-support@amazon.com) if you believe that you should
-// be able to invoke this operation.
+// user is not permitted to invoke the operation. If you must be able to invoke
+// this operation, contact aws-devicefarm-support@amazon.com (mailto:aws-devicefarm-support@amazon.com).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -4066,22 +4517,23 @@ func (c *DeviceFarm) ListOfferingsRequest(input *ListOfferingsInput) (req *reque
 // See the AWS API reference guide for AWS Device Farm's
 // API operation ListOfferings for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeNotEligibleException "NotEligibleException"
-//   Exception gets thrown when a user is not eligible to perform the specified
-//   transaction.
+//   - NotEligibleException
+//     Exception gets thrown when a user is not eligible to perform the specified
+//     transaction.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListOfferings
 func (c *DeviceFarm) ListOfferings(input *ListOfferingsInput) (*ListOfferingsOutput, error) {
@@ -4113,15 +4565,14 @@ func (c *DeviceFarm) ListOfferingsWithContext(ctx aws.Context, input *ListOfferi
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListOfferings operation.
-//    pageNum := 0
-//    err := client.ListOfferingsPages(params,
-//        func(page *devicefarm.ListOfferingsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListOfferings operation.
+//	pageNum := 0
+//	err := client.ListOfferingsPages(params,
+//	    func(page *devicefarm.ListOfferingsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *DeviceFarm) ListOfferingsPages(input *ListOfferingsInput, fn func(*ListOfferingsOutput, bool) bool) error {
 	return c.ListOfferingsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -4148,10 +4599,12 @@ func (c *DeviceFarm) ListOfferingsPagesWithContext(ctx aws.Context, input *ListO
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListOfferingsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListOfferingsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -4171,14 +4624,13 @@ const opListProjects = "ListProjects"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListProjectsRequest method.
+//	req, resp := client.ListProjectsRequest(params)
 //
-//    // Example sending a request using the ListProjectsRequest method.
-//    req, resp := client.ListProjectsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListProjects
 func (c *DeviceFarm) ListProjectsRequest(input *ListProjectsInput) (req *request.Request, output *ListProjectsOutput) {
@@ -4214,18 +4666,19 @@ func (c *DeviceFarm) ListProjectsRequest(input *ListProjectsInput) (req *request
 // See the AWS API reference guide for AWS Device Farm's
 // API operation ListProjects for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListProjects
 func (c *DeviceFarm) ListProjects(input *ListProjectsInput) (*ListProjectsOutput, error) {
@@ -4257,15 +4710,14 @@ func (c *DeviceFarm) ListProjectsWithContext(ctx aws.Context, input *ListProject
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListProjects operation.
-//    pageNum := 0
-//    err := client.ListProjectsPages(params,
-//        func(page *devicefarm.ListProjectsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListProjects operation.
+//	pageNum := 0
+//	err := client.ListProjectsPages(params,
+//	    func(page *devicefarm.ListProjectsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *DeviceFarm) ListProjectsPages(input *ListProjectsInput, fn func(*ListProjectsOutput, bool) bool) error {
 	return c.ListProjectsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -4292,10 +4744,12 @@ func (c *DeviceFarm) ListProjectsPagesWithContext(ctx aws.Context, input *ListPr
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListProjectsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListProjectsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -4315,14 +4769,13 @@ const opListRemoteAccessSessions = "ListRemoteAccessSessions"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListRemoteAccessSessionsRequest method.
+//	req, resp := client.ListRemoteAccessSessionsRequest(params)
 //
-//    // Example sending a request using the ListRemoteAccessSessionsRequest method.
-//    req, resp := client.ListRemoteAccessSessionsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListRemoteAccessSessions
 func (c *DeviceFarm) ListRemoteAccessSessionsRequest(input *ListRemoteAccessSessionsInput) (req *request.Request, output *ListRemoteAccessSessionsOutput) {
@@ -4352,18 +4805,19 @@ func (c *DeviceFarm) ListRemoteAccessSessionsRequest(input *ListRemoteAccessSess
 // See the AWS API reference guide for AWS Device Farm's
 // API operation ListRemoteAccessSessions for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListRemoteAccessSessions
 func (c *DeviceFarm) ListRemoteAccessSessions(input *ListRemoteAccessSessionsInput) (*ListRemoteAccessSessionsOutput, error) {
@@ -4403,14 +4857,13 @@ const opListRuns = "ListRuns"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListRunsRequest method.
+//	req, resp := client.ListRunsRequest(params)
 //
-//    // Example sending a request using the ListRunsRequest method.
-//    req, resp := client.ListRunsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListRuns
 func (c *DeviceFarm) ListRunsRequest(input *ListRunsInput) (req *request.Request, output *ListRunsOutput) {
@@ -4446,18 +4899,19 @@ func (c *DeviceFarm) ListRunsRequest(input *ListRunsInput) (req *request.Request
 // See the AWS API reference guide for AWS Device Farm's
 // API operation ListRuns for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListRuns
 func (c *DeviceFarm) ListRuns(input *ListRunsInput) (*ListRunsOutput, error) {
@@ -4489,15 +4943,14 @@ func (c *DeviceFarm) ListRunsWithContext(ctx aws.Context, input *ListRunsInput,
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListRuns operation.
-//    pageNum := 0
-//    err := client.ListRunsPages(params,
-//        func(page *devicefarm.ListRunsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListRuns operation.
+//	pageNum := 0
+//	err := client.ListRunsPages(params,
+//	    func(page *devicefarm.ListRunsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *DeviceFarm) ListRunsPages(input *ListRunsInput, fn func(*ListRunsOutput, bool) bool) error {
 	return c.ListRunsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -4524,10 +4977,12 @@ func (c *DeviceFarm) ListRunsPagesWithContext(ctx aws.Context, input *ListRunsIn
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListRunsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListRunsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -4547,14 +5002,13 @@ const opListSamples = "ListSamples"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListSamplesRequest method.
+//	req, resp := client.ListSamplesRequest(params)
 //
-//    // Example sending a request using the ListSamplesRequest method.
-//    req, resp := client.ListSamplesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListSamples
 func (c *DeviceFarm) ListSamplesRequest(input *ListSamplesInput) (req *request.Request, output *ListSamplesOutput) {
@@ -4590,18 +5044,19 @@ func (c *DeviceFarm) ListSamplesRequest(input *ListSamplesInput) (req *request.R
 // See the AWS API reference guide for AWS Device Farm's
 // API operation ListSamples for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListSamples
 func (c *DeviceFarm) ListSamples(input *ListSamplesInput) (*ListSamplesOutput, error) {
@@ -4633,15 +5088,14 @@ func (c *DeviceFarm) ListSamplesWithContext(ctx aws.Context, input *ListSamplesI
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListSamples operation.
-//    pageNum := 0
-//    err := client.ListSamplesPages(params,
-//        func(page *devicefarm.ListSamplesOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListSamples operation.
+//	pageNum := 0
+//	err := client.ListSamplesPages(params,
+//	    func(page *devicefarm.ListSamplesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *DeviceFarm) ListSamplesPages(input *ListSamplesInput, fn func(*ListSamplesOutput, bool) bool) error {
 	return c.ListSamplesPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -4668,10 +5122,12 @@ func (c *DeviceFarm) ListSamplesPagesWithContext(ctx aws.Context, input *ListSam
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListSamplesOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListSamplesOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -4691,14 +5147,13 @@ const opListSuites = "ListSuites"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListSuitesRequest method.
+//	req, resp := client.ListSuitesRequest(params)
 //
-//    // Example sending a request using the ListSuitesRequest method.
-//    req, resp := client.ListSuitesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListSuites
 func (c *DeviceFarm) ListSuitesRequest(input *ListSuitesInput) (req *request.Request, output *ListSuitesOutput) {
@@ -4734,18 +5189,19 @@ func (c *DeviceFarm) ListSuitesRequest(input *ListSuitesInput) (req *request.Req
 // See the AWS API reference guide for AWS Device Farm's
 // API operation ListSuites for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListSuites
 func (c *DeviceFarm) ListSuites(input *ListSuitesInput) (*ListSuitesOutput, error) {
@@ -4777,15 +5233,14 @@ func (c *DeviceFarm) ListSuitesWithContext(ctx aws.Context, input *ListSuitesInp
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListSuites operation.
-//    pageNum := 0
-//    err := client.ListSuitesPages(params,
-//        func(page *devicefarm.ListSuitesOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListSuites operation.
+//	pageNum := 0
+//	err := client.ListSuitesPages(params,
+//	    func(page *devicefarm.ListSuitesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *DeviceFarm) ListSuitesPages(input *ListSuitesInput, fn func(*ListSuitesOutput, bool) bool) error {
 	return c.ListSuitesPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -4812,10 +5267,12 @@ func (c *DeviceFarm) ListSuitesPagesWithContext(ctx aws.Context, input *ListSuit
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListSuitesOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListSuitesOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -4835,14 +5292,13 @@ const opListTagsForResource = "ListTagsForResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTagsForResourceRequest method.
+//	req, resp := client.ListTagsForResourceRequest(params)
 //
-//    // Example sending a request using the ListTagsForResourceRequest method.
-//    req, resp := client.ListTagsForResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListTagsForResource
 func (c *DeviceFarm) ListTagsForResourceRequest(input *ListTagsForResourceInput) (req *request.Request, output *ListTagsForResourceOutput) {
@@ -4872,12 +5328,16 @@ func (c *DeviceFarm) ListTagsForResourceRequest(input *ListTagsForResourceInput)
 // See the AWS API reference guide for AWS Device Farm's
 // API operation ListTagsForResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
+//
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeTagOperationException "TagOperationException"
-//   The operation was not successful. Try again.
+//   - TagOperationException
+//     The operation was not successful. Try again.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListTagsForResource
 func (c *DeviceFarm) ListTagsForResource(input *ListTagsForResourceInput) (*ListTagsForResourceOutput, error) {
@@ -4901,2273 +5361,4622 @@ func (c *DeviceFarm) ListTagsForResourceWithContext(ctx aws.Context, input *List
 	return out, req.Send()
 }
 
-const opListTests = "ListTests"
+const opListTestGridProjects = "ListTestGridProjects"
 
-// ListTestsRequest generates a "aws/request.Request" representing the
-// client's request for the ListTests operation. The "output" return
+// ListTestGridProjectsRequest generates a "aws/request.Request" representing the
+// client's request for the ListTestGridProjects operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListTests for more information on using the ListTests
+// See ListTestGridProjects for more information on using the ListTestGridProjects
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTestGridProjectsRequest method.
+//	req, resp := client.ListTestGridProjectsRequest(params)
 //
-//    // Example sending a request using the ListTestsRequest method.
-//    req, resp := client.ListTestsRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListTests
-func (c *DeviceFarm) ListTestsRequest(input *ListTestsInput) (req *request.Request, output *ListTestsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListTestGridProjects
+func (c *DeviceFarm) ListTestGridProjectsRequest(input *ListTestGridProjectsInput) (req *request.Request, output *ListTestGridProjectsOutput) {
 	op := &request.Operation{
-		Name:       opListTests,
+		Name:       opListTestGridProjects,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 		Paginator: &request.Paginator{
 			InputTokens:     []string{"nextToken"},
 			OutputTokens:    []string{"nextToken"},
-			LimitToken:      "",
+			LimitToken:      "maxResult",
 			TruncationToken: "",
 		},
 	}
 
 	if input == nil {
-		input = &ListTestsInput{}
+		input = &ListTestGridProjectsInput{}
 	}
 
-	output = &ListTestsOutput{}
+	output = &ListTestGridProjectsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListTests API operation for AWS Device Farm.
+// ListTestGridProjects API operation for AWS Device Farm.
 //
-// Gets information about tests in a given test suite.
+// Gets a list of all Selenium testing projects in your account.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Device Farm's
-// API operation ListTests for usage and error information.
+// API operation ListTestGridProjects for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - InternalServiceException
+//     An internal exception was raised in the service. Contact aws-devicefarm-support@amazon.com
+//     (mailto:aws-devicefarm-support@amazon.com) if you see this error.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListTests
-func (c *DeviceFarm) ListTests(input *ListTestsInput) (*ListTestsOutput, error) {
-	req, out := c.ListTestsRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListTestGridProjects
+func (c *DeviceFarm) ListTestGridProjects(input *ListTestGridProjectsInput) (*ListTestGridProjectsOutput, error) {
+	req, out := c.ListTestGridProjectsRequest(input)
 	return out, req.Send()
 }
 
-// ListTestsWithContext is the same as ListTests with the addition of
+// ListTestGridProjectsWithContext is the same as ListTestGridProjects with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListTests for details on how to use this API operation.
+// See ListTestGridProjects for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DeviceFarm) ListTestsWithContext(ctx aws.Context, input *ListTestsInput, opts ...request.Option) (*ListTestsOutput, error) {
-	req, out := c.ListTestsRequest(input)
+func (c *DeviceFarm) ListTestGridProjectsWithContext(ctx aws.Context, input *ListTestGridProjectsInput, opts ...request.Option) (*ListTestGridProjectsOutput, error) {
+	req, out := c.ListTestGridProjectsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-// ListTestsPages iterates over the pages of a ListTests operation,
+// ListTestGridProjectsPages iterates over the pages of a ListTestGridProjects operation,
 // calling the "fn" function with the response data for each page. To stop
 // iterating, return false from the fn function.
 //
-// See ListTests method for more information on how to use this operation.
+// See ListTestGridProjects method for more information on how to use this operation.
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListTests operation.
-//    pageNum := 0
-//    err := client.ListTestsPages(params,
-//        func(page *devicefarm.ListTestsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
-func (c *DeviceFarm) ListTestsPages(input *ListTestsInput, fn func(*ListTestsOutput, bool) bool) error {
-	return c.ListTestsPagesWithContext(aws.BackgroundContext(), input, fn)
+//	// Example iterating over at most 3 pages of a ListTestGridProjects operation.
+//	pageNum := 0
+//	err := client.ListTestGridProjectsPages(params,
+//	    func(page *devicefarm.ListTestGridProjectsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *DeviceFarm) ListTestGridProjectsPages(input *ListTestGridProjectsInput, fn func(*ListTestGridProjectsOutput, bool) bool) error {
+	return c.ListTestGridProjectsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
 
-// ListTestsPagesWithContext same as ListTestsPages except
+// ListTestGridProjectsPagesWithContext same as ListTestGridProjectsPages except
 // it takes a Context and allows setting request options on the pages.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DeviceFarm) ListTestsPagesWithContext(ctx aws.Context, input *ListTestsInput, fn func(*ListTestsOutput, bool) bool, opts ...request.Option) error {
+func (c *DeviceFarm) ListTestGridProjectsPagesWithContext(ctx aws.Context, input *ListTestGridProjectsInput, fn func(*ListTestGridProjectsOutput, bool) bool, opts ...request.Option) error {
 	p := request.Pagination{
 		NewRequest: func() (*request.Request, error) {
-			var inCpy *ListTestsInput
+			var inCpy *ListTestGridProjectsInput
 			if input != nil {
 				tmp := *input
 				inCpy = &tmp
 			}
-			req, _ := c.ListTestsRequest(inCpy)
+			req, _ := c.ListTestGridProjectsRequest(inCpy)
 			req.SetContext(ctx)
 			req.ApplyOptions(opts...)
 			return req, nil
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListTestsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListTestGridProjectsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
-const opListUniqueProblems = "ListUniqueProblems"
+const opListTestGridSessionActions = "ListTestGridSessionActions"
 
-// ListUniqueProblemsRequest generates a "aws/request.Request" representing the
-// client's request for the ListUniqueProblems operation. The "output" return
+// ListTestGridSessionActionsRequest generates a "aws/request.Request" representing the
+// client's request for the ListTestGridSessionActions operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListUniqueProblems for more information on using the ListUniqueProblems
+// See ListTestGridSessionActions for more information on using the ListTestGridSessionActions
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTestGridSessionActionsRequest method.
+//	req, resp := client.ListTestGridSessionActionsRequest(params)
 //
-//    // Example sending a request using the ListUniqueProblemsRequest method.
-//    req, resp := client.ListUniqueProblemsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListUniqueProblems
-func (c *DeviceFarm) ListUniqueProblemsRequest(input *ListUniqueProblemsInput) (req *request.Request, output *ListUniqueProblemsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListTestGridSessionActions
+func (c *DeviceFarm) ListTestGridSessionActionsRequest(input *ListTestGridSessionActionsInput) (req *request.Request, output *ListTestGridSessionActionsOutput) {
 	op := &request.Operation{
-		Name:       opListUniqueProblems,
+		Name:       opListTestGridSessionActions,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 		Paginator: &request.Paginator{
 			InputTokens:     []string{"nextToken"},
 			OutputTokens:    []string{"nextToken"},
-			LimitToken:      "",
+			LimitToken:      "maxResult",
 			TruncationToken: "",
 		},
 	}
 
 	if input == nil {
-		input = &ListUniqueProblemsInput{}
+		input = &ListTestGridSessionActionsInput{}
 	}
 
-	output = &ListUniqueProblemsOutput{}
+	output = &ListTestGridSessionActionsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListUniqueProblems API operation for AWS Device Farm.
+// ListTestGridSessionActions API operation for AWS Device Farm.
 //
-// Gets information about unique problems.
+// Returns a list of the actions taken in a TestGridSession.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Device Farm's
-// API operation ListUniqueProblems for usage and error information.
+// API operation ListTestGridSessionActions for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - InternalServiceException
+//     An internal exception was raised in the service. Contact aws-devicefarm-support@amazon.com
+//     (mailto:aws-devicefarm-support@amazon.com) if you see this error.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListUniqueProblems
-func (c *DeviceFarm) ListUniqueProblems(input *ListUniqueProblemsInput) (*ListUniqueProblemsOutput, error) {
-	req, out := c.ListUniqueProblemsRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListTestGridSessionActions
+func (c *DeviceFarm) ListTestGridSessionActions(input *ListTestGridSessionActionsInput) (*ListTestGridSessionActionsOutput, error) {
+	req, out := c.ListTestGridSessionActionsRequest(input)
 	return out, req.Send()
 }
 
-// ListUniqueProblemsWithContext is the same as ListUniqueProblems with the addition of
+// ListTestGridSessionActionsWithContext is the same as ListTestGridSessionActions with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListUniqueProblems for details on how to use this API operation.
+// See ListTestGridSessionActions for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DeviceFarm) ListUniqueProblemsWithContext(ctx aws.Context, input *ListUniqueProblemsInput, opts ...request.Option) (*ListUniqueProblemsOutput, error) {
-	req, out := c.ListUniqueProblemsRequest(input)
+func (c *DeviceFarm) ListTestGridSessionActionsWithContext(ctx aws.Context, input *ListTestGridSessionActionsInput, opts ...request.Option) (*ListTestGridSessionActionsOutput, error) {
+	req, out := c.ListTestGridSessionActionsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-// ListUniqueProblemsPages iterates over the pages of a ListUniqueProblems operation,
+// ListTestGridSessionActionsPages iterates over the pages of a ListTestGridSessionActions operation,
 // calling the "fn" function with the response data for each page. To stop
 // iterating, return false from the fn function.
 //
-// See ListUniqueProblems method for more information on how to use this operation.
+// See ListTestGridSessionActions method for more information on how to use this operation.
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListUniqueProblems operation.
-//    pageNum := 0
-//    err := client.ListUniqueProblemsPages(params,
-//        func(page *devicefarm.ListUniqueProblemsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
-func (c *DeviceFarm) ListUniqueProblemsPages(input *ListUniqueProblemsInput, fn func(*ListUniqueProblemsOutput, bool) bool) error {
-	return c.ListUniqueProblemsPagesWithContext(aws.BackgroundContext(), input, fn)
+//	// Example iterating over at most 3 pages of a ListTestGridSessionActions operation.
+//	pageNum := 0
+//	err := client.ListTestGridSessionActionsPages(params,
+//	    func(page *devicefarm.ListTestGridSessionActionsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *DeviceFarm) ListTestGridSessionActionsPages(input *ListTestGridSessionActionsInput, fn func(*ListTestGridSessionActionsOutput, bool) bool) error {
+	return c.ListTestGridSessionActionsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
 
-// ListUniqueProblemsPagesWithContext same as ListUniqueProblemsPages except
+// ListTestGridSessionActionsPagesWithContext same as ListTestGridSessionActionsPages except
 // it takes a Context and allows setting request options on the pages.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DeviceFarm) ListUniqueProblemsPagesWithContext(ctx aws.Context, input *ListUniqueProblemsInput, fn func(*ListUniqueProblemsOutput, bool) bool, opts ...request.Option) error {
+func (c *DeviceFarm) ListTestGridSessionActionsPagesWithContext(ctx aws.Context, input *ListTestGridSessionActionsInput, fn func(*ListTestGridSessionActionsOutput, bool) bool, opts ...request.Option) error {
 	p := request.Pagination{
 		NewRequest: func() (*request.Request, error) {
-			var inCpy *ListUniqueProblemsInput
+			var inCpy *ListTestGridSessionActionsInput
 			if input != nil {
 				tmp := *input
 				inCpy = &tmp
 			}
-			req, _ := c.ListUniqueProblemsRequest(inCpy)
+			req, _ := c.ListTestGridSessionActionsRequest(inCpy)
 			req.SetContext(ctx)
 			req.ApplyOptions(opts...)
 			return req, nil
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListUniqueProblemsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListTestGridSessionActionsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
-const opListUploads = "ListUploads"
+const opListTestGridSessionArtifacts = "ListTestGridSessionArtifacts"
 
-// ListUploadsRequest generates a "aws/request.Request" representing the
-// client's request for the ListUploads operation. The "output" return
+// ListTestGridSessionArtifactsRequest generates a "aws/request.Request" representing the
+// client's request for the ListTestGridSessionArtifacts operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListUploads for more information on using the ListUploads
+// See ListTestGridSessionArtifacts for more information on using the ListTestGridSessionArtifacts
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTestGridSessionArtifactsRequest method.
+//	req, resp := client.ListTestGridSessionArtifactsRequest(params)
 //
-//    // Example sending a request using the ListUploadsRequest method.
-//    req, resp := client.ListUploadsRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListUploads
-func (c *DeviceFarm) ListUploadsRequest(input *ListUploadsInput) (req *request.Request, output *ListUploadsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListTestGridSessionArtifacts
+func (c *DeviceFarm) ListTestGridSessionArtifactsRequest(input *ListTestGridSessionArtifactsInput) (req *request.Request, output *ListTestGridSessionArtifactsOutput) {
 	op := &request.Operation{
-		Name:       opListUploads,
+		Name:       opListTestGridSessionArtifacts,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 		Paginator: &request.Paginator{
 			InputTokens:     []string{"nextToken"},
 			OutputTokens:    []string{"nextToken"},
-			LimitToken:      "",
+			LimitToken:      "maxResult",
 			TruncationToken: "",
 		},
 	}
 
 	if input == nil {
-		input = &ListUploadsInput{}
+		input = &ListTestGridSessionArtifactsInput{}
 	}
 
-	output = &ListUploadsOutput{}
+	output = &ListTestGridSessionArtifactsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListUploads API operation for AWS Device Farm.
+// ListTestGridSessionArtifacts API operation for AWS Device Farm.
 //
-// Gets information about uploads, given an AWS Device Farm project ARN.
+// Retrieves a list of artifacts created during the session.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Device Farm's
-// API operation ListUploads for usage and error information.
+// API operation ListTestGridSessionArtifacts for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - InternalServiceException
+//     An internal exception was raised in the service. Contact aws-devicefarm-support@amazon.com
+//     (mailto:aws-devicefarm-support@amazon.com) if you see this error.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListUploads
-func (c *DeviceFarm) ListUploads(input *ListUploadsInput) (*ListUploadsOutput, error) {
-	req, out := c.ListUploadsRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListTestGridSessionArtifacts
+func (c *DeviceFarm) ListTestGridSessionArtifacts(input *ListTestGridSessionArtifactsInput) (*ListTestGridSessionArtifactsOutput, error) {
+	req, out := c.ListTestGridSessionArtifactsRequest(input)
 	return out, req.Send()
 }
 
-// ListUploadsWithContext is the same as ListUploads with the addition of
+// ListTestGridSessionArtifactsWithContext is the same as ListTestGridSessionArtifacts with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListUploads for details on how to use this API operation.
+// See ListTestGridSessionArtifacts for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DeviceFarm) ListUploadsWithContext(ctx aws.Context, input *ListUploadsInput, opts ...request.Option) (*ListUploadsOutput, error) {
-	req, out := c.ListUploadsRequest(input)
+func (c *DeviceFarm) ListTestGridSessionArtifactsWithContext(ctx aws.Context, input *ListTestGridSessionArtifactsInput, opts ...request.Option) (*ListTestGridSessionArtifactsOutput, error) {
+	req, out := c.ListTestGridSessionArtifactsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-// ListUploadsPages iterates over the pages of a ListUploads operation,
+// ListTestGridSessionArtifactsPages iterates over the pages of a ListTestGridSessionArtifacts operation,
 // calling the "fn" function with the response data for each page. To stop
 // iterating, return false from the fn function.
 //
-// See ListUploads method for more information on how to use this operation.
+// See ListTestGridSessionArtifacts method for more information on how to use this operation.
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListUploads operation.
-//    pageNum := 0
-//    err := client.ListUploadsPages(params,
-//        func(page *devicefarm.ListUploadsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
-func (c *DeviceFarm) ListUploadsPages(input *ListUploadsInput, fn func(*ListUploadsOutput, bool) bool) error {
-	return c.ListUploadsPagesWithContext(aws.BackgroundContext(), input, fn)
+//	// Example iterating over at most 3 pages of a ListTestGridSessionArtifacts operation.
+//	pageNum := 0
+//	err := client.ListTestGridSessionArtifactsPages(params,
+//	    func(page *devicefarm.ListTestGridSessionArtifactsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *DeviceFarm) ListTestGridSessionArtifactsPages(input *ListTestGridSessionArtifactsInput, fn func(*ListTestGridSessionArtifactsOutput, bool) bool) error {
+	return c.ListTestGridSessionArtifactsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
 
-// ListUploadsPagesWithContext same as ListUploadsPages except
+// ListTestGridSessionArtifactsPagesWithContext same as ListTestGridSessionArtifactsPages except
 // it takes a Context and allows setting request options on the pages.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DeviceFarm) ListUploadsPagesWithContext(ctx aws.Context, input *ListUploadsInput, fn func(*ListUploadsOutput, bool) bool, opts ...request.Option) error {
+func (c *DeviceFarm) ListTestGridSessionArtifactsPagesWithContext(ctx aws.Context, input *ListTestGridSessionArtifactsInput, fn func(*ListTestGridSessionArtifactsOutput, bool) bool, opts ...request.Option) error {
 	p := request.Pagination{
 		NewRequest: func() (*request.Request, error) {
-			var inCpy *ListUploadsInput
+			var inCpy *ListTestGridSessionArtifactsInput
 			if input != nil {
 				tmp := *input
 				inCpy = &tmp
 			}
-			req, _ := c.ListUploadsRequest(inCpy)
+			req, _ := c.ListTestGridSessionArtifactsRequest(inCpy)
 			req.SetContext(ctx)
 			req.ApplyOptions(opts...)
 			return req, nil
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListUploadsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListTestGridSessionArtifactsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
-const opListVPCEConfigurations = "ListVPCEConfigurations"
+const opListTestGridSessions = "ListTestGridSessions"
 
-// ListVPCEConfigurationsRequest generates a "aws/request.Request" representing the
-// client's request for the ListVPCEConfigurations operation. The "output" return
+// ListTestGridSessionsRequest generates a "aws/request.Request" representing the
+// client's request for the ListTestGridSessions operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListVPCEConfigurations for more information on using the ListVPCEConfigurations
+// See ListTestGridSessions for more information on using the ListTestGridSessions
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTestGridSessionsRequest method.
+//	req, resp := client.ListTestGridSessionsRequest(params)
 //
-//    // Example sending a request using the ListVPCEConfigurationsRequest method.
-//    req, resp := client.ListVPCEConfigurationsRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListVPCEConfigurations
-func (c *DeviceFarm) ListVPCEConfigurationsRequest(input *ListVPCEConfigurationsInput) (req *request.Request, output *ListVPCEConfigurationsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListTestGridSessions
+func (c *DeviceFarm) ListTestGridSessionsRequest(input *ListTestGridSessionsInput) (req *request.Request, output *ListTestGridSessionsOutput) {
 	op := &request.Operation{
-		Name:       opListVPCEConfigurations,
+		Name:       opListTestGridSessions,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"nextToken"},
+			OutputTokens:    []string{"nextToken"},
+			LimitToken:      "maxResult",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &ListVPCEConfigurationsInput{}
+		input = &ListTestGridSessionsInput{}
 	}
 
-	output = &ListVPCEConfigurationsOutput{}
+	output = &ListTestGridSessionsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListVPCEConfigurations API operation for AWS Device Farm.
+// ListTestGridSessions API operation for AWS Device Farm.
 //
-// Returns information about all Amazon Virtual Private Cloud (VPC) endpoint
-// configurations in the AWS account.
+// Retrieves a list of sessions for a TestGridProject.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Device Farm's
-// API operation ListVPCEConfigurations for usage and error information.
+// API operation ListTestGridSessions for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListVPCEConfigurations
-func (c *DeviceFarm) ListVPCEConfigurations(input *ListVPCEConfigurationsInput) (*ListVPCEConfigurationsOutput, error) {
-	req, out := c.ListVPCEConfigurationsRequest(input)
+//   - ArgumentException
+//     An invalid argument was specified.
+//
+//   - InternalServiceException
+//     An internal exception was raised in the service. Contact aws-devicefarm-support@amazon.com
+//     (mailto:aws-devicefarm-support@amazon.com) if you see this error.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListTestGridSessions
+func (c *DeviceFarm) ListTestGridSessions(input *ListTestGridSessionsInput) (*ListTestGridSessionsOutput, error) {
+	req, out := c.ListTestGridSessionsRequest(input)
 	return out, req.Send()
 }
 
-// ListVPCEConfigurationsWithContext is the same as ListVPCEConfigurations with the addition of
+// ListTestGridSessionsWithContext is the same as ListTestGridSessions with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListVPCEConfigurations for details on how to use this API operation.
+// See ListTestGridSessions for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DeviceFarm) ListVPCEConfigurationsWithContext(ctx aws.Context, input *ListVPCEConfigurationsInput, opts ...request.Option) (*ListVPCEConfigurationsOutput, error) {
-	req, out := c.ListVPCEConfigurationsRequest(input)
+func (c *DeviceFarm) ListTestGridSessionsWithContext(ctx aws.Context, input *ListTestGridSessionsInput, opts ...request.Option) (*ListTestGridSessionsOutput, error) {
+	req, out := c.ListTestGridSessionsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opPurchaseOffering = "PurchaseOffering"
+// ListTestGridSessionsPages iterates over the pages of a ListTestGridSessions operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListTestGridSessions method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListTestGridSessions operation.
+//	pageNum := 0
+//	err := client.ListTestGridSessionsPages(params,
+//	    func(page *devicefarm.ListTestGridSessionsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *DeviceFarm) ListTestGridSessionsPages(input *ListTestGridSessionsInput, fn func(*ListTestGridSessionsOutput, bool) bool) error {
+	return c.ListTestGridSessionsPagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-// PurchaseOfferingRequest generates a "aws/request.Request" representing the
-// client's request for the PurchaseOffering operation. The "output" return
+// ListTestGridSessionsPagesWithContext same as ListTestGridSessionsPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DeviceFarm) ListTestGridSessionsPagesWithContext(ctx aws.Context, input *ListTestGridSessionsInput, fn func(*ListTestGridSessionsOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListTestGridSessionsInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListTestGridSessionsRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListTestGridSessionsOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListTests = "ListTests"
+
+// ListTestsRequest generates a "aws/request.Request" representing the
+// client's request for the ListTests operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See PurchaseOffering for more information on using the PurchaseOffering
+// See ListTests for more information on using the ListTests
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTestsRequest method.
+//	req, resp := client.ListTestsRequest(params)
 //
-//    // Example sending a request using the PurchaseOfferingRequest method.
-//    req, resp := client.PurchaseOfferingRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/PurchaseOffering
-func (c *DeviceFarm) PurchaseOfferingRequest(input *PurchaseOfferingInput) (req *request.Request, output *PurchaseOfferingOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListTests
+func (c *DeviceFarm) ListTestsRequest(input *ListTestsInput) (req *request.Request, output *ListTestsOutput) {
 	op := &request.Operation{
-		Name:       opPurchaseOffering,
+		Name:       opListTests,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"nextToken"},
+			OutputTokens:    []string{"nextToken"},
+			LimitToken:      "",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &PurchaseOfferingInput{}
+		input = &ListTestsInput{}
 	}
 
-	output = &PurchaseOfferingOutput{}
+	output = &ListTestsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// PurchaseOffering API operation for AWS Device Farm.
+// ListTests API operation for AWS Device Farm.
 //
-// Immediately purchases offerings for an AWS account. Offerings renew with
-// the latest total purchased quantity for an offering, unless the renewal was
-// overridden. The API returns a NotEligible error if the user is not permitted
-// to invoke the operation. Please contact aws-devicefarm-support@amazon.com
-// (mailto:aws-devicefarm-support@amazon.com) if you believe that you should
-// be able to invoke this operation.
+// Gets information about tests in a given test suite.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Device Farm's
-// API operation PurchaseOffering for usage and error information.
+// API operation ListTests for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotEligibleException "NotEligibleException"
-//   Exception gets thrown when a user is not eligible to perform the specified
-//   transaction.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/PurchaseOffering
-func (c *DeviceFarm) PurchaseOffering(input *PurchaseOfferingInput) (*PurchaseOfferingOutput, error) {
-	req, out := c.PurchaseOfferingRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListTests
+func (c *DeviceFarm) ListTests(input *ListTestsInput) (*ListTestsOutput, error) {
+	req, out := c.ListTestsRequest(input)
 	return out, req.Send()
 }
 
-// PurchaseOfferingWithContext is the same as PurchaseOffering with the addition of
+// ListTestsWithContext is the same as ListTests with the addition of
 // the ability to pass a context and additional request options.
 //
-// See PurchaseOffering for details on how to use this API operation.
+// See ListTests for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DeviceFarm) PurchaseOfferingWithContext(ctx aws.Context, input *PurchaseOfferingInput, opts ...request.Option) (*PurchaseOfferingOutput, error) {
-	req, out := c.PurchaseOfferingRequest(input)
+func (c *DeviceFarm) ListTestsWithContext(ctx aws.Context, input *ListTestsInput, opts ...request.Option) (*ListTestsOutput, error) {
+	req, out := c.ListTestsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opRenewOffering = "RenewOffering"
+// ListTestsPages iterates over the pages of a ListTests operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListTests method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListTests operation.
+//	pageNum := 0
+//	err := client.ListTestsPages(params,
+//	    func(page *devicefarm.ListTestsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *DeviceFarm) ListTestsPages(input *ListTestsInput, fn func(*ListTestsOutput, bool) bool) error {
+	return c.ListTestsPagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-// RenewOfferingRequest generates a "aws/request.Request" representing the
-// client's request for the RenewOffering operation. The "output" return
+// ListTestsPagesWithContext same as ListTestsPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DeviceFarm) ListTestsPagesWithContext(ctx aws.Context, input *ListTestsInput, fn func(*ListTestsOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListTestsInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListTestsRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListTestsOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListUniqueProblems = "ListUniqueProblems"
+
+// ListUniqueProblemsRequest generates a "aws/request.Request" representing the
+// client's request for the ListUniqueProblems operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See RenewOffering for more information on using the RenewOffering
+// See ListUniqueProblems for more information on using the ListUniqueProblems
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListUniqueProblemsRequest method.
+//	req, resp := client.ListUniqueProblemsRequest(params)
 //
-//    // Example sending a request using the RenewOfferingRequest method.
-//    req, resp := client.RenewOfferingRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/RenewOffering
-func (c *DeviceFarm) RenewOfferingRequest(input *RenewOfferingInput) (req *request.Request, output *RenewOfferingOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListUniqueProblems
+func (c *DeviceFarm) ListUniqueProblemsRequest(input *ListUniqueProblemsInput) (req *request.Request, output *ListUniqueProblemsOutput) {
 	op := &request.Operation{
-		Name:       opRenewOffering,
+		Name:       opListUniqueProblems,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"nextToken"},
+			OutputTokens:    []string{"nextToken"},
+			LimitToken:      "",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &RenewOfferingInput{}
+		input = &ListUniqueProblemsInput{}
 	}
 
-	output = &RenewOfferingOutput{}
+	output = &ListUniqueProblemsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// RenewOffering API operation for AWS Device Farm.
+// ListUniqueProblems API operation for AWS Device Farm.
 //
-// Explicitly sets the quantity of devices to renew for an offering, starting
-// from the effectiveDate of the next period. The API returns a NotEligible
-// error if the user is not permitted to invoke the operation. Please contact
-// aws-devicefarm-support@amazon.com (mailto:aws-devicefarm-support@amazon.com)
-// if you believe that you should be able to invoke this operation.
+// Gets information about unique problems, such as exceptions or crashes.
+//
+// Unique problems are defined as a single instance of an error across a run,
+// job, or suite. For example, if a call in your application consistently raises
+// an exception (OutOfBoundsException in MyActivity.java:386), ListUniqueProblems
+// returns a single entry instead of many individual entries for that exception.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Device Farm's
-// API operation RenewOffering for usage and error information.
+// API operation ListUniqueProblems for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeNotEligibleException "NotEligibleException"
-//   Exception gets thrown when a user is not eligible to perform the specified
-//   transaction.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/RenewOffering
-func (c *DeviceFarm) RenewOffering(input *RenewOfferingInput) (*RenewOfferingOutput, error) {
-	req, out := c.RenewOfferingRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListUniqueProblems
+func (c *DeviceFarm) ListUniqueProblems(input *ListUniqueProblemsInput) (*ListUniqueProblemsOutput, error) {
+	req, out := c.ListUniqueProblemsRequest(input)
 	return out, req.Send()
 }
 
-// RenewOfferingWithContext is the same as RenewOffering with the addition of
+// ListUniqueProblemsWithContext is the same as ListUniqueProblems with the addition of
 // the ability to pass a context and additional request options.
 //
-// See RenewOffering for details on how to use this API operation.
+// See ListUniqueProblems for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DeviceFarm) RenewOfferingWithContext(ctx aws.Context, input *RenewOfferingInput, opts ...request.Option) (*RenewOfferingOutput, error) {
-	req, out := c.RenewOfferingRequest(input)
+func (c *DeviceFarm) ListUniqueProblemsWithContext(ctx aws.Context, input *ListUniqueProblemsInput, opts ...request.Option) (*ListUniqueProblemsOutput, error) {
+	req, out := c.ListUniqueProblemsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opScheduleRun = "ScheduleRun"
-
-// ScheduleRunRequest generates a "aws/request.Request" representing the
-// client's request for the ScheduleRun operation. The "output" return
-// value will be populated with the request's response once the request completes
-// successfully.
-//
-// Use "Send" method on the returned Request to send the API call to the service.
-// the "output" return value is not valid until after Send returns without error.
-//
-// See ScheduleRun for more information on using the ScheduleRun
-// API call, and error handling.
+// ListUniqueProblemsPages iterates over the pages of a ListUniqueProblems operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
 //
-// This method is useful when you want to inject custom logic or configuration
-// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+// See ListUniqueProblems method for more information on how to use this operation.
 //
+// Note: This operation can generate multiple requests to a service.
 //
-//    // Example sending a request using the ScheduleRunRequest method.
-//    req, resp := client.ScheduleRunRequest(params)
+//	// Example iterating over at most 3 pages of a ListUniqueProblems operation.
+//	pageNum := 0
+//	err := client.ListUniqueProblemsPages(params,
+//	    func(page *devicefarm.ListUniqueProblemsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *DeviceFarm) ListUniqueProblemsPages(input *ListUniqueProblemsInput, fn func(*ListUniqueProblemsOutput, bool) bool) error {
+	return c.ListUniqueProblemsPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListUniqueProblemsPagesWithContext same as ListUniqueProblemsPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DeviceFarm) ListUniqueProblemsPagesWithContext(ctx aws.Context, input *ListUniqueProblemsInput, fn func(*ListUniqueProblemsOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListUniqueProblemsInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListUniqueProblemsRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListUniqueProblemsOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListUploads = "ListUploads"
+
+// ListUploadsRequest generates a "aws/request.Request" representing the
+// client's request for the ListUploads operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+// See ListUploads for more information on using the ListUploads
+// API call, and error handling.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ScheduleRun
-func (c *DeviceFarm) ScheduleRunRequest(input *ScheduleRunInput) (req *request.Request, output *ScheduleRunOutput) {
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListUploadsRequest method.
+//	req, resp := client.ListUploadsRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListUploads
+func (c *DeviceFarm) ListUploadsRequest(input *ListUploadsInput) (req *request.Request, output *ListUploadsOutput) {
 	op := &request.Operation{
-		Name:       opScheduleRun,
+		Name:       opListUploads,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"nextToken"},
+			OutputTokens:    []string{"nextToken"},
+			LimitToken:      "",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &ScheduleRunInput{}
+		input = &ListUploadsInput{}
 	}
 
-	output = &ScheduleRunOutput{}
+	output = &ListUploadsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ScheduleRun API operation for AWS Device Farm.
+// ListUploads API operation for AWS Device Farm.
 //
-// Schedules a run.
+// Gets information about uploads, given an AWS Device Farm project ARN.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Device Farm's
-// API operation ScheduleRun for usage and error information.
+// API operation ListUploads for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeIdempotencyException "IdempotencyException"
-//   An entity with the same name already exists.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ScheduleRun
-func (c *DeviceFarm) ScheduleRun(input *ScheduleRunInput) (*ScheduleRunOutput, error) {
-	req, out := c.ScheduleRunRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListUploads
+func (c *DeviceFarm) ListUploads(input *ListUploadsInput) (*ListUploadsOutput, error) {
+	req, out := c.ListUploadsRequest(input)
 	return out, req.Send()
 }
 
-// ScheduleRunWithContext is the same as ScheduleRun with the addition of
+// ListUploadsWithContext is the same as ListUploads with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ScheduleRun for details on how to use this API operation.
+// See ListUploads for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DeviceFarm) ScheduleRunWithContext(ctx aws.Context, input *ScheduleRunInput, opts ...request.Option) (*ScheduleRunOutput, error) {
-	req, out := c.ScheduleRunRequest(input)
+func (c *DeviceFarm) ListUploadsWithContext(ctx aws.Context, input *ListUploadsInput, opts ...request.Option) (*ListUploadsOutput, error) {
+	req, out := c.ListUploadsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opStopJob = "StopJob"
+// ListUploadsPages iterates over the pages of a ListUploads operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListUploads method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListUploads operation.
+//	pageNum := 0
+//	err := client.ListUploadsPages(params,
+//	    func(page *devicefarm.ListUploadsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *DeviceFarm) ListUploadsPages(input *ListUploadsInput, fn func(*ListUploadsOutput, bool) bool) error {
+	return c.ListUploadsPagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-// StopJobRequest generates a "aws/request.Request" representing the
-// client's request for the StopJob operation. The "output" return
+// ListUploadsPagesWithContext same as ListUploadsPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DeviceFarm) ListUploadsPagesWithContext(ctx aws.Context, input *ListUploadsInput, fn func(*ListUploadsOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListUploadsInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListUploadsRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListUploadsOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListVPCEConfigurations = "ListVPCEConfigurations"
+
+// ListVPCEConfigurationsRequest generates a "aws/request.Request" representing the
+// client's request for the ListVPCEConfigurations operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See StopJob for more information on using the StopJob
+// See ListVPCEConfigurations for more information on using the ListVPCEConfigurations
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListVPCEConfigurationsRequest method.
+//	req, resp := client.ListVPCEConfigurationsRequest(params)
 //
-//    // Example sending a request using the StopJobRequest method.
-//    req, resp := client.StopJobRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/StopJob
-func (c *DeviceFarm) StopJobRequest(input *StopJobInput) (req *request.Request, output *StopJobOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListVPCEConfigurations
+func (c *DeviceFarm) ListVPCEConfigurationsRequest(input *ListVPCEConfigurationsInput) (req *request.Request, output *ListVPCEConfigurationsOutput) {
 	op := &request.Operation{
-		Name:       opStopJob,
+		Name:       opListVPCEConfigurations,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &StopJobInput{}
+		input = &ListVPCEConfigurationsInput{}
 	}
 
-	output = &StopJobOutput{}
+	output = &ListVPCEConfigurationsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// StopJob API operation for AWS Device Farm.
+// ListVPCEConfigurations API operation for AWS Device Farm.
 //
-// Initiates a stop request for the current job. AWS Device Farm will immediately
-// stop the job on the device where tests have not started executing, and you
-// will not be billed for this device. On the device where tests have started
-// executing, Setup Suite and Teardown Suite tests will run to completion before
-// stopping execution on the device. You will be billed for Setup, Teardown,
-// and any tests that were in progress or already completed.
+// Returns information about all Amazon Virtual Private Cloud (VPC) endpoint
+// configurations in the AWS account.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Device Farm's
-// API operation StopJob for usage and error information.
-//
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// API operation ListVPCEConfigurations for usage and error information.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+// Returned Error Types:
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - ServiceAccountException
+//     There was a problem with the service account.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/StopJob
-func (c *DeviceFarm) StopJob(input *StopJobInput) (*StopJobOutput, error) {
-	req, out := c.StopJobRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ListVPCEConfigurations
+func (c *DeviceFarm) ListVPCEConfigurations(input *ListVPCEConfigurationsInput) (*ListVPCEConfigurationsOutput, error) {
+	req, out := c.ListVPCEConfigurationsRequest(input)
 	return out, req.Send()
 }
 
-// StopJobWithContext is the same as StopJob with the addition of
+// ListVPCEConfigurationsWithContext is the same as ListVPCEConfigurations with the addition of
 // the ability to pass a context and additional request options.
 //
-// See StopJob for details on how to use this API operation.
+// See ListVPCEConfigurations for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DeviceFarm) StopJobWithContext(ctx aws.Context, input *StopJobInput, opts ...request.Option) (*StopJobOutput, error) {
-	req, out := c.StopJobRequest(input)
+func (c *DeviceFarm) ListVPCEConfigurationsWithContext(ctx aws.Context, input *ListVPCEConfigurationsInput, opts ...request.Option) (*ListVPCEConfigurationsOutput, error) {
+	req, out := c.ListVPCEConfigurationsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opStopRemoteAccessSession = "StopRemoteAccessSession"
+const opPurchaseOffering = "PurchaseOffering"
 
-// StopRemoteAccessSessionRequest generates a "aws/request.Request" representing the
-// client's request for the StopRemoteAccessSession operation. The "output" return
+// PurchaseOfferingRequest generates a "aws/request.Request" representing the
+// client's request for the PurchaseOffering operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See StopRemoteAccessSession for more information on using the StopRemoteAccessSession
+// See PurchaseOffering for more information on using the PurchaseOffering
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PurchaseOfferingRequest method.
+//	req, resp := client.PurchaseOfferingRequest(params)
 //
-//    // Example sending a request using the StopRemoteAccessSessionRequest method.
-//    req, resp := client.StopRemoteAccessSessionRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/StopRemoteAccessSession
-func (c *DeviceFarm) StopRemoteAccessSessionRequest(input *StopRemoteAccessSessionInput) (req *request.Request, output *StopRemoteAccessSessionOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/PurchaseOffering
+func (c *DeviceFarm) PurchaseOfferingRequest(input *PurchaseOfferingInput) (req *request.Request, output *PurchaseOfferingOutput) {
 	op := &request.Operation{
-		Name:       opStopRemoteAccessSession,
+		Name:       opPurchaseOffering,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &StopRemoteAccessSessionInput{}
+		input = &PurchaseOfferingInput{}
 	}
 
-	output = &StopRemoteAccessSessionOutput{}
+	output = &PurchaseOfferingOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// StopRemoteAccessSession API operation for AWS Device Farm.
+// PurchaseOffering API operation for AWS Device Farm.
 //
-// Ends a specified remote access session.
+// Immediately purchases offerings for an AWS account. Offerings renew with
+// the latest total purchased quantity for an offering, unless the renewal was
+// overridden. The API returns a NotEligible error if the user is not permitted
+// to invoke the operation. If you must be able to invoke this operation, contact
+// aws-devicefarm-support@amazon.com (mailto:aws-devicefarm-support@amazon.com).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Device Farm's
-// API operation StopRemoteAccessSession for usage and error information.
+// API operation PurchaseOffering for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - NotEligibleException
+//     Exception gets thrown when a user is not eligible to perform the specified
+//     transaction.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/StopRemoteAccessSession
-func (c *DeviceFarm) StopRemoteAccessSession(input *StopRemoteAccessSessionInput) (*StopRemoteAccessSessionOutput, error) {
-	req, out := c.StopRemoteAccessSessionRequest(input)
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/PurchaseOffering
+func (c *DeviceFarm) PurchaseOffering(input *PurchaseOfferingInput) (*PurchaseOfferingOutput, error) {
+	req, out := c.PurchaseOfferingRequest(input)
 	return out, req.Send()
 }
 
-// StopRemoteAccessSessionWithContext is the same as StopRemoteAccessSession with the addition of
+// PurchaseOfferingWithContext is the same as PurchaseOffering with the addition of
 // the ability to pass a context and additional request options.
 //
-// See StopRemoteAccessSession for details on how to use this API operation.
+// See PurchaseOffering for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DeviceFarm) StopRemoteAccessSessionWithContext(ctx aws.Context, input *StopRemoteAccessSessionInput, opts ...request.Option) (*StopRemoteAccessSessionOutput, error) {
-	req, out := c.StopRemoteAccessSessionRequest(input)
+func (c *DeviceFarm) PurchaseOfferingWithContext(ctx aws.Context, input *PurchaseOfferingInput, opts ...request.Option) (*PurchaseOfferingOutput, error) {
+	req, out := c.PurchaseOfferingRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opStopRun = "StopRun"
+const opRenewOffering = "RenewOffering"
 
-// StopRunRequest generates a "aws/request.Request" representing the
-// client's request for the StopRun operation. The "output" return
+// RenewOfferingRequest generates a "aws/request.Request" representing the
+// client's request for the RenewOffering operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See StopRun for more information on using the StopRun
+// See RenewOffering for more information on using the RenewOffering
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the RenewOfferingRequest method.
+//	req, resp := client.RenewOfferingRequest(params)
 //
-//    // Example sending a request using the StopRunRequest method.
-//    req, resp := client.StopRunRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/StopRun
-func (c *DeviceFarm) StopRunRequest(input *StopRunInput) (req *request.Request, output *StopRunOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/RenewOffering
+func (c *DeviceFarm) RenewOfferingRequest(input *RenewOfferingInput) (req *request.Request, output *RenewOfferingOutput) {
 	op := &request.Operation{
-		Name:       opStopRun,
+		Name:       opRenewOffering,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &StopRunInput{}
+		input = &RenewOfferingInput{}
 	}
 
-	output = &StopRunOutput{}
+	output = &RenewOfferingOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// StopRun API operation for AWS Device Farm.
+// RenewOffering API operation for AWS Device Farm.
 //
-// Initiates a stop request for the current test run. AWS Device Farm will immediately
-// stop the run on devices where tests have not started executing, and you will
-// not be billed for these devices. On devices where tests have started executing,
-// Setup Suite and Teardown Suite tests will run to completion before stopping
-// execution on those devices. You will be billed for Setup, Teardown, and any
-// tests that were in progress or already completed.
+// Explicitly sets the quantity of devices to renew for an offering, starting
+// from the effectiveDate of the next period. The API returns a NotEligible
+// error if the user is not permitted to invoke the operation. If you must be
+// able to invoke this operation, contact aws-devicefarm-support@amazon.com
+// (mailto:aws-devicefarm-support@amazon.com).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Device Farm's
-// API operation StopRun for usage and error information.
+// API operation RenewOffering for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - NotEligibleException
+//     Exception gets thrown when a user is not eligible to perform the specified
+//     transaction.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/StopRun
-func (c *DeviceFarm) StopRun(input *StopRunInput) (*StopRunOutput, error) {
-	req, out := c.StopRunRequest(input)
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/RenewOffering
+func (c *DeviceFarm) RenewOffering(input *RenewOfferingInput) (*RenewOfferingOutput, error) {
+	req, out := c.RenewOfferingRequest(input)
 	return out, req.Send()
 }
 
-// StopRunWithContext is the same as StopRun with the addition of
+// RenewOfferingWithContext is the same as RenewOffering with the addition of
 // the ability to pass a context and additional request options.
 //
-// See StopRun for details on how to use this API operation.
+// See RenewOffering for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DeviceFarm) StopRunWithContext(ctx aws.Context, input *StopRunInput, opts ...request.Option) (*StopRunOutput, error) {
-	req, out := c.StopRunRequest(input)
+func (c *DeviceFarm) RenewOfferingWithContext(ctx aws.Context, input *RenewOfferingInput, opts ...request.Option) (*RenewOfferingOutput, error) {
+	req, out := c.RenewOfferingRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opTagResource = "TagResource"
+const opScheduleRun = "ScheduleRun"
 
-// TagResourceRequest generates a "aws/request.Request" representing the
-// client's request for the TagResource operation. The "output" return
+// ScheduleRunRequest generates a "aws/request.Request" representing the
+// client's request for the ScheduleRun operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See TagResource for more information on using the TagResource
+// See ScheduleRun for more information on using the ScheduleRun
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ScheduleRunRequest method.
+//	req, resp := client.ScheduleRunRequest(params)
 //
-//    // Example sending a request using the TagResourceRequest method.
-//    req, resp := client.TagResourceRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/TagResource
-func (c *DeviceFarm) TagResourceRequest(input *TagResourceInput) (req *request.Request, output *TagResourceOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ScheduleRun
+func (c *DeviceFarm) ScheduleRunRequest(input *ScheduleRunInput) (req *request.Request, output *ScheduleRunOutput) {
 	op := &request.Operation{
-		Name:       opTagResource,
+		Name:       opScheduleRun,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &TagResourceInput{}
+		input = &ScheduleRunInput{}
 	}
 
-	output = &TagResourceOutput{}
+	output = &ScheduleRunOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// TagResource API operation for AWS Device Farm.
+// ScheduleRun API operation for AWS Device Farm.
 //
-// Associates the specified tags to a resource with the specified resourceArn.
-// If existing tags on a resource are not specified in the request parameters,
-// they are not changed. When a resource is deleted, the tags associated with
-// that resource are deleted as well.
+// Schedules a run.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Device Farm's
-// API operation TagResource for usage and error information.
+// API operation ScheduleRun for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+// Returned Error Types:
 //
-//   * ErrCodeTagOperationException "TagOperationException"
-//   The operation was not successful. Try again.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeTooManyTagsException "TooManyTagsException"
-//   The list of tags on the repository is over the limit. The maximum number
-//   of tags that can be applied to a repository is 50.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeTagPolicyException "TagPolicyException"
-//   The request doesn't comply with the AWS Identity and Access Management (IAM)
-//   tag policy. Correct your request and then retry it.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/TagResource
-func (c *DeviceFarm) TagResource(input *TagResourceInput) (*TagResourceOutput, error) {
-	req, out := c.TagResourceRequest(input)
+//   - IdempotencyException
+//     An entity with the same name already exists.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/ScheduleRun
+func (c *DeviceFarm) ScheduleRun(input *ScheduleRunInput) (*ScheduleRunOutput, error) {
+	req, out := c.ScheduleRunRequest(input)
 	return out, req.Send()
 }
 
-// TagResourceWithContext is the same as TagResource with the addition of
+// ScheduleRunWithContext is the same as ScheduleRun with the addition of
 // the ability to pass a context and additional request options.
 //
-// See TagResource for details on how to use this API operation.
+// See ScheduleRun for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DeviceFarm) TagResourceWithContext(ctx aws.Context, input *TagResourceInput, opts ...request.Option) (*TagResourceOutput, error) {
-	req, out := c.TagResourceRequest(input)
+func (c *DeviceFarm) ScheduleRunWithContext(ctx aws.Context, input *ScheduleRunInput, opts ...request.Option) (*ScheduleRunOutput, error) {
+	req, out := c.ScheduleRunRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUntagResource = "UntagResource"
+const opStopJob = "StopJob"
 
-// UntagResourceRequest generates a "aws/request.Request" representing the
-// client's request for the UntagResource operation. The "output" return
+// StopJobRequest generates a "aws/request.Request" representing the
+// client's request for the StopJob operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UntagResource for more information on using the UntagResource
+// See StopJob for more information on using the StopJob
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the StopJobRequest method.
+//	req, resp := client.StopJobRequest(params)
 //
-//    // Example sending a request using the UntagResourceRequest method.
-//    req, resp := client.UntagResourceRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UntagResource
-func (c *DeviceFarm) UntagResourceRequest(input *UntagResourceInput) (req *request.Request, output *UntagResourceOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/StopJob
+func (c *DeviceFarm) StopJobRequest(input *StopJobInput) (req *request.Request, output *StopJobOutput) {
 	op := &request.Operation{
-		Name:       opUntagResource,
+		Name:       opStopJob,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &UntagResourceInput{}
+		input = &StopJobInput{}
 	}
 
-	output = &UntagResourceOutput{}
+	output = &StopJobOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// UntagResource API operation for AWS Device Farm.
+// StopJob API operation for AWS Device Farm.
 //
-// Deletes the specified tags from a resource.
+// Initiates a stop request for the current job. AWS Device Farm immediately
+// stops the job on the device where tests have not started. You are not billed
+// for this device. On the device where tests have started, setup suite and
+// teardown suite tests run to completion on the device. You are billed for
+// setup, teardown, and any tests that were in progress or already completed.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Device Farm's
-// API operation UntagResource for usage and error information.
+// API operation StopJob for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+// Returned Error Types:
 //
-//   * ErrCodeTagOperationException "TagOperationException"
-//   The operation was not successful. Try again.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UntagResource
-func (c *DeviceFarm) UntagResource(input *UntagResourceInput) (*UntagResourceOutput, error) {
-	req, out := c.UntagResourceRequest(input)
+//   - NotFoundException
+//     The specified entity was not found.
+//
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/StopJob
+func (c *DeviceFarm) StopJob(input *StopJobInput) (*StopJobOutput, error) {
+	req, out := c.StopJobRequest(input)
 	return out, req.Send()
 }
 
-// UntagResourceWithContext is the same as UntagResource with the addition of
+// StopJobWithContext is the same as StopJob with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UntagResource for details on how to use this API operation.
+// See StopJob for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DeviceFarm) UntagResourceWithContext(ctx aws.Context, input *UntagResourceInput, opts ...request.Option) (*UntagResourceOutput, error) {
-	req, out := c.UntagResourceRequest(input)
+func (c *DeviceFarm) StopJobWithContext(ctx aws.Context, input *StopJobInput, opts ...request.Option) (*StopJobOutput, error) {
+	req, out := c.StopJobRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateDeviceInstance = "UpdateDeviceInstance"
+const opStopRemoteAccessSession = "StopRemoteAccessSession"
 
-// UpdateDeviceInstanceRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateDeviceInstance operation. The "output" return
+// StopRemoteAccessSessionRequest generates a "aws/request.Request" representing the
+// client's request for the StopRemoteAccessSession operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateDeviceInstance for more information on using the UpdateDeviceInstance
+// See StopRemoteAccessSession for more information on using the StopRemoteAccessSession
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the StopRemoteAccessSessionRequest method.
+//	req, resp := client.StopRemoteAccessSessionRequest(params)
 //
-//    // Example sending a request using the UpdateDeviceInstanceRequest method.
-//    req, resp := client.UpdateDeviceInstanceRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateDeviceInstance
-func (c *DeviceFarm) UpdateDeviceInstanceRequest(input *UpdateDeviceInstanceInput) (req *request.Request, output *UpdateDeviceInstanceOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/StopRemoteAccessSession
+func (c *DeviceFarm) StopRemoteAccessSessionRequest(input *StopRemoteAccessSessionInput) (req *request.Request, output *StopRemoteAccessSessionOutput) {
 	op := &request.Operation{
-		Name:       opUpdateDeviceInstance,
+		Name:       opStopRemoteAccessSession,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &UpdateDeviceInstanceInput{}
+		input = &StopRemoteAccessSessionInput{}
 	}
 
-	output = &UpdateDeviceInstanceOutput{}
+	output = &StopRemoteAccessSessionOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// UpdateDeviceInstance API operation for AWS Device Farm.
+// StopRemoteAccessSession API operation for AWS Device Farm.
 //
-// Updates information about an existing private device instance.
+// Ends a specified remote access session.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Device Farm's
-// API operation UpdateDeviceInstance for usage and error information.
+// API operation StopRemoteAccessSession for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateDeviceInstance
-func (c *DeviceFarm) UpdateDeviceInstance(input *UpdateDeviceInstanceInput) (*UpdateDeviceInstanceOutput, error) {
-	req, out := c.UpdateDeviceInstanceRequest(input)
+//   - ServiceAccountException
+//     There was a problem with the service account.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/StopRemoteAccessSession
+func (c *DeviceFarm) StopRemoteAccessSession(input *StopRemoteAccessSessionInput) (*StopRemoteAccessSessionOutput, error) {
+	req, out := c.StopRemoteAccessSessionRequest(input)
 	return out, req.Send()
 }
 
-// UpdateDeviceInstanceWithContext is the same as UpdateDeviceInstance with the addition of
+// StopRemoteAccessSessionWithContext is the same as StopRemoteAccessSession with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateDeviceInstance for details on how to use this API operation.
+// See StopRemoteAccessSession for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DeviceFarm) UpdateDeviceInstanceWithContext(ctx aws.Context, input *UpdateDeviceInstanceInput, opts ...request.Option) (*UpdateDeviceInstanceOutput, error) {
-	req, out := c.UpdateDeviceInstanceRequest(input)
+func (c *DeviceFarm) StopRemoteAccessSessionWithContext(ctx aws.Context, input *StopRemoteAccessSessionInput, opts ...request.Option) (*StopRemoteAccessSessionOutput, error) {
+	req, out := c.StopRemoteAccessSessionRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateDevicePool = "UpdateDevicePool"
+const opStopRun = "StopRun"
 
-// UpdateDevicePoolRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateDevicePool operation. The "output" return
+// StopRunRequest generates a "aws/request.Request" representing the
+// client's request for the StopRun operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateDevicePool for more information on using the UpdateDevicePool
+// See StopRun for more information on using the StopRun
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the StopRunRequest method.
+//	req, resp := client.StopRunRequest(params)
 //
-//    // Example sending a request using the UpdateDevicePoolRequest method.
-//    req, resp := client.UpdateDevicePoolRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateDevicePool
-func (c *DeviceFarm) UpdateDevicePoolRequest(input *UpdateDevicePoolInput) (req *request.Request, output *UpdateDevicePoolOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/StopRun
+func (c *DeviceFarm) StopRunRequest(input *StopRunInput) (req *request.Request, output *StopRunOutput) {
 	op := &request.Operation{
-		Name:       opUpdateDevicePool,
+		Name:       opStopRun,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &UpdateDevicePoolInput{}
+		input = &StopRunInput{}
 	}
 
-	output = &UpdateDevicePoolOutput{}
+	output = &StopRunOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// UpdateDevicePool API operation for AWS Device Farm.
+// StopRun API operation for AWS Device Farm.
 //
-// Modifies the name, description, and rules in a device pool given the attributes
-// and the pool ARN. Rule updates are all-or-nothing, meaning they can only
-// be updated as a whole (or not at all).
+// Initiates a stop request for the current test run. AWS Device Farm immediately
+// stops the run on devices where tests have not started. You are not billed
+// for these devices. On devices where tests have started executing, setup suite
+// and teardown suite tests run to completion on those devices. You are billed
+// for setup, teardown, and any tests that were in progress or already completed.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Device Farm's
-// API operation UpdateDevicePool for usage and error information.
+// API operation StopRun for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateDevicePool
-func (c *DeviceFarm) UpdateDevicePool(input *UpdateDevicePoolInput) (*UpdateDevicePoolOutput, error) {
-	req, out := c.UpdateDevicePoolRequest(input)
+//   - ServiceAccountException
+//     There was a problem with the service account.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/StopRun
+func (c *DeviceFarm) StopRun(input *StopRunInput) (*StopRunOutput, error) {
+	req, out := c.StopRunRequest(input)
 	return out, req.Send()
 }
 
-// UpdateDevicePoolWithContext is the same as UpdateDevicePool with the addition of
+// StopRunWithContext is the same as StopRun with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateDevicePool for details on how to use this API operation.
+// See StopRun for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DeviceFarm) UpdateDevicePoolWithContext(ctx aws.Context, input *UpdateDevicePoolInput, opts ...request.Option) (*UpdateDevicePoolOutput, error) {
-	req, out := c.UpdateDevicePoolRequest(input)
+func (c *DeviceFarm) StopRunWithContext(ctx aws.Context, input *StopRunInput, opts ...request.Option) (*StopRunOutput, error) {
+	req, out := c.StopRunRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateInstanceProfile = "UpdateInstanceProfile"
+const opTagResource = "TagResource"
 
-// UpdateInstanceProfileRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateInstanceProfile operation. The "output" return
+// TagResourceRequest generates a "aws/request.Request" representing the
+// client's request for the TagResource operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateInstanceProfile for more information on using the UpdateInstanceProfile
+// See TagResource for more information on using the TagResource
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the TagResourceRequest method.
+//	req, resp := client.TagResourceRequest(params)
 //
-//    // Example sending a request using the UpdateInstanceProfileRequest method.
-//    req, resp := client.UpdateInstanceProfileRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateInstanceProfile
-func (c *DeviceFarm) UpdateInstanceProfileRequest(input *UpdateInstanceProfileInput) (req *request.Request, output *UpdateInstanceProfileOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/TagResource
+func (c *DeviceFarm) TagResourceRequest(input *TagResourceInput) (req *request.Request, output *TagResourceOutput) {
 	op := &request.Operation{
-		Name:       opUpdateInstanceProfile,
+		Name:       opTagResource,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &UpdateInstanceProfileInput{}
+		input = &TagResourceInput{}
 	}
 
-	output = &UpdateInstanceProfileOutput{}
+	output = &TagResourceOutput{}
 	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// UpdateInstanceProfile API operation for AWS Device Farm.
+// TagResource API operation for AWS Device Farm.
 //
-// Updates information about an existing private device instance profile.
+// Associates the specified tags to a resource with the specified resourceArn.
+// If existing tags on a resource are not specified in the request parameters,
+// they are not changed. When a resource is deleted, the tags associated with
+// that resource are also deleted.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Device Farm's
-// API operation UpdateInstanceProfile for usage and error information.
+// API operation TagResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - TagOperationException
+//     The operation was not successful. Try again.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateInstanceProfile
-func (c *DeviceFarm) UpdateInstanceProfile(input *UpdateInstanceProfileInput) (*UpdateInstanceProfileOutput, error) {
-	req, out := c.UpdateInstanceProfileRequest(input)
+//   - TooManyTagsException
+//     The list of tags on the repository is over the limit. The maximum number
+//     of tags that can be applied to a repository is 50.
+//
+//   - TagPolicyException
+//     The request doesn't comply with the AWS Identity and Access Management (IAM)
+//     tag policy. Correct your request and then retry it.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/TagResource
+func (c *DeviceFarm) TagResource(input *TagResourceInput) (*TagResourceOutput, error) {
+	req, out := c.TagResourceRequest(input)
 	return out, req.Send()
 }
 
-// UpdateInstanceProfileWithContext is the same as UpdateInstanceProfile with the addition of
+// TagResourceWithContext is the same as TagResource with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateInstanceProfile for details on how to use this API operation.
+// See TagResource for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DeviceFarm) UpdateInstanceProfileWithContext(ctx aws.Context, input *UpdateInstanceProfileInput, opts ...request.Option) (*UpdateInstanceProfileOutput, error) {
-	req, out := c.UpdateInstanceProfileRequest(input)
+func (c *DeviceFarm) TagResourceWithContext(ctx aws.Context, input *TagResourceInput, opts ...request.Option) (*TagResourceOutput, error) {
+	req, out := c.TagResourceRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateNetworkProfile = "UpdateNetworkProfile"
+const opUntagResource = "UntagResource"
 
-// UpdateNetworkProfileRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateNetworkProfile operation. The "output" return
+// UntagResourceRequest generates a "aws/request.Request" representing the
+// client's request for the UntagResource operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateNetworkProfile for more information on using the UpdateNetworkProfile
+// See UntagResource for more information on using the UntagResource
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UntagResourceRequest method.
+//	req, resp := client.UntagResourceRequest(params)
 //
-//    // Example sending a request using the UpdateNetworkProfileRequest method.
-//    req, resp := client.UpdateNetworkProfileRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateNetworkProfile
-func (c *DeviceFarm) UpdateNetworkProfileRequest(input *UpdateNetworkProfileInput) (req *request.Request, output *UpdateNetworkProfileOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UntagResource
+func (c *DeviceFarm) UntagResourceRequest(input *UntagResourceInput) (req *request.Request, output *UntagResourceOutput) {
 	op := &request.Operation{
-		Name:       opUpdateNetworkProfile,
+		Name:       opUntagResource,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &UpdateNetworkProfileInput{}
+		input = &UntagResourceInput{}
 	}
 
-	output = &UpdateNetworkProfileOutput{}
+	output = &UntagResourceOutput{}
 	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// UpdateNetworkProfile API operation for AWS Device Farm.
+// UntagResource API operation for AWS Device Farm.
 //
-// Updates the network profile with specific settings.
+// Deletes the specified tags from a resource.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Device Farm's
-// API operation UpdateNetworkProfile for usage and error information.
+// API operation UntagResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - TagOperationException
+//     The operation was not successful. Try again.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateNetworkProfile
-func (c *DeviceFarm) UpdateNetworkProfile(input *UpdateNetworkProfileInput) (*UpdateNetworkProfileOutput, error) {
-	req, out := c.UpdateNetworkProfileRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UntagResource
+func (c *DeviceFarm) UntagResource(input *UntagResourceInput) (*UntagResourceOutput, error) {
+	req, out := c.UntagResourceRequest(input)
 	return out, req.Send()
 }
 
-// UpdateNetworkProfileWithContext is the same as UpdateNetworkProfile with the addition of
+// UntagResourceWithContext is the same as UntagResource with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateNetworkProfile for details on how to use this API operation.
+// See UntagResource for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DeviceFarm) UpdateNetworkProfileWithContext(ctx aws.Context, input *UpdateNetworkProfileInput, opts ...request.Option) (*UpdateNetworkProfileOutput, error) {
-	req, out := c.UpdateNetworkProfileRequest(input)
+func (c *DeviceFarm) UntagResourceWithContext(ctx aws.Context, input *UntagResourceInput, opts ...request.Option) (*UntagResourceOutput, error) {
+	req, out := c.UntagResourceRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateProject = "UpdateProject"
+const opUpdateDeviceInstance = "UpdateDeviceInstance"
 
-// UpdateProjectRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateProject operation. The "output" return
+// UpdateDeviceInstanceRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateDeviceInstance operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateProject for more information on using the UpdateProject
+// See UpdateDeviceInstance for more information on using the UpdateDeviceInstance
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateDeviceInstanceRequest method.
+//	req, resp := client.UpdateDeviceInstanceRequest(params)
 //
-//    // Example sending a request using the UpdateProjectRequest method.
-//    req, resp := client.UpdateProjectRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateProject
-func (c *DeviceFarm) UpdateProjectRequest(input *UpdateProjectInput) (req *request.Request, output *UpdateProjectOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateDeviceInstance
+func (c *DeviceFarm) UpdateDeviceInstanceRequest(input *UpdateDeviceInstanceInput) (req *request.Request, output *UpdateDeviceInstanceOutput) {
 	op := &request.Operation{
-		Name:       opUpdateProject,
+		Name:       opUpdateDeviceInstance,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &UpdateProjectInput{}
+		input = &UpdateDeviceInstanceInput{}
 	}
 
-	output = &UpdateProjectOutput{}
+	output = &UpdateDeviceInstanceOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// UpdateProject API operation for AWS Device Farm.
+// UpdateDeviceInstance API operation for AWS Device Farm.
 //
-// Modifies the specified project name, given the project ARN and a new name.
+// Updates information about a private device instance.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Device Farm's
-// API operation UpdateProject for usage and error information.
+// API operation UpdateDeviceInstance for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateProject
-func (c *DeviceFarm) UpdateProject(input *UpdateProjectInput) (*UpdateProjectOutput, error) {
-	req, out := c.UpdateProjectRequest(input)
+//   - ServiceAccountException
+//     There was a problem with the service account.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateDeviceInstance
+func (c *DeviceFarm) UpdateDeviceInstance(input *UpdateDeviceInstanceInput) (*UpdateDeviceInstanceOutput, error) {
+	req, out := c.UpdateDeviceInstanceRequest(input)
 	return out, req.Send()
 }
 
-// UpdateProjectWithContext is the same as UpdateProject with the addition of
+// UpdateDeviceInstanceWithContext is the same as UpdateDeviceInstance with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateProject for details on how to use this API operation.
+// See UpdateDeviceInstance for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DeviceFarm) UpdateProjectWithContext(ctx aws.Context, input *UpdateProjectInput, opts ...request.Option) (*UpdateProjectOutput, error) {
-	req, out := c.UpdateProjectRequest(input)
+func (c *DeviceFarm) UpdateDeviceInstanceWithContext(ctx aws.Context, input *UpdateDeviceInstanceInput, opts ...request.Option) (*UpdateDeviceInstanceOutput, error) {
+	req, out := c.UpdateDeviceInstanceRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateUpload = "UpdateUpload"
+const opUpdateDevicePool = "UpdateDevicePool"
 
-// UpdateUploadRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateUpload operation. The "output" return
+// UpdateDevicePoolRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateDevicePool operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateUpload for more information on using the UpdateUpload
+// See UpdateDevicePool for more information on using the UpdateDevicePool
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateDevicePoolRequest method.
+//	req, resp := client.UpdateDevicePoolRequest(params)
 //
-//    // Example sending a request using the UpdateUploadRequest method.
-//    req, resp := client.UpdateUploadRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateUpload
-func (c *DeviceFarm) UpdateUploadRequest(input *UpdateUploadInput) (req *request.Request, output *UpdateUploadOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateDevicePool
+func (c *DeviceFarm) UpdateDevicePoolRequest(input *UpdateDevicePoolInput) (req *request.Request, output *UpdateDevicePoolOutput) {
 	op := &request.Operation{
-		Name:       opUpdateUpload,
+		Name:       opUpdateDevicePool,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &UpdateUploadInput{}
+		input = &UpdateDevicePoolInput{}
 	}
 
-	output = &UpdateUploadOutput{}
+	output = &UpdateDevicePoolOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// UpdateUpload API operation for AWS Device Farm.
+// UpdateDevicePool API operation for AWS Device Farm.
 //
-// Update an uploaded test specification (test spec).
+// Modifies the name, description, and rules in a device pool given the attributes
+// and the pool ARN. Rule updates are all-or-nothing, meaning they can only
+// be updated as a whole (or not at all).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Device Farm's
-// API operation UpdateUpload for usage and error information.
+// API operation UpdateDevicePool for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A limit was exceeded.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateUpload
-func (c *DeviceFarm) UpdateUpload(input *UpdateUploadInput) (*UpdateUploadOutput, error) {
-	req, out := c.UpdateUploadRequest(input)
+//   - ServiceAccountException
+//     There was a problem with the service account.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateDevicePool
+func (c *DeviceFarm) UpdateDevicePool(input *UpdateDevicePoolInput) (*UpdateDevicePoolOutput, error) {
+	req, out := c.UpdateDevicePoolRequest(input)
 	return out, req.Send()
 }
 
-// UpdateUploadWithContext is the same as UpdateUpload with the addition of
+// UpdateDevicePoolWithContext is the same as UpdateDevicePool with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateUpload for details on how to use this API operation.
+// See UpdateDevicePool for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DeviceFarm) UpdateUploadWithContext(ctx aws.Context, input *UpdateUploadInput, opts ...request.Option) (*UpdateUploadOutput, error) {
-	req, out := c.UpdateUploadRequest(input)
+func (c *DeviceFarm) UpdateDevicePoolWithContext(ctx aws.Context, input *UpdateDevicePoolInput, opts ...request.Option) (*UpdateDevicePoolOutput, error) {
+	req, out := c.UpdateDevicePoolRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateVPCEConfiguration = "UpdateVPCEConfiguration"
+const opUpdateInstanceProfile = "UpdateInstanceProfile"
 
-// UpdateVPCEConfigurationRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateVPCEConfiguration operation. The "output" return
+// UpdateInstanceProfileRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateInstanceProfile operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateVPCEConfiguration for more information on using the UpdateVPCEConfiguration
+// See UpdateInstanceProfile for more information on using the UpdateInstanceProfile
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateInstanceProfileRequest method.
+//	req, resp := client.UpdateInstanceProfileRequest(params)
 //
-//    // Example sending a request using the UpdateVPCEConfigurationRequest method.
-//    req, resp := client.UpdateVPCEConfigurationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateVPCEConfiguration
-func (c *DeviceFarm) UpdateVPCEConfigurationRequest(input *UpdateVPCEConfigurationInput) (req *request.Request, output *UpdateVPCEConfigurationOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateInstanceProfile
+func (c *DeviceFarm) UpdateInstanceProfileRequest(input *UpdateInstanceProfileInput) (req *request.Request, output *UpdateInstanceProfileOutput) {
 	op := &request.Operation{
-		Name:       opUpdateVPCEConfiguration,
+		Name:       opUpdateInstanceProfile,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &UpdateVPCEConfigurationInput{}
+		input = &UpdateInstanceProfileInput{}
 	}
 
-	output = &UpdateVPCEConfigurationOutput{}
+	output = &UpdateInstanceProfileOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// UpdateVPCEConfiguration API operation for AWS Device Farm.
+// UpdateInstanceProfile API operation for AWS Device Farm.
 //
-// Updates information about an existing Amazon Virtual Private Cloud (VPC)
-// endpoint configuration.
+// Updates information about an existing private device instance profile.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Device Farm's
-// API operation UpdateVPCEConfiguration for usage and error information.
+// API operation UpdateInstanceProfile for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeArgumentException "ArgumentException"
-//   An invalid argument was specified.
+// Returned Error Types:
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The specified entity was not found.
+//   - ArgumentException
+//     An invalid argument was specified.
 //
-//   * ErrCodeServiceAccountException "ServiceAccountException"
-//   There was a problem with the service account.
+//   - NotFoundException
+//     The specified entity was not found.
 //
-//   * ErrCodeInvalidOperationException "InvalidOperationException"
-//   There was an error with the update request, or you do not have sufficient
-//   permissions to update this VPC endpoint configuration.
+//   - LimitExceededException
+//     A limit was exceeded.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateVPCEConfiguration
-func (c *DeviceFarm) UpdateVPCEConfiguration(input *UpdateVPCEConfigurationInput) (*UpdateVPCEConfigurationOutput, error) {
-	req, out := c.UpdateVPCEConfigurationRequest(input)
+//   - ServiceAccountException
+//     There was a problem with the service account.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateInstanceProfile
+func (c *DeviceFarm) UpdateInstanceProfile(input *UpdateInstanceProfileInput) (*UpdateInstanceProfileOutput, error) {
+	req, out := c.UpdateInstanceProfileRequest(input)
 	return out, req.Send()
 }
 
-// UpdateVPCEConfigurationWithContext is the same as UpdateVPCEConfiguration with the addition of
+// UpdateInstanceProfileWithContext is the same as UpdateInstanceProfile with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateVPCEConfiguration for details on how to use this API operation.
+// See UpdateInstanceProfile for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DeviceFarm) UpdateVPCEConfigurationWithContext(ctx aws.Context, input *UpdateVPCEConfigurationInput, opts ...request.Option) (*UpdateVPCEConfigurationOutput, error) {
-	req, out := c.UpdateVPCEConfigurationRequest(input)
+func (c *DeviceFarm) UpdateInstanceProfileWithContext(ctx aws.Context, input *UpdateInstanceProfileInput, opts ...request.Option) (*UpdateInstanceProfileOutput, error) {
+	req, out := c.UpdateInstanceProfileRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-// A container for account-level settings within AWS Device Farm.
-type AccountSettings struct {
-	_ struct{} `type:"structure"`
-
-	// The AWS account number specified in the AccountSettings container.
-	AwsAccountNumber *string `locationName:"awsAccountNumber" min:"2" type:"string"`
-
-	// The default number of minutes (at the account level) a test run will execute
-	// before it times out. The default value is 150 minutes.
-	DefaultJobTimeoutMinutes *int64 `locationName:"defaultJobTimeoutMinutes" type:"integer"`
-
-	// The maximum number of minutes a test run will execute before it times out.
-	MaxJobTimeoutMinutes *int64 `locationName:"maxJobTimeoutMinutes" type:"integer"`
-
-	// The maximum number of device slots that the AWS account can purchase. Each
-	// maximum is expressed as an offering-id:number pair, where the offering-id
-	// represents one of the IDs returned by the ListOfferings command.
-	MaxSlots map[string]*int64 `locationName:"maxSlots" type:"map"`
-
-	// When set to true, for private devices, Device Farm will not sign your app
-	// again. For public devices, Device Farm always signs your apps again and this
-	// parameter has no effect.
-	//
-	// For more information about how Device Farm re-signs your app(s), see Do you
-	// modify my app? (https://aws.amazon.com/device-farm/faq/) in the AWS Device
-	// Farm FAQs.
-	SkipAppResign *bool `locationName:"skipAppResign" type:"boolean"`
-
-	// Information about an AWS account's usage of free trial device minutes.
-	TrialMinutes *TrialMinutes `locationName:"trialMinutes" type:"structure"`
+const opUpdateNetworkProfile = "UpdateNetworkProfile"
 
-	// Returns the unmetered devices you have purchased or want to purchase.
-	UnmeteredDevices map[string]*int64 `locationName:"unmeteredDevices" type:"map"`
+// UpdateNetworkProfileRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateNetworkProfile operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateNetworkProfile for more information on using the UpdateNetworkProfile
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateNetworkProfileRequest method.
+//	req, resp := client.UpdateNetworkProfileRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateNetworkProfile
+func (c *DeviceFarm) UpdateNetworkProfileRequest(input *UpdateNetworkProfileInput) (req *request.Request, output *UpdateNetworkProfileOutput) {
+	op := &request.Operation{
+		Name:       opUpdateNetworkProfile,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
 
-	// Returns the unmetered remote access devices you have purchased or want to
-	// purchase.
-	UnmeteredRemoteAccessDevices map[string]*int64 `locationName:"unmeteredRemoteAccessDevices" type:"map"`
-}
+	if input == nil {
+		input = &UpdateNetworkProfileInput{}
+	}
 
-// String returns the string representation
-func (s AccountSettings) String() string {
-	return awsutil.Prettify(s)
+	output = &UpdateNetworkProfileOutput{}
+	req = c.newRequest(op, input, output)
+	return
 }
 
-// GoString returns the string representation
-func (s AccountSettings) GoString() string {
-	return s.String()
+// UpdateNetworkProfile API operation for AWS Device Farm.
+//
+// Updates the network profile.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Device Farm's
+// API operation UpdateNetworkProfile for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
+//
+//   - NotFoundException
+//     The specified entity was not found.
+//
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateNetworkProfile
+func (c *DeviceFarm) UpdateNetworkProfile(input *UpdateNetworkProfileInput) (*UpdateNetworkProfileOutput, error) {
+	req, out := c.UpdateNetworkProfileRequest(input)
+	return out, req.Send()
 }
 
-// SetAwsAccountNumber sets the AwsAccountNumber field's value.
-func (s *AccountSettings) SetAwsAccountNumber(v string) *AccountSettings {
-	s.AwsAccountNumber = &v
-	return s
+// UpdateNetworkProfileWithContext is the same as UpdateNetworkProfile with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateNetworkProfile for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DeviceFarm) UpdateNetworkProfileWithContext(ctx aws.Context, input *UpdateNetworkProfileInput, opts ...request.Option) (*UpdateNetworkProfileOutput, error) {
+	req, out := c.UpdateNetworkProfileRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
 }
 
-// SetDefaultJobTimeoutMinutes sets the DefaultJobTimeoutMinutes field's value.
-func (s *AccountSettings) SetDefaultJobTimeoutMinutes(v int64) *AccountSettings {
-	s.DefaultJobTimeoutMinutes = &v
-	return s
-}
+const opUpdateProject = "UpdateProject"
 
-// SetMaxJobTimeoutMinutes sets the MaxJobTimeoutMinutes field's value.
-func (s *AccountSettings) SetMaxJobTimeoutMinutes(v int64) *AccountSettings {
-	s.MaxJobTimeoutMinutes = &v
-	return s
-}
+// UpdateProjectRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateProject operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateProject for more information on using the UpdateProject
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateProjectRequest method.
+//	req, resp := client.UpdateProjectRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateProject
+func (c *DeviceFarm) UpdateProjectRequest(input *UpdateProjectInput) (req *request.Request, output *UpdateProjectOutput) {
+	op := &request.Operation{
+		Name:       opUpdateProject,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
 
-// SetMaxSlots sets the MaxSlots field's value.
-func (s *AccountSettings) SetMaxSlots(v map[string]*int64) *AccountSettings {
-	s.MaxSlots = v
-	return s
-}
+	if input == nil {
+		input = &UpdateProjectInput{}
+	}
 
-// SetSkipAppResign sets the SkipAppResign field's value.
-func (s *AccountSettings) SetSkipAppResign(v bool) *AccountSettings {
-	s.SkipAppResign = &v
-	return s
+	output = &UpdateProjectOutput{}
+	req = c.newRequest(op, input, output)
+	return
 }
 
-// SetTrialMinutes sets the TrialMinutes field's value.
-func (s *AccountSettings) SetTrialMinutes(v *TrialMinutes) *AccountSettings {
-	s.TrialMinutes = v
-	return s
+// UpdateProject API operation for AWS Device Farm.
+//
+// Modifies the specified project name, given the project ARN and a new name.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Device Farm's
+// API operation UpdateProject for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
+//
+//   - NotFoundException
+//     The specified entity was not found.
+//
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateProject
+func (c *DeviceFarm) UpdateProject(input *UpdateProjectInput) (*UpdateProjectOutput, error) {
+	req, out := c.UpdateProjectRequest(input)
+	return out, req.Send()
+}
+
+// UpdateProjectWithContext is the same as UpdateProject with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateProject for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DeviceFarm) UpdateProjectWithContext(ctx aws.Context, input *UpdateProjectInput, opts ...request.Option) (*UpdateProjectOutput, error) {
+	req, out := c.UpdateProjectRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateTestGridProject = "UpdateTestGridProject"
+
+// UpdateTestGridProjectRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateTestGridProject operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateTestGridProject for more information on using the UpdateTestGridProject
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateTestGridProjectRequest method.
+//	req, resp := client.UpdateTestGridProjectRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateTestGridProject
+func (c *DeviceFarm) UpdateTestGridProjectRequest(input *UpdateTestGridProjectInput) (req *request.Request, output *UpdateTestGridProjectOutput) {
+	op := &request.Operation{
+		Name:       opUpdateTestGridProject,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UpdateTestGridProjectInput{}
+	}
+
+	output = &UpdateTestGridProjectOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UpdateTestGridProject API operation for AWS Device Farm.
+//
+// Change details of a project.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Device Farm's
+// API operation UpdateTestGridProject for usage and error information.
+//
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The specified entity was not found.
+//
+//   - ArgumentException
+//     An invalid argument was specified.
+//
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - InternalServiceException
+//     An internal exception was raised in the service. Contact aws-devicefarm-support@amazon.com
+//     (mailto:aws-devicefarm-support@amazon.com) if you see this error.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateTestGridProject
+func (c *DeviceFarm) UpdateTestGridProject(input *UpdateTestGridProjectInput) (*UpdateTestGridProjectOutput, error) {
+	req, out := c.UpdateTestGridProjectRequest(input)
+	return out, req.Send()
+}
+
+// UpdateTestGridProjectWithContext is the same as UpdateTestGridProject with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateTestGridProject for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DeviceFarm) UpdateTestGridProjectWithContext(ctx aws.Context, input *UpdateTestGridProjectInput, opts ...request.Option) (*UpdateTestGridProjectOutput, error) {
+	req, out := c.UpdateTestGridProjectRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateUpload = "UpdateUpload"
+
+// UpdateUploadRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateUpload operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateUpload for more information on using the UpdateUpload
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateUploadRequest method.
+//	req, resp := client.UpdateUploadRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateUpload
+func (c *DeviceFarm) UpdateUploadRequest(input *UpdateUploadInput) (req *request.Request, output *UpdateUploadOutput) {
+	op := &request.Operation{
+		Name:       opUpdateUpload,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UpdateUploadInput{}
+	}
+
+	output = &UpdateUploadOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UpdateUpload API operation for AWS Device Farm.
+//
+// Updates an uploaded test spec.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Device Farm's
+// API operation UpdateUpload for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
+//
+//   - NotFoundException
+//     The specified entity was not found.
+//
+//   - LimitExceededException
+//     A limit was exceeded.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateUpload
+func (c *DeviceFarm) UpdateUpload(input *UpdateUploadInput) (*UpdateUploadOutput, error) {
+	req, out := c.UpdateUploadRequest(input)
+	return out, req.Send()
+}
+
+// UpdateUploadWithContext is the same as UpdateUpload with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateUpload for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DeviceFarm) UpdateUploadWithContext(ctx aws.Context, input *UpdateUploadInput, opts ...request.Option) (*UpdateUploadOutput, error) {
+	req, out := c.UpdateUploadRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateVPCEConfiguration = "UpdateVPCEConfiguration"
+
+// UpdateVPCEConfigurationRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateVPCEConfiguration operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateVPCEConfiguration for more information on using the UpdateVPCEConfiguration
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateVPCEConfigurationRequest method.
+//	req, resp := client.UpdateVPCEConfigurationRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateVPCEConfiguration
+func (c *DeviceFarm) UpdateVPCEConfigurationRequest(input *UpdateVPCEConfigurationInput) (req *request.Request, output *UpdateVPCEConfigurationOutput) {
+	op := &request.Operation{
+		Name:       opUpdateVPCEConfiguration,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UpdateVPCEConfigurationInput{}
+	}
+
+	output = &UpdateVPCEConfigurationOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UpdateVPCEConfiguration API operation for AWS Device Farm.
+//
+// Updates information about an Amazon Virtual Private Cloud (VPC) endpoint
+// configuration.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Device Farm's
+// API operation UpdateVPCEConfiguration for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ArgumentException
+//     An invalid argument was specified.
+//
+//   - NotFoundException
+//     The specified entity was not found.
+//
+//   - ServiceAccountException
+//     There was a problem with the service account.
+//
+//   - InvalidOperationException
+//     There was an error with the update request, or you do not have sufficient
+//     permissions to update this VPC endpoint configuration.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/devicefarm-2015-06-23/UpdateVPCEConfiguration
+func (c *DeviceFarm) UpdateVPCEConfiguration(input *UpdateVPCEConfigurationInput) (*UpdateVPCEConfigurationOutput, error) {
+	req, out := c.UpdateVPCEConfigurationRequest(input)
+	return out, req.Send()
+}
+
+// UpdateVPCEConfigurationWithContext is the same as UpdateVPCEConfiguration with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateVPCEConfiguration for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DeviceFarm) UpdateVPCEConfigurationWithContext(ctx aws.Context, input *UpdateVPCEConfigurationInput, opts ...request.Option) (*UpdateVPCEConfigurationOutput, error) {
+	req, out := c.UpdateVPCEConfigurationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+// A container for account-level settings in AWS Device Farm.
+type AccountSettings struct {
+	_ struct{} `type:"structure"`
+
+	// The AWS account number specified in the AccountSettings container.
+	AwsAccountNumber *string `locationName:"awsAccountNumber" min:"2" type:"string"`
+
+	// The default number of minutes (at the account level) a test run executes
+	// before it times out. The default value is 150 minutes.
+	DefaultJobTimeoutMinutes *int64 `locationName:"defaultJobTimeoutMinutes" type:"integer"`
+
+	// The maximum number of minutes a test run executes before it times out.
+	MaxJobTimeoutMinutes *int64 `locationName:"maxJobTimeoutMinutes" type:"integer"`
+
+	// The maximum number of device slots that the AWS account can purchase. Each
+	// maximum is expressed as an offering-id:number pair, where the offering-id
+	// represents one of the IDs returned by the ListOfferings command.
+	MaxSlots map[string]*int64 `locationName:"maxSlots" type:"map"`
+
+	// When set to true, for private devices, Device Farm does not sign your app
+	// again. For public devices, Device Farm always signs your apps again.
+	//
+	// For more information about how Device Farm re-signs your apps, see Do you
+	// modify my app? (http://aws.amazon.com/device-farm/faqs/) in the AWS Device
+	// Farm FAQs.
+	SkipAppResign *bool `locationName:"skipAppResign" type:"boolean"`
+
+	// Information about an AWS account's usage of free trial device minutes.
+	TrialMinutes *TrialMinutes `locationName:"trialMinutes" type:"structure"`
+
+	// Returns the unmetered devices you have purchased or want to purchase.
+	UnmeteredDevices map[string]*int64 `locationName:"unmeteredDevices" type:"map"`
+
+	// Returns the unmetered remote access devices you have purchased or want to
+	// purchase.
+	UnmeteredRemoteAccessDevices map[string]*int64 `locationName:"unmeteredRemoteAccessDevices" type:"map"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AccountSettings) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AccountSettings) GoString() string {
+	return s.String()
+}
+
+// SetAwsAccountNumber sets the AwsAccountNumber field's value.
+func (s *AccountSettings) SetAwsAccountNumber(v string) *AccountSettings {
+	s.AwsAccountNumber = &v
+	return s
+}
+
+// SetDefaultJobTimeoutMinutes sets the DefaultJobTimeoutMinutes field's value.
+func (s *AccountSettings) SetDefaultJobTimeoutMinutes(v int64) *AccountSettings {
+	s.DefaultJobTimeoutMinutes = &v
+	return s
+}
+
+// SetMaxJobTimeoutMinutes sets the MaxJobTimeoutMinutes field's value.
+func (s *AccountSettings) SetMaxJobTimeoutMinutes(v int64) *AccountSettings {
+	s.MaxJobTimeoutMinutes = &v
+	return s
+}
+
+// SetMaxSlots sets the MaxSlots field's value.
+func (s *AccountSettings) SetMaxSlots(v map[string]*int64) *AccountSettings {
+	s.MaxSlots = v
+	return s
+}
+
+// SetSkipAppResign sets the SkipAppResign field's value.
+func (s *AccountSettings) SetSkipAppResign(v bool) *AccountSettings {
+	s.SkipAppResign = &v
+	return s
+}
+
+// SetTrialMinutes sets the TrialMinutes field's value.
+func (s *AccountSettings) SetTrialMinutes(v *TrialMinutes) *AccountSettings {
+	s.TrialMinutes = v
+	return s
+}
+
+// SetUnmeteredDevices sets the UnmeteredDevices field's value.
+func (s *AccountSettings) SetUnmeteredDevices(v map[string]*int64) *AccountSettings {
+	s.UnmeteredDevices = v
+	return s
+}
+
+// SetUnmeteredRemoteAccessDevices sets the UnmeteredRemoteAccessDevices field's value.
+func (s *AccountSettings) SetUnmeteredRemoteAccessDevices(v map[string]*int64) *AccountSettings {
+	s.UnmeteredRemoteAccessDevices = v
+	return s
+}
+
+// An invalid argument was specified.
+type ArgumentException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// Any additional information about the exception.
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ArgumentException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ArgumentException) GoString() string {
+	return s.String()
+}
+
+func newErrorArgumentException(v protocol.ResponseMetadata) error {
+	return &ArgumentException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ArgumentException) Code() string {
+	return "ArgumentException"
+}
+
+// Message returns the exception's message.
+func (s *ArgumentException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ArgumentException) OrigErr() error {
+	return nil
+}
+
+func (s *ArgumentException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ArgumentException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ArgumentException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Represents the output of a test. Examples of artifacts include logs and screenshots.
+type Artifact struct {
+	_ struct{} `type:"structure"`
+
+	// The artifact's ARN.
+	Arn *string `locationName:"arn" min:"32" type:"string"`
+
+	// The artifact's file extension.
+	Extension *string `locationName:"extension" type:"string"`
+
+	// The artifact's name.
+	Name *string `locationName:"name" type:"string"`
+
+	// The artifact's type.
+	//
+	// Allowed values include the following:
+	//
+	//    * UNKNOWN
+	//
+	//    * SCREENSHOT
+	//
+	//    * DEVICE_LOG
+	//
+	//    * MESSAGE_LOG
+	//
+	//    * VIDEO_LOG
+	//
+	//    * RESULT_LOG
+	//
+	//    * SERVICE_LOG
+	//
+	//    * WEBKIT_LOG
+	//
+	//    * INSTRUMENTATION_OUTPUT
+	//
+	//    * EXERCISER_MONKEY_OUTPUT: the artifact (log) generated by an Android
+	//    fuzz test.
+	//
+	//    * CALABASH_JSON_OUTPUT
+	//
+	//    * CALABASH_PRETTY_OUTPUT
+	//
+	//    * CALABASH_STANDARD_OUTPUT
+	//
+	//    * CALABASH_JAVA_XML_OUTPUT
+	//
+	//    * AUTOMATION_OUTPUT
+	//
+	//    * APPIUM_SERVER_OUTPUT
+	//
+	//    * APPIUM_JAVA_OUTPUT
+	//
+	//    * APPIUM_JAVA_XML_OUTPUT
+	//
+	//    * APPIUM_PYTHON_OUTPUT
+	//
+	//    * APPIUM_PYTHON_XML_OUTPUT
+	//
+	//    * EXPLORER_EVENT_LOG
+	//
+	//    * EXPLORER_SUMMARY_LOG
+	//
+	//    * APPLICATION_CRASH_REPORT
+	//
+	//    * XCTEST_LOG
+	//
+	//    * VIDEO
+	//
+	//    * CUSTOMER_ARTIFACT
+	//
+	//    * CUSTOMER_ARTIFACT_LOG
+	//
+	//    * TESTSPEC_OUTPUT
+	Type *string `locationName:"type" type:"string" enum:"ArtifactType"`
+
+	// The presigned Amazon S3 URL that can be used with a GET request to download
+	// the artifact's file.
+	Url *string `locationName:"url" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Artifact) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Artifact) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *Artifact) SetArn(v string) *Artifact {
+	s.Arn = &v
+	return s
+}
+
+// SetExtension sets the Extension field's value.
+func (s *Artifact) SetExtension(v string) *Artifact {
+	s.Extension = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *Artifact) SetName(v string) *Artifact {
+	s.Name = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *Artifact) SetType(v string) *Artifact {
+	s.Type = &v
+	return s
+}
+
+// SetUrl sets the Url field's value.
+func (s *Artifact) SetUrl(v string) *Artifact {
+	s.Url = &v
+	return s
+}
+
+// Represents the amount of CPU that an app is using on a physical device. Does
+// not represent system-wide CPU usage.
+type CPU struct {
+	_ struct{} `type:"structure"`
+
+	// The CPU's architecture (for example, x86 or ARM).
+	Architecture *string `locationName:"architecture" type:"string"`
+
+	// The clock speed of the device's CPU, expressed in hertz (Hz). For example,
+	// a 1.2 GHz CPU is expressed as 1200000000.
+	Clock *float64 `locationName:"clock" type:"double"`
+
+	// The CPU's frequency.
+	Frequency *string `locationName:"frequency" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CPU) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CPU) GoString() string {
+	return s.String()
+}
+
+// SetArchitecture sets the Architecture field's value.
+func (s *CPU) SetArchitecture(v string) *CPU {
+	s.Architecture = &v
+	return s
+}
+
+// SetClock sets the Clock field's value.
+func (s *CPU) SetClock(v float64) *CPU {
+	s.Clock = &v
+	return s
+}
+
+// SetFrequency sets the Frequency field's value.
+func (s *CPU) SetFrequency(v string) *CPU {
+	s.Frequency = &v
+	return s
+}
+
+// The requested object could not be deleted.
+type CannotDeleteException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CannotDeleteException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CannotDeleteException) GoString() string {
+	return s.String()
+}
+
+func newErrorCannotDeleteException(v protocol.ResponseMetadata) error {
+	return &CannotDeleteException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *CannotDeleteException) Code() string {
+	return "CannotDeleteException"
+}
+
+// Message returns the exception's message.
+func (s *CannotDeleteException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *CannotDeleteException) OrigErr() error {
+	return nil
+}
+
+func (s *CannotDeleteException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *CannotDeleteException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *CannotDeleteException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Represents entity counters.
+type Counters struct {
+	_ struct{} `type:"structure"`
+
+	// The number of errored entities.
+	Errored *int64 `locationName:"errored" type:"integer"`
+
+	// The number of failed entities.
+	Failed *int64 `locationName:"failed" type:"integer"`
+
+	// The number of passed entities.
+	Passed *int64 `locationName:"passed" type:"integer"`
+
+	// The number of skipped entities.
+	Skipped *int64 `locationName:"skipped" type:"integer"`
+
+	// The number of stopped entities.
+	Stopped *int64 `locationName:"stopped" type:"integer"`
+
+	// The total number of entities.
+	Total *int64 `locationName:"total" type:"integer"`
+
+	// The number of warned entities.
+	Warned *int64 `locationName:"warned" type:"integer"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Counters) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Counters) GoString() string {
+	return s.String()
+}
+
+// SetErrored sets the Errored field's value.
+func (s *Counters) SetErrored(v int64) *Counters {
+	s.Errored = &v
+	return s
+}
+
+// SetFailed sets the Failed field's value.
+func (s *Counters) SetFailed(v int64) *Counters {
+	s.Failed = &v
+	return s
+}
+
+// SetPassed sets the Passed field's value.
+func (s *Counters) SetPassed(v int64) *Counters {
+	s.Passed = &v
+	return s
+}
+
+// SetSkipped sets the Skipped field's value.
+func (s *Counters) SetSkipped(v int64) *Counters {
+	s.Skipped = &v
+	return s
+}
+
+// SetStopped sets the Stopped field's value.
+func (s *Counters) SetStopped(v int64) *Counters {
+	s.Stopped = &v
+	return s
+}
+
+// SetTotal sets the Total field's value.
+func (s *Counters) SetTotal(v int64) *Counters {
+	s.Total = &v
+	return s
+}
+
+// SetWarned sets the Warned field's value.
+func (s *Counters) SetWarned(v int64) *Counters {
+	s.Warned = &v
+	return s
+}
+
+// Represents a request to the create device pool operation.
+type CreateDevicePoolInput struct {
+	_ struct{} `type:"structure"`
+
+	// The device pool's description.
+	Description *string `locationName:"description" type:"string"`
+
+	// The number of devices that Device Farm can add to your device pool. Device
+	// Farm adds devices that are available and meet the criteria that you assign
+	// for the rules parameter. Depending on how many devices meet these constraints,
+	// your device pool might contain fewer devices than the value for this parameter.
+	//
+	// By specifying the maximum number of devices, you can control the costs that
+	// you incur by running tests.
+	MaxDevices *int64 `locationName:"maxDevices" type:"integer"`
+
+	// The device pool's name.
+	//
+	// Name is a required field
+	Name *string `locationName:"name" type:"string" required:"true"`
+
+	// The ARN of the project for the device pool.
+	//
+	// ProjectArn is a required field
+	ProjectArn *string `locationName:"projectArn" min:"32" type:"string" required:"true"`
+
+	// The device pool's rules.
+	//
+	// Rules is a required field
+	Rules []*Rule `locationName:"rules" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDevicePoolInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDevicePoolInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateDevicePoolInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDevicePoolInput"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.ProjectArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ProjectArn"))
+	}
+	if s.ProjectArn != nil && len(*s.ProjectArn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("ProjectArn", 32))
+	}
+	if s.Rules == nil {
+		invalidParams.Add(request.NewErrParamRequired("Rules"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDescription sets the Description field's value.
+func (s *CreateDevicePoolInput) SetDescription(v string) *CreateDevicePoolInput {
+	s.Description = &v
+	return s
+}
+
+// SetMaxDevices sets the MaxDevices field's value.
+func (s *CreateDevicePoolInput) SetMaxDevices(v int64) *CreateDevicePoolInput {
+	s.MaxDevices = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *CreateDevicePoolInput) SetName(v string) *CreateDevicePoolInput {
+	s.Name = &v
+	return s
+}
+
+// SetProjectArn sets the ProjectArn field's value.
+func (s *CreateDevicePoolInput) SetProjectArn(v string) *CreateDevicePoolInput {
+	s.ProjectArn = &v
+	return s
+}
+
+// SetRules sets the Rules field's value.
+func (s *CreateDevicePoolInput) SetRules(v []*Rule) *CreateDevicePoolInput {
+	s.Rules = v
+	return s
+}
+
+// Represents the result of a create device pool request.
+type CreateDevicePoolOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The newly created device pool.
+	DevicePool *DevicePool `locationName:"devicePool" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDevicePoolOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDevicePoolOutput) GoString() string {
+	return s.String()
+}
+
+// SetDevicePool sets the DevicePool field's value.
+func (s *CreateDevicePoolOutput) SetDevicePool(v *DevicePool) *CreateDevicePoolOutput {
+	s.DevicePool = v
+	return s
+}
+
+type CreateInstanceProfileInput struct {
+	_ struct{} `type:"structure"`
+
+	// The description of your instance profile.
+	Description *string `locationName:"description" type:"string"`
+
+	// An array of strings that specifies the list of app packages that should not
+	// be cleaned up from the device after a test run.
+	//
+	// The list of packages is considered only if you set packageCleanup to true.
+	ExcludeAppPackagesFromCleanup []*string `locationName:"excludeAppPackagesFromCleanup" type:"list"`
+
+	// The name of your instance profile.
+	//
+	// Name is a required field
+	Name *string `locationName:"name" type:"string" required:"true"`
+
+	// When set to true, Device Farm removes app packages after a test run. The
+	// default value is false for private devices.
+	PackageCleanup *bool `locationName:"packageCleanup" type:"boolean"`
+
+	// When set to true, Device Farm reboots the instance after a test run. The
+	// default value is true.
+	RebootAfterUse *bool `locationName:"rebootAfterUse" type:"boolean"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateInstanceProfileInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateInstanceProfileInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateInstanceProfileInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateInstanceProfileInput"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDescription sets the Description field's value.
+func (s *CreateInstanceProfileInput) SetDescription(v string) *CreateInstanceProfileInput {
+	s.Description = &v
+	return s
+}
+
+// SetExcludeAppPackagesFromCleanup sets the ExcludeAppPackagesFromCleanup field's value.
+func (s *CreateInstanceProfileInput) SetExcludeAppPackagesFromCleanup(v []*string) *CreateInstanceProfileInput {
+	s.ExcludeAppPackagesFromCleanup = v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *CreateInstanceProfileInput) SetName(v string) *CreateInstanceProfileInput {
+	s.Name = &v
+	return s
+}
+
+// SetPackageCleanup sets the PackageCleanup field's value.
+func (s *CreateInstanceProfileInput) SetPackageCleanup(v bool) *CreateInstanceProfileInput {
+	s.PackageCleanup = &v
+	return s
+}
+
+// SetRebootAfterUse sets the RebootAfterUse field's value.
+func (s *CreateInstanceProfileInput) SetRebootAfterUse(v bool) *CreateInstanceProfileInput {
+	s.RebootAfterUse = &v
+	return s
+}
+
+type CreateInstanceProfileOutput struct {
+	_ struct{} `type:"structure"`
+
+	// An object that contains information about your instance profile.
+	InstanceProfile *InstanceProfile `locationName:"instanceProfile" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateInstanceProfileOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateInstanceProfileOutput) GoString() string {
+	return s.String()
+}
+
+// SetInstanceProfile sets the InstanceProfile field's value.
+func (s *CreateInstanceProfileOutput) SetInstanceProfile(v *InstanceProfile) *CreateInstanceProfileOutput {
+	s.InstanceProfile = v
+	return s
+}
+
+type CreateNetworkProfileInput struct {
+	_ struct{} `type:"structure"`
+
+	// The description of the network profile.
+	Description *string `locationName:"description" type:"string"`
+
+	// The data throughput rate in bits per second, as an integer from 0 to 104857600.
+	DownlinkBandwidthBits *int64 `locationName:"downlinkBandwidthBits" type:"long"`
+
+	// Delay time for all packets to destination in milliseconds as an integer from
+	// 0 to 2000.
+	DownlinkDelayMs *int64 `locationName:"downlinkDelayMs" type:"long"`
+
+	// Time variation in the delay of received packets in milliseconds as an integer
+	// from 0 to 2000.
+	DownlinkJitterMs *int64 `locationName:"downlinkJitterMs" type:"long"`
+
+	// Proportion of received packets that fail to arrive from 0 to 100 percent.
+	DownlinkLossPercent *int64 `locationName:"downlinkLossPercent" type:"integer"`
+
+	// The name for the new network profile.
+	//
+	// Name is a required field
+	Name *string `locationName:"name" type:"string" required:"true"`
+
+	// The Amazon Resource Name (ARN) of the project for which you want to create
+	// a network profile.
+	//
+	// ProjectArn is a required field
+	ProjectArn *string `locationName:"projectArn" min:"32" type:"string" required:"true"`
+
+	// The type of network profile to create. Valid values are listed here.
+	Type *string `locationName:"type" type:"string" enum:"NetworkProfileType"`
+
+	// The data throughput rate in bits per second, as an integer from 0 to 104857600.
+	UplinkBandwidthBits *int64 `locationName:"uplinkBandwidthBits" type:"long"`
+
+	// Delay time for all packets to destination in milliseconds as an integer from
+	// 0 to 2000.
+	UplinkDelayMs *int64 `locationName:"uplinkDelayMs" type:"long"`
+
+	// Time variation in the delay of received packets in milliseconds as an integer
+	// from 0 to 2000.
+	UplinkJitterMs *int64 `locationName:"uplinkJitterMs" type:"long"`
+
+	// Proportion of transmitted packets that fail to arrive from 0 to 100 percent.
+	UplinkLossPercent *int64 `locationName:"uplinkLossPercent" type:"integer"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateNetworkProfileInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateNetworkProfileInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateNetworkProfileInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateNetworkProfileInput"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.ProjectArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ProjectArn"))
+	}
+	if s.ProjectArn != nil && len(*s.ProjectArn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("ProjectArn", 32))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDescription sets the Description field's value.
+func (s *CreateNetworkProfileInput) SetDescription(v string) *CreateNetworkProfileInput {
+	s.Description = &v
+	return s
+}
+
+// SetDownlinkBandwidthBits sets the DownlinkBandwidthBits field's value.
+func (s *CreateNetworkProfileInput) SetDownlinkBandwidthBits(v int64) *CreateNetworkProfileInput {
+	s.DownlinkBandwidthBits = &v
+	return s
+}
+
+// SetDownlinkDelayMs sets the DownlinkDelayMs field's value.
+func (s *CreateNetworkProfileInput) SetDownlinkDelayMs(v int64) *CreateNetworkProfileInput {
+	s.DownlinkDelayMs = &v
+	return s
+}
+
+// SetDownlinkJitterMs sets the DownlinkJitterMs field's value.
+func (s *CreateNetworkProfileInput) SetDownlinkJitterMs(v int64) *CreateNetworkProfileInput {
+	s.DownlinkJitterMs = &v
+	return s
+}
+
+// SetDownlinkLossPercent sets the DownlinkLossPercent field's value.
+func (s *CreateNetworkProfileInput) SetDownlinkLossPercent(v int64) *CreateNetworkProfileInput {
+	s.DownlinkLossPercent = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *CreateNetworkProfileInput) SetName(v string) *CreateNetworkProfileInput {
+	s.Name = &v
+	return s
+}
+
+// SetProjectArn sets the ProjectArn field's value.
+func (s *CreateNetworkProfileInput) SetProjectArn(v string) *CreateNetworkProfileInput {
+	s.ProjectArn = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *CreateNetworkProfileInput) SetType(v string) *CreateNetworkProfileInput {
+	s.Type = &v
+	return s
+}
+
+// SetUplinkBandwidthBits sets the UplinkBandwidthBits field's value.
+func (s *CreateNetworkProfileInput) SetUplinkBandwidthBits(v int64) *CreateNetworkProfileInput {
+	s.UplinkBandwidthBits = &v
+	return s
+}
+
+// SetUplinkDelayMs sets the UplinkDelayMs field's value.
+func (s *CreateNetworkProfileInput) SetUplinkDelayMs(v int64) *CreateNetworkProfileInput {
+	s.UplinkDelayMs = &v
+	return s
+}
+
+// SetUplinkJitterMs sets the UplinkJitterMs field's value.
+func (s *CreateNetworkProfileInput) SetUplinkJitterMs(v int64) *CreateNetworkProfileInput {
+	s.UplinkJitterMs = &v
+	return s
+}
+
+// SetUplinkLossPercent sets the UplinkLossPercent field's value.
+func (s *CreateNetworkProfileInput) SetUplinkLossPercent(v int64) *CreateNetworkProfileInput {
+	s.UplinkLossPercent = &v
+	return s
+}
+
+type CreateNetworkProfileOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The network profile that is returned by the create network profile request.
+	NetworkProfile *NetworkProfile `locationName:"networkProfile" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateNetworkProfileOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateNetworkProfileOutput) GoString() string {
+	return s.String()
+}
+
+// SetNetworkProfile sets the NetworkProfile field's value.
+func (s *CreateNetworkProfileOutput) SetNetworkProfile(v *NetworkProfile) *CreateNetworkProfileOutput {
+	s.NetworkProfile = v
+	return s
+}
+
+// Represents a request to the create project operation.
+type CreateProjectInput struct {
+	_ struct{} `type:"structure"`
+
+	// Sets the execution timeout value (in minutes) for a project. All test runs
+	// in this project use the specified execution timeout value unless overridden
+	// when scheduling a run.
+	DefaultJobTimeoutMinutes *int64 `locationName:"defaultJobTimeoutMinutes" type:"integer"`
+
+	// The project's name.
+	//
+	// Name is a required field
+	Name *string `locationName:"name" type:"string" required:"true"`
+
+	// The VPC security groups and subnets that are attached to a project.
+	VpcConfig *VpcConfig `locationName:"vpcConfig" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateProjectInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateProjectInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateProjectInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateProjectInput"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.VpcConfig != nil {
+		if err := s.VpcConfig.Validate(); err != nil {
+			invalidParams.AddNested("VpcConfig", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDefaultJobTimeoutMinutes sets the DefaultJobTimeoutMinutes field's value.
+func (s *CreateProjectInput) SetDefaultJobTimeoutMinutes(v int64) *CreateProjectInput {
+	s.DefaultJobTimeoutMinutes = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *CreateProjectInput) SetName(v string) *CreateProjectInput {
+	s.Name = &v
+	return s
+}
+
+// SetVpcConfig sets the VpcConfig field's value.
+func (s *CreateProjectInput) SetVpcConfig(v *VpcConfig) *CreateProjectInput {
+	s.VpcConfig = v
+	return s
+}
+
+// Represents the result of a create project request.
+type CreateProjectOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The newly created project.
+	Project *Project `locationName:"project" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateProjectOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateProjectOutput) GoString() string {
+	return s.String()
+}
+
+// SetProject sets the Project field's value.
+func (s *CreateProjectOutput) SetProject(v *Project) *CreateProjectOutput {
+	s.Project = v
+	return s
+}
+
+// Configuration settings for a remote access session, including billing method.
+type CreateRemoteAccessSessionConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	// The billing method for the remote access session.
+	BillingMethod *string `locationName:"billingMethod" type:"string" enum:"BillingMethod"`
+
+	// An array of ARNs included in the VPC endpoint configuration.
+	VpceConfigurationArns []*string `locationName:"vpceConfigurationArns" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateRemoteAccessSessionConfiguration) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateRemoteAccessSessionConfiguration) GoString() string {
+	return s.String()
+}
+
+// SetBillingMethod sets the BillingMethod field's value.
+func (s *CreateRemoteAccessSessionConfiguration) SetBillingMethod(v string) *CreateRemoteAccessSessionConfiguration {
+	s.BillingMethod = &v
+	return s
+}
+
+// SetVpceConfigurationArns sets the VpceConfigurationArns field's value.
+func (s *CreateRemoteAccessSessionConfiguration) SetVpceConfigurationArns(v []*string) *CreateRemoteAccessSessionConfiguration {
+	s.VpceConfigurationArns = v
+	return s
+}
+
+// Creates and submits a request to start a remote access session.
+type CreateRemoteAccessSessionInput struct {
+	_ struct{} `type:"structure"`
+
+	// Unique identifier for the client. If you want access to multiple devices
+	// on the same client, you should pass the same clientId value in each call
+	// to CreateRemoteAccessSession. This identifier is required only if remoteDebugEnabled
+	// is set to true.
+	//
+	// Remote debugging is no longer supported (https://docs.aws.amazon.com/devicefarm/latest/developerguide/history.html).
+	ClientId *string `locationName:"clientId" type:"string"`
+
+	// The configuration information for the remote access session request.
+	Configuration *CreateRemoteAccessSessionConfiguration `locationName:"configuration" type:"structure"`
+
+	// The ARN of the device for which you want to create a remote access session.
+	//
+	// DeviceArn is a required field
+	DeviceArn *string `locationName:"deviceArn" min:"32" type:"string" required:"true"`
+
+	// The Amazon Resource Name (ARN) of the device instance for which you want
+	// to create a remote access session.
+	InstanceArn *string `locationName:"instanceArn" min:"32" type:"string"`
+
+	// The interaction mode of the remote access session. Valid values are:
+	//
+	//    * INTERACTIVE: You can interact with the iOS device by viewing, touching,
+	//    and rotating the screen. You cannot run XCUITest framework-based tests
+	//    in this mode.
+	//
+	//    * NO_VIDEO: You are connected to the device, but cannot interact with
+	//    it or view the screen. This mode has the fastest test execution speed.
+	//    You can run XCUITest framework-based tests in this mode.
+	//
+	//    * VIDEO_ONLY: You can view the screen, but cannot touch or rotate it.
+	//    You can run XCUITest framework-based tests and watch the screen in this
+	//    mode.
+	InteractionMode *string `locationName:"interactionMode" type:"string" enum:"InteractionMode"`
+
+	// The name of the remote access session to create.
+	Name *string `locationName:"name" type:"string"`
+
+	// The Amazon Resource Name (ARN) of the project for which you want to create
+	// a remote access session.
+	//
+	// ProjectArn is a required field
+	ProjectArn *string `locationName:"projectArn" min:"32" type:"string" required:"true"`
+
+	// Set to true if you want to access devices remotely for debugging in your
+	// remote access session.
+	//
+	// Remote debugging is no longer supported (https://docs.aws.amazon.com/devicefarm/latest/developerguide/history.html).
+	RemoteDebugEnabled *bool `locationName:"remoteDebugEnabled" type:"boolean"`
+
+	// The Amazon Resource Name (ARN) for the app to be recorded in the remote access
+	// session.
+	RemoteRecordAppArn *string `locationName:"remoteRecordAppArn" min:"32" type:"string"`
+
+	// Set to true to enable remote recording for the remote access session.
+	RemoteRecordEnabled *bool `locationName:"remoteRecordEnabled" type:"boolean"`
+
+	// When set to true, for private devices, Device Farm does not sign your app
+	// again. For public devices, Device Farm always signs your apps again.
+	//
+	// For more information on how Device Farm modifies your uploads during tests,
+	// see Do you modify my app? (http://aws.amazon.com/device-farm/faqs/)
+	SkipAppResign *bool `locationName:"skipAppResign" type:"boolean"`
+
+	// Ignored. The public key of the ssh key pair you want to use for connecting
+	// to remote devices in your remote debugging session. This key is required
+	// only if remoteDebugEnabled is set to true.
+	//
+	// Remote debugging is no longer supported (https://docs.aws.amazon.com/devicefarm/latest/developerguide/history.html).
+	SshPublicKey *string `locationName:"sshPublicKey" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateRemoteAccessSessionInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateRemoteAccessSessionInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateRemoteAccessSessionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateRemoteAccessSessionInput"}
+	if s.DeviceArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("DeviceArn"))
+	}
+	if s.DeviceArn != nil && len(*s.DeviceArn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("DeviceArn", 32))
+	}
+	if s.InstanceArn != nil && len(*s.InstanceArn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("InstanceArn", 32))
+	}
+	if s.ProjectArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ProjectArn"))
+	}
+	if s.ProjectArn != nil && len(*s.ProjectArn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("ProjectArn", 32))
+	}
+	if s.RemoteRecordAppArn != nil && len(*s.RemoteRecordAppArn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("RemoteRecordAppArn", 32))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetClientId sets the ClientId field's value.
+func (s *CreateRemoteAccessSessionInput) SetClientId(v string) *CreateRemoteAccessSessionInput {
+	s.ClientId = &v
+	return s
+}
+
+// SetConfiguration sets the Configuration field's value.
+func (s *CreateRemoteAccessSessionInput) SetConfiguration(v *CreateRemoteAccessSessionConfiguration) *CreateRemoteAccessSessionInput {
+	s.Configuration = v
+	return s
+}
+
+// SetDeviceArn sets the DeviceArn field's value.
+func (s *CreateRemoteAccessSessionInput) SetDeviceArn(v string) *CreateRemoteAccessSessionInput {
+	s.DeviceArn = &v
+	return s
+}
+
+// SetInstanceArn sets the InstanceArn field's value.
+func (s *CreateRemoteAccessSessionInput) SetInstanceArn(v string) *CreateRemoteAccessSessionInput {
+	s.InstanceArn = &v
+	return s
+}
+
+// SetInteractionMode sets the InteractionMode field's value.
+func (s *CreateRemoteAccessSessionInput) SetInteractionMode(v string) *CreateRemoteAccessSessionInput {
+	s.InteractionMode = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *CreateRemoteAccessSessionInput) SetName(v string) *CreateRemoteAccessSessionInput {
+	s.Name = &v
+	return s
+}
+
+// SetProjectArn sets the ProjectArn field's value.
+func (s *CreateRemoteAccessSessionInput) SetProjectArn(v string) *CreateRemoteAccessSessionInput {
+	s.ProjectArn = &v
+	return s
+}
+
+// SetRemoteDebugEnabled sets the RemoteDebugEnabled field's value.
+func (s *CreateRemoteAccessSessionInput) SetRemoteDebugEnabled(v bool) *CreateRemoteAccessSessionInput {
+	s.RemoteDebugEnabled = &v
+	return s
+}
+
+// SetRemoteRecordAppArn sets the RemoteRecordAppArn field's value.
+func (s *CreateRemoteAccessSessionInput) SetRemoteRecordAppArn(v string) *CreateRemoteAccessSessionInput {
+	s.RemoteRecordAppArn = &v
+	return s
+}
+
+// SetRemoteRecordEnabled sets the RemoteRecordEnabled field's value.
+func (s *CreateRemoteAccessSessionInput) SetRemoteRecordEnabled(v bool) *CreateRemoteAccessSessionInput {
+	s.RemoteRecordEnabled = &v
+	return s
+}
+
+// SetSkipAppResign sets the SkipAppResign field's value.
+func (s *CreateRemoteAccessSessionInput) SetSkipAppResign(v bool) *CreateRemoteAccessSessionInput {
+	s.SkipAppResign = &v
+	return s
+}
+
+// SetSshPublicKey sets the SshPublicKey field's value.
+func (s *CreateRemoteAccessSessionInput) SetSshPublicKey(v string) *CreateRemoteAccessSessionInput {
+	s.SshPublicKey = &v
+	return s
+}
+
+// Represents the server response from a request to create a remote access session.
+type CreateRemoteAccessSessionOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A container that describes the remote access session when the request to
+	// create a remote access session is sent.
+	RemoteAccessSession *RemoteAccessSession `locationName:"remoteAccessSession" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateRemoteAccessSessionOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateRemoteAccessSessionOutput) GoString() string {
+	return s.String()
+}
+
+// SetRemoteAccessSession sets the RemoteAccessSession field's value.
+func (s *CreateRemoteAccessSessionOutput) SetRemoteAccessSession(v *RemoteAccessSession) *CreateRemoteAccessSessionOutput {
+	s.RemoteAccessSession = v
+	return s
+}
+
+type CreateTestGridProjectInput struct {
+	_ struct{} `type:"structure"`
+
+	// Human-readable description of the project.
+	Description *string `locationName:"description" min:"1" type:"string"`
+
+	// Human-readable name of the Selenium testing project.
+	//
+	// Name is a required field
+	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
+
+	// The VPC security groups and subnets that are attached to a project.
+	VpcConfig *TestGridVpcConfig `locationName:"vpcConfig" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTestGridProjectInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTestGridProjectInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateTestGridProjectInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateTestGridProjectInput"}
+	if s.Description != nil && len(*s.Description) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Description", 1))
+	}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+	if s.VpcConfig != nil {
+		if err := s.VpcConfig.Validate(); err != nil {
+			invalidParams.AddNested("VpcConfig", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDescription sets the Description field's value.
+func (s *CreateTestGridProjectInput) SetDescription(v string) *CreateTestGridProjectInput {
+	s.Description = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *CreateTestGridProjectInput) SetName(v string) *CreateTestGridProjectInput {
+	s.Name = &v
+	return s
+}
+
+// SetVpcConfig sets the VpcConfig field's value.
+func (s *CreateTestGridProjectInput) SetVpcConfig(v *TestGridVpcConfig) *CreateTestGridProjectInput {
+	s.VpcConfig = v
+	return s
+}
+
+type CreateTestGridProjectOutput struct {
+	_ struct{} `type:"structure"`
+
+	// ARN of the Selenium testing project that was created.
+	TestGridProject *TestGridProject `locationName:"testGridProject" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTestGridProjectOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTestGridProjectOutput) GoString() string {
+	return s.String()
+}
+
+// SetTestGridProject sets the TestGridProject field's value.
+func (s *CreateTestGridProjectOutput) SetTestGridProject(v *TestGridProject) *CreateTestGridProjectOutput {
+	s.TestGridProject = v
+	return s
+}
+
+type CreateTestGridUrlInput struct {
+	_ struct{} `type:"structure"`
+
+	// Lifetime, in seconds, of the URL.
+	//
+	// ExpiresInSeconds is a required field
+	ExpiresInSeconds *int64 `locationName:"expiresInSeconds" min:"60" type:"integer" required:"true"`
+
+	// ARN (from CreateTestGridProject or ListTestGridProjects) to associate with
+	// the short-term URL.
+	//
+	// ProjectArn is a required field
+	ProjectArn *string `locationName:"projectArn" min:"32" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTestGridUrlInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTestGridUrlInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateTestGridUrlInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateTestGridUrlInput"}
+	if s.ExpiresInSeconds == nil {
+		invalidParams.Add(request.NewErrParamRequired("ExpiresInSeconds"))
+	}
+	if s.ExpiresInSeconds != nil && *s.ExpiresInSeconds < 60 {
+		invalidParams.Add(request.NewErrParamMinValue("ExpiresInSeconds", 60))
+	}
+	if s.ProjectArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ProjectArn"))
+	}
+	if s.ProjectArn != nil && len(*s.ProjectArn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("ProjectArn", 32))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetExpiresInSeconds sets the ExpiresInSeconds field's value.
+func (s *CreateTestGridUrlInput) SetExpiresInSeconds(v int64) *CreateTestGridUrlInput {
+	s.ExpiresInSeconds = &v
+	return s
 }
 
-// SetUnmeteredDevices sets the UnmeteredDevices field's value.
-func (s *AccountSettings) SetUnmeteredDevices(v map[string]*int64) *AccountSettings {
-	s.UnmeteredDevices = v
+// SetProjectArn sets the ProjectArn field's value.
+func (s *CreateTestGridUrlInput) SetProjectArn(v string) *CreateTestGridUrlInput {
+	s.ProjectArn = &v
 	return s
 }
 
-// SetUnmeteredRemoteAccessDevices sets the UnmeteredRemoteAccessDevices field's value.
-func (s *AccountSettings) SetUnmeteredRemoteAccessDevices(v map[string]*int64) *AccountSettings {
-	s.UnmeteredRemoteAccessDevices = v
+type CreateTestGridUrlOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The number of seconds the URL from CreateTestGridUrlResult$url stays active.
+	Expires *time.Time `locationName:"expires" type:"timestamp"`
+
+	// A signed URL, expiring in CreateTestGridUrlRequest$expiresInSeconds seconds,
+	// to be passed to a RemoteWebDriver.
+	//
+	// Url is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by CreateTestGridUrlOutput's
+	// String and GoString methods.
+	Url *string `locationName:"url" type:"string" sensitive:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTestGridUrlOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTestGridUrlOutput) GoString() string {
+	return s.String()
+}
+
+// SetExpires sets the Expires field's value.
+func (s *CreateTestGridUrlOutput) SetExpires(v time.Time) *CreateTestGridUrlOutput {
+	s.Expires = &v
 	return s
 }
 
-// Represents the output of a test. Examples of artifacts include logs and screenshots.
-type Artifact struct {
+// SetUrl sets the Url field's value.
+func (s *CreateTestGridUrlOutput) SetUrl(v string) *CreateTestGridUrlOutput {
+	s.Url = &v
+	return s
+}
+
+// Represents a request to the create upload operation.
+type CreateUploadInput struct {
 	_ struct{} `type:"structure"`
 
-	// The artifact's ARN.
-	Arn *string `locationName:"arn" min:"32" type:"string"`
+	// The upload's content type (for example, application/octet-stream).
+	ContentType *string `locationName:"contentType" type:"string"`
 
-	// The artifact's file extension.
-	Extension *string `locationName:"extension" type:"string"`
+	// The upload's file name. The name should not contain any forward slashes (/).
+	// If you are uploading an iOS app, the file name must end with the .ipa extension.
+	// If you are uploading an Android app, the file name must end with the .apk
+	// extension. For all others, the file name must end with the .zip file extension.
+	//
+	// Name is a required field
+	Name *string `locationName:"name" type:"string" required:"true"`
 
-	// The artifact's name.
-	Name *string `locationName:"name" type:"string"`
+	// The ARN of the project for the upload.
+	//
+	// ProjectArn is a required field
+	ProjectArn *string `locationName:"projectArn" min:"32" type:"string" required:"true"`
 
-	// The artifact's type.
+	// The upload's upload type.
 	//
-	// Allowed values include the following:
+	// Must be one of the following values:
 	//
-	//    * UNKNOWN: An unknown type.
+	//    * ANDROID_APP
 	//
-	//    * SCREENSHOT: The screenshot type.
+	//    * IOS_APP
 	//
-	//    * DEVICE_LOG: The device log type.
+	//    * WEB_APP
 	//
-	//    * MESSAGE_LOG: The message log type.
+	//    * EXTERNAL_DATA
 	//
-	//    * VIDEO_LOG: The video log type.
+	//    * APPIUM_JAVA_JUNIT_TEST_PACKAGE
 	//
-	//    * RESULT_LOG: The result log type.
+	//    * APPIUM_JAVA_TESTNG_TEST_PACKAGE
 	//
-	//    * SERVICE_LOG: The service log type.
+	//    * APPIUM_PYTHON_TEST_PACKAGE
 	//
-	//    * WEBKIT_LOG: The web kit log type.
+	//    * APPIUM_NODE_TEST_PACKAGE
 	//
-	//    * INSTRUMENTATION_OUTPUT: The instrumentation type.
+	//    * APPIUM_RUBY_TEST_PACKAGE
 	//
-	//    * EXERCISER_MONKEY_OUTPUT: For Android, the artifact (log) generated by
-	//    an Android fuzz test.
+	//    * APPIUM_WEB_JAVA_JUNIT_TEST_PACKAGE
 	//
-	//    * CALABASH_JSON_OUTPUT: The Calabash JSON output type.
+	//    * APPIUM_WEB_JAVA_TESTNG_TEST_PACKAGE
 	//
-	//    * CALABASH_PRETTY_OUTPUT: The Calabash pretty output type.
+	//    * APPIUM_WEB_PYTHON_TEST_PACKAGE
 	//
-	//    * CALABASH_STANDARD_OUTPUT: The Calabash standard output type.
+	//    * APPIUM_WEB_NODE_TEST_PACKAGE
 	//
-	//    * CALABASH_JAVA_XML_OUTPUT: The Calabash Java XML output type.
+	//    * APPIUM_WEB_RUBY_TEST_PACKAGE
 	//
-	//    * AUTOMATION_OUTPUT: The automation output type.
+	//    * CALABASH_TEST_PACKAGE
 	//
-	//    * APPIUM_SERVER_OUTPUT: The Appium server output type.
+	//    * INSTRUMENTATION_TEST_PACKAGE
 	//
-	//    * APPIUM_JAVA_OUTPUT: The Appium Java output type.
+	//    * UIAUTOMATION_TEST_PACKAGE
 	//
-	//    * APPIUM_JAVA_XML_OUTPUT: The Appium Java XML output type.
+	//    * UIAUTOMATOR_TEST_PACKAGE
 	//
-	//    * APPIUM_PYTHON_OUTPUT: The Appium Python output type.
+	//    * XCTEST_TEST_PACKAGE
 	//
-	//    * APPIUM_PYTHON_XML_OUTPUT: The Appium Python XML output type.
+	//    * XCTEST_UI_TEST_PACKAGE
 	//
-	//    * EXPLORER_EVENT_LOG: The Explorer event log output type.
+	//    * APPIUM_JAVA_JUNIT_TEST_SPEC
 	//
-	//    * EXPLORER_SUMMARY_LOG: The Explorer summary log output type.
+	//    * APPIUM_JAVA_TESTNG_TEST_SPEC
 	//
-	//    * APPLICATION_CRASH_REPORT: The application crash report output type.
+	//    * APPIUM_PYTHON_TEST_SPEC
 	//
-	//    * XCTEST_LOG: The Xcode test output type.
+	//    * APPIUM_NODE_TEST_SPEC
 	//
-	//    * VIDEO: The Video output type.
+	//    * APPIUM_RUBY_TEST_SPEC
 	//
-	//    * CUSTOMER_ARTIFACT:The Customer Artifact output type.
+	//    * APPIUM_WEB_JAVA_JUNIT_TEST_SPEC
 	//
-	//    * CUSTOMER_ARTIFACT_LOG: The Customer Artifact Log output type.
+	//    * APPIUM_WEB_JAVA_TESTNG_TEST_SPEC
 	//
-	//    * TESTSPEC_OUTPUT: The Test Spec Output type.
-	Type *string `locationName:"type" type:"string" enum:"ArtifactType"`
-
-	// The pre-signed Amazon S3 URL that can be used with a corresponding GET request
-	// to download the artifact's file.
-	Url *string `locationName:"url" type:"string"`
+	//    * APPIUM_WEB_PYTHON_TEST_SPEC
+	//
+	//    * APPIUM_WEB_NODE_TEST_SPEC
+	//
+	//    * APPIUM_WEB_RUBY_TEST_SPEC
+	//
+	//    * INSTRUMENTATION_TEST_SPEC
+	//
+	//    * XCTEST_UI_TEST_SPEC
+	//
+	// If you call CreateUpload with WEB_APP specified, AWS Device Farm throws an
+	// ArgumentException error.
+	//
+	// Type is a required field
+	Type *string `locationName:"type" type:"string" required:"true" enum:"UploadType"`
 }
 
-// String returns the string representation
-func (s Artifact) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateUploadInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Artifact) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateUploadInput) GoString() string {
 	return s.String()
 }
 
-// SetArn sets the Arn field's value.
-func (s *Artifact) SetArn(v string) *Artifact {
-	s.Arn = &v
-	return s
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateUploadInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateUploadInput"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.ProjectArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ProjectArn"))
+	}
+	if s.ProjectArn != nil && len(*s.ProjectArn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("ProjectArn", 32))
+	}
+	if s.Type == nil {
+		invalidParams.Add(request.NewErrParamRequired("Type"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetExtension sets the Extension field's value.
-func (s *Artifact) SetExtension(v string) *Artifact {
-	s.Extension = &v
+// SetContentType sets the ContentType field's value.
+func (s *CreateUploadInput) SetContentType(v string) *CreateUploadInput {
+	s.ContentType = &v
 	return s
 }
 
 // SetName sets the Name field's value.
-func (s *Artifact) SetName(v string) *Artifact {
+func (s *CreateUploadInput) SetName(v string) *CreateUploadInput {
 	s.Name = &v
 	return s
 }
 
+// SetProjectArn sets the ProjectArn field's value.
+func (s *CreateUploadInput) SetProjectArn(v string) *CreateUploadInput {
+	s.ProjectArn = &v
+	return s
+}
+
 // SetType sets the Type field's value.
-func (s *Artifact) SetType(v string) *Artifact {
+func (s *CreateUploadInput) SetType(v string) *CreateUploadInput {
 	s.Type = &v
 	return s
 }
 
-// SetUrl sets the Url field's value.
-func (s *Artifact) SetUrl(v string) *Artifact {
-	s.Url = &v
+// Represents the result of a create upload request.
+type CreateUploadOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The newly created upload.
+	Upload *Upload `locationName:"upload" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateUploadOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateUploadOutput) GoString() string {
+	return s.String()
+}
+
+// SetUpload sets the Upload field's value.
+func (s *CreateUploadOutput) SetUpload(v *Upload) *CreateUploadOutput {
+	s.Upload = v
 	return s
 }
 
-// Represents the amount of CPU that an app is using on a physical device.
+type CreateVPCEConfigurationInput struct {
+	_ struct{} `type:"structure"`
+
+	// The DNS name of the service running in your VPC that you want Device Farm
+	// to test.
+	//
+	// ServiceDnsName is a required field
+	ServiceDnsName *string `locationName:"serviceDnsName" type:"string" required:"true"`
+
+	// An optional description that provides details about your VPC endpoint configuration.
+	VpceConfigurationDescription *string `locationName:"vpceConfigurationDescription" type:"string"`
+
+	// The friendly name you give to your VPC endpoint configuration, to manage
+	// your configurations more easily.
+	//
+	// VpceConfigurationName is a required field
+	VpceConfigurationName *string `locationName:"vpceConfigurationName" type:"string" required:"true"`
+
+	// The name of the VPC endpoint service running in your AWS account that you
+	// want Device Farm to test.
+	//
+	// VpceServiceName is a required field
+	VpceServiceName *string `locationName:"vpceServiceName" type:"string" required:"true"`
+}
+
+// String returns the string representation.
 //
-// Note that this does not represent system-wide CPU usage.
-type CPU struct {
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVPCEConfigurationInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVPCEConfigurationInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateVPCEConfigurationInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateVPCEConfigurationInput"}
+	if s.ServiceDnsName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ServiceDnsName"))
+	}
+	if s.VpceConfigurationName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VpceConfigurationName"))
+	}
+	if s.VpceServiceName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VpceServiceName"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetServiceDnsName sets the ServiceDnsName field's value.
+func (s *CreateVPCEConfigurationInput) SetServiceDnsName(v string) *CreateVPCEConfigurationInput {
+	s.ServiceDnsName = &v
+	return s
+}
+
+// SetVpceConfigurationDescription sets the VpceConfigurationDescription field's value.
+func (s *CreateVPCEConfigurationInput) SetVpceConfigurationDescription(v string) *CreateVPCEConfigurationInput {
+	s.VpceConfigurationDescription = &v
+	return s
+}
+
+// SetVpceConfigurationName sets the VpceConfigurationName field's value.
+func (s *CreateVPCEConfigurationInput) SetVpceConfigurationName(v string) *CreateVPCEConfigurationInput {
+	s.VpceConfigurationName = &v
+	return s
+}
+
+// SetVpceServiceName sets the VpceServiceName field's value.
+func (s *CreateVPCEConfigurationInput) SetVpceServiceName(v string) *CreateVPCEConfigurationInput {
+	s.VpceServiceName = &v
+	return s
+}
+
+type CreateVPCEConfigurationOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The CPU's architecture, for example x86 or ARM.
-	Architecture *string `locationName:"architecture" type:"string"`
+	// An object that contains information about your VPC endpoint configuration.
+	VpceConfiguration *VPCEConfiguration `locationName:"vpceConfiguration" type:"structure"`
+}
 
-	// The clock speed of the device's CPU, expressed in hertz (Hz). For example,
-	// a 1.2 GHz CPU is expressed as 1200000000.
-	Clock *float64 `locationName:"clock" type:"double"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVPCEConfigurationOutput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// The CPU's frequency.
-	Frequency *string `locationName:"frequency" type:"string"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVPCEConfigurationOutput) GoString() string {
+	return s.String()
 }
 
-// String returns the string representation
-func (s CPU) String() string {
+// SetVpceConfiguration sets the VpceConfiguration field's value.
+func (s *CreateVPCEConfigurationOutput) SetVpceConfiguration(v *VPCEConfiguration) *CreateVPCEConfigurationOutput {
+	s.VpceConfiguration = v
+	return s
+}
+
+// A JSON object that specifies the paths where the artifacts generated by the
+// customer's tests, on the device or in the test environment, are pulled from.
+//
+// Specify deviceHostPaths and optionally specify either iosPaths or androidPaths.
+//
+// For web app tests, you can specify both iosPaths and androidPaths.
+type CustomerArtifactPaths struct {
+	_ struct{} `type:"structure"`
+
+	// Comma-separated list of paths on the Android device where the artifacts generated
+	// by the customer's tests are pulled from.
+	AndroidPaths []*string `locationName:"androidPaths" type:"list"`
+
+	// Comma-separated list of paths in the test execution environment where the
+	// artifacts generated by the customer's tests are pulled from.
+	DeviceHostPaths []*string `locationName:"deviceHostPaths" type:"list"`
+
+	// Comma-separated list of paths on the iOS device where the artifacts generated
+	// by the customer's tests are pulled from.
+	IosPaths []*string `locationName:"iosPaths" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CustomerArtifactPaths) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CPU) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CustomerArtifactPaths) GoString() string {
 	return s.String()
 }
 
-// SetArchitecture sets the Architecture field's value.
-func (s *CPU) SetArchitecture(v string) *CPU {
-	s.Architecture = &v
+// SetAndroidPaths sets the AndroidPaths field's value.
+func (s *CustomerArtifactPaths) SetAndroidPaths(v []*string) *CustomerArtifactPaths {
+	s.AndroidPaths = v
 	return s
 }
 
-// SetClock sets the Clock field's value.
-func (s *CPU) SetClock(v float64) *CPU {
-	s.Clock = &v
+// SetDeviceHostPaths sets the DeviceHostPaths field's value.
+func (s *CustomerArtifactPaths) SetDeviceHostPaths(v []*string) *CustomerArtifactPaths {
+	s.DeviceHostPaths = v
 	return s
 }
 
-// SetFrequency sets the Frequency field's value.
-func (s *CPU) SetFrequency(v string) *CPU {
-	s.Frequency = &v
+// SetIosPaths sets the IosPaths field's value.
+func (s *CustomerArtifactPaths) SetIosPaths(v []*string) *CustomerArtifactPaths {
+	s.IosPaths = v
 	return s
 }
 
-// Represents entity counters.
-type Counters struct {
+// Represents a request to the delete device pool operation.
+type DeleteDevicePoolInput struct {
 	_ struct{} `type:"structure"`
 
-	// The number of errored entities.
-	Errored *int64 `locationName:"errored" type:"integer"`
+	// Represents the Amazon Resource Name (ARN) of the Device Farm device pool
+	// to delete.
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+}
 
-	// The number of failed entities.
-	Failed *int64 `locationName:"failed" type:"integer"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDevicePoolInput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// The number of passed entities.
-	Passed *int64 `locationName:"passed" type:"integer"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDevicePoolInput) GoString() string {
+	return s.String()
+}
 
-	// The number of skipped entities.
-	Skipped *int64 `locationName:"skipped" type:"integer"`
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteDevicePoolInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteDevicePoolInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
+	}
+	if s.Arn != nil && len(*s.Arn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+	}
 
-	// The number of stopped entities.
-	Stopped *int64 `locationName:"stopped" type:"integer"`
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
 
-	// The total number of entities.
-	Total *int64 `locationName:"total" type:"integer"`
+// SetArn sets the Arn field's value.
+func (s *DeleteDevicePoolInput) SetArn(v string) *DeleteDevicePoolInput {
+	s.Arn = &v
+	return s
+}
 
-	// The number of warned entities.
-	Warned *int64 `locationName:"warned" type:"integer"`
+// Represents the result of a delete device pool request.
+type DeleteDevicePoolOutput struct {
+	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
-func (s Counters) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDevicePoolOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Counters) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDevicePoolOutput) GoString() string {
 	return s.String()
 }
 
-// SetErrored sets the Errored field's value.
-func (s *Counters) SetErrored(v int64) *Counters {
-	s.Errored = &v
-	return s
-}
+type DeleteInstanceProfileInput struct {
+	_ struct{} `type:"structure"`
 
-// SetFailed sets the Failed field's value.
-func (s *Counters) SetFailed(v int64) *Counters {
-	s.Failed = &v
-	return s
+	// The Amazon Resource Name (ARN) of the instance profile you are requesting
+	// to delete.
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 }
 
-// SetPassed sets the Passed field's value.
-func (s *Counters) SetPassed(v int64) *Counters {
-	s.Passed = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteInstanceProfileInput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetSkipped sets the Skipped field's value.
-func (s *Counters) SetSkipped(v int64) *Counters {
-	s.Skipped = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteInstanceProfileInput) GoString() string {
+	return s.String()
 }
 
-// SetStopped sets the Stopped field's value.
-func (s *Counters) SetStopped(v int64) *Counters {
-	s.Stopped = &v
-	return s
-}
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteInstanceProfileInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteInstanceProfileInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
+	}
+	if s.Arn != nil && len(*s.Arn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+	}
 
-// SetTotal sets the Total field's value.
-func (s *Counters) SetTotal(v int64) *Counters {
-	s.Total = &v
-	return s
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetWarned sets the Warned field's value.
-func (s *Counters) SetWarned(v int64) *Counters {
-	s.Warned = &v
+// SetArn sets the Arn field's value.
+func (s *DeleteInstanceProfileInput) SetArn(v string) *DeleteInstanceProfileInput {
+	s.Arn = &v
 	return s
 }
 
-// Represents a request to the create device pool operation.
-type CreateDevicePoolInput struct {
+type DeleteInstanceProfileOutput struct {
 	_ struct{} `type:"structure"`
+}
 
-	// The device pool's description.
-	Description *string `locationName:"description" type:"string"`
-
-	// The number of devices that Device Farm can add to your device pool. Device
-	// Farm adds devices that are available and that meet the criteria that you
-	// assign for the rules parameter. Depending on how many devices meet these
-	// constraints, your device pool might contain fewer devices than the value
-	// for this parameter.
-	//
-	// By specifying the maximum number of devices, you can control the costs that
-	// you incur by running tests.
-	MaxDevices *int64 `locationName:"maxDevices" type:"integer"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteInstanceProfileOutput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// The device pool's name.
-	//
-	// Name is a required field
-	Name *string `locationName:"name" type:"string" required:"true"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteInstanceProfileOutput) GoString() string {
+	return s.String()
+}
 
-	// The ARN of the project for the device pool.
-	//
-	// ProjectArn is a required field
-	ProjectArn *string `locationName:"projectArn" min:"32" type:"string" required:"true"`
+type DeleteNetworkProfileInput struct {
+	_ struct{} `type:"structure"`
 
-	// The device pool's rules.
+	// The ARN of the network profile to delete.
 	//
-	// Rules is a required field
-	Rules []*Rule `locationName:"rules" type:"list" required:"true"`
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateDevicePoolInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteNetworkProfileInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateDevicePoolInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteNetworkProfileInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateDevicePoolInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateDevicePoolInput"}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
-	}
-	if s.ProjectArn == nil {
-		invalidParams.Add(request.NewErrParamRequired("ProjectArn"))
-	}
-	if s.ProjectArn != nil && len(*s.ProjectArn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("ProjectArn", 32))
+func (s *DeleteNetworkProfileInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteNetworkProfileInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
 	}
-	if s.Rules == nil {
-		invalidParams.Add(request.NewErrParamRequired("Rules"))
+	if s.Arn != nil && len(*s.Arn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -7176,101 +9985,144 @@ func (s *CreateDevicePoolInput) Validate() error {
 	return nil
 }
 
-// SetDescription sets the Description field's value.
-func (s *CreateDevicePoolInput) SetDescription(v string) *CreateDevicePoolInput {
-	s.Description = &v
+// SetArn sets the Arn field's value.
+func (s *DeleteNetworkProfileInput) SetArn(v string) *DeleteNetworkProfileInput {
+	s.Arn = &v
 	return s
 }
 
-// SetMaxDevices sets the MaxDevices field's value.
-func (s *CreateDevicePoolInput) SetMaxDevices(v int64) *CreateDevicePoolInput {
-	s.MaxDevices = &v
-	return s
+type DeleteNetworkProfileOutput struct {
+	_ struct{} `type:"structure"`
 }
 
-// SetName sets the Name field's value.
-func (s *CreateDevicePoolInput) SetName(v string) *CreateDevicePoolInput {
-	s.Name = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteNetworkProfileOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetProjectArn sets the ProjectArn field's value.
-func (s *CreateDevicePoolInput) SetProjectArn(v string) *CreateDevicePoolInput {
-	s.ProjectArn = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteNetworkProfileOutput) GoString() string {
+	return s.String()
 }
 
-// SetRules sets the Rules field's value.
-func (s *CreateDevicePoolInput) SetRules(v []*Rule) *CreateDevicePoolInput {
-	s.Rules = v
+// Represents a request to the delete project operation.
+type DeleteProjectInput struct {
+	_ struct{} `type:"structure"`
+
+	// Represents the Amazon Resource Name (ARN) of the Device Farm project to delete.
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteProjectInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteProjectInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteProjectInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteProjectInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
+	}
+	if s.Arn != nil && len(*s.Arn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetArn sets the Arn field's value.
+func (s *DeleteProjectInput) SetArn(v string) *DeleteProjectInput {
+	s.Arn = &v
 	return s
 }
 
-// Represents the result of a create device pool request.
-type CreateDevicePoolOutput struct {
+// Represents the result of a delete project request.
+type DeleteProjectOutput struct {
 	_ struct{} `type:"structure"`
-
-	// The newly created device pool.
-	DevicePool *DevicePool `locationName:"devicePool" type:"structure"`
 }
 
-// String returns the string representation
-func (s CreateDevicePoolOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteProjectOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateDevicePoolOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteProjectOutput) GoString() string {
 	return s.String()
 }
 
-// SetDevicePool sets the DevicePool field's value.
-func (s *CreateDevicePoolOutput) SetDevicePool(v *DevicePool) *CreateDevicePoolOutput {
-	s.DevicePool = v
-	return s
-}
-
-type CreateInstanceProfileInput struct {
+// Represents the request to delete the specified remote access session.
+type DeleteRemoteAccessSessionInput struct {
 	_ struct{} `type:"structure"`
 
-	// The description of your instance profile.
-	Description *string `locationName:"description" type:"string"`
-
-	// An array of strings specifying the list of app packages that should not be
-	// cleaned up from the device after a test run is over.
-	//
-	// The list of packages is only considered if you set packageCleanup to true.
-	ExcludeAppPackagesFromCleanup []*string `locationName:"excludeAppPackagesFromCleanup" type:"list"`
-
-	// The name of your instance profile.
+	// The Amazon Resource Name (ARN) of the session for which you want to delete
+	// remote access.
 	//
-	// Name is a required field
-	Name *string `locationName:"name" type:"string" required:"true"`
-
-	// When set to true, Device Farm will remove app packages after a test run.
-	// The default value is false for private devices.
-	PackageCleanup *bool `locationName:"packageCleanup" type:"boolean"`
-
-	// When set to true, Device Farm will reboot the instance after a test run.
-	// The default value is true.
-	RebootAfterUse *bool `locationName:"rebootAfterUse" type:"boolean"`
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateInstanceProfileInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteRemoteAccessSessionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateInstanceProfileInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteRemoteAccessSessionInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateInstanceProfileInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateInstanceProfileInput"}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
+func (s *DeleteRemoteAccessSessionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteRemoteAccessSessionInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
+	}
+	if s.Arn != nil && len(*s.Arn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -7279,124 +10131,139 @@ func (s *CreateInstanceProfileInput) Validate() error {
 	return nil
 }
 
-// SetDescription sets the Description field's value.
-func (s *CreateInstanceProfileInput) SetDescription(v string) *CreateInstanceProfileInput {
-	s.Description = &v
-	return s
-}
-
-// SetExcludeAppPackagesFromCleanup sets the ExcludeAppPackagesFromCleanup field's value.
-func (s *CreateInstanceProfileInput) SetExcludeAppPackagesFromCleanup(v []*string) *CreateInstanceProfileInput {
-	s.ExcludeAppPackagesFromCleanup = v
+// SetArn sets the Arn field's value.
+func (s *DeleteRemoteAccessSessionInput) SetArn(v string) *DeleteRemoteAccessSessionInput {
+	s.Arn = &v
 	return s
 }
 
-// SetName sets the Name field's value.
-func (s *CreateInstanceProfileInput) SetName(v string) *CreateInstanceProfileInput {
-	s.Name = &v
-	return s
+// The response from the server when a request is made to delete the remote
+// access session.
+type DeleteRemoteAccessSessionOutput struct {
+	_ struct{} `type:"structure"`
 }
 
-// SetPackageCleanup sets the PackageCleanup field's value.
-func (s *CreateInstanceProfileInput) SetPackageCleanup(v bool) *CreateInstanceProfileInput {
-	s.PackageCleanup = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteRemoteAccessSessionOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetRebootAfterUse sets the RebootAfterUse field's value.
-func (s *CreateInstanceProfileInput) SetRebootAfterUse(v bool) *CreateInstanceProfileInput {
-	s.RebootAfterUse = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteRemoteAccessSessionOutput) GoString() string {
+	return s.String()
 }
 
-type CreateInstanceProfileOutput struct {
+// Represents a request to the delete run operation.
+type DeleteRunInput struct {
 	_ struct{} `type:"structure"`
 
-	// An object containing information about your instance profile.
-	InstanceProfile *InstanceProfile `locationName:"instanceProfile" type:"structure"`
+	// The Amazon Resource Name (ARN) for the run to delete.
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateInstanceProfileOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteRunInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateInstanceProfileOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteRunInput) GoString() string {
 	return s.String()
 }
 
-// SetInstanceProfile sets the InstanceProfile field's value.
-func (s *CreateInstanceProfileOutput) SetInstanceProfile(v *InstanceProfile) *CreateInstanceProfileOutput {
-	s.InstanceProfile = v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteRunInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteRunInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
+	}
+	if s.Arn != nil && len(*s.Arn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetArn sets the Arn field's value.
+func (s *DeleteRunInput) SetArn(v string) *DeleteRunInput {
+	s.Arn = &v
 	return s
 }
 
-type CreateNetworkProfileInput struct {
+// Represents the result of a delete run request.
+type DeleteRunOutput struct {
 	_ struct{} `type:"structure"`
+}
 
-	// The description of the network profile.
-	Description *string `locationName:"description" type:"string"`
-
-	// The data throughput rate in bits per second, as an integer from 0 to 104857600.
-	DownlinkBandwidthBits *int64 `locationName:"downlinkBandwidthBits" type:"long"`
-
-	// Delay time for all packets to destination in milliseconds as an integer from
-	// 0 to 2000.
-	DownlinkDelayMs *int64 `locationName:"downlinkDelayMs" type:"long"`
-
-	// Time variation in the delay of received packets in milliseconds as an integer
-	// from 0 to 2000.
-	DownlinkJitterMs *int64 `locationName:"downlinkJitterMs" type:"long"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteRunOutput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// Proportion of received packets that fail to arrive from 0 to 100 percent.
-	DownlinkLossPercent *int64 `locationName:"downlinkLossPercent" type:"integer"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteRunOutput) GoString() string {
+	return s.String()
+}
 
-	// The name you wish to specify for the new network profile.
-	//
-	// Name is a required field
-	Name *string `locationName:"name" type:"string" required:"true"`
+type DeleteTestGridProjectInput struct {
+	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the project for which you want to create
-	// a network profile.
+	// The ARN of the project to delete, from CreateTestGridProject or ListTestGridProjects.
 	//
 	// ProjectArn is a required field
 	ProjectArn *string `locationName:"projectArn" min:"32" type:"string" required:"true"`
-
-	// The type of network profile you wish to create. Valid values are listed below.
-	Type *string `locationName:"type" type:"string" enum:"NetworkProfileType"`
-
-	// The data throughput rate in bits per second, as an integer from 0 to 104857600.
-	UplinkBandwidthBits *int64 `locationName:"uplinkBandwidthBits" type:"long"`
-
-	// Delay time for all packets to destination in milliseconds as an integer from
-	// 0 to 2000.
-	UplinkDelayMs *int64 `locationName:"uplinkDelayMs" type:"long"`
-
-	// Time variation in the delay of received packets in milliseconds as an integer
-	// from 0 to 2000.
-	UplinkJitterMs *int64 `locationName:"uplinkJitterMs" type:"long"`
-
-	// Proportion of transmitted packets that fail to arrive from 0 to 100 percent.
-	UplinkLossPercent *int64 `locationName:"uplinkLossPercent" type:"integer"`
 }
 
-// String returns the string representation
-func (s CreateNetworkProfileInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteTestGridProjectInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateNetworkProfileInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteTestGridProjectInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateNetworkProfileInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateNetworkProfileInput"}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
-	}
+func (s *DeleteTestGridProjectInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteTestGridProjectInput"}
 	if s.ProjectArn == nil {
 		invalidParams.Add(request.NewErrParamRequired("ProjectArn"))
 	}
@@ -7410,131 +10277,70 @@ func (s *CreateNetworkProfileInput) Validate() error {
 	return nil
 }
 
-// SetDescription sets the Description field's value.
-func (s *CreateNetworkProfileInput) SetDescription(v string) *CreateNetworkProfileInput {
-	s.Description = &v
-	return s
-}
-
-// SetDownlinkBandwidthBits sets the DownlinkBandwidthBits field's value.
-func (s *CreateNetworkProfileInput) SetDownlinkBandwidthBits(v int64) *CreateNetworkProfileInput {
-	s.DownlinkBandwidthBits = &v
-	return s
-}
-
-// SetDownlinkDelayMs sets the DownlinkDelayMs field's value.
-func (s *CreateNetworkProfileInput) SetDownlinkDelayMs(v int64) *CreateNetworkProfileInput {
-	s.DownlinkDelayMs = &v
-	return s
-}
-
-// SetDownlinkJitterMs sets the DownlinkJitterMs field's value.
-func (s *CreateNetworkProfileInput) SetDownlinkJitterMs(v int64) *CreateNetworkProfileInput {
-	s.DownlinkJitterMs = &v
-	return s
-}
-
-// SetDownlinkLossPercent sets the DownlinkLossPercent field's value.
-func (s *CreateNetworkProfileInput) SetDownlinkLossPercent(v int64) *CreateNetworkProfileInput {
-	s.DownlinkLossPercent = &v
-	return s
-}
-
-// SetName sets the Name field's value.
-func (s *CreateNetworkProfileInput) SetName(v string) *CreateNetworkProfileInput {
-	s.Name = &v
-	return s
-}
-
 // SetProjectArn sets the ProjectArn field's value.
-func (s *CreateNetworkProfileInput) SetProjectArn(v string) *CreateNetworkProfileInput {
+func (s *DeleteTestGridProjectInput) SetProjectArn(v string) *DeleteTestGridProjectInput {
 	s.ProjectArn = &v
 	return s
 }
 
-// SetType sets the Type field's value.
-func (s *CreateNetworkProfileInput) SetType(v string) *CreateNetworkProfileInput {
-	s.Type = &v
-	return s
-}
-
-// SetUplinkBandwidthBits sets the UplinkBandwidthBits field's value.
-func (s *CreateNetworkProfileInput) SetUplinkBandwidthBits(v int64) *CreateNetworkProfileInput {
-	s.UplinkBandwidthBits = &v
-	return s
-}
-
-// SetUplinkDelayMs sets the UplinkDelayMs field's value.
-func (s *CreateNetworkProfileInput) SetUplinkDelayMs(v int64) *CreateNetworkProfileInput {
-	s.UplinkDelayMs = &v
-	return s
-}
-
-// SetUplinkJitterMs sets the UplinkJitterMs field's value.
-func (s *CreateNetworkProfileInput) SetUplinkJitterMs(v int64) *CreateNetworkProfileInput {
-	s.UplinkJitterMs = &v
-	return s
-}
-
-// SetUplinkLossPercent sets the UplinkLossPercent field's value.
-func (s *CreateNetworkProfileInput) SetUplinkLossPercent(v int64) *CreateNetworkProfileInput {
-	s.UplinkLossPercent = &v
-	return s
-}
-
-type CreateNetworkProfileOutput struct {
+type DeleteTestGridProjectOutput struct {
 	_ struct{} `type:"structure"`
-
-	// The network profile that is returned by the create network profile request.
-	NetworkProfile *NetworkProfile `locationName:"networkProfile" type:"structure"`
 }
 
-// String returns the string representation
-func (s CreateNetworkProfileOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteTestGridProjectOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateNetworkProfileOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteTestGridProjectOutput) GoString() string {
 	return s.String()
 }
 
-// SetNetworkProfile sets the NetworkProfile field's value.
-func (s *CreateNetworkProfileOutput) SetNetworkProfile(v *NetworkProfile) *CreateNetworkProfileOutput {
-	s.NetworkProfile = v
-	return s
-}
-
-// Represents a request to the create project operation.
-type CreateProjectInput struct {
+// Represents a request to the delete upload operation.
+type DeleteUploadInput struct {
 	_ struct{} `type:"structure"`
 
-	// Sets the execution timeout value (in minutes) for a project. All test runs
-	// in this project will use the specified execution timeout value unless overridden
-	// when scheduling a run.
-	DefaultJobTimeoutMinutes *int64 `locationName:"defaultJobTimeoutMinutes" type:"integer"`
-
-	// The project's name.
+	// Represents the Amazon Resource Name (ARN) of the Device Farm upload to delete.
 	//
-	// Name is a required field
-	Name *string `locationName:"name" type:"string" required:"true"`
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateProjectInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteUploadInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateProjectInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteUploadInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateProjectInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateProjectInput"}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
+func (s *DeleteUploadInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteUploadInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
+	}
+	if s.Arn != nil && len(*s.Arn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -7543,514 +10349,492 @@ func (s *CreateProjectInput) Validate() error {
 	return nil
 }
 
-// SetDefaultJobTimeoutMinutes sets the DefaultJobTimeoutMinutes field's value.
-func (s *CreateProjectInput) SetDefaultJobTimeoutMinutes(v int64) *CreateProjectInput {
-	s.DefaultJobTimeoutMinutes = &v
+// SetArn sets the Arn field's value.
+func (s *DeleteUploadInput) SetArn(v string) *DeleteUploadInput {
+	s.Arn = &v
 	return s
 }
 
-// SetName sets the Name field's value.
-func (s *CreateProjectInput) SetName(v string) *CreateProjectInput {
-	s.Name = &v
-	return s
+// Represents the result of a delete upload request.
+type DeleteUploadOutput struct {
+	_ struct{} `type:"structure"`
 }
 
-// Represents the result of a create project request.
-type CreateProjectOutput struct {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteUploadOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteUploadOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteVPCEConfigurationInput struct {
 	_ struct{} `type:"structure"`
 
-	// The newly created project.
-	Project *Project `locationName:"project" type:"structure"`
+	// The Amazon Resource Name (ARN) of the VPC endpoint configuration you want
+	// to delete.
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateProjectOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVPCEConfigurationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateProjectOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVPCEConfigurationInput) GoString() string {
 	return s.String()
 }
 
-// SetProject sets the Project field's value.
-func (s *CreateProjectOutput) SetProject(v *Project) *CreateProjectOutput {
-	s.Project = v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteVPCEConfigurationInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteVPCEConfigurationInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
+	}
+	if s.Arn != nil && len(*s.Arn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetArn sets the Arn field's value.
+func (s *DeleteVPCEConfigurationInput) SetArn(v string) *DeleteVPCEConfigurationInput {
+	s.Arn = &v
 	return s
 }
 
-// Configuration settings for a remote access session, including billing method.
-type CreateRemoteAccessSessionConfiguration struct {
+type DeleteVPCEConfigurationOutput struct {
 	_ struct{} `type:"structure"`
-
-	// The billing method for the remote access session.
-	BillingMethod *string `locationName:"billingMethod" type:"string" enum:"BillingMethod"`
-
-	// An array of Amazon Resource Names (ARNs) included in the VPC endpoint configuration.
-	VpceConfigurationArns []*string `locationName:"vpceConfigurationArns" type:"list"`
 }
 
-// String returns the string representation
-func (s CreateRemoteAccessSessionConfiguration) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVPCEConfigurationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateRemoteAccessSessionConfiguration) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVPCEConfigurationOutput) GoString() string {
 	return s.String()
 }
 
-// SetBillingMethod sets the BillingMethod field's value.
-func (s *CreateRemoteAccessSessionConfiguration) SetBillingMethod(v string) *CreateRemoteAccessSessionConfiguration {
-	s.BillingMethod = &v
-	return s
-}
+// Represents a device type that an app is tested against.
+type Device struct {
+	_ struct{} `type:"structure"`
 
-// SetVpceConfigurationArns sets the VpceConfigurationArns field's value.
-func (s *CreateRemoteAccessSessionConfiguration) SetVpceConfigurationArns(v []*string) *CreateRemoteAccessSessionConfiguration {
-	s.VpceConfigurationArns = v
-	return s
-}
+	// The device's ARN.
+	Arn *string `locationName:"arn" min:"32" type:"string"`
 
-// Creates and submits a request to start a remote access session.
-type CreateRemoteAccessSessionInput struct {
-	_ struct{} `type:"structure"`
+	// Indicates how likely a device is available for a test run. Currently available
+	// in the ListDevices and GetDevice API methods.
+	Availability *string `locationName:"availability" type:"string" enum:"DeviceAvailability"`
 
-	// Unique identifier for the client. If you want access to multiple devices
-	// on the same client, you should pass the same clientId value in each call
-	// to CreateRemoteAccessSession. This is required only if remoteDebugEnabled
-	// is set to true.
-	ClientId *string `locationName:"clientId" type:"string"`
+	// The device's carrier.
+	Carrier *string `locationName:"carrier" type:"string"`
 
-	// The configuration information for the remote access session request.
-	Configuration *CreateRemoteAccessSessionConfiguration `locationName:"configuration" type:"structure"`
+	// Information about the device's CPU.
+	Cpu *CPU `locationName:"cpu" type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the device for which you want to create
-	// a remote access session.
-	//
-	// DeviceArn is a required field
-	DeviceArn *string `locationName:"deviceArn" min:"32" type:"string" required:"true"`
+	// The name of the fleet to which this device belongs.
+	FleetName *string `locationName:"fleetName" type:"string"`
 
-	// The Amazon Resource Name (ARN) of the device instance for which you want
-	// to create a remote access session.
-	InstanceArn *string `locationName:"instanceArn" min:"32" type:"string"`
+	// The type of fleet to which this device belongs. Possible values are PRIVATE
+	// and PUBLIC.
+	FleetType *string `locationName:"fleetType" type:"string"`
 
-	// The interaction mode of the remote access session. Valid values are:
+	// The device's form factor.
 	//
-	//    * INTERACTIVE: You can interact with the iOS device by viewing, touching,
-	//    and rotating the screen. You cannot run XCUITest framework-based tests
-	//    in this mode.
+	// Allowed values include:
 	//
-	//    * NO_VIDEO: You are connected to the device but cannot interact with it
-	//    or view the screen. This mode has the fastest test execution speed. You
-	//    can run XCUITest framework-based tests in this mode.
+	//    * PHONE
 	//
-	//    * VIDEO_ONLY: You can view the screen but cannot touch or rotate it. You
-	//    can run XCUITest framework-based tests and watch the screen in this mode.
-	InteractionMode *string `locationName:"interactionMode" type:"string" enum:"InteractionMode"`
+	//    * TABLET
+	FormFactor *string `locationName:"formFactor" type:"string" enum:"DeviceFormFactor"`
+
+	// The device's heap size, expressed in bytes.
+	HeapSize *int64 `locationName:"heapSize" type:"long"`
+
+	// The device's image name.
+	Image *string `locationName:"image" type:"string"`
+
+	// The instances that belong to this device.
+	Instances []*DeviceInstance `locationName:"instances" type:"list"`
+
+	// The device's manufacturer name.
+	Manufacturer *string `locationName:"manufacturer" type:"string"`
+
+	// The device's total memory size, expressed in bytes.
+	Memory *int64 `locationName:"memory" type:"long"`
+
+	// The device's model name.
+	Model *string `locationName:"model" type:"string"`
+
+	// The device's model ID.
+	ModelId *string `locationName:"modelId" type:"string"`
 
-	// The name of the remote access session that you wish to create.
+	// The device's display name.
 	Name *string `locationName:"name" type:"string"`
 
-	// The Amazon Resource Name (ARN) of the project for which you want to create
-	// a remote access session.
-	//
-	// ProjectArn is a required field
-	ProjectArn *string `locationName:"projectArn" min:"32" type:"string" required:"true"`
+	// The device's operating system type.
+	Os *string `locationName:"os" type:"string"`
 
-	// Set to true if you want to access devices remotely for debugging in your
-	// remote access session.
-	RemoteDebugEnabled *bool `locationName:"remoteDebugEnabled" type:"boolean"`
+	// The device's platform.
+	//
+	// Allowed values include:
+	//
+	//    * ANDROID
+	//
+	//    * IOS
+	Platform *string `locationName:"platform" type:"string" enum:"DevicePlatform"`
 
-	// The Amazon Resource Name (ARN) for the app to be recorded in the remote access
-	// session.
-	RemoteRecordAppArn *string `locationName:"remoteRecordAppArn" min:"32" type:"string"`
+	// The device's radio.
+	Radio *string `locationName:"radio" type:"string"`
 
-	// Set to true to enable remote recording for the remote access session.
-	RemoteRecordEnabled *bool `locationName:"remoteRecordEnabled" type:"boolean"`
+	// Specifies whether remote access has been enabled for the specified device.
+	RemoteAccessEnabled *bool `locationName:"remoteAccessEnabled" type:"boolean"`
 
-	// When set to true, for private devices, Device Farm will not sign your app
-	// again. For public devices, Device Farm always signs your apps again and this
-	// parameter has no effect.
+	// This flag is set to true if remote debugging is enabled for the device.
 	//
-	// For more information about how Device Farm re-signs your app(s), see Do you
-	// modify my app? (https://aws.amazon.com/device-farm/faq/) in the AWS Device
-	// Farm FAQs.
-	SkipAppResign *bool `locationName:"skipAppResign" type:"boolean"`
+	// Remote debugging is no longer supported (https://docs.aws.amazon.com/devicefarm/latest/developerguide/history.html).
+	RemoteDebugEnabled *bool `locationName:"remoteDebugEnabled" type:"boolean"`
 
-	// The public key of the ssh key pair you want to use for connecting to remote
-	// devices in your remote debugging session. This is only required if remoteDebugEnabled
-	// is set to true.
-	SshPublicKey *string `locationName:"sshPublicKey" type:"string"`
+	// The resolution of the device.
+	Resolution *Resolution `locationName:"resolution" type:"structure"`
 }
 
-// String returns the string representation
-func (s CreateRemoteAccessSessionInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Device) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateRemoteAccessSessionInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Device) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateRemoteAccessSessionInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateRemoteAccessSessionInput"}
-	if s.DeviceArn == nil {
-		invalidParams.Add(request.NewErrParamRequired("DeviceArn"))
-	}
-	if s.DeviceArn != nil && len(*s.DeviceArn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("DeviceArn", 32))
-	}
-	if s.InstanceArn != nil && len(*s.InstanceArn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("InstanceArn", 32))
-	}
-	if s.ProjectArn == nil {
-		invalidParams.Add(request.NewErrParamRequired("ProjectArn"))
-	}
-	if s.ProjectArn != nil && len(*s.ProjectArn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("ProjectArn", 32))
-	}
-	if s.RemoteRecordAppArn != nil && len(*s.RemoteRecordAppArn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("RemoteRecordAppArn", 32))
-	}
+// SetArn sets the Arn field's value.
+func (s *Device) SetArn(v string) *Device {
+	s.Arn = &v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetAvailability sets the Availability field's value.
+func (s *Device) SetAvailability(v string) *Device {
+	s.Availability = &v
+	return s
 }
 
-// SetClientId sets the ClientId field's value.
-func (s *CreateRemoteAccessSessionInput) SetClientId(v string) *CreateRemoteAccessSessionInput {
-	s.ClientId = &v
+// SetCarrier sets the Carrier field's value.
+func (s *Device) SetCarrier(v string) *Device {
+	s.Carrier = &v
 	return s
 }
 
-// SetConfiguration sets the Configuration field's value.
-func (s *CreateRemoteAccessSessionInput) SetConfiguration(v *CreateRemoteAccessSessionConfiguration) *CreateRemoteAccessSessionInput {
-	s.Configuration = v
+// SetCpu sets the Cpu field's value.
+func (s *Device) SetCpu(v *CPU) *Device {
+	s.Cpu = v
 	return s
 }
 
-// SetDeviceArn sets the DeviceArn field's value.
-func (s *CreateRemoteAccessSessionInput) SetDeviceArn(v string) *CreateRemoteAccessSessionInput {
-	s.DeviceArn = &v
+// SetFleetName sets the FleetName field's value.
+func (s *Device) SetFleetName(v string) *Device {
+	s.FleetName = &v
 	return s
 }
 
-// SetInstanceArn sets the InstanceArn field's value.
-func (s *CreateRemoteAccessSessionInput) SetInstanceArn(v string) *CreateRemoteAccessSessionInput {
-	s.InstanceArn = &v
+// SetFleetType sets the FleetType field's value.
+func (s *Device) SetFleetType(v string) *Device {
+	s.FleetType = &v
 	return s
 }
 
-// SetInteractionMode sets the InteractionMode field's value.
-func (s *CreateRemoteAccessSessionInput) SetInteractionMode(v string) *CreateRemoteAccessSessionInput {
-	s.InteractionMode = &v
+// SetFormFactor sets the FormFactor field's value.
+func (s *Device) SetFormFactor(v string) *Device {
+	s.FormFactor = &v
 	return s
 }
 
-// SetName sets the Name field's value.
-func (s *CreateRemoteAccessSessionInput) SetName(v string) *CreateRemoteAccessSessionInput {
-	s.Name = &v
+// SetHeapSize sets the HeapSize field's value.
+func (s *Device) SetHeapSize(v int64) *Device {
+	s.HeapSize = &v
 	return s
 }
 
-// SetProjectArn sets the ProjectArn field's value.
-func (s *CreateRemoteAccessSessionInput) SetProjectArn(v string) *CreateRemoteAccessSessionInput {
-	s.ProjectArn = &v
+// SetImage sets the Image field's value.
+func (s *Device) SetImage(v string) *Device {
+	s.Image = &v
 	return s
 }
 
-// SetRemoteDebugEnabled sets the RemoteDebugEnabled field's value.
-func (s *CreateRemoteAccessSessionInput) SetRemoteDebugEnabled(v bool) *CreateRemoteAccessSessionInput {
-	s.RemoteDebugEnabled = &v
+// SetInstances sets the Instances field's value.
+func (s *Device) SetInstances(v []*DeviceInstance) *Device {
+	s.Instances = v
 	return s
 }
 
-// SetRemoteRecordAppArn sets the RemoteRecordAppArn field's value.
-func (s *CreateRemoteAccessSessionInput) SetRemoteRecordAppArn(v string) *CreateRemoteAccessSessionInput {
-	s.RemoteRecordAppArn = &v
+// SetManufacturer sets the Manufacturer field's value.
+func (s *Device) SetManufacturer(v string) *Device {
+	s.Manufacturer = &v
 	return s
 }
 
-// SetRemoteRecordEnabled sets the RemoteRecordEnabled field's value.
-func (s *CreateRemoteAccessSessionInput) SetRemoteRecordEnabled(v bool) *CreateRemoteAccessSessionInput {
-	s.RemoteRecordEnabled = &v
+// SetMemory sets the Memory field's value.
+func (s *Device) SetMemory(v int64) *Device {
+	s.Memory = &v
 	return s
 }
 
-// SetSkipAppResign sets the SkipAppResign field's value.
-func (s *CreateRemoteAccessSessionInput) SetSkipAppResign(v bool) *CreateRemoteAccessSessionInput {
-	s.SkipAppResign = &v
+// SetModel sets the Model field's value.
+func (s *Device) SetModel(v string) *Device {
+	s.Model = &v
 	return s
 }
 
-// SetSshPublicKey sets the SshPublicKey field's value.
-func (s *CreateRemoteAccessSessionInput) SetSshPublicKey(v string) *CreateRemoteAccessSessionInput {
-	s.SshPublicKey = &v
+// SetModelId sets the ModelId field's value.
+func (s *Device) SetModelId(v string) *Device {
+	s.ModelId = &v
 	return s
 }
 
-// Represents the server response from a request to create a remote access session.
-type CreateRemoteAccessSessionOutput struct {
-	_ struct{} `type:"structure"`
+// SetName sets the Name field's value.
+func (s *Device) SetName(v string) *Device {
+	s.Name = &v
+	return s
+}
 
-	// A container that describes the remote access session when the request to
-	// create a remote access session is sent.
-	RemoteAccessSession *RemoteAccessSession `locationName:"remoteAccessSession" type:"structure"`
+// SetOs sets the Os field's value.
+func (s *Device) SetOs(v string) *Device {
+	s.Os = &v
+	return s
 }
 
-// String returns the string representation
-func (s CreateRemoteAccessSessionOutput) String() string {
-	return awsutil.Prettify(s)
+// SetPlatform sets the Platform field's value.
+func (s *Device) SetPlatform(v string) *Device {
+	s.Platform = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s CreateRemoteAccessSessionOutput) GoString() string {
-	return s.String()
+// SetRadio sets the Radio field's value.
+func (s *Device) SetRadio(v string) *Device {
+	s.Radio = &v
+	return s
 }
 
-// SetRemoteAccessSession sets the RemoteAccessSession field's value.
-func (s *CreateRemoteAccessSessionOutput) SetRemoteAccessSession(v *RemoteAccessSession) *CreateRemoteAccessSessionOutput {
-	s.RemoteAccessSession = v
+// SetRemoteAccessEnabled sets the RemoteAccessEnabled field's value.
+func (s *Device) SetRemoteAccessEnabled(v bool) *Device {
+	s.RemoteAccessEnabled = &v
 	return s
 }
 
-// Represents a request to the create upload operation.
-type CreateUploadInput struct {
-	_ struct{} `type:"structure"`
+// SetRemoteDebugEnabled sets the RemoteDebugEnabled field's value.
+func (s *Device) SetRemoteDebugEnabled(v bool) *Device {
+	s.RemoteDebugEnabled = &v
+	return s
+}
 
-	// The upload's content type (for example, "application/octet-stream").
-	ContentType *string `locationName:"contentType" type:"string"`
+// SetResolution sets the Resolution field's value.
+func (s *Device) SetResolution(v *Resolution) *Device {
+	s.Resolution = v
+	return s
+}
 
-	// The upload's file name. The name should not contain the '/' character. If
-	// uploading an iOS app, the file name needs to end with the .ipa extension.
-	// If uploading an Android app, the file name needs to end with the .apk extension.
-	// For all others, the file name must end with the .zip file extension.
-	//
-	// Name is a required field
-	Name *string `locationName:"name" type:"string" required:"true"`
+// Represents a device filter used to select a set of devices to be included
+// in a test run. This data structure is passed in as the deviceSelectionConfiguration
+// parameter to ScheduleRun. For an example of the JSON request syntax, see
+// ScheduleRun.
+//
+// It is also passed in as the filters parameter to ListDevices. For an example
+// of the JSON request syntax, see ListDevices.
+type DeviceFilter struct {
+	_ struct{} `type:"structure"`
 
-	// The ARN of the project for the upload.
+	// The aspect of a device such as platform or model used as the selection criteria
+	// in a device filter.
 	//
-	// ProjectArn is a required field
-	ProjectArn *string `locationName:"projectArn" min:"32" type:"string" required:"true"`
-
-	// The upload's upload type.
+	// The supported operators for each attribute are provided in the following
+	// list.
 	//
-	// Must be one of the following values:
+	// ARN
 	//
-	//    * ANDROID_APP: An Android upload.
+	// The Amazon Resource Name (ARN) of the device (for example, arn:aws:devicefarm:us-west-2::device:12345Example).
 	//
-	//    * IOS_APP: An iOS upload.
+	// Supported operators: EQUALS, IN, NOT_IN
 	//
-	//    * WEB_APP: A web application upload.
+	// PLATFORM
 	//
-	//    * EXTERNAL_DATA: An external data upload.
+	// The device platform. Valid values are ANDROID or IOS.
 	//
-	//    * APPIUM_JAVA_JUNIT_TEST_PACKAGE: An Appium Java JUnit test package upload.
+	// Supported operators: EQUALS
 	//
-	//    * APPIUM_JAVA_TESTNG_TEST_PACKAGE: An Appium Java TestNG test package
-	//    upload.
+	// OS_VERSION
 	//
-	//    * APPIUM_PYTHON_TEST_PACKAGE: An Appium Python test package upload.
+	// The operating system version (for example, 10.3.2).
 	//
-	//    * APPIUM_NODE_TEST_PACKAGE: An Appium Node.js test package upload.
+	// Supported operators: EQUALS, GREATER_THAN, GREATER_THAN_OR_EQUALS, IN, LESS_THAN,
+	// LESS_THAN_OR_EQUALS, NOT_IN
 	//
-	//    * APPIUM_RUBY_TEST_PACKAGE: An Appium Ruby test package upload.
+	// MODEL
 	//
-	//    * APPIUM_WEB_JAVA_JUNIT_TEST_PACKAGE: An Appium Java JUnit test package
-	//    upload for a web app.
+	// The device model (for example, iPad 5th Gen).
 	//
-	//    * APPIUM_WEB_JAVA_TESTNG_TEST_PACKAGE: An Appium Java TestNG test package
-	//    upload for a web app.
+	// Supported operators: CONTAINS, EQUALS, IN, NOT_IN
 	//
-	//    * APPIUM_WEB_PYTHON_TEST_PACKAGE: An Appium Python test package upload
-	//    for a web app.
+	// AVAILABILITY
 	//
-	//    * APPIUM_WEB_NODE_TEST_PACKAGE: An Appium Node.js test package upload
-	//    for a web app.
+	// The current availability of the device. Valid values are AVAILABLE, HIGHLY_AVAILABLE,
+	// BUSY, or TEMPORARY_NOT_AVAILABLE.
 	//
-	//    * APPIUM_WEB_RUBY_TEST_PACKAGE: An Appium Ruby test package upload for
-	//    a web app.
+	// Supported operators: EQUALS
 	//
-	//    * CALABASH_TEST_PACKAGE: A Calabash test package upload.
+	// FORM_FACTOR
 	//
-	//    * INSTRUMENTATION_TEST_PACKAGE: An instrumentation upload.
+	// The device form factor. Valid values are PHONE or TABLET.
 	//
-	//    * UIAUTOMATION_TEST_PACKAGE: A uiautomation test package upload.
+	// Supported operators: EQUALS
 	//
-	//    * UIAUTOMATOR_TEST_PACKAGE: A uiautomator test package upload.
+	// MANUFACTURER
 	//
-	//    * XCTEST_TEST_PACKAGE: An Xcode test package upload.
+	// The device manufacturer (for example, Apple).
 	//
-	//    * XCTEST_UI_TEST_PACKAGE: An Xcode UI test package upload.
+	// Supported operators: EQUALS, IN, NOT_IN
 	//
-	//    * APPIUM_JAVA_JUNIT_TEST_SPEC: An Appium Java JUnit test spec upload.
+	// REMOTE_ACCESS_ENABLED
 	//
-	//    * APPIUM_JAVA_TESTNG_TEST_SPEC: An Appium Java TestNG test spec upload.
+	// Whether the device is enabled for remote access. Valid values are TRUE or
+	// FALSE.
 	//
-	//    * APPIUM_PYTHON_TEST_SPEC: An Appium Python test spec upload.
+	// Supported operators: EQUALS
 	//
-	//    * APPIUM_NODE_TEST_SPEC: An Appium Node.js test spec upload.
+	// REMOTE_DEBUG_ENABLED
 	//
-	//    * APPIUM_RUBY_TEST_SPEC: An Appium Ruby test spec upload.
+	// Whether the device is enabled for remote debugging. Valid values are TRUE
+	// or FALSE.
 	//
-	//    * APPIUM_WEB_JAVA_JUNIT_TEST_SPEC: An Appium Java JUnit test spec upload
-	//    for a web app.
+	// Supported operators: EQUALS
 	//
-	//    * APPIUM_WEB_JAVA_TESTNG_TEST_SPEC: An Appium Java TestNG test spec upload
-	//    for a web app.
+	// Because remote debugging is no longer supported (https://docs.aws.amazon.com/devicefarm/latest/developerguide/history.html),
+	// this filter is ignored.
 	//
-	//    * APPIUM_WEB_PYTHON_TEST_SPEC: An Appium Python test spec upload for a
-	//    web app.
+	// INSTANCE_ARN
 	//
-	//    * APPIUM_WEB_NODE_TEST_SPEC: An Appium Node.js test spec upload for a
-	//    web app.
+	// The Amazon Resource Name (ARN) of the device instance.
 	//
-	//    * APPIUM_WEB_RUBY_TEST_SPEC: An Appium Ruby test spec upload for a web
-	//    app.
+	// Supported operators: EQUALS, IN, NOT_IN
 	//
-	//    * INSTRUMENTATION_TEST_SPEC: An instrumentation test spec upload.
+	// INSTANCE_LABELS
 	//
-	//    * XCTEST_UI_TEST_SPEC: An Xcode UI test spec upload.
+	// The label of the device instance.
 	//
-	// Note If you call CreateUpload with WEB_APP specified, AWS Device Farm throws
-	// an ArgumentException error.
+	// Supported operators: CONTAINS
 	//
-	// Type is a required field
-	Type *string `locationName:"type" type:"string" required:"true" enum:"UploadType"`
-}
-
-// String returns the string representation
-func (s CreateUploadInput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s CreateUploadInput) GoString() string {
-	return s.String()
-}
-
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateUploadInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateUploadInput"}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
-	}
-	if s.ProjectArn == nil {
-		invalidParams.Add(request.NewErrParamRequired("ProjectArn"))
-	}
-	if s.ProjectArn != nil && len(*s.ProjectArn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("ProjectArn", 32))
-	}
-	if s.Type == nil {
-		invalidParams.Add(request.NewErrParamRequired("Type"))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
-// SetContentType sets the ContentType field's value.
-func (s *CreateUploadInput) SetContentType(v string) *CreateUploadInput {
-	s.ContentType = &v
-	return s
-}
-
-// SetName sets the Name field's value.
-func (s *CreateUploadInput) SetName(v string) *CreateUploadInput {
-	s.Name = &v
-	return s
-}
-
-// SetProjectArn sets the ProjectArn field's value.
-func (s *CreateUploadInput) SetProjectArn(v string) *CreateUploadInput {
-	s.ProjectArn = &v
-	return s
-}
-
-// SetType sets the Type field's value.
-func (s *CreateUploadInput) SetType(v string) *CreateUploadInput {
-	s.Type = &v
-	return s
-}
-
-// Represents the result of a create upload request.
-type CreateUploadOutput struct {
-	_ struct{} `type:"structure"`
-
-	// The newly created upload.
-	Upload *Upload `locationName:"upload" type:"structure"`
-}
-
-// String returns the string representation
-func (s CreateUploadOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s CreateUploadOutput) GoString() string {
-	return s.String()
-}
-
-// SetUpload sets the Upload field's value.
-func (s *CreateUploadOutput) SetUpload(v *Upload) *CreateUploadOutput {
-	s.Upload = v
-	return s
-}
-
-type CreateVPCEConfigurationInput struct {
-	_ struct{} `type:"structure"`
-
-	// The DNS name of the service running in your VPC that you want Device Farm
-	// to test.
+	// FLEET_TYPE
 	//
-	// ServiceDnsName is a required field
-	ServiceDnsName *string `locationName:"serviceDnsName" type:"string" required:"true"`
-
-	// An optional description, providing more details about your VPC endpoint configuration.
-	VpceConfigurationDescription *string `locationName:"vpceConfigurationDescription" type:"string"`
+	// The fleet type. Valid values are PUBLIC or PRIVATE.
+	//
+	// Supported operators: EQUALS
+	//
+	// Attribute is a required field
+	Attribute *string `locationName:"attribute" type:"string" required:"true" enum:"DeviceFilterAttribute"`
 
-	// The friendly name you give to your VPC endpoint configuration, to manage
-	// your configurations more easily.
+	// Specifies how Device Farm compares the filter's attribute to the value. See
+	// the attribute descriptions.
 	//
-	// VpceConfigurationName is a required field
-	VpceConfigurationName *string `locationName:"vpceConfigurationName" type:"string" required:"true"`
+	// Operator is a required field
+	Operator *string `locationName:"operator" type:"string" required:"true" enum:"RuleOperator"`
 
-	// The name of the VPC endpoint service running inside your AWS account that
-	// you want Device Farm to test.
+	// An array of one or more filter values used in a device filter.
 	//
-	// VpceServiceName is a required field
-	VpceServiceName *string `locationName:"vpceServiceName" type:"string" required:"true"`
+	// Operator Values
+	//
+	//    * The IN and NOT_IN operators can take a values array that has more than
+	//    one element.
+	//
+	//    * The other operators require an array with a single element.
+	//
+	// Attribute Values
+	//
+	//    * The PLATFORM attribute can be set to ANDROID or IOS.
+	//
+	//    * The AVAILABILITY attribute can be set to AVAILABLE, HIGHLY_AVAILABLE,
+	//    BUSY, or TEMPORARY_NOT_AVAILABLE.
+	//
+	//    * The FORM_FACTOR attribute can be set to PHONE or TABLET.
+	//
+	//    * The FLEET_TYPE attribute can be set to PUBLIC or PRIVATE.
+	//
+	// Values is a required field
+	Values []*string `locationName:"values" type:"list" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateVPCEConfigurationInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeviceFilter) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateVPCEConfigurationInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeviceFilter) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateVPCEConfigurationInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateVPCEConfigurationInput"}
-	if s.ServiceDnsName == nil {
-		invalidParams.Add(request.NewErrParamRequired("ServiceDnsName"))
+func (s *DeviceFilter) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeviceFilter"}
+	if s.Attribute == nil {
+		invalidParams.Add(request.NewErrParamRequired("Attribute"))
 	}
-	if s.VpceConfigurationName == nil {
-		invalidParams.Add(request.NewErrParamRequired("VpceConfigurationName"))
+	if s.Operator == nil {
+		invalidParams.Add(request.NewErrParamRequired("Operator"))
 	}
-	if s.VpceServiceName == nil {
-		invalidParams.Add(request.NewErrParamRequired("VpceServiceName"))
+	if s.Values == nil {
+		invalidParams.Add(request.NewErrParamRequired("Values"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -8059,244 +10843,381 @@ func (s *CreateVPCEConfigurationInput) Validate() error {
 	return nil
 }
 
-// SetServiceDnsName sets the ServiceDnsName field's value.
-func (s *CreateVPCEConfigurationInput) SetServiceDnsName(v string) *CreateVPCEConfigurationInput {
-	s.ServiceDnsName = &v
-	return s
-}
-
-// SetVpceConfigurationDescription sets the VpceConfigurationDescription field's value.
-func (s *CreateVPCEConfigurationInput) SetVpceConfigurationDescription(v string) *CreateVPCEConfigurationInput {
-	s.VpceConfigurationDescription = &v
+// SetAttribute sets the Attribute field's value.
+func (s *DeviceFilter) SetAttribute(v string) *DeviceFilter {
+	s.Attribute = &v
 	return s
 }
 
-// SetVpceConfigurationName sets the VpceConfigurationName field's value.
-func (s *CreateVPCEConfigurationInput) SetVpceConfigurationName(v string) *CreateVPCEConfigurationInput {
-	s.VpceConfigurationName = &v
+// SetOperator sets the Operator field's value.
+func (s *DeviceFilter) SetOperator(v string) *DeviceFilter {
+	s.Operator = &v
 	return s
 }
 
-// SetVpceServiceName sets the VpceServiceName field's value.
-func (s *CreateVPCEConfigurationInput) SetVpceServiceName(v string) *CreateVPCEConfigurationInput {
-	s.VpceServiceName = &v
+// SetValues sets the Values field's value.
+func (s *DeviceFilter) SetValues(v []*string) *DeviceFilter {
+	s.Values = v
 	return s
 }
 
-type CreateVPCEConfigurationOutput struct {
+// Represents the device instance.
+type DeviceInstance struct {
 	_ struct{} `type:"structure"`
 
-	// An object containing information about your VPC endpoint configuration.
-	VpceConfiguration *VPCEConfiguration `locationName:"vpceConfiguration" type:"structure"`
+	// The Amazon Resource Name (ARN) of the device instance.
+	Arn *string `locationName:"arn" min:"32" type:"string"`
+
+	// The ARN of the device.
+	DeviceArn *string `locationName:"deviceArn" min:"32" type:"string"`
+
+	// A object that contains information about the instance profile.
+	InstanceProfile *InstanceProfile `locationName:"instanceProfile" type:"structure"`
+
+	// An array of strings that describe the device instance.
+	Labels []*string `locationName:"labels" type:"list"`
+
+	// The status of the device instance. Valid values are listed here.
+	Status *string `locationName:"status" type:"string" enum:"InstanceStatus"`
+
+	// Unique device identifier for the device instance.
+	Udid *string `locationName:"udid" type:"string"`
 }
 
-// String returns the string representation
-func (s CreateVPCEConfigurationOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeviceInstance) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateVPCEConfigurationOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeviceInstance) GoString() string {
 	return s.String()
 }
 
-// SetVpceConfiguration sets the VpceConfiguration field's value.
-func (s *CreateVPCEConfigurationOutput) SetVpceConfiguration(v *VPCEConfiguration) *CreateVPCEConfigurationOutput {
-	s.VpceConfiguration = v
+// SetArn sets the Arn field's value.
+func (s *DeviceInstance) SetArn(v string) *DeviceInstance {
+	s.Arn = &v
 	return s
 }
 
-// A JSON object specifying the paths where the artifacts generated by the customer's
-// tests, on the device or in the test environment, will be pulled from.
-//
-// Specify deviceHostPaths and optionally specify either iosPaths or androidPaths.
-//
-// For web app tests, you can specify both iosPaths and androidPaths.
-type CustomerArtifactPaths struct {
+// SetDeviceArn sets the DeviceArn field's value.
+func (s *DeviceInstance) SetDeviceArn(v string) *DeviceInstance {
+	s.DeviceArn = &v
+	return s
+}
+
+// SetInstanceProfile sets the InstanceProfile field's value.
+func (s *DeviceInstance) SetInstanceProfile(v *InstanceProfile) *DeviceInstance {
+	s.InstanceProfile = v
+	return s
+}
+
+// SetLabels sets the Labels field's value.
+func (s *DeviceInstance) SetLabels(v []*string) *DeviceInstance {
+	s.Labels = v
+	return s
+}
+
+// SetStatus sets the Status field's value.
+func (s *DeviceInstance) SetStatus(v string) *DeviceInstance {
+	s.Status = &v
+	return s
+}
+
+// SetUdid sets the Udid field's value.
+func (s *DeviceInstance) SetUdid(v string) *DeviceInstance {
+	s.Udid = &v
+	return s
+}
+
+// Represents the total (metered or unmetered) minutes used by the resource
+// to run tests. Contains the sum of minutes consumed by all children.
+type DeviceMinutes struct {
 	_ struct{} `type:"structure"`
 
-	// Comma-separated list of paths on the Android device where the artifacts generated
-	// by the customer's tests will be pulled from.
-	AndroidPaths []*string `locationName:"androidPaths" type:"list"`
+	// When specified, represents only the sum of metered minutes used by the resource
+	// to run tests.
+	Metered *float64 `locationName:"metered" type:"double"`
 
-	// Comma-separated list of paths in the test execution environment where the
-	// artifacts generated by the customer's tests will be pulled from.
-	DeviceHostPaths []*string `locationName:"deviceHostPaths" type:"list"`
+	// When specified, represents the total minutes used by the resource to run
+	// tests.
+	Total *float64 `locationName:"total" type:"double"`
 
-	// Comma-separated list of paths on the iOS device where the artifacts generated
-	// by the customer's tests will be pulled from.
-	IosPaths []*string `locationName:"iosPaths" type:"list"`
+	// When specified, represents only the sum of unmetered minutes used by the
+	// resource to run tests.
+	Unmetered *float64 `locationName:"unmetered" type:"double"`
 }
 
-// String returns the string representation
-func (s CustomerArtifactPaths) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeviceMinutes) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CustomerArtifactPaths) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeviceMinutes) GoString() string {
 	return s.String()
 }
 
-// SetAndroidPaths sets the AndroidPaths field's value.
-func (s *CustomerArtifactPaths) SetAndroidPaths(v []*string) *CustomerArtifactPaths {
-	s.AndroidPaths = v
+// SetMetered sets the Metered field's value.
+func (s *DeviceMinutes) SetMetered(v float64) *DeviceMinutes {
+	s.Metered = &v
 	return s
 }
 
-// SetDeviceHostPaths sets the DeviceHostPaths field's value.
-func (s *CustomerArtifactPaths) SetDeviceHostPaths(v []*string) *CustomerArtifactPaths {
-	s.DeviceHostPaths = v
+// SetTotal sets the Total field's value.
+func (s *DeviceMinutes) SetTotal(v float64) *DeviceMinutes {
+	s.Total = &v
 	return s
 }
 
-// SetIosPaths sets the IosPaths field's value.
-func (s *CustomerArtifactPaths) SetIosPaths(v []*string) *CustomerArtifactPaths {
-	s.IosPaths = v
+// SetUnmetered sets the Unmetered field's value.
+func (s *DeviceMinutes) SetUnmetered(v float64) *DeviceMinutes {
+	s.Unmetered = &v
 	return s
 }
 
-// Represents a request to the delete device pool operation.
-type DeleteDevicePoolInput struct {
+// Represents a collection of device types.
+type DevicePool struct {
 	_ struct{} `type:"structure"`
 
-	// Represents the Amazon Resource Name (ARN) of the Device Farm device pool
-	// you wish to delete.
+	// The device pool's ARN.
+	Arn *string `locationName:"arn" min:"32" type:"string"`
+
+	// The device pool's description.
+	Description *string `locationName:"description" type:"string"`
+
+	// The number of devices that Device Farm can add to your device pool. Device
+	// Farm adds devices that are available and meet the criteria that you assign
+	// for the rules parameter. Depending on how many devices meet these constraints,
+	// your device pool might contain fewer devices than the value for this parameter.
 	//
-	// Arn is a required field
-	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+	// By specifying the maximum number of devices, you can control the costs that
+	// you incur by running tests.
+	MaxDevices *int64 `locationName:"maxDevices" type:"integer"`
+
+	// The device pool's name.
+	Name *string `locationName:"name" type:"string"`
+
+	// Information about the device pool's rules.
+	Rules []*Rule `locationName:"rules" type:"list"`
+
+	// The device pool's type.
+	//
+	// Allowed values include:
+	//
+	//    * CURATED: A device pool that is created and managed by AWS Device Farm.
+	//
+	//    * PRIVATE: A device pool that is created and managed by the device pool
+	//    developer.
+	Type *string `locationName:"type" type:"string" enum:"DevicePoolType"`
 }
 
-// String returns the string representation
-func (s DeleteDevicePoolInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DevicePool) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteDevicePoolInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DevicePool) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteDevicePoolInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteDevicePoolInput"}
-	if s.Arn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Arn"))
-	}
-	if s.Arn != nil && len(*s.Arn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
-	}
+// SetArn sets the Arn field's value.
+func (s *DevicePool) SetArn(v string) *DevicePool {
+	s.Arn = &v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetDescription sets the Description field's value.
+func (s *DevicePool) SetDescription(v string) *DevicePool {
+	s.Description = &v
+	return s
 }
 
-// SetArn sets the Arn field's value.
-func (s *DeleteDevicePoolInput) SetArn(v string) *DeleteDevicePoolInput {
-	s.Arn = &v
+// SetMaxDevices sets the MaxDevices field's value.
+func (s *DevicePool) SetMaxDevices(v int64) *DevicePool {
+	s.MaxDevices = &v
 	return s
 }
 
-// Represents the result of a delete device pool request.
-type DeleteDevicePoolOutput struct {
-	_ struct{} `type:"structure"`
+// SetName sets the Name field's value.
+func (s *DevicePool) SetName(v string) *DevicePool {
+	s.Name = &v
+	return s
 }
 
-// String returns the string representation
-func (s DeleteDevicePoolOutput) String() string {
-	return awsutil.Prettify(s)
+// SetRules sets the Rules field's value.
+func (s *DevicePool) SetRules(v []*Rule) *DevicePool {
+	s.Rules = v
+	return s
 }
 
-// GoString returns the string representation
-func (s DeleteDevicePoolOutput) GoString() string {
-	return s.String()
+// SetType sets the Type field's value.
+func (s *DevicePool) SetType(v string) *DevicePool {
+	s.Type = &v
+	return s
 }
 
-type DeleteInstanceProfileInput struct {
+// Represents a device pool compatibility result.
+type DevicePoolCompatibilityResult struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the instance profile you are requesting
-	// to delete.
-	//
-	// Arn is a required field
-	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+	// Whether the result was compatible with the device pool.
+	Compatible *bool `locationName:"compatible" type:"boolean"`
+
+	// The device (phone or tablet) to return information about.
+	Device *Device `locationName:"device" type:"structure"`
+
+	// Information about the compatibility.
+	IncompatibilityMessages []*IncompatibilityMessage `locationName:"incompatibilityMessages" type:"list"`
 }
 
-// String returns the string representation
-func (s DeleteInstanceProfileInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DevicePoolCompatibilityResult) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteInstanceProfileInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DevicePoolCompatibilityResult) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteInstanceProfileInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteInstanceProfileInput"}
-	if s.Arn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Arn"))
-	}
-	if s.Arn != nil && len(*s.Arn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
-// SetArn sets the Arn field's value.
-func (s *DeleteInstanceProfileInput) SetArn(v string) *DeleteInstanceProfileInput {
-	s.Arn = &v
+// SetCompatible sets the Compatible field's value.
+func (s *DevicePoolCompatibilityResult) SetCompatible(v bool) *DevicePoolCompatibilityResult {
+	s.Compatible = &v
 	return s
 }
 
-type DeleteInstanceProfileOutput struct {
-	_ struct{} `type:"structure"`
-}
-
-// String returns the string representation
-func (s DeleteInstanceProfileOutput) String() string {
-	return awsutil.Prettify(s)
+// SetDevice sets the Device field's value.
+func (s *DevicePoolCompatibilityResult) SetDevice(v *Device) *DevicePoolCompatibilityResult {
+	s.Device = v
+	return s
 }
 
-// GoString returns the string representation
-func (s DeleteInstanceProfileOutput) GoString() string {
-	return s.String()
+// SetIncompatibilityMessages sets the IncompatibilityMessages field's value.
+func (s *DevicePoolCompatibilityResult) SetIncompatibilityMessages(v []*IncompatibilityMessage) *DevicePoolCompatibilityResult {
+	s.IncompatibilityMessages = v
+	return s
 }
 
-type DeleteNetworkProfileInput struct {
+// Represents the device filters used in a test run and the maximum number of
+// devices to be included in the run. It is passed in as the deviceSelectionConfiguration
+// request parameter in ScheduleRun.
+type DeviceSelectionConfiguration struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the network profile you want to delete.
+	// Used to dynamically select a set of devices for a test run. A filter is made
+	// up of an attribute, an operator, and one or more values.
+	//
+	//    * Attribute The aspect of a device such as platform or model used as the
+	//    selection criteria in a device filter. Allowed values include: ARN: The
+	//    Amazon Resource Name (ARN) of the device (for example, arn:aws:devicefarm:us-west-2::device:12345Example).
+	//    PLATFORM: The device platform. Valid values are ANDROID or IOS. OS_VERSION:
+	//    The operating system version (for example, 10.3.2). MODEL: The device
+	//    model (for example, iPad 5th Gen). AVAILABILITY: The current availability
+	//    of the device. Valid values are AVAILABLE, HIGHLY_AVAILABLE, BUSY, or
+	//    TEMPORARY_NOT_AVAILABLE. FORM_FACTOR: The device form factor. Valid values
+	//    are PHONE or TABLET. MANUFACTURER: The device manufacturer (for example,
+	//    Apple). REMOTE_ACCESS_ENABLED: Whether the device is enabled for remote
+	//    access. Valid values are TRUE or FALSE. REMOTE_DEBUG_ENABLED: Whether
+	//    the device is enabled for remote debugging. Valid values are TRUE or FALSE.
+	//    Because remote debugging is no longer supported (https://docs.aws.amazon.com/devicefarm/latest/developerguide/history.html),
+	//    this filter is ignored. INSTANCE_ARN: The Amazon Resource Name (ARN) of
+	//    the device instance. INSTANCE_LABELS: The label of the device instance.
+	//    FLEET_TYPE: The fleet type. Valid values are PUBLIC or PRIVATE.
+	//
+	//    * Operator The filter operator. The EQUALS operator is available for every
+	//    attribute except INSTANCE_LABELS. The CONTAINS operator is available for
+	//    the INSTANCE_LABELS and MODEL attributes. The IN and NOT_IN operators
+	//    are available for the ARN, OS_VERSION, MODEL, MANUFACTURER, and INSTANCE_ARN
+	//    attributes. The LESS_THAN, GREATER_THAN, LESS_THAN_OR_EQUALS, and GREATER_THAN_OR_EQUALS
+	//    operators are also available for the OS_VERSION attribute.
+	//
+	//    * Values An array of one or more filter values. Operator Values The IN
+	//    and NOT_IN operators can take a values array that has more than one element.
+	//    The other operators require an array with a single element. Attribute
+	//    Values The PLATFORM attribute can be set to ANDROID or IOS. The AVAILABILITY
+	//    attribute can be set to AVAILABLE, HIGHLY_AVAILABLE, BUSY, or TEMPORARY_NOT_AVAILABLE.
+	//    The FORM_FACTOR attribute can be set to PHONE or TABLET. The FLEET_TYPE
+	//    attribute can be set to PUBLIC or PRIVATE.
+	//
+	// Filters is a required field
+	Filters []*DeviceFilter `locationName:"filters" type:"list" required:"true"`
+
+	// The maximum number of devices to be included in a test run.
 	//
-	// Arn is a required field
-	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+	// MaxDevices is a required field
+	MaxDevices *int64 `locationName:"maxDevices" type:"integer" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteNetworkProfileInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeviceSelectionConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteNetworkProfileInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeviceSelectionConfiguration) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteNetworkProfileInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteNetworkProfileInput"}
-	if s.Arn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Arn"))
+func (s *DeviceSelectionConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeviceSelectionConfiguration"}
+	if s.Filters == nil {
+		invalidParams.Add(request.NewErrParamRequired("Filters"))
 	}
-	if s.Arn != nil && len(*s.Arn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+	if s.MaxDevices == nil {
+		invalidParams.Add(request.NewErrParamRequired("MaxDevices"))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
 	}
 
 	if invalidParams.Len() > 0 {
@@ -8305,224 +11226,235 @@ func (s *DeleteNetworkProfileInput) Validate() error {
 	return nil
 }
 
-// SetArn sets the Arn field's value.
-func (s *DeleteNetworkProfileInput) SetArn(v string) *DeleteNetworkProfileInput {
-	s.Arn = &v
+// SetFilters sets the Filters field's value.
+func (s *DeviceSelectionConfiguration) SetFilters(v []*DeviceFilter) *DeviceSelectionConfiguration {
+	s.Filters = v
 	return s
 }
 
-type DeleteNetworkProfileOutput struct {
-	_ struct{} `type:"structure"`
+// SetMaxDevices sets the MaxDevices field's value.
+func (s *DeviceSelectionConfiguration) SetMaxDevices(v int64) *DeviceSelectionConfiguration {
+	s.MaxDevices = &v
+	return s
 }
 
-// String returns the string representation
-func (s DeleteNetworkProfileOutput) String() string {
-	return awsutil.Prettify(s)
-}
+// Contains the run results requested by the device selection configuration
+// and how many devices were returned. For an example of the JSON response syntax,
+// see ScheduleRun.
+type DeviceSelectionResult struct {
+	_ struct{} `type:"structure"`
 
-// GoString returns the string representation
-func (s DeleteNetworkProfileOutput) GoString() string {
-	return s.String()
-}
+	// The filters in a device selection result.
+	Filters []*DeviceFilter `locationName:"filters" type:"list"`
 
-// Represents a request to the delete project operation.
-type DeleteProjectInput struct {
-	_ struct{} `type:"structure"`
+	// The number of devices that matched the device filter selection criteria.
+	MatchedDevicesCount *int64 `locationName:"matchedDevicesCount" type:"integer"`
 
-	// Represents the Amazon Resource Name (ARN) of the Device Farm project you
-	// wish to delete.
-	//
-	// Arn is a required field
-	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+	// The maximum number of devices to be selected by a device filter and included
+	// in a test run.
+	MaxDevices *int64 `locationName:"maxDevices" type:"integer"`
 }
 
-// String returns the string representation
-func (s DeleteProjectInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeviceSelectionResult) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteProjectInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeviceSelectionResult) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteProjectInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteProjectInput"}
-	if s.Arn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Arn"))
-	}
-	if s.Arn != nil && len(*s.Arn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
-	}
+// SetFilters sets the Filters field's value.
+func (s *DeviceSelectionResult) SetFilters(v []*DeviceFilter) *DeviceSelectionResult {
+	s.Filters = v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetMatchedDevicesCount sets the MatchedDevicesCount field's value.
+func (s *DeviceSelectionResult) SetMatchedDevicesCount(v int64) *DeviceSelectionResult {
+	s.MatchedDevicesCount = &v
+	return s
 }
 
-// SetArn sets the Arn field's value.
-func (s *DeleteProjectInput) SetArn(v string) *DeleteProjectInput {
-	s.Arn = &v
+// SetMaxDevices sets the MaxDevices field's value.
+func (s *DeviceSelectionResult) SetMaxDevices(v int64) *DeviceSelectionResult {
+	s.MaxDevices = &v
 	return s
 }
 
-// Represents the result of a delete project request.
-type DeleteProjectOutput struct {
+// Represents configuration information about a test run, such as the execution
+// timeout (in minutes).
+type ExecutionConfiguration struct {
 	_ struct{} `type:"structure"`
-}
 
-// String returns the string representation
-func (s DeleteProjectOutput) String() string {
-	return awsutil.Prettify(s)
-}
+	// True if account cleanup is enabled at the beginning of the test. Otherwise,
+	// false.
+	AccountsCleanup *bool `locationName:"accountsCleanup" type:"boolean"`
 
-// GoString returns the string representation
-func (s DeleteProjectOutput) GoString() string {
-	return s.String()
-}
+	// True if app package cleanup is enabled at the beginning of the test. Otherwise,
+	// false.
+	AppPackagesCleanup *bool `locationName:"appPackagesCleanup" type:"boolean"`
 
-// Represents the request to delete the specified remote access session.
-type DeleteRemoteAccessSessionInput struct {
-	_ struct{} `type:"structure"`
+	// The number of minutes a test run executes before it times out.
+	JobTimeoutMinutes *int64 `locationName:"jobTimeoutMinutes" type:"integer"`
 
-	// The Amazon Resource Name (ARN) of the session for which you want to delete
-	// remote access.
+	// When set to true, for private devices, Device Farm does not sign your app
+	// again. For public devices, Device Farm always signs your apps again.
 	//
-	// Arn is a required field
-	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+	// For more information about how Device Farm re-signs your apps, see Do you
+	// modify my app? (http://aws.amazon.com/device-farm/faqs/) in the AWS Device
+	// Farm FAQs.
+	SkipAppResign *bool `locationName:"skipAppResign" type:"boolean"`
+
+	// Set to true to enable video capture. Otherwise, set to false. The default
+	// is true.
+	VideoCapture *bool `locationName:"videoCapture" type:"boolean"`
 }
 
-// String returns the string representation
-func (s DeleteRemoteAccessSessionInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ExecutionConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteRemoteAccessSessionInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ExecutionConfiguration) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteRemoteAccessSessionInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteRemoteAccessSessionInput"}
-	if s.Arn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Arn"))
-	}
-	if s.Arn != nil && len(*s.Arn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
-	}
+// SetAccountsCleanup sets the AccountsCleanup field's value.
+func (s *ExecutionConfiguration) SetAccountsCleanup(v bool) *ExecutionConfiguration {
+	s.AccountsCleanup = &v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetAppPackagesCleanup sets the AppPackagesCleanup field's value.
+func (s *ExecutionConfiguration) SetAppPackagesCleanup(v bool) *ExecutionConfiguration {
+	s.AppPackagesCleanup = &v
+	return s
 }
 
-// SetArn sets the Arn field's value.
-func (s *DeleteRemoteAccessSessionInput) SetArn(v string) *DeleteRemoteAccessSessionInput {
-	s.Arn = &v
+// SetJobTimeoutMinutes sets the JobTimeoutMinutes field's value.
+func (s *ExecutionConfiguration) SetJobTimeoutMinutes(v int64) *ExecutionConfiguration {
+	s.JobTimeoutMinutes = &v
+	return s
+}
+
+// SetSkipAppResign sets the SkipAppResign field's value.
+func (s *ExecutionConfiguration) SetSkipAppResign(v bool) *ExecutionConfiguration {
+	s.SkipAppResign = &v
+	return s
+}
+
+// SetVideoCapture sets the VideoCapture field's value.
+func (s *ExecutionConfiguration) SetVideoCapture(v bool) *ExecutionConfiguration {
+	s.VideoCapture = &v
 	return s
 }
 
-// The response from the server when a request is made to delete the remote
-// access session.
-type DeleteRemoteAccessSessionOutput struct {
+// Represents the request sent to retrieve the account settings.
+type GetAccountSettingsInput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
-func (s DeleteRemoteAccessSessionOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetAccountSettingsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteRemoteAccessSessionOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetAccountSettingsInput) GoString() string {
 	return s.String()
 }
 
-// Represents a request to the delete run operation.
-type DeleteRunInput struct {
+// Represents the account settings return values from the GetAccountSettings
+// request.
+type GetAccountSettingsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) for the run you wish to delete.
-	//
-	// Arn is a required field
-	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+	// The account settings.
+	AccountSettings *AccountSettings `locationName:"accountSettings" type:"structure"`
 }
 
-// String returns the string representation
-func (s DeleteRunInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetAccountSettingsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteRunInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetAccountSettingsOutput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteRunInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteRunInput"}
-	if s.Arn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Arn"))
-	}
-	if s.Arn != nil && len(*s.Arn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
-// SetArn sets the Arn field's value.
-func (s *DeleteRunInput) SetArn(v string) *DeleteRunInput {
-	s.Arn = &v
+// SetAccountSettings sets the AccountSettings field's value.
+func (s *GetAccountSettingsOutput) SetAccountSettings(v *AccountSettings) *GetAccountSettingsOutput {
+	s.AccountSettings = v
 	return s
 }
 
-// Represents the result of a delete run request.
-type DeleteRunOutput struct {
-	_ struct{} `type:"structure"`
-}
-
-// String returns the string representation
-func (s DeleteRunOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s DeleteRunOutput) GoString() string {
-	return s.String()
-}
-
-// Represents a request to the delete upload operation.
-type DeleteUploadInput struct {
+// Represents a request to the get device request.
+type GetDeviceInput struct {
 	_ struct{} `type:"structure"`
 
-	// Represents the Amazon Resource Name (ARN) of the Device Farm upload you wish
-	// to delete.
+	// The device type's ARN.
 	//
 	// Arn is a required field
 	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteUploadInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDeviceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteUploadInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDeviceInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteUploadInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteUploadInput"}
+func (s *GetDeviceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDeviceInput"}
 	if s.Arn == nil {
 		invalidParams.Add(request.NewErrParamRequired("Arn"))
 	}
@@ -8537,49 +11469,42 @@ func (s *DeleteUploadInput) Validate() error {
 }
 
 // SetArn sets the Arn field's value.
-func (s *DeleteUploadInput) SetArn(v string) *DeleteUploadInput {
+func (s *GetDeviceInput) SetArn(v string) *GetDeviceInput {
 	s.Arn = &v
 	return s
 }
 
-// Represents the result of a delete upload request.
-type DeleteUploadOutput struct {
-	_ struct{} `type:"structure"`
-}
-
-// String returns the string representation
-func (s DeleteUploadOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s DeleteUploadOutput) GoString() string {
-	return s.String()
-}
-
-type DeleteVPCEConfigurationInput struct {
+type GetDeviceInstanceInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the VPC endpoint configuration you want
-	// to delete.
+	// The Amazon Resource Name (ARN) of the instance you're requesting information
+	// about.
 	//
 	// Arn is a required field
 	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteVPCEConfigurationInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDeviceInstanceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteVPCEConfigurationInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDeviceInstanceInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteVPCEConfigurationInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteVPCEConfigurationInput"}
+func (s *GetDeviceInstanceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDeviceInstanceInput"}
 	if s.Arn == nil {
 		invalidParams.Add(request.NewErrParamRequired("Arn"))
 	}
@@ -8594,697 +11519,610 @@ func (s *DeleteVPCEConfigurationInput) Validate() error {
 }
 
 // SetArn sets the Arn field's value.
-func (s *DeleteVPCEConfigurationInput) SetArn(v string) *DeleteVPCEConfigurationInput {
+func (s *GetDeviceInstanceInput) SetArn(v string) *GetDeviceInstanceInput {
 	s.Arn = &v
 	return s
 }
 
-type DeleteVPCEConfigurationOutput struct {
+type GetDeviceInstanceOutput struct {
 	_ struct{} `type:"structure"`
+
+	// An object that contains information about your device instance.
+	DeviceInstance *DeviceInstance `locationName:"deviceInstance" type:"structure"`
 }
 
-// String returns the string representation
-func (s DeleteVPCEConfigurationOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDeviceInstanceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteVPCEConfigurationOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDeviceInstanceOutput) GoString() string {
 	return s.String()
 }
 
-// Represents a device type that an app is tested against.
-type Device struct {
-	_ struct{} `type:"structure"`
-
-	// The device's ARN.
-	Arn *string `locationName:"arn" min:"32" type:"string"`
-
-	// Reflects how likely a device will be available for a test run. It is currently
-	// available in the ListDevices and GetDevice API methods.
-	Availability *string `locationName:"availability" type:"string" enum:"DeviceAvailability"`
-
-	// The device's carrier.
-	Carrier *string `locationName:"carrier" type:"string"`
-
-	// Information about the device's CPU.
-	Cpu *CPU `locationName:"cpu" type:"structure"`
-
-	// The name of the fleet to which this device belongs.
-	FleetName *string `locationName:"fleetName" type:"string"`
-
-	// The type of fleet to which this device belongs. Possible values for fleet
-	// type are PRIVATE and PUBLIC.
-	FleetType *string `locationName:"fleetType" type:"string"`
-
-	// The device's form factor.
-	//
-	// Allowed values include:
-	//
-	//    * PHONE: The phone form factor.
-	//
-	//    * TABLET: The tablet form factor.
-	FormFactor *string `locationName:"formFactor" type:"string" enum:"DeviceFormFactor"`
-
-	// The device's heap size, expressed in bytes.
-	HeapSize *int64 `locationName:"heapSize" type:"long"`
-
-	// The device's image name.
-	Image *string `locationName:"image" type:"string"`
-
-	// The instances belonging to this device.
-	Instances []*DeviceInstance `locationName:"instances" type:"list"`
-
-	// The device's manufacturer name.
-	Manufacturer *string `locationName:"manufacturer" type:"string"`
-
-	// The device's total memory size, expressed in bytes.
-	Memory *int64 `locationName:"memory" type:"long"`
-
-	// The device's model name.
-	Model *string `locationName:"model" type:"string"`
-
-	// The device's model ID.
-	ModelId *string `locationName:"modelId" type:"string"`
-
-	// The device's display name.
-	Name *string `locationName:"name" type:"string"`
-
-	// The device's operating system type.
-	Os *string `locationName:"os" type:"string"`
-
-	// The device's platform.
-	//
-	// Allowed values include:
-	//
-	//    * ANDROID: The Android platform.
-	//
-	//    * IOS: The iOS platform.
-	Platform *string `locationName:"platform" type:"string" enum:"DevicePlatform"`
-
-	// The device's radio.
-	Radio *string `locationName:"radio" type:"string"`
-
-	// Specifies whether remote access has been enabled for the specified device.
-	RemoteAccessEnabled *bool `locationName:"remoteAccessEnabled" type:"boolean"`
+// SetDeviceInstance sets the DeviceInstance field's value.
+func (s *GetDeviceInstanceOutput) SetDeviceInstance(v *DeviceInstance) *GetDeviceInstanceOutput {
+	s.DeviceInstance = v
+	return s
+}
 
-	// This flag is set to true if remote debugging is enabled for the device.
-	RemoteDebugEnabled *bool `locationName:"remoteDebugEnabled" type:"boolean"`
+// Represents the result of a get device request.
+type GetDeviceOutput struct {
+	_ struct{} `type:"structure"`
 
-	// The resolution of the device.
-	Resolution *Resolution `locationName:"resolution" type:"structure"`
+	// An object that contains information about the requested device.
+	Device *Device `locationName:"device" type:"structure"`
 }
 
-// String returns the string representation
-func (s Device) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDeviceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Device) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDeviceOutput) GoString() string {
 	return s.String()
 }
 
-// SetArn sets the Arn field's value.
-func (s *Device) SetArn(v string) *Device {
-	s.Arn = &v
+// SetDevice sets the Device field's value.
+func (s *GetDeviceOutput) SetDevice(v *Device) *GetDeviceOutput {
+	s.Device = v
 	return s
 }
 
-// SetAvailability sets the Availability field's value.
-func (s *Device) SetAvailability(v string) *Device {
-	s.Availability = &v
-	return s
-}
+// Represents a request to the get device pool compatibility operation.
+type GetDevicePoolCompatibilityInput struct {
+	_ struct{} `type:"structure"`
 
-// SetCarrier sets the Carrier field's value.
-func (s *Device) SetCarrier(v string) *Device {
-	s.Carrier = &v
-	return s
+	// The ARN of the app that is associated with the specified device pool.
+	AppArn *string `locationName:"appArn" min:"32" type:"string"`
+
+	// An object that contains information about the settings for a run.
+	Configuration *ScheduleRunConfiguration `locationName:"configuration" type:"structure"`
+
+	// The device pool's ARN.
+	//
+	// DevicePoolArn is a required field
+	DevicePoolArn *string `locationName:"devicePoolArn" min:"32" type:"string" required:"true"`
+
+	// Information about the uploaded test to be run against the device pool.
+	Test *ScheduleRunTest `locationName:"test" type:"structure"`
+
+	// The test type for the specified device pool.
+	//
+	// Allowed values include the following:
+	//
+	//    * BUILTIN_FUZZ.
+	//
+	//    * BUILTIN_EXPLORER. For Android, an app explorer that traverses an Android
+	//    app, interacting with it and capturing screenshots at the same time.
+	//
+	//    * APPIUM_JAVA_JUNIT.
+	//
+	//    * APPIUM_JAVA_TESTNG.
+	//
+	//    * APPIUM_PYTHON.
+	//
+	//    * APPIUM_NODE.
+	//
+	//    * APPIUM_RUBY.
+	//
+	//    * APPIUM_WEB_JAVA_JUNIT.
+	//
+	//    * APPIUM_WEB_JAVA_TESTNG.
+	//
+	//    * APPIUM_WEB_PYTHON.
+	//
+	//    * APPIUM_WEB_NODE.
+	//
+	//    * APPIUM_WEB_RUBY.
+	//
+	//    * CALABASH.
+	//
+	//    * INSTRUMENTATION.
+	//
+	//    * UIAUTOMATION.
+	//
+	//    * UIAUTOMATOR.
+	//
+	//    * XCTEST.
+	//
+	//    * XCTEST_UI.
+	TestType *string `locationName:"testType" type:"string" enum:"TestType"`
 }
 
-// SetCpu sets the Cpu field's value.
-func (s *Device) SetCpu(v *CPU) *Device {
-	s.Cpu = v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDevicePoolCompatibilityInput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetFleetName sets the FleetName field's value.
-func (s *Device) SetFleetName(v string) *Device {
-	s.FleetName = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDevicePoolCompatibilityInput) GoString() string {
+	return s.String()
 }
 
-// SetFleetType sets the FleetType field's value.
-func (s *Device) SetFleetType(v string) *Device {
-	s.FleetType = &v
-	return s
-}
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetDevicePoolCompatibilityInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDevicePoolCompatibilityInput"}
+	if s.AppArn != nil && len(*s.AppArn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("AppArn", 32))
+	}
+	if s.DevicePoolArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("DevicePoolArn"))
+	}
+	if s.DevicePoolArn != nil && len(*s.DevicePoolArn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("DevicePoolArn", 32))
+	}
+	if s.Configuration != nil {
+		if err := s.Configuration.Validate(); err != nil {
+			invalidParams.AddNested("Configuration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Test != nil {
+		if err := s.Test.Validate(); err != nil {
+			invalidParams.AddNested("Test", err.(request.ErrInvalidParams))
+		}
+	}
 
-// SetFormFactor sets the FormFactor field's value.
-func (s *Device) SetFormFactor(v string) *Device {
-	s.FormFactor = &v
-	return s
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetHeapSize sets the HeapSize field's value.
-func (s *Device) SetHeapSize(v int64) *Device {
-	s.HeapSize = &v
+// SetAppArn sets the AppArn field's value.
+func (s *GetDevicePoolCompatibilityInput) SetAppArn(v string) *GetDevicePoolCompatibilityInput {
+	s.AppArn = &v
 	return s
 }
 
-// SetImage sets the Image field's value.
-func (s *Device) SetImage(v string) *Device {
-	s.Image = &v
+// SetConfiguration sets the Configuration field's value.
+func (s *GetDevicePoolCompatibilityInput) SetConfiguration(v *ScheduleRunConfiguration) *GetDevicePoolCompatibilityInput {
+	s.Configuration = v
 	return s
 }
 
-// SetInstances sets the Instances field's value.
-func (s *Device) SetInstances(v []*DeviceInstance) *Device {
-	s.Instances = v
+// SetDevicePoolArn sets the DevicePoolArn field's value.
+func (s *GetDevicePoolCompatibilityInput) SetDevicePoolArn(v string) *GetDevicePoolCompatibilityInput {
+	s.DevicePoolArn = &v
 	return s
 }
 
-// SetManufacturer sets the Manufacturer field's value.
-func (s *Device) SetManufacturer(v string) *Device {
-	s.Manufacturer = &v
+// SetTest sets the Test field's value.
+func (s *GetDevicePoolCompatibilityInput) SetTest(v *ScheduleRunTest) *GetDevicePoolCompatibilityInput {
+	s.Test = v
 	return s
 }
 
-// SetMemory sets the Memory field's value.
-func (s *Device) SetMemory(v int64) *Device {
-	s.Memory = &v
+// SetTestType sets the TestType field's value.
+func (s *GetDevicePoolCompatibilityInput) SetTestType(v string) *GetDevicePoolCompatibilityInput {
+	s.TestType = &v
 	return s
 }
 
-// SetModel sets the Model field's value.
-func (s *Device) SetModel(v string) *Device {
-	s.Model = &v
-	return s
-}
+// Represents the result of describe device pool compatibility request.
+type GetDevicePoolCompatibilityOutput struct {
+	_ struct{} `type:"structure"`
 
-// SetModelId sets the ModelId field's value.
-func (s *Device) SetModelId(v string) *Device {
-	s.ModelId = &v
-	return s
-}
+	// Information about compatible devices.
+	CompatibleDevices []*DevicePoolCompatibilityResult `locationName:"compatibleDevices" type:"list"`
 
-// SetName sets the Name field's value.
-func (s *Device) SetName(v string) *Device {
-	s.Name = &v
-	return s
+	// Information about incompatible devices.
+	IncompatibleDevices []*DevicePoolCompatibilityResult `locationName:"incompatibleDevices" type:"list"`
 }
 
-// SetOs sets the Os field's value.
-func (s *Device) SetOs(v string) *Device {
-	s.Os = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDevicePoolCompatibilityOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetPlatform sets the Platform field's value.
-func (s *Device) SetPlatform(v string) *Device {
-	s.Platform = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDevicePoolCompatibilityOutput) GoString() string {
+	return s.String()
 }
 
-// SetRadio sets the Radio field's value.
-func (s *Device) SetRadio(v string) *Device {
-	s.Radio = &v
+// SetCompatibleDevices sets the CompatibleDevices field's value.
+func (s *GetDevicePoolCompatibilityOutput) SetCompatibleDevices(v []*DevicePoolCompatibilityResult) *GetDevicePoolCompatibilityOutput {
+	s.CompatibleDevices = v
 	return s
 }
 
-// SetRemoteAccessEnabled sets the RemoteAccessEnabled field's value.
-func (s *Device) SetRemoteAccessEnabled(v bool) *Device {
-	s.RemoteAccessEnabled = &v
+// SetIncompatibleDevices sets the IncompatibleDevices field's value.
+func (s *GetDevicePoolCompatibilityOutput) SetIncompatibleDevices(v []*DevicePoolCompatibilityResult) *GetDevicePoolCompatibilityOutput {
+	s.IncompatibleDevices = v
 	return s
 }
 
-// SetRemoteDebugEnabled sets the RemoteDebugEnabled field's value.
-func (s *Device) SetRemoteDebugEnabled(v bool) *Device {
-	s.RemoteDebugEnabled = &v
-	return s
-}
+// Represents a request to the get device pool operation.
+type GetDevicePoolInput struct {
+	_ struct{} `type:"structure"`
 
-// SetResolution sets the Resolution field's value.
-func (s *Device) SetResolution(v *Resolution) *Device {
-	s.Resolution = v
-	return s
+	// The device pool's ARN.
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 }
 
-// Represents a device filter used to select a set of devices to be included
-// in a test run. This data structure is passed in as the deviceSelectionConfiguration
-// parameter to ScheduleRun. For an example of the JSON request syntax, see
-// ScheduleRun.
+// String returns the string representation.
 //
-// It is also passed in as the filters parameter to ListDevices. For an example
-// of the JSON request syntax, see ListDevices.
-type DeviceFilter struct {
-	_ struct{} `type:"structure"`
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDevicePoolInput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// The aspect of a device such as platform or model used as the selection criteria
-	// in a device filter.
-	//
-	// The supported operators for each attribute are provided in the following
-	// list.
-	//
-	// ARN
-	//
-	// The Amazon Resource Name (ARN) of the device. For example, "arn:aws:devicefarm:us-west-2::device:12345Example".
-	//
-	// Supported operators: EQUALS, IN, NOT_IN
-	//
-	// PLATFORM
-	//
-	// The device platform. Valid values are "ANDROID" or "IOS".
-	//
-	// Supported operators: EQUALS
-	//
-	// OS_VERSION
-	//
-	// The operating system version. For example, "10.3.2".
-	//
-	// Supported operators: EQUALS, GREATER_THAN, GREATER_THAN_OR_EQUALS, IN, LESS_THAN,
-	// LESS_THAN_OR_EQUALS, NOT_IN
-	//
-	// MODEL
-	//
-	// The device model. For example, "iPad 5th Gen".
-	//
-	// Supported operators: CONTAINS, EQUALS, IN, NOT_IN
-	//
-	// AVAILABILITY
-	//
-	// The current availability of the device. Valid values are "AVAILABLE", "HIGHLY_AVAILABLE",
-	// "BUSY", or "TEMPORARY_NOT_AVAILABLE".
-	//
-	// Supported operators: EQUALS
-	//
-	// FORM_FACTOR
-	//
-	// The device form factor. Valid values are "PHONE" or "TABLET".
-	//
-	// Supported operators: EQUALS
-	//
-	// MANUFACTURER
-	//
-	// The device manufacturer. For example, "Apple".
-	//
-	// Supported operators: EQUALS, IN, NOT_IN
-	//
-	// REMOTE_ACCESS_ENABLED
-	//
-	// Whether the device is enabled for remote access. Valid values are "TRUE"
-	// or "FALSE".
-	//
-	// Supported operators: EQUALS
-	//
-	// REMOTE_DEBUG_ENABLED
-	//
-	// Whether the device is enabled for remote debugging. Valid values are "TRUE"
-	// or "FALSE".
-	//
-	// Supported operators: EQUALS
-	//
-	// INSTANCE_ARN
-	//
-	// The Amazon Resource Name (ARN) of the device instance.
-	//
-	// Supported operators: EQUALS, IN, NOT_IN
-	//
-	// INSTANCE_LABELS
-	//
-	// The label of the device instance.
-	//
-	// Supported operators: CONTAINS
-	//
-	// FLEET_TYPE
-	//
-	// The fleet type. Valid values are "PUBLIC" or "PRIVATE".
-	//
-	// Supported operators: EQUALS
-	Attribute *string `locationName:"attribute" type:"string" enum:"DeviceFilterAttribute"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDevicePoolInput) GoString() string {
+	return s.String()
+}
 
-	// Specifies how Device Farm compares the filter's attribute to the value. For
-	// the operators that are supported by each attribute, see the attribute descriptions.
-	Operator *string `locationName:"operator" type:"string" enum:"RuleOperator"`
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetDevicePoolInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDevicePoolInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
+	}
+	if s.Arn != nil && len(*s.Arn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+	}
 
-	// An array of one or more filter values used in a device filter.
-	//
-	// Operator Values
-	//
-	//    * The IN and NOT_IN operators can take a values array that has more than
-	//    one element.
-	//
-	//    * The other operators require an array with a single element.
-	//
-	// Attribute Values
-	//
-	//    * The PLATFORM attribute can be set to "ANDROID" or "IOS".
-	//
-	//    * The AVAILABILITY attribute can be set to "AVAILABLE", "HIGHLY_AVAILABLE",
-	//    "BUSY", or "TEMPORARY_NOT_AVAILABLE".
-	//
-	//    * The FORM_FACTOR attribute can be set to "PHONE" or "TABLET".
-	//
-	//    * The FLEET_TYPE attribute can be set to "PUBLIC" or "PRIVATE".
-	Values []*string `locationName:"values" type:"list"`
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// String returns the string representation
-func (s DeviceFilter) String() string {
-	return awsutil.Prettify(s)
+// SetArn sets the Arn field's value.
+func (s *GetDevicePoolInput) SetArn(v string) *GetDevicePoolInput {
+	s.Arn = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s DeviceFilter) GoString() string {
-	return s.String()
+// Represents the result of a get device pool request.
+type GetDevicePoolOutput struct {
+	_ struct{} `type:"structure"`
+
+	// An object that contains information about the requested device pool.
+	DevicePool *DevicePool `locationName:"devicePool" type:"structure"`
 }
 
-// SetAttribute sets the Attribute field's value.
-func (s *DeviceFilter) SetAttribute(v string) *DeviceFilter {
-	s.Attribute = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDevicePoolOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetOperator sets the Operator field's value.
-func (s *DeviceFilter) SetOperator(v string) *DeviceFilter {
-	s.Operator = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDevicePoolOutput) GoString() string {
+	return s.String()
 }
 
-// SetValues sets the Values field's value.
-func (s *DeviceFilter) SetValues(v []*string) *DeviceFilter {
-	s.Values = v
+// SetDevicePool sets the DevicePool field's value.
+func (s *GetDevicePoolOutput) SetDevicePool(v *DevicePool) *GetDevicePoolOutput {
+	s.DevicePool = v
 	return s
 }
 
-// Represents the device instance.
-type DeviceInstance struct {
+type GetInstanceProfileInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the device instance.
-	Arn *string `locationName:"arn" min:"32" type:"string"`
-
-	// The Amazon Resource Name (ARN) of the device.
-	DeviceArn *string `locationName:"deviceArn" min:"32" type:"string"`
-
-	// A object containing information about the instance profile.
-	InstanceProfile *InstanceProfile `locationName:"instanceProfile" type:"structure"`
-
-	// An array of strings describing the device instance.
-	Labels []*string `locationName:"labels" type:"list"`
-
-	// The status of the device instance. Valid values are listed below.
-	Status *string `locationName:"status" type:"string" enum:"InstanceStatus"`
-
-	// Unique device identifier for the device instance.
-	Udid *string `locationName:"udid" type:"string"`
+	// The Amazon Resource Name (ARN) of an instance profile.
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DeviceInstance) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetInstanceProfileInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeviceInstance) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetInstanceProfileInput) GoString() string {
 	return s.String()
 }
 
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetInstanceProfileInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetInstanceProfileInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
+	}
+	if s.Arn != nil && len(*s.Arn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArn sets the Arn field's value.
-func (s *DeviceInstance) SetArn(v string) *DeviceInstance {
+func (s *GetInstanceProfileInput) SetArn(v string) *GetInstanceProfileInput {
 	s.Arn = &v
 	return s
 }
 
-// SetDeviceArn sets the DeviceArn field's value.
-func (s *DeviceInstance) SetDeviceArn(v string) *DeviceInstance {
-	s.DeviceArn = &v
-	return s
-}
+type GetInstanceProfileOutput struct {
+	_ struct{} `type:"structure"`
 
-// SetInstanceProfile sets the InstanceProfile field's value.
-func (s *DeviceInstance) SetInstanceProfile(v *InstanceProfile) *DeviceInstance {
-	s.InstanceProfile = v
-	return s
+	// An object that contains information about an instance profile.
+	InstanceProfile *InstanceProfile `locationName:"instanceProfile" type:"structure"`
 }
 
-// SetLabels sets the Labels field's value.
-func (s *DeviceInstance) SetLabels(v []*string) *DeviceInstance {
-	s.Labels = v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetInstanceProfileOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetStatus sets the Status field's value.
-func (s *DeviceInstance) SetStatus(v string) *DeviceInstance {
-	s.Status = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetInstanceProfileOutput) GoString() string {
+	return s.String()
 }
 
-// SetUdid sets the Udid field's value.
-func (s *DeviceInstance) SetUdid(v string) *DeviceInstance {
-	s.Udid = &v
+// SetInstanceProfile sets the InstanceProfile field's value.
+func (s *GetInstanceProfileOutput) SetInstanceProfile(v *InstanceProfile) *GetInstanceProfileOutput {
+	s.InstanceProfile = v
 	return s
 }
 
-// Represents the total (metered or unmetered) minutes used by the resource
-// to run tests. Contains the sum of minutes consumed by all children.
-type DeviceMinutes struct {
+// Represents a request to the get job operation.
+type GetJobInput struct {
 	_ struct{} `type:"structure"`
 
-	// When specified, represents only the sum of metered minutes used by the resource
-	// to run tests.
-	Metered *float64 `locationName:"metered" type:"double"`
-
-	// When specified, represents the total minutes used by the resource to run
-	// tests.
-	Total *float64 `locationName:"total" type:"double"`
-
-	// When specified, represents only the sum of unmetered minutes used by the
-	// resource to run tests.
-	Unmetered *float64 `locationName:"unmetered" type:"double"`
+	// The job's ARN.
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DeviceMinutes) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetJobInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeviceMinutes) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetJobInput) GoString() string {
 	return s.String()
 }
 
-// SetMetered sets the Metered field's value.
-func (s *DeviceMinutes) SetMetered(v float64) *DeviceMinutes {
-	s.Metered = &v
-	return s
-}
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetJobInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetJobInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
+	}
+	if s.Arn != nil && len(*s.Arn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+	}
 
-// SetTotal sets the Total field's value.
-func (s *DeviceMinutes) SetTotal(v float64) *DeviceMinutes {
-	s.Total = &v
-	return s
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetUnmetered sets the Unmetered field's value.
-func (s *DeviceMinutes) SetUnmetered(v float64) *DeviceMinutes {
-	s.Unmetered = &v
+// SetArn sets the Arn field's value.
+func (s *GetJobInput) SetArn(v string) *GetJobInput {
+	s.Arn = &v
 	return s
 }
 
-// Represents a collection of device types.
-type DevicePool struct {
+// Represents the result of a get job request.
+type GetJobOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The device pool's ARN.
-	Arn *string `locationName:"arn" min:"32" type:"string"`
-
-	// The device pool's description.
-	Description *string `locationName:"description" type:"string"`
-
-	// The number of devices that Device Farm can add to your device pool. Device
-	// Farm adds devices that are available and that meet the criteria that you
-	// assign for the rules parameter. Depending on how many devices meet these
-	// constraints, your device pool might contain fewer devices than the value
-	// for this parameter.
-	//
-	// By specifying the maximum number of devices, you can control the costs that
-	// you incur by running tests.
-	MaxDevices *int64 `locationName:"maxDevices" type:"integer"`
-
-	// The device pool's name.
-	Name *string `locationName:"name" type:"string"`
-
-	// Information about the device pool's rules.
-	Rules []*Rule `locationName:"rules" type:"list"`
-
-	// The device pool's type.
-	//
-	// Allowed values include:
-	//
-	//    * CURATED: A device pool that is created and managed by AWS Device Farm.
-	//
-	//    * PRIVATE: A device pool that is created and managed by the device pool
-	//    developer.
-	Type *string `locationName:"type" type:"string" enum:"DevicePoolType"`
+	// An object that contains information about the requested job.
+	Job *Job `locationName:"job" type:"structure"`
 }
 
-// String returns the string representation
-func (s DevicePool) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetJobOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DevicePool) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetJobOutput) GoString() string {
 	return s.String()
 }
 
-// SetArn sets the Arn field's value.
-func (s *DevicePool) SetArn(v string) *DevicePool {
-	s.Arn = &v
+// SetJob sets the Job field's value.
+func (s *GetJobOutput) SetJob(v *Job) *GetJobOutput {
+	s.Job = v
 	return s
 }
 
-// SetDescription sets the Description field's value.
-func (s *DevicePool) SetDescription(v string) *DevicePool {
-	s.Description = &v
-	return s
-}
+type GetNetworkProfileInput struct {
+	_ struct{} `type:"structure"`
 
-// SetMaxDevices sets the MaxDevices field's value.
-func (s *DevicePool) SetMaxDevices(v int64) *DevicePool {
-	s.MaxDevices = &v
-	return s
+	// The ARN of the network profile to return information about.
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 }
 
-// SetName sets the Name field's value.
-func (s *DevicePool) SetName(v string) *DevicePool {
-	s.Name = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetNetworkProfileInput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetRules sets the Rules field's value.
-func (s *DevicePool) SetRules(v []*Rule) *DevicePool {
-	s.Rules = v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetNetworkProfileInput) GoString() string {
+	return s.String()
 }
 
-// SetType sets the Type field's value.
-func (s *DevicePool) SetType(v string) *DevicePool {
-	s.Type = &v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetNetworkProfileInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetNetworkProfileInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
+	}
+	if s.Arn != nil && len(*s.Arn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetArn sets the Arn field's value.
+func (s *GetNetworkProfileInput) SetArn(v string) *GetNetworkProfileInput {
+	s.Arn = &v
 	return s
 }
 
-// Represents a device pool compatibility result.
-type DevicePoolCompatibilityResult struct {
+type GetNetworkProfileOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Whether the result was compatible with the device pool.
-	Compatible *bool `locationName:"compatible" type:"boolean"`
-
-	// The device (phone or tablet) that you wish to return information about.
-	Device *Device `locationName:"device" type:"structure"`
-
-	// Information about the compatibility.
-	IncompatibilityMessages []*IncompatibilityMessage `locationName:"incompatibilityMessages" type:"list"`
+	// The network profile.
+	NetworkProfile *NetworkProfile `locationName:"networkProfile" type:"structure"`
 }
 
-// String returns the string representation
-func (s DevicePoolCompatibilityResult) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetNetworkProfileOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DevicePoolCompatibilityResult) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetNetworkProfileOutput) GoString() string {
 	return s.String()
 }
 
-// SetCompatible sets the Compatible field's value.
-func (s *DevicePoolCompatibilityResult) SetCompatible(v bool) *DevicePoolCompatibilityResult {
-	s.Compatible = &v
-	return s
-}
-
-// SetDevice sets the Device field's value.
-func (s *DevicePoolCompatibilityResult) SetDevice(v *Device) *DevicePoolCompatibilityResult {
-	s.Device = v
-	return s
-}
-
-// SetIncompatibilityMessages sets the IncompatibilityMessages field's value.
-func (s *DevicePoolCompatibilityResult) SetIncompatibilityMessages(v []*IncompatibilityMessage) *DevicePoolCompatibilityResult {
-	s.IncompatibilityMessages = v
+// SetNetworkProfile sets the NetworkProfile field's value.
+func (s *GetNetworkProfileOutput) SetNetworkProfile(v *NetworkProfile) *GetNetworkProfileOutput {
+	s.NetworkProfile = v
 	return s
 }
 
-// Represents the device filters used in a test run as well as the maximum number
-// of devices to be included in the run. It is passed in as the deviceSelectionConfiguration
-// request parameter in ScheduleRun.
-type DeviceSelectionConfiguration struct {
+// Represents the request to retrieve the offering status for the specified
+// customer or account.
+type GetOfferingStatusInput struct {
 	_ struct{} `type:"structure"`
 
-	// Used to dynamically select a set of devices for a test run. A filter is made
-	// up of an attribute, an operator, and one or more values.
-	//
-	//    * Attribute The aspect of a device such as platform or model used as the
-	//    selection criteria in a device filter. Allowed values include: ARN: The
-	//    Amazon Resource Name (ARN) of the device. For example, "arn:aws:devicefarm:us-west-2::device:12345Example".
-	//    PLATFORM: The device platform. Valid values are "ANDROID" or "IOS". OS_VERSION:
-	//    The operating system version. For example, "10.3.2". MODEL: The device
-	//    model. For example, "iPad 5th Gen". AVAILABILITY: The current availability
-	//    of the device. Valid values are "AVAILABLE", "HIGHLY_AVAILABLE", "BUSY",
-	//    or "TEMPORARY_NOT_AVAILABLE". FORM_FACTOR: The device form factor. Valid
-	//    values are "PHONE" or "TABLET". MANUFACTURER: The device manufacturer.
-	//    For example, "Apple". REMOTE_ACCESS_ENABLED: Whether the device is enabled
-	//    for remote access. Valid values are "TRUE" or "FALSE". REMOTE_DEBUG_ENABLED:
-	//    Whether the device is enabled for remote debugging. Valid values are "TRUE"
-	//    or "FALSE". INSTANCE_ARN: The Amazon Resource Name (ARN) of the device
-	//    instance. INSTANCE_LABELS: The label of the device instance. FLEET_TYPE:
-	//    The fleet type. Valid values are "PUBLIC" or "PRIVATE".
-	//
-	//    * Operator The filter operator. The EQUALS operator is available for every
-	//    attribute except INSTANCE_LABELS. The CONTAINS operator is available for
-	//    the INSTANCE_LABELS and MODEL attributes. The IN and NOT_IN operators
-	//    are available for the ARN, OS_VERSION, MODEL, MANUFACTURER, and INSTANCE_ARN
-	//    attributes. The LESS_THAN, GREATER_THAN, LESS_THAN_OR_EQUALS, and GREATER_THAN_OR_EQUALS
-	//    operators are also available for the OS_VERSION attribute.
-	//
-	//    * Values An array of one or more filter values. Operator Values The IN
-	//    and NOT_IN operators can take a values array that has more than one element.
-	//    The other operators require an array with a single element. Attribute
-	//    Values The PLATFORM attribute can be set to "ANDROID" or "IOS". The AVAILABILITY
-	//    attribute can be set to "AVAILABLE", "HIGHLY_AVAILABLE", "BUSY", or "TEMPORARY_NOT_AVAILABLE".
-	//    The FORM_FACTOR attribute can be set to "PHONE" or "TABLET". The FLEET_TYPE
-	//    attribute can be set to "PUBLIC" or "PRIVATE".
-	//
-	// Filters is a required field
-	Filters []*DeviceFilter `locationName:"filters" type:"list" required:"true"`
-
-	// The maximum number of devices to be included in a test run.
-	//
-	// MaxDevices is a required field
-	MaxDevices *int64 `locationName:"maxDevices" type:"integer" required:"true"`
+	// An identifier that was returned from the previous call to this operation,
+	// which can be used to return the next set of items in the list.
+	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// String returns the string representation
-func (s DeviceSelectionConfiguration) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetOfferingStatusInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeviceSelectionConfiguration) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetOfferingStatusInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeviceSelectionConfiguration) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeviceSelectionConfiguration"}
-	if s.Filters == nil {
-		invalidParams.Add(request.NewErrParamRequired("Filters"))
-	}
-	if s.MaxDevices == nil {
-		invalidParams.Add(request.NewErrParamRequired("MaxDevices"))
+func (s *GetOfferingStatusInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetOfferingStatusInput"}
+	if s.NextToken != nil && len(*s.NextToken) < 4 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 4))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -9293,196 +12131,261 @@ func (s *DeviceSelectionConfiguration) Validate() error {
 	return nil
 }
 
-// SetFilters sets the Filters field's value.
-func (s *DeviceSelectionConfiguration) SetFilters(v []*DeviceFilter) *DeviceSelectionConfiguration {
-	s.Filters = v
-	return s
-}
-
-// SetMaxDevices sets the MaxDevices field's value.
-func (s *DeviceSelectionConfiguration) SetMaxDevices(v int64) *DeviceSelectionConfiguration {
-	s.MaxDevices = &v
+// SetNextToken sets the NextToken field's value.
+func (s *GetOfferingStatusInput) SetNextToken(v string) *GetOfferingStatusInput {
+	s.NextToken = &v
 	return s
 }
 
-// Contains the run results requested by the device selection configuration
-// as well as how many devices were returned. For an example of the JSON response
-// syntax, see ScheduleRun.
-type DeviceSelectionResult struct {
+// Returns the status result for a device offering.
+type GetOfferingStatusOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The filters in a device selection result.
-	Filters []*DeviceFilter `locationName:"filters" type:"list"`
+	// When specified, gets the offering status for the current period.
+	Current map[string]*OfferingStatus `locationName:"current" type:"map"`
 
-	// The number of devices that matched the device filter selection criteria.
-	MatchedDevicesCount *int64 `locationName:"matchedDevicesCount" type:"integer"`
+	// When specified, gets the offering status for the next period.
+	NextPeriod map[string]*OfferingStatus `locationName:"nextPeriod" type:"map"`
 
-	// The maximum number of devices to be selected by a device filter and included
-	// in a test run.
-	MaxDevices *int64 `locationName:"maxDevices" type:"integer"`
+	// An identifier that was returned from the previous call to this operation,
+	// which can be used to return the next set of items in the list.
+	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// String returns the string representation
-func (s DeviceSelectionResult) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetOfferingStatusOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeviceSelectionResult) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetOfferingStatusOutput) GoString() string {
 	return s.String()
 }
 
-// SetFilters sets the Filters field's value.
-func (s *DeviceSelectionResult) SetFilters(v []*DeviceFilter) *DeviceSelectionResult {
-	s.Filters = v
+// SetCurrent sets the Current field's value.
+func (s *GetOfferingStatusOutput) SetCurrent(v map[string]*OfferingStatus) *GetOfferingStatusOutput {
+	s.Current = v
 	return s
 }
 
-// SetMatchedDevicesCount sets the MatchedDevicesCount field's value.
-func (s *DeviceSelectionResult) SetMatchedDevicesCount(v int64) *DeviceSelectionResult {
-	s.MatchedDevicesCount = &v
+// SetNextPeriod sets the NextPeriod field's value.
+func (s *GetOfferingStatusOutput) SetNextPeriod(v map[string]*OfferingStatus) *GetOfferingStatusOutput {
+	s.NextPeriod = v
 	return s
 }
 
-// SetMaxDevices sets the MaxDevices field's value.
-func (s *DeviceSelectionResult) SetMaxDevices(v int64) *DeviceSelectionResult {
-	s.MaxDevices = &v
+// SetNextToken sets the NextToken field's value.
+func (s *GetOfferingStatusOutput) SetNextToken(v string) *GetOfferingStatusOutput {
+	s.NextToken = &v
 	return s
 }
 
-// Represents configuration information about a test run, such as the execution
-// timeout (in minutes).
-type ExecutionConfiguration struct {
+// Represents a request to the get project operation.
+type GetProjectInput struct {
 	_ struct{} `type:"structure"`
 
-	// True if account cleanup is enabled at the beginning of the test; otherwise,
-	// false.
-	AccountsCleanup *bool `locationName:"accountsCleanup" type:"boolean"`
-
-	// True if app package cleanup is enabled at the beginning of the test; otherwise,
-	// false.
-	AppPackagesCleanup *bool `locationName:"appPackagesCleanup" type:"boolean"`
-
-	// The number of minutes a test run will execute before it times out.
-	JobTimeoutMinutes *int64 `locationName:"jobTimeoutMinutes" type:"integer"`
-
-	// When set to true, for private devices, Device Farm will not sign your app
-	// again. For public devices, Device Farm always signs your apps again and this
-	// parameter has no effect.
+	// The project's ARN.
 	//
-	// For more information about how Device Farm re-signs your app(s), see Do you
-	// modify my app? (https://aws.amazon.com/device-farm/faq/) in the AWS Device
-	// Farm FAQs.
-	SkipAppResign *bool `locationName:"skipAppResign" type:"boolean"`
-
-	// Set to true to enable video capture; otherwise, set to false. The default
-	// is true.
-	VideoCapture *bool `locationName:"videoCapture" type:"boolean"`
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s ExecutionConfiguration) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetProjectInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ExecutionConfiguration) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetProjectInput) GoString() string {
 	return s.String()
 }
 
-// SetAccountsCleanup sets the AccountsCleanup field's value.
-func (s *ExecutionConfiguration) SetAccountsCleanup(v bool) *ExecutionConfiguration {
-	s.AccountsCleanup = &v
-	return s
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetProjectInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetProjectInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
+	}
+	if s.Arn != nil && len(*s.Arn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetAppPackagesCleanup sets the AppPackagesCleanup field's value.
-func (s *ExecutionConfiguration) SetAppPackagesCleanup(v bool) *ExecutionConfiguration {
-	s.AppPackagesCleanup = &v
+// SetArn sets the Arn field's value.
+func (s *GetProjectInput) SetArn(v string) *GetProjectInput {
+	s.Arn = &v
 	return s
 }
 
-// SetJobTimeoutMinutes sets the JobTimeoutMinutes field's value.
-func (s *ExecutionConfiguration) SetJobTimeoutMinutes(v int64) *ExecutionConfiguration {
-	s.JobTimeoutMinutes = &v
-	return s
+// Represents the result of a get project request.
+type GetProjectOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The project to get information about.
+	Project *Project `locationName:"project" type:"structure"`
 }
 
-// SetSkipAppResign sets the SkipAppResign field's value.
-func (s *ExecutionConfiguration) SetSkipAppResign(v bool) *ExecutionConfiguration {
-	s.SkipAppResign = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetProjectOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetVideoCapture sets the VideoCapture field's value.
-func (s *ExecutionConfiguration) SetVideoCapture(v bool) *ExecutionConfiguration {
-	s.VideoCapture = &v
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetProjectOutput) GoString() string {
+	return s.String()
+}
+
+// SetProject sets the Project field's value.
+func (s *GetProjectOutput) SetProject(v *Project) *GetProjectOutput {
+	s.Project = v
 	return s
 }
 
-// Represents the request sent to retrieve the account settings.
-type GetAccountSettingsInput struct {
+// Represents the request to get information about the specified remote access
+// session.
+type GetRemoteAccessSessionInput struct {
 	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the remote access session about which you
+	// want to get session information.
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s GetAccountSettingsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetRemoteAccessSessionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetAccountSettingsInput) GoString() string {
-	return s.String()
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetRemoteAccessSessionInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetRemoteAccessSessionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetRemoteAccessSessionInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
+	}
+	if s.Arn != nil && len(*s.Arn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetArn sets the Arn field's value.
+func (s *GetRemoteAccessSessionInput) SetArn(v string) *GetRemoteAccessSessionInput {
+	s.Arn = &v
+	return s
 }
 
-// Represents the account settings return values from the GetAccountSettings
-// request.
-type GetAccountSettingsOutput struct {
+// Represents the response from the server that lists detailed information about
+// the remote access session.
+type GetRemoteAccessSessionOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The account settings.
-	AccountSettings *AccountSettings `locationName:"accountSettings" type:"structure"`
+	// A container that lists detailed information about the remote access session.
+	RemoteAccessSession *RemoteAccessSession `locationName:"remoteAccessSession" type:"structure"`
 }
 
-// String returns the string representation
-func (s GetAccountSettingsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetRemoteAccessSessionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetAccountSettingsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetRemoteAccessSessionOutput) GoString() string {
 	return s.String()
 }
 
-// SetAccountSettings sets the AccountSettings field's value.
-func (s *GetAccountSettingsOutput) SetAccountSettings(v *AccountSettings) *GetAccountSettingsOutput {
-	s.AccountSettings = v
+// SetRemoteAccessSession sets the RemoteAccessSession field's value.
+func (s *GetRemoteAccessSessionOutput) SetRemoteAccessSession(v *RemoteAccessSession) *GetRemoteAccessSessionOutput {
+	s.RemoteAccessSession = v
 	return s
 }
 
-// Represents a request to the get device request.
-type GetDeviceInput struct {
+// Represents a request to the get run operation.
+type GetRunInput struct {
 	_ struct{} `type:"structure"`
 
-	// The device type's ARN.
+	// The run's ARN.
 	//
 	// Arn is a required field
 	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s GetDeviceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetRunInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetDeviceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetRunInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *GetDeviceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetDeviceInput"}
+func (s *GetRunInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetRunInput"}
 	if s.Arn == nil {
 		invalidParams.Add(request.NewErrParamRequired("Arn"))
 	}
@@ -9497,34 +12400,74 @@ func (s *GetDeviceInput) Validate() error {
 }
 
 // SetArn sets the Arn field's value.
-func (s *GetDeviceInput) SetArn(v string) *GetDeviceInput {
+func (s *GetRunInput) SetArn(v string) *GetRunInput {
 	s.Arn = &v
 	return s
 }
 
-type GetDeviceInstanceInput struct {
+// Represents the result of a get run request.
+type GetRunOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the instance you're requesting information
-	// about.
+	// The run to get results from.
+	Run *Run `locationName:"run" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetRunOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetRunOutput) GoString() string {
+	return s.String()
+}
+
+// SetRun sets the Run field's value.
+func (s *GetRunOutput) SetRun(v *Run) *GetRunOutput {
+	s.Run = v
+	return s
+}
+
+// Represents a request to the get suite operation.
+type GetSuiteInput struct {
+	_ struct{} `type:"structure"`
+
+	// The suite's ARN.
 	//
 	// Arn is a required field
 	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s GetDeviceInstanceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSuiteInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetDeviceInstanceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSuiteInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *GetDeviceInstanceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetDeviceInstanceInput"}
+func (s *GetSuiteInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetSuiteInput"}
 	if s.Arn == nil {
 		invalidParams.Add(request.NewErrParamRequired("Arn"))
 	}
@@ -9539,151 +12482,167 @@ func (s *GetDeviceInstanceInput) Validate() error {
 }
 
 // SetArn sets the Arn field's value.
-func (s *GetDeviceInstanceInput) SetArn(v string) *GetDeviceInstanceInput {
+func (s *GetSuiteInput) SetArn(v string) *GetSuiteInput {
 	s.Arn = &v
 	return s
 }
 
-type GetDeviceInstanceOutput struct {
+// Represents the result of a get suite request.
+type GetSuiteOutput struct {
 	_ struct{} `type:"structure"`
 
-	// An object containing information about your device instance.
-	DeviceInstance *DeviceInstance `locationName:"deviceInstance" type:"structure"`
+	// A collection of one or more tests.
+	Suite *Suite `locationName:"suite" type:"structure"`
 }
 
-// String returns the string representation
-func (s GetDeviceInstanceOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSuiteOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetDeviceInstanceOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSuiteOutput) GoString() string {
 	return s.String()
 }
 
-// SetDeviceInstance sets the DeviceInstance field's value.
-func (s *GetDeviceInstanceOutput) SetDeviceInstance(v *DeviceInstance) *GetDeviceInstanceOutput {
-	s.DeviceInstance = v
+// SetSuite sets the Suite field's value.
+func (s *GetSuiteOutput) SetSuite(v *Suite) *GetSuiteOutput {
+	s.Suite = v
 	return s
 }
 
-// Represents the result of a get device request.
-type GetDeviceOutput struct {
+type GetTestGridProjectInput struct {
 	_ struct{} `type:"structure"`
 
-	// An object containing information about the requested device.
-	Device *Device `locationName:"device" type:"structure"`
+	// The ARN of the Selenium testing project, from either CreateTestGridProject
+	// or ListTestGridProjects.
+	//
+	// ProjectArn is a required field
+	ProjectArn *string `locationName:"projectArn" min:"32" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s GetDeviceOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTestGridProjectInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetDeviceOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTestGridProjectInput) GoString() string {
 	return s.String()
 }
 
-// SetDevice sets the Device field's value.
-func (s *GetDeviceOutput) SetDevice(v *Device) *GetDeviceOutput {
-	s.Device = v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetTestGridProjectInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetTestGridProjectInput"}
+	if s.ProjectArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ProjectArn"))
+	}
+	if s.ProjectArn != nil && len(*s.ProjectArn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("ProjectArn", 32))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetProjectArn sets the ProjectArn field's value.
+func (s *GetTestGridProjectInput) SetProjectArn(v string) *GetTestGridProjectInput {
+	s.ProjectArn = &v
 	return s
 }
 
-// Represents a request to the get device pool compatibility operation.
-type GetDevicePoolCompatibilityInput struct {
+type GetTestGridProjectOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The ARN of the app that is associated with the specified device pool.
-	AppArn *string `locationName:"appArn" min:"32" type:"string"`
+	// A TestGridProject.
+	TestGridProject *TestGridProject `locationName:"testGridProject" type:"structure"`
+}
 
-	// An object containing information about the settings for a run.
-	Configuration *ScheduleRunConfiguration `locationName:"configuration" type:"structure"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTestGridProjectOutput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// The device pool's ARN.
-	//
-	// DevicePoolArn is a required field
-	DevicePoolArn *string `locationName:"devicePoolArn" min:"32" type:"string" required:"true"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTestGridProjectOutput) GoString() string {
+	return s.String()
+}
 
-	// Information about the uploaded test to be run against the device pool.
-	Test *ScheduleRunTest `locationName:"test" type:"structure"`
+// SetTestGridProject sets the TestGridProject field's value.
+func (s *GetTestGridProjectOutput) SetTestGridProject(v *TestGridProject) *GetTestGridProjectOutput {
+	s.TestGridProject = v
+	return s
+}
 
-	// The test type for the specified device pool.
-	//
-	// Allowed values include the following:
-	//
-	//    * BUILTIN_FUZZ: The built-in fuzz type.
-	//
-	//    * BUILTIN_EXPLORER: For Android, an app explorer that will traverse an
-	//    Android app, interacting with it and capturing screenshots at the same
-	//    time.
-	//
-	//    * APPIUM_JAVA_JUNIT: The Appium Java JUnit type.
-	//
-	//    * APPIUM_JAVA_TESTNG: The Appium Java TestNG type.
-	//
-	//    * APPIUM_PYTHON: The Appium Python type.
-	//
-	//    * APPIUM_NODE: The Appium Node.js type.
-	//
-	//    * APPIUM_RUBY: The Appium Ruby type.
-	//
-	//    * APPIUM_WEB_JAVA_JUNIT: The Appium Java JUnit type for web apps.
-	//
-	//    * APPIUM_WEB_JAVA_TESTNG: The Appium Java TestNG type for web apps.
-	//
-	//    * APPIUM_WEB_PYTHON: The Appium Python type for web apps.
-	//
-	//    * APPIUM_WEB_NODE: The Appium Node.js type for web apps.
-	//
-	//    * APPIUM_WEB_RUBY: The Appium Ruby type for web apps.
-	//
-	//    * CALABASH: The Calabash type.
-	//
-	//    * INSTRUMENTATION: The Instrumentation type.
-	//
-	//    * UIAUTOMATION: The uiautomation type.
-	//
-	//    * UIAUTOMATOR: The uiautomator type.
-	//
-	//    * XCTEST: The Xcode test type.
-	//
-	//    * XCTEST_UI: The Xcode UI test type.
-	TestType *string `locationName:"testType" type:"string" enum:"TestType"`
+type GetTestGridSessionInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN for the project that this session belongs to. See CreateTestGridProject
+	// and ListTestGridProjects.
+	ProjectArn *string `locationName:"projectArn" min:"32" type:"string"`
+
+	// An ARN that uniquely identifies a TestGridSession.
+	SessionArn *string `locationName:"sessionArn" min:"32" type:"string"`
+
+	// An ID associated with this session.
+	SessionId *string `locationName:"sessionId" min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s GetDevicePoolCompatibilityInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTestGridSessionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetDevicePoolCompatibilityInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTestGridSessionInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *GetDevicePoolCompatibilityInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetDevicePoolCompatibilityInput"}
-	if s.AppArn != nil && len(*s.AppArn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("AppArn", 32))
-	}
-	if s.DevicePoolArn == nil {
-		invalidParams.Add(request.NewErrParamRequired("DevicePoolArn"))
-	}
-	if s.DevicePoolArn != nil && len(*s.DevicePoolArn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("DevicePoolArn", 32))
+func (s *GetTestGridSessionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetTestGridSessionInput"}
+	if s.ProjectArn != nil && len(*s.ProjectArn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("ProjectArn", 32))
 	}
-	if s.Configuration != nil {
-		if err := s.Configuration.Validate(); err != nil {
-			invalidParams.AddNested("Configuration", err.(request.ErrInvalidParams))
-		}
+	if s.SessionArn != nil && len(*s.SessionArn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("SessionArn", 32))
 	}
-	if s.Test != nil {
-		if err := s.Test.Validate(); err != nil {
-			invalidParams.AddNested("Test", err.(request.ErrInvalidParams))
-		}
+	if s.SessionId != nil && len(*s.SessionId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("SessionId", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -9692,92 +12651,168 @@ func (s *GetDevicePoolCompatibilityInput) Validate() error {
 	return nil
 }
 
-// SetAppArn sets the AppArn field's value.
-func (s *GetDevicePoolCompatibilityInput) SetAppArn(v string) *GetDevicePoolCompatibilityInput {
-	s.AppArn = &v
+// SetProjectArn sets the ProjectArn field's value.
+func (s *GetTestGridSessionInput) SetProjectArn(v string) *GetTestGridSessionInput {
+	s.ProjectArn = &v
 	return s
 }
 
-// SetConfiguration sets the Configuration field's value.
-func (s *GetDevicePoolCompatibilityInput) SetConfiguration(v *ScheduleRunConfiguration) *GetDevicePoolCompatibilityInput {
-	s.Configuration = v
+// SetSessionArn sets the SessionArn field's value.
+func (s *GetTestGridSessionInput) SetSessionArn(v string) *GetTestGridSessionInput {
+	s.SessionArn = &v
 	return s
 }
 
-// SetDevicePoolArn sets the DevicePoolArn field's value.
-func (s *GetDevicePoolCompatibilityInput) SetDevicePoolArn(v string) *GetDevicePoolCompatibilityInput {
-	s.DevicePoolArn = &v
+// SetSessionId sets the SessionId field's value.
+func (s *GetTestGridSessionInput) SetSessionId(v string) *GetTestGridSessionInput {
+	s.SessionId = &v
+	return s
+}
+
+type GetTestGridSessionOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The TestGridSession that was requested.
+	TestGridSession *TestGridSession `locationName:"testGridSession" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTestGridSessionOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTestGridSessionOutput) GoString() string {
+	return s.String()
+}
+
+// SetTestGridSession sets the TestGridSession field's value.
+func (s *GetTestGridSessionOutput) SetTestGridSession(v *TestGridSession) *GetTestGridSessionOutput {
+	s.TestGridSession = v
 	return s
 }
 
-// SetTest sets the Test field's value.
-func (s *GetDevicePoolCompatibilityInput) SetTest(v *ScheduleRunTest) *GetDevicePoolCompatibilityInput {
-	s.Test = v
-	return s
+// Represents a request to the get test operation.
+type GetTestInput struct {
+	_ struct{} `type:"structure"`
+
+	// The test's ARN.
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTestInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTestInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetTestInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetTestInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
+	}
+	if s.Arn != nil && len(*s.Arn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetTestType sets the TestType field's value.
-func (s *GetDevicePoolCompatibilityInput) SetTestType(v string) *GetDevicePoolCompatibilityInput {
-	s.TestType = &v
+// SetArn sets the Arn field's value.
+func (s *GetTestInput) SetArn(v string) *GetTestInput {
+	s.Arn = &v
 	return s
 }
 
-// Represents the result of describe device pool compatibility request.
-type GetDevicePoolCompatibilityOutput struct {
+// Represents the result of a get test request.
+type GetTestOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Information about compatible devices.
-	CompatibleDevices []*DevicePoolCompatibilityResult `locationName:"compatibleDevices" type:"list"`
-
-	// Information about incompatible devices.
-	IncompatibleDevices []*DevicePoolCompatibilityResult `locationName:"incompatibleDevices" type:"list"`
+	// A test condition that is evaluated.
+	Test *Test `locationName:"test" type:"structure"`
 }
 
-// String returns the string representation
-func (s GetDevicePoolCompatibilityOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTestOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetDevicePoolCompatibilityOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTestOutput) GoString() string {
 	return s.String()
 }
 
-// SetCompatibleDevices sets the CompatibleDevices field's value.
-func (s *GetDevicePoolCompatibilityOutput) SetCompatibleDevices(v []*DevicePoolCompatibilityResult) *GetDevicePoolCompatibilityOutput {
-	s.CompatibleDevices = v
-	return s
-}
-
-// SetIncompatibleDevices sets the IncompatibleDevices field's value.
-func (s *GetDevicePoolCompatibilityOutput) SetIncompatibleDevices(v []*DevicePoolCompatibilityResult) *GetDevicePoolCompatibilityOutput {
-	s.IncompatibleDevices = v
+// SetTest sets the Test field's value.
+func (s *GetTestOutput) SetTest(v *Test) *GetTestOutput {
+	s.Test = v
 	return s
 }
 
-// Represents a request to the get device pool operation.
-type GetDevicePoolInput struct {
+// Represents a request to the get upload operation.
+type GetUploadInput struct {
 	_ struct{} `type:"structure"`
 
-	// The device pool's ARN.
+	// The upload's ARN.
 	//
 	// Arn is a required field
 	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s GetDevicePoolInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetUploadInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetDevicePoolInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetUploadInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *GetDevicePoolInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetDevicePoolInput"}
+func (s *GetUploadInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetUploadInput"}
 	if s.Arn == nil {
 		invalidParams.Add(request.NewErrParamRequired("Arn"))
 	}
@@ -9792,57 +12827,74 @@ func (s *GetDevicePoolInput) Validate() error {
 }
 
 // SetArn sets the Arn field's value.
-func (s *GetDevicePoolInput) SetArn(v string) *GetDevicePoolInput {
+func (s *GetUploadInput) SetArn(v string) *GetUploadInput {
 	s.Arn = &v
 	return s
 }
 
-// Represents the result of a get device pool request.
-type GetDevicePoolOutput struct {
+// Represents the result of a get upload request.
+type GetUploadOutput struct {
 	_ struct{} `type:"structure"`
 
-	// An object containing information about the requested device pool.
-	DevicePool *DevicePool `locationName:"devicePool" type:"structure"`
+	// An app or a set of one or more tests to upload or that have been uploaded.
+	Upload *Upload `locationName:"upload" type:"structure"`
 }
 
-// String returns the string representation
-func (s GetDevicePoolOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetUploadOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetDevicePoolOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetUploadOutput) GoString() string {
 	return s.String()
 }
 
-// SetDevicePool sets the DevicePool field's value.
-func (s *GetDevicePoolOutput) SetDevicePool(v *DevicePool) *GetDevicePoolOutput {
-	s.DevicePool = v
+// SetUpload sets the Upload field's value.
+func (s *GetUploadOutput) SetUpload(v *Upload) *GetUploadOutput {
+	s.Upload = v
 	return s
 }
 
-type GetInstanceProfileInput struct {
+type GetVPCEConfigurationInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of your instance profile.
+	// The Amazon Resource Name (ARN) of the VPC endpoint configuration you want
+	// to describe.
 	//
 	// Arn is a required field
 	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s GetInstanceProfileInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetVPCEConfigurationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetInstanceProfileInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetVPCEConfigurationInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *GetInstanceProfileInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetInstanceProfileInput"}
+func (s *GetVPCEConfigurationInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetVPCEConfigurationInput"}
 	if s.Arn == nil {
 		invalidParams.Add(request.NewErrParamRequired("Arn"))
 	}
@@ -9857,62 +12909,211 @@ func (s *GetInstanceProfileInput) Validate() error {
 }
 
 // SetArn sets the Arn field's value.
-func (s *GetInstanceProfileInput) SetArn(v string) *GetInstanceProfileInput {
+func (s *GetVPCEConfigurationInput) SetArn(v string) *GetVPCEConfigurationInput {
 	s.Arn = &v
 	return s
 }
 
-type GetInstanceProfileOutput struct {
+type GetVPCEConfigurationOutput struct {
 	_ struct{} `type:"structure"`
 
-	// An object containing information about your instance profile.
-	InstanceProfile *InstanceProfile `locationName:"instanceProfile" type:"structure"`
+	// An object that contains information about your VPC endpoint configuration.
+	VpceConfiguration *VPCEConfiguration `locationName:"vpceConfiguration" type:"structure"`
 }
 
-// String returns the string representation
-func (s GetInstanceProfileOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetVPCEConfigurationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetInstanceProfileOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetVPCEConfigurationOutput) GoString() string {
 	return s.String()
 }
 
-// SetInstanceProfile sets the InstanceProfile field's value.
-func (s *GetInstanceProfileOutput) SetInstanceProfile(v *InstanceProfile) *GetInstanceProfileOutput {
-	s.InstanceProfile = v
+// SetVpceConfiguration sets the VpceConfiguration field's value.
+func (s *GetVPCEConfigurationOutput) SetVpceConfiguration(v *VPCEConfiguration) *GetVPCEConfigurationOutput {
+	s.VpceConfiguration = v
 	return s
 }
 
-// Represents a request to the get job operation.
-type GetJobInput struct {
+// An entity with the same name already exists.
+type IdempotencyException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// Any additional information about the exception.
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IdempotencyException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IdempotencyException) GoString() string {
+	return s.String()
+}
+
+func newErrorIdempotencyException(v protocol.ResponseMetadata) error {
+	return &IdempotencyException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *IdempotencyException) Code() string {
+	return "IdempotencyException"
+}
+
+// Message returns the exception's message.
+func (s *IdempotencyException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *IdempotencyException) OrigErr() error {
+	return nil
+}
+
+func (s *IdempotencyException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *IdempotencyException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *IdempotencyException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Represents information about incompatibility.
+type IncompatibilityMessage struct {
 	_ struct{} `type:"structure"`
 
-	// The job's ARN.
+	// A message about the incompatibility.
+	Message *string `locationName:"message" type:"string"`
+
+	// The type of incompatibility.
 	//
-	// Arn is a required field
-	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+	// Allowed values include:
+	//
+	//    * ARN
+	//
+	//    * FORM_FACTOR (for example, phone or tablet)
+	//
+	//    * MANUFACTURER
+	//
+	//    * PLATFORM (for example, Android or iOS)
+	//
+	//    * REMOTE_ACCESS_ENABLED
+	//
+	//    * APPIUM_VERSION
+	Type *string `locationName:"type" type:"string" enum:"DeviceAttribute"`
 }
 
-// String returns the string representation
-func (s GetJobInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IncompatibilityMessage) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetJobInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IncompatibilityMessage) GoString() string {
+	return s.String()
+}
+
+// SetMessage sets the Message field's value.
+func (s *IncompatibilityMessage) SetMessage(v string) *IncompatibilityMessage {
+	s.Message = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *IncompatibilityMessage) SetType(v string) *IncompatibilityMessage {
+	s.Type = &v
+	return s
+}
+
+// Represents the request to install an Android application (in .apk format)
+// or an iOS application (in .ipa format) as part of a remote access session.
+type InstallToRemoteAccessSessionInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the app about which you are requesting information.
+	//
+	// AppArn is a required field
+	AppArn *string `locationName:"appArn" min:"32" type:"string" required:"true"`
+
+	// The Amazon Resource Name (ARN) of the remote access session about which you
+	// are requesting information.
+	//
+	// RemoteAccessSessionArn is a required field
+	RemoteAccessSessionArn *string `locationName:"remoteAccessSessionArn" min:"32" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InstallToRemoteAccessSessionInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InstallToRemoteAccessSessionInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *GetJobInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetJobInput"}
-	if s.Arn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Arn"))
+func (s *InstallToRemoteAccessSessionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "InstallToRemoteAccessSessionInput"}
+	if s.AppArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("AppArn"))
 	}
-	if s.Arn != nil && len(*s.Arn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+	if s.AppArn != nil && len(*s.AppArn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("AppArn", 32))
+	}
+	if s.RemoteAccessSessionArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("RemoteAccessSessionArn"))
+	}
+	if s.RemoteAccessSessionArn != nil && len(*s.RemoteAccessSessionArn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("RemoteAccessSessionArn", 32))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -9921,545 +13122,622 @@ func (s *GetJobInput) Validate() error {
 	return nil
 }
 
-// SetArn sets the Arn field's value.
-func (s *GetJobInput) SetArn(v string) *GetJobInput {
-	s.Arn = &v
+// SetAppArn sets the AppArn field's value.
+func (s *InstallToRemoteAccessSessionInput) SetAppArn(v string) *InstallToRemoteAccessSessionInput {
+	s.AppArn = &v
 	return s
 }
 
-// Represents the result of a get job request.
-type GetJobOutput struct {
+// SetRemoteAccessSessionArn sets the RemoteAccessSessionArn field's value.
+func (s *InstallToRemoteAccessSessionInput) SetRemoteAccessSessionArn(v string) *InstallToRemoteAccessSessionInput {
+	s.RemoteAccessSessionArn = &v
+	return s
+}
+
+// Represents the response from the server after AWS Device Farm makes a request
+// to install to a remote access session.
+type InstallToRemoteAccessSessionOutput struct {
 	_ struct{} `type:"structure"`
 
-	// An object containing information about the requested job.
-	Job *Job `locationName:"job" type:"structure"`
+	// An app to upload or that has been uploaded.
+	AppUpload *Upload `locationName:"appUpload" type:"structure"`
 }
 
-// String returns the string representation
-func (s GetJobOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InstallToRemoteAccessSessionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetJobOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InstallToRemoteAccessSessionOutput) GoString() string {
 	return s.String()
 }
-
-// SetJob sets the Job field's value.
-func (s *GetJobOutput) SetJob(v *Job) *GetJobOutput {
-	s.Job = v
+
+// SetAppUpload sets the AppUpload field's value.
+func (s *InstallToRemoteAccessSessionOutput) SetAppUpload(v *Upload) *InstallToRemoteAccessSessionOutput {
+	s.AppUpload = v
 	return s
 }
 
-type GetNetworkProfileInput struct {
+// Represents the instance profile.
+type InstanceProfile struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the network profile you want to return
-	// information about.
+	// The Amazon Resource Name (ARN) of the instance profile.
+	Arn *string `locationName:"arn" min:"32" type:"string"`
+
+	// The description of the instance profile.
+	Description *string `locationName:"description" type:"string"`
+
+	// An array of strings containing the list of app packages that should not be
+	// cleaned up from the device after a test run completes.
 	//
-	// Arn is a required field
-	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+	// The list of packages is considered only if you set packageCleanup to true.
+	ExcludeAppPackagesFromCleanup []*string `locationName:"excludeAppPackagesFromCleanup" type:"list"`
+
+	// The name of the instance profile.
+	Name *string `locationName:"name" type:"string"`
+
+	// When set to true, Device Farm removes app packages after a test run. The
+	// default value is false for private devices.
+	PackageCleanup *bool `locationName:"packageCleanup" type:"boolean"`
+
+	// When set to true, Device Farm reboots the instance after a test run. The
+	// default value is true.
+	RebootAfterUse *bool `locationName:"rebootAfterUse" type:"boolean"`
 }
 
-// String returns the string representation
-func (s GetNetworkProfileInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InstanceProfile) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetNetworkProfileInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InstanceProfile) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetNetworkProfileInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetNetworkProfileInput"}
-	if s.Arn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Arn"))
-	}
-	if s.Arn != nil && len(*s.Arn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
 // SetArn sets the Arn field's value.
-func (s *GetNetworkProfileInput) SetArn(v string) *GetNetworkProfileInput {
+func (s *InstanceProfile) SetArn(v string) *InstanceProfile {
 	s.Arn = &v
 	return s
 }
 
-type GetNetworkProfileOutput struct {
-	_ struct{} `type:"structure"`
+// SetDescription sets the Description field's value.
+func (s *InstanceProfile) SetDescription(v string) *InstanceProfile {
+	s.Description = &v
+	return s
+}
 
-	// The network profile.
-	NetworkProfile *NetworkProfile `locationName:"networkProfile" type:"structure"`
+// SetExcludeAppPackagesFromCleanup sets the ExcludeAppPackagesFromCleanup field's value.
+func (s *InstanceProfile) SetExcludeAppPackagesFromCleanup(v []*string) *InstanceProfile {
+	s.ExcludeAppPackagesFromCleanup = v
+	return s
 }
 
-// String returns the string representation
-func (s GetNetworkProfileOutput) String() string {
-	return awsutil.Prettify(s)
+// SetName sets the Name field's value.
+func (s *InstanceProfile) SetName(v string) *InstanceProfile {
+	s.Name = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s GetNetworkProfileOutput) GoString() string {
-	return s.String()
+// SetPackageCleanup sets the PackageCleanup field's value.
+func (s *InstanceProfile) SetPackageCleanup(v bool) *InstanceProfile {
+	s.PackageCleanup = &v
+	return s
 }
 
-// SetNetworkProfile sets the NetworkProfile field's value.
-func (s *GetNetworkProfileOutput) SetNetworkProfile(v *NetworkProfile) *GetNetworkProfileOutput {
-	s.NetworkProfile = v
+// SetRebootAfterUse sets the RebootAfterUse field's value.
+func (s *InstanceProfile) SetRebootAfterUse(v bool) *InstanceProfile {
+	s.RebootAfterUse = &v
 	return s
 }
 
-// Represents the request to retrieve the offering status for the specified
-// customer or account.
-type GetOfferingStatusInput struct {
-	_ struct{} `type:"structure"`
+// An internal exception was raised in the service. Contact aws-devicefarm-support@amazon.com
+// (mailto:aws-devicefarm-support@amazon.com) if you see this error.
+type InternalServiceException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// An identifier that was returned from the previous call to this operation,
-	// which can be used to return the next set of items in the list.
-	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s GetOfferingStatusInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InternalServiceException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetOfferingStatusInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InternalServiceException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetOfferingStatusInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetOfferingStatusInput"}
-	if s.NextToken != nil && len(*s.NextToken) < 4 {
-		invalidParams.Add(request.NewErrParamMinLen("NextToken", 4))
+func newErrorInternalServiceException(v protocol.ResponseMetadata) error {
+	return &InternalServiceException{
+		RespMetadata: v,
 	}
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
+// Code returns the exception type name.
+func (s *InternalServiceException) Code() string {
+	return "InternalServiceException"
+}
+
+// Message returns the exception's message.
+func (s *InternalServiceException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InternalServiceException) OrigErr() error {
 	return nil
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *GetOfferingStatusInput) SetNextToken(v string) *GetOfferingStatusInput {
-	s.NextToken = &v
-	return s
+func (s *InternalServiceException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// Returns the status result for a device offering.
-type GetOfferingStatusOutput struct {
-	_ struct{} `type:"structure"`
+// Status code returns the HTTP status code for the request's response error.
+func (s *InternalServiceException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
 
-	// When specified, gets the offering status for the current period.
-	Current map[string]*OfferingStatus `locationName:"current" type:"map"`
+// RequestID returns the service's response RequestID for request.
+func (s *InternalServiceException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// When specified, gets the offering status for the next period.
-	NextPeriod map[string]*OfferingStatus `locationName:"nextPeriod" type:"map"`
+// There was an error with the update request, or you do not have sufficient
+// permissions to update this VPC endpoint configuration.
+type InvalidOperationException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// An identifier that was returned from the previous call to this operation,
-	// which can be used to return the next set of items in the list.
-	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s GetOfferingStatusOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidOperationException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetOfferingStatusOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidOperationException) GoString() string {
 	return s.String()
 }
 
-// SetCurrent sets the Current field's value.
-func (s *GetOfferingStatusOutput) SetCurrent(v map[string]*OfferingStatus) *GetOfferingStatusOutput {
-	s.Current = v
-	return s
+func newErrorInvalidOperationException(v protocol.ResponseMetadata) error {
+	return &InvalidOperationException{
+		RespMetadata: v,
+	}
 }
 
-// SetNextPeriod sets the NextPeriod field's value.
-func (s *GetOfferingStatusOutput) SetNextPeriod(v map[string]*OfferingStatus) *GetOfferingStatusOutput {
-	s.NextPeriod = v
-	return s
+// Code returns the exception type name.
+func (s *InvalidOperationException) Code() string {
+	return "InvalidOperationException"
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *GetOfferingStatusOutput) SetNextToken(v string) *GetOfferingStatusOutput {
-	s.NextToken = &v
-	return s
+// Message returns the exception's message.
+func (s *InvalidOperationException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// Represents a request to the get project operation.
-type GetProjectInput struct {
-	_ struct{} `type:"structure"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidOperationException) OrigErr() error {
+	return nil
+}
 
-	// The project's ARN.
-	//
-	// Arn is a required field
-	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+func (s *InvalidOperationException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// String returns the string representation
-func (s GetProjectInput) String() string {
-	return awsutil.Prettify(s)
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidOperationException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// GoString returns the string representation
-func (s GetProjectInput) GoString() string {
-	return s.String()
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidOperationException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetProjectInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetProjectInput"}
-	if s.Arn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Arn"))
-	}
-	if s.Arn != nil && len(*s.Arn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
-	}
+// Represents a device.
+type Job struct {
+	_ struct{} `type:"structure"`
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
+	// The job's ARN.
+	Arn *string `locationName:"arn" min:"32" type:"string"`
 
-// SetArn sets the Arn field's value.
-func (s *GetProjectInput) SetArn(v string) *GetProjectInput {
-	s.Arn = &v
-	return s
-}
+	// The job's result counters.
+	Counters *Counters `locationName:"counters" type:"structure"`
 
-// Represents the result of a get project request.
-type GetProjectOutput struct {
-	_ struct{} `type:"structure"`
+	// When the job was created.
+	Created *time.Time `locationName:"created" type:"timestamp"`
 
-	// The project you wish to get information about.
-	Project *Project `locationName:"project" type:"structure"`
-}
+	// The device (phone or tablet).
+	Device *Device `locationName:"device" type:"structure"`
 
-// String returns the string representation
-func (s GetProjectOutput) String() string {
-	return awsutil.Prettify(s)
-}
+	// Represents the total (metered or unmetered) minutes used by the job.
+	DeviceMinutes *DeviceMinutes `locationName:"deviceMinutes" type:"structure"`
 
-// GoString returns the string representation
-func (s GetProjectOutput) GoString() string {
-	return s.String()
-}
+	// The ARN of the instance.
+	InstanceArn *string `locationName:"instanceArn" min:"32" type:"string"`
 
-// SetProject sets the Project field's value.
-func (s *GetProjectOutput) SetProject(v *Project) *GetProjectOutput {
-	s.Project = v
-	return s
-}
+	// A message about the job's result.
+	Message *string `locationName:"message" type:"string"`
+
+	// The job's name.
+	Name *string `locationName:"name" type:"string"`
+
+	// The job's result.
+	//
+	// Allowed values include:
+	//
+	//    * PENDING
+	//
+	//    * PASSED
+	//
+	//    * WARNED
+	//
+	//    * FAILED
+	//
+	//    * SKIPPED
+	//
+	//    * ERRORED
+	//
+	//    * STOPPED
+	Result *string `locationName:"result" type:"string" enum:"ExecutionResult"`
+
+	// The job's start time.
+	Started *time.Time `locationName:"started" type:"timestamp"`
+
+	// The job's status.
+	//
+	// Allowed values include:
+	//
+	//    * PENDING
+	//
+	//    * PENDING_CONCURRENCY
+	//
+	//    * PENDING_DEVICE
+	//
+	//    * PROCESSING
+	//
+	//    * SCHEDULING
+	//
+	//    * PREPARING
+	//
+	//    * RUNNING
+	//
+	//    * COMPLETED
+	//
+	//    * STOPPING
+	Status *string `locationName:"status" type:"string" enum:"ExecutionStatus"`
+
+	// The job's stop time.
+	Stopped *time.Time `locationName:"stopped" type:"timestamp"`
+
+	// The job's type.
+	//
+	// Allowed values include the following:
+	//
+	//    * BUILTIN_FUZZ
+	//
+	//    * BUILTIN_EXPLORER. For Android, an app explorer that traverses an Android
+	//    app, interacting with it and capturing screenshots at the same time.
+	//
+	//    * APPIUM_JAVA_JUNIT
+	//
+	//    * APPIUM_JAVA_TESTNG
+	//
+	//    * APPIUM_PYTHON
+	//
+	//    * APPIUM_NODE
+	//
+	//    * APPIUM_RUBY
+	//
+	//    * APPIUM_WEB_JAVA_JUNIT
+	//
+	//    * APPIUM_WEB_JAVA_TESTNG
+	//
+	//    * APPIUM_WEB_PYTHON
+	//
+	//    * APPIUM_WEB_NODE
+	//
+	//    * APPIUM_WEB_RUBY
+	//
+	//    * CALABASH
+	//
+	//    * INSTRUMENTATION
+	//
+	//    * UIAUTOMATION
+	//
+	//    * UIAUTOMATOR
+	//
+	//    * XCTEST
+	//
+	//    * XCTEST_UI
+	Type *string `locationName:"type" type:"string" enum:"TestType"`
 
-// Represents the request to get information about the specified remote access
-// session.
-type GetRemoteAccessSessionInput struct {
-	_ struct{} `type:"structure"`
+	// This value is set to true if video capture is enabled. Otherwise, it is set
+	// to false.
+	VideoCapture *bool `locationName:"videoCapture" type:"boolean"`
 
-	// The Amazon Resource Name (ARN) of the remote access session about which you
-	// want to get session information.
-	//
-	// Arn is a required field
-	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+	// The endpoint for streaming device video.
+	VideoEndpoint *string `locationName:"videoEndpoint" type:"string"`
 }
 
-// String returns the string representation
-func (s GetRemoteAccessSessionInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Job) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetRemoteAccessSessionInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Job) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetRemoteAccessSessionInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetRemoteAccessSessionInput"}
-	if s.Arn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Arn"))
-	}
-	if s.Arn != nil && len(*s.Arn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
 // SetArn sets the Arn field's value.
-func (s *GetRemoteAccessSessionInput) SetArn(v string) *GetRemoteAccessSessionInput {
+func (s *Job) SetArn(v string) *Job {
 	s.Arn = &v
 	return s
 }
 
-// Represents the response from the server that lists detailed information about
-// the remote access session.
-type GetRemoteAccessSessionOutput struct {
-	_ struct{} `type:"structure"`
-
-	// A container that lists detailed information about the remote access session.
-	RemoteAccessSession *RemoteAccessSession `locationName:"remoteAccessSession" type:"structure"`
+// SetCounters sets the Counters field's value.
+func (s *Job) SetCounters(v *Counters) *Job {
+	s.Counters = v
+	return s
 }
 
-// String returns the string representation
-func (s GetRemoteAccessSessionOutput) String() string {
-	return awsutil.Prettify(s)
+// SetCreated sets the Created field's value.
+func (s *Job) SetCreated(v time.Time) *Job {
+	s.Created = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s GetRemoteAccessSessionOutput) GoString() string {
-	return s.String()
+// SetDevice sets the Device field's value.
+func (s *Job) SetDevice(v *Device) *Job {
+	s.Device = v
+	return s
 }
 
-// SetRemoteAccessSession sets the RemoteAccessSession field's value.
-func (s *GetRemoteAccessSessionOutput) SetRemoteAccessSession(v *RemoteAccessSession) *GetRemoteAccessSessionOutput {
-	s.RemoteAccessSession = v
+// SetDeviceMinutes sets the DeviceMinutes field's value.
+func (s *Job) SetDeviceMinutes(v *DeviceMinutes) *Job {
+	s.DeviceMinutes = v
 	return s
 }
 
-// Represents a request to the get run operation.
-type GetRunInput struct {
-	_ struct{} `type:"structure"`
-
-	// The run's ARN.
-	//
-	// Arn is a required field
-	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+// SetInstanceArn sets the InstanceArn field's value.
+func (s *Job) SetInstanceArn(v string) *Job {
+	s.InstanceArn = &v
+	return s
 }
 
-// String returns the string representation
-func (s GetRunInput) String() string {
-	return awsutil.Prettify(s)
+// SetMessage sets the Message field's value.
+func (s *Job) SetMessage(v string) *Job {
+	s.Message = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s GetRunInput) GoString() string {
-	return s.String()
+// SetName sets the Name field's value.
+func (s *Job) SetName(v string) *Job {
+	s.Name = &v
+	return s
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetRunInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetRunInput"}
-	if s.Arn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Arn"))
-	}
-	if s.Arn != nil && len(*s.Arn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetResult sets the Result field's value.
+func (s *Job) SetResult(v string) *Job {
+	s.Result = &v
+	return s
 }
 
-// SetArn sets the Arn field's value.
-func (s *GetRunInput) SetArn(v string) *GetRunInput {
-	s.Arn = &v
+// SetStarted sets the Started field's value.
+func (s *Job) SetStarted(v time.Time) *Job {
+	s.Started = &v
 	return s
 }
 
-// Represents the result of a get run request.
-type GetRunOutput struct {
-	_ struct{} `type:"structure"`
+// SetStatus sets the Status field's value.
+func (s *Job) SetStatus(v string) *Job {
+	s.Status = &v
+	return s
+}
 
-	// The run you wish to get results from.
-	Run *Run `locationName:"run" type:"structure"`
+// SetStopped sets the Stopped field's value.
+func (s *Job) SetStopped(v time.Time) *Job {
+	s.Stopped = &v
+	return s
 }
 
-// String returns the string representation
-func (s GetRunOutput) String() string {
-	return awsutil.Prettify(s)
+// SetType sets the Type field's value.
+func (s *Job) SetType(v string) *Job {
+	s.Type = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s GetRunOutput) GoString() string {
-	return s.String()
+// SetVideoCapture sets the VideoCapture field's value.
+func (s *Job) SetVideoCapture(v bool) *Job {
+	s.VideoCapture = &v
+	return s
 }
 
-// SetRun sets the Run field's value.
-func (s *GetRunOutput) SetRun(v *Run) *GetRunOutput {
-	s.Run = v
+// SetVideoEndpoint sets the VideoEndpoint field's value.
+func (s *Job) SetVideoEndpoint(v string) *Job {
+	s.VideoEndpoint = &v
 	return s
 }
 
-// Represents a request to the get suite operation.
-type GetSuiteInput struct {
-	_ struct{} `type:"structure"`
+// A limit was exceeded.
+type LimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The suite's ARN.
-	//
-	// Arn is a required field
-	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+	// Any additional information about the exception.
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s GetSuiteInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetSuiteInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetSuiteInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetSuiteInput"}
-	if s.Arn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Arn"))
-	}
-	if s.Arn != nil && len(*s.Arn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
+func newErrorLimitExceededException(v protocol.ResponseMetadata) error {
+	return &LimitExceededException{
+		RespMetadata: v,
 	}
-	return nil
 }
 
-// SetArn sets the Arn field's value.
-func (s *GetSuiteInput) SetArn(v string) *GetSuiteInput {
-	s.Arn = &v
-	return s
+// Code returns the exception type name.
+func (s *LimitExceededException) Code() string {
+	return "LimitExceededException"
 }
 
-// Represents the result of a get suite request.
-type GetSuiteOutput struct {
-	_ struct{} `type:"structure"`
+// Message returns the exception's message.
+func (s *LimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
 
-	// A collection of one or more tests.
-	Suite *Suite `locationName:"suite" type:"structure"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *LimitExceededException) OrigErr() error {
+	return nil
 }
 
-// String returns the string representation
-func (s GetSuiteOutput) String() string {
-	return awsutil.Prettify(s)
+func (s *LimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// GoString returns the string representation
-func (s GetSuiteOutput) GoString() string {
-	return s.String()
+// Status code returns the HTTP status code for the request's response error.
+func (s *LimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// SetSuite sets the Suite field's value.
-func (s *GetSuiteOutput) SetSuite(v *Suite) *GetSuiteOutput {
-	s.Suite = v
-	return s
+// RequestID returns the service's response RequestID for request.
+func (s *LimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// Represents a request to the get test operation.
-type GetTestInput struct {
+// Represents a request to the list artifacts operation.
+type ListArtifactsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The test's ARN.
+	// The run, job, suite, or test ARN.
 	//
 	// Arn is a required field
 	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
-}
-
-// String returns the string representation
-func (s GetTestInput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s GetTestInput) GoString() string {
-	return s.String()
-}
-
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetTestInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetTestInput"}
-	if s.Arn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Arn"))
-	}
-	if s.Arn != nil && len(*s.Arn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
-// SetArn sets the Arn field's value.
-func (s *GetTestInput) SetArn(v string) *GetTestInput {
-	s.Arn = &v
-	return s
-}
-
-// Represents the result of a get test request.
-type GetTestOutput struct {
-	_ struct{} `type:"structure"`
-
-	// A test condition that is evaluated.
-	Test *Test `locationName:"test" type:"structure"`
-}
-
-// String returns the string representation
-func (s GetTestOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s GetTestOutput) GoString() string {
-	return s.String()
-}
-
-// SetTest sets the Test field's value.
-func (s *GetTestOutput) SetTest(v *Test) *GetTestOutput {
-	s.Test = v
-	return s
-}
 
-// Represents a request to the get upload operation.
-type GetUploadInput struct {
-	_ struct{} `type:"structure"`
+	// An identifier that was returned from the previous call to this operation,
+	// which can be used to return the next set of items in the list.
+	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 
-	// The upload's ARN.
+	// The artifacts' type.
 	//
-	// Arn is a required field
-	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+	// Allowed values include:
+	//
+	//    * FILE
+	//
+	//    * LOG
+	//
+	//    * SCREENSHOT
+	//
+	// Type is a required field
+	Type *string `locationName:"type" type:"string" required:"true" enum:"ArtifactCategory"`
 }
 
-// String returns the string representation
-func (s GetUploadInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListArtifactsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetUploadInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListArtifactsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *GetUploadInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetUploadInput"}
+func (s *ListArtifactsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListArtifactsInput"}
 	if s.Arn == nil {
 		invalidParams.Add(request.NewErrParamRequired("Arn"))
 	}
 	if s.Arn != nil && len(*s.Arn) < 32 {
 		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
 	}
+	if s.NextToken != nil && len(*s.NextToken) < 4 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 4))
+	}
+	if s.Type == nil {
+		invalidParams.Add(request.NewErrParamRequired("Type"))
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -10468,63 +13746,101 @@ func (s *GetUploadInput) Validate() error {
 }
 
 // SetArn sets the Arn field's value.
-func (s *GetUploadInput) SetArn(v string) *GetUploadInput {
+func (s *ListArtifactsInput) SetArn(v string) *ListArtifactsInput {
 	s.Arn = &v
 	return s
 }
 
-// Represents the result of a get upload request.
-type GetUploadOutput struct {
+// SetNextToken sets the NextToken field's value.
+func (s *ListArtifactsInput) SetNextToken(v string) *ListArtifactsInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *ListArtifactsInput) SetType(v string) *ListArtifactsInput {
+	s.Type = &v
+	return s
+}
+
+// Represents the result of a list artifacts operation.
+type ListArtifactsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// An app or a set of one or more tests to upload or that have been uploaded.
-	Upload *Upload `locationName:"upload" type:"structure"`
+	// Information about the artifacts.
+	Artifacts []*Artifact `locationName:"artifacts" type:"list"`
+
+	// If the number of items that are returned is significantly large, this is
+	// an identifier that is also returned. It can be used in a subsequent call
+	// to this operation to return the next set of items in the list.
+	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// String returns the string representation
-func (s GetUploadOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListArtifactsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetUploadOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListArtifactsOutput) GoString() string {
 	return s.String()
 }
 
-// SetUpload sets the Upload field's value.
-func (s *GetUploadOutput) SetUpload(v *Upload) *GetUploadOutput {
-	s.Upload = v
+// SetArtifacts sets the Artifacts field's value.
+func (s *ListArtifactsOutput) SetArtifacts(v []*Artifact) *ListArtifactsOutput {
+	s.Artifacts = v
 	return s
 }
 
-type GetVPCEConfigurationInput struct {
+// SetNextToken sets the NextToken field's value.
+func (s *ListArtifactsOutput) SetNextToken(v string) *ListArtifactsOutput {
+	s.NextToken = &v
+	return s
+}
+
+type ListDeviceInstancesInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the VPC endpoint configuration you want
-	// to describe.
-	//
-	// Arn is a required field
-	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+	// An integer that specifies the maximum number of items you want to return
+	// in the API response.
+	MaxResults *int64 `locationName:"maxResults" type:"integer"`
+
+	// An identifier that was returned from the previous call to this operation,
+	// which can be used to return the next set of items in the list.
+	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// String returns the string representation
-func (s GetVPCEConfigurationInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListDeviceInstancesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetVPCEConfigurationInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListDeviceInstancesInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *GetVPCEConfigurationInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetVPCEConfigurationInput"}
-	if s.Arn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Arn"))
-	}
-	if s.Arn != nil && len(*s.Arn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+func (s *ListDeviceInstancesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListDeviceInstancesInput"}
+	if s.NextToken != nil && len(*s.NextToken) < 4 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 4))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -10533,124 +13849,112 @@ func (s *GetVPCEConfigurationInput) Validate() error {
 	return nil
 }
 
-// SetArn sets the Arn field's value.
-func (s *GetVPCEConfigurationInput) SetArn(v string) *GetVPCEConfigurationInput {
-	s.Arn = &v
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListDeviceInstancesInput) SetMaxResults(v int64) *ListDeviceInstancesInput {
+	s.MaxResults = &v
 	return s
 }
 
-type GetVPCEConfigurationOutput struct {
-	_ struct{} `type:"structure"`
-
-	// An object containing information about your VPC endpoint configuration.
-	VpceConfiguration *VPCEConfiguration `locationName:"vpceConfiguration" type:"structure"`
-}
-
-// String returns the string representation
-func (s GetVPCEConfigurationOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s GetVPCEConfigurationOutput) GoString() string {
-	return s.String()
-}
-
-// SetVpceConfiguration sets the VpceConfiguration field's value.
-func (s *GetVPCEConfigurationOutput) SetVpceConfiguration(v *VPCEConfiguration) *GetVPCEConfigurationOutput {
-	s.VpceConfiguration = v
+// SetNextToken sets the NextToken field's value.
+func (s *ListDeviceInstancesInput) SetNextToken(v string) *ListDeviceInstancesInput {
+	s.NextToken = &v
 	return s
 }
 
-// Represents information about incompatibility.
-type IncompatibilityMessage struct {
+type ListDeviceInstancesOutput struct {
 	_ struct{} `type:"structure"`
 
-	// A message about the incompatibility.
-	Message *string `locationName:"message" type:"string"`
+	// An object that contains information about your device instances.
+	DeviceInstances []*DeviceInstance `locationName:"deviceInstances" type:"list"`
 
-	// The type of incompatibility.
-	//
-	// Allowed values include:
-	//
-	//    * ARN: The ARN.
-	//
-	//    * FORM_FACTOR: The form factor (for example, phone or tablet).
-	//
-	//    * MANUFACTURER: The manufacturer.
-	//
-	//    * PLATFORM: The platform (for example, Android or iOS).
-	//
-	//    * REMOTE_ACCESS_ENABLED: Whether the device is enabled for remote access.
-	//
-	//    * APPIUM_VERSION: The Appium version for the test.
-	Type *string `locationName:"type" type:"string" enum:"DeviceAttribute"`
+	// An identifier that can be used in the next call to this operation to return
+	// the next set of items in the list.
+	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// String returns the string representation
-func (s IncompatibilityMessage) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListDeviceInstancesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s IncompatibilityMessage) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListDeviceInstancesOutput) GoString() string {
 	return s.String()
 }
 
-// SetMessage sets the Message field's value.
-func (s *IncompatibilityMessage) SetMessage(v string) *IncompatibilityMessage {
-	s.Message = &v
+// SetDeviceInstances sets the DeviceInstances field's value.
+func (s *ListDeviceInstancesOutput) SetDeviceInstances(v []*DeviceInstance) *ListDeviceInstancesOutput {
+	s.DeviceInstances = v
 	return s
 }
 
-// SetType sets the Type field's value.
-func (s *IncompatibilityMessage) SetType(v string) *IncompatibilityMessage {
-	s.Type = &v
+// SetNextToken sets the NextToken field's value.
+func (s *ListDeviceInstancesOutput) SetNextToken(v string) *ListDeviceInstancesOutput {
+	s.NextToken = &v
 	return s
 }
 
-// Represents the request to install an Android application (in .apk format)
-// or an iOS application (in .ipa format) as part of a remote access session.
-type InstallToRemoteAccessSessionInput struct {
+// Represents the result of a list device pools request.
+type ListDevicePoolsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the app about which you are requesting
-	// information.
+	// The project ARN.
 	//
-	// AppArn is a required field
-	AppArn *string `locationName:"appArn" min:"32" type:"string" required:"true"`
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 
-	// The Amazon Resource Name (ARN) of the remote access session about which you
-	// are requesting information.
+	// An identifier that was returned from the previous call to this operation,
+	// which can be used to return the next set of items in the list.
+	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
+
+	// The device pools' type.
 	//
-	// RemoteAccessSessionArn is a required field
-	RemoteAccessSessionArn *string `locationName:"remoteAccessSessionArn" min:"32" type:"string" required:"true"`
+	// Allowed values include:
+	//
+	//    * CURATED: A device pool that is created and managed by AWS Device Farm.
+	//
+	//    * PRIVATE: A device pool that is created and managed by the device pool
+	//    developer.
+	Type *string `locationName:"type" type:"string" enum:"DevicePoolType"`
 }
 
-// String returns the string representation
-func (s InstallToRemoteAccessSessionInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListDevicePoolsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s InstallToRemoteAccessSessionInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListDevicePoolsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *InstallToRemoteAccessSessionInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "InstallToRemoteAccessSessionInput"}
-	if s.AppArn == nil {
-		invalidParams.Add(request.NewErrParamRequired("AppArn"))
-	}
-	if s.AppArn != nil && len(*s.AppArn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("AppArn", 32))
+func (s *ListDevicePoolsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListDevicePoolsInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
 	}
-	if s.RemoteAccessSessionArn == nil {
-		invalidParams.Add(request.NewErrParamRequired("RemoteAccessSessionArn"))
+	if s.Arn != nil && len(*s.Arn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
 	}
-	if s.RemoteAccessSessionArn != nil && len(*s.RemoteAccessSessionArn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("RemoteAccessSessionArn", 32))
+	if s.NextToken != nil && len(*s.NextToken) < 4 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 4))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -10659,349 +13963,319 @@ func (s *InstallToRemoteAccessSessionInput) Validate() error {
 	return nil
 }
 
-// SetAppArn sets the AppArn field's value.
-func (s *InstallToRemoteAccessSessionInput) SetAppArn(v string) *InstallToRemoteAccessSessionInput {
-	s.AppArn = &v
+// SetArn sets the Arn field's value.
+func (s *ListDevicePoolsInput) SetArn(v string) *ListDevicePoolsInput {
+	s.Arn = &v
 	return s
 }
 
-// SetRemoteAccessSessionArn sets the RemoteAccessSessionArn field's value.
-func (s *InstallToRemoteAccessSessionInput) SetRemoteAccessSessionArn(v string) *InstallToRemoteAccessSessionInput {
-	s.RemoteAccessSessionArn = &v
+// SetNextToken sets the NextToken field's value.
+func (s *ListDevicePoolsInput) SetNextToken(v string) *ListDevicePoolsInput {
+	s.NextToken = &v
 	return s
 }
 
-// Represents the response from the server after AWS Device Farm makes a request
-// to install to a remote access session.
-type InstallToRemoteAccessSessionOutput struct {
+// SetType sets the Type field's value.
+func (s *ListDevicePoolsInput) SetType(v string) *ListDevicePoolsInput {
+	s.Type = &v
+	return s
+}
+
+// Represents the result of a list device pools request.
+type ListDevicePoolsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// An app to upload or that has been uploaded.
-	AppUpload *Upload `locationName:"appUpload" type:"structure"`
+	// Information about the device pools.
+	DevicePools []*DevicePool `locationName:"devicePools" type:"list"`
+
+	// If the number of items that are returned is significantly large, this is
+	// an identifier that is also returned. It can be used in a subsequent call
+	// to this operation to return the next set of items in the list.
+	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// String returns the string representation
-func (s InstallToRemoteAccessSessionOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListDevicePoolsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s InstallToRemoteAccessSessionOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListDevicePoolsOutput) GoString() string {
 	return s.String()
 }
 
-// SetAppUpload sets the AppUpload field's value.
-func (s *InstallToRemoteAccessSessionOutput) SetAppUpload(v *Upload) *InstallToRemoteAccessSessionOutput {
-	s.AppUpload = v
+// SetDevicePools sets the DevicePools field's value.
+func (s *ListDevicePoolsOutput) SetDevicePools(v []*DevicePool) *ListDevicePoolsOutput {
+	s.DevicePools = v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListDevicePoolsOutput) SetNextToken(v string) *ListDevicePoolsOutput {
+	s.NextToken = &v
 	return s
 }
 
-// Represents the instance profile.
-type InstanceProfile struct {
+// Represents the result of a list devices request.
+type ListDevicesInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the instance profile.
+	// The Amazon Resource Name (ARN) of the project.
 	Arn *string `locationName:"arn" min:"32" type:"string"`
 
-	// The description of the instance profile.
-	Description *string `locationName:"description" type:"string"`
-
-	// An array of strings specifying the list of app packages that should not be
-	// cleaned up from the device after a test run is over.
+	// Used to select a set of devices. A filter is made up of an attribute, an
+	// operator, and one or more values.
 	//
-	// The list of packages is only considered if you set packageCleanup to true.
-	ExcludeAppPackagesFromCleanup []*string `locationName:"excludeAppPackagesFromCleanup" type:"list"`
-
-	// The name of the instance profile.
-	Name *string `locationName:"name" type:"string"`
-
-	// When set to true, Device Farm will remove app packages after a test run.
-	// The default value is false for private devices.
-	PackageCleanup *bool `locationName:"packageCleanup" type:"boolean"`
+	//    * Attribute: The aspect of a device such as platform or model used as
+	//    the selection criteria in a device filter. Allowed values include: ARN:
+	//    The Amazon Resource Name (ARN) of the device (for example, arn:aws:devicefarm:us-west-2::device:12345Example).
+	//    PLATFORM: The device platform. Valid values are ANDROID or IOS. OS_VERSION:
+	//    The operating system version (for example, 10.3.2). MODEL: The device
+	//    model (for example, iPad 5th Gen). AVAILABILITY: The current availability
+	//    of the device. Valid values are AVAILABLE, HIGHLY_AVAILABLE, BUSY, or
+	//    TEMPORARY_NOT_AVAILABLE. FORM_FACTOR: The device form factor. Valid values
+	//    are PHONE or TABLET. MANUFACTURER: The device manufacturer (for example,
+	//    Apple). REMOTE_ACCESS_ENABLED: Whether the device is enabled for remote
+	//    access. Valid values are TRUE or FALSE. REMOTE_DEBUG_ENABLED: Whether
+	//    the device is enabled for remote debugging. Valid values are TRUE or FALSE.
+	//    Because remote debugging is no longer supported (https://docs.aws.amazon.com/devicefarm/latest/developerguide/history.html),
+	//    this attribute is ignored. INSTANCE_ARN: The Amazon Resource Name (ARN)
+	//    of the device instance. INSTANCE_LABELS: The label of the device instance.
+	//    FLEET_TYPE: The fleet type. Valid values are PUBLIC or PRIVATE.
+	//
+	//    * Operator: The filter operator. The EQUALS operator is available for
+	//    every attribute except INSTANCE_LABELS. The CONTAINS operator is available
+	//    for the INSTANCE_LABELS and MODEL attributes. The IN and NOT_IN operators
+	//    are available for the ARN, OS_VERSION, MODEL, MANUFACTURER, and INSTANCE_ARN
+	//    attributes. The LESS_THAN, GREATER_THAN, LESS_THAN_OR_EQUALS, and GREATER_THAN_OR_EQUALS
+	//    operators are also available for the OS_VERSION attribute.
+	//
+	//    * Values: An array of one or more filter values. The IN and NOT_IN operators
+	//    take a values array that has one or more elements. The other operators
+	//    require an array with a single element. In a request, the AVAILABILITY
+	//    attribute takes the following values: AVAILABLE, HIGHLY_AVAILABLE, BUSY,
+	//    or TEMPORARY_NOT_AVAILABLE.
+	Filters []*DeviceFilter `locationName:"filters" type:"list"`
 
-	// When set to true, Device Farm will reboot the instance after a test run.
-	// The default value is true.
-	RebootAfterUse *bool `locationName:"rebootAfterUse" type:"boolean"`
+	// An identifier that was returned from the previous call to this operation,
+	// which can be used to return the next set of items in the list.
+	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// String returns the string representation
-func (s InstanceProfile) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListDevicesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s InstanceProfile) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListDevicesInput) GoString() string {
 	return s.String()
 }
 
-// SetArn sets the Arn field's value.
-func (s *InstanceProfile) SetArn(v string) *InstanceProfile {
-	s.Arn = &v
-	return s
-}
-
-// SetDescription sets the Description field's value.
-func (s *InstanceProfile) SetDescription(v string) *InstanceProfile {
-	s.Description = &v
-	return s
-}
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListDevicesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListDevicesInput"}
+	if s.Arn != nil && len(*s.Arn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+	}
+	if s.NextToken != nil && len(*s.NextToken) < 4 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 4))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
 
-// SetExcludeAppPackagesFromCleanup sets the ExcludeAppPackagesFromCleanup field's value.
-func (s *InstanceProfile) SetExcludeAppPackagesFromCleanup(v []*string) *InstanceProfile {
-	s.ExcludeAppPackagesFromCleanup = v
-	return s
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetName sets the Name field's value.
-func (s *InstanceProfile) SetName(v string) *InstanceProfile {
-	s.Name = &v
+// SetArn sets the Arn field's value.
+func (s *ListDevicesInput) SetArn(v string) *ListDevicesInput {
+	s.Arn = &v
 	return s
 }
 
-// SetPackageCleanup sets the PackageCleanup field's value.
-func (s *InstanceProfile) SetPackageCleanup(v bool) *InstanceProfile {
-	s.PackageCleanup = &v
+// SetFilters sets the Filters field's value.
+func (s *ListDevicesInput) SetFilters(v []*DeviceFilter) *ListDevicesInput {
+	s.Filters = v
 	return s
 }
 
-// SetRebootAfterUse sets the RebootAfterUse field's value.
-func (s *InstanceProfile) SetRebootAfterUse(v bool) *InstanceProfile {
-	s.RebootAfterUse = &v
+// SetNextToken sets the NextToken field's value.
+func (s *ListDevicesInput) SetNextToken(v string) *ListDevicesInput {
+	s.NextToken = &v
 	return s
 }
 
-// Represents a device.
-type Job struct {
+// Represents the result of a list devices operation.
+type ListDevicesOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The job's ARN.
-	Arn *string `locationName:"arn" min:"32" type:"string"`
-
-	// The job's result counters.
-	Counters *Counters `locationName:"counters" type:"structure"`
-
-	// When the job was created.
-	Created *time.Time `locationName:"created" type:"timestamp"`
-
-	// The device (phone or tablet).
-	Device *Device `locationName:"device" type:"structure"`
-
-	// Represents the total (metered or unmetered) minutes used by the job.
-	DeviceMinutes *DeviceMinutes `locationName:"deviceMinutes" type:"structure"`
-
-	// The Amazon Resource Name (ARN) of the instance.
-	InstanceArn *string `locationName:"instanceArn" min:"32" type:"string"`
-
-	// A message about the job's result.
-	Message *string `locationName:"message" type:"string"`
-
-	// The job's name.
-	Name *string `locationName:"name" type:"string"`
-
-	// The job's result.
-	//
-	// Allowed values include:
-	//
-	//    * PENDING: A pending condition.
-	//
-	//    * PASSED: A passing condition.
-	//
-	//    * WARNED: A warning condition.
-	//
-	//    * FAILED: A failed condition.
-	//
-	//    * SKIPPED: A skipped condition.
-	//
-	//    * ERRORED: An error condition.
-	//
-	//    * STOPPED: A stopped condition.
-	Result *string `locationName:"result" type:"string" enum:"ExecutionResult"`
-
-	// The job's start time.
-	Started *time.Time `locationName:"started" type:"timestamp"`
-
-	// The job's status.
-	//
-	// Allowed values include:
-	//
-	//    * PENDING: A pending status.
-	//
-	//    * PENDING_CONCURRENCY: A pending concurrency status.
-	//
-	//    * PENDING_DEVICE: A pending device status.
-	//
-	//    * PROCESSING: A processing status.
-	//
-	//    * SCHEDULING: A scheduling status.
-	//
-	//    * PREPARING: A preparing status.
-	//
-	//    * RUNNING: A running status.
-	//
-	//    * COMPLETED: A completed status.
-	//
-	//    * STOPPING: A stopping status.
-	Status *string `locationName:"status" type:"string" enum:"ExecutionStatus"`
-
-	// The job's stop time.
-	Stopped *time.Time `locationName:"stopped" type:"timestamp"`
-
-	// The job's type.
-	//
-	// Allowed values include the following:
-	//
-	//    * BUILTIN_FUZZ: The built-in fuzz type.
-	//
-	//    * BUILTIN_EXPLORER: For Android, an app explorer that will traverse an
-	//    Android app, interacting with it and capturing screenshots at the same
-	//    time.
-	//
-	//    * APPIUM_JAVA_JUNIT: The Appium Java JUnit type.
-	//
-	//    * APPIUM_JAVA_TESTNG: The Appium Java TestNG type.
-	//
-	//    * APPIUM_PYTHON: The Appium Python type.
-	//
-	//    * APPIUM_NODE: The Appium Node.js type.
-	//
-	//    * APPIUM_RUBY: The Appium Ruby type.
-	//
-	//    * APPIUM_WEB_JAVA_JUNIT: The Appium Java JUnit type for web apps.
-	//
-	//    * APPIUM_WEB_JAVA_TESTNG: The Appium Java TestNG type for web apps.
-	//
-	//    * APPIUM_WEB_PYTHON: The Appium Python type for web apps.
-	//
-	//    * APPIUM_WEB_NODE: The Appium Node.js type for web apps.
-	//
-	//    * APPIUM_WEB_RUBY: The Appium Ruby test type for web apps.
-	//
-	//    * CALABASH: The Calabash type.
-	//
-	//    * INSTRUMENTATION: The Instrumentation type.
-	//
-	//    * UIAUTOMATION: The uiautomation type.
-	//
-	//    * UIAUTOMATOR: The uiautomator type.
-	//
-	//    * XCTEST: The Xcode test type.
-	//
-	//    * XCTEST_UI: The Xcode UI test type.
-	Type *string `locationName:"type" type:"string" enum:"TestType"`
-
-	// This value is set to true if video capture is enabled; otherwise, it is set
-	// to false.
-	VideoCapture *bool `locationName:"videoCapture" type:"boolean"`
+	// Information about the devices.
+	Devices []*Device `locationName:"devices" type:"list"`
 
-	// The endpoint for streaming device video.
-	VideoEndpoint *string `locationName:"videoEndpoint" type:"string"`
+	// If the number of items that are returned is significantly large, this is
+	// an identifier that is also returned. It can be used in a subsequent call
+	// to this operation to return the next set of items in the list.
+	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// String returns the string representation
-func (s Job) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListDevicesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Job) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListDevicesOutput) GoString() string {
 	return s.String()
 }
 
-// SetArn sets the Arn field's value.
-func (s *Job) SetArn(v string) *Job {
-	s.Arn = &v
+// SetDevices sets the Devices field's value.
+func (s *ListDevicesOutput) SetDevices(v []*Device) *ListDevicesOutput {
+	s.Devices = v
 	return s
 }
 
-// SetCounters sets the Counters field's value.
-func (s *Job) SetCounters(v *Counters) *Job {
-	s.Counters = v
+// SetNextToken sets the NextToken field's value.
+func (s *ListDevicesOutput) SetNextToken(v string) *ListDevicesOutput {
+	s.NextToken = &v
 	return s
 }
 
-// SetCreated sets the Created field's value.
-func (s *Job) SetCreated(v time.Time) *Job {
-	s.Created = &v
-	return s
-}
+type ListInstanceProfilesInput struct {
+	_ struct{} `type:"structure"`
 
-// SetDevice sets the Device field's value.
-func (s *Job) SetDevice(v *Device) *Job {
-	s.Device = v
-	return s
+	// An integer that specifies the maximum number of items you want to return
+	// in the API response.
+	MaxResults *int64 `locationName:"maxResults" type:"integer"`
+
+	// An identifier that was returned from the previous call to this operation,
+	// which can be used to return the next set of items in the list.
+	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// SetDeviceMinutes sets the DeviceMinutes field's value.
-func (s *Job) SetDeviceMinutes(v *DeviceMinutes) *Job {
-	s.DeviceMinutes = v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListInstanceProfilesInput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetInstanceArn sets the InstanceArn field's value.
-func (s *Job) SetInstanceArn(v string) *Job {
-	s.InstanceArn = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListInstanceProfilesInput) GoString() string {
+	return s.String()
 }
 
-// SetMessage sets the Message field's value.
-func (s *Job) SetMessage(v string) *Job {
-	s.Message = &v
-	return s
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListInstanceProfilesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListInstanceProfilesInput"}
+	if s.NextToken != nil && len(*s.NextToken) < 4 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 4))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetName sets the Name field's value.
-func (s *Job) SetName(v string) *Job {
-	s.Name = &v
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListInstanceProfilesInput) SetMaxResults(v int64) *ListInstanceProfilesInput {
+	s.MaxResults = &v
 	return s
 }
 
-// SetResult sets the Result field's value.
-func (s *Job) SetResult(v string) *Job {
-	s.Result = &v
+// SetNextToken sets the NextToken field's value.
+func (s *ListInstanceProfilesInput) SetNextToken(v string) *ListInstanceProfilesInput {
+	s.NextToken = &v
 	return s
 }
 
-// SetStarted sets the Started field's value.
-func (s *Job) SetStarted(v time.Time) *Job {
-	s.Started = &v
-	return s
-}
+type ListInstanceProfilesOutput struct {
+	_ struct{} `type:"structure"`
 
-// SetStatus sets the Status field's value.
-func (s *Job) SetStatus(v string) *Job {
-	s.Status = &v
-	return s
+	// An object that contains information about your instance profiles.
+	InstanceProfiles []*InstanceProfile `locationName:"instanceProfiles" type:"list"`
+
+	// An identifier that can be used in the next call to this operation to return
+	// the next set of items in the list.
+	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// SetStopped sets the Stopped field's value.
-func (s *Job) SetStopped(v time.Time) *Job {
-	s.Stopped = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListInstanceProfilesOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetType sets the Type field's value.
-func (s *Job) SetType(v string) *Job {
-	s.Type = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListInstanceProfilesOutput) GoString() string {
+	return s.String()
 }
 
-// SetVideoCapture sets the VideoCapture field's value.
-func (s *Job) SetVideoCapture(v bool) *Job {
-	s.VideoCapture = &v
+// SetInstanceProfiles sets the InstanceProfiles field's value.
+func (s *ListInstanceProfilesOutput) SetInstanceProfiles(v []*InstanceProfile) *ListInstanceProfilesOutput {
+	s.InstanceProfiles = v
 	return s
 }
 
-// SetVideoEndpoint sets the VideoEndpoint field's value.
-func (s *Job) SetVideoEndpoint(v string) *Job {
-	s.VideoEndpoint = &v
+// SetNextToken sets the NextToken field's value.
+func (s *ListInstanceProfilesOutput) SetNextToken(v string) *ListInstanceProfilesOutput {
+	s.NextToken = &v
 	return s
 }
 
-// Represents a request to the list artifacts operation.
-type ListArtifactsInput struct {
+// Represents a request to the list jobs operation.
+type ListJobsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Run, Job, Suite, or Test ARN.
+	// The run's Amazon Resource Name (ARN).
 	//
 	// Arn is a required field
 	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
@@ -11009,34 +14283,29 @@ type ListArtifactsInput struct {
 	// An identifier that was returned from the previous call to this operation,
 	// which can be used to return the next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
-
-	// The artifacts' type.
-	//
-	// Allowed values include:
-	//
-	//    * FILE: The artifacts are files.
-	//
-	//    * LOG: The artifacts are logs.
-	//
-	//    * SCREENSHOT: The artifacts are screenshots.
-	//
-	// Type is a required field
-	Type *string `locationName:"type" type:"string" required:"true" enum:"ArtifactCategory"`
 }
 
-// String returns the string representation
-func (s ListArtifactsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListJobsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListArtifactsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListJobsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListArtifactsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListArtifactsInput"}
+func (s *ListJobsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListJobsInput"}
 	if s.Arn == nil {
 		invalidParams.Add(request.NewErrParamRequired("Arn"))
 	}
@@ -11046,9 +14315,6 @@ func (s *ListArtifactsInput) Validate() error {
 	if s.NextToken != nil && len(*s.NextToken) < 4 {
 		invalidParams.Add(request.NewErrParamMinLen("NextToken", 4))
 	}
-	if s.Type == nil {
-		invalidParams.Add(request.NewErrParamRequired("Type"))
-	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -11057,83 +14323,105 @@ func (s *ListArtifactsInput) Validate() error {
 }
 
 // SetArn sets the Arn field's value.
-func (s *ListArtifactsInput) SetArn(v string) *ListArtifactsInput {
+func (s *ListJobsInput) SetArn(v string) *ListJobsInput {
 	s.Arn = &v
 	return s
 }
 
 // SetNextToken sets the NextToken field's value.
-func (s *ListArtifactsInput) SetNextToken(v string) *ListArtifactsInput {
+func (s *ListJobsInput) SetNextToken(v string) *ListJobsInput {
 	s.NextToken = &v
 	return s
 }
 
-// SetType sets the Type field's value.
-func (s *ListArtifactsInput) SetType(v string) *ListArtifactsInput {
-	s.Type = &v
-	return s
-}
-
-// Represents the result of a list artifacts operation.
-type ListArtifactsOutput struct {
+// Represents the result of a list jobs request.
+type ListJobsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Information about the artifacts.
-	Artifacts []*Artifact `locationName:"artifacts" type:"list"`
+	// Information about the jobs.
+	Jobs []*Job `locationName:"jobs" type:"list"`
 
 	// If the number of items that are returned is significantly large, this is
-	// an identifier that is also returned, which can be used in a subsequent call
+	// an identifier that is also returned. It can be used in a subsequent call
 	// to this operation to return the next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// String returns the string representation
-func (s ListArtifactsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListJobsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListArtifactsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListJobsOutput) GoString() string {
 	return s.String()
 }
 
-// SetArtifacts sets the Artifacts field's value.
-func (s *ListArtifactsOutput) SetArtifacts(v []*Artifact) *ListArtifactsOutput {
-	s.Artifacts = v
+// SetJobs sets the Jobs field's value.
+func (s *ListJobsOutput) SetJobs(v []*Job) *ListJobsOutput {
+	s.Jobs = v
 	return s
 }
 
 // SetNextToken sets the NextToken field's value.
-func (s *ListArtifactsOutput) SetNextToken(v string) *ListArtifactsOutput {
+func (s *ListJobsOutput) SetNextToken(v string) *ListJobsOutput {
 	s.NextToken = &v
 	return s
 }
 
-type ListDeviceInstancesInput struct {
+type ListNetworkProfilesInput struct {
 	_ struct{} `type:"structure"`
 
-	// An integer specifying the maximum number of items you want to return in the
-	// API response.
-	MaxResults *int64 `locationName:"maxResults" type:"integer"`
+	// The Amazon Resource Name (ARN) of the project for which you want to list
+	// network profiles.
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 
 	// An identifier that was returned from the previous call to this operation,
 	// which can be used to return the next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
+
+	// The type of network profile to return information about. Valid values are
+	// listed here.
+	Type *string `locationName:"type" type:"string" enum:"NetworkProfileType"`
 }
 
-// String returns the string representation
-func (s ListDeviceInstancesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListNetworkProfilesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListDeviceInstancesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListNetworkProfilesInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListDeviceInstancesInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListDeviceInstancesInput"}
+func (s *ListNetworkProfilesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListNetworkProfilesInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
+	}
+	if s.Arn != nil && len(*s.Arn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+	}
 	if s.NextToken != nil && len(*s.NextToken) < 4 {
 		invalidParams.Add(request.NewErrParamMinLen("NextToken", 4))
 	}
@@ -11144,94 +14432,94 @@ func (s *ListDeviceInstancesInput) Validate() error {
 	return nil
 }
 
-// SetMaxResults sets the MaxResults field's value.
-func (s *ListDeviceInstancesInput) SetMaxResults(v int64) *ListDeviceInstancesInput {
-	s.MaxResults = &v
+// SetArn sets the Arn field's value.
+func (s *ListNetworkProfilesInput) SetArn(v string) *ListNetworkProfilesInput {
+	s.Arn = &v
 	return s
 }
 
 // SetNextToken sets the NextToken field's value.
-func (s *ListDeviceInstancesInput) SetNextToken(v string) *ListDeviceInstancesInput {
+func (s *ListNetworkProfilesInput) SetNextToken(v string) *ListNetworkProfilesInput {
 	s.NextToken = &v
 	return s
 }
 
-type ListDeviceInstancesOutput struct {
+// SetType sets the Type field's value.
+func (s *ListNetworkProfilesInput) SetType(v string) *ListNetworkProfilesInput {
+	s.Type = &v
+	return s
+}
+
+type ListNetworkProfilesOutput struct {
 	_ struct{} `type:"structure"`
 
-	// An object containing information about your device instances.
-	DeviceInstances []*DeviceInstance `locationName:"deviceInstances" type:"list"`
+	// A list of the available network profiles.
+	NetworkProfiles []*NetworkProfile `locationName:"networkProfiles" type:"list"`
 
-	// An identifier that can be used in the next call to this operation to return
-	// the next set of items in the list.
+	// An identifier that was returned from the previous call to this operation,
+	// which can be used to return the next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// String returns the string representation
-func (s ListDeviceInstancesOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListNetworkProfilesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListDeviceInstancesOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListNetworkProfilesOutput) GoString() string {
 	return s.String()
 }
 
-// SetDeviceInstances sets the DeviceInstances field's value.
-func (s *ListDeviceInstancesOutput) SetDeviceInstances(v []*DeviceInstance) *ListDeviceInstancesOutput {
-	s.DeviceInstances = v
+// SetNetworkProfiles sets the NetworkProfiles field's value.
+func (s *ListNetworkProfilesOutput) SetNetworkProfiles(v []*NetworkProfile) *ListNetworkProfilesOutput {
+	s.NetworkProfiles = v
 	return s
 }
 
 // SetNextToken sets the NextToken field's value.
-func (s *ListDeviceInstancesOutput) SetNextToken(v string) *ListDeviceInstancesOutput {
+func (s *ListNetworkProfilesOutput) SetNextToken(v string) *ListNetworkProfilesOutput {
 	s.NextToken = &v
 	return s
 }
 
-// Represents the result of a list device pools request.
-type ListDevicePoolsInput struct {
+type ListOfferingPromotionsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The project ARN.
-	//
-	// Arn is a required field
-	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
-
 	// An identifier that was returned from the previous call to this operation,
 	// which can be used to return the next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
-
-	// The device pools' type.
-	//
-	// Allowed values include:
-	//
-	//    * CURATED: A device pool that is created and managed by AWS Device Farm.
-	//
-	//    * PRIVATE: A device pool that is created and managed by the device pool
-	//    developer.
-	Type *string `locationName:"type" type:"string" enum:"DevicePoolType"`
 }
 
-// String returns the string representation
-func (s ListDevicePoolsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListOfferingPromotionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListDevicePoolsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListOfferingPromotionsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListDevicePoolsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListDevicePoolsInput"}
-	if s.Arn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Arn"))
-	}
-	if s.Arn != nil && len(*s.Arn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
-	}
+func (s *ListOfferingPromotionsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListOfferingPromotionsInput"}
 	if s.NextToken != nil && len(*s.NextToken) < 4 {
 		invalidParams.Add(request.NewErrParamMinLen("NextToken", 4))
 	}
@@ -11242,120 +14530,83 @@ func (s *ListDevicePoolsInput) Validate() error {
 	return nil
 }
 
-// SetArn sets the Arn field's value.
-func (s *ListDevicePoolsInput) SetArn(v string) *ListDevicePoolsInput {
-	s.Arn = &v
-	return s
-}
-
 // SetNextToken sets the NextToken field's value.
-func (s *ListDevicePoolsInput) SetNextToken(v string) *ListDevicePoolsInput {
+func (s *ListOfferingPromotionsInput) SetNextToken(v string) *ListOfferingPromotionsInput {
 	s.NextToken = &v
 	return s
 }
 
-// SetType sets the Type field's value.
-func (s *ListDevicePoolsInput) SetType(v string) *ListDevicePoolsInput {
-	s.Type = &v
-	return s
-}
-
-// Represents the result of a list device pools request.
-type ListDevicePoolsOutput struct {
+type ListOfferingPromotionsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Information about the device pools.
-	DevicePools []*DevicePool `locationName:"devicePools" type:"list"`
-
-	// If the number of items that are returned is significantly large, this is
-	// an identifier that is also returned, which can be used in a subsequent call
-	// to this operation to return the next set of items in the list.
+	// An identifier to be used in the next call to this operation, to return the
+	// next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
+
+	// Information about the offering promotions.
+	OfferingPromotions []*OfferingPromotion `locationName:"offeringPromotions" type:"list"`
 }
 
-// String returns the string representation
-func (s ListDevicePoolsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListOfferingPromotionsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListDevicePoolsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListOfferingPromotionsOutput) GoString() string {
 	return s.String()
 }
 
-// SetDevicePools sets the DevicePools field's value.
-func (s *ListDevicePoolsOutput) SetDevicePools(v []*DevicePool) *ListDevicePoolsOutput {
-	s.DevicePools = v
+// SetNextToken sets the NextToken field's value.
+func (s *ListOfferingPromotionsOutput) SetNextToken(v string) *ListOfferingPromotionsOutput {
+	s.NextToken = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListDevicePoolsOutput) SetNextToken(v string) *ListDevicePoolsOutput {
-	s.NextToken = &v
+// SetOfferingPromotions sets the OfferingPromotions field's value.
+func (s *ListOfferingPromotionsOutput) SetOfferingPromotions(v []*OfferingPromotion) *ListOfferingPromotionsOutput {
+	s.OfferingPromotions = v
 	return s
 }
 
-// Represents the result of a list devices request.
-type ListDevicesInput struct {
+// Represents the request to list the offering transaction history.
+type ListOfferingTransactionsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the project.
-	Arn *string `locationName:"arn" min:"32" type:"string"`
-
-	// Used to select a set of devices. A filter is made up of an attribute, an
-	// operator, and one or more values.
-	//
-	//    * Attribute: The aspect of a device such as platform or model used as
-	//    the selection criteria in a device filter. Allowed values include: ARN:
-	//    The Amazon Resource Name (ARN) of the device. For example, "arn:aws:devicefarm:us-west-2::device:12345Example".
-	//    PLATFORM: The device platform. Valid values are "ANDROID" or "IOS". OS_VERSION:
-	//    The operating system version. For example, "10.3.2". MODEL: The device
-	//    model. For example, "iPad 5th Gen". AVAILABILITY: The current availability
-	//    of the device. Valid values are "AVAILABLE", "HIGHLY_AVAILABLE", "BUSY",
-	//    or "TEMPORARY_NOT_AVAILABLE". FORM_FACTOR: The device form factor. Valid
-	//    values are "PHONE" or "TABLET". MANUFACTURER: The device manufacturer.
-	//    For example, "Apple". REMOTE_ACCESS_ENABLED: Whether the device is enabled
-	//    for remote access. Valid values are "TRUE" or "FALSE". REMOTE_DEBUG_ENABLED:
-	//    Whether the device is enabled for remote debugging. Valid values are "TRUE"
-	//    or "FALSE". INSTANCE_ARN: The Amazon Resource Name (ARN) of the device
-	//    instance. INSTANCE_LABELS: The label of the device instance. FLEET_TYPE:
-	//    The fleet type. Valid values are "PUBLIC" or "PRIVATE".
-	//
-	//    * Operator: The filter operator. The EQUALS operator is available for
-	//    every attribute except INSTANCE_LABELS. The CONTAINS operator is available
-	//    for the INSTANCE_LABELS and MODEL attributes. The IN and NOT_IN operators
-	//    are available for the ARN, OS_VERSION, MODEL, MANUFACTURER, and INSTANCE_ARN
-	//    attributes. The LESS_THAN, GREATER_THAN, LESS_THAN_OR_EQUALS, and GREATER_THAN_OR_EQUALS
-	//    operators are also available for the OS_VERSION attribute.
-	//
-	//    * Values: An array of one or more filter values. The IN and NOT_IN operators
-	//    take a values array that has one or more elements. The other operators
-	//    require an array with a single element. In a request, the AVAILABILITY
-	//    attribute takes "AVAILABLE", "HIGHLY_AVAILABLE", "BUSY", or "TEMPORARY_NOT_AVAILABLE"
-	//    as values.
-	Filters []*DeviceFilter `locationName:"filters" type:"list"`
-
 	// An identifier that was returned from the previous call to this operation,
 	// which can be used to return the next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// String returns the string representation
-func (s ListDevicesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListOfferingTransactionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListDevicesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListOfferingTransactionsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListDevicesInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListDevicesInput"}
-	if s.Arn != nil && len(*s.Arn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
-	}
+func (s *ListOfferingTransactionsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListOfferingTransactionsInput"}
 	if s.NextToken != nil && len(*s.NextToken) < 4 {
 		invalidParams.Add(request.NewErrParamMinLen("NextToken", 4))
 	}
@@ -11366,84 +14617,85 @@ func (s *ListDevicesInput) Validate() error {
 	return nil
 }
 
-// SetArn sets the Arn field's value.
-func (s *ListDevicesInput) SetArn(v string) *ListDevicesInput {
-	s.Arn = &v
-	return s
-}
-
-// SetFilters sets the Filters field's value.
-func (s *ListDevicesInput) SetFilters(v []*DeviceFilter) *ListDevicesInput {
-	s.Filters = v
-	return s
-}
-
 // SetNextToken sets the NextToken field's value.
-func (s *ListDevicesInput) SetNextToken(v string) *ListDevicesInput {
+func (s *ListOfferingTransactionsInput) SetNextToken(v string) *ListOfferingTransactionsInput {
 	s.NextToken = &v
 	return s
 }
 
-// Represents the result of a list devices operation.
-type ListDevicesOutput struct {
+// Returns the transaction log of the specified offerings.
+type ListOfferingTransactionsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Information about the devices.
-	Devices []*Device `locationName:"devices" type:"list"`
-
-	// If the number of items that are returned is significantly large, this is
-	// an identifier that is also returned, which can be used in a subsequent call
-	// to this operation to return the next set of items in the list.
+	// An identifier that was returned from the previous call to this operation,
+	// which can be used to return the next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
+
+	// The audit log of subscriptions you have purchased and modified through AWS
+	// Device Farm.
+	OfferingTransactions []*OfferingTransaction `locationName:"offeringTransactions" type:"list"`
 }
 
-// String returns the string representation
-func (s ListDevicesOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListOfferingTransactionsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListDevicesOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListOfferingTransactionsOutput) GoString() string {
 	return s.String()
 }
 
-// SetDevices sets the Devices field's value.
-func (s *ListDevicesOutput) SetDevices(v []*Device) *ListDevicesOutput {
-	s.Devices = v
+// SetNextToken sets the NextToken field's value.
+func (s *ListOfferingTransactionsOutput) SetNextToken(v string) *ListOfferingTransactionsOutput {
+	s.NextToken = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListDevicesOutput) SetNextToken(v string) *ListDevicesOutput {
-	s.NextToken = &v
+// SetOfferingTransactions sets the OfferingTransactions field's value.
+func (s *ListOfferingTransactionsOutput) SetOfferingTransactions(v []*OfferingTransaction) *ListOfferingTransactionsOutput {
+	s.OfferingTransactions = v
 	return s
 }
 
-type ListInstanceProfilesInput struct {
+// Represents the request to list all offerings.
+type ListOfferingsInput struct {
 	_ struct{} `type:"structure"`
 
-	// An integer specifying the maximum number of items you want to return in the
-	// API response.
-	MaxResults *int64 `locationName:"maxResults" type:"integer"`
-
 	// An identifier that was returned from the previous call to this operation,
 	// which can be used to return the next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// String returns the string representation
-func (s ListInstanceProfilesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListOfferingsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListInstanceProfilesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListOfferingsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListInstanceProfilesInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListInstanceProfilesInput"}
+func (s *ListOfferingsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListOfferingsInput"}
 	if s.NextToken != nil && len(*s.NextToken) < 4 {
 		invalidParams.Add(request.NewErrParamMinLen("NextToken", 4))
 	}
@@ -11454,81 +14706,89 @@ func (s *ListInstanceProfilesInput) Validate() error {
 	return nil
 }
 
-// SetMaxResults sets the MaxResults field's value.
-func (s *ListInstanceProfilesInput) SetMaxResults(v int64) *ListInstanceProfilesInput {
-	s.MaxResults = &v
-	return s
-}
-
 // SetNextToken sets the NextToken field's value.
-func (s *ListInstanceProfilesInput) SetNextToken(v string) *ListInstanceProfilesInput {
+func (s *ListOfferingsInput) SetNextToken(v string) *ListOfferingsInput {
 	s.NextToken = &v
 	return s
 }
 
-type ListInstanceProfilesOutput struct {
+// Represents the return values of the list of offerings.
+type ListOfferingsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// An object containing information about your instance profiles.
-	InstanceProfiles []*InstanceProfile `locationName:"instanceProfiles" type:"list"`
-
-	// An identifier that can be used in the next call to this operation to return
-	// the next set of items in the list.
+	// An identifier that was returned from the previous call to this operation,
+	// which can be used to return the next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
+
+	// A value that represents the list offering results.
+	Offerings []*Offering `locationName:"offerings" type:"list"`
 }
 
-// String returns the string representation
-func (s ListInstanceProfilesOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListOfferingsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListInstanceProfilesOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListOfferingsOutput) GoString() string {
 	return s.String()
 }
 
-// SetInstanceProfiles sets the InstanceProfiles field's value.
-func (s *ListInstanceProfilesOutput) SetInstanceProfiles(v []*InstanceProfile) *ListInstanceProfilesOutput {
-	s.InstanceProfiles = v
+// SetNextToken sets the NextToken field's value.
+func (s *ListOfferingsOutput) SetNextToken(v string) *ListOfferingsOutput {
+	s.NextToken = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListInstanceProfilesOutput) SetNextToken(v string) *ListInstanceProfilesOutput {
-	s.NextToken = &v
+// SetOfferings sets the Offerings field's value.
+func (s *ListOfferingsOutput) SetOfferings(v []*Offering) *ListOfferingsOutput {
+	s.Offerings = v
 	return s
 }
 
-// Represents a request to the list jobs operation.
-type ListJobsInput struct {
+// Represents a request to the list projects operation.
+type ListProjectsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The run's Amazon Resource Name (ARN).
-	//
-	// Arn is a required field
-	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+	// Optional. If no Amazon Resource Name (ARN) is specified, then AWS Device
+	// Farm returns a list of all projects for the AWS account. You can also specify
+	// a project ARN.
+	Arn *string `locationName:"arn" min:"32" type:"string"`
 
 	// An identifier that was returned from the previous call to this operation,
 	// which can be used to return the next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// String returns the string representation
-func (s ListJobsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListProjectsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListJobsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListProjectsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListJobsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListJobsInput"}
-	if s.Arn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Arn"))
-	}
+func (s *ListProjectsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListProjectsInput"}
 	if s.Arn != nil && len(*s.Arn) < 32 {
 		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
 	}
@@ -11543,57 +14803,66 @@ func (s *ListJobsInput) Validate() error {
 }
 
 // SetArn sets the Arn field's value.
-func (s *ListJobsInput) SetArn(v string) *ListJobsInput {
+func (s *ListProjectsInput) SetArn(v string) *ListProjectsInput {
 	s.Arn = &v
 	return s
 }
 
 // SetNextToken sets the NextToken field's value.
-func (s *ListJobsInput) SetNextToken(v string) *ListJobsInput {
+func (s *ListProjectsInput) SetNextToken(v string) *ListProjectsInput {
 	s.NextToken = &v
 	return s
 }
 
-// Represents the result of a list jobs request.
-type ListJobsOutput struct {
+// Represents the result of a list projects request.
+type ListProjectsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Information about the jobs.
-	Jobs []*Job `locationName:"jobs" type:"list"`
-
 	// If the number of items that are returned is significantly large, this is
-	// an identifier that is also returned, which can be used in a subsequent call
+	// an identifier that is also returned. It can be used in a subsequent call
 	// to this operation to return the next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
+
+	// Information about the projects.
+	Projects []*Project `locationName:"projects" type:"list"`
 }
 
-// String returns the string representation
-func (s ListJobsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListProjectsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListJobsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListProjectsOutput) GoString() string {
 	return s.String()
 }
 
-// SetJobs sets the Jobs field's value.
-func (s *ListJobsOutput) SetJobs(v []*Job) *ListJobsOutput {
-	s.Jobs = v
+// SetNextToken sets the NextToken field's value.
+func (s *ListProjectsOutput) SetNextToken(v string) *ListProjectsOutput {
+	s.NextToken = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListJobsOutput) SetNextToken(v string) *ListJobsOutput {
-	s.NextToken = &v
+// SetProjects sets the Projects field's value.
+func (s *ListProjectsOutput) SetProjects(v []*Project) *ListProjectsOutput {
+	s.Projects = v
 	return s
 }
 
-type ListNetworkProfilesInput struct {
+// Represents the request to return information about the remote access session.
+type ListRemoteAccessSessionsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the project for which you want to list
-	// network profiles.
+	// The Amazon Resource Name (ARN) of the project about which you are requesting
+	// information.
 	//
 	// Arn is a required field
 	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
@@ -11601,25 +14870,29 @@ type ListNetworkProfilesInput struct {
 	// An identifier that was returned from the previous call to this operation,
 	// which can be used to return the next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
-
-	// The type of network profile you wish to return information about. Valid values
-	// are listed below.
-	Type *string `locationName:"type" type:"string" enum:"NetworkProfileType"`
 }
 
-// String returns the string representation
-func (s ListNetworkProfilesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListRemoteAccessSessionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListNetworkProfilesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListRemoteAccessSessionsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListNetworkProfilesInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListNetworkProfilesInput"}
+func (s *ListRemoteAccessSessionsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListRemoteAccessSessionsInput"}
 	if s.Arn == nil {
 		invalidParams.Add(request.NewErrParamRequired("Arn"))
 	}
@@ -11637,77 +14910,103 @@ func (s *ListNetworkProfilesInput) Validate() error {
 }
 
 // SetArn sets the Arn field's value.
-func (s *ListNetworkProfilesInput) SetArn(v string) *ListNetworkProfilesInput {
+func (s *ListRemoteAccessSessionsInput) SetArn(v string) *ListRemoteAccessSessionsInput {
 	s.Arn = &v
 	return s
 }
 
 // SetNextToken sets the NextToken field's value.
-func (s *ListNetworkProfilesInput) SetNextToken(v string) *ListNetworkProfilesInput {
+func (s *ListRemoteAccessSessionsInput) SetNextToken(v string) *ListRemoteAccessSessionsInput {
 	s.NextToken = &v
 	return s
 }
 
-// SetType sets the Type field's value.
-func (s *ListNetworkProfilesInput) SetType(v string) *ListNetworkProfilesInput {
-	s.Type = &v
-	return s
-}
-
-type ListNetworkProfilesOutput struct {
+// Represents the response from the server after AWS Device Farm makes a request
+// to return information about the remote access session.
+type ListRemoteAccessSessionsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// A list of the available network profiles.
-	NetworkProfiles []*NetworkProfile `locationName:"networkProfiles" type:"list"`
-
 	// An identifier that was returned from the previous call to this operation,
 	// which can be used to return the next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
+
+	// A container that represents the metadata from the service about each remote
+	// access session you are requesting.
+	RemoteAccessSessions []*RemoteAccessSession `locationName:"remoteAccessSessions" type:"list"`
 }
 
-// String returns the string representation
-func (s ListNetworkProfilesOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListRemoteAccessSessionsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListNetworkProfilesOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListRemoteAccessSessionsOutput) GoString() string {
 	return s.String()
 }
 
-// SetNetworkProfiles sets the NetworkProfiles field's value.
-func (s *ListNetworkProfilesOutput) SetNetworkProfiles(v []*NetworkProfile) *ListNetworkProfilesOutput {
-	s.NetworkProfiles = v
+// SetNextToken sets the NextToken field's value.
+func (s *ListRemoteAccessSessionsOutput) SetNextToken(v string) *ListRemoteAccessSessionsOutput {
+	s.NextToken = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListNetworkProfilesOutput) SetNextToken(v string) *ListNetworkProfilesOutput {
-	s.NextToken = &v
+// SetRemoteAccessSessions sets the RemoteAccessSessions field's value.
+func (s *ListRemoteAccessSessionsOutput) SetRemoteAccessSessions(v []*RemoteAccessSession) *ListRemoteAccessSessionsOutput {
+	s.RemoteAccessSessions = v
 	return s
 }
 
-type ListOfferingPromotionsInput struct {
+// Represents a request to the list runs operation.
+type ListRunsInput struct {
 	_ struct{} `type:"structure"`
 
+	// The Amazon Resource Name (ARN) of the project for which you want to list
+	// runs.
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+
 	// An identifier that was returned from the previous call to this operation,
 	// which can be used to return the next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// String returns the string representation
-func (s ListOfferingPromotionsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListRunsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListOfferingPromotionsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListRunsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListOfferingPromotionsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListOfferingPromotionsInput"}
+func (s *ListRunsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListRunsInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
+	}
+	if s.Arn != nil && len(*s.Arn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+	}
 	if s.NextToken != nil && len(*s.NextToken) < 4 {
 		invalidParams.Add(request.NewErrParamMinLen("NextToken", 4))
 	}
@@ -11718,67 +15017,102 @@ func (s *ListOfferingPromotionsInput) Validate() error {
 	return nil
 }
 
+// SetArn sets the Arn field's value.
+func (s *ListRunsInput) SetArn(v string) *ListRunsInput {
+	s.Arn = &v
+	return s
+}
+
 // SetNextToken sets the NextToken field's value.
-func (s *ListOfferingPromotionsInput) SetNextToken(v string) *ListOfferingPromotionsInput {
+func (s *ListRunsInput) SetNextToken(v string) *ListRunsInput {
 	s.NextToken = &v
 	return s
 }
 
-type ListOfferingPromotionsOutput struct {
+// Represents the result of a list runs request.
+type ListRunsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// An identifier to be used in the next call to this operation, to return the
-	// next set of items in the list.
+	// If the number of items that are returned is significantly large, this is
+	// an identifier that is also returned. It can be used in a subsequent call
+	// to this operation to return the next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 
-	// Information about the offering promotions.
-	OfferingPromotions []*OfferingPromotion `locationName:"offeringPromotions" type:"list"`
+	// Information about the runs.
+	Runs []*Run `locationName:"runs" type:"list"`
 }
 
-// String returns the string representation
-func (s ListOfferingPromotionsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListRunsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListOfferingPromotionsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListRunsOutput) GoString() string {
 	return s.String()
 }
 
 // SetNextToken sets the NextToken field's value.
-func (s *ListOfferingPromotionsOutput) SetNextToken(v string) *ListOfferingPromotionsOutput {
+func (s *ListRunsOutput) SetNextToken(v string) *ListRunsOutput {
 	s.NextToken = &v
 	return s
 }
 
-// SetOfferingPromotions sets the OfferingPromotions field's value.
-func (s *ListOfferingPromotionsOutput) SetOfferingPromotions(v []*OfferingPromotion) *ListOfferingPromotionsOutput {
-	s.OfferingPromotions = v
+// SetRuns sets the Runs field's value.
+func (s *ListRunsOutput) SetRuns(v []*Run) *ListRunsOutput {
+	s.Runs = v
 	return s
 }
 
-// Represents the request to list the offering transaction history.
-type ListOfferingTransactionsInput struct {
+// Represents a request to the list samples operation.
+type ListSamplesInput struct {
 	_ struct{} `type:"structure"`
 
+	// The Amazon Resource Name (ARN) of the job used to list samples.
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+
 	// An identifier that was returned from the previous call to this operation,
 	// which can be used to return the next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// String returns the string representation
-func (s ListOfferingTransactionsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSamplesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListOfferingTransactionsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSamplesInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListOfferingTransactionsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListOfferingTransactionsInput"}
+func (s *ListSamplesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListSamplesInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
+	}
+	if s.Arn != nil && len(*s.Arn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+	}
 	if s.NextToken != nil && len(*s.NextToken) < 4 {
 		invalidParams.Add(request.NewErrParamMinLen("NextToken", 4))
 	}
@@ -11789,69 +15123,102 @@ func (s *ListOfferingTransactionsInput) Validate() error {
 	return nil
 }
 
+// SetArn sets the Arn field's value.
+func (s *ListSamplesInput) SetArn(v string) *ListSamplesInput {
+	s.Arn = &v
+	return s
+}
+
 // SetNextToken sets the NextToken field's value.
-func (s *ListOfferingTransactionsInput) SetNextToken(v string) *ListOfferingTransactionsInput {
+func (s *ListSamplesInput) SetNextToken(v string) *ListSamplesInput {
 	s.NextToken = &v
 	return s
 }
 
-// Returns the transaction log of the specified offerings.
-type ListOfferingTransactionsOutput struct {
+// Represents the result of a list samples request.
+type ListSamplesOutput struct {
 	_ struct{} `type:"structure"`
 
-	// An identifier that was returned from the previous call to this operation,
-	// which can be used to return the next set of items in the list.
+	// If the number of items that are returned is significantly large, this is
+	// an identifier that is also returned. It can be used in a subsequent call
+	// to this operation to return the next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 
-	// The audit log of subscriptions you have purchased and modified through AWS
-	// Device Farm.
-	OfferingTransactions []*OfferingTransaction `locationName:"offeringTransactions" type:"list"`
+	// Information about the samples.
+	Samples []*Sample `locationName:"samples" type:"list"`
 }
 
-// String returns the string representation
-func (s ListOfferingTransactionsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSamplesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListOfferingTransactionsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSamplesOutput) GoString() string {
 	return s.String()
 }
 
 // SetNextToken sets the NextToken field's value.
-func (s *ListOfferingTransactionsOutput) SetNextToken(v string) *ListOfferingTransactionsOutput {
+func (s *ListSamplesOutput) SetNextToken(v string) *ListSamplesOutput {
 	s.NextToken = &v
 	return s
 }
 
-// SetOfferingTransactions sets the OfferingTransactions field's value.
-func (s *ListOfferingTransactionsOutput) SetOfferingTransactions(v []*OfferingTransaction) *ListOfferingTransactionsOutput {
-	s.OfferingTransactions = v
+// SetSamples sets the Samples field's value.
+func (s *ListSamplesOutput) SetSamples(v []*Sample) *ListSamplesOutput {
+	s.Samples = v
 	return s
 }
 
-// Represents the request to list all offerings.
-type ListOfferingsInput struct {
+// Represents a request to the list suites operation.
+type ListSuitesInput struct {
 	_ struct{} `type:"structure"`
 
+	// The job's Amazon Resource Name (ARN).
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+
 	// An identifier that was returned from the previous call to this operation,
 	// which can be used to return the next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// String returns the string representation
-func (s ListOfferingsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSuitesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListOfferingsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSuitesInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListOfferingsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListOfferingsInput"}
+func (s *ListSuitesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListSuitesInput"}
+	if s.Arn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Arn"))
+	}
+	if s.Arn != nil && len(*s.Arn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+	}
 	if s.NextToken != nil && len(*s.NextToken) < 4 {
 		invalidParams.Add(request.NewErrParamMinLen("NextToken", 4))
 	}
@@ -11862,78 +15229,99 @@ func (s *ListOfferingsInput) Validate() error {
 	return nil
 }
 
+// SetArn sets the Arn field's value.
+func (s *ListSuitesInput) SetArn(v string) *ListSuitesInput {
+	s.Arn = &v
+	return s
+}
+
 // SetNextToken sets the NextToken field's value.
-func (s *ListOfferingsInput) SetNextToken(v string) *ListOfferingsInput {
+func (s *ListSuitesInput) SetNextToken(v string) *ListSuitesInput {
 	s.NextToken = &v
 	return s
 }
 
-// Represents the return values of the list of offerings.
-type ListOfferingsOutput struct {
+// Represents the result of a list suites request.
+type ListSuitesOutput struct {
 	_ struct{} `type:"structure"`
 
-	// An identifier that was returned from the previous call to this operation,
-	// which can be used to return the next set of items in the list.
+	// If the number of items that are returned is significantly large, this is
+	// an identifier that is also returned. It can be used in a subsequent call
+	// to this operation to return the next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 
-	// A value representing the list offering results.
-	Offerings []*Offering `locationName:"offerings" type:"list"`
+	// Information about the suites.
+	Suites []*Suite `locationName:"suites" type:"list"`
 }
 
-// String returns the string representation
-func (s ListOfferingsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSuitesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListOfferingsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSuitesOutput) GoString() string {
 	return s.String()
 }
 
 // SetNextToken sets the NextToken field's value.
-func (s *ListOfferingsOutput) SetNextToken(v string) *ListOfferingsOutput {
+func (s *ListSuitesOutput) SetNextToken(v string) *ListSuitesOutput {
 	s.NextToken = &v
 	return s
 }
 
-// SetOfferings sets the Offerings field's value.
-func (s *ListOfferingsOutput) SetOfferings(v []*Offering) *ListOfferingsOutput {
-	s.Offerings = v
+// SetSuites sets the Suites field's value.
+func (s *ListSuitesOutput) SetSuites(v []*Suite) *ListSuitesOutput {
+	s.Suites = v
 	return s
 }
 
-// Represents a request to the list projects operation.
-type ListProjectsInput struct {
+type ListTagsForResourceInput struct {
 	_ struct{} `type:"structure"`
 
-	// Optional. If no Amazon Resource Name (ARN) is specified, then AWS Device
-	// Farm returns a list of all projects for the AWS account. You can also specify
-	// a project ARN.
-	Arn *string `locationName:"arn" min:"32" type:"string"`
-
-	// An identifier that was returned from the previous call to this operation,
-	// which can be used to return the next set of items in the list.
-	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
+	// The Amazon Resource Name (ARN) of the resource or resources for which to
+	// list tags. You can associate tags with the following Device Farm resources:
+	// PROJECT, RUN, NETWORK_PROFILE, INSTANCE_PROFILE, DEVICE_INSTANCE, SESSION,
+	// DEVICE_POOL, DEVICE, and VPCE_CONFIGURATION.
+	//
+	// ResourceARN is a required field
+	ResourceARN *string `min:"32" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s ListProjectsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListProjectsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListProjectsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListProjectsInput"}
-	if s.Arn != nil && len(*s.Arn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+func (s *ListTagsForResourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsForResourceInput"}
+	if s.ResourceARN == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceARN"))
 	}
-	if s.NextToken != nil && len(*s.NextToken) < 4 {
-		invalidParams.Add(request.NewErrParamMinLen("NextToken", 4))
+	if s.ResourceARN != nil && len(*s.ResourceARN) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("ResourceARN", 32))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -11942,86 +15330,78 @@ func (s *ListProjectsInput) Validate() error {
 	return nil
 }
 
-// SetArn sets the Arn field's value.
-func (s *ListProjectsInput) SetArn(v string) *ListProjectsInput {
-	s.Arn = &v
-	return s
-}
-
-// SetNextToken sets the NextToken field's value.
-func (s *ListProjectsInput) SetNextToken(v string) *ListProjectsInput {
-	s.NextToken = &v
+// SetResourceARN sets the ResourceARN field's value.
+func (s *ListTagsForResourceInput) SetResourceARN(v string) *ListTagsForResourceInput {
+	s.ResourceARN = &v
 	return s
 }
 
-// Represents the result of a list projects request.
-type ListProjectsOutput struct {
+type ListTagsForResourceOutput struct {
 	_ struct{} `type:"structure"`
 
-	// If the number of items that are returned is significantly large, this is
-	// an identifier that is also returned, which can be used in a subsequent call
-	// to this operation to return the next set of items in the list.
-	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
-
-	// Information about the projects.
-	Projects []*Project `locationName:"projects" type:"list"`
+	// The tags to add to the resource. A tag is an array of key-value pairs. Tag
+	// keys can have a maximum character length of 128 characters. Tag values can
+	// have a maximum length of 256 characters.
+	Tags []*Tag `type:"list"`
 }
 
-// String returns the string representation
-func (s ListProjectsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListProjectsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceOutput) GoString() string {
 	return s.String()
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListProjectsOutput) SetNextToken(v string) *ListProjectsOutput {
-	s.NextToken = &v
-	return s
-}
-
-// SetProjects sets the Projects field's value.
-func (s *ListProjectsOutput) SetProjects(v []*Project) *ListProjectsOutput {
-	s.Projects = v
+// SetTags sets the Tags field's value.
+func (s *ListTagsForResourceOutput) SetTags(v []*Tag) *ListTagsForResourceOutput {
+	s.Tags = v
 	return s
 }
 
-// Represents the request to return information about the remote access session.
-type ListRemoteAccessSessionsInput struct {
+type ListTestGridProjectsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the remote access session about which you
-	// are requesting information.
-	//
-	// Arn is a required field
-	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+	// Return no more than this number of results.
+	MaxResult *int64 `locationName:"maxResult" min:"1" type:"integer"`
 
-	// An identifier that was returned from the previous call to this operation,
-	// which can be used to return the next set of items in the list.
+	// From a response, used to continue a paginated listing.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// String returns the string representation
-func (s ListRemoteAccessSessionsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTestGridProjectsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListRemoteAccessSessionsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTestGridProjectsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListRemoteAccessSessionsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListRemoteAccessSessionsInput"}
-	if s.Arn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Arn"))
-	}
-	if s.Arn != nil && len(*s.Arn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+func (s *ListTestGridProjectsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTestGridProjectsInput"}
+	if s.MaxResult != nil && *s.MaxResult < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResult", 1))
 	}
 	if s.NextToken != nil && len(*s.NextToken) < 4 {
 		invalidParams.Add(request.NewErrParamMinLen("NextToken", 4))
@@ -12033,91 +15413,107 @@ func (s *ListRemoteAccessSessionsInput) Validate() error {
 	return nil
 }
 
-// SetArn sets the Arn field's value.
-func (s *ListRemoteAccessSessionsInput) SetArn(v string) *ListRemoteAccessSessionsInput {
-	s.Arn = &v
+// SetMaxResult sets the MaxResult field's value.
+func (s *ListTestGridProjectsInput) SetMaxResult(v int64) *ListTestGridProjectsInput {
+	s.MaxResult = &v
 	return s
 }
 
 // SetNextToken sets the NextToken field's value.
-func (s *ListRemoteAccessSessionsInput) SetNextToken(v string) *ListRemoteAccessSessionsInput {
+func (s *ListTestGridProjectsInput) SetNextToken(v string) *ListTestGridProjectsInput {
 	s.NextToken = &v
 	return s
 }
 
-// Represents the response from the server after AWS Device Farm makes a request
-// to return information about the remote access session.
-type ListRemoteAccessSessionsOutput struct {
+type ListTestGridProjectsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// An identifier that was returned from the previous call to this operation,
-	// which can be used to return the next set of items in the list.
+	// Used for pagination. Pass into ListTestGridProjects to get more results in
+	// a paginated request.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 
-	// A container representing the metadata from the service about each remote
-	// access session you are requesting.
-	RemoteAccessSessions []*RemoteAccessSession `locationName:"remoteAccessSessions" type:"list"`
+	// The list of TestGridProjects, based on a ListTestGridProjectsRequest.
+	TestGridProjects []*TestGridProject `locationName:"testGridProjects" type:"list"`
 }
 
-// String returns the string representation
-func (s ListRemoteAccessSessionsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTestGridProjectsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListRemoteAccessSessionsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTestGridProjectsOutput) GoString() string {
 	return s.String()
 }
 
 // SetNextToken sets the NextToken field's value.
-func (s *ListRemoteAccessSessionsOutput) SetNextToken(v string) *ListRemoteAccessSessionsOutput {
+func (s *ListTestGridProjectsOutput) SetNextToken(v string) *ListTestGridProjectsOutput {
 	s.NextToken = &v
 	return s
 }
 
-// SetRemoteAccessSessions sets the RemoteAccessSessions field's value.
-func (s *ListRemoteAccessSessionsOutput) SetRemoteAccessSessions(v []*RemoteAccessSession) *ListRemoteAccessSessionsOutput {
-	s.RemoteAccessSessions = v
+// SetTestGridProjects sets the TestGridProjects field's value.
+func (s *ListTestGridProjectsOutput) SetTestGridProjects(v []*TestGridProject) *ListTestGridProjectsOutput {
+	s.TestGridProjects = v
 	return s
 }
 
-// Represents a request to the list runs operation.
-type ListRunsInput struct {
+type ListTestGridSessionActionsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the project for which you want to list
-	// runs.
-	//
-	// Arn is a required field
-	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+	// The maximum number of sessions to return per response.
+	MaxResult *int64 `locationName:"maxResult" min:"1" type:"integer"`
 
-	// An identifier that was returned from the previous call to this operation,
-	// which can be used to return the next set of items in the list.
+	// Pagination token.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
+
+	// The ARN of the session to retrieve.
+	//
+	// SessionArn is a required field
+	SessionArn *string `locationName:"sessionArn" min:"32" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s ListRunsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTestGridSessionActionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListRunsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTestGridSessionActionsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListRunsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListRunsInput"}
-	if s.Arn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Arn"))
-	}
-	if s.Arn != nil && len(*s.Arn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+func (s *ListTestGridSessionActionsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTestGridSessionActionsInput"}
+	if s.MaxResult != nil && *s.MaxResult < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResult", 1))
 	}
 	if s.NextToken != nil && len(*s.NextToken) < 4 {
 		invalidParams.Add(request.NewErrParamMinLen("NextToken", 4))
 	}
+	if s.SessionArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("SessionArn"))
+	}
+	if s.SessionArn != nil && len(*s.SessionArn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("SessionArn", 32))
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -12125,89 +15521,115 @@ func (s *ListRunsInput) Validate() error {
 	return nil
 }
 
-// SetArn sets the Arn field's value.
-func (s *ListRunsInput) SetArn(v string) *ListRunsInput {
-	s.Arn = &v
+// SetMaxResult sets the MaxResult field's value.
+func (s *ListTestGridSessionActionsInput) SetMaxResult(v int64) *ListTestGridSessionActionsInput {
+	s.MaxResult = &v
 	return s
 }
 
 // SetNextToken sets the NextToken field's value.
-func (s *ListRunsInput) SetNextToken(v string) *ListRunsInput {
+func (s *ListTestGridSessionActionsInput) SetNextToken(v string) *ListTestGridSessionActionsInput {
 	s.NextToken = &v
 	return s
 }
 
-// Represents the result of a list runs request.
-type ListRunsOutput struct {
+// SetSessionArn sets the SessionArn field's value.
+func (s *ListTestGridSessionActionsInput) SetSessionArn(v string) *ListTestGridSessionActionsInput {
+	s.SessionArn = &v
+	return s
+}
+
+type ListTestGridSessionActionsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// If the number of items that are returned is significantly large, this is
-	// an identifier that is also returned, which can be used in a subsequent call
-	// to this operation to return the next set of items in the list.
-	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
+	// The action taken by the session.
+	Actions []*TestGridSessionAction `locationName:"actions" type:"list"`
 
-	// Information about the runs.
-	Runs []*Run `locationName:"runs" type:"list"`
+	// Pagination token.
+	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// String returns the string representation
-func (s ListRunsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTestGridSessionActionsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListRunsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTestGridSessionActionsOutput) GoString() string {
 	return s.String()
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListRunsOutput) SetNextToken(v string) *ListRunsOutput {
-	s.NextToken = &v
+// SetActions sets the Actions field's value.
+func (s *ListTestGridSessionActionsOutput) SetActions(v []*TestGridSessionAction) *ListTestGridSessionActionsOutput {
+	s.Actions = v
 	return s
 }
 
-// SetRuns sets the Runs field's value.
-func (s *ListRunsOutput) SetRuns(v []*Run) *ListRunsOutput {
-	s.Runs = v
+// SetNextToken sets the NextToken field's value.
+func (s *ListTestGridSessionActionsOutput) SetNextToken(v string) *ListTestGridSessionActionsOutput {
+	s.NextToken = &v
 	return s
 }
 
-// Represents a request to the list samples operation.
-type ListSamplesInput struct {
+type ListTestGridSessionArtifactsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the job used to list samples.
-	//
-	// Arn is a required field
-	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+	// The maximum number of results to be returned by a request.
+	MaxResult *int64 `locationName:"maxResult" min:"1" type:"integer"`
 
-	// An identifier that was returned from the previous call to this operation,
-	// which can be used to return the next set of items in the list.
+	// Pagination token.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
+
+	// The ARN of a TestGridSession.
+	//
+	// SessionArn is a required field
+	SessionArn *string `locationName:"sessionArn" min:"32" type:"string" required:"true"`
+
+	// Limit results to a specified type of artifact.
+	Type *string `locationName:"type" type:"string" enum:"TestGridSessionArtifactCategory"`
 }
 
-// String returns the string representation
-func (s ListSamplesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTestGridSessionArtifactsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListSamplesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTestGridSessionArtifactsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListSamplesInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListSamplesInput"}
-	if s.Arn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Arn"))
-	}
-	if s.Arn != nil && len(*s.Arn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+func (s *ListTestGridSessionArtifactsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTestGridSessionArtifactsInput"}
+	if s.MaxResult != nil && *s.MaxResult < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResult", 1))
 	}
 	if s.NextToken != nil && len(*s.NextToken) < 4 {
 		invalidParams.Add(request.NewErrParamMinLen("NextToken", 4))
 	}
+	if s.SessionArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("SessionArn"))
+	}
+	if s.SessionArn != nil && len(*s.SessionArn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("SessionArn", 32))
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -12215,89 +15637,133 @@ func (s *ListSamplesInput) Validate() error {
 	return nil
 }
 
-// SetArn sets the Arn field's value.
-func (s *ListSamplesInput) SetArn(v string) *ListSamplesInput {
-	s.Arn = &v
+// SetMaxResult sets the MaxResult field's value.
+func (s *ListTestGridSessionArtifactsInput) SetMaxResult(v int64) *ListTestGridSessionArtifactsInput {
+	s.MaxResult = &v
 	return s
 }
 
 // SetNextToken sets the NextToken field's value.
-func (s *ListSamplesInput) SetNextToken(v string) *ListSamplesInput {
+func (s *ListTestGridSessionArtifactsInput) SetNextToken(v string) *ListTestGridSessionArtifactsInput {
 	s.NextToken = &v
 	return s
 }
 
-// Represents the result of a list samples request.
-type ListSamplesOutput struct {
+// SetSessionArn sets the SessionArn field's value.
+func (s *ListTestGridSessionArtifactsInput) SetSessionArn(v string) *ListTestGridSessionArtifactsInput {
+	s.SessionArn = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *ListTestGridSessionArtifactsInput) SetType(v string) *ListTestGridSessionArtifactsInput {
+	s.Type = &v
+	return s
+}
+
+type ListTestGridSessionArtifactsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// If the number of items that are returned is significantly large, this is
-	// an identifier that is also returned, which can be used in a subsequent call
-	// to this operation to return the next set of items in the list.
-	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
+	// A list of test grid session artifacts for a TestGridSession.
+	Artifacts []*TestGridSessionArtifact `locationName:"artifacts" type:"list"`
 
-	// Information about the samples.
-	Samples []*Sample `locationName:"samples" type:"list"`
+	// Pagination token.
+	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// String returns the string representation
-func (s ListSamplesOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTestGridSessionArtifactsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListSamplesOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTestGridSessionArtifactsOutput) GoString() string {
 	return s.String()
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListSamplesOutput) SetNextToken(v string) *ListSamplesOutput {
-	s.NextToken = &v
+// SetArtifacts sets the Artifacts field's value.
+func (s *ListTestGridSessionArtifactsOutput) SetArtifacts(v []*TestGridSessionArtifact) *ListTestGridSessionArtifactsOutput {
+	s.Artifacts = v
 	return s
 }
 
-// SetSamples sets the Samples field's value.
-func (s *ListSamplesOutput) SetSamples(v []*Sample) *ListSamplesOutput {
-	s.Samples = v
+// SetNextToken sets the NextToken field's value.
+func (s *ListTestGridSessionArtifactsOutput) SetNextToken(v string) *ListTestGridSessionArtifactsOutput {
+	s.NextToken = &v
 	return s
 }
 
-// Represents a request to the list suites operation.
-type ListSuitesInput struct {
+type ListTestGridSessionsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The job's Amazon Resource Name (ARN).
-	//
-	// Arn is a required field
-	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
+	// Return only sessions created after this time.
+	CreationTimeAfter *time.Time `locationName:"creationTimeAfter" type:"timestamp"`
 
-	// An identifier that was returned from the previous call to this operation,
-	// which can be used to return the next set of items in the list.
+	// Return only sessions created before this time.
+	CreationTimeBefore *time.Time `locationName:"creationTimeBefore" type:"timestamp"`
+
+	// Return only sessions that ended after this time.
+	EndTimeAfter *time.Time `locationName:"endTimeAfter" type:"timestamp"`
+
+	// Return only sessions that ended before this time.
+	EndTimeBefore *time.Time `locationName:"endTimeBefore" type:"timestamp"`
+
+	// Return only this many results at a time.
+	MaxResult *int64 `locationName:"maxResult" min:"1" type:"integer"`
+
+	// Pagination token.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
+
+	// ARN of a TestGridProject.
+	//
+	// ProjectArn is a required field
+	ProjectArn *string `locationName:"projectArn" min:"32" type:"string" required:"true"`
+
+	// Return only sessions in this state.
+	Status *string `locationName:"status" type:"string" enum:"TestGridSessionStatus"`
 }
 
-// String returns the string representation
-func (s ListSuitesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTestGridSessionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListSuitesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTestGridSessionsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListSuitesInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListSuitesInput"}
-	if s.Arn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Arn"))
-	}
-	if s.Arn != nil && len(*s.Arn) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
+func (s *ListTestGridSessionsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTestGridSessionsInput"}
+	if s.MaxResult != nil && *s.MaxResult < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResult", 1))
 	}
 	if s.NextToken != nil && len(*s.NextToken) < 4 {
 		invalidParams.Add(request.NewErrParamMinLen("NextToken", 4))
 	}
+	if s.ProjectArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ProjectArn"))
+	}
+	if s.ProjectArn != nil && len(*s.ProjectArn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("ProjectArn", 32))
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -12305,119 +15771,91 @@ func (s *ListSuitesInput) Validate() error {
 	return nil
 }
 
-// SetArn sets the Arn field's value.
-func (s *ListSuitesInput) SetArn(v string) *ListSuitesInput {
-	s.Arn = &v
+// SetCreationTimeAfter sets the CreationTimeAfter field's value.
+func (s *ListTestGridSessionsInput) SetCreationTimeAfter(v time.Time) *ListTestGridSessionsInput {
+	s.CreationTimeAfter = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *ListSuitesInput) SetNextToken(v string) *ListSuitesInput {
-	s.NextToken = &v
+// SetCreationTimeBefore sets the CreationTimeBefore field's value.
+func (s *ListTestGridSessionsInput) SetCreationTimeBefore(v time.Time) *ListTestGridSessionsInput {
+	s.CreationTimeBefore = &v
 	return s
 }
 
-// Represents the result of a list suites request.
-type ListSuitesOutput struct {
-	_ struct{} `type:"structure"`
-
-	// If the number of items that are returned is significantly large, this is
-	// an identifier that is also returned, which can be used in a subsequent call
-	// to this operation to return the next set of items in the list.
-	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
-
-	// Information about the suites.
-	Suites []*Suite `locationName:"suites" type:"list"`
+// SetEndTimeAfter sets the EndTimeAfter field's value.
+func (s *ListTestGridSessionsInput) SetEndTimeAfter(v time.Time) *ListTestGridSessionsInput {
+	s.EndTimeAfter = &v
+	return s
 }
 
-// String returns the string representation
-func (s ListSuitesOutput) String() string {
-	return awsutil.Prettify(s)
+// SetEndTimeBefore sets the EndTimeBefore field's value.
+func (s *ListTestGridSessionsInput) SetEndTimeBefore(v time.Time) *ListTestGridSessionsInput {
+	s.EndTimeBefore = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s ListSuitesOutput) GoString() string {
-	return s.String()
+// SetMaxResult sets the MaxResult field's value.
+func (s *ListTestGridSessionsInput) SetMaxResult(v int64) *ListTestGridSessionsInput {
+	s.MaxResult = &v
+	return s
 }
 
 // SetNextToken sets the NextToken field's value.
-func (s *ListSuitesOutput) SetNextToken(v string) *ListSuitesOutput {
+func (s *ListTestGridSessionsInput) SetNextToken(v string) *ListTestGridSessionsInput {
 	s.NextToken = &v
 	return s
 }
 
-// SetSuites sets the Suites field's value.
-func (s *ListSuitesOutput) SetSuites(v []*Suite) *ListSuitesOutput {
-	s.Suites = v
+// SetProjectArn sets the ProjectArn field's value.
+func (s *ListTestGridSessionsInput) SetProjectArn(v string) *ListTestGridSessionsInput {
+	s.ProjectArn = &v
 	return s
 }
 
-type ListTagsForResourceInput struct {
-	_ struct{} `type:"structure"`
-
-	// The Amazon Resource Name (ARN) of the resource(s) for which to list tags.
-	// You can associate tags with the following Device Farm resources: PROJECT,
-	// RUN, NETWORK_PROFILE, INSTANCE_PROFILE, DEVICE_INSTANCE, SESSION, DEVICE_POOL,
-	// DEVICE, and VPCE_CONFIGURATION.
-	//
-	// ResourceARN is a required field
-	ResourceARN *string `min:"32" type:"string" required:"true"`
-}
-
-// String returns the string representation
-func (s ListTagsForResourceInput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s ListTagsForResourceInput) GoString() string {
-	return s.String()
-}
-
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *ListTagsForResourceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListTagsForResourceInput"}
-	if s.ResourceARN == nil {
-		invalidParams.Add(request.NewErrParamRequired("ResourceARN"))
-	}
-	if s.ResourceARN != nil && len(*s.ResourceARN) < 32 {
-		invalidParams.Add(request.NewErrParamMinLen("ResourceARN", 32))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
-// SetResourceARN sets the ResourceARN field's value.
-func (s *ListTagsForResourceInput) SetResourceARN(v string) *ListTagsForResourceInput {
-	s.ResourceARN = &v
+// SetStatus sets the Status field's value.
+func (s *ListTestGridSessionsInput) SetStatus(v string) *ListTestGridSessionsInput {
+	s.Status = &v
 	return s
 }
 
-type ListTagsForResourceOutput struct {
+type ListTestGridSessionsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The tags to add to the resource. A tag is an array of key-value pairs. Tag
-	// keys can have a maximum character length of 128 characters, and tag values
-	// can have a maximum length of 256 characters.
-	Tags []*Tag `type:"list"`
+	// Pagination token.
+	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
+
+	// The sessions that match the criteria in a ListTestGridSessionsRequest.
+	TestGridSessions []*TestGridSession `locationName:"testGridSessions" type:"list"`
 }
 
-// String returns the string representation
-func (s ListTagsForResourceOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTestGridSessionsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListTagsForResourceOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTestGridSessionsOutput) GoString() string {
 	return s.String()
 }
 
-// SetTags sets the Tags field's value.
-func (s *ListTagsForResourceOutput) SetTags(v []*Tag) *ListTagsForResourceOutput {
-	s.Tags = v
+// SetNextToken sets the NextToken field's value.
+func (s *ListTestGridSessionsOutput) SetNextToken(v string) *ListTestGridSessionsOutput {
+	s.NextToken = &v
+	return s
+}
+
+// SetTestGridSessions sets the TestGridSessions field's value.
+func (s *ListTestGridSessionsOutput) SetTestGridSessions(v []*TestGridSession) *ListTestGridSessionsOutput {
+	s.TestGridSessions = v
 	return s
 }
 
@@ -12435,12 +15873,20 @@ type ListTestsInput struct {
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTestsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTestsInput) GoString() string {
 	return s.String()
 }
@@ -12481,7 +15927,7 @@ type ListTestsOutput struct {
 	_ struct{} `type:"structure"`
 
 	// If the number of items that are returned is significantly large, this is
-	// an identifier that is also returned, which can be used in a subsequent call
+	// an identifier that is also returned. It can be used in a subsequent call
 	// to this operation to return the next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 
@@ -12489,12 +15935,20 @@ type ListTestsOutput struct {
 	Tests []*Test `locationName:"tests" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTestsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTestsOutput) GoString() string {
 	return s.String()
 }
@@ -12525,12 +15979,20 @@ type ListUniqueProblemsInput struct {
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListUniqueProblemsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListUniqueProblemsInput) GoString() string {
 	return s.String()
 }
@@ -12571,7 +16033,7 @@ type ListUniqueProblemsOutput struct {
 	_ struct{} `type:"structure"`
 
 	// If the number of items that are returned is significantly large, this is
-	// an identifier that is also returned, which can be used in a subsequent call
+	// an identifier that is also returned. It can be used in a subsequent call
 	// to this operation to return the next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 
@@ -12579,28 +16041,36 @@ type ListUniqueProblemsOutput struct {
 	//
 	// Allowed values include:
 	//
-	//    * PENDING: A pending condition.
+	//    * PENDING
 	//
-	//    * PASSED: A passing condition.
+	//    * PASSED
 	//
-	//    * WARNED: A warning condition.
+	//    * WARNED
 	//
-	//    * FAILED: A failed condition.
+	//    * FAILED
 	//
-	//    * SKIPPED: A skipped condition.
+	//    * SKIPPED
 	//
-	//    * ERRORED: An error condition.
+	//    * ERRORED
 	//
-	//    * STOPPED: A stopped condition.
+	//    * STOPPED
 	UniqueProblems map[string][]*UniqueProblem `locationName:"uniqueProblems" type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListUniqueProblemsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListUniqueProblemsOutput) GoString() string {
 	return s.String()
 }
@@ -12635,89 +16105,86 @@ type ListUploadsInput struct {
 	//
 	// Must be one of the following values:
 	//
-	//    * ANDROID_APP: An Android upload.
+	//    * ANDROID_APP
 	//
-	//    * IOS_APP: An iOS upload.
+	//    * IOS_APP
 	//
-	//    * WEB_APP: A web application upload.
+	//    * WEB_APP
 	//
-	//    * EXTERNAL_DATA: An external data upload.
+	//    * EXTERNAL_DATA
 	//
-	//    * APPIUM_JAVA_JUNIT_TEST_PACKAGE: An Appium Java JUnit test package upload.
+	//    * APPIUM_JAVA_JUNIT_TEST_PACKAGE
 	//
-	//    * APPIUM_JAVA_TESTNG_TEST_PACKAGE: An Appium Java TestNG test package
-	//    upload.
+	//    * APPIUM_JAVA_TESTNG_TEST_PACKAGE
 	//
-	//    * APPIUM_PYTHON_TEST_PACKAGE: An Appium Python test package upload.
+	//    * APPIUM_PYTHON_TEST_PACKAGE
 	//
-	//    * APPIUM_NODE_TEST_PACKAGE: An Appium Node.js test package upload.
+	//    * APPIUM_NODE_TEST_PACKAGE
 	//
-	//    * APPIUM_RUBY_TEST_PACKAGE: An Appium Ruby test package upload.
+	//    * APPIUM_RUBY_TEST_PACKAGE
 	//
-	//    * APPIUM_WEB_JAVA_JUNIT_TEST_PACKAGE: An Appium Java JUnit test package
-	//    upload for a web app.
+	//    * APPIUM_WEB_JAVA_JUNIT_TEST_PACKAGE
 	//
-	//    * APPIUM_WEB_JAVA_TESTNG_TEST_PACKAGE: An Appium Java TestNG test package
-	//    upload for a web app.
+	//    * APPIUM_WEB_JAVA_TESTNG_TEST_PACKAGE
 	//
-	//    * APPIUM_WEB_PYTHON_TEST_PACKAGE: An Appium Python test package upload
-	//    for a web app.
+	//    * APPIUM_WEB_PYTHON_TEST_PACKAGE
 	//
-	//    * APPIUM_WEB_NODE_TEST_PACKAGE: An Appium Node.js test package upload
-	//    for a web app.
+	//    * APPIUM_WEB_NODE_TEST_PACKAGE
 	//
-	//    * APPIUM_WEB_RUBY_TEST_PACKAGE: An Appium Ruby test package upload for
-	//    a web app.
+	//    * APPIUM_WEB_RUBY_TEST_PACKAGE
 	//
-	//    * CALABASH_TEST_PACKAGE: A Calabash test package upload.
+	//    * CALABASH_TEST_PACKAGE
 	//
-	//    * INSTRUMENTATION_TEST_PACKAGE: An instrumentation upload.
+	//    * INSTRUMENTATION_TEST_PACKAGE
 	//
-	//    * UIAUTOMATION_TEST_PACKAGE: A uiautomation test package upload.
+	//    * UIAUTOMATION_TEST_PACKAGE
 	//
-	//    * UIAUTOMATOR_TEST_PACKAGE: A uiautomator test package upload.
+	//    * UIAUTOMATOR_TEST_PACKAGE
 	//
-	//    * XCTEST_TEST_PACKAGE: An Xcode test package upload.
+	//    * XCTEST_TEST_PACKAGE
 	//
-	//    * XCTEST_UI_TEST_PACKAGE: An Xcode UI test package upload.
+	//    * XCTEST_UI_TEST_PACKAGE
 	//
-	//    * APPIUM_JAVA_JUNIT_TEST_SPEC: An Appium Java JUnit test spec upload.
+	//    * APPIUM_JAVA_JUNIT_TEST_SPEC
 	//
-	//    * APPIUM_JAVA_TESTNG_TEST_SPEC: An Appium Java TestNG test spec upload.
+	//    * APPIUM_JAVA_TESTNG_TEST_SPEC
 	//
-	//    * APPIUM_PYTHON_TEST_SPEC: An Appium Python test spec upload.
+	//    * APPIUM_PYTHON_TEST_SPEC
 	//
-	//    * APPIUM_NODE_TEST_SPEC: An Appium Node.js test spec upload.
+	//    * APPIUM_NODE_TEST_SPEC
 	//
-	//    * APPIUM_RUBY_TEST_SPEC: An Appium Ruby test spec upload.
+	//    * APPIUM_RUBY_TEST_SPEC
 	//
-	//    * APPIUM_WEB_JAVA_JUNIT_TEST_SPEC: An Appium Java JUnit test spec upload
-	//    for a web app.
+	//    * APPIUM_WEB_JAVA_JUNIT_TEST_SPEC
 	//
-	//    * APPIUM_WEB_JAVA_TESTNG_TEST_SPEC: An Appium Java TestNG test spec upload
-	//    for a web app.
+	//    * APPIUM_WEB_JAVA_TESTNG_TEST_SPEC
 	//
-	//    * APPIUM_WEB_PYTHON_TEST_SPEC: An Appium Python test spec upload for a
-	//    web app.
+	//    * APPIUM_WEB_PYTHON_TEST_SPEC
 	//
-	//    * APPIUM_WEB_NODE_TEST_SPEC: An Appium Node.js test spec upload for a
-	//    web app.
+	//    * APPIUM_WEB_NODE_TEST_SPEC
 	//
-	//    * APPIUM_WEB_RUBY_TEST_SPEC: An Appium Ruby test spec upload for a web
-	//    app.
+	//    * APPIUM_WEB_RUBY_TEST_SPEC
 	//
-	//    * INSTRUMENTATION_TEST_SPEC: An instrumentation test spec upload.
+	//    * INSTRUMENTATION_TEST_SPEC
 	//
-	//    * XCTEST_UI_TEST_SPEC: An Xcode UI test spec upload.
+	//    * XCTEST_UI_TEST_SPEC
 	Type *string `locationName:"type" type:"string" enum:"UploadType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListUploadsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListUploadsInput) GoString() string {
 	return s.String()
 }
@@ -12764,7 +16231,7 @@ type ListUploadsOutput struct {
 	_ struct{} `type:"structure"`
 
 	// If the number of items that are returned is significantly large, this is
-	// an identifier that is also returned, which can be used in a subsequent call
+	// an identifier that is also returned. It can be used in a subsequent call
 	// to this operation to return the next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 
@@ -12772,12 +16239,20 @@ type ListUploadsOutput struct {
 	Uploads []*Upload `locationName:"uploads" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListUploadsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListUploadsOutput) GoString() string {
 	return s.String()
 }
@@ -12797,8 +16272,8 @@ func (s *ListUploadsOutput) SetUploads(v []*Upload) *ListUploadsOutput {
 type ListVPCEConfigurationsInput struct {
 	_ struct{} `type:"structure"`
 
-	// An integer specifying the maximum number of items you want to return in the
-	// API response.
+	// An integer that specifies the maximum number of items you want to return
+	// in the API response.
 	MaxResults *int64 `locationName:"maxResults" type:"integer"`
 
 	// An identifier that was returned from the previous call to this operation,
@@ -12806,12 +16281,20 @@ type ListVPCEConfigurationsInput struct {
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListVPCEConfigurationsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListVPCEConfigurationsInput) GoString() string {
 	return s.String()
 }
@@ -12848,17 +16331,25 @@ type ListVPCEConfigurationsOutput struct {
 	// which can be used to return the next set of items in the list.
 	NextToken *string `locationName:"nextToken" min:"4" type:"string"`
 
-	// An array of VPCEConfiguration objects containing information about your VPC
-	// endpoint configuration.
+	// An array of VPCEConfiguration objects that contain information about your
+	// VPC endpoint configuration.
 	VpceConfigurations []*VPCEConfiguration `locationName:"vpceConfigurations" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListVPCEConfigurationsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListVPCEConfigurationsOutput) GoString() string {
 	return s.String()
 }
@@ -12876,7 +16367,7 @@ func (s *ListVPCEConfigurationsOutput) SetVpceConfigurations(v []*VPCEConfigurat
 }
 
 // Represents a latitude and longitude pair, expressed in geographic coordinate
-// system degrees (for example 47.6204, -122.3491).
+// system degrees (for example, 47.6204, -122.3491).
 //
 // Elevation is currently not supported.
 type Location struct {
@@ -12893,12 +16384,20 @@ type Location struct {
 	Longitude *float64 `locationName:"longitude" type:"double" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Location) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Location) GoString() string {
 	return s.String()
 }
@@ -12931,23 +16430,31 @@ func (s *Location) SetLongitude(v float64) *Location {
 	return s
 }
 
-// A number representing the monetary amount for an offering or transaction.
+// A number that represents the monetary amount for an offering or transaction.
 type MonetaryAmount struct {
 	_ struct{} `type:"structure"`
 
 	// The numerical amount of an offering or transaction.
 	Amount *float64 `locationName:"amount" type:"double"`
 
-	// The currency code of a monetary amount. For example, USD means "U.S. dollars."
+	// The currency code of a monetary amount. For example, USD means U.S. dollars.
 	CurrencyCode *string `locationName:"currencyCode" type:"string" enum:"CurrencyCode"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s MonetaryAmount) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s MonetaryAmount) GoString() string {
 	return s.String()
 }
@@ -12991,7 +16498,7 @@ type NetworkProfile struct {
 	// The name of the network profile.
 	Name *string `locationName:"name" type:"string"`
 
-	// The type of network profile. Valid values are listed below.
+	// The type of network profile. Valid values are listed here.
 	Type *string `locationName:"type" type:"string" enum:"NetworkProfileType"`
 
 	// The data throughput rate in bits per second, as an integer from 0 to 104857600.
@@ -13009,12 +16516,20 @@ type NetworkProfile struct {
 	UplinkLossPercent *int64 `locationName:"uplinkLossPercent" type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s NetworkProfile) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s NetworkProfile) GoString() string {
 	return s.String()
 }
@@ -13091,32 +16606,171 @@ func (s *NetworkProfile) SetUplinkLossPercent(v int64) *NetworkProfile {
 	return s
 }
 
+// Exception gets thrown when a user is not eligible to perform the specified
+// transaction.
+type NotEligibleException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The HTTP response code of a Not Eligible exception.
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotEligibleException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotEligibleException) GoString() string {
+	return s.String()
+}
+
+func newErrorNotEligibleException(v protocol.ResponseMetadata) error {
+	return &NotEligibleException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *NotEligibleException) Code() string {
+	return "NotEligibleException"
+}
+
+// Message returns the exception's message.
+func (s *NotEligibleException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *NotEligibleException) OrigErr() error {
+	return nil
+}
+
+func (s *NotEligibleException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *NotEligibleException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *NotEligibleException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The specified entity was not found.
+type NotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// Any additional information about the exception.
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorNotFoundException(v protocol.ResponseMetadata) error {
+	return &NotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *NotFoundException) Code() string {
+	return "NotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *NotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *NotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *NotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *NotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *NotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Represents the metadata of a device offering.
 type Offering struct {
 	_ struct{} `type:"structure"`
 
-	// A string describing the offering.
+	// A string that describes the offering.
 	Description *string `locationName:"description" type:"string"`
 
 	// The ID that corresponds to a device offering.
 	Id *string `locationName:"id" min:"32" type:"string"`
 
-	// The platform of the device (e.g., ANDROID or IOS).
+	// The platform of the device (for example, ANDROID or IOS).
 	Platform *string `locationName:"platform" type:"string" enum:"DevicePlatform"`
 
 	// Specifies whether there are recurring charges for the offering.
 	RecurringCharges []*RecurringCharge `locationName:"recurringCharges" type:"list"`
 
-	// The type of offering (e.g., "RECURRING") for a device.
+	// The type of offering (for example, RECURRING) for a device.
 	Type *string `locationName:"type" type:"string" enum:"OfferingType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Offering) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Offering) GoString() string {
 	return s.String()
 }
@@ -13155,19 +16809,27 @@ func (s *Offering) SetType(v string) *Offering {
 type OfferingPromotion struct {
 	_ struct{} `type:"structure"`
 
-	// A string describing the offering promotion.
+	// A string that describes the offering promotion.
 	Description *string `locationName:"description" type:"string"`
 
 	// The ID of the offering promotion.
 	Id *string `locationName:"id" min:"4" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OfferingPromotion) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OfferingPromotion) GoString() string {
 	return s.String()
 }
@@ -13201,12 +16863,20 @@ type OfferingStatus struct {
 	Type *string `locationName:"type" type:"string" enum:"OfferingTransactionType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OfferingStatus) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OfferingStatus) GoString() string {
 	return s.String()
 }
@@ -13255,12 +16925,20 @@ type OfferingTransaction struct {
 	TransactionId *string `locationName:"transactionId" min:"32" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OfferingTransaction) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OfferingTransaction) GoString() string {
 	return s.String()
 }
@@ -13312,19 +16990,19 @@ type Problem struct {
 	//
 	// Allowed values include:
 	//
-	//    * PENDING: A pending condition.
+	//    * PENDING
 	//
-	//    * PASSED: A passing condition.
+	//    * PASSED
 	//
-	//    * WARNED: A warning condition.
+	//    * WARNED
 	//
-	//    * FAILED: A failed condition.
+	//    * FAILED
 	//
-	//    * SKIPPED: A skipped condition.
+	//    * SKIPPED
 	//
-	//    * ERRORED: An error condition.
+	//    * ERRORED
 	//
-	//    * STOPPED: A stopped condition.
+	//    * STOPPED
 	Result *string `locationName:"result" type:"string" enum:"ExecutionResult"`
 
 	// Information about the associated run.
@@ -13337,12 +17015,20 @@ type Problem struct {
 	Test *ProblemDetail `locationName:"test" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Problem) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Problem) GoString() string {
 	return s.String()
 }
@@ -13400,12 +17086,20 @@ type ProblemDetail struct {
 	Name *string `locationName:"name" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProblemDetail) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProblemDetail) GoString() string {
 	return s.String()
 }
@@ -13433,20 +17127,31 @@ type Project struct {
 	// When the project was created.
 	Created *time.Time `locationName:"created" type:"timestamp"`
 
-	// The default number of minutes (at the project level) a test run will execute
+	// The default number of minutes (at the project level) a test run executes
 	// before it times out. The default value is 150 minutes.
 	DefaultJobTimeoutMinutes *int64 `locationName:"defaultJobTimeoutMinutes" type:"integer"`
 
 	// The project's name.
 	Name *string `locationName:"name" type:"string"`
+
+	// The VPC security groups and subnets that are attached to a project.
+	VpcConfig *VpcConfig `locationName:"vpcConfig" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Project) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Project) GoString() string {
 	return s.String()
 }
@@ -13475,26 +17180,44 @@ func (s *Project) SetName(v string) *Project {
 	return s
 }
 
+// SetVpcConfig sets the VpcConfig field's value.
+func (s *Project) SetVpcConfig(v *VpcConfig) *Project {
+	s.VpcConfig = v
+	return s
+}
+
 // Represents a request for a purchase offering.
 type PurchaseOfferingInput struct {
 	_ struct{} `type:"structure"`
 
 	// The ID of the offering.
-	OfferingId *string `locationName:"offeringId" min:"32" type:"string"`
+	//
+	// OfferingId is a required field
+	OfferingId *string `locationName:"offeringId" min:"32" type:"string" required:"true"`
 
 	// The ID of the offering promotion to be applied to the purchase.
 	OfferingPromotionId *string `locationName:"offeringPromotionId" min:"4" type:"string"`
 
-	// The number of device slots you wish to purchase in an offering request.
-	Quantity *int64 `locationName:"quantity" type:"integer"`
+	// The number of device slots to purchase in an offering request.
+	//
+	// Quantity is a required field
+	Quantity *int64 `locationName:"quantity" type:"integer" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PurchaseOfferingInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PurchaseOfferingInput) GoString() string {
 	return s.String()
 }
@@ -13502,12 +17225,18 @@ func (s PurchaseOfferingInput) GoString() string {
 // Validate inspects the fields of the type to determine if they are valid.
 func (s *PurchaseOfferingInput) Validate() error {
 	invalidParams := request.ErrInvalidParams{Context: "PurchaseOfferingInput"}
+	if s.OfferingId == nil {
+		invalidParams.Add(request.NewErrParamRequired("OfferingId"))
+	}
 	if s.OfferingId != nil && len(*s.OfferingId) < 32 {
 		invalidParams.Add(request.NewErrParamMinLen("OfferingId", 32))
 	}
 	if s.OfferingPromotionId != nil && len(*s.OfferingPromotionId) < 4 {
 		invalidParams.Add(request.NewErrParamMinLen("OfferingPromotionId", 4))
 	}
+	if s.Quantity == nil {
+		invalidParams.Add(request.NewErrParamRequired("Quantity"))
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -13533,7 +17262,7 @@ func (s *PurchaseOfferingInput) SetQuantity(v int64) *PurchaseOfferingInput {
 	return s
 }
 
-// The result of the purchase offering (e.g., success or failure).
+// The result of the purchase offering (for example, success or failure).
 type PurchaseOfferingOutput struct {
 	_ struct{} `type:"structure"`
 
@@ -13541,12 +17270,20 @@ type PurchaseOfferingOutput struct {
 	OfferingTransaction *OfferingTransaction `locationName:"offeringTransaction" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PurchaseOfferingOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PurchaseOfferingOutput) GoString() string {
 	return s.String()
 }
@@ -13562,25 +17299,33 @@ func (s *PurchaseOfferingOutput) SetOfferingTransaction(v *OfferingTransaction)
 type Radios struct {
 	_ struct{} `type:"structure"`
 
-	// True if Bluetooth is enabled at the beginning of the test; otherwise, false.
+	// True if Bluetooth is enabled at the beginning of the test. Otherwise, false.
 	Bluetooth *bool `locationName:"bluetooth" type:"boolean"`
 
-	// True if GPS is enabled at the beginning of the test; otherwise, false.
+	// True if GPS is enabled at the beginning of the test. Otherwise, false.
 	Gps *bool `locationName:"gps" type:"boolean"`
 
-	// True if NFC is enabled at the beginning of the test; otherwise, false.
+	// True if NFC is enabled at the beginning of the test. Otherwise, false.
 	Nfc *bool `locationName:"nfc" type:"boolean"`
 
-	// True if Wi-Fi is enabled at the beginning of the test; otherwise, false.
+	// True if Wi-Fi is enabled at the beginning of the test. Otherwise, false.
 	Wifi *bool `locationName:"wifi" type:"boolean"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Radios) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Radios) GoString() string {
 	return s.String()
 }
@@ -13609,23 +17354,31 @@ func (s *Radios) SetWifi(v bool) *Radios {
 	return s
 }
 
-// Specifies whether charges for devices will be recurring.
+// Specifies whether charges for devices are recurring.
 type RecurringCharge struct {
 	_ struct{} `type:"structure"`
 
 	// The cost of the recurring charge.
 	Cost *MonetaryAmount `locationName:"cost" type:"structure"`
 
-	// The frequency in which charges will recur.
+	// The frequency in which charges recur.
 	Frequency *string `locationName:"frequency" type:"string" enum:"RecurringChargeFrequency"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RecurringCharge) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RecurringCharge) GoString() string {
 	return s.String()
 }
@@ -13651,11 +17404,13 @@ type RemoteAccessSession struct {
 
 	// The billing method of the remote access session. Possible values include
 	// METERED or UNMETERED. For more information about metered devices, see AWS
-	// Device Farm terminology (https://docs.aws.amazon.com/devicefarm/latest/developerguide/welcome.html#welcome-terminology)."
+	// Device Farm terminology (https://docs.aws.amazon.com/devicefarm/latest/developerguide/welcome.html#welcome-terminology).
 	BillingMethod *string `locationName:"billingMethod" type:"string" enum:"BillingMethod"`
 
 	// Unique identifier of your client for the remote access session. Only returned
 	// if remote debugging is enabled for the remote access session.
+	//
+	// Remote debugging is no longer supported (https://docs.aws.amazon.com/devicefarm/latest/developerguide/history.html).
 	ClientId *string `locationName:"clientId" type:"string"`
 
 	// The date and time the remote access session was created.
@@ -13670,6 +17425,8 @@ type RemoteAccessSession struct {
 
 	// Unique device identifier for the remote device. Only returned if remote debugging
 	// is enabled for the remote access session.
+	//
+	// Remote debugging is no longer supported (https://docs.aws.amazon.com/devicefarm/latest/developerguide/history.html).
 	DeviceUdid *string `locationName:"deviceUdid" type:"string"`
 
 	// The endpoint for the remote access sesssion.
@@ -13677,9 +17434,11 @@ type RemoteAccessSession struct {
 
 	// IP address of the EC2 host where you need to connect to remotely debug devices.
 	// Only returned if remote debugging is enabled for the remote access session.
+	//
+	// Remote debugging is no longer supported (https://docs.aws.amazon.com/devicefarm/latest/developerguide/history.html).
 	HostAddress *string `locationName:"hostAddress" type:"string"`
 
-	// The Amazon Resource Name (ARN) of the instance.
+	// The ARN of the instance.
 	InstanceArn *string `locationName:"instanceArn" min:"32" type:"string"`
 
 	// The interaction mode of the remote access session. Valid values are:
@@ -13688,12 +17447,13 @@ type RemoteAccessSession struct {
 	//    and rotating the screen. You cannot run XCUITest framework-based tests
 	//    in this mode.
 	//
-	//    * NO_VIDEO: You are connected to the device but cannot interact with it
-	//    or view the screen. This mode has the fastest test execution speed. You
-	//    can run XCUITest framework-based tests in this mode.
+	//    * NO_VIDEO: You are connected to the device, but cannot interact with
+	//    it or view the screen. This mode has the fastest test execution speed.
+	//    You can run XCUITest framework-based tests in this mode.
 	//
-	//    * VIDEO_ONLY: You can view the screen but cannot touch or rotate it. You
-	//    can run XCUITest framework-based tests and watch the screen in this mode.
+	//    * VIDEO_ONLY: You can view the screen, but cannot touch or rotate it.
+	//    You can run XCUITest framework-based tests and watch the screen in this
+	//    mode.
 	InteractionMode *string `locationName:"interactionMode" type:"string" enum:"InteractionMode"`
 
 	// A message about the remote access session.
@@ -13704,10 +17464,11 @@ type RemoteAccessSession struct {
 
 	// This flag is set to true if remote debugging is enabled for the remote access
 	// session.
+	//
+	// Remote debugging is no longer supported (https://docs.aws.amazon.com/devicefarm/latest/developerguide/history.html).
 	RemoteDebugEnabled *bool `locationName:"remoteDebugEnabled" type:"boolean"`
 
-	// The Amazon Resource Name (ARN) for the app to be recorded in the remote access
-	// session.
+	// The ARN for the app to be recorded in the remote access session.
 	RemoteRecordAppArn *string `locationName:"remoteRecordAppArn" min:"32" type:"string"`
 
 	// This flag is set to true if remote recording is enabled for the remote access
@@ -13716,27 +17477,26 @@ type RemoteAccessSession struct {
 
 	// The result of the remote access session. Can be any of the following:
 	//
-	//    * PENDING: A pending condition.
+	//    * PENDING.
 	//
-	//    * PASSED: A passing condition.
+	//    * PASSED.
 	//
-	//    * WARNED: A warning condition.
+	//    * WARNED.
 	//
-	//    * FAILED: A failed condition.
+	//    * FAILED.
 	//
-	//    * SKIPPED: A skipped condition.
+	//    * SKIPPED.
 	//
-	//    * ERRORED: An error condition.
+	//    * ERRORED.
 	//
-	//    * STOPPED: A stopped condition.
+	//    * STOPPED.
 	Result *string `locationName:"result" type:"string" enum:"ExecutionResult"`
 
-	// When set to true, for private devices, Device Farm will not sign your app
-	// again. For public devices, Device Farm always signs your apps again and this
-	// parameter has no effect.
+	// When set to true, for private devices, Device Farm does not sign your app
+	// again. For public devices, Device Farm always signs your apps again.
 	//
-	// For more information about how Device Farm re-signs your app(s), see Do you
-	// modify my app? (https://aws.amazon.com/device-farm/faq/) in the AWS Device
+	// For more information about how Device Farm re-signs your apps, see Do you
+	// modify my app? (http://aws.amazon.com/device-farm/faqs/) in the AWS Device
 	// Farm FAQs.
 	SkipAppResign *bool `locationName:"skipAppResign" type:"boolean"`
 
@@ -13745,35 +17505,46 @@ type RemoteAccessSession struct {
 
 	// The status of the remote access session. Can be any of the following:
 	//
-	//    * PENDING: A pending status.
+	//    * PENDING.
 	//
-	//    * PENDING_CONCURRENCY: A pending concurrency status.
+	//    * PENDING_CONCURRENCY.
 	//
-	//    * PENDING_DEVICE: A pending device status.
+	//    * PENDING_DEVICE.
 	//
-	//    * PROCESSING: A processing status.
+	//    * PROCESSING.
 	//
-	//    * SCHEDULING: A scheduling status.
+	//    * SCHEDULING.
 	//
-	//    * PREPARING: A preparing status.
+	//    * PREPARING.
 	//
-	//    * RUNNING: A running status.
+	//    * RUNNING.
 	//
-	//    * COMPLETED: A completed status.
+	//    * COMPLETED.
 	//
-	//    * STOPPING: A stopping status.
+	//    * STOPPING.
 	Status *string `locationName:"status" type:"string" enum:"ExecutionStatus"`
 
 	// The date and time the remote access session was stopped.
 	Stopped *time.Time `locationName:"stopped" type:"timestamp"`
+
+	// The VPC security groups and subnets that are attached to a project.
+	VpcConfig *VpcConfig `locationName:"vpcConfig" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemoteAccessSession) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemoteAccessSession) GoString() string {
 	return s.String()
 }
@@ -13904,23 +17675,41 @@ func (s *RemoteAccessSession) SetStopped(v time.Time) *RemoteAccessSession {
 	return s
 }
 
-// A request representing an offering renewal.
+// SetVpcConfig sets the VpcConfig field's value.
+func (s *RemoteAccessSession) SetVpcConfig(v *VpcConfig) *RemoteAccessSession {
+	s.VpcConfig = v
+	return s
+}
+
+// A request that represents an offering renewal.
 type RenewOfferingInput struct {
 	_ struct{} `type:"structure"`
 
 	// The ID of a request to renew an offering.
-	OfferingId *string `locationName:"offeringId" min:"32" type:"string"`
+	//
+	// OfferingId is a required field
+	OfferingId *string `locationName:"offeringId" min:"32" type:"string" required:"true"`
 
 	// The quantity requested in an offering renewal.
-	Quantity *int64 `locationName:"quantity" type:"integer"`
+	//
+	// Quantity is a required field
+	Quantity *int64 `locationName:"quantity" type:"integer" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RenewOfferingInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RenewOfferingInput) GoString() string {
 	return s.String()
 }
@@ -13928,9 +17717,15 @@ func (s RenewOfferingInput) GoString() string {
 // Validate inspects the fields of the type to determine if they are valid.
 func (s *RenewOfferingInput) Validate() error {
 	invalidParams := request.ErrInvalidParams{Context: "RenewOfferingInput"}
+	if s.OfferingId == nil {
+		invalidParams.Add(request.NewErrParamRequired("OfferingId"))
+	}
 	if s.OfferingId != nil && len(*s.OfferingId) < 32 {
 		invalidParams.Add(request.NewErrParamMinLen("OfferingId", 32))
 	}
+	if s.Quantity == nil {
+		invalidParams.Add(request.NewErrParamRequired("Quantity"))
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -13958,12 +17753,20 @@ type RenewOfferingOutput struct {
 	OfferingTransaction *OfferingTransaction `locationName:"offeringTransaction" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RenewOfferingOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RenewOfferingOutput) GoString() string {
 	return s.String()
 }
@@ -13986,12 +17789,20 @@ type Resolution struct {
 	Width *int64 `locationName:"width" type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Resolution) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Resolution) GoString() string {
 	return s.String()
 }
@@ -14025,26 +17836,26 @@ type Rule struct {
 	//
 	// ARN
 	//
-	// The Amazon Resource Name (ARN) of the device. For example, "arn:aws:devicefarm:us-west-2::device:12345Example".
+	// The Amazon Resource Name (ARN) of the device (for example, arn:aws:devicefarm:us-west-2::device:12345Example.
 	//
 	// Supported operators: EQUALS, IN, NOT_IN
 	//
 	// AVAILABILITY
 	//
-	// The current availability of the device. Valid values are "AVAILABLE", "HIGHLY_AVAILABLE",
-	// "BUSY", or "TEMPORARY_NOT_AVAILABLE".
+	// The current availability of the device. Valid values are AVAILABLE, HIGHLY_AVAILABLE,
+	// BUSY, or TEMPORARY_NOT_AVAILABLE.
 	//
 	// Supported operators: EQUALS
 	//
 	// FLEET_TYPE
 	//
-	// The fleet type. Valid values are "PUBLIC" or "PRIVATE".
+	// The fleet type. Valid values are PUBLIC or PRIVATE.
 	//
 	// Supported operators: EQUALS
 	//
 	// FORM_FACTOR
 	//
-	// The device form factor. Valid values are "PHONE" or "TABLET".
+	// The device form factor. Valid values are PHONE or TABLET.
 	//
 	// Supported operators: EQUALS, IN, NOT_IN
 	//
@@ -14062,42 +17873,45 @@ type Rule struct {
 	//
 	// MANUFACTURER
 	//
-	// The device manufacturer. For example, "Apple".
+	// The device manufacturer (for example, Apple).
 	//
 	// Supported operators: EQUALS, IN, NOT_IN
 	//
 	// MODEL
 	//
-	// The device model, such as "Apple iPad Air 2" or "Google Pixel".
+	// The device model, such as Apple iPad Air 2 or Google Pixel.
 	//
 	// Supported operators: CONTAINS, EQUALS, IN, NOT_IN
 	//
 	// OS_VERSION
 	//
-	// The operating system version. For example, "10.3.2".
+	// The operating system version (for example, 10.3.2).
 	//
 	// Supported operators: EQUALS, GREATER_THAN, GREATER_THAN_OR_EQUALS, IN, LESS_THAN,
 	// LESS_THAN_OR_EQUALS, NOT_IN
 	//
 	// PLATFORM
 	//
-	// The device platform. Valid values are "ANDROID" or "IOS".
+	// The device platform. Valid values are ANDROID or IOS.
 	//
 	// Supported operators: EQUALS, IN, NOT_IN
 	//
 	// REMOTE_ACCESS_ENABLED
 	//
-	// Whether the device is enabled for remote access. Valid values are "TRUE"
-	// or "FALSE".
+	// Whether the device is enabled for remote access. Valid values are TRUE or
+	// FALSE.
 	//
 	// Supported operators: EQUALS
 	//
 	// REMOTE_DEBUG_ENABLED
 	//
-	// Whether the device is enabled for remote debugging. Valid values are "TRUE"
-	// or "FALSE".
+	// Whether the device is enabled for remote debugging. Valid values are TRUE
+	// or FALSE.
 	//
 	// Supported operators: EQUALS
+	//
+	// Because remote debugging is no longer supported (https://docs.aws.amazon.com/devicefarm/latest/developerguide/history.html),
+	// this filter is ignored.
 	Attribute *string `locationName:"attribute" type:"string" enum:"DeviceAttribute"`
 
 	// Specifies how Device Farm compares the rule's attribute to the value. For
@@ -14108,12 +17922,20 @@ type Rule struct {
 	Value *string `locationName:"value" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Rule) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Rule) GoString() string {
 	return s.String()
 }
@@ -14137,7 +17959,7 @@ func (s *Rule) SetValue(v string) *Rule {
 }
 
 // Represents a test run on a set of devices with a given app package, test
-// parameters, etc.
+// parameters, and so on.
 type Run struct {
 	_ struct{} `type:"structure"`
 
@@ -14149,6 +17971,9 @@ type Run struct {
 
 	// Specifies the billing method for a test run: metered or unmetered. If the
 	// parameter is not specified, the default value is metered.
+	//
+	// If you have unmetered device slots, you must set this to unmetered to use
+	// them. Otherwise, the run is counted toward metered device minutes.
 	BillingMethod *string `locationName:"billingMethod" type:"string" enum:"BillingMethod"`
 
 	// The total number of completed jobs.
@@ -14176,7 +18001,7 @@ type Run struct {
 	// UI fuzz test should perform.
 	EventCount *int64 `locationName:"eventCount" type:"integer"`
 
-	// The number of minutes the job will execute before it times out.
+	// The number of minutes the job executes before it times out.
 	JobTimeoutMinutes *int64 `locationName:"jobTimeoutMinutes" type:"integer"`
 
 	// Information about the locale that is used for the run.
@@ -14194,18 +18019,18 @@ type Run struct {
 	// The network profile being used for a test run.
 	NetworkProfile *NetworkProfile `locationName:"networkProfile" type:"structure"`
 
-	// Read-only URL for an object in S3 bucket where you can get the parsing results
-	// of the test package. If the test package doesn't parse, the reason why it
-	// doesn't parse appears in the file that this URL points to.
+	// Read-only URL for an object in an S3 bucket where you can get the parsing
+	// results of the test package. If the test package doesn't parse, the reason
+	// why it doesn't parse appears in the file that this URL points to.
 	ParsingResultUrl *string `locationName:"parsingResultUrl" type:"string"`
 
 	// The run's platform.
 	//
 	// Allowed values include:
 	//
-	//    * ANDROID: The Android platform.
+	//    * ANDROID
 	//
-	//    * IOS: The iOS platform.
+	//    * IOS
 	Platform *string `locationName:"platform" type:"string" enum:"DevicePlatform"`
 
 	// Information about the radio states for the run.
@@ -14215,19 +18040,19 @@ type Run struct {
 	//
 	// Allowed values include:
 	//
-	//    * PENDING: A pending condition.
+	//    * PENDING
 	//
-	//    * PASSED: A passing condition.
+	//    * PASSED
 	//
-	//    * WARNED: A warning condition.
+	//    * WARNED
 	//
-	//    * FAILED: A failed condition.
+	//    * FAILED
 	//
-	//    * SKIPPED: A skipped condition.
+	//    * SKIPPED
 	//
-	//    * ERRORED: An error condition.
+	//    * ERRORED
 	//
-	//    * STOPPED: A stopped condition.
+	//    * STOPPED
 	Result *string `locationName:"result" type:"string" enum:"ExecutionResult"`
 
 	// Supporting field for the result field. Set only if result is SKIPPED. PARSING_FAILED
@@ -14238,12 +18063,11 @@ type Run struct {
 	// the same seed value between tests ensures identical event sequences.
 	Seed *int64 `locationName:"seed" type:"integer"`
 
-	// When set to true, for private devices, Device Farm will not sign your app
-	// again. For public devices, Device Farm always signs your apps again and this
-	// parameter has no effect.
+	// When set to true, for private devices, Device Farm does not sign your app
+	// again. For public devices, Device Farm always signs your apps again.
 	//
-	// For more information about how Device Farm re-signs your app(s), see Do you
-	// modify my app? (https://aws.amazon.com/device-farm/faq/) in the AWS Device
+	// For more information about how Device Farm re-signs your apps, see Do you
+	// modify my app? (http://aws.amazon.com/device-farm/faqs/) in the AWS Device
 	// Farm FAQs.
 	SkipAppResign *bool `locationName:"skipAppResign" type:"boolean"`
 
@@ -14254,23 +18078,23 @@ type Run struct {
 	//
 	// Allowed values include:
 	//
-	//    * PENDING: A pending status.
+	//    * PENDING
 	//
-	//    * PENDING_CONCURRENCY: A pending concurrency status.
+	//    * PENDING_CONCURRENCY
 	//
-	//    * PENDING_DEVICE: A pending device status.
+	//    * PENDING_DEVICE
 	//
-	//    * PROCESSING: A processing status.
+	//    * PROCESSING
 	//
-	//    * SCHEDULING: A scheduling status.
+	//    * SCHEDULING
 	//
-	//    * PREPARING: A preparing status.
+	//    * PREPARING
 	//
-	//    * RUNNING: A running status.
+	//    * RUNNING
 	//
-	//    * COMPLETED: A completed status.
+	//    * COMPLETED
 	//
-	//    * STOPPING: A stopping status.
+	//    * STOPPING
 	Status *string `locationName:"status" type:"string" enum:"ExecutionStatus"`
 
 	// The run's stop time.
@@ -14286,55 +18110,65 @@ type Run struct {
 	//
 	// Must be one of the following values:
 	//
-	//    * BUILTIN_FUZZ: The built-in fuzz type.
+	//    * BUILTIN_FUZZ
 	//
-	//    * BUILTIN_EXPLORER: For Android, an app explorer that will traverse an
-	//    Android app, interacting with it and capturing screenshots at the same
-	//    time.
+	//    * BUILTIN_EXPLORER For Android, an app explorer that traverses an Android
+	//    app, interacting with it and capturing screenshots at the same time.
 	//
-	//    * APPIUM_JAVA_JUNIT: The Appium Java JUnit type.
+	//    * APPIUM_JAVA_JUNIT
 	//
-	//    * APPIUM_JAVA_TESTNG: The Appium Java TestNG type.
+	//    * APPIUM_JAVA_TESTNG
 	//
-	//    * APPIUM_PYTHON: The Appium Python type.
+	//    * APPIUM_PYTHON
 	//
-	//    * APPIUM_NODE: The Appium Node.js type.
+	//    * APPIUM_NODE
 	//
-	//    * APPIUM_RUBY: The Appium Ruby type.
+	//    * APPIUM_RUBY
 	//
-	//    * APPIUM_WEB_JAVA_JUNIT: The Appium Java JUnit type for web apps.
+	//    * APPIUM_WEB_JAVA_JUNIT
 	//
-	//    * APPIUM_WEB_JAVA_TESTNG: The Appium Java TestNG type for web apps.
+	//    * APPIUM_WEB_JAVA_TESTNG
 	//
-	//    * APPIUM_WEB_PYTHON: The Appium Python type for web apps.
+	//    * APPIUM_WEB_PYTHON
 	//
-	//    * APPIUM_WEB_NODE: The Appium Node.js type for web apps.
+	//    * APPIUM_WEB_NODE
 	//
-	//    * APPIUM_WEB_RUBY: The Appium Ruby type for web apps.
+	//    * APPIUM_WEB_RUBY
 	//
-	//    * CALABASH: The Calabash type.
+	//    * CALABASH
 	//
-	//    * INSTRUMENTATION: The Instrumentation type.
+	//    * INSTRUMENTATION
 	//
-	//    * UIAUTOMATION: The uiautomation type.
+	//    * UIAUTOMATION
 	//
-	//    * UIAUTOMATOR: The uiautomator type.
+	//    * UIAUTOMATOR
 	//
-	//    * XCTEST: The Xcode test type.
+	//    * XCTEST
 	//
-	//    * XCTEST_UI: The Xcode UI test type.
+	//    * XCTEST_UI
 	Type *string `locationName:"type" type:"string" enum:"TestType"`
 
+	// The VPC security groups and subnets that are attached to a project.
+	VpcConfig *VpcConfig `locationName:"vpcConfig" type:"structure"`
+
 	// The Device Farm console URL for the recording of the run.
 	WebUrl *string `locationName:"webUrl" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Run) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Run) GoString() string {
 	return s.String()
 }
@@ -14519,6 +18353,12 @@ func (s *Run) SetType(v string) *Run {
 	return s
 }
 
+// SetVpcConfig sets the VpcConfig field's value.
+func (s *Run) SetVpcConfig(v *VpcConfig) *Run {
+	s.VpcConfig = v
+	return s
+}
+
 // SetWebUrl sets the WebUrl field's value.
 func (s *Run) SetWebUrl(v string) *Run {
 	s.WebUrl = &v
@@ -14576,17 +18416,25 @@ type Sample struct {
 	//    received, by app process.
 	Type *string `locationName:"type" type:"string" enum:"SampleType"`
 
-	// The pre-signed Amazon S3 URL that can be used with a corresponding GET request
-	// to download the sample's file.
+	// The presigned Amazon S3 URL that can be used with a GET request to download
+	// the sample's file.
 	Url *string `locationName:"url" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Sample) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Sample) GoString() string {
 	return s.String()
 }
@@ -14614,18 +18462,22 @@ func (s *Sample) SetUrl(v string) *Sample {
 type ScheduleRunConfiguration struct {
 	_ struct{} `type:"structure"`
 
-	// A list of auxiliary apps for the run.
+	// A list of upload ARNs for app packages to be installed with your app.
 	AuxiliaryApps []*string `locationName:"auxiliaryApps" type:"list"`
 
 	// Specifies the billing method for a test run: metered or unmetered. If the
 	// parameter is not specified, the default value is metered.
+	//
+	// If you have purchased unmetered device slots, you must set this parameter
+	// to unmetered to make use of them. Otherwise, your run counts against your
+	// metered time.
 	BillingMethod *string `locationName:"billingMethod" type:"string" enum:"BillingMethod"`
 
 	// Input CustomerArtifactPaths object for the scheduled run configuration.
 	CustomerArtifactPaths *CustomerArtifactPaths `locationName:"customerArtifactPaths" type:"structure"`
 
 	// The ARN of the extra data for the run. The extra data is a .zip file that
-	// AWS Device Farm will extract to external data for Android or the app's sandbox
+	// AWS Device Farm extracts to external data for Android or the app's sandbox
 	// for iOS.
 	ExtraDataPackageArn *string `locationName:"extraDataPackageArn" min:"32" type:"string"`
 
@@ -14641,16 +18493,24 @@ type ScheduleRunConfiguration struct {
 	// Information about the radio states for the run.
 	Radios *Radios `locationName:"radios" type:"structure"`
 
-	// An array of Amazon Resource Names (ARNs) for your VPC endpoint configurations.
+	// An array of ARNs for your VPC endpoint configurations.
 	VpceConfigurationArns []*string `locationName:"vpceConfigurationArns" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ScheduleRunConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ScheduleRunConfiguration) GoString() string {
 	return s.String()
 }
@@ -14734,7 +18594,8 @@ func (s *ScheduleRunConfiguration) SetVpceConfigurationArns(v []*string) *Schedu
 type ScheduleRunInput struct {
 	_ struct{} `type:"structure"`
 
-	// The ARN of the app to schedule a run.
+	// The ARN of an application package to run tests against, created with CreateUpload.
+	// See ListUploads.
 	AppArn *string `locationName:"appArn" min:"32" type:"string"`
 
 	// Information about the settings for the run to be scheduled.
@@ -14744,7 +18605,7 @@ type ScheduleRunInput struct {
 	DevicePoolArn *string `locationName:"devicePoolArn" min:"32" type:"string"`
 
 	// The filter criteria used to dynamically select a set of devices for a test
-	// run, as well as the maximum number of devices to be included in the run.
+	// run and the maximum number of devices to be included in the run.
 	//
 	// Either devicePoolArn or deviceSelectionConfiguration is required in a request.
 	DeviceSelectionConfiguration *DeviceSelectionConfiguration `locationName:"deviceSelectionConfiguration" type:"structure"`
@@ -14767,12 +18628,20 @@ type ScheduleRunInput struct {
 	Test *ScheduleRunTest `locationName:"test" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ScheduleRunInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ScheduleRunInput) GoString() string {
 	return s.String()
 }
@@ -14873,12 +18742,20 @@ type ScheduleRunOutput struct {
 	Run *Run `locationName:"run" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ScheduleRunOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ScheduleRunOutput) GoString() string {
 	return s.String()
 }
@@ -14889,9 +18766,8 @@ func (s *ScheduleRunOutput) SetRun(v *Run) *ScheduleRunOutput {
 	return s
 }
 
-// Represents test settings. This data structure is passed in as the "test"
-// parameter to ScheduleRun. For an example of the JSON request syntax, see
-// ScheduleRun.
+// Represents test settings. This data structure is passed in as the test parameter
+// to ScheduleRun. For an example of the JSON request syntax, see ScheduleRun.
 type ScheduleRunTest struct {
 	_ struct{} `type:"structure"`
 
@@ -14904,25 +18780,25 @@ type ScheduleRunTest struct {
 	// For all tests:
 	//
 	//    * app_performance_monitoring: Performance monitoring is enabled by default.
-	//    Set this parameter to "false" to disable it.
+	//    Set this parameter to false to disable it.
 	//
 	// For Calabash tests:
 	//
-	//    * profile: A cucumber profile, for example, "my_profile_name".
+	//    * profile: A cucumber profile (for example, my_profile_name).
 	//
 	//    * tags: You can limit execution to features or scenarios that have (or
-	//    don't have) certain tags, for example, "@smoke" or "@smoke,~@wip".
+	//    don't have) certain tags (for example, @smoke or @smoke,~@wip).
 	//
 	// For Appium tests (all types):
 	//
-	//    * appium_version: The Appium version. Currently supported values are "1.6.5"
-	//    (and higher), "latest", and "default". “latest” will run the latest
-	//    Appium version supported by Device Farm (1.9.1). For “default”, Device
-	//    Farm will choose a compatible version of Appium for the device. The current
-	//    behavior is to run 1.7.2 on Android devices and iOS 9 and earlier, 1.7.2
-	//    for iOS 10 and later. This behavior is subject to change.
+	//    * appium_version: The Appium version. Currently supported values are 1.6.5
+	//    (and later), latest, and default. latest runs the latest Appium version
+	//    supported by Device Farm (1.9.1). For default, Device Farm selects a compatible
+	//    version of Appium for the device. The current behavior is to run 1.7.2
+	//    on Android devices and iOS 9 and earlier and 1.7.2 for iOS 10 and later.
+	//    This behavior is subject to change.
 	//
-	// For Fuzz tests (Android only):
+	// For fuzz tests (Android only):
 	//
 	//    * event_count: The number of events, between 1 and 10000, that the UI
 	//    fuzz test should perform.
@@ -14935,33 +18811,32 @@ type ScheduleRunTest struct {
 	//
 	// For Explorer tests:
 	//
-	//    * username: A username to use if the Explorer encounters a login form.
-	//    If not supplied, no username will be inserted.
+	//    * username: A user name to use if the Explorer encounters a login form.
+	//    If not supplied, no user name is inserted.
 	//
 	//    * password: A password to use if the Explorer encounters a login form.
-	//    If not supplied, no password will be inserted.
+	//    If not supplied, no password is inserted.
 	//
 	// For Instrumentation:
 	//
 	//    * filter: A test filter string. Examples: Running a single test case:
-	//    "com.android.abc.Test1" Running a single test: "com.android.abc.Test1#smoke"
-	//    Running multiple tests: "com.android.abc.Test1,com.android.abc.Test2"
+	//    com.android.abc.Test1 Running a single test: com.android.abc.Test1#smoke
+	//    Running multiple tests: com.android.abc.Test1,com.android.abc.Test2
 	//
 	// For XCTest and XCTestUI:
 	//
 	//    * filter: A test filter string. Examples: Running a single test class:
-	//    "LoginTests" Running a multiple test classes: "LoginTests,SmokeTests"
-	//    Running a single test: "LoginTests/testValid" Running multiple tests:
-	//    "LoginTests/testValid,LoginTests/testInvalid"
+	//    LoginTests Running a multiple test classes: LoginTests,SmokeTests Running
+	//    a single test: LoginTests/testValid Running multiple tests: LoginTests/testValid,LoginTests/testInvalid
 	//
 	// For UIAutomator:
 	//
 	//    * filter: A test filter string. Examples: Running a single test case:
-	//    "com.android.abc.Test1" Running a single test: "com.android.abc.Test1#smoke"
-	//    Running multiple tests: "com.android.abc.Test1,com.android.abc.Test2"
+	//    com.android.abc.Test1 Running a single test: com.android.abc.Test1#smoke
+	//    Running multiple tests: com.android.abc.Test1,com.android.abc.Test2
 	Parameters map[string]*string `locationName:"parameters" type:"map"`
 
-	// The ARN of the uploaded test that will be run.
+	// The ARN of the uploaded test to be run.
 	TestPackageArn *string `locationName:"testPackageArn" min:"32" type:"string"`
 
 	// The ARN of the YAML-formatted test specification.
@@ -14971,54 +18846,61 @@ type ScheduleRunTest struct {
 	//
 	// Must be one of the following values:
 	//
-	//    * BUILTIN_FUZZ: The built-in fuzz type.
+	//    * BUILTIN_FUZZ
 	//
-	//    * BUILTIN_EXPLORER: For Android, an app explorer that will traverse an
-	//    Android app, interacting with it and capturing screenshots at the same
-	//    time.
+	//    * BUILTIN_EXPLORER. For Android, an app explorer that traverses an Android
+	//    app, interacting with it and capturing screenshots at the same time.
 	//
-	//    * APPIUM_JAVA_JUNIT: The Appium Java JUnit type.
+	//    * APPIUM_JAVA_JUNIT
 	//
-	//    * APPIUM_JAVA_TESTNG: The Appium Java TestNG type.
+	//    * APPIUM_JAVA_TESTNG
 	//
-	//    * APPIUM_PYTHON: The Appium Python type.
+	//    * APPIUM_PYTHON
 	//
-	//    * APPIUM_NODE: The Appium Node.js type.
+	//    * APPIUM_NODE
 	//
-	//    * APPIUM_RUBY: The Appium Ruby type.
+	//    * APPIUM_RUBY
 	//
-	//    * APPIUM_WEB_JAVA_JUNIT: The Appium Java JUnit type for web apps.
+	//    * APPIUM_WEB_JAVA_JUNIT
 	//
-	//    * APPIUM_WEB_JAVA_TESTNG: The Appium Java TestNG type for web apps.
+	//    * APPIUM_WEB_JAVA_TESTNG
 	//
-	//    * APPIUM_WEB_PYTHON: The Appium Python type for web apps.
+	//    * APPIUM_WEB_PYTHON
 	//
-	//    * APPIUM_WEB_NODE: The Appium Node.js type for web apps.
+	//    * APPIUM_WEB_NODE
 	//
-	//    * APPIUM_WEB_RUBY: The Appium Ruby type for web apps.
+	//    * APPIUM_WEB_RUBY
 	//
-	//    * CALABASH: The Calabash type.
+	//    * CALABASH
 	//
-	//    * INSTRUMENTATION: The Instrumentation type.
+	//    * INSTRUMENTATION
 	//
-	//    * UIAUTOMATION: The uiautomation type.
+	//    * UIAUTOMATION
 	//
-	//    * UIAUTOMATOR: The uiautomator type.
+	//    * UIAUTOMATOR
 	//
-	//    * XCTEST: The Xcode test type.
+	//    * XCTEST
 	//
-	//    * XCTEST_UI: The Xcode UI test type.
+	//    * XCTEST_UI
 	//
 	// Type is a required field
 	Type *string `locationName:"type" type:"string" required:"true" enum:"TestType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ScheduleRunTest) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ScheduleRunTest) GoString() string {
 	return s.String()
 }
@@ -15072,22 +18954,94 @@ func (s *ScheduleRunTest) SetType(v string) *ScheduleRunTest {
 	return s
 }
 
+// There was a problem with the service account.
+type ServiceAccountException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// Any additional information about the exception.
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceAccountException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceAccountException) GoString() string {
+	return s.String()
+}
+
+func newErrorServiceAccountException(v protocol.ResponseMetadata) error {
+	return &ServiceAccountException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ServiceAccountException) Code() string {
+	return "ServiceAccountException"
+}
+
+// Message returns the exception's message.
+func (s *ServiceAccountException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ServiceAccountException) OrigErr() error {
+	return nil
+}
+
+func (s *ServiceAccountException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ServiceAccountException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ServiceAccountException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 type StopJobInput struct {
 	_ struct{} `type:"structure"`
 
-	// Represents the Amazon Resource Name (ARN) of the Device Farm job you wish
-	// to stop.
+	// Represents the Amazon Resource Name (ARN) of the Device Farm job to stop.
 	//
 	// Arn is a required field
 	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopJobInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopJobInput) GoString() string {
 	return s.String()
 }
@@ -15121,12 +19075,20 @@ type StopJobOutput struct {
 	Job *Job `locationName:"job" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopJobOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopJobOutput) GoString() string {
 	return s.String()
 }
@@ -15141,18 +19103,26 @@ func (s *StopJobOutput) SetJob(v *Job) *StopJobOutput {
 type StopRemoteAccessSessionInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the remote access session you wish to stop.
+	// The Amazon Resource Name (ARN) of the remote access session to stop.
 	//
 	// Arn is a required field
 	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopRemoteAccessSessionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopRemoteAccessSessionInput) GoString() string {
 	return s.String()
 }
@@ -15184,17 +19154,25 @@ func (s *StopRemoteAccessSessionInput) SetArn(v string) *StopRemoteAccessSession
 type StopRemoteAccessSessionOutput struct {
 	_ struct{} `type:"structure"`
 
-	// A container representing the metadata from the service about the remote access
-	// session you are stopping.
+	// A container that represents the metadata from the service about the remote
+	// access session you are stopping.
 	RemoteAccessSession *RemoteAccessSession `locationName:"remoteAccessSession" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopRemoteAccessSessionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopRemoteAccessSessionOutput) GoString() string {
 	return s.String()
 }
@@ -15209,19 +19187,26 @@ func (s *StopRemoteAccessSessionOutput) SetRemoteAccessSession(v *RemoteAccessSe
 type StopRunInput struct {
 	_ struct{} `type:"structure"`
 
-	// Represents the Amazon Resource Name (ARN) of the Device Farm run you wish
-	// to stop.
+	// Represents the Amazon Resource Name (ARN) of the Device Farm run to stop.
 	//
 	// Arn is a required field
 	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopRunInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopRunInput) GoString() string {
 	return s.String()
 }
@@ -15256,12 +19241,20 @@ type StopRunOutput struct {
 	Run *Run `locationName:"run" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopRunOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopRunOutput) GoString() string {
 	return s.String()
 }
@@ -15298,19 +19291,19 @@ type Suite struct {
 	//
 	// Allowed values include:
 	//
-	//    * PENDING: A pending condition.
+	//    * PENDING
 	//
-	//    * PASSED: A passing condition.
+	//    * PASSED
 	//
-	//    * WARNED: A warning condition.
+	//    * WARNED
 	//
-	//    * FAILED: A failed condition.
+	//    * FAILED
 	//
-	//    * SKIPPED: A skipped condition.
+	//    * SKIPPED
 	//
-	//    * ERRORED: An error condition.
+	//    * ERRORED
 	//
-	//    * STOPPED: A stopped condition.
+	//    * STOPPED
 	Result *string `locationName:"result" type:"string" enum:"ExecutionResult"`
 
 	// The suite's start time.
@@ -15320,23 +19313,23 @@ type Suite struct {
 	//
 	// Allowed values include:
 	//
-	//    * PENDING: A pending status.
+	//    * PENDING
 	//
-	//    * PENDING_CONCURRENCY: A pending concurrency status.
+	//    * PENDING_CONCURRENCY
 	//
-	//    * PENDING_DEVICE: A pending device status.
+	//    * PENDING_DEVICE
 	//
-	//    * PROCESSING: A processing status.
+	//    * PROCESSING
 	//
-	//    * SCHEDULING: A scheduling status.
+	//    * SCHEDULING
 	//
-	//    * PREPARING: A preparing status.
+	//    * PREPARING
 	//
-	//    * RUNNING: A running status.
+	//    * RUNNING
 	//
-	//    * COMPLETED: A completed status.
+	//    * COMPLETED
 	//
-	//    * STOPPING: A stopping status.
+	//    * STOPPING
 	Status *string `locationName:"status" type:"string" enum:"ExecutionStatus"`
 
 	// The suite's stop time.
@@ -15346,52 +19339,60 @@ type Suite struct {
 	//
 	// Must be one of the following values:
 	//
-	//    * BUILTIN_FUZZ: The built-in fuzz type.
+	//    * BUILTIN_FUZZ
 	//
-	//    * BUILTIN_EXPLORER: For Android, an app explorer that will traverse an
-	//    Android app, interacting with it and capturing screenshots at the same
+	//    * BUILTIN_EXPLORER Only available for Android; an app explorer that traverses
+	//    an Android app, interacting with it and capturing screenshots at the same
 	//    time.
 	//
-	//    * APPIUM_JAVA_JUNIT: The Appium Java JUnit type.
+	//    * APPIUM_JAVA_JUNIT
 	//
-	//    * APPIUM_JAVA_TESTNG: The Appium Java TestNG type.
+	//    * APPIUM_JAVA_TESTNG
 	//
-	//    * APPIUM_PYTHON: The Appium Python type.
+	//    * APPIUM_PYTHON
 	//
-	//    * APPIUM_NODE: The Appium Node.js type.
+	//    * APPIUM_NODE
 	//
-	//    * APPIUM_RUBY: The Appium Ruby type.
+	//    * APPIUM_RUBY
 	//
-	//    * APPIUM_WEB_JAVA_JUNIT: The Appium Java JUnit type for web apps.
+	//    * APPIUM_WEB_JAVA_JUNIT
 	//
-	//    * APPIUM_WEB_JAVA_TESTNG: The Appium Java TestNG type for web apps.
+	//    * APPIUM_WEB_JAVA_TESTNG
 	//
-	//    * APPIUM_WEB_PYTHON: The Appium Python type for web apps.
+	//    * APPIUM_WEB_PYTHON
 	//
-	//    * APPIUM_WEB_NODE: The Appium Node.js type for web apps.
+	//    * APPIUM_WEB_NODE
 	//
-	//    * APPIUM_WEB_RUBY: The Appium Ruby type for web apps.
+	//    * APPIUM_WEB_RUBY
 	//
-	//    * CALABASH: The Calabash type.
+	//    * CALABASH
 	//
-	//    * INSTRUMENTATION: The Instrumentation type.
+	//    * INSTRUMENTATION
 	//
-	//    * UIAUTOMATION: The uiautomation type.
+	//    * UIAUTOMATION
 	//
-	//    * UIAUTOMATOR: The uiautomator type.
+	//    * UIAUTOMATOR
 	//
-	//    * XCTEST: The Xcode test type.
+	//    * XCTEST
 	//
-	//    * XCTEST_UI: The Xcode UI test type.
+	//    * XCTEST_UI
 	Type *string `locationName:"type" type:"string" enum:"TestType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Suite) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Suite) GoString() string {
 	return s.String()
 }
@@ -15464,30 +19465,38 @@ func (s *Suite) SetType(v string) *Suite {
 
 // The metadata that you apply to a resource to help you categorize and organize
 // it. Each tag consists of a key and an optional value, both of which you define.
-// Tag keys can have a maximum character length of 128 characters, and tag values
+// Tag keys can have a maximum character length of 128 characters. Tag values
 // can have a maximum length of 256 characters.
 type Tag struct {
 	_ struct{} `type:"structure"`
 
-	// One part of a key-value pair that make up a tag. A key is a general label
+	// One part of a key-value pair that makes up a tag. A key is a general label
 	// that acts like a category for more specific tag values.
 	//
 	// Key is a required field
 	Key *string `min:"1" type:"string" required:"true"`
 
-	// The optional part of a key-value pair that make up a tag. A value acts as
-	// a descriptor within a tag category (key).
+	// The optional part of a key-value pair that makes up a tag. A value acts as
+	// a descriptor in a tag category (key).
 	//
 	// Value is a required field
 	Value *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) GoString() string {
 	return s.String()
 }
@@ -15505,49 +19514,190 @@ func (s *Tag) Validate() error {
 		invalidParams.Add(request.NewErrParamRequired("Value"))
 	}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetKey sets the Key field's value.
+func (s *Tag) SetKey(v string) *Tag {
+	s.Key = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *Tag) SetValue(v string) *Tag {
+	s.Value = &v
+	return s
+}
+
+// The operation was not successful. Try again.
+type TagOperationException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+
+	ResourceName *string `locationName:"resourceName" min:"32" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagOperationException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagOperationException) GoString() string {
+	return s.String()
+}
+
+func newErrorTagOperationException(v protocol.ResponseMetadata) error {
+	return &TagOperationException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *TagOperationException) Code() string {
+	return "TagOperationException"
+}
+
+// Message returns the exception's message.
+func (s *TagOperationException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *TagOperationException) OrigErr() error {
+	return nil
+}
+
+func (s *TagOperationException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *TagOperationException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *TagOperationException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The request doesn't comply with the AWS Identity and Access Management (IAM)
+// tag policy. Correct your request and then retry it.
+type TagPolicyException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+
+	ResourceName *string `locationName:"resourceName" min:"32" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagPolicyException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagPolicyException) GoString() string {
+	return s.String()
+}
+
+func newErrorTagPolicyException(v protocol.ResponseMetadata) error {
+	return &TagPolicyException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *TagPolicyException) Code() string {
+	return "TagPolicyException"
+}
+
+// Message returns the exception's message.
+func (s *TagPolicyException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *TagPolicyException) OrigErr() error {
 	return nil
 }
 
-// SetKey sets the Key field's value.
-func (s *Tag) SetKey(v string) *Tag {
-	s.Key = &v
-	return s
+func (s *TagPolicyException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
 }
 
-// SetValue sets the Value field's value.
-func (s *Tag) SetValue(v string) *Tag {
-	s.Value = &v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *TagPolicyException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *TagPolicyException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
 type TagResourceInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the resource(s) to which to add tags. You
-	// can associate tags with the following Device Farm resources: PROJECT, RUN,
-	// NETWORK_PROFILE, INSTANCE_PROFILE, DEVICE_INSTANCE, SESSION, DEVICE_POOL,
+	// The Amazon Resource Name (ARN) of the resource or resources to which to add
+	// tags. You can associate tags with the following Device Farm resources: PROJECT,
+	// RUN, NETWORK_PROFILE, INSTANCE_PROFILE, DEVICE_INSTANCE, SESSION, DEVICE_POOL,
 	// DEVICE, and VPCE_CONFIGURATION.
 	//
 	// ResourceARN is a required field
 	ResourceARN *string `min:"32" type:"string" required:"true"`
 
 	// The tags to add to the resource. A tag is an array of key-value pairs. Tag
-	// keys can have a maximum character length of 128 characters, and tag values
-	// can have a maximum length of 256 characters.
+	// keys can have a maximum character length of 128 characters. Tag values can
+	// have a maximum length of 256 characters.
 	//
 	// Tags is a required field
 	Tags []*Tag `type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceInput) GoString() string {
 	return s.String()
 }
@@ -15597,12 +19747,20 @@ type TagResourceOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceOutput) GoString() string {
 	return s.String()
 }
@@ -15633,19 +19791,19 @@ type Test struct {
 	//
 	// Allowed values include:
 	//
-	//    * PENDING: A pending condition.
+	//    * PENDING
 	//
-	//    * PASSED: A passing condition.
+	//    * PASSED
 	//
-	//    * WARNED: A warning condition.
+	//    * WARNED
 	//
-	//    * FAILED: A failed condition.
+	//    * FAILED
 	//
-	//    * SKIPPED: A skipped condition.
+	//    * SKIPPED
 	//
-	//    * ERRORED: An error condition.
+	//    * ERRORED
 	//
-	//    * STOPPED: A stopped condition.
+	//    * STOPPED
 	Result *string `locationName:"result" type:"string" enum:"ExecutionResult"`
 
 	// The test's start time.
@@ -15655,23 +19813,23 @@ type Test struct {
 	//
 	// Allowed values include:
 	//
-	//    * PENDING: A pending status.
+	//    * PENDING
 	//
-	//    * PENDING_CONCURRENCY: A pending concurrency status.
+	//    * PENDING_CONCURRENCY
 	//
-	//    * PENDING_DEVICE: A pending device status.
+	//    * PENDING_DEVICE
 	//
-	//    * PROCESSING: A processing status.
+	//    * PROCESSING
 	//
-	//    * SCHEDULING: A scheduling status.
+	//    * SCHEDULING
 	//
-	//    * PREPARING: A preparing status.
+	//    * PREPARING
 	//
-	//    * RUNNING: A running status.
+	//    * RUNNING
 	//
-	//    * COMPLETED: A completed status.
+	//    * COMPLETED
 	//
-	//    * STOPPING: A stopping status.
+	//    * STOPPING
 	Status *string `locationName:"status" type:"string" enum:"ExecutionStatus"`
 
 	// The test's stop time.
@@ -15681,52 +19839,59 @@ type Test struct {
 	//
 	// Must be one of the following values:
 	//
-	//    * BUILTIN_FUZZ: The built-in fuzz type.
+	//    * BUILTIN_FUZZ
 	//
-	//    * BUILTIN_EXPLORER: For Android, an app explorer that will traverse an
-	//    Android app, interacting with it and capturing screenshots at the same
-	//    time.
+	//    * BUILTIN_EXPLORER For Android, an app explorer that traverses an Android
+	//    app, interacting with it and capturing screenshots at the same time.
 	//
-	//    * APPIUM_JAVA_JUNIT: The Appium Java JUnit type.
+	//    * APPIUM_JAVA_JUNIT
 	//
-	//    * APPIUM_JAVA_TESTNG: The Appium Java TestNG type.
+	//    * APPIUM_JAVA_TESTNG
 	//
-	//    * APPIUM_PYTHON: The Appium Python type.
+	//    * APPIUM_PYTHON
 	//
-	//    * APPIUM_NODE: The Appium Node.js type.
+	//    * APPIUM_NODE
 	//
-	//    * APPIUM_RUBY: The Appium Ruby type.
+	//    * APPIUM_RUBY
 	//
-	//    * APPIUM_WEB_JAVA_JUNIT: The Appium Java JUnit type for web apps.
+	//    * APPIUM_WEB_JAVA_JUNIT
 	//
-	//    * APPIUM_WEB_JAVA_TESTNG: The Appium Java TestNG type for web apps.
+	//    * APPIUM_WEB_JAVA_TESTNG
 	//
-	//    * APPIUM_WEB_PYTHON: The Appium Python type for web apps.
+	//    * APPIUM_WEB_PYTHON
 	//
-	//    * APPIUM_WEB_NODE: The Appium Node.js type for web apps.
+	//    * APPIUM_WEB_NODE
 	//
-	//    * APPIUM_WEB_RUBY: The Appium Ruby type for web apps.
+	//    * APPIUM_WEB_RUBY
 	//
-	//    * CALABASH: The Calabash type.
+	//    * CALABASH
 	//
-	//    * INSTRUMENTATION: The Instrumentation type.
+	//    * INSTRUMENTATION
 	//
-	//    * UIAUTOMATION: The uiautomation type.
+	//    * UIAUTOMATION
 	//
-	//    * UIAUTOMATOR: The uiautomator type.
+	//    * UIAUTOMATOR
 	//
-	//    * XCTEST: The Xcode test type.
+	//    * XCTEST
 	//
-	//    * XCTEST_UI: The Xcode UI test type.
+	//    * XCTEST_UI
 	Type *string `locationName:"type" type:"string" enum:"TestType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Test) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Test) GoString() string {
 	return s.String()
 }
@@ -15797,6 +19962,429 @@ func (s *Test) SetType(v string) *Test {
 	return s
 }
 
+// A Selenium testing project. Projects are used to collect and collate sessions.
+type TestGridProject struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN for the project.
+	Arn *string `locationName:"arn" min:"32" type:"string"`
+
+	// When the project was created.
+	Created *time.Time `locationName:"created" type:"timestamp"`
+
+	// A human-readable description for the project.
+	Description *string `locationName:"description" type:"string"`
+
+	// A human-readable name for the project.
+	Name *string `locationName:"name" type:"string"`
+
+	// The VPC security groups and subnets that are attached to a project.
+	VpcConfig *TestGridVpcConfig `locationName:"vpcConfig" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TestGridProject) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TestGridProject) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *TestGridProject) SetArn(v string) *TestGridProject {
+	s.Arn = &v
+	return s
+}
+
+// SetCreated sets the Created field's value.
+func (s *TestGridProject) SetCreated(v time.Time) *TestGridProject {
+	s.Created = &v
+	return s
+}
+
+// SetDescription sets the Description field's value.
+func (s *TestGridProject) SetDescription(v string) *TestGridProject {
+	s.Description = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *TestGridProject) SetName(v string) *TestGridProject {
+	s.Name = &v
+	return s
+}
+
+// SetVpcConfig sets the VpcConfig field's value.
+func (s *TestGridProject) SetVpcConfig(v *TestGridVpcConfig) *TestGridProject {
+	s.VpcConfig = v
+	return s
+}
+
+// A TestGridSession is a single instance of a browser launched from the URL
+// provided by a call to CreateTestGridUrl.
+type TestGridSession struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the session.
+	Arn *string `locationName:"arn" min:"32" type:"string"`
+
+	// The number of billed minutes that were used for this session.
+	BillingMinutes *float64 `locationName:"billingMinutes" type:"double"`
+
+	// The time that the session was started.
+	Created *time.Time `locationName:"created" type:"timestamp"`
+
+	// The time the session ended.
+	Ended *time.Time `locationName:"ended" type:"timestamp"`
+
+	// A JSON object of options and parameters passed to the Selenium WebDriver.
+	SeleniumProperties *string `locationName:"seleniumProperties" type:"string"`
+
+	// The state of the session.
+	Status *string `locationName:"status" type:"string" enum:"TestGridSessionStatus"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TestGridSession) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TestGridSession) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *TestGridSession) SetArn(v string) *TestGridSession {
+	s.Arn = &v
+	return s
+}
+
+// SetBillingMinutes sets the BillingMinutes field's value.
+func (s *TestGridSession) SetBillingMinutes(v float64) *TestGridSession {
+	s.BillingMinutes = &v
+	return s
+}
+
+// SetCreated sets the Created field's value.
+func (s *TestGridSession) SetCreated(v time.Time) *TestGridSession {
+	s.Created = &v
+	return s
+}
+
+// SetEnded sets the Ended field's value.
+func (s *TestGridSession) SetEnded(v time.Time) *TestGridSession {
+	s.Ended = &v
+	return s
+}
+
+// SetSeleniumProperties sets the SeleniumProperties field's value.
+func (s *TestGridSession) SetSeleniumProperties(v string) *TestGridSession {
+	s.SeleniumProperties = &v
+	return s
+}
+
+// SetStatus sets the Status field's value.
+func (s *TestGridSession) SetStatus(v string) *TestGridSession {
+	s.Status = &v
+	return s
+}
+
+// An action taken by a TestGridSession browser instance.
+type TestGridSessionAction struct {
+	_ struct{} `type:"structure"`
+
+	// The action taken by the session.
+	Action *string `locationName:"action" type:"string"`
+
+	// The time, in milliseconds, that the action took to complete in the browser.
+	Duration *int64 `locationName:"duration" type:"long"`
+
+	// HTTP method that the browser used to make the request.
+	RequestMethod *string `locationName:"requestMethod" type:"string"`
+
+	// The time that the session invoked the action.
+	Started *time.Time `locationName:"started" type:"timestamp"`
+
+	// HTTP status code returned to the browser when the action was taken.
+	StatusCode *string `locationName:"statusCode" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TestGridSessionAction) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TestGridSessionAction) GoString() string {
+	return s.String()
+}
+
+// SetAction sets the Action field's value.
+func (s *TestGridSessionAction) SetAction(v string) *TestGridSessionAction {
+	s.Action = &v
+	return s
+}
+
+// SetDuration sets the Duration field's value.
+func (s *TestGridSessionAction) SetDuration(v int64) *TestGridSessionAction {
+	s.Duration = &v
+	return s
+}
+
+// SetRequestMethod sets the RequestMethod field's value.
+func (s *TestGridSessionAction) SetRequestMethod(v string) *TestGridSessionAction {
+	s.RequestMethod = &v
+	return s
+}
+
+// SetStarted sets the Started field's value.
+func (s *TestGridSessionAction) SetStarted(v time.Time) *TestGridSessionAction {
+	s.Started = &v
+	return s
+}
+
+// SetStatusCode sets the StatusCode field's value.
+func (s *TestGridSessionAction) SetStatusCode(v string) *TestGridSessionAction {
+	s.StatusCode = &v
+	return s
+}
+
+// Artifacts are video and other files that are produced in the process of running
+// a browser in an automated context.
+//
+// Video elements might be broken up into multiple artifacts as they grow in
+// size during creation.
+type TestGridSessionArtifact struct {
+	_ struct{} `type:"structure"`
+
+	// The file name of the artifact.
+	Filename *string `locationName:"filename" type:"string"`
+
+	// The kind of artifact.
+	Type *string `locationName:"type" type:"string" enum:"TestGridSessionArtifactType"`
+
+	// A semi-stable URL to the content of the object.
+	//
+	// Url is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by TestGridSessionArtifact's
+	// String and GoString methods.
+	Url *string `locationName:"url" type:"string" sensitive:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TestGridSessionArtifact) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TestGridSessionArtifact) GoString() string {
+	return s.String()
+}
+
+// SetFilename sets the Filename field's value.
+func (s *TestGridSessionArtifact) SetFilename(v string) *TestGridSessionArtifact {
+	s.Filename = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *TestGridSessionArtifact) SetType(v string) *TestGridSessionArtifact {
+	s.Type = &v
+	return s
+}
+
+// SetUrl sets the Url field's value.
+func (s *TestGridSessionArtifact) SetUrl(v string) *TestGridSessionArtifact {
+	s.Url = &v
+	return s
+}
+
+// The VPC security groups and subnets that are attached to a project.
+type TestGridVpcConfig struct {
+	_ struct{} `type:"structure"`
+
+	// A list of VPC security group IDs in your Amazon VPC.
+	//
+	// SecurityGroupIds is a required field
+	SecurityGroupIds []*string `locationName:"securityGroupIds" min:"1" type:"list" required:"true"`
+
+	// A list of VPC subnet IDs in your Amazon VPC.
+	//
+	// SubnetIds is a required field
+	SubnetIds []*string `locationName:"subnetIds" min:"1" type:"list" required:"true"`
+
+	// The ID of the Amazon VPC.
+	//
+	// VpcId is a required field
+	VpcId *string `locationName:"vpcId" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TestGridVpcConfig) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TestGridVpcConfig) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *TestGridVpcConfig) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "TestGridVpcConfig"}
+	if s.SecurityGroupIds == nil {
+		invalidParams.Add(request.NewErrParamRequired("SecurityGroupIds"))
+	}
+	if s.SecurityGroupIds != nil && len(s.SecurityGroupIds) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("SecurityGroupIds", 1))
+	}
+	if s.SubnetIds == nil {
+		invalidParams.Add(request.NewErrParamRequired("SubnetIds"))
+	}
+	if s.SubnetIds != nil && len(s.SubnetIds) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("SubnetIds", 1))
+	}
+	if s.VpcId == nil {
+		invalidParams.Add(request.NewErrParamRequired("VpcId"))
+	}
+	if s.VpcId != nil && len(*s.VpcId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VpcId", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetSecurityGroupIds sets the SecurityGroupIds field's value.
+func (s *TestGridVpcConfig) SetSecurityGroupIds(v []*string) *TestGridVpcConfig {
+	s.SecurityGroupIds = v
+	return s
+}
+
+// SetSubnetIds sets the SubnetIds field's value.
+func (s *TestGridVpcConfig) SetSubnetIds(v []*string) *TestGridVpcConfig {
+	s.SubnetIds = v
+	return s
+}
+
+// SetVpcId sets the VpcId field's value.
+func (s *TestGridVpcConfig) SetVpcId(v string) *TestGridVpcConfig {
+	s.VpcId = &v
+	return s
+}
+
+// The list of tags on the repository is over the limit. The maximum number
+// of tags that can be applied to a repository is 50.
+type TooManyTagsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+
+	ResourceName *string `locationName:"resourceName" min:"32" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyTagsException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyTagsException) GoString() string {
+	return s.String()
+}
+
+func newErrorTooManyTagsException(v protocol.ResponseMetadata) error {
+	return &TooManyTagsException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *TooManyTagsException) Code() string {
+	return "TooManyTagsException"
+}
+
+// Message returns the exception's message.
+func (s *TooManyTagsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *TooManyTagsException) OrigErr() error {
+	return nil
+}
+
+func (s *TooManyTagsException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *TooManyTagsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *TooManyTagsException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Represents information about free trial device minutes for an AWS account.
 type TrialMinutes struct {
 	_ struct{} `type:"structure"`
@@ -15808,12 +20396,20 @@ type TrialMinutes struct {
 	Total *float64 `locationName:"total" type:"double"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TrialMinutes) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TrialMinutes) GoString() string {
 	return s.String()
 }
@@ -15841,12 +20437,20 @@ type UniqueProblem struct {
 	Problems []*Problem `locationName:"problems" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UniqueProblem) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UniqueProblem) GoString() string {
 	return s.String()
 }
@@ -15865,11 +20469,11 @@ func (s *UniqueProblem) SetProblems(v []*Problem) *UniqueProblem {
 
 type UntagResourceInput struct {
 	_ struct{} `type:"structure"`
-
-	// The Amazon Resource Name (ARN) of the resource(s) from which to delete tags.
-	// You can associate tags with the following Device Farm resources: PROJECT,
-	// RUN, NETWORK_PROFILE, INSTANCE_PROFILE, DEVICE_INSTANCE, SESSION, DEVICE_POOL,
-	// DEVICE, and VPCE_CONFIGURATION.
+
+	// The Amazon Resource Name (ARN) of the resource or resources from which to
+	// delete tags. You can associate tags with the following Device Farm resources:
+	// PROJECT, RUN, NETWORK_PROFILE, INSTANCE_PROFILE, DEVICE_INSTANCE, SESSION,
+	// DEVICE_POOL, DEVICE, and VPCE_CONFIGURATION.
 	//
 	// ResourceARN is a required field
 	ResourceARN *string `min:"32" type:"string" required:"true"`
@@ -15880,12 +20484,20 @@ type UntagResourceInput struct {
 	TagKeys []*string `type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceInput) GoString() string {
 	return s.String()
 }
@@ -15925,12 +20537,20 @@ type UntagResourceOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceOutput) GoString() string {
 	return s.String()
 }
@@ -15946,17 +20566,24 @@ type UpdateDeviceInstanceInput struct {
 	// An array of strings that you want to associate with the device instance.
 	Labels []*string `locationName:"labels" type:"list"`
 
-	// The Amazon Resource Name (ARN) of the profile that you want to associate
-	// with the device instance.
+	// The ARN of the profile that you want to associate with the device instance.
 	ProfileArn *string `locationName:"profileArn" min:"32" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDeviceInstanceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDeviceInstanceInput) GoString() string {
 	return s.String()
 }
@@ -16001,16 +20628,24 @@ func (s *UpdateDeviceInstanceInput) SetProfileArn(v string) *UpdateDeviceInstanc
 type UpdateDeviceInstanceOutput struct {
 	_ struct{} `type:"structure"`
 
-	// An object containing information about your device instance.
+	// An object that contains information about your device instance.
 	DeviceInstance *DeviceInstance `locationName:"deviceInstance" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDeviceInstanceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDeviceInstanceOutput) GoString() string {
 	return s.String()
 }
@@ -16025,8 +20660,7 @@ func (s *UpdateDeviceInstanceOutput) SetDeviceInstance(v *DeviceInstance) *Updat
 type UpdateDevicePoolInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the Device Farm device pool you wish to
-	// update.
+	// The Amazon Resource Name (ARN) of the Device Farm device pool to update.
 	//
 	// Arn is a required field
 	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
@@ -16035,13 +20669,13 @@ type UpdateDevicePoolInput struct {
 	// set this parameter to true, the maxDevices parameter does not apply, and
 	// Device Farm does not limit the number of devices that it adds to your device
 	// pool. In this case, Device Farm adds all available devices that meet the
-	// criteria that are specified for the rules parameter.
+	// criteria specified in the rules parameter.
 	//
 	// If you use this parameter in your request, you cannot use the maxDevices
 	// parameter in the same request.
 	ClearMaxDevices *bool `locationName:"clearMaxDevices" type:"boolean"`
 
-	// A description of the device pool you wish to update.
+	// A description of the device pool to update.
 	Description *string `locationName:"description" type:"string"`
 
 	// The number of devices that Device Farm can add to your device pool. Device
@@ -16057,21 +20691,28 @@ type UpdateDevicePoolInput struct {
 	// parameter in the same request.
 	MaxDevices *int64 `locationName:"maxDevices" type:"integer"`
 
-	// A string representing the name of the device pool you wish to update.
+	// A string that represents the name of the device pool to update.
 	Name *string `locationName:"name" type:"string"`
 
-	// Represents the rules you wish to modify for the device pool. Updating rules
-	// is optional; however, if you choose to update rules for your request, the
-	// update will replace the existing rules.
+	// Represents the rules to modify for the device pool. Updating rules is optional.
+	// If you update rules for your request, the update replaces the existing rules.
 	Rules []*Rule `locationName:"rules" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDevicePoolInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDevicePoolInput) GoString() string {
 	return s.String()
 }
@@ -16136,12 +20777,20 @@ type UpdateDevicePoolOutput struct {
 	DevicePool *DevicePool `locationName:"devicePool" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDevicePoolOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDevicePoolOutput) GoString() string {
 	return s.String()
 }
@@ -16163,8 +20812,8 @@ type UpdateInstanceProfileInput struct {
 	// The updated description for your instance profile.
 	Description *string `locationName:"description" type:"string"`
 
-	// An array of strings specifying the list of app packages that should not be
-	// cleaned up from the device after a test run is over.
+	// An array of strings that specifies the list of app packages that should not
+	// be cleaned up from the device after a test run is over.
 	//
 	// The list of packages is only considered if you set packageCleanup to true.
 	ExcludeAppPackagesFromCleanup []*string `locationName:"excludeAppPackagesFromCleanup" type:"list"`
@@ -16181,12 +20830,20 @@ type UpdateInstanceProfileInput struct {
 	RebootAfterUse *bool `locationName:"rebootAfterUse" type:"boolean"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateInstanceProfileInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateInstanceProfileInput) GoString() string {
 	return s.String()
 }
@@ -16246,16 +20903,24 @@ func (s *UpdateInstanceProfileInput) SetRebootAfterUse(v bool) *UpdateInstancePr
 type UpdateInstanceProfileOutput struct {
 	_ struct{} `type:"structure"`
 
-	// An object containing information about your instance profile.
+	// An object that contains information about your instance profile.
 	InstanceProfile *InstanceProfile `locationName:"instanceProfile" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateInstanceProfileOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateInstanceProfileOutput) GoString() string {
 	return s.String()
 }
@@ -16295,8 +20960,8 @@ type UpdateNetworkProfileInput struct {
 	// The name of the network profile about which you are returning information.
 	Name *string `locationName:"name" type:"string"`
 
-	// The type of network profile you wish to return information about. Valid values
-	// are listed below.
+	// The type of network profile to return information about. Valid values are
+	// listed here.
 	Type *string `locationName:"type" type:"string" enum:"NetworkProfileType"`
 
 	// The data throughput rate in bits per second, as an integer from 0 to 104857600.
@@ -16314,12 +20979,20 @@ type UpdateNetworkProfileInput struct {
 	UplinkLossPercent *int64 `locationName:"uplinkLossPercent" type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateNetworkProfileInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateNetworkProfileInput) GoString() string {
 	return s.String()
 }
@@ -16419,12 +21092,20 @@ type UpdateNetworkProfileOutput struct {
 	NetworkProfile *NetworkProfile `locationName:"networkProfile" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateNetworkProfileOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateNetworkProfileOutput) GoString() string {
 	return s.String()
 }
@@ -16439,25 +21120,36 @@ func (s *UpdateNetworkProfileOutput) SetNetworkProfile(v *NetworkProfile) *Updat
 type UpdateProjectInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the project whose name you wish to update.
+	// The Amazon Resource Name (ARN) of the project whose name to update.
 	//
 	// Arn is a required field
 	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 
-	// The number of minutes a test run in the project will execute before it times
+	// The number of minutes a test run in the project executes before it times
 	// out.
 	DefaultJobTimeoutMinutes *int64 `locationName:"defaultJobTimeoutMinutes" type:"integer"`
 
-	// A string representing the new name of the project that you are updating.
+	// A string that represents the new name of the project that you are updating.
 	Name *string `locationName:"name" type:"string"`
+
+	// The VPC security groups and subnets that are attached to a project.
+	VpcConfig *VpcConfig `locationName:"vpcConfig" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProjectInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProjectInput) GoString() string {
 	return s.String()
 }
@@ -16471,6 +21163,11 @@ func (s *UpdateProjectInput) Validate() error {
 	if s.Arn != nil && len(*s.Arn) < 32 {
 		invalidParams.Add(request.NewErrParamMinLen("Arn", 32))
 	}
+	if s.VpcConfig != nil {
+		if err := s.VpcConfig.Validate(); err != nil {
+			invalidParams.AddNested("VpcConfig", err.(request.ErrInvalidParams))
+		}
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -16496,20 +21193,34 @@ func (s *UpdateProjectInput) SetName(v string) *UpdateProjectInput {
 	return s
 }
 
+// SetVpcConfig sets the VpcConfig field's value.
+func (s *UpdateProjectInput) SetVpcConfig(v *VpcConfig) *UpdateProjectInput {
+	s.VpcConfig = v
+	return s
+}
+
 // Represents the result of an update project request.
 type UpdateProjectOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The project you wish to update.
+	// The project to update.
 	Project *Project `locationName:"project" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProjectOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProjectOutput) GoString() string {
 	return s.String()
 }
@@ -16520,6 +21231,124 @@ func (s *UpdateProjectOutput) SetProject(v *Project) *UpdateProjectOutput {
 	return s
 }
 
+type UpdateTestGridProjectInput struct {
+	_ struct{} `type:"structure"`
+
+	// Human-readable description for the project.
+	Description *string `locationName:"description" min:"1" type:"string"`
+
+	// Human-readable name for the project.
+	Name *string `locationName:"name" min:"1" type:"string"`
+
+	// ARN of the project to update.
+	//
+	// ProjectArn is a required field
+	ProjectArn *string `locationName:"projectArn" min:"32" type:"string" required:"true"`
+
+	// The VPC security groups and subnets that are attached to a project.
+	VpcConfig *TestGridVpcConfig `locationName:"vpcConfig" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateTestGridProjectInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateTestGridProjectInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *UpdateTestGridProjectInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateTestGridProjectInput"}
+	if s.Description != nil && len(*s.Description) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Description", 1))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+	if s.ProjectArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ProjectArn"))
+	}
+	if s.ProjectArn != nil && len(*s.ProjectArn) < 32 {
+		invalidParams.Add(request.NewErrParamMinLen("ProjectArn", 32))
+	}
+	if s.VpcConfig != nil {
+		if err := s.VpcConfig.Validate(); err != nil {
+			invalidParams.AddNested("VpcConfig", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDescription sets the Description field's value.
+func (s *UpdateTestGridProjectInput) SetDescription(v string) *UpdateTestGridProjectInput {
+	s.Description = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *UpdateTestGridProjectInput) SetName(v string) *UpdateTestGridProjectInput {
+	s.Name = &v
+	return s
+}
+
+// SetProjectArn sets the ProjectArn field's value.
+func (s *UpdateTestGridProjectInput) SetProjectArn(v string) *UpdateTestGridProjectInput {
+	s.ProjectArn = &v
+	return s
+}
+
+// SetVpcConfig sets the VpcConfig field's value.
+func (s *UpdateTestGridProjectInput) SetVpcConfig(v *TestGridVpcConfig) *UpdateTestGridProjectInput {
+	s.VpcConfig = v
+	return s
+}
+
+type UpdateTestGridProjectOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The project, including updated information.
+	TestGridProject *TestGridProject `locationName:"testGridProject" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateTestGridProjectOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateTestGridProjectOutput) GoString() string {
+	return s.String()
+}
+
+// SetTestGridProject sets the TestGridProject field's value.
+func (s *UpdateTestGridProjectOutput) SetTestGridProject(v *TestGridProject) *UpdateTestGridProjectOutput {
+	s.TestGridProject = v
+	return s
+}
+
 type UpdateUploadInput struct {
 	_ struct{} `type:"structure"`
 
@@ -16528,24 +21357,32 @@ type UpdateUploadInput struct {
 	// Arn is a required field
 	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 
-	// The upload's content type (for example, "application/x-yaml").
+	// The upload's content type (for example, application/x-yaml).
 	ContentType *string `locationName:"contentType" type:"string"`
 
-	// Set to true if the YAML file has changed and needs to be updated; otherwise,
+	// Set to true if the YAML file has changed and must be updated. Otherwise,
 	// set to false.
 	EditContent *bool `locationName:"editContent" type:"boolean"`
 
-	// The upload's test spec file name. The name should not contain the '/' character.
-	// The test spec file name must end with the .yaml or .yml file extension.
+	// The upload's test spec file name. The name must not contain any forward slashes
+	// (/). The test spec file name must end with the .yaml or .yml file extension.
 	Name *string `locationName:"name" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateUploadInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateUploadInput) GoString() string {
 	return s.String()
 }
@@ -16597,12 +21434,20 @@ type UpdateUploadOutput struct {
 	Upload *Upload `locationName:"upload" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateUploadOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateUploadOutput) GoString() string {
 	return s.String()
 }
@@ -16622,28 +21467,36 @@ type UpdateVPCEConfigurationInput struct {
 	// Arn is a required field
 	Arn *string `locationName:"arn" min:"32" type:"string" required:"true"`
 
-	// The DNS (domain) name used to connect to your private service in your Amazon
-	// VPC. The DNS name must not already be in use on the Internet.
+	// The DNS (domain) name used to connect to your private service in your VPC.
+	// The DNS name must not already be in use on the internet.
 	ServiceDnsName *string `locationName:"serviceDnsName" type:"string"`
 
-	// An optional description, providing more details about your VPC endpoint configuration.
+	// An optional description that provides details about your VPC endpoint configuration.
 	VpceConfigurationDescription *string `locationName:"vpceConfigurationDescription" type:"string"`
 
-	// The friendly name you give to your VPC endpoint configuration, to manage
-	// your configurations more easily.
+	// The friendly name you give to your VPC endpoint configuration to manage your
+	// configurations more easily.
 	VpceConfigurationName *string `locationName:"vpceConfigurationName" type:"string"`
 
-	// The name of the VPC endpoint service running inside your AWS account that
-	// you want Device Farm to test.
+	// The name of the VPC endpoint service running in your AWS account that you
+	// want Device Farm to test.
 	VpceServiceName *string `locationName:"vpceServiceName" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateVPCEConfigurationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateVPCEConfigurationInput) GoString() string {
 	return s.String()
 }
@@ -16697,16 +21550,24 @@ func (s *UpdateVPCEConfigurationInput) SetVpceServiceName(v string) *UpdateVPCEC
 type UpdateVPCEConfigurationOutput struct {
 	_ struct{} `type:"structure"`
 
-	// An object containing information about your VPC endpoint configuration.
+	// An object that contains information about your VPC endpoint configuration.
 	VpceConfiguration *VPCEConfiguration `locationName:"vpceConfiguration" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateVPCEConfigurationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateVPCEConfigurationOutput) GoString() string {
 	return s.String()
 }
@@ -16731,7 +21592,7 @@ type Upload struct {
 	//    * PRIVATE: An upload managed by the AWS Device Farm customer.
 	Category *string `locationName:"category" type:"string" enum:"UploadCategory"`
 
-	// The upload's content type (for example, "application/octet-stream").
+	// The upload's content type (for example, application/octet-stream).
 	ContentType *string `locationName:"contentType" type:"string"`
 
 	// When the upload was created.
@@ -16752,106 +21613,106 @@ type Upload struct {
 	//
 	// Must be one of the following values:
 	//
-	//    * FAILED: A failed status.
+	//    * FAILED
 	//
-	//    * INITIALIZED: An initialized status.
+	//    * INITIALIZED
 	//
-	//    * PROCESSING: A processing status.
+	//    * PROCESSING
 	//
-	//    * SUCCEEDED: A succeeded status.
+	//    * SUCCEEDED
 	Status *string `locationName:"status" type:"string" enum:"UploadStatus"`
 
 	// The upload's type.
 	//
 	// Must be one of the following values:
 	//
-	//    * ANDROID_APP: An Android upload.
+	//    * ANDROID_APP
 	//
-	//    * IOS_APP: An iOS upload.
+	//    * IOS_APP
 	//
-	//    * WEB_APP: A web application upload.
+	//    * WEB_APP
 	//
-	//    * EXTERNAL_DATA: An external data upload.
+	//    * EXTERNAL_DATA
 	//
-	//    * APPIUM_JAVA_JUNIT_TEST_PACKAGE: An Appium Java JUnit test package upload.
+	//    * APPIUM_JAVA_JUNIT_TEST_PACKAGE
 	//
-	//    * APPIUM_JAVA_TESTNG_TEST_PACKAGE: An Appium Java TestNG test package
-	//    upload.
+	//    * APPIUM_JAVA_TESTNG_TEST_PACKAGE
 	//
-	//    * APPIUM_PYTHON_TEST_PACKAGE: An Appium Python test package upload.
+	//    * APPIUM_PYTHON_TEST_PACKAGE
 	//
-	//    * APPIUM_NODE_TEST_PACKAGE: An Appium Node.js test package upload.
+	//    * APPIUM_NODE_TEST_PACKAGE
 	//
-	//    * APPIUM_RUBY_TEST_PACKAGE: An Appium Ruby test package upload.
+	//    * APPIUM_RUBY_TEST_PACKAGE
 	//
-	//    * APPIUM_WEB_JAVA_JUNIT_TEST_PACKAGE: An Appium Java JUnit test package
-	//    upload for web apps.
+	//    * APPIUM_WEB_JAVA_JUNIT_TEST_PACKAGE
 	//
-	//    * APPIUM_WEB_JAVA_TESTNG_TEST_PACKAGE: An Appium Java TestNG test package
-	//    upload for web apps.
+	//    * APPIUM_WEB_JAVA_TESTNG_TEST_PACKAGE
 	//
-	//    * APPIUM_WEB_PYTHON_TEST_PACKAGE: An Appium Python test package upload
-	//    for web apps.
+	//    * APPIUM_WEB_PYTHON_TEST_PACKAGE
 	//
-	//    * APPIUM_WEB_NODE_TEST_PACKAGE: An Appium Node.js test package upload
-	//    for web apps.
+	//    * APPIUM_WEB_NODE_TEST_PACKAGE
 	//
-	//    * APPIUM_WEB_RUBY_TEST_PACKAGE: An Appium Ruby test package upload for
-	//    web apps.
+	//    * APPIUM_WEB_RUBY_TEST_PACKAGE
 	//
-	//    * CALABASH_TEST_PACKAGE: A Calabash test package upload.
+	//    * CALABASH_TEST_PACKAGE
 	//
-	//    * INSTRUMENTATION_TEST_PACKAGE: An instrumentation upload.
+	//    * INSTRUMENTATION_TEST_PACKAGE
 	//
-	//    * UIAUTOMATION_TEST_PACKAGE: A uiautomation test package upload.
+	//    * UIAUTOMATION_TEST_PACKAGE
 	//
-	//    * UIAUTOMATOR_TEST_PACKAGE: A uiautomator test package upload.
+	//    * UIAUTOMATOR_TEST_PACKAGE
 	//
-	//    * XCTEST_TEST_PACKAGE: An Xcode test package upload.
+	//    * XCTEST_TEST_PACKAGE
 	//
-	//    * XCTEST_UI_TEST_PACKAGE: An Xcode UI test package upload.
+	//    * XCTEST_UI_TEST_PACKAGE
 	//
-	//    * APPIUM_JAVA_JUNIT_TEST_SPEC: An Appium Java JUnit test spec upload.
+	//    * APPIUM_JAVA_JUNIT_TEST_SPEC
 	//
-	//    * APPIUM_JAVA_TESTNG_TEST_SPEC: An Appium Java TestNG test spec upload.
+	//    * APPIUM_JAVA_TESTNG_TEST_SPEC
 	//
-	//    * APPIUM_PYTHON_TEST_SPEC: An Appium Python test spec upload.
+	//    * APPIUM_PYTHON_TEST_SPEC
 	//
-	//    * APPIUM_NODE_TEST_SPEC: An Appium Node.js test spec upload.
+	//    * APPIUM_NODE_TEST_SPEC
 	//
-	//    * APPIUM_RUBY_TEST_SPEC: An Appium Ruby test spec upload.
+	//    * APPIUM_RUBY_TEST_SPEC
 	//
-	//    * APPIUM_WEB_JAVA_JUNIT_TEST_SPEC: An Appium Java JUnit test spec upload
-	//    for a web app.
+	//    * APPIUM_WEB_JAVA_JUNIT_TEST_SPEC
 	//
-	//    * APPIUM_WEB_JAVA_TESTNG_TEST_SPEC: An Appium Java TestNG test spec upload
-	//    for a web app.
+	//    * APPIUM_WEB_JAVA_TESTNG_TEST_SPEC
 	//
-	//    * APPIUM_WEB_PYTHON_TEST_SPEC: An Appium Python test spec upload for a
-	//    web app.
+	//    * APPIUM_WEB_PYTHON_TEST_SPEC
 	//
-	//    * APPIUM_WEB_NODE_TEST_SPEC: An Appium Node.js test spec upload for a
-	//    web app.
+	//    * APPIUM_WEB_NODE_TEST_SPEC
 	//
-	//    * APPIUM_WEB_RUBY_TEST_SPEC: An Appium Ruby test spec upload for a web
-	//    app.
+	//    * APPIUM_WEB_RUBY_TEST_SPEC
 	//
-	//    * INSTRUMENTATION_TEST_SPEC: An instrumentation test spec upload.
+	//    * INSTRUMENTATION_TEST_SPEC
 	//
-	//    * XCTEST_UI_TEST_SPEC: An Xcode UI test spec upload.
+	//    * XCTEST_UI_TEST_SPEC
 	Type *string `locationName:"type" type:"string" enum:"UploadType"`
 
-	// The pre-signed Amazon S3 URL that was used to store a file through a corresponding
-	// PUT request.
-	Url *string `locationName:"url" type:"string"`
+	// The presigned Amazon S3 URL that was used to store a file using a PUT request.
+	//
+	// Url is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by Upload's
+	// String and GoString methods.
+	Url *string `locationName:"url" type:"string" sensitive:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Upload) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Upload) GoString() string {
 	return s.String()
 }
@@ -16927,24 +21788,32 @@ type VPCEConfiguration struct {
 	// to access.
 	ServiceDnsName *string `locationName:"serviceDnsName" type:"string"`
 
-	// An optional description, providing more details about your VPC endpoint configuration.
+	// An optional description that provides details about your VPC endpoint configuration.
 	VpceConfigurationDescription *string `locationName:"vpceConfigurationDescription" type:"string"`
 
-	// The friendly name you give to your VPC endpoint configuration, to manage
-	// your configurations more easily.
+	// The friendly name you give to your VPC endpoint configuration to manage your
+	// configurations more easily.
 	VpceConfigurationName *string `locationName:"vpceConfigurationName" type:"string"`
 
-	// The name of the VPC endpoint service running inside your AWS account that
-	// you want Device Farm to test.
+	// The name of the VPC endpoint service running in your AWS account that you
+	// want Device Farm to test.
 	VpceServiceName *string `locationName:"vpceServiceName" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VPCEConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VPCEConfiguration) GoString() string {
 	return s.String()
 }
@@ -16979,6 +21848,91 @@ func (s *VPCEConfiguration) SetVpceServiceName(v string) *VPCEConfiguration {
 	return s
 }
 
+// Contains the VPC configuration data necessary to interface with AWS Device
+// Farm's services.
+type VpcConfig struct {
+	_ struct{} `type:"structure"`
+
+	// An array of one or more security groups IDs in your Amazon VPC.
+	//
+	// SecurityGroupIds is a required field
+	SecurityGroupIds []*string `locationName:"securityGroupIds" min:"1" type:"list" required:"true"`
+
+	// An array of one or more subnet IDs in your Amazon VPC.
+	//
+	// SubnetIds is a required field
+	SubnetIds []*string `locationName:"subnetIds" min:"1" type:"list" required:"true"`
+
+	// The ID of the Amazon VPC.
+	//
+	// VpcId is a required field
+	VpcId *string `locationName:"vpcId" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VpcConfig) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VpcConfig) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *VpcConfig) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VpcConfig"}
+	if s.SecurityGroupIds == nil {
+		invalidParams.Add(request.NewErrParamRequired("SecurityGroupIds"))
+	}
+	if s.SecurityGroupIds != nil && len(s.SecurityGroupIds) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("SecurityGroupIds", 1))
+	}
+	if s.SubnetIds == nil {
+		invalidParams.Add(request.NewErrParamRequired("SubnetIds"))
+	}
+	if s.SubnetIds != nil && len(s.SubnetIds) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("SubnetIds", 1))
+	}
+	if s.VpcId == nil {
+		invalidParams.Add(request.NewErrParamRequired("VpcId"))
+	}
+	if s.VpcId != nil && len(*s.VpcId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VpcId", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetSecurityGroupIds sets the SecurityGroupIds field's value.
+func (s *VpcConfig) SetSecurityGroupIds(v []*string) *VpcConfig {
+	s.SecurityGroupIds = v
+	return s
+}
+
+// SetSubnetIds sets the SubnetIds field's value.
+func (s *VpcConfig) SetSubnetIds(v []*string) *VpcConfig {
+	s.SubnetIds = v
+	return s
+}
+
+// SetVpcId sets the VpcId field's value.
+func (s *VpcConfig) SetVpcId(v string) *VpcConfig {
+	s.VpcId = &v
+	return s
+}
+
 const (
 	// ArtifactCategoryScreenshot is a ArtifactCategory enum value
 	ArtifactCategoryScreenshot = "SCREENSHOT"
@@ -16990,6 +21944,15 @@ const (
 	ArtifactCategoryLog = "LOG"
 )
 
+// ArtifactCategory_Values returns all elements of the ArtifactCategory enum
+func ArtifactCategory_Values() []string {
+	return []string{
+		ArtifactCategoryScreenshot,
+		ArtifactCategoryFile,
+		ArtifactCategoryLog,
+	}
+}
+
 const (
 	// ArtifactTypeUnknown is a ArtifactType enum value
 	ArtifactTypeUnknown = "UNKNOWN"
@@ -17076,6 +22039,40 @@ const (
 	ArtifactTypeTestspecOutput = "TESTSPEC_OUTPUT"
 )
 
+// ArtifactType_Values returns all elements of the ArtifactType enum
+func ArtifactType_Values() []string {
+	return []string{
+		ArtifactTypeUnknown,
+		ArtifactTypeScreenshot,
+		ArtifactTypeDeviceLog,
+		ArtifactTypeMessageLog,
+		ArtifactTypeVideoLog,
+		ArtifactTypeResultLog,
+		ArtifactTypeServiceLog,
+		ArtifactTypeWebkitLog,
+		ArtifactTypeInstrumentationOutput,
+		ArtifactTypeExerciserMonkeyOutput,
+		ArtifactTypeCalabashJsonOutput,
+		ArtifactTypeCalabashPrettyOutput,
+		ArtifactTypeCalabashStandardOutput,
+		ArtifactTypeCalabashJavaXmlOutput,
+		ArtifactTypeAutomationOutput,
+		ArtifactTypeAppiumServerOutput,
+		ArtifactTypeAppiumJavaOutput,
+		ArtifactTypeAppiumJavaXmlOutput,
+		ArtifactTypeAppiumPythonOutput,
+		ArtifactTypeAppiumPythonXmlOutput,
+		ArtifactTypeExplorerEventLog,
+		ArtifactTypeExplorerSummaryLog,
+		ArtifactTypeApplicationCrashReport,
+		ArtifactTypeXctestLog,
+		ArtifactTypeVideo,
+		ArtifactTypeCustomerArtifact,
+		ArtifactTypeCustomerArtifactLog,
+		ArtifactTypeTestspecOutput,
+	}
+}
+
 const (
 	// BillingMethodMetered is a BillingMethod enum value
 	BillingMethodMetered = "METERED"
@@ -17084,11 +22081,26 @@ const (
 	BillingMethodUnmetered = "UNMETERED"
 )
 
+// BillingMethod_Values returns all elements of the BillingMethod enum
+func BillingMethod_Values() []string {
+	return []string{
+		BillingMethodMetered,
+		BillingMethodUnmetered,
+	}
+}
+
 const (
 	// CurrencyCodeUsd is a CurrencyCode enum value
 	CurrencyCodeUsd = "USD"
 )
 
+// CurrencyCode_Values returns all elements of the CurrencyCode enum
+func CurrencyCode_Values() []string {
+	return []string{
+		CurrencyCodeUsd,
+	}
+}
+
 const (
 	// DeviceAttributeArn is a DeviceAttribute enum value
 	DeviceAttributeArn = "ARN"
@@ -17130,6 +22142,25 @@ const (
 	DeviceAttributeAvailability = "AVAILABILITY"
 )
 
+// DeviceAttribute_Values returns all elements of the DeviceAttribute enum
+func DeviceAttribute_Values() []string {
+	return []string{
+		DeviceAttributeArn,
+		DeviceAttributePlatform,
+		DeviceAttributeFormFactor,
+		DeviceAttributeManufacturer,
+		DeviceAttributeRemoteAccessEnabled,
+		DeviceAttributeRemoteDebugEnabled,
+		DeviceAttributeAppiumVersion,
+		DeviceAttributeInstanceArn,
+		DeviceAttributeInstanceLabels,
+		DeviceAttributeFleetType,
+		DeviceAttributeOsVersion,
+		DeviceAttributeModel,
+		DeviceAttributeAvailability,
+	}
+}
+
 const (
 	// DeviceAvailabilityTemporaryNotAvailable is a DeviceAvailability enum value
 	DeviceAvailabilityTemporaryNotAvailable = "TEMPORARY_NOT_AVAILABLE"
@@ -17144,6 +22175,16 @@ const (
 	DeviceAvailabilityHighlyAvailable = "HIGHLY_AVAILABLE"
 )
 
+// DeviceAvailability_Values returns all elements of the DeviceAvailability enum
+func DeviceAvailability_Values() []string {
+	return []string{
+		DeviceAvailabilityTemporaryNotAvailable,
+		DeviceAvailabilityBusy,
+		DeviceAvailabilityAvailable,
+		DeviceAvailabilityHighlyAvailable,
+	}
+}
+
 const (
 	// DeviceFilterAttributeArn is a DeviceFilterAttribute enum value
 	DeviceFilterAttributeArn = "ARN"
@@ -17182,6 +22223,24 @@ const (
 	DeviceFilterAttributeFleetType = "FLEET_TYPE"
 )
 
+// DeviceFilterAttribute_Values returns all elements of the DeviceFilterAttribute enum
+func DeviceFilterAttribute_Values() []string {
+	return []string{
+		DeviceFilterAttributeArn,
+		DeviceFilterAttributePlatform,
+		DeviceFilterAttributeOsVersion,
+		DeviceFilterAttributeModel,
+		DeviceFilterAttributeAvailability,
+		DeviceFilterAttributeFormFactor,
+		DeviceFilterAttributeManufacturer,
+		DeviceFilterAttributeRemoteAccessEnabled,
+		DeviceFilterAttributeRemoteDebugEnabled,
+		DeviceFilterAttributeInstanceArn,
+		DeviceFilterAttributeInstanceLabels,
+		DeviceFilterAttributeFleetType,
+	}
+}
+
 const (
 	// DeviceFormFactorPhone is a DeviceFormFactor enum value
 	DeviceFormFactorPhone = "PHONE"
@@ -17190,6 +22249,14 @@ const (
 	DeviceFormFactorTablet = "TABLET"
 )
 
+// DeviceFormFactor_Values returns all elements of the DeviceFormFactor enum
+func DeviceFormFactor_Values() []string {
+	return []string{
+		DeviceFormFactorPhone,
+		DeviceFormFactorTablet,
+	}
+}
+
 const (
 	// DevicePlatformAndroid is a DevicePlatform enum value
 	DevicePlatformAndroid = "ANDROID"
@@ -17198,6 +22265,14 @@ const (
 	DevicePlatformIos = "IOS"
 )
 
+// DevicePlatform_Values returns all elements of the DevicePlatform enum
+func DevicePlatform_Values() []string {
+	return []string{
+		DevicePlatformAndroid,
+		DevicePlatformIos,
+	}
+}
+
 const (
 	// DevicePoolTypeCurated is a DevicePoolType enum value
 	DevicePoolTypeCurated = "CURATED"
@@ -17206,6 +22281,14 @@ const (
 	DevicePoolTypePrivate = "PRIVATE"
 )
 
+// DevicePoolType_Values returns all elements of the DevicePoolType enum
+func DevicePoolType_Values() []string {
+	return []string{
+		DevicePoolTypeCurated,
+		DevicePoolTypePrivate,
+	}
+}
+
 const (
 	// ExecutionResultPending is a ExecutionResult enum value
 	ExecutionResultPending = "PENDING"
@@ -17229,6 +22312,19 @@ const (
 	ExecutionResultStopped = "STOPPED"
 )
 
+// ExecutionResult_Values returns all elements of the ExecutionResult enum
+func ExecutionResult_Values() []string {
+	return []string{
+		ExecutionResultPending,
+		ExecutionResultPassed,
+		ExecutionResultWarned,
+		ExecutionResultFailed,
+		ExecutionResultSkipped,
+		ExecutionResultErrored,
+		ExecutionResultStopped,
+	}
+}
+
 const (
 	// ExecutionResultCodeParsingFailed is a ExecutionResultCode enum value
 	ExecutionResultCodeParsingFailed = "PARSING_FAILED"
@@ -17237,6 +22333,14 @@ const (
 	ExecutionResultCodeVpcEndpointSetupFailed = "VPC_ENDPOINT_SETUP_FAILED"
 )
 
+// ExecutionResultCode_Values returns all elements of the ExecutionResultCode enum
+func ExecutionResultCode_Values() []string {
+	return []string{
+		ExecutionResultCodeParsingFailed,
+		ExecutionResultCodeVpcEndpointSetupFailed,
+	}
+}
+
 const (
 	// ExecutionStatusPending is a ExecutionStatus enum value
 	ExecutionStatusPending = "PENDING"
@@ -17266,6 +22370,21 @@ const (
 	ExecutionStatusStopping = "STOPPING"
 )
 
+// ExecutionStatus_Values returns all elements of the ExecutionStatus enum
+func ExecutionStatus_Values() []string {
+	return []string{
+		ExecutionStatusPending,
+		ExecutionStatusPendingConcurrency,
+		ExecutionStatusPendingDevice,
+		ExecutionStatusProcessing,
+		ExecutionStatusScheduling,
+		ExecutionStatusPreparing,
+		ExecutionStatusRunning,
+		ExecutionStatusCompleted,
+		ExecutionStatusStopping,
+	}
+}
+
 const (
 	// InstanceStatusInUse is a InstanceStatus enum value
 	InstanceStatusInUse = "IN_USE"
@@ -17280,6 +22399,16 @@ const (
 	InstanceStatusNotAvailable = "NOT_AVAILABLE"
 )
 
+// InstanceStatus_Values returns all elements of the InstanceStatus enum
+func InstanceStatus_Values() []string {
+	return []string{
+		InstanceStatusInUse,
+		InstanceStatusPreparing,
+		InstanceStatusAvailable,
+		InstanceStatusNotAvailable,
+	}
+}
+
 const (
 	// InteractionModeInteractive is a InteractionMode enum value
 	InteractionModeInteractive = "INTERACTIVE"
@@ -17291,6 +22420,15 @@ const (
 	InteractionModeVideoOnly = "VIDEO_ONLY"
 )
 
+// InteractionMode_Values returns all elements of the InteractionMode enum
+func InteractionMode_Values() []string {
+	return []string{
+		InteractionModeInteractive,
+		InteractionModeNoVideo,
+		InteractionModeVideoOnly,
+	}
+}
+
 const (
 	// NetworkProfileTypeCurated is a NetworkProfileType enum value
 	NetworkProfileTypeCurated = "CURATED"
@@ -17299,6 +22437,14 @@ const (
 	NetworkProfileTypePrivate = "PRIVATE"
 )
 
+// NetworkProfileType_Values returns all elements of the NetworkProfileType enum
+func NetworkProfileType_Values() []string {
+	return []string{
+		NetworkProfileTypeCurated,
+		NetworkProfileTypePrivate,
+	}
+}
+
 const (
 	// OfferingTransactionTypePurchase is a OfferingTransactionType enum value
 	OfferingTransactionTypePurchase = "PURCHASE"
@@ -17310,16 +22456,39 @@ const (
 	OfferingTransactionTypeSystem = "SYSTEM"
 )
 
+// OfferingTransactionType_Values returns all elements of the OfferingTransactionType enum
+func OfferingTransactionType_Values() []string {
+	return []string{
+		OfferingTransactionTypePurchase,
+		OfferingTransactionTypeRenew,
+		OfferingTransactionTypeSystem,
+	}
+}
+
 const (
 	// OfferingTypeRecurring is a OfferingType enum value
 	OfferingTypeRecurring = "RECURRING"
 )
 
+// OfferingType_Values returns all elements of the OfferingType enum
+func OfferingType_Values() []string {
+	return []string{
+		OfferingTypeRecurring,
+	}
+}
+
 const (
 	// RecurringChargeFrequencyMonthly is a RecurringChargeFrequency enum value
 	RecurringChargeFrequencyMonthly = "MONTHLY"
 )
 
+// RecurringChargeFrequency_Values returns all elements of the RecurringChargeFrequency enum
+func RecurringChargeFrequency_Values() []string {
+	return []string{
+		RecurringChargeFrequencyMonthly,
+	}
+}
+
 const (
 	// RuleOperatorEquals is a RuleOperator enum value
 	RuleOperatorEquals = "EQUALS"
@@ -17346,6 +22515,20 @@ const (
 	RuleOperatorContains = "CONTAINS"
 )
 
+// RuleOperator_Values returns all elements of the RuleOperator enum
+func RuleOperator_Values() []string {
+	return []string{
+		RuleOperatorEquals,
+		RuleOperatorLessThan,
+		RuleOperatorLessThanOrEquals,
+		RuleOperatorGreaterThan,
+		RuleOperatorGreaterThanOrEquals,
+		RuleOperatorIn,
+		RuleOperatorNotIn,
+		RuleOperatorContains,
+	}
+}
+
 const (
 	// SampleTypeCpu is a SampleType enum value
 	SampleTypeCpu = "CPU"
@@ -17399,6 +22582,85 @@ const (
 	SampleTypeOpenglMaxDrawtime = "OPENGL_MAX_DRAWTIME"
 )
 
+// SampleType_Values returns all elements of the SampleType enum
+func SampleType_Values() []string {
+	return []string{
+		SampleTypeCpu,
+		SampleTypeMemory,
+		SampleTypeThreads,
+		SampleTypeRxRate,
+		SampleTypeTxRate,
+		SampleTypeRx,
+		SampleTypeTx,
+		SampleTypeNativeFrames,
+		SampleTypeNativeFps,
+		SampleTypeNativeMinDrawtime,
+		SampleTypeNativeAvgDrawtime,
+		SampleTypeNativeMaxDrawtime,
+		SampleTypeOpenglFrames,
+		SampleTypeOpenglFps,
+		SampleTypeOpenglMinDrawtime,
+		SampleTypeOpenglAvgDrawtime,
+		SampleTypeOpenglMaxDrawtime,
+	}
+}
+
+const (
+	// TestGridSessionArtifactCategoryVideo is a TestGridSessionArtifactCategory enum value
+	TestGridSessionArtifactCategoryVideo = "VIDEO"
+
+	// TestGridSessionArtifactCategoryLog is a TestGridSessionArtifactCategory enum value
+	TestGridSessionArtifactCategoryLog = "LOG"
+)
+
+// TestGridSessionArtifactCategory_Values returns all elements of the TestGridSessionArtifactCategory enum
+func TestGridSessionArtifactCategory_Values() []string {
+	return []string{
+		TestGridSessionArtifactCategoryVideo,
+		TestGridSessionArtifactCategoryLog,
+	}
+}
+
+const (
+	// TestGridSessionArtifactTypeUnknown is a TestGridSessionArtifactType enum value
+	TestGridSessionArtifactTypeUnknown = "UNKNOWN"
+
+	// TestGridSessionArtifactTypeVideo is a TestGridSessionArtifactType enum value
+	TestGridSessionArtifactTypeVideo = "VIDEO"
+
+	// TestGridSessionArtifactTypeSeleniumLog is a TestGridSessionArtifactType enum value
+	TestGridSessionArtifactTypeSeleniumLog = "SELENIUM_LOG"
+)
+
+// TestGridSessionArtifactType_Values returns all elements of the TestGridSessionArtifactType enum
+func TestGridSessionArtifactType_Values() []string {
+	return []string{
+		TestGridSessionArtifactTypeUnknown,
+		TestGridSessionArtifactTypeVideo,
+		TestGridSessionArtifactTypeSeleniumLog,
+	}
+}
+
+const (
+	// TestGridSessionStatusActive is a TestGridSessionStatus enum value
+	TestGridSessionStatusActive = "ACTIVE"
+
+	// TestGridSessionStatusClosed is a TestGridSessionStatus enum value
+	TestGridSessionStatusClosed = "CLOSED"
+
+	// TestGridSessionStatusErrored is a TestGridSessionStatus enum value
+	TestGridSessionStatusErrored = "ERRORED"
+)
+
+// TestGridSessionStatus_Values returns all elements of the TestGridSessionStatus enum
+func TestGridSessionStatus_Values() []string {
+	return []string{
+		TestGridSessionStatusActive,
+		TestGridSessionStatusClosed,
+		TestGridSessionStatusErrored,
+	}
+}
+
 const (
 	// TestTypeBuiltinFuzz is a TestType enum value
 	TestTypeBuiltinFuzz = "BUILTIN_FUZZ"
@@ -17464,6 +22726,33 @@ const (
 	TestTypeRemoteAccessReplay = "REMOTE_ACCESS_REPLAY"
 )
 
+// TestType_Values returns all elements of the TestType enum
+func TestType_Values() []string {
+	return []string{
+		TestTypeBuiltinFuzz,
+		TestTypeBuiltinExplorer,
+		TestTypeWebPerformanceProfile,
+		TestTypeAppiumJavaJunit,
+		TestTypeAppiumJavaTestng,
+		TestTypeAppiumPython,
+		TestTypeAppiumNode,
+		TestTypeAppiumRuby,
+		TestTypeAppiumWebJavaJunit,
+		TestTypeAppiumWebJavaTestng,
+		TestTypeAppiumWebPython,
+		TestTypeAppiumWebNode,
+		TestTypeAppiumWebRuby,
+		TestTypeCalabash,
+		TestTypeInstrumentation,
+		TestTypeUiautomation,
+		TestTypeUiautomator,
+		TestTypeXctest,
+		TestTypeXctestUi,
+		TestTypeRemoteAccessRecord,
+		TestTypeRemoteAccessReplay,
+	}
+}
+
 const (
 	// UploadCategoryCurated is a UploadCategory enum value
 	UploadCategoryCurated = "CURATED"
@@ -17472,6 +22761,14 @@ const (
 	UploadCategoryPrivate = "PRIVATE"
 )
 
+// UploadCategory_Values returns all elements of the UploadCategory enum
+func UploadCategory_Values() []string {
+	return []string{
+		UploadCategoryCurated,
+		UploadCategoryPrivate,
+	}
+}
+
 const (
 	// UploadStatusInitialized is a UploadStatus enum value
 	UploadStatusInitialized = "INITIALIZED"
@@ -17486,6 +22783,16 @@ const (
 	UploadStatusFailed = "FAILED"
 )
 
+// UploadStatus_Values returns all elements of the UploadStatus enum
+func UploadStatus_Values() []string {
+	return []string{
+		UploadStatusInitialized,
+		UploadStatusProcessing,
+		UploadStatusSucceeded,
+		UploadStatusFailed,
+	}
+}
+
 const (
 	// UploadTypeAndroidApp is a UploadType enum value
 	UploadTypeAndroidApp = "ANDROID_APP"
@@ -17583,3 +22890,41 @@ const (
 	// UploadTypeXctestUiTestSpec is a UploadType enum value
 	UploadTypeXctestUiTestSpec = "XCTEST_UI_TEST_SPEC"
 )
+
+// UploadType_Values returns all elements of the UploadType enum
+func UploadType_Values() []string {
+	return []string{
+		UploadTypeAndroidApp,
+		UploadTypeIosApp,
+		UploadTypeWebApp,
+		UploadTypeExternalData,
+		UploadTypeAppiumJavaJunitTestPackage,
+		UploadTypeAppiumJavaTestngTestPackage,
+		UploadTypeAppiumPythonTestPackage,
+		UploadTypeAppiumNodeTestPackage,
+		UploadTypeAppiumRubyTestPackage,
+		UploadTypeAppiumWebJavaJunitTestPackage,
+		UploadTypeAppiumWebJavaTestngTestPackage,
+		UploadTypeAppiumWebPythonTestPackage,
+		UploadTypeAppiumWebNodeTestPackage,
+		UploadTypeAppiumWebRubyTestPackage,
+		UploadTypeCalabashTestPackage,
+		UploadTypeInstrumentationTestPackage,
+		UploadTypeUiautomationTestPackage,
+		UploadTypeUiautomatorTestPackage,
+		UploadTypeXctestTestPackage,
+		UploadTypeXctestUiTestPackage,
+		UploadTypeAppiumJavaJunitTestSpec,
+		UploadTypeAppiumJavaTestngTestSpec,
+		UploadTypeAppiumPythonTestSpec,
+		UploadTypeAppiumNodeTestSpec,
+		UploadTypeAppiumRubyTestSpec,
+		UploadTypeAppiumWebJavaJunitTestSpec,
+		UploadTypeAppiumWebJavaTestngTestSpec,
+		UploadTypeAppiumWebPythonTestSpec,
+		UploadTypeAppiumWebNodeTestSpec,
+		UploadTypeAppiumWebRubyTestSpec,
+		UploadTypeInstrumentationTestSpec,
+		UploadTypeXctestUiTestSpec,
+	}
+}
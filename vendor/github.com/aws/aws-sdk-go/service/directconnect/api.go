@@ -29,14 +29,13 @@ const opAcceptDirectConnectGatewayAssociationProposal = "AcceptDirectConnectGate
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AcceptDirectConnectGatewayAssociationProposalRequest method.
+//	req, resp := client.AcceptDirectConnectGatewayAssociationProposalRequest(params)
 //
-//    // Example sending a request using the AcceptDirectConnectGatewayAssociationProposalRequest method.
-//    req, resp := client.AcceptDirectConnectGatewayAssociationProposalRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/AcceptDirectConnectGatewayAssociationProposal
 func (c *DirectConnect) AcceptDirectConnectGatewayAssociationProposalRequest(input *AcceptDirectConnectGatewayAssociationProposalInput) (req *request.Request, output *AcceptDirectConnectGatewayAssociationProposalOutput) {
@@ -67,12 +66,13 @@ func (c *DirectConnect) AcceptDirectConnectGatewayAssociationProposalRequest(inp
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation AcceptDirectConnectGatewayAssociationProposal for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/AcceptDirectConnectGatewayAssociationProposal
 func (c *DirectConnect) AcceptDirectConnectGatewayAssociationProposal(input *AcceptDirectConnectGatewayAssociationProposalInput) (*AcceptDirectConnectGatewayAssociationProposalOutput, error) {
@@ -112,14 +112,13 @@ const opAllocateConnectionOnInterconnect = "AllocateConnectionOnInterconnect"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AllocateConnectionOnInterconnectRequest method.
+//	req, resp := client.AllocateConnectionOnInterconnectRequest(params)
 //
-//    // Example sending a request using the AllocateConnectionOnInterconnectRequest method.
-//    req, resp := client.AllocateConnectionOnInterconnectRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/AllocateConnectionOnInterconnect
 //
@@ -152,7 +151,7 @@ func (c *DirectConnect) AllocateConnectionOnInterconnectRequest(input *AllocateC
 // Allocates a VLAN number and a specified amount of bandwidth for use by a
 // hosted connection on the specified interconnect.
 //
-// Intended for use by AWS Direct Connect Partners only.
+// Intended for use by Direct Connect Partners only.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -161,12 +160,13 @@ func (c *DirectConnect) AllocateConnectionOnInterconnectRequest(input *AllocateC
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation AllocateConnectionOnInterconnect for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/AllocateConnectionOnInterconnect
 //
@@ -210,14 +210,13 @@ const opAllocateHostedConnection = "AllocateHostedConnection"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AllocateHostedConnectionRequest method.
+//	req, resp := client.AllocateHostedConnectionRequest(params)
 //
-//    // Example sending a request using the AllocateHostedConnectionRequest method.
-//    req, resp := client.AllocateHostedConnectionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/AllocateHostedConnection
 func (c *DirectConnect) AllocateHostedConnectionRequest(input *AllocateHostedConnectionInput) (req *request.Request, output *Connection) {
@@ -243,11 +242,11 @@ func (c *DirectConnect) AllocateHostedConnectionRequest(input *AllocateHostedCon
 //
 // Allocates a VLAN number and a specified amount of capacity (bandwidth) for
 // use by a hosted connection on the specified interconnect or LAG of interconnects.
-// AWS polices the hosted connection for the specified capacity and the AWS
-// Direct Connect Partner must also police the hosted connection for the specified
-// capacity.
+// Amazon Web Services polices the hosted connection for the specified capacity
+// and the Direct Connect Partner must also police the hosted connection for
+// the specified capacity.
 //
-// Intended for use by AWS Direct Connect Partners only.
+// Intended for use by Direct Connect Partners only.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -256,18 +255,19 @@ func (c *DirectConnect) AllocateHostedConnectionRequest(input *AllocateHostedCon
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation AllocateHostedConnection for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeDuplicateTagKeysException "DuplicateTagKeysException"
-//   A tag key was specified more than once.
+// Returned Error Types:
+//
+//   - DuplicateTagKeysException
+//     A tag key was specified more than once.
 //
-//   * ErrCodeTooManyTagsException "TooManyTagsException"
-//   You have reached the limit on the number of tags that can be assigned.
+//   - TooManyTagsException
+//     You have reached the limit on the number of tags that can be assigned.
 //
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/AllocateHostedConnection
 func (c *DirectConnect) AllocateHostedConnection(input *AllocateHostedConnectionInput) (*Connection, error) {
@@ -307,14 +307,13 @@ const opAllocatePrivateVirtualInterface = "AllocatePrivateVirtualInterface"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AllocatePrivateVirtualInterfaceRequest method.
+//	req, resp := client.AllocatePrivateVirtualInterfaceRequest(params)
 //
-//    // Example sending a request using the AllocatePrivateVirtualInterfaceRequest method.
-//    req, resp := client.AllocatePrivateVirtualInterfaceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/AllocatePrivateVirtualInterface
 func (c *DirectConnect) AllocatePrivateVirtualInterfaceRequest(input *AllocatePrivateVirtualInterfaceInput) (req *request.Request, output *VirtualInterface) {
@@ -335,7 +334,8 @@ func (c *DirectConnect) AllocatePrivateVirtualInterfaceRequest(input *AllocatePr
 
 // AllocatePrivateVirtualInterface API operation for AWS Direct Connect.
 //
-// Provisions a private virtual interface to be owned by the specified AWS account.
+// Provisions a private virtual interface to be owned by the specified Amazon
+// Web Services account.
 //
 // Virtual interfaces created using this action must be confirmed by the owner
 // using ConfirmPrivateVirtualInterface. Until then, the virtual interface is
@@ -348,18 +348,19 @@ func (c *DirectConnect) AllocatePrivateVirtualInterfaceRequest(input *AllocatePr
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation AllocatePrivateVirtualInterface for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeDuplicateTagKeysException "DuplicateTagKeysException"
-//   A tag key was specified more than once.
+// Returned Error Types:
+//
+//   - DuplicateTagKeysException
+//     A tag key was specified more than once.
 //
-//   * ErrCodeTooManyTagsException "TooManyTagsException"
-//   You have reached the limit on the number of tags that can be assigned.
+//   - TooManyTagsException
+//     You have reached the limit on the number of tags that can be assigned.
 //
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/AllocatePrivateVirtualInterface
 func (c *DirectConnect) AllocatePrivateVirtualInterface(input *AllocatePrivateVirtualInterfaceInput) (*VirtualInterface, error) {
@@ -399,14 +400,13 @@ const opAllocatePublicVirtualInterface = "AllocatePublicVirtualInterface"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AllocatePublicVirtualInterfaceRequest method.
+//	req, resp := client.AllocatePublicVirtualInterfaceRequest(params)
 //
-//    // Example sending a request using the AllocatePublicVirtualInterfaceRequest method.
-//    req, resp := client.AllocatePublicVirtualInterfaceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/AllocatePublicVirtualInterface
 func (c *DirectConnect) AllocatePublicVirtualInterfaceRequest(input *AllocatePublicVirtualInterfaceInput) (req *request.Request, output *VirtualInterface) {
@@ -427,10 +427,11 @@ func (c *DirectConnect) AllocatePublicVirtualInterfaceRequest(input *AllocatePub
 
 // AllocatePublicVirtualInterface API operation for AWS Direct Connect.
 //
-// Provisions a public virtual interface to be owned by the specified AWS account.
+// Provisions a public virtual interface to be owned by the specified Amazon
+// Web Services account.
 //
 // The owner of a connection calls this function to provision a public virtual
-// interface to be owned by the specified AWS account.
+// interface to be owned by the specified Amazon Web Services account.
 //
 // Virtual interfaces created using this function must be confirmed by the owner
 // using ConfirmPublicVirtualInterface. Until this step has been completed,
@@ -448,18 +449,19 @@ func (c *DirectConnect) AllocatePublicVirtualInterfaceRequest(input *AllocatePub
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation AllocatePublicVirtualInterface for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeDuplicateTagKeysException "DuplicateTagKeysException"
-//   A tag key was specified more than once.
+// Returned Error Types:
+//
+//   - DuplicateTagKeysException
+//     A tag key was specified more than once.
 //
-//   * ErrCodeTooManyTagsException "TooManyTagsException"
-//   You have reached the limit on the number of tags that can be assigned.
+//   - TooManyTagsException
+//     You have reached the limit on the number of tags that can be assigned.
 //
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/AllocatePublicVirtualInterface
 func (c *DirectConnect) AllocatePublicVirtualInterface(input *AllocatePublicVirtualInterfaceInput) (*VirtualInterface, error) {
@@ -499,14 +501,13 @@ const opAllocateTransitVirtualInterface = "AllocateTransitVirtualInterface"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AllocateTransitVirtualInterfaceRequest method.
+//	req, resp := client.AllocateTransitVirtualInterfaceRequest(params)
 //
-//    // Example sending a request using the AllocateTransitVirtualInterfaceRequest method.
-//    req, resp := client.AllocateTransitVirtualInterfaceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/AllocateTransitVirtualInterface
 func (c *DirectConnect) AllocateTransitVirtualInterfaceRequest(input *AllocateTransitVirtualInterfaceInput) (req *request.Request, output *AllocateTransitVirtualInterfaceOutput) {
@@ -527,12 +528,12 @@ func (c *DirectConnect) AllocateTransitVirtualInterfaceRequest(input *AllocateTr
 
 // AllocateTransitVirtualInterface API operation for AWS Direct Connect.
 //
-// Provisions a transit virtual interface to be owned by the specified AWS account.
-// Use this type of interface to connect a transit gateway to your Direct Connect
-// gateway.
+// Provisions a transit virtual interface to be owned by the specified Amazon
+// Web Services account. Use this type of interface to connect a transit gateway
+// to your Direct Connect gateway.
 //
 // The owner of a connection provisions a transit virtual interface to be owned
-// by the specified AWS account.
+// by the specified Amazon Web Services account.
 //
 // After you create a transit virtual interface, it must be confirmed by the
 // owner using ConfirmTransitVirtualInterface. Until this step has been completed,
@@ -546,18 +547,19 @@ func (c *DirectConnect) AllocateTransitVirtualInterfaceRequest(input *AllocateTr
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation AllocateTransitVirtualInterface for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeDuplicateTagKeysException "DuplicateTagKeysException"
-//   A tag key was specified more than once.
+// Returned Error Types:
+//
+//   - DuplicateTagKeysException
+//     A tag key was specified more than once.
 //
-//   * ErrCodeTooManyTagsException "TooManyTagsException"
-//   You have reached the limit on the number of tags that can be assigned.
+//   - TooManyTagsException
+//     You have reached the limit on the number of tags that can be assigned.
 //
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/AllocateTransitVirtualInterface
 func (c *DirectConnect) AllocateTransitVirtualInterface(input *AllocateTransitVirtualInterfaceInput) (*AllocateTransitVirtualInterfaceOutput, error) {
@@ -597,14 +599,13 @@ const opAssociateConnectionWithLag = "AssociateConnectionWithLag"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AssociateConnectionWithLagRequest method.
+//	req, resp := client.AssociateConnectionWithLagRequest(params)
 //
-//    // Example sending a request using the AssociateConnectionWithLagRequest method.
-//    req, resp := client.AssociateConnectionWithLagRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/AssociateConnectionWithLag
 func (c *DirectConnect) AssociateConnectionWithLagRequest(input *AssociateConnectionWithLagInput) (req *request.Request, output *Connection) {
@@ -627,12 +628,12 @@ func (c *DirectConnect) AssociateConnectionWithLagRequest(input *AssociateConnec
 //
 // Associates an existing connection with a link aggregation group (LAG). The
 // connection is interrupted and re-established as a member of the LAG (connectivity
-// to AWS is interrupted). The connection must be hosted on the same AWS Direct
-// Connect endpoint as the LAG, and its bandwidth must match the bandwidth for
-// the LAG. You can re-associate a connection that's currently associated with
-// a different LAG; however, if removing the connection would cause the original
-// LAG to fall below its setting for minimum number of operational connections,
-// the request fails.
+// to Amazon Web Services is interrupted). The connection must be hosted on
+// the same Direct Connect endpoint as the LAG, and its bandwidth must match
+// the bandwidth for the LAG. You can re-associate a connection that's currently
+// associated with a different LAG; however, if removing the connection would
+// cause the original LAG to fall below its setting for minimum number of operational
+// connections, the request fails.
 //
 // Any virtual interfaces that are directly associated with the connection are
 // automatically re-associated with the LAG. If the connection was originally
@@ -650,12 +651,13 @@ func (c *DirectConnect) AssociateConnectionWithLagRequest(input *AssociateConnec
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation AssociateConnectionWithLag for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/AssociateConnectionWithLag
 func (c *DirectConnect) AssociateConnectionWithLag(input *AssociateConnectionWithLagInput) (*Connection, error) {
@@ -695,14 +697,13 @@ const opAssociateHostedConnection = "AssociateHostedConnection"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AssociateHostedConnectionRequest method.
+//	req, resp := client.AssociateHostedConnectionRequest(params)
 //
-//    // Example sending a request using the AssociateHostedConnectionRequest method.
-//    req, resp := client.AssociateHostedConnectionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/AssociateHostedConnection
 func (c *DirectConnect) AssociateHostedConnectionRequest(input *AssociateHostedConnectionInput) (req *request.Request, output *Connection) {
@@ -727,9 +728,9 @@ func (c *DirectConnect) AssociateHostedConnectionRequest(input *AssociateHostedC
 // group (LAG) or interconnect. If the target interconnect or LAG has an existing
 // hosted connection with a conflicting VLAN number or IP address, the operation
 // fails. This action temporarily interrupts the hosted connection's connectivity
-// to AWS as it is being migrated.
+// to Amazon Web Services as it is being migrated.
 //
-// Intended for use by AWS Direct Connect Partners only.
+// Intended for use by Direct Connect Partners only.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -738,12 +739,13 @@ func (c *DirectConnect) AssociateHostedConnectionRequest(input *AssociateHostedC
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation AssociateHostedConnection for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/AssociateHostedConnection
 func (c *DirectConnect) AssociateHostedConnection(input *AssociateHostedConnectionInput) (*Connection, error) {
@@ -767,6 +769,96 @@ func (c *DirectConnect) AssociateHostedConnectionWithContext(ctx aws.Context, in
 	return out, req.Send()
 }
 
+const opAssociateMacSecKey = "AssociateMacSecKey"
+
+// AssociateMacSecKeyRequest generates a "aws/request.Request" representing the
+// client's request for the AssociateMacSecKey operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See AssociateMacSecKey for more information on using the AssociateMacSecKey
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the AssociateMacSecKeyRequest method.
+//	req, resp := client.AssociateMacSecKeyRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/AssociateMacSecKey
+func (c *DirectConnect) AssociateMacSecKeyRequest(input *AssociateMacSecKeyInput) (req *request.Request, output *AssociateMacSecKeyOutput) {
+	op := &request.Operation{
+		Name:       opAssociateMacSecKey,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &AssociateMacSecKeyInput{}
+	}
+
+	output = &AssociateMacSecKeyOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// AssociateMacSecKey API operation for AWS Direct Connect.
+//
+// Associates a MAC Security (MACsec) Connection Key Name (CKN)/ Connectivity
+// Association Key (CAK) pair with an Direct Connect dedicated connection.
+//
+// You must supply either the secretARN, or the CKN/CAK (ckn and cak) pair in
+// the request.
+//
+// For information about MAC Security (MACsec) key considerations, see MACsec
+// pre-shared CKN/CAK key considerations (https://docs.aws.amazon.com/directconnect/latest/UserGuide/direct-connect-mac-sec-getting-started.html#mac-sec-key-consideration)
+// in the Direct Connect User Guide.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Direct Connect's
+// API operation AssociateMacSecKey for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/AssociateMacSecKey
+func (c *DirectConnect) AssociateMacSecKey(input *AssociateMacSecKeyInput) (*AssociateMacSecKeyOutput, error) {
+	req, out := c.AssociateMacSecKeyRequest(input)
+	return out, req.Send()
+}
+
+// AssociateMacSecKeyWithContext is the same as AssociateMacSecKey with the addition of
+// the ability to pass a context and additional request options.
+//
+// See AssociateMacSecKey for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectConnect) AssociateMacSecKeyWithContext(ctx aws.Context, input *AssociateMacSecKeyInput, opts ...request.Option) (*AssociateMacSecKeyOutput, error) {
+	req, out := c.AssociateMacSecKeyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opAssociateVirtualInterface = "AssociateVirtualInterface"
 
 // AssociateVirtualInterfaceRequest generates a "aws/request.Request" representing the
@@ -783,14 +875,13 @@ const opAssociateVirtualInterface = "AssociateVirtualInterface"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AssociateVirtualInterfaceRequest method.
+//	req, resp := client.AssociateVirtualInterfaceRequest(params)
 //
-//    // Example sending a request using the AssociateVirtualInterfaceRequest method.
-//    req, resp := client.AssociateVirtualInterfaceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/AssociateVirtualInterface
 func (c *DirectConnect) AssociateVirtualInterfaceRequest(input *AssociateVirtualInterfaceInput) (req *request.Request, output *VirtualInterface) {
@@ -812,10 +903,10 @@ func (c *DirectConnect) AssociateVirtualInterfaceRequest(input *AssociateVirtual
 // AssociateVirtualInterface API operation for AWS Direct Connect.
 //
 // Associates a virtual interface with a specified link aggregation group (LAG)
-// or connection. Connectivity to AWS is temporarily interrupted as the virtual
-// interface is being migrated. If the target connection or LAG has an associated
-// virtual interface with a conflicting VLAN number or a conflicting IP address,
-// the operation fails.
+// or connection. Connectivity to Amazon Web Services is temporarily interrupted
+// as the virtual interface is being migrated. If the target connection or LAG
+// has an associated virtual interface with a conflicting VLAN number or a conflicting
+// IP address, the operation fails.
 //
 // Virtual interfaces associated with a hosted connection cannot be associated
 // with a LAG; hosted connections must be migrated along with their virtual
@@ -833,12 +924,13 @@ func (c *DirectConnect) AssociateVirtualInterfaceRequest(input *AssociateVirtual
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation AssociateVirtualInterface for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/AssociateVirtualInterface
 func (c *DirectConnect) AssociateVirtualInterface(input *AssociateVirtualInterfaceInput) (*VirtualInterface, error) {
@@ -878,14 +970,13 @@ const opConfirmConnection = "ConfirmConnection"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ConfirmConnectionRequest method.
+//	req, resp := client.ConfirmConnectionRequest(params)
 //
-//    // Example sending a request using the ConfirmConnectionRequest method.
-//    req, resp := client.ConfirmConnectionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/ConfirmConnection
 func (c *DirectConnect) ConfirmConnectionRequest(input *ConfirmConnectionInput) (req *request.Request, output *ConfirmConnectionOutput) {
@@ -919,12 +1010,13 @@ func (c *DirectConnect) ConfirmConnectionRequest(input *ConfirmConnectionInput)
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation ConfirmConnection for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/ConfirmConnection
 func (c *DirectConnect) ConfirmConnection(input *ConfirmConnectionInput) (*ConfirmConnectionOutput, error) {
@@ -948,6 +1040,89 @@ func (c *DirectConnect) ConfirmConnectionWithContext(ctx aws.Context, input *Con
 	return out, req.Send()
 }
 
+const opConfirmCustomerAgreement = "ConfirmCustomerAgreement"
+
+// ConfirmCustomerAgreementRequest generates a "aws/request.Request" representing the
+// client's request for the ConfirmCustomerAgreement operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ConfirmCustomerAgreement for more information on using the ConfirmCustomerAgreement
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ConfirmCustomerAgreementRequest method.
+//	req, resp := client.ConfirmCustomerAgreementRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/ConfirmCustomerAgreement
+func (c *DirectConnect) ConfirmCustomerAgreementRequest(input *ConfirmCustomerAgreementInput) (req *request.Request, output *ConfirmCustomerAgreementOutput) {
+	op := &request.Operation{
+		Name:       opConfirmCustomerAgreement,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &ConfirmCustomerAgreementInput{}
+	}
+
+	output = &ConfirmCustomerAgreementOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ConfirmCustomerAgreement API operation for AWS Direct Connect.
+//
+// The confirmation of the terms of agreement when creating the connection/link
+// aggregation group (LAG).
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Direct Connect's
+// API operation ConfirmCustomerAgreement for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/ConfirmCustomerAgreement
+func (c *DirectConnect) ConfirmCustomerAgreement(input *ConfirmCustomerAgreementInput) (*ConfirmCustomerAgreementOutput, error) {
+	req, out := c.ConfirmCustomerAgreementRequest(input)
+	return out, req.Send()
+}
+
+// ConfirmCustomerAgreementWithContext is the same as ConfirmCustomerAgreement with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ConfirmCustomerAgreement for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectConnect) ConfirmCustomerAgreementWithContext(ctx aws.Context, input *ConfirmCustomerAgreementInput, opts ...request.Option) (*ConfirmCustomerAgreementOutput, error) {
+	req, out := c.ConfirmCustomerAgreementRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opConfirmPrivateVirtualInterface = "ConfirmPrivateVirtualInterface"
 
 // ConfirmPrivateVirtualInterfaceRequest generates a "aws/request.Request" representing the
@@ -964,14 +1139,13 @@ const opConfirmPrivateVirtualInterface = "ConfirmPrivateVirtualInterface"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ConfirmPrivateVirtualInterfaceRequest method.
+//	req, resp := client.ConfirmPrivateVirtualInterfaceRequest(params)
 //
-//    // Example sending a request using the ConfirmPrivateVirtualInterfaceRequest method.
-//    req, resp := client.ConfirmPrivateVirtualInterfaceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/ConfirmPrivateVirtualInterface
 func (c *DirectConnect) ConfirmPrivateVirtualInterfaceRequest(input *ConfirmPrivateVirtualInterfaceInput) (req *request.Request, output *ConfirmPrivateVirtualInterfaceOutput) {
@@ -992,7 +1166,8 @@ func (c *DirectConnect) ConfirmPrivateVirtualInterfaceRequest(input *ConfirmPriv
 
 // ConfirmPrivateVirtualInterface API operation for AWS Direct Connect.
 //
-// Accepts ownership of a private virtual interface created by another AWS account.
+// Accepts ownership of a private virtual interface created by another Amazon
+// Web Services account.
 //
 // After the virtual interface owner makes this call, the virtual interface
 // is created and attached to the specified virtual private gateway or Direct
@@ -1005,12 +1180,13 @@ func (c *DirectConnect) ConfirmPrivateVirtualInterfaceRequest(input *ConfirmPriv
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation ConfirmPrivateVirtualInterface for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/ConfirmPrivateVirtualInterface
 func (c *DirectConnect) ConfirmPrivateVirtualInterface(input *ConfirmPrivateVirtualInterfaceInput) (*ConfirmPrivateVirtualInterfaceOutput, error) {
@@ -1050,14 +1226,13 @@ const opConfirmPublicVirtualInterface = "ConfirmPublicVirtualInterface"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ConfirmPublicVirtualInterfaceRequest method.
+//	req, resp := client.ConfirmPublicVirtualInterfaceRequest(params)
 //
-//    // Example sending a request using the ConfirmPublicVirtualInterfaceRequest method.
-//    req, resp := client.ConfirmPublicVirtualInterfaceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/ConfirmPublicVirtualInterface
 func (c *DirectConnect) ConfirmPublicVirtualInterfaceRequest(input *ConfirmPublicVirtualInterfaceInput) (req *request.Request, output *ConfirmPublicVirtualInterfaceOutput) {
@@ -1078,7 +1253,8 @@ func (c *DirectConnect) ConfirmPublicVirtualInterfaceRequest(input *ConfirmPubli
 
 // ConfirmPublicVirtualInterface API operation for AWS Direct Connect.
 //
-// Accepts ownership of a public virtual interface created by another AWS account.
+// Accepts ownership of a public virtual interface created by another Amazon
+// Web Services account.
 //
 // After the virtual interface owner makes this call, the specified virtual
 // interface is created and made available to handle traffic.
@@ -1090,12 +1266,13 @@ func (c *DirectConnect) ConfirmPublicVirtualInterfaceRequest(input *ConfirmPubli
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation ConfirmPublicVirtualInterface for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/ConfirmPublicVirtualInterface
 func (c *DirectConnect) ConfirmPublicVirtualInterface(input *ConfirmPublicVirtualInterfaceInput) (*ConfirmPublicVirtualInterfaceOutput, error) {
@@ -1135,14 +1312,13 @@ const opConfirmTransitVirtualInterface = "ConfirmTransitVirtualInterface"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ConfirmTransitVirtualInterfaceRequest method.
+//	req, resp := client.ConfirmTransitVirtualInterfaceRequest(params)
 //
-//    // Example sending a request using the ConfirmTransitVirtualInterfaceRequest method.
-//    req, resp := client.ConfirmTransitVirtualInterfaceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/ConfirmTransitVirtualInterface
 func (c *DirectConnect) ConfirmTransitVirtualInterfaceRequest(input *ConfirmTransitVirtualInterfaceInput) (req *request.Request, output *ConfirmTransitVirtualInterfaceOutput) {
@@ -1163,7 +1339,8 @@ func (c *DirectConnect) ConfirmTransitVirtualInterfaceRequest(input *ConfirmTran
 
 // ConfirmTransitVirtualInterface API operation for AWS Direct Connect.
 //
-// Accepts ownership of a transit virtual interface created by another AWS account.
+// Accepts ownership of a transit virtual interface created by another Amazon
+// Web Services account.
 //
 // After the owner of the transit virtual interface makes this call, the specified
 // transit virtual interface is created and made available to handle traffic.
@@ -1175,12 +1352,13 @@ func (c *DirectConnect) ConfirmTransitVirtualInterfaceRequest(input *ConfirmTran
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation ConfirmTransitVirtualInterface for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/ConfirmTransitVirtualInterface
 func (c *DirectConnect) ConfirmTransitVirtualInterface(input *ConfirmTransitVirtualInterfaceInput) (*ConfirmTransitVirtualInterfaceOutput, error) {
@@ -1220,14 +1398,13 @@ const opCreateBGPPeer = "CreateBGPPeer"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateBGPPeerRequest method.
+//	req, resp := client.CreateBGPPeerRequest(params)
 //
-//    // Example sending a request using the CreateBGPPeerRequest method.
-//    req, resp := client.CreateBGPPeerRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/CreateBGPPeer
 func (c *DirectConnect) CreateBGPPeerRequest(input *CreateBGPPeerInput) (req *request.Request, output *CreateBGPPeerOutput) {
@@ -1251,7 +1428,8 @@ func (c *DirectConnect) CreateBGPPeerRequest(input *CreateBGPPeerInput) (req *re
 // Creates a BGP peer on the specified virtual interface.
 //
 // You must create a BGP peer for the corresponding address family (IPv4/IPv6)
-// in order to access AWS resources that also use that address family.
+// in order to access Amazon Web Services resources that also use that address
+// family.
 //
 // If logical redundancy is not supported by the connection, interconnect, or
 // LAG, the BGP peer cannot be in the same address family as an existing BGP
@@ -1261,8 +1439,15 @@ func (c *DirectConnect) CreateBGPPeerRequest(input *CreateBGPPeerInput) (req *re
 // IPv6 addresses are automatically assigned from the Amazon pool of IPv6 addresses;
 // you cannot specify custom IPv6 addresses.
 //
+// If you let Amazon Web Services auto-assign IPv4 addresses, a /30 CIDR will
+// be allocated from 169.254.0.0/16. Amazon Web Services does not recommend
+// this option if you intend to use the customer router peer IP address as the
+// source and destination for traffic. Instead you should use RFC 1918 or other
+// addressing, and specify the address yourself. For more information about
+// RFC 1918 see Address Allocation for Private Internets (https://datatracker.ietf.org/doc/html/rfc1918).
+//
 // For a public virtual interface, the Autonomous System Number (ASN) must be
-// private or already whitelisted for the virtual interface.
+// private or already on the allow list for the virtual interface.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1271,12 +1456,13 @@ func (c *DirectConnect) CreateBGPPeerRequest(input *CreateBGPPeerInput) (req *re
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation CreateBGPPeer for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/CreateBGPPeer
 func (c *DirectConnect) CreateBGPPeer(input *CreateBGPPeerInput) (*CreateBGPPeerOutput, error) {
@@ -1316,14 +1502,13 @@ const opCreateConnection = "CreateConnection"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateConnectionRequest method.
+//	req, resp := client.CreateConnectionRequest(params)
 //
-//    // Example sending a request using the CreateConnectionRequest method.
-//    req, resp := client.CreateConnectionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/CreateConnection
 func (c *DirectConnect) CreateConnectionRequest(input *CreateConnectionInput) (req *request.Request, output *Connection) {
@@ -1344,18 +1529,18 @@ func (c *DirectConnect) CreateConnectionRequest(input *CreateConnectionInput) (r
 
 // CreateConnection API operation for AWS Direct Connect.
 //
-// Creates a connection between a customer network and a specific AWS Direct
-// Connect location.
+// Creates a connection between a customer network and a specific Direct Connect
+// location.
 //
-// A connection links your internal network to an AWS Direct Connect location
-// over a standard Ethernet fiber-optic cable. One end of the cable is connected
-// to your router, the other to an AWS Direct Connect router.
+// A connection links your internal network to an Direct Connect location over
+// a standard Ethernet fiber-optic cable. One end of the cable is connected
+// to your router, the other to an Direct Connect router.
 //
 // To find the locations for your Region, use DescribeLocations.
 //
 // You can automatically add the new connection to a link aggregation group
 // (LAG) by specifying a LAG ID in the request. This ensures that the new connection
-// is allocated on the same AWS Direct Connect endpoint that hosts the specified
+// is allocated on the same Direct Connect endpoint that hosts the specified
 // LAG. If there are no available ports on the endpoint, the request fails and
 // no connection is created.
 //
@@ -1366,18 +1551,19 @@ func (c *DirectConnect) CreateConnectionRequest(input *CreateConnectionInput) (r
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation CreateConnection for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeDuplicateTagKeysException "DuplicateTagKeysException"
-//   A tag key was specified more than once.
+// Returned Error Types:
 //
-//   * ErrCodeTooManyTagsException "TooManyTagsException"
-//   You have reached the limit on the number of tags that can be assigned.
+//   - DuplicateTagKeysException
+//     A tag key was specified more than once.
 //
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+//   - TooManyTagsException
+//     You have reached the limit on the number of tags that can be assigned.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/CreateConnection
 func (c *DirectConnect) CreateConnection(input *CreateConnectionInput) (*Connection, error) {
@@ -1417,14 +1603,13 @@ const opCreateDirectConnectGateway = "CreateDirectConnectGateway"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateDirectConnectGatewayRequest method.
+//	req, resp := client.CreateDirectConnectGatewayRequest(params)
 //
-//    // Example sending a request using the CreateDirectConnectGatewayRequest method.
-//    req, resp := client.CreateDirectConnectGatewayRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/CreateDirectConnectGateway
 func (c *DirectConnect) CreateDirectConnectGatewayRequest(input *CreateDirectConnectGatewayInput) (req *request.Request, output *CreateDirectConnectGatewayOutput) {
@@ -1447,11 +1632,12 @@ func (c *DirectConnect) CreateDirectConnectGatewayRequest(input *CreateDirectCon
 //
 // Creates a Direct Connect gateway, which is an intermediate object that enables
 // you to connect a set of virtual interfaces and virtual private gateways.
-// A Direct Connect gateway is global and visible in any AWS Region after it
-// is created. The virtual interfaces and virtual private gateways that are
-// connected through a Direct Connect gateway can be in different AWS Regions.
-// This enables you to connect to a VPC in any Region, regardless of the Region
-// in which the virtual interfaces are located, and pass traffic between them.
+// A Direct Connect gateway is global and visible in any Amazon Web Services
+// Region after it is created. The virtual interfaces and virtual private gateways
+// that are connected through a Direct Connect gateway can be in different Amazon
+// Web Services Regions. This enables you to connect to a VPC in any Region,
+// regardless of the Region in which the virtual interfaces are located, and
+// pass traffic between them.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1460,12 +1646,13 @@ func (c *DirectConnect) CreateDirectConnectGatewayRequest(input *CreateDirectCon
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation CreateDirectConnectGateway for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/CreateDirectConnectGateway
 func (c *DirectConnect) CreateDirectConnectGateway(input *CreateDirectConnectGatewayInput) (*CreateDirectConnectGatewayOutput, error) {
@@ -1505,14 +1692,13 @@ const opCreateDirectConnectGatewayAssociation = "CreateDirectConnectGatewayAssoc
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateDirectConnectGatewayAssociationRequest method.
+//	req, resp := client.CreateDirectConnectGatewayAssociationRequest(params)
 //
-//    // Example sending a request using the CreateDirectConnectGatewayAssociationRequest method.
-//    req, resp := client.CreateDirectConnectGatewayAssociationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/CreateDirectConnectGatewayAssociation
 func (c *DirectConnect) CreateDirectConnectGatewayAssociationRequest(input *CreateDirectConnectGatewayAssociationInput) (req *request.Request, output *CreateDirectConnectGatewayAssociationOutput) {
@@ -1544,12 +1730,13 @@ func (c *DirectConnect) CreateDirectConnectGatewayAssociationRequest(input *Crea
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation CreateDirectConnectGatewayAssociation for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/CreateDirectConnectGatewayAssociation
 func (c *DirectConnect) CreateDirectConnectGatewayAssociation(input *CreateDirectConnectGatewayAssociationInput) (*CreateDirectConnectGatewayAssociationOutput, error) {
@@ -1589,14 +1776,13 @@ const opCreateDirectConnectGatewayAssociationProposal = "CreateDirectConnectGate
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateDirectConnectGatewayAssociationProposalRequest method.
+//	req, resp := client.CreateDirectConnectGatewayAssociationProposalRequest(params)
 //
-//    // Example sending a request using the CreateDirectConnectGatewayAssociationProposalRequest method.
-//    req, resp := client.CreateDirectConnectGatewayAssociationProposalRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/CreateDirectConnectGatewayAssociationProposal
 func (c *DirectConnect) CreateDirectConnectGatewayAssociationProposalRequest(input *CreateDirectConnectGatewayAssociationProposalInput) (req *request.Request, output *CreateDirectConnectGatewayAssociationProposalOutput) {
@@ -1620,10 +1806,8 @@ func (c *DirectConnect) CreateDirectConnectGatewayAssociationProposalRequest(inp
 // Creates a proposal to associate the specified virtual private gateway or
 // transit gateway with the specified Direct Connect gateway.
 //
-// You can only associate a Direct Connect gateway and virtual private gateway
-// or transit gateway when the account that owns the Direct Connect gateway
-// and the account that owns the virtual private gateway or transit gateway
-// have the same AWS Payer ID.
+// You can associate a Direct Connect gateway and virtual private gateway or
+// transit gateway that is owned by any Amazon Web Services account.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1632,12 +1816,13 @@ func (c *DirectConnect) CreateDirectConnectGatewayAssociationProposalRequest(inp
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation CreateDirectConnectGatewayAssociationProposal for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/CreateDirectConnectGatewayAssociationProposal
 func (c *DirectConnect) CreateDirectConnectGatewayAssociationProposal(input *CreateDirectConnectGatewayAssociationProposalInput) (*CreateDirectConnectGatewayAssociationProposalOutput, error) {
@@ -1677,14 +1862,13 @@ const opCreateInterconnect = "CreateInterconnect"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateInterconnectRequest method.
+//	req, resp := client.CreateInterconnectRequest(params)
 //
-//    // Example sending a request using the CreateInterconnectRequest method.
-//    req, resp := client.CreateInterconnectRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/CreateInterconnect
 func (c *DirectConnect) CreateInterconnectRequest(input *CreateInterconnectInput) (req *request.Request, output *Interconnect) {
@@ -1705,29 +1889,29 @@ func (c *DirectConnect) CreateInterconnectRequest(input *CreateInterconnectInput
 
 // CreateInterconnect API operation for AWS Direct Connect.
 //
-// Creates an interconnect between an AWS Direct Connect Partner's network and
-// a specific AWS Direct Connect location.
+// Creates an interconnect between an Direct Connect Partner's network and a
+// specific Direct Connect location.
 //
 // An interconnect is a connection that is capable of hosting other connections.
-// The AWS Direct Connect partner can use an interconnect to provide AWS Direct
-// Connect hosted connections to customers through their own network services.
-// Like a standard connection, an interconnect links the partner's network to
-// an AWS Direct Connect location over a standard Ethernet fiber-optic cable.
-// One end is connected to the partner's router, the other to an AWS Direct
-// Connect router.
+// The Direct Connect Partner can use an interconnect to provide Direct Connect
+// hosted connections to customers through their own network services. Like
+// a standard connection, an interconnect links the partner's network to an
+// Direct Connect location over a standard Ethernet fiber-optic cable. One end
+// is connected to the partner's router, the other to an Direct Connect router.
 //
 // You can automatically add the new interconnect to a link aggregation group
 // (LAG) by specifying a LAG ID in the request. This ensures that the new interconnect
-// is allocated on the same AWS Direct Connect endpoint that hosts the specified
+// is allocated on the same Direct Connect endpoint that hosts the specified
 // LAG. If there are no available ports on the endpoint, the request fails and
 // no interconnect is created.
 //
-// For each end customer, the AWS Direct Connect Partner provisions a connection
+// For each end customer, the Direct Connect Partner provisions a connection
 // on their interconnect by calling AllocateHostedConnection. The end customer
-// can then connect to AWS resources by creating a virtual interface on their
-// connection, using the VLAN assigned to them by the AWS Direct Connect Partner.
+// can then connect to Amazon Web Services resources by creating a virtual interface
+// on their connection, using the VLAN assigned to them by the Direct Connect
+// Partner.
 //
-// Intended for use by AWS Direct Connect Partners only.
+// Intended for use by Direct Connect Partners only.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1736,18 +1920,19 @@ func (c *DirectConnect) CreateInterconnectRequest(input *CreateInterconnectInput
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation CreateInterconnect for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeDuplicateTagKeysException "DuplicateTagKeysException"
-//   A tag key was specified more than once.
+// Returned Error Types:
 //
-//   * ErrCodeTooManyTagsException "TooManyTagsException"
-//   You have reached the limit on the number of tags that can be assigned.
+//   - DuplicateTagKeysException
+//     A tag key was specified more than once.
 //
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+//   - TooManyTagsException
+//     You have reached the limit on the number of tags that can be assigned.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/CreateInterconnect
 func (c *DirectConnect) CreateInterconnect(input *CreateInterconnectInput) (*Interconnect, error) {
@@ -1787,14 +1972,13 @@ const opCreateLag = "CreateLag"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateLagRequest method.
+//	req, resp := client.CreateLagRequest(params)
 //
-//    // Example sending a request using the CreateLagRequest method.
-//    req, resp := client.CreateLagRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/CreateLag
 func (c *DirectConnect) CreateLagRequest(input *CreateLagInput) (req *request.Request, output *Lag) {
@@ -1816,30 +2000,30 @@ func (c *DirectConnect) CreateLagRequest(input *CreateLagInput) (req *request.Re
 // CreateLag API operation for AWS Direct Connect.
 //
 // Creates a link aggregation group (LAG) with the specified number of bundled
-// physical connections between the customer network and a specific AWS Direct
-// Connect location. A LAG is a logical interface that uses the Link Aggregation
-// Control Protocol (LACP) to aggregate multiple interfaces, enabling you to
-// treat them as a single interface.
+// physical dedicated connections between the customer network and a specific
+// Direct Connect location. A LAG is a logical interface that uses the Link
+// Aggregation Control Protocol (LACP) to aggregate multiple interfaces, enabling
+// you to treat them as a single interface.
 //
-// All connections in a LAG must use the same bandwidth and must terminate at
-// the same AWS Direct Connect endpoint.
+// All connections in a LAG must use the same bandwidth (either 1Gbps or 10Gbps)
+// and must terminate at the same Direct Connect endpoint.
 //
-// You can have up to 10 connections per LAG. Regardless of this limit, if you
-// request more connections for the LAG than AWS Direct Connect can allocate
+// You can have up to 10 dedicated connections per LAG. Regardless of this limit,
+// if you request more connections for the LAG than Direct Connect can allocate
 // on a single endpoint, no LAG is created.
 //
-// You can specify an existing physical connection or interconnect to include
-// in the LAG (which counts towards the total number of connections). Doing
-// so interrupts the current physical connection or hosted connections, and
-// re-establishes them as a member of the LAG. The LAG will be created on the
-// same AWS Direct Connect endpoint to which the connection terminates. Any
-// virtual interfaces associated with the connection are automatically disassociated
+// You can specify an existing physical dedicated connection or interconnect
+// to include in the LAG (which counts towards the total number of connections).
+// Doing so interrupts the current physical dedicated connection, and re-establishes
+// them as a member of the LAG. The LAG will be created on the same Direct Connect
+// endpoint to which the dedicated connection terminates. Any virtual interfaces
+// associated with the dedicated connection are automatically disassociated
 // and re-associated with the LAG. The connection ID does not change.
 //
-// If the AWS account used to create a LAG is a registered AWS Direct Connect
-// Partner, the LAG is automatically enabled to host sub-connections. For a
-// LAG owned by a partner, any associated virtual interfaces cannot be directly
-// configured.
+// If the Amazon Web Services account used to create a LAG is a registered Direct
+// Connect Partner, the LAG is automatically enabled to host sub-connections.
+// For a LAG owned by a partner, any associated virtual interfaces cannot be
+// directly configured.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1848,18 +2032,19 @@ func (c *DirectConnect) CreateLagRequest(input *CreateLagInput) (req *request.Re
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation CreateLag for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeDuplicateTagKeysException "DuplicateTagKeysException"
-//   A tag key was specified more than once.
+// Returned Error Types:
 //
-//   * ErrCodeTooManyTagsException "TooManyTagsException"
-//   You have reached the limit on the number of tags that can be assigned.
+//   - DuplicateTagKeysException
+//     A tag key was specified more than once.
 //
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+//   - TooManyTagsException
+//     You have reached the limit on the number of tags that can be assigned.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/CreateLag
 func (c *DirectConnect) CreateLag(input *CreateLagInput) (*Lag, error) {
@@ -1899,14 +2084,13 @@ const opCreatePrivateVirtualInterface = "CreatePrivateVirtualInterface"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreatePrivateVirtualInterfaceRequest method.
+//	req, resp := client.CreatePrivateVirtualInterfaceRequest(params)
 //
-//    // Example sending a request using the CreatePrivateVirtualInterfaceRequest method.
-//    req, resp := client.CreatePrivateVirtualInterfaceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/CreatePrivateVirtualInterface
 func (c *DirectConnect) CreatePrivateVirtualInterfaceRequest(input *CreatePrivateVirtualInterfaceInput) (req *request.Request, output *VirtualInterface) {
@@ -1928,12 +2112,19 @@ func (c *DirectConnect) CreatePrivateVirtualInterfaceRequest(input *CreatePrivat
 // CreatePrivateVirtualInterface API operation for AWS Direct Connect.
 //
 // Creates a private virtual interface. A virtual interface is the VLAN that
-// transports AWS Direct Connect traffic. A private virtual interface can be
-// connected to either a Direct Connect gateway or a Virtual Private Gateway
-// (VGW). Connecting the private virtual interface to a Direct Connect gateway
-// enables the possibility for connecting to multiple VPCs, including VPCs in
-// different AWS Regions. Connecting the private virtual interface to a VGW
-// only provides access to a single VPC within the same Region.
+// transports Direct Connect traffic. A private virtual interface can be connected
+// to either a Direct Connect gateway or a Virtual Private Gateway (VGW). Connecting
+// the private virtual interface to a Direct Connect gateway enables the possibility
+// for connecting to multiple VPCs, including VPCs in different Amazon Web Services
+// Regions. Connecting the private virtual interface to a VGW only provides
+// access to a single VPC within the same Region.
+//
+// Setting the MTU of a virtual interface to 9001 (jumbo frames) can cause an
+// update to the underlying physical connection if it wasn't updated to support
+// jumbo frames. Updating the connection disrupts network connectivity for all
+// virtual interfaces associated with the connection for up to 30 seconds. To
+// check whether your connection supports jumbo frames, call DescribeConnections.
+// To check whether your virtual interface supports jumbo frames, call DescribeVirtualInterfaces.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1942,18 +2133,19 @@ func (c *DirectConnect) CreatePrivateVirtualInterfaceRequest(input *CreatePrivat
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation CreatePrivateVirtualInterface for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeDuplicateTagKeysException "DuplicateTagKeysException"
-//   A tag key was specified more than once.
+// Returned Error Types:
+//
+//   - DuplicateTagKeysException
+//     A tag key was specified more than once.
 //
-//   * ErrCodeTooManyTagsException "TooManyTagsException"
-//   You have reached the limit on the number of tags that can be assigned.
+//   - TooManyTagsException
+//     You have reached the limit on the number of tags that can be assigned.
 //
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/CreatePrivateVirtualInterface
 func (c *DirectConnect) CreatePrivateVirtualInterface(input *CreatePrivateVirtualInterfaceInput) (*VirtualInterface, error) {
@@ -1993,14 +2185,13 @@ const opCreatePublicVirtualInterface = "CreatePublicVirtualInterface"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreatePublicVirtualInterfaceRequest method.
+//	req, resp := client.CreatePublicVirtualInterfaceRequest(params)
 //
-//    // Example sending a request using the CreatePublicVirtualInterfaceRequest method.
-//    req, resp := client.CreatePublicVirtualInterfaceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/CreatePublicVirtualInterface
 func (c *DirectConnect) CreatePublicVirtualInterfaceRequest(input *CreatePublicVirtualInterfaceInput) (req *request.Request, output *VirtualInterface) {
@@ -2022,8 +2213,8 @@ func (c *DirectConnect) CreatePublicVirtualInterfaceRequest(input *CreatePublicV
 // CreatePublicVirtualInterface API operation for AWS Direct Connect.
 //
 // Creates a public virtual interface. A virtual interface is the VLAN that
-// transports AWS Direct Connect traffic. A public virtual interface supports
-// sending traffic to public services of AWS such as Amazon S3.
+// transports Direct Connect traffic. A public virtual interface supports sending
+// traffic to public services of Amazon Web Services such as Amazon S3.
 //
 // When creating an IPv6 public virtual interface (addressFamily is ipv6), leave
 // the customer and amazon address fields blank to use auto-assigned IPv6 space.
@@ -2036,18 +2227,19 @@ func (c *DirectConnect) CreatePublicVirtualInterfaceRequest(input *CreatePublicV
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation CreatePublicVirtualInterface for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeDuplicateTagKeysException "DuplicateTagKeysException"
-//   A tag key was specified more than once.
+// Returned Error Types:
+//
+//   - DuplicateTagKeysException
+//     A tag key was specified more than once.
 //
-//   * ErrCodeTooManyTagsException "TooManyTagsException"
-//   You have reached the limit on the number of tags that can be assigned.
+//   - TooManyTagsException
+//     You have reached the limit on the number of tags that can be assigned.
 //
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/CreatePublicVirtualInterface
 func (c *DirectConnect) CreatePublicVirtualInterface(input *CreatePublicVirtualInterfaceInput) (*VirtualInterface, error) {
@@ -2087,14 +2279,13 @@ const opCreateTransitVirtualInterface = "CreateTransitVirtualInterface"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateTransitVirtualInterfaceRequest method.
+//	req, resp := client.CreateTransitVirtualInterfaceRequest(params)
 //
-//    // Example sending a request using the CreateTransitVirtualInterfaceRequest method.
-//    req, resp := client.CreateTransitVirtualInterfaceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/CreateTransitVirtualInterface
 func (c *DirectConnect) CreateTransitVirtualInterfaceRequest(input *CreateTransitVirtualInterfaceInput) (req *request.Request, output *CreateTransitVirtualInterfaceOutput) {
@@ -2126,6 +2317,14 @@ func (c *DirectConnect) CreateTransitVirtualInterfaceRequest(input *CreateTransi
 // 64512 for both your the transit gateway and Direct Connect gateway, the association
 // request fails.
 //
+// A jumbo MTU value must be either 1500 or 8500. No other values will be accepted.
+// Setting the MTU of a virtual interface to 8500 (jumbo frames) can cause an
+// update to the underlying physical connection if it wasn't updated to support
+// jumbo frames. Updating the connection disrupts network connectivity for all
+// virtual interfaces associated with the connection for up to 30 seconds. To
+// check whether your connection supports jumbo frames, call DescribeConnections.
+// To check whether your virtual interface supports jumbo frames, call DescribeVirtualInterfaces.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -2133,18 +2332,19 @@ func (c *DirectConnect) CreateTransitVirtualInterfaceRequest(input *CreateTransi
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation CreateTransitVirtualInterface for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeDuplicateTagKeysException "DuplicateTagKeysException"
-//   A tag key was specified more than once.
+// Returned Error Types:
+//
+//   - DuplicateTagKeysException
+//     A tag key was specified more than once.
 //
-//   * ErrCodeTooManyTagsException "TooManyTagsException"
-//   You have reached the limit on the number of tags that can be assigned.
+//   - TooManyTagsException
+//     You have reached the limit on the number of tags that can be assigned.
 //
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/CreateTransitVirtualInterface
 func (c *DirectConnect) CreateTransitVirtualInterface(input *CreateTransitVirtualInterfaceInput) (*CreateTransitVirtualInterfaceOutput, error) {
@@ -2184,14 +2384,13 @@ const opDeleteBGPPeer = "DeleteBGPPeer"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteBGPPeerRequest method.
+//	req, resp := client.DeleteBGPPeerRequest(params)
 //
-//    // Example sending a request using the DeleteBGPPeerRequest method.
-//    req, resp := client.DeleteBGPPeerRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DeleteBGPPeer
 func (c *DirectConnect) DeleteBGPPeerRequest(input *DeleteBGPPeerInput) (req *request.Request, output *DeleteBGPPeerOutput) {
@@ -2224,12 +2423,13 @@ func (c *DirectConnect) DeleteBGPPeerRequest(input *DeleteBGPPeerInput) (req *re
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DeleteBGPPeer for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DeleteBGPPeer
 func (c *DirectConnect) DeleteBGPPeer(input *DeleteBGPPeerInput) (*DeleteBGPPeerOutput, error) {
@@ -2269,14 +2469,13 @@ const opDeleteConnection = "DeleteConnection"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteConnectionRequest method.
+//	req, resp := client.DeleteConnectionRequest(params)
 //
-//    // Example sending a request using the DeleteConnectionRequest method.
-//    req, resp := client.DeleteConnectionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DeleteConnection
 func (c *DirectConnect) DeleteConnectionRequest(input *DeleteConnectionInput) (req *request.Request, output *Connection) {
@@ -2299,10 +2498,9 @@ func (c *DirectConnect) DeleteConnectionRequest(input *DeleteConnectionInput) (r
 //
 // Deletes the specified connection.
 //
-// Deleting a connection only stops the AWS Direct Connect port hour and data
-// transfer charges. If you are partnering with any third parties to connect
-// with the AWS Direct Connect location, you must cancel your service with them
-// separately.
+// Deleting a connection only stops the Direct Connect port hour and data transfer
+// charges. If you are partnering with any third parties to connect with the
+// Direct Connect location, you must cancel your service with them separately.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2311,12 +2509,13 @@ func (c *DirectConnect) DeleteConnectionRequest(input *DeleteConnectionInput) (r
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DeleteConnection for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DeleteConnection
 func (c *DirectConnect) DeleteConnection(input *DeleteConnectionInput) (*Connection, error) {
@@ -2356,14 +2555,13 @@ const opDeleteDirectConnectGateway = "DeleteDirectConnectGateway"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteDirectConnectGatewayRequest method.
+//	req, resp := client.DeleteDirectConnectGatewayRequest(params)
 //
-//    // Example sending a request using the DeleteDirectConnectGatewayRequest method.
-//    req, resp := client.DeleteDirectConnectGatewayRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DeleteDirectConnectGateway
 func (c *DirectConnect) DeleteDirectConnectGatewayRequest(input *DeleteDirectConnectGatewayInput) (req *request.Request, output *DeleteDirectConnectGatewayOutput) {
@@ -2386,8 +2584,7 @@ func (c *DirectConnect) DeleteDirectConnectGatewayRequest(input *DeleteDirectCon
 //
 // Deletes the specified Direct Connect gateway. You must first delete all virtual
 // interfaces that are attached to the Direct Connect gateway and disassociate
-// all virtual private gateways that are associated with the Direct Connect
-// gateway.
+// all virtual private gateways associated with the Direct Connect gateway.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2396,12 +2593,13 @@ func (c *DirectConnect) DeleteDirectConnectGatewayRequest(input *DeleteDirectCon
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DeleteDirectConnectGateway for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DeleteDirectConnectGateway
 func (c *DirectConnect) DeleteDirectConnectGateway(input *DeleteDirectConnectGatewayInput) (*DeleteDirectConnectGatewayOutput, error) {
@@ -2441,14 +2639,13 @@ const opDeleteDirectConnectGatewayAssociation = "DeleteDirectConnectGatewayAssoc
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteDirectConnectGatewayAssociationRequest method.
+//	req, resp := client.DeleteDirectConnectGatewayAssociationRequest(params)
 //
-//    // Example sending a request using the DeleteDirectConnectGatewayAssociationRequest method.
-//    req, resp := client.DeleteDirectConnectGatewayAssociationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DeleteDirectConnectGatewayAssociation
 func (c *DirectConnect) DeleteDirectConnectGatewayAssociationRequest(input *DeleteDirectConnectGatewayAssociationInput) (req *request.Request, output *DeleteDirectConnectGatewayAssociationOutput) {
@@ -2472,6 +2669,11 @@ func (c *DirectConnect) DeleteDirectConnectGatewayAssociationRequest(input *Dele
 // Deletes the association between the specified Direct Connect gateway and
 // virtual private gateway.
 //
+// We recommend that you specify the associationID to delete the association.
+// Alternatively, if you own virtual gateway and a Direct Connect gateway association,
+// you can specify the virtualGatewayId and directConnectGatewayId to delete
+// an association.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -2479,12 +2681,13 @@ func (c *DirectConnect) DeleteDirectConnectGatewayAssociationRequest(input *Dele
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DeleteDirectConnectGatewayAssociation for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DeleteDirectConnectGatewayAssociation
 func (c *DirectConnect) DeleteDirectConnectGatewayAssociation(input *DeleteDirectConnectGatewayAssociationInput) (*DeleteDirectConnectGatewayAssociationOutput, error) {
@@ -2524,14 +2727,13 @@ const opDeleteDirectConnectGatewayAssociationProposal = "DeleteDirectConnectGate
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteDirectConnectGatewayAssociationProposalRequest method.
+//	req, resp := client.DeleteDirectConnectGatewayAssociationProposalRequest(params)
 //
-//    // Example sending a request using the DeleteDirectConnectGatewayAssociationProposalRequest method.
-//    req, resp := client.DeleteDirectConnectGatewayAssociationProposalRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DeleteDirectConnectGatewayAssociationProposal
 func (c *DirectConnect) DeleteDirectConnectGatewayAssociationProposalRequest(input *DeleteDirectConnectGatewayAssociationProposalInput) (req *request.Request, output *DeleteDirectConnectGatewayAssociationProposalOutput) {
@@ -2562,12 +2764,13 @@ func (c *DirectConnect) DeleteDirectConnectGatewayAssociationProposalRequest(inp
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DeleteDirectConnectGatewayAssociationProposal for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DeleteDirectConnectGatewayAssociationProposal
 func (c *DirectConnect) DeleteDirectConnectGatewayAssociationProposal(input *DeleteDirectConnectGatewayAssociationProposalInput) (*DeleteDirectConnectGatewayAssociationProposalOutput, error) {
@@ -2607,14 +2810,13 @@ const opDeleteInterconnect = "DeleteInterconnect"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteInterconnectRequest method.
+//	req, resp := client.DeleteInterconnectRequest(params)
 //
-//    // Example sending a request using the DeleteInterconnectRequest method.
-//    req, resp := client.DeleteInterconnectRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DeleteInterconnect
 func (c *DirectConnect) DeleteInterconnectRequest(input *DeleteInterconnectInput) (req *request.Request, output *DeleteInterconnectOutput) {
@@ -2637,7 +2839,7 @@ func (c *DirectConnect) DeleteInterconnectRequest(input *DeleteInterconnectInput
 //
 // Deletes the specified interconnect.
 //
-// Intended for use by AWS Direct Connect Partners only.
+// Intended for use by Direct Connect Partners only.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2646,12 +2848,13 @@ func (c *DirectConnect) DeleteInterconnectRequest(input *DeleteInterconnectInput
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DeleteInterconnect for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DeleteInterconnect
 func (c *DirectConnect) DeleteInterconnect(input *DeleteInterconnectInput) (*DeleteInterconnectOutput, error) {
@@ -2691,14 +2894,13 @@ const opDeleteLag = "DeleteLag"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteLagRequest method.
+//	req, resp := client.DeleteLagRequest(params)
 //
-//    // Example sending a request using the DeleteLagRequest method.
-//    req, resp := client.DeleteLagRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DeleteLag
 func (c *DirectConnect) DeleteLagRequest(input *DeleteLagInput) (req *request.Request, output *Lag) {
@@ -2729,12 +2931,13 @@ func (c *DirectConnect) DeleteLagRequest(input *DeleteLagInput) (req *request.Re
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DeleteLag for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DeleteLag
 func (c *DirectConnect) DeleteLag(input *DeleteLagInput) (*Lag, error) {
@@ -2774,14 +2977,13 @@ const opDeleteVirtualInterface = "DeleteVirtualInterface"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteVirtualInterfaceRequest method.
+//	req, resp := client.DeleteVirtualInterfaceRequest(params)
 //
-//    // Example sending a request using the DeleteVirtualInterfaceRequest method.
-//    req, resp := client.DeleteVirtualInterfaceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DeleteVirtualInterface
 func (c *DirectConnect) DeleteVirtualInterfaceRequest(input *DeleteVirtualInterfaceInput) (req *request.Request, output *DeleteVirtualInterfaceOutput) {
@@ -2811,12 +3013,13 @@ func (c *DirectConnect) DeleteVirtualInterfaceRequest(input *DeleteVirtualInterf
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DeleteVirtualInterface for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DeleteVirtualInterface
 func (c *DirectConnect) DeleteVirtualInterface(input *DeleteVirtualInterfaceInput) (*DeleteVirtualInterfaceOutput, error) {
@@ -2856,14 +3059,13 @@ const opDescribeConnectionLoa = "DescribeConnectionLoa"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeConnectionLoaRequest method.
+//	req, resp := client.DescribeConnectionLoaRequest(params)
 //
-//    // Example sending a request using the DescribeConnectionLoaRequest method.
-//    req, resp := client.DescribeConnectionLoaRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeConnectionLoa
 //
@@ -2895,9 +3097,10 @@ func (c *DirectConnect) DescribeConnectionLoaRequest(input *DescribeConnectionLo
 //
 // The Letter of Authorization - Connecting Facility Assignment (LOA-CFA) is
 // a document that your APN partner or service provider uses when establishing
-// your cross connect to AWS at the colocation facility. For more information,
-// see Requesting Cross Connects at AWS Direct Connect Locations (https://docs.aws.amazon.com/directconnect/latest/UserGuide/Colocation.html)
-// in the AWS Direct Connect User Guide.
+// your cross connect to Amazon Web Services at the colocation facility. For
+// more information, see Requesting Cross Connects at Direct Connect Locations
+// (https://docs.aws.amazon.com/directconnect/latest/UserGuide/Colocation.html)
+// in the Direct Connect User Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2906,12 +3109,13 @@ func (c *DirectConnect) DescribeConnectionLoaRequest(input *DescribeConnectionLo
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DescribeConnectionLoa for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeConnectionLoa
 //
@@ -2955,14 +3159,13 @@ const opDescribeConnections = "DescribeConnections"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeConnectionsRequest method.
+//	req, resp := client.DescribeConnectionsRequest(params)
 //
-//    // Example sending a request using the DescribeConnectionsRequest method.
-//    req, resp := client.DescribeConnectionsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeConnections
 func (c *DirectConnect) DescribeConnectionsRequest(input *DescribeConnectionsInput) (req *request.Request, output *Connections) {
@@ -2992,12 +3195,13 @@ func (c *DirectConnect) DescribeConnectionsRequest(input *DescribeConnectionsInp
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DescribeConnections for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeConnections
 func (c *DirectConnect) DescribeConnections(input *DescribeConnectionsInput) (*Connections, error) {
@@ -3037,14 +3241,13 @@ const opDescribeConnectionsOnInterconnect = "DescribeConnectionsOnInterconnect"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeConnectionsOnInterconnectRequest method.
+//	req, resp := client.DescribeConnectionsOnInterconnectRequest(params)
 //
-//    // Example sending a request using the DescribeConnectionsOnInterconnectRequest method.
-//    req, resp := client.DescribeConnectionsOnInterconnectRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeConnectionsOnInterconnect
 //
@@ -3074,7 +3277,7 @@ func (c *DirectConnect) DescribeConnectionsOnInterconnectRequest(input *Describe
 //
 // Lists the connections that have been provisioned on the specified interconnect.
 //
-// Intended for use by AWS Direct Connect Partners only.
+// Intended for use by Direct Connect Partners only.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -3083,12 +3286,13 @@ func (c *DirectConnect) DescribeConnectionsOnInterconnectRequest(input *Describe
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DescribeConnectionsOnInterconnect for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeConnectionsOnInterconnect
 //
@@ -3116,6 +3320,89 @@ func (c *DirectConnect) DescribeConnectionsOnInterconnectWithContext(ctx aws.Con
 	return out, req.Send()
 }
 
+const opDescribeCustomerMetadata = "DescribeCustomerMetadata"
+
+// DescribeCustomerMetadataRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeCustomerMetadata operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See DescribeCustomerMetadata for more information on using the DescribeCustomerMetadata
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the DescribeCustomerMetadataRequest method.
+//	req, resp := client.DescribeCustomerMetadataRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeCustomerMetadata
+func (c *DirectConnect) DescribeCustomerMetadataRequest(input *DescribeCustomerMetadataInput) (req *request.Request, output *DescribeCustomerMetadataOutput) {
+	op := &request.Operation{
+		Name:       opDescribeCustomerMetadata,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &DescribeCustomerMetadataInput{}
+	}
+
+	output = &DescribeCustomerMetadataOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// DescribeCustomerMetadata API operation for AWS Direct Connect.
+//
+// Get and view a list of customer agreements, along with their signed status
+// and whether the customer is an NNIPartner, NNIPartnerV2, or a nonPartner.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Direct Connect's
+// API operation DescribeCustomerMetadata for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeCustomerMetadata
+func (c *DirectConnect) DescribeCustomerMetadata(input *DescribeCustomerMetadataInput) (*DescribeCustomerMetadataOutput, error) {
+	req, out := c.DescribeCustomerMetadataRequest(input)
+	return out, req.Send()
+}
+
+// DescribeCustomerMetadataWithContext is the same as DescribeCustomerMetadata with the addition of
+// the ability to pass a context and additional request options.
+//
+// See DescribeCustomerMetadata for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectConnect) DescribeCustomerMetadataWithContext(ctx aws.Context, input *DescribeCustomerMetadataInput, opts ...request.Option) (*DescribeCustomerMetadataOutput, error) {
+	req, out := c.DescribeCustomerMetadataRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opDescribeDirectConnectGatewayAssociationProposals = "DescribeDirectConnectGatewayAssociationProposals"
 
 // DescribeDirectConnectGatewayAssociationProposalsRequest generates a "aws/request.Request" representing the
@@ -3132,14 +3419,13 @@ const opDescribeDirectConnectGatewayAssociationProposals = "DescribeDirectConnec
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeDirectConnectGatewayAssociationProposalsRequest method.
+//	req, resp := client.DescribeDirectConnectGatewayAssociationProposalsRequest(params)
 //
-//    // Example sending a request using the DescribeDirectConnectGatewayAssociationProposalsRequest method.
-//    req, resp := client.DescribeDirectConnectGatewayAssociationProposalsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeDirectConnectGatewayAssociationProposals
 func (c *DirectConnect) DescribeDirectConnectGatewayAssociationProposalsRequest(input *DescribeDirectConnectGatewayAssociationProposalsInput) (req *request.Request, output *DescribeDirectConnectGatewayAssociationProposalsOutput) {
@@ -3170,12 +3456,13 @@ func (c *DirectConnect) DescribeDirectConnectGatewayAssociationProposalsRequest(
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DescribeDirectConnectGatewayAssociationProposals for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeDirectConnectGatewayAssociationProposals
 func (c *DirectConnect) DescribeDirectConnectGatewayAssociationProposals(input *DescribeDirectConnectGatewayAssociationProposalsInput) (*DescribeDirectConnectGatewayAssociationProposalsOutput, error) {
@@ -3215,14 +3502,13 @@ const opDescribeDirectConnectGatewayAssociations = "DescribeDirectConnectGateway
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeDirectConnectGatewayAssociationsRequest method.
+//	req, resp := client.DescribeDirectConnectGatewayAssociationsRequest(params)
 //
-//    // Example sending a request using the DescribeDirectConnectGatewayAssociationsRequest method.
-//    req, resp := client.DescribeDirectConnectGatewayAssociationsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeDirectConnectGatewayAssociations
 func (c *DirectConnect) DescribeDirectConnectGatewayAssociationsRequest(input *DescribeDirectConnectGatewayAssociationsInput) (req *request.Request, output *DescribeDirectConnectGatewayAssociationsOutput) {
@@ -3244,13 +3530,21 @@ func (c *DirectConnect) DescribeDirectConnectGatewayAssociationsRequest(input *D
 // DescribeDirectConnectGatewayAssociations API operation for AWS Direct Connect.
 //
 // Lists the associations between your Direct Connect gateways and virtual private
-// gateways. You must specify a Direct Connect gateway, a virtual private gateway,
-// or both. If you specify a Direct Connect gateway, the response contains all
-// virtual private gateways associated with the Direct Connect gateway. If you
-// specify a virtual private gateway, the response contains all Direct Connect
-// gateways associated with the virtual private gateway. If you specify both,
-// the response contains the association between the Direct Connect gateway
-// and the virtual private gateway.
+// gateways and transit gateways. You must specify one of the following:
+//
+//   - A Direct Connect gateway The response contains all virtual private gateways
+//     and transit gateways associated with the Direct Connect gateway.
+//
+//   - A virtual private gateway The response contains the Direct Connect gateway.
+//
+//   - A transit gateway The response contains the Direct Connect gateway.
+//
+//   - A Direct Connect gateway and a virtual private gateway The response
+//     contains the association between the Direct Connect gateway and virtual
+//     private gateway.
+//
+//   - A Direct Connect gateway and a transit gateway The response contains
+//     the association between the Direct Connect gateway and transit gateway.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -3259,12 +3553,13 @@ func (c *DirectConnect) DescribeDirectConnectGatewayAssociationsRequest(input *D
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DescribeDirectConnectGatewayAssociations for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeDirectConnectGatewayAssociations
 func (c *DirectConnect) DescribeDirectConnectGatewayAssociations(input *DescribeDirectConnectGatewayAssociationsInput) (*DescribeDirectConnectGatewayAssociationsOutput, error) {
@@ -3304,14 +3599,13 @@ const opDescribeDirectConnectGatewayAttachments = "DescribeDirectConnectGatewayA
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeDirectConnectGatewayAttachmentsRequest method.
+//	req, resp := client.DescribeDirectConnectGatewayAttachmentsRequest(params)
 //
-//    // Example sending a request using the DescribeDirectConnectGatewayAttachmentsRequest method.
-//    req, resp := client.DescribeDirectConnectGatewayAttachmentsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeDirectConnectGatewayAttachments
 func (c *DirectConnect) DescribeDirectConnectGatewayAttachmentsRequest(input *DescribeDirectConnectGatewayAttachmentsInput) (req *request.Request, output *DescribeDirectConnectGatewayAttachmentsOutput) {
@@ -3347,12 +3641,13 @@ func (c *DirectConnect) DescribeDirectConnectGatewayAttachmentsRequest(input *De
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DescribeDirectConnectGatewayAttachments for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeDirectConnectGatewayAttachments
 func (c *DirectConnect) DescribeDirectConnectGatewayAttachments(input *DescribeDirectConnectGatewayAttachmentsInput) (*DescribeDirectConnectGatewayAttachmentsOutput, error) {
@@ -3392,14 +3687,13 @@ const opDescribeDirectConnectGateways = "DescribeDirectConnectGateways"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeDirectConnectGatewaysRequest method.
+//	req, resp := client.DescribeDirectConnectGatewaysRequest(params)
 //
-//    // Example sending a request using the DescribeDirectConnectGatewaysRequest method.
-//    req, resp := client.DescribeDirectConnectGatewaysRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeDirectConnectGateways
 func (c *DirectConnect) DescribeDirectConnectGatewaysRequest(input *DescribeDirectConnectGatewaysInput) (req *request.Request, output *DescribeDirectConnectGatewaysOutput) {
@@ -3430,12 +3724,13 @@ func (c *DirectConnect) DescribeDirectConnectGatewaysRequest(input *DescribeDire
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DescribeDirectConnectGateways for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeDirectConnectGateways
 func (c *DirectConnect) DescribeDirectConnectGateways(input *DescribeDirectConnectGatewaysInput) (*DescribeDirectConnectGatewaysOutput, error) {
@@ -3475,14 +3770,13 @@ const opDescribeHostedConnections = "DescribeHostedConnections"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeHostedConnectionsRequest method.
+//	req, resp := client.DescribeHostedConnectionsRequest(params)
 //
-//    // Example sending a request using the DescribeHostedConnectionsRequest method.
-//    req, resp := client.DescribeHostedConnectionsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeHostedConnections
 func (c *DirectConnect) DescribeHostedConnectionsRequest(input *DescribeHostedConnectionsInput) (req *request.Request, output *Connections) {
@@ -3506,7 +3800,7 @@ func (c *DirectConnect) DescribeHostedConnectionsRequest(input *DescribeHostedCo
 // Lists the hosted connections that have been provisioned on the specified
 // interconnect or link aggregation group (LAG).
 //
-// Intended for use by AWS Direct Connect Partners only.
+// Intended for use by Direct Connect Partners only.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -3515,12 +3809,13 @@ func (c *DirectConnect) DescribeHostedConnectionsRequest(input *DescribeHostedCo
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DescribeHostedConnections for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeHostedConnections
 func (c *DirectConnect) DescribeHostedConnections(input *DescribeHostedConnectionsInput) (*Connections, error) {
@@ -3560,14 +3855,13 @@ const opDescribeInterconnectLoa = "DescribeInterconnectLoa"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeInterconnectLoaRequest method.
+//	req, resp := client.DescribeInterconnectLoaRequest(params)
 //
-//    // Example sending a request using the DescribeInterconnectLoaRequest method.
-//    req, resp := client.DescribeInterconnectLoaRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeInterconnectLoa
 //
@@ -3598,10 +3892,10 @@ func (c *DirectConnect) DescribeInterconnectLoaRequest(input *DescribeInterconne
 // Gets the LOA-CFA for the specified interconnect.
 //
 // The Letter of Authorization - Connecting Facility Assignment (LOA-CFA) is
-// a document that is used when establishing your cross connect to AWS at the
-// colocation facility. For more information, see Requesting Cross Connects
-// at AWS Direct Connect Locations (https://docs.aws.amazon.com/directconnect/latest/UserGuide/Colocation.html)
-// in the AWS Direct Connect User Guide.
+// a document that is used when establishing your cross connect to Amazon Web
+// Services at the colocation facility. For more information, see Requesting
+// Cross Connects at Direct Connect Locations (https://docs.aws.amazon.com/directconnect/latest/UserGuide/Colocation.html)
+// in the Direct Connect User Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -3610,12 +3904,13 @@ func (c *DirectConnect) DescribeInterconnectLoaRequest(input *DescribeInterconne
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DescribeInterconnectLoa for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeInterconnectLoa
 //
@@ -3659,14 +3954,13 @@ const opDescribeInterconnects = "DescribeInterconnects"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeInterconnectsRequest method.
+//	req, resp := client.DescribeInterconnectsRequest(params)
 //
-//    // Example sending a request using the DescribeInterconnectsRequest method.
-//    req, resp := client.DescribeInterconnectsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeInterconnects
 func (c *DirectConnect) DescribeInterconnectsRequest(input *DescribeInterconnectsInput) (req *request.Request, output *DescribeInterconnectsOutput) {
@@ -3687,7 +3981,8 @@ func (c *DirectConnect) DescribeInterconnectsRequest(input *DescribeInterconnect
 
 // DescribeInterconnects API operation for AWS Direct Connect.
 //
-// Lists the interconnects owned by the AWS account or only the specified interconnect.
+// Lists the interconnects owned by the Amazon Web Services account or only
+// the specified interconnect.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -3696,12 +3991,13 @@ func (c *DirectConnect) DescribeInterconnectsRequest(input *DescribeInterconnect
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DescribeInterconnects for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeInterconnects
 func (c *DirectConnect) DescribeInterconnects(input *DescribeInterconnectsInput) (*DescribeInterconnectsOutput, error) {
@@ -3741,14 +4037,13 @@ const opDescribeLags = "DescribeLags"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeLagsRequest method.
+//	req, resp := client.DescribeLagsRequest(params)
 //
-//    // Example sending a request using the DescribeLagsRequest method.
-//    req, resp := client.DescribeLagsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeLags
 func (c *DirectConnect) DescribeLagsRequest(input *DescribeLagsInput) (req *request.Request, output *DescribeLagsOutput) {
@@ -3778,12 +4073,13 @@ func (c *DirectConnect) DescribeLagsRequest(input *DescribeLagsInput) (req *requ
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DescribeLags for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeLags
 func (c *DirectConnect) DescribeLags(input *DescribeLagsInput) (*DescribeLagsOutput, error) {
@@ -3823,14 +4119,13 @@ const opDescribeLoa = "DescribeLoa"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeLoaRequest method.
+//	req, resp := client.DescribeLoaRequest(params)
 //
-//    // Example sending a request using the DescribeLoaRequest method.
-//    req, resp := client.DescribeLoaRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeLoa
 func (c *DirectConnect) DescribeLoaRequest(input *DescribeLoaInput) (req *request.Request, output *Loa) {
@@ -3855,10 +4150,10 @@ func (c *DirectConnect) DescribeLoaRequest(input *DescribeLoaInput) (req *reques
 // (LAG).
 //
 // The Letter of Authorization - Connecting Facility Assignment (LOA-CFA) is
-// a document that is used when establishing your cross connect to AWS at the
-// colocation facility. For more information, see Requesting Cross Connects
-// at AWS Direct Connect Locations (https://docs.aws.amazon.com/directconnect/latest/UserGuide/Colocation.html)
-// in the AWS Direct Connect User Guide.
+// a document that is used when establishing your cross connect to Amazon Web
+// Services at the colocation facility. For more information, see Requesting
+// Cross Connects at Direct Connect Locations (https://docs.aws.amazon.com/directconnect/latest/UserGuide/Colocation.html)
+// in the Direct Connect User Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -3867,12 +4162,13 @@ func (c *DirectConnect) DescribeLoaRequest(input *DescribeLoaInput) (req *reques
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DescribeLoa for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeLoa
 func (c *DirectConnect) DescribeLoa(input *DescribeLoaInput) (*Loa, error) {
@@ -3912,14 +4208,13 @@ const opDescribeLocations = "DescribeLocations"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeLocationsRequest method.
+//	req, resp := client.DescribeLocationsRequest(params)
 //
-//    // Example sending a request using the DescribeLocationsRequest method.
-//    req, resp := client.DescribeLocationsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeLocations
 func (c *DirectConnect) DescribeLocationsRequest(input *DescribeLocationsInput) (req *request.Request, output *DescribeLocationsOutput) {
@@ -3940,8 +4235,9 @@ func (c *DirectConnect) DescribeLocationsRequest(input *DescribeLocationsInput)
 
 // DescribeLocations API operation for AWS Direct Connect.
 //
-// Lists the AWS Direct Connect locations in the current AWS Region. These are
-// the locations that can be selected when calling CreateConnection or CreateInterconnect.
+// Lists the Direct Connect locations in the current Amazon Web Services Region.
+// These are the locations that can be selected when calling CreateConnection
+// or CreateInterconnect.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -3950,12 +4246,13 @@ func (c *DirectConnect) DescribeLocationsRequest(input *DescribeLocationsInput)
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DescribeLocations for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeLocations
 func (c *DirectConnect) DescribeLocations(input *DescribeLocationsInput) (*DescribeLocationsOutput, error) {
@@ -3979,6 +4276,88 @@ func (c *DirectConnect) DescribeLocationsWithContext(ctx aws.Context, input *Des
 	return out, req.Send()
 }
 
+const opDescribeRouterConfiguration = "DescribeRouterConfiguration"
+
+// DescribeRouterConfigurationRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeRouterConfiguration operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See DescribeRouterConfiguration for more information on using the DescribeRouterConfiguration
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the DescribeRouterConfigurationRequest method.
+//	req, resp := client.DescribeRouterConfigurationRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeRouterConfiguration
+func (c *DirectConnect) DescribeRouterConfigurationRequest(input *DescribeRouterConfigurationInput) (req *request.Request, output *DescribeRouterConfigurationOutput) {
+	op := &request.Operation{
+		Name:       opDescribeRouterConfiguration,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &DescribeRouterConfigurationInput{}
+	}
+
+	output = &DescribeRouterConfigurationOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// DescribeRouterConfiguration API operation for AWS Direct Connect.
+//
+// Details about the router.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Direct Connect's
+// API operation DescribeRouterConfiguration for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeRouterConfiguration
+func (c *DirectConnect) DescribeRouterConfiguration(input *DescribeRouterConfigurationInput) (*DescribeRouterConfigurationOutput, error) {
+	req, out := c.DescribeRouterConfigurationRequest(input)
+	return out, req.Send()
+}
+
+// DescribeRouterConfigurationWithContext is the same as DescribeRouterConfiguration with the addition of
+// the ability to pass a context and additional request options.
+//
+// See DescribeRouterConfiguration for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectConnect) DescribeRouterConfigurationWithContext(ctx aws.Context, input *DescribeRouterConfigurationInput, opts ...request.Option) (*DescribeRouterConfigurationOutput, error) {
+	req, out := c.DescribeRouterConfigurationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opDescribeTags = "DescribeTags"
 
 // DescribeTagsRequest generates a "aws/request.Request" representing the
@@ -3995,14 +4374,13 @@ const opDescribeTags = "DescribeTags"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeTagsRequest method.
+//	req, resp := client.DescribeTagsRequest(params)
 //
-//    // Example sending a request using the DescribeTagsRequest method.
-//    req, resp := client.DescribeTagsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeTags
 func (c *DirectConnect) DescribeTagsRequest(input *DescribeTagsInput) (req *request.Request, output *DescribeTagsOutput) {
@@ -4023,7 +4401,7 @@ func (c *DirectConnect) DescribeTagsRequest(input *DescribeTagsInput) (req *requ
 
 // DescribeTags API operation for AWS Direct Connect.
 //
-// Describes the tags associated with the specified AWS Direct Connect resources.
+// Describes the tags associated with the specified Direct Connect resources.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -4032,12 +4410,13 @@ func (c *DirectConnect) DescribeTagsRequest(input *DescribeTagsInput) (req *requ
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DescribeTags for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeTags
 func (c *DirectConnect) DescribeTags(input *DescribeTagsInput) (*DescribeTagsOutput, error) {
@@ -4077,14 +4456,13 @@ const opDescribeVirtualGateways = "DescribeVirtualGateways"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeVirtualGatewaysRequest method.
+//	req, resp := client.DescribeVirtualGatewaysRequest(params)
 //
-//    // Example sending a request using the DescribeVirtualGatewaysRequest method.
-//    req, resp := client.DescribeVirtualGatewaysRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeVirtualGateways
 func (c *DirectConnect) DescribeVirtualGatewaysRequest(input *DescribeVirtualGatewaysInput) (req *request.Request, output *DescribeVirtualGatewaysOutput) {
@@ -4105,10 +4483,10 @@ func (c *DirectConnect) DescribeVirtualGatewaysRequest(input *DescribeVirtualGat
 
 // DescribeVirtualGateways API operation for AWS Direct Connect.
 //
-// Lists the virtual private gateways owned by the AWS account.
+// Lists the virtual private gateways owned by the Amazon Web Services account.
 //
-// You can create one or more AWS Direct Connect private virtual interfaces
-// linked to a virtual private gateway.
+// You can create one or more Direct Connect private virtual interfaces linked
+// to a virtual private gateway.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -4117,12 +4495,13 @@ func (c *DirectConnect) DescribeVirtualGatewaysRequest(input *DescribeVirtualGat
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DescribeVirtualGateways for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeVirtualGateways
 func (c *DirectConnect) DescribeVirtualGateways(input *DescribeVirtualGatewaysInput) (*DescribeVirtualGatewaysOutput, error) {
@@ -4162,14 +4541,13 @@ const opDescribeVirtualInterfaces = "DescribeVirtualInterfaces"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeVirtualInterfacesRequest method.
+//	req, resp := client.DescribeVirtualInterfacesRequest(params)
 //
-//    // Example sending a request using the DescribeVirtualInterfacesRequest method.
-//    req, resp := client.DescribeVirtualInterfacesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeVirtualInterfaces
 func (c *DirectConnect) DescribeVirtualInterfacesRequest(input *DescribeVirtualInterfacesInput) (req *request.Request, output *DescribeVirtualInterfacesOutput) {
@@ -4190,13 +4568,13 @@ func (c *DirectConnect) DescribeVirtualInterfacesRequest(input *DescribeVirtualI
 
 // DescribeVirtualInterfaces API operation for AWS Direct Connect.
 //
-// Displays all virtual interfaces for an AWS account. Virtual interfaces deleted
-// fewer than 15 minutes before you make the request are also returned. If you
-// specify a connection ID, only the virtual interfaces associated with the
-// connection are returned. If you specify a virtual interface ID, then only
-// a single virtual interface is returned.
+// Displays all virtual interfaces for an Amazon Web Services account. Virtual
+// interfaces deleted fewer than 15 minutes before you make the request are
+// also returned. If you specify a connection ID, only the virtual interfaces
+// associated with the connection are returned. If you specify a virtual interface
+// ID, then only a single virtual interface is returned.
 //
-// A virtual interface (VLAN) transmits the traffic between the AWS Direct Connect
+// A virtual interface (VLAN) transmits the traffic between the Direct Connect
 // location and the customer network.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
@@ -4206,12 +4584,13 @@ func (c *DirectConnect) DescribeVirtualInterfacesRequest(input *DescribeVirtualI
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DescribeVirtualInterfaces for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DescribeVirtualInterfaces
 func (c *DirectConnect) DescribeVirtualInterfaces(input *DescribeVirtualInterfacesInput) (*DescribeVirtualInterfacesOutput, error) {
@@ -4251,14 +4630,13 @@ const opDisassociateConnectionFromLag = "DisassociateConnectionFromLag"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DisassociateConnectionFromLagRequest method.
+//	req, resp := client.DisassociateConnectionFromLagRequest(params)
 //
-//    // Example sending a request using the DisassociateConnectionFromLagRequest method.
-//    req, resp := client.DisassociateConnectionFromLagRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DisassociateConnectionFromLag
 func (c *DirectConnect) DisassociateConnectionFromLagRequest(input *DisassociateConnectionFromLagInput) (req *request.Request, output *Connection) {
@@ -4283,8 +4661,8 @@ func (c *DirectConnect) DisassociateConnectionFromLagRequest(input *Disassociate
 // is interrupted and re-established as a standalone connection (the connection
 // is not deleted; to delete the connection, use the DeleteConnection request).
 // If the LAG has associated virtual interfaces or hosted connections, they
-// remain associated with the LAG. A disassociated connection owned by an AWS
-// Direct Connect Partner is automatically converted to an interconnect.
+// remain associated with the LAG. A disassociated connection owned by an Direct
+// Connect Partner is automatically converted to an interconnect.
 //
 // If disassociating the connection would cause the LAG to fall below its setting
 // for minimum number of operational connections, the request fails, except
@@ -4298,12 +4676,13 @@ func (c *DirectConnect) DisassociateConnectionFromLagRequest(input *Disassociate
 // See the AWS API reference guide for AWS Direct Connect's
 // API operation DisassociateConnectionFromLag for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ClientException
+//     One or more parameters are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DisassociateConnectionFromLag
 func (c *DirectConnect) DisassociateConnectionFromLag(input *DisassociateConnectionFromLagInput) (*Connection, error) {
@@ -4327,727 +4706,2359 @@ func (c *DirectConnect) DisassociateConnectionFromLagWithContext(ctx aws.Context
 	return out, req.Send()
 }
 
-const opTagResource = "TagResource"
+const opDisassociateMacSecKey = "DisassociateMacSecKey"
 
-// TagResourceRequest generates a "aws/request.Request" representing the
-// client's request for the TagResource operation. The "output" return
+// DisassociateMacSecKeyRequest generates a "aws/request.Request" representing the
+// client's request for the DisassociateMacSecKey operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See TagResource for more information on using the TagResource
+// See DisassociateMacSecKey for more information on using the DisassociateMacSecKey
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DisassociateMacSecKeyRequest method.
+//	req, resp := client.DisassociateMacSecKeyRequest(params)
 //
-//    // Example sending a request using the TagResourceRequest method.
-//    req, resp := client.TagResourceRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/TagResource
-func (c *DirectConnect) TagResourceRequest(input *TagResourceInput) (req *request.Request, output *TagResourceOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DisassociateMacSecKey
+func (c *DirectConnect) DisassociateMacSecKeyRequest(input *DisassociateMacSecKeyInput) (req *request.Request, output *DisassociateMacSecKeyOutput) {
 	op := &request.Operation{
-		Name:       opTagResource,
+		Name:       opDisassociateMacSecKey,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &TagResourceInput{}
+		input = &DisassociateMacSecKeyInput{}
 	}
 
-	output = &TagResourceOutput{}
+	output = &DisassociateMacSecKeyOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// TagResource API operation for AWS Direct Connect.
+// DisassociateMacSecKey API operation for AWS Direct Connect.
 //
-// Adds the specified tags to the specified AWS Direct Connect resource. Each
-// resource can have a maximum of 50 tags.
-//
-// Each tag consists of a key and an optional value. If a tag with the same
-// key is already associated with the resource, this action updates its value.
+// Removes the association between a MAC Security (MACsec) security key and
+// an Direct Connect dedicated connection.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Direct Connect's
-// API operation TagResource for usage and error information.
+// API operation DisassociateMacSecKey for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeDuplicateTagKeysException "DuplicateTagKeysException"
-//   A tag key was specified more than once.
+// Returned Error Types:
 //
-//   * ErrCodeTooManyTagsException "TooManyTagsException"
-//   You have reached the limit on the number of tags that can be assigned.
+//   - ServerException
+//     A server-side error occurred.
 //
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+//   - ClientException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/TagResource
-func (c *DirectConnect) TagResource(input *TagResourceInput) (*TagResourceOutput, error) {
-	req, out := c.TagResourceRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/DisassociateMacSecKey
+func (c *DirectConnect) DisassociateMacSecKey(input *DisassociateMacSecKeyInput) (*DisassociateMacSecKeyOutput, error) {
+	req, out := c.DisassociateMacSecKeyRequest(input)
 	return out, req.Send()
 }
 
-// TagResourceWithContext is the same as TagResource with the addition of
+// DisassociateMacSecKeyWithContext is the same as DisassociateMacSecKey with the addition of
 // the ability to pass a context and additional request options.
 //
-// See TagResource for details on how to use this API operation.
+// See DisassociateMacSecKey for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectConnect) TagResourceWithContext(ctx aws.Context, input *TagResourceInput, opts ...request.Option) (*TagResourceOutput, error) {
-	req, out := c.TagResourceRequest(input)
+func (c *DirectConnect) DisassociateMacSecKeyWithContext(ctx aws.Context, input *DisassociateMacSecKeyInput, opts ...request.Option) (*DisassociateMacSecKeyOutput, error) {
+	req, out := c.DisassociateMacSecKeyRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUntagResource = "UntagResource"
+const opListVirtualInterfaceTestHistory = "ListVirtualInterfaceTestHistory"
 
-// UntagResourceRequest generates a "aws/request.Request" representing the
-// client's request for the UntagResource operation. The "output" return
+// ListVirtualInterfaceTestHistoryRequest generates a "aws/request.Request" representing the
+// client's request for the ListVirtualInterfaceTestHistory operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UntagResource for more information on using the UntagResource
+// See ListVirtualInterfaceTestHistory for more information on using the ListVirtualInterfaceTestHistory
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListVirtualInterfaceTestHistoryRequest method.
+//	req, resp := client.ListVirtualInterfaceTestHistoryRequest(params)
 //
-//    // Example sending a request using the UntagResourceRequest method.
-//    req, resp := client.UntagResourceRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/UntagResource
-func (c *DirectConnect) UntagResourceRequest(input *UntagResourceInput) (req *request.Request, output *UntagResourceOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/ListVirtualInterfaceTestHistory
+func (c *DirectConnect) ListVirtualInterfaceTestHistoryRequest(input *ListVirtualInterfaceTestHistoryInput) (req *request.Request, output *ListVirtualInterfaceTestHistoryOutput) {
 	op := &request.Operation{
-		Name:       opUntagResource,
+		Name:       opListVirtualInterfaceTestHistory,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &UntagResourceInput{}
+		input = &ListVirtualInterfaceTestHistoryInput{}
 	}
 
-	output = &UntagResourceOutput{}
+	output = &ListVirtualInterfaceTestHistoryOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// UntagResource API operation for AWS Direct Connect.
+// ListVirtualInterfaceTestHistory API operation for AWS Direct Connect.
 //
-// Removes one or more tags from the specified AWS Direct Connect resource.
+// Lists the virtual interface failover test history.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Direct Connect's
-// API operation UntagResource for usage and error information.
+// API operation ListVirtualInterfaceTestHistory for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ServerException
+//     A server-side error occurred.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/UntagResource
-func (c *DirectConnect) UntagResource(input *UntagResourceInput) (*UntagResourceOutput, error) {
-	req, out := c.UntagResourceRequest(input)
+//   - ClientException
+//     One or more parameters are not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/ListVirtualInterfaceTestHistory
+func (c *DirectConnect) ListVirtualInterfaceTestHistory(input *ListVirtualInterfaceTestHistoryInput) (*ListVirtualInterfaceTestHistoryOutput, error) {
+	req, out := c.ListVirtualInterfaceTestHistoryRequest(input)
 	return out, req.Send()
 }
 
-// UntagResourceWithContext is the same as UntagResource with the addition of
+// ListVirtualInterfaceTestHistoryWithContext is the same as ListVirtualInterfaceTestHistory with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UntagResource for details on how to use this API operation.
+// See ListVirtualInterfaceTestHistory for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectConnect) UntagResourceWithContext(ctx aws.Context, input *UntagResourceInput, opts ...request.Option) (*UntagResourceOutput, error) {
-	req, out := c.UntagResourceRequest(input)
+func (c *DirectConnect) ListVirtualInterfaceTestHistoryWithContext(ctx aws.Context, input *ListVirtualInterfaceTestHistoryInput, opts ...request.Option) (*ListVirtualInterfaceTestHistoryOutput, error) {
+	req, out := c.ListVirtualInterfaceTestHistoryRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateDirectConnectGatewayAssociation = "UpdateDirectConnectGatewayAssociation"
+const opStartBgpFailoverTest = "StartBgpFailoverTest"
 
-// UpdateDirectConnectGatewayAssociationRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateDirectConnectGatewayAssociation operation. The "output" return
+// StartBgpFailoverTestRequest generates a "aws/request.Request" representing the
+// client's request for the StartBgpFailoverTest operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateDirectConnectGatewayAssociation for more information on using the UpdateDirectConnectGatewayAssociation
+// See StartBgpFailoverTest for more information on using the StartBgpFailoverTest
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the StartBgpFailoverTestRequest method.
+//	req, resp := client.StartBgpFailoverTestRequest(params)
 //
-//    // Example sending a request using the UpdateDirectConnectGatewayAssociationRequest method.
-//    req, resp := client.UpdateDirectConnectGatewayAssociationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/UpdateDirectConnectGatewayAssociation
-func (c *DirectConnect) UpdateDirectConnectGatewayAssociationRequest(input *UpdateDirectConnectGatewayAssociationInput) (req *request.Request, output *UpdateDirectConnectGatewayAssociationOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/StartBgpFailoverTest
+func (c *DirectConnect) StartBgpFailoverTestRequest(input *StartBgpFailoverTestInput) (req *request.Request, output *StartBgpFailoverTestOutput) {
 	op := &request.Operation{
-		Name:       opUpdateDirectConnectGatewayAssociation,
+		Name:       opStartBgpFailoverTest,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &UpdateDirectConnectGatewayAssociationInput{}
+		input = &StartBgpFailoverTestInput{}
 	}
 
-	output = &UpdateDirectConnectGatewayAssociationOutput{}
+	output = &StartBgpFailoverTestOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// UpdateDirectConnectGatewayAssociation API operation for AWS Direct Connect.
+// StartBgpFailoverTest API operation for AWS Direct Connect.
 //
-// Updates the specified attributes of the Direct Connect gateway association.
+// Starts the virtual interface failover test that verifies your configuration
+// meets your resiliency requirements by placing the BGP peering session in
+// the DOWN state. You can then send traffic to verify that there are no outages.
 //
-// Add or remove prefixes from the association.
+// You can run the test on public, private, transit, and hosted virtual interfaces.
+//
+// You can use ListVirtualInterfaceTestHistory (https://docs.aws.amazon.com/directconnect/latest/APIReference/API_ListVirtualInterfaceTestHistory.html)
+// to view the virtual interface test history.
+//
+// If you need to stop the test before the test interval completes, use StopBgpFailoverTest
+// (https://docs.aws.amazon.com/directconnect/latest/APIReference/API_StopBgpFailoverTest.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Direct Connect's
-// API operation UpdateDirectConnectGatewayAssociation for usage and error information.
+// API operation StartBgpFailoverTest for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ServerException
+//     A server-side error occurred.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/UpdateDirectConnectGatewayAssociation
-func (c *DirectConnect) UpdateDirectConnectGatewayAssociation(input *UpdateDirectConnectGatewayAssociationInput) (*UpdateDirectConnectGatewayAssociationOutput, error) {
-	req, out := c.UpdateDirectConnectGatewayAssociationRequest(input)
+//   - ClientException
+//     One or more parameters are not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/StartBgpFailoverTest
+func (c *DirectConnect) StartBgpFailoverTest(input *StartBgpFailoverTestInput) (*StartBgpFailoverTestOutput, error) {
+	req, out := c.StartBgpFailoverTestRequest(input)
 	return out, req.Send()
 }
 
-// UpdateDirectConnectGatewayAssociationWithContext is the same as UpdateDirectConnectGatewayAssociation with the addition of
+// StartBgpFailoverTestWithContext is the same as StartBgpFailoverTest with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateDirectConnectGatewayAssociation for details on how to use this API operation.
+// See StartBgpFailoverTest for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectConnect) UpdateDirectConnectGatewayAssociationWithContext(ctx aws.Context, input *UpdateDirectConnectGatewayAssociationInput, opts ...request.Option) (*UpdateDirectConnectGatewayAssociationOutput, error) {
-	req, out := c.UpdateDirectConnectGatewayAssociationRequest(input)
+func (c *DirectConnect) StartBgpFailoverTestWithContext(ctx aws.Context, input *StartBgpFailoverTestInput, opts ...request.Option) (*StartBgpFailoverTestOutput, error) {
+	req, out := c.StartBgpFailoverTestRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateLag = "UpdateLag"
+const opStopBgpFailoverTest = "StopBgpFailoverTest"
 
-// UpdateLagRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateLag operation. The "output" return
+// StopBgpFailoverTestRequest generates a "aws/request.Request" representing the
+// client's request for the StopBgpFailoverTest operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateLag for more information on using the UpdateLag
+// See StopBgpFailoverTest for more information on using the StopBgpFailoverTest
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the StopBgpFailoverTestRequest method.
+//	req, resp := client.StopBgpFailoverTestRequest(params)
 //
-//    // Example sending a request using the UpdateLagRequest method.
-//    req, resp := client.UpdateLagRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/UpdateLag
-func (c *DirectConnect) UpdateLagRequest(input *UpdateLagInput) (req *request.Request, output *Lag) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/StopBgpFailoverTest
+func (c *DirectConnect) StopBgpFailoverTestRequest(input *StopBgpFailoverTestInput) (req *request.Request, output *StopBgpFailoverTestOutput) {
 	op := &request.Operation{
-		Name:       opUpdateLag,
+		Name:       opStopBgpFailoverTest,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &UpdateLagInput{}
+		input = &StopBgpFailoverTestInput{}
 	}
 
-	output = &Lag{}
+	output = &StopBgpFailoverTestOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// UpdateLag API operation for AWS Direct Connect.
-//
-// Updates the attributes of the specified link aggregation group (LAG).
+// StopBgpFailoverTest API operation for AWS Direct Connect.
 //
-// You can update the following attributes:
-//
-//    * The name of the LAG.
-//
-//    * The value for the minimum number of connections that must be operational
-//    for the LAG itself to be operational.
-//
-// When you create a LAG, the default value for the minimum number of operational
-// connections is zero (0). If you update this value and the number of operational
-// connections falls below the specified value, the LAG automatically goes down
-// to avoid over-utilization of the remaining connections. Adjust this value
-// with care, as it could force the LAG down if it is set higher than the current
-// number of operational connections.
+// Stops the virtual interface failover test.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Direct Connect's
-// API operation UpdateLag for usage and error information.
+// API operation StopBgpFailoverTest for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - ServerException
+//     A server-side error occurred.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/UpdateLag
-func (c *DirectConnect) UpdateLag(input *UpdateLagInput) (*Lag, error) {
-	req, out := c.UpdateLagRequest(input)
+//   - ClientException
+//     One or more parameters are not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/StopBgpFailoverTest
+func (c *DirectConnect) StopBgpFailoverTest(input *StopBgpFailoverTestInput) (*StopBgpFailoverTestOutput, error) {
+	req, out := c.StopBgpFailoverTestRequest(input)
 	return out, req.Send()
 }
 
-// UpdateLagWithContext is the same as UpdateLag with the addition of
+// StopBgpFailoverTestWithContext is the same as StopBgpFailoverTest with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateLag for details on how to use this API operation.
+// See StopBgpFailoverTest for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectConnect) UpdateLagWithContext(ctx aws.Context, input *UpdateLagInput, opts ...request.Option) (*Lag, error) {
-	req, out := c.UpdateLagRequest(input)
+func (c *DirectConnect) StopBgpFailoverTestWithContext(ctx aws.Context, input *StopBgpFailoverTestInput, opts ...request.Option) (*StopBgpFailoverTestOutput, error) {
+	req, out := c.StopBgpFailoverTestRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateVirtualInterfaceAttributes = "UpdateVirtualInterfaceAttributes"
+const opTagResource = "TagResource"
 
-// UpdateVirtualInterfaceAttributesRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateVirtualInterfaceAttributes operation. The "output" return
+// TagResourceRequest generates a "aws/request.Request" representing the
+// client's request for the TagResource operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateVirtualInterfaceAttributes for more information on using the UpdateVirtualInterfaceAttributes
+// See TagResource for more information on using the TagResource
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the TagResourceRequest method.
+//	req, resp := client.TagResourceRequest(params)
 //
-//    // Example sending a request using the UpdateVirtualInterfaceAttributesRequest method.
-//    req, resp := client.UpdateVirtualInterfaceAttributesRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/UpdateVirtualInterfaceAttributes
-func (c *DirectConnect) UpdateVirtualInterfaceAttributesRequest(input *UpdateVirtualInterfaceAttributesInput) (req *request.Request, output *UpdateVirtualInterfaceAttributesOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/TagResource
+func (c *DirectConnect) TagResourceRequest(input *TagResourceInput) (req *request.Request, output *TagResourceOutput) {
 	op := &request.Operation{
-		Name:       opUpdateVirtualInterfaceAttributes,
+		Name:       opTagResource,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &UpdateVirtualInterfaceAttributesInput{}
+		input = &TagResourceInput{}
 	}
 
-	output = &UpdateVirtualInterfaceAttributesOutput{}
+	output = &TagResourceOutput{}
 	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// UpdateVirtualInterfaceAttributes API operation for AWS Direct Connect.
+// TagResource API operation for AWS Direct Connect.
 //
-// Updates the specified attributes of the specified virtual private interface.
+// Adds the specified tags to the specified Direct Connect resource. Each resource
+// can have a maximum of 50 tags.
 //
-// Setting the MTU of a virtual interface to 9001 (jumbo frames) can cause an
-// update to the underlying physical connection if it wasn't updated to support
-// jumbo frames. Updating the connection disrupts network connectivity for all
-// virtual interfaces associated with the connection for up to 30 seconds. To
-// check whether your connection supports jumbo frames, call DescribeConnections.
-// To check whether your virtual interface supports jumbo frames, call DescribeVirtualInterfaces.
+// Each tag consists of a key and an optional value. If a tag with the same
+// key is already associated with the resource, this action updates its value.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Direct Connect's
-// API operation UpdateVirtualInterfaceAttributes for usage and error information.
+// API operation TagResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeServerException "DirectConnectServerException"
-//   A server-side error occurred.
+// Returned Error Types:
 //
-//   * ErrCodeClientException "DirectConnectClientException"
-//   One or more parameters are not valid.
+//   - DuplicateTagKeysException
+//     A tag key was specified more than once.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/UpdateVirtualInterfaceAttributes
-func (c *DirectConnect) UpdateVirtualInterfaceAttributes(input *UpdateVirtualInterfaceAttributesInput) (*UpdateVirtualInterfaceAttributesOutput, error) {
-	req, out := c.UpdateVirtualInterfaceAttributesRequest(input)
+//   - TooManyTagsException
+//     You have reached the limit on the number of tags that can be assigned.
+//
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/TagResource
+func (c *DirectConnect) TagResource(input *TagResourceInput) (*TagResourceOutput, error) {
+	req, out := c.TagResourceRequest(input)
 	return out, req.Send()
 }
 
-// UpdateVirtualInterfaceAttributesWithContext is the same as UpdateVirtualInterfaceAttributes with the addition of
+// TagResourceWithContext is the same as TagResource with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateVirtualInterfaceAttributes for details on how to use this API operation.
+// See TagResource for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectConnect) UpdateVirtualInterfaceAttributesWithContext(ctx aws.Context, input *UpdateVirtualInterfaceAttributesInput, opts ...request.Option) (*UpdateVirtualInterfaceAttributesOutput, error) {
-	req, out := c.UpdateVirtualInterfaceAttributesRequest(input)
+func (c *DirectConnect) TagResourceWithContext(ctx aws.Context, input *TagResourceInput, opts ...request.Option) (*TagResourceOutput, error) {
+	req, out := c.TagResourceRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-type AcceptDirectConnectGatewayAssociationProposalInput struct {
-	_ struct{} `type:"structure"`
-
-	// The ID of the AWS account that owns the virtual private gateway or transit
-	// gateway.
-	//
-	// AssociatedGatewayOwnerAccount is a required field
-	AssociatedGatewayOwnerAccount *string `locationName:"associatedGatewayOwnerAccount" type:"string" required:"true"`
+const opUntagResource = "UntagResource"
 
-	// The ID of the Direct Connect gateway.
-	//
-	// DirectConnectGatewayId is a required field
-	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string" required:"true"`
+// UntagResourceRequest generates a "aws/request.Request" representing the
+// client's request for the UntagResource operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UntagResource for more information on using the UntagResource
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UntagResourceRequest method.
+//	req, resp := client.UntagResourceRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/UntagResource
+func (c *DirectConnect) UntagResourceRequest(input *UntagResourceInput) (req *request.Request, output *UntagResourceOutput) {
+	op := &request.Operation{
+		Name:       opUntagResource,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
 
-	// Overrides the Amazon VPC prefixes advertised to the Direct Connect gateway.
-	//
-	// For information about how to set the prefixes, see Allowed Prefixes (https://docs.aws.amazon.com/directconnect/latest/UserGuide/multi-account-associate-vgw.html#allowed-prefixes)
-	// in the AWS Direct Connect User Guide.
-	OverrideAllowedPrefixesToDirectConnectGateway []*RouteFilterPrefix `locationName:"overrideAllowedPrefixesToDirectConnectGateway" type:"list"`
+	if input == nil {
+		input = &UntagResourceInput{}
+	}
 
-	// The ID of the request proposal.
+	output = &UntagResourceOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// UntagResource API operation for AWS Direct Connect.
+//
+// Removes one or more tags from the specified Direct Connect resource.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Direct Connect's
+// API operation UntagResource for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/UntagResource
+func (c *DirectConnect) UntagResource(input *UntagResourceInput) (*UntagResourceOutput, error) {
+	req, out := c.UntagResourceRequest(input)
+	return out, req.Send()
+}
+
+// UntagResourceWithContext is the same as UntagResource with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UntagResource for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectConnect) UntagResourceWithContext(ctx aws.Context, input *UntagResourceInput, opts ...request.Option) (*UntagResourceOutput, error) {
+	req, out := c.UntagResourceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateConnection = "UpdateConnection"
+
+// UpdateConnectionRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateConnection operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateConnection for more information on using the UpdateConnection
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateConnectionRequest method.
+//	req, resp := client.UpdateConnectionRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/UpdateConnection
+func (c *DirectConnect) UpdateConnectionRequest(input *UpdateConnectionInput) (req *request.Request, output *UpdateConnectionOutput) {
+	op := &request.Operation{
+		Name:       opUpdateConnection,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UpdateConnectionInput{}
+	}
+
+	output = &UpdateConnectionOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UpdateConnection API operation for AWS Direct Connect.
+//
+// Updates the Direct Connect dedicated connection configuration.
+//
+// You can update the following parameters for a connection:
+//
+//   - The connection name
+//
+//   - The connection's MAC Security (MACsec) encryption mode.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Direct Connect's
+// API operation UpdateConnection for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/UpdateConnection
+func (c *DirectConnect) UpdateConnection(input *UpdateConnectionInput) (*UpdateConnectionOutput, error) {
+	req, out := c.UpdateConnectionRequest(input)
+	return out, req.Send()
+}
+
+// UpdateConnectionWithContext is the same as UpdateConnection with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateConnection for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectConnect) UpdateConnectionWithContext(ctx aws.Context, input *UpdateConnectionInput, opts ...request.Option) (*UpdateConnectionOutput, error) {
+	req, out := c.UpdateConnectionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateDirectConnectGateway = "UpdateDirectConnectGateway"
+
+// UpdateDirectConnectGatewayRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateDirectConnectGateway operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateDirectConnectGateway for more information on using the UpdateDirectConnectGateway
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateDirectConnectGatewayRequest method.
+//	req, resp := client.UpdateDirectConnectGatewayRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/UpdateDirectConnectGateway
+func (c *DirectConnect) UpdateDirectConnectGatewayRequest(input *UpdateDirectConnectGatewayInput) (req *request.Request, output *UpdateDirectConnectGatewayOutput) {
+	op := &request.Operation{
+		Name:       opUpdateDirectConnectGateway,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UpdateDirectConnectGatewayInput{}
+	}
+
+	output = &UpdateDirectConnectGatewayOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UpdateDirectConnectGateway API operation for AWS Direct Connect.
+//
+// Updates the name of a current Direct Connect gateway.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Direct Connect's
+// API operation UpdateDirectConnectGateway for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/UpdateDirectConnectGateway
+func (c *DirectConnect) UpdateDirectConnectGateway(input *UpdateDirectConnectGatewayInput) (*UpdateDirectConnectGatewayOutput, error) {
+	req, out := c.UpdateDirectConnectGatewayRequest(input)
+	return out, req.Send()
+}
+
+// UpdateDirectConnectGatewayWithContext is the same as UpdateDirectConnectGateway with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateDirectConnectGateway for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectConnect) UpdateDirectConnectGatewayWithContext(ctx aws.Context, input *UpdateDirectConnectGatewayInput, opts ...request.Option) (*UpdateDirectConnectGatewayOutput, error) {
+	req, out := c.UpdateDirectConnectGatewayRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateDirectConnectGatewayAssociation = "UpdateDirectConnectGatewayAssociation"
+
+// UpdateDirectConnectGatewayAssociationRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateDirectConnectGatewayAssociation operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateDirectConnectGatewayAssociation for more information on using the UpdateDirectConnectGatewayAssociation
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateDirectConnectGatewayAssociationRequest method.
+//	req, resp := client.UpdateDirectConnectGatewayAssociationRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/UpdateDirectConnectGatewayAssociation
+func (c *DirectConnect) UpdateDirectConnectGatewayAssociationRequest(input *UpdateDirectConnectGatewayAssociationInput) (req *request.Request, output *UpdateDirectConnectGatewayAssociationOutput) {
+	op := &request.Operation{
+		Name:       opUpdateDirectConnectGatewayAssociation,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UpdateDirectConnectGatewayAssociationInput{}
+	}
+
+	output = &UpdateDirectConnectGatewayAssociationOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UpdateDirectConnectGatewayAssociation API operation for AWS Direct Connect.
+//
+// Updates the specified attributes of the Direct Connect gateway association.
+//
+// Add or remove prefixes from the association.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Direct Connect's
+// API operation UpdateDirectConnectGatewayAssociation for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/UpdateDirectConnectGatewayAssociation
+func (c *DirectConnect) UpdateDirectConnectGatewayAssociation(input *UpdateDirectConnectGatewayAssociationInput) (*UpdateDirectConnectGatewayAssociationOutput, error) {
+	req, out := c.UpdateDirectConnectGatewayAssociationRequest(input)
+	return out, req.Send()
+}
+
+// UpdateDirectConnectGatewayAssociationWithContext is the same as UpdateDirectConnectGatewayAssociation with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateDirectConnectGatewayAssociation for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectConnect) UpdateDirectConnectGatewayAssociationWithContext(ctx aws.Context, input *UpdateDirectConnectGatewayAssociationInput, opts ...request.Option) (*UpdateDirectConnectGatewayAssociationOutput, error) {
+	req, out := c.UpdateDirectConnectGatewayAssociationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateLag = "UpdateLag"
+
+// UpdateLagRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateLag operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateLag for more information on using the UpdateLag
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateLagRequest method.
+//	req, resp := client.UpdateLagRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/UpdateLag
+func (c *DirectConnect) UpdateLagRequest(input *UpdateLagInput) (req *request.Request, output *Lag) {
+	op := &request.Operation{
+		Name:       opUpdateLag,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UpdateLagInput{}
+	}
+
+	output = &Lag{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UpdateLag API operation for AWS Direct Connect.
+//
+// Updates the attributes of the specified link aggregation group (LAG).
+//
+// You can update the following LAG attributes:
+//
+//   - The name of the LAG.
+//
+//   - The value for the minimum number of connections that must be operational
+//     for the LAG itself to be operational.
+//
+//   - The LAG's MACsec encryption mode. Amazon Web Services assigns this value
+//     to each connection which is part of the LAG.
+//
+//   - The tags
+//
+// If you adjust the threshold value for the minimum number of operational connections,
+// ensure that the new value does not cause the LAG to fall below the threshold
+// and become non-operational.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Direct Connect's
+// API operation UpdateLag for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/UpdateLag
+func (c *DirectConnect) UpdateLag(input *UpdateLagInput) (*Lag, error) {
+	req, out := c.UpdateLagRequest(input)
+	return out, req.Send()
+}
+
+// UpdateLagWithContext is the same as UpdateLag with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateLag for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectConnect) UpdateLagWithContext(ctx aws.Context, input *UpdateLagInput, opts ...request.Option) (*Lag, error) {
+	req, out := c.UpdateLagRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateVirtualInterfaceAttributes = "UpdateVirtualInterfaceAttributes"
+
+// UpdateVirtualInterfaceAttributesRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateVirtualInterfaceAttributes operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateVirtualInterfaceAttributes for more information on using the UpdateVirtualInterfaceAttributes
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateVirtualInterfaceAttributesRequest method.
+//	req, resp := client.UpdateVirtualInterfaceAttributesRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/UpdateVirtualInterfaceAttributes
+func (c *DirectConnect) UpdateVirtualInterfaceAttributesRequest(input *UpdateVirtualInterfaceAttributesInput) (req *request.Request, output *UpdateVirtualInterfaceAttributesOutput) {
+	op := &request.Operation{
+		Name:       opUpdateVirtualInterfaceAttributes,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UpdateVirtualInterfaceAttributesInput{}
+	}
+
+	output = &UpdateVirtualInterfaceAttributesOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UpdateVirtualInterfaceAttributes API operation for AWS Direct Connect.
+//
+// Updates the specified attributes of the specified virtual private interface.
+//
+// Setting the MTU of a virtual interface to 9001 (jumbo frames) can cause an
+// update to the underlying physical connection if it wasn't updated to support
+// jumbo frames. Updating the connection disrupts network connectivity for all
+// virtual interfaces associated with the connection for up to 30 seconds. To
+// check whether your connection supports jumbo frames, call DescribeConnections.
+// To check whether your virtual interface supports jumbo frames, call DescribeVirtualInterfaces.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Direct Connect's
+// API operation UpdateVirtualInterfaceAttributes for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ServerException
+//     A server-side error occurred.
+//
+//   - ClientException
+//     One or more parameters are not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/directconnect-2012-10-25/UpdateVirtualInterfaceAttributes
+func (c *DirectConnect) UpdateVirtualInterfaceAttributes(input *UpdateVirtualInterfaceAttributesInput) (*UpdateVirtualInterfaceAttributesOutput, error) {
+	req, out := c.UpdateVirtualInterfaceAttributesRequest(input)
+	return out, req.Send()
+}
+
+// UpdateVirtualInterfaceAttributesWithContext is the same as UpdateVirtualInterfaceAttributes with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateVirtualInterfaceAttributes for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectConnect) UpdateVirtualInterfaceAttributesWithContext(ctx aws.Context, input *UpdateVirtualInterfaceAttributesInput, opts ...request.Option) (*UpdateVirtualInterfaceAttributesOutput, error) {
+	req, out := c.UpdateVirtualInterfaceAttributesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+type AcceptDirectConnectGatewayAssociationProposalInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ID of the Amazon Web Services account that owns the virtual private gateway
+	// or transit gateway.
+	//
+	// AssociatedGatewayOwnerAccount is a required field
+	AssociatedGatewayOwnerAccount *string `locationName:"associatedGatewayOwnerAccount" type:"string" required:"true"`
+
+	// The ID of the Direct Connect gateway.
+	//
+	// DirectConnectGatewayId is a required field
+	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string" required:"true"`
+
+	// Overrides the Amazon VPC prefixes advertised to the Direct Connect gateway.
+	//
+	// For information about how to set the prefixes, see Allowed Prefixes (https://docs.aws.amazon.com/directconnect/latest/UserGuide/multi-account-associate-vgw.html#allowed-prefixes)
+	// in the Direct Connect User Guide.
+	OverrideAllowedPrefixesToDirectConnectGateway []*RouteFilterPrefix `locationName:"overrideAllowedPrefixesToDirectConnectGateway" type:"list"`
+
+	// The ID of the request proposal.
+	//
+	// ProposalId is a required field
+	ProposalId *string `locationName:"proposalId" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AcceptDirectConnectGatewayAssociationProposalInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AcceptDirectConnectGatewayAssociationProposalInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *AcceptDirectConnectGatewayAssociationProposalInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AcceptDirectConnectGatewayAssociationProposalInput"}
+	if s.AssociatedGatewayOwnerAccount == nil {
+		invalidParams.Add(request.NewErrParamRequired("AssociatedGatewayOwnerAccount"))
+	}
+	if s.DirectConnectGatewayId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectConnectGatewayId"))
+	}
+	if s.ProposalId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ProposalId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAssociatedGatewayOwnerAccount sets the AssociatedGatewayOwnerAccount field's value.
+func (s *AcceptDirectConnectGatewayAssociationProposalInput) SetAssociatedGatewayOwnerAccount(v string) *AcceptDirectConnectGatewayAssociationProposalInput {
+	s.AssociatedGatewayOwnerAccount = &v
+	return s
+}
+
+// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
+func (s *AcceptDirectConnectGatewayAssociationProposalInput) SetDirectConnectGatewayId(v string) *AcceptDirectConnectGatewayAssociationProposalInput {
+	s.DirectConnectGatewayId = &v
+	return s
+}
+
+// SetOverrideAllowedPrefixesToDirectConnectGateway sets the OverrideAllowedPrefixesToDirectConnectGateway field's value.
+func (s *AcceptDirectConnectGatewayAssociationProposalInput) SetOverrideAllowedPrefixesToDirectConnectGateway(v []*RouteFilterPrefix) *AcceptDirectConnectGatewayAssociationProposalInput {
+	s.OverrideAllowedPrefixesToDirectConnectGateway = v
+	return s
+}
+
+// SetProposalId sets the ProposalId field's value.
+func (s *AcceptDirectConnectGatewayAssociationProposalInput) SetProposalId(v string) *AcceptDirectConnectGatewayAssociationProposalInput {
+	s.ProposalId = &v
+	return s
+}
+
+type AcceptDirectConnectGatewayAssociationProposalOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Information about an association between a Direct Connect gateway and a virtual
+	// private gateway or transit gateway.
+	DirectConnectGatewayAssociation *GatewayAssociation `locationName:"directConnectGatewayAssociation" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AcceptDirectConnectGatewayAssociationProposalOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AcceptDirectConnectGatewayAssociationProposalOutput) GoString() string {
+	return s.String()
+}
+
+// SetDirectConnectGatewayAssociation sets the DirectConnectGatewayAssociation field's value.
+func (s *AcceptDirectConnectGatewayAssociationProposalOutput) SetDirectConnectGatewayAssociation(v *GatewayAssociation) *AcceptDirectConnectGatewayAssociationProposalOutput {
+	s.DirectConnectGatewayAssociation = v
+	return s
+}
+
+type AllocateConnectionOnInterconnectInput struct {
+	_ struct{} `type:"structure"`
+
+	// The bandwidth of the connection. The possible values are 50Mbps, 100Mbps,
+	// 200Mbps, 300Mbps, 400Mbps, 500Mbps, 1Gbps, 2Gbps, 5Gbps, and 10Gbps. Note
+	// that only those Direct Connect Partners who have met specific requirements
+	// are allowed to create a 1Gbps, 2Gbps, 5Gbps or 10Gbps hosted connection.
+	//
+	// Bandwidth is a required field
+	Bandwidth *string `locationName:"bandwidth" type:"string" required:"true"`
+
+	// The name of the provisioned connection.
+	//
+	// ConnectionName is a required field
+	ConnectionName *string `locationName:"connectionName" type:"string" required:"true"`
+
+	// The ID of the interconnect on which the connection will be provisioned.
+	//
+	// InterconnectId is a required field
+	InterconnectId *string `locationName:"interconnectId" type:"string" required:"true"`
+
+	// The ID of the Amazon Web Services account of the customer for whom the connection
+	// will be provisioned.
+	//
+	// OwnerAccount is a required field
+	OwnerAccount *string `locationName:"ownerAccount" type:"string" required:"true"`
+
+	// The dedicated VLAN provisioned to the connection.
+	//
+	// Vlan is a required field
+	Vlan *int64 `locationName:"vlan" type:"integer" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AllocateConnectionOnInterconnectInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AllocateConnectionOnInterconnectInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *AllocateConnectionOnInterconnectInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AllocateConnectionOnInterconnectInput"}
+	if s.Bandwidth == nil {
+		invalidParams.Add(request.NewErrParamRequired("Bandwidth"))
+	}
+	if s.ConnectionName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionName"))
+	}
+	if s.InterconnectId == nil {
+		invalidParams.Add(request.NewErrParamRequired("InterconnectId"))
+	}
+	if s.OwnerAccount == nil {
+		invalidParams.Add(request.NewErrParamRequired("OwnerAccount"))
+	}
+	if s.Vlan == nil {
+		invalidParams.Add(request.NewErrParamRequired("Vlan"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetBandwidth sets the Bandwidth field's value.
+func (s *AllocateConnectionOnInterconnectInput) SetBandwidth(v string) *AllocateConnectionOnInterconnectInput {
+	s.Bandwidth = &v
+	return s
+}
+
+// SetConnectionName sets the ConnectionName field's value.
+func (s *AllocateConnectionOnInterconnectInput) SetConnectionName(v string) *AllocateConnectionOnInterconnectInput {
+	s.ConnectionName = &v
+	return s
+}
+
+// SetInterconnectId sets the InterconnectId field's value.
+func (s *AllocateConnectionOnInterconnectInput) SetInterconnectId(v string) *AllocateConnectionOnInterconnectInput {
+	s.InterconnectId = &v
+	return s
+}
+
+// SetOwnerAccount sets the OwnerAccount field's value.
+func (s *AllocateConnectionOnInterconnectInput) SetOwnerAccount(v string) *AllocateConnectionOnInterconnectInput {
+	s.OwnerAccount = &v
+	return s
+}
+
+// SetVlan sets the Vlan field's value.
+func (s *AllocateConnectionOnInterconnectInput) SetVlan(v int64) *AllocateConnectionOnInterconnectInput {
+	s.Vlan = &v
+	return s
+}
+
+type AllocateHostedConnectionInput struct {
+	_ struct{} `type:"structure"`
+
+	// The bandwidth of the connection. The possible values are 50Mbps, 100Mbps,
+	// 200Mbps, 300Mbps, 400Mbps, 500Mbps, 1Gbps, 2Gbps, 5Gbps, and 10Gbps. Note
+	// that only those Direct Connect Partners who have met specific requirements
+	// are allowed to create a 1Gbps, 2Gbps, 5Gbps or 10Gbps hosted connection.
+	//
+	// Bandwidth is a required field
+	Bandwidth *string `locationName:"bandwidth" type:"string" required:"true"`
+
+	// The ID of the interconnect or LAG.
+	//
+	// ConnectionId is a required field
+	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
+
+	// The name of the hosted connection.
+	//
+	// ConnectionName is a required field
+	ConnectionName *string `locationName:"connectionName" type:"string" required:"true"`
+
+	// The ID of the Amazon Web Services account ID of the customer for the connection.
+	//
+	// OwnerAccount is a required field
+	OwnerAccount *string `locationName:"ownerAccount" type:"string" required:"true"`
+
+	// The tags associated with the connection.
+	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
+
+	// The dedicated VLAN provisioned to the hosted connection.
+	//
+	// Vlan is a required field
+	Vlan *int64 `locationName:"vlan" type:"integer" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AllocateHostedConnectionInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AllocateHostedConnectionInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *AllocateHostedConnectionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AllocateHostedConnectionInput"}
+	if s.Bandwidth == nil {
+		invalidParams.Add(request.NewErrParamRequired("Bandwidth"))
+	}
+	if s.ConnectionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
+	}
+	if s.ConnectionName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionName"))
+	}
+	if s.OwnerAccount == nil {
+		invalidParams.Add(request.NewErrParamRequired("OwnerAccount"))
+	}
+	if s.Tags != nil && len(s.Tags) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
+	}
+	if s.Vlan == nil {
+		invalidParams.Add(request.NewErrParamRequired("Vlan"))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetBandwidth sets the Bandwidth field's value.
+func (s *AllocateHostedConnectionInput) SetBandwidth(v string) *AllocateHostedConnectionInput {
+	s.Bandwidth = &v
+	return s
+}
+
+// SetConnectionId sets the ConnectionId field's value.
+func (s *AllocateHostedConnectionInput) SetConnectionId(v string) *AllocateHostedConnectionInput {
+	s.ConnectionId = &v
+	return s
+}
+
+// SetConnectionName sets the ConnectionName field's value.
+func (s *AllocateHostedConnectionInput) SetConnectionName(v string) *AllocateHostedConnectionInput {
+	s.ConnectionName = &v
+	return s
+}
+
+// SetOwnerAccount sets the OwnerAccount field's value.
+func (s *AllocateHostedConnectionInput) SetOwnerAccount(v string) *AllocateHostedConnectionInput {
+	s.OwnerAccount = &v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *AllocateHostedConnectionInput) SetTags(v []*Tag) *AllocateHostedConnectionInput {
+	s.Tags = v
+	return s
+}
+
+// SetVlan sets the Vlan field's value.
+func (s *AllocateHostedConnectionInput) SetVlan(v int64) *AllocateHostedConnectionInput {
+	s.Vlan = &v
+	return s
+}
+
+type AllocatePrivateVirtualInterfaceInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ID of the connection on which the private virtual interface is provisioned.
+	//
+	// ConnectionId is a required field
+	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
+
+	// Information about the private virtual interface.
+	//
+	// NewPrivateVirtualInterfaceAllocation is a required field
+	NewPrivateVirtualInterfaceAllocation *NewPrivateVirtualInterfaceAllocation `locationName:"newPrivateVirtualInterfaceAllocation" type:"structure" required:"true"`
+
+	// The ID of the Amazon Web Services account that owns the virtual private interface.
+	//
+	// OwnerAccount is a required field
+	OwnerAccount *string `locationName:"ownerAccount" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AllocatePrivateVirtualInterfaceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AllocatePrivateVirtualInterfaceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *AllocatePrivateVirtualInterfaceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AllocatePrivateVirtualInterfaceInput"}
+	if s.ConnectionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
+	}
+	if s.NewPrivateVirtualInterfaceAllocation == nil {
+		invalidParams.Add(request.NewErrParamRequired("NewPrivateVirtualInterfaceAllocation"))
+	}
+	if s.OwnerAccount == nil {
+		invalidParams.Add(request.NewErrParamRequired("OwnerAccount"))
+	}
+	if s.NewPrivateVirtualInterfaceAllocation != nil {
+		if err := s.NewPrivateVirtualInterfaceAllocation.Validate(); err != nil {
+			invalidParams.AddNested("NewPrivateVirtualInterfaceAllocation", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetConnectionId sets the ConnectionId field's value.
+func (s *AllocatePrivateVirtualInterfaceInput) SetConnectionId(v string) *AllocatePrivateVirtualInterfaceInput {
+	s.ConnectionId = &v
+	return s
+}
+
+// SetNewPrivateVirtualInterfaceAllocation sets the NewPrivateVirtualInterfaceAllocation field's value.
+func (s *AllocatePrivateVirtualInterfaceInput) SetNewPrivateVirtualInterfaceAllocation(v *NewPrivateVirtualInterfaceAllocation) *AllocatePrivateVirtualInterfaceInput {
+	s.NewPrivateVirtualInterfaceAllocation = v
+	return s
+}
+
+// SetOwnerAccount sets the OwnerAccount field's value.
+func (s *AllocatePrivateVirtualInterfaceInput) SetOwnerAccount(v string) *AllocatePrivateVirtualInterfaceInput {
+	s.OwnerAccount = &v
+	return s
+}
+
+type AllocatePublicVirtualInterfaceInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ID of the connection on which the public virtual interface is provisioned.
+	//
+	// ConnectionId is a required field
+	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
+
+	// Information about the public virtual interface.
+	//
+	// NewPublicVirtualInterfaceAllocation is a required field
+	NewPublicVirtualInterfaceAllocation *NewPublicVirtualInterfaceAllocation `locationName:"newPublicVirtualInterfaceAllocation" type:"structure" required:"true"`
+
+	// The ID of the Amazon Web Services account that owns the public virtual interface.
+	//
+	// OwnerAccount is a required field
+	OwnerAccount *string `locationName:"ownerAccount" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AllocatePublicVirtualInterfaceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AllocatePublicVirtualInterfaceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *AllocatePublicVirtualInterfaceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AllocatePublicVirtualInterfaceInput"}
+	if s.ConnectionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
+	}
+	if s.NewPublicVirtualInterfaceAllocation == nil {
+		invalidParams.Add(request.NewErrParamRequired("NewPublicVirtualInterfaceAllocation"))
+	}
+	if s.OwnerAccount == nil {
+		invalidParams.Add(request.NewErrParamRequired("OwnerAccount"))
+	}
+	if s.NewPublicVirtualInterfaceAllocation != nil {
+		if err := s.NewPublicVirtualInterfaceAllocation.Validate(); err != nil {
+			invalidParams.AddNested("NewPublicVirtualInterfaceAllocation", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetConnectionId sets the ConnectionId field's value.
+func (s *AllocatePublicVirtualInterfaceInput) SetConnectionId(v string) *AllocatePublicVirtualInterfaceInput {
+	s.ConnectionId = &v
+	return s
+}
+
+// SetNewPublicVirtualInterfaceAllocation sets the NewPublicVirtualInterfaceAllocation field's value.
+func (s *AllocatePublicVirtualInterfaceInput) SetNewPublicVirtualInterfaceAllocation(v *NewPublicVirtualInterfaceAllocation) *AllocatePublicVirtualInterfaceInput {
+	s.NewPublicVirtualInterfaceAllocation = v
+	return s
+}
+
+// SetOwnerAccount sets the OwnerAccount field's value.
+func (s *AllocatePublicVirtualInterfaceInput) SetOwnerAccount(v string) *AllocatePublicVirtualInterfaceInput {
+	s.OwnerAccount = &v
+	return s
+}
+
+type AllocateTransitVirtualInterfaceInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ID of the connection on which the transit virtual interface is provisioned.
+	//
+	// ConnectionId is a required field
+	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
+
+	// Information about the transit virtual interface.
+	//
+	// NewTransitVirtualInterfaceAllocation is a required field
+	NewTransitVirtualInterfaceAllocation *NewTransitVirtualInterfaceAllocation `locationName:"newTransitVirtualInterfaceAllocation" type:"structure" required:"true"`
+
+	// The ID of the Amazon Web Services account that owns the transit virtual interface.
+	//
+	// OwnerAccount is a required field
+	OwnerAccount *string `locationName:"ownerAccount" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AllocateTransitVirtualInterfaceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AllocateTransitVirtualInterfaceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *AllocateTransitVirtualInterfaceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AllocateTransitVirtualInterfaceInput"}
+	if s.ConnectionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
+	}
+	if s.NewTransitVirtualInterfaceAllocation == nil {
+		invalidParams.Add(request.NewErrParamRequired("NewTransitVirtualInterfaceAllocation"))
+	}
+	if s.OwnerAccount == nil {
+		invalidParams.Add(request.NewErrParamRequired("OwnerAccount"))
+	}
+	if s.NewTransitVirtualInterfaceAllocation != nil {
+		if err := s.NewTransitVirtualInterfaceAllocation.Validate(); err != nil {
+			invalidParams.AddNested("NewTransitVirtualInterfaceAllocation", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetConnectionId sets the ConnectionId field's value.
+func (s *AllocateTransitVirtualInterfaceInput) SetConnectionId(v string) *AllocateTransitVirtualInterfaceInput {
+	s.ConnectionId = &v
+	return s
+}
+
+// SetNewTransitVirtualInterfaceAllocation sets the NewTransitVirtualInterfaceAllocation field's value.
+func (s *AllocateTransitVirtualInterfaceInput) SetNewTransitVirtualInterfaceAllocation(v *NewTransitVirtualInterfaceAllocation) *AllocateTransitVirtualInterfaceInput {
+	s.NewTransitVirtualInterfaceAllocation = v
+	return s
+}
+
+// SetOwnerAccount sets the OwnerAccount field's value.
+func (s *AllocateTransitVirtualInterfaceInput) SetOwnerAccount(v string) *AllocateTransitVirtualInterfaceInput {
+	s.OwnerAccount = &v
+	return s
+}
+
+type AllocateTransitVirtualInterfaceOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Information about a virtual interface.
+	VirtualInterface *VirtualInterface `locationName:"virtualInterface" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AllocateTransitVirtualInterfaceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AllocateTransitVirtualInterfaceOutput) GoString() string {
+	return s.String()
+}
+
+// SetVirtualInterface sets the VirtualInterface field's value.
+func (s *AllocateTransitVirtualInterfaceOutput) SetVirtualInterface(v *VirtualInterface) *AllocateTransitVirtualInterfaceOutput {
+	s.VirtualInterface = v
+	return s
+}
+
+type AssociateConnectionWithLagInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ID of the connection.
+	//
+	// ConnectionId is a required field
+	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
+
+	// The ID of the LAG with which to associate the connection.
+	//
+	// LagId is a required field
+	LagId *string `locationName:"lagId" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AssociateConnectionWithLagInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AssociateConnectionWithLagInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *AssociateConnectionWithLagInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssociateConnectionWithLagInput"}
+	if s.ConnectionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
+	}
+	if s.LagId == nil {
+		invalidParams.Add(request.NewErrParamRequired("LagId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetConnectionId sets the ConnectionId field's value.
+func (s *AssociateConnectionWithLagInput) SetConnectionId(v string) *AssociateConnectionWithLagInput {
+	s.ConnectionId = &v
+	return s
+}
+
+// SetLagId sets the LagId field's value.
+func (s *AssociateConnectionWithLagInput) SetLagId(v string) *AssociateConnectionWithLagInput {
+	s.LagId = &v
+	return s
+}
+
+type AssociateHostedConnectionInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ID of the hosted connection.
+	//
+	// ConnectionId is a required field
+	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
+
+	// The ID of the interconnect or the LAG.
+	//
+	// ParentConnectionId is a required field
+	ParentConnectionId *string `locationName:"parentConnectionId" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AssociateHostedConnectionInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AssociateHostedConnectionInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *AssociateHostedConnectionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssociateHostedConnectionInput"}
+	if s.ConnectionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
+	}
+	if s.ParentConnectionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ParentConnectionId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetConnectionId sets the ConnectionId field's value.
+func (s *AssociateHostedConnectionInput) SetConnectionId(v string) *AssociateHostedConnectionInput {
+	s.ConnectionId = &v
+	return s
+}
+
+// SetParentConnectionId sets the ParentConnectionId field's value.
+func (s *AssociateHostedConnectionInput) SetParentConnectionId(v string) *AssociateHostedConnectionInput {
+	s.ParentConnectionId = &v
+	return s
+}
+
+type AssociateMacSecKeyInput struct {
+	_ struct{} `type:"structure"`
+
+	// The MAC Security (MACsec) CAK to associate with the dedicated connection.
+	//
+	// You can create the CKN/CAK pair using an industry standard tool.
+	//
+	// The valid values are 64 hexadecimal characters (0-9, A-E).
+	//
+	// If you use this request parameter, you must use the ckn request parameter
+	// and not use the secretARN request parameter.
+	Cak *string `locationName:"cak" type:"string"`
+
+	// The MAC Security (MACsec) CKN to associate with the dedicated connection.
+	//
+	// You can create the CKN/CAK pair using an industry standard tool.
+	//
+	// The valid values are 64 hexadecimal characters (0-9, A-E).
+	//
+	// If you use this request parameter, you must use the cak request parameter
+	// and not use the secretARN request parameter.
+	Ckn *string `locationName:"ckn" type:"string"`
+
+	// The ID of the dedicated connection (dxcon-xxxx), or the ID of the LAG (dxlag-xxxx).
+	//
+	// You can use DescribeConnections or DescribeLags to retrieve connection ID.
+	//
+	// ConnectionId is a required field
+	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
+
+	// The Amazon Resource Name (ARN) of the MAC Security (MACsec) secret key to
+	// associate with the dedicated connection.
+	//
+	// You can use DescribeConnections or DescribeLags to retrieve the MAC Security
+	// (MACsec) secret key.
+	//
+	// If you use this request parameter, you do not use the ckn and cak request
+	// parameters.
+	SecretARN *string `locationName:"secretARN" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AssociateMacSecKeyInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AssociateMacSecKeyInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *AssociateMacSecKeyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssociateMacSecKeyInput"}
+	if s.ConnectionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCak sets the Cak field's value.
+func (s *AssociateMacSecKeyInput) SetCak(v string) *AssociateMacSecKeyInput {
+	s.Cak = &v
+	return s
+}
+
+// SetCkn sets the Ckn field's value.
+func (s *AssociateMacSecKeyInput) SetCkn(v string) *AssociateMacSecKeyInput {
+	s.Ckn = &v
+	return s
+}
+
+// SetConnectionId sets the ConnectionId field's value.
+func (s *AssociateMacSecKeyInput) SetConnectionId(v string) *AssociateMacSecKeyInput {
+	s.ConnectionId = &v
+	return s
+}
+
+// SetSecretARN sets the SecretARN field's value.
+func (s *AssociateMacSecKeyInput) SetSecretARN(v string) *AssociateMacSecKeyInput {
+	s.SecretARN = &v
+	return s
+}
+
+type AssociateMacSecKeyOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The ID of the dedicated connection (dxcon-xxxx), or the ID of the LAG (dxlag-xxxx).
+	ConnectionId *string `locationName:"connectionId" type:"string"`
+
+	// The MAC Security (MACsec) security keys associated with the dedicated connection.
+	MacSecKeys []*MacSecKey `locationName:"macSecKeys" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AssociateMacSecKeyOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AssociateMacSecKeyOutput) GoString() string {
+	return s.String()
+}
+
+// SetConnectionId sets the ConnectionId field's value.
+func (s *AssociateMacSecKeyOutput) SetConnectionId(v string) *AssociateMacSecKeyOutput {
+	s.ConnectionId = &v
+	return s
+}
+
+// SetMacSecKeys sets the MacSecKeys field's value.
+func (s *AssociateMacSecKeyOutput) SetMacSecKeys(v []*MacSecKey) *AssociateMacSecKeyOutput {
+	s.MacSecKeys = v
+	return s
+}
+
+type AssociateVirtualInterfaceInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ID of the LAG or connection.
+	//
+	// ConnectionId is a required field
+	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
+
+	// The ID of the virtual interface.
+	//
+	// VirtualInterfaceId is a required field
+	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AssociateVirtualInterfaceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AssociateVirtualInterfaceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *AssociateVirtualInterfaceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssociateVirtualInterfaceInput"}
+	if s.ConnectionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
+	}
+	if s.VirtualInterfaceId == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualInterfaceId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetConnectionId sets the ConnectionId field's value.
+func (s *AssociateVirtualInterfaceInput) SetConnectionId(v string) *AssociateVirtualInterfaceInput {
+	s.ConnectionId = &v
+	return s
+}
+
+// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
+func (s *AssociateVirtualInterfaceInput) SetVirtualInterfaceId(v string) *AssociateVirtualInterfaceInput {
+	s.VirtualInterfaceId = &v
+	return s
+}
+
+// Information about the associated gateway.
+type AssociatedGateway struct {
+	_ struct{} `type:"structure"`
+
+	// The ID of the associated gateway.
+	Id *string `locationName:"id" type:"string"`
+
+	// The ID of the Amazon Web Services account that owns the associated virtual
+	// private gateway or transit gateway.
+	OwnerAccount *string `locationName:"ownerAccount" type:"string"`
+
+	// The Region where the associated gateway is located.
+	Region *string `locationName:"region" type:"string"`
+
+	// The type of associated gateway.
+	Type *string `locationName:"type" type:"string" enum:"GatewayType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AssociatedGateway) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AssociatedGateway) GoString() string {
+	return s.String()
+}
+
+// SetId sets the Id field's value.
+func (s *AssociatedGateway) SetId(v string) *AssociatedGateway {
+	s.Id = &v
+	return s
+}
+
+// SetOwnerAccount sets the OwnerAccount field's value.
+func (s *AssociatedGateway) SetOwnerAccount(v string) *AssociatedGateway {
+	s.OwnerAccount = &v
+	return s
+}
+
+// SetRegion sets the Region field's value.
+func (s *AssociatedGateway) SetRegion(v string) *AssociatedGateway {
+	s.Region = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *AssociatedGateway) SetType(v string) *AssociatedGateway {
+	s.Type = &v
+	return s
+}
+
+// Information about a BGP peer.
+type BGPPeer struct {
+	_ struct{} `type:"structure"`
+
+	// The address family for the BGP peer.
+	AddressFamily *string `locationName:"addressFamily" type:"string" enum:"AddressFamily"`
+
+	// The IP address assigned to the Amazon interface.
+	AmazonAddress *string `locationName:"amazonAddress" type:"string"`
+
+	// The autonomous system (AS) number for Border Gateway Protocol (BGP) configuration.
+	Asn *int64 `locationName:"asn" type:"integer"`
+
+	// The authentication key for BGP configuration. This string has a minimum length
+	// of 6 characters and and a maximun lenth of 80 characters.
+	AuthKey *string `locationName:"authKey" type:"string"`
+
+	// The Direct Connect endpoint that terminates the BGP peer.
+	AwsDeviceV2 *string `locationName:"awsDeviceV2" type:"string"`
+
+	// The Direct Connect endpoint that terminates the logical connection. This
+	// device might be different than the device that terminates the physical connection.
+	AwsLogicalDeviceId *string `locationName:"awsLogicalDeviceId" type:"string"`
+
+	// The ID of the BGP peer.
+	BgpPeerId *string `locationName:"bgpPeerId" type:"string"`
+
+	// The state of the BGP peer. The following are the possible values:
 	//
-	// ProposalId is a required field
-	ProposalId *string `locationName:"proposalId" type:"string" required:"true"`
+	//    * verifying: The BGP peering addresses or ASN require validation before
+	//    the BGP peer can be created. This state applies only to public virtual
+	//    interfaces.
+	//
+	//    * pending: The BGP peer is created, and remains in this state until it
+	//    is ready to be established.
+	//
+	//    * available: The BGP peer is ready to be established.
+	//
+	//    * deleting: The BGP peer is being deleted.
+	//
+	//    * deleted: The BGP peer is deleted and cannot be established.
+	BgpPeerState *string `locationName:"bgpPeerState" type:"string" enum:"BGPPeerState"`
+
+	// The status of the BGP peer. The following are the possible values:
+	//
+	//    * up: The BGP peer is established. This state does not indicate the state
+	//    of the routing function. Ensure that you are receiving routes over the
+	//    BGP session.
+	//
+	//    * down: The BGP peer is down.
+	//
+	//    * unknown: The BGP peer status is not available.
+	BgpStatus *string `locationName:"bgpStatus" type:"string" enum:"BGPStatus"`
+
+	// The IP address assigned to the customer interface.
+	CustomerAddress *string `locationName:"customerAddress" type:"string"`
 }
 
-// String returns the string representation
-func (s AcceptDirectConnectGatewayAssociationProposalInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BGPPeer) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s AcceptDirectConnectGatewayAssociationProposalInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BGPPeer) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *AcceptDirectConnectGatewayAssociationProposalInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "AcceptDirectConnectGatewayAssociationProposalInput"}
-	if s.AssociatedGatewayOwnerAccount == nil {
-		invalidParams.Add(request.NewErrParamRequired("AssociatedGatewayOwnerAccount"))
-	}
-	if s.DirectConnectGatewayId == nil {
-		invalidParams.Add(request.NewErrParamRequired("DirectConnectGatewayId"))
-	}
-	if s.ProposalId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ProposalId"))
-	}
+// SetAddressFamily sets the AddressFamily field's value.
+func (s *BGPPeer) SetAddressFamily(v string) *BGPPeer {
+	s.AddressFamily = &v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetAmazonAddress sets the AmazonAddress field's value.
+func (s *BGPPeer) SetAmazonAddress(v string) *BGPPeer {
+	s.AmazonAddress = &v
+	return s
 }
 
-// SetAssociatedGatewayOwnerAccount sets the AssociatedGatewayOwnerAccount field's value.
-func (s *AcceptDirectConnectGatewayAssociationProposalInput) SetAssociatedGatewayOwnerAccount(v string) *AcceptDirectConnectGatewayAssociationProposalInput {
-	s.AssociatedGatewayOwnerAccount = &v
+// SetAsn sets the Asn field's value.
+func (s *BGPPeer) SetAsn(v int64) *BGPPeer {
+	s.Asn = &v
 	return s
 }
 
-// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
-func (s *AcceptDirectConnectGatewayAssociationProposalInput) SetDirectConnectGatewayId(v string) *AcceptDirectConnectGatewayAssociationProposalInput {
-	s.DirectConnectGatewayId = &v
+// SetAuthKey sets the AuthKey field's value.
+func (s *BGPPeer) SetAuthKey(v string) *BGPPeer {
+	s.AuthKey = &v
 	return s
 }
 
-// SetOverrideAllowedPrefixesToDirectConnectGateway sets the OverrideAllowedPrefixesToDirectConnectGateway field's value.
-func (s *AcceptDirectConnectGatewayAssociationProposalInput) SetOverrideAllowedPrefixesToDirectConnectGateway(v []*RouteFilterPrefix) *AcceptDirectConnectGatewayAssociationProposalInput {
-	s.OverrideAllowedPrefixesToDirectConnectGateway = v
+// SetAwsDeviceV2 sets the AwsDeviceV2 field's value.
+func (s *BGPPeer) SetAwsDeviceV2(v string) *BGPPeer {
+	s.AwsDeviceV2 = &v
 	return s
 }
 
-// SetProposalId sets the ProposalId field's value.
-func (s *AcceptDirectConnectGatewayAssociationProposalInput) SetProposalId(v string) *AcceptDirectConnectGatewayAssociationProposalInput {
-	s.ProposalId = &v
+// SetAwsLogicalDeviceId sets the AwsLogicalDeviceId field's value.
+func (s *BGPPeer) SetAwsLogicalDeviceId(v string) *BGPPeer {
+	s.AwsLogicalDeviceId = &v
 	return s
 }
 
-type AcceptDirectConnectGatewayAssociationProposalOutput struct {
+// SetBgpPeerId sets the BgpPeerId field's value.
+func (s *BGPPeer) SetBgpPeerId(v string) *BGPPeer {
+	s.BgpPeerId = &v
+	return s
+}
+
+// SetBgpPeerState sets the BgpPeerState field's value.
+func (s *BGPPeer) SetBgpPeerState(v string) *BGPPeer {
+	s.BgpPeerState = &v
+	return s
+}
+
+// SetBgpStatus sets the BgpStatus field's value.
+func (s *BGPPeer) SetBgpStatus(v string) *BGPPeer {
+	s.BgpStatus = &v
+	return s
+}
+
+// SetCustomerAddress sets the CustomerAddress field's value.
+func (s *BGPPeer) SetCustomerAddress(v string) *BGPPeer {
+	s.CustomerAddress = &v
+	return s
+}
+
+// One or more parameters are not valid.
+type ClientException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ClientException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ClientException) GoString() string {
+	return s.String()
+}
+
+func newErrorClientException(v protocol.ResponseMetadata) error {
+	return &ClientException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ClientException) Code() string {
+	return "DirectConnectClientException"
+}
+
+// Message returns the exception's message.
+func (s *ClientException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ClientException) OrigErr() error {
+	return nil
+}
+
+func (s *ClientException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ClientException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ClientException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type ConfirmConnectionInput struct {
 	_ struct{} `type:"structure"`
 
-	// Information about an association between a Direct Connect gateway and a virtual
-	// private gateway or transit gateway.
-	DirectConnectGatewayAssociation *GatewayAssociation `locationName:"directConnectGatewayAssociation" type:"structure"`
+	// The ID of the hosted connection.
+	//
+	// ConnectionId is a required field
+	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfirmConnectionInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfirmConnectionInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ConfirmConnectionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ConfirmConnectionInput"}
+	if s.ConnectionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetConnectionId sets the ConnectionId field's value.
+func (s *ConfirmConnectionInput) SetConnectionId(v string) *ConfirmConnectionInput {
+	s.ConnectionId = &v
+	return s
+}
+
+type ConfirmConnectionOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The state of the connection. The following are the possible values:
+	//
+	//    * ordering: The initial state of a hosted connection provisioned on an
+	//    interconnect. The connection stays in the ordering state until the owner
+	//    of the hosted connection confirms or declines the connection order.
+	//
+	//    * requested: The initial state of a standard connection. The connection
+	//    stays in the requested state until the Letter of Authorization (LOA) is
+	//    sent to the customer.
+	//
+	//    * pending: The connection has been approved and is being initialized.
+	//
+	//    * available: The network link is up and the connection is ready for use.
+	//
+	//    * down: The network link is down.
+	//
+	//    * deleting: The connection is being deleted.
+	//
+	//    * deleted: The connection has been deleted.
+	//
+	//    * rejected: A hosted connection in the ordering state enters the rejected
+	//    state if it is deleted by the customer.
+	//
+	//    * unknown: The state of the connection is not available.
+	ConnectionState *string `locationName:"connectionState" type:"string" enum:"ConnectionState"`
 }
 
-// String returns the string representation
-func (s AcceptDirectConnectGatewayAssociationProposalOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfirmConnectionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s AcceptDirectConnectGatewayAssociationProposalOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfirmConnectionOutput) GoString() string {
 	return s.String()
 }
 
-// SetDirectConnectGatewayAssociation sets the DirectConnectGatewayAssociation field's value.
-func (s *AcceptDirectConnectGatewayAssociationProposalOutput) SetDirectConnectGatewayAssociation(v *GatewayAssociation) *AcceptDirectConnectGatewayAssociationProposalOutput {
-	s.DirectConnectGatewayAssociation = v
+// SetConnectionState sets the ConnectionState field's value.
+func (s *ConfirmConnectionOutput) SetConnectionState(v string) *ConfirmConnectionOutput {
+	s.ConnectionState = &v
 	return s
 }
 
-type AllocateConnectionOnInterconnectInput struct {
+type ConfirmCustomerAgreementInput struct {
 	_ struct{} `type:"structure"`
 
-	// The bandwidth of the connection. The possible values are 50Mbps, 100Mbps,
-	// 200Mbps, 300Mbps, 400Mbps, 500Mbps, 1Gbps, 2Gbps, 5Gbps, and 10Gbps. Note
-	// that only those AWS Direct Connect Partners who have met specific requirements
-	// are allowed to create a 1Gbps, 2Gbps, 5Gbps or 10Gbps hosted connection.
-	//
-	// Bandwidth is a required field
-	Bandwidth *string `locationName:"bandwidth" type:"string" required:"true"`
-
-	// The name of the provisioned connection.
-	//
-	// ConnectionName is a required field
-	ConnectionName *string `locationName:"connectionName" type:"string" required:"true"`
-
-	// The ID of the interconnect on which the connection will be provisioned.
-	//
-	// InterconnectId is a required field
-	InterconnectId *string `locationName:"interconnectId" type:"string" required:"true"`
-
-	// The ID of the AWS account of the customer for whom the connection will be
-	// provisioned.
-	//
-	// OwnerAccount is a required field
-	OwnerAccount *string `locationName:"ownerAccount" type:"string" required:"true"`
-
-	// The dedicated VLAN provisioned to the connection.
-	//
-	// Vlan is a required field
-	Vlan *int64 `locationName:"vlan" type:"integer" required:"true"`
+	// The name of the customer agreement.
+	AgreementName *string `locationName:"agreementName" type:"string"`
 }
 
-// String returns the string representation
-func (s AllocateConnectionOnInterconnectInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfirmCustomerAgreementInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s AllocateConnectionOnInterconnectInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfirmCustomerAgreementInput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *AllocateConnectionOnInterconnectInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "AllocateConnectionOnInterconnectInput"}
-	if s.Bandwidth == nil {
-		invalidParams.Add(request.NewErrParamRequired("Bandwidth"))
-	}
-	if s.ConnectionName == nil {
-		invalidParams.Add(request.NewErrParamRequired("ConnectionName"))
-	}
-	if s.InterconnectId == nil {
-		invalidParams.Add(request.NewErrParamRequired("InterconnectId"))
-	}
-	if s.OwnerAccount == nil {
-		invalidParams.Add(request.NewErrParamRequired("OwnerAccount"))
-	}
-	if s.Vlan == nil {
-		invalidParams.Add(request.NewErrParamRequired("Vlan"))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
-// SetBandwidth sets the Bandwidth field's value.
-func (s *AllocateConnectionOnInterconnectInput) SetBandwidth(v string) *AllocateConnectionOnInterconnectInput {
-	s.Bandwidth = &v
+// SetAgreementName sets the AgreementName field's value.
+func (s *ConfirmCustomerAgreementInput) SetAgreementName(v string) *ConfirmCustomerAgreementInput {
+	s.AgreementName = &v
 	return s
 }
 
-// SetConnectionName sets the ConnectionName field's value.
-func (s *AllocateConnectionOnInterconnectInput) SetConnectionName(v string) *AllocateConnectionOnInterconnectInput {
-	s.ConnectionName = &v
-	return s
+type ConfirmCustomerAgreementOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The status of the customer agreement when the connection was created. This
+	// will be either signed or unsigned.
+	Status *string `locationName:"status" type:"string"`
 }
 
-// SetInterconnectId sets the InterconnectId field's value.
-func (s *AllocateConnectionOnInterconnectInput) SetInterconnectId(v string) *AllocateConnectionOnInterconnectInput {
-	s.InterconnectId = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfirmCustomerAgreementOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetOwnerAccount sets the OwnerAccount field's value.
-func (s *AllocateConnectionOnInterconnectInput) SetOwnerAccount(v string) *AllocateConnectionOnInterconnectInput {
-	s.OwnerAccount = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfirmCustomerAgreementOutput) GoString() string {
+	return s.String()
 }
 
-// SetVlan sets the Vlan field's value.
-func (s *AllocateConnectionOnInterconnectInput) SetVlan(v int64) *AllocateConnectionOnInterconnectInput {
-	s.Vlan = &v
+// SetStatus sets the Status field's value.
+func (s *ConfirmCustomerAgreementOutput) SetStatus(v string) *ConfirmCustomerAgreementOutput {
+	s.Status = &v
 	return s
 }
 
-type AllocateHostedConnectionInput struct {
+type ConfirmPrivateVirtualInterfaceInput struct {
 	_ struct{} `type:"structure"`
 
-	// The bandwidth of the connection. The possible values are 50Mbps, 100Mbps,
-	// 200Mbps, 300Mbps, 400Mbps, 500Mbps, 1Gbps, 2Gbps, 5Gbps, and 10Gbps. Note
-	// that only those AWS Direct Connect Partners who have met specific requirements
-	// are allowed to create a 1Gbps, 2Gbps, 5Gbps or 10Gbps hosted connection.
-	//
-	// Bandwidth is a required field
-	Bandwidth *string `locationName:"bandwidth" type:"string" required:"true"`
-
-	// The ID of the interconnect or LAG.
-	//
-	// ConnectionId is a required field
-	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
-
-	// The name of the hosted connection.
-	//
-	// ConnectionName is a required field
-	ConnectionName *string `locationName:"connectionName" type:"string" required:"true"`
-
-	// The ID of the AWS account ID of the customer for the connection.
-	//
-	// OwnerAccount is a required field
-	OwnerAccount *string `locationName:"ownerAccount" type:"string" required:"true"`
+	// The ID of the Direct Connect gateway.
+	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
 
-	// The tags to assign to the hosted connection.
-	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
+	// The ID of the virtual private gateway.
+	VirtualGatewayId *string `locationName:"virtualGatewayId" type:"string"`
 
-	// The dedicated VLAN provisioned to the hosted connection.
+	// The ID of the virtual interface.
 	//
-	// Vlan is a required field
-	Vlan *int64 `locationName:"vlan" type:"integer" required:"true"`
+	// VirtualInterfaceId is a required field
+	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s AllocateHostedConnectionInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfirmPrivateVirtualInterfaceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s AllocateHostedConnectionInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfirmPrivateVirtualInterfaceInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *AllocateHostedConnectionInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "AllocateHostedConnectionInput"}
-	if s.Bandwidth == nil {
-		invalidParams.Add(request.NewErrParamRequired("Bandwidth"))
-	}
-	if s.ConnectionId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
-	}
-	if s.ConnectionName == nil {
-		invalidParams.Add(request.NewErrParamRequired("ConnectionName"))
-	}
-	if s.OwnerAccount == nil {
-		invalidParams.Add(request.NewErrParamRequired("OwnerAccount"))
-	}
-	if s.Tags != nil && len(s.Tags) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
-	}
-	if s.Vlan == nil {
-		invalidParams.Add(request.NewErrParamRequired("Vlan"))
-	}
-	if s.Tags != nil {
-		for i, v := range s.Tags {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
-			}
-		}
+func (s *ConfirmPrivateVirtualInterfaceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ConfirmPrivateVirtualInterfaceInput"}
+	if s.VirtualInterfaceId == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualInterfaceId"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5056,87 +7067,116 @@ func (s *AllocateHostedConnectionInput) Validate() error {
 	return nil
 }
 
-// SetBandwidth sets the Bandwidth field's value.
-func (s *AllocateHostedConnectionInput) SetBandwidth(v string) *AllocateHostedConnectionInput {
-	s.Bandwidth = &v
+// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
+func (s *ConfirmPrivateVirtualInterfaceInput) SetDirectConnectGatewayId(v string) *ConfirmPrivateVirtualInterfaceInput {
+	s.DirectConnectGatewayId = &v
 	return s
 }
 
-// SetConnectionId sets the ConnectionId field's value.
-func (s *AllocateHostedConnectionInput) SetConnectionId(v string) *AllocateHostedConnectionInput {
-	s.ConnectionId = &v
+// SetVirtualGatewayId sets the VirtualGatewayId field's value.
+func (s *ConfirmPrivateVirtualInterfaceInput) SetVirtualGatewayId(v string) *ConfirmPrivateVirtualInterfaceInput {
+	s.VirtualGatewayId = &v
 	return s
 }
 
-// SetConnectionName sets the ConnectionName field's value.
-func (s *AllocateHostedConnectionInput) SetConnectionName(v string) *AllocateHostedConnectionInput {
-	s.ConnectionName = &v
+// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
+func (s *ConfirmPrivateVirtualInterfaceInput) SetVirtualInterfaceId(v string) *ConfirmPrivateVirtualInterfaceInput {
+	s.VirtualInterfaceId = &v
 	return s
 }
 
-// SetOwnerAccount sets the OwnerAccount field's value.
-func (s *AllocateHostedConnectionInput) SetOwnerAccount(v string) *AllocateHostedConnectionInput {
-	s.OwnerAccount = &v
-	return s
+type ConfirmPrivateVirtualInterfaceOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The state of the virtual interface. The following are the possible values:
+	//
+	//    * confirming: The creation of the virtual interface is pending confirmation
+	//    from the virtual interface owner. If the owner of the virtual interface
+	//    is different from the owner of the connection on which it is provisioned,
+	//    then the virtual interface will remain in this state until it is confirmed
+	//    by the virtual interface owner.
+	//
+	//    * verifying: This state only applies to public virtual interfaces. Each
+	//    public virtual interface needs validation before the virtual interface
+	//    can be created.
+	//
+	//    * pending: A virtual interface is in this state from the time that it
+	//    is created until the virtual interface is ready to forward traffic.
+	//
+	//    * available: A virtual interface that is able to forward traffic.
+	//
+	//    * down: A virtual interface that is BGP down.
+	//
+	//    * deleting: A virtual interface is in this state immediately after calling
+	//    DeleteVirtualInterface until it can no longer forward traffic.
+	//
+	//    * deleted: A virtual interface that cannot forward traffic.
+	//
+	//    * rejected: The virtual interface owner has declined creation of the virtual
+	//    interface. If a virtual interface in the Confirming state is deleted by
+	//    the virtual interface owner, the virtual interface enters the Rejected
+	//    state.
+	//
+	//    * unknown: The state of the virtual interface is not available.
+	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string" enum:"VirtualInterfaceState"`
 }
 
-// SetTags sets the Tags field's value.
-func (s *AllocateHostedConnectionInput) SetTags(v []*Tag) *AllocateHostedConnectionInput {
-	s.Tags = v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfirmPrivateVirtualInterfaceOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetVlan sets the Vlan field's value.
-func (s *AllocateHostedConnectionInput) SetVlan(v int64) *AllocateHostedConnectionInput {
-	s.Vlan = &v
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfirmPrivateVirtualInterfaceOutput) GoString() string {
+	return s.String()
+}
+
+// SetVirtualInterfaceState sets the VirtualInterfaceState field's value.
+func (s *ConfirmPrivateVirtualInterfaceOutput) SetVirtualInterfaceState(v string) *ConfirmPrivateVirtualInterfaceOutput {
+	s.VirtualInterfaceState = &v
 	return s
 }
 
-type AllocatePrivateVirtualInterfaceInput struct {
+type ConfirmPublicVirtualInterfaceInput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the connection on which the private virtual interface is provisioned.
-	//
-	// ConnectionId is a required field
-	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
-
-	// Information about the private virtual interface.
-	//
-	// NewPrivateVirtualInterfaceAllocation is a required field
-	NewPrivateVirtualInterfaceAllocation *NewPrivateVirtualInterfaceAllocation `locationName:"newPrivateVirtualInterfaceAllocation" type:"structure" required:"true"`
-
-	// The ID of the AWS account that owns the virtual private interface.
-	//
-	// OwnerAccount is a required field
-	OwnerAccount *string `locationName:"ownerAccount" type:"string" required:"true"`
+	// The ID of the virtual interface.
+	//
+	// VirtualInterfaceId is a required field
+	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s AllocatePrivateVirtualInterfaceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfirmPublicVirtualInterfaceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s AllocatePrivateVirtualInterfaceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfirmPublicVirtualInterfaceInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *AllocatePrivateVirtualInterfaceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "AllocatePrivateVirtualInterfaceInput"}
-	if s.ConnectionId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
-	}
-	if s.NewPrivateVirtualInterfaceAllocation == nil {
-		invalidParams.Add(request.NewErrParamRequired("NewPrivateVirtualInterfaceAllocation"))
-	}
-	if s.OwnerAccount == nil {
-		invalidParams.Add(request.NewErrParamRequired("OwnerAccount"))
-	}
-	if s.NewPrivateVirtualInterfaceAllocation != nil {
-		if err := s.NewPrivateVirtualInterfaceAllocation.Validate(); err != nil {
-			invalidParams.AddNested("NewPrivateVirtualInterfaceAllocation", err.(request.ErrInvalidParams))
-		}
+func (s *ConfirmPublicVirtualInterfaceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ConfirmPublicVirtualInterfaceInput"}
+	if s.VirtualInterfaceId == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualInterfaceId"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5145,69 +7185,112 @@ func (s *AllocatePrivateVirtualInterfaceInput) Validate() error {
 	return nil
 }
 
-// SetConnectionId sets the ConnectionId field's value.
-func (s *AllocatePrivateVirtualInterfaceInput) SetConnectionId(v string) *AllocatePrivateVirtualInterfaceInput {
-	s.ConnectionId = &v
+// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
+func (s *ConfirmPublicVirtualInterfaceInput) SetVirtualInterfaceId(v string) *ConfirmPublicVirtualInterfaceInput {
+	s.VirtualInterfaceId = &v
 	return s
 }
 
-// SetNewPrivateVirtualInterfaceAllocation sets the NewPrivateVirtualInterfaceAllocation field's value.
-func (s *AllocatePrivateVirtualInterfaceInput) SetNewPrivateVirtualInterfaceAllocation(v *NewPrivateVirtualInterfaceAllocation) *AllocatePrivateVirtualInterfaceInput {
-	s.NewPrivateVirtualInterfaceAllocation = v
-	return s
+type ConfirmPublicVirtualInterfaceOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The state of the virtual interface. The following are the possible values:
+	//
+	//    * confirming: The creation of the virtual interface is pending confirmation
+	//    from the virtual interface owner. If the owner of the virtual interface
+	//    is different from the owner of the connection on which it is provisioned,
+	//    then the virtual interface will remain in this state until it is confirmed
+	//    by the virtual interface owner.
+	//
+	//    * verifying: This state only applies to public virtual interfaces. Each
+	//    public virtual interface needs validation before the virtual interface
+	//    can be created.
+	//
+	//    * pending: A virtual interface is in this state from the time that it
+	//    is created until the virtual interface is ready to forward traffic.
+	//
+	//    * available: A virtual interface that is able to forward traffic.
+	//
+	//    * down: A virtual interface that is BGP down.
+	//
+	//    * deleting: A virtual interface is in this state immediately after calling
+	//    DeleteVirtualInterface until it can no longer forward traffic.
+	//
+	//    * deleted: A virtual interface that cannot forward traffic.
+	//
+	//    * rejected: The virtual interface owner has declined creation of the virtual
+	//    interface. If a virtual interface in the Confirming state is deleted by
+	//    the virtual interface owner, the virtual interface enters the Rejected
+	//    state.
+	//
+	//    * unknown: The state of the virtual interface is not available.
+	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string" enum:"VirtualInterfaceState"`
 }
 
-// SetOwnerAccount sets the OwnerAccount field's value.
-func (s *AllocatePrivateVirtualInterfaceInput) SetOwnerAccount(v string) *AllocatePrivateVirtualInterfaceInput {
-	s.OwnerAccount = &v
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfirmPublicVirtualInterfaceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfirmPublicVirtualInterfaceOutput) GoString() string {
+	return s.String()
+}
+
+// SetVirtualInterfaceState sets the VirtualInterfaceState field's value.
+func (s *ConfirmPublicVirtualInterfaceOutput) SetVirtualInterfaceState(v string) *ConfirmPublicVirtualInterfaceOutput {
+	s.VirtualInterfaceState = &v
 	return s
 }
 
-type AllocatePublicVirtualInterfaceInput struct {
+type ConfirmTransitVirtualInterfaceInput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the connection on which the public virtual interface is provisioned.
-	//
-	// ConnectionId is a required field
-	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
-
-	// Information about the public virtual interface.
+	// The ID of the Direct Connect gateway.
 	//
-	// NewPublicVirtualInterfaceAllocation is a required field
-	NewPublicVirtualInterfaceAllocation *NewPublicVirtualInterfaceAllocation `locationName:"newPublicVirtualInterfaceAllocation" type:"structure" required:"true"`
+	// DirectConnectGatewayId is a required field
+	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string" required:"true"`
 
-	// The ID of the AWS account that owns the public virtual interface.
+	// The ID of the virtual interface.
 	//
-	// OwnerAccount is a required field
-	OwnerAccount *string `locationName:"ownerAccount" type:"string" required:"true"`
+	// VirtualInterfaceId is a required field
+	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s AllocatePublicVirtualInterfaceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfirmTransitVirtualInterfaceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s AllocatePublicVirtualInterfaceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfirmTransitVirtualInterfaceInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *AllocatePublicVirtualInterfaceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "AllocatePublicVirtualInterfaceInput"}
-	if s.ConnectionId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
-	}
-	if s.NewPublicVirtualInterfaceAllocation == nil {
-		invalidParams.Add(request.NewErrParamRequired("NewPublicVirtualInterfaceAllocation"))
-	}
-	if s.OwnerAccount == nil {
-		invalidParams.Add(request.NewErrParamRequired("OwnerAccount"))
+func (s *ConfirmTransitVirtualInterfaceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ConfirmTransitVirtualInterfaceInput"}
+	if s.DirectConnectGatewayId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectConnectGatewayId"))
 	}
-	if s.NewPublicVirtualInterfaceAllocation != nil {
-		if err := s.NewPublicVirtualInterfaceAllocation.Validate(); err != nil {
-			invalidParams.AddNested("NewPublicVirtualInterfaceAllocation", err.(request.ErrInvalidParams))
-		}
+	if s.VirtualInterfaceId == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualInterfaceId"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5216,558 +7299,721 @@ func (s *AllocatePublicVirtualInterfaceInput) Validate() error {
 	return nil
 }
 
-// SetConnectionId sets the ConnectionId field's value.
-func (s *AllocatePublicVirtualInterfaceInput) SetConnectionId(v string) *AllocatePublicVirtualInterfaceInput {
-	s.ConnectionId = &v
+// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
+func (s *ConfirmTransitVirtualInterfaceInput) SetDirectConnectGatewayId(v string) *ConfirmTransitVirtualInterfaceInput {
+	s.DirectConnectGatewayId = &v
 	return s
 }
 
-// SetNewPublicVirtualInterfaceAllocation sets the NewPublicVirtualInterfaceAllocation field's value.
-func (s *AllocatePublicVirtualInterfaceInput) SetNewPublicVirtualInterfaceAllocation(v *NewPublicVirtualInterfaceAllocation) *AllocatePublicVirtualInterfaceInput {
-	s.NewPublicVirtualInterfaceAllocation = v
+// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
+func (s *ConfirmTransitVirtualInterfaceInput) SetVirtualInterfaceId(v string) *ConfirmTransitVirtualInterfaceInput {
+	s.VirtualInterfaceId = &v
 	return s
 }
 
-// SetOwnerAccount sets the OwnerAccount field's value.
-func (s *AllocatePublicVirtualInterfaceInput) SetOwnerAccount(v string) *AllocatePublicVirtualInterfaceInput {
-	s.OwnerAccount = &v
+type ConfirmTransitVirtualInterfaceOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The state of the virtual interface. The following are the possible values:
+	//
+	//    * confirming: The creation of the virtual interface is pending confirmation
+	//    from the virtual interface owner. If the owner of the virtual interface
+	//    is different from the owner of the connection on which it is provisioned,
+	//    then the virtual interface will remain in this state until it is confirmed
+	//    by the virtual interface owner.
+	//
+	//    * verifying: This state only applies to public virtual interfaces. Each
+	//    public virtual interface needs validation before the virtual interface
+	//    can be created.
+	//
+	//    * pending: A virtual interface is in this state from the time that it
+	//    is created until the virtual interface is ready to forward traffic.
+	//
+	//    * available: A virtual interface that is able to forward traffic.
+	//
+	//    * down: A virtual interface that is BGP down.
+	//
+	//    * deleting: A virtual interface is in this state immediately after calling
+	//    DeleteVirtualInterface until it can no longer forward traffic.
+	//
+	//    * deleted: A virtual interface that cannot forward traffic.
+	//
+	//    * rejected: The virtual interface owner has declined creation of the virtual
+	//    interface. If a virtual interface in the Confirming state is deleted by
+	//    the virtual interface owner, the virtual interface enters the Rejected
+	//    state.
+	//
+	//    * unknown: The state of the virtual interface is not available.
+	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string" enum:"VirtualInterfaceState"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfirmTransitVirtualInterfaceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfirmTransitVirtualInterfaceOutput) GoString() string {
+	return s.String()
+}
+
+// SetVirtualInterfaceState sets the VirtualInterfaceState field's value.
+func (s *ConfirmTransitVirtualInterfaceOutput) SetVirtualInterfaceState(v string) *ConfirmTransitVirtualInterfaceOutput {
+	s.VirtualInterfaceState = &v
 	return s
 }
 
-type AllocateTransitVirtualInterfaceInput struct {
-	_ struct{} `type:"structure"`
+// Information about an Direct Connect connection.
+type Connection struct {
+	_ struct{} `type:"structure"`
+
+	// The Direct Connect endpoint on which the physical connection terminates.
+	AwsDevice *string `locationName:"awsDevice" deprecated:"true" type:"string"`
+
+	// The Direct Connect endpoint that terminates the physical connection.
+	AwsDeviceV2 *string `locationName:"awsDeviceV2" type:"string"`
+
+	// The Direct Connect endpoint that terminates the logical connection. This
+	// device might be different than the device that terminates the physical connection.
+	AwsLogicalDeviceId *string `locationName:"awsLogicalDeviceId" type:"string"`
+
+	// The bandwidth of the connection.
+	Bandwidth *string `locationName:"bandwidth" type:"string"`
+
+	// The ID of the connection.
+	ConnectionId *string `locationName:"connectionId" type:"string"`
+
+	// The name of the connection.
+	ConnectionName *string `locationName:"connectionName" type:"string"`
+
+	// The state of the connection. The following are the possible values:
+	//
+	//    * ordering: The initial state of a hosted connection provisioned on an
+	//    interconnect. The connection stays in the ordering state until the owner
+	//    of the hosted connection confirms or declines the connection order.
+	//
+	//    * requested: The initial state of a standard connection. The connection
+	//    stays in the requested state until the Letter of Authorization (LOA) is
+	//    sent to the customer.
+	//
+	//    * pending: The connection has been approved and is being initialized.
+	//
+	//    * available: The network link is up and the connection is ready for use.
+	//
+	//    * down: The network link is down.
+	//
+	//    * deleting: The connection is being deleted.
+	//
+	//    * deleted: The connection has been deleted.
+	//
+	//    * rejected: A hosted connection in the ordering state enters the rejected
+	//    state if it is deleted by the customer.
+	//
+	//    * unknown: The state of the connection is not available.
+	ConnectionState *string `locationName:"connectionState" type:"string" enum:"ConnectionState"`
+
+	// The MAC Security (MACsec) connection encryption mode.
+	//
+	// The valid values are no_encrypt, should_encrypt, and must_encrypt.
+	EncryptionMode *string `locationName:"encryptionMode" type:"string"`
+
+	// Indicates whether the connection supports a secondary BGP peer in the same
+	// address family (IPv4/IPv6).
+	HasLogicalRedundancy *string `locationName:"hasLogicalRedundancy" type:"string" enum:"HasLogicalRedundancy"`
+
+	// Indicates whether jumbo frames are supported.
+	JumboFrameCapable *bool `locationName:"jumboFrameCapable" type:"boolean"`
+
+	// The ID of the LAG.
+	LagId *string `locationName:"lagId" type:"string"`
+
+	// The time of the most recent call to DescribeLoa for this connection.
+	LoaIssueTime *time.Time `locationName:"loaIssueTime" type:"timestamp"`
+
+	// The location of the connection.
+	Location *string `locationName:"location" type:"string"`
+
+	// Indicates whether the connection supports MAC Security (MACsec).
+	MacSecCapable *bool `locationName:"macSecCapable" type:"boolean"`
 
-	// The ID of the connection on which the transit virtual interface is provisioned.
-	//
-	// ConnectionId is a required field
-	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
+	// The MAC Security (MACsec) security keys associated with the connection.
+	MacSecKeys []*MacSecKey `locationName:"macSecKeys" type:"list"`
 
-	// Information about the transit virtual interface.
-	//
-	// NewTransitVirtualInterfaceAllocation is a required field
-	NewTransitVirtualInterfaceAllocation *NewTransitVirtualInterfaceAllocation `locationName:"newTransitVirtualInterfaceAllocation" type:"structure" required:"true"`
+	// The ID of the Amazon Web Services account that owns the connection.
+	OwnerAccount *string `locationName:"ownerAccount" type:"string"`
 
-	// The ID of the AWS account that owns the transit virtual interface.
+	// The name of the Direct Connect service provider associated with the connection.
+	PartnerName *string `locationName:"partnerName" type:"string"`
+
+	// The MAC Security (MACsec) port link status of the connection.
 	//
-	// OwnerAccount is a required field
-	OwnerAccount *string `locationName:"ownerAccount" type:"string" required:"true"`
+	// The valid values are Encryption Up, which means that there is an active Connection
+	// Key Name, or Encryption Down.
+	PortEncryptionStatus *string `locationName:"portEncryptionStatus" type:"string"`
+
+	// The name of the service provider associated with the connection.
+	ProviderName *string `locationName:"providerName" type:"string"`
+
+	// The Amazon Web Services Region where the connection is located.
+	Region *string `locationName:"region" type:"string"`
+
+	// The tags associated with the connection.
+	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
+
+	// The ID of the VLAN.
+	Vlan *int64 `locationName:"vlan" type:"integer"`
 }
 
-// String returns the string representation
-func (s AllocateTransitVirtualInterfaceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Connection) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s AllocateTransitVirtualInterfaceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Connection) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *AllocateTransitVirtualInterfaceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "AllocateTransitVirtualInterfaceInput"}
-	if s.ConnectionId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
-	}
-	if s.NewTransitVirtualInterfaceAllocation == nil {
-		invalidParams.Add(request.NewErrParamRequired("NewTransitVirtualInterfaceAllocation"))
-	}
-	if s.OwnerAccount == nil {
-		invalidParams.Add(request.NewErrParamRequired("OwnerAccount"))
-	}
-	if s.NewTransitVirtualInterfaceAllocation != nil {
-		if err := s.NewTransitVirtualInterfaceAllocation.Validate(); err != nil {
-			invalidParams.AddNested("NewTransitVirtualInterfaceAllocation", err.(request.ErrInvalidParams))
-		}
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetAwsDevice sets the AwsDevice field's value.
+func (s *Connection) SetAwsDevice(v string) *Connection {
+	s.AwsDevice = &v
+	return s
 }
 
-// SetConnectionId sets the ConnectionId field's value.
-func (s *AllocateTransitVirtualInterfaceInput) SetConnectionId(v string) *AllocateTransitVirtualInterfaceInput {
-	s.ConnectionId = &v
+// SetAwsDeviceV2 sets the AwsDeviceV2 field's value.
+func (s *Connection) SetAwsDeviceV2(v string) *Connection {
+	s.AwsDeviceV2 = &v
 	return s
 }
 
-// SetNewTransitVirtualInterfaceAllocation sets the NewTransitVirtualInterfaceAllocation field's value.
-func (s *AllocateTransitVirtualInterfaceInput) SetNewTransitVirtualInterfaceAllocation(v *NewTransitVirtualInterfaceAllocation) *AllocateTransitVirtualInterfaceInput {
-	s.NewTransitVirtualInterfaceAllocation = v
+// SetAwsLogicalDeviceId sets the AwsLogicalDeviceId field's value.
+func (s *Connection) SetAwsLogicalDeviceId(v string) *Connection {
+	s.AwsLogicalDeviceId = &v
 	return s
 }
 
-// SetOwnerAccount sets the OwnerAccount field's value.
-func (s *AllocateTransitVirtualInterfaceInput) SetOwnerAccount(v string) *AllocateTransitVirtualInterfaceInput {
-	s.OwnerAccount = &v
+// SetBandwidth sets the Bandwidth field's value.
+func (s *Connection) SetBandwidth(v string) *Connection {
+	s.Bandwidth = &v
 	return s
 }
 
-type AllocateTransitVirtualInterfaceOutput struct {
-	_ struct{} `type:"structure"`
-
-	// Information about a virtual interface.
-	VirtualInterface *VirtualInterface `locationName:"virtualInterface" type:"structure"`
+// SetConnectionId sets the ConnectionId field's value.
+func (s *Connection) SetConnectionId(v string) *Connection {
+	s.ConnectionId = &v
+	return s
 }
 
-// String returns the string representation
-func (s AllocateTransitVirtualInterfaceOutput) String() string {
-	return awsutil.Prettify(s)
+// SetConnectionName sets the ConnectionName field's value.
+func (s *Connection) SetConnectionName(v string) *Connection {
+	s.ConnectionName = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s AllocateTransitVirtualInterfaceOutput) GoString() string {
-	return s.String()
+// SetConnectionState sets the ConnectionState field's value.
+func (s *Connection) SetConnectionState(v string) *Connection {
+	s.ConnectionState = &v
+	return s
 }
 
-// SetVirtualInterface sets the VirtualInterface field's value.
-func (s *AllocateTransitVirtualInterfaceOutput) SetVirtualInterface(v *VirtualInterface) *AllocateTransitVirtualInterfaceOutput {
-	s.VirtualInterface = v
+// SetEncryptionMode sets the EncryptionMode field's value.
+func (s *Connection) SetEncryptionMode(v string) *Connection {
+	s.EncryptionMode = &v
 	return s
 }
 
-type AssociateConnectionWithLagInput struct {
-	_ struct{} `type:"structure"`
-
-	// The ID of the connection.
-	//
-	// ConnectionId is a required field
-	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
-
-	// The ID of the LAG with which to associate the connection.
-	//
-	// LagId is a required field
-	LagId *string `locationName:"lagId" type:"string" required:"true"`
+// SetHasLogicalRedundancy sets the HasLogicalRedundancy field's value.
+func (s *Connection) SetHasLogicalRedundancy(v string) *Connection {
+	s.HasLogicalRedundancy = &v
+	return s
 }
 
-// String returns the string representation
-func (s AssociateConnectionWithLagInput) String() string {
-	return awsutil.Prettify(s)
+// SetJumboFrameCapable sets the JumboFrameCapable field's value.
+func (s *Connection) SetJumboFrameCapable(v bool) *Connection {
+	s.JumboFrameCapable = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s AssociateConnectionWithLagInput) GoString() string {
-	return s.String()
+// SetLagId sets the LagId field's value.
+func (s *Connection) SetLagId(v string) *Connection {
+	s.LagId = &v
+	return s
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *AssociateConnectionWithLagInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "AssociateConnectionWithLagInput"}
-	if s.ConnectionId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
-	}
-	if s.LagId == nil {
-		invalidParams.Add(request.NewErrParamRequired("LagId"))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetLoaIssueTime sets the LoaIssueTime field's value.
+func (s *Connection) SetLoaIssueTime(v time.Time) *Connection {
+	s.LoaIssueTime = &v
+	return s
 }
 
-// SetConnectionId sets the ConnectionId field's value.
-func (s *AssociateConnectionWithLagInput) SetConnectionId(v string) *AssociateConnectionWithLagInput {
-	s.ConnectionId = &v
+// SetLocation sets the Location field's value.
+func (s *Connection) SetLocation(v string) *Connection {
+	s.Location = &v
 	return s
 }
 
-// SetLagId sets the LagId field's value.
-func (s *AssociateConnectionWithLagInput) SetLagId(v string) *AssociateConnectionWithLagInput {
-	s.LagId = &v
+// SetMacSecCapable sets the MacSecCapable field's value.
+func (s *Connection) SetMacSecCapable(v bool) *Connection {
+	s.MacSecCapable = &v
 	return s
 }
 
-type AssociateHostedConnectionInput struct {
-	_ struct{} `type:"structure"`
-
-	// The ID of the hosted connection.
-	//
-	// ConnectionId is a required field
-	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
+// SetMacSecKeys sets the MacSecKeys field's value.
+func (s *Connection) SetMacSecKeys(v []*MacSecKey) *Connection {
+	s.MacSecKeys = v
+	return s
+}
 
-	// The ID of the interconnect or the LAG.
-	//
-	// ParentConnectionId is a required field
-	ParentConnectionId *string `locationName:"parentConnectionId" type:"string" required:"true"`
+// SetOwnerAccount sets the OwnerAccount field's value.
+func (s *Connection) SetOwnerAccount(v string) *Connection {
+	s.OwnerAccount = &v
+	return s
 }
 
-// String returns the string representation
-func (s AssociateHostedConnectionInput) String() string {
-	return awsutil.Prettify(s)
+// SetPartnerName sets the PartnerName field's value.
+func (s *Connection) SetPartnerName(v string) *Connection {
+	s.PartnerName = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s AssociateHostedConnectionInput) GoString() string {
-	return s.String()
+// SetPortEncryptionStatus sets the PortEncryptionStatus field's value.
+func (s *Connection) SetPortEncryptionStatus(v string) *Connection {
+	s.PortEncryptionStatus = &v
+	return s
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *AssociateHostedConnectionInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "AssociateHostedConnectionInput"}
-	if s.ConnectionId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
-	}
-	if s.ParentConnectionId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ParentConnectionId"))
-	}
+// SetProviderName sets the ProviderName field's value.
+func (s *Connection) SetProviderName(v string) *Connection {
+	s.ProviderName = &v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetRegion sets the Region field's value.
+func (s *Connection) SetRegion(v string) *Connection {
+	s.Region = &v
+	return s
 }
 
-// SetConnectionId sets the ConnectionId field's value.
-func (s *AssociateHostedConnectionInput) SetConnectionId(v string) *AssociateHostedConnectionInput {
-	s.ConnectionId = &v
+// SetTags sets the Tags field's value.
+func (s *Connection) SetTags(v []*Tag) *Connection {
+	s.Tags = v
 	return s
 }
 
-// SetParentConnectionId sets the ParentConnectionId field's value.
-func (s *AssociateHostedConnectionInput) SetParentConnectionId(v string) *AssociateHostedConnectionInput {
-	s.ParentConnectionId = &v
+// SetVlan sets the Vlan field's value.
+func (s *Connection) SetVlan(v int64) *Connection {
+	s.Vlan = &v
 	return s
 }
 
-type AssociateVirtualInterfaceInput struct {
+type Connections struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the LAG or connection.
-	//
-	// ConnectionId is a required field
-	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
-
-	// The ID of the virtual interface.
-	//
-	// VirtualInterfaceId is a required field
-	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string" required:"true"`
+	// The connections.
+	Connections []*Connection `locationName:"connections" type:"list"`
 }
 
-// String returns the string representation
-func (s AssociateVirtualInterfaceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Connections) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s AssociateVirtualInterfaceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Connections) GoString() string {
 	return s.String()
-}
-
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *AssociateVirtualInterfaceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "AssociateVirtualInterfaceInput"}
-	if s.ConnectionId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
-	}
-	if s.VirtualInterfaceId == nil {
-		invalidParams.Add(request.NewErrParamRequired("VirtualInterfaceId"))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
-// SetConnectionId sets the ConnectionId field's value.
-func (s *AssociateVirtualInterfaceInput) SetConnectionId(v string) *AssociateVirtualInterfaceInput {
-	s.ConnectionId = &v
-	return s
-}
-
-// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
-func (s *AssociateVirtualInterfaceInput) SetVirtualInterfaceId(v string) *AssociateVirtualInterfaceInput {
-	s.VirtualInterfaceId = &v
+}
+
+// SetConnections sets the Connections field's value.
+func (s *Connections) SetConnections(v []*Connection) *Connections {
+	s.Connections = v
 	return s
 }
 
-// Information about the associated gateway.
-type AssociatedGateway struct {
+type CreateBGPPeerInput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the associated gateway.
-	Id *string `locationName:"id" type:"string"`
-
-	// The ID of the AWS account that owns the associated virtual private gateway
-	// or transit gateway.
-	OwnerAccount *string `locationName:"ownerAccount" type:"string"`
-
-	// The Region where the associated gateway is located.
-	Region *string `locationName:"region" type:"string"`
+	// Information about the BGP peer.
+	NewBGPPeer *NewBGPPeer `locationName:"newBGPPeer" type:"structure"`
 
-	// The type of associated gateway.
-	Type *string `locationName:"type" type:"string" enum:"GatewayType"`
+	// The ID of the virtual interface.
+	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string"`
 }
 
-// String returns the string representation
-func (s AssociatedGateway) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateBGPPeerInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s AssociatedGateway) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateBGPPeerInput) GoString() string {
 	return s.String()
 }
 
-// SetId sets the Id field's value.
-func (s *AssociatedGateway) SetId(v string) *AssociatedGateway {
-	s.Id = &v
+// SetNewBGPPeer sets the NewBGPPeer field's value.
+func (s *CreateBGPPeerInput) SetNewBGPPeer(v *NewBGPPeer) *CreateBGPPeerInput {
+	s.NewBGPPeer = v
 	return s
 }
 
-// SetOwnerAccount sets the OwnerAccount field's value.
-func (s *AssociatedGateway) SetOwnerAccount(v string) *AssociatedGateway {
-	s.OwnerAccount = &v
+// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
+func (s *CreateBGPPeerInput) SetVirtualInterfaceId(v string) *CreateBGPPeerInput {
+	s.VirtualInterfaceId = &v
 	return s
 }
 
-// SetRegion sets the Region field's value.
-func (s *AssociatedGateway) SetRegion(v string) *AssociatedGateway {
-	s.Region = &v
-	return s
-}
+type CreateBGPPeerOutput struct {
+	_ struct{} `type:"structure"`
 
-// SetType sets the Type field's value.
-func (s *AssociatedGateway) SetType(v string) *AssociatedGateway {
-	s.Type = &v
-	return s
+	// The virtual interface.
+	VirtualInterface *VirtualInterface `locationName:"virtualInterface" type:"structure"`
 }
 
-// Information about a BGP peer.
-type BGPPeer struct {
-	_ struct{} `type:"structure"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateBGPPeerOutput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// The address family for the BGP peer.
-	AddressFamily *string `locationName:"addressFamily" type:"string" enum:"AddressFamily"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateBGPPeerOutput) GoString() string {
+	return s.String()
+}
 
-	// The IP address assigned to the Amazon interface.
-	AmazonAddress *string `locationName:"amazonAddress" type:"string"`
+// SetVirtualInterface sets the VirtualInterface field's value.
+func (s *CreateBGPPeerOutput) SetVirtualInterface(v *VirtualInterface) *CreateBGPPeerOutput {
+	s.VirtualInterface = v
+	return s
+}
 
-	// The autonomous system (AS) number for Border Gateway Protocol (BGP) configuration.
-	Asn *int64 `locationName:"asn" type:"integer"`
+type CreateConnectionInput struct {
+	_ struct{} `type:"structure"`
 
-	// The authentication key for BGP configuration. This string has a minimum length
-	// of 6 characters and and a maximun lenth of 80 characters.
-	AuthKey *string `locationName:"authKey" type:"string"`
+	// The bandwidth of the connection.
+	//
+	// Bandwidth is a required field
+	Bandwidth *string `locationName:"bandwidth" type:"string" required:"true"`
 
-	// The Direct Connect endpoint on which the BGP peer terminates.
-	AwsDeviceV2 *string `locationName:"awsDeviceV2" type:"string"`
+	// The name of the connection.
+	//
+	// ConnectionName is a required field
+	ConnectionName *string `locationName:"connectionName" type:"string" required:"true"`
 
-	// The ID of the BGP peer.
-	BgpPeerId *string `locationName:"bgpPeerId" type:"string"`
+	// The ID of the LAG.
+	LagId *string `locationName:"lagId" type:"string"`
 
-	// The state of the BGP peer. The following are the possible values:
-	//
-	//    * verifying: The BGP peering addresses or ASN require validation before
-	//    the BGP peer can be created. This state applies only to public virtual
-	//    interfaces.
-	//
-	//    * pending: The BGP peer is created, and remains in this state until it
-	//    is ready to be established.
-	//
-	//    * available: The BGP peer is ready to be established.
-	//
-	//    * deleting: The BGP peer is being deleted.
+	// The location of the connection.
 	//
-	//    * deleted: The BGP peer is deleted and cannot be established.
-	BgpPeerState *string `locationName:"bgpPeerState" type:"string" enum:"BGPPeerState"`
+	// Location is a required field
+	Location *string `locationName:"location" type:"string" required:"true"`
 
-	// The status of the BGP peer. The following are the possible values:
-	//
-	//    * up: The BGP peer is established. This state does not indicate the state
-	//    of the routing function. Ensure that you are receiving routes over the
-	//    BGP session.
-	//
-	//    * down: The BGP peer is down.
+	// The name of the service provider associated with the requested connection.
+	ProviderName *string `locationName:"providerName" type:"string"`
+
+	// Indicates whether you want the connection to support MAC Security (MACsec).
 	//
-	//    * unknown: The BGP peer status is not available.
-	BgpStatus *string `locationName:"bgpStatus" type:"string" enum:"BGPStatus"`
+	// MAC Security (MACsec) is only available on dedicated connections. For information
+	// about MAC Security (MACsec) prerequisties, see MACsec prerequisties (https://docs.aws.amazon.com/directconnect/latest/UserGuide/direct-connect-mac-sec-getting-started.html#mac-sec-prerequisites)
+	// in the Direct Connect User Guide.
+	RequestMACSec *bool `locationName:"requestMACSec" type:"boolean"`
 
-	// The IP address assigned to the customer interface.
-	CustomerAddress *string `locationName:"customerAddress" type:"string"`
+	// The tags to associate with the lag.
+	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
 }
 
-// String returns the string representation
-func (s BGPPeer) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateConnectionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s BGPPeer) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateConnectionInput) GoString() string {
 	return s.String()
 }
 
-// SetAddressFamily sets the AddressFamily field's value.
-func (s *BGPPeer) SetAddressFamily(v string) *BGPPeer {
-	s.AddressFamily = &v
-	return s
-}
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateConnectionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateConnectionInput"}
+	if s.Bandwidth == nil {
+		invalidParams.Add(request.NewErrParamRequired("Bandwidth"))
+	}
+	if s.ConnectionName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionName"))
+	}
+	if s.Location == nil {
+		invalidParams.Add(request.NewErrParamRequired("Location"))
+	}
+	if s.Tags != nil && len(s.Tags) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
 
-// SetAmazonAddress sets the AmazonAddress field's value.
-func (s *BGPPeer) SetAmazonAddress(v string) *BGPPeer {
-	s.AmazonAddress = &v
-	return s
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetAsn sets the Asn field's value.
-func (s *BGPPeer) SetAsn(v int64) *BGPPeer {
-	s.Asn = &v
+// SetBandwidth sets the Bandwidth field's value.
+func (s *CreateConnectionInput) SetBandwidth(v string) *CreateConnectionInput {
+	s.Bandwidth = &v
 	return s
 }
 
-// SetAuthKey sets the AuthKey field's value.
-func (s *BGPPeer) SetAuthKey(v string) *BGPPeer {
-	s.AuthKey = &v
+// SetConnectionName sets the ConnectionName field's value.
+func (s *CreateConnectionInput) SetConnectionName(v string) *CreateConnectionInput {
+	s.ConnectionName = &v
 	return s
 }
 
-// SetAwsDeviceV2 sets the AwsDeviceV2 field's value.
-func (s *BGPPeer) SetAwsDeviceV2(v string) *BGPPeer {
-	s.AwsDeviceV2 = &v
+// SetLagId sets the LagId field's value.
+func (s *CreateConnectionInput) SetLagId(v string) *CreateConnectionInput {
+	s.LagId = &v
 	return s
 }
 
-// SetBgpPeerId sets the BgpPeerId field's value.
-func (s *BGPPeer) SetBgpPeerId(v string) *BGPPeer {
-	s.BgpPeerId = &v
+// SetLocation sets the Location field's value.
+func (s *CreateConnectionInput) SetLocation(v string) *CreateConnectionInput {
+	s.Location = &v
 	return s
 }
 
-// SetBgpPeerState sets the BgpPeerState field's value.
-func (s *BGPPeer) SetBgpPeerState(v string) *BGPPeer {
-	s.BgpPeerState = &v
+// SetProviderName sets the ProviderName field's value.
+func (s *CreateConnectionInput) SetProviderName(v string) *CreateConnectionInput {
+	s.ProviderName = &v
 	return s
 }
 
-// SetBgpStatus sets the BgpStatus field's value.
-func (s *BGPPeer) SetBgpStatus(v string) *BGPPeer {
-	s.BgpStatus = &v
+// SetRequestMACSec sets the RequestMACSec field's value.
+func (s *CreateConnectionInput) SetRequestMACSec(v bool) *CreateConnectionInput {
+	s.RequestMACSec = &v
 	return s
 }
 
-// SetCustomerAddress sets the CustomerAddress field's value.
-func (s *BGPPeer) SetCustomerAddress(v string) *BGPPeer {
-	s.CustomerAddress = &v
+// SetTags sets the Tags field's value.
+func (s *CreateConnectionInput) SetTags(v []*Tag) *CreateConnectionInput {
+	s.Tags = v
 	return s
 }
 
-type ConfirmConnectionInput struct {
+type CreateDirectConnectGatewayAssociationInput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the hosted connection.
+	// The Amazon VPC prefixes to advertise to the Direct Connect gateway
 	//
-	// ConnectionId is a required field
-	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
+	// This parameter is required when you create an association to a transit gateway.
+	//
+	// For information about how to set the prefixes, see Allowed Prefixes (https://docs.aws.amazon.com/directconnect/latest/UserGuide/multi-account-associate-vgw.html#allowed-prefixes)
+	// in the Direct Connect User Guide.
+	AddAllowedPrefixesToDirectConnectGateway []*RouteFilterPrefix `locationName:"addAllowedPrefixesToDirectConnectGateway" type:"list"`
+
+	// The ID of the Direct Connect gateway.
+	//
+	// DirectConnectGatewayId is a required field
+	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string" required:"true"`
+
+	// The ID of the virtual private gateway or transit gateway.
+	GatewayId *string `locationName:"gatewayId" type:"string"`
+
+	// The ID of the virtual private gateway.
+	VirtualGatewayId *string `locationName:"virtualGatewayId" type:"string"`
 }
 
-// String returns the string representation
-func (s ConfirmConnectionInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDirectConnectGatewayAssociationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ConfirmConnectionInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDirectConnectGatewayAssociationInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ConfirmConnectionInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ConfirmConnectionInput"}
-	if s.ConnectionId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
+func (s *CreateDirectConnectGatewayAssociationInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDirectConnectGatewayAssociationInput"}
+	if s.DirectConnectGatewayId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectConnectGatewayId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
 	}
+	return nil
+}
+
+// SetAddAllowedPrefixesToDirectConnectGateway sets the AddAllowedPrefixesToDirectConnectGateway field's value.
+func (s *CreateDirectConnectGatewayAssociationInput) SetAddAllowedPrefixesToDirectConnectGateway(v []*RouteFilterPrefix) *CreateDirectConnectGatewayAssociationInput {
+	s.AddAllowedPrefixesToDirectConnectGateway = v
+	return s
+}
+
+// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
+func (s *CreateDirectConnectGatewayAssociationInput) SetDirectConnectGatewayId(v string) *CreateDirectConnectGatewayAssociationInput {
+	s.DirectConnectGatewayId = &v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetGatewayId sets the GatewayId field's value.
+func (s *CreateDirectConnectGatewayAssociationInput) SetGatewayId(v string) *CreateDirectConnectGatewayAssociationInput {
+	s.GatewayId = &v
+	return s
 }
 
-// SetConnectionId sets the ConnectionId field's value.
-func (s *ConfirmConnectionInput) SetConnectionId(v string) *ConfirmConnectionInput {
-	s.ConnectionId = &v
+// SetVirtualGatewayId sets the VirtualGatewayId field's value.
+func (s *CreateDirectConnectGatewayAssociationInput) SetVirtualGatewayId(v string) *CreateDirectConnectGatewayAssociationInput {
+	s.VirtualGatewayId = &v
 	return s
 }
 
-type ConfirmConnectionOutput struct {
+type CreateDirectConnectGatewayAssociationOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The state of the connection. The following are the possible values:
-	//
-	//    * ordering: The initial state of a hosted connection provisioned on an
-	//    interconnect. The connection stays in the ordering state until the owner
-	//    of the hosted connection confirms or declines the connection order.
-	//
-	//    * requested: The initial state of a standard connection. The connection
-	//    stays in the requested state until the Letter of Authorization (LOA) is
-	//    sent to the customer.
-	//
-	//    * pending: The connection has been approved and is being initialized.
-	//
-	//    * available: The network link is up and the connection is ready for use.
-	//
-	//    * down: The network link is down.
-	//
-	//    * deleting: The connection is being deleted.
-	//
-	//    * deleted: The connection has been deleted.
-	//
-	//    * rejected: A hosted connection in the ordering state enters the rejected
-	//    state if it is deleted by the customer.
-	//
-	//    * unknown: The state of the connection is not available.
-	ConnectionState *string `locationName:"connectionState" type:"string" enum:"ConnectionState"`
+	// The association to be created.
+	DirectConnectGatewayAssociation *GatewayAssociation `locationName:"directConnectGatewayAssociation" type:"structure"`
 }
 
-// String returns the string representation
-func (s ConfirmConnectionOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDirectConnectGatewayAssociationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ConfirmConnectionOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDirectConnectGatewayAssociationOutput) GoString() string {
 	return s.String()
 }
 
-// SetConnectionState sets the ConnectionState field's value.
-func (s *ConfirmConnectionOutput) SetConnectionState(v string) *ConfirmConnectionOutput {
-	s.ConnectionState = &v
+// SetDirectConnectGatewayAssociation sets the DirectConnectGatewayAssociation field's value.
+func (s *CreateDirectConnectGatewayAssociationOutput) SetDirectConnectGatewayAssociation(v *GatewayAssociation) *CreateDirectConnectGatewayAssociationOutput {
+	s.DirectConnectGatewayAssociation = v
 	return s
 }
 
-type ConfirmPrivateVirtualInterfaceInput struct {
+type CreateDirectConnectGatewayAssociationProposalInput struct {
 	_ struct{} `type:"structure"`
 
+	// The Amazon VPC prefixes to advertise to the Direct Connect gateway.
+	AddAllowedPrefixesToDirectConnectGateway []*RouteFilterPrefix `locationName:"addAllowedPrefixesToDirectConnectGateway" type:"list"`
+
 	// The ID of the Direct Connect gateway.
-	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
+	//
+	// DirectConnectGatewayId is a required field
+	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string" required:"true"`
 
-	// The ID of the virtual private gateway.
-	VirtualGatewayId *string `locationName:"virtualGatewayId" deprecated:"true" type:"string"`
+	// The ID of the Amazon Web Services account that owns the Direct Connect gateway.
+	//
+	// DirectConnectGatewayOwnerAccount is a required field
+	DirectConnectGatewayOwnerAccount *string `locationName:"directConnectGatewayOwnerAccount" type:"string" required:"true"`
 
-	// The ID of the virtual interface.
+	// The ID of the virtual private gateway or transit gateway.
 	//
-	// VirtualInterfaceId is a required field
-	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string" required:"true"`
+	// GatewayId is a required field
+	GatewayId *string `locationName:"gatewayId" type:"string" required:"true"`
+
+	// The Amazon VPC prefixes to no longer advertise to the Direct Connect gateway.
+	RemoveAllowedPrefixesToDirectConnectGateway []*RouteFilterPrefix `locationName:"removeAllowedPrefixesToDirectConnectGateway" type:"list"`
 }
 
-// String returns the string representation
-func (s ConfirmPrivateVirtualInterfaceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDirectConnectGatewayAssociationProposalInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ConfirmPrivateVirtualInterfaceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDirectConnectGatewayAssociationProposalInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ConfirmPrivateVirtualInterfaceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ConfirmPrivateVirtualInterfaceInput"}
-	if s.VirtualInterfaceId == nil {
-		invalidParams.Add(request.NewErrParamRequired("VirtualInterfaceId"))
+func (s *CreateDirectConnectGatewayAssociationProposalInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDirectConnectGatewayAssociationProposalInput"}
+	if s.DirectConnectGatewayId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectConnectGatewayId"))
+	}
+	if s.DirectConnectGatewayOwnerAccount == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectConnectGatewayOwnerAccount"))
+	}
+	if s.GatewayId == nil {
+		invalidParams.Add(request.NewErrParamRequired("GatewayId"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5776,100 +8022,105 @@ func (s *ConfirmPrivateVirtualInterfaceInput) Validate() error {
 	return nil
 }
 
+// SetAddAllowedPrefixesToDirectConnectGateway sets the AddAllowedPrefixesToDirectConnectGateway field's value.
+func (s *CreateDirectConnectGatewayAssociationProposalInput) SetAddAllowedPrefixesToDirectConnectGateway(v []*RouteFilterPrefix) *CreateDirectConnectGatewayAssociationProposalInput {
+	s.AddAllowedPrefixesToDirectConnectGateway = v
+	return s
+}
+
 // SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
-func (s *ConfirmPrivateVirtualInterfaceInput) SetDirectConnectGatewayId(v string) *ConfirmPrivateVirtualInterfaceInput {
+func (s *CreateDirectConnectGatewayAssociationProposalInput) SetDirectConnectGatewayId(v string) *CreateDirectConnectGatewayAssociationProposalInput {
 	s.DirectConnectGatewayId = &v
 	return s
 }
 
-// SetVirtualGatewayId sets the VirtualGatewayId field's value.
-func (s *ConfirmPrivateVirtualInterfaceInput) SetVirtualGatewayId(v string) *ConfirmPrivateVirtualInterfaceInput {
-	s.VirtualGatewayId = &v
+// SetDirectConnectGatewayOwnerAccount sets the DirectConnectGatewayOwnerAccount field's value.
+func (s *CreateDirectConnectGatewayAssociationProposalInput) SetDirectConnectGatewayOwnerAccount(v string) *CreateDirectConnectGatewayAssociationProposalInput {
+	s.DirectConnectGatewayOwnerAccount = &v
 	return s
 }
 
-// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
-func (s *ConfirmPrivateVirtualInterfaceInput) SetVirtualInterfaceId(v string) *ConfirmPrivateVirtualInterfaceInput {
-	s.VirtualInterfaceId = &v
+// SetGatewayId sets the GatewayId field's value.
+func (s *CreateDirectConnectGatewayAssociationProposalInput) SetGatewayId(v string) *CreateDirectConnectGatewayAssociationProposalInput {
+	s.GatewayId = &v
 	return s
 }
 
-type ConfirmPrivateVirtualInterfaceOutput struct {
+// SetRemoveAllowedPrefixesToDirectConnectGateway sets the RemoveAllowedPrefixesToDirectConnectGateway field's value.
+func (s *CreateDirectConnectGatewayAssociationProposalInput) SetRemoveAllowedPrefixesToDirectConnectGateway(v []*RouteFilterPrefix) *CreateDirectConnectGatewayAssociationProposalInput {
+	s.RemoveAllowedPrefixesToDirectConnectGateway = v
+	return s
+}
+
+type CreateDirectConnectGatewayAssociationProposalOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The state of the virtual interface. The following are the possible values:
-	//
-	//    * confirming: The creation of the virtual interface is pending confirmation
-	//    from the virtual interface owner. If the owner of the virtual interface
-	//    is different from the owner of the connection on which it is provisioned,
-	//    then the virtual interface will remain in this state until it is confirmed
-	//    by the virtual interface owner.
-	//
-	//    * verifying: This state only applies to public virtual interfaces. Each
-	//    public virtual interface needs validation before the virtual interface
-	//    can be created.
-	//
-	//    * pending: A virtual interface is in this state from the time that it
-	//    is created until the virtual interface is ready to forward traffic.
-	//
-	//    * available: A virtual interface that is able to forward traffic.
-	//
-	//    * down: A virtual interface that is BGP down.
-	//
-	//    * deleting: A virtual interface is in this state immediately after calling
-	//    DeleteVirtualInterface until it can no longer forward traffic.
-	//
-	//    * deleted: A virtual interface that cannot forward traffic.
-	//
-	//    * rejected: The virtual interface owner has declined creation of the virtual
-	//    interface. If a virtual interface in the Confirming state is deleted by
-	//    the virtual interface owner, the virtual interface enters the Rejected
-	//    state.
-	//
-	//    * unknown: The state of the virtual interface is not available.
-	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string" enum:"VirtualInterfaceState"`
+	// Information about the Direct Connect gateway proposal.
+	DirectConnectGatewayAssociationProposal *GatewayAssociationProposal `locationName:"directConnectGatewayAssociationProposal" type:"structure"`
 }
 
-// String returns the string representation
-func (s ConfirmPrivateVirtualInterfaceOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDirectConnectGatewayAssociationProposalOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ConfirmPrivateVirtualInterfaceOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDirectConnectGatewayAssociationProposalOutput) GoString() string {
 	return s.String()
 }
 
-// SetVirtualInterfaceState sets the VirtualInterfaceState field's value.
-func (s *ConfirmPrivateVirtualInterfaceOutput) SetVirtualInterfaceState(v string) *ConfirmPrivateVirtualInterfaceOutput {
-	s.VirtualInterfaceState = &v
+// SetDirectConnectGatewayAssociationProposal sets the DirectConnectGatewayAssociationProposal field's value.
+func (s *CreateDirectConnectGatewayAssociationProposalOutput) SetDirectConnectGatewayAssociationProposal(v *GatewayAssociationProposal) *CreateDirectConnectGatewayAssociationProposalOutput {
+	s.DirectConnectGatewayAssociationProposal = v
 	return s
 }
 
-type ConfirmPublicVirtualInterfaceInput struct {
+type CreateDirectConnectGatewayInput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the virtual interface.
+	// The autonomous system number (ASN) for Border Gateway Protocol (BGP) to be
+	// configured on the Amazon side of the connection. The ASN must be in the private
+	// range of 64,512 to 65,534 or 4,200,000,000 to 4,294,967,294. The default
+	// is 64512.
+	AmazonSideAsn *int64 `locationName:"amazonSideAsn" type:"long"`
+
+	// The name of the Direct Connect gateway.
 	//
-	// VirtualInterfaceId is a required field
-	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string" required:"true"`
+	// DirectConnectGatewayName is a required field
+	DirectConnectGatewayName *string `locationName:"directConnectGatewayName" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s ConfirmPublicVirtualInterfaceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDirectConnectGatewayInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ConfirmPublicVirtualInterfaceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDirectConnectGatewayInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ConfirmPublicVirtualInterfaceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ConfirmPublicVirtualInterfaceInput"}
-	if s.VirtualInterfaceId == nil {
-		invalidParams.Add(request.NewErrParamRequired("VirtualInterfaceId"))
+func (s *CreateDirectConnectGatewayInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDirectConnectGatewayInput"}
+	if s.DirectConnectGatewayName == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectConnectGatewayName"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5878,96 +8129,119 @@ func (s *ConfirmPublicVirtualInterfaceInput) Validate() error {
 	return nil
 }
 
-// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
-func (s *ConfirmPublicVirtualInterfaceInput) SetVirtualInterfaceId(v string) *ConfirmPublicVirtualInterfaceInput {
-	s.VirtualInterfaceId = &v
+// SetAmazonSideAsn sets the AmazonSideAsn field's value.
+func (s *CreateDirectConnectGatewayInput) SetAmazonSideAsn(v int64) *CreateDirectConnectGatewayInput {
+	s.AmazonSideAsn = &v
 	return s
 }
 
-type ConfirmPublicVirtualInterfaceOutput struct {
+// SetDirectConnectGatewayName sets the DirectConnectGatewayName field's value.
+func (s *CreateDirectConnectGatewayInput) SetDirectConnectGatewayName(v string) *CreateDirectConnectGatewayInput {
+	s.DirectConnectGatewayName = &v
+	return s
+}
+
+type CreateDirectConnectGatewayOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The state of the virtual interface. The following are the possible values:
-	//
-	//    * confirming: The creation of the virtual interface is pending confirmation
-	//    from the virtual interface owner. If the owner of the virtual interface
-	//    is different from the owner of the connection on which it is provisioned,
-	//    then the virtual interface will remain in this state until it is confirmed
-	//    by the virtual interface owner.
-	//
-	//    * verifying: This state only applies to public virtual interfaces. Each
-	//    public virtual interface needs validation before the virtual interface
-	//    can be created.
-	//
-	//    * pending: A virtual interface is in this state from the time that it
-	//    is created until the virtual interface is ready to forward traffic.
-	//
-	//    * available: A virtual interface that is able to forward traffic.
-	//
-	//    * down: A virtual interface that is BGP down.
-	//
-	//    * deleting: A virtual interface is in this state immediately after calling
-	//    DeleteVirtualInterface until it can no longer forward traffic.
-	//
-	//    * deleted: A virtual interface that cannot forward traffic.
-	//
-	//    * rejected: The virtual interface owner has declined creation of the virtual
-	//    interface. If a virtual interface in the Confirming state is deleted by
-	//    the virtual interface owner, the virtual interface enters the Rejected
-	//    state.
-	//
-	//    * unknown: The state of the virtual interface is not available.
-	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string" enum:"VirtualInterfaceState"`
+	// The Direct Connect gateway.
+	DirectConnectGateway *Gateway `locationName:"directConnectGateway" type:"structure"`
 }
 
-// String returns the string representation
-func (s ConfirmPublicVirtualInterfaceOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDirectConnectGatewayOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ConfirmPublicVirtualInterfaceOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDirectConnectGatewayOutput) GoString() string {
 	return s.String()
 }
-
-// SetVirtualInterfaceState sets the VirtualInterfaceState field's value.
-func (s *ConfirmPublicVirtualInterfaceOutput) SetVirtualInterfaceState(v string) *ConfirmPublicVirtualInterfaceOutput {
-	s.VirtualInterfaceState = &v
+
+// SetDirectConnectGateway sets the DirectConnectGateway field's value.
+func (s *CreateDirectConnectGatewayOutput) SetDirectConnectGateway(v *Gateway) *CreateDirectConnectGatewayOutput {
+	s.DirectConnectGateway = v
 	return s
 }
 
-type ConfirmTransitVirtualInterfaceInput struct {
+type CreateInterconnectInput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the Direct Connect gateway.
+	// The port bandwidth, in Gbps. The possible values are 1 and 10.
 	//
-	// DirectConnectGatewayId is a required field
-	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string" required:"true"`
+	// Bandwidth is a required field
+	Bandwidth *string `locationName:"bandwidth" type:"string" required:"true"`
 
-	// The ID of the virtual interface.
+	// The name of the interconnect.
 	//
-	// VirtualInterfaceId is a required field
-	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string" required:"true"`
+	// InterconnectName is a required field
+	InterconnectName *string `locationName:"interconnectName" type:"string" required:"true"`
+
+	// The ID of the LAG.
+	LagId *string `locationName:"lagId" type:"string"`
+
+	// The location of the interconnect.
+	//
+	// Location is a required field
+	Location *string `locationName:"location" type:"string" required:"true"`
+
+	// The name of the service provider associated with the interconnect.
+	ProviderName *string `locationName:"providerName" type:"string"`
+
+	// The tags to associate with the interconnect.
+	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
 }
 
-// String returns the string representation
-func (s ConfirmTransitVirtualInterfaceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateInterconnectInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ConfirmTransitVirtualInterfaceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateInterconnectInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ConfirmTransitVirtualInterfaceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ConfirmTransitVirtualInterfaceInput"}
-	if s.DirectConnectGatewayId == nil {
-		invalidParams.Add(request.NewErrParamRequired("DirectConnectGatewayId"))
+func (s *CreateInterconnectInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateInterconnectInput"}
+	if s.Bandwidth == nil {
+		invalidParams.Add(request.NewErrParamRequired("Bandwidth"))
 	}
-	if s.VirtualInterfaceId == nil {
-		invalidParams.Add(request.NewErrParamRequired("VirtualInterfaceId"))
+	if s.InterconnectName == nil {
+		invalidParams.Add(request.NewErrParamRequired("InterconnectName"))
+	}
+	if s.Location == nil {
+		invalidParams.Add(request.NewErrParamRequired("Location"))
+	}
+	if s.Tags != nil && len(s.Tags) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5976,389 +8250,383 @@ func (s *ConfirmTransitVirtualInterfaceInput) Validate() error {
 	return nil
 }
 
-// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
-func (s *ConfirmTransitVirtualInterfaceInput) SetDirectConnectGatewayId(v string) *ConfirmTransitVirtualInterfaceInput {
-	s.DirectConnectGatewayId = &v
+// SetBandwidth sets the Bandwidth field's value.
+func (s *CreateInterconnectInput) SetBandwidth(v string) *CreateInterconnectInput {
+	s.Bandwidth = &v
 	return s
 }
 
-// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
-func (s *ConfirmTransitVirtualInterfaceInput) SetVirtualInterfaceId(v string) *ConfirmTransitVirtualInterfaceInput {
-	s.VirtualInterfaceId = &v
+// SetInterconnectName sets the InterconnectName field's value.
+func (s *CreateInterconnectInput) SetInterconnectName(v string) *CreateInterconnectInput {
+	s.InterconnectName = &v
 	return s
 }
 
-type ConfirmTransitVirtualInterfaceOutput struct {
-	_ struct{} `type:"structure"`
-
-	// The state of the virtual interface. The following are the possible values:
-	//
-	//    * confirming: The creation of the virtual interface is pending confirmation
-	//    from the virtual interface owner. If the owner of the virtual interface
-	//    is different from the owner of the connection on which it is provisioned,
-	//    then the virtual interface will remain in this state until it is confirmed
-	//    by the virtual interface owner.
-	//
-	//    * verifying: This state only applies to public virtual interfaces. Each
-	//    public virtual interface needs validation before the virtual interface
-	//    can be created.
-	//
-	//    * pending: A virtual interface is in this state from the time that it
-	//    is created until the virtual interface is ready to forward traffic.
-	//
-	//    * available: A virtual interface that is able to forward traffic.
-	//
-	//    * down: A virtual interface that is BGP down.
-	//
-	//    * deleting: A virtual interface is in this state immediately after calling
-	//    DeleteVirtualInterface until it can no longer forward traffic.
-	//
-	//    * deleted: A virtual interface that cannot forward traffic.
-	//
-	//    * rejected: The virtual interface owner has declined creation of the virtual
-	//    interface. If a virtual interface in the Confirming state is deleted by
-	//    the virtual interface owner, the virtual interface enters the Rejected
-	//    state.
-	//
-	//    * unknown: The state of the virtual interface is not available.
-	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string" enum:"VirtualInterfaceState"`
+// SetLagId sets the LagId field's value.
+func (s *CreateInterconnectInput) SetLagId(v string) *CreateInterconnectInput {
+	s.LagId = &v
+	return s
 }
 
-// String returns the string representation
-func (s ConfirmTransitVirtualInterfaceOutput) String() string {
-	return awsutil.Prettify(s)
+// SetLocation sets the Location field's value.
+func (s *CreateInterconnectInput) SetLocation(v string) *CreateInterconnectInput {
+	s.Location = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s ConfirmTransitVirtualInterfaceOutput) GoString() string {
-	return s.String()
+// SetProviderName sets the ProviderName field's value.
+func (s *CreateInterconnectInput) SetProviderName(v string) *CreateInterconnectInput {
+	s.ProviderName = &v
+	return s
 }
 
-// SetVirtualInterfaceState sets the VirtualInterfaceState field's value.
-func (s *ConfirmTransitVirtualInterfaceOutput) SetVirtualInterfaceState(v string) *ConfirmTransitVirtualInterfaceOutput {
-	s.VirtualInterfaceState = &v
+// SetTags sets the Tags field's value.
+func (s *CreateInterconnectInput) SetTags(v []*Tag) *CreateInterconnectInput {
+	s.Tags = v
 	return s
 }
 
-// Information about an AWS Direct Connect connection.
-type Connection struct {
+type CreateLagInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Direct Connect endpoint on which the physical connection terminates.
-	AwsDevice *string `locationName:"awsDevice" deprecated:"true" type:"string"`
-
-	// The Direct Connect endpoint on which the physical connection terminates.
-	AwsDeviceV2 *string `locationName:"awsDeviceV2" type:"string"`
-
-	// The bandwidth of the connection.
-	Bandwidth *string `locationName:"bandwidth" type:"string"`
+	// The tags to associate with the automtically created LAGs.
+	ChildConnectionTags []*Tag `locationName:"childConnectionTags" min:"1" type:"list"`
 
-	// The ID of the connection.
+	// The ID of an existing dedicated connection to migrate to the LAG.
 	ConnectionId *string `locationName:"connectionId" type:"string"`
 
-	// The name of the connection.
-	ConnectionName *string `locationName:"connectionName" type:"string"`
-
-	// The state of the connection. The following are the possible values:
-	//
-	//    * ordering: The initial state of a hosted connection provisioned on an
-	//    interconnect. The connection stays in the ordering state until the owner
-	//    of the hosted connection confirms or declines the connection order.
-	//
-	//    * requested: The initial state of a standard connection. The connection
-	//    stays in the requested state until the Letter of Authorization (LOA) is
-	//    sent to the customer.
-	//
-	//    * pending: The connection has been approved and is being initialized.
-	//
-	//    * available: The network link is up and the connection is ready for use.
-	//
-	//    * down: The network link is down.
-	//
-	//    * deleting: The connection is being deleted.
-	//
-	//    * deleted: The connection has been deleted.
-	//
-	//    * rejected: A hosted connection in the ordering state enters the rejected
-	//    state if it is deleted by the customer.
+	// The bandwidth of the individual physical dedicated connections bundled by
+	// the LAG. The possible values are 1Gbps and 10Gbps.
 	//
-	//    * unknown: The state of the connection is not available.
-	ConnectionState *string `locationName:"connectionState" type:"string" enum:"ConnectionState"`
-
-	// Indicates whether the connection supports a secondary BGP peer in the same
-	// address family (IPv4/IPv6).
-	HasLogicalRedundancy *string `locationName:"hasLogicalRedundancy" type:"string" enum:"HasLogicalRedundancy"`
-
-	// Indicates whether jumbo frames (9001 MTU) are supported.
-	JumboFrameCapable *bool `locationName:"jumboFrameCapable" type:"boolean"`
-
-	// The ID of the LAG.
-	LagId *string `locationName:"lagId" type:"string"`
+	// ConnectionsBandwidth is a required field
+	ConnectionsBandwidth *string `locationName:"connectionsBandwidth" type:"string" required:"true"`
 
-	// The time of the most recent call to DescribeLoa for this connection.
-	LoaIssueTime *time.Time `locationName:"loaIssueTime" type:"timestamp"`
+	// The name of the LAG.
+	//
+	// LagName is a required field
+	LagName *string `locationName:"lagName" type:"string" required:"true"`
 
-	// The location of the connection.
-	Location *string `locationName:"location" type:"string"`
+	// The location for the LAG.
+	//
+	// Location is a required field
+	Location *string `locationName:"location" type:"string" required:"true"`
 
-	// The ID of the AWS account that owns the connection.
-	OwnerAccount *string `locationName:"ownerAccount" type:"string"`
+	// The number of physical dedicated connections initially provisioned and bundled
+	// by the LAG. You can have a maximum of four connections when the port speed
+	// is 1G or 10G, or two when the port speed is 100G.
+	//
+	// NumberOfConnections is a required field
+	NumberOfConnections *int64 `locationName:"numberOfConnections" type:"integer" required:"true"`
 
-	// The name of the AWS Direct Connect service provider associated with the connection.
-	PartnerName *string `locationName:"partnerName" type:"string"`
+	// The name of the service provider associated with the LAG.
+	ProviderName *string `locationName:"providerName" type:"string"`
 
-	// The AWS Region where the connection is located.
-	Region *string `locationName:"region" type:"string"`
+	// Indicates whether the connection will support MAC Security (MACsec).
+	//
+	// All connections in the LAG must be capable of supporting MAC Security (MACsec).
+	// For information about MAC Security (MACsec) prerequisties, see MACsec prerequisties
+	// (https://docs.aws.amazon.com/directconnect/latest/UserGuide/direct-connect-mac-sec-getting-started.html#mac-sec-prerequisites)
+	// in the Direct Connect User Guide.
+	RequestMACSec *bool `locationName:"requestMACSec" type:"boolean"`
 
-	// Any tags assigned to the connection.
+	// The tags to associate with the LAG.
 	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
-
-	// The ID of the VLAN.
-	Vlan *int64 `locationName:"vlan" type:"integer"`
 }
 
-// String returns the string representation
-func (s Connection) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateLagInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Connection) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateLagInput) GoString() string {
 	return s.String()
 }
 
-// SetAwsDevice sets the AwsDevice field's value.
-func (s *Connection) SetAwsDevice(v string) *Connection {
-	s.AwsDevice = &v
-	return s
-}
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateLagInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateLagInput"}
+	if s.ChildConnectionTags != nil && len(s.ChildConnectionTags) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ChildConnectionTags", 1))
+	}
+	if s.ConnectionsBandwidth == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionsBandwidth"))
+	}
+	if s.LagName == nil {
+		invalidParams.Add(request.NewErrParamRequired("LagName"))
+	}
+	if s.Location == nil {
+		invalidParams.Add(request.NewErrParamRequired("Location"))
+	}
+	if s.NumberOfConnections == nil {
+		invalidParams.Add(request.NewErrParamRequired("NumberOfConnections"))
+	}
+	if s.Tags != nil && len(s.Tags) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
+	}
+	if s.ChildConnectionTags != nil {
+		for i, v := range s.ChildConnectionTags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ChildConnectionTags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
 
-// SetAwsDeviceV2 sets the AwsDeviceV2 field's value.
-func (s *Connection) SetAwsDeviceV2(v string) *Connection {
-	s.AwsDeviceV2 = &v
-	return s
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetBandwidth sets the Bandwidth field's value.
-func (s *Connection) SetBandwidth(v string) *Connection {
-	s.Bandwidth = &v
+// SetChildConnectionTags sets the ChildConnectionTags field's value.
+func (s *CreateLagInput) SetChildConnectionTags(v []*Tag) *CreateLagInput {
+	s.ChildConnectionTags = v
 	return s
 }
 
 // SetConnectionId sets the ConnectionId field's value.
-func (s *Connection) SetConnectionId(v string) *Connection {
+func (s *CreateLagInput) SetConnectionId(v string) *CreateLagInput {
 	s.ConnectionId = &v
 	return s
 }
 
-// SetConnectionName sets the ConnectionName field's value.
-func (s *Connection) SetConnectionName(v string) *Connection {
-	s.ConnectionName = &v
-	return s
-}
-
-// SetConnectionState sets the ConnectionState field's value.
-func (s *Connection) SetConnectionState(v string) *Connection {
-	s.ConnectionState = &v
-	return s
-}
-
-// SetHasLogicalRedundancy sets the HasLogicalRedundancy field's value.
-func (s *Connection) SetHasLogicalRedundancy(v string) *Connection {
-	s.HasLogicalRedundancy = &v
-	return s
-}
-
-// SetJumboFrameCapable sets the JumboFrameCapable field's value.
-func (s *Connection) SetJumboFrameCapable(v bool) *Connection {
-	s.JumboFrameCapable = &v
-	return s
-}
-
-// SetLagId sets the LagId field's value.
-func (s *Connection) SetLagId(v string) *Connection {
-	s.LagId = &v
+// SetConnectionsBandwidth sets the ConnectionsBandwidth field's value.
+func (s *CreateLagInput) SetConnectionsBandwidth(v string) *CreateLagInput {
+	s.ConnectionsBandwidth = &v
 	return s
 }
 
-// SetLoaIssueTime sets the LoaIssueTime field's value.
-func (s *Connection) SetLoaIssueTime(v time.Time) *Connection {
-	s.LoaIssueTime = &v
+// SetLagName sets the LagName field's value.
+func (s *CreateLagInput) SetLagName(v string) *CreateLagInput {
+	s.LagName = &v
 	return s
 }
 
 // SetLocation sets the Location field's value.
-func (s *Connection) SetLocation(v string) *Connection {
+func (s *CreateLagInput) SetLocation(v string) *CreateLagInput {
 	s.Location = &v
 	return s
 }
 
-// SetOwnerAccount sets the OwnerAccount field's value.
-func (s *Connection) SetOwnerAccount(v string) *Connection {
-	s.OwnerAccount = &v
+// SetNumberOfConnections sets the NumberOfConnections field's value.
+func (s *CreateLagInput) SetNumberOfConnections(v int64) *CreateLagInput {
+	s.NumberOfConnections = &v
 	return s
 }
 
-// SetPartnerName sets the PartnerName field's value.
-func (s *Connection) SetPartnerName(v string) *Connection {
-	s.PartnerName = &v
+// SetProviderName sets the ProviderName field's value.
+func (s *CreateLagInput) SetProviderName(v string) *CreateLagInput {
+	s.ProviderName = &v
 	return s
 }
 
-// SetRegion sets the Region field's value.
-func (s *Connection) SetRegion(v string) *Connection {
-	s.Region = &v
+// SetRequestMACSec sets the RequestMACSec field's value.
+func (s *CreateLagInput) SetRequestMACSec(v bool) *CreateLagInput {
+	s.RequestMACSec = &v
 	return s
 }
 
 // SetTags sets the Tags field's value.
-func (s *Connection) SetTags(v []*Tag) *Connection {
+func (s *CreateLagInput) SetTags(v []*Tag) *CreateLagInput {
 	s.Tags = v
 	return s
 }
 
-// SetVlan sets the Vlan field's value.
-func (s *Connection) SetVlan(v int64) *Connection {
-	s.Vlan = &v
-	return s
-}
-
-type Connections struct {
+type CreatePrivateVirtualInterfaceInput struct {
 	_ struct{} `type:"structure"`
 
-	// The connections.
-	Connections []*Connection `locationName:"connections" type:"list"`
+	// The ID of the connection.
+	//
+	// ConnectionId is a required field
+	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
+
+	// Information about the private virtual interface.
+	//
+	// NewPrivateVirtualInterface is a required field
+	NewPrivateVirtualInterface *NewPrivateVirtualInterface `locationName:"newPrivateVirtualInterface" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s Connections) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreatePrivateVirtualInterfaceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Connections) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreatePrivateVirtualInterfaceInput) GoString() string {
 	return s.String()
 }
 
-// SetConnections sets the Connections field's value.
-func (s *Connections) SetConnections(v []*Connection) *Connections {
-	s.Connections = v
-	return s
-}
-
-type CreateBGPPeerInput struct {
-	_ struct{} `type:"structure"`
-
-	// Information about the BGP peer.
-	NewBGPPeer *NewBGPPeer `locationName:"newBGPPeer" type:"structure"`
-
-	// The ID of the virtual interface.
-	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string"`
-}
-
-// String returns the string representation
-func (s CreateBGPPeerInput) String() string {
-	return awsutil.Prettify(s)
-}
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreatePrivateVirtualInterfaceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatePrivateVirtualInterfaceInput"}
+	if s.ConnectionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
+	}
+	if s.NewPrivateVirtualInterface == nil {
+		invalidParams.Add(request.NewErrParamRequired("NewPrivateVirtualInterface"))
+	}
+	if s.NewPrivateVirtualInterface != nil {
+		if err := s.NewPrivateVirtualInterface.Validate(); err != nil {
+			invalidParams.AddNested("NewPrivateVirtualInterface", err.(request.ErrInvalidParams))
+		}
+	}
 
-// GoString returns the string representation
-func (s CreateBGPPeerInput) GoString() string {
-	return s.String()
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetNewBGPPeer sets the NewBGPPeer field's value.
-func (s *CreateBGPPeerInput) SetNewBGPPeer(v *NewBGPPeer) *CreateBGPPeerInput {
-	s.NewBGPPeer = v
+// SetConnectionId sets the ConnectionId field's value.
+func (s *CreatePrivateVirtualInterfaceInput) SetConnectionId(v string) *CreatePrivateVirtualInterfaceInput {
+	s.ConnectionId = &v
 	return s
 }
 
-// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
-func (s *CreateBGPPeerInput) SetVirtualInterfaceId(v string) *CreateBGPPeerInput {
-	s.VirtualInterfaceId = &v
+// SetNewPrivateVirtualInterface sets the NewPrivateVirtualInterface field's value.
+func (s *CreatePrivateVirtualInterfaceInput) SetNewPrivateVirtualInterface(v *NewPrivateVirtualInterface) *CreatePrivateVirtualInterfaceInput {
+	s.NewPrivateVirtualInterface = v
 	return s
 }
 
-type CreateBGPPeerOutput struct {
+type CreatePublicVirtualInterfaceInput struct {
 	_ struct{} `type:"structure"`
 
-	// The virtual interface.
-	VirtualInterface *VirtualInterface `locationName:"virtualInterface" type:"structure"`
+	// The ID of the connection.
+	//
+	// ConnectionId is a required field
+	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
+
+	// Information about the public virtual interface.
+	//
+	// NewPublicVirtualInterface is a required field
+	NewPublicVirtualInterface *NewPublicVirtualInterface `locationName:"newPublicVirtualInterface" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateBGPPeerOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreatePublicVirtualInterfaceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateBGPPeerOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreatePublicVirtualInterfaceInput) GoString() string {
 	return s.String()
 }
 
-// SetVirtualInterface sets the VirtualInterface field's value.
-func (s *CreateBGPPeerOutput) SetVirtualInterface(v *VirtualInterface) *CreateBGPPeerOutput {
-	s.VirtualInterface = v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreatePublicVirtualInterfaceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatePublicVirtualInterfaceInput"}
+	if s.ConnectionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
+	}
+	if s.NewPublicVirtualInterface == nil {
+		invalidParams.Add(request.NewErrParamRequired("NewPublicVirtualInterface"))
+	}
+	if s.NewPublicVirtualInterface != nil {
+		if err := s.NewPublicVirtualInterface.Validate(); err != nil {
+			invalidParams.AddNested("NewPublicVirtualInterface", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetConnectionId sets the ConnectionId field's value.
+func (s *CreatePublicVirtualInterfaceInput) SetConnectionId(v string) *CreatePublicVirtualInterfaceInput {
+	s.ConnectionId = &v
 	return s
 }
 
-type CreateConnectionInput struct {
-	_ struct{} `type:"structure"`
+// SetNewPublicVirtualInterface sets the NewPublicVirtualInterface field's value.
+func (s *CreatePublicVirtualInterfaceInput) SetNewPublicVirtualInterface(v *NewPublicVirtualInterface) *CreatePublicVirtualInterfaceInput {
+	s.NewPublicVirtualInterface = v
+	return s
+}
 
-	// The bandwidth of the connection.
-	//
-	// Bandwidth is a required field
-	Bandwidth *string `locationName:"bandwidth" type:"string" required:"true"`
+type CreateTransitVirtualInterfaceInput struct {
+	_ struct{} `type:"structure"`
 
-	// The name of the connection.
+	// The ID of the connection.
 	//
-	// ConnectionName is a required field
-	ConnectionName *string `locationName:"connectionName" type:"string" required:"true"`
-
-	// The ID of the LAG.
-	LagId *string `locationName:"lagId" type:"string"`
+	// ConnectionId is a required field
+	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
 
-	// The location of the connection.
+	// Information about the transit virtual interface.
 	//
-	// Location is a required field
-	Location *string `locationName:"location" type:"string" required:"true"`
-
-	// The tags to assign to the connection.
-	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
+	// NewTransitVirtualInterface is a required field
+	NewTransitVirtualInterface *NewTransitVirtualInterface `locationName:"newTransitVirtualInterface" type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateConnectionInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTransitVirtualInterfaceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateConnectionInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTransitVirtualInterfaceInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateConnectionInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateConnectionInput"}
-	if s.Bandwidth == nil {
-		invalidParams.Add(request.NewErrParamRequired("Bandwidth"))
-	}
-	if s.ConnectionName == nil {
-		invalidParams.Add(request.NewErrParamRequired("ConnectionName"))
-	}
-	if s.Location == nil {
-		invalidParams.Add(request.NewErrParamRequired("Location"))
+func (s *CreateTransitVirtualInterfaceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateTransitVirtualInterfaceInput"}
+	if s.ConnectionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
 	}
-	if s.Tags != nil && len(s.Tags) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
+	if s.NewTransitVirtualInterface == nil {
+		invalidParams.Add(request.NewErrParamRequired("NewTransitVirtualInterface"))
 	}
-	if s.Tags != nil {
-		for i, v := range s.Tags {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
-			}
+	if s.NewTransitVirtualInterface != nil {
+		if err := s.NewTransitVirtualInterface.Validate(); err != nil {
+			invalidParams.AddNested("NewTransitVirtualInterface", err.(request.ErrInvalidParams))
 		}
 	}
 
@@ -6368,173 +8636,211 @@ func (s *CreateConnectionInput) Validate() error {
 	return nil
 }
 
-// SetBandwidth sets the Bandwidth field's value.
-func (s *CreateConnectionInput) SetBandwidth(v string) *CreateConnectionInput {
-	s.Bandwidth = &v
+// SetConnectionId sets the ConnectionId field's value.
+func (s *CreateTransitVirtualInterfaceInput) SetConnectionId(v string) *CreateTransitVirtualInterfaceInput {
+	s.ConnectionId = &v
 	return s
 }
 
-// SetConnectionName sets the ConnectionName field's value.
-func (s *CreateConnectionInput) SetConnectionName(v string) *CreateConnectionInput {
-	s.ConnectionName = &v
+// SetNewTransitVirtualInterface sets the NewTransitVirtualInterface field's value.
+func (s *CreateTransitVirtualInterfaceInput) SetNewTransitVirtualInterface(v *NewTransitVirtualInterface) *CreateTransitVirtualInterfaceInput {
+	s.NewTransitVirtualInterface = v
 	return s
 }
 
-// SetLagId sets the LagId field's value.
-func (s *CreateConnectionInput) SetLagId(v string) *CreateConnectionInput {
-	s.LagId = &v
+type CreateTransitVirtualInterfaceOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Information about a virtual interface.
+	VirtualInterface *VirtualInterface `locationName:"virtualInterface" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTransitVirtualInterfaceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTransitVirtualInterfaceOutput) GoString() string {
+	return s.String()
+}
+
+// SetVirtualInterface sets the VirtualInterface field's value.
+func (s *CreateTransitVirtualInterfaceOutput) SetVirtualInterface(v *VirtualInterface) *CreateTransitVirtualInterfaceOutput {
+	s.VirtualInterface = v
 	return s
 }
 
-// SetLocation sets the Location field's value.
-func (s *CreateConnectionInput) SetLocation(v string) *CreateConnectionInput {
-	s.Location = &v
+// The name and status of a customer agreement.
+type CustomerAgreement struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the agreement.
+	AgreementName *string `locationName:"agreementName" type:"string"`
+
+	// The status of the customer agreement. This will be either signed or unsigned
+	Status *string `locationName:"status" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CustomerAgreement) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CustomerAgreement) GoString() string {
+	return s.String()
+}
+
+// SetAgreementName sets the AgreementName field's value.
+func (s *CustomerAgreement) SetAgreementName(v string) *CustomerAgreement {
+	s.AgreementName = &v
 	return s
 }
 
-// SetTags sets the Tags field's value.
-func (s *CreateConnectionInput) SetTags(v []*Tag) *CreateConnectionInput {
-	s.Tags = v
+// SetStatus sets the Status field's value.
+func (s *CustomerAgreement) SetStatus(v string) *CustomerAgreement {
+	s.Status = &v
 	return s
 }
 
-type CreateDirectConnectGatewayAssociationInput struct {
+type DeleteBGPPeerInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon VPC prefixes to advertise to the Direct Connect gateway
-	//
-	// For information about how to set the prefixes, see Allowed Prefixes (https://docs.aws.amazon.com/directconnect/latest/UserGuide/multi-account-associate-vgw.html#allowed-prefixes)
-	// in the AWS Direct Connect User Guide.
-	AddAllowedPrefixesToDirectConnectGateway []*RouteFilterPrefix `locationName:"addAllowedPrefixesToDirectConnectGateway" type:"list"`
+	// The autonomous system (AS) number for Border Gateway Protocol (BGP) configuration.
+	Asn *int64 `locationName:"asn" type:"integer"`
 
-	// The ID of the Direct Connect gateway.
-	//
-	// DirectConnectGatewayId is a required field
-	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string" required:"true"`
+	// The ID of the BGP peer.
+	BgpPeerId *string `locationName:"bgpPeerId" type:"string"`
 
-	// The ID of the virtual private gateway or transit gateway.
-	GatewayId *string `locationName:"gatewayId" type:"string"`
+	// The IP address assigned to the customer interface.
+	CustomerAddress *string `locationName:"customerAddress" type:"string"`
 
-	// The ID of the virtual private gateway.
-	VirtualGatewayId *string `locationName:"virtualGatewayId" deprecated:"true" type:"string"`
+	// The ID of the virtual interface.
+	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string"`
 }
 
-// String returns the string representation
-func (s CreateDirectConnectGatewayAssociationInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteBGPPeerInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateDirectConnectGatewayAssociationInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteBGPPeerInput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateDirectConnectGatewayAssociationInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateDirectConnectGatewayAssociationInput"}
-	if s.DirectConnectGatewayId == nil {
-		invalidParams.Add(request.NewErrParamRequired("DirectConnectGatewayId"))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
-// SetAddAllowedPrefixesToDirectConnectGateway sets the AddAllowedPrefixesToDirectConnectGateway field's value.
-func (s *CreateDirectConnectGatewayAssociationInput) SetAddAllowedPrefixesToDirectConnectGateway(v []*RouteFilterPrefix) *CreateDirectConnectGatewayAssociationInput {
-	s.AddAllowedPrefixesToDirectConnectGateway = v
+// SetAsn sets the Asn field's value.
+func (s *DeleteBGPPeerInput) SetAsn(v int64) *DeleteBGPPeerInput {
+	s.Asn = &v
 	return s
 }
 
-// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
-func (s *CreateDirectConnectGatewayAssociationInput) SetDirectConnectGatewayId(v string) *CreateDirectConnectGatewayAssociationInput {
-	s.DirectConnectGatewayId = &v
+// SetBgpPeerId sets the BgpPeerId field's value.
+func (s *DeleteBGPPeerInput) SetBgpPeerId(v string) *DeleteBGPPeerInput {
+	s.BgpPeerId = &v
 	return s
 }
 
-// SetGatewayId sets the GatewayId field's value.
-func (s *CreateDirectConnectGatewayAssociationInput) SetGatewayId(v string) *CreateDirectConnectGatewayAssociationInput {
-	s.GatewayId = &v
+// SetCustomerAddress sets the CustomerAddress field's value.
+func (s *DeleteBGPPeerInput) SetCustomerAddress(v string) *DeleteBGPPeerInput {
+	s.CustomerAddress = &v
 	return s
 }
 
-// SetVirtualGatewayId sets the VirtualGatewayId field's value.
-func (s *CreateDirectConnectGatewayAssociationInput) SetVirtualGatewayId(v string) *CreateDirectConnectGatewayAssociationInput {
-	s.VirtualGatewayId = &v
+// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
+func (s *DeleteBGPPeerInput) SetVirtualInterfaceId(v string) *DeleteBGPPeerInput {
+	s.VirtualInterfaceId = &v
 	return s
 }
 
-type CreateDirectConnectGatewayAssociationOutput struct {
+type DeleteBGPPeerOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The association to be created.
-	DirectConnectGatewayAssociation *GatewayAssociation `locationName:"directConnectGatewayAssociation" type:"structure"`
+	// The virtual interface.
+	VirtualInterface *VirtualInterface `locationName:"virtualInterface" type:"structure"`
 }
 
-// String returns the string representation
-func (s CreateDirectConnectGatewayAssociationOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteBGPPeerOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateDirectConnectGatewayAssociationOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteBGPPeerOutput) GoString() string {
 	return s.String()
 }
 
-// SetDirectConnectGatewayAssociation sets the DirectConnectGatewayAssociation field's value.
-func (s *CreateDirectConnectGatewayAssociationOutput) SetDirectConnectGatewayAssociation(v *GatewayAssociation) *CreateDirectConnectGatewayAssociationOutput {
-	s.DirectConnectGatewayAssociation = v
+// SetVirtualInterface sets the VirtualInterface field's value.
+func (s *DeleteBGPPeerOutput) SetVirtualInterface(v *VirtualInterface) *DeleteBGPPeerOutput {
+	s.VirtualInterface = v
 	return s
 }
 
-type CreateDirectConnectGatewayAssociationProposalInput struct {
+type DeleteConnectionInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon VPC prefixes to advertise to the Direct Connect gateway.
-	AddAllowedPrefixesToDirectConnectGateway []*RouteFilterPrefix `locationName:"addAllowedPrefixesToDirectConnectGateway" type:"list"`
-
-	// The ID of the Direct Connect gateway.
-	//
-	// DirectConnectGatewayId is a required field
-	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string" required:"true"`
-
-	// The ID of the AWS account that owns the Direct Connect gateway.
-	//
-	// DirectConnectGatewayOwnerAccount is a required field
-	DirectConnectGatewayOwnerAccount *string `locationName:"directConnectGatewayOwnerAccount" type:"string" required:"true"`
-
-	// The ID of the virtual private gateway or transit gateway.
+	// The ID of the connection.
 	//
-	// GatewayId is a required field
-	GatewayId *string `locationName:"gatewayId" type:"string" required:"true"`
-
-	// The Amazon VPC prefixes to no longer advertise to the Direct Connect gateway.
-	RemoveAllowedPrefixesToDirectConnectGateway []*RouteFilterPrefix `locationName:"removeAllowedPrefixesToDirectConnectGateway" type:"list"`
+	// ConnectionId is a required field
+	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateDirectConnectGatewayAssociationProposalInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteConnectionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateDirectConnectGatewayAssociationProposalInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteConnectionInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateDirectConnectGatewayAssociationProposalInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateDirectConnectGatewayAssociationProposalInput"}
-	if s.DirectConnectGatewayId == nil {
-		invalidParams.Add(request.NewErrParamRequired("DirectConnectGatewayId"))
-	}
-	if s.DirectConnectGatewayOwnerAccount == nil {
-		invalidParams.Add(request.NewErrParamRequired("DirectConnectGatewayOwnerAccount"))
-	}
-	if s.GatewayId == nil {
-		invalidParams.Add(request.NewErrParamRequired("GatewayId"))
+func (s *DeleteConnectionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteConnectionInput"}
+	if s.ConnectionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -6543,89 +8849,124 @@ func (s *CreateDirectConnectGatewayAssociationProposalInput) Validate() error {
 	return nil
 }
 
-// SetAddAllowedPrefixesToDirectConnectGateway sets the AddAllowedPrefixesToDirectConnectGateway field's value.
-func (s *CreateDirectConnectGatewayAssociationProposalInput) SetAddAllowedPrefixesToDirectConnectGateway(v []*RouteFilterPrefix) *CreateDirectConnectGatewayAssociationProposalInput {
-	s.AddAllowedPrefixesToDirectConnectGateway = v
+// SetConnectionId sets the ConnectionId field's value.
+func (s *DeleteConnectionInput) SetConnectionId(v string) *DeleteConnectionInput {
+	s.ConnectionId = &v
 	return s
 }
 
-// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
-func (s *CreateDirectConnectGatewayAssociationProposalInput) SetDirectConnectGatewayId(v string) *CreateDirectConnectGatewayAssociationProposalInput {
-	s.DirectConnectGatewayId = &v
-	return s
+type DeleteDirectConnectGatewayAssociationInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ID of the Direct Connect gateway association.
+	AssociationId *string `locationName:"associationId" type:"string"`
+
+	// The ID of the Direct Connect gateway.
+	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
+
+	// The ID of the virtual private gateway.
+	VirtualGatewayId *string `locationName:"virtualGatewayId" type:"string"`
 }
 
-// SetDirectConnectGatewayOwnerAccount sets the DirectConnectGatewayOwnerAccount field's value.
-func (s *CreateDirectConnectGatewayAssociationProposalInput) SetDirectConnectGatewayOwnerAccount(v string) *CreateDirectConnectGatewayAssociationProposalInput {
-	s.DirectConnectGatewayOwnerAccount = &v
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDirectConnectGatewayAssociationInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDirectConnectGatewayAssociationInput) GoString() string {
+	return s.String()
+}
+
+// SetAssociationId sets the AssociationId field's value.
+func (s *DeleteDirectConnectGatewayAssociationInput) SetAssociationId(v string) *DeleteDirectConnectGatewayAssociationInput {
+	s.AssociationId = &v
 	return s
 }
 
-// SetGatewayId sets the GatewayId field's value.
-func (s *CreateDirectConnectGatewayAssociationProposalInput) SetGatewayId(v string) *CreateDirectConnectGatewayAssociationProposalInput {
-	s.GatewayId = &v
+// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
+func (s *DeleteDirectConnectGatewayAssociationInput) SetDirectConnectGatewayId(v string) *DeleteDirectConnectGatewayAssociationInput {
+	s.DirectConnectGatewayId = &v
 	return s
 }
 
-// SetRemoveAllowedPrefixesToDirectConnectGateway sets the RemoveAllowedPrefixesToDirectConnectGateway field's value.
-func (s *CreateDirectConnectGatewayAssociationProposalInput) SetRemoveAllowedPrefixesToDirectConnectGateway(v []*RouteFilterPrefix) *CreateDirectConnectGatewayAssociationProposalInput {
-	s.RemoveAllowedPrefixesToDirectConnectGateway = v
+// SetVirtualGatewayId sets the VirtualGatewayId field's value.
+func (s *DeleteDirectConnectGatewayAssociationInput) SetVirtualGatewayId(v string) *DeleteDirectConnectGatewayAssociationInput {
+	s.VirtualGatewayId = &v
 	return s
 }
 
-type CreateDirectConnectGatewayAssociationProposalOutput struct {
+type DeleteDirectConnectGatewayAssociationOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Information about the Direct Connect gateway proposal.
-	DirectConnectGatewayAssociationProposal *GatewayAssociationProposal `locationName:"directConnectGatewayAssociationProposal" type:"structure"`
+	// Information about the deleted association.
+	DirectConnectGatewayAssociation *GatewayAssociation `locationName:"directConnectGatewayAssociation" type:"structure"`
 }
 
-// String returns the string representation
-func (s CreateDirectConnectGatewayAssociationProposalOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDirectConnectGatewayAssociationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateDirectConnectGatewayAssociationProposalOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDirectConnectGatewayAssociationOutput) GoString() string {
 	return s.String()
 }
 
-// SetDirectConnectGatewayAssociationProposal sets the DirectConnectGatewayAssociationProposal field's value.
-func (s *CreateDirectConnectGatewayAssociationProposalOutput) SetDirectConnectGatewayAssociationProposal(v *GatewayAssociationProposal) *CreateDirectConnectGatewayAssociationProposalOutput {
-	s.DirectConnectGatewayAssociationProposal = v
+// SetDirectConnectGatewayAssociation sets the DirectConnectGatewayAssociation field's value.
+func (s *DeleteDirectConnectGatewayAssociationOutput) SetDirectConnectGatewayAssociation(v *GatewayAssociation) *DeleteDirectConnectGatewayAssociationOutput {
+	s.DirectConnectGatewayAssociation = v
 	return s
-}
-
-type CreateDirectConnectGatewayInput struct {
-	_ struct{} `type:"structure"`
-
-	// The autonomous system number (ASN) for Border Gateway Protocol (BGP) to be
-	// configured on the Amazon side of the connection. The ASN must be in the private
-	// range of 64,512 to 65,534 or 4,200,000,000 to 4,294,967,294. The default
-	// is 64512.
-	AmazonSideAsn *int64 `locationName:"amazonSideAsn" type:"long"`
+}
 
-	// The name of the Direct Connect gateway.
+type DeleteDirectConnectGatewayAssociationProposalInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ID of the proposal.
 	//
-	// DirectConnectGatewayName is a required field
-	DirectConnectGatewayName *string `locationName:"directConnectGatewayName" type:"string" required:"true"`
+	// ProposalId is a required field
+	ProposalId *string `locationName:"proposalId" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateDirectConnectGatewayInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDirectConnectGatewayAssociationProposalInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateDirectConnectGatewayInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDirectConnectGatewayAssociationProposalInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateDirectConnectGatewayInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateDirectConnectGatewayInput"}
-	if s.DirectConnectGatewayName == nil {
-		invalidParams.Add(request.NewErrParamRequired("DirectConnectGatewayName"))
+func (s *DeleteDirectConnectGatewayAssociationProposalInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteDirectConnectGatewayAssociationProposalInput"}
+	if s.ProposalId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ProposalId"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -6634,100 +8975,75 @@ func (s *CreateDirectConnectGatewayInput) Validate() error {
 	return nil
 }
 
-// SetAmazonSideAsn sets the AmazonSideAsn field's value.
-func (s *CreateDirectConnectGatewayInput) SetAmazonSideAsn(v int64) *CreateDirectConnectGatewayInput {
-	s.AmazonSideAsn = &v
-	return s
-}
-
-// SetDirectConnectGatewayName sets the DirectConnectGatewayName field's value.
-func (s *CreateDirectConnectGatewayInput) SetDirectConnectGatewayName(v string) *CreateDirectConnectGatewayInput {
-	s.DirectConnectGatewayName = &v
+// SetProposalId sets the ProposalId field's value.
+func (s *DeleteDirectConnectGatewayAssociationProposalInput) SetProposalId(v string) *DeleteDirectConnectGatewayAssociationProposalInput {
+	s.ProposalId = &v
 	return s
 }
 
-type CreateDirectConnectGatewayOutput struct {
+type DeleteDirectConnectGatewayAssociationProposalOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The Direct Connect gateway.
-	DirectConnectGateway *Gateway `locationName:"directConnectGateway" type:"structure"`
+	// The ID of the associated gateway.
+	DirectConnectGatewayAssociationProposal *GatewayAssociationProposal `locationName:"directConnectGatewayAssociationProposal" type:"structure"`
 }
 
-// String returns the string representation
-func (s CreateDirectConnectGatewayOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDirectConnectGatewayAssociationProposalOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateDirectConnectGatewayOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDirectConnectGatewayAssociationProposalOutput) GoString() string {
 	return s.String()
 }
 
-// SetDirectConnectGateway sets the DirectConnectGateway field's value.
-func (s *CreateDirectConnectGatewayOutput) SetDirectConnectGateway(v *Gateway) *CreateDirectConnectGatewayOutput {
-	s.DirectConnectGateway = v
+// SetDirectConnectGatewayAssociationProposal sets the DirectConnectGatewayAssociationProposal field's value.
+func (s *DeleteDirectConnectGatewayAssociationProposalOutput) SetDirectConnectGatewayAssociationProposal(v *GatewayAssociationProposal) *DeleteDirectConnectGatewayAssociationProposalOutput {
+	s.DirectConnectGatewayAssociationProposal = v
 	return s
 }
 
-type CreateInterconnectInput struct {
+type DeleteDirectConnectGatewayInput struct {
 	_ struct{} `type:"structure"`
 
-	// The port bandwidth, in Gbps. The possible values are 1 and 10.
-	//
-	// Bandwidth is a required field
-	Bandwidth *string `locationName:"bandwidth" type:"string" required:"true"`
-
-	// The name of the interconnect.
-	//
-	// InterconnectName is a required field
-	InterconnectName *string `locationName:"interconnectName" type:"string" required:"true"`
-
-	// The ID of the LAG.
-	LagId *string `locationName:"lagId" type:"string"`
-
-	// The location of the interconnect.
+	// The ID of the Direct Connect gateway.
 	//
-	// Location is a required field
-	Location *string `locationName:"location" type:"string" required:"true"`
-
-	// The tags to assign to the interconnect,
-	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
+	// DirectConnectGatewayId is a required field
+	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateInterconnectInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDirectConnectGatewayInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateInterconnectInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDirectConnectGatewayInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateInterconnectInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateInterconnectInput"}
-	if s.Bandwidth == nil {
-		invalidParams.Add(request.NewErrParamRequired("Bandwidth"))
-	}
-	if s.InterconnectName == nil {
-		invalidParams.Add(request.NewErrParamRequired("InterconnectName"))
-	}
-	if s.Location == nil {
-		invalidParams.Add(request.NewErrParamRequired("Location"))
-	}
-	if s.Tags != nil && len(s.Tags) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
-	}
-	if s.Tags != nil {
-		for i, v := range s.Tags {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
-			}
-		}
+func (s *DeleteDirectConnectGatewayInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteDirectConnectGatewayInput"}
+	if s.DirectConnectGatewayId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectConnectGatewayId"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -6736,126 +9052,75 @@ func (s *CreateInterconnectInput) Validate() error {
 	return nil
 }
 
-// SetBandwidth sets the Bandwidth field's value.
-func (s *CreateInterconnectInput) SetBandwidth(v string) *CreateInterconnectInput {
-	s.Bandwidth = &v
+// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
+func (s *DeleteDirectConnectGatewayInput) SetDirectConnectGatewayId(v string) *DeleteDirectConnectGatewayInput {
+	s.DirectConnectGatewayId = &v
 	return s
 }
 
-// SetInterconnectName sets the InterconnectName field's value.
-func (s *CreateInterconnectInput) SetInterconnectName(v string) *CreateInterconnectInput {
-	s.InterconnectName = &v
-	return s
+type DeleteDirectConnectGatewayOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The Direct Connect gateway.
+	DirectConnectGateway *Gateway `locationName:"directConnectGateway" type:"structure"`
 }
 
-// SetLagId sets the LagId field's value.
-func (s *CreateInterconnectInput) SetLagId(v string) *CreateInterconnectInput {
-	s.LagId = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDirectConnectGatewayOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetLocation sets the Location field's value.
-func (s *CreateInterconnectInput) SetLocation(v string) *CreateInterconnectInput {
-	s.Location = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDirectConnectGatewayOutput) GoString() string {
+	return s.String()
 }
 
-// SetTags sets the Tags field's value.
-func (s *CreateInterconnectInput) SetTags(v []*Tag) *CreateInterconnectInput {
-	s.Tags = v
+// SetDirectConnectGateway sets the DirectConnectGateway field's value.
+func (s *DeleteDirectConnectGatewayOutput) SetDirectConnectGateway(v *Gateway) *DeleteDirectConnectGatewayOutput {
+	s.DirectConnectGateway = v
 	return s
 }
 
-type CreateLagInput struct {
+type DeleteInterconnectInput struct {
 	_ struct{} `type:"structure"`
 
-	// The tags to assign to the child connections of the LAG. Only newly created
-	// child connections as the result of creating a LAG connection are assigned
-	// the provided tags. The tags are not assigned to an existing connection that
-	// is provided via the “connectionId” parameter that will be migrated to
-	// the LAG.
-	ChildConnectionTags []*Tag `locationName:"childConnectionTags" min:"1" type:"list"`
-
-	// The ID of an existing connection to migrate to the LAG.
-	ConnectionId *string `locationName:"connectionId" type:"string"`
-
-	// The bandwidth of the individual physical connections bundled by the LAG.
-	// The possible values are 50Mbps, 100Mbps, 200Mbps, 300Mbps, 400Mbps, 500Mbps,
-	// 1Gbps, 2Gbps, 5Gbps, and 10Gbps.
-	//
-	// ConnectionsBandwidth is a required field
-	ConnectionsBandwidth *string `locationName:"connectionsBandwidth" type:"string" required:"true"`
-
-	// The name of the LAG.
-	//
-	// LagName is a required field
-	LagName *string `locationName:"lagName" type:"string" required:"true"`
-
-	// The location for the LAG.
-	//
-	// Location is a required field
-	Location *string `locationName:"location" type:"string" required:"true"`
-
-	// The number of physical connections initially provisioned and bundled by the
-	// LAG.
+	// The ID of the interconnect.
 	//
-	// NumberOfConnections is a required field
-	NumberOfConnections *int64 `locationName:"numberOfConnections" type:"integer" required:"true"`
-
-	// The tags to assign to the link aggregation group (LAG).
-	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
+	// InterconnectId is a required field
+	InterconnectId *string `locationName:"interconnectId" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateLagInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteInterconnectInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateLagInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteInterconnectInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateLagInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateLagInput"}
-	if s.ChildConnectionTags != nil && len(s.ChildConnectionTags) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("ChildConnectionTags", 1))
-	}
-	if s.ConnectionsBandwidth == nil {
-		invalidParams.Add(request.NewErrParamRequired("ConnectionsBandwidth"))
-	}
-	if s.LagName == nil {
-		invalidParams.Add(request.NewErrParamRequired("LagName"))
-	}
-	if s.Location == nil {
-		invalidParams.Add(request.NewErrParamRequired("Location"))
-	}
-	if s.NumberOfConnections == nil {
-		invalidParams.Add(request.NewErrParamRequired("NumberOfConnections"))
-	}
-	if s.Tags != nil && len(s.Tags) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
-	}
-	if s.ChildConnectionTags != nil {
-		for i, v := range s.ChildConnectionTags {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ChildConnectionTags", i), err.(request.ErrInvalidParams))
-			}
-		}
-	}
-	if s.Tags != nil {
-		for i, v := range s.Tags {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
-			}
-		}
+func (s *DeleteInterconnectInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteInterconnectInput"}
+	if s.InterconnectId == nil {
+		invalidParams.Add(request.NewErrParamRequired("InterconnectId"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -6864,85 +9129,92 @@ func (s *CreateLagInput) Validate() error {
 	return nil
 }
 
-// SetChildConnectionTags sets the ChildConnectionTags field's value.
-func (s *CreateLagInput) SetChildConnectionTags(v []*Tag) *CreateLagInput {
-	s.ChildConnectionTags = v
-	return s
-}
-
-// SetConnectionId sets the ConnectionId field's value.
-func (s *CreateLagInput) SetConnectionId(v string) *CreateLagInput {
-	s.ConnectionId = &v
+// SetInterconnectId sets the InterconnectId field's value.
+func (s *DeleteInterconnectInput) SetInterconnectId(v string) *DeleteInterconnectInput {
+	s.InterconnectId = &v
 	return s
 }
 
-// SetConnectionsBandwidth sets the ConnectionsBandwidth field's value.
-func (s *CreateLagInput) SetConnectionsBandwidth(v string) *CreateLagInput {
-	s.ConnectionsBandwidth = &v
-	return s
-}
+type DeleteInterconnectOutput struct {
+	_ struct{} `type:"structure"`
 
-// SetLagName sets the LagName field's value.
-func (s *CreateLagInput) SetLagName(v string) *CreateLagInput {
-	s.LagName = &v
-	return s
+	// The state of the interconnect. The following are the possible values:
+	//
+	//    * requested: The initial state of an interconnect. The interconnect stays
+	//    in the requested state until the Letter of Authorization (LOA) is sent
+	//    to the customer.
+	//
+	//    * pending: The interconnect is approved, and is being initialized.
+	//
+	//    * available: The network link is up, and the interconnect is ready for
+	//    use.
+	//
+	//    * down: The network link is down.
+	//
+	//    * deleting: The interconnect is being deleted.
+	//
+	//    * deleted: The interconnect is deleted.
+	//
+	//    * unknown: The state of the interconnect is not available.
+	InterconnectState *string `locationName:"interconnectState" type:"string" enum:"InterconnectState"`
 }
 
-// SetLocation sets the Location field's value.
-func (s *CreateLagInput) SetLocation(v string) *CreateLagInput {
-	s.Location = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteInterconnectOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetNumberOfConnections sets the NumberOfConnections field's value.
-func (s *CreateLagInput) SetNumberOfConnections(v int64) *CreateLagInput {
-	s.NumberOfConnections = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteInterconnectOutput) GoString() string {
+	return s.String()
 }
 
-// SetTags sets the Tags field's value.
-func (s *CreateLagInput) SetTags(v []*Tag) *CreateLagInput {
-	s.Tags = v
+// SetInterconnectState sets the InterconnectState field's value.
+func (s *DeleteInterconnectOutput) SetInterconnectState(v string) *DeleteInterconnectOutput {
+	s.InterconnectState = &v
 	return s
 }
 
-type CreatePrivateVirtualInterfaceInput struct {
+type DeleteLagInput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the connection.
-	//
-	// ConnectionId is a required field
-	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
-
-	// Information about the private virtual interface.
+	// The ID of the LAG.
 	//
-	// NewPrivateVirtualInterface is a required field
-	NewPrivateVirtualInterface *NewPrivateVirtualInterface `locationName:"newPrivateVirtualInterface" type:"structure" required:"true"`
+	// LagId is a required field
+	LagId *string `locationName:"lagId" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreatePrivateVirtualInterfaceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteLagInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreatePrivateVirtualInterfaceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteLagInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreatePrivateVirtualInterfaceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreatePrivateVirtualInterfaceInput"}
-	if s.ConnectionId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
-	}
-	if s.NewPrivateVirtualInterface == nil {
-		invalidParams.Add(request.NewErrParamRequired("NewPrivateVirtualInterface"))
-	}
-	if s.NewPrivateVirtualInterface != nil {
-		if err := s.NewPrivateVirtualInterface.Validate(); err != nil {
-			invalidParams.AddNested("NewPrivateVirtualInterface", err.(request.ErrInvalidParams))
-		}
+func (s *DeleteLagInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteLagInput"}
+	if s.LagId == nil {
+		invalidParams.Add(request.NewErrParamRequired("LagId"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -6951,55 +9223,44 @@ func (s *CreatePrivateVirtualInterfaceInput) Validate() error {
 	return nil
 }
 
-// SetConnectionId sets the ConnectionId field's value.
-func (s *CreatePrivateVirtualInterfaceInput) SetConnectionId(v string) *CreatePrivateVirtualInterfaceInput {
-	s.ConnectionId = &v
-	return s
-}
-
-// SetNewPrivateVirtualInterface sets the NewPrivateVirtualInterface field's value.
-func (s *CreatePrivateVirtualInterfaceInput) SetNewPrivateVirtualInterface(v *NewPrivateVirtualInterface) *CreatePrivateVirtualInterfaceInput {
-	s.NewPrivateVirtualInterface = v
+// SetLagId sets the LagId field's value.
+func (s *DeleteLagInput) SetLagId(v string) *DeleteLagInput {
+	s.LagId = &v
 	return s
 }
 
-type CreatePublicVirtualInterfaceInput struct {
+type DeleteVirtualInterfaceInput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the connection.
-	//
-	// ConnectionId is a required field
-	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
-
-	// Information about the public virtual interface.
+	// The ID of the virtual interface.
 	//
-	// NewPublicVirtualInterface is a required field
-	NewPublicVirtualInterface *NewPublicVirtualInterface `locationName:"newPublicVirtualInterface" type:"structure" required:"true"`
+	// VirtualInterfaceId is a required field
+	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreatePublicVirtualInterfaceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVirtualInterfaceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreatePublicVirtualInterfaceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVirtualInterfaceInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreatePublicVirtualInterfaceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreatePublicVirtualInterfaceInput"}
-	if s.ConnectionId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
-	}
-	if s.NewPublicVirtualInterface == nil {
-		invalidParams.Add(request.NewErrParamRequired("NewPublicVirtualInterface"))
-	}
-	if s.NewPublicVirtualInterface != nil {
-		if err := s.NewPublicVirtualInterface.Validate(); err != nil {
-			invalidParams.AddNested("NewPublicVirtualInterface", err.(request.ErrInvalidParams))
-		}
+func (s *DeleteVirtualInterfaceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteVirtualInterfaceInput"}
+	if s.VirtualInterfaceId == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualInterfaceId"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -7008,19 +9269,73 @@ func (s *CreatePublicVirtualInterfaceInput) Validate() error {
 	return nil
 }
 
-// SetConnectionId sets the ConnectionId field's value.
-func (s *CreatePublicVirtualInterfaceInput) SetConnectionId(v string) *CreatePublicVirtualInterfaceInput {
-	s.ConnectionId = &v
+// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
+func (s *DeleteVirtualInterfaceInput) SetVirtualInterfaceId(v string) *DeleteVirtualInterfaceInput {
+	s.VirtualInterfaceId = &v
 	return s
 }
 
-// SetNewPublicVirtualInterface sets the NewPublicVirtualInterface field's value.
-func (s *CreatePublicVirtualInterfaceInput) SetNewPublicVirtualInterface(v *NewPublicVirtualInterface) *CreatePublicVirtualInterfaceInput {
-	s.NewPublicVirtualInterface = v
+type DeleteVirtualInterfaceOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The state of the virtual interface. The following are the possible values:
+	//
+	//    * confirming: The creation of the virtual interface is pending confirmation
+	//    from the virtual interface owner. If the owner of the virtual interface
+	//    is different from the owner of the connection on which it is provisioned,
+	//    then the virtual interface will remain in this state until it is confirmed
+	//    by the virtual interface owner.
+	//
+	//    * verifying: This state only applies to public virtual interfaces. Each
+	//    public virtual interface needs validation before the virtual interface
+	//    can be created.
+	//
+	//    * pending: A virtual interface is in this state from the time that it
+	//    is created until the virtual interface is ready to forward traffic.
+	//
+	//    * available: A virtual interface that is able to forward traffic.
+	//
+	//    * down: A virtual interface that is BGP down.
+	//
+	//    * deleting: A virtual interface is in this state immediately after calling
+	//    DeleteVirtualInterface until it can no longer forward traffic.
+	//
+	//    * deleted: A virtual interface that cannot forward traffic.
+	//
+	//    * rejected: The virtual interface owner has declined creation of the virtual
+	//    interface. If a virtual interface in the Confirming state is deleted by
+	//    the virtual interface owner, the virtual interface enters the Rejected
+	//    state.
+	//
+	//    * unknown: The state of the virtual interface is not available.
+	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string" enum:"VirtualInterfaceState"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVirtualInterfaceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVirtualInterfaceOutput) GoString() string {
+	return s.String()
+}
+
+// SetVirtualInterfaceState sets the VirtualInterfaceState field's value.
+func (s *DeleteVirtualInterfaceOutput) SetVirtualInterfaceState(v string) *DeleteVirtualInterfaceOutput {
+	s.VirtualInterfaceState = &v
 	return s
 }
 
-type CreateTransitVirtualInterfaceInput struct {
+type DescribeConnectionLoaInput struct {
 	_ struct{} `type:"structure"`
 
 	// The ID of the connection.
@@ -7028,36 +9343,40 @@ type CreateTransitVirtualInterfaceInput struct {
 	// ConnectionId is a required field
 	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
 
-	// Information about the transit virtual interface.
-	//
-	// NewTransitVirtualInterface is a required field
-	NewTransitVirtualInterface *NewTransitVirtualInterface `locationName:"newTransitVirtualInterface" type:"structure" required:"true"`
+	// The standard media type for the LOA-CFA document. The only supported value
+	// is application/pdf.
+	LoaContentType *string `locationName:"loaContentType" type:"string" enum:"LoaContentType"`
+
+	// The name of the APN partner or service provider who establishes connectivity
+	// on your behalf. If you specify this parameter, the LOA-CFA lists the provider
+	// name alongside your company name as the requester of the cross connect.
+	ProviderName *string `locationName:"providerName" type:"string"`
 }
 
-// String returns the string representation
-func (s CreateTransitVirtualInterfaceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeConnectionLoaInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateTransitVirtualInterfaceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeConnectionLoaInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateTransitVirtualInterfaceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateTransitVirtualInterfaceInput"}
+func (s *DescribeConnectionLoaInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeConnectionLoaInput"}
 	if s.ConnectionId == nil {
 		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
 	}
-	if s.NewTransitVirtualInterface == nil {
-		invalidParams.Add(request.NewErrParamRequired("NewTransitVirtualInterface"))
-	}
-	if s.NewTransitVirtualInterface != nil {
-		if err := s.NewTransitVirtualInterface.Validate(); err != nil {
-			invalidParams.AddNested("NewTransitVirtualInterface", err.(request.ErrInvalidParams))
-		}
-	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -7066,137 +9385,117 @@ func (s *CreateTransitVirtualInterfaceInput) Validate() error {
 }
 
 // SetConnectionId sets the ConnectionId field's value.
-func (s *CreateTransitVirtualInterfaceInput) SetConnectionId(v string) *CreateTransitVirtualInterfaceInput {
+func (s *DescribeConnectionLoaInput) SetConnectionId(v string) *DescribeConnectionLoaInput {
 	s.ConnectionId = &v
 	return s
 }
 
-// SetNewTransitVirtualInterface sets the NewTransitVirtualInterface field's value.
-func (s *CreateTransitVirtualInterfaceInput) SetNewTransitVirtualInterface(v *NewTransitVirtualInterface) *CreateTransitVirtualInterfaceInput {
-	s.NewTransitVirtualInterface = v
+// SetLoaContentType sets the LoaContentType field's value.
+func (s *DescribeConnectionLoaInput) SetLoaContentType(v string) *DescribeConnectionLoaInput {
+	s.LoaContentType = &v
 	return s
 }
 
-type CreateTransitVirtualInterfaceOutput struct {
-	_ struct{} `type:"structure"`
-
-	// Information about a virtual interface.
-	VirtualInterface *VirtualInterface `locationName:"virtualInterface" type:"structure"`
-}
-
-// String returns the string representation
-func (s CreateTransitVirtualInterfaceOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s CreateTransitVirtualInterfaceOutput) GoString() string {
-	return s.String()
-}
-
-// SetVirtualInterface sets the VirtualInterface field's value.
-func (s *CreateTransitVirtualInterfaceOutput) SetVirtualInterface(v *VirtualInterface) *CreateTransitVirtualInterfaceOutput {
-	s.VirtualInterface = v
+// SetProviderName sets the ProviderName field's value.
+func (s *DescribeConnectionLoaInput) SetProviderName(v string) *DescribeConnectionLoaInput {
+	s.ProviderName = &v
 	return s
 }
 
-type DeleteBGPPeerInput struct {
+type DescribeConnectionLoaOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The autonomous system (AS) number for Border Gateway Protocol (BGP) configuration.
-	Asn *int64 `locationName:"asn" type:"integer"`
-
-	// The ID of the BGP peer.
-	BgpPeerId *string `locationName:"bgpPeerId" type:"string"`
-
-	// The IP address assigned to the customer interface.
-	CustomerAddress *string `locationName:"customerAddress" type:"string"`
-
-	// The ID of the virtual interface.
-	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string"`
-}
-
-// String returns the string representation
-func (s DeleteBGPPeerInput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s DeleteBGPPeerInput) GoString() string {
-	return s.String()
-}
-
-// SetAsn sets the Asn field's value.
-func (s *DeleteBGPPeerInput) SetAsn(v int64) *DeleteBGPPeerInput {
-	s.Asn = &v
-	return s
+	// The Letter of Authorization - Connecting Facility Assignment (LOA-CFA).
+	Loa *Loa `locationName:"loa" type:"structure"`
 }
 
-// SetBgpPeerId sets the BgpPeerId field's value.
-func (s *DeleteBGPPeerInput) SetBgpPeerId(v string) *DeleteBGPPeerInput {
-	s.BgpPeerId = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeConnectionLoaOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetCustomerAddress sets the CustomerAddress field's value.
-func (s *DeleteBGPPeerInput) SetCustomerAddress(v string) *DeleteBGPPeerInput {
-	s.CustomerAddress = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeConnectionLoaOutput) GoString() string {
+	return s.String()
 }
 
-// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
-func (s *DeleteBGPPeerInput) SetVirtualInterfaceId(v string) *DeleteBGPPeerInput {
-	s.VirtualInterfaceId = &v
+// SetLoa sets the Loa field's value.
+func (s *DescribeConnectionLoaOutput) SetLoa(v *Loa) *DescribeConnectionLoaOutput {
+	s.Loa = v
 	return s
 }
 
-type DeleteBGPPeerOutput struct {
+type DescribeConnectionsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The virtual interface.
-	VirtualInterface *VirtualInterface `locationName:"virtualInterface" type:"structure"`
+	// The ID of the connection.
+	ConnectionId *string `locationName:"connectionId" type:"string"`
 }
 
-// String returns the string representation
-func (s DeleteBGPPeerOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeConnectionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteBGPPeerOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeConnectionsInput) GoString() string {
 	return s.String()
 }
 
-// SetVirtualInterface sets the VirtualInterface field's value.
-func (s *DeleteBGPPeerOutput) SetVirtualInterface(v *VirtualInterface) *DeleteBGPPeerOutput {
-	s.VirtualInterface = v
+// SetConnectionId sets the ConnectionId field's value.
+func (s *DescribeConnectionsInput) SetConnectionId(v string) *DescribeConnectionsInput {
+	s.ConnectionId = &v
 	return s
 }
 
-type DeleteConnectionInput struct {
+type DescribeConnectionsOnInterconnectInput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the connection.
+	// The ID of the interconnect.
 	//
-	// ConnectionId is a required field
-	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
+	// InterconnectId is a required field
+	InterconnectId *string `locationName:"interconnectId" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteConnectionInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeConnectionsOnInterconnectInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteConnectionInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeConnectionsOnInterconnectInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteConnectionInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteConnectionInput"}
-	if s.ConnectionId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
+func (s *DescribeConnectionsOnInterconnectInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeConnectionsOnInterconnectInput"}
+	if s.InterconnectId == nil {
+		invalidParams.Add(request.NewErrParamRequired("InterconnectId"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -7205,437 +9504,592 @@ func (s *DeleteConnectionInput) Validate() error {
 	return nil
 }
 
-// SetConnectionId sets the ConnectionId field's value.
-func (s *DeleteConnectionInput) SetConnectionId(v string) *DeleteConnectionInput {
-	s.ConnectionId = &v
+// SetInterconnectId sets the InterconnectId field's value.
+func (s *DescribeConnectionsOnInterconnectInput) SetInterconnectId(v string) *DescribeConnectionsOnInterconnectInput {
+	s.InterconnectId = &v
 	return s
 }
 
-type DeleteDirectConnectGatewayAssociationInput struct {
+type DescribeCustomerMetadataInput struct {
 	_ struct{} `type:"structure"`
+}
 
-	// The ID of the Direct Connect gateway association.
-	AssociationId *string `locationName:"associationId" type:"string"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeCustomerMetadataInput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// The ID of the Direct Connect gateway.
-	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeCustomerMetadataInput) GoString() string {
+	return s.String()
+}
 
-	// The ID of the virtual private gateway.
-	VirtualGatewayId *string `locationName:"virtualGatewayId" deprecated:"true" type:"string"`
+type DescribeCustomerMetadataOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The list of customer agreements.
+	Agreements []*CustomerAgreement `locationName:"agreements" type:"list"`
+
+	// The type of network-to-network interface (NNI) partner. The partner type
+	// will be one of the following:
+	//
+	//    * V1: This partner can only allocate 50Mbps, 100Mbps, 200Mbps, 300Mbps,
+	//    400Mbps, or 500Mbps subgigabit connections.
+	//
+	//    * V2: This partner can only allocate 1GB, 2GB, 5GB, or 10GB hosted connections.
+	//
+	//    * nonPartner: The customer is not a partner.
+	NniPartnerType *string `locationName:"nniPartnerType" type:"string" enum:"NniPartnerType"`
 }
 
-// String returns the string representation
-func (s DeleteDirectConnectGatewayAssociationInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeCustomerMetadataOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteDirectConnectGatewayAssociationInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeCustomerMetadataOutput) GoString() string {
 	return s.String()
 }
 
-// SetAssociationId sets the AssociationId field's value.
-func (s *DeleteDirectConnectGatewayAssociationInput) SetAssociationId(v string) *DeleteDirectConnectGatewayAssociationInput {
-	s.AssociationId = &v
-	return s
-}
-
-// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
-func (s *DeleteDirectConnectGatewayAssociationInput) SetDirectConnectGatewayId(v string) *DeleteDirectConnectGatewayAssociationInput {
-	s.DirectConnectGatewayId = &v
+// SetAgreements sets the Agreements field's value.
+func (s *DescribeCustomerMetadataOutput) SetAgreements(v []*CustomerAgreement) *DescribeCustomerMetadataOutput {
+	s.Agreements = v
 	return s
 }
 
-// SetVirtualGatewayId sets the VirtualGatewayId field's value.
-func (s *DeleteDirectConnectGatewayAssociationInput) SetVirtualGatewayId(v string) *DeleteDirectConnectGatewayAssociationInput {
-	s.VirtualGatewayId = &v
+// SetNniPartnerType sets the NniPartnerType field's value.
+func (s *DescribeCustomerMetadataOutput) SetNniPartnerType(v string) *DescribeCustomerMetadataOutput {
+	s.NniPartnerType = &v
 	return s
 }
 
-type DeleteDirectConnectGatewayAssociationOutput struct {
+type DescribeDirectConnectGatewayAssociationProposalsInput struct {
 	_ struct{} `type:"structure"`
 
-	// Information about the deleted association.
-	DirectConnectGatewayAssociation *GatewayAssociation `locationName:"directConnectGatewayAssociation" type:"structure"`
+	// The ID of the associated gateway.
+	AssociatedGatewayId *string `locationName:"associatedGatewayId" type:"string"`
+
+	// The ID of the Direct Connect gateway.
+	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
+
+	// The maximum number of results to return with a single call. To retrieve the
+	// remaining results, make another call with the returned nextToken value.
+	//
+	// If MaxResults is given a value larger than 100, only 100 results are returned.
+	MaxResults *int64 `locationName:"maxResults" type:"integer"`
+
+	// The token for the next page of results.
+	NextToken *string `locationName:"nextToken" type:"string"`
+
+	// The ID of the proposal.
+	ProposalId *string `locationName:"proposalId" type:"string"`
 }
 
-// String returns the string representation
-func (s DeleteDirectConnectGatewayAssociationOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDirectConnectGatewayAssociationProposalsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteDirectConnectGatewayAssociationOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDirectConnectGatewayAssociationProposalsInput) GoString() string {
 	return s.String()
 }
 
-// SetDirectConnectGatewayAssociation sets the DirectConnectGatewayAssociation field's value.
-func (s *DeleteDirectConnectGatewayAssociationOutput) SetDirectConnectGatewayAssociation(v *GatewayAssociation) *DeleteDirectConnectGatewayAssociationOutput {
-	s.DirectConnectGatewayAssociation = v
+// SetAssociatedGatewayId sets the AssociatedGatewayId field's value.
+func (s *DescribeDirectConnectGatewayAssociationProposalsInput) SetAssociatedGatewayId(v string) *DescribeDirectConnectGatewayAssociationProposalsInput {
+	s.AssociatedGatewayId = &v
 	return s
 }
 
-type DeleteDirectConnectGatewayAssociationProposalInput struct {
-	_ struct{} `type:"structure"`
-
-	// The ID of the proposal.
-	//
-	// ProposalId is a required field
-	ProposalId *string `locationName:"proposalId" type:"string" required:"true"`
-}
-
-// String returns the string representation
-func (s DeleteDirectConnectGatewayAssociationProposalInput) String() string {
-	return awsutil.Prettify(s)
+// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
+func (s *DescribeDirectConnectGatewayAssociationProposalsInput) SetDirectConnectGatewayId(v string) *DescribeDirectConnectGatewayAssociationProposalsInput {
+	s.DirectConnectGatewayId = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s DeleteDirectConnectGatewayAssociationProposalInput) GoString() string {
-	return s.String()
+// SetMaxResults sets the MaxResults field's value.
+func (s *DescribeDirectConnectGatewayAssociationProposalsInput) SetMaxResults(v int64) *DescribeDirectConnectGatewayAssociationProposalsInput {
+	s.MaxResults = &v
+	return s
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteDirectConnectGatewayAssociationProposalInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteDirectConnectGatewayAssociationProposalInput"}
-	if s.ProposalId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ProposalId"))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeDirectConnectGatewayAssociationProposalsInput) SetNextToken(v string) *DescribeDirectConnectGatewayAssociationProposalsInput {
+	s.NextToken = &v
+	return s
 }
 
 // SetProposalId sets the ProposalId field's value.
-func (s *DeleteDirectConnectGatewayAssociationProposalInput) SetProposalId(v string) *DeleteDirectConnectGatewayAssociationProposalInput {
+func (s *DescribeDirectConnectGatewayAssociationProposalsInput) SetProposalId(v string) *DescribeDirectConnectGatewayAssociationProposalsInput {
 	s.ProposalId = &v
 	return s
 }
 
-type DeleteDirectConnectGatewayAssociationProposalOutput struct {
+type DescribeDirectConnectGatewayAssociationProposalsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the associated gateway.
-	DirectConnectGatewayAssociationProposal *GatewayAssociationProposal `locationName:"directConnectGatewayAssociationProposal" type:"structure"`
+	// Describes the Direct Connect gateway association proposals.
+	DirectConnectGatewayAssociationProposals []*GatewayAssociationProposal `locationName:"directConnectGatewayAssociationProposals" type:"list"`
+
+	// The token to use to retrieve the next page of results. This value is null
+	// when there are no more results to return.
+	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
-func (s DeleteDirectConnectGatewayAssociationProposalOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDirectConnectGatewayAssociationProposalsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteDirectConnectGatewayAssociationProposalOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDirectConnectGatewayAssociationProposalsOutput) GoString() string {
 	return s.String()
 }
 
-// SetDirectConnectGatewayAssociationProposal sets the DirectConnectGatewayAssociationProposal field's value.
-func (s *DeleteDirectConnectGatewayAssociationProposalOutput) SetDirectConnectGatewayAssociationProposal(v *GatewayAssociationProposal) *DeleteDirectConnectGatewayAssociationProposalOutput {
-	s.DirectConnectGatewayAssociationProposal = v
+// SetDirectConnectGatewayAssociationProposals sets the DirectConnectGatewayAssociationProposals field's value.
+func (s *DescribeDirectConnectGatewayAssociationProposalsOutput) SetDirectConnectGatewayAssociationProposals(v []*GatewayAssociationProposal) *DescribeDirectConnectGatewayAssociationProposalsOutput {
+	s.DirectConnectGatewayAssociationProposals = v
 	return s
 }
 
-type DeleteDirectConnectGatewayInput struct {
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeDirectConnectGatewayAssociationProposalsOutput) SetNextToken(v string) *DescribeDirectConnectGatewayAssociationProposalsOutput {
+	s.NextToken = &v
+	return s
+}
+
+type DescribeDirectConnectGatewayAssociationsInput struct {
 	_ struct{} `type:"structure"`
 
+	// The ID of the associated gateway.
+	AssociatedGatewayId *string `locationName:"associatedGatewayId" type:"string"`
+
+	// The ID of the Direct Connect gateway association.
+	AssociationId *string `locationName:"associationId" type:"string"`
+
 	// The ID of the Direct Connect gateway.
+	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
+
+	// The maximum number of results to return with a single call. To retrieve the
+	// remaining results, make another call with the returned nextToken value.
 	//
-	// DirectConnectGatewayId is a required field
-	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string" required:"true"`
+	// If MaxResults is given a value larger than 100, only 100 results are returned.
+	MaxResults *int64 `locationName:"maxResults" type:"integer"`
+
+	// The token provided in the previous call to retrieve the next page.
+	NextToken *string `locationName:"nextToken" type:"string"`
+
+	// The ID of the virtual private gateway or transit gateway.
+	VirtualGatewayId *string `locationName:"virtualGatewayId" type:"string"`
 }
 
-// String returns the string representation
-func (s DeleteDirectConnectGatewayInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDirectConnectGatewayAssociationsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteDirectConnectGatewayInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDirectConnectGatewayAssociationsInput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteDirectConnectGatewayInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteDirectConnectGatewayInput"}
-	if s.DirectConnectGatewayId == nil {
-		invalidParams.Add(request.NewErrParamRequired("DirectConnectGatewayId"))
-	}
+// SetAssociatedGatewayId sets the AssociatedGatewayId field's value.
+func (s *DescribeDirectConnectGatewayAssociationsInput) SetAssociatedGatewayId(v string) *DescribeDirectConnectGatewayAssociationsInput {
+	s.AssociatedGatewayId = &v
+	return s
+}
+
+// SetAssociationId sets the AssociationId field's value.
+func (s *DescribeDirectConnectGatewayAssociationsInput) SetAssociationId(v string) *DescribeDirectConnectGatewayAssociationsInput {
+	s.AssociationId = &v
+	return s
+}
+
+// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
+func (s *DescribeDirectConnectGatewayAssociationsInput) SetDirectConnectGatewayId(v string) *DescribeDirectConnectGatewayAssociationsInput {
+	s.DirectConnectGatewayId = &v
+	return s
+}
+
+// SetMaxResults sets the MaxResults field's value.
+func (s *DescribeDirectConnectGatewayAssociationsInput) SetMaxResults(v int64) *DescribeDirectConnectGatewayAssociationsInput {
+	s.MaxResults = &v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeDirectConnectGatewayAssociationsInput) SetNextToken(v string) *DescribeDirectConnectGatewayAssociationsInput {
+	s.NextToken = &v
+	return s
 }
 
-// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
-func (s *DeleteDirectConnectGatewayInput) SetDirectConnectGatewayId(v string) *DeleteDirectConnectGatewayInput {
-	s.DirectConnectGatewayId = &v
+// SetVirtualGatewayId sets the VirtualGatewayId field's value.
+func (s *DescribeDirectConnectGatewayAssociationsInput) SetVirtualGatewayId(v string) *DescribeDirectConnectGatewayAssociationsInput {
+	s.VirtualGatewayId = &v
 	return s
 }
 
-type DeleteDirectConnectGatewayOutput struct {
+type DescribeDirectConnectGatewayAssociationsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The Direct Connect gateway.
-	DirectConnectGateway *Gateway `locationName:"directConnectGateway" type:"structure"`
+	// Information about the associations.
+	DirectConnectGatewayAssociations []*GatewayAssociation `locationName:"directConnectGatewayAssociations" type:"list"`
+
+	// The token to retrieve the next page.
+	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
-func (s DeleteDirectConnectGatewayOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDirectConnectGatewayAssociationsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteDirectConnectGatewayOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDirectConnectGatewayAssociationsOutput) GoString() string {
 	return s.String()
 }
 
-// SetDirectConnectGateway sets the DirectConnectGateway field's value.
-func (s *DeleteDirectConnectGatewayOutput) SetDirectConnectGateway(v *Gateway) *DeleteDirectConnectGatewayOutput {
-	s.DirectConnectGateway = v
+// SetDirectConnectGatewayAssociations sets the DirectConnectGatewayAssociations field's value.
+func (s *DescribeDirectConnectGatewayAssociationsOutput) SetDirectConnectGatewayAssociations(v []*GatewayAssociation) *DescribeDirectConnectGatewayAssociationsOutput {
+	s.DirectConnectGatewayAssociations = v
 	return s
 }
 
-type DeleteInterconnectInput struct {
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeDirectConnectGatewayAssociationsOutput) SetNextToken(v string) *DescribeDirectConnectGatewayAssociationsOutput {
+	s.NextToken = &v
+	return s
+}
+
+type DescribeDirectConnectGatewayAttachmentsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the interconnect.
+	// The ID of the Direct Connect gateway.
+	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
+
+	// The maximum number of results to return with a single call. To retrieve the
+	// remaining results, make another call with the returned nextToken value.
 	//
-	// InterconnectId is a required field
-	InterconnectId *string `locationName:"interconnectId" type:"string" required:"true"`
+	// If MaxResults is given a value larger than 100, only 100 results are returned.
+	MaxResults *int64 `locationName:"maxResults" type:"integer"`
+
+	// The token provided in the previous call to retrieve the next page.
+	NextToken *string `locationName:"nextToken" type:"string"`
+
+	// The ID of the virtual interface.
+	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string"`
 }
 
-// String returns the string representation
-func (s DeleteInterconnectInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDirectConnectGatewayAttachmentsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteInterconnectInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDirectConnectGatewayAttachmentsInput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteInterconnectInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteInterconnectInput"}
-	if s.InterconnectId == nil {
-		invalidParams.Add(request.NewErrParamRequired("InterconnectId"))
-	}
+// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
+func (s *DescribeDirectConnectGatewayAttachmentsInput) SetDirectConnectGatewayId(v string) *DescribeDirectConnectGatewayAttachmentsInput {
+	s.DirectConnectGatewayId = &v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetMaxResults sets the MaxResults field's value.
+func (s *DescribeDirectConnectGatewayAttachmentsInput) SetMaxResults(v int64) *DescribeDirectConnectGatewayAttachmentsInput {
+	s.MaxResults = &v
+	return s
 }
 
-// SetInterconnectId sets the InterconnectId field's value.
-func (s *DeleteInterconnectInput) SetInterconnectId(v string) *DeleteInterconnectInput {
-	s.InterconnectId = &v
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeDirectConnectGatewayAttachmentsInput) SetNextToken(v string) *DescribeDirectConnectGatewayAttachmentsInput {
+	s.NextToken = &v
 	return s
 }
 
-type DeleteInterconnectOutput struct {
+// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
+func (s *DescribeDirectConnectGatewayAttachmentsInput) SetVirtualInterfaceId(v string) *DescribeDirectConnectGatewayAttachmentsInput {
+	s.VirtualInterfaceId = &v
+	return s
+}
+
+type DescribeDirectConnectGatewayAttachmentsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The state of the interconnect. The following are the possible values:
-	//
-	//    * requested: The initial state of an interconnect. The interconnect stays
-	//    in the requested state until the Letter of Authorization (LOA) is sent
-	//    to the customer.
-	//
-	//    * pending: The interconnect is approved, and is being initialized.
-	//
-	//    * available: The network link is up, and the interconnect is ready for
-	//    use.
-	//
-	//    * down: The network link is down.
-	//
-	//    * deleting: The interconnect is being deleted.
-	//
-	//    * deleted: The interconnect is deleted.
-	//
-	//    * unknown: The state of the interconnect is not available.
-	InterconnectState *string `locationName:"interconnectState" type:"string" enum:"InterconnectState"`
+	// The attachments.
+	DirectConnectGatewayAttachments []*GatewayAttachment `locationName:"directConnectGatewayAttachments" type:"list"`
+
+	// The token to retrieve the next page.
+	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
-func (s DeleteInterconnectOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDirectConnectGatewayAttachmentsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteInterconnectOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDirectConnectGatewayAttachmentsOutput) GoString() string {
 	return s.String()
 }
 
-// SetInterconnectState sets the InterconnectState field's value.
-func (s *DeleteInterconnectOutput) SetInterconnectState(v string) *DeleteInterconnectOutput {
-	s.InterconnectState = &v
+// SetDirectConnectGatewayAttachments sets the DirectConnectGatewayAttachments field's value.
+func (s *DescribeDirectConnectGatewayAttachmentsOutput) SetDirectConnectGatewayAttachments(v []*GatewayAttachment) *DescribeDirectConnectGatewayAttachmentsOutput {
+	s.DirectConnectGatewayAttachments = v
 	return s
 }
 
-type DeleteLagInput struct {
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeDirectConnectGatewayAttachmentsOutput) SetNextToken(v string) *DescribeDirectConnectGatewayAttachmentsOutput {
+	s.NextToken = &v
+	return s
+}
+
+type DescribeDirectConnectGatewaysInput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the LAG.
+	// The ID of the Direct Connect gateway.
+	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
+
+	// The maximum number of results to return with a single call. To retrieve the
+	// remaining results, make another call with the returned nextToken value.
 	//
-	// LagId is a required field
-	LagId *string `locationName:"lagId" type:"string" required:"true"`
+	// If MaxResults is given a value larger than 100, only 100 results are returned.
+	MaxResults *int64 `locationName:"maxResults" type:"integer"`
+
+	// The token provided in the previous call to retrieve the next page.
+	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
-func (s DeleteLagInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDirectConnectGatewaysInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteLagInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDirectConnectGatewaysInput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteLagInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteLagInput"}
-	if s.LagId == nil {
-		invalidParams.Add(request.NewErrParamRequired("LagId"))
-	}
+// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
+func (s *DescribeDirectConnectGatewaysInput) SetDirectConnectGatewayId(v string) *DescribeDirectConnectGatewaysInput {
+	s.DirectConnectGatewayId = &v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetMaxResults sets the MaxResults field's value.
+func (s *DescribeDirectConnectGatewaysInput) SetMaxResults(v int64) *DescribeDirectConnectGatewaysInput {
+	s.MaxResults = &v
+	return s
 }
 
-// SetLagId sets the LagId field's value.
-func (s *DeleteLagInput) SetLagId(v string) *DeleteLagInput {
-	s.LagId = &v
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeDirectConnectGatewaysInput) SetNextToken(v string) *DescribeDirectConnectGatewaysInput {
+	s.NextToken = &v
 	return s
 }
 
-type DeleteVirtualInterfaceInput struct {
+type DescribeDirectConnectGatewaysOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the virtual interface.
-	//
-	// VirtualInterfaceId is a required field
-	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string" required:"true"`
+	// The Direct Connect gateways.
+	DirectConnectGateways []*Gateway `locationName:"directConnectGateways" type:"list"`
+
+	// The token to retrieve the next page.
+	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
-func (s DeleteVirtualInterfaceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDirectConnectGatewaysOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteVirtualInterfaceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDirectConnectGatewaysOutput) GoString() string {
 	return s.String()
 }
-
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteVirtualInterfaceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteVirtualInterfaceInput"}
-	if s.VirtualInterfaceId == nil {
-		invalidParams.Add(request.NewErrParamRequired("VirtualInterfaceId"))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
-// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
-func (s *DeleteVirtualInterfaceInput) SetVirtualInterfaceId(v string) *DeleteVirtualInterfaceInput {
-	s.VirtualInterfaceId = &v
-	return s
-}
-
-type DeleteVirtualInterfaceOutput struct {
-	_ struct{} `type:"structure"`
-
-	// The state of the virtual interface. The following are the possible values:
-	//
-	//    * confirming: The creation of the virtual interface is pending confirmation
-	//    from the virtual interface owner. If the owner of the virtual interface
-	//    is different from the owner of the connection on which it is provisioned,
-	//    then the virtual interface will remain in this state until it is confirmed
-	//    by the virtual interface owner.
-	//
-	//    * verifying: This state only applies to public virtual interfaces. Each
-	//    public virtual interface needs validation before the virtual interface
-	//    can be created.
-	//
-	//    * pending: A virtual interface is in this state from the time that it
-	//    is created until the virtual interface is ready to forward traffic.
-	//
-	//    * available: A virtual interface that is able to forward traffic.
-	//
-	//    * down: A virtual interface that is BGP down.
-	//
-	//    * deleting: A virtual interface is in this state immediately after calling
-	//    DeleteVirtualInterface until it can no longer forward traffic.
-	//
-	//    * deleted: A virtual interface that cannot forward traffic.
-	//
-	//    * rejected: The virtual interface owner has declined creation of the virtual
-	//    interface. If a virtual interface in the Confirming state is deleted by
-	//    the virtual interface owner, the virtual interface enters the Rejected
-	//    state.
+
+// SetDirectConnectGateways sets the DirectConnectGateways field's value.
+func (s *DescribeDirectConnectGatewaysOutput) SetDirectConnectGateways(v []*Gateway) *DescribeDirectConnectGatewaysOutput {
+	s.DirectConnectGateways = v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeDirectConnectGatewaysOutput) SetNextToken(v string) *DescribeDirectConnectGatewaysOutput {
+	s.NextToken = &v
+	return s
+}
+
+type DescribeHostedConnectionsInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ID of the interconnect or LAG.
 	//
-	//    * unknown: The state of the virtual interface is not available.
-	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string" enum:"VirtualInterfaceState"`
+	// ConnectionId is a required field
+	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteVirtualInterfaceOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeHostedConnectionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteVirtualInterfaceOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeHostedConnectionsInput) GoString() string {
 	return s.String()
 }
 
-// SetVirtualInterfaceState sets the VirtualInterfaceState field's value.
-func (s *DeleteVirtualInterfaceOutput) SetVirtualInterfaceState(v string) *DeleteVirtualInterfaceOutput {
-	s.VirtualInterfaceState = &v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DescribeHostedConnectionsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeHostedConnectionsInput"}
+	if s.ConnectionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetConnectionId sets the ConnectionId field's value.
+func (s *DescribeHostedConnectionsInput) SetConnectionId(v string) *DescribeHostedConnectionsInput {
+	s.ConnectionId = &v
 	return s
 }
 
-type DescribeConnectionLoaInput struct {
+type DescribeInterconnectLoaInput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the connection.
+	// The ID of the interconnect.
 	//
-	// ConnectionId is a required field
-	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
+	// InterconnectId is a required field
+	InterconnectId *string `locationName:"interconnectId" type:"string" required:"true"`
 
 	// The standard media type for the LOA-CFA document. The only supported value
 	// is application/pdf.
 	LoaContentType *string `locationName:"loaContentType" type:"string" enum:"LoaContentType"`
 
-	// The name of the APN partner or service provider who establishes connectivity
-	// on your behalf. If you specify this parameter, the LOA-CFA lists the provider
-	// name alongside your company name as the requester of the cross connect.
+	// The name of the service provider who establishes connectivity on your behalf.
+	// If you supply this parameter, the LOA-CFA lists the provider name alongside
+	// your company name as the requester of the cross connect.
 	ProviderName *string `locationName:"providerName" type:"string"`
 }
 
-// String returns the string representation
-func (s DescribeConnectionLoaInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeInterconnectLoaInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeConnectionLoaInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeInterconnectLoaInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DescribeConnectionLoaInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DescribeConnectionLoaInput"}
-	if s.ConnectionId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
+func (s *DescribeInterconnectLoaInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeInterconnectLoaInput"}
+	if s.InterconnectId == nil {
+		invalidParams.Add(request.NewErrParamRequired("InterconnectId"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -7644,492 +10098,656 @@ func (s *DescribeConnectionLoaInput) Validate() error {
 	return nil
 }
 
-// SetConnectionId sets the ConnectionId field's value.
-func (s *DescribeConnectionLoaInput) SetConnectionId(v string) *DescribeConnectionLoaInput {
-	s.ConnectionId = &v
+// SetInterconnectId sets the InterconnectId field's value.
+func (s *DescribeInterconnectLoaInput) SetInterconnectId(v string) *DescribeInterconnectLoaInput {
+	s.InterconnectId = &v
 	return s
 }
 
 // SetLoaContentType sets the LoaContentType field's value.
-func (s *DescribeConnectionLoaInput) SetLoaContentType(v string) *DescribeConnectionLoaInput {
+func (s *DescribeInterconnectLoaInput) SetLoaContentType(v string) *DescribeInterconnectLoaInput {
 	s.LoaContentType = &v
 	return s
 }
 
 // SetProviderName sets the ProviderName field's value.
-func (s *DescribeConnectionLoaInput) SetProviderName(v string) *DescribeConnectionLoaInput {
+func (s *DescribeInterconnectLoaInput) SetProviderName(v string) *DescribeInterconnectLoaInput {
 	s.ProviderName = &v
 	return s
 }
 
-type DescribeConnectionLoaOutput struct {
+type DescribeInterconnectLoaOutput struct {
 	_ struct{} `type:"structure"`
 
 	// The Letter of Authorization - Connecting Facility Assignment (LOA-CFA).
 	Loa *Loa `locationName:"loa" type:"structure"`
 }
 
-// String returns the string representation
-func (s DescribeConnectionLoaOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeInterconnectLoaOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeConnectionLoaOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeInterconnectLoaOutput) GoString() string {
 	return s.String()
 }
 
 // SetLoa sets the Loa field's value.
-func (s *DescribeConnectionLoaOutput) SetLoa(v *Loa) *DescribeConnectionLoaOutput {
+func (s *DescribeInterconnectLoaOutput) SetLoa(v *Loa) *DescribeInterconnectLoaOutput {
 	s.Loa = v
 	return s
 }
 
-type DescribeConnectionsInput struct {
+type DescribeInterconnectsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the connection.
-	ConnectionId *string `locationName:"connectionId" type:"string"`
+	// The ID of the interconnect.
+	InterconnectId *string `locationName:"interconnectId" type:"string"`
 }
 
-// String returns the string representation
-func (s DescribeConnectionsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeInterconnectsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeConnectionsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeInterconnectsInput) GoString() string {
 	return s.String()
 }
 
-// SetConnectionId sets the ConnectionId field's value.
-func (s *DescribeConnectionsInput) SetConnectionId(v string) *DescribeConnectionsInput {
-	s.ConnectionId = &v
+// SetInterconnectId sets the InterconnectId field's value.
+func (s *DescribeInterconnectsInput) SetInterconnectId(v string) *DescribeInterconnectsInput {
+	s.InterconnectId = &v
 	return s
 }
 
-type DescribeConnectionsOnInterconnectInput struct {
+type DescribeInterconnectsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the interconnect.
-	//
-	// InterconnectId is a required field
-	InterconnectId *string `locationName:"interconnectId" type:"string" required:"true"`
+	// The interconnects.
+	Interconnects []*Interconnect `locationName:"interconnects" type:"list"`
 }
 
-// String returns the string representation
-func (s DescribeConnectionsOnInterconnectInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeInterconnectsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeConnectionsOnInterconnectInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeInterconnectsOutput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DescribeConnectionsOnInterconnectInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DescribeConnectionsOnInterconnectInput"}
-	if s.InterconnectId == nil {
-		invalidParams.Add(request.NewErrParamRequired("InterconnectId"))
-	}
+// SetInterconnects sets the Interconnects field's value.
+func (s *DescribeInterconnectsOutput) SetInterconnects(v []*Interconnect) *DescribeInterconnectsOutput {
+	s.Interconnects = v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+type DescribeLagsInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ID of the LAG.
+	LagId *string `locationName:"lagId" type:"string"`
 }
 
-// SetInterconnectId sets the InterconnectId field's value.
-func (s *DescribeConnectionsOnInterconnectInput) SetInterconnectId(v string) *DescribeConnectionsOnInterconnectInput {
-	s.InterconnectId = &v
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeLagsInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeLagsInput) GoString() string {
+	return s.String()
+}
+
+// SetLagId sets the LagId field's value.
+func (s *DescribeLagsInput) SetLagId(v string) *DescribeLagsInput {
+	s.LagId = &v
 	return s
 }
 
-type DescribeDirectConnectGatewayAssociationProposalsInput struct {
+type DescribeLagsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the associated gateway.
-	AssociatedGatewayId *string `locationName:"associatedGatewayId" type:"string"`
+	// The LAGs.
+	Lags []*Lag `locationName:"lags" type:"list"`
+}
 
-	// The ID of the Direct Connect gateway.
-	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeLagsOutput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// The maximum number of results to return with a single call. To retrieve the
-	// remaining results, make another call with the returned nextToken value.
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeLagsOutput) GoString() string {
+	return s.String()
+}
+
+// SetLags sets the Lags field's value.
+func (s *DescribeLagsOutput) SetLags(v []*Lag) *DescribeLagsOutput {
+	s.Lags = v
+	return s
+}
+
+type DescribeLoaInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ID of a connection, LAG, or interconnect.
 	//
-	// If MaxResults is given a value larger than 100, only 100 results are returned.
-	MaxResults *int64 `locationName:"maxResults" type:"integer"`
+	// ConnectionId is a required field
+	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
 
-	// The token for the next page of results.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	// The standard media type for the LOA-CFA document. The only supported value
+	// is application/pdf.
+	LoaContentType *string `locationName:"loaContentType" type:"string" enum:"LoaContentType"`
 
-	// The ID of the proposal.
-	ProposalId *string `locationName:"proposalId" type:"string"`
+	// The name of the service provider who establishes connectivity on your behalf.
+	// If you specify this parameter, the LOA-CFA lists the provider name alongside
+	// your company name as the requester of the cross connect.
+	ProviderName *string `locationName:"providerName" type:"string"`
 }
 
-// String returns the string representation
-func (s DescribeDirectConnectGatewayAssociationProposalsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeLoaInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeDirectConnectGatewayAssociationProposalsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeLoaInput) GoString() string {
 	return s.String()
 }
 
-// SetAssociatedGatewayId sets the AssociatedGatewayId field's value.
-func (s *DescribeDirectConnectGatewayAssociationProposalsInput) SetAssociatedGatewayId(v string) *DescribeDirectConnectGatewayAssociationProposalsInput {
-	s.AssociatedGatewayId = &v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DescribeLoaInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeLoaInput"}
+	if s.ConnectionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetConnectionId sets the ConnectionId field's value.
+func (s *DescribeLoaInput) SetConnectionId(v string) *DescribeLoaInput {
+	s.ConnectionId = &v
+	return s
+}
+
+// SetLoaContentType sets the LoaContentType field's value.
+func (s *DescribeLoaInput) SetLoaContentType(v string) *DescribeLoaInput {
+	s.LoaContentType = &v
+	return s
+}
+
+// SetProviderName sets the ProviderName field's value.
+func (s *DescribeLoaInput) SetProviderName(v string) *DescribeLoaInput {
+	s.ProviderName = &v
 	return s
 }
 
-// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
-func (s *DescribeDirectConnectGatewayAssociationProposalsInput) SetDirectConnectGatewayId(v string) *DescribeDirectConnectGatewayAssociationProposalsInput {
-	s.DirectConnectGatewayId = &v
-	return s
+type DescribeLocationsInput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeLocationsInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeLocationsInput) GoString() string {
+	return s.String()
+}
+
+type DescribeLocationsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The locations.
+	Locations []*Location `locationName:"locations" type:"list"`
 }
 
-// SetMaxResults sets the MaxResults field's value.
-func (s *DescribeDirectConnectGatewayAssociationProposalsInput) SetMaxResults(v int64) *DescribeDirectConnectGatewayAssociationProposalsInput {
-	s.MaxResults = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeLocationsOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *DescribeDirectConnectGatewayAssociationProposalsInput) SetNextToken(v string) *DescribeDirectConnectGatewayAssociationProposalsInput {
-	s.NextToken = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeLocationsOutput) GoString() string {
+	return s.String()
 }
 
-// SetProposalId sets the ProposalId field's value.
-func (s *DescribeDirectConnectGatewayAssociationProposalsInput) SetProposalId(v string) *DescribeDirectConnectGatewayAssociationProposalsInput {
-	s.ProposalId = &v
+// SetLocations sets the Locations field's value.
+func (s *DescribeLocationsOutput) SetLocations(v []*Location) *DescribeLocationsOutput {
+	s.Locations = v
 	return s
 }
 
-type DescribeDirectConnectGatewayAssociationProposalsOutput struct {
+// Provides the details about a virtual interface's router.
+type DescribeRouterConfigurationInput struct {
 	_ struct{} `type:"structure"`
 
-	// Describes the Direct Connect gateway association proposals.
-	DirectConnectGatewayAssociationProposals []*GatewayAssociationProposal `locationName:"directConnectGatewayAssociationProposals" type:"list"`
+	// Identifies the router by a combination of vendor, platform, and software
+	// version. For example, CiscoSystemsInc-2900SeriesRouters-IOS124.
+	RouterTypeIdentifier *string `locationName:"routerTypeIdentifier" type:"string"`
 
-	// The token to use to retrieve the next page of results. This value is null
-	// when there are no more results to return.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	// The ID of the virtual interface.
+	//
+	// VirtualInterfaceId is a required field
+	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DescribeDirectConnectGatewayAssociationProposalsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeRouterConfigurationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeDirectConnectGatewayAssociationProposalsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeRouterConfigurationInput) GoString() string {
 	return s.String()
 }
 
-// SetDirectConnectGatewayAssociationProposals sets the DirectConnectGatewayAssociationProposals field's value.
-func (s *DescribeDirectConnectGatewayAssociationProposalsOutput) SetDirectConnectGatewayAssociationProposals(v []*GatewayAssociationProposal) *DescribeDirectConnectGatewayAssociationProposalsOutput {
-	s.DirectConnectGatewayAssociationProposals = v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DescribeRouterConfigurationInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeRouterConfigurationInput"}
+	if s.VirtualInterfaceId == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualInterfaceId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetRouterTypeIdentifier sets the RouterTypeIdentifier field's value.
+func (s *DescribeRouterConfigurationInput) SetRouterTypeIdentifier(v string) *DescribeRouterConfigurationInput {
+	s.RouterTypeIdentifier = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *DescribeDirectConnectGatewayAssociationProposalsOutput) SetNextToken(v string) *DescribeDirectConnectGatewayAssociationProposalsOutput {
-	s.NextToken = &v
+// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
+func (s *DescribeRouterConfigurationInput) SetVirtualInterfaceId(v string) *DescribeRouterConfigurationInput {
+	s.VirtualInterfaceId = &v
 	return s
 }
 
-type DescribeDirectConnectGatewayAssociationsInput struct {
+type DescribeRouterConfigurationOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the associated gateway.
-	AssociatedGatewayId *string `locationName:"associatedGatewayId" type:"string"`
-
-	// The ID of the Direct Connect gateway association.
-	AssociationId *string `locationName:"associationId" type:"string"`
-
-	// The ID of the Direct Connect gateway.
-	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
+	// The customer router configuration.
+	CustomerRouterConfig *string `locationName:"customerRouterConfig" type:"string"`
 
-	// The maximum number of results to return with a single call. To retrieve the
-	// remaining results, make another call with the returned nextToken value.
-	//
-	// If MaxResults is given a value larger than 100, only 100 results are returned.
-	MaxResults *int64 `locationName:"maxResults" type:"integer"`
+	// The details about the router.
+	Router *RouterType `locationName:"router" type:"structure"`
 
-	// The token provided in the previous call to retrieve the next page.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	// The ID assigned to the virtual interface.
+	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string"`
 
-	// The ID of the virtual private gateway.
-	VirtualGatewayId *string `locationName:"virtualGatewayId" deprecated:"true" type:"string"`
+	// Provides the details about a virtual interface's router.
+	VirtualInterfaceName *string `locationName:"virtualInterfaceName" type:"string"`
 }
 
-// String returns the string representation
-func (s DescribeDirectConnectGatewayAssociationsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeRouterConfigurationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeDirectConnectGatewayAssociationsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeRouterConfigurationOutput) GoString() string {
 	return s.String()
 }
 
-// SetAssociatedGatewayId sets the AssociatedGatewayId field's value.
-func (s *DescribeDirectConnectGatewayAssociationsInput) SetAssociatedGatewayId(v string) *DescribeDirectConnectGatewayAssociationsInput {
-	s.AssociatedGatewayId = &v
-	return s
-}
-
-// SetAssociationId sets the AssociationId field's value.
-func (s *DescribeDirectConnectGatewayAssociationsInput) SetAssociationId(v string) *DescribeDirectConnectGatewayAssociationsInput {
-	s.AssociationId = &v
-	return s
-}
-
-// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
-func (s *DescribeDirectConnectGatewayAssociationsInput) SetDirectConnectGatewayId(v string) *DescribeDirectConnectGatewayAssociationsInput {
-	s.DirectConnectGatewayId = &v
+// SetCustomerRouterConfig sets the CustomerRouterConfig field's value.
+func (s *DescribeRouterConfigurationOutput) SetCustomerRouterConfig(v string) *DescribeRouterConfigurationOutput {
+	s.CustomerRouterConfig = &v
 	return s
 }
 
-// SetMaxResults sets the MaxResults field's value.
-func (s *DescribeDirectConnectGatewayAssociationsInput) SetMaxResults(v int64) *DescribeDirectConnectGatewayAssociationsInput {
-	s.MaxResults = &v
+// SetRouter sets the Router field's value.
+func (s *DescribeRouterConfigurationOutput) SetRouter(v *RouterType) *DescribeRouterConfigurationOutput {
+	s.Router = v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *DescribeDirectConnectGatewayAssociationsInput) SetNextToken(v string) *DescribeDirectConnectGatewayAssociationsInput {
-	s.NextToken = &v
+// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
+func (s *DescribeRouterConfigurationOutput) SetVirtualInterfaceId(v string) *DescribeRouterConfigurationOutput {
+	s.VirtualInterfaceId = &v
 	return s
 }
 
-// SetVirtualGatewayId sets the VirtualGatewayId field's value.
-func (s *DescribeDirectConnectGatewayAssociationsInput) SetVirtualGatewayId(v string) *DescribeDirectConnectGatewayAssociationsInput {
-	s.VirtualGatewayId = &v
+// SetVirtualInterfaceName sets the VirtualInterfaceName field's value.
+func (s *DescribeRouterConfigurationOutput) SetVirtualInterfaceName(v string) *DescribeRouterConfigurationOutput {
+	s.VirtualInterfaceName = &v
 	return s
 }
 
-type DescribeDirectConnectGatewayAssociationsOutput struct {
+type DescribeTagsInput struct {
 	_ struct{} `type:"structure"`
 
-	// Information about the associations.
-	DirectConnectGatewayAssociations []*GatewayAssociation `locationName:"directConnectGatewayAssociations" type:"list"`
-
-	// The token to retrieve the next page.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	// The Amazon Resource Names (ARNs) of the resources.
+	//
+	// ResourceArns is a required field
+	ResourceArns []*string `locationName:"resourceArns" type:"list" required:"true"`
 }
 
-// String returns the string representation
-func (s DescribeDirectConnectGatewayAssociationsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeTagsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeDirectConnectGatewayAssociationsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeTagsInput) GoString() string {
 	return s.String()
 }
 
-// SetDirectConnectGatewayAssociations sets the DirectConnectGatewayAssociations field's value.
-func (s *DescribeDirectConnectGatewayAssociationsOutput) SetDirectConnectGatewayAssociations(v []*GatewayAssociation) *DescribeDirectConnectGatewayAssociationsOutput {
-	s.DirectConnectGatewayAssociations = v
-	return s
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DescribeTagsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeTagsInput"}
+	if s.ResourceArns == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceArns"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *DescribeDirectConnectGatewayAssociationsOutput) SetNextToken(v string) *DescribeDirectConnectGatewayAssociationsOutput {
-	s.NextToken = &v
+// SetResourceArns sets the ResourceArns field's value.
+func (s *DescribeTagsInput) SetResourceArns(v []*string) *DescribeTagsInput {
+	s.ResourceArns = v
 	return s
 }
 
-type DescribeDirectConnectGatewayAttachmentsInput struct {
+type DescribeTagsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the Direct Connect gateway.
-	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
-
-	// The maximum number of results to return with a single call. To retrieve the
-	// remaining results, make another call with the returned nextToken value.
-	//
-	// If MaxResults is given a value larger than 100, only 100 results are returned.
-	MaxResults *int64 `locationName:"maxResults" type:"integer"`
-
-	// The token provided in the previous call to retrieve the next page.
-	NextToken *string `locationName:"nextToken" type:"string"`
-
-	// The ID of the virtual interface.
-	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string"`
+	// Information about the tags.
+	ResourceTags []*ResourceTag `locationName:"resourceTags" type:"list"`
 }
 
-// String returns the string representation
-func (s DescribeDirectConnectGatewayAttachmentsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeTagsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeDirectConnectGatewayAttachmentsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeTagsOutput) GoString() string {
 	return s.String()
 }
 
-// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
-func (s *DescribeDirectConnectGatewayAttachmentsInput) SetDirectConnectGatewayId(v string) *DescribeDirectConnectGatewayAttachmentsInput {
-	s.DirectConnectGatewayId = &v
+// SetResourceTags sets the ResourceTags field's value.
+func (s *DescribeTagsOutput) SetResourceTags(v []*ResourceTag) *DescribeTagsOutput {
+	s.ResourceTags = v
 	return s
 }
 
-// SetMaxResults sets the MaxResults field's value.
-func (s *DescribeDirectConnectGatewayAttachmentsInput) SetMaxResults(v int64) *DescribeDirectConnectGatewayAttachmentsInput {
-	s.MaxResults = &v
-	return s
+type DescribeVirtualGatewaysInput struct {
+	_ struct{} `type:"structure"`
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *DescribeDirectConnectGatewayAttachmentsInput) SetNextToken(v string) *DescribeDirectConnectGatewayAttachmentsInput {
-	s.NextToken = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualGatewaysInput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
-func (s *DescribeDirectConnectGatewayAttachmentsInput) SetVirtualInterfaceId(v string) *DescribeDirectConnectGatewayAttachmentsInput {
-	s.VirtualInterfaceId = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualGatewaysInput) GoString() string {
+	return s.String()
 }
 
-type DescribeDirectConnectGatewayAttachmentsOutput struct {
+type DescribeVirtualGatewaysOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The attachments.
-	DirectConnectGatewayAttachments []*GatewayAttachment `locationName:"directConnectGatewayAttachments" type:"list"`
-
-	// The token to retrieve the next page.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	// The virtual private gateways.
+	VirtualGateways []*VirtualGateway `locationName:"virtualGateways" type:"list"`
 }
 
-// String returns the string representation
-func (s DescribeDirectConnectGatewayAttachmentsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualGatewaysOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeDirectConnectGatewayAttachmentsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualGatewaysOutput) GoString() string {
 	return s.String()
 }
 
-// SetDirectConnectGatewayAttachments sets the DirectConnectGatewayAttachments field's value.
-func (s *DescribeDirectConnectGatewayAttachmentsOutput) SetDirectConnectGatewayAttachments(v []*GatewayAttachment) *DescribeDirectConnectGatewayAttachmentsOutput {
-	s.DirectConnectGatewayAttachments = v
-	return s
-}
-
-// SetNextToken sets the NextToken field's value.
-func (s *DescribeDirectConnectGatewayAttachmentsOutput) SetNextToken(v string) *DescribeDirectConnectGatewayAttachmentsOutput {
-	s.NextToken = &v
+// SetVirtualGateways sets the VirtualGateways field's value.
+func (s *DescribeVirtualGatewaysOutput) SetVirtualGateways(v []*VirtualGateway) *DescribeVirtualGatewaysOutput {
+	s.VirtualGateways = v
 	return s
 }
 
-type DescribeDirectConnectGatewaysInput struct {
+type DescribeVirtualInterfacesInput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the Direct Connect gateway.
-	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
-
-	// The maximum number of results to return with a single call. To retrieve the
-	// remaining results, make another call with the returned nextToken value.
-	//
-	// If MaxResults is given a value larger than 100, only 100 results are returned.
-	MaxResults *int64 `locationName:"maxResults" type:"integer"`
+	// The ID of the connection.
+	ConnectionId *string `locationName:"connectionId" type:"string"`
 
-	// The token provided in the previous call to retrieve the next page.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	// The ID of the virtual interface.
+	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string"`
 }
 
-// String returns the string representation
-func (s DescribeDirectConnectGatewaysInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualInterfacesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeDirectConnectGatewaysInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualInterfacesInput) GoString() string {
 	return s.String()
 }
 
-// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
-func (s *DescribeDirectConnectGatewaysInput) SetDirectConnectGatewayId(v string) *DescribeDirectConnectGatewaysInput {
-	s.DirectConnectGatewayId = &v
-	return s
-}
-
-// SetMaxResults sets the MaxResults field's value.
-func (s *DescribeDirectConnectGatewaysInput) SetMaxResults(v int64) *DescribeDirectConnectGatewaysInput {
-	s.MaxResults = &v
+// SetConnectionId sets the ConnectionId field's value.
+func (s *DescribeVirtualInterfacesInput) SetConnectionId(v string) *DescribeVirtualInterfacesInput {
+	s.ConnectionId = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *DescribeDirectConnectGatewaysInput) SetNextToken(v string) *DescribeDirectConnectGatewaysInput {
-	s.NextToken = &v
+// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
+func (s *DescribeVirtualInterfacesInput) SetVirtualInterfaceId(v string) *DescribeVirtualInterfacesInput {
+	s.VirtualInterfaceId = &v
 	return s
 }
 
-type DescribeDirectConnectGatewaysOutput struct {
+type DescribeVirtualInterfacesOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The Direct Connect gateways.
-	DirectConnectGateways []*Gateway `locationName:"directConnectGateways" type:"list"`
-
-	// The token to retrieve the next page.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	// The virtual interfaces
+	VirtualInterfaces []*VirtualInterface `locationName:"virtualInterfaces" type:"list"`
 }
 
-// String returns the string representation
-func (s DescribeDirectConnectGatewaysOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualInterfacesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeDirectConnectGatewaysOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeVirtualInterfacesOutput) GoString() string {
 	return s.String()
 }
 
-// SetDirectConnectGateways sets the DirectConnectGateways field's value.
-func (s *DescribeDirectConnectGatewaysOutput) SetDirectConnectGateways(v []*Gateway) *DescribeDirectConnectGatewaysOutput {
-	s.DirectConnectGateways = v
-	return s
-}
-
-// SetNextToken sets the NextToken field's value.
-func (s *DescribeDirectConnectGatewaysOutput) SetNextToken(v string) *DescribeDirectConnectGatewaysOutput {
-	s.NextToken = &v
+// SetVirtualInterfaces sets the VirtualInterfaces field's value.
+func (s *DescribeVirtualInterfacesOutput) SetVirtualInterfaces(v []*VirtualInterface) *DescribeVirtualInterfacesOutput {
+	s.VirtualInterfaces = v
 	return s
 }
 
-type DescribeHostedConnectionsInput struct {
+type DisassociateConnectionFromLagInput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the interconnect or LAG.
+	// The ID of the connection.
 	//
 	// ConnectionId is a required field
 	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
+
+	// The ID of the LAG.
+	//
+	// LagId is a required field
+	LagId *string `locationName:"lagId" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DescribeHostedConnectionsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisassociateConnectionFromLagInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeHostedConnectionsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisassociateConnectionFromLagInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DescribeHostedConnectionsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DescribeHostedConnectionsInput"}
+func (s *DisassociateConnectionFromLagInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisassociateConnectionFromLagInput"}
 	if s.ConnectionId == nil {
 		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
 	}
+	if s.LagId == nil {
+		invalidParams.Add(request.NewErrParamRequired("LagId"))
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -8138,44 +10756,62 @@ func (s *DescribeHostedConnectionsInput) Validate() error {
 }
 
 // SetConnectionId sets the ConnectionId field's value.
-func (s *DescribeHostedConnectionsInput) SetConnectionId(v string) *DescribeHostedConnectionsInput {
+func (s *DisassociateConnectionFromLagInput) SetConnectionId(v string) *DisassociateConnectionFromLagInput {
 	s.ConnectionId = &v
 	return s
 }
 
-type DescribeInterconnectLoaInput struct {
+// SetLagId sets the LagId field's value.
+func (s *DisassociateConnectionFromLagInput) SetLagId(v string) *DisassociateConnectionFromLagInput {
+	s.LagId = &v
+	return s
+}
+
+type DisassociateMacSecKeyInput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the interconnect.
+	// The ID of the dedicated connection (dxcon-xxxx), or the ID of the LAG (dxlag-xxxx).
 	//
-	// InterconnectId is a required field
-	InterconnectId *string `locationName:"interconnectId" type:"string" required:"true"`
-
-	// The standard media type for the LOA-CFA document. The only supported value
-	// is application/pdf.
-	LoaContentType *string `locationName:"loaContentType" type:"string" enum:"LoaContentType"`
+	// You can use DescribeConnections or DescribeLags to retrieve connection ID.
+	//
+	// ConnectionId is a required field
+	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
 
-	// The name of the service provider who establishes connectivity on your behalf.
-	// If you supply this parameter, the LOA-CFA lists the provider name alongside
-	// your company name as the requester of the cross connect.
-	ProviderName *string `locationName:"providerName" type:"string"`
+	// The Amazon Resource Name (ARN) of the MAC Security (MACsec) secret key.
+	//
+	// You can use DescribeConnections to retrieve the ARN of the MAC Security (MACsec)
+	// secret key.
+	//
+	// SecretARN is a required field
+	SecretARN *string `locationName:"secretARN" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DescribeInterconnectLoaInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisassociateMacSecKeyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeInterconnectLoaInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisassociateMacSecKeyInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DescribeInterconnectLoaInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DescribeInterconnectLoaInput"}
-	if s.InterconnectId == nil {
-		invalidParams.Add(request.NewErrParamRequired("InterconnectId"))
+func (s *DisassociateMacSecKeyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisassociateMacSecKeyInput"}
+	if s.ConnectionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
+	}
+	if s.SecretARN == nil {
+		invalidParams.Add(request.NewErrParamRequired("SecretARN"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -8184,1234 +10820,1508 @@ func (s *DescribeInterconnectLoaInput) Validate() error {
 	return nil
 }
 
-// SetInterconnectId sets the InterconnectId field's value.
-func (s *DescribeInterconnectLoaInput) SetInterconnectId(v string) *DescribeInterconnectLoaInput {
-	s.InterconnectId = &v
-	return s
-}
-
-// SetLoaContentType sets the LoaContentType field's value.
-func (s *DescribeInterconnectLoaInput) SetLoaContentType(v string) *DescribeInterconnectLoaInput {
-	s.LoaContentType = &v
+// SetConnectionId sets the ConnectionId field's value.
+func (s *DisassociateMacSecKeyInput) SetConnectionId(v string) *DisassociateMacSecKeyInput {
+	s.ConnectionId = &v
 	return s
 }
 
-// SetProviderName sets the ProviderName field's value.
-func (s *DescribeInterconnectLoaInput) SetProviderName(v string) *DescribeInterconnectLoaInput {
-	s.ProviderName = &v
+// SetSecretARN sets the SecretARN field's value.
+func (s *DisassociateMacSecKeyInput) SetSecretARN(v string) *DisassociateMacSecKeyInput {
+	s.SecretARN = &v
 	return s
 }
 
-type DescribeInterconnectLoaOutput struct {
+type DisassociateMacSecKeyOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The Letter of Authorization - Connecting Facility Assignment (LOA-CFA).
-	Loa *Loa `locationName:"loa" type:"structure"`
+	// The ID of the dedicated connection (dxcon-xxxx), or the ID of the LAG (dxlag-xxxx).
+	ConnectionId *string `locationName:"connectionId" type:"string"`
+
+	// The MAC Security (MACsec) security keys no longer associated with the dedicated
+	// connection.
+	MacSecKeys []*MacSecKey `locationName:"macSecKeys" type:"list"`
 }
 
-// String returns the string representation
-func (s DescribeInterconnectLoaOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisassociateMacSecKeyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeInterconnectLoaOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisassociateMacSecKeyOutput) GoString() string {
 	return s.String()
 }
 
-// SetLoa sets the Loa field's value.
-func (s *DescribeInterconnectLoaOutput) SetLoa(v *Loa) *DescribeInterconnectLoaOutput {
-	s.Loa = v
+// SetConnectionId sets the ConnectionId field's value.
+func (s *DisassociateMacSecKeyOutput) SetConnectionId(v string) *DisassociateMacSecKeyOutput {
+	s.ConnectionId = &v
 	return s
 }
 
-type DescribeInterconnectsInput struct {
-	_ struct{} `type:"structure"`
+// SetMacSecKeys sets the MacSecKeys field's value.
+func (s *DisassociateMacSecKeyOutput) SetMacSecKeys(v []*MacSecKey) *DisassociateMacSecKeyOutput {
+	s.MacSecKeys = v
+	return s
+}
 
-	// The ID of the interconnect.
-	InterconnectId *string `locationName:"interconnectId" type:"string"`
+// A tag key was specified more than once.
+type DuplicateTagKeysException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s DescribeInterconnectsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DuplicateTagKeysException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeInterconnectsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DuplicateTagKeysException) GoString() string {
 	return s.String()
 }
 
-// SetInterconnectId sets the InterconnectId field's value.
-func (s *DescribeInterconnectsInput) SetInterconnectId(v string) *DescribeInterconnectsInput {
-	s.InterconnectId = &v
-	return s
+func newErrorDuplicateTagKeysException(v protocol.ResponseMetadata) error {
+	return &DuplicateTagKeysException{
+		RespMetadata: v,
+	}
 }
 
-type DescribeInterconnectsOutput struct {
-	_ struct{} `type:"structure"`
+// Code returns the exception type name.
+func (s *DuplicateTagKeysException) Code() string {
+	return "DuplicateTagKeysException"
+}
 
-	// The interconnects.
-	Interconnects []*Interconnect `locationName:"interconnects" type:"list"`
+// Message returns the exception's message.
+func (s *DuplicateTagKeysException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// String returns the string representation
-func (s DescribeInterconnectsOutput) String() string {
-	return awsutil.Prettify(s)
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *DuplicateTagKeysException) OrigErr() error {
+	return nil
 }
 
-// GoString returns the string representation
-func (s DescribeInterconnectsOutput) GoString() string {
-	return s.String()
+func (s *DuplicateTagKeysException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// SetInterconnects sets the Interconnects field's value.
-func (s *DescribeInterconnectsOutput) SetInterconnects(v []*Interconnect) *DescribeInterconnectsOutput {
-	s.Interconnects = v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *DuplicateTagKeysException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-type DescribeLagsInput struct {
+// RequestID returns the service's response RequestID for request.
+func (s *DuplicateTagKeysException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Information about a Direct Connect gateway, which enables you to connect
+// virtual interfaces and virtual private gateway or transit gateways.
+type Gateway struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the LAG.
-	LagId *string `locationName:"lagId" type:"string"`
+	// The autonomous system number (ASN) for the Amazon side of the connection.
+	AmazonSideAsn *int64 `locationName:"amazonSideAsn" type:"long"`
+
+	// The ID of the Direct Connect gateway.
+	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
+
+	// The name of the Direct Connect gateway.
+	DirectConnectGatewayName *string `locationName:"directConnectGatewayName" type:"string"`
+
+	// The state of the Direct Connect gateway. The following are the possible values:
+	//
+	//    * pending: The initial state after calling CreateDirectConnectGateway.
+	//
+	//    * available: The Direct Connect gateway is ready for use.
+	//
+	//    * deleting: The initial state after calling DeleteDirectConnectGateway.
+	//
+	//    * deleted: The Direct Connect gateway is deleted and cannot pass traffic.
+	DirectConnectGatewayState *string `locationName:"directConnectGatewayState" type:"string" enum:"GatewayState"`
+
+	// The ID of the Amazon Web Services account that owns the Direct Connect gateway.
+	OwnerAccount *string `locationName:"ownerAccount" type:"string"`
+
+	// The error message if the state of an object failed to advance.
+	StateChangeError *string `locationName:"stateChangeError" type:"string"`
 }
 
-// String returns the string representation
-func (s DescribeLagsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Gateway) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeLagsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Gateway) GoString() string {
 	return s.String()
 }
 
-// SetLagId sets the LagId field's value.
-func (s *DescribeLagsInput) SetLagId(v string) *DescribeLagsInput {
-	s.LagId = &v
+// SetAmazonSideAsn sets the AmazonSideAsn field's value.
+func (s *Gateway) SetAmazonSideAsn(v int64) *Gateway {
+	s.AmazonSideAsn = &v
 	return s
 }
 
-type DescribeLagsOutput struct {
-	_ struct{} `type:"structure"`
+// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
+func (s *Gateway) SetDirectConnectGatewayId(v string) *Gateway {
+	s.DirectConnectGatewayId = &v
+	return s
+}
 
-	// The LAGs.
-	Lags []*Lag `locationName:"lags" type:"list"`
+// SetDirectConnectGatewayName sets the DirectConnectGatewayName field's value.
+func (s *Gateway) SetDirectConnectGatewayName(v string) *Gateway {
+	s.DirectConnectGatewayName = &v
+	return s
 }
 
-// String returns the string representation
-func (s DescribeLagsOutput) String() string {
-	return awsutil.Prettify(s)
+// SetDirectConnectGatewayState sets the DirectConnectGatewayState field's value.
+func (s *Gateway) SetDirectConnectGatewayState(v string) *Gateway {
+	s.DirectConnectGatewayState = &v
+	return s
+}
+
+// SetOwnerAccount sets the OwnerAccount field's value.
+func (s *Gateway) SetOwnerAccount(v string) *Gateway {
+	s.OwnerAccount = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s DescribeLagsOutput) GoString() string {
-	return s.String()
-}
+// SetStateChangeError sets the StateChangeError field's value.
+func (s *Gateway) SetStateChangeError(v string) *Gateway {
+	s.StateChangeError = &v
+	return s
+}
+
+// Information about an association between a Direct Connect gateway and a virtual
+// private gateway or transit gateway.
+type GatewayAssociation struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon VPC prefixes to advertise to the Direct Connect gateway.
+	AllowedPrefixesToDirectConnectGateway []*RouteFilterPrefix `locationName:"allowedPrefixesToDirectConnectGateway" type:"list"`
+
+	// Information about the associated gateway.
+	AssociatedGateway *AssociatedGateway `locationName:"associatedGateway" type:"structure"`
+
+	// The ID of the Direct Connect gateway association.
+	AssociationId *string `locationName:"associationId" type:"string"`
+
+	// The state of the association. The following are the possible values:
+	//
+	//    * associating: The initial state after calling CreateDirectConnectGatewayAssociation.
+	//
+	//    * associated: The Direct Connect gateway and virtual private gateway or
+	//    transit gateway are successfully associated and ready to pass traffic.
+	//
+	//    * disassociating: The initial state after calling DeleteDirectConnectGatewayAssociation.
+	//
+	//    * disassociated: The virtual private gateway or transit gateway is disassociated
+	//    from the Direct Connect gateway. Traffic flow between the Direct Connect
+	//    gateway and virtual private gateway or transit gateway is stopped.
+	//
+	//    * updating: The CIDR blocks for the virtual private gateway or transit
+	//    gateway are currently being updated. This could be new CIDR blocks added
+	//    or current CIDR blocks removed.
+	AssociationState *string `locationName:"associationState" type:"string" enum:"GatewayAssociationState"`
+
+	// The ID of the Direct Connect gateway.
+	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
 
-// SetLags sets the Lags field's value.
-func (s *DescribeLagsOutput) SetLags(v []*Lag) *DescribeLagsOutput {
-	s.Lags = v
-	return s
-}
+	// The ID of the Amazon Web Services account that owns the associated gateway.
+	DirectConnectGatewayOwnerAccount *string `locationName:"directConnectGatewayOwnerAccount" type:"string"`
 
-type DescribeLoaInput struct {
-	_ struct{} `type:"structure"`
+	// The error message if the state of an object failed to advance.
+	StateChangeError *string `locationName:"stateChangeError" type:"string"`
 
-	// The ID of a connection, LAG, or interconnect.
-	//
-	// ConnectionId is a required field
-	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
+	// The ID of the virtual private gateway. Applies only to private virtual interfaces.
+	VirtualGatewayId *string `locationName:"virtualGatewayId" type:"string"`
 
-	// The standard media type for the LOA-CFA document. The only supported value
-	// is application/pdf.
-	LoaContentType *string `locationName:"loaContentType" type:"string" enum:"LoaContentType"`
+	// The ID of the Amazon Web Services account that owns the virtual private gateway.
+	VirtualGatewayOwnerAccount *string `locationName:"virtualGatewayOwnerAccount" type:"string"`
 
-	// The name of the service provider who establishes connectivity on your behalf.
-	// If you specify this parameter, the LOA-CFA lists the provider name alongside
-	// your company name as the requester of the cross connect.
-	ProviderName *string `locationName:"providerName" type:"string"`
+	// The Amazon Web Services Region where the virtual private gateway is located.
+	VirtualGatewayRegion *string `locationName:"virtualGatewayRegion" deprecated:"true" type:"string"`
 }
 
-// String returns the string representation
-func (s DescribeLoaInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GatewayAssociation) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeLoaInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GatewayAssociation) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DescribeLoaInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DescribeLoaInput"}
-	if s.ConnectionId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
-// SetConnectionId sets the ConnectionId field's value.
-func (s *DescribeLoaInput) SetConnectionId(v string) *DescribeLoaInput {
-	s.ConnectionId = &v
+// SetAllowedPrefixesToDirectConnectGateway sets the AllowedPrefixesToDirectConnectGateway field's value.
+func (s *GatewayAssociation) SetAllowedPrefixesToDirectConnectGateway(v []*RouteFilterPrefix) *GatewayAssociation {
+	s.AllowedPrefixesToDirectConnectGateway = v
 	return s
 }
 
-// SetLoaContentType sets the LoaContentType field's value.
-func (s *DescribeLoaInput) SetLoaContentType(v string) *DescribeLoaInput {
-	s.LoaContentType = &v
+// SetAssociatedGateway sets the AssociatedGateway field's value.
+func (s *GatewayAssociation) SetAssociatedGateway(v *AssociatedGateway) *GatewayAssociation {
+	s.AssociatedGateway = v
 	return s
 }
 
-// SetProviderName sets the ProviderName field's value.
-func (s *DescribeLoaInput) SetProviderName(v string) *DescribeLoaInput {
-	s.ProviderName = &v
+// SetAssociationId sets the AssociationId field's value.
+func (s *GatewayAssociation) SetAssociationId(v string) *GatewayAssociation {
+	s.AssociationId = &v
 	return s
 }
 
-type DescribeLocationsInput struct {
-	_ struct{} `type:"structure"`
+// SetAssociationState sets the AssociationState field's value.
+func (s *GatewayAssociation) SetAssociationState(v string) *GatewayAssociation {
+	s.AssociationState = &v
+	return s
 }
 
-// String returns the string representation
-func (s DescribeLocationsInput) String() string {
-	return awsutil.Prettify(s)
+// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
+func (s *GatewayAssociation) SetDirectConnectGatewayId(v string) *GatewayAssociation {
+	s.DirectConnectGatewayId = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s DescribeLocationsInput) GoString() string {
-	return s.String()
+// SetDirectConnectGatewayOwnerAccount sets the DirectConnectGatewayOwnerAccount field's value.
+func (s *GatewayAssociation) SetDirectConnectGatewayOwnerAccount(v string) *GatewayAssociation {
+	s.DirectConnectGatewayOwnerAccount = &v
+	return s
 }
 
-type DescribeLocationsOutput struct {
-	_ struct{} `type:"structure"`
-
-	// The locations.
-	Locations []*Location `locationName:"locations" type:"list"`
+// SetStateChangeError sets the StateChangeError field's value.
+func (s *GatewayAssociation) SetStateChangeError(v string) *GatewayAssociation {
+	s.StateChangeError = &v
+	return s
 }
 
-// String returns the string representation
-func (s DescribeLocationsOutput) String() string {
-	return awsutil.Prettify(s)
+// SetVirtualGatewayId sets the VirtualGatewayId field's value.
+func (s *GatewayAssociation) SetVirtualGatewayId(v string) *GatewayAssociation {
+	s.VirtualGatewayId = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s DescribeLocationsOutput) GoString() string {
-	return s.String()
+// SetVirtualGatewayOwnerAccount sets the VirtualGatewayOwnerAccount field's value.
+func (s *GatewayAssociation) SetVirtualGatewayOwnerAccount(v string) *GatewayAssociation {
+	s.VirtualGatewayOwnerAccount = &v
+	return s
 }
 
-// SetLocations sets the Locations field's value.
-func (s *DescribeLocationsOutput) SetLocations(v []*Location) *DescribeLocationsOutput {
-	s.Locations = v
+// SetVirtualGatewayRegion sets the VirtualGatewayRegion field's value.
+func (s *GatewayAssociation) SetVirtualGatewayRegion(v string) *GatewayAssociation {
+	s.VirtualGatewayRegion = &v
 	return s
 }
 
-type DescribeTagsInput struct {
+// Information about the proposal request to attach a virtual private gateway
+// to a Direct Connect gateway.
+type GatewayAssociationProposal struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Names (ARNs) of the resources.
-	//
-	// ResourceArns is a required field
-	ResourceArns []*string `locationName:"resourceArns" type:"list" required:"true"`
-}
-
-// String returns the string representation
-func (s DescribeTagsInput) String() string {
-	return awsutil.Prettify(s)
-}
+	// Information about the associated gateway.
+	AssociatedGateway *AssociatedGateway `locationName:"associatedGateway" type:"structure"`
 
-// GoString returns the string representation
-func (s DescribeTagsInput) GoString() string {
-	return s.String()
-}
+	// The ID of the Direct Connect gateway.
+	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DescribeTagsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DescribeTagsInput"}
-	if s.ResourceArns == nil {
-		invalidParams.Add(request.NewErrParamRequired("ResourceArns"))
-	}
+	// The ID of the Amazon Web Services account that owns the Direct Connect gateway.
+	DirectConnectGatewayOwnerAccount *string `locationName:"directConnectGatewayOwnerAccount" type:"string"`
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
+	// The existing Amazon VPC prefixes advertised to the Direct Connect gateway.
+	ExistingAllowedPrefixesToDirectConnectGateway []*RouteFilterPrefix `locationName:"existingAllowedPrefixesToDirectConnectGateway" type:"list"`
 
-// SetResourceArns sets the ResourceArns field's value.
-func (s *DescribeTagsInput) SetResourceArns(v []*string) *DescribeTagsInput {
-	s.ResourceArns = v
-	return s
-}
+	// The ID of the association proposal.
+	ProposalId *string `locationName:"proposalId" type:"string"`
 
-type DescribeTagsOutput struct {
-	_ struct{} `type:"structure"`
+	// The state of the proposal. The following are possible values:
+	//
+	//    * accepted: The proposal has been accepted. The Direct Connect gateway
+	//    association is available to use in this state.
+	//
+	//    * deleted: The proposal has been deleted by the owner that made the proposal.
+	//    The Direct Connect gateway association cannot be used in this state.
+	//
+	//    * requested: The proposal has been requested. The Direct Connect gateway
+	//    association cannot be used in this state.
+	ProposalState *string `locationName:"proposalState" type:"string" enum:"GatewayAssociationProposalState"`
 
-	// Information about the tags.
-	ResourceTags []*ResourceTag `locationName:"resourceTags" type:"list"`
+	// The Amazon VPC prefixes to advertise to the Direct Connect gateway.
+	RequestedAllowedPrefixesToDirectConnectGateway []*RouteFilterPrefix `locationName:"requestedAllowedPrefixesToDirectConnectGateway" type:"list"`
 }
 
-// String returns the string representation
-func (s DescribeTagsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GatewayAssociationProposal) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeTagsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GatewayAssociationProposal) GoString() string {
 	return s.String()
 }
 
-// SetResourceTags sets the ResourceTags field's value.
-func (s *DescribeTagsOutput) SetResourceTags(v []*ResourceTag) *DescribeTagsOutput {
-	s.ResourceTags = v
+// SetAssociatedGateway sets the AssociatedGateway field's value.
+func (s *GatewayAssociationProposal) SetAssociatedGateway(v *AssociatedGateway) *GatewayAssociationProposal {
+	s.AssociatedGateway = v
 	return s
 }
 
-type DescribeVirtualGatewaysInput struct {
-	_ struct{} `type:"structure"`
-}
-
-// String returns the string representation
-func (s DescribeVirtualGatewaysInput) String() string {
-	return awsutil.Prettify(s)
+// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
+func (s *GatewayAssociationProposal) SetDirectConnectGatewayId(v string) *GatewayAssociationProposal {
+	s.DirectConnectGatewayId = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s DescribeVirtualGatewaysInput) GoString() string {
-	return s.String()
+// SetDirectConnectGatewayOwnerAccount sets the DirectConnectGatewayOwnerAccount field's value.
+func (s *GatewayAssociationProposal) SetDirectConnectGatewayOwnerAccount(v string) *GatewayAssociationProposal {
+	s.DirectConnectGatewayOwnerAccount = &v
+	return s
 }
 
-type DescribeVirtualGatewaysOutput struct {
-	_ struct{} `type:"structure"`
-
-	// The virtual private gateways.
-	VirtualGateways []*VirtualGateway `locationName:"virtualGateways" type:"list"`
+// SetExistingAllowedPrefixesToDirectConnectGateway sets the ExistingAllowedPrefixesToDirectConnectGateway field's value.
+func (s *GatewayAssociationProposal) SetExistingAllowedPrefixesToDirectConnectGateway(v []*RouteFilterPrefix) *GatewayAssociationProposal {
+	s.ExistingAllowedPrefixesToDirectConnectGateway = v
+	return s
 }
 
-// String returns the string representation
-func (s DescribeVirtualGatewaysOutput) String() string {
-	return awsutil.Prettify(s)
+// SetProposalId sets the ProposalId field's value.
+func (s *GatewayAssociationProposal) SetProposalId(v string) *GatewayAssociationProposal {
+	s.ProposalId = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s DescribeVirtualGatewaysOutput) GoString() string {
-	return s.String()
+// SetProposalState sets the ProposalState field's value.
+func (s *GatewayAssociationProposal) SetProposalState(v string) *GatewayAssociationProposal {
+	s.ProposalState = &v
+	return s
 }
 
-// SetVirtualGateways sets the VirtualGateways field's value.
-func (s *DescribeVirtualGatewaysOutput) SetVirtualGateways(v []*VirtualGateway) *DescribeVirtualGatewaysOutput {
-	s.VirtualGateways = v
+// SetRequestedAllowedPrefixesToDirectConnectGateway sets the RequestedAllowedPrefixesToDirectConnectGateway field's value.
+func (s *GatewayAssociationProposal) SetRequestedAllowedPrefixesToDirectConnectGateway(v []*RouteFilterPrefix) *GatewayAssociationProposal {
+	s.RequestedAllowedPrefixesToDirectConnectGateway = v
 	return s
 }
 
-type DescribeVirtualInterfacesInput struct {
+// Information about an attachment between a Direct Connect gateway and a virtual
+// interface.
+type GatewayAttachment struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the connection.
-	ConnectionId *string `locationName:"connectionId" type:"string"`
+	// The state of the attachment. The following are the possible values:
+	//
+	//    * attaching: The initial state after a virtual interface is created using
+	//    the Direct Connect gateway.
+	//
+	//    * attached: The Direct Connect gateway and virtual interface are attached
+	//    and ready to pass traffic.
+	//
+	//    * detaching: The initial state after calling DeleteVirtualInterface.
+	//
+	//    * detached: The virtual interface is detached from the Direct Connect
+	//    gateway. Traffic flow between the Direct Connect gateway and virtual interface
+	//    is stopped.
+	AttachmentState *string `locationName:"attachmentState" type:"string" enum:"GatewayAttachmentState"`
+
+	// The type of attachment.
+	AttachmentType *string `locationName:"attachmentType" type:"string" enum:"GatewayAttachmentType"`
+
+	// The ID of the Direct Connect gateway.
+	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
+
+	// The error message if the state of an object failed to advance.
+	StateChangeError *string `locationName:"stateChangeError" type:"string"`
 
 	// The ID of the virtual interface.
 	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string"`
+
+	// The ID of the Amazon Web Services account that owns the virtual interface.
+	VirtualInterfaceOwnerAccount *string `locationName:"virtualInterfaceOwnerAccount" type:"string"`
+
+	// The Amazon Web Services Region where the virtual interface is located.
+	VirtualInterfaceRegion *string `locationName:"virtualInterfaceRegion" type:"string"`
 }
 
-// String returns the string representation
-func (s DescribeVirtualInterfacesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GatewayAttachment) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeVirtualInterfacesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GatewayAttachment) GoString() string {
 	return s.String()
 }
 
-// SetConnectionId sets the ConnectionId field's value.
-func (s *DescribeVirtualInterfacesInput) SetConnectionId(v string) *DescribeVirtualInterfacesInput {
-	s.ConnectionId = &v
+// SetAttachmentState sets the AttachmentState field's value.
+func (s *GatewayAttachment) SetAttachmentState(v string) *GatewayAttachment {
+	s.AttachmentState = &v
 	return s
 }
 
-// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
-func (s *DescribeVirtualInterfacesInput) SetVirtualInterfaceId(v string) *DescribeVirtualInterfacesInput {
-	s.VirtualInterfaceId = &v
+// SetAttachmentType sets the AttachmentType field's value.
+func (s *GatewayAttachment) SetAttachmentType(v string) *GatewayAttachment {
+	s.AttachmentType = &v
 	return s
 }
 
-type DescribeVirtualInterfacesOutput struct {
-	_ struct{} `type:"structure"`
+// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
+func (s *GatewayAttachment) SetDirectConnectGatewayId(v string) *GatewayAttachment {
+	s.DirectConnectGatewayId = &v
+	return s
+}
 
-	// The virtual interfaces
-	VirtualInterfaces []*VirtualInterface `locationName:"virtualInterfaces" type:"list"`
+// SetStateChangeError sets the StateChangeError field's value.
+func (s *GatewayAttachment) SetStateChangeError(v string) *GatewayAttachment {
+	s.StateChangeError = &v
+	return s
 }
 
-// String returns the string representation
-func (s DescribeVirtualInterfacesOutput) String() string {
-	return awsutil.Prettify(s)
+// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
+func (s *GatewayAttachment) SetVirtualInterfaceId(v string) *GatewayAttachment {
+	s.VirtualInterfaceId = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s DescribeVirtualInterfacesOutput) GoString() string {
-	return s.String()
+// SetVirtualInterfaceOwnerAccount sets the VirtualInterfaceOwnerAccount field's value.
+func (s *GatewayAttachment) SetVirtualInterfaceOwnerAccount(v string) *GatewayAttachment {
+	s.VirtualInterfaceOwnerAccount = &v
+	return s
 }
 
-// SetVirtualInterfaces sets the VirtualInterfaces field's value.
-func (s *DescribeVirtualInterfacesOutput) SetVirtualInterfaces(v []*VirtualInterface) *DescribeVirtualInterfacesOutput {
-	s.VirtualInterfaces = v
+// SetVirtualInterfaceRegion sets the VirtualInterfaceRegion field's value.
+func (s *GatewayAttachment) SetVirtualInterfaceRegion(v string) *GatewayAttachment {
+	s.VirtualInterfaceRegion = &v
 	return s
 }
 
-type DisassociateConnectionFromLagInput struct {
+// Information about an interconnect.
+type Interconnect struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the connection.
+	// The Direct Connect endpoint on which the physical connection terminates.
+	AwsDevice *string `locationName:"awsDevice" deprecated:"true" type:"string"`
+
+	// The Direct Connect endpoint that terminates the physical connection.
+	AwsDeviceV2 *string `locationName:"awsDeviceV2" type:"string"`
+
+	// The Direct Connect endpoint that terminates the logical connection. This
+	// device might be different than the device that terminates the physical connection.
+	AwsLogicalDeviceId *string `locationName:"awsLogicalDeviceId" type:"string"`
+
+	// The bandwidth of the connection.
+	Bandwidth *string `locationName:"bandwidth" type:"string"`
+
+	// Indicates whether the interconnect supports a secondary BGP in the same address
+	// family (IPv4/IPv6).
+	HasLogicalRedundancy *string `locationName:"hasLogicalRedundancy" type:"string" enum:"HasLogicalRedundancy"`
+
+	// The ID of the interconnect.
+	InterconnectId *string `locationName:"interconnectId" type:"string"`
+
+	// The name of the interconnect.
+	InterconnectName *string `locationName:"interconnectName" type:"string"`
+
+	// The state of the interconnect. The following are the possible values:
 	//
-	// ConnectionId is a required field
-	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
+	//    * requested: The initial state of an interconnect. The interconnect stays
+	//    in the requested state until the Letter of Authorization (LOA) is sent
+	//    to the customer.
+	//
+	//    * pending: The interconnect is approved, and is being initialized.
+	//
+	//    * available: The network link is up, and the interconnect is ready for
+	//    use.
+	//
+	//    * down: The network link is down.
+	//
+	//    * deleting: The interconnect is being deleted.
+	//
+	//    * deleted: The interconnect is deleted.
+	//
+	//    * unknown: The state of the interconnect is not available.
+	InterconnectState *string `locationName:"interconnectState" type:"string" enum:"InterconnectState"`
+
+	// Indicates whether jumbo frames are supported.
+	JumboFrameCapable *bool `locationName:"jumboFrameCapable" type:"boolean"`
 
 	// The ID of the LAG.
-	//
-	// LagId is a required field
-	LagId *string `locationName:"lagId" type:"string" required:"true"`
+	LagId *string `locationName:"lagId" type:"string"`
+
+	// The time of the most recent call to DescribeLoa for this connection.
+	LoaIssueTime *time.Time `locationName:"loaIssueTime" type:"timestamp"`
+
+	// The location of the connection.
+	Location *string `locationName:"location" type:"string"`
+
+	// The name of the service provider associated with the interconnect.
+	ProviderName *string `locationName:"providerName" type:"string"`
+
+	// The Amazon Web Services Region where the connection is located.
+	Region *string `locationName:"region" type:"string"`
+
+	// The tags associated with the interconnect.
+	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
 }
 
-// String returns the string representation
-func (s DisassociateConnectionFromLagInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Interconnect) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DisassociateConnectionFromLagInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Interconnect) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DisassociateConnectionFromLagInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DisassociateConnectionFromLagInput"}
-	if s.ConnectionId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
-	}
-	if s.LagId == nil {
-		invalidParams.Add(request.NewErrParamRequired("LagId"))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetAwsDevice sets the AwsDevice field's value.
+func (s *Interconnect) SetAwsDevice(v string) *Interconnect {
+	s.AwsDevice = &v
+	return s
 }
 
-// SetConnectionId sets the ConnectionId field's value.
-func (s *DisassociateConnectionFromLagInput) SetConnectionId(v string) *DisassociateConnectionFromLagInput {
-	s.ConnectionId = &v
+// SetAwsDeviceV2 sets the AwsDeviceV2 field's value.
+func (s *Interconnect) SetAwsDeviceV2(v string) *Interconnect {
+	s.AwsDeviceV2 = &v
 	return s
 }
 
-// SetLagId sets the LagId field's value.
-func (s *DisassociateConnectionFromLagInput) SetLagId(v string) *DisassociateConnectionFromLagInput {
-	s.LagId = &v
+// SetAwsLogicalDeviceId sets the AwsLogicalDeviceId field's value.
+func (s *Interconnect) SetAwsLogicalDeviceId(v string) *Interconnect {
+	s.AwsLogicalDeviceId = &v
 	return s
 }
 
-// Information about a Direct Connect gateway, which enables you to connect
-// virtual interfaces and virtual private gateway or transit gateways.
-type Gateway struct {
-	_ struct{} `type:"structure"`
-
-	// The autonomous system number (ASN) for the Amazon side of the connection.
-	AmazonSideAsn *int64 `locationName:"amazonSideAsn" type:"long"`
+// SetBandwidth sets the Bandwidth field's value.
+func (s *Interconnect) SetBandwidth(v string) *Interconnect {
+	s.Bandwidth = &v
+	return s
+}
 
-	// The ID of the Direct Connect gateway.
-	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
+// SetHasLogicalRedundancy sets the HasLogicalRedundancy field's value.
+func (s *Interconnect) SetHasLogicalRedundancy(v string) *Interconnect {
+	s.HasLogicalRedundancy = &v
+	return s
+}
 
-	// The name of the Direct Connect gateway.
-	DirectConnectGatewayName *string `locationName:"directConnectGatewayName" type:"string"`
+// SetInterconnectId sets the InterconnectId field's value.
+func (s *Interconnect) SetInterconnectId(v string) *Interconnect {
+	s.InterconnectId = &v
+	return s
+}
 
-	// The state of the Direct Connect gateway. The following are the possible values:
-	//
-	//    * pending: The initial state after calling CreateDirectConnectGateway.
-	//
-	//    * available: The Direct Connect gateway is ready for use.
-	//
-	//    * deleting: The initial state after calling DeleteDirectConnectGateway.
-	//
-	//    * deleted: The Direct Connect gateway is deleted and cannot pass traffic.
-	DirectConnectGatewayState *string `locationName:"directConnectGatewayState" type:"string" enum:"GatewayState"`
+// SetInterconnectName sets the InterconnectName field's value.
+func (s *Interconnect) SetInterconnectName(v string) *Interconnect {
+	s.InterconnectName = &v
+	return s
+}
 
-	// The ID of the AWS account that owns the Direct Connect gateway.
-	OwnerAccount *string `locationName:"ownerAccount" type:"string"`
+// SetInterconnectState sets the InterconnectState field's value.
+func (s *Interconnect) SetInterconnectState(v string) *Interconnect {
+	s.InterconnectState = &v
+	return s
+}
 
-	// The error message if the state of an object failed to advance.
-	StateChangeError *string `locationName:"stateChangeError" type:"string"`
+// SetJumboFrameCapable sets the JumboFrameCapable field's value.
+func (s *Interconnect) SetJumboFrameCapable(v bool) *Interconnect {
+	s.JumboFrameCapable = &v
+	return s
 }
 
-// String returns the string representation
-func (s Gateway) String() string {
-	return awsutil.Prettify(s)
+// SetLagId sets the LagId field's value.
+func (s *Interconnect) SetLagId(v string) *Interconnect {
+	s.LagId = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s Gateway) GoString() string {
-	return s.String()
+// SetLoaIssueTime sets the LoaIssueTime field's value.
+func (s *Interconnect) SetLoaIssueTime(v time.Time) *Interconnect {
+	s.LoaIssueTime = &v
+	return s
 }
 
-// SetAmazonSideAsn sets the AmazonSideAsn field's value.
-func (s *Gateway) SetAmazonSideAsn(v int64) *Gateway {
-	s.AmazonSideAsn = &v
+// SetLocation sets the Location field's value.
+func (s *Interconnect) SetLocation(v string) *Interconnect {
+	s.Location = &v
 	return s
 }
 
-// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
-func (s *Gateway) SetDirectConnectGatewayId(v string) *Gateway {
-	s.DirectConnectGatewayId = &v
+// SetProviderName sets the ProviderName field's value.
+func (s *Interconnect) SetProviderName(v string) *Interconnect {
+	s.ProviderName = &v
 	return s
 }
 
-// SetDirectConnectGatewayName sets the DirectConnectGatewayName field's value.
-func (s *Gateway) SetDirectConnectGatewayName(v string) *Gateway {
-	s.DirectConnectGatewayName = &v
+// SetRegion sets the Region field's value.
+func (s *Interconnect) SetRegion(v string) *Interconnect {
+	s.Region = &v
 	return s
 }
 
-// SetDirectConnectGatewayState sets the DirectConnectGatewayState field's value.
-func (s *Gateway) SetDirectConnectGatewayState(v string) *Gateway {
-	s.DirectConnectGatewayState = &v
+// SetTags sets the Tags field's value.
+func (s *Interconnect) SetTags(v []*Tag) *Interconnect {
+	s.Tags = v
 	return s
 }
 
-// SetOwnerAccount sets the OwnerAccount field's value.
-func (s *Gateway) SetOwnerAccount(v string) *Gateway {
-	s.OwnerAccount = &v
-	return s
-}
+// Information about a link aggregation group (LAG).
+type Lag struct {
+	_ struct{} `type:"structure"`
+
+	// Indicates whether the LAG can host other connections.
+	AllowsHostedConnections *bool `locationName:"allowsHostedConnections" type:"boolean"`
+
+	// The Direct Connect endpoint that hosts the LAG.
+	AwsDevice *string `locationName:"awsDevice" deprecated:"true" type:"string"`
+
+	// The Direct Connect endpoint that hosts the LAG.
+	AwsDeviceV2 *string `locationName:"awsDeviceV2" type:"string"`
+
+	// The Direct Connect endpoint that terminates the logical connection. This
+	// device might be different than the device that terminates the physical connection.
+	AwsLogicalDeviceId *string `locationName:"awsLogicalDeviceId" type:"string"`
+
+	// The connections bundled by the LAG.
+	Connections []*Connection `locationName:"connections" type:"list"`
+
+	// The individual bandwidth of the physical connections bundled by the LAG.
+	// The possible values are 1Gbps and 10Gbps.
+	ConnectionsBandwidth *string `locationName:"connectionsBandwidth" type:"string"`
 
-// SetStateChangeError sets the StateChangeError field's value.
-func (s *Gateway) SetStateChangeError(v string) *Gateway {
-	s.StateChangeError = &v
-	return s
-}
+	// The LAG MAC Security (MACsec) encryption mode.
+	//
+	// The valid values are no_encrypt, should_encrypt, and must_encrypt.
+	EncryptionMode *string `locationName:"encryptionMode" type:"string"`
 
-// Information about an association between a Direct Connect gateway and a virtual
-// private gateway or transit gateway.
-type GatewayAssociation struct {
-	_ struct{} `type:"structure"`
+	// Indicates whether the LAG supports a secondary BGP peer in the same address
+	// family (IPv4/IPv6).
+	HasLogicalRedundancy *string `locationName:"hasLogicalRedundancy" type:"string" enum:"HasLogicalRedundancy"`
 
-	// The Amazon VPC prefixes to advertise to the Direct Connect gateway.
-	AllowedPrefixesToDirectConnectGateway []*RouteFilterPrefix `locationName:"allowedPrefixesToDirectConnectGateway" type:"list"`
+	// Indicates whether jumbo frames are supported.
+	JumboFrameCapable *bool `locationName:"jumboFrameCapable" type:"boolean"`
 
-	// Information about the associated gateway.
-	AssociatedGateway *AssociatedGateway `locationName:"associatedGateway" type:"structure"`
+	// The ID of the LAG.
+	LagId *string `locationName:"lagId" type:"string"`
 
-	// The ID of the Direct Connect gateway association.
-	AssociationId *string `locationName:"associationId" type:"string"`
+	// The name of the LAG.
+	LagName *string `locationName:"lagName" type:"string"`
 
-	// The state of the association. The following are the possible values:
+	// The state of the LAG. The following are the possible values:
 	//
-	//    * associating: The initial state after calling CreateDirectConnectGatewayAssociation.
+	//    * requested: The initial state of a LAG. The LAG stays in the requested
+	//    state until the Letter of Authorization (LOA) is available.
 	//
-	//    * associated: The Direct Connect gateway and virtual private gateway or
-	//    transit gateway are successfully associated and ready to pass traffic.
+	//    * pending: The LAG has been approved and is being initialized.
 	//
-	//    * disassociating: The initial state after calling DeleteDirectConnectGatewayAssociation.
+	//    * available: The network link is established and the LAG is ready for
+	//    use.
 	//
-	//    * disassociated: The virtual private gateway or transit gateway is disassociated
-	//    from the Direct Connect gateway. Traffic flow between the Direct Connect
-	//    gateway and virtual private gateway or transit gateway is stopped.
-	AssociationState *string `locationName:"associationState" type:"string" enum:"GatewayAssociationState"`
+	//    * down: The network link is down.
+	//
+	//    * deleting: The LAG is being deleted.
+	//
+	//    * deleted: The LAG is deleted.
+	//
+	//    * unknown: The state of the LAG is not available.
+	LagState *string `locationName:"lagState" type:"string" enum:"LagState"`
 
-	// The ID of the Direct Connect gateway.
-	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
+	// The location of the LAG.
+	Location *string `locationName:"location" type:"string"`
 
-	// The ID of the AWS account that owns the associated gateway.
-	DirectConnectGatewayOwnerAccount *string `locationName:"directConnectGatewayOwnerAccount" type:"string"`
+	// Indicates whether the LAG supports MAC Security (MACsec).
+	MacSecCapable *bool `locationName:"macSecCapable" type:"boolean"`
 
-	// The error message if the state of an object failed to advance.
-	StateChangeError *string `locationName:"stateChangeError" type:"string"`
+	// The MAC Security (MACsec) security keys associated with the LAG.
+	MacSecKeys []*MacSecKey `locationName:"macSecKeys" type:"list"`
 
-	// The ID of the virtual private gateway. Applies only to private virtual interfaces.
-	VirtualGatewayId *string `locationName:"virtualGatewayId" deprecated:"true" type:"string"`
+	// The minimum number of physical dedicated connections that must be operational
+	// for the LAG itself to be operational.
+	MinimumLinks *int64 `locationName:"minimumLinks" type:"integer"`
 
-	// The ID of the AWS account that owns the virtual private gateway.
-	VirtualGatewayOwnerAccount *string `locationName:"virtualGatewayOwnerAccount" type:"string"`
+	// The number of physical dedicated connections bundled by the LAG, up to a
+	// maximum of 10.
+	NumberOfConnections *int64 `locationName:"numberOfConnections" type:"integer"`
 
-	// The AWS Region where the virtual private gateway is located.
-	VirtualGatewayRegion *string `locationName:"virtualGatewayRegion" deprecated:"true" type:"string"`
+	// The ID of the Amazon Web Services account that owns the LAG.
+	OwnerAccount *string `locationName:"ownerAccount" type:"string"`
+
+	// The name of the service provider associated with the LAG.
+	ProviderName *string `locationName:"providerName" type:"string"`
+
+	// The Amazon Web Services Region where the connection is located.
+	Region *string `locationName:"region" type:"string"`
+
+	// The tags associated with the LAG.
+	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
 }
 
-// String returns the string representation
-func (s GatewayAssociation) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Lag) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GatewayAssociation) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Lag) GoString() string {
 	return s.String()
 }
 
-// SetAllowedPrefixesToDirectConnectGateway sets the AllowedPrefixesToDirectConnectGateway field's value.
-func (s *GatewayAssociation) SetAllowedPrefixesToDirectConnectGateway(v []*RouteFilterPrefix) *GatewayAssociation {
-	s.AllowedPrefixesToDirectConnectGateway = v
+// SetAllowsHostedConnections sets the AllowsHostedConnections field's value.
+func (s *Lag) SetAllowsHostedConnections(v bool) *Lag {
+	s.AllowsHostedConnections = &v
 	return s
 }
 
-// SetAssociatedGateway sets the AssociatedGateway field's value.
-func (s *GatewayAssociation) SetAssociatedGateway(v *AssociatedGateway) *GatewayAssociation {
-	s.AssociatedGateway = v
+// SetAwsDevice sets the AwsDevice field's value.
+func (s *Lag) SetAwsDevice(v string) *Lag {
+	s.AwsDevice = &v
 	return s
 }
 
-// SetAssociationId sets the AssociationId field's value.
-func (s *GatewayAssociation) SetAssociationId(v string) *GatewayAssociation {
-	s.AssociationId = &v
+// SetAwsDeviceV2 sets the AwsDeviceV2 field's value.
+func (s *Lag) SetAwsDeviceV2(v string) *Lag {
+	s.AwsDeviceV2 = &v
 	return s
 }
 
-// SetAssociationState sets the AssociationState field's value.
-func (s *GatewayAssociation) SetAssociationState(v string) *GatewayAssociation {
-	s.AssociationState = &v
+// SetAwsLogicalDeviceId sets the AwsLogicalDeviceId field's value.
+func (s *Lag) SetAwsLogicalDeviceId(v string) *Lag {
+	s.AwsLogicalDeviceId = &v
 	return s
 }
 
-// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
-func (s *GatewayAssociation) SetDirectConnectGatewayId(v string) *GatewayAssociation {
-	s.DirectConnectGatewayId = &v
+// SetConnections sets the Connections field's value.
+func (s *Lag) SetConnections(v []*Connection) *Lag {
+	s.Connections = v
 	return s
 }
 
-// SetDirectConnectGatewayOwnerAccount sets the DirectConnectGatewayOwnerAccount field's value.
-func (s *GatewayAssociation) SetDirectConnectGatewayOwnerAccount(v string) *GatewayAssociation {
-	s.DirectConnectGatewayOwnerAccount = &v
+// SetConnectionsBandwidth sets the ConnectionsBandwidth field's value.
+func (s *Lag) SetConnectionsBandwidth(v string) *Lag {
+	s.ConnectionsBandwidth = &v
 	return s
 }
 
-// SetStateChangeError sets the StateChangeError field's value.
-func (s *GatewayAssociation) SetStateChangeError(v string) *GatewayAssociation {
-	s.StateChangeError = &v
+// SetEncryptionMode sets the EncryptionMode field's value.
+func (s *Lag) SetEncryptionMode(v string) *Lag {
+	s.EncryptionMode = &v
 	return s
 }
 
-// SetVirtualGatewayId sets the VirtualGatewayId field's value.
-func (s *GatewayAssociation) SetVirtualGatewayId(v string) *GatewayAssociation {
-	s.VirtualGatewayId = &v
+// SetHasLogicalRedundancy sets the HasLogicalRedundancy field's value.
+func (s *Lag) SetHasLogicalRedundancy(v string) *Lag {
+	s.HasLogicalRedundancy = &v
 	return s
 }
 
-// SetVirtualGatewayOwnerAccount sets the VirtualGatewayOwnerAccount field's value.
-func (s *GatewayAssociation) SetVirtualGatewayOwnerAccount(v string) *GatewayAssociation {
-	s.VirtualGatewayOwnerAccount = &v
+// SetJumboFrameCapable sets the JumboFrameCapable field's value.
+func (s *Lag) SetJumboFrameCapable(v bool) *Lag {
+	s.JumboFrameCapable = &v
 	return s
 }
 
-// SetVirtualGatewayRegion sets the VirtualGatewayRegion field's value.
-func (s *GatewayAssociation) SetVirtualGatewayRegion(v string) *GatewayAssociation {
-	s.VirtualGatewayRegion = &v
+// SetLagId sets the LagId field's value.
+func (s *Lag) SetLagId(v string) *Lag {
+	s.LagId = &v
 	return s
 }
 
-// Information about the proposal request to attach a virtual private gateway
-// to a Direct Connect gateway.
-type GatewayAssociationProposal struct {
-	_ struct{} `type:"structure"`
-
-	// Information about the associated gateway.
-	AssociatedGateway *AssociatedGateway `locationName:"associatedGateway" type:"structure"`
-
-	// The ID of the Direct Connect gateway.
-	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
-
-	// The ID of the AWS account that owns the Direct Connect gateway.
-	DirectConnectGatewayOwnerAccount *string `locationName:"directConnectGatewayOwnerAccount" type:"string"`
-
-	// The existing Amazon VPC prefixes advertised to the Direct Connect gateway.
-	ExistingAllowedPrefixesToDirectConnectGateway []*RouteFilterPrefix `locationName:"existingAllowedPrefixesToDirectConnectGateway" type:"list"`
-
-	// The ID of the association proposal.
-	ProposalId *string `locationName:"proposalId" type:"string"`
-
-	// The state of the proposal. The following are possible values:
-	//
-	//    * accepted: The proposal has been accepted. The Direct Connect gateway
-	//    association is available to use in this state.
-	//
-	//    * deleted: The proposal has been deleted by the owner that made the proposal.
-	//    The Direct Connect gateway association cannot be used in this state.
-	//
-	//    * requested: The proposal has been requested. The Direct Connect gateway
-	//    association cannot be used in this state.
-	ProposalState *string `locationName:"proposalState" type:"string" enum:"GatewayAssociationProposalState"`
+// SetLagName sets the LagName field's value.
+func (s *Lag) SetLagName(v string) *Lag {
+	s.LagName = &v
+	return s
+}
 
-	// The Amazon VPC prefixes to advertise to the Direct Connect gateway.
-	RequestedAllowedPrefixesToDirectConnectGateway []*RouteFilterPrefix `locationName:"requestedAllowedPrefixesToDirectConnectGateway" type:"list"`
+// SetLagState sets the LagState field's value.
+func (s *Lag) SetLagState(v string) *Lag {
+	s.LagState = &v
+	return s
 }
 
-// String returns the string representation
-func (s GatewayAssociationProposal) String() string {
-	return awsutil.Prettify(s)
+// SetLocation sets the Location field's value.
+func (s *Lag) SetLocation(v string) *Lag {
+	s.Location = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s GatewayAssociationProposal) GoString() string {
-	return s.String()
+// SetMacSecCapable sets the MacSecCapable field's value.
+func (s *Lag) SetMacSecCapable(v bool) *Lag {
+	s.MacSecCapable = &v
+	return s
 }
 
-// SetAssociatedGateway sets the AssociatedGateway field's value.
-func (s *GatewayAssociationProposal) SetAssociatedGateway(v *AssociatedGateway) *GatewayAssociationProposal {
-	s.AssociatedGateway = v
+// SetMacSecKeys sets the MacSecKeys field's value.
+func (s *Lag) SetMacSecKeys(v []*MacSecKey) *Lag {
+	s.MacSecKeys = v
 	return s
 }
 
-// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
-func (s *GatewayAssociationProposal) SetDirectConnectGatewayId(v string) *GatewayAssociationProposal {
-	s.DirectConnectGatewayId = &v
+// SetMinimumLinks sets the MinimumLinks field's value.
+func (s *Lag) SetMinimumLinks(v int64) *Lag {
+	s.MinimumLinks = &v
 	return s
 }
 
-// SetDirectConnectGatewayOwnerAccount sets the DirectConnectGatewayOwnerAccount field's value.
-func (s *GatewayAssociationProposal) SetDirectConnectGatewayOwnerAccount(v string) *GatewayAssociationProposal {
-	s.DirectConnectGatewayOwnerAccount = &v
+// SetNumberOfConnections sets the NumberOfConnections field's value.
+func (s *Lag) SetNumberOfConnections(v int64) *Lag {
+	s.NumberOfConnections = &v
 	return s
 }
 
-// SetExistingAllowedPrefixesToDirectConnectGateway sets the ExistingAllowedPrefixesToDirectConnectGateway field's value.
-func (s *GatewayAssociationProposal) SetExistingAllowedPrefixesToDirectConnectGateway(v []*RouteFilterPrefix) *GatewayAssociationProposal {
-	s.ExistingAllowedPrefixesToDirectConnectGateway = v
+// SetOwnerAccount sets the OwnerAccount field's value.
+func (s *Lag) SetOwnerAccount(v string) *Lag {
+	s.OwnerAccount = &v
 	return s
 }
 
-// SetProposalId sets the ProposalId field's value.
-func (s *GatewayAssociationProposal) SetProposalId(v string) *GatewayAssociationProposal {
-	s.ProposalId = &v
+// SetProviderName sets the ProviderName field's value.
+func (s *Lag) SetProviderName(v string) *Lag {
+	s.ProviderName = &v
 	return s
 }
 
-// SetProposalState sets the ProposalState field's value.
-func (s *GatewayAssociationProposal) SetProposalState(v string) *GatewayAssociationProposal {
-	s.ProposalState = &v
+// SetRegion sets the Region field's value.
+func (s *Lag) SetRegion(v string) *Lag {
+	s.Region = &v
 	return s
 }
 
-// SetRequestedAllowedPrefixesToDirectConnectGateway sets the RequestedAllowedPrefixesToDirectConnectGateway field's value.
-func (s *GatewayAssociationProposal) SetRequestedAllowedPrefixesToDirectConnectGateway(v []*RouteFilterPrefix) *GatewayAssociationProposal {
-	s.RequestedAllowedPrefixesToDirectConnectGateway = v
+// SetTags sets the Tags field's value.
+func (s *Lag) SetTags(v []*Tag) *Lag {
+	s.Tags = v
 	return s
 }
 
-// Information about an attachment between a Direct Connect gateway and a virtual
-// interface.
-type GatewayAttachment struct {
+type ListVirtualInterfaceTestHistoryInput struct {
 	_ struct{} `type:"structure"`
 
-	// The state of the attachment. The following are the possible values:
-	//
-	//    * attaching: The initial state after a virtual interface is created using
-	//    the Direct Connect gateway.
-	//
-	//    * attached: The Direct Connect gateway and virtual interface are attached
-	//    and ready to pass traffic.
-	//
-	//    * detaching: The initial state after calling DeleteVirtualInterface.
-	//
-	//    * detached: The virtual interface is detached from the Direct Connect
-	//    gateway. Traffic flow between the Direct Connect gateway and virtual interface
-	//    is stopped.
-	AttachmentState *string `locationName:"attachmentState" type:"string" enum:"GatewayAttachmentState"`
-
-	// The interface type.
-	AttachmentType *string `locationName:"attachmentType" type:"string" enum:"GatewayAttachmentType"`
-
-	// The ID of the Direct Connect gateway.
-	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
+	// The BGP peers that were placed in the DOWN state during the virtual interface
+	// failover test.
+	BgpPeers []*string `locationName:"bgpPeers" type:"list"`
 
-	// The error message if the state of an object failed to advance.
-	StateChangeError *string `locationName:"stateChangeError" type:"string"`
+	// The maximum number of results to return with a single call. To retrieve the
+	// remaining results, make another call with the returned nextToken value.
+	//
+	// If MaxResults is given a value larger than 100, only 100 results are returned.
+	MaxResults *int64 `locationName:"maxResults" type:"integer"`
 
-	// The ID of the virtual interface.
-	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string"`
+	// The token for the next page of results.
+	NextToken *string `locationName:"nextToken" type:"string"`
 
-	// The ID of the AWS account that owns the virtual interface.
-	VirtualInterfaceOwnerAccount *string `locationName:"virtualInterfaceOwnerAccount" type:"string"`
+	// The status of the virtual interface failover test.
+	Status *string `locationName:"status" type:"string"`
 
-	// The AWS Region where the virtual interface is located.
-	VirtualInterfaceRegion *string `locationName:"virtualInterfaceRegion" type:"string"`
+	// The ID of the virtual interface failover test.
+	TestId *string `locationName:"testId" type:"string"`
+
+	// The ID of the virtual interface that was tested.
+	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string"`
 }
 
-// String returns the string representation
-func (s GatewayAttachment) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListVirtualInterfaceTestHistoryInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GatewayAttachment) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListVirtualInterfaceTestHistoryInput) GoString() string {
 	return s.String()
 }
 
-// SetAttachmentState sets the AttachmentState field's value.
-func (s *GatewayAttachment) SetAttachmentState(v string) *GatewayAttachment {
-	s.AttachmentState = &v
-	return s
-}
-
-// SetAttachmentType sets the AttachmentType field's value.
-func (s *GatewayAttachment) SetAttachmentType(v string) *GatewayAttachment {
-	s.AttachmentType = &v
+// SetBgpPeers sets the BgpPeers field's value.
+func (s *ListVirtualInterfaceTestHistoryInput) SetBgpPeers(v []*string) *ListVirtualInterfaceTestHistoryInput {
+	s.BgpPeers = v
 	return s
 }
 
-// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
-func (s *GatewayAttachment) SetDirectConnectGatewayId(v string) *GatewayAttachment {
-	s.DirectConnectGatewayId = &v
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListVirtualInterfaceTestHistoryInput) SetMaxResults(v int64) *ListVirtualInterfaceTestHistoryInput {
+	s.MaxResults = &v
 	return s
 }
 
-// SetStateChangeError sets the StateChangeError field's value.
-func (s *GatewayAttachment) SetStateChangeError(v string) *GatewayAttachment {
-	s.StateChangeError = &v
+// SetNextToken sets the NextToken field's value.
+func (s *ListVirtualInterfaceTestHistoryInput) SetNextToken(v string) *ListVirtualInterfaceTestHistoryInput {
+	s.NextToken = &v
 	return s
 }
 
-// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
-func (s *GatewayAttachment) SetVirtualInterfaceId(v string) *GatewayAttachment {
-	s.VirtualInterfaceId = &v
+// SetStatus sets the Status field's value.
+func (s *ListVirtualInterfaceTestHistoryInput) SetStatus(v string) *ListVirtualInterfaceTestHistoryInput {
+	s.Status = &v
 	return s
 }
 
-// SetVirtualInterfaceOwnerAccount sets the VirtualInterfaceOwnerAccount field's value.
-func (s *GatewayAttachment) SetVirtualInterfaceOwnerAccount(v string) *GatewayAttachment {
-	s.VirtualInterfaceOwnerAccount = &v
+// SetTestId sets the TestId field's value.
+func (s *ListVirtualInterfaceTestHistoryInput) SetTestId(v string) *ListVirtualInterfaceTestHistoryInput {
+	s.TestId = &v
 	return s
 }
 
-// SetVirtualInterfaceRegion sets the VirtualInterfaceRegion field's value.
-func (s *GatewayAttachment) SetVirtualInterfaceRegion(v string) *GatewayAttachment {
-	s.VirtualInterfaceRegion = &v
+// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
+func (s *ListVirtualInterfaceTestHistoryInput) SetVirtualInterfaceId(v string) *ListVirtualInterfaceTestHistoryInput {
+	s.VirtualInterfaceId = &v
 	return s
 }
 
-// Information about an interconnect.
-type Interconnect struct {
+type ListVirtualInterfaceTestHistoryOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The Direct Connect endpoint on which the physical connection terminates.
-	AwsDevice *string `locationName:"awsDevice" deprecated:"true" type:"string"`
-
-	// The Direct Connect endpoint on which the physical connection terminates.
-	AwsDeviceV2 *string `locationName:"awsDeviceV2" type:"string"`
-
-	// The bandwidth of the connection.
-	Bandwidth *string `locationName:"bandwidth" type:"string"`
-
-	// Indicates whether the interconnect supports a secondary BGP in the same address
-	// family (IPv4/IPv6).
-	HasLogicalRedundancy *string `locationName:"hasLogicalRedundancy" type:"string" enum:"HasLogicalRedundancy"`
-
-	// The ID of the interconnect.
-	InterconnectId *string `locationName:"interconnectId" type:"string"`
+	// The token to use to retrieve the next page of results. This value is null
+	// when there are no more results to return.
+	NextToken *string `locationName:"nextToken" type:"string"`
 
-	// The name of the interconnect.
-	InterconnectName *string `locationName:"interconnectName" type:"string"`
+	// The ID of the tested virtual interface.
+	VirtualInterfaceTestHistory []*VirtualInterfaceTestHistory `locationName:"virtualInterfaceTestHistory" type:"list"`
+}
 
-	// The state of the interconnect. The following are the possible values:
-	//
-	//    * requested: The initial state of an interconnect. The interconnect stays
-	//    in the requested state until the Letter of Authorization (LOA) is sent
-	//    to the customer.
-	//
-	//    * pending: The interconnect is approved, and is being initialized.
-	//
-	//    * available: The network link is up, and the interconnect is ready for
-	//    use.
-	//
-	//    * down: The network link is down.
-	//
-	//    * deleting: The interconnect is being deleted.
-	//
-	//    * deleted: The interconnect is deleted.
-	//
-	//    * unknown: The state of the interconnect is not available.
-	InterconnectState *string `locationName:"interconnectState" type:"string" enum:"InterconnectState"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListVirtualInterfaceTestHistoryOutput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// Indicates whether jumbo frames (9001 MTU) are supported.
-	JumboFrameCapable *bool `locationName:"jumboFrameCapable" type:"boolean"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListVirtualInterfaceTestHistoryOutput) GoString() string {
+	return s.String()
+}
 
-	// The ID of the LAG.
-	LagId *string `locationName:"lagId" type:"string"`
+// SetNextToken sets the NextToken field's value.
+func (s *ListVirtualInterfaceTestHistoryOutput) SetNextToken(v string) *ListVirtualInterfaceTestHistoryOutput {
+	s.NextToken = &v
+	return s
+}
 
-	// The time of the most recent call to DescribeLoa for this connection.
-	LoaIssueTime *time.Time `locationName:"loaIssueTime" type:"timestamp"`
+// SetVirtualInterfaceTestHistory sets the VirtualInterfaceTestHistory field's value.
+func (s *ListVirtualInterfaceTestHistoryOutput) SetVirtualInterfaceTestHistory(v []*VirtualInterfaceTestHistory) *ListVirtualInterfaceTestHistoryOutput {
+	s.VirtualInterfaceTestHistory = v
+	return s
+}
 
-	// The location of the connection.
-	Location *string `locationName:"location" type:"string"`
+// Information about a Letter of Authorization - Connecting Facility Assignment
+// (LOA-CFA) for a connection.
+type Loa struct {
+	_ struct{} `type:"structure"`
 
-	// The AWS Region where the connection is located.
-	Region *string `locationName:"region" type:"string"`
+	// The binary contents of the LOA-CFA document.
+	// LoaContent is automatically base64 encoded/decoded by the SDK.
+	LoaContent []byte `locationName:"loaContent" type:"blob"`
 
-	// Any tags assigned to the interconnect.
-	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
+	// The standard media type for the LOA-CFA document. The only supported value
+	// is application/pdf.
+	LoaContentType *string `locationName:"loaContentType" type:"string" enum:"LoaContentType"`
 }
 
-// String returns the string representation
-func (s Interconnect) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Loa) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Interconnect) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Loa) GoString() string {
 	return s.String()
 }
 
-// SetAwsDevice sets the AwsDevice field's value.
-func (s *Interconnect) SetAwsDevice(v string) *Interconnect {
-	s.AwsDevice = &v
+// SetLoaContent sets the LoaContent field's value.
+func (s *Loa) SetLoaContent(v []byte) *Loa {
+	s.LoaContent = v
 	return s
 }
 
-// SetAwsDeviceV2 sets the AwsDeviceV2 field's value.
-func (s *Interconnect) SetAwsDeviceV2(v string) *Interconnect {
-	s.AwsDeviceV2 = &v
+// SetLoaContentType sets the LoaContentType field's value.
+func (s *Loa) SetLoaContentType(v string) *Loa {
+	s.LoaContentType = &v
 	return s
 }
 
-// SetBandwidth sets the Bandwidth field's value.
-func (s *Interconnect) SetBandwidth(v string) *Interconnect {
-	s.Bandwidth = &v
-	return s
-}
+// Information about an Direct Connect location.
+type Location struct {
+	_ struct{} `type:"structure"`
 
-// SetHasLogicalRedundancy sets the HasLogicalRedundancy field's value.
-func (s *Interconnect) SetHasLogicalRedundancy(v string) *Interconnect {
-	s.HasLogicalRedundancy = &v
-	return s
+	// The available MAC Security (MACsec) port speeds for the location.
+	AvailableMacSecPortSpeeds []*string `locationName:"availableMacSecPortSpeeds" type:"list"`
+
+	// The available port speeds for the location.
+	AvailablePortSpeeds []*string `locationName:"availablePortSpeeds" type:"list"`
+
+	// The name of the service provider for the location.
+	AvailableProviders []*string `locationName:"availableProviders" type:"list"`
+
+	// The code for the location.
+	LocationCode *string `locationName:"locationCode" type:"string"`
+
+	// The name of the location. This includes the name of the colocation partner
+	// and the physical site of the building.
+	LocationName *string `locationName:"locationName" type:"string"`
+
+	// The Amazon Web Services Region for the location.
+	Region *string `locationName:"region" type:"string"`
 }
 
-// SetInterconnectId sets the InterconnectId field's value.
-func (s *Interconnect) SetInterconnectId(v string) *Interconnect {
-	s.InterconnectId = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Location) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetInterconnectName sets the InterconnectName field's value.
-func (s *Interconnect) SetInterconnectName(v string) *Interconnect {
-	s.InterconnectName = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Location) GoString() string {
+	return s.String()
 }
 
-// SetInterconnectState sets the InterconnectState field's value.
-func (s *Interconnect) SetInterconnectState(v string) *Interconnect {
-	s.InterconnectState = &v
+// SetAvailableMacSecPortSpeeds sets the AvailableMacSecPortSpeeds field's value.
+func (s *Location) SetAvailableMacSecPortSpeeds(v []*string) *Location {
+	s.AvailableMacSecPortSpeeds = v
 	return s
 }
 
-// SetJumboFrameCapable sets the JumboFrameCapable field's value.
-func (s *Interconnect) SetJumboFrameCapable(v bool) *Interconnect {
-	s.JumboFrameCapable = &v
+// SetAvailablePortSpeeds sets the AvailablePortSpeeds field's value.
+func (s *Location) SetAvailablePortSpeeds(v []*string) *Location {
+	s.AvailablePortSpeeds = v
 	return s
 }
 
-// SetLagId sets the LagId field's value.
-func (s *Interconnect) SetLagId(v string) *Interconnect {
-	s.LagId = &v
+// SetAvailableProviders sets the AvailableProviders field's value.
+func (s *Location) SetAvailableProviders(v []*string) *Location {
+	s.AvailableProviders = v
 	return s
 }
 
-// SetLoaIssueTime sets the LoaIssueTime field's value.
-func (s *Interconnect) SetLoaIssueTime(v time.Time) *Interconnect {
-	s.LoaIssueTime = &v
+// SetLocationCode sets the LocationCode field's value.
+func (s *Location) SetLocationCode(v string) *Location {
+	s.LocationCode = &v
 	return s
 }
 
-// SetLocation sets the Location field's value.
-func (s *Interconnect) SetLocation(v string) *Interconnect {
-	s.Location = &v
+// SetLocationName sets the LocationName field's value.
+func (s *Location) SetLocationName(v string) *Location {
+	s.LocationName = &v
 	return s
 }
 
 // SetRegion sets the Region field's value.
-func (s *Interconnect) SetRegion(v string) *Interconnect {
+func (s *Location) SetRegion(v string) *Location {
 	s.Region = &v
 	return s
 }
 
-// SetTags sets the Tags field's value.
-func (s *Interconnect) SetTags(v []*Tag) *Interconnect {
-	s.Tags = v
-	return s
-}
-
-// Information about a link aggregation group (LAG).
-type Lag struct {
+// Information about the MAC Security (MACsec) secret key.
+type MacSecKey struct {
 	_ struct{} `type:"structure"`
 
-	// Indicates whether the LAG can host other connections.
-	AllowsHostedConnections *bool `locationName:"allowsHostedConnections" type:"boolean"`
-
-	// The AWS Direct Connect endpoint that hosts the LAG.
-	AwsDevice *string `locationName:"awsDevice" deprecated:"true" type:"string"`
-
-	// The AWS Direct Connect endpoint that hosts the LAG.
-	AwsDeviceV2 *string `locationName:"awsDeviceV2" type:"string"`
-
-	// The connections bundled by the LAG.
-	Connections []*Connection `locationName:"connections" type:"list"`
-
-	// The individual bandwidth of the physical connections bundled by the LAG.
-	// The possible values are 1Gbps and 10Gbps.
-	ConnectionsBandwidth *string `locationName:"connectionsBandwidth" type:"string"`
+	// The Connection Key Name (CKN) for the MAC Security secret key.
+	Ckn *string `locationName:"ckn" type:"string"`
 
-	// Indicates whether the LAG supports a secondary BGP peer in the same address
-	// family (IPv4/IPv6).
-	HasLogicalRedundancy *string `locationName:"hasLogicalRedundancy" type:"string" enum:"HasLogicalRedundancy"`
-
-	// Indicates whether jumbo frames (9001 MTU) are supported.
-	JumboFrameCapable *bool `locationName:"jumboFrameCapable" type:"boolean"`
-
-	// The ID of the LAG.
-	LagId *string `locationName:"lagId" type:"string"`
+	// The Amazon Resource Name (ARN) of the MAC Security (MACsec) secret key.
+	SecretARN *string `locationName:"secretARN" type:"string"`
 
-	// The name of the LAG.
-	LagName *string `locationName:"lagName" type:"string"`
+	// The date that the MAC Security (MACsec) secret key takes effect. The value
+	// is displayed in UTC format.
+	StartOn *string `locationName:"startOn" type:"string"`
 
-	// The state of the LAG. The following are the possible values:
-	//
-	//    * requested: The initial state of a LAG. The LAG stays in the requested
-	//    state until the Letter of Authorization (LOA) is available.
-	//
-	//    * pending: The LAG has been approved and is being initialized.
+	// The state of the MAC Security (MACsec) secret key.
 	//
-	//    * available: The network link is established and the LAG is ready for
-	//    use.
+	// The possible values are:
 	//
-	//    * down: The network link is down.
+	//    * associating: The MAC Security (MACsec) secret key is being validated
+	//    and not yet associated with the connection or LAG.
 	//
-	//    * deleting: The LAG is being deleted.
+	//    * associated: The MAC Security (MACsec) secret key is validated and associated
+	//    with the connection or LAG.
 	//
-	//    * deleted: The LAG is deleted.
+	//    * disassociating: The MAC Security (MACsec) secret key is being disassociated
+	//    from the connection or LAG
 	//
-	//    * unknown: The state of the LAG is not available.
-	LagState *string `locationName:"lagState" type:"string" enum:"LagState"`
-
-	// The location of the LAG.
-	Location *string `locationName:"location" type:"string"`
-
-	// The minimum number of physical connections that must be operational for the
-	// LAG itself to be operational.
-	MinimumLinks *int64 `locationName:"minimumLinks" type:"integer"`
-
-	// The number of physical connections bundled by the LAG, up to a maximum of
-	// 10.
-	NumberOfConnections *int64 `locationName:"numberOfConnections" type:"integer"`
-
-	// The ID of the AWS account that owns the LAG.
-	OwnerAccount *string `locationName:"ownerAccount" type:"string"`
-
-	// The AWS Region where the connection is located.
-	Region *string `locationName:"region" type:"string"`
-
-	// Any tags assigned to link aggregation group (LAG).
-	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
+	//    * disassociated: The MAC Security (MACsec) secret key is no longer associated
+	//    with the connection or LAG.
+	State *string `locationName:"state" type:"string"`
 }
 
-// String returns the string representation
-func (s Lag) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MacSecKey) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Lag) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MacSecKey) GoString() string {
 	return s.String()
 }
 
-// SetAllowsHostedConnections sets the AllowsHostedConnections field's value.
-func (s *Lag) SetAllowsHostedConnections(v bool) *Lag {
-	s.AllowsHostedConnections = &v
+// SetCkn sets the Ckn field's value.
+func (s *MacSecKey) SetCkn(v string) *MacSecKey {
+	s.Ckn = &v
 	return s
 }
 
-// SetAwsDevice sets the AwsDevice field's value.
-func (s *Lag) SetAwsDevice(v string) *Lag {
-	s.AwsDevice = &v
+// SetSecretARN sets the SecretARN field's value.
+func (s *MacSecKey) SetSecretARN(v string) *MacSecKey {
+	s.SecretARN = &v
 	return s
 }
 
-// SetAwsDeviceV2 sets the AwsDeviceV2 field's value.
-func (s *Lag) SetAwsDeviceV2(v string) *Lag {
-	s.AwsDeviceV2 = &v
+// SetStartOn sets the StartOn field's value.
+func (s *MacSecKey) SetStartOn(v string) *MacSecKey {
+	s.StartOn = &v
 	return s
 }
 
-// SetConnections sets the Connections field's value.
-func (s *Lag) SetConnections(v []*Connection) *Lag {
-	s.Connections = v
+// SetState sets the State field's value.
+func (s *MacSecKey) SetState(v string) *MacSecKey {
+	s.State = &v
 	return s
 }
 
-// SetConnectionsBandwidth sets the ConnectionsBandwidth field's value.
-func (s *Lag) SetConnectionsBandwidth(v string) *Lag {
-	s.ConnectionsBandwidth = &v
-	return s
-}
+// Information about a new BGP peer.
+type NewBGPPeer struct {
+	_ struct{} `type:"structure"`
 
-// SetHasLogicalRedundancy sets the HasLogicalRedundancy field's value.
-func (s *Lag) SetHasLogicalRedundancy(v string) *Lag {
-	s.HasLogicalRedundancy = &v
-	return s
-}
+	// The address family for the BGP peer.
+	AddressFamily *string `locationName:"addressFamily" type:"string" enum:"AddressFamily"`
 
-// SetJumboFrameCapable sets the JumboFrameCapable field's value.
-func (s *Lag) SetJumboFrameCapable(v bool) *Lag {
-	s.JumboFrameCapable = &v
-	return s
-}
+	// The IP address assigned to the Amazon interface.
+	AmazonAddress *string `locationName:"amazonAddress" type:"string"`
 
-// SetLagId sets the LagId field's value.
-func (s *Lag) SetLagId(v string) *Lag {
-	s.LagId = &v
-	return s
-}
+	// The autonomous system (AS) number for Border Gateway Protocol (BGP) configuration.
+	Asn *int64 `locationName:"asn" type:"integer"`
 
-// SetLagName sets the LagName field's value.
-func (s *Lag) SetLagName(v string) *Lag {
-	s.LagName = &v
-	return s
+	// The authentication key for BGP configuration. This string has a minimum length
+	// of 6 characters and and a maximun lenth of 80 characters.
+	AuthKey *string `locationName:"authKey" type:"string"`
+
+	// The IP address assigned to the customer interface.
+	CustomerAddress *string `locationName:"customerAddress" type:"string"`
 }
 
-// SetLagState sets the LagState field's value.
-func (s *Lag) SetLagState(v string) *Lag {
-	s.LagState = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NewBGPPeer) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetLocation sets the Location field's value.
-func (s *Lag) SetLocation(v string) *Lag {
-	s.Location = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NewBGPPeer) GoString() string {
+	return s.String()
 }
 
-// SetMinimumLinks sets the MinimumLinks field's value.
-func (s *Lag) SetMinimumLinks(v int64) *Lag {
-	s.MinimumLinks = &v
+// SetAddressFamily sets the AddressFamily field's value.
+func (s *NewBGPPeer) SetAddressFamily(v string) *NewBGPPeer {
+	s.AddressFamily = &v
 	return s
 }
 
-// SetNumberOfConnections sets the NumberOfConnections field's value.
-func (s *Lag) SetNumberOfConnections(v int64) *Lag {
-	s.NumberOfConnections = &v
+// SetAmazonAddress sets the AmazonAddress field's value.
+func (s *NewBGPPeer) SetAmazonAddress(v string) *NewBGPPeer {
+	s.AmazonAddress = &v
 	return s
 }
 
-// SetOwnerAccount sets the OwnerAccount field's value.
-func (s *Lag) SetOwnerAccount(v string) *Lag {
-	s.OwnerAccount = &v
+// SetAsn sets the Asn field's value.
+func (s *NewBGPPeer) SetAsn(v int64) *NewBGPPeer {
+	s.Asn = &v
 	return s
 }
 
-// SetRegion sets the Region field's value.
-func (s *Lag) SetRegion(v string) *Lag {
-	s.Region = &v
+// SetAuthKey sets the AuthKey field's value.
+func (s *NewBGPPeer) SetAuthKey(v string) *NewBGPPeer {
+	s.AuthKey = &v
 	return s
 }
 
-// SetTags sets the Tags field's value.
-func (s *Lag) SetTags(v []*Tag) *Lag {
-	s.Tags = v
+// SetCustomerAddress sets the CustomerAddress field's value.
+func (s *NewBGPPeer) SetCustomerAddress(v string) *NewBGPPeer {
+	s.CustomerAddress = &v
 	return s
 }
 
-// Information about a Letter of Authorization - Connecting Facility Assignment
-// (LOA-CFA) for a connection.
-type Loa struct {
+// Information about a private virtual interface.
+type NewPrivateVirtualInterface struct {
 	_ struct{} `type:"structure"`
 
-	// The binary contents of the LOA-CFA document.
+	// The address family for the BGP peer.
+	AddressFamily *string `locationName:"addressFamily" type:"string" enum:"AddressFamily"`
+
+	// The IP address assigned to the Amazon interface.
+	AmazonAddress *string `locationName:"amazonAddress" type:"string"`
+
+	// The autonomous system (AS) number for Border Gateway Protocol (BGP) configuration.
 	//
-	// LoaContent is automatically base64 encoded/decoded by the SDK.
-	LoaContent []byte `locationName:"loaContent" type:"blob"`
+	// The valid values are 1-2147483647.
+	//
+	// Asn is a required field
+	Asn *int64 `locationName:"asn" type:"integer" required:"true"`
 
-	// The standard media type for the LOA-CFA document. The only supported value
-	// is application/pdf.
-	LoaContentType *string `locationName:"loaContentType" type:"string" enum:"LoaContentType"`
+	// The authentication key for BGP configuration. This string has a minimum length
+	// of 6 characters and and a maximun lenth of 80 characters.
+	AuthKey *string `locationName:"authKey" type:"string"`
+
+	// The IP address assigned to the customer interface.
+	CustomerAddress *string `locationName:"customerAddress" type:"string"`
+
+	// The ID of the Direct Connect gateway.
+	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
+
+	// Indicates whether to enable or disable SiteLink.
+	EnableSiteLink *bool `locationName:"enableSiteLink" type:"boolean"`
+
+	// The maximum transmission unit (MTU), in bytes. The supported values are 1500
+	// and 9001. The default value is 1500.
+	Mtu *int64 `locationName:"mtu" type:"integer"`
+
+	// The tags associated with the private virtual interface.
+	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
+
+	// The ID of the virtual private gateway.
+	VirtualGatewayId *string `locationName:"virtualGatewayId" type:"string"`
+
+	// The name of the virtual interface assigned by the customer network. The name
+	// has a maximum of 100 characters. The following are valid characters: a-z,
+	// 0-9 and a hyphen (-).
+	//
+	// VirtualInterfaceName is a required field
+	VirtualInterfaceName *string `locationName:"virtualInterfaceName" type:"string" required:"true"`
+
+	// The ID of the VLAN.
+	//
+	// Vlan is a required field
+	Vlan *int64 `locationName:"vlan" type:"integer" required:"true"`
 }
 
-// String returns the string representation
-func (s Loa) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NewPrivateVirtualInterface) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Loa) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NewPrivateVirtualInterface) GoString() string {
 	return s.String()
 }
 
-// SetLoaContent sets the LoaContent field's value.
-func (s *Loa) SetLoaContent(v []byte) *Loa {
-	s.LoaContent = v
-	return s
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *NewPrivateVirtualInterface) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "NewPrivateVirtualInterface"}
+	if s.Asn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Asn"))
+	}
+	if s.Tags != nil && len(s.Tags) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
+	}
+	if s.VirtualInterfaceName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualInterfaceName"))
+	}
+	if s.Vlan == nil {
+		invalidParams.Add(request.NewErrParamRequired("Vlan"))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetLoaContentType sets the LoaContentType field's value.
-func (s *Loa) SetLoaContentType(v string) *Loa {
-	s.LoaContentType = &v
+// SetAddressFamily sets the AddressFamily field's value.
+func (s *NewPrivateVirtualInterface) SetAddressFamily(v string) *NewPrivateVirtualInterface {
+	s.AddressFamily = &v
 	return s
 }
 
-// Information about an AWS Direct Connect location.
-type Location struct {
-	_ struct{} `type:"structure"`
+// SetAmazonAddress sets the AmazonAddress field's value.
+func (s *NewPrivateVirtualInterface) SetAmazonAddress(v string) *NewPrivateVirtualInterface {
+	s.AmazonAddress = &v
+	return s
+}
 
-	// The available port speeds for the location.
-	AvailablePortSpeeds []*string `locationName:"availablePortSpeeds" type:"list"`
+// SetAsn sets the Asn field's value.
+func (s *NewPrivateVirtualInterface) SetAsn(v int64) *NewPrivateVirtualInterface {
+	s.Asn = &v
+	return s
+}
 
-	// The code for the location.
-	LocationCode *string `locationName:"locationCode" type:"string"`
+// SetAuthKey sets the AuthKey field's value.
+func (s *NewPrivateVirtualInterface) SetAuthKey(v string) *NewPrivateVirtualInterface {
+	s.AuthKey = &v
+	return s
+}
 
-	// The name of the location. This includes the name of the colocation partner
-	// and the physical site of the building.
-	LocationName *string `locationName:"locationName" type:"string"`
+// SetCustomerAddress sets the CustomerAddress field's value.
+func (s *NewPrivateVirtualInterface) SetCustomerAddress(v string) *NewPrivateVirtualInterface {
+	s.CustomerAddress = &v
+	return s
+}
 
-	// The AWS Region for the location.
-	Region *string `locationName:"region" type:"string"`
+// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
+func (s *NewPrivateVirtualInterface) SetDirectConnectGatewayId(v string) *NewPrivateVirtualInterface {
+	s.DirectConnectGatewayId = &v
+	return s
 }
 
-// String returns the string representation
-func (s Location) String() string {
-	return awsutil.Prettify(s)
+// SetEnableSiteLink sets the EnableSiteLink field's value.
+func (s *NewPrivateVirtualInterface) SetEnableSiteLink(v bool) *NewPrivateVirtualInterface {
+	s.EnableSiteLink = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s Location) GoString() string {
-	return s.String()
+// SetMtu sets the Mtu field's value.
+func (s *NewPrivateVirtualInterface) SetMtu(v int64) *NewPrivateVirtualInterface {
+	s.Mtu = &v
+	return s
 }
 
-// SetAvailablePortSpeeds sets the AvailablePortSpeeds field's value.
-func (s *Location) SetAvailablePortSpeeds(v []*string) *Location {
-	s.AvailablePortSpeeds = v
+// SetTags sets the Tags field's value.
+func (s *NewPrivateVirtualInterface) SetTags(v []*Tag) *NewPrivateVirtualInterface {
+	s.Tags = v
 	return s
 }
 
-// SetLocationCode sets the LocationCode field's value.
-func (s *Location) SetLocationCode(v string) *Location {
-	s.LocationCode = &v
+// SetVirtualGatewayId sets the VirtualGatewayId field's value.
+func (s *NewPrivateVirtualInterface) SetVirtualGatewayId(v string) *NewPrivateVirtualInterface {
+	s.VirtualGatewayId = &v
 	return s
 }
 
-// SetLocationName sets the LocationName field's value.
-func (s *Location) SetLocationName(v string) *Location {
-	s.LocationName = &v
+// SetVirtualInterfaceName sets the VirtualInterfaceName field's value.
+func (s *NewPrivateVirtualInterface) SetVirtualInterfaceName(v string) *NewPrivateVirtualInterface {
+	s.VirtualInterfaceName = &v
 	return s
 }
 
-// SetRegion sets the Region field's value.
-func (s *Location) SetRegion(v string) *Location {
-	s.Region = &v
+// SetVlan sets the Vlan field's value.
+func (s *NewPrivateVirtualInterface) SetVlan(v int64) *NewPrivateVirtualInterface {
+	s.Vlan = &v
 	return s
 }
 
-// Information about a new BGP peer.
-type NewBGPPeer struct {
+// Information about a private virtual interface to be provisioned on a connection.
+type NewPrivateVirtualInterfaceAllocation struct {
 	_ struct{} `type:"structure"`
 
 	// The address family for the BGP peer.
@@ -9421,7 +12331,11 @@ type NewBGPPeer struct {
 	AmazonAddress *string `locationName:"amazonAddress" type:"string"`
 
 	// The autonomous system (AS) number for Border Gateway Protocol (BGP) configuration.
-	Asn *int64 `locationName:"asn" type:"integer"`
+	//
+	// The valid values are 1-2147483647.
+	//
+	// Asn is a required field
+	Asn *int64 `locationName:"asn" type:"integer" required:"true"`
 
 	// The authentication key for BGP configuration. This string has a minimum length
 	// of 6 characters and and a maximun lenth of 80 characters.
@@ -9429,50 +12343,133 @@ type NewBGPPeer struct {
 
 	// The IP address assigned to the customer interface.
 	CustomerAddress *string `locationName:"customerAddress" type:"string"`
+
+	// The maximum transmission unit (MTU), in bytes. The supported values are 1500
+	// and 9001. The default value is 1500.
+	Mtu *int64 `locationName:"mtu" type:"integer"`
+
+	// The tags associated with the private virtual interface.
+	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
+
+	// The name of the virtual interface assigned by the customer network. The name
+	// has a maximum of 100 characters. The following are valid characters: a-z,
+	// 0-9 and a hyphen (-).
+	//
+	// VirtualInterfaceName is a required field
+	VirtualInterfaceName *string `locationName:"virtualInterfaceName" type:"string" required:"true"`
+
+	// The ID of the VLAN.
+	//
+	// Vlan is a required field
+	Vlan *int64 `locationName:"vlan" type:"integer" required:"true"`
 }
 
-// String returns the string representation
-func (s NewBGPPeer) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NewPrivateVirtualInterfaceAllocation) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s NewBGPPeer) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NewPrivateVirtualInterfaceAllocation) GoString() string {
 	return s.String()
 }
 
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *NewPrivateVirtualInterfaceAllocation) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "NewPrivateVirtualInterfaceAllocation"}
+	if s.Asn == nil {
+		invalidParams.Add(request.NewErrParamRequired("Asn"))
+	}
+	if s.Tags != nil && len(s.Tags) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
+	}
+	if s.VirtualInterfaceName == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualInterfaceName"))
+	}
+	if s.Vlan == nil {
+		invalidParams.Add(request.NewErrParamRequired("Vlan"))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddressFamily sets the AddressFamily field's value.
-func (s *NewBGPPeer) SetAddressFamily(v string) *NewBGPPeer {
+func (s *NewPrivateVirtualInterfaceAllocation) SetAddressFamily(v string) *NewPrivateVirtualInterfaceAllocation {
 	s.AddressFamily = &v
 	return s
 }
 
 // SetAmazonAddress sets the AmazonAddress field's value.
-func (s *NewBGPPeer) SetAmazonAddress(v string) *NewBGPPeer {
+func (s *NewPrivateVirtualInterfaceAllocation) SetAmazonAddress(v string) *NewPrivateVirtualInterfaceAllocation {
 	s.AmazonAddress = &v
 	return s
 }
 
 // SetAsn sets the Asn field's value.
-func (s *NewBGPPeer) SetAsn(v int64) *NewBGPPeer {
+func (s *NewPrivateVirtualInterfaceAllocation) SetAsn(v int64) *NewPrivateVirtualInterfaceAllocation {
 	s.Asn = &v
 	return s
 }
 
 // SetAuthKey sets the AuthKey field's value.
-func (s *NewBGPPeer) SetAuthKey(v string) *NewBGPPeer {
+func (s *NewPrivateVirtualInterfaceAllocation) SetAuthKey(v string) *NewPrivateVirtualInterfaceAllocation {
 	s.AuthKey = &v
 	return s
 }
 
 // SetCustomerAddress sets the CustomerAddress field's value.
-func (s *NewBGPPeer) SetCustomerAddress(v string) *NewBGPPeer {
+func (s *NewPrivateVirtualInterfaceAllocation) SetCustomerAddress(v string) *NewPrivateVirtualInterfaceAllocation {
 	s.CustomerAddress = &v
 	return s
 }
 
-// Information about a private virtual interface.
-type NewPrivateVirtualInterface struct {
+// SetMtu sets the Mtu field's value.
+func (s *NewPrivateVirtualInterfaceAllocation) SetMtu(v int64) *NewPrivateVirtualInterfaceAllocation {
+	s.Mtu = &v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *NewPrivateVirtualInterfaceAllocation) SetTags(v []*Tag) *NewPrivateVirtualInterfaceAllocation {
+	s.Tags = v
+	return s
+}
+
+// SetVirtualInterfaceName sets the VirtualInterfaceName field's value.
+func (s *NewPrivateVirtualInterfaceAllocation) SetVirtualInterfaceName(v string) *NewPrivateVirtualInterfaceAllocation {
+	s.VirtualInterfaceName = &v
+	return s
+}
+
+// SetVlan sets the Vlan field's value.
+func (s *NewPrivateVirtualInterfaceAllocation) SetVlan(v int64) *NewPrivateVirtualInterfaceAllocation {
+	s.Vlan = &v
+	return s
+}
+
+// Information about a public virtual interface.
+type NewPublicVirtualInterface struct {
 	_ struct{} `type:"structure"`
 
 	// The address family for the BGP peer.
@@ -9483,6 +12480,8 @@ type NewPrivateVirtualInterface struct {
 
 	// The autonomous system (AS) number for Border Gateway Protocol (BGP) configuration.
 	//
+	// The valid values are 1-2147483647.
+	//
 	// Asn is a required field
 	Asn *int64 `locationName:"asn" type:"integer" required:"true"`
 
@@ -9493,20 +12492,16 @@ type NewPrivateVirtualInterface struct {
 	// The IP address assigned to the customer interface.
 	CustomerAddress *string `locationName:"customerAddress" type:"string"`
 
-	// The ID of the Direct Connect gateway.
-	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
-
-	// The maximum transmission unit (MTU), in bytes. The supported values are 1500
-	// and 9001. The default value is 1500.
-	Mtu *int64 `locationName:"mtu" type:"integer"`
+	// The routes to be advertised to the Amazon Web Services network in this Region.
+	// Applies to public virtual interfaces.
+	RouteFilterPrefixes []*RouteFilterPrefix `locationName:"routeFilterPrefixes" type:"list"`
 
-	// Any tags assigned to the private virtual interface.
+	// The tags associated with the public virtual interface.
 	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
 
-	// The ID of the virtual private gateway.
-	VirtualGatewayId *string `locationName:"virtualGatewayId" deprecated:"true" type:"string"`
-
-	// The name of the virtual interface assigned by the customer network.
+	// The name of the virtual interface assigned by the customer network. The name
+	// has a maximum of 100 characters. The following are valid characters: a-z,
+	// 0-9 and a hyphen (-).
 	//
 	// VirtualInterfaceName is a required field
 	VirtualInterfaceName *string `locationName:"virtualInterfaceName" type:"string" required:"true"`
@@ -9517,19 +12512,27 @@ type NewPrivateVirtualInterface struct {
 	Vlan *int64 `locationName:"vlan" type:"integer" required:"true"`
 }
 
-// String returns the string representation
-func (s NewPrivateVirtualInterface) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NewPublicVirtualInterface) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s NewPrivateVirtualInterface) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NewPublicVirtualInterface) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *NewPrivateVirtualInterface) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "NewPrivateVirtualInterface"}
+func (s *NewPublicVirtualInterface) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "NewPublicVirtualInterface"}
 	if s.Asn == nil {
 		invalidParams.Add(request.NewErrParamRequired("Asn"))
 	}
@@ -9560,73 +12563,61 @@ func (s *NewPrivateVirtualInterface) Validate() error {
 }
 
 // SetAddressFamily sets the AddressFamily field's value.
-func (s *NewPrivateVirtualInterface) SetAddressFamily(v string) *NewPrivateVirtualInterface {
+func (s *NewPublicVirtualInterface) SetAddressFamily(v string) *NewPublicVirtualInterface {
 	s.AddressFamily = &v
 	return s
 }
 
 // SetAmazonAddress sets the AmazonAddress field's value.
-func (s *NewPrivateVirtualInterface) SetAmazonAddress(v string) *NewPrivateVirtualInterface {
+func (s *NewPublicVirtualInterface) SetAmazonAddress(v string) *NewPublicVirtualInterface {
 	s.AmazonAddress = &v
 	return s
 }
 
 // SetAsn sets the Asn field's value.
-func (s *NewPrivateVirtualInterface) SetAsn(v int64) *NewPrivateVirtualInterface {
+func (s *NewPublicVirtualInterface) SetAsn(v int64) *NewPublicVirtualInterface {
 	s.Asn = &v
 	return s
 }
 
 // SetAuthKey sets the AuthKey field's value.
-func (s *NewPrivateVirtualInterface) SetAuthKey(v string) *NewPrivateVirtualInterface {
+func (s *NewPublicVirtualInterface) SetAuthKey(v string) *NewPublicVirtualInterface {
 	s.AuthKey = &v
 	return s
 }
 
 // SetCustomerAddress sets the CustomerAddress field's value.
-func (s *NewPrivateVirtualInterface) SetCustomerAddress(v string) *NewPrivateVirtualInterface {
+func (s *NewPublicVirtualInterface) SetCustomerAddress(v string) *NewPublicVirtualInterface {
 	s.CustomerAddress = &v
 	return s
 }
 
-// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
-func (s *NewPrivateVirtualInterface) SetDirectConnectGatewayId(v string) *NewPrivateVirtualInterface {
-	s.DirectConnectGatewayId = &v
-	return s
-}
-
-// SetMtu sets the Mtu field's value.
-func (s *NewPrivateVirtualInterface) SetMtu(v int64) *NewPrivateVirtualInterface {
-	s.Mtu = &v
+// SetRouteFilterPrefixes sets the RouteFilterPrefixes field's value.
+func (s *NewPublicVirtualInterface) SetRouteFilterPrefixes(v []*RouteFilterPrefix) *NewPublicVirtualInterface {
+	s.RouteFilterPrefixes = v
 	return s
 }
 
 // SetTags sets the Tags field's value.
-func (s *NewPrivateVirtualInterface) SetTags(v []*Tag) *NewPrivateVirtualInterface {
+func (s *NewPublicVirtualInterface) SetTags(v []*Tag) *NewPublicVirtualInterface {
 	s.Tags = v
 	return s
 }
 
-// SetVirtualGatewayId sets the VirtualGatewayId field's value.
-func (s *NewPrivateVirtualInterface) SetVirtualGatewayId(v string) *NewPrivateVirtualInterface {
-	s.VirtualGatewayId = &v
-	return s
-}
-
 // SetVirtualInterfaceName sets the VirtualInterfaceName field's value.
-func (s *NewPrivateVirtualInterface) SetVirtualInterfaceName(v string) *NewPrivateVirtualInterface {
+func (s *NewPublicVirtualInterface) SetVirtualInterfaceName(v string) *NewPublicVirtualInterface {
 	s.VirtualInterfaceName = &v
 	return s
 }
 
 // SetVlan sets the Vlan field's value.
-func (s *NewPrivateVirtualInterface) SetVlan(v int64) *NewPrivateVirtualInterface {
+func (s *NewPublicVirtualInterface) SetVlan(v int64) *NewPublicVirtualInterface {
 	s.Vlan = &v
 	return s
 }
 
-// Information about a private virtual interface to be provisioned on a connection.
-type NewPrivateVirtualInterfaceAllocation struct {
+// Information about a public virtual interface to be provisioned on a connection.
+type NewPublicVirtualInterfaceAllocation struct {
 	_ struct{} `type:"structure"`
 
 	// The address family for the BGP peer.
@@ -9637,6 +12628,8 @@ type NewPrivateVirtualInterfaceAllocation struct {
 
 	// The autonomous system (AS) number for Border Gateway Protocol (BGP) configuration.
 	//
+	// The valid values are 1-2147483647.
+	//
 	// Asn is a required field
 	Asn *int64 `locationName:"asn" type:"integer" required:"true"`
 
@@ -9647,15 +12640,16 @@ type NewPrivateVirtualInterfaceAllocation struct {
 	// The IP address assigned to the customer interface.
 	CustomerAddress *string `locationName:"customerAddress" type:"string"`
 
-	// The maximum transmission unit (MTU), in bytes. The supported values are 1500
-	// and 9001. The default value is 1500.
-	Mtu *int64 `locationName:"mtu" type:"integer"`
+	// The routes to be advertised to the Amazon Web Services network in this Region.
+	// Applies to public virtual interfaces.
+	RouteFilterPrefixes []*RouteFilterPrefix `locationName:"routeFilterPrefixes" type:"list"`
 
-	// Any tags assigned to the private virtual interface to be provisioned on a
-	// connection.
+	// The tags associated with the public virtual interface.
 	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
 
-	// The name of the virtual interface assigned by the customer network.
+	// The name of the virtual interface assigned by the customer network. The name
+	// has a maximum of 100 characters. The following are valid characters: a-z,
+	// 0-9 and a hyphen (-).
 	//
 	// VirtualInterfaceName is a required field
 	VirtualInterfaceName *string `locationName:"virtualInterfaceName" type:"string" required:"true"`
@@ -9666,19 +12660,27 @@ type NewPrivateVirtualInterfaceAllocation struct {
 	Vlan *int64 `locationName:"vlan" type:"integer" required:"true"`
 }
 
-// String returns the string representation
-func (s NewPrivateVirtualInterfaceAllocation) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NewPublicVirtualInterfaceAllocation) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s NewPrivateVirtualInterfaceAllocation) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NewPublicVirtualInterfaceAllocation) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *NewPrivateVirtualInterfaceAllocation) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "NewPrivateVirtualInterfaceAllocation"}
+func (s *NewPublicVirtualInterfaceAllocation) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "NewPublicVirtualInterfaceAllocation"}
 	if s.Asn == nil {
 		invalidParams.Add(request.NewErrParamRequired("Asn"))
 	}
@@ -9709,61 +12711,61 @@ func (s *NewPrivateVirtualInterfaceAllocation) Validate() error {
 }
 
 // SetAddressFamily sets the AddressFamily field's value.
-func (s *NewPrivateVirtualInterfaceAllocation) SetAddressFamily(v string) *NewPrivateVirtualInterfaceAllocation {
+func (s *NewPublicVirtualInterfaceAllocation) SetAddressFamily(v string) *NewPublicVirtualInterfaceAllocation {
 	s.AddressFamily = &v
 	return s
 }
 
 // SetAmazonAddress sets the AmazonAddress field's value.
-func (s *NewPrivateVirtualInterfaceAllocation) SetAmazonAddress(v string) *NewPrivateVirtualInterfaceAllocation {
+func (s *NewPublicVirtualInterfaceAllocation) SetAmazonAddress(v string) *NewPublicVirtualInterfaceAllocation {
 	s.AmazonAddress = &v
 	return s
 }
 
 // SetAsn sets the Asn field's value.
-func (s *NewPrivateVirtualInterfaceAllocation) SetAsn(v int64) *NewPrivateVirtualInterfaceAllocation {
+func (s *NewPublicVirtualInterfaceAllocation) SetAsn(v int64) *NewPublicVirtualInterfaceAllocation {
 	s.Asn = &v
 	return s
 }
 
 // SetAuthKey sets the AuthKey field's value.
-func (s *NewPrivateVirtualInterfaceAllocation) SetAuthKey(v string) *NewPrivateVirtualInterfaceAllocation {
+func (s *NewPublicVirtualInterfaceAllocation) SetAuthKey(v string) *NewPublicVirtualInterfaceAllocation {
 	s.AuthKey = &v
 	return s
 }
 
 // SetCustomerAddress sets the CustomerAddress field's value.
-func (s *NewPrivateVirtualInterfaceAllocation) SetCustomerAddress(v string) *NewPrivateVirtualInterfaceAllocation {
+func (s *NewPublicVirtualInterfaceAllocation) SetCustomerAddress(v string) *NewPublicVirtualInterfaceAllocation {
 	s.CustomerAddress = &v
 	return s
 }
 
-// SetMtu sets the Mtu field's value.
-func (s *NewPrivateVirtualInterfaceAllocation) SetMtu(v int64) *NewPrivateVirtualInterfaceAllocation {
-	s.Mtu = &v
+// SetRouteFilterPrefixes sets the RouteFilterPrefixes field's value.
+func (s *NewPublicVirtualInterfaceAllocation) SetRouteFilterPrefixes(v []*RouteFilterPrefix) *NewPublicVirtualInterfaceAllocation {
+	s.RouteFilterPrefixes = v
 	return s
 }
 
 // SetTags sets the Tags field's value.
-func (s *NewPrivateVirtualInterfaceAllocation) SetTags(v []*Tag) *NewPrivateVirtualInterfaceAllocation {
+func (s *NewPublicVirtualInterfaceAllocation) SetTags(v []*Tag) *NewPublicVirtualInterfaceAllocation {
 	s.Tags = v
 	return s
 }
 
 // SetVirtualInterfaceName sets the VirtualInterfaceName field's value.
-func (s *NewPrivateVirtualInterfaceAllocation) SetVirtualInterfaceName(v string) *NewPrivateVirtualInterfaceAllocation {
+func (s *NewPublicVirtualInterfaceAllocation) SetVirtualInterfaceName(v string) *NewPublicVirtualInterfaceAllocation {
 	s.VirtualInterfaceName = &v
 	return s
 }
 
 // SetVlan sets the Vlan field's value.
-func (s *NewPrivateVirtualInterfaceAllocation) SetVlan(v int64) *NewPrivateVirtualInterfaceAllocation {
+func (s *NewPublicVirtualInterfaceAllocation) SetVlan(v int64) *NewPublicVirtualInterfaceAllocation {
 	s.Vlan = &v
 	return s
 }
 
-// Information about a public virtual interface.
-type NewPublicVirtualInterface struct {
+// Information about a transit virtual interface.
+type NewTransitVirtualInterface struct {
 	_ struct{} `type:"structure"`
 
 	// The address family for the BGP peer.
@@ -9774,8 +12776,8 @@ type NewPublicVirtualInterface struct {
 
 	// The autonomous system (AS) number for Border Gateway Protocol (BGP) configuration.
 	//
-	// Asn is a required field
-	Asn *int64 `locationName:"asn" type:"integer" required:"true"`
+	// The valid values are 1-2147483647.
+	Asn *int64 `locationName:"asn" type:"integer"`
 
 	// The authentication key for BGP configuration. This string has a minimum length
 	// of 6 characters and and a maximun lenth of 80 characters.
@@ -9784,49 +12786,52 @@ type NewPublicVirtualInterface struct {
 	// The IP address assigned to the customer interface.
 	CustomerAddress *string `locationName:"customerAddress" type:"string"`
 
-	// The routes to be advertised to the AWS network in this Region. Applies to
-	// public virtual interfaces.
-	RouteFilterPrefixes []*RouteFilterPrefix `locationName:"routeFilterPrefixes" type:"list"`
+	// The ID of the Direct Connect gateway.
+	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
 
-	// Any tags assigned to the public virtual interface.
+	// Indicates whether to enable or disable SiteLink.
+	EnableSiteLink *bool `locationName:"enableSiteLink" type:"boolean"`
+
+	// The maximum transmission unit (MTU), in bytes. The supported values are 1500
+	// and 8500. The default value is 1500.
+	Mtu *int64 `locationName:"mtu" type:"integer"`
+
+	// The tags associated with the transitive virtual interface.
 	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
 
-	// The name of the virtual interface assigned by the customer network.
-	//
-	// VirtualInterfaceName is a required field
-	VirtualInterfaceName *string `locationName:"virtualInterfaceName" type:"string" required:"true"`
+	// The name of the virtual interface assigned by the customer network. The name
+	// has a maximum of 100 characters. The following are valid characters: a-z,
+	// 0-9 and a hyphen (-).
+	VirtualInterfaceName *string `locationName:"virtualInterfaceName" type:"string"`
 
 	// The ID of the VLAN.
-	//
-	// Vlan is a required field
-	Vlan *int64 `locationName:"vlan" type:"integer" required:"true"`
+	Vlan *int64 `locationName:"vlan" type:"integer"`
 }
 
-// String returns the string representation
-func (s NewPublicVirtualInterface) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NewTransitVirtualInterface) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s NewPublicVirtualInterface) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NewTransitVirtualInterface) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *NewPublicVirtualInterface) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "NewPublicVirtualInterface"}
-	if s.Asn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Asn"))
-	}
+func (s *NewTransitVirtualInterface) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "NewTransitVirtualInterface"}
 	if s.Tags != nil && len(s.Tags) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
 	}
-	if s.VirtualInterfaceName == nil {
-		invalidParams.Add(request.NewErrParamRequired("VirtualInterfaceName"))
-	}
-	if s.Vlan == nil {
-		invalidParams.Add(request.NewErrParamRequired("Vlan"))
-	}
 	if s.Tags != nil {
 		for i, v := range s.Tags {
 			if v == nil {
@@ -9845,61 +12850,73 @@ func (s *NewPublicVirtualInterface) Validate() error {
 }
 
 // SetAddressFamily sets the AddressFamily field's value.
-func (s *NewPublicVirtualInterface) SetAddressFamily(v string) *NewPublicVirtualInterface {
+func (s *NewTransitVirtualInterface) SetAddressFamily(v string) *NewTransitVirtualInterface {
 	s.AddressFamily = &v
 	return s
 }
 
 // SetAmazonAddress sets the AmazonAddress field's value.
-func (s *NewPublicVirtualInterface) SetAmazonAddress(v string) *NewPublicVirtualInterface {
+func (s *NewTransitVirtualInterface) SetAmazonAddress(v string) *NewTransitVirtualInterface {
 	s.AmazonAddress = &v
 	return s
 }
 
 // SetAsn sets the Asn field's value.
-func (s *NewPublicVirtualInterface) SetAsn(v int64) *NewPublicVirtualInterface {
+func (s *NewTransitVirtualInterface) SetAsn(v int64) *NewTransitVirtualInterface {
 	s.Asn = &v
 	return s
 }
 
 // SetAuthKey sets the AuthKey field's value.
-func (s *NewPublicVirtualInterface) SetAuthKey(v string) *NewPublicVirtualInterface {
+func (s *NewTransitVirtualInterface) SetAuthKey(v string) *NewTransitVirtualInterface {
 	s.AuthKey = &v
 	return s
 }
 
-// SetCustomerAddress sets the CustomerAddress field's value.
-func (s *NewPublicVirtualInterface) SetCustomerAddress(v string) *NewPublicVirtualInterface {
-	s.CustomerAddress = &v
+// SetCustomerAddress sets the CustomerAddress field's value.
+func (s *NewTransitVirtualInterface) SetCustomerAddress(v string) *NewTransitVirtualInterface {
+	s.CustomerAddress = &v
+	return s
+}
+
+// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
+func (s *NewTransitVirtualInterface) SetDirectConnectGatewayId(v string) *NewTransitVirtualInterface {
+	s.DirectConnectGatewayId = &v
+	return s
+}
+
+// SetEnableSiteLink sets the EnableSiteLink field's value.
+func (s *NewTransitVirtualInterface) SetEnableSiteLink(v bool) *NewTransitVirtualInterface {
+	s.EnableSiteLink = &v
 	return s
 }
 
-// SetRouteFilterPrefixes sets the RouteFilterPrefixes field's value.
-func (s *NewPublicVirtualInterface) SetRouteFilterPrefixes(v []*RouteFilterPrefix) *NewPublicVirtualInterface {
-	s.RouteFilterPrefixes = v
+// SetMtu sets the Mtu field's value.
+func (s *NewTransitVirtualInterface) SetMtu(v int64) *NewTransitVirtualInterface {
+	s.Mtu = &v
 	return s
 }
 
 // SetTags sets the Tags field's value.
-func (s *NewPublicVirtualInterface) SetTags(v []*Tag) *NewPublicVirtualInterface {
+func (s *NewTransitVirtualInterface) SetTags(v []*Tag) *NewTransitVirtualInterface {
 	s.Tags = v
 	return s
 }
 
 // SetVirtualInterfaceName sets the VirtualInterfaceName field's value.
-func (s *NewPublicVirtualInterface) SetVirtualInterfaceName(v string) *NewPublicVirtualInterface {
+func (s *NewTransitVirtualInterface) SetVirtualInterfaceName(v string) *NewTransitVirtualInterface {
 	s.VirtualInterfaceName = &v
 	return s
 }
 
 // SetVlan sets the Vlan field's value.
-func (s *NewPublicVirtualInterface) SetVlan(v int64) *NewPublicVirtualInterface {
+func (s *NewTransitVirtualInterface) SetVlan(v int64) *NewTransitVirtualInterface {
 	s.Vlan = &v
 	return s
 }
 
-// Information about a public virtual interface to be provisioned on a connection.
-type NewPublicVirtualInterfaceAllocation struct {
+// Information about a transit virtual interface to be provisioned on a connection.
+type NewTransitVirtualInterfaceAllocation struct {
 	_ struct{} `type:"structure"`
 
 	// The address family for the BGP peer.
@@ -9910,8 +12927,8 @@ type NewPublicVirtualInterfaceAllocation struct {
 
 	// The autonomous system (AS) number for Border Gateway Protocol (BGP) configuration.
 	//
-	// Asn is a required field
-	Asn *int64 `locationName:"asn" type:"integer" required:"true"`
+	// The valid values are 1-2147483647.
+	Asn *int64 `locationName:"asn" type:"integer"`
 
 	// The authentication key for BGP configuration. This string has a minimum length
 	// of 6 characters and and a maximun lenth of 80 characters.
@@ -9920,50 +12937,46 @@ type NewPublicVirtualInterfaceAllocation struct {
 	// The IP address assigned to the customer interface.
 	CustomerAddress *string `locationName:"customerAddress" type:"string"`
 
-	// The routes to be advertised to the AWS network in this Region. Applies to
-	// public virtual interfaces.
-	RouteFilterPrefixes []*RouteFilterPrefix `locationName:"routeFilterPrefixes" type:"list"`
+	// The maximum transmission unit (MTU), in bytes. The supported values are 1500
+	// and 8500. The default value is 1500
+	Mtu *int64 `locationName:"mtu" type:"integer"`
 
-	// Any tags assigned to the public virtual interface to be provisioned on a
-	// connection.
+	// The tags associated with the transitive virtual interface.
 	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
 
-	// The name of the virtual interface assigned by the customer network.
-	//
-	// VirtualInterfaceName is a required field
-	VirtualInterfaceName *string `locationName:"virtualInterfaceName" type:"string" required:"true"`
+	// The name of the virtual interface assigned by the customer network. The name
+	// has a maximum of 100 characters. The following are valid characters: a-z,
+	// 0-9 and a hyphen (-).
+	VirtualInterfaceName *string `locationName:"virtualInterfaceName" type:"string"`
 
 	// The ID of the VLAN.
-	//
-	// Vlan is a required field
-	Vlan *int64 `locationName:"vlan" type:"integer" required:"true"`
+	Vlan *int64 `locationName:"vlan" type:"integer"`
 }
 
-// String returns the string representation
-func (s NewPublicVirtualInterfaceAllocation) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NewTransitVirtualInterfaceAllocation) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s NewPublicVirtualInterfaceAllocation) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NewTransitVirtualInterfaceAllocation) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *NewPublicVirtualInterfaceAllocation) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "NewPublicVirtualInterfaceAllocation"}
-	if s.Asn == nil {
-		invalidParams.Add(request.NewErrParamRequired("Asn"))
-	}
+func (s *NewTransitVirtualInterfaceAllocation) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "NewTransitVirtualInterfaceAllocation"}
 	if s.Tags != nil && len(s.Tags) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
 	}
-	if s.VirtualInterfaceName == nil {
-		invalidParams.Add(request.NewErrParamRequired("VirtualInterfaceName"))
-	}
-	if s.Vlan == nil {
-		invalidParams.Add(request.NewErrParamRequired("Vlan"))
-	}
 	if s.Tags != nil {
 		for i, v := range s.Tags {
 			if v == nil {
@@ -9982,120 +12995,407 @@ func (s *NewPublicVirtualInterfaceAllocation) Validate() error {
 }
 
 // SetAddressFamily sets the AddressFamily field's value.
-func (s *NewPublicVirtualInterfaceAllocation) SetAddressFamily(v string) *NewPublicVirtualInterfaceAllocation {
+func (s *NewTransitVirtualInterfaceAllocation) SetAddressFamily(v string) *NewTransitVirtualInterfaceAllocation {
 	s.AddressFamily = &v
 	return s
 }
 
 // SetAmazonAddress sets the AmazonAddress field's value.
-func (s *NewPublicVirtualInterfaceAllocation) SetAmazonAddress(v string) *NewPublicVirtualInterfaceAllocation {
+func (s *NewTransitVirtualInterfaceAllocation) SetAmazonAddress(v string) *NewTransitVirtualInterfaceAllocation {
 	s.AmazonAddress = &v
 	return s
 }
 
 // SetAsn sets the Asn field's value.
-func (s *NewPublicVirtualInterfaceAllocation) SetAsn(v int64) *NewPublicVirtualInterfaceAllocation {
+func (s *NewTransitVirtualInterfaceAllocation) SetAsn(v int64) *NewTransitVirtualInterfaceAllocation {
 	s.Asn = &v
 	return s
 }
 
 // SetAuthKey sets the AuthKey field's value.
-func (s *NewPublicVirtualInterfaceAllocation) SetAuthKey(v string) *NewPublicVirtualInterfaceAllocation {
+func (s *NewTransitVirtualInterfaceAllocation) SetAuthKey(v string) *NewTransitVirtualInterfaceAllocation {
 	s.AuthKey = &v
 	return s
 }
 
 // SetCustomerAddress sets the CustomerAddress field's value.
-func (s *NewPublicVirtualInterfaceAllocation) SetCustomerAddress(v string) *NewPublicVirtualInterfaceAllocation {
+func (s *NewTransitVirtualInterfaceAllocation) SetCustomerAddress(v string) *NewTransitVirtualInterfaceAllocation {
 	s.CustomerAddress = &v
 	return s
 }
 
-// SetRouteFilterPrefixes sets the RouteFilterPrefixes field's value.
-func (s *NewPublicVirtualInterfaceAllocation) SetRouteFilterPrefixes(v []*RouteFilterPrefix) *NewPublicVirtualInterfaceAllocation {
-	s.RouteFilterPrefixes = v
+// SetMtu sets the Mtu field's value.
+func (s *NewTransitVirtualInterfaceAllocation) SetMtu(v int64) *NewTransitVirtualInterfaceAllocation {
+	s.Mtu = &v
 	return s
 }
 
 // SetTags sets the Tags field's value.
-func (s *NewPublicVirtualInterfaceAllocation) SetTags(v []*Tag) *NewPublicVirtualInterfaceAllocation {
+func (s *NewTransitVirtualInterfaceAllocation) SetTags(v []*Tag) *NewTransitVirtualInterfaceAllocation {
 	s.Tags = v
 	return s
 }
 
 // SetVirtualInterfaceName sets the VirtualInterfaceName field's value.
-func (s *NewPublicVirtualInterfaceAllocation) SetVirtualInterfaceName(v string) *NewPublicVirtualInterfaceAllocation {
+func (s *NewTransitVirtualInterfaceAllocation) SetVirtualInterfaceName(v string) *NewTransitVirtualInterfaceAllocation {
 	s.VirtualInterfaceName = &v
 	return s
 }
 
 // SetVlan sets the Vlan field's value.
-func (s *NewPublicVirtualInterfaceAllocation) SetVlan(v int64) *NewPublicVirtualInterfaceAllocation {
+func (s *NewTransitVirtualInterfaceAllocation) SetVlan(v int64) *NewTransitVirtualInterfaceAllocation {
 	s.Vlan = &v
 	return s
 }
 
-// Information about the transit virtual interface.
-type NewTransitVirtualInterface struct {
+// Information about a tag associated with an Direct Connect resource.
+type ResourceTag struct {
 	_ struct{} `type:"structure"`
 
-	// The address family for the BGP peer.
-	AddressFamily *string `locationName:"addressFamily" type:"string" enum:"AddressFamily"`
+	// The Amazon Resource Name (ARN) of the resource.
+	ResourceArn *string `locationName:"resourceArn" type:"string"`
 
-	// The IP address assigned to the Amazon interface.
-	AmazonAddress *string `locationName:"amazonAddress" type:"string"`
+	// The tags.
+	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
+}
 
-	// The autonomous system (AS) number for Border Gateway Protocol (BGP) configuration.
-	Asn *int64 `locationName:"asn" type:"integer"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceTag) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// The authentication key for BGP configuration.
-	AuthKey *string `locationName:"authKey" type:"string"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceTag) GoString() string {
+	return s.String()
+}
 
-	// The IP address assigned to the customer interface.
-	CustomerAddress *string `locationName:"customerAddress" type:"string"`
+// SetResourceArn sets the ResourceArn field's value.
+func (s *ResourceTag) SetResourceArn(v string) *ResourceTag {
+	s.ResourceArn = &v
+	return s
+}
 
-	// The ID of the Direct Connect gateway.
-	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
+// SetTags sets the Tags field's value.
+func (s *ResourceTag) SetTags(v []*Tag) *ResourceTag {
+	s.Tags = v
+	return s
+}
 
-	// The maximum transmission unit (MTU), in bytes. The supported values are 1500
-	// and 8500. The default value is 1500.
-	Mtu *int64 `locationName:"mtu" type:"integer"`
+// Information about a route filter prefix that a customer can advertise through
+// Border Gateway Protocol (BGP) over a public virtual interface.
+type RouteFilterPrefix struct {
+	_ struct{} `type:"structure"`
+
+	// The CIDR block for the advertised route. Separate multiple routes using commas.
+	// An IPv6 CIDR must use /64 or shorter.
+	Cidr *string `locationName:"cidr" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RouteFilterPrefix) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RouteFilterPrefix) GoString() string {
+	return s.String()
+}
+
+// SetCidr sets the Cidr field's value.
+func (s *RouteFilterPrefix) SetCidr(v string) *RouteFilterPrefix {
+	s.Cidr = &v
+	return s
+}
+
+// Information about the virtual router.
+type RouterType struct {
+	_ struct{} `type:"structure"`
+
+	// The virtual interface router platform.
+	Platform *string `locationName:"platform" type:"string"`
+
+	// Identifies the router by a combination of vendor, platform, and software
+	// version. For example, CiscoSystemsInc-2900SeriesRouters-IOS124.
+	RouterTypeIdentifier *string `locationName:"routerTypeIdentifier" type:"string"`
+
+	// The router software.
+	Software *string `locationName:"software" type:"string"`
+
+	// The vendor for the virtual interface's router.
+	Vendor *string `locationName:"vendor" type:"string"`
+
+	// The template for the virtual interface's router.
+	XsltTemplateName *string `locationName:"xsltTemplateName" type:"string"`
+
+	// The MAC Security (MACsec) template for the virtual interface's router.
+	XsltTemplateNameForMacSec *string `locationName:"xsltTemplateNameForMacSec" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RouterType) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RouterType) GoString() string {
+	return s.String()
+}
+
+// SetPlatform sets the Platform field's value.
+func (s *RouterType) SetPlatform(v string) *RouterType {
+	s.Platform = &v
+	return s
+}
+
+// SetRouterTypeIdentifier sets the RouterTypeIdentifier field's value.
+func (s *RouterType) SetRouterTypeIdentifier(v string) *RouterType {
+	s.RouterTypeIdentifier = &v
+	return s
+}
+
+// SetSoftware sets the Software field's value.
+func (s *RouterType) SetSoftware(v string) *RouterType {
+	s.Software = &v
+	return s
+}
+
+// SetVendor sets the Vendor field's value.
+func (s *RouterType) SetVendor(v string) *RouterType {
+	s.Vendor = &v
+	return s
+}
+
+// SetXsltTemplateName sets the XsltTemplateName field's value.
+func (s *RouterType) SetXsltTemplateName(v string) *RouterType {
+	s.XsltTemplateName = &v
+	return s
+}
+
+// SetXsltTemplateNameForMacSec sets the XsltTemplateNameForMacSec field's value.
+func (s *RouterType) SetXsltTemplateNameForMacSec(v string) *RouterType {
+	s.XsltTemplateNameForMacSec = &v
+	return s
+}
+
+// A server-side error occurred.
+type ServerException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServerException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServerException) GoString() string {
+	return s.String()
+}
+
+func newErrorServerException(v protocol.ResponseMetadata) error {
+	return &ServerException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ServerException) Code() string {
+	return "DirectConnectServerException"
+}
+
+// Message returns the exception's message.
+func (s *ServerException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ServerException) OrigErr() error {
+	return nil
+}
+
+func (s *ServerException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ServerException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ServerException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type StartBgpFailoverTestInput struct {
+	_ struct{} `type:"structure"`
+
+	// The BGP peers to place in the DOWN state.
+	BgpPeers []*string `locationName:"bgpPeers" type:"list"`
+
+	// The time in minutes that the virtual interface failover test will last.
+	//
+	// Maximum value: 4,320 minutes (72 hours).
+	//
+	// Default: 180 minutes (3 hours).
+	TestDurationInMinutes *int64 `locationName:"testDurationInMinutes" type:"integer"`
+
+	// The ID of the virtual interface you want to test.
+	//
+	// VirtualInterfaceId is a required field
+	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StartBgpFailoverTestInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StartBgpFailoverTestInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *StartBgpFailoverTestInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartBgpFailoverTestInput"}
+	if s.VirtualInterfaceId == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualInterfaceId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetBgpPeers sets the BgpPeers field's value.
+func (s *StartBgpFailoverTestInput) SetBgpPeers(v []*string) *StartBgpFailoverTestInput {
+	s.BgpPeers = v
+	return s
+}
+
+// SetTestDurationInMinutes sets the TestDurationInMinutes field's value.
+func (s *StartBgpFailoverTestInput) SetTestDurationInMinutes(v int64) *StartBgpFailoverTestInput {
+	s.TestDurationInMinutes = &v
+	return s
+}
+
+// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
+func (s *StartBgpFailoverTestInput) SetVirtualInterfaceId(v string) *StartBgpFailoverTestInput {
+	s.VirtualInterfaceId = &v
+	return s
+}
+
+type StartBgpFailoverTestOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Information about the virtual interface failover test.
+	VirtualInterfaceTest *VirtualInterfaceTestHistory `locationName:"virtualInterfaceTest" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StartBgpFailoverTestOutput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// Any tags assigned to the transit virtual interface.
-	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StartBgpFailoverTestOutput) GoString() string {
+	return s.String()
+}
 
-	// The name of the virtual interface assigned by the customer network.
-	VirtualInterfaceName *string `locationName:"virtualInterfaceName" type:"string"`
+// SetVirtualInterfaceTest sets the VirtualInterfaceTest field's value.
+func (s *StartBgpFailoverTestOutput) SetVirtualInterfaceTest(v *VirtualInterfaceTestHistory) *StartBgpFailoverTestOutput {
+	s.VirtualInterfaceTest = v
+	return s
+}
 
-	// The ID of the VLAN.
-	Vlan *int64 `locationName:"vlan" type:"integer"`
+type StopBgpFailoverTestInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ID of the virtual interface you no longer want to test.
+	//
+	// VirtualInterfaceId is a required field
+	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s NewTransitVirtualInterface) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StopBgpFailoverTestInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s NewTransitVirtualInterface) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StopBgpFailoverTestInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *NewTransitVirtualInterface) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "NewTransitVirtualInterface"}
-	if s.Tags != nil && len(s.Tags) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
-	}
-	if s.Tags != nil {
-		for i, v := range s.Tags {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
-			}
-		}
+func (s *StopBgpFailoverTestInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "StopBgpFailoverTestInput"}
+	if s.VirtualInterfaceId == nil {
+		invalidParams.Add(request.NewErrParamRequired("VirtualInterfaceId"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -10104,112 +13404,143 @@ func (s *NewTransitVirtualInterface) Validate() error {
 	return nil
 }
 
-// SetAddressFamily sets the AddressFamily field's value.
-func (s *NewTransitVirtualInterface) SetAddressFamily(v string) *NewTransitVirtualInterface {
-	s.AddressFamily = &v
+// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
+func (s *StopBgpFailoverTestInput) SetVirtualInterfaceId(v string) *StopBgpFailoverTestInput {
+	s.VirtualInterfaceId = &v
 	return s
 }
 
-// SetAmazonAddress sets the AmazonAddress field's value.
-func (s *NewTransitVirtualInterface) SetAmazonAddress(v string) *NewTransitVirtualInterface {
-	s.AmazonAddress = &v
-	return s
+type StopBgpFailoverTestOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Information about the virtual interface failover test.
+	VirtualInterfaceTest *VirtualInterfaceTestHistory `locationName:"virtualInterfaceTest" type:"structure"`
 }
 
-// SetAsn sets the Asn field's value.
-func (s *NewTransitVirtualInterface) SetAsn(v int64) *NewTransitVirtualInterface {
-	s.Asn = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StopBgpFailoverTestOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetAuthKey sets the AuthKey field's value.
-func (s *NewTransitVirtualInterface) SetAuthKey(v string) *NewTransitVirtualInterface {
-	s.AuthKey = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StopBgpFailoverTestOutput) GoString() string {
+	return s.String()
 }
 
-// SetCustomerAddress sets the CustomerAddress field's value.
-func (s *NewTransitVirtualInterface) SetCustomerAddress(v string) *NewTransitVirtualInterface {
-	s.CustomerAddress = &v
+// SetVirtualInterfaceTest sets the VirtualInterfaceTest field's value.
+func (s *StopBgpFailoverTestOutput) SetVirtualInterfaceTest(v *VirtualInterfaceTestHistory) *StopBgpFailoverTestOutput {
+	s.VirtualInterfaceTest = v
 	return s
 }
 
-// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
-func (s *NewTransitVirtualInterface) SetDirectConnectGatewayId(v string) *NewTransitVirtualInterface {
-	s.DirectConnectGatewayId = &v
-	return s
+// Information about a tag.
+type Tag struct {
+	_ struct{} `type:"structure"`
+
+	// The key.
+	//
+	// Key is a required field
+	Key *string `locationName:"key" min:"1" type:"string" required:"true"`
+
+	// The value.
+	Value *string `locationName:"value" type:"string"`
 }
 
-// SetMtu sets the Mtu field's value.
-func (s *NewTransitVirtualInterface) SetMtu(v int64) *NewTransitVirtualInterface {
-	s.Mtu = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Tag) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetTags sets the Tags field's value.
-func (s *NewTransitVirtualInterface) SetTags(v []*Tag) *NewTransitVirtualInterface {
-	s.Tags = v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Tag) GoString() string {
+	return s.String()
 }
 
-// SetVirtualInterfaceName sets the VirtualInterfaceName field's value.
-func (s *NewTransitVirtualInterface) SetVirtualInterfaceName(v string) *NewTransitVirtualInterface {
-	s.VirtualInterfaceName = &v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *Tag) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key == nil {
+		invalidParams.Add(request.NewErrParamRequired("Key"))
+	}
+	if s.Key != nil && len(*s.Key) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Key", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetKey sets the Key field's value.
+func (s *Tag) SetKey(v string) *Tag {
+	s.Key = &v
 	return s
 }
 
-// SetVlan sets the Vlan field's value.
-func (s *NewTransitVirtualInterface) SetVlan(v int64) *NewTransitVirtualInterface {
-	s.Vlan = &v
+// SetValue sets the Value field's value.
+func (s *Tag) SetValue(v string) *Tag {
+	s.Value = &v
 	return s
 }
 
-// Information about a transit virtual interface.
-type NewTransitVirtualInterfaceAllocation struct {
+type TagResourceInput struct {
 	_ struct{} `type:"structure"`
 
-	// The address family for the BGP peer.
-	AddressFamily *string `locationName:"addressFamily" type:"string" enum:"AddressFamily"`
-
-	// The IP address assigned to the Amazon interface.
-	AmazonAddress *string `locationName:"amazonAddress" type:"string"`
-
-	// The autonomous system (AS) number for Border Gateway Protocol (BGP) configuration.
-	Asn *int64 `locationName:"asn" type:"integer"`
-
-	// The authentication key for BGP configuration.
-	AuthKey *string `locationName:"authKey" type:"string"`
-
-	// The IP address assigned to the customer interface.
-	CustomerAddress *string `locationName:"customerAddress" type:"string"`
-
-	// The maximum transmission unit (MTU), in bytes. The supported values are 1500
-	// and 8500. The default value is 1500.
-	Mtu *int64 `locationName:"mtu" type:"integer"`
-
-	// Any tags assigned to the transit virtual interface.
-	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
-
-	// The name of the virtual interface assigned by the customer network.
-	VirtualInterfaceName *string `locationName:"virtualInterfaceName" type:"string"`
+	// The Amazon Resource Name (ARN) of the resource.
+	//
+	// ResourceArn is a required field
+	ResourceArn *string `locationName:"resourceArn" type:"string" required:"true"`
 
-	// The ID of the VLAN.
-	Vlan *int64 `locationName:"vlan" type:"integer"`
+	// The tags to add.
+	//
+	// Tags is a required field
+	Tags []*Tag `locationName:"tags" min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
-func (s NewTransitVirtualInterfaceAllocation) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s NewTransitVirtualInterfaceAllocation) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *NewTransitVirtualInterfaceAllocation) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "NewTransitVirtualInterfaceAllocation"}
+func (s *TagResourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "TagResourceInput"}
+	if s.ResourceArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceArn"))
+	}
+	if s.Tags == nil {
+		invalidParams.Add(request.NewErrParamRequired("Tags"))
+	}
 	if s.Tags != nil && len(s.Tags) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
 	}
@@ -10230,150 +13561,228 @@ func (s *NewTransitVirtualInterfaceAllocation) Validate() error {
 	return nil
 }
 
-// SetAddressFamily sets the AddressFamily field's value.
-func (s *NewTransitVirtualInterfaceAllocation) SetAddressFamily(v string) *NewTransitVirtualInterfaceAllocation {
-	s.AddressFamily = &v
+// SetResourceArn sets the ResourceArn field's value.
+func (s *TagResourceInput) SetResourceArn(v string) *TagResourceInput {
+	s.ResourceArn = &v
 	return s
 }
 
-// SetAmazonAddress sets the AmazonAddress field's value.
-func (s *NewTransitVirtualInterfaceAllocation) SetAmazonAddress(v string) *NewTransitVirtualInterfaceAllocation {
-	s.AmazonAddress = &v
+// SetTags sets the Tags field's value.
+func (s *TagResourceInput) SetTags(v []*Tag) *TagResourceInput {
+	s.Tags = v
 	return s
 }
 
-// SetAsn sets the Asn field's value.
-func (s *NewTransitVirtualInterfaceAllocation) SetAsn(v int64) *NewTransitVirtualInterfaceAllocation {
-	s.Asn = &v
-	return s
+type TagResourceOutput struct {
+	_ struct{} `type:"structure"`
 }
 
-// SetAuthKey sets the AuthKey field's value.
-func (s *NewTransitVirtualInterfaceAllocation) SetAuthKey(v string) *NewTransitVirtualInterfaceAllocation {
-	s.AuthKey = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetCustomerAddress sets the CustomerAddress field's value.
-func (s *NewTransitVirtualInterfaceAllocation) SetCustomerAddress(v string) *NewTransitVirtualInterfaceAllocation {
-	s.CustomerAddress = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceOutput) GoString() string {
+	return s.String()
 }
 
-// SetMtu sets the Mtu field's value.
-func (s *NewTransitVirtualInterfaceAllocation) SetMtu(v int64) *NewTransitVirtualInterfaceAllocation {
-	s.Mtu = &v
-	return s
+// You have reached the limit on the number of tags that can be assigned.
+type TooManyTagsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// SetTags sets the Tags field's value.
-func (s *NewTransitVirtualInterfaceAllocation) SetTags(v []*Tag) *NewTransitVirtualInterfaceAllocation {
-	s.Tags = v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyTagsException) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetVirtualInterfaceName sets the VirtualInterfaceName field's value.
-func (s *NewTransitVirtualInterfaceAllocation) SetVirtualInterfaceName(v string) *NewTransitVirtualInterfaceAllocation {
-	s.VirtualInterfaceName = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyTagsException) GoString() string {
+	return s.String()
 }
 
-// SetVlan sets the Vlan field's value.
-func (s *NewTransitVirtualInterfaceAllocation) SetVlan(v int64) *NewTransitVirtualInterfaceAllocation {
-	s.Vlan = &v
-	return s
+func newErrorTooManyTagsException(v protocol.ResponseMetadata) error {
+	return &TooManyTagsException{
+		RespMetadata: v,
+	}
 }
 
-// Information about a tag associated with an AWS Direct Connect resource.
-type ResourceTag struct {
+// Code returns the exception type name.
+func (s *TooManyTagsException) Code() string {
+	return "TooManyTagsException"
+}
+
+// Message returns the exception's message.
+func (s *TooManyTagsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *TooManyTagsException) OrigErr() error {
+	return nil
+}
+
+func (s *TooManyTagsException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *TooManyTagsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *TooManyTagsException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type UntagResourceInput struct {
 	_ struct{} `type:"structure"`
 
 	// The Amazon Resource Name (ARN) of the resource.
-	ResourceArn *string `locationName:"resourceArn" type:"string"`
+	//
+	// ResourceArn is a required field
+	ResourceArn *string `locationName:"resourceArn" type:"string" required:"true"`
 
-	// The tags.
-	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
+	// The tag keys of the tags to remove.
+	//
+	// TagKeys is a required field
+	TagKeys []*string `locationName:"tagKeys" type:"list" required:"true"`
 }
 
-// String returns the string representation
-func (s ResourceTag) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ResourceTag) GoString() string {
-	return s.String()
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *UntagResourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UntagResourceInput"}
+	if s.ResourceArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceArn"))
+	}
+	if s.TagKeys == nil {
+		invalidParams.Add(request.NewErrParamRequired("TagKeys"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
 // SetResourceArn sets the ResourceArn field's value.
-func (s *ResourceTag) SetResourceArn(v string) *ResourceTag {
+func (s *UntagResourceInput) SetResourceArn(v string) *UntagResourceInput {
 	s.ResourceArn = &v
 	return s
 }
 
-// SetTags sets the Tags field's value.
-func (s *ResourceTag) SetTags(v []*Tag) *ResourceTag {
-	s.Tags = v
+// SetTagKeys sets the TagKeys field's value.
+func (s *UntagResourceInput) SetTagKeys(v []*string) *UntagResourceInput {
+	s.TagKeys = v
 	return s
 }
 
-// Information about a route filter prefix that a customer can advertise through
-// Border Gateway Protocol (BGP) over a public virtual interface.
-type RouteFilterPrefix struct {
+type UntagResourceOutput struct {
 	_ struct{} `type:"structure"`
-
-	// The CIDR block for the advertised route. Separate multiple routes using commas.
-	// An IPv6 CIDR must use /64 or shorter.
-	Cidr *string `locationName:"cidr" type:"string"`
 }
 
-// String returns the string representation
-func (s RouteFilterPrefix) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s RouteFilterPrefix) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceOutput) GoString() string {
 	return s.String()
 }
 
-// SetCidr sets the Cidr field's value.
-func (s *RouteFilterPrefix) SetCidr(v string) *RouteFilterPrefix {
-	s.Cidr = &v
-	return s
-}
-
-// Information about a tag.
-type Tag struct {
+type UpdateConnectionInput struct {
 	_ struct{} `type:"structure"`
 
-	// The key.
+	// The ID of the dedicated connection.
 	//
-	// Key is a required field
-	Key *string `locationName:"key" min:"1" type:"string" required:"true"`
+	// You can use DescribeConnections to retrieve the connection ID.
+	//
+	// ConnectionId is a required field
+	ConnectionId *string `locationName:"connectionId" type:"string" required:"true"`
 
-	// The value.
-	Value *string `locationName:"value" type:"string"`
+	// The name of the connection.
+	ConnectionName *string `locationName:"connectionName" type:"string"`
+
+	// The connection MAC Security (MACsec) encryption mode.
+	//
+	// The valid values are no_encrypt, should_encrypt, and must_encrypt.
+	EncryptionMode *string `locationName:"encryptionMode" type:"string"`
 }
 
-// String returns the string representation
-func (s Tag) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateConnectionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Tag) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateConnectionInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *Tag) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "Tag"}
-	if s.Key == nil {
-		invalidParams.Add(request.NewErrParamRequired("Key"))
-	}
-	if s.Key != nil && len(*s.Key) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Key", 1))
+func (s *UpdateConnectionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateConnectionInput"}
+	if s.ConnectionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionId"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -10382,161 +13791,273 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
-// SetKey sets the Key field's value.
-func (s *Tag) SetKey(v string) *Tag {
-	s.Key = &v
+// SetConnectionId sets the ConnectionId field's value.
+func (s *UpdateConnectionInput) SetConnectionId(v string) *UpdateConnectionInput {
+	s.ConnectionId = &v
 	return s
 }
 
-// SetValue sets the Value field's value.
-func (s *Tag) SetValue(v string) *Tag {
-	s.Value = &v
+// SetConnectionName sets the ConnectionName field's value.
+func (s *UpdateConnectionInput) SetConnectionName(v string) *UpdateConnectionInput {
+	s.ConnectionName = &v
 	return s
 }
 
-type TagResourceInput struct {
+// SetEncryptionMode sets the EncryptionMode field's value.
+func (s *UpdateConnectionInput) SetEncryptionMode(v string) *UpdateConnectionInput {
+	s.EncryptionMode = &v
+	return s
+}
+
+// Information about an Direct Connect connection.
+type UpdateConnectionOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the resource.
+	// The Direct Connect endpoint on which the physical connection terminates.
+	AwsDevice *string `locationName:"awsDevice" deprecated:"true" type:"string"`
+
+	// The Direct Connect endpoint that terminates the physical connection.
+	AwsDeviceV2 *string `locationName:"awsDeviceV2" type:"string"`
+
+	// The Direct Connect endpoint that terminates the logical connection. This
+	// device might be different than the device that terminates the physical connection.
+	AwsLogicalDeviceId *string `locationName:"awsLogicalDeviceId" type:"string"`
+
+	// The bandwidth of the connection.
+	Bandwidth *string `locationName:"bandwidth" type:"string"`
+
+	// The ID of the connection.
+	ConnectionId *string `locationName:"connectionId" type:"string"`
+
+	// The name of the connection.
+	ConnectionName *string `locationName:"connectionName" type:"string"`
+
+	// The state of the connection. The following are the possible values:
 	//
-	// ResourceArn is a required field
-	ResourceArn *string `locationName:"resourceArn" type:"string" required:"true"`
+	//    * ordering: The initial state of a hosted connection provisioned on an
+	//    interconnect. The connection stays in the ordering state until the owner
+	//    of the hosted connection confirms or declines the connection order.
+	//
+	//    * requested: The initial state of a standard connection. The connection
+	//    stays in the requested state until the Letter of Authorization (LOA) is
+	//    sent to the customer.
+	//
+	//    * pending: The connection has been approved and is being initialized.
+	//
+	//    * available: The network link is up and the connection is ready for use.
+	//
+	//    * down: The network link is down.
+	//
+	//    * deleting: The connection is being deleted.
+	//
+	//    * deleted: The connection has been deleted.
+	//
+	//    * rejected: A hosted connection in the ordering state enters the rejected
+	//    state if it is deleted by the customer.
+	//
+	//    * unknown: The state of the connection is not available.
+	ConnectionState *string `locationName:"connectionState" type:"string" enum:"ConnectionState"`
 
-	// The tags to assign.
+	// The MAC Security (MACsec) connection encryption mode.
 	//
-	// Tags is a required field
-	Tags []*Tag `locationName:"tags" min:"1" type:"list" required:"true"`
+	// The valid values are no_encrypt, should_encrypt, and must_encrypt.
+	EncryptionMode *string `locationName:"encryptionMode" type:"string"`
+
+	// Indicates whether the connection supports a secondary BGP peer in the same
+	// address family (IPv4/IPv6).
+	HasLogicalRedundancy *string `locationName:"hasLogicalRedundancy" type:"string" enum:"HasLogicalRedundancy"`
+
+	// Indicates whether jumbo frames are supported.
+	JumboFrameCapable *bool `locationName:"jumboFrameCapable" type:"boolean"`
+
+	// The ID of the LAG.
+	LagId *string `locationName:"lagId" type:"string"`
+
+	// The time of the most recent call to DescribeLoa for this connection.
+	LoaIssueTime *time.Time `locationName:"loaIssueTime" type:"timestamp"`
+
+	// The location of the connection.
+	Location *string `locationName:"location" type:"string"`
+
+	// Indicates whether the connection supports MAC Security (MACsec).
+	MacSecCapable *bool `locationName:"macSecCapable" type:"boolean"`
+
+	// The MAC Security (MACsec) security keys associated with the connection.
+	MacSecKeys []*MacSecKey `locationName:"macSecKeys" type:"list"`
+
+	// The ID of the Amazon Web Services account that owns the connection.
+	OwnerAccount *string `locationName:"ownerAccount" type:"string"`
+
+	// The name of the Direct Connect service provider associated with the connection.
+	PartnerName *string `locationName:"partnerName" type:"string"`
+
+	// The MAC Security (MACsec) port link status of the connection.
+	//
+	// The valid values are Encryption Up, which means that there is an active Connection
+	// Key Name, or Encryption Down.
+	PortEncryptionStatus *string `locationName:"portEncryptionStatus" type:"string"`
+
+	// The name of the service provider associated with the connection.
+	ProviderName *string `locationName:"providerName" type:"string"`
+
+	// The Amazon Web Services Region where the connection is located.
+	Region *string `locationName:"region" type:"string"`
+
+	// The tags associated with the connection.
+	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
+
+	// The ID of the VLAN.
+	Vlan *int64 `locationName:"vlan" type:"integer"`
 }
 
-// String returns the string representation
-func (s TagResourceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateConnectionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s TagResourceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateConnectionOutput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *TagResourceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "TagResourceInput"}
-	if s.ResourceArn == nil {
-		invalidParams.Add(request.NewErrParamRequired("ResourceArn"))
-	}
-	if s.Tags == nil {
-		invalidParams.Add(request.NewErrParamRequired("Tags"))
-	}
-	if s.Tags != nil && len(s.Tags) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
-	}
-	if s.Tags != nil {
-		for i, v := range s.Tags {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
-			}
-		}
-	}
+// SetAwsDevice sets the AwsDevice field's value.
+func (s *UpdateConnectionOutput) SetAwsDevice(v string) *UpdateConnectionOutput {
+	s.AwsDevice = &v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetAwsDeviceV2 sets the AwsDeviceV2 field's value.
+func (s *UpdateConnectionOutput) SetAwsDeviceV2(v string) *UpdateConnectionOutput {
+	s.AwsDeviceV2 = &v
+	return s
 }
 
-// SetResourceArn sets the ResourceArn field's value.
-func (s *TagResourceInput) SetResourceArn(v string) *TagResourceInput {
-	s.ResourceArn = &v
+// SetAwsLogicalDeviceId sets the AwsLogicalDeviceId field's value.
+func (s *UpdateConnectionOutput) SetAwsLogicalDeviceId(v string) *UpdateConnectionOutput {
+	s.AwsLogicalDeviceId = &v
 	return s
 }
 
-// SetTags sets the Tags field's value.
-func (s *TagResourceInput) SetTags(v []*Tag) *TagResourceInput {
-	s.Tags = v
+// SetBandwidth sets the Bandwidth field's value.
+func (s *UpdateConnectionOutput) SetBandwidth(v string) *UpdateConnectionOutput {
+	s.Bandwidth = &v
 	return s
 }
 
-type TagResourceOutput struct {
-	_ struct{} `type:"structure"`
+// SetConnectionId sets the ConnectionId field's value.
+func (s *UpdateConnectionOutput) SetConnectionId(v string) *UpdateConnectionOutput {
+	s.ConnectionId = &v
+	return s
 }
 
-// String returns the string representation
-func (s TagResourceOutput) String() string {
-	return awsutil.Prettify(s)
+// SetConnectionName sets the ConnectionName field's value.
+func (s *UpdateConnectionOutput) SetConnectionName(v string) *UpdateConnectionOutput {
+	s.ConnectionName = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s TagResourceOutput) GoString() string {
-	return s.String()
+// SetConnectionState sets the ConnectionState field's value.
+func (s *UpdateConnectionOutput) SetConnectionState(v string) *UpdateConnectionOutput {
+	s.ConnectionState = &v
+	return s
 }
 
-type UntagResourceInput struct {
-	_ struct{} `type:"structure"`
+// SetEncryptionMode sets the EncryptionMode field's value.
+func (s *UpdateConnectionOutput) SetEncryptionMode(v string) *UpdateConnectionOutput {
+	s.EncryptionMode = &v
+	return s
+}
 
-	// The Amazon Resource Name (ARN) of the resource.
-	//
-	// ResourceArn is a required field
-	ResourceArn *string `locationName:"resourceArn" type:"string" required:"true"`
+// SetHasLogicalRedundancy sets the HasLogicalRedundancy field's value.
+func (s *UpdateConnectionOutput) SetHasLogicalRedundancy(v string) *UpdateConnectionOutput {
+	s.HasLogicalRedundancy = &v
+	return s
+}
 
-	// The tag keys of the tags to remove.
-	//
-	// TagKeys is a required field
-	TagKeys []*string `locationName:"tagKeys" type:"list" required:"true"`
+// SetJumboFrameCapable sets the JumboFrameCapable field's value.
+func (s *UpdateConnectionOutput) SetJumboFrameCapable(v bool) *UpdateConnectionOutput {
+	s.JumboFrameCapable = &v
+	return s
 }
 
-// String returns the string representation
-func (s UntagResourceInput) String() string {
-	return awsutil.Prettify(s)
+// SetLagId sets the LagId field's value.
+func (s *UpdateConnectionOutput) SetLagId(v string) *UpdateConnectionOutput {
+	s.LagId = &v
+	return s
+}
+
+// SetLoaIssueTime sets the LoaIssueTime field's value.
+func (s *UpdateConnectionOutput) SetLoaIssueTime(v time.Time) *UpdateConnectionOutput {
+	s.LoaIssueTime = &v
+	return s
+}
+
+// SetLocation sets the Location field's value.
+func (s *UpdateConnectionOutput) SetLocation(v string) *UpdateConnectionOutput {
+	s.Location = &v
+	return s
+}
+
+// SetMacSecCapable sets the MacSecCapable field's value.
+func (s *UpdateConnectionOutput) SetMacSecCapable(v bool) *UpdateConnectionOutput {
+	s.MacSecCapable = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s UntagResourceInput) GoString() string {
-	return s.String()
+// SetMacSecKeys sets the MacSecKeys field's value.
+func (s *UpdateConnectionOutput) SetMacSecKeys(v []*MacSecKey) *UpdateConnectionOutput {
+	s.MacSecKeys = v
+	return s
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *UntagResourceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "UntagResourceInput"}
-	if s.ResourceArn == nil {
-		invalidParams.Add(request.NewErrParamRequired("ResourceArn"))
-	}
-	if s.TagKeys == nil {
-		invalidParams.Add(request.NewErrParamRequired("TagKeys"))
-	}
+// SetOwnerAccount sets the OwnerAccount field's value.
+func (s *UpdateConnectionOutput) SetOwnerAccount(v string) *UpdateConnectionOutput {
+	s.OwnerAccount = &v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetPartnerName sets the PartnerName field's value.
+func (s *UpdateConnectionOutput) SetPartnerName(v string) *UpdateConnectionOutput {
+	s.PartnerName = &v
+	return s
 }
 
-// SetResourceArn sets the ResourceArn field's value.
-func (s *UntagResourceInput) SetResourceArn(v string) *UntagResourceInput {
-	s.ResourceArn = &v
+// SetPortEncryptionStatus sets the PortEncryptionStatus field's value.
+func (s *UpdateConnectionOutput) SetPortEncryptionStatus(v string) *UpdateConnectionOutput {
+	s.PortEncryptionStatus = &v
 	return s
 }
 
-// SetTagKeys sets the TagKeys field's value.
-func (s *UntagResourceInput) SetTagKeys(v []*string) *UntagResourceInput {
-	s.TagKeys = v
+// SetProviderName sets the ProviderName field's value.
+func (s *UpdateConnectionOutput) SetProviderName(v string) *UpdateConnectionOutput {
+	s.ProviderName = &v
 	return s
 }
 
-type UntagResourceOutput struct {
-	_ struct{} `type:"structure"`
+// SetRegion sets the Region field's value.
+func (s *UpdateConnectionOutput) SetRegion(v string) *UpdateConnectionOutput {
+	s.Region = &v
+	return s
 }
 
-// String returns the string representation
-func (s UntagResourceOutput) String() string {
-	return awsutil.Prettify(s)
+// SetTags sets the Tags field's value.
+func (s *UpdateConnectionOutput) SetTags(v []*Tag) *UpdateConnectionOutput {
+	s.Tags = v
+	return s
 }
 
-// GoString returns the string representation
-func (s UntagResourceOutput) GoString() string {
-	return s.String()
+// SetVlan sets the Vlan field's value.
+func (s *UpdateConnectionOutput) SetVlan(v int64) *UpdateConnectionOutput {
+	s.Vlan = &v
+	return s
 }
 
 type UpdateDirectConnectGatewayAssociationInput struct {
@@ -10552,12 +14073,20 @@ type UpdateDirectConnectGatewayAssociationInput struct {
 	RemoveAllowedPrefixesToDirectConnectGateway []*RouteFilterPrefix `locationName:"removeAllowedPrefixesToDirectConnectGateway" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDirectConnectGatewayAssociationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDirectConnectGatewayAssociationInput) GoString() string {
 	return s.String()
 }
@@ -10588,12 +14117,20 @@ type UpdateDirectConnectGatewayAssociationOutput struct {
 	DirectConnectGatewayAssociation *GatewayAssociation `locationName:"directConnectGatewayAssociation" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDirectConnectGatewayAssociationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDirectConnectGatewayAssociationOutput) GoString() string {
 	return s.String()
 }
@@ -10604,9 +14141,107 @@ func (s *UpdateDirectConnectGatewayAssociationOutput) SetDirectConnectGatewayAss
 	return s
 }
 
+type UpdateDirectConnectGatewayInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ID of the Direct Connect gateway to update.
+	//
+	// DirectConnectGatewayId is a required field
+	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string" required:"true"`
+
+	// The new name for the Direct Connect gateway.
+	//
+	// NewDirectConnectGatewayName is a required field
+	NewDirectConnectGatewayName *string `locationName:"newDirectConnectGatewayName" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateDirectConnectGatewayInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateDirectConnectGatewayInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *UpdateDirectConnectGatewayInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateDirectConnectGatewayInput"}
+	if s.DirectConnectGatewayId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectConnectGatewayId"))
+	}
+	if s.NewDirectConnectGatewayName == nil {
+		invalidParams.Add(request.NewErrParamRequired("NewDirectConnectGatewayName"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDirectConnectGatewayId sets the DirectConnectGatewayId field's value.
+func (s *UpdateDirectConnectGatewayInput) SetDirectConnectGatewayId(v string) *UpdateDirectConnectGatewayInput {
+	s.DirectConnectGatewayId = &v
+	return s
+}
+
+// SetNewDirectConnectGatewayName sets the NewDirectConnectGatewayName field's value.
+func (s *UpdateDirectConnectGatewayInput) SetNewDirectConnectGatewayName(v string) *UpdateDirectConnectGatewayInput {
+	s.NewDirectConnectGatewayName = &v
+	return s
+}
+
+type UpdateDirectConnectGatewayOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Information about a Direct Connect gateway, which enables you to connect
+	// virtual interfaces and virtual private gateway or transit gateways.
+	DirectConnectGateway *Gateway `locationName:"directConnectGateway" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateDirectConnectGatewayOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateDirectConnectGatewayOutput) GoString() string {
+	return s.String()
+}
+
+// SetDirectConnectGateway sets the DirectConnectGateway field's value.
+func (s *UpdateDirectConnectGatewayOutput) SetDirectConnectGateway(v *Gateway) *UpdateDirectConnectGatewayOutput {
+	s.DirectConnectGateway = v
+	return s
+}
+
 type UpdateLagInput struct {
 	_ struct{} `type:"structure"`
 
+	// The LAG MAC Security (MACsec) encryption mode.
+	//
+	// Amazon Web Services applies the value to all connections which are part of
+	// the LAG.
+	EncryptionMode *string `locationName:"encryptionMode" type:"string"`
+
 	// The ID of the LAG.
 	//
 	// LagId is a required field
@@ -10620,12 +14255,20 @@ type UpdateLagInput struct {
 	MinimumLinks *int64 `locationName:"minimumLinks" type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateLagInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateLagInput) GoString() string {
 	return s.String()
 }
@@ -10643,6 +14286,12 @@ func (s *UpdateLagInput) Validate() error {
 	return nil
 }
 
+// SetEncryptionMode sets the EncryptionMode field's value.
+func (s *UpdateLagInput) SetEncryptionMode(v string) *UpdateLagInput {
+	s.EncryptionMode = &v
+	return s
+}
+
 // SetLagId sets the LagId field's value.
 func (s *UpdateLagInput) SetLagId(v string) *UpdateLagInput {
 	s.LagId = &v
@@ -10664,6 +14313,9 @@ func (s *UpdateLagInput) SetMinimumLinks(v int64) *UpdateLagInput {
 type UpdateVirtualInterfaceAttributesInput struct {
 	_ struct{} `type:"structure"`
 
+	// Indicates whether to enable or disable SiteLink.
+	EnableSiteLink *bool `locationName:"enableSiteLink" type:"boolean"`
+
 	// The maximum transmission unit (MTU), in bytes. The supported values are 1500
 	// and 9001. The default value is 1500.
 	Mtu *int64 `locationName:"mtu" type:"integer"`
@@ -10672,14 +14324,25 @@ type UpdateVirtualInterfaceAttributesInput struct {
 	//
 	// VirtualInterfaceId is a required field
 	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string" required:"true"`
+
+	// The name of the virtual private interface.
+	VirtualInterfaceName *string `locationName:"virtualInterfaceName" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateVirtualInterfaceAttributesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateVirtualInterfaceAttributesInput) GoString() string {
 	return s.String()
 }
@@ -10697,6 +14360,12 @@ func (s *UpdateVirtualInterfaceAttributesInput) Validate() error {
 	return nil
 }
 
+// SetEnableSiteLink sets the EnableSiteLink field's value.
+func (s *UpdateVirtualInterfaceAttributesInput) SetEnableSiteLink(v bool) *UpdateVirtualInterfaceAttributesInput {
+	s.EnableSiteLink = &v
+	return s
+}
+
 // SetMtu sets the Mtu field's value.
 func (s *UpdateVirtualInterfaceAttributesInput) SetMtu(v int64) *UpdateVirtualInterfaceAttributesInput {
 	s.Mtu = &v
@@ -10709,6 +14378,12 @@ func (s *UpdateVirtualInterfaceAttributesInput) SetVirtualInterfaceId(v string)
 	return s
 }
 
+// SetVirtualInterfaceName sets the VirtualInterfaceName field's value.
+func (s *UpdateVirtualInterfaceAttributesInput) SetVirtualInterfaceName(v string) *UpdateVirtualInterfaceAttributesInput {
+	s.VirtualInterfaceName = &v
+	return s
+}
+
 // Information about a virtual interface.
 type UpdateVirtualInterfaceAttributesOutput struct {
 	_ struct{} `type:"structure"`
@@ -10723,15 +14398,21 @@ type UpdateVirtualInterfaceAttributesOutput struct {
 	AmazonSideAsn *int64 `locationName:"amazonSideAsn" type:"long"`
 
 	// The autonomous system (AS) number for Border Gateway Protocol (BGP) configuration.
+	//
+	// The valid values are 1-2147483647.
 	Asn *int64 `locationName:"asn" type:"integer"`
 
 	// The authentication key for BGP configuration. This string has a minimum length
 	// of 6 characters and and a maximun lenth of 80 characters.
 	AuthKey *string `locationName:"authKey" type:"string"`
 
-	// The Direct Connect endpoint on which the virtual interface terminates.
+	// The Direct Connect endpoint that terminates the physical connection.
 	AwsDeviceV2 *string `locationName:"awsDeviceV2" type:"string"`
 
+	// The Direct Connect endpoint that terminates the logical connection. This
+	// device might be different than the device that terminates the physical connection.
+	AwsLogicalDeviceId *string `locationName:"awsLogicalDeviceId" type:"string"`
+
 	// The BGP peers configured on this virtual interface.
 	BgpPeers []*BGPPeer `locationName:"bgpPeers" type:"list"`
 
@@ -10747,36 +14428,41 @@ type UpdateVirtualInterfaceAttributesOutput struct {
 	// The ID of the Direct Connect gateway.
 	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
 
-	// Indicates whether jumbo frames (9001 MTU) are supported.
+	// Indicates whether jumbo frames are supported.
 	JumboFrameCapable *bool `locationName:"jumboFrameCapable" type:"boolean"`
 
 	// The location of the connection.
 	Location *string `locationName:"location" type:"string"`
 
 	// The maximum transmission unit (MTU), in bytes. The supported values are 1500
-	// and 9001. The default value is 1500.
+	// and 8500. The default value is 1500
 	Mtu *int64 `locationName:"mtu" type:"integer"`
 
-	// The ID of the AWS account that owns the virtual interface.
+	// The ID of the Amazon Web Services account that owns the virtual interface.
 	OwnerAccount *string `locationName:"ownerAccount" type:"string"`
 
-	// The AWS Region where the virtual interface is located.
+	// The Amazon Web Services Region where the virtual interface is located.
 	Region *string `locationName:"region" type:"string"`
 
-	// The routes to be advertised to the AWS network in this Region. Applies to
-	// public virtual interfaces.
+	// The routes to be advertised to the Amazon Web Services network in this Region.
+	// Applies to public virtual interfaces.
 	RouteFilterPrefixes []*RouteFilterPrefix `locationName:"routeFilterPrefixes" type:"list"`
 
-	// Any tags assigned to the virtual interface.
+	// Indicates whether SiteLink is enabled.
+	SiteLinkEnabled *bool `locationName:"siteLinkEnabled" type:"boolean"`
+
+	// The tags associated with the virtual interface.
 	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
 
 	// The ID of the virtual private gateway. Applies only to private virtual interfaces.
-	VirtualGatewayId *string `locationName:"virtualGatewayId" deprecated:"true" type:"string"`
+	VirtualGatewayId *string `locationName:"virtualGatewayId" type:"string"`
 
 	// The ID of the virtual interface.
 	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string"`
 
-	// The name of the virtual interface assigned by the customer network.
+	// The name of the virtual interface assigned by the customer network. The name
+	// has a maximum of 100 characters. The following are valid characters: a-z,
+	// 0-9 and a hyphen (-).
 	VirtualInterfaceName *string `locationName:"virtualInterfaceName" type:"string"`
 
 	// The state of the virtual interface. The following are the possible values:
@@ -10818,12 +14504,20 @@ type UpdateVirtualInterfaceAttributesOutput struct {
 	Vlan *int64 `locationName:"vlan" type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateVirtualInterfaceAttributesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateVirtualInterfaceAttributesOutput) GoString() string {
 	return s.String()
 }
@@ -10864,6 +14558,12 @@ func (s *UpdateVirtualInterfaceAttributesOutput) SetAwsDeviceV2(v string) *Updat
 	return s
 }
 
+// SetAwsLogicalDeviceId sets the AwsLogicalDeviceId field's value.
+func (s *UpdateVirtualInterfaceAttributesOutput) SetAwsLogicalDeviceId(v string) *UpdateVirtualInterfaceAttributesOutput {
+	s.AwsLogicalDeviceId = &v
+	return s
+}
+
 // SetBgpPeers sets the BgpPeers field's value.
 func (s *UpdateVirtualInterfaceAttributesOutput) SetBgpPeers(v []*BGPPeer) *UpdateVirtualInterfaceAttributesOutput {
 	s.BgpPeers = v
@@ -10930,6 +14630,12 @@ func (s *UpdateVirtualInterfaceAttributesOutput) SetRouteFilterPrefixes(v []*Rou
 	return s
 }
 
+// SetSiteLinkEnabled sets the SiteLinkEnabled field's value.
+func (s *UpdateVirtualInterfaceAttributesOutput) SetSiteLinkEnabled(v bool) *UpdateVirtualInterfaceAttributesOutput {
+	s.SiteLinkEnabled = &v
+	return s
+}
+
 // SetTags sets the Tags field's value.
 func (s *UpdateVirtualInterfaceAttributesOutput) SetTags(v []*Tag) *UpdateVirtualInterfaceAttributesOutput {
 	s.Tags = v
@@ -10977,7 +14683,7 @@ type VirtualGateway struct {
 	_ struct{} `type:"structure"`
 
 	// The ID of the virtual private gateway.
-	VirtualGatewayId *string `locationName:"virtualGatewayId" deprecated:"true" type:"string"`
+	VirtualGatewayId *string `locationName:"virtualGatewayId" type:"string"`
 
 	// The state of the virtual private gateway. The following are the possible
 	// values:
@@ -10993,12 +14699,20 @@ type VirtualGateway struct {
 	VirtualGatewayState *string `locationName:"virtualGatewayState" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualGateway) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualGateway) GoString() string {
 	return s.String()
 }
@@ -11029,15 +14743,21 @@ type VirtualInterface struct {
 	AmazonSideAsn *int64 `locationName:"amazonSideAsn" type:"long"`
 
 	// The autonomous system (AS) number for Border Gateway Protocol (BGP) configuration.
+	//
+	// The valid values are 1-2147483647.
 	Asn *int64 `locationName:"asn" type:"integer"`
 
 	// The authentication key for BGP configuration. This string has a minimum length
 	// of 6 characters and and a maximun lenth of 80 characters.
 	AuthKey *string `locationName:"authKey" type:"string"`
 
-	// The Direct Connect endpoint on which the virtual interface terminates.
+	// The Direct Connect endpoint that terminates the physical connection.
 	AwsDeviceV2 *string `locationName:"awsDeviceV2" type:"string"`
 
+	// The Direct Connect endpoint that terminates the logical connection. This
+	// device might be different than the device that terminates the physical connection.
+	AwsLogicalDeviceId *string `locationName:"awsLogicalDeviceId" type:"string"`
+
 	// The BGP peers configured on this virtual interface.
 	BgpPeers []*BGPPeer `locationName:"bgpPeers" type:"list"`
 
@@ -11053,36 +14773,41 @@ type VirtualInterface struct {
 	// The ID of the Direct Connect gateway.
 	DirectConnectGatewayId *string `locationName:"directConnectGatewayId" type:"string"`
 
-	// Indicates whether jumbo frames (9001 MTU) are supported.
+	// Indicates whether jumbo frames are supported.
 	JumboFrameCapable *bool `locationName:"jumboFrameCapable" type:"boolean"`
 
 	// The location of the connection.
 	Location *string `locationName:"location" type:"string"`
 
 	// The maximum transmission unit (MTU), in bytes. The supported values are 1500
-	// and 9001. The default value is 1500.
+	// and 8500. The default value is 1500
 	Mtu *int64 `locationName:"mtu" type:"integer"`
 
-	// The ID of the AWS account that owns the virtual interface.
+	// The ID of the Amazon Web Services account that owns the virtual interface.
 	OwnerAccount *string `locationName:"ownerAccount" type:"string"`
 
-	// The AWS Region where the virtual interface is located.
+	// The Amazon Web Services Region where the virtual interface is located.
 	Region *string `locationName:"region" type:"string"`
 
-	// The routes to be advertised to the AWS network in this Region. Applies to
-	// public virtual interfaces.
+	// The routes to be advertised to the Amazon Web Services network in this Region.
+	// Applies to public virtual interfaces.
 	RouteFilterPrefixes []*RouteFilterPrefix `locationName:"routeFilterPrefixes" type:"list"`
 
-	// Any tags assigned to the virtual interface.
+	// Indicates whether SiteLink is enabled.
+	SiteLinkEnabled *bool `locationName:"siteLinkEnabled" type:"boolean"`
+
+	// The tags associated with the virtual interface.
 	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
 
 	// The ID of the virtual private gateway. Applies only to private virtual interfaces.
-	VirtualGatewayId *string `locationName:"virtualGatewayId" deprecated:"true" type:"string"`
+	VirtualGatewayId *string `locationName:"virtualGatewayId" type:"string"`
 
 	// The ID of the virtual interface.
 	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string"`
 
-	// The name of the virtual interface assigned by the customer network.
+	// The name of the virtual interface assigned by the customer network. The name
+	// has a maximum of 100 characters. The following are valid characters: a-z,
+	// 0-9 and a hyphen (-).
 	VirtualInterfaceName *string `locationName:"virtualInterfaceName" type:"string"`
 
 	// The state of the virtual interface. The following are the possible values:
@@ -11124,12 +14849,20 @@ type VirtualInterface struct {
 	Vlan *int64 `locationName:"vlan" type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualInterface) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VirtualInterface) GoString() string {
 	return s.String()
 }
@@ -11170,6 +14903,12 @@ func (s *VirtualInterface) SetAwsDeviceV2(v string) *VirtualInterface {
 	return s
 }
 
+// SetAwsLogicalDeviceId sets the AwsLogicalDeviceId field's value.
+func (s *VirtualInterface) SetAwsLogicalDeviceId(v string) *VirtualInterface {
+	s.AwsLogicalDeviceId = &v
+	return s
+}
+
 // SetBgpPeers sets the BgpPeers field's value.
 func (s *VirtualInterface) SetBgpPeers(v []*BGPPeer) *VirtualInterface {
 	s.BgpPeers = v
@@ -11236,6 +14975,12 @@ func (s *VirtualInterface) SetRouteFilterPrefixes(v []*RouteFilterPrefix) *Virtu
 	return s
 }
 
+// SetSiteLinkEnabled sets the SiteLinkEnabled field's value.
+func (s *VirtualInterface) SetSiteLinkEnabled(v bool) *VirtualInterface {
+	s.SiteLinkEnabled = &v
+	return s
+}
+
 // SetTags sets the Tags field's value.
 func (s *VirtualInterface) SetTags(v []*Tag) *VirtualInterface {
 	s.Tags = v
@@ -11278,6 +15023,102 @@ func (s *VirtualInterface) SetVlan(v int64) *VirtualInterface {
 	return s
 }
 
+// Information about the virtual interface failover test.
+type VirtualInterfaceTestHistory struct {
+	_ struct{} `type:"structure"`
+
+	// The BGP peers that were put in the DOWN state as part of the virtual interface
+	// failover test.
+	BgpPeers []*string `locationName:"bgpPeers" type:"list"`
+
+	// The time that the virtual interface moves out of the DOWN state.
+	EndTime *time.Time `locationName:"endTime" type:"timestamp"`
+
+	// The owner ID of the tested virtual interface.
+	OwnerAccount *string `locationName:"ownerAccount" type:"string"`
+
+	// The time that the virtual interface moves to the DOWN state.
+	StartTime *time.Time `locationName:"startTime" type:"timestamp"`
+
+	// The status of the virtual interface failover test.
+	Status *string `locationName:"status" type:"string"`
+
+	// The time that the virtual interface failover test ran in minutes.
+	TestDurationInMinutes *int64 `locationName:"testDurationInMinutes" type:"integer"`
+
+	// The ID of the virtual interface failover test.
+	TestId *string `locationName:"testId" type:"string"`
+
+	// The ID of the tested virtual interface.
+	VirtualInterfaceId *string `locationName:"virtualInterfaceId" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualInterfaceTestHistory) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VirtualInterfaceTestHistory) GoString() string {
+	return s.String()
+}
+
+// SetBgpPeers sets the BgpPeers field's value.
+func (s *VirtualInterfaceTestHistory) SetBgpPeers(v []*string) *VirtualInterfaceTestHistory {
+	s.BgpPeers = v
+	return s
+}
+
+// SetEndTime sets the EndTime field's value.
+func (s *VirtualInterfaceTestHistory) SetEndTime(v time.Time) *VirtualInterfaceTestHistory {
+	s.EndTime = &v
+	return s
+}
+
+// SetOwnerAccount sets the OwnerAccount field's value.
+func (s *VirtualInterfaceTestHistory) SetOwnerAccount(v string) *VirtualInterfaceTestHistory {
+	s.OwnerAccount = &v
+	return s
+}
+
+// SetStartTime sets the StartTime field's value.
+func (s *VirtualInterfaceTestHistory) SetStartTime(v time.Time) *VirtualInterfaceTestHistory {
+	s.StartTime = &v
+	return s
+}
+
+// SetStatus sets the Status field's value.
+func (s *VirtualInterfaceTestHistory) SetStatus(v string) *VirtualInterfaceTestHistory {
+	s.Status = &v
+	return s
+}
+
+// SetTestDurationInMinutes sets the TestDurationInMinutes field's value.
+func (s *VirtualInterfaceTestHistory) SetTestDurationInMinutes(v int64) *VirtualInterfaceTestHistory {
+	s.TestDurationInMinutes = &v
+	return s
+}
+
+// SetTestId sets the TestId field's value.
+func (s *VirtualInterfaceTestHistory) SetTestId(v string) *VirtualInterfaceTestHistory {
+	s.TestId = &v
+	return s
+}
+
+// SetVirtualInterfaceId sets the VirtualInterfaceId field's value.
+func (s *VirtualInterfaceTestHistory) SetVirtualInterfaceId(v string) *VirtualInterfaceTestHistory {
+	s.VirtualInterfaceId = &v
+	return s
+}
+
 const (
 	// AddressFamilyIpv4 is a AddressFamily enum value
 	AddressFamilyIpv4 = "ipv4"
@@ -11286,6 +15127,14 @@ const (
 	AddressFamilyIpv6 = "ipv6"
 )
 
+// AddressFamily_Values returns all elements of the AddressFamily enum
+func AddressFamily_Values() []string {
+	return []string{
+		AddressFamilyIpv4,
+		AddressFamilyIpv6,
+	}
+}
+
 const (
 	// BGPPeerStateVerifying is a BGPPeerState enum value
 	BGPPeerStateVerifying = "verifying"
@@ -11303,6 +15152,17 @@ const (
 	BGPPeerStateDeleted = "deleted"
 )
 
+// BGPPeerState_Values returns all elements of the BGPPeerState enum
+func BGPPeerState_Values() []string {
+	return []string{
+		BGPPeerStateVerifying,
+		BGPPeerStatePending,
+		BGPPeerStateAvailable,
+		BGPPeerStateDeleting,
+		BGPPeerStateDeleted,
+	}
+}
+
 const (
 	// BGPStatusUp is a BGPStatus enum value
 	BGPStatusUp = "up"
@@ -11314,6 +15174,15 @@ const (
 	BGPStatusUnknown = "unknown"
 )
 
+// BGPStatus_Values returns all elements of the BGPStatus enum
+func BGPStatus_Values() []string {
+	return []string{
+		BGPStatusUp,
+		BGPStatusDown,
+		BGPStatusUnknown,
+	}
+}
+
 const (
 	// ConnectionStateOrdering is a ConnectionState enum value
 	ConnectionStateOrdering = "ordering"
@@ -11343,6 +15212,21 @@ const (
 	ConnectionStateUnknown = "unknown"
 )
 
+// ConnectionState_Values returns all elements of the ConnectionState enum
+func ConnectionState_Values() []string {
+	return []string{
+		ConnectionStateOrdering,
+		ConnectionStateRequested,
+		ConnectionStatePending,
+		ConnectionStateAvailable,
+		ConnectionStateDown,
+		ConnectionStateDeleting,
+		ConnectionStateDeleted,
+		ConnectionStateRejected,
+		ConnectionStateUnknown,
+	}
+}
+
 const (
 	// GatewayAssociationProposalStateRequested is a GatewayAssociationProposalState enum value
 	GatewayAssociationProposalStateRequested = "requested"
@@ -11354,6 +15238,15 @@ const (
 	GatewayAssociationProposalStateDeleted = "deleted"
 )
 
+// GatewayAssociationProposalState_Values returns all elements of the GatewayAssociationProposalState enum
+func GatewayAssociationProposalState_Values() []string {
+	return []string{
+		GatewayAssociationProposalStateRequested,
+		GatewayAssociationProposalStateAccepted,
+		GatewayAssociationProposalStateDeleted,
+	}
+}
+
 const (
 	// GatewayAssociationStateAssociating is a GatewayAssociationState enum value
 	GatewayAssociationStateAssociating = "associating"
@@ -11371,6 +15264,17 @@ const (
 	GatewayAssociationStateUpdating = "updating"
 )
 
+// GatewayAssociationState_Values returns all elements of the GatewayAssociationState enum
+func GatewayAssociationState_Values() []string {
+	return []string{
+		GatewayAssociationStateAssociating,
+		GatewayAssociationStateAssociated,
+		GatewayAssociationStateDisassociating,
+		GatewayAssociationStateDisassociated,
+		GatewayAssociationStateUpdating,
+	}
+}
+
 const (
 	// GatewayAttachmentStateAttaching is a GatewayAttachmentState enum value
 	GatewayAttachmentStateAttaching = "attaching"
@@ -11385,6 +15289,16 @@ const (
 	GatewayAttachmentStateDetached = "detached"
 )
 
+// GatewayAttachmentState_Values returns all elements of the GatewayAttachmentState enum
+func GatewayAttachmentState_Values() []string {
+	return []string{
+		GatewayAttachmentStateAttaching,
+		GatewayAttachmentStateAttached,
+		GatewayAttachmentStateDetaching,
+		GatewayAttachmentStateDetached,
+	}
+}
+
 const (
 	// GatewayAttachmentTypeTransitVirtualInterface is a GatewayAttachmentType enum value
 	GatewayAttachmentTypeTransitVirtualInterface = "TransitVirtualInterface"
@@ -11393,6 +15307,14 @@ const (
 	GatewayAttachmentTypePrivateVirtualInterface = "PrivateVirtualInterface"
 )
 
+// GatewayAttachmentType_Values returns all elements of the GatewayAttachmentType enum
+func GatewayAttachmentType_Values() []string {
+	return []string{
+		GatewayAttachmentTypeTransitVirtualInterface,
+		GatewayAttachmentTypePrivateVirtualInterface,
+	}
+}
+
 const (
 	// GatewayStatePending is a GatewayState enum value
 	GatewayStatePending = "pending"
@@ -11407,6 +15329,16 @@ const (
 	GatewayStateDeleted = "deleted"
 )
 
+// GatewayState_Values returns all elements of the GatewayState enum
+func GatewayState_Values() []string {
+	return []string{
+		GatewayStatePending,
+		GatewayStateAvailable,
+		GatewayStateDeleting,
+		GatewayStateDeleted,
+	}
+}
+
 const (
 	// GatewayTypeVirtualPrivateGateway is a GatewayType enum value
 	GatewayTypeVirtualPrivateGateway = "virtualPrivateGateway"
@@ -11415,6 +15347,14 @@ const (
 	GatewayTypeTransitGateway = "transitGateway"
 )
 
+// GatewayType_Values returns all elements of the GatewayType enum
+func GatewayType_Values() []string {
+	return []string{
+		GatewayTypeVirtualPrivateGateway,
+		GatewayTypeTransitGateway,
+	}
+}
+
 const (
 	// HasLogicalRedundancyUnknown is a HasLogicalRedundancy enum value
 	HasLogicalRedundancyUnknown = "unknown"
@@ -11426,6 +15366,15 @@ const (
 	HasLogicalRedundancyNo = "no"
 )
 
+// HasLogicalRedundancy_Values returns all elements of the HasLogicalRedundancy enum
+func HasLogicalRedundancy_Values() []string {
+	return []string{
+		HasLogicalRedundancyUnknown,
+		HasLogicalRedundancyYes,
+		HasLogicalRedundancyNo,
+	}
+}
+
 const (
 	// InterconnectStateRequested is a InterconnectState enum value
 	InterconnectStateRequested = "requested"
@@ -11449,6 +15398,19 @@ const (
 	InterconnectStateUnknown = "unknown"
 )
 
+// InterconnectState_Values returns all elements of the InterconnectState enum
+func InterconnectState_Values() []string {
+	return []string{
+		InterconnectStateRequested,
+		InterconnectStatePending,
+		InterconnectStateAvailable,
+		InterconnectStateDown,
+		InterconnectStateDeleting,
+		InterconnectStateDeleted,
+		InterconnectStateUnknown,
+	}
+}
+
 const (
 	// LagStateRequested is a LagState enum value
 	LagStateRequested = "requested"
@@ -11472,11 +15434,51 @@ const (
 	LagStateUnknown = "unknown"
 )
 
+// LagState_Values returns all elements of the LagState enum
+func LagState_Values() []string {
+	return []string{
+		LagStateRequested,
+		LagStatePending,
+		LagStateAvailable,
+		LagStateDown,
+		LagStateDeleting,
+		LagStateDeleted,
+		LagStateUnknown,
+	}
+}
+
 const (
 	// LoaContentTypeApplicationPdf is a LoaContentType enum value
 	LoaContentTypeApplicationPdf = "application/pdf"
 )
 
+// LoaContentType_Values returns all elements of the LoaContentType enum
+func LoaContentType_Values() []string {
+	return []string{
+		LoaContentTypeApplicationPdf,
+	}
+}
+
+const (
+	// NniPartnerTypeV1 is a NniPartnerType enum value
+	NniPartnerTypeV1 = "v1"
+
+	// NniPartnerTypeV2 is a NniPartnerType enum value
+	NniPartnerTypeV2 = "v2"
+
+	// NniPartnerTypeNonPartner is a NniPartnerType enum value
+	NniPartnerTypeNonPartner = "nonPartner"
+)
+
+// NniPartnerType_Values returns all elements of the NniPartnerType enum
+func NniPartnerType_Values() []string {
+	return []string{
+		NniPartnerTypeV1,
+		NniPartnerTypeV2,
+		NniPartnerTypeNonPartner,
+	}
+}
+
 const (
 	// VirtualInterfaceStateConfirming is a VirtualInterfaceState enum value
 	VirtualInterfaceStateConfirming = "confirming"
@@ -11505,3 +15507,18 @@ const (
 	// VirtualInterfaceStateUnknown is a VirtualInterfaceState enum value
 	VirtualInterfaceStateUnknown = "unknown"
 )
+
+// VirtualInterfaceState_Values returns all elements of the VirtualInterfaceState enum
+func VirtualInterfaceState_Values() []string {
+	return []string{
+		VirtualInterfaceStateConfirming,
+		VirtualInterfaceStateVerifying,
+		VirtualInterfaceStatePending,
+		VirtualInterfaceStateAvailable,
+		VirtualInterfaceStateDown,
+		VirtualInterfaceStateDeleting,
+		VirtualInterfaceStateDeleted,
+		VirtualInterfaceStateRejected,
+		VirtualInterfaceStateUnknown,
+	}
+}
@@ -29,14 +29,13 @@ const opAcceptSharedDirectory = "AcceptSharedDirectory"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AcceptSharedDirectoryRequest method.
+//	req, resp := client.AcceptSharedDirectoryRequest(params)
 //
-//    // Example sending a request using the AcceptSharedDirectoryRequest method.
-//    req, resp := client.AcceptSharedDirectoryRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/AcceptSharedDirectory
 func (c *DirectoryService) AcceptSharedDirectoryRequest(input *AcceptSharedDirectoryInput) (req *request.Request, output *AcceptSharedDirectoryOutput) {
@@ -67,21 +66,23 @@ func (c *DirectoryService) AcceptSharedDirectoryRequest(input *AcceptSharedDirec
 // See the AWS API reference guide for AWS Directory Service's
 // API operation AcceptSharedDirectory for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+// Returned Error Types:
+//
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeDirectoryAlreadySharedException "DirectoryAlreadySharedException"
-//   The specified directory has already been shared with this AWS account.
+//   - DirectoryAlreadySharedException
+//     The specified directory has already been shared with this Amazon Web Services
+//     account.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/AcceptSharedDirectory
 func (c *DirectoryService) AcceptSharedDirectory(input *AcceptSharedDirectoryInput) (*AcceptSharedDirectoryOutput, error) {
@@ -121,14 +122,13 @@ const opAddIpRoutes = "AddIpRoutes"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AddIpRoutesRequest method.
+//	req, resp := client.AddIpRoutesRequest(params)
 //
-//    // Example sending a request using the AddIpRoutesRequest method.
-//    req, resp := client.AddIpRoutesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/AddIpRoutes
 func (c *DirectoryService) AddIpRoutesRequest(input *AddIpRoutesInput) (req *request.Request, output *AddIpRoutesOutput) {
@@ -150,16 +150,17 @@ func (c *DirectoryService) AddIpRoutesRequest(input *AddIpRoutesInput) (req *req
 
 // AddIpRoutes API operation for AWS Directory Service.
 //
-// If the DNS server for your on-premises domain uses a publicly addressable
+// If the DNS server for your self-managed domain uses a publicly addressable
 // IP address, you must add a CIDR address block to correctly route traffic
 // to and from your Microsoft AD on Amazon Web Services. AddIpRoutes adds this
 // address block. You can also use AddIpRoutes to facilitate routing traffic
-// that uses public IP ranges from your Microsoft AD on AWS to a peer VPC.
+// that uses public IP ranges from your Microsoft AD on Amazon Web Services
+// to a peer VPC.
 //
 // Before you call AddIpRoutes, ensure that all of the required permissions
 // have been explicitly granted through a policy. For details about what permissions
-// are required to run the AddIpRoutes operation, see AWS Directory Service
-// API Permissions: Actions, Resources, and Conditions Reference (http://docs.aws.amazon.com/directoryservice/latest/admin-guide/UsingWithDS_IAM_ResourcePermissions.html).
+// are required to run the AddIpRoutes operation, see Directory Service API
+// Permissions: Actions, Resources, and Conditions Reference (http://docs.aws.amazon.com/directoryservice/latest/admin-guide/UsingWithDS_IAM_ResourcePermissions.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -168,28 +169,29 @@ func (c *DirectoryService) AddIpRoutesRequest(input *AddIpRoutesInput) (req *req
 // See the AWS API reference guide for AWS Directory Service's
 // API operation AddIpRoutes for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeEntityAlreadyExistsException "EntityAlreadyExistsException"
-//   The specified entity already exists.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - EntityAlreadyExistsException
+//     The specified entity already exists.
 //
-//   * ErrCodeDirectoryUnavailableException "DirectoryUnavailableException"
-//   The specified directory is unavailable or could not be found.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeIpRouteLimitExceededException "IpRouteLimitExceededException"
-//   The maximum allowed number of IP addresses was exceeded. The default limit
-//   is 100 IP address blocks.
+//   - DirectoryUnavailableException
+//     The specified directory is unavailable or could not be found.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - IpRouteLimitExceededException
+//     The maximum allowed number of IP addresses was exceeded. The default limit
+//     is 100 IP address blocks.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/AddIpRoutes
 func (c *DirectoryService) AddIpRoutes(input *AddIpRoutesInput) (*AddIpRoutesOutput, error) {
@@ -213,6 +215,115 @@ func (c *DirectoryService) AddIpRoutesWithContext(ctx aws.Context, input *AddIpR
 	return out, req.Send()
 }
 
+const opAddRegion = "AddRegion"
+
+// AddRegionRequest generates a "aws/request.Request" representing the
+// client's request for the AddRegion operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See AddRegion for more information on using the AddRegion
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the AddRegionRequest method.
+//	req, resp := client.AddRegionRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/AddRegion
+func (c *DirectoryService) AddRegionRequest(input *AddRegionInput) (req *request.Request, output *AddRegionOutput) {
+	op := &request.Operation{
+		Name:       opAddRegion,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &AddRegionInput{}
+	}
+
+	output = &AddRegionOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// AddRegion API operation for AWS Directory Service.
+//
+// Adds two domain controllers in the specified Region for the specified directory.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Directory Service's
+// API operation AddRegion for usage and error information.
+//
+// Returned Error Types:
+//
+//   - DirectoryUnavailableException
+//     The specified directory is unavailable or could not be found.
+//
+//   - InvalidParameterException
+//     One or more parameters are not valid.
+//
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
+//
+//   - DirectoryAlreadyInRegionException
+//     The Region you specified is the same Region where the Managed Microsoft AD
+//     directory was created. Specify a different Region and try again.
+//
+//   - UnsupportedOperationException
+//     The operation is not supported.
+//
+//   - DirectoryDoesNotExistException
+//     The specified directory does not exist in the system.
+//
+//   - RegionLimitExceededException
+//     You have reached the limit for maximum number of simultaneous Region replications
+//     per directory.
+//
+//   - AccessDeniedException
+//     Client authentication is not available in this region at this time.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/AddRegion
+func (c *DirectoryService) AddRegion(input *AddRegionInput) (*AddRegionOutput, error) {
+	req, out := c.AddRegionRequest(input)
+	return out, req.Send()
+}
+
+// AddRegionWithContext is the same as AddRegion with the addition of
+// the ability to pass a context and additional request options.
+//
+// See AddRegion for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) AddRegionWithContext(ctx aws.Context, input *AddRegionInput, opts ...request.Option) (*AddRegionOutput, error) {
+	req, out := c.AddRegionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opAddTagsToResource = "AddTagsToResource"
 
 // AddTagsToResourceRequest generates a "aws/request.Request" representing the
@@ -229,14 +340,13 @@ const opAddTagsToResource = "AddTagsToResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AddTagsToResourceRequest method.
+//	req, resp := client.AddTagsToResourceRequest(params)
 //
-//    // Example sending a request using the AddTagsToResourceRequest method.
-//    req, resp := client.AddTagsToResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/AddTagsToResource
 func (c *DirectoryService) AddTagsToResourceRequest(input *AddTagsToResourceInput) (req *request.Request, output *AddTagsToResourceOutput) {
@@ -269,21 +379,22 @@ func (c *DirectoryService) AddTagsToResourceRequest(input *AddTagsToResourceInpu
 // See the AWS API reference guide for AWS Directory Service's
 // API operation AddTagsToResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
+//
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeTagLimitExceededException "TagLimitExceededException"
-//   The maximum allowed number of tags was exceeded.
+//   - TagLimitExceededException
+//     The maximum allowed number of tags was exceeded.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/AddTagsToResource
 func (c *DirectoryService) AddTagsToResource(input *AddTagsToResourceInput) (*AddTagsToResourceOutput, error) {
@@ -323,14 +434,13 @@ const opCancelSchemaExtension = "CancelSchemaExtension"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CancelSchemaExtensionRequest method.
+//	req, resp := client.CancelSchemaExtensionRequest(params)
 //
-//    // Example sending a request using the CancelSchemaExtensionRequest method.
-//    req, resp := client.CancelSchemaExtensionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/CancelSchemaExtension
 func (c *DirectoryService) CancelSchemaExtensionRequest(input *CancelSchemaExtensionInput) (req *request.Request, output *CancelSchemaExtensionOutput) {
@@ -364,15 +474,16 @@ func (c *DirectoryService) CancelSchemaExtensionRequest(input *CancelSchemaExten
 // See the AWS API reference guide for AWS Directory Service's
 // API operation CancelSchemaExtension for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
+//
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/CancelSchemaExtension
 func (c *DirectoryService) CancelSchemaExtension(input *CancelSchemaExtensionInput) (*CancelSchemaExtensionOutput, error) {
@@ -412,14 +523,13 @@ const opConnectDirectory = "ConnectDirectory"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ConnectDirectoryRequest method.
+//	req, resp := client.ConnectDirectoryRequest(params)
 //
-//    // Example sending a request using the ConnectDirectoryRequest method.
-//    req, resp := client.ConnectDirectoryRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ConnectDirectory
 func (c *DirectoryService) ConnectDirectoryRequest(input *ConnectDirectoryInput) (req *request.Request, output *ConnectDirectoryOutput) {
@@ -440,11 +550,11 @@ func (c *DirectoryService) ConnectDirectoryRequest(input *ConnectDirectoryInput)
 
 // ConnectDirectory API operation for AWS Directory Service.
 //
-// Creates an AD Connector to connect to an on-premises directory.
+// Creates an AD Connector to connect to a self-managed directory.
 //
 // Before you call ConnectDirectory, ensure that all of the required permissions
 // have been explicitly granted through a policy. For details about what permissions
-// are required to run the ConnectDirectory operation, see AWS Directory Service
+// are required to run the ConnectDirectory operation, see Directory Service
 // API Permissions: Actions, Resources, and Conditions Reference (http://docs.aws.amazon.com/directoryservice/latest/admin-guide/UsingWithDS_IAM_ResourcePermissions.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
@@ -454,20 +564,21 @@ func (c *DirectoryService) ConnectDirectoryRequest(input *ConnectDirectoryInput)
 // See the AWS API reference guide for AWS Directory Service's
 // API operation ConnectDirectory for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeDirectoryLimitExceededException "DirectoryLimitExceededException"
-//   The maximum number of directories in the region has been reached. You can
-//   use the GetDirectoryLimits operation to determine your directory limits in
-//   the region.
+// Returned Error Types:
+//
+//   - DirectoryLimitExceededException
+//     The maximum number of directories in the region has been reached. You can
+//     use the GetDirectoryLimits operation to determine your directory limits in
+//     the region.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ConnectDirectory
 func (c *DirectoryService) ConnectDirectory(input *ConnectDirectoryInput) (*ConnectDirectoryOutput, error) {
@@ -507,14 +618,13 @@ const opCreateAlias = "CreateAlias"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateAliasRequest method.
+//	req, resp := client.CreateAliasRequest(params)
 //
-//    // Example sending a request using the CreateAliasRequest method.
-//    req, resp := client.CreateAliasRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/CreateAlias
 func (c *DirectoryService) CreateAliasRequest(input *CreateAliasInput) (req *request.Request, output *CreateAliasOutput) {
@@ -549,21 +659,22 @@ func (c *DirectoryService) CreateAliasRequest(input *CreateAliasInput) (req *req
 // See the AWS API reference guide for AWS Directory Service's
 // API operation CreateAlias for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityAlreadyExistsException "EntityAlreadyExistsException"
-//   The specified entity already exists.
+// Returned Error Types:
+//
+//   - EntityAlreadyExistsException
+//     The specified entity already exists.
 //
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/CreateAlias
 func (c *DirectoryService) CreateAlias(input *CreateAliasInput) (*CreateAliasOutput, error) {
@@ -603,14 +714,13 @@ const opCreateComputer = "CreateComputer"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateComputerRequest method.
+//	req, resp := client.CreateComputerRequest(params)
 //
-//    // Example sending a request using the CreateComputerRequest method.
-//    req, resp := client.CreateComputerRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/CreateComputer
 func (c *DirectoryService) CreateComputerRequest(input *CreateComputerInput) (req *request.Request, output *CreateComputerOutput) {
@@ -631,8 +741,7 @@ func (c *DirectoryService) CreateComputerRequest(input *CreateComputerInput) (re
 
 // CreateComputer API operation for AWS Directory Service.
 //
-// Creates a computer account in the specified directory, and joins the computer
-// to the directory.
+// Creates an Active Directory computer object in the specified directory.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -641,30 +750,31 @@ func (c *DirectoryService) CreateComputerRequest(input *CreateComputerInput) (re
 // See the AWS API reference guide for AWS Directory Service's
 // API operation CreateComputer for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeAuthenticationFailedException "AuthenticationFailedException"
-//   An authentication error occurred.
+// Returned Error Types:
+//
+//   - AuthenticationFailedException
+//     An authentication error occurred.
 //
-//   * ErrCodeDirectoryUnavailableException "DirectoryUnavailableException"
-//   The specified directory is unavailable or could not be found.
+//   - DirectoryUnavailableException
+//     The specified directory is unavailable or could not be found.
 //
-//   * ErrCodeEntityAlreadyExistsException "EntityAlreadyExistsException"
-//   The specified entity already exists.
+//   - EntityAlreadyExistsException
+//     The specified entity already exists.
 //
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeUnsupportedOperationException "UnsupportedOperationException"
-//   The operation is not supported.
+//   - UnsupportedOperationException
+//     The operation is not supported.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/CreateComputer
 func (c *DirectoryService) CreateComputer(input *CreateComputerInput) (*CreateComputerOutput, error) {
@@ -704,14 +814,13 @@ const opCreateConditionalForwarder = "CreateConditionalForwarder"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateConditionalForwarderRequest method.
+//	req, resp := client.CreateConditionalForwarderRequest(params)
 //
-//    // Example sending a request using the CreateConditionalForwarderRequest method.
-//    req, resp := client.CreateConditionalForwarderRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/CreateConditionalForwarder
 func (c *DirectoryService) CreateConditionalForwarderRequest(input *CreateConditionalForwarderInput) (req *request.Request, output *CreateConditionalForwarderOutput) {
@@ -733,9 +842,10 @@ func (c *DirectoryService) CreateConditionalForwarderRequest(input *CreateCondit
 
 // CreateConditionalForwarder API operation for AWS Directory Service.
 //
-// Creates a conditional forwarder associated with your AWS directory. Conditional
-// forwarders are required in order to set up a trust relationship with another
-// domain. The conditional forwarder points to the trusted domain.
+// Creates a conditional forwarder associated with your Amazon Web Services
+// directory. Conditional forwarders are required in order to set up a trust
+// relationship with another domain. The conditional forwarder points to the
+// trusted domain.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -744,27 +854,28 @@ func (c *DirectoryService) CreateConditionalForwarderRequest(input *CreateCondit
 // See the AWS API reference guide for AWS Directory Service's
 // API operation CreateConditionalForwarder for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityAlreadyExistsException "EntityAlreadyExistsException"
-//   The specified entity already exists.
+// Returned Error Types:
+//
+//   - EntityAlreadyExistsException
+//     The specified entity already exists.
 //
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeDirectoryUnavailableException "DirectoryUnavailableException"
-//   The specified directory is unavailable or could not be found.
+//   - DirectoryUnavailableException
+//     The specified directory is unavailable or could not be found.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeUnsupportedOperationException "UnsupportedOperationException"
-//   The operation is not supported.
+//   - UnsupportedOperationException
+//     The operation is not supported.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/CreateConditionalForwarder
 func (c *DirectoryService) CreateConditionalForwarder(input *CreateConditionalForwarderInput) (*CreateConditionalForwarderOutput, error) {
@@ -804,14 +915,13 @@ const opCreateDirectory = "CreateDirectory"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateDirectoryRequest method.
+//	req, resp := client.CreateDirectoryRequest(params)
 //
-//    // Example sending a request using the CreateDirectoryRequest method.
-//    req, resp := client.CreateDirectoryRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/CreateDirectory
 func (c *DirectoryService) CreateDirectoryRequest(input *CreateDirectoryInput) (req *request.Request, output *CreateDirectoryOutput) {
@@ -832,11 +942,13 @@ func (c *DirectoryService) CreateDirectoryRequest(input *CreateDirectoryInput) (
 
 // CreateDirectory API operation for AWS Directory Service.
 //
-// Creates a Simple AD directory.
+// Creates a Simple AD directory. For more information, see Simple Active Directory
+// (https://docs.aws.amazon.com/directoryservice/latest/admin-guide/directory_simple_ad.html)
+// in the Directory Service Admin Guide.
 //
 // Before you call CreateDirectory, ensure that all of the required permissions
 // have been explicitly granted through a policy. For details about what permissions
-// are required to run the CreateDirectory operation, see AWS Directory Service
+// are required to run the CreateDirectory operation, see Directory Service
 // API Permissions: Actions, Resources, and Conditions Reference (http://docs.aws.amazon.com/directoryservice/latest/admin-guide/UsingWithDS_IAM_ResourcePermissions.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
@@ -846,20 +958,21 @@ func (c *DirectoryService) CreateDirectoryRequest(input *CreateDirectoryInput) (
 // See the AWS API reference guide for AWS Directory Service's
 // API operation CreateDirectory for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeDirectoryLimitExceededException "DirectoryLimitExceededException"
-//   The maximum number of directories in the region has been reached. You can
-//   use the GetDirectoryLimits operation to determine your directory limits in
-//   the region.
+// Returned Error Types:
+//
+//   - DirectoryLimitExceededException
+//     The maximum number of directories in the region has been reached. You can
+//     use the GetDirectoryLimits operation to determine your directory limits in
+//     the region.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/CreateDirectory
 func (c *DirectoryService) CreateDirectory(input *CreateDirectoryInput) (*CreateDirectoryOutput, error) {
@@ -899,14 +1012,13 @@ const opCreateLogSubscription = "CreateLogSubscription"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateLogSubscriptionRequest method.
+//	req, resp := client.CreateLogSubscriptionRequest(params)
 //
-//    // Example sending a request using the CreateLogSubscriptionRequest method.
-//    req, resp := client.CreateLogSubscriptionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/CreateLogSubscription
 func (c *DirectoryService) CreateLogSubscriptionRequest(input *CreateLogSubscriptionInput) (req *request.Request, output *CreateLogSubscriptionOutput) {
@@ -928,8 +1040,9 @@ func (c *DirectoryService) CreateLogSubscriptionRequest(input *CreateLogSubscrip
 
 // CreateLogSubscription API operation for AWS Directory Service.
 //
-// Creates a subscription to forward real time Directory Service domain controller
-// security logs to the specified CloudWatch log group in your AWS account.
+// Creates a subscription to forward real-time Directory Service domain controller
+// security logs to the specified Amazon CloudWatch log group in your Amazon
+// Web Services account.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -938,24 +1051,25 @@ func (c *DirectoryService) CreateLogSubscriptionRequest(input *CreateLogSubscrip
 // See the AWS API reference guide for AWS Directory Service's
 // API operation CreateLogSubscription for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityAlreadyExistsException "EntityAlreadyExistsException"
-//   The specified entity already exists.
+// Returned Error Types:
+//
+//   - EntityAlreadyExistsException
+//     The specified entity already exists.
 //
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeUnsupportedOperationException "UnsupportedOperationException"
-//   The operation is not supported.
+//   - UnsupportedOperationException
+//     The operation is not supported.
 //
-//   * ErrCodeInsufficientPermissionsException "InsufficientPermissionsException"
-//   The account does not have sufficient permission to perform the operation.
+//   - InsufficientPermissionsException
+//     The account does not have sufficient permission to perform the operation.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/CreateLogSubscription
 func (c *DirectoryService) CreateLogSubscription(input *CreateLogSubscriptionInput) (*CreateLogSubscriptionOutput, error) {
@@ -995,14 +1109,13 @@ const opCreateMicrosoftAD = "CreateMicrosoftAD"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateMicrosoftADRequest method.
+//	req, resp := client.CreateMicrosoftADRequest(params)
 //
-//    // Example sending a request using the CreateMicrosoftADRequest method.
-//    req, resp := client.CreateMicrosoftADRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/CreateMicrosoftAD
 func (c *DirectoryService) CreateMicrosoftADRequest(input *CreateMicrosoftADInput) (req *request.Request, output *CreateMicrosoftADOutput) {
@@ -1023,11 +1136,13 @@ func (c *DirectoryService) CreateMicrosoftADRequest(input *CreateMicrosoftADInpu
 
 // CreateMicrosoftAD API operation for AWS Directory Service.
 //
-// Creates an AWS Managed Microsoft AD directory.
+// Creates a Microsoft AD directory in the Amazon Web Services Cloud. For more
+// information, see Managed Microsoft AD (https://docs.aws.amazon.com/directoryservice/latest/admin-guide/directory_microsoft_ad.html)
+// in the Directory Service Admin Guide.
 //
 // Before you call CreateMicrosoftAD, ensure that all of the required permissions
 // have been explicitly granted through a policy. For details about what permissions
-// are required to run the CreateMicrosoftAD operation, see AWS Directory Service
+// are required to run the CreateMicrosoftAD operation, see Directory Service
 // API Permissions: Actions, Resources, and Conditions Reference (http://docs.aws.amazon.com/directoryservice/latest/admin-guide/UsingWithDS_IAM_ResourcePermissions.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
@@ -1037,23 +1152,24 @@ func (c *DirectoryService) CreateMicrosoftADRequest(input *CreateMicrosoftADInpu
 // See the AWS API reference guide for AWS Directory Service's
 // API operation CreateMicrosoftAD for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeDirectoryLimitExceededException "DirectoryLimitExceededException"
-//   The maximum number of directories in the region has been reached. You can
-//   use the GetDirectoryLimits operation to determine your directory limits in
-//   the region.
+// Returned Error Types:
+//
+//   - DirectoryLimitExceededException
+//     The maximum number of directories in the region has been reached. You can
+//     use the GetDirectoryLimits operation to determine your directory limits in
+//     the region.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
-//   * ErrCodeUnsupportedOperationException "UnsupportedOperationException"
-//   The operation is not supported.
+//   - UnsupportedOperationException
+//     The operation is not supported.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/CreateMicrosoftAD
 func (c *DirectoryService) CreateMicrosoftAD(input *CreateMicrosoftADInput) (*CreateMicrosoftADOutput, error) {
@@ -1093,14 +1209,13 @@ const opCreateSnapshot = "CreateSnapshot"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateSnapshotRequest method.
+//	req, resp := client.CreateSnapshotRequest(params)
 //
-//    // Example sending a request using the CreateSnapshotRequest method.
-//    req, resp := client.CreateSnapshotRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/CreateSnapshot
 func (c *DirectoryService) CreateSnapshotRequest(input *CreateSnapshotInput) (req *request.Request, output *CreateSnapshotOutput) {
@@ -1121,7 +1236,8 @@ func (c *DirectoryService) CreateSnapshotRequest(input *CreateSnapshotInput) (re
 
 // CreateSnapshot API operation for AWS Directory Service.
 //
-// Creates a snapshot of a Simple AD or Microsoft AD directory in the AWS cloud.
+// Creates a snapshot of a Simple AD or Microsoft AD directory in the Amazon
+// Web Services cloud.
 //
 // You cannot take snapshots of AD Connector directories.
 //
@@ -1132,23 +1248,24 @@ func (c *DirectoryService) CreateSnapshotRequest(input *CreateSnapshotInput) (re
 // See the AWS API reference guide for AWS Directory Service's
 // API operation CreateSnapshot for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
+//
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeSnapshotLimitExceededException "SnapshotLimitExceededException"
-//   The maximum number of manual snapshots for the directory has been reached.
-//   You can use the GetSnapshotLimits operation to determine the snapshot limits
-//   for a directory.
+//   - SnapshotLimitExceededException
+//     The maximum number of manual snapshots for the directory has been reached.
+//     You can use the GetSnapshotLimits operation to determine the snapshot limits
+//     for a directory.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/CreateSnapshot
 func (c *DirectoryService) CreateSnapshot(input *CreateSnapshotInput) (*CreateSnapshotOutput, error) {
@@ -1188,14 +1305,13 @@ const opCreateTrust = "CreateTrust"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateTrustRequest method.
+//	req, resp := client.CreateTrustRequest(params)
 //
-//    // Example sending a request using the CreateTrustRequest method.
-//    req, resp := client.CreateTrustRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/CreateTrust
 func (c *DirectoryService) CreateTrustRequest(input *CreateTrustInput) (req *request.Request, output *CreateTrustOutput) {
@@ -1216,15 +1332,15 @@ func (c *DirectoryService) CreateTrustRequest(input *CreateTrustInput) (req *req
 
 // CreateTrust API operation for AWS Directory Service.
 //
-// AWS Directory Service for Microsoft Active Directory allows you to configure
+// Directory Service for Microsoft Active Directory allows you to configure
 // trust relationships. For example, you can establish a trust between your
-// AWS Managed Microsoft AD directory, and your existing on-premises Microsoft
+// Managed Microsoft AD directory, and your existing self-managed Microsoft
 // Active Directory. This would allow you to provide users and groups access
 // to resources in either domain, with a single set of credentials.
 //
-// This action initiates the creation of the AWS side of a trust relationship
-// between an AWS Managed Microsoft AD directory and an external domain. You
-// can create either a forest trust or an external trust.
+// This action initiates the creation of the Amazon Web Services side of a trust
+// relationship between an Managed Microsoft AD directory and an external domain.
+// You can create either a forest trust or an external trust.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1233,24 +1349,25 @@ func (c *DirectoryService) CreateTrustRequest(input *CreateTrustInput) (req *req
 // See the AWS API reference guide for AWS Directory Service's
 // API operation CreateTrust for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityAlreadyExistsException "EntityAlreadyExistsException"
-//   The specified entity already exists.
+// Returned Error Types:
+//
+//   - EntityAlreadyExistsException
+//     The specified entity already exists.
 //
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
-//   * ErrCodeUnsupportedOperationException "UnsupportedOperationException"
-//   The operation is not supported.
+//   - UnsupportedOperationException
+//     The operation is not supported.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/CreateTrust
 func (c *DirectoryService) CreateTrust(input *CreateTrustInput) (*CreateTrustOutput, error) {
@@ -1290,14 +1407,13 @@ const opDeleteConditionalForwarder = "DeleteConditionalForwarder"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteConditionalForwarderRequest method.
+//	req, resp := client.DeleteConditionalForwarderRequest(params)
 //
-//    // Example sending a request using the DeleteConditionalForwarderRequest method.
-//    req, resp := client.DeleteConditionalForwarderRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DeleteConditionalForwarder
 func (c *DirectoryService) DeleteConditionalForwarderRequest(input *DeleteConditionalForwarderInput) (req *request.Request, output *DeleteConditionalForwarderOutput) {
@@ -1319,7 +1435,8 @@ func (c *DirectoryService) DeleteConditionalForwarderRequest(input *DeleteCondit
 
 // DeleteConditionalForwarder API operation for AWS Directory Service.
 //
-// Deletes a conditional forwarder that has been set up for your AWS directory.
+// Deletes a conditional forwarder that has been set up for your Amazon Web
+// Services directory.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1328,24 +1445,25 @@ func (c *DirectoryService) DeleteConditionalForwarderRequest(input *DeleteCondit
 // See the AWS API reference guide for AWS Directory Service's
 // API operation DeleteConditionalForwarder for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
+//
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeDirectoryUnavailableException "DirectoryUnavailableException"
-//   The specified directory is unavailable or could not be found.
+//   - DirectoryUnavailableException
+//     The specified directory is unavailable or could not be found.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeUnsupportedOperationException "UnsupportedOperationException"
-//   The operation is not supported.
+//   - UnsupportedOperationException
+//     The operation is not supported.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DeleteConditionalForwarder
 func (c *DirectoryService) DeleteConditionalForwarder(input *DeleteConditionalForwarderInput) (*DeleteConditionalForwarderOutput, error) {
@@ -1385,14 +1503,13 @@ const opDeleteDirectory = "DeleteDirectory"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteDirectoryRequest method.
+//	req, resp := client.DeleteDirectoryRequest(params)
 //
-//    // Example sending a request using the DeleteDirectoryRequest method.
-//    req, resp := client.DeleteDirectoryRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DeleteDirectory
 func (c *DirectoryService) DeleteDirectoryRequest(input *DeleteDirectoryInput) (req *request.Request, output *DeleteDirectoryOutput) {
@@ -1413,11 +1530,11 @@ func (c *DirectoryService) DeleteDirectoryRequest(input *DeleteDirectoryInput) (
 
 // DeleteDirectory API operation for AWS Directory Service.
 //
-// Deletes an AWS Directory Service directory.
+// Deletes an Directory Service directory.
 //
 // Before you call DeleteDirectory, ensure that all of the required permissions
 // have been explicitly granted through a policy. For details about what permissions
-// are required to run the DeleteDirectory operation, see AWS Directory Service
+// are required to run the DeleteDirectory operation, see Directory Service
 // API Permissions: Actions, Resources, and Conditions Reference (http://docs.aws.amazon.com/directoryservice/latest/admin-guide/UsingWithDS_IAM_ResourcePermissions.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
@@ -1427,15 +1544,16 @@ func (c *DirectoryService) DeleteDirectoryRequest(input *DeleteDirectoryInput) (
 // See the AWS API reference guide for AWS Directory Service's
 // API operation DeleteDirectory for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
+//
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DeleteDirectory
 func (c *DirectoryService) DeleteDirectory(input *DeleteDirectoryInput) (*DeleteDirectoryOutput, error) {
@@ -1475,14 +1593,13 @@ const opDeleteLogSubscription = "DeleteLogSubscription"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteLogSubscriptionRequest method.
+//	req, resp := client.DeleteLogSubscriptionRequest(params)
 //
-//    // Example sending a request using the DeleteLogSubscriptionRequest method.
-//    req, resp := client.DeleteLogSubscriptionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DeleteLogSubscription
 func (c *DirectoryService) DeleteLogSubscriptionRequest(input *DeleteLogSubscriptionInput) (req *request.Request, output *DeleteLogSubscriptionOutput) {
@@ -1513,18 +1630,19 @@ func (c *DirectoryService) DeleteLogSubscriptionRequest(input *DeleteLogSubscrip
 // See the AWS API reference guide for AWS Directory Service's
 // API operation DeleteLogSubscription for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
+//
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeUnsupportedOperationException "UnsupportedOperationException"
-//   The operation is not supported.
+//   - UnsupportedOperationException
+//     The operation is not supported.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DeleteLogSubscription
 func (c *DirectoryService) DeleteLogSubscription(input *DeleteLogSubscriptionInput) (*DeleteLogSubscriptionOutput, error) {
@@ -1564,14 +1682,13 @@ const opDeleteSnapshot = "DeleteSnapshot"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteSnapshotRequest method.
+//	req, resp := client.DeleteSnapshotRequest(params)
 //
-//    // Example sending a request using the DeleteSnapshotRequest method.
-//    req, resp := client.DeleteSnapshotRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DeleteSnapshot
 func (c *DirectoryService) DeleteSnapshotRequest(input *DeleteSnapshotInput) (req *request.Request, output *DeleteSnapshotOutput) {
@@ -1601,18 +1718,19 @@ func (c *DirectoryService) DeleteSnapshotRequest(input *DeleteSnapshotInput) (re
 // See the AWS API reference guide for AWS Directory Service's
 // API operation DeleteSnapshot for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
+//
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DeleteSnapshot
 func (c *DirectoryService) DeleteSnapshot(input *DeleteSnapshotInput) (*DeleteSnapshotOutput, error) {
@@ -1652,14 +1770,13 @@ const opDeleteTrust = "DeleteTrust"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteTrustRequest method.
+//	req, resp := client.DeleteTrustRequest(params)
 //
-//    // Example sending a request using the DeleteTrustRequest method.
-//    req, resp := client.DeleteTrustRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DeleteTrust
 func (c *DirectoryService) DeleteTrustRequest(input *DeleteTrustInput) (req *request.Request, output *DeleteTrustOutput) {
@@ -1680,8 +1797,8 @@ func (c *DirectoryService) DeleteTrustRequest(input *DeleteTrustInput) (req *req
 
 // DeleteTrust API operation for AWS Directory Service.
 //
-// Deletes an existing trust relationship between your AWS Managed Microsoft
-// AD directory and an external domain.
+// Deletes an existing trust relationship between your Managed Microsoft AD
+// directory and an external domain.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1690,21 +1807,22 @@ func (c *DirectoryService) DeleteTrustRequest(input *DeleteTrustInput) (req *req
 // See the AWS API reference guide for AWS Directory Service's
 // API operation DeleteTrust for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeUnsupportedOperationException "UnsupportedOperationException"
-//   The operation is not supported.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+//   - UnsupportedOperationException
+//     The operation is not supported.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DeleteTrust
 func (c *DirectoryService) DeleteTrust(input *DeleteTrustInput) (*DeleteTrustOutput, error) {
@@ -1728,323 +1846,322 @@ func (c *DirectoryService) DeleteTrustWithContext(ctx aws.Context, input *Delete
 	return out, req.Send()
 }
 
-const opDeregisterEventTopic = "DeregisterEventTopic"
+const opDeregisterCertificate = "DeregisterCertificate"
 
-// DeregisterEventTopicRequest generates a "aws/request.Request" representing the
-// client's request for the DeregisterEventTopic operation. The "output" return
+// DeregisterCertificateRequest generates a "aws/request.Request" representing the
+// client's request for the DeregisterCertificate operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See DeregisterEventTopic for more information on using the DeregisterEventTopic
+// See DeregisterCertificate for more information on using the DeregisterCertificate
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeregisterCertificateRequest method.
+//	req, resp := client.DeregisterCertificateRequest(params)
 //
-//    // Example sending a request using the DeregisterEventTopicRequest method.
-//    req, resp := client.DeregisterEventTopicRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DeregisterEventTopic
-func (c *DirectoryService) DeregisterEventTopicRequest(input *DeregisterEventTopicInput) (req *request.Request, output *DeregisterEventTopicOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DeregisterCertificate
+func (c *DirectoryService) DeregisterCertificateRequest(input *DeregisterCertificateInput) (req *request.Request, output *DeregisterCertificateOutput) {
 	op := &request.Operation{
-		Name:       opDeregisterEventTopic,
+		Name:       opDeregisterCertificate,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &DeregisterEventTopicInput{}
+		input = &DeregisterCertificateInput{}
 	}
 
-	output = &DeregisterEventTopicOutput{}
+	output = &DeregisterCertificateOutput{}
 	req = c.newRequest(op, input, output)
 	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// DeregisterEventTopic API operation for AWS Directory Service.
+// DeregisterCertificate API operation for AWS Directory Service.
 //
-// Removes the specified directory as a publisher to the specified SNS topic.
+// Deletes from the system the certificate that was registered for secure LDAP
+// or client certificate authentication.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation DeregisterEventTopic for usage and error information.
+// API operation DeregisterCertificate for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - DirectoryUnavailableException
+//     The specified directory is unavailable or could not be found.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - DirectoryDoesNotExistException
+//     The specified directory does not exist in the system.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - CertificateDoesNotExistException
+//     The certificate is not present in the system for describe or deregister activities.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DeregisterEventTopic
-func (c *DirectoryService) DeregisterEventTopic(input *DeregisterEventTopicInput) (*DeregisterEventTopicOutput, error) {
-	req, out := c.DeregisterEventTopicRequest(input)
+//   - CertificateInUseException
+//     The certificate is being used for the LDAP security connection and cannot
+//     be removed without disabling LDAP security.
+//
+//   - UnsupportedOperationException
+//     The operation is not supported.
+//
+//   - InvalidParameterException
+//     One or more parameters are not valid.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DeregisterCertificate
+func (c *DirectoryService) DeregisterCertificate(input *DeregisterCertificateInput) (*DeregisterCertificateOutput, error) {
+	req, out := c.DeregisterCertificateRequest(input)
 	return out, req.Send()
 }
 
-// DeregisterEventTopicWithContext is the same as DeregisterEventTopic with the addition of
+// DeregisterCertificateWithContext is the same as DeregisterCertificate with the addition of
 // the ability to pass a context and additional request options.
 //
-// See DeregisterEventTopic for details on how to use this API operation.
+// See DeregisterCertificate for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) DeregisterEventTopicWithContext(ctx aws.Context, input *DeregisterEventTopicInput, opts ...request.Option) (*DeregisterEventTopicOutput, error) {
-	req, out := c.DeregisterEventTopicRequest(input)
+func (c *DirectoryService) DeregisterCertificateWithContext(ctx aws.Context, input *DeregisterCertificateInput, opts ...request.Option) (*DeregisterCertificateOutput, error) {
+	req, out := c.DeregisterCertificateRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opDescribeConditionalForwarders = "DescribeConditionalForwarders"
+const opDeregisterEventTopic = "DeregisterEventTopic"
 
-// DescribeConditionalForwardersRequest generates a "aws/request.Request" representing the
-// client's request for the DescribeConditionalForwarders operation. The "output" return
+// DeregisterEventTopicRequest generates a "aws/request.Request" representing the
+// client's request for the DeregisterEventTopic operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See DescribeConditionalForwarders for more information on using the DescribeConditionalForwarders
+// See DeregisterEventTopic for more information on using the DeregisterEventTopic
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeregisterEventTopicRequest method.
+//	req, resp := client.DeregisterEventTopicRequest(params)
 //
-//    // Example sending a request using the DescribeConditionalForwardersRequest method.
-//    req, resp := client.DescribeConditionalForwardersRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeConditionalForwarders
-func (c *DirectoryService) DescribeConditionalForwardersRequest(input *DescribeConditionalForwardersInput) (req *request.Request, output *DescribeConditionalForwardersOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DeregisterEventTopic
+func (c *DirectoryService) DeregisterEventTopicRequest(input *DeregisterEventTopicInput) (req *request.Request, output *DeregisterEventTopicOutput) {
 	op := &request.Operation{
-		Name:       opDescribeConditionalForwarders,
+		Name:       opDeregisterEventTopic,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &DescribeConditionalForwardersInput{}
+		input = &DeregisterEventTopicInput{}
 	}
 
-	output = &DescribeConditionalForwardersOutput{}
+	output = &DeregisterEventTopicOutput{}
 	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// DescribeConditionalForwarders API operation for AWS Directory Service.
-//
-// Obtains information about the conditional forwarders for this account.
+// DeregisterEventTopic API operation for AWS Directory Service.
 //
-// If no input parameters are provided for RemoteDomainNames, this request describes
-// all conditional forwarders for the specified directory ID.
+// Removes the specified directory as a publisher to the specified Amazon SNS
+// topic.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation DescribeConditionalForwarders for usage and error information.
-//
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// API operation DeregisterEventTopic for usage and error information.
 //
-//   * ErrCodeDirectoryUnavailableException "DirectoryUnavailableException"
-//   The specified directory is unavailable or could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeUnsupportedOperationException "UnsupportedOperationException"
-//   The operation is not supported.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeConditionalForwarders
-func (c *DirectoryService) DescribeConditionalForwarders(input *DescribeConditionalForwardersInput) (*DescribeConditionalForwardersOutput, error) {
-	req, out := c.DescribeConditionalForwardersRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DeregisterEventTopic
+func (c *DirectoryService) DeregisterEventTopic(input *DeregisterEventTopicInput) (*DeregisterEventTopicOutput, error) {
+	req, out := c.DeregisterEventTopicRequest(input)
 	return out, req.Send()
 }
 
-// DescribeConditionalForwardersWithContext is the same as DescribeConditionalForwarders with the addition of
+// DeregisterEventTopicWithContext is the same as DeregisterEventTopic with the addition of
 // the ability to pass a context and additional request options.
 //
-// See DescribeConditionalForwarders for details on how to use this API operation.
+// See DeregisterEventTopic for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) DescribeConditionalForwardersWithContext(ctx aws.Context, input *DescribeConditionalForwardersInput, opts ...request.Option) (*DescribeConditionalForwardersOutput, error) {
-	req, out := c.DescribeConditionalForwardersRequest(input)
+func (c *DirectoryService) DeregisterEventTopicWithContext(ctx aws.Context, input *DeregisterEventTopicInput, opts ...request.Option) (*DeregisterEventTopicOutput, error) {
+	req, out := c.DeregisterEventTopicRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opDescribeDirectories = "DescribeDirectories"
+const opDescribeCertificate = "DescribeCertificate"
 
-// DescribeDirectoriesRequest generates a "aws/request.Request" representing the
-// client's request for the DescribeDirectories operation. The "output" return
+// DescribeCertificateRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeCertificate operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See DescribeDirectories for more information on using the DescribeDirectories
+// See DescribeCertificate for more information on using the DescribeCertificate
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeCertificateRequest method.
+//	req, resp := client.DescribeCertificateRequest(params)
 //
-//    // Example sending a request using the DescribeDirectoriesRequest method.
-//    req, resp := client.DescribeDirectoriesRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeDirectories
-func (c *DirectoryService) DescribeDirectoriesRequest(input *DescribeDirectoriesInput) (req *request.Request, output *DescribeDirectoriesOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeCertificate
+func (c *DirectoryService) DescribeCertificateRequest(input *DescribeCertificateInput) (req *request.Request, output *DescribeCertificateOutput) {
 	op := &request.Operation{
-		Name:       opDescribeDirectories,
+		Name:       opDescribeCertificate,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &DescribeDirectoriesInput{}
+		input = &DescribeCertificateInput{}
 	}
 
-	output = &DescribeDirectoriesOutput{}
+	output = &DescribeCertificateOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// DescribeDirectories API operation for AWS Directory Service.
-//
-// Obtains information about the directories that belong to this account.
-//
-// You can retrieve information about specific directories by passing the directory
-// identifiers in the DirectoryIds parameter. Otherwise, all directories that
-// belong to the current account are returned.
-//
-// This operation supports pagination with the use of the NextToken request
-// and response parameters. If more results are available, the DescribeDirectoriesResult.NextToken
-// member contains a token that you pass in the next call to DescribeDirectories
-// to retrieve the next set of items.
+// DescribeCertificate API operation for AWS Directory Service.
 //
-// You can also specify a maximum number of return results with the Limit parameter.
+// Displays information about the certificate registered for secure LDAP or
+// client certificate authentication.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation DescribeDirectories for usage and error information.
+// API operation DescribeCertificate for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - DirectoryDoesNotExistException
+//     The specified directory does not exist in the system.
 //
-//   * ErrCodeInvalidNextTokenException "InvalidNextTokenException"
-//   The NextToken value is not valid.
+//   - UnsupportedOperationException
+//     The operation is not supported.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - CertificateDoesNotExistException
+//     The certificate is not present in the system for describe or deregister activities.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeDirectories
-func (c *DirectoryService) DescribeDirectories(input *DescribeDirectoriesInput) (*DescribeDirectoriesOutput, error) {
-	req, out := c.DescribeDirectoriesRequest(input)
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeCertificate
+func (c *DirectoryService) DescribeCertificate(input *DescribeCertificateInput) (*DescribeCertificateOutput, error) {
+	req, out := c.DescribeCertificateRequest(input)
 	return out, req.Send()
 }
 
-// DescribeDirectoriesWithContext is the same as DescribeDirectories with the addition of
+// DescribeCertificateWithContext is the same as DescribeCertificate with the addition of
 // the ability to pass a context and additional request options.
 //
-// See DescribeDirectories for details on how to use this API operation.
+// See DescribeCertificate for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) DescribeDirectoriesWithContext(ctx aws.Context, input *DescribeDirectoriesInput, opts ...request.Option) (*DescribeDirectoriesOutput, error) {
-	req, out := c.DescribeDirectoriesRequest(input)
+func (c *DirectoryService) DescribeCertificateWithContext(ctx aws.Context, input *DescribeCertificateInput, opts ...request.Option) (*DescribeCertificateOutput, error) {
+	req, out := c.DescribeCertificateRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opDescribeDomainControllers = "DescribeDomainControllers"
+const opDescribeClientAuthenticationSettings = "DescribeClientAuthenticationSettings"
 
-// DescribeDomainControllersRequest generates a "aws/request.Request" representing the
-// client's request for the DescribeDomainControllers operation. The "output" return
+// DescribeClientAuthenticationSettingsRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeClientAuthenticationSettings operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See DescribeDomainControllers for more information on using the DescribeDomainControllers
+// See DescribeClientAuthenticationSettings for more information on using the DescribeClientAuthenticationSettings
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeClientAuthenticationSettingsRequest method.
+//	req, resp := client.DescribeClientAuthenticationSettingsRequest(params)
 //
-//    // Example sending a request using the DescribeDomainControllersRequest method.
-//    req, resp := client.DescribeDomainControllersRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeDomainControllers
-func (c *DirectoryService) DescribeDomainControllersRequest(input *DescribeDomainControllersInput) (req *request.Request, output *DescribeDomainControllersOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeClientAuthenticationSettings
+func (c *DirectoryService) DescribeClientAuthenticationSettingsRequest(input *DescribeClientAuthenticationSettingsInput) (req *request.Request, output *DescribeClientAuthenticationSettingsOutput) {
 	op := &request.Operation{
-		Name:       opDescribeDomainControllers,
+		Name:       opDescribeClientAuthenticationSettings,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 		Paginator: &request.Paginator{
@@ -2056,2764 +2173,8240 @@ func (c *DirectoryService) DescribeDomainControllersRequest(input *DescribeDomai
 	}
 
 	if input == nil {
-		input = &DescribeDomainControllersInput{}
+		input = &DescribeClientAuthenticationSettingsInput{}
 	}
 
-	output = &DescribeDomainControllersOutput{}
+	output = &DescribeClientAuthenticationSettingsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// DescribeDomainControllers API operation for AWS Directory Service.
+// DescribeClientAuthenticationSettings API operation for AWS Directory Service.
 //
-// Provides information about any domain controllers in your directory.
+// Retrieves information about the type of client authentication for the specified
+// directory, if the type is specified. If no type is specified, information
+// about all client authentication types that are supported for the specified
+// directory is retrieved. Currently, only SmartCard is supported.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation DescribeDomainControllers for usage and error information.
+// API operation DescribeClientAuthenticationSettings for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidNextTokenException "InvalidNextTokenException"
-//   The NextToken value is not valid.
+//   - DirectoryDoesNotExistException
+//     The specified directory does not exist in the system.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - UnsupportedOperationException
+//     The operation is not supported.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - AccessDeniedException
+//     Client authentication is not available in this region at this time.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeUnsupportedOperationException "UnsupportedOperationException"
-//   The operation is not supported.
+//   - ClientException
+//     A client exception has occurred.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeDomainControllers
-func (c *DirectoryService) DescribeDomainControllers(input *DescribeDomainControllersInput) (*DescribeDomainControllersOutput, error) {
-	req, out := c.DescribeDomainControllersRequest(input)
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeClientAuthenticationSettings
+func (c *DirectoryService) DescribeClientAuthenticationSettings(input *DescribeClientAuthenticationSettingsInput) (*DescribeClientAuthenticationSettingsOutput, error) {
+	req, out := c.DescribeClientAuthenticationSettingsRequest(input)
 	return out, req.Send()
 }
 
-// DescribeDomainControllersWithContext is the same as DescribeDomainControllers with the addition of
+// DescribeClientAuthenticationSettingsWithContext is the same as DescribeClientAuthenticationSettings with the addition of
 // the ability to pass a context and additional request options.
 //
-// See DescribeDomainControllers for details on how to use this API operation.
+// See DescribeClientAuthenticationSettings for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) DescribeDomainControllersWithContext(ctx aws.Context, input *DescribeDomainControllersInput, opts ...request.Option) (*DescribeDomainControllersOutput, error) {
-	req, out := c.DescribeDomainControllersRequest(input)
+func (c *DirectoryService) DescribeClientAuthenticationSettingsWithContext(ctx aws.Context, input *DescribeClientAuthenticationSettingsInput, opts ...request.Option) (*DescribeClientAuthenticationSettingsOutput, error) {
+	req, out := c.DescribeClientAuthenticationSettingsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-// DescribeDomainControllersPages iterates over the pages of a DescribeDomainControllers operation,
+// DescribeClientAuthenticationSettingsPages iterates over the pages of a DescribeClientAuthenticationSettings operation,
 // calling the "fn" function with the response data for each page. To stop
 // iterating, return false from the fn function.
 //
-// See DescribeDomainControllers method for more information on how to use this operation.
+// See DescribeClientAuthenticationSettings method for more information on how to use this operation.
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a DescribeDomainControllers operation.
-//    pageNum := 0
-//    err := client.DescribeDomainControllersPages(params,
-//        func(page *directoryservice.DescribeDomainControllersOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
-func (c *DirectoryService) DescribeDomainControllersPages(input *DescribeDomainControllersInput, fn func(*DescribeDomainControllersOutput, bool) bool) error {
-	return c.DescribeDomainControllersPagesWithContext(aws.BackgroundContext(), input, fn)
+//	// Example iterating over at most 3 pages of a DescribeClientAuthenticationSettings operation.
+//	pageNum := 0
+//	err := client.DescribeClientAuthenticationSettingsPages(params,
+//	    func(page *directoryservice.DescribeClientAuthenticationSettingsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *DirectoryService) DescribeClientAuthenticationSettingsPages(input *DescribeClientAuthenticationSettingsInput, fn func(*DescribeClientAuthenticationSettingsOutput, bool) bool) error {
+	return c.DescribeClientAuthenticationSettingsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
 
-// DescribeDomainControllersPagesWithContext same as DescribeDomainControllersPages except
+// DescribeClientAuthenticationSettingsPagesWithContext same as DescribeClientAuthenticationSettingsPages except
 // it takes a Context and allows setting request options on the pages.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) DescribeDomainControllersPagesWithContext(ctx aws.Context, input *DescribeDomainControllersInput, fn func(*DescribeDomainControllersOutput, bool) bool, opts ...request.Option) error {
+func (c *DirectoryService) DescribeClientAuthenticationSettingsPagesWithContext(ctx aws.Context, input *DescribeClientAuthenticationSettingsInput, fn func(*DescribeClientAuthenticationSettingsOutput, bool) bool, opts ...request.Option) error {
 	p := request.Pagination{
 		NewRequest: func() (*request.Request, error) {
-			var inCpy *DescribeDomainControllersInput
+			var inCpy *DescribeClientAuthenticationSettingsInput
 			if input != nil {
 				tmp := *input
 				inCpy = &tmp
 			}
-			req, _ := c.DescribeDomainControllersRequest(inCpy)
+			req, _ := c.DescribeClientAuthenticationSettingsRequest(inCpy)
 			req.SetContext(ctx)
 			req.ApplyOptions(opts...)
 			return req, nil
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*DescribeDomainControllersOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*DescribeClientAuthenticationSettingsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
-const opDescribeEventTopics = "DescribeEventTopics"
+const opDescribeConditionalForwarders = "DescribeConditionalForwarders"
 
-// DescribeEventTopicsRequest generates a "aws/request.Request" representing the
-// client's request for the DescribeEventTopics operation. The "output" return
+// DescribeConditionalForwardersRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeConditionalForwarders operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See DescribeEventTopics for more information on using the DescribeEventTopics
+// See DescribeConditionalForwarders for more information on using the DescribeConditionalForwarders
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeConditionalForwardersRequest method.
+//	req, resp := client.DescribeConditionalForwardersRequest(params)
 //
-//    // Example sending a request using the DescribeEventTopicsRequest method.
-//    req, resp := client.DescribeEventTopicsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeEventTopics
-func (c *DirectoryService) DescribeEventTopicsRequest(input *DescribeEventTopicsInput) (req *request.Request, output *DescribeEventTopicsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeConditionalForwarders
+func (c *DirectoryService) DescribeConditionalForwardersRequest(input *DescribeConditionalForwardersInput) (req *request.Request, output *DescribeConditionalForwardersOutput) {
 	op := &request.Operation{
-		Name:       opDescribeEventTopics,
+		Name:       opDescribeConditionalForwarders,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &DescribeEventTopicsInput{}
+		input = &DescribeConditionalForwardersInput{}
 	}
 
-	output = &DescribeEventTopicsOutput{}
+	output = &DescribeConditionalForwardersOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// DescribeEventTopics API operation for AWS Directory Service.
-//
-// Obtains information about which SNS topics receive status messages from the
-// specified directory.
+// DescribeConditionalForwarders API operation for AWS Directory Service.
 //
-// If no input parameters are provided, such as DirectoryId or TopicName, this
-// request describes all of the associations in the account.
+// Obtains information about the conditional forwarders for this account.
+//
+// If no input parameters are provided for RemoteDomainNames, this request describes
+// all conditional forwarders for the specified directory ID.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation DescribeEventTopics for usage and error information.
+// API operation DescribeConditionalForwarders for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - DirectoryUnavailableException
+//     The specified directory is unavailable or could not be found.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeEventTopics
-func (c *DirectoryService) DescribeEventTopics(input *DescribeEventTopicsInput) (*DescribeEventTopicsOutput, error) {
-	req, out := c.DescribeEventTopicsRequest(input)
+//   - UnsupportedOperationException
+//     The operation is not supported.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeConditionalForwarders
+func (c *DirectoryService) DescribeConditionalForwarders(input *DescribeConditionalForwardersInput) (*DescribeConditionalForwardersOutput, error) {
+	req, out := c.DescribeConditionalForwardersRequest(input)
 	return out, req.Send()
 }
 
-// DescribeEventTopicsWithContext is the same as DescribeEventTopics with the addition of
+// DescribeConditionalForwardersWithContext is the same as DescribeConditionalForwarders with the addition of
 // the ability to pass a context and additional request options.
 //
-// See DescribeEventTopics for details on how to use this API operation.
+// See DescribeConditionalForwarders for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) DescribeEventTopicsWithContext(ctx aws.Context, input *DescribeEventTopicsInput, opts ...request.Option) (*DescribeEventTopicsOutput, error) {
-	req, out := c.DescribeEventTopicsRequest(input)
+func (c *DirectoryService) DescribeConditionalForwardersWithContext(ctx aws.Context, input *DescribeConditionalForwardersInput, opts ...request.Option) (*DescribeConditionalForwardersOutput, error) {
+	req, out := c.DescribeConditionalForwardersRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opDescribeSharedDirectories = "DescribeSharedDirectories"
+const opDescribeDirectories = "DescribeDirectories"
 
-// DescribeSharedDirectoriesRequest generates a "aws/request.Request" representing the
-// client's request for the DescribeSharedDirectories operation. The "output" return
+// DescribeDirectoriesRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeDirectories operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See DescribeSharedDirectories for more information on using the DescribeSharedDirectories
+// See DescribeDirectories for more information on using the DescribeDirectories
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeDirectoriesRequest method.
+//	req, resp := client.DescribeDirectoriesRequest(params)
 //
-//    // Example sending a request using the DescribeSharedDirectoriesRequest method.
-//    req, resp := client.DescribeSharedDirectoriesRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeSharedDirectories
-func (c *DirectoryService) DescribeSharedDirectoriesRequest(input *DescribeSharedDirectoriesInput) (req *request.Request, output *DescribeSharedDirectoriesOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeDirectories
+func (c *DirectoryService) DescribeDirectoriesRequest(input *DescribeDirectoriesInput) (req *request.Request, output *DescribeDirectoriesOutput) {
 	op := &request.Operation{
-		Name:       opDescribeSharedDirectories,
+		Name:       opDescribeDirectories,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "Limit",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &DescribeSharedDirectoriesInput{}
+		input = &DescribeDirectoriesInput{}
 	}
 
-	output = &DescribeSharedDirectoriesOutput{}
+	output = &DescribeDirectoriesOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// DescribeSharedDirectories API operation for AWS Directory Service.
+// DescribeDirectories API operation for AWS Directory Service.
 //
-// Returns the shared directories in your account.
+// Obtains information about the directories that belong to this account.
+//
+// You can retrieve information about specific directories by passing the directory
+// identifiers in the DirectoryIds parameter. Otherwise, all directories that
+// belong to the current account are returned.
+//
+// This operation supports pagination with the use of the NextToken request
+// and response parameters. If more results are available, the DescribeDirectoriesResult.NextToken
+// member contains a token that you pass in the next call to DescribeDirectories
+// to retrieve the next set of items.
+//
+// You can also specify a maximum number of return results with the Limit parameter.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation DescribeSharedDirectories for usage and error information.
+// API operation DescribeDirectories for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidNextTokenException "InvalidNextTokenException"
-//   The NextToken value is not valid.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeUnsupportedOperationException "UnsupportedOperationException"
-//   The operation is not supported.
+//   - InvalidNextTokenException
+//     The NextToken value is not valid.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeSharedDirectories
-func (c *DirectoryService) DescribeSharedDirectories(input *DescribeSharedDirectoriesInput) (*DescribeSharedDirectoriesOutput, error) {
-	req, out := c.DescribeSharedDirectoriesRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeDirectories
+func (c *DirectoryService) DescribeDirectories(input *DescribeDirectoriesInput) (*DescribeDirectoriesOutput, error) {
+	req, out := c.DescribeDirectoriesRequest(input)
 	return out, req.Send()
 }
 
-// DescribeSharedDirectoriesWithContext is the same as DescribeSharedDirectories with the addition of
+// DescribeDirectoriesWithContext is the same as DescribeDirectories with the addition of
 // the ability to pass a context and additional request options.
 //
-// See DescribeSharedDirectories for details on how to use this API operation.
+// See DescribeDirectories for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) DescribeSharedDirectoriesWithContext(ctx aws.Context, input *DescribeSharedDirectoriesInput, opts ...request.Option) (*DescribeSharedDirectoriesOutput, error) {
-	req, out := c.DescribeSharedDirectoriesRequest(input)
+func (c *DirectoryService) DescribeDirectoriesWithContext(ctx aws.Context, input *DescribeDirectoriesInput, opts ...request.Option) (*DescribeDirectoriesOutput, error) {
+	req, out := c.DescribeDirectoriesRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opDescribeSnapshots = "DescribeSnapshots"
+// DescribeDirectoriesPages iterates over the pages of a DescribeDirectories operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See DescribeDirectories method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a DescribeDirectories operation.
+//	pageNum := 0
+//	err := client.DescribeDirectoriesPages(params,
+//	    func(page *directoryservice.DescribeDirectoriesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *DirectoryService) DescribeDirectoriesPages(input *DescribeDirectoriesInput, fn func(*DescribeDirectoriesOutput, bool) bool) error {
+	return c.DescribeDirectoriesPagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-// DescribeSnapshotsRequest generates a "aws/request.Request" representing the
-// client's request for the DescribeSnapshots operation. The "output" return
+// DescribeDirectoriesPagesWithContext same as DescribeDirectoriesPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) DescribeDirectoriesPagesWithContext(ctx aws.Context, input *DescribeDirectoriesInput, fn func(*DescribeDirectoriesOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *DescribeDirectoriesInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.DescribeDirectoriesRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*DescribeDirectoriesOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opDescribeDomainControllers = "DescribeDomainControllers"
+
+// DescribeDomainControllersRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeDomainControllers operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See DescribeSnapshots for more information on using the DescribeSnapshots
+// See DescribeDomainControllers for more information on using the DescribeDomainControllers
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeDomainControllersRequest method.
+//	req, resp := client.DescribeDomainControllersRequest(params)
 //
-//    // Example sending a request using the DescribeSnapshotsRequest method.
-//    req, resp := client.DescribeSnapshotsRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeSnapshots
-func (c *DirectoryService) DescribeSnapshotsRequest(input *DescribeSnapshotsInput) (req *request.Request, output *DescribeSnapshotsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeDomainControllers
+func (c *DirectoryService) DescribeDomainControllersRequest(input *DescribeDomainControllersInput) (req *request.Request, output *DescribeDomainControllersOutput) {
 	op := &request.Operation{
-		Name:       opDescribeSnapshots,
+		Name:       opDescribeDomainControllers,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "Limit",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &DescribeSnapshotsInput{}
+		input = &DescribeDomainControllersInput{}
 	}
 
-	output = &DescribeSnapshotsOutput{}
+	output = &DescribeDomainControllersOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// DescribeSnapshots API operation for AWS Directory Service.
-//
-// Obtains information about the directory snapshots that belong to this account.
-//
-// This operation supports pagination with the use of the NextToken request
-// and response parameters. If more results are available, the DescribeSnapshots.NextToken
-// member contains a token that you pass in the next call to DescribeSnapshots
-// to retrieve the next set of items.
+// DescribeDomainControllers API operation for AWS Directory Service.
 //
-// You can also specify a maximum number of return results with the Limit parameter.
+// Provides information about any domain controllers in your directory.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation DescribeSnapshots for usage and error information.
+// API operation DescribeDomainControllers for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeInvalidNextTokenException "InvalidNextTokenException"
-//   The NextToken value is not valid.
+//   - InvalidNextTokenException
+//     The NextToken value is not valid.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ClientException
+//     A client exception has occurred.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeSnapshots
-func (c *DirectoryService) DescribeSnapshots(input *DescribeSnapshotsInput) (*DescribeSnapshotsOutput, error) {
-	req, out := c.DescribeSnapshotsRequest(input)
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+//   - UnsupportedOperationException
+//     The operation is not supported.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeDomainControllers
+func (c *DirectoryService) DescribeDomainControllers(input *DescribeDomainControllersInput) (*DescribeDomainControllersOutput, error) {
+	req, out := c.DescribeDomainControllersRequest(input)
 	return out, req.Send()
 }
 
-// DescribeSnapshotsWithContext is the same as DescribeSnapshots with the addition of
+// DescribeDomainControllersWithContext is the same as DescribeDomainControllers with the addition of
 // the ability to pass a context and additional request options.
 //
-// See DescribeSnapshots for details on how to use this API operation.
+// See DescribeDomainControllers for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) DescribeSnapshotsWithContext(ctx aws.Context, input *DescribeSnapshotsInput, opts ...request.Option) (*DescribeSnapshotsOutput, error) {
-	req, out := c.DescribeSnapshotsRequest(input)
+func (c *DirectoryService) DescribeDomainControllersWithContext(ctx aws.Context, input *DescribeDomainControllersInput, opts ...request.Option) (*DescribeDomainControllersOutput, error) {
+	req, out := c.DescribeDomainControllersRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opDescribeTrusts = "DescribeTrusts"
+// DescribeDomainControllersPages iterates over the pages of a DescribeDomainControllers operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See DescribeDomainControllers method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a DescribeDomainControllers operation.
+//	pageNum := 0
+//	err := client.DescribeDomainControllersPages(params,
+//	    func(page *directoryservice.DescribeDomainControllersOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *DirectoryService) DescribeDomainControllersPages(input *DescribeDomainControllersInput, fn func(*DescribeDomainControllersOutput, bool) bool) error {
+	return c.DescribeDomainControllersPagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-// DescribeTrustsRequest generates a "aws/request.Request" representing the
-// client's request for the DescribeTrusts operation. The "output" return
+// DescribeDomainControllersPagesWithContext same as DescribeDomainControllersPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) DescribeDomainControllersPagesWithContext(ctx aws.Context, input *DescribeDomainControllersInput, fn func(*DescribeDomainControllersOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *DescribeDomainControllersInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.DescribeDomainControllersRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*DescribeDomainControllersOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opDescribeEventTopics = "DescribeEventTopics"
+
+// DescribeEventTopicsRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeEventTopics operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See DescribeTrusts for more information on using the DescribeTrusts
+// See DescribeEventTopics for more information on using the DescribeEventTopics
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeEventTopicsRequest method.
+//	req, resp := client.DescribeEventTopicsRequest(params)
 //
-//    // Example sending a request using the DescribeTrustsRequest method.
-//    req, resp := client.DescribeTrustsRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeTrusts
-func (c *DirectoryService) DescribeTrustsRequest(input *DescribeTrustsInput) (req *request.Request, output *DescribeTrustsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeEventTopics
+func (c *DirectoryService) DescribeEventTopicsRequest(input *DescribeEventTopicsInput) (req *request.Request, output *DescribeEventTopicsOutput) {
 	op := &request.Operation{
-		Name:       opDescribeTrusts,
+		Name:       opDescribeEventTopics,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &DescribeTrustsInput{}
+		input = &DescribeEventTopicsInput{}
 	}
 
-	output = &DescribeTrustsOutput{}
+	output = &DescribeEventTopicsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// DescribeTrusts API operation for AWS Directory Service.
+// DescribeEventTopics API operation for AWS Directory Service.
 //
-// Obtains information about the trust relationships for this account.
+// Obtains information about which Amazon SNS topics receive status messages
+// from the specified directory.
 //
-// If no input parameters are provided, such as DirectoryId or TrustIds, this
-// request describes all the trust relationships belonging to the account.
+// If no input parameters are provided, such as DirectoryId or TopicName, this
+// request describes all of the associations in the account.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation DescribeTrusts for usage and error information.
-//
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// API operation DescribeEventTopics for usage and error information.
 //
-//   * ErrCodeInvalidNextTokenException "InvalidNextTokenException"
-//   The NextToken value is not valid.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeUnsupportedOperationException "UnsupportedOperationException"
-//   The operation is not supported.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeTrusts
-func (c *DirectoryService) DescribeTrusts(input *DescribeTrustsInput) (*DescribeTrustsOutput, error) {
-	req, out := c.DescribeTrustsRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeEventTopics
+func (c *DirectoryService) DescribeEventTopics(input *DescribeEventTopicsInput) (*DescribeEventTopicsOutput, error) {
+	req, out := c.DescribeEventTopicsRequest(input)
 	return out, req.Send()
 }
 
-// DescribeTrustsWithContext is the same as DescribeTrusts with the addition of
+// DescribeEventTopicsWithContext is the same as DescribeEventTopics with the addition of
 // the ability to pass a context and additional request options.
 //
-// See DescribeTrusts for details on how to use this API operation.
+// See DescribeEventTopics for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) DescribeTrustsWithContext(ctx aws.Context, input *DescribeTrustsInput, opts ...request.Option) (*DescribeTrustsOutput, error) {
-	req, out := c.DescribeTrustsRequest(input)
+func (c *DirectoryService) DescribeEventTopicsWithContext(ctx aws.Context, input *DescribeEventTopicsInput, opts ...request.Option) (*DescribeEventTopicsOutput, error) {
+	req, out := c.DescribeEventTopicsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opDisableRadius = "DisableRadius"
+const opDescribeLDAPSSettings = "DescribeLDAPSSettings"
 
-// DisableRadiusRequest generates a "aws/request.Request" representing the
-// client's request for the DisableRadius operation. The "output" return
+// DescribeLDAPSSettingsRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeLDAPSSettings operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See DisableRadius for more information on using the DisableRadius
+// See DescribeLDAPSSettings for more information on using the DescribeLDAPSSettings
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeLDAPSSettingsRequest method.
+//	req, resp := client.DescribeLDAPSSettingsRequest(params)
 //
-//    // Example sending a request using the DisableRadiusRequest method.
-//    req, resp := client.DisableRadiusRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DisableRadius
-func (c *DirectoryService) DisableRadiusRequest(input *DisableRadiusInput) (req *request.Request, output *DisableRadiusOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeLDAPSSettings
+func (c *DirectoryService) DescribeLDAPSSettingsRequest(input *DescribeLDAPSSettingsInput) (req *request.Request, output *DescribeLDAPSSettingsOutput) {
 	op := &request.Operation{
-		Name:       opDisableRadius,
+		Name:       opDescribeLDAPSSettings,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
-	}
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "Limit",
+			TruncationToken: "",
+		},
+	}
 
 	if input == nil {
-		input = &DisableRadiusInput{}
+		input = &DescribeLDAPSSettingsInput{}
 	}
 
-	output = &DisableRadiusOutput{}
+	output = &DescribeLDAPSSettingsOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// DisableRadius API operation for AWS Directory Service.
+// DescribeLDAPSSettings API operation for AWS Directory Service.
 //
-// Disables multi-factor authentication (MFA) with the Remote Authentication
-// Dial In User Service (RADIUS) server for an AD Connector or Microsoft AD
-// directory.
+// Describes the status of LDAP security for the specified directory.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation DisableRadius for usage and error information.
+// API operation DescribeLDAPSSettings for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - DirectoryDoesNotExistException
+//     The specified directory does not exist in the system.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - UnsupportedOperationException
+//     The operation is not supported.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DisableRadius
-func (c *DirectoryService) DisableRadius(input *DisableRadiusInput) (*DisableRadiusOutput, error) {
-	req, out := c.DisableRadiusRequest(input)
+//   - InvalidNextTokenException
+//     The NextToken value is not valid.
+//
+//   - InvalidParameterException
+//     One or more parameters are not valid.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeLDAPSSettings
+func (c *DirectoryService) DescribeLDAPSSettings(input *DescribeLDAPSSettingsInput) (*DescribeLDAPSSettingsOutput, error) {
+	req, out := c.DescribeLDAPSSettingsRequest(input)
 	return out, req.Send()
 }
 
-// DisableRadiusWithContext is the same as DisableRadius with the addition of
+// DescribeLDAPSSettingsWithContext is the same as DescribeLDAPSSettings with the addition of
 // the ability to pass a context and additional request options.
 //
-// See DisableRadius for details on how to use this API operation.
+// See DescribeLDAPSSettings for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) DisableRadiusWithContext(ctx aws.Context, input *DisableRadiusInput, opts ...request.Option) (*DisableRadiusOutput, error) {
-	req, out := c.DisableRadiusRequest(input)
+func (c *DirectoryService) DescribeLDAPSSettingsWithContext(ctx aws.Context, input *DescribeLDAPSSettingsInput, opts ...request.Option) (*DescribeLDAPSSettingsOutput, error) {
+	req, out := c.DescribeLDAPSSettingsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opDisableSso = "DisableSso"
+// DescribeLDAPSSettingsPages iterates over the pages of a DescribeLDAPSSettings operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See DescribeLDAPSSettings method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a DescribeLDAPSSettings operation.
+//	pageNum := 0
+//	err := client.DescribeLDAPSSettingsPages(params,
+//	    func(page *directoryservice.DescribeLDAPSSettingsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *DirectoryService) DescribeLDAPSSettingsPages(input *DescribeLDAPSSettingsInput, fn func(*DescribeLDAPSSettingsOutput, bool) bool) error {
+	return c.DescribeLDAPSSettingsPagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-// DisableSsoRequest generates a "aws/request.Request" representing the
-// client's request for the DisableSso operation. The "output" return
+// DescribeLDAPSSettingsPagesWithContext same as DescribeLDAPSSettingsPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) DescribeLDAPSSettingsPagesWithContext(ctx aws.Context, input *DescribeLDAPSSettingsInput, fn func(*DescribeLDAPSSettingsOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *DescribeLDAPSSettingsInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.DescribeLDAPSSettingsRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*DescribeLDAPSSettingsOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opDescribeRegions = "DescribeRegions"
+
+// DescribeRegionsRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeRegions operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See DisableSso for more information on using the DisableSso
+// See DescribeRegions for more information on using the DescribeRegions
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeRegionsRequest method.
+//	req, resp := client.DescribeRegionsRequest(params)
 //
-//    // Example sending a request using the DisableSsoRequest method.
-//    req, resp := client.DisableSsoRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DisableSso
-func (c *DirectoryService) DisableSsoRequest(input *DisableSsoInput) (req *request.Request, output *DisableSsoOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeRegions
+func (c *DirectoryService) DescribeRegionsRequest(input *DescribeRegionsInput) (req *request.Request, output *DescribeRegionsOutput) {
 	op := &request.Operation{
-		Name:       opDisableSso,
+		Name:       opDescribeRegions,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &DisableSsoInput{}
+		input = &DescribeRegionsInput{}
 	}
 
-	output = &DisableSsoOutput{}
+	output = &DescribeRegionsOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// DisableSso API operation for AWS Directory Service.
+// DescribeRegions API operation for AWS Directory Service.
 //
-// Disables single-sign on for a directory.
+// Provides information about the Regions that are configured for multi-Region
+// replication.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation DisableSso for usage and error information.
+// API operation DescribeRegions for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeInsufficientPermissionsException "InsufficientPermissionsException"
-//   The account does not have sufficient permission to perform the operation.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeAuthenticationFailedException "AuthenticationFailedException"
-//   An authentication error occurred.
+//   - DirectoryDoesNotExistException
+//     The specified directory does not exist in the system.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - UnsupportedOperationException
+//     The operation is not supported.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - InvalidNextTokenException
+//     The NextToken value is not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DisableSso
-func (c *DirectoryService) DisableSso(input *DisableSsoInput) (*DisableSsoOutput, error) {
-	req, out := c.DisableSsoRequest(input)
+//   - AccessDeniedException
+//     Client authentication is not available in this region at this time.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeRegions
+func (c *DirectoryService) DescribeRegions(input *DescribeRegionsInput) (*DescribeRegionsOutput, error) {
+	req, out := c.DescribeRegionsRequest(input)
 	return out, req.Send()
 }
 
-// DisableSsoWithContext is the same as DisableSso with the addition of
+// DescribeRegionsWithContext is the same as DescribeRegions with the addition of
 // the ability to pass a context and additional request options.
 //
-// See DisableSso for details on how to use this API operation.
+// See DescribeRegions for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) DisableSsoWithContext(ctx aws.Context, input *DisableSsoInput, opts ...request.Option) (*DisableSsoOutput, error) {
-	req, out := c.DisableSsoRequest(input)
+func (c *DirectoryService) DescribeRegionsWithContext(ctx aws.Context, input *DescribeRegionsInput, opts ...request.Option) (*DescribeRegionsOutput, error) {
+	req, out := c.DescribeRegionsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opEnableRadius = "EnableRadius"
+// DescribeRegionsPages iterates over the pages of a DescribeRegions operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See DescribeRegions method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a DescribeRegions operation.
+//	pageNum := 0
+//	err := client.DescribeRegionsPages(params,
+//	    func(page *directoryservice.DescribeRegionsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *DirectoryService) DescribeRegionsPages(input *DescribeRegionsInput, fn func(*DescribeRegionsOutput, bool) bool) error {
+	return c.DescribeRegionsPagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-// EnableRadiusRequest generates a "aws/request.Request" representing the
-// client's request for the EnableRadius operation. The "output" return
+// DescribeRegionsPagesWithContext same as DescribeRegionsPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) DescribeRegionsPagesWithContext(ctx aws.Context, input *DescribeRegionsInput, fn func(*DescribeRegionsOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *DescribeRegionsInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.DescribeRegionsRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*DescribeRegionsOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opDescribeSettings = "DescribeSettings"
+
+// DescribeSettingsRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeSettings operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See EnableRadius for more information on using the EnableRadius
+// See DescribeSettings for more information on using the DescribeSettings
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeSettingsRequest method.
+//	req, resp := client.DescribeSettingsRequest(params)
 //
-//    // Example sending a request using the EnableRadiusRequest method.
-//    req, resp := client.EnableRadiusRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/EnableRadius
-func (c *DirectoryService) EnableRadiusRequest(input *EnableRadiusInput) (req *request.Request, output *EnableRadiusOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeSettings
+func (c *DirectoryService) DescribeSettingsRequest(input *DescribeSettingsInput) (req *request.Request, output *DescribeSettingsOutput) {
 	op := &request.Operation{
-		Name:       opEnableRadius,
+		Name:       opDescribeSettings,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &EnableRadiusInput{}
+		input = &DescribeSettingsInput{}
 	}
 
-	output = &EnableRadiusOutput{}
+	output = &DescribeSettingsOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// EnableRadius API operation for AWS Directory Service.
+// DescribeSettings API operation for AWS Directory Service.
 //
-// Enables multi-factor authentication (MFA) with the Remote Authentication
-// Dial In User Service (RADIUS) server for an AD Connector or Microsoft AD
-// directory.
+// Retrieves information about the configurable settings for the specified directory.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation EnableRadius for usage and error information.
+// API operation DescribeSettings for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+// Returned Error Types:
 //
-//   * ErrCodeEntityAlreadyExistsException "EntityAlreadyExistsException"
-//   The specified entity already exists.
+//   - DirectoryDoesNotExistException
+//     The specified directory does not exist in the system.
 //
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+//   - UnsupportedOperationException
+//     The operation is not supported.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - InvalidNextTokenException
+//     The NextToken value is not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/EnableRadius
-func (c *DirectoryService) EnableRadius(input *EnableRadiusInput) (*EnableRadiusOutput, error) {
-	req, out := c.EnableRadiusRequest(input)
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeSettings
+func (c *DirectoryService) DescribeSettings(input *DescribeSettingsInput) (*DescribeSettingsOutput, error) {
+	req, out := c.DescribeSettingsRequest(input)
 	return out, req.Send()
 }
 
-// EnableRadiusWithContext is the same as EnableRadius with the addition of
+// DescribeSettingsWithContext is the same as DescribeSettings with the addition of
 // the ability to pass a context and additional request options.
 //
-// See EnableRadius for details on how to use this API operation.
+// See DescribeSettings for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) EnableRadiusWithContext(ctx aws.Context, input *EnableRadiusInput, opts ...request.Option) (*EnableRadiusOutput, error) {
-	req, out := c.EnableRadiusRequest(input)
+func (c *DirectoryService) DescribeSettingsWithContext(ctx aws.Context, input *DescribeSettingsInput, opts ...request.Option) (*DescribeSettingsOutput, error) {
+	req, out := c.DescribeSettingsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opEnableSso = "EnableSso"
+const opDescribeSharedDirectories = "DescribeSharedDirectories"
 
-// EnableSsoRequest generates a "aws/request.Request" representing the
-// client's request for the EnableSso operation. The "output" return
+// DescribeSharedDirectoriesRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeSharedDirectories operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See EnableSso for more information on using the EnableSso
+// See DescribeSharedDirectories for more information on using the DescribeSharedDirectories
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeSharedDirectoriesRequest method.
+//	req, resp := client.DescribeSharedDirectoriesRequest(params)
 //
-//    // Example sending a request using the EnableSsoRequest method.
-//    req, resp := client.EnableSsoRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/EnableSso
-func (c *DirectoryService) EnableSsoRequest(input *EnableSsoInput) (req *request.Request, output *EnableSsoOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeSharedDirectories
+func (c *DirectoryService) DescribeSharedDirectoriesRequest(input *DescribeSharedDirectoriesInput) (req *request.Request, output *DescribeSharedDirectoriesOutput) {
 	op := &request.Operation{
-		Name:       opEnableSso,
+		Name:       opDescribeSharedDirectories,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "Limit",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &EnableSsoInput{}
+		input = &DescribeSharedDirectoriesInput{}
 	}
 
-	output = &EnableSsoOutput{}
+	output = &DescribeSharedDirectoriesOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// EnableSso API operation for AWS Directory Service.
+// DescribeSharedDirectories API operation for AWS Directory Service.
 //
-// Enables single sign-on for a directory.
+// Returns the shared directories in your account.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation EnableSso for usage and error information.
+// API operation DescribeSharedDirectories for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeInsufficientPermissionsException "InsufficientPermissionsException"
-//   The account does not have sufficient permission to perform the operation.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeAuthenticationFailedException "AuthenticationFailedException"
-//   An authentication error occurred.
+//   - InvalidNextTokenException
+//     The NextToken value is not valid.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - UnsupportedOperationException
+//     The operation is not supported.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/EnableSso
-func (c *DirectoryService) EnableSso(input *EnableSsoInput) (*EnableSsoOutput, error) {
-	req, out := c.EnableSsoRequest(input)
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeSharedDirectories
+func (c *DirectoryService) DescribeSharedDirectories(input *DescribeSharedDirectoriesInput) (*DescribeSharedDirectoriesOutput, error) {
+	req, out := c.DescribeSharedDirectoriesRequest(input)
 	return out, req.Send()
 }
 
-// EnableSsoWithContext is the same as EnableSso with the addition of
+// DescribeSharedDirectoriesWithContext is the same as DescribeSharedDirectories with the addition of
 // the ability to pass a context and additional request options.
 //
-// See EnableSso for details on how to use this API operation.
+// See DescribeSharedDirectories for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) EnableSsoWithContext(ctx aws.Context, input *EnableSsoInput, opts ...request.Option) (*EnableSsoOutput, error) {
-	req, out := c.EnableSsoRequest(input)
+func (c *DirectoryService) DescribeSharedDirectoriesWithContext(ctx aws.Context, input *DescribeSharedDirectoriesInput, opts ...request.Option) (*DescribeSharedDirectoriesOutput, error) {
+	req, out := c.DescribeSharedDirectoriesRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opGetDirectoryLimits = "GetDirectoryLimits"
+// DescribeSharedDirectoriesPages iterates over the pages of a DescribeSharedDirectories operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See DescribeSharedDirectories method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a DescribeSharedDirectories operation.
+//	pageNum := 0
+//	err := client.DescribeSharedDirectoriesPages(params,
+//	    func(page *directoryservice.DescribeSharedDirectoriesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *DirectoryService) DescribeSharedDirectoriesPages(input *DescribeSharedDirectoriesInput, fn func(*DescribeSharedDirectoriesOutput, bool) bool) error {
+	return c.DescribeSharedDirectoriesPagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-// GetDirectoryLimitsRequest generates a "aws/request.Request" representing the
-// client's request for the GetDirectoryLimits operation. The "output" return
+// DescribeSharedDirectoriesPagesWithContext same as DescribeSharedDirectoriesPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) DescribeSharedDirectoriesPagesWithContext(ctx aws.Context, input *DescribeSharedDirectoriesInput, fn func(*DescribeSharedDirectoriesOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *DescribeSharedDirectoriesInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.DescribeSharedDirectoriesRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*DescribeSharedDirectoriesOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opDescribeSnapshots = "DescribeSnapshots"
+
+// DescribeSnapshotsRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeSnapshots operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See GetDirectoryLimits for more information on using the GetDirectoryLimits
+// See DescribeSnapshots for more information on using the DescribeSnapshots
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeSnapshotsRequest method.
+//	req, resp := client.DescribeSnapshotsRequest(params)
 //
-//    // Example sending a request using the GetDirectoryLimitsRequest method.
-//    req, resp := client.GetDirectoryLimitsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/GetDirectoryLimits
-func (c *DirectoryService) GetDirectoryLimitsRequest(input *GetDirectoryLimitsInput) (req *request.Request, output *GetDirectoryLimitsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeSnapshots
+func (c *DirectoryService) DescribeSnapshotsRequest(input *DescribeSnapshotsInput) (req *request.Request, output *DescribeSnapshotsOutput) {
 	op := &request.Operation{
-		Name:       opGetDirectoryLimits,
+		Name:       opDescribeSnapshots,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "Limit",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &GetDirectoryLimitsInput{}
+		input = &DescribeSnapshotsInput{}
 	}
 
-	output = &GetDirectoryLimitsOutput{}
+	output = &DescribeSnapshotsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// GetDirectoryLimits API operation for AWS Directory Service.
-//
-// Obtains directory limit information for the current region.
+// DescribeSnapshots API operation for AWS Directory Service.
 //
-// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
-// with awserr.Error's Code and Message methods to get detailed information about
-// the error.
+// Obtains information about the directory snapshots that belong to this account.
 //
-// See the AWS API reference guide for AWS Directory Service's
-// API operation GetDirectoryLimits for usage and error information.
+// This operation supports pagination with the use of the NextToken request
+// and response parameters. If more results are available, the DescribeSnapshots.NextToken
+// member contains a token that you pass in the next call to DescribeSnapshots
+// to retrieve the next set of items.
+//
+// You can also specify a maximum number of return results with the Limit parameter.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// See the AWS API reference guide for AWS Directory Service's
+// API operation DescribeSnapshots for usage and error information.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+// Returned Error Types:
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/GetDirectoryLimits
-func (c *DirectoryService) GetDirectoryLimits(input *GetDirectoryLimitsInput) (*GetDirectoryLimitsOutput, error) {
-	req, out := c.GetDirectoryLimitsRequest(input)
+//   - InvalidParameterException
+//     One or more parameters are not valid.
+//
+//   - InvalidNextTokenException
+//     The NextToken value is not valid.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeSnapshots
+func (c *DirectoryService) DescribeSnapshots(input *DescribeSnapshotsInput) (*DescribeSnapshotsOutput, error) {
+	req, out := c.DescribeSnapshotsRequest(input)
 	return out, req.Send()
 }
 
-// GetDirectoryLimitsWithContext is the same as GetDirectoryLimits with the addition of
+// DescribeSnapshotsWithContext is the same as DescribeSnapshots with the addition of
 // the ability to pass a context and additional request options.
 //
-// See GetDirectoryLimits for details on how to use this API operation.
+// See DescribeSnapshots for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) GetDirectoryLimitsWithContext(ctx aws.Context, input *GetDirectoryLimitsInput, opts ...request.Option) (*GetDirectoryLimitsOutput, error) {
-	req, out := c.GetDirectoryLimitsRequest(input)
+func (c *DirectoryService) DescribeSnapshotsWithContext(ctx aws.Context, input *DescribeSnapshotsInput, opts ...request.Option) (*DescribeSnapshotsOutput, error) {
+	req, out := c.DescribeSnapshotsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opGetSnapshotLimits = "GetSnapshotLimits"
+// DescribeSnapshotsPages iterates over the pages of a DescribeSnapshots operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See DescribeSnapshots method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a DescribeSnapshots operation.
+//	pageNum := 0
+//	err := client.DescribeSnapshotsPages(params,
+//	    func(page *directoryservice.DescribeSnapshotsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *DirectoryService) DescribeSnapshotsPages(input *DescribeSnapshotsInput, fn func(*DescribeSnapshotsOutput, bool) bool) error {
+	return c.DescribeSnapshotsPagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-// GetSnapshotLimitsRequest generates a "aws/request.Request" representing the
-// client's request for the GetSnapshotLimits operation. The "output" return
+// DescribeSnapshotsPagesWithContext same as DescribeSnapshotsPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) DescribeSnapshotsPagesWithContext(ctx aws.Context, input *DescribeSnapshotsInput, fn func(*DescribeSnapshotsOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *DescribeSnapshotsInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.DescribeSnapshotsRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*DescribeSnapshotsOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opDescribeTrusts = "DescribeTrusts"
+
+// DescribeTrustsRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeTrusts operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See GetSnapshotLimits for more information on using the GetSnapshotLimits
+// See DescribeTrusts for more information on using the DescribeTrusts
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeTrustsRequest method.
+//	req, resp := client.DescribeTrustsRequest(params)
 //
-//    // Example sending a request using the GetSnapshotLimitsRequest method.
-//    req, resp := client.GetSnapshotLimitsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/GetSnapshotLimits
-func (c *DirectoryService) GetSnapshotLimitsRequest(input *GetSnapshotLimitsInput) (req *request.Request, output *GetSnapshotLimitsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeTrusts
+func (c *DirectoryService) DescribeTrustsRequest(input *DescribeTrustsInput) (req *request.Request, output *DescribeTrustsOutput) {
 	op := &request.Operation{
-		Name:       opGetSnapshotLimits,
+		Name:       opDescribeTrusts,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "Limit",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &GetSnapshotLimitsInput{}
+		input = &DescribeTrustsInput{}
 	}
 
-	output = &GetSnapshotLimitsOutput{}
+	output = &DescribeTrustsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// GetSnapshotLimits API operation for AWS Directory Service.
+// DescribeTrusts API operation for AWS Directory Service.
 //
-// Obtains the manual snapshot limits for a directory.
+// Obtains information about the trust relationships for this account.
+//
+// If no input parameters are provided, such as DirectoryId or TrustIds, this
+// request describes all the trust relationships belonging to the account.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation GetSnapshotLimits for usage and error information.
+// API operation DescribeTrusts for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - InvalidNextTokenException
+//     The NextToken value is not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/GetSnapshotLimits
-func (c *DirectoryService) GetSnapshotLimits(input *GetSnapshotLimitsInput) (*GetSnapshotLimitsOutput, error) {
-	req, out := c.GetSnapshotLimitsRequest(input)
+//   - InvalidParameterException
+//     One or more parameters are not valid.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+//   - UnsupportedOperationException
+//     The operation is not supported.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeTrusts
+func (c *DirectoryService) DescribeTrusts(input *DescribeTrustsInput) (*DescribeTrustsOutput, error) {
+	req, out := c.DescribeTrustsRequest(input)
 	return out, req.Send()
 }
 
-// GetSnapshotLimitsWithContext is the same as GetSnapshotLimits with the addition of
+// DescribeTrustsWithContext is the same as DescribeTrusts with the addition of
 // the ability to pass a context and additional request options.
 //
-// See GetSnapshotLimits for details on how to use this API operation.
+// See DescribeTrusts for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) GetSnapshotLimitsWithContext(ctx aws.Context, input *GetSnapshotLimitsInput, opts ...request.Option) (*GetSnapshotLimitsOutput, error) {
-	req, out := c.GetSnapshotLimitsRequest(input)
+func (c *DirectoryService) DescribeTrustsWithContext(ctx aws.Context, input *DescribeTrustsInput, opts ...request.Option) (*DescribeTrustsOutput, error) {
+	req, out := c.DescribeTrustsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListIpRoutes = "ListIpRoutes"
+// DescribeTrustsPages iterates over the pages of a DescribeTrusts operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See DescribeTrusts method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a DescribeTrusts operation.
+//	pageNum := 0
+//	err := client.DescribeTrustsPages(params,
+//	    func(page *directoryservice.DescribeTrustsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *DirectoryService) DescribeTrustsPages(input *DescribeTrustsInput, fn func(*DescribeTrustsOutput, bool) bool) error {
+	return c.DescribeTrustsPagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-// ListIpRoutesRequest generates a "aws/request.Request" representing the
-// client's request for the ListIpRoutes operation. The "output" return
+// DescribeTrustsPagesWithContext same as DescribeTrustsPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) DescribeTrustsPagesWithContext(ctx aws.Context, input *DescribeTrustsInput, fn func(*DescribeTrustsOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *DescribeTrustsInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.DescribeTrustsRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*DescribeTrustsOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opDescribeUpdateDirectory = "DescribeUpdateDirectory"
+
+// DescribeUpdateDirectoryRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeUpdateDirectory operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListIpRoutes for more information on using the ListIpRoutes
+// See DescribeUpdateDirectory for more information on using the DescribeUpdateDirectory
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeUpdateDirectoryRequest method.
+//	req, resp := client.DescribeUpdateDirectoryRequest(params)
 //
-//    // Example sending a request using the ListIpRoutesRequest method.
-//    req, resp := client.ListIpRoutesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ListIpRoutes
-func (c *DirectoryService) ListIpRoutesRequest(input *ListIpRoutesInput) (req *request.Request, output *ListIpRoutesOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeUpdateDirectory
+func (c *DirectoryService) DescribeUpdateDirectoryRequest(input *DescribeUpdateDirectoryInput) (req *request.Request, output *DescribeUpdateDirectoryOutput) {
 	op := &request.Operation{
-		Name:       opListIpRoutes,
+		Name:       opDescribeUpdateDirectory,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &ListIpRoutesInput{}
+		input = &DescribeUpdateDirectoryInput{}
 	}
 
-	output = &ListIpRoutesOutput{}
+	output = &DescribeUpdateDirectoryOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListIpRoutes API operation for AWS Directory Service.
+// DescribeUpdateDirectory API operation for AWS Directory Service.
 //
-// Lists the address blocks that you have added to a directory.
+// Describes the updates of a directory for a particular update type.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation ListIpRoutes for usage and error information.
+// API operation DescribeUpdateDirectory for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidNextTokenException "InvalidNextTokenException"
-//   The NextToken value is not valid.
+//   - DirectoryDoesNotExistException
+//     The specified directory does not exist in the system.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - AccessDeniedException
+//     Client authentication is not available in this region at this time.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ClientException
+//     A client exception has occurred.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ListIpRoutes
-func (c *DirectoryService) ListIpRoutes(input *ListIpRoutesInput) (*ListIpRoutesOutput, error) {
-	req, out := c.ListIpRoutesRequest(input)
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+//   - InvalidNextTokenException
+//     The NextToken value is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeUpdateDirectory
+func (c *DirectoryService) DescribeUpdateDirectory(input *DescribeUpdateDirectoryInput) (*DescribeUpdateDirectoryOutput, error) {
+	req, out := c.DescribeUpdateDirectoryRequest(input)
 	return out, req.Send()
 }
 
-// ListIpRoutesWithContext is the same as ListIpRoutes with the addition of
+// DescribeUpdateDirectoryWithContext is the same as DescribeUpdateDirectory with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListIpRoutes for details on how to use this API operation.
+// See DescribeUpdateDirectory for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) ListIpRoutesWithContext(ctx aws.Context, input *ListIpRoutesInput, opts ...request.Option) (*ListIpRoutesOutput, error) {
-	req, out := c.ListIpRoutesRequest(input)
+func (c *DirectoryService) DescribeUpdateDirectoryWithContext(ctx aws.Context, input *DescribeUpdateDirectoryInput, opts ...request.Option) (*DescribeUpdateDirectoryOutput, error) {
+	req, out := c.DescribeUpdateDirectoryRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListLogSubscriptions = "ListLogSubscriptions"
+// DescribeUpdateDirectoryPages iterates over the pages of a DescribeUpdateDirectory operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See DescribeUpdateDirectory method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a DescribeUpdateDirectory operation.
+//	pageNum := 0
+//	err := client.DescribeUpdateDirectoryPages(params,
+//	    func(page *directoryservice.DescribeUpdateDirectoryOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *DirectoryService) DescribeUpdateDirectoryPages(input *DescribeUpdateDirectoryInput, fn func(*DescribeUpdateDirectoryOutput, bool) bool) error {
+	return c.DescribeUpdateDirectoryPagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-// ListLogSubscriptionsRequest generates a "aws/request.Request" representing the
-// client's request for the ListLogSubscriptions operation. The "output" return
+// DescribeUpdateDirectoryPagesWithContext same as DescribeUpdateDirectoryPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) DescribeUpdateDirectoryPagesWithContext(ctx aws.Context, input *DescribeUpdateDirectoryInput, fn func(*DescribeUpdateDirectoryOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *DescribeUpdateDirectoryInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.DescribeUpdateDirectoryRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*DescribeUpdateDirectoryOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opDisableClientAuthentication = "DisableClientAuthentication"
+
+// DisableClientAuthenticationRequest generates a "aws/request.Request" representing the
+// client's request for the DisableClientAuthentication operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListLogSubscriptions for more information on using the ListLogSubscriptions
+// See DisableClientAuthentication for more information on using the DisableClientAuthentication
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DisableClientAuthenticationRequest method.
+//	req, resp := client.DisableClientAuthenticationRequest(params)
 //
-//    // Example sending a request using the ListLogSubscriptionsRequest method.
-//    req, resp := client.ListLogSubscriptionsRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ListLogSubscriptions
-func (c *DirectoryService) ListLogSubscriptionsRequest(input *ListLogSubscriptionsInput) (req *request.Request, output *ListLogSubscriptionsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DisableClientAuthentication
+func (c *DirectoryService) DisableClientAuthenticationRequest(input *DisableClientAuthenticationInput) (req *request.Request, output *DisableClientAuthenticationOutput) {
 	op := &request.Operation{
-		Name:       opListLogSubscriptions,
+		Name:       opDisableClientAuthentication,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &ListLogSubscriptionsInput{}
+		input = &DisableClientAuthenticationInput{}
 	}
 
-	output = &ListLogSubscriptionsOutput{}
+	output = &DisableClientAuthenticationOutput{}
 	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// ListLogSubscriptions API operation for AWS Directory Service.
+// DisableClientAuthentication API operation for AWS Directory Service.
 //
-// Lists the active log subscriptions for the AWS account.
+// Disables alternative client authentication methods for the specified directory.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation ListLogSubscriptions for usage and error information.
+// API operation DisableClientAuthentication for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidNextTokenException "InvalidNextTokenException"
-//   The NextToken value is not valid.
+//   - DirectoryDoesNotExistException
+//     The specified directory does not exist in the system.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - UnsupportedOperationException
+//     The operation is not supported.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - InvalidClientAuthStatusException
+//     Client authentication is already enabled.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ListLogSubscriptions
-func (c *DirectoryService) ListLogSubscriptions(input *ListLogSubscriptionsInput) (*ListLogSubscriptionsOutput, error) {
-	req, out := c.ListLogSubscriptionsRequest(input)
+//   - AccessDeniedException
+//     Client authentication is not available in this region at this time.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DisableClientAuthentication
+func (c *DirectoryService) DisableClientAuthentication(input *DisableClientAuthenticationInput) (*DisableClientAuthenticationOutput, error) {
+	req, out := c.DisableClientAuthenticationRequest(input)
 	return out, req.Send()
 }
 
-// ListLogSubscriptionsWithContext is the same as ListLogSubscriptions with the addition of
+// DisableClientAuthenticationWithContext is the same as DisableClientAuthentication with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListLogSubscriptions for details on how to use this API operation.
+// See DisableClientAuthentication for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) ListLogSubscriptionsWithContext(ctx aws.Context, input *ListLogSubscriptionsInput, opts ...request.Option) (*ListLogSubscriptionsOutput, error) {
-	req, out := c.ListLogSubscriptionsRequest(input)
+func (c *DirectoryService) DisableClientAuthenticationWithContext(ctx aws.Context, input *DisableClientAuthenticationInput, opts ...request.Option) (*DisableClientAuthenticationOutput, error) {
+	req, out := c.DisableClientAuthenticationRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListSchemaExtensions = "ListSchemaExtensions"
+const opDisableLDAPS = "DisableLDAPS"
 
-// ListSchemaExtensionsRequest generates a "aws/request.Request" representing the
-// client's request for the ListSchemaExtensions operation. The "output" return
+// DisableLDAPSRequest generates a "aws/request.Request" representing the
+// client's request for the DisableLDAPS operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListSchemaExtensions for more information on using the ListSchemaExtensions
+// See DisableLDAPS for more information on using the DisableLDAPS
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DisableLDAPSRequest method.
+//	req, resp := client.DisableLDAPSRequest(params)
 //
-//    // Example sending a request using the ListSchemaExtensionsRequest method.
-//    req, resp := client.ListSchemaExtensionsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ListSchemaExtensions
-func (c *DirectoryService) ListSchemaExtensionsRequest(input *ListSchemaExtensionsInput) (req *request.Request, output *ListSchemaExtensionsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DisableLDAPS
+func (c *DirectoryService) DisableLDAPSRequest(input *DisableLDAPSInput) (req *request.Request, output *DisableLDAPSOutput) {
 	op := &request.Operation{
-		Name:       opListSchemaExtensions,
+		Name:       opDisableLDAPS,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &ListSchemaExtensionsInput{}
+		input = &DisableLDAPSInput{}
 	}
 
-	output = &ListSchemaExtensionsOutput{}
+	output = &DisableLDAPSOutput{}
 	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// ListSchemaExtensions API operation for AWS Directory Service.
+// DisableLDAPS API operation for AWS Directory Service.
 //
-// Lists all schema extensions applied to a Microsoft AD Directory.
+// Deactivates LDAP secure calls for the specified directory.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation ListSchemaExtensions for usage and error information.
+// API operation DisableLDAPS for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidNextTokenException "InvalidNextTokenException"
-//   The NextToken value is not valid.
+// Returned Error Types:
 //
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+//   - DirectoryUnavailableException
+//     The specified directory is unavailable or could not be found.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - DirectoryDoesNotExistException
+//     The specified directory does not exist in the system.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - InvalidLDAPSStatusException
+//     The LDAP activities could not be performed because they are limited by the
+//     LDAPS status.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ListSchemaExtensions
-func (c *DirectoryService) ListSchemaExtensions(input *ListSchemaExtensionsInput) (*ListSchemaExtensionsOutput, error) {
-	req, out := c.ListSchemaExtensionsRequest(input)
+//   - UnsupportedOperationException
+//     The operation is not supported.
+//
+//   - InvalidParameterException
+//     One or more parameters are not valid.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DisableLDAPS
+func (c *DirectoryService) DisableLDAPS(input *DisableLDAPSInput) (*DisableLDAPSOutput, error) {
+	req, out := c.DisableLDAPSRequest(input)
 	return out, req.Send()
 }
 
-// ListSchemaExtensionsWithContext is the same as ListSchemaExtensions with the addition of
+// DisableLDAPSWithContext is the same as DisableLDAPS with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListSchemaExtensions for details on how to use this API operation.
+// See DisableLDAPS for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) ListSchemaExtensionsWithContext(ctx aws.Context, input *ListSchemaExtensionsInput, opts ...request.Option) (*ListSchemaExtensionsOutput, error) {
-	req, out := c.ListSchemaExtensionsRequest(input)
+func (c *DirectoryService) DisableLDAPSWithContext(ctx aws.Context, input *DisableLDAPSInput, opts ...request.Option) (*DisableLDAPSOutput, error) {
+	req, out := c.DisableLDAPSRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListTagsForResource = "ListTagsForResource"
+const opDisableRadius = "DisableRadius"
 
-// ListTagsForResourceRequest generates a "aws/request.Request" representing the
-// client's request for the ListTagsForResource operation. The "output" return
+// DisableRadiusRequest generates a "aws/request.Request" representing the
+// client's request for the DisableRadius operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListTagsForResource for more information on using the ListTagsForResource
+// See DisableRadius for more information on using the DisableRadius
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DisableRadiusRequest method.
+//	req, resp := client.DisableRadiusRequest(params)
 //
-//    // Example sending a request using the ListTagsForResourceRequest method.
-//    req, resp := client.ListTagsForResourceRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ListTagsForResource
-func (c *DirectoryService) ListTagsForResourceRequest(input *ListTagsForResourceInput) (req *request.Request, output *ListTagsForResourceOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DisableRadius
+func (c *DirectoryService) DisableRadiusRequest(input *DisableRadiusInput) (req *request.Request, output *DisableRadiusOutput) {
 	op := &request.Operation{
-		Name:       opListTagsForResource,
+		Name:       opDisableRadius,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &ListTagsForResourceInput{}
+		input = &DisableRadiusInput{}
 	}
 
-	output = &ListTagsForResourceOutput{}
+	output = &DisableRadiusOutput{}
 	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// ListTagsForResource API operation for AWS Directory Service.
+// DisableRadius API operation for AWS Directory Service.
 //
-// Lists all tags on a directory.
+// Disables multi-factor authentication (MFA) with the Remote Authentication
+// Dial In User Service (RADIUS) server for an AD Connector or Microsoft AD
+// directory.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation ListTagsForResource for usage and error information.
-//
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// API operation DisableRadius for usage and error information.
 //
-//   * ErrCodeInvalidNextTokenException "InvalidNextTokenException"
-//   The NextToken value is not valid.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ListTagsForResource
-func (c *DirectoryService) ListTagsForResource(input *ListTagsForResourceInput) (*ListTagsForResourceOutput, error) {
-	req, out := c.ListTagsForResourceRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DisableRadius
+func (c *DirectoryService) DisableRadius(input *DisableRadiusInput) (*DisableRadiusOutput, error) {
+	req, out := c.DisableRadiusRequest(input)
 	return out, req.Send()
 }
 
-// ListTagsForResourceWithContext is the same as ListTagsForResource with the addition of
+// DisableRadiusWithContext is the same as DisableRadius with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListTagsForResource for details on how to use this API operation.
+// See DisableRadius for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) ListTagsForResourceWithContext(ctx aws.Context, input *ListTagsForResourceInput, opts ...request.Option) (*ListTagsForResourceOutput, error) {
-	req, out := c.ListTagsForResourceRequest(input)
+func (c *DirectoryService) DisableRadiusWithContext(ctx aws.Context, input *DisableRadiusInput, opts ...request.Option) (*DisableRadiusOutput, error) {
+	req, out := c.DisableRadiusRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opRegisterEventTopic = "RegisterEventTopic"
+const opDisableSso = "DisableSso"
 
-// RegisterEventTopicRequest generates a "aws/request.Request" representing the
-// client's request for the RegisterEventTopic operation. The "output" return
+// DisableSsoRequest generates a "aws/request.Request" representing the
+// client's request for the DisableSso operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See RegisterEventTopic for more information on using the RegisterEventTopic
+// See DisableSso for more information on using the DisableSso
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DisableSsoRequest method.
+//	req, resp := client.DisableSsoRequest(params)
 //
-//    // Example sending a request using the RegisterEventTopicRequest method.
-//    req, resp := client.RegisterEventTopicRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RegisterEventTopic
-func (c *DirectoryService) RegisterEventTopicRequest(input *RegisterEventTopicInput) (req *request.Request, output *RegisterEventTopicOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DisableSso
+func (c *DirectoryService) DisableSsoRequest(input *DisableSsoInput) (req *request.Request, output *DisableSsoOutput) {
 	op := &request.Operation{
-		Name:       opRegisterEventTopic,
+		Name:       opDisableSso,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &RegisterEventTopicInput{}
+		input = &DisableSsoInput{}
 	}
 
-	output = &RegisterEventTopicOutput{}
+	output = &DisableSsoOutput{}
 	req = c.newRequest(op, input, output)
 	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// RegisterEventTopic API operation for AWS Directory Service.
+// DisableSso API operation for AWS Directory Service.
 //
-// Associates a directory with an SNS topic. This establishes the directory
-// as a publisher to the specified SNS topic. You can then receive email or
-// text (SMS) messages when the status of your directory changes. You get notified
-// if your directory goes from an Active status to an Impaired or Inoperable
-// status. You also receive a notification when the directory returns to an
-// Active status.
+// Disables single-sign on for a directory.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation RegisterEventTopic for usage and error information.
+// API operation DisableSso for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - InsufficientPermissionsException
+//     The account does not have sufficient permission to perform the operation.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - AuthenticationFailedException
+//     An authentication error occurred.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RegisterEventTopic
-func (c *DirectoryService) RegisterEventTopic(input *RegisterEventTopicInput) (*RegisterEventTopicOutput, error) {
-	req, out := c.RegisterEventTopicRequest(input)
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DisableSso
+func (c *DirectoryService) DisableSso(input *DisableSsoInput) (*DisableSsoOutput, error) {
+	req, out := c.DisableSsoRequest(input)
 	return out, req.Send()
 }
 
-// RegisterEventTopicWithContext is the same as RegisterEventTopic with the addition of
+// DisableSsoWithContext is the same as DisableSso with the addition of
 // the ability to pass a context and additional request options.
 //
-// See RegisterEventTopic for details on how to use this API operation.
+// See DisableSso for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) RegisterEventTopicWithContext(ctx aws.Context, input *RegisterEventTopicInput, opts ...request.Option) (*RegisterEventTopicOutput, error) {
-	req, out := c.RegisterEventTopicRequest(input)
+func (c *DirectoryService) DisableSsoWithContext(ctx aws.Context, input *DisableSsoInput, opts ...request.Option) (*DisableSsoOutput, error) {
+	req, out := c.DisableSsoRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opRejectSharedDirectory = "RejectSharedDirectory"
+const opEnableClientAuthentication = "EnableClientAuthentication"
 
-// RejectSharedDirectoryRequest generates a "aws/request.Request" representing the
-// client's request for the RejectSharedDirectory operation. The "output" return
+// EnableClientAuthenticationRequest generates a "aws/request.Request" representing the
+// client's request for the EnableClientAuthentication operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See RejectSharedDirectory for more information on using the RejectSharedDirectory
+// See EnableClientAuthentication for more information on using the EnableClientAuthentication
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the EnableClientAuthenticationRequest method.
+//	req, resp := client.EnableClientAuthenticationRequest(params)
 //
-//    // Example sending a request using the RejectSharedDirectoryRequest method.
-//    req, resp := client.RejectSharedDirectoryRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RejectSharedDirectory
-func (c *DirectoryService) RejectSharedDirectoryRequest(input *RejectSharedDirectoryInput) (req *request.Request, output *RejectSharedDirectoryOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/EnableClientAuthentication
+func (c *DirectoryService) EnableClientAuthenticationRequest(input *EnableClientAuthenticationInput) (req *request.Request, output *EnableClientAuthenticationOutput) {
 	op := &request.Operation{
-		Name:       opRejectSharedDirectory,
+		Name:       opEnableClientAuthentication,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &RejectSharedDirectoryInput{}
+		input = &EnableClientAuthenticationInput{}
 	}
 
-	output = &RejectSharedDirectoryOutput{}
+	output = &EnableClientAuthenticationOutput{}
 	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// RejectSharedDirectory API operation for AWS Directory Service.
+// EnableClientAuthentication API operation for AWS Directory Service.
 //
-// Rejects a directory sharing request that was sent from the directory owner
-// account.
+// Enables alternative client authentication methods for the specified directory.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation RejectSharedDirectory for usage and error information.
+// API operation EnableClientAuthentication for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+// Returned Error Types:
 //
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+//   - DirectoryDoesNotExistException
+//     The specified directory does not exist in the system.
 //
-//   * ErrCodeDirectoryAlreadySharedException "DirectoryAlreadySharedException"
-//   The specified directory has already been shared with this AWS account.
+//   - UnsupportedOperationException
+//     The operation is not supported.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - InvalidClientAuthStatusException
+//     Client authentication is already enabled.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - AccessDeniedException
+//     Client authentication is not available in this region at this time.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RejectSharedDirectory
-func (c *DirectoryService) RejectSharedDirectory(input *RejectSharedDirectoryInput) (*RejectSharedDirectoryOutput, error) {
-	req, out := c.RejectSharedDirectoryRequest(input)
+//   - NoAvailableCertificateException
+//     Client authentication setup could not be completed because at least one valid
+//     certificate must be registered in the system.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/EnableClientAuthentication
+func (c *DirectoryService) EnableClientAuthentication(input *EnableClientAuthenticationInput) (*EnableClientAuthenticationOutput, error) {
+	req, out := c.EnableClientAuthenticationRequest(input)
 	return out, req.Send()
 }
 
-// RejectSharedDirectoryWithContext is the same as RejectSharedDirectory with the addition of
+// EnableClientAuthenticationWithContext is the same as EnableClientAuthentication with the addition of
 // the ability to pass a context and additional request options.
 //
-// See RejectSharedDirectory for details on how to use this API operation.
+// See EnableClientAuthentication for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) RejectSharedDirectoryWithContext(ctx aws.Context, input *RejectSharedDirectoryInput, opts ...request.Option) (*RejectSharedDirectoryOutput, error) {
-	req, out := c.RejectSharedDirectoryRequest(input)
+func (c *DirectoryService) EnableClientAuthenticationWithContext(ctx aws.Context, input *EnableClientAuthenticationInput, opts ...request.Option) (*EnableClientAuthenticationOutput, error) {
+	req, out := c.EnableClientAuthenticationRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opRemoveIpRoutes = "RemoveIpRoutes"
+const opEnableLDAPS = "EnableLDAPS"
 
-// RemoveIpRoutesRequest generates a "aws/request.Request" representing the
-// client's request for the RemoveIpRoutes operation. The "output" return
+// EnableLDAPSRequest generates a "aws/request.Request" representing the
+// client's request for the EnableLDAPS operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See RemoveIpRoutes for more information on using the RemoveIpRoutes
+// See EnableLDAPS for more information on using the EnableLDAPS
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the EnableLDAPSRequest method.
+//	req, resp := client.EnableLDAPSRequest(params)
 //
-//    // Example sending a request using the RemoveIpRoutesRequest method.
-//    req, resp := client.RemoveIpRoutesRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RemoveIpRoutes
-func (c *DirectoryService) RemoveIpRoutesRequest(input *RemoveIpRoutesInput) (req *request.Request, output *RemoveIpRoutesOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/EnableLDAPS
+func (c *DirectoryService) EnableLDAPSRequest(input *EnableLDAPSInput) (req *request.Request, output *EnableLDAPSOutput) {
 	op := &request.Operation{
-		Name:       opRemoveIpRoutes,
+		Name:       opEnableLDAPS,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &RemoveIpRoutesInput{}
+		input = &EnableLDAPSInput{}
 	}
 
-	output = &RemoveIpRoutesOutput{}
+	output = &EnableLDAPSOutput{}
 	req = c.newRequest(op, input, output)
 	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// RemoveIpRoutes API operation for AWS Directory Service.
+// EnableLDAPS API operation for AWS Directory Service.
 //
-// Removes IP address blocks from a directory.
+// Activates the switch for the specific directory to always use LDAP secure
+// calls.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation RemoveIpRoutes for usage and error information.
+// API operation EnableLDAPS for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - DirectoryUnavailableException
+//     The specified directory is unavailable or could not be found.
 //
-//   * ErrCodeDirectoryUnavailableException "DirectoryUnavailableException"
-//   The specified directory is unavailable or could not be found.
+//   - DirectoryDoesNotExistException
+//     The specified directory does not exist in the system.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - NoAvailableCertificateException
+//     Client authentication setup could not be completed because at least one valid
+//     certificate must be registered in the system.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - InvalidLDAPSStatusException
+//     The LDAP activities could not be performed because they are limited by the
+//     LDAPS status.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RemoveIpRoutes
-func (c *DirectoryService) RemoveIpRoutes(input *RemoveIpRoutesInput) (*RemoveIpRoutesOutput, error) {
-	req, out := c.RemoveIpRoutesRequest(input)
+//   - UnsupportedOperationException
+//     The operation is not supported.
+//
+//   - InvalidParameterException
+//     One or more parameters are not valid.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/EnableLDAPS
+func (c *DirectoryService) EnableLDAPS(input *EnableLDAPSInput) (*EnableLDAPSOutput, error) {
+	req, out := c.EnableLDAPSRequest(input)
 	return out, req.Send()
 }
 
-// RemoveIpRoutesWithContext is the same as RemoveIpRoutes with the addition of
+// EnableLDAPSWithContext is the same as EnableLDAPS with the addition of
 // the ability to pass a context and additional request options.
 //
-// See RemoveIpRoutes for details on how to use this API operation.
+// See EnableLDAPS for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) RemoveIpRoutesWithContext(ctx aws.Context, input *RemoveIpRoutesInput, opts ...request.Option) (*RemoveIpRoutesOutput, error) {
-	req, out := c.RemoveIpRoutesRequest(input)
+func (c *DirectoryService) EnableLDAPSWithContext(ctx aws.Context, input *EnableLDAPSInput, opts ...request.Option) (*EnableLDAPSOutput, error) {
+	req, out := c.EnableLDAPSRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opRemoveTagsFromResource = "RemoveTagsFromResource"
+const opEnableRadius = "EnableRadius"
 
-// RemoveTagsFromResourceRequest generates a "aws/request.Request" representing the
-// client's request for the RemoveTagsFromResource operation. The "output" return
+// EnableRadiusRequest generates a "aws/request.Request" representing the
+// client's request for the EnableRadius operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See RemoveTagsFromResource for more information on using the RemoveTagsFromResource
+// See EnableRadius for more information on using the EnableRadius
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the EnableRadiusRequest method.
+//	req, resp := client.EnableRadiusRequest(params)
 //
-//    // Example sending a request using the RemoveTagsFromResourceRequest method.
-//    req, resp := client.RemoveTagsFromResourceRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RemoveTagsFromResource
-func (c *DirectoryService) RemoveTagsFromResourceRequest(input *RemoveTagsFromResourceInput) (req *request.Request, output *RemoveTagsFromResourceOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/EnableRadius
+func (c *DirectoryService) EnableRadiusRequest(input *EnableRadiusInput) (req *request.Request, output *EnableRadiusOutput) {
 	op := &request.Operation{
-		Name:       opRemoveTagsFromResource,
+		Name:       opEnableRadius,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &RemoveTagsFromResourceInput{}
+		input = &EnableRadiusInput{}
 	}
 
-	output = &RemoveTagsFromResourceOutput{}
+	output = &EnableRadiusOutput{}
 	req = c.newRequest(op, input, output)
 	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// RemoveTagsFromResource API operation for AWS Directory Service.
+// EnableRadius API operation for AWS Directory Service.
 //
-// Removes tags from a directory.
+// Enables multi-factor authentication (MFA) with the Remote Authentication
+// Dial In User Service (RADIUS) server for an AD Connector or Microsoft AD
+// directory.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation RemoveTagsFromResource for usage and error information.
+// API operation EnableRadius for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - EntityAlreadyExistsException
+//     The specified entity already exists.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RemoveTagsFromResource
-func (c *DirectoryService) RemoveTagsFromResource(input *RemoveTagsFromResourceInput) (*RemoveTagsFromResourceOutput, error) {
-	req, out := c.RemoveTagsFromResourceRequest(input)
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/EnableRadius
+func (c *DirectoryService) EnableRadius(input *EnableRadiusInput) (*EnableRadiusOutput, error) {
+	req, out := c.EnableRadiusRequest(input)
 	return out, req.Send()
 }
 
-// RemoveTagsFromResourceWithContext is the same as RemoveTagsFromResource with the addition of
+// EnableRadiusWithContext is the same as EnableRadius with the addition of
 // the ability to pass a context and additional request options.
 //
-// See RemoveTagsFromResource for details on how to use this API operation.
+// See EnableRadius for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) RemoveTagsFromResourceWithContext(ctx aws.Context, input *RemoveTagsFromResourceInput, opts ...request.Option) (*RemoveTagsFromResourceOutput, error) {
-	req, out := c.RemoveTagsFromResourceRequest(input)
+func (c *DirectoryService) EnableRadiusWithContext(ctx aws.Context, input *EnableRadiusInput, opts ...request.Option) (*EnableRadiusOutput, error) {
+	req, out := c.EnableRadiusRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opResetUserPassword = "ResetUserPassword"
+const opEnableSso = "EnableSso"
 
-// ResetUserPasswordRequest generates a "aws/request.Request" representing the
-// client's request for the ResetUserPassword operation. The "output" return
+// EnableSsoRequest generates a "aws/request.Request" representing the
+// client's request for the EnableSso operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ResetUserPassword for more information on using the ResetUserPassword
+// See EnableSso for more information on using the EnableSso
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the EnableSsoRequest method.
+//	req, resp := client.EnableSsoRequest(params)
 //
-//    // Example sending a request using the ResetUserPasswordRequest method.
-//    req, resp := client.ResetUserPasswordRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ResetUserPassword
-func (c *DirectoryService) ResetUserPasswordRequest(input *ResetUserPasswordInput) (req *request.Request, output *ResetUserPasswordOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/EnableSso
+func (c *DirectoryService) EnableSsoRequest(input *EnableSsoInput) (req *request.Request, output *EnableSsoOutput) {
 	op := &request.Operation{
-		Name:       opResetUserPassword,
+		Name:       opEnableSso,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &ResetUserPasswordInput{}
+		input = &EnableSsoInput{}
 	}
 
-	output = &ResetUserPasswordOutput{}
+	output = &EnableSsoOutput{}
 	req = c.newRequest(op, input, output)
 	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// ResetUserPassword API operation for AWS Directory Service.
+// EnableSso API operation for AWS Directory Service.
 //
-// Resets the password for any user in your AWS Managed Microsoft AD or Simple
-// AD directory.
+// Enables single sign-on for a directory. Single sign-on allows users in your
+// directory to access certain Amazon Web Services services from a computer
+// joined to the directory without having to enter their credentials separately.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation ResetUserPassword for usage and error information.
-//
-// Returned Error Codes:
-//   * ErrCodeDirectoryUnavailableException "DirectoryUnavailableException"
-//   The specified directory is unavailable or could not be found.
+// API operation EnableSso for usage and error information.
 //
-//   * ErrCodeUserDoesNotExistException "UserDoesNotExistException"
-//   The user provided a username that does not exist in your directory.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidPasswordException "InvalidPasswordException"
-//   The new password provided by the user does not meet the password complexity
-//   requirements defined in your directory.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeUnsupportedOperationException "UnsupportedOperationException"
-//   The operation is not supported.
+//   - InsufficientPermissionsException
+//     The account does not have sufficient permission to perform the operation.
 //
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+//   - AuthenticationFailedException
+//     An authentication error occurred.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ResetUserPassword
-func (c *DirectoryService) ResetUserPassword(input *ResetUserPasswordInput) (*ResetUserPasswordOutput, error) {
-	req, out := c.ResetUserPasswordRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/EnableSso
+func (c *DirectoryService) EnableSso(input *EnableSsoInput) (*EnableSsoOutput, error) {
+	req, out := c.EnableSsoRequest(input)
 	return out, req.Send()
 }
 
-// ResetUserPasswordWithContext is the same as ResetUserPassword with the addition of
+// EnableSsoWithContext is the same as EnableSso with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ResetUserPassword for details on how to use this API operation.
+// See EnableSso for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) ResetUserPasswordWithContext(ctx aws.Context, input *ResetUserPasswordInput, opts ...request.Option) (*ResetUserPasswordOutput, error) {
-	req, out := c.ResetUserPasswordRequest(input)
+func (c *DirectoryService) EnableSsoWithContext(ctx aws.Context, input *EnableSsoInput, opts ...request.Option) (*EnableSsoOutput, error) {
+	req, out := c.EnableSsoRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opRestoreFromSnapshot = "RestoreFromSnapshot"
+const opGetDirectoryLimits = "GetDirectoryLimits"
 
-// RestoreFromSnapshotRequest generates a "aws/request.Request" representing the
-// client's request for the RestoreFromSnapshot operation. The "output" return
+// GetDirectoryLimitsRequest generates a "aws/request.Request" representing the
+// client's request for the GetDirectoryLimits operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See RestoreFromSnapshot for more information on using the RestoreFromSnapshot
+// See GetDirectoryLimits for more information on using the GetDirectoryLimits
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetDirectoryLimitsRequest method.
+//	req, resp := client.GetDirectoryLimitsRequest(params)
 //
-//    // Example sending a request using the RestoreFromSnapshotRequest method.
-//    req, resp := client.RestoreFromSnapshotRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RestoreFromSnapshot
-func (c *DirectoryService) RestoreFromSnapshotRequest(input *RestoreFromSnapshotInput) (req *request.Request, output *RestoreFromSnapshotOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/GetDirectoryLimits
+func (c *DirectoryService) GetDirectoryLimitsRequest(input *GetDirectoryLimitsInput) (req *request.Request, output *GetDirectoryLimitsOutput) {
 	op := &request.Operation{
-		Name:       opRestoreFromSnapshot,
+		Name:       opGetDirectoryLimits,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &RestoreFromSnapshotInput{}
+		input = &GetDirectoryLimitsInput{}
 	}
 
-	output = &RestoreFromSnapshotOutput{}
+	output = &GetDirectoryLimitsOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// RestoreFromSnapshot API operation for AWS Directory Service.
-//
-// Restores a directory using an existing directory snapshot.
-//
-// When you restore a directory from a snapshot, any changes made to the directory
-// after the snapshot date are overwritten.
+// GetDirectoryLimits API operation for AWS Directory Service.
 //
-// This action returns as soon as the restore operation is initiated. You can
-// monitor the progress of the restore operation by calling the DescribeDirectories
-// operation with the directory identifier. When the DirectoryDescription.Stage
-// value changes to Active, the restore operation is complete.
+// Obtains directory limit information for the current Region.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation RestoreFromSnapshot for usage and error information.
+// API operation GetDirectoryLimits for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RestoreFromSnapshot
-func (c *DirectoryService) RestoreFromSnapshot(input *RestoreFromSnapshotInput) (*RestoreFromSnapshotOutput, error) {
-	req, out := c.RestoreFromSnapshotRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/GetDirectoryLimits
+func (c *DirectoryService) GetDirectoryLimits(input *GetDirectoryLimitsInput) (*GetDirectoryLimitsOutput, error) {
+	req, out := c.GetDirectoryLimitsRequest(input)
 	return out, req.Send()
 }
 
-// RestoreFromSnapshotWithContext is the same as RestoreFromSnapshot with the addition of
+// GetDirectoryLimitsWithContext is the same as GetDirectoryLimits with the addition of
 // the ability to pass a context and additional request options.
 //
-// See RestoreFromSnapshot for details on how to use this API operation.
+// See GetDirectoryLimits for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) RestoreFromSnapshotWithContext(ctx aws.Context, input *RestoreFromSnapshotInput, opts ...request.Option) (*RestoreFromSnapshotOutput, error) {
-	req, out := c.RestoreFromSnapshotRequest(input)
+func (c *DirectoryService) GetDirectoryLimitsWithContext(ctx aws.Context, input *GetDirectoryLimitsInput, opts ...request.Option) (*GetDirectoryLimitsOutput, error) {
+	req, out := c.GetDirectoryLimitsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opShareDirectory = "ShareDirectory"
+const opGetSnapshotLimits = "GetSnapshotLimits"
 
-// ShareDirectoryRequest generates a "aws/request.Request" representing the
-// client's request for the ShareDirectory operation. The "output" return
+// GetSnapshotLimitsRequest generates a "aws/request.Request" representing the
+// client's request for the GetSnapshotLimits operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ShareDirectory for more information on using the ShareDirectory
+// See GetSnapshotLimits for more information on using the GetSnapshotLimits
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetSnapshotLimitsRequest method.
+//	req, resp := client.GetSnapshotLimitsRequest(params)
 //
-//    // Example sending a request using the ShareDirectoryRequest method.
-//    req, resp := client.ShareDirectoryRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ShareDirectory
-func (c *DirectoryService) ShareDirectoryRequest(input *ShareDirectoryInput) (req *request.Request, output *ShareDirectoryOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/GetSnapshotLimits
+func (c *DirectoryService) GetSnapshotLimitsRequest(input *GetSnapshotLimitsInput) (req *request.Request, output *GetSnapshotLimitsOutput) {
 	op := &request.Operation{
-		Name:       opShareDirectory,
+		Name:       opGetSnapshotLimits,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &ShareDirectoryInput{}
+		input = &GetSnapshotLimitsInput{}
 	}
 
-	output = &ShareDirectoryOutput{}
+	output = &GetSnapshotLimitsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ShareDirectory API operation for AWS Directory Service.
-//
-// Shares a specified directory (DirectoryId) in your AWS account (directory
-// owner) with another AWS account (directory consumer). With this operation
-// you can use your directory from any AWS account and from any Amazon VPC within
-// an AWS Region.
-//
-// When you share your AWS Managed Microsoft AD directory, AWS Directory Service
-// creates a shared directory in the directory consumer account. This shared
-// directory contains the metadata to provide access to the directory within
-// the directory owner account. The shared directory is visible in all VPCs
-// in the directory consumer account.
-//
-// The ShareMethod parameter determines whether the specified directory can
-// be shared between AWS accounts inside the same AWS organization (ORGANIZATIONS).
-// It also determines whether you can share the directory with any other AWS
-// account either inside or outside of the organization (HANDSHAKE).
+// GetSnapshotLimits API operation for AWS Directory Service.
 //
-// The ShareNotes parameter is only used when HANDSHAKE is called, which sends
-// a directory sharing request to the directory consumer.
+// Obtains the manual snapshot limits for a directory.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation ShareDirectory for usage and error information.
-//
-// Returned Error Codes:
-//   * ErrCodeDirectoryAlreadySharedException "DirectoryAlreadySharedException"
-//   The specified directory has already been shared with this AWS account.
-//
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
-//
-//   * ErrCodeInvalidTargetException "InvalidTargetException"
-//   The specified shared target is not valid.
-//
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
-//
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
-//
-//   * ErrCodeShareLimitExceededException "ShareLimitExceededException"
-//   The maximum number of AWS accounts that you can share with this directory
-//   has been reached.
+// API operation GetSnapshotLimits for usage and error information.
 //
-//   * ErrCodeOrganizationsException "OrganizationsException"
-//   Exception encountered while trying to access your AWS organization.
+// Returned Error Types:
 //
-//   * ErrCodeAccessDeniedException "AccessDeniedException"
-//   You do not have sufficient access to perform this action.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeUnsupportedOperationException "UnsupportedOperationException"
-//   The operation is not supported.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ShareDirectory
-func (c *DirectoryService) ShareDirectory(input *ShareDirectoryInput) (*ShareDirectoryOutput, error) {
-	req, out := c.ShareDirectoryRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/GetSnapshotLimits
+func (c *DirectoryService) GetSnapshotLimits(input *GetSnapshotLimitsInput) (*GetSnapshotLimitsOutput, error) {
+	req, out := c.GetSnapshotLimitsRequest(input)
 	return out, req.Send()
 }
 
-// ShareDirectoryWithContext is the same as ShareDirectory with the addition of
+// GetSnapshotLimitsWithContext is the same as GetSnapshotLimits with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ShareDirectory for details on how to use this API operation.
+// See GetSnapshotLimits for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) ShareDirectoryWithContext(ctx aws.Context, input *ShareDirectoryInput, opts ...request.Option) (*ShareDirectoryOutput, error) {
-	req, out := c.ShareDirectoryRequest(input)
+func (c *DirectoryService) GetSnapshotLimitsWithContext(ctx aws.Context, input *GetSnapshotLimitsInput, opts ...request.Option) (*GetSnapshotLimitsOutput, error) {
+	req, out := c.GetSnapshotLimitsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opStartSchemaExtension = "StartSchemaExtension"
+const opListCertificates = "ListCertificates"
 
-// StartSchemaExtensionRequest generates a "aws/request.Request" representing the
-// client's request for the StartSchemaExtension operation. The "output" return
+// ListCertificatesRequest generates a "aws/request.Request" representing the
+// client's request for the ListCertificates operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See StartSchemaExtension for more information on using the StartSchemaExtension
+// See ListCertificates for more information on using the ListCertificates
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListCertificatesRequest method.
+//	req, resp := client.ListCertificatesRequest(params)
 //
-//    // Example sending a request using the StartSchemaExtensionRequest method.
-//    req, resp := client.StartSchemaExtensionRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/StartSchemaExtension
-func (c *DirectoryService) StartSchemaExtensionRequest(input *StartSchemaExtensionInput) (req *request.Request, output *StartSchemaExtensionOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ListCertificates
+func (c *DirectoryService) ListCertificatesRequest(input *ListCertificatesInput) (req *request.Request, output *ListCertificatesOutput) {
 	op := &request.Operation{
-		Name:       opStartSchemaExtension,
+		Name:       opListCertificates,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "Limit",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &StartSchemaExtensionInput{}
+		input = &ListCertificatesInput{}
 	}
 
-	output = &StartSchemaExtensionOutput{}
+	output = &ListCertificatesOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// StartSchemaExtension API operation for AWS Directory Service.
+// ListCertificates API operation for AWS Directory Service.
 //
-// Applies a schema extension to a Microsoft AD directory.
+// For the specified directory, lists all the certificates registered for a
+// secure LDAP or client certificate authentication.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation StartSchemaExtension for usage and error information.
+// API operation ListCertificates for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeDirectoryUnavailableException "DirectoryUnavailableException"
-//   The specified directory is unavailable or could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+//   - DirectoryDoesNotExistException
+//     The specified directory does not exist in the system.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - UnsupportedOperationException
+//     The operation is not supported.
 //
-//   * ErrCodeSnapshotLimitExceededException "SnapshotLimitExceededException"
-//   The maximum number of manual snapshots for the directory has been reached.
-//   You can use the GetSnapshotLimits operation to determine the snapshot limits
-//   for a directory.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - InvalidNextTokenException
+//     The NextToken value is not valid.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ClientException
+//     A client exception has occurred.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/StartSchemaExtension
-func (c *DirectoryService) StartSchemaExtension(input *StartSchemaExtensionInput) (*StartSchemaExtensionOutput, error) {
-	req, out := c.StartSchemaExtensionRequest(input)
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ListCertificates
+func (c *DirectoryService) ListCertificates(input *ListCertificatesInput) (*ListCertificatesOutput, error) {
+	req, out := c.ListCertificatesRequest(input)
 	return out, req.Send()
 }
 
-// StartSchemaExtensionWithContext is the same as StartSchemaExtension with the addition of
+// ListCertificatesWithContext is the same as ListCertificates with the addition of
 // the ability to pass a context and additional request options.
 //
-// See StartSchemaExtension for details on how to use this API operation.
+// See ListCertificates for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) StartSchemaExtensionWithContext(ctx aws.Context, input *StartSchemaExtensionInput, opts ...request.Option) (*StartSchemaExtensionOutput, error) {
-	req, out := c.StartSchemaExtensionRequest(input)
+func (c *DirectoryService) ListCertificatesWithContext(ctx aws.Context, input *ListCertificatesInput, opts ...request.Option) (*ListCertificatesOutput, error) {
+	req, out := c.ListCertificatesRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUnshareDirectory = "UnshareDirectory"
+// ListCertificatesPages iterates over the pages of a ListCertificates operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListCertificates method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListCertificates operation.
+//	pageNum := 0
+//	err := client.ListCertificatesPages(params,
+//	    func(page *directoryservice.ListCertificatesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *DirectoryService) ListCertificatesPages(input *ListCertificatesInput, fn func(*ListCertificatesOutput, bool) bool) error {
+	return c.ListCertificatesPagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-// UnshareDirectoryRequest generates a "aws/request.Request" representing the
-// client's request for the UnshareDirectory operation. The "output" return
+// ListCertificatesPagesWithContext same as ListCertificatesPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) ListCertificatesPagesWithContext(ctx aws.Context, input *ListCertificatesInput, fn func(*ListCertificatesOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListCertificatesInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListCertificatesRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListCertificatesOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListIpRoutes = "ListIpRoutes"
+
+// ListIpRoutesRequest generates a "aws/request.Request" representing the
+// client's request for the ListIpRoutes operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UnshareDirectory for more information on using the UnshareDirectory
+// See ListIpRoutes for more information on using the ListIpRoutes
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListIpRoutesRequest method.
+//	req, resp := client.ListIpRoutesRequest(params)
 //
-//    // Example sending a request using the UnshareDirectoryRequest method.
-//    req, resp := client.UnshareDirectoryRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UnshareDirectory
-func (c *DirectoryService) UnshareDirectoryRequest(input *UnshareDirectoryInput) (req *request.Request, output *UnshareDirectoryOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ListIpRoutes
+func (c *DirectoryService) ListIpRoutesRequest(input *ListIpRoutesInput) (req *request.Request, output *ListIpRoutesOutput) {
 	op := &request.Operation{
-		Name:       opUnshareDirectory,
+		Name:       opListIpRoutes,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "Limit",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &UnshareDirectoryInput{}
+		input = &ListIpRoutesInput{}
 	}
 
-	output = &UnshareDirectoryOutput{}
+	output = &ListIpRoutesOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// UnshareDirectory API operation for AWS Directory Service.
+// ListIpRoutes API operation for AWS Directory Service.
 //
-// Stops the directory sharing between the directory owner and consumer accounts.
+// Lists the address blocks that you have added to a directory.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation UnshareDirectory for usage and error information.
+// API operation ListIpRoutes for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidTargetException "InvalidTargetException"
-//   The specified shared target is not valid.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeDirectoryNotSharedException "DirectoryNotSharedException"
-//   The specified directory has not been shared with this AWS account.
+//   - InvalidNextTokenException
+//     The NextToken value is not valid.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ClientException
+//     A client exception has occurred.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UnshareDirectory
-func (c *DirectoryService) UnshareDirectory(input *UnshareDirectoryInput) (*UnshareDirectoryOutput, error) {
-	req, out := c.UnshareDirectoryRequest(input)
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ListIpRoutes
+func (c *DirectoryService) ListIpRoutes(input *ListIpRoutesInput) (*ListIpRoutesOutput, error) {
+	req, out := c.ListIpRoutesRequest(input)
 	return out, req.Send()
 }
 
-// UnshareDirectoryWithContext is the same as UnshareDirectory with the addition of
+// ListIpRoutesWithContext is the same as ListIpRoutes with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UnshareDirectory for details on how to use this API operation.
+// See ListIpRoutes for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) UnshareDirectoryWithContext(ctx aws.Context, input *UnshareDirectoryInput, opts ...request.Option) (*UnshareDirectoryOutput, error) {
-	req, out := c.UnshareDirectoryRequest(input)
+func (c *DirectoryService) ListIpRoutesWithContext(ctx aws.Context, input *ListIpRoutesInput, opts ...request.Option) (*ListIpRoutesOutput, error) {
+	req, out := c.ListIpRoutesRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateConditionalForwarder = "UpdateConditionalForwarder"
+// ListIpRoutesPages iterates over the pages of a ListIpRoutes operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListIpRoutes method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListIpRoutes operation.
+//	pageNum := 0
+//	err := client.ListIpRoutesPages(params,
+//	    func(page *directoryservice.ListIpRoutesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *DirectoryService) ListIpRoutesPages(input *ListIpRoutesInput, fn func(*ListIpRoutesOutput, bool) bool) error {
+	return c.ListIpRoutesPagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-// UpdateConditionalForwarderRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateConditionalForwarder operation. The "output" return
+// ListIpRoutesPagesWithContext same as ListIpRoutesPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) ListIpRoutesPagesWithContext(ctx aws.Context, input *ListIpRoutesInput, fn func(*ListIpRoutesOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListIpRoutesInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListIpRoutesRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListIpRoutesOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListLogSubscriptions = "ListLogSubscriptions"
+
+// ListLogSubscriptionsRequest generates a "aws/request.Request" representing the
+// client's request for the ListLogSubscriptions operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateConditionalForwarder for more information on using the UpdateConditionalForwarder
+// See ListLogSubscriptions for more information on using the ListLogSubscriptions
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListLogSubscriptionsRequest method.
+//	req, resp := client.ListLogSubscriptionsRequest(params)
 //
-//    // Example sending a request using the UpdateConditionalForwarderRequest method.
-//    req, resp := client.UpdateConditionalForwarderRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UpdateConditionalForwarder
-func (c *DirectoryService) UpdateConditionalForwarderRequest(input *UpdateConditionalForwarderInput) (req *request.Request, output *UpdateConditionalForwarderOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ListLogSubscriptions
+func (c *DirectoryService) ListLogSubscriptionsRequest(input *ListLogSubscriptionsInput) (req *request.Request, output *ListLogSubscriptionsOutput) {
 	op := &request.Operation{
-		Name:       opUpdateConditionalForwarder,
+		Name:       opListLogSubscriptions,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "Limit",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &UpdateConditionalForwarderInput{}
+		input = &ListLogSubscriptionsInput{}
 	}
 
-	output = &UpdateConditionalForwarderOutput{}
+	output = &ListLogSubscriptionsOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// UpdateConditionalForwarder API operation for AWS Directory Service.
+// ListLogSubscriptions API operation for AWS Directory Service.
 //
-// Updates a conditional forwarder that has been set up for your AWS directory.
+// Lists the active log subscriptions for the Amazon Web Services account.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation UpdateConditionalForwarder for usage and error information.
-//
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// API operation ListLogSubscriptions for usage and error information.
 //
-//   * ErrCodeDirectoryUnavailableException "DirectoryUnavailableException"
-//   The specified directory is unavailable or could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeUnsupportedOperationException "UnsupportedOperationException"
-//   The operation is not supported.
+//   - InvalidNextTokenException
+//     The NextToken value is not valid.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UpdateConditionalForwarder
-func (c *DirectoryService) UpdateConditionalForwarder(input *UpdateConditionalForwarderInput) (*UpdateConditionalForwarderOutput, error) {
-	req, out := c.UpdateConditionalForwarderRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ListLogSubscriptions
+func (c *DirectoryService) ListLogSubscriptions(input *ListLogSubscriptionsInput) (*ListLogSubscriptionsOutput, error) {
+	req, out := c.ListLogSubscriptionsRequest(input)
 	return out, req.Send()
 }
 
-// UpdateConditionalForwarderWithContext is the same as UpdateConditionalForwarder with the addition of
+// ListLogSubscriptionsWithContext is the same as ListLogSubscriptions with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateConditionalForwarder for details on how to use this API operation.
+// See ListLogSubscriptions for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) UpdateConditionalForwarderWithContext(ctx aws.Context, input *UpdateConditionalForwarderInput, opts ...request.Option) (*UpdateConditionalForwarderOutput, error) {
-	req, out := c.UpdateConditionalForwarderRequest(input)
+func (c *DirectoryService) ListLogSubscriptionsWithContext(ctx aws.Context, input *ListLogSubscriptionsInput, opts ...request.Option) (*ListLogSubscriptionsOutput, error) {
+	req, out := c.ListLogSubscriptionsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateNumberOfDomainControllers = "UpdateNumberOfDomainControllers"
-
-// UpdateNumberOfDomainControllersRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateNumberOfDomainControllers operation. The "output" return
-// value will be populated with the request's response once the request completes
-// successfully.
-//
-// Use "Send" method on the returned Request to send the API call to the service.
-// the "output" return value is not valid until after Send returns without error.
-//
-// See UpdateNumberOfDomainControllers for more information on using the UpdateNumberOfDomainControllers
-// API call, and error handling.
+// ListLogSubscriptionsPages iterates over the pages of a ListLogSubscriptions operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
 //
-// This method is useful when you want to inject custom logic or configuration
-// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+// See ListLogSubscriptions method for more information on how to use this operation.
 //
+// Note: This operation can generate multiple requests to a service.
 //
-//    // Example sending a request using the UpdateNumberOfDomainControllersRequest method.
-//    req, resp := client.UpdateNumberOfDomainControllersRequest(params)
+//	// Example iterating over at most 3 pages of a ListLogSubscriptions operation.
+//	pageNum := 0
+//	err := client.ListLogSubscriptionsPages(params,
+//	    func(page *directoryservice.ListLogSubscriptionsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *DirectoryService) ListLogSubscriptionsPages(input *ListLogSubscriptionsInput, fn func(*ListLogSubscriptionsOutput, bool) bool) error {
+	return c.ListLogSubscriptionsPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListLogSubscriptionsPagesWithContext same as ListLogSubscriptionsPages except
+// it takes a Context and allows setting request options on the pages.
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) ListLogSubscriptionsPagesWithContext(ctx aws.Context, input *ListLogSubscriptionsInput, fn func(*ListLogSubscriptionsOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListLogSubscriptionsInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListLogSubscriptionsRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListLogSubscriptionsOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListSchemaExtensions = "ListSchemaExtensions"
+
+// ListSchemaExtensionsRequest generates a "aws/request.Request" representing the
+// client's request for the ListSchemaExtensions operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UpdateNumberOfDomainControllers
-func (c *DirectoryService) UpdateNumberOfDomainControllersRequest(input *UpdateNumberOfDomainControllersInput) (req *request.Request, output *UpdateNumberOfDomainControllersOutput) {
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListSchemaExtensions for more information on using the ListSchemaExtensions
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListSchemaExtensionsRequest method.
+//	req, resp := client.ListSchemaExtensionsRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ListSchemaExtensions
+func (c *DirectoryService) ListSchemaExtensionsRequest(input *ListSchemaExtensionsInput) (req *request.Request, output *ListSchemaExtensionsOutput) {
 	op := &request.Operation{
-		Name:       opUpdateNumberOfDomainControllers,
+		Name:       opListSchemaExtensions,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "Limit",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &UpdateNumberOfDomainControllersInput{}
+		input = &ListSchemaExtensionsInput{}
 	}
 
-	output = &UpdateNumberOfDomainControllersOutput{}
+	output = &ListSchemaExtensionsOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// UpdateNumberOfDomainControllers API operation for AWS Directory Service.
+// ListSchemaExtensions API operation for AWS Directory Service.
 //
-// Adds or removes domain controllers to or from the directory. Based on the
-// difference between current value and new value (provided through this API
-// call), domain controllers will be added or removed. It may take up to 45
-// minutes for any new domain controllers to become fully active once the requested
-// number of domain controllers is updated. During this time, you cannot make
-// another update request.
+// Lists all schema extensions applied to a Microsoft AD Directory.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation UpdateNumberOfDomainControllers for usage and error information.
-//
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
-//
-//   * ErrCodeDirectoryUnavailableException "DirectoryUnavailableException"
-//   The specified directory is unavailable or could not be found.
+// API operation ListSchemaExtensions for usage and error information.
 //
-//   * ErrCodeDomainControllerLimitExceededException "DomainControllerLimitExceededException"
-//   The maximum allowed number of domain controllers per directory was exceeded.
-//   The default limit per directory is 20 domain controllers.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - InvalidNextTokenException
+//     The NextToken value is not valid.
 //
-//   * ErrCodeUnsupportedOperationException "UnsupportedOperationException"
-//   The operation is not supported.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UpdateNumberOfDomainControllers
-func (c *DirectoryService) UpdateNumberOfDomainControllers(input *UpdateNumberOfDomainControllersInput) (*UpdateNumberOfDomainControllersOutput, error) {
-	req, out := c.UpdateNumberOfDomainControllersRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ListSchemaExtensions
+func (c *DirectoryService) ListSchemaExtensions(input *ListSchemaExtensionsInput) (*ListSchemaExtensionsOutput, error) {
+	req, out := c.ListSchemaExtensionsRequest(input)
 	return out, req.Send()
 }
 
-// UpdateNumberOfDomainControllersWithContext is the same as UpdateNumberOfDomainControllers with the addition of
+// ListSchemaExtensionsWithContext is the same as ListSchemaExtensions with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateNumberOfDomainControllers for details on how to use this API operation.
+// See ListSchemaExtensions for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) UpdateNumberOfDomainControllersWithContext(ctx aws.Context, input *UpdateNumberOfDomainControllersInput, opts ...request.Option) (*UpdateNumberOfDomainControllersOutput, error) {
-	req, out := c.UpdateNumberOfDomainControllersRequest(input)
+func (c *DirectoryService) ListSchemaExtensionsWithContext(ctx aws.Context, input *ListSchemaExtensionsInput, opts ...request.Option) (*ListSchemaExtensionsOutput, error) {
+	req, out := c.ListSchemaExtensionsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateRadius = "UpdateRadius"
+// ListSchemaExtensionsPages iterates over the pages of a ListSchemaExtensions operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListSchemaExtensions method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListSchemaExtensions operation.
+//	pageNum := 0
+//	err := client.ListSchemaExtensionsPages(params,
+//	    func(page *directoryservice.ListSchemaExtensionsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *DirectoryService) ListSchemaExtensionsPages(input *ListSchemaExtensionsInput, fn func(*ListSchemaExtensionsOutput, bool) bool) error {
+	return c.ListSchemaExtensionsPagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-// UpdateRadiusRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateRadius operation. The "output" return
+// ListSchemaExtensionsPagesWithContext same as ListSchemaExtensionsPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) ListSchemaExtensionsPagesWithContext(ctx aws.Context, input *ListSchemaExtensionsInput, fn func(*ListSchemaExtensionsOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListSchemaExtensionsInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListSchemaExtensionsRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListSchemaExtensionsOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListTagsForResource = "ListTagsForResource"
+
+// ListTagsForResourceRequest generates a "aws/request.Request" representing the
+// client's request for the ListTagsForResource operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateRadius for more information on using the UpdateRadius
+// See ListTagsForResource for more information on using the ListTagsForResource
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTagsForResourceRequest method.
+//	req, resp := client.ListTagsForResourceRequest(params)
 //
-//    // Example sending a request using the UpdateRadiusRequest method.
-//    req, resp := client.UpdateRadiusRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UpdateRadius
-func (c *DirectoryService) UpdateRadiusRequest(input *UpdateRadiusInput) (req *request.Request, output *UpdateRadiusOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ListTagsForResource
+func (c *DirectoryService) ListTagsForResourceRequest(input *ListTagsForResourceInput) (req *request.Request, output *ListTagsForResourceOutput) {
 	op := &request.Operation{
-		Name:       opUpdateRadius,
+		Name:       opListTagsForResource,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "Limit",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &UpdateRadiusInput{}
+		input = &ListTagsForResourceInput{}
 	}
 
-	output = &UpdateRadiusOutput{}
+	output = &ListTagsForResourceOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// UpdateRadius API operation for AWS Directory Service.
+// ListTagsForResource API operation for AWS Directory Service.
 //
-// Updates the Remote Authentication Dial In User Service (RADIUS) server information
-// for an AD Connector or Microsoft AD directory.
+// Lists all tags on a directory.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation UpdateRadius for usage and error information.
+// API operation ListTagsForResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+// Returned Error Types:
 //
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - InvalidNextTokenException
+//     The NextToken value is not valid.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UpdateRadius
-func (c *DirectoryService) UpdateRadius(input *UpdateRadiusInput) (*UpdateRadiusOutput, error) {
-	req, out := c.UpdateRadiusRequest(input)
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ListTagsForResource
+func (c *DirectoryService) ListTagsForResource(input *ListTagsForResourceInput) (*ListTagsForResourceOutput, error) {
+	req, out := c.ListTagsForResourceRequest(input)
 	return out, req.Send()
 }
 
-// UpdateRadiusWithContext is the same as UpdateRadius with the addition of
+// ListTagsForResourceWithContext is the same as ListTagsForResource with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateRadius for details on how to use this API operation.
+// See ListTagsForResource for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) UpdateRadiusWithContext(ctx aws.Context, input *UpdateRadiusInput, opts ...request.Option) (*UpdateRadiusOutput, error) {
-	req, out := c.UpdateRadiusRequest(input)
+func (c *DirectoryService) ListTagsForResourceWithContext(ctx aws.Context, input *ListTagsForResourceInput, opts ...request.Option) (*ListTagsForResourceOutput, error) {
+	req, out := c.ListTagsForResourceRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateTrust = "UpdateTrust"
+// ListTagsForResourcePages iterates over the pages of a ListTagsForResource operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListTagsForResource method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListTagsForResource operation.
+//	pageNum := 0
+//	err := client.ListTagsForResourcePages(params,
+//	    func(page *directoryservice.ListTagsForResourceOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *DirectoryService) ListTagsForResourcePages(input *ListTagsForResourceInput, fn func(*ListTagsForResourceOutput, bool) bool) error {
+	return c.ListTagsForResourcePagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-// UpdateTrustRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateTrust operation. The "output" return
+// ListTagsForResourcePagesWithContext same as ListTagsForResourcePages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) ListTagsForResourcePagesWithContext(ctx aws.Context, input *ListTagsForResourceInput, fn func(*ListTagsForResourceOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListTagsForResourceInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListTagsForResourceRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListTagsForResourceOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opRegisterCertificate = "RegisterCertificate"
+
+// RegisterCertificateRequest generates a "aws/request.Request" representing the
+// client's request for the RegisterCertificate operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateTrust for more information on using the UpdateTrust
+// See RegisterCertificate for more information on using the RegisterCertificate
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the RegisterCertificateRequest method.
+//	req, resp := client.RegisterCertificateRequest(params)
 //
-//    // Example sending a request using the UpdateTrustRequest method.
-//    req, resp := client.UpdateTrustRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UpdateTrust
-func (c *DirectoryService) UpdateTrustRequest(input *UpdateTrustInput) (req *request.Request, output *UpdateTrustOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RegisterCertificate
+func (c *DirectoryService) RegisterCertificateRequest(input *RegisterCertificateInput) (req *request.Request, output *RegisterCertificateOutput) {
 	op := &request.Operation{
-		Name:       opUpdateTrust,
+		Name:       opRegisterCertificate,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &UpdateTrustInput{}
+		input = &RegisterCertificateInput{}
 	}
 
-	output = &UpdateTrustOutput{}
+	output = &RegisterCertificateOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// UpdateTrust API operation for AWS Directory Service.
+// RegisterCertificate API operation for AWS Directory Service.
 //
-// Updates the trust that has been set up between your AWS Managed Microsoft
-// AD directory and an on-premises Active Directory.
+// Registers a certificate for a secure LDAP or client certificate authentication.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation UpdateTrust for usage and error information.
+// API operation RegisterCertificate for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - DirectoryUnavailableException
+//     The specified directory is unavailable or could not be found.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - DirectoryDoesNotExistException
+//     The specified directory does not exist in the system.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - InvalidCertificateException
+//     The certificate PEM that was provided has incorrect encoding.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UpdateTrust
-func (c *DirectoryService) UpdateTrust(input *UpdateTrustInput) (*UpdateTrustOutput, error) {
-	req, out := c.UpdateTrustRequest(input)
+//   - CertificateLimitExceededException
+//     The certificate could not be added because the certificate limit has been
+//     reached.
+//
+//   - CertificateAlreadyExistsException
+//     The certificate has already been registered into the system.
+//
+//   - UnsupportedOperationException
+//     The operation is not supported.
+//
+//   - InvalidParameterException
+//     One or more parameters are not valid.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RegisterCertificate
+func (c *DirectoryService) RegisterCertificate(input *RegisterCertificateInput) (*RegisterCertificateOutput, error) {
+	req, out := c.RegisterCertificateRequest(input)
 	return out, req.Send()
 }
 
-// UpdateTrustWithContext is the same as UpdateTrust with the addition of
+// RegisterCertificateWithContext is the same as RegisterCertificate with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateTrust for details on how to use this API operation.
+// See RegisterCertificate for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *DirectoryService) UpdateTrustWithContext(ctx aws.Context, input *UpdateTrustInput, opts ...request.Option) (*UpdateTrustOutput, error) {
-	req, out := c.UpdateTrustRequest(input)
+func (c *DirectoryService) RegisterCertificateWithContext(ctx aws.Context, input *RegisterCertificateInput, opts ...request.Option) (*RegisterCertificateOutput, error) {
+	req, out := c.RegisterCertificateRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opVerifyTrust = "VerifyTrust"
+const opRegisterEventTopic = "RegisterEventTopic"
 
-// VerifyTrustRequest generates a "aws/request.Request" representing the
-// client's request for the VerifyTrust operation. The "output" return
+// RegisterEventTopicRequest generates a "aws/request.Request" representing the
+// client's request for the RegisterEventTopic operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See VerifyTrust for more information on using the VerifyTrust
+// See RegisterEventTopic for more information on using the RegisterEventTopic
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the RegisterEventTopicRequest method.
+//	req, resp := client.RegisterEventTopicRequest(params)
 //
-//    // Example sending a request using the VerifyTrustRequest method.
-//    req, resp := client.VerifyTrustRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/VerifyTrust
-func (c *DirectoryService) VerifyTrustRequest(input *VerifyTrustInput) (req *request.Request, output *VerifyTrustOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RegisterEventTopic
+func (c *DirectoryService) RegisterEventTopicRequest(input *RegisterEventTopicInput) (req *request.Request, output *RegisterEventTopicOutput) {
 	op := &request.Operation{
-		Name:       opVerifyTrust,
+		Name:       opRegisterEventTopic,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &VerifyTrustInput{}
+		input = &RegisterEventTopicInput{}
 	}
 
-	output = &VerifyTrustOutput{}
+	output = &RegisterEventTopicOutput{}
 	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// VerifyTrust API operation for AWS Directory Service.
-//
-// AWS Directory Service for Microsoft Active Directory allows you to configure
-// and verify trust relationships.
+// RegisterEventTopic API operation for AWS Directory Service.
 //
-// This action verifies a trust relationship between your AWS Managed Microsoft
-// AD directory and an external domain.
+// Associates a directory with an Amazon SNS topic. This establishes the directory
+// as a publisher to the specified Amazon SNS topic. You can then receive email
+// or text (SMS) messages when the status of your directory changes. You get
+// notified if your directory goes from an Active status to an Impaired or Inoperable
+// status. You also receive a notification when the directory returns to an
+// Active status.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Directory Service's
-// API operation VerifyTrust for usage and error information.
+// API operation RegisterEventTopic for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeEntityDoesNotExistException "EntityDoesNotExistException"
-//   The specified entity could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterException "InvalidParameterException"
-//   One or more parameters are not valid.
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
 //
-//   * ErrCodeClientException "ClientException"
-//   A client exception has occurred.
+//   - InvalidParameterException
+//     One or more parameters are not valid.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   An exception has occurred in AWS Directory Service.
+//   - ClientException
+//     A client exception has occurred.
 //
-//   * ErrCodeUnsupportedOperationException "UnsupportedOperationException"
-//   The operation is not supported.
+//   - ServiceException
+//     An exception has occurred in Directory Service.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/VerifyTrust
-func (c *DirectoryService) VerifyTrust(input *VerifyTrustInput) (*VerifyTrustOutput, error) {
-	req, out := c.VerifyTrustRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RegisterEventTopic
+func (c *DirectoryService) RegisterEventTopic(input *RegisterEventTopicInput) (*RegisterEventTopicOutput, error) {
+	req, out := c.RegisterEventTopicRequest(input)
 	return out, req.Send()
 }
 
-// VerifyTrustWithContext is the same as VerifyTrust with the addition of
+// RegisterEventTopicWithContext is the same as RegisterEventTopic with the addition of
+// the ability to pass a context and additional request options.
+//
+// See RegisterEventTopic for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) RegisterEventTopicWithContext(ctx aws.Context, input *RegisterEventTopicInput, opts ...request.Option) (*RegisterEventTopicOutput, error) {
+	req, out := c.RegisterEventTopicRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opRejectSharedDirectory = "RejectSharedDirectory"
+
+// RejectSharedDirectoryRequest generates a "aws/request.Request" representing the
+// client's request for the RejectSharedDirectory operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See RejectSharedDirectory for more information on using the RejectSharedDirectory
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the RejectSharedDirectoryRequest method.
+//	req, resp := client.RejectSharedDirectoryRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RejectSharedDirectory
+func (c *DirectoryService) RejectSharedDirectoryRequest(input *RejectSharedDirectoryInput) (req *request.Request, output *RejectSharedDirectoryOutput) {
+	op := &request.Operation{
+		Name:       opRejectSharedDirectory,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &RejectSharedDirectoryInput{}
+	}
+
+	output = &RejectSharedDirectoryOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// RejectSharedDirectory API operation for AWS Directory Service.
+//
+// Rejects a directory sharing request that was sent from the directory owner
+// account.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Directory Service's
+// API operation RejectSharedDirectory for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidParameterException
+//     One or more parameters are not valid.
+//
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
+//
+//   - DirectoryAlreadySharedException
+//     The specified directory has already been shared with this Amazon Web Services
+//     account.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RejectSharedDirectory
+func (c *DirectoryService) RejectSharedDirectory(input *RejectSharedDirectoryInput) (*RejectSharedDirectoryOutput, error) {
+	req, out := c.RejectSharedDirectoryRequest(input)
+	return out, req.Send()
+}
+
+// RejectSharedDirectoryWithContext is the same as RejectSharedDirectory with the addition of
+// the ability to pass a context and additional request options.
+//
+// See RejectSharedDirectory for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) RejectSharedDirectoryWithContext(ctx aws.Context, input *RejectSharedDirectoryInput, opts ...request.Option) (*RejectSharedDirectoryOutput, error) {
+	req, out := c.RejectSharedDirectoryRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opRemoveIpRoutes = "RemoveIpRoutes"
+
+// RemoveIpRoutesRequest generates a "aws/request.Request" representing the
+// client's request for the RemoveIpRoutes operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See RemoveIpRoutes for more information on using the RemoveIpRoutes
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the RemoveIpRoutesRequest method.
+//	req, resp := client.RemoveIpRoutesRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RemoveIpRoutes
+func (c *DirectoryService) RemoveIpRoutesRequest(input *RemoveIpRoutesInput) (req *request.Request, output *RemoveIpRoutesOutput) {
+	op := &request.Operation{
+		Name:       opRemoveIpRoutes,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &RemoveIpRoutesInput{}
+	}
+
+	output = &RemoveIpRoutesOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// RemoveIpRoutes API operation for AWS Directory Service.
+//
+// Removes IP address blocks from a directory.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Directory Service's
+// API operation RemoveIpRoutes for usage and error information.
+//
+// Returned Error Types:
+//
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
+//
+//   - InvalidParameterException
+//     One or more parameters are not valid.
+//
+//   - DirectoryUnavailableException
+//     The specified directory is unavailable or could not be found.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RemoveIpRoutes
+func (c *DirectoryService) RemoveIpRoutes(input *RemoveIpRoutesInput) (*RemoveIpRoutesOutput, error) {
+	req, out := c.RemoveIpRoutesRequest(input)
+	return out, req.Send()
+}
+
+// RemoveIpRoutesWithContext is the same as RemoveIpRoutes with the addition of
+// the ability to pass a context and additional request options.
+//
+// See RemoveIpRoutes for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) RemoveIpRoutesWithContext(ctx aws.Context, input *RemoveIpRoutesInput, opts ...request.Option) (*RemoveIpRoutesOutput, error) {
+	req, out := c.RemoveIpRoutesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opRemoveRegion = "RemoveRegion"
+
+// RemoveRegionRequest generates a "aws/request.Request" representing the
+// client's request for the RemoveRegion operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See RemoveRegion for more information on using the RemoveRegion
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the RemoveRegionRequest method.
+//	req, resp := client.RemoveRegionRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RemoveRegion
+func (c *DirectoryService) RemoveRegionRequest(input *RemoveRegionInput) (req *request.Request, output *RemoveRegionOutput) {
+	op := &request.Operation{
+		Name:       opRemoveRegion,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &RemoveRegionInput{}
+	}
+
+	output = &RemoveRegionOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// RemoveRegion API operation for AWS Directory Service.
+//
+// Stops all replication and removes the domain controllers from the specified
+// Region. You cannot remove the primary Region with this operation. Instead,
+// use the DeleteDirectory API.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Directory Service's
+// API operation RemoveRegion for usage and error information.
+//
+// Returned Error Types:
+//
+//   - DirectoryUnavailableException
+//     The specified directory is unavailable or could not be found.
+//
+//   - DirectoryDoesNotExistException
+//     The specified directory does not exist in the system.
+//
+//   - UnsupportedOperationException
+//     The operation is not supported.
+//
+//   - AccessDeniedException
+//     Client authentication is not available in this region at this time.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RemoveRegion
+func (c *DirectoryService) RemoveRegion(input *RemoveRegionInput) (*RemoveRegionOutput, error) {
+	req, out := c.RemoveRegionRequest(input)
+	return out, req.Send()
+}
+
+// RemoveRegionWithContext is the same as RemoveRegion with the addition of
 // the ability to pass a context and additional request options.
 //
-// See VerifyTrust for details on how to use this API operation.
+// See RemoveRegion for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) RemoveRegionWithContext(ctx aws.Context, input *RemoveRegionInput, opts ...request.Option) (*RemoveRegionOutput, error) {
+	req, out := c.RemoveRegionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opRemoveTagsFromResource = "RemoveTagsFromResource"
+
+// RemoveTagsFromResourceRequest generates a "aws/request.Request" representing the
+// client's request for the RemoveTagsFromResource operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See RemoveTagsFromResource for more information on using the RemoveTagsFromResource
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the RemoveTagsFromResourceRequest method.
+//	req, resp := client.RemoveTagsFromResourceRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RemoveTagsFromResource
+func (c *DirectoryService) RemoveTagsFromResourceRequest(input *RemoveTagsFromResourceInput) (req *request.Request, output *RemoveTagsFromResourceOutput) {
+	op := &request.Operation{
+		Name:       opRemoveTagsFromResource,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &RemoveTagsFromResourceInput{}
+	}
+
+	output = &RemoveTagsFromResourceOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// RemoveTagsFromResource API operation for AWS Directory Service.
+//
+// Removes tags from a directory.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Directory Service's
+// API operation RemoveTagsFromResource for usage and error information.
+//
+// Returned Error Types:
+//
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
+//
+//   - InvalidParameterException
+//     One or more parameters are not valid.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RemoveTagsFromResource
+func (c *DirectoryService) RemoveTagsFromResource(input *RemoveTagsFromResourceInput) (*RemoveTagsFromResourceOutput, error) {
+	req, out := c.RemoveTagsFromResourceRequest(input)
+	return out, req.Send()
+}
+
+// RemoveTagsFromResourceWithContext is the same as RemoveTagsFromResource with the addition of
+// the ability to pass a context and additional request options.
+//
+// See RemoveTagsFromResource for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) RemoveTagsFromResourceWithContext(ctx aws.Context, input *RemoveTagsFromResourceInput, opts ...request.Option) (*RemoveTagsFromResourceOutput, error) {
+	req, out := c.RemoveTagsFromResourceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opResetUserPassword = "ResetUserPassword"
+
+// ResetUserPasswordRequest generates a "aws/request.Request" representing the
+// client's request for the ResetUserPassword operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ResetUserPassword for more information on using the ResetUserPassword
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ResetUserPasswordRequest method.
+//	req, resp := client.ResetUserPasswordRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ResetUserPassword
+func (c *DirectoryService) ResetUserPasswordRequest(input *ResetUserPasswordInput) (req *request.Request, output *ResetUserPasswordOutput) {
+	op := &request.Operation{
+		Name:       opResetUserPassword,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &ResetUserPasswordInput{}
+	}
+
+	output = &ResetUserPasswordOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// ResetUserPassword API operation for AWS Directory Service.
+//
+// Resets the password for any user in your Managed Microsoft AD or Simple AD
+// directory.
+//
+// You can reset the password for any user in your directory with the following
+// exceptions:
+//
+//   - For Simple AD, you cannot reset the password for any user that is a
+//     member of either the Domain Admins or Enterprise Admins group except for
+//     the administrator user.
+//
+//   - For Managed Microsoft AD, you can only reset the password for a user
+//     that is in an OU based off of the NetBIOS name that you typed when you
+//     created your directory. For example, you cannot reset the password for
+//     a user in the Amazon Web Services Reserved OU. For more information about
+//     the OU structure for an Managed Microsoft AD directory, see What Gets
+//     Created (https://docs.aws.amazon.com/directoryservice/latest/admin-guide/ms_ad_getting_started_what_gets_created.html)
+//     in the Directory Service Administration Guide.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Directory Service's
+// API operation ResetUserPassword for usage and error information.
+//
+// Returned Error Types:
+//
+//   - DirectoryUnavailableException
+//     The specified directory is unavailable or could not be found.
+//
+//   - UserDoesNotExistException
+//     The user provided a username that does not exist in your directory.
+//
+//   - InvalidPasswordException
+//     The new password provided by the user does not meet the password complexity
+//     requirements defined in your directory.
+//
+//   - UnsupportedOperationException
+//     The operation is not supported.
+//
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ResetUserPassword
+func (c *DirectoryService) ResetUserPassword(input *ResetUserPasswordInput) (*ResetUserPasswordOutput, error) {
+	req, out := c.ResetUserPasswordRequest(input)
+	return out, req.Send()
+}
+
+// ResetUserPasswordWithContext is the same as ResetUserPassword with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ResetUserPassword for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) ResetUserPasswordWithContext(ctx aws.Context, input *ResetUserPasswordInput, opts ...request.Option) (*ResetUserPasswordOutput, error) {
+	req, out := c.ResetUserPasswordRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opRestoreFromSnapshot = "RestoreFromSnapshot"
+
+// RestoreFromSnapshotRequest generates a "aws/request.Request" representing the
+// client's request for the RestoreFromSnapshot operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See RestoreFromSnapshot for more information on using the RestoreFromSnapshot
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the RestoreFromSnapshotRequest method.
+//	req, resp := client.RestoreFromSnapshotRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RestoreFromSnapshot
+func (c *DirectoryService) RestoreFromSnapshotRequest(input *RestoreFromSnapshotInput) (req *request.Request, output *RestoreFromSnapshotOutput) {
+	op := &request.Operation{
+		Name:       opRestoreFromSnapshot,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &RestoreFromSnapshotInput{}
+	}
+
+	output = &RestoreFromSnapshotOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// RestoreFromSnapshot API operation for AWS Directory Service.
+//
+// Restores a directory using an existing directory snapshot.
+//
+// When you restore a directory from a snapshot, any changes made to the directory
+// after the snapshot date are overwritten.
+//
+// This action returns as soon as the restore operation is initiated. You can
+// monitor the progress of the restore operation by calling the DescribeDirectories
+// operation with the directory identifier. When the DirectoryDescription.Stage
+// value changes to Active, the restore operation is complete.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Directory Service's
+// API operation RestoreFromSnapshot for usage and error information.
+//
+// Returned Error Types:
+//
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
+//
+//   - InvalidParameterException
+//     One or more parameters are not valid.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/RestoreFromSnapshot
+func (c *DirectoryService) RestoreFromSnapshot(input *RestoreFromSnapshotInput) (*RestoreFromSnapshotOutput, error) {
+	req, out := c.RestoreFromSnapshotRequest(input)
+	return out, req.Send()
+}
+
+// RestoreFromSnapshotWithContext is the same as RestoreFromSnapshot with the addition of
+// the ability to pass a context and additional request options.
+//
+// See RestoreFromSnapshot for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) RestoreFromSnapshotWithContext(ctx aws.Context, input *RestoreFromSnapshotInput, opts ...request.Option) (*RestoreFromSnapshotOutput, error) {
+	req, out := c.RestoreFromSnapshotRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opShareDirectory = "ShareDirectory"
+
+// ShareDirectoryRequest generates a "aws/request.Request" representing the
+// client's request for the ShareDirectory operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ShareDirectory for more information on using the ShareDirectory
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ShareDirectoryRequest method.
+//	req, resp := client.ShareDirectoryRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ShareDirectory
+func (c *DirectoryService) ShareDirectoryRequest(input *ShareDirectoryInput) (req *request.Request, output *ShareDirectoryOutput) {
+	op := &request.Operation{
+		Name:       opShareDirectory,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &ShareDirectoryInput{}
+	}
+
+	output = &ShareDirectoryOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ShareDirectory API operation for AWS Directory Service.
+//
+// Shares a specified directory (DirectoryId) in your Amazon Web Services account
+// (directory owner) with another Amazon Web Services account (directory consumer).
+// With this operation you can use your directory from any Amazon Web Services
+// account and from any Amazon VPC within an Amazon Web Services Region.
+//
+// When you share your Managed Microsoft AD directory, Directory Service creates
+// a shared directory in the directory consumer account. This shared directory
+// contains the metadata to provide access to the directory within the directory
+// owner account. The shared directory is visible in all VPCs in the directory
+// consumer account.
+//
+// The ShareMethod parameter determines whether the specified directory can
+// be shared between Amazon Web Services accounts inside the same Amazon Web
+// Services organization (ORGANIZATIONS). It also determines whether you can
+// share the directory with any other Amazon Web Services account either inside
+// or outside of the organization (HANDSHAKE).
+//
+// The ShareNotes parameter is only used when HANDSHAKE is called, which sends
+// a directory sharing request to the directory consumer.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Directory Service's
+// API operation ShareDirectory for usage and error information.
+//
+// Returned Error Types:
+//
+//   - DirectoryAlreadySharedException
+//     The specified directory has already been shared with this Amazon Web Services
+//     account.
+//
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
+//
+//   - InvalidTargetException
+//     The specified shared target is not valid.
+//
+//   - InvalidParameterException
+//     One or more parameters are not valid.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ShareLimitExceededException
+//     The maximum number of Amazon Web Services accounts that you can share with
+//     this directory has been reached.
+//
+//   - OrganizationsException
+//     Exception encountered while trying to access your Amazon Web Services organization.
+//
+//   - AccessDeniedException
+//     Client authentication is not available in this region at this time.
+//
+//   - UnsupportedOperationException
+//     The operation is not supported.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/ShareDirectory
+func (c *DirectoryService) ShareDirectory(input *ShareDirectoryInput) (*ShareDirectoryOutput, error) {
+	req, out := c.ShareDirectoryRequest(input)
+	return out, req.Send()
+}
+
+// ShareDirectoryWithContext is the same as ShareDirectory with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ShareDirectory for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) ShareDirectoryWithContext(ctx aws.Context, input *ShareDirectoryInput, opts ...request.Option) (*ShareDirectoryOutput, error) {
+	req, out := c.ShareDirectoryRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opStartSchemaExtension = "StartSchemaExtension"
+
+// StartSchemaExtensionRequest generates a "aws/request.Request" representing the
+// client's request for the StartSchemaExtension operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See StartSchemaExtension for more information on using the StartSchemaExtension
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the StartSchemaExtensionRequest method.
+//	req, resp := client.StartSchemaExtensionRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/StartSchemaExtension
+func (c *DirectoryService) StartSchemaExtensionRequest(input *StartSchemaExtensionInput) (req *request.Request, output *StartSchemaExtensionOutput) {
+	op := &request.Operation{
+		Name:       opStartSchemaExtension,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &StartSchemaExtensionInput{}
+	}
+
+	output = &StartSchemaExtensionOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// StartSchemaExtension API operation for AWS Directory Service.
+//
+// Applies a schema extension to a Microsoft AD directory.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Directory Service's
+// API operation StartSchemaExtension for usage and error information.
+//
+// Returned Error Types:
+//
+//   - DirectoryUnavailableException
+//     The specified directory is unavailable or could not be found.
+//
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
+//
+//   - InvalidParameterException
+//     One or more parameters are not valid.
+//
+//   - SnapshotLimitExceededException
+//     The maximum number of manual snapshots for the directory has been reached.
+//     You can use the GetSnapshotLimits operation to determine the snapshot limits
+//     for a directory.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/StartSchemaExtension
+func (c *DirectoryService) StartSchemaExtension(input *StartSchemaExtensionInput) (*StartSchemaExtensionOutput, error) {
+	req, out := c.StartSchemaExtensionRequest(input)
+	return out, req.Send()
+}
+
+// StartSchemaExtensionWithContext is the same as StartSchemaExtension with the addition of
+// the ability to pass a context and additional request options.
+//
+// See StartSchemaExtension for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) StartSchemaExtensionWithContext(ctx aws.Context, input *StartSchemaExtensionInput, opts ...request.Option) (*StartSchemaExtensionOutput, error) {
+	req, out := c.StartSchemaExtensionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUnshareDirectory = "UnshareDirectory"
+
+// UnshareDirectoryRequest generates a "aws/request.Request" representing the
+// client's request for the UnshareDirectory operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UnshareDirectory for more information on using the UnshareDirectory
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UnshareDirectoryRequest method.
+//	req, resp := client.UnshareDirectoryRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UnshareDirectory
+func (c *DirectoryService) UnshareDirectoryRequest(input *UnshareDirectoryInput) (req *request.Request, output *UnshareDirectoryOutput) {
+	op := &request.Operation{
+		Name:       opUnshareDirectory,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UnshareDirectoryInput{}
+	}
+
+	output = &UnshareDirectoryOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UnshareDirectory API operation for AWS Directory Service.
+//
+// Stops the directory sharing between the directory owner and consumer accounts.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Directory Service's
+// API operation UnshareDirectory for usage and error information.
+//
+// Returned Error Types:
+//
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
+//
+//   - InvalidTargetException
+//     The specified shared target is not valid.
+//
+//   - DirectoryNotSharedException
+//     The specified directory has not been shared with this Amazon Web Services
+//     account.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UnshareDirectory
+func (c *DirectoryService) UnshareDirectory(input *UnshareDirectoryInput) (*UnshareDirectoryOutput, error) {
+	req, out := c.UnshareDirectoryRequest(input)
+	return out, req.Send()
+}
+
+// UnshareDirectoryWithContext is the same as UnshareDirectory with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UnshareDirectory for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) UnshareDirectoryWithContext(ctx aws.Context, input *UnshareDirectoryInput, opts ...request.Option) (*UnshareDirectoryOutput, error) {
+	req, out := c.UnshareDirectoryRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateConditionalForwarder = "UpdateConditionalForwarder"
+
+// UpdateConditionalForwarderRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateConditionalForwarder operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateConditionalForwarder for more information on using the UpdateConditionalForwarder
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateConditionalForwarderRequest method.
+//	req, resp := client.UpdateConditionalForwarderRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UpdateConditionalForwarder
+func (c *DirectoryService) UpdateConditionalForwarderRequest(input *UpdateConditionalForwarderInput) (req *request.Request, output *UpdateConditionalForwarderOutput) {
+	op := &request.Operation{
+		Name:       opUpdateConditionalForwarder,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UpdateConditionalForwarderInput{}
+	}
+
+	output = &UpdateConditionalForwarderOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// UpdateConditionalForwarder API operation for AWS Directory Service.
+//
+// Updates a conditional forwarder that has been set up for your Amazon Web
+// Services directory.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Directory Service's
+// API operation UpdateConditionalForwarder for usage and error information.
+//
+// Returned Error Types:
+//
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
+//
+//   - DirectoryUnavailableException
+//     The specified directory is unavailable or could not be found.
+//
+//   - InvalidParameterException
+//     One or more parameters are not valid.
+//
+//   - UnsupportedOperationException
+//     The operation is not supported.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UpdateConditionalForwarder
+func (c *DirectoryService) UpdateConditionalForwarder(input *UpdateConditionalForwarderInput) (*UpdateConditionalForwarderOutput, error) {
+	req, out := c.UpdateConditionalForwarderRequest(input)
+	return out, req.Send()
+}
+
+// UpdateConditionalForwarderWithContext is the same as UpdateConditionalForwarder with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateConditionalForwarder for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) UpdateConditionalForwarderWithContext(ctx aws.Context, input *UpdateConditionalForwarderInput, opts ...request.Option) (*UpdateConditionalForwarderOutput, error) {
+	req, out := c.UpdateConditionalForwarderRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateDirectorySetup = "UpdateDirectorySetup"
+
+// UpdateDirectorySetupRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateDirectorySetup operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateDirectorySetup for more information on using the UpdateDirectorySetup
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateDirectorySetupRequest method.
+//	req, resp := client.UpdateDirectorySetupRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UpdateDirectorySetup
+func (c *DirectoryService) UpdateDirectorySetupRequest(input *UpdateDirectorySetupInput) (req *request.Request, output *UpdateDirectorySetupOutput) {
+	op := &request.Operation{
+		Name:       opUpdateDirectorySetup,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UpdateDirectorySetupInput{}
+	}
+
+	output = &UpdateDirectorySetupOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// UpdateDirectorySetup API operation for AWS Directory Service.
+//
+// Updates the directory for a particular update type.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Directory Service's
+// API operation UpdateDirectorySetup for usage and error information.
+//
+// Returned Error Types:
+//
+//   - UnsupportedOperationException
+//     The operation is not supported.
+//
+//   - DirectoryInDesiredStateException
+//     The directory is already updated to desired update type settings.
+//
+//   - DirectoryUnavailableException
+//     The specified directory is unavailable or could not be found.
+//
+//   - SnapshotLimitExceededException
+//     The maximum number of manual snapshots for the directory has been reached.
+//     You can use the GetSnapshotLimits operation to determine the snapshot limits
+//     for a directory.
+//
+//   - InvalidParameterException
+//     One or more parameters are not valid.
+//
+//   - DirectoryDoesNotExistException
+//     The specified directory does not exist in the system.
+//
+//   - AccessDeniedException
+//     Client authentication is not available in this region at this time.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UpdateDirectorySetup
+func (c *DirectoryService) UpdateDirectorySetup(input *UpdateDirectorySetupInput) (*UpdateDirectorySetupOutput, error) {
+	req, out := c.UpdateDirectorySetupRequest(input)
+	return out, req.Send()
+}
+
+// UpdateDirectorySetupWithContext is the same as UpdateDirectorySetup with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateDirectorySetup for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) UpdateDirectorySetupWithContext(ctx aws.Context, input *UpdateDirectorySetupInput, opts ...request.Option) (*UpdateDirectorySetupOutput, error) {
+	req, out := c.UpdateDirectorySetupRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateNumberOfDomainControllers = "UpdateNumberOfDomainControllers"
+
+// UpdateNumberOfDomainControllersRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateNumberOfDomainControllers operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateNumberOfDomainControllers for more information on using the UpdateNumberOfDomainControllers
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateNumberOfDomainControllersRequest method.
+//	req, resp := client.UpdateNumberOfDomainControllersRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UpdateNumberOfDomainControllers
+func (c *DirectoryService) UpdateNumberOfDomainControllersRequest(input *UpdateNumberOfDomainControllersInput) (req *request.Request, output *UpdateNumberOfDomainControllersOutput) {
+	op := &request.Operation{
+		Name:       opUpdateNumberOfDomainControllers,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UpdateNumberOfDomainControllersInput{}
+	}
+
+	output = &UpdateNumberOfDomainControllersOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// UpdateNumberOfDomainControllers API operation for AWS Directory Service.
+//
+// Adds or removes domain controllers to or from the directory. Based on the
+// difference between current value and new value (provided through this API
+// call), domain controllers will be added or removed. It may take up to 45
+// minutes for any new domain controllers to become fully active once the requested
+// number of domain controllers is updated. During this time, you cannot make
+// another update request.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Directory Service's
+// API operation UpdateNumberOfDomainControllers for usage and error information.
+//
+// Returned Error Types:
+//
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
+//
+//   - DirectoryUnavailableException
+//     The specified directory is unavailable or could not be found.
+//
+//   - DomainControllerLimitExceededException
+//     The maximum allowed number of domain controllers per directory was exceeded.
+//     The default limit per directory is 20 domain controllers.
+//
+//   - InvalidParameterException
+//     One or more parameters are not valid.
+//
+//   - UnsupportedOperationException
+//     The operation is not supported.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UpdateNumberOfDomainControllers
+func (c *DirectoryService) UpdateNumberOfDomainControllers(input *UpdateNumberOfDomainControllersInput) (*UpdateNumberOfDomainControllersOutput, error) {
+	req, out := c.UpdateNumberOfDomainControllersRequest(input)
+	return out, req.Send()
+}
+
+// UpdateNumberOfDomainControllersWithContext is the same as UpdateNumberOfDomainControllers with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateNumberOfDomainControllers for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) UpdateNumberOfDomainControllersWithContext(ctx aws.Context, input *UpdateNumberOfDomainControllersInput, opts ...request.Option) (*UpdateNumberOfDomainControllersOutput, error) {
+	req, out := c.UpdateNumberOfDomainControllersRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateRadius = "UpdateRadius"
+
+// UpdateRadiusRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateRadius operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateRadius for more information on using the UpdateRadius
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateRadiusRequest method.
+//	req, resp := client.UpdateRadiusRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UpdateRadius
+func (c *DirectoryService) UpdateRadiusRequest(input *UpdateRadiusInput) (req *request.Request, output *UpdateRadiusOutput) {
+	op := &request.Operation{
+		Name:       opUpdateRadius,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UpdateRadiusInput{}
+	}
+
+	output = &UpdateRadiusOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// UpdateRadius API operation for AWS Directory Service.
+//
+// Updates the Remote Authentication Dial In User Service (RADIUS) server information
+// for an AD Connector or Microsoft AD directory.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Directory Service's
+// API operation UpdateRadius for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidParameterException
+//     One or more parameters are not valid.
+//
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UpdateRadius
+func (c *DirectoryService) UpdateRadius(input *UpdateRadiusInput) (*UpdateRadiusOutput, error) {
+	req, out := c.UpdateRadiusRequest(input)
+	return out, req.Send()
+}
+
+// UpdateRadiusWithContext is the same as UpdateRadius with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateRadius for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) UpdateRadiusWithContext(ctx aws.Context, input *UpdateRadiusInput, opts ...request.Option) (*UpdateRadiusOutput, error) {
+	req, out := c.UpdateRadiusRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateSettings = "UpdateSettings"
+
+// UpdateSettingsRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateSettings operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateSettings for more information on using the UpdateSettings
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateSettingsRequest method.
+//	req, resp := client.UpdateSettingsRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UpdateSettings
+func (c *DirectoryService) UpdateSettingsRequest(input *UpdateSettingsInput) (req *request.Request, output *UpdateSettingsOutput) {
+	op := &request.Operation{
+		Name:       opUpdateSettings,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UpdateSettingsInput{}
+	}
+
+	output = &UpdateSettingsOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UpdateSettings API operation for AWS Directory Service.
+//
+// Updates the configurable settings for the specified directory.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Directory Service's
+// API operation UpdateSettings for usage and error information.
+//
+// Returned Error Types:
+//
+//   - DirectoryDoesNotExistException
+//     The specified directory does not exist in the system.
+//
+//   - UnsupportedOperationException
+//     The operation is not supported.
+//
+//   - DirectoryUnavailableException
+//     The specified directory is unavailable or could not be found.
+//
+//   - IncompatibleSettingsException
+//     The specified directory setting is not compatible with other settings.
+//
+//   - UnsupportedSettingsException
+//     The specified directory setting is not supported.
+//
+//   - InvalidParameterException
+//     One or more parameters are not valid.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UpdateSettings
+func (c *DirectoryService) UpdateSettings(input *UpdateSettingsInput) (*UpdateSettingsOutput, error) {
+	req, out := c.UpdateSettingsRequest(input)
+	return out, req.Send()
+}
+
+// UpdateSettingsWithContext is the same as UpdateSettings with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateSettings for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) UpdateSettingsWithContext(ctx aws.Context, input *UpdateSettingsInput, opts ...request.Option) (*UpdateSettingsOutput, error) {
+	req, out := c.UpdateSettingsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateTrust = "UpdateTrust"
+
+// UpdateTrustRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateTrust operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateTrust for more information on using the UpdateTrust
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateTrustRequest method.
+//	req, resp := client.UpdateTrustRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UpdateTrust
+func (c *DirectoryService) UpdateTrustRequest(input *UpdateTrustInput) (req *request.Request, output *UpdateTrustOutput) {
+	op := &request.Operation{
+		Name:       opUpdateTrust,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UpdateTrustInput{}
+	}
+
+	output = &UpdateTrustOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UpdateTrust API operation for AWS Directory Service.
+//
+// Updates the trust that has been set up between your Managed Microsoft AD
+// directory and an self-managed Active Directory.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Directory Service's
+// API operation UpdateTrust for usage and error information.
+//
+// Returned Error Types:
+//
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
+//
+//   - InvalidParameterException
+//     One or more parameters are not valid.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/UpdateTrust
+func (c *DirectoryService) UpdateTrust(input *UpdateTrustInput) (*UpdateTrustOutput, error) {
+	req, out := c.UpdateTrustRequest(input)
+	return out, req.Send()
+}
+
+// UpdateTrustWithContext is the same as UpdateTrust with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateTrust for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) UpdateTrustWithContext(ctx aws.Context, input *UpdateTrustInput, opts ...request.Option) (*UpdateTrustOutput, error) {
+	req, out := c.UpdateTrustRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opVerifyTrust = "VerifyTrust"
+
+// VerifyTrustRequest generates a "aws/request.Request" representing the
+// client's request for the VerifyTrust operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See VerifyTrust for more information on using the VerifyTrust
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the VerifyTrustRequest method.
+//	req, resp := client.VerifyTrustRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/VerifyTrust
+func (c *DirectoryService) VerifyTrustRequest(input *VerifyTrustInput) (req *request.Request, output *VerifyTrustOutput) {
+	op := &request.Operation{
+		Name:       opVerifyTrust,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &VerifyTrustInput{}
+	}
+
+	output = &VerifyTrustOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// VerifyTrust API operation for AWS Directory Service.
+//
+// Directory Service for Microsoft Active Directory allows you to configure
+// and verify trust relationships.
+//
+// This action verifies a trust relationship between your Managed Microsoft
+// AD directory and an external domain.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Directory Service's
+// API operation VerifyTrust for usage and error information.
+//
+// Returned Error Types:
+//
+//   - EntityDoesNotExistException
+//     The specified entity could not be found.
+//
+//   - InvalidParameterException
+//     One or more parameters are not valid.
+//
+//   - ClientException
+//     A client exception has occurred.
+//
+//   - ServiceException
+//     An exception has occurred in Directory Service.
+//
+//   - UnsupportedOperationException
+//     The operation is not supported.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/VerifyTrust
+func (c *DirectoryService) VerifyTrust(input *VerifyTrustInput) (*VerifyTrustOutput, error) {
+	req, out := c.VerifyTrustRequest(input)
+	return out, req.Send()
+}
+
+// VerifyTrustWithContext is the same as VerifyTrust with the addition of
+// the ability to pass a context and additional request options.
+//
+// See VerifyTrust for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DirectoryService) VerifyTrustWithContext(ctx aws.Context, input *VerifyTrustInput, opts ...request.Option) (*VerifyTrustOutput, error) {
+	req, out := c.VerifyTrustRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+type AcceptSharedDirectoryInput struct {
+	_ struct{} `type:"structure"`
+
+	// Identifier of the shared directory in the directory consumer account. This
+	// identifier is different for each directory owner account.
+	//
+	// SharedDirectoryId is a required field
+	SharedDirectoryId *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AcceptSharedDirectoryInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AcceptSharedDirectoryInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *AcceptSharedDirectoryInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AcceptSharedDirectoryInput"}
+	if s.SharedDirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("SharedDirectoryId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetSharedDirectoryId sets the SharedDirectoryId field's value.
+func (s *AcceptSharedDirectoryInput) SetSharedDirectoryId(v string) *AcceptSharedDirectoryInput {
+	s.SharedDirectoryId = &v
+	return s
+}
+
+type AcceptSharedDirectoryOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The shared directory in the directory consumer account.
+	SharedDirectory *SharedDirectory `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AcceptSharedDirectoryOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AcceptSharedDirectoryOutput) GoString() string {
+	return s.String()
+}
+
+// SetSharedDirectory sets the SharedDirectory field's value.
+func (s *AcceptSharedDirectoryOutput) SetSharedDirectory(v *SharedDirectory) *AcceptSharedDirectoryOutput {
+	s.SharedDirectory = v
+	return s
+}
+
+// Client authentication is not available in this region at this time.
+type AccessDeniedException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AccessDeniedException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AccessDeniedException) GoString() string {
+	return s.String()
+}
+
+func newErrorAccessDeniedException(v protocol.ResponseMetadata) error {
+	return &AccessDeniedException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *AccessDeniedException) Code() string {
+	return "AccessDeniedException"
+}
+
+// Message returns the exception's message.
+func (s *AccessDeniedException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *AccessDeniedException) OrigErr() error {
+	return nil
+}
+
+func (s *AccessDeniedException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *AccessDeniedException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *AccessDeniedException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type AddIpRoutesInput struct {
+	_ struct{} `type:"structure"`
+
+	// Identifier (ID) of the directory to which to add the address block.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
+
+	// IP address blocks, using CIDR format, of the traffic to route. This is often
+	// the IP address block of the DNS server used for your self-managed domain.
+	//
+	// IpRoutes is a required field
+	IpRoutes []*IpRoute `type:"list" required:"true"`
+
+	// If set to true, updates the inbound and outbound rules of the security group
+	// that has the description: "Amazon Web Services created security group for
+	// directory ID directory controllers." Following are the new rules:
+	//
+	// Inbound:
+	//
+	//    * Type: Custom UDP Rule, Protocol: UDP, Range: 88, Source: 0.0.0.0/0
+	//
+	//    * Type: Custom UDP Rule, Protocol: UDP, Range: 123, Source: 0.0.0.0/0
+	//
+	//    * Type: Custom UDP Rule, Protocol: UDP, Range: 138, Source: 0.0.0.0/0
+	//
+	//    * Type: Custom UDP Rule, Protocol: UDP, Range: 389, Source: 0.0.0.0/0
+	//
+	//    * Type: Custom UDP Rule, Protocol: UDP, Range: 464, Source: 0.0.0.0/0
+	//
+	//    * Type: Custom UDP Rule, Protocol: UDP, Range: 445, Source: 0.0.0.0/0
+	//
+	//    * Type: Custom TCP Rule, Protocol: TCP, Range: 88, Source: 0.0.0.0/0
+	//
+	//    * Type: Custom TCP Rule, Protocol: TCP, Range: 135, Source: 0.0.0.0/0
+	//
+	//    * Type: Custom TCP Rule, Protocol: TCP, Range: 445, Source: 0.0.0.0/0
+	//
+	//    * Type: Custom TCP Rule, Protocol: TCP, Range: 464, Source: 0.0.0.0/0
+	//
+	//    * Type: Custom TCP Rule, Protocol: TCP, Range: 636, Source: 0.0.0.0/0
+	//
+	//    * Type: Custom TCP Rule, Protocol: TCP, Range: 1024-65535, Source: 0.0.0.0/0
+	//
+	//    * Type: Custom TCP Rule, Protocol: TCP, Range: 3268-33269, Source: 0.0.0.0/0
+	//
+	//    * Type: DNS (UDP), Protocol: UDP, Range: 53, Source: 0.0.0.0/0
+	//
+	//    * Type: DNS (TCP), Protocol: TCP, Range: 53, Source: 0.0.0.0/0
+	//
+	//    * Type: LDAP, Protocol: TCP, Range: 389, Source: 0.0.0.0/0
+	//
+	//    * Type: All ICMP, Protocol: All, Range: N/A, Source: 0.0.0.0/0
+	//
+	// Outbound:
+	//
+	//    * Type: All traffic, Protocol: All, Range: All, Destination: 0.0.0.0/0
+	//
+	// These security rules impact an internal network interface that is not exposed
+	// publicly.
+	UpdateSecurityGroupForDirectoryControllers *bool `type:"boolean"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AddIpRoutesInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AddIpRoutesInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *AddIpRoutesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddIpRoutesInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+	if s.IpRoutes == nil {
+		invalidParams.Add(request.NewErrParamRequired("IpRoutes"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *AddIpRoutesInput) SetDirectoryId(v string) *AddIpRoutesInput {
+	s.DirectoryId = &v
+	return s
+}
+
+// SetIpRoutes sets the IpRoutes field's value.
+func (s *AddIpRoutesInput) SetIpRoutes(v []*IpRoute) *AddIpRoutesInput {
+	s.IpRoutes = v
+	return s
+}
+
+// SetUpdateSecurityGroupForDirectoryControllers sets the UpdateSecurityGroupForDirectoryControllers field's value.
+func (s *AddIpRoutesInput) SetUpdateSecurityGroupForDirectoryControllers(v bool) *AddIpRoutesInput {
+	s.UpdateSecurityGroupForDirectoryControllers = &v
+	return s
+}
+
+type AddIpRoutesOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AddIpRoutesOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AddIpRoutesOutput) GoString() string {
+	return s.String()
+}
+
+type AddRegionInput struct {
+	_ struct{} `type:"structure"`
+
+	// The identifier of the directory to which you want to add Region replication.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
+
+	// The name of the Region where you want to add domain controllers for replication.
+	// For example, us-east-1.
+	//
+	// RegionName is a required field
+	RegionName *string `min:"8" type:"string" required:"true"`
+
+	// Contains VPC information for the CreateDirectory or CreateMicrosoftAD operation.
+	//
+	// VPCSettings is a required field
+	VPCSettings *DirectoryVpcSettings `type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AddRegionInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AddRegionInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *AddRegionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddRegionInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+	if s.RegionName == nil {
+		invalidParams.Add(request.NewErrParamRequired("RegionName"))
+	}
+	if s.RegionName != nil && len(*s.RegionName) < 8 {
+		invalidParams.Add(request.NewErrParamMinLen("RegionName", 8))
+	}
+	if s.VPCSettings == nil {
+		invalidParams.Add(request.NewErrParamRequired("VPCSettings"))
+	}
+	if s.VPCSettings != nil {
+		if err := s.VPCSettings.Validate(); err != nil {
+			invalidParams.AddNested("VPCSettings", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *AddRegionInput) SetDirectoryId(v string) *AddRegionInput {
+	s.DirectoryId = &v
+	return s
+}
+
+// SetRegionName sets the RegionName field's value.
+func (s *AddRegionInput) SetRegionName(v string) *AddRegionInput {
+	s.RegionName = &v
+	return s
+}
+
+// SetVPCSettings sets the VPCSettings field's value.
+func (s *AddRegionInput) SetVPCSettings(v *DirectoryVpcSettings) *AddRegionInput {
+	s.VPCSettings = v
+	return s
+}
+
+type AddRegionOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AddRegionOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AddRegionOutput) GoString() string {
+	return s.String()
+}
+
+type AddTagsToResourceInput struct {
+	_ struct{} `type:"structure"`
+
+	// Identifier (ID) for the directory to which to add the tag.
+	//
+	// ResourceId is a required field
+	ResourceId *string `type:"string" required:"true"`
+
+	// The tags to be assigned to the directory.
+	//
+	// Tags is a required field
+	Tags []*Tag `type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AddTagsToResourceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AddTagsToResourceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *AddTagsToResourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddTagsToResourceInput"}
+	if s.ResourceId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceId"))
+	}
+	if s.Tags == nil {
+		invalidParams.Add(request.NewErrParamRequired("Tags"))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetResourceId sets the ResourceId field's value.
+func (s *AddTagsToResourceInput) SetResourceId(v string) *AddTagsToResourceInput {
+	s.ResourceId = &v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *AddTagsToResourceInput) SetTags(v []*Tag) *AddTagsToResourceInput {
+	s.Tags = v
+	return s
+}
+
+type AddTagsToResourceOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AddTagsToResourceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AddTagsToResourceOutput) GoString() string {
+	return s.String()
+}
+
+// Represents a named directory attribute.
+type Attribute struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the attribute.
+	Name *string `min:"1" type:"string"`
+
+	// The value of the attribute.
+	Value *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Attribute) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Attribute) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *Attribute) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "Attribute"}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetName sets the Name field's value.
+func (s *Attribute) SetName(v string) *Attribute {
+	s.Name = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *Attribute) SetValue(v string) *Attribute {
+	s.Value = &v
+	return s
+}
+
+// An authentication error occurred.
+type AuthenticationFailedException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The textual message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The identifier of the request that caused the exception.
+	RequestId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AuthenticationFailedException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AuthenticationFailedException) GoString() string {
+	return s.String()
+}
+
+func newErrorAuthenticationFailedException(v protocol.ResponseMetadata) error {
+	return &AuthenticationFailedException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *AuthenticationFailedException) Code() string {
+	return "AuthenticationFailedException"
+}
+
+// Message returns the exception's message.
+func (s *AuthenticationFailedException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *AuthenticationFailedException) OrigErr() error {
+	return nil
+}
+
+func (s *AuthenticationFailedException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *AuthenticationFailedException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *AuthenticationFailedException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type CancelSchemaExtensionInput struct {
+	_ struct{} `type:"structure"`
+
+	// The identifier of the directory whose schema extension will be canceled.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
+
+	// The identifier of the schema extension that will be canceled.
+	//
+	// SchemaExtensionId is a required field
+	SchemaExtensionId *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CancelSchemaExtensionInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CancelSchemaExtensionInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CancelSchemaExtensionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CancelSchemaExtensionInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+	if s.SchemaExtensionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("SchemaExtensionId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *CancelSchemaExtensionInput) SetDirectoryId(v string) *CancelSchemaExtensionInput {
+	s.DirectoryId = &v
+	return s
+}
+
+// SetSchemaExtensionId sets the SchemaExtensionId field's value.
+func (s *CancelSchemaExtensionInput) SetSchemaExtensionId(v string) *CancelSchemaExtensionInput {
+	s.SchemaExtensionId = &v
+	return s
+}
+
+type CancelSchemaExtensionOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CancelSchemaExtensionOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CancelSchemaExtensionOutput) GoString() string {
+	return s.String()
+}
+
+// Information about the certificate.
+type Certificate struct {
+	_ struct{} `type:"structure"`
+
+	// The identifier of the certificate.
+	CertificateId *string `type:"string"`
+
+	// A ClientCertAuthSettings object that contains client certificate authentication
+	// settings.
+	ClientCertAuthSettings *ClientCertAuthSettings `type:"structure"`
+
+	// The common name for the certificate.
+	CommonName *string `type:"string"`
+
+	// The date and time when the certificate will expire.
+	ExpiryDateTime *time.Time `type:"timestamp"`
+
+	// The date and time that the certificate was registered.
+	RegisteredDateTime *time.Time `type:"timestamp"`
+
+	// The state of the certificate.
+	State *string `type:"string" enum:"CertificateState"`
+
+	// Describes a state change for the certificate.
+	StateReason *string `type:"string"`
+
+	// The function that the registered certificate performs. Valid values include
+	// ClientLDAPS or ClientCertAuth. The default value is ClientLDAPS.
+	Type *string `type:"string" enum:"CertificateType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Certificate) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Certificate) GoString() string {
+	return s.String()
+}
+
+// SetCertificateId sets the CertificateId field's value.
+func (s *Certificate) SetCertificateId(v string) *Certificate {
+	s.CertificateId = &v
+	return s
+}
+
+// SetClientCertAuthSettings sets the ClientCertAuthSettings field's value.
+func (s *Certificate) SetClientCertAuthSettings(v *ClientCertAuthSettings) *Certificate {
+	s.ClientCertAuthSettings = v
+	return s
+}
+
+// SetCommonName sets the CommonName field's value.
+func (s *Certificate) SetCommonName(v string) *Certificate {
+	s.CommonName = &v
+	return s
+}
+
+// SetExpiryDateTime sets the ExpiryDateTime field's value.
+func (s *Certificate) SetExpiryDateTime(v time.Time) *Certificate {
+	s.ExpiryDateTime = &v
+	return s
+}
+
+// SetRegisteredDateTime sets the RegisteredDateTime field's value.
+func (s *Certificate) SetRegisteredDateTime(v time.Time) *Certificate {
+	s.RegisteredDateTime = &v
+	return s
+}
+
+// SetState sets the State field's value.
+func (s *Certificate) SetState(v string) *Certificate {
+	s.State = &v
+	return s
+}
+
+// SetStateReason sets the StateReason field's value.
+func (s *Certificate) SetStateReason(v string) *Certificate {
+	s.StateReason = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *Certificate) SetType(v string) *Certificate {
+	s.Type = &v
+	return s
+}
+
+// The certificate has already been registered into the system.
+type CertificateAlreadyExistsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CertificateAlreadyExistsException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CertificateAlreadyExistsException) GoString() string {
+	return s.String()
+}
+
+func newErrorCertificateAlreadyExistsException(v protocol.ResponseMetadata) error {
+	return &CertificateAlreadyExistsException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *CertificateAlreadyExistsException) Code() string {
+	return "CertificateAlreadyExistsException"
+}
+
+// Message returns the exception's message.
+func (s *CertificateAlreadyExistsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *CertificateAlreadyExistsException) OrigErr() error {
+	return nil
+}
+
+func (s *CertificateAlreadyExistsException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *CertificateAlreadyExistsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *CertificateAlreadyExistsException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The certificate is not present in the system for describe or deregister activities.
+type CertificateDoesNotExistException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CertificateDoesNotExistException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CertificateDoesNotExistException) GoString() string {
+	return s.String()
+}
+
+func newErrorCertificateDoesNotExistException(v protocol.ResponseMetadata) error {
+	return &CertificateDoesNotExistException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *CertificateDoesNotExistException) Code() string {
+	return "CertificateDoesNotExistException"
+}
+
+// Message returns the exception's message.
+func (s *CertificateDoesNotExistException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *CertificateDoesNotExistException) OrigErr() error {
+	return nil
+}
+
+func (s *CertificateDoesNotExistException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *CertificateDoesNotExistException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *CertificateDoesNotExistException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The certificate is being used for the LDAP security connection and cannot
+// be removed without disabling LDAP security.
+type CertificateInUseException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CertificateInUseException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CertificateInUseException) GoString() string {
+	return s.String()
+}
+
+func newErrorCertificateInUseException(v protocol.ResponseMetadata) error {
+	return &CertificateInUseException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *CertificateInUseException) Code() string {
+	return "CertificateInUseException"
+}
+
+// Message returns the exception's message.
+func (s *CertificateInUseException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *CertificateInUseException) OrigErr() error {
+	return nil
+}
+
+func (s *CertificateInUseException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *CertificateInUseException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *CertificateInUseException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Contains general information about a certificate.
+type CertificateInfo struct {
+	_ struct{} `type:"structure"`
+
+	// The identifier of the certificate.
+	CertificateId *string `type:"string"`
+
+	// The common name for the certificate.
+	CommonName *string `type:"string"`
+
+	// The date and time when the certificate will expire.
+	ExpiryDateTime *time.Time `type:"timestamp"`
+
+	// The state of the certificate.
+	State *string `type:"string" enum:"CertificateState"`
+
+	// The function that the registered certificate performs. Valid values include
+	// ClientLDAPS or ClientCertAuth. The default value is ClientLDAPS.
+	Type *string `type:"string" enum:"CertificateType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CertificateInfo) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CertificateInfo) GoString() string {
+	return s.String()
+}
+
+// SetCertificateId sets the CertificateId field's value.
+func (s *CertificateInfo) SetCertificateId(v string) *CertificateInfo {
+	s.CertificateId = &v
+	return s
+}
+
+// SetCommonName sets the CommonName field's value.
+func (s *CertificateInfo) SetCommonName(v string) *CertificateInfo {
+	s.CommonName = &v
+	return s
+}
+
+// SetExpiryDateTime sets the ExpiryDateTime field's value.
+func (s *CertificateInfo) SetExpiryDateTime(v time.Time) *CertificateInfo {
+	s.ExpiryDateTime = &v
+	return s
+}
+
+// SetState sets the State field's value.
+func (s *CertificateInfo) SetState(v string) *CertificateInfo {
+	s.State = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *CertificateInfo) SetType(v string) *CertificateInfo {
+	s.Type = &v
+	return s
+}
+
+// The certificate could not be added because the certificate limit has been
+// reached.
+type CertificateLimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CertificateLimitExceededException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CertificateLimitExceededException) GoString() string {
+	return s.String()
+}
+
+func newErrorCertificateLimitExceededException(v protocol.ResponseMetadata) error {
+	return &CertificateLimitExceededException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *CertificateLimitExceededException) Code() string {
+	return "CertificateLimitExceededException"
+}
+
+// Message returns the exception's message.
+func (s *CertificateLimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *CertificateLimitExceededException) OrigErr() error {
+	return nil
+}
+
+func (s *CertificateLimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *CertificateLimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *CertificateLimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Contains information about a client authentication method for a directory.
+type ClientAuthenticationSettingInfo struct {
+	_ struct{} `type:"structure"`
+
+	// The date and time when the status of the client authentication type was last
+	// updated.
+	LastUpdatedDateTime *time.Time `type:"timestamp"`
+
+	// Whether the client authentication type is enabled or disabled for the specified
+	// directory.
+	Status *string `type:"string" enum:"ClientAuthenticationStatus"`
+
+	// The type of client authentication for the specified directory. If no type
+	// is specified, a list of all client authentication types that are supported
+	// for the directory is retrieved.
+	Type *string `type:"string" enum:"ClientAuthenticationType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ClientAuthenticationSettingInfo) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ClientAuthenticationSettingInfo) GoString() string {
+	return s.String()
+}
+
+// SetLastUpdatedDateTime sets the LastUpdatedDateTime field's value.
+func (s *ClientAuthenticationSettingInfo) SetLastUpdatedDateTime(v time.Time) *ClientAuthenticationSettingInfo {
+	s.LastUpdatedDateTime = &v
+	return s
+}
+
+// SetStatus sets the Status field's value.
+func (s *ClientAuthenticationSettingInfo) SetStatus(v string) *ClientAuthenticationSettingInfo {
+	s.Status = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *ClientAuthenticationSettingInfo) SetType(v string) *ClientAuthenticationSettingInfo {
+	s.Type = &v
+	return s
+}
+
+// Contains information about the client certificate authentication settings
+// for the RegisterCertificate and DescribeCertificate operations.
+type ClientCertAuthSettings struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies the URL of the default OCSP server used to check for revocation
+	// status. A secondary value to any OCSP address found in the AIA extension
+	// of the user certificate.
+	OCSPUrl *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ClientCertAuthSettings) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ClientCertAuthSettings) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ClientCertAuthSettings) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ClientCertAuthSettings"}
+	if s.OCSPUrl != nil && len(*s.OCSPUrl) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("OCSPUrl", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetOCSPUrl sets the OCSPUrl field's value.
+func (s *ClientCertAuthSettings) SetOCSPUrl(v string) *ClientCertAuthSettings {
+	s.OCSPUrl = &v
+	return s
+}
+
+// A client exception has occurred.
+type ClientException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ClientException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ClientException) GoString() string {
+	return s.String()
+}
+
+func newErrorClientException(v protocol.ResponseMetadata) error {
+	return &ClientException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ClientException) Code() string {
+	return "ClientException"
+}
+
+// Message returns the exception's message.
+func (s *ClientException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ClientException) OrigErr() error {
+	return nil
+}
+
+func (s *ClientException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ClientException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ClientException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Contains information about a computer account in a directory.
+type Computer struct {
+	_ struct{} `type:"structure"`
+
+	// An array of Attribute objects containing the LDAP attributes that belong
+	// to the computer account.
+	ComputerAttributes []*Attribute `type:"list"`
+
+	// The identifier of the computer.
+	ComputerId *string `min:"1" type:"string"`
+
+	// The computer name.
+	ComputerName *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Computer) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Computer) GoString() string {
+	return s.String()
+}
+
+// SetComputerAttributes sets the ComputerAttributes field's value.
+func (s *Computer) SetComputerAttributes(v []*Attribute) *Computer {
+	s.ComputerAttributes = v
+	return s
+}
+
+// SetComputerId sets the ComputerId field's value.
+func (s *Computer) SetComputerId(v string) *Computer {
+	s.ComputerId = &v
+	return s
+}
+
+// SetComputerName sets the ComputerName field's value.
+func (s *Computer) SetComputerName(v string) *Computer {
+	s.ComputerName = &v
+	return s
+}
+
+// Points to a remote domain with which you are setting up a trust relationship.
+// Conditional forwarders are required in order to set up a trust relationship
+// with another domain.
+type ConditionalForwarder struct {
+	_ struct{} `type:"structure"`
+
+	// The IP addresses of the remote DNS server associated with RemoteDomainName.
+	// This is the IP address of the DNS server that your conditional forwarder
+	// points to.
+	DnsIpAddrs []*string `type:"list"`
+
+	// The fully qualified domain name (FQDN) of the remote domains pointed to by
+	// the conditional forwarder.
+	RemoteDomainName *string `type:"string"`
+
+	// The replication scope of the conditional forwarder. The only allowed value
+	// is Domain, which will replicate the conditional forwarder to all of the domain
+	// controllers for your Amazon Web Services directory.
+	ReplicationScope *string `type:"string" enum:"ReplicationScope"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConditionalForwarder) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConditionalForwarder) GoString() string {
+	return s.String()
+}
+
+// SetDnsIpAddrs sets the DnsIpAddrs field's value.
+func (s *ConditionalForwarder) SetDnsIpAddrs(v []*string) *ConditionalForwarder {
+	s.DnsIpAddrs = v
+	return s
+}
+
+// SetRemoteDomainName sets the RemoteDomainName field's value.
+func (s *ConditionalForwarder) SetRemoteDomainName(v string) *ConditionalForwarder {
+	s.RemoteDomainName = &v
+	return s
+}
+
+// SetReplicationScope sets the ReplicationScope field's value.
+func (s *ConditionalForwarder) SetReplicationScope(v string) *ConditionalForwarder {
+	s.ReplicationScope = &v
+	return s
+}
+
+// Contains the inputs for the ConnectDirectory operation.
+type ConnectDirectoryInput struct {
+	_ struct{} `type:"structure"`
+
+	// A DirectoryConnectSettings object that contains additional information for
+	// the operation.
+	//
+	// ConnectSettings is a required field
+	ConnectSettings *DirectoryConnectSettings `type:"structure" required:"true"`
+
+	// A description for the directory.
+	Description *string `type:"string"`
+
+	// The fully qualified name of your self-managed directory, such as corp.example.com.
+	//
+	// Name is a required field
+	Name *string `type:"string" required:"true"`
+
+	// The password for your self-managed user account.
+	//
+	// Password is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by ConnectDirectoryInput's
+	// String and GoString methods.
+	//
+	// Password is a required field
+	Password *string `min:"1" type:"string" required:"true" sensitive:"true"`
+
+	// The NetBIOS name of your self-managed directory, such as CORP.
+	ShortName *string `type:"string"`
+
+	// The size of the directory.
+	//
+	// Size is a required field
+	Size *string `type:"string" required:"true" enum:"DirectorySize"`
+
+	// The tags to be assigned to AD Connector.
+	Tags []*Tag `type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConnectDirectoryInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConnectDirectoryInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ConnectDirectoryInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ConnectDirectoryInput"}
+	if s.ConnectSettings == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectSettings"))
+	}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Password == nil {
+		invalidParams.Add(request.NewErrParamRequired("Password"))
+	}
+	if s.Password != nil && len(*s.Password) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Password", 1))
+	}
+	if s.Size == nil {
+		invalidParams.Add(request.NewErrParamRequired("Size"))
+	}
+	if s.ConnectSettings != nil {
+		if err := s.ConnectSettings.Validate(); err != nil {
+			invalidParams.AddNested("ConnectSettings", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetConnectSettings sets the ConnectSettings field's value.
+func (s *ConnectDirectoryInput) SetConnectSettings(v *DirectoryConnectSettings) *ConnectDirectoryInput {
+	s.ConnectSettings = v
+	return s
+}
+
+// SetDescription sets the Description field's value.
+func (s *ConnectDirectoryInput) SetDescription(v string) *ConnectDirectoryInput {
+	s.Description = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *ConnectDirectoryInput) SetName(v string) *ConnectDirectoryInput {
+	s.Name = &v
+	return s
+}
+
+// SetPassword sets the Password field's value.
+func (s *ConnectDirectoryInput) SetPassword(v string) *ConnectDirectoryInput {
+	s.Password = &v
+	return s
+}
+
+// SetShortName sets the ShortName field's value.
+func (s *ConnectDirectoryInput) SetShortName(v string) *ConnectDirectoryInput {
+	s.ShortName = &v
+	return s
+}
+
+// SetSize sets the Size field's value.
+func (s *ConnectDirectoryInput) SetSize(v string) *ConnectDirectoryInput {
+	s.Size = &v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *ConnectDirectoryInput) SetTags(v []*Tag) *ConnectDirectoryInput {
+	s.Tags = v
+	return s
+}
+
+// Contains the results of the ConnectDirectory operation.
+type ConnectDirectoryOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The identifier of the new directory.
+	DirectoryId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConnectDirectoryOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConnectDirectoryOutput) GoString() string {
+	return s.String()
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *ConnectDirectoryOutput) SetDirectoryId(v string) *ConnectDirectoryOutput {
+	s.DirectoryId = &v
+	return s
+}
+
+// Contains the inputs for the CreateAlias operation.
+type CreateAliasInput struct {
+	_ struct{} `type:"structure"`
+
+	// The requested alias.
+	//
+	// The alias must be unique amongst all aliases in Amazon Web Services. This
+	// operation throws an EntityAlreadyExistsException error if the alias already
+	// exists.
+	//
+	// Alias is a required field
+	Alias *string `min:"1" type:"string" required:"true"`
+
+	// The identifier of the directory for which to create the alias.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateAliasInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateAliasInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateAliasInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateAliasInput"}
+	if s.Alias == nil {
+		invalidParams.Add(request.NewErrParamRequired("Alias"))
+	}
+	if s.Alias != nil && len(*s.Alias) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Alias", 1))
+	}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAlias sets the Alias field's value.
+func (s *CreateAliasInput) SetAlias(v string) *CreateAliasInput {
+	s.Alias = &v
+	return s
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *CreateAliasInput) SetDirectoryId(v string) *CreateAliasInput {
+	s.DirectoryId = &v
+	return s
+}
+
+// Contains the results of the CreateAlias operation.
+type CreateAliasOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The alias for the directory.
+	Alias *string `min:"1" type:"string"`
+
+	// The identifier of the directory.
+	DirectoryId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateAliasOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateAliasOutput) GoString() string {
+	return s.String()
+}
+
+// SetAlias sets the Alias field's value.
+func (s *CreateAliasOutput) SetAlias(v string) *CreateAliasOutput {
+	s.Alias = &v
+	return s
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *CreateAliasOutput) SetDirectoryId(v string) *CreateAliasOutput {
+	s.DirectoryId = &v
+	return s
+}
+
+// Contains the inputs for the CreateComputer operation.
+type CreateComputerInput struct {
+	_ struct{} `type:"structure"`
+
+	// An array of Attribute objects that contain any LDAP attributes to apply to
+	// the computer account.
+	ComputerAttributes []*Attribute `type:"list"`
+
+	// The name of the computer account.
+	//
+	// ComputerName is a required field
+	ComputerName *string `min:"1" type:"string" required:"true"`
+
+	// The identifier of the directory in which to create the computer account.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
+
+	// The fully-qualified distinguished name of the organizational unit to place
+	// the computer account in.
+	OrganizationalUnitDistinguishedName *string `min:"1" type:"string"`
+
+	// A one-time password that is used to join the computer to the directory. You
+	// should generate a random, strong password to use for this parameter.
+	//
+	// Password is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by CreateComputerInput's
+	// String and GoString methods.
+	//
+	// Password is a required field
+	Password *string `min:"8" type:"string" required:"true" sensitive:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateComputerInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateComputerInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateComputerInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateComputerInput"}
+	if s.ComputerName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ComputerName"))
+	}
+	if s.ComputerName != nil && len(*s.ComputerName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ComputerName", 1))
+	}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+	if s.OrganizationalUnitDistinguishedName != nil && len(*s.OrganizationalUnitDistinguishedName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("OrganizationalUnitDistinguishedName", 1))
+	}
+	if s.Password == nil {
+		invalidParams.Add(request.NewErrParamRequired("Password"))
+	}
+	if s.Password != nil && len(*s.Password) < 8 {
+		invalidParams.Add(request.NewErrParamMinLen("Password", 8))
+	}
+	if s.ComputerAttributes != nil {
+		for i, v := range s.ComputerAttributes {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ComputerAttributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetComputerAttributes sets the ComputerAttributes field's value.
+func (s *CreateComputerInput) SetComputerAttributes(v []*Attribute) *CreateComputerInput {
+	s.ComputerAttributes = v
+	return s
+}
+
+// SetComputerName sets the ComputerName field's value.
+func (s *CreateComputerInput) SetComputerName(v string) *CreateComputerInput {
+	s.ComputerName = &v
+	return s
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *CreateComputerInput) SetDirectoryId(v string) *CreateComputerInput {
+	s.DirectoryId = &v
+	return s
+}
+
+// SetOrganizationalUnitDistinguishedName sets the OrganizationalUnitDistinguishedName field's value.
+func (s *CreateComputerInput) SetOrganizationalUnitDistinguishedName(v string) *CreateComputerInput {
+	s.OrganizationalUnitDistinguishedName = &v
+	return s
+}
+
+// SetPassword sets the Password field's value.
+func (s *CreateComputerInput) SetPassword(v string) *CreateComputerInput {
+	s.Password = &v
+	return s
+}
+
+// Contains the results for the CreateComputer operation.
+type CreateComputerOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A Computer object that represents the computer account.
+	Computer *Computer `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateComputerOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateComputerOutput) GoString() string {
+	return s.String()
+}
+
+// SetComputer sets the Computer field's value.
+func (s *CreateComputerOutput) SetComputer(v *Computer) *CreateComputerOutput {
+	s.Computer = v
+	return s
+}
+
+// Initiates the creation of a conditional forwarder for your Directory Service
+// for Microsoft Active Directory. Conditional forwarders are required in order
+// to set up a trust relationship with another domain.
+type CreateConditionalForwarderInput struct {
+	_ struct{} `type:"structure"`
+
+	// The directory ID of the Amazon Web Services directory for which you are creating
+	// the conditional forwarder.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
+
+	// The IP addresses of the remote DNS server associated with RemoteDomainName.
+	//
+	// DnsIpAddrs is a required field
+	DnsIpAddrs []*string `type:"list" required:"true"`
+
+	// The fully qualified domain name (FQDN) of the remote domain with which you
+	// will set up a trust relationship.
+	//
+	// RemoteDomainName is a required field
+	RemoteDomainName *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateConditionalForwarderInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateConditionalForwarderInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateConditionalForwarderInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateConditionalForwarderInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+	if s.DnsIpAddrs == nil {
+		invalidParams.Add(request.NewErrParamRequired("DnsIpAddrs"))
+	}
+	if s.RemoteDomainName == nil {
+		invalidParams.Add(request.NewErrParamRequired("RemoteDomainName"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *CreateConditionalForwarderInput) SetDirectoryId(v string) *CreateConditionalForwarderInput {
+	s.DirectoryId = &v
+	return s
+}
+
+// SetDnsIpAddrs sets the DnsIpAddrs field's value.
+func (s *CreateConditionalForwarderInput) SetDnsIpAddrs(v []*string) *CreateConditionalForwarderInput {
+	s.DnsIpAddrs = v
+	return s
+}
+
+// SetRemoteDomainName sets the RemoteDomainName field's value.
+func (s *CreateConditionalForwarderInput) SetRemoteDomainName(v string) *CreateConditionalForwarderInput {
+	s.RemoteDomainName = &v
+	return s
+}
+
+// The result of a CreateConditinalForwarder request.
+type CreateConditionalForwarderOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateConditionalForwarderOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateConditionalForwarderOutput) GoString() string {
+	return s.String()
+}
+
+// Contains the inputs for the CreateDirectory operation.
+type CreateDirectoryInput struct {
+	_ struct{} `type:"structure"`
+
+	// A description for the directory.
+	Description *string `type:"string"`
+
+	// The fully qualified name for the directory, such as corp.example.com.
+	//
+	// Name is a required field
+	Name *string `type:"string" required:"true"`
+
+	// The password for the directory administrator. The directory creation process
+	// creates a directory administrator account with the user name Administrator
+	// and this password.
+	//
+	// If you need to change the password for the administrator account, you can
+	// use the ResetUserPassword API call.
+	//
+	// The regex pattern for this string is made up of the following conditions:
+	//
+	//    * Length (?=^.{8,64}$) – Must be between 8 and 64 characters
+	//
+	// AND any 3 of the following password complexity rules required by Active Directory:
+	//
+	//    * Numbers and upper case and lowercase (?=.*\d)(?=.*[A-Z])(?=.*[a-z])
+	//
+	//    * Numbers and special characters and lower case (?=.*\d)(?=.*[^A-Za-z0-9\s])(?=.*[a-z])
+	//
+	//    * Special characters and upper case and lower case (?=.*[^A-Za-z0-9\s])(?=.*[A-Z])(?=.*[a-z])
+	//
+	//    * Numbers and upper case and special characters (?=.*\d)(?=.*[A-Z])(?=.*[^A-Za-z0-9\s])
+	//
+	// For additional information about how Active Directory passwords are enforced,
+	// see Password must meet complexity requirements (https://docs.microsoft.com/en-us/windows/security/threat-protection/security-policy-settings/password-must-meet-complexity-requirements)
+	// on the Microsoft website.
+	//
+	// Password is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by CreateDirectoryInput's
+	// String and GoString methods.
+	//
+	// Password is a required field
+	Password *string `type:"string" required:"true" sensitive:"true"`
+
+	// The NetBIOS name of the directory, such as CORP.
+	ShortName *string `type:"string"`
+
+	// The size of the directory.
+	//
+	// Size is a required field
+	Size *string `type:"string" required:"true" enum:"DirectorySize"`
+
+	// The tags to be assigned to the Simple AD directory.
+	Tags []*Tag `type:"list"`
+
+	// A DirectoryVpcSettings object that contains additional information for the
+	// operation.
+	VpcSettings *DirectoryVpcSettings `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDirectoryInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDirectoryInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateDirectoryInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDirectoryInput"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Password == nil {
+		invalidParams.Add(request.NewErrParamRequired("Password"))
+	}
+	if s.Size == nil {
+		invalidParams.Add(request.NewErrParamRequired("Size"))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.VpcSettings != nil {
+		if err := s.VpcSettings.Validate(); err != nil {
+			invalidParams.AddNested("VpcSettings", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDescription sets the Description field's value.
+func (s *CreateDirectoryInput) SetDescription(v string) *CreateDirectoryInput {
+	s.Description = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *CreateDirectoryInput) SetName(v string) *CreateDirectoryInput {
+	s.Name = &v
+	return s
+}
+
+// SetPassword sets the Password field's value.
+func (s *CreateDirectoryInput) SetPassword(v string) *CreateDirectoryInput {
+	s.Password = &v
+	return s
+}
+
+// SetShortName sets the ShortName field's value.
+func (s *CreateDirectoryInput) SetShortName(v string) *CreateDirectoryInput {
+	s.ShortName = &v
+	return s
+}
+
+// SetSize sets the Size field's value.
+func (s *CreateDirectoryInput) SetSize(v string) *CreateDirectoryInput {
+	s.Size = &v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *CreateDirectoryInput) SetTags(v []*Tag) *CreateDirectoryInput {
+	s.Tags = v
+	return s
+}
+
+// SetVpcSettings sets the VpcSettings field's value.
+func (s *CreateDirectoryInput) SetVpcSettings(v *DirectoryVpcSettings) *CreateDirectoryInput {
+	s.VpcSettings = v
+	return s
+}
+
+// Contains the results of the CreateDirectory operation.
+type CreateDirectoryOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The identifier of the directory that was created.
+	DirectoryId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDirectoryOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDirectoryOutput) GoString() string {
+	return s.String()
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *CreateDirectoryOutput) SetDirectoryId(v string) *CreateDirectoryOutput {
+	s.DirectoryId = &v
+	return s
+}
+
+type CreateLogSubscriptionInput struct {
+	_ struct{} `type:"structure"`
+
+	// Identifier of the directory to which you want to subscribe and receive real-time
+	// logs to your specified CloudWatch log group.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
+
+	// The name of the CloudWatch log group where the real-time domain controller
+	// logs are forwarded.
+	//
+	// LogGroupName is a required field
+	LogGroupName *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateLogSubscriptionInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateLogSubscriptionInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateLogSubscriptionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateLogSubscriptionInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+	if s.LogGroupName == nil {
+		invalidParams.Add(request.NewErrParamRequired("LogGroupName"))
+	}
+	if s.LogGroupName != nil && len(*s.LogGroupName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("LogGroupName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *CreateLogSubscriptionInput) SetDirectoryId(v string) *CreateLogSubscriptionInput {
+	s.DirectoryId = &v
+	return s
+}
+
+// SetLogGroupName sets the LogGroupName field's value.
+func (s *CreateLogSubscriptionInput) SetLogGroupName(v string) *CreateLogSubscriptionInput {
+	s.LogGroupName = &v
+	return s
+}
+
+type CreateLogSubscriptionOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateLogSubscriptionOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateLogSubscriptionOutput) GoString() string {
+	return s.String()
+}
+
+// Creates an Managed Microsoft AD directory.
+type CreateMicrosoftADInput struct {
+	_ struct{} `type:"structure"`
+
+	// A description for the directory. This label will appear on the Amazon Web
+	// Services console Directory Details page after the directory is created.
+	Description *string `type:"string"`
+
+	// Managed Microsoft AD is available in two editions: Standard and Enterprise.
+	// Enterprise is the default.
+	Edition *string `type:"string" enum:"DirectoryEdition"`
+
+	// The fully qualified domain name for the Managed Microsoft AD directory, such
+	// as corp.example.com. This name will resolve inside your VPC only. It does
+	// not need to be publicly resolvable.
+	//
+	// Name is a required field
+	Name *string `type:"string" required:"true"`
+
+	// The password for the default administrative user named Admin.
+	//
+	// If you need to change the password for the administrator account, you can
+	// use the ResetUserPassword API call.
+	//
+	// Password is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by CreateMicrosoftADInput's
+	// String and GoString methods.
+	//
+	// Password is a required field
+	Password *string `type:"string" required:"true" sensitive:"true"`
+
+	// The NetBIOS name for your domain, such as CORP. If you don't specify a NetBIOS
+	// name, it will default to the first part of your directory DNS. For example,
+	// CORP for the directory DNS corp.example.com.
+	ShortName *string `type:"string"`
+
+	// The tags to be assigned to the Managed Microsoft AD directory.
+	Tags []*Tag `type:"list"`
+
+	// Contains VPC information for the CreateDirectory or CreateMicrosoftAD operation.
+	//
+	// VpcSettings is a required field
+	VpcSettings *DirectoryVpcSettings `type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateMicrosoftADInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateMicrosoftADInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateMicrosoftADInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateMicrosoftADInput"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Password == nil {
+		invalidParams.Add(request.NewErrParamRequired("Password"))
+	}
+	if s.VpcSettings == nil {
+		invalidParams.Add(request.NewErrParamRequired("VpcSettings"))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.VpcSettings != nil {
+		if err := s.VpcSettings.Validate(); err != nil {
+			invalidParams.AddNested("VpcSettings", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDescription sets the Description field's value.
+func (s *CreateMicrosoftADInput) SetDescription(v string) *CreateMicrosoftADInput {
+	s.Description = &v
+	return s
+}
+
+// SetEdition sets the Edition field's value.
+func (s *CreateMicrosoftADInput) SetEdition(v string) *CreateMicrosoftADInput {
+	s.Edition = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *CreateMicrosoftADInput) SetName(v string) *CreateMicrosoftADInput {
+	s.Name = &v
+	return s
+}
+
+// SetPassword sets the Password field's value.
+func (s *CreateMicrosoftADInput) SetPassword(v string) *CreateMicrosoftADInput {
+	s.Password = &v
+	return s
+}
+
+// SetShortName sets the ShortName field's value.
+func (s *CreateMicrosoftADInput) SetShortName(v string) *CreateMicrosoftADInput {
+	s.ShortName = &v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *CreateMicrosoftADInput) SetTags(v []*Tag) *CreateMicrosoftADInput {
+	s.Tags = v
+	return s
+}
+
+// SetVpcSettings sets the VpcSettings field's value.
+func (s *CreateMicrosoftADInput) SetVpcSettings(v *DirectoryVpcSettings) *CreateMicrosoftADInput {
+	s.VpcSettings = v
+	return s
+}
+
+// Result of a CreateMicrosoftAD request.
+type CreateMicrosoftADOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The identifier of the directory that was created.
+	DirectoryId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateMicrosoftADOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateMicrosoftADOutput) GoString() string {
+	return s.String()
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *CreateMicrosoftADOutput) SetDirectoryId(v string) *CreateMicrosoftADOutput {
+	s.DirectoryId = &v
+	return s
+}
+
+// Contains the inputs for the CreateSnapshot operation.
+type CreateSnapshotInput struct {
+	_ struct{} `type:"structure"`
+
+	// The identifier of the directory of which to take a snapshot.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
+
+	// The descriptive name to apply to the snapshot.
+	Name *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateSnapshotInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateSnapshotInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateSnapshotInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateSnapshotInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *CreateSnapshotInput) SetDirectoryId(v string) *CreateSnapshotInput {
+	s.DirectoryId = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *CreateSnapshotInput) SetName(v string) *CreateSnapshotInput {
+	s.Name = &v
+	return s
+}
+
+// Contains the results of the CreateSnapshot operation.
+type CreateSnapshotOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The identifier of the snapshot that was created.
+	SnapshotId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateSnapshotOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateSnapshotOutput) GoString() string {
+	return s.String()
+}
+
+// SetSnapshotId sets the SnapshotId field's value.
+func (s *CreateSnapshotOutput) SetSnapshotId(v string) *CreateSnapshotOutput {
+	s.SnapshotId = &v
+	return s
+}
+
+// Directory Service for Microsoft Active Directory allows you to configure
+// trust relationships. For example, you can establish a trust between your
+// Managed Microsoft AD directory, and your existing self-managed Microsoft
+// Active Directory. This would allow you to provide users and groups access
+// to resources in either domain, with a single set of credentials.
+//
+// This action initiates the creation of the Amazon Web Services side of a trust
+// relationship between an Managed Microsoft AD directory and an external domain.
+type CreateTrustInput struct {
+	_ struct{} `type:"structure"`
+
+	// The IP addresses of the remote DNS server associated with RemoteDomainName.
+	ConditionalForwarderIpAddrs []*string `type:"list"`
+
+	// The Directory ID of the Managed Microsoft AD directory for which to establish
+	// the trust relationship.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
+
+	// The Fully Qualified Domain Name (FQDN) of the external domain for which to
+	// create the trust relationship.
+	//
+	// RemoteDomainName is a required field
+	RemoteDomainName *string `type:"string" required:"true"`
+
+	// Optional parameter to enable selective authentication for the trust.
+	SelectiveAuth *string `type:"string" enum:"SelectiveAuth"`
+
+	// The direction of the trust relationship.
+	//
+	// TrustDirection is a required field
+	TrustDirection *string `type:"string" required:"true" enum:"TrustDirection"`
+
+	// The trust password. The must be the same password that was used when creating
+	// the trust relationship on the external domain.
+	//
+	// TrustPassword is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by CreateTrustInput's
+	// String and GoString methods.
+	//
+	// TrustPassword is a required field
+	TrustPassword *string `min:"1" type:"string" required:"true" sensitive:"true"`
+
+	// The trust relationship type. Forest is the default.
+	TrustType *string `type:"string" enum:"TrustType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTrustInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTrustInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateTrustInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateTrustInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+	if s.RemoteDomainName == nil {
+		invalidParams.Add(request.NewErrParamRequired("RemoteDomainName"))
+	}
+	if s.TrustDirection == nil {
+		invalidParams.Add(request.NewErrParamRequired("TrustDirection"))
+	}
+	if s.TrustPassword == nil {
+		invalidParams.Add(request.NewErrParamRequired("TrustPassword"))
+	}
+	if s.TrustPassword != nil && len(*s.TrustPassword) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("TrustPassword", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetConditionalForwarderIpAddrs sets the ConditionalForwarderIpAddrs field's value.
+func (s *CreateTrustInput) SetConditionalForwarderIpAddrs(v []*string) *CreateTrustInput {
+	s.ConditionalForwarderIpAddrs = v
+	return s
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *CreateTrustInput) SetDirectoryId(v string) *CreateTrustInput {
+	s.DirectoryId = &v
+	return s
+}
+
+// SetRemoteDomainName sets the RemoteDomainName field's value.
+func (s *CreateTrustInput) SetRemoteDomainName(v string) *CreateTrustInput {
+	s.RemoteDomainName = &v
+	return s
+}
+
+// SetSelectiveAuth sets the SelectiveAuth field's value.
+func (s *CreateTrustInput) SetSelectiveAuth(v string) *CreateTrustInput {
+	s.SelectiveAuth = &v
+	return s
+}
+
+// SetTrustDirection sets the TrustDirection field's value.
+func (s *CreateTrustInput) SetTrustDirection(v string) *CreateTrustInput {
+	s.TrustDirection = &v
+	return s
+}
+
+// SetTrustPassword sets the TrustPassword field's value.
+func (s *CreateTrustInput) SetTrustPassword(v string) *CreateTrustInput {
+	s.TrustPassword = &v
+	return s
+}
+
+// SetTrustType sets the TrustType field's value.
+func (s *CreateTrustInput) SetTrustType(v string) *CreateTrustInput {
+	s.TrustType = &v
+	return s
+}
+
+// The result of a CreateTrust request.
+type CreateTrustOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A unique identifier for the trust relationship that was created.
+	TrustId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTrustOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTrustOutput) GoString() string {
+	return s.String()
+}
+
+// SetTrustId sets the TrustId field's value.
+func (s *CreateTrustOutput) SetTrustId(v string) *CreateTrustOutput {
+	s.TrustId = &v
+	return s
+}
+
+// Deletes a conditional forwarder.
+type DeleteConditionalForwarderInput struct {
+	_ struct{} `type:"structure"`
+
+	// The directory ID for which you are deleting the conditional forwarder.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
+
+	// The fully qualified domain name (FQDN) of the remote domain with which you
+	// are deleting the conditional forwarder.
+	//
+	// RemoteDomainName is a required field
+	RemoteDomainName *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteConditionalForwarderInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteConditionalForwarderInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteConditionalForwarderInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteConditionalForwarderInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+	if s.RemoteDomainName == nil {
+		invalidParams.Add(request.NewErrParamRequired("RemoteDomainName"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *DeleteConditionalForwarderInput) SetDirectoryId(v string) *DeleteConditionalForwarderInput {
+	s.DirectoryId = &v
+	return s
+}
+
+// SetRemoteDomainName sets the RemoteDomainName field's value.
+func (s *DeleteConditionalForwarderInput) SetRemoteDomainName(v string) *DeleteConditionalForwarderInput {
+	s.RemoteDomainName = &v
+	return s
+}
+
+// The result of a DeleteConditionalForwarder request.
+type DeleteConditionalForwarderOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteConditionalForwarderOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteConditionalForwarderOutput) GoString() string {
+	return s.String()
+}
+
+// Contains the inputs for the DeleteDirectory operation.
+type DeleteDirectoryInput struct {
+	_ struct{} `type:"structure"`
+
+	// The identifier of the directory to delete.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDirectoryInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDirectoryInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteDirectoryInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteDirectoryInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *DeleteDirectoryInput) SetDirectoryId(v string) *DeleteDirectoryInput {
+	s.DirectoryId = &v
+	return s
+}
+
+// Contains the results of the DeleteDirectory operation.
+type DeleteDirectoryOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The directory identifier.
+	DirectoryId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDirectoryOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDirectoryOutput) GoString() string {
+	return s.String()
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *DeleteDirectoryOutput) SetDirectoryId(v string) *DeleteDirectoryOutput {
+	s.DirectoryId = &v
+	return s
+}
+
+type DeleteLogSubscriptionInput struct {
+	_ struct{} `type:"structure"`
+
+	// Identifier of the directory whose log subscription you want to delete.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteLogSubscriptionInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteLogSubscriptionInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteLogSubscriptionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteLogSubscriptionInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *DeleteLogSubscriptionInput) SetDirectoryId(v string) *DeleteLogSubscriptionInput {
+	s.DirectoryId = &v
+	return s
+}
+
+type DeleteLogSubscriptionOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteLogSubscriptionOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteLogSubscriptionOutput) GoString() string {
+	return s.String()
+}
+
+// Contains the inputs for the DeleteSnapshot operation.
+type DeleteSnapshotInput struct {
+	_ struct{} `type:"structure"`
+
+	// The identifier of the directory snapshot to be deleted.
+	//
+	// SnapshotId is a required field
+	SnapshotId *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteSnapshotInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteSnapshotInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteSnapshotInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteSnapshotInput"}
+	if s.SnapshotId == nil {
+		invalidParams.Add(request.NewErrParamRequired("SnapshotId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetSnapshotId sets the SnapshotId field's value.
+func (s *DeleteSnapshotInput) SetSnapshotId(v string) *DeleteSnapshotInput {
+	s.SnapshotId = &v
+	return s
+}
+
+// Contains the results of the DeleteSnapshot operation.
+type DeleteSnapshotOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The identifier of the directory snapshot that was deleted.
+	SnapshotId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteSnapshotOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteSnapshotOutput) GoString() string {
+	return s.String()
+}
+
+// SetSnapshotId sets the SnapshotId field's value.
+func (s *DeleteSnapshotOutput) SetSnapshotId(v string) *DeleteSnapshotOutput {
+	s.SnapshotId = &v
+	return s
+}
+
+// Deletes the local side of an existing trust relationship between the Managed
+// Microsoft AD directory and the external domain.
+type DeleteTrustInput struct {
+	_ struct{} `type:"structure"`
+
+	// Delete a conditional forwarder as part of a DeleteTrustRequest.
+	DeleteAssociatedConditionalForwarder *bool `type:"boolean"`
+
+	// The Trust ID of the trust relationship to be deleted.
+	//
+	// TrustId is a required field
+	TrustId *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteTrustInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteTrustInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteTrustInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteTrustInput"}
+	if s.TrustId == nil {
+		invalidParams.Add(request.NewErrParamRequired("TrustId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDeleteAssociatedConditionalForwarder sets the DeleteAssociatedConditionalForwarder field's value.
+func (s *DeleteTrustInput) SetDeleteAssociatedConditionalForwarder(v bool) *DeleteTrustInput {
+	s.DeleteAssociatedConditionalForwarder = &v
+	return s
+}
+
+// SetTrustId sets the TrustId field's value.
+func (s *DeleteTrustInput) SetTrustId(v string) *DeleteTrustInput {
+	s.TrustId = &v
+	return s
+}
+
+// The result of a DeleteTrust request.
+type DeleteTrustOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The Trust ID of the trust relationship that was deleted.
+	TrustId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteTrustOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteTrustOutput) GoString() string {
+	return s.String()
+}
+
+// SetTrustId sets the TrustId field's value.
+func (s *DeleteTrustOutput) SetTrustId(v string) *DeleteTrustOutput {
+	s.TrustId = &v
+	return s
+}
+
+type DeregisterCertificateInput struct {
+	_ struct{} `type:"structure"`
+
+	// The identifier of the certificate.
+	//
+	// CertificateId is a required field
+	CertificateId *string `type:"string" required:"true"`
+
+	// The identifier of the directory.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeregisterCertificateInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
 //
-// The context must be non-nil and will be used for request cancellation. If
-// the context is nil a panic will occur. In the future the SDK may create
-// sub-contexts for http.Requests. See https://golang.org/pkg/context/
-// for more information on using Contexts.
-func (c *DirectoryService) VerifyTrustWithContext(ctx aws.Context, input *VerifyTrustInput, opts ...request.Option) (*VerifyTrustOutput, error) {
-	req, out := c.VerifyTrustRequest(input)
-	req.SetContext(ctx)
-	req.ApplyOptions(opts...)
-	return out, req.Send()
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeregisterCertificateInput) GoString() string {
+	return s.String()
 }
 
-type AcceptSharedDirectoryInput struct {
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeregisterCertificateInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeregisterCertificateInput"}
+	if s.CertificateId == nil {
+		invalidParams.Add(request.NewErrParamRequired("CertificateId"))
+	}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCertificateId sets the CertificateId field's value.
+func (s *DeregisterCertificateInput) SetCertificateId(v string) *DeregisterCertificateInput {
+	s.CertificateId = &v
+	return s
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *DeregisterCertificateInput) SetDirectoryId(v string) *DeregisterCertificateInput {
+	s.DirectoryId = &v
+	return s
+}
+
+type DeregisterCertificateOutput struct {
 	_ struct{} `type:"structure"`
+}
 
-	// Identifier of the shared directory in the directory consumer account. This
-	// identifier is different for each directory owner account.
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeregisterCertificateOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeregisterCertificateOutput) GoString() string {
+	return s.String()
+}
+
+// Removes the specified directory as a publisher to the specified Amazon SNS
+// topic.
+type DeregisterEventTopicInput struct {
+	_ struct{} `type:"structure"`
+
+	// The Directory ID to remove as a publisher. This directory will no longer
+	// send messages to the specified Amazon SNS topic.
 	//
-	// SharedDirectoryId is a required field
-	SharedDirectoryId *string `type:"string" required:"true"`
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
+
+	// The name of the Amazon SNS topic from which to remove the directory as a
+	// publisher.
+	//
+	// TopicName is a required field
+	TopicName *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s AcceptSharedDirectoryInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeregisterEventTopicInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s AcceptSharedDirectoryInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeregisterEventTopicInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *AcceptSharedDirectoryInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "AcceptSharedDirectoryInput"}
-	if s.SharedDirectoryId == nil {
-		invalidParams.Add(request.NewErrParamRequired("SharedDirectoryId"))
+func (s *DeregisterEventTopicInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeregisterEventTopicInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+	if s.TopicName == nil {
+		invalidParams.Add(request.NewErrParamRequired("TopicName"))
+	}
+	if s.TopicName != nil && len(*s.TopicName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("TopicName", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -4822,117 +10415,298 @@ func (s *AcceptSharedDirectoryInput) Validate() error {
 	return nil
 }
 
-// SetSharedDirectoryId sets the SharedDirectoryId field's value.
-func (s *AcceptSharedDirectoryInput) SetSharedDirectoryId(v string) *AcceptSharedDirectoryInput {
-	s.SharedDirectoryId = &v
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *DeregisterEventTopicInput) SetDirectoryId(v string) *DeregisterEventTopicInput {
+	s.DirectoryId = &v
 	return s
 }
 
-type AcceptSharedDirectoryOutput struct {
+// SetTopicName sets the TopicName field's value.
+func (s *DeregisterEventTopicInput) SetTopicName(v string) *DeregisterEventTopicInput {
+	s.TopicName = &v
+	return s
+}
+
+// The result of a DeregisterEventTopic request.
+type DeregisterEventTopicOutput struct {
 	_ struct{} `type:"structure"`
+}
 
-	// The shared directory in the directory consumer account.
-	SharedDirectory *SharedDirectory `type:"structure"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeregisterEventTopicOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// String returns the string representation
-func (s AcceptSharedDirectoryOutput) String() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeregisterEventTopicOutput) GoString() string {
+	return s.String()
+}
+
+type DescribeCertificateInput struct {
+	_ struct{} `type:"structure"`
+
+	// The identifier of the certificate.
+	//
+	// CertificateId is a required field
+	CertificateId *string `type:"string" required:"true"`
+
+	// The identifier of the directory.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeCertificateInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s AcceptSharedDirectoryOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeCertificateInput) GoString() string {
 	return s.String()
 }
 
-// SetSharedDirectory sets the SharedDirectory field's value.
-func (s *AcceptSharedDirectoryOutput) SetSharedDirectory(v *SharedDirectory) *AcceptSharedDirectoryOutput {
-	s.SharedDirectory = v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DescribeCertificateInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeCertificateInput"}
+	if s.CertificateId == nil {
+		invalidParams.Add(request.NewErrParamRequired("CertificateId"))
+	}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCertificateId sets the CertificateId field's value.
+func (s *DescribeCertificateInput) SetCertificateId(v string) *DescribeCertificateInput {
+	s.CertificateId = &v
 	return s
 }
 
-type AddIpRoutesInput struct {
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *DescribeCertificateInput) SetDirectoryId(v string) *DescribeCertificateInput {
+	s.DirectoryId = &v
+	return s
+}
+
+type DescribeCertificateOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Identifier (ID) of the directory to which to add the address block.
+	// Information about the certificate, including registered date time, certificate
+	// state, the reason for the state, expiration date time, and certificate common
+	// name.
+	Certificate *Certificate `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeCertificateOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeCertificateOutput) GoString() string {
+	return s.String()
+}
+
+// SetCertificate sets the Certificate field's value.
+func (s *DescribeCertificateOutput) SetCertificate(v *Certificate) *DescribeCertificateOutput {
+	s.Certificate = v
+	return s
+}
+
+type DescribeClientAuthenticationSettingsInput struct {
+	_ struct{} `type:"structure"`
+
+	// The identifier of the directory for which to retrieve information.
 	//
 	// DirectoryId is a required field
 	DirectoryId *string `type:"string" required:"true"`
 
-	// IP address blocks, using CIDR format, of the traffic to route. This is often
-	// the IP address block of the DNS server used for your on-premises domain.
-	//
-	// IpRoutes is a required field
-	IpRoutes []*IpRoute `type:"list" required:"true"`
+	// The maximum number of items to return. If this value is zero, the maximum
+	// number of items is specified by the limitations of the operation.
+	Limit *int64 `min:"1" type:"integer"`
 
-	// If set to true, updates the inbound and outbound rules of the security group
-	// that has the description: "AWS created security group for directory ID directory
-	// controllers." Following are the new rules:
-	//
-	// Inbound:
-	//
-	//    * Type: Custom UDP Rule, Protocol: UDP, Range: 88, Source: 0.0.0.0/0
-	//
-	//    * Type: Custom UDP Rule, Protocol: UDP, Range: 123, Source: 0.0.0.0/0
-	//
-	//    * Type: Custom UDP Rule, Protocol: UDP, Range: 138, Source: 0.0.0.0/0
-	//
-	//    * Type: Custom UDP Rule, Protocol: UDP, Range: 389, Source: 0.0.0.0/0
-	//
-	//    * Type: Custom UDP Rule, Protocol: UDP, Range: 464, Source: 0.0.0.0/0
-	//
-	//    * Type: Custom UDP Rule, Protocol: UDP, Range: 445, Source: 0.0.0.0/0
-	//
-	//    * Type: Custom TCP Rule, Protocol: TCP, Range: 88, Source: 0.0.0.0/0
-	//
-	//    * Type: Custom TCP Rule, Protocol: TCP, Range: 135, Source: 0.0.0.0/0
-	//
-	//    * Type: Custom TCP Rule, Protocol: TCP, Range: 445, Source: 0.0.0.0/0
-	//
-	//    * Type: Custom TCP Rule, Protocol: TCP, Range: 464, Source: 0.0.0.0/0
-	//
-	//    * Type: Custom TCP Rule, Protocol: TCP, Range: 636, Source: 0.0.0.0/0
-	//
-	//    * Type: Custom TCP Rule, Protocol: TCP, Range: 1024-65535, Source: 0.0.0.0/0
-	//
-	//    * Type: Custom TCP Rule, Protocol: TCP, Range: 3268-33269, Source: 0.0.0.0/0
-	//
-	//    * Type: DNS (UDP), Protocol: UDP, Range: 53, Source: 0.0.0.0/0
-	//
-	//    * Type: DNS (TCP), Protocol: TCP, Range: 53, Source: 0.0.0.0/0
-	//
-	//    * Type: LDAP, Protocol: TCP, Range: 389, Source: 0.0.0.0/0
-	//
-	//    * Type: All ICMP, Protocol: All, Range: N/A, Source: 0.0.0.0/0
-	//
-	// Outbound:
-	//
-	//    * Type: All traffic, Protocol: All, Range: All, Destination: 0.0.0.0/0
+	// The DescribeClientAuthenticationSettingsResult.NextToken value from a previous
+	// call to DescribeClientAuthenticationSettings. Pass null if this is the first
+	// call.
+	NextToken *string `type:"string"`
+
+	// The type of client authentication for which to retrieve information. If no
+	// type is specified, a list of all client authentication types that are supported
+	// for the specified directory is retrieved.
+	Type *string `type:"string" enum:"ClientAuthenticationType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeClientAuthenticationSettingsInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeClientAuthenticationSettingsInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DescribeClientAuthenticationSettingsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeClientAuthenticationSettingsInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+	if s.Limit != nil && *s.Limit < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *DescribeClientAuthenticationSettingsInput) SetDirectoryId(v string) *DescribeClientAuthenticationSettingsInput {
+	s.DirectoryId = &v
+	return s
+}
+
+// SetLimit sets the Limit field's value.
+func (s *DescribeClientAuthenticationSettingsInput) SetLimit(v int64) *DescribeClientAuthenticationSettingsInput {
+	s.Limit = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeClientAuthenticationSettingsInput) SetNextToken(v string) *DescribeClientAuthenticationSettingsInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *DescribeClientAuthenticationSettingsInput) SetType(v string) *DescribeClientAuthenticationSettingsInput {
+	s.Type = &v
+	return s
+}
+
+type DescribeClientAuthenticationSettingsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Information about the type of client authentication for the specified directory.
+	// The following information is retrieved: The date and time when the status
+	// of the client authentication type was last updated, whether the client authentication
+	// type is enabled or disabled, and the type of client authentication.
+	ClientAuthenticationSettingsInfo []*ClientAuthenticationSettingInfo `type:"list"`
+
+	// The next token used to retrieve the client authentication settings if the
+	// number of setting types exceeds page limit and there is another page.
+	NextToken *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeClientAuthenticationSettingsOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeClientAuthenticationSettingsOutput) GoString() string {
+	return s.String()
+}
+
+// SetClientAuthenticationSettingsInfo sets the ClientAuthenticationSettingsInfo field's value.
+func (s *DescribeClientAuthenticationSettingsOutput) SetClientAuthenticationSettingsInfo(v []*ClientAuthenticationSettingInfo) *DescribeClientAuthenticationSettingsOutput {
+	s.ClientAuthenticationSettingsInfo = v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeClientAuthenticationSettingsOutput) SetNextToken(v string) *DescribeClientAuthenticationSettingsOutput {
+	s.NextToken = &v
+	return s
+}
+
+// Describes a conditional forwarder.
+type DescribeConditionalForwardersInput struct {
+	_ struct{} `type:"structure"`
+
+	// The directory ID for which to get the list of associated conditional forwarders.
 	//
-	// These security rules impact an internal network interface that is not exposed
-	// publicly.
-	UpdateSecurityGroupForDirectoryControllers *bool `type:"boolean"`
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
+
+	// The fully qualified domain names (FQDN) of the remote domains for which to
+	// get the list of associated conditional forwarders. If this member is null,
+	// all conditional forwarders are returned.
+	RemoteDomainNames []*string `type:"list"`
 }
 
-// String returns the string representation
-func (s AddIpRoutesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeConditionalForwardersInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s AddIpRoutesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeConditionalForwardersInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *AddIpRoutesInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "AddIpRoutesInput"}
+func (s *DescribeConditionalForwardersInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeConditionalForwardersInput"}
 	if s.DirectoryId == nil {
 		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
 	}
-	if s.IpRoutes == nil {
-		invalidParams.Add(request.NewErrParamRequired("IpRoutes"))
-	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -4941,139 +10715,196 @@ func (s *AddIpRoutesInput) Validate() error {
 }
 
 // SetDirectoryId sets the DirectoryId field's value.
-func (s *AddIpRoutesInput) SetDirectoryId(v string) *AddIpRoutesInput {
+func (s *DescribeConditionalForwardersInput) SetDirectoryId(v string) *DescribeConditionalForwardersInput {
 	s.DirectoryId = &v
 	return s
 }
 
-// SetIpRoutes sets the IpRoutes field's value.
-func (s *AddIpRoutesInput) SetIpRoutes(v []*IpRoute) *AddIpRoutesInput {
-	s.IpRoutes = v
-	return s
-}
-
-// SetUpdateSecurityGroupForDirectoryControllers sets the UpdateSecurityGroupForDirectoryControllers field's value.
-func (s *AddIpRoutesInput) SetUpdateSecurityGroupForDirectoryControllers(v bool) *AddIpRoutesInput {
-	s.UpdateSecurityGroupForDirectoryControllers = &v
+// SetRemoteDomainNames sets the RemoteDomainNames field's value.
+func (s *DescribeConditionalForwardersInput) SetRemoteDomainNames(v []*string) *DescribeConditionalForwardersInput {
+	s.RemoteDomainNames = v
 	return s
 }
 
-type AddIpRoutesOutput struct {
+// The result of a DescribeConditionalForwarder request.
+type DescribeConditionalForwardersOutput struct {
 	_ struct{} `type:"structure"`
+
+	// The list of conditional forwarders that have been created.
+	ConditionalForwarders []*ConditionalForwarder `type:"list"`
 }
 
-// String returns the string representation
-func (s AddIpRoutesOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeConditionalForwardersOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s AddIpRoutesOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeConditionalForwardersOutput) GoString() string {
 	return s.String()
 }
 
-type AddTagsToResourceInput struct {
+// SetConditionalForwarders sets the ConditionalForwarders field's value.
+func (s *DescribeConditionalForwardersOutput) SetConditionalForwarders(v []*ConditionalForwarder) *DescribeConditionalForwardersOutput {
+	s.ConditionalForwarders = v
+	return s
+}
+
+// Contains the inputs for the DescribeDirectories operation.
+type DescribeDirectoriesInput struct {
 	_ struct{} `type:"structure"`
 
-	// Identifier (ID) for the directory to which to add the tag.
+	// A list of identifiers of the directories for which to obtain the information.
+	// If this member is null, all directories that belong to the current account
+	// are returned.
 	//
-	// ResourceId is a required field
-	ResourceId *string `type:"string" required:"true"`
+	// An empty list results in an InvalidParameterException being thrown.
+	DirectoryIds []*string `type:"list"`
 
-	// The tags to be assigned to the directory.
-	//
-	// Tags is a required field
-	Tags []*Tag `type:"list" required:"true"`
+	// The maximum number of items to return. If this value is zero, the maximum
+	// number of items is specified by the limitations of the operation.
+	Limit *int64 `type:"integer"`
+
+	// The DescribeDirectoriesResult.NextToken value from a previous call to DescribeDirectories.
+	// Pass null if this is the first call.
+	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
-func (s AddTagsToResourceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDirectoriesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s AddTagsToResourceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDirectoriesInput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *AddTagsToResourceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "AddTagsToResourceInput"}
-	if s.ResourceId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ResourceId"))
-	}
-	if s.Tags == nil {
-		invalidParams.Add(request.NewErrParamRequired("Tags"))
-	}
-	if s.Tags != nil {
-		for i, v := range s.Tags {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
-			}
-		}
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetDirectoryIds sets the DirectoryIds field's value.
+func (s *DescribeDirectoriesInput) SetDirectoryIds(v []*string) *DescribeDirectoriesInput {
+	s.DirectoryIds = v
+	return s
 }
 
-// SetResourceId sets the ResourceId field's value.
-func (s *AddTagsToResourceInput) SetResourceId(v string) *AddTagsToResourceInput {
-	s.ResourceId = &v
+// SetLimit sets the Limit field's value.
+func (s *DescribeDirectoriesInput) SetLimit(v int64) *DescribeDirectoriesInput {
+	s.Limit = &v
 	return s
 }
 
-// SetTags sets the Tags field's value.
-func (s *AddTagsToResourceInput) SetTags(v []*Tag) *AddTagsToResourceInput {
-	s.Tags = v
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeDirectoriesInput) SetNextToken(v string) *DescribeDirectoriesInput {
+	s.NextToken = &v
 	return s
 }
 
-type AddTagsToResourceOutput struct {
+// Contains the results of the DescribeDirectories operation.
+type DescribeDirectoriesOutput struct {
 	_ struct{} `type:"structure"`
+
+	// The list of DirectoryDescription objects that were retrieved.
+	//
+	// It is possible that this list contains less than the number of items specified
+	// in the Limit member of the request. This occurs if there are less than the
+	// requested number of items left to retrieve, or if the limitations of the
+	// operation have been exceeded.
+	DirectoryDescriptions []*DirectoryDescription `type:"list"`
+
+	// If not null, more results are available. Pass this value for the NextToken
+	// parameter in a subsequent call to DescribeDirectories to retrieve the next
+	// set of items.
+	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
-func (s AddTagsToResourceOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDirectoriesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s AddTagsToResourceOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDirectoriesOutput) GoString() string {
 	return s.String()
 }
 
-// Represents a named directory attribute.
-type Attribute struct {
+// SetDirectoryDescriptions sets the DirectoryDescriptions field's value.
+func (s *DescribeDirectoriesOutput) SetDirectoryDescriptions(v []*DirectoryDescription) *DescribeDirectoriesOutput {
+	s.DirectoryDescriptions = v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeDirectoriesOutput) SetNextToken(v string) *DescribeDirectoriesOutput {
+	s.NextToken = &v
+	return s
+}
+
+type DescribeDomainControllersInput struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the attribute.
-	Name *string `min:"1" type:"string"`
+	// Identifier of the directory for which to retrieve the domain controller information.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
 
-	// The value of the attribute.
-	Value *string `type:"string"`
+	// A list of identifiers for the domain controllers whose information will be
+	// provided.
+	DomainControllerIds []*string `type:"list"`
+
+	// The maximum number of items to return.
+	Limit *int64 `type:"integer"`
+
+	// The DescribeDomainControllers.NextToken value from a previous call to DescribeDomainControllers.
+	// Pass null if this is the first call.
+	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
-func (s Attribute) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDomainControllersInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Attribute) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDomainControllersInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *Attribute) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "Attribute"}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+func (s *DescribeDomainControllersInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeDomainControllersInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5082,253 +10913,311 @@ func (s *Attribute) Validate() error {
 	return nil
 }
 
-// SetName sets the Name field's value.
-func (s *Attribute) SetName(v string) *Attribute {
-	s.Name = &v
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *DescribeDomainControllersInput) SetDirectoryId(v string) *DescribeDomainControllersInput {
+	s.DirectoryId = &v
+	return s
+}
+
+// SetDomainControllerIds sets the DomainControllerIds field's value.
+func (s *DescribeDomainControllersInput) SetDomainControllerIds(v []*string) *DescribeDomainControllersInput {
+	s.DomainControllerIds = v
+	return s
+}
+
+// SetLimit sets the Limit field's value.
+func (s *DescribeDomainControllersInput) SetLimit(v int64) *DescribeDomainControllersInput {
+	s.Limit = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeDomainControllersInput) SetNextToken(v string) *DescribeDomainControllersInput {
+	s.NextToken = &v
+	return s
+}
+
+type DescribeDomainControllersOutput struct {
+	_ struct{} `type:"structure"`
+
+	// List of the DomainController objects that were retrieved.
+	DomainControllers []*DomainController `type:"list"`
+
+	// If not null, more results are available. Pass this value for the NextToken
+	// parameter in a subsequent call to DescribeDomainControllers retrieve the
+	// next set of items.
+	NextToken *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDomainControllersOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDomainControllersOutput) GoString() string {
+	return s.String()
+}
+
+// SetDomainControllers sets the DomainControllers field's value.
+func (s *DescribeDomainControllersOutput) SetDomainControllers(v []*DomainController) *DescribeDomainControllersOutput {
+	s.DomainControllers = v
 	return s
 }
 
-// SetValue sets the Value field's value.
-func (s *Attribute) SetValue(v string) *Attribute {
-	s.Value = &v
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeDomainControllersOutput) SetNextToken(v string) *DescribeDomainControllersOutput {
+	s.NextToken = &v
 	return s
 }
 
-type CancelSchemaExtensionInput struct {
+// Describes event topics.
+type DescribeEventTopicsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The identifier of the directory whose schema extension will be canceled.
-	//
-	// DirectoryId is a required field
-	DirectoryId *string `type:"string" required:"true"`
+	// The Directory ID for which to get the list of associated Amazon SNS topics.
+	// If this member is null, associations for all Directory IDs are returned.
+	DirectoryId *string `type:"string"`
 
-	// The identifier of the schema extension that will be canceled.
+	// A list of Amazon SNS topic names for which to obtain the information. If
+	// this member is null, all associations for the specified Directory ID are
+	// returned.
 	//
-	// SchemaExtensionId is a required field
-	SchemaExtensionId *string `type:"string" required:"true"`
+	// An empty list results in an InvalidParameterException being thrown.
+	TopicNames []*string `type:"list"`
 }
 
-// String returns the string representation
-func (s CancelSchemaExtensionInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeEventTopicsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CancelSchemaExtensionInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeEventTopicsInput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *CancelSchemaExtensionInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CancelSchemaExtensionInput"}
-	if s.DirectoryId == nil {
-		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
-	}
-	if s.SchemaExtensionId == nil {
-		invalidParams.Add(request.NewErrParamRequired("SchemaExtensionId"))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
 // SetDirectoryId sets the DirectoryId field's value.
-func (s *CancelSchemaExtensionInput) SetDirectoryId(v string) *CancelSchemaExtensionInput {
+func (s *DescribeEventTopicsInput) SetDirectoryId(v string) *DescribeEventTopicsInput {
 	s.DirectoryId = &v
 	return s
 }
 
-// SetSchemaExtensionId sets the SchemaExtensionId field's value.
-func (s *CancelSchemaExtensionInput) SetSchemaExtensionId(v string) *CancelSchemaExtensionInput {
-	s.SchemaExtensionId = &v
+// SetTopicNames sets the TopicNames field's value.
+func (s *DescribeEventTopicsInput) SetTopicNames(v []*string) *DescribeEventTopicsInput {
+	s.TopicNames = v
 	return s
 }
 
-type CancelSchemaExtensionOutput struct {
+// The result of a DescribeEventTopic request.
+type DescribeEventTopicsOutput struct {
 	_ struct{} `type:"structure"`
+
+	// A list of Amazon SNS topic names that receive status messages from the specified
+	// Directory ID.
+	EventTopics []*EventTopic `type:"list"`
 }
 
-// String returns the string representation
-func (s CancelSchemaExtensionOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeEventTopicsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CancelSchemaExtensionOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeEventTopicsOutput) GoString() string {
 	return s.String()
 }
 
-// Contains information about a computer account in a directory.
-type Computer struct {
+// SetEventTopics sets the EventTopics field's value.
+func (s *DescribeEventTopicsOutput) SetEventTopics(v []*EventTopic) *DescribeEventTopicsOutput {
+	s.EventTopics = v
+	return s
+}
+
+type DescribeLDAPSSettingsInput struct {
 	_ struct{} `type:"structure"`
 
-	// An array of Attribute objects containing the LDAP attributes that belong
-	// to the computer account.
-	ComputerAttributes []*Attribute `type:"list"`
+	// The identifier of the directory.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
 
-	// The identifier of the computer.
-	ComputerId *string `min:"1" type:"string"`
+	// Specifies the number of items that should be displayed on one page.
+	Limit *int64 `min:"1" type:"integer"`
 
-	// The computer name.
-	ComputerName *string `min:"1" type:"string"`
+	// The type of next token used for pagination.
+	NextToken *string `type:"string"`
+
+	// The type of LDAP security to enable. Currently only the value Client is supported.
+	Type *string `type:"string" enum:"LDAPSType"`
 }
 
-// String returns the string representation
-func (s Computer) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeLDAPSSettingsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Computer) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeLDAPSSettingsInput) GoString() string {
 	return s.String()
 }
 
-// SetComputerAttributes sets the ComputerAttributes field's value.
-func (s *Computer) SetComputerAttributes(v []*Attribute) *Computer {
-	s.ComputerAttributes = v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DescribeLDAPSSettingsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeLDAPSSettingsInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+	if s.Limit != nil && *s.Limit < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *DescribeLDAPSSettingsInput) SetDirectoryId(v string) *DescribeLDAPSSettingsInput {
+	s.DirectoryId = &v
 	return s
 }
 
-// SetComputerId sets the ComputerId field's value.
-func (s *Computer) SetComputerId(v string) *Computer {
-	s.ComputerId = &v
+// SetLimit sets the Limit field's value.
+func (s *DescribeLDAPSSettingsInput) SetLimit(v int64) *DescribeLDAPSSettingsInput {
+	s.Limit = &v
 	return s
 }
 
-// SetComputerName sets the ComputerName field's value.
-func (s *Computer) SetComputerName(v string) *Computer {
-	s.ComputerName = &v
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeLDAPSSettingsInput) SetNextToken(v string) *DescribeLDAPSSettingsInput {
+	s.NextToken = &v
 	return s
 }
 
-// Points to a remote domain with which you are setting up a trust relationship.
-// Conditional forwarders are required in order to set up a trust relationship
-// with another domain.
-type ConditionalForwarder struct {
-	_ struct{} `type:"structure"`
+// SetType sets the Type field's value.
+func (s *DescribeLDAPSSettingsInput) SetType(v string) *DescribeLDAPSSettingsInput {
+	s.Type = &v
+	return s
+}
 
-	// The IP addresses of the remote DNS server associated with RemoteDomainName.
-	// This is the IP address of the DNS server that your conditional forwarder
-	// points to.
-	DnsIpAddrs []*string `type:"list"`
+type DescribeLDAPSSettingsOutput struct {
+	_ struct{} `type:"structure"`
 
-	// The fully qualified domain name (FQDN) of the remote domains pointed to by
-	// the conditional forwarder.
-	RemoteDomainName *string `type:"string"`
+	// Information about LDAP security for the specified directory, including status
+	// of enablement, state last updated date time, and the reason for the state.
+	LDAPSSettingsInfo []*LDAPSSettingInfo `type:"list"`
 
-	// The replication scope of the conditional forwarder. The only allowed value
-	// is Domain, which will replicate the conditional forwarder to all of the domain
-	// controllers for your AWS directory.
-	ReplicationScope *string `type:"string" enum:"ReplicationScope"`
+	// The next token used to retrieve the LDAPS settings if the number of setting
+	// types exceeds page limit and there is another page.
+	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
-func (s ConditionalForwarder) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeLDAPSSettingsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ConditionalForwarder) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeLDAPSSettingsOutput) GoString() string {
 	return s.String()
 }
 
-// SetDnsIpAddrs sets the DnsIpAddrs field's value.
-func (s *ConditionalForwarder) SetDnsIpAddrs(v []*string) *ConditionalForwarder {
-	s.DnsIpAddrs = v
-	return s
-}
-
-// SetRemoteDomainName sets the RemoteDomainName field's value.
-func (s *ConditionalForwarder) SetRemoteDomainName(v string) *ConditionalForwarder {
-	s.RemoteDomainName = &v
+// SetLDAPSSettingsInfo sets the LDAPSSettingsInfo field's value.
+func (s *DescribeLDAPSSettingsOutput) SetLDAPSSettingsInfo(v []*LDAPSSettingInfo) *DescribeLDAPSSettingsOutput {
+	s.LDAPSSettingsInfo = v
 	return s
 }
 
-// SetReplicationScope sets the ReplicationScope field's value.
-func (s *ConditionalForwarder) SetReplicationScope(v string) *ConditionalForwarder {
-	s.ReplicationScope = &v
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeLDAPSSettingsOutput) SetNextToken(v string) *DescribeLDAPSSettingsOutput {
+	s.NextToken = &v
 	return s
 }
 
-// Contains the inputs for the ConnectDirectory operation.
-type ConnectDirectoryInput struct {
+type DescribeRegionsInput struct {
 	_ struct{} `type:"structure"`
 
-	// A DirectoryConnectSettings object that contains additional information for
-	// the operation.
-	//
-	// ConnectSettings is a required field
-	ConnectSettings *DirectoryConnectSettings `type:"structure" required:"true"`
-
-	// A textual description for the directory.
-	Description *string `type:"string"`
-
-	// The fully qualified name of the on-premises directory, such as corp.example.com.
-	//
-	// Name is a required field
-	Name *string `type:"string" required:"true"`
-
-	// The password for the on-premises user account.
+	// The identifier of the directory.
 	//
-	// Password is a required field
-	Password *string `min:"1" type:"string" required:"true" sensitive:"true"`
-
-	// The NetBIOS name of the on-premises directory, such as CORP.
-	ShortName *string `type:"string"`
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
 
-	// The size of the directory.
-	//
-	// Size is a required field
-	Size *string `type:"string" required:"true" enum:"DirectorySize"`
+	// The DescribeRegionsResult.NextToken value from a previous call to DescribeRegions.
+	// Pass null if this is the first call.
+	NextToken *string `type:"string"`
 
-	// The tags to be assigned to AD Connector.
-	Tags []*Tag `type:"list"`
+	// The name of the Region. For example, us-east-1.
+	RegionName *string `min:"8" type:"string"`
 }
 
-// String returns the string representation
-func (s ConnectDirectoryInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeRegionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ConnectDirectoryInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeRegionsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ConnectDirectoryInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ConnectDirectoryInput"}
-	if s.ConnectSettings == nil {
-		invalidParams.Add(request.NewErrParamRequired("ConnectSettings"))
-	}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
-	}
-	if s.Password == nil {
-		invalidParams.Add(request.NewErrParamRequired("Password"))
-	}
-	if s.Password != nil && len(*s.Password) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Password", 1))
-	}
-	if s.Size == nil {
-		invalidParams.Add(request.NewErrParamRequired("Size"))
-	}
-	if s.ConnectSettings != nil {
-		if err := s.ConnectSettings.Validate(); err != nil {
-			invalidParams.AddNested("ConnectSettings", err.(request.ErrInvalidParams))
-		}
+func (s *DescribeRegionsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeRegionsInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
 	}
-	if s.Tags != nil {
-		for i, v := range s.Tags {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
-			}
-		}
+	if s.RegionName != nil && len(*s.RegionName) < 8 {
+		invalidParams.Add(request.NewErrParamMinLen("RegionName", 8))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5337,109 +11226,103 @@ func (s *ConnectDirectoryInput) Validate() error {
 	return nil
 }
 
-// SetConnectSettings sets the ConnectSettings field's value.
-func (s *ConnectDirectoryInput) SetConnectSettings(v *DirectoryConnectSettings) *ConnectDirectoryInput {
-	s.ConnectSettings = v
-	return s
-}
-
-// SetDescription sets the Description field's value.
-func (s *ConnectDirectoryInput) SetDescription(v string) *ConnectDirectoryInput {
-	s.Description = &v
-	return s
-}
-
-// SetName sets the Name field's value.
-func (s *ConnectDirectoryInput) SetName(v string) *ConnectDirectoryInput {
-	s.Name = &v
-	return s
-}
-
-// SetPassword sets the Password field's value.
-func (s *ConnectDirectoryInput) SetPassword(v string) *ConnectDirectoryInput {
-	s.Password = &v
-	return s
-}
-
-// SetShortName sets the ShortName field's value.
-func (s *ConnectDirectoryInput) SetShortName(v string) *ConnectDirectoryInput {
-	s.ShortName = &v
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *DescribeRegionsInput) SetDirectoryId(v string) *DescribeRegionsInput {
+	s.DirectoryId = &v
 	return s
 }
 
-// SetSize sets the Size field's value.
-func (s *ConnectDirectoryInput) SetSize(v string) *ConnectDirectoryInput {
-	s.Size = &v
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeRegionsInput) SetNextToken(v string) *DescribeRegionsInput {
+	s.NextToken = &v
 	return s
 }
 
-// SetTags sets the Tags field's value.
-func (s *ConnectDirectoryInput) SetTags(v []*Tag) *ConnectDirectoryInput {
-	s.Tags = v
+// SetRegionName sets the RegionName field's value.
+func (s *DescribeRegionsInput) SetRegionName(v string) *DescribeRegionsInput {
+	s.RegionName = &v
 	return s
 }
 
-// Contains the results of the ConnectDirectory operation.
-type ConnectDirectoryOutput struct {
+type DescribeRegionsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The identifier of the new directory.
-	DirectoryId *string `type:"string"`
+	// If not null, more results are available. Pass this value for the NextToken
+	// parameter in a subsequent call to DescribeRegions to retrieve the next set
+	// of items.
+	NextToken *string `type:"string"`
+
+	// List of Region information related to the directory for each replicated Region.
+	RegionsDescription []*RegionDescription `type:"list"`
 }
 
-// String returns the string representation
-func (s ConnectDirectoryOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeRegionsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ConnectDirectoryOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeRegionsOutput) GoString() string {
 	return s.String()
 }
 
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *ConnectDirectoryOutput) SetDirectoryId(v string) *ConnectDirectoryOutput {
-	s.DirectoryId = &v
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeRegionsOutput) SetNextToken(v string) *DescribeRegionsOutput {
+	s.NextToken = &v
 	return s
 }
 
-// Contains the inputs for the CreateAlias operation.
-type CreateAliasInput struct {
-	_ struct{} `type:"structure"`
+// SetRegionsDescription sets the RegionsDescription field's value.
+func (s *DescribeRegionsOutput) SetRegionsDescription(v []*RegionDescription) *DescribeRegionsOutput {
+	s.RegionsDescription = v
+	return s
+}
 
-	// The requested alias.
-	//
-	// The alias must be unique amongst all aliases in AWS. This operation throws
-	// an EntityAlreadyExistsException error if the alias already exists.
-	//
-	// Alias is a required field
-	Alias *string `min:"1" type:"string" required:"true"`
+type DescribeSettingsInput struct {
+	_ struct{} `type:"structure"`
 
-	// The identifier of the directory for which to create the alias.
+	// The identifier of the directory for which to retrieve information.
 	//
 	// DirectoryId is a required field
 	DirectoryId *string `type:"string" required:"true"`
+
+	// The DescribeSettingsResult.NextToken value from a previous call to DescribeSettings.
+	// Pass null if this is the first call.
+	NextToken *string `type:"string"`
+
+	// The status of the directory settings for which to retrieve information.
+	Status *string `type:"string" enum:"DirectoryConfigurationStatus"`
 }
 
-// String returns the string representation
-func (s CreateAliasInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeSettingsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateAliasInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeSettingsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateAliasInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateAliasInput"}
-	if s.Alias == nil {
-		invalidParams.Add(request.NewErrParamRequired("Alias"))
-	}
-	if s.Alias != nil && len(*s.Alias) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Alias", 1))
-	}
+func (s *DescribeSettingsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeSettingsInput"}
 	if s.DirectoryId == nil {
 		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
 	}
@@ -5450,120 +11333,122 @@ func (s *CreateAliasInput) Validate() error {
 	return nil
 }
 
-// SetAlias sets the Alias field's value.
-func (s *CreateAliasInput) SetAlias(v string) *CreateAliasInput {
-	s.Alias = &v
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *DescribeSettingsInput) SetDirectoryId(v string) *DescribeSettingsInput {
+	s.DirectoryId = &v
 	return s
 }
 
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *CreateAliasInput) SetDirectoryId(v string) *CreateAliasInput {
-	s.DirectoryId = &v
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeSettingsInput) SetNextToken(v string) *DescribeSettingsInput {
+	s.NextToken = &v
 	return s
 }
 
-// Contains the results of the CreateAlias operation.
-type CreateAliasOutput struct {
-	_ struct{} `type:"structure"`
+// SetStatus sets the Status field's value.
+func (s *DescribeSettingsInput) SetStatus(v string) *DescribeSettingsInput {
+	s.Status = &v
+	return s
+}
 
-	// The alias for the directory.
-	Alias *string `min:"1" type:"string"`
+type DescribeSettingsOutput struct {
+	_ struct{} `type:"structure"`
 
 	// The identifier of the directory.
 	DirectoryId *string `type:"string"`
+
+	// If not null, token that indicates that more results are available. Pass this
+	// value for the NextToken parameter in a subsequent call to DescribeSettings
+	// to retrieve the next set of items.
+	NextToken *string `type:"string"`
+
+	// The list of SettingEntry objects that were retrieved.
+	//
+	// It is possible that this list contains less than the number of items specified
+	// in the Limit member of the request. This occurs if there are less than the
+	// requested number of items left to retrieve, or if the limitations of the
+	// operation have been exceeded.
+	SettingEntries []*SettingEntry `type:"list"`
 }
 
-// String returns the string representation
-func (s CreateAliasOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeSettingsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateAliasOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeSettingsOutput) GoString() string {
 	return s.String()
 }
 
-// SetAlias sets the Alias field's value.
-func (s *CreateAliasOutput) SetAlias(v string) *CreateAliasOutput {
-	s.Alias = &v
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *DescribeSettingsOutput) SetDirectoryId(v string) *DescribeSettingsOutput {
+	s.DirectoryId = &v
 	return s
 }
 
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *CreateAliasOutput) SetDirectoryId(v string) *CreateAliasOutput {
-	s.DirectoryId = &v
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeSettingsOutput) SetNextToken(v string) *DescribeSettingsOutput {
+	s.NextToken = &v
 	return s
 }
 
-// Contains the inputs for the CreateComputer operation.
-type CreateComputerInput struct {
+// SetSettingEntries sets the SettingEntries field's value.
+func (s *DescribeSettingsOutput) SetSettingEntries(v []*SettingEntry) *DescribeSettingsOutput {
+	s.SettingEntries = v
+	return s
+}
+
+type DescribeSharedDirectoriesInput struct {
 	_ struct{} `type:"structure"`
 
-	// An array of Attribute objects that contain any LDAP attributes to apply to
-	// the computer account.
-	ComputerAttributes []*Attribute `type:"list"`
+	// The number of shared directories to return in the response object.
+	Limit *int64 `type:"integer"`
 
-	// The name of the computer account.
-	//
-	// ComputerName is a required field
-	ComputerName *string `min:"1" type:"string" required:"true"`
+	// The DescribeSharedDirectoriesResult.NextToken value from a previous call
+	// to DescribeSharedDirectories. Pass null if this is the first call.
+	NextToken *string `type:"string"`
 
-	// The identifier of the directory in which to create the computer account.
+	// Returns the identifier of the directory in the directory owner account.
 	//
-	// DirectoryId is a required field
-	DirectoryId *string `type:"string" required:"true"`
-
-	// The fully-qualified distinguished name of the organizational unit to place
-	// the computer account in.
-	OrganizationalUnitDistinguishedName *string `min:"1" type:"string"`
+	// OwnerDirectoryId is a required field
+	OwnerDirectoryId *string `type:"string" required:"true"`
 
-	// A one-time password that is used to join the computer to the directory. You
-	// should generate a random, strong password to use for this parameter.
-	//
-	// Password is a required field
-	Password *string `min:"8" type:"string" required:"true" sensitive:"true"`
+	// A list of identifiers of all shared directories in your account.
+	SharedDirectoryIds []*string `type:"list"`
 }
 
-// String returns the string representation
-func (s CreateComputerInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeSharedDirectoriesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateComputerInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeSharedDirectoriesInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateComputerInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateComputerInput"}
-	if s.ComputerName == nil {
-		invalidParams.Add(request.NewErrParamRequired("ComputerName"))
-	}
-	if s.ComputerName != nil && len(*s.ComputerName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("ComputerName", 1))
-	}
-	if s.DirectoryId == nil {
-		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
-	}
-	if s.OrganizationalUnitDistinguishedName != nil && len(*s.OrganizationalUnitDistinguishedName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("OrganizationalUnitDistinguishedName", 1))
-	}
-	if s.Password == nil {
-		invalidParams.Add(request.NewErrParamRequired("Password"))
-	}
-	if s.Password != nil && len(*s.Password) < 8 {
-		invalidParams.Add(request.NewErrParamMinLen("Password", 8))
-	}
-	if s.ComputerAttributes != nil {
-		for i, v := range s.ComputerAttributes {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ComputerAttributes", i), err.(request.ErrInvalidParams))
-			}
-		}
+func (s *DescribeSharedDirectoriesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeSharedDirectoriesInput"}
+	if s.OwnerDirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("OwnerDirectoryId"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5572,328 +11457,346 @@ func (s *CreateComputerInput) Validate() error {
 	return nil
 }
 
-// SetComputerAttributes sets the ComputerAttributes field's value.
-func (s *CreateComputerInput) SetComputerAttributes(v []*Attribute) *CreateComputerInput {
-	s.ComputerAttributes = v
-	return s
-}
-
-// SetComputerName sets the ComputerName field's value.
-func (s *CreateComputerInput) SetComputerName(v string) *CreateComputerInput {
-	s.ComputerName = &v
+// SetLimit sets the Limit field's value.
+func (s *DescribeSharedDirectoriesInput) SetLimit(v int64) *DescribeSharedDirectoriesInput {
+	s.Limit = &v
 	return s
 }
 
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *CreateComputerInput) SetDirectoryId(v string) *CreateComputerInput {
-	s.DirectoryId = &v
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeSharedDirectoriesInput) SetNextToken(v string) *DescribeSharedDirectoriesInput {
+	s.NextToken = &v
 	return s
 }
 
-// SetOrganizationalUnitDistinguishedName sets the OrganizationalUnitDistinguishedName field's value.
-func (s *CreateComputerInput) SetOrganizationalUnitDistinguishedName(v string) *CreateComputerInput {
-	s.OrganizationalUnitDistinguishedName = &v
+// SetOwnerDirectoryId sets the OwnerDirectoryId field's value.
+func (s *DescribeSharedDirectoriesInput) SetOwnerDirectoryId(v string) *DescribeSharedDirectoriesInput {
+	s.OwnerDirectoryId = &v
 	return s
 }
 
-// SetPassword sets the Password field's value.
-func (s *CreateComputerInput) SetPassword(v string) *CreateComputerInput {
-	s.Password = &v
+// SetSharedDirectoryIds sets the SharedDirectoryIds field's value.
+func (s *DescribeSharedDirectoriesInput) SetSharedDirectoryIds(v []*string) *DescribeSharedDirectoriesInput {
+	s.SharedDirectoryIds = v
 	return s
 }
 
-// Contains the results for the CreateComputer operation.
-type CreateComputerOutput struct {
+type DescribeSharedDirectoriesOutput struct {
 	_ struct{} `type:"structure"`
 
-	// A Computer object that represents the computer account.
-	Computer *Computer `type:"structure"`
+	// If not null, token that indicates that more results are available. Pass this
+	// value for the NextToken parameter in a subsequent call to DescribeSharedDirectories
+	// to retrieve the next set of items.
+	NextToken *string `type:"string"`
+
+	// A list of all shared directories in your account.
+	SharedDirectories []*SharedDirectory `type:"list"`
 }
 
-// String returns the string representation
-func (s CreateComputerOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeSharedDirectoriesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateComputerOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeSharedDirectoriesOutput) GoString() string {
 	return s.String()
 }
 
-// SetComputer sets the Computer field's value.
-func (s *CreateComputerOutput) SetComputer(v *Computer) *CreateComputerOutput {
-	s.Computer = v
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeSharedDirectoriesOutput) SetNextToken(v string) *DescribeSharedDirectoriesOutput {
+	s.NextToken = &v
 	return s
 }
 
-// Initiates the creation of a conditional forwarder for your AWS Directory
-// Service for Microsoft Active Directory. Conditional forwarders are required
-// in order to set up a trust relationship with another domain.
-type CreateConditionalForwarderInput struct {
+// SetSharedDirectories sets the SharedDirectories field's value.
+func (s *DescribeSharedDirectoriesOutput) SetSharedDirectories(v []*SharedDirectory) *DescribeSharedDirectoriesOutput {
+	s.SharedDirectories = v
+	return s
+}
+
+// Contains the inputs for the DescribeSnapshots operation.
+type DescribeSnapshotsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The directory ID of the AWS directory for which you are creating the conditional
-	// forwarder.
-	//
-	// DirectoryId is a required field
-	DirectoryId *string `type:"string" required:"true"`
+	// The identifier of the directory for which to retrieve snapshot information.
+	DirectoryId *string `type:"string"`
 
-	// The IP addresses of the remote DNS server associated with RemoteDomainName.
-	//
-	// DnsIpAddrs is a required field
-	DnsIpAddrs []*string `type:"list" required:"true"`
+	// The maximum number of objects to return.
+	Limit *int64 `type:"integer"`
 
-	// The fully qualified domain name (FQDN) of the remote domain with which you
-	// will set up a trust relationship.
-	//
-	// RemoteDomainName is a required field
-	RemoteDomainName *string `type:"string" required:"true"`
+	// The DescribeSnapshotsResult.NextToken value from a previous call to DescribeSnapshots.
+	// Pass null if this is the first call.
+	NextToken *string `type:"string"`
+
+	// A list of identifiers of the snapshots to obtain the information for. If
+	// this member is null or empty, all snapshots are returned using the Limit
+	// and NextToken members.
+	SnapshotIds []*string `type:"list"`
 }
 
-// String returns the string representation
-func (s CreateConditionalForwarderInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeSnapshotsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateConditionalForwarderInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeSnapshotsInput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateConditionalForwarderInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateConditionalForwarderInput"}
-	if s.DirectoryId == nil {
-		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
-	}
-	if s.DnsIpAddrs == nil {
-		invalidParams.Add(request.NewErrParamRequired("DnsIpAddrs"))
-	}
-	if s.RemoteDomainName == nil {
-		invalidParams.Add(request.NewErrParamRequired("RemoteDomainName"))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
 // SetDirectoryId sets the DirectoryId field's value.
-func (s *CreateConditionalForwarderInput) SetDirectoryId(v string) *CreateConditionalForwarderInput {
+func (s *DescribeSnapshotsInput) SetDirectoryId(v string) *DescribeSnapshotsInput {
 	s.DirectoryId = &v
 	return s
 }
 
-// SetDnsIpAddrs sets the DnsIpAddrs field's value.
-func (s *CreateConditionalForwarderInput) SetDnsIpAddrs(v []*string) *CreateConditionalForwarderInput {
-	s.DnsIpAddrs = v
+// SetLimit sets the Limit field's value.
+func (s *DescribeSnapshotsInput) SetLimit(v int64) *DescribeSnapshotsInput {
+	s.Limit = &v
 	return s
 }
 
-// SetRemoteDomainName sets the RemoteDomainName field's value.
-func (s *CreateConditionalForwarderInput) SetRemoteDomainName(v string) *CreateConditionalForwarderInput {
-	s.RemoteDomainName = &v
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeSnapshotsInput) SetNextToken(v string) *DescribeSnapshotsInput {
+	s.NextToken = &v
 	return s
 }
 
-// The result of a CreateConditinalForwarder request.
-type CreateConditionalForwarderOutput struct {
-	_ struct{} `type:"structure"`
-}
-
-// String returns the string representation
-func (s CreateConditionalForwarderOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s CreateConditionalForwarderOutput) GoString() string {
-	return s.String()
+// SetSnapshotIds sets the SnapshotIds field's value.
+func (s *DescribeSnapshotsInput) SetSnapshotIds(v []*string) *DescribeSnapshotsInput {
+	s.SnapshotIds = v
+	return s
 }
 
-// Contains the inputs for the CreateDirectory operation.
-type CreateDirectoryInput struct {
+// Contains the results of the DescribeSnapshots operation.
+type DescribeSnapshotsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// A textual description for the directory.
-	Description *string `type:"string"`
-
-	// The fully qualified name for the directory, such as corp.example.com.
-	//
-	// Name is a required field
-	Name *string `type:"string" required:"true"`
-
-	// The password for the directory administrator. The directory creation process
-	// creates a directory administrator account with the user name Administrator
-	// and this password.
-	//
-	// Password is a required field
-	Password *string `type:"string" required:"true" sensitive:"true"`
-
-	// The short name of the directory, such as CORP.
-	ShortName *string `type:"string"`
+	// If not null, more results are available. Pass this value in the NextToken
+	// member of a subsequent call to DescribeSnapshots.
+	NextToken *string `type:"string"`
 
-	// The size of the directory.
+	// The list of Snapshot objects that were retrieved.
 	//
-	// Size is a required field
-	Size *string `type:"string" required:"true" enum:"DirectorySize"`
-
-	// The tags to be assigned to the Simple AD directory.
-	Tags []*Tag `type:"list"`
-
-	// A DirectoryVpcSettings object that contains additional information for the
-	// operation.
-	VpcSettings *DirectoryVpcSettings `type:"structure"`
+	// It is possible that this list contains less than the number of items specified
+	// in the Limit member of the request. This occurs if there are less than the
+	// requested number of items left to retrieve, or if the limitations of the
+	// operation have been exceeded.
+	Snapshots []*Snapshot `type:"list"`
 }
 
-// String returns the string representation
-func (s CreateDirectoryInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeSnapshotsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateDirectoryInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeSnapshotsOutput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateDirectoryInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateDirectoryInput"}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
-	}
-	if s.Password == nil {
-		invalidParams.Add(request.NewErrParamRequired("Password"))
-	}
-	if s.Size == nil {
-		invalidParams.Add(request.NewErrParamRequired("Size"))
-	}
-	if s.Tags != nil {
-		for i, v := range s.Tags {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
-			}
-		}
-	}
-	if s.VpcSettings != nil {
-		if err := s.VpcSettings.Validate(); err != nil {
-			invalidParams.AddNested("VpcSettings", err.(request.ErrInvalidParams))
-		}
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeSnapshotsOutput) SetNextToken(v string) *DescribeSnapshotsOutput {
+	s.NextToken = &v
+	return s
 }
 
-// SetDescription sets the Description field's value.
-func (s *CreateDirectoryInput) SetDescription(v string) *CreateDirectoryInput {
-	s.Description = &v
+// SetSnapshots sets the Snapshots field's value.
+func (s *DescribeSnapshotsOutput) SetSnapshots(v []*Snapshot) *DescribeSnapshotsOutput {
+	s.Snapshots = v
 	return s
 }
 
-// SetName sets the Name field's value.
-func (s *CreateDirectoryInput) SetName(v string) *CreateDirectoryInput {
-	s.Name = &v
-	return s
+// Describes the trust relationships for a particular Managed Microsoft AD directory.
+// If no input parameters are provided, such as directory ID or trust ID, this
+// request describes all the trust relationships.
+type DescribeTrustsInput struct {
+	_ struct{} `type:"structure"`
+
+	// The Directory ID of the Amazon Web Services directory that is a part of the
+	// requested trust relationship.
+	DirectoryId *string `type:"string"`
+
+	// The maximum number of objects to return.
+	Limit *int64 `type:"integer"`
+
+	// The DescribeTrustsResult.NextToken value from a previous call to DescribeTrusts.
+	// Pass null if this is the first call.
+	NextToken *string `type:"string"`
+
+	// A list of identifiers of the trust relationships for which to obtain the
+	// information. If this member is null, all trust relationships that belong
+	// to the current account are returned.
+	//
+	// An empty list results in an InvalidParameterException being thrown.
+	TrustIds []*string `type:"list"`
 }
 
-// SetPassword sets the Password field's value.
-func (s *CreateDirectoryInput) SetPassword(v string) *CreateDirectoryInput {
-	s.Password = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeTrustsInput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetShortName sets the ShortName field's value.
-func (s *CreateDirectoryInput) SetShortName(v string) *CreateDirectoryInput {
-	s.ShortName = &v
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeTrustsInput) GoString() string {
+	return s.String()
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *DescribeTrustsInput) SetDirectoryId(v string) *DescribeTrustsInput {
+	s.DirectoryId = &v
 	return s
 }
 
-// SetSize sets the Size field's value.
-func (s *CreateDirectoryInput) SetSize(v string) *CreateDirectoryInput {
-	s.Size = &v
+// SetLimit sets the Limit field's value.
+func (s *DescribeTrustsInput) SetLimit(v int64) *DescribeTrustsInput {
+	s.Limit = &v
 	return s
 }
 
-// SetTags sets the Tags field's value.
-func (s *CreateDirectoryInput) SetTags(v []*Tag) *CreateDirectoryInput {
-	s.Tags = v
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeTrustsInput) SetNextToken(v string) *DescribeTrustsInput {
+	s.NextToken = &v
 	return s
 }
 
-// SetVpcSettings sets the VpcSettings field's value.
-func (s *CreateDirectoryInput) SetVpcSettings(v *DirectoryVpcSettings) *CreateDirectoryInput {
-	s.VpcSettings = v
+// SetTrustIds sets the TrustIds field's value.
+func (s *DescribeTrustsInput) SetTrustIds(v []*string) *DescribeTrustsInput {
+	s.TrustIds = v
 	return s
 }
 
-// Contains the results of the CreateDirectory operation.
-type CreateDirectoryOutput struct {
+// The result of a DescribeTrust request.
+type DescribeTrustsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The identifier of the directory that was created.
-	DirectoryId *string `type:"string"`
+	// If not null, more results are available. Pass this value for the NextToken
+	// parameter in a subsequent call to DescribeTrusts to retrieve the next set
+	// of items.
+	NextToken *string `type:"string"`
+
+	// The list of Trust objects that were retrieved.
+	//
+	// It is possible that this list contains less than the number of items specified
+	// in the Limit member of the request. This occurs if there are less than the
+	// requested number of items left to retrieve, or if the limitations of the
+	// operation have been exceeded.
+	Trusts []*Trust `type:"list"`
 }
 
-// String returns the string representation
-func (s CreateDirectoryOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeTrustsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateDirectoryOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeTrustsOutput) GoString() string {
 	return s.String()
 }
 
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *CreateDirectoryOutput) SetDirectoryId(v string) *CreateDirectoryOutput {
-	s.DirectoryId = &v
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeTrustsOutput) SetNextToken(v string) *DescribeTrustsOutput {
+	s.NextToken = &v
 	return s
 }
 
-type CreateLogSubscriptionInput struct {
+// SetTrusts sets the Trusts field's value.
+func (s *DescribeTrustsOutput) SetTrusts(v []*Trust) *DescribeTrustsOutput {
+	s.Trusts = v
+	return s
+}
+
+type DescribeUpdateDirectoryInput struct {
 	_ struct{} `type:"structure"`
 
-	// Identifier (ID) of the directory to which you want to subscribe and receive
-	// real-time logs to your specified CloudWatch log group.
+	// The unique identifier of the directory.
 	//
 	// DirectoryId is a required field
 	DirectoryId *string `type:"string" required:"true"`
 
-	// The name of the CloudWatch log group where the real-time domain controller
-	// logs are forwarded.
+	// The DescribeUpdateDirectoryResult. NextToken value from a previous call to
+	// DescribeUpdateDirectory. Pass null if this is the first call.
+	NextToken *string `type:"string"`
+
+	// The name of the Region.
+	RegionName *string `min:"8" type:"string"`
+
+	// The type of updates you want to describe for the directory.
 	//
-	// LogGroupName is a required field
-	LogGroupName *string `min:"1" type:"string" required:"true"`
+	// UpdateType is a required field
+	UpdateType *string `type:"string" required:"true" enum:"UpdateType"`
 }
 
-// String returns the string representation
-func (s CreateLogSubscriptionInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeUpdateDirectoryInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateLogSubscriptionInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeUpdateDirectoryInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateLogSubscriptionInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateLogSubscriptionInput"}
+func (s *DescribeUpdateDirectoryInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeUpdateDirectoryInput"}
 	if s.DirectoryId == nil {
 		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
 	}
-	if s.LogGroupName == nil {
-		invalidParams.Add(request.NewErrParamRequired("LogGroupName"))
+	if s.RegionName != nil && len(*s.RegionName) < 8 {
+		invalidParams.Add(request.NewErrParamMinLen("RegionName", 8))
 	}
-	if s.LogGroupName != nil && len(*s.LogGroupName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("LogGroupName", 1))
+	if s.UpdateType == nil {
+		invalidParams.Add(request.NewErrParamRequired("UpdateType"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -5902,208 +11805,278 @@ func (s *CreateLogSubscriptionInput) Validate() error {
 	return nil
 }
 
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *CreateLogSubscriptionInput) SetDirectoryId(v string) *CreateLogSubscriptionInput {
-	s.DirectoryId = &v
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *DescribeUpdateDirectoryInput) SetDirectoryId(v string) *DescribeUpdateDirectoryInput {
+	s.DirectoryId = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeUpdateDirectoryInput) SetNextToken(v string) *DescribeUpdateDirectoryInput {
+	s.NextToken = &v
 	return s
 }
 
-// SetLogGroupName sets the LogGroupName field's value.
-func (s *CreateLogSubscriptionInput) SetLogGroupName(v string) *CreateLogSubscriptionInput {
-	s.LogGroupName = &v
+// SetRegionName sets the RegionName field's value.
+func (s *DescribeUpdateDirectoryInput) SetRegionName(v string) *DescribeUpdateDirectoryInput {
+	s.RegionName = &v
 	return s
 }
 
-type CreateLogSubscriptionOutput struct {
+// SetUpdateType sets the UpdateType field's value.
+func (s *DescribeUpdateDirectoryInput) SetUpdateType(v string) *DescribeUpdateDirectoryInput {
+	s.UpdateType = &v
+	return s
+}
+
+type DescribeUpdateDirectoryOutput struct {
 	_ struct{} `type:"structure"`
+
+	// If not null, more results are available. Pass this value for the NextToken
+	// parameter.
+	NextToken *string `type:"string"`
+
+	// The list of update activities on a directory for the requested update type.
+	UpdateActivities []*UpdateInfoEntry `type:"list"`
 }
 
-// String returns the string representation
-func (s CreateLogSubscriptionOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeUpdateDirectoryOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateLogSubscriptionOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeUpdateDirectoryOutput) GoString() string {
 	return s.String()
 }
 
-// Creates an AWS Managed Microsoft AD directory.
-type CreateMicrosoftADInput struct {
-	_ struct{} `type:"structure"`
-
-	// A textual description for the directory. This label will appear on the AWS
-	// console Directory Details page after the directory is created.
-	Description *string `type:"string"`
-
-	// AWS Managed Microsoft AD is available in two editions: Standard and Enterprise.
-	// Enterprise is the default.
-	Edition *string `type:"string" enum:"DirectoryEdition"`
-
-	// The fully qualified domain name for the directory, such as corp.example.com.
-	// This name will resolve inside your VPC only. It does not need to be publicly
-	// resolvable.
-	//
-	// Name is a required field
-	Name *string `type:"string" required:"true"`
+// SetNextToken sets the NextToken field's value.
+func (s *DescribeUpdateDirectoryOutput) SetNextToken(v string) *DescribeUpdateDirectoryOutput {
+	s.NextToken = &v
+	return s
+}
 
-	// The password for the default administrative user named Admin.
-	//
-	// Password is a required field
-	Password *string `type:"string" required:"true" sensitive:"true"`
+// SetUpdateActivities sets the UpdateActivities field's value.
+func (s *DescribeUpdateDirectoryOutput) SetUpdateActivities(v []*UpdateInfoEntry) *DescribeUpdateDirectoryOutput {
+	s.UpdateActivities = v
+	return s
+}
 
-	// The NetBIOS name for your domain. A short identifier for your domain, such
-	// as CORP. If you don't specify a NetBIOS name, it will default to the first
-	// part of your directory DNS. For example, CORP for the directory DNS corp.example.com.
-	ShortName *string `type:"string"`
+// The Region you specified is the same Region where the Managed Microsoft AD
+// directory was created. Specify a different Region and try again.
+type DirectoryAlreadyInRegionException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The tags to be assigned to the AWS Managed Microsoft AD directory.
-	Tags []*Tag `type:"list"`
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
 
-	// Contains VPC information for the CreateDirectory or CreateMicrosoftAD operation.
-	//
-	// VpcSettings is a required field
-	VpcSettings *DirectoryVpcSettings `type:"structure" required:"true"`
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
 }
 
-// String returns the string representation
-func (s CreateMicrosoftADInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryAlreadyInRegionException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateMicrosoftADInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryAlreadyInRegionException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateMicrosoftADInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateMicrosoftADInput"}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
-	}
-	if s.Password == nil {
-		invalidParams.Add(request.NewErrParamRequired("Password"))
-	}
-	if s.VpcSettings == nil {
-		invalidParams.Add(request.NewErrParamRequired("VpcSettings"))
-	}
-	if s.Tags != nil {
-		for i, v := range s.Tags {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
-			}
-		}
-	}
-	if s.VpcSettings != nil {
-		if err := s.VpcSettings.Validate(); err != nil {
-			invalidParams.AddNested("VpcSettings", err.(request.ErrInvalidParams))
-		}
+func newErrorDirectoryAlreadyInRegionException(v protocol.ResponseMetadata) error {
+	return &DirectoryAlreadyInRegionException{
+		RespMetadata: v,
 	}
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
+// Code returns the exception type name.
+func (s *DirectoryAlreadyInRegionException) Code() string {
+	return "DirectoryAlreadyInRegionException"
+}
+
+// Message returns the exception's message.
+func (s *DirectoryAlreadyInRegionException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *DirectoryAlreadyInRegionException) OrigErr() error {
 	return nil
 }
 
-// SetDescription sets the Description field's value.
-func (s *CreateMicrosoftADInput) SetDescription(v string) *CreateMicrosoftADInput {
-	s.Description = &v
-	return s
+func (s *DirectoryAlreadyInRegionException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
 }
 
-// SetEdition sets the Edition field's value.
-func (s *CreateMicrosoftADInput) SetEdition(v string) *CreateMicrosoftADInput {
-	s.Edition = &v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *DirectoryAlreadyInRegionException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// SetName sets the Name field's value.
-func (s *CreateMicrosoftADInput) SetName(v string) *CreateMicrosoftADInput {
-	s.Name = &v
-	return s
+// RequestID returns the service's response RequestID for request.
+func (s *DirectoryAlreadyInRegionException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// SetPassword sets the Password field's value.
-func (s *CreateMicrosoftADInput) SetPassword(v string) *CreateMicrosoftADInput {
-	s.Password = &v
-	return s
+// The specified directory has already been shared with this Amazon Web Services
+// account.
+type DirectoryAlreadySharedException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
 }
 
-// SetShortName sets the ShortName field's value.
-func (s *CreateMicrosoftADInput) SetShortName(v string) *CreateMicrosoftADInput {
-	s.ShortName = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryAlreadySharedException) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetTags sets the Tags field's value.
-func (s *CreateMicrosoftADInput) SetTags(v []*Tag) *CreateMicrosoftADInput {
-	s.Tags = v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryAlreadySharedException) GoString() string {
+	return s.String()
 }
 
-// SetVpcSettings sets the VpcSettings field's value.
-func (s *CreateMicrosoftADInput) SetVpcSettings(v *DirectoryVpcSettings) *CreateMicrosoftADInput {
-	s.VpcSettings = v
-	return s
+func newErrorDirectoryAlreadySharedException(v protocol.ResponseMetadata) error {
+	return &DirectoryAlreadySharedException{
+		RespMetadata: v,
+	}
 }
 
-// Result of a CreateMicrosoftAD request.
-type CreateMicrosoftADOutput struct {
-	_ struct{} `type:"structure"`
+// Code returns the exception type name.
+func (s *DirectoryAlreadySharedException) Code() string {
+	return "DirectoryAlreadySharedException"
+}
 
-	// The identifier of the directory that was created.
-	DirectoryId *string `type:"string"`
+// Message returns the exception's message.
+func (s *DirectoryAlreadySharedException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// String returns the string representation
-func (s CreateMicrosoftADOutput) String() string {
-	return awsutil.Prettify(s)
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *DirectoryAlreadySharedException) OrigErr() error {
+	return nil
 }
 
-// GoString returns the string representation
-func (s CreateMicrosoftADOutput) GoString() string {
-	return s.String()
+func (s *DirectoryAlreadySharedException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
 }
 
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *CreateMicrosoftADOutput) SetDirectoryId(v string) *CreateMicrosoftADOutput {
-	s.DirectoryId = &v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *DirectoryAlreadySharedException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// Contains the inputs for the CreateSnapshot operation.
-type CreateSnapshotInput struct {
+// RequestID returns the service's response RequestID for request.
+func (s *DirectoryAlreadySharedException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Contains information for the ConnectDirectory operation when an AD Connector
+// directory is being created.
+type DirectoryConnectSettings struct {
 	_ struct{} `type:"structure"`
 
-	// The identifier of the directory of which to take a snapshot.
+	// A list of one or more IP addresses of DNS servers or domain controllers in
+	// your self-managed directory.
 	//
-	// DirectoryId is a required field
-	DirectoryId *string `type:"string" required:"true"`
+	// CustomerDnsIps is a required field
+	CustomerDnsIps []*string `type:"list" required:"true"`
 
-	// The descriptive name to apply to the snapshot.
-	Name *string `type:"string"`
+	// The user name of an account in your self-managed directory that is used to
+	// connect to the directory. This account must have the following permissions:
+	//
+	//    * Read users and groups
+	//
+	//    * Create computer objects
+	//
+	//    * Join computers to the domain
+	//
+	// CustomerUserName is a required field
+	CustomerUserName *string `min:"1" type:"string" required:"true"`
+
+	// A list of subnet identifiers in the VPC in which the AD Connector is created.
+	//
+	// SubnetIds is a required field
+	SubnetIds []*string `type:"list" required:"true"`
+
+	// The identifier of the VPC in which the AD Connector is created.
+	//
+	// VpcId is a required field
+	VpcId *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateSnapshotInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryConnectSettings) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateSnapshotInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryConnectSettings) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateSnapshotInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateSnapshotInput"}
-	if s.DirectoryId == nil {
-		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+func (s *DirectoryConnectSettings) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DirectoryConnectSettings"}
+	if s.CustomerDnsIps == nil {
+		invalidParams.Add(request.NewErrParamRequired("CustomerDnsIps"))
+	}
+	if s.CustomerUserName == nil {
+		invalidParams.Add(request.NewErrParamRequired("CustomerUserName"))
+	}
+	if s.CustomerUserName != nil && len(*s.CustomerUserName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CustomerUserName", 1))
+	}
+	if s.SubnetIds == nil {
+		invalidParams.Add(request.NewErrParamRequired("SubnetIds"))
+	}
+	if s.VpcId == nil {
+		invalidParams.Add(request.NewErrParamRequired("VpcId"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -6112,776 +12085,877 @@ func (s *CreateSnapshotInput) Validate() error {
 	return nil
 }
 
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *CreateSnapshotInput) SetDirectoryId(v string) *CreateSnapshotInput {
-	s.DirectoryId = &v
+// SetCustomerDnsIps sets the CustomerDnsIps field's value.
+func (s *DirectoryConnectSettings) SetCustomerDnsIps(v []*string) *DirectoryConnectSettings {
+	s.CustomerDnsIps = v
 	return s
 }
 
-// SetName sets the Name field's value.
-func (s *CreateSnapshotInput) SetName(v string) *CreateSnapshotInput {
-	s.Name = &v
+// SetCustomerUserName sets the CustomerUserName field's value.
+func (s *DirectoryConnectSettings) SetCustomerUserName(v string) *DirectoryConnectSettings {
+	s.CustomerUserName = &v
 	return s
 }
 
-// Contains the results of the CreateSnapshot operation.
-type CreateSnapshotOutput struct {
-	_ struct{} `type:"structure"`
-
-	// The identifier of the snapshot that was created.
-	SnapshotId *string `type:"string"`
-}
-
-// String returns the string representation
-func (s CreateSnapshotOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s CreateSnapshotOutput) GoString() string {
-	return s.String()
+// SetSubnetIds sets the SubnetIds field's value.
+func (s *DirectoryConnectSettings) SetSubnetIds(v []*string) *DirectoryConnectSettings {
+	s.SubnetIds = v
+	return s
 }
 
-// SetSnapshotId sets the SnapshotId field's value.
-func (s *CreateSnapshotOutput) SetSnapshotId(v string) *CreateSnapshotOutput {
-	s.SnapshotId = &v
+// SetVpcId sets the VpcId field's value.
+func (s *DirectoryConnectSettings) SetVpcId(v string) *DirectoryConnectSettings {
+	s.VpcId = &v
 	return s
 }
 
-// AWS Directory Service for Microsoft Active Directory allows you to configure
-// trust relationships. For example, you can establish a trust between your
-// AWS Managed Microsoft AD directory, and your existing on-premises Microsoft
-// Active Directory. This would allow you to provide users and groups access
-// to resources in either domain, with a single set of credentials.
-//
-// This action initiates the creation of the AWS side of a trust relationship
-// between an AWS Managed Microsoft AD directory and an external domain.
-type CreateTrustInput struct {
+// Contains information about an AD Connector directory.
+type DirectoryConnectSettingsDescription struct {
 	_ struct{} `type:"structure"`
 
-	// The IP addresses of the remote DNS server associated with RemoteDomainName.
-	ConditionalForwarderIpAddrs []*string `type:"list"`
-
-	// The Directory ID of the AWS Managed Microsoft AD directory for which to establish
-	// the trust relationship.
-	//
-	// DirectoryId is a required field
-	DirectoryId *string `type:"string" required:"true"`
+	// A list of the Availability Zones that the directory is in.
+	AvailabilityZones []*string `type:"list"`
 
-	// The Fully Qualified Domain Name (FQDN) of the external domain for which to
-	// create the trust relationship.
-	//
-	// RemoteDomainName is a required field
-	RemoteDomainName *string `type:"string" required:"true"`
+	// The IP addresses of the AD Connector servers.
+	ConnectIps []*string `type:"list"`
 
-	// Optional parameter to enable selective authentication for the trust.
-	SelectiveAuth *string `type:"string" enum:"SelectiveAuth"`
+	// The user name of the service account in your self-managed directory.
+	CustomerUserName *string `min:"1" type:"string"`
 
-	// The direction of the trust relationship.
-	//
-	// TrustDirection is a required field
-	TrustDirection *string `type:"string" required:"true" enum:"TrustDirection"`
+	// The security group identifier for the AD Connector directory.
+	SecurityGroupId *string `type:"string"`
 
-	// The trust password. The must be the same password that was used when creating
-	// the trust relationship on the external domain.
-	//
-	// TrustPassword is a required field
-	TrustPassword *string `min:"1" type:"string" required:"true" sensitive:"true"`
+	// A list of subnet identifiers in the VPC that the AD Connector is in.
+	SubnetIds []*string `type:"list"`
 
-	// The trust relationship type. Forest is the default.
-	TrustType *string `type:"string" enum:"TrustType"`
+	// The identifier of the VPC that the AD Connector is in.
+	VpcId *string `type:"string"`
 }
 
-// String returns the string representation
-func (s CreateTrustInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryConnectSettingsDescription) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateTrustInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryConnectSettingsDescription) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateTrustInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateTrustInput"}
-	if s.DirectoryId == nil {
-		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
-	}
-	if s.RemoteDomainName == nil {
-		invalidParams.Add(request.NewErrParamRequired("RemoteDomainName"))
-	}
-	if s.TrustDirection == nil {
-		invalidParams.Add(request.NewErrParamRequired("TrustDirection"))
-	}
-	if s.TrustPassword == nil {
-		invalidParams.Add(request.NewErrParamRequired("TrustPassword"))
-	}
-	if s.TrustPassword != nil && len(*s.TrustPassword) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("TrustPassword", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
-// SetConditionalForwarderIpAddrs sets the ConditionalForwarderIpAddrs field's value.
-func (s *CreateTrustInput) SetConditionalForwarderIpAddrs(v []*string) *CreateTrustInput {
-	s.ConditionalForwarderIpAddrs = v
-	return s
-}
-
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *CreateTrustInput) SetDirectoryId(v string) *CreateTrustInput {
-	s.DirectoryId = &v
+// SetAvailabilityZones sets the AvailabilityZones field's value.
+func (s *DirectoryConnectSettingsDescription) SetAvailabilityZones(v []*string) *DirectoryConnectSettingsDescription {
+	s.AvailabilityZones = v
 	return s
 }
 
-// SetRemoteDomainName sets the RemoteDomainName field's value.
-func (s *CreateTrustInput) SetRemoteDomainName(v string) *CreateTrustInput {
-	s.RemoteDomainName = &v
+// SetConnectIps sets the ConnectIps field's value.
+func (s *DirectoryConnectSettingsDescription) SetConnectIps(v []*string) *DirectoryConnectSettingsDescription {
+	s.ConnectIps = v
 	return s
 }
 
-// SetSelectiveAuth sets the SelectiveAuth field's value.
-func (s *CreateTrustInput) SetSelectiveAuth(v string) *CreateTrustInput {
-	s.SelectiveAuth = &v
+// SetCustomerUserName sets the CustomerUserName field's value.
+func (s *DirectoryConnectSettingsDescription) SetCustomerUserName(v string) *DirectoryConnectSettingsDescription {
+	s.CustomerUserName = &v
 	return s
 }
 
-// SetTrustDirection sets the TrustDirection field's value.
-func (s *CreateTrustInput) SetTrustDirection(v string) *CreateTrustInput {
-	s.TrustDirection = &v
+// SetSecurityGroupId sets the SecurityGroupId field's value.
+func (s *DirectoryConnectSettingsDescription) SetSecurityGroupId(v string) *DirectoryConnectSettingsDescription {
+	s.SecurityGroupId = &v
 	return s
 }
 
-// SetTrustPassword sets the TrustPassword field's value.
-func (s *CreateTrustInput) SetTrustPassword(v string) *CreateTrustInput {
-	s.TrustPassword = &v
+// SetSubnetIds sets the SubnetIds field's value.
+func (s *DirectoryConnectSettingsDescription) SetSubnetIds(v []*string) *DirectoryConnectSettingsDescription {
+	s.SubnetIds = v
 	return s
 }
 
-// SetTrustType sets the TrustType field's value.
-func (s *CreateTrustInput) SetTrustType(v string) *CreateTrustInput {
-	s.TrustType = &v
+// SetVpcId sets the VpcId field's value.
+func (s *DirectoryConnectSettingsDescription) SetVpcId(v string) *DirectoryConnectSettingsDescription {
+	s.VpcId = &v
 	return s
 }
 
-// The result of a CreateTrust request.
-type CreateTrustOutput struct {
+// Contains information about an Directory Service directory.
+type DirectoryDescription struct {
 	_ struct{} `type:"structure"`
 
-	// A unique identifier for the trust relationship that was created.
-	TrustId *string `type:"string"`
+	// The access URL for the directory, such as http://<alias>.awsapps.com. If
+	// no alias has been created for the directory, <alias> is the directory identifier,
+	// such as d-XXXXXXXXXX.
+	AccessUrl *string `min:"1" type:"string"`
+
+	// The alias for the directory. If no alias has been created for the directory,
+	// the alias is the directory identifier, such as d-XXXXXXXXXX.
+	Alias *string `min:"1" type:"string"`
+
+	// A DirectoryConnectSettingsDescription object that contains additional information
+	// about an AD Connector directory. This member is only present if the directory
+	// is an AD Connector directory.
+	ConnectSettings *DirectoryConnectSettingsDescription `type:"structure"`
+
+	// The description for the directory.
+	Description *string `type:"string"`
+
+	// The desired number of domain controllers in the directory if the directory
+	// is Microsoft AD.
+	DesiredNumberOfDomainControllers *int64 `min:"2" type:"integer"`
+
+	// The directory identifier.
+	DirectoryId *string `type:"string"`
+
+	// The IP addresses of the DNS servers for the directory. For a Simple AD or
+	// Microsoft AD directory, these are the IP addresses of the Simple AD or Microsoft
+	// AD directory servers. For an AD Connector directory, these are the IP addresses
+	// of the DNS servers or domain controllers in your self-managed directory to
+	// which the AD Connector is connected.
+	DnsIpAddrs []*string `type:"list"`
+
+	// The edition associated with this directory.
+	Edition *string `type:"string" enum:"DirectoryEdition"`
+
+	// Specifies when the directory was created.
+	LaunchTime *time.Time `type:"timestamp"`
+
+	// The fully qualified name of the directory.
+	Name *string `type:"string"`
+
+	// The operating system (OS) version of the directory.
+	OsVersion *string `type:"string" enum:"OSVersion"`
+
+	// Describes the Managed Microsoft AD directory in the directory owner account.
+	OwnerDirectoryDescription *OwnerDirectoryDescription `type:"structure"`
+
+	// A RadiusSettings object that contains information about the RADIUS server
+	// configured for this directory.
+	RadiusSettings *RadiusSettings `type:"structure"`
+
+	// The status of the RADIUS MFA server connection.
+	RadiusStatus *string `type:"string" enum:"RadiusStatus"`
+
+	// Lists the Regions where the directory has replicated.
+	RegionsInfo *RegionsInfo `type:"structure"`
+
+	// The method used when sharing a directory to determine whether the directory
+	// should be shared within your Amazon Web Services organization (ORGANIZATIONS)
+	// or with any Amazon Web Services account by sending a shared directory request
+	// (HANDSHAKE).
+	ShareMethod *string `type:"string" enum:"ShareMethod"`
+
+	// A directory share request that is sent by the directory owner to the directory
+	// consumer. The request includes a typed message to help the directory consumer
+	// administrator determine whether to approve or reject the share invitation.
+	//
+	// ShareNotes is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by DirectoryDescription's
+	// String and GoString methods.
+	ShareNotes *string `type:"string" sensitive:"true"`
+
+	// Current directory status of the shared Managed Microsoft AD directory.
+	ShareStatus *string `type:"string" enum:"ShareStatus"`
+
+	// The short name of the directory.
+	ShortName *string `type:"string"`
+
+	// The directory size.
+	Size *string `type:"string" enum:"DirectorySize"`
+
+	// Indicates if single sign-on is enabled for the directory. For more information,
+	// see EnableSso and DisableSso.
+	SsoEnabled *bool `type:"boolean"`
+
+	// The current stage of the directory.
+	Stage *string `type:"string" enum:"DirectoryStage"`
+
+	// The date and time that the stage was last updated.
+	StageLastUpdatedDateTime *time.Time `type:"timestamp"`
+
+	// Additional information about the directory stage.
+	StageReason *string `type:"string"`
+
+	// The directory size.
+	Type *string `type:"string" enum:"DirectoryType"`
+
+	// A DirectoryVpcSettingsDescription object that contains additional information
+	// about a directory. This member is only present if the directory is a Simple
+	// AD or Managed Microsoft AD directory.
+	VpcSettings *DirectoryVpcSettingsDescription `type:"structure"`
 }
 
-// String returns the string representation
-func (s CreateTrustOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryDescription) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateTrustOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryDescription) GoString() string {
 	return s.String()
 }
 
-// SetTrustId sets the TrustId field's value.
-func (s *CreateTrustOutput) SetTrustId(v string) *CreateTrustOutput {
-	s.TrustId = &v
+// SetAccessUrl sets the AccessUrl field's value.
+func (s *DirectoryDescription) SetAccessUrl(v string) *DirectoryDescription {
+	s.AccessUrl = &v
 	return s
 }
 
-// Deletes a conditional forwarder.
-type DeleteConditionalForwarderInput struct {
-	_ struct{} `type:"structure"`
+// SetAlias sets the Alias field's value.
+func (s *DirectoryDescription) SetAlias(v string) *DirectoryDescription {
+	s.Alias = &v
+	return s
+}
 
-	// The directory ID for which you are deleting the conditional forwarder.
-	//
-	// DirectoryId is a required field
-	DirectoryId *string `type:"string" required:"true"`
+// SetConnectSettings sets the ConnectSettings field's value.
+func (s *DirectoryDescription) SetConnectSettings(v *DirectoryConnectSettingsDescription) *DirectoryDescription {
+	s.ConnectSettings = v
+	return s
+}
 
-	// The fully qualified domain name (FQDN) of the remote domain with which you
-	// are deleting the conditional forwarder.
-	//
-	// RemoteDomainName is a required field
-	RemoteDomainName *string `type:"string" required:"true"`
+// SetDescription sets the Description field's value.
+func (s *DirectoryDescription) SetDescription(v string) *DirectoryDescription {
+	s.Description = &v
+	return s
 }
 
-// String returns the string representation
-func (s DeleteConditionalForwarderInput) String() string {
-	return awsutil.Prettify(s)
+// SetDesiredNumberOfDomainControllers sets the DesiredNumberOfDomainControllers field's value.
+func (s *DirectoryDescription) SetDesiredNumberOfDomainControllers(v int64) *DirectoryDescription {
+	s.DesiredNumberOfDomainControllers = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s DeleteConditionalForwarderInput) GoString() string {
-	return s.String()
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *DirectoryDescription) SetDirectoryId(v string) *DirectoryDescription {
+	s.DirectoryId = &v
+	return s
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteConditionalForwarderInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteConditionalForwarderInput"}
-	if s.DirectoryId == nil {
-		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
-	}
-	if s.RemoteDomainName == nil {
-		invalidParams.Add(request.NewErrParamRequired("RemoteDomainName"))
-	}
+// SetDnsIpAddrs sets the DnsIpAddrs field's value.
+func (s *DirectoryDescription) SetDnsIpAddrs(v []*string) *DirectoryDescription {
+	s.DnsIpAddrs = v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetEdition sets the Edition field's value.
+func (s *DirectoryDescription) SetEdition(v string) *DirectoryDescription {
+	s.Edition = &v
+	return s
 }
 
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *DeleteConditionalForwarderInput) SetDirectoryId(v string) *DeleteConditionalForwarderInput {
-	s.DirectoryId = &v
+// SetLaunchTime sets the LaunchTime field's value.
+func (s *DirectoryDescription) SetLaunchTime(v time.Time) *DirectoryDescription {
+	s.LaunchTime = &v
 	return s
 }
 
-// SetRemoteDomainName sets the RemoteDomainName field's value.
-func (s *DeleteConditionalForwarderInput) SetRemoteDomainName(v string) *DeleteConditionalForwarderInput {
-	s.RemoteDomainName = &v
+// SetName sets the Name field's value.
+func (s *DirectoryDescription) SetName(v string) *DirectoryDescription {
+	s.Name = &v
 	return s
 }
 
-// The result of a DeleteConditionalForwarder request.
-type DeleteConditionalForwarderOutput struct {
-	_ struct{} `type:"structure"`
+// SetOsVersion sets the OsVersion field's value.
+func (s *DirectoryDescription) SetOsVersion(v string) *DirectoryDescription {
+	s.OsVersion = &v
+	return s
 }
 
-// String returns the string representation
-func (s DeleteConditionalForwarderOutput) String() string {
-	return awsutil.Prettify(s)
+// SetOwnerDirectoryDescription sets the OwnerDirectoryDescription field's value.
+func (s *DirectoryDescription) SetOwnerDirectoryDescription(v *OwnerDirectoryDescription) *DirectoryDescription {
+	s.OwnerDirectoryDescription = v
+	return s
 }
 
-// GoString returns the string representation
-func (s DeleteConditionalForwarderOutput) GoString() string {
-	return s.String()
+// SetRadiusSettings sets the RadiusSettings field's value.
+func (s *DirectoryDescription) SetRadiusSettings(v *RadiusSettings) *DirectoryDescription {
+	s.RadiusSettings = v
+	return s
 }
 
-// Contains the inputs for the DeleteDirectory operation.
-type DeleteDirectoryInput struct {
-	_ struct{} `type:"structure"`
+// SetRadiusStatus sets the RadiusStatus field's value.
+func (s *DirectoryDescription) SetRadiusStatus(v string) *DirectoryDescription {
+	s.RadiusStatus = &v
+	return s
+}
 
-	// The identifier of the directory to delete.
-	//
-	// DirectoryId is a required field
-	DirectoryId *string `type:"string" required:"true"`
+// SetRegionsInfo sets the RegionsInfo field's value.
+func (s *DirectoryDescription) SetRegionsInfo(v *RegionsInfo) *DirectoryDescription {
+	s.RegionsInfo = v
+	return s
 }
 
-// String returns the string representation
-func (s DeleteDirectoryInput) String() string {
-	return awsutil.Prettify(s)
+// SetShareMethod sets the ShareMethod field's value.
+func (s *DirectoryDescription) SetShareMethod(v string) *DirectoryDescription {
+	s.ShareMethod = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s DeleteDirectoryInput) GoString() string {
-	return s.String()
+// SetShareNotes sets the ShareNotes field's value.
+func (s *DirectoryDescription) SetShareNotes(v string) *DirectoryDescription {
+	s.ShareNotes = &v
+	return s
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteDirectoryInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteDirectoryInput"}
-	if s.DirectoryId == nil {
-		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
-	}
+// SetShareStatus sets the ShareStatus field's value.
+func (s *DirectoryDescription) SetShareStatus(v string) *DirectoryDescription {
+	s.ShareStatus = &v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetShortName sets the ShortName field's value.
+func (s *DirectoryDescription) SetShortName(v string) *DirectoryDescription {
+	s.ShortName = &v
+	return s
 }
 
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *DeleteDirectoryInput) SetDirectoryId(v string) *DeleteDirectoryInput {
-	s.DirectoryId = &v
+// SetSize sets the Size field's value.
+func (s *DirectoryDescription) SetSize(v string) *DirectoryDescription {
+	s.Size = &v
 	return s
 }
 
-// Contains the results of the DeleteDirectory operation.
-type DeleteDirectoryOutput struct {
-	_ struct{} `type:"structure"`
+// SetSsoEnabled sets the SsoEnabled field's value.
+func (s *DirectoryDescription) SetSsoEnabled(v bool) *DirectoryDescription {
+	s.SsoEnabled = &v
+	return s
+}
 
-	// The directory identifier.
-	DirectoryId *string `type:"string"`
+// SetStage sets the Stage field's value.
+func (s *DirectoryDescription) SetStage(v string) *DirectoryDescription {
+	s.Stage = &v
+	return s
 }
 
-// String returns the string representation
-func (s DeleteDirectoryOutput) String() string {
-	return awsutil.Prettify(s)
+// SetStageLastUpdatedDateTime sets the StageLastUpdatedDateTime field's value.
+func (s *DirectoryDescription) SetStageLastUpdatedDateTime(v time.Time) *DirectoryDescription {
+	s.StageLastUpdatedDateTime = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s DeleteDirectoryOutput) GoString() string {
-	return s.String()
+// SetStageReason sets the StageReason field's value.
+func (s *DirectoryDescription) SetStageReason(v string) *DirectoryDescription {
+	s.StageReason = &v
+	return s
 }
 
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *DeleteDirectoryOutput) SetDirectoryId(v string) *DeleteDirectoryOutput {
-	s.DirectoryId = &v
+// SetType sets the Type field's value.
+func (s *DirectoryDescription) SetType(v string) *DirectoryDescription {
+	s.Type = &v
 	return s
 }
 
-type DeleteLogSubscriptionInput struct {
-	_ struct{} `type:"structure"`
+// SetVpcSettings sets the VpcSettings field's value.
+func (s *DirectoryDescription) SetVpcSettings(v *DirectoryVpcSettingsDescription) *DirectoryDescription {
+	s.VpcSettings = v
+	return s
+}
 
-	// Identifier (ID) of the directory whose log subscription you want to delete.
-	//
-	// DirectoryId is a required field
-	DirectoryId *string `type:"string" required:"true"`
+// The specified directory does not exist in the system.
+type DirectoryDoesNotExistException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
 }
 
-// String returns the string representation
-func (s DeleteLogSubscriptionInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryDoesNotExistException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteLogSubscriptionInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryDoesNotExistException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteLogSubscriptionInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteLogSubscriptionInput"}
-	if s.DirectoryId == nil {
-		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+func newErrorDirectoryDoesNotExistException(v protocol.ResponseMetadata) error {
+	return &DirectoryDoesNotExistException{
+		RespMetadata: v,
 	}
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
+// Code returns the exception type name.
+func (s *DirectoryDoesNotExistException) Code() string {
+	return "DirectoryDoesNotExistException"
+}
+
+// Message returns the exception's message.
+func (s *DirectoryDoesNotExistException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
-	return nil
+	return ""
 }
 
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *DeleteLogSubscriptionInput) SetDirectoryId(v string) *DeleteLogSubscriptionInput {
-	s.DirectoryId = &v
-	return s
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *DirectoryDoesNotExistException) OrigErr() error {
+	return nil
 }
 
-type DeleteLogSubscriptionOutput struct {
-	_ struct{} `type:"structure"`
+func (s *DirectoryDoesNotExistException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
 }
 
-// String returns the string representation
-func (s DeleteLogSubscriptionOutput) String() string {
-	return awsutil.Prettify(s)
+// Status code returns the HTTP status code for the request's response error.
+func (s *DirectoryDoesNotExistException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// GoString returns the string representation
-func (s DeleteLogSubscriptionOutput) GoString() string {
-	return s.String()
+// RequestID returns the service's response RequestID for request.
+func (s *DirectoryDoesNotExistException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// Contains the inputs for the DeleteSnapshot operation.
-type DeleteSnapshotInput struct {
-	_ struct{} `type:"structure"`
+// The directory is already updated to desired update type settings.
+type DirectoryInDesiredStateException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The identifier of the directory snapshot to be deleted.
-	//
-	// SnapshotId is a required field
-	SnapshotId *string `type:"string" required:"true"`
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
 }
 
-// String returns the string representation
-func (s DeleteSnapshotInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryInDesiredStateException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteSnapshotInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryInDesiredStateException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteSnapshotInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteSnapshotInput"}
-	if s.SnapshotId == nil {
-		invalidParams.Add(request.NewErrParamRequired("SnapshotId"))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
+func newErrorDirectoryInDesiredStateException(v protocol.ResponseMetadata) error {
+	return &DirectoryInDesiredStateException{
+		RespMetadata: v,
 	}
-	return nil
 }
 
-// SetSnapshotId sets the SnapshotId field's value.
-func (s *DeleteSnapshotInput) SetSnapshotId(v string) *DeleteSnapshotInput {
-	s.SnapshotId = &v
-	return s
+// Code returns the exception type name.
+func (s *DirectoryInDesiredStateException) Code() string {
+	return "DirectoryInDesiredStateException"
 }
 
-// Contains the results of the DeleteSnapshot operation.
-type DeleteSnapshotOutput struct {
-	_ struct{} `type:"structure"`
+// Message returns the exception's message.
+func (s *DirectoryInDesiredStateException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
 
-	// The identifier of the directory snapshot that was deleted.
-	SnapshotId *string `type:"string"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *DirectoryInDesiredStateException) OrigErr() error {
+	return nil
 }
 
-// String returns the string representation
-func (s DeleteSnapshotOutput) String() string {
-	return awsutil.Prettify(s)
+func (s *DirectoryInDesiredStateException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
 }
 
-// GoString returns the string representation
-func (s DeleteSnapshotOutput) GoString() string {
-	return s.String()
+// Status code returns the HTTP status code for the request's response error.
+func (s *DirectoryInDesiredStateException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// SetSnapshotId sets the SnapshotId field's value.
-func (s *DeleteSnapshotOutput) SetSnapshotId(v string) *DeleteSnapshotOutput {
-	s.SnapshotId = &v
-	return s
+// RequestID returns the service's response RequestID for request.
+func (s *DirectoryInDesiredStateException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// Deletes the local side of an existing trust relationship between the AWS
-// Managed Microsoft AD directory and the external domain.
-type DeleteTrustInput struct {
-	_ struct{} `type:"structure"`
+// The maximum number of directories in the region has been reached. You can
+// use the GetDirectoryLimits operation to determine your directory limits in
+// the region.
+type DirectoryLimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// Delete a conditional forwarder as part of a DeleteTrustRequest.
-	DeleteAssociatedConditionalForwarder *bool `type:"boolean"`
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
 
-	// The Trust ID of the trust relationship to be deleted.
-	//
-	// TrustId is a required field
-	TrustId *string `type:"string" required:"true"`
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
 }
 
-// String returns the string representation
-func (s DeleteTrustInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryLimitExceededException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteTrustInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryLimitExceededException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteTrustInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteTrustInput"}
-	if s.TrustId == nil {
-		invalidParams.Add(request.NewErrParamRequired("TrustId"))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
+func newErrorDirectoryLimitExceededException(v protocol.ResponseMetadata) error {
+	return &DirectoryLimitExceededException{
+		RespMetadata: v,
 	}
-	return nil
 }
 
-// SetDeleteAssociatedConditionalForwarder sets the DeleteAssociatedConditionalForwarder field's value.
-func (s *DeleteTrustInput) SetDeleteAssociatedConditionalForwarder(v bool) *DeleteTrustInput {
-	s.DeleteAssociatedConditionalForwarder = &v
-	return s
+// Code returns the exception type name.
+func (s *DirectoryLimitExceededException) Code() string {
+	return "DirectoryLimitExceededException"
 }
 
-// SetTrustId sets the TrustId field's value.
-func (s *DeleteTrustInput) SetTrustId(v string) *DeleteTrustInput {
-	s.TrustId = &v
-	return s
+// Message returns the exception's message.
+func (s *DirectoryLimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// The result of a DeleteTrust request.
-type DeleteTrustOutput struct {
-	_ struct{} `type:"structure"`
-
-	// The Trust ID of the trust relationship that was deleted.
-	TrustId *string `type:"string"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *DirectoryLimitExceededException) OrigErr() error {
+	return nil
 }
 
-// String returns the string representation
-func (s DeleteTrustOutput) String() string {
-	return awsutil.Prettify(s)
+func (s *DirectoryLimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
 }
 
-// GoString returns the string representation
-func (s DeleteTrustOutput) GoString() string {
-	return s.String()
+// Status code returns the HTTP status code for the request's response error.
+func (s *DirectoryLimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// SetTrustId sets the TrustId field's value.
-func (s *DeleteTrustOutput) SetTrustId(v string) *DeleteTrustOutput {
-	s.TrustId = &v
-	return s
+// RequestID returns the service's response RequestID for request.
+func (s *DirectoryLimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// Removes the specified directory as a publisher to the specified SNS topic.
-type DeregisterEventTopicInput struct {
+// Contains directory limit information for a Region.
+type DirectoryLimits struct {
 	_ struct{} `type:"structure"`
 
-	// The Directory ID to remove as a publisher. This directory will no longer
-	// send messages to the specified SNS topic.
-	//
-	// DirectoryId is a required field
-	DirectoryId *string `type:"string" required:"true"`
+	// The current number of cloud directories in the Region.
+	CloudOnlyDirectoriesCurrentCount *int64 `type:"integer"`
+
+	// The maximum number of cloud directories allowed in the Region.
+	CloudOnlyDirectoriesLimit *int64 `type:"integer"`
+
+	// Indicates if the cloud directory limit has been reached.
+	CloudOnlyDirectoriesLimitReached *bool `type:"boolean"`
+
+	// The current number of Managed Microsoft AD directories in the region.
+	CloudOnlyMicrosoftADCurrentCount *int64 `type:"integer"`
+
+	// The maximum number of Managed Microsoft AD directories allowed in the region.
+	CloudOnlyMicrosoftADLimit *int64 `type:"integer"`
+
+	// Indicates if the Managed Microsoft AD directory limit has been reached.
+	CloudOnlyMicrosoftADLimitReached *bool `type:"boolean"`
+
+	// The current number of connected directories in the Region.
+	ConnectedDirectoriesCurrentCount *int64 `type:"integer"`
+
+	// The maximum number of connected directories allowed in the Region.
+	ConnectedDirectoriesLimit *int64 `type:"integer"`
 
-	// The name of the SNS topic from which to remove the directory as a publisher.
-	//
-	// TopicName is a required field
-	TopicName *string `min:"1" type:"string" required:"true"`
+	// Indicates if the connected directory limit has been reached.
+	ConnectedDirectoriesLimitReached *bool `type:"boolean"`
 }
 
-// String returns the string representation
-func (s DeregisterEventTopicInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryLimits) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeregisterEventTopicInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryLimits) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeregisterEventTopicInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeregisterEventTopicInput"}
-	if s.DirectoryId == nil {
-		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
-	}
-	if s.TopicName == nil {
-		invalidParams.Add(request.NewErrParamRequired("TopicName"))
-	}
-	if s.TopicName != nil && len(*s.TopicName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("TopicName", 1))
-	}
+// SetCloudOnlyDirectoriesCurrentCount sets the CloudOnlyDirectoriesCurrentCount field's value.
+func (s *DirectoryLimits) SetCloudOnlyDirectoriesCurrentCount(v int64) *DirectoryLimits {
+	s.CloudOnlyDirectoriesCurrentCount = &v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetCloudOnlyDirectoriesLimit sets the CloudOnlyDirectoriesLimit field's value.
+func (s *DirectoryLimits) SetCloudOnlyDirectoriesLimit(v int64) *DirectoryLimits {
+	s.CloudOnlyDirectoriesLimit = &v
+	return s
 }
 
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *DeregisterEventTopicInput) SetDirectoryId(v string) *DeregisterEventTopicInput {
-	s.DirectoryId = &v
+// SetCloudOnlyDirectoriesLimitReached sets the CloudOnlyDirectoriesLimitReached field's value.
+func (s *DirectoryLimits) SetCloudOnlyDirectoriesLimitReached(v bool) *DirectoryLimits {
+	s.CloudOnlyDirectoriesLimitReached = &v
 	return s
 }
 
-// SetTopicName sets the TopicName field's value.
-func (s *DeregisterEventTopicInput) SetTopicName(v string) *DeregisterEventTopicInput {
-	s.TopicName = &v
+// SetCloudOnlyMicrosoftADCurrentCount sets the CloudOnlyMicrosoftADCurrentCount field's value.
+func (s *DirectoryLimits) SetCloudOnlyMicrosoftADCurrentCount(v int64) *DirectoryLimits {
+	s.CloudOnlyMicrosoftADCurrentCount = &v
 	return s
 }
 
-// The result of a DeregisterEventTopic request.
-type DeregisterEventTopicOutput struct {
-	_ struct{} `type:"structure"`
+// SetCloudOnlyMicrosoftADLimit sets the CloudOnlyMicrosoftADLimit field's value.
+func (s *DirectoryLimits) SetCloudOnlyMicrosoftADLimit(v int64) *DirectoryLimits {
+	s.CloudOnlyMicrosoftADLimit = &v
+	return s
 }
 
-// String returns the string representation
-func (s DeregisterEventTopicOutput) String() string {
-	return awsutil.Prettify(s)
+// SetCloudOnlyMicrosoftADLimitReached sets the CloudOnlyMicrosoftADLimitReached field's value.
+func (s *DirectoryLimits) SetCloudOnlyMicrosoftADLimitReached(v bool) *DirectoryLimits {
+	s.CloudOnlyMicrosoftADLimitReached = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s DeregisterEventTopicOutput) GoString() string {
-	return s.String()
+// SetConnectedDirectoriesCurrentCount sets the ConnectedDirectoriesCurrentCount field's value.
+func (s *DirectoryLimits) SetConnectedDirectoriesCurrentCount(v int64) *DirectoryLimits {
+	s.ConnectedDirectoriesCurrentCount = &v
+	return s
 }
 
-// Describes a conditional forwarder.
-type DescribeConditionalForwardersInput struct {
-	_ struct{} `type:"structure"`
+// SetConnectedDirectoriesLimit sets the ConnectedDirectoriesLimit field's value.
+func (s *DirectoryLimits) SetConnectedDirectoriesLimit(v int64) *DirectoryLimits {
+	s.ConnectedDirectoriesLimit = &v
+	return s
+}
 
-	// The directory ID for which to get the list of associated conditional forwarders.
-	//
-	// DirectoryId is a required field
-	DirectoryId *string `type:"string" required:"true"`
+// SetConnectedDirectoriesLimitReached sets the ConnectedDirectoriesLimitReached field's value.
+func (s *DirectoryLimits) SetConnectedDirectoriesLimitReached(v bool) *DirectoryLimits {
+	s.ConnectedDirectoriesLimitReached = &v
+	return s
+}
 
-	// The fully qualified domain names (FQDN) of the remote domains for which to
-	// get the list of associated conditional forwarders. If this member is null,
-	// all conditional forwarders are returned.
-	RemoteDomainNames []*string `type:"list"`
+// The specified directory has not been shared with this Amazon Web Services
+// account.
+type DirectoryNotSharedException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
 }
 
-// String returns the string representation
-func (s DescribeConditionalForwardersInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryNotSharedException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeConditionalForwardersInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryNotSharedException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DescribeConditionalForwardersInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DescribeConditionalForwardersInput"}
-	if s.DirectoryId == nil {
-		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
+func newErrorDirectoryNotSharedException(v protocol.ResponseMetadata) error {
+	return &DirectoryNotSharedException{
+		RespMetadata: v,
 	}
-	return nil
 }
 
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *DescribeConditionalForwardersInput) SetDirectoryId(v string) *DescribeConditionalForwardersInput {
-	s.DirectoryId = &v
-	return s
+// Code returns the exception type name.
+func (s *DirectoryNotSharedException) Code() string {
+	return "DirectoryNotSharedException"
 }
 
-// SetRemoteDomainNames sets the RemoteDomainNames field's value.
-func (s *DescribeConditionalForwardersInput) SetRemoteDomainNames(v []*string) *DescribeConditionalForwardersInput {
-	s.RemoteDomainNames = v
-	return s
+// Message returns the exception's message.
+func (s *DirectoryNotSharedException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// The result of a DescribeConditionalForwarder request.
-type DescribeConditionalForwardersOutput struct {
-	_ struct{} `type:"structure"`
-
-	// The list of conditional forwarders that have been created.
-	ConditionalForwarders []*ConditionalForwarder `type:"list"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *DirectoryNotSharedException) OrigErr() error {
+	return nil
 }
 
-// String returns the string representation
-func (s DescribeConditionalForwardersOutput) String() string {
-	return awsutil.Prettify(s)
+func (s *DirectoryNotSharedException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
 }
 
-// GoString returns the string representation
-func (s DescribeConditionalForwardersOutput) GoString() string {
-	return s.String()
+// Status code returns the HTTP status code for the request's response error.
+func (s *DirectoryNotSharedException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// SetConditionalForwarders sets the ConditionalForwarders field's value.
-func (s *DescribeConditionalForwardersOutput) SetConditionalForwarders(v []*ConditionalForwarder) *DescribeConditionalForwardersOutput {
-	s.ConditionalForwarders = v
-	return s
+// RequestID returns the service's response RequestID for request.
+func (s *DirectoryNotSharedException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// Contains the inputs for the DescribeDirectories operation.
-type DescribeDirectoriesInput struct {
-	_ struct{} `type:"structure"`
-
-	// A list of identifiers of the directories for which to obtain the information.
-	// If this member is null, all directories that belong to the current account
-	// are returned.
-	//
-	// An empty list results in an InvalidParameterException being thrown.
-	DirectoryIds []*string `type:"list"`
+// The specified directory is unavailable or could not be found.
+type DirectoryUnavailableException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The maximum number of items to return. If this value is zero, the maximum
-	// number of items is specified by the limitations of the operation.
-	Limit *int64 `type:"integer"`
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
 
-	// The DescribeDirectoriesResult.NextToken value from a previous call to DescribeDirectories.
-	// Pass null if this is the first call.
-	NextToken *string `type:"string"`
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
 }
 
-// String returns the string representation
-func (s DescribeDirectoriesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryUnavailableException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeDirectoriesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryUnavailableException) GoString() string {
 	return s.String()
 }
 
-// SetDirectoryIds sets the DirectoryIds field's value.
-func (s *DescribeDirectoriesInput) SetDirectoryIds(v []*string) *DescribeDirectoriesInput {
-	s.DirectoryIds = v
-	return s
-}
-
-// SetLimit sets the Limit field's value.
-func (s *DescribeDirectoriesInput) SetLimit(v int64) *DescribeDirectoriesInput {
-	s.Limit = &v
-	return s
+func newErrorDirectoryUnavailableException(v protocol.ResponseMetadata) error {
+	return &DirectoryUnavailableException{
+		RespMetadata: v,
+	}
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *DescribeDirectoriesInput) SetNextToken(v string) *DescribeDirectoriesInput {
-	s.NextToken = &v
-	return s
+// Code returns the exception type name.
+func (s *DirectoryUnavailableException) Code() string {
+	return "DirectoryUnavailableException"
 }
 
-// Contains the results of the DescribeDirectories operation.
-type DescribeDirectoriesOutput struct {
-	_ struct{} `type:"structure"`
-
-	// The list of DirectoryDescription objects that were retrieved.
-	//
-	// It is possible that this list contains less than the number of items specified
-	// in the Limit member of the request. This occurs if there are less than the
-	// requested number of items left to retrieve, or if the limitations of the
-	// operation have been exceeded.
-	DirectoryDescriptions []*DirectoryDescription `type:"list"`
-
-	// If not null, more results are available. Pass this value for the NextToken
-	// parameter in a subsequent call to DescribeDirectories to retrieve the next
-	// set of items.
-	NextToken *string `type:"string"`
+// Message returns the exception's message.
+func (s *DirectoryUnavailableException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// String returns the string representation
-func (s DescribeDirectoriesOutput) String() string {
-	return awsutil.Prettify(s)
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *DirectoryUnavailableException) OrigErr() error {
+	return nil
 }
 
-// GoString returns the string representation
-func (s DescribeDirectoriesOutput) GoString() string {
-	return s.String()
+func (s *DirectoryUnavailableException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
 }
 
-// SetDirectoryDescriptions sets the DirectoryDescriptions field's value.
-func (s *DescribeDirectoriesOutput) SetDirectoryDescriptions(v []*DirectoryDescription) *DescribeDirectoriesOutput {
-	s.DirectoryDescriptions = v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *DirectoryUnavailableException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *DescribeDirectoriesOutput) SetNextToken(v string) *DescribeDirectoriesOutput {
-	s.NextToken = &v
-	return s
+// RequestID returns the service's response RequestID for request.
+func (s *DirectoryUnavailableException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-type DescribeDomainControllersInput struct {
+// Contains VPC information for the CreateDirectory or CreateMicrosoftAD operation.
+type DirectoryVpcSettings struct {
 	_ struct{} `type:"structure"`
 
-	// Identifier of the directory for which to retrieve the domain controller information.
+	// The identifiers of the subnets for the directory servers. The two subnets
+	// must be in different Availability Zones. Directory Service creates a directory
+	// server and a DNS server in each of these subnets.
 	//
-	// DirectoryId is a required field
-	DirectoryId *string `type:"string" required:"true"`
-
-	// A list of identifiers for the domain controllers whose information will be
-	// provided.
-	DomainControllerIds []*string `type:"list"`
-
-	// The maximum number of items to return.
-	Limit *int64 `type:"integer"`
+	// SubnetIds is a required field
+	SubnetIds []*string `type:"list" required:"true"`
 
-	// The DescribeDomainControllers.NextToken value from a previous call to DescribeDomainControllers.
-	// Pass null if this is the first call.
-	NextToken *string `type:"string"`
+	// The identifier of the VPC in which to create the directory.
+	//
+	// VpcId is a required field
+	VpcId *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DescribeDomainControllersInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryVpcSettings) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeDomainControllersInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryVpcSettings) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DescribeDomainControllersInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DescribeDomainControllersInput"}
-	if s.DirectoryId == nil {
-		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+func (s *DirectoryVpcSettings) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DirectoryVpcSettings"}
+	if s.SubnetIds == nil {
+		invalidParams.Add(request.NewErrParamRequired("SubnetIds"))
+	}
+	if s.VpcId == nil {
+		invalidParams.Add(request.NewErrParamRequired("VpcId"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -6890,160 +12964,200 @@ func (s *DescribeDomainControllersInput) Validate() error {
 	return nil
 }
 
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *DescribeDomainControllersInput) SetDirectoryId(v string) *DescribeDomainControllersInput {
-	s.DirectoryId = &v
-	return s
-}
-
-// SetDomainControllerIds sets the DomainControllerIds field's value.
-func (s *DescribeDomainControllersInput) SetDomainControllerIds(v []*string) *DescribeDomainControllersInput {
-	s.DomainControllerIds = v
-	return s
-}
-
-// SetLimit sets the Limit field's value.
-func (s *DescribeDomainControllersInput) SetLimit(v int64) *DescribeDomainControllersInput {
-	s.Limit = &v
+// SetSubnetIds sets the SubnetIds field's value.
+func (s *DirectoryVpcSettings) SetSubnetIds(v []*string) *DirectoryVpcSettings {
+	s.SubnetIds = v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *DescribeDomainControllersInput) SetNextToken(v string) *DescribeDomainControllersInput {
-	s.NextToken = &v
+// SetVpcId sets the VpcId field's value.
+func (s *DirectoryVpcSettings) SetVpcId(v string) *DirectoryVpcSettings {
+	s.VpcId = &v
 	return s
 }
 
-type DescribeDomainControllersOutput struct {
+// Contains information about the directory.
+type DirectoryVpcSettingsDescription struct {
 	_ struct{} `type:"structure"`
 
-	// List of the DomainController objects that were retrieved.
-	DomainControllers []*DomainController `type:"list"`
+	// The list of Availability Zones that the directory is in.
+	AvailabilityZones []*string `type:"list"`
 
-	// If not null, more results are available. Pass this value for the NextToken
-	// parameter in a subsequent call to DescribeDomainControllers retrieve the
-	// next set of items.
-	NextToken *string `type:"string"`
+	// The domain controller security group identifier for the directory.
+	SecurityGroupId *string `type:"string"`
+
+	// The identifiers of the subnets for the directory servers.
+	SubnetIds []*string `type:"list"`
+
+	// The identifier of the VPC that the directory is in.
+	VpcId *string `type:"string"`
 }
 
-// String returns the string representation
-func (s DescribeDomainControllersOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryVpcSettingsDescription) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeDomainControllersOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DirectoryVpcSettingsDescription) GoString() string {
 	return s.String()
 }
 
-// SetDomainControllers sets the DomainControllers field's value.
-func (s *DescribeDomainControllersOutput) SetDomainControllers(v []*DomainController) *DescribeDomainControllersOutput {
-	s.DomainControllers = v
+// SetAvailabilityZones sets the AvailabilityZones field's value.
+func (s *DirectoryVpcSettingsDescription) SetAvailabilityZones(v []*string) *DirectoryVpcSettingsDescription {
+	s.AvailabilityZones = v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *DescribeDomainControllersOutput) SetNextToken(v string) *DescribeDomainControllersOutput {
-	s.NextToken = &v
+// SetSecurityGroupId sets the SecurityGroupId field's value.
+func (s *DirectoryVpcSettingsDescription) SetSecurityGroupId(v string) *DirectoryVpcSettingsDescription {
+	s.SecurityGroupId = &v
 	return s
 }
 
-// Describes event topics.
-type DescribeEventTopicsInput struct {
+// SetSubnetIds sets the SubnetIds field's value.
+func (s *DirectoryVpcSettingsDescription) SetSubnetIds(v []*string) *DirectoryVpcSettingsDescription {
+	s.SubnetIds = v
+	return s
+}
+
+// SetVpcId sets the VpcId field's value.
+func (s *DirectoryVpcSettingsDescription) SetVpcId(v string) *DirectoryVpcSettingsDescription {
+	s.VpcId = &v
+	return s
+}
+
+type DisableClientAuthenticationInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Directory ID for which to get the list of associated SNS topics. If this
-	// member is null, associations for all Directory IDs are returned.
-	DirectoryId *string `type:"string"`
+	// The identifier of the directory
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
 
-	// A list of SNS topic names for which to obtain the information. If this member
-	// is null, all associations for the specified Directory ID are returned.
+	// The type of client authentication to disable. Currently, only the parameter,
+	// SmartCard is supported.
 	//
-	// An empty list results in an InvalidParameterException being thrown.
-	TopicNames []*string `type:"list"`
+	// Type is a required field
+	Type *string `type:"string" required:"true" enum:"ClientAuthenticationType"`
 }
 
-// String returns the string representation
-func (s DescribeEventTopicsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableClientAuthenticationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeEventTopicsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableClientAuthenticationInput) GoString() string {
 	return s.String()
 }
 
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DisableClientAuthenticationInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisableClientAuthenticationInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+	if s.Type == nil {
+		invalidParams.Add(request.NewErrParamRequired("Type"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryId sets the DirectoryId field's value.
-func (s *DescribeEventTopicsInput) SetDirectoryId(v string) *DescribeEventTopicsInput {
+func (s *DisableClientAuthenticationInput) SetDirectoryId(v string) *DisableClientAuthenticationInput {
 	s.DirectoryId = &v
 	return s
 }
 
-// SetTopicNames sets the TopicNames field's value.
-func (s *DescribeEventTopicsInput) SetTopicNames(v []*string) *DescribeEventTopicsInput {
-	s.TopicNames = v
+// SetType sets the Type field's value.
+func (s *DisableClientAuthenticationInput) SetType(v string) *DisableClientAuthenticationInput {
+	s.Type = &v
 	return s
 }
 
-// The result of a DescribeEventTopic request.
-type DescribeEventTopicsOutput struct {
+type DisableClientAuthenticationOutput struct {
 	_ struct{} `type:"structure"`
-
-	// A list of SNS topic names that receive status messages from the specified
-	// Directory ID.
-	EventTopics []*EventTopic `type:"list"`
 }
 
-// String returns the string representation
-func (s DescribeEventTopicsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableClientAuthenticationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeEventTopicsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableClientAuthenticationOutput) GoString() string {
 	return s.String()
 }
 
-// SetEventTopics sets the EventTopics field's value.
-func (s *DescribeEventTopicsOutput) SetEventTopics(v []*EventTopic) *DescribeEventTopicsOutput {
-	s.EventTopics = v
-	return s
-}
-
-type DescribeSharedDirectoriesInput struct {
+type DisableLDAPSInput struct {
 	_ struct{} `type:"structure"`
 
-	// The number of shared directories to return in the response object.
-	Limit *int64 `type:"integer"`
-
-	// The DescribeSharedDirectoriesResult.NextToken value from a previous call
-	// to DescribeSharedDirectories. Pass null if this is the first call.
-	NextToken *string `type:"string"`
-
-	// Returns the identifier of the directory in the directory owner account.
+	// The identifier of the directory.
 	//
-	// OwnerDirectoryId is a required field
-	OwnerDirectoryId *string `type:"string" required:"true"`
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
 
-	// A list of identifiers of all shared directories in your account.
-	SharedDirectoryIds []*string `type:"list"`
+	// The type of LDAP security to enable. Currently only the value Client is supported.
+	//
+	// Type is a required field
+	Type *string `type:"string" required:"true" enum:"LDAPSType"`
 }
 
-// String returns the string representation
-func (s DescribeSharedDirectoriesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableLDAPSInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeSharedDirectoriesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableLDAPSInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DescribeSharedDirectoriesInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DescribeSharedDirectoriesInput"}
-	if s.OwnerDirectoryId == nil {
-		invalidParams.Add(request.NewErrParamRequired("OwnerDirectoryId"))
+func (s *DisableLDAPSInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisableLDAPSInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+	if s.Type == nil {
+		invalidParams.Add(request.NewErrParamRequired("Type"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -7052,893 +13166,1057 @@ func (s *DescribeSharedDirectoriesInput) Validate() error {
 	return nil
 }
 
-// SetLimit sets the Limit field's value.
-func (s *DescribeSharedDirectoriesInput) SetLimit(v int64) *DescribeSharedDirectoriesInput {
-	s.Limit = &v
-	return s
-}
-
-// SetNextToken sets the NextToken field's value.
-func (s *DescribeSharedDirectoriesInput) SetNextToken(v string) *DescribeSharedDirectoriesInput {
-	s.NextToken = &v
-	return s
-}
-
-// SetOwnerDirectoryId sets the OwnerDirectoryId field's value.
-func (s *DescribeSharedDirectoriesInput) SetOwnerDirectoryId(v string) *DescribeSharedDirectoriesInput {
-	s.OwnerDirectoryId = &v
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *DisableLDAPSInput) SetDirectoryId(v string) *DisableLDAPSInput {
+	s.DirectoryId = &v
 	return s
 }
 
-// SetSharedDirectoryIds sets the SharedDirectoryIds field's value.
-func (s *DescribeSharedDirectoriesInput) SetSharedDirectoryIds(v []*string) *DescribeSharedDirectoriesInput {
-	s.SharedDirectoryIds = v
+// SetType sets the Type field's value.
+func (s *DisableLDAPSInput) SetType(v string) *DisableLDAPSInput {
+	s.Type = &v
 	return s
 }
 
-type DescribeSharedDirectoriesOutput struct {
+type DisableLDAPSOutput struct {
 	_ struct{} `type:"structure"`
-
-	// If not null, token that indicates that more results are available. Pass this
-	// value for the NextToken parameter in a subsequent call to DescribeSharedDirectories
-	// to retrieve the next set of items.
-	NextToken *string `type:"string"`
-
-	// A list of all shared directories in your account.
-	SharedDirectories []*SharedDirectory `type:"list"`
 }
 
-// String returns the string representation
-func (s DescribeSharedDirectoriesOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableLDAPSOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeSharedDirectoriesOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableLDAPSOutput) GoString() string {
 	return s.String()
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *DescribeSharedDirectoriesOutput) SetNextToken(v string) *DescribeSharedDirectoriesOutput {
-	s.NextToken = &v
-	return s
-}
-
-// SetSharedDirectories sets the SharedDirectories field's value.
-func (s *DescribeSharedDirectoriesOutput) SetSharedDirectories(v []*SharedDirectory) *DescribeSharedDirectoriesOutput {
-	s.SharedDirectories = v
-	return s
-}
-
-// Contains the inputs for the DescribeSnapshots operation.
-type DescribeSnapshotsInput struct {
+// Contains the inputs for the DisableRadius operation.
+type DisableRadiusInput struct {
 	_ struct{} `type:"structure"`
 
-	// The identifier of the directory for which to retrieve snapshot information.
-	DirectoryId *string `type:"string"`
-
-	// The maximum number of objects to return.
-	Limit *int64 `type:"integer"`
-
-	// The DescribeSnapshotsResult.NextToken value from a previous call to DescribeSnapshots.
-	// Pass null if this is the first call.
-	NextToken *string `type:"string"`
-
-	// A list of identifiers of the snapshots to obtain the information for. If
-	// this member is null or empty, all snapshots are returned using the Limit
-	// and NextToken members.
-	SnapshotIds []*string `type:"list"`
+	// The identifier of the directory for which to disable MFA.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DescribeSnapshotsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableRadiusInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeSnapshotsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableRadiusInput) GoString() string {
 	return s.String()
 }
 
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *DescribeSnapshotsInput) SetDirectoryId(v string) *DescribeSnapshotsInput {
-	s.DirectoryId = &v
-	return s
-}
-
-// SetLimit sets the Limit field's value.
-func (s *DescribeSnapshotsInput) SetLimit(v int64) *DescribeSnapshotsInput {
-	s.Limit = &v
-	return s
-}
-
-// SetNextToken sets the NextToken field's value.
-func (s *DescribeSnapshotsInput) SetNextToken(v string) *DescribeSnapshotsInput {
-	s.NextToken = &v
-	return s
-}
-
-// SetSnapshotIds sets the SnapshotIds field's value.
-func (s *DescribeSnapshotsInput) SetSnapshotIds(v []*string) *DescribeSnapshotsInput {
-	s.SnapshotIds = v
-	return s
-}
-
-// Contains the results of the DescribeSnapshots operation.
-type DescribeSnapshotsOutput struct {
-	_ struct{} `type:"structure"`
-
-	// If not null, more results are available. Pass this value in the NextToken
-	// member of a subsequent call to DescribeSnapshots.
-	NextToken *string `type:"string"`
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DisableRadiusInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisableRadiusInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
 
-	// The list of Snapshot objects that were retrieved.
-	//
-	// It is possible that this list contains less than the number of items specified
-	// in the Limit member of the request. This occurs if there are less than the
-	// requested number of items left to retrieve, or if the limitations of the
-	// operation have been exceeded.
-	Snapshots []*Snapshot `type:"list"`
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// String returns the string representation
-func (s DescribeSnapshotsOutput) String() string {
-	return awsutil.Prettify(s)
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *DisableRadiusInput) SetDirectoryId(v string) *DisableRadiusInput {
+	s.DirectoryId = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s DescribeSnapshotsOutput) GoString() string {
-	return s.String()
+// Contains the results of the DisableRadius operation.
+type DisableRadiusOutput struct {
+	_ struct{} `type:"structure"`
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *DescribeSnapshotsOutput) SetNextToken(v string) *DescribeSnapshotsOutput {
-	s.NextToken = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableRadiusOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetSnapshots sets the Snapshots field's value.
-func (s *DescribeSnapshotsOutput) SetSnapshots(v []*Snapshot) *DescribeSnapshotsOutput {
-	s.Snapshots = v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableRadiusOutput) GoString() string {
+	return s.String()
 }
 
-// Describes the trust relationships for a particular AWS Managed Microsoft
-// AD directory. If no input parameters are are provided, such as directory
-// ID or trust ID, this request describes all the trust relationships.
-type DescribeTrustsInput struct {
+// Contains the inputs for the DisableSso operation.
+type DisableSsoInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Directory ID of the AWS directory that is a part of the requested trust
-	// relationship.
-	DirectoryId *string `type:"string"`
-
-	// The maximum number of objects to return.
-	Limit *int64 `type:"integer"`
+	// The identifier of the directory for which to disable single-sign on.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
 
-	// The DescribeTrustsResult.NextToken value from a previous call to DescribeTrusts.
-	// Pass null if this is the first call.
-	NextToken *string `type:"string"`
+	// The password of an alternate account to use to disable single-sign on. This
+	// is only used for AD Connector directories. For more information, see the
+	// UserName parameter.
+	//
+	// Password is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by DisableSsoInput's
+	// String and GoString methods.
+	Password *string `min:"1" type:"string" sensitive:"true"`
 
-	// A list of identifiers of the trust relationships for which to obtain the
-	// information. If this member is null, all trust relationships that belong
-	// to the current account are returned.
+	// The username of an alternate account to use to disable single-sign on. This
+	// is only used for AD Connector directories. This account must have privileges
+	// to remove a service principal name.
 	//
-	// An empty list results in an InvalidParameterException being thrown.
-	TrustIds []*string `type:"list"`
+	// If the AD Connector service account does not have privileges to remove a
+	// service principal name, you can specify an alternate account with the UserName
+	// and Password parameters. These credentials are only used to disable single
+	// sign-on and are not stored by the service. The AD Connector service account
+	// is not changed.
+	UserName *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s DescribeTrustsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableSsoInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeTrustsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableSsoInput) GoString() string {
 	return s.String()
 }
 
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *DescribeTrustsInput) SetDirectoryId(v string) *DescribeTrustsInput {
-	s.DirectoryId = &v
-	return s
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DisableSsoInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisableSsoInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+	if s.Password != nil && len(*s.Password) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Password", 1))
+	}
+	if s.UserName != nil && len(*s.UserName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("UserName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetLimit sets the Limit field's value.
-func (s *DescribeTrustsInput) SetLimit(v int64) *DescribeTrustsInput {
-	s.Limit = &v
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *DisableSsoInput) SetDirectoryId(v string) *DisableSsoInput {
+	s.DirectoryId = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *DescribeTrustsInput) SetNextToken(v string) *DescribeTrustsInput {
-	s.NextToken = &v
+// SetPassword sets the Password field's value.
+func (s *DisableSsoInput) SetPassword(v string) *DisableSsoInput {
+	s.Password = &v
 	return s
 }
 
-// SetTrustIds sets the TrustIds field's value.
-func (s *DescribeTrustsInput) SetTrustIds(v []*string) *DescribeTrustsInput {
-	s.TrustIds = v
+// SetUserName sets the UserName field's value.
+func (s *DisableSsoInput) SetUserName(v string) *DisableSsoInput {
+	s.UserName = &v
 	return s
 }
 
-// The result of a DescribeTrust request.
-type DescribeTrustsOutput struct {
+// Contains the results of the DisableSso operation.
+type DisableSsoOutput struct {
 	_ struct{} `type:"structure"`
-
-	// If not null, more results are available. Pass this value for the NextToken
-	// parameter in a subsequent call to DescribeTrusts to retrieve the next set
-	// of items.
-	NextToken *string `type:"string"`
-
-	// The list of Trust objects that were retrieved.
-	//
-	// It is possible that this list contains less than the number of items specified
-	// in the Limit member of the request. This occurs if there are less than the
-	// requested number of items left to retrieve, or if the limitations of the
-	// operation have been exceeded.
-	Trusts []*Trust `type:"list"`
 }
 
-// String returns the string representation
-func (s DescribeTrustsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableSsoOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeTrustsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableSsoOutput) GoString() string {
 	return s.String()
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *DescribeTrustsOutput) SetNextToken(v string) *DescribeTrustsOutput {
-	s.NextToken = &v
-	return s
-}
+// Contains information about the domain controllers for a specified directory.
+type DomainController struct {
+	_ struct{} `type:"structure"`
 
-// SetTrusts sets the Trusts field's value.
-func (s *DescribeTrustsOutput) SetTrusts(v []*Trust) *DescribeTrustsOutput {
-	s.Trusts = v
-	return s
-}
+	// The Availability Zone where the domain controller is located.
+	AvailabilityZone *string `type:"string"`
 
-// Contains information for the ConnectDirectory operation when an AD Connector
-// directory is being created.
-type DirectoryConnectSettings struct {
-	_ struct{} `type:"structure"`
+	// Identifier of the directory where the domain controller resides.
+	DirectoryId *string `type:"string"`
 
-	// A list of one or more IP addresses of DNS servers or domain controllers in
-	// the on-premises directory.
-	//
-	// CustomerDnsIps is a required field
-	CustomerDnsIps []*string `type:"list" required:"true"`
+	// The IP address of the domain controller.
+	DnsIpAddr *string `type:"string"`
 
-	// The user name of an account in the on-premises directory that is used to
-	// connect to the directory. This account must have the following permissions:
-	//
-	//    * Read users and groups
-	//
-	//    * Create computer objects
-	//
-	//    * Join computers to the domain
-	//
-	// CustomerUserName is a required field
-	CustomerUserName *string `min:"1" type:"string" required:"true"`
+	// Identifies a specific domain controller in the directory.
+	DomainControllerId *string `type:"string"`
 
-	// A list of subnet identifiers in the VPC in which the AD Connector is created.
-	//
-	// SubnetIds is a required field
-	SubnetIds []*string `type:"list" required:"true"`
+	// Specifies when the domain controller was created.
+	LaunchTime *time.Time `type:"timestamp"`
 
-	// The identifier of the VPC in which the AD Connector is created.
-	//
-	// VpcId is a required field
-	VpcId *string `type:"string" required:"true"`
+	// The status of the domain controller.
+	Status *string `type:"string" enum:"DomainControllerStatus"`
+
+	// The date and time that the status was last updated.
+	StatusLastUpdatedDateTime *time.Time `type:"timestamp"`
+
+	// A description of the domain controller state.
+	StatusReason *string `type:"string"`
+
+	// Identifier of the subnet in the VPC that contains the domain controller.
+	SubnetId *string `type:"string"`
+
+	// The identifier of the VPC that contains the domain controller.
+	VpcId *string `type:"string"`
 }
 
-// String returns the string representation
-func (s DirectoryConnectSettings) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DomainController) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DirectoryConnectSettings) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DomainController) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DirectoryConnectSettings) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DirectoryConnectSettings"}
-	if s.CustomerDnsIps == nil {
-		invalidParams.Add(request.NewErrParamRequired("CustomerDnsIps"))
-	}
-	if s.CustomerUserName == nil {
-		invalidParams.Add(request.NewErrParamRequired("CustomerUserName"))
-	}
-	if s.CustomerUserName != nil && len(*s.CustomerUserName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("CustomerUserName", 1))
-	}
-	if s.SubnetIds == nil {
-		invalidParams.Add(request.NewErrParamRequired("SubnetIds"))
-	}
-	if s.VpcId == nil {
-		invalidParams.Add(request.NewErrParamRequired("VpcId"))
-	}
+// SetAvailabilityZone sets the AvailabilityZone field's value.
+func (s *DomainController) SetAvailabilityZone(v string) *DomainController {
+	s.AvailabilityZone = &v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *DomainController) SetDirectoryId(v string) *DomainController {
+	s.DirectoryId = &v
+	return s
 }
 
-// SetCustomerDnsIps sets the CustomerDnsIps field's value.
-func (s *DirectoryConnectSettings) SetCustomerDnsIps(v []*string) *DirectoryConnectSettings {
-	s.CustomerDnsIps = v
+// SetDnsIpAddr sets the DnsIpAddr field's value.
+func (s *DomainController) SetDnsIpAddr(v string) *DomainController {
+	s.DnsIpAddr = &v
+	return s
+}
+
+// SetDomainControllerId sets the DomainControllerId field's value.
+func (s *DomainController) SetDomainControllerId(v string) *DomainController {
+	s.DomainControllerId = &v
+	return s
+}
+
+// SetLaunchTime sets the LaunchTime field's value.
+func (s *DomainController) SetLaunchTime(v time.Time) *DomainController {
+	s.LaunchTime = &v
+	return s
+}
+
+// SetStatus sets the Status field's value.
+func (s *DomainController) SetStatus(v string) *DomainController {
+	s.Status = &v
+	return s
+}
+
+// SetStatusLastUpdatedDateTime sets the StatusLastUpdatedDateTime field's value.
+func (s *DomainController) SetStatusLastUpdatedDateTime(v time.Time) *DomainController {
+	s.StatusLastUpdatedDateTime = &v
 	return s
 }
 
-// SetCustomerUserName sets the CustomerUserName field's value.
-func (s *DirectoryConnectSettings) SetCustomerUserName(v string) *DirectoryConnectSettings {
-	s.CustomerUserName = &v
+// SetStatusReason sets the StatusReason field's value.
+func (s *DomainController) SetStatusReason(v string) *DomainController {
+	s.StatusReason = &v
 	return s
 }
 
-// SetSubnetIds sets the SubnetIds field's value.
-func (s *DirectoryConnectSettings) SetSubnetIds(v []*string) *DirectoryConnectSettings {
-	s.SubnetIds = v
+// SetSubnetId sets the SubnetId field's value.
+func (s *DomainController) SetSubnetId(v string) *DomainController {
+	s.SubnetId = &v
 	return s
 }
 
 // SetVpcId sets the VpcId field's value.
-func (s *DirectoryConnectSettings) SetVpcId(v string) *DirectoryConnectSettings {
+func (s *DomainController) SetVpcId(v string) *DomainController {
 	s.VpcId = &v
 	return s
 }
 
-// Contains information about an AD Connector directory.
-type DirectoryConnectSettingsDescription struct {
-	_ struct{} `type:"structure"`
-
-	// A list of the Availability Zones that the directory is in.
-	AvailabilityZones []*string `type:"list"`
-
-	// The IP addresses of the AD Connector servers.
-	ConnectIps []*string `type:"list"`
-
-	// The user name of the service account in the on-premises directory.
-	CustomerUserName *string `min:"1" type:"string"`
-
-	// The security group identifier for the AD Connector directory.
-	SecurityGroupId *string `type:"string"`
+// The maximum allowed number of domain controllers per directory was exceeded.
+// The default limit per directory is 20 domain controllers.
+type DomainControllerLimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// A list of subnet identifiers in the VPC that the AD connector is in.
-	SubnetIds []*string `type:"list"`
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
 
-	// The identifier of the VPC that the AD Connector is in.
-	VpcId *string `type:"string"`
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
 }
 
-// String returns the string representation
-func (s DirectoryConnectSettingsDescription) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DomainControllerLimitExceededException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DirectoryConnectSettingsDescription) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DomainControllerLimitExceededException) GoString() string {
 	return s.String()
 }
 
-// SetAvailabilityZones sets the AvailabilityZones field's value.
-func (s *DirectoryConnectSettingsDescription) SetAvailabilityZones(v []*string) *DirectoryConnectSettingsDescription {
-	s.AvailabilityZones = v
-	return s
+func newErrorDomainControllerLimitExceededException(v protocol.ResponseMetadata) error {
+	return &DomainControllerLimitExceededException{
+		RespMetadata: v,
+	}
 }
 
-// SetConnectIps sets the ConnectIps field's value.
-func (s *DirectoryConnectSettingsDescription) SetConnectIps(v []*string) *DirectoryConnectSettingsDescription {
-	s.ConnectIps = v
-	return s
+// Code returns the exception type name.
+func (s *DomainControllerLimitExceededException) Code() string {
+	return "DomainControllerLimitExceededException"
 }
 
-// SetCustomerUserName sets the CustomerUserName field's value.
-func (s *DirectoryConnectSettingsDescription) SetCustomerUserName(v string) *DirectoryConnectSettingsDescription {
-	s.CustomerUserName = &v
-	return s
+// Message returns the exception's message.
+func (s *DomainControllerLimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// SetSecurityGroupId sets the SecurityGroupId field's value.
-func (s *DirectoryConnectSettingsDescription) SetSecurityGroupId(v string) *DirectoryConnectSettingsDescription {
-	s.SecurityGroupId = &v
-	return s
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *DomainControllerLimitExceededException) OrigErr() error {
+	return nil
 }
 
-// SetSubnetIds sets the SubnetIds field's value.
-func (s *DirectoryConnectSettingsDescription) SetSubnetIds(v []*string) *DirectoryConnectSettingsDescription {
-	s.SubnetIds = v
-	return s
+func (s *DomainControllerLimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
 }
 
-// SetVpcId sets the VpcId field's value.
-func (s *DirectoryConnectSettingsDescription) SetVpcId(v string) *DirectoryConnectSettingsDescription {
-	s.VpcId = &v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *DomainControllerLimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// Contains information about an AWS Directory Service directory.
-type DirectoryDescription struct {
-	_ struct{} `type:"structure"`
-
-	// The access URL for the directory, such as http://<alias>.awsapps.com. If
-	// no alias has been created for the directory, <alias> is the directory identifier,
-	// such as d-XXXXXXXXXX.
-	AccessUrl *string `min:"1" type:"string"`
-
-	// The alias for the directory. If no alias has been created for the directory,
-	// the alias is the directory identifier, such as d-XXXXXXXXXX.
-	Alias *string `min:"1" type:"string"`
+// RequestID returns the service's response RequestID for request.
+func (s *DomainControllerLimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// A DirectoryConnectSettingsDescription object that contains additional information
-	// about an AD Connector directory. This member is only present if the directory
-	// is an AD Connector directory.
-	ConnectSettings *DirectoryConnectSettingsDescription `type:"structure"`
+type EnableClientAuthenticationInput struct {
+	_ struct{} `type:"structure"`
 
-	// The textual description for the directory.
-	Description *string `type:"string"`
+	// The identifier of the specified directory.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
 
-	// The desired number of domain controllers in the directory if the directory
-	// is Microsoft AD.
-	DesiredNumberOfDomainControllers *int64 `min:"2" type:"integer"`
+	// The type of client authentication to enable. Currently only the value SmartCard
+	// is supported. Smart card authentication in AD Connector requires that you
+	// enable Kerberos Constrained Delegation for the Service User to the LDAP service
+	// in your self-managed AD.
+	//
+	// Type is a required field
+	Type *string `type:"string" required:"true" enum:"ClientAuthenticationType"`
+}
 
-	// The directory identifier.
-	DirectoryId *string `type:"string"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableClientAuthenticationInput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// The IP addresses of the DNS servers for the directory. For a Simple AD or
-	// Microsoft AD directory, these are the IP addresses of the Simple AD or Microsoft
-	// AD directory servers. For an AD Connector directory, these are the IP addresses
-	// of the DNS servers or domain controllers in the on-premises directory to
-	// which the AD Connector is connected.
-	DnsIpAddrs []*string `type:"list"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableClientAuthenticationInput) GoString() string {
+	return s.String()
+}
 
-	// The edition associated with this directory.
-	Edition *string `type:"string" enum:"DirectoryEdition"`
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *EnableClientAuthenticationInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnableClientAuthenticationInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+	if s.Type == nil {
+		invalidParams.Add(request.NewErrParamRequired("Type"))
+	}
 
-	// Specifies when the directory was created.
-	LaunchTime *time.Time `type:"timestamp"`
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
 
-	// The fully qualified name of the directory.
-	Name *string `type:"string"`
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *EnableClientAuthenticationInput) SetDirectoryId(v string) *EnableClientAuthenticationInput {
+	s.DirectoryId = &v
+	return s
+}
 
-	// Describes the AWS Managed Microsoft AD directory in the directory owner account.
-	OwnerDirectoryDescription *OwnerDirectoryDescription `type:"structure"`
+// SetType sets the Type field's value.
+func (s *EnableClientAuthenticationInput) SetType(v string) *EnableClientAuthenticationInput {
+	s.Type = &v
+	return s
+}
 
-	// A RadiusSettings object that contains information about the RADIUS server
-	// configured for this directory.
-	RadiusSettings *RadiusSettings `type:"structure"`
+type EnableClientAuthenticationOutput struct {
+	_ struct{} `type:"structure"`
+}
 
-	// The status of the RADIUS MFA server connection.
-	RadiusStatus *string `type:"string" enum:"RadiusStatus"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableClientAuthenticationOutput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// The method used when sharing a directory to determine whether the directory
-	// should be shared within your AWS organization (ORGANIZATIONS) or with any
-	// AWS account by sending a shared directory request (HANDSHAKE).
-	ShareMethod *string `type:"string" enum:"ShareMethod"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableClientAuthenticationOutput) GoString() string {
+	return s.String()
+}
 
-	// A directory share request that is sent by the directory owner to the directory
-	// consumer. The request includes a typed message to help the directory consumer
-	// administrator determine whether to approve or reject the share invitation.
-	ShareNotes *string `type:"string" sensitive:"true"`
+type EnableLDAPSInput struct {
+	_ struct{} `type:"structure"`
 
-	// Current directory status of the shared AWS Managed Microsoft AD directory.
-	ShareStatus *string `type:"string" enum:"ShareStatus"`
+	// The identifier of the directory.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
 
-	// The short name of the directory.
-	ShortName *string `type:"string"`
+	// The type of LDAP security to enable. Currently only the value Client is supported.
+	//
+	// Type is a required field
+	Type *string `type:"string" required:"true" enum:"LDAPSType"`
+}
 
-	// The directory size.
-	Size *string `type:"string" enum:"DirectorySize"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableLDAPSInput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// Indicates if single sign-on is enabled for the directory. For more information,
-	// see EnableSso and DisableSso.
-	SsoEnabled *bool `type:"boolean"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableLDAPSInput) GoString() string {
+	return s.String()
+}
 
-	// The current stage of the directory.
-	Stage *string `type:"string" enum:"DirectoryStage"`
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *EnableLDAPSInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnableLDAPSInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+	if s.Type == nil {
+		invalidParams.Add(request.NewErrParamRequired("Type"))
+	}
 
-	// The date and time that the stage was last updated.
-	StageLastUpdatedDateTime *time.Time `type:"timestamp"`
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
 
-	// Additional information about the directory stage.
-	StageReason *string `type:"string"`
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *EnableLDAPSInput) SetDirectoryId(v string) *EnableLDAPSInput {
+	s.DirectoryId = &v
+	return s
+}
 
-	// The directory size.
-	Type *string `type:"string" enum:"DirectoryType"`
+// SetType sets the Type field's value.
+func (s *EnableLDAPSInput) SetType(v string) *EnableLDAPSInput {
+	s.Type = &v
+	return s
+}
 
-	// A DirectoryVpcSettingsDescription object that contains additional information
-	// about a directory. This member is only present if the directory is a Simple
-	// AD or Managed AD directory.
-	VpcSettings *DirectoryVpcSettingsDescription `type:"structure"`
+type EnableLDAPSOutput struct {
+	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
-func (s DirectoryDescription) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableLDAPSOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DirectoryDescription) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableLDAPSOutput) GoString() string {
 	return s.String()
 }
 
-// SetAccessUrl sets the AccessUrl field's value.
-func (s *DirectoryDescription) SetAccessUrl(v string) *DirectoryDescription {
-	s.AccessUrl = &v
-	return s
-}
+// Contains the inputs for the EnableRadius operation.
+type EnableRadiusInput struct {
+	_ struct{} `type:"structure"`
 
-// SetAlias sets the Alias field's value.
-func (s *DirectoryDescription) SetAlias(v string) *DirectoryDescription {
-	s.Alias = &v
-	return s
+	// The identifier of the directory for which to enable MFA.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
+
+	// A RadiusSettings object that contains information about the RADIUS server.
+	//
+	// RadiusSettings is a required field
+	RadiusSettings *RadiusSettings `type:"structure" required:"true"`
 }
 
-// SetConnectSettings sets the ConnectSettings field's value.
-func (s *DirectoryDescription) SetConnectSettings(v *DirectoryConnectSettingsDescription) *DirectoryDescription {
-	s.ConnectSettings = v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableRadiusInput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetDescription sets the Description field's value.
-func (s *DirectoryDescription) SetDescription(v string) *DirectoryDescription {
-	s.Description = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableRadiusInput) GoString() string {
+	return s.String()
 }
 
-// SetDesiredNumberOfDomainControllers sets the DesiredNumberOfDomainControllers field's value.
-func (s *DirectoryDescription) SetDesiredNumberOfDomainControllers(v int64) *DirectoryDescription {
-	s.DesiredNumberOfDomainControllers = &v
-	return s
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *EnableRadiusInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnableRadiusInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+	if s.RadiusSettings == nil {
+		invalidParams.Add(request.NewErrParamRequired("RadiusSettings"))
+	}
+	if s.RadiusSettings != nil {
+		if err := s.RadiusSettings.Validate(); err != nil {
+			invalidParams.AddNested("RadiusSettings", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
 // SetDirectoryId sets the DirectoryId field's value.
-func (s *DirectoryDescription) SetDirectoryId(v string) *DirectoryDescription {
+func (s *EnableRadiusInput) SetDirectoryId(v string) *EnableRadiusInput {
 	s.DirectoryId = &v
 	return s
 }
 
-// SetDnsIpAddrs sets the DnsIpAddrs field's value.
-func (s *DirectoryDescription) SetDnsIpAddrs(v []*string) *DirectoryDescription {
-	s.DnsIpAddrs = v
+// SetRadiusSettings sets the RadiusSettings field's value.
+func (s *EnableRadiusInput) SetRadiusSettings(v *RadiusSettings) *EnableRadiusInput {
+	s.RadiusSettings = v
 	return s
 }
 
-// SetEdition sets the Edition field's value.
-func (s *DirectoryDescription) SetEdition(v string) *DirectoryDescription {
-	s.Edition = &v
-	return s
+// Contains the results of the EnableRadius operation.
+type EnableRadiusOutput struct {
+	_ struct{} `type:"structure"`
 }
 
-// SetLaunchTime sets the LaunchTime field's value.
-func (s *DirectoryDescription) SetLaunchTime(v time.Time) *DirectoryDescription {
-	s.LaunchTime = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableRadiusOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetName sets the Name field's value.
-func (s *DirectoryDescription) SetName(v string) *DirectoryDescription {
-	s.Name = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableRadiusOutput) GoString() string {
+	return s.String()
 }
 
-// SetOwnerDirectoryDescription sets the OwnerDirectoryDescription field's value.
-func (s *DirectoryDescription) SetOwnerDirectoryDescription(v *OwnerDirectoryDescription) *DirectoryDescription {
-	s.OwnerDirectoryDescription = v
-	return s
-}
+// Contains the inputs for the EnableSso operation.
+type EnableSsoInput struct {
+	_ struct{} `type:"structure"`
 
-// SetRadiusSettings sets the RadiusSettings field's value.
-func (s *DirectoryDescription) SetRadiusSettings(v *RadiusSettings) *DirectoryDescription {
-	s.RadiusSettings = v
-	return s
+	// The identifier of the directory for which to enable single-sign on.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
+
+	// The password of an alternate account to use to enable single-sign on. This
+	// is only used for AD Connector directories. For more information, see the
+	// UserName parameter.
+	//
+	// Password is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by EnableSsoInput's
+	// String and GoString methods.
+	Password *string `min:"1" type:"string" sensitive:"true"`
+
+	// The username of an alternate account to use to enable single-sign on. This
+	// is only used for AD Connector directories. This account must have privileges
+	// to add a service principal name.
+	//
+	// If the AD Connector service account does not have privileges to add a service
+	// principal name, you can specify an alternate account with the UserName and
+	// Password parameters. These credentials are only used to enable single sign-on
+	// and are not stored by the service. The AD Connector service account is not
+	// changed.
+	UserName *string `min:"1" type:"string"`
 }
 
-// SetRadiusStatus sets the RadiusStatus field's value.
-func (s *DirectoryDescription) SetRadiusStatus(v string) *DirectoryDescription {
-	s.RadiusStatus = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableSsoInput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetShareMethod sets the ShareMethod field's value.
-func (s *DirectoryDescription) SetShareMethod(v string) *DirectoryDescription {
-	s.ShareMethod = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableSsoInput) GoString() string {
+	return s.String()
 }
 
-// SetShareNotes sets the ShareNotes field's value.
-func (s *DirectoryDescription) SetShareNotes(v string) *DirectoryDescription {
-	s.ShareNotes = &v
-	return s
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *EnableSsoInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnableSsoInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+	if s.Password != nil && len(*s.Password) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Password", 1))
+	}
+	if s.UserName != nil && len(*s.UserName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("UserName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetShareStatus sets the ShareStatus field's value.
-func (s *DirectoryDescription) SetShareStatus(v string) *DirectoryDescription {
-	s.ShareStatus = &v
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *EnableSsoInput) SetDirectoryId(v string) *EnableSsoInput {
+	s.DirectoryId = &v
 	return s
 }
 
-// SetShortName sets the ShortName field's value.
-func (s *DirectoryDescription) SetShortName(v string) *DirectoryDescription {
-	s.ShortName = &v
+// SetPassword sets the Password field's value.
+func (s *EnableSsoInput) SetPassword(v string) *EnableSsoInput {
+	s.Password = &v
 	return s
 }
 
-// SetSize sets the Size field's value.
-func (s *DirectoryDescription) SetSize(v string) *DirectoryDescription {
-	s.Size = &v
+// SetUserName sets the UserName field's value.
+func (s *EnableSsoInput) SetUserName(v string) *EnableSsoInput {
+	s.UserName = &v
 	return s
 }
 
-// SetSsoEnabled sets the SsoEnabled field's value.
-func (s *DirectoryDescription) SetSsoEnabled(v bool) *DirectoryDescription {
-	s.SsoEnabled = &v
-	return s
+// Contains the results of the EnableSso operation.
+type EnableSsoOutput struct {
+	_ struct{} `type:"structure"`
 }
 
-// SetStage sets the Stage field's value.
-func (s *DirectoryDescription) SetStage(v string) *DirectoryDescription {
-	s.Stage = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableSsoOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetStageLastUpdatedDateTime sets the StageLastUpdatedDateTime field's value.
-func (s *DirectoryDescription) SetStageLastUpdatedDateTime(v time.Time) *DirectoryDescription {
-	s.StageLastUpdatedDateTime = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableSsoOutput) GoString() string {
+	return s.String()
 }
 
-// SetStageReason sets the StageReason field's value.
-func (s *DirectoryDescription) SetStageReason(v string) *DirectoryDescription {
-	s.StageReason = &v
-	return s
+// The specified entity already exists.
+type EntityAlreadyExistsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
 }
 
-// SetType sets the Type field's value.
-func (s *DirectoryDescription) SetType(v string) *DirectoryDescription {
-	s.Type = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EntityAlreadyExistsException) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetVpcSettings sets the VpcSettings field's value.
-func (s *DirectoryDescription) SetVpcSettings(v *DirectoryVpcSettingsDescription) *DirectoryDescription {
-	s.VpcSettings = v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EntityAlreadyExistsException) GoString() string {
+	return s.String()
 }
 
-// Contains directory limit information for a region.
-type DirectoryLimits struct {
-	_ struct{} `type:"structure"`
+func newErrorEntityAlreadyExistsException(v protocol.ResponseMetadata) error {
+	return &EntityAlreadyExistsException{
+		RespMetadata: v,
+	}
+}
 
-	// The current number of cloud directories in the region.
-	CloudOnlyDirectoriesCurrentCount *int64 `type:"integer"`
+// Code returns the exception type name.
+func (s *EntityAlreadyExistsException) Code() string {
+	return "EntityAlreadyExistsException"
+}
 
-	// The maximum number of cloud directories allowed in the region.
-	CloudOnlyDirectoriesLimit *int64 `type:"integer"`
+// Message returns the exception's message.
+func (s *EntityAlreadyExistsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
 
-	// Indicates if the cloud directory limit has been reached.
-	CloudOnlyDirectoriesLimitReached *bool `type:"boolean"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *EntityAlreadyExistsException) OrigErr() error {
+	return nil
+}
 
-	// The current number of AWS Managed Microsoft AD directories in the region.
-	CloudOnlyMicrosoftADCurrentCount *int64 `type:"integer"`
+func (s *EntityAlreadyExistsException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
 
-	// The maximum number of AWS Managed Microsoft AD directories allowed in the
-	// region.
-	CloudOnlyMicrosoftADLimit *int64 `type:"integer"`
+// Status code returns the HTTP status code for the request's response error.
+func (s *EntityAlreadyExistsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
 
-	// Indicates if the AWS Managed Microsoft AD directory limit has been reached.
-	CloudOnlyMicrosoftADLimitReached *bool `type:"boolean"`
+// RequestID returns the service's response RequestID for request.
+func (s *EntityAlreadyExistsException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// The current number of connected directories in the region.
-	ConnectedDirectoriesCurrentCount *int64 `type:"integer"`
+// The specified entity could not be found.
+type EntityDoesNotExistException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The maximum number of connected directories allowed in the region.
-	ConnectedDirectoriesLimit *int64 `type:"integer"`
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
 
-	// Indicates if the connected directory limit has been reached.
-	ConnectedDirectoriesLimitReached *bool `type:"boolean"`
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
 }
 
-// String returns the string representation
-func (s DirectoryLimits) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EntityDoesNotExistException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DirectoryLimits) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EntityDoesNotExistException) GoString() string {
 	return s.String()
 }
 
-// SetCloudOnlyDirectoriesCurrentCount sets the CloudOnlyDirectoriesCurrentCount field's value.
-func (s *DirectoryLimits) SetCloudOnlyDirectoriesCurrentCount(v int64) *DirectoryLimits {
-	s.CloudOnlyDirectoriesCurrentCount = &v
-	return s
-}
-
-// SetCloudOnlyDirectoriesLimit sets the CloudOnlyDirectoriesLimit field's value.
-func (s *DirectoryLimits) SetCloudOnlyDirectoriesLimit(v int64) *DirectoryLimits {
-	s.CloudOnlyDirectoriesLimit = &v
-	return s
-}
-
-// SetCloudOnlyDirectoriesLimitReached sets the CloudOnlyDirectoriesLimitReached field's value.
-func (s *DirectoryLimits) SetCloudOnlyDirectoriesLimitReached(v bool) *DirectoryLimits {
-	s.CloudOnlyDirectoriesLimitReached = &v
-	return s
+func newErrorEntityDoesNotExistException(v protocol.ResponseMetadata) error {
+	return &EntityDoesNotExistException{
+		RespMetadata: v,
+	}
 }
 
-// SetCloudOnlyMicrosoftADCurrentCount sets the CloudOnlyMicrosoftADCurrentCount field's value.
-func (s *DirectoryLimits) SetCloudOnlyMicrosoftADCurrentCount(v int64) *DirectoryLimits {
-	s.CloudOnlyMicrosoftADCurrentCount = &v
-	return s
+// Code returns the exception type name.
+func (s *EntityDoesNotExistException) Code() string {
+	return "EntityDoesNotExistException"
 }
 
-// SetCloudOnlyMicrosoftADLimit sets the CloudOnlyMicrosoftADLimit field's value.
-func (s *DirectoryLimits) SetCloudOnlyMicrosoftADLimit(v int64) *DirectoryLimits {
-	s.CloudOnlyMicrosoftADLimit = &v
-	return s
+// Message returns the exception's message.
+func (s *EntityDoesNotExistException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// SetCloudOnlyMicrosoftADLimitReached sets the CloudOnlyMicrosoftADLimitReached field's value.
-func (s *DirectoryLimits) SetCloudOnlyMicrosoftADLimitReached(v bool) *DirectoryLimits {
-	s.CloudOnlyMicrosoftADLimitReached = &v
-	return s
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *EntityDoesNotExistException) OrigErr() error {
+	return nil
 }
 
-// SetConnectedDirectoriesCurrentCount sets the ConnectedDirectoriesCurrentCount field's value.
-func (s *DirectoryLimits) SetConnectedDirectoriesCurrentCount(v int64) *DirectoryLimits {
-	s.ConnectedDirectoriesCurrentCount = &v
-	return s
+func (s *EntityDoesNotExistException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
 }
 
-// SetConnectedDirectoriesLimit sets the ConnectedDirectoriesLimit field's value.
-func (s *DirectoryLimits) SetConnectedDirectoriesLimit(v int64) *DirectoryLimits {
-	s.ConnectedDirectoriesLimit = &v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *EntityDoesNotExistException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// SetConnectedDirectoriesLimitReached sets the ConnectedDirectoriesLimitReached field's value.
-func (s *DirectoryLimits) SetConnectedDirectoriesLimitReached(v bool) *DirectoryLimits {
-	s.ConnectedDirectoriesLimitReached = &v
-	return s
+// RequestID returns the service's response RequestID for request.
+func (s *EntityDoesNotExistException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// Contains VPC information for the CreateDirectory or CreateMicrosoftAD operation.
-type DirectoryVpcSettings struct {
+// Information about Amazon SNS topic and Directory Service directory associations.
+type EventTopic struct {
 	_ struct{} `type:"structure"`
 
-	// The identifiers of the subnets for the directory servers. The two subnets
-	// must be in different Availability Zones. AWS Directory Service creates a
-	// directory server and a DNS server in each of these subnets.
-	//
-	// SubnetIds is a required field
-	SubnetIds []*string `type:"list" required:"true"`
+	// The date and time of when you associated your directory with the Amazon SNS
+	// topic.
+	CreatedDateTime *time.Time `type:"timestamp"`
 
-	// The identifier of the VPC in which to create the directory.
-	//
-	// VpcId is a required field
-	VpcId *string `type:"string" required:"true"`
+	// The Directory ID of an Directory Service directory that will publish status
+	// messages to an Amazon SNS topic.
+	DirectoryId *string `type:"string"`
+
+	// The topic registration status.
+	Status *string `type:"string" enum:"TopicStatus"`
+
+	// The Amazon SNS topic ARN (Amazon Resource Name).
+	TopicArn *string `type:"string"`
+
+	// The name of an Amazon SNS topic the receives status messages from the directory.
+	TopicName *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s DirectoryVpcSettings) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EventTopic) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DirectoryVpcSettings) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EventTopic) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DirectoryVpcSettings) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DirectoryVpcSettings"}
-	if s.SubnetIds == nil {
-		invalidParams.Add(request.NewErrParamRequired("SubnetIds"))
-	}
-	if s.VpcId == nil {
-		invalidParams.Add(request.NewErrParamRequired("VpcId"))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetCreatedDateTime sets the CreatedDateTime field's value.
+func (s *EventTopic) SetCreatedDateTime(v time.Time) *EventTopic {
+	s.CreatedDateTime = &v
+	return s
 }
 
-// SetSubnetIds sets the SubnetIds field's value.
-func (s *DirectoryVpcSettings) SetSubnetIds(v []*string) *DirectoryVpcSettings {
-	s.SubnetIds = v
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *EventTopic) SetDirectoryId(v string) *EventTopic {
+	s.DirectoryId = &v
 	return s
 }
 
-// SetVpcId sets the VpcId field's value.
-func (s *DirectoryVpcSettings) SetVpcId(v string) *DirectoryVpcSettings {
-	s.VpcId = &v
+// SetStatus sets the Status field's value.
+func (s *EventTopic) SetStatus(v string) *EventTopic {
+	s.Status = &v
 	return s
 }
 
-// Contains information about the directory.
-type DirectoryVpcSettingsDescription struct {
-	_ struct{} `type:"structure"`
-
-	// The list of Availability Zones that the directory is in.
-	AvailabilityZones []*string `type:"list"`
-
-	// The domain controller security group identifier for the directory.
-	SecurityGroupId *string `type:"string"`
+// SetTopicArn sets the TopicArn field's value.
+func (s *EventTopic) SetTopicArn(v string) *EventTopic {
+	s.TopicArn = &v
+	return s
+}
 
-	// The identifiers of the subnets for the directory servers.
-	SubnetIds []*string `type:"list"`
+// SetTopicName sets the TopicName field's value.
+func (s *EventTopic) SetTopicName(v string) *EventTopic {
+	s.TopicName = &v
+	return s
+}
 
-	// The identifier of the VPC that the directory is in.
-	VpcId *string `type:"string"`
+// Contains the inputs for the GetDirectoryLimits operation.
+type GetDirectoryLimitsInput struct {
+	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
-func (s DirectoryVpcSettingsDescription) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDirectoryLimitsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DirectoryVpcSettingsDescription) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDirectoryLimitsInput) GoString() string {
 	return s.String()
 }
 
-// SetAvailabilityZones sets the AvailabilityZones field's value.
-func (s *DirectoryVpcSettingsDescription) SetAvailabilityZones(v []*string) *DirectoryVpcSettingsDescription {
-	s.AvailabilityZones = v
-	return s
+// Contains the results of the GetDirectoryLimits operation.
+type GetDirectoryLimitsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A DirectoryLimits object that contains the directory limits for the current
+	// Region.
+	DirectoryLimits *DirectoryLimits `type:"structure"`
 }
 
-// SetSecurityGroupId sets the SecurityGroupId field's value.
-func (s *DirectoryVpcSettingsDescription) SetSecurityGroupId(v string) *DirectoryVpcSettingsDescription {
-	s.SecurityGroupId = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDirectoryLimitsOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetSubnetIds sets the SubnetIds field's value.
-func (s *DirectoryVpcSettingsDescription) SetSubnetIds(v []*string) *DirectoryVpcSettingsDescription {
-	s.SubnetIds = v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDirectoryLimitsOutput) GoString() string {
+	return s.String()
 }
 
-// SetVpcId sets the VpcId field's value.
-func (s *DirectoryVpcSettingsDescription) SetVpcId(v string) *DirectoryVpcSettingsDescription {
-	s.VpcId = &v
+// SetDirectoryLimits sets the DirectoryLimits field's value.
+func (s *GetDirectoryLimitsOutput) SetDirectoryLimits(v *DirectoryLimits) *GetDirectoryLimitsOutput {
+	s.DirectoryLimits = v
 	return s
 }
 
-// Contains the inputs for the DisableRadius operation.
-type DisableRadiusInput struct {
+// Contains the inputs for the GetSnapshotLimits operation.
+type GetSnapshotLimitsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The identifier of the directory for which to disable MFA.
+	// Contains the identifier of the directory to obtain the limits for.
 	//
 	// DirectoryId is a required field
 	DirectoryId *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DisableRadiusInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSnapshotLimitsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DisableRadiusInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSnapshotLimitsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DisableRadiusInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DisableRadiusInput"}
+func (s *GetSnapshotLimitsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetSnapshotLimitsInput"}
 	if s.DirectoryId == nil {
 		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
 	}
@@ -7950,552 +14228,665 @@ func (s *DisableRadiusInput) Validate() error {
 }
 
 // SetDirectoryId sets the DirectoryId field's value.
-func (s *DisableRadiusInput) SetDirectoryId(v string) *DisableRadiusInput {
+func (s *GetSnapshotLimitsInput) SetDirectoryId(v string) *GetSnapshotLimitsInput {
 	s.DirectoryId = &v
 	return s
 }
 
-// Contains the results of the DisableRadius operation.
-type DisableRadiusOutput struct {
+// Contains the results of the GetSnapshotLimits operation.
+type GetSnapshotLimitsOutput struct {
 	_ struct{} `type:"structure"`
+
+	// A SnapshotLimits object that contains the manual snapshot limits for the
+	// specified directory.
+	SnapshotLimits *SnapshotLimits `type:"structure"`
 }
 
-// String returns the string representation
-func (s DisableRadiusOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSnapshotLimitsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DisableRadiusOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSnapshotLimitsOutput) GoString() string {
 	return s.String()
 }
 
-// Contains the inputs for the DisableSso operation.
-type DisableSsoInput struct {
-	_ struct{} `type:"structure"`
+// SetSnapshotLimits sets the SnapshotLimits field's value.
+func (s *GetSnapshotLimitsOutput) SetSnapshotLimits(v *SnapshotLimits) *GetSnapshotLimitsOutput {
+	s.SnapshotLimits = v
+	return s
+}
 
-	// The identifier of the directory for which to disable single-sign on.
-	//
-	// DirectoryId is a required field
-	DirectoryId *string `type:"string" required:"true"`
+// The specified directory setting is not compatible with other settings.
+type IncompatibleSettingsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The password of an alternate account to use to disable single-sign on. This
-	// is only used for AD Connector directories. For more information, see the
-	// UserName parameter.
-	Password *string `min:"1" type:"string" sensitive:"true"`
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
 
-	// The username of an alternate account to use to disable single-sign on. This
-	// is only used for AD Connector directories. This account must have privileges
-	// to remove a service principal name.
-	//
-	// If the AD Connector service account does not have privileges to remove a
-	// service principal name, you can specify an alternate account with the UserName
-	// and Password parameters. These credentials are only used to disable single
-	// sign-on and are not stored by the service. The AD Connector service account
-	// is not changed.
-	UserName *string `min:"1" type:"string"`
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
 }
 
-// String returns the string representation
-func (s DisableSsoInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IncompatibleSettingsException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DisableSsoInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IncompatibleSettingsException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DisableSsoInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DisableSsoInput"}
-	if s.DirectoryId == nil {
-		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
-	}
-	if s.Password != nil && len(*s.Password) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Password", 1))
-	}
-	if s.UserName != nil && len(*s.UserName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("UserName", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
+func newErrorIncompatibleSettingsException(v protocol.ResponseMetadata) error {
+	return &IncompatibleSettingsException{
+		RespMetadata: v,
 	}
-	return nil
 }
 
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *DisableSsoInput) SetDirectoryId(v string) *DisableSsoInput {
-	s.DirectoryId = &v
-	return s
+// Code returns the exception type name.
+func (s *IncompatibleSettingsException) Code() string {
+	return "IncompatibleSettingsException"
 }
 
-// SetPassword sets the Password field's value.
-func (s *DisableSsoInput) SetPassword(v string) *DisableSsoInput {
-	s.Password = &v
-	return s
+// Message returns the exception's message.
+func (s *IncompatibleSettingsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// SetUserName sets the UserName field's value.
-func (s *DisableSsoInput) SetUserName(v string) *DisableSsoInput {
-	s.UserName = &v
-	return s
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *IncompatibleSettingsException) OrigErr() error {
+	return nil
 }
 
-// Contains the results of the DisableSso operation.
-type DisableSsoOutput struct {
-	_ struct{} `type:"structure"`
+func (s *IncompatibleSettingsException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
 }
 
-// String returns the string representation
-func (s DisableSsoOutput) String() string {
-	return awsutil.Prettify(s)
+// Status code returns the HTTP status code for the request's response error.
+func (s *IncompatibleSettingsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// GoString returns the string representation
-func (s DisableSsoOutput) GoString() string {
-	return s.String()
+// RequestID returns the service's response RequestID for request.
+func (s *IncompatibleSettingsException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// Contains information about the domain controllers for a specified directory.
-type DomainController struct {
-	_ struct{} `type:"structure"`
-
-	// The Availability Zone where the domain controller is located.
-	AvailabilityZone *string `type:"string"`
-
-	// Identifier of the directory where the domain controller resides.
-	DirectoryId *string `type:"string"`
+// The account does not have sufficient permission to perform the operation.
+type InsufficientPermissionsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The IP address of the domain controller.
-	DnsIpAddr *string `type:"string"`
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
 
-	// Identifies a specific domain controller in the directory.
-	DomainControllerId *string `type:"string"`
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
+}
 
-	// Specifies when the domain controller was created.
-	LaunchTime *time.Time `type:"timestamp"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InsufficientPermissionsException) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// The status of the domain controller.
-	Status *string `type:"string" enum:"DomainControllerStatus"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InsufficientPermissionsException) GoString() string {
+	return s.String()
+}
 
-	// The date and time that the status was last updated.
-	StatusLastUpdatedDateTime *time.Time `type:"timestamp"`
+func newErrorInsufficientPermissionsException(v protocol.ResponseMetadata) error {
+	return &InsufficientPermissionsException{
+		RespMetadata: v,
+	}
+}
 
-	// A description of the domain controller state.
-	StatusReason *string `type:"string"`
+// Code returns the exception type name.
+func (s *InsufficientPermissionsException) Code() string {
+	return "InsufficientPermissionsException"
+}
 
-	// Identifier of the subnet in the VPC that contains the domain controller.
-	SubnetId *string `type:"string"`
+// Message returns the exception's message.
+func (s *InsufficientPermissionsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
 
-	// The identifier of the VPC that contains the domain controller.
-	VpcId *string `type:"string"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InsufficientPermissionsException) OrigErr() error {
+	return nil
 }
 
-// String returns the string representation
-func (s DomainController) String() string {
-	return awsutil.Prettify(s)
+func (s *InsufficientPermissionsException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
 }
 
-// GoString returns the string representation
-func (s DomainController) GoString() string {
-	return s.String()
+// Status code returns the HTTP status code for the request's response error.
+func (s *InsufficientPermissionsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// SetAvailabilityZone sets the AvailabilityZone field's value.
-func (s *DomainController) SetAvailabilityZone(v string) *DomainController {
-	s.AvailabilityZone = &v
-	return s
+// RequestID returns the service's response RequestID for request.
+func (s *InsufficientPermissionsException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *DomainController) SetDirectoryId(v string) *DomainController {
-	s.DirectoryId = &v
-	return s
+// The certificate PEM that was provided has incorrect encoding.
+type InvalidCertificateException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
 }
 
-// SetDnsIpAddr sets the DnsIpAddr field's value.
-func (s *DomainController) SetDnsIpAddr(v string) *DomainController {
-	s.DnsIpAddr = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidCertificateException) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetDomainControllerId sets the DomainControllerId field's value.
-func (s *DomainController) SetDomainControllerId(v string) *DomainController {
-	s.DomainControllerId = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidCertificateException) GoString() string {
+	return s.String()
 }
 
-// SetLaunchTime sets the LaunchTime field's value.
-func (s *DomainController) SetLaunchTime(v time.Time) *DomainController {
-	s.LaunchTime = &v
-	return s
+func newErrorInvalidCertificateException(v protocol.ResponseMetadata) error {
+	return &InvalidCertificateException{
+		RespMetadata: v,
+	}
 }
 
-// SetStatus sets the Status field's value.
-func (s *DomainController) SetStatus(v string) *DomainController {
-	s.Status = &v
-	return s
+// Code returns the exception type name.
+func (s *InvalidCertificateException) Code() string {
+	return "InvalidCertificateException"
 }
 
-// SetStatusLastUpdatedDateTime sets the StatusLastUpdatedDateTime field's value.
-func (s *DomainController) SetStatusLastUpdatedDateTime(v time.Time) *DomainController {
-	s.StatusLastUpdatedDateTime = &v
-	return s
+// Message returns the exception's message.
+func (s *InvalidCertificateException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// SetStatusReason sets the StatusReason field's value.
-func (s *DomainController) SetStatusReason(v string) *DomainController {
-	s.StatusReason = &v
-	return s
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidCertificateException) OrigErr() error {
+	return nil
 }
 
-// SetSubnetId sets the SubnetId field's value.
-func (s *DomainController) SetSubnetId(v string) *DomainController {
-	s.SubnetId = &v
-	return s
+func (s *InvalidCertificateException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
 }
 
-// SetVpcId sets the VpcId field's value.
-func (s *DomainController) SetVpcId(v string) *DomainController {
-	s.VpcId = &v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidCertificateException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// Contains the inputs for the EnableRadius operation.
-type EnableRadiusInput struct {
-	_ struct{} `type:"structure"`
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidCertificateException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// The identifier of the directory for which to enable MFA.
-	//
-	// DirectoryId is a required field
-	DirectoryId *string `type:"string" required:"true"`
+// Client authentication is already enabled.
+type InvalidClientAuthStatusException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// A RadiusSettings object that contains information about the RADIUS server.
-	//
-	// RadiusSettings is a required field
-	RadiusSettings *RadiusSettings `type:"structure" required:"true"`
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
 }
 
-// String returns the string representation
-func (s EnableRadiusInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidClientAuthStatusException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s EnableRadiusInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidClientAuthStatusException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *EnableRadiusInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "EnableRadiusInput"}
-	if s.DirectoryId == nil {
-		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
-	}
-	if s.RadiusSettings == nil {
-		invalidParams.Add(request.NewErrParamRequired("RadiusSettings"))
-	}
-	if s.RadiusSettings != nil {
-		if err := s.RadiusSettings.Validate(); err != nil {
-			invalidParams.AddNested("RadiusSettings", err.(request.ErrInvalidParams))
-		}
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
+func newErrorInvalidClientAuthStatusException(v protocol.ResponseMetadata) error {
+	return &InvalidClientAuthStatusException{
+		RespMetadata: v,
 	}
-	return nil
 }
 
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *EnableRadiusInput) SetDirectoryId(v string) *EnableRadiusInput {
-	s.DirectoryId = &v
-	return s
+// Code returns the exception type name.
+func (s *InvalidClientAuthStatusException) Code() string {
+	return "InvalidClientAuthStatusException"
 }
 
-// SetRadiusSettings sets the RadiusSettings field's value.
-func (s *EnableRadiusInput) SetRadiusSettings(v *RadiusSettings) *EnableRadiusInput {
-	s.RadiusSettings = v
-	return s
+// Message returns the exception's message.
+func (s *InvalidClientAuthStatusException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// Contains the results of the EnableRadius operation.
-type EnableRadiusOutput struct {
-	_ struct{} `type:"structure"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidClientAuthStatusException) OrigErr() error {
+	return nil
 }
 
-// String returns the string representation
-func (s EnableRadiusOutput) String() string {
-	return awsutil.Prettify(s)
+func (s *InvalidClientAuthStatusException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
 }
 
-// GoString returns the string representation
-func (s EnableRadiusOutput) GoString() string {
-	return s.String()
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidClientAuthStatusException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// Contains the inputs for the EnableSso operation.
-type EnableSsoInput struct {
-	_ struct{} `type:"structure"`
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidClientAuthStatusException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// The identifier of the directory for which to enable single-sign on.
-	//
-	// DirectoryId is a required field
-	DirectoryId *string `type:"string" required:"true"`
+// The LDAP activities could not be performed because they are limited by the
+// LDAPS status.
+type InvalidLDAPSStatusException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The password of an alternate account to use to enable single-sign on. This
-	// is only used for AD Connector directories. For more information, see the
-	// UserName parameter.
-	Password *string `min:"1" type:"string" sensitive:"true"`
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
 
-	// The username of an alternate account to use to enable single-sign on. This
-	// is only used for AD Connector directories. This account must have privileges
-	// to add a service principal name.
-	//
-	// If the AD Connector service account does not have privileges to add a service
-	// principal name, you can specify an alternate account with the UserName and
-	// Password parameters. These credentials are only used to enable single sign-on
-	// and are not stored by the service. The AD Connector service account is not
-	// changed.
-	UserName *string `min:"1" type:"string"`
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
 }
 
-// String returns the string representation
-func (s EnableSsoInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidLDAPSStatusException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s EnableSsoInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidLDAPSStatusException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *EnableSsoInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "EnableSsoInput"}
-	if s.DirectoryId == nil {
-		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
-	}
-	if s.Password != nil && len(*s.Password) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Password", 1))
-	}
-	if s.UserName != nil && len(*s.UserName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("UserName", 1))
+func newErrorInvalidLDAPSStatusException(v protocol.ResponseMetadata) error {
+	return &InvalidLDAPSStatusException{
+		RespMetadata: v,
 	}
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
+// Code returns the exception type name.
+func (s *InvalidLDAPSStatusException) Code() string {
+	return "InvalidLDAPSStatusException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidLDAPSStatusException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidLDAPSStatusException) OrigErr() error {
 	return nil
 }
 
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *EnableSsoInput) SetDirectoryId(v string) *EnableSsoInput {
-	s.DirectoryId = &v
-	return s
+func (s *InvalidLDAPSStatusException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
 }
 
-// SetPassword sets the Password field's value.
-func (s *EnableSsoInput) SetPassword(v string) *EnableSsoInput {
-	s.Password = &v
-	return s
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidLDAPSStatusException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// SetUserName sets the UserName field's value.
-func (s *EnableSsoInput) SetUserName(v string) *EnableSsoInput {
-	s.UserName = &v
-	return s
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidLDAPSStatusException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// Contains the results of the EnableSso operation.
-type EnableSsoOutput struct {
-	_ struct{} `type:"structure"`
+// The NextToken value is not valid.
+type InvalidNextTokenException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
 }
 
-// String returns the string representation
-func (s EnableSsoOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidNextTokenException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s EnableSsoOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidNextTokenException) GoString() string {
 	return s.String()
 }
 
-// Information about SNS topic and AWS Directory Service directory associations.
-type EventTopic struct {
-	_ struct{} `type:"structure"`
+func newErrorInvalidNextTokenException(v protocol.ResponseMetadata) error {
+	return &InvalidNextTokenException{
+		RespMetadata: v,
+	}
+}
 
-	// The date and time of when you associated your directory with the SNS topic.
-	CreatedDateTime *time.Time `type:"timestamp"`
+// Code returns the exception type name.
+func (s *InvalidNextTokenException) Code() string {
+	return "InvalidNextTokenException"
+}
 
-	// The Directory ID of an AWS Directory Service directory that will publish
-	// status messages to an SNS topic.
-	DirectoryId *string `type:"string"`
+// Message returns the exception's message.
+func (s *InvalidNextTokenException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
 
-	// The topic registration status.
-	Status *string `type:"string" enum:"TopicStatus"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidNextTokenException) OrigErr() error {
+	return nil
+}
 
-	// The SNS topic ARN (Amazon Resource Name).
-	TopicArn *string `type:"string"`
+func (s *InvalidNextTokenException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
 
-	// The name of an AWS SNS topic the receives status messages from the directory.
-	TopicName *string `min:"1" type:"string"`
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidNextTokenException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// String returns the string representation
-func (s EventTopic) String() string {
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidNextTokenException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// One or more parameters are not valid.
+type InvalidParameterException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidParameterException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s EventTopic) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidParameterException) GoString() string {
 	return s.String()
 }
 
-// SetCreatedDateTime sets the CreatedDateTime field's value.
-func (s *EventTopic) SetCreatedDateTime(v time.Time) *EventTopic {
-	s.CreatedDateTime = &v
-	return s
+func newErrorInvalidParameterException(v protocol.ResponseMetadata) error {
+	return &InvalidParameterException{
+		RespMetadata: v,
+	}
 }
 
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *EventTopic) SetDirectoryId(v string) *EventTopic {
-	s.DirectoryId = &v
-	return s
+// Code returns the exception type name.
+func (s *InvalidParameterException) Code() string {
+	return "InvalidParameterException"
 }
 
-// SetStatus sets the Status field's value.
-func (s *EventTopic) SetStatus(v string) *EventTopic {
-	s.Status = &v
-	return s
+// Message returns the exception's message.
+func (s *InvalidParameterException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// SetTopicArn sets the TopicArn field's value.
-func (s *EventTopic) SetTopicArn(v string) *EventTopic {
-	s.TopicArn = &v
-	return s
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidParameterException) OrigErr() error {
+	return nil
 }
 
-// SetTopicName sets the TopicName field's value.
-func (s *EventTopic) SetTopicName(v string) *EventTopic {
-	s.TopicName = &v
-	return s
+func (s *InvalidParameterException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
 }
 
-// Contains the inputs for the GetDirectoryLimits operation.
-type GetDirectoryLimitsInput struct {
-	_ struct{} `type:"structure"`
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidParameterException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// String returns the string representation
-func (s GetDirectoryLimitsInput) String() string {
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidParameterException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The new password provided by the user does not meet the password complexity
+// requirements defined in your directory.
+type InvalidPasswordException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidPasswordException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetDirectoryLimitsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidPasswordException) GoString() string {
 	return s.String()
 }
 
-// Contains the results of the GetDirectoryLimits operation.
-type GetDirectoryLimitsOutput struct {
-	_ struct{} `type:"structure"`
+func newErrorInvalidPasswordException(v protocol.ResponseMetadata) error {
+	return &InvalidPasswordException{
+		RespMetadata: v,
+	}
+}
 
-	// A DirectoryLimits object that contains the directory limits for the current
-	// region.
-	DirectoryLimits *DirectoryLimits `type:"structure"`
+// Code returns the exception type name.
+func (s *InvalidPasswordException) Code() string {
+	return "InvalidPasswordException"
 }
 
-// String returns the string representation
-func (s GetDirectoryLimitsOutput) String() string {
-	return awsutil.Prettify(s)
+// Message returns the exception's message.
+func (s *InvalidPasswordException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// GoString returns the string representation
-func (s GetDirectoryLimitsOutput) GoString() string {
-	return s.String()
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidPasswordException) OrigErr() error {
+	return nil
 }
 
-// SetDirectoryLimits sets the DirectoryLimits field's value.
-func (s *GetDirectoryLimitsOutput) SetDirectoryLimits(v *DirectoryLimits) *GetDirectoryLimitsOutput {
-	s.DirectoryLimits = v
-	return s
+func (s *InvalidPasswordException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
 }
 
-// Contains the inputs for the GetSnapshotLimits operation.
-type GetSnapshotLimitsInput struct {
-	_ struct{} `type:"structure"`
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidPasswordException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
 
-	// Contains the identifier of the directory to obtain the limits for.
-	//
-	// DirectoryId is a required field
-	DirectoryId *string `type:"string" required:"true"`
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidPasswordException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// String returns the string representation
-func (s GetSnapshotLimitsInput) String() string {
+// The specified shared target is not valid.
+type InvalidTargetException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidTargetException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetSnapshotLimitsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidTargetException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetSnapshotLimitsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetSnapshotLimitsInput"}
-	if s.DirectoryId == nil {
-		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
+func newErrorInvalidTargetException(v protocol.ResponseMetadata) error {
+	return &InvalidTargetException{
+		RespMetadata: v,
 	}
-	return nil
 }
 
-// SetDirectoryId sets the DirectoryId field's value.
-func (s *GetSnapshotLimitsInput) SetDirectoryId(v string) *GetSnapshotLimitsInput {
-	s.DirectoryId = &v
-	return s
+// Code returns the exception type name.
+func (s *InvalidTargetException) Code() string {
+	return "InvalidTargetException"
 }
 
-// Contains the results of the GetSnapshotLimits operation.
-type GetSnapshotLimitsOutput struct {
-	_ struct{} `type:"structure"`
+// Message returns the exception's message.
+func (s *InvalidTargetException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
 
-	// A SnapshotLimits object that contains the manual snapshot limits for the
-	// specified directory.
-	SnapshotLimits *SnapshotLimits `type:"structure"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidTargetException) OrigErr() error {
+	return nil
 }
 
-// String returns the string representation
-func (s GetSnapshotLimitsOutput) String() string {
-	return awsutil.Prettify(s)
+func (s *InvalidTargetException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
 }
 
-// GoString returns the string representation
-func (s GetSnapshotLimitsOutput) GoString() string {
-	return s.String()
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidTargetException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// SetSnapshotLimits sets the SnapshotLimits field's value.
-func (s *GetSnapshotLimitsOutput) SetSnapshotLimits(v *SnapshotLimits) *GetSnapshotLimitsOutput {
-	s.SnapshotLimits = v
-	return s
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidTargetException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
 // IP address block. This is often the address block of the DNS server used
-// for your on-premises domain.
+// for your self-managed domain.
 type IpRoute struct {
 	_ struct{} `type:"structure"`
 
 	// IP address block using CIDR format, for example 10.0.0.0/24. This is often
-	// the address block of the DNS server used for your on-premises domain. For
+	// the address block of the DNS server used for your self-managed domain. For
 	// a single IP address use a CIDR address block with /32. For example 10.0.0.0/32.
 	CidrIp *string `type:"string"`
 
@@ -8503,12 +14894,20 @@ type IpRoute struct {
 	Description *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s IpRoute) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s IpRoute) GoString() string {
 	return s.String()
 }
@@ -8548,12 +14947,20 @@ type IpRouteInfo struct {
 	IpRouteStatusReason *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s IpRouteInfo) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s IpRouteInfo) GoString() string {
 	return s.String()
 }
@@ -8594,6 +15001,237 @@ func (s *IpRouteInfo) SetIpRouteStatusReason(v string) *IpRouteInfo {
 	return s
 }
 
+// The maximum allowed number of IP addresses was exceeded. The default limit
+// is 100 IP address blocks.
+type IpRouteLimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IpRouteLimitExceededException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IpRouteLimitExceededException) GoString() string {
+	return s.String()
+}
+
+func newErrorIpRouteLimitExceededException(v protocol.ResponseMetadata) error {
+	return &IpRouteLimitExceededException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *IpRouteLimitExceededException) Code() string {
+	return "IpRouteLimitExceededException"
+}
+
+// Message returns the exception's message.
+func (s *IpRouteLimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *IpRouteLimitExceededException) OrigErr() error {
+	return nil
+}
+
+func (s *IpRouteLimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *IpRouteLimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *IpRouteLimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Contains general information about the LDAPS settings.
+type LDAPSSettingInfo struct {
+	_ struct{} `type:"structure"`
+
+	// The state of the LDAPS settings.
+	LDAPSStatus *string `type:"string" enum:"LDAPSStatus"`
+
+	// Describes a state change for LDAPS.
+	LDAPSStatusReason *string `type:"string"`
+
+	// The date and time when the LDAPS settings were last updated.
+	LastUpdatedDateTime *time.Time `type:"timestamp"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LDAPSSettingInfo) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LDAPSSettingInfo) GoString() string {
+	return s.String()
+}
+
+// SetLDAPSStatus sets the LDAPSStatus field's value.
+func (s *LDAPSSettingInfo) SetLDAPSStatus(v string) *LDAPSSettingInfo {
+	s.LDAPSStatus = &v
+	return s
+}
+
+// SetLDAPSStatusReason sets the LDAPSStatusReason field's value.
+func (s *LDAPSSettingInfo) SetLDAPSStatusReason(v string) *LDAPSSettingInfo {
+	s.LDAPSStatusReason = &v
+	return s
+}
+
+// SetLastUpdatedDateTime sets the LastUpdatedDateTime field's value.
+func (s *LDAPSSettingInfo) SetLastUpdatedDateTime(v time.Time) *LDAPSSettingInfo {
+	s.LastUpdatedDateTime = &v
+	return s
+}
+
+type ListCertificatesInput struct {
+	_ struct{} `type:"structure"`
+
+	// The identifier of the directory.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
+
+	// The number of items that should show up on one page
+	Limit *int64 `min:"1" type:"integer"`
+
+	// A token for requesting another page of certificates if the NextToken response
+	// element indicates that more certificates are available. Use the value of
+	// the returned NextToken element in your request until the token comes back
+	// as null. Pass null if this is the first call.
+	NextToken *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCertificatesInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCertificatesInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListCertificatesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListCertificatesInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+	if s.Limit != nil && *s.Limit < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *ListCertificatesInput) SetDirectoryId(v string) *ListCertificatesInput {
+	s.DirectoryId = &v
+	return s
+}
+
+// SetLimit sets the Limit field's value.
+func (s *ListCertificatesInput) SetLimit(v int64) *ListCertificatesInput {
+	s.Limit = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListCertificatesInput) SetNextToken(v string) *ListCertificatesInput {
+	s.NextToken = &v
+	return s
+}
+
+type ListCertificatesOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A list of certificates with basic details including certificate ID, certificate
+	// common name, certificate state.
+	CertificatesInfo []*CertificateInfo `type:"list"`
+
+	// Indicates whether another page of certificates is available when the number
+	// of available certificates exceeds the page limit.
+	NextToken *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCertificatesOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCertificatesOutput) GoString() string {
+	return s.String()
+}
+
+// SetCertificatesInfo sets the CertificatesInfo field's value.
+func (s *ListCertificatesOutput) SetCertificatesInfo(v []*CertificateInfo) *ListCertificatesOutput {
+	s.CertificatesInfo = v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListCertificatesOutput) SetNextToken(v string) *ListCertificatesOutput {
+	s.NextToken = &v
+	return s
+}
+
 type ListIpRoutesInput struct {
 	_ struct{} `type:"structure"`
 
@@ -8611,12 +15249,20 @@ type ListIpRoutesInput struct {
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListIpRoutesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListIpRoutesInput) GoString() string {
 	return s.String()
 }
@@ -8664,12 +15310,20 @@ type ListIpRoutesOutput struct {
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListIpRoutesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListIpRoutesOutput) GoString() string {
 	return s.String()
 }
@@ -8691,8 +15345,9 @@ type ListLogSubscriptionsInput struct {
 
 	// If a DirectoryID is provided, lists only the log subscription associated
 	// with that directory. If no DirectoryId is provided, lists all log subscriptions
-	// associated with your AWS account. If there are no log subscriptions for the
-	// AWS account or the directory, an empty list will be returned.
+	// associated with your Amazon Web Services account. If there are no log subscriptions
+	// for the Amazon Web Services account or the directory, an empty list will
+	// be returned.
 	DirectoryId *string `type:"string"`
 
 	// The maximum number of items returned.
@@ -8702,12 +15357,20 @@ type ListLogSubscriptionsInput struct {
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListLogSubscriptionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListLogSubscriptionsInput) GoString() string {
 	return s.String()
 }
@@ -8733,19 +15396,28 @@ func (s *ListLogSubscriptionsInput) SetNextToken(v string) *ListLogSubscriptions
 type ListLogSubscriptionsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// A list of active LogSubscription objects for calling the AWS account.
+	// A list of active LogSubscription objects for calling the Amazon Web Services
+	// account.
 	LogSubscriptions []*LogSubscription `type:"list"`
 
 	// The token for the next set of items to return.
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListLogSubscriptionsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListLogSubscriptionsOutput) GoString() string {
 	return s.String()
 }
@@ -8779,12 +15451,20 @@ type ListSchemaExtensionsInput struct {
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListSchemaExtensionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListSchemaExtensionsInput) GoString() string {
 	return s.String()
 }
@@ -8832,12 +15512,20 @@ type ListSchemaExtensionsOutput struct {
 	SchemaExtensionsInfo []*SchemaExtensionInfo `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListSchemaExtensionsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListSchemaExtensionsOutput) GoString() string {
 	return s.String()
 }
@@ -8869,12 +15557,20 @@ type ListTagsForResourceInput struct {
 	ResourceId *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceInput) GoString() string {
 	return s.String()
 }
@@ -8920,12 +15616,20 @@ type ListTagsForResourceOutput struct {
 	Tags []*Tag `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceOutput) GoString() string {
 	return s.String()
 }
@@ -8958,12 +15662,20 @@ type LogSubscription struct {
 	SubscriptionCreatedDateTime *time.Time `type:"timestamp"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s LogSubscription) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s LogSubscription) GoString() string {
 	return s.String()
 }
@@ -8986,6 +15698,175 @@ func (s *LogSubscription) SetSubscriptionCreatedDateTime(v time.Time) *LogSubscr
 	return s
 }
 
+// Client authentication setup could not be completed because at least one valid
+// certificate must be registered in the system.
+type NoAvailableCertificateException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NoAvailableCertificateException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NoAvailableCertificateException) GoString() string {
+	return s.String()
+}
+
+func newErrorNoAvailableCertificateException(v protocol.ResponseMetadata) error {
+	return &NoAvailableCertificateException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *NoAvailableCertificateException) Code() string {
+	return "NoAvailableCertificateException"
+}
+
+// Message returns the exception's message.
+func (s *NoAvailableCertificateException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *NoAvailableCertificateException) OrigErr() error {
+	return nil
+}
+
+func (s *NoAvailableCertificateException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *NoAvailableCertificateException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *NoAvailableCertificateException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// OS version that the directory needs to be updated to.
+type OSUpdateSettings struct {
+	_ struct{} `type:"structure"`
+
+	// OS version that the directory needs to be updated to.
+	OSVersion *string `type:"string" enum:"OSVersion"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s OSUpdateSettings) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s OSUpdateSettings) GoString() string {
+	return s.String()
+}
+
+// SetOSVersion sets the OSVersion field's value.
+func (s *OSUpdateSettings) SetOSVersion(v string) *OSUpdateSettings {
+	s.OSVersion = &v
+	return s
+}
+
+// Exception encountered while trying to access your Amazon Web Services organization.
+type OrganizationsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s OrganizationsException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s OrganizationsException) GoString() string {
+	return s.String()
+}
+
+func newErrorOrganizationsException(v protocol.ResponseMetadata) error {
+	return &OrganizationsException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *OrganizationsException) Code() string {
+	return "OrganizationsException"
+}
+
+// Message returns the exception's message.
+func (s *OrganizationsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *OrganizationsException) OrigErr() error {
+	return nil
+}
+
+func (s *OrganizationsException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *OrganizationsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *OrganizationsException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Describes the directory owner account details that have been shared to the
 // directory consumer account.
 type OwnerDirectoryDescription struct {
@@ -8994,8 +15875,7 @@ type OwnerDirectoryDescription struct {
 	// Identifier of the directory owner account.
 	AccountId *string `type:"string"`
 
-	// Identifier of the AWS Managed Microsoft AD directory in the directory owner
-	// account.
+	// Identifier of the Managed Microsoft AD directory in the directory owner account.
 	DirectoryId *string `type:"string"`
 
 	// IP address of the directory’s domain controllers.
@@ -9011,12 +15891,20 @@ type OwnerDirectoryDescription struct {
 	VpcSettings *DirectoryVpcSettingsDescription `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OwnerDirectoryDescription) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OwnerDirectoryDescription) GoString() string {
 	return s.String()
 }
@@ -9068,35 +15956,48 @@ type RadiusSettings struct {
 	// Not currently used.
 	DisplayLabel *string `min:"1" type:"string"`
 
-	// The port that your RADIUS server is using for communications. Your on-premises
-	// network must allow inbound traffic over this port from the AWS Directory
-	// Service servers.
+	// The port that your RADIUS server is using for communications. Your self-managed
+	// network must allow inbound traffic over this port from the Directory Service
+	// servers.
 	RadiusPort *int64 `min:"1025" type:"integer"`
 
 	// The maximum number of times that communication with the RADIUS server is
 	// attempted.
 	RadiusRetries *int64 `type:"integer"`
 
-	// An array of strings that contains the IP addresses of the RADIUS server endpoints,
-	// or the IP addresses of your RADIUS server load balancer.
+	// An array of strings that contains the fully qualified domain name (FQDN)
+	// or IP addresses of the RADIUS server endpoints, or the FQDN or IP addresses
+	// of your RADIUS server load balancer.
 	RadiusServers []*string `type:"list"`
 
 	// The amount of time, in seconds, to wait for the RADIUS server to respond.
 	RadiusTimeout *int64 `min:"1" type:"integer"`
 
 	// Required for enabling RADIUS on the directory.
+	//
+	// SharedSecret is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by RadiusSettings's
+	// String and GoString methods.
 	SharedSecret *string `min:"8" type:"string" sensitive:"true"`
 
 	// Not currently used.
 	UseSameUsername *bool `type:"boolean"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RadiusSettings) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RadiusSettings) GoString() string {
 	return s.String()
 }
@@ -9171,28 +16072,373 @@ func (s *RadiusSettings) SetUseSameUsername(v bool) *RadiusSettings {
 	return s
 }
 
+// The replicated Region information for a directory.
+type RegionDescription struct {
+	_ struct{} `type:"structure"`
+
+	// The desired number of domain controllers in the specified Region for the
+	// specified directory.
+	DesiredNumberOfDomainControllers *int64 `min:"2" type:"integer"`
+
+	// The identifier of the directory.
+	DirectoryId *string `type:"string"`
+
+	// The date and time that the Region description was last updated.
+	LastUpdatedDateTime *time.Time `type:"timestamp"`
+
+	// Specifies when the Region replication began.
+	LaunchTime *time.Time `type:"timestamp"`
+
+	// The name of the Region. For example, us-east-1.
+	RegionName *string `min:"8" type:"string"`
+
+	// Specifies whether the Region is the primary Region or an additional Region.
+	RegionType *string `type:"string" enum:"RegionType"`
+
+	// The status of the replication process for the specified Region.
+	Status *string `type:"string" enum:"DirectoryStage"`
+
+	// The date and time that the Region status was last updated.
+	StatusLastUpdatedDateTime *time.Time `type:"timestamp"`
+
+	// Contains VPC information for the CreateDirectory or CreateMicrosoftAD operation.
+	VpcSettings *DirectoryVpcSettings `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RegionDescription) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RegionDescription) GoString() string {
+	return s.String()
+}
+
+// SetDesiredNumberOfDomainControllers sets the DesiredNumberOfDomainControllers field's value.
+func (s *RegionDescription) SetDesiredNumberOfDomainControllers(v int64) *RegionDescription {
+	s.DesiredNumberOfDomainControllers = &v
+	return s
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *RegionDescription) SetDirectoryId(v string) *RegionDescription {
+	s.DirectoryId = &v
+	return s
+}
+
+// SetLastUpdatedDateTime sets the LastUpdatedDateTime field's value.
+func (s *RegionDescription) SetLastUpdatedDateTime(v time.Time) *RegionDescription {
+	s.LastUpdatedDateTime = &v
+	return s
+}
+
+// SetLaunchTime sets the LaunchTime field's value.
+func (s *RegionDescription) SetLaunchTime(v time.Time) *RegionDescription {
+	s.LaunchTime = &v
+	return s
+}
+
+// SetRegionName sets the RegionName field's value.
+func (s *RegionDescription) SetRegionName(v string) *RegionDescription {
+	s.RegionName = &v
+	return s
+}
+
+// SetRegionType sets the RegionType field's value.
+func (s *RegionDescription) SetRegionType(v string) *RegionDescription {
+	s.RegionType = &v
+	return s
+}
+
+// SetStatus sets the Status field's value.
+func (s *RegionDescription) SetStatus(v string) *RegionDescription {
+	s.Status = &v
+	return s
+}
+
+// SetStatusLastUpdatedDateTime sets the StatusLastUpdatedDateTime field's value.
+func (s *RegionDescription) SetStatusLastUpdatedDateTime(v time.Time) *RegionDescription {
+	s.StatusLastUpdatedDateTime = &v
+	return s
+}
+
+// SetVpcSettings sets the VpcSettings field's value.
+func (s *RegionDescription) SetVpcSettings(v *DirectoryVpcSettings) *RegionDescription {
+	s.VpcSettings = v
+	return s
+}
+
+// You have reached the limit for maximum number of simultaneous Region replications
+// per directory.
+type RegionLimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RegionLimitExceededException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RegionLimitExceededException) GoString() string {
+	return s.String()
+}
+
+func newErrorRegionLimitExceededException(v protocol.ResponseMetadata) error {
+	return &RegionLimitExceededException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *RegionLimitExceededException) Code() string {
+	return "RegionLimitExceededException"
+}
+
+// Message returns the exception's message.
+func (s *RegionLimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *RegionLimitExceededException) OrigErr() error {
+	return nil
+}
+
+func (s *RegionLimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *RegionLimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *RegionLimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Provides information about the Regions that are configured for multi-Region
+// replication.
+type RegionsInfo struct {
+	_ struct{} `type:"structure"`
+
+	// Lists the Regions where the directory has been replicated, excluding the
+	// primary Region.
+	AdditionalRegions []*string `type:"list"`
+
+	// The Region where the Managed Microsoft AD directory was originally created.
+	PrimaryRegion *string `min:"8" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RegionsInfo) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RegionsInfo) GoString() string {
+	return s.String()
+}
+
+// SetAdditionalRegions sets the AdditionalRegions field's value.
+func (s *RegionsInfo) SetAdditionalRegions(v []*string) *RegionsInfo {
+	s.AdditionalRegions = v
+	return s
+}
+
+// SetPrimaryRegion sets the PrimaryRegion field's value.
+func (s *RegionsInfo) SetPrimaryRegion(v string) *RegionsInfo {
+	s.PrimaryRegion = &v
+	return s
+}
+
+type RegisterCertificateInput struct {
+	_ struct{} `type:"structure"`
+
+	// The certificate PEM string that needs to be registered.
+	//
+	// CertificateData is a required field
+	CertificateData *string `min:"1" type:"string" required:"true"`
+
+	// A ClientCertAuthSettings object that contains client certificate authentication
+	// settings.
+	ClientCertAuthSettings *ClientCertAuthSettings `type:"structure"`
+
+	// The identifier of the directory.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
+
+	// The function that the registered certificate performs. Valid values include
+	// ClientLDAPS or ClientCertAuth. The default value is ClientLDAPS.
+	Type *string `type:"string" enum:"CertificateType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RegisterCertificateInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RegisterCertificateInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *RegisterCertificateInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "RegisterCertificateInput"}
+	if s.CertificateData == nil {
+		invalidParams.Add(request.NewErrParamRequired("CertificateData"))
+	}
+	if s.CertificateData != nil && len(*s.CertificateData) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CertificateData", 1))
+	}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+	if s.ClientCertAuthSettings != nil {
+		if err := s.ClientCertAuthSettings.Validate(); err != nil {
+			invalidParams.AddNested("ClientCertAuthSettings", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCertificateData sets the CertificateData field's value.
+func (s *RegisterCertificateInput) SetCertificateData(v string) *RegisterCertificateInput {
+	s.CertificateData = &v
+	return s
+}
+
+// SetClientCertAuthSettings sets the ClientCertAuthSettings field's value.
+func (s *RegisterCertificateInput) SetClientCertAuthSettings(v *ClientCertAuthSettings) *RegisterCertificateInput {
+	s.ClientCertAuthSettings = v
+	return s
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *RegisterCertificateInput) SetDirectoryId(v string) *RegisterCertificateInput {
+	s.DirectoryId = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *RegisterCertificateInput) SetType(v string) *RegisterCertificateInput {
+	s.Type = &v
+	return s
+}
+
+type RegisterCertificateOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The identifier of the certificate.
+	CertificateId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RegisterCertificateOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RegisterCertificateOutput) GoString() string {
+	return s.String()
+}
+
+// SetCertificateId sets the CertificateId field's value.
+func (s *RegisterCertificateOutput) SetCertificateId(v string) *RegisterCertificateOutput {
+	s.CertificateId = &v
+	return s
+}
+
 // Registers a new event topic.
 type RegisterEventTopicInput struct {
 	_ struct{} `type:"structure"`
 
-	// The Directory ID that will publish status messages to the SNS topic.
+	// The Directory ID that will publish status messages to the Amazon SNS topic.
 	//
 	// DirectoryId is a required field
 	DirectoryId *string `type:"string" required:"true"`
 
-	// The SNS topic name to which the directory will publish status messages. This
-	// SNS topic must be in the same region as the specified Directory ID.
+	// The Amazon SNS topic name to which the directory will publish status messages.
+	// This Amazon SNS topic must be in the same region as the specified Directory
+	// ID.
 	//
 	// TopicName is a required field
 	TopicName *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RegisterEventTopicInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RegisterEventTopicInput) GoString() string {
 	return s.String()
 }
@@ -9233,12 +16479,20 @@ type RegisterEventTopicOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RegisterEventTopicOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RegisterEventTopicOutput) GoString() string {
 	return s.String()
 }
@@ -9253,12 +16507,20 @@ type RejectSharedDirectoryInput struct {
 	SharedDirectoryId *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RejectSharedDirectoryInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RejectSharedDirectoryInput) GoString() string {
 	return s.String()
 }
@@ -9289,12 +16551,20 @@ type RejectSharedDirectoryOutput struct {
 	SharedDirectoryId *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RejectSharedDirectoryOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RejectSharedDirectoryOutput) GoString() string {
 	return s.String()
 }
@@ -9319,12 +16589,20 @@ type RemoveIpRoutesInput struct {
 	DirectoryId *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemoveIpRoutesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemoveIpRoutesInput) GoString() string {
 	return s.String()
 }
@@ -9361,16 +16639,92 @@ type RemoveIpRoutesOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemoveIpRoutesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemoveIpRoutesOutput) GoString() string {
 	return s.String()
 }
 
+type RemoveRegionInput struct {
+	_ struct{} `type:"structure"`
+
+	// The identifier of the directory for which you want to remove Region replication.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RemoveRegionInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RemoveRegionInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *RemoveRegionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "RemoveRegionInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *RemoveRegionInput) SetDirectoryId(v string) *RemoveRegionInput {
+	s.DirectoryId = &v
+	return s
+}
+
+type RemoveRegionOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RemoveRegionOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RemoveRegionOutput) GoString() string {
+	return s.String()
+}
+
 type RemoveTagsFromResourceInput struct {
 	_ struct{} `type:"structure"`
 
@@ -9385,12 +16739,20 @@ type RemoveTagsFromResourceInput struct {
 	TagKeys []*string `type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemoveTagsFromResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemoveTagsFromResourceInput) GoString() string {
 	return s.String()
 }
@@ -9427,12 +16789,20 @@ type RemoveTagsFromResourceOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemoveTagsFromResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemoveTagsFromResourceOutput) GoString() string {
 	return s.String()
 }
@@ -9440,14 +16810,18 @@ func (s RemoveTagsFromResourceOutput) GoString() string {
 type ResetUserPasswordInput struct {
 	_ struct{} `type:"structure"`
 
-	// Identifier of the AWS Managed Microsoft AD or Simple AD directory in which
-	// the user resides.
+	// Identifier of the Managed Microsoft AD or Simple AD directory in which the
+	// user resides.
 	//
 	// DirectoryId is a required field
 	DirectoryId *string `type:"string" required:"true"`
 
 	// The new password that will be reset.
 	//
+	// NewPassword is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by ResetUserPasswordInput's
+	// String and GoString methods.
+	//
 	// NewPassword is a required field
 	NewPassword *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
@@ -9457,12 +16831,20 @@ type ResetUserPasswordInput struct {
 	UserName *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResetUserPasswordInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResetUserPasswordInput) GoString() string {
 	return s.String()
 }
@@ -9514,12 +16896,20 @@ type ResetUserPasswordOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResetUserPasswordOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResetUserPasswordOutput) GoString() string {
 	return s.String()
 }
@@ -9534,12 +16924,20 @@ type RestoreFromSnapshotInput struct {
 	SnapshotId *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RestoreFromSnapshotInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RestoreFromSnapshotInput) GoString() string {
 	return s.String()
 }
@@ -9568,12 +16966,20 @@ type RestoreFromSnapshotOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RestoreFromSnapshotOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RestoreFromSnapshotOutput) GoString() string {
 	return s.String()
 }
@@ -9605,12 +17011,20 @@ type SchemaExtensionInfo struct {
 	StartDateTime *time.Time `type:"timestamp"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SchemaExtensionInfo) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SchemaExtensionInfo) GoString() string {
 	return s.String()
 }
@@ -9657,18 +17071,290 @@ func (s *SchemaExtensionInfo) SetStartDateTime(v time.Time) *SchemaExtensionInfo
 	return s
 }
 
+// An exception has occurred in Directory Service.
+type ServiceException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceException) GoString() string {
+	return s.String()
+}
+
+func newErrorServiceException(v protocol.ResponseMetadata) error {
+	return &ServiceException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ServiceException) Code() string {
+	return "ServiceException"
+}
+
+// Message returns the exception's message.
+func (s *ServiceException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ServiceException) OrigErr() error {
+	return nil
+}
+
+func (s *ServiceException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ServiceException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ServiceException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Contains information about the configurable settings for a directory.
+type Setting struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the directory setting. For example:
+	//
+	// TLS_1_0
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
+
+	// The value of the directory setting for which to retrieve information. For
+	// example, for TLS_1_0, the valid values are: Enable and Disable.
+	//
+	// Value is a required field
+	Value *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Setting) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Setting) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *Setting) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "Setting"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+	if s.Value == nil {
+		invalidParams.Add(request.NewErrParamRequired("Value"))
+	}
+	if s.Value != nil && len(*s.Value) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Value", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetName sets the Name field's value.
+func (s *Setting) SetName(v string) *Setting {
+	s.Name = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *Setting) SetValue(v string) *Setting {
+	s.Value = &v
+	return s
+}
+
+// Contains information about the specified configurable setting for a directory.
+type SettingEntry struct {
+	_ struct{} `type:"structure"`
+
+	// The valid range of values for the directory setting. These values depend
+	// on the DataType of your directory.
+	AllowedValues *string `type:"string"`
+
+	// The value of the directory setting that is applied to the directory.
+	AppliedValue *string `min:"1" type:"string"`
+
+	// The data type of a directory setting. This is used to define the AllowedValues
+	// of a setting. For example a data type can be Boolean, DurationInSeconds,
+	// or Enum.
+	DataType *string `type:"string"`
+
+	// The date and time when the request to update a directory setting was last
+	// submitted.
+	LastRequestedDateTime *time.Time `type:"timestamp"`
+
+	// The date and time when the directory setting was last updated.
+	LastUpdatedDateTime *time.Time `type:"timestamp"`
+
+	// The name of the directory setting. For example:
+	//
+	// TLS_1_0
+	Name *string `min:"1" type:"string"`
+
+	// Details about the status of the request to update the directory setting.
+	// If the directory setting is deployed in more than one region, status is returned
+	// for the request in each region where the setting is deployed.
+	RequestDetailedStatus map[string]*string `type:"map"`
+
+	// The overall status of the request to update the directory setting request.
+	// If the directory setting is deployed in more than one region, and the request
+	// fails in any region, the overall status is Failed.
+	RequestStatus *string `type:"string" enum:"DirectoryConfigurationStatus"`
+
+	// The last status message for the directory status request.
+	RequestStatusMessage *string `type:"string"`
+
+	// The value that was last requested for the directory setting.
+	RequestedValue *string `min:"1" type:"string"`
+
+	// The type, or category, of a directory setting. Similar settings have the
+	// same type. For example, Protocol, Cipher, or Certificate-Based Authentication.
+	Type *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SettingEntry) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SettingEntry) GoString() string {
+	return s.String()
+}
+
+// SetAllowedValues sets the AllowedValues field's value.
+func (s *SettingEntry) SetAllowedValues(v string) *SettingEntry {
+	s.AllowedValues = &v
+	return s
+}
+
+// SetAppliedValue sets the AppliedValue field's value.
+func (s *SettingEntry) SetAppliedValue(v string) *SettingEntry {
+	s.AppliedValue = &v
+	return s
+}
+
+// SetDataType sets the DataType field's value.
+func (s *SettingEntry) SetDataType(v string) *SettingEntry {
+	s.DataType = &v
+	return s
+}
+
+// SetLastRequestedDateTime sets the LastRequestedDateTime field's value.
+func (s *SettingEntry) SetLastRequestedDateTime(v time.Time) *SettingEntry {
+	s.LastRequestedDateTime = &v
+	return s
+}
+
+// SetLastUpdatedDateTime sets the LastUpdatedDateTime field's value.
+func (s *SettingEntry) SetLastUpdatedDateTime(v time.Time) *SettingEntry {
+	s.LastUpdatedDateTime = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *SettingEntry) SetName(v string) *SettingEntry {
+	s.Name = &v
+	return s
+}
+
+// SetRequestDetailedStatus sets the RequestDetailedStatus field's value.
+func (s *SettingEntry) SetRequestDetailedStatus(v map[string]*string) *SettingEntry {
+	s.RequestDetailedStatus = v
+	return s
+}
+
+// SetRequestStatus sets the RequestStatus field's value.
+func (s *SettingEntry) SetRequestStatus(v string) *SettingEntry {
+	s.RequestStatus = &v
+	return s
+}
+
+// SetRequestStatusMessage sets the RequestStatusMessage field's value.
+func (s *SettingEntry) SetRequestStatusMessage(v string) *SettingEntry {
+	s.RequestStatusMessage = &v
+	return s
+}
+
+// SetRequestedValue sets the RequestedValue field's value.
+func (s *SettingEntry) SetRequestedValue(v string) *SettingEntry {
+	s.RequestedValue = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *SettingEntry) SetType(v string) *SettingEntry {
+	s.Type = &v
+	return s
+}
+
 type ShareDirectoryInput struct {
 	_ struct{} `type:"structure"`
 
-	// Identifier of the AWS Managed Microsoft AD directory that you want to share
-	// with other AWS accounts.
+	// Identifier of the Managed Microsoft AD directory that you want to share with
+	// other Amazon Web Services accounts.
 	//
 	// DirectoryId is a required field
 	DirectoryId *string `type:"string" required:"true"`
 
 	// The method used when sharing a directory to determine whether the directory
-	// should be shared within your AWS organization (ORGANIZATIONS) or with any
-	// AWS account by sending a directory sharing request (HANDSHAKE).
+	// should be shared within your Amazon Web Services organization (ORGANIZATIONS)
+	// or with any Amazon Web Services account by sending a directory sharing request
+	// (HANDSHAKE).
 	//
 	// ShareMethod is a required field
 	ShareMethod *string `type:"string" required:"true" enum:"ShareMethod"`
@@ -9676,6 +17362,10 @@ type ShareDirectoryInput struct {
 	// A directory share request that is sent by the directory owner to the directory
 	// consumer. The request includes a typed message to help the directory consumer
 	// administrator determine whether to approve or reject the share invitation.
+	//
+	// ShareNotes is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by ShareDirectoryInput's
+	// String and GoString methods.
 	ShareNotes *string `type:"string" sensitive:"true"`
 
 	// Identifier for the directory consumer account with whom the directory is
@@ -9685,12 +17375,20 @@ type ShareDirectoryInput struct {
 	ShareTarget *ShareTarget `type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ShareDirectoryInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ShareDirectoryInput) GoString() string {
 	return s.String()
 }
@@ -9751,12 +17449,20 @@ type ShareDirectoryOutput struct {
 	SharedDirectoryId *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ShareDirectoryOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ShareDirectoryOutput) GoString() string {
 	return s.String()
 }
@@ -9767,6 +17473,75 @@ func (s *ShareDirectoryOutput) SetSharedDirectoryId(v string) *ShareDirectoryOut
 	return s
 }
 
+// The maximum number of Amazon Web Services accounts that you can share with
+// this directory has been reached.
+type ShareLimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ShareLimitExceededException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ShareLimitExceededException) GoString() string {
+	return s.String()
+}
+
+func newErrorShareLimitExceededException(v protocol.ResponseMetadata) error {
+	return &ShareLimitExceededException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ShareLimitExceededException) Code() string {
+	return "ShareLimitExceededException"
+}
+
+// Message returns the exception's message.
+func (s *ShareLimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ShareLimitExceededException) OrigErr() error {
+	return nil
+}
+
+func (s *ShareLimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ShareLimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ShareLimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Identifier that contains details about the directory consumer account.
 type ShareTarget struct {
 	_ struct{} `type:"structure"`
@@ -9782,12 +17557,20 @@ type ShareTarget struct {
 	Type *string `type:"string" required:"true" enum:"TargetType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ShareTarget) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ShareTarget) GoString() string {
 	return s.String()
 }
@@ -9842,16 +17625,21 @@ type SharedDirectory struct {
 	OwnerDirectoryId *string `type:"string"`
 
 	// The method used when sharing a directory to determine whether the directory
-	// should be shared within your AWS organization (ORGANIZATIONS) or with any
-	// AWS account by sending a shared directory request (HANDSHAKE).
+	// should be shared within your Amazon Web Services organization (ORGANIZATIONS)
+	// or with any Amazon Web Services account by sending a shared directory request
+	// (HANDSHAKE).
 	ShareMethod *string `type:"string" enum:"ShareMethod"`
 
 	// A directory share request that is sent by the directory owner to the directory
 	// consumer. The request includes a typed message to help the directory consumer
 	// administrator determine whether to approve or reject the share invitation.
+	//
+	// ShareNotes is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by SharedDirectory's
+	// String and GoString methods.
 	ShareNotes *string `type:"string" sensitive:"true"`
 
-	// Current directory status of the shared AWS Managed Microsoft AD directory.
+	// Current directory status of the shared Managed Microsoft AD directory.
 	ShareStatus *string `type:"string" enum:"ShareStatus"`
 
 	// Identifier of the directory consumer account that has access to the shared
@@ -9863,12 +17651,20 @@ type SharedDirectory struct {
 	SharedDirectoryId *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SharedDirectory) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SharedDirectory) GoString() string {
 	return s.String()
 }
@@ -9950,12 +17746,20 @@ type Snapshot struct {
 	Type *string `type:"string" enum:"SnapshotType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Snapshot) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Snapshot) GoString() string {
 	return s.String()
 }
@@ -9996,6 +17800,76 @@ func (s *Snapshot) SetType(v string) *Snapshot {
 	return s
 }
 
+// The maximum number of manual snapshots for the directory has been reached.
+// You can use the GetSnapshotLimits operation to determine the snapshot limits
+// for a directory.
+type SnapshotLimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SnapshotLimitExceededException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SnapshotLimitExceededException) GoString() string {
+	return s.String()
+}
+
+func newErrorSnapshotLimitExceededException(v protocol.ResponseMetadata) error {
+	return &SnapshotLimitExceededException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *SnapshotLimitExceededException) Code() string {
+	return "SnapshotLimitExceededException"
+}
+
+// Message returns the exception's message.
+func (s *SnapshotLimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *SnapshotLimitExceededException) OrigErr() error {
+	return nil
+}
+
+func (s *SnapshotLimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *SnapshotLimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *SnapshotLimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Contains manual snapshot limit information for a directory.
 type SnapshotLimits struct {
 	_ struct{} `type:"structure"`
@@ -10010,12 +17884,20 @@ type SnapshotLimits struct {
 	ManualSnapshotsLimitReached *bool `type:"boolean"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SnapshotLimits) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SnapshotLimits) GoString() string {
 	return s.String()
 }
@@ -10066,12 +17948,20 @@ type StartSchemaExtensionInput struct {
 	LdifContent *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartSchemaExtensionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartSchemaExtensionInput) GoString() string {
 	return s.String()
 }
@@ -10132,12 +18022,20 @@ type StartSchemaExtensionOutput struct {
 	SchemaExtensionId *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartSchemaExtensionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartSchemaExtensionOutput) GoString() string {
 	return s.String()
 }
@@ -10167,12 +18065,20 @@ type Tag struct {
 	Value *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) GoString() string {
 	return s.String()
 }
@@ -10202,13 +18108,81 @@ func (s *Tag) SetKey(v string) *Tag {
 	return s
 }
 
-// SetValue sets the Value field's value.
-func (s *Tag) SetValue(v string) *Tag {
-	s.Value = &v
-	return s
+// SetValue sets the Value field's value.
+func (s *Tag) SetValue(v string) *Tag {
+	s.Value = &v
+	return s
+}
+
+// The maximum allowed number of tags was exceeded.
+type TagLimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagLimitExceededException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagLimitExceededException) GoString() string {
+	return s.String()
+}
+
+func newErrorTagLimitExceededException(v protocol.ResponseMetadata) error {
+	return &TagLimitExceededException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *TagLimitExceededException) Code() string {
+	return "TagLimitExceededException"
+}
+
+// Message returns the exception's message.
+func (s *TagLimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *TagLimitExceededException) OrigErr() error {
+	return nil
+}
+
+func (s *TagLimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *TagLimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *TagLimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// Describes a trust relationship between an AWS Managed Microsoft AD directory
+// Describes a trust relationship between an Managed Microsoft AD directory
 // and an external domain.
 type Trust struct {
 	_ struct{} `type:"structure"`
@@ -10216,7 +18190,8 @@ type Trust struct {
 	// The date and time that the trust relationship was created.
 	CreatedDateTime *time.Time `type:"timestamp"`
 
-	// The Directory ID of the AWS directory involved in the trust relationship.
+	// The Directory ID of the Amazon Web Services directory involved in the trust
+	// relationship.
 	DirectoryId *string `type:"string"`
 
 	// The date and time that the trust relationship was last updated.
@@ -10248,12 +18223,20 @@ type Trust struct {
 	TrustType *string `type:"string" enum:"TrustType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Trust) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Trust) GoString() string {
 	return s.String()
 }
@@ -10327,8 +18310,8 @@ func (s *Trust) SetTrustType(v string) *Trust {
 type UnshareDirectoryInput struct {
 	_ struct{} `type:"structure"`
 
-	// The identifier of the AWS Managed Microsoft AD directory that you want to
-	// stop sharing.
+	// The identifier of the Managed Microsoft AD directory that you want to stop
+	// sharing.
 	//
 	// DirectoryId is a required field
 	DirectoryId *string `type:"string" required:"true"`
@@ -10340,12 +18323,20 @@ type UnshareDirectoryInput struct {
 	UnshareTarget *UnshareTarget `type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UnshareDirectoryInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UnshareDirectoryInput) GoString() string {
 	return s.String()
 }
@@ -10391,12 +18382,20 @@ type UnshareDirectoryOutput struct {
 	SharedDirectoryId *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UnshareDirectoryOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UnshareDirectoryOutput) GoString() string {
 	return s.String()
 }
@@ -10423,12 +18422,20 @@ type UnshareTarget struct {
 	Type *string `type:"string" required:"true" enum:"TargetType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UnshareTarget) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UnshareTarget) GoString() string {
 	return s.String()
 }
@@ -10464,12 +18471,148 @@ func (s *UnshareTarget) SetType(v string) *UnshareTarget {
 	return s
 }
 
+// The operation is not supported.
+type UnsupportedOperationException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UnsupportedOperationException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UnsupportedOperationException) GoString() string {
+	return s.String()
+}
+
+func newErrorUnsupportedOperationException(v protocol.ResponseMetadata) error {
+	return &UnsupportedOperationException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *UnsupportedOperationException) Code() string {
+	return "UnsupportedOperationException"
+}
+
+// Message returns the exception's message.
+func (s *UnsupportedOperationException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *UnsupportedOperationException) OrigErr() error {
+	return nil
+}
+
+func (s *UnsupportedOperationException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *UnsupportedOperationException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *UnsupportedOperationException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The specified directory setting is not supported.
+type UnsupportedSettingsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UnsupportedSettingsException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UnsupportedSettingsException) GoString() string {
+	return s.String()
+}
+
+func newErrorUnsupportedSettingsException(v protocol.ResponseMetadata) error {
+	return &UnsupportedSettingsException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *UnsupportedSettingsException) Code() string {
+	return "UnsupportedSettingsException"
+}
+
+// Message returns the exception's message.
+func (s *UnsupportedSettingsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *UnsupportedSettingsException) OrigErr() error {
+	return nil
+}
+
+func (s *UnsupportedSettingsException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *UnsupportedSettingsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *UnsupportedSettingsException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Updates a conditional forwarder.
 type UpdateConditionalForwarderInput struct {
 	_ struct{} `type:"structure"`
 
-	// The directory ID of the AWS directory for which to update the conditional
-	// forwarder.
+	// The directory ID of the Amazon Web Services directory for which to update
+	// the conditional forwarder.
 	//
 	// DirectoryId is a required field
 	DirectoryId *string `type:"string" required:"true"`
@@ -10487,12 +18630,20 @@ type UpdateConditionalForwarderInput struct {
 	RemoteDomainName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConditionalForwarderInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConditionalForwarderInput) GoString() string {
 	return s.String()
 }
@@ -10539,16 +18690,222 @@ type UpdateConditionalForwarderOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConditionalForwarderOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConditionalForwarderOutput) GoString() string {
 	return s.String()
 }
 
+type UpdateDirectorySetupInput struct {
+	_ struct{} `type:"structure"`
+
+	// The boolean that specifies if a snapshot for the directory needs to be taken
+	// before updating the directory.
+	CreateSnapshotBeforeUpdate *bool `type:"boolean"`
+
+	// The identifier of the directory on which you want to perform the update.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
+
+	// The settings for the OS update that needs to be performed on the directory.
+	OSUpdateSettings *OSUpdateSettings `type:"structure"`
+
+	// The type of update that needs to be performed on the directory. For example,
+	// OS.
+	//
+	// UpdateType is a required field
+	UpdateType *string `type:"string" required:"true" enum:"UpdateType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateDirectorySetupInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateDirectorySetupInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *UpdateDirectorySetupInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateDirectorySetupInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+	if s.UpdateType == nil {
+		invalidParams.Add(request.NewErrParamRequired("UpdateType"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCreateSnapshotBeforeUpdate sets the CreateSnapshotBeforeUpdate field's value.
+func (s *UpdateDirectorySetupInput) SetCreateSnapshotBeforeUpdate(v bool) *UpdateDirectorySetupInput {
+	s.CreateSnapshotBeforeUpdate = &v
+	return s
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *UpdateDirectorySetupInput) SetDirectoryId(v string) *UpdateDirectorySetupInput {
+	s.DirectoryId = &v
+	return s
+}
+
+// SetOSUpdateSettings sets the OSUpdateSettings field's value.
+func (s *UpdateDirectorySetupInput) SetOSUpdateSettings(v *OSUpdateSettings) *UpdateDirectorySetupInput {
+	s.OSUpdateSettings = v
+	return s
+}
+
+// SetUpdateType sets the UpdateType field's value.
+func (s *UpdateDirectorySetupInput) SetUpdateType(v string) *UpdateDirectorySetupInput {
+	s.UpdateType = &v
+	return s
+}
+
+type UpdateDirectorySetupOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateDirectorySetupOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateDirectorySetupOutput) GoString() string {
+	return s.String()
+}
+
+// An entry of update information related to a requested update type.
+type UpdateInfoEntry struct {
+	_ struct{} `type:"structure"`
+
+	// This specifies if the update was initiated by the customer or by the service
+	// team.
+	InitiatedBy *string `type:"string"`
+
+	// The last updated date and time of a particular directory setting.
+	LastUpdatedDateTime *time.Time `type:"timestamp"`
+
+	// The new value of the target setting.
+	NewValue *UpdateValue `type:"structure"`
+
+	// The old value of the target setting.
+	PreviousValue *UpdateValue `type:"structure"`
+
+	// The name of the Region.
+	Region *string `min:"8" type:"string"`
+
+	// The start time of the UpdateDirectorySetup for the particular type.
+	StartTime *time.Time `type:"timestamp"`
+
+	// The status of the update performed on the directory.
+	Status *string `type:"string" enum:"UpdateStatus"`
+
+	// The reason for the current status of the update type activity.
+	StatusReason *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateInfoEntry) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateInfoEntry) GoString() string {
+	return s.String()
+}
+
+// SetInitiatedBy sets the InitiatedBy field's value.
+func (s *UpdateInfoEntry) SetInitiatedBy(v string) *UpdateInfoEntry {
+	s.InitiatedBy = &v
+	return s
+}
+
+// SetLastUpdatedDateTime sets the LastUpdatedDateTime field's value.
+func (s *UpdateInfoEntry) SetLastUpdatedDateTime(v time.Time) *UpdateInfoEntry {
+	s.LastUpdatedDateTime = &v
+	return s
+}
+
+// SetNewValue sets the NewValue field's value.
+func (s *UpdateInfoEntry) SetNewValue(v *UpdateValue) *UpdateInfoEntry {
+	s.NewValue = v
+	return s
+}
+
+// SetPreviousValue sets the PreviousValue field's value.
+func (s *UpdateInfoEntry) SetPreviousValue(v *UpdateValue) *UpdateInfoEntry {
+	s.PreviousValue = v
+	return s
+}
+
+// SetRegion sets the Region field's value.
+func (s *UpdateInfoEntry) SetRegion(v string) *UpdateInfoEntry {
+	s.Region = &v
+	return s
+}
+
+// SetStartTime sets the StartTime field's value.
+func (s *UpdateInfoEntry) SetStartTime(v time.Time) *UpdateInfoEntry {
+	s.StartTime = &v
+	return s
+}
+
+// SetStatus sets the Status field's value.
+func (s *UpdateInfoEntry) SetStatus(v string) *UpdateInfoEntry {
+	s.Status = &v
+	return s
+}
+
+// SetStatusReason sets the StatusReason field's value.
+func (s *UpdateInfoEntry) SetStatusReason(v string) *UpdateInfoEntry {
+	s.StatusReason = &v
+	return s
+}
+
 type UpdateNumberOfDomainControllersInput struct {
 	_ struct{} `type:"structure"`
 
@@ -10564,12 +18921,20 @@ type UpdateNumberOfDomainControllersInput struct {
 	DirectoryId *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateNumberOfDomainControllersInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateNumberOfDomainControllersInput) GoString() string {
 	return s.String()
 }
@@ -10600,62 +18965,171 @@ func (s *UpdateNumberOfDomainControllersInput) SetDesiredNumber(v int64) *Update
 }
 
 // SetDirectoryId sets the DirectoryId field's value.
-func (s *UpdateNumberOfDomainControllersInput) SetDirectoryId(v string) *UpdateNumberOfDomainControllersInput {
+func (s *UpdateNumberOfDomainControllersInput) SetDirectoryId(v string) *UpdateNumberOfDomainControllersInput {
+	s.DirectoryId = &v
+	return s
+}
+
+type UpdateNumberOfDomainControllersOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateNumberOfDomainControllersOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateNumberOfDomainControllersOutput) GoString() string {
+	return s.String()
+}
+
+// Contains the inputs for the UpdateRadius operation.
+type UpdateRadiusInput struct {
+	_ struct{} `type:"structure"`
+
+	// The identifier of the directory for which to update the RADIUS server information.
+	//
+	// DirectoryId is a required field
+	DirectoryId *string `type:"string" required:"true"`
+
+	// A RadiusSettings object that contains information about the RADIUS server.
+	//
+	// RadiusSettings is a required field
+	RadiusSettings *RadiusSettings `type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateRadiusInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateRadiusInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *UpdateRadiusInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateRadiusInput"}
+	if s.DirectoryId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
+	}
+	if s.RadiusSettings == nil {
+		invalidParams.Add(request.NewErrParamRequired("RadiusSettings"))
+	}
+	if s.RadiusSettings != nil {
+		if err := s.RadiusSettings.Validate(); err != nil {
+			invalidParams.AddNested("RadiusSettings", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *UpdateRadiusInput) SetDirectoryId(v string) *UpdateRadiusInput {
 	s.DirectoryId = &v
 	return s
 }
 
-type UpdateNumberOfDomainControllersOutput struct {
+// SetRadiusSettings sets the RadiusSettings field's value.
+func (s *UpdateRadiusInput) SetRadiusSettings(v *RadiusSettings) *UpdateRadiusInput {
+	s.RadiusSettings = v
+	return s
+}
+
+// Contains the results of the UpdateRadius operation.
+type UpdateRadiusOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
-func (s UpdateNumberOfDomainControllersOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateRadiusOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s UpdateNumberOfDomainControllersOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateRadiusOutput) GoString() string {
 	return s.String()
 }
 
-// Contains the inputs for the UpdateRadius operation.
-type UpdateRadiusInput struct {
+type UpdateSettingsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The identifier of the directory for which to update the RADIUS server information.
+	// The identifier of the directory for which to update settings.
 	//
 	// DirectoryId is a required field
 	DirectoryId *string `type:"string" required:"true"`
 
-	// A RadiusSettings object that contains information about the RADIUS server.
+	// The list of Setting objects.
 	//
-	// RadiusSettings is a required field
-	RadiusSettings *RadiusSettings `type:"structure" required:"true"`
+	// Settings is a required field
+	Settings []*Setting `type:"list" required:"true"`
 }
 
-// String returns the string representation
-func (s UpdateRadiusInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateSettingsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s UpdateRadiusInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateSettingsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *UpdateRadiusInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "UpdateRadiusInput"}
+func (s *UpdateSettingsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateSettingsInput"}
 	if s.DirectoryId == nil {
 		invalidParams.Add(request.NewErrParamRequired("DirectoryId"))
 	}
-	if s.RadiusSettings == nil {
-		invalidParams.Add(request.NewErrParamRequired("RadiusSettings"))
+	if s.Settings == nil {
+		invalidParams.Add(request.NewErrParamRequired("Settings"))
 	}
-	if s.RadiusSettings != nil {
-		if err := s.RadiusSettings.Validate(); err != nil {
-			invalidParams.AddNested("RadiusSettings", err.(request.ErrInvalidParams))
+	if s.Settings != nil {
+		for i, v := range s.Settings {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Settings", i), err.(request.ErrInvalidParams))
+			}
 		}
 	}
 
@@ -10666,32 +19140,48 @@ func (s *UpdateRadiusInput) Validate() error {
 }
 
 // SetDirectoryId sets the DirectoryId field's value.
-func (s *UpdateRadiusInput) SetDirectoryId(v string) *UpdateRadiusInput {
+func (s *UpdateSettingsInput) SetDirectoryId(v string) *UpdateSettingsInput {
 	s.DirectoryId = &v
 	return s
 }
 
-// SetRadiusSettings sets the RadiusSettings field's value.
-func (s *UpdateRadiusInput) SetRadiusSettings(v *RadiusSettings) *UpdateRadiusInput {
-	s.RadiusSettings = v
+// SetSettings sets the Settings field's value.
+func (s *UpdateSettingsInput) SetSettings(v []*Setting) *UpdateSettingsInput {
+	s.Settings = v
 	return s
 }
 
-// Contains the results of the UpdateRadius operation.
-type UpdateRadiusOutput struct {
+type UpdateSettingsOutput struct {
 	_ struct{} `type:"structure"`
+
+	// The identifier of the directory.
+	DirectoryId *string `type:"string"`
 }
 
-// String returns the string representation
-func (s UpdateRadiusOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateSettingsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s UpdateRadiusOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateSettingsOutput) GoString() string {
 	return s.String()
 }
 
+// SetDirectoryId sets the DirectoryId field's value.
+func (s *UpdateSettingsOutput) SetDirectoryId(v string) *UpdateSettingsOutput {
+	s.DirectoryId = &v
+	return s
+}
+
 type UpdateTrustInput struct {
 	_ struct{} `type:"structure"`
 
@@ -10704,12 +19194,20 @@ type UpdateTrustInput struct {
 	TrustId *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateTrustInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateTrustInput) GoString() string {
 	return s.String()
 }
@@ -10742,19 +19240,27 @@ func (s *UpdateTrustInput) SetTrustId(v string) *UpdateTrustInput {
 type UpdateTrustOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The AWS request identifier.
+	// The Amazon Web Services request identifier.
 	RequestId *string `type:"string"`
 
 	// Identifier of the trust relationship.
 	TrustId *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateTrustOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateTrustOutput) GoString() string {
 	return s.String()
 }
@@ -10771,8 +19277,108 @@ func (s *UpdateTrustOutput) SetTrustId(v string) *UpdateTrustOutput {
 	return s
 }
 
-// Initiates the verification of an existing trust relationship between an AWS
-// Managed Microsoft AD directory and an external domain.
+// The value for a given type of UpdateSettings.
+type UpdateValue struct {
+	_ struct{} `type:"structure"`
+
+	// The OS update related settings.
+	OSUpdateSettings *OSUpdateSettings `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateValue) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdateValue) GoString() string {
+	return s.String()
+}
+
+// SetOSUpdateSettings sets the OSUpdateSettings field's value.
+func (s *UpdateValue) SetOSUpdateSettings(v *OSUpdateSettings) *UpdateValue {
+	s.OSUpdateSettings = v
+	return s
+}
+
+// The user provided a username that does not exist in your directory.
+type UserDoesNotExistException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// The descriptive message for the exception.
+	Message_ *string `locationName:"Message" type:"string"`
+
+	// The Amazon Web Services request identifier.
+	RequestId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UserDoesNotExistException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UserDoesNotExistException) GoString() string {
+	return s.String()
+}
+
+func newErrorUserDoesNotExistException(v protocol.ResponseMetadata) error {
+	return &UserDoesNotExistException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *UserDoesNotExistException) Code() string {
+	return "UserDoesNotExistException"
+}
+
+// Message returns the exception's message.
+func (s *UserDoesNotExistException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *UserDoesNotExistException) OrigErr() error {
+	return nil
+}
+
+func (s *UserDoesNotExistException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *UserDoesNotExistException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *UserDoesNotExistException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Initiates the verification of an existing trust relationship between an Managed
+// Microsoft AD directory and an external domain.
 type VerifyTrustInput struct {
 	_ struct{} `type:"structure"`
 
@@ -10782,12 +19388,20 @@ type VerifyTrustInput struct {
 	TrustId *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VerifyTrustInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VerifyTrustInput) GoString() string {
 	return s.String()
 }
@@ -10819,12 +19433,20 @@ type VerifyTrustOutput struct {
 	TrustId *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VerifyTrustOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VerifyTrustOutput) GoString() string {
 	return s.String()
 }
@@ -10835,6 +19457,114 @@ func (s *VerifyTrustOutput) SetTrustId(v string) *VerifyTrustOutput {
 	return s
 }
 
+const (
+	// CertificateStateRegistering is a CertificateState enum value
+	CertificateStateRegistering = "Registering"
+
+	// CertificateStateRegistered is a CertificateState enum value
+	CertificateStateRegistered = "Registered"
+
+	// CertificateStateRegisterFailed is a CertificateState enum value
+	CertificateStateRegisterFailed = "RegisterFailed"
+
+	// CertificateStateDeregistering is a CertificateState enum value
+	CertificateStateDeregistering = "Deregistering"
+
+	// CertificateStateDeregistered is a CertificateState enum value
+	CertificateStateDeregistered = "Deregistered"
+
+	// CertificateStateDeregisterFailed is a CertificateState enum value
+	CertificateStateDeregisterFailed = "DeregisterFailed"
+)
+
+// CertificateState_Values returns all elements of the CertificateState enum
+func CertificateState_Values() []string {
+	return []string{
+		CertificateStateRegistering,
+		CertificateStateRegistered,
+		CertificateStateRegisterFailed,
+		CertificateStateDeregistering,
+		CertificateStateDeregistered,
+		CertificateStateDeregisterFailed,
+	}
+}
+
+const (
+	// CertificateTypeClientCertAuth is a CertificateType enum value
+	CertificateTypeClientCertAuth = "ClientCertAuth"
+
+	// CertificateTypeClientLdaps is a CertificateType enum value
+	CertificateTypeClientLdaps = "ClientLDAPS"
+)
+
+// CertificateType_Values returns all elements of the CertificateType enum
+func CertificateType_Values() []string {
+	return []string{
+		CertificateTypeClientCertAuth,
+		CertificateTypeClientLdaps,
+	}
+}
+
+const (
+	// ClientAuthenticationStatusEnabled is a ClientAuthenticationStatus enum value
+	ClientAuthenticationStatusEnabled = "Enabled"
+
+	// ClientAuthenticationStatusDisabled is a ClientAuthenticationStatus enum value
+	ClientAuthenticationStatusDisabled = "Disabled"
+)
+
+// ClientAuthenticationStatus_Values returns all elements of the ClientAuthenticationStatus enum
+func ClientAuthenticationStatus_Values() []string {
+	return []string{
+		ClientAuthenticationStatusEnabled,
+		ClientAuthenticationStatusDisabled,
+	}
+}
+
+const (
+	// ClientAuthenticationTypeSmartCard is a ClientAuthenticationType enum value
+	ClientAuthenticationTypeSmartCard = "SmartCard"
+
+	// ClientAuthenticationTypeSmartCardOrPassword is a ClientAuthenticationType enum value
+	ClientAuthenticationTypeSmartCardOrPassword = "SmartCardOrPassword"
+)
+
+// ClientAuthenticationType_Values returns all elements of the ClientAuthenticationType enum
+func ClientAuthenticationType_Values() []string {
+	return []string{
+		ClientAuthenticationTypeSmartCard,
+		ClientAuthenticationTypeSmartCardOrPassword,
+	}
+}
+
+const (
+	// DirectoryConfigurationStatusRequested is a DirectoryConfigurationStatus enum value
+	DirectoryConfigurationStatusRequested = "Requested"
+
+	// DirectoryConfigurationStatusUpdating is a DirectoryConfigurationStatus enum value
+	DirectoryConfigurationStatusUpdating = "Updating"
+
+	// DirectoryConfigurationStatusUpdated is a DirectoryConfigurationStatus enum value
+	DirectoryConfigurationStatusUpdated = "Updated"
+
+	// DirectoryConfigurationStatusFailed is a DirectoryConfigurationStatus enum value
+	DirectoryConfigurationStatusFailed = "Failed"
+
+	// DirectoryConfigurationStatusDefault is a DirectoryConfigurationStatus enum value
+	DirectoryConfigurationStatusDefault = "Default"
+)
+
+// DirectoryConfigurationStatus_Values returns all elements of the DirectoryConfigurationStatus enum
+func DirectoryConfigurationStatus_Values() []string {
+	return []string{
+		DirectoryConfigurationStatusRequested,
+		DirectoryConfigurationStatusUpdating,
+		DirectoryConfigurationStatusUpdated,
+		DirectoryConfigurationStatusFailed,
+		DirectoryConfigurationStatusDefault,
+	}
+}
+
 const (
 	// DirectoryEditionEnterprise is a DirectoryEdition enum value
 	DirectoryEditionEnterprise = "Enterprise"
@@ -10843,6 +19573,14 @@ const (
 	DirectoryEditionStandard = "Standard"
 )
 
+// DirectoryEdition_Values returns all elements of the DirectoryEdition enum
+func DirectoryEdition_Values() []string {
+	return []string{
+		DirectoryEditionEnterprise,
+		DirectoryEditionStandard,
+	}
+}
+
 const (
 	// DirectorySizeSmall is a DirectorySize enum value
 	DirectorySizeSmall = "Small"
@@ -10851,6 +19589,14 @@ const (
 	DirectorySizeLarge = "Large"
 )
 
+// DirectorySize_Values returns all elements of the DirectorySize enum
+func DirectorySize_Values() []string {
+	return []string{
+		DirectorySizeSmall,
+		DirectorySizeLarge,
+	}
+}
+
 const (
 	// DirectoryStageRequested is a DirectoryStage enum value
 	DirectoryStageRequested = "Requested"
@@ -10886,6 +19632,23 @@ const (
 	DirectoryStageFailed = "Failed"
 )
 
+// DirectoryStage_Values returns all elements of the DirectoryStage enum
+func DirectoryStage_Values() []string {
+	return []string{
+		DirectoryStageRequested,
+		DirectoryStageCreating,
+		DirectoryStageCreated,
+		DirectoryStageActive,
+		DirectoryStageInoperable,
+		DirectoryStageImpaired,
+		DirectoryStageRestoring,
+		DirectoryStageRestoreFailed,
+		DirectoryStageDeleting,
+		DirectoryStageDeleted,
+		DirectoryStageFailed,
+	}
+}
+
 const (
 	// DirectoryTypeSimpleAd is a DirectoryType enum value
 	DirectoryTypeSimpleAd = "SimpleAD"
@@ -10900,6 +19663,16 @@ const (
 	DirectoryTypeSharedMicrosoftAd = "SharedMicrosoftAD"
 )
 
+// DirectoryType_Values returns all elements of the DirectoryType enum
+func DirectoryType_Values() []string {
+	return []string{
+		DirectoryTypeSimpleAd,
+		DirectoryTypeAdconnector,
+		DirectoryTypeMicrosoftAd,
+		DirectoryTypeSharedMicrosoftAd,
+	}
+}
+
 const (
 	// DomainControllerStatusCreating is a DomainControllerStatus enum value
 	DomainControllerStatusCreating = "Creating"
@@ -10923,6 +19696,19 @@ const (
 	DomainControllerStatusFailed = "Failed"
 )
 
+// DomainControllerStatus_Values returns all elements of the DomainControllerStatus enum
+func DomainControllerStatus_Values() []string {
+	return []string{
+		DomainControllerStatusCreating,
+		DomainControllerStatusActive,
+		DomainControllerStatusImpaired,
+		DomainControllerStatusRestoring,
+		DomainControllerStatusDeleting,
+		DomainControllerStatusDeleted,
+		DomainControllerStatusFailed,
+	}
+}
+
 const (
 	// IpRouteStatusMsgAdding is a IpRouteStatusMsg enum value
 	IpRouteStatusMsgAdding = "Adding"
@@ -10943,6 +19729,70 @@ const (
 	IpRouteStatusMsgRemoveFailed = "RemoveFailed"
 )
 
+// IpRouteStatusMsg_Values returns all elements of the IpRouteStatusMsg enum
+func IpRouteStatusMsg_Values() []string {
+	return []string{
+		IpRouteStatusMsgAdding,
+		IpRouteStatusMsgAdded,
+		IpRouteStatusMsgRemoving,
+		IpRouteStatusMsgRemoved,
+		IpRouteStatusMsgAddFailed,
+		IpRouteStatusMsgRemoveFailed,
+	}
+}
+
+const (
+	// LDAPSStatusEnabling is a LDAPSStatus enum value
+	LDAPSStatusEnabling = "Enabling"
+
+	// LDAPSStatusEnabled is a LDAPSStatus enum value
+	LDAPSStatusEnabled = "Enabled"
+
+	// LDAPSStatusEnableFailed is a LDAPSStatus enum value
+	LDAPSStatusEnableFailed = "EnableFailed"
+
+	// LDAPSStatusDisabled is a LDAPSStatus enum value
+	LDAPSStatusDisabled = "Disabled"
+)
+
+// LDAPSStatus_Values returns all elements of the LDAPSStatus enum
+func LDAPSStatus_Values() []string {
+	return []string{
+		LDAPSStatusEnabling,
+		LDAPSStatusEnabled,
+		LDAPSStatusEnableFailed,
+		LDAPSStatusDisabled,
+	}
+}
+
+const (
+	// LDAPSTypeClient is a LDAPSType enum value
+	LDAPSTypeClient = "Client"
+)
+
+// LDAPSType_Values returns all elements of the LDAPSType enum
+func LDAPSType_Values() []string {
+	return []string{
+		LDAPSTypeClient,
+	}
+}
+
+const (
+	// OSVersionServer2012 is a OSVersion enum value
+	OSVersionServer2012 = "SERVER_2012"
+
+	// OSVersionServer2019 is a OSVersion enum value
+	OSVersionServer2019 = "SERVER_2019"
+)
+
+// OSVersion_Values returns all elements of the OSVersion enum
+func OSVersion_Values() []string {
+	return []string{
+		OSVersionServer2012,
+		OSVersionServer2019,
+	}
+}
+
 const (
 	// RadiusAuthenticationProtocolPap is a RadiusAuthenticationProtocol enum value
 	RadiusAuthenticationProtocolPap = "PAP"
@@ -10957,6 +19807,16 @@ const (
 	RadiusAuthenticationProtocolMsChapv2 = "MS-CHAPv2"
 )
 
+// RadiusAuthenticationProtocol_Values returns all elements of the RadiusAuthenticationProtocol enum
+func RadiusAuthenticationProtocol_Values() []string {
+	return []string{
+		RadiusAuthenticationProtocolPap,
+		RadiusAuthenticationProtocolChap,
+		RadiusAuthenticationProtocolMsChapv1,
+		RadiusAuthenticationProtocolMsChapv2,
+	}
+}
+
 const (
 	// RadiusStatusCreating is a RadiusStatus enum value
 	RadiusStatusCreating = "Creating"
@@ -10968,11 +19828,43 @@ const (
 	RadiusStatusFailed = "Failed"
 )
 
+// RadiusStatus_Values returns all elements of the RadiusStatus enum
+func RadiusStatus_Values() []string {
+	return []string{
+		RadiusStatusCreating,
+		RadiusStatusCompleted,
+		RadiusStatusFailed,
+	}
+}
+
+const (
+	// RegionTypePrimary is a RegionType enum value
+	RegionTypePrimary = "Primary"
+
+	// RegionTypeAdditional is a RegionType enum value
+	RegionTypeAdditional = "Additional"
+)
+
+// RegionType_Values returns all elements of the RegionType enum
+func RegionType_Values() []string {
+	return []string{
+		RegionTypePrimary,
+		RegionTypeAdditional,
+	}
+}
+
 const (
 	// ReplicationScopeDomain is a ReplicationScope enum value
 	ReplicationScopeDomain = "Domain"
 )
 
+// ReplicationScope_Values returns all elements of the ReplicationScope enum
+func ReplicationScope_Values() []string {
+	return []string{
+		ReplicationScopeDomain,
+	}
+}
+
 const (
 	// SchemaExtensionStatusInitializing is a SchemaExtensionStatus enum value
 	SchemaExtensionStatusInitializing = "Initializing"
@@ -11002,6 +19894,21 @@ const (
 	SchemaExtensionStatusCompleted = "Completed"
 )
 
+// SchemaExtensionStatus_Values returns all elements of the SchemaExtensionStatus enum
+func SchemaExtensionStatus_Values() []string {
+	return []string{
+		SchemaExtensionStatusInitializing,
+		SchemaExtensionStatusCreatingSnapshot,
+		SchemaExtensionStatusUpdatingSchema,
+		SchemaExtensionStatusReplicating,
+		SchemaExtensionStatusCancelInProgress,
+		SchemaExtensionStatusRollbackInProgress,
+		SchemaExtensionStatusCancelled,
+		SchemaExtensionStatusFailed,
+		SchemaExtensionStatusCompleted,
+	}
+}
+
 const (
 	// SelectiveAuthEnabled is a SelectiveAuth enum value
 	SelectiveAuthEnabled = "Enabled"
@@ -11010,6 +19917,14 @@ const (
 	SelectiveAuthDisabled = "Disabled"
 )
 
+// SelectiveAuth_Values returns all elements of the SelectiveAuth enum
+func SelectiveAuth_Values() []string {
+	return []string{
+		SelectiveAuthEnabled,
+		SelectiveAuthDisabled,
+	}
+}
+
 const (
 	// ShareMethodOrganizations is a ShareMethod enum value
 	ShareMethodOrganizations = "ORGANIZATIONS"
@@ -11018,6 +19933,14 @@ const (
 	ShareMethodHandshake = "HANDSHAKE"
 )
 
+// ShareMethod_Values returns all elements of the ShareMethod enum
+func ShareMethod_Values() []string {
+	return []string{
+		ShareMethodOrganizations,
+		ShareMethodHandshake,
+	}
+}
+
 const (
 	// ShareStatusShared is a ShareStatus enum value
 	ShareStatusShared = "Shared"
@@ -11047,6 +19970,21 @@ const (
 	ShareStatusDeleting = "Deleting"
 )
 
+// ShareStatus_Values returns all elements of the ShareStatus enum
+func ShareStatus_Values() []string {
+	return []string{
+		ShareStatusShared,
+		ShareStatusPendingAcceptance,
+		ShareStatusRejected,
+		ShareStatusRejecting,
+		ShareStatusRejectFailed,
+		ShareStatusSharing,
+		ShareStatusShareFailed,
+		ShareStatusDeleted,
+		ShareStatusDeleting,
+	}
+}
+
 const (
 	// SnapshotStatusCreating is a SnapshotStatus enum value
 	SnapshotStatusCreating = "Creating"
@@ -11058,6 +19996,15 @@ const (
 	SnapshotStatusFailed = "Failed"
 )
 
+// SnapshotStatus_Values returns all elements of the SnapshotStatus enum
+func SnapshotStatus_Values() []string {
+	return []string{
+		SnapshotStatusCreating,
+		SnapshotStatusCompleted,
+		SnapshotStatusFailed,
+	}
+}
+
 const (
 	// SnapshotTypeAuto is a SnapshotType enum value
 	SnapshotTypeAuto = "Auto"
@@ -11066,11 +20013,26 @@ const (
 	SnapshotTypeManual = "Manual"
 )
 
+// SnapshotType_Values returns all elements of the SnapshotType enum
+func SnapshotType_Values() []string {
+	return []string{
+		SnapshotTypeAuto,
+		SnapshotTypeManual,
+	}
+}
+
 const (
 	// TargetTypeAccount is a TargetType enum value
 	TargetTypeAccount = "ACCOUNT"
 )
 
+// TargetType_Values returns all elements of the TargetType enum
+func TargetType_Values() []string {
+	return []string{
+		TargetTypeAccount,
+	}
+}
+
 const (
 	// TopicStatusRegistered is a TopicStatus enum value
 	TopicStatusRegistered = "Registered"
@@ -11085,6 +20047,16 @@ const (
 	TopicStatusDeleted = "Deleted"
 )
 
+// TopicStatus_Values returns all elements of the TopicStatus enum
+func TopicStatus_Values() []string {
+	return []string{
+		TopicStatusRegistered,
+		TopicStatusTopicnotfound,
+		TopicStatusFailed,
+		TopicStatusDeleted,
+	}
+}
+
 const (
 	// TrustDirectionOneWayOutgoing is a TrustDirection enum value
 	TrustDirectionOneWayOutgoing = "One-Way: Outgoing"
@@ -11096,6 +20068,15 @@ const (
 	TrustDirectionTwoWay = "Two-Way"
 )
 
+// TrustDirection_Values returns all elements of the TrustDirection enum
+func TrustDirection_Values() []string {
+	return []string{
+		TrustDirectionOneWayOutgoing,
+		TrustDirectionOneWayIncoming,
+		TrustDirectionTwoWay,
+	}
+}
+
 const (
 	// TrustStateCreating is a TrustState enum value
 	TrustStateCreating = "Creating"
@@ -11131,6 +20112,23 @@ const (
 	TrustStateFailed = "Failed"
 )
 
+// TrustState_Values returns all elements of the TrustState enum
+func TrustState_Values() []string {
+	return []string{
+		TrustStateCreating,
+		TrustStateCreated,
+		TrustStateVerifying,
+		TrustStateVerifyFailed,
+		TrustStateVerified,
+		TrustStateUpdating,
+		TrustStateUpdateFailed,
+		TrustStateUpdated,
+		TrustStateDeleting,
+		TrustStateDeleted,
+		TrustStateFailed,
+	}
+}
+
 const (
 	// TrustTypeForest is a TrustType enum value
 	TrustTypeForest = "Forest"
@@ -11138,3 +20136,43 @@ const (
 	// TrustTypeExternal is a TrustType enum value
 	TrustTypeExternal = "External"
 )
+
+// TrustType_Values returns all elements of the TrustType enum
+func TrustType_Values() []string {
+	return []string{
+		TrustTypeForest,
+		TrustTypeExternal,
+	}
+}
+
+const (
+	// UpdateStatusUpdated is a UpdateStatus enum value
+	UpdateStatusUpdated = "Updated"
+
+	// UpdateStatusUpdating is a UpdateStatus enum value
+	UpdateStatusUpdating = "Updating"
+
+	// UpdateStatusUpdateFailed is a UpdateStatus enum value
+	UpdateStatusUpdateFailed = "UpdateFailed"
+)
+
+// UpdateStatus_Values returns all elements of the UpdateStatus enum
+func UpdateStatus_Values() []string {
+	return []string{
+		UpdateStatusUpdated,
+		UpdateStatusUpdating,
+		UpdateStatusUpdateFailed,
+	}
+}
+
+const (
+	// UpdateTypeOs is a UpdateType enum value
+	UpdateTypeOs = "OS"
+)
+
+// UpdateType_Values returns all elements of the UpdateType enum
+func UpdateType_Values() []string {
+	return []string{
+		UpdateTypeOs,
+	}
+}
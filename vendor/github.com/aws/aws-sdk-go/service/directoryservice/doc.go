@@ -3,26 +3,27 @@
 // Package directoryservice provides the client and types for making API
 // requests to AWS Directory Service.
 //
-// AWS Directory Service is a web service that makes it easy for you to setup
-// and run directories in the AWS cloud, or connect your AWS resources with
-// an existing on-premises Microsoft Active Directory. This guide provides detailed
-// information about AWS Directory Service operations, data types, parameters,
-// and errors. For information about AWS Directory Services features, see AWS
-// Directory Service (https://aws.amazon.com/directoryservice/) and the AWS
-// Directory Service Administration Guide (http://docs.aws.amazon.com/directoryservice/latest/admin-guide/what_is.html).
-//
-// AWS provides SDKs that consist of libraries and sample code for various programming
-// languages and platforms (Java, Ruby, .Net, iOS, Android, etc.). The SDKs
-// provide a convenient way to create programmatic access to AWS Directory Service
-// and other AWS services. For more information about the AWS SDKs, including
-// how to download and install them, see Tools for Amazon Web Services (http://aws.amazon.com/tools/).
+// Directory Service is a web service that makes it easy for you to setup and
+// run directories in the Amazon Web Services cloud, or connect your Amazon
+// Web Services resources with an existing self-managed Microsoft Active Directory.
+// This guide provides detailed information about Directory Service operations,
+// data types, parameters, and errors. For information about Directory Services
+// features, see Directory Service (https://aws.amazon.com/directoryservice/)
+// and the Directory Service Administration Guide (http://docs.aws.amazon.com/directoryservice/latest/admin-guide/what_is.html).
+//
+// Amazon Web Services provides SDKs that consist of libraries and sample code
+// for various programming languages and platforms (Java, Ruby, .Net, iOS, Android,
+// etc.). The SDKs provide a convenient way to create programmatic access to
+// Directory Service and other Amazon Web Services services. For more information
+// about the Amazon Web Services SDKs, including how to download and install
+// them, see Tools for Amazon Web Services (http://aws.amazon.com/tools/).
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16 for more information on this service.
 //
 // See directoryservice package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/directoryservice/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact AWS Directory Service with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
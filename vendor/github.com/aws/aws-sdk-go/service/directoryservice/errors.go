@@ -2,12 +2,16 @@
 
 package directoryservice
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeAccessDeniedException for service response error code
 	// "AccessDeniedException".
 	//
-	// You do not have sufficient access to perform this action.
+	// Client authentication is not available in this region at this time.
 	ErrCodeAccessDeniedException = "AccessDeniedException"
 
 	// ErrCodeAuthenticationFailedException for service response error code
@@ -16,18 +20,64 @@ const (
 	// An authentication error occurred.
 	ErrCodeAuthenticationFailedException = "AuthenticationFailedException"
 
+	// ErrCodeCertificateAlreadyExistsException for service response error code
+	// "CertificateAlreadyExistsException".
+	//
+	// The certificate has already been registered into the system.
+	ErrCodeCertificateAlreadyExistsException = "CertificateAlreadyExistsException"
+
+	// ErrCodeCertificateDoesNotExistException for service response error code
+	// "CertificateDoesNotExistException".
+	//
+	// The certificate is not present in the system for describe or deregister activities.
+	ErrCodeCertificateDoesNotExistException = "CertificateDoesNotExistException"
+
+	// ErrCodeCertificateInUseException for service response error code
+	// "CertificateInUseException".
+	//
+	// The certificate is being used for the LDAP security connection and cannot
+	// be removed without disabling LDAP security.
+	ErrCodeCertificateInUseException = "CertificateInUseException"
+
+	// ErrCodeCertificateLimitExceededException for service response error code
+	// "CertificateLimitExceededException".
+	//
+	// The certificate could not be added because the certificate limit has been
+	// reached.
+	ErrCodeCertificateLimitExceededException = "CertificateLimitExceededException"
+
 	// ErrCodeClientException for service response error code
 	// "ClientException".
 	//
 	// A client exception has occurred.
 	ErrCodeClientException = "ClientException"
 
+	// ErrCodeDirectoryAlreadyInRegionException for service response error code
+	// "DirectoryAlreadyInRegionException".
+	//
+	// The Region you specified is the same Region where the Managed Microsoft AD
+	// directory was created. Specify a different Region and try again.
+	ErrCodeDirectoryAlreadyInRegionException = "DirectoryAlreadyInRegionException"
+
 	// ErrCodeDirectoryAlreadySharedException for service response error code
 	// "DirectoryAlreadySharedException".
 	//
-	// The specified directory has already been shared with this AWS account.
+	// The specified directory has already been shared with this Amazon Web Services
+	// account.
 	ErrCodeDirectoryAlreadySharedException = "DirectoryAlreadySharedException"
 
+	// ErrCodeDirectoryDoesNotExistException for service response error code
+	// "DirectoryDoesNotExistException".
+	//
+	// The specified directory does not exist in the system.
+	ErrCodeDirectoryDoesNotExistException = "DirectoryDoesNotExistException"
+
+	// ErrCodeDirectoryInDesiredStateException for service response error code
+	// "DirectoryInDesiredStateException".
+	//
+	// The directory is already updated to desired update type settings.
+	ErrCodeDirectoryInDesiredStateException = "DirectoryInDesiredStateException"
+
 	// ErrCodeDirectoryLimitExceededException for service response error code
 	// "DirectoryLimitExceededException".
 	//
@@ -39,7 +89,8 @@ const (
 	// ErrCodeDirectoryNotSharedException for service response error code
 	// "DirectoryNotSharedException".
 	//
-	// The specified directory has not been shared with this AWS account.
+	// The specified directory has not been shared with this Amazon Web Services
+	// account.
 	ErrCodeDirectoryNotSharedException = "DirectoryNotSharedException"
 
 	// ErrCodeDirectoryUnavailableException for service response error code
@@ -67,12 +118,37 @@ const (
 	// The specified entity could not be found.
 	ErrCodeEntityDoesNotExistException = "EntityDoesNotExistException"
 
+	// ErrCodeIncompatibleSettingsException for service response error code
+	// "IncompatibleSettingsException".
+	//
+	// The specified directory setting is not compatible with other settings.
+	ErrCodeIncompatibleSettingsException = "IncompatibleSettingsException"
+
 	// ErrCodeInsufficientPermissionsException for service response error code
 	// "InsufficientPermissionsException".
 	//
 	// The account does not have sufficient permission to perform the operation.
 	ErrCodeInsufficientPermissionsException = "InsufficientPermissionsException"
 
+	// ErrCodeInvalidCertificateException for service response error code
+	// "InvalidCertificateException".
+	//
+	// The certificate PEM that was provided has incorrect encoding.
+	ErrCodeInvalidCertificateException = "InvalidCertificateException"
+
+	// ErrCodeInvalidClientAuthStatusException for service response error code
+	// "InvalidClientAuthStatusException".
+	//
+	// Client authentication is already enabled.
+	ErrCodeInvalidClientAuthStatusException = "InvalidClientAuthStatusException"
+
+	// ErrCodeInvalidLDAPSStatusException for service response error code
+	// "InvalidLDAPSStatusException".
+	//
+	// The LDAP activities could not be performed because they are limited by the
+	// LDAPS status.
+	ErrCodeInvalidLDAPSStatusException = "InvalidLDAPSStatusException"
+
 	// ErrCodeInvalidNextTokenException for service response error code
 	// "InvalidNextTokenException".
 	//
@@ -105,23 +181,37 @@ const (
 	// is 100 IP address blocks.
 	ErrCodeIpRouteLimitExceededException = "IpRouteLimitExceededException"
 
+	// ErrCodeNoAvailableCertificateException for service response error code
+	// "NoAvailableCertificateException".
+	//
+	// Client authentication setup could not be completed because at least one valid
+	// certificate must be registered in the system.
+	ErrCodeNoAvailableCertificateException = "NoAvailableCertificateException"
+
 	// ErrCodeOrganizationsException for service response error code
 	// "OrganizationsException".
 	//
-	// Exception encountered while trying to access your AWS organization.
+	// Exception encountered while trying to access your Amazon Web Services organization.
 	ErrCodeOrganizationsException = "OrganizationsException"
 
+	// ErrCodeRegionLimitExceededException for service response error code
+	// "RegionLimitExceededException".
+	//
+	// You have reached the limit for maximum number of simultaneous Region replications
+	// per directory.
+	ErrCodeRegionLimitExceededException = "RegionLimitExceededException"
+
 	// ErrCodeServiceException for service response error code
 	// "ServiceException".
 	//
-	// An exception has occurred in AWS Directory Service.
+	// An exception has occurred in Directory Service.
 	ErrCodeServiceException = "ServiceException"
 
 	// ErrCodeShareLimitExceededException for service response error code
 	// "ShareLimitExceededException".
 	//
-	// The maximum number of AWS accounts that you can share with this directory
-	// has been reached.
+	// The maximum number of Amazon Web Services accounts that you can share with
+	// this directory has been reached.
 	ErrCodeShareLimitExceededException = "ShareLimitExceededException"
 
 	// ErrCodeSnapshotLimitExceededException for service response error code
@@ -144,9 +234,55 @@ const (
 	// The operation is not supported.
 	ErrCodeUnsupportedOperationException = "UnsupportedOperationException"
 
+	// ErrCodeUnsupportedSettingsException for service response error code
+	// "UnsupportedSettingsException".
+	//
+	// The specified directory setting is not supported.
+	ErrCodeUnsupportedSettingsException = "UnsupportedSettingsException"
+
 	// ErrCodeUserDoesNotExistException for service response error code
 	// "UserDoesNotExistException".
 	//
 	// The user provided a username that does not exist in your directory.
 	ErrCodeUserDoesNotExistException = "UserDoesNotExistException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"AccessDeniedException":                  newErrorAccessDeniedException,
+	"AuthenticationFailedException":          newErrorAuthenticationFailedException,
+	"CertificateAlreadyExistsException":      newErrorCertificateAlreadyExistsException,
+	"CertificateDoesNotExistException":       newErrorCertificateDoesNotExistException,
+	"CertificateInUseException":              newErrorCertificateInUseException,
+	"CertificateLimitExceededException":      newErrorCertificateLimitExceededException,
+	"ClientException":                        newErrorClientException,
+	"DirectoryAlreadyInRegionException":      newErrorDirectoryAlreadyInRegionException,
+	"DirectoryAlreadySharedException":        newErrorDirectoryAlreadySharedException,
+	"DirectoryDoesNotExistException":         newErrorDirectoryDoesNotExistException,
+	"DirectoryInDesiredStateException":       newErrorDirectoryInDesiredStateException,
+	"DirectoryLimitExceededException":        newErrorDirectoryLimitExceededException,
+	"DirectoryNotSharedException":            newErrorDirectoryNotSharedException,
+	"DirectoryUnavailableException":          newErrorDirectoryUnavailableException,
+	"DomainControllerLimitExceededException": newErrorDomainControllerLimitExceededException,
+	"EntityAlreadyExistsException":           newErrorEntityAlreadyExistsException,
+	"EntityDoesNotExistException":            newErrorEntityDoesNotExistException,
+	"IncompatibleSettingsException":          newErrorIncompatibleSettingsException,
+	"InsufficientPermissionsException":       newErrorInsufficientPermissionsException,
+	"InvalidCertificateException":            newErrorInvalidCertificateException,
+	"InvalidClientAuthStatusException":       newErrorInvalidClientAuthStatusException,
+	"InvalidLDAPSStatusException":            newErrorInvalidLDAPSStatusException,
+	"InvalidNextTokenException":              newErrorInvalidNextTokenException,
+	"InvalidParameterException":              newErrorInvalidParameterException,
+	"InvalidPasswordException":               newErrorInvalidPasswordException,
+	"InvalidTargetException":                 newErrorInvalidTargetException,
+	"IpRouteLimitExceededException":          newErrorIpRouteLimitExceededException,
+	"NoAvailableCertificateException":        newErrorNoAvailableCertificateException,
+	"OrganizationsException":                 newErrorOrganizationsException,
+	"RegionLimitExceededException":           newErrorRegionLimitExceededException,
+	"ServiceException":                       newErrorServiceException,
+	"ShareLimitExceededException":            newErrorShareLimitExceededException,
+	"SnapshotLimitExceededException":         newErrorSnapshotLimitExceededException,
+	"TagLimitExceededException":              newErrorTagLimitExceededException,
+	"UnsupportedOperationException":          newErrorUnsupportedOperationException,
+	"UnsupportedSettingsException":           newErrorUnsupportedSettingsException,
+	"UserDoesNotExistException":              newErrorUserDoesNotExistException,
+}
@@ -4,12 +4,12 @@
 // requests to Amazon Data Lifecycle Manager.
 //
 // With Amazon Data Lifecycle Manager, you can manage the lifecycle of your
-// AWS resources. You create lifecycle policies, which are used to automate
-// operations on the specified resources.
+// Amazon Web Services resources. You create lifecycle policies, which are used
+// to automate operations on the specified resources.
 //
-// Amazon DLM supports Amazon EBS volumes and snapshots. For information about
-// using Amazon DLM with Amazon EBS, see Automating the Amazon EBS Snapshot
-// Lifecycle (https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/snapshot-lifecycle.html)
+// Amazon Data Lifecycle Manager supports Amazon EBS volumes and snapshots.
+// For information about using Amazon Data Lifecycle Manager with Amazon EBS,
+// see Amazon Data Lifecycle Manager (https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/snapshot-lifecycle.html)
 // in the Amazon EC2 User Guide.
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/dlm-2018-01-12 for more information on this service.
@@ -17,7 +17,7 @@
 // See dlm package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/dlm/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon Data Lifecycle Manager with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
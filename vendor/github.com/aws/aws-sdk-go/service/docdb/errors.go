@@ -8,7 +8,7 @@ const (
 	// "AuthorizationNotFound".
 	//
 	// The specified CIDR IP or Amazon EC2 security group isn't authorized for the
-	// specified DB security group.
+	// specified security group.
 	//
 	// Amazon DocumentDB also might not be authorized to perform necessary actions
 	// on your behalf using IAM.
@@ -23,76 +23,75 @@ const (
 	// ErrCodeDBClusterAlreadyExistsFault for service response error code
 	// "DBClusterAlreadyExistsFault".
 	//
-	// You already have a DB cluster with the given identifier.
+	// You already have a cluster with the given identifier.
 	ErrCodeDBClusterAlreadyExistsFault = "DBClusterAlreadyExistsFault"
 
 	// ErrCodeDBClusterNotFoundFault for service response error code
 	// "DBClusterNotFoundFault".
 	//
-	// DBClusterIdentifier doesn't refer to an existing DB cluster.
+	// DBClusterIdentifier doesn't refer to an existing cluster.
 	ErrCodeDBClusterNotFoundFault = "DBClusterNotFoundFault"
 
 	// ErrCodeDBClusterParameterGroupNotFoundFault for service response error code
 	// "DBClusterParameterGroupNotFound".
 	//
-	// DBClusterParameterGroupName doesn't refer to an existing DB cluster parameter
+	// DBClusterParameterGroupName doesn't refer to an existing cluster parameter
 	// group.
 	ErrCodeDBClusterParameterGroupNotFoundFault = "DBClusterParameterGroupNotFound"
 
 	// ErrCodeDBClusterQuotaExceededFault for service response error code
 	// "DBClusterQuotaExceededFault".
 	//
-	// The DB cluster can't be created because you have reached the maximum allowed
-	// quota of DB clusters.
+	// The cluster can't be created because you have reached the maximum allowed
+	// quota of clusters.
 	ErrCodeDBClusterQuotaExceededFault = "DBClusterQuotaExceededFault"
 
 	// ErrCodeDBClusterSnapshotAlreadyExistsFault for service response error code
 	// "DBClusterSnapshotAlreadyExistsFault".
 	//
-	// You already have a DB cluster snapshot with the given identifier.
+	// You already have a cluster snapshot with the given identifier.
 	ErrCodeDBClusterSnapshotAlreadyExistsFault = "DBClusterSnapshotAlreadyExistsFault"
 
 	// ErrCodeDBClusterSnapshotNotFoundFault for service response error code
 	// "DBClusterSnapshotNotFoundFault".
 	//
-	// DBClusterSnapshotIdentifier doesn't refer to an existing DB cluster snapshot.
+	// DBClusterSnapshotIdentifier doesn't refer to an existing cluster snapshot.
 	ErrCodeDBClusterSnapshotNotFoundFault = "DBClusterSnapshotNotFoundFault"
 
 	// ErrCodeDBInstanceAlreadyExistsFault for service response error code
 	// "DBInstanceAlreadyExists".
 	//
-	// You already have a DB instance with the given identifier.
+	// You already have a instance with the given identifier.
 	ErrCodeDBInstanceAlreadyExistsFault = "DBInstanceAlreadyExists"
 
 	// ErrCodeDBInstanceNotFoundFault for service response error code
 	// "DBInstanceNotFound".
 	//
-	// DBInstanceIdentifier doesn't refer to an existing DB instance.
+	// DBInstanceIdentifier doesn't refer to an existing instance.
 	ErrCodeDBInstanceNotFoundFault = "DBInstanceNotFound"
 
 	// ErrCodeDBParameterGroupAlreadyExistsFault for service response error code
 	// "DBParameterGroupAlreadyExists".
 	//
-	// A DB parameter group with the same name already exists.
+	// A parameter group with the same name already exists.
 	ErrCodeDBParameterGroupAlreadyExistsFault = "DBParameterGroupAlreadyExists"
 
 	// ErrCodeDBParameterGroupNotFoundFault for service response error code
 	// "DBParameterGroupNotFound".
 	//
-	// DBParameterGroupName doesn't refer to an existing DB parameter group.
+	// DBParameterGroupName doesn't refer to an existing parameter group.
 	ErrCodeDBParameterGroupNotFoundFault = "DBParameterGroupNotFound"
 
 	// ErrCodeDBParameterGroupQuotaExceededFault for service response error code
 	// "DBParameterGroupQuotaExceeded".
 	//
-	// This request would cause you to exceed the allowed number of DB parameter
-	// groups.
+	// This request would cause you to exceed the allowed number of parameter groups.
 	ErrCodeDBParameterGroupQuotaExceededFault = "DBParameterGroupQuotaExceeded"
 
 	// ErrCodeDBSecurityGroupNotFoundFault for service response error code
 	// "DBSecurityGroupNotFound".
 	//
-	// DBSecurityGroupName doesn't refer to an existing DB security group.
+	// DBSecurityGroupName doesn't refer to an existing security group.
 	ErrCodeDBSecurityGroupNotFoundFault = "DBSecurityGroupNotFound"
 
 	// ErrCodeDBSnapshotAlreadyExistsFault for service response error code
@@ -104,64 +103,89 @@ const (
 	// ErrCodeDBSnapshotNotFoundFault for service response error code
 	// "DBSnapshotNotFound".
 	//
-	// DBSnapshotIdentifier doesn't refer to an existing DB snapshot.
+	// DBSnapshotIdentifier doesn't refer to an existing snapshot.
 	ErrCodeDBSnapshotNotFoundFault = "DBSnapshotNotFound"
 
 	// ErrCodeDBSubnetGroupAlreadyExistsFault for service response error code
 	// "DBSubnetGroupAlreadyExists".
 	//
-	// DBSubnetGroupName is already being used by an existing DB subnet group.
+	// DBSubnetGroupName is already being used by an existing subnet group.
 	ErrCodeDBSubnetGroupAlreadyExistsFault = "DBSubnetGroupAlreadyExists"
 
 	// ErrCodeDBSubnetGroupDoesNotCoverEnoughAZs for service response error code
 	// "DBSubnetGroupDoesNotCoverEnoughAZs".
 	//
-	// Subnets in the DB subnet group should cover at least two Availability Zones
+	// Subnets in the subnet group should cover at least two Availability Zones
 	// unless there is only one Availability Zone.
 	ErrCodeDBSubnetGroupDoesNotCoverEnoughAZs = "DBSubnetGroupDoesNotCoverEnoughAZs"
 
 	// ErrCodeDBSubnetGroupNotFoundFault for service response error code
 	// "DBSubnetGroupNotFoundFault".
 	//
-	// DBSubnetGroupName doesn't refer to an existing DB subnet group.
+	// DBSubnetGroupName doesn't refer to an existing subnet group.
 	ErrCodeDBSubnetGroupNotFoundFault = "DBSubnetGroupNotFoundFault"
 
 	// ErrCodeDBSubnetGroupQuotaExceededFault for service response error code
 	// "DBSubnetGroupQuotaExceeded".
 	//
-	// The request would cause you to exceed the allowed number of DB subnet groups.
+	// The request would cause you to exceed the allowed number of subnet groups.
 	ErrCodeDBSubnetGroupQuotaExceededFault = "DBSubnetGroupQuotaExceeded"
 
 	// ErrCodeDBSubnetQuotaExceededFault for service response error code
 	// "DBSubnetQuotaExceededFault".
 	//
 	// The request would cause you to exceed the allowed number of subnets in a
-	// DB subnet group.
+	// subnet group.
 	ErrCodeDBSubnetQuotaExceededFault = "DBSubnetQuotaExceededFault"
 
 	// ErrCodeDBUpgradeDependencyFailureFault for service response error code
 	// "DBUpgradeDependencyFailure".
 	//
-	// The DB upgrade failed because a resource that the DB depends on can't be
-	// modified.
+	// The upgrade failed because a resource that the depends on can't be modified.
 	ErrCodeDBUpgradeDependencyFailureFault = "DBUpgradeDependencyFailure"
 
+	// ErrCodeEventSubscriptionQuotaExceededFault for service response error code
+	// "EventSubscriptionQuotaExceeded".
+	//
+	// You have reached the maximum number of event subscriptions.
+	ErrCodeEventSubscriptionQuotaExceededFault = "EventSubscriptionQuotaExceeded"
+
+	// ErrCodeGlobalClusterAlreadyExistsFault for service response error code
+	// "GlobalClusterAlreadyExistsFault".
+	//
+	// The GlobalClusterIdentifier already exists. Choose a new global cluster identifier
+	// (unique name) to create a new global cluster.
+	ErrCodeGlobalClusterAlreadyExistsFault = "GlobalClusterAlreadyExistsFault"
+
+	// ErrCodeGlobalClusterNotFoundFault for service response error code
+	// "GlobalClusterNotFoundFault".
+	//
+	// The GlobalClusterIdentifier doesn't refer to an existing global cluster.
+	ErrCodeGlobalClusterNotFoundFault = "GlobalClusterNotFoundFault"
+
+	// ErrCodeGlobalClusterQuotaExceededFault for service response error code
+	// "GlobalClusterQuotaExceededFault".
+	//
+	// The number of global clusters for this account is already at the maximum
+	// allowed.
+	ErrCodeGlobalClusterQuotaExceededFault = "GlobalClusterQuotaExceededFault"
+
 	// ErrCodeInstanceQuotaExceededFault for service response error code
 	// "InstanceQuotaExceeded".
 	//
-	// The request would cause you to exceed the allowed number of DB instances.
+	// The request would cause you to exceed the allowed number of instances.
 	ErrCodeInstanceQuotaExceededFault = "InstanceQuotaExceeded"
 
 	// ErrCodeInsufficientDBClusterCapacityFault for service response error code
 	// "InsufficientDBClusterCapacityFault".
 	//
-	// The DB cluster doesn't have enough capacity for the current operation.
+	// The cluster doesn't have enough capacity for the current operation.
 	ErrCodeInsufficientDBClusterCapacityFault = "InsufficientDBClusterCapacityFault"
 
 	// ErrCodeInsufficientDBInstanceCapacityFault for service response error code
 	// "InsufficientDBInstanceCapacity".
 	//
-	// The specified DB instance class isn't available in the specified Availability
+	// The specified instance class isn't available in the specified Availability
 	// Zone.
 	ErrCodeInsufficientDBInstanceCapacityFault = "InsufficientDBInstanceCapacity"
 
@@ -176,53 +200,66 @@ const (
 	// ErrCodeInvalidDBClusterSnapshotStateFault for service response error code
 	// "InvalidDBClusterSnapshotStateFault".
 	//
-	// The provided value isn't a valid DB cluster snapshot state.
+	// The provided value isn't a valid cluster snapshot state.
 	ErrCodeInvalidDBClusterSnapshotStateFault = "InvalidDBClusterSnapshotStateFault"
 
 	// ErrCodeInvalidDBClusterStateFault for service response error code
 	// "InvalidDBClusterStateFault".
 	//
-	// The DB cluster isn't in a valid state.
+	// The cluster isn't in a valid state.
 	ErrCodeInvalidDBClusterStateFault = "InvalidDBClusterStateFault"
 
 	// ErrCodeInvalidDBInstanceStateFault for service response error code
 	// "InvalidDBInstanceState".
 	//
-	// The specified DB instance isn't in the available state.
+	// The specified instance isn't in the available state.
 	ErrCodeInvalidDBInstanceStateFault = "InvalidDBInstanceState"
 
 	// ErrCodeInvalidDBParameterGroupStateFault for service response error code
 	// "InvalidDBParameterGroupState".
 	//
-	// The DB parameter group is in use, or it is in a state that is not valid.
-	// If you are trying to delete the parameter group, you can't delete it when
-	// the parameter group is in this state.
+	// The parameter group is in use, or it is in a state that is not valid. If
+	// you are trying to delete the parameter group, you can't delete it when the
+	// parameter group is in this state.
 	ErrCodeInvalidDBParameterGroupStateFault = "InvalidDBParameterGroupState"
 
 	// ErrCodeInvalidDBSecurityGroupStateFault for service response error code
 	// "InvalidDBSecurityGroupState".
 	//
-	// The state of the DB security group doesn't allow deletion.
+	// The state of the security group doesn't allow deletion.
 	ErrCodeInvalidDBSecurityGroupStateFault = "InvalidDBSecurityGroupState"
 
 	// ErrCodeInvalidDBSnapshotStateFault for service response error code
 	// "InvalidDBSnapshotState".
 	//
-	// The state of the DB snapshot doesn't allow deletion.
+	// The state of the snapshot doesn't allow deletion.
 	ErrCodeInvalidDBSnapshotStateFault = "InvalidDBSnapshotState"
 
 	// ErrCodeInvalidDBSubnetGroupStateFault for service response error code
 	// "InvalidDBSubnetGroupStateFault".
 	//
-	// The DB subnet group can't be deleted because it's in use.
+	// The subnet group can't be deleted because it's in use.
 	ErrCodeInvalidDBSubnetGroupStateFault = "InvalidDBSubnetGroupStateFault"
 
 	// ErrCodeInvalidDBSubnetStateFault for service response error code
 	// "InvalidDBSubnetStateFault".
 	//
-	// The DB subnet isn't in the available state.
+	// The subnet isn't in the available state.
 	ErrCodeInvalidDBSubnetStateFault = "InvalidDBSubnetStateFault"
 
+	// ErrCodeInvalidEventSubscriptionStateFault for service response error code
+	// "InvalidEventSubscriptionState".
+	//
+	// Someone else might be modifying a subscription. Wait a few seconds, and try
+	// again.
+	ErrCodeInvalidEventSubscriptionStateFault = "InvalidEventSubscriptionState"
+
+	// ErrCodeInvalidGlobalClusterStateFault for service response error code
+	// "InvalidGlobalClusterStateFault".
+	//
+	// The requested operation can't be performed while the cluster is in this state.
+	ErrCodeInvalidGlobalClusterStateFault = "InvalidGlobalClusterStateFault"
+
 	// ErrCodeInvalidRestoreFault for service response error code
 	// "InvalidRestoreFault".
 	//
@@ -240,14 +277,14 @@ const (
 	// ErrCodeInvalidVPCNetworkStateFault for service response error code
 	// "InvalidVPCNetworkStateFault".
 	//
-	// The DB subnet group doesn't cover all Availability Zones after it is created
+	// The subnet group doesn't cover all Availability Zones after it is created
 	// because of changes that were made.
 	ErrCodeInvalidVPCNetworkStateFault = "InvalidVPCNetworkStateFault"
 
 	// ErrCodeKMSKeyNotAccessibleFault for service response error code
 	// "KMSKeyNotAccessibleFault".
 	//
-	// An error occurred when accessing an AWS KMS key.
+	// An error occurred when accessing an KMS key.
 	ErrCodeKMSKeyNotAccessibleFault = "KMSKeyNotAccessibleFault"
 
 	// ErrCodeResourceNotFoundFault for service response error code
@@ -256,6 +293,25 @@ const (
 	// The specified resource ID was not found.
 	ErrCodeResourceNotFoundFault = "ResourceNotFoundFault"
 
+	// ErrCodeSNSInvalidTopicFault for service response error code
+	// "SNSInvalidTopic".
+	//
+	// Amazon SNS has responded that there is a problem with the specified topic.
+	ErrCodeSNSInvalidTopicFault = "SNSInvalidTopic"
+
+	// ErrCodeSNSNoAuthorizationFault for service response error code
+	// "SNSNoAuthorization".
+	//
+	// You do not have permission to publish to the SNS topic Amazon Resource Name
+	// (ARN).
+	ErrCodeSNSNoAuthorizationFault = "SNSNoAuthorization"
+
+	// ErrCodeSNSTopicArnNotFoundFault for service response error code
+	// "SNSTopicArnNotFound".
+	//
+	// The SNS topic Amazon Resource Name (ARN) does not exist.
+	ErrCodeSNSTopicArnNotFoundFault = "SNSTopicArnNotFound"
+
 	// ErrCodeSharedSnapshotQuotaExceededFault for service response error code
 	// "SharedSnapshotQuotaExceeded".
 	//
@@ -266,14 +322,20 @@ const (
 	// ErrCodeSnapshotQuotaExceededFault for service response error code
 	// "SnapshotQuotaExceeded".
 	//
-	// The request would cause you to exceed the allowed number of DB snapshots.
+	// The request would cause you to exceed the allowed number of snapshots.
 	ErrCodeSnapshotQuotaExceededFault = "SnapshotQuotaExceeded"
 
+	// ErrCodeSourceNotFoundFault for service response error code
+	// "SourceNotFound".
+	//
+	// The requested source could not be found.
+	ErrCodeSourceNotFoundFault = "SourceNotFound"
+
 	// ErrCodeStorageQuotaExceededFault for service response error code
 	// "StorageQuotaExceeded".
 	//
 	// The request would cause you to exceed the allowed amount of storage available
-	// across all DB instances.
+	// across all instances.
 	ErrCodeStorageQuotaExceededFault = "StorageQuotaExceeded"
 
 	// ErrCodeStorageTypeNotSupportedFault for service response error code
@@ -285,6 +347,24 @@ const (
 	// ErrCodeSubnetAlreadyInUse for service response error code
 	// "SubnetAlreadyInUse".
 	//
-	// The DB subnet is already in use in the Availability Zone.
+	// The subnet is already in use in the Availability Zone.
 	ErrCodeSubnetAlreadyInUse = "SubnetAlreadyInUse"
+
+	// ErrCodeSubscriptionAlreadyExistFault for service response error code
+	// "SubscriptionAlreadyExist".
+	//
+	// The provided subscription name already exists.
+	ErrCodeSubscriptionAlreadyExistFault = "SubscriptionAlreadyExist"
+
+	// ErrCodeSubscriptionCategoryNotFoundFault for service response error code
+	// "SubscriptionCategoryNotFound".
+	//
+	// The provided category does not exist.
+	ErrCodeSubscriptionCategoryNotFoundFault = "SubscriptionCategoryNotFound"
+
+	// ErrCodeSubscriptionNotFoundFault for service response error code
+	// "SubscriptionNotFound".
+	//
+	// The subscription name does not exist.
+	ErrCodeSubscriptionNotFoundFault = "SubscriptionNotFound"
 )
@@ -2,6 +2,10 @@
 
 package dynamodb
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeBackupInUseException for service response error code
@@ -29,6 +33,25 @@ const (
 	// Backups have not yet been enabled for this table.
 	ErrCodeContinuousBackupsUnavailableException = "ContinuousBackupsUnavailableException"
 
+	// ErrCodeDuplicateItemException for service response error code
+	// "DuplicateItemException".
+	//
+	// There was an attempt to insert an item with the same primary key as an item
+	// that already exists in the DynamoDB table.
+	ErrCodeDuplicateItemException = "DuplicateItemException"
+
+	// ErrCodeExportConflictException for service response error code
+	// "ExportConflictException".
+	//
+	// There was a conflict when writing to the specified S3 bucket.
+	ErrCodeExportConflictException = "ExportConflictException"
+
+	// ErrCodeExportNotFoundException for service response error code
+	// "ExportNotFoundException".
+	//
+	// The specified export was not found.
+	ErrCodeExportNotFoundException = "ExportNotFoundException"
+
 	// ErrCodeGlobalTableAlreadyExistsException for service response error code
 	// "GlobalTableAlreadyExistsException".
 	//
@@ -48,6 +71,20 @@ const (
 	// payload but with an idempotent token that was already used.
 	ErrCodeIdempotentParameterMismatchException = "IdempotentParameterMismatchException"
 
+	// ErrCodeImportConflictException for service response error code
+	// "ImportConflictException".
+	//
+	// There was a conflict when importing from the specified S3 source. This can
+	// occur when the current import conflicts with a previous import request that
+	// had the same client token.
+	ErrCodeImportConflictException = "ImportConflictException"
+
+	// ErrCodeImportNotFoundException for service response error code
+	// "ImportNotFoundException".
+	//
+	// The specified import was not found.
+	ErrCodeImportNotFoundException = "ImportNotFoundException"
+
 	// ErrCodeIndexNotFoundException for service response error code
 	// "IndexNotFoundException".
 	//
@@ -60,6 +97,12 @@ const (
 	// An error occurred on the server side.
 	ErrCodeInternalServerError = "InternalServerError"
 
+	// ErrCodeInvalidExportTimeException for service response error code
+	// "InvalidExportTimeException".
+	//
+	// The specified ExportTime is outside of the point in time recovery window.
+	ErrCodeInvalidExportTimeException = "InvalidExportTimeException"
+
 	// ErrCodeInvalidRestoreTimeException for service response error code
 	// "InvalidRestoreTimeException".
 	//
@@ -79,16 +122,25 @@ const (
 	//
 	// There is no limit to the number of daily on-demand backups that can be taken.
 	//
-	// Up to 50 simultaneous table operations are allowed per account. These operations
-	// include CreateTable, UpdateTable, DeleteTable,UpdateTimeToLive, RestoreTableFromBackup,
-	// and RestoreTableToPointInTime.
+	// For most purposes, up to 500 simultaneous table operations are allowed per
+	// account. These operations include CreateTable, UpdateTable, DeleteTable,UpdateTimeToLive,
+	// RestoreTableFromBackup, and RestoreTableToPointInTime.
 	//
-	// The only exception is when you are creating a table with one or more secondary
-	// indexes. You can have up to 25 such requests running at a time; however,
-	// if the table or index specifications are complex, DynamoDB might temporarily
-	// reduce the number of concurrent operations.
+	// When you are creating a table with one or more secondary indexes, you can
+	// have up to 250 such requests running at a time. However, if the table or
+	// index specifications are complex, then DynamoDB might temporarily reduce
+	// the number of concurrent operations.
 	//
-	// There is a soft account limit of 256 tables.
+	// When importing into DynamoDB, up to 50 simultaneous import table operations
+	// are allowed per account.
+	//
+	// There is a soft account quota of 2,500 tables.
+	//
+	// GetRecords was called with a value of more than 1000 for the limit request
+	// parameter.
+	//
+	// More than 2 processes are reading from the same streams shard at the same
+	// time. Exceeding this limit may result in request throttling.
 	ErrCodeLimitExceededException = "LimitExceededException"
 
 	// ErrCodePointInTimeRecoveryUnavailableException for service response error code
@@ -100,11 +152,11 @@ const (
 	// ErrCodeProvisionedThroughputExceededException for service response error code
 	// "ProvisionedThroughputExceededException".
 	//
-	// Your request rate is too high. The AWS SDKs for DynamoDB automatically retry
-	// requests that receive this exception. Your request is eventually successful,
-	// unless your retry queue is too large to finish. Reduce the frequency of requests
-	// and use exponential backoff. For more information, go to Error Retries and
-	// Exponential Backoff (https://docs.aws.amazon.com/amazondynamodb/latest/developerguide/Programming.Errors.html#Programming.Errors.RetryAndBackoff)
+	// Your request rate is too high. The Amazon Web Services SDKs for DynamoDB
+	// automatically retry requests that receive this exception. Your request is
+	// eventually successful, unless your retry queue is too large to finish. Reduce
+	// the frequency of requests and use exponential backoff. For more information,
+	// go to Error Retries and Exponential Backoff (https://docs.aws.amazon.com/amazondynamodb/latest/developerguide/Programming.Errors.html#Programming.Errors.RetryAndBackoff)
 	// in the Amazon DynamoDB Developer Guide.
 	ErrCodeProvisionedThroughputExceededException = "ProvisionedThroughputExceededException"
 
@@ -123,9 +175,9 @@ const (
 	// ErrCodeRequestLimitExceeded for service response error code
 	// "RequestLimitExceeded".
 	//
-	// Throughput exceeds the current throughput limit for your account. Please
-	// contact AWS Support at AWS Support (https://aws.amazon.com/support) to request
-	// a limit increase.
+	// Throughput exceeds the current throughput quota for your account. Please
+	// contact Amazon Web Services Support (https://aws.amazon.com/support) to request
+	// a quota increase.
 	ErrCodeRequestLimitExceeded = "RequestLimitExceeded"
 
 	// ErrCodeResourceInUseException for service response error code
@@ -159,7 +211,8 @@ const (
 	// "TableNotFoundException".
 	//
 	// A source table with the name TableName does not currently exist within the
-	// subscriber's account.
+	// subscriber's account or the subscriber is operating in the wrong Amazon Web
+	// Services Region.
 	ErrCodeTableNotFoundException = "TableNotFoundException"
 
 	// ErrCodeTransactionCanceledException for service response error code
@@ -184,10 +237,12 @@ const (
 	//    index (LSI) becomes too large, or a similar validation error occurs because
 	//    of changes made by the transaction.
 	//
-	//    * The aggregate size of the items in the transaction exceeds 4 MBs.
-	//
 	//    * There is a user error, such as an invalid data format.
 	//
+	//    * There is an ongoing TransactWriteItems operation that conflicts with
+	//    a concurrent TransactWriteItems request. In this case the TransactWriteItems
+	//    operation fails with a TransactionCanceledException.
+	//
 	// DynamoDB cancels a TransactGetItems request under the following circumstances:
 	//
 	//    * There is an ongoing TransactGetItems operation that conflicts with a
@@ -200,18 +255,16 @@ const (
 	//    * There is insufficient provisioned capacity for the transaction to be
 	//    completed.
 	//
-	//    * The aggregate size of the items in the transaction exceeds 4 MBs.
-	//
 	//    * There is a user error, such as an invalid data format.
 	//
 	// If using Java, DynamoDB lists the cancellation reasons on the CancellationReasons
 	// property. This property is not set for other languages. Transaction cancellation
 	// reasons are ordered in the order of requested items, if an item has no error
-	// it will have NONE code and Null message.
+	// it will have None code and Null message.
 	//
 	// Cancellation reason codes and possible error messages:
 	//
-	//    * No Errors: Code: NONE Message: null
+	//    * No Errors: Code: None Message: null
 	//
 	//    * Conditional Check Failed: Code: ConditionalCheckFailed Message: The
 	//    conditional request failed.
@@ -240,7 +293,7 @@ const (
 	//    as DynamoDB is automatically scaling the table. Throughput exceeds the
 	//    current capacity for one or more global secondary indexes. DynamoDB is
 	//    automatically scaling your index so please try again shortly. This message
-	//    is returned when when writes get throttled on an On-Demand GSI as DynamoDB
+	//    is returned when writes get throttled on an On-Demand GSI as DynamoDB
 	//    is automatically scaling the GSI.
 	//
 	//    * Validation Error: Code: ValidationError Messages: One or more parameter
@@ -266,5 +319,80 @@ const (
 	// "TransactionInProgressException".
 	//
 	// The transaction with the given request token is already in progress.
+	//
+	// Recommended Settings
+	//
+	// This is a general recommendation for handling the TransactionInProgressException.
+	// These settings help ensure that the client retries will trigger completion
+	// of the ongoing TransactWriteItems request.
+	//
+	//    * Set clientExecutionTimeout to a value that allows at least one retry
+	//    to be processed after 5 seconds have elapsed since the first attempt for
+	//    the TransactWriteItems operation.
+	//
+	//    * Set socketTimeout to a value a little lower than the requestTimeout
+	//    setting.
+	//
+	//    * requestTimeout should be set based on the time taken for the individual
+	//    retries of a single HTTP request for your use case, but setting it to
+	//    1 second or higher should work well to reduce chances of retries and TransactionInProgressException
+	//    errors.
+	//
+	//    * Use exponential backoff when retrying and tune backoff if needed.
+	//
+	// Assuming default retry policy (https://github.com/aws/aws-sdk-java/blob/fd409dee8ae23fb8953e0bb4dbde65536a7e0514/aws-java-sdk-core/src/main/java/com/amazonaws/retry/PredefinedRetryPolicies.java#L97),
+	// example timeout settings based on the guidelines above are as follows:
+	//
+	// Example timeline:
+	//
+	//    * 0-1000 first attempt
+	//
+	//    * 1000-1500 first sleep/delay (default retry policy uses 500 ms as base
+	//    delay for 4xx errors)
+	//
+	//    * 1500-2500 second attempt
+	//
+	//    * 2500-3500 second sleep/delay (500 * 2, exponential backoff)
+	//
+	//    * 3500-4500 third attempt
+	//
+	//    * 4500-6500 third sleep/delay (500 * 2^2)
+	//
+	//    * 6500-7500 fourth attempt (this can trigger inline recovery since 5 seconds
+	//    have elapsed since the first attempt reached TC)
 	ErrCodeTransactionInProgressException = "TransactionInProgressException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"BackupInUseException":                     newErrorBackupInUseException,
+	"BackupNotFoundException":                  newErrorBackupNotFoundException,
+	"ConditionalCheckFailedException":          newErrorConditionalCheckFailedException,
+	"ContinuousBackupsUnavailableException":    newErrorContinuousBackupsUnavailableException,
+	"DuplicateItemException":                   newErrorDuplicateItemException,
+	"ExportConflictException":                  newErrorExportConflictException,
+	"ExportNotFoundException":                  newErrorExportNotFoundException,
+	"GlobalTableAlreadyExistsException":        newErrorGlobalTableAlreadyExistsException,
+	"GlobalTableNotFoundException":             newErrorGlobalTableNotFoundException,
+	"IdempotentParameterMismatchException":     newErrorIdempotentParameterMismatchException,
+	"ImportConflictException":                  newErrorImportConflictException,
+	"ImportNotFoundException":                  newErrorImportNotFoundException,
+	"IndexNotFoundException":                   newErrorIndexNotFoundException,
+	"InternalServerError":                      newErrorInternalServerError,
+	"InvalidExportTimeException":               newErrorInvalidExportTimeException,
+	"InvalidRestoreTimeException":              newErrorInvalidRestoreTimeException,
+	"ItemCollectionSizeLimitExceededException": newErrorItemCollectionSizeLimitExceededException,
+	"LimitExceededException":                   newErrorLimitExceededException,
+	"PointInTimeRecoveryUnavailableException":  newErrorPointInTimeRecoveryUnavailableException,
+	"ProvisionedThroughputExceededException":   newErrorProvisionedThroughputExceededException,
+	"ReplicaAlreadyExistsException":            newErrorReplicaAlreadyExistsException,
+	"ReplicaNotFoundException":                 newErrorReplicaNotFoundException,
+	"RequestLimitExceeded":                     newErrorRequestLimitExceeded,
+	"ResourceInUseException":                   newErrorResourceInUseException,
+	"ResourceNotFoundException":                newErrorResourceNotFoundException,
+	"TableAlreadyExistsException":              newErrorTableAlreadyExistsException,
+	"TableInUseException":                      newErrorTableInUseException,
+	"TableNotFoundException":                   newErrorTableNotFoundException,
+	"TransactionCanceledException":             newErrorTransactionCanceledException,
+	"TransactionConflictException":             newErrorTransactionConflictException,
+	"TransactionInProgressException":           newErrorTransactionInProgressException,
+}
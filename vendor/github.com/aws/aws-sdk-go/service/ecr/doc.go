@@ -3,19 +3,24 @@
 // Package ecr provides the client and types for making API
 // requests to Amazon EC2 Container Registry.
 //
-// Amazon Elastic Container Registry (Amazon ECR) is a managed Docker registry
-// service. Customers can use the familiar Docker CLI to push, pull, and manage
-// images. Amazon ECR provides a secure, scalable, and reliable registry. Amazon
-// ECR supports private Docker repositories with resource-based permissions
+// Amazon Elastic Container Registry (Amazon ECR) is a managed container image
+// registry service. Customers can use the familiar Docker CLI, or their preferred
+// client, to push, pull, and manage images. Amazon ECR provides a secure, scalable,
+// and reliable registry for your Docker or Open Container Initiative (OCI)
+// images. Amazon ECR supports private repositories with resource-based permissions
 // using IAM so that specific users or Amazon EC2 instances can access repositories
-// and images. Developers can use the Docker CLI to author and manage images.
+// and images.
+//
+// Amazon ECR has service endpoints in each supported Region. For more information,
+// see Amazon ECR endpoints (https://docs.aws.amazon.com/general/latest/gr/ecr.html)
+// in the Amazon Web Services General Reference.
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/ecr-2015-09-21 for more information on this service.
 //
 // See ecr package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/ecr/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon EC2 Container Registry with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -2,6 +2,10 @@
 
 package ecr
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeEmptyUploadException for service response error code
@@ -17,6 +21,13 @@ const (
 	// the manifest or image tag after the last push.
 	ErrCodeImageAlreadyExistsException = "ImageAlreadyExistsException"
 
+	// ErrCodeImageDigestDoesNotMatchException for service response error code
+	// "ImageDigestDoesNotMatchException".
+	//
+	// The specified image digest does not match the digest that Amazon ECR calculated
+	// for the image.
+	ErrCodeImageDigestDoesNotMatchException = "ImageDigestDoesNotMatchException"
+
 	// ErrCodeImageNotFoundException for service response error code
 	// "ImageNotFoundException".
 	//
@@ -59,6 +70,12 @@ const (
 	// characters.
 	ErrCodeInvalidTagParameterException = "InvalidTagParameterException"
 
+	// ErrCodeKmsException for service response error code
+	// "KmsException".
+	//
+	// The operation failed due to a KMS exception.
+	ErrCodeKmsException = "KmsException"
+
 	// ErrCodeLayerAlreadyExistsException for service response error code
 	// "LayerAlreadyExistsException".
 	//
@@ -94,8 +111,8 @@ const (
 	// ErrCodeLifecyclePolicyPreviewInProgressException for service response error code
 	// "LifecyclePolicyPreviewInProgressException".
 	//
-	// The previous lifecycle policy preview request has not completed. Please try
-	// again later.
+	// The previous lifecycle policy preview request has not completed. Wait and
+	// try again.
 	ErrCodeLifecyclePolicyPreviewInProgressException = "LifecyclePolicyPreviewInProgressException"
 
 	// ErrCodeLifecyclePolicyPreviewNotFoundException for service response error code
@@ -108,11 +125,36 @@ const (
 	// "LimitExceededException".
 	//
 	// The operation did not succeed because it would have exceeded a service limit
-	// for your account. For more information, see Amazon ECR Default Service Limits
-	// (https://docs.aws.amazon.com/AmazonECR/latest/userguide/service_limits.html)
+	// for your account. For more information, see Amazon ECR service quotas (https://docs.aws.amazon.com/AmazonECR/latest/userguide/service-quotas.html)
 	// in the Amazon Elastic Container Registry User Guide.
 	ErrCodeLimitExceededException = "LimitExceededException"
 
+	// ErrCodePullThroughCacheRuleAlreadyExistsException for service response error code
+	// "PullThroughCacheRuleAlreadyExistsException".
+	//
+	// A pull through cache rule with these settings already exists for the private
+	// registry.
+	ErrCodePullThroughCacheRuleAlreadyExistsException = "PullThroughCacheRuleAlreadyExistsException"
+
+	// ErrCodePullThroughCacheRuleNotFoundException for service response error code
+	// "PullThroughCacheRuleNotFoundException".
+	//
+	// The pull through cache rule was not found. Specify a valid pull through cache
+	// rule and try again.
+	ErrCodePullThroughCacheRuleNotFoundException = "PullThroughCacheRuleNotFoundException"
+
+	// ErrCodeReferencedImagesNotFoundException for service response error code
+	// "ReferencedImagesNotFoundException".
+	//
+	// The manifest list is referencing an image that does not exist.
+	ErrCodeReferencedImagesNotFoundException = "ReferencedImagesNotFoundException"
+
+	// ErrCodeRegistryPolicyNotFoundException for service response error code
+	// "RegistryPolicyNotFoundException".
+	//
+	// The registry doesn't have an associated registry policy.
+	ErrCodeRegistryPolicyNotFoundException = "RegistryPolicyNotFoundException"
+
 	// ErrCodeRepositoryAlreadyExistsException for service response error code
 	// "RepositoryAlreadyExistsException".
 	//
@@ -140,6 +182,13 @@ const (
 	// repository policy.
 	ErrCodeRepositoryPolicyNotFoundException = "RepositoryPolicyNotFoundException"
 
+	// ErrCodeScanNotFoundException for service response error code
+	// "ScanNotFoundException".
+	//
+	// The specified image scan could not be found. Ensure that image scanning is
+	// enabled on the repository and try again.
+	ErrCodeScanNotFoundException = "ScanNotFoundException"
+
 	// ErrCodeServerException for service response error code
 	// "ServerException".
 	//
@@ -153,10 +202,64 @@ const (
 	// of tags that can be applied to a repository is 50.
 	ErrCodeTooManyTagsException = "TooManyTagsException"
 
+	// ErrCodeUnsupportedImageTypeException for service response error code
+	// "UnsupportedImageTypeException".
+	//
+	// The image is of a type that cannot be scanned.
+	ErrCodeUnsupportedImageTypeException = "UnsupportedImageTypeException"
+
+	// ErrCodeUnsupportedUpstreamRegistryException for service response error code
+	// "UnsupportedUpstreamRegistryException".
+	//
+	// The specified upstream registry isn't supported.
+	ErrCodeUnsupportedUpstreamRegistryException = "UnsupportedUpstreamRegistryException"
+
 	// ErrCodeUploadNotFoundException for service response error code
 	// "UploadNotFoundException".
 	//
-	// The upload could not be found, or the specified upload id is not valid for
+	// The upload could not be found, or the specified upload ID is not valid for
 	// this repository.
 	ErrCodeUploadNotFoundException = "UploadNotFoundException"
+
+	// ErrCodeValidationException for service response error code
+	// "ValidationException".
+	//
+	// There was an exception validating this request.
+	ErrCodeValidationException = "ValidationException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"EmptyUploadException":                       newErrorEmptyUploadException,
+	"ImageAlreadyExistsException":                newErrorImageAlreadyExistsException,
+	"ImageDigestDoesNotMatchException":           newErrorImageDigestDoesNotMatchException,
+	"ImageNotFoundException":                     newErrorImageNotFoundException,
+	"ImageTagAlreadyExistsException":             newErrorImageTagAlreadyExistsException,
+	"InvalidLayerException":                      newErrorInvalidLayerException,
+	"InvalidLayerPartException":                  newErrorInvalidLayerPartException,
+	"InvalidParameterException":                  newErrorInvalidParameterException,
+	"InvalidTagParameterException":               newErrorInvalidTagParameterException,
+	"KmsException":                               newErrorKmsException,
+	"LayerAlreadyExistsException":                newErrorLayerAlreadyExistsException,
+	"LayerInaccessibleException":                 newErrorLayerInaccessibleException,
+	"LayerPartTooSmallException":                 newErrorLayerPartTooSmallException,
+	"LayersNotFoundException":                    newErrorLayersNotFoundException,
+	"LifecyclePolicyNotFoundException":           newErrorLifecyclePolicyNotFoundException,
+	"LifecyclePolicyPreviewInProgressException":  newErrorLifecyclePolicyPreviewInProgressException,
+	"LifecyclePolicyPreviewNotFoundException":    newErrorLifecyclePolicyPreviewNotFoundException,
+	"LimitExceededException":                     newErrorLimitExceededException,
+	"PullThroughCacheRuleAlreadyExistsException": newErrorPullThroughCacheRuleAlreadyExistsException,
+	"PullThroughCacheRuleNotFoundException":      newErrorPullThroughCacheRuleNotFoundException,
+	"ReferencedImagesNotFoundException":          newErrorReferencedImagesNotFoundException,
+	"RegistryPolicyNotFoundException":            newErrorRegistryPolicyNotFoundException,
+	"RepositoryAlreadyExistsException":           newErrorRepositoryAlreadyExistsException,
+	"RepositoryNotEmptyException":                newErrorRepositoryNotEmptyException,
+	"RepositoryNotFoundException":                newErrorRepositoryNotFoundException,
+	"RepositoryPolicyNotFoundException":          newErrorRepositoryPolicyNotFoundException,
+	"ScanNotFoundException":                      newErrorScanNotFoundException,
+	"ServerException":                            newErrorServerException,
+	"TooManyTagsException":                       newErrorTooManyTagsException,
+	"UnsupportedImageTypeException":              newErrorUnsupportedImageTypeException,
+	"UnsupportedUpstreamRegistryException":       newErrorUnsupportedUpstreamRegistryException,
+	"UploadNotFoundException":                    newErrorUploadNotFoundException,
+	"ValidationException":                        newErrorValidationException,
+}
@@ -4,17 +4,19 @@
 // requests to Amazon Elastic File System.
 //
 // Amazon Elastic File System (Amazon EFS) provides simple, scalable file storage
-// for use with Amazon EC2 instances in the AWS Cloud. With Amazon EFS, storage
-// capacity is elastic, growing and shrinking automatically as you add and remove
-// files, so your applications have the storage they need, when they need it.
-// For more information, see the User Guide (https://docs.aws.amazon.com/efs/latest/ug/api-reference.html).
+// for use with Amazon EC2 Linux and Mac instances in the Amazon Web Services
+// Cloud. With Amazon EFS, storage capacity is elastic, growing and shrinking
+// automatically as you add and remove files, so that your applications have
+// the storage they need, when they need it. For more information, see the Amazon
+// Elastic File System API Reference (https://docs.aws.amazon.com/efs/latest/ug/api-reference.html)
+// and the Amazon Elastic File System User Guide (https://docs.aws.amazon.com/efs/latest/ug/whatisefs.html).
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/elasticfilesystem-2015-02-01 for more information on this service.
 //
 // See efs package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/efs/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon Elastic File System with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -2,8 +2,22 @@
 
 package eks
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
+	// ErrCodeAccessDeniedException for service response error code
+	// "AccessDeniedException".
+	//
+	// You don't have permissions to perform the requested operation. The user or
+	// role that is making the request must have at least one IAM permissions policy
+	// attached that grants the required permissions. For more information, see
+	// Access Management (https://docs.aws.amazon.com/IAM/latest/UserGuide/access.html)
+	// in the IAM User Guide.
+	ErrCodeAccessDeniedException = "AccessDeniedException"
+
 	// ErrCodeBadRequestException for service response error code
 	// "BadRequestException".
 	//
@@ -56,9 +70,17 @@ const (
 	// "ResourceNotFoundException".
 	//
 	// The specified resource could not be found. You can view your available clusters
-	// with ListClusters. Amazon EKS clusters are Region-specific.
+	// with ListClusters. You can view your available managed node groups with ListNodegroups.
+	// Amazon EKS clusters and node groups are Region-specific.
 	ErrCodeResourceNotFoundException = "ResourceNotFoundException"
 
+	// ErrCodeResourcePropagationDelayException for service response error code
+	// "ResourcePropagationDelayException".
+	//
+	// Required resources (such as service-linked roles) were created and are still
+	// propagating. Retry later.
+	ErrCodeResourcePropagationDelayException = "ResourcePropagationDelayException"
+
 	// ErrCodeServerException for service response error code
 	// "ServerException".
 	//
@@ -80,3 +102,19 @@ const (
 	// your cluster.
 	ErrCodeUnsupportedAvailabilityZoneException = "UnsupportedAvailabilityZoneException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"AccessDeniedException":                newErrorAccessDeniedException,
+	"BadRequestException":                  newErrorBadRequestException,
+	"ClientException":                      newErrorClientException,
+	"InvalidParameterException":            newErrorInvalidParameterException,
+	"InvalidRequestException":              newErrorInvalidRequestException,
+	"NotFoundException":                    newErrorNotFoundException,
+	"ResourceInUseException":               newErrorResourceInUseException,
+	"ResourceLimitExceededException":       newErrorResourceLimitExceededException,
+	"ResourceNotFoundException":            newErrorResourceNotFoundException,
+	"ResourcePropagationDelayException":    newErrorResourcePropagationDelayException,
+	"ServerException":                      newErrorServerException,
+	"ServiceUnavailableException":          newErrorServiceUnavailableException,
+	"UnsupportedAvailabilityZoneException": newErrorUnsupportedAvailabilityZoneException,
+}
@@ -2,14 +2,32 @@
 
 package elasticsearchservice
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
+	// ErrCodeAccessDeniedException for service response error code
+	// "AccessDeniedException".
+	//
+	// An error occurred because user does not have permissions to access the resource.
+	// Returns HTTP status code 403.
+	ErrCodeAccessDeniedException = "AccessDeniedException"
+
 	// ErrCodeBaseException for service response error code
 	// "BaseException".
 	//
 	// An error occurred while processing the request.
 	ErrCodeBaseException = "BaseException"
 
+	// ErrCodeConflictException for service response error code
+	// "ConflictException".
+	//
+	// An error occurred because the client attempts to remove a resource that is
+	// currently in use. Returns HTTP status code 409.
+	ErrCodeConflictException = "ConflictException"
+
 	// ErrCodeDisabledOperationException for service response error code
 	// "DisabledOperationException".
 	//
@@ -25,6 +43,13 @@ const (
 	// of 500.
 	ErrCodeInternalException = "InternalException"
 
+	// ErrCodeInvalidPaginationTokenException for service response error code
+	// "InvalidPaginationTokenException".
+	//
+	// The request processing has failed because of invalid pagination token provided
+	// by customer. Returns an HTTP status code of 400.
+	ErrCodeInvalidPaginationTokenException = "InvalidPaginationTokenException"
+
 	// ErrCodeInvalidTypeException for service response error code
 	// "InvalidTypeException".
 	//
@@ -60,3 +85,17 @@ const (
 	// 400.
 	ErrCodeValidationException = "ValidationException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"AccessDeniedException":           newErrorAccessDeniedException,
+	"BaseException":                   newErrorBaseException,
+	"ConflictException":               newErrorConflictException,
+	"DisabledOperationException":      newErrorDisabledOperationException,
+	"InternalException":               newErrorInternalException,
+	"InvalidPaginationTokenException": newErrorInvalidPaginationTokenException,
+	"InvalidTypeException":            newErrorInvalidTypeException,
+	"LimitExceededException":          newErrorLimitExceededException,
+	"ResourceAlreadyExistsException":  newErrorResourceAlreadyExistsException,
+	"ResourceNotFoundException":       newErrorResourceNotFoundException,
+	"ValidationException":             newErrorValidationException,
+}
@@ -2,6 +2,10 @@
 
 package emr
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeInternalServerError for service response error code
@@ -14,7 +18,8 @@ const (
 	// ErrCodeInternalServerException for service response error code
 	// "InternalServerException".
 	//
-	// This exception occurs when there is an internal failure in the EMR service.
+	// This exception occurs when there is an internal failure in the Amazon EMR
+	// service.
 	ErrCodeInternalServerException = "InternalServerException"
 
 	// ErrCodeInvalidRequestException for service response error code
@@ -23,3 +28,9 @@ const (
 	// This exception occurs when there is something wrong with user input.
 	ErrCodeInvalidRequestException = "InvalidRequestException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"InternalServerError":     newErrorInternalServerError,
+	"InternalServerException": newErrorInternalServerException,
+	"InvalidRequestException": newErrorInvalidRequestException,
+}
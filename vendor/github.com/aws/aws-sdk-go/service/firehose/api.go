@@ -29,14 +29,13 @@ const opCreateDeliveryStream = "CreateDeliveryStream"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateDeliveryStreamRequest method.
+//	req, resp := client.CreateDeliveryStreamRequest(params)
 //
-//    // Example sending a request using the CreateDeliveryStreamRequest method.
-//    req, resp := client.CreateDeliveryStreamRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/CreateDeliveryStream
 func (c *Firehose) CreateDeliveryStreamRequest(input *CreateDeliveryStreamInput) (req *request.Request, output *CreateDeliveryStreamOutput) {
@@ -59,13 +58,19 @@ func (c *Firehose) CreateDeliveryStreamRequest(input *CreateDeliveryStreamInput)
 //
 // Creates a Kinesis Data Firehose delivery stream.
 //
-// By default, you can create up to 50 delivery streams per AWS Region.
+// By default, you can create up to 50 delivery streams per Amazon Web Services
+// Region.
 //
 // This is an asynchronous operation that immediately returns. The initial status
 // of the delivery stream is CREATING. After the delivery stream is created,
-// its status is ACTIVE and it now accepts data. Attempts to send data to a
-// delivery stream that is not in the ACTIVE state cause an exception. To check
-// the state of a delivery stream, use DescribeDeliveryStream.
+// its status is ACTIVE and it now accepts data. If the delivery stream creation
+// fails, the status transitions to CREATING_FAILED. Attempts to send data to
+// a delivery stream that is not in the ACTIVE state cause an exception. To
+// check the state of a delivery stream, use DescribeDeliveryStream.
+//
+// If the status of a delivery stream is CREATING_FAILED, this status doesn't
+// change, and you can't invoke CreateDeliveryStream again on it. However, you
+// can invoke the DeleteDeliveryStream operation to delete it.
 //
 // A Kinesis Data Firehose delivery stream can be configured to receive records
 // directly from providers using PutRecord or PutRecordBatch, or it can be configured
@@ -74,11 +79,19 @@ func (c *Firehose) CreateDeliveryStreamRequest(input *CreateDeliveryStreamInput)
 // and provide the Kinesis stream Amazon Resource Name (ARN) and role ARN in
 // the KinesisStreamSourceConfiguration parameter.
 //
-// A delivery stream is configured with a single destination: Amazon S3, Amazon
-// ES, Amazon Redshift, or Splunk. You must specify only one of the following
-// destination configuration parameters: ExtendedS3DestinationConfiguration,
-// S3DestinationConfiguration, ElasticsearchDestinationConfiguration, RedshiftDestinationConfiguration,
-// or SplunkDestinationConfiguration.
+// To create a delivery stream with server-side encryption (SSE) enabled, include
+// DeliveryStreamEncryptionConfigurationInput in your request. This is optional.
+// You can also invoke StartDeliveryStreamEncryption to turn on SSE for an existing
+// delivery stream that doesn't have SSE enabled.
+//
+// A delivery stream is configured with a single destination, such as Amazon
+// Simple Storage Service (Amazon S3), Amazon Redshift, Amazon OpenSearch Service,
+// Amazon OpenSearch Serverless, Splunk, and any custom HTTP endpoint or HTTP
+// endpoints owned by or supported by third-party service providers, including
+// Datadog, Dynatrace, LogicMonitor, MongoDB, New Relic, and Sumo Logic. You
+// must specify only one of the following destination configuration parameters:
+// ExtendedS3DestinationConfiguration, S3DestinationConfiguration, ElasticsearchDestinationConfiguration,
+// RedshiftDestinationConfiguration, or SplunkDestinationConfiguration.
 //
 // When you specify S3DestinationConfiguration, you can also provide the following
 // optional values: BufferingHints, EncryptionConfiguration, and CompressionFormat.
@@ -92,24 +105,24 @@ func (c *Firehose) CreateDeliveryStreamRequest(input *CreateDeliveryStreamInput)
 //
 // A few notes about Amazon Redshift as a destination:
 //
-//    * An Amazon Redshift destination requires an S3 bucket as intermediate
-//    location. Kinesis Data Firehose first delivers data to Amazon S3 and then
-//    uses COPY syntax to load data into an Amazon Redshift table. This is specified
-//    in the RedshiftDestinationConfiguration.S3Configuration parameter.
+//   - An Amazon Redshift destination requires an S3 bucket as intermediate
+//     location. Kinesis Data Firehose first delivers data to Amazon S3 and then
+//     uses COPY syntax to load data into an Amazon Redshift table. This is specified
+//     in the RedshiftDestinationConfiguration.S3Configuration parameter.
 //
-//    * The compression formats SNAPPY or ZIP cannot be specified in RedshiftDestinationConfiguration.S3Configuration
-//    because the Amazon Redshift COPY operation that reads from the S3 bucket
-//    doesn't support these compression formats.
+//   - The compression formats SNAPPY or ZIP cannot be specified in RedshiftDestinationConfiguration.S3Configuration
+//     because the Amazon Redshift COPY operation that reads from the S3 bucket
+//     doesn't support these compression formats.
 //
-//    * We strongly recommend that you use the user name and password you provide
-//    exclusively with Kinesis Data Firehose, and that the permissions for the
-//    account are restricted for Amazon Redshift INSERT permissions.
+//   - We strongly recommend that you use the user name and password you provide
+//     exclusively with Kinesis Data Firehose, and that the permissions for the
+//     account are restricted for Amazon Redshift INSERT permissions.
 //
 // Kinesis Data Firehose assumes the IAM role that is configured as part of
 // the destination. The role should allow the Kinesis Data Firehose principal
 // to assume the role, and the role should have permissions that allow the service
 // to deliver the data. For more information, see Grant Kinesis Data Firehose
-// Access to an Amazon S3 Destination (http://docs.aws.amazon.com/firehose/latest/dev/controlling-access.html#using-iam-s3)
+// Access to an Amazon S3 Destination (https://docs.aws.amazon.com/firehose/latest/dev/controlling-access.html#using-iam-s3)
 // in the Amazon Kinesis Data Firehose Developer Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
@@ -119,15 +132,22 @@ func (c *Firehose) CreateDeliveryStreamRequest(input *CreateDeliveryStreamInput)
 // See the AWS API reference guide for Amazon Kinesis Firehose's
 // API operation CreateDeliveryStream for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidArgumentException "InvalidArgumentException"
-//   The specified input parameter has a value that is not valid.
+// Returned Error Types:
+//
+//   - InvalidArgumentException
+//     The specified input parameter has a value that is not valid.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   You have already reached the limit for a requested resource.
+//   - LimitExceededException
+//     You have already reached the limit for a requested resource.
 //
-//   * ErrCodeResourceInUseException "ResourceInUseException"
-//   The resource is already in use and not available for this operation.
+//   - ResourceInUseException
+//     The resource is already in use and not available for this operation.
+//
+//   - InvalidKMSResourceException
+//     Kinesis Data Firehose throws this exception when an attempt to put records
+//     or to start or stop delivery stream encryption fails. This happens when the
+//     KMS service throws one of the following exception types: AccessDeniedException,
+//     InvalidStateException, DisabledException, or NotFoundException.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/CreateDeliveryStream
 func (c *Firehose) CreateDeliveryStream(input *CreateDeliveryStreamInput) (*CreateDeliveryStreamOutput, error) {
@@ -167,14 +187,13 @@ const opDeleteDeliveryStream = "DeleteDeliveryStream"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteDeliveryStreamRequest method.
+//	req, resp := client.DeleteDeliveryStreamRequest(params)
 //
-//    // Example sending a request using the DeleteDeliveryStreamRequest method.
-//    req, resp := client.DeleteDeliveryStreamRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/DeleteDeliveryStream
 func (c *Firehose) DeleteDeliveryStreamRequest(input *DeleteDeliveryStreamInput) (req *request.Request, output *DeleteDeliveryStreamOutput) {
@@ -198,16 +217,16 @@ func (c *Firehose) DeleteDeliveryStreamRequest(input *DeleteDeliveryStreamInput)
 //
 // Deletes a delivery stream and its data.
 //
-// You can delete a delivery stream only if it is in ACTIVE or DELETING state,
-// and not in the CREATING state. While the deletion request is in process,
-// the delivery stream is in the DELETING state.
+// To check the state of a delivery stream, use DescribeDeliveryStream. You
+// can delete a delivery stream only if it is in one of the following states:
+// ACTIVE, DELETING, CREATING_FAILED, or DELETING_FAILED. You can't delete a
+// delivery stream that is in the CREATING state. While the deletion request
+// is in process, the delivery stream is in the DELETING state.
 //
-// To check the state of a delivery stream, use DescribeDeliveryStream.
-//
-// While the delivery stream is DELETING state, the service might continue to
-// accept the records, but it doesn't make any guarantees with respect to delivering
-// the data. Therefore, as a best practice, you should first stop any applications
-// that are sending records before deleting a delivery stream.
+// While the delivery stream is in the DELETING state, the service might continue
+// to accept records, but it doesn't make any guarantees with respect to delivering
+// the data. Therefore, as a best practice, first stop any applications that
+// are sending records before you delete a delivery stream.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -216,12 +235,13 @@ func (c *Firehose) DeleteDeliveryStreamRequest(input *DeleteDeliveryStreamInput)
 // See the AWS API reference guide for Amazon Kinesis Firehose's
 // API operation DeleteDeliveryStream for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceInUseException "ResourceInUseException"
-//   The resource is already in use and not available for this operation.
+// Returned Error Types:
+//
+//   - ResourceInUseException
+//     The resource is already in use and not available for this operation.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource could not be found.
+//   - ResourceNotFoundException
+//     The specified resource could not be found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/DeleteDeliveryStream
 func (c *Firehose) DeleteDeliveryStream(input *DeleteDeliveryStreamInput) (*DeleteDeliveryStreamOutput, error) {
@@ -261,14 +281,13 @@ const opDescribeDeliveryStream = "DescribeDeliveryStream"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeDeliveryStreamRequest method.
+//	req, resp := client.DescribeDeliveryStreamRequest(params)
 //
-//    // Example sending a request using the DescribeDeliveryStreamRequest method.
-//    req, resp := client.DescribeDeliveryStreamRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/DescribeDeliveryStream
 func (c *Firehose) DescribeDeliveryStreamRequest(input *DescribeDeliveryStreamInput) (req *request.Request, output *DescribeDeliveryStreamOutput) {
@@ -289,10 +308,16 @@ func (c *Firehose) DescribeDeliveryStreamRequest(input *DescribeDeliveryStreamIn
 
 // DescribeDeliveryStream API operation for Amazon Kinesis Firehose.
 //
-// Describes the specified delivery stream and gets the status. For example,
-// after your delivery stream is created, call DescribeDeliveryStream to see
-// whether the delivery stream is ACTIVE and therefore ready for data to be
-// sent to it.
+// Describes the specified delivery stream and its status. For example, after
+// your delivery stream is created, call DescribeDeliveryStream to see whether
+// the delivery stream is ACTIVE and therefore ready for data to be sent to
+// it.
+//
+// If the status of a delivery stream is CREATING_FAILED, this status doesn't
+// change, and you can't invoke CreateDeliveryStream again on it. However, you
+// can invoke the DeleteDeliveryStream operation to delete it. If the status
+// is DELETING_FAILED, you can force deletion by invoking DeleteDeliveryStream
+// again but with DeleteDeliveryStreamInput$AllowForceDelete set to true.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -301,9 +326,9 @@ func (c *Firehose) DescribeDeliveryStreamRequest(input *DescribeDeliveryStreamIn
 // See the AWS API reference guide for Amazon Kinesis Firehose's
 // API operation DescribeDeliveryStream for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource could not be found.
+// Returned Error Types:
+//   - ResourceNotFoundException
+//     The specified resource could not be found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/DescribeDeliveryStream
 func (c *Firehose) DescribeDeliveryStream(input *DescribeDeliveryStreamInput) (*DescribeDeliveryStreamOutput, error) {
@@ -343,14 +368,13 @@ const opListDeliveryStreams = "ListDeliveryStreams"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListDeliveryStreamsRequest method.
+//	req, resp := client.ListDeliveryStreamsRequest(params)
 //
-//    // Example sending a request using the ListDeliveryStreamsRequest method.
-//    req, resp := client.ListDeliveryStreamsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/ListDeliveryStreams
 func (c *Firehose) ListDeliveryStreamsRequest(input *ListDeliveryStreamsInput) (req *request.Request, output *ListDeliveryStreamsOutput) {
@@ -425,14 +449,13 @@ const opListTagsForDeliveryStream = "ListTagsForDeliveryStream"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTagsForDeliveryStreamRequest method.
+//	req, resp := client.ListTagsForDeliveryStreamRequest(params)
 //
-//    // Example sending a request using the ListTagsForDeliveryStreamRequest method.
-//    req, resp := client.ListTagsForDeliveryStreamRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/ListTagsForDeliveryStream
 func (c *Firehose) ListTagsForDeliveryStreamRequest(input *ListTagsForDeliveryStreamInput) (req *request.Request, output *ListTagsForDeliveryStreamOutput) {
@@ -463,15 +486,16 @@ func (c *Firehose) ListTagsForDeliveryStreamRequest(input *ListTagsForDeliverySt
 // See the AWS API reference guide for Amazon Kinesis Firehose's
 // API operation ListTagsForDeliveryStream for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidArgumentException "InvalidArgumentException"
-//   The specified input parameter has a value that is not valid.
+//   - ResourceNotFoundException
+//     The specified resource could not be found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   You have already reached the limit for a requested resource.
+//   - InvalidArgumentException
+//     The specified input parameter has a value that is not valid.
+//
+//   - LimitExceededException
+//     You have already reached the limit for a requested resource.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/ListTagsForDeliveryStream
 func (c *Firehose) ListTagsForDeliveryStream(input *ListTagsForDeliveryStreamInput) (*ListTagsForDeliveryStreamOutput, error) {
@@ -511,14 +535,13 @@ const opPutRecord = "PutRecord"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PutRecordRequest method.
+//	req, resp := client.PutRecordRequest(params)
 //
-//    // Example sending a request using the PutRecordRequest method.
-//    req, resp := client.PutRecordRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/PutRecord
 func (c *Firehose) PutRecordRequest(input *PutRecordInput) (req *request.Request, output *PutRecordOutput) {
@@ -547,11 +570,17 @@ func (c *Firehose) PutRecordRequest(input *PutRecordInput) (req *request.Request
 // second, 5,000 records per second, or 5 MB per second. If you use PutRecord
 // and PutRecordBatch, the limits are an aggregate across these two operations
 // for each delivery stream. For more information about limits and how to request
-// an increase, see Amazon Kinesis Data Firehose Limits (http://docs.aws.amazon.com/firehose/latest/dev/limits.html).
+// an increase, see Amazon Kinesis Data Firehose Limits (https://docs.aws.amazon.com/firehose/latest/dev/limits.html).
+//
+// Kinesis Data Firehose accumulates and publishes a particular metric for a
+// customer account in one minute intervals. It is possible that the bursts
+// of incoming bytes/records ingested to a delivery stream last only for a few
+// seconds. Due to this, the actual spikes in the traffic might not be fully
+// visible in the customer's 1 minute CloudWatch metrics.
 //
 // You must specify the name of the delivery stream and the data record when
 // using PutRecord. The data record consists of a data blob that can be up to
-// 1,000 KB in size, and any kind of data. For example, it can be a segment
+// 1,000 KiB in size, and any kind of data. For example, it can be a segment
 // from a log file, geographic location data, website clickstream data, and
 // so on.
 //
@@ -565,9 +594,14 @@ func (c *Firehose) PutRecordRequest(input *PutRecordInput) (req *request.Request
 // to each record. Producer applications can use this ID for purposes such as
 // auditability and investigation.
 //
-// If the PutRecord operation throws a ServiceUnavailableException, back off
-// and retry. If the exception persists, it is possible that the throughput
-// limits have been exceeded for the delivery stream.
+// If the PutRecord operation throws a ServiceUnavailableException, the API
+// is automatically reinvoked (retried) 3 times. If the exception persists,
+// it is possible that the throughput limits have been exceeded for the delivery
+// stream.
+//
+// Re-invoking the Put API operations (for example, PutRecord and PutRecordBatch)
+// can result in data duplicates. For larger data assets, allow for a longer
+// time out before retrying Put API operations.
 //
 // Data records sent to Kinesis Data Firehose are stored for 24 hours from the
 // time they are added to a delivery stream as it tries to send the records
@@ -584,18 +618,25 @@ func (c *Firehose) PutRecordRequest(input *PutRecordInput) (req *request.Request
 // See the AWS API reference guide for Amazon Kinesis Firehose's
 // API operation PutRecord for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidArgumentException "InvalidArgumentException"
-//   The specified input parameter has a value that is not valid.
+//   - ResourceNotFoundException
+//     The specified resource could not be found.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is unavailable. Back off and retry the operation. If you continue
-//   to see the exception, throughput limits for the delivery stream may have
-//   been exceeded. For more information about limits and how to request an increase,
-//   see Amazon Kinesis Data Firehose Limits (http://docs.aws.amazon.com/firehose/latest/dev/limits.html).
+//   - InvalidArgumentException
+//     The specified input parameter has a value that is not valid.
+//
+//   - InvalidKMSResourceException
+//     Kinesis Data Firehose throws this exception when an attempt to put records
+//     or to start or stop delivery stream encryption fails. This happens when the
+//     KMS service throws one of the following exception types: AccessDeniedException,
+//     InvalidStateException, DisabledException, or NotFoundException.
+//
+//   - ServiceUnavailableException
+//     The service is unavailable. Back off and retry the operation. If you continue
+//     to see the exception, throughput limits for the delivery stream may have
+//     been exceeded. For more information about limits and how to request an increase,
+//     see Amazon Kinesis Data Firehose Limits (https://docs.aws.amazon.com/firehose/latest/dev/limits.html).
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/PutRecord
 func (c *Firehose) PutRecord(input *PutRecordInput) (*PutRecordOutput, error) {
@@ -635,14 +676,13 @@ const opPutRecordBatch = "PutRecordBatch"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PutRecordBatchRequest method.
+//	req, resp := client.PutRecordBatchRequest(params)
 //
-//    // Example sending a request using the PutRecordBatchRequest method.
-//    req, resp := client.PutRecordBatchRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/PutRecordBatch
 func (c *Firehose) PutRecordBatchRequest(input *PutRecordBatchInput) (req *request.Request, output *PutRecordBatchOutput) {
@@ -668,14 +708,17 @@ func (c *Firehose) PutRecordBatchRequest(input *PutRecordBatchInput) (req *reque
 // To write single data records into a delivery stream, use PutRecord. Applications
 // using these operations are referred to as producers.
 //
-// By default, each delivery stream can take in up to 2,000 transactions per
-// second, 5,000 records per second, or 5 MB per second. If you use PutRecord
-// and PutRecordBatch, the limits are an aggregate across these two operations
-// for each delivery stream. For more information about limits, see Amazon Kinesis
-// Data Firehose Limits (http://docs.aws.amazon.com/firehose/latest/dev/limits.html).
+// Kinesis Data Firehose accumulates and publishes a particular metric for a
+// customer account in one minute intervals. It is possible that the bursts
+// of incoming bytes/records ingested to a delivery stream last only for a few
+// seconds. Due to this, the actual spikes in the traffic might not be fully
+// visible in the customer's 1 minute CloudWatch metrics.
+//
+// For information about service quota, see Amazon Kinesis Data Firehose Quota
+// (https://docs.aws.amazon.com/firehose/latest/dev/limits.html).
 //
 // Each PutRecordBatch request supports up to 500 records. Each record in the
-// request can be as large as 1,000 KB (before 64-bit encoding), up to a limit
+// request can be as large as 1,000 KB (before base64 encoding), up to a limit
 // of 4 MB for the entire request. These limits cannot be changed.
 //
 // You must specify the name of the delivery stream and the data record when
@@ -715,9 +758,13 @@ func (c *Firehose) PutRecordBatchRequest(input *PutRecordBatchInput) (req *reque
 // corresponding charges). We recommend that you handle any duplicates at the
 // destination.
 //
-// If PutRecordBatch throws ServiceUnavailableException, back off and retry.
-// If the exception persists, it is possible that the throughput limits have
-// been exceeded for the delivery stream.
+// If PutRecordBatch throws ServiceUnavailableException, the API is automatically
+// reinvoked (retried) 3 times. If the exception persists, it is possible that
+// the throughput limits have been exceeded for the delivery stream.
+//
+// Re-invoking the Put API operations (for example, PutRecord and PutRecordBatch)
+// can result in data duplicates. For larger data assets, allow for a longer
+// time out before retrying Put API operations.
 //
 // Data records sent to Kinesis Data Firehose are stored for 24 hours from the
 // time they are added to a delivery stream as it attempts to send the records
@@ -734,18 +781,25 @@ func (c *Firehose) PutRecordBatchRequest(input *PutRecordBatchInput) (req *reque
 // See the AWS API reference guide for Amazon Kinesis Firehose's
 // API operation PutRecordBatch for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidArgumentException "InvalidArgumentException"
-//   The specified input parameter has a value that is not valid.
+//   - ResourceNotFoundException
+//     The specified resource could not be found.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is unavailable. Back off and retry the operation. If you continue
-//   to see the exception, throughput limits for the delivery stream may have
-//   been exceeded. For more information about limits and how to request an increase,
-//   see Amazon Kinesis Data Firehose Limits (http://docs.aws.amazon.com/firehose/latest/dev/limits.html).
+//   - InvalidArgumentException
+//     The specified input parameter has a value that is not valid.
+//
+//   - InvalidKMSResourceException
+//     Kinesis Data Firehose throws this exception when an attempt to put records
+//     or to start or stop delivery stream encryption fails. This happens when the
+//     KMS service throws one of the following exception types: AccessDeniedException,
+//     InvalidStateException, DisabledException, or NotFoundException.
+//
+//   - ServiceUnavailableException
+//     The service is unavailable. Back off and retry the operation. If you continue
+//     to see the exception, throughput limits for the delivery stream may have
+//     been exceeded. For more information about limits and how to request an increase,
+//     see Amazon Kinesis Data Firehose Limits (https://docs.aws.amazon.com/firehose/latest/dev/limits.html).
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/PutRecordBatch
 func (c *Firehose) PutRecordBatch(input *PutRecordBatchInput) (*PutRecordBatchOutput, error) {
@@ -785,14 +839,13 @@ const opStartDeliveryStreamEncryption = "StartDeliveryStreamEncryption"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the StartDeliveryStreamEncryptionRequest method.
+//	req, resp := client.StartDeliveryStreamEncryptionRequest(params)
 //
-//    // Example sending a request using the StartDeliveryStreamEncryptionRequest method.
-//    req, resp := client.StartDeliveryStreamEncryptionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/StartDeliveryStreamEncryption
 func (c *Firehose) StartDeliveryStreamEncryptionRequest(input *StartDeliveryStreamEncryptionInput) (req *request.Request, output *StartDeliveryStreamEncryptionOutput) {
@@ -817,18 +870,43 @@ func (c *Firehose) StartDeliveryStreamEncryptionRequest(input *StartDeliveryStre
 // Enables server-side encryption (SSE) for the delivery stream.
 //
 // This operation is asynchronous. It returns immediately. When you invoke it,
-// Kinesis Data Firehose first sets the status of the stream to ENABLING, and
-// then to ENABLED. You can continue to read and write data to your stream while
-// its status is ENABLING, but the data is not encrypted. It can take up to
-// 5 seconds after the encryption status changes to ENABLED before all records
-// written to the delivery stream are encrypted. To find out whether a record
-// or a batch of records was encrypted, check the response elements PutRecordOutput$Encrypted
-// and PutRecordBatchOutput$Encrypted, respectively.
-//
-// To check the encryption state of a delivery stream, use DescribeDeliveryStream.
-//
-// You can only enable SSE for a delivery stream that uses DirectPut as its
-// source.
+// Kinesis Data Firehose first sets the encryption status of the stream to ENABLING,
+// and then to ENABLED. The encryption status of a delivery stream is the Status
+// property in DeliveryStreamEncryptionConfiguration. If the operation fails,
+// the encryption status changes to ENABLING_FAILED. You can continue to read
+// and write data to your delivery stream while the encryption status is ENABLING,
+// but the data is not encrypted. It can take up to 5 seconds after the encryption
+// status changes to ENABLED before all records written to the delivery stream
+// are encrypted. To find out whether a record or a batch of records was encrypted,
+// check the response elements PutRecordOutput$Encrypted and PutRecordBatchOutput$Encrypted,
+// respectively.
+//
+// To check the encryption status of a delivery stream, use DescribeDeliveryStream.
+//
+// Even if encryption is currently enabled for a delivery stream, you can still
+// invoke this operation on it to change the ARN of the CMK or both its type
+// and ARN. If you invoke this method to change the CMK, and the old CMK is
+// of type CUSTOMER_MANAGED_CMK, Kinesis Data Firehose schedules the grant it
+// had on the old CMK for retirement. If the new CMK is of type CUSTOMER_MANAGED_CMK,
+// Kinesis Data Firehose creates a grant that enables it to use the new CMK
+// to encrypt and decrypt data and to manage the grant.
+//
+// For the KMS grant creation to be successful, Kinesis Data Firehose APIs StartDeliveryStreamEncryption
+// and CreateDeliveryStream should not be called with session credentials that
+// are more than 6 hours old.
+//
+// If a delivery stream already has encryption enabled and then you invoke this
+// operation to change the ARN of the CMK or both its type and ARN and you get
+// ENABLING_FAILED, this only means that the attempt to change the CMK failed.
+// In this case, encryption remains enabled with the old CMK.
+//
+// If the encryption status of your delivery stream is ENABLING_FAILED, you
+// can invoke this operation again with a valid CMK. The CMK must be enabled
+// and the key policy mustn't explicitly deny the permission for Kinesis Data
+// Firehose to invoke KMS encrypt and decrypt operations.
+//
+// You can enable SSE for a delivery stream only if it's a delivery stream that
+// uses DirectPut as its source.
 //
 // The StartDeliveryStreamEncryption and StopDeliveryStreamEncryption operations
 // have a combined limit of 25 calls per delivery stream per 24 hours. For example,
@@ -843,18 +921,25 @@ func (c *Firehose) StartDeliveryStreamEncryptionRequest(input *StartDeliveryStre
 // See the AWS API reference guide for Amazon Kinesis Firehose's
 // API operation StartDeliveryStreamEncryption for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource could not be found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource could not be found.
 //
-//   * ErrCodeResourceInUseException "ResourceInUseException"
-//   The resource is already in use and not available for this operation.
+//   - ResourceInUseException
+//     The resource is already in use and not available for this operation.
 //
-//   * ErrCodeInvalidArgumentException "InvalidArgumentException"
-//   The specified input parameter has a value that is not valid.
+//   - InvalidArgumentException
+//     The specified input parameter has a value that is not valid.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   You have already reached the limit for a requested resource.
+//   - LimitExceededException
+//     You have already reached the limit for a requested resource.
+//
+//   - InvalidKMSResourceException
+//     Kinesis Data Firehose throws this exception when an attempt to put records
+//     or to start or stop delivery stream encryption fails. This happens when the
+//     KMS service throws one of the following exception types: AccessDeniedException,
+//     InvalidStateException, DisabledException, or NotFoundException.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/StartDeliveryStreamEncryption
 func (c *Firehose) StartDeliveryStreamEncryption(input *StartDeliveryStreamEncryptionInput) (*StartDeliveryStreamEncryptionOutput, error) {
@@ -894,14 +979,13 @@ const opStopDeliveryStreamEncryption = "StopDeliveryStreamEncryption"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the StopDeliveryStreamEncryptionRequest method.
+//	req, resp := client.StopDeliveryStreamEncryptionRequest(params)
 //
-//    // Example sending a request using the StopDeliveryStreamEncryptionRequest method.
-//    req, resp := client.StopDeliveryStreamEncryptionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/StopDeliveryStreamEncryption
 func (c *Firehose) StopDeliveryStreamEncryptionRequest(input *StopDeliveryStreamEncryptionInput) (req *request.Request, output *StopDeliveryStreamEncryptionOutput) {
@@ -926,8 +1010,8 @@ func (c *Firehose) StopDeliveryStreamEncryptionRequest(input *StopDeliveryStream
 // Disables server-side encryption (SSE) for the delivery stream.
 //
 // This operation is asynchronous. It returns immediately. When you invoke it,
-// Kinesis Data Firehose first sets the status of the stream to DISABLING, and
-// then to DISABLED. You can continue to read and write data to your stream
+// Kinesis Data Firehose first sets the encryption status of the stream to DISABLING,
+// and then to DISABLED. You can continue to read and write data to your stream
 // while its status is DISABLING. It can take up to 5 seconds after the encryption
 // status changes to DISABLED before all records written to the delivery stream
 // are no longer subject to encryption. To find out whether a record or a batch
@@ -936,6 +1020,11 @@ func (c *Firehose) StopDeliveryStreamEncryptionRequest(input *StopDeliveryStream
 //
 // To check the encryption state of a delivery stream, use DescribeDeliveryStream.
 //
+// If SSE is enabled using a customer managed CMK and then you invoke StopDeliveryStreamEncryption,
+// Kinesis Data Firehose schedules the related KMS grant for retirement and
+// then retires it after it ensures that it is finished delivering records to
+// the destination.
+//
 // The StartDeliveryStreamEncryption and StopDeliveryStreamEncryption operations
 // have a combined limit of 25 calls per delivery stream per 24 hours. For example,
 // you reach the limit if you call StartDeliveryStreamEncryption 13 times and
@@ -949,18 +1038,19 @@ func (c *Firehose) StopDeliveryStreamEncryptionRequest(input *StopDeliveryStream
 // See the AWS API reference guide for Amazon Kinesis Firehose's
 // API operation StopDeliveryStreamEncryption for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource could not be found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource could not be found.
 //
-//   * ErrCodeResourceInUseException "ResourceInUseException"
-//   The resource is already in use and not available for this operation.
+//   - ResourceInUseException
+//     The resource is already in use and not available for this operation.
 //
-//   * ErrCodeInvalidArgumentException "InvalidArgumentException"
-//   The specified input parameter has a value that is not valid.
+//   - InvalidArgumentException
+//     The specified input parameter has a value that is not valid.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   You have already reached the limit for a requested resource.
+//   - LimitExceededException
+//     You have already reached the limit for a requested resource.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/StopDeliveryStreamEncryption
 func (c *Firehose) StopDeliveryStreamEncryption(input *StopDeliveryStreamEncryptionInput) (*StopDeliveryStreamEncryptionOutput, error) {
@@ -1000,14 +1090,13 @@ const opTagDeliveryStream = "TagDeliveryStream"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the TagDeliveryStreamRequest method.
+//	req, resp := client.TagDeliveryStreamRequest(params)
 //
-//    // Example sending a request using the TagDeliveryStreamRequest method.
-//    req, resp := client.TagDeliveryStreamRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/TagDeliveryStream
 func (c *Firehose) TagDeliveryStreamRequest(input *TagDeliveryStreamInput) (req *request.Request, output *TagDeliveryStreamOutput) {
@@ -1030,13 +1119,13 @@ func (c *Firehose) TagDeliveryStreamRequest(input *TagDeliveryStreamInput) (req
 // TagDeliveryStream API operation for Amazon Kinesis Firehose.
 //
 // Adds or updates tags for the specified delivery stream. A tag is a key-value
-// pair that you can define and assign to AWS resources. If you specify a tag
-// that already exists, the tag value is replaced with the value that you specify
-// in the request. Tags are metadata. For example, you can add friendly names
-// and descriptions or other types of information that can help you distinguish
-// the delivery stream. For more information about tags, see Using Cost Allocation
-// Tags (https://docs.aws.amazon.com/awsaccountbilling/latest/aboutv2/cost-alloc-tags.html)
-// in the AWS Billing and Cost Management User Guide.
+// pair that you can define and assign to Amazon Web Services resources. If
+// you specify a tag that already exists, the tag value is replaced with the
+// value that you specify in the request. Tags are metadata. For example, you
+// can add friendly names and descriptions or other types of information that
+// can help you distinguish the delivery stream. For more information about
+// tags, see Using Cost Allocation Tags (https://docs.aws.amazon.com/awsaccountbilling/latest/aboutv2/cost-alloc-tags.html)
+// in the Amazon Web Services Billing and Cost Management User Guide.
 //
 // Each delivery stream can have up to 50 tags.
 //
@@ -1049,18 +1138,19 @@ func (c *Firehose) TagDeliveryStreamRequest(input *TagDeliveryStreamInput) (req
 // See the AWS API reference guide for Amazon Kinesis Firehose's
 // API operation TagDeliveryStream for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource could not be found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource could not be found.
 //
-//   * ErrCodeResourceInUseException "ResourceInUseException"
-//   The resource is already in use and not available for this operation.
+//   - ResourceInUseException
+//     The resource is already in use and not available for this operation.
 //
-//   * ErrCodeInvalidArgumentException "InvalidArgumentException"
-//   The specified input parameter has a value that is not valid.
+//   - InvalidArgumentException
+//     The specified input parameter has a value that is not valid.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   You have already reached the limit for a requested resource.
+//   - LimitExceededException
+//     You have already reached the limit for a requested resource.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/TagDeliveryStream
 func (c *Firehose) TagDeliveryStream(input *TagDeliveryStreamInput) (*TagDeliveryStreamOutput, error) {
@@ -1100,14 +1190,13 @@ const opUntagDeliveryStream = "UntagDeliveryStream"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UntagDeliveryStreamRequest method.
+//	req, resp := client.UntagDeliveryStreamRequest(params)
 //
-//    // Example sending a request using the UntagDeliveryStreamRequest method.
-//    req, resp := client.UntagDeliveryStreamRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/UntagDeliveryStream
 func (c *Firehose) UntagDeliveryStreamRequest(input *UntagDeliveryStreamInput) (req *request.Request, output *UntagDeliveryStreamOutput) {
@@ -1143,18 +1232,19 @@ func (c *Firehose) UntagDeliveryStreamRequest(input *UntagDeliveryStreamInput) (
 // See the AWS API reference guide for Amazon Kinesis Firehose's
 // API operation UntagDeliveryStream for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource could not be found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource could not be found.
 //
-//   * ErrCodeResourceInUseException "ResourceInUseException"
-//   The resource is already in use and not available for this operation.
+//   - ResourceInUseException
+//     The resource is already in use and not available for this operation.
 //
-//   * ErrCodeInvalidArgumentException "InvalidArgumentException"
-//   The specified input parameter has a value that is not valid.
+//   - InvalidArgumentException
+//     The specified input parameter has a value that is not valid.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   You have already reached the limit for a requested resource.
+//   - LimitExceededException
+//     You have already reached the limit for a requested resource.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/UntagDeliveryStream
 func (c *Firehose) UntagDeliveryStream(input *UntagDeliveryStreamInput) (*UntagDeliveryStreamOutput, error) {
@@ -1194,14 +1284,13 @@ const opUpdateDestination = "UpdateDestination"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateDestinationRequest method.
+//	req, resp := client.UpdateDestinationRequest(params)
 //
-//    // Example sending a request using the UpdateDestinationRequest method.
-//    req, resp := client.UpdateDestinationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/UpdateDestination
 func (c *Firehose) UpdateDestinationRequest(input *UpdateDestinationInput) (req *request.Request, output *UpdateDestinationOutput) {
@@ -1233,8 +1322,9 @@ func (c *Firehose) UpdateDestinationRequest(input *UpdateDestinationInput) (req
 // writes to the delivery stream can continue during this process. The updated
 // configurations are usually effective within a few minutes.
 //
-// Switching between Amazon ES and other services is not supported. For an Amazon
-// ES destination, you can only update to another Amazon ES destination.
+// Switching between Amazon OpenSearch Service and other services is not supported.
+// For an Amazon OpenSearch Service destination, you can only update to another
+// Amazon OpenSearch Service destination.
 //
 // If the destination type is the same, Kinesis Data Firehose merges the configuration
 // parameters specified with the destination configuration that already exists
@@ -1261,19 +1351,20 @@ func (c *Firehose) UpdateDestinationRequest(input *UpdateDestinationInput) (req
 // See the AWS API reference guide for Amazon Kinesis Firehose's
 // API operation UpdateDestination for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidArgumentException "InvalidArgumentException"
-//   The specified input parameter has a value that is not valid.
+// Returned Error Types:
+//
+//   - InvalidArgumentException
+//     The specified input parameter has a value that is not valid.
 //
-//   * ErrCodeResourceInUseException "ResourceInUseException"
-//   The resource is already in use and not available for this operation.
+//   - ResourceInUseException
+//     The resource is already in use and not available for this operation.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource could not be found.
+//   - ResourceNotFoundException
+//     The specified resource could not be found.
 //
-//   * ErrCodeConcurrentModificationException "ConcurrentModificationException"
-//   Another modification has already happened. Fetch VersionId again and use
-//   it to update the destination.
+//   - ConcurrentModificationException
+//     Another modification has already happened. Fetch VersionId again and use
+//     it to update the destination.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/UpdateDestination
 func (c *Firehose) UpdateDestination(input *UpdateDestinationInput) (*UpdateDestinationOutput, error) {
@@ -1297,14 +1388,13 @@ func (c *Firehose) UpdateDestinationWithContext(ctx aws.Context, input *UpdateDe
 	return out, req.Send()
 }
 
-// Describes hints for the buffering to perform before delivering data to the
-// destination. These options are treated as hints, and therefore Kinesis Data
-// Firehose might choose to use different values when it is optimal.
-type BufferingHints struct {
+// Describes the buffering to perform before delivering data to the Serverless
+// offering for Amazon OpenSearch Service destination.
+type AmazonOpenSearchServerlessBufferingHints struct {
 	_ struct{} `type:"structure"`
 
 	// Buffer incoming data for the specified period of time, in seconds, before
-	// delivering it to the destination. The default value is 300.
+	// delivering it to the destination. The default value is 300 (5 minutes).
 	IntervalInSeconds *int64 `min:"60" type:"integer"`
 
 	// Buffer incoming data to the specified size, in MBs, before delivering it
@@ -1316,19 +1406,27 @@ type BufferingHints struct {
 	SizeInMBs *int64 `min:"1" type:"integer"`
 }
 
-// String returns the string representation
-func (s BufferingHints) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AmazonOpenSearchServerlessBufferingHints) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s BufferingHints) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AmazonOpenSearchServerlessBufferingHints) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *BufferingHints) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "BufferingHints"}
+func (s *AmazonOpenSearchServerlessBufferingHints) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AmazonOpenSearchServerlessBufferingHints"}
 	if s.IntervalInSeconds != nil && *s.IntervalInSeconds < 60 {
 		invalidParams.Add(request.NewErrParamMinValue("IntervalInSeconds", 60))
 	}
@@ -1343,114 +1441,129 @@ func (s *BufferingHints) Validate() error {
 }
 
 // SetIntervalInSeconds sets the IntervalInSeconds field's value.
-func (s *BufferingHints) SetIntervalInSeconds(v int64) *BufferingHints {
+func (s *AmazonOpenSearchServerlessBufferingHints) SetIntervalInSeconds(v int64) *AmazonOpenSearchServerlessBufferingHints {
 	s.IntervalInSeconds = &v
 	return s
 }
 
 // SetSizeInMBs sets the SizeInMBs field's value.
-func (s *BufferingHints) SetSizeInMBs(v int64) *BufferingHints {
+func (s *AmazonOpenSearchServerlessBufferingHints) SetSizeInMBs(v int64) *AmazonOpenSearchServerlessBufferingHints {
 	s.SizeInMBs = &v
 	return s
 }
 
-// Describes the Amazon CloudWatch logging options for your delivery stream.
-type CloudWatchLoggingOptions struct {
+// Describes the configuration of a destination in the Serverless offering for
+// Amazon OpenSearch Service.
+type AmazonOpenSearchServerlessDestinationConfiguration struct {
 	_ struct{} `type:"structure"`
 
-	// Enables or disables CloudWatch logging.
-	Enabled *bool `type:"boolean"`
-
-	// The CloudWatch group name for logging. This value is required if CloudWatch
-	// logging is enabled.
-	LogGroupName *string `type:"string"`
-
-	// The CloudWatch log stream name for logging. This value is required if CloudWatch
-	// logging is enabled.
-	LogStreamName *string `type:"string"`
-}
-
-// String returns the string representation
-func (s CloudWatchLoggingOptions) String() string {
-	return awsutil.Prettify(s)
-}
+	// The buffering options. If no value is specified, the default values for AmazonopensearchserviceBufferingHints
+	// are used.
+	BufferingHints *AmazonOpenSearchServerlessBufferingHints `type:"structure"`
 
-// GoString returns the string representation
-func (s CloudWatchLoggingOptions) GoString() string {
-	return s.String()
-}
+	// Describes the Amazon CloudWatch logging options for your delivery stream.
+	CloudWatchLoggingOptions *CloudWatchLoggingOptions `type:"structure"`
 
-// SetEnabled sets the Enabled field's value.
-func (s *CloudWatchLoggingOptions) SetEnabled(v bool) *CloudWatchLoggingOptions {
-	s.Enabled = &v
-	return s
-}
+	// The endpoint to use when communicating with the collection in the Serverless
+	// offering for Amazon OpenSearch Service.
+	CollectionEndpoint *string `min:"1" type:"string"`
 
-// SetLogGroupName sets the LogGroupName field's value.
-func (s *CloudWatchLoggingOptions) SetLogGroupName(v string) *CloudWatchLoggingOptions {
-	s.LogGroupName = &v
-	return s
-}
+	// The Serverless offering for Amazon OpenSearch Service index name.
+	//
+	// IndexName is a required field
+	IndexName *string `min:"1" type:"string" required:"true"`
 
-// SetLogStreamName sets the LogStreamName field's value.
-func (s *CloudWatchLoggingOptions) SetLogStreamName(v string) *CloudWatchLoggingOptions {
-	s.LogStreamName = &v
-	return s
-}
+	// Describes a data processing configuration.
+	ProcessingConfiguration *ProcessingConfiguration `type:"structure"`
 
-// Describes a COPY command for Amazon Redshift.
-type CopyCommand struct {
-	_ struct{} `type:"structure"`
+	// The retry behavior in case Kinesis Data Firehose is unable to deliver documents
+	// to the Serverless offering for Amazon OpenSearch Service. The default value
+	// is 300 (5 minutes).
+	RetryOptions *AmazonOpenSearchServerlessRetryOptions `type:"structure"`
 
-	// Optional parameters to use with the Amazon Redshift COPY command. For more
-	// information, see the "Optional Parameters" section of Amazon Redshift COPY
-	// command (http://docs.aws.amazon.com/redshift/latest/dg/r_COPY.html). Some
-	// possible examples that would apply to Kinesis Data Firehose are as follows:
-	//
-	// delimiter '\t' lzop; - fields are delimited with "\t" (TAB character) and
-	// compressed using lzop.
-	//
-	// delimiter '|' - fields are delimited with "|" (this is the default delimiter).
-	//
-	// delimiter '|' escape - the delimiter should be escaped.
-	//
-	// fixedwidth 'venueid:3,venuename:25,venuecity:12,venuestate:2,venueseats:6'
-	// - fields are fixed width in the source, with each width specified after every
-	// column in the table.
-	//
-	// JSON 's3://mybucket/jsonpaths.txt' - data is in JSON format, and the path
-	// specified is the format of the data.
+	// The Amazon Resource Name (ARN) of the IAM role to be assumed by Kinesis Data
+	// Firehose for calling the Serverless offering for Amazon OpenSearch Service
+	// Configuration API and for indexing documents.
 	//
-	// For more examples, see Amazon Redshift COPY command examples (http://docs.aws.amazon.com/redshift/latest/dg/r_COPY_command_examples.html).
-	CopyOptions *string `type:"string"`
+	// RoleARN is a required field
+	RoleARN *string `min:"1" type:"string" required:"true"`
 
-	// A comma-separated list of column names.
-	DataTableColumns *string `type:"string"`
+	// Defines how documents should be delivered to Amazon S3. When it is set to
+	// FailedDocumentsOnly, Kinesis Data Firehose writes any documents that could
+	// not be indexed to the configured Amazon S3 destination, with AmazonOpenSearchService-failed/
+	// appended to the key prefix. When set to AllDocuments, Kinesis Data Firehose
+	// delivers all incoming records to Amazon S3, and also writes failed documents
+	// with AmazonOpenSearchService-failed/ appended to the prefix.
+	S3BackupMode *string `type:"string" enum:"AmazonOpenSearchServerlessS3BackupMode"`
 
-	// The name of the target table. The table must already exist in the database.
+	// Describes the configuration of a destination in Amazon S3.
 	//
-	// DataTableName is a required field
-	DataTableName *string `min:"1" type:"string" required:"true"`
+	// S3Configuration is a required field
+	S3Configuration *S3DestinationConfiguration `type:"structure" required:"true"`
+
+	// The details of the VPC of the Amazon OpenSearch or Amazon OpenSearch Serverless
+	// destination.
+	VpcConfiguration *VpcConfiguration `type:"structure"`
 }
 
-// String returns the string representation
-func (s CopyCommand) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AmazonOpenSearchServerlessDestinationConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CopyCommand) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AmazonOpenSearchServerlessDestinationConfiguration) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CopyCommand) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CopyCommand"}
-	if s.DataTableName == nil {
-		invalidParams.Add(request.NewErrParamRequired("DataTableName"))
+func (s *AmazonOpenSearchServerlessDestinationConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AmazonOpenSearchServerlessDestinationConfiguration"}
+	if s.CollectionEndpoint != nil && len(*s.CollectionEndpoint) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CollectionEndpoint", 1))
 	}
-	if s.DataTableName != nil && len(*s.DataTableName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("DataTableName", 1))
+	if s.IndexName == nil {
+		invalidParams.Add(request.NewErrParamRequired("IndexName"))
+	}
+	if s.IndexName != nil && len(*s.IndexName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("IndexName", 1))
+	}
+	if s.RoleARN == nil {
+		invalidParams.Add(request.NewErrParamRequired("RoleARN"))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RoleARN", 1))
+	}
+	if s.S3Configuration == nil {
+		invalidParams.Add(request.NewErrParamRequired("S3Configuration"))
+	}
+	if s.BufferingHints != nil {
+		if err := s.BufferingHints.Validate(); err != nil {
+			invalidParams.AddNested("BufferingHints", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ProcessingConfiguration != nil {
+		if err := s.ProcessingConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("ProcessingConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3Configuration != nil {
+		if err := s.S3Configuration.Validate(); err != nil {
+			invalidParams.AddNested("S3Configuration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.VpcConfiguration != nil {
+		if err := s.VpcConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("VpcConfiguration", err.(request.ErrInvalidParams))
+		}
 	}
 
 	if invalidParams.Len() > 0 {
@@ -1459,263 +1572,260 @@ func (s *CopyCommand) Validate() error {
 	return nil
 }
 
-// SetCopyOptions sets the CopyOptions field's value.
-func (s *CopyCommand) SetCopyOptions(v string) *CopyCommand {
-	s.CopyOptions = &v
+// SetBufferingHints sets the BufferingHints field's value.
+func (s *AmazonOpenSearchServerlessDestinationConfiguration) SetBufferingHints(v *AmazonOpenSearchServerlessBufferingHints) *AmazonOpenSearchServerlessDestinationConfiguration {
+	s.BufferingHints = v
 	return s
 }
 
-// SetDataTableColumns sets the DataTableColumns field's value.
-func (s *CopyCommand) SetDataTableColumns(v string) *CopyCommand {
-	s.DataTableColumns = &v
+// SetCloudWatchLoggingOptions sets the CloudWatchLoggingOptions field's value.
+func (s *AmazonOpenSearchServerlessDestinationConfiguration) SetCloudWatchLoggingOptions(v *CloudWatchLoggingOptions) *AmazonOpenSearchServerlessDestinationConfiguration {
+	s.CloudWatchLoggingOptions = v
 	return s
 }
 
-// SetDataTableName sets the DataTableName field's value.
-func (s *CopyCommand) SetDataTableName(v string) *CopyCommand {
-	s.DataTableName = &v
+// SetCollectionEndpoint sets the CollectionEndpoint field's value.
+func (s *AmazonOpenSearchServerlessDestinationConfiguration) SetCollectionEndpoint(v string) *AmazonOpenSearchServerlessDestinationConfiguration {
+	s.CollectionEndpoint = &v
 	return s
 }
 
-type CreateDeliveryStreamInput struct {
-	_ struct{} `type:"structure"`
+// SetIndexName sets the IndexName field's value.
+func (s *AmazonOpenSearchServerlessDestinationConfiguration) SetIndexName(v string) *AmazonOpenSearchServerlessDestinationConfiguration {
+	s.IndexName = &v
+	return s
+}
 
-	// The name of the delivery stream. This name must be unique per AWS account
-	// in the same AWS Region. If the delivery streams are in different accounts
-	// or different Regions, you can have multiple delivery streams with the same
-	// name.
-	//
-	// DeliveryStreamName is a required field
-	DeliveryStreamName *string `min:"1" type:"string" required:"true"`
+// SetProcessingConfiguration sets the ProcessingConfiguration field's value.
+func (s *AmazonOpenSearchServerlessDestinationConfiguration) SetProcessingConfiguration(v *ProcessingConfiguration) *AmazonOpenSearchServerlessDestinationConfiguration {
+	s.ProcessingConfiguration = v
+	return s
+}
 
-	// The delivery stream type. This parameter can be one of the following values:
-	//
-	//    * DirectPut: Provider applications access the delivery stream directly.
-	//
-	//    * KinesisStreamAsSource: The delivery stream uses a Kinesis data stream
-	//    as a source.
-	DeliveryStreamType *string `type:"string" enum:"DeliveryStreamType"`
+// SetRetryOptions sets the RetryOptions field's value.
+func (s *AmazonOpenSearchServerlessDestinationConfiguration) SetRetryOptions(v *AmazonOpenSearchServerlessRetryOptions) *AmazonOpenSearchServerlessDestinationConfiguration {
+	s.RetryOptions = v
+	return s
+}
 
-	// The destination in Amazon ES. You can specify only one destination.
-	ElasticsearchDestinationConfiguration *ElasticsearchDestinationConfiguration `type:"structure"`
+// SetRoleARN sets the RoleARN field's value.
+func (s *AmazonOpenSearchServerlessDestinationConfiguration) SetRoleARN(v string) *AmazonOpenSearchServerlessDestinationConfiguration {
+	s.RoleARN = &v
+	return s
+}
 
-	// The destination in Amazon S3. You can specify only one destination.
-	ExtendedS3DestinationConfiguration *ExtendedS3DestinationConfiguration `type:"structure"`
+// SetS3BackupMode sets the S3BackupMode field's value.
+func (s *AmazonOpenSearchServerlessDestinationConfiguration) SetS3BackupMode(v string) *AmazonOpenSearchServerlessDestinationConfiguration {
+	s.S3BackupMode = &v
+	return s
+}
 
-	// When a Kinesis data stream is used as the source for the delivery stream,
-	// a KinesisStreamSourceConfiguration containing the Kinesis data stream Amazon
-	// Resource Name (ARN) and the role ARN for the source stream.
-	KinesisStreamSourceConfiguration *KinesisStreamSourceConfiguration `type:"structure"`
+// SetS3Configuration sets the S3Configuration field's value.
+func (s *AmazonOpenSearchServerlessDestinationConfiguration) SetS3Configuration(v *S3DestinationConfiguration) *AmazonOpenSearchServerlessDestinationConfiguration {
+	s.S3Configuration = v
+	return s
+}
 
-	// The destination in Amazon Redshift. You can specify only one destination.
-	RedshiftDestinationConfiguration *RedshiftDestinationConfiguration `type:"structure"`
+// SetVpcConfiguration sets the VpcConfiguration field's value.
+func (s *AmazonOpenSearchServerlessDestinationConfiguration) SetVpcConfiguration(v *VpcConfiguration) *AmazonOpenSearchServerlessDestinationConfiguration {
+	s.VpcConfiguration = v
+	return s
+}
 
-	// [Deprecated] The destination in Amazon S3. You can specify only one destination.
-	//
-	// Deprecated: S3DestinationConfiguration has been deprecated
-	S3DestinationConfiguration *S3DestinationConfiguration `deprecated:"true" type:"structure"`
+// The destination description in the Serverless offering for Amazon OpenSearch
+// Service.
+type AmazonOpenSearchServerlessDestinationDescription struct {
+	_ struct{} `type:"structure"`
 
-	// The destination in Splunk. You can specify only one destination.
-	SplunkDestinationConfiguration *SplunkDestinationConfiguration `type:"structure"`
+	// The buffering options.
+	BufferingHints *AmazonOpenSearchServerlessBufferingHints `type:"structure"`
 
-	// A set of tags to assign to the delivery stream. A tag is a key-value pair
-	// that you can define and assign to AWS resources. Tags are metadata. For example,
-	// you can add friendly names and descriptions or other types of information
-	// that can help you distinguish the delivery stream. For more information about
-	// tags, see Using Cost Allocation Tags (https://docs.aws.amazon.com/awsaccountbilling/latest/aboutv2/cost-alloc-tags.html)
-	// in the AWS Billing and Cost Management User Guide.
-	//
-	// You can specify up to 50 tags when creating a delivery stream.
-	Tags []*Tag `min:"1" type:"list"`
-}
+	// Describes the Amazon CloudWatch logging options for your delivery stream.
+	CloudWatchLoggingOptions *CloudWatchLoggingOptions `type:"structure"`
 
-// String returns the string representation
-func (s CreateDeliveryStreamInput) String() string {
-	return awsutil.Prettify(s)
-}
+	// The endpoint to use when communicating with the collection in the Serverless
+	// offering for Amazon OpenSearch Service.
+	CollectionEndpoint *string `min:"1" type:"string"`
 
-// GoString returns the string representation
-func (s CreateDeliveryStreamInput) GoString() string {
-	return s.String()
-}
+	// The Serverless offering for Amazon OpenSearch Service index name.
+	IndexName *string `min:"1" type:"string"`
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateDeliveryStreamInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateDeliveryStreamInput"}
-	if s.DeliveryStreamName == nil {
-		invalidParams.Add(request.NewErrParamRequired("DeliveryStreamName"))
-	}
-	if s.DeliveryStreamName != nil && len(*s.DeliveryStreamName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("DeliveryStreamName", 1))
-	}
-	if s.Tags != nil && len(s.Tags) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
-	}
-	if s.ElasticsearchDestinationConfiguration != nil {
-		if err := s.ElasticsearchDestinationConfiguration.Validate(); err != nil {
-			invalidParams.AddNested("ElasticsearchDestinationConfiguration", err.(request.ErrInvalidParams))
-		}
-	}
-	if s.ExtendedS3DestinationConfiguration != nil {
-		if err := s.ExtendedS3DestinationConfiguration.Validate(); err != nil {
-			invalidParams.AddNested("ExtendedS3DestinationConfiguration", err.(request.ErrInvalidParams))
-		}
-	}
-	if s.KinesisStreamSourceConfiguration != nil {
-		if err := s.KinesisStreamSourceConfiguration.Validate(); err != nil {
-			invalidParams.AddNested("KinesisStreamSourceConfiguration", err.(request.ErrInvalidParams))
-		}
-	}
-	if s.RedshiftDestinationConfiguration != nil {
-		if err := s.RedshiftDestinationConfiguration.Validate(); err != nil {
-			invalidParams.AddNested("RedshiftDestinationConfiguration", err.(request.ErrInvalidParams))
-		}
-	}
-	if s.S3DestinationConfiguration != nil {
-		if err := s.S3DestinationConfiguration.Validate(); err != nil {
-			invalidParams.AddNested("S3DestinationConfiguration", err.(request.ErrInvalidParams))
-		}
-	}
-	if s.SplunkDestinationConfiguration != nil {
-		if err := s.SplunkDestinationConfiguration.Validate(); err != nil {
-			invalidParams.AddNested("SplunkDestinationConfiguration", err.(request.ErrInvalidParams))
-		}
-	}
-	if s.Tags != nil {
-		for i, v := range s.Tags {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
-			}
-		}
-	}
+	// Describes a data processing configuration.
+	ProcessingConfiguration *ProcessingConfiguration `type:"structure"`
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
+	// The Serverless offering for Amazon OpenSearch Service retry options.
+	RetryOptions *AmazonOpenSearchServerlessRetryOptions `type:"structure"`
 
-// SetDeliveryStreamName sets the DeliveryStreamName field's value.
-func (s *CreateDeliveryStreamInput) SetDeliveryStreamName(v string) *CreateDeliveryStreamInput {
-	s.DeliveryStreamName = &v
-	return s
+	// The Amazon Resource Name (ARN) of the Amazon Web Services credentials.
+	RoleARN *string `min:"1" type:"string"`
+
+	// The Amazon S3 backup mode.
+	S3BackupMode *string `type:"string" enum:"AmazonOpenSearchServerlessS3BackupMode"`
+
+	// Describes a destination in Amazon S3.
+	S3DestinationDescription *S3DestinationDescription `type:"structure"`
+
+	// The details of the VPC of the Amazon ES destination.
+	VpcConfigurationDescription *VpcConfigurationDescription `type:"structure"`
 }
 
-// SetDeliveryStreamType sets the DeliveryStreamType field's value.
-func (s *CreateDeliveryStreamInput) SetDeliveryStreamType(v string) *CreateDeliveryStreamInput {
-	s.DeliveryStreamType = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AmazonOpenSearchServerlessDestinationDescription) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetElasticsearchDestinationConfiguration sets the ElasticsearchDestinationConfiguration field's value.
-func (s *CreateDeliveryStreamInput) SetElasticsearchDestinationConfiguration(v *ElasticsearchDestinationConfiguration) *CreateDeliveryStreamInput {
-	s.ElasticsearchDestinationConfiguration = v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AmazonOpenSearchServerlessDestinationDescription) GoString() string {
+	return s.String()
 }
 
-// SetExtendedS3DestinationConfiguration sets the ExtendedS3DestinationConfiguration field's value.
-func (s *CreateDeliveryStreamInput) SetExtendedS3DestinationConfiguration(v *ExtendedS3DestinationConfiguration) *CreateDeliveryStreamInput {
-	s.ExtendedS3DestinationConfiguration = v
+// SetBufferingHints sets the BufferingHints field's value.
+func (s *AmazonOpenSearchServerlessDestinationDescription) SetBufferingHints(v *AmazonOpenSearchServerlessBufferingHints) *AmazonOpenSearchServerlessDestinationDescription {
+	s.BufferingHints = v
 	return s
 }
 
-// SetKinesisStreamSourceConfiguration sets the KinesisStreamSourceConfiguration field's value.
-func (s *CreateDeliveryStreamInput) SetKinesisStreamSourceConfiguration(v *KinesisStreamSourceConfiguration) *CreateDeliveryStreamInput {
-	s.KinesisStreamSourceConfiguration = v
+// SetCloudWatchLoggingOptions sets the CloudWatchLoggingOptions field's value.
+func (s *AmazonOpenSearchServerlessDestinationDescription) SetCloudWatchLoggingOptions(v *CloudWatchLoggingOptions) *AmazonOpenSearchServerlessDestinationDescription {
+	s.CloudWatchLoggingOptions = v
 	return s
 }
 
-// SetRedshiftDestinationConfiguration sets the RedshiftDestinationConfiguration field's value.
-func (s *CreateDeliveryStreamInput) SetRedshiftDestinationConfiguration(v *RedshiftDestinationConfiguration) *CreateDeliveryStreamInput {
-	s.RedshiftDestinationConfiguration = v
+// SetCollectionEndpoint sets the CollectionEndpoint field's value.
+func (s *AmazonOpenSearchServerlessDestinationDescription) SetCollectionEndpoint(v string) *AmazonOpenSearchServerlessDestinationDescription {
+	s.CollectionEndpoint = &v
 	return s
 }
 
-// SetS3DestinationConfiguration sets the S3DestinationConfiguration field's value.
-func (s *CreateDeliveryStreamInput) SetS3DestinationConfiguration(v *S3DestinationConfiguration) *CreateDeliveryStreamInput {
-	s.S3DestinationConfiguration = v
+// SetIndexName sets the IndexName field's value.
+func (s *AmazonOpenSearchServerlessDestinationDescription) SetIndexName(v string) *AmazonOpenSearchServerlessDestinationDescription {
+	s.IndexName = &v
 	return s
 }
 
-// SetSplunkDestinationConfiguration sets the SplunkDestinationConfiguration field's value.
-func (s *CreateDeliveryStreamInput) SetSplunkDestinationConfiguration(v *SplunkDestinationConfiguration) *CreateDeliveryStreamInput {
-	s.SplunkDestinationConfiguration = v
+// SetProcessingConfiguration sets the ProcessingConfiguration field's value.
+func (s *AmazonOpenSearchServerlessDestinationDescription) SetProcessingConfiguration(v *ProcessingConfiguration) *AmazonOpenSearchServerlessDestinationDescription {
+	s.ProcessingConfiguration = v
 	return s
 }
 
-// SetTags sets the Tags field's value.
-func (s *CreateDeliveryStreamInput) SetTags(v []*Tag) *CreateDeliveryStreamInput {
-	s.Tags = v
+// SetRetryOptions sets the RetryOptions field's value.
+func (s *AmazonOpenSearchServerlessDestinationDescription) SetRetryOptions(v *AmazonOpenSearchServerlessRetryOptions) *AmazonOpenSearchServerlessDestinationDescription {
+	s.RetryOptions = v
 	return s
 }
 
-type CreateDeliveryStreamOutput struct {
-	_ struct{} `type:"structure"`
-
-	// The ARN of the delivery stream.
-	DeliveryStreamARN *string `min:"1" type:"string"`
+// SetRoleARN sets the RoleARN field's value.
+func (s *AmazonOpenSearchServerlessDestinationDescription) SetRoleARN(v string) *AmazonOpenSearchServerlessDestinationDescription {
+	s.RoleARN = &v
+	return s
 }
 
-// String returns the string representation
-func (s CreateDeliveryStreamOutput) String() string {
-	return awsutil.Prettify(s)
+// SetS3BackupMode sets the S3BackupMode field's value.
+func (s *AmazonOpenSearchServerlessDestinationDescription) SetS3BackupMode(v string) *AmazonOpenSearchServerlessDestinationDescription {
+	s.S3BackupMode = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s CreateDeliveryStreamOutput) GoString() string {
-	return s.String()
+// SetS3DestinationDescription sets the S3DestinationDescription field's value.
+func (s *AmazonOpenSearchServerlessDestinationDescription) SetS3DestinationDescription(v *S3DestinationDescription) *AmazonOpenSearchServerlessDestinationDescription {
+	s.S3DestinationDescription = v
+	return s
 }
 
-// SetDeliveryStreamARN sets the DeliveryStreamARN field's value.
-func (s *CreateDeliveryStreamOutput) SetDeliveryStreamARN(v string) *CreateDeliveryStreamOutput {
-	s.DeliveryStreamARN = &v
+// SetVpcConfigurationDescription sets the VpcConfigurationDescription field's value.
+func (s *AmazonOpenSearchServerlessDestinationDescription) SetVpcConfigurationDescription(v *VpcConfigurationDescription) *AmazonOpenSearchServerlessDestinationDescription {
+	s.VpcConfigurationDescription = v
 	return s
 }
 
-// Specifies that you want Kinesis Data Firehose to convert data from the JSON
-// format to the Parquet or ORC format before writing it to Amazon S3. Kinesis
-// Data Firehose uses the serializer and deserializer that you specify, in addition
-// to the column information from the AWS Glue table, to deserialize your input
-// data from JSON and then serialize it to the Parquet or ORC format. For more
-// information, see Kinesis Data Firehose Record Format Conversion (https://docs.aws.amazon.com/firehose/latest/dev/record-format-conversion.html).
-type DataFormatConversionConfiguration struct {
+// Describes an update for a destination in the Serverless offering for Amazon
+// OpenSearch Service.
+type AmazonOpenSearchServerlessDestinationUpdate struct {
 	_ struct{} `type:"structure"`
 
-	// Defaults to true. Set it to false if you want to disable format conversion
-	// while preserving the configuration details.
-	Enabled *bool `type:"boolean"`
+	// The buffering options. If no value is specified, AmazonopensearchBufferingHints
+	// object default values are used.
+	BufferingHints *AmazonOpenSearchServerlessBufferingHints `type:"structure"`
 
-	// Specifies the deserializer that you want Kinesis Data Firehose to use to
-	// convert the format of your data from JSON.
-	InputFormatConfiguration *InputFormatConfiguration `type:"structure"`
+	// Describes the Amazon CloudWatch logging options for your delivery stream.
+	CloudWatchLoggingOptions *CloudWatchLoggingOptions `type:"structure"`
 
-	// Specifies the serializer that you want Kinesis Data Firehose to use to convert
-	// the format of your data to the Parquet or ORC format.
-	OutputFormatConfiguration *OutputFormatConfiguration `type:"structure"`
+	// The endpoint to use when communicating with the collection in the Serverless
+	// offering for Amazon OpenSearch Service.
+	CollectionEndpoint *string `min:"1" type:"string"`
 
-	// Specifies the AWS Glue Data Catalog table that contains the column information.
-	SchemaConfiguration *SchemaConfiguration `type:"structure"`
+	// The Serverless offering for Amazon OpenSearch Service index name.
+	IndexName *string `min:"1" type:"string"`
+
+	// Describes a data processing configuration.
+	ProcessingConfiguration *ProcessingConfiguration `type:"structure"`
+
+	// The retry behavior in case Kinesis Data Firehose is unable to deliver documents
+	// to the Serverless offering for Amazon OpenSearch Service. The default value
+	// is 300 (5 minutes).
+	RetryOptions *AmazonOpenSearchServerlessRetryOptions `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the IAM role to be assumed by Kinesis Data
+	// Firehose for calling the Serverless offering for Amazon OpenSearch Service
+	// Configuration API and for indexing documents.
+	RoleARN *string `min:"1" type:"string"`
+
+	// Describes an update for a destination in Amazon S3.
+	S3Update *S3DestinationUpdate `type:"structure"`
 }
 
-// String returns the string representation
-func (s DataFormatConversionConfiguration) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AmazonOpenSearchServerlessDestinationUpdate) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DataFormatConversionConfiguration) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AmazonOpenSearchServerlessDestinationUpdate) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DataFormatConversionConfiguration) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DataFormatConversionConfiguration"}
-	if s.OutputFormatConfiguration != nil {
-		if err := s.OutputFormatConfiguration.Validate(); err != nil {
-			invalidParams.AddNested("OutputFormatConfiguration", err.(request.ErrInvalidParams))
+func (s *AmazonOpenSearchServerlessDestinationUpdate) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AmazonOpenSearchServerlessDestinationUpdate"}
+	if s.CollectionEndpoint != nil && len(*s.CollectionEndpoint) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CollectionEndpoint", 1))
+	}
+	if s.IndexName != nil && len(*s.IndexName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("IndexName", 1))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RoleARN", 1))
+	}
+	if s.BufferingHints != nil {
+		if err := s.BufferingHints.Validate(); err != nil {
+			invalidParams.AddNested("BufferingHints", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ProcessingConfiguration != nil {
+		if err := s.ProcessingConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("ProcessingConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3Update != nil {
+		if err := s.S3Update.Validate(); err != nil {
+			invalidParams.AddNested("S3Update", err.(request.ErrInvalidParams))
 		}
 	}
 
@@ -1725,289 +1835,3146 @@ func (s *DataFormatConversionConfiguration) Validate() error {
 	return nil
 }
 
-// SetEnabled sets the Enabled field's value.
-func (s *DataFormatConversionConfiguration) SetEnabled(v bool) *DataFormatConversionConfiguration {
-	s.Enabled = &v
+// SetBufferingHints sets the BufferingHints field's value.
+func (s *AmazonOpenSearchServerlessDestinationUpdate) SetBufferingHints(v *AmazonOpenSearchServerlessBufferingHints) *AmazonOpenSearchServerlessDestinationUpdate {
+	s.BufferingHints = v
 	return s
 }
 
-// SetInputFormatConfiguration sets the InputFormatConfiguration field's value.
-func (s *DataFormatConversionConfiguration) SetInputFormatConfiguration(v *InputFormatConfiguration) *DataFormatConversionConfiguration {
-	s.InputFormatConfiguration = v
+// SetCloudWatchLoggingOptions sets the CloudWatchLoggingOptions field's value.
+func (s *AmazonOpenSearchServerlessDestinationUpdate) SetCloudWatchLoggingOptions(v *CloudWatchLoggingOptions) *AmazonOpenSearchServerlessDestinationUpdate {
+	s.CloudWatchLoggingOptions = v
 	return s
 }
 
-// SetOutputFormatConfiguration sets the OutputFormatConfiguration field's value.
-func (s *DataFormatConversionConfiguration) SetOutputFormatConfiguration(v *OutputFormatConfiguration) *DataFormatConversionConfiguration {
-	s.OutputFormatConfiguration = v
+// SetCollectionEndpoint sets the CollectionEndpoint field's value.
+func (s *AmazonOpenSearchServerlessDestinationUpdate) SetCollectionEndpoint(v string) *AmazonOpenSearchServerlessDestinationUpdate {
+	s.CollectionEndpoint = &v
 	return s
 }
 
-// SetSchemaConfiguration sets the SchemaConfiguration field's value.
-func (s *DataFormatConversionConfiguration) SetSchemaConfiguration(v *SchemaConfiguration) *DataFormatConversionConfiguration {
-	s.SchemaConfiguration = v
+// SetIndexName sets the IndexName field's value.
+func (s *AmazonOpenSearchServerlessDestinationUpdate) SetIndexName(v string) *AmazonOpenSearchServerlessDestinationUpdate {
+	s.IndexName = &v
 	return s
 }
 
-type DeleteDeliveryStreamInput struct {
-	_ struct{} `type:"structure"`
-
-	// The name of the delivery stream.
-	//
-	// DeliveryStreamName is a required field
-	DeliveryStreamName *string `min:"1" type:"string" required:"true"`
-}
-
-// String returns the string representation
-func (s DeleteDeliveryStreamInput) String() string {
-	return awsutil.Prettify(s)
+// SetProcessingConfiguration sets the ProcessingConfiguration field's value.
+func (s *AmazonOpenSearchServerlessDestinationUpdate) SetProcessingConfiguration(v *ProcessingConfiguration) *AmazonOpenSearchServerlessDestinationUpdate {
+	s.ProcessingConfiguration = v
+	return s
 }
 
-// GoString returns the string representation
-func (s DeleteDeliveryStreamInput) GoString() string {
-	return s.String()
+// SetRetryOptions sets the RetryOptions field's value.
+func (s *AmazonOpenSearchServerlessDestinationUpdate) SetRetryOptions(v *AmazonOpenSearchServerlessRetryOptions) *AmazonOpenSearchServerlessDestinationUpdate {
+	s.RetryOptions = v
+	return s
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteDeliveryStreamInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteDeliveryStreamInput"}
-	if s.DeliveryStreamName == nil {
-		invalidParams.Add(request.NewErrParamRequired("DeliveryStreamName"))
-	}
-	if s.DeliveryStreamName != nil && len(*s.DeliveryStreamName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("DeliveryStreamName", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetRoleARN sets the RoleARN field's value.
+func (s *AmazonOpenSearchServerlessDestinationUpdate) SetRoleARN(v string) *AmazonOpenSearchServerlessDestinationUpdate {
+	s.RoleARN = &v
+	return s
 }
 
-// SetDeliveryStreamName sets the DeliveryStreamName field's value.
-func (s *DeleteDeliveryStreamInput) SetDeliveryStreamName(v string) *DeleteDeliveryStreamInput {
-	s.DeliveryStreamName = &v
+// SetS3Update sets the S3Update field's value.
+func (s *AmazonOpenSearchServerlessDestinationUpdate) SetS3Update(v *S3DestinationUpdate) *AmazonOpenSearchServerlessDestinationUpdate {
+	s.S3Update = v
 	return s
 }
 
-type DeleteDeliveryStreamOutput struct {
+// Configures retry behavior in case Kinesis Data Firehose is unable to deliver
+// documents to the Serverless offering for Amazon OpenSearch Service.
+type AmazonOpenSearchServerlessRetryOptions struct {
 	_ struct{} `type:"structure"`
+
+	// After an initial failure to deliver to the Serverless offering for Amazon
+	// OpenSearch Service, the total amount of time during which Kinesis Data Firehose
+	// retries delivery (including the first attempt). After this time has elapsed,
+	// the failed documents are written to Amazon S3. Default value is 300 seconds
+	// (5 minutes). A value of 0 (zero) results in no retries.
+	DurationInSeconds *int64 `type:"integer"`
 }
 
-// String returns the string representation
-func (s DeleteDeliveryStreamOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AmazonOpenSearchServerlessRetryOptions) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteDeliveryStreamOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AmazonOpenSearchServerlessRetryOptions) GoString() string {
 	return s.String()
 }
 
-// Contains information about a delivery stream.
-type DeliveryStreamDescription struct {
+// SetDurationInSeconds sets the DurationInSeconds field's value.
+func (s *AmazonOpenSearchServerlessRetryOptions) SetDurationInSeconds(v int64) *AmazonOpenSearchServerlessRetryOptions {
+	s.DurationInSeconds = &v
+	return s
+}
+
+// Describes the buffering to perform before delivering data to the Amazon OpenSearch
+// Service destination.
+type AmazonopensearchserviceBufferingHints struct {
 	_ struct{} `type:"structure"`
 
-	// The date and time that the delivery stream was created.
-	CreateTimestamp *time.Time `type:"timestamp"`
+	// Buffer incoming data for the specified period of time, in seconds, before
+	// delivering it to the destination. The default value is 300 (5 minutes).
+	IntervalInSeconds *int64 `min:"60" type:"integer"`
 
-	// The Amazon Resource Name (ARN) of the delivery stream. For more information,
-	// see Amazon Resource Names (ARNs) and AWS Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	// Buffer incoming data to the specified size, in MBs, before delivering it
+	// to the destination. The default value is 5.
 	//
-	// DeliveryStreamARN is a required field
-	DeliveryStreamARN *string `min:"1" type:"string" required:"true"`
+	// We recommend setting this parameter to a value greater than the amount of
+	// data you typically ingest into the delivery stream in 10 seconds. For example,
+	// if you typically ingest data at 1 MB/sec, the value should be 10 MB or higher.
+	SizeInMBs *int64 `min:"1" type:"integer"`
+}
 
-	// Indicates the server-side encryption (SSE) status for the delivery stream.
-	DeliveryStreamEncryptionConfiguration *DeliveryStreamEncryptionConfiguration `type:"structure"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AmazonopensearchserviceBufferingHints) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// The name of the delivery stream.
-	//
-	// DeliveryStreamName is a required field
-	DeliveryStreamName *string `min:"1" type:"string" required:"true"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AmazonopensearchserviceBufferingHints) GoString() string {
+	return s.String()
+}
 
-	// The status of the delivery stream.
-	//
-	// DeliveryStreamStatus is a required field
-	DeliveryStreamStatus *string `type:"string" required:"true" enum:"DeliveryStreamStatus"`
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *AmazonopensearchserviceBufferingHints) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AmazonopensearchserviceBufferingHints"}
+	if s.IntervalInSeconds != nil && *s.IntervalInSeconds < 60 {
+		invalidParams.Add(request.NewErrParamMinValue("IntervalInSeconds", 60))
+	}
+	if s.SizeInMBs != nil && *s.SizeInMBs < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("SizeInMBs", 1))
+	}
 
-	// The delivery stream type. This can be one of the following values:
-	//
-	//    * DirectPut: Provider applications access the delivery stream directly.
-	//
-	//    * KinesisStreamAsSource: The delivery stream uses a Kinesis data stream
-	//    as a source.
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetIntervalInSeconds sets the IntervalInSeconds field's value.
+func (s *AmazonopensearchserviceBufferingHints) SetIntervalInSeconds(v int64) *AmazonopensearchserviceBufferingHints {
+	s.IntervalInSeconds = &v
+	return s
+}
+
+// SetSizeInMBs sets the SizeInMBs field's value.
+func (s *AmazonopensearchserviceBufferingHints) SetSizeInMBs(v int64) *AmazonopensearchserviceBufferingHints {
+	s.SizeInMBs = &v
+	return s
+}
+
+// Describes the configuration of a destination in Amazon OpenSearch Service
+type AmazonopensearchserviceDestinationConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	// The buffering options. If no value is specified, the default values for AmazonopensearchserviceBufferingHints
+	// are used.
+	BufferingHints *AmazonopensearchserviceBufferingHints `type:"structure"`
+
+	// Describes the Amazon CloudWatch logging options for your delivery stream.
+	CloudWatchLoggingOptions *CloudWatchLoggingOptions `type:"structure"`
+
+	// The endpoint to use when communicating with the cluster. Specify either this
+	// ClusterEndpoint or the DomainARN field.
+	ClusterEndpoint *string `min:"1" type:"string"`
+
+	// Indicates the method for setting up document ID. The supported methods are
+	// Kinesis Data Firehose generated document ID and OpenSearch Service generated
+	// document ID.
+	DocumentIdOptions *DocumentIdOptions `type:"structure"`
+
+	// The ARN of the Amazon OpenSearch Service domain. The IAM role must have permissions
+	// for DescribeElasticsearchDomain, DescribeElasticsearchDomains, and DescribeElasticsearchDomainConfig
+	// after assuming the role specified in RoleARN.
+	DomainARN *string `min:"1" type:"string"`
+
+	// The ElasticsearAmazon OpenSearch Service index name.
 	//
-	// DeliveryStreamType is a required field
-	DeliveryStreamType *string `type:"string" required:"true" enum:"DeliveryStreamType"`
+	// IndexName is a required field
+	IndexName *string `min:"1" type:"string" required:"true"`
 
-	// The destinations.
+	// The Amazon OpenSearch Service index rotation period. Index rotation appends
+	// a timestamp to the IndexName to facilitate the expiration of old data.
+	IndexRotationPeriod *string `type:"string" enum:"AmazonopensearchserviceIndexRotationPeriod"`
+
+	// Describes a data processing configuration.
+	ProcessingConfiguration *ProcessingConfiguration `type:"structure"`
+
+	// The retry behavior in case Kinesis Data Firehose is unable to deliver documents
+	// to Amazon OpenSearch Service. The default value is 300 (5 minutes).
+	RetryOptions *AmazonopensearchserviceRetryOptions `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the IAM role to be assumed by Kinesis Data
+	// Firehose for calling the Amazon OpenSearch Service Configuration API and
+	// for indexing documents.
 	//
-	// Destinations is a required field
-	Destinations []*DestinationDescription `type:"list" required:"true"`
+	// RoleARN is a required field
+	RoleARN *string `min:"1" type:"string" required:"true"`
 
-	// Indicates whether there are more destinations available to list.
+	// Defines how documents should be delivered to Amazon S3. When it is set to
+	// FailedDocumentsOnly, Kinesis Data Firehose writes any documents that could
+	// not be indexed to the configured Amazon S3 destination, with AmazonOpenSearchService-failed/
+	// appended to the key prefix. When set to AllDocuments, Kinesis Data Firehose
+	// delivers all incoming records to Amazon S3, and also writes failed documents
+	// with AmazonOpenSearchService-failed/ appended to the prefix.
+	S3BackupMode *string `type:"string" enum:"AmazonopensearchserviceS3BackupMode"`
+
+	// Describes the configuration of a destination in Amazon S3.
 	//
-	// HasMoreDestinations is a required field
-	HasMoreDestinations *bool `type:"boolean" required:"true"`
+	// S3Configuration is a required field
+	S3Configuration *S3DestinationConfiguration `type:"structure" required:"true"`
 
-	// The date and time that the delivery stream was last updated.
-	LastUpdateTimestamp *time.Time `type:"timestamp"`
+	// The Amazon OpenSearch Service type name. For Elasticsearch 6.x, there can
+	// be only one type per index. If you try to specify a new type for an existing
+	// index that already has another type, Kinesis Data Firehose returns an error
+	// during run time.
+	TypeName *string `type:"string"`
 
-	// If the DeliveryStreamType parameter is KinesisStreamAsSource, a SourceDescription
-	// object describing the source Kinesis data stream.
-	Source *SourceDescription `type:"structure"`
+	// The details of the VPC of the Amazon OpenSearch or Amazon OpenSearch Serverless
+	// destination.
+	VpcConfiguration *VpcConfiguration `type:"structure"`
+}
 
-	// Each time the destination is updated for a delivery stream, the version ID
-	// is changed, and the current version ID is required when updating the destination.
-	// This is so that the service knows it is applying the changes to the correct
-	// version of the delivery stream.
-	//
-	// VersionId is a required field
-	VersionId *string `min:"1" type:"string" required:"true"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AmazonopensearchserviceDestinationConfiguration) String() string {
+	return awsutil.Prettify(s)
 }
 
-// String returns the string representation
-func (s DeliveryStreamDescription) String() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AmazonopensearchserviceDestinationConfiguration) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *AmazonopensearchserviceDestinationConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AmazonopensearchserviceDestinationConfiguration"}
+	if s.ClusterEndpoint != nil && len(*s.ClusterEndpoint) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ClusterEndpoint", 1))
+	}
+	if s.DomainARN != nil && len(*s.DomainARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("DomainARN", 1))
+	}
+	if s.IndexName == nil {
+		invalidParams.Add(request.NewErrParamRequired("IndexName"))
+	}
+	if s.IndexName != nil && len(*s.IndexName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("IndexName", 1))
+	}
+	if s.RoleARN == nil {
+		invalidParams.Add(request.NewErrParamRequired("RoleARN"))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RoleARN", 1))
+	}
+	if s.S3Configuration == nil {
+		invalidParams.Add(request.NewErrParamRequired("S3Configuration"))
+	}
+	if s.BufferingHints != nil {
+		if err := s.BufferingHints.Validate(); err != nil {
+			invalidParams.AddNested("BufferingHints", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.DocumentIdOptions != nil {
+		if err := s.DocumentIdOptions.Validate(); err != nil {
+			invalidParams.AddNested("DocumentIdOptions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ProcessingConfiguration != nil {
+		if err := s.ProcessingConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("ProcessingConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3Configuration != nil {
+		if err := s.S3Configuration.Validate(); err != nil {
+			invalidParams.AddNested("S3Configuration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.VpcConfiguration != nil {
+		if err := s.VpcConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("VpcConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetBufferingHints sets the BufferingHints field's value.
+func (s *AmazonopensearchserviceDestinationConfiguration) SetBufferingHints(v *AmazonopensearchserviceBufferingHints) *AmazonopensearchserviceDestinationConfiguration {
+	s.BufferingHints = v
+	return s
+}
+
+// SetCloudWatchLoggingOptions sets the CloudWatchLoggingOptions field's value.
+func (s *AmazonopensearchserviceDestinationConfiguration) SetCloudWatchLoggingOptions(v *CloudWatchLoggingOptions) *AmazonopensearchserviceDestinationConfiguration {
+	s.CloudWatchLoggingOptions = v
+	return s
+}
+
+// SetClusterEndpoint sets the ClusterEndpoint field's value.
+func (s *AmazonopensearchserviceDestinationConfiguration) SetClusterEndpoint(v string) *AmazonopensearchserviceDestinationConfiguration {
+	s.ClusterEndpoint = &v
+	return s
+}
+
+// SetDocumentIdOptions sets the DocumentIdOptions field's value.
+func (s *AmazonopensearchserviceDestinationConfiguration) SetDocumentIdOptions(v *DocumentIdOptions) *AmazonopensearchserviceDestinationConfiguration {
+	s.DocumentIdOptions = v
+	return s
+}
+
+// SetDomainARN sets the DomainARN field's value.
+func (s *AmazonopensearchserviceDestinationConfiguration) SetDomainARN(v string) *AmazonopensearchserviceDestinationConfiguration {
+	s.DomainARN = &v
+	return s
+}
+
+// SetIndexName sets the IndexName field's value.
+func (s *AmazonopensearchserviceDestinationConfiguration) SetIndexName(v string) *AmazonopensearchserviceDestinationConfiguration {
+	s.IndexName = &v
+	return s
+}
+
+// SetIndexRotationPeriod sets the IndexRotationPeriod field's value.
+func (s *AmazonopensearchserviceDestinationConfiguration) SetIndexRotationPeriod(v string) *AmazonopensearchserviceDestinationConfiguration {
+	s.IndexRotationPeriod = &v
+	return s
+}
+
+// SetProcessingConfiguration sets the ProcessingConfiguration field's value.
+func (s *AmazonopensearchserviceDestinationConfiguration) SetProcessingConfiguration(v *ProcessingConfiguration) *AmazonopensearchserviceDestinationConfiguration {
+	s.ProcessingConfiguration = v
+	return s
+}
+
+// SetRetryOptions sets the RetryOptions field's value.
+func (s *AmazonopensearchserviceDestinationConfiguration) SetRetryOptions(v *AmazonopensearchserviceRetryOptions) *AmazonopensearchserviceDestinationConfiguration {
+	s.RetryOptions = v
+	return s
+}
+
+// SetRoleARN sets the RoleARN field's value.
+func (s *AmazonopensearchserviceDestinationConfiguration) SetRoleARN(v string) *AmazonopensearchserviceDestinationConfiguration {
+	s.RoleARN = &v
+	return s
+}
+
+// SetS3BackupMode sets the S3BackupMode field's value.
+func (s *AmazonopensearchserviceDestinationConfiguration) SetS3BackupMode(v string) *AmazonopensearchserviceDestinationConfiguration {
+	s.S3BackupMode = &v
+	return s
+}
+
+// SetS3Configuration sets the S3Configuration field's value.
+func (s *AmazonopensearchserviceDestinationConfiguration) SetS3Configuration(v *S3DestinationConfiguration) *AmazonopensearchserviceDestinationConfiguration {
+	s.S3Configuration = v
+	return s
+}
+
+// SetTypeName sets the TypeName field's value.
+func (s *AmazonopensearchserviceDestinationConfiguration) SetTypeName(v string) *AmazonopensearchserviceDestinationConfiguration {
+	s.TypeName = &v
+	return s
+}
+
+// SetVpcConfiguration sets the VpcConfiguration field's value.
+func (s *AmazonopensearchserviceDestinationConfiguration) SetVpcConfiguration(v *VpcConfiguration) *AmazonopensearchserviceDestinationConfiguration {
+	s.VpcConfiguration = v
+	return s
+}
+
+// The destination description in Amazon OpenSearch Service.
+type AmazonopensearchserviceDestinationDescription struct {
+	_ struct{} `type:"structure"`
+
+	// The buffering options.
+	BufferingHints *AmazonopensearchserviceBufferingHints `type:"structure"`
+
+	// Describes the Amazon CloudWatch logging options for your delivery stream.
+	CloudWatchLoggingOptions *CloudWatchLoggingOptions `type:"structure"`
+
+	// The endpoint to use when communicating with the cluster. Kinesis Data Firehose
+	// uses either this ClusterEndpoint or the DomainARN field to send data to Amazon
+	// OpenSearch Service.
+	ClusterEndpoint *string `min:"1" type:"string"`
+
+	// Indicates the method for setting up document ID. The supported methods are
+	// Kinesis Data Firehose generated document ID and OpenSearch Service generated
+	// document ID.
+	DocumentIdOptions *DocumentIdOptions `type:"structure"`
+
+	// The ARN of the Amazon OpenSearch Service domain.
+	DomainARN *string `min:"1" type:"string"`
+
+	// The Amazon OpenSearch Service index name.
+	IndexName *string `min:"1" type:"string"`
+
+	// The Amazon OpenSearch Service index rotation period
+	IndexRotationPeriod *string `type:"string" enum:"AmazonopensearchserviceIndexRotationPeriod"`
+
+	// Describes a data processing configuration.
+	ProcessingConfiguration *ProcessingConfiguration `type:"structure"`
+
+	// The Amazon OpenSearch Service retry options.
+	RetryOptions *AmazonopensearchserviceRetryOptions `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the Amazon Web Services credentials.
+	RoleARN *string `min:"1" type:"string"`
+
+	// The Amazon S3 backup mode.
+	S3BackupMode *string `type:"string" enum:"AmazonopensearchserviceS3BackupMode"`
+
+	// Describes a destination in Amazon S3.
+	S3DestinationDescription *S3DestinationDescription `type:"structure"`
+
+	// The Amazon OpenSearch Service type name. This applies to Elasticsearch 6.x
+	// and lower versions. For Elasticsearch 7.x and OpenSearch Service 1.x, there's
+	// no value for TypeName.
+	TypeName *string `type:"string"`
+
+	// The details of the VPC of the Amazon ES destination.
+	VpcConfigurationDescription *VpcConfigurationDescription `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AmazonopensearchserviceDestinationDescription) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeliveryStreamDescription) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AmazonopensearchserviceDestinationDescription) GoString() string {
 	return s.String()
 }
 
-// SetCreateTimestamp sets the CreateTimestamp field's value.
-func (s *DeliveryStreamDescription) SetCreateTimestamp(v time.Time) *DeliveryStreamDescription {
-	s.CreateTimestamp = &v
+// SetBufferingHints sets the BufferingHints field's value.
+func (s *AmazonopensearchserviceDestinationDescription) SetBufferingHints(v *AmazonopensearchserviceBufferingHints) *AmazonopensearchserviceDestinationDescription {
+	s.BufferingHints = v
+	return s
+}
+
+// SetCloudWatchLoggingOptions sets the CloudWatchLoggingOptions field's value.
+func (s *AmazonopensearchserviceDestinationDescription) SetCloudWatchLoggingOptions(v *CloudWatchLoggingOptions) *AmazonopensearchserviceDestinationDescription {
+	s.CloudWatchLoggingOptions = v
+	return s
+}
+
+// SetClusterEndpoint sets the ClusterEndpoint field's value.
+func (s *AmazonopensearchserviceDestinationDescription) SetClusterEndpoint(v string) *AmazonopensearchserviceDestinationDescription {
+	s.ClusterEndpoint = &v
+	return s
+}
+
+// SetDocumentIdOptions sets the DocumentIdOptions field's value.
+func (s *AmazonopensearchserviceDestinationDescription) SetDocumentIdOptions(v *DocumentIdOptions) *AmazonopensearchserviceDestinationDescription {
+	s.DocumentIdOptions = v
+	return s
+}
+
+// SetDomainARN sets the DomainARN field's value.
+func (s *AmazonopensearchserviceDestinationDescription) SetDomainARN(v string) *AmazonopensearchserviceDestinationDescription {
+	s.DomainARN = &v
+	return s
+}
+
+// SetIndexName sets the IndexName field's value.
+func (s *AmazonopensearchserviceDestinationDescription) SetIndexName(v string) *AmazonopensearchserviceDestinationDescription {
+	s.IndexName = &v
+	return s
+}
+
+// SetIndexRotationPeriod sets the IndexRotationPeriod field's value.
+func (s *AmazonopensearchserviceDestinationDescription) SetIndexRotationPeriod(v string) *AmazonopensearchserviceDestinationDescription {
+	s.IndexRotationPeriod = &v
+	return s
+}
+
+// SetProcessingConfiguration sets the ProcessingConfiguration field's value.
+func (s *AmazonopensearchserviceDestinationDescription) SetProcessingConfiguration(v *ProcessingConfiguration) *AmazonopensearchserviceDestinationDescription {
+	s.ProcessingConfiguration = v
+	return s
+}
+
+// SetRetryOptions sets the RetryOptions field's value.
+func (s *AmazonopensearchserviceDestinationDescription) SetRetryOptions(v *AmazonopensearchserviceRetryOptions) *AmazonopensearchserviceDestinationDescription {
+	s.RetryOptions = v
+	return s
+}
+
+// SetRoleARN sets the RoleARN field's value.
+func (s *AmazonopensearchserviceDestinationDescription) SetRoleARN(v string) *AmazonopensearchserviceDestinationDescription {
+	s.RoleARN = &v
+	return s
+}
+
+// SetS3BackupMode sets the S3BackupMode field's value.
+func (s *AmazonopensearchserviceDestinationDescription) SetS3BackupMode(v string) *AmazonopensearchserviceDestinationDescription {
+	s.S3BackupMode = &v
+	return s
+}
+
+// SetS3DestinationDescription sets the S3DestinationDescription field's value.
+func (s *AmazonopensearchserviceDestinationDescription) SetS3DestinationDescription(v *S3DestinationDescription) *AmazonopensearchserviceDestinationDescription {
+	s.S3DestinationDescription = v
+	return s
+}
+
+// SetTypeName sets the TypeName field's value.
+func (s *AmazonopensearchserviceDestinationDescription) SetTypeName(v string) *AmazonopensearchserviceDestinationDescription {
+	s.TypeName = &v
+	return s
+}
+
+// SetVpcConfigurationDescription sets the VpcConfigurationDescription field's value.
+func (s *AmazonopensearchserviceDestinationDescription) SetVpcConfigurationDescription(v *VpcConfigurationDescription) *AmazonopensearchserviceDestinationDescription {
+	s.VpcConfigurationDescription = v
+	return s
+}
+
+// Describes an update for a destination in Amazon OpenSearch Service.
+type AmazonopensearchserviceDestinationUpdate struct {
+	_ struct{} `type:"structure"`
+
+	// The buffering options. If no value is specified, AmazonopensearchBufferingHints
+	// object default values are used.
+	BufferingHints *AmazonopensearchserviceBufferingHints `type:"structure"`
+
+	// Describes the Amazon CloudWatch logging options for your delivery stream.
+	CloudWatchLoggingOptions *CloudWatchLoggingOptions `type:"structure"`
+
+	// The endpoint to use when communicating with the cluster. Specify either this
+	// ClusterEndpoint or the DomainARN field.
+	ClusterEndpoint *string `min:"1" type:"string"`
+
+	// Indicates the method for setting up document ID. The supported methods are
+	// Kinesis Data Firehose generated document ID and OpenSearch Service generated
+	// document ID.
+	DocumentIdOptions *DocumentIdOptions `type:"structure"`
+
+	// The ARN of the Amazon OpenSearch Service domain. The IAM role must have permissions
+	// for DescribeDomain, DescribeDomains, and DescribeDomainConfig after assuming
+	// the IAM role specified in RoleARN.
+	DomainARN *string `min:"1" type:"string"`
+
+	// The Amazon OpenSearch Service index name.
+	IndexName *string `min:"1" type:"string"`
+
+	// The Amazon OpenSearch Service index rotation period. Index rotation appends
+	// a timestamp to IndexName to facilitate the expiration of old data.
+	IndexRotationPeriod *string `type:"string" enum:"AmazonopensearchserviceIndexRotationPeriod"`
+
+	// Describes a data processing configuration.
+	ProcessingConfiguration *ProcessingConfiguration `type:"structure"`
+
+	// The retry behavior in case Kinesis Data Firehose is unable to deliver documents
+	// to Amazon OpenSearch Service. The default value is 300 (5 minutes).
+	RetryOptions *AmazonopensearchserviceRetryOptions `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the IAM role to be assumed by Kinesis Data
+	// Firehose for calling the Amazon OpenSearch Service Configuration API and
+	// for indexing documents.
+	RoleARN *string `min:"1" type:"string"`
+
+	// Describes an update for a destination in Amazon S3.
+	S3Update *S3DestinationUpdate `type:"structure"`
+
+	// The Amazon OpenSearch Service type name. For Elasticsearch 6.x, there can
+	// be only one type per index. If you try to specify a new type for an existing
+	// index that already has another type, Kinesis Data Firehose returns an error
+	// during runtime.
+	//
+	// If you upgrade Elasticsearch from 6.x to 7.x and don’t update your delivery
+	// stream, Kinesis Data Firehose still delivers data to Elasticsearch with the
+	// old index name and type name. If you want to update your delivery stream
+	// with a new index name, provide an empty string for TypeName.
+	TypeName *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AmazonopensearchserviceDestinationUpdate) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AmazonopensearchserviceDestinationUpdate) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *AmazonopensearchserviceDestinationUpdate) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AmazonopensearchserviceDestinationUpdate"}
+	if s.ClusterEndpoint != nil && len(*s.ClusterEndpoint) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ClusterEndpoint", 1))
+	}
+	if s.DomainARN != nil && len(*s.DomainARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("DomainARN", 1))
+	}
+	if s.IndexName != nil && len(*s.IndexName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("IndexName", 1))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RoleARN", 1))
+	}
+	if s.BufferingHints != nil {
+		if err := s.BufferingHints.Validate(); err != nil {
+			invalidParams.AddNested("BufferingHints", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.DocumentIdOptions != nil {
+		if err := s.DocumentIdOptions.Validate(); err != nil {
+			invalidParams.AddNested("DocumentIdOptions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ProcessingConfiguration != nil {
+		if err := s.ProcessingConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("ProcessingConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3Update != nil {
+		if err := s.S3Update.Validate(); err != nil {
+			invalidParams.AddNested("S3Update", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetBufferingHints sets the BufferingHints field's value.
+func (s *AmazonopensearchserviceDestinationUpdate) SetBufferingHints(v *AmazonopensearchserviceBufferingHints) *AmazonopensearchserviceDestinationUpdate {
+	s.BufferingHints = v
+	return s
+}
+
+// SetCloudWatchLoggingOptions sets the CloudWatchLoggingOptions field's value.
+func (s *AmazonopensearchserviceDestinationUpdate) SetCloudWatchLoggingOptions(v *CloudWatchLoggingOptions) *AmazonopensearchserviceDestinationUpdate {
+	s.CloudWatchLoggingOptions = v
+	return s
+}
+
+// SetClusterEndpoint sets the ClusterEndpoint field's value.
+func (s *AmazonopensearchserviceDestinationUpdate) SetClusterEndpoint(v string) *AmazonopensearchserviceDestinationUpdate {
+	s.ClusterEndpoint = &v
+	return s
+}
+
+// SetDocumentIdOptions sets the DocumentIdOptions field's value.
+func (s *AmazonopensearchserviceDestinationUpdate) SetDocumentIdOptions(v *DocumentIdOptions) *AmazonopensearchserviceDestinationUpdate {
+	s.DocumentIdOptions = v
+	return s
+}
+
+// SetDomainARN sets the DomainARN field's value.
+func (s *AmazonopensearchserviceDestinationUpdate) SetDomainARN(v string) *AmazonopensearchserviceDestinationUpdate {
+	s.DomainARN = &v
+	return s
+}
+
+// SetIndexName sets the IndexName field's value.
+func (s *AmazonopensearchserviceDestinationUpdate) SetIndexName(v string) *AmazonopensearchserviceDestinationUpdate {
+	s.IndexName = &v
+	return s
+}
+
+// SetIndexRotationPeriod sets the IndexRotationPeriod field's value.
+func (s *AmazonopensearchserviceDestinationUpdate) SetIndexRotationPeriod(v string) *AmazonopensearchserviceDestinationUpdate {
+	s.IndexRotationPeriod = &v
+	return s
+}
+
+// SetProcessingConfiguration sets the ProcessingConfiguration field's value.
+func (s *AmazonopensearchserviceDestinationUpdate) SetProcessingConfiguration(v *ProcessingConfiguration) *AmazonopensearchserviceDestinationUpdate {
+	s.ProcessingConfiguration = v
+	return s
+}
+
+// SetRetryOptions sets the RetryOptions field's value.
+func (s *AmazonopensearchserviceDestinationUpdate) SetRetryOptions(v *AmazonopensearchserviceRetryOptions) *AmazonopensearchserviceDestinationUpdate {
+	s.RetryOptions = v
+	return s
+}
+
+// SetRoleARN sets the RoleARN field's value.
+func (s *AmazonopensearchserviceDestinationUpdate) SetRoleARN(v string) *AmazonopensearchserviceDestinationUpdate {
+	s.RoleARN = &v
+	return s
+}
+
+// SetS3Update sets the S3Update field's value.
+func (s *AmazonopensearchserviceDestinationUpdate) SetS3Update(v *S3DestinationUpdate) *AmazonopensearchserviceDestinationUpdate {
+	s.S3Update = v
+	return s
+}
+
+// SetTypeName sets the TypeName field's value.
+func (s *AmazonopensearchserviceDestinationUpdate) SetTypeName(v string) *AmazonopensearchserviceDestinationUpdate {
+	s.TypeName = &v
+	return s
+}
+
+// Configures retry behavior in case Kinesis Data Firehose is unable to deliver
+// documents to Amazon OpenSearch Service.
+type AmazonopensearchserviceRetryOptions struct {
+	_ struct{} `type:"structure"`
+
+	// After an initial failure to deliver to Amazon OpenSearch Service, the total
+	// amount of time during which Kinesis Data Firehose retries delivery (including
+	// the first attempt). After this time has elapsed, the failed documents are
+	// written to Amazon S3. Default value is 300 seconds (5 minutes). A value of
+	// 0 (zero) results in no retries.
+	DurationInSeconds *int64 `type:"integer"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AmazonopensearchserviceRetryOptions) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AmazonopensearchserviceRetryOptions) GoString() string {
+	return s.String()
+}
+
+// SetDurationInSeconds sets the DurationInSeconds field's value.
+func (s *AmazonopensearchserviceRetryOptions) SetDurationInSeconds(v int64) *AmazonopensearchserviceRetryOptions {
+	s.DurationInSeconds = &v
+	return s
+}
+
+// The authentication configuration of the Amazon MSK cluster.
+type AuthenticationConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	// The type of connectivity used to access the Amazon MSK cluster.
+	//
+	// Connectivity is a required field
+	Connectivity *string `type:"string" required:"true" enum:"Connectivity"`
+
+	// The ARN of the role used to access the Amazon MSK cluster.
+	//
+	// RoleARN is a required field
+	RoleARN *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AuthenticationConfiguration) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AuthenticationConfiguration) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *AuthenticationConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AuthenticationConfiguration"}
+	if s.Connectivity == nil {
+		invalidParams.Add(request.NewErrParamRequired("Connectivity"))
+	}
+	if s.RoleARN == nil {
+		invalidParams.Add(request.NewErrParamRequired("RoleARN"))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RoleARN", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetConnectivity sets the Connectivity field's value.
+func (s *AuthenticationConfiguration) SetConnectivity(v string) *AuthenticationConfiguration {
+	s.Connectivity = &v
+	return s
+}
+
+// SetRoleARN sets the RoleARN field's value.
+func (s *AuthenticationConfiguration) SetRoleARN(v string) *AuthenticationConfiguration {
+	s.RoleARN = &v
+	return s
+}
+
+// Describes hints for the buffering to perform before delivering data to the
+// destination. These options are treated as hints, and therefore Kinesis Data
+// Firehose might choose to use different values when it is optimal. The SizeInMBs
+// and IntervalInSeconds parameters are optional. However, if specify a value
+// for one of them, you must also provide a value for the other.
+type BufferingHints struct {
+	_ struct{} `type:"structure"`
+
+	// Buffer incoming data for the specified period of time, in seconds, before
+	// delivering it to the destination. The default value is 300. This parameter
+	// is optional but if you specify a value for it, you must also specify a value
+	// for SizeInMBs, and vice versa.
+	IntervalInSeconds *int64 `min:"60" type:"integer"`
+
+	// Buffer incoming data to the specified size, in MiBs, before delivering it
+	// to the destination. The default value is 5. This parameter is optional but
+	// if you specify a value for it, you must also specify a value for IntervalInSeconds,
+	// and vice versa.
+	//
+	// We recommend setting this parameter to a value greater than the amount of
+	// data you typically ingest into the delivery stream in 10 seconds. For example,
+	// if you typically ingest data at 1 MiB/sec, the value should be 10 MiB or
+	// higher.
+	SizeInMBs *int64 `min:"1" type:"integer"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BufferingHints) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BufferingHints) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *BufferingHints) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "BufferingHints"}
+	if s.IntervalInSeconds != nil && *s.IntervalInSeconds < 60 {
+		invalidParams.Add(request.NewErrParamMinValue("IntervalInSeconds", 60))
+	}
+	if s.SizeInMBs != nil && *s.SizeInMBs < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("SizeInMBs", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetIntervalInSeconds sets the IntervalInSeconds field's value.
+func (s *BufferingHints) SetIntervalInSeconds(v int64) *BufferingHints {
+	s.IntervalInSeconds = &v
+	return s
+}
+
+// SetSizeInMBs sets the SizeInMBs field's value.
+func (s *BufferingHints) SetSizeInMBs(v int64) *BufferingHints {
+	s.SizeInMBs = &v
+	return s
+}
+
+// Describes the Amazon CloudWatch logging options for your delivery stream.
+type CloudWatchLoggingOptions struct {
+	_ struct{} `type:"structure"`
+
+	// Enables or disables CloudWatch logging.
+	Enabled *bool `type:"boolean"`
+
+	// The CloudWatch group name for logging. This value is required if CloudWatch
+	// logging is enabled.
+	LogGroupName *string `type:"string"`
+
+	// The CloudWatch log stream name for logging. This value is required if CloudWatch
+	// logging is enabled.
+	LogStreamName *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CloudWatchLoggingOptions) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CloudWatchLoggingOptions) GoString() string {
+	return s.String()
+}
+
+// SetEnabled sets the Enabled field's value.
+func (s *CloudWatchLoggingOptions) SetEnabled(v bool) *CloudWatchLoggingOptions {
+	s.Enabled = &v
+	return s
+}
+
+// SetLogGroupName sets the LogGroupName field's value.
+func (s *CloudWatchLoggingOptions) SetLogGroupName(v string) *CloudWatchLoggingOptions {
+	s.LogGroupName = &v
+	return s
+}
+
+// SetLogStreamName sets the LogStreamName field's value.
+func (s *CloudWatchLoggingOptions) SetLogStreamName(v string) *CloudWatchLoggingOptions {
+	s.LogStreamName = &v
+	return s
+}
+
+// Another modification has already happened. Fetch VersionId again and use
+// it to update the destination.
+type ConcurrentModificationException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// A message that provides information about the error.
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConcurrentModificationException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConcurrentModificationException) GoString() string {
+	return s.String()
+}
+
+func newErrorConcurrentModificationException(v protocol.ResponseMetadata) error {
+	return &ConcurrentModificationException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ConcurrentModificationException) Code() string {
+	return "ConcurrentModificationException"
+}
+
+// Message returns the exception's message.
+func (s *ConcurrentModificationException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ConcurrentModificationException) OrigErr() error {
+	return nil
+}
+
+func (s *ConcurrentModificationException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ConcurrentModificationException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ConcurrentModificationException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Describes a COPY command for Amazon Redshift.
+type CopyCommand struct {
+	_ struct{} `type:"structure"`
+
+	// Optional parameters to use with the Amazon Redshift COPY command. For more
+	// information, see the "Optional Parameters" section of Amazon Redshift COPY
+	// command (https://docs.aws.amazon.com/redshift/latest/dg/r_COPY.html). Some
+	// possible examples that would apply to Kinesis Data Firehose are as follows:
+	//
+	// delimiter '\t' lzop; - fields are delimited with "\t" (TAB character) and
+	// compressed using lzop.
+	//
+	// delimiter '|' - fields are delimited with "|" (this is the default delimiter).
+	//
+	// delimiter '|' escape - the delimiter should be escaped.
+	//
+	// fixedwidth 'venueid:3,venuename:25,venuecity:12,venuestate:2,venueseats:6'
+	// - fields are fixed width in the source, with each width specified after every
+	// column in the table.
+	//
+	// JSON 's3://mybucket/jsonpaths.txt' - data is in JSON format, and the path
+	// specified is the format of the data.
+	//
+	// For more examples, see Amazon Redshift COPY command examples (https://docs.aws.amazon.com/redshift/latest/dg/r_COPY_command_examples.html).
+	CopyOptions *string `type:"string"`
+
+	// A comma-separated list of column names.
+	DataTableColumns *string `type:"string"`
+
+	// The name of the target table. The table must already exist in the database.
+	//
+	// DataTableName is a required field
+	DataTableName *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CopyCommand) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CopyCommand) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CopyCommand) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CopyCommand"}
+	if s.DataTableName == nil {
+		invalidParams.Add(request.NewErrParamRequired("DataTableName"))
+	}
+	if s.DataTableName != nil && len(*s.DataTableName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("DataTableName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCopyOptions sets the CopyOptions field's value.
+func (s *CopyCommand) SetCopyOptions(v string) *CopyCommand {
+	s.CopyOptions = &v
+	return s
+}
+
+// SetDataTableColumns sets the DataTableColumns field's value.
+func (s *CopyCommand) SetDataTableColumns(v string) *CopyCommand {
+	s.DataTableColumns = &v
+	return s
+}
+
+// SetDataTableName sets the DataTableName field's value.
+func (s *CopyCommand) SetDataTableName(v string) *CopyCommand {
+	s.DataTableName = &v
+	return s
+}
+
+type CreateDeliveryStreamInput struct {
+	_ struct{} `type:"structure"`
+
+	// The destination in the Serverless offering for Amazon OpenSearch Service.
+	// You can specify only one destination.
+	AmazonOpenSearchServerlessDestinationConfiguration *AmazonOpenSearchServerlessDestinationConfiguration `type:"structure"`
+
+	// The destination in Amazon OpenSearch Service. You can specify only one destination.
+	AmazonopensearchserviceDestinationConfiguration *AmazonopensearchserviceDestinationConfiguration `type:"structure"`
+
+	// Used to specify the type and Amazon Resource Name (ARN) of the KMS key needed
+	// for Server-Side Encryption (SSE).
+	DeliveryStreamEncryptionConfigurationInput *DeliveryStreamEncryptionConfigurationInput `type:"structure"`
+
+	// The name of the delivery stream. This name must be unique per Amazon Web
+	// Services account in the same Amazon Web Services Region. If the delivery
+	// streams are in different accounts or different Regions, you can have multiple
+	// delivery streams with the same name.
+	//
+	// DeliveryStreamName is a required field
+	DeliveryStreamName *string `min:"1" type:"string" required:"true"`
+
+	// The delivery stream type. This parameter can be one of the following values:
+	//
+	//    * DirectPut: Provider applications access the delivery stream directly.
+	//
+	//    * KinesisStreamAsSource: The delivery stream uses a Kinesis data stream
+	//    as a source.
+	DeliveryStreamType *string `type:"string" enum:"DeliveryStreamType"`
+
+	// The destination in Amazon ES. You can specify only one destination.
+	ElasticsearchDestinationConfiguration *ElasticsearchDestinationConfiguration `type:"structure"`
+
+	// The destination in Amazon S3. You can specify only one destination.
+	ExtendedS3DestinationConfiguration *ExtendedS3DestinationConfiguration `type:"structure"`
+
+	// Enables configuring Kinesis Firehose to deliver data to any HTTP endpoint
+	// destination. You can specify only one destination.
+	HttpEndpointDestinationConfiguration *HttpEndpointDestinationConfiguration `type:"structure"`
+
+	// When a Kinesis data stream is used as the source for the delivery stream,
+	// a KinesisStreamSourceConfiguration containing the Kinesis data stream Amazon
+	// Resource Name (ARN) and the role ARN for the source stream.
+	KinesisStreamSourceConfiguration *KinesisStreamSourceConfiguration `type:"structure"`
+
+	// The configuration for the Amazon MSK cluster to be used as the source for
+	// a delivery stream.
+	MSKSourceConfiguration *MSKSourceConfiguration `type:"structure"`
+
+	// The destination in Amazon Redshift. You can specify only one destination.
+	RedshiftDestinationConfiguration *RedshiftDestinationConfiguration `type:"structure"`
+
+	// [Deprecated] The destination in Amazon S3. You can specify only one destination.
+	//
+	// Deprecated: S3DestinationConfiguration has been deprecated
+	S3DestinationConfiguration *S3DestinationConfiguration `deprecated:"true" type:"structure"`
+
+	// The destination in Splunk. You can specify only one destination.
+	SplunkDestinationConfiguration *SplunkDestinationConfiguration `type:"structure"`
+
+	// A set of tags to assign to the delivery stream. A tag is a key-value pair
+	// that you can define and assign to Amazon Web Services resources. Tags are
+	// metadata. For example, you can add friendly names and descriptions or other
+	// types of information that can help you distinguish the delivery stream. For
+	// more information about tags, see Using Cost Allocation Tags (https://docs.aws.amazon.com/awsaccountbilling/latest/aboutv2/cost-alloc-tags.html)
+	// in the Amazon Web Services Billing and Cost Management User Guide.
+	//
+	// You can specify up to 50 tags when creating a delivery stream.
+	Tags []*Tag `min:"1" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDeliveryStreamInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDeliveryStreamInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateDeliveryStreamInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDeliveryStreamInput"}
+	if s.DeliveryStreamName == nil {
+		invalidParams.Add(request.NewErrParamRequired("DeliveryStreamName"))
+	}
+	if s.DeliveryStreamName != nil && len(*s.DeliveryStreamName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("DeliveryStreamName", 1))
+	}
+	if s.Tags != nil && len(s.Tags) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
+	}
+	if s.AmazonOpenSearchServerlessDestinationConfiguration != nil {
+		if err := s.AmazonOpenSearchServerlessDestinationConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("AmazonOpenSearchServerlessDestinationConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.AmazonopensearchserviceDestinationConfiguration != nil {
+		if err := s.AmazonopensearchserviceDestinationConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("AmazonopensearchserviceDestinationConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.DeliveryStreamEncryptionConfigurationInput != nil {
+		if err := s.DeliveryStreamEncryptionConfigurationInput.Validate(); err != nil {
+			invalidParams.AddNested("DeliveryStreamEncryptionConfigurationInput", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ElasticsearchDestinationConfiguration != nil {
+		if err := s.ElasticsearchDestinationConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("ElasticsearchDestinationConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ExtendedS3DestinationConfiguration != nil {
+		if err := s.ExtendedS3DestinationConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("ExtendedS3DestinationConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.HttpEndpointDestinationConfiguration != nil {
+		if err := s.HttpEndpointDestinationConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("HttpEndpointDestinationConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.KinesisStreamSourceConfiguration != nil {
+		if err := s.KinesisStreamSourceConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("KinesisStreamSourceConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.MSKSourceConfiguration != nil {
+		if err := s.MSKSourceConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("MSKSourceConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.RedshiftDestinationConfiguration != nil {
+		if err := s.RedshiftDestinationConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("RedshiftDestinationConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3DestinationConfiguration != nil {
+		if err := s.S3DestinationConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("S3DestinationConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.SplunkDestinationConfiguration != nil {
+		if err := s.SplunkDestinationConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("SplunkDestinationConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAmazonOpenSearchServerlessDestinationConfiguration sets the AmazonOpenSearchServerlessDestinationConfiguration field's value.
+func (s *CreateDeliveryStreamInput) SetAmazonOpenSearchServerlessDestinationConfiguration(v *AmazonOpenSearchServerlessDestinationConfiguration) *CreateDeliveryStreamInput {
+	s.AmazonOpenSearchServerlessDestinationConfiguration = v
+	return s
+}
+
+// SetAmazonopensearchserviceDestinationConfiguration sets the AmazonopensearchserviceDestinationConfiguration field's value.
+func (s *CreateDeliveryStreamInput) SetAmazonopensearchserviceDestinationConfiguration(v *AmazonopensearchserviceDestinationConfiguration) *CreateDeliveryStreamInput {
+	s.AmazonopensearchserviceDestinationConfiguration = v
+	return s
+}
+
+// SetDeliveryStreamEncryptionConfigurationInput sets the DeliveryStreamEncryptionConfigurationInput field's value.
+func (s *CreateDeliveryStreamInput) SetDeliveryStreamEncryptionConfigurationInput(v *DeliveryStreamEncryptionConfigurationInput) *CreateDeliveryStreamInput {
+	s.DeliveryStreamEncryptionConfigurationInput = v
+	return s
+}
+
+// SetDeliveryStreamName sets the DeliveryStreamName field's value.
+func (s *CreateDeliveryStreamInput) SetDeliveryStreamName(v string) *CreateDeliveryStreamInput {
+	s.DeliveryStreamName = &v
+	return s
+}
+
+// SetDeliveryStreamType sets the DeliveryStreamType field's value.
+func (s *CreateDeliveryStreamInput) SetDeliveryStreamType(v string) *CreateDeliveryStreamInput {
+	s.DeliveryStreamType = &v
+	return s
+}
+
+// SetElasticsearchDestinationConfiguration sets the ElasticsearchDestinationConfiguration field's value.
+func (s *CreateDeliveryStreamInput) SetElasticsearchDestinationConfiguration(v *ElasticsearchDestinationConfiguration) *CreateDeliveryStreamInput {
+	s.ElasticsearchDestinationConfiguration = v
+	return s
+}
+
+// SetExtendedS3DestinationConfiguration sets the ExtendedS3DestinationConfiguration field's value.
+func (s *CreateDeliveryStreamInput) SetExtendedS3DestinationConfiguration(v *ExtendedS3DestinationConfiguration) *CreateDeliveryStreamInput {
+	s.ExtendedS3DestinationConfiguration = v
+	return s
+}
+
+// SetHttpEndpointDestinationConfiguration sets the HttpEndpointDestinationConfiguration field's value.
+func (s *CreateDeliveryStreamInput) SetHttpEndpointDestinationConfiguration(v *HttpEndpointDestinationConfiguration) *CreateDeliveryStreamInput {
+	s.HttpEndpointDestinationConfiguration = v
+	return s
+}
+
+// SetKinesisStreamSourceConfiguration sets the KinesisStreamSourceConfiguration field's value.
+func (s *CreateDeliveryStreamInput) SetKinesisStreamSourceConfiguration(v *KinesisStreamSourceConfiguration) *CreateDeliveryStreamInput {
+	s.KinesisStreamSourceConfiguration = v
+	return s
+}
+
+// SetMSKSourceConfiguration sets the MSKSourceConfiguration field's value.
+func (s *CreateDeliveryStreamInput) SetMSKSourceConfiguration(v *MSKSourceConfiguration) *CreateDeliveryStreamInput {
+	s.MSKSourceConfiguration = v
+	return s
+}
+
+// SetRedshiftDestinationConfiguration sets the RedshiftDestinationConfiguration field's value.
+func (s *CreateDeliveryStreamInput) SetRedshiftDestinationConfiguration(v *RedshiftDestinationConfiguration) *CreateDeliveryStreamInput {
+	s.RedshiftDestinationConfiguration = v
+	return s
+}
+
+// SetS3DestinationConfiguration sets the S3DestinationConfiguration field's value.
+func (s *CreateDeliveryStreamInput) SetS3DestinationConfiguration(v *S3DestinationConfiguration) *CreateDeliveryStreamInput {
+	s.S3DestinationConfiguration = v
+	return s
+}
+
+// SetSplunkDestinationConfiguration sets the SplunkDestinationConfiguration field's value.
+func (s *CreateDeliveryStreamInput) SetSplunkDestinationConfiguration(v *SplunkDestinationConfiguration) *CreateDeliveryStreamInput {
+	s.SplunkDestinationConfiguration = v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *CreateDeliveryStreamInput) SetTags(v []*Tag) *CreateDeliveryStreamInput {
+	s.Tags = v
+	return s
+}
+
+type CreateDeliveryStreamOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the delivery stream.
+	DeliveryStreamARN *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDeliveryStreamOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateDeliveryStreamOutput) GoString() string {
+	return s.String()
+}
+
+// SetDeliveryStreamARN sets the DeliveryStreamARN field's value.
+func (s *CreateDeliveryStreamOutput) SetDeliveryStreamARN(v string) *CreateDeliveryStreamOutput {
+	s.DeliveryStreamARN = &v
+	return s
+}
+
+// Specifies that you want Kinesis Data Firehose to convert data from the JSON
+// format to the Parquet or ORC format before writing it to Amazon S3. Kinesis
+// Data Firehose uses the serializer and deserializer that you specify, in addition
+// to the column information from the Amazon Web Services Glue table, to deserialize
+// your input data from JSON and then serialize it to the Parquet or ORC format.
+// For more information, see Kinesis Data Firehose Record Format Conversion
+// (https://docs.aws.amazon.com/firehose/latest/dev/record-format-conversion.html).
+type DataFormatConversionConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	// Defaults to true. Set it to false if you want to disable format conversion
+	// while preserving the configuration details.
+	Enabled *bool `type:"boolean"`
+
+	// Specifies the deserializer that you want Kinesis Data Firehose to use to
+	// convert the format of your data from JSON. This parameter is required if
+	// Enabled is set to true.
+	InputFormatConfiguration *InputFormatConfiguration `type:"structure"`
+
+	// Specifies the serializer that you want Kinesis Data Firehose to use to convert
+	// the format of your data to the Parquet or ORC format. This parameter is required
+	// if Enabled is set to true.
+	OutputFormatConfiguration *OutputFormatConfiguration `type:"structure"`
+
+	// Specifies the Amazon Web Services Glue Data Catalog table that contains the
+	// column information. This parameter is required if Enabled is set to true.
+	SchemaConfiguration *SchemaConfiguration `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DataFormatConversionConfiguration) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DataFormatConversionConfiguration) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DataFormatConversionConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DataFormatConversionConfiguration"}
+	if s.OutputFormatConfiguration != nil {
+		if err := s.OutputFormatConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("OutputFormatConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.SchemaConfiguration != nil {
+		if err := s.SchemaConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("SchemaConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetEnabled sets the Enabled field's value.
+func (s *DataFormatConversionConfiguration) SetEnabled(v bool) *DataFormatConversionConfiguration {
+	s.Enabled = &v
+	return s
+}
+
+// SetInputFormatConfiguration sets the InputFormatConfiguration field's value.
+func (s *DataFormatConversionConfiguration) SetInputFormatConfiguration(v *InputFormatConfiguration) *DataFormatConversionConfiguration {
+	s.InputFormatConfiguration = v
+	return s
+}
+
+// SetOutputFormatConfiguration sets the OutputFormatConfiguration field's value.
+func (s *DataFormatConversionConfiguration) SetOutputFormatConfiguration(v *OutputFormatConfiguration) *DataFormatConversionConfiguration {
+	s.OutputFormatConfiguration = v
+	return s
+}
+
+// SetSchemaConfiguration sets the SchemaConfiguration field's value.
+func (s *DataFormatConversionConfiguration) SetSchemaConfiguration(v *SchemaConfiguration) *DataFormatConversionConfiguration {
+	s.SchemaConfiguration = v
+	return s
+}
+
+type DeleteDeliveryStreamInput struct {
+	_ struct{} `type:"structure"`
+
+	// Set this to true if you want to delete the delivery stream even if Kinesis
+	// Data Firehose is unable to retire the grant for the CMK. Kinesis Data Firehose
+	// might be unable to retire the grant due to a customer error, such as when
+	// the CMK or the grant are in an invalid state. If you force deletion, you
+	// can then use the RevokeGrant (https://docs.aws.amazon.com/kms/latest/APIReference/API_RevokeGrant.html)
+	// operation to revoke the grant you gave to Kinesis Data Firehose. If a failure
+	// to retire the grant happens due to an Amazon Web Services KMS issue, Kinesis
+	// Data Firehose keeps retrying the delete operation.
+	//
+	// The default value is false.
+	AllowForceDelete *bool `type:"boolean"`
+
+	// The name of the delivery stream.
+	//
+	// DeliveryStreamName is a required field
+	DeliveryStreamName *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDeliveryStreamInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDeliveryStreamInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteDeliveryStreamInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteDeliveryStreamInput"}
+	if s.DeliveryStreamName == nil {
+		invalidParams.Add(request.NewErrParamRequired("DeliveryStreamName"))
+	}
+	if s.DeliveryStreamName != nil && len(*s.DeliveryStreamName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("DeliveryStreamName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAllowForceDelete sets the AllowForceDelete field's value.
+func (s *DeleteDeliveryStreamInput) SetAllowForceDelete(v bool) *DeleteDeliveryStreamInput {
+	s.AllowForceDelete = &v
+	return s
+}
+
+// SetDeliveryStreamName sets the DeliveryStreamName field's value.
+func (s *DeleteDeliveryStreamInput) SetDeliveryStreamName(v string) *DeleteDeliveryStreamInput {
+	s.DeliveryStreamName = &v
+	return s
+}
+
+type DeleteDeliveryStreamOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDeliveryStreamOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteDeliveryStreamOutput) GoString() string {
+	return s.String()
+}
+
+// Contains information about a delivery stream.
+type DeliveryStreamDescription struct {
+	_ struct{} `type:"structure"`
+
+	// The date and time that the delivery stream was created.
+	CreateTimestamp *time.Time `type:"timestamp"`
+
+	// The Amazon Resource Name (ARN) of the delivery stream. For more information,
+	// see Amazon Resource Names (ARNs) and Amazon Web Services Service Namespaces
+	// (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	//
+	// DeliveryStreamARN is a required field
+	DeliveryStreamARN *string `min:"1" type:"string" required:"true"`
+
+	// Indicates the server-side encryption (SSE) status for the delivery stream.
+	DeliveryStreamEncryptionConfiguration *DeliveryStreamEncryptionConfiguration `type:"structure"`
+
+	// The name of the delivery stream.
+	//
+	// DeliveryStreamName is a required field
+	DeliveryStreamName *string `min:"1" type:"string" required:"true"`
+
+	// The status of the delivery stream. If the status of a delivery stream is
+	// CREATING_FAILED, this status doesn't change, and you can't invoke CreateDeliveryStream
+	// again on it. However, you can invoke the DeleteDeliveryStream operation to
+	// delete it.
+	//
+	// DeliveryStreamStatus is a required field
+	DeliveryStreamStatus *string `type:"string" required:"true" enum:"DeliveryStreamStatus"`
+
+	// The delivery stream type. This can be one of the following values:
+	//
+	//    * DirectPut: Provider applications access the delivery stream directly.
+	//
+	//    * KinesisStreamAsSource: The delivery stream uses a Kinesis data stream
+	//    as a source.
+	//
+	// DeliveryStreamType is a required field
+	DeliveryStreamType *string `type:"string" required:"true" enum:"DeliveryStreamType"`
+
+	// The destinations.
+	//
+	// Destinations is a required field
+	Destinations []*DestinationDescription `type:"list" required:"true"`
+
+	// Provides details in case one of the following operations fails due to an
+	// error related to KMS: CreateDeliveryStream, DeleteDeliveryStream, StartDeliveryStreamEncryption,
+	// StopDeliveryStreamEncryption.
+	FailureDescription *FailureDescription `type:"structure"`
+
+	// Indicates whether there are more destinations available to list.
+	//
+	// HasMoreDestinations is a required field
+	HasMoreDestinations *bool `type:"boolean" required:"true"`
+
+	// The date and time that the delivery stream was last updated.
+	LastUpdateTimestamp *time.Time `type:"timestamp"`
+
+	// If the DeliveryStreamType parameter is KinesisStreamAsSource, a SourceDescription
+	// object describing the source Kinesis data stream.
+	Source *SourceDescription `type:"structure"`
+
+	// Each time the destination is updated for a delivery stream, the version ID
+	// is changed, and the current version ID is required when updating the destination.
+	// This is so that the service knows it is applying the changes to the correct
+	// version of the delivery stream.
+	//
+	// VersionId is a required field
+	VersionId *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeliveryStreamDescription) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeliveryStreamDescription) GoString() string {
+	return s.String()
+}
+
+// SetCreateTimestamp sets the CreateTimestamp field's value.
+func (s *DeliveryStreamDescription) SetCreateTimestamp(v time.Time) *DeliveryStreamDescription {
+	s.CreateTimestamp = &v
+	return s
+}
+
+// SetDeliveryStreamARN sets the DeliveryStreamARN field's value.
+func (s *DeliveryStreamDescription) SetDeliveryStreamARN(v string) *DeliveryStreamDescription {
+	s.DeliveryStreamARN = &v
+	return s
+}
+
+// SetDeliveryStreamEncryptionConfiguration sets the DeliveryStreamEncryptionConfiguration field's value.
+func (s *DeliveryStreamDescription) SetDeliveryStreamEncryptionConfiguration(v *DeliveryStreamEncryptionConfiguration) *DeliveryStreamDescription {
+	s.DeliveryStreamEncryptionConfiguration = v
+	return s
+}
+
+// SetDeliveryStreamName sets the DeliveryStreamName field's value.
+func (s *DeliveryStreamDescription) SetDeliveryStreamName(v string) *DeliveryStreamDescription {
+	s.DeliveryStreamName = &v
+	return s
+}
+
+// SetDeliveryStreamStatus sets the DeliveryStreamStatus field's value.
+func (s *DeliveryStreamDescription) SetDeliveryStreamStatus(v string) *DeliveryStreamDescription {
+	s.DeliveryStreamStatus = &v
+	return s
+}
+
+// SetDeliveryStreamType sets the DeliveryStreamType field's value.
+func (s *DeliveryStreamDescription) SetDeliveryStreamType(v string) *DeliveryStreamDescription {
+	s.DeliveryStreamType = &v
+	return s
+}
+
+// SetDestinations sets the Destinations field's value.
+func (s *DeliveryStreamDescription) SetDestinations(v []*DestinationDescription) *DeliveryStreamDescription {
+	s.Destinations = v
+	return s
+}
+
+// SetFailureDescription sets the FailureDescription field's value.
+func (s *DeliveryStreamDescription) SetFailureDescription(v *FailureDescription) *DeliveryStreamDescription {
+	s.FailureDescription = v
+	return s
+}
+
+// SetHasMoreDestinations sets the HasMoreDestinations field's value.
+func (s *DeliveryStreamDescription) SetHasMoreDestinations(v bool) *DeliveryStreamDescription {
+	s.HasMoreDestinations = &v
+	return s
+}
+
+// SetLastUpdateTimestamp sets the LastUpdateTimestamp field's value.
+func (s *DeliveryStreamDescription) SetLastUpdateTimestamp(v time.Time) *DeliveryStreamDescription {
+	s.LastUpdateTimestamp = &v
+	return s
+}
+
+// SetSource sets the Source field's value.
+func (s *DeliveryStreamDescription) SetSource(v *SourceDescription) *DeliveryStreamDescription {
+	s.Source = v
+	return s
+}
+
+// SetVersionId sets the VersionId field's value.
+func (s *DeliveryStreamDescription) SetVersionId(v string) *DeliveryStreamDescription {
+	s.VersionId = &v
+	return s
+}
+
+// Contains information about the server-side encryption (SSE) status for the
+// delivery stream, the type customer master key (CMK) in use, if any, and the
+// ARN of the CMK. You can get DeliveryStreamEncryptionConfiguration by invoking
+// the DescribeDeliveryStream operation.
+type DeliveryStreamEncryptionConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	// Provides details in case one of the following operations fails due to an
+	// error related to KMS: CreateDeliveryStream, DeleteDeliveryStream, StartDeliveryStreamEncryption,
+	// StopDeliveryStreamEncryption.
+	FailureDescription *FailureDescription `type:"structure"`
+
+	// If KeyType is CUSTOMER_MANAGED_CMK, this field contains the ARN of the customer
+	// managed CMK. If KeyType is Amazon Web Services_OWNED_CMK, DeliveryStreamEncryptionConfiguration
+	// doesn't contain a value for KeyARN.
+	KeyARN *string `min:"1" type:"string"`
+
+	// Indicates the type of customer master key (CMK) that is used for encryption.
+	// The default setting is Amazon Web Services_OWNED_CMK. For more information
+	// about CMKs, see Customer Master Keys (CMKs) (https://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#master_keys).
+	KeyType *string `type:"string" enum:"KeyType"`
+
+	// This is the server-side encryption (SSE) status for the delivery stream.
+	// For a full description of the different values of this status, see StartDeliveryStreamEncryption
+	// and StopDeliveryStreamEncryption. If this status is ENABLING_FAILED or DISABLING_FAILED,
+	// it is the status of the most recent attempt to enable or disable SSE, respectively.
+	Status *string `type:"string" enum:"DeliveryStreamEncryptionStatus"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeliveryStreamEncryptionConfiguration) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeliveryStreamEncryptionConfiguration) GoString() string {
+	return s.String()
+}
+
+// SetFailureDescription sets the FailureDescription field's value.
+func (s *DeliveryStreamEncryptionConfiguration) SetFailureDescription(v *FailureDescription) *DeliveryStreamEncryptionConfiguration {
+	s.FailureDescription = v
+	return s
+}
+
+// SetKeyARN sets the KeyARN field's value.
+func (s *DeliveryStreamEncryptionConfiguration) SetKeyARN(v string) *DeliveryStreamEncryptionConfiguration {
+	s.KeyARN = &v
+	return s
+}
+
+// SetKeyType sets the KeyType field's value.
+func (s *DeliveryStreamEncryptionConfiguration) SetKeyType(v string) *DeliveryStreamEncryptionConfiguration {
+	s.KeyType = &v
+	return s
+}
+
+// SetStatus sets the Status field's value.
+func (s *DeliveryStreamEncryptionConfiguration) SetStatus(v string) *DeliveryStreamEncryptionConfiguration {
+	s.Status = &v
+	return s
+}
+
+// Specifies the type and Amazon Resource Name (ARN) of the CMK to use for Server-Side
+// Encryption (SSE).
+type DeliveryStreamEncryptionConfigurationInput struct {
+	_ struct{} `type:"structure"`
+
+	// If you set KeyType to CUSTOMER_MANAGED_CMK, you must specify the Amazon Resource
+	// Name (ARN) of the CMK. If you set KeyType to Amazon Web Services_OWNED_CMK,
+	// Kinesis Data Firehose uses a service-account CMK.
+	KeyARN *string `min:"1" type:"string"`
+
+	// Indicates the type of customer master key (CMK) to use for encryption. The
+	// default setting is Amazon Web Services_OWNED_CMK. For more information about
+	// CMKs, see Customer Master Keys (CMKs) (https://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#master_keys).
+	// When you invoke CreateDeliveryStream or StartDeliveryStreamEncryption with
+	// KeyType set to CUSTOMER_MANAGED_CMK, Kinesis Data Firehose invokes the Amazon
+	// KMS operation CreateGrant (https://docs.aws.amazon.com/kms/latest/APIReference/API_CreateGrant.html)
+	// to create a grant that allows the Kinesis Data Firehose service to use the
+	// customer managed CMK to perform encryption and decryption. Kinesis Data Firehose
+	// manages that grant.
+	//
+	// When you invoke StartDeliveryStreamEncryption to change the CMK for a delivery
+	// stream that is encrypted with a customer managed CMK, Kinesis Data Firehose
+	// schedules the grant it had on the old CMK for retirement.
+	//
+	// You can use a CMK of type CUSTOMER_MANAGED_CMK to encrypt up to 500 delivery
+	// streams. If a CreateDeliveryStream or StartDeliveryStreamEncryption operation
+	// exceeds this limit, Kinesis Data Firehose throws a LimitExceededException.
+	//
+	// To encrypt your delivery stream, use symmetric CMKs. Kinesis Data Firehose
+	// doesn't support asymmetric CMKs. For information about symmetric and asymmetric
+	// CMKs, see About Symmetric and Asymmetric CMKs (https://docs.aws.amazon.com/kms/latest/developerguide/symm-asymm-concepts.html)
+	// in the Amazon Web Services Key Management Service developer guide.
+	//
+	// KeyType is a required field
+	KeyType *string `type:"string" required:"true" enum:"KeyType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeliveryStreamEncryptionConfigurationInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeliveryStreamEncryptionConfigurationInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeliveryStreamEncryptionConfigurationInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeliveryStreamEncryptionConfigurationInput"}
+	if s.KeyARN != nil && len(*s.KeyARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("KeyARN", 1))
+	}
+	if s.KeyType == nil {
+		invalidParams.Add(request.NewErrParamRequired("KeyType"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetKeyARN sets the KeyARN field's value.
+func (s *DeliveryStreamEncryptionConfigurationInput) SetKeyARN(v string) *DeliveryStreamEncryptionConfigurationInput {
+	s.KeyARN = &v
+	return s
+}
+
+// SetKeyType sets the KeyType field's value.
+func (s *DeliveryStreamEncryptionConfigurationInput) SetKeyType(v string) *DeliveryStreamEncryptionConfigurationInput {
+	s.KeyType = &v
+	return s
+}
+
+type DescribeDeliveryStreamInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the delivery stream.
+	//
+	// DeliveryStreamName is a required field
+	DeliveryStreamName *string `min:"1" type:"string" required:"true"`
+
+	// The ID of the destination to start returning the destination information.
+	// Kinesis Data Firehose supports one destination per delivery stream.
+	ExclusiveStartDestinationId *string `min:"1" type:"string"`
+
+	// The limit on the number of destinations to return. You can have one destination
+	// per delivery stream.
+	Limit *int64 `min:"1" type:"integer"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDeliveryStreamInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDeliveryStreamInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DescribeDeliveryStreamInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeDeliveryStreamInput"}
+	if s.DeliveryStreamName == nil {
+		invalidParams.Add(request.NewErrParamRequired("DeliveryStreamName"))
+	}
+	if s.DeliveryStreamName != nil && len(*s.DeliveryStreamName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("DeliveryStreamName", 1))
+	}
+	if s.ExclusiveStartDestinationId != nil && len(*s.ExclusiveStartDestinationId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ExclusiveStartDestinationId", 1))
+	}
+	if s.Limit != nil && *s.Limit < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDeliveryStreamName sets the DeliveryStreamName field's value.
+func (s *DescribeDeliveryStreamInput) SetDeliveryStreamName(v string) *DescribeDeliveryStreamInput {
+	s.DeliveryStreamName = &v
+	return s
+}
+
+// SetExclusiveStartDestinationId sets the ExclusiveStartDestinationId field's value.
+func (s *DescribeDeliveryStreamInput) SetExclusiveStartDestinationId(v string) *DescribeDeliveryStreamInput {
+	s.ExclusiveStartDestinationId = &v
+	return s
+}
+
+// SetLimit sets the Limit field's value.
+func (s *DescribeDeliveryStreamInput) SetLimit(v int64) *DescribeDeliveryStreamInput {
+	s.Limit = &v
+	return s
+}
+
+type DescribeDeliveryStreamOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Information about the delivery stream.
+	//
+	// DeliveryStreamDescription is a required field
+	DeliveryStreamDescription *DeliveryStreamDescription `type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDeliveryStreamOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeDeliveryStreamOutput) GoString() string {
+	return s.String()
+}
+
+// SetDeliveryStreamDescription sets the DeliveryStreamDescription field's value.
+func (s *DescribeDeliveryStreamOutput) SetDeliveryStreamDescription(v *DeliveryStreamDescription) *DescribeDeliveryStreamOutput {
+	s.DeliveryStreamDescription = v
+	return s
+}
+
+// The deserializer you want Kinesis Data Firehose to use for converting the
+// input data from JSON. Kinesis Data Firehose then serializes the data to its
+// final format using the Serializer. Kinesis Data Firehose supports two types
+// of deserializers: the Apache Hive JSON SerDe (https://cwiki.apache.org/confluence/display/Hive/LanguageManual+DDL#LanguageManualDDL-JSON)
+// and the OpenX JSON SerDe (https://github.com/rcongiu/Hive-JSON-Serde).
+type Deserializer struct {
+	_ struct{} `type:"structure"`
+
+	// The native Hive / HCatalog JsonSerDe. Used by Kinesis Data Firehose for deserializing
+	// data, which means converting it from the JSON format in preparation for serializing
+	// it to the Parquet or ORC format. This is one of two deserializers you can
+	// choose, depending on which one offers the functionality you need. The other
+	// option is the OpenX SerDe.
+	HiveJsonSerDe *HiveJsonSerDe `type:"structure"`
+
+	// The OpenX SerDe. Used by Kinesis Data Firehose for deserializing data, which
+	// means converting it from the JSON format in preparation for serializing it
+	// to the Parquet or ORC format. This is one of two deserializers you can choose,
+	// depending on which one offers the functionality you need. The other option
+	// is the native Hive / HCatalog JsonSerDe.
+	OpenXJsonSerDe *OpenXJsonSerDe `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Deserializer) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Deserializer) GoString() string {
+	return s.String()
+}
+
+// SetHiveJsonSerDe sets the HiveJsonSerDe field's value.
+func (s *Deserializer) SetHiveJsonSerDe(v *HiveJsonSerDe) *Deserializer {
+	s.HiveJsonSerDe = v
+	return s
+}
+
+// SetOpenXJsonSerDe sets the OpenXJsonSerDe field's value.
+func (s *Deserializer) SetOpenXJsonSerDe(v *OpenXJsonSerDe) *Deserializer {
+	s.OpenXJsonSerDe = v
+	return s
+}
+
+// Describes the destination for a delivery stream.
+type DestinationDescription struct {
+	_ struct{} `type:"structure"`
+
+	// The destination in the Serverless offering for Amazon OpenSearch Service.
+	AmazonOpenSearchServerlessDestinationDescription *AmazonOpenSearchServerlessDestinationDescription `type:"structure"`
+
+	// The destination in Amazon OpenSearch Service.
+	AmazonopensearchserviceDestinationDescription *AmazonopensearchserviceDestinationDescription `type:"structure"`
+
+	// The ID of the destination.
+	//
+	// DestinationId is a required field
+	DestinationId *string `min:"1" type:"string" required:"true"`
+
+	// The destination in Amazon ES.
+	ElasticsearchDestinationDescription *ElasticsearchDestinationDescription `type:"structure"`
+
+	// The destination in Amazon S3.
+	ExtendedS3DestinationDescription *ExtendedS3DestinationDescription `type:"structure"`
+
+	// Describes the specified HTTP endpoint destination.
+	HttpEndpointDestinationDescription *HttpEndpointDestinationDescription `type:"structure"`
+
+	// The destination in Amazon Redshift.
+	RedshiftDestinationDescription *RedshiftDestinationDescription `type:"structure"`
+
+	// [Deprecated] The destination in Amazon S3.
+	S3DestinationDescription *S3DestinationDescription `type:"structure"`
+
+	// The destination in Splunk.
+	SplunkDestinationDescription *SplunkDestinationDescription `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DestinationDescription) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DestinationDescription) GoString() string {
+	return s.String()
+}
+
+// SetAmazonOpenSearchServerlessDestinationDescription sets the AmazonOpenSearchServerlessDestinationDescription field's value.
+func (s *DestinationDescription) SetAmazonOpenSearchServerlessDestinationDescription(v *AmazonOpenSearchServerlessDestinationDescription) *DestinationDescription {
+	s.AmazonOpenSearchServerlessDestinationDescription = v
+	return s
+}
+
+// SetAmazonopensearchserviceDestinationDescription sets the AmazonopensearchserviceDestinationDescription field's value.
+func (s *DestinationDescription) SetAmazonopensearchserviceDestinationDescription(v *AmazonopensearchserviceDestinationDescription) *DestinationDescription {
+	s.AmazonopensearchserviceDestinationDescription = v
+	return s
+}
+
+// SetDestinationId sets the DestinationId field's value.
+func (s *DestinationDescription) SetDestinationId(v string) *DestinationDescription {
+	s.DestinationId = &v
+	return s
+}
+
+// SetElasticsearchDestinationDescription sets the ElasticsearchDestinationDescription field's value.
+func (s *DestinationDescription) SetElasticsearchDestinationDescription(v *ElasticsearchDestinationDescription) *DestinationDescription {
+	s.ElasticsearchDestinationDescription = v
+	return s
+}
+
+// SetExtendedS3DestinationDescription sets the ExtendedS3DestinationDescription field's value.
+func (s *DestinationDescription) SetExtendedS3DestinationDescription(v *ExtendedS3DestinationDescription) *DestinationDescription {
+	s.ExtendedS3DestinationDescription = v
+	return s
+}
+
+// SetHttpEndpointDestinationDescription sets the HttpEndpointDestinationDescription field's value.
+func (s *DestinationDescription) SetHttpEndpointDestinationDescription(v *HttpEndpointDestinationDescription) *DestinationDescription {
+	s.HttpEndpointDestinationDescription = v
+	return s
+}
+
+// SetRedshiftDestinationDescription sets the RedshiftDestinationDescription field's value.
+func (s *DestinationDescription) SetRedshiftDestinationDescription(v *RedshiftDestinationDescription) *DestinationDescription {
+	s.RedshiftDestinationDescription = v
+	return s
+}
+
+// SetS3DestinationDescription sets the S3DestinationDescription field's value.
+func (s *DestinationDescription) SetS3DestinationDescription(v *S3DestinationDescription) *DestinationDescription {
+	s.S3DestinationDescription = v
+	return s
+}
+
+// SetSplunkDestinationDescription sets the SplunkDestinationDescription field's value.
+func (s *DestinationDescription) SetSplunkDestinationDescription(v *SplunkDestinationDescription) *DestinationDescription {
+	s.SplunkDestinationDescription = v
+	return s
+}
+
+// Indicates the method for setting up document ID. The supported methods are
+// Kinesis Data Firehose generated document ID and OpenSearch Service generated
+// document ID.
+type DocumentIdOptions struct {
+	_ struct{} `type:"structure"`
+
+	// When the FIREHOSE_DEFAULT option is chosen, Kinesis Data Firehose generates
+	// a unique document ID for each record based on a unique internal identifier.
+	// The generated document ID is stable across multiple delivery attempts, which
+	// helps prevent the same record from being indexed multiple times with different
+	// document IDs.
+	//
+	// When the NO_DOCUMENT_ID option is chosen, Kinesis Data Firehose does not
+	// include any document IDs in the requests it sends to the Amazon OpenSearch
+	// Service. This causes the Amazon OpenSearch Service domain to generate document
+	// IDs. In case of multiple delivery attempts, this may cause the same record
+	// to be indexed more than once with different document IDs. This option enables
+	// write-heavy operations, such as the ingestion of logs and observability data,
+	// to consume less resources in the Amazon OpenSearch Service domain, resulting
+	// in improved performance.
+	//
+	// DefaultDocumentIdFormat is a required field
+	DefaultDocumentIdFormat *string `type:"string" required:"true" enum:"DefaultDocumentIdFormat"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DocumentIdOptions) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DocumentIdOptions) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DocumentIdOptions) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DocumentIdOptions"}
+	if s.DefaultDocumentIdFormat == nil {
+		invalidParams.Add(request.NewErrParamRequired("DefaultDocumentIdFormat"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDefaultDocumentIdFormat sets the DefaultDocumentIdFormat field's value.
+func (s *DocumentIdOptions) SetDefaultDocumentIdFormat(v string) *DocumentIdOptions {
+	s.DefaultDocumentIdFormat = &v
+	return s
+}
+
+// The configuration of the dynamic partitioning mechanism that creates smaller
+// data sets from the streaming data by partitioning it based on partition keys.
+// Currently, dynamic partitioning is only supported for Amazon S3 destinations.
+type DynamicPartitioningConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies that the dynamic partitioning is enabled for this Kinesis Data
+	// Firehose delivery stream.
+	Enabled *bool `type:"boolean"`
+
+	// The retry behavior in case Kinesis Data Firehose is unable to deliver data
+	// to an Amazon S3 prefix.
+	RetryOptions *RetryOptions `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DynamicPartitioningConfiguration) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DynamicPartitioningConfiguration) GoString() string {
+	return s.String()
+}
+
+// SetEnabled sets the Enabled field's value.
+func (s *DynamicPartitioningConfiguration) SetEnabled(v bool) *DynamicPartitioningConfiguration {
+	s.Enabled = &v
+	return s
+}
+
+// SetRetryOptions sets the RetryOptions field's value.
+func (s *DynamicPartitioningConfiguration) SetRetryOptions(v *RetryOptions) *DynamicPartitioningConfiguration {
+	s.RetryOptions = v
+	return s
+}
+
+// Describes the buffering to perform before delivering data to the Amazon ES
+// destination.
+type ElasticsearchBufferingHints struct {
+	_ struct{} `type:"structure"`
+
+	// Buffer incoming data for the specified period of time, in seconds, before
+	// delivering it to the destination. The default value is 300 (5 minutes).
+	IntervalInSeconds *int64 `min:"60" type:"integer"`
+
+	// Buffer incoming data to the specified size, in MBs, before delivering it
+	// to the destination. The default value is 5.
+	//
+	// We recommend setting this parameter to a value greater than the amount of
+	// data you typically ingest into the delivery stream in 10 seconds. For example,
+	// if you typically ingest data at 1 MB/sec, the value should be 10 MB or higher.
+	SizeInMBs *int64 `min:"1" type:"integer"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ElasticsearchBufferingHints) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ElasticsearchBufferingHints) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ElasticsearchBufferingHints) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ElasticsearchBufferingHints"}
+	if s.IntervalInSeconds != nil && *s.IntervalInSeconds < 60 {
+		invalidParams.Add(request.NewErrParamMinValue("IntervalInSeconds", 60))
+	}
+	if s.SizeInMBs != nil && *s.SizeInMBs < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("SizeInMBs", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetIntervalInSeconds sets the IntervalInSeconds field's value.
+func (s *ElasticsearchBufferingHints) SetIntervalInSeconds(v int64) *ElasticsearchBufferingHints {
+	s.IntervalInSeconds = &v
+	return s
+}
+
+// SetSizeInMBs sets the SizeInMBs field's value.
+func (s *ElasticsearchBufferingHints) SetSizeInMBs(v int64) *ElasticsearchBufferingHints {
+	s.SizeInMBs = &v
+	return s
+}
+
+// Describes the configuration of a destination in Amazon ES.
+type ElasticsearchDestinationConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	// The buffering options. If no value is specified, the default values for ElasticsearchBufferingHints
+	// are used.
+	BufferingHints *ElasticsearchBufferingHints `type:"structure"`
+
+	// The Amazon CloudWatch logging options for your delivery stream.
+	CloudWatchLoggingOptions *CloudWatchLoggingOptions `type:"structure"`
+
+	// The endpoint to use when communicating with the cluster. Specify either this
+	// ClusterEndpoint or the DomainARN field.
+	ClusterEndpoint *string `min:"1" type:"string"`
+
+	// Indicates the method for setting up document ID. The supported methods are
+	// Kinesis Data Firehose generated document ID and OpenSearch Service generated
+	// document ID.
+	DocumentIdOptions *DocumentIdOptions `type:"structure"`
+
+	// The ARN of the Amazon ES domain. The IAM role must have permissions for DescribeDomain,
+	// DescribeDomains, and DescribeDomainConfig after assuming the role specified
+	// in RoleARN. For more information, see Amazon Resource Names (ARNs) and Amazon
+	// Web Services Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	//
+	// Specify either ClusterEndpoint or DomainARN.
+	DomainARN *string `min:"1" type:"string"`
+
+	// The Elasticsearch index name.
+	//
+	// IndexName is a required field
+	IndexName *string `min:"1" type:"string" required:"true"`
+
+	// The Elasticsearch index rotation period. Index rotation appends a timestamp
+	// to the IndexName to facilitate the expiration of old data. For more information,
+	// see Index Rotation for the Amazon ES Destination (https://docs.aws.amazon.com/firehose/latest/dev/basic-deliver.html#es-index-rotation).
+	// The default value is OneDay.
+	IndexRotationPeriod *string `type:"string" enum:"ElasticsearchIndexRotationPeriod"`
+
+	// The data processing configuration.
+	ProcessingConfiguration *ProcessingConfiguration `type:"structure"`
+
+	// The retry behavior in case Kinesis Data Firehose is unable to deliver documents
+	// to Amazon ES. The default value is 300 (5 minutes).
+	RetryOptions *ElasticsearchRetryOptions `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the IAM role to be assumed by Kinesis Data
+	// Firehose for calling the Amazon ES Configuration API and for indexing documents.
+	// For more information, see Grant Kinesis Data Firehose Access to an Amazon
+	// S3 Destination (https://docs.aws.amazon.com/firehose/latest/dev/controlling-access.html#using-iam-s3)
+	// and Amazon Resource Names (ARNs) and Amazon Web Services Service Namespaces
+	// (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	//
+	// RoleARN is a required field
+	RoleARN *string `min:"1" type:"string" required:"true"`
+
+	// Defines how documents should be delivered to Amazon S3. When it is set to
+	// FailedDocumentsOnly, Kinesis Data Firehose writes any documents that could
+	// not be indexed to the configured Amazon S3 destination, with AmazonOpenSearchService-failed/
+	// appended to the key prefix. When set to AllDocuments, Kinesis Data Firehose
+	// delivers all incoming records to Amazon S3, and also writes failed documents
+	// with AmazonOpenSearchService-failed/ appended to the prefix. For more information,
+	// see Amazon S3 Backup for the Amazon ES Destination (https://docs.aws.amazon.com/firehose/latest/dev/basic-deliver.html#es-s3-backup).
+	// Default value is FailedDocumentsOnly.
+	//
+	// You can't change this backup mode after you create the delivery stream.
+	S3BackupMode *string `type:"string" enum:"ElasticsearchS3BackupMode"`
+
+	// The configuration for the backup Amazon S3 location.
+	//
+	// S3Configuration is a required field
+	S3Configuration *S3DestinationConfiguration `type:"structure" required:"true"`
+
+	// The Elasticsearch type name. For Elasticsearch 6.x, there can be only one
+	// type per index. If you try to specify a new type for an existing index that
+	// already has another type, Kinesis Data Firehose returns an error during run
+	// time.
+	//
+	// For Elasticsearch 7.x, don't specify a TypeName.
+	TypeName *string `type:"string"`
+
+	// The details of the VPC of the Amazon destination.
+	VpcConfiguration *VpcConfiguration `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ElasticsearchDestinationConfiguration) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ElasticsearchDestinationConfiguration) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ElasticsearchDestinationConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ElasticsearchDestinationConfiguration"}
+	if s.ClusterEndpoint != nil && len(*s.ClusterEndpoint) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ClusterEndpoint", 1))
+	}
+	if s.DomainARN != nil && len(*s.DomainARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("DomainARN", 1))
+	}
+	if s.IndexName == nil {
+		invalidParams.Add(request.NewErrParamRequired("IndexName"))
+	}
+	if s.IndexName != nil && len(*s.IndexName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("IndexName", 1))
+	}
+	if s.RoleARN == nil {
+		invalidParams.Add(request.NewErrParamRequired("RoleARN"))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RoleARN", 1))
+	}
+	if s.S3Configuration == nil {
+		invalidParams.Add(request.NewErrParamRequired("S3Configuration"))
+	}
+	if s.BufferingHints != nil {
+		if err := s.BufferingHints.Validate(); err != nil {
+			invalidParams.AddNested("BufferingHints", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.DocumentIdOptions != nil {
+		if err := s.DocumentIdOptions.Validate(); err != nil {
+			invalidParams.AddNested("DocumentIdOptions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ProcessingConfiguration != nil {
+		if err := s.ProcessingConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("ProcessingConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3Configuration != nil {
+		if err := s.S3Configuration.Validate(); err != nil {
+			invalidParams.AddNested("S3Configuration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.VpcConfiguration != nil {
+		if err := s.VpcConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("VpcConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetBufferingHints sets the BufferingHints field's value.
+func (s *ElasticsearchDestinationConfiguration) SetBufferingHints(v *ElasticsearchBufferingHints) *ElasticsearchDestinationConfiguration {
+	s.BufferingHints = v
+	return s
+}
+
+// SetCloudWatchLoggingOptions sets the CloudWatchLoggingOptions field's value.
+func (s *ElasticsearchDestinationConfiguration) SetCloudWatchLoggingOptions(v *CloudWatchLoggingOptions) *ElasticsearchDestinationConfiguration {
+	s.CloudWatchLoggingOptions = v
+	return s
+}
+
+// SetClusterEndpoint sets the ClusterEndpoint field's value.
+func (s *ElasticsearchDestinationConfiguration) SetClusterEndpoint(v string) *ElasticsearchDestinationConfiguration {
+	s.ClusterEndpoint = &v
+	return s
+}
+
+// SetDocumentIdOptions sets the DocumentIdOptions field's value.
+func (s *ElasticsearchDestinationConfiguration) SetDocumentIdOptions(v *DocumentIdOptions) *ElasticsearchDestinationConfiguration {
+	s.DocumentIdOptions = v
+	return s
+}
+
+// SetDomainARN sets the DomainARN field's value.
+func (s *ElasticsearchDestinationConfiguration) SetDomainARN(v string) *ElasticsearchDestinationConfiguration {
+	s.DomainARN = &v
+	return s
+}
+
+// SetIndexName sets the IndexName field's value.
+func (s *ElasticsearchDestinationConfiguration) SetIndexName(v string) *ElasticsearchDestinationConfiguration {
+	s.IndexName = &v
+	return s
+}
+
+// SetIndexRotationPeriod sets the IndexRotationPeriod field's value.
+func (s *ElasticsearchDestinationConfiguration) SetIndexRotationPeriod(v string) *ElasticsearchDestinationConfiguration {
+	s.IndexRotationPeriod = &v
+	return s
+}
+
+// SetProcessingConfiguration sets the ProcessingConfiguration field's value.
+func (s *ElasticsearchDestinationConfiguration) SetProcessingConfiguration(v *ProcessingConfiguration) *ElasticsearchDestinationConfiguration {
+	s.ProcessingConfiguration = v
+	return s
+}
+
+// SetRetryOptions sets the RetryOptions field's value.
+func (s *ElasticsearchDestinationConfiguration) SetRetryOptions(v *ElasticsearchRetryOptions) *ElasticsearchDestinationConfiguration {
+	s.RetryOptions = v
+	return s
+}
+
+// SetRoleARN sets the RoleARN field's value.
+func (s *ElasticsearchDestinationConfiguration) SetRoleARN(v string) *ElasticsearchDestinationConfiguration {
+	s.RoleARN = &v
+	return s
+}
+
+// SetS3BackupMode sets the S3BackupMode field's value.
+func (s *ElasticsearchDestinationConfiguration) SetS3BackupMode(v string) *ElasticsearchDestinationConfiguration {
+	s.S3BackupMode = &v
+	return s
+}
+
+// SetS3Configuration sets the S3Configuration field's value.
+func (s *ElasticsearchDestinationConfiguration) SetS3Configuration(v *S3DestinationConfiguration) *ElasticsearchDestinationConfiguration {
+	s.S3Configuration = v
+	return s
+}
+
+// SetTypeName sets the TypeName field's value.
+func (s *ElasticsearchDestinationConfiguration) SetTypeName(v string) *ElasticsearchDestinationConfiguration {
+	s.TypeName = &v
+	return s
+}
+
+// SetVpcConfiguration sets the VpcConfiguration field's value.
+func (s *ElasticsearchDestinationConfiguration) SetVpcConfiguration(v *VpcConfiguration) *ElasticsearchDestinationConfiguration {
+	s.VpcConfiguration = v
+	return s
+}
+
+// The destination description in Amazon ES.
+type ElasticsearchDestinationDescription struct {
+	_ struct{} `type:"structure"`
+
+	// The buffering options.
+	BufferingHints *ElasticsearchBufferingHints `type:"structure"`
+
+	// The Amazon CloudWatch logging options.
+	CloudWatchLoggingOptions *CloudWatchLoggingOptions `type:"structure"`
+
+	// The endpoint to use when communicating with the cluster. Kinesis Data Firehose
+	// uses either this ClusterEndpoint or the DomainARN field to send data to Amazon
+	// ES.
+	ClusterEndpoint *string `min:"1" type:"string"`
+
+	// Indicates the method for setting up document ID. The supported methods are
+	// Kinesis Data Firehose generated document ID and OpenSearch Service generated
+	// document ID.
+	DocumentIdOptions *DocumentIdOptions `type:"structure"`
+
+	// The ARN of the Amazon ES domain. For more information, see Amazon Resource
+	// Names (ARNs) and Amazon Web Services Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	//
+	// Kinesis Data Firehose uses either ClusterEndpoint or DomainARN to send data
+	// to Amazon ES.
+	DomainARN *string `min:"1" type:"string"`
+
+	// The Elasticsearch index name.
+	IndexName *string `min:"1" type:"string"`
+
+	// The Elasticsearch index rotation period
+	IndexRotationPeriod *string `type:"string" enum:"ElasticsearchIndexRotationPeriod"`
+
+	// The data processing configuration.
+	ProcessingConfiguration *ProcessingConfiguration `type:"structure"`
+
+	// The Amazon ES retry options.
+	RetryOptions *ElasticsearchRetryOptions `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the Amazon Web Services credentials. For
+	// more information, see Amazon Resource Names (ARNs) and Amazon Web Services
+	// Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	RoleARN *string `min:"1" type:"string"`
+
+	// The Amazon S3 backup mode.
+	S3BackupMode *string `type:"string" enum:"ElasticsearchS3BackupMode"`
+
+	// The Amazon S3 destination.
+	S3DestinationDescription *S3DestinationDescription `type:"structure"`
+
+	// The Elasticsearch type name. This applies to Elasticsearch 6.x and lower
+	// versions. For Elasticsearch 7.x and OpenSearch Service 1.x, there's no value
+	// for TypeName.
+	TypeName *string `type:"string"`
+
+	// The details of the VPC of the Amazon OpenSearch or the Amazon OpenSearch
+	// Serverless destination.
+	VpcConfigurationDescription *VpcConfigurationDescription `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ElasticsearchDestinationDescription) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ElasticsearchDestinationDescription) GoString() string {
+	return s.String()
+}
+
+// SetBufferingHints sets the BufferingHints field's value.
+func (s *ElasticsearchDestinationDescription) SetBufferingHints(v *ElasticsearchBufferingHints) *ElasticsearchDestinationDescription {
+	s.BufferingHints = v
+	return s
+}
+
+// SetCloudWatchLoggingOptions sets the CloudWatchLoggingOptions field's value.
+func (s *ElasticsearchDestinationDescription) SetCloudWatchLoggingOptions(v *CloudWatchLoggingOptions) *ElasticsearchDestinationDescription {
+	s.CloudWatchLoggingOptions = v
+	return s
+}
+
+// SetClusterEndpoint sets the ClusterEndpoint field's value.
+func (s *ElasticsearchDestinationDescription) SetClusterEndpoint(v string) *ElasticsearchDestinationDescription {
+	s.ClusterEndpoint = &v
+	return s
+}
+
+// SetDocumentIdOptions sets the DocumentIdOptions field's value.
+func (s *ElasticsearchDestinationDescription) SetDocumentIdOptions(v *DocumentIdOptions) *ElasticsearchDestinationDescription {
+	s.DocumentIdOptions = v
+	return s
+}
+
+// SetDomainARN sets the DomainARN field's value.
+func (s *ElasticsearchDestinationDescription) SetDomainARN(v string) *ElasticsearchDestinationDescription {
+	s.DomainARN = &v
+	return s
+}
+
+// SetIndexName sets the IndexName field's value.
+func (s *ElasticsearchDestinationDescription) SetIndexName(v string) *ElasticsearchDestinationDescription {
+	s.IndexName = &v
+	return s
+}
+
+// SetIndexRotationPeriod sets the IndexRotationPeriod field's value.
+func (s *ElasticsearchDestinationDescription) SetIndexRotationPeriod(v string) *ElasticsearchDestinationDescription {
+	s.IndexRotationPeriod = &v
+	return s
+}
+
+// SetProcessingConfiguration sets the ProcessingConfiguration field's value.
+func (s *ElasticsearchDestinationDescription) SetProcessingConfiguration(v *ProcessingConfiguration) *ElasticsearchDestinationDescription {
+	s.ProcessingConfiguration = v
+	return s
+}
+
+// SetRetryOptions sets the RetryOptions field's value.
+func (s *ElasticsearchDestinationDescription) SetRetryOptions(v *ElasticsearchRetryOptions) *ElasticsearchDestinationDescription {
+	s.RetryOptions = v
+	return s
+}
+
+// SetRoleARN sets the RoleARN field's value.
+func (s *ElasticsearchDestinationDescription) SetRoleARN(v string) *ElasticsearchDestinationDescription {
+	s.RoleARN = &v
+	return s
+}
+
+// SetS3BackupMode sets the S3BackupMode field's value.
+func (s *ElasticsearchDestinationDescription) SetS3BackupMode(v string) *ElasticsearchDestinationDescription {
+	s.S3BackupMode = &v
+	return s
+}
+
+// SetS3DestinationDescription sets the S3DestinationDescription field's value.
+func (s *ElasticsearchDestinationDescription) SetS3DestinationDescription(v *S3DestinationDescription) *ElasticsearchDestinationDescription {
+	s.S3DestinationDescription = v
+	return s
+}
+
+// SetTypeName sets the TypeName field's value.
+func (s *ElasticsearchDestinationDescription) SetTypeName(v string) *ElasticsearchDestinationDescription {
+	s.TypeName = &v
+	return s
+}
+
+// SetVpcConfigurationDescription sets the VpcConfigurationDescription field's value.
+func (s *ElasticsearchDestinationDescription) SetVpcConfigurationDescription(v *VpcConfigurationDescription) *ElasticsearchDestinationDescription {
+	s.VpcConfigurationDescription = v
+	return s
+}
+
+// Describes an update for a destination in Amazon ES.
+type ElasticsearchDestinationUpdate struct {
+	_ struct{} `type:"structure"`
+
+	// The buffering options. If no value is specified, ElasticsearchBufferingHints
+	// object default values are used.
+	BufferingHints *ElasticsearchBufferingHints `type:"structure"`
+
+	// The CloudWatch logging options for your delivery stream.
+	CloudWatchLoggingOptions *CloudWatchLoggingOptions `type:"structure"`
+
+	// The endpoint to use when communicating with the cluster. Specify either this
+	// ClusterEndpoint or the DomainARN field.
+	ClusterEndpoint *string `min:"1" type:"string"`
+
+	// Indicates the method for setting up document ID. The supported methods are
+	// Kinesis Data Firehose generated document ID and OpenSearch Service generated
+	// document ID.
+	DocumentIdOptions *DocumentIdOptions `type:"structure"`
+
+	// The ARN of the Amazon ES domain. The IAM role must have permissions for DescribeDomain,
+	// DescribeDomains, and DescribeDomainConfig after assuming the IAM role specified
+	// in RoleARN. For more information, see Amazon Resource Names (ARNs) and Amazon
+	// Web Services Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	//
+	// Specify either ClusterEndpoint or DomainARN.
+	DomainARN *string `min:"1" type:"string"`
+
+	// The Elasticsearch index name.
+	IndexName *string `min:"1" type:"string"`
+
+	// The Elasticsearch index rotation period. Index rotation appends a timestamp
+	// to IndexName to facilitate the expiration of old data. For more information,
+	// see Index Rotation for the Amazon ES Destination (https://docs.aws.amazon.com/firehose/latest/dev/basic-deliver.html#es-index-rotation).
+	// Default value is OneDay.
+	IndexRotationPeriod *string `type:"string" enum:"ElasticsearchIndexRotationPeriod"`
+
+	// The data processing configuration.
+	ProcessingConfiguration *ProcessingConfiguration `type:"structure"`
+
+	// The retry behavior in case Kinesis Data Firehose is unable to deliver documents
+	// to Amazon ES. The default value is 300 (5 minutes).
+	RetryOptions *ElasticsearchRetryOptions `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the IAM role to be assumed by Kinesis Data
+	// Firehose for calling the Amazon ES Configuration API and for indexing documents.
+	// For more information, see Grant Kinesis Data Firehose Access to an Amazon
+	// S3 Destination (https://docs.aws.amazon.com/firehose/latest/dev/controlling-access.html#using-iam-s3)
+	// and Amazon Resource Names (ARNs) and Amazon Web Services Service Namespaces
+	// (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	RoleARN *string `min:"1" type:"string"`
+
+	// The Amazon S3 destination.
+	S3Update *S3DestinationUpdate `type:"structure"`
+
+	// The Elasticsearch type name. For Elasticsearch 6.x, there can be only one
+	// type per index. If you try to specify a new type for an existing index that
+	// already has another type, Kinesis Data Firehose returns an error during runtime.
+	//
+	// If you upgrade Elasticsearch from 6.x to 7.x and don’t update your delivery
+	// stream, Kinesis Data Firehose still delivers data to Elasticsearch with the
+	// old index name and type name. If you want to update your delivery stream
+	// with a new index name, provide an empty string for TypeName.
+	TypeName *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ElasticsearchDestinationUpdate) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ElasticsearchDestinationUpdate) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ElasticsearchDestinationUpdate) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ElasticsearchDestinationUpdate"}
+	if s.ClusterEndpoint != nil && len(*s.ClusterEndpoint) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ClusterEndpoint", 1))
+	}
+	if s.DomainARN != nil && len(*s.DomainARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("DomainARN", 1))
+	}
+	if s.IndexName != nil && len(*s.IndexName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("IndexName", 1))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RoleARN", 1))
+	}
+	if s.BufferingHints != nil {
+		if err := s.BufferingHints.Validate(); err != nil {
+			invalidParams.AddNested("BufferingHints", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.DocumentIdOptions != nil {
+		if err := s.DocumentIdOptions.Validate(); err != nil {
+			invalidParams.AddNested("DocumentIdOptions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ProcessingConfiguration != nil {
+		if err := s.ProcessingConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("ProcessingConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3Update != nil {
+		if err := s.S3Update.Validate(); err != nil {
+			invalidParams.AddNested("S3Update", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetBufferingHints sets the BufferingHints field's value.
+func (s *ElasticsearchDestinationUpdate) SetBufferingHints(v *ElasticsearchBufferingHints) *ElasticsearchDestinationUpdate {
+	s.BufferingHints = v
+	return s
+}
+
+// SetCloudWatchLoggingOptions sets the CloudWatchLoggingOptions field's value.
+func (s *ElasticsearchDestinationUpdate) SetCloudWatchLoggingOptions(v *CloudWatchLoggingOptions) *ElasticsearchDestinationUpdate {
+	s.CloudWatchLoggingOptions = v
+	return s
+}
+
+// SetClusterEndpoint sets the ClusterEndpoint field's value.
+func (s *ElasticsearchDestinationUpdate) SetClusterEndpoint(v string) *ElasticsearchDestinationUpdate {
+	s.ClusterEndpoint = &v
+	return s
+}
+
+// SetDocumentIdOptions sets the DocumentIdOptions field's value.
+func (s *ElasticsearchDestinationUpdate) SetDocumentIdOptions(v *DocumentIdOptions) *ElasticsearchDestinationUpdate {
+	s.DocumentIdOptions = v
 	return s
 }
 
-// SetDeliveryStreamARN sets the DeliveryStreamARN field's value.
-func (s *DeliveryStreamDescription) SetDeliveryStreamARN(v string) *DeliveryStreamDescription {
-	s.DeliveryStreamARN = &v
+// SetDomainARN sets the DomainARN field's value.
+func (s *ElasticsearchDestinationUpdate) SetDomainARN(v string) *ElasticsearchDestinationUpdate {
+	s.DomainARN = &v
 	return s
 }
 
-// SetDeliveryStreamEncryptionConfiguration sets the DeliveryStreamEncryptionConfiguration field's value.
-func (s *DeliveryStreamDescription) SetDeliveryStreamEncryptionConfiguration(v *DeliveryStreamEncryptionConfiguration) *DeliveryStreamDescription {
-	s.DeliveryStreamEncryptionConfiguration = v
+// SetIndexName sets the IndexName field's value.
+func (s *ElasticsearchDestinationUpdate) SetIndexName(v string) *ElasticsearchDestinationUpdate {
+	s.IndexName = &v
 	return s
 }
 
-// SetDeliveryStreamName sets the DeliveryStreamName field's value.
-func (s *DeliveryStreamDescription) SetDeliveryStreamName(v string) *DeliveryStreamDescription {
-	s.DeliveryStreamName = &v
+// SetIndexRotationPeriod sets the IndexRotationPeriod field's value.
+func (s *ElasticsearchDestinationUpdate) SetIndexRotationPeriod(v string) *ElasticsearchDestinationUpdate {
+	s.IndexRotationPeriod = &v
 	return s
 }
 
-// SetDeliveryStreamStatus sets the DeliveryStreamStatus field's value.
-func (s *DeliveryStreamDescription) SetDeliveryStreamStatus(v string) *DeliveryStreamDescription {
-	s.DeliveryStreamStatus = &v
+// SetProcessingConfiguration sets the ProcessingConfiguration field's value.
+func (s *ElasticsearchDestinationUpdate) SetProcessingConfiguration(v *ProcessingConfiguration) *ElasticsearchDestinationUpdate {
+	s.ProcessingConfiguration = v
 	return s
 }
 
-// SetDeliveryStreamType sets the DeliveryStreamType field's value.
-func (s *DeliveryStreamDescription) SetDeliveryStreamType(v string) *DeliveryStreamDescription {
-	s.DeliveryStreamType = &v
+// SetRetryOptions sets the RetryOptions field's value.
+func (s *ElasticsearchDestinationUpdate) SetRetryOptions(v *ElasticsearchRetryOptions) *ElasticsearchDestinationUpdate {
+	s.RetryOptions = v
 	return s
 }
 
-// SetDestinations sets the Destinations field's value.
-func (s *DeliveryStreamDescription) SetDestinations(v []*DestinationDescription) *DeliveryStreamDescription {
-	s.Destinations = v
+// SetRoleARN sets the RoleARN field's value.
+func (s *ElasticsearchDestinationUpdate) SetRoleARN(v string) *ElasticsearchDestinationUpdate {
+	s.RoleARN = &v
 	return s
 }
 
-// SetHasMoreDestinations sets the HasMoreDestinations field's value.
-func (s *DeliveryStreamDescription) SetHasMoreDestinations(v bool) *DeliveryStreamDescription {
-	s.HasMoreDestinations = &v
+// SetS3Update sets the S3Update field's value.
+func (s *ElasticsearchDestinationUpdate) SetS3Update(v *S3DestinationUpdate) *ElasticsearchDestinationUpdate {
+	s.S3Update = v
 	return s
 }
 
-// SetLastUpdateTimestamp sets the LastUpdateTimestamp field's value.
-func (s *DeliveryStreamDescription) SetLastUpdateTimestamp(v time.Time) *DeliveryStreamDescription {
-	s.LastUpdateTimestamp = &v
+// SetTypeName sets the TypeName field's value.
+func (s *ElasticsearchDestinationUpdate) SetTypeName(v string) *ElasticsearchDestinationUpdate {
+	s.TypeName = &v
 	return s
 }
 
-// SetSource sets the Source field's value.
-func (s *DeliveryStreamDescription) SetSource(v *SourceDescription) *DeliveryStreamDescription {
-	s.Source = v
-	return s
+// Configures retry behavior in case Kinesis Data Firehose is unable to deliver
+// documents to Amazon ES.
+type ElasticsearchRetryOptions struct {
+	_ struct{} `type:"structure"`
+
+	// After an initial failure to deliver to Amazon ES, the total amount of time
+	// during which Kinesis Data Firehose retries delivery (including the first
+	// attempt). After this time has elapsed, the failed documents are written to
+	// Amazon S3. Default value is 300 seconds (5 minutes). A value of 0 (zero)
+	// results in no retries.
+	DurationInSeconds *int64 `type:"integer"`
 }
 
-// SetVersionId sets the VersionId field's value.
-func (s *DeliveryStreamDescription) SetVersionId(v string) *DeliveryStreamDescription {
-	s.VersionId = &v
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ElasticsearchRetryOptions) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ElasticsearchRetryOptions) GoString() string {
+	return s.String()
+}
+
+// SetDurationInSeconds sets the DurationInSeconds field's value.
+func (s *ElasticsearchRetryOptions) SetDurationInSeconds(v int64) *ElasticsearchRetryOptions {
+	s.DurationInSeconds = &v
 	return s
 }
 
-// Indicates the server-side encryption (SSE) status for the delivery stream.
-type DeliveryStreamEncryptionConfiguration struct {
+// Describes the encryption for a destination in Amazon S3.
+type EncryptionConfiguration struct {
 	_ struct{} `type:"structure"`
 
-	// For a full description of the different values of this status, see StartDeliveryStreamEncryption
-	// and StopDeliveryStreamEncryption.
-	Status *string `type:"string" enum:"DeliveryStreamEncryptionStatus"`
+	// The encryption key.
+	KMSEncryptionConfig *KMSEncryptionConfig `type:"structure"`
+
+	// Specifically override existing encryption information to ensure that no encryption
+	// is used.
+	NoEncryptionConfig *string `type:"string" enum:"NoEncryptionConfig"`
 }
 
-// String returns the string representation
-func (s DeliveryStreamEncryptionConfiguration) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EncryptionConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeliveryStreamEncryptionConfiguration) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EncryptionConfiguration) GoString() string {
 	return s.String()
 }
 
-// SetStatus sets the Status field's value.
-func (s *DeliveryStreamEncryptionConfiguration) SetStatus(v string) *DeliveryStreamEncryptionConfiguration {
-	s.Status = &v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *EncryptionConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "EncryptionConfiguration"}
+	if s.KMSEncryptionConfig != nil {
+		if err := s.KMSEncryptionConfig.Validate(); err != nil {
+			invalidParams.AddNested("KMSEncryptionConfig", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetKMSEncryptionConfig sets the KMSEncryptionConfig field's value.
+func (s *EncryptionConfiguration) SetKMSEncryptionConfig(v *KMSEncryptionConfig) *EncryptionConfiguration {
+	s.KMSEncryptionConfig = v
 	return s
 }
 
-type DescribeDeliveryStreamInput struct {
+// SetNoEncryptionConfig sets the NoEncryptionConfig field's value.
+func (s *EncryptionConfiguration) SetNoEncryptionConfig(v string) *EncryptionConfiguration {
+	s.NoEncryptionConfig = &v
+	return s
+}
+
+// Describes the configuration of a destination in Amazon S3.
+type ExtendedS3DestinationConfiguration struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the delivery stream.
+	// The ARN of the S3 bucket. For more information, see Amazon Resource Names
+	// (ARNs) and Amazon Web Services Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
 	//
-	// DeliveryStreamName is a required field
-	DeliveryStreamName *string `min:"1" type:"string" required:"true"`
+	// BucketARN is a required field
+	BucketARN *string `min:"1" type:"string" required:"true"`
 
-	// The ID of the destination to start returning the destination information.
-	// Kinesis Data Firehose supports one destination per delivery stream.
-	ExclusiveStartDestinationId *string `min:"1" type:"string"`
+	// The buffering option.
+	BufferingHints *BufferingHints `type:"structure"`
 
-	// The limit on the number of destinations to return. You can have one destination
-	// per delivery stream.
-	Limit *int64 `min:"1" type:"integer"`
+	// The Amazon CloudWatch logging options for your delivery stream.
+	CloudWatchLoggingOptions *CloudWatchLoggingOptions `type:"structure"`
+
+	// The compression format. If no value is specified, the default is UNCOMPRESSED.
+	CompressionFormat *string `type:"string" enum:"CompressionFormat"`
+
+	// The serializer, deserializer, and schema for converting data from the JSON
+	// format to the Parquet or ORC format before writing it to Amazon S3.
+	DataFormatConversionConfiguration *DataFormatConversionConfiguration `type:"structure"`
+
+	// The configuration of the dynamic partitioning mechanism that creates smaller
+	// data sets from the streaming data by partitioning it based on partition keys.
+	// Currently, dynamic partitioning is only supported for Amazon S3 destinations.
+	DynamicPartitioningConfiguration *DynamicPartitioningConfiguration `type:"structure"`
+
+	// The encryption configuration. If no value is specified, the default is no
+	// encryption.
+	EncryptionConfiguration *EncryptionConfiguration `type:"structure"`
+
+	// A prefix that Kinesis Data Firehose evaluates and adds to failed records
+	// before writing them to S3. This prefix appears immediately following the
+	// bucket name. For information about how to specify this prefix, see Custom
+	// Prefixes for Amazon S3 Objects (https://docs.aws.amazon.com/firehose/latest/dev/s3-prefixes.html).
+	ErrorOutputPrefix *string `type:"string"`
+
+	// The "YYYY/MM/DD/HH" time format prefix is automatically used for delivered
+	// Amazon S3 files. You can also specify a custom prefix, as described in Custom
+	// Prefixes for Amazon S3 Objects (https://docs.aws.amazon.com/firehose/latest/dev/s3-prefixes.html).
+	Prefix *string `type:"string"`
+
+	// The data processing configuration.
+	ProcessingConfiguration *ProcessingConfiguration `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the Amazon Web Services credentials. For
+	// more information, see Amazon Resource Names (ARNs) and Amazon Web Services
+	// Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	//
+	// RoleARN is a required field
+	RoleARN *string `min:"1" type:"string" required:"true"`
+
+	// The configuration for backup in Amazon S3.
+	S3BackupConfiguration *S3DestinationConfiguration `type:"structure"`
+
+	// The Amazon S3 backup mode. After you create a delivery stream, you can update
+	// it to enable Amazon S3 backup if it is disabled. If backup is enabled, you
+	// can't update the delivery stream to disable it.
+	S3BackupMode *string `type:"string" enum:"S3BackupMode"`
 }
 
-// String returns the string representation
-func (s DescribeDeliveryStreamInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ExtendedS3DestinationConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeDeliveryStreamInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ExtendedS3DestinationConfiguration) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DescribeDeliveryStreamInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DescribeDeliveryStreamInput"}
-	if s.DeliveryStreamName == nil {
-		invalidParams.Add(request.NewErrParamRequired("DeliveryStreamName"))
+func (s *ExtendedS3DestinationConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ExtendedS3DestinationConfiguration"}
+	if s.BucketARN == nil {
+		invalidParams.Add(request.NewErrParamRequired("BucketARN"))
 	}
-	if s.DeliveryStreamName != nil && len(*s.DeliveryStreamName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("DeliveryStreamName", 1))
+	if s.BucketARN != nil && len(*s.BucketARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("BucketARN", 1))
 	}
-	if s.ExclusiveStartDestinationId != nil && len(*s.ExclusiveStartDestinationId) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("ExclusiveStartDestinationId", 1))
+	if s.RoleARN == nil {
+		invalidParams.Add(request.NewErrParamRequired("RoleARN"))
 	}
-	if s.Limit != nil && *s.Limit < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("Limit", 1))
+	if s.RoleARN != nil && len(*s.RoleARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RoleARN", 1))
+	}
+	if s.BufferingHints != nil {
+		if err := s.BufferingHints.Validate(); err != nil {
+			invalidParams.AddNested("BufferingHints", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.DataFormatConversionConfiguration != nil {
+		if err := s.DataFormatConversionConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("DataFormatConversionConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.EncryptionConfiguration != nil {
+		if err := s.EncryptionConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("EncryptionConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ProcessingConfiguration != nil {
+		if err := s.ProcessingConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("ProcessingConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3BackupConfiguration != nil {
+		if err := s.S3BackupConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("S3BackupConfiguration", err.(request.ErrInvalidParams))
+		}
 	}
 
 	if invalidParams.Len() > 0 {
@@ -2016,344 +4983,353 @@ func (s *DescribeDeliveryStreamInput) Validate() error {
 	return nil
 }
 
-// SetDeliveryStreamName sets the DeliveryStreamName field's value.
-func (s *DescribeDeliveryStreamInput) SetDeliveryStreamName(v string) *DescribeDeliveryStreamInput {
-	s.DeliveryStreamName = &v
+// SetBucketARN sets the BucketARN field's value.
+func (s *ExtendedS3DestinationConfiguration) SetBucketARN(v string) *ExtendedS3DestinationConfiguration {
+	s.BucketARN = &v
 	return s
 }
 
-// SetExclusiveStartDestinationId sets the ExclusiveStartDestinationId field's value.
-func (s *DescribeDeliveryStreamInput) SetExclusiveStartDestinationId(v string) *DescribeDeliveryStreamInput {
-	s.ExclusiveStartDestinationId = &v
+// SetBufferingHints sets the BufferingHints field's value.
+func (s *ExtendedS3DestinationConfiguration) SetBufferingHints(v *BufferingHints) *ExtendedS3DestinationConfiguration {
+	s.BufferingHints = v
 	return s
 }
 
-// SetLimit sets the Limit field's value.
-func (s *DescribeDeliveryStreamInput) SetLimit(v int64) *DescribeDeliveryStreamInput {
-	s.Limit = &v
+// SetCloudWatchLoggingOptions sets the CloudWatchLoggingOptions field's value.
+func (s *ExtendedS3DestinationConfiguration) SetCloudWatchLoggingOptions(v *CloudWatchLoggingOptions) *ExtendedS3DestinationConfiguration {
+	s.CloudWatchLoggingOptions = v
 	return s
 }
 
-type DescribeDeliveryStreamOutput struct {
-	_ struct{} `type:"structure"`
-
-	// Information about the delivery stream.
-	//
-	// DeliveryStreamDescription is a required field
-	DeliveryStreamDescription *DeliveryStreamDescription `type:"structure" required:"true"`
-}
-
-// String returns the string representation
-func (s DescribeDeliveryStreamOutput) String() string {
-	return awsutil.Prettify(s)
+// SetCompressionFormat sets the CompressionFormat field's value.
+func (s *ExtendedS3DestinationConfiguration) SetCompressionFormat(v string) *ExtendedS3DestinationConfiguration {
+	s.CompressionFormat = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s DescribeDeliveryStreamOutput) GoString() string {
-	return s.String()
+// SetDataFormatConversionConfiguration sets the DataFormatConversionConfiguration field's value.
+func (s *ExtendedS3DestinationConfiguration) SetDataFormatConversionConfiguration(v *DataFormatConversionConfiguration) *ExtendedS3DestinationConfiguration {
+	s.DataFormatConversionConfiguration = v
+	return s
 }
 
-// SetDeliveryStreamDescription sets the DeliveryStreamDescription field's value.
-func (s *DescribeDeliveryStreamOutput) SetDeliveryStreamDescription(v *DeliveryStreamDescription) *DescribeDeliveryStreamOutput {
-	s.DeliveryStreamDescription = v
+// SetDynamicPartitioningConfiguration sets the DynamicPartitioningConfiguration field's value.
+func (s *ExtendedS3DestinationConfiguration) SetDynamicPartitioningConfiguration(v *DynamicPartitioningConfiguration) *ExtendedS3DestinationConfiguration {
+	s.DynamicPartitioningConfiguration = v
 	return s
 }
 
-// The deserializer you want Kinesis Data Firehose to use for converting the
-// input data from JSON. Kinesis Data Firehose then serializes the data to its
-// final format using the Serializer. Kinesis Data Firehose supports two types
-// of deserializers: the Apache Hive JSON SerDe (https://cwiki.apache.org/confluence/display/Hive/LanguageManual+DDL#LanguageManualDDL-JSON)
-// and the OpenX JSON SerDe (https://github.com/rcongiu/Hive-JSON-Serde).
-type Deserializer struct {
-	_ struct{} `type:"structure"`
+// SetEncryptionConfiguration sets the EncryptionConfiguration field's value.
+func (s *ExtendedS3DestinationConfiguration) SetEncryptionConfiguration(v *EncryptionConfiguration) *ExtendedS3DestinationConfiguration {
+	s.EncryptionConfiguration = v
+	return s
+}
 
-	// The native Hive / HCatalog JsonSerDe. Used by Kinesis Data Firehose for deserializing
-	// data, which means converting it from the JSON format in preparation for serializing
-	// it to the Parquet or ORC format. This is one of two deserializers you can
-	// choose, depending on which one offers the functionality you need. The other
-	// option is the OpenX SerDe.
-	HiveJsonSerDe *HiveJsonSerDe `type:"structure"`
+// SetErrorOutputPrefix sets the ErrorOutputPrefix field's value.
+func (s *ExtendedS3DestinationConfiguration) SetErrorOutputPrefix(v string) *ExtendedS3DestinationConfiguration {
+	s.ErrorOutputPrefix = &v
+	return s
+}
 
-	// The OpenX SerDe. Used by Kinesis Data Firehose for deserializing data, which
-	// means converting it from the JSON format in preparation for serializing it
-	// to the Parquet or ORC format. This is one of two deserializers you can choose,
-	// depending on which one offers the functionality you need. The other option
-	// is the native Hive / HCatalog JsonSerDe.
-	OpenXJsonSerDe *OpenXJsonSerDe `type:"structure"`
+// SetPrefix sets the Prefix field's value.
+func (s *ExtendedS3DestinationConfiguration) SetPrefix(v string) *ExtendedS3DestinationConfiguration {
+	s.Prefix = &v
+	return s
 }
 
-// String returns the string representation
-func (s Deserializer) String() string {
-	return awsutil.Prettify(s)
+// SetProcessingConfiguration sets the ProcessingConfiguration field's value.
+func (s *ExtendedS3DestinationConfiguration) SetProcessingConfiguration(v *ProcessingConfiguration) *ExtendedS3DestinationConfiguration {
+	s.ProcessingConfiguration = v
+	return s
 }
 
-// GoString returns the string representation
-func (s Deserializer) GoString() string {
-	return s.String()
+// SetRoleARN sets the RoleARN field's value.
+func (s *ExtendedS3DestinationConfiguration) SetRoleARN(v string) *ExtendedS3DestinationConfiguration {
+	s.RoleARN = &v
+	return s
 }
 
-// SetHiveJsonSerDe sets the HiveJsonSerDe field's value.
-func (s *Deserializer) SetHiveJsonSerDe(v *HiveJsonSerDe) *Deserializer {
-	s.HiveJsonSerDe = v
+// SetS3BackupConfiguration sets the S3BackupConfiguration field's value.
+func (s *ExtendedS3DestinationConfiguration) SetS3BackupConfiguration(v *S3DestinationConfiguration) *ExtendedS3DestinationConfiguration {
+	s.S3BackupConfiguration = v
 	return s
 }
 
-// SetOpenXJsonSerDe sets the OpenXJsonSerDe field's value.
-func (s *Deserializer) SetOpenXJsonSerDe(v *OpenXJsonSerDe) *Deserializer {
-	s.OpenXJsonSerDe = v
+// SetS3BackupMode sets the S3BackupMode field's value.
+func (s *ExtendedS3DestinationConfiguration) SetS3BackupMode(v string) *ExtendedS3DestinationConfiguration {
+	s.S3BackupMode = &v
 	return s
 }
 
-// Describes the destination for a delivery stream.
-type DestinationDescription struct {
+// Describes a destination in Amazon S3.
+type ExtendedS3DestinationDescription struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the destination.
+	// The ARN of the S3 bucket. For more information, see Amazon Resource Names
+	// (ARNs) and Amazon Web Services Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
 	//
-	// DestinationId is a required field
-	DestinationId *string `min:"1" type:"string" required:"true"`
+	// BucketARN is a required field
+	BucketARN *string `min:"1" type:"string" required:"true"`
 
-	// The destination in Amazon ES.
-	ElasticsearchDestinationDescription *ElasticsearchDestinationDescription `type:"structure"`
+	// The buffering option.
+	//
+	// BufferingHints is a required field
+	BufferingHints *BufferingHints `type:"structure" required:"true"`
 
-	// The destination in Amazon S3.
-	ExtendedS3DestinationDescription *ExtendedS3DestinationDescription `type:"structure"`
+	// The Amazon CloudWatch logging options for your delivery stream.
+	CloudWatchLoggingOptions *CloudWatchLoggingOptions `type:"structure"`
 
-	// The destination in Amazon Redshift.
-	RedshiftDestinationDescription *RedshiftDestinationDescription `type:"structure"`
+	// The compression format. If no value is specified, the default is UNCOMPRESSED.
+	//
+	// CompressionFormat is a required field
+	CompressionFormat *string `type:"string" required:"true" enum:"CompressionFormat"`
 
-	// [Deprecated] The destination in Amazon S3.
-	S3DestinationDescription *S3DestinationDescription `type:"structure"`
+	// The serializer, deserializer, and schema for converting data from the JSON
+	// format to the Parquet or ORC format before writing it to Amazon S3.
+	DataFormatConversionConfiguration *DataFormatConversionConfiguration `type:"structure"`
 
-	// The destination in Splunk.
-	SplunkDestinationDescription *SplunkDestinationDescription `type:"structure"`
+	// The configuration of the dynamic partitioning mechanism that creates smaller
+	// data sets from the streaming data by partitioning it based on partition keys.
+	// Currently, dynamic partitioning is only supported for Amazon S3 destinations.
+	DynamicPartitioningConfiguration *DynamicPartitioningConfiguration `type:"structure"`
+
+	// The encryption configuration. If no value is specified, the default is no
+	// encryption.
+	//
+	// EncryptionConfiguration is a required field
+	EncryptionConfiguration *EncryptionConfiguration `type:"structure" required:"true"`
+
+	// A prefix that Kinesis Data Firehose evaluates and adds to failed records
+	// before writing them to S3. This prefix appears immediately following the
+	// bucket name. For information about how to specify this prefix, see Custom
+	// Prefixes for Amazon S3 Objects (https://docs.aws.amazon.com/firehose/latest/dev/s3-prefixes.html).
+	ErrorOutputPrefix *string `type:"string"`
+
+	// The "YYYY/MM/DD/HH" time format prefix is automatically used for delivered
+	// Amazon S3 files. You can also specify a custom prefix, as described in Custom
+	// Prefixes for Amazon S3 Objects (https://docs.aws.amazon.com/firehose/latest/dev/s3-prefixes.html).
+	Prefix *string `type:"string"`
+
+	// The data processing configuration.
+	ProcessingConfiguration *ProcessingConfiguration `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the Amazon Web Services credentials. For
+	// more information, see Amazon Resource Names (ARNs) and Amazon Web Services
+	// Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	//
+	// RoleARN is a required field
+	RoleARN *string `min:"1" type:"string" required:"true"`
+
+	// The configuration for backup in Amazon S3.
+	S3BackupDescription *S3DestinationDescription `type:"structure"`
+
+	// The Amazon S3 backup mode.
+	S3BackupMode *string `type:"string" enum:"S3BackupMode"`
 }
 
-// String returns the string representation
-func (s DestinationDescription) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ExtendedS3DestinationDescription) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DestinationDescription) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ExtendedS3DestinationDescription) GoString() string {
 	return s.String()
 }
 
-// SetDestinationId sets the DestinationId field's value.
-func (s *DestinationDescription) SetDestinationId(v string) *DestinationDescription {
-	s.DestinationId = &v
+// SetBucketARN sets the BucketARN field's value.
+func (s *ExtendedS3DestinationDescription) SetBucketARN(v string) *ExtendedS3DestinationDescription {
+	s.BucketARN = &v
 	return s
 }
 
-// SetElasticsearchDestinationDescription sets the ElasticsearchDestinationDescription field's value.
-func (s *DestinationDescription) SetElasticsearchDestinationDescription(v *ElasticsearchDestinationDescription) *DestinationDescription {
-	s.ElasticsearchDestinationDescription = v
+// SetBufferingHints sets the BufferingHints field's value.
+func (s *ExtendedS3DestinationDescription) SetBufferingHints(v *BufferingHints) *ExtendedS3DestinationDescription {
+	s.BufferingHints = v
 	return s
 }
 
-// SetExtendedS3DestinationDescription sets the ExtendedS3DestinationDescription field's value.
-func (s *DestinationDescription) SetExtendedS3DestinationDescription(v *ExtendedS3DestinationDescription) *DestinationDescription {
-	s.ExtendedS3DestinationDescription = v
+// SetCloudWatchLoggingOptions sets the CloudWatchLoggingOptions field's value.
+func (s *ExtendedS3DestinationDescription) SetCloudWatchLoggingOptions(v *CloudWatchLoggingOptions) *ExtendedS3DestinationDescription {
+	s.CloudWatchLoggingOptions = v
 	return s
 }
 
-// SetRedshiftDestinationDescription sets the RedshiftDestinationDescription field's value.
-func (s *DestinationDescription) SetRedshiftDestinationDescription(v *RedshiftDestinationDescription) *DestinationDescription {
-	s.RedshiftDestinationDescription = v
+// SetCompressionFormat sets the CompressionFormat field's value.
+func (s *ExtendedS3DestinationDescription) SetCompressionFormat(v string) *ExtendedS3DestinationDescription {
+	s.CompressionFormat = &v
 	return s
 }
 
-// SetS3DestinationDescription sets the S3DestinationDescription field's value.
-func (s *DestinationDescription) SetS3DestinationDescription(v *S3DestinationDescription) *DestinationDescription {
-	s.S3DestinationDescription = v
+// SetDataFormatConversionConfiguration sets the DataFormatConversionConfiguration field's value.
+func (s *ExtendedS3DestinationDescription) SetDataFormatConversionConfiguration(v *DataFormatConversionConfiguration) *ExtendedS3DestinationDescription {
+	s.DataFormatConversionConfiguration = v
 	return s
 }
 
-// SetSplunkDestinationDescription sets the SplunkDestinationDescription field's value.
-func (s *DestinationDescription) SetSplunkDestinationDescription(v *SplunkDestinationDescription) *DestinationDescription {
-	s.SplunkDestinationDescription = v
+// SetDynamicPartitioningConfiguration sets the DynamicPartitioningConfiguration field's value.
+func (s *ExtendedS3DestinationDescription) SetDynamicPartitioningConfiguration(v *DynamicPartitioningConfiguration) *ExtendedS3DestinationDescription {
+	s.DynamicPartitioningConfiguration = v
 	return s
 }
 
-// Describes the buffering to perform before delivering data to the Amazon ES
-// destination.
-type ElasticsearchBufferingHints struct {
-	_ struct{} `type:"structure"`
-
-	// Buffer incoming data for the specified period of time, in seconds, before
-	// delivering it to the destination. The default value is 300 (5 minutes).
-	IntervalInSeconds *int64 `min:"60" type:"integer"`
-
-	// Buffer incoming data to the specified size, in MBs, before delivering it
-	// to the destination. The default value is 5.
-	//
-	// We recommend setting this parameter to a value greater than the amount of
-	// data you typically ingest into the delivery stream in 10 seconds. For example,
-	// if you typically ingest data at 1 MB/sec, the value should be 10 MB or higher.
-	SizeInMBs *int64 `min:"1" type:"integer"`
+// SetEncryptionConfiguration sets the EncryptionConfiguration field's value.
+func (s *ExtendedS3DestinationDescription) SetEncryptionConfiguration(v *EncryptionConfiguration) *ExtendedS3DestinationDescription {
+	s.EncryptionConfiguration = v
+	return s
 }
 
-// String returns the string representation
-func (s ElasticsearchBufferingHints) String() string {
-	return awsutil.Prettify(s)
+// SetErrorOutputPrefix sets the ErrorOutputPrefix field's value.
+func (s *ExtendedS3DestinationDescription) SetErrorOutputPrefix(v string) *ExtendedS3DestinationDescription {
+	s.ErrorOutputPrefix = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s ElasticsearchBufferingHints) GoString() string {
-	return s.String()
+// SetPrefix sets the Prefix field's value.
+func (s *ExtendedS3DestinationDescription) SetPrefix(v string) *ExtendedS3DestinationDescription {
+	s.Prefix = &v
+	return s
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *ElasticsearchBufferingHints) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ElasticsearchBufferingHints"}
-	if s.IntervalInSeconds != nil && *s.IntervalInSeconds < 60 {
-		invalidParams.Add(request.NewErrParamMinValue("IntervalInSeconds", 60))
-	}
-	if s.SizeInMBs != nil && *s.SizeInMBs < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("SizeInMBs", 1))
-	}
+// SetProcessingConfiguration sets the ProcessingConfiguration field's value.
+func (s *ExtendedS3DestinationDescription) SetProcessingConfiguration(v *ProcessingConfiguration) *ExtendedS3DestinationDescription {
+	s.ProcessingConfiguration = v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetRoleARN sets the RoleARN field's value.
+func (s *ExtendedS3DestinationDescription) SetRoleARN(v string) *ExtendedS3DestinationDescription {
+	s.RoleARN = &v
+	return s
 }
 
-// SetIntervalInSeconds sets the IntervalInSeconds field's value.
-func (s *ElasticsearchBufferingHints) SetIntervalInSeconds(v int64) *ElasticsearchBufferingHints {
-	s.IntervalInSeconds = &v
+// SetS3BackupDescription sets the S3BackupDescription field's value.
+func (s *ExtendedS3DestinationDescription) SetS3BackupDescription(v *S3DestinationDescription) *ExtendedS3DestinationDescription {
+	s.S3BackupDescription = v
 	return s
 }
 
-// SetSizeInMBs sets the SizeInMBs field's value.
-func (s *ElasticsearchBufferingHints) SetSizeInMBs(v int64) *ElasticsearchBufferingHints {
-	s.SizeInMBs = &v
+// SetS3BackupMode sets the S3BackupMode field's value.
+func (s *ExtendedS3DestinationDescription) SetS3BackupMode(v string) *ExtendedS3DestinationDescription {
+	s.S3BackupMode = &v
 	return s
 }
 
-// Describes the configuration of a destination in Amazon ES.
-type ElasticsearchDestinationConfiguration struct {
+// Describes an update for a destination in Amazon S3.
+type ExtendedS3DestinationUpdate struct {
 	_ struct{} `type:"structure"`
 
-	// The buffering options. If no value is specified, the default values for ElasticsearchBufferingHints
-	// are used.
-	BufferingHints *ElasticsearchBufferingHints `type:"structure"`
+	// The ARN of the S3 bucket. For more information, see Amazon Resource Names
+	// (ARNs) and Amazon Web Services Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	BucketARN *string `min:"1" type:"string"`
+
+	// The buffering option.
+	BufferingHints *BufferingHints `type:"structure"`
 
 	// The Amazon CloudWatch logging options for your delivery stream.
 	CloudWatchLoggingOptions *CloudWatchLoggingOptions `type:"structure"`
 
-	// The ARN of the Amazon ES domain. The IAM role must have permissions for DescribeElasticsearchDomain,
-	// DescribeElasticsearchDomains, and DescribeElasticsearchDomainConfig after
-	// assuming the role specified in RoleARN. For more information, see Amazon
-	// Resource Names (ARNs) and AWS Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
-	//
-	// DomainARN is a required field
-	DomainARN *string `min:"1" type:"string" required:"true"`
+	// The compression format. If no value is specified, the default is UNCOMPRESSED.
+	CompressionFormat *string `type:"string" enum:"CompressionFormat"`
 
-	// The Elasticsearch index name.
-	//
-	// IndexName is a required field
-	IndexName *string `min:"1" type:"string" required:"true"`
+	// The serializer, deserializer, and schema for converting data from the JSON
+	// format to the Parquet or ORC format before writing it to Amazon S3.
+	DataFormatConversionConfiguration *DataFormatConversionConfiguration `type:"structure"`
+
+	// The configuration of the dynamic partitioning mechanism that creates smaller
+	// data sets from the streaming data by partitioning it based on partition keys.
+	// Currently, dynamic partitioning is only supported for Amazon S3 destinations.
+	DynamicPartitioningConfiguration *DynamicPartitioningConfiguration `type:"structure"`
+
+	// The encryption configuration. If no value is specified, the default is no
+	// encryption.
+	EncryptionConfiguration *EncryptionConfiguration `type:"structure"`
 
-	// The Elasticsearch index rotation period. Index rotation appends a timestamp
-	// to the IndexName to facilitate the expiration of old data. For more information,
-	// see Index Rotation for the Amazon ES Destination (http://docs.aws.amazon.com/firehose/latest/dev/basic-deliver.html#es-index-rotation).
-	// The default value is OneDay.
-	IndexRotationPeriod *string `type:"string" enum:"ElasticsearchIndexRotationPeriod"`
+	// A prefix that Kinesis Data Firehose evaluates and adds to failed records
+	// before writing them to S3. This prefix appears immediately following the
+	// bucket name. For information about how to specify this prefix, see Custom
+	// Prefixes for Amazon S3 Objects (https://docs.aws.amazon.com/firehose/latest/dev/s3-prefixes.html).
+	ErrorOutputPrefix *string `type:"string"`
+
+	// The "YYYY/MM/DD/HH" time format prefix is automatically used for delivered
+	// Amazon S3 files. You can also specify a custom prefix, as described in Custom
+	// Prefixes for Amazon S3 Objects (https://docs.aws.amazon.com/firehose/latest/dev/s3-prefixes.html).
+	Prefix *string `type:"string"`
 
 	// The data processing configuration.
 	ProcessingConfiguration *ProcessingConfiguration `type:"structure"`
 
-	// The retry behavior in case Kinesis Data Firehose is unable to deliver documents
-	// to Amazon ES. The default value is 300 (5 minutes).
-	RetryOptions *ElasticsearchRetryOptions `type:"structure"`
-
-	// The Amazon Resource Name (ARN) of the IAM role to be assumed by Kinesis Data
-	// Firehose for calling the Amazon ES Configuration API and for indexing documents.
-	// For more information, see Grant Kinesis Data Firehose Access to an Amazon
-	// S3 Destination (http://docs.aws.amazon.com/firehose/latest/dev/controlling-access.html#using-iam-s3)
-	// and Amazon Resource Names (ARNs) and AWS Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
-	//
-	// RoleARN is a required field
-	RoleARN *string `min:"1" type:"string" required:"true"`
-
-	// Defines how documents should be delivered to Amazon S3. When it is set to
-	// FailedDocumentsOnly, Kinesis Data Firehose writes any documents that could
-	// not be indexed to the configured Amazon S3 destination, with elasticsearch-failed/
-	// appended to the key prefix. When set to AllDocuments, Kinesis Data Firehose
-	// delivers all incoming records to Amazon S3, and also writes failed documents
-	// with elasticsearch-failed/ appended to the prefix. For more information,
-	// see Amazon S3 Backup for the Amazon ES Destination (http://docs.aws.amazon.com/firehose/latest/dev/basic-deliver.html#es-s3-backup).
-	// Default value is FailedDocumentsOnly.
-	S3BackupMode *string `type:"string" enum:"ElasticsearchS3BackupMode"`
+	// The Amazon Resource Name (ARN) of the Amazon Web Services credentials. For
+	// more information, see Amazon Resource Names (ARNs) and Amazon Web Services
+	// Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	RoleARN *string `min:"1" type:"string"`
 
-	// The configuration for the backup Amazon S3 location.
-	//
-	// S3Configuration is a required field
-	S3Configuration *S3DestinationConfiguration `type:"structure" required:"true"`
+	// You can update a delivery stream to enable Amazon S3 backup if it is disabled.
+	// If backup is enabled, you can't update the delivery stream to disable it.
+	S3BackupMode *string `type:"string" enum:"S3BackupMode"`
 
-	// The Elasticsearch type name. For Elasticsearch 6.x, there can be only one
-	// type per index. If you try to specify a new type for an existing index that
-	// already has another type, Kinesis Data Firehose returns an error during run
-	// time.
-	//
-	// TypeName is a required field
-	TypeName *string `min:"1" type:"string" required:"true"`
+	// The Amazon S3 destination for backup.
+	S3BackupUpdate *S3DestinationUpdate `type:"structure"`
 }
 
-// String returns the string representation
-func (s ElasticsearchDestinationConfiguration) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ExtendedS3DestinationUpdate) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ElasticsearchDestinationConfiguration) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ExtendedS3DestinationUpdate) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ElasticsearchDestinationConfiguration) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ElasticsearchDestinationConfiguration"}
-	if s.DomainARN == nil {
-		invalidParams.Add(request.NewErrParamRequired("DomainARN"))
-	}
-	if s.DomainARN != nil && len(*s.DomainARN) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("DomainARN", 1))
-	}
-	if s.IndexName == nil {
-		invalidParams.Add(request.NewErrParamRequired("IndexName"))
-	}
-	if s.IndexName != nil && len(*s.IndexName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("IndexName", 1))
-	}
-	if s.RoleARN == nil {
-		invalidParams.Add(request.NewErrParamRequired("RoleARN"))
+func (s *ExtendedS3DestinationUpdate) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ExtendedS3DestinationUpdate"}
+	if s.BucketARN != nil && len(*s.BucketARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("BucketARN", 1))
 	}
 	if s.RoleARN != nil && len(*s.RoleARN) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("RoleARN", 1))
 	}
-	if s.S3Configuration == nil {
-		invalidParams.Add(request.NewErrParamRequired("S3Configuration"))
-	}
-	if s.TypeName == nil {
-		invalidParams.Add(request.NewErrParamRequired("TypeName"))
-	}
-	if s.TypeName != nil && len(*s.TypeName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("TypeName", 1))
-	}
 	if s.BufferingHints != nil {
 		if err := s.BufferingHints.Validate(); err != nil {
 			invalidParams.AddNested("BufferingHints", err.(request.ErrInvalidParams))
 		}
 	}
+	if s.DataFormatConversionConfiguration != nil {
+		if err := s.DataFormatConversionConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("DataFormatConversionConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.EncryptionConfiguration != nil {
+		if err := s.EncryptionConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("EncryptionConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
 	if s.ProcessingConfiguration != nil {
 		if err := s.ProcessingConfiguration.Validate(); err != nil {
 			invalidParams.AddNested("ProcessingConfiguration", err.(request.ErrInvalidParams))
 		}
 	}
-	if s.S3Configuration != nil {
-		if err := s.S3Configuration.Validate(); err != nil {
-			invalidParams.AddNested("S3Configuration", err.(request.ErrInvalidParams))
+	if s.S3BackupUpdate != nil {
+		if err := s.S3BackupUpdate.Validate(); err != nil {
+			invalidParams.AddNested("S3BackupUpdate", err.(request.ErrInvalidParams))
 		}
 	}
 
@@ -2363,276 +5339,220 @@ func (s *ElasticsearchDestinationConfiguration) Validate() error {
 	return nil
 }
 
+// SetBucketARN sets the BucketARN field's value.
+func (s *ExtendedS3DestinationUpdate) SetBucketARN(v string) *ExtendedS3DestinationUpdate {
+	s.BucketARN = &v
+	return s
+}
+
 // SetBufferingHints sets the BufferingHints field's value.
-func (s *ElasticsearchDestinationConfiguration) SetBufferingHints(v *ElasticsearchBufferingHints) *ElasticsearchDestinationConfiguration {
+func (s *ExtendedS3DestinationUpdate) SetBufferingHints(v *BufferingHints) *ExtendedS3DestinationUpdate {
 	s.BufferingHints = v
 	return s
 }
 
 // SetCloudWatchLoggingOptions sets the CloudWatchLoggingOptions field's value.
-func (s *ElasticsearchDestinationConfiguration) SetCloudWatchLoggingOptions(v *CloudWatchLoggingOptions) *ElasticsearchDestinationConfiguration {
+func (s *ExtendedS3DestinationUpdate) SetCloudWatchLoggingOptions(v *CloudWatchLoggingOptions) *ExtendedS3DestinationUpdate {
 	s.CloudWatchLoggingOptions = v
 	return s
 }
 
-// SetDomainARN sets the DomainARN field's value.
-func (s *ElasticsearchDestinationConfiguration) SetDomainARN(v string) *ElasticsearchDestinationConfiguration {
-	s.DomainARN = &v
+// SetCompressionFormat sets the CompressionFormat field's value.
+func (s *ExtendedS3DestinationUpdate) SetCompressionFormat(v string) *ExtendedS3DestinationUpdate {
+	s.CompressionFormat = &v
 	return s
 }
 
-// SetIndexName sets the IndexName field's value.
-func (s *ElasticsearchDestinationConfiguration) SetIndexName(v string) *ElasticsearchDestinationConfiguration {
-	s.IndexName = &v
+// SetDataFormatConversionConfiguration sets the DataFormatConversionConfiguration field's value.
+func (s *ExtendedS3DestinationUpdate) SetDataFormatConversionConfiguration(v *DataFormatConversionConfiguration) *ExtendedS3DestinationUpdate {
+	s.DataFormatConversionConfiguration = v
 	return s
 }
 
-// SetIndexRotationPeriod sets the IndexRotationPeriod field's value.
-func (s *ElasticsearchDestinationConfiguration) SetIndexRotationPeriod(v string) *ElasticsearchDestinationConfiguration {
-	s.IndexRotationPeriod = &v
+// SetDynamicPartitioningConfiguration sets the DynamicPartitioningConfiguration field's value.
+func (s *ExtendedS3DestinationUpdate) SetDynamicPartitioningConfiguration(v *DynamicPartitioningConfiguration) *ExtendedS3DestinationUpdate {
+	s.DynamicPartitioningConfiguration = v
 	return s
 }
 
-// SetProcessingConfiguration sets the ProcessingConfiguration field's value.
-func (s *ElasticsearchDestinationConfiguration) SetProcessingConfiguration(v *ProcessingConfiguration) *ElasticsearchDestinationConfiguration {
-	s.ProcessingConfiguration = v
+// SetEncryptionConfiguration sets the EncryptionConfiguration field's value.
+func (s *ExtendedS3DestinationUpdate) SetEncryptionConfiguration(v *EncryptionConfiguration) *ExtendedS3DestinationUpdate {
+	s.EncryptionConfiguration = v
 	return s
 }
 
-// SetRetryOptions sets the RetryOptions field's value.
-func (s *ElasticsearchDestinationConfiguration) SetRetryOptions(v *ElasticsearchRetryOptions) *ElasticsearchDestinationConfiguration {
-	s.RetryOptions = v
+// SetErrorOutputPrefix sets the ErrorOutputPrefix field's value.
+func (s *ExtendedS3DestinationUpdate) SetErrorOutputPrefix(v string) *ExtendedS3DestinationUpdate {
+	s.ErrorOutputPrefix = &v
+	return s
+}
+
+// SetPrefix sets the Prefix field's value.
+func (s *ExtendedS3DestinationUpdate) SetPrefix(v string) *ExtendedS3DestinationUpdate {
+	s.Prefix = &v
+	return s
+}
+
+// SetProcessingConfiguration sets the ProcessingConfiguration field's value.
+func (s *ExtendedS3DestinationUpdate) SetProcessingConfiguration(v *ProcessingConfiguration) *ExtendedS3DestinationUpdate {
+	s.ProcessingConfiguration = v
 	return s
 }
 
 // SetRoleARN sets the RoleARN field's value.
-func (s *ElasticsearchDestinationConfiguration) SetRoleARN(v string) *ElasticsearchDestinationConfiguration {
+func (s *ExtendedS3DestinationUpdate) SetRoleARN(v string) *ExtendedS3DestinationUpdate {
 	s.RoleARN = &v
 	return s
 }
 
 // SetS3BackupMode sets the S3BackupMode field's value.
-func (s *ElasticsearchDestinationConfiguration) SetS3BackupMode(v string) *ElasticsearchDestinationConfiguration {
+func (s *ExtendedS3DestinationUpdate) SetS3BackupMode(v string) *ExtendedS3DestinationUpdate {
 	s.S3BackupMode = &v
 	return s
 }
 
-// SetS3Configuration sets the S3Configuration field's value.
-func (s *ElasticsearchDestinationConfiguration) SetS3Configuration(v *S3DestinationConfiguration) *ElasticsearchDestinationConfiguration {
-	s.S3Configuration = v
-	return s
-}
-
-// SetTypeName sets the TypeName field's value.
-func (s *ElasticsearchDestinationConfiguration) SetTypeName(v string) *ElasticsearchDestinationConfiguration {
-	s.TypeName = &v
+// SetS3BackupUpdate sets the S3BackupUpdate field's value.
+func (s *ExtendedS3DestinationUpdate) SetS3BackupUpdate(v *S3DestinationUpdate) *ExtendedS3DestinationUpdate {
+	s.S3BackupUpdate = v
 	return s
 }
 
-// The destination description in Amazon ES.
-type ElasticsearchDestinationDescription struct {
+// Provides details in case one of the following operations fails due to an
+// error related to KMS: CreateDeliveryStream, DeleteDeliveryStream, StartDeliveryStreamEncryption,
+// StopDeliveryStreamEncryption.
+type FailureDescription struct {
 	_ struct{} `type:"structure"`
 
-	// The buffering options.
-	BufferingHints *ElasticsearchBufferingHints `type:"structure"`
-
-	// The Amazon CloudWatch logging options.
-	CloudWatchLoggingOptions *CloudWatchLoggingOptions `type:"structure"`
-
-	// The ARN of the Amazon ES domain. For more information, see Amazon Resource
-	// Names (ARNs) and AWS Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
-	DomainARN *string `min:"1" type:"string"`
-
-	// The Elasticsearch index name.
-	IndexName *string `min:"1" type:"string"`
-
-	// The Elasticsearch index rotation period
-	IndexRotationPeriod *string `type:"string" enum:"ElasticsearchIndexRotationPeriod"`
-
-	// The data processing configuration.
-	ProcessingConfiguration *ProcessingConfiguration `type:"structure"`
-
-	// The Amazon ES retry options.
-	RetryOptions *ElasticsearchRetryOptions `type:"structure"`
-
-	// The Amazon Resource Name (ARN) of the AWS credentials. For more information,
-	// see Amazon Resource Names (ARNs) and AWS Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
-	RoleARN *string `min:"1" type:"string"`
-
-	// The Amazon S3 backup mode.
-	S3BackupMode *string `type:"string" enum:"ElasticsearchS3BackupMode"`
-
-	// The Amazon S3 destination.
-	S3DestinationDescription *S3DestinationDescription `type:"structure"`
+	// A message providing details about the error that caused the failure.
+	//
+	// Details is a required field
+	Details *string `min:"1" type:"string" required:"true"`
 
-	// The Elasticsearch type name.
-	TypeName *string `min:"1" type:"string"`
+	// The type of error that caused the failure.
+	//
+	// Type is a required field
+	Type *string `type:"string" required:"true" enum:"DeliveryStreamFailureType"`
 }
 
-// String returns the string representation
-func (s ElasticsearchDestinationDescription) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s FailureDescription) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ElasticsearchDestinationDescription) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s FailureDescription) GoString() string {
 	return s.String()
 }
 
-// SetBufferingHints sets the BufferingHints field's value.
-func (s *ElasticsearchDestinationDescription) SetBufferingHints(v *ElasticsearchBufferingHints) *ElasticsearchDestinationDescription {
-	s.BufferingHints = v
-	return s
-}
-
-// SetCloudWatchLoggingOptions sets the CloudWatchLoggingOptions field's value.
-func (s *ElasticsearchDestinationDescription) SetCloudWatchLoggingOptions(v *CloudWatchLoggingOptions) *ElasticsearchDestinationDescription {
-	s.CloudWatchLoggingOptions = v
-	return s
-}
-
-// SetDomainARN sets the DomainARN field's value.
-func (s *ElasticsearchDestinationDescription) SetDomainARN(v string) *ElasticsearchDestinationDescription {
-	s.DomainARN = &v
-	return s
-}
-
-// SetIndexName sets the IndexName field's value.
-func (s *ElasticsearchDestinationDescription) SetIndexName(v string) *ElasticsearchDestinationDescription {
-	s.IndexName = &v
-	return s
-}
-
-// SetIndexRotationPeriod sets the IndexRotationPeriod field's value.
-func (s *ElasticsearchDestinationDescription) SetIndexRotationPeriod(v string) *ElasticsearchDestinationDescription {
-	s.IndexRotationPeriod = &v
+// SetDetails sets the Details field's value.
+func (s *FailureDescription) SetDetails(v string) *FailureDescription {
+	s.Details = &v
 	return s
 }
 
-// SetProcessingConfiguration sets the ProcessingConfiguration field's value.
-func (s *ElasticsearchDestinationDescription) SetProcessingConfiguration(v *ProcessingConfiguration) *ElasticsearchDestinationDescription {
-	s.ProcessingConfiguration = v
+// SetType sets the Type field's value.
+func (s *FailureDescription) SetType(v string) *FailureDescription {
+	s.Type = &v
 	return s
 }
 
-// SetRetryOptions sets the RetryOptions field's value.
-func (s *ElasticsearchDestinationDescription) SetRetryOptions(v *ElasticsearchRetryOptions) *ElasticsearchDestinationDescription {
-	s.RetryOptions = v
-	return s
-}
+// The native Hive / HCatalog JsonSerDe. Used by Kinesis Data Firehose for deserializing
+// data, which means converting it from the JSON format in preparation for serializing
+// it to the Parquet or ORC format. This is one of two deserializers you can
+// choose, depending on which one offers the functionality you need. The other
+// option is the OpenX SerDe.
+type HiveJsonSerDe struct {
+	_ struct{} `type:"structure"`
 
-// SetRoleARN sets the RoleARN field's value.
-func (s *ElasticsearchDestinationDescription) SetRoleARN(v string) *ElasticsearchDestinationDescription {
-	s.RoleARN = &v
-	return s
+	// Indicates how you want Kinesis Data Firehose to parse the date and timestamps
+	// that may be present in your input data JSON. To specify these format strings,
+	// follow the pattern syntax of JodaTime's DateTimeFormat format strings. For
+	// more information, see Class DateTimeFormat (https://www.joda.org/joda-time/apidocs/org/joda/time/format/DateTimeFormat.html).
+	// You can also use the special value millis to parse timestamps in epoch milliseconds.
+	// If you don't specify a format, Kinesis Data Firehose uses java.sql.Timestamp::valueOf
+	// by default.
+	TimestampFormats []*string `type:"list"`
 }
 
-// SetS3BackupMode sets the S3BackupMode field's value.
-func (s *ElasticsearchDestinationDescription) SetS3BackupMode(v string) *ElasticsearchDestinationDescription {
-	s.S3BackupMode = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HiveJsonSerDe) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetS3DestinationDescription sets the S3DestinationDescription field's value.
-func (s *ElasticsearchDestinationDescription) SetS3DestinationDescription(v *S3DestinationDescription) *ElasticsearchDestinationDescription {
-	s.S3DestinationDescription = v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HiveJsonSerDe) GoString() string {
+	return s.String()
 }
 
-// SetTypeName sets the TypeName field's value.
-func (s *ElasticsearchDestinationDescription) SetTypeName(v string) *ElasticsearchDestinationDescription {
-	s.TypeName = &v
+// SetTimestampFormats sets the TimestampFormats field's value.
+func (s *HiveJsonSerDe) SetTimestampFormats(v []*string) *HiveJsonSerDe {
+	s.TimestampFormats = v
 	return s
 }
 
-// Describes an update for a destination in Amazon ES.
-type ElasticsearchDestinationUpdate struct {
+// Describes the buffering options that can be applied before data is delivered
+// to the HTTP endpoint destination. Kinesis Data Firehose treats these options
+// as hints, and it might choose to use more optimal values. The SizeInMBs and
+// IntervalInSeconds parameters are optional. However, if specify a value for
+// one of them, you must also provide a value for the other.
+type HttpEndpointBufferingHints struct {
 	_ struct{} `type:"structure"`
 
-	// The buffering options. If no value is specified, ElasticsearchBufferingHints
-	// object default values are used.
-	BufferingHints *ElasticsearchBufferingHints `type:"structure"`
-
-	// The CloudWatch logging options for your delivery stream.
-	CloudWatchLoggingOptions *CloudWatchLoggingOptions `type:"structure"`
-
-	// The ARN of the Amazon ES domain. The IAM role must have permissions for DescribeElasticsearchDomain,
-	// DescribeElasticsearchDomains, and DescribeElasticsearchDomainConfig after
-	// assuming the IAM role specified in RoleARN. For more information, see Amazon
-	// Resource Names (ARNs) and AWS Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
-	DomainARN *string `min:"1" type:"string"`
-
-	// The Elasticsearch index name.
-	IndexName *string `min:"1" type:"string"`
-
-	// The Elasticsearch index rotation period. Index rotation appends a timestamp
-	// to IndexName to facilitate the expiration of old data. For more information,
-	// see Index Rotation for the Amazon ES Destination (http://docs.aws.amazon.com/firehose/latest/dev/basic-deliver.html#es-index-rotation).
-	// Default value is OneDay.
-	IndexRotationPeriod *string `type:"string" enum:"ElasticsearchIndexRotationPeriod"`
-
-	// The data processing configuration.
-	ProcessingConfiguration *ProcessingConfiguration `type:"structure"`
-
-	// The retry behavior in case Kinesis Data Firehose is unable to deliver documents
-	// to Amazon ES. The default value is 300 (5 minutes).
-	RetryOptions *ElasticsearchRetryOptions `type:"structure"`
-
-	// The Amazon Resource Name (ARN) of the IAM role to be assumed by Kinesis Data
-	// Firehose for calling the Amazon ES Configuration API and for indexing documents.
-	// For more information, see Grant Kinesis Data Firehose Access to an Amazon
-	// S3 Destination (http://docs.aws.amazon.com/firehose/latest/dev/controlling-access.html#using-iam-s3)
-	// and Amazon Resource Names (ARNs) and AWS Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
-	RoleARN *string `min:"1" type:"string"`
-
-	// The Amazon S3 destination.
-	S3Update *S3DestinationUpdate `type:"structure"`
+	// Buffer incoming data for the specified period of time, in seconds, before
+	// delivering it to the destination. The default value is 300 (5 minutes).
+	IntervalInSeconds *int64 `min:"60" type:"integer"`
 
-	// The Elasticsearch type name. For Elasticsearch 6.x, there can be only one
-	// type per index. If you try to specify a new type for an existing index that
-	// already has another type, Kinesis Data Firehose returns an error during runtime.
-	TypeName *string `min:"1" type:"string"`
+	// Buffer incoming data to the specified size, in MBs, before delivering it
+	// to the destination. The default value is 5.
+	//
+	// We recommend setting this parameter to a value greater than the amount of
+	// data you typically ingest into the delivery stream in 10 seconds. For example,
+	// if you typically ingest data at 1 MB/sec, the value should be 10 MB or higher.
+	SizeInMBs *int64 `min:"1" type:"integer"`
 }
 
-// String returns the string representation
-func (s ElasticsearchDestinationUpdate) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpEndpointBufferingHints) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ElasticsearchDestinationUpdate) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpEndpointBufferingHints) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ElasticsearchDestinationUpdate) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ElasticsearchDestinationUpdate"}
-	if s.DomainARN != nil && len(*s.DomainARN) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("DomainARN", 1))
-	}
-	if s.IndexName != nil && len(*s.IndexName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("IndexName", 1))
-	}
-	if s.RoleARN != nil && len(*s.RoleARN) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("RoleARN", 1))
-	}
-	if s.TypeName != nil && len(*s.TypeName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("TypeName", 1))
-	}
-	if s.BufferingHints != nil {
-		if err := s.BufferingHints.Validate(); err != nil {
-			invalidParams.AddNested("BufferingHints", err.(request.ErrInvalidParams))
-		}
-	}
-	if s.ProcessingConfiguration != nil {
-		if err := s.ProcessingConfiguration.Validate(); err != nil {
-			invalidParams.AddNested("ProcessingConfiguration", err.(request.ErrInvalidParams))
-		}
+func (s *HttpEndpointBufferingHints) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "HttpEndpointBufferingHints"}
+	if s.IntervalInSeconds != nil && *s.IntervalInSeconds < 60 {
+		invalidParams.Add(request.NewErrParamMinValue("IntervalInSeconds", 60))
 	}
-	if s.S3Update != nil {
-		if err := s.S3Update.Validate(); err != nil {
-			invalidParams.AddNested("S3Update", err.(request.ErrInvalidParams))
-		}
+	if s.SizeInMBs != nil && *s.SizeInMBs < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("SizeInMBs", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -2641,124 +5561,149 @@ func (s *ElasticsearchDestinationUpdate) Validate() error {
 	return nil
 }
 
-// SetBufferingHints sets the BufferingHints field's value.
-func (s *ElasticsearchDestinationUpdate) SetBufferingHints(v *ElasticsearchBufferingHints) *ElasticsearchDestinationUpdate {
-	s.BufferingHints = v
+// SetIntervalInSeconds sets the IntervalInSeconds field's value.
+func (s *HttpEndpointBufferingHints) SetIntervalInSeconds(v int64) *HttpEndpointBufferingHints {
+	s.IntervalInSeconds = &v
 	return s
 }
 
-// SetCloudWatchLoggingOptions sets the CloudWatchLoggingOptions field's value.
-func (s *ElasticsearchDestinationUpdate) SetCloudWatchLoggingOptions(v *CloudWatchLoggingOptions) *ElasticsearchDestinationUpdate {
-	s.CloudWatchLoggingOptions = v
+// SetSizeInMBs sets the SizeInMBs field's value.
+func (s *HttpEndpointBufferingHints) SetSizeInMBs(v int64) *HttpEndpointBufferingHints {
+	s.SizeInMBs = &v
 	return s
 }
 
-// SetDomainARN sets the DomainARN field's value.
-func (s *ElasticsearchDestinationUpdate) SetDomainARN(v string) *ElasticsearchDestinationUpdate {
-	s.DomainARN = &v
-	return s
-}
+// Describes the metadata that's delivered to the specified HTTP endpoint destination.
+type HttpEndpointCommonAttribute struct {
+	_ struct{} `type:"structure"`
 
-// SetIndexName sets the IndexName field's value.
-func (s *ElasticsearchDestinationUpdate) SetIndexName(v string) *ElasticsearchDestinationUpdate {
-	s.IndexName = &v
-	return s
-}
+	// The name of the HTTP endpoint common attribute.
+	//
+	// AttributeName is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by HttpEndpointCommonAttribute's
+	// String and GoString methods.
+	//
+	// AttributeName is a required field
+	AttributeName *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
-// SetIndexRotationPeriod sets the IndexRotationPeriod field's value.
-func (s *ElasticsearchDestinationUpdate) SetIndexRotationPeriod(v string) *ElasticsearchDestinationUpdate {
-	s.IndexRotationPeriod = &v
-	return s
+	// The value of the HTTP endpoint common attribute.
+	//
+	// AttributeValue is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by HttpEndpointCommonAttribute's
+	// String and GoString methods.
+	//
+	// AttributeValue is a required field
+	AttributeValue *string `type:"string" required:"true" sensitive:"true"`
 }
 
-// SetProcessingConfiguration sets the ProcessingConfiguration field's value.
-func (s *ElasticsearchDestinationUpdate) SetProcessingConfiguration(v *ProcessingConfiguration) *ElasticsearchDestinationUpdate {
-	s.ProcessingConfiguration = v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpEndpointCommonAttribute) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetRetryOptions sets the RetryOptions field's value.
-func (s *ElasticsearchDestinationUpdate) SetRetryOptions(v *ElasticsearchRetryOptions) *ElasticsearchDestinationUpdate {
-	s.RetryOptions = v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpEndpointCommonAttribute) GoString() string {
+	return s.String()
 }
 
-// SetRoleARN sets the RoleARN field's value.
-func (s *ElasticsearchDestinationUpdate) SetRoleARN(v string) *ElasticsearchDestinationUpdate {
-	s.RoleARN = &v
-	return s
-}
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *HttpEndpointCommonAttribute) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "HttpEndpointCommonAttribute"}
+	if s.AttributeName == nil {
+		invalidParams.Add(request.NewErrParamRequired("AttributeName"))
+	}
+	if s.AttributeName != nil && len(*s.AttributeName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("AttributeName", 1))
+	}
+	if s.AttributeValue == nil {
+		invalidParams.Add(request.NewErrParamRequired("AttributeValue"))
+	}
 
-// SetS3Update sets the S3Update field's value.
-func (s *ElasticsearchDestinationUpdate) SetS3Update(v *S3DestinationUpdate) *ElasticsearchDestinationUpdate {
-	s.S3Update = v
-	return s
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetTypeName sets the TypeName field's value.
-func (s *ElasticsearchDestinationUpdate) SetTypeName(v string) *ElasticsearchDestinationUpdate {
-	s.TypeName = &v
+// SetAttributeName sets the AttributeName field's value.
+func (s *HttpEndpointCommonAttribute) SetAttributeName(v string) *HttpEndpointCommonAttribute {
+	s.AttributeName = &v
 	return s
 }
 
-// Configures retry behavior in case Kinesis Data Firehose is unable to deliver
-// documents to Amazon ES.
-type ElasticsearchRetryOptions struct {
-	_ struct{} `type:"structure"`
-
-	// After an initial failure to deliver to Amazon ES, the total amount of time
-	// during which Kinesis Data Firehose retries delivery (including the first
-	// attempt). After this time has elapsed, the failed documents are written to
-	// Amazon S3. Default value is 300 seconds (5 minutes). A value of 0 (zero)
-	// results in no retries.
-	DurationInSeconds *int64 `type:"integer"`
-}
-
-// String returns the string representation
-func (s ElasticsearchRetryOptions) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s ElasticsearchRetryOptions) GoString() string {
-	return s.String()
-}
-
-// SetDurationInSeconds sets the DurationInSeconds field's value.
-func (s *ElasticsearchRetryOptions) SetDurationInSeconds(v int64) *ElasticsearchRetryOptions {
-	s.DurationInSeconds = &v
+// SetAttributeValue sets the AttributeValue field's value.
+func (s *HttpEndpointCommonAttribute) SetAttributeValue(v string) *HttpEndpointCommonAttribute {
+	s.AttributeValue = &v
 	return s
 }
 
-// Describes the encryption for a destination in Amazon S3.
-type EncryptionConfiguration struct {
+// Describes the configuration of the HTTP endpoint to which Kinesis Firehose
+// delivers data.
+type HttpEndpointConfiguration struct {
 	_ struct{} `type:"structure"`
 
-	// The encryption key.
-	KMSEncryptionConfig *KMSEncryptionConfig `type:"structure"`
+	// The access key required for Kinesis Firehose to authenticate with the HTTP
+	// endpoint selected as the destination.
+	//
+	// AccessKey is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by HttpEndpointConfiguration's
+	// String and GoString methods.
+	AccessKey *string `type:"string" sensitive:"true"`
 
-	// Specifically override existing encryption information to ensure that no encryption
-	// is used.
-	NoEncryptionConfig *string `type:"string" enum:"NoEncryptionConfig"`
+	// The name of the HTTP endpoint selected as the destination.
+	Name *string `min:"1" type:"string"`
+
+	// The URL of the HTTP endpoint selected as the destination.
+	//
+	// If you choose an HTTP endpoint as your destination, review and follow the
+	// instructions in the Appendix - HTTP Endpoint Delivery Request and Response
+	// Specifications (https://docs.aws.amazon.com/firehose/latest/dev/httpdeliveryrequestresponse.html).
+	//
+	// Url is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by HttpEndpointConfiguration's
+	// String and GoString methods.
+	//
+	// Url is a required field
+	Url *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
-// String returns the string representation
-func (s EncryptionConfiguration) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpEndpointConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s EncryptionConfiguration) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpEndpointConfiguration) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *EncryptionConfiguration) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "EncryptionConfiguration"}
-	if s.KMSEncryptionConfig != nil {
-		if err := s.KMSEncryptionConfig.Validate(); err != nil {
-			invalidParams.AddNested("KMSEncryptionConfig", err.(request.ErrInvalidParams))
-		}
+func (s *HttpEndpointConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "HttpEndpointConfiguration"}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+	if s.Url == nil {
+		invalidParams.Add(request.NewErrParamRequired("Url"))
+	}
+	if s.Url != nil && len(*s.Url) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Url", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -2767,112 +5712,154 @@ func (s *EncryptionConfiguration) Validate() error {
 	return nil
 }
 
-// SetKMSEncryptionConfig sets the KMSEncryptionConfig field's value.
-func (s *EncryptionConfiguration) SetKMSEncryptionConfig(v *KMSEncryptionConfig) *EncryptionConfiguration {
-	s.KMSEncryptionConfig = v
+// SetAccessKey sets the AccessKey field's value.
+func (s *HttpEndpointConfiguration) SetAccessKey(v string) *HttpEndpointConfiguration {
+	s.AccessKey = &v
 	return s
 }
 
-// SetNoEncryptionConfig sets the NoEncryptionConfig field's value.
-func (s *EncryptionConfiguration) SetNoEncryptionConfig(v string) *EncryptionConfiguration {
-	s.NoEncryptionConfig = &v
+// SetName sets the Name field's value.
+func (s *HttpEndpointConfiguration) SetName(v string) *HttpEndpointConfiguration {
+	s.Name = &v
 	return s
 }
 
-// Describes the configuration of a destination in Amazon S3.
-type ExtendedS3DestinationConfiguration struct {
+// SetUrl sets the Url field's value.
+func (s *HttpEndpointConfiguration) SetUrl(v string) *HttpEndpointConfiguration {
+	s.Url = &v
+	return s
+}
+
+// Describes the HTTP endpoint selected as the destination.
+type HttpEndpointDescription struct {
 	_ struct{} `type:"structure"`
 
-	// The ARN of the S3 bucket. For more information, see Amazon Resource Names
-	// (ARNs) and AWS Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	// The name of the HTTP endpoint selected as the destination.
+	Name *string `min:"1" type:"string"`
+
+	// The URL of the HTTP endpoint selected as the destination.
 	//
-	// BucketARN is a required field
-	BucketARN *string `min:"1" type:"string" required:"true"`
+	// Url is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by HttpEndpointDescription's
+	// String and GoString methods.
+	Url *string `min:"1" type:"string" sensitive:"true"`
+}
 
-	// The buffering option.
-	BufferingHints *BufferingHints `type:"structure"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpEndpointDescription) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// The Amazon CloudWatch logging options for your delivery stream.
-	CloudWatchLoggingOptions *CloudWatchLoggingOptions `type:"structure"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpEndpointDescription) GoString() string {
+	return s.String()
+}
 
-	// The compression format. If no value is specified, the default is UNCOMPRESSED.
-	CompressionFormat *string `type:"string" enum:"CompressionFormat"`
+// SetName sets the Name field's value.
+func (s *HttpEndpointDescription) SetName(v string) *HttpEndpointDescription {
+	s.Name = &v
+	return s
+}
 
-	// The serializer, deserializer, and schema for converting data from the JSON
-	// format to the Parquet or ORC format before writing it to Amazon S3.
-	DataFormatConversionConfiguration *DataFormatConversionConfiguration `type:"structure"`
+// SetUrl sets the Url field's value.
+func (s *HttpEndpointDescription) SetUrl(v string) *HttpEndpointDescription {
+	s.Url = &v
+	return s
+}
 
-	// The encryption configuration. If no value is specified, the default is no
-	// encryption.
-	EncryptionConfiguration *EncryptionConfiguration `type:"structure"`
+// Describes the configuration of the HTTP endpoint destination.
+type HttpEndpointDestinationConfiguration struct {
+	_ struct{} `type:"structure"`
 
-	// A prefix that Kinesis Data Firehose evaluates and adds to failed records
-	// before writing them to S3. This prefix appears immediately following the
-	// bucket name.
-	ErrorOutputPrefix *string `type:"string"`
+	// The buffering options that can be used before data is delivered to the specified
+	// destination. Kinesis Data Firehose treats these options as hints, and it
+	// might choose to use more optimal values. The SizeInMBs and IntervalInSeconds
+	// parameters are optional. However, if you specify a value for one of them,
+	// you must also provide a value for the other.
+	BufferingHints *HttpEndpointBufferingHints `type:"structure"`
 
-	// The "YYYY/MM/DD/HH" time format prefix is automatically used for delivered
-	// Amazon S3 files. You can specify an extra prefix to be added in front of
-	// the time format prefix. If the prefix ends with a slash, it appears as a
-	// folder in the S3 bucket. For more information, see Amazon S3 Object Name
-	// Format (http://docs.aws.amazon.com/firehose/latest/dev/basic-deliver.html#s3-object-name)
-	// in the Amazon Kinesis Data Firehose Developer Guide.
-	Prefix *string `type:"string"`
+	// Describes the Amazon CloudWatch logging options for your delivery stream.
+	CloudWatchLoggingOptions *CloudWatchLoggingOptions `type:"structure"`
 
-	// The data processing configuration.
+	// The configuration of the HTTP endpoint selected as the destination.
+	//
+	// EndpointConfiguration is a required field
+	EndpointConfiguration *HttpEndpointConfiguration `type:"structure" required:"true"`
+
+	// Describes a data processing configuration.
 	ProcessingConfiguration *ProcessingConfiguration `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the AWS credentials. For more information,
-	// see Amazon Resource Names (ARNs) and AWS Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
-	//
-	// RoleARN is a required field
-	RoleARN *string `min:"1" type:"string" required:"true"`
+	// The configuration of the requeste sent to the HTTP endpoint specified as
+	// the destination.
+	RequestConfiguration *HttpEndpointRequestConfiguration `type:"structure"`
 
-	// The configuration for backup in Amazon S3.
-	S3BackupConfiguration *S3DestinationConfiguration `type:"structure"`
+	// Describes the retry behavior in case Kinesis Data Firehose is unable to deliver
+	// data to the specified HTTP endpoint destination, or if it doesn't receive
+	// a valid acknowledgment of receipt from the specified HTTP endpoint destination.
+	RetryOptions *HttpEndpointRetryOptions `type:"structure"`
 
-	// The Amazon S3 backup mode.
-	S3BackupMode *string `type:"string" enum:"S3BackupMode"`
+	// Kinesis Data Firehose uses this IAM role for all the permissions that the
+	// delivery stream needs.
+	RoleARN *string `min:"1" type:"string"`
+
+	// Describes the S3 bucket backup options for the data that Kinesis Data Firehose
+	// delivers to the HTTP endpoint destination. You can back up all documents
+	// (AllData) or only the documents that Kinesis Data Firehose could not deliver
+	// to the specified HTTP endpoint destination (FailedDataOnly).
+	S3BackupMode *string `type:"string" enum:"HttpEndpointS3BackupMode"`
+
+	// Describes the configuration of a destination in Amazon S3.
+	//
+	// S3Configuration is a required field
+	S3Configuration *S3DestinationConfiguration `type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s ExtendedS3DestinationConfiguration) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpEndpointDestinationConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ExtendedS3DestinationConfiguration) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpEndpointDestinationConfiguration) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ExtendedS3DestinationConfiguration) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ExtendedS3DestinationConfiguration"}
-	if s.BucketARN == nil {
-		invalidParams.Add(request.NewErrParamRequired("BucketARN"))
-	}
-	if s.BucketARN != nil && len(*s.BucketARN) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("BucketARN", 1))
-	}
-	if s.RoleARN == nil {
-		invalidParams.Add(request.NewErrParamRequired("RoleARN"))
+func (s *HttpEndpointDestinationConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "HttpEndpointDestinationConfiguration"}
+	if s.EndpointConfiguration == nil {
+		invalidParams.Add(request.NewErrParamRequired("EndpointConfiguration"))
 	}
 	if s.RoleARN != nil && len(*s.RoleARN) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("RoleARN", 1))
 	}
+	if s.S3Configuration == nil {
+		invalidParams.Add(request.NewErrParamRequired("S3Configuration"))
+	}
 	if s.BufferingHints != nil {
 		if err := s.BufferingHints.Validate(); err != nil {
 			invalidParams.AddNested("BufferingHints", err.(request.ErrInvalidParams))
 		}
 	}
-	if s.DataFormatConversionConfiguration != nil {
-		if err := s.DataFormatConversionConfiguration.Validate(); err != nil {
-			invalidParams.AddNested("DataFormatConversionConfiguration", err.(request.ErrInvalidParams))
-		}
-	}
-	if s.EncryptionConfiguration != nil {
-		if err := s.EncryptionConfiguration.Validate(); err != nil {
-			invalidParams.AddNested("EncryptionConfiguration", err.(request.ErrInvalidParams))
+	if s.EndpointConfiguration != nil {
+		if err := s.EndpointConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("EndpointConfiguration", err.(request.ErrInvalidParams))
 		}
 	}
 	if s.ProcessingConfiguration != nil {
@@ -2880,9 +5867,14 @@ func (s *ExtendedS3DestinationConfiguration) Validate() error {
 			invalidParams.AddNested("ProcessingConfiguration", err.(request.ErrInvalidParams))
 		}
 	}
-	if s.S3BackupConfiguration != nil {
-		if err := s.S3BackupConfiguration.Validate(); err != nil {
-			invalidParams.AddNested("S3BackupConfiguration", err.(request.ErrInvalidParams))
+	if s.RequestConfiguration != nil {
+		if err := s.RequestConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("RequestConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3Configuration != nil {
+		if err := s.S3Configuration.Validate(); err != nil {
+			invalidParams.AddNested("S3Configuration", err.(request.ErrInvalidParams))
 		}
 	}
 
@@ -2892,290 +5884,238 @@ func (s *ExtendedS3DestinationConfiguration) Validate() error {
 	return nil
 }
 
-// SetBucketARN sets the BucketARN field's value.
-func (s *ExtendedS3DestinationConfiguration) SetBucketARN(v string) *ExtendedS3DestinationConfiguration {
-	s.BucketARN = &v
-	return s
-}
-
 // SetBufferingHints sets the BufferingHints field's value.
-func (s *ExtendedS3DestinationConfiguration) SetBufferingHints(v *BufferingHints) *ExtendedS3DestinationConfiguration {
+func (s *HttpEndpointDestinationConfiguration) SetBufferingHints(v *HttpEndpointBufferingHints) *HttpEndpointDestinationConfiguration {
 	s.BufferingHints = v
 	return s
 }
 
 // SetCloudWatchLoggingOptions sets the CloudWatchLoggingOptions field's value.
-func (s *ExtendedS3DestinationConfiguration) SetCloudWatchLoggingOptions(v *CloudWatchLoggingOptions) *ExtendedS3DestinationConfiguration {
+func (s *HttpEndpointDestinationConfiguration) SetCloudWatchLoggingOptions(v *CloudWatchLoggingOptions) *HttpEndpointDestinationConfiguration {
 	s.CloudWatchLoggingOptions = v
 	return s
 }
 
-// SetCompressionFormat sets the CompressionFormat field's value.
-func (s *ExtendedS3DestinationConfiguration) SetCompressionFormat(v string) *ExtendedS3DestinationConfiguration {
-	s.CompressionFormat = &v
-	return s
-}
-
-// SetDataFormatConversionConfiguration sets the DataFormatConversionConfiguration field's value.
-func (s *ExtendedS3DestinationConfiguration) SetDataFormatConversionConfiguration(v *DataFormatConversionConfiguration) *ExtendedS3DestinationConfiguration {
-	s.DataFormatConversionConfiguration = v
-	return s
-}
-
-// SetEncryptionConfiguration sets the EncryptionConfiguration field's value.
-func (s *ExtendedS3DestinationConfiguration) SetEncryptionConfiguration(v *EncryptionConfiguration) *ExtendedS3DestinationConfiguration {
-	s.EncryptionConfiguration = v
+// SetEndpointConfiguration sets the EndpointConfiguration field's value.
+func (s *HttpEndpointDestinationConfiguration) SetEndpointConfiguration(v *HttpEndpointConfiguration) *HttpEndpointDestinationConfiguration {
+	s.EndpointConfiguration = v
 	return s
 }
 
-// SetErrorOutputPrefix sets the ErrorOutputPrefix field's value.
-func (s *ExtendedS3DestinationConfiguration) SetErrorOutputPrefix(v string) *ExtendedS3DestinationConfiguration {
-	s.ErrorOutputPrefix = &v
+// SetProcessingConfiguration sets the ProcessingConfiguration field's value.
+func (s *HttpEndpointDestinationConfiguration) SetProcessingConfiguration(v *ProcessingConfiguration) *HttpEndpointDestinationConfiguration {
+	s.ProcessingConfiguration = v
 	return s
 }
 
-// SetPrefix sets the Prefix field's value.
-func (s *ExtendedS3DestinationConfiguration) SetPrefix(v string) *ExtendedS3DestinationConfiguration {
-	s.Prefix = &v
+// SetRequestConfiguration sets the RequestConfiguration field's value.
+func (s *HttpEndpointDestinationConfiguration) SetRequestConfiguration(v *HttpEndpointRequestConfiguration) *HttpEndpointDestinationConfiguration {
+	s.RequestConfiguration = v
 	return s
 }
 
-// SetProcessingConfiguration sets the ProcessingConfiguration field's value.
-func (s *ExtendedS3DestinationConfiguration) SetProcessingConfiguration(v *ProcessingConfiguration) *ExtendedS3DestinationConfiguration {
-	s.ProcessingConfiguration = v
+// SetRetryOptions sets the RetryOptions field's value.
+func (s *HttpEndpointDestinationConfiguration) SetRetryOptions(v *HttpEndpointRetryOptions) *HttpEndpointDestinationConfiguration {
+	s.RetryOptions = v
 	return s
 }
 
 // SetRoleARN sets the RoleARN field's value.
-func (s *ExtendedS3DestinationConfiguration) SetRoleARN(v string) *ExtendedS3DestinationConfiguration {
+func (s *HttpEndpointDestinationConfiguration) SetRoleARN(v string) *HttpEndpointDestinationConfiguration {
 	s.RoleARN = &v
 	return s
 }
 
-// SetS3BackupConfiguration sets the S3BackupConfiguration field's value.
-func (s *ExtendedS3DestinationConfiguration) SetS3BackupConfiguration(v *S3DestinationConfiguration) *ExtendedS3DestinationConfiguration {
-	s.S3BackupConfiguration = v
+// SetS3BackupMode sets the S3BackupMode field's value.
+func (s *HttpEndpointDestinationConfiguration) SetS3BackupMode(v string) *HttpEndpointDestinationConfiguration {
+	s.S3BackupMode = &v
 	return s
 }
 
-// SetS3BackupMode sets the S3BackupMode field's value.
-func (s *ExtendedS3DestinationConfiguration) SetS3BackupMode(v string) *ExtendedS3DestinationConfiguration {
-	s.S3BackupMode = &v
+// SetS3Configuration sets the S3Configuration field's value.
+func (s *HttpEndpointDestinationConfiguration) SetS3Configuration(v *S3DestinationConfiguration) *HttpEndpointDestinationConfiguration {
+	s.S3Configuration = v
 	return s
 }
 
-// Describes a destination in Amazon S3.
-type ExtendedS3DestinationDescription struct {
+// Describes the HTTP endpoint destination.
+type HttpEndpointDestinationDescription struct {
 	_ struct{} `type:"structure"`
 
-	// The ARN of the S3 bucket. For more information, see Amazon Resource Names
-	// (ARNs) and AWS Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
-	//
-	// BucketARN is a required field
-	BucketARN *string `min:"1" type:"string" required:"true"`
-
-	// The buffering option.
-	//
-	// BufferingHints is a required field
-	BufferingHints *BufferingHints `type:"structure" required:"true"`
+	// Describes buffering options that can be applied to the data before it is
+	// delivered to the HTTPS endpoint destination. Kinesis Data Firehose teats
+	// these options as hints, and it might choose to use more optimal values. The
+	// SizeInMBs and IntervalInSeconds parameters are optional. However, if specify
+	// a value for one of them, you must also provide a value for the other.
+	BufferingHints *HttpEndpointBufferingHints `type:"structure"`
 
-	// The Amazon CloudWatch logging options for your delivery stream.
+	// Describes the Amazon CloudWatch logging options for your delivery stream.
 	CloudWatchLoggingOptions *CloudWatchLoggingOptions `type:"structure"`
 
-	// The compression format. If no value is specified, the default is UNCOMPRESSED.
-	//
-	// CompressionFormat is a required field
-	CompressionFormat *string `type:"string" required:"true" enum:"CompressionFormat"`
-
-	// The serializer, deserializer, and schema for converting data from the JSON
-	// format to the Parquet or ORC format before writing it to Amazon S3.
-	DataFormatConversionConfiguration *DataFormatConversionConfiguration `type:"structure"`
-
-	// The encryption configuration. If no value is specified, the default is no
-	// encryption.
-	//
-	// EncryptionConfiguration is a required field
-	EncryptionConfiguration *EncryptionConfiguration `type:"structure" required:"true"`
+	// The configuration of the specified HTTP endpoint destination.
+	EndpointConfiguration *HttpEndpointDescription `type:"structure"`
 
-	// A prefix that Kinesis Data Firehose evaluates and adds to failed records
-	// before writing them to S3. This prefix appears immediately following the
-	// bucket name.
-	ErrorOutputPrefix *string `type:"string"`
+	// Describes a data processing configuration.
+	ProcessingConfiguration *ProcessingConfiguration `type:"structure"`
 
-	// The "YYYY/MM/DD/HH" time format prefix is automatically used for delivered
-	// Amazon S3 files. You can specify an extra prefix to be added in front of
-	// the time format prefix. If the prefix ends with a slash, it appears as a
-	// folder in the S3 bucket. For more information, see Amazon S3 Object Name
-	// Format (http://docs.aws.amazon.com/firehose/latest/dev/basic-deliver.html#s3-object-name)
-	// in the Amazon Kinesis Data Firehose Developer Guide.
-	Prefix *string `type:"string"`
+	// The configuration of request sent to the HTTP endpoint specified as the destination.
+	RequestConfiguration *HttpEndpointRequestConfiguration `type:"structure"`
 
-	// The data processing configuration.
-	ProcessingConfiguration *ProcessingConfiguration `type:"structure"`
+	// Describes the retry behavior in case Kinesis Data Firehose is unable to deliver
+	// data to the specified HTTP endpoint destination, or if it doesn't receive
+	// a valid acknowledgment of receipt from the specified HTTP endpoint destination.
+	RetryOptions *HttpEndpointRetryOptions `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the AWS credentials. For more information,
-	// see Amazon Resource Names (ARNs) and AWS Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
-	//
-	// RoleARN is a required field
-	RoleARN *string `min:"1" type:"string" required:"true"`
+	// Kinesis Data Firehose uses this IAM role for all the permissions that the
+	// delivery stream needs.
+	RoleARN *string `min:"1" type:"string"`
 
-	// The configuration for backup in Amazon S3.
-	S3BackupDescription *S3DestinationDescription `type:"structure"`
+	// Describes the S3 bucket backup options for the data that Kinesis Firehose
+	// delivers to the HTTP endpoint destination. You can back up all documents
+	// (AllData) or only the documents that Kinesis Data Firehose could not deliver
+	// to the specified HTTP endpoint destination (FailedDataOnly).
+	S3BackupMode *string `type:"string" enum:"HttpEndpointS3BackupMode"`
 
-	// The Amazon S3 backup mode.
-	S3BackupMode *string `type:"string" enum:"S3BackupMode"`
+	// Describes a destination in Amazon S3.
+	S3DestinationDescription *S3DestinationDescription `type:"structure"`
 }
 
-// String returns the string representation
-func (s ExtendedS3DestinationDescription) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpEndpointDestinationDescription) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ExtendedS3DestinationDescription) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpEndpointDestinationDescription) GoString() string {
 	return s.String()
 }
 
-// SetBucketARN sets the BucketARN field's value.
-func (s *ExtendedS3DestinationDescription) SetBucketARN(v string) *ExtendedS3DestinationDescription {
-	s.BucketARN = &v
-	return s
-}
-
 // SetBufferingHints sets the BufferingHints field's value.
-func (s *ExtendedS3DestinationDescription) SetBufferingHints(v *BufferingHints) *ExtendedS3DestinationDescription {
+func (s *HttpEndpointDestinationDescription) SetBufferingHints(v *HttpEndpointBufferingHints) *HttpEndpointDestinationDescription {
 	s.BufferingHints = v
 	return s
 }
 
 // SetCloudWatchLoggingOptions sets the CloudWatchLoggingOptions field's value.
-func (s *ExtendedS3DestinationDescription) SetCloudWatchLoggingOptions(v *CloudWatchLoggingOptions) *ExtendedS3DestinationDescription {
+func (s *HttpEndpointDestinationDescription) SetCloudWatchLoggingOptions(v *CloudWatchLoggingOptions) *HttpEndpointDestinationDescription {
 	s.CloudWatchLoggingOptions = v
 	return s
 }
 
-// SetCompressionFormat sets the CompressionFormat field's value.
-func (s *ExtendedS3DestinationDescription) SetCompressionFormat(v string) *ExtendedS3DestinationDescription {
-	s.CompressionFormat = &v
-	return s
-}
-
-// SetDataFormatConversionConfiguration sets the DataFormatConversionConfiguration field's value.
-func (s *ExtendedS3DestinationDescription) SetDataFormatConversionConfiguration(v *DataFormatConversionConfiguration) *ExtendedS3DestinationDescription {
-	s.DataFormatConversionConfiguration = v
-	return s
-}
-
-// SetEncryptionConfiguration sets the EncryptionConfiguration field's value.
-func (s *ExtendedS3DestinationDescription) SetEncryptionConfiguration(v *EncryptionConfiguration) *ExtendedS3DestinationDescription {
-	s.EncryptionConfiguration = v
+// SetEndpointConfiguration sets the EndpointConfiguration field's value.
+func (s *HttpEndpointDestinationDescription) SetEndpointConfiguration(v *HttpEndpointDescription) *HttpEndpointDestinationDescription {
+	s.EndpointConfiguration = v
 	return s
 }
 
-// SetErrorOutputPrefix sets the ErrorOutputPrefix field's value.
-func (s *ExtendedS3DestinationDescription) SetErrorOutputPrefix(v string) *ExtendedS3DestinationDescription {
-	s.ErrorOutputPrefix = &v
+// SetProcessingConfiguration sets the ProcessingConfiguration field's value.
+func (s *HttpEndpointDestinationDescription) SetProcessingConfiguration(v *ProcessingConfiguration) *HttpEndpointDestinationDescription {
+	s.ProcessingConfiguration = v
 	return s
 }
 
-// SetPrefix sets the Prefix field's value.
-func (s *ExtendedS3DestinationDescription) SetPrefix(v string) *ExtendedS3DestinationDescription {
-	s.Prefix = &v
+// SetRequestConfiguration sets the RequestConfiguration field's value.
+func (s *HttpEndpointDestinationDescription) SetRequestConfiguration(v *HttpEndpointRequestConfiguration) *HttpEndpointDestinationDescription {
+	s.RequestConfiguration = v
 	return s
 }
 
-// SetProcessingConfiguration sets the ProcessingConfiguration field's value.
-func (s *ExtendedS3DestinationDescription) SetProcessingConfiguration(v *ProcessingConfiguration) *ExtendedS3DestinationDescription {
-	s.ProcessingConfiguration = v
+// SetRetryOptions sets the RetryOptions field's value.
+func (s *HttpEndpointDestinationDescription) SetRetryOptions(v *HttpEndpointRetryOptions) *HttpEndpointDestinationDescription {
+	s.RetryOptions = v
 	return s
 }
 
 // SetRoleARN sets the RoleARN field's value.
-func (s *ExtendedS3DestinationDescription) SetRoleARN(v string) *ExtendedS3DestinationDescription {
+func (s *HttpEndpointDestinationDescription) SetRoleARN(v string) *HttpEndpointDestinationDescription {
 	s.RoleARN = &v
 	return s
 }
 
-// SetS3BackupDescription sets the S3BackupDescription field's value.
-func (s *ExtendedS3DestinationDescription) SetS3BackupDescription(v *S3DestinationDescription) *ExtendedS3DestinationDescription {
-	s.S3BackupDescription = v
+// SetS3BackupMode sets the S3BackupMode field's value.
+func (s *HttpEndpointDestinationDescription) SetS3BackupMode(v string) *HttpEndpointDestinationDescription {
+	s.S3BackupMode = &v
 	return s
 }
 
-// SetS3BackupMode sets the S3BackupMode field's value.
-func (s *ExtendedS3DestinationDescription) SetS3BackupMode(v string) *ExtendedS3DestinationDescription {
-	s.S3BackupMode = &v
+// SetS3DestinationDescription sets the S3DestinationDescription field's value.
+func (s *HttpEndpointDestinationDescription) SetS3DestinationDescription(v *S3DestinationDescription) *HttpEndpointDestinationDescription {
+	s.S3DestinationDescription = v
 	return s
 }
 
-// Describes an update for a destination in Amazon S3.
-type ExtendedS3DestinationUpdate struct {
+// Updates the specified HTTP endpoint destination.
+type HttpEndpointDestinationUpdate struct {
 	_ struct{} `type:"structure"`
 
-	// The ARN of the S3 bucket. For more information, see Amazon Resource Names
-	// (ARNs) and AWS Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
-	BucketARN *string `min:"1" type:"string"`
-
-	// The buffering option.
-	BufferingHints *BufferingHints `type:"structure"`
+	// Describes buffering options that can be applied to the data before it is
+	// delivered to the HTTPS endpoint destination. Kinesis Data Firehose teats
+	// these options as hints, and it might choose to use more optimal values. The
+	// SizeInMBs and IntervalInSeconds parameters are optional. However, if specify
+	// a value for one of them, you must also provide a value for the other.
+	BufferingHints *HttpEndpointBufferingHints `type:"structure"`
 
-	// The Amazon CloudWatch logging options for your delivery stream.
+	// Describes the Amazon CloudWatch logging options for your delivery stream.
 	CloudWatchLoggingOptions *CloudWatchLoggingOptions `type:"structure"`
 
-	// The compression format. If no value is specified, the default is UNCOMPRESSED.
-	CompressionFormat *string `type:"string" enum:"CompressionFormat"`
-
-	// The serializer, deserializer, and schema for converting data from the JSON
-	// format to the Parquet or ORC format before writing it to Amazon S3.
-	DataFormatConversionConfiguration *DataFormatConversionConfiguration `type:"structure"`
-
-	// The encryption configuration. If no value is specified, the default is no
-	// encryption.
-	EncryptionConfiguration *EncryptionConfiguration `type:"structure"`
+	// Describes the configuration of the HTTP endpoint destination.
+	EndpointConfiguration *HttpEndpointConfiguration `type:"structure"`
 
-	// A prefix that Kinesis Data Firehose evaluates and adds to failed records
-	// before writing them to S3. This prefix appears immediately following the
-	// bucket name.
-	ErrorOutputPrefix *string `type:"string"`
+	// Describes a data processing configuration.
+	ProcessingConfiguration *ProcessingConfiguration `type:"structure"`
 
-	// The "YYYY/MM/DD/HH" time format prefix is automatically used for delivered
-	// Amazon S3 files. You can specify an extra prefix to be added in front of
-	// the time format prefix. If the prefix ends with a slash, it appears as a
-	// folder in the S3 bucket. For more information, see Amazon S3 Object Name
-	// Format (http://docs.aws.amazon.com/firehose/latest/dev/basic-deliver.html#s3-object-name)
-	// in the Amazon Kinesis Data Firehose Developer Guide.
-	Prefix *string `type:"string"`
+	// The configuration of the request sent to the HTTP endpoint specified as the
+	// destination.
+	RequestConfiguration *HttpEndpointRequestConfiguration `type:"structure"`
 
-	// The data processing configuration.
-	ProcessingConfiguration *ProcessingConfiguration `type:"structure"`
+	// Describes the retry behavior in case Kinesis Data Firehose is unable to deliver
+	// data to the specified HTTP endpoint destination, or if it doesn't receive
+	// a valid acknowledgment of receipt from the specified HTTP endpoint destination.
+	RetryOptions *HttpEndpointRetryOptions `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the AWS credentials. For more information,
-	// see Amazon Resource Names (ARNs) and AWS Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	// Kinesis Data Firehose uses this IAM role for all the permissions that the
+	// delivery stream needs.
 	RoleARN *string `min:"1" type:"string"`
 
-	// Enables or disables Amazon S3 backup mode.
-	S3BackupMode *string `type:"string" enum:"S3BackupMode"`
+	// Describes the S3 bucket backup options for the data that Kinesis Firehose
+	// delivers to the HTTP endpoint destination. You can back up all documents
+	// (AllData) or only the documents that Kinesis Data Firehose could not deliver
+	// to the specified HTTP endpoint destination (FailedDataOnly).
+	S3BackupMode *string `type:"string" enum:"HttpEndpointS3BackupMode"`
 
-	// The Amazon S3 destination for backup.
-	S3BackupUpdate *S3DestinationUpdate `type:"structure"`
+	// Describes an update for a destination in Amazon S3.
+	S3Update *S3DestinationUpdate `type:"structure"`
 }
 
-// String returns the string representation
-func (s ExtendedS3DestinationUpdate) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpEndpointDestinationUpdate) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ExtendedS3DestinationUpdate) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpEndpointDestinationUpdate) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ExtendedS3DestinationUpdate) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ExtendedS3DestinationUpdate"}
-	if s.BucketARN != nil && len(*s.BucketARN) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("BucketARN", 1))
-	}
+func (s *HttpEndpointDestinationUpdate) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "HttpEndpointDestinationUpdate"}
 	if s.RoleARN != nil && len(*s.RoleARN) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("RoleARN", 1))
 	}
@@ -3184,14 +6124,9 @@ func (s *ExtendedS3DestinationUpdate) Validate() error {
 			invalidParams.AddNested("BufferingHints", err.(request.ErrInvalidParams))
 		}
 	}
-	if s.DataFormatConversionConfiguration != nil {
-		if err := s.DataFormatConversionConfiguration.Validate(); err != nil {
-			invalidParams.AddNested("DataFormatConversionConfiguration", err.(request.ErrInvalidParams))
-		}
-	}
-	if s.EncryptionConfiguration != nil {
-		if err := s.EncryptionConfiguration.Validate(); err != nil {
-			invalidParams.AddNested("EncryptionConfiguration", err.(request.ErrInvalidParams))
+	if s.EndpointConfiguration != nil {
+		if err := s.EndpointConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("EndpointConfiguration", err.(request.ErrInvalidParams))
 		}
 	}
 	if s.ProcessingConfiguration != nil {
@@ -3199,9 +6134,14 @@ func (s *ExtendedS3DestinationUpdate) Validate() error {
 			invalidParams.AddNested("ProcessingConfiguration", err.(request.ErrInvalidParams))
 		}
 	}
-	if s.S3BackupUpdate != nil {
-		if err := s.S3BackupUpdate.Validate(); err != nil {
-			invalidParams.AddNested("S3BackupUpdate", err.(request.ErrInvalidParams))
+	if s.RequestConfiguration != nil {
+		if err := s.RequestConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("RequestConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3Update != nil {
+		if err := s.S3Update.Validate(); err != nil {
+			invalidParams.AddNested("S3Update", err.(request.ErrInvalidParams))
 		}
 	}
 
@@ -3211,114 +6151,164 @@ func (s *ExtendedS3DestinationUpdate) Validate() error {
 	return nil
 }
 
-// SetBucketARN sets the BucketARN field's value.
-func (s *ExtendedS3DestinationUpdate) SetBucketARN(v string) *ExtendedS3DestinationUpdate {
-	s.BucketARN = &v
-	return s
-}
-
 // SetBufferingHints sets the BufferingHints field's value.
-func (s *ExtendedS3DestinationUpdate) SetBufferingHints(v *BufferingHints) *ExtendedS3DestinationUpdate {
+func (s *HttpEndpointDestinationUpdate) SetBufferingHints(v *HttpEndpointBufferingHints) *HttpEndpointDestinationUpdate {
 	s.BufferingHints = v
 	return s
-}
-
-// SetCloudWatchLoggingOptions sets the CloudWatchLoggingOptions field's value.
-func (s *ExtendedS3DestinationUpdate) SetCloudWatchLoggingOptions(v *CloudWatchLoggingOptions) *ExtendedS3DestinationUpdate {
-	s.CloudWatchLoggingOptions = v
-	return s
-}
-
-// SetCompressionFormat sets the CompressionFormat field's value.
-func (s *ExtendedS3DestinationUpdate) SetCompressionFormat(v string) *ExtendedS3DestinationUpdate {
-	s.CompressionFormat = &v
-	return s
-}
-
-// SetDataFormatConversionConfiguration sets the DataFormatConversionConfiguration field's value.
-func (s *ExtendedS3DestinationUpdate) SetDataFormatConversionConfiguration(v *DataFormatConversionConfiguration) *ExtendedS3DestinationUpdate {
-	s.DataFormatConversionConfiguration = v
+}
+
+// SetCloudWatchLoggingOptions sets the CloudWatchLoggingOptions field's value.
+func (s *HttpEndpointDestinationUpdate) SetCloudWatchLoggingOptions(v *CloudWatchLoggingOptions) *HttpEndpointDestinationUpdate {
+	s.CloudWatchLoggingOptions = v
 	return s
 }
 
-// SetEncryptionConfiguration sets the EncryptionConfiguration field's value.
-func (s *ExtendedS3DestinationUpdate) SetEncryptionConfiguration(v *EncryptionConfiguration) *ExtendedS3DestinationUpdate {
-	s.EncryptionConfiguration = v
+// SetEndpointConfiguration sets the EndpointConfiguration field's value.
+func (s *HttpEndpointDestinationUpdate) SetEndpointConfiguration(v *HttpEndpointConfiguration) *HttpEndpointDestinationUpdate {
+	s.EndpointConfiguration = v
 	return s
 }
 
-// SetErrorOutputPrefix sets the ErrorOutputPrefix field's value.
-func (s *ExtendedS3DestinationUpdate) SetErrorOutputPrefix(v string) *ExtendedS3DestinationUpdate {
-	s.ErrorOutputPrefix = &v
+// SetProcessingConfiguration sets the ProcessingConfiguration field's value.
+func (s *HttpEndpointDestinationUpdate) SetProcessingConfiguration(v *ProcessingConfiguration) *HttpEndpointDestinationUpdate {
+	s.ProcessingConfiguration = v
 	return s
 }
 
-// SetPrefix sets the Prefix field's value.
-func (s *ExtendedS3DestinationUpdate) SetPrefix(v string) *ExtendedS3DestinationUpdate {
-	s.Prefix = &v
+// SetRequestConfiguration sets the RequestConfiguration field's value.
+func (s *HttpEndpointDestinationUpdate) SetRequestConfiguration(v *HttpEndpointRequestConfiguration) *HttpEndpointDestinationUpdate {
+	s.RequestConfiguration = v
 	return s
 }
 
-// SetProcessingConfiguration sets the ProcessingConfiguration field's value.
-func (s *ExtendedS3DestinationUpdate) SetProcessingConfiguration(v *ProcessingConfiguration) *ExtendedS3DestinationUpdate {
-	s.ProcessingConfiguration = v
+// SetRetryOptions sets the RetryOptions field's value.
+func (s *HttpEndpointDestinationUpdate) SetRetryOptions(v *HttpEndpointRetryOptions) *HttpEndpointDestinationUpdate {
+	s.RetryOptions = v
 	return s
 }
 
 // SetRoleARN sets the RoleARN field's value.
-func (s *ExtendedS3DestinationUpdate) SetRoleARN(v string) *ExtendedS3DestinationUpdate {
+func (s *HttpEndpointDestinationUpdate) SetRoleARN(v string) *HttpEndpointDestinationUpdate {
 	s.RoleARN = &v
 	return s
 }
 
 // SetS3BackupMode sets the S3BackupMode field's value.
-func (s *ExtendedS3DestinationUpdate) SetS3BackupMode(v string) *ExtendedS3DestinationUpdate {
+func (s *HttpEndpointDestinationUpdate) SetS3BackupMode(v string) *HttpEndpointDestinationUpdate {
 	s.S3BackupMode = &v
 	return s
 }
 
-// SetS3BackupUpdate sets the S3BackupUpdate field's value.
-func (s *ExtendedS3DestinationUpdate) SetS3BackupUpdate(v *S3DestinationUpdate) *ExtendedS3DestinationUpdate {
-	s.S3BackupUpdate = v
+// SetS3Update sets the S3Update field's value.
+func (s *HttpEndpointDestinationUpdate) SetS3Update(v *S3DestinationUpdate) *HttpEndpointDestinationUpdate {
+	s.S3Update = v
 	return s
 }
 
-// The native Hive / HCatalog JsonSerDe. Used by Kinesis Data Firehose for deserializing
-// data, which means converting it from the JSON format in preparation for serializing
-// it to the Parquet or ORC format. This is one of two deserializers you can
-// choose, depending on which one offers the functionality you need. The other
-// option is the OpenX SerDe.
-type HiveJsonSerDe struct {
+// The configuration of the HTTP endpoint request.
+type HttpEndpointRequestConfiguration struct {
 	_ struct{} `type:"structure"`
 
-	// Indicates how you want Kinesis Data Firehose to parse the date and timestamps
-	// that may be present in your input data JSON. To specify these format strings,
-	// follow the pattern syntax of JodaTime's DateTimeFormat format strings. For
-	// more information, see Class DateTimeFormat (https://www.joda.org/joda-time/apidocs/org/joda/time/format/DateTimeFormat.html).
-	// You can also use the special value millis to parse timestamps in epoch milliseconds.
-	// If you don't specify a format, Kinesis Data Firehose uses java.sql.Timestamp::valueOf
-	// by default.
-	TimestampFormats []*string `type:"list"`
+	// Describes the metadata sent to the HTTP endpoint destination.
+	CommonAttributes []*HttpEndpointCommonAttribute `type:"list"`
+
+	// Kinesis Data Firehose uses the content encoding to compress the body of a
+	// request before sending the request to the destination. For more information,
+	// see Content-Encoding (https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Content-Encoding)
+	// in MDN Web Docs, the official Mozilla documentation.
+	ContentEncoding *string `type:"string" enum:"ContentEncoding"`
 }
 
-// String returns the string representation
-func (s HiveJsonSerDe) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpEndpointRequestConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s HiveJsonSerDe) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpEndpointRequestConfiguration) GoString() string {
 	return s.String()
 }
 
-// SetTimestampFormats sets the TimestampFormats field's value.
-func (s *HiveJsonSerDe) SetTimestampFormats(v []*string) *HiveJsonSerDe {
-	s.TimestampFormats = v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *HttpEndpointRequestConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "HttpEndpointRequestConfiguration"}
+	if s.CommonAttributes != nil {
+		for i, v := range s.CommonAttributes {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "CommonAttributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCommonAttributes sets the CommonAttributes field's value.
+func (s *HttpEndpointRequestConfiguration) SetCommonAttributes(v []*HttpEndpointCommonAttribute) *HttpEndpointRequestConfiguration {
+	s.CommonAttributes = v
+	return s
+}
+
+// SetContentEncoding sets the ContentEncoding field's value.
+func (s *HttpEndpointRequestConfiguration) SetContentEncoding(v string) *HttpEndpointRequestConfiguration {
+	s.ContentEncoding = &v
+	return s
+}
+
+// Describes the retry behavior in case Kinesis Data Firehose is unable to deliver
+// data to the specified HTTP endpoint destination, or if it doesn't receive
+// a valid acknowledgment of receipt from the specified HTTP endpoint destination.
+type HttpEndpointRetryOptions struct {
+	_ struct{} `type:"structure"`
+
+	// The total amount of time that Kinesis Data Firehose spends on retries. This
+	// duration starts after the initial attempt to send data to the custom destination
+	// via HTTPS endpoint fails. It doesn't include the periods during which Kinesis
+	// Data Firehose waits for acknowledgment from the specified destination after
+	// each attempt.
+	DurationInSeconds *int64 `type:"integer"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpEndpointRetryOptions) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HttpEndpointRetryOptions) GoString() string {
+	return s.String()
+}
+
+// SetDurationInSeconds sets the DurationInSeconds field's value.
+func (s *HttpEndpointRetryOptions) SetDurationInSeconds(v int64) *HttpEndpointRetryOptions {
+	s.DurationInSeconds = &v
 	return s
 }
 
 // Specifies the deserializer you want to use to convert the format of the input
-// data.
+// data. This parameter is required if Enabled is set to true.
 type InputFormatConfiguration struct {
 	_ struct{} `type:"structure"`
 
@@ -3328,12 +6318,20 @@ type InputFormatConfiguration struct {
 	Deserializer *Deserializer `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InputFormatConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InputFormatConfiguration) GoString() string {
 	return s.String()
 }
@@ -3344,24 +6342,167 @@ func (s *InputFormatConfiguration) SetDeserializer(v *Deserializer) *InputFormat
 	return s
 }
 
+// The specified input parameter has a value that is not valid.
+type InvalidArgumentException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// A message that provides information about the error.
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidArgumentException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidArgumentException) GoString() string {
+	return s.String()
+}
+
+func newErrorInvalidArgumentException(v protocol.ResponseMetadata) error {
+	return &InvalidArgumentException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InvalidArgumentException) Code() string {
+	return "InvalidArgumentException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidArgumentException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidArgumentException) OrigErr() error {
+	return nil
+}
+
+func (s *InvalidArgumentException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidArgumentException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidArgumentException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Kinesis Data Firehose throws this exception when an attempt to put records
+// or to start or stop delivery stream encryption fails. This happens when the
+// KMS service throws one of the following exception types: AccessDeniedException,
+// InvalidStateException, DisabledException, or NotFoundException.
+type InvalidKMSResourceException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Code_ *string `locationName:"code" type:"string"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidKMSResourceException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidKMSResourceException) GoString() string {
+	return s.String()
+}
+
+func newErrorInvalidKMSResourceException(v protocol.ResponseMetadata) error {
+	return &InvalidKMSResourceException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InvalidKMSResourceException) Code() string {
+	return "InvalidKMSResourceException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidKMSResourceException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidKMSResourceException) OrigErr() error {
+	return nil
+}
+
+func (s *InvalidKMSResourceException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidKMSResourceException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidKMSResourceException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Describes an encryption key for a destination in Amazon S3.
 type KMSEncryptionConfig struct {
 	_ struct{} `type:"structure"`
 
 	// The Amazon Resource Name (ARN) of the encryption key. Must belong to the
-	// same AWS Region as the destination Amazon S3 bucket. For more information,
-	// see Amazon Resource Names (ARNs) and AWS Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	// same Amazon Web Services Region as the destination Amazon S3 bucket. For
+	// more information, see Amazon Resource Names (ARNs) and Amazon Web Services
+	// Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
 	//
 	// AWSKMSKeyARN is a required field
 	AWSKMSKeyARN *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s KMSEncryptionConfig) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s KMSEncryptionConfig) GoString() string {
 	return s.String()
 }
@@ -3400,19 +6541,27 @@ type KinesisStreamSourceConfiguration struct {
 	KinesisStreamARN *string `min:"1" type:"string" required:"true"`
 
 	// The ARN of the role that provides access to the source Kinesis data stream.
-	// For more information, see AWS Identity and Access Management (IAM) ARN Format
-	// (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html#arn-syntax-iam).
+	// For more information, see Amazon Web Services Identity and Access Management
+	// (IAM) ARN Format (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html#arn-syntax-iam).
 	//
 	// RoleARN is a required field
 	RoleARN *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s KinesisStreamSourceConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s KinesisStreamSourceConfiguration) GoString() string {
 	return s.String()
 }
@@ -3465,16 +6614,24 @@ type KinesisStreamSourceDescription struct {
 	KinesisStreamARN *string `min:"1" type:"string"`
 
 	// The ARN of the role used by the source Kinesis data stream. For more information,
-	// see AWS Identity and Access Management (IAM) ARN Format (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html#arn-syntax-iam).
+	// see Amazon Web Services Identity and Access Management (IAM) ARN Format (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html#arn-syntax-iam).
 	RoleARN *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s KinesisStreamSourceDescription) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s KinesisStreamSourceDescription) GoString() string {
 	return s.String()
 }
@@ -3497,6 +6654,71 @@ func (s *KinesisStreamSourceDescription) SetRoleARN(v string) *KinesisStreamSour
 	return s
 }
 
+// You have already reached the limit for a requested resource.
+type LimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// A message that provides information about the error.
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) GoString() string {
+	return s.String()
+}
+
+func newErrorLimitExceededException(v protocol.ResponseMetadata) error {
+	return &LimitExceededException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *LimitExceededException) Code() string {
+	return "LimitExceededException"
+}
+
+// Message returns the exception's message.
+func (s *LimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *LimitExceededException) OrigErr() error {
+	return nil
+}
+
+func (s *LimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *LimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *LimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 type ListDeliveryStreamsInput struct {
 	_ struct{} `type:"structure"`
 
@@ -3520,12 +6742,20 @@ type ListDeliveryStreamsInput struct {
 	Limit *int64 `min:"1" type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDeliveryStreamsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDeliveryStreamsInput) GoString() string {
 	return s.String()
 }
@@ -3578,12 +6808,20 @@ type ListDeliveryStreamsOutput struct {
 	HasMoreDeliveryStreams *bool `type:"boolean" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDeliveryStreamsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDeliveryStreamsOutput) GoString() string {
 	return s.String()
 }
@@ -3619,12 +6857,20 @@ type ListTagsForDeliveryStreamInput struct {
 	Limit *int64 `min:"1" type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForDeliveryStreamInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForDeliveryStreamInput) GoString() string {
 	return s.String()
 }
@@ -3686,25 +6932,181 @@ type ListTagsForDeliveryStreamOutput struct {
 	Tags []*Tag `type:"list" required:"true"`
 }
 
-// String returns the string representation
-func (s ListTagsForDeliveryStreamOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForDeliveryStreamOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForDeliveryStreamOutput) GoString() string {
+	return s.String()
+}
+
+// SetHasMoreTags sets the HasMoreTags field's value.
+func (s *ListTagsForDeliveryStreamOutput) SetHasMoreTags(v bool) *ListTagsForDeliveryStreamOutput {
+	s.HasMoreTags = &v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *ListTagsForDeliveryStreamOutput) SetTags(v []*Tag) *ListTagsForDeliveryStreamOutput {
+	s.Tags = v
+	return s
+}
+
+// The configuration for the Amazon MSK cluster to be used as the source for
+// a delivery stream.
+type MSKSourceConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	// The authentication configuration of the Amazon MSK cluster.
+	//
+	// AuthenticationConfiguration is a required field
+	AuthenticationConfiguration *AuthenticationConfiguration `type:"structure" required:"true"`
+
+	// The ARN of the Amazon MSK cluster.
+	//
+	// MSKClusterARN is a required field
+	MSKClusterARN *string `min:"1" type:"string" required:"true"`
+
+	// The topic name within the Amazon MSK cluster.
+	//
+	// TopicName is a required field
+	TopicName *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MSKSourceConfiguration) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MSKSourceConfiguration) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *MSKSourceConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "MSKSourceConfiguration"}
+	if s.AuthenticationConfiguration == nil {
+		invalidParams.Add(request.NewErrParamRequired("AuthenticationConfiguration"))
+	}
+	if s.MSKClusterARN == nil {
+		invalidParams.Add(request.NewErrParamRequired("MSKClusterARN"))
+	}
+	if s.MSKClusterARN != nil && len(*s.MSKClusterARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("MSKClusterARN", 1))
+	}
+	if s.TopicName == nil {
+		invalidParams.Add(request.NewErrParamRequired("TopicName"))
+	}
+	if s.TopicName != nil && len(*s.TopicName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("TopicName", 1))
+	}
+	if s.AuthenticationConfiguration != nil {
+		if err := s.AuthenticationConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("AuthenticationConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAuthenticationConfiguration sets the AuthenticationConfiguration field's value.
+func (s *MSKSourceConfiguration) SetAuthenticationConfiguration(v *AuthenticationConfiguration) *MSKSourceConfiguration {
+	s.AuthenticationConfiguration = v
+	return s
+}
+
+// SetMSKClusterARN sets the MSKClusterARN field's value.
+func (s *MSKSourceConfiguration) SetMSKClusterARN(v string) *MSKSourceConfiguration {
+	s.MSKClusterARN = &v
+	return s
+}
+
+// SetTopicName sets the TopicName field's value.
+func (s *MSKSourceConfiguration) SetTopicName(v string) *MSKSourceConfiguration {
+	s.TopicName = &v
+	return s
+}
+
+// Details about the Amazon MSK cluster used as the source for a Kinesis Data
+// Firehose delivery stream.
+type MSKSourceDescription struct {
+	_ struct{} `type:"structure"`
+
+	// The authentication configuration of the Amazon MSK cluster.
+	AuthenticationConfiguration *AuthenticationConfiguration `type:"structure"`
+
+	// Kinesis Data Firehose starts retrieving records from the topic within the
+	// Amazon MSK cluster starting with this timestamp.
+	DeliveryStartTimestamp *time.Time `type:"timestamp"`
+
+	// The ARN of the Amazon MSK cluster.
+	MSKClusterARN *string `min:"1" type:"string"`
+
+	// The topic name within the Amazon MSK cluster.
+	TopicName *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MSKSourceDescription) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListTagsForDeliveryStreamOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MSKSourceDescription) GoString() string {
 	return s.String()
 }
 
-// SetHasMoreTags sets the HasMoreTags field's value.
-func (s *ListTagsForDeliveryStreamOutput) SetHasMoreTags(v bool) *ListTagsForDeliveryStreamOutput {
-	s.HasMoreTags = &v
+// SetAuthenticationConfiguration sets the AuthenticationConfiguration field's value.
+func (s *MSKSourceDescription) SetAuthenticationConfiguration(v *AuthenticationConfiguration) *MSKSourceDescription {
+	s.AuthenticationConfiguration = v
 	return s
 }
 
-// SetTags sets the Tags field's value.
-func (s *ListTagsForDeliveryStreamOutput) SetTags(v []*Tag) *ListTagsForDeliveryStreamOutput {
-	s.Tags = v
+// SetDeliveryStartTimestamp sets the DeliveryStartTimestamp field's value.
+func (s *MSKSourceDescription) SetDeliveryStartTimestamp(v time.Time) *MSKSourceDescription {
+	s.DeliveryStartTimestamp = &v
+	return s
+}
+
+// SetMSKClusterARN sets the MSKClusterARN field's value.
+func (s *MSKSourceDescription) SetMSKClusterARN(v string) *MSKSourceDescription {
+	s.MSKClusterARN = &v
+	return s
+}
+
+// SetTopicName sets the TopicName field's value.
+func (s *MSKSourceDescription) SetTopicName(v string) *MSKSourceDescription {
+	s.TopicName = &v
 	return s
 }
 
@@ -3736,12 +7138,20 @@ type OpenXJsonSerDe struct {
 	ConvertDotsInJsonKeysToUnderscores *bool `type:"boolean"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OpenXJsonSerDe) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OpenXJsonSerDe) GoString() string {
 	return s.String()
 }
@@ -3826,12 +7236,20 @@ type OrcSerDe struct {
 	StripeSizeBytes *int64 `min:"8.388608e+06" type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OrcSerDe) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OrcSerDe) GoString() string {
 	return s.String()
 }
@@ -3916,7 +7334,8 @@ func (s *OrcSerDe) SetStripeSizeBytes(v int64) *OrcSerDe {
 }
 
 // Specifies the serializer that you want Kinesis Data Firehose to use to convert
-// the format of your data before it writes it to Amazon S3.
+// the format of your data before it writes it to Amazon S3. This parameter
+// is required if Enabled is set to true.
 type OutputFormatConfiguration struct {
 	_ struct{} `type:"structure"`
 
@@ -3925,12 +7344,20 @@ type OutputFormatConfiguration struct {
 	Serializer *Serializer `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OutputFormatConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OutputFormatConfiguration) GoString() string {
 	return s.String()
 }
@@ -3969,7 +7396,7 @@ type ParquetSerDe struct {
 
 	// The compression code to use over data blocks. The possible values are UNCOMPRESSED,
 	// SNAPPY, and GZIP, with the default being SNAPPY. Use SNAPPY for higher decompression
-	// speed. Use GZIP if the compression ration is more important than speed.
+	// speed. Use GZIP if the compression ratio is more important than speed.
 	Compression *string `type:"string" enum:"ParquetCompression"`
 
 	// Indicates whether to enable dictionary compression.
@@ -3989,12 +7416,20 @@ type ParquetSerDe struct {
 	WriterVersion *string `type:"string" enum:"ParquetWriterVersion"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ParquetSerDe) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ParquetSerDe) GoString() string {
 	return s.String()
 }
@@ -4062,12 +7497,20 @@ type ProcessingConfiguration struct {
 	Processors []*Processor `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProcessingConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProcessingConfiguration) GoString() string {
 	return s.String()
 }
@@ -4117,12 +7560,20 @@ type Processor struct {
 	Type *string `type:"string" required:"true" enum:"ProcessorType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Processor) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Processor) GoString() string {
 	return s.String()
 }
@@ -4166,7 +7617,11 @@ func (s *Processor) SetType(v string) *Processor {
 type ProcessorParameter struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the parameter.
+	// The name of the parameter. Currently the following default values are supported:
+	// 3 for NumberOfRetries and 60 for the BufferIntervalInSeconds. The BufferSizeInMBs
+	// ranges between 0.2 MB and up to 3MB. The default buffering hint is 1MB for
+	// all destinations, except Splunk. For Splunk, the default buffering hint is
+	// 256 KB.
 	//
 	// ParameterName is a required field
 	ParameterName *string `type:"string" required:"true" enum:"ProcessorParameterName"`
@@ -4177,12 +7632,20 @@ type ProcessorParameter struct {
 	ParameterValue *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProcessorParameter) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProcessorParameter) GoString() string {
 	return s.String()
 }
@@ -4232,12 +7695,20 @@ type PutRecordBatchInput struct {
 	Records []*Record `min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutRecordBatchInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutRecordBatchInput) GoString() string {
 	return s.String()
 }
@@ -4306,12 +7777,20 @@ type PutRecordBatchOutput struct {
 	RequestResponses []*PutRecordBatchResponseEntry `min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutRecordBatchOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutRecordBatchOutput) GoString() string {
 	return s.String()
 }
@@ -4351,12 +7830,20 @@ type PutRecordBatchResponseEntry struct {
 	RecordId *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutRecordBatchResponseEntry) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutRecordBatchResponseEntry) GoString() string {
 	return s.String()
 }
@@ -4393,12 +7880,20 @@ type PutRecordInput struct {
 	Record *Record `type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutRecordInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutRecordInput) GoString() string {
 	return s.String()
 }
@@ -4451,12 +7946,20 @@ type PutRecordOutput struct {
 	RecordId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutRecordOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutRecordOutput) GoString() string {
 	return s.String()
 }
@@ -4479,19 +7982,26 @@ type Record struct {
 
 	// The data blob, which is base64-encoded when the blob is serialized. The maximum
 	// size of the data blob, before base64-encoding, is 1,000 KiB.
-	//
 	// Data is automatically base64 encoded/decoded by the SDK.
 	//
 	// Data is a required field
 	Data []byte `type:"blob" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Record) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Record) GoString() string {
 	return s.String()
 }
@@ -4534,6 +8044,10 @@ type RedshiftDestinationConfiguration struct {
 
 	// The user password.
 	//
+	// Password is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by RedshiftDestinationConfiguration's
+	// String and GoString methods.
+	//
 	// Password is a required field
 	Password *string `min:"6" type:"string" required:"true" sensitive:"true"`
 
@@ -4544,8 +8058,9 @@ type RedshiftDestinationConfiguration struct {
 	// to Amazon Redshift. Default value is 3600 (60 minutes).
 	RetryOptions *RedshiftRetryOptions `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the AWS credentials. For more information,
-	// see Amazon Resource Names (ARNs) and AWS Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	// The Amazon Resource Name (ARN) of the Amazon Web Services credentials. For
+	// more information, see Amazon Resource Names (ARNs) and Amazon Web Services
+	// Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
 	//
 	// RoleARN is a required field
 	RoleARN *string `min:"1" type:"string" required:"true"`
@@ -4553,7 +8068,9 @@ type RedshiftDestinationConfiguration struct {
 	// The configuration for backup in Amazon S3.
 	S3BackupConfiguration *S3DestinationConfiguration `type:"structure"`
 
-	// The Amazon S3 backup mode.
+	// The Amazon S3 backup mode. After you create a delivery stream, you can update
+	// it to enable Amazon S3 backup if it is disabled. If backup is enabled, you
+	// can't update the delivery stream to disable it.
 	S3BackupMode *string `type:"string" enum:"RedshiftS3BackupMode"`
 
 	// The configuration for the intermediate Amazon S3 location from which Amazon
@@ -4568,16 +8085,28 @@ type RedshiftDestinationConfiguration struct {
 
 	// The name of the user.
 	//
+	// Username is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by RedshiftDestinationConfiguration's
+	// String and GoString methods.
+	//
 	// Username is a required field
 	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RedshiftDestinationConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RedshiftDestinationConfiguration) GoString() string {
 	return s.String()
 }
@@ -4732,8 +8261,9 @@ type RedshiftDestinationDescription struct {
 	// to Amazon Redshift. Default value is 3600 (60 minutes).
 	RetryOptions *RedshiftRetryOptions `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the AWS credentials. For more information,
-	// see Amazon Resource Names (ARNs) and AWS Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	// The Amazon Resource Name (ARN) of the Amazon Web Services credentials. For
+	// more information, see Amazon Resource Names (ARNs) and Amazon Web Services
+	// Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
 	//
 	// RoleARN is a required field
 	RoleARN *string `min:"1" type:"string" required:"true"`
@@ -4751,16 +8281,28 @@ type RedshiftDestinationDescription struct {
 
 	// The name of the user.
 	//
+	// Username is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by RedshiftDestinationDescription's
+	// String and GoString methods.
+	//
 	// Username is a required field
 	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RedshiftDestinationDescription) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RedshiftDestinationDescription) GoString() string {
 	return s.String()
 }
@@ -4839,6 +8381,10 @@ type RedshiftDestinationUpdate struct {
 	CopyCommand *CopyCommand `type:"structure"`
 
 	// The user password.
+	//
+	// Password is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by RedshiftDestinationUpdate's
+	// String and GoString methods.
 	Password *string `min:"6" type:"string" sensitive:"true"`
 
 	// The data processing configuration.
@@ -4848,11 +8394,13 @@ type RedshiftDestinationUpdate struct {
 	// to Amazon Redshift. Default value is 3600 (60 minutes).
 	RetryOptions *RedshiftRetryOptions `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the AWS credentials. For more information,
-	// see Amazon Resource Names (ARNs) and AWS Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	// The Amazon Resource Name (ARN) of the Amazon Web Services credentials. For
+	// more information, see Amazon Resource Names (ARNs) and Amazon Web Services
+	// Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
 	RoleARN *string `min:"1" type:"string"`
 
-	// The Amazon S3 backup mode.
+	// You can update a delivery stream to enable Amazon S3 backup if it is disabled.
+	// If backup is enabled, you can't update the delivery stream to disable it.
 	S3BackupMode *string `type:"string" enum:"RedshiftS3BackupMode"`
 
 	// The Amazon S3 destination for backup.
@@ -4866,15 +8414,27 @@ type RedshiftDestinationUpdate struct {
 	S3Update *S3DestinationUpdate `type:"structure"`
 
 	// The name of the user.
+	//
+	// Username is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by RedshiftDestinationUpdate's
+	// String and GoString methods.
 	Username *string `min:"1" type:"string" sensitive:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RedshiftDestinationUpdate) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RedshiftDestinationUpdate) GoString() string {
 	return s.String()
 }
@@ -5000,12 +8560,20 @@ type RedshiftRetryOptions struct {
 	DurationInSeconds *int64 `type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RedshiftRetryOptions) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RedshiftRetryOptions) GoString() string {
 	return s.String()
 }
@@ -5016,12 +8584,176 @@ func (s *RedshiftRetryOptions) SetDurationInSeconds(v int64) *RedshiftRetryOptio
 	return s
 }
 
+// The resource is already in use and not available for this operation.
+type ResourceInUseException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// A message that provides information about the error.
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceInUseException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceInUseException) GoString() string {
+	return s.String()
+}
+
+func newErrorResourceInUseException(v protocol.ResponseMetadata) error {
+	return &ResourceInUseException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ResourceInUseException) Code() string {
+	return "ResourceInUseException"
+}
+
+// Message returns the exception's message.
+func (s *ResourceInUseException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ResourceInUseException) OrigErr() error {
+	return nil
+}
+
+func (s *ResourceInUseException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ResourceInUseException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ResourceInUseException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The specified resource could not be found.
+type ResourceNotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// A message that provides information about the error.
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceNotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceNotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorResourceNotFoundException(v protocol.ResponseMetadata) error {
+	return &ResourceNotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ResourceNotFoundException) Code() string {
+	return "ResourceNotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *ResourceNotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ResourceNotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *ResourceNotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ResourceNotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ResourceNotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The retry behavior in case Kinesis Data Firehose is unable to deliver data
+// to an Amazon S3 prefix.
+type RetryOptions struct {
+	_ struct{} `type:"structure"`
+
+	// The period of time during which Kinesis Data Firehose retries to deliver
+	// data to the specified Amazon S3 prefix.
+	DurationInSeconds *int64 `type:"integer"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RetryOptions) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RetryOptions) GoString() string {
+	return s.String()
+}
+
+// SetDurationInSeconds sets the DurationInSeconds field's value.
+func (s *RetryOptions) SetDurationInSeconds(v int64) *RetryOptions {
+	s.DurationInSeconds = &v
+	return s
+}
+
 // Describes the configuration of a destination in Amazon S3.
 type S3DestinationConfiguration struct {
 	_ struct{} `type:"structure"`
 
 	// The ARN of the S3 bucket. For more information, see Amazon Resource Names
-	// (ARNs) and AWS Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	// (ARNs) and Amazon Web Services Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
 	//
 	// BucketARN is a required field
 	BucketARN *string `min:"1" type:"string" required:"true"`
@@ -5046,30 +8778,37 @@ type S3DestinationConfiguration struct {
 
 	// A prefix that Kinesis Data Firehose evaluates and adds to failed records
 	// before writing them to S3. This prefix appears immediately following the
-	// bucket name.
+	// bucket name. For information about how to specify this prefix, see Custom
+	// Prefixes for Amazon S3 Objects (https://docs.aws.amazon.com/firehose/latest/dev/s3-prefixes.html).
 	ErrorOutputPrefix *string `type:"string"`
 
 	// The "YYYY/MM/DD/HH" time format prefix is automatically used for delivered
-	// Amazon S3 files. You can specify an extra prefix to be added in front of
-	// the time format prefix. If the prefix ends with a slash, it appears as a
-	// folder in the S3 bucket. For more information, see Amazon S3 Object Name
-	// Format (http://docs.aws.amazon.com/firehose/latest/dev/basic-deliver.html#s3-object-name)
-	// in the Amazon Kinesis Data Firehose Developer Guide.
+	// Amazon S3 files. You can also specify a custom prefix, as described in Custom
+	// Prefixes for Amazon S3 Objects (https://docs.aws.amazon.com/firehose/latest/dev/s3-prefixes.html).
 	Prefix *string `type:"string"`
 
-	// The Amazon Resource Name (ARN) of the AWS credentials. For more information,
-	// see Amazon Resource Names (ARNs) and AWS Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	// The Amazon Resource Name (ARN) of the Amazon Web Services credentials. For
+	// more information, see Amazon Resource Names (ARNs) and Amazon Web Services
+	// Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
 	//
 	// RoleARN is a required field
 	RoleARN *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s S3DestinationConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s S3DestinationConfiguration) GoString() string {
 	return s.String()
 }
@@ -5159,7 +8898,7 @@ type S3DestinationDescription struct {
 	_ struct{} `type:"structure"`
 
 	// The ARN of the S3 bucket. For more information, see Amazon Resource Names
-	// (ARNs) and AWS Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	// (ARNs) and Amazon Web Services Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
 	//
 	// BucketARN is a required field
 	BucketARN *string `min:"1" type:"string" required:"true"`
@@ -5186,30 +8925,37 @@ type S3DestinationDescription struct {
 
 	// A prefix that Kinesis Data Firehose evaluates and adds to failed records
 	// before writing them to S3. This prefix appears immediately following the
-	// bucket name.
+	// bucket name. For information about how to specify this prefix, see Custom
+	// Prefixes for Amazon S3 Objects (https://docs.aws.amazon.com/firehose/latest/dev/s3-prefixes.html).
 	ErrorOutputPrefix *string `type:"string"`
 
 	// The "YYYY/MM/DD/HH" time format prefix is automatically used for delivered
-	// Amazon S3 files. You can specify an extra prefix to be added in front of
-	// the time format prefix. If the prefix ends with a slash, it appears as a
-	// folder in the S3 bucket. For more information, see Amazon S3 Object Name
-	// Format (http://docs.aws.amazon.com/firehose/latest/dev/basic-deliver.html#s3-object-name)
-	// in the Amazon Kinesis Data Firehose Developer Guide.
+	// Amazon S3 files. You can also specify a custom prefix, as described in Custom
+	// Prefixes for Amazon S3 Objects (https://docs.aws.amazon.com/firehose/latest/dev/s3-prefixes.html).
 	Prefix *string `type:"string"`
 
-	// The Amazon Resource Name (ARN) of the AWS credentials. For more information,
-	// see Amazon Resource Names (ARNs) and AWS Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	// The Amazon Resource Name (ARN) of the Amazon Web Services credentials. For
+	// more information, see Amazon Resource Names (ARNs) and Amazon Web Services
+	// Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
 	//
 	// RoleARN is a required field
 	RoleARN *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s S3DestinationDescription) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s S3DestinationDescription) GoString() string {
 	return s.String()
 }
@@ -5267,7 +9013,7 @@ type S3DestinationUpdate struct {
 	_ struct{} `type:"structure"`
 
 	// The ARN of the S3 bucket. For more information, see Amazon Resource Names
-	// (ARNs) and AWS Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	// (ARNs) and Amazon Web Services Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
 	BucketARN *string `min:"1" type:"string"`
 
 	// The buffering option. If no value is specified, BufferingHints object default
@@ -5290,28 +9036,35 @@ type S3DestinationUpdate struct {
 
 	// A prefix that Kinesis Data Firehose evaluates and adds to failed records
 	// before writing them to S3. This prefix appears immediately following the
-	// bucket name.
+	// bucket name. For information about how to specify this prefix, see Custom
+	// Prefixes for Amazon S3 Objects (https://docs.aws.amazon.com/firehose/latest/dev/s3-prefixes.html).
 	ErrorOutputPrefix *string `type:"string"`
 
 	// The "YYYY/MM/DD/HH" time format prefix is automatically used for delivered
-	// Amazon S3 files. You can specify an extra prefix to be added in front of
-	// the time format prefix. If the prefix ends with a slash, it appears as a
-	// folder in the S3 bucket. For more information, see Amazon S3 Object Name
-	// Format (http://docs.aws.amazon.com/firehose/latest/dev/basic-deliver.html#s3-object-name)
-	// in the Amazon Kinesis Data Firehose Developer Guide.
+	// Amazon S3 files. You can also specify a custom prefix, as described in Custom
+	// Prefixes for Amazon S3 Objects (https://docs.aws.amazon.com/firehose/latest/dev/s3-prefixes.html).
 	Prefix *string `type:"string"`
 
-	// The Amazon Resource Name (ARN) of the AWS credentials. For more information,
-	// see Amazon Resource Names (ARNs) and AWS Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
+	// The Amazon Resource Name (ARN) of the Amazon Web Services credentials. For
+	// more information, see Amazon Resource Names (ARNs) and Amazon Web Services
+	// Service Namespaces (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html).
 	RoleARN *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s S3DestinationUpdate) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s S3DestinationUpdate) GoString() string {
 	return s.String()
 }
@@ -5391,47 +9144,97 @@ func (s *S3DestinationUpdate) SetRoleARN(v string) *S3DestinationUpdate {
 }
 
 // Specifies the schema to which you want Kinesis Data Firehose to configure
-// your data before it writes it to Amazon S3.
+// your data before it writes it to Amazon S3. This parameter is required if
+// Enabled is set to true.
 type SchemaConfiguration struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the AWS Glue Data Catalog. If you don't supply this, the AWS account
-	// ID is used by default.
-	CatalogId *string `type:"string"`
-
-	// Specifies the name of the AWS Glue database that contains the schema for
-	// the output data.
-	DatabaseName *string `type:"string"`
+	// The ID of the Amazon Web Services Glue Data Catalog. If you don't supply
+	// this, the Amazon Web Services account ID is used by default.
+	CatalogId *string `min:"1" type:"string"`
 
-	// If you don't specify an AWS Region, the default is the current Region.
-	Region *string `type:"string"`
-
-	// The role that Kinesis Data Firehose can use to access AWS Glue. This role
-	// must be in the same account you use for Kinesis Data Firehose. Cross-account
-	// roles aren't allowed.
-	RoleARN *string `type:"string"`
+	// Specifies the name of the Amazon Web Services Glue database that contains
+	// the schema for the output data.
+	//
+	// If the SchemaConfiguration request parameter is used as part of invoking
+	// the CreateDeliveryStream API, then the DatabaseName property is required
+	// and its value must be specified.
+	DatabaseName *string `min:"1" type:"string"`
+
+	// If you don't specify an Amazon Web Services Region, the default is the current
+	// Region.
+	Region *string `min:"1" type:"string"`
+
+	// The role that Kinesis Data Firehose can use to access Amazon Web Services
+	// Glue. This role must be in the same account you use for Kinesis Data Firehose.
+	// Cross-account roles aren't allowed.
+	//
+	// If the SchemaConfiguration request parameter is used as part of invoking
+	// the CreateDeliveryStream API, then the RoleARN property is required and its
+	// value must be specified.
+	RoleARN *string `min:"1" type:"string"`
 
-	// Specifies the AWS Glue table that contains the column information that constitutes
-	// your data schema.
-	TableName *string `type:"string"`
+	// Specifies the Amazon Web Services Glue table that contains the column information
+	// that constitutes your data schema.
+	//
+	// If the SchemaConfiguration request parameter is used as part of invoking
+	// the CreateDeliveryStream API, then the TableName property is required and
+	// its value must be specified.
+	TableName *string `min:"1" type:"string"`
 
 	// Specifies the table version for the output data schema. If you don't specify
 	// this version ID, or if you set it to LATEST, Kinesis Data Firehose uses the
 	// most recent version. This means that any updates to the table are automatically
 	// picked up.
-	VersionId *string `type:"string"`
+	VersionId *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SchemaConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SchemaConfiguration) GoString() string {
 	return s.String()
 }
 
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *SchemaConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "SchemaConfiguration"}
+	if s.CatalogId != nil && len(*s.CatalogId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CatalogId", 1))
+	}
+	if s.DatabaseName != nil && len(*s.DatabaseName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("DatabaseName", 1))
+	}
+	if s.Region != nil && len(*s.Region) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Region", 1))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RoleARN", 1))
+	}
+	if s.TableName != nil && len(*s.TableName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("TableName", 1))
+	}
+	if s.VersionId != nil && len(*s.VersionId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VersionId", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *SchemaConfiguration) SetCatalogId(v string) *SchemaConfiguration {
 	s.CatalogId = &v
@@ -5484,12 +9287,20 @@ type Serializer struct {
 	ParquetSerDe *ParquetSerDe `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Serializer) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Serializer) GoString() string {
 	return s.String()
 }
@@ -5526,6 +9337,74 @@ func (s *Serializer) SetParquetSerDe(v *ParquetSerDe) *Serializer {
 	return s
 }
 
+// The service is unavailable. Back off and retry the operation. If you continue
+// to see the exception, throughput limits for the delivery stream may have
+// been exceeded. For more information about limits and how to request an increase,
+// see Amazon Kinesis Data Firehose Limits (https://docs.aws.amazon.com/firehose/latest/dev/limits.html).
+type ServiceUnavailableException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// A message that provides information about the error.
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceUnavailableException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceUnavailableException) GoString() string {
+	return s.String()
+}
+
+func newErrorServiceUnavailableException(v protocol.ResponseMetadata) error {
+	return &ServiceUnavailableException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ServiceUnavailableException) Code() string {
+	return "ServiceUnavailableException"
+}
+
+// Message returns the exception's message.
+func (s *ServiceUnavailableException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ServiceUnavailableException) OrigErr() error {
+	return nil
+}
+
+func (s *ServiceUnavailableException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ServiceUnavailableException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ServiceUnavailableException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Details about a Kinesis data stream used as the source for a Kinesis Data
 // Firehose delivery stream.
 type SourceDescription struct {
@@ -5533,14 +9412,26 @@ type SourceDescription struct {
 
 	// The KinesisStreamSourceDescription value for the source Kinesis data stream.
 	KinesisStreamSourceDescription *KinesisStreamSourceDescription `type:"structure"`
+
+	// The configuration description for the Amazon MSK cluster to be used as the
+	// source for a delivery stream.
+	MSKSourceDescription *MSKSourceDescription `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SourceDescription) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SourceDescription) GoString() string {
 	return s.String()
 }
@@ -5551,6 +9442,12 @@ func (s *SourceDescription) SetKinesisStreamSourceDescription(v *KinesisStreamSo
 	return s
 }
 
+// SetMSKSourceDescription sets the MSKSourceDescription field's value.
+func (s *SourceDescription) SetMSKSourceDescription(v *MSKSourceDescription) *SourceDescription {
+	s.MSKSourceDescription = v
+	return s
+}
+
 // Describes the configuration of a destination in Splunk.
 type SplunkDestinationConfiguration struct {
 	_ struct{} `type:"structure"`
@@ -5588,11 +9485,14 @@ type SplunkDestinationConfiguration struct {
 	// to Splunk, or if it doesn't receive an acknowledgment of receipt from Splunk.
 	RetryOptions *SplunkRetryOptions `type:"structure"`
 
-	// Defines how documents should be delivered to Amazon S3. When set to FailedDocumentsOnly,
+	// Defines how documents should be delivered to Amazon S3. When set to FailedEventsOnly,
 	// Kinesis Data Firehose writes any data that could not be indexed to the configured
-	// Amazon S3 destination. When set to AllDocuments, Kinesis Data Firehose delivers
+	// Amazon S3 destination. When set to AllEvents, Kinesis Data Firehose delivers
 	// all incoming records to Amazon S3, and also writes failed documents to Amazon
-	// S3. Default value is FailedDocumentsOnly.
+	// S3. The default value is FailedEventsOnly.
+	//
+	// You can update this backup mode from FailedEventsOnly to AllEvents. You can't
+	// update it from AllEvents to FailedEventsOnly.
 	S3BackupMode *string `type:"string" enum:"SplunkS3BackupMode"`
 
 	// The configuration for the backup Amazon S3 location.
@@ -5601,12 +9501,20 @@ type SplunkDestinationConfiguration struct {
 	S3Configuration *S3DestinationConfiguration `type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SplunkDestinationConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SplunkDestinationConfiguration) GoString() string {
 	return s.String()
 }
@@ -5741,12 +9649,20 @@ type SplunkDestinationDescription struct {
 	S3DestinationDescription *S3DestinationDescription `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SplunkDestinationDescription) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SplunkDestinationDescription) GoString() string {
 	return s.String()
 }
@@ -5836,23 +9752,34 @@ type SplunkDestinationUpdate struct {
 	// to Splunk or if it doesn't receive an acknowledgment of receipt from Splunk.
 	RetryOptions *SplunkRetryOptions `type:"structure"`
 
-	// Defines how documents should be delivered to Amazon S3. When set to FailedDocumentsOnly,
-	// Kinesis Data Firehose writes any data that could not be indexed to the configured
-	// Amazon S3 destination. When set to AllDocuments, Kinesis Data Firehose delivers
-	// all incoming records to Amazon S3, and also writes failed documents to Amazon
-	// S3. Default value is FailedDocumentsOnly.
+	// Specifies how you want Kinesis Data Firehose to back up documents to Amazon
+	// S3. When set to FailedDocumentsOnly, Kinesis Data Firehose writes any data
+	// that could not be indexed to the configured Amazon S3 destination. When set
+	// to AllEvents, Kinesis Data Firehose delivers all incoming records to Amazon
+	// S3, and also writes failed documents to Amazon S3. The default value is FailedEventsOnly.
+	//
+	// You can update this backup mode from FailedEventsOnly to AllEvents. You can't
+	// update it from AllEvents to FailedEventsOnly.
 	S3BackupMode *string `type:"string" enum:"SplunkS3BackupMode"`
 
 	// Your update to the configuration of the backup Amazon S3 location.
 	S3Update *S3DestinationUpdate `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SplunkDestinationUpdate) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SplunkDestinationUpdate) GoString() string {
 	return s.String()
 }
@@ -5946,12 +9873,20 @@ type SplunkRetryOptions struct {
 	DurationInSeconds *int64 `type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SplunkRetryOptions) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SplunkRetryOptions) GoString() string {
 	return s.String()
 }
@@ -5965,6 +9900,10 @@ func (s *SplunkRetryOptions) SetDurationInSeconds(v int64) *SplunkRetryOptions {
 type StartDeliveryStreamEncryptionInput struct {
 	_ struct{} `type:"structure"`
 
+	// Used to specify the type and Amazon Resource Name (ARN) of the KMS key needed
+	// for Server-Side Encryption (SSE).
+	DeliveryStreamEncryptionConfigurationInput *DeliveryStreamEncryptionConfigurationInput `type:"structure"`
+
 	// The name of the delivery stream for which you want to enable server-side
 	// encryption (SSE).
 	//
@@ -5972,12 +9911,20 @@ type StartDeliveryStreamEncryptionInput struct {
 	DeliveryStreamName *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartDeliveryStreamEncryptionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartDeliveryStreamEncryptionInput) GoString() string {
 	return s.String()
 }
@@ -5991,6 +9938,11 @@ func (s *StartDeliveryStreamEncryptionInput) Validate() error {
 	if s.DeliveryStreamName != nil && len(*s.DeliveryStreamName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("DeliveryStreamName", 1))
 	}
+	if s.DeliveryStreamEncryptionConfigurationInput != nil {
+		if err := s.DeliveryStreamEncryptionConfigurationInput.Validate(); err != nil {
+			invalidParams.AddNested("DeliveryStreamEncryptionConfigurationInput", err.(request.ErrInvalidParams))
+		}
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -5998,6 +9950,12 @@ func (s *StartDeliveryStreamEncryptionInput) Validate() error {
 	return nil
 }
 
+// SetDeliveryStreamEncryptionConfigurationInput sets the DeliveryStreamEncryptionConfigurationInput field's value.
+func (s *StartDeliveryStreamEncryptionInput) SetDeliveryStreamEncryptionConfigurationInput(v *DeliveryStreamEncryptionConfigurationInput) *StartDeliveryStreamEncryptionInput {
+	s.DeliveryStreamEncryptionConfigurationInput = v
+	return s
+}
+
 // SetDeliveryStreamName sets the DeliveryStreamName field's value.
 func (s *StartDeliveryStreamEncryptionInput) SetDeliveryStreamName(v string) *StartDeliveryStreamEncryptionInput {
 	s.DeliveryStreamName = &v
@@ -6008,12 +9966,20 @@ type StartDeliveryStreamEncryptionOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartDeliveryStreamEncryptionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartDeliveryStreamEncryptionOutput) GoString() string {
 	return s.String()
 }
@@ -6028,12 +9994,20 @@ type StopDeliveryStreamEncryptionInput struct {
 	DeliveryStreamName *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopDeliveryStreamEncryptionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopDeliveryStreamEncryptionInput) GoString() string {
 	return s.String()
 }
@@ -6064,12 +10038,20 @@ type StopDeliveryStreamEncryptionOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopDeliveryStreamEncryptionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopDeliveryStreamEncryptionOutput) GoString() string {
 	return s.String()
 }
@@ -6091,12 +10073,20 @@ type Tag struct {
 	Value *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) GoString() string {
 	return s.String()
 }
@@ -6143,12 +10133,20 @@ type TagDeliveryStreamInput struct {
 	Tags []*Tag `min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagDeliveryStreamInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagDeliveryStreamInput) GoString() string {
 	return s.String()
 }
@@ -6201,12 +10199,20 @@ type TagDeliveryStreamOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagDeliveryStreamOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagDeliveryStreamOutput) GoString() string {
 	return s.String()
 }
@@ -6225,12 +10231,20 @@ type UntagDeliveryStreamInput struct {
 	TagKeys []*string `min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagDeliveryStreamInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagDeliveryStreamInput) GoString() string {
 	return s.String()
 }
@@ -6273,12 +10287,20 @@ type UntagDeliveryStreamOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagDeliveryStreamOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagDeliveryStreamOutput) GoString() string {
 	return s.String()
 }
@@ -6286,6 +10308,13 @@ func (s UntagDeliveryStreamOutput) GoString() string {
 type UpdateDestinationInput struct {
 	_ struct{} `type:"structure"`
 
+	// Describes an update for a destination in the Serverless offering for Amazon
+	// OpenSearch Service.
+	AmazonOpenSearchServerlessDestinationUpdate *AmazonOpenSearchServerlessDestinationUpdate `type:"structure"`
+
+	// Describes an update for a destination in Amazon OpenSearch Service.
+	AmazonopensearchserviceDestinationUpdate *AmazonopensearchserviceDestinationUpdate `type:"structure"`
+
 	// Obtain this value from the VersionId result of DeliveryStreamDescription.
 	// This value is required, and helps the service perform conditional operations.
 	// For example, if there is an interleaving update and this value is null, then
@@ -6312,6 +10341,9 @@ type UpdateDestinationInput struct {
 	// Describes an update for a destination in Amazon S3.
 	ExtendedS3DestinationUpdate *ExtendedS3DestinationUpdate `type:"structure"`
 
+	// Describes an update to the specified HTTP endpoint destination.
+	HttpEndpointDestinationUpdate *HttpEndpointDestinationUpdate `type:"structure"`
+
 	// Describes an update for a destination in Amazon Redshift.
 	RedshiftDestinationUpdate *RedshiftDestinationUpdate `type:"structure"`
 
@@ -6324,12 +10356,20 @@ type UpdateDestinationInput struct {
 	SplunkDestinationUpdate *SplunkDestinationUpdate `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDestinationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDestinationInput) GoString() string {
 	return s.String()
 }
@@ -6355,6 +10395,16 @@ func (s *UpdateDestinationInput) Validate() error {
 	if s.DestinationId != nil && len(*s.DestinationId) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("DestinationId", 1))
 	}
+	if s.AmazonOpenSearchServerlessDestinationUpdate != nil {
+		if err := s.AmazonOpenSearchServerlessDestinationUpdate.Validate(); err != nil {
+			invalidParams.AddNested("AmazonOpenSearchServerlessDestinationUpdate", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.AmazonopensearchserviceDestinationUpdate != nil {
+		if err := s.AmazonopensearchserviceDestinationUpdate.Validate(); err != nil {
+			invalidParams.AddNested("AmazonopensearchserviceDestinationUpdate", err.(request.ErrInvalidParams))
+		}
+	}
 	if s.ElasticsearchDestinationUpdate != nil {
 		if err := s.ElasticsearchDestinationUpdate.Validate(); err != nil {
 			invalidParams.AddNested("ElasticsearchDestinationUpdate", err.(request.ErrInvalidParams))
@@ -6365,6 +10415,11 @@ func (s *UpdateDestinationInput) Validate() error {
 			invalidParams.AddNested("ExtendedS3DestinationUpdate", err.(request.ErrInvalidParams))
 		}
 	}
+	if s.HttpEndpointDestinationUpdate != nil {
+		if err := s.HttpEndpointDestinationUpdate.Validate(); err != nil {
+			invalidParams.AddNested("HttpEndpointDestinationUpdate", err.(request.ErrInvalidParams))
+		}
+	}
 	if s.RedshiftDestinationUpdate != nil {
 		if err := s.RedshiftDestinationUpdate.Validate(); err != nil {
 			invalidParams.AddNested("RedshiftDestinationUpdate", err.(request.ErrInvalidParams))
@@ -6387,6 +10442,18 @@ func (s *UpdateDestinationInput) Validate() error {
 	return nil
 }
 
+// SetAmazonOpenSearchServerlessDestinationUpdate sets the AmazonOpenSearchServerlessDestinationUpdate field's value.
+func (s *UpdateDestinationInput) SetAmazonOpenSearchServerlessDestinationUpdate(v *AmazonOpenSearchServerlessDestinationUpdate) *UpdateDestinationInput {
+	s.AmazonOpenSearchServerlessDestinationUpdate = v
+	return s
+}
+
+// SetAmazonopensearchserviceDestinationUpdate sets the AmazonopensearchserviceDestinationUpdate field's value.
+func (s *UpdateDestinationInput) SetAmazonopensearchserviceDestinationUpdate(v *AmazonopensearchserviceDestinationUpdate) *UpdateDestinationInput {
+	s.AmazonopensearchserviceDestinationUpdate = v
+	return s
+}
+
 // SetCurrentDeliveryStreamVersionId sets the CurrentDeliveryStreamVersionId field's value.
 func (s *UpdateDestinationInput) SetCurrentDeliveryStreamVersionId(v string) *UpdateDestinationInput {
 	s.CurrentDeliveryStreamVersionId = &v
@@ -6417,6 +10484,12 @@ func (s *UpdateDestinationInput) SetExtendedS3DestinationUpdate(v *ExtendedS3Des
 	return s
 }
 
+// SetHttpEndpointDestinationUpdate sets the HttpEndpointDestinationUpdate field's value.
+func (s *UpdateDestinationInput) SetHttpEndpointDestinationUpdate(v *HttpEndpointDestinationUpdate) *UpdateDestinationInput {
+	s.HttpEndpointDestinationUpdate = v
+	return s
+}
+
 // SetRedshiftDestinationUpdate sets the RedshiftDestinationUpdate field's value.
 func (s *UpdateDestinationInput) SetRedshiftDestinationUpdate(v *RedshiftDestinationUpdate) *UpdateDestinationInput {
 	s.RedshiftDestinationUpdate = v
@@ -6439,16 +10512,332 @@ type UpdateDestinationOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDestinationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDestinationOutput) GoString() string {
 	return s.String()
 }
 
+// The details of the VPC of the Amazon OpenSearch or Amazon OpenSearch Serverless
+// destination.
+type VpcConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the IAM role that you want the delivery stream to use to create
+	// endpoints in the destination VPC. You can use your existing Kinesis Data
+	// Firehose delivery role or you can specify a new role. In either case, make
+	// sure that the role trusts the Kinesis Data Firehose service principal and
+	// that it grants the following permissions:
+	//
+	//    * ec2:DescribeVpcs
+	//
+	//    * ec2:DescribeVpcAttribute
+	//
+	//    * ec2:DescribeSubnets
+	//
+	//    * ec2:DescribeSecurityGroups
+	//
+	//    * ec2:DescribeNetworkInterfaces
+	//
+	//    * ec2:CreateNetworkInterface
+	//
+	//    * ec2:CreateNetworkInterfacePermission
+	//
+	//    * ec2:DeleteNetworkInterface
+	//
+	// If you revoke these permissions after you create the delivery stream, Kinesis
+	// Data Firehose can't scale out by creating more ENIs when necessary. You might
+	// therefore see a degradation in performance.
+	//
+	// RoleARN is a required field
+	RoleARN *string `min:"1" type:"string" required:"true"`
+
+	// The IDs of the security groups that you want Kinesis Data Firehose to use
+	// when it creates ENIs in the VPC of the Amazon ES destination. You can use
+	// the same security group that the Amazon ES domain uses or different ones.
+	// If you specify different security groups here, ensure that they allow outbound
+	// HTTPS traffic to the Amazon ES domain's security group. Also ensure that
+	// the Amazon ES domain's security group allows HTTPS traffic from the security
+	// groups specified here. If you use the same security group for both your delivery
+	// stream and the Amazon ES domain, make sure the security group inbound rule
+	// allows HTTPS traffic. For more information about security group rules, see
+	// Security group rules (https://docs.aws.amazon.com/vpc/latest/userguide/VPC_SecurityGroups.html#SecurityGroupRules)
+	// in the Amazon VPC documentation.
+	//
+	// SecurityGroupIds is a required field
+	SecurityGroupIds []*string `min:"1" type:"list" required:"true"`
+
+	// The IDs of the subnets that you want Kinesis Data Firehose to use to create
+	// ENIs in the VPC of the Amazon ES destination. Make sure that the routing
+	// tables and inbound and outbound rules allow traffic to flow from the subnets
+	// whose IDs are specified here to the subnets that have the destination Amazon
+	// ES endpoints. Kinesis Data Firehose creates at least one ENI in each of the
+	// subnets that are specified here. Do not delete or modify these ENIs.
+	//
+	// The number of ENIs that Kinesis Data Firehose creates in the subnets specified
+	// here scales up and down automatically based on throughput. To enable Kinesis
+	// Data Firehose to scale up the number of ENIs to match throughput, ensure
+	// that you have sufficient quota. To help you calculate the quota you need,
+	// assume that Kinesis Data Firehose can create up to three ENIs for this delivery
+	// stream for each of the subnets specified here. For more information about
+	// ENI quota, see Network Interfaces (https://docs.aws.amazon.com/vpc/latest/userguide/amazon-vpc-limits.html#vpc-limits-enis)
+	// in the Amazon VPC Quotas topic.
+	//
+	// SubnetIds is a required field
+	SubnetIds []*string `min:"1" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VpcConfiguration) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VpcConfiguration) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *VpcConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VpcConfiguration"}
+	if s.RoleARN == nil {
+		invalidParams.Add(request.NewErrParamRequired("RoleARN"))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RoleARN", 1))
+	}
+	if s.SecurityGroupIds == nil {
+		invalidParams.Add(request.NewErrParamRequired("SecurityGroupIds"))
+	}
+	if s.SecurityGroupIds != nil && len(s.SecurityGroupIds) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("SecurityGroupIds", 1))
+	}
+	if s.SubnetIds == nil {
+		invalidParams.Add(request.NewErrParamRequired("SubnetIds"))
+	}
+	if s.SubnetIds != nil && len(s.SubnetIds) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("SubnetIds", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetRoleARN sets the RoleARN field's value.
+func (s *VpcConfiguration) SetRoleARN(v string) *VpcConfiguration {
+	s.RoleARN = &v
+	return s
+}
+
+// SetSecurityGroupIds sets the SecurityGroupIds field's value.
+func (s *VpcConfiguration) SetSecurityGroupIds(v []*string) *VpcConfiguration {
+	s.SecurityGroupIds = v
+	return s
+}
+
+// SetSubnetIds sets the SubnetIds field's value.
+func (s *VpcConfiguration) SetSubnetIds(v []*string) *VpcConfiguration {
+	s.SubnetIds = v
+	return s
+}
+
+// The details of the VPC of the Amazon ES destination.
+type VpcConfigurationDescription struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the IAM role that the delivery stream uses to create endpoints
+	// in the destination VPC. You can use your existing Kinesis Data Firehose delivery
+	// role or you can specify a new role. In either case, make sure that the role
+	// trusts the Kinesis Data Firehose service principal and that it grants the
+	// following permissions:
+	//
+	//    * ec2:DescribeVpcs
+	//
+	//    * ec2:DescribeVpcAttribute
+	//
+	//    * ec2:DescribeSubnets
+	//
+	//    * ec2:DescribeSecurityGroups
+	//
+	//    * ec2:DescribeNetworkInterfaces
+	//
+	//    * ec2:CreateNetworkInterface
+	//
+	//    * ec2:CreateNetworkInterfacePermission
+	//
+	//    * ec2:DeleteNetworkInterface
+	//
+	// If you revoke these permissions after you create the delivery stream, Kinesis
+	// Data Firehose can't scale out by creating more ENIs when necessary. You might
+	// therefore see a degradation in performance.
+	//
+	// RoleARN is a required field
+	RoleARN *string `min:"1" type:"string" required:"true"`
+
+	// The IDs of the security groups that Kinesis Data Firehose uses when it creates
+	// ENIs in the VPC of the Amazon ES destination. You can use the same security
+	// group that the Amazon ES domain uses or different ones. If you specify different
+	// security groups, ensure that they allow outbound HTTPS traffic to the Amazon
+	// ES domain's security group. Also ensure that the Amazon ES domain's security
+	// group allows HTTPS traffic from the security groups specified here. If you
+	// use the same security group for both your delivery stream and the Amazon
+	// ES domain, make sure the security group inbound rule allows HTTPS traffic.
+	// For more information about security group rules, see Security group rules
+	// (https://docs.aws.amazon.com/vpc/latest/userguide/VPC_SecurityGroups.html#SecurityGroupRules)
+	// in the Amazon VPC documentation.
+	//
+	// SecurityGroupIds is a required field
+	SecurityGroupIds []*string `min:"1" type:"list" required:"true"`
+
+	// The IDs of the subnets that Kinesis Data Firehose uses to create ENIs in
+	// the VPC of the Amazon ES destination. Make sure that the routing tables and
+	// inbound and outbound rules allow traffic to flow from the subnets whose IDs
+	// are specified here to the subnets that have the destination Amazon ES endpoints.
+	// Kinesis Data Firehose creates at least one ENI in each of the subnets that
+	// are specified here. Do not delete or modify these ENIs.
+	//
+	// The number of ENIs that Kinesis Data Firehose creates in the subnets specified
+	// here scales up and down automatically based on throughput. To enable Kinesis
+	// Data Firehose to scale up the number of ENIs to match throughput, ensure
+	// that you have sufficient quota. To help you calculate the quota you need,
+	// assume that Kinesis Data Firehose can create up to three ENIs for this delivery
+	// stream for each of the subnets specified here. For more information about
+	// ENI quota, see Network Interfaces (https://docs.aws.amazon.com/vpc/latest/userguide/amazon-vpc-limits.html#vpc-limits-enis)
+	// in the Amazon VPC Quotas topic.
+	//
+	// SubnetIds is a required field
+	SubnetIds []*string `min:"1" type:"list" required:"true"`
+
+	// The ID of the Amazon ES destination's VPC.
+	//
+	// VpcId is a required field
+	VpcId *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VpcConfigurationDescription) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VpcConfigurationDescription) GoString() string {
+	return s.String()
+}
+
+// SetRoleARN sets the RoleARN field's value.
+func (s *VpcConfigurationDescription) SetRoleARN(v string) *VpcConfigurationDescription {
+	s.RoleARN = &v
+	return s
+}
+
+// SetSecurityGroupIds sets the SecurityGroupIds field's value.
+func (s *VpcConfigurationDescription) SetSecurityGroupIds(v []*string) *VpcConfigurationDescription {
+	s.SecurityGroupIds = v
+	return s
+}
+
+// SetSubnetIds sets the SubnetIds field's value.
+func (s *VpcConfigurationDescription) SetSubnetIds(v []*string) *VpcConfigurationDescription {
+	s.SubnetIds = v
+	return s
+}
+
+// SetVpcId sets the VpcId field's value.
+func (s *VpcConfigurationDescription) SetVpcId(v string) *VpcConfigurationDescription {
+	s.VpcId = &v
+	return s
+}
+
+const (
+	// AmazonOpenSearchServerlessS3BackupModeFailedDocumentsOnly is a AmazonOpenSearchServerlessS3BackupMode enum value
+	AmazonOpenSearchServerlessS3BackupModeFailedDocumentsOnly = "FailedDocumentsOnly"
+
+	// AmazonOpenSearchServerlessS3BackupModeAllDocuments is a AmazonOpenSearchServerlessS3BackupMode enum value
+	AmazonOpenSearchServerlessS3BackupModeAllDocuments = "AllDocuments"
+)
+
+// AmazonOpenSearchServerlessS3BackupMode_Values returns all elements of the AmazonOpenSearchServerlessS3BackupMode enum
+func AmazonOpenSearchServerlessS3BackupMode_Values() []string {
+	return []string{
+		AmazonOpenSearchServerlessS3BackupModeFailedDocumentsOnly,
+		AmazonOpenSearchServerlessS3BackupModeAllDocuments,
+	}
+}
+
+const (
+	// AmazonopensearchserviceIndexRotationPeriodNoRotation is a AmazonopensearchserviceIndexRotationPeriod enum value
+	AmazonopensearchserviceIndexRotationPeriodNoRotation = "NoRotation"
+
+	// AmazonopensearchserviceIndexRotationPeriodOneHour is a AmazonopensearchserviceIndexRotationPeriod enum value
+	AmazonopensearchserviceIndexRotationPeriodOneHour = "OneHour"
+
+	// AmazonopensearchserviceIndexRotationPeriodOneDay is a AmazonopensearchserviceIndexRotationPeriod enum value
+	AmazonopensearchserviceIndexRotationPeriodOneDay = "OneDay"
+
+	// AmazonopensearchserviceIndexRotationPeriodOneWeek is a AmazonopensearchserviceIndexRotationPeriod enum value
+	AmazonopensearchserviceIndexRotationPeriodOneWeek = "OneWeek"
+
+	// AmazonopensearchserviceIndexRotationPeriodOneMonth is a AmazonopensearchserviceIndexRotationPeriod enum value
+	AmazonopensearchserviceIndexRotationPeriodOneMonth = "OneMonth"
+)
+
+// AmazonopensearchserviceIndexRotationPeriod_Values returns all elements of the AmazonopensearchserviceIndexRotationPeriod enum
+func AmazonopensearchserviceIndexRotationPeriod_Values() []string {
+	return []string{
+		AmazonopensearchserviceIndexRotationPeriodNoRotation,
+		AmazonopensearchserviceIndexRotationPeriodOneHour,
+		AmazonopensearchserviceIndexRotationPeriodOneDay,
+		AmazonopensearchserviceIndexRotationPeriodOneWeek,
+		AmazonopensearchserviceIndexRotationPeriodOneMonth,
+	}
+}
+
+const (
+	// AmazonopensearchserviceS3BackupModeFailedDocumentsOnly is a AmazonopensearchserviceS3BackupMode enum value
+	AmazonopensearchserviceS3BackupModeFailedDocumentsOnly = "FailedDocumentsOnly"
+
+	// AmazonopensearchserviceS3BackupModeAllDocuments is a AmazonopensearchserviceS3BackupMode enum value
+	AmazonopensearchserviceS3BackupModeAllDocuments = "AllDocuments"
+)
+
+// AmazonopensearchserviceS3BackupMode_Values returns all elements of the AmazonopensearchserviceS3BackupMode enum
+func AmazonopensearchserviceS3BackupMode_Values() []string {
+	return []string{
+		AmazonopensearchserviceS3BackupModeFailedDocumentsOnly,
+		AmazonopensearchserviceS3BackupModeAllDocuments,
+	}
+}
+
 const (
 	// CompressionFormatUncompressed is a CompressionFormat enum value
 	CompressionFormatUncompressed = "UNCOMPRESSED"
@@ -6461,8 +10850,70 @@ const (
 
 	// CompressionFormatSnappy is a CompressionFormat enum value
 	CompressionFormatSnappy = "Snappy"
+
+	// CompressionFormatHadoopSnappy is a CompressionFormat enum value
+	CompressionFormatHadoopSnappy = "HADOOP_SNAPPY"
+)
+
+// CompressionFormat_Values returns all elements of the CompressionFormat enum
+func CompressionFormat_Values() []string {
+	return []string{
+		CompressionFormatUncompressed,
+		CompressionFormatGzip,
+		CompressionFormatZip,
+		CompressionFormatSnappy,
+		CompressionFormatHadoopSnappy,
+	}
+}
+
+const (
+	// ConnectivityPublic is a Connectivity enum value
+	ConnectivityPublic = "PUBLIC"
+
+	// ConnectivityPrivate is a Connectivity enum value
+	ConnectivityPrivate = "PRIVATE"
+)
+
+// Connectivity_Values returns all elements of the Connectivity enum
+func Connectivity_Values() []string {
+	return []string{
+		ConnectivityPublic,
+		ConnectivityPrivate,
+	}
+}
+
+const (
+	// ContentEncodingNone is a ContentEncoding enum value
+	ContentEncodingNone = "NONE"
+
+	// ContentEncodingGzip is a ContentEncoding enum value
+	ContentEncodingGzip = "GZIP"
+)
+
+// ContentEncoding_Values returns all elements of the ContentEncoding enum
+func ContentEncoding_Values() []string {
+	return []string{
+		ContentEncodingNone,
+		ContentEncodingGzip,
+	}
+}
+
+const (
+	// DefaultDocumentIdFormatFirehoseDefault is a DefaultDocumentIdFormat enum value
+	DefaultDocumentIdFormatFirehoseDefault = "FIREHOSE_DEFAULT"
+
+	// DefaultDocumentIdFormatNoDocumentId is a DefaultDocumentIdFormat enum value
+	DefaultDocumentIdFormatNoDocumentId = "NO_DOCUMENT_ID"
 )
 
+// DefaultDocumentIdFormat_Values returns all elements of the DefaultDocumentIdFormat enum
+func DefaultDocumentIdFormat_Values() []string {
+	return []string{
+		DefaultDocumentIdFormatFirehoseDefault,
+		DefaultDocumentIdFormatNoDocumentId,
+	}
+}
+
 const (
 	// DeliveryStreamEncryptionStatusEnabled is a DeliveryStreamEncryptionStatus enum value
 	DeliveryStreamEncryptionStatusEnabled = "ENABLED"
@@ -6470,32 +10921,147 @@ const (
 	// DeliveryStreamEncryptionStatusEnabling is a DeliveryStreamEncryptionStatus enum value
 	DeliveryStreamEncryptionStatusEnabling = "ENABLING"
 
+	// DeliveryStreamEncryptionStatusEnablingFailed is a DeliveryStreamEncryptionStatus enum value
+	DeliveryStreamEncryptionStatusEnablingFailed = "ENABLING_FAILED"
+
 	// DeliveryStreamEncryptionStatusDisabled is a DeliveryStreamEncryptionStatus enum value
 	DeliveryStreamEncryptionStatusDisabled = "DISABLED"
 
 	// DeliveryStreamEncryptionStatusDisabling is a DeliveryStreamEncryptionStatus enum value
 	DeliveryStreamEncryptionStatusDisabling = "DISABLING"
+
+	// DeliveryStreamEncryptionStatusDisablingFailed is a DeliveryStreamEncryptionStatus enum value
+	DeliveryStreamEncryptionStatusDisablingFailed = "DISABLING_FAILED"
+)
+
+// DeliveryStreamEncryptionStatus_Values returns all elements of the DeliveryStreamEncryptionStatus enum
+func DeliveryStreamEncryptionStatus_Values() []string {
+	return []string{
+		DeliveryStreamEncryptionStatusEnabled,
+		DeliveryStreamEncryptionStatusEnabling,
+		DeliveryStreamEncryptionStatusEnablingFailed,
+		DeliveryStreamEncryptionStatusDisabled,
+		DeliveryStreamEncryptionStatusDisabling,
+		DeliveryStreamEncryptionStatusDisablingFailed,
+	}
+}
+
+const (
+	// DeliveryStreamFailureTypeRetireKmsGrantFailed is a DeliveryStreamFailureType enum value
+	DeliveryStreamFailureTypeRetireKmsGrantFailed = "RETIRE_KMS_GRANT_FAILED"
+
+	// DeliveryStreamFailureTypeCreateKmsGrantFailed is a DeliveryStreamFailureType enum value
+	DeliveryStreamFailureTypeCreateKmsGrantFailed = "CREATE_KMS_GRANT_FAILED"
+
+	// DeliveryStreamFailureTypeKmsAccessDenied is a DeliveryStreamFailureType enum value
+	DeliveryStreamFailureTypeKmsAccessDenied = "KMS_ACCESS_DENIED"
+
+	// DeliveryStreamFailureTypeDisabledKmsKey is a DeliveryStreamFailureType enum value
+	DeliveryStreamFailureTypeDisabledKmsKey = "DISABLED_KMS_KEY"
+
+	// DeliveryStreamFailureTypeInvalidKmsKey is a DeliveryStreamFailureType enum value
+	DeliveryStreamFailureTypeInvalidKmsKey = "INVALID_KMS_KEY"
+
+	// DeliveryStreamFailureTypeKmsKeyNotFound is a DeliveryStreamFailureType enum value
+	DeliveryStreamFailureTypeKmsKeyNotFound = "KMS_KEY_NOT_FOUND"
+
+	// DeliveryStreamFailureTypeKmsOptInRequired is a DeliveryStreamFailureType enum value
+	DeliveryStreamFailureTypeKmsOptInRequired = "KMS_OPT_IN_REQUIRED"
+
+	// DeliveryStreamFailureTypeCreateEniFailed is a DeliveryStreamFailureType enum value
+	DeliveryStreamFailureTypeCreateEniFailed = "CREATE_ENI_FAILED"
+
+	// DeliveryStreamFailureTypeDeleteEniFailed is a DeliveryStreamFailureType enum value
+	DeliveryStreamFailureTypeDeleteEniFailed = "DELETE_ENI_FAILED"
+
+	// DeliveryStreamFailureTypeSubnetNotFound is a DeliveryStreamFailureType enum value
+	DeliveryStreamFailureTypeSubnetNotFound = "SUBNET_NOT_FOUND"
+
+	// DeliveryStreamFailureTypeSecurityGroupNotFound is a DeliveryStreamFailureType enum value
+	DeliveryStreamFailureTypeSecurityGroupNotFound = "SECURITY_GROUP_NOT_FOUND"
+
+	// DeliveryStreamFailureTypeEniAccessDenied is a DeliveryStreamFailureType enum value
+	DeliveryStreamFailureTypeEniAccessDenied = "ENI_ACCESS_DENIED"
+
+	// DeliveryStreamFailureTypeSubnetAccessDenied is a DeliveryStreamFailureType enum value
+	DeliveryStreamFailureTypeSubnetAccessDenied = "SUBNET_ACCESS_DENIED"
+
+	// DeliveryStreamFailureTypeSecurityGroupAccessDenied is a DeliveryStreamFailureType enum value
+	DeliveryStreamFailureTypeSecurityGroupAccessDenied = "SECURITY_GROUP_ACCESS_DENIED"
+
+	// DeliveryStreamFailureTypeUnknownError is a DeliveryStreamFailureType enum value
+	DeliveryStreamFailureTypeUnknownError = "UNKNOWN_ERROR"
 )
 
+// DeliveryStreamFailureType_Values returns all elements of the DeliveryStreamFailureType enum
+func DeliveryStreamFailureType_Values() []string {
+	return []string{
+		DeliveryStreamFailureTypeRetireKmsGrantFailed,
+		DeliveryStreamFailureTypeCreateKmsGrantFailed,
+		DeliveryStreamFailureTypeKmsAccessDenied,
+		DeliveryStreamFailureTypeDisabledKmsKey,
+		DeliveryStreamFailureTypeInvalidKmsKey,
+		DeliveryStreamFailureTypeKmsKeyNotFound,
+		DeliveryStreamFailureTypeKmsOptInRequired,
+		DeliveryStreamFailureTypeCreateEniFailed,
+		DeliveryStreamFailureTypeDeleteEniFailed,
+		DeliveryStreamFailureTypeSubnetNotFound,
+		DeliveryStreamFailureTypeSecurityGroupNotFound,
+		DeliveryStreamFailureTypeEniAccessDenied,
+		DeliveryStreamFailureTypeSubnetAccessDenied,
+		DeliveryStreamFailureTypeSecurityGroupAccessDenied,
+		DeliveryStreamFailureTypeUnknownError,
+	}
+}
+
 const (
 	// DeliveryStreamStatusCreating is a DeliveryStreamStatus enum value
 	DeliveryStreamStatusCreating = "CREATING"
 
+	// DeliveryStreamStatusCreatingFailed is a DeliveryStreamStatus enum value
+	DeliveryStreamStatusCreatingFailed = "CREATING_FAILED"
+
 	// DeliveryStreamStatusDeleting is a DeliveryStreamStatus enum value
 	DeliveryStreamStatusDeleting = "DELETING"
 
+	// DeliveryStreamStatusDeletingFailed is a DeliveryStreamStatus enum value
+	DeliveryStreamStatusDeletingFailed = "DELETING_FAILED"
+
 	// DeliveryStreamStatusActive is a DeliveryStreamStatus enum value
 	DeliveryStreamStatusActive = "ACTIVE"
 )
 
+// DeliveryStreamStatus_Values returns all elements of the DeliveryStreamStatus enum
+func DeliveryStreamStatus_Values() []string {
+	return []string{
+		DeliveryStreamStatusCreating,
+		DeliveryStreamStatusCreatingFailed,
+		DeliveryStreamStatusDeleting,
+		DeliveryStreamStatusDeletingFailed,
+		DeliveryStreamStatusActive,
+	}
+}
+
 const (
 	// DeliveryStreamTypeDirectPut is a DeliveryStreamType enum value
 	DeliveryStreamTypeDirectPut = "DirectPut"
 
 	// DeliveryStreamTypeKinesisStreamAsSource is a DeliveryStreamType enum value
 	DeliveryStreamTypeKinesisStreamAsSource = "KinesisStreamAsSource"
+
+	// DeliveryStreamTypeMskasSource is a DeliveryStreamType enum value
+	DeliveryStreamTypeMskasSource = "MSKAsSource"
 )
 
+// DeliveryStreamType_Values returns all elements of the DeliveryStreamType enum
+func DeliveryStreamType_Values() []string {
+	return []string{
+		DeliveryStreamTypeDirectPut,
+		DeliveryStreamTypeKinesisStreamAsSource,
+		DeliveryStreamTypeMskasSource,
+	}
+}
+
 const (
 	// ElasticsearchIndexRotationPeriodNoRotation is a ElasticsearchIndexRotationPeriod enum value
 	ElasticsearchIndexRotationPeriodNoRotation = "NoRotation"
@@ -6513,6 +11079,17 @@ const (
 	ElasticsearchIndexRotationPeriodOneMonth = "OneMonth"
 )
 
+// ElasticsearchIndexRotationPeriod_Values returns all elements of the ElasticsearchIndexRotationPeriod enum
+func ElasticsearchIndexRotationPeriod_Values() []string {
+	return []string{
+		ElasticsearchIndexRotationPeriodNoRotation,
+		ElasticsearchIndexRotationPeriodOneHour,
+		ElasticsearchIndexRotationPeriodOneDay,
+		ElasticsearchIndexRotationPeriodOneWeek,
+		ElasticsearchIndexRotationPeriodOneMonth,
+	}
+}
+
 const (
 	// ElasticsearchS3BackupModeFailedDocumentsOnly is a ElasticsearchS3BackupMode enum value
 	ElasticsearchS3BackupModeFailedDocumentsOnly = "FailedDocumentsOnly"
@@ -6521,6 +11098,14 @@ const (
 	ElasticsearchS3BackupModeAllDocuments = "AllDocuments"
 )
 
+// ElasticsearchS3BackupMode_Values returns all elements of the ElasticsearchS3BackupMode enum
+func ElasticsearchS3BackupMode_Values() []string {
+	return []string{
+		ElasticsearchS3BackupModeFailedDocumentsOnly,
+		ElasticsearchS3BackupModeAllDocuments,
+	}
+}
+
 const (
 	// HECEndpointTypeRaw is a HECEndpointType enum value
 	HECEndpointTypeRaw = "Raw"
@@ -6529,11 +11114,58 @@ const (
 	HECEndpointTypeEvent = "Event"
 )
 
+// HECEndpointType_Values returns all elements of the HECEndpointType enum
+func HECEndpointType_Values() []string {
+	return []string{
+		HECEndpointTypeRaw,
+		HECEndpointTypeEvent,
+	}
+}
+
+const (
+	// HttpEndpointS3BackupModeFailedDataOnly is a HttpEndpointS3BackupMode enum value
+	HttpEndpointS3BackupModeFailedDataOnly = "FailedDataOnly"
+
+	// HttpEndpointS3BackupModeAllData is a HttpEndpointS3BackupMode enum value
+	HttpEndpointS3BackupModeAllData = "AllData"
+)
+
+// HttpEndpointS3BackupMode_Values returns all elements of the HttpEndpointS3BackupMode enum
+func HttpEndpointS3BackupMode_Values() []string {
+	return []string{
+		HttpEndpointS3BackupModeFailedDataOnly,
+		HttpEndpointS3BackupModeAllData,
+	}
+}
+
+const (
+	// KeyTypeAwsOwnedCmk is a KeyType enum value
+	KeyTypeAwsOwnedCmk = "AWS_OWNED_CMK"
+
+	// KeyTypeCustomerManagedCmk is a KeyType enum value
+	KeyTypeCustomerManagedCmk = "CUSTOMER_MANAGED_CMK"
+)
+
+// KeyType_Values returns all elements of the KeyType enum
+func KeyType_Values() []string {
+	return []string{
+		KeyTypeAwsOwnedCmk,
+		KeyTypeCustomerManagedCmk,
+	}
+}
+
 const (
 	// NoEncryptionConfigNoEncryption is a NoEncryptionConfig enum value
 	NoEncryptionConfigNoEncryption = "NoEncryption"
 )
 
+// NoEncryptionConfig_Values returns all elements of the NoEncryptionConfig enum
+func NoEncryptionConfig_Values() []string {
+	return []string{
+		NoEncryptionConfigNoEncryption,
+	}
+}
+
 const (
 	// OrcCompressionNone is a OrcCompression enum value
 	OrcCompressionNone = "NONE"
@@ -6545,6 +11177,15 @@ const (
 	OrcCompressionSnappy = "SNAPPY"
 )
 
+// OrcCompression_Values returns all elements of the OrcCompression enum
+func OrcCompression_Values() []string {
+	return []string{
+		OrcCompressionNone,
+		OrcCompressionZlib,
+		OrcCompressionSnappy,
+	}
+}
+
 const (
 	// OrcFormatVersionV011 is a OrcFormatVersion enum value
 	OrcFormatVersionV011 = "V0_11"
@@ -6553,6 +11194,14 @@ const (
 	OrcFormatVersionV012 = "V0_12"
 )
 
+// OrcFormatVersion_Values returns all elements of the OrcFormatVersion enum
+func OrcFormatVersion_Values() []string {
+	return []string{
+		OrcFormatVersionV011,
+		OrcFormatVersionV012,
+	}
+}
+
 const (
 	// ParquetCompressionUncompressed is a ParquetCompression enum value
 	ParquetCompressionUncompressed = "UNCOMPRESSED"
@@ -6564,6 +11213,15 @@ const (
 	ParquetCompressionSnappy = "SNAPPY"
 )
 
+// ParquetCompression_Values returns all elements of the ParquetCompression enum
+func ParquetCompression_Values() []string {
+	return []string{
+		ParquetCompressionUncompressed,
+		ParquetCompressionGzip,
+		ParquetCompressionSnappy,
+	}
+}
+
 const (
 	// ParquetWriterVersionV1 is a ParquetWriterVersion enum value
 	ParquetWriterVersionV1 = "V1"
@@ -6572,6 +11230,14 @@ const (
 	ParquetWriterVersionV2 = "V2"
 )
 
+// ParquetWriterVersion_Values returns all elements of the ParquetWriterVersion enum
+func ParquetWriterVersion_Values() []string {
+	return []string{
+		ParquetWriterVersionV1,
+		ParquetWriterVersionV2,
+	}
+}
+
 const (
 	// ProcessorParameterNameLambdaArn is a ProcessorParameterName enum value
 	ProcessorParameterNameLambdaArn = "LambdaArn"
@@ -6579,6 +11245,12 @@ const (
 	// ProcessorParameterNameNumberOfRetries is a ProcessorParameterName enum value
 	ProcessorParameterNameNumberOfRetries = "NumberOfRetries"
 
+	// ProcessorParameterNameMetadataExtractionQuery is a ProcessorParameterName enum value
+	ProcessorParameterNameMetadataExtractionQuery = "MetadataExtractionQuery"
+
+	// ProcessorParameterNameJsonParsingEngine is a ProcessorParameterName enum value
+	ProcessorParameterNameJsonParsingEngine = "JsonParsingEngine"
+
 	// ProcessorParameterNameRoleArn is a ProcessorParameterName enum value
 	ProcessorParameterNameRoleArn = "RoleArn"
 
@@ -6587,13 +11259,61 @@ const (
 
 	// ProcessorParameterNameBufferIntervalInSeconds is a ProcessorParameterName enum value
 	ProcessorParameterNameBufferIntervalInSeconds = "BufferIntervalInSeconds"
+
+	// ProcessorParameterNameSubRecordType is a ProcessorParameterName enum value
+	ProcessorParameterNameSubRecordType = "SubRecordType"
+
+	// ProcessorParameterNameDelimiter is a ProcessorParameterName enum value
+	ProcessorParameterNameDelimiter = "Delimiter"
+
+	// ProcessorParameterNameCompressionFormat is a ProcessorParameterName enum value
+	ProcessorParameterNameCompressionFormat = "CompressionFormat"
 )
 
+// ProcessorParameterName_Values returns all elements of the ProcessorParameterName enum
+func ProcessorParameterName_Values() []string {
+	return []string{
+		ProcessorParameterNameLambdaArn,
+		ProcessorParameterNameNumberOfRetries,
+		ProcessorParameterNameMetadataExtractionQuery,
+		ProcessorParameterNameJsonParsingEngine,
+		ProcessorParameterNameRoleArn,
+		ProcessorParameterNameBufferSizeInMbs,
+		ProcessorParameterNameBufferIntervalInSeconds,
+		ProcessorParameterNameSubRecordType,
+		ProcessorParameterNameDelimiter,
+		ProcessorParameterNameCompressionFormat,
+	}
+}
+
 const (
+	// ProcessorTypeRecordDeAggregation is a ProcessorType enum value
+	ProcessorTypeRecordDeAggregation = "RecordDeAggregation"
+
+	// ProcessorTypeDecompression is a ProcessorType enum value
+	ProcessorTypeDecompression = "Decompression"
+
 	// ProcessorTypeLambda is a ProcessorType enum value
 	ProcessorTypeLambda = "Lambda"
+
+	// ProcessorTypeMetadataExtraction is a ProcessorType enum value
+	ProcessorTypeMetadataExtraction = "MetadataExtraction"
+
+	// ProcessorTypeAppendDelimiterToRecord is a ProcessorType enum value
+	ProcessorTypeAppendDelimiterToRecord = "AppendDelimiterToRecord"
 )
 
+// ProcessorType_Values returns all elements of the ProcessorType enum
+func ProcessorType_Values() []string {
+	return []string{
+		ProcessorTypeRecordDeAggregation,
+		ProcessorTypeDecompression,
+		ProcessorTypeLambda,
+		ProcessorTypeMetadataExtraction,
+		ProcessorTypeAppendDelimiterToRecord,
+	}
+}
+
 const (
 	// RedshiftS3BackupModeDisabled is a RedshiftS3BackupMode enum value
 	RedshiftS3BackupModeDisabled = "Disabled"
@@ -6602,6 +11322,14 @@ const (
 	RedshiftS3BackupModeEnabled = "Enabled"
 )
 
+// RedshiftS3BackupMode_Values returns all elements of the RedshiftS3BackupMode enum
+func RedshiftS3BackupMode_Values() []string {
+	return []string{
+		RedshiftS3BackupModeDisabled,
+		RedshiftS3BackupModeEnabled,
+	}
+}
+
 const (
 	// S3BackupModeDisabled is a S3BackupMode enum value
 	S3BackupModeDisabled = "Disabled"
@@ -6610,6 +11338,14 @@ const (
 	S3BackupModeEnabled = "Enabled"
 )
 
+// S3BackupMode_Values returns all elements of the S3BackupMode enum
+func S3BackupMode_Values() []string {
+	return []string{
+		S3BackupModeDisabled,
+		S3BackupModeEnabled,
+	}
+}
+
 const (
 	// SplunkS3BackupModeFailedEventsOnly is a SplunkS3BackupMode enum value
 	SplunkS3BackupModeFailedEventsOnly = "FailedEventsOnly"
@@ -6617,3 +11353,11 @@ const (
 	// SplunkS3BackupModeAllEvents is a SplunkS3BackupMode enum value
 	SplunkS3BackupModeAllEvents = "AllEvents"
 )
+
+// SplunkS3BackupMode_Values returns all elements of the SplunkS3BackupMode enum
+func SplunkS3BackupMode_Values() []string {
+	return []string{
+		SplunkS3BackupModeFailedEventsOnly,
+		SplunkS3BackupModeAllEvents,
+	}
+}
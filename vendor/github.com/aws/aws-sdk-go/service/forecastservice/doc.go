@@ -10,7 +10,7 @@
 // See forecastservice package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/forecastservice/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon Forecast Service with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -2,6 +2,10 @@
 
 package forecastservice
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeInvalidInputException for service response error code
@@ -20,14 +24,13 @@ const (
 	// ErrCodeLimitExceededException for service response error code
 	// "LimitExceededException".
 	//
-	// The limit on the number of requests per second has been exceeded.
+	// The limit on the number of resources per account has been exceeded.
 	ErrCodeLimitExceededException = "LimitExceededException"
 
 	// ErrCodeResourceAlreadyExistsException for service response error code
 	// "ResourceAlreadyExistsException".
 	//
-	// There is already a resource with that Amazon Resource Name (ARN). Try again
-	// with a different ARN.
+	// There is already a resource with this name. Try again with a different name.
 	ErrCodeResourceAlreadyExistsException = "ResourceAlreadyExistsException"
 
 	// ErrCodeResourceInUseException for service response error code
@@ -43,3 +46,12 @@ const (
 	// ARN and try again.
 	ErrCodeResourceNotFoundException = "ResourceNotFoundException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"InvalidInputException":          newErrorInvalidInputException,
+	"InvalidNextTokenException":      newErrorInvalidNextTokenException,
+	"LimitExceededException":         newErrorLimitExceededException,
+	"ResourceAlreadyExistsException": newErrorResourceAlreadyExistsException,
+	"ResourceInUseException":         newErrorResourceInUseException,
+	"ResourceNotFoundException":      newErrorResourceNotFoundException,
+}
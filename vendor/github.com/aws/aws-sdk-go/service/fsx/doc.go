@@ -11,7 +11,7 @@
 // See fsx package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/fsx/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon FSx with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -29,14 +29,13 @@ const opAbortMultipartUpload = "AbortMultipartUpload"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AbortMultipartUploadRequest method.
+//	req, resp := client.AbortMultipartUploadRequest(params)
 //
-//    // Example sending a request using the AbortMultipartUploadRequest method.
-//    req, resp := client.AbortMultipartUploadRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) AbortMultipartUploadRequest(input *AbortMultipartUploadInput) (req *request.Request, output *AbortMultipartUploadOutput) {
 	op := &request.Operation{
 		Name:       opAbortMultipartUpload,
@@ -84,20 +83,20 @@ func (c *Glacier) AbortMultipartUploadRequest(input *AbortMultipartUploadInput)
 // See the AWS API reference guide for Amazon Glacier's
 // API operation AbortMultipartUpload for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) AbortMultipartUpload(input *AbortMultipartUploadInput) (*AbortMultipartUploadOutput, error) {
 	req, out := c.AbortMultipartUploadRequest(input)
 	return out, req.Send()
@@ -135,14 +134,13 @@ const opAbortVaultLock = "AbortVaultLock"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AbortVaultLockRequest method.
+//	req, resp := client.AbortVaultLockRequest(params)
 //
-//    // Example sending a request using the AbortVaultLockRequest method.
-//    req, resp := client.AbortVaultLockRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) AbortVaultLockRequest(input *AbortVaultLockInput) (req *request.Request, output *AbortVaultLockOutput) {
 	op := &request.Operation{
 		Name:       opAbortVaultLock,
@@ -186,20 +184,20 @@ func (c *Glacier) AbortVaultLockRequest(input *AbortVaultLockInput) (req *reques
 // See the AWS API reference guide for Amazon Glacier's
 // API operation AbortVaultLock for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) AbortVaultLock(input *AbortVaultLockInput) (*AbortVaultLockOutput, error) {
 	req, out := c.AbortVaultLockRequest(input)
 	return out, req.Send()
@@ -237,14 +235,13 @@ const opAddTagsToVault = "AddTagsToVault"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AddTagsToVaultRequest method.
+//	req, resp := client.AddTagsToVaultRequest(params)
 //
-//    // Example sending a request using the AddTagsToVaultRequest method.
-//    req, resp := client.AddTagsToVaultRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) AddTagsToVaultRequest(input *AddTagsToVaultInput) (req *request.Request, output *AddTagsToVaultOutput) {
 	op := &request.Operation{
 		Name:       opAddTagsToVault,
@@ -278,23 +275,23 @@ func (c *Glacier) AddTagsToVaultRequest(input *AddTagsToVaultInput) (req *reques
 // See the AWS API reference guide for Amazon Glacier's
 // API operation AddTagsToVault for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+// Returned Error Types:
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   Returned if the request results in a vault or account limit being exceeded.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - LimitExceededException
+//     Returned if the request results in a vault or account limit being exceeded.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) AddTagsToVault(input *AddTagsToVaultInput) (*AddTagsToVaultOutput, error) {
 	req, out := c.AddTagsToVaultRequest(input)
 	return out, req.Send()
@@ -332,14 +329,13 @@ const opCompleteMultipartUpload = "CompleteMultipartUpload"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CompleteMultipartUploadRequest method.
+//	req, resp := client.CompleteMultipartUploadRequest(params)
 //
-//    // Example sending a request using the CompleteMultipartUploadRequest method.
-//    req, resp := client.CompleteMultipartUploadRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) CompleteMultipartUploadRequest(input *CompleteMultipartUploadInput) (req *request.Request, output *ArchiveCreationOutput) {
 	op := &request.Operation{
 		Name:       opCompleteMultipartUpload,
@@ -410,20 +406,20 @@ func (c *Glacier) CompleteMultipartUploadRequest(input *CompleteMultipartUploadI
 // See the AWS API reference guide for Amazon Glacier's
 // API operation CompleteMultipartUpload for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) CompleteMultipartUpload(input *CompleteMultipartUploadInput) (*ArchiveCreationOutput, error) {
 	req, out := c.CompleteMultipartUploadRequest(input)
 	return out, req.Send()
@@ -461,14 +457,13 @@ const opCompleteVaultLock = "CompleteVaultLock"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CompleteVaultLockRequest method.
+//	req, resp := client.CompleteVaultLockRequest(params)
 //
-//    // Example sending a request using the CompleteVaultLockRequest method.
-//    req, resp := client.CompleteVaultLockRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) CompleteVaultLockRequest(input *CompleteVaultLockInput) (req *request.Request, output *CompleteVaultLockOutput) {
 	op := &request.Operation{
 		Name:       opCompleteVaultLock,
@@ -511,20 +506,20 @@ func (c *Glacier) CompleteVaultLockRequest(input *CompleteVaultLockInput) (req *
 // See the AWS API reference guide for Amazon Glacier's
 // API operation CompleteVaultLock for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) CompleteVaultLock(input *CompleteVaultLockInput) (*CompleteVaultLockOutput, error) {
 	req, out := c.CompleteVaultLockRequest(input)
 	return out, req.Send()
@@ -562,14 +557,13 @@ const opCreateVault = "CreateVault"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateVaultRequest method.
+//	req, resp := client.CreateVaultRequest(params)
 //
-//    // Example sending a request using the CreateVaultRequest method.
-//    req, resp := client.CreateVaultRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) CreateVaultRequest(input *CreateVaultInput) (req *request.Request, output *CreateVaultOutput) {
 	op := &request.Operation{
 		Name:       opCreateVault,
@@ -595,10 +589,10 @@ func (c *Glacier) CreateVaultRequest(input *CreateVaultInput) (req *request.Requ
 //
 // You must use the following guidelines when naming a vault.
 //
-//    * Names can be between 1 and 255 characters long.
+//   - Names can be between 1 and 255 characters long.
 //
-//    * Allowed characters are a-z, A-Z, 0-9, '_' (underscore), '-' (hyphen),
-//    and '.' (period).
+//   - Allowed characters are a-z, A-Z, 0-9, '_' (underscore), '-' (hyphen),
+//     and '.' (period).
 //
 // This operation is idempotent.
 //
@@ -620,19 +614,19 @@ func (c *Glacier) CreateVaultRequest(input *CreateVaultInput) (req *request.Requ
 // See the AWS API reference guide for Amazon Glacier's
 // API operation CreateVault for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+// Returned Error Types:
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   Returned if the request results in a vault or account limit being exceeded.
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 //
+//   - LimitExceededException
+//     Returned if the request results in a vault or account limit being exceeded.
 func (c *Glacier) CreateVault(input *CreateVaultInput) (*CreateVaultOutput, error) {
 	req, out := c.CreateVaultRequest(input)
 	return out, req.Send()
@@ -670,14 +664,13 @@ const opDeleteArchive = "DeleteArchive"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteArchiveRequest method.
+//	req, resp := client.DeleteArchiveRequest(params)
 //
-//    // Example sending a request using the DeleteArchiveRequest method.
-//    req, resp := client.DeleteArchiveRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) DeleteArchiveRequest(input *DeleteArchiveInput) (req *request.Request, output *DeleteArchiveOutput) {
 	op := &request.Operation{
 		Name:       opDeleteArchive,
@@ -701,13 +694,13 @@ func (c *Glacier) DeleteArchiveRequest(input *DeleteArchiveInput) (req *request.
 // a retrieval of this archive will fail. Archive retrievals that are in progress
 // for this archive ID may or may not succeed according to the following scenarios:
 //
-//    * If the archive retrieval job is actively preparing the data for download
-//    when Amazon S3 Glacier receives the delete archive request, the archival
-//    retrieval operation might fail.
+//   - If the archive retrieval job is actively preparing the data for download
+//     when Amazon S3 Glacier receives the delete archive request, the archival
+//     retrieval operation might fail.
 //
-//    * If the archive retrieval job has successfully prepared the archive for
-//    download when Amazon S3 Glacier receives the delete archive request, you
-//    will be able to download the output.
+//   - If the archive retrieval job has successfully prepared the archive for
+//     download when Amazon S3 Glacier receives the delete archive request, you
+//     will be able to download the output.
 //
 // This operation is idempotent. Attempting to delete an already-deleted archive
 // does not result in an error.
@@ -730,20 +723,20 @@ func (c *Glacier) DeleteArchiveRequest(input *DeleteArchiveInput) (req *request.
 // See the AWS API reference guide for Amazon Glacier's
 // API operation DeleteArchive for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) DeleteArchive(input *DeleteArchiveInput) (*DeleteArchiveOutput, error) {
 	req, out := c.DeleteArchiveRequest(input)
 	return out, req.Send()
@@ -781,14 +774,13 @@ const opDeleteVault = "DeleteVault"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteVaultRequest method.
+//	req, resp := client.DeleteVaultRequest(params)
 //
-//    // Example sending a request using the DeleteVaultRequest method.
-//    req, resp := client.DeleteVaultRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) DeleteVaultRequest(input *DeleteVaultInput) (req *request.Request, output *DeleteVaultOutput) {
 	op := &request.Operation{
 		Name:       opDeleteVault,
@@ -839,20 +831,20 @@ func (c *Glacier) DeleteVaultRequest(input *DeleteVaultInput) (req *request.Requ
 // See the AWS API reference guide for Amazon Glacier's
 // API operation DeleteVault for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) DeleteVault(input *DeleteVaultInput) (*DeleteVaultOutput, error) {
 	req, out := c.DeleteVaultRequest(input)
 	return out, req.Send()
@@ -890,14 +882,13 @@ const opDeleteVaultAccessPolicy = "DeleteVaultAccessPolicy"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteVaultAccessPolicyRequest method.
+//	req, resp := client.DeleteVaultAccessPolicyRequest(params)
 //
-//    // Example sending a request using the DeleteVaultAccessPolicyRequest method.
-//    req, resp := client.DeleteVaultAccessPolicyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) DeleteVaultAccessPolicyRequest(input *DeleteVaultAccessPolicyInput) (req *request.Request, output *DeleteVaultAccessPolicyOutput) {
 	op := &request.Operation{
 		Name:       opDeleteVaultAccessPolicy,
@@ -935,20 +926,20 @@ func (c *Glacier) DeleteVaultAccessPolicyRequest(input *DeleteVaultAccessPolicyI
 // See the AWS API reference guide for Amazon Glacier's
 // API operation DeleteVaultAccessPolicy for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) DeleteVaultAccessPolicy(input *DeleteVaultAccessPolicyInput) (*DeleteVaultAccessPolicyOutput, error) {
 	req, out := c.DeleteVaultAccessPolicyRequest(input)
 	return out, req.Send()
@@ -986,14 +977,13 @@ const opDeleteVaultNotifications = "DeleteVaultNotifications"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteVaultNotificationsRequest method.
+//	req, resp := client.DeleteVaultNotificationsRequest(params)
 //
-//    // Example sending a request using the DeleteVaultNotificationsRequest method.
-//    req, resp := client.DeleteVaultNotificationsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) DeleteVaultNotificationsRequest(input *DeleteVaultNotificationsInput) (req *request.Request, output *DeleteVaultNotificationsOutput) {
 	op := &request.Operation{
 		Name:       opDeleteVaultNotifications,
@@ -1036,20 +1026,20 @@ func (c *Glacier) DeleteVaultNotificationsRequest(input *DeleteVaultNotification
 // See the AWS API reference guide for Amazon Glacier's
 // API operation DeleteVaultNotifications for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) DeleteVaultNotifications(input *DeleteVaultNotificationsInput) (*DeleteVaultNotificationsOutput, error) {
 	req, out := c.DeleteVaultNotificationsRequest(input)
 	return out, req.Send()
@@ -1087,14 +1077,13 @@ const opDescribeJob = "DescribeJob"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeJobRequest method.
+//	req, resp := client.DescribeJobRequest(params)
 //
-//    // Example sending a request using the DescribeJobRequest method.
-//    req, resp := client.DescribeJobRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) DescribeJobRequest(input *DescribeJobInput) (req *request.Request, output *JobDescription) {
 	op := &request.Operation{
 		Name:       opDescribeJob,
@@ -1144,20 +1133,20 @@ func (c *Glacier) DescribeJobRequest(input *DescribeJobInput) (req *request.Requ
 // See the AWS API reference guide for Amazon Glacier's
 // API operation DescribeJob for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) DescribeJob(input *DescribeJobInput) (*JobDescription, error) {
 	req, out := c.DescribeJobRequest(input)
 	return out, req.Send()
@@ -1195,14 +1184,13 @@ const opDescribeVault = "DescribeVault"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeVaultRequest method.
+//	req, resp := client.DescribeVaultRequest(params)
 //
-//    // Example sending a request using the DescribeVaultRequest method.
-//    req, resp := client.DescribeVaultRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) DescribeVaultRequest(input *DescribeVaultInput) (req *request.Request, output *DescribeVaultOutput) {
 	op := &request.Operation{
 		Name:       opDescribeVault,
@@ -1249,20 +1237,20 @@ func (c *Glacier) DescribeVaultRequest(input *DescribeVaultInput) (req *request.
 // See the AWS API reference guide for Amazon Glacier's
 // API operation DescribeVault for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) DescribeVault(input *DescribeVaultInput) (*DescribeVaultOutput, error) {
 	req, out := c.DescribeVaultRequest(input)
 	return out, req.Send()
@@ -1300,14 +1288,13 @@ const opGetDataRetrievalPolicy = "GetDataRetrievalPolicy"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetDataRetrievalPolicyRequest method.
+//	req, resp := client.GetDataRetrievalPolicyRequest(params)
 //
-//    // Example sending a request using the GetDataRetrievalPolicyRequest method.
-//    req, resp := client.GetDataRetrievalPolicyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) GetDataRetrievalPolicyRequest(input *GetDataRetrievalPolicyInput) (req *request.Request, output *GetDataRetrievalPolicyOutput) {
 	op := &request.Operation{
 		Name:       opGetDataRetrievalPolicy,
@@ -1337,16 +1324,16 @@ func (c *Glacier) GetDataRetrievalPolicyRequest(input *GetDataRetrievalPolicyInp
 // See the AWS API reference guide for Amazon Glacier's
 // API operation GetDataRetrievalPolicy for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+// Returned Error Types:
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) GetDataRetrievalPolicy(input *GetDataRetrievalPolicyInput) (*GetDataRetrievalPolicyOutput, error) {
 	req, out := c.GetDataRetrievalPolicyRequest(input)
 	return out, req.Send()
@@ -1384,14 +1371,13 @@ const opGetJobOutput = "GetJobOutput"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetJobOutputRequest method.
+//	req, resp := client.GetJobOutputRequest(params)
 //
-//    // Example sending a request using the GetJobOutputRequest method.
-//    req, resp := client.GetJobOutputRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) GetJobOutputRequest(input *GetJobOutputInput) (req *request.Request, output *GetJobOutputOutput) {
 	op := &request.Operation{
 		Name:       opGetJobOutput,
@@ -1462,20 +1448,20 @@ func (c *Glacier) GetJobOutputRequest(input *GetJobOutputInput) (req *request.Re
 // See the AWS API reference guide for Amazon Glacier's
 // API operation GetJobOutput for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) GetJobOutput(input *GetJobOutputInput) (*GetJobOutputOutput, error) {
 	req, out := c.GetJobOutputRequest(input)
 	return out, req.Send()
@@ -1513,14 +1499,13 @@ const opGetVaultAccessPolicy = "GetVaultAccessPolicy"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetVaultAccessPolicyRequest method.
+//	req, resp := client.GetVaultAccessPolicyRequest(params)
 //
-//    // Example sending a request using the GetVaultAccessPolicyRequest method.
-//    req, resp := client.GetVaultAccessPolicyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) GetVaultAccessPolicyRequest(input *GetVaultAccessPolicyInput) (req *request.Request, output *GetVaultAccessPolicyOutput) {
 	op := &request.Operation{
 		Name:       opGetVaultAccessPolicy,
@@ -1553,20 +1538,20 @@ func (c *Glacier) GetVaultAccessPolicyRequest(input *GetVaultAccessPolicyInput)
 // See the AWS API reference guide for Amazon Glacier's
 // API operation GetVaultAccessPolicy for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) GetVaultAccessPolicy(input *GetVaultAccessPolicyInput) (*GetVaultAccessPolicyOutput, error) {
 	req, out := c.GetVaultAccessPolicyRequest(input)
 	return out, req.Send()
@@ -1604,14 +1589,13 @@ const opGetVaultLock = "GetVaultLock"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetVaultLockRequest method.
+//	req, resp := client.GetVaultLockRequest(params)
 //
-//    // Example sending a request using the GetVaultLockRequest method.
-//    req, resp := client.GetVaultLockRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) GetVaultLockRequest(input *GetVaultLockInput) (req *request.Request, output *GetVaultLockOutput) {
 	op := &request.Operation{
 		Name:       opGetVaultLock,
@@ -1633,14 +1617,14 @@ func (c *Glacier) GetVaultLockRequest(input *GetVaultLockInput) (req *request.Re
 // This operation retrieves the following attributes from the lock-policy subresource
 // set on the specified vault:
 //
-//    * The vault lock policy set on the vault.
+//   - The vault lock policy set on the vault.
 //
-//    * The state of the vault lock, which is either InProgess or Locked.
+//   - The state of the vault lock, which is either InProgess or Locked.
 //
-//    * When the lock ID expires. The lock ID is used to complete the vault
-//    locking process.
+//   - When the lock ID expires. The lock ID is used to complete the vault
+//     locking process.
 //
-//    * When the vault lock was initiated and put into the InProgress state.
+//   - When the vault lock was initiated and put into the InProgress state.
 //
 // A vault lock is put into the InProgress state by calling InitiateVaultLock.
 // A vault lock is put into the Locked state by calling CompleteVaultLock. You
@@ -1658,20 +1642,20 @@ func (c *Glacier) GetVaultLockRequest(input *GetVaultLockInput) (req *request.Re
 // See the AWS API reference guide for Amazon Glacier's
 // API operation GetVaultLock for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) GetVaultLock(input *GetVaultLockInput) (*GetVaultLockOutput, error) {
 	req, out := c.GetVaultLockRequest(input)
 	return out, req.Send()
@@ -1709,14 +1693,13 @@ const opGetVaultNotifications = "GetVaultNotifications"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetVaultNotificationsRequest method.
+//	req, resp := client.GetVaultNotificationsRequest(params)
 //
-//    // Example sending a request using the GetVaultNotificationsRequest method.
-//    req, resp := client.GetVaultNotificationsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) GetVaultNotificationsRequest(input *GetVaultNotificationsInput) (req *request.Request, output *GetVaultNotificationsOutput) {
 	op := &request.Operation{
 		Name:       opGetVaultNotifications,
@@ -1762,20 +1745,20 @@ func (c *Glacier) GetVaultNotificationsRequest(input *GetVaultNotificationsInput
 // See the AWS API reference guide for Amazon Glacier's
 // API operation GetVaultNotifications for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) GetVaultNotifications(input *GetVaultNotificationsInput) (*GetVaultNotificationsOutput, error) {
 	req, out := c.GetVaultNotificationsRequest(input)
 	return out, req.Send()
@@ -1813,14 +1796,13 @@ const opInitiateJob = "InitiateJob"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the InitiateJobRequest method.
+//	req, resp := client.InitiateJobRequest(params)
 //
-//    // Example sending a request using the InitiateJobRequest method.
-//    req, resp := client.InitiateJobRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) InitiateJobRequest(input *InitiateJobInput) (req *request.Request, output *InitiateJobOutput) {
 	op := &request.Operation{
 		Name:       opInitiateJob,
@@ -1851,29 +1833,29 @@ func (c *Glacier) InitiateJobRequest(input *InitiateJobInput) (req *request.Requ
 // See the AWS API reference guide for Amazon Glacier's
 // API operation InitiateJob for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodePolicyEnforcedException "PolicyEnforcedException"
-//   Returned if a retrieval job would exceed the current data policy's retrieval
-//   rate limit. For more information about data retrieval policies,
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - PolicyEnforcedException
+//     Returned if a retrieval job would exceed the current data policy's retrieval
+//     rate limit. For more information about data retrieval policies,
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeInsufficientCapacityException "InsufficientCapacityException"
-//   Returned if there is insufficient capacity to process this expedited request.
-//   This error only applies to expedited retrievals and not to standard or bulk
-//   retrievals.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - InsufficientCapacityException
+//     Returned if there is insufficient capacity to process this expedited request.
+//     This error only applies to expedited retrievals and not to standard or bulk
+//     retrievals.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) InitiateJob(input *InitiateJobInput) (*InitiateJobOutput, error) {
 	req, out := c.InitiateJobRequest(input)
 	return out, req.Send()
@@ -1911,14 +1893,13 @@ const opInitiateMultipartUpload = "InitiateMultipartUpload"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the InitiateMultipartUploadRequest method.
+//	req, resp := client.InitiateMultipartUploadRequest(params)
 //
-//    // Example sending a request using the InitiateMultipartUploadRequest method.
-//    req, resp := client.InitiateMultipartUploadRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) InitiateMultipartUploadRequest(input *InitiateMultipartUploadInput) (req *request.Request, output *InitiateMultipartUploadOutput) {
 	op := &request.Operation{
 		Name:       opInitiateMultipartUpload,
@@ -1981,20 +1962,20 @@ func (c *Glacier) InitiateMultipartUploadRequest(input *InitiateMultipartUploadI
 // See the AWS API reference guide for Amazon Glacier's
 // API operation InitiateMultipartUpload for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) InitiateMultipartUpload(input *InitiateMultipartUploadInput) (*InitiateMultipartUploadOutput, error) {
 	req, out := c.InitiateMultipartUploadRequest(input)
 	return out, req.Send()
@@ -2032,14 +2013,13 @@ const opInitiateVaultLock = "InitiateVaultLock"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the InitiateVaultLockRequest method.
+//	req, resp := client.InitiateVaultLockRequest(params)
 //
-//    // Example sending a request using the InitiateVaultLockRequest method.
-//    req, resp := client.InitiateVaultLockRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) InitiateVaultLockRequest(input *InitiateVaultLockInput) (req *request.Request, output *InitiateVaultLockOutput) {
 	op := &request.Operation{
 		Name:       opInitiateVaultLock,
@@ -2060,11 +2040,11 @@ func (c *Glacier) InitiateVaultLockRequest(input *InitiateVaultLockInput) (req *
 //
 // This operation initiates the vault locking process by doing the following:
 //
-//    * Installing a vault lock policy on the specified vault.
+//   - Installing a vault lock policy on the specified vault.
 //
-//    * Setting the lock state of vault lock to InProgress.
+//   - Setting the lock state of vault lock to InProgress.
 //
-//    * Returning a lock ID, which is used to complete the vault locking process.
+//   - Returning a lock ID, which is used to complete the vault locking process.
 //
 // You can set one vault lock policy for each vault and this policy can be up
 // to 20 KB in size. For more information about vault lock policies, see Amazon
@@ -2095,20 +2075,20 @@ func (c *Glacier) InitiateVaultLockRequest(input *InitiateVaultLockInput) (req *
 // See the AWS API reference guide for Amazon Glacier's
 // API operation InitiateVaultLock for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) InitiateVaultLock(input *InitiateVaultLockInput) (*InitiateVaultLockOutput, error) {
 	req, out := c.InitiateVaultLockRequest(input)
 	return out, req.Send()
@@ -2146,14 +2126,13 @@ const opListJobs = "ListJobs"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListJobsRequest method.
+//	req, resp := client.ListJobsRequest(params)
 //
-//    // Example sending a request using the ListJobsRequest method.
-//    req, resp := client.ListJobsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) ListJobsRequest(input *ListJobsInput) (req *request.Request, output *ListJobsOutput) {
 	op := &request.Operation{
 		Name:       opListJobs,
@@ -2222,20 +2201,20 @@ func (c *Glacier) ListJobsRequest(input *ListJobsInput) (req *request.Request, o
 // See the AWS API reference guide for Amazon Glacier's
 // API operation ListJobs for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) ListJobs(input *ListJobsInput) (*ListJobsOutput, error) {
 	req, out := c.ListJobsRequest(input)
 	return out, req.Send()
@@ -2265,15 +2244,14 @@ func (c *Glacier) ListJobsWithContext(ctx aws.Context, input *ListJobsInput, opt
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListJobs operation.
-//    pageNum := 0
-//    err := client.ListJobsPages(params,
-//        func(page *glacier.ListJobsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListJobs operation.
+//	pageNum := 0
+//	err := client.ListJobsPages(params,
+//	    func(page *glacier.ListJobsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *Glacier) ListJobsPages(input *ListJobsInput, fn func(*ListJobsOutput, bool) bool) error {
 	return c.ListJobsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -2300,10 +2278,12 @@ func (c *Glacier) ListJobsPagesWithContext(ctx aws.Context, input *ListJobsInput
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListJobsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListJobsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -2323,14 +2303,13 @@ const opListMultipartUploads = "ListMultipartUploads"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListMultipartUploadsRequest method.
+//	req, resp := client.ListMultipartUploadsRequest(params)
 //
-//    // Example sending a request using the ListMultipartUploadsRequest method.
-//    req, resp := client.ListMultipartUploadsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) ListMultipartUploadsRequest(input *ListMultipartUploadsInput) (req *request.Request, output *ListMultipartUploadsOutput) {
 	op := &request.Operation{
 		Name:       opListMultipartUploads,
@@ -2393,20 +2372,20 @@ func (c *Glacier) ListMultipartUploadsRequest(input *ListMultipartUploadsInput)
 // See the AWS API reference guide for Amazon Glacier's
 // API operation ListMultipartUploads for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) ListMultipartUploads(input *ListMultipartUploadsInput) (*ListMultipartUploadsOutput, error) {
 	req, out := c.ListMultipartUploadsRequest(input)
 	return out, req.Send()
@@ -2436,15 +2415,14 @@ func (c *Glacier) ListMultipartUploadsWithContext(ctx aws.Context, input *ListMu
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListMultipartUploads operation.
-//    pageNum := 0
-//    err := client.ListMultipartUploadsPages(params,
-//        func(page *glacier.ListMultipartUploadsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListMultipartUploads operation.
+//	pageNum := 0
+//	err := client.ListMultipartUploadsPages(params,
+//	    func(page *glacier.ListMultipartUploadsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *Glacier) ListMultipartUploadsPages(input *ListMultipartUploadsInput, fn func(*ListMultipartUploadsOutput, bool) bool) error {
 	return c.ListMultipartUploadsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -2471,10 +2449,12 @@ func (c *Glacier) ListMultipartUploadsPagesWithContext(ctx aws.Context, input *L
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListMultipartUploadsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListMultipartUploadsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -2494,14 +2474,13 @@ const opListParts = "ListParts"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListPartsRequest method.
+//	req, resp := client.ListPartsRequest(params)
 //
-//    // Example sending a request using the ListPartsRequest method.
-//    req, resp := client.ListPartsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) ListPartsRequest(input *ListPartsInput) (req *request.Request, output *ListPartsOutput) {
 	op := &request.Operation{
 		Name:       opListParts,
@@ -2558,20 +2537,20 @@ func (c *Glacier) ListPartsRequest(input *ListPartsInput) (req *request.Request,
 // See the AWS API reference guide for Amazon Glacier's
 // API operation ListParts for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) ListParts(input *ListPartsInput) (*ListPartsOutput, error) {
 	req, out := c.ListPartsRequest(input)
 	return out, req.Send()
@@ -2601,15 +2580,14 @@ func (c *Glacier) ListPartsWithContext(ctx aws.Context, input *ListPartsInput, o
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListParts operation.
-//    pageNum := 0
-//    err := client.ListPartsPages(params,
-//        func(page *glacier.ListPartsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListParts operation.
+//	pageNum := 0
+//	err := client.ListPartsPages(params,
+//	    func(page *glacier.ListPartsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *Glacier) ListPartsPages(input *ListPartsInput, fn func(*ListPartsOutput, bool) bool) error {
 	return c.ListPartsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -2636,10 +2614,12 @@ func (c *Glacier) ListPartsPagesWithContext(ctx aws.Context, input *ListPartsInp
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListPartsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListPartsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -2659,14 +2639,13 @@ const opListProvisionedCapacity = "ListProvisionedCapacity"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListProvisionedCapacityRequest method.
+//	req, resp := client.ListProvisionedCapacityRequest(params)
 //
-//    // Example sending a request using the ListProvisionedCapacityRequest method.
-//    req, resp := client.ListProvisionedCapacityRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) ListProvisionedCapacityRequest(input *ListProvisionedCapacityInput) (req *request.Request, output *ListProvisionedCapacityOutput) {
 	op := &request.Operation{
 		Name:       opListProvisionedCapacity,
@@ -2695,16 +2674,16 @@ func (c *Glacier) ListProvisionedCapacityRequest(input *ListProvisionedCapacityI
 // See the AWS API reference guide for Amazon Glacier's
 // API operation ListProvisionedCapacity for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+// Returned Error Types:
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) ListProvisionedCapacity(input *ListProvisionedCapacityInput) (*ListProvisionedCapacityOutput, error) {
 	req, out := c.ListProvisionedCapacityRequest(input)
 	return out, req.Send()
@@ -2742,14 +2721,13 @@ const opListTagsForVault = "ListTagsForVault"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTagsForVaultRequest method.
+//	req, resp := client.ListTagsForVaultRequest(params)
 //
-//    // Example sending a request using the ListTagsForVaultRequest method.
-//    req, resp := client.ListTagsForVaultRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) ListTagsForVaultRequest(input *ListTagsForVaultInput) (req *request.Request, output *ListTagsForVaultOutput) {
 	op := &request.Operation{
 		Name:       opListTagsForVault,
@@ -2779,20 +2757,20 @@ func (c *Glacier) ListTagsForVaultRequest(input *ListTagsForVaultInput) (req *re
 // See the AWS API reference guide for Amazon Glacier's
 // API operation ListTagsForVault for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+// Returned Error Types:
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) ListTagsForVault(input *ListTagsForVaultInput) (*ListTagsForVaultOutput, error) {
 	req, out := c.ListTagsForVaultRequest(input)
 	return out, req.Send()
@@ -2830,14 +2808,13 @@ const opListVaults = "ListVaults"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListVaultsRequest method.
+//	req, resp := client.ListVaultsRequest(params)
 //
-//    // Example sending a request using the ListVaultsRequest method.
-//    req, resp := client.ListVaultsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) ListVaultsRequest(input *ListVaultsInput) (req *request.Request, output *ListVaultsOutput) {
 	op := &request.Operation{
 		Name:       opListVaults,
@@ -2891,20 +2868,20 @@ func (c *Glacier) ListVaultsRequest(input *ListVaultsInput) (req *request.Reques
 // See the AWS API reference guide for Amazon Glacier's
 // API operation ListVaults for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) ListVaults(input *ListVaultsInput) (*ListVaultsOutput, error) {
 	req, out := c.ListVaultsRequest(input)
 	return out, req.Send()
@@ -2934,15 +2911,14 @@ func (c *Glacier) ListVaultsWithContext(ctx aws.Context, input *ListVaultsInput,
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListVaults operation.
-//    pageNum := 0
-//    err := client.ListVaultsPages(params,
-//        func(page *glacier.ListVaultsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListVaults operation.
+//	pageNum := 0
+//	err := client.ListVaultsPages(params,
+//	    func(page *glacier.ListVaultsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *Glacier) ListVaultsPages(input *ListVaultsInput, fn func(*ListVaultsOutput, bool) bool) error {
 	return c.ListVaultsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -2969,10 +2945,12 @@ func (c *Glacier) ListVaultsPagesWithContext(ctx aws.Context, input *ListVaultsI
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListVaultsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListVaultsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -2992,14 +2970,13 @@ const opPurchaseProvisionedCapacity = "PurchaseProvisionedCapacity"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PurchaseProvisionedCapacityRequest method.
+//	req, resp := client.PurchaseProvisionedCapacityRequest(params)
 //
-//    // Example sending a request using the PurchaseProvisionedCapacityRequest method.
-//    req, resp := client.PurchaseProvisionedCapacityRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) PurchaseProvisionedCapacityRequest(input *PurchaseProvisionedCapacityInput) (req *request.Request, output *PurchaseProvisionedCapacityOutput) {
 	op := &request.Operation{
 		Name:       opPurchaseProvisionedCapacity,
@@ -3027,19 +3004,19 @@ func (c *Glacier) PurchaseProvisionedCapacityRequest(input *PurchaseProvisionedC
 // See the AWS API reference guide for Amazon Glacier's
 // API operation PurchaseProvisionedCapacity for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+// Returned Error Types:
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   Returned if the request results in a vault or account limit being exceeded.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - LimitExceededException
+//     Returned if the request results in a vault or account limit being exceeded.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) PurchaseProvisionedCapacity(input *PurchaseProvisionedCapacityInput) (*PurchaseProvisionedCapacityOutput, error) {
 	req, out := c.PurchaseProvisionedCapacityRequest(input)
 	return out, req.Send()
@@ -3077,14 +3054,13 @@ const opRemoveTagsFromVault = "RemoveTagsFromVault"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the RemoveTagsFromVaultRequest method.
+//	req, resp := client.RemoveTagsFromVaultRequest(params)
 //
-//    // Example sending a request using the RemoveTagsFromVaultRequest method.
-//    req, resp := client.RemoveTagsFromVaultRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) RemoveTagsFromVaultRequest(input *RemoveTagsFromVaultInput) (req *request.Request, output *RemoveTagsFromVaultOutput) {
 	op := &request.Operation{
 		Name:       opRemoveTagsFromVault,
@@ -3117,20 +3093,20 @@ func (c *Glacier) RemoveTagsFromVaultRequest(input *RemoveTagsFromVaultInput) (r
 // See the AWS API reference guide for Amazon Glacier's
 // API operation RemoveTagsFromVault for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+// Returned Error Types:
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) RemoveTagsFromVault(input *RemoveTagsFromVaultInput) (*RemoveTagsFromVaultOutput, error) {
 	req, out := c.RemoveTagsFromVaultRequest(input)
 	return out, req.Send()
@@ -3168,14 +3144,13 @@ const opSetDataRetrievalPolicy = "SetDataRetrievalPolicy"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SetDataRetrievalPolicyRequest method.
+//	req, resp := client.SetDataRetrievalPolicyRequest(params)
 //
-//    // Example sending a request using the SetDataRetrievalPolicyRequest method.
-//    req, resp := client.SetDataRetrievalPolicyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) SetDataRetrievalPolicyRequest(input *SetDataRetrievalPolicyInput) (req *request.Request, output *SetDataRetrievalPolicyOutput) {
 	op := &request.Operation{
 		Name:       opSetDataRetrievalPolicy,
@@ -3210,16 +3185,16 @@ func (c *Glacier) SetDataRetrievalPolicyRequest(input *SetDataRetrievalPolicyInp
 // See the AWS API reference guide for Amazon Glacier's
 // API operation SetDataRetrievalPolicy for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+// Returned Error Types:
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) SetDataRetrievalPolicy(input *SetDataRetrievalPolicyInput) (*SetDataRetrievalPolicyOutput, error) {
 	req, out := c.SetDataRetrievalPolicyRequest(input)
 	return out, req.Send()
@@ -3257,14 +3232,13 @@ const opSetVaultAccessPolicy = "SetVaultAccessPolicy"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SetVaultAccessPolicyRequest method.
+//	req, resp := client.SetVaultAccessPolicyRequest(params)
 //
-//    // Example sending a request using the SetVaultAccessPolicyRequest method.
-//    req, resp := client.SetVaultAccessPolicyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) SetVaultAccessPolicyRequest(input *SetVaultAccessPolicyInput) (req *request.Request, output *SetVaultAccessPolicyOutput) {
 	op := &request.Operation{
 		Name:       opSetVaultAccessPolicy,
@@ -3299,20 +3273,20 @@ func (c *Glacier) SetVaultAccessPolicyRequest(input *SetVaultAccessPolicyInput)
 // See the AWS API reference guide for Amazon Glacier's
 // API operation SetVaultAccessPolicy for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) SetVaultAccessPolicy(input *SetVaultAccessPolicyInput) (*SetVaultAccessPolicyOutput, error) {
 	req, out := c.SetVaultAccessPolicyRequest(input)
 	return out, req.Send()
@@ -3350,14 +3324,13 @@ const opSetVaultNotifications = "SetVaultNotifications"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SetVaultNotificationsRequest method.
+//	req, resp := client.SetVaultNotificationsRequest(params)
 //
-//    // Example sending a request using the SetVaultNotificationsRequest method.
-//    req, resp := client.SetVaultNotificationsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) SetVaultNotificationsRequest(input *SetVaultNotificationsInput) (req *request.Request, output *SetVaultNotificationsOutput) {
 	op := &request.Operation{
 		Name:       opSetVaultNotifications,
@@ -3389,15 +3362,15 @@ func (c *Glacier) SetVaultNotificationsRequest(input *SetVaultNotificationsInput
 // notifications to the topic. You can configure a vault to publish a notification
 // for the following vault events:
 //
-//    * ArchiveRetrievalCompleted This event occurs when a job that was initiated
-//    for an archive retrieval is completed (InitiateJob). The status of the
-//    completed job can be "Succeeded" or "Failed". The notification sent to
-//    the SNS topic is the same output as returned from DescribeJob.
+//   - ArchiveRetrievalCompleted This event occurs when a job that was initiated
+//     for an archive retrieval is completed (InitiateJob). The status of the
+//     completed job can be "Succeeded" or "Failed". The notification sent to
+//     the SNS topic is the same output as returned from DescribeJob.
 //
-//    * InventoryRetrievalCompleted This event occurs when a job that was initiated
-//    for an inventory retrieval is completed (InitiateJob). The status of the
-//    completed job can be "Succeeded" or "Failed". The notification sent to
-//    the SNS topic is the same output as returned from DescribeJob.
+//   - InventoryRetrievalCompleted This event occurs when a job that was initiated
+//     for an inventory retrieval is completed (InitiateJob). The status of the
+//     completed job can be "Succeeded" or "Failed". The notification sent to
+//     the SNS topic is the same output as returned from DescribeJob.
 //
 // An AWS account has full permission to perform all operations (actions). However,
 // AWS Identity and Access Management (IAM) users don't have any permissions
@@ -3417,20 +3390,20 @@ func (c *Glacier) SetVaultNotificationsRequest(input *SetVaultNotificationsInput
 // See the AWS API reference guide for Amazon Glacier's
 // API operation SetVaultNotifications for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) SetVaultNotifications(input *SetVaultNotificationsInput) (*SetVaultNotificationsOutput, error) {
 	req, out := c.SetVaultNotificationsRequest(input)
 	return out, req.Send()
@@ -3468,14 +3441,13 @@ const opUploadArchive = "UploadArchive"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UploadArchiveRequest method.
+//	req, resp := client.UploadArchiveRequest(params)
 //
-//    // Example sending a request using the UploadArchiveRequest method.
-//    req, resp := client.UploadArchiveRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) UploadArchiveRequest(input *UploadArchiveInput) (req *request.Request, output *ArchiveCreationOutput) {
 	op := &request.Operation{
 		Name:       opUploadArchive,
@@ -3538,24 +3510,24 @@ func (c *Glacier) UploadArchiveRequest(input *UploadArchiveInput) (req *request.
 // See the AWS API reference guide for Amazon Glacier's
 // API operation UploadArchive for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeRequestTimeoutException "RequestTimeoutException"
-//   Returned if, when uploading an archive, Amazon S3 Glacier times out while
-//   receiving the upload.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - RequestTimeoutException
+//     Returned if, when uploading an archive, Amazon S3 Glacier times out while
+//     receiving the upload.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) UploadArchive(input *UploadArchiveInput) (*ArchiveCreationOutput, error) {
 	req, out := c.UploadArchiveRequest(input)
 	return out, req.Send()
@@ -3593,14 +3565,13 @@ const opUploadMultipartPart = "UploadMultipartPart"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UploadMultipartPartRequest method.
+//	req, resp := client.UploadMultipartPartRequest(params)
 //
-//    // Example sending a request using the UploadMultipartPartRequest method.
-//    req, resp := client.UploadMultipartPartRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 func (c *Glacier) UploadMultipartPartRequest(input *UploadMultipartPartInput) (req *request.Request, output *UploadMultipartPartOutput) {
 	op := &request.Operation{
 		Name:       opUploadMultipartPart,
@@ -3626,27 +3597,27 @@ func (c *Glacier) UploadMultipartPartRequest(input *UploadMultipartPartInput) (r
 // Amazon Glacier rejects your upload part request if any of the following conditions
 // is true:
 //
-//    * SHA256 tree hash does not matchTo ensure that part data is not corrupted
-//    in transmission, you compute a SHA256 tree hash of the part and include
-//    it in your request. Upon receiving the part data, Amazon S3 Glacier also
-//    computes a SHA256 tree hash. If these hash values don't match, the operation
-//    fails. For information about computing a SHA256 tree hash, see Computing
-//    Checksums (https://docs.aws.amazon.com/amazonglacier/latest/dev/checksum-calculations.html).
-//
-//    * Part size does not matchThe size of each part except the last must match
-//    the size specified in the corresponding InitiateMultipartUpload request.
-//    The size of the last part must be the same size as, or smaller than, the
-//    specified size. If you upload a part whose size is smaller than the part
-//    size you specified in your initiate multipart upload request and that
-//    part is not the last part, then the upload part request will succeed.
-//    However, the subsequent Complete Multipart Upload request will fail.
-//
-//    * Range does not alignThe byte range value in the request does not align
-//    with the part size specified in the corresponding initiate request. For
-//    example, if you specify a part size of 4194304 bytes (4 MB), then 0 to
-//    4194303 bytes (4 MB - 1) and 4194304 (4 MB) to 8388607 (8 MB - 1) are
-//    valid part ranges. However, if you set a range value of 2 MB to 6 MB,
-//    the range does not align with the part size and the upload will fail.
+//   - SHA256 tree hash does not matchTo ensure that part data is not corrupted
+//     in transmission, you compute a SHA256 tree hash of the part and include
+//     it in your request. Upon receiving the part data, Amazon S3 Glacier also
+//     computes a SHA256 tree hash. If these hash values don't match, the operation
+//     fails. For information about computing a SHA256 tree hash, see Computing
+//     Checksums (https://docs.aws.amazon.com/amazonglacier/latest/dev/checksum-calculations.html).
+//
+//   - Part size does not matchThe size of each part except the last must match
+//     the size specified in the corresponding InitiateMultipartUpload request.
+//     The size of the last part must be the same size as, or smaller than, the
+//     specified size. If you upload a part whose size is smaller than the part
+//     size you specified in your initiate multipart upload request and that
+//     part is not the last part, then the upload part request will succeed.
+//     However, the subsequent Complete Multipart Upload request will fail.
+//
+//   - Range does not alignThe byte range value in the request does not align
+//     with the part size specified in the corresponding initiate request. For
+//     example, if you specify a part size of 4194304 bytes (4 MB), then 0 to
+//     4194303 bytes (4 MB - 1) and 4194304 (4 MB) to 8388607 (8 MB - 1) are
+//     valid part ranges. However, if you set a range value of 2 MB to 6 MB,
+//     the range does not align with the part size and the upload will fail.
 //
 // This operation is idempotent. If you upload the same part multiple times,
 // the data included in the most recent request overwrites the previously uploaded
@@ -3670,24 +3641,24 @@ func (c *Glacier) UploadMultipartPartRequest(input *UploadMultipartPartInput) (r
 // See the AWS API reference guide for Amazon Glacier's
 // API operation UploadMultipartPart for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   Returned if the specified resource (such as a vault, upload ID, or job ID)
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParameterValueException "InvalidParameterValueException"
-//   Returned if a parameter of the request is incorrectly specified.
+//   - ResourceNotFoundException
+//     Returned if the specified resource (such as a vault, upload ID, or job ID)
+//     doesn't exist.
 //
-//   * ErrCodeMissingParameterValueException "MissingParameterValueException"
-//   Returned if a required header or parameter is missing from the request.
+//   - InvalidParameterValueException
+//     Returned if a parameter of the request is incorrectly specified.
 //
-//   * ErrCodeRequestTimeoutException "RequestTimeoutException"
-//   Returned if, when uploading an archive, Amazon S3 Glacier times out while
-//   receiving the upload.
+//   - MissingParameterValueException
+//     Returned if a required header or parameter is missing from the request.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   Returned if the service cannot complete the request.
+//   - RequestTimeoutException
+//     Returned if, when uploading an archive, Amazon S3 Glacier times out while
+//     receiving the upload.
 //
+//   - ServiceUnavailableException
+//     Returned if the service cannot complete the request.
 func (c *Glacier) UploadMultipartPart(input *UploadMultipartPartInput) (*UploadMultipartPartOutput, error) {
 	req, out := c.UploadMultipartPartRequest(input)
 	return out, req.Send()
@@ -3716,7 +3687,7 @@ func (c *Glacier) UploadMultipartPartWithContext(ctx aws.Context, input *UploadM
 // For conceptual information, see Working with Archives in Amazon S3 Glacier
 // (https://docs.aws.amazon.com/amazonglacier/latest/dev/working-with-archives.html).
 type AbortMultipartUploadInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AccountId value is the AWS account ID of the account that owns the vault.
 	// You can either specify an AWS account ID or optionally a single '-' (hyphen),
@@ -3738,12 +3709,20 @@ type AbortMultipartUploadInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AbortMultipartUploadInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AbortMultipartUploadInput) GoString() string {
 	return s.String()
 }
@@ -3798,19 +3777,27 @@ type AbortMultipartUploadOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AbortMultipartUploadOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AbortMultipartUploadOutput) GoString() string {
 	return s.String()
 }
 
 // The input values for AbortVaultLock.
 type AbortVaultLockInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AccountId value is the AWS account ID. This value must match the AWS
 	// account ID associated with the credentials used to sign the request. You
@@ -3828,12 +3815,20 @@ type AbortVaultLockInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AbortVaultLockInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AbortVaultLockInput) GoString() string {
 	return s.String()
 }
@@ -3876,12 +3871,20 @@ type AbortVaultLockOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AbortVaultLockOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AbortVaultLockOutput) GoString() string {
 	return s.String()
 }
@@ -3909,12 +3912,20 @@ type AddTagsToVaultInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AddTagsToVaultInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AddTagsToVaultInput) GoString() string {
 	return s.String()
 }
@@ -3963,12 +3974,20 @@ type AddTagsToVaultOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AddTagsToVaultOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AddTagsToVaultOutput) GoString() string {
 	return s.String()
 }
@@ -3991,12 +4010,20 @@ type ArchiveCreationOutput struct {
 	Location *string `location:"header" locationName:"Location" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ArchiveCreationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ArchiveCreationOutput) GoString() string {
 	return s.String()
 }
@@ -4046,12 +4073,20 @@ type CSVInput struct {
 	RecordDelimiter *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CSVInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CSVInput) GoString() string {
 	return s.String()
 }
@@ -4116,12 +4151,20 @@ type CSVOutput struct {
 	RecordDelimiter *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CSVOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CSVOutput) GoString() string {
 	return s.String()
 }
@@ -4162,7 +4205,7 @@ func (s *CSVOutput) SetRecordDelimiter(v string) *CSVOutput {
 // and saving the archive to the vault, Glacier returns the URI path of the
 // newly created archive resource.
 type CompleteMultipartUploadInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AccountId value is the AWS account ID of the account that owns the vault.
 	// You can either specify an AWS account ID or optionally a single '-' (hyphen),
@@ -4195,12 +4238,20 @@ type CompleteMultipartUploadInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CompleteMultipartUploadInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CompleteMultipartUploadInput) GoString() string {
 	return s.String()
 }
@@ -4265,7 +4316,7 @@ func (s *CompleteMultipartUploadInput) SetVaultName(v string) *CompleteMultipart
 
 // The input values for CompleteVaultLock.
 type CompleteVaultLockInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AccountId value is the AWS account ID. This value must match the AWS
 	// account ID associated with the credentials used to sign the request. You
@@ -4288,12 +4339,20 @@ type CompleteVaultLockInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CompleteVaultLockInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CompleteVaultLockInput) GoString() string {
 	return s.String()
 }
@@ -4348,19 +4407,27 @@ type CompleteVaultLockOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CompleteVaultLockOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CompleteVaultLockOutput) GoString() string {
 	return s.String()
 }
 
 // Provides options to create a vault.
 type CreateVaultInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AccountId value is the AWS account ID. This value must match the AWS
 	// account ID associated with the credentials used to sign the request. You
@@ -4378,12 +4445,20 @@ type CreateVaultInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateVaultInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateVaultInput) GoString() string {
 	return s.String()
 }
@@ -4430,12 +4505,20 @@ type CreateVaultOutput struct {
 	Location *string `location:"header" locationName:"Location" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateVaultOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateVaultOutput) GoString() string {
 	return s.String()
 }
@@ -4455,12 +4538,20 @@ type DataRetrievalPolicy struct {
 	Rules []*DataRetrievalRule `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DataRetrievalPolicy) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DataRetrievalPolicy) GoString() string {
 	return s.String()
 }
@@ -4488,12 +4579,20 @@ type DataRetrievalRule struct {
 	Strategy *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DataRetrievalRule) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DataRetrievalRule) GoString() string {
 	return s.String()
 }
@@ -4512,7 +4611,7 @@ func (s *DataRetrievalRule) SetStrategy(v string) *DataRetrievalRule {
 
 // Provides options for deleting an archive from an Amazon S3 Glacier vault.
 type DeleteArchiveInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AccountId value is the AWS account ID of the account that owns the vault.
 	// You can either specify an AWS account ID or optionally a single '-' (hyphen),
@@ -4534,12 +4633,20 @@ type DeleteArchiveInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteArchiveInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteArchiveInput) GoString() string {
 	return s.String()
 }
@@ -4594,19 +4701,27 @@ type DeleteArchiveOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteArchiveOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteArchiveOutput) GoString() string {
 	return s.String()
 }
 
 // DeleteVaultAccessPolicy input.
 type DeleteVaultAccessPolicyInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AccountId value is the AWS account ID of the account that owns the vault.
 	// You can either specify an AWS account ID or optionally a single '-' (hyphen),
@@ -4623,12 +4738,20 @@ type DeleteVaultAccessPolicyInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteVaultAccessPolicyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteVaultAccessPolicyInput) GoString() string {
 	return s.String()
 }
@@ -4671,19 +4794,27 @@ type DeleteVaultAccessPolicyOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteVaultAccessPolicyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteVaultAccessPolicyOutput) GoString() string {
 	return s.String()
 }
 
 // Provides options for deleting a vault from Amazon S3 Glacier.
 type DeleteVaultInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AccountId value is the AWS account ID of the account that owns the vault.
 	// You can either specify an AWS account ID or optionally a single '-' (hyphen),
@@ -4700,12 +4831,20 @@ type DeleteVaultInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteVaultInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteVaultInput) GoString() string {
 	return s.String()
 }
@@ -4747,7 +4886,7 @@ func (s *DeleteVaultInput) SetVaultName(v string) *DeleteVaultInput {
 // Provides options for deleting a vault notification configuration from an
 // Amazon Glacier vault.
 type DeleteVaultNotificationsInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AccountId value is the AWS account ID of the account that owns the vault.
 	// You can either specify an AWS account ID or optionally a single '-' (hyphen),
@@ -4764,12 +4903,20 @@ type DeleteVaultNotificationsInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteVaultNotificationsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteVaultNotificationsInput) GoString() string {
 	return s.String()
 }
@@ -4812,12 +4959,20 @@ type DeleteVaultNotificationsOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteVaultNotificationsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteVaultNotificationsOutput) GoString() string {
 	return s.String()
 }
@@ -4826,19 +4981,27 @@ type DeleteVaultOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteVaultOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteVaultOutput) GoString() string {
 	return s.String()
 }
 
 // Provides options for retrieving a job description.
 type DescribeJobInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AccountId value is the AWS account ID of the account that owns the vault.
 	// You can either specify an AWS account ID or optionally a single '-' (hyphen),
@@ -4860,12 +5023,20 @@ type DescribeJobInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeJobInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeJobInput) GoString() string {
 	return s.String()
 }
@@ -4918,7 +5089,7 @@ func (s *DescribeJobInput) SetVaultName(v string) *DescribeJobInput {
 
 // Provides options for retrieving metadata for a specific vault in Amazon Glacier.
 type DescribeVaultInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AccountId value is the AWS account ID of the account that owns the vault.
 	// You can either specify an AWS account ID or optionally a single '-' (hyphen),
@@ -4935,12 +5106,20 @@ type DescribeVaultInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeVaultInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeVaultInput) GoString() string {
 	return s.String()
 }
@@ -5009,12 +5188,20 @@ type DescribeVaultOutput struct {
 	VaultName *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeVaultOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeVaultOutput) GoString() string {
 	return s.String()
 }
@@ -5074,12 +5261,20 @@ type Encryption struct {
 	KMSKeyId *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Encryption) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Encryption) GoString() string {
 	return s.String()
 }
@@ -5104,7 +5299,7 @@ func (s *Encryption) SetKMSKeyId(v string) *Encryption {
 
 // Input for GetDataRetrievalPolicy.
 type GetDataRetrievalPolicyInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AccountId value is the AWS account ID. This value must match the AWS
 	// account ID associated with the credentials used to sign the request. You
@@ -5117,12 +5312,20 @@ type GetDataRetrievalPolicyInput struct {
 	AccountId *string `location:"uri" locationName:"accountId" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetDataRetrievalPolicyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetDataRetrievalPolicyInput) GoString() string {
 	return s.String()
 }
@@ -5157,12 +5360,20 @@ type GetDataRetrievalPolicyOutput struct {
 	Policy *DataRetrievalPolicy `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetDataRetrievalPolicyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetDataRetrievalPolicyOutput) GoString() string {
 	return s.String()
 }
@@ -5175,7 +5386,7 @@ func (s *GetDataRetrievalPolicyOutput) SetPolicy(v *DataRetrievalPolicy) *GetDat
 
 // Provides options for downloading output of an Amazon S3 Glacier job.
 type GetJobOutputInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AccountId value is the AWS account ID of the account that owns the vault.
 	// You can either specify an AWS account ID or optionally a single '-' (hyphen),
@@ -5228,12 +5439,20 @@ type GetJobOutputInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetJobOutputInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetJobOutputInput) GoString() string {
 	return s.String()
 }
@@ -5339,12 +5558,20 @@ type GetJobOutputOutput struct {
 	Status *int64 `location:"statusCode" locationName:"status" type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetJobOutputOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetJobOutputOutput) GoString() string {
 	return s.String()
 }
@@ -5393,7 +5620,7 @@ func (s *GetJobOutputOutput) SetStatus(v int64) *GetJobOutputOutput {
 
 // Input for GetVaultAccessPolicy.
 type GetVaultAccessPolicyInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AccountId value is the AWS account ID of the account that owns the vault.
 	// You can either specify an AWS account ID or optionally a single '-' (hyphen),
@@ -5410,12 +5637,20 @@ type GetVaultAccessPolicyInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetVaultAccessPolicyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetVaultAccessPolicyInput) GoString() string {
 	return s.String()
 }
@@ -5462,12 +5697,20 @@ type GetVaultAccessPolicyOutput struct {
 	Policy *VaultAccessPolicy `locationName:"policy" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetVaultAccessPolicyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetVaultAccessPolicyOutput) GoString() string {
 	return s.String()
 }
@@ -5480,7 +5723,7 @@ func (s *GetVaultAccessPolicyOutput) SetPolicy(v *VaultAccessPolicy) *GetVaultAc
 
 // The input values for GetVaultLock.
 type GetVaultLockInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AccountId value is the AWS account ID of the account that owns the vault.
 	// You can either specify an AWS account ID or optionally a single '-' (hyphen),
@@ -5497,12 +5740,20 @@ type GetVaultLockInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetVaultLockInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetVaultLockInput) GoString() string {
 	return s.String()
 }
@@ -5560,12 +5811,20 @@ type GetVaultLockOutput struct {
 	State *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetVaultLockOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetVaultLockOutput) GoString() string {
 	return s.String()
 }
@@ -5597,7 +5856,7 @@ func (s *GetVaultLockOutput) SetState(v string) *GetVaultLockOutput {
 // Provides options for retrieving the notification configuration set on an
 // Amazon Glacier vault.
 type GetVaultNotificationsInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AccountId value is the AWS account ID of the account that owns the vault.
 	// You can either specify an AWS account ID or optionally a single '-' (hyphen),
@@ -5614,12 +5873,20 @@ type GetVaultNotificationsInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetVaultNotificationsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetVaultNotificationsInput) GoString() string {
 	return s.String()
 }
@@ -5666,12 +5933,20 @@ type GetVaultNotificationsOutput struct {
 	VaultNotificationConfig *VaultNotificationConfig `locationName:"vaultNotificationConfig" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetVaultNotificationsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetVaultNotificationsOutput) GoString() string {
 	return s.String()
 }
@@ -5693,12 +5968,20 @@ type Grant struct {
 	Permission *string `type:"string" enum:"Permission"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Grant) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Grant) GoString() string {
 	return s.String()
 }
@@ -5752,12 +6035,20 @@ type Grantee struct {
 	URI *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Grantee) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Grantee) GoString() string {
 	return s.String()
 }
@@ -5827,12 +6118,20 @@ type InitiateJobInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InitiateJobInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InitiateJobInput) GoString() string {
 	return s.String()
 }
@@ -5896,12 +6195,20 @@ type InitiateJobOutput struct {
 	Location *string `location:"header" locationName:"Location" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InitiateJobOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InitiateJobOutput) GoString() string {
 	return s.String()
 }
@@ -5927,7 +6234,7 @@ func (s *InitiateJobOutput) SetLocation(v string) *InitiateJobOutput {
 // Provides options for initiating a multipart upload to an Amazon S3 Glacier
 // vault.
 type InitiateMultipartUploadInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AccountId value is the AWS account ID of the account that owns the vault.
 	// You can either specify an AWS account ID or optionally a single '-' (hyphen),
@@ -5956,12 +6263,20 @@ type InitiateMultipartUploadInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InitiateMultipartUploadInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InitiateMultipartUploadInput) GoString() string {
 	return s.String()
 }
@@ -6024,12 +6339,20 @@ type InitiateMultipartUploadOutput struct {
 	UploadId *string `location:"header" locationName:"x-amz-multipart-upload-id" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InitiateMultipartUploadOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InitiateMultipartUploadOutput) GoString() string {
 	return s.String()
 }
@@ -6069,12 +6392,20 @@ type InitiateVaultLockInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InitiateVaultLockInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InitiateVaultLockInput) GoString() string {
 	return s.String()
 }
@@ -6127,12 +6458,20 @@ type InitiateVaultLockOutput struct {
 	LockId *string `location:"header" locationName:"x-amz-lock-id" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InitiateVaultLockOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InitiateVaultLockOutput) GoString() string {
 	return s.String()
 }
@@ -6151,12 +6490,20 @@ type InputSerialization struct {
 	Csv *CSVInput `locationName:"csv" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InputSerialization) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InputSerialization) GoString() string {
 	return s.String()
 }
@@ -6167,6 +6514,147 @@ func (s *InputSerialization) SetCsv(v *CSVInput) *InputSerialization {
 	return s
 }
 
+// Returned if there is insufficient capacity to process this expedited request.
+// This error only applies to expedited retrievals and not to standard or bulk
+// retrievals.
+type InsufficientCapacityException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Code_ *string `locationName:"code" type:"string"`
+
+	Message_ *string `locationName:"message" type:"string"`
+
+	Type *string `locationName:"type" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InsufficientCapacityException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InsufficientCapacityException) GoString() string {
+	return s.String()
+}
+
+func newErrorInsufficientCapacityException(v protocol.ResponseMetadata) error {
+	return &InsufficientCapacityException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InsufficientCapacityException) Code() string {
+	return "InsufficientCapacityException"
+}
+
+// Message returns the exception's message.
+func (s *InsufficientCapacityException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InsufficientCapacityException) OrigErr() error {
+	return nil
+}
+
+func (s *InsufficientCapacityException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InsufficientCapacityException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InsufficientCapacityException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Returned if a parameter of the request is incorrectly specified.
+type InvalidParameterValueException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// 400 Bad Request
+	Code_ *string `locationName:"code" type:"string"`
+
+	// Returned if a parameter of the request is incorrectly specified.
+	Message_ *string `locationName:"message" type:"string"`
+
+	// Client
+	Type *string `locationName:"type" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidParameterValueException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidParameterValueException) GoString() string {
+	return s.String()
+}
+
+func newErrorInvalidParameterValueException(v protocol.ResponseMetadata) error {
+	return &InvalidParameterValueException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InvalidParameterValueException) Code() string {
+	return "InvalidParameterValueException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidParameterValueException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidParameterValueException) OrigErr() error {
+	return nil
+}
+
+func (s *InvalidParameterValueException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidParameterValueException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidParameterValueException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Describes the options for a range inventory retrieval job.
 type InventoryRetrievalJobDescription struct {
 	_ struct{} `type:"structure"`
@@ -6198,12 +6686,20 @@ type InventoryRetrievalJobDescription struct {
 	StartDate *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InventoryRetrievalJobDescription) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InventoryRetrievalJobDescription) GoString() string {
 	return s.String()
 }
@@ -6263,12 +6759,20 @@ type InventoryRetrievalJobInput struct {
 	StartDate *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InventoryRetrievalJobInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s InventoryRetrievalJobInput) GoString() string {
 	return s.String()
 }
@@ -6401,12 +6905,20 @@ type JobDescription struct {
 	VaultARN *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s JobDescription) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s JobDescription) GoString() string {
 	return s.String()
 }
@@ -6595,12 +7107,20 @@ type JobParameters struct {
 	Type *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s JobParameters) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s JobParameters) GoString() string {
 	return s.String()
 }
@@ -6680,9 +7200,80 @@ func (s *JobParameters) SetType(v string) *JobParameters {
 	return s
 }
 
+// Returned if the request results in a vault or account limit being exceeded.
+type LimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// 400 Bad Request
+	Code_ *string `locationName:"code" type:"string"`
+
+	// Returned if the request results in a vault limit or tags limit being exceeded.
+	Message_ *string `locationName:"message" type:"string"`
+
+	// Client
+	Type *string `locationName:"type" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) GoString() string {
+	return s.String()
+}
+
+func newErrorLimitExceededException(v protocol.ResponseMetadata) error {
+	return &LimitExceededException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *LimitExceededException) Code() string {
+	return "LimitExceededException"
+}
+
+// Message returns the exception's message.
+func (s *LimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *LimitExceededException) OrigErr() error {
+	return nil
+}
+
+func (s *LimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *LimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *LimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Provides options for retrieving a job list for an Amazon S3 Glacier vault.
 type ListJobsInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AccountId value is the AWS account ID of the account that owns the vault.
 	// You can either specify an AWS account ID or optionally a single '-' (hyphen),
@@ -6717,12 +7308,20 @@ type ListJobsInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListJobsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListJobsInput) GoString() string {
 	return s.String()
 }
@@ -6799,12 +7398,20 @@ type ListJobsOutput struct {
 	Marker *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListJobsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListJobsOutput) GoString() string {
 	return s.String()
 }
@@ -6824,7 +7431,7 @@ func (s *ListJobsOutput) SetMarker(v string) *ListJobsOutput {
 // Provides options for retrieving list of in-progress multipart uploads for
 // an Amazon Glacier vault.
 type ListMultipartUploadsInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AccountId value is the AWS account ID of the account that owns the vault.
 	// You can either specify an AWS account ID or optionally a single '-' (hyphen),
@@ -6852,12 +7459,20 @@ type ListMultipartUploadsInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListMultipartUploadsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListMultipartUploadsInput) GoString() string {
 	return s.String()
 }
@@ -6921,12 +7536,20 @@ type ListMultipartUploadsOutput struct {
 	UploadsList []*UploadListElement `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListMultipartUploadsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListMultipartUploadsOutput) GoString() string {
 	return s.String()
 }
@@ -6946,7 +7569,7 @@ func (s *ListMultipartUploadsOutput) SetUploadsList(v []*UploadListElement) *Lis
 // Provides options for retrieving a list of parts of an archive that have been
 // uploaded in a specific multipart upload.
 type ListPartsInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AccountId value is the AWS account ID of the account that owns the vault.
 	// You can either specify an AWS account ID or optionally a single '-' (hyphen),
@@ -6980,12 +7603,20 @@ type ListPartsInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPartsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPartsInput) GoString() string {
 	return s.String()
 }
@@ -7080,12 +7711,20 @@ type ListPartsOutput struct {
 	VaultARN *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPartsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPartsOutput) GoString() string {
 	return s.String()
 }
@@ -7133,7 +7772,7 @@ func (s *ListPartsOutput) SetVaultARN(v string) *ListPartsOutput {
 }
 
 type ListProvisionedCapacityInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AWS account ID of the account that owns the vault. You can either specify
 	// an AWS account ID or optionally a single '-' (hyphen), in which case Amazon
@@ -7145,12 +7784,20 @@ type ListProvisionedCapacityInput struct {
 	AccountId *string `location:"uri" locationName:"accountId" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListProvisionedCapacityInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListProvisionedCapacityInput) GoString() string {
 	return s.String()
 }
@@ -7184,12 +7831,20 @@ type ListProvisionedCapacityOutput struct {
 	ProvisionedCapacityList []*ProvisionedCapacityDescription `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListProvisionedCapacityOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListProvisionedCapacityOutput) GoString() string {
 	return s.String()
 }
@@ -7202,7 +7857,7 @@ func (s *ListProvisionedCapacityOutput) SetProvisionedCapacityList(v []*Provisio
 
 // The input value for ListTagsForVaultInput.
 type ListTagsForVaultInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AccountId value is the AWS account ID of the account that owns the vault.
 	// You can either specify an AWS account ID or optionally a single '-' (hyphen),
@@ -7219,12 +7874,20 @@ type ListTagsForVaultInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForVaultInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForVaultInput) GoString() string {
 	return s.String()
 }
@@ -7271,12 +7934,20 @@ type ListTagsForVaultOutput struct {
 	Tags map[string]*string `type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForVaultOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForVaultOutput) GoString() string {
 	return s.String()
 }
@@ -7290,7 +7961,7 @@ func (s *ListTagsForVaultOutput) SetTags(v map[string]*string) *ListTagsForVault
 // Provides options to retrieve the vault list owned by the calling user's account.
 // The list provides metadata information for each vault.
 type ListVaultsInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AccountId value is the AWS account ID. This value must match the AWS
 	// account ID associated with the credentials used to sign the request. You
@@ -7312,12 +7983,20 @@ type ListVaultsInput struct {
 	Marker *string `location:"querystring" locationName:"marker" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListVaultsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListVaultsInput) GoString() string {
 	return s.String()
 }
@@ -7368,12 +8047,20 @@ type ListVaultsOutput struct {
 	VaultList []*DescribeVaultOutput `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListVaultsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListVaultsOutput) GoString() string {
 	return s.String()
 }
@@ -7390,6 +8077,77 @@ func (s *ListVaultsOutput) SetVaultList(v []*DescribeVaultOutput) *ListVaultsOut
 	return s
 }
 
+// Returned if a required header or parameter is missing from the request.
+type MissingParameterValueException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// 400 Bad Request
+	Code_ *string `locationName:"code" type:"string"`
+
+	// Returned if no authentication data is found for the request.
+	Message_ *string `locationName:"message" type:"string"`
+
+	// Client.
+	Type *string `locationName:"type" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MissingParameterValueException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MissingParameterValueException) GoString() string {
+	return s.String()
+}
+
+func newErrorMissingParameterValueException(v protocol.ResponseMetadata) error {
+	return &MissingParameterValueException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *MissingParameterValueException) Code() string {
+	return "MissingParameterValueException"
+}
+
+// Message returns the exception's message.
+func (s *MissingParameterValueException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *MissingParameterValueException) OrigErr() error {
+	return nil
+}
+
+func (s *MissingParameterValueException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *MissingParameterValueException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *MissingParameterValueException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Contains information about the location where the select job results are
 // stored.
 type OutputLocation struct {
@@ -7399,12 +8157,20 @@ type OutputLocation struct {
 	S3 *S3Location `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OutputLocation) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OutputLocation) GoString() string {
 	return s.String()
 }
@@ -7438,12 +8204,20 @@ type OutputSerialization struct {
 	Csv *CSVOutput `locationName:"csv" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OutputSerialization) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OutputSerialization) GoString() string {
 	return s.String()
 }
@@ -7466,12 +8240,20 @@ type PartListElement struct {
 	SHA256TreeHash *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PartListElement) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PartListElement) GoString() string {
 	return s.String()
 }
@@ -7488,6 +8270,78 @@ func (s *PartListElement) SetSHA256TreeHash(v string) *PartListElement {
 	return s
 }
 
+// Returned if a retrieval job would exceed the current data policy's retrieval
+// rate limit. For more information about data retrieval policies,
+type PolicyEnforcedException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// PolicyEnforcedException
+	Code_ *string `locationName:"code" type:"string"`
+
+	// InitiateJob request denied by current data retrieval policy.
+	Message_ *string `locationName:"message" type:"string"`
+
+	// Client
+	Type *string `locationName:"type" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PolicyEnforcedException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PolicyEnforcedException) GoString() string {
+	return s.String()
+}
+
+func newErrorPolicyEnforcedException(v protocol.ResponseMetadata) error {
+	return &PolicyEnforcedException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *PolicyEnforcedException) Code() string {
+	return "PolicyEnforcedException"
+}
+
+// Message returns the exception's message.
+func (s *PolicyEnforcedException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *PolicyEnforcedException) OrigErr() error {
+	return nil
+}
+
+func (s *PolicyEnforcedException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *PolicyEnforcedException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *PolicyEnforcedException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // The definition for a provisioned capacity unit.
 type ProvisionedCapacityDescription struct {
 	_ struct{} `type:"structure"`
@@ -7504,12 +8358,20 @@ type ProvisionedCapacityDescription struct {
 	StartDate *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisionedCapacityDescription) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisionedCapacityDescription) GoString() string {
 	return s.String()
 }
@@ -7533,7 +8395,7 @@ func (s *ProvisionedCapacityDescription) SetStartDate(v string) *ProvisionedCapa
 }
 
 type PurchaseProvisionedCapacityInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The AWS account ID of the account that owns the vault. You can either specify
 	// an AWS account ID or optionally a single '-' (hyphen), in which case Amazon
@@ -7545,12 +8407,20 @@ type PurchaseProvisionedCapacityInput struct {
 	AccountId *string `location:"uri" locationName:"accountId" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PurchaseProvisionedCapacityInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PurchaseProvisionedCapacityInput) GoString() string {
 	return s.String()
 }
@@ -7584,12 +8454,20 @@ type PurchaseProvisionedCapacityOutput struct {
 	CapacityId *string `location:"header" locationName:"x-amz-capacity-id" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PurchaseProvisionedCapacityOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PurchaseProvisionedCapacityOutput) GoString() string {
 	return s.String()
 }
@@ -7622,12 +8500,20 @@ type RemoveTagsFromVaultInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemoveTagsFromVaultInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemoveTagsFromVaultInput) GoString() string {
 	return s.String()
 }
@@ -7676,16 +8562,170 @@ type RemoveTagsFromVaultOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemoveTagsFromVaultOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemoveTagsFromVaultOutput) GoString() string {
 	return s.String()
 }
 
+// Returned if, when uploading an archive, Amazon S3 Glacier times out while
+// receiving the upload.
+type RequestTimeoutException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// 408 Request Timeout
+	Code_ *string `locationName:"code" type:"string"`
+
+	// Returned if, when uploading an archive, Amazon S3 Glacier times out while
+	// receiving the upload.
+	Message_ *string `locationName:"message" type:"string"`
+
+	// Client
+	Type *string `locationName:"type" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RequestTimeoutException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RequestTimeoutException) GoString() string {
+	return s.String()
+}
+
+func newErrorRequestTimeoutException(v protocol.ResponseMetadata) error {
+	return &RequestTimeoutException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *RequestTimeoutException) Code() string {
+	return "RequestTimeoutException"
+}
+
+// Message returns the exception's message.
+func (s *RequestTimeoutException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *RequestTimeoutException) OrigErr() error {
+	return nil
+}
+
+func (s *RequestTimeoutException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *RequestTimeoutException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *RequestTimeoutException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Returned if the specified resource (such as a vault, upload ID, or job ID)
+// doesn't exist.
+type ResourceNotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// 404 Not Found
+	Code_ *string `locationName:"code" type:"string"`
+
+	// Returned if the specified resource (such as a vault, upload ID, or job ID)
+	// doesn't exist.
+	Message_ *string `locationName:"message" type:"string"`
+
+	// Client
+	Type *string `locationName:"type" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceNotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceNotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorResourceNotFoundException(v protocol.ResponseMetadata) error {
+	return &ResourceNotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ResourceNotFoundException) Code() string {
+	return "ResourceNotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *ResourceNotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ResourceNotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *ResourceNotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ResourceNotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ResourceNotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Contains information about the location in Amazon S3 where the select job
 // results are stored.
 type S3Location struct {
@@ -7717,12 +8757,20 @@ type S3Location struct {
 	UserMetadata map[string]*string `type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s S3Location) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s S3Location) GoString() string {
 	return s.String()
 }
@@ -7812,12 +8860,20 @@ type SelectParameters struct {
 	OutputSerialization *OutputSerialization `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SelectParameters) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SelectParameters) GoString() string {
 	return s.String()
 }
@@ -7846,6 +8902,77 @@ func (s *SelectParameters) SetOutputSerialization(v *OutputSerialization) *Selec
 	return s
 }
 
+// Returned if the service cannot complete the request.
+type ServiceUnavailableException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// 500 Internal Server Error
+	Code_ *string `locationName:"code" type:"string"`
+
+	// Returned if the service cannot complete the request.
+	Message_ *string `locationName:"message" type:"string"`
+
+	// Server
+	Type *string `locationName:"type" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceUnavailableException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceUnavailableException) GoString() string {
+	return s.String()
+}
+
+func newErrorServiceUnavailableException(v protocol.ResponseMetadata) error {
+	return &ServiceUnavailableException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ServiceUnavailableException) Code() string {
+	return "ServiceUnavailableException"
+}
+
+// Message returns the exception's message.
+func (s *ServiceUnavailableException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ServiceUnavailableException) OrigErr() error {
+	return nil
+}
+
+func (s *ServiceUnavailableException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ServiceUnavailableException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ServiceUnavailableException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // SetDataRetrievalPolicy input.
 type SetDataRetrievalPolicyInput struct {
 	_ struct{} `type:"structure"`
@@ -7864,12 +8991,20 @@ type SetDataRetrievalPolicyInput struct {
 	Policy *DataRetrievalPolicy `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetDataRetrievalPolicyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetDataRetrievalPolicyInput) GoString() string {
 	return s.String()
 }
@@ -7906,12 +9041,20 @@ type SetDataRetrievalPolicyOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetDataRetrievalPolicyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetDataRetrievalPolicyOutput) GoString() string {
 	return s.String()
 }
@@ -7938,12 +9081,20 @@ type SetVaultAccessPolicyInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetVaultAccessPolicyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetVaultAccessPolicyInput) GoString() string {
 	return s.String()
 }
@@ -7992,12 +9143,20 @@ type SetVaultAccessPolicyOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetVaultAccessPolicyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetVaultAccessPolicyOutput) GoString() string {
 	return s.String()
 }
@@ -8025,12 +9184,20 @@ type SetVaultNotificationsInput struct {
 	VaultNotificationConfig *VaultNotificationConfig `locationName:"vaultNotificationConfig" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetVaultNotificationsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetVaultNotificationsInput) GoString() string {
 	return s.String()
 }
@@ -8079,12 +9246,20 @@ type SetVaultNotificationsOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetVaultNotificationsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetVaultNotificationsOutput) GoString() string {
 	return s.String()
 }
@@ -8117,12 +9292,20 @@ type UploadArchiveInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UploadArchiveInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UploadArchiveInput) GoString() string {
 	return s.String()
 }
@@ -8202,12 +9385,20 @@ type UploadListElement struct {
 	VaultARN *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UploadListElement) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UploadListElement) GoString() string {
 	return s.String()
 }
@@ -8278,12 +9469,20 @@ type UploadMultipartPartInput struct {
 	VaultName *string `location:"uri" locationName:"vaultName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UploadMultipartPartInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UploadMultipartPartInput) GoString() string {
 	return s.String()
 }
@@ -8360,12 +9559,20 @@ type UploadMultipartPartOutput struct {
 	Checksum *string `location:"header" locationName:"x-amz-sha256-tree-hash" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UploadMultipartPartOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UploadMultipartPartOutput) GoString() string {
 	return s.String()
 }
@@ -8384,12 +9591,20 @@ type VaultAccessPolicy struct {
 	Policy *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VaultAccessPolicy) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VaultAccessPolicy) GoString() string {
 	return s.String()
 }
@@ -8408,12 +9623,20 @@ type VaultLockPolicy struct {
 	Policy *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VaultLockPolicy) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VaultLockPolicy) GoString() string {
 	return s.String()
 }
@@ -8437,12 +9660,20 @@ type VaultNotificationConfig struct {
 	SNSTopic *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VaultNotificationConfig) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VaultNotificationConfig) GoString() string {
 	return s.String()
 }
@@ -8470,6 +9701,15 @@ const (
 	ActionCodeSelect = "Select"
 )
 
+// ActionCode_Values returns all elements of the ActionCode enum
+func ActionCode_Values() []string {
+	return []string{
+		ActionCodeArchiveRetrieval,
+		ActionCodeInventoryRetrieval,
+		ActionCodeSelect,
+	}
+}
+
 const (
 	// CannedACLPrivate is a CannedACL enum value
 	CannedACLPrivate = "private"
@@ -8493,6 +9733,19 @@ const (
 	CannedACLBucketOwnerFullControl = "bucket-owner-full-control"
 )
 
+// CannedACL_Values returns all elements of the CannedACL enum
+func CannedACL_Values() []string {
+	return []string{
+		CannedACLPrivate,
+		CannedACLPublicRead,
+		CannedACLPublicReadWrite,
+		CannedACLAwsExecRead,
+		CannedACLAuthenticatedRead,
+		CannedACLBucketOwnerRead,
+		CannedACLBucketOwnerFullControl,
+	}
+}
+
 const (
 	// EncryptionTypeAwsKms is a EncryptionType enum value
 	EncryptionTypeAwsKms = "aws:kms"
@@ -8501,11 +9754,26 @@ const (
 	EncryptionTypeAes256 = "AES256"
 )
 
+// EncryptionType_Values returns all elements of the EncryptionType enum
+func EncryptionType_Values() []string {
+	return []string{
+		EncryptionTypeAwsKms,
+		EncryptionTypeAes256,
+	}
+}
+
 const (
 	// ExpressionTypeSql is a ExpressionType enum value
 	ExpressionTypeSql = "SQL"
 )
 
+// ExpressionType_Values returns all elements of the ExpressionType enum
+func ExpressionType_Values() []string {
+	return []string{
+		ExpressionTypeSql,
+	}
+}
+
 const (
 	// FileHeaderInfoUse is a FileHeaderInfo enum value
 	FileHeaderInfoUse = "USE"
@@ -8517,6 +9785,15 @@ const (
 	FileHeaderInfoNone = "NONE"
 )
 
+// FileHeaderInfo_Values returns all elements of the FileHeaderInfo enum
+func FileHeaderInfo_Values() []string {
+	return []string{
+		FileHeaderInfoUse,
+		FileHeaderInfoIgnore,
+		FileHeaderInfoNone,
+	}
+}
+
 const (
 	// PermissionFullControl is a Permission enum value
 	PermissionFullControl = "FULL_CONTROL"
@@ -8534,6 +9811,17 @@ const (
 	PermissionReadAcp = "READ_ACP"
 )
 
+// Permission_Values returns all elements of the Permission enum
+func Permission_Values() []string {
+	return []string{
+		PermissionFullControl,
+		PermissionWrite,
+		PermissionWriteAcp,
+		PermissionRead,
+		PermissionReadAcp,
+	}
+}
+
 const (
 	// QuoteFieldsAlways is a QuoteFields enum value
 	QuoteFieldsAlways = "ALWAYS"
@@ -8542,6 +9830,14 @@ const (
 	QuoteFieldsAsneeded = "ASNEEDED"
 )
 
+// QuoteFields_Values returns all elements of the QuoteFields enum
+func QuoteFields_Values() []string {
+	return []string{
+		QuoteFieldsAlways,
+		QuoteFieldsAsneeded,
+	}
+}
+
 const (
 	// StatusCodeInProgress is a StatusCode enum value
 	StatusCodeInProgress = "InProgress"
@@ -8553,6 +9849,15 @@ const (
 	StatusCodeFailed = "Failed"
 )
 
+// StatusCode_Values returns all elements of the StatusCode enum
+func StatusCode_Values() []string {
+	return []string{
+		StatusCodeInProgress,
+		StatusCodeSucceeded,
+		StatusCodeFailed,
+	}
+}
+
 const (
 	// StorageClassStandard is a StorageClass enum value
 	StorageClassStandard = "STANDARD"
@@ -8564,6 +9869,15 @@ const (
 	StorageClassStandardIa = "STANDARD_IA"
 )
 
+// StorageClass_Values returns all elements of the StorageClass enum
+func StorageClass_Values() []string {
+	return []string{
+		StorageClassStandard,
+		StorageClassReducedRedundancy,
+		StorageClassStandardIa,
+	}
+}
+
 const (
 	// TypeAmazonCustomerByEmail is a Type enum value
 	TypeAmazonCustomerByEmail = "AmazonCustomerByEmail"
@@ -8574,3 +9888,12 @@ const (
 	// TypeGroup is a Type enum value
 	TypeGroup = "Group"
 )
+
+// Type_Values returns all elements of the Type enum
+func Type_Values() []string {
+	return []string{
+		TypeAmazonCustomerByEmail,
+		TypeCanonicalUser,
+		TypeGroup,
+	}
+}
@@ -24,20 +24,22 @@
 // If you are a first-time user of Glacier, we recommend that you begin by reading
 // the following sections in the Amazon S3 Glacier Developer Guide:
 //
-//    * What is Amazon S3 Glacier (https://docs.aws.amazon.com/amazonglacier/latest/dev/introduction.html)
-//    - This section of the Developer Guide describes the underlying data model,
-//    the operations it supports, and the AWS SDKs that you can use to interact
-//    with the service.
+//   - What is Amazon S3 Glacier (https://docs.aws.amazon.com/amazonglacier/latest/dev/introduction.html)
 //
-//    * Getting Started with Amazon S3 Glacier (https://docs.aws.amazon.com/amazonglacier/latest/dev/amazon-glacier-getting-started.html)
-//    - The Getting Started section walks you through the process of creating
-//    a vault, uploading archives, creating jobs to download archives, retrieving
-//    the job output, and deleting archives.
+//   - This section of the Developer Guide describes the underlying data model,
+//     the operations it supports, and the AWS SDKs that you can use to interact
+//     with the service.
+//
+//   - Getting Started with Amazon S3 Glacier (https://docs.aws.amazon.com/amazonglacier/latest/dev/amazon-glacier-getting-started.html)
+//
+//   - The Getting Started section walks you through the process of creating
+//     a vault, uploading archives, creating jobs to download archives, retrieving
+//     the job output, and deleting archives.
 //
 // See glacier package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/glacier/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon Glacier with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
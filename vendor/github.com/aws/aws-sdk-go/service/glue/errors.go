@@ -2,6 +2,10 @@
 
 package glue
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeAccessDeniedException for service response error code
@@ -34,6 +38,12 @@ const (
 	// A specified condition was not satisfied.
 	ErrCodeConditionCheckFailureException = "ConditionCheckFailureException"
 
+	// ErrCodeConflictException for service response error code
+	// "ConflictException".
+	//
+	// The CreatePartitions API was called on a table that has indexes enabled.
+	ErrCodeConflictException = "ConflictException"
+
 	// ErrCodeCrawlerNotRunningException for service response error code
 	// "CrawlerNotRunningException".
 	//
@@ -64,12 +74,46 @@ const (
 	// A specified entity does not exist
 	ErrCodeEntityNotFoundException = "EntityNotFoundException"
 
+	// ErrCodeFederatedResourceAlreadyExistsException for service response error code
+	// "FederatedResourceAlreadyExistsException".
+	//
+	// A federated resource already exists.
+	ErrCodeFederatedResourceAlreadyExistsException = "FederatedResourceAlreadyExistsException"
+
+	// ErrCodeFederationSourceException for service response error code
+	// "FederationSourceException".
+	//
+	// A federation source failed.
+	ErrCodeFederationSourceException = "FederationSourceException"
+
+	// ErrCodeFederationSourceRetryableException for service response error code
+	// "FederationSourceRetryableException".
+	ErrCodeFederationSourceRetryableException = "FederationSourceRetryableException"
+
 	// ErrCodeIdempotentParameterMismatchException for service response error code
 	// "IdempotentParameterMismatchException".
 	//
 	// The same unique identifier was associated with two different records.
 	ErrCodeIdempotentParameterMismatchException = "IdempotentParameterMismatchException"
 
+	// ErrCodeIllegalBlueprintStateException for service response error code
+	// "IllegalBlueprintStateException".
+	//
+	// The blueprint is in an invalid state to perform a requested operation.
+	ErrCodeIllegalBlueprintStateException = "IllegalBlueprintStateException"
+
+	// ErrCodeIllegalSessionStateException for service response error code
+	// "IllegalSessionStateException".
+	//
+	// The session is in an invalid state to perform a requested operation.
+	ErrCodeIllegalSessionStateException = "IllegalSessionStateException"
+
+	// ErrCodeIllegalWorkflowStateException for service response error code
+	// "IllegalWorkflowStateException".
+	//
+	// The workflow is in an invalid state to perform a requested operation.
+	ErrCodeIllegalWorkflowStateException = "IllegalWorkflowStateException"
+
 	// ErrCodeInternalServiceException for service response error code
 	// "InternalServiceException".
 	//
@@ -82,6 +126,12 @@ const (
 	// The input provided was not valid.
 	ErrCodeInvalidInputException = "InvalidInputException"
 
+	// ErrCodeInvalidStateException for service response error code
+	// "InvalidStateException".
+	//
+	// An error that indicates your data is in an invalid state.
+	ErrCodeInvalidStateException = "InvalidStateException"
+
 	// ErrCodeMLTransformNotReadyException for service response error code
 	// "MLTransformNotReadyException".
 	//
@@ -100,6 +150,18 @@ const (
 	// The operation timed out.
 	ErrCodeOperationTimeoutException = "OperationTimeoutException"
 
+	// ErrCodePermissionTypeMismatchException for service response error code
+	// "PermissionTypeMismatchException".
+	//
+	// The operation timed out.
+	ErrCodePermissionTypeMismatchException = "PermissionTypeMismatchException"
+
+	// ErrCodeResourceNotReadyException for service response error code
+	// "ResourceNotReadyException".
+	//
+	// A resource was not ready for a transaction.
+	ErrCodeResourceNotReadyException = "ResourceNotReadyException"
+
 	// ErrCodeResourceNumberLimitExceededException for service response error code
 	// "ResourceNumberLimitExceededException".
 	//
@@ -136,3 +198,38 @@ const (
 	// There was a version conflict.
 	ErrCodeVersionMismatchException = "VersionMismatchException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"AccessDeniedException":                   newErrorAccessDeniedException,
+	"AlreadyExistsException":                  newErrorAlreadyExistsException,
+	"ConcurrentModificationException":         newErrorConcurrentModificationException,
+	"ConcurrentRunsExceededException":         newErrorConcurrentRunsExceededException,
+	"ConditionCheckFailureException":          newErrorConditionCheckFailureException,
+	"ConflictException":                       newErrorConflictException,
+	"CrawlerNotRunningException":              newErrorCrawlerNotRunningException,
+	"CrawlerRunningException":                 newErrorCrawlerRunningException,
+	"CrawlerStoppingException":                newErrorCrawlerStoppingException,
+	"GlueEncryptionException":                 newErrorEncryptionException,
+	"EntityNotFoundException":                 newErrorEntityNotFoundException,
+	"FederatedResourceAlreadyExistsException": newErrorFederatedResourceAlreadyExistsException,
+	"FederationSourceException":               newErrorFederationSourceException,
+	"FederationSourceRetryableException":      newErrorFederationSourceRetryableException,
+	"IdempotentParameterMismatchException":    newErrorIdempotentParameterMismatchException,
+	"IllegalBlueprintStateException":          newErrorIllegalBlueprintStateException,
+	"IllegalSessionStateException":            newErrorIllegalSessionStateException,
+	"IllegalWorkflowStateException":           newErrorIllegalWorkflowStateException,
+	"InternalServiceException":                newErrorInternalServiceException,
+	"InvalidInputException":                   newErrorInvalidInputException,
+	"InvalidStateException":                   newErrorInvalidStateException,
+	"MLTransformNotReadyException":            newErrorMLTransformNotReadyException,
+	"NoScheduleException":                     newErrorNoScheduleException,
+	"OperationTimeoutException":               newErrorOperationTimeoutException,
+	"PermissionTypeMismatchException":         newErrorPermissionTypeMismatchException,
+	"ResourceNotReadyException":               newErrorResourceNotReadyException,
+	"ResourceNumberLimitExceededException":    newErrorResourceNumberLimitExceededException,
+	"SchedulerNotRunningException":            newErrorSchedulerNotRunningException,
+	"SchedulerRunningException":               newErrorSchedulerRunningException,
+	"SchedulerTransitioningException":         newErrorSchedulerTransitioningException,
+	"ValidationException":                     newErrorValidationException,
+	"VersionMismatchException":                newErrorVersionMismatchException,
+}
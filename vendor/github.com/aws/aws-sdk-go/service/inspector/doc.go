@@ -12,7 +12,7 @@
 // See inspector package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/inspector/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon Inspector with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -3,22 +3,31 @@
 // Package iot provides the client and types for making API
 // requests to AWS IoT.
 //
-// AWS IoT provides secure, bi-directional communication between Internet-connected
+// IoT provides secure, bi-directional communication between Internet-connected
 // devices (such as sensors, actuators, embedded devices, or smart appliances)
-// and the AWS cloud. You can discover your custom IoT-Data endpoint to communicate
-// with, configure rules for data processing and integration with other services,
-// organize resources associated with each device (Registry), configure logging,
-// and create and manage policies and credentials to authenticate devices.
+// and the Amazon Web Services cloud. You can discover your custom IoT-Data
+// endpoint to communicate with, configure rules for data processing and integration
+// with other services, organize resources associated with each device (Registry),
+// configure logging, and create and manage policies and credentials to authenticate
+// devices.
 //
-// For more information about how AWS IoT works, see the Developer Guide (https://docs.aws.amazon.com/iot/latest/developerguide/aws-iot-how-it-works.html).
+// The service endpoints that expose this API are listed in Amazon Web Services
+// IoT Core Endpoints and Quotas (https://docs.aws.amazon.com/general/latest/gr/iot-core.html).
+// You must use the endpoint for the region that has the resources you want
+// to access.
 //
-// For information about how to use the credentials provider for AWS IoT, see
-// Authorizing Direct Calls to AWS Services (https://docs.aws.amazon.com/iot/latest/developerguide/authorizing-direct-aws.html).
+// The service name used by Amazon Web Services Signature Version 4 (https://docs.aws.amazon.com/general/latest/gr/signature-version-4.html)
+// to sign the request is: execute-api.
+//
+// For more information about how IoT works, see the Developer Guide (https://docs.aws.amazon.com/iot/latest/developerguide/aws-iot-how-it-works.html).
+//
+// For information about how to use the credentials provider for IoT, see Authorizing
+// Direct Calls to Amazon Web Services Services (https://docs.aws.amazon.com/iot/latest/developerguide/authorizing-direct-aws.html).
 //
 // See iot package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/iot/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact AWS IoT with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
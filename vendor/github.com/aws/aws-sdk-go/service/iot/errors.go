@@ -2,6 +2,10 @@
 
 package iot
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeCertificateConflictException for service response error code
@@ -24,6 +28,12 @@ const (
 	// The certificate is invalid.
 	ErrCodeCertificateValidationException = "CertificateValidationException"
 
+	// ErrCodeConflictException for service response error code
+	// "ConflictException".
+	//
+	// A resource with the same name already exists.
+	ErrCodeConflictException = "ConflictException"
+
 	// ErrCodeConflictingResourceUpdateException for service response error code
 	// "ConflictingResourceUpdateException".
 	//
@@ -55,6 +65,13 @@ const (
 	// An unexpected error has occurred.
 	ErrCodeInternalFailureException = "InternalFailureException"
 
+	// ErrCodeInternalServerException for service response error code
+	// "InternalServerException".
+	//
+	// Internal error from the service that indicates an unexpected error or that
+	// the service is unavailable.
+	ErrCodeInternalServerException = "InternalServerException"
+
 	// ErrCodeInvalidAggregationException for service response error code
 	// "InvalidAggregationException".
 	//
@@ -129,6 +146,12 @@ const (
 	// The resource registration failed.
 	ErrCodeResourceRegistrationFailureException = "ResourceRegistrationFailureException"
 
+	// ErrCodeServiceQuotaExceededException for service response error code
+	// "ServiceQuotaExceededException".
+	//
+	// A limit has been exceeded.
+	ErrCodeServiceQuotaExceededException = "ServiceQuotaExceededException"
+
 	// ErrCodeServiceUnavailableException for service response error code
 	// "ServiceUnavailableException".
 	//
@@ -174,6 +197,12 @@ const (
 	// You are not authorized to perform this operation.
 	ErrCodeUnauthorizedException = "UnauthorizedException"
 
+	// ErrCodeValidationException for service response error code
+	// "ValidationException".
+	//
+	// The request is not valid.
+	ErrCodeValidationException = "ValidationException"
+
 	// ErrCodeVersionConflictException for service response error code
 	// "VersionConflictException".
 	//
@@ -187,3 +216,39 @@ const (
 	// The number of policy versions exceeds the limit.
 	ErrCodeVersionsLimitExceededException = "VersionsLimitExceededException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"CertificateConflictException":         newErrorCertificateConflictException,
+	"CertificateStateException":            newErrorCertificateStateException,
+	"CertificateValidationException":       newErrorCertificateValidationException,
+	"ConflictException":                    newErrorConflictException,
+	"ConflictingResourceUpdateException":   newErrorConflictingResourceUpdateException,
+	"DeleteConflictException":              newErrorDeleteConflictException,
+	"IndexNotReadyException":               newErrorIndexNotReadyException,
+	"InternalException":                    newErrorInternalException,
+	"InternalFailureException":             newErrorInternalFailureException,
+	"InternalServerException":              newErrorInternalServerException,
+	"InvalidAggregationException":          newErrorInvalidAggregationException,
+	"InvalidQueryException":                newErrorInvalidQueryException,
+	"InvalidRequestException":              newErrorInvalidRequestException,
+	"InvalidResponseException":             newErrorInvalidResponseException,
+	"InvalidStateTransitionException":      newErrorInvalidStateTransitionException,
+	"LimitExceededException":               newErrorLimitExceededException,
+	"MalformedPolicyException":             newErrorMalformedPolicyException,
+	"NotConfiguredException":               newErrorNotConfiguredException,
+	"RegistrationCodeValidationException":  newErrorRegistrationCodeValidationException,
+	"ResourceAlreadyExistsException":       newErrorResourceAlreadyExistsException,
+	"ResourceNotFoundException":            newErrorResourceNotFoundException,
+	"ResourceRegistrationFailureException": newErrorResourceRegistrationFailureException,
+	"ServiceQuotaExceededException":        newErrorServiceQuotaExceededException,
+	"ServiceUnavailableException":          newErrorServiceUnavailableException,
+	"SqlParseException":                    newErrorSqlParseException,
+	"TaskAlreadyExistsException":           newErrorTaskAlreadyExistsException,
+	"ThrottlingException":                  newErrorThrottlingException,
+	"TransferAlreadyCompletedException":    newErrorTransferAlreadyCompletedException,
+	"TransferConflictException":            newErrorTransferConflictException,
+	"UnauthorizedException":                newErrorUnauthorizedException,
+	"ValidationException":                  newErrorValidationException,
+	"VersionConflictException":             newErrorVersionConflictException,
+	"VersionsLimitExceededException":       newErrorVersionsLimitExceededException,
+}
@@ -29,14 +29,13 @@ const opBatchPutMessage = "BatchPutMessage"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the BatchPutMessageRequest method.
+//	req, resp := client.BatchPutMessageRequest(params)
 //
-//    // Example sending a request using the BatchPutMessageRequest method.
-//    req, resp := client.BatchPutMessageRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/BatchPutMessage
 func (c *IoTAnalytics) BatchPutMessageRequest(input *BatchPutMessageInput) (req *request.Request, output *BatchPutMessageOutput) {
@@ -66,21 +65,22 @@ func (c *IoTAnalytics) BatchPutMessageRequest(input *BatchPutMessageInput) (req
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation BatchPutMessage for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/BatchPutMessage
 func (c *IoTAnalytics) BatchPutMessage(input *BatchPutMessageInput) (*BatchPutMessageOutput, error) {
@@ -120,14 +120,13 @@ const opCancelPipelineReprocessing = "CancelPipelineReprocessing"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CancelPipelineReprocessingRequest method.
+//	req, resp := client.CancelPipelineReprocessingRequest(params)
 //
-//    // Example sending a request using the CancelPipelineReprocessingRequest method.
-//    req, resp := client.CancelPipelineReprocessingRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/CancelPipelineReprocessing
 func (c *IoTAnalytics) CancelPipelineReprocessingRequest(input *CancelPipelineReprocessingInput) (req *request.Request, output *CancelPipelineReprocessingOutput) {
@@ -158,21 +157,22 @@ func (c *IoTAnalytics) CancelPipelineReprocessingRequest(input *CancelPipelineRe
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation CancelPipelineReprocessing for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/CancelPipelineReprocessing
 func (c *IoTAnalytics) CancelPipelineReprocessing(input *CancelPipelineReprocessingInput) (*CancelPipelineReprocessingOutput, error) {
@@ -212,14 +212,13 @@ const opCreateChannel = "CreateChannel"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateChannelRequest method.
+//	req, resp := client.CreateChannelRequest(params)
 //
-//    // Example sending a request using the CreateChannelRequest method.
-//    req, resp := client.CreateChannelRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/CreateChannel
 func (c *IoTAnalytics) CreateChannelRequest(input *CreateChannelInput) (req *request.Request, output *CreateChannelOutput) {
@@ -240,8 +239,8 @@ func (c *IoTAnalytics) CreateChannelRequest(input *CreateChannelInput) (req *req
 
 // CreateChannel API operation for AWS IoT Analytics.
 //
-// Creates a channel. A channel collects data from an MQTT topic and archives
-// the raw, unprocessed messages before publishing the data to a pipeline.
+// Used to create a channel. A channel collects data from an MQTT topic and
+// archives the raw, unprocessed messages before publishing the data to a pipeline.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -250,24 +249,25 @@ func (c *IoTAnalytics) CreateChannelRequest(input *CreateChannelInput) (req *req
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation CreateChannel for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeResourceAlreadyExistsException "ResourceAlreadyExistsException"
-//   A resource with the same name already exists.
+//   - ResourceAlreadyExistsException
+//     A resource with the same name already exists.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The command caused an internal limit to be exceeded.
+//   - LimitExceededException
+//     The command caused an internal limit to be exceeded.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/CreateChannel
 func (c *IoTAnalytics) CreateChannel(input *CreateChannelInput) (*CreateChannelOutput, error) {
@@ -307,14 +307,13 @@ const opCreateDataset = "CreateDataset"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateDatasetRequest method.
+//	req, resp := client.CreateDatasetRequest(params)
 //
-//    // Example sending a request using the CreateDatasetRequest method.
-//    req, resp := client.CreateDatasetRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/CreateDataset
 func (c *IoTAnalytics) CreateDatasetRequest(input *CreateDatasetInput) (req *request.Request, output *CreateDatasetOutput) {
@@ -335,11 +334,11 @@ func (c *IoTAnalytics) CreateDatasetRequest(input *CreateDatasetInput) (req *req
 
 // CreateDataset API operation for AWS IoT Analytics.
 //
-// Creates a data set. A data set stores data retrieved from a data store by
-// applying a "queryAction" (a SQL query) or a "containerAction" (executing
-// a containerized application). This operation creates the skeleton of a data
-// set. The data set can be populated manually by calling "CreateDatasetContent"
-// or automatically according to a "trigger" you specify.
+// Used to create a dataset. A dataset stores data retrieved from a data store
+// by applying a queryAction (a SQL query) or a containerAction (executing a
+// containerized application). This operation creates the skeleton of a dataset.
+// The dataset can be populated manually by calling CreateDatasetContent or
+// automatically according to a trigger you specify.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -348,24 +347,25 @@ func (c *IoTAnalytics) CreateDatasetRequest(input *CreateDatasetInput) (req *req
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation CreateDataset for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeResourceAlreadyExistsException "ResourceAlreadyExistsException"
-//   A resource with the same name already exists.
+//   - ResourceAlreadyExistsException
+//     A resource with the same name already exists.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The command caused an internal limit to be exceeded.
+//   - LimitExceededException
+//     The command caused an internal limit to be exceeded.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/CreateDataset
 func (c *IoTAnalytics) CreateDataset(input *CreateDatasetInput) (*CreateDatasetOutput, error) {
@@ -405,14 +405,13 @@ const opCreateDatasetContent = "CreateDatasetContent"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateDatasetContentRequest method.
+//	req, resp := client.CreateDatasetContentRequest(params)
 //
-//    // Example sending a request using the CreateDatasetContentRequest method.
-//    req, resp := client.CreateDatasetContentRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/CreateDatasetContent
 func (c *IoTAnalytics) CreateDatasetContentRequest(input *CreateDatasetContentInput) (req *request.Request, output *CreateDatasetContentOutput) {
@@ -433,8 +432,8 @@ func (c *IoTAnalytics) CreateDatasetContentRequest(input *CreateDatasetContentIn
 
 // CreateDatasetContent API operation for AWS IoT Analytics.
 //
-// Creates the content of a data set by applying a "queryAction" (a SQL query)
-// or a "containerAction" (executing a containerized application).
+// Creates the content of a dataset by applying a queryAction (a SQL query)
+// or a containerAction (executing a containerized application).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -443,21 +442,22 @@ func (c *IoTAnalytics) CreateDatasetContentRequest(input *CreateDatasetContentIn
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation CreateDatasetContent for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/CreateDatasetContent
 func (c *IoTAnalytics) CreateDatasetContent(input *CreateDatasetContentInput) (*CreateDatasetContentOutput, error) {
@@ -497,14 +497,13 @@ const opCreateDatastore = "CreateDatastore"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateDatastoreRequest method.
+//	req, resp := client.CreateDatastoreRequest(params)
 //
-//    // Example sending a request using the CreateDatastoreRequest method.
-//    req, resp := client.CreateDatastoreRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/CreateDatastore
 func (c *IoTAnalytics) CreateDatastoreRequest(input *CreateDatastoreInput) (req *request.Request, output *CreateDatastoreOutput) {
@@ -534,24 +533,25 @@ func (c *IoTAnalytics) CreateDatastoreRequest(input *CreateDatastoreInput) (req
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation CreateDatastore for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeResourceAlreadyExistsException "ResourceAlreadyExistsException"
-//   A resource with the same name already exists.
+//   - ResourceAlreadyExistsException
+//     A resource with the same name already exists.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The command caused an internal limit to be exceeded.
+//   - LimitExceededException
+//     The command caused an internal limit to be exceeded.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/CreateDatastore
 func (c *IoTAnalytics) CreateDatastore(input *CreateDatastoreInput) (*CreateDatastoreOutput, error) {
@@ -591,14 +591,13 @@ const opCreatePipeline = "CreatePipeline"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreatePipelineRequest method.
+//	req, resp := client.CreatePipelineRequest(params)
 //
-//    // Example sending a request using the CreatePipelineRequest method.
-//    req, resp := client.CreatePipelineRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/CreatePipeline
 func (c *IoTAnalytics) CreatePipelineRequest(input *CreatePipelineInput) (req *request.Request, output *CreatePipelineOutput) {
@@ -619,10 +618,10 @@ func (c *IoTAnalytics) CreatePipelineRequest(input *CreatePipelineInput) (req *r
 
 // CreatePipeline API operation for AWS IoT Analytics.
 //
-// Creates a pipeline. A pipeline consumes messages from one or more channels
-// and allows you to process the messages before storing them in a data store.
-// You must specify both a channel and a datastore activity and, optionally,
-// as many as 23 additional activities in the pipelineActivities array.
+// Creates a pipeline. A pipeline consumes messages from a channel and allows
+// you to process the messages before storing them in a data store. You must
+// specify both a channel and a datastore activity and, optionally, as many
+// as 23 additional activities in the pipelineActivities array.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -631,24 +630,25 @@ func (c *IoTAnalytics) CreatePipelineRequest(input *CreatePipelineInput) (req *r
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation CreatePipeline for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeResourceAlreadyExistsException "ResourceAlreadyExistsException"
-//   A resource with the same name already exists.
+//   - ResourceAlreadyExistsException
+//     A resource with the same name already exists.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The command caused an internal limit to be exceeded.
+//   - LimitExceededException
+//     The command caused an internal limit to be exceeded.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/CreatePipeline
 func (c *IoTAnalytics) CreatePipeline(input *CreatePipelineInput) (*CreatePipelineOutput, error) {
@@ -688,14 +688,13 @@ const opDeleteChannel = "DeleteChannel"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteChannelRequest method.
+//	req, resp := client.DeleteChannelRequest(params)
 //
-//    // Example sending a request using the DeleteChannelRequest method.
-//    req, resp := client.DeleteChannelRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/DeleteChannel
 func (c *IoTAnalytics) DeleteChannelRequest(input *DeleteChannelInput) (req *request.Request, output *DeleteChannelOutput) {
@@ -726,21 +725,22 @@ func (c *IoTAnalytics) DeleteChannelRequest(input *DeleteChannelInput) (req *req
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation DeleteChannel for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/DeleteChannel
 func (c *IoTAnalytics) DeleteChannel(input *DeleteChannelInput) (*DeleteChannelOutput, error) {
@@ -780,14 +780,13 @@ const opDeleteDataset = "DeleteDataset"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteDatasetRequest method.
+//	req, resp := client.DeleteDatasetRequest(params)
 //
-//    // Example sending a request using the DeleteDatasetRequest method.
-//    req, resp := client.DeleteDatasetRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/DeleteDataset
 func (c *IoTAnalytics) DeleteDatasetRequest(input *DeleteDatasetInput) (req *request.Request, output *DeleteDatasetOutput) {
@@ -809,10 +808,10 @@ func (c *IoTAnalytics) DeleteDatasetRequest(input *DeleteDatasetInput) (req *req
 
 // DeleteDataset API operation for AWS IoT Analytics.
 //
-// Deletes the specified data set.
+// Deletes the specified dataset.
 //
-// You do not have to delete the content of the data set before you perform
-// this operation.
+// You do not have to delete the content of the dataset before you perform this
+// operation.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -821,21 +820,22 @@ func (c *IoTAnalytics) DeleteDatasetRequest(input *DeleteDatasetInput) (req *req
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation DeleteDataset for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/DeleteDataset
 func (c *IoTAnalytics) DeleteDataset(input *DeleteDatasetInput) (*DeleteDatasetOutput, error) {
@@ -875,14 +875,13 @@ const opDeleteDatasetContent = "DeleteDatasetContent"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteDatasetContentRequest method.
+//	req, resp := client.DeleteDatasetContentRequest(params)
 //
-//    // Example sending a request using the DeleteDatasetContentRequest method.
-//    req, resp := client.DeleteDatasetContentRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/DeleteDatasetContent
 func (c *IoTAnalytics) DeleteDatasetContentRequest(input *DeleteDatasetContentInput) (req *request.Request, output *DeleteDatasetContentOutput) {
@@ -904,7 +903,7 @@ func (c *IoTAnalytics) DeleteDatasetContentRequest(input *DeleteDatasetContentIn
 
 // DeleteDatasetContent API operation for AWS IoT Analytics.
 //
-// Deletes the content of the specified data set.
+// Deletes the content of the specified dataset.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -913,21 +912,22 @@ func (c *IoTAnalytics) DeleteDatasetContentRequest(input *DeleteDatasetContentIn
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation DeleteDatasetContent for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/DeleteDatasetContent
 func (c *IoTAnalytics) DeleteDatasetContent(input *DeleteDatasetContentInput) (*DeleteDatasetContentOutput, error) {
@@ -967,14 +967,13 @@ const opDeleteDatastore = "DeleteDatastore"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteDatastoreRequest method.
+//	req, resp := client.DeleteDatastoreRequest(params)
 //
-//    // Example sending a request using the DeleteDatastoreRequest method.
-//    req, resp := client.DeleteDatastoreRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/DeleteDatastore
 func (c *IoTAnalytics) DeleteDatastoreRequest(input *DeleteDatastoreInput) (req *request.Request, output *DeleteDatastoreOutput) {
@@ -1005,21 +1004,22 @@ func (c *IoTAnalytics) DeleteDatastoreRequest(input *DeleteDatastoreInput) (req
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation DeleteDatastore for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/DeleteDatastore
 func (c *IoTAnalytics) DeleteDatastore(input *DeleteDatastoreInput) (*DeleteDatastoreOutput, error) {
@@ -1059,14 +1059,13 @@ const opDeletePipeline = "DeletePipeline"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeletePipelineRequest method.
+//	req, resp := client.DeletePipelineRequest(params)
 //
-//    // Example sending a request using the DeletePipelineRequest method.
-//    req, resp := client.DeletePipelineRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/DeletePipeline
 func (c *IoTAnalytics) DeletePipelineRequest(input *DeletePipelineInput) (req *request.Request, output *DeletePipelineOutput) {
@@ -1097,21 +1096,22 @@ func (c *IoTAnalytics) DeletePipelineRequest(input *DeletePipelineInput) (req *r
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation DeletePipeline for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/DeletePipeline
 func (c *IoTAnalytics) DeletePipeline(input *DeletePipelineInput) (*DeletePipelineOutput, error) {
@@ -1151,14 +1151,13 @@ const opDescribeChannel = "DescribeChannel"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeChannelRequest method.
+//	req, resp := client.DescribeChannelRequest(params)
 //
-//    // Example sending a request using the DescribeChannelRequest method.
-//    req, resp := client.DescribeChannelRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/DescribeChannel
 func (c *IoTAnalytics) DescribeChannelRequest(input *DescribeChannelInput) (req *request.Request, output *DescribeChannelOutput) {
@@ -1188,21 +1187,22 @@ func (c *IoTAnalytics) DescribeChannelRequest(input *DescribeChannelInput) (req
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation DescribeChannel for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/DescribeChannel
 func (c *IoTAnalytics) DescribeChannel(input *DescribeChannelInput) (*DescribeChannelOutput, error) {
@@ -1242,14 +1242,13 @@ const opDescribeDataset = "DescribeDataset"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeDatasetRequest method.
+//	req, resp := client.DescribeDatasetRequest(params)
 //
-//    // Example sending a request using the DescribeDatasetRequest method.
-//    req, resp := client.DescribeDatasetRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/DescribeDataset
 func (c *IoTAnalytics) DescribeDatasetRequest(input *DescribeDatasetInput) (req *request.Request, output *DescribeDatasetOutput) {
@@ -1270,7 +1269,7 @@ func (c *IoTAnalytics) DescribeDatasetRequest(input *DescribeDatasetInput) (req
 
 // DescribeDataset API operation for AWS IoT Analytics.
 //
-// Retrieves information about a data set.
+// Retrieves information about a dataset.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1279,21 +1278,22 @@ func (c *IoTAnalytics) DescribeDatasetRequest(input *DescribeDatasetInput) (req
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation DescribeDataset for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/DescribeDataset
 func (c *IoTAnalytics) DescribeDataset(input *DescribeDatasetInput) (*DescribeDatasetOutput, error) {
@@ -1333,14 +1333,13 @@ const opDescribeDatastore = "DescribeDatastore"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeDatastoreRequest method.
+//	req, resp := client.DescribeDatastoreRequest(params)
 //
-//    // Example sending a request using the DescribeDatastoreRequest method.
-//    req, resp := client.DescribeDatastoreRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/DescribeDatastore
 func (c *IoTAnalytics) DescribeDatastoreRequest(input *DescribeDatastoreInput) (req *request.Request, output *DescribeDatastoreOutput) {
@@ -1370,21 +1369,22 @@ func (c *IoTAnalytics) DescribeDatastoreRequest(input *DescribeDatastoreInput) (
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation DescribeDatastore for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/DescribeDatastore
 func (c *IoTAnalytics) DescribeDatastore(input *DescribeDatastoreInput) (*DescribeDatastoreOutput, error) {
@@ -1424,14 +1424,13 @@ const opDescribeLoggingOptions = "DescribeLoggingOptions"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeLoggingOptionsRequest method.
+//	req, resp := client.DescribeLoggingOptionsRequest(params)
 //
-//    // Example sending a request using the DescribeLoggingOptionsRequest method.
-//    req, resp := client.DescribeLoggingOptionsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/DescribeLoggingOptions
 func (c *IoTAnalytics) DescribeLoggingOptionsRequest(input *DescribeLoggingOptionsInput) (req *request.Request, output *DescribeLoggingOptionsOutput) {
@@ -1452,7 +1451,7 @@ func (c *IoTAnalytics) DescribeLoggingOptionsRequest(input *DescribeLoggingOptio
 
 // DescribeLoggingOptions API operation for AWS IoT Analytics.
 //
-// Retrieves the current settings of the AWS IoT Analytics logging options.
+// Retrieves the current settings of the IoT Analytics logging options.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1461,21 +1460,22 @@ func (c *IoTAnalytics) DescribeLoggingOptionsRequest(input *DescribeLoggingOptio
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation DescribeLoggingOptions for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/DescribeLoggingOptions
 func (c *IoTAnalytics) DescribeLoggingOptions(input *DescribeLoggingOptionsInput) (*DescribeLoggingOptionsOutput, error) {
@@ -1515,14 +1515,13 @@ const opDescribePipeline = "DescribePipeline"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribePipelineRequest method.
+//	req, resp := client.DescribePipelineRequest(params)
 //
-//    // Example sending a request using the DescribePipelineRequest method.
-//    req, resp := client.DescribePipelineRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/DescribePipeline
 func (c *IoTAnalytics) DescribePipelineRequest(input *DescribePipelineInput) (req *request.Request, output *DescribePipelineOutput) {
@@ -1552,21 +1551,22 @@ func (c *IoTAnalytics) DescribePipelineRequest(input *DescribePipelineInput) (re
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation DescribePipeline for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/DescribePipeline
 func (c *IoTAnalytics) DescribePipeline(input *DescribePipelineInput) (*DescribePipelineOutput, error) {
@@ -1606,14 +1606,13 @@ const opGetDatasetContent = "GetDatasetContent"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetDatasetContentRequest method.
+//	req, resp := client.GetDatasetContentRequest(params)
 //
-//    // Example sending a request using the GetDatasetContentRequest method.
-//    req, resp := client.GetDatasetContentRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/GetDatasetContent
 func (c *IoTAnalytics) GetDatasetContentRequest(input *GetDatasetContentInput) (req *request.Request, output *GetDatasetContentOutput) {
@@ -1634,7 +1633,7 @@ func (c *IoTAnalytics) GetDatasetContentRequest(input *GetDatasetContentInput) (
 
 // GetDatasetContent API operation for AWS IoT Analytics.
 //
-// Retrieves the contents of a data set as pre-signed URIs.
+// Retrieves the contents of a dataset as presigned URIs.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1643,21 +1642,22 @@ func (c *IoTAnalytics) GetDatasetContentRequest(input *GetDatasetContentInput) (
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation GetDatasetContent for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/GetDatasetContent
 func (c *IoTAnalytics) GetDatasetContent(input *GetDatasetContentInput) (*GetDatasetContentOutput, error) {
@@ -1697,14 +1697,13 @@ const opListChannels = "ListChannels"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListChannelsRequest method.
+//	req, resp := client.ListChannelsRequest(params)
 //
-//    // Example sending a request using the ListChannelsRequest method.
-//    req, resp := client.ListChannelsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/ListChannels
 func (c *IoTAnalytics) ListChannelsRequest(input *ListChannelsInput) (req *request.Request, output *ListChannelsOutput) {
@@ -1740,18 +1739,19 @@ func (c *IoTAnalytics) ListChannelsRequest(input *ListChannelsInput) (req *reque
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation ListChannels for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/ListChannels
 func (c *IoTAnalytics) ListChannels(input *ListChannelsInput) (*ListChannelsOutput, error) {
@@ -1783,15 +1783,14 @@ func (c *IoTAnalytics) ListChannelsWithContext(ctx aws.Context, input *ListChann
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListChannels operation.
-//    pageNum := 0
-//    err := client.ListChannelsPages(params,
-//        func(page *iotanalytics.ListChannelsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListChannels operation.
+//	pageNum := 0
+//	err := client.ListChannelsPages(params,
+//	    func(page *iotanalytics.ListChannelsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *IoTAnalytics) ListChannelsPages(input *ListChannelsInput, fn func(*ListChannelsOutput, bool) bool) error {
 	return c.ListChannelsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1818,10 +1817,12 @@ func (c *IoTAnalytics) ListChannelsPagesWithContext(ctx aws.Context, input *List
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListChannelsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListChannelsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -1841,14 +1842,13 @@ const opListDatasetContents = "ListDatasetContents"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListDatasetContentsRequest method.
+//	req, resp := client.ListDatasetContentsRequest(params)
 //
-//    // Example sending a request using the ListDatasetContentsRequest method.
-//    req, resp := client.ListDatasetContentsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/ListDatasetContents
 func (c *IoTAnalytics) ListDatasetContentsRequest(input *ListDatasetContentsInput) (req *request.Request, output *ListDatasetContentsOutput) {
@@ -1875,7 +1875,7 @@ func (c *IoTAnalytics) ListDatasetContentsRequest(input *ListDatasetContentsInpu
 
 // ListDatasetContents API operation for AWS IoT Analytics.
 //
-// Lists information about data set contents that have been created.
+// Lists information about dataset contents that have been created.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1884,21 +1884,22 @@ func (c *IoTAnalytics) ListDatasetContentsRequest(input *ListDatasetContentsInpu
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation ListDatasetContents for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
+//
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/ListDatasetContents
 func (c *IoTAnalytics) ListDatasetContents(input *ListDatasetContentsInput) (*ListDatasetContentsOutput, error) {
@@ -1930,15 +1931,14 @@ func (c *IoTAnalytics) ListDatasetContentsWithContext(ctx aws.Context, input *Li
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListDatasetContents operation.
-//    pageNum := 0
-//    err := client.ListDatasetContentsPages(params,
-//        func(page *iotanalytics.ListDatasetContentsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListDatasetContents operation.
+//	pageNum := 0
+//	err := client.ListDatasetContentsPages(params,
+//	    func(page *iotanalytics.ListDatasetContentsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *IoTAnalytics) ListDatasetContentsPages(input *ListDatasetContentsInput, fn func(*ListDatasetContentsOutput, bool) bool) error {
 	return c.ListDatasetContentsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1965,10 +1965,12 @@ func (c *IoTAnalytics) ListDatasetContentsPagesWithContext(ctx aws.Context, inpu
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListDatasetContentsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListDatasetContentsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -1988,14 +1990,13 @@ const opListDatasets = "ListDatasets"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListDatasetsRequest method.
+//	req, resp := client.ListDatasetsRequest(params)
 //
-//    // Example sending a request using the ListDatasetsRequest method.
-//    req, resp := client.ListDatasetsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/ListDatasets
 func (c *IoTAnalytics) ListDatasetsRequest(input *ListDatasetsInput) (req *request.Request, output *ListDatasetsOutput) {
@@ -2022,7 +2023,7 @@ func (c *IoTAnalytics) ListDatasetsRequest(input *ListDatasetsInput) (req *reque
 
 // ListDatasets API operation for AWS IoT Analytics.
 //
-// Retrieves information about data sets.
+// Retrieves information about datasets.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2031,18 +2032,19 @@ func (c *IoTAnalytics) ListDatasetsRequest(input *ListDatasetsInput) (req *reque
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation ListDatasets for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/ListDatasets
 func (c *IoTAnalytics) ListDatasets(input *ListDatasetsInput) (*ListDatasetsOutput, error) {
@@ -2074,15 +2076,14 @@ func (c *IoTAnalytics) ListDatasetsWithContext(ctx aws.Context, input *ListDatas
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListDatasets operation.
-//    pageNum := 0
-//    err := client.ListDatasetsPages(params,
-//        func(page *iotanalytics.ListDatasetsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListDatasets operation.
+//	pageNum := 0
+//	err := client.ListDatasetsPages(params,
+//	    func(page *iotanalytics.ListDatasetsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *IoTAnalytics) ListDatasetsPages(input *ListDatasetsInput, fn func(*ListDatasetsOutput, bool) bool) error {
 	return c.ListDatasetsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -2109,10 +2110,12 @@ func (c *IoTAnalytics) ListDatasetsPagesWithContext(ctx aws.Context, input *List
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListDatasetsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListDatasetsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -2132,14 +2135,13 @@ const opListDatastores = "ListDatastores"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListDatastoresRequest method.
+//	req, resp := client.ListDatastoresRequest(params)
 //
-//    // Example sending a request using the ListDatastoresRequest method.
-//    req, resp := client.ListDatastoresRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/ListDatastores
 func (c *IoTAnalytics) ListDatastoresRequest(input *ListDatastoresInput) (req *request.Request, output *ListDatastoresOutput) {
@@ -2175,18 +2177,19 @@ func (c *IoTAnalytics) ListDatastoresRequest(input *ListDatastoresInput) (req *r
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation ListDatastores for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
+//
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/ListDatastores
 func (c *IoTAnalytics) ListDatastores(input *ListDatastoresInput) (*ListDatastoresOutput, error) {
@@ -2218,15 +2221,14 @@ func (c *IoTAnalytics) ListDatastoresWithContext(ctx aws.Context, input *ListDat
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListDatastores operation.
-//    pageNum := 0
-//    err := client.ListDatastoresPages(params,
-//        func(page *iotanalytics.ListDatastoresOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListDatastores operation.
+//	pageNum := 0
+//	err := client.ListDatastoresPages(params,
+//	    func(page *iotanalytics.ListDatastoresOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *IoTAnalytics) ListDatastoresPages(input *ListDatastoresInput, fn func(*ListDatastoresOutput, bool) bool) error {
 	return c.ListDatastoresPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -2253,10 +2255,12 @@ func (c *IoTAnalytics) ListDatastoresPagesWithContext(ctx aws.Context, input *Li
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListDatastoresOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListDatastoresOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -2276,14 +2280,13 @@ const opListPipelines = "ListPipelines"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListPipelinesRequest method.
+//	req, resp := client.ListPipelinesRequest(params)
 //
-//    // Example sending a request using the ListPipelinesRequest method.
-//    req, resp := client.ListPipelinesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/ListPipelines
 func (c *IoTAnalytics) ListPipelinesRequest(input *ListPipelinesInput) (req *request.Request, output *ListPipelinesOutput) {
@@ -2319,18 +2322,19 @@ func (c *IoTAnalytics) ListPipelinesRequest(input *ListPipelinesInput) (req *req
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation ListPipelines for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/ListPipelines
 func (c *IoTAnalytics) ListPipelines(input *ListPipelinesInput) (*ListPipelinesOutput, error) {
@@ -2362,15 +2366,14 @@ func (c *IoTAnalytics) ListPipelinesWithContext(ctx aws.Context, input *ListPipe
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListPipelines operation.
-//    pageNum := 0
-//    err := client.ListPipelinesPages(params,
-//        func(page *iotanalytics.ListPipelinesOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListPipelines operation.
+//	pageNum := 0
+//	err := client.ListPipelinesPages(params,
+//	    func(page *iotanalytics.ListPipelinesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *IoTAnalytics) ListPipelinesPages(input *ListPipelinesInput, fn func(*ListPipelinesOutput, bool) bool) error {
 	return c.ListPipelinesPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -2397,10 +2400,12 @@ func (c *IoTAnalytics) ListPipelinesPagesWithContext(ctx aws.Context, input *Lis
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListPipelinesOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListPipelinesOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -2420,14 +2425,13 @@ const opListTagsForResource = "ListTagsForResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTagsForResourceRequest method.
+//	req, resp := client.ListTagsForResourceRequest(params)
 //
-//    // Example sending a request using the ListTagsForResourceRequest method.
-//    req, resp := client.ListTagsForResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/ListTagsForResource
 func (c *IoTAnalytics) ListTagsForResourceRequest(input *ListTagsForResourceInput) (req *request.Request, output *ListTagsForResourceOutput) {
@@ -2448,7 +2452,7 @@ func (c *IoTAnalytics) ListTagsForResourceRequest(input *ListTagsForResourceInpu
 
 // ListTagsForResource API operation for AWS IoT Analytics.
 //
-// Lists the tags (metadata) which you have assigned to the resource.
+// Lists the tags (metadata) that you have assigned to the resource.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2457,24 +2461,25 @@ func (c *IoTAnalytics) ListTagsForResourceRequest(input *ListTagsForResourceInpu
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation ListTagsForResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The command caused an internal limit to be exceeded.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+//   - LimitExceededException
+//     The command caused an internal limit to be exceeded.
+//
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/ListTagsForResource
 func (c *IoTAnalytics) ListTagsForResource(input *ListTagsForResourceInput) (*ListTagsForResourceOutput, error) {
@@ -2514,14 +2519,13 @@ const opPutLoggingOptions = "PutLoggingOptions"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PutLoggingOptionsRequest method.
+//	req, resp := client.PutLoggingOptionsRequest(params)
 //
-//    // Example sending a request using the PutLoggingOptionsRequest method.
-//    req, resp := client.PutLoggingOptionsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/PutLoggingOptions
 func (c *IoTAnalytics) PutLoggingOptionsRequest(input *PutLoggingOptionsInput) (req *request.Request, output *PutLoggingOptionsOutput) {
@@ -2543,13 +2547,12 @@ func (c *IoTAnalytics) PutLoggingOptionsRequest(input *PutLoggingOptionsInput) (
 
 // PutLoggingOptions API operation for AWS IoT Analytics.
 //
-// Sets or updates the AWS IoT Analytics logging options.
+// Sets or updates the IoT Analytics logging options.
 //
-// Note that if you update the value of any loggingOptions field, it takes up
-// to one minute for the change to take effect. Also, if you change the policy
-// attached to the role you specified in the roleArn field (for example, to
-// correct an invalid policy) it takes up to 5 minutes for that change to take
-// effect.
+// If you update the value of any loggingOptions field, it takes up to one minute
+// for the change to take effect. Also, if you change the policy attached to
+// the role you specified in the roleArn field (for example, to correct an invalid
+// policy), it takes up to five minutes for that change to take effect.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2558,18 +2561,19 @@ func (c *IoTAnalytics) PutLoggingOptionsRequest(input *PutLoggingOptionsInput) (
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation PutLoggingOptions for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
+//
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/PutLoggingOptions
 func (c *IoTAnalytics) PutLoggingOptions(input *PutLoggingOptionsInput) (*PutLoggingOptionsOutput, error) {
@@ -2609,14 +2613,13 @@ const opRunPipelineActivity = "RunPipelineActivity"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the RunPipelineActivityRequest method.
+//	req, resp := client.RunPipelineActivityRequest(params)
 //
-//    // Example sending a request using the RunPipelineActivityRequest method.
-//    req, resp := client.RunPipelineActivityRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/RunPipelineActivity
 func (c *IoTAnalytics) RunPipelineActivityRequest(input *RunPipelineActivityInput) (req *request.Request, output *RunPipelineActivityOutput) {
@@ -2646,18 +2649,19 @@ func (c *IoTAnalytics) RunPipelineActivityRequest(input *RunPipelineActivityInpu
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation RunPipelineActivity for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
+//
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/RunPipelineActivity
 func (c *IoTAnalytics) RunPipelineActivity(input *RunPipelineActivityInput) (*RunPipelineActivityOutput, error) {
@@ -2697,14 +2701,13 @@ const opSampleChannelData = "SampleChannelData"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SampleChannelDataRequest method.
+//	req, resp := client.SampleChannelDataRequest(params)
 //
-//    // Example sending a request using the SampleChannelDataRequest method.
-//    req, resp := client.SampleChannelDataRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/SampleChannelData
 func (c *IoTAnalytics) SampleChannelDataRequest(input *SampleChannelDataInput) (req *request.Request, output *SampleChannelDataOutput) {
@@ -2735,21 +2738,22 @@ func (c *IoTAnalytics) SampleChannelDataRequest(input *SampleChannelDataInput) (
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation SampleChannelData for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
+//
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/SampleChannelData
 func (c *IoTAnalytics) SampleChannelData(input *SampleChannelDataInput) (*SampleChannelDataOutput, error) {
@@ -2789,14 +2793,13 @@ const opStartPipelineReprocessing = "StartPipelineReprocessing"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the StartPipelineReprocessingRequest method.
+//	req, resp := client.StartPipelineReprocessingRequest(params)
 //
-//    // Example sending a request using the StartPipelineReprocessingRequest method.
-//    req, resp := client.StartPipelineReprocessingRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/StartPipelineReprocessing
 func (c *IoTAnalytics) StartPipelineReprocessingRequest(input *StartPipelineReprocessingInput) (req *request.Request, output *StartPipelineReprocessingOutput) {
@@ -2826,24 +2829,25 @@ func (c *IoTAnalytics) StartPipelineReprocessingRequest(input *StartPipelineRepr
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation StartPipelineReprocessing for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+// Returned Error Types:
 //
-//   * ErrCodeResourceAlreadyExistsException "ResourceAlreadyExistsException"
-//   A resource with the same name already exists.
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+//   - ResourceAlreadyExistsException
+//     A resource with the same name already exists.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
+//
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/StartPipelineReprocessing
 func (c *IoTAnalytics) StartPipelineReprocessing(input *StartPipelineReprocessingInput) (*StartPipelineReprocessingOutput, error) {
@@ -2883,14 +2887,13 @@ const opTagResource = "TagResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the TagResourceRequest method.
+//	req, resp := client.TagResourceRequest(params)
 //
-//    // Example sending a request using the TagResourceRequest method.
-//    req, resp := client.TagResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/TagResource
 func (c *IoTAnalytics) TagResourceRequest(input *TagResourceInput) (req *request.Request, output *TagResourceOutput) {
@@ -2912,7 +2915,7 @@ func (c *IoTAnalytics) TagResourceRequest(input *TagResourceInput) (req *request
 
 // TagResource API operation for AWS IoT Analytics.
 //
-// Adds to or modifies the tags of the given resource. Tags are metadata which
+// Adds to or modifies the tags of the given resource. Tags are metadata that
 // can be used to manage a resource.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
@@ -2922,24 +2925,25 @@ func (c *IoTAnalytics) TagResourceRequest(input *TagResourceInput) (req *request
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation TagResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The command caused an internal limit to be exceeded.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+//   - LimitExceededException
+//     The command caused an internal limit to be exceeded.
+//
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/TagResource
 func (c *IoTAnalytics) TagResource(input *TagResourceInput) (*TagResourceOutput, error) {
@@ -2979,14 +2983,13 @@ const opUntagResource = "UntagResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UntagResourceRequest method.
+//	req, resp := client.UntagResourceRequest(params)
 //
-//    // Example sending a request using the UntagResourceRequest method.
-//    req, resp := client.UntagResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/UntagResource
 func (c *IoTAnalytics) UntagResourceRequest(input *UntagResourceInput) (req *request.Request, output *UntagResourceOutput) {
@@ -3017,24 +3020,25 @@ func (c *IoTAnalytics) UntagResourceRequest(input *UntagResourceInput) (req *req
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation UntagResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The command caused an internal limit to be exceeded.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+//   - LimitExceededException
+//     The command caused an internal limit to be exceeded.
+//
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/UntagResource
 func (c *IoTAnalytics) UntagResource(input *UntagResourceInput) (*UntagResourceOutput, error) {
@@ -3074,14 +3078,13 @@ const opUpdateChannel = "UpdateChannel"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateChannelRequest method.
+//	req, resp := client.UpdateChannelRequest(params)
 //
-//    // Example sending a request using the UpdateChannelRequest method.
-//    req, resp := client.UpdateChannelRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/UpdateChannel
 func (c *IoTAnalytics) UpdateChannelRequest(input *UpdateChannelInput) (req *request.Request, output *UpdateChannelOutput) {
@@ -3103,7 +3106,7 @@ func (c *IoTAnalytics) UpdateChannelRequest(input *UpdateChannelInput) (req *req
 
 // UpdateChannel API operation for AWS IoT Analytics.
 //
-// Updates the settings of a channel.
+// Used to update the settings of a channel.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -3112,21 +3115,22 @@ func (c *IoTAnalytics) UpdateChannelRequest(input *UpdateChannelInput) (req *req
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation UpdateChannel for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
+//
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/UpdateChannel
 func (c *IoTAnalytics) UpdateChannel(input *UpdateChannelInput) (*UpdateChannelOutput, error) {
@@ -3166,14 +3170,13 @@ const opUpdateDataset = "UpdateDataset"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateDatasetRequest method.
+//	req, resp := client.UpdateDatasetRequest(params)
 //
-//    // Example sending a request using the UpdateDatasetRequest method.
-//    req, resp := client.UpdateDatasetRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/UpdateDataset
 func (c *IoTAnalytics) UpdateDatasetRequest(input *UpdateDatasetInput) (req *request.Request, output *UpdateDatasetOutput) {
@@ -3195,7 +3198,7 @@ func (c *IoTAnalytics) UpdateDatasetRequest(input *UpdateDatasetInput) (req *req
 
 // UpdateDataset API operation for AWS IoT Analytics.
 //
-// Updates the settings of a data set.
+// Updates the settings of a dataset.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -3204,21 +3207,22 @@ func (c *IoTAnalytics) UpdateDatasetRequest(input *UpdateDatasetInput) (req *req
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation UpdateDataset for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
+//
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/UpdateDataset
 func (c *IoTAnalytics) UpdateDataset(input *UpdateDatasetInput) (*UpdateDatasetOutput, error) {
@@ -3258,14 +3262,13 @@ const opUpdateDatastore = "UpdateDatastore"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateDatastoreRequest method.
+//	req, resp := client.UpdateDatastoreRequest(params)
 //
-//    // Example sending a request using the UpdateDatastoreRequest method.
-//    req, resp := client.UpdateDatastoreRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/UpdateDatastore
 func (c *IoTAnalytics) UpdateDatastoreRequest(input *UpdateDatastoreInput) (req *request.Request, output *UpdateDatastoreOutput) {
@@ -3287,7 +3290,7 @@ func (c *IoTAnalytics) UpdateDatastoreRequest(input *UpdateDatastoreInput) (req
 
 // UpdateDatastore API operation for AWS IoT Analytics.
 //
-// Updates the settings of a data store.
+// Used to update the settings of a data store.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -3296,21 +3299,22 @@ func (c *IoTAnalytics) UpdateDatastoreRequest(input *UpdateDatastoreInput) (req
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation UpdateDatastore for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
+//
+//   - ThrottlingException
+//     The request was denied due to request throttling.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/UpdateDatastore
 func (c *IoTAnalytics) UpdateDatastore(input *UpdateDatastoreInput) (*UpdateDatastoreOutput, error) {
@@ -3350,14 +3354,13 @@ const opUpdatePipeline = "UpdatePipeline"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdatePipelineRequest method.
+//	req, resp := client.UpdatePipelineRequest(params)
 //
-//    // Example sending a request using the UpdatePipelineRequest method.
-//    req, resp := client.UpdatePipelineRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/UpdatePipeline
 func (c *IoTAnalytics) UpdatePipelineRequest(input *UpdatePipelineInput) (req *request.Request, output *UpdatePipelineOutput) {
@@ -3390,24 +3393,25 @@ func (c *IoTAnalytics) UpdatePipelineRequest(input *UpdatePipelineInput) (req *r
 // See the AWS API reference guide for AWS IoT Analytics's
 // API operation UpdatePipeline for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request was not valid.
+// Returned Error Types:
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   A resource with the specified name could not be found.
+//   - InvalidRequestException
+//     The request was not valid.
 //
-//   * ErrCodeInternalFailureException "InternalFailureException"
-//   There was an internal failure.
+//   - ResourceNotFoundException
+//     A resource with the specified name could not be found.
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
-//   The service is temporarily unavailable.
+//   - InternalFailureException
+//     There was an internal failure.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The request was denied due to request throttling.
+//   - ServiceUnavailableException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The command caused an internal limit to be exceeded.
+//   - ThrottlingException
+//     The request was denied due to request throttling.
+//
+//   - LimitExceededException
+//     The command caused an internal limit to be exceeded.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/iotanalytics-2017-11-27/UpdatePipeline
 func (c *IoTAnalytics) UpdatePipeline(input *UpdatePipelineInput) (*UpdatePipelineOutput, error) {
@@ -3436,16 +3440,16 @@ func (c *IoTAnalytics) UpdatePipelineWithContext(ctx aws.Context, input *UpdateP
 type AddAttributesActivity struct {
 	_ struct{} `type:"structure"`
 
-	// A list of 1-50 "AttributeNameMapping" objects that map an existing attribute
+	// A list of 1-50 AttributeNameMapping objects that map an existing attribute
 	// to a new attribute.
 	//
 	// The existing attributes remain in the message, so if you want to remove the
-	// originals, use "RemoveAttributeActivity".
+	// originals, use RemoveAttributeActivity.
 	//
 	// Attributes is a required field
 	Attributes map[string]*string `locationName:"attributes" min:"1" type:"map" required:"true"`
 
-	// The name of the 'addAttributes' activity.
+	// The name of the addAttributes activity.
 	//
 	// Name is a required field
 	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
@@ -3454,12 +3458,20 @@ type AddAttributesActivity struct {
 	Next *string `locationName:"next" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AddAttributesActivity) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AddAttributesActivity) GoString() string {
 	return s.String()
 }
@@ -3517,17 +3529,25 @@ type BatchPutMessageErrorEntry struct {
 	// The message associated with the error.
 	ErrorMessage *string `locationName:"errorMessage" type:"string"`
 
-	// The ID of the message that caused the error. (See the value corresponding
-	// to the "messageId" key in the message object.)
+	// The ID of the message that caused the error. See the value corresponding
+	// to the messageId key in the message object.
 	MessageId *string `locationName:"messageId" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BatchPutMessageErrorEntry) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BatchPutMessageErrorEntry) GoString() string {
 	return s.String()
 }
@@ -3558,13 +3578,12 @@ type BatchPutMessageInput struct {
 	// ChannelName is a required field
 	ChannelName *string `locationName:"channelName" min:"1" type:"string" required:"true"`
 
-	// The list of messages to be sent. Each message has format: '{ "messageId":
-	// "string", "payload": "string"}'.
+	// The list of messages to be sent. Each message has the format: { "messageId":
+	// "string", "payload": "string"}.
 	//
-	// Note that the field names of message payloads (data) that you send to AWS
-	// IoT Analytics:
+	// The field names of message payloads (data) that you send to IoT Analytics:
 	//
-	//    * Must contain only alphanumeric characters and undescores (_); no other
+	//    * Must contain only alphanumeric characters and undescores (_). No other
 	//    special characters are allowed.
 	//
 	//    * Must begin with an alphabetic character or single underscore (_).
@@ -3573,9 +3592,9 @@ type BatchPutMessageInput struct {
 	//
 	//    * In regular expression terms: "^[A-Za-z_]([A-Za-z0-9]*|[A-Za-z0-9][A-Za-z0-9_]*)$".
 	//
-	//    * Cannot be greater than 255 characters.
+	//    * Cannot be more than 255 characters.
 	//
-	//    * Are case-insensitive. (Fields named "foo" and "FOO" in the same payload
+	//    * Are case insensitive. (Fields named foo and FOO in the same payload
 	//    are considered duplicates.)
 	//
 	// For example, {"temp_01": 29} or {"_temp_01": 29} are valid, but {"temp-01":
@@ -3585,12 +3604,20 @@ type BatchPutMessageInput struct {
 	Messages []*Message `locationName:"messages" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BatchPutMessageInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BatchPutMessageInput) GoString() string {
 	return s.String()
 }
@@ -3643,12 +3670,20 @@ type BatchPutMessageOutput struct {
 	BatchPutMessageErrorEntries []*BatchPutMessageErrorEntry `locationName:"batchPutMessageErrorEntries" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BatchPutMessageOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BatchPutMessageOutput) GoString() string {
 	return s.String()
 }
@@ -3660,25 +3695,33 @@ func (s *BatchPutMessageOutput) SetBatchPutMessageErrorEntries(v []*BatchPutMess
 }
 
 type CancelPipelineReprocessingInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The name of pipeline for which data reprocessing is canceled.
 	//
 	// PipelineName is a required field
 	PipelineName *string `location:"uri" locationName:"pipelineName" min:"1" type:"string" required:"true"`
 
-	// The ID of the reprocessing task (returned by "StartPipelineReprocessing").
+	// The ID of the reprocessing task (returned by StartPipelineReprocessing).
 	//
 	// ReprocessingId is a required field
 	ReprocessingId *string `location:"uri" locationName:"reprocessingId" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CancelPipelineReprocessingInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CancelPipelineReprocessingInput) GoString() string {
 	return s.String()
 }
@@ -3721,12 +3764,20 @@ type CancelPipelineReprocessingOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CancelPipelineReprocessingOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CancelPipelineReprocessingOutput) GoString() string {
 	return s.String()
 }
@@ -3742,6 +3793,15 @@ type Channel struct {
 	// When the channel was created.
 	CreationTime *time.Time `locationName:"creationTime" type:"timestamp"`
 
+	// The last time when a new message arrived in the channel.
+	//
+	// IoT Analytics updates this value at most once per minute for one channel.
+	// Hence, the lastMessageArrivalTime value is an approximation.
+	//
+	// This feature only applies to messages that arrived in the data store after
+	// October 23, 2020.
+	LastMessageArrivalTime *time.Time `locationName:"lastMessageArrivalTime" type:"timestamp"`
+
 	// When the channel was last updated.
 	LastUpdateTime *time.Time `locationName:"lastUpdateTime" type:"timestamp"`
 
@@ -3754,16 +3814,26 @@ type Channel struct {
 	// The status of the channel.
 	Status *string `locationName:"status" type:"string" enum:"ChannelStatus"`
 
-	// Where channel data is stored.
+	// Where channel data is stored. You can choose one of serviceManagedS3 or customerManagedS3
+	// storage. If not specified, the default is serviceManagedS3. You can't change
+	// this storage option after the channel is created.
 	Storage *ChannelStorage `locationName:"storage" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Channel) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Channel) GoString() string {
 	return s.String()
 }
@@ -3780,6 +3850,12 @@ func (s *Channel) SetCreationTime(v time.Time) *Channel {
 	return s
 }
 
+// SetLastMessageArrivalTime sets the LastMessageArrivalTime field's value.
+func (s *Channel) SetLastMessageArrivalTime(v time.Time) *Channel {
+	s.LastMessageArrivalTime = &v
+	return s
+}
+
 // SetLastUpdateTime sets the LastUpdateTime field's value.
 func (s *Channel) SetLastUpdateTime(v time.Time) *Channel {
 	s.LastUpdateTime = &v
@@ -3819,7 +3895,7 @@ type ChannelActivity struct {
 	// ChannelName is a required field
 	ChannelName *string `locationName:"channelName" min:"1" type:"string" required:"true"`
 
-	// The name of the 'channel' activity.
+	// The name of the channel activity.
 	//
 	// Name is a required field
 	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
@@ -3828,12 +3904,20 @@ type ChannelActivity struct {
 	Next *string `locationName:"next" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ChannelActivity) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ChannelActivity) GoString() string {
 	return s.String()
 }
@@ -3881,6 +3965,56 @@ func (s *ChannelActivity) SetNext(v string) *ChannelActivity {
 	return s
 }
 
+// Specifies one or more sets of channel messages.
+type ChannelMessages struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies one or more keys that identify the Amazon Simple Storage Service
+	// (Amazon S3) objects that save your channel messages.
+	//
+	// You must use the full path for the key.
+	//
+	// Example path: channel/mychannel/__dt=2020-02-29 00:00:00/1582940490000_1582940520000_123456789012_mychannel_0_2118.0.json.gz
+	S3Paths []*string `locationName:"s3Paths" min:"1" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ChannelMessages) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ChannelMessages) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ChannelMessages) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ChannelMessages"}
+	if s.S3Paths != nil && len(s.S3Paths) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("S3Paths", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetS3Paths sets the S3Paths field's value.
+func (s *ChannelMessages) SetS3Paths(v []*string) *ChannelMessages {
+	s.S3Paths = v
+	return s
+}
+
 // Statistics information about the channel.
 type ChannelStatistics struct {
 	_ struct{} `type:"structure"`
@@ -3889,12 +4023,20 @@ type ChannelStatistics struct {
 	Size *EstimatedResourceSize `locationName:"size" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ChannelStatistics) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ChannelStatistics) GoString() string {
 	return s.String()
 }
@@ -3905,24 +4047,36 @@ func (s *ChannelStatistics) SetSize(v *EstimatedResourceSize) *ChannelStatistics
 	return s
 }
 
-// Where channel data is stored.
+// Where channel data is stored. You may choose one of serviceManagedS3, customerManagedS3
+// storage. If not specified, the default is serviceManagedS3. This can't be
+// changed after creation of the channel.
 type ChannelStorage struct {
 	_ struct{} `type:"structure"`
 
-	// Use this to store channel data in an S3 bucket that you manage.
+	// Used to store channel data in an S3 bucket that you manage. If customer managed
+	// storage is selected, the retentionPeriod parameter is ignored. You can't
+	// change the choice of S3 storage after the data store is created.
 	CustomerManagedS3 *CustomerManagedChannelS3Storage `locationName:"customerManagedS3" type:"structure"`
 
-	// Use this to store channel data in an S3 bucket managed by the AWS IoT Analytics
-	// service.
+	// Used to store channel data in an S3 bucket managed by IoT Analytics. You
+	// can't change the choice of S3 storage after the data store is created.
 	ServiceManagedS3 *ServiceManagedChannelS3Storage `locationName:"serviceManagedS3" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ChannelStorage) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ChannelStorage) GoString() string {
 	return s.String()
 }
@@ -3961,17 +4115,24 @@ type ChannelStorageSummary struct {
 	// Used to store channel data in an S3 bucket that you manage.
 	CustomerManagedS3 *CustomerManagedChannelS3StorageSummary `locationName:"customerManagedS3" type:"structure"`
 
-	// Used to store channel data in an S3 bucket managed by the AWS IoT Analytics
-	// service.
+	// Used to store channel data in an S3 bucket managed by IoT Analytics.
 	ServiceManagedS3 *ServiceManagedChannelS3StorageSummary `locationName:"serviceManagedS3" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ChannelStorageSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ChannelStorageSummary) GoString() string {
 	return s.String()
 }
@@ -4001,6 +4162,15 @@ type ChannelSummary struct {
 	// When the channel was created.
 	CreationTime *time.Time `locationName:"creationTime" type:"timestamp"`
 
+	// The last time when a new message arrived in the channel.
+	//
+	// IoT Analytics updates this value at most once per minute for one channel.
+	// Hence, the lastMessageArrivalTime value is an approximation.
+	//
+	// This feature only applies to messages that arrived in the data store after
+	// October 23, 2020.
+	LastMessageArrivalTime *time.Time `locationName:"lastMessageArrivalTime" type:"timestamp"`
+
 	// The last time the channel was updated.
 	LastUpdateTime *time.Time `locationName:"lastUpdateTime" type:"timestamp"`
 
@@ -4008,12 +4178,20 @@ type ChannelSummary struct {
 	Status *string `locationName:"status" type:"string" enum:"ChannelStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ChannelSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ChannelSummary) GoString() string {
 	return s.String()
 }
@@ -4036,6 +4214,12 @@ func (s *ChannelSummary) SetCreationTime(v time.Time) *ChannelSummary {
 	return s
 }
 
+// SetLastMessageArrivalTime sets the LastMessageArrivalTime field's value.
+func (s *ChannelSummary) SetLastMessageArrivalTime(v time.Time) *ChannelSummary {
+	s.LastMessageArrivalTime = &v
+	return s
+}
+
 // SetLastUpdateTime sets the LastUpdateTime field's value.
 func (s *ChannelSummary) SetLastUpdateTime(v time.Time) *ChannelSummary {
 	s.LastUpdateTime = &v
@@ -4048,43 +4232,120 @@ func (s *ChannelSummary) SetStatus(v string) *ChannelSummary {
 	return s
 }
 
-// Information needed to run the "containerAction" to produce data set contents.
+// Contains information about a column that stores your data.
+type Column struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the column.
+	//
+	// Name is a required field
+	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
+
+	// The type of data. For more information about the supported data types, see
+	// Common data types (https://docs.aws.amazon.com/glue/latest/dg/aws-glue-api-common.html)
+	// in the Glue Developer Guide.
+	//
+	// Type is a required field
+	Type *string `locationName:"type" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Column) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Column) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *Column) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "Column"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+	if s.Type == nil {
+		invalidParams.Add(request.NewErrParamRequired("Type"))
+	}
+	if s.Type != nil && len(*s.Type) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Type", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetName sets the Name field's value.
+func (s *Column) SetName(v string) *Column {
+	s.Name = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *Column) SetType(v string) *Column {
+	s.Type = &v
+	return s
+}
+
+// Information required to run the containerAction to produce dataset contents.
 type ContainerDatasetAction struct {
 	_ struct{} `type:"structure"`
 
-	// The ARN of the role which gives permission to the system to access needed
-	// resources in order to run the "containerAction". This includes, at minimum,
-	// permission to retrieve the data set contents which are the input to the containerized
+	// The ARN of the role that gives permission to the system to access required
+	// resources to run the containerAction. This includes, at minimum, permission
+	// to retrieve the dataset contents that are the input to the containerized
 	// application.
 	//
 	// ExecutionRoleArn is a required field
 	ExecutionRoleArn *string `locationName:"executionRoleArn" min:"20" type:"string" required:"true"`
 
 	// The ARN of the Docker container stored in your account. The Docker container
-	// contains an application and needed support libraries and is used to generate
-	// data set contents.
+	// contains an application and required support libraries and is used to generate
+	// dataset contents.
 	//
 	// Image is a required field
 	Image *string `locationName:"image" type:"string" required:"true"`
 
-	// Configuration of the resource which executes the "containerAction".
+	// Configuration of the resource that executes the containerAction.
 	//
 	// ResourceConfiguration is a required field
 	ResourceConfiguration *ResourceConfiguration `locationName:"resourceConfiguration" type:"structure" required:"true"`
 
-	// The values of variables used within the context of the execution of the containerized
+	// The values of variables used in the context of the execution of the containerized
 	// application (basically, parameters passed to the application). Each variable
-	// must have a name and a value given by one of "stringValue", "datasetContentVersionValue",
-	// or "outputFileUriValue".
+	// must have a name and a value given by one of stringValue, datasetContentVersionValue,
+	// or outputFileUriValue.
 	Variables []*Variable `locationName:"variables" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ContainerDatasetAction) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ContainerDatasetAction) GoString() string {
 	return s.String()
 }
@@ -4158,22 +4419,33 @@ type CreateChannelInput struct {
 	// ChannelName is a required field
 	ChannelName *string `locationName:"channelName" min:"1" type:"string" required:"true"`
 
-	// Where channel data is stored.
+	// Where channel data is stored. You can choose one of serviceManagedS3 or customerManagedS3
+	// storage. If not specified, the default is serviceManagedS3. You can't change
+	// this storage option after the channel is created.
 	ChannelStorage *ChannelStorage `locationName:"channelStorage" type:"structure"`
 
-	// How long, in days, message data is kept for the channel.
+	// How long, in days, message data is kept for the channel. When customerManagedS3
+	// storage is selected, this parameter is ignored.
 	RetentionPeriod *RetentionPeriod `locationName:"retentionPeriod" type:"structure"`
 
 	// Metadata which can be used to manage the channel.
 	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateChannelInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateChannelInput) GoString() string {
 	return s.String()
 }
@@ -4254,12 +4526,20 @@ type CreateChannelOutput struct {
 	RetentionPeriod *RetentionPeriod `locationName:"retentionPeriod" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateChannelOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateChannelOutput) GoString() string {
 	return s.String()
 }
@@ -4285,18 +4565,31 @@ func (s *CreateChannelOutput) SetRetentionPeriod(v *RetentionPeriod) *CreateChan
 type CreateDatasetContentInput struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the data set.
+	// The name of the dataset.
 	//
 	// DatasetName is a required field
 	DatasetName *string `location:"uri" locationName:"datasetName" min:"1" type:"string" required:"true"`
+
+	// The version ID of the dataset content. To specify versionId for a dataset
+	// content, the dataset must use a DeltaTimer (https://docs.aws.amazon.com/iotanalytics/latest/APIReference/API_DeltaTime.html)
+	// filter.
+	VersionId *string `locationName:"versionId" min:"7" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateDatasetContentInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateDatasetContentInput) GoString() string {
 	return s.String()
 }
@@ -4310,6 +4603,9 @@ func (s *CreateDatasetContentInput) Validate() error {
 	if s.DatasetName != nil && len(*s.DatasetName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("DatasetName", 1))
 	}
+	if s.VersionId != nil && len(*s.VersionId) < 7 {
+		invalidParams.Add(request.NewErrParamMinLen("VersionId", 7))
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -4323,19 +4619,33 @@ func (s *CreateDatasetContentInput) SetDatasetName(v string) *CreateDatasetConte
 	return s
 }
 
+// SetVersionId sets the VersionId field's value.
+func (s *CreateDatasetContentInput) SetVersionId(v string) *CreateDatasetContentInput {
+	s.VersionId = &v
+	return s
+}
+
 type CreateDatasetContentOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The version ID of the data set contents which are being created.
+	// The version ID of the dataset contents that are being created.
 	VersionId *string `locationName:"versionId" min:"7" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateDatasetContentOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateDatasetContentOutput) GoString() string {
 	return s.String()
 }
@@ -4349,48 +4659,64 @@ func (s *CreateDatasetContentOutput) SetVersionId(v string) *CreateDatasetConten
 type CreateDatasetInput struct {
 	_ struct{} `type:"structure"`
 
-	// A list of actions that create the data set contents.
+	// A list of actions that create the dataset contents.
 	//
 	// Actions is a required field
 	Actions []*DatasetAction `locationName:"actions" min:"1" type:"list" required:"true"`
 
-	// When data set contents are created they are delivered to destinations specified
+	// When dataset contents are created, they are delivered to destinations specified
 	// here.
 	ContentDeliveryRules []*DatasetContentDeliveryRule `locationName:"contentDeliveryRules" type:"list"`
 
-	// The name of the data set.
+	// The name of the dataset.
 	//
 	// DatasetName is a required field
 	DatasetName *string `locationName:"datasetName" min:"1" type:"string" required:"true"`
 
-	// [Optional] How long, in days, versions of data set contents are kept for
-	// the data set. If not specified or set to null, versions of data set contents
-	// are retained for at most 90 days. The number of versions of data set contents
-	// retained is determined by the versioningConfiguration parameter. (For more
-	// information, see https://docs.aws.amazon.com/iotanalytics/latest/userguide/getting-started.html#aws-iot-analytics-dataset-versions)
+	// A list of data rules that send notifications to CloudWatch, when data arrives
+	// late. To specify lateDataRules, the dataset must use a DeltaTimer (https://docs.aws.amazon.com/iotanalytics/latest/APIReference/API_DeltaTime.html)
+	// filter.
+	LateDataRules []*LateDataRule `locationName:"lateDataRules" min:"1" type:"list"`
+
+	// Optional. How long, in days, versions of dataset contents are kept for the
+	// dataset. If not specified or set to null, versions of dataset contents are
+	// retained for at most 90 days. The number of versions of dataset contents
+	// retained is determined by the versioningConfiguration parameter. For more
+	// information, see Keeping Multiple Versions of IoT Analytics datasets (https://docs.aws.amazon.com/iotanalytics/latest/userguide/getting-started.html#aws-iot-analytics-dataset-versions)
+	// in the IoT Analytics User Guide.
 	RetentionPeriod *RetentionPeriod `locationName:"retentionPeriod" type:"structure"`
 
-	// Metadata which can be used to manage the data set.
+	// Metadata which can be used to manage the dataset.
 	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
 
-	// A list of triggers. A trigger causes data set contents to be populated at
-	// a specified time interval or when another data set's contents are created.
+	// A list of triggers. A trigger causes dataset contents to be populated at
+	// a specified time interval or when another dataset's contents are created.
 	// The list of triggers can be empty or contain up to five DataSetTrigger objects.
 	Triggers []*DatasetTrigger `locationName:"triggers" type:"list"`
 
-	// [Optional] How many versions of data set contents are kept. If not specified
+	// Optional. How many versions of dataset contents are kept. If not specified
 	// or set to null, only the latest version plus the latest succeeded version
-	// (if they are different) are kept for the time period specified by the "retentionPeriod"
-	// parameter. (For more information, see https://docs.aws.amazon.com/iotanalytics/latest/userguide/getting-started.html#aws-iot-analytics-dataset-versions)
+	// (if they are different) are kept for the time period specified by the retentionPeriod
+	// parameter. For more information, see Keeping Multiple Versions of IoT Analytics
+	// datasets (https://docs.aws.amazon.com/iotanalytics/latest/userguide/getting-started.html#aws-iot-analytics-dataset-versions)
+	// in the IoT Analytics User Guide.
 	VersioningConfiguration *VersioningConfiguration `locationName:"versioningConfiguration" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateDatasetInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateDatasetInput) GoString() string {
 	return s.String()
 }
@@ -4410,6 +4736,9 @@ func (s *CreateDatasetInput) Validate() error {
 	if s.DatasetName != nil && len(*s.DatasetName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("DatasetName", 1))
 	}
+	if s.LateDataRules != nil && len(s.LateDataRules) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("LateDataRules", 1))
+	}
 	if s.Tags != nil && len(s.Tags) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
 	}
@@ -4433,6 +4762,16 @@ func (s *CreateDatasetInput) Validate() error {
 			}
 		}
 	}
+	if s.LateDataRules != nil {
+		for i, v := range s.LateDataRules {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "LateDataRules", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
 	if s.RetentionPeriod != nil {
 		if err := s.RetentionPeriod.Validate(); err != nil {
 			invalidParams.AddNested("RetentionPeriod", err.(request.ErrInvalidParams))
@@ -4488,6 +4827,12 @@ func (s *CreateDatasetInput) SetDatasetName(v string) *CreateDatasetInput {
 	return s
 }
 
+// SetLateDataRules sets the LateDataRules field's value.
+func (s *CreateDatasetInput) SetLateDataRules(v []*LateDataRule) *CreateDatasetInput {
+	s.LateDataRules = v
+	return s
+}
+
 // SetRetentionPeriod sets the RetentionPeriod field's value.
 func (s *CreateDatasetInput) SetRetentionPeriod(v *RetentionPeriod) *CreateDatasetInput {
 	s.RetentionPeriod = v
@@ -4515,22 +4860,30 @@ func (s *CreateDatasetInput) SetVersioningConfiguration(v *VersioningConfigurati
 type CreateDatasetOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The ARN of the data set.
+	// The ARN of the dataset.
 	DatasetArn *string `locationName:"datasetArn" type:"string"`
 
-	// The name of the data set.
+	// The name of the dataset.
 	DatasetName *string `locationName:"datasetName" min:"1" type:"string"`
 
-	// How long, in days, data set contents are kept for the data set.
+	// How long, in days, dataset contents are kept for the dataset.
 	RetentionPeriod *RetentionPeriod `locationName:"retentionPeriod" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateDatasetOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateDatasetOutput) GoString() string {
 	return s.String()
 }
@@ -4561,22 +4914,45 @@ type CreateDatastoreInput struct {
 	// DatastoreName is a required field
 	DatastoreName *string `locationName:"datastoreName" min:"1" type:"string" required:"true"`
 
-	// Where data store data is stored.
+	// Contains information about the partition dimensions in a data store.
+	DatastorePartitions *DatastorePartitions `locationName:"datastorePartitions" type:"structure"`
+
+	// Where data in a data store is stored.. You can choose serviceManagedS3 storage,
+	// customerManagedS3 storage, or iotSiteWiseMultiLayerStorage storage. The default
+	// is serviceManagedS3. You can't change the choice of Amazon S3 storage after
+	// your data store is created.
 	DatastoreStorage *DatastoreStorage `locationName:"datastoreStorage" type:"structure"`
 
-	// How long, in days, message data is kept for the data store.
+	// Contains the configuration information of file formats. IoT Analytics data
+	// stores support JSON and Parquet (https://parquet.apache.org/).
+	//
+	// The default file format is JSON. You can specify only one format.
+	//
+	// You can't change the file format after you create the data store.
+	FileFormatConfiguration *FileFormatConfiguration `locationName:"fileFormatConfiguration" type:"structure"`
+
+	// How long, in days, message data is kept for the data store. When customerManagedS3
+	// storage is selected, this parameter is ignored.
 	RetentionPeriod *RetentionPeriod `locationName:"retentionPeriod" type:"structure"`
 
 	// Metadata which can be used to manage the data store.
 	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateDatastoreInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateDatastoreInput) GoString() string {
 	return s.String()
 }
@@ -4593,11 +4969,21 @@ func (s *CreateDatastoreInput) Validate() error {
 	if s.Tags != nil && len(s.Tags) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
 	}
+	if s.DatastorePartitions != nil {
+		if err := s.DatastorePartitions.Validate(); err != nil {
+			invalidParams.AddNested("DatastorePartitions", err.(request.ErrInvalidParams))
+		}
+	}
 	if s.DatastoreStorage != nil {
 		if err := s.DatastoreStorage.Validate(); err != nil {
 			invalidParams.AddNested("DatastoreStorage", err.(request.ErrInvalidParams))
 		}
 	}
+	if s.FileFormatConfiguration != nil {
+		if err := s.FileFormatConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("FileFormatConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
 	if s.RetentionPeriod != nil {
 		if err := s.RetentionPeriod.Validate(); err != nil {
 			invalidParams.AddNested("RetentionPeriod", err.(request.ErrInvalidParams))
@@ -4626,12 +5012,24 @@ func (s *CreateDatastoreInput) SetDatastoreName(v string) *CreateDatastoreInput
 	return s
 }
 
+// SetDatastorePartitions sets the DatastorePartitions field's value.
+func (s *CreateDatastoreInput) SetDatastorePartitions(v *DatastorePartitions) *CreateDatastoreInput {
+	s.DatastorePartitions = v
+	return s
+}
+
 // SetDatastoreStorage sets the DatastoreStorage field's value.
 func (s *CreateDatastoreInput) SetDatastoreStorage(v *DatastoreStorage) *CreateDatastoreInput {
 	s.DatastoreStorage = v
 	return s
 }
 
+// SetFileFormatConfiguration sets the FileFormatConfiguration field's value.
+func (s *CreateDatastoreInput) SetFileFormatConfiguration(v *FileFormatConfiguration) *CreateDatastoreInput {
+	s.FileFormatConfiguration = v
+	return s
+}
+
 // SetRetentionPeriod sets the RetentionPeriod field's value.
 func (s *CreateDatastoreInput) SetRetentionPeriod(v *RetentionPeriod) *CreateDatastoreInput {
 	s.RetentionPeriod = v
@@ -4657,12 +5055,20 @@ type CreateDatastoreOutput struct {
 	RetentionPeriod *RetentionPeriod `locationName:"retentionPeriod" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateDatastoreOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateDatastoreOutput) GoString() string {
 	return s.String()
 }
@@ -4688,15 +5094,15 @@ func (s *CreateDatastoreOutput) SetRetentionPeriod(v *RetentionPeriod) *CreateDa
 type CreatePipelineInput struct {
 	_ struct{} `type:"structure"`
 
-	// A list of "PipelineActivity" objects. Activities perform transformations
-	// on your messages, such as removing, renaming or adding message attributes;
-	// filtering messages based on attribute values; invoking your Lambda functions
-	// on messages for advanced processing; or performing mathematical transformations
-	// to normalize device data.
+	// A list of PipelineActivity objects. Activities perform transformations on
+	// your messages, such as removing, renaming or adding message attributes; filtering
+	// messages based on attribute values; invoking your Lambda unctions on messages
+	// for advanced processing; or performing mathematical transformations to normalize
+	// device data.
 	//
 	// The list can be 2-25 PipelineActivity objects and must contain both a channel
-	// and a datastore activity. Each entry in the list must contain only one activity,
-	// for example:
+	// and a datastore activity. Each entry in the list must contain only one activity.
+	// For example:
 	//
 	// pipelineActivities = [ { "channel": { ... } }, { "lambda": { ... } }, ...
 	// ]
@@ -4713,12 +5119,20 @@ type CreatePipelineInput struct {
 	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePipelineInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePipelineInput) GoString() string {
 	return s.String()
 }
@@ -4796,12 +5210,20 @@ type CreatePipelineOutput struct {
 	PipelineName *string `locationName:"pipelineName" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePipelineOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePipelineOutput) GoString() string {
 	return s.String()
 }
@@ -4818,33 +5240,44 @@ func (s *CreatePipelineOutput) SetPipelineName(v string) *CreatePipelineOutput {
 	return s
 }
 
-// Use this to store channel data in an S3 bucket that you manage.
+// Used to store channel data in an S3 bucket that you manage. If customer-managed
+// storage is selected, the retentionPeriod parameter is ignored. You can't
+// change the choice of S3 storage after the data store is created.
 type CustomerManagedChannelS3Storage struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the Amazon S3 bucket in which channel data is stored.
+	// The name of the S3 bucket in which channel data is stored.
 	//
 	// Bucket is a required field
 	Bucket *string `locationName:"bucket" min:"3" type:"string" required:"true"`
 
-	// The prefix used to create the keys of the channel data objects. Each object
-	// in an Amazon S3 bucket has a key that is its unique identifier within the
-	// bucket (each object in a bucket has exactly one key).
+	// (Optional) The prefix used to create the keys of the channel data objects.
+	// Each object in an S3 bucket has a key that is its unique identifier in the
+	// bucket. Each object in a bucket has exactly one key. The prefix must end
+	// with a forward slash (/).
 	KeyPrefix *string `locationName:"keyPrefix" min:"1" type:"string"`
 
-	// The ARN of the role which grants AWS IoT Analytics permission to interact
-	// with your Amazon S3 resources.
+	// The ARN of the role that grants IoT Analytics permission to interact with
+	// your Amazon S3 resources.
 	//
 	// RoleArn is a required field
 	RoleArn *string `locationName:"roleArn" min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CustomerManagedChannelS3Storage) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CustomerManagedChannelS3Storage) GoString() string {
 	return s.String()
 }
@@ -4896,25 +5329,34 @@ func (s *CustomerManagedChannelS3Storage) SetRoleArn(v string) *CustomerManagedC
 type CustomerManagedChannelS3StorageSummary struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the Amazon S3 bucket in which channel data is stored.
+	// The name of the S3 bucket in which channel data is stored.
 	Bucket *string `locationName:"bucket" min:"3" type:"string"`
 
-	// The prefix used to create the keys of the channel data objects. Each object
-	// in an Amazon S3 bucket has a key that is its unique identifier within the
-	// bucket (each object in a bucket has exactly one key).
+	// (Optional) The prefix used to create the keys of the channel data objects.
+	// Each object in an S3 bucket has a key that is its unique identifier within
+	// the bucket (each object in a bucket has exactly one key). The prefix must
+	// end with a forward slash (/).
 	KeyPrefix *string `locationName:"keyPrefix" min:"1" type:"string"`
 
-	// The ARN of the role which grants AWS IoT Analytics permission to interact
-	// with your Amazon S3 resources.
+	// The ARN of the role that grants IoT Analytics permission to interact with
+	// your Amazon S3 resources.
 	RoleArn *string `locationName:"roleArn" min:"20" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CustomerManagedChannelS3StorageSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CustomerManagedChannelS3StorageSummary) GoString() string {
 	return s.String()
 }
@@ -4937,33 +5379,44 @@ func (s *CustomerManagedChannelS3StorageSummary) SetRoleArn(v string) *CustomerM
 	return s
 }
 
-// Use this to store data store data in an S3 bucket that you manage.
+// S3-customer-managed; When you choose customer-managed storage, the retentionPeriod
+// parameter is ignored. You can't change the choice of Amazon S3 storage after
+// your data store is created.
 type CustomerManagedDatastoreS3Storage struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the Amazon S3 bucket in which data store data is stored.
+	// The name of the Amazon S3 bucket where your data is stored.
 	//
 	// Bucket is a required field
 	Bucket *string `locationName:"bucket" min:"3" type:"string" required:"true"`
 
-	// The prefix used to create the keys of the data store data objects. Each object
-	// in an Amazon S3 bucket has a key that is its unique identifier within the
-	// bucket (each object in a bucket has exactly one key).
+	// (Optional) The prefix used to create the keys of the data store data objects.
+	// Each object in an Amazon S3 bucket has a key that is its unique identifier
+	// in the bucket. Each object in a bucket has exactly one key. The prefix must
+	// end with a forward slash (/).
 	KeyPrefix *string `locationName:"keyPrefix" min:"1" type:"string"`
 
-	// The ARN of the role which grants AWS IoT Analytics permission to interact
-	// with your Amazon S3 resources.
+	// The ARN of the role that grants IoT Analytics permission to interact with
+	// your Amazon S3 resources.
 	//
 	// RoleArn is a required field
 	RoleArn *string `locationName:"roleArn" min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CustomerManagedDatastoreS3Storage) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CustomerManagedDatastoreS3Storage) GoString() string {
 	return s.String()
 }
@@ -5011,29 +5464,38 @@ func (s *CustomerManagedDatastoreS3Storage) SetRoleArn(v string) *CustomerManage
 	return s
 }
 
-// Used to store data store data in an S3 bucket that you manage.
+// Contains information about the data store that you manage.
 type CustomerManagedDatastoreS3StorageSummary struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the Amazon S3 bucket in which data store data is stored.
+	// The name of the Amazon S3 bucket where your data is stored.
 	Bucket *string `locationName:"bucket" min:"3" type:"string"`
 
-	// The prefix used to create the keys of the data store data objects. Each object
-	// in an Amazon S3 bucket has a key that is its unique identifier within the
-	// bucket (each object in a bucket has exactly one key).
+	// (Optional) The prefix used to create the keys of the data store data objects.
+	// Each object in an Amazon S3 bucket has a key that is its unique identifier
+	// in the bucket. Each object in a bucket has exactly one key. The prefix must
+	// end with a forward slash (/).
 	KeyPrefix *string `locationName:"keyPrefix" min:"1" type:"string"`
 
-	// The ARN of the role which grants AWS IoT Analytics permission to interact
-	// with your Amazon S3 resources.
+	// The ARN of the role that grants IoT Analytics permission to interact with
+	// your Amazon S3 resources.
 	RoleArn *string `locationName:"roleArn" min:"20" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CustomerManagedDatastoreS3StorageSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CustomerManagedDatastoreS3StorageSummary) GoString() string {
 	return s.String()
 }
@@ -5056,52 +5518,67 @@ func (s *CustomerManagedDatastoreS3StorageSummary) SetRoleArn(v string) *Custome
 	return s
 }
 
-// Information about a data set.
+// Information about a dataset.
 type Dataset struct {
 	_ struct{} `type:"structure"`
 
-	// The "DatasetAction" objects that automatically create the data set contents.
+	// The DatasetAction objects that automatically create the dataset contents.
 	Actions []*DatasetAction `locationName:"actions" min:"1" type:"list"`
 
-	// The ARN of the data set.
+	// The ARN of the dataset.
 	Arn *string `locationName:"arn" type:"string"`
 
-	// When data set contents are created they are delivered to destinations specified
+	// When dataset contents are created they are delivered to destinations specified
 	// here.
 	ContentDeliveryRules []*DatasetContentDeliveryRule `locationName:"contentDeliveryRules" type:"list"`
 
-	// When the data set was created.
+	// When the dataset was created.
 	CreationTime *time.Time `locationName:"creationTime" type:"timestamp"`
 
-	// The last time the data set was updated.
+	// The last time the dataset was updated.
 	LastUpdateTime *time.Time `locationName:"lastUpdateTime" type:"timestamp"`
 
-	// The name of the data set.
+	// A list of data rules that send notifications to CloudWatch, when data arrives
+	// late. To specify lateDataRules, the dataset must use a DeltaTimer (https://docs.aws.amazon.com/iotanalytics/latest/APIReference/API_DeltaTime.html)
+	// filter.
+	LateDataRules []*LateDataRule `locationName:"lateDataRules" min:"1" type:"list"`
+
+	// The name of the dataset.
 	Name *string `locationName:"name" min:"1" type:"string"`
 
-	// [Optional] How long, in days, message data is kept for the data set.
+	// Optional. How long, in days, message data is kept for the dataset.
 	RetentionPeriod *RetentionPeriod `locationName:"retentionPeriod" type:"structure"`
 
-	// The status of the data set.
+	// The status of the dataset.
 	Status *string `locationName:"status" type:"string" enum:"DatasetStatus"`
 
-	// The "DatasetTrigger" objects that specify when the data set is automatically
+	// The DatasetTrigger objects that specify when the dataset is automatically
 	// updated.
 	Triggers []*DatasetTrigger `locationName:"triggers" type:"list"`
 
-	// [Optional] How many versions of data set contents are kept. If not specified
+	// Optional. How many versions of dataset contents are kept. If not specified
 	// or set to null, only the latest version plus the latest succeeded version
-	// (if they are different) are kept for the time period specified by the "retentionPeriod"
-	// parameter. (For more information, see https://docs.aws.amazon.com/iotanalytics/latest/userguide/getting-started.html#aws-iot-analytics-dataset-versions)
+	// (if they are different) are kept for the time period specified by the retentionPeriod
+	// parameter. For more information, see Keeping Multiple Versions of IoT Analytics
+	// datasets (https://docs.aws.amazon.com/iotanalytics/latest/userguide/getting-started.html#aws-iot-analytics-dataset-versions)
+	// in the IoT Analytics User Guide.
 	VersioningConfiguration *VersioningConfiguration `locationName:"versioningConfiguration" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Dataset) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Dataset) GoString() string {
 	return s.String()
 }
@@ -5136,6 +5613,12 @@ func (s *Dataset) SetLastUpdateTime(v time.Time) *Dataset {
 	return s
 }
 
+// SetLateDataRules sets the LateDataRules field's value.
+func (s *Dataset) SetLateDataRules(v []*LateDataRule) *Dataset {
+	s.LateDataRules = v
+	return s
+}
+
 // SetName sets the Name field's value.
 func (s *Dataset) SetName(v string) *Dataset {
 	s.Name = &v
@@ -5166,31 +5649,39 @@ func (s *Dataset) SetVersioningConfiguration(v *VersioningConfiguration) *Datase
 	return s
 }
 
-// A "DatasetAction" object that specifies how data set contents are automatically
+// A DatasetAction object that specifies how dataset contents are automatically
 // created.
 type DatasetAction struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the data set action by which data set contents are automatically
+	// The name of the dataset action by which dataset contents are automatically
 	// created.
 	ActionName *string `locationName:"actionName" min:"1" type:"string"`
 
-	// Information which allows the system to run a containerized application in
-	// order to create the data set contents. The application must be in a Docker
-	// container along with any needed support libraries.
+	// Information that allows the system to run a containerized application to
+	// create the dataset contents. The application must be in a Docker container
+	// along with any required support libraries.
 	ContainerAction *ContainerDatasetAction `locationName:"containerAction" type:"structure"`
 
-	// An "SqlQueryDatasetAction" object that uses an SQL query to automatically
-	// create data set contents.
+	// An SqlQueryDatasetAction object that uses an SQL query to automatically create
+	// dataset contents.
 	QueryAction *SqlQueryDatasetAction `locationName:"queryAction" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatasetAction) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatasetAction) GoString() string {
 	return s.String()
 }
@@ -5236,23 +5727,31 @@ func (s *DatasetAction) SetQueryAction(v *SqlQueryDatasetAction) *DatasetAction
 	return s
 }
 
-// Information about the action which automatically creates the data set's contents.
+// Information about the action that automatically creates the dataset's contents.
 type DatasetActionSummary struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the action which automatically creates the data set's contents.
+	// The name of the action that automatically creates the dataset's contents.
 	ActionName *string `locationName:"actionName" min:"1" type:"string"`
 
-	// The type of action by which the data set's contents are automatically created.
+	// The type of action by which the dataset's contents are automatically created.
 	ActionType *string `locationName:"actionType" type:"string" enum:"DatasetActionType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatasetActionSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatasetActionSummary) GoString() string {
 	return s.String()
 }
@@ -5269,23 +5768,31 @@ func (s *DatasetActionSummary) SetActionType(v string) *DatasetActionSummary {
 	return s
 }
 
-// The destination to which data set contents are delivered.
+// The destination to which dataset contents are delivered.
 type DatasetContentDeliveryDestination struct {
 	_ struct{} `type:"structure"`
 
-	// Configuration information for delivery of data set contents to AWS IoT Events.
+	// Configuration information for delivery of dataset contents to IoT Events.
 	IotEventsDestinationConfiguration *IotEventsDestinationConfiguration `locationName:"iotEventsDestinationConfiguration" type:"structure"`
 
-	// Configuration information for delivery of data set contents to Amazon S3.
+	// Configuration information for delivery of dataset contents to Amazon S3.
 	S3DestinationConfiguration *S3DestinationConfiguration `locationName:"s3DestinationConfiguration" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatasetContentDeliveryDestination) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatasetContentDeliveryDestination) GoString() string {
 	return s.String()
 }
@@ -5322,26 +5829,34 @@ func (s *DatasetContentDeliveryDestination) SetS3DestinationConfiguration(v *S3D
 	return s
 }
 
-// When data set contents are created they are delivered to destination specified
+// When dataset contents are created, they are delivered to destination specified
 // here.
 type DatasetContentDeliveryRule struct {
 	_ struct{} `type:"structure"`
 
-	// The destination to which data set contents are delivered.
+	// The destination to which dataset contents are delivered.
 	//
 	// Destination is a required field
 	Destination *DatasetContentDeliveryDestination `locationName:"destination" type:"structure" required:"true"`
 
-	// The name of the data set content delivery rules entry.
+	// The name of the dataset content delivery rules entry.
 	EntryName *string `locationName:"entryName" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatasetContentDeliveryRule) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatasetContentDeliveryRule) GoString() string {
 	return s.String()
 }
@@ -5376,24 +5891,32 @@ func (s *DatasetContentDeliveryRule) SetEntryName(v string) *DatasetContentDeliv
 	return s
 }
 
-// The state of the data set contents and the reason they are in this state.
+// The state of the dataset contents and the reason they are in this state.
 type DatasetContentStatus struct {
 	_ struct{} `type:"structure"`
 
-	// The reason the data set contents are in this state.
+	// The reason the dataset contents are in this state.
 	Reason *string `locationName:"reason" type:"string"`
 
-	// The state of the data set contents. Can be one of "READY", "CREATING", "SUCCEEDED"
-	// or "FAILED".
+	// The state of the dataset contents. Can be one of READY, CREATING, SUCCEEDED,
+	// or FAILED.
 	State *string `locationName:"state" type:"string" enum:"DatasetContentState"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatasetContentStatus) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatasetContentStatus) GoString() string {
 	return s.String()
 }
@@ -5410,33 +5933,50 @@ func (s *DatasetContentStatus) SetState(v string) *DatasetContentStatus {
 	return s
 }
 
-// Summary information about data set contents.
+// Summary information about dataset contents.
 type DatasetContentSummary struct {
 	_ struct{} `type:"structure"`
 
-	// The actual time the creation of the data set contents was started.
+	// The time the dataset content status was updated to SUCCEEDED or FAILED.
+	CompletionTime *time.Time `locationName:"completionTime" type:"timestamp"`
+
+	// The actual time the creation of the dataset contents was started.
 	CreationTime *time.Time `locationName:"creationTime" type:"timestamp"`
 
-	// The time the creation of the data set contents was scheduled to start.
+	// The time the creation of the dataset contents was scheduled to start.
 	ScheduleTime *time.Time `locationName:"scheduleTime" type:"timestamp"`
 
-	// The status of the data set contents.
+	// The status of the dataset contents.
 	Status *DatasetContentStatus `locationName:"status" type:"structure"`
 
-	// The version of the data set contents.
+	// The version of the dataset contents.
 	Version *string `locationName:"version" min:"7" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatasetContentSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatasetContentSummary) GoString() string {
 	return s.String()
 }
 
+// SetCompletionTime sets the CompletionTime field's value.
+func (s *DatasetContentSummary) SetCompletionTime(v time.Time) *DatasetContentSummary {
+	s.CompletionTime = &v
+	return s
+}
+
 // SetCreationTime sets the CreationTime field's value.
 func (s *DatasetContentSummary) SetCreationTime(v time.Time) *DatasetContentSummary {
 	s.CreationTime = &v
@@ -5461,23 +6001,31 @@ func (s *DatasetContentSummary) SetVersion(v string) *DatasetContentSummary {
 	return s
 }
 
-// The data set whose latest contents are used as input to the notebook or application.
+// The dataset whose latest contents are used as input to the notebook or application.
 type DatasetContentVersionValue struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the data set whose latest contents are used as input to the notebook
+	// The name of the dataset whose latest contents are used as input to the notebook
 	// or application.
 	//
 	// DatasetName is a required field
 	DatasetName *string `locationName:"datasetName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatasetContentVersionValue) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatasetContentVersionValue) GoString() string {
 	return s.String()
 }
@@ -5504,23 +6052,31 @@ func (s *DatasetContentVersionValue) SetDatasetName(v string) *DatasetContentVer
 	return s
 }
 
-// The reference to a data set entry.
+// The reference to a dataset entry.
 type DatasetEntry struct {
 	_ struct{} `type:"structure"`
 
-	// The pre-signed URI of the data set item.
+	// The presigned URI of the dataset item.
 	DataURI *string `locationName:"dataURI" type:"string"`
 
-	// The name of the data set item.
+	// The name of the dataset item.
 	EntryName *string `locationName:"entryName" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatasetEntry) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatasetEntry) GoString() string {
 	return s.String()
 }
@@ -5537,37 +6093,45 @@ func (s *DatasetEntry) SetEntryName(v string) *DatasetEntry {
 	return s
 }
 
-// A summary of information about a data set.
+// A summary of information about a dataset.
 type DatasetSummary struct {
 	_ struct{} `type:"structure"`
 
-	// A list of "DataActionSummary" objects.
+	// A list of DataActionSummary objects.
 	Actions []*DatasetActionSummary `locationName:"actions" min:"1" type:"list"`
 
-	// The time the data set was created.
+	// The time the dataset was created.
 	CreationTime *time.Time `locationName:"creationTime" type:"timestamp"`
 
-	// The name of the data set.
+	// The name of the dataset.
 	DatasetName *string `locationName:"datasetName" min:"1" type:"string"`
 
-	// The last time the data set was updated.
+	// The last time the dataset was updated.
 	LastUpdateTime *time.Time `locationName:"lastUpdateTime" type:"timestamp"`
 
-	// The status of the data set.
+	// The status of the dataset.
 	Status *string `locationName:"status" type:"string" enum:"DatasetStatus"`
 
-	// A list of triggers. A trigger causes data set content to be populated at
-	// a specified time interval or when another data set is populated. The list
-	// of triggers can be empty or contain up to five DataSetTrigger objects
+	// A list of triggers. A trigger causes dataset content to be populated at a
+	// specified time interval or when another dataset is populated. The list of
+	// triggers can be empty or contain up to five DataSetTrigger objects
 	Triggers []*DatasetTrigger `locationName:"triggers" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatasetSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatasetSummary) GoString() string {
 	return s.String()
 }
@@ -5608,24 +6172,32 @@ func (s *DatasetSummary) SetTriggers(v []*DatasetTrigger) *DatasetSummary {
 	return s
 }
 
-// The "DatasetTrigger" that specifies when the data set is automatically updated.
+// The DatasetTrigger that specifies when the dataset is automatically updated.
 type DatasetTrigger struct {
 	_ struct{} `type:"structure"`
 
-	// The data set whose content creation triggers the creation of this data set's
+	// The dataset whose content creation triggers the creation of this dataset's
 	// contents.
 	Dataset *TriggeringDataset `locationName:"dataset" type:"structure"`
 
-	// The "Schedule" when the trigger is initiated.
+	// The Schedule when the trigger is initiated.
 	Schedule *Schedule `locationName:"schedule" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatasetTrigger) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatasetTrigger) GoString() string {
 	return s.String()
 }
@@ -5667,13 +6239,35 @@ type Datastore struct {
 	// When the data store was created.
 	CreationTime *time.Time `locationName:"creationTime" type:"timestamp"`
 
+	// Contains information about the partition dimensions in a data store.
+	DatastorePartitions *DatastorePartitions `locationName:"datastorePartitions" type:"structure"`
+
+	// Contains the configuration information of file formats. IoT Analytics data
+	// stores support JSON and Parquet (https://parquet.apache.org/).
+	//
+	// The default file format is JSON. You can specify only one format.
+	//
+	// You can't change the file format after you create the data store.
+	FileFormatConfiguration *FileFormatConfiguration `locationName:"fileFormatConfiguration" type:"structure"`
+
+	// The last time when a new message arrived in the data store.
+	//
+	// IoT Analytics updates this value at most once per minute for Amazon Simple
+	// Storage Service one data store. Hence, the lastMessageArrivalTime value is
+	// an approximation.
+	//
+	// This feature only applies to messages that arrived in the data store after
+	// October 23, 2020.
+	LastMessageArrivalTime *time.Time `locationName:"lastMessageArrivalTime" type:"timestamp"`
+
 	// The last time the data store was updated.
 	LastUpdateTime *time.Time `locationName:"lastUpdateTime" type:"timestamp"`
 
 	// The name of the data store.
 	Name *string `locationName:"name" min:"1" type:"string"`
 
-	// How long, in days, message data is kept for the data store.
+	// How long, in days, message data is kept for the data store. When customerManagedS3
+	// storage is selected, this parameter is ignored.
 	RetentionPeriod *RetentionPeriod `locationName:"retentionPeriod" type:"structure"`
 
 	// The status of a data store:
@@ -5691,16 +6285,27 @@ type Datastore struct {
 	// The data store is being deleted.
 	Status *string `locationName:"status" type:"string" enum:"DatastoreStatus"`
 
-	// Where data store data is stored.
+	// Where data in a data store is stored.. You can choose serviceManagedS3 storage,
+	// customerManagedS3 storage, or iotSiteWiseMultiLayerStorage storage. The default
+	// is serviceManagedS3. You can't change the choice of Amazon S3 storage after
+	// your data store is created.
 	Storage *DatastoreStorage `locationName:"storage" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Datastore) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Datastore) GoString() string {
 	return s.String()
 }
@@ -5717,6 +6322,24 @@ func (s *Datastore) SetCreationTime(v time.Time) *Datastore {
 	return s
 }
 
+// SetDatastorePartitions sets the DatastorePartitions field's value.
+func (s *Datastore) SetDatastorePartitions(v *DatastorePartitions) *Datastore {
+	s.DatastorePartitions = v
+	return s
+}
+
+// SetFileFormatConfiguration sets the FileFormatConfiguration field's value.
+func (s *Datastore) SetFileFormatConfiguration(v *FileFormatConfiguration) *Datastore {
+	s.FileFormatConfiguration = v
+	return s
+}
+
+// SetLastMessageArrivalTime sets the LastMessageArrivalTime field's value.
+func (s *Datastore) SetLastMessageArrivalTime(v time.Time) *Datastore {
+	s.LastMessageArrivalTime = &v
+	return s
+}
+
 // SetLastUpdateTime sets the LastUpdateTime field's value.
 func (s *Datastore) SetLastUpdateTime(v time.Time) *Datastore {
 	s.LastUpdateTime = &v
@@ -5747,7 +6370,7 @@ func (s *Datastore) SetStorage(v *DatastoreStorage) *Datastore {
 	return s
 }
 
-// The 'datastore' activity that specifies where to store the processed data.
+// The datastore activity that specifies where to store the processed data.
 type DatastoreActivity struct {
 	_ struct{} `type:"structure"`
 
@@ -5756,18 +6379,26 @@ type DatastoreActivity struct {
 	// DatastoreName is a required field
 	DatastoreName *string `locationName:"datastoreName" min:"1" type:"string" required:"true"`
 
-	// The name of the 'datastore' activity.
+	// The name of the datastore activity.
 	//
 	// Name is a required field
 	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatastoreActivity) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatastoreActivity) GoString() string {
 	return s.String()
 }
@@ -5806,6 +6437,207 @@ func (s *DatastoreActivity) SetName(v string) *DatastoreActivity {
 	return s
 }
 
+// Used to store data used by IoT SiteWise in an Amazon S3 bucket that you manage.
+// You can't change the choice of Amazon S3 storage after your data store is
+// created.
+type DatastoreIotSiteWiseMultiLayerStorage struct {
+	_ struct{} `type:"structure"`
+
+	// Used to store data used by IoT SiteWise in an Amazon S3 bucket that you manage.
+	//
+	// CustomerManagedS3Storage is a required field
+	CustomerManagedS3Storage *IotSiteWiseCustomerManagedDatastoreS3Storage `locationName:"customerManagedS3Storage" type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DatastoreIotSiteWiseMultiLayerStorage) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DatastoreIotSiteWiseMultiLayerStorage) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DatastoreIotSiteWiseMultiLayerStorage) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DatastoreIotSiteWiseMultiLayerStorage"}
+	if s.CustomerManagedS3Storage == nil {
+		invalidParams.Add(request.NewErrParamRequired("CustomerManagedS3Storage"))
+	}
+	if s.CustomerManagedS3Storage != nil {
+		if err := s.CustomerManagedS3Storage.Validate(); err != nil {
+			invalidParams.AddNested("CustomerManagedS3Storage", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCustomerManagedS3Storage sets the CustomerManagedS3Storage field's value.
+func (s *DatastoreIotSiteWiseMultiLayerStorage) SetCustomerManagedS3Storage(v *IotSiteWiseCustomerManagedDatastoreS3Storage) *DatastoreIotSiteWiseMultiLayerStorage {
+	s.CustomerManagedS3Storage = v
+	return s
+}
+
+// Contains information about the data store that you manage, which stores data
+// used by IoT SiteWise.
+type DatastoreIotSiteWiseMultiLayerStorageSummary struct {
+	_ struct{} `type:"structure"`
+
+	// Used to store data used by IoT SiteWise in an Amazon S3 bucket that you manage.
+	CustomerManagedS3Storage *IotSiteWiseCustomerManagedDatastoreS3StorageSummary `locationName:"customerManagedS3Storage" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DatastoreIotSiteWiseMultiLayerStorageSummary) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DatastoreIotSiteWiseMultiLayerStorageSummary) GoString() string {
+	return s.String()
+}
+
+// SetCustomerManagedS3Storage sets the CustomerManagedS3Storage field's value.
+func (s *DatastoreIotSiteWiseMultiLayerStorageSummary) SetCustomerManagedS3Storage(v *IotSiteWiseCustomerManagedDatastoreS3StorageSummary) *DatastoreIotSiteWiseMultiLayerStorageSummary {
+	s.CustomerManagedS3Storage = v
+	return s
+}
+
+// A single dimension to partition a data store. The dimension must be an AttributePartition
+// or a TimestampPartition.
+type DatastorePartition struct {
+	_ struct{} `type:"structure"`
+
+	// A partition dimension defined by an attributeName.
+	AttributePartition *Partition `locationName:"attributePartition" type:"structure"`
+
+	// A partition dimension defined by a timestamp attribute.
+	TimestampPartition *TimestampPartition `locationName:"timestampPartition" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DatastorePartition) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DatastorePartition) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DatastorePartition) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DatastorePartition"}
+	if s.AttributePartition != nil {
+		if err := s.AttributePartition.Validate(); err != nil {
+			invalidParams.AddNested("AttributePartition", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TimestampPartition != nil {
+		if err := s.TimestampPartition.Validate(); err != nil {
+			invalidParams.AddNested("TimestampPartition", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAttributePartition sets the AttributePartition field's value.
+func (s *DatastorePartition) SetAttributePartition(v *Partition) *DatastorePartition {
+	s.AttributePartition = v
+	return s
+}
+
+// SetTimestampPartition sets the TimestampPartition field's value.
+func (s *DatastorePartition) SetTimestampPartition(v *TimestampPartition) *DatastorePartition {
+	s.TimestampPartition = v
+	return s
+}
+
+// Contains information about the partition dimensions in a data store.
+type DatastorePartitions struct {
+	_ struct{} `type:"structure"`
+
+	// A list of partition dimensions in a data store.
+	Partitions []*DatastorePartition `locationName:"partitions" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DatastorePartitions) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DatastorePartitions) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DatastorePartitions) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DatastorePartitions"}
+	if s.Partitions != nil {
+		for i, v := range s.Partitions {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Partitions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetPartitions sets the Partitions field's value.
+func (s *DatastorePartitions) SetPartitions(v []*DatastorePartition) *DatastorePartitions {
+	s.Partitions = v
+	return s
+}
+
 // Statistical information about the data store.
 type DatastoreStatistics struct {
 	_ struct{} `type:"structure"`
@@ -5814,12 +6646,20 @@ type DatastoreStatistics struct {
 	Size *EstimatedResourceSize `locationName:"size" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatastoreStatistics) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatastoreStatistics) GoString() string {
 	return s.String()
 }
@@ -5830,24 +6670,42 @@ func (s *DatastoreStatistics) SetSize(v *EstimatedResourceSize) *DatastoreStatis
 	return s
 }
 
-// Where data store data is stored.
+// Where data in a data store is stored.. You can choose serviceManagedS3 storage,
+// customerManagedS3 storage, or iotSiteWiseMultiLayerStorage storage. The default
+// is serviceManagedS3. You can't change the choice of Amazon S3 storage after
+// your data store is created.
 type DatastoreStorage struct {
 	_ struct{} `type:"structure"`
 
-	// Use this to store data store data in an S3 bucket that you manage.
+	// S3-customer-managed; When you choose customer-managed storage, the retentionPeriod
+	// parameter is ignored. You can't change the choice of Amazon S3 storage after
+	// your data store is created.
 	CustomerManagedS3 *CustomerManagedDatastoreS3Storage `locationName:"customerManagedS3" type:"structure"`
 
-	// Use this to store data store data in an S3 bucket managed by the AWS IoT
-	// Analytics service.
+	// Used to store data used by IoT SiteWise in an Amazon S3 bucket that you manage.
+	// You can't change the choice of Amazon S3 storage after your data store is
+	// created.
+	IotSiteWiseMultiLayerStorage *DatastoreIotSiteWiseMultiLayerStorage `locationName:"iotSiteWiseMultiLayerStorage" type:"structure"`
+
+	// Used to store data in an Amazon S3 bucket managed by IoT Analytics. You can't
+	// change the choice of Amazon S3 storage after your data store is created.
 	ServiceManagedS3 *ServiceManagedDatastoreS3Storage `locationName:"serviceManagedS3" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatastoreStorage) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatastoreStorage) GoString() string {
 	return s.String()
 }
@@ -5860,6 +6718,11 @@ func (s *DatastoreStorage) Validate() error {
 			invalidParams.AddNested("CustomerManagedS3", err.(request.ErrInvalidParams))
 		}
 	}
+	if s.IotSiteWiseMultiLayerStorage != nil {
+		if err := s.IotSiteWiseMultiLayerStorage.Validate(); err != nil {
+			invalidParams.AddNested("IotSiteWiseMultiLayerStorage", err.(request.ErrInvalidParams))
+		}
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -5873,30 +6736,46 @@ func (s *DatastoreStorage) SetCustomerManagedS3(v *CustomerManagedDatastoreS3Sto
 	return s
 }
 
+// SetIotSiteWiseMultiLayerStorage sets the IotSiteWiseMultiLayerStorage field's value.
+func (s *DatastoreStorage) SetIotSiteWiseMultiLayerStorage(v *DatastoreIotSiteWiseMultiLayerStorage) *DatastoreStorage {
+	s.IotSiteWiseMultiLayerStorage = v
+	return s
+}
+
 // SetServiceManagedS3 sets the ServiceManagedS3 field's value.
 func (s *DatastoreStorage) SetServiceManagedS3(v *ServiceManagedDatastoreS3Storage) *DatastoreStorage {
 	s.ServiceManagedS3 = v
 	return s
 }
 
-// Where data store data is stored.
+// Contains information about your data store.
 type DatastoreStorageSummary struct {
 	_ struct{} `type:"structure"`
 
-	// Used to store data store data in an S3 bucket that you manage.
+	// Used to store data in an Amazon S3 bucket managed by IoT Analytics.
 	CustomerManagedS3 *CustomerManagedDatastoreS3StorageSummary `locationName:"customerManagedS3" type:"structure"`
 
-	// Used to store data store data in an S3 bucket managed by the AWS IoT Analytics
-	// service.
+	// Used to store data used by IoT SiteWise in an Amazon S3 bucket that you manage.
+	IotSiteWiseMultiLayerStorage *DatastoreIotSiteWiseMultiLayerStorageSummary `locationName:"iotSiteWiseMultiLayerStorage" type:"structure"`
+
+	// Used to store data in an Amazon S3 bucket managed by IoT Analytics.
 	ServiceManagedS3 *ServiceManagedDatastoreS3StorageSummary `locationName:"serviceManagedS3" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatastoreStorageSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatastoreStorageSummary) GoString() string {
 	return s.String()
 }
@@ -5907,6 +6786,12 @@ func (s *DatastoreStorageSummary) SetCustomerManagedS3(v *CustomerManagedDatasto
 	return s
 }
 
+// SetIotSiteWiseMultiLayerStorage sets the IotSiteWiseMultiLayerStorage field's value.
+func (s *DatastoreStorageSummary) SetIotSiteWiseMultiLayerStorage(v *DatastoreIotSiteWiseMultiLayerStorageSummary) *DatastoreStorageSummary {
+	s.IotSiteWiseMultiLayerStorage = v
+	return s
+}
+
 // SetServiceManagedS3 sets the ServiceManagedS3 field's value.
 func (s *DatastoreStorageSummary) SetServiceManagedS3(v *ServiceManagedDatastoreS3StorageSummary) *DatastoreStorageSummary {
 	s.ServiceManagedS3 = v
@@ -5923,9 +6808,25 @@ type DatastoreSummary struct {
 	// The name of the data store.
 	DatastoreName *string `locationName:"datastoreName" min:"1" type:"string"`
 
-	// Where data store data is stored.
+	// Contains information about the partition dimensions in a data store.
+	DatastorePartitions *DatastorePartitions `locationName:"datastorePartitions" type:"structure"`
+
+	// Where data in a data store is stored.
 	DatastoreStorage *DatastoreStorageSummary `locationName:"datastoreStorage" type:"structure"`
 
+	// The file format of the data in the data store.
+	FileFormatType *string `locationName:"fileFormatType" type:"string" enum:"FileFormatType"`
+
+	// The last time when a new message arrived in the data store.
+	//
+	// IoT Analytics updates this value at most once per minute for Amazon Simple
+	// Storage Service one data store. Hence, the lastMessageArrivalTime value is
+	// an approximation.
+	//
+	// This feature only applies to messages that arrived in the data store after
+	// October 23, 2020.
+	LastMessageArrivalTime *time.Time `locationName:"lastMessageArrivalTime" type:"timestamp"`
+
 	// The last time the data store was updated.
 	LastUpdateTime *time.Time `locationName:"lastUpdateTime" type:"timestamp"`
 
@@ -5933,12 +6834,20 @@ type DatastoreSummary struct {
 	Status *string `locationName:"status" type:"string" enum:"DatastoreStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatastoreSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DatastoreSummary) GoString() string {
 	return s.String()
 }
@@ -5955,12 +6864,30 @@ func (s *DatastoreSummary) SetDatastoreName(v string) *DatastoreSummary {
 	return s
 }
 
+// SetDatastorePartitions sets the DatastorePartitions field's value.
+func (s *DatastoreSummary) SetDatastorePartitions(v *DatastorePartitions) *DatastoreSummary {
+	s.DatastorePartitions = v
+	return s
+}
+
 // SetDatastoreStorage sets the DatastoreStorage field's value.
 func (s *DatastoreSummary) SetDatastoreStorage(v *DatastoreStorageSummary) *DatastoreSummary {
 	s.DatastoreStorage = v
 	return s
 }
 
+// SetFileFormatType sets the FileFormatType field's value.
+func (s *DatastoreSummary) SetFileFormatType(v string) *DatastoreSummary {
+	s.FileFormatType = &v
+	return s
+}
+
+// SetLastMessageArrivalTime sets the LastMessageArrivalTime field's value.
+func (s *DatastoreSummary) SetLastMessageArrivalTime(v time.Time) *DatastoreSummary {
+	s.LastMessageArrivalTime = &v
+	return s
+}
+
 // SetLastUpdateTime sets the LastUpdateTime field's value.
 func (s *DatastoreSummary) SetLastUpdateTime(v time.Time) *DatastoreSummary {
 	s.LastUpdateTime = &v
@@ -5974,7 +6901,7 @@ func (s *DatastoreSummary) SetStatus(v string) *DatastoreSummary {
 }
 
 type DeleteChannelInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The name of the channel to delete.
 	//
@@ -5982,12 +6909,20 @@ type DeleteChannelInput struct {
 	ChannelName *string `location:"uri" locationName:"channelName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteChannelInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteChannelInput) GoString() string {
 	return s.String()
 }
@@ -6018,36 +6953,52 @@ type DeleteChannelOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteChannelOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteChannelOutput) GoString() string {
 	return s.String()
 }
 
 type DeleteDatasetContentInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// The name of the data set whose content is deleted.
+	// The name of the dataset whose content is deleted.
 	//
 	// DatasetName is a required field
 	DatasetName *string `location:"uri" locationName:"datasetName" min:"1" type:"string" required:"true"`
 
-	// The version of the data set whose content is deleted. You can also use the
+	// The version of the dataset whose content is deleted. You can also use the
 	// strings "$LATEST" or "$LATEST_SUCCEEDED" to delete the latest or latest successfully
 	// completed data set. If not specified, "$LATEST_SUCCEEDED" is the default.
 	VersionId *string `location:"querystring" locationName:"versionId" min:"7" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteDatasetContentInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteDatasetContentInput) GoString() string {
 	return s.String()
 }
@@ -6087,31 +7038,47 @@ type DeleteDatasetContentOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteDatasetContentOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteDatasetContentOutput) GoString() string {
 	return s.String()
 }
 
 type DeleteDatasetInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// The name of the data set to delete.
+	// The name of the dataset to delete.
 	//
 	// DatasetName is a required field
 	DatasetName *string `location:"uri" locationName:"datasetName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteDatasetInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteDatasetInput) GoString() string {
 	return s.String()
 }
@@ -6142,18 +7109,26 @@ type DeleteDatasetOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteDatasetOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteDatasetOutput) GoString() string {
 	return s.String()
 }
 
 type DeleteDatastoreInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The name of the data store to delete.
 	//
@@ -6161,12 +7136,20 @@ type DeleteDatastoreInput struct {
 	DatastoreName *string `location:"uri" locationName:"datastoreName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteDatastoreInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteDatastoreInput) GoString() string {
 	return s.String()
 }
@@ -6197,18 +7180,26 @@ type DeleteDatastoreOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteDatastoreOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteDatastoreOutput) GoString() string {
 	return s.String()
 }
 
 type DeletePipelineInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The name of the pipeline to delete.
 	//
@@ -6216,12 +7207,20 @@ type DeletePipelineInput struct {
 	PipelineName *string `location:"uri" locationName:"pipelineName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeletePipelineInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeletePipelineInput) GoString() string {
 	return s.String()
 }
@@ -6252,12 +7251,20 @@ type DeletePipelineOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeletePipelineOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeletePipelineOutput) GoString() string {
 	return s.String()
 }
@@ -6267,33 +7274,40 @@ func (s DeletePipelineOutput) GoString() string {
 type DeltaTime struct {
 	_ struct{} `type:"structure"`
 
-	// The number of seconds of estimated "in flight" lag time of message data.
-	// When you create data set contents using message data from a specified time
-	// frame, some message data may still be "in flight" when processing begins,
-	// and so will not arrive in time to be processed. Use this field to make allowances
-	// for the "in flight" time of your message data, so that data not processed
-	// from a previous time frame will be included with the next time frame. Without
-	// this, missed message data would be excluded from processing during the next
-	// time frame as well, because its timestamp places it within the previous time
-	// frame.
+	// The number of seconds of estimated in-flight lag time of message data. When
+	// you create dataset contents using message data from a specified timeframe,
+	// some message data might still be in flight when processing begins, and so
+	// do not arrive in time to be processed. Use this field to make allowances
+	// for the in flight time of your message data, so that data not processed from
+	// a previous timeframe is included with the next timeframe. Otherwise, missed
+	// message data would be excluded from processing during the next timeframe
+	// too, because its timestamp places it within the previous timeframe.
 	//
 	// OffsetSeconds is a required field
 	OffsetSeconds *int64 `locationName:"offsetSeconds" type:"integer" required:"true"`
 
-	// An expression by which the time of the message data may be determined. This
-	// may be the name of a timestamp field, or a SQL expression which is used to
-	// derive the time the message data was generated.
+	// An expression by which the time of the message data might be determined.
+	// This can be the name of a timestamp field or a SQL expression that is used
+	// to derive the time the message data was generated.
 	//
 	// TimeExpression is a required field
 	TimeExpression *string `locationName:"timeExpression" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeltaTime) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeltaTime) GoString() string {
 	return s.String()
 }
@@ -6326,25 +7340,99 @@ func (s *DeltaTime) SetTimeExpression(v string) *DeltaTime {
 	return s
 }
 
-type DescribeChannelInput struct {
+// A structure that contains the configuration information of a delta time session
+// window.
+//
+//	DeltaTime (https://docs.aws.amazon.com/iotanalytics/latest/APIReference/API_DeltaTime.html)
+//	specifies a time interval. You can use DeltaTime to create dataset contents
+//	with data that has arrived in the data store since the last execution. For
+//	an example of DeltaTime, see Creating a SQL dataset with a delta window
+//	(CLI) (https://docs.aws.amazon.com/iotanalytics/latest/userguide/automate-create-dataset.html#automate-example6)
+//	in the IoT Analytics User Guide.
+type DeltaTimeSessionWindowConfiguration struct {
 	_ struct{} `type:"structure"`
 
+	// A time interval. You can use timeoutInMinutes so that IoT Analytics can batch
+	// up late data notifications that have been generated since the last execution.
+	// IoT Analytics sends one batch of notifications to Amazon CloudWatch Events
+	// at one time.
+	//
+	// For more information about how to write a timestamp expression, see Date
+	// and Time Functions and Operators (https://prestodb.io/docs/0.172/functions/datetime.html),
+	// in the Presto 0.172 Documentation.
+	//
+	// TimeoutInMinutes is a required field
+	TimeoutInMinutes *int64 `locationName:"timeoutInMinutes" min:"1" type:"integer" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeltaTimeSessionWindowConfiguration) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeltaTimeSessionWindowConfiguration) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeltaTimeSessionWindowConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeltaTimeSessionWindowConfiguration"}
+	if s.TimeoutInMinutes == nil {
+		invalidParams.Add(request.NewErrParamRequired("TimeoutInMinutes"))
+	}
+	if s.TimeoutInMinutes != nil && *s.TimeoutInMinutes < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("TimeoutInMinutes", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetTimeoutInMinutes sets the TimeoutInMinutes field's value.
+func (s *DeltaTimeSessionWindowConfiguration) SetTimeoutInMinutes(v int64) *DeltaTimeSessionWindowConfiguration {
+	s.TimeoutInMinutes = &v
+	return s
+}
+
+type DescribeChannelInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
 	// The name of the channel whose information is retrieved.
 	//
 	// ChannelName is a required field
 	ChannelName *string `location:"uri" locationName:"channelName" min:"1" type:"string" required:"true"`
 
 	// If true, additional statistical information about the channel is included
-	// in the response.
+	// in the response. This feature can't be used with a channel whose S3 storage
+	// is customer-managed.
 	IncludeStatistics *bool `location:"querystring" locationName:"includeStatistics" type:"boolean"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeChannelInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeChannelInput) GoString() string {
 	return s.String()
 }
@@ -6383,17 +7471,25 @@ type DescribeChannelOutput struct {
 	// An object that contains information about the channel.
 	Channel *Channel `locationName:"channel" type:"structure"`
 
-	// Statistics about the channel. Included if the 'includeStatistics' parameter
+	// Statistics about the channel. Included if the includeStatistics parameter
 	// is set to true in the request.
 	Statistics *ChannelStatistics `locationName:"statistics" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeChannelOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeChannelOutput) GoString() string {
 	return s.String()
 }
@@ -6411,20 +7507,28 @@ func (s *DescribeChannelOutput) SetStatistics(v *ChannelStatistics) *DescribeCha
 }
 
 type DescribeDatasetInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// The name of the data set whose information is retrieved.
+	// The name of the dataset whose information is retrieved.
 	//
 	// DatasetName is a required field
 	DatasetName *string `location:"uri" locationName:"datasetName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDatasetInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDatasetInput) GoString() string {
 	return s.String()
 }
@@ -6454,16 +7558,24 @@ func (s *DescribeDatasetInput) SetDatasetName(v string) *DescribeDatasetInput {
 type DescribeDatasetOutput struct {
 	_ struct{} `type:"structure"`
 
-	// An object that contains information about the data set.
+	// An object that contains information about the dataset.
 	Dataset *Dataset `locationName:"dataset" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDatasetOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDatasetOutput) GoString() string {
 	return s.String()
 }
@@ -6475,24 +7587,33 @@ func (s *DescribeDatasetOutput) SetDataset(v *Dataset) *DescribeDatasetOutput {
 }
 
 type DescribeDatastoreInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The name of the data store
 	//
 	// DatastoreName is a required field
 	DatastoreName *string `location:"uri" locationName:"datastoreName" min:"1" type:"string" required:"true"`
 
-	// If true, additional statistical information about the datastore is included
-	// in the response.
+	// If true, additional statistical information about the data store is included
+	// in the response. This feature can't be used with a data store whose S3 storage
+	// is customer-managed.
 	IncludeStatistics *bool `location:"querystring" locationName:"includeStatistics" type:"boolean"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDatastoreInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDatastoreInput) GoString() string {
 	return s.String()
 }
@@ -6532,16 +7653,24 @@ type DescribeDatastoreOutput struct {
 	Datastore *Datastore `locationName:"datastore" type:"structure"`
 
 	// Additional statistical information about the data store. Included if the
-	// 'includeStatistics' parameter is set to true in the request.
+	// includeStatistics parameter is set to true in the request.
 	Statistics *DatastoreStatistics `locationName:"statistics" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDatastoreOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDatastoreOutput) GoString() string {
 	return s.String()
 }
@@ -6559,15 +7688,23 @@ func (s *DescribeDatastoreOutput) SetStatistics(v *DatastoreStatistics) *Describ
 }
 
 type DescribeLoggingOptionsInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeLoggingOptionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeLoggingOptionsInput) GoString() string {
 	return s.String()
 }
@@ -6575,16 +7712,24 @@ func (s DescribeLoggingOptionsInput) GoString() string {
 type DescribeLoggingOptionsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The current settings of the AWS IoT Analytics logging options.
+	// The current settings of the IoT Analytics logging options.
 	LoggingOptions *LoggingOptions `locationName:"loggingOptions" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeLoggingOptionsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeLoggingOptionsOutput) GoString() string {
 	return s.String()
 }
@@ -6596,7 +7741,7 @@ func (s *DescribeLoggingOptionsOutput) SetLoggingOptions(v *LoggingOptions) *Des
 }
 
 type DescribePipelineInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The name of the pipeline whose information is retrieved.
 	//
@@ -6604,12 +7749,20 @@ type DescribePipelineInput struct {
 	PipelineName *string `location:"uri" locationName:"pipelineName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribePipelineInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribePipelineInput) GoString() string {
 	return s.String()
 }
@@ -6639,16 +7792,24 @@ func (s *DescribePipelineInput) SetPipelineName(v string) *DescribePipelineInput
 type DescribePipelineOutput struct {
 	_ struct{} `type:"structure"`
 
-	// A "Pipeline" object that contains information about the pipeline.
+	// A Pipeline object that contains information about the pipeline.
 	Pipeline *Pipeline `locationName:"pipeline" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribePipelineOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribePipelineOutput) GoString() string {
 	return s.String()
 }
@@ -6659,7 +7820,7 @@ func (s *DescribePipelineOutput) SetPipeline(v *Pipeline) *DescribePipelineOutpu
 	return s
 }
 
-// An activity that adds data from the AWS IoT device registry to your message.
+// An activity that adds data from the IoT device registry to your message.
 type DeviceRegistryEnrichActivity struct {
 	_ struct{} `type:"structure"`
 
@@ -6668,7 +7829,7 @@ type DeviceRegistryEnrichActivity struct {
 	// Attribute is a required field
 	Attribute *string `locationName:"attribute" min:"1" type:"string" required:"true"`
 
-	// The name of the 'deviceRegistryEnrich' activity.
+	// The name of the deviceRegistryEnrich activity.
 	//
 	// Name is a required field
 	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
@@ -6687,12 +7848,20 @@ type DeviceRegistryEnrichActivity struct {
 	ThingName *string `locationName:"thingName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeviceRegistryEnrichActivity) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeviceRegistryEnrichActivity) GoString() string {
 	return s.String()
 }
@@ -6764,8 +7933,8 @@ func (s *DeviceRegistryEnrichActivity) SetThingName(v string) *DeviceRegistryEnr
 	return s
 }
 
-// An activity that adds information from the AWS IoT Device Shadows service
-// to a message.
+// An activity that adds information from the IoT Device Shadow service to a
+// message.
 type DeviceShadowEnrichActivity struct {
 	_ struct{} `type:"structure"`
 
@@ -6774,7 +7943,7 @@ type DeviceShadowEnrichActivity struct {
 	// Attribute is a required field
 	Attribute *string `locationName:"attribute" min:"1" type:"string" required:"true"`
 
-	// The name of the 'deviceShadowEnrich' activity.
+	// The name of the deviceShadowEnrich activity.
 	//
 	// Name is a required field
 	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
@@ -6793,12 +7962,20 @@ type DeviceShadowEnrichActivity struct {
 	ThingName *string `locationName:"thingName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeviceShadowEnrichActivity) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeviceShadowEnrichActivity) GoString() string {
 	return s.String()
 }
@@ -6877,16 +8054,24 @@ type EstimatedResourceSize struct {
 	// The time when the estimate of the size of the resource was made.
 	EstimatedOn *time.Time `locationName:"estimatedOn" type:"timestamp"`
 
-	// The estimated size of the resource in bytes.
+	// The estimated size of the resource, in bytes.
 	EstimatedSizeInBytes *float64 `locationName:"estimatedSizeInBytes" type:"double"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s EstimatedResourceSize) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s EstimatedResourceSize) GoString() string {
 	return s.String()
 }
@@ -6903,17 +8088,78 @@ func (s *EstimatedResourceSize) SetEstimatedSizeInBytes(v float64) *EstimatedRes
 	return s
 }
 
+// Contains the configuration information of file formats. IoT Analytics data
+// stores support JSON and Parquet (https://parquet.apache.org/).
+//
+// The default file format is JSON. You can specify only one format.
+//
+// You can't change the file format after you create the data store.
+type FileFormatConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	// Contains the configuration information of the JSON format.
+	JsonConfiguration *JsonConfiguration `locationName:"jsonConfiguration" type:"structure"`
+
+	// Contains the configuration information of the Parquet format.
+	ParquetConfiguration *ParquetConfiguration `locationName:"parquetConfiguration" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s FileFormatConfiguration) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s FileFormatConfiguration) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *FileFormatConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "FileFormatConfiguration"}
+	if s.ParquetConfiguration != nil {
+		if err := s.ParquetConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("ParquetConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetJsonConfiguration sets the JsonConfiguration field's value.
+func (s *FileFormatConfiguration) SetJsonConfiguration(v *JsonConfiguration) *FileFormatConfiguration {
+	s.JsonConfiguration = v
+	return s
+}
+
+// SetParquetConfiguration sets the ParquetConfiguration field's value.
+func (s *FileFormatConfiguration) SetParquetConfiguration(v *ParquetConfiguration) *FileFormatConfiguration {
+	s.ParquetConfiguration = v
+	return s
+}
+
 // An activity that filters a message based on its attributes.
 type FilterActivity struct {
 	_ struct{} `type:"structure"`
 
 	// An expression that looks like a SQL WHERE clause that must return a Boolean
-	// value.
+	// value. Messages that satisfy the condition are passed to the next activity.
 	//
 	// Filter is a required field
 	Filter *string `locationName:"filter" min:"1" type:"string" required:"true"`
 
-	// The name of the 'filter' activity.
+	// The name of the filter activity.
 	//
 	// Name is a required field
 	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
@@ -6922,12 +8168,20 @@ type FilterActivity struct {
 	Next *string `locationName:"next" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s FilterActivity) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s FilterActivity) GoString() string {
 	return s.String()
 }
@@ -6976,26 +8230,34 @@ func (s *FilterActivity) SetNext(v string) *FilterActivity {
 }
 
 type GetDatasetContentInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// The name of the data set whose contents are retrieved.
+	// The name of the dataset whose contents are retrieved.
 	//
 	// DatasetName is a required field
 	DatasetName *string `location:"uri" locationName:"datasetName" min:"1" type:"string" required:"true"`
 
-	// The version of the data set whose contents are retrieved. You can also use
+	// The version of the dataset whose contents are retrieved. You can also use
 	// the strings "$LATEST" or "$LATEST_SUCCEEDED" to retrieve the contents of
-	// the latest or latest successfully completed data set. If not specified, "$LATEST_SUCCEEDED"
+	// the latest or latest successfully completed dataset. If not specified, "$LATEST_SUCCEEDED"
 	// is the default.
 	VersionId *string `location:"querystring" locationName:"versionId" min:"7" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetDatasetContentInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetDatasetContentInput) GoString() string {
 	return s.String()
 }
@@ -7034,22 +8296,30 @@ func (s *GetDatasetContentInput) SetVersionId(v string) *GetDatasetContentInput
 type GetDatasetContentOutput struct {
 	_ struct{} `type:"structure"`
 
-	// A list of "DatasetEntry" objects.
+	// A list of DatasetEntry objects.
 	Entries []*DatasetEntry `locationName:"entries" type:"list"`
 
-	// The status of the data set content.
+	// The status of the dataset content.
 	Status *DatasetContentStatus `locationName:"status" type:"structure"`
 
 	// The time when the request was made.
 	Timestamp *time.Time `locationName:"timestamp" type:"timestamp"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetDatasetContentOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetDatasetContentOutput) GoString() string {
 	return s.String()
 }
@@ -7072,31 +8342,39 @@ func (s *GetDatasetContentOutput) SetTimestamp(v time.Time) *GetDatasetContentOu
 	return s
 }
 
-// Configuration information for coordination with the AWS Glue ETL (extract,
-// transform and load) service.
+// Configuration information for coordination with Glue, a fully managed extract,
+// transform and load (ETL) service.
 type GlueConfiguration struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the database in your AWS Glue Data Catalog in which the table
-	// is located. (An AWS Glue Data Catalog database contains Glue Data tables.)
+	// The name of the database in your Glue Data Catalog in which the table is
+	// located. An Glue Data Catalog database contains metadata tables.
 	//
 	// DatabaseName is a required field
 	DatabaseName *string `locationName:"databaseName" min:"1" type:"string" required:"true"`
 
-	// The name of the table in your AWS Glue Data Catalog which is used to perform
-	// the ETL (extract, transform and load) operations. (An AWS Glue Data Catalog
-	// table contains partitioned data and descriptions of data sources and targets.)
+	// The name of the table in your Glue Data Catalog that is used to perform the
+	// ETL operations. An Glue Data Catalog table contains partitioned data and
+	// descriptions of data sources and targets.
 	//
 	// TableName is a required field
 	TableName *string `locationName:"tableName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GlueConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GlueConfiguration) GoString() string {
 	return s.String()
 }
@@ -7135,28 +8413,164 @@ func (s *GlueConfiguration) SetTableName(v string) *GlueConfiguration {
 	return s
 }
 
-// Configuration information for delivery of data set contents to AWS IoT Events.
+// There was an internal failure.
+type InternalFailureException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InternalFailureException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InternalFailureException) GoString() string {
+	return s.String()
+}
+
+func newErrorInternalFailureException(v protocol.ResponseMetadata) error {
+	return &InternalFailureException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InternalFailureException) Code() string {
+	return "InternalFailureException"
+}
+
+// Message returns the exception's message.
+func (s *InternalFailureException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InternalFailureException) OrigErr() error {
+	return nil
+}
+
+func (s *InternalFailureException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InternalFailureException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InternalFailureException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The request was not valid.
+type InvalidRequestException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidRequestException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidRequestException) GoString() string {
+	return s.String()
+}
+
+func newErrorInvalidRequestException(v protocol.ResponseMetadata) error {
+	return &InvalidRequestException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InvalidRequestException) Code() string {
+	return "InvalidRequestException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidRequestException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidRequestException) OrigErr() error {
+	return nil
+}
+
+func (s *InvalidRequestException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidRequestException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidRequestException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Configuration information for delivery of dataset contents to IoT Events.
 type IotEventsDestinationConfiguration struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the AWS IoT Events input to which data set contents are delivered.
+	// The name of the IoT Events input to which dataset contents are delivered.
 	//
 	// InputName is a required field
 	InputName *string `locationName:"inputName" min:"1" type:"string" required:"true"`
 
-	// The ARN of the role which grants AWS IoT Analytics permission to deliver
-	// data set contents to an AWS IoT Events input.
+	// The ARN of the role that grants IoT Analytics permission to deliver dataset
+	// contents to an IoT Events input.
 	//
 	// RoleArn is a required field
 	RoleArn *string `locationName:"roleArn" min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s IotEventsDestinationConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s IotEventsDestinationConfiguration) GoString() string {
 	return s.String()
 }
@@ -7195,13 +8609,148 @@ func (s *IotEventsDestinationConfiguration) SetRoleArn(v string) *IotEventsDesti
 	return s
 }
 
+// Used to store data used by IoT SiteWise in an Amazon S3 bucket that you manage.
+// You can't change the choice of Amazon S3 storage after your data store is
+// created.
+type IotSiteWiseCustomerManagedDatastoreS3Storage struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the Amazon S3 bucket where your data is stored.
+	//
+	// Bucket is a required field
+	Bucket *string `locationName:"bucket" min:"3" type:"string" required:"true"`
+
+	// (Optional) The prefix used to create the keys of the data store data objects.
+	// Each object in an Amazon S3 bucket has a key that is its unique identifier
+	// in the bucket. Each object in a bucket has exactly one key. The prefix must
+	// end with a forward slash (/).
+	KeyPrefix *string `locationName:"keyPrefix" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IotSiteWiseCustomerManagedDatastoreS3Storage) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IotSiteWiseCustomerManagedDatastoreS3Storage) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *IotSiteWiseCustomerManagedDatastoreS3Storage) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "IotSiteWiseCustomerManagedDatastoreS3Storage"}
+	if s.Bucket == nil {
+		invalidParams.Add(request.NewErrParamRequired("Bucket"))
+	}
+	if s.Bucket != nil && len(*s.Bucket) < 3 {
+		invalidParams.Add(request.NewErrParamMinLen("Bucket", 3))
+	}
+	if s.KeyPrefix != nil && len(*s.KeyPrefix) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("KeyPrefix", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetBucket sets the Bucket field's value.
+func (s *IotSiteWiseCustomerManagedDatastoreS3Storage) SetBucket(v string) *IotSiteWiseCustomerManagedDatastoreS3Storage {
+	s.Bucket = &v
+	return s
+}
+
+// SetKeyPrefix sets the KeyPrefix field's value.
+func (s *IotSiteWiseCustomerManagedDatastoreS3Storage) SetKeyPrefix(v string) *IotSiteWiseCustomerManagedDatastoreS3Storage {
+	s.KeyPrefix = &v
+	return s
+}
+
+// Contains information about the data store that you manage, which stores data
+// used by IoT SiteWise.
+type IotSiteWiseCustomerManagedDatastoreS3StorageSummary struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the Amazon S3 bucket where your data is stored.
+	Bucket *string `locationName:"bucket" min:"3" type:"string"`
+
+	// (Optional) The prefix used to create the keys of the data store data objects.
+	// Each object in an Amazon S3 bucket has a key that is its unique identifier
+	// in the bucket. Each object in a bucket has exactly one key. The prefix must
+	// end with a forward slash (/).
+	KeyPrefix *string `locationName:"keyPrefix" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IotSiteWiseCustomerManagedDatastoreS3StorageSummary) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IotSiteWiseCustomerManagedDatastoreS3StorageSummary) GoString() string {
+	return s.String()
+}
+
+// SetBucket sets the Bucket field's value.
+func (s *IotSiteWiseCustomerManagedDatastoreS3StorageSummary) SetBucket(v string) *IotSiteWiseCustomerManagedDatastoreS3StorageSummary {
+	s.Bucket = &v
+	return s
+}
+
+// SetKeyPrefix sets the KeyPrefix field's value.
+func (s *IotSiteWiseCustomerManagedDatastoreS3StorageSummary) SetKeyPrefix(v string) *IotSiteWiseCustomerManagedDatastoreS3StorageSummary {
+	s.KeyPrefix = &v
+	return s
+}
+
+// Contains the configuration information of the JSON format.
+type JsonConfiguration struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s JsonConfiguration) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s JsonConfiguration) GoString() string {
+	return s.String()
+}
+
 // An activity that runs a Lambda function to modify the message.
 type LambdaActivity struct {
 	_ struct{} `type:"structure"`
 
 	// The number of messages passed to the Lambda function for processing.
 	//
-	// The AWS Lambda function must be able to process all of these messages within
+	// The Lambda function must be able to process all of these messages within
 	// five minutes, which is the maximum timeout duration for Lambda functions.
 	//
 	// BatchSize is a required field
@@ -7212,7 +8761,7 @@ type LambdaActivity struct {
 	// LambdaName is a required field
 	LambdaName *string `locationName:"lambdaName" min:"1" type:"string" required:"true"`
 
-	// The name of the 'lambda' activity.
+	// The name of the lambda activity.
 	//
 	// Name is a required field
 	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
@@ -7221,12 +8770,20 @@ type LambdaActivity struct {
 	Next *string `locationName:"next" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s LambdaActivity) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s LambdaActivity) GoString() string {
 	return s.String()
 }
@@ -7286,9 +8843,185 @@ func (s *LambdaActivity) SetNext(v string) *LambdaActivity {
 	return s
 }
 
-type ListChannelsInput struct {
+// A structure that contains the name and configuration information of a late
+// data rule.
+type LateDataRule struct {
+	_ struct{} `type:"structure"`
+
+	// The information needed to configure the late data rule.
+	//
+	// RuleConfiguration is a required field
+	RuleConfiguration *LateDataRuleConfiguration `locationName:"ruleConfiguration" type:"structure" required:"true"`
+
+	// The name of the late data rule.
+	RuleName *string `locationName:"ruleName" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LateDataRule) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LateDataRule) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *LateDataRule) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "LateDataRule"}
+	if s.RuleConfiguration == nil {
+		invalidParams.Add(request.NewErrParamRequired("RuleConfiguration"))
+	}
+	if s.RuleName != nil && len(*s.RuleName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RuleName", 1))
+	}
+	if s.RuleConfiguration != nil {
+		if err := s.RuleConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("RuleConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetRuleConfiguration sets the RuleConfiguration field's value.
+func (s *LateDataRule) SetRuleConfiguration(v *LateDataRuleConfiguration) *LateDataRule {
+	s.RuleConfiguration = v
+	return s
+}
+
+// SetRuleName sets the RuleName field's value.
+func (s *LateDataRule) SetRuleName(v string) *LateDataRule {
+	s.RuleName = &v
+	return s
+}
+
+// The information needed to configure a delta time session window.
+type LateDataRuleConfiguration struct {
 	_ struct{} `type:"structure"`
 
+	// The information needed to configure a delta time session window.
+	DeltaTimeSessionWindowConfiguration *DeltaTimeSessionWindowConfiguration `locationName:"deltaTimeSessionWindowConfiguration" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LateDataRuleConfiguration) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LateDataRuleConfiguration) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *LateDataRuleConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "LateDataRuleConfiguration"}
+	if s.DeltaTimeSessionWindowConfiguration != nil {
+		if err := s.DeltaTimeSessionWindowConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("DeltaTimeSessionWindowConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDeltaTimeSessionWindowConfiguration sets the DeltaTimeSessionWindowConfiguration field's value.
+func (s *LateDataRuleConfiguration) SetDeltaTimeSessionWindowConfiguration(v *DeltaTimeSessionWindowConfiguration) *LateDataRuleConfiguration {
+	s.DeltaTimeSessionWindowConfiguration = v
+	return s
+}
+
+// The command caused an internal limit to be exceeded.
+type LimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) GoString() string {
+	return s.String()
+}
+
+func newErrorLimitExceededException(v protocol.ResponseMetadata) error {
+	return &LimitExceededException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *LimitExceededException) Code() string {
+	return "LimitExceededException"
+}
+
+// Message returns the exception's message.
+func (s *LimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *LimitExceededException) OrigErr() error {
+	return nil
+}
+
+func (s *LimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *LimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *LimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type ListChannelsInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
 	// The maximum number of results to return in this request.
 	//
 	// The default value is 100.
@@ -7298,12 +9031,20 @@ type ListChannelsInput struct {
 	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListChannelsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListChannelsInput) GoString() string {
 	return s.String()
 }
@@ -7336,7 +9077,7 @@ func (s *ListChannelsInput) SetNextToken(v string) *ListChannelsInput {
 type ListChannelsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// A list of "ChannelSummary" objects.
+	// A list of ChannelSummary objects.
 	ChannelSummaries []*ChannelSummary `locationName:"channelSummaries" type:"list"`
 
 	// The token to retrieve the next set of results, or null if there are no more
@@ -7344,12 +9085,20 @@ type ListChannelsOutput struct {
 	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListChannelsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListChannelsOutput) GoString() string {
 	return s.String()
 }
@@ -7367,9 +9116,9 @@ func (s *ListChannelsOutput) SetNextToken(v string) *ListChannelsOutput {
 }
 
 type ListDatasetContentsInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
-	// The name of the data set whose contents information you want to list.
+	// The name of the dataset whose contents information you want to list.
 	//
 	// DatasetName is a required field
 	DatasetName *string `location:"uri" locationName:"datasetName" min:"1" type:"string" required:"true"`
@@ -7380,23 +9129,31 @@ type ListDatasetContentsInput struct {
 	// The token for the next set of results.
 	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
 
-	// A filter to limit results to those data set contents whose creation is scheduled
+	// A filter to limit results to those dataset contents whose creation is scheduled
 	// before the given time. See the field triggers.schedule in the CreateDataset
 	// request. (timestamp)
 	ScheduledBefore *time.Time `location:"querystring" locationName:"scheduledBefore" type:"timestamp"`
 
-	// A filter to limit results to those data set contents whose creation is scheduled
+	// A filter to limit results to those dataset contents whose creation is scheduled
 	// on or after the given time. See the field triggers.schedule in the CreateDataset
 	// request. (timestamp)
 	ScheduledOnOrAfter *time.Time `location:"querystring" locationName:"scheduledOnOrAfter" type:"timestamp"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDatasetContentsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDatasetContentsInput) GoString() string {
 	return s.String()
 }
@@ -7453,7 +9210,7 @@ func (s *ListDatasetContentsInput) SetScheduledOnOrAfter(v time.Time) *ListDatas
 type ListDatasetContentsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Summary information about data set contents that have been created.
+	// Summary information about dataset contents that have been created.
 	DatasetContentSummaries []*DatasetContentSummary `locationName:"datasetContentSummaries" type:"list"`
 
 	// The token to retrieve the next set of results, or null if there are no more
@@ -7461,12 +9218,20 @@ type ListDatasetContentsOutput struct {
 	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDatasetContentsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDatasetContentsOutput) GoString() string {
 	return s.String()
 }
@@ -7484,7 +9249,7 @@ func (s *ListDatasetContentsOutput) SetNextToken(v string) *ListDatasetContentsO
 }
 
 type ListDatasetsInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The maximum number of results to return in this request.
 	//
@@ -7495,12 +9260,20 @@ type ListDatasetsInput struct {
 	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDatasetsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDatasetsInput) GoString() string {
 	return s.String()
 }
@@ -7533,7 +9306,7 @@ func (s *ListDatasetsInput) SetNextToken(v string) *ListDatasetsInput {
 type ListDatasetsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// A list of "DatasetSummary" objects.
+	// A list of DatasetSummary objects.
 	DatasetSummaries []*DatasetSummary `locationName:"datasetSummaries" type:"list"`
 
 	// The token to retrieve the next set of results, or null if there are no more
@@ -7541,12 +9314,20 @@ type ListDatasetsOutput struct {
 	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDatasetsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDatasetsOutput) GoString() string {
 	return s.String()
 }
@@ -7564,7 +9345,7 @@ func (s *ListDatasetsOutput) SetNextToken(v string) *ListDatasetsOutput {
 }
 
 type ListDatastoresInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The maximum number of results to return in this request.
 	//
@@ -7575,12 +9356,20 @@ type ListDatastoresInput struct {
 	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDatastoresInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDatastoresInput) GoString() string {
 	return s.String()
 }
@@ -7613,7 +9402,7 @@ func (s *ListDatastoresInput) SetNextToken(v string) *ListDatastoresInput {
 type ListDatastoresOutput struct {
 	_ struct{} `type:"structure"`
 
-	// A list of "DatastoreSummary" objects.
+	// A list of DatastoreSummary objects.
 	DatastoreSummaries []*DatastoreSummary `locationName:"datastoreSummaries" type:"list"`
 
 	// The token to retrieve the next set of results, or null if there are no more
@@ -7621,12 +9410,20 @@ type ListDatastoresOutput struct {
 	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDatastoresOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDatastoresOutput) GoString() string {
 	return s.String()
 }
@@ -7644,7 +9441,7 @@ func (s *ListDatastoresOutput) SetNextToken(v string) *ListDatastoresOutput {
 }
 
 type ListPipelinesInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The maximum number of results to return in this request.
 	//
@@ -7655,12 +9452,20 @@ type ListPipelinesInput struct {
 	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPipelinesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPipelinesInput) GoString() string {
 	return s.String()
 }
@@ -7697,16 +9502,24 @@ type ListPipelinesOutput struct {
 	// results.
 	NextToken *string `locationName:"nextToken" type:"string"`
 
-	// A list of "PipelineSummary" objects.
+	// A list of PipelineSummary objects.
 	PipelineSummaries []*PipelineSummary `locationName:"pipelineSummaries" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPipelinesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPipelinesOutput) GoString() string {
 	return s.String()
 }
@@ -7724,7 +9537,7 @@ func (s *ListPipelinesOutput) SetPipelineSummaries(v []*PipelineSummary) *ListPi
 }
 
 type ListTagsForResourceInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The ARN of the resource whose tags you want to list.
 	//
@@ -7732,12 +9545,20 @@ type ListTagsForResourceInput struct {
 	ResourceArn *string `location:"querystring" locationName:"resourceArn" min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceInput) GoString() string {
 	return s.String()
 }
@@ -7767,16 +9588,24 @@ func (s *ListTagsForResourceInput) SetResourceArn(v string) *ListTagsForResource
 type ListTagsForResourceOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The tags (metadata) which you have assigned to the resource.
+	// The tags (metadata) that you have assigned to the resource.
 	Tags []*Tag `locationName:"tags" min:"1" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceOutput) GoString() string {
 	return s.String()
 }
@@ -7791,29 +9620,36 @@ func (s *ListTagsForResourceOutput) SetTags(v []*Tag) *ListTagsForResourceOutput
 type LoggingOptions struct {
 	_ struct{} `type:"structure"`
 
-	// If true, logging is enabled for AWS IoT Analytics.
+	// If true, logging is enabled for IoT Analytics.
 	//
 	// Enabled is a required field
 	Enabled *bool `locationName:"enabled" type:"boolean" required:"true"`
 
-	// The logging level. Currently, only "ERROR" is supported.
+	// The logging level. Currently, only ERROR is supported.
 	//
 	// Level is a required field
 	Level *string `locationName:"level" type:"string" required:"true" enum:"LoggingLevel"`
 
-	// The ARN of the role that grants permission to AWS IoT Analytics to perform
-	// logging.
+	// The ARN of the role that grants permission to IoT Analytics to perform logging.
 	//
 	// RoleArn is a required field
 	RoleArn *string `locationName:"roleArn" min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s LoggingOptions) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s LoggingOptions) GoString() string {
 	return s.String()
 }
@@ -7873,7 +9709,7 @@ type MathActivity struct {
 	// Math is a required field
 	Math *string `locationName:"math" min:"1" type:"string" required:"true"`
 
-	// The name of the 'math' activity.
+	// The name of the math activity.
 	//
 	// Name is a required field
 	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
@@ -7882,12 +9718,20 @@ type MathActivity struct {
 	Next *string `locationName:"next" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s MathActivity) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s MathActivity) GoString() string {
 	return s.String()
 }
@@ -7951,28 +9795,35 @@ func (s *MathActivity) SetNext(v string) *MathActivity {
 type Message struct {
 	_ struct{} `type:"structure"`
 
-	// The ID you wish to assign to the message. Each "messageId" must be unique
-	// within each batch sent.
+	// The ID you want to assign to the message. Each messageId must be unique within
+	// each batch sent.
 	//
 	// MessageId is a required field
 	MessageId *string `locationName:"messageId" min:"1" type:"string" required:"true"`
 
-	// The payload of the message. This may be a JSON string or a Base64-encoded
-	// string representing binary data (in which case you must decode it by means
-	// of a pipeline activity).
-	//
+	// The payload of the message. This can be a JSON string or a base64-encoded
+	// string representing binary data, in which case you must decode it by means
+	// of a pipeline activity.
 	// Payload is automatically base64 encoded/decoded by the SDK.
 	//
 	// Payload is a required field
 	Payload []byte `locationName:"payload" type:"blob" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Message) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Message) GoString() string {
 	return s.String()
 }
@@ -8012,19 +9863,27 @@ func (s *Message) SetPayload(v []byte) *Message {
 type OutputFileUriValue struct {
 	_ struct{} `type:"structure"`
 
-	// The URI of the location where data set contents are stored, usually the URI
+	// The URI of the location where dataset contents are stored, usually the URI
 	// of a file in an S3 bucket.
 	//
 	// FileName is a required field
 	FileName *string `locationName:"fileName" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OutputFileUriValue) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OutputFileUriValue) GoString() string {
 	return s.String()
 }
@@ -8048,6 +9907,103 @@ func (s *OutputFileUriValue) SetFileName(v string) *OutputFileUriValue {
 	return s
 }
 
+// Contains the configuration information of the Parquet format.
+type ParquetConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	// Information needed to define a schema.
+	SchemaDefinition *SchemaDefinition `locationName:"schemaDefinition" type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ParquetConfiguration) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ParquetConfiguration) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ParquetConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ParquetConfiguration"}
+	if s.SchemaDefinition != nil {
+		if err := s.SchemaDefinition.Validate(); err != nil {
+			invalidParams.AddNested("SchemaDefinition", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetSchemaDefinition sets the SchemaDefinition field's value.
+func (s *ParquetConfiguration) SetSchemaDefinition(v *SchemaDefinition) *ParquetConfiguration {
+	s.SchemaDefinition = v
+	return s
+}
+
+// A partition dimension defined by an attribute.
+type Partition struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the attribute that defines a partition dimension.
+	//
+	// AttributeName is a required field
+	AttributeName *string `locationName:"attributeName" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Partition) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Partition) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *Partition) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "Partition"}
+	if s.AttributeName == nil {
+		invalidParams.Add(request.NewErrParamRequired("AttributeName"))
+	}
+	if s.AttributeName != nil && len(*s.AttributeName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("AttributeName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAttributeName sets the AttributeName field's value.
+func (s *Partition) SetAttributeName(v string) *Partition {
+	s.AttributeName = &v
+	return s
+}
+
 // Contains information about a pipeline.
 type Pipeline struct {
 	_ struct{} `type:"structure"`
@@ -8071,12 +10027,20 @@ type Pipeline struct {
 	ReprocessingSummaries []*ReprocessingSummary `locationName:"reprocessingSummaries" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Pipeline) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Pipeline) GoString() string {
 	return s.String()
 }
@@ -8130,10 +10094,10 @@ type PipelineActivity struct {
 	// Specifies where to store the processed message data.
 	Datastore *DatastoreActivity `locationName:"datastore" type:"structure"`
 
-	// Adds data from the AWS IoT device registry to your message.
+	// Adds data from the IoT device registry to your message.
 	DeviceRegistryEnrich *DeviceRegistryEnrichActivity `locationName:"deviceRegistryEnrich" type:"structure"`
 
-	// Adds information from the AWS IoT Device Shadows service to a message.
+	// Adds information from the IoT Device Shadow service to a message.
 	DeviceShadowEnrich *DeviceShadowEnrichActivity `locationName:"deviceShadowEnrich" type:"structure"`
 
 	// Filters a message based on its attributes.
@@ -8149,17 +10113,25 @@ type PipelineActivity struct {
 	// Removes attributes from a message.
 	RemoveAttributes *RemoveAttributesActivity `locationName:"removeAttributes" type:"structure"`
 
-	// Creates a new message using only the specified attributes from the original
-	// message.
+	// Used to create a new message using only the specified attributes from the
+	// original message.
 	SelectAttributes *SelectAttributesActivity `locationName:"selectAttributes" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PipelineActivity) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PipelineActivity) GoString() string {
 	return s.String()
 }
@@ -8301,12 +10273,20 @@ type PipelineSummary struct {
 	ReprocessingSummaries []*ReprocessingSummary `locationName:"reprocessingSummaries" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PipelineSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PipelineSummary) GoString() string {
 	return s.String()
 }
@@ -8338,18 +10318,26 @@ func (s *PipelineSummary) SetReprocessingSummaries(v []*ReprocessingSummary) *Pi
 type PutLoggingOptionsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The new values of the AWS IoT Analytics logging options.
+	// The new values of the IoT Analytics logging options.
 	//
 	// LoggingOptions is a required field
 	LoggingOptions *LoggingOptions `locationName:"loggingOptions" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutLoggingOptionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutLoggingOptionsInput) GoString() string {
 	return s.String()
 }
@@ -8382,18 +10370,26 @@ type PutLoggingOptionsOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutLoggingOptionsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutLoggingOptionsOutput) GoString() string {
 	return s.String()
 }
 
-// Information which is used to filter message data, to segregate it according
-// to the time frame in which it arrives.
+// Information that is used to filter message data, to segregate it according
+// to the timeframe in which it arrives.
 type QueryFilter struct {
 	_ struct{} `type:"structure"`
 
@@ -8402,12 +10398,20 @@ type QueryFilter struct {
 	DeltaTime *DeltaTime `locationName:"deltaTime" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s QueryFilter) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s QueryFilter) GoString() string {
 	return s.String()
 }
@@ -8442,7 +10446,7 @@ type RemoveAttributesActivity struct {
 	// Attributes is a required field
 	Attributes []*string `locationName:"attributes" min:"1" type:"list" required:"true"`
 
-	// The name of the 'removeAttributes' activity.
+	// The name of the removeAttributes activity.
 	//
 	// Name is a required field
 	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
@@ -8451,12 +10455,20 @@ type RemoveAttributesActivity struct {
 	Next *string `locationName:"next" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemoveAttributesActivity) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemoveAttributesActivity) GoString() string {
 	return s.String()
 }
@@ -8511,19 +10523,27 @@ type ReprocessingSummary struct {
 	// The time the pipeline reprocessing was created.
 	CreationTime *time.Time `locationName:"creationTime" type:"timestamp"`
 
-	// The 'reprocessingId' returned by "StartPipelineReprocessing".
+	// The reprocessingId returned by StartPipelineReprocessing.
 	Id *string `locationName:"id" type:"string"`
 
 	// The status of the pipeline reprocessing.
 	Status *string `locationName:"status" type:"string" enum:"ReprocessingStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReprocessingSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReprocessingSummary) GoString() string {
 	return s.String()
 }
@@ -8546,29 +10566,107 @@ func (s *ReprocessingSummary) SetStatus(v string) *ReprocessingSummary {
 	return s
 }
 
-// The configuration of the resource used to execute the "containerAction".
+// A resource with the same name already exists.
+type ResourceAlreadyExistsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+
+	// The ARN of the resource.
+	ResourceArn *string `locationName:"resourceArn" type:"string"`
+
+	// The ID of the resource.
+	ResourceId *string `locationName:"resourceId" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceAlreadyExistsException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceAlreadyExistsException) GoString() string {
+	return s.String()
+}
+
+func newErrorResourceAlreadyExistsException(v protocol.ResponseMetadata) error {
+	return &ResourceAlreadyExistsException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ResourceAlreadyExistsException) Code() string {
+	return "ResourceAlreadyExistsException"
+}
+
+// Message returns the exception's message.
+func (s *ResourceAlreadyExistsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ResourceAlreadyExistsException) OrigErr() error {
+	return nil
+}
+
+func (s *ResourceAlreadyExistsException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ResourceAlreadyExistsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ResourceAlreadyExistsException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The configuration of the resource used to execute the containerAction.
 type ResourceConfiguration struct {
 	_ struct{} `type:"structure"`
 
-	// The type of the compute resource used to execute the "containerAction". Possible
-	// values are: ACU_1 (vCPU=4, memory=16GiB) or ACU_2 (vCPU=8, memory=32GiB).
+	// The type of the compute resource used to execute the containerAction. Possible
+	// values are: ACU_1 (vCPU=4, memory=16 GiB) or ACU_2 (vCPU=8, memory=32 GiB).
 	//
 	// ComputeType is a required field
 	ComputeType *string `locationName:"computeType" type:"string" required:"true" enum:"ComputeType"`
 
-	// The size (in GB) of the persistent storage available to the resource instance
-	// used to execute the "containerAction" (min: 1, max: 50).
+	// The size, in GB, of the persistent storage available to the resource instance
+	// used to execute the containerAction (min: 1, max: 50).
 	//
 	// VolumeSizeInGB is a required field
 	VolumeSizeInGB *int64 `locationName:"volumeSizeInGB" min:"1" type:"integer" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResourceConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResourceConfiguration) GoString() string {
 	return s.String()
 }
@@ -8604,11 +10702,75 @@ func (s *ResourceConfiguration) SetVolumeSizeInGB(v int64) *ResourceConfiguratio
 	return s
 }
 
+// A resource with the specified name could not be found.
+type ResourceNotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceNotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceNotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorResourceNotFoundException(v protocol.ResponseMetadata) error {
+	return &ResourceNotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ResourceNotFoundException) Code() string {
+	return "ResourceNotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *ResourceNotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ResourceNotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *ResourceNotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ResourceNotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ResourceNotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // How long, in days, message data is kept.
 type RetentionPeriod struct {
 	_ struct{} `type:"structure"`
 
-	// The number of days that message data is kept. The "unlimited" parameter must
+	// The number of days that message data is kept. The unlimited parameter must
 	// be false.
 	NumberOfDays *int64 `locationName:"numberOfDays" min:"1" type:"integer"`
 
@@ -8616,12 +10778,20 @@ type RetentionPeriod struct {
 	Unlimited *bool `locationName:"unlimited" type:"boolean"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RetentionPeriod) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RetentionPeriod) GoString() string {
 	return s.String()
 }
@@ -8659,22 +10829,30 @@ type RunPipelineActivityInput struct {
 	// Payloads is a required field
 	Payloads [][]byte `locationName:"payloads" min:"1" type:"list" required:"true"`
 
-	// The pipeline activity that is run. This must not be a 'channel' activity
-	// or a 'datastore' activity because these activities are used in a pipeline
-	// only to load the original message and to store the (possibly) transformed
-	// message. If a 'lambda' activity is specified, only short-running Lambda functions
-	// (those with a timeout of less than 30 seconds or less) can be used.
+	// The pipeline activity that is run. This must not be a channel activity or
+	// a data store activity because these activities are used in a pipeline only
+	// to load the original message and to store the (possibly) transformed message.
+	// If a Lambda activity is specified, only short-running Lambda functions (those
+	// with a timeout of less than 30 seconds or less) can be used.
 	//
 	// PipelineActivity is a required field
 	PipelineActivity *PipelineActivity `locationName:"pipelineActivity" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RunPipelineActivityInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RunPipelineActivityInput) GoString() string {
 	return s.String()
 }
@@ -8727,12 +10905,20 @@ type RunPipelineActivityOutput struct {
 	Payloads [][]byte `locationName:"payloads" min:"1" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RunPipelineActivityOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RunPipelineActivityOutput) GoString() string {
 	return s.String()
 }
@@ -8749,39 +10935,65 @@ func (s *RunPipelineActivityOutput) SetPayloads(v [][]byte) *RunPipelineActivity
 	return s
 }
 
-// Configuration information for delivery of data set contents to Amazon S3.
+// Configuration information for delivery of dataset contents to Amazon Simple
+// Storage Service (Amazon S3).
 type S3DestinationConfiguration struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the Amazon S3 bucket to which data set contents are delivered.
+	// The name of the S3 bucket to which dataset contents are delivered.
 	//
 	// Bucket is a required field
 	Bucket *string `locationName:"bucket" min:"3" type:"string" required:"true"`
 
-	// Configuration information for coordination with the AWS Glue ETL (extract,
-	// transform and load) service.
+	// Configuration information for coordination with Glue, a fully managed extract,
+	// transform and load (ETL) service.
 	GlueConfiguration *GlueConfiguration `locationName:"glueConfiguration" type:"structure"`
 
-	// The key of the data set contents object. Each object in an Amazon S3 bucket
-	// has a key that is its unique identifier within the bucket (each object in
-	// a bucket has exactly one key).
+	// The key of the dataset contents object in an S3 bucket. Each object has a
+	// key that is a unique identifier. Each object has exactly one key.
+	//
+	// You can create a unique key with the following options:
+	//
+	//    * Use !{iotanalytics:scheduleTime} to insert the time of a scheduled SQL
+	//    query run.
+	//
+	//    * Use !{iotanalytics:versionId} to insert a unique hash that identifies
+	//    a dataset content.
+	//
+	//    * Use !{iotanalytics:creationTime} to insert the creation time of a dataset
+	//    content.
+	//
+	// The following example creates a unique key for a CSV file: dataset/mydataset/!{iotanalytics:scheduleTime}/!{iotanalytics:versionId}.csv
+	//
+	// If you don't use !{iotanalytics:versionId} to specify the key, you might
+	// get duplicate keys. For example, you might have two dataset contents with
+	// the same scheduleTime but different versionIds. This means that one dataset
+	// content overwrites the other.
 	//
 	// Key is a required field
 	Key *string `locationName:"key" min:"1" type:"string" required:"true"`
 
-	// The ARN of the role which grants AWS IoT Analytics permission to interact
-	// with your Amazon S3 and AWS Glue resources.
+	// The ARN of the role that grants IoT Analytics permission to interact with
+	// your Amazon S3 and Glue resources.
 	//
 	// RoleArn is a required field
 	RoleArn *string `locationName:"roleArn" min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s S3DestinationConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s S3DestinationConfiguration) GoString() string {
 	return s.String()
 }
@@ -8844,7 +11056,7 @@ func (s *S3DestinationConfiguration) SetRoleArn(v string) *S3DestinationConfigur
 }
 
 type SampleChannelDataInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The name of the channel whose message samples are retrieved.
 	//
@@ -8854,7 +11066,7 @@ type SampleChannelDataInput struct {
 	// The end of the time window from which sample messages are retrieved.
 	EndTime *time.Time `location:"querystring" locationName:"endTime" type:"timestamp"`
 
-	// The number of sample messages to be retrieved. The limit is 10, the default
+	// The number of sample messages to be retrieved. The limit is 10. The default
 	// is also 10.
 	MaxMessages *int64 `location:"querystring" locationName:"maxMessages" min:"1" type:"integer"`
 
@@ -8862,12 +11074,20 @@ type SampleChannelDataInput struct {
 	StartTime *time.Time `location:"querystring" locationName:"startTime" type:"timestamp"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SampleChannelDataInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SampleChannelDataInput) GoString() string {
 	return s.String()
 }
@@ -8923,12 +11143,20 @@ type SampleChannelDataOutput struct {
 	Payloads [][]byte `locationName:"payloads" min:"1" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SampleChannelDataOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SampleChannelDataOutput) GoString() string {
 	return s.String()
 }
@@ -8949,12 +11177,20 @@ type Schedule struct {
 	Expression *string `locationName:"expression" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Schedule) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Schedule) GoString() string {
 	return s.String()
 }
@@ -8965,8 +11201,63 @@ func (s *Schedule) SetExpression(v string) *Schedule {
 	return s
 }
 
-// Creates a new message using only the specified attributes from the original
-// message.
+// Information needed to define a schema.
+type SchemaDefinition struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies one or more columns that store your data.
+	//
+	// Each schema can have up to 100 columns. Each column can have up to 100 nested
+	// types.
+	Columns []*Column `locationName:"columns" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SchemaDefinition) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SchemaDefinition) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *SchemaDefinition) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "SchemaDefinition"}
+	if s.Columns != nil {
+		for i, v := range s.Columns {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Columns", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetColumns sets the Columns field's value.
+func (s *SchemaDefinition) SetColumns(v []*Column) *SchemaDefinition {
+	s.Columns = v
+	return s
+}
+
+// Used to create a new message using only the specified attributes from the
+// original message.
 type SelectAttributesActivity struct {
 	_ struct{} `type:"structure"`
 
@@ -8975,7 +11266,7 @@ type SelectAttributesActivity struct {
 	// Attributes is a required field
 	Attributes []*string `locationName:"attributes" min:"1" type:"list" required:"true"`
 
-	// The name of the 'selectAttributes' activity.
+	// The name of the selectAttributes activity.
 	//
 	// Name is a required field
 	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
@@ -8984,12 +11275,20 @@ type SelectAttributesActivity struct {
 	Next *string `locationName:"next" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SelectAttributesActivity) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SelectAttributesActivity) GoString() string {
 	return s.String()
 }
@@ -9037,75 +11336,169 @@ func (s *SelectAttributesActivity) SetNext(v string) *SelectAttributesActivity {
 	return s
 }
 
-// Use this to store channel data in an S3 bucket managed by the AWS IoT Analytics
-// service.
+// Used to store channel data in an S3 bucket managed by IoT Analytics. You
+// can't change the choice of S3 storage after the data store is created.
 type ServiceManagedChannelS3Storage struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ServiceManagedChannelS3Storage) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ServiceManagedChannelS3Storage) GoString() string {
 	return s.String()
 }
 
-// Used to store channel data in an S3 bucket managed by the AWS IoT Analytics
-// service.
+// Used to store channel data in an S3 bucket managed by IoT Analytics.
 type ServiceManagedChannelS3StorageSummary struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ServiceManagedChannelS3StorageSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ServiceManagedChannelS3StorageSummary) GoString() string {
 	return s.String()
 }
 
-// Use this to store data store data in an S3 bucket managed by the AWS IoT
-// Analytics service.
+// Used to store data in an Amazon S3 bucket managed by IoT Analytics. You can't
+// change the choice of Amazon S3 storage after your data store is created.
 type ServiceManagedDatastoreS3Storage struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ServiceManagedDatastoreS3Storage) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ServiceManagedDatastoreS3Storage) GoString() string {
 	return s.String()
 }
 
-// Used to store data store data in an S3 bucket managed by the AWS IoT Analytics
-// service.
+// Contains information about the data store that is managed by IoT Analytics.
 type ServiceManagedDatastoreS3StorageSummary struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ServiceManagedDatastoreS3StorageSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ServiceManagedDatastoreS3StorageSummary) GoString() string {
 	return s.String()
 }
 
+// The service is temporarily unavailable.
+type ServiceUnavailableException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceUnavailableException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceUnavailableException) GoString() string {
+	return s.String()
+}
+
+func newErrorServiceUnavailableException(v protocol.ResponseMetadata) error {
+	return &ServiceUnavailableException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ServiceUnavailableException) Code() string {
+	return "ServiceUnavailableException"
+}
+
+// Message returns the exception's message.
+func (s *ServiceUnavailableException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ServiceUnavailableException) OrigErr() error {
+	return nil
+}
+
+func (s *ServiceUnavailableException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ServiceUnavailableException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ServiceUnavailableException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // The SQL query to modify the message.
 type SqlQueryDatasetAction struct {
 	_ struct{} `type:"structure"`
 
-	// Pre-filters applied to message data.
+	// Prefilters applied to message data.
 	Filters []*QueryFilter `locationName:"filters" type:"list"`
 
 	// A SQL query string.
@@ -9114,12 +11507,20 @@ type SqlQueryDatasetAction struct {
 	SqlQuery *string `locationName:"sqlQuery" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SqlQueryDatasetAction) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SqlQueryDatasetAction) GoString() string {
 	return s.String()
 }
@@ -9162,7 +11563,16 @@ func (s *SqlQueryDatasetAction) SetSqlQuery(v string) *SqlQueryDatasetAction {
 type StartPipelineReprocessingInput struct {
 	_ struct{} `type:"structure"`
 
+	// Specifies one or more sets of channel messages that you want to reprocess.
+	//
+	// If you use the channelMessages object, you must not specify a value for startTime
+	// and endTime.
+	ChannelMessages *ChannelMessages `locationName:"channelMessages" type:"structure"`
+
 	// The end time (exclusive) of raw message data that is reprocessed.
+	//
+	// If you specify a value for the endTime parameter, you must not use the channelMessages
+	// object.
 	EndTime *time.Time `locationName:"endTime" type:"timestamp"`
 
 	// The name of the pipeline on which to start reprocessing.
@@ -9171,15 +11581,26 @@ type StartPipelineReprocessingInput struct {
 	PipelineName *string `location:"uri" locationName:"pipelineName" min:"1" type:"string" required:"true"`
 
 	// The start time (inclusive) of raw message data that is reprocessed.
+	//
+	// If you specify a value for the startTime parameter, you must not use the
+	// channelMessages object.
 	StartTime *time.Time `locationName:"startTime" type:"timestamp"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartPipelineReprocessingInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartPipelineReprocessingInput) GoString() string {
 	return s.String()
 }
@@ -9193,6 +11614,11 @@ func (s *StartPipelineReprocessingInput) Validate() error {
 	if s.PipelineName != nil && len(*s.PipelineName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("PipelineName", 1))
 	}
+	if s.ChannelMessages != nil {
+		if err := s.ChannelMessages.Validate(); err != nil {
+			invalidParams.AddNested("ChannelMessages", err.(request.ErrInvalidParams))
+		}
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -9200,6 +11626,12 @@ func (s *StartPipelineReprocessingInput) Validate() error {
 	return nil
 }
 
+// SetChannelMessages sets the ChannelMessages field's value.
+func (s *StartPipelineReprocessingInput) SetChannelMessages(v *ChannelMessages) *StartPipelineReprocessingInput {
+	s.ChannelMessages = v
+	return s
+}
+
 // SetEndTime sets the EndTime field's value.
 func (s *StartPipelineReprocessingInput) SetEndTime(v time.Time) *StartPipelineReprocessingInput {
 	s.EndTime = &v
@@ -9225,12 +11657,20 @@ type StartPipelineReprocessingOutput struct {
 	ReprocessingId *string `locationName:"reprocessingId" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartPipelineReprocessingOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartPipelineReprocessingOutput) GoString() string {
 	return s.String()
 }
@@ -9241,7 +11681,7 @@ func (s *StartPipelineReprocessingOutput) SetReprocessingId(v string) *StartPipe
 	return s
 }
 
-// A set of key/value pairs which are used to manage the resource.
+// A set of key-value pairs that are used to manage the resource.
 type Tag struct {
 	_ struct{} `type:"structure"`
 
@@ -9256,12 +11696,20 @@ type Tag struct {
 	Value *string `locationName:"value" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) GoString() string {
 	return s.String()
 }
@@ -9314,12 +11762,20 @@ type TagResourceInput struct {
 	Tags []*Tag `locationName:"tags" min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceInput) GoString() string {
 	return s.String()
 }
@@ -9372,34 +11828,177 @@ type TagResourceOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceOutput) GoString() string {
 	return s.String()
 }
 
-// Information about the data set whose content generation triggers the new
-// data set content generation.
+// The request was denied due to request throttling.
+type ThrottlingException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ThrottlingException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ThrottlingException) GoString() string {
+	return s.String()
+}
+
+func newErrorThrottlingException(v protocol.ResponseMetadata) error {
+	return &ThrottlingException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ThrottlingException) Code() string {
+	return "ThrottlingException"
+}
+
+// Message returns the exception's message.
+func (s *ThrottlingException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ThrottlingException) OrigErr() error {
+	return nil
+}
+
+func (s *ThrottlingException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ThrottlingException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ThrottlingException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// A partition dimension defined by a timestamp attribute.
+type TimestampPartition struct {
+	_ struct{} `type:"structure"`
+
+	// The attribute name of the partition defined by a timestamp.
+	//
+	// AttributeName is a required field
+	AttributeName *string `locationName:"attributeName" min:"1" type:"string" required:"true"`
+
+	// The timestamp format of a partition defined by a timestamp. The default format
+	// is seconds since epoch (January 1, 1970 at midnight UTC time).
+	TimestampFormat *string `locationName:"timestampFormat" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TimestampPartition) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TimestampPartition) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *TimestampPartition) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "TimestampPartition"}
+	if s.AttributeName == nil {
+		invalidParams.Add(request.NewErrParamRequired("AttributeName"))
+	}
+	if s.AttributeName != nil && len(*s.AttributeName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("AttributeName", 1))
+	}
+	if s.TimestampFormat != nil && len(*s.TimestampFormat) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("TimestampFormat", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAttributeName sets the AttributeName field's value.
+func (s *TimestampPartition) SetAttributeName(v string) *TimestampPartition {
+	s.AttributeName = &v
+	return s
+}
+
+// SetTimestampFormat sets the TimestampFormat field's value.
+func (s *TimestampPartition) SetTimestampFormat(v string) *TimestampPartition {
+	s.TimestampFormat = &v
+	return s
+}
+
+// Information about the dataset whose content generation triggers the new dataset
+// content generation.
 type TriggeringDataset struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the data set whose content generation triggers the new data set
+	// The name of the dataset whose content generation triggers the new dataset
 	// content generation.
 	//
 	// Name is a required field
 	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TriggeringDataset) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TriggeringDataset) GoString() string {
 	return s.String()
 }
@@ -9427,7 +12026,7 @@ func (s *TriggeringDataset) SetName(v string) *TriggeringDataset {
 }
 
 type UntagResourceInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// The ARN of the resource whose tags you want to remove.
 	//
@@ -9440,12 +12039,20 @@ type UntagResourceInput struct {
 	TagKeys []*string `location:"querystring" locationName:"tagKeys" min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceInput) GoString() string {
 	return s.String()
 }
@@ -9488,12 +12095,20 @@ type UntagResourceOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceOutput) GoString() string {
 	return s.String()
 }
@@ -9506,19 +12121,30 @@ type UpdateChannelInput struct {
 	// ChannelName is a required field
 	ChannelName *string `location:"uri" locationName:"channelName" min:"1" type:"string" required:"true"`
 
-	// Where channel data is stored.
+	// Where channel data is stored. You can choose one of serviceManagedS3 or customerManagedS3
+	// storage. If not specified, the default is serviceManagedS3. You can't change
+	// this storage option after the channel is created.
 	ChannelStorage *ChannelStorage `locationName:"channelStorage" type:"structure"`
 
-	// How long, in days, message data is kept for the channel.
+	// How long, in days, message data is kept for the channel. The retention period
+	// can't be updated if the channel's Amazon S3 storage is customer-managed.
 	RetentionPeriod *RetentionPeriod `locationName:"retentionPeriod" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateChannelInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateChannelInput) GoString() string {
 	return s.String()
 }
@@ -9571,12 +12197,20 @@ type UpdateChannelOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateChannelOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateChannelOutput) GoString() string {
 	return s.String()
 }
@@ -9584,40 +12218,55 @@ func (s UpdateChannelOutput) GoString() string {
 type UpdateDatasetInput struct {
 	_ struct{} `type:"structure"`
 
-	// A list of "DatasetAction" objects.
+	// A list of DatasetAction objects.
 	//
 	// Actions is a required field
 	Actions []*DatasetAction `locationName:"actions" min:"1" type:"list" required:"true"`
 
-	// When data set contents are created they are delivered to destinations specified
+	// When dataset contents are created, they are delivered to destinations specified
 	// here.
 	ContentDeliveryRules []*DatasetContentDeliveryRule `locationName:"contentDeliveryRules" type:"list"`
 
-	// The name of the data set to update.
+	// The name of the dataset to update.
 	//
 	// DatasetName is a required field
 	DatasetName *string `location:"uri" locationName:"datasetName" min:"1" type:"string" required:"true"`
 
-	// How long, in days, data set contents are kept for the data set.
+	// A list of data rules that send notifications to CloudWatch, when data arrives
+	// late. To specify lateDataRules, the dataset must use a DeltaTimer (https://docs.aws.amazon.com/iotanalytics/latest/APIReference/API_DeltaTime.html)
+	// filter.
+	LateDataRules []*LateDataRule `locationName:"lateDataRules" min:"1" type:"list"`
+
+	// How long, in days, dataset contents are kept for the dataset.
 	RetentionPeriod *RetentionPeriod `locationName:"retentionPeriod" type:"structure"`
 
-	// A list of "DatasetTrigger" objects. The list can be empty or can contain
-	// up to five DataSetTrigger objects.
+	// A list of DatasetTrigger objects. The list can be empty or can contain up
+	// to five DatasetTrigger objects.
 	Triggers []*DatasetTrigger `locationName:"triggers" type:"list"`
 
-	// [Optional] How many versions of data set contents are kept. If not specified
+	// Optional. How many versions of dataset contents are kept. If not specified
 	// or set to null, only the latest version plus the latest succeeded version
-	// (if they are different) are kept for the time period specified by the "retentionPeriod"
-	// parameter. (For more information, see https://docs.aws.amazon.com/iotanalytics/latest/userguide/getting-started.html#aws-iot-analytics-dataset-versions)
+	// (if they are different) are kept for the time period specified by the retentionPeriod
+	// parameter. For more information, see Keeping Multiple Versions of IoT Analytics
+	// datasets (https://docs.aws.amazon.com/iotanalytics/latest/userguide/getting-started.html#aws-iot-analytics-dataset-versions)
+	// in the IoT Analytics User Guide.
 	VersioningConfiguration *VersioningConfiguration `locationName:"versioningConfiguration" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDatasetInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDatasetInput) GoString() string {
 	return s.String()
 }
@@ -9637,6 +12286,9 @@ func (s *UpdateDatasetInput) Validate() error {
 	if s.DatasetName != nil && len(*s.DatasetName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("DatasetName", 1))
 	}
+	if s.LateDataRules != nil && len(s.LateDataRules) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("LateDataRules", 1))
+	}
 	if s.Actions != nil {
 		for i, v := range s.Actions {
 			if v == nil {
@@ -9657,6 +12309,16 @@ func (s *UpdateDatasetInput) Validate() error {
 			}
 		}
 	}
+	if s.LateDataRules != nil {
+		for i, v := range s.LateDataRules {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "LateDataRules", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
 	if s.RetentionPeriod != nil {
 		if err := s.RetentionPeriod.Validate(); err != nil {
 			invalidParams.AddNested("RetentionPeriod", err.(request.ErrInvalidParams))
@@ -9702,6 +12364,12 @@ func (s *UpdateDatasetInput) SetDatasetName(v string) *UpdateDatasetInput {
 	return s
 }
 
+// SetLateDataRules sets the LateDataRules field's value.
+func (s *UpdateDatasetInput) SetLateDataRules(v []*LateDataRule) *UpdateDatasetInput {
+	s.LateDataRules = v
+	return s
+}
+
 // SetRetentionPeriod sets the RetentionPeriod field's value.
 func (s *UpdateDatasetInput) SetRetentionPeriod(v *RetentionPeriod) *UpdateDatasetInput {
 	s.RetentionPeriod = v
@@ -9724,12 +12392,20 @@ type UpdateDatasetOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDatasetOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDatasetOutput) GoString() string {
 	return s.String()
 }
@@ -9742,19 +12418,39 @@ type UpdateDatastoreInput struct {
 	// DatastoreName is a required field
 	DatastoreName *string `location:"uri" locationName:"datastoreName" min:"1" type:"string" required:"true"`
 
-	// Where data store data is stored.
+	// Where data in a data store is stored.. You can choose serviceManagedS3 storage,
+	// customerManagedS3 storage, or iotSiteWiseMultiLayerStorage storage. The default
+	// is serviceManagedS3. You can't change the choice of Amazon S3 storage after
+	// your data store is created.
 	DatastoreStorage *DatastoreStorage `locationName:"datastoreStorage" type:"structure"`
 
-	// How long, in days, message data is kept for the data store.
+	// Contains the configuration information of file formats. IoT Analytics data
+	// stores support JSON and Parquet (https://parquet.apache.org/).
+	//
+	// The default file format is JSON. You can specify only one format.
+	//
+	// You can't change the file format after you create the data store.
+	FileFormatConfiguration *FileFormatConfiguration `locationName:"fileFormatConfiguration" type:"structure"`
+
+	// How long, in days, message data is kept for the data store. The retention
+	// period can't be updated if the data store's Amazon S3 storage is customer-managed.
 	RetentionPeriod *RetentionPeriod `locationName:"retentionPeriod" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDatastoreInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDatastoreInput) GoString() string {
 	return s.String()
 }
@@ -9773,6 +12469,11 @@ func (s *UpdateDatastoreInput) Validate() error {
 			invalidParams.AddNested("DatastoreStorage", err.(request.ErrInvalidParams))
 		}
 	}
+	if s.FileFormatConfiguration != nil {
+		if err := s.FileFormatConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("FileFormatConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
 	if s.RetentionPeriod != nil {
 		if err := s.RetentionPeriod.Validate(); err != nil {
 			invalidParams.AddNested("RetentionPeriod", err.(request.ErrInvalidParams))
@@ -9797,6 +12498,12 @@ func (s *UpdateDatastoreInput) SetDatastoreStorage(v *DatastoreStorage) *UpdateD
 	return s
 }
 
+// SetFileFormatConfiguration sets the FileFormatConfiguration field's value.
+func (s *UpdateDatastoreInput) SetFileFormatConfiguration(v *FileFormatConfiguration) *UpdateDatastoreInput {
+	s.FileFormatConfiguration = v
+	return s
+}
+
 // SetRetentionPeriod sets the RetentionPeriod field's value.
 func (s *UpdateDatastoreInput) SetRetentionPeriod(v *RetentionPeriod) *UpdateDatastoreInput {
 	s.RetentionPeriod = v
@@ -9807,12 +12514,20 @@ type UpdateDatastoreOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDatastoreOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDatastoreOutput) GoString() string {
 	return s.String()
 }
@@ -9820,15 +12535,15 @@ func (s UpdateDatastoreOutput) GoString() string {
 type UpdatePipelineInput struct {
 	_ struct{} `type:"structure"`
 
-	// A list of "PipelineActivity" objects. Activities perform transformations
-	// on your messages, such as removing, renaming or adding message attributes;
-	// filtering messages based on attribute values; invoking your Lambda functions
-	// on messages for advanced processing; or performing mathematical transformations
-	// to normalize device data.
+	// A list of PipelineActivity objects. Activities perform transformations on
+	// your messages, such as removing, renaming or adding message attributes; filtering
+	// messages based on attribute values; invoking your Lambda functions on messages
+	// for advanced processing; or performing mathematical transformations to normalize
+	// device data.
 	//
 	// The list can be 2-25 PipelineActivity objects and must contain both a channel
-	// and a datastore activity. Each entry in the list must contain only one activity,
-	// for example:
+	// and a datastore activity. Each entry in the list must contain only one activity.
+	// For example:
 	//
 	// pipelineActivities = [ { "channel": { ... } }, { "lambda": { ... } }, ...
 	// ]
@@ -9842,12 +12557,20 @@ type UpdatePipelineInput struct {
 	PipelineName *string `location:"uri" locationName:"pipelineName" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdatePipelineInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdatePipelineInput) GoString() string {
 	return s.String()
 }
@@ -9900,23 +12623,31 @@ type UpdatePipelineOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdatePipelineOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdatePipelineOutput) GoString() string {
 	return s.String()
 }
 
-// An instance of a variable to be passed to the "containerAction" execution.
-// Each variable must have a name and a value given by one of "stringValue",
-// "datasetContentVersionValue", or "outputFileUriValue".
+// An instance of a variable to be passed to the containerAction execution.
+// Each variable must have a name and a value given by one of stringValue, datasetContentVersionValue,
+// or outputFileUriValue.
 type Variable struct {
 	_ struct{} `type:"structure"`
 
-	// The value of the variable as a structure that specifies a data set content
+	// The value of the variable as a structure that specifies a dataset content
 	// version.
 	DatasetContentVersionValue *DatasetContentVersionValue `locationName:"datasetContentVersionValue" type:"structure"`
 
@@ -9935,12 +12666,20 @@ type Variable struct {
 	StringValue *string `locationName:"stringValue" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Variable) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Variable) GoString() string {
 	return s.String()
 }
@@ -10001,24 +12740,32 @@ func (s *Variable) SetStringValue(v string) *Variable {
 	return s
 }
 
-// Information about the versioning of data set contents.
+// Information about the versioning of dataset contents.
 type VersioningConfiguration struct {
 	_ struct{} `type:"structure"`
 
-	// How many versions of data set contents will be kept. The "unlimited" parameter
-	// must be false.
+	// How many versions of dataset contents are kept. The unlimited parameter must
+	// be false.
 	MaxVersions *int64 `locationName:"maxVersions" min:"1" type:"integer"`
 
-	// If true, unlimited versions of data set contents will be kept.
+	// If true, unlimited versions of dataset contents are kept.
 	Unlimited *bool `locationName:"unlimited" type:"boolean"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VersioningConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VersioningConfiguration) GoString() string {
 	return s.String()
 }
@@ -10059,6 +12806,15 @@ const (
 	ChannelStatusDeleting = "DELETING"
 )
 
+// ChannelStatus_Values returns all elements of the ChannelStatus enum
+func ChannelStatus_Values() []string {
+	return []string{
+		ChannelStatusCreating,
+		ChannelStatusActive,
+		ChannelStatusDeleting,
+	}
+}
+
 const (
 	// ComputeTypeAcu1 is a ComputeType enum value
 	ComputeTypeAcu1 = "ACU_1"
@@ -10067,6 +12823,14 @@ const (
 	ComputeTypeAcu2 = "ACU_2"
 )
 
+// ComputeType_Values returns all elements of the ComputeType enum
+func ComputeType_Values() []string {
+	return []string{
+		ComputeTypeAcu1,
+		ComputeTypeAcu2,
+	}
+}
+
 const (
 	// DatasetActionTypeQuery is a DatasetActionType enum value
 	DatasetActionTypeQuery = "QUERY"
@@ -10075,6 +12839,14 @@ const (
 	DatasetActionTypeContainer = "CONTAINER"
 )
 
+// DatasetActionType_Values returns all elements of the DatasetActionType enum
+func DatasetActionType_Values() []string {
+	return []string{
+		DatasetActionTypeQuery,
+		DatasetActionTypeContainer,
+	}
+}
+
 const (
 	// DatasetContentStateCreating is a DatasetContentState enum value
 	DatasetContentStateCreating = "CREATING"
@@ -10086,6 +12858,15 @@ const (
 	DatasetContentStateFailed = "FAILED"
 )
 
+// DatasetContentState_Values returns all elements of the DatasetContentState enum
+func DatasetContentState_Values() []string {
+	return []string{
+		DatasetContentStateCreating,
+		DatasetContentStateSucceeded,
+		DatasetContentStateFailed,
+	}
+}
+
 const (
 	// DatasetStatusCreating is a DatasetStatus enum value
 	DatasetStatusCreating = "CREATING"
@@ -10097,6 +12878,15 @@ const (
 	DatasetStatusDeleting = "DELETING"
 )
 
+// DatasetStatus_Values returns all elements of the DatasetStatus enum
+func DatasetStatus_Values() []string {
+	return []string{
+		DatasetStatusCreating,
+		DatasetStatusActive,
+		DatasetStatusDeleting,
+	}
+}
+
 const (
 	// DatastoreStatusCreating is a DatastoreStatus enum value
 	DatastoreStatusCreating = "CREATING"
@@ -10108,11 +12898,43 @@ const (
 	DatastoreStatusDeleting = "DELETING"
 )
 
+// DatastoreStatus_Values returns all elements of the DatastoreStatus enum
+func DatastoreStatus_Values() []string {
+	return []string{
+		DatastoreStatusCreating,
+		DatastoreStatusActive,
+		DatastoreStatusDeleting,
+	}
+}
+
+const (
+	// FileFormatTypeJson is a FileFormatType enum value
+	FileFormatTypeJson = "JSON"
+
+	// FileFormatTypeParquet is a FileFormatType enum value
+	FileFormatTypeParquet = "PARQUET"
+)
+
+// FileFormatType_Values returns all elements of the FileFormatType enum
+func FileFormatType_Values() []string {
+	return []string{
+		FileFormatTypeJson,
+		FileFormatTypeParquet,
+	}
+}
+
 const (
 	// LoggingLevelError is a LoggingLevel enum value
 	LoggingLevelError = "ERROR"
 )
 
+// LoggingLevel_Values returns all elements of the LoggingLevel enum
+func LoggingLevel_Values() []string {
+	return []string{
+		LoggingLevelError,
+	}
+}
+
 const (
 	// ReprocessingStatusRunning is a ReprocessingStatus enum value
 	ReprocessingStatusRunning = "RUNNING"
@@ -10126,3 +12948,13 @@ const (
 	// ReprocessingStatusFailed is a ReprocessingStatus enum value
 	ReprocessingStatusFailed = "FAILED"
 )
+
+// ReprocessingStatus_Values returns all elements of the ReprocessingStatus enum
+func ReprocessingStatus_Values() []string {
+	return []string{
+		ReprocessingStatusRunning,
+		ReprocessingStatusSucceeded,
+		ReprocessingStatusCancelled,
+		ReprocessingStatusFailed,
+	}
+}
@@ -2,6 +2,10 @@
 
 package iotevents
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeInternalFailureException for service response error code
@@ -58,3 +62,15 @@ const (
 	// The requested operation is not supported.
 	ErrCodeUnsupportedOperationException = "UnsupportedOperationException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"InternalFailureException":       newErrorInternalFailureException,
+	"InvalidRequestException":        newErrorInvalidRequestException,
+	"LimitExceededException":         newErrorLimitExceededException,
+	"ResourceAlreadyExistsException": newErrorResourceAlreadyExistsException,
+	"ResourceInUseException":         newErrorResourceInUseException,
+	"ResourceNotFoundException":      newErrorResourceNotFoundException,
+	"ServiceUnavailableException":    newErrorServiceUnavailableException,
+	"ThrottlingException":            newErrorThrottlingException,
+	"UnsupportedOperationException":  newErrorUnsupportedOperationException,
+}
@@ -11,7 +11,7 @@
 // See kinesis package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/kinesis/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon Kinesis with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -3,7 +3,7 @@
 // Package kinesisanalytics provides the client and types for making API
 // requests to Amazon Kinesis Analytics.
 //
-// Overview
+// # Overview
 //
 // This documentation is for version 1 of the Amazon Kinesis Data Analytics
 // API, which only supports SQL applications. Version 2 of the API supports
@@ -18,7 +18,7 @@
 // See kinesisanalytics package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/kinesisanalytics/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon Kinesis Analytics with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -4,17 +4,17 @@
 // requests to Amazon Kinesis Analytics.
 //
 // Amazon Kinesis Data Analytics is a fully managed service that you can use
-// to process and analyze streaming data using SQL or Java. The service enables
-// you to quickly author and run SQL or Java code against streaming sources
-// to perform time series analytics, feed real-time dashboards, and create real-time
-// metrics.
+// to process and analyze streaming data using Java, SQL, or Scala. The service
+// enables you to quickly author and run Java, SQL, or Scala code against streaming
+// sources to perform time series analytics, feed real-time dashboards, and
+// create real-time metrics.
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/kinesisanalyticsv2-2018-05-23 for more information on this service.
 //
 // See kinesisanalyticsv2 package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/kinesisanalyticsv2/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon Kinesis Analytics with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
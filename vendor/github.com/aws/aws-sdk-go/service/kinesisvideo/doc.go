@@ -8,7 +8,7 @@
 // See kinesisvideo package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/kinesisvideo/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon Kinesis Video Streams with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
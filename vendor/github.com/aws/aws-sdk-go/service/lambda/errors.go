@@ -2,14 +2,33 @@
 
 package lambda
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
+	// ErrCodeCodeSigningConfigNotFoundException for service response error code
+	// "CodeSigningConfigNotFoundException".
+	//
+	// The specified code signing configuration does not exist.
+	ErrCodeCodeSigningConfigNotFoundException = "CodeSigningConfigNotFoundException"
+
 	// ErrCodeCodeStorageExceededException for service response error code
 	// "CodeStorageExceededException".
 	//
-	// You have exceeded your maximum total code size per account. Learn more (https://docs.aws.amazon.com/lambda/latest/dg/limits.html)
+	// Your Amazon Web Services account has exceeded its maximum total code size.
+	// For more information, see Lambda quotas (https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html).
 	ErrCodeCodeStorageExceededException = "CodeStorageExceededException"
 
+	// ErrCodeCodeVerificationFailedException for service response error code
+	// "CodeVerificationFailedException".
+	//
+	// The code signature failed one or more of the validation checks for signature
+	// mismatch or expiry, and the code signing policy is set to ENFORCE. Lambda
+	// blocks the deployment.
+	ErrCodeCodeVerificationFailedException = "CodeVerificationFailedException"
+
 	// ErrCodeEC2AccessDeniedException for service response error code
 	// "EC2AccessDeniedException".
 	//
@@ -19,31 +38,64 @@ const (
 	// ErrCodeEC2ThrottledException for service response error code
 	// "EC2ThrottledException".
 	//
-	// AWS Lambda was throttled by Amazon EC2 during Lambda function initialization
-	// using the execution role provided for the Lambda function.
+	// Amazon EC2 throttled Lambda during Lambda function initialization using the
+	// execution role provided for the function.
 	ErrCodeEC2ThrottledException = "EC2ThrottledException"
 
 	// ErrCodeEC2UnexpectedException for service response error code
 	// "EC2UnexpectedException".
 	//
-	// AWS Lambda received an unexpected EC2 client exception while setting up for
-	// the Lambda function.
+	// Lambda received an unexpected Amazon EC2 client exception while setting up
+	// for the Lambda function.
 	ErrCodeEC2UnexpectedException = "EC2UnexpectedException"
 
+	// ErrCodeEFSIOException for service response error code
+	// "EFSIOException".
+	//
+	// An error occurred when reading from or writing to a connected file system.
+	ErrCodeEFSIOException = "EFSIOException"
+
+	// ErrCodeEFSMountConnectivityException for service response error code
+	// "EFSMountConnectivityException".
+	//
+	// The Lambda function couldn't make a network connection to the configured
+	// file system.
+	ErrCodeEFSMountConnectivityException = "EFSMountConnectivityException"
+
+	// ErrCodeEFSMountFailureException for service response error code
+	// "EFSMountFailureException".
+	//
+	// The Lambda function couldn't mount the configured file system due to a permission
+	// or configuration issue.
+	ErrCodeEFSMountFailureException = "EFSMountFailureException"
+
+	// ErrCodeEFSMountTimeoutException for service response error code
+	// "EFSMountTimeoutException".
+	//
+	// The Lambda function made a network connection to the configured file system,
+	// but the mount operation timed out.
+	ErrCodeEFSMountTimeoutException = "EFSMountTimeoutException"
+
 	// ErrCodeENILimitReachedException for service response error code
 	// "ENILimitReachedException".
 	//
-	// AWS Lambda was not able to create an Elastic Network Interface (ENI) in the
-	// VPC, specified as part of Lambda function configuration, because the limit
-	// for network interfaces has been reached.
+	// Lambda couldn't create an elastic network interface in the VPC, specified
+	// as part of Lambda function configuration, because the limit for network interfaces
+	// has been reached. For more information, see Lambda quotas (https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html).
 	ErrCodeENILimitReachedException = "ENILimitReachedException"
 
+	// ErrCodeInvalidCodeSignatureException for service response error code
+	// "InvalidCodeSignatureException".
+	//
+	// The code signature failed the integrity check. If the integrity check fails,
+	// then Lambda blocks deployment, even if the code signing policy is set to
+	// WARN.
+	ErrCodeInvalidCodeSignatureException = "InvalidCodeSignatureException"
+
 	// ErrCodeInvalidParameterValueException for service response error code
 	// "InvalidParameterValueException".
 	//
-	// One of the parameters in the request is invalid. For example, if you provided
-	// an IAM role for AWS Lambda to assume in the CreateFunction or the UpdateFunctionConfiguration
-	// API, that AWS Lambda is unable to assume you will get this exception.
+	// One of the parameters in the request is not valid.
 	ErrCodeInvalidParameterValueException = "InvalidParameterValueException"
 
 	// ErrCodeInvalidRequestContentException for service response error code
@@ -61,109 +113,151 @@ const (
 	// ErrCodeInvalidSecurityGroupIDException for service response error code
 	// "InvalidSecurityGroupIDException".
 	//
-	// The Security Group ID provided in the Lambda function VPC configuration is
-	// invalid.
+	// The security group ID provided in the Lambda function VPC configuration is
+	// not valid.
 	ErrCodeInvalidSecurityGroupIDException = "InvalidSecurityGroupIDException"
 
 	// ErrCodeInvalidSubnetIDException for service response error code
 	// "InvalidSubnetIDException".
 	//
-	// The Subnet ID provided in the Lambda function VPC configuration is invalid.
+	// The subnet ID provided in the Lambda function VPC configuration is not valid.
 	ErrCodeInvalidSubnetIDException = "InvalidSubnetIDException"
 
 	// ErrCodeInvalidZipFileException for service response error code
 	// "InvalidZipFileException".
 	//
-	// AWS Lambda could not unzip the deployment package.
+	// Lambda could not unzip the deployment package.
 	ErrCodeInvalidZipFileException = "InvalidZipFileException"
 
 	// ErrCodeKMSAccessDeniedException for service response error code
 	// "KMSAccessDeniedException".
 	//
-	// Lambda was unable to decrypt the environment variables because KMS access
-	// was denied. Check the Lambda function's KMS permissions.
+	// Lambda couldn't decrypt the environment variables because KMS access was
+	// denied. Check the Lambda function's KMS permissions.
 	ErrCodeKMSAccessDeniedException = "KMSAccessDeniedException"
 
 	// ErrCodeKMSDisabledException for service response error code
 	// "KMSDisabledException".
 	//
-	// Lambda was unable to decrypt the environment variables because the KMS key
-	// used is disabled. Check the Lambda function's KMS key settings.
+	// Lambda couldn't decrypt the environment variables because the KMS key used
+	// is disabled. Check the Lambda function's KMS key settings.
 	ErrCodeKMSDisabledException = "KMSDisabledException"
 
 	// ErrCodeKMSInvalidStateException for service response error code
 	// "KMSInvalidStateException".
 	//
-	// Lambda was unable to decrypt the environment variables because the KMS key
-	// used is in an invalid state for Decrypt. Check the function's KMS key settings.
+	// Lambda couldn't decrypt the environment variables because the state of the
+	// KMS key used is not valid for Decrypt. Check the function's KMS key settings.
 	ErrCodeKMSInvalidStateException = "KMSInvalidStateException"
 
 	// ErrCodeKMSNotFoundException for service response error code
 	// "KMSNotFoundException".
 	//
-	// Lambda was unable to decrypt the environment variables because the KMS key
-	// was not found. Check the function's KMS key settings.
+	// Lambda couldn't decrypt the environment variables because the KMS key was
+	// not found. Check the function's KMS key settings.
 	ErrCodeKMSNotFoundException = "KMSNotFoundException"
 
 	// ErrCodePolicyLengthExceededException for service response error code
 	// "PolicyLengthExceededException".
 	//
-	// The permissions policy for the resource is too large. Learn more (https://docs.aws.amazon.com/lambda/latest/dg/limits.html)
+	// The permissions policy for the resource is too large. For more information,
+	// see Lambda quotas (https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html).
 	ErrCodePolicyLengthExceededException = "PolicyLengthExceededException"
 
 	// ErrCodePreconditionFailedException for service response error code
 	// "PreconditionFailedException".
 	//
 	// The RevisionId provided does not match the latest RevisionId for the Lambda
-	// function or alias. Call the GetFunction or the GetAlias API to retrieve the
-	// latest RevisionId for your resource.
+	// function or alias. Call the GetFunction or the GetAlias API operation to
+	// retrieve the latest RevisionId for your resource.
 	ErrCodePreconditionFailedException = "PreconditionFailedException"
 
+	// ErrCodeProvisionedConcurrencyConfigNotFoundException for service response error code
+	// "ProvisionedConcurrencyConfigNotFoundException".
+	//
+	// The specified configuration does not exist.
+	ErrCodeProvisionedConcurrencyConfigNotFoundException = "ProvisionedConcurrencyConfigNotFoundException"
+
+	// ErrCodeRecursiveInvocationException for service response error code
+	// "RecursiveInvocationException".
+	//
+	// Lambda has detected your function being invoked in a recursive loop with
+	// other Amazon Web Services resources and stopped your function's invocation.
+	ErrCodeRecursiveInvocationException = "RecursiveInvocationException"
+
 	// ErrCodeRequestTooLargeException for service response error code
 	// "RequestTooLargeException".
 	//
-	// The request payload exceeded the Invoke request body JSON input limit. For
-	// more information, see Limits (https://docs.aws.amazon.com/lambda/latest/dg/limits.html).
+	// The request payload exceeded the Invoke request body JSON input quota. For
+	// more information, see Lambda quotas (https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html).
 	ErrCodeRequestTooLargeException = "RequestTooLargeException"
 
 	// ErrCodeResourceConflictException for service response error code
 	// "ResourceConflictException".
 	//
-	// The resource already exists.
+	// The resource already exists, or another operation is in progress.
 	ErrCodeResourceConflictException = "ResourceConflictException"
 
 	// ErrCodeResourceInUseException for service response error code
 	// "ResourceInUseException".
 	//
 	// The operation conflicts with the resource's availability. For example, you
-	// attempted to update an EventSource Mapping in CREATING, or tried to delete
-	// a EventSource mapping currently in the UPDATING state.
+	// tried to update an event source mapping in the CREATING state, or you tried
+	// to delete an event source mapping currently UPDATING.
 	ErrCodeResourceInUseException = "ResourceInUseException"
 
 	// ErrCodeResourceNotFoundException for service response error code
 	// "ResourceNotFoundException".
 	//
-	// The resource (for example, a Lambda function or access policy statement)
-	// specified in the request does not exist.
+	// The resource specified in the request does not exist.
 	ErrCodeResourceNotFoundException = "ResourceNotFoundException"
 
+	// ErrCodeResourceNotReadyException for service response error code
+	// "ResourceNotReadyException".
+	//
+	// The function is inactive and its VPC connection is no longer available. Wait
+	// for the VPC connection to reestablish and try again.
+	ErrCodeResourceNotReadyException = "ResourceNotReadyException"
+
 	// ErrCodeServiceException for service response error code
 	// "ServiceException".
 	//
-	// The AWS Lambda service encountered an internal error.
+	// The Lambda service encountered an internal error.
 	ErrCodeServiceException = "ServiceException"
 
+	// ErrCodeSnapStartException for service response error code
+	// "SnapStartException".
+	//
+	// The afterRestore() runtime hook (https://docs.aws.amazon.com/lambda/latest/dg/snapstart-runtime-hooks.html)
+	// encountered an error. For more information, check the Amazon CloudWatch logs.
+	ErrCodeSnapStartException = "SnapStartException"
+
+	// ErrCodeSnapStartNotReadyException for service response error code
+	// "SnapStartNotReadyException".
+	//
+	// Lambda is initializing your function. You can invoke the function when the
+	// function state (https://docs.aws.amazon.com/lambda/latest/dg/functions-states.html)
+	// becomes Active.
+	ErrCodeSnapStartNotReadyException = "SnapStartNotReadyException"
+
+	// ErrCodeSnapStartTimeoutException for service response error code
+	// "SnapStartTimeoutException".
+	//
+	// Lambda couldn't restore the snapshot within the timeout limit.
+	ErrCodeSnapStartTimeoutException = "SnapStartTimeoutException"
+
 	// ErrCodeSubnetIPAddressLimitReachedException for service response error code
 	// "SubnetIPAddressLimitReachedException".
 	//
-	// AWS Lambda was not able to set up VPC access for the Lambda function because
-	// one or more configured subnets has no available IP addresses.
+	// Lambda couldn't set up VPC access for the Lambda function because one or
+	// more configured subnets has no available IP addresses.
 	ErrCodeSubnetIPAddressLimitReachedException = "SubnetIPAddressLimitReachedException"
 
 	// ErrCodeTooManyRequestsException for service response error code
 	// "TooManyRequestsException".
 	//
-	// Request throughput limit exceeded.
+	// The request throughput limit was exceeded. For more information, see Lambda
+	// quotas (https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html#api-requests).
 	ErrCodeTooManyRequestsException = "TooManyRequestsException"
 
 	// ErrCodeUnsupportedMediaTypeException for service response error code
@@ -172,3 +266,44 @@ const (
 	// The content type of the Invoke request body is not JSON.
 	ErrCodeUnsupportedMediaTypeException = "UnsupportedMediaTypeException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"CodeSigningConfigNotFoundException":            newErrorCodeSigningConfigNotFoundException,
+	"CodeStorageExceededException":                  newErrorCodeStorageExceededException,
+	"CodeVerificationFailedException":               newErrorCodeVerificationFailedException,
+	"EC2AccessDeniedException":                      newErrorEC2AccessDeniedException,
+	"EC2ThrottledException":                         newErrorEC2ThrottledException,
+	"EC2UnexpectedException":                        newErrorEC2UnexpectedException,
+	"EFSIOException":                                newErrorEFSIOException,
+	"EFSMountConnectivityException":                 newErrorEFSMountConnectivityException,
+	"EFSMountFailureException":                      newErrorEFSMountFailureException,
+	"EFSMountTimeoutException":                      newErrorEFSMountTimeoutException,
+	"ENILimitReachedException":                      newErrorENILimitReachedException,
+	"InvalidCodeSignatureException":                 newErrorInvalidCodeSignatureException,
+	"InvalidParameterValueException":                newErrorInvalidParameterValueException,
+	"InvalidRequestContentException":                newErrorInvalidRequestContentException,
+	"InvalidRuntimeException":                       newErrorInvalidRuntimeException,
+	"InvalidSecurityGroupIDException":               newErrorInvalidSecurityGroupIDException,
+	"InvalidSubnetIDException":                      newErrorInvalidSubnetIDException,
+	"InvalidZipFileException":                       newErrorInvalidZipFileException,
+	"KMSAccessDeniedException":                      newErrorKMSAccessDeniedException,
+	"KMSDisabledException":                          newErrorKMSDisabledException,
+	"KMSInvalidStateException":                      newErrorKMSInvalidStateException,
+	"KMSNotFoundException":                          newErrorKMSNotFoundException,
+	"PolicyLengthExceededException":                 newErrorPolicyLengthExceededException,
+	"PreconditionFailedException":                   newErrorPreconditionFailedException,
+	"ProvisionedConcurrencyConfigNotFoundException": newErrorProvisionedConcurrencyConfigNotFoundException,
+	"RecursiveInvocationException":                  newErrorRecursiveInvocationException,
+	"RequestTooLargeException":                      newErrorRequestTooLargeException,
+	"ResourceConflictException":                     newErrorResourceConflictException,
+	"ResourceInUseException":                        newErrorResourceInUseException,
+	"ResourceNotFoundException":                     newErrorResourceNotFoundException,
+	"ResourceNotReadyException":                     newErrorResourceNotReadyException,
+	"ServiceException":                              newErrorServiceException,
+	"SnapStartException":                            newErrorSnapStartException,
+	"SnapStartNotReadyException":                    newErrorSnapStartNotReadyException,
+	"SnapStartTimeoutException":                     newErrorSnapStartTimeoutException,
+	"SubnetIPAddressLimitReachedException":          newErrorSubnetIPAddressLimitReachedException,
+	"TooManyRequestsException":                      newErrorTooManyRequestsException,
+	"UnsupportedMediaTypeException":                 newErrorUnsupportedMediaTypeException,
+}
@@ -12,7 +12,7 @@
 // See lexmodelbuildingservice package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/lexmodelbuildingservice/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon Lex Model Building Service with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -3,26 +3,30 @@
 // Package lightsail provides the client and types for making API
 // requests to Amazon Lightsail.
 //
-// Amazon Lightsail is the easiest way to get started with AWS for developers
-// who just need virtual private servers. Lightsail includes everything you
-// need to launch your project quickly - a virtual machine, a managed database,
-// SSD-based storage, data transfer, DNS management, and a static IP - for a
-// low, predictable price. You manage those Lightsail servers through the Lightsail
-// console or by using the API or command-line interface (CLI).
-//
-// For more information about Lightsail concepts and tasks, see the Lightsail
-// Dev Guide (https://lightsail.aws.amazon.com/ls/docs/all).
-//
-// To use the Lightsail API or the CLI, you will need to use AWS Identity and
-// Access Management (IAM) to generate access keys. For details about how to
-// set this up, see the Lightsail Dev Guide (http://lightsail.aws.amazon.com/ls/docs/how-to/article/lightsail-how-to-set-up-access-keys-to-use-sdk-api-cli).
+// Amazon Lightsail is the easiest way to get started with Amazon Web Services
+// (Amazon Web Services) for developers who need to build websites or web applications.
+// It includes everything you need to launch your project quickly - instances
+// (virtual private servers), container services, storage buckets, managed databases,
+// SSD-based block storage, static IP addresses, load balancers, content delivery
+// network (CDN) distributions, DNS management of registered domains, and resource
+// snapshots (backups) - for a low, predictable monthly price.
+//
+// You can manage your Lightsail resources using the Lightsail console, Lightsail
+// API, Command Line Interface (CLI), or SDKs. For more information about Lightsail
+// concepts and tasks, see the Amazon Lightsail Developer Guide (https://lightsail.aws.amazon.com/ls/docs/en_us/articles/lightsail-how-to-set-up-access-keys-to-use-sdk-api-cli).
+//
+// This API Reference provides detailed information about the actions, data
+// types, parameters, and errors of the Lightsail service. For more information
+// about the supported Amazon Web Services Regions, endpoints, and service quotas
+// of the Lightsail service, see Amazon Lightsail Endpoints and Quotas (https://docs.aws.amazon.com/general/latest/gr/lightsail.html)
+// in the Amazon Web Services General Reference.
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/lightsail-2016-11-28 for more information on this service.
 //
 // See lightsail package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/lightsail/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon Lightsail with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -2,6 +2,10 @@
 
 package lightsail
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeAccessDeniedException for service response error code
@@ -24,9 +28,9 @@ const (
 	// Lightsail throws this exception when user input does not conform to the validation
 	// rules of an input field.
 	//
-	// Domain-related APIs are only available in the N. Virginia (us-east-1) Region.
-	// Please set your AWS Region configuration to us-east-1 to create, view, or
-	// edit these resources.
+	// Domain and distribution APIs are only available in the N. Virginia (us-east-1)
+	// Amazon Web Services Region. Please set your Amazon Web Services Region configuration
+	// to us-east-1 to create, view, or edit these resources.
 	ErrCodeInvalidInputException = "InvalidInputException"
 
 	// ErrCodeNotFoundException for service response error code
@@ -53,3 +57,13 @@ const (
 	// Lightsail throws this exception when the user has not been authenticated.
 	ErrCodeUnauthenticatedException = "UnauthenticatedException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"AccessDeniedException":           newErrorAccessDeniedException,
+	"AccountSetupInProgressException": newErrorAccountSetupInProgressException,
+	"InvalidInputException":           newErrorInvalidInputException,
+	"NotFoundException":               newErrorNotFoundException,
+	"OperationFailureException":       newErrorOperationFailureException,
+	"ServiceException":                newErrorServiceException,
+	"UnauthenticatedException":        newErrorUnauthenticatedException,
+}
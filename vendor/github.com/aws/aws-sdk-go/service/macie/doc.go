@@ -3,19 +3,21 @@
 // Package macie provides the client and types for making API
 // requests to Amazon Macie.
 //
-// Amazon Macie is a security service that uses machine learning to automatically
-// discover, classify, and protect sensitive data in AWS. Macie recognizes sensitive
-// data such as personally identifiable information (PII) or intellectual property,
-// and provides you with dashboards and alerts that give visibility into how
-// this data is being accessed or moved. For more information, see the Macie
-// User Guide (https://docs.aws.amazon.com/macie/latest/userguide/what-is-macie.html).
+// Amazon Macie Classic has been discontinued and is no longer available.
+//
+// A new Amazon Macie is now available with significant design improvements
+// and additional features, at a lower price and in most Amazon Web Services
+// Regions. We encourage you to take advantage of the new and improved features,
+// and benefit from the reduced cost. To learn about features and pricing for
+// the new Macie, see Amazon Macie (http://aws.amazon.com/macie/). To learn
+// how to use the new Macie, see the Amazon Macie User Guide (https://docs.aws.amazon.com/macie/latest/user/what-is-macie.html).
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/macie-2017-12-19 for more information on this service.
 //
 // See macie package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/macie/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon Macie with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -2,31 +2,44 @@
 
 package macie
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeAccessDeniedException for service response error code
 	// "AccessDeniedException".
 	//
-	// You do not have required permissions to access the requested resource.
+	// (Discontinued) You do not have required permissions to access the requested
+	// resource.
 	ErrCodeAccessDeniedException = "AccessDeniedException"
 
 	// ErrCodeInternalException for service response error code
 	// "InternalException".
 	//
-	// Internal server error.
+	// (Discontinued) Internal server error.
 	ErrCodeInternalException = "InternalException"
 
 	// ErrCodeInvalidInputException for service response error code
 	// "InvalidInputException".
 	//
-	// The request was rejected because an invalid or out-of-range value was supplied
-	// for an input parameter.
+	// (Discontinued) The request was rejected because an invalid or out-of-range
+	// value was supplied for an input parameter.
 	ErrCodeInvalidInputException = "InvalidInputException"
 
 	// ErrCodeLimitExceededException for service response error code
 	// "LimitExceededException".
 	//
-	// The request was rejected because it attempted to create resources beyond
-	// the current AWS account limits. The error code describes the limit exceeded.
+	// (Discontinued) The request was rejected because it attempted to create resources
+	// beyond the current Amazon Web Services account quotas. The error code describes
+	// the quota exceeded.
 	ErrCodeLimitExceededException = "LimitExceededException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"AccessDeniedException":  newErrorAccessDeniedException,
+	"InternalException":      newErrorInternalException,
+	"InvalidInputException":  newErrorInvalidInputException,
+	"LimitExceededException": newErrorLimitExceededException,
+}
@@ -2,12 +2,16 @@
 
 package managedblockchain
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeAccessDeniedException for service response error code
 	// "AccessDeniedException".
 	//
-	// You do not have sufficient access to perform this action.
+	// You don't have sufficient access to perform this action.
 	ErrCodeAccessDeniedException = "AccessDeniedException"
 
 	// ErrCodeIllegalActionException for service response error code
@@ -45,23 +49,40 @@ const (
 	// ErrCodeResourceNotFoundException for service response error code
 	// "ResourceNotFoundException".
 	//
-	// A requested resource does not exist on the network. It may have been deleted
-	// or referenced inaccurately.
+	// A requested resource doesn't exist. It may have been deleted or referenced
+	// incorrectly.
 	ErrCodeResourceNotFoundException = "ResourceNotFoundException"
 
 	// ErrCodeResourceNotReadyException for service response error code
 	// "ResourceNotReadyException".
 	//
-	// The requested resource exists but is not in a status that can complete the
+	// The requested resource exists but isn't in a status that can complete the
 	// operation.
 	ErrCodeResourceNotReadyException = "ResourceNotReadyException"
 
 	// ErrCodeThrottlingException for service response error code
 	// "ThrottlingException".
 	//
-	// The request or operation could not be performed because a service is throttling
-	// requests. The most common source of throttling errors is launching EC2 instances
-	// such that your service limit for EC2 instances is exceeded. Request a limit
-	// increase or delete unused resources if possible.
+	// The request or operation couldn't be performed because a service is throttling
+	// requests. The most common source of throttling errors is creating resources
+	// that exceed your service limit for this resource type. Request a limit increase
+	// or delete unused resources if possible.
 	ErrCodeThrottlingException = "ThrottlingException"
+
+	// ErrCodeTooManyTagsException for service response error code
+	// "TooManyTagsException".
+	ErrCodeTooManyTagsException = "TooManyTagsException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"AccessDeniedException":          newErrorAccessDeniedException,
+	"IllegalActionException":         newErrorIllegalActionException,
+	"InternalServiceErrorException":  newErrorInternalServiceErrorException,
+	"InvalidRequestException":        newErrorInvalidRequestException,
+	"ResourceAlreadyExistsException": newErrorResourceAlreadyExistsException,
+	"ResourceLimitExceededException": newErrorResourceLimitExceededException,
+	"ResourceNotFoundException":      newErrorResourceNotFoundException,
+	"ResourceNotReadyException":      newErrorResourceNotReadyException,
+	"ThrottlingException":            newErrorThrottlingException,
+	"TooManyTagsException":           newErrorTooManyTagsException,
+}
@@ -3,14 +3,14 @@
 // Package mediaconnect provides the client and types for making API
 // requests to AWS MediaConnect.
 //
-// API for AWS Elemental MediaConnect
+// # API for AWS Elemental MediaConnect
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/mediaconnect-2018-11-14 for more information on this service.
 //
 // See mediaconnect package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/mediaconnect/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact AWS MediaConnect with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -3,14 +3,14 @@
 // Package mediaconvert provides the client and types for making API
 // requests to AWS Elemental MediaConvert.
 //
-// AWS Elemental MediaConvert
+// # AWS Elemental MediaConvert
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/mediaconvert-2017-08-29 for more information on this service.
 //
 // See mediaconvert package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/mediaconvert/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact AWS Elemental MediaConvert with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -12,6 +12,94 @@ import (
 	"github.com/aws/aws-sdk-go/private/protocol/restjson"
 )
 
+const opConfigureLogs = "ConfigureLogs"
+
+// ConfigureLogsRequest generates a "aws/request.Request" representing the
+// client's request for the ConfigureLogs operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ConfigureLogs for more information on using the ConfigureLogs
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ConfigureLogsRequest method.
+//	req, resp := client.ConfigureLogsRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/ConfigureLogs
+func (c *MediaPackage) ConfigureLogsRequest(input *ConfigureLogsInput) (req *request.Request, output *ConfigureLogsOutput) {
+	op := &request.Operation{
+		Name:       opConfigureLogs,
+		HTTPMethod: "PUT",
+		HTTPPath:   "/channels/{id}/configure_logs",
+	}
+
+	if input == nil {
+		input = &ConfigureLogsInput{}
+	}
+
+	output = &ConfigureLogsOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ConfigureLogs API operation for AWS Elemental MediaPackage.
+//
+// # Changes the Channel's properities to configure log subscription
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Elemental MediaPackage's
+// API operation ConfigureLogs for usage and error information.
+//
+// Returned Error Types:
+//
+//   - UnprocessableEntityException
+//
+//   - InternalServerErrorException
+//
+//   - ForbiddenException
+//
+//   - NotFoundException
+//
+//   - ServiceUnavailableException
+//
+//   - TooManyRequestsException
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/ConfigureLogs
+func (c *MediaPackage) ConfigureLogs(input *ConfigureLogsInput) (*ConfigureLogsOutput, error) {
+	req, out := c.ConfigureLogsRequest(input)
+	return out, req.Send()
+}
+
+// ConfigureLogsWithContext is the same as ConfigureLogs with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ConfigureLogs for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *MediaPackage) ConfigureLogsWithContext(ctx aws.Context, input *ConfigureLogsInput, opts ...request.Option) (*ConfigureLogsOutput, error) {
+	req, out := c.ConfigureLogsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opCreateChannel = "CreateChannel"
 
 // CreateChannelRequest generates a "aws/request.Request" representing the
@@ -28,14 +116,13 @@ const opCreateChannel = "CreateChannel"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateChannelRequest method.
+//	req, resp := client.CreateChannelRequest(params)
 //
-//    // Example sending a request using the CreateChannelRequest method.
-//    req, resp := client.CreateChannelRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/CreateChannel
 func (c *MediaPackage) CreateChannelRequest(input *CreateChannelInput) (req *request.Request, output *CreateChannelOutput) {
@@ -65,18 +152,19 @@ func (c *MediaPackage) CreateChannelRequest(input *CreateChannelInput) (req *req
 // See the AWS API reference guide for AWS Elemental MediaPackage's
 // API operation CreateChannel for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnprocessableEntityException "UnprocessableEntityException"
+// Returned Error Types:
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
+//   - UnprocessableEntityException
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
+//   - InternalServerErrorException
 //
-//   * ErrCodeNotFoundException "NotFoundException"
+//   - ForbiddenException
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
+//   - NotFoundException
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
+//   - ServiceUnavailableException
+//
+//   - TooManyRequestsException
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/CreateChannel
 func (c *MediaPackage) CreateChannel(input *CreateChannelInput) (*CreateChannelOutput, error) {
@@ -100,6 +188,94 @@ func (c *MediaPackage) CreateChannelWithContext(ctx aws.Context, input *CreateCh
 	return out, req.Send()
 }
 
+const opCreateHarvestJob = "CreateHarvestJob"
+
+// CreateHarvestJobRequest generates a "aws/request.Request" representing the
+// client's request for the CreateHarvestJob operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See CreateHarvestJob for more information on using the CreateHarvestJob
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the CreateHarvestJobRequest method.
+//	req, resp := client.CreateHarvestJobRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/CreateHarvestJob
+func (c *MediaPackage) CreateHarvestJobRequest(input *CreateHarvestJobInput) (req *request.Request, output *CreateHarvestJobOutput) {
+	op := &request.Operation{
+		Name:       opCreateHarvestJob,
+		HTTPMethod: "POST",
+		HTTPPath:   "/harvest_jobs",
+	}
+
+	if input == nil {
+		input = &CreateHarvestJobInput{}
+	}
+
+	output = &CreateHarvestJobOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// CreateHarvestJob API operation for AWS Elemental MediaPackage.
+//
+// Creates a new HarvestJob record.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Elemental MediaPackage's
+// API operation CreateHarvestJob for usage and error information.
+//
+// Returned Error Types:
+//
+//   - UnprocessableEntityException
+//
+//   - InternalServerErrorException
+//
+//   - ForbiddenException
+//
+//   - NotFoundException
+//
+//   - ServiceUnavailableException
+//
+//   - TooManyRequestsException
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/CreateHarvestJob
+func (c *MediaPackage) CreateHarvestJob(input *CreateHarvestJobInput) (*CreateHarvestJobOutput, error) {
+	req, out := c.CreateHarvestJobRequest(input)
+	return out, req.Send()
+}
+
+// CreateHarvestJobWithContext is the same as CreateHarvestJob with the addition of
+// the ability to pass a context and additional request options.
+//
+// See CreateHarvestJob for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *MediaPackage) CreateHarvestJobWithContext(ctx aws.Context, input *CreateHarvestJobInput, opts ...request.Option) (*CreateHarvestJobOutput, error) {
+	req, out := c.CreateHarvestJobRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opCreateOriginEndpoint = "CreateOriginEndpoint"
 
 // CreateOriginEndpointRequest generates a "aws/request.Request" representing the
@@ -116,14 +292,13 @@ const opCreateOriginEndpoint = "CreateOriginEndpoint"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateOriginEndpointRequest method.
+//	req, resp := client.CreateOriginEndpointRequest(params)
 //
-//    // Example sending a request using the CreateOriginEndpointRequest method.
-//    req, resp := client.CreateOriginEndpointRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/CreateOriginEndpoint
 func (c *MediaPackage) CreateOriginEndpointRequest(input *CreateOriginEndpointInput) (req *request.Request, output *CreateOriginEndpointOutput) {
@@ -153,18 +328,19 @@ func (c *MediaPackage) CreateOriginEndpointRequest(input *CreateOriginEndpointIn
 // See the AWS API reference guide for AWS Elemental MediaPackage's
 // API operation CreateOriginEndpoint for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnprocessableEntityException "UnprocessableEntityException"
+// Returned Error Types:
+//
+//   - UnprocessableEntityException
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
+//   - InternalServerErrorException
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
+//   - ForbiddenException
 //
-//   * ErrCodeNotFoundException "NotFoundException"
+//   - NotFoundException
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
+//   - ServiceUnavailableException
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
+//   - TooManyRequestsException
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/CreateOriginEndpoint
 func (c *MediaPackage) CreateOriginEndpoint(input *CreateOriginEndpointInput) (*CreateOriginEndpointOutput, error) {
@@ -204,14 +380,13 @@ const opDeleteChannel = "DeleteChannel"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteChannelRequest method.
+//	req, resp := client.DeleteChannelRequest(params)
 //
-//    // Example sending a request using the DeleteChannelRequest method.
-//    req, resp := client.DeleteChannelRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/DeleteChannel
 func (c *MediaPackage) DeleteChannelRequest(input *DeleteChannelInput) (req *request.Request, output *DeleteChannelOutput) {
@@ -242,18 +417,19 @@ func (c *MediaPackage) DeleteChannelRequest(input *DeleteChannelInput) (req *req
 // See the AWS API reference guide for AWS Elemental MediaPackage's
 // API operation DeleteChannel for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnprocessableEntityException "UnprocessableEntityException"
+// Returned Error Types:
+//
+//   - UnprocessableEntityException
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
+//   - InternalServerErrorException
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
+//   - ForbiddenException
 //
-//   * ErrCodeNotFoundException "NotFoundException"
+//   - NotFoundException
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
+//   - ServiceUnavailableException
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
+//   - TooManyRequestsException
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/DeleteChannel
 func (c *MediaPackage) DeleteChannel(input *DeleteChannelInput) (*DeleteChannelOutput, error) {
@@ -293,14 +469,13 @@ const opDeleteOriginEndpoint = "DeleteOriginEndpoint"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteOriginEndpointRequest method.
+//	req, resp := client.DeleteOriginEndpointRequest(params)
 //
-//    // Example sending a request using the DeleteOriginEndpointRequest method.
-//    req, resp := client.DeleteOriginEndpointRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/DeleteOriginEndpoint
 func (c *MediaPackage) DeleteOriginEndpointRequest(input *DeleteOriginEndpointInput) (req *request.Request, output *DeleteOriginEndpointOutput) {
@@ -331,18 +506,19 @@ func (c *MediaPackage) DeleteOriginEndpointRequest(input *DeleteOriginEndpointIn
 // See the AWS API reference guide for AWS Elemental MediaPackage's
 // API operation DeleteOriginEndpoint for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnprocessableEntityException "UnprocessableEntityException"
+// Returned Error Types:
+//
+//   - UnprocessableEntityException
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
+//   - InternalServerErrorException
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
+//   - ForbiddenException
 //
-//   * ErrCodeNotFoundException "NotFoundException"
+//   - NotFoundException
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
+//   - ServiceUnavailableException
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
+//   - TooManyRequestsException
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/DeleteOriginEndpoint
 func (c *MediaPackage) DeleteOriginEndpoint(input *DeleteOriginEndpointInput) (*DeleteOriginEndpointOutput, error) {
@@ -382,14 +558,13 @@ const opDescribeChannel = "DescribeChannel"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeChannelRequest method.
+//	req, resp := client.DescribeChannelRequest(params)
 //
-//    // Example sending a request using the DescribeChannelRequest method.
-//    req, resp := client.DescribeChannelRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/DescribeChannel
 func (c *MediaPackage) DescribeChannelRequest(input *DescribeChannelInput) (req *request.Request, output *DescribeChannelOutput) {
@@ -419,18 +594,19 @@ func (c *MediaPackage) DescribeChannelRequest(input *DescribeChannelInput) (req
 // See the AWS API reference guide for AWS Elemental MediaPackage's
 // API operation DescribeChannel for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnprocessableEntityException "UnprocessableEntityException"
+// Returned Error Types:
+//
+//   - UnprocessableEntityException
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
+//   - InternalServerErrorException
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
+//   - ForbiddenException
 //
-//   * ErrCodeNotFoundException "NotFoundException"
+//   - NotFoundException
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
+//   - ServiceUnavailableException
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
+//   - TooManyRequestsException
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/DescribeChannel
 func (c *MediaPackage) DescribeChannel(input *DescribeChannelInput) (*DescribeChannelOutput, error) {
@@ -454,6 +630,94 @@ func (c *MediaPackage) DescribeChannelWithContext(ctx aws.Context, input *Descri
 	return out, req.Send()
 }
 
+const opDescribeHarvestJob = "DescribeHarvestJob"
+
+// DescribeHarvestJobRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeHarvestJob operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See DescribeHarvestJob for more information on using the DescribeHarvestJob
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the DescribeHarvestJobRequest method.
+//	req, resp := client.DescribeHarvestJobRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/DescribeHarvestJob
+func (c *MediaPackage) DescribeHarvestJobRequest(input *DescribeHarvestJobInput) (req *request.Request, output *DescribeHarvestJobOutput) {
+	op := &request.Operation{
+		Name:       opDescribeHarvestJob,
+		HTTPMethod: "GET",
+		HTTPPath:   "/harvest_jobs/{id}",
+	}
+
+	if input == nil {
+		input = &DescribeHarvestJobInput{}
+	}
+
+	output = &DescribeHarvestJobOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// DescribeHarvestJob API operation for AWS Elemental MediaPackage.
+//
+// Gets details about an existing HarvestJob.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Elemental MediaPackage's
+// API operation DescribeHarvestJob for usage and error information.
+//
+// Returned Error Types:
+//
+//   - UnprocessableEntityException
+//
+//   - InternalServerErrorException
+//
+//   - ForbiddenException
+//
+//   - NotFoundException
+//
+//   - ServiceUnavailableException
+//
+//   - TooManyRequestsException
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/DescribeHarvestJob
+func (c *MediaPackage) DescribeHarvestJob(input *DescribeHarvestJobInput) (*DescribeHarvestJobOutput, error) {
+	req, out := c.DescribeHarvestJobRequest(input)
+	return out, req.Send()
+}
+
+// DescribeHarvestJobWithContext is the same as DescribeHarvestJob with the addition of
+// the ability to pass a context and additional request options.
+//
+// See DescribeHarvestJob for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *MediaPackage) DescribeHarvestJobWithContext(ctx aws.Context, input *DescribeHarvestJobInput, opts ...request.Option) (*DescribeHarvestJobOutput, error) {
+	req, out := c.DescribeHarvestJobRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opDescribeOriginEndpoint = "DescribeOriginEndpoint"
 
 // DescribeOriginEndpointRequest generates a "aws/request.Request" representing the
@@ -470,14 +734,13 @@ const opDescribeOriginEndpoint = "DescribeOriginEndpoint"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeOriginEndpointRequest method.
+//	req, resp := client.DescribeOriginEndpointRequest(params)
 //
-//    // Example sending a request using the DescribeOriginEndpointRequest method.
-//    req, resp := client.DescribeOriginEndpointRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/DescribeOriginEndpoint
 func (c *MediaPackage) DescribeOriginEndpointRequest(input *DescribeOriginEndpointInput) (req *request.Request, output *DescribeOriginEndpointOutput) {
@@ -507,18 +770,19 @@ func (c *MediaPackage) DescribeOriginEndpointRequest(input *DescribeOriginEndpoi
 // See the AWS API reference guide for AWS Elemental MediaPackage's
 // API operation DescribeOriginEndpoint for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnprocessableEntityException "UnprocessableEntityException"
+// Returned Error Types:
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
+//   - UnprocessableEntityException
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
+//   - InternalServerErrorException
 //
-//   * ErrCodeNotFoundException "NotFoundException"
+//   - ForbiddenException
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
+//   - NotFoundException
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
+//   - ServiceUnavailableException
+//
+//   - TooManyRequestsException
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/DescribeOriginEndpoint
 func (c *MediaPackage) DescribeOriginEndpoint(input *DescribeOriginEndpointInput) (*DescribeOriginEndpointOutput, error) {
@@ -558,14 +822,13 @@ const opListChannels = "ListChannels"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListChannelsRequest method.
+//	req, resp := client.ListChannelsRequest(params)
 //
-//    // Example sending a request using the ListChannelsRequest method.
-//    req, resp := client.ListChannelsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/ListChannels
 func (c *MediaPackage) ListChannelsRequest(input *ListChannelsInput) (req *request.Request, output *ListChannelsOutput) {
@@ -601,18 +864,19 @@ func (c *MediaPackage) ListChannelsRequest(input *ListChannelsInput) (req *reque
 // See the AWS API reference guide for AWS Elemental MediaPackage's
 // API operation ListChannels for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnprocessableEntityException "UnprocessableEntityException"
+// Returned Error Types:
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
+//   - UnprocessableEntityException
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
+//   - InternalServerErrorException
 //
-//   * ErrCodeNotFoundException "NotFoundException"
+//   - ForbiddenException
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
+//   - NotFoundException
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
+//   - ServiceUnavailableException
+//
+//   - TooManyRequestsException
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/ListChannels
 func (c *MediaPackage) ListChannels(input *ListChannelsInput) (*ListChannelsOutput, error) {
@@ -644,15 +908,14 @@ func (c *MediaPackage) ListChannelsWithContext(ctx aws.Context, input *ListChann
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListChannels operation.
-//    pageNum := 0
-//    err := client.ListChannelsPages(params,
-//        func(page *mediapackage.ListChannelsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListChannels operation.
+//	pageNum := 0
+//	err := client.ListChannelsPages(params,
+//	    func(page *mediapackage.ListChannelsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *MediaPackage) ListChannelsPages(input *ListChannelsInput, fn func(*ListChannelsOutput, bool) bool) error {
 	return c.ListChannelsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -679,44 +942,45 @@ func (c *MediaPackage) ListChannelsPagesWithContext(ctx aws.Context, input *List
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListChannelsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListChannelsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
-const opListOriginEndpoints = "ListOriginEndpoints"
+const opListHarvestJobs = "ListHarvestJobs"
 
-// ListOriginEndpointsRequest generates a "aws/request.Request" representing the
-// client's request for the ListOriginEndpoints operation. The "output" return
+// ListHarvestJobsRequest generates a "aws/request.Request" representing the
+// client's request for the ListHarvestJobs operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListOriginEndpoints for more information on using the ListOriginEndpoints
+// See ListHarvestJobs for more information on using the ListHarvestJobs
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListHarvestJobsRequest method.
+//	req, resp := client.ListHarvestJobsRequest(params)
 //
-//    // Example sending a request using the ListOriginEndpointsRequest method.
-//    req, resp := client.ListOriginEndpointsRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/ListOriginEndpoints
-func (c *MediaPackage) ListOriginEndpointsRequest(input *ListOriginEndpointsInput) (req *request.Request, output *ListOriginEndpointsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/ListHarvestJobs
+func (c *MediaPackage) ListHarvestJobsRequest(input *ListHarvestJobsInput) (req *request.Request, output *ListHarvestJobsOutput) {
 	op := &request.Operation{
-		Name:       opListOriginEndpoints,
+		Name:       opListHarvestJobs,
 		HTTPMethod: "GET",
-		HTTPPath:   "/origin_endpoints",
+		HTTPPath:   "/harvest_jobs",
 		Paginator: &request.Paginator{
 			InputTokens:     []string{"NextToken"},
 			OutputTokens:    []string{"NextToken"},
@@ -726,168 +990,314 @@ func (c *MediaPackage) ListOriginEndpointsRequest(input *ListOriginEndpointsInpu
 	}
 
 	if input == nil {
-		input = &ListOriginEndpointsInput{}
+		input = &ListHarvestJobsInput{}
 	}
 
-	output = &ListOriginEndpointsOutput{}
+	output = &ListHarvestJobsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListOriginEndpoints API operation for AWS Elemental MediaPackage.
+// ListHarvestJobs API operation for AWS Elemental MediaPackage.
 //
-// Returns a collection of OriginEndpoint records.
+// Returns a collection of HarvestJob records.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Elemental MediaPackage's
-// API operation ListOriginEndpoints for usage and error information.
+// API operation ListHarvestJobs for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnprocessableEntityException "UnprocessableEntityException"
+// Returned Error Types:
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
+//   - UnprocessableEntityException
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
+//   - InternalServerErrorException
 //
-//   * ErrCodeNotFoundException "NotFoundException"
+//   - ForbiddenException
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
+//   - NotFoundException
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
+//   - ServiceUnavailableException
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/ListOriginEndpoints
-func (c *MediaPackage) ListOriginEndpoints(input *ListOriginEndpointsInput) (*ListOriginEndpointsOutput, error) {
-	req, out := c.ListOriginEndpointsRequest(input)
+//   - TooManyRequestsException
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/ListHarvestJobs
+func (c *MediaPackage) ListHarvestJobs(input *ListHarvestJobsInput) (*ListHarvestJobsOutput, error) {
+	req, out := c.ListHarvestJobsRequest(input)
 	return out, req.Send()
 }
 
-// ListOriginEndpointsWithContext is the same as ListOriginEndpoints with the addition of
+// ListHarvestJobsWithContext is the same as ListHarvestJobs with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListOriginEndpoints for details on how to use this API operation.
+// See ListHarvestJobs for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *MediaPackage) ListOriginEndpointsWithContext(ctx aws.Context, input *ListOriginEndpointsInput, opts ...request.Option) (*ListOriginEndpointsOutput, error) {
-	req, out := c.ListOriginEndpointsRequest(input)
+func (c *MediaPackage) ListHarvestJobsWithContext(ctx aws.Context, input *ListHarvestJobsInput, opts ...request.Option) (*ListHarvestJobsOutput, error) {
+	req, out := c.ListHarvestJobsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-// ListOriginEndpointsPages iterates over the pages of a ListOriginEndpoints operation,
+// ListHarvestJobsPages iterates over the pages of a ListHarvestJobs operation,
 // calling the "fn" function with the response data for each page. To stop
 // iterating, return false from the fn function.
 //
-// See ListOriginEndpoints method for more information on how to use this operation.
+// See ListHarvestJobs method for more information on how to use this operation.
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListOriginEndpoints operation.
-//    pageNum := 0
-//    err := client.ListOriginEndpointsPages(params,
-//        func(page *mediapackage.ListOriginEndpointsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
-func (c *MediaPackage) ListOriginEndpointsPages(input *ListOriginEndpointsInput, fn func(*ListOriginEndpointsOutput, bool) bool) error {
-	return c.ListOriginEndpointsPagesWithContext(aws.BackgroundContext(), input, fn)
+//	// Example iterating over at most 3 pages of a ListHarvestJobs operation.
+//	pageNum := 0
+//	err := client.ListHarvestJobsPages(params,
+//	    func(page *mediapackage.ListHarvestJobsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *MediaPackage) ListHarvestJobsPages(input *ListHarvestJobsInput, fn func(*ListHarvestJobsOutput, bool) bool) error {
+	return c.ListHarvestJobsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
 
-// ListOriginEndpointsPagesWithContext same as ListOriginEndpointsPages except
+// ListHarvestJobsPagesWithContext same as ListHarvestJobsPages except
 // it takes a Context and allows setting request options on the pages.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *MediaPackage) ListOriginEndpointsPagesWithContext(ctx aws.Context, input *ListOriginEndpointsInput, fn func(*ListOriginEndpointsOutput, bool) bool, opts ...request.Option) error {
+func (c *MediaPackage) ListHarvestJobsPagesWithContext(ctx aws.Context, input *ListHarvestJobsInput, fn func(*ListHarvestJobsOutput, bool) bool, opts ...request.Option) error {
 	p := request.Pagination{
 		NewRequest: func() (*request.Request, error) {
-			var inCpy *ListOriginEndpointsInput
+			var inCpy *ListHarvestJobsInput
 			if input != nil {
 				tmp := *input
 				inCpy = &tmp
 			}
-			req, _ := c.ListOriginEndpointsRequest(inCpy)
+			req, _ := c.ListHarvestJobsRequest(inCpy)
 			req.SetContext(ctx)
 			req.ApplyOptions(opts...)
 			return req, nil
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListOriginEndpointsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListHarvestJobsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
-const opListTagsForResource = "ListTagsForResource"
+const opListOriginEndpoints = "ListOriginEndpoints"
 
-// ListTagsForResourceRequest generates a "aws/request.Request" representing the
-// client's request for the ListTagsForResource operation. The "output" return
+// ListOriginEndpointsRequest generates a "aws/request.Request" representing the
+// client's request for the ListOriginEndpoints operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListTagsForResource for more information on using the ListTagsForResource
+// See ListOriginEndpoints for more information on using the ListOriginEndpoints
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListOriginEndpointsRequest method.
+//	req, resp := client.ListOriginEndpointsRequest(params)
 //
-//    // Example sending a request using the ListTagsForResourceRequest method.
-//    req, resp := client.ListTagsForResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/ListTagsForResource
-func (c *MediaPackage) ListTagsForResourceRequest(input *ListTagsForResourceInput) (req *request.Request, output *ListTagsForResourceOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/ListOriginEndpoints
+func (c *MediaPackage) ListOriginEndpointsRequest(input *ListOriginEndpointsInput) (req *request.Request, output *ListOriginEndpointsOutput) {
 	op := &request.Operation{
-		Name:       opListTagsForResource,
+		Name:       opListOriginEndpoints,
 		HTTPMethod: "GET",
-		HTTPPath:   "/tags/{resource-arn}",
+		HTTPPath:   "/origin_endpoints",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "MaxResults",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &ListTagsForResourceInput{}
+		input = &ListOriginEndpointsInput{}
 	}
 
-	output = &ListTagsForResourceOutput{}
+	output = &ListOriginEndpointsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListTagsForResource API operation for AWS Elemental MediaPackage.
+// ListOriginEndpoints API operation for AWS Elemental MediaPackage.
+//
+// Returns a collection of OriginEndpoint records.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Elemental MediaPackage's
-// API operation ListTagsForResource for usage and error information.
-// See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/ListTagsForResource
-func (c *MediaPackage) ListTagsForResource(input *ListTagsForResourceInput) (*ListTagsForResourceOutput, error) {
-	req, out := c.ListTagsForResourceRequest(input)
-	return out, req.Send()
-}
-
-// ListTagsForResourceWithContext is the same as ListTagsForResource with the addition of
-// the ability to pass a context and additional request options.
+// API operation ListOriginEndpoints for usage and error information.
+//
+// Returned Error Types:
+//
+//   - UnprocessableEntityException
+//
+//   - InternalServerErrorException
+//
+//   - ForbiddenException
+//
+//   - NotFoundException
+//
+//   - ServiceUnavailableException
+//
+//   - TooManyRequestsException
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/ListOriginEndpoints
+func (c *MediaPackage) ListOriginEndpoints(input *ListOriginEndpointsInput) (*ListOriginEndpointsOutput, error) {
+	req, out := c.ListOriginEndpointsRequest(input)
+	return out, req.Send()
+}
+
+// ListOriginEndpointsWithContext is the same as ListOriginEndpoints with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListOriginEndpoints for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *MediaPackage) ListOriginEndpointsWithContext(ctx aws.Context, input *ListOriginEndpointsInput, opts ...request.Option) (*ListOriginEndpointsOutput, error) {
+	req, out := c.ListOriginEndpointsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+// ListOriginEndpointsPages iterates over the pages of a ListOriginEndpoints operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListOriginEndpoints method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListOriginEndpoints operation.
+//	pageNum := 0
+//	err := client.ListOriginEndpointsPages(params,
+//	    func(page *mediapackage.ListOriginEndpointsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *MediaPackage) ListOriginEndpointsPages(input *ListOriginEndpointsInput, fn func(*ListOriginEndpointsOutput, bool) bool) error {
+	return c.ListOriginEndpointsPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListOriginEndpointsPagesWithContext same as ListOriginEndpointsPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *MediaPackage) ListOriginEndpointsPagesWithContext(ctx aws.Context, input *ListOriginEndpointsInput, fn func(*ListOriginEndpointsOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListOriginEndpointsInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListOriginEndpointsRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListOriginEndpointsOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListTagsForResource = "ListTagsForResource"
+
+// ListTagsForResourceRequest generates a "aws/request.Request" representing the
+// client's request for the ListTagsForResource operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListTagsForResource for more information on using the ListTagsForResource
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListTagsForResourceRequest method.
+//	req, resp := client.ListTagsForResourceRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/ListTagsForResource
+func (c *MediaPackage) ListTagsForResourceRequest(input *ListTagsForResourceInput) (req *request.Request, output *ListTagsForResourceOutput) {
+	op := &request.Operation{
+		Name:       opListTagsForResource,
+		HTTPMethod: "GET",
+		HTTPPath:   "/tags/{resource-arn}",
+	}
+
+	if input == nil {
+		input = &ListTagsForResourceInput{}
+	}
+
+	output = &ListTagsForResourceOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListTagsForResource API operation for AWS Elemental MediaPackage.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Elemental MediaPackage's
+// API operation ListTagsForResource for usage and error information.
+// See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/ListTagsForResource
+func (c *MediaPackage) ListTagsForResource(input *ListTagsForResourceInput) (*ListTagsForResourceOutput, error) {
+	req, out := c.ListTagsForResourceRequest(input)
+	return out, req.Send()
+}
+
+// ListTagsForResourceWithContext is the same as ListTagsForResource with the addition of
+// the ability to pass a context and additional request options.
 //
 // See ListTagsForResource for details on how to use this API operation.
 //
@@ -918,14 +1328,13 @@ const opRotateChannelCredentials = "RotateChannelCredentials"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the RotateChannelCredentialsRequest method.
+//	req, resp := client.RotateChannelCredentialsRequest(params)
 //
-//    // Example sending a request using the RotateChannelCredentialsRequest method.
-//    req, resp := client.RotateChannelCredentialsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/RotateChannelCredentials
 //
@@ -961,18 +1370,19 @@ func (c *MediaPackage) RotateChannelCredentialsRequest(input *RotateChannelCrede
 // See the AWS API reference guide for AWS Elemental MediaPackage's
 // API operation RotateChannelCredentials for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnprocessableEntityException "UnprocessableEntityException"
+// Returned Error Types:
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
+//   - UnprocessableEntityException
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
+//   - InternalServerErrorException
 //
-//   * ErrCodeNotFoundException "NotFoundException"
+//   - ForbiddenException
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
+//   - NotFoundException
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
+//   - ServiceUnavailableException
+//
+//   - TooManyRequestsException
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/RotateChannelCredentials
 //
@@ -1016,14 +1426,13 @@ const opRotateIngestEndpointCredentials = "RotateIngestEndpointCredentials"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the RotateIngestEndpointCredentialsRequest method.
+//	req, resp := client.RotateIngestEndpointCredentialsRequest(params)
 //
-//    // Example sending a request using the RotateIngestEndpointCredentialsRequest method.
-//    req, resp := client.RotateIngestEndpointCredentialsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/RotateIngestEndpointCredentials
 func (c *MediaPackage) RotateIngestEndpointCredentialsRequest(input *RotateIngestEndpointCredentialsInput) (req *request.Request, output *RotateIngestEndpointCredentialsOutput) {
@@ -1054,18 +1463,19 @@ func (c *MediaPackage) RotateIngestEndpointCredentialsRequest(input *RotateInges
 // See the AWS API reference guide for AWS Elemental MediaPackage's
 // API operation RotateIngestEndpointCredentials for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnprocessableEntityException "UnprocessableEntityException"
+// Returned Error Types:
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
+//   - UnprocessableEntityException
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
+//   - InternalServerErrorException
 //
-//   * ErrCodeNotFoundException "NotFoundException"
+//   - ForbiddenException
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
+//   - NotFoundException
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
+//   - ServiceUnavailableException
+//
+//   - TooManyRequestsException
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/RotateIngestEndpointCredentials
 func (c *MediaPackage) RotateIngestEndpointCredentials(input *RotateIngestEndpointCredentialsInput) (*RotateIngestEndpointCredentialsOutput, error) {
@@ -1105,14 +1515,13 @@ const opTagResource = "TagResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the TagResourceRequest method.
+//	req, resp := client.TagResourceRequest(params)
 //
-//    // Example sending a request using the TagResourceRequest method.
-//    req, resp := client.TagResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/TagResource
 func (c *MediaPackage) TagResourceRequest(input *TagResourceInput) (req *request.Request, output *TagResourceOutput) {
@@ -1178,14 +1587,13 @@ const opUntagResource = "UntagResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UntagResourceRequest method.
+//	req, resp := client.UntagResourceRequest(params)
 //
-//    // Example sending a request using the UntagResourceRequest method.
-//    req, resp := client.UntagResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/UntagResource
 func (c *MediaPackage) UntagResourceRequest(input *UntagResourceInput) (req *request.Request, output *UntagResourceOutput) {
@@ -1251,14 +1659,13 @@ const opUpdateChannel = "UpdateChannel"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateChannelRequest method.
+//	req, resp := client.UpdateChannelRequest(params)
 //
-//    // Example sending a request using the UpdateChannelRequest method.
-//    req, resp := client.UpdateChannelRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/UpdateChannel
 func (c *MediaPackage) UpdateChannelRequest(input *UpdateChannelInput) (req *request.Request, output *UpdateChannelOutput) {
@@ -1288,18 +1695,19 @@ func (c *MediaPackage) UpdateChannelRequest(input *UpdateChannelInput) (req *req
 // See the AWS API reference guide for AWS Elemental MediaPackage's
 // API operation UpdateChannel for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnprocessableEntityException "UnprocessableEntityException"
+// Returned Error Types:
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
+//   - UnprocessableEntityException
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
+//   - InternalServerErrorException
 //
-//   * ErrCodeNotFoundException "NotFoundException"
+//   - ForbiddenException
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
+//   - NotFoundException
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
+//   - ServiceUnavailableException
+//
+//   - TooManyRequestsException
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/UpdateChannel
 func (c *MediaPackage) UpdateChannel(input *UpdateChannelInput) (*UpdateChannelOutput, error) {
@@ -1339,14 +1747,13 @@ const opUpdateOriginEndpoint = "UpdateOriginEndpoint"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateOriginEndpointRequest method.
+//	req, resp := client.UpdateOriginEndpointRequest(params)
 //
-//    // Example sending a request using the UpdateOriginEndpointRequest method.
-//    req, resp := client.UpdateOriginEndpointRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/UpdateOriginEndpoint
 func (c *MediaPackage) UpdateOriginEndpointRequest(input *UpdateOriginEndpointInput) (req *request.Request, output *UpdateOriginEndpointOutput) {
@@ -1376,18 +1783,19 @@ func (c *MediaPackage) UpdateOriginEndpointRequest(input *UpdateOriginEndpointIn
 // See the AWS API reference guide for AWS Elemental MediaPackage's
 // API operation UpdateOriginEndpoint for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnprocessableEntityException "UnprocessableEntityException"
+// Returned Error Types:
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
+//   - UnprocessableEntityException
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
+//   - InternalServerErrorException
 //
-//   * ErrCodeNotFoundException "NotFoundException"
+//   - ForbiddenException
 //
-//   * ErrCodeServiceUnavailableException "ServiceUnavailableException"
+//   - NotFoundException
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
+//   - ServiceUnavailableException
+//
+//   - TooManyRequestsException
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12/UpdateOriginEndpoint
 func (c *MediaPackage) UpdateOriginEndpoint(input *UpdateOriginEndpointInput) (*UpdateOriginEndpointOutput, error) {
@@ -1411,6 +1819,70 @@ func (c *MediaPackage) UpdateOriginEndpointWithContext(ctx aws.Context, input *U
 	return out, req.Send()
 }
 
+// CDN Authorization credentials
+type Authorization struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) for the secret in Secrets Manager that your
+	// Content Distribution Network (CDN) uses for authorization to access your
+	// endpoint.
+	//
+	// CdnIdentifierSecret is a required field
+	CdnIdentifierSecret *string `locationName:"cdnIdentifierSecret" type:"string" required:"true"`
+
+	// The Amazon Resource Name (ARN) for the IAM role that allows MediaPackage
+	// to communicate with AWS Secrets Manager.
+	//
+	// SecretsRoleArn is a required field
+	SecretsRoleArn *string `locationName:"secretsRoleArn" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Authorization) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Authorization) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *Authorization) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "Authorization"}
+	if s.CdnIdentifierSecret == nil {
+		invalidParams.Add(request.NewErrParamRequired("CdnIdentifierSecret"))
+	}
+	if s.SecretsRoleArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("SecretsRoleArn"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCdnIdentifierSecret sets the CdnIdentifierSecret field's value.
+func (s *Authorization) SetCdnIdentifierSecret(v string) *Authorization {
+	s.CdnIdentifierSecret = &v
+	return s
+}
+
+// SetSecretsRoleArn sets the SecretsRoleArn field's value.
+func (s *Authorization) SetSecretsRoleArn(v string) *Authorization {
+	s.SecretsRoleArn = &v
+	return s
+}
+
 // A Channel resource configuration.
 type Channel struct {
 	_ struct{} `type:"structure"`
@@ -1418,25 +1890,42 @@ type Channel struct {
 	// The Amazon Resource Name (ARN) assigned to the Channel.
 	Arn *string `locationName:"arn" type:"string"`
 
+	// The date and time the Channel was created.
+	CreatedAt *string `locationName:"createdAt" type:"string"`
+
 	// A short text description of the Channel.
 	Description *string `locationName:"description" type:"string"`
 
+	// Configure egress access logging.
+	EgressAccessLogs *EgressAccessLogs `locationName:"egressAccessLogs" type:"structure"`
+
 	// An HTTP Live Streaming (HLS) ingest resource configuration.
 	HlsIngest *HlsIngest `locationName:"hlsIngest" type:"structure"`
 
 	// The ID of the Channel.
 	Id *string `locationName:"id" type:"string"`
 
+	// Configure ingress access logging.
+	IngressAccessLogs *IngressAccessLogs `locationName:"ingressAccessLogs" type:"structure"`
+
 	// A collection of tags associated with a resource
 	Tags map[string]*string `locationName:"tags" type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Channel) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Channel) GoString() string {
 	return s.String()
 }
@@ -1447,12 +1936,24 @@ func (s *Channel) SetArn(v string) *Channel {
 	return s
 }
 
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *Channel) SetCreatedAt(v string) *Channel {
+	s.CreatedAt = &v
+	return s
+}
+
 // SetDescription sets the Description field's value.
 func (s *Channel) SetDescription(v string) *Channel {
 	s.Description = &v
 	return s
 }
 
+// SetEgressAccessLogs sets the EgressAccessLogs field's value.
+func (s *Channel) SetEgressAccessLogs(v *EgressAccessLogs) *Channel {
+	s.EgressAccessLogs = v
+	return s
+}
+
 // SetHlsIngest sets the HlsIngest field's value.
 func (s *Channel) SetHlsIngest(v *HlsIngest) *Channel {
 	s.HlsIngest = v
@@ -1465,6 +1966,12 @@ func (s *Channel) SetId(v string) *Channel {
 	return s
 }
 
+// SetIngressAccessLogs sets the IngressAccessLogs field's value.
+func (s *Channel) SetIngressAccessLogs(v *IngressAccessLogs) *Channel {
+	s.IngressAccessLogs = v
+	return s
+}
+
 // SetTags sets the Tags field's value.
 func (s *Channel) SetTags(v map[string]*string) *Channel {
 	s.Tags = v
@@ -1475,6 +1982,14 @@ func (s *Channel) SetTags(v map[string]*string) *Channel {
 type CmafEncryption struct {
 	_ struct{} `type:"structure"`
 
+	// An optional 128-bit, 16-byte hex value represented by a 32-character string,
+	// used in conjunction with the key for encrypting blocks. If you don't specify
+	// a value, then MediaPackage creates the constant initialization vector (IV).
+	ConstantInitializationVector *string `locationName:"constantInitializationVector" type:"string"`
+
+	// The encryption method to use.
+	EncryptionMethod *string `locationName:"encryptionMethod" type:"string" enum:"CmafEncryptionMethod"`
+
 	// Time (in seconds) between each encryption key rotation.
 	KeyRotationIntervalSeconds *int64 `locationName:"keyRotationIntervalSeconds" type:"integer"`
 
@@ -1485,12 +2000,20 @@ type CmafEncryption struct {
 	SpekeKeyProvider *SpekeKeyProvider `locationName:"spekeKeyProvider" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CmafEncryption) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CmafEncryption) GoString() string {
 	return s.String()
 }
@@ -1513,6 +2036,18 @@ func (s *CmafEncryption) Validate() error {
 	return nil
 }
 
+// SetConstantInitializationVector sets the ConstantInitializationVector field's value.
+func (s *CmafEncryption) SetConstantInitializationVector(v string) *CmafEncryption {
+	s.ConstantInitializationVector = &v
+	return s
+}
+
+// SetEncryptionMethod sets the EncryptionMethod field's value.
+func (s *CmafEncryption) SetEncryptionMethod(v string) *CmafEncryption {
+	s.EncryptionMethod = &v
+	return s
+}
+
 // SetKeyRotationIntervalSeconds sets the KeyRotationIntervalSeconds field's value.
 func (s *CmafEncryption) SetKeyRotationIntervalSeconds(v int64) *CmafEncryption {
 	s.KeyRotationIntervalSeconds = &v
@@ -1547,12 +2082,20 @@ type CmafPackage struct {
 	StreamSelection *StreamSelection `locationName:"streamSelection" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CmafPackage) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CmafPackage) GoString() string {
 	return s.String()
 }
@@ -1609,12 +2152,20 @@ type CmafPackageCreateOrUpdateParameters struct {
 	StreamSelection *StreamSelection `locationName:"streamSelection" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CmafPackageCreateOrUpdateParameters) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CmafPackageCreateOrUpdateParameters) GoString() string {
 	return s.String()
 }
@@ -1674,34 +2225,46 @@ func (s *CmafPackageCreateOrUpdateParameters) SetStreamSelection(v *StreamSelect
 	return s
 }
 
-type CreateChannelInput struct {
+type ConfigureLogsInput struct {
 	_ struct{} `type:"structure"`
 
-	Description *string `locationName:"description" type:"string"`
+	// Configure egress access logging.
+	EgressAccessLogs *EgressAccessLogs `locationName:"egressAccessLogs" type:"structure"`
 
 	// Id is a required field
-	Id *string `locationName:"id" type:"string" required:"true"`
+	Id *string `location:"uri" locationName:"id" type:"string" required:"true"`
 
-	// A collection of tags associated with a resource
-	Tags map[string]*string `locationName:"tags" type:"map"`
+	// Configure ingress access logging.
+	IngressAccessLogs *IngressAccessLogs `locationName:"ingressAccessLogs" type:"structure"`
 }
 
-// String returns the string representation
-func (s CreateChannelInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfigureLogsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateChannelInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfigureLogsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateChannelInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateChannelInput"}
+func (s *ConfigureLogsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ConfigureLogsInput"}
 	if s.Id == nil {
 		invalidParams.Add(request.NewErrParamRequired("Id"))
 	}
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -1709,105 +2272,491 @@ func (s *CreateChannelInput) Validate() error {
 	return nil
 }
 
-// SetDescription sets the Description field's value.
-func (s *CreateChannelInput) SetDescription(v string) *CreateChannelInput {
-	s.Description = &v
+// SetEgressAccessLogs sets the EgressAccessLogs field's value.
+func (s *ConfigureLogsInput) SetEgressAccessLogs(v *EgressAccessLogs) *ConfigureLogsInput {
+	s.EgressAccessLogs = v
 	return s
 }
 
 // SetId sets the Id field's value.
-func (s *CreateChannelInput) SetId(v string) *CreateChannelInput {
+func (s *ConfigureLogsInput) SetId(v string) *ConfigureLogsInput {
 	s.Id = &v
 	return s
 }
 
-// SetTags sets the Tags field's value.
-func (s *CreateChannelInput) SetTags(v map[string]*string) *CreateChannelInput {
-	s.Tags = v
+// SetIngressAccessLogs sets the IngressAccessLogs field's value.
+func (s *ConfigureLogsInput) SetIngressAccessLogs(v *IngressAccessLogs) *ConfigureLogsInput {
+	s.IngressAccessLogs = v
 	return s
 }
 
-type CreateChannelOutput struct {
+type ConfigureLogsOutput struct {
 	_ struct{} `type:"structure"`
 
 	Arn *string `locationName:"arn" type:"string"`
 
+	CreatedAt *string `locationName:"createdAt" type:"string"`
+
 	Description *string `locationName:"description" type:"string"`
 
+	// Configure egress access logging.
+	EgressAccessLogs *EgressAccessLogs `locationName:"egressAccessLogs" type:"structure"`
+
 	// An HTTP Live Streaming (HLS) ingest resource configuration.
 	HlsIngest *HlsIngest `locationName:"hlsIngest" type:"structure"`
 
 	Id *string `locationName:"id" type:"string"`
 
+	// Configure ingress access logging.
+	IngressAccessLogs *IngressAccessLogs `locationName:"ingressAccessLogs" type:"structure"`
+
 	// A collection of tags associated with a resource
 	Tags map[string]*string `locationName:"tags" type:"map"`
 }
 
-// String returns the string representation
-func (s CreateChannelOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfigureLogsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateChannelOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConfigureLogsOutput) GoString() string {
 	return s.String()
 }
 
 // SetArn sets the Arn field's value.
-func (s *CreateChannelOutput) SetArn(v string) *CreateChannelOutput {
+func (s *ConfigureLogsOutput) SetArn(v string) *ConfigureLogsOutput {
 	s.Arn = &v
 	return s
 }
 
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *ConfigureLogsOutput) SetCreatedAt(v string) *ConfigureLogsOutput {
+	s.CreatedAt = &v
+	return s
+}
+
 // SetDescription sets the Description field's value.
-func (s *CreateChannelOutput) SetDescription(v string) *CreateChannelOutput {
+func (s *ConfigureLogsOutput) SetDescription(v string) *ConfigureLogsOutput {
 	s.Description = &v
 	return s
 }
 
+// SetEgressAccessLogs sets the EgressAccessLogs field's value.
+func (s *ConfigureLogsOutput) SetEgressAccessLogs(v *EgressAccessLogs) *ConfigureLogsOutput {
+	s.EgressAccessLogs = v
+	return s
+}
+
 // SetHlsIngest sets the HlsIngest field's value.
-func (s *CreateChannelOutput) SetHlsIngest(v *HlsIngest) *CreateChannelOutput {
+func (s *ConfigureLogsOutput) SetHlsIngest(v *HlsIngest) *ConfigureLogsOutput {
 	s.HlsIngest = v
 	return s
 }
 
 // SetId sets the Id field's value.
-func (s *CreateChannelOutput) SetId(v string) *CreateChannelOutput {
+func (s *ConfigureLogsOutput) SetId(v string) *ConfigureLogsOutput {
 	s.Id = &v
 	return s
 }
 
+// SetIngressAccessLogs sets the IngressAccessLogs field's value.
+func (s *ConfigureLogsOutput) SetIngressAccessLogs(v *IngressAccessLogs) *ConfigureLogsOutput {
+	s.IngressAccessLogs = v
+	return s
+}
+
 // SetTags sets the Tags field's value.
-func (s *CreateChannelOutput) SetTags(v map[string]*string) *CreateChannelOutput {
+func (s *ConfigureLogsOutput) SetTags(v map[string]*string) *ConfigureLogsOutput {
 	s.Tags = v
 	return s
 }
 
-type CreateOriginEndpointInput struct {
+type CreateChannelInput struct {
 	_ struct{} `type:"structure"`
 
-	// ChannelId is a required field
-	ChannelId *string `locationName:"channelId" type:"string" required:"true"`
-
-	// A Common Media Application Format (CMAF) packaging configuration.
-	CmafPackage *CmafPackageCreateOrUpdateParameters `locationName:"cmafPackage" type:"structure"`
-
-	// A Dynamic Adaptive Streaming over HTTP (DASH) packaging configuration.
-	DashPackage *DashPackage `locationName:"dashPackage" type:"structure"`
-
 	Description *string `locationName:"description" type:"string"`
 
-	// An HTTP Live Streaming (HLS) packaging configuration.
-	HlsPackage *HlsPackage `locationName:"hlsPackage" type:"structure"`
-
 	// Id is a required field
 	Id *string `locationName:"id" type:"string" required:"true"`
 
-	ManifestName *string `locationName:"manifestName" type:"string"`
-
+	// A collection of tags associated with a resource
+	Tags map[string]*string `locationName:"tags" type:"map"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateChannelInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateChannelInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateChannelInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateChannelInput"}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDescription sets the Description field's value.
+func (s *CreateChannelInput) SetDescription(v string) *CreateChannelInput {
+	s.Description = &v
+	return s
+}
+
+// SetId sets the Id field's value.
+func (s *CreateChannelInput) SetId(v string) *CreateChannelInput {
+	s.Id = &v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *CreateChannelInput) SetTags(v map[string]*string) *CreateChannelInput {
+	s.Tags = v
+	return s
+}
+
+type CreateChannelOutput struct {
+	_ struct{} `type:"structure"`
+
+	Arn *string `locationName:"arn" type:"string"`
+
+	CreatedAt *string `locationName:"createdAt" type:"string"`
+
+	Description *string `locationName:"description" type:"string"`
+
+	// Configure egress access logging.
+	EgressAccessLogs *EgressAccessLogs `locationName:"egressAccessLogs" type:"structure"`
+
+	// An HTTP Live Streaming (HLS) ingest resource configuration.
+	HlsIngest *HlsIngest `locationName:"hlsIngest" type:"structure"`
+
+	Id *string `locationName:"id" type:"string"`
+
+	// Configure ingress access logging.
+	IngressAccessLogs *IngressAccessLogs `locationName:"ingressAccessLogs" type:"structure"`
+
+	// A collection of tags associated with a resource
+	Tags map[string]*string `locationName:"tags" type:"map"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateChannelOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateChannelOutput) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *CreateChannelOutput) SetArn(v string) *CreateChannelOutput {
+	s.Arn = &v
+	return s
+}
+
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *CreateChannelOutput) SetCreatedAt(v string) *CreateChannelOutput {
+	s.CreatedAt = &v
+	return s
+}
+
+// SetDescription sets the Description field's value.
+func (s *CreateChannelOutput) SetDescription(v string) *CreateChannelOutput {
+	s.Description = &v
+	return s
+}
+
+// SetEgressAccessLogs sets the EgressAccessLogs field's value.
+func (s *CreateChannelOutput) SetEgressAccessLogs(v *EgressAccessLogs) *CreateChannelOutput {
+	s.EgressAccessLogs = v
+	return s
+}
+
+// SetHlsIngest sets the HlsIngest field's value.
+func (s *CreateChannelOutput) SetHlsIngest(v *HlsIngest) *CreateChannelOutput {
+	s.HlsIngest = v
+	return s
+}
+
+// SetId sets the Id field's value.
+func (s *CreateChannelOutput) SetId(v string) *CreateChannelOutput {
+	s.Id = &v
+	return s
+}
+
+// SetIngressAccessLogs sets the IngressAccessLogs field's value.
+func (s *CreateChannelOutput) SetIngressAccessLogs(v *IngressAccessLogs) *CreateChannelOutput {
+	s.IngressAccessLogs = v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *CreateChannelOutput) SetTags(v map[string]*string) *CreateChannelOutput {
+	s.Tags = v
+	return s
+}
+
+type CreateHarvestJobInput struct {
+	_ struct{} `type:"structure"`
+
+	// EndTime is a required field
+	EndTime *string `locationName:"endTime" type:"string" required:"true"`
+
+	// Id is a required field
+	Id *string `locationName:"id" type:"string" required:"true"`
+
+	// OriginEndpointId is a required field
+	OriginEndpointId *string `locationName:"originEndpointId" type:"string" required:"true"`
+
+	// Configuration parameters for where in an S3 bucket to place the harvested
+	// content
+	//
+	// S3Destination is a required field
+	S3Destination *S3Destination `locationName:"s3Destination" type:"structure" required:"true"`
+
+	// StartTime is a required field
+	StartTime *string `locationName:"startTime" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateHarvestJobInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateHarvestJobInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateHarvestJobInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateHarvestJobInput"}
+	if s.EndTime == nil {
+		invalidParams.Add(request.NewErrParamRequired("EndTime"))
+	}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
+	}
+	if s.OriginEndpointId == nil {
+		invalidParams.Add(request.NewErrParamRequired("OriginEndpointId"))
+	}
+	if s.S3Destination == nil {
+		invalidParams.Add(request.NewErrParamRequired("S3Destination"))
+	}
+	if s.StartTime == nil {
+		invalidParams.Add(request.NewErrParamRequired("StartTime"))
+	}
+	if s.S3Destination != nil {
+		if err := s.S3Destination.Validate(); err != nil {
+			invalidParams.AddNested("S3Destination", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetEndTime sets the EndTime field's value.
+func (s *CreateHarvestJobInput) SetEndTime(v string) *CreateHarvestJobInput {
+	s.EndTime = &v
+	return s
+}
+
+// SetId sets the Id field's value.
+func (s *CreateHarvestJobInput) SetId(v string) *CreateHarvestJobInput {
+	s.Id = &v
+	return s
+}
+
+// SetOriginEndpointId sets the OriginEndpointId field's value.
+func (s *CreateHarvestJobInput) SetOriginEndpointId(v string) *CreateHarvestJobInput {
+	s.OriginEndpointId = &v
+	return s
+}
+
+// SetS3Destination sets the S3Destination field's value.
+func (s *CreateHarvestJobInput) SetS3Destination(v *S3Destination) *CreateHarvestJobInput {
+	s.S3Destination = v
+	return s
+}
+
+// SetStartTime sets the StartTime field's value.
+func (s *CreateHarvestJobInput) SetStartTime(v string) *CreateHarvestJobInput {
+	s.StartTime = &v
+	return s
+}
+
+type CreateHarvestJobOutput struct {
+	_ struct{} `type:"structure"`
+
+	Arn *string `locationName:"arn" type:"string"`
+
+	ChannelId *string `locationName:"channelId" type:"string"`
+
+	CreatedAt *string `locationName:"createdAt" type:"string"`
+
+	EndTime *string `locationName:"endTime" type:"string"`
+
+	Id *string `locationName:"id" type:"string"`
+
+	OriginEndpointId *string `locationName:"originEndpointId" type:"string"`
+
+	// Configuration parameters for where in an S3 bucket to place the harvested
+	// content
+	S3Destination *S3Destination `locationName:"s3Destination" type:"structure"`
+
+	StartTime *string `locationName:"startTime" type:"string"`
+
+	Status *string `locationName:"status" type:"string" enum:"Status"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateHarvestJobOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateHarvestJobOutput) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *CreateHarvestJobOutput) SetArn(v string) *CreateHarvestJobOutput {
+	s.Arn = &v
+	return s
+}
+
+// SetChannelId sets the ChannelId field's value.
+func (s *CreateHarvestJobOutput) SetChannelId(v string) *CreateHarvestJobOutput {
+	s.ChannelId = &v
+	return s
+}
+
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *CreateHarvestJobOutput) SetCreatedAt(v string) *CreateHarvestJobOutput {
+	s.CreatedAt = &v
+	return s
+}
+
+// SetEndTime sets the EndTime field's value.
+func (s *CreateHarvestJobOutput) SetEndTime(v string) *CreateHarvestJobOutput {
+	s.EndTime = &v
+	return s
+}
+
+// SetId sets the Id field's value.
+func (s *CreateHarvestJobOutput) SetId(v string) *CreateHarvestJobOutput {
+	s.Id = &v
+	return s
+}
+
+// SetOriginEndpointId sets the OriginEndpointId field's value.
+func (s *CreateHarvestJobOutput) SetOriginEndpointId(v string) *CreateHarvestJobOutput {
+	s.OriginEndpointId = &v
+	return s
+}
+
+// SetS3Destination sets the S3Destination field's value.
+func (s *CreateHarvestJobOutput) SetS3Destination(v *S3Destination) *CreateHarvestJobOutput {
+	s.S3Destination = v
+	return s
+}
+
+// SetStartTime sets the StartTime field's value.
+func (s *CreateHarvestJobOutput) SetStartTime(v string) *CreateHarvestJobOutput {
+	s.StartTime = &v
+	return s
+}
+
+// SetStatus sets the Status field's value.
+func (s *CreateHarvestJobOutput) SetStatus(v string) *CreateHarvestJobOutput {
+	s.Status = &v
+	return s
+}
+
+type CreateOriginEndpointInput struct {
+	_ struct{} `type:"structure"`
+
+	// CDN Authorization credentials
+	Authorization *Authorization `locationName:"authorization" type:"structure"`
+
+	// ChannelId is a required field
+	ChannelId *string `locationName:"channelId" type:"string" required:"true"`
+
+	// A Common Media Application Format (CMAF) packaging configuration.
+	CmafPackage *CmafPackageCreateOrUpdateParameters `locationName:"cmafPackage" type:"structure"`
+
+	// A Dynamic Adaptive Streaming over HTTP (DASH) packaging configuration.
+	DashPackage *DashPackage `locationName:"dashPackage" type:"structure"`
+
+	Description *string `locationName:"description" type:"string"`
+
+	// An HTTP Live Streaming (HLS) packaging configuration.
+	HlsPackage *HlsPackage `locationName:"hlsPackage" type:"structure"`
+
+	// Id is a required field
+	Id *string `locationName:"id" type:"string" required:"true"`
+
+	ManifestName *string `locationName:"manifestName" type:"string"`
+
 	// A Microsoft Smooth Streaming (MSS) packaging configuration.
 	MssPackage *MssPackage `locationName:"mssPackage" type:"structure"`
 
+	Origination *string `locationName:"origination" type:"string" enum:"Origination"`
+
 	StartoverWindowSeconds *int64 `locationName:"startoverWindowSeconds" type:"integer"`
 
 	// A collection of tags associated with a resource
@@ -1818,12 +2767,20 @@ type CreateOriginEndpointInput struct {
 	Whitelist []*string `locationName:"whitelist" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateOriginEndpointInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateOriginEndpointInput) GoString() string {
 	return s.String()
 }
@@ -1837,6 +2794,11 @@ func (s *CreateOriginEndpointInput) Validate() error {
 	if s.Id == nil {
 		invalidParams.Add(request.NewErrParamRequired("Id"))
 	}
+	if s.Authorization != nil {
+		if err := s.Authorization.Validate(); err != nil {
+			invalidParams.AddNested("Authorization", err.(request.ErrInvalidParams))
+		}
+	}
 	if s.CmafPackage != nil {
 		if err := s.CmafPackage.Validate(); err != nil {
 			invalidParams.AddNested("CmafPackage", err.(request.ErrInvalidParams))
@@ -1864,6 +2826,12 @@ func (s *CreateOriginEndpointInput) Validate() error {
 	return nil
 }
 
+// SetAuthorization sets the Authorization field's value.
+func (s *CreateOriginEndpointInput) SetAuthorization(v *Authorization) *CreateOriginEndpointInput {
+	s.Authorization = v
+	return s
+}
+
 // SetChannelId sets the ChannelId field's value.
 func (s *CreateOriginEndpointInput) SetChannelId(v string) *CreateOriginEndpointInput {
 	s.ChannelId = &v
@@ -1912,6 +2880,12 @@ func (s *CreateOriginEndpointInput) SetMssPackage(v *MssPackage) *CreateOriginEn
 	return s
 }
 
+// SetOrigination sets the Origination field's value.
+func (s *CreateOriginEndpointInput) SetOrigination(v string) *CreateOriginEndpointInput {
+	s.Origination = &v
+	return s
+}
+
 // SetStartoverWindowSeconds sets the StartoverWindowSeconds field's value.
 func (s *CreateOriginEndpointInput) SetStartoverWindowSeconds(v int64) *CreateOriginEndpointInput {
 	s.StartoverWindowSeconds = &v
@@ -1941,11 +2915,16 @@ type CreateOriginEndpointOutput struct {
 
 	Arn *string `locationName:"arn" type:"string"`
 
+	// CDN Authorization credentials
+	Authorization *Authorization `locationName:"authorization" type:"structure"`
+
 	ChannelId *string `locationName:"channelId" type:"string"`
 
 	// A Common Media Application Format (CMAF) packaging configuration.
 	CmafPackage *CmafPackage `locationName:"cmafPackage" type:"structure"`
 
+	CreatedAt *string `locationName:"createdAt" type:"string"`
+
 	// A Dynamic Adaptive Streaming over HTTP (DASH) packaging configuration.
 	DashPackage *DashPackage `locationName:"dashPackage" type:"structure"`
 
@@ -1961,6 +2940,8 @@ type CreateOriginEndpointOutput struct {
 	// A Microsoft Smooth Streaming (MSS) packaging configuration.
 	MssPackage *MssPackage `locationName:"mssPackage" type:"structure"`
 
+	Origination *string `locationName:"origination" type:"string" enum:"Origination"`
+
 	StartoverWindowSeconds *int64 `locationName:"startoverWindowSeconds" type:"integer"`
 
 	// A collection of tags associated with a resource
@@ -1973,12 +2954,20 @@ type CreateOriginEndpointOutput struct {
 	Whitelist []*string `locationName:"whitelist" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateOriginEndpointOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateOriginEndpointOutput) GoString() string {
 	return s.String()
 }
@@ -1989,6 +2978,12 @@ func (s *CreateOriginEndpointOutput) SetArn(v string) *CreateOriginEndpointOutpu
 	return s
 }
 
+// SetAuthorization sets the Authorization field's value.
+func (s *CreateOriginEndpointOutput) SetAuthorization(v *Authorization) *CreateOriginEndpointOutput {
+	s.Authorization = v
+	return s
+}
+
 // SetChannelId sets the ChannelId field's value.
 func (s *CreateOriginEndpointOutput) SetChannelId(v string) *CreateOriginEndpointOutput {
 	s.ChannelId = &v
@@ -2001,6 +2996,12 @@ func (s *CreateOriginEndpointOutput) SetCmafPackage(v *CmafPackage) *CreateOrigi
 	return s
 }
 
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *CreateOriginEndpointOutput) SetCreatedAt(v string) *CreateOriginEndpointOutput {
+	s.CreatedAt = &v
+	return s
+}
+
 // SetDashPackage sets the DashPackage field's value.
 func (s *CreateOriginEndpointOutput) SetDashPackage(v *DashPackage) *CreateOriginEndpointOutput {
 	s.DashPackage = v
@@ -2037,6 +3038,12 @@ func (s *CreateOriginEndpointOutput) SetMssPackage(v *MssPackage) *CreateOriginE
 	return s
 }
 
+// SetOrigination sets the Origination field's value.
+func (s *CreateOriginEndpointOutput) SetOrigination(v string) *CreateOriginEndpointOutput {
+	s.Origination = &v
+	return s
+}
+
 // SetStartoverWindowSeconds sets the StartoverWindowSeconds field's value.
 func (s *CreateOriginEndpointOutput) SetStartoverWindowSeconds(v int64) *CreateOriginEndpointOutput {
 	s.StartoverWindowSeconds = &v
@@ -2081,12 +3088,20 @@ type DashEncryption struct {
 	SpekeKeyProvider *SpekeKeyProvider `locationName:"spekeKeyProvider" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DashEncryption) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DashEncryption) GoString() string {
 	return s.String()
 }
@@ -2128,7 +3143,7 @@ type DashPackage struct {
 	// A list of SCTE-35 message types that are treated as ad markers in the output.
 	// If empty, noad markers are output. Specify multiple items to create ad markers
 	// for all of the includedmessage types.
-	AdTriggers []*string `locationName:"adTriggers" type:"list"`
+	AdTriggers []*string `locationName:"adTriggers" type:"list" enum:"__AdTriggersElement"`
 
 	// This setting allows the delivery restriction flags on SCTE-35 segmentation
 	// descriptors todetermine whether a message signals an ad. Choosing "NONE"
@@ -2145,6 +3160,9 @@ type DashPackage struct {
 	// A Dynamic Adaptive Streaming over HTTP (DASH) encryption configuration.
 	Encryption *DashEncryption `locationName:"encryption" type:"structure"`
 
+	// When enabled, an I-Frame only stream will be included in the output.
+	IncludeIframeOnlyStream *bool `locationName:"includeIframeOnlyStream" type:"boolean"`
+
 	// Determines the position of some tags in the Media Presentation Description
 	// (MPD). When set to FULL, elements like SegmentTemplate and ContentProtection
 	// are included in each Representation. When set to COMPACT, duplicate elements
@@ -2167,10 +3185,11 @@ type DashPackage struct {
 	// into multiple periods. If empty, the content will notbe partitioned into
 	// more than one period. If the list contains "ADS", new periods will be created
 	// wherethe Channel source contains SCTE-35 ad markers.
-	PeriodTriggers []*string `locationName:"periodTriggers" type:"list"`
+	PeriodTriggers []*string `locationName:"periodTriggers" type:"list" enum:"__PeriodTriggersElement"`
 
 	// The Dynamic Adaptive Streaming over HTTP (DASH) profile type. When set to
-	// "HBBTV_1_5", HbbTV 1.5 compliant output is enabled.
+	// "HBBTV_1_5", HbbTV 1.5 compliant output is enabled. When set to "DVB-DASH_2014",
+	// DVB-DASH 2014 compliant output is enabled.
 	Profile *string `locationName:"profile" type:"string" enum:"Profile"`
 
 	// Duration (in seconds) of each segment. Actual segments will berounded to
@@ -2190,14 +3209,30 @@ type DashPackage struct {
 
 	// Duration (in seconds) to delay live content before presentation.
 	SuggestedPresentationDelaySeconds *int64 `locationName:"suggestedPresentationDelaySeconds" type:"integer"`
+
+	// Determines the type of UTCTiming included in the Media Presentation Description
+	// (MPD)
+	UtcTiming *string `locationName:"utcTiming" type:"string" enum:"UtcTiming"`
+
+	// Specifies the value attribute of the UTCTiming field when utcTiming is set
+	// to HTTP-ISO, HTTP-HEAD or HTTP-XSDATE
+	UtcTimingUri *string `locationName:"utcTimingUri" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DashPackage) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DashPackage) GoString() string {
 	return s.String()
 }
@@ -2235,6 +3270,12 @@ func (s *DashPackage) SetEncryption(v *DashEncryption) *DashPackage {
 	return s
 }
 
+// SetIncludeIframeOnlyStream sets the IncludeIframeOnlyStream field's value.
+func (s *DashPackage) SetIncludeIframeOnlyStream(v bool) *DashPackage {
+	s.IncludeIframeOnlyStream = &v
+	return s
+}
+
 // SetManifestLayout sets the ManifestLayout field's value.
 func (s *DashPackage) SetManifestLayout(v string) *DashPackage {
 	s.ManifestLayout = &v
@@ -2283,38 +3324,333 @@ func (s *DashPackage) SetSegmentTemplateFormat(v string) *DashPackage {
 	return s
 }
 
-// SetStreamSelection sets the StreamSelection field's value.
-func (s *DashPackage) SetStreamSelection(v *StreamSelection) *DashPackage {
-	s.StreamSelection = v
+// SetStreamSelection sets the StreamSelection field's value.
+func (s *DashPackage) SetStreamSelection(v *StreamSelection) *DashPackage {
+	s.StreamSelection = v
+	return s
+}
+
+// SetSuggestedPresentationDelaySeconds sets the SuggestedPresentationDelaySeconds field's value.
+func (s *DashPackage) SetSuggestedPresentationDelaySeconds(v int64) *DashPackage {
+	s.SuggestedPresentationDelaySeconds = &v
+	return s
+}
+
+// SetUtcTiming sets the UtcTiming field's value.
+func (s *DashPackage) SetUtcTiming(v string) *DashPackage {
+	s.UtcTiming = &v
+	return s
+}
+
+// SetUtcTimingUri sets the UtcTimingUri field's value.
+func (s *DashPackage) SetUtcTimingUri(v string) *DashPackage {
+	s.UtcTimingUri = &v
+	return s
+}
+
+type DeleteChannelInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
+	// Id is a required field
+	Id *string `location:"uri" locationName:"id" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteChannelInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteChannelInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteChannelInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteChannelInput"}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
+	}
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetId sets the Id field's value.
+func (s *DeleteChannelInput) SetId(v string) *DeleteChannelInput {
+	s.Id = &v
+	return s
+}
+
+type DeleteChannelOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteChannelOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteChannelOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteOriginEndpointInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
+	// Id is a required field
+	Id *string `location:"uri" locationName:"id" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteOriginEndpointInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteOriginEndpointInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteOriginEndpointInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteOriginEndpointInput"}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
+	}
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetId sets the Id field's value.
+func (s *DeleteOriginEndpointInput) SetId(v string) *DeleteOriginEndpointInput {
+	s.Id = &v
+	return s
+}
+
+type DeleteOriginEndpointOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteOriginEndpointOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteOriginEndpointOutput) GoString() string {
+	return s.String()
+}
+
+type DescribeChannelInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
+	// Id is a required field
+	Id *string `location:"uri" locationName:"id" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeChannelInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeChannelInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DescribeChannelInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeChannelInput"}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
+	}
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetId sets the Id field's value.
+func (s *DescribeChannelInput) SetId(v string) *DescribeChannelInput {
+	s.Id = &v
+	return s
+}
+
+type DescribeChannelOutput struct {
+	_ struct{} `type:"structure"`
+
+	Arn *string `locationName:"arn" type:"string"`
+
+	CreatedAt *string `locationName:"createdAt" type:"string"`
+
+	Description *string `locationName:"description" type:"string"`
+
+	// Configure egress access logging.
+	EgressAccessLogs *EgressAccessLogs `locationName:"egressAccessLogs" type:"structure"`
+
+	// An HTTP Live Streaming (HLS) ingest resource configuration.
+	HlsIngest *HlsIngest `locationName:"hlsIngest" type:"structure"`
+
+	Id *string `locationName:"id" type:"string"`
+
+	// Configure ingress access logging.
+	IngressAccessLogs *IngressAccessLogs `locationName:"ingressAccessLogs" type:"structure"`
+
+	// A collection of tags associated with a resource
+	Tags map[string]*string `locationName:"tags" type:"map"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeChannelOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeChannelOutput) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *DescribeChannelOutput) SetArn(v string) *DescribeChannelOutput {
+	s.Arn = &v
+	return s
+}
+
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *DescribeChannelOutput) SetCreatedAt(v string) *DescribeChannelOutput {
+	s.CreatedAt = &v
+	return s
+}
+
+// SetDescription sets the Description field's value.
+func (s *DescribeChannelOutput) SetDescription(v string) *DescribeChannelOutput {
+	s.Description = &v
+	return s
+}
+
+// SetEgressAccessLogs sets the EgressAccessLogs field's value.
+func (s *DescribeChannelOutput) SetEgressAccessLogs(v *EgressAccessLogs) *DescribeChannelOutput {
+	s.EgressAccessLogs = v
+	return s
+}
+
+// SetHlsIngest sets the HlsIngest field's value.
+func (s *DescribeChannelOutput) SetHlsIngest(v *HlsIngest) *DescribeChannelOutput {
+	s.HlsIngest = v
+	return s
+}
+
+// SetId sets the Id field's value.
+func (s *DescribeChannelOutput) SetId(v string) *DescribeChannelOutput {
+	s.Id = &v
+	return s
+}
+
+// SetIngressAccessLogs sets the IngressAccessLogs field's value.
+func (s *DescribeChannelOutput) SetIngressAccessLogs(v *IngressAccessLogs) *DescribeChannelOutput {
+	s.IngressAccessLogs = v
 	return s
 }
 
-// SetSuggestedPresentationDelaySeconds sets the SuggestedPresentationDelaySeconds field's value.
-func (s *DashPackage) SetSuggestedPresentationDelaySeconds(v int64) *DashPackage {
-	s.SuggestedPresentationDelaySeconds = &v
+// SetTags sets the Tags field's value.
+func (s *DescribeChannelOutput) SetTags(v map[string]*string) *DescribeChannelOutput {
+	s.Tags = v
 	return s
 }
 
-type DeleteChannelInput struct {
-	_ struct{} `type:"structure"`
+type DescribeHarvestJobInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// Id is a required field
 	Id *string `location:"uri" locationName:"id" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteChannelInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeHarvestJobInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteChannelInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeHarvestJobInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteChannelInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteChannelInput"}
+func (s *DescribeHarvestJobInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeHarvestJobInput"}
 	if s.Id == nil {
 		invalidParams.Add(request.NewErrParamRequired("Id"))
 	}
@@ -2329,98 +3665,135 @@ func (s *DeleteChannelInput) Validate() error {
 }
 
 // SetId sets the Id field's value.
-func (s *DeleteChannelInput) SetId(v string) *DeleteChannelInput {
+func (s *DescribeHarvestJobInput) SetId(v string) *DescribeHarvestJobInput {
 	s.Id = &v
 	return s
 }
 
-type DeleteChannelOutput struct {
+type DescribeHarvestJobOutput struct {
 	_ struct{} `type:"structure"`
+
+	Arn *string `locationName:"arn" type:"string"`
+
+	ChannelId *string `locationName:"channelId" type:"string"`
+
+	CreatedAt *string `locationName:"createdAt" type:"string"`
+
+	EndTime *string `locationName:"endTime" type:"string"`
+
+	Id *string `locationName:"id" type:"string"`
+
+	OriginEndpointId *string `locationName:"originEndpointId" type:"string"`
+
+	// Configuration parameters for where in an S3 bucket to place the harvested
+	// content
+	S3Destination *S3Destination `locationName:"s3Destination" type:"structure"`
+
+	StartTime *string `locationName:"startTime" type:"string"`
+
+	Status *string `locationName:"status" type:"string" enum:"Status"`
 }
 
-// String returns the string representation
-func (s DeleteChannelOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeHarvestJobOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteChannelOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeHarvestJobOutput) GoString() string {
 	return s.String()
 }
 
-type DeleteOriginEndpointInput struct {
-	_ struct{} `type:"structure"`
-
-	// Id is a required field
-	Id *string `location:"uri" locationName:"id" type:"string" required:"true"`
+// SetArn sets the Arn field's value.
+func (s *DescribeHarvestJobOutput) SetArn(v string) *DescribeHarvestJobOutput {
+	s.Arn = &v
+	return s
 }
 
-// String returns the string representation
-func (s DeleteOriginEndpointInput) String() string {
-	return awsutil.Prettify(s)
+// SetChannelId sets the ChannelId field's value.
+func (s *DescribeHarvestJobOutput) SetChannelId(v string) *DescribeHarvestJobOutput {
+	s.ChannelId = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s DeleteOriginEndpointInput) GoString() string {
-	return s.String()
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *DescribeHarvestJobOutput) SetCreatedAt(v string) *DescribeHarvestJobOutput {
+	s.CreatedAt = &v
+	return s
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteOriginEndpointInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteOriginEndpointInput"}
-	if s.Id == nil {
-		invalidParams.Add(request.NewErrParamRequired("Id"))
-	}
-	if s.Id != nil && len(*s.Id) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetEndTime sets the EndTime field's value.
+func (s *DescribeHarvestJobOutput) SetEndTime(v string) *DescribeHarvestJobOutput {
+	s.EndTime = &v
+	return s
 }
 
 // SetId sets the Id field's value.
-func (s *DeleteOriginEndpointInput) SetId(v string) *DeleteOriginEndpointInput {
+func (s *DescribeHarvestJobOutput) SetId(v string) *DescribeHarvestJobOutput {
 	s.Id = &v
 	return s
 }
 
-type DeleteOriginEndpointOutput struct {
-	_ struct{} `type:"structure"`
+// SetOriginEndpointId sets the OriginEndpointId field's value.
+func (s *DescribeHarvestJobOutput) SetOriginEndpointId(v string) *DescribeHarvestJobOutput {
+	s.OriginEndpointId = &v
+	return s
 }
 
-// String returns the string representation
-func (s DeleteOriginEndpointOutput) String() string {
-	return awsutil.Prettify(s)
+// SetS3Destination sets the S3Destination field's value.
+func (s *DescribeHarvestJobOutput) SetS3Destination(v *S3Destination) *DescribeHarvestJobOutput {
+	s.S3Destination = v
+	return s
 }
 
-// GoString returns the string representation
-func (s DeleteOriginEndpointOutput) GoString() string {
-	return s.String()
+// SetStartTime sets the StartTime field's value.
+func (s *DescribeHarvestJobOutput) SetStartTime(v string) *DescribeHarvestJobOutput {
+	s.StartTime = &v
+	return s
 }
 
-type DescribeChannelInput struct {
-	_ struct{} `type:"structure"`
+// SetStatus sets the Status field's value.
+func (s *DescribeHarvestJobOutput) SetStatus(v string) *DescribeHarvestJobOutput {
+	s.Status = &v
+	return s
+}
+
+type DescribeOriginEndpointInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// Id is a required field
 	Id *string `location:"uri" locationName:"id" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DescribeChannelInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeOriginEndpointInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeChannelInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeOriginEndpointInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DescribeChannelInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DescribeChannelInput"}
+func (s *DescribeOriginEndpointInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeOriginEndpointInput"}
 	if s.Id == nil {
 		invalidParams.Add(request.NewErrParamRequired("Id"))
 	}
@@ -2435,234 +3808,444 @@ func (s *DescribeChannelInput) Validate() error {
 }
 
 // SetId sets the Id field's value.
-func (s *DescribeChannelInput) SetId(v string) *DescribeChannelInput {
+func (s *DescribeOriginEndpointInput) SetId(v string) *DescribeOriginEndpointInput {
 	s.Id = &v
 	return s
 }
 
-type DescribeChannelOutput struct {
+type DescribeOriginEndpointOutput struct {
 	_ struct{} `type:"structure"`
 
 	Arn *string `locationName:"arn" type:"string"`
 
+	// CDN Authorization credentials
+	Authorization *Authorization `locationName:"authorization" type:"structure"`
+
+	ChannelId *string `locationName:"channelId" type:"string"`
+
+	// A Common Media Application Format (CMAF) packaging configuration.
+	CmafPackage *CmafPackage `locationName:"cmafPackage" type:"structure"`
+
+	CreatedAt *string `locationName:"createdAt" type:"string"`
+
+	// A Dynamic Adaptive Streaming over HTTP (DASH) packaging configuration.
+	DashPackage *DashPackage `locationName:"dashPackage" type:"structure"`
+
 	Description *string `locationName:"description" type:"string"`
 
-	// An HTTP Live Streaming (HLS) ingest resource configuration.
-	HlsIngest *HlsIngest `locationName:"hlsIngest" type:"structure"`
+	// An HTTP Live Streaming (HLS) packaging configuration.
+	HlsPackage *HlsPackage `locationName:"hlsPackage" type:"structure"`
 
 	Id *string `locationName:"id" type:"string"`
 
+	ManifestName *string `locationName:"manifestName" type:"string"`
+
+	// A Microsoft Smooth Streaming (MSS) packaging configuration.
+	MssPackage *MssPackage `locationName:"mssPackage" type:"structure"`
+
+	Origination *string `locationName:"origination" type:"string" enum:"Origination"`
+
+	StartoverWindowSeconds *int64 `locationName:"startoverWindowSeconds" type:"integer"`
+
 	// A collection of tags associated with a resource
 	Tags map[string]*string `locationName:"tags" type:"map"`
+
+	TimeDelaySeconds *int64 `locationName:"timeDelaySeconds" type:"integer"`
+
+	Url *string `locationName:"url" type:"string"`
+
+	Whitelist []*string `locationName:"whitelist" type:"list"`
 }
 
-// String returns the string representation
-func (s DescribeChannelOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeOriginEndpointOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeChannelOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribeOriginEndpointOutput) GoString() string {
 	return s.String()
 }
 
 // SetArn sets the Arn field's value.
-func (s *DescribeChannelOutput) SetArn(v string) *DescribeChannelOutput {
+func (s *DescribeOriginEndpointOutput) SetArn(v string) *DescribeOriginEndpointOutput {
 	s.Arn = &v
 	return s
 }
 
+// SetAuthorization sets the Authorization field's value.
+func (s *DescribeOriginEndpointOutput) SetAuthorization(v *Authorization) *DescribeOriginEndpointOutput {
+	s.Authorization = v
+	return s
+}
+
+// SetChannelId sets the ChannelId field's value.
+func (s *DescribeOriginEndpointOutput) SetChannelId(v string) *DescribeOriginEndpointOutput {
+	s.ChannelId = &v
+	return s
+}
+
+// SetCmafPackage sets the CmafPackage field's value.
+func (s *DescribeOriginEndpointOutput) SetCmafPackage(v *CmafPackage) *DescribeOriginEndpointOutput {
+	s.CmafPackage = v
+	return s
+}
+
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *DescribeOriginEndpointOutput) SetCreatedAt(v string) *DescribeOriginEndpointOutput {
+	s.CreatedAt = &v
+	return s
+}
+
+// SetDashPackage sets the DashPackage field's value.
+func (s *DescribeOriginEndpointOutput) SetDashPackage(v *DashPackage) *DescribeOriginEndpointOutput {
+	s.DashPackage = v
+	return s
+}
+
 // SetDescription sets the Description field's value.
-func (s *DescribeChannelOutput) SetDescription(v string) *DescribeChannelOutput {
+func (s *DescribeOriginEndpointOutput) SetDescription(v string) *DescribeOriginEndpointOutput {
 	s.Description = &v
 	return s
 }
 
-// SetHlsIngest sets the HlsIngest field's value.
-func (s *DescribeChannelOutput) SetHlsIngest(v *HlsIngest) *DescribeChannelOutput {
-	s.HlsIngest = v
+// SetHlsPackage sets the HlsPackage field's value.
+func (s *DescribeOriginEndpointOutput) SetHlsPackage(v *HlsPackage) *DescribeOriginEndpointOutput {
+	s.HlsPackage = v
 	return s
 }
 
 // SetId sets the Id field's value.
-func (s *DescribeChannelOutput) SetId(v string) *DescribeChannelOutput {
+func (s *DescribeOriginEndpointOutput) SetId(v string) *DescribeOriginEndpointOutput {
 	s.Id = &v
 	return s
 }
 
+// SetManifestName sets the ManifestName field's value.
+func (s *DescribeOriginEndpointOutput) SetManifestName(v string) *DescribeOriginEndpointOutput {
+	s.ManifestName = &v
+	return s
+}
+
+// SetMssPackage sets the MssPackage field's value.
+func (s *DescribeOriginEndpointOutput) SetMssPackage(v *MssPackage) *DescribeOriginEndpointOutput {
+	s.MssPackage = v
+	return s
+}
+
+// SetOrigination sets the Origination field's value.
+func (s *DescribeOriginEndpointOutput) SetOrigination(v string) *DescribeOriginEndpointOutput {
+	s.Origination = &v
+	return s
+}
+
+// SetStartoverWindowSeconds sets the StartoverWindowSeconds field's value.
+func (s *DescribeOriginEndpointOutput) SetStartoverWindowSeconds(v int64) *DescribeOriginEndpointOutput {
+	s.StartoverWindowSeconds = &v
+	return s
+}
+
 // SetTags sets the Tags field's value.
-func (s *DescribeChannelOutput) SetTags(v map[string]*string) *DescribeChannelOutput {
+func (s *DescribeOriginEndpointOutput) SetTags(v map[string]*string) *DescribeOriginEndpointOutput {
 	s.Tags = v
 	return s
 }
 
-type DescribeOriginEndpointInput struct {
-	_ struct{} `type:"structure"`
+// SetTimeDelaySeconds sets the TimeDelaySeconds field's value.
+func (s *DescribeOriginEndpointOutput) SetTimeDelaySeconds(v int64) *DescribeOriginEndpointOutput {
+	s.TimeDelaySeconds = &v
+	return s
+}
+
+// SetUrl sets the Url field's value.
+func (s *DescribeOriginEndpointOutput) SetUrl(v string) *DescribeOriginEndpointOutput {
+	s.Url = &v
+	return s
+}
+
+// SetWhitelist sets the Whitelist field's value.
+func (s *DescribeOriginEndpointOutput) SetWhitelist(v []*string) *DescribeOriginEndpointOutput {
+	s.Whitelist = v
+	return s
+}
+
+// Configure egress access logging.
+type EgressAccessLogs struct {
+	_ struct{} `type:"structure"`
+
+	// Customize the log group name.
+	LogGroupName *string `locationName:"logGroupName" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EgressAccessLogs) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EgressAccessLogs) GoString() string {
+	return s.String()
+}
+
+// SetLogGroupName sets the LogGroupName field's value.
+func (s *EgressAccessLogs) SetLogGroupName(v string) *EgressAccessLogs {
+	s.LogGroupName = &v
+	return s
+}
+
+// Use encryptionContractConfiguration to configure one or more content encryption
+// keys for your endpoints that use SPEKE 2.0. The encryption contract defines
+// which content keys are used to encrypt the audio and video tracks in your
+// stream. To configure the encryption contract, specify which audio and video
+// encryption presets to use.Note the following considerations when using encryptionContractConfiguration:encryptionContractConfiguration
+// can be used for DASH or CMAF endpoints that use SPEKE 2.0. SPEKE 2.0 relies
+// on the CPIX 2.3 specification.You must disable key rotation for this endpoint
+// by setting keyRotationIntervalSeconds to 0.
+type EncryptionContractConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	// A collection of audio encryption presets.
+	//
+	// PresetSpeke20Audio is a required field
+	PresetSpeke20Audio *string `locationName:"presetSpeke20Audio" type:"string" required:"true" enum:"PresetSpeke20Audio"`
+
+	// A collection of video encryption presets.
+	//
+	// PresetSpeke20Video is a required field
+	PresetSpeke20Video *string `locationName:"presetSpeke20Video" type:"string" required:"true" enum:"PresetSpeke20Video"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EncryptionContractConfiguration) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EncryptionContractConfiguration) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *EncryptionContractConfiguration) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "EncryptionContractConfiguration"}
+	if s.PresetSpeke20Audio == nil {
+		invalidParams.Add(request.NewErrParamRequired("PresetSpeke20Audio"))
+	}
+	if s.PresetSpeke20Video == nil {
+		invalidParams.Add(request.NewErrParamRequired("PresetSpeke20Video"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetPresetSpeke20Audio sets the PresetSpeke20Audio field's value.
+func (s *EncryptionContractConfiguration) SetPresetSpeke20Audio(v string) *EncryptionContractConfiguration {
+	s.PresetSpeke20Audio = &v
+	return s
+}
+
+// SetPresetSpeke20Video sets the PresetSpeke20Video field's value.
+func (s *EncryptionContractConfiguration) SetPresetSpeke20Video(v string) *EncryptionContractConfiguration {
+	s.PresetSpeke20Video = &v
+	return s
+}
+
+type ForbiddenException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// Id is a required field
-	Id *string `location:"uri" locationName:"id" type:"string" required:"true"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s DescribeOriginEndpointInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ForbiddenException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeOriginEndpointInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ForbiddenException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DescribeOriginEndpointInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DescribeOriginEndpointInput"}
-	if s.Id == nil {
-		invalidParams.Add(request.NewErrParamRequired("Id"))
-	}
-	if s.Id != nil && len(*s.Id) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
+func newErrorForbiddenException(v protocol.ResponseMetadata) error {
+	return &ForbiddenException{
+		RespMetadata: v,
 	}
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
+// Code returns the exception type name.
+func (s *ForbiddenException) Code() string {
+	return "ForbiddenException"
+}
+
+// Message returns the exception's message.
+func (s *ForbiddenException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
 	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ForbiddenException) OrigErr() error {
 	return nil
 }
 
-// SetId sets the Id field's value.
-func (s *DescribeOriginEndpointInput) SetId(v string) *DescribeOriginEndpointInput {
-	s.Id = &v
-	return s
+func (s *ForbiddenException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-type DescribeOriginEndpointOutput struct {
+// Status code returns the HTTP status code for the request's response error.
+func (s *ForbiddenException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ForbiddenException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// A HarvestJob resource configuration
+type HarvestJob struct {
 	_ struct{} `type:"structure"`
 
+	// The Amazon Resource Name (ARN) assigned to the HarvestJob.
 	Arn *string `locationName:"arn" type:"string"`
 
+	// The ID of the Channel that the HarvestJob will harvest from.
 	ChannelId *string `locationName:"channelId" type:"string"`
 
-	// A Common Media Application Format (CMAF) packaging configuration.
-	CmafPackage *CmafPackage `locationName:"cmafPackage" type:"structure"`
-
-	// A Dynamic Adaptive Streaming over HTTP (DASH) packaging configuration.
-	DashPackage *DashPackage `locationName:"dashPackage" type:"structure"`
-
-	Description *string `locationName:"description" type:"string"`
+	// The date and time the HarvestJob was submitted.
+	CreatedAt *string `locationName:"createdAt" type:"string"`
 
-	// An HTTP Live Streaming (HLS) packaging configuration.
-	HlsPackage *HlsPackage `locationName:"hlsPackage" type:"structure"`
+	// The end of the time-window which will be harvested.
+	EndTime *string `locationName:"endTime" type:"string"`
 
+	// The ID of the HarvestJob. The ID must be unique within the regionand it cannot
+	// be changed after the HarvestJob is submitted.
 	Id *string `locationName:"id" type:"string"`
 
-	ManifestName *string `locationName:"manifestName" type:"string"`
-
-	// A Microsoft Smooth Streaming (MSS) packaging configuration.
-	MssPackage *MssPackage `locationName:"mssPackage" type:"structure"`
-
-	StartoverWindowSeconds *int64 `locationName:"startoverWindowSeconds" type:"integer"`
-
-	// A collection of tags associated with a resource
-	Tags map[string]*string `locationName:"tags" type:"map"`
+	// The ID of the OriginEndpoint that the HarvestJob will harvest from.This cannot
+	// be changed after the HarvestJob is submitted.
+	OriginEndpointId *string `locationName:"originEndpointId" type:"string"`
 
-	TimeDelaySeconds *int64 `locationName:"timeDelaySeconds" type:"integer"`
+	// Configuration parameters for where in an S3 bucket to place the harvested
+	// content
+	S3Destination *S3Destination `locationName:"s3Destination" type:"structure"`
 
-	Url *string `locationName:"url" type:"string"`
+	// The start of the time-window which will be harvested.
+	StartTime *string `locationName:"startTime" type:"string"`
 
-	Whitelist []*string `locationName:"whitelist" type:"list"`
+	// The current status of the HarvestJob. Consider setting up a CloudWatch Event
+	// to listen forHarvestJobs as they succeed or fail. In the event of failure,
+	// the CloudWatch Event willinclude an explanation of why the HarvestJob failed.
+	Status *string `locationName:"status" type:"string" enum:"Status"`
 }
 
-// String returns the string representation
-func (s DescribeOriginEndpointOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HarvestJob) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DescribeOriginEndpointOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HarvestJob) GoString() string {
 	return s.String()
 }
 
 // SetArn sets the Arn field's value.
-func (s *DescribeOriginEndpointOutput) SetArn(v string) *DescribeOriginEndpointOutput {
+func (s *HarvestJob) SetArn(v string) *HarvestJob {
 	s.Arn = &v
 	return s
 }
 
 // SetChannelId sets the ChannelId field's value.
-func (s *DescribeOriginEndpointOutput) SetChannelId(v string) *DescribeOriginEndpointOutput {
+func (s *HarvestJob) SetChannelId(v string) *HarvestJob {
 	s.ChannelId = &v
 	return s
 }
 
-// SetCmafPackage sets the CmafPackage field's value.
-func (s *DescribeOriginEndpointOutput) SetCmafPackage(v *CmafPackage) *DescribeOriginEndpointOutput {
-	s.CmafPackage = v
-	return s
-}
-
-// SetDashPackage sets the DashPackage field's value.
-func (s *DescribeOriginEndpointOutput) SetDashPackage(v *DashPackage) *DescribeOriginEndpointOutput {
-	s.DashPackage = v
-	return s
-}
-
-// SetDescription sets the Description field's value.
-func (s *DescribeOriginEndpointOutput) SetDescription(v string) *DescribeOriginEndpointOutput {
-	s.Description = &v
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *HarvestJob) SetCreatedAt(v string) *HarvestJob {
+	s.CreatedAt = &v
 	return s
 }
 
-// SetHlsPackage sets the HlsPackage field's value.
-func (s *DescribeOriginEndpointOutput) SetHlsPackage(v *HlsPackage) *DescribeOriginEndpointOutput {
-	s.HlsPackage = v
+// SetEndTime sets the EndTime field's value.
+func (s *HarvestJob) SetEndTime(v string) *HarvestJob {
+	s.EndTime = &v
 	return s
 }
 
 // SetId sets the Id field's value.
-func (s *DescribeOriginEndpointOutput) SetId(v string) *DescribeOriginEndpointOutput {
+func (s *HarvestJob) SetId(v string) *HarvestJob {
 	s.Id = &v
 	return s
 }
 
-// SetManifestName sets the ManifestName field's value.
-func (s *DescribeOriginEndpointOutput) SetManifestName(v string) *DescribeOriginEndpointOutput {
-	s.ManifestName = &v
-	return s
-}
-
-// SetMssPackage sets the MssPackage field's value.
-func (s *DescribeOriginEndpointOutput) SetMssPackage(v *MssPackage) *DescribeOriginEndpointOutput {
-	s.MssPackage = v
-	return s
-}
-
-// SetStartoverWindowSeconds sets the StartoverWindowSeconds field's value.
-func (s *DescribeOriginEndpointOutput) SetStartoverWindowSeconds(v int64) *DescribeOriginEndpointOutput {
-	s.StartoverWindowSeconds = &v
-	return s
-}
-
-// SetTags sets the Tags field's value.
-func (s *DescribeOriginEndpointOutput) SetTags(v map[string]*string) *DescribeOriginEndpointOutput {
-	s.Tags = v
+// SetOriginEndpointId sets the OriginEndpointId field's value.
+func (s *HarvestJob) SetOriginEndpointId(v string) *HarvestJob {
+	s.OriginEndpointId = &v
 	return s
 }
 
-// SetTimeDelaySeconds sets the TimeDelaySeconds field's value.
-func (s *DescribeOriginEndpointOutput) SetTimeDelaySeconds(v int64) *DescribeOriginEndpointOutput {
-	s.TimeDelaySeconds = &v
+// SetS3Destination sets the S3Destination field's value.
+func (s *HarvestJob) SetS3Destination(v *S3Destination) *HarvestJob {
+	s.S3Destination = v
 	return s
 }
 
-// SetUrl sets the Url field's value.
-func (s *DescribeOriginEndpointOutput) SetUrl(v string) *DescribeOriginEndpointOutput {
-	s.Url = &v
+// SetStartTime sets the StartTime field's value.
+func (s *HarvestJob) SetStartTime(v string) *HarvestJob {
+	s.StartTime = &v
 	return s
 }
 
-// SetWhitelist sets the Whitelist field's value.
-func (s *DescribeOriginEndpointOutput) SetWhitelist(v []*string) *DescribeOriginEndpointOutput {
-	s.Whitelist = v
+// SetStatus sets the Status field's value.
+func (s *HarvestJob) SetStatus(v string) *HarvestJob {
+	s.Status = &v
 	return s
 }
 
@@ -2690,12 +4273,20 @@ type HlsEncryption struct {
 	SpekeKeyProvider *SpekeKeyProvider `locationName:"spekeKeyProvider" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s HlsEncryption) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s HlsEncryption) GoString() string {
 	return s.String()
 }
@@ -2756,12 +4347,20 @@ type HlsIngest struct {
 	IngestEndpoints []*IngestEndpoint `locationName:"ingestEndpoints" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s HlsIngest) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s HlsIngest) GoString() string {
 	return s.String()
 }
@@ -2780,7 +4379,10 @@ type HlsManifest struct {
 	// will omit all SCTE-35 ad markers from the output."PASSTHROUGH" causes the
 	// manifest to contain a copy of the SCTE-35 admarkers (comments) taken directly
 	// from the input HTTP Live Streaming (HLS) manifest."SCTE35_ENHANCED" generates
-	// ad markers and blackout tags based on SCTE-35messages in the input source.
+	// ad markers and blackout tags based on SCTE-35messages in the input source."DATERANGE"
+	// inserts EXT-X-DATERANGE tags to signal ad and program transition events in
+	// HLS and CMAF manifests. For this option, you must set a programDateTimeIntervalSeconds
+	// value that is greater than 0.
 	AdMarkers *string `locationName:"adMarkers" type:"string" enum:"AdMarkers"`
 
 	// The ID of the manifest. The ID must be unique within the OriginEndpoint and
@@ -2818,12 +4420,20 @@ type HlsManifest struct {
 	Url *string `locationName:"url" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s HlsManifest) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s HlsManifest) GoString() string {
 	return s.String()
 }
@@ -2884,13 +4494,16 @@ type HlsManifestCreateOrUpdateParameters struct {
 	// will omit all SCTE-35 ad markers from the output."PASSTHROUGH" causes the
 	// manifest to contain a copy of the SCTE-35 admarkers (comments) taken directly
 	// from the input HTTP Live Streaming (HLS) manifest."SCTE35_ENHANCED" generates
-	// ad markers and blackout tags based on SCTE-35messages in the input source.
+	// ad markers and blackout tags based on SCTE-35messages in the input source."DATERANGE"
+	// inserts EXT-X-DATERANGE tags to signal ad and program transition events in
+	// HLS and CMAF manifests. For this option, you must set a programDateTimeIntervalSeconds
+	// value that is greater than 0.
 	AdMarkers *string `locationName:"adMarkers" type:"string" enum:"AdMarkers"`
 
 	// A list of SCTE-35 message types that are treated as ad markers in the output.
 	// If empty, noad markers are output. Specify multiple items to create ad markers
 	// for all of the includedmessage types.
-	AdTriggers []*string `locationName:"adTriggers" type:"list"`
+	AdTriggers []*string `locationName:"adTriggers" type:"list" enum:"__AdTriggersElement"`
 
 	// This setting allows the delivery restriction flags on SCTE-35 segmentation
 	// descriptors todetermine whether a message signals an ad. Choosing "NONE"
@@ -2936,12 +4549,20 @@ type HlsManifestCreateOrUpdateParameters struct {
 	ProgramDateTimeIntervalSeconds *int64 `locationName:"programDateTimeIntervalSeconds" type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s HlsManifestCreateOrUpdateParameters) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s HlsManifestCreateOrUpdateParameters) GoString() string {
 	return s.String()
 }
@@ -3021,13 +4642,16 @@ type HlsPackage struct {
 	// will omit all SCTE-35 ad markers from the output."PASSTHROUGH" causes the
 	// manifest to contain a copy of the SCTE-35 admarkers (comments) taken directly
 	// from the input HTTP Live Streaming (HLS) manifest."SCTE35_ENHANCED" generates
-	// ad markers and blackout tags based on SCTE-35messages in the input source.
+	// ad markers and blackout tags based on SCTE-35messages in the input source."DATERANGE"
+	// inserts EXT-X-DATERANGE tags to signal ad and program transition events in
+	// HLS and CMAF manifests. For this option, you must set a programDateTimeIntervalSeconds
+	// value that is greater than 0.
 	AdMarkers *string `locationName:"adMarkers" type:"string" enum:"AdMarkers"`
 
 	// A list of SCTE-35 message types that are treated as ad markers in the output.
 	// If empty, noad markers are output. Specify multiple items to create ad markers
 	// for all of the includedmessage types.
-	AdTriggers []*string `locationName:"adTriggers" type:"list"`
+	AdTriggers []*string `locationName:"adTriggers" type:"list" enum:"__AdTriggersElement"`
 
 	// This setting allows the delivery restriction flags on SCTE-35 segmentation
 	// descriptors todetermine whether a message signals an ad. Choosing "NONE"
@@ -3044,6 +4668,10 @@ type HlsPackage struct {
 	// An HTTP Live Streaming (HLS) encryption configuration.
 	Encryption *HlsEncryption `locationName:"encryption" type:"structure"`
 
+	// When enabled, MediaPackage passes through digital video broadcasting (DVB)
+	// subtitles into the output.
+	IncludeDvbSubtitles *bool `locationName:"includeDvbSubtitles" type:"boolean"`
+
 	// When enabled, an I-Frame only stream will be included in the output.
 	IncludeIframeOnlyStream *bool `locationName:"includeIframeOnlyStream" type:"boolean"`
 
@@ -3076,12 +4704,20 @@ type HlsPackage struct {
 	UseAudioRenditionGroup *bool `locationName:"useAudioRenditionGroup" type:"boolean"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s HlsPackage) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s HlsPackage) GoString() string {
 	return s.String()
 }
@@ -3125,6 +4761,12 @@ func (s *HlsPackage) SetEncryption(v *HlsEncryption) *HlsPackage {
 	return s
 }
 
+// SetIncludeDvbSubtitles sets the IncludeDvbSubtitles field's value.
+func (s *HlsPackage) SetIncludeDvbSubtitles(v bool) *HlsPackage {
+	s.IncludeDvbSubtitles = &v
+	return s
+}
+
 // SetIncludeIframeOnlyStream sets the IncludeIframeOnlyStream field's value.
 func (s *HlsPackage) SetIncludeIframeOnlyStream(v bool) *HlsPackage {
 	s.IncludeIframeOnlyStream = &v
@@ -3161,84 +4803,292 @@ func (s *HlsPackage) SetStreamSelection(v *StreamSelection) *HlsPackage {
 	return s
 }
 
-// SetUseAudioRenditionGroup sets the UseAudioRenditionGroup field's value.
-func (s *HlsPackage) SetUseAudioRenditionGroup(v bool) *HlsPackage {
-	s.UseAudioRenditionGroup = &v
+// SetUseAudioRenditionGroup sets the UseAudioRenditionGroup field's value.
+func (s *HlsPackage) SetUseAudioRenditionGroup(v bool) *HlsPackage {
+	s.UseAudioRenditionGroup = &v
+	return s
+}
+
+// An endpoint for ingesting source content for a Channel.
+type IngestEndpoint struct {
+	_ struct{} `type:"structure"`
+
+	// The system generated unique identifier for the IngestEndpoint
+	Id *string `locationName:"id" type:"string"`
+
+	// Password is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by IngestEndpoint's
+	// String and GoString methods.
+	Password *string `locationName:"password" type:"string" sensitive:"true"`
+
+	// The ingest URL to which the source stream should be sent.
+	Url *string `locationName:"url" type:"string"`
+
+	// Username is a sensitive parameter and its value will be
+	// replaced with "sensitive" in string returned by IngestEndpoint's
+	// String and GoString methods.
+	Username *string `locationName:"username" type:"string" sensitive:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IngestEndpoint) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IngestEndpoint) GoString() string {
+	return s.String()
+}
+
+// SetId sets the Id field's value.
+func (s *IngestEndpoint) SetId(v string) *IngestEndpoint {
+	s.Id = &v
+	return s
+}
+
+// SetPassword sets the Password field's value.
+func (s *IngestEndpoint) SetPassword(v string) *IngestEndpoint {
+	s.Password = &v
+	return s
+}
+
+// SetUrl sets the Url field's value.
+func (s *IngestEndpoint) SetUrl(v string) *IngestEndpoint {
+	s.Url = &v
+	return s
+}
+
+// SetUsername sets the Username field's value.
+func (s *IngestEndpoint) SetUsername(v string) *IngestEndpoint {
+	s.Username = &v
+	return s
+}
+
+// Configure ingress access logging.
+type IngressAccessLogs struct {
+	_ struct{} `type:"structure"`
+
+	// Customize the log group name.
+	LogGroupName *string `locationName:"logGroupName" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IngressAccessLogs) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IngressAccessLogs) GoString() string {
+	return s.String()
+}
+
+// SetLogGroupName sets the LogGroupName field's value.
+func (s *IngressAccessLogs) SetLogGroupName(v string) *IngressAccessLogs {
+	s.LogGroupName = &v
+	return s
+}
+
+type InternalServerErrorException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InternalServerErrorException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InternalServerErrorException) GoString() string {
+	return s.String()
+}
+
+func newErrorInternalServerErrorException(v protocol.ResponseMetadata) error {
+	return &InternalServerErrorException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InternalServerErrorException) Code() string {
+	return "InternalServerErrorException"
+}
+
+// Message returns the exception's message.
+func (s *InternalServerErrorException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InternalServerErrorException) OrigErr() error {
+	return nil
+}
+
+func (s *InternalServerErrorException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InternalServerErrorException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InternalServerErrorException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type ListChannelsInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
+	MaxResults *int64 `location:"querystring" locationName:"maxResults" min:"1" type:"integer"`
+
+	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListChannelsInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListChannelsInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListChannelsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListChannelsInput"}
+	if s.MaxResults != nil && *s.MaxResults < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListChannelsInput) SetMaxResults(v int64) *ListChannelsInput {
+	s.MaxResults = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListChannelsInput) SetNextToken(v string) *ListChannelsInput {
+	s.NextToken = &v
 	return s
 }
 
-// An endpoint for ingesting source content for a Channel.
-type IngestEndpoint struct {
+type ListChannelsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The system generated unique identifier for the IngestEndpoint
-	Id *string `locationName:"id" type:"string"`
-
-	// The system generated password for ingest authentication.
-	Password *string `locationName:"password" type:"string"`
-
-	// The ingest URL to which the source stream should be sent.
-	Url *string `locationName:"url" type:"string"`
+	Channels []*Channel `locationName:"channels" type:"list"`
 
-	// The system generated username for ingest authentication.
-	Username *string `locationName:"username" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
-func (s IngestEndpoint) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListChannelsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s IngestEndpoint) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListChannelsOutput) GoString() string {
 	return s.String()
 }
 
-// SetId sets the Id field's value.
-func (s *IngestEndpoint) SetId(v string) *IngestEndpoint {
-	s.Id = &v
+// SetChannels sets the Channels field's value.
+func (s *ListChannelsOutput) SetChannels(v []*Channel) *ListChannelsOutput {
+	s.Channels = v
 	return s
 }
 
-// SetPassword sets the Password field's value.
-func (s *IngestEndpoint) SetPassword(v string) *IngestEndpoint {
-	s.Password = &v
+// SetNextToken sets the NextToken field's value.
+func (s *ListChannelsOutput) SetNextToken(v string) *ListChannelsOutput {
+	s.NextToken = &v
 	return s
 }
 
-// SetUrl sets the Url field's value.
-func (s *IngestEndpoint) SetUrl(v string) *IngestEndpoint {
-	s.Url = &v
-	return s
-}
+type ListHarvestJobsInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
 
-// SetUsername sets the Username field's value.
-func (s *IngestEndpoint) SetUsername(v string) *IngestEndpoint {
-	s.Username = &v
-	return s
-}
+	IncludeChannelId *string `location:"querystring" locationName:"includeChannelId" type:"string"`
 
-type ListChannelsInput struct {
-	_ struct{} `type:"structure"`
+	IncludeStatus *string `location:"querystring" locationName:"includeStatus" type:"string"`
 
 	MaxResults *int64 `location:"querystring" locationName:"maxResults" min:"1" type:"integer"`
 
 	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
-func (s ListChannelsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListHarvestJobsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListChannelsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListHarvestJobsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ListChannelsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListChannelsInput"}
+func (s *ListHarvestJobsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListHarvestJobsInput"}
 	if s.MaxResults != nil && *s.MaxResults < 1 {
 		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
 	}
@@ -3249,50 +5099,70 @@ func (s *ListChannelsInput) Validate() error {
 	return nil
 }
 
+// SetIncludeChannelId sets the IncludeChannelId field's value.
+func (s *ListHarvestJobsInput) SetIncludeChannelId(v string) *ListHarvestJobsInput {
+	s.IncludeChannelId = &v
+	return s
+}
+
+// SetIncludeStatus sets the IncludeStatus field's value.
+func (s *ListHarvestJobsInput) SetIncludeStatus(v string) *ListHarvestJobsInput {
+	s.IncludeStatus = &v
+	return s
+}
+
 // SetMaxResults sets the MaxResults field's value.
-func (s *ListChannelsInput) SetMaxResults(v int64) *ListChannelsInput {
+func (s *ListHarvestJobsInput) SetMaxResults(v int64) *ListHarvestJobsInput {
 	s.MaxResults = &v
 	return s
 }
 
 // SetNextToken sets the NextToken field's value.
-func (s *ListChannelsInput) SetNextToken(v string) *ListChannelsInput {
+func (s *ListHarvestJobsInput) SetNextToken(v string) *ListHarvestJobsInput {
 	s.NextToken = &v
 	return s
 }
 
-type ListChannelsOutput struct {
+type ListHarvestJobsOutput struct {
 	_ struct{} `type:"structure"`
 
-	Channels []*Channel `locationName:"channels" type:"list"`
+	HarvestJobs []*HarvestJob `locationName:"harvestJobs" type:"list"`
 
 	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
-func (s ListChannelsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListHarvestJobsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListChannelsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListHarvestJobsOutput) GoString() string {
 	return s.String()
 }
 
-// SetChannels sets the Channels field's value.
-func (s *ListChannelsOutput) SetChannels(v []*Channel) *ListChannelsOutput {
-	s.Channels = v
+// SetHarvestJobs sets the HarvestJobs field's value.
+func (s *ListHarvestJobsOutput) SetHarvestJobs(v []*HarvestJob) *ListHarvestJobsOutput {
+	s.HarvestJobs = v
 	return s
 }
 
 // SetNextToken sets the NextToken field's value.
-func (s *ListChannelsOutput) SetNextToken(v string) *ListChannelsOutput {
+func (s *ListHarvestJobsOutput) SetNextToken(v string) *ListHarvestJobsOutput {
 	s.NextToken = &v
 	return s
 }
 
 type ListOriginEndpointsInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	ChannelId *string `location:"querystring" locationName:"channelId" type:"string"`
 
@@ -3301,12 +5171,20 @@ type ListOriginEndpointsInput struct {
 	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListOriginEndpointsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListOriginEndpointsInput) GoString() string {
 	return s.String()
 }
@@ -3350,12 +5228,20 @@ type ListOriginEndpointsOutput struct {
 	OriginEndpoints []*OriginEndpoint `locationName:"originEndpoints" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListOriginEndpointsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListOriginEndpointsOutput) GoString() string {
 	return s.String()
 }
@@ -3373,18 +5259,26 @@ func (s *ListOriginEndpointsOutput) SetOriginEndpoints(v []*OriginEndpoint) *Lis
 }
 
 type ListTagsForResourceInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// ResourceArn is a required field
 	ResourceArn *string `location:"uri" locationName:"resource-arn" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceInput) GoString() string {
 	return s.String()
 }
@@ -3417,12 +5311,20 @@ type ListTagsForResourceOutput struct {
 	Tags map[string]*string `locationName:"tags" type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceOutput) GoString() string {
 	return s.String()
 }
@@ -3444,12 +5346,20 @@ type MssEncryption struct {
 	SpekeKeyProvider *SpekeKeyProvider `locationName:"spekeKeyProvider" type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s MssEncryption) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s MssEncryption) GoString() string {
 	return s.String()
 }
@@ -3495,12 +5405,20 @@ type MssPackage struct {
 	StreamSelection *StreamSelection `locationName:"streamSelection" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s MssPackage) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s MssPackage) GoString() string {
 	return s.String()
 }
@@ -3544,6 +5462,69 @@ func (s *MssPackage) SetStreamSelection(v *StreamSelection) *MssPackage {
 	return s
 }
 
+type NotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorNotFoundException(v protocol.ResponseMetadata) error {
+	return &NotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *NotFoundException) Code() string {
+	return "NotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *NotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *NotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *NotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *NotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *NotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // An OriginEndpoint resource configuration.
 type OriginEndpoint struct {
 	_ struct{} `type:"structure"`
@@ -3551,12 +5532,18 @@ type OriginEndpoint struct {
 	// The Amazon Resource Name (ARN) assigned to the OriginEndpoint.
 	Arn *string `locationName:"arn" type:"string"`
 
+	// CDN Authorization credentials
+	Authorization *Authorization `locationName:"authorization" type:"structure"`
+
 	// The ID of the Channel the OriginEndpoint is associated with.
 	ChannelId *string `locationName:"channelId" type:"string"`
 
 	// A Common Media Application Format (CMAF) packaging configuration.
 	CmafPackage *CmafPackage `locationName:"cmafPackage" type:"structure"`
 
+	// The date and time the OriginEndpoint was created.
+	CreatedAt *string `locationName:"createdAt" type:"string"`
+
 	// A Dynamic Adaptive Streaming over HTTP (DASH) packaging configuration.
 	DashPackage *DashPackage `locationName:"dashPackage" type:"structure"`
 
@@ -3575,6 +5562,13 @@ type OriginEndpoint struct {
 	// A Microsoft Smooth Streaming (MSS) packaging configuration.
 	MssPackage *MssPackage `locationName:"mssPackage" type:"structure"`
 
+	// Control whether origination of video is allowed for this OriginEndpoint.
+	// If set to ALLOW, the OriginEndpointmay by requested, pursuant to any other
+	// form of access control. If set to DENY, the OriginEndpoint may not berequested.
+	// This can be helpful for Live to VOD harvesting, or for temporarily disabling
+	// origination
+	Origination *string `locationName:"origination" type:"string" enum:"Origination"`
+
 	// Maximum duration (seconds) of content to retain for startover playback.If
 	// not specified, startover playback will be disabled for the OriginEndpoint.
 	StartoverWindowSeconds *int64 `locationName:"startoverWindowSeconds" type:"integer"`
@@ -3593,12 +5587,20 @@ type OriginEndpoint struct {
 	Whitelist []*string `locationName:"whitelist" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OriginEndpoint) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OriginEndpoint) GoString() string {
 	return s.String()
 }
@@ -3609,6 +5611,12 @@ func (s *OriginEndpoint) SetArn(v string) *OriginEndpoint {
 	return s
 }
 
+// SetAuthorization sets the Authorization field's value.
+func (s *OriginEndpoint) SetAuthorization(v *Authorization) *OriginEndpoint {
+	s.Authorization = v
+	return s
+}
+
 // SetChannelId sets the ChannelId field's value.
 func (s *OriginEndpoint) SetChannelId(v string) *OriginEndpoint {
 	s.ChannelId = &v
@@ -3621,6 +5629,12 @@ func (s *OriginEndpoint) SetCmafPackage(v *CmafPackage) *OriginEndpoint {
 	return s
 }
 
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *OriginEndpoint) SetCreatedAt(v string) *OriginEndpoint {
+	s.CreatedAt = &v
+	return s
+}
+
 // SetDashPackage sets the DashPackage field's value.
 func (s *OriginEndpoint) SetDashPackage(v *DashPackage) *OriginEndpoint {
 	s.DashPackage = v
@@ -3657,6 +5671,12 @@ func (s *OriginEndpoint) SetMssPackage(v *MssPackage) *OriginEndpoint {
 	return s
 }
 
+// SetOrigination sets the Origination field's value.
+func (s *OriginEndpoint) SetOrigination(v string) *OriginEndpoint {
+	s.Origination = &v
+	return s
+}
+
 // SetStartoverWindowSeconds sets the StartoverWindowSeconds field's value.
 func (s *OriginEndpoint) SetStartoverWindowSeconds(v int64) *OriginEndpoint {
 	s.StartoverWindowSeconds = &v
@@ -3689,18 +5709,26 @@ func (s *OriginEndpoint) SetWhitelist(v []*string) *OriginEndpoint {
 
 // Deprecated: RotateChannelCredentialsInput has been deprecated
 type RotateChannelCredentialsInput struct {
-	_ struct{} `deprecated:"true" type:"structure"`
+	_ struct{} `deprecated:"true" type:"structure" nopayload:"true"`
 
 	// Id is a required field
 	Id *string `location:"uri" locationName:"id" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RotateChannelCredentialsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RotateChannelCredentialsInput) GoString() string {
 	return s.String()
 }
@@ -3733,23 +5761,39 @@ type RotateChannelCredentialsOutput struct {
 
 	Arn *string `locationName:"arn" type:"string"`
 
+	CreatedAt *string `locationName:"createdAt" type:"string"`
+
 	Description *string `locationName:"description" type:"string"`
 
+	// Configure egress access logging.
+	EgressAccessLogs *EgressAccessLogs `locationName:"egressAccessLogs" type:"structure"`
+
 	// An HTTP Live Streaming (HLS) ingest resource configuration.
 	HlsIngest *HlsIngest `locationName:"hlsIngest" type:"structure"`
 
 	Id *string `locationName:"id" type:"string"`
 
+	// Configure ingress access logging.
+	IngressAccessLogs *IngressAccessLogs `locationName:"ingressAccessLogs" type:"structure"`
+
 	// A collection of tags associated with a resource
 	Tags map[string]*string `locationName:"tags" type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RotateChannelCredentialsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RotateChannelCredentialsOutput) GoString() string {
 	return s.String()
 }
@@ -3760,12 +5804,24 @@ func (s *RotateChannelCredentialsOutput) SetArn(v string) *RotateChannelCredenti
 	return s
 }
 
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *RotateChannelCredentialsOutput) SetCreatedAt(v string) *RotateChannelCredentialsOutput {
+	s.CreatedAt = &v
+	return s
+}
+
 // SetDescription sets the Description field's value.
 func (s *RotateChannelCredentialsOutput) SetDescription(v string) *RotateChannelCredentialsOutput {
 	s.Description = &v
 	return s
 }
 
+// SetEgressAccessLogs sets the EgressAccessLogs field's value.
+func (s *RotateChannelCredentialsOutput) SetEgressAccessLogs(v *EgressAccessLogs) *RotateChannelCredentialsOutput {
+	s.EgressAccessLogs = v
+	return s
+}
+
 // SetHlsIngest sets the HlsIngest field's value.
 func (s *RotateChannelCredentialsOutput) SetHlsIngest(v *HlsIngest) *RotateChannelCredentialsOutput {
 	s.HlsIngest = v
@@ -3778,6 +5834,12 @@ func (s *RotateChannelCredentialsOutput) SetId(v string) *RotateChannelCredentia
 	return s
 }
 
+// SetIngressAccessLogs sets the IngressAccessLogs field's value.
+func (s *RotateChannelCredentialsOutput) SetIngressAccessLogs(v *IngressAccessLogs) *RotateChannelCredentialsOutput {
+	s.IngressAccessLogs = v
+	return s
+}
+
 // SetTags sets the Tags field's value.
 func (s *RotateChannelCredentialsOutput) SetTags(v map[string]*string) *RotateChannelCredentialsOutput {
 	s.Tags = v
@@ -3785,7 +5847,7 @@ func (s *RotateChannelCredentialsOutput) SetTags(v map[string]*string) *RotateCh
 }
 
 type RotateIngestEndpointCredentialsInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// Id is a required field
 	Id *string `location:"uri" locationName:"id" type:"string" required:"true"`
@@ -3794,12 +5856,20 @@ type RotateIngestEndpointCredentialsInput struct {
 	IngestEndpointId *string `location:"uri" locationName:"ingest_endpoint_id" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RotateIngestEndpointCredentialsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RotateIngestEndpointCredentialsInput) GoString() string {
 	return s.String()
 }
@@ -3832,66 +5902,240 @@ func (s *RotateIngestEndpointCredentialsInput) SetId(v string) *RotateIngestEndp
 	return s
 }
 
-// SetIngestEndpointId sets the IngestEndpointId field's value.
-func (s *RotateIngestEndpointCredentialsInput) SetIngestEndpointId(v string) *RotateIngestEndpointCredentialsInput {
-	s.IngestEndpointId = &v
+// SetIngestEndpointId sets the IngestEndpointId field's value.
+func (s *RotateIngestEndpointCredentialsInput) SetIngestEndpointId(v string) *RotateIngestEndpointCredentialsInput {
+	s.IngestEndpointId = &v
+	return s
+}
+
+type RotateIngestEndpointCredentialsOutput struct {
+	_ struct{} `type:"structure"`
+
+	Arn *string `locationName:"arn" type:"string"`
+
+	CreatedAt *string `locationName:"createdAt" type:"string"`
+
+	Description *string `locationName:"description" type:"string"`
+
+	// Configure egress access logging.
+	EgressAccessLogs *EgressAccessLogs `locationName:"egressAccessLogs" type:"structure"`
+
+	// An HTTP Live Streaming (HLS) ingest resource configuration.
+	HlsIngest *HlsIngest `locationName:"hlsIngest" type:"structure"`
+
+	Id *string `locationName:"id" type:"string"`
+
+	// Configure ingress access logging.
+	IngressAccessLogs *IngressAccessLogs `locationName:"ingressAccessLogs" type:"structure"`
+
+	// A collection of tags associated with a resource
+	Tags map[string]*string `locationName:"tags" type:"map"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RotateIngestEndpointCredentialsOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RotateIngestEndpointCredentialsOutput) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *RotateIngestEndpointCredentialsOutput) SetArn(v string) *RotateIngestEndpointCredentialsOutput {
+	s.Arn = &v
+	return s
+}
+
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *RotateIngestEndpointCredentialsOutput) SetCreatedAt(v string) *RotateIngestEndpointCredentialsOutput {
+	s.CreatedAt = &v
+	return s
+}
+
+// SetDescription sets the Description field's value.
+func (s *RotateIngestEndpointCredentialsOutput) SetDescription(v string) *RotateIngestEndpointCredentialsOutput {
+	s.Description = &v
+	return s
+}
+
+// SetEgressAccessLogs sets the EgressAccessLogs field's value.
+func (s *RotateIngestEndpointCredentialsOutput) SetEgressAccessLogs(v *EgressAccessLogs) *RotateIngestEndpointCredentialsOutput {
+	s.EgressAccessLogs = v
+	return s
+}
+
+// SetHlsIngest sets the HlsIngest field's value.
+func (s *RotateIngestEndpointCredentialsOutput) SetHlsIngest(v *HlsIngest) *RotateIngestEndpointCredentialsOutput {
+	s.HlsIngest = v
+	return s
+}
+
+// SetId sets the Id field's value.
+func (s *RotateIngestEndpointCredentialsOutput) SetId(v string) *RotateIngestEndpointCredentialsOutput {
+	s.Id = &v
+	return s
+}
+
+// SetIngressAccessLogs sets the IngressAccessLogs field's value.
+func (s *RotateIngestEndpointCredentialsOutput) SetIngressAccessLogs(v *IngressAccessLogs) *RotateIngestEndpointCredentialsOutput {
+	s.IngressAccessLogs = v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *RotateIngestEndpointCredentialsOutput) SetTags(v map[string]*string) *RotateIngestEndpointCredentialsOutput {
+	s.Tags = v
+	return s
+}
+
+// Configuration parameters for where in an S3 bucket to place the harvested
+// content
+type S3Destination struct {
+	_ struct{} `type:"structure"`
+
+	// The name of an S3 bucket within which harvested content will be exported
+	//
+	// BucketName is a required field
+	BucketName *string `locationName:"bucketName" type:"string" required:"true"`
+
+	// The key in the specified S3 bucket where the harvested top-level manifest
+	// will be placed.
+	//
+	// ManifestKey is a required field
+	ManifestKey *string `locationName:"manifestKey" type:"string" required:"true"`
+
+	// The IAM role used to write to the specified S3 bucket
+	//
+	// RoleArn is a required field
+	RoleArn *string `locationName:"roleArn" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s S3Destination) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s S3Destination) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *S3Destination) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "S3Destination"}
+	if s.BucketName == nil {
+		invalidParams.Add(request.NewErrParamRequired("BucketName"))
+	}
+	if s.ManifestKey == nil {
+		invalidParams.Add(request.NewErrParamRequired("ManifestKey"))
+	}
+	if s.RoleArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("RoleArn"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetBucketName sets the BucketName field's value.
+func (s *S3Destination) SetBucketName(v string) *S3Destination {
+	s.BucketName = &v
+	return s
+}
+
+// SetManifestKey sets the ManifestKey field's value.
+func (s *S3Destination) SetManifestKey(v string) *S3Destination {
+	s.ManifestKey = &v
 	return s
 }
 
-type RotateIngestEndpointCredentialsOutput struct {
-	_ struct{} `type:"structure"`
-
-	Arn *string `locationName:"arn" type:"string"`
-
-	Description *string `locationName:"description" type:"string"`
-
-	// An HTTP Live Streaming (HLS) ingest resource configuration.
-	HlsIngest *HlsIngest `locationName:"hlsIngest" type:"structure"`
+// SetRoleArn sets the RoleArn field's value.
+func (s *S3Destination) SetRoleArn(v string) *S3Destination {
+	s.RoleArn = &v
+	return s
+}
 
-	Id *string `locationName:"id" type:"string"`
+type ServiceUnavailableException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// A collection of tags associated with a resource
-	Tags map[string]*string `locationName:"tags" type:"map"`
+	Message_ *string `locationName:"message" type:"string"`
 }
 
-// String returns the string representation
-func (s RotateIngestEndpointCredentialsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceUnavailableException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s RotateIngestEndpointCredentialsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceUnavailableException) GoString() string {
 	return s.String()
 }
 
-// SetArn sets the Arn field's value.
-func (s *RotateIngestEndpointCredentialsOutput) SetArn(v string) *RotateIngestEndpointCredentialsOutput {
-	s.Arn = &v
-	return s
+func newErrorServiceUnavailableException(v protocol.ResponseMetadata) error {
+	return &ServiceUnavailableException{
+		RespMetadata: v,
+	}
 }
 
-// SetDescription sets the Description field's value.
-func (s *RotateIngestEndpointCredentialsOutput) SetDescription(v string) *RotateIngestEndpointCredentialsOutput {
-	s.Description = &v
-	return s
+// Code returns the exception type name.
+func (s *ServiceUnavailableException) Code() string {
+	return "ServiceUnavailableException"
 }
 
-// SetHlsIngest sets the HlsIngest field's value.
-func (s *RotateIngestEndpointCredentialsOutput) SetHlsIngest(v *HlsIngest) *RotateIngestEndpointCredentialsOutput {
-	s.HlsIngest = v
-	return s
+// Message returns the exception's message.
+func (s *ServiceUnavailableException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// SetId sets the Id field's value.
-func (s *RotateIngestEndpointCredentialsOutput) SetId(v string) *RotateIngestEndpointCredentialsOutput {
-	s.Id = &v
-	return s
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ServiceUnavailableException) OrigErr() error {
+	return nil
 }
 
-// SetTags sets the Tags field's value.
-func (s *RotateIngestEndpointCredentialsOutput) SetTags(v map[string]*string) *RotateIngestEndpointCredentialsOutput {
-	s.Tags = v
-	return s
+func (s *ServiceUnavailableException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ServiceUnavailableException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ServiceUnavailableException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
 // A configuration for accessing an external Secure Packager and Encoder Key
@@ -3904,6 +6148,16 @@ type SpekeKeyProvider struct {
 	// service.
 	CertificateArn *string `locationName:"certificateArn" type:"string"`
 
+	// Use encryptionContractConfiguration to configure one or more content encryption
+	// keys for your endpoints that use SPEKE 2.0. The encryption contract defines
+	// which content keys are used to encrypt the audio and video tracks in your
+	// stream. To configure the encryption contract, specify which audio and video
+	// encryption presets to use.Note the following considerations when using encryptionContractConfiguration:encryptionContractConfiguration
+	// can be used for DASH or CMAF endpoints that use SPEKE 2.0. SPEKE 2.0 relies
+	// on the CPIX 2.3 specification.You must disable key rotation for this endpoint
+	// by setting keyRotationIntervalSeconds to 0.
+	EncryptionContractConfiguration *EncryptionContractConfiguration `locationName:"encryptionContractConfiguration" type:"structure"`
+
 	// The resource ID to include in key requests.
 	//
 	// ResourceId is a required field
@@ -3926,12 +6180,20 @@ type SpekeKeyProvider struct {
 	Url *string `locationName:"url" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SpekeKeyProvider) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SpekeKeyProvider) GoString() string {
 	return s.String()
 }
@@ -3951,6 +6213,11 @@ func (s *SpekeKeyProvider) Validate() error {
 	if s.Url == nil {
 		invalidParams.Add(request.NewErrParamRequired("Url"))
 	}
+	if s.EncryptionContractConfiguration != nil {
+		if err := s.EncryptionContractConfiguration.Validate(); err != nil {
+			invalidParams.AddNested("EncryptionContractConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -3964,6 +6231,12 @@ func (s *SpekeKeyProvider) SetCertificateArn(v string) *SpekeKeyProvider {
 	return s
 }
 
+// SetEncryptionContractConfiguration sets the EncryptionContractConfiguration field's value.
+func (s *SpekeKeyProvider) SetEncryptionContractConfiguration(v *EncryptionContractConfiguration) *SpekeKeyProvider {
+	s.EncryptionContractConfiguration = v
+	return s
+}
+
 // SetResourceId sets the ResourceId field's value.
 func (s *SpekeKeyProvider) SetResourceId(v string) *SpekeKeyProvider {
 	s.ResourceId = &v
@@ -4002,12 +6275,20 @@ type StreamSelection struct {
 	StreamOrder *string `locationName:"streamOrder" type:"string" enum:"StreamOrder"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StreamSelection) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StreamSelection) GoString() string {
 	return s.String()
 }
@@ -4040,12 +6321,20 @@ type TagResourceInput struct {
 	Tags map[string]*string `locationName:"tags" type:"map" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceInput) GoString() string {
 	return s.String()
 }
@@ -4085,18 +6374,152 @@ type TagResourceOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceOutput) GoString() string {
 	return s.String()
 }
 
+type TooManyRequestsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyRequestsException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyRequestsException) GoString() string {
+	return s.String()
+}
+
+func newErrorTooManyRequestsException(v protocol.ResponseMetadata) error {
+	return &TooManyRequestsException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *TooManyRequestsException) Code() string {
+	return "TooManyRequestsException"
+}
+
+// Message returns the exception's message.
+func (s *TooManyRequestsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *TooManyRequestsException) OrigErr() error {
+	return nil
+}
+
+func (s *TooManyRequestsException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *TooManyRequestsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *TooManyRequestsException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type UnprocessableEntityException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UnprocessableEntityException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UnprocessableEntityException) GoString() string {
+	return s.String()
+}
+
+func newErrorUnprocessableEntityException(v protocol.ResponseMetadata) error {
+	return &UnprocessableEntityException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *UnprocessableEntityException) Code() string {
+	return "UnprocessableEntityException"
+}
+
+// Message returns the exception's message.
+func (s *UnprocessableEntityException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *UnprocessableEntityException) OrigErr() error {
+	return nil
+}
+
+func (s *UnprocessableEntityException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *UnprocessableEntityException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *UnprocessableEntityException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 type UntagResourceInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// ResourceArn is a required field
 	ResourceArn *string `location:"uri" locationName:"resource-arn" type:"string" required:"true"`
@@ -4105,12 +6528,20 @@ type UntagResourceInput struct {
 	TagKeys []*string `location:"querystring" locationName:"tagKeys" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceInput) GoString() string {
 	return s.String()
 }
@@ -4150,12 +6581,20 @@ type UntagResourceOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceOutput) GoString() string {
 	return s.String()
 }
@@ -4169,12 +6608,20 @@ type UpdateChannelInput struct {
 	Id *string `location:"uri" locationName:"id" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateChannelInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateChannelInput) GoString() string {
 	return s.String()
 }
@@ -4212,23 +6659,39 @@ type UpdateChannelOutput struct {
 
 	Arn *string `locationName:"arn" type:"string"`
 
+	CreatedAt *string `locationName:"createdAt" type:"string"`
+
 	Description *string `locationName:"description" type:"string"`
 
+	// Configure egress access logging.
+	EgressAccessLogs *EgressAccessLogs `locationName:"egressAccessLogs" type:"structure"`
+
 	// An HTTP Live Streaming (HLS) ingest resource configuration.
 	HlsIngest *HlsIngest `locationName:"hlsIngest" type:"structure"`
 
 	Id *string `locationName:"id" type:"string"`
 
+	// Configure ingress access logging.
+	IngressAccessLogs *IngressAccessLogs `locationName:"ingressAccessLogs" type:"structure"`
+
 	// A collection of tags associated with a resource
 	Tags map[string]*string `locationName:"tags" type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateChannelOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateChannelOutput) GoString() string {
 	return s.String()
 }
@@ -4239,12 +6702,24 @@ func (s *UpdateChannelOutput) SetArn(v string) *UpdateChannelOutput {
 	return s
 }
 
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *UpdateChannelOutput) SetCreatedAt(v string) *UpdateChannelOutput {
+	s.CreatedAt = &v
+	return s
+}
+
 // SetDescription sets the Description field's value.
 func (s *UpdateChannelOutput) SetDescription(v string) *UpdateChannelOutput {
 	s.Description = &v
 	return s
 }
 
+// SetEgressAccessLogs sets the EgressAccessLogs field's value.
+func (s *UpdateChannelOutput) SetEgressAccessLogs(v *EgressAccessLogs) *UpdateChannelOutput {
+	s.EgressAccessLogs = v
+	return s
+}
+
 // SetHlsIngest sets the HlsIngest field's value.
 func (s *UpdateChannelOutput) SetHlsIngest(v *HlsIngest) *UpdateChannelOutput {
 	s.HlsIngest = v
@@ -4257,6 +6732,12 @@ func (s *UpdateChannelOutput) SetId(v string) *UpdateChannelOutput {
 	return s
 }
 
+// SetIngressAccessLogs sets the IngressAccessLogs field's value.
+func (s *UpdateChannelOutput) SetIngressAccessLogs(v *IngressAccessLogs) *UpdateChannelOutput {
+	s.IngressAccessLogs = v
+	return s
+}
+
 // SetTags sets the Tags field's value.
 func (s *UpdateChannelOutput) SetTags(v map[string]*string) *UpdateChannelOutput {
 	s.Tags = v
@@ -4266,6 +6747,9 @@ func (s *UpdateChannelOutput) SetTags(v map[string]*string) *UpdateChannelOutput
 type UpdateOriginEndpointInput struct {
 	_ struct{} `type:"structure"`
 
+	// CDN Authorization credentials
+	Authorization *Authorization `locationName:"authorization" type:"structure"`
+
 	// A Common Media Application Format (CMAF) packaging configuration.
 	CmafPackage *CmafPackageCreateOrUpdateParameters `locationName:"cmafPackage" type:"structure"`
 
@@ -4285,6 +6769,8 @@ type UpdateOriginEndpointInput struct {
 	// A Microsoft Smooth Streaming (MSS) packaging configuration.
 	MssPackage *MssPackage `locationName:"mssPackage" type:"structure"`
 
+	Origination *string `locationName:"origination" type:"string" enum:"Origination"`
+
 	StartoverWindowSeconds *int64 `locationName:"startoverWindowSeconds" type:"integer"`
 
 	TimeDelaySeconds *int64 `locationName:"timeDelaySeconds" type:"integer"`
@@ -4292,12 +6778,20 @@ type UpdateOriginEndpointInput struct {
 	Whitelist []*string `locationName:"whitelist" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateOriginEndpointInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateOriginEndpointInput) GoString() string {
 	return s.String()
 }
@@ -4311,6 +6805,11 @@ func (s *UpdateOriginEndpointInput) Validate() error {
 	if s.Id != nil && len(*s.Id) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
 	}
+	if s.Authorization != nil {
+		if err := s.Authorization.Validate(); err != nil {
+			invalidParams.AddNested("Authorization", err.(request.ErrInvalidParams))
+		}
+	}
 	if s.CmafPackage != nil {
 		if err := s.CmafPackage.Validate(); err != nil {
 			invalidParams.AddNested("CmafPackage", err.(request.ErrInvalidParams))
@@ -4338,6 +6837,12 @@ func (s *UpdateOriginEndpointInput) Validate() error {
 	return nil
 }
 
+// SetAuthorization sets the Authorization field's value.
+func (s *UpdateOriginEndpointInput) SetAuthorization(v *Authorization) *UpdateOriginEndpointInput {
+	s.Authorization = v
+	return s
+}
+
 // SetCmafPackage sets the CmafPackage field's value.
 func (s *UpdateOriginEndpointInput) SetCmafPackage(v *CmafPackageCreateOrUpdateParameters) *UpdateOriginEndpointInput {
 	s.CmafPackage = v
@@ -4380,6 +6885,12 @@ func (s *UpdateOriginEndpointInput) SetMssPackage(v *MssPackage) *UpdateOriginEn
 	return s
 }
 
+// SetOrigination sets the Origination field's value.
+func (s *UpdateOriginEndpointInput) SetOrigination(v string) *UpdateOriginEndpointInput {
+	s.Origination = &v
+	return s
+}
+
 // SetStartoverWindowSeconds sets the StartoverWindowSeconds field's value.
 func (s *UpdateOriginEndpointInput) SetStartoverWindowSeconds(v int64) *UpdateOriginEndpointInput {
 	s.StartoverWindowSeconds = &v
@@ -4403,11 +6914,16 @@ type UpdateOriginEndpointOutput struct {
 
 	Arn *string `locationName:"arn" type:"string"`
 
+	// CDN Authorization credentials
+	Authorization *Authorization `locationName:"authorization" type:"structure"`
+
 	ChannelId *string `locationName:"channelId" type:"string"`
 
 	// A Common Media Application Format (CMAF) packaging configuration.
 	CmafPackage *CmafPackage `locationName:"cmafPackage" type:"structure"`
 
+	CreatedAt *string `locationName:"createdAt" type:"string"`
+
 	// A Dynamic Adaptive Streaming over HTTP (DASH) packaging configuration.
 	DashPackage *DashPackage `locationName:"dashPackage" type:"structure"`
 
@@ -4423,6 +6939,8 @@ type UpdateOriginEndpointOutput struct {
 	// A Microsoft Smooth Streaming (MSS) packaging configuration.
 	MssPackage *MssPackage `locationName:"mssPackage" type:"structure"`
 
+	Origination *string `locationName:"origination" type:"string" enum:"Origination"`
+
 	StartoverWindowSeconds *int64 `locationName:"startoverWindowSeconds" type:"integer"`
 
 	// A collection of tags associated with a resource
@@ -4435,12 +6953,20 @@ type UpdateOriginEndpointOutput struct {
 	Whitelist []*string `locationName:"whitelist" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateOriginEndpointOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateOriginEndpointOutput) GoString() string {
 	return s.String()
 }
@@ -4451,6 +6977,12 @@ func (s *UpdateOriginEndpointOutput) SetArn(v string) *UpdateOriginEndpointOutpu
 	return s
 }
 
+// SetAuthorization sets the Authorization field's value.
+func (s *UpdateOriginEndpointOutput) SetAuthorization(v *Authorization) *UpdateOriginEndpointOutput {
+	s.Authorization = v
+	return s
+}
+
 // SetChannelId sets the ChannelId field's value.
 func (s *UpdateOriginEndpointOutput) SetChannelId(v string) *UpdateOriginEndpointOutput {
 	s.ChannelId = &v
@@ -4463,6 +6995,12 @@ func (s *UpdateOriginEndpointOutput) SetCmafPackage(v *CmafPackage) *UpdateOrigi
 	return s
 }
 
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *UpdateOriginEndpointOutput) SetCreatedAt(v string) *UpdateOriginEndpointOutput {
+	s.CreatedAt = &v
+	return s
+}
+
 // SetDashPackage sets the DashPackage field's value.
 func (s *UpdateOriginEndpointOutput) SetDashPackage(v *DashPackage) *UpdateOriginEndpointOutput {
 	s.DashPackage = v
@@ -4499,6 +7037,12 @@ func (s *UpdateOriginEndpointOutput) SetMssPackage(v *MssPackage) *UpdateOriginE
 	return s
 }
 
+// SetOrigination sets the Origination field's value.
+func (s *UpdateOriginEndpointOutput) SetOrigination(v string) *UpdateOriginEndpointOutput {
+	s.Origination = &v
+	return s
+}
+
 // SetStartoverWindowSeconds sets the StartoverWindowSeconds field's value.
 func (s *UpdateOriginEndpointOutput) SetStartoverWindowSeconds(v int64) *UpdateOriginEndpointOutput {
 	s.StartoverWindowSeconds = &v
@@ -4538,8 +7082,21 @@ const (
 
 	// AdMarkersPassthrough is a AdMarkers enum value
 	AdMarkersPassthrough = "PASSTHROUGH"
+
+	// AdMarkersDaterange is a AdMarkers enum value
+	AdMarkersDaterange = "DATERANGE"
 )
 
+// AdMarkers_Values returns all elements of the AdMarkers enum
+func AdMarkers_Values() []string {
+	return []string{
+		AdMarkersNone,
+		AdMarkersScte35Enhanced,
+		AdMarkersPassthrough,
+		AdMarkersDaterange,
+	}
+}
+
 // This setting allows the delivery restriction flags on SCTE-35 segmentation
 // descriptors todetermine whether a message signals an ad. Choosing "NONE"
 // means no SCTE-35 messages becomeads. Choosing "RESTRICTED" means SCTE-35
@@ -4564,6 +7121,33 @@ const (
 	AdsOnDeliveryRestrictionsBoth = "BOTH"
 )
 
+// AdsOnDeliveryRestrictions_Values returns all elements of the AdsOnDeliveryRestrictions enum
+func AdsOnDeliveryRestrictions_Values() []string {
+	return []string{
+		AdsOnDeliveryRestrictionsNone,
+		AdsOnDeliveryRestrictionsRestricted,
+		AdsOnDeliveryRestrictionsUnrestricted,
+		AdsOnDeliveryRestrictionsBoth,
+	}
+}
+
+// The encryption method to use.
+const (
+	// CmafEncryptionMethodSampleAes is a CmafEncryptionMethod enum value
+	CmafEncryptionMethodSampleAes = "SAMPLE_AES"
+
+	// CmafEncryptionMethodAesCtr is a CmafEncryptionMethod enum value
+	CmafEncryptionMethodAesCtr = "AES_CTR"
+)
+
+// CmafEncryptionMethod_Values returns all elements of the CmafEncryptionMethod enum
+func CmafEncryptionMethod_Values() []string {
+	return []string{
+		CmafEncryptionMethodSampleAes,
+		CmafEncryptionMethodAesCtr,
+	}
+}
+
 const (
 	// EncryptionMethodAes128 is a EncryptionMethod enum value
 	EncryptionMethodAes128 = "AES_128"
@@ -4572,6 +7156,14 @@ const (
 	EncryptionMethodSampleAes = "SAMPLE_AES"
 )
 
+// EncryptionMethod_Values returns all elements of the EncryptionMethod enum
+func EncryptionMethod_Values() []string {
+	return []string{
+		EncryptionMethodAes128,
+		EncryptionMethodSampleAes,
+	}
+}
+
 const (
 	// ManifestLayoutFull is a ManifestLayout enum value
 	ManifestLayoutFull = "FULL"
@@ -4580,6 +7172,30 @@ const (
 	ManifestLayoutCompact = "COMPACT"
 )
 
+// ManifestLayout_Values returns all elements of the ManifestLayout enum
+func ManifestLayout_Values() []string {
+	return []string{
+		ManifestLayoutFull,
+		ManifestLayoutCompact,
+	}
+}
+
+const (
+	// OriginationAllow is a Origination enum value
+	OriginationAllow = "ALLOW"
+
+	// OriginationDeny is a Origination enum value
+	OriginationDeny = "DENY"
+)
+
+// Origination_Values returns all elements of the Origination enum
+func Origination_Values() []string {
+	return []string{
+		OriginationAllow,
+		OriginationDeny,
+	}
+}
+
 const (
 	// PlaylistTypeNone is a PlaylistType enum value
 	PlaylistTypeNone = "NONE"
@@ -4591,14 +7207,115 @@ const (
 	PlaylistTypeVod = "VOD"
 )
 
+// PlaylistType_Values returns all elements of the PlaylistType enum
+func PlaylistType_Values() []string {
+	return []string{
+		PlaylistTypeNone,
+		PlaylistTypeEvent,
+		PlaylistTypeVod,
+	}
+}
+
+const (
+	// PresetSpeke20AudioPresetAudio1 is a PresetSpeke20Audio enum value
+	PresetSpeke20AudioPresetAudio1 = "PRESET-AUDIO-1"
+
+	// PresetSpeke20AudioPresetAudio2 is a PresetSpeke20Audio enum value
+	PresetSpeke20AudioPresetAudio2 = "PRESET-AUDIO-2"
+
+	// PresetSpeke20AudioPresetAudio3 is a PresetSpeke20Audio enum value
+	PresetSpeke20AudioPresetAudio3 = "PRESET-AUDIO-3"
+
+	// PresetSpeke20AudioShared is a PresetSpeke20Audio enum value
+	PresetSpeke20AudioShared = "SHARED"
+
+	// PresetSpeke20AudioUnencrypted is a PresetSpeke20Audio enum value
+	PresetSpeke20AudioUnencrypted = "UNENCRYPTED"
+)
+
+// PresetSpeke20Audio_Values returns all elements of the PresetSpeke20Audio enum
+func PresetSpeke20Audio_Values() []string {
+	return []string{
+		PresetSpeke20AudioPresetAudio1,
+		PresetSpeke20AudioPresetAudio2,
+		PresetSpeke20AudioPresetAudio3,
+		PresetSpeke20AudioShared,
+		PresetSpeke20AudioUnencrypted,
+	}
+}
+
+const (
+	// PresetSpeke20VideoPresetVideo1 is a PresetSpeke20Video enum value
+	PresetSpeke20VideoPresetVideo1 = "PRESET-VIDEO-1"
+
+	// PresetSpeke20VideoPresetVideo2 is a PresetSpeke20Video enum value
+	PresetSpeke20VideoPresetVideo2 = "PRESET-VIDEO-2"
+
+	// PresetSpeke20VideoPresetVideo3 is a PresetSpeke20Video enum value
+	PresetSpeke20VideoPresetVideo3 = "PRESET-VIDEO-3"
+
+	// PresetSpeke20VideoPresetVideo4 is a PresetSpeke20Video enum value
+	PresetSpeke20VideoPresetVideo4 = "PRESET-VIDEO-4"
+
+	// PresetSpeke20VideoPresetVideo5 is a PresetSpeke20Video enum value
+	PresetSpeke20VideoPresetVideo5 = "PRESET-VIDEO-5"
+
+	// PresetSpeke20VideoPresetVideo6 is a PresetSpeke20Video enum value
+	PresetSpeke20VideoPresetVideo6 = "PRESET-VIDEO-6"
+
+	// PresetSpeke20VideoPresetVideo7 is a PresetSpeke20Video enum value
+	PresetSpeke20VideoPresetVideo7 = "PRESET-VIDEO-7"
+
+	// PresetSpeke20VideoPresetVideo8 is a PresetSpeke20Video enum value
+	PresetSpeke20VideoPresetVideo8 = "PRESET-VIDEO-8"
+
+	// PresetSpeke20VideoShared is a PresetSpeke20Video enum value
+	PresetSpeke20VideoShared = "SHARED"
+
+	// PresetSpeke20VideoUnencrypted is a PresetSpeke20Video enum value
+	PresetSpeke20VideoUnencrypted = "UNENCRYPTED"
+)
+
+// PresetSpeke20Video_Values returns all elements of the PresetSpeke20Video enum
+func PresetSpeke20Video_Values() []string {
+	return []string{
+		PresetSpeke20VideoPresetVideo1,
+		PresetSpeke20VideoPresetVideo2,
+		PresetSpeke20VideoPresetVideo3,
+		PresetSpeke20VideoPresetVideo4,
+		PresetSpeke20VideoPresetVideo5,
+		PresetSpeke20VideoPresetVideo6,
+		PresetSpeke20VideoPresetVideo7,
+		PresetSpeke20VideoPresetVideo8,
+		PresetSpeke20VideoShared,
+		PresetSpeke20VideoUnencrypted,
+	}
+}
+
 const (
 	// ProfileNone is a Profile enum value
 	ProfileNone = "NONE"
 
 	// ProfileHbbtv15 is a Profile enum value
 	ProfileHbbtv15 = "HBBTV_1_5"
+
+	// ProfileHybridcast is a Profile enum value
+	ProfileHybridcast = "HYBRIDCAST"
+
+	// ProfileDvbDash2014 is a Profile enum value
+	ProfileDvbDash2014 = "DVB_DASH_2014"
 )
 
+// Profile_Values returns all elements of the Profile enum
+func Profile_Values() []string {
+	return []string{
+		ProfileNone,
+		ProfileHbbtv15,
+		ProfileHybridcast,
+		ProfileDvbDash2014,
+	}
+}
+
 const (
 	// SegmentTemplateFormatNumberWithTimeline is a SegmentTemplateFormat enum value
 	SegmentTemplateFormatNumberWithTimeline = "NUMBER_WITH_TIMELINE"
@@ -4610,6 +7327,35 @@ const (
 	SegmentTemplateFormatNumberWithDuration = "NUMBER_WITH_DURATION"
 )
 
+// SegmentTemplateFormat_Values returns all elements of the SegmentTemplateFormat enum
+func SegmentTemplateFormat_Values() []string {
+	return []string{
+		SegmentTemplateFormatNumberWithTimeline,
+		SegmentTemplateFormatTimeWithTimeline,
+		SegmentTemplateFormatNumberWithDuration,
+	}
+}
+
+const (
+	// StatusInProgress is a Status enum value
+	StatusInProgress = "IN_PROGRESS"
+
+	// StatusSucceeded is a Status enum value
+	StatusSucceeded = "SUCCEEDED"
+
+	// StatusFailed is a Status enum value
+	StatusFailed = "FAILED"
+)
+
+// Status_Values returns all elements of the Status enum
+func Status_Values() []string {
+	return []string{
+		StatusInProgress,
+		StatusSucceeded,
+		StatusFailed,
+	}
+}
+
 const (
 	// StreamOrderOriginal is a StreamOrder enum value
 	StreamOrderOriginal = "ORIGINAL"
@@ -4621,6 +7367,39 @@ const (
 	StreamOrderVideoBitrateDescending = "VIDEO_BITRATE_DESCENDING"
 )
 
+// StreamOrder_Values returns all elements of the StreamOrder enum
+func StreamOrder_Values() []string {
+	return []string{
+		StreamOrderOriginal,
+		StreamOrderVideoBitrateAscending,
+		StreamOrderVideoBitrateDescending,
+	}
+}
+
+const (
+	// UtcTimingNone is a UtcTiming enum value
+	UtcTimingNone = "NONE"
+
+	// UtcTimingHttpHead is a UtcTiming enum value
+	UtcTimingHttpHead = "HTTP-HEAD"
+
+	// UtcTimingHttpIso is a UtcTiming enum value
+	UtcTimingHttpIso = "HTTP-ISO"
+
+	// UtcTimingHttpXsdate is a UtcTiming enum value
+	UtcTimingHttpXsdate = "HTTP-XSDATE"
+)
+
+// UtcTiming_Values returns all elements of the UtcTiming enum
+func UtcTiming_Values() []string {
+	return []string{
+		UtcTimingNone,
+		UtcTimingHttpHead,
+		UtcTimingHttpIso,
+		UtcTimingHttpXsdate,
+	}
+}
+
 const (
 	// __AdTriggersElementSpliceInsert is a __AdTriggersElement enum value
 	__AdTriggersElementSpliceInsert = "SPLICE_INSERT"
@@ -4647,7 +7426,28 @@ const (
 	__AdTriggersElementDistributorOverlayPlacementOpportunity = "DISTRIBUTOR_OVERLAY_PLACEMENT_OPPORTUNITY"
 )
 
+// __AdTriggersElement_Values returns all elements of the __AdTriggersElement enum
+func __AdTriggersElement_Values() []string {
+	return []string{
+		__AdTriggersElementSpliceInsert,
+		__AdTriggersElementBreak,
+		__AdTriggersElementProviderAdvertisement,
+		__AdTriggersElementDistributorAdvertisement,
+		__AdTriggersElementProviderPlacementOpportunity,
+		__AdTriggersElementDistributorPlacementOpportunity,
+		__AdTriggersElementProviderOverlayPlacementOpportunity,
+		__AdTriggersElementDistributorOverlayPlacementOpportunity,
+	}
+}
+
 const (
 	// __PeriodTriggersElementAds is a __PeriodTriggersElement enum value
 	__PeriodTriggersElementAds = "ADS"
 )
+
+// __PeriodTriggersElement_Values returns all elements of the __PeriodTriggersElement enum
+func __PeriodTriggersElement_Values() []string {
+	return []string{
+		__PeriodTriggersElementAds,
+	}
+}
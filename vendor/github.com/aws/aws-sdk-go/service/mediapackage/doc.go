@@ -3,14 +3,14 @@
 // Package mediapackage provides the client and types for making API
 // requests to AWS Elemental MediaPackage.
 //
-// AWS Elemental MediaPackage
+// # AWS Elemental MediaPackage
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/mediapackage-2017-10-12 for more information on this service.
 //
 // See mediapackage package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/mediapackage/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact AWS Elemental MediaPackage with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
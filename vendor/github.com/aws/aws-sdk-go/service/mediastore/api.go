@@ -29,14 +29,13 @@ const opCreateContainer = "CreateContainer"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateContainerRequest method.
+//	req, resp := client.CreateContainerRequest(params)
 //
-//    // Example sending a request using the CreateContainerRequest method.
-//    req, resp := client.CreateContainerRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/CreateContainer
 func (c *MediaStore) CreateContainerRequest(input *CreateContainerInput) (req *request.Request, output *CreateContainerOutput) {
@@ -67,16 +66,17 @@ func (c *MediaStore) CreateContainerRequest(input *CreateContainerInput) (req *r
 // See the AWS API reference guide for AWS Elemental MediaStore's
 // API operation CreateContainer for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeContainerInUseException "ContainerInUseException"
-//   The container that you specified in the request already exists or is being
-//   updated.
+// Returned Error Types:
+//
+//   - ContainerInUseException
+//     The container that you specified in the request already exists or is being
+//     updated.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   A service limit has been exceeded.
+//   - LimitExceededException
+//     A service limit has been exceeded.
 //
-//   * ErrCodeInternalServerError "InternalServerError"
-//   The service is temporarily unavailable.
+//   - InternalServerError
+//     The service is temporarily unavailable.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/CreateContainer
 func (c *MediaStore) CreateContainer(input *CreateContainerInput) (*CreateContainerOutput, error) {
@@ -116,14 +116,13 @@ const opDeleteContainer = "DeleteContainer"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteContainerRequest method.
+//	req, resp := client.DeleteContainerRequest(params)
 //
-//    // Example sending a request using the DeleteContainerRequest method.
-//    req, resp := client.DeleteContainerRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/DeleteContainer
 func (c *MediaStore) DeleteContainerRequest(input *DeleteContainerInput) (req *request.Request, output *DeleteContainerOutput) {
@@ -156,16 +155,17 @@ func (c *MediaStore) DeleteContainerRequest(input *DeleteContainerInput) (req *r
 // See the AWS API reference guide for AWS Elemental MediaStore's
 // API operation DeleteContainer for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeContainerInUseException "ContainerInUseException"
-//   The container that you specified in the request already exists or is being
-//   updated.
+// Returned Error Types:
+//
+//   - ContainerInUseException
+//     The container that you specified in the request already exists or is being
+//     updated.
 //
-//   * ErrCodeContainerNotFoundException "ContainerNotFoundException"
-//   The container that you specified in the request does not exist.
+//   - ContainerNotFoundException
+//     The container that you specified in the request does not exist.
 //
-//   * ErrCodeInternalServerError "InternalServerError"
-//   The service is temporarily unavailable.
+//   - InternalServerError
+//     The service is temporarily unavailable.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/DeleteContainer
 func (c *MediaStore) DeleteContainer(input *DeleteContainerInput) (*DeleteContainerOutput, error) {
@@ -205,14 +205,13 @@ const opDeleteContainerPolicy = "DeleteContainerPolicy"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteContainerPolicyRequest method.
+//	req, resp := client.DeleteContainerPolicyRequest(params)
 //
-//    // Example sending a request using the DeleteContainerPolicyRequest method.
-//    req, resp := client.DeleteContainerPolicyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/DeleteContainerPolicy
 func (c *MediaStore) DeleteContainerPolicyRequest(input *DeleteContainerPolicyInput) (req *request.Request, output *DeleteContainerPolicyOutput) {
@@ -243,19 +242,20 @@ func (c *MediaStore) DeleteContainerPolicyRequest(input *DeleteContainerPolicyIn
 // See the AWS API reference guide for AWS Elemental MediaStore's
 // API operation DeleteContainerPolicy for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeContainerInUseException "ContainerInUseException"
-//   The container that you specified in the request already exists or is being
-//   updated.
+// Returned Error Types:
+//
+//   - ContainerInUseException
+//     The container that you specified in the request already exists or is being
+//     updated.
 //
-//   * ErrCodeContainerNotFoundException "ContainerNotFoundException"
-//   The container that you specified in the request does not exist.
+//   - ContainerNotFoundException
+//     The container that you specified in the request does not exist.
 //
-//   * ErrCodePolicyNotFoundException "PolicyNotFoundException"
-//   The policy that you specified in the request does not exist.
+//   - PolicyNotFoundException
+//     The policy that you specified in the request does not exist.
 //
-//   * ErrCodeInternalServerError "InternalServerError"
-//   The service is temporarily unavailable.
+//   - InternalServerError
+//     The service is temporarily unavailable.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/DeleteContainerPolicy
 func (c *MediaStore) DeleteContainerPolicy(input *DeleteContainerPolicyInput) (*DeleteContainerPolicyOutput, error) {
@@ -295,14 +295,13 @@ const opDeleteCorsPolicy = "DeleteCorsPolicy"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteCorsPolicyRequest method.
+//	req, resp := client.DeleteCorsPolicyRequest(params)
 //
-//    // Example sending a request using the DeleteCorsPolicyRequest method.
-//    req, resp := client.DeleteCorsPolicyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/DeleteCorsPolicy
 func (c *MediaStore) DeleteCorsPolicyRequest(input *DeleteCorsPolicyInput) (req *request.Request, output *DeleteCorsPolicyOutput) {
@@ -338,19 +337,20 @@ func (c *MediaStore) DeleteCorsPolicyRequest(input *DeleteCorsPolicyInput) (req
 // See the AWS API reference guide for AWS Elemental MediaStore's
 // API operation DeleteCorsPolicy for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeContainerInUseException "ContainerInUseException"
-//   The container that you specified in the request already exists or is being
-//   updated.
+// Returned Error Types:
+//
+//   - ContainerInUseException
+//     The container that you specified in the request already exists or is being
+//     updated.
 //
-//   * ErrCodeContainerNotFoundException "ContainerNotFoundException"
-//   The container that you specified in the request does not exist.
+//   - ContainerNotFoundException
+//     The container that you specified in the request does not exist.
 //
-//   * ErrCodeCorsPolicyNotFoundException "CorsPolicyNotFoundException"
-//   The CORS policy that you specified in the request does not exist.
+//   - CorsPolicyNotFoundException
+//     The CORS policy that you specified in the request does not exist.
 //
-//   * ErrCodeInternalServerError "InternalServerError"
-//   The service is temporarily unavailable.
+//   - InternalServerError
+//     The service is temporarily unavailable.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/DeleteCorsPolicy
 func (c *MediaStore) DeleteCorsPolicy(input *DeleteCorsPolicyInput) (*DeleteCorsPolicyOutput, error) {
@@ -390,14 +390,13 @@ const opDeleteLifecyclePolicy = "DeleteLifecyclePolicy"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteLifecyclePolicyRequest method.
+//	req, resp := client.DeleteLifecyclePolicyRequest(params)
 //
-//    // Example sending a request using the DeleteLifecyclePolicyRequest method.
-//    req, resp := client.DeleteLifecyclePolicyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/DeleteLifecyclePolicy
 func (c *MediaStore) DeleteLifecyclePolicyRequest(input *DeleteLifecyclePolicyInput) (req *request.Request, output *DeleteLifecyclePolicyOutput) {
@@ -429,19 +428,20 @@ func (c *MediaStore) DeleteLifecyclePolicyRequest(input *DeleteLifecyclePolicyIn
 // See the AWS API reference guide for AWS Elemental MediaStore's
 // API operation DeleteLifecyclePolicy for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeContainerInUseException "ContainerInUseException"
-//   The container that you specified in the request already exists or is being
-//   updated.
+// Returned Error Types:
+//
+//   - ContainerInUseException
+//     The container that you specified in the request already exists or is being
+//     updated.
 //
-//   * ErrCodeContainerNotFoundException "ContainerNotFoundException"
-//   The container that you specified in the request does not exist.
+//   - ContainerNotFoundException
+//     The container that you specified in the request does not exist.
 //
-//   * ErrCodePolicyNotFoundException "PolicyNotFoundException"
-//   The policy that you specified in the request does not exist.
+//   - PolicyNotFoundException
+//     The policy that you specified in the request does not exist.
 //
-//   * ErrCodeInternalServerError "InternalServerError"
-//   The service is temporarily unavailable.
+//   - InternalServerError
+//     The service is temporarily unavailable.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/DeleteLifecyclePolicy
 func (c *MediaStore) DeleteLifecyclePolicy(input *DeleteLifecyclePolicyInput) (*DeleteLifecyclePolicyOutput, error) {
@@ -465,6 +465,98 @@ func (c *MediaStore) DeleteLifecyclePolicyWithContext(ctx aws.Context, input *De
 	return out, req.Send()
 }
 
+const opDeleteMetricPolicy = "DeleteMetricPolicy"
+
+// DeleteMetricPolicyRequest generates a "aws/request.Request" representing the
+// client's request for the DeleteMetricPolicy operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See DeleteMetricPolicy for more information on using the DeleteMetricPolicy
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the DeleteMetricPolicyRequest method.
+//	req, resp := client.DeleteMetricPolicyRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/DeleteMetricPolicy
+func (c *MediaStore) DeleteMetricPolicyRequest(input *DeleteMetricPolicyInput) (req *request.Request, output *DeleteMetricPolicyOutput) {
+	op := &request.Operation{
+		Name:       opDeleteMetricPolicy,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &DeleteMetricPolicyInput{}
+	}
+
+	output = &DeleteMetricPolicyOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// DeleteMetricPolicy API operation for AWS Elemental MediaStore.
+//
+// Deletes the metric policy that is associated with the specified container.
+// If there is no metric policy associated with the container, MediaStore doesn't
+// send metrics to CloudWatch.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Elemental MediaStore's
+// API operation DeleteMetricPolicy for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ContainerInUseException
+//     The container that you specified in the request already exists or is being
+//     updated.
+//
+//   - ContainerNotFoundException
+//     The container that you specified in the request does not exist.
+//
+//   - PolicyNotFoundException
+//     The policy that you specified in the request does not exist.
+//
+//   - InternalServerError
+//     The service is temporarily unavailable.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/DeleteMetricPolicy
+func (c *MediaStore) DeleteMetricPolicy(input *DeleteMetricPolicyInput) (*DeleteMetricPolicyOutput, error) {
+	req, out := c.DeleteMetricPolicyRequest(input)
+	return out, req.Send()
+}
+
+// DeleteMetricPolicyWithContext is the same as DeleteMetricPolicy with the addition of
+// the ability to pass a context and additional request options.
+//
+// See DeleteMetricPolicy for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *MediaStore) DeleteMetricPolicyWithContext(ctx aws.Context, input *DeleteMetricPolicyInput, opts ...request.Option) (*DeleteMetricPolicyOutput, error) {
+	req, out := c.DeleteMetricPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opDescribeContainer = "DescribeContainer"
 
 // DescribeContainerRequest generates a "aws/request.Request" representing the
@@ -481,14 +573,13 @@ const opDescribeContainer = "DescribeContainer"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeContainerRequest method.
+//	req, resp := client.DescribeContainerRequest(params)
 //
-//    // Example sending a request using the DescribeContainerRequest method.
-//    req, resp := client.DescribeContainerRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/DescribeContainer
 func (c *MediaStore) DescribeContainerRequest(input *DescribeContainerInput) (req *request.Request, output *DescribeContainerOutput) {
@@ -523,12 +614,13 @@ func (c *MediaStore) DescribeContainerRequest(input *DescribeContainerInput) (re
 // See the AWS API reference guide for AWS Elemental MediaStore's
 // API operation DescribeContainer for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeContainerNotFoundException "ContainerNotFoundException"
-//   The container that you specified in the request does not exist.
+// Returned Error Types:
 //
-//   * ErrCodeInternalServerError "InternalServerError"
-//   The service is temporarily unavailable.
+//   - ContainerNotFoundException
+//     The container that you specified in the request does not exist.
+//
+//   - InternalServerError
+//     The service is temporarily unavailable.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/DescribeContainer
 func (c *MediaStore) DescribeContainer(input *DescribeContainerInput) (*DescribeContainerOutput, error) {
@@ -568,14 +660,13 @@ const opGetContainerPolicy = "GetContainerPolicy"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetContainerPolicyRequest method.
+//	req, resp := client.GetContainerPolicyRequest(params)
 //
-//    // Example sending a request using the GetContainerPolicyRequest method.
-//    req, resp := client.GetContainerPolicyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/GetContainerPolicy
 func (c *MediaStore) GetContainerPolicyRequest(input *GetContainerPolicyInput) (req *request.Request, output *GetContainerPolicyOutput) {
@@ -607,19 +698,20 @@ func (c *MediaStore) GetContainerPolicyRequest(input *GetContainerPolicyInput) (
 // See the AWS API reference guide for AWS Elemental MediaStore's
 // API operation GetContainerPolicy for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeContainerInUseException "ContainerInUseException"
-//   The container that you specified in the request already exists or is being
-//   updated.
+// Returned Error Types:
 //
-//   * ErrCodeContainerNotFoundException "ContainerNotFoundException"
-//   The container that you specified in the request does not exist.
+//   - ContainerInUseException
+//     The container that you specified in the request already exists or is being
+//     updated.
 //
-//   * ErrCodePolicyNotFoundException "PolicyNotFoundException"
-//   The policy that you specified in the request does not exist.
+//   - ContainerNotFoundException
+//     The container that you specified in the request does not exist.
 //
-//   * ErrCodeInternalServerError "InternalServerError"
-//   The service is temporarily unavailable.
+//   - PolicyNotFoundException
+//     The policy that you specified in the request does not exist.
+//
+//   - InternalServerError
+//     The service is temporarily unavailable.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/GetContainerPolicy
 func (c *MediaStore) GetContainerPolicy(input *GetContainerPolicyInput) (*GetContainerPolicyOutput, error) {
@@ -659,14 +751,13 @@ const opGetCorsPolicy = "GetCorsPolicy"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetCorsPolicyRequest method.
+//	req, resp := client.GetCorsPolicyRequest(params)
 //
-//    // Example sending a request using the GetCorsPolicyRequest method.
-//    req, resp := client.GetCorsPolicyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/GetCorsPolicy
 func (c *MediaStore) GetCorsPolicyRequest(input *GetCorsPolicyInput) (req *request.Request, output *GetCorsPolicyOutput) {
@@ -701,19 +792,20 @@ func (c *MediaStore) GetCorsPolicyRequest(input *GetCorsPolicyInput) (req *reque
 // See the AWS API reference guide for AWS Elemental MediaStore's
 // API operation GetCorsPolicy for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeContainerInUseException "ContainerInUseException"
-//   The container that you specified in the request already exists or is being
-//   updated.
+// Returned Error Types:
 //
-//   * ErrCodeContainerNotFoundException "ContainerNotFoundException"
-//   The container that you specified in the request does not exist.
+//   - ContainerInUseException
+//     The container that you specified in the request already exists or is being
+//     updated.
 //
-//   * ErrCodeCorsPolicyNotFoundException "CorsPolicyNotFoundException"
-//   The CORS policy that you specified in the request does not exist.
+//   - ContainerNotFoundException
+//     The container that you specified in the request does not exist.
 //
-//   * ErrCodeInternalServerError "InternalServerError"
-//   The service is temporarily unavailable.
+//   - CorsPolicyNotFoundException
+//     The CORS policy that you specified in the request does not exist.
+//
+//   - InternalServerError
+//     The service is temporarily unavailable.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/GetCorsPolicy
 func (c *MediaStore) GetCorsPolicy(input *GetCorsPolicyInput) (*GetCorsPolicyOutput, error) {
@@ -753,14 +845,13 @@ const opGetLifecyclePolicy = "GetLifecyclePolicy"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetLifecyclePolicyRequest method.
+//	req, resp := client.GetLifecyclePolicyRequest(params)
 //
-//    // Example sending a request using the GetLifecyclePolicyRequest method.
-//    req, resp := client.GetLifecyclePolicyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/GetLifecyclePolicy
 func (c *MediaStore) GetLifecyclePolicyRequest(input *GetLifecyclePolicyInput) (req *request.Request, output *GetLifecyclePolicyOutput) {
@@ -790,19 +881,20 @@ func (c *MediaStore) GetLifecyclePolicyRequest(input *GetLifecyclePolicyInput) (
 // See the AWS API reference guide for AWS Elemental MediaStore's
 // API operation GetLifecyclePolicy for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeContainerInUseException "ContainerInUseException"
-//   The container that you specified in the request already exists or is being
-//   updated.
+// Returned Error Types:
 //
-//   * ErrCodeContainerNotFoundException "ContainerNotFoundException"
-//   The container that you specified in the request does not exist.
+//   - ContainerInUseException
+//     The container that you specified in the request already exists or is being
+//     updated.
 //
-//   * ErrCodePolicyNotFoundException "PolicyNotFoundException"
-//   The policy that you specified in the request does not exist.
+//   - ContainerNotFoundException
+//     The container that you specified in the request does not exist.
 //
-//   * ErrCodeInternalServerError "InternalServerError"
-//   The service is temporarily unavailable.
+//   - PolicyNotFoundException
+//     The policy that you specified in the request does not exist.
+//
+//   - InternalServerError
+//     The service is temporarily unavailable.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/GetLifecyclePolicy
 func (c *MediaStore) GetLifecyclePolicy(input *GetLifecyclePolicyInput) (*GetLifecyclePolicyOutput, error) {
@@ -826,6 +918,95 @@ func (c *MediaStore) GetLifecyclePolicyWithContext(ctx aws.Context, input *GetLi
 	return out, req.Send()
 }
 
+const opGetMetricPolicy = "GetMetricPolicy"
+
+// GetMetricPolicyRequest generates a "aws/request.Request" representing the
+// client's request for the GetMetricPolicy operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See GetMetricPolicy for more information on using the GetMetricPolicy
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the GetMetricPolicyRequest method.
+//	req, resp := client.GetMetricPolicyRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/GetMetricPolicy
+func (c *MediaStore) GetMetricPolicyRequest(input *GetMetricPolicyInput) (req *request.Request, output *GetMetricPolicyOutput) {
+	op := &request.Operation{
+		Name:       opGetMetricPolicy,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &GetMetricPolicyInput{}
+	}
+
+	output = &GetMetricPolicyOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// GetMetricPolicy API operation for AWS Elemental MediaStore.
+//
+// Returns the metric policy for the specified container.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Elemental MediaStore's
+// API operation GetMetricPolicy for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ContainerNotFoundException
+//     The container that you specified in the request does not exist.
+//
+//   - PolicyNotFoundException
+//     The policy that you specified in the request does not exist.
+//
+//   - ContainerInUseException
+//     The container that you specified in the request already exists or is being
+//     updated.
+//
+//   - InternalServerError
+//     The service is temporarily unavailable.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/GetMetricPolicy
+func (c *MediaStore) GetMetricPolicy(input *GetMetricPolicyInput) (*GetMetricPolicyOutput, error) {
+	req, out := c.GetMetricPolicyRequest(input)
+	return out, req.Send()
+}
+
+// GetMetricPolicyWithContext is the same as GetMetricPolicy with the addition of
+// the ability to pass a context and additional request options.
+//
+// See GetMetricPolicy for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *MediaStore) GetMetricPolicyWithContext(ctx aws.Context, input *GetMetricPolicyInput, opts ...request.Option) (*GetMetricPolicyOutput, error) {
+	req, out := c.GetMetricPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opListContainers = "ListContainers"
 
 // ListContainersRequest generates a "aws/request.Request" representing the
@@ -842,14 +1023,13 @@ const opListContainers = "ListContainers"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListContainersRequest method.
+//	req, resp := client.ListContainersRequest(params)
 //
-//    // Example sending a request using the ListContainersRequest method.
-//    req, resp := client.ListContainersRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/ListContainers
 func (c *MediaStore) ListContainersRequest(input *ListContainersInput) (req *request.Request, output *ListContainersOutput) {
@@ -894,9 +1074,9 @@ func (c *MediaStore) ListContainersRequest(input *ListContainersInput) (req *req
 // See the AWS API reference guide for AWS Elemental MediaStore's
 // API operation ListContainers for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInternalServerError "InternalServerError"
-//   The service is temporarily unavailable.
+// Returned Error Types:
+//   - InternalServerError
+//     The service is temporarily unavailable.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/ListContainers
 func (c *MediaStore) ListContainers(input *ListContainersInput) (*ListContainersOutput, error) {
@@ -928,15 +1108,14 @@ func (c *MediaStore) ListContainersWithContext(ctx aws.Context, input *ListConta
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListContainers operation.
-//    pageNum := 0
-//    err := client.ListContainersPages(params,
-//        func(page *mediastore.ListContainersOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListContainers operation.
+//	pageNum := 0
+//	err := client.ListContainersPages(params,
+//	    func(page *mediastore.ListContainersOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *MediaStore) ListContainersPages(input *ListContainersInput, fn func(*ListContainersOutput, bool) bool) error {
 	return c.ListContainersPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -963,10 +1142,12 @@ func (c *MediaStore) ListContainersPagesWithContext(ctx aws.Context, input *List
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListContainersOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListContainersOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -986,14 +1167,13 @@ const opListTagsForResource = "ListTagsForResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTagsForResourceRequest method.
+//	req, resp := client.ListTagsForResourceRequest(params)
 //
-//    // Example sending a request using the ListTagsForResourceRequest method.
-//    req, resp := client.ListTagsForResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/ListTagsForResource
 func (c *MediaStore) ListTagsForResourceRequest(input *ListTagsForResourceInput) (req *request.Request, output *ListTagsForResourceOutput) {
@@ -1023,16 +1203,17 @@ func (c *MediaStore) ListTagsForResourceRequest(input *ListTagsForResourceInput)
 // See the AWS API reference guide for AWS Elemental MediaStore's
 // API operation ListTagsForResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeContainerInUseException "ContainerInUseException"
-//   The container that you specified in the request already exists or is being
-//   updated.
+// Returned Error Types:
 //
-//   * ErrCodeContainerNotFoundException "ContainerNotFoundException"
-//   The container that you specified in the request does not exist.
+//   - ContainerInUseException
+//     The container that you specified in the request already exists or is being
+//     updated.
 //
-//   * ErrCodeInternalServerError "InternalServerError"
-//   The service is temporarily unavailable.
+//   - ContainerNotFoundException
+//     The container that you specified in the request does not exist.
+//
+//   - InternalServerError
+//     The service is temporarily unavailable.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/ListTagsForResource
 func (c *MediaStore) ListTagsForResource(input *ListTagsForResourceInput) (*ListTagsForResourceOutput, error) {
@@ -1072,14 +1253,13 @@ const opPutContainerPolicy = "PutContainerPolicy"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PutContainerPolicyRequest method.
+//	req, resp := client.PutContainerPolicyRequest(params)
 //
-//    // Example sending a request using the PutContainerPolicyRequest method.
-//    req, resp := client.PutContainerPolicyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/PutContainerPolicy
 func (c *MediaStore) PutContainerPolicyRequest(input *PutContainerPolicyInput) (req *request.Request, output *PutContainerPolicyOutput) {
@@ -1117,16 +1297,17 @@ func (c *MediaStore) PutContainerPolicyRequest(input *PutContainerPolicyInput) (
 // See the AWS API reference guide for AWS Elemental MediaStore's
 // API operation PutContainerPolicy for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeContainerNotFoundException "ContainerNotFoundException"
-//   The container that you specified in the request does not exist.
+// Returned Error Types:
 //
-//   * ErrCodeContainerInUseException "ContainerInUseException"
-//   The container that you specified in the request already exists or is being
-//   updated.
+//   - ContainerNotFoundException
+//     The container that you specified in the request does not exist.
 //
-//   * ErrCodeInternalServerError "InternalServerError"
-//   The service is temporarily unavailable.
+//   - ContainerInUseException
+//     The container that you specified in the request already exists or is being
+//     updated.
+//
+//   - InternalServerError
+//     The service is temporarily unavailable.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/PutContainerPolicy
 func (c *MediaStore) PutContainerPolicy(input *PutContainerPolicyInput) (*PutContainerPolicyOutput, error) {
@@ -1166,14 +1347,13 @@ const opPutCorsPolicy = "PutCorsPolicy"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PutCorsPolicyRequest method.
+//	req, resp := client.PutCorsPolicyRequest(params)
 //
-//    // Example sending a request using the PutCorsPolicyRequest method.
-//    req, resp := client.PutCorsPolicyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/PutCorsPolicy
 func (c *MediaStore) PutCorsPolicyRequest(input *PutCorsPolicyInput) (req *request.Request, output *PutCorsPolicyOutput) {
@@ -1217,16 +1397,17 @@ func (c *MediaStore) PutCorsPolicyRequest(input *PutCorsPolicyInput) (req *reque
 // See the AWS API reference guide for AWS Elemental MediaStore's
 // API operation PutCorsPolicy for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeContainerNotFoundException "ContainerNotFoundException"
-//   The container that you specified in the request does not exist.
+// Returned Error Types:
 //
-//   * ErrCodeContainerInUseException "ContainerInUseException"
-//   The container that you specified in the request already exists or is being
-//   updated.
+//   - ContainerNotFoundException
+//     The container that you specified in the request does not exist.
 //
-//   * ErrCodeInternalServerError "InternalServerError"
-//   The service is temporarily unavailable.
+//   - ContainerInUseException
+//     The container that you specified in the request already exists or is being
+//     updated.
+//
+//   - InternalServerError
+//     The service is temporarily unavailable.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/PutCorsPolicy
 func (c *MediaStore) PutCorsPolicy(input *PutCorsPolicyInput) (*PutCorsPolicyOutput, error) {
@@ -1266,14 +1447,13 @@ const opPutLifecyclePolicy = "PutLifecyclePolicy"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PutLifecyclePolicyRequest method.
+//	req, resp := client.PutLifecyclePolicyRequest(params)
 //
-//    // Example sending a request using the PutLifecyclePolicyRequest method.
-//    req, resp := client.PutLifecyclePolicyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/PutLifecyclePolicy
 func (c *MediaStore) PutLifecyclePolicyRequest(input *PutLifecyclePolicyInput) (req *request.Request, output *PutLifecyclePolicyOutput) {
@@ -1309,16 +1489,17 @@ func (c *MediaStore) PutLifecyclePolicyRequest(input *PutLifecyclePolicyInput) (
 // See the AWS API reference guide for AWS Elemental MediaStore's
 // API operation PutLifecyclePolicy for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeContainerInUseException "ContainerInUseException"
-//   The container that you specified in the request already exists or is being
-//   updated.
+// Returned Error Types:
 //
-//   * ErrCodeContainerNotFoundException "ContainerNotFoundException"
-//   The container that you specified in the request does not exist.
+//   - ContainerInUseException
+//     The container that you specified in the request already exists or is being
+//     updated.
 //
-//   * ErrCodeInternalServerError "InternalServerError"
-//   The service is temporarily unavailable.
+//   - ContainerNotFoundException
+//     The container that you specified in the request does not exist.
+//
+//   - InternalServerError
+//     The service is temporarily unavailable.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/PutLifecyclePolicy
 func (c *MediaStore) PutLifecyclePolicy(input *PutLifecyclePolicyInput) (*PutLifecyclePolicyOutput, error) {
@@ -1342,6 +1523,95 @@ func (c *MediaStore) PutLifecyclePolicyWithContext(ctx aws.Context, input *PutLi
 	return out, req.Send()
 }
 
+const opPutMetricPolicy = "PutMetricPolicy"
+
+// PutMetricPolicyRequest generates a "aws/request.Request" representing the
+// client's request for the PutMetricPolicy operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See PutMetricPolicy for more information on using the PutMetricPolicy
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the PutMetricPolicyRequest method.
+//	req, resp := client.PutMetricPolicyRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/PutMetricPolicy
+func (c *MediaStore) PutMetricPolicyRequest(input *PutMetricPolicyInput) (req *request.Request, output *PutMetricPolicyOutput) {
+	op := &request.Operation{
+		Name:       opPutMetricPolicy,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &PutMetricPolicyInput{}
+	}
+
+	output = &PutMetricPolicyOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// PutMetricPolicy API operation for AWS Elemental MediaStore.
+//
+// The metric policy that you want to add to the container. A metric policy
+// allows AWS Elemental MediaStore to send metrics to Amazon CloudWatch. It
+// takes up to 20 minutes for the new policy to take effect.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Elemental MediaStore's
+// API operation PutMetricPolicy for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ContainerInUseException
+//     The container that you specified in the request already exists or is being
+//     updated.
+//
+//   - ContainerNotFoundException
+//     The container that you specified in the request does not exist.
+//
+//   - InternalServerError
+//     The service is temporarily unavailable.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/PutMetricPolicy
+func (c *MediaStore) PutMetricPolicy(input *PutMetricPolicyInput) (*PutMetricPolicyOutput, error) {
+	req, out := c.PutMetricPolicyRequest(input)
+	return out, req.Send()
+}
+
+// PutMetricPolicyWithContext is the same as PutMetricPolicy with the addition of
+// the ability to pass a context and additional request options.
+//
+// See PutMetricPolicy for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *MediaStore) PutMetricPolicyWithContext(ctx aws.Context, input *PutMetricPolicyInput, opts ...request.Option) (*PutMetricPolicyOutput, error) {
+	req, out := c.PutMetricPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opStartAccessLogging = "StartAccessLogging"
 
 // StartAccessLoggingRequest generates a "aws/request.Request" representing the
@@ -1358,14 +1628,13 @@ const opStartAccessLogging = "StartAccessLogging"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the StartAccessLoggingRequest method.
+//	req, resp := client.StartAccessLoggingRequest(params)
 //
-//    // Example sending a request using the StartAccessLoggingRequest method.
-//    req, resp := client.StartAccessLoggingRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/StartAccessLogging
 func (c *MediaStore) StartAccessLoggingRequest(input *StartAccessLoggingInput) (req *request.Request, output *StartAccessLoggingOutput) {
@@ -1398,16 +1667,17 @@ func (c *MediaStore) StartAccessLoggingRequest(input *StartAccessLoggingInput) (
 // See the AWS API reference guide for AWS Elemental MediaStore's
 // API operation StartAccessLogging for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeContainerInUseException "ContainerInUseException"
-//   The container that you specified in the request already exists or is being
-//   updated.
+// Returned Error Types:
 //
-//   * ErrCodeContainerNotFoundException "ContainerNotFoundException"
-//   The container that you specified in the request does not exist.
+//   - ContainerInUseException
+//     The container that you specified in the request already exists or is being
+//     updated.
 //
-//   * ErrCodeInternalServerError "InternalServerError"
-//   The service is temporarily unavailable.
+//   - ContainerNotFoundException
+//     The container that you specified in the request does not exist.
+//
+//   - InternalServerError
+//     The service is temporarily unavailable.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/StartAccessLogging
 func (c *MediaStore) StartAccessLogging(input *StartAccessLoggingInput) (*StartAccessLoggingOutput, error) {
@@ -1447,14 +1717,13 @@ const opStopAccessLogging = "StopAccessLogging"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the StopAccessLoggingRequest method.
+//	req, resp := client.StopAccessLoggingRequest(params)
 //
-//    // Example sending a request using the StopAccessLoggingRequest method.
-//    req, resp := client.StopAccessLoggingRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/StopAccessLogging
 func (c *MediaStore) StopAccessLoggingRequest(input *StopAccessLoggingInput) (req *request.Request, output *StopAccessLoggingOutput) {
@@ -1487,16 +1756,17 @@ func (c *MediaStore) StopAccessLoggingRequest(input *StopAccessLoggingInput) (re
 // See the AWS API reference guide for AWS Elemental MediaStore's
 // API operation StopAccessLogging for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeContainerInUseException "ContainerInUseException"
-//   The container that you specified in the request already exists or is being
-//   updated.
+// Returned Error Types:
 //
-//   * ErrCodeContainerNotFoundException "ContainerNotFoundException"
-//   The container that you specified in the request does not exist.
+//   - ContainerInUseException
+//     The container that you specified in the request already exists or is being
+//     updated.
 //
-//   * ErrCodeInternalServerError "InternalServerError"
-//   The service is temporarily unavailable.
+//   - ContainerNotFoundException
+//     The container that you specified in the request does not exist.
+//
+//   - InternalServerError
+//     The service is temporarily unavailable.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/StopAccessLogging
 func (c *MediaStore) StopAccessLogging(input *StopAccessLoggingInput) (*StopAccessLoggingOutput, error) {
@@ -1536,14 +1806,13 @@ const opTagResource = "TagResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the TagResourceRequest method.
+//	req, resp := client.TagResourceRequest(params)
 //
-//    // Example sending a request using the TagResourceRequest method.
-//    req, resp := client.TagResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/TagResource
 func (c *MediaStore) TagResourceRequest(input *TagResourceInput) (req *request.Request, output *TagResourceOutput) {
@@ -1570,7 +1839,7 @@ func (c *MediaStore) TagResourceRequest(input *TagResourceInput) (req *request.R
 // might be "customer" and the tag value might be "companyA." You can specify
 // one or more tags to add to each container. You can add up to 50 tags to each
 // container. For more information about tagging, including naming and usage
-// conventions, see Tagging Resources in MediaStore (https://aws.amazon.com/documentation/mediastore/tagging).
+// conventions, see Tagging Resources in MediaStore (https://docs.aws.amazon.com/mediastore/latest/ug/tagging.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1579,16 +1848,17 @@ func (c *MediaStore) TagResourceRequest(input *TagResourceInput) (req *request.R
 // See the AWS API reference guide for AWS Elemental MediaStore's
 // API operation TagResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeContainerInUseException "ContainerInUseException"
-//   The container that you specified in the request already exists or is being
-//   updated.
+// Returned Error Types:
 //
-//   * ErrCodeContainerNotFoundException "ContainerNotFoundException"
-//   The container that you specified in the request does not exist.
+//   - ContainerInUseException
+//     The container that you specified in the request already exists or is being
+//     updated.
 //
-//   * ErrCodeInternalServerError "InternalServerError"
-//   The service is temporarily unavailable.
+//   - ContainerNotFoundException
+//     The container that you specified in the request does not exist.
+//
+//   - InternalServerError
+//     The service is temporarily unavailable.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/TagResource
 func (c *MediaStore) TagResource(input *TagResourceInput) (*TagResourceOutput, error) {
@@ -1628,14 +1898,13 @@ const opUntagResource = "UntagResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UntagResourceRequest method.
+//	req, resp := client.UntagResourceRequest(params)
 //
-//    // Example sending a request using the UntagResourceRequest method.
-//    req, resp := client.UntagResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/UntagResource
 func (c *MediaStore) UntagResourceRequest(input *UntagResourceInput) (req *request.Request, output *UntagResourceOutput) {
@@ -1667,16 +1936,17 @@ func (c *MediaStore) UntagResourceRequest(input *UntagResourceInput) (req *reque
 // See the AWS API reference guide for AWS Elemental MediaStore's
 // API operation UntagResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeContainerInUseException "ContainerInUseException"
-//   The container that you specified in the request already exists or is being
-//   updated.
+// Returned Error Types:
 //
-//   * ErrCodeContainerNotFoundException "ContainerNotFoundException"
-//   The container that you specified in the request does not exist.
+//   - ContainerInUseException
+//     The container that you specified in the request already exists or is being
+//     updated.
 //
-//   * ErrCodeInternalServerError "InternalServerError"
-//   The service is temporarily unavailable.
+//   - ContainerNotFoundException
+//     The container that you specified in the request does not exist.
+//
+//   - InternalServerError
+//     The service is temporarily unavailable.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mediastore-2017-09-01/UntagResource
 func (c *MediaStore) UntagResource(input *UntagResourceInput) (*UntagResourceOutput, error) {
@@ -1739,12 +2009,20 @@ type Container struct {
 	Status *string `min:"1" type:"string" enum:"ContainerStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Container) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Container) GoString() string {
 	return s.String()
 }
@@ -1785,60 +2063,261 @@ func (s *Container) SetStatus(v string) *Container {
 	return s
 }
 
-// A rule for a CORS policy. You can add up to 100 rules to a CORS policy. If
-// more than one rule applies, the service uses the first applicable rule listed.
-type CorsRule struct {
-	_ struct{} `type:"structure"`
+// The container that you specified in the request already exists or is being
+// updated.
+type ContainerInUseException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// Specifies which headers are allowed in a preflight OPTIONS request through
-	// the Access-Control-Request-Headers header. Each header name that is specified
-	// in Access-Control-Request-Headers must have a corresponding entry in the
-	// rule. Only the headers that were requested are sent back.
-	//
-	// This element can contain only one wildcard character (*).
-	//
-	// AllowedHeaders is a required field
-	AllowedHeaders []*string `type:"list" required:"true"`
+	Message_ *string `locationName:"Message" min:"1" type:"string"`
+}
 
-	// Identifies an HTTP method that the origin that is specified in the rule is
-	// allowed to execute.
-	//
-	// Each CORS rule must contain at least one AllowedMethods and one AllowedOrigins
-	// element.
-	AllowedMethods []*string `min:"1" type:"list"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ContainerInUseException) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// One or more response headers that you want users to be able to access from
-	// their applications (for example, from a JavaScript XMLHttpRequest object).
-	//
-	// Each CORS rule must have at least one AllowedOrigins element. The string
-	// value can include only one wildcard character (*), for example, http://*.example.com.
-	// Additionally, you can specify only one wildcard character to allow cross-origin
-	// access for all origins.
-	//
-	// AllowedOrigins is a required field
-	AllowedOrigins []*string `min:"1" type:"list" required:"true"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ContainerInUseException) GoString() string {
+	return s.String()
+}
 
-	// One or more headers in the response that you want users to be able to access
-	// from their applications (for example, from a JavaScript XMLHttpRequest object).
-	//
-	// This element is optional for each rule.
-	ExposeHeaders []*string `type:"list"`
+func newErrorContainerInUseException(v protocol.ResponseMetadata) error {
+	return &ContainerInUseException{
+		RespMetadata: v,
+	}
+}
 
-	// The time in seconds that your browser caches the preflight response for the
-	// specified resource.
-	//
-	// A CORS rule can have only one MaxAgeSeconds element.
-	MaxAgeSeconds *int64 `type:"integer"`
+// Code returns the exception type name.
+func (s *ContainerInUseException) Code() string {
+	return "ContainerInUseException"
 }
 
-// String returns the string representation
-func (s CorsRule) String() string {
-	return awsutil.Prettify(s)
+// Message returns the exception's message.
+func (s *ContainerInUseException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// GoString returns the string representation
-func (s CorsRule) GoString() string {
-	return s.String()
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ContainerInUseException) OrigErr() error {
+	return nil
+}
+
+func (s *ContainerInUseException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ContainerInUseException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ContainerInUseException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The container that you specified in the request does not exist.
+type ContainerNotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ContainerNotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ContainerNotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorContainerNotFoundException(v protocol.ResponseMetadata) error {
+	return &ContainerNotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ContainerNotFoundException) Code() string {
+	return "ContainerNotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *ContainerNotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ContainerNotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *ContainerNotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ContainerNotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ContainerNotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The CORS policy that you specified in the request does not exist.
+type CorsPolicyNotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CorsPolicyNotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CorsPolicyNotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorCorsPolicyNotFoundException(v protocol.ResponseMetadata) error {
+	return &CorsPolicyNotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *CorsPolicyNotFoundException) Code() string {
+	return "CorsPolicyNotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *CorsPolicyNotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *CorsPolicyNotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *CorsPolicyNotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *CorsPolicyNotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *CorsPolicyNotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// A rule for a CORS policy. You can add up to 100 rules to a CORS policy. If
+// more than one rule applies, the service uses the first applicable rule listed.
+type CorsRule struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies which headers are allowed in a preflight OPTIONS request through
+	// the Access-Control-Request-Headers header. Each header name that is specified
+	// in Access-Control-Request-Headers must have a corresponding entry in the
+	// rule. Only the headers that were requested are sent back.
+	//
+	// This element can contain only one wildcard character (*).
+	//
+	// AllowedHeaders is a required field
+	AllowedHeaders []*string `type:"list" required:"true"`
+
+	// Identifies an HTTP method that the origin that is specified in the rule is
+	// allowed to execute.
+	//
+	// Each CORS rule must contain at least one AllowedMethods and one AllowedOrigins
+	// element.
+	AllowedMethods []*string `min:"1" type:"list" enum:"MethodName"`
+
+	// One or more response headers that you want users to be able to access from
+	// their applications (for example, from a JavaScript XMLHttpRequest object).
+	//
+	// Each CORS rule must have at least one AllowedOrigins element. The string
+	// value can include only one wildcard character (*), for example, http://*.example.com.
+	// Additionally, you can specify only one wildcard character to allow cross-origin
+	// access for all origins.
+	//
+	// AllowedOrigins is a required field
+	AllowedOrigins []*string `min:"1" type:"list" required:"true"`
+
+	// One or more headers in the response that you want users to be able to access
+	// from their applications (for example, from a JavaScript XMLHttpRequest object).
+	//
+	// This element is optional for each rule.
+	ExposeHeaders []*string `type:"list"`
+
+	// The time in seconds that your browser caches the preflight response for the
+	// specified resource.
+	//
+	// A CORS rule can have only one MaxAgeSeconds element.
+	MaxAgeSeconds *int64 `type:"integer"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CorsRule) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CorsRule) GoString() string {
+	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
@@ -1909,16 +2388,24 @@ type CreateContainerInput struct {
 	// and the tag value represents a specific value within that category (such
 	// as "test," "development," or "production"). You can add up to 50 tags to
 	// each container. For more information about tagging, including naming and
-	// usage conventions, see Tagging Resources in MediaStore (https://aws.amazon.com/documentation/mediastore/tagging).
-	Tags []*Tag `type:"list"`
+	// usage conventions, see Tagging Resources in MediaStore (https://docs.aws.amazon.com/mediastore/latest/ug/tagging.html).
+	Tags []*Tag `min:"1" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateContainerInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateContainerInput) GoString() string {
 	return s.String()
 }
@@ -1932,6 +2419,9 @@ func (s *CreateContainerInput) Validate() error {
 	if s.ContainerName != nil && len(*s.ContainerName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("ContainerName", 1))
 	}
+	if s.Tags != nil && len(s.Tags) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
+	}
 	if s.Tags != nil {
 		for i, v := range s.Tags {
 			if v == nil {
@@ -1984,12 +2474,20 @@ type CreateContainerOutput struct {
 	Container *Container `type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateContainerOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateContainerOutput) GoString() string {
 	return s.String()
 }
@@ -2009,12 +2507,20 @@ type DeleteContainerInput struct {
 	ContainerName *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteContainerInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteContainerInput) GoString() string {
 	return s.String()
 }
@@ -2045,12 +2551,20 @@ type DeleteContainerOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteContainerOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteContainerOutput) GoString() string {
 	return s.String()
 }
@@ -2064,12 +2578,20 @@ type DeleteContainerPolicyInput struct {
 	ContainerName *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteContainerPolicyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteContainerPolicyInput) GoString() string {
 	return s.String()
 }
@@ -2100,12 +2622,20 @@ type DeleteContainerPolicyOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteContainerPolicyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteContainerPolicyOutput) GoString() string {
 	return s.String()
 }
@@ -2119,12 +2649,20 @@ type DeleteCorsPolicyInput struct {
 	ContainerName *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteCorsPolicyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteCorsPolicyInput) GoString() string {
 	return s.String()
 }
@@ -2155,12 +2693,20 @@ type DeleteCorsPolicyOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteCorsPolicyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteCorsPolicyOutput) GoString() string {
 	return s.String()
 }
@@ -2174,12 +2720,20 @@ type DeleteLifecyclePolicyInput struct {
 	ContainerName *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteLifecyclePolicyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteLifecyclePolicyInput) GoString() string {
 	return s.String()
 }
@@ -2210,16 +2764,96 @@ type DeleteLifecyclePolicyOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteLifecyclePolicyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteLifecyclePolicyOutput) GoString() string {
 	return s.String()
 }
 
+type DeleteMetricPolicyInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the container that is associated with the metric policy that
+	// you want to delete.
+	//
+	// ContainerName is a required field
+	ContainerName *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteMetricPolicyInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteMetricPolicyInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteMetricPolicyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteMetricPolicyInput"}
+	if s.ContainerName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ContainerName"))
+	}
+	if s.ContainerName != nil && len(*s.ContainerName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ContainerName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetContainerName sets the ContainerName field's value.
+func (s *DeleteMetricPolicyInput) SetContainerName(v string) *DeleteMetricPolicyInput {
+	s.ContainerName = &v
+	return s
+}
+
+type DeleteMetricPolicyOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteMetricPolicyOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteMetricPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeContainerInput struct {
 	_ struct{} `type:"structure"`
 
@@ -2227,12 +2861,20 @@ type DescribeContainerInput struct {
 	ContainerName *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeContainerInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeContainerInput) GoString() string {
 	return s.String()
 }
@@ -2263,12 +2905,20 @@ type DescribeContainerOutput struct {
 	Container *Container `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeContainerOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeContainerOutput) GoString() string {
 	return s.String()
 }
@@ -2288,12 +2938,20 @@ type GetContainerPolicyInput struct {
 	ContainerName *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetContainerPolicyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetContainerPolicyInput) GoString() string {
 	return s.String()
 }
@@ -2329,12 +2987,20 @@ type GetContainerPolicyOutput struct {
 	Policy *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetContainerPolicyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetContainerPolicyOutput) GoString() string {
 	return s.String()
 }
@@ -2354,12 +3020,20 @@ type GetCorsPolicyInput struct {
 	ContainerName *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCorsPolicyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCorsPolicyInput) GoString() string {
 	return s.String()
 }
@@ -2395,86 +3069,320 @@ type GetCorsPolicyOutput struct {
 	CorsPolicy []*CorsRule `min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCorsPolicyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCorsPolicyOutput) GoString() string {
 	return s.String()
 }
 
-// SetCorsPolicy sets the CorsPolicy field's value.
-func (s *GetCorsPolicyOutput) SetCorsPolicy(v []*CorsRule) *GetCorsPolicyOutput {
-	s.CorsPolicy = v
-	return s
+// SetCorsPolicy sets the CorsPolicy field's value.
+func (s *GetCorsPolicyOutput) SetCorsPolicy(v []*CorsRule) *GetCorsPolicyOutput {
+	s.CorsPolicy = v
+	return s
+}
+
+type GetLifecyclePolicyInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the container that the object lifecycle policy is assigned to.
+	//
+	// ContainerName is a required field
+	ContainerName *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetLifecyclePolicyInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetLifecyclePolicyInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetLifecyclePolicyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetLifecyclePolicyInput"}
+	if s.ContainerName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ContainerName"))
+	}
+	if s.ContainerName != nil && len(*s.ContainerName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ContainerName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetContainerName sets the ContainerName field's value.
+func (s *GetLifecyclePolicyInput) SetContainerName(v string) *GetLifecyclePolicyInput {
+	s.ContainerName = &v
+	return s
+}
+
+type GetLifecyclePolicyOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The object lifecycle policy that is assigned to the container.
+	//
+	// LifecyclePolicy is a required field
+	LifecyclePolicy *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetLifecyclePolicyOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetLifecyclePolicyOutput) GoString() string {
+	return s.String()
+}
+
+// SetLifecyclePolicy sets the LifecyclePolicy field's value.
+func (s *GetLifecyclePolicyOutput) SetLifecyclePolicy(v string) *GetLifecyclePolicyOutput {
+	s.LifecyclePolicy = &v
+	return s
+}
+
+type GetMetricPolicyInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the container that is associated with the metric policy.
+	//
+	// ContainerName is a required field
+	ContainerName *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetMetricPolicyInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetMetricPolicyInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetMetricPolicyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetMetricPolicyInput"}
+	if s.ContainerName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ContainerName"))
+	}
+	if s.ContainerName != nil && len(*s.ContainerName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ContainerName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetContainerName sets the ContainerName field's value.
+func (s *GetMetricPolicyInput) SetContainerName(v string) *GetMetricPolicyInput {
+	s.ContainerName = &v
+	return s
+}
+
+type GetMetricPolicyOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The metric policy that is associated with the specific container.
+	//
+	// MetricPolicy is a required field
+	MetricPolicy *MetricPolicy `type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetMetricPolicyOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetMetricPolicyOutput) GoString() string {
+	return s.String()
+}
+
+// SetMetricPolicy sets the MetricPolicy field's value.
+func (s *GetMetricPolicyOutput) SetMetricPolicy(v *MetricPolicy) *GetMetricPolicyOutput {
+	s.MetricPolicy = v
+	return s
+}
+
+// The service is temporarily unavailable.
+type InternalServerError struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InternalServerError) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InternalServerError) GoString() string {
+	return s.String()
+}
+
+func newErrorInternalServerError(v protocol.ResponseMetadata) error {
+	return &InternalServerError{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InternalServerError) Code() string {
+	return "InternalServerError"
+}
+
+// Message returns the exception's message.
+func (s *InternalServerError) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InternalServerError) OrigErr() error {
+	return nil
+}
+
+func (s *InternalServerError) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InternalServerError) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InternalServerError) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-type GetLifecyclePolicyInput struct {
-	_ struct{} `type:"structure"`
+// A service limit has been exceeded.
+type LimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The name of the container that the object lifecycle policy is assigned to.
-	//
-	// ContainerName is a required field
-	ContainerName *string `min:"1" type:"string" required:"true"`
+	Message_ *string `locationName:"Message" min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s GetLifecyclePolicyInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetLifecyclePolicyInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetLifecyclePolicyInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetLifecyclePolicyInput"}
-	if s.ContainerName == nil {
-		invalidParams.Add(request.NewErrParamRequired("ContainerName"))
-	}
-	if s.ContainerName != nil && len(*s.ContainerName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("ContainerName", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
+func newErrorLimitExceededException(v protocol.ResponseMetadata) error {
+	return &LimitExceededException{
+		RespMetadata: v,
 	}
-	return nil
 }
 
-// SetContainerName sets the ContainerName field's value.
-func (s *GetLifecyclePolicyInput) SetContainerName(v string) *GetLifecyclePolicyInput {
-	s.ContainerName = &v
-	return s
+// Code returns the exception type name.
+func (s *LimitExceededException) Code() string {
+	return "LimitExceededException"
 }
 
-type GetLifecyclePolicyOutput struct {
-	_ struct{} `type:"structure"`
+// Message returns the exception's message.
+func (s *LimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
 
-	// The object lifecycle policy that is assigned to the container.
-	//
-	// LifecyclePolicy is a required field
-	LifecyclePolicy *string `type:"string" required:"true"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *LimitExceededException) OrigErr() error {
+	return nil
 }
 
-// String returns the string representation
-func (s GetLifecyclePolicyOutput) String() string {
-	return awsutil.Prettify(s)
+func (s *LimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// GoString returns the string representation
-func (s GetLifecyclePolicyOutput) GoString() string {
-	return s.String()
+// Status code returns the HTTP status code for the request's response error.
+func (s *LimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// SetLifecyclePolicy sets the LifecyclePolicy field's value.
-func (s *GetLifecyclePolicyOutput) SetLifecyclePolicy(v string) *GetLifecyclePolicyOutput {
-	s.LifecyclePolicy = &v
-	return s
+// RequestID returns the service's response RequestID for request.
+func (s *LimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
 type ListContainersInput struct {
@@ -2491,12 +3399,20 @@ type ListContainersInput struct {
 	NextToken *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListContainersInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListContainersInput) GoString() string {
 	return s.String()
 }
@@ -2543,12 +3459,20 @@ type ListContainersOutput struct {
 	NextToken *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListContainersOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListContainersOutput) GoString() string {
 	return s.String()
 }
@@ -2574,12 +3498,20 @@ type ListTagsForResourceInput struct {
 	Resource *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceInput) GoString() string {
 	return s.String()
 }
@@ -2610,15 +3542,23 @@ type ListTagsForResourceOutput struct {
 	_ struct{} `type:"structure"`
 
 	// An array of key:value pairs that are assigned to the container.
-	Tags []*Tag `type:"list"`
+	Tags []*Tag `min:"1" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceOutput) GoString() string {
 	return s.String()
 }
@@ -2629,6 +3569,222 @@ func (s *ListTagsForResourceOutput) SetTags(v []*Tag) *ListTagsForResourceOutput
 	return s
 }
 
+// The metric policy that is associated with the container. A metric policy
+// allows AWS Elemental MediaStore to send metrics to Amazon CloudWatch. In
+// the policy, you must indicate whether you want MediaStore to send container-level
+// metrics. You can also include rules to define groups of objects that you
+// want MediaStore to send object-level metrics for.
+//
+// To view examples of how to construct a metric policy for your use case, see
+// Example Metric Policies (https://docs.aws.amazon.com/mediastore/latest/ug/policies-metric-examples.html).
+type MetricPolicy struct {
+	_ struct{} `type:"structure"`
+
+	// A setting to enable or disable metrics at the container level.
+	//
+	// ContainerLevelMetrics is a required field
+	ContainerLevelMetrics *string `type:"string" required:"true" enum:"ContainerLevelMetrics"`
+
+	// A parameter that holds an array of rules that enable metrics at the object
+	// level. This parameter is optional, but if you choose to include it, you must
+	// also include at least one rule. By default, you can include up to five rules.
+	// You can also request a quota increase (https://console.aws.amazon.com/servicequotas/home?region=us-east-1#!/services/mediastore/quotas)
+	// to allow up to 300 rules per policy.
+	MetricPolicyRules []*MetricPolicyRule `min:"1" type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MetricPolicy) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MetricPolicy) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *MetricPolicy) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "MetricPolicy"}
+	if s.ContainerLevelMetrics == nil {
+		invalidParams.Add(request.NewErrParamRequired("ContainerLevelMetrics"))
+	}
+	if s.MetricPolicyRules != nil && len(s.MetricPolicyRules) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("MetricPolicyRules", 1))
+	}
+	if s.MetricPolicyRules != nil {
+		for i, v := range s.MetricPolicyRules {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "MetricPolicyRules", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetContainerLevelMetrics sets the ContainerLevelMetrics field's value.
+func (s *MetricPolicy) SetContainerLevelMetrics(v string) *MetricPolicy {
+	s.ContainerLevelMetrics = &v
+	return s
+}
+
+// SetMetricPolicyRules sets the MetricPolicyRules field's value.
+func (s *MetricPolicy) SetMetricPolicyRules(v []*MetricPolicyRule) *MetricPolicy {
+	s.MetricPolicyRules = v
+	return s
+}
+
+// A setting that enables metrics at the object level. Each rule contains an
+// object group and an object group name. If the policy includes the MetricPolicyRules
+// parameter, you must include at least one rule. Each metric policy can include
+// up to five rules by default. You can also request a quota increase (https://console.aws.amazon.com/servicequotas/home?region=us-east-1#!/services/mediastore/quotas)
+// to allow up to 300 rules per policy.
+type MetricPolicyRule struct {
+	_ struct{} `type:"structure"`
+
+	// A path or file name that defines which objects to include in the group. Wildcards
+	// (*) are acceptable.
+	//
+	// ObjectGroup is a required field
+	ObjectGroup *string `min:"1" type:"string" required:"true"`
+
+	// A name that allows you to refer to the object group.
+	//
+	// ObjectGroupName is a required field
+	ObjectGroupName *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MetricPolicyRule) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MetricPolicyRule) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *MetricPolicyRule) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "MetricPolicyRule"}
+	if s.ObjectGroup == nil {
+		invalidParams.Add(request.NewErrParamRequired("ObjectGroup"))
+	}
+	if s.ObjectGroup != nil && len(*s.ObjectGroup) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ObjectGroup", 1))
+	}
+	if s.ObjectGroupName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ObjectGroupName"))
+	}
+	if s.ObjectGroupName != nil && len(*s.ObjectGroupName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ObjectGroupName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetObjectGroup sets the ObjectGroup field's value.
+func (s *MetricPolicyRule) SetObjectGroup(v string) *MetricPolicyRule {
+	s.ObjectGroup = &v
+	return s
+}
+
+// SetObjectGroupName sets the ObjectGroupName field's value.
+func (s *MetricPolicyRule) SetObjectGroupName(v string) *MetricPolicyRule {
+	s.ObjectGroupName = &v
+	return s
+}
+
+// The policy that you specified in the request does not exist.
+type PolicyNotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PolicyNotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PolicyNotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorPolicyNotFoundException(v protocol.ResponseMetadata) error {
+	return &PolicyNotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *PolicyNotFoundException) Code() string {
+	return "PolicyNotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *PolicyNotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *PolicyNotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *PolicyNotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *PolicyNotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *PolicyNotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 type PutContainerPolicyInput struct {
 	_ struct{} `type:"structure"`
 
@@ -2647,12 +3803,20 @@ type PutContainerPolicyInput struct {
 	Policy *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutContainerPolicyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutContainerPolicyInput) GoString() string {
 	return s.String()
 }
@@ -2695,12 +3859,20 @@ type PutContainerPolicyOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutContainerPolicyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutContainerPolicyOutput) GoString() string {
 	return s.String()
 }
@@ -2719,12 +3891,20 @@ type PutCorsPolicyInput struct {
 	CorsPolicy []*CorsRule `min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutCorsPolicyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutCorsPolicyInput) GoString() string {
 	return s.String()
 }
@@ -2777,12 +3957,20 @@ type PutCorsPolicyOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutCorsPolicyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutCorsPolicyOutput) GoString() string {
 	return s.String()
 }
@@ -2802,12 +3990,20 @@ type PutLifecyclePolicyInput struct {
 	LifecyclePolicy *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutLifecyclePolicyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutLifecyclePolicyInput) GoString() string {
 	return s.String()
 }
@@ -2847,16 +4043,128 @@ type PutLifecyclePolicyOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutLifecyclePolicyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutLifecyclePolicyOutput) GoString() string {
 	return s.String()
 }
 
+type PutMetricPolicyInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the container that you want to add the metric policy to.
+	//
+	// ContainerName is a required field
+	ContainerName *string `min:"1" type:"string" required:"true"`
+
+	// The metric policy that you want to associate with the container. In the policy,
+	// you must indicate whether you want MediaStore to send container-level metrics.
+	// You can also include up to five rules to define groups of objects that you
+	// want MediaStore to send object-level metrics for. If you include rules in
+	// the policy, construct each rule with both of the following:
+	//
+	//    * An object group that defines which objects to include in the group.
+	//    The definition can be a path or a file name, but it can't have more than
+	//    900 characters. Valid characters are: a-z, A-Z, 0-9, _ (underscore), =
+	//    (equal), : (colon), . (period), - (hyphen), ~ (tilde), / (forward slash),
+	//    and * (asterisk). Wildcards (*) are acceptable.
+	//
+	//    * An object group name that allows you to refer to the object group. The
+	//    name can't have more than 30 characters. Valid characters are: a-z, A-Z,
+	//    0-9, and _ (underscore).
+	//
+	// MetricPolicy is a required field
+	MetricPolicy *MetricPolicy `type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutMetricPolicyInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutMetricPolicyInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *PutMetricPolicyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutMetricPolicyInput"}
+	if s.ContainerName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ContainerName"))
+	}
+	if s.ContainerName != nil && len(*s.ContainerName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ContainerName", 1))
+	}
+	if s.MetricPolicy == nil {
+		invalidParams.Add(request.NewErrParamRequired("MetricPolicy"))
+	}
+	if s.MetricPolicy != nil {
+		if err := s.MetricPolicy.Validate(); err != nil {
+			invalidParams.AddNested("MetricPolicy", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetContainerName sets the ContainerName field's value.
+func (s *PutMetricPolicyInput) SetContainerName(v string) *PutMetricPolicyInput {
+	s.ContainerName = &v
+	return s
+}
+
+// SetMetricPolicy sets the MetricPolicy field's value.
+func (s *PutMetricPolicyInput) SetMetricPolicy(v *MetricPolicy) *PutMetricPolicyInput {
+	s.MetricPolicy = v
+	return s
+}
+
+type PutMetricPolicyOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutMetricPolicyOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutMetricPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type StartAccessLoggingInput struct {
 	_ struct{} `type:"structure"`
 
@@ -2866,12 +4174,20 @@ type StartAccessLoggingInput struct {
 	ContainerName *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartAccessLoggingInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartAccessLoggingInput) GoString() string {
 	return s.String()
 }
@@ -2902,12 +4218,20 @@ type StartAccessLoggingOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartAccessLoggingOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StartAccessLoggingOutput) GoString() string {
 	return s.String()
 }
@@ -2921,12 +4245,20 @@ type StopAccessLoggingInput struct {
 	ContainerName *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopAccessLoggingInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopAccessLoggingInput) GoString() string {
 	return s.String()
 }
@@ -2957,12 +4289,20 @@ type StopAccessLoggingOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopAccessLoggingOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopAccessLoggingOutput) GoString() string {
 	return s.String()
 }
@@ -2973,13 +4313,15 @@ func (s StopAccessLoggingOutput) GoString() string {
 // a specific value within that category (such as "test," "development," or
 // "production"). You can add up to 50 tags to each container. For more information
 // about tagging, including naming and usage conventions, see Tagging Resources
-// in MediaStore (https://aws.amazon.com/documentation/mediastore/tagging).
+// in MediaStore (https://docs.aws.amazon.com/mediastore/latest/ug/tagging.html).
 type Tag struct {
 	_ struct{} `type:"structure"`
 
 	// Part of the key:value pair that defines a tag. You can use a tag key to describe
 	// a category of information, such as "customer." Tag keys are case-sensitive.
-	Key *string `min:"1" type:"string"`
+	//
+	// Key is a required field
+	Key *string `min:"1" type:"string" required:"true"`
 
 	// Part of the key:value pair that defines a tag. You can use a tag value to
 	// describe a specific value within a category, such as "companyA" or "companyB."
@@ -2987,12 +4329,20 @@ type Tag struct {
 	Value *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) GoString() string {
 	return s.String()
 }
@@ -3000,6 +4350,9 @@ func (s Tag) GoString() string {
 // Validate inspects the fields of the type to determine if they are valid.
 func (s *Tag) Validate() error {
 	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key == nil {
+		invalidParams.Add(request.NewErrParamRequired("Key"))
+	}
 	if s.Key != nil && len(*s.Key) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("Key", 1))
 	}
@@ -3039,15 +4392,23 @@ type TagResourceInput struct {
 	// and type:Contract.
 	//
 	// Tags is a required field
-	Tags []*Tag `type:"list" required:"true"`
+	Tags []*Tag `min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceInput) GoString() string {
 	return s.String()
 }
@@ -3064,6 +4425,9 @@ func (s *TagResourceInput) Validate() error {
 	if s.Tags == nil {
 		invalidParams.Add(request.NewErrParamRequired("Tags"))
 	}
+	if s.Tags != nil && len(s.Tags) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
+	}
 	if s.Tags != nil {
 		for i, v := range s.Tags {
 			if v == nil {
@@ -3097,12 +4461,20 @@ type TagResourceOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceOutput) GoString() string {
 	return s.String()
 }
@@ -3124,12 +4496,20 @@ type UntagResourceInput struct {
 	TagKeys []*string `type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceInput) GoString() string {
 	return s.String()
 }
@@ -3169,16 +4549,40 @@ type UntagResourceOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceOutput) GoString() string {
 	return s.String()
 }
 
+const (
+	// ContainerLevelMetricsEnabled is a ContainerLevelMetrics enum value
+	ContainerLevelMetricsEnabled = "ENABLED"
+
+	// ContainerLevelMetricsDisabled is a ContainerLevelMetrics enum value
+	ContainerLevelMetricsDisabled = "DISABLED"
+)
+
+// ContainerLevelMetrics_Values returns all elements of the ContainerLevelMetrics enum
+func ContainerLevelMetrics_Values() []string {
+	return []string{
+		ContainerLevelMetricsEnabled,
+		ContainerLevelMetricsDisabled,
+	}
+}
+
 const (
 	// ContainerStatusActive is a ContainerStatus enum value
 	ContainerStatusActive = "ACTIVE"
@@ -3190,6 +4594,15 @@ const (
 	ContainerStatusDeleting = "DELETING"
 )
 
+// ContainerStatus_Values returns all elements of the ContainerStatus enum
+func ContainerStatus_Values() []string {
+	return []string{
+		ContainerStatusActive,
+		ContainerStatusCreating,
+		ContainerStatusDeleting,
+	}
+}
+
 const (
 	// MethodNamePut is a MethodName enum value
 	MethodNamePut = "PUT"
@@ -3203,3 +4616,13 @@ const (
 	// MethodNameHead is a MethodName enum value
 	MethodNameHead = "HEAD"
 )
+
+// MethodName_Values returns all elements of the MethodName enum
+func MethodName_Values() []string {
+	return []string{
+		MethodNamePut,
+		MethodNameGet,
+		MethodNameDelete,
+		MethodNameHead,
+	}
+}
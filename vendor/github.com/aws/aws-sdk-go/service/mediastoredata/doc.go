@@ -12,7 +12,7 @@
 // See mediastoredata package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/mediastoredata/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact AWS Elemental MediaStore Data Plane with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
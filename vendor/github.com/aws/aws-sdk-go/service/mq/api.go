@@ -3,6 +3,7 @@
 package mq
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -28,14 +29,13 @@ const opCreateBroker = "CreateBroker"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateBrokerRequest method.
+//	req, resp := client.CreateBrokerRequest(params)
 //
-//    // Example sending a request using the CreateBrokerRequest method.
-//    req, resp := client.CreateBrokerRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/CreateBroker
 func (c *MQ) CreateBrokerRequest(input *CreateBrokerRequest) (req *request.Request, output *CreateBrokerResponse) {
@@ -58,6 +58,40 @@ func (c *MQ) CreateBrokerRequest(input *CreateBrokerRequest) (req *request.Reque
 //
 // Creates a broker. Note: This API is asynchronous.
 //
+// To create a broker, you must either use the AmazonMQFullAccess IAM policy
+// or include the following EC2 permissions in your IAM policy.
+//
+//   - ec2:CreateNetworkInterface This permission is required to allow Amazon
+//     MQ to create an elastic network interface (ENI) on behalf of your account.
+//
+//   - ec2:CreateNetworkInterfacePermission This permission is required to
+//     attach the ENI to the broker instance.
+//
+//   - ec2:DeleteNetworkInterface
+//
+//   - ec2:DeleteNetworkInterfacePermission
+//
+//   - ec2:DetachNetworkInterface
+//
+//   - ec2:DescribeInternetGateways
+//
+//   - ec2:DescribeNetworkInterfaces
+//
+//   - ec2:DescribeNetworkInterfacePermissions
+//
+//   - ec2:DescribeRouteTables
+//
+//   - ec2:DescribeSecurityGroups
+//
+//   - ec2:DescribeSubnets
+//
+//   - ec2:DescribeVpcs
+//
+// For more information, see Create an IAM User and Get Your Amazon Web Services
+// Credentials (https://docs.aws.amazon.com//amazon-mq/latest/developer-guide/amazon-mq-setting-up.html#create-iam-user)
+// and Never Modify or Delete the Amazon MQ Elastic Network Interface (https://docs.aws.amazon.com//amazon-mq/latest/developer-guide/connecting-to-amazon-mq.html#never-modify-delete-elastic-network-interface)
+// in the Amazon MQ Developer Guide.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -65,21 +99,22 @@ func (c *MQ) CreateBrokerRequest(input *CreateBrokerRequest) (req *request.Reque
 // See the AWS API reference guide for AmazonMQ's
 // API operation CreateBroker for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Returns information about an error.
+// Returned Error Types:
+//
+//   - BadRequestException
+//     Returns information about an error.
 //
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   Returns information about an error.
+//   - UnauthorizedException
+//     Returns information about an error.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   Returns information about an error.
+//   - InternalServerErrorException
+//     Returns information about an error.
 //
-//   * ErrCodeConflictException "ConflictException"
-//   Returns information about an error.
+//   - ConflictException
+//     Returns information about an error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   Returns information about an error.
+//   - ForbiddenException
+//     Returns information about an error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/CreateBroker
 func (c *MQ) CreateBroker(input *CreateBrokerRequest) (*CreateBrokerResponse, error) {
@@ -119,14 +154,13 @@ const opCreateConfiguration = "CreateConfiguration"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateConfigurationRequest method.
+//	req, resp := client.CreateConfigurationRequest(params)
 //
-//    // Example sending a request using the CreateConfigurationRequest method.
-//    req, resp := client.CreateConfigurationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/CreateConfiguration
 func (c *MQ) CreateConfigurationRequest(input *CreateConfigurationRequest) (req *request.Request, output *CreateConfigurationResponse) {
@@ -157,18 +191,19 @@ func (c *MQ) CreateConfigurationRequest(input *CreateConfigurationRequest) (req
 // See the AWS API reference guide for AmazonMQ's
 // API operation CreateConfiguration for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Returns information about an error.
+// Returned Error Types:
+//
+//   - BadRequestException
+//     Returns information about an error.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   Returns information about an error.
+//   - InternalServerErrorException
+//     Returns information about an error.
 //
-//   * ErrCodeConflictException "ConflictException"
-//   Returns information about an error.
+//   - ConflictException
+//     Returns information about an error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   Returns information about an error.
+//   - ForbiddenException
+//     Returns information about an error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/CreateConfiguration
 func (c *MQ) CreateConfiguration(input *CreateConfigurationRequest) (*CreateConfigurationResponse, error) {
@@ -208,14 +243,13 @@ const opCreateTags = "CreateTags"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateTagsRequest method.
+//	req, resp := client.CreateTagsRequest(params)
 //
-//    // Example sending a request using the CreateTagsRequest method.
-//    req, resp := client.CreateTagsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/CreateTags
 func (c *MQ) CreateTagsRequest(input *CreateTagsInput) (req *request.Request, output *CreateTagsOutput) {
@@ -246,18 +280,19 @@ func (c *MQ) CreateTagsRequest(input *CreateTagsInput) (req *request.Request, ou
 // See the AWS API reference guide for AmazonMQ's
 // API operation CreateTags for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Returns information about an error.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     Returns information about an error.
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Returns information about an error.
+//   - BadRequestException
+//     Returns information about an error.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   Returns information about an error.
+//   - InternalServerErrorException
+//     Returns information about an error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   Returns information about an error.
+//   - ForbiddenException
+//     Returns information about an error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/CreateTags
 func (c *MQ) CreateTags(input *CreateTagsInput) (*CreateTagsOutput, error) {
@@ -297,14 +332,13 @@ const opCreateUser = "CreateUser"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateUserRequest method.
+//	req, resp := client.CreateUserRequest(params)
 //
-//    // Example sending a request using the CreateUserRequest method.
-//    req, resp := client.CreateUserRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/CreateUser
 func (c *MQ) CreateUserRequest(input *CreateUserRequest) (req *request.Request, output *CreateUserOutput) {
@@ -328,6 +362,11 @@ func (c *MQ) CreateUserRequest(input *CreateUserRequest) (req *request.Request,
 //
 // Creates an ActiveMQ user.
 //
+// Do not add personally identifiable information (PII) or other confidential
+// or sensitive information in broker usernames. Broker usernames are accessible
+// to other Amazon Web Services services, including CloudWatch Logs. Broker
+// usernames are not intended to be used for private or sensitive data.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -335,21 +374,22 @@ func (c *MQ) CreateUserRequest(input *CreateUserRequest) (req *request.Request,
 // See the AWS API reference guide for AmazonMQ's
 // API operation CreateUser for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Returns information about an error.
+// Returned Error Types:
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Returns information about an error.
+//   - NotFoundException
+//     Returns information about an error.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   Returns information about an error.
+//   - BadRequestException
+//     Returns information about an error.
 //
-//   * ErrCodeConflictException "ConflictException"
-//   Returns information about an error.
+//   - InternalServerErrorException
+//     Returns information about an error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   Returns information about an error.
+//   - ConflictException
+//     Returns information about an error.
+//
+//   - ForbiddenException
+//     Returns information about an error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/CreateUser
 func (c *MQ) CreateUser(input *CreateUserRequest) (*CreateUserOutput, error) {
@@ -389,14 +429,13 @@ const opDeleteBroker = "DeleteBroker"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteBrokerRequest method.
+//	req, resp := client.DeleteBrokerRequest(params)
 //
-//    // Example sending a request using the DeleteBrokerRequest method.
-//    req, resp := client.DeleteBrokerRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/DeleteBroker
 func (c *MQ) DeleteBrokerRequest(input *DeleteBrokerInput) (req *request.Request, output *DeleteBrokerResponse) {
@@ -426,18 +465,19 @@ func (c *MQ) DeleteBrokerRequest(input *DeleteBrokerInput) (req *request.Request
 // See the AWS API reference guide for AmazonMQ's
 // API operation DeleteBroker for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Returns information about an error.
+// Returned Error Types:
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Returns information about an error.
+//   - NotFoundException
+//     Returns information about an error.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   Returns information about an error.
+//   - BadRequestException
+//     Returns information about an error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   Returns information about an error.
+//   - InternalServerErrorException
+//     Returns information about an error.
+//
+//   - ForbiddenException
+//     Returns information about an error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/DeleteBroker
 func (c *MQ) DeleteBroker(input *DeleteBrokerInput) (*DeleteBrokerResponse, error) {
@@ -477,14 +517,13 @@ const opDeleteTags = "DeleteTags"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteTagsRequest method.
+//	req, resp := client.DeleteTagsRequest(params)
 //
-//    // Example sending a request using the DeleteTagsRequest method.
-//    req, resp := client.DeleteTagsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/DeleteTags
 func (c *MQ) DeleteTagsRequest(input *DeleteTagsInput) (req *request.Request, output *DeleteTagsOutput) {
@@ -515,18 +554,19 @@ func (c *MQ) DeleteTagsRequest(input *DeleteTagsInput) (req *request.Request, ou
 // See the AWS API reference guide for AmazonMQ's
 // API operation DeleteTags for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Returns information about an error.
+// Returned Error Types:
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Returns information about an error.
+//   - NotFoundException
+//     Returns information about an error.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   Returns information about an error.
+//   - BadRequestException
+//     Returns information about an error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   Returns information about an error.
+//   - InternalServerErrorException
+//     Returns information about an error.
+//
+//   - ForbiddenException
+//     Returns information about an error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/DeleteTags
 func (c *MQ) DeleteTags(input *DeleteTagsInput) (*DeleteTagsOutput, error) {
@@ -566,14 +606,13 @@ const opDeleteUser = "DeleteUser"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteUserRequest method.
+//	req, resp := client.DeleteUserRequest(params)
 //
-//    // Example sending a request using the DeleteUserRequest method.
-//    req, resp := client.DeleteUserRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/DeleteUser
 func (c *MQ) DeleteUserRequest(input *DeleteUserInput) (req *request.Request, output *DeleteUserOutput) {
@@ -604,18 +643,19 @@ func (c *MQ) DeleteUserRequest(input *DeleteUserInput) (req *request.Request, ou
 // See the AWS API reference guide for AmazonMQ's
 // API operation DeleteUser for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Returns information about an error.
+// Returned Error Types:
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Returns information about an error.
+//   - NotFoundException
+//     Returns information about an error.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   Returns information about an error.
+//   - BadRequestException
+//     Returns information about an error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   Returns information about an error.
+//   - InternalServerErrorException
+//     Returns information about an error.
+//
+//   - ForbiddenException
+//     Returns information about an error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/DeleteUser
 func (c *MQ) DeleteUser(input *DeleteUserInput) (*DeleteUserOutput, error) {
@@ -655,14 +695,13 @@ const opDescribeBroker = "DescribeBroker"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeBrokerRequest method.
+//	req, resp := client.DescribeBrokerRequest(params)
 //
-//    // Example sending a request using the DescribeBrokerRequest method.
-//    req, resp := client.DescribeBrokerRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/DescribeBroker
 func (c *MQ) DescribeBrokerRequest(input *DescribeBrokerInput) (req *request.Request, output *DescribeBrokerResponse) {
@@ -692,18 +731,19 @@ func (c *MQ) DescribeBrokerRequest(input *DescribeBrokerInput) (req *request.Req
 // See the AWS API reference guide for AmazonMQ's
 // API operation DescribeBroker for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Returns information about an error.
+// Returned Error Types:
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Returns information about an error.
+//   - NotFoundException
+//     Returns information about an error.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   Returns information about an error.
+//   - BadRequestException
+//     Returns information about an error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   Returns information about an error.
+//   - InternalServerErrorException
+//     Returns information about an error.
+//
+//   - ForbiddenException
+//     Returns information about an error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/DescribeBroker
 func (c *MQ) DescribeBroker(input *DescribeBrokerInput) (*DescribeBrokerResponse, error) {
@@ -743,14 +783,13 @@ const opDescribeBrokerEngineTypes = "DescribeBrokerEngineTypes"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeBrokerEngineTypesRequest method.
+//	req, resp := client.DescribeBrokerEngineTypesRequest(params)
 //
-//    // Example sending a request using the DescribeBrokerEngineTypesRequest method.
-//    req, resp := client.DescribeBrokerEngineTypesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/DescribeBrokerEngineTypes
 func (c *MQ) DescribeBrokerEngineTypesRequest(input *DescribeBrokerEngineTypesInput) (req *request.Request, output *DescribeBrokerEngineTypesOutput) {
@@ -780,15 +819,16 @@ func (c *MQ) DescribeBrokerEngineTypesRequest(input *DescribeBrokerEngineTypesIn
 // See the AWS API reference guide for AmazonMQ's
 // API operation DescribeBrokerEngineTypes for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Returns information about an error.
+// Returned Error Types:
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   Returns information about an error.
+//   - BadRequestException
+//     Returns information about an error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   Returns information about an error.
+//   - InternalServerErrorException
+//     Returns information about an error.
+//
+//   - ForbiddenException
+//     Returns information about an error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/DescribeBrokerEngineTypes
 func (c *MQ) DescribeBrokerEngineTypes(input *DescribeBrokerEngineTypesInput) (*DescribeBrokerEngineTypesOutput, error) {
@@ -828,14 +868,13 @@ const opDescribeBrokerInstanceOptions = "DescribeBrokerInstanceOptions"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeBrokerInstanceOptionsRequest method.
+//	req, resp := client.DescribeBrokerInstanceOptionsRequest(params)
 //
-//    // Example sending a request using the DescribeBrokerInstanceOptionsRequest method.
-//    req, resp := client.DescribeBrokerInstanceOptionsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/DescribeBrokerInstanceOptions
 func (c *MQ) DescribeBrokerInstanceOptionsRequest(input *DescribeBrokerInstanceOptionsInput) (req *request.Request, output *DescribeBrokerInstanceOptionsOutput) {
@@ -865,15 +904,16 @@ func (c *MQ) DescribeBrokerInstanceOptionsRequest(input *DescribeBrokerInstanceO
 // See the AWS API reference guide for AmazonMQ's
 // API operation DescribeBrokerInstanceOptions for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Returns information about an error.
+// Returned Error Types:
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   Returns information about an error.
+//   - BadRequestException
+//     Returns information about an error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   Returns information about an error.
+//   - InternalServerErrorException
+//     Returns information about an error.
+//
+//   - ForbiddenException
+//     Returns information about an error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/DescribeBrokerInstanceOptions
 func (c *MQ) DescribeBrokerInstanceOptions(input *DescribeBrokerInstanceOptionsInput) (*DescribeBrokerInstanceOptionsOutput, error) {
@@ -913,14 +953,13 @@ const opDescribeConfiguration = "DescribeConfiguration"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeConfigurationRequest method.
+//	req, resp := client.DescribeConfigurationRequest(params)
 //
-//    // Example sending a request using the DescribeConfigurationRequest method.
-//    req, resp := client.DescribeConfigurationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/DescribeConfiguration
 func (c *MQ) DescribeConfigurationRequest(input *DescribeConfigurationInput) (req *request.Request, output *DescribeConfigurationOutput) {
@@ -950,18 +989,19 @@ func (c *MQ) DescribeConfigurationRequest(input *DescribeConfigurationInput) (re
 // See the AWS API reference guide for AmazonMQ's
 // API operation DescribeConfiguration for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Returns information about an error.
+// Returned Error Types:
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Returns information about an error.
+//   - NotFoundException
+//     Returns information about an error.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   Returns information about an error.
+//   - BadRequestException
+//     Returns information about an error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   Returns information about an error.
+//   - InternalServerErrorException
+//     Returns information about an error.
+//
+//   - ForbiddenException
+//     Returns information about an error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/DescribeConfiguration
 func (c *MQ) DescribeConfiguration(input *DescribeConfigurationInput) (*DescribeConfigurationOutput, error) {
@@ -1001,14 +1041,13 @@ const opDescribeConfigurationRevision = "DescribeConfigurationRevision"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeConfigurationRevisionRequest method.
+//	req, resp := client.DescribeConfigurationRevisionRequest(params)
 //
-//    // Example sending a request using the DescribeConfigurationRevisionRequest method.
-//    req, resp := client.DescribeConfigurationRevisionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/DescribeConfigurationRevision
 func (c *MQ) DescribeConfigurationRevisionRequest(input *DescribeConfigurationRevisionInput) (req *request.Request, output *DescribeConfigurationRevisionResponse) {
@@ -1038,18 +1077,19 @@ func (c *MQ) DescribeConfigurationRevisionRequest(input *DescribeConfigurationRe
 // See the AWS API reference guide for AmazonMQ's
 // API operation DescribeConfigurationRevision for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Returns information about an error.
+// Returned Error Types:
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Returns information about an error.
+//   - NotFoundException
+//     Returns information about an error.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   Returns information about an error.
+//   - BadRequestException
+//     Returns information about an error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   Returns information about an error.
+//   - InternalServerErrorException
+//     Returns information about an error.
+//
+//   - ForbiddenException
+//     Returns information about an error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/DescribeConfigurationRevision
 func (c *MQ) DescribeConfigurationRevision(input *DescribeConfigurationRevisionInput) (*DescribeConfigurationRevisionResponse, error) {
@@ -1089,14 +1129,13 @@ const opDescribeUser = "DescribeUser"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeUserRequest method.
+//	req, resp := client.DescribeUserRequest(params)
 //
-//    // Example sending a request using the DescribeUserRequest method.
-//    req, resp := client.DescribeUserRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/DescribeUser
 func (c *MQ) DescribeUserRequest(input *DescribeUserInput) (req *request.Request, output *DescribeUserResponse) {
@@ -1126,18 +1165,19 @@ func (c *MQ) DescribeUserRequest(input *DescribeUserInput) (req *request.Request
 // See the AWS API reference guide for AmazonMQ's
 // API operation DescribeUser for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Returns information about an error.
+// Returned Error Types:
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Returns information about an error.
+//   - NotFoundException
+//     Returns information about an error.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   Returns information about an error.
+//   - BadRequestException
+//     Returns information about an error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   Returns information about an error.
+//   - InternalServerErrorException
+//     Returns information about an error.
+//
+//   - ForbiddenException
+//     Returns information about an error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/DescribeUser
 func (c *MQ) DescribeUser(input *DescribeUserInput) (*DescribeUserResponse, error) {
@@ -1177,14 +1217,13 @@ const opListBrokers = "ListBrokers"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListBrokersRequest method.
+//	req, resp := client.ListBrokersRequest(params)
 //
-//    // Example sending a request using the ListBrokersRequest method.
-//    req, resp := client.ListBrokersRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/ListBrokers
 func (c *MQ) ListBrokersRequest(input *ListBrokersInput) (req *request.Request, output *ListBrokersResponse) {
@@ -1192,6 +1231,12 @@ func (c *MQ) ListBrokersRequest(input *ListBrokersInput) (req *request.Request,
 		Name:       opListBrokers,
 		HTTPMethod: "GET",
 		HTTPPath:   "/v1/brokers",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "MaxResults",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
@@ -1214,15 +1259,16 @@ func (c *MQ) ListBrokersRequest(input *ListBrokersInput) (req *request.Request,
 // See the AWS API reference guide for AmazonMQ's
 // API operation ListBrokers for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Returns information about an error.
+// Returned Error Types:
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   Returns information about an error.
+//   - BadRequestException
+//     Returns information about an error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   Returns information about an error.
+//   - InternalServerErrorException
+//     Returns information about an error.
+//
+//   - ForbiddenException
+//     Returns information about an error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/ListBrokers
 func (c *MQ) ListBrokers(input *ListBrokersInput) (*ListBrokersResponse, error) {
@@ -1246,6 +1292,57 @@ func (c *MQ) ListBrokersWithContext(ctx aws.Context, input *ListBrokersInput, op
 	return out, req.Send()
 }
 
+// ListBrokersPages iterates over the pages of a ListBrokers operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListBrokers method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListBrokers operation.
+//	pageNum := 0
+//	err := client.ListBrokersPages(params,
+//	    func(page *mq.ListBrokersResponse, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *MQ) ListBrokersPages(input *ListBrokersInput, fn func(*ListBrokersResponse, bool) bool) error {
+	return c.ListBrokersPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListBrokersPagesWithContext same as ListBrokersPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *MQ) ListBrokersPagesWithContext(ctx aws.Context, input *ListBrokersInput, fn func(*ListBrokersResponse, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListBrokersInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListBrokersRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListBrokersResponse), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
 const opListConfigurationRevisions = "ListConfigurationRevisions"
 
 // ListConfigurationRevisionsRequest generates a "aws/request.Request" representing the
@@ -1262,14 +1359,13 @@ const opListConfigurationRevisions = "ListConfigurationRevisions"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListConfigurationRevisionsRequest method.
+//	req, resp := client.ListConfigurationRevisionsRequest(params)
 //
-//    // Example sending a request using the ListConfigurationRevisionsRequest method.
-//    req, resp := client.ListConfigurationRevisionsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/ListConfigurationRevisions
 func (c *MQ) ListConfigurationRevisionsRequest(input *ListConfigurationRevisionsInput) (req *request.Request, output *ListConfigurationRevisionsResponse) {
@@ -1299,18 +1395,19 @@ func (c *MQ) ListConfigurationRevisionsRequest(input *ListConfigurationRevisions
 // See the AWS API reference guide for AmazonMQ's
 // API operation ListConfigurationRevisions for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Returns information about an error.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     Returns information about an error.
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Returns information about an error.
+//   - BadRequestException
+//     Returns information about an error.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   Returns information about an error.
+//   - InternalServerErrorException
+//     Returns information about an error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   Returns information about an error.
+//   - ForbiddenException
+//     Returns information about an error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/ListConfigurationRevisions
 func (c *MQ) ListConfigurationRevisions(input *ListConfigurationRevisionsInput) (*ListConfigurationRevisionsResponse, error) {
@@ -1350,14 +1447,13 @@ const opListConfigurations = "ListConfigurations"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListConfigurationsRequest method.
+//	req, resp := client.ListConfigurationsRequest(params)
 //
-//    // Example sending a request using the ListConfigurationsRequest method.
-//    req, resp := client.ListConfigurationsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/ListConfigurations
 func (c *MQ) ListConfigurationsRequest(input *ListConfigurationsInput) (req *request.Request, output *ListConfigurationsResponse) {
@@ -1387,15 +1483,16 @@ func (c *MQ) ListConfigurationsRequest(input *ListConfigurationsInput) (req *req
 // See the AWS API reference guide for AmazonMQ's
 // API operation ListConfigurations for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Returns information about an error.
+// Returned Error Types:
+//
+//   - BadRequestException
+//     Returns information about an error.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   Returns information about an error.
+//   - InternalServerErrorException
+//     Returns information about an error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   Returns information about an error.
+//   - ForbiddenException
+//     Returns information about an error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/ListConfigurations
 func (c *MQ) ListConfigurations(input *ListConfigurationsInput) (*ListConfigurationsResponse, error) {
@@ -1435,14 +1532,13 @@ const opListTags = "ListTags"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTagsRequest method.
+//	req, resp := client.ListTagsRequest(params)
 //
-//    // Example sending a request using the ListTagsRequest method.
-//    req, resp := client.ListTagsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/ListTags
 func (c *MQ) ListTagsRequest(input *ListTagsInput) (req *request.Request, output *ListTagsOutput) {
@@ -1472,18 +1568,19 @@ func (c *MQ) ListTagsRequest(input *ListTagsInput) (req *request.Request, output
 // See the AWS API reference guide for AmazonMQ's
 // API operation ListTags for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Returns information about an error.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     Returns information about an error.
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Returns information about an error.
+//   - BadRequestException
+//     Returns information about an error.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   Returns information about an error.
+//   - InternalServerErrorException
+//     Returns information about an error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   Returns information about an error.
+//   - ForbiddenException
+//     Returns information about an error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/ListTags
 func (c *MQ) ListTags(input *ListTagsInput) (*ListTagsOutput, error) {
@@ -1523,14 +1620,13 @@ const opListUsers = "ListUsers"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListUsersRequest method.
+//	req, resp := client.ListUsersRequest(params)
 //
-//    // Example sending a request using the ListUsersRequest method.
-//    req, resp := client.ListUsersRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/ListUsers
 func (c *MQ) ListUsersRequest(input *ListUsersInput) (req *request.Request, output *ListUsersResponse) {
@@ -1560,18 +1656,19 @@ func (c *MQ) ListUsersRequest(input *ListUsersInput) (req *request.Request, outp
 // See the AWS API reference guide for AmazonMQ's
 // API operation ListUsers for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Returns information about an error.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     Returns information about an error.
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Returns information about an error.
+//   - BadRequestException
+//     Returns information about an error.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   Returns information about an error.
+//   - InternalServerErrorException
+//     Returns information about an error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   Returns information about an error.
+//   - ForbiddenException
+//     Returns information about an error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/ListUsers
 func (c *MQ) ListUsers(input *ListUsersInput) (*ListUsersResponse, error) {
@@ -1595,6 +1692,94 @@ func (c *MQ) ListUsersWithContext(ctx aws.Context, input *ListUsersInput, opts .
 	return out, req.Send()
 }
 
+const opPromote = "Promote"
+
+// PromoteRequest generates a "aws/request.Request" representing the
+// client's request for the Promote operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See Promote for more information on using the Promote
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the PromoteRequest method.
+//	req, resp := client.PromoteRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/Promote
+func (c *MQ) PromoteRequest(input *PromoteInput) (req *request.Request, output *PromoteOutput) {
+	op := &request.Operation{
+		Name:       opPromote,
+		HTTPMethod: "POST",
+		HTTPPath:   "/v1/brokers/{broker-id}/promote",
+	}
+
+	if input == nil {
+		input = &PromoteInput{}
+	}
+
+	output = &PromoteOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// Promote API operation for AmazonMQ.
+//
+// Promotes a data replication replica broker to the primary broker role.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AmazonMQ's
+// API operation Promote for usage and error information.
+//
+// Returned Error Types:
+//
+//   - NotFoundException
+//     Returns information about an error.
+//
+//   - BadRequestException
+//     Returns information about an error.
+//
+//   - InternalServerErrorException
+//     Returns information about an error.
+//
+//   - ForbiddenException
+//     Returns information about an error.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/Promote
+func (c *MQ) Promote(input *PromoteInput) (*PromoteOutput, error) {
+	req, out := c.PromoteRequest(input)
+	return out, req.Send()
+}
+
+// PromoteWithContext is the same as Promote with the addition of
+// the ability to pass a context and additional request options.
+//
+// See Promote for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *MQ) PromoteWithContext(ctx aws.Context, input *PromoteInput, opts ...request.Option) (*PromoteOutput, error) {
+	req, out := c.PromoteRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opRebootBroker = "RebootBroker"
 
 // RebootBrokerRequest generates a "aws/request.Request" representing the
@@ -1611,14 +1796,13 @@ const opRebootBroker = "RebootBroker"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the RebootBrokerRequest method.
+//	req, resp := client.RebootBrokerRequest(params)
 //
-//    // Example sending a request using the RebootBrokerRequest method.
-//    req, resp := client.RebootBrokerRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/RebootBroker
 func (c *MQ) RebootBrokerRequest(input *RebootBrokerInput) (req *request.Request, output *RebootBrokerOutput) {
@@ -1649,18 +1833,19 @@ func (c *MQ) RebootBrokerRequest(input *RebootBrokerInput) (req *request.Request
 // See the AWS API reference guide for AmazonMQ's
 // API operation RebootBroker for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Returns information about an error.
+// Returned Error Types:
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Returns information about an error.
+//   - NotFoundException
+//     Returns information about an error.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   Returns information about an error.
+//   - BadRequestException
+//     Returns information about an error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   Returns information about an error.
+//   - InternalServerErrorException
+//     Returns information about an error.
+//
+//   - ForbiddenException
+//     Returns information about an error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/RebootBroker
 func (c *MQ) RebootBroker(input *RebootBrokerInput) (*RebootBrokerOutput, error) {
@@ -1700,14 +1885,13 @@ const opUpdateBroker = "UpdateBroker"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateBrokerRequest method.
+//	req, resp := client.UpdateBrokerRequest(params)
 //
-//    // Example sending a request using the UpdateBrokerRequest method.
-//    req, resp := client.UpdateBrokerRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/UpdateBroker
 func (c *MQ) UpdateBrokerRequest(input *UpdateBrokerRequest) (req *request.Request, output *UpdateBrokerResponse) {
@@ -1737,21 +1921,22 @@ func (c *MQ) UpdateBrokerRequest(input *UpdateBrokerRequest) (req *request.Reque
 // See the AWS API reference guide for AmazonMQ's
 // API operation UpdateBroker for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Returns information about an error.
+// Returned Error Types:
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Returns information about an error.
+//   - NotFoundException
+//     Returns information about an error.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   Returns information about an error.
+//   - BadRequestException
+//     Returns information about an error.
 //
-//   * ErrCodeConflictException "ConflictException"
-//   Returns information about an error.
+//   - InternalServerErrorException
+//     Returns information about an error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   Returns information about an error.
+//   - ConflictException
+//     Returns information about an error.
+//
+//   - ForbiddenException
+//     Returns information about an error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/UpdateBroker
 func (c *MQ) UpdateBroker(input *UpdateBrokerRequest) (*UpdateBrokerResponse, error) {
@@ -1791,14 +1976,13 @@ const opUpdateConfiguration = "UpdateConfiguration"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateConfigurationRequest method.
+//	req, resp := client.UpdateConfigurationRequest(params)
 //
-//    // Example sending a request using the UpdateConfigurationRequest method.
-//    req, resp := client.UpdateConfigurationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/UpdateConfiguration
 func (c *MQ) UpdateConfigurationRequest(input *UpdateConfigurationRequest) (req *request.Request, output *UpdateConfigurationResponse) {
@@ -1828,21 +2012,22 @@ func (c *MQ) UpdateConfigurationRequest(input *UpdateConfigurationRequest) (req
 // See the AWS API reference guide for AmazonMQ's
 // API operation UpdateConfiguration for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Returns information about an error.
+// Returned Error Types:
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Returns information about an error.
+//   - NotFoundException
+//     Returns information about an error.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   Returns information about an error.
+//   - BadRequestException
+//     Returns information about an error.
 //
-//   * ErrCodeConflictException "ConflictException"
-//   Returns information about an error.
+//   - InternalServerErrorException
+//     Returns information about an error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   Returns information about an error.
+//   - ConflictException
+//     Returns information about an error.
+//
+//   - ForbiddenException
+//     Returns information about an error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/UpdateConfiguration
 func (c *MQ) UpdateConfiguration(input *UpdateConfigurationRequest) (*UpdateConfigurationResponse, error) {
@@ -1882,14 +2067,13 @@ const opUpdateUser = "UpdateUser"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateUserRequest method.
+//	req, resp := client.UpdateUserRequest(params)
 //
-//    // Example sending a request using the UpdateUserRequest method.
-//    req, resp := client.UpdateUserRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/UpdateUser
 func (c *MQ) UpdateUserRequest(input *UpdateUserRequest) (req *request.Request, output *UpdateUserOutput) {
@@ -1920,21 +2104,22 @@ func (c *MQ) UpdateUserRequest(input *UpdateUserRequest) (req *request.Request,
 // See the AWS API reference guide for AmazonMQ's
 // API operation UpdateUser for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   Returns information about an error.
+// Returned Error Types:
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   Returns information about an error.
+//   - NotFoundException
+//     Returns information about an error.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   Returns information about an error.
+//   - BadRequestException
+//     Returns information about an error.
 //
-//   * ErrCodeConflictException "ConflictException"
-//   Returns information about an error.
+//   - InternalServerErrorException
+//     Returns information about an error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   Returns information about an error.
+//   - ConflictException
+//     Returns information about an error.
+//
+//   - ForbiddenException
+//     Returns information about an error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/mq-2017-11-27/UpdateUser
 func (c *MQ) UpdateUser(input *UpdateUserRequest) (*UpdateUserOutput, error) {
@@ -1958,6 +2143,48 @@ func (c *MQ) UpdateUserWithContext(ctx aws.Context, input *UpdateUserRequest, op
 	return out, req.Send()
 }
 
+// Action required for a broker.
+type ActionRequired struct {
+	_ struct{} `type:"structure"`
+
+	// The code you can use to find instructions on the action required to resolve
+	// your broker issue.
+	ActionRequiredCode *string `locationName:"actionRequiredCode" type:"string"`
+
+	// Information about the action required to resolve your broker issue.
+	ActionRequiredInfo *string `locationName:"actionRequiredInfo" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionRequired) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActionRequired) GoString() string {
+	return s.String()
+}
+
+// SetActionRequiredCode sets the ActionRequiredCode field's value.
+func (s *ActionRequired) SetActionRequiredCode(v string) *ActionRequired {
+	s.ActionRequiredCode = &v
+	return s
+}
+
+// SetActionRequiredInfo sets the ActionRequiredInfo field's value.
+func (s *ActionRequired) SetActionRequiredInfo(v string) *ActionRequired {
+	s.ActionRequiredInfo = &v
+	return s
+}
+
 // Name of the availability zone.
 type AvailabilityZone struct {
 	_ struct{} `type:"structure"`
@@ -1966,12 +2193,20 @@ type AvailabilityZone struct {
 	Name *string `locationName:"name" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AvailabilityZone) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AvailabilityZone) GoString() string {
 	return s.String()
 }
@@ -1982,23 +2217,97 @@ func (s *AvailabilityZone) SetName(v string) *AvailabilityZone {
 	return s
 }
 
+// Returns information about an error.
+type BadRequestException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	ErrorAttribute *string `locationName:"errorAttribute" type:"string"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BadRequestException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BadRequestException) GoString() string {
+	return s.String()
+}
+
+func newErrorBadRequestException(v protocol.ResponseMetadata) error {
+	return &BadRequestException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *BadRequestException) Code() string {
+	return "BadRequestException"
+}
+
+// Message returns the exception's message.
+func (s *BadRequestException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *BadRequestException) OrigErr() error {
+	return nil
+}
+
+func (s *BadRequestException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *BadRequestException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *BadRequestException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Types of broker engines.
 type BrokerEngineType struct {
 	_ struct{} `type:"structure"`
 
-	// The type of broker engine.
+	// The broker's engine type.
 	EngineType *string `locationName:"engineType" type:"string" enum:"EngineType"`
 
 	// The list of engine versions.
 	EngineVersions []*EngineVersion `locationName:"engineVersions" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BrokerEngineType) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BrokerEngineType) GoString() string {
 	return s.String()
 }
@@ -2019,22 +2328,31 @@ func (s *BrokerEngineType) SetEngineVersions(v []*EngineVersion) *BrokerEngineTy
 type BrokerInstance struct {
 	_ struct{} `type:"structure"`
 
-	// The URL of the broker's ActiveMQ Web Console.
+	// The brokers web console URL.
 	ConsoleURL *string `locationName:"consoleURL" type:"string"`
 
 	// The broker's wire-level protocol endpoints.
 	Endpoints []*string `locationName:"endpoints" type:"list"`
 
 	// The IP address of the Elastic Network Interface (ENI) attached to the broker.
+	// Does not apply to RabbitMQ brokers.
 	IpAddress *string `locationName:"ipAddress" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BrokerInstance) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BrokerInstance) GoString() string {
 	return s.String()
 }
@@ -2064,22 +2382,36 @@ type BrokerInstanceOption struct {
 	// The list of available az.
 	AvailabilityZones []*AvailabilityZone `locationName:"availabilityZones" type:"list"`
 
-	// The type of broker engine.
+	// The broker's engine type.
 	EngineType *string `locationName:"engineType" type:"string" enum:"EngineType"`
 
-	// The type of broker instance.
+	// The broker's instance type.
 	HostInstanceType *string `locationName:"hostInstanceType" type:"string"`
 
+	// The broker's storage type.
+	StorageType *string `locationName:"storageType" type:"string" enum:"BrokerStorageType"`
+
+	// The list of supported deployment modes.
+	SupportedDeploymentModes []*string `locationName:"supportedDeploymentModes" type:"list" enum:"DeploymentMode"`
+
 	// The list of supported engine versions.
 	SupportedEngineVersions []*string `locationName:"supportedEngineVersions" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BrokerInstanceOption) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BrokerInstanceOption) GoString() string {
 	return s.String()
 }
@@ -2102,47 +2434,74 @@ func (s *BrokerInstanceOption) SetHostInstanceType(v string) *BrokerInstanceOpti
 	return s
 }
 
+// SetStorageType sets the StorageType field's value.
+func (s *BrokerInstanceOption) SetStorageType(v string) *BrokerInstanceOption {
+	s.StorageType = &v
+	return s
+}
+
+// SetSupportedDeploymentModes sets the SupportedDeploymentModes field's value.
+func (s *BrokerInstanceOption) SetSupportedDeploymentModes(v []*string) *BrokerInstanceOption {
+	s.SupportedDeploymentModes = v
+	return s
+}
+
 // SetSupportedEngineVersions sets the SupportedEngineVersions field's value.
 func (s *BrokerInstanceOption) SetSupportedEngineVersions(v []*string) *BrokerInstanceOption {
 	s.SupportedEngineVersions = v
 	return s
 }
 
-// The Amazon Resource Name (ARN) of the broker.
+// Returns information about all brokers.
 type BrokerSummary struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the broker.
+	// The broker's Amazon Resource Name (ARN).
 	BrokerArn *string `locationName:"brokerArn" type:"string"`
 
 	// The unique ID that Amazon MQ generates for the broker.
 	BrokerId *string `locationName:"brokerId" type:"string"`
 
-	// The name of the broker. This value must be unique in your AWS account, 1-50
-	// characters long, must contain only letters, numbers, dashes, and underscores,
-	// and must not contain whitespaces, brackets, wildcard characters, or special
+	// The broker's name. This value is unique in your Amazon Web Services account,
+	// 1-50 characters long, and containing only letters, numbers, dashes, and underscores,
+	// and must not contain white spaces, brackets, wildcard characters, or special
 	// characters.
 	BrokerName *string `locationName:"brokerName" type:"string"`
 
-	// The status of the broker.
+	// The broker's status.
 	BrokerState *string `locationName:"brokerState" type:"string" enum:"BrokerState"`
 
 	// The time when the broker was created.
 	Created *time.Time `locationName:"created" type:"timestamp" timestampFormat:"iso8601"`
 
-	// Required. The deployment mode of the broker.
-	DeploymentMode *string `locationName:"deploymentMode" type:"string" enum:"DeploymentMode"`
+	// The broker's deployment mode.
+	//
+	// DeploymentMode is a required field
+	DeploymentMode *string `locationName:"deploymentMode" type:"string" required:"true" enum:"DeploymentMode"`
+
+	// The type of broker engine.
+	//
+	// EngineType is a required field
+	EngineType *string `locationName:"engineType" type:"string" required:"true" enum:"EngineType"`
 
 	// The broker's instance type.
 	HostInstanceType *string `locationName:"hostInstanceType" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BrokerSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BrokerSummary) GoString() string {
 	return s.String()
 }
@@ -2183,6 +2542,12 @@ func (s *BrokerSummary) SetDeploymentMode(v string) *BrokerSummary {
 	return s
 }
 
+// SetEngineType sets the EngineType field's value.
+func (s *BrokerSummary) SetEngineType(v string) *BrokerSummary {
+	s.EngineType = &v
+	return s
+}
+
 // SetHostInstanceType sets the HostInstanceType field's value.
 func (s *BrokerSummary) SetHostInstanceType(v string) *BrokerSummary {
 	s.HostInstanceType = &v
@@ -2194,43 +2559,73 @@ type Configuration struct {
 	_ struct{} `type:"structure"`
 
 	// Required. The ARN of the configuration.
-	Arn *string `locationName:"arn" type:"string"`
+	//
+	// Arn is a required field
+	Arn *string `locationName:"arn" type:"string" required:"true"`
+
+	// Optional. The authentication strategy associated with the configuration.
+	// The default is SIMPLE.
+	//
+	// AuthenticationStrategy is a required field
+	AuthenticationStrategy *string `locationName:"authenticationStrategy" type:"string" required:"true" enum:"AuthenticationStrategy"`
 
 	// Required. The date and time of the configuration revision.
-	Created *time.Time `locationName:"created" type:"timestamp" timestampFormat:"iso8601"`
+	//
+	// Created is a required field
+	Created *time.Time `locationName:"created" type:"timestamp" timestampFormat:"iso8601" required:"true"`
 
 	// Required. The description of the configuration.
-	Description *string `locationName:"description" type:"string"`
+	//
+	// Description is a required field
+	Description *string `locationName:"description" type:"string" required:"true"`
 
-	// Required. The type of broker engine. Note: Currently, Amazon MQ supports
-	// only ACTIVEMQ.
-	EngineType *string `locationName:"engineType" type:"string" enum:"EngineType"`
+	// Required. The type of broker engine. Currently, Amazon MQ supports ACTIVEMQ
+	// and RABBITMQ.
+	//
+	// EngineType is a required field
+	EngineType *string `locationName:"engineType" type:"string" required:"true" enum:"EngineType"`
 
-	// Required. The version of the broker engine. For a list of supported engine
-	// versions, see https://docs.aws.amazon.com/amazon-mq/latest/developer-guide/broker-engine.html
-	EngineVersion *string `locationName:"engineVersion" type:"string"`
+	// Required. The broker engine's version. For a list of supported engine versions,
+	// see, Supported engines (https://docs.aws.amazon.com//amazon-mq/latest/developer-guide/broker-engine.html).
+	//
+	// EngineVersion is a required field
+	EngineVersion *string `locationName:"engineVersion" type:"string" required:"true"`
 
 	// Required. The unique ID that Amazon MQ generates for the configuration.
-	Id *string `locationName:"id" type:"string"`
+	//
+	// Id is a required field
+	Id *string `locationName:"id" type:"string" required:"true"`
 
 	// Required. The latest revision of the configuration.
-	LatestRevision *ConfigurationRevision `locationName:"latestRevision" type:"structure"`
+	//
+	// LatestRevision is a required field
+	LatestRevision *ConfigurationRevision `locationName:"latestRevision" type:"structure" required:"true"`
 
 	// Required. The name of the configuration. This value can contain only alphanumeric
 	// characters, dashes, periods, underscores, and tildes (- . _ ~). This value
 	// must be 1-150 characters long.
-	Name *string `locationName:"name" type:"string"`
+	//
+	// Name is a required field
+	Name *string `locationName:"name" type:"string" required:"true"`
 
 	// The list of all tags associated with this configuration.
 	Tags map[string]*string `locationName:"tags" type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Configuration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Configuration) GoString() string {
 	return s.String()
 }
@@ -2241,6 +2636,12 @@ func (s *Configuration) SetArn(v string) *Configuration {
 	return s
 }
 
+// SetAuthenticationStrategy sets the AuthenticationStrategy field's value.
+func (s *Configuration) SetAuthenticationStrategy(v string) *Configuration {
+	s.AuthenticationStrategy = &v
+	return s
+}
+
 // SetCreated sets the Created field's value.
 func (s *Configuration) SetCreated(v time.Time) *Configuration {
 	s.Created = &v
@@ -2294,22 +2695,45 @@ type ConfigurationId struct {
 	_ struct{} `type:"structure"`
 
 	// Required. The unique ID that Amazon MQ generates for the configuration.
-	Id *string `locationName:"id" type:"string"`
+	//
+	// Id is a required field
+	Id *string `locationName:"id" type:"string" required:"true"`
 
 	// The revision number of the configuration.
 	Revision *int64 `locationName:"revision" type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ConfigurationId) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ConfigurationId) GoString() string {
 	return s.String()
 }
 
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ConfigurationId) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ConfigurationId"}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *ConfigurationId) SetId(v string) *ConfigurationId {
 	s.Id = &v
@@ -2327,21 +2751,33 @@ type ConfigurationRevision struct {
 	_ struct{} `type:"structure"`
 
 	// Required. The date and time of the configuration revision.
-	Created *time.Time `locationName:"created" type:"timestamp" timestampFormat:"iso8601"`
+	//
+	// Created is a required field
+	Created *time.Time `locationName:"created" type:"timestamp" timestampFormat:"iso8601" required:"true"`
 
 	// The description of the configuration revision.
 	Description *string `locationName:"description" type:"string"`
 
 	// Required. The revision number of the configuration.
-	Revision *int64 `locationName:"revision" type:"integer"`
+	//
+	// Revision is a required field
+	Revision *int64 `locationName:"revision" type:"integer" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ConfigurationRevision) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ConfigurationRevision) GoString() string {
 	return s.String()
 }
@@ -2368,22 +2804,30 @@ func (s *ConfigurationRevision) SetRevision(v int64) *ConfigurationRevision {
 type Configurations struct {
 	_ struct{} `type:"structure"`
 
-	// The current configuration of the broker.
+	// The broker's current configuration.
 	Current *ConfigurationId `locationName:"current" type:"structure"`
 
 	// The history of configurations applied to the broker.
 	History []*ConfigurationId `locationName:"history" type:"list"`
 
-	// The pending configuration of the broker.
+	// The broker's pending configuration.
 	Pending *ConfigurationId `locationName:"pending" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Configurations) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Configurations) GoString() string {
 	return s.String()
 }
@@ -2406,30 +2850,119 @@ func (s *Configurations) SetPending(v *ConfigurationId) *Configurations {
 	return s
 }
 
-type CreateBrokerRequest struct {
-	_ struct{} `type:"structure"`
+// Returns information about an error.
+type ConflictException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	AutoMinorVersionUpgrade *bool `locationName:"autoMinorVersionUpgrade" type:"boolean"`
+	ErrorAttribute *string `locationName:"errorAttribute" type:"string"`
 
-	BrokerName *string `locationName:"brokerName" type:"string"`
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConflictException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConflictException) GoString() string {
+	return s.String()
+}
+
+func newErrorConflictException(v protocol.ResponseMetadata) error {
+	return &ConflictException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ConflictException) Code() string {
+	return "ConflictException"
+}
+
+// Message returns the exception's message.
+func (s *ConflictException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ConflictException) OrigErr() error {
+	return nil
+}
+
+func (s *ConflictException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ConflictException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ConflictException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type CreateBrokerRequest struct {
+	_ struct{} `type:"structure"`
+
+	// Optional. The authentication strategy used to secure the broker. The default
+	// is SIMPLE.
+	AuthenticationStrategy *string `locationName:"authenticationStrategy" type:"string" enum:"AuthenticationStrategy"`
+
+	// AutoMinorVersionUpgrade is a required field
+	AutoMinorVersionUpgrade *bool `locationName:"autoMinorVersionUpgrade" type:"boolean" required:"true"`
+
+	// BrokerName is a required field
+	BrokerName *string `locationName:"brokerName" type:"string" required:"true"`
 
 	// A list of information about the configuration.
 	Configuration *ConfigurationId `locationName:"configuration" type:"structure"`
 
 	CreatorRequestId *string `locationName:"creatorRequestId" type:"string" idempotencyToken:"true"`
 
-	// The deployment mode of the broker.
-	DeploymentMode *string `locationName:"deploymentMode" type:"string" enum:"DeploymentMode"`
+	// Specifies whether a broker is a part of a data replication pair.
+	DataReplicationMode *string `locationName:"dataReplicationMode" type:"string" enum:"DataReplicationMode"`
+
+	DataReplicationPrimaryBrokerArn *string `locationName:"dataReplicationPrimaryBrokerArn" type:"string"`
+
+	// The broker's deployment mode.
+	//
+	// DeploymentMode is a required field
+	DeploymentMode *string `locationName:"deploymentMode" type:"string" required:"true" enum:"DeploymentMode"`
 
 	// Encryption options for the broker.
 	EncryptionOptions *EncryptionOptions `locationName:"encryptionOptions" type:"structure"`
 
-	// The type of broker engine. Note: Currently, Amazon MQ supports only ActiveMQ.
-	EngineType *string `locationName:"engineType" type:"string" enum:"EngineType"`
+	// The type of broker engine. Amazon MQ supports ActiveMQ and RabbitMQ.
+	//
+	// EngineType is a required field
+	EngineType *string `locationName:"engineType" type:"string" required:"true" enum:"EngineType"`
 
-	EngineVersion *string `locationName:"engineVersion" type:"string"`
+	// EngineVersion is a required field
+	EngineVersion *string `locationName:"engineVersion" type:"string" required:"true"`
 
-	HostInstanceType *string `locationName:"hostInstanceType" type:"string"`
+	// HostInstanceType is a required field
+	HostInstanceType *string `locationName:"hostInstanceType" type:"string" required:"true"`
+
+	// Optional. The metadata of the LDAP server used to authenticate and authorize
+	// connections to the broker.
+	//
+	// Does not apply to RabbitMQ brokers.
+	LdapServerMetadata *LdapServerMetadataInput `locationName:"ldapServerMetadata" type:"structure"`
 
 	// The list of information about logs to be enabled for the specified broker.
 	Logs *Logs `locationName:"logs" type:"structure"`
@@ -2438,23 +2971,38 @@ type CreateBrokerRequest struct {
 	// apply pending updates or patches to the broker.
 	MaintenanceWindowStartTime *WeeklyStartTime `locationName:"maintenanceWindowStartTime" type:"structure"`
 
-	PubliclyAccessible *bool `locationName:"publiclyAccessible" type:"boolean"`
+	// PubliclyAccessible is a required field
+	PubliclyAccessible *bool `locationName:"publiclyAccessible" type:"boolean" required:"true"`
 
 	SecurityGroups []*string `locationName:"securityGroups" type:"list"`
 
+	// The broker's storage type.
+	//
+	// EFS is not supported for RabbitMQ engine type.
+	StorageType *string `locationName:"storageType" type:"string" enum:"BrokerStorageType"`
+
 	SubnetIds []*string `locationName:"subnetIds" type:"list"`
 
 	Tags map[string]*string `locationName:"tags" type:"map"`
 
-	Users []*User `locationName:"users" type:"list"`
+	// Users is a required field
+	Users []*User `locationName:"users" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateBrokerRequest) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateBrokerRequest) GoString() string {
 	return s.String()
 }
@@ -2462,11 +3010,60 @@ func (s CreateBrokerRequest) GoString() string {
 // Validate inspects the fields of the type to determine if they are valid.
 func (s *CreateBrokerRequest) Validate() error {
 	invalidParams := request.ErrInvalidParams{Context: "CreateBrokerRequest"}
+	if s.AutoMinorVersionUpgrade == nil {
+		invalidParams.Add(request.NewErrParamRequired("AutoMinorVersionUpgrade"))
+	}
+	if s.BrokerName == nil {
+		invalidParams.Add(request.NewErrParamRequired("BrokerName"))
+	}
+	if s.DeploymentMode == nil {
+		invalidParams.Add(request.NewErrParamRequired("DeploymentMode"))
+	}
+	if s.EngineType == nil {
+		invalidParams.Add(request.NewErrParamRequired("EngineType"))
+	}
+	if s.EngineVersion == nil {
+		invalidParams.Add(request.NewErrParamRequired("EngineVersion"))
+	}
+	if s.HostInstanceType == nil {
+		invalidParams.Add(request.NewErrParamRequired("HostInstanceType"))
+	}
+	if s.PubliclyAccessible == nil {
+		invalidParams.Add(request.NewErrParamRequired("PubliclyAccessible"))
+	}
+	if s.Users == nil {
+		invalidParams.Add(request.NewErrParamRequired("Users"))
+	}
+	if s.Configuration != nil {
+		if err := s.Configuration.Validate(); err != nil {
+			invalidParams.AddNested("Configuration", err.(request.ErrInvalidParams))
+		}
+	}
 	if s.EncryptionOptions != nil {
 		if err := s.EncryptionOptions.Validate(); err != nil {
 			invalidParams.AddNested("EncryptionOptions", err.(request.ErrInvalidParams))
 		}
 	}
+	if s.LdapServerMetadata != nil {
+		if err := s.LdapServerMetadata.Validate(); err != nil {
+			invalidParams.AddNested("LdapServerMetadata", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.MaintenanceWindowStartTime != nil {
+		if err := s.MaintenanceWindowStartTime.Validate(); err != nil {
+			invalidParams.AddNested("MaintenanceWindowStartTime", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Users != nil {
+		for i, v := range s.Users {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Users", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -2474,6 +3071,12 @@ func (s *CreateBrokerRequest) Validate() error {
 	return nil
 }
 
+// SetAuthenticationStrategy sets the AuthenticationStrategy field's value.
+func (s *CreateBrokerRequest) SetAuthenticationStrategy(v string) *CreateBrokerRequest {
+	s.AuthenticationStrategy = &v
+	return s
+}
+
 // SetAutoMinorVersionUpgrade sets the AutoMinorVersionUpgrade field's value.
 func (s *CreateBrokerRequest) SetAutoMinorVersionUpgrade(v bool) *CreateBrokerRequest {
 	s.AutoMinorVersionUpgrade = &v
@@ -2498,6 +3101,18 @@ func (s *CreateBrokerRequest) SetCreatorRequestId(v string) *CreateBrokerRequest
 	return s
 }
 
+// SetDataReplicationMode sets the DataReplicationMode field's value.
+func (s *CreateBrokerRequest) SetDataReplicationMode(v string) *CreateBrokerRequest {
+	s.DataReplicationMode = &v
+	return s
+}
+
+// SetDataReplicationPrimaryBrokerArn sets the DataReplicationPrimaryBrokerArn field's value.
+func (s *CreateBrokerRequest) SetDataReplicationPrimaryBrokerArn(v string) *CreateBrokerRequest {
+	s.DataReplicationPrimaryBrokerArn = &v
+	return s
+}
+
 // SetDeploymentMode sets the DeploymentMode field's value.
 func (s *CreateBrokerRequest) SetDeploymentMode(v string) *CreateBrokerRequest {
 	s.DeploymentMode = &v
@@ -2528,6 +3143,12 @@ func (s *CreateBrokerRequest) SetHostInstanceType(v string) *CreateBrokerRequest
 	return s
 }
 
+// SetLdapServerMetadata sets the LdapServerMetadata field's value.
+func (s *CreateBrokerRequest) SetLdapServerMetadata(v *LdapServerMetadataInput) *CreateBrokerRequest {
+	s.LdapServerMetadata = v
+	return s
+}
+
 // SetLogs sets the Logs field's value.
 func (s *CreateBrokerRequest) SetLogs(v *Logs) *CreateBrokerRequest {
 	s.Logs = v
@@ -2552,6 +3173,12 @@ func (s *CreateBrokerRequest) SetSecurityGroups(v []*string) *CreateBrokerReques
 	return s
 }
 
+// SetStorageType sets the StorageType field's value.
+func (s *CreateBrokerRequest) SetStorageType(v string) *CreateBrokerRequest {
+	s.StorageType = &v
+	return s
+}
+
 // SetSubnetIds sets the SubnetIds field's value.
 func (s *CreateBrokerRequest) SetSubnetIds(v []*string) *CreateBrokerRequest {
 	s.SubnetIds = v
@@ -2578,12 +3205,20 @@ type CreateBrokerResponse struct {
 	BrokerId *string `locationName:"brokerId" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateBrokerResponse) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateBrokerResponse) GoString() string {
 	return s.String()
 }
@@ -2603,26 +3238,67 @@ func (s *CreateBrokerResponse) SetBrokerId(v string) *CreateBrokerResponse {
 type CreateConfigurationRequest struct {
 	_ struct{} `type:"structure"`
 
-	// The type of broker engine. Note: Currently, Amazon MQ supports only ActiveMQ.
-	EngineType *string `locationName:"engineType" type:"string" enum:"EngineType"`
+	// Optional. The authentication strategy used to secure the broker. The default
+	// is SIMPLE.
+	AuthenticationStrategy *string `locationName:"authenticationStrategy" type:"string" enum:"AuthenticationStrategy"`
 
-	EngineVersion *string `locationName:"engineVersion" type:"string"`
+	// The type of broker engine. Amazon MQ supports ActiveMQ and RabbitMQ.
+	//
+	// EngineType is a required field
+	EngineType *string `locationName:"engineType" type:"string" required:"true" enum:"EngineType"`
 
-	Name *string `locationName:"name" type:"string"`
+	// EngineVersion is a required field
+	EngineVersion *string `locationName:"engineVersion" type:"string" required:"true"`
+
+	// Name is a required field
+	Name *string `locationName:"name" type:"string" required:"true"`
 
 	Tags map[string]*string `locationName:"tags" type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateConfigurationRequest) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateConfigurationRequest) GoString() string {
 	return s.String()
 }
 
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateConfigurationRequest) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateConfigurationRequest"}
+	if s.EngineType == nil {
+		invalidParams.Add(request.NewErrParamRequired("EngineType"))
+	}
+	if s.EngineVersion == nil {
+		invalidParams.Add(request.NewErrParamRequired("EngineVersion"))
+	}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAuthenticationStrategy sets the AuthenticationStrategy field's value.
+func (s *CreateConfigurationRequest) SetAuthenticationStrategy(v string) *CreateConfigurationRequest {
+	s.AuthenticationStrategy = &v
+	return s
+}
+
 // SetEngineType sets the EngineType field's value.
 func (s *CreateConfigurationRequest) SetEngineType(v string) *CreateConfigurationRequest {
 	s.EngineType = &v
@@ -2652,6 +3328,10 @@ type CreateConfigurationResponse struct {
 
 	Arn *string `locationName:"arn" type:"string"`
 
+	// Optional. The authentication strategy used to secure the broker. The default
+	// is SIMPLE.
+	AuthenticationStrategy *string `locationName:"authenticationStrategy" type:"string" enum:"AuthenticationStrategy"`
+
 	Created *time.Time `locationName:"created" type:"timestamp" timestampFormat:"iso8601"`
 
 	Id *string `locationName:"id" type:"string"`
@@ -2662,12 +3342,20 @@ type CreateConfigurationResponse struct {
 	Name *string `locationName:"name" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateConfigurationResponse) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateConfigurationResponse) GoString() string {
 	return s.String()
 }
@@ -2678,6 +3366,12 @@ func (s *CreateConfigurationResponse) SetArn(v string) *CreateConfigurationRespo
 	return s
 }
 
+// SetAuthenticationStrategy sets the AuthenticationStrategy field's value.
+func (s *CreateConfigurationResponse) SetAuthenticationStrategy(v string) *CreateConfigurationResponse {
+	s.AuthenticationStrategy = &v
+	return s
+}
+
 // SetCreated sets the Created field's value.
 func (s *CreateConfigurationResponse) SetCreated(v time.Time) *CreateConfigurationResponse {
 	s.Created = &v
@@ -2711,12 +3405,20 @@ type CreateTagsInput struct {
 	Tags map[string]*string `locationName:"tags" type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateTagsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateTagsInput) GoString() string {
 	return s.String()
 }
@@ -2753,12 +3455,20 @@ type CreateTagsOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateTagsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateTagsOutput) GoString() string {
 	return s.String()
 }
@@ -2767,12 +3477,20 @@ type CreateUserOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateUserOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateUserOutput) GoString() string {
 	return s.String()
 }
@@ -2787,18 +3505,29 @@ type CreateUserRequest struct {
 
 	Groups []*string `locationName:"groups" type:"list"`
 
-	Password *string `locationName:"password" type:"string"`
+	// Password is a required field
+	Password *string `locationName:"password" type:"string" required:"true"`
+
+	ReplicationUser *bool `locationName:"replicationUser" type:"boolean"`
 
 	// Username is a required field
 	Username *string `location:"uri" locationName:"username" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateUserRequest) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateUserRequest) GoString() string {
 	return s.String()
 }
@@ -2812,6 +3541,9 @@ func (s *CreateUserRequest) Validate() error {
 	if s.BrokerId != nil && len(*s.BrokerId) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("BrokerId", 1))
 	}
+	if s.Password == nil {
+		invalidParams.Add(request.NewErrParamRequired("Password"))
+	}
 	if s.Username == nil {
 		invalidParams.Add(request.NewErrParamRequired("Username"))
 	}
@@ -2849,25 +3581,130 @@ func (s *CreateUserRequest) SetPassword(v string) *CreateUserRequest {
 	return s
 }
 
+// SetReplicationUser sets the ReplicationUser field's value.
+func (s *CreateUserRequest) SetReplicationUser(v bool) *CreateUserRequest {
+	s.ReplicationUser = &v
+	return s
+}
+
 // SetUsername sets the Username field's value.
 func (s *CreateUserRequest) SetUsername(v string) *CreateUserRequest {
 	s.Username = &v
 	return s
 }
 
-type DeleteBrokerInput struct {
+// Specifies a broker in a data replication pair.
+type DataReplicationCounterpart struct {
+	_ struct{} `type:"structure"`
+
+	// Required. The unique broker id generated by Amazon MQ.
+	//
+	// BrokerId is a required field
+	BrokerId *string `locationName:"brokerId" type:"string" required:"true"`
+
+	// Required. The region of the broker.
+	//
+	// Region is a required field
+	Region *string `locationName:"region" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DataReplicationCounterpart) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DataReplicationCounterpart) GoString() string {
+	return s.String()
+}
+
+// SetBrokerId sets the BrokerId field's value.
+func (s *DataReplicationCounterpart) SetBrokerId(v string) *DataReplicationCounterpart {
+	s.BrokerId = &v
+	return s
+}
+
+// SetRegion sets the Region field's value.
+func (s *DataReplicationCounterpart) SetRegion(v string) *DataReplicationCounterpart {
+	s.Region = &v
+	return s
+}
+
+// The replication details of the data replication-enabled broker. Only returned
+// if dataReplicationMode or pendingDataReplicationMode is set to CRDR.
+type DataReplicationMetadataOutput_ struct {
 	_ struct{} `type:"structure"`
 
+	// Describes the replica/primary broker. Only returned if this broker is currently
+	// set as a primary or replica in the broker's dataReplicationRole property.
+	DataReplicationCounterpart *DataReplicationCounterpart `locationName:"dataReplicationCounterpart" type:"structure"`
+
+	// Defines the role of this broker in a data replication pair. When a replica
+	// broker is promoted to primary, this role is interchanged.
+	//
+	// DataReplicationRole is a required field
+	DataReplicationRole *string `locationName:"dataReplicationRole" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DataReplicationMetadataOutput_) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DataReplicationMetadataOutput_) GoString() string {
+	return s.String()
+}
+
+// SetDataReplicationCounterpart sets the DataReplicationCounterpart field's value.
+func (s *DataReplicationMetadataOutput_) SetDataReplicationCounterpart(v *DataReplicationCounterpart) *DataReplicationMetadataOutput_ {
+	s.DataReplicationCounterpart = v
+	return s
+}
+
+// SetDataReplicationRole sets the DataReplicationRole field's value.
+func (s *DataReplicationMetadataOutput_) SetDataReplicationRole(v string) *DataReplicationMetadataOutput_ {
+	s.DataReplicationRole = &v
+	return s
+}
+
+type DeleteBrokerInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
 	// BrokerId is a required field
 	BrokerId *string `location:"uri" locationName:"broker-id" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteBrokerInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteBrokerInput) GoString() string {
 	return s.String()
 }
@@ -2900,12 +3737,20 @@ type DeleteBrokerResponse struct {
 	BrokerId *string `locationName:"brokerId" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteBrokerResponse) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteBrokerResponse) GoString() string {
 	return s.String()
 }
@@ -2917,7 +3762,7 @@ func (s *DeleteBrokerResponse) SetBrokerId(v string) *DeleteBrokerResponse {
 }
 
 type DeleteTagsInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// ResourceArn is a required field
 	ResourceArn *string `location:"uri" locationName:"resource-arn" type:"string" required:"true"`
@@ -2926,12 +3771,20 @@ type DeleteTagsInput struct {
 	TagKeys []*string `location:"querystring" locationName:"tagKeys" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteTagsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteTagsInput) GoString() string {
 	return s.String()
 }
@@ -2971,18 +3824,26 @@ type DeleteTagsOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteTagsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteTagsOutput) GoString() string {
 	return s.String()
 }
 
 type DeleteUserInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// BrokerId is a required field
 	BrokerId *string `location:"uri" locationName:"broker-id" type:"string" required:"true"`
@@ -2991,12 +3852,20 @@ type DeleteUserInput struct {
 	Username *string `location:"uri" locationName:"username" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteUserInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteUserInput) GoString() string {
 	return s.String()
 }
@@ -3039,18 +3908,26 @@ type DeleteUserOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteUserOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteUserOutput) GoString() string {
 	return s.String()
 }
 
 type DescribeBrokerEngineTypesInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	EngineType *string `location:"querystring" locationName:"engineType" type:"string"`
 
@@ -3059,12 +3936,20 @@ type DescribeBrokerEngineTypesInput struct {
 	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeBrokerEngineTypesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeBrokerEngineTypesInput) GoString() string {
 	return s.String()
 }
@@ -3110,12 +3995,20 @@ type DescribeBrokerEngineTypesOutput struct {
 	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeBrokerEngineTypesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeBrokerEngineTypesOutput) GoString() string {
 	return s.String()
 }
@@ -3139,18 +4032,26 @@ func (s *DescribeBrokerEngineTypesOutput) SetNextToken(v string) *DescribeBroker
 }
 
 type DescribeBrokerInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// BrokerId is a required field
 	BrokerId *string `location:"uri" locationName:"broker-id" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeBrokerInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeBrokerInput) GoString() string {
 	return s.String()
 }
@@ -3178,7 +4079,7 @@ func (s *DescribeBrokerInput) SetBrokerId(v string) *DescribeBrokerInput {
 }
 
 type DescribeBrokerInstanceOptionsInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	EngineType *string `location:"querystring" locationName:"engineType" type:"string"`
 
@@ -3187,14 +4088,24 @@ type DescribeBrokerInstanceOptionsInput struct {
 	MaxResults *int64 `location:"querystring" locationName:"maxResults" min:"1" type:"integer"`
 
 	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
+
+	StorageType *string `location:"querystring" locationName:"storageType" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeBrokerInstanceOptionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeBrokerInstanceOptionsInput) GoString() string {
 	return s.String()
 }
@@ -3236,6 +4147,12 @@ func (s *DescribeBrokerInstanceOptionsInput) SetNextToken(v string) *DescribeBro
 	return s
 }
 
+// SetStorageType sets the StorageType field's value.
+func (s *DescribeBrokerInstanceOptionsInput) SetStorageType(v string) *DescribeBrokerInstanceOptionsInput {
+	s.StorageType = &v
+	return s
+}
+
 type DescribeBrokerInstanceOptionsOutput struct {
 	_ struct{} `type:"structure"`
 
@@ -3246,12 +4163,20 @@ type DescribeBrokerInstanceOptionsOutput struct {
 	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeBrokerInstanceOptionsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeBrokerInstanceOptionsOutput) GoString() string {
 	return s.String()
 }
@@ -3277,6 +4202,12 @@ func (s *DescribeBrokerInstanceOptionsOutput) SetNextToken(v string) *DescribeBr
 type DescribeBrokerResponse struct {
 	_ struct{} `type:"structure"`
 
+	ActionsRequired []*ActionRequired `locationName:"actionsRequired" type:"list"`
+
+	// Optional. The authentication strategy used to secure the broker. The default
+	// is SIMPLE.
+	AuthenticationStrategy *string `locationName:"authenticationStrategy" type:"string" enum:"AuthenticationStrategy"`
+
 	AutoMinorVersionUpgrade *bool `locationName:"autoMinorVersionUpgrade" type:"boolean"`
 
 	BrokerArn *string `locationName:"brokerArn" type:"string"`
@@ -3287,7 +4218,7 @@ type DescribeBrokerResponse struct {
 
 	BrokerName *string `locationName:"brokerName" type:"string"`
 
-	// The status of the broker.
+	// The broker's status.
 	BrokerState *string `locationName:"brokerState" type:"string" enum:"BrokerState"`
 
 	// Broker configuration information
@@ -3295,19 +4226,30 @@ type DescribeBrokerResponse struct {
 
 	Created *time.Time `locationName:"created" type:"timestamp" timestampFormat:"iso8601"`
 
-	// The deployment mode of the broker.
+	// The replication details of the data replication-enabled broker. Only returned
+	// if dataReplicationMode or pendingDataReplicationMode is set to CRDR.
+	DataReplicationMetadata *DataReplicationMetadataOutput_ `locationName:"dataReplicationMetadata" type:"structure"`
+
+	// Specifies whether a broker is a part of a data replication pair.
+	DataReplicationMode *string `locationName:"dataReplicationMode" type:"string" enum:"DataReplicationMode"`
+
+	// The broker's deployment mode.
 	DeploymentMode *string `locationName:"deploymentMode" type:"string" enum:"DeploymentMode"`
 
 	// Encryption options for the broker.
 	EncryptionOptions *EncryptionOptions `locationName:"encryptionOptions" type:"structure"`
 
-	// The type of broker engine. Note: Currently, Amazon MQ supports only ActiveMQ.
+	// The type of broker engine. Amazon MQ supports ActiveMQ and RabbitMQ.
 	EngineType *string `locationName:"engineType" type:"string" enum:"EngineType"`
 
 	EngineVersion *string `locationName:"engineVersion" type:"string"`
 
 	HostInstanceType *string `locationName:"hostInstanceType" type:"string"`
 
+	// Optional. The metadata of the LDAP server used to authenticate and authorize
+	// connections to the broker.
+	LdapServerMetadata *LdapServerMetadataOutput `locationName:"ldapServerMetadata" type:"structure"`
+
 	// The list of information about logs currently enabled and pending to be deployed
 	// for the specified broker.
 	Logs *LogsSummary `locationName:"logs" type:"structure"`
@@ -3316,16 +4258,36 @@ type DescribeBrokerResponse struct {
 	// apply pending updates or patches to the broker.
 	MaintenanceWindowStartTime *WeeklyStartTime `locationName:"maintenanceWindowStartTime" type:"structure"`
 
+	// Optional. The authentication strategy used to secure the broker. The default
+	// is SIMPLE.
+	PendingAuthenticationStrategy *string `locationName:"pendingAuthenticationStrategy" type:"string" enum:"AuthenticationStrategy"`
+
+	// The replication details of the data replication-enabled broker. Only returned
+	// if dataReplicationMode or pendingDataReplicationMode is set to CRDR.
+	PendingDataReplicationMetadata *DataReplicationMetadataOutput_ `locationName:"pendingDataReplicationMetadata" type:"structure"`
+
+	// Specifies whether a broker is a part of a data replication pair.
+	PendingDataReplicationMode *string `locationName:"pendingDataReplicationMode" type:"string" enum:"DataReplicationMode"`
+
 	PendingEngineVersion *string `locationName:"pendingEngineVersion" type:"string"`
 
 	PendingHostInstanceType *string `locationName:"pendingHostInstanceType" type:"string"`
 
+	// Optional. The metadata of the LDAP server used to authenticate and authorize
+	// connections to the broker.
+	PendingLdapServerMetadata *LdapServerMetadataOutput `locationName:"pendingLdapServerMetadata" type:"structure"`
+
 	PendingSecurityGroups []*string `locationName:"pendingSecurityGroups" type:"list"`
 
 	PubliclyAccessible *bool `locationName:"publiclyAccessible" type:"boolean"`
 
 	SecurityGroups []*string `locationName:"securityGroups" type:"list"`
 
+	// The broker's storage type.
+	//
+	// EFS is not supported for RabbitMQ engine type.
+	StorageType *string `locationName:"storageType" type:"string" enum:"BrokerStorageType"`
+
 	SubnetIds []*string `locationName:"subnetIds" type:"list"`
 
 	Tags map[string]*string `locationName:"tags" type:"map"`
@@ -3333,16 +4295,36 @@ type DescribeBrokerResponse struct {
 	Users []*UserSummary `locationName:"users" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeBrokerResponse) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeBrokerResponse) GoString() string {
 	return s.String()
 }
 
+// SetActionsRequired sets the ActionsRequired field's value.
+func (s *DescribeBrokerResponse) SetActionsRequired(v []*ActionRequired) *DescribeBrokerResponse {
+	s.ActionsRequired = v
+	return s
+}
+
+// SetAuthenticationStrategy sets the AuthenticationStrategy field's value.
+func (s *DescribeBrokerResponse) SetAuthenticationStrategy(v string) *DescribeBrokerResponse {
+	s.AuthenticationStrategy = &v
+	return s
+}
+
 // SetAutoMinorVersionUpgrade sets the AutoMinorVersionUpgrade field's value.
 func (s *DescribeBrokerResponse) SetAutoMinorVersionUpgrade(v bool) *DescribeBrokerResponse {
 	s.AutoMinorVersionUpgrade = &v
@@ -3391,6 +4373,18 @@ func (s *DescribeBrokerResponse) SetCreated(v time.Time) *DescribeBrokerResponse
 	return s
 }
 
+// SetDataReplicationMetadata sets the DataReplicationMetadata field's value.
+func (s *DescribeBrokerResponse) SetDataReplicationMetadata(v *DataReplicationMetadataOutput_) *DescribeBrokerResponse {
+	s.DataReplicationMetadata = v
+	return s
+}
+
+// SetDataReplicationMode sets the DataReplicationMode field's value.
+func (s *DescribeBrokerResponse) SetDataReplicationMode(v string) *DescribeBrokerResponse {
+	s.DataReplicationMode = &v
+	return s
+}
+
 // SetDeploymentMode sets the DeploymentMode field's value.
 func (s *DescribeBrokerResponse) SetDeploymentMode(v string) *DescribeBrokerResponse {
 	s.DeploymentMode = &v
@@ -3421,6 +4415,12 @@ func (s *DescribeBrokerResponse) SetHostInstanceType(v string) *DescribeBrokerRe
 	return s
 }
 
+// SetLdapServerMetadata sets the LdapServerMetadata field's value.
+func (s *DescribeBrokerResponse) SetLdapServerMetadata(v *LdapServerMetadataOutput) *DescribeBrokerResponse {
+	s.LdapServerMetadata = v
+	return s
+}
+
 // SetLogs sets the Logs field's value.
 func (s *DescribeBrokerResponse) SetLogs(v *LogsSummary) *DescribeBrokerResponse {
 	s.Logs = v
@@ -3433,6 +4433,24 @@ func (s *DescribeBrokerResponse) SetMaintenanceWindowStartTime(v *WeeklyStartTim
 	return s
 }
 
+// SetPendingAuthenticationStrategy sets the PendingAuthenticationStrategy field's value.
+func (s *DescribeBrokerResponse) SetPendingAuthenticationStrategy(v string) *DescribeBrokerResponse {
+	s.PendingAuthenticationStrategy = &v
+	return s
+}
+
+// SetPendingDataReplicationMetadata sets the PendingDataReplicationMetadata field's value.
+func (s *DescribeBrokerResponse) SetPendingDataReplicationMetadata(v *DataReplicationMetadataOutput_) *DescribeBrokerResponse {
+	s.PendingDataReplicationMetadata = v
+	return s
+}
+
+// SetPendingDataReplicationMode sets the PendingDataReplicationMode field's value.
+func (s *DescribeBrokerResponse) SetPendingDataReplicationMode(v string) *DescribeBrokerResponse {
+	s.PendingDataReplicationMode = &v
+	return s
+}
+
 // SetPendingEngineVersion sets the PendingEngineVersion field's value.
 func (s *DescribeBrokerResponse) SetPendingEngineVersion(v string) *DescribeBrokerResponse {
 	s.PendingEngineVersion = &v
@@ -3445,6 +4463,12 @@ func (s *DescribeBrokerResponse) SetPendingHostInstanceType(v string) *DescribeB
 	return s
 }
 
+// SetPendingLdapServerMetadata sets the PendingLdapServerMetadata field's value.
+func (s *DescribeBrokerResponse) SetPendingLdapServerMetadata(v *LdapServerMetadataOutput) *DescribeBrokerResponse {
+	s.PendingLdapServerMetadata = v
+	return s
+}
+
 // SetPendingSecurityGroups sets the PendingSecurityGroups field's value.
 func (s *DescribeBrokerResponse) SetPendingSecurityGroups(v []*string) *DescribeBrokerResponse {
 	s.PendingSecurityGroups = v
@@ -3463,6 +4487,12 @@ func (s *DescribeBrokerResponse) SetSecurityGroups(v []*string) *DescribeBrokerR
 	return s
 }
 
+// SetStorageType sets the StorageType field's value.
+func (s *DescribeBrokerResponse) SetStorageType(v string) *DescribeBrokerResponse {
+	s.StorageType = &v
+	return s
+}
+
 // SetSubnetIds sets the SubnetIds field's value.
 func (s *DescribeBrokerResponse) SetSubnetIds(v []*string) *DescribeBrokerResponse {
 	s.SubnetIds = v
@@ -3482,18 +4512,26 @@ func (s *DescribeBrokerResponse) SetUsers(v []*UserSummary) *DescribeBrokerRespo
 }
 
 type DescribeConfigurationInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// ConfigurationId is a required field
 	ConfigurationId *string `location:"uri" locationName:"configuration-id" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeConfigurationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeConfigurationInput) GoString() string {
 	return s.String()
 }
@@ -3525,11 +4563,15 @@ type DescribeConfigurationOutput struct {
 
 	Arn *string `locationName:"arn" type:"string"`
 
+	// Optional. The authentication strategy used to secure the broker. The default
+	// is SIMPLE.
+	AuthenticationStrategy *string `locationName:"authenticationStrategy" type:"string" enum:"AuthenticationStrategy"`
+
 	Created *time.Time `locationName:"created" type:"timestamp" timestampFormat:"iso8601"`
 
 	Description *string `locationName:"description" type:"string"`
 
-	// The type of broker engine. Note: Currently, Amazon MQ supports only ActiveMQ.
+	// The type of broker engine. Amazon MQ supports ActiveMQ and RabbitMQ.
 	EngineType *string `locationName:"engineType" type:"string" enum:"EngineType"`
 
 	EngineVersion *string `locationName:"engineVersion" type:"string"`
@@ -3544,12 +4586,20 @@ type DescribeConfigurationOutput struct {
 	Tags map[string]*string `locationName:"tags" type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeConfigurationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeConfigurationOutput) GoString() string {
 	return s.String()
 }
@@ -3560,6 +4610,12 @@ func (s *DescribeConfigurationOutput) SetArn(v string) *DescribeConfigurationOut
 	return s
 }
 
+// SetAuthenticationStrategy sets the AuthenticationStrategy field's value.
+func (s *DescribeConfigurationOutput) SetAuthenticationStrategy(v string) *DescribeConfigurationOutput {
+	s.AuthenticationStrategy = &v
+	return s
+}
+
 // SetCreated sets the Created field's value.
 func (s *DescribeConfigurationOutput) SetCreated(v time.Time) *DescribeConfigurationOutput {
 	s.Created = &v
@@ -3609,7 +4665,7 @@ func (s *DescribeConfigurationOutput) SetTags(v map[string]*string) *DescribeCon
 }
 
 type DescribeConfigurationRevisionInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// ConfigurationId is a required field
 	ConfigurationId *string `location:"uri" locationName:"configuration-id" type:"string" required:"true"`
@@ -3618,12 +4674,20 @@ type DescribeConfigurationRevisionInput struct {
 	ConfigurationRevision *string `location:"uri" locationName:"configuration-revision" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeConfigurationRevisionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeConfigurationRevisionInput) GoString() string {
 	return s.String()
 }
@@ -3674,12 +4738,20 @@ type DescribeConfigurationRevisionResponse struct {
 	Description *string `locationName:"description" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeConfigurationRevisionResponse) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeConfigurationRevisionResponse) GoString() string {
 	return s.String()
 }
@@ -3709,7 +4781,7 @@ func (s *DescribeConfigurationRevisionResponse) SetDescription(v string) *Descri
 }
 
 type DescribeUserInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// BrokerId is a required field
 	BrokerId *string `location:"uri" locationName:"broker-id" type:"string" required:"true"`
@@ -3718,12 +4790,20 @@ type DescribeUserInput struct {
 	Username *string `location:"uri" locationName:"username" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeUserInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeUserInput) GoString() string {
 	return s.String()
 }
@@ -3775,15 +4855,25 @@ type DescribeUserResponse struct {
 	// user.
 	Pending *UserPendingChanges `locationName:"pending" type:"structure"`
 
+	ReplicationUser *bool `locationName:"replicationUser" type:"boolean"`
+
 	Username *string `locationName:"username" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeUserResponse) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeUserResponse) GoString() string {
 	return s.String()
 }
@@ -3812,6 +4902,12 @@ func (s *DescribeUserResponse) SetPending(v *UserPendingChanges) *DescribeUserRe
 	return s
 }
 
+// SetReplicationUser sets the ReplicationUser field's value.
+func (s *DescribeUserResponse) SetReplicationUser(v bool) *DescribeUserResponse {
+	s.ReplicationUser = &v
+	return s
+}
+
 // SetUsername sets the Username field's value.
 func (s *DescribeUserResponse) SetUsername(v string) *DescribeUserResponse {
 	s.Username = &v
@@ -3822,23 +4918,32 @@ func (s *DescribeUserResponse) SetUsername(v string) *DescribeUserResponse {
 type EncryptionOptions struct {
 	_ struct{} `type:"structure"`
 
-	// The customer master key (CMK) to use for the AWS Key Management Service (KMS).
-	// This key is used to encrypt your data at rest. If not provided, Amazon MQ
-	// will use a default CMK to encrypt your data.
+	// The customer master key (CMK) to use for the A KMS (KMS). This key is used
+	// to encrypt your data at rest. If not provided, Amazon MQ will use a default
+	// CMK to encrypt your data.
 	KmsKeyId *string `locationName:"kmsKeyId" type:"string"`
 
-	// Enables the use of an AWS owned CMK using AWS Key Management Service (KMS).
+	// Enables the use of an Amazon Web Services owned CMK using KMS (KMS). Set
+	// to true by default, if no value is provided, for example, for RabbitMQ brokers.
 	//
 	// UseAwsOwnedKey is a required field
 	UseAwsOwnedKey *bool `locationName:"useAwsOwnedKey" type:"boolean" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s EncryptionOptions) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s EncryptionOptions) GoString() string {
 	return s.String()
 }
@@ -3876,12 +4981,20 @@ type EngineVersion struct {
 	Name *string `locationName:"name" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s EngineVersion) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s EngineVersion) GoString() string {
 	return s.String()
 }
@@ -3892,20 +5005,504 @@ func (s *EngineVersion) SetName(v string) *EngineVersion {
 	return s
 }
 
-type ListBrokersInput struct {
+// Returns information about an error.
+type ForbiddenException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	ErrorAttribute *string `locationName:"errorAttribute" type:"string"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ForbiddenException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ForbiddenException) GoString() string {
+	return s.String()
+}
+
+func newErrorForbiddenException(v protocol.ResponseMetadata) error {
+	return &ForbiddenException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ForbiddenException) Code() string {
+	return "ForbiddenException"
+}
+
+// Message returns the exception's message.
+func (s *ForbiddenException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ForbiddenException) OrigErr() error {
+	return nil
+}
+
+func (s *ForbiddenException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ForbiddenException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ForbiddenException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Returns information about an error.
+type InternalServerErrorException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	ErrorAttribute *string `locationName:"errorAttribute" type:"string"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InternalServerErrorException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InternalServerErrorException) GoString() string {
+	return s.String()
+}
+
+func newErrorInternalServerErrorException(v protocol.ResponseMetadata) error {
+	return &InternalServerErrorException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InternalServerErrorException) Code() string {
+	return "InternalServerErrorException"
+}
+
+// Message returns the exception's message.
+func (s *InternalServerErrorException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InternalServerErrorException) OrigErr() error {
+	return nil
+}
+
+func (s *InternalServerErrorException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InternalServerErrorException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InternalServerErrorException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Optional. The metadata of the LDAP server used to authenticate and authorize
+// connections to the broker.
+//
+// Does not apply to RabbitMQ brokers.
+type LdapServerMetadataInput struct {
 	_ struct{} `type:"structure"`
 
+	// Specifies the location of the LDAP server such as Directory Service for Microsoft
+	// Active Directory. Optional failover server.
+	//
+	// Hosts is a required field
+	Hosts []*string `locationName:"hosts" type:"list" required:"true"`
+
+	// The distinguished name of the node in the directory information tree (DIT)
+	// to search for roles or groups. For example, ou=group, ou=corp, dc=corp, dc=example,
+	// dc=com.
+	//
+	// RoleBase is a required field
+	RoleBase *string `locationName:"roleBase" type:"string" required:"true"`
+
+	// Specifies the LDAP attribute that identifies the group name attribute in
+	// the object returned from the group membership query.
+	RoleName *string `locationName:"roleName" type:"string"`
+
+	// The LDAP search filter used to find roles within the roleBase. The distinguished
+	// name of the user matched by userSearchMatching is substituted into the {0}
+	// placeholder in the search filter. The client's username is substituted into
+	// the {1} placeholder. For example, if you set this option to (member=uid={1})for
+	// the user janedoe, the search filter becomes (member=uid=janedoe) after string
+	// substitution. It matches all role entries that have a member attribute equal
+	// to uid=janedoe under the subtree selected by the roleBase.
+	//
+	// RoleSearchMatching is a required field
+	RoleSearchMatching *string `locationName:"roleSearchMatching" type:"string" required:"true"`
+
+	// The directory search scope for the role. If set to true, scope is to search
+	// the entire subtree.
+	RoleSearchSubtree *bool `locationName:"roleSearchSubtree" type:"boolean"`
+
+	// Service account password. A service account is an account in your LDAP server
+	// that has access to initiate a connection. For example, cn=admin,dc=corp,
+	// dc=example, dc=com.
+	//
+	// ServiceAccountPassword is a required field
+	ServiceAccountPassword *string `locationName:"serviceAccountPassword" type:"string" required:"true"`
+
+	// Service account username. A service account is an account in your LDAP server
+	// that has access to initiate a connection. For example, cn=admin,dc=corp,
+	// dc=example, dc=com.
+	//
+	// ServiceAccountUsername is a required field
+	ServiceAccountUsername *string `locationName:"serviceAccountUsername" type:"string" required:"true"`
+
+	// Select a particular subtree of the directory information tree (DIT) to search
+	// for user entries. The subtree is specified by a DN, which specifies the base
+	// node of the subtree. For example, by setting this option to ou=Users,ou=corp,
+	// dc=corp, dc=example, dc=com, the search for user entries is restricted to
+	// the subtree beneath ou=Users, ou=corp, dc=corp, dc=example, dc=com.
+	//
+	// UserBase is a required field
+	UserBase *string `locationName:"userBase" type:"string" required:"true"`
+
+	// Specifies the name of the LDAP attribute for the user group membership.
+	UserRoleName *string `locationName:"userRoleName" type:"string"`
+
+	// The LDAP search filter used to find users within the userBase. The client's
+	// username is substituted into the {0} placeholder in the search filter. For
+	// example, if this option is set to (uid={0}) and the received username is
+	// janedoe, the search filter becomes (uid=janedoe) after string substitution.
+	// It will result in matching an entry like uid=janedoe, ou=Users,ou=corp, dc=corp,
+	// dc=example, dc=com.
+	//
+	// UserSearchMatching is a required field
+	UserSearchMatching *string `locationName:"userSearchMatching" type:"string" required:"true"`
+
+	// The directory search scope for the user. If set to true, scope is to search
+	// the entire subtree.
+	UserSearchSubtree *bool `locationName:"userSearchSubtree" type:"boolean"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LdapServerMetadataInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LdapServerMetadataInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *LdapServerMetadataInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "LdapServerMetadataInput"}
+	if s.Hosts == nil {
+		invalidParams.Add(request.NewErrParamRequired("Hosts"))
+	}
+	if s.RoleBase == nil {
+		invalidParams.Add(request.NewErrParamRequired("RoleBase"))
+	}
+	if s.RoleSearchMatching == nil {
+		invalidParams.Add(request.NewErrParamRequired("RoleSearchMatching"))
+	}
+	if s.ServiceAccountPassword == nil {
+		invalidParams.Add(request.NewErrParamRequired("ServiceAccountPassword"))
+	}
+	if s.ServiceAccountUsername == nil {
+		invalidParams.Add(request.NewErrParamRequired("ServiceAccountUsername"))
+	}
+	if s.UserBase == nil {
+		invalidParams.Add(request.NewErrParamRequired("UserBase"))
+	}
+	if s.UserSearchMatching == nil {
+		invalidParams.Add(request.NewErrParamRequired("UserSearchMatching"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetHosts sets the Hosts field's value.
+func (s *LdapServerMetadataInput) SetHosts(v []*string) *LdapServerMetadataInput {
+	s.Hosts = v
+	return s
+}
+
+// SetRoleBase sets the RoleBase field's value.
+func (s *LdapServerMetadataInput) SetRoleBase(v string) *LdapServerMetadataInput {
+	s.RoleBase = &v
+	return s
+}
+
+// SetRoleName sets the RoleName field's value.
+func (s *LdapServerMetadataInput) SetRoleName(v string) *LdapServerMetadataInput {
+	s.RoleName = &v
+	return s
+}
+
+// SetRoleSearchMatching sets the RoleSearchMatching field's value.
+func (s *LdapServerMetadataInput) SetRoleSearchMatching(v string) *LdapServerMetadataInput {
+	s.RoleSearchMatching = &v
+	return s
+}
+
+// SetRoleSearchSubtree sets the RoleSearchSubtree field's value.
+func (s *LdapServerMetadataInput) SetRoleSearchSubtree(v bool) *LdapServerMetadataInput {
+	s.RoleSearchSubtree = &v
+	return s
+}
+
+// SetServiceAccountPassword sets the ServiceAccountPassword field's value.
+func (s *LdapServerMetadataInput) SetServiceAccountPassword(v string) *LdapServerMetadataInput {
+	s.ServiceAccountPassword = &v
+	return s
+}
+
+// SetServiceAccountUsername sets the ServiceAccountUsername field's value.
+func (s *LdapServerMetadataInput) SetServiceAccountUsername(v string) *LdapServerMetadataInput {
+	s.ServiceAccountUsername = &v
+	return s
+}
+
+// SetUserBase sets the UserBase field's value.
+func (s *LdapServerMetadataInput) SetUserBase(v string) *LdapServerMetadataInput {
+	s.UserBase = &v
+	return s
+}
+
+// SetUserRoleName sets the UserRoleName field's value.
+func (s *LdapServerMetadataInput) SetUserRoleName(v string) *LdapServerMetadataInput {
+	s.UserRoleName = &v
+	return s
+}
+
+// SetUserSearchMatching sets the UserSearchMatching field's value.
+func (s *LdapServerMetadataInput) SetUserSearchMatching(v string) *LdapServerMetadataInput {
+	s.UserSearchMatching = &v
+	return s
+}
+
+// SetUserSearchSubtree sets the UserSearchSubtree field's value.
+func (s *LdapServerMetadataInput) SetUserSearchSubtree(v bool) *LdapServerMetadataInput {
+	s.UserSearchSubtree = &v
+	return s
+}
+
+// Optional. The metadata of the LDAP server used to authenticate and authorize
+// connections to the broker.
+type LdapServerMetadataOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies the location of the LDAP server such as Directory Service for Microsoft
+	// Active Directory. Optional failover server.
+	//
+	// Hosts is a required field
+	Hosts []*string `locationName:"hosts" type:"list" required:"true"`
+
+	// The distinguished name of the node in the directory information tree (DIT)
+	// to search for roles or groups. For example, ou=group, ou=corp, dc=corp, dc=example,
+	// dc=com.
+	//
+	// RoleBase is a required field
+	RoleBase *string `locationName:"roleBase" type:"string" required:"true"`
+
+	// Specifies the LDAP attribute that identifies the group name attribute in
+	// the object returned from the group membership query.
+	RoleName *string `locationName:"roleName" type:"string"`
+
+	// The LDAP search filter used to find roles within the roleBase. The distinguished
+	// name of the user matched by userSearchMatching is substituted into the {0}
+	// placeholder in the search filter. The client's username is substituted into
+	// the {1} placeholder. For example, if you set this option to (member=uid={1})for
+	// the user janedoe, the search filter becomes (member=uid=janedoe) after string
+	// substitution. It matches all role entries that have a member attribute equal
+	// to uid=janedoe under the subtree selected by the roleBase.
+	//
+	// RoleSearchMatching is a required field
+	RoleSearchMatching *string `locationName:"roleSearchMatching" type:"string" required:"true"`
+
+	// The directory search scope for the role. If set to true, scope is to search
+	// the entire subtree.
+	RoleSearchSubtree *bool `locationName:"roleSearchSubtree" type:"boolean"`
+
+	// Service account username. A service account is an account in your LDAP server
+	// that has access to initiate a connection. For example, cn=admin,dc=corp,
+	// dc=example, dc=com.
+	//
+	// ServiceAccountUsername is a required field
+	ServiceAccountUsername *string `locationName:"serviceAccountUsername" type:"string" required:"true"`
+
+	// Select a particular subtree of the directory information tree (DIT) to search
+	// for user entries. The subtree is specified by a DN, which specifies the base
+	// node of the subtree. For example, by setting this option to ou=Users,ou=corp,
+	// dc=corp, dc=example, dc=com, the search for user entries is restricted to
+	// the subtree beneath ou=Users, ou=corp, dc=corp, dc=example, dc=com.
+	//
+	// UserBase is a required field
+	UserBase *string `locationName:"userBase" type:"string" required:"true"`
+
+	// Specifies the name of the LDAP attribute for the user group membership.
+	UserRoleName *string `locationName:"userRoleName" type:"string"`
+
+	// The LDAP search filter used to find users within the userBase. The client's
+	// username is substituted into the {0} placeholder in the search filter. For
+	// example, if this option is set to (uid={0}) and the received username is
+	// janedoe, the search filter becomes (uid=janedoe) after string substitution.
+	// It will result in matching an entry like uid=janedoe, ou=Users,ou=corp, dc=corp,
+	// dc=example, dc=com.
+	//
+	// UserSearchMatching is a required field
+	UserSearchMatching *string `locationName:"userSearchMatching" type:"string" required:"true"`
+
+	// The directory search scope for the user. If set to true, scope is to search
+	// the entire subtree.
+	UserSearchSubtree *bool `locationName:"userSearchSubtree" type:"boolean"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LdapServerMetadataOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LdapServerMetadataOutput) GoString() string {
+	return s.String()
+}
+
+// SetHosts sets the Hosts field's value.
+func (s *LdapServerMetadataOutput) SetHosts(v []*string) *LdapServerMetadataOutput {
+	s.Hosts = v
+	return s
+}
+
+// SetRoleBase sets the RoleBase field's value.
+func (s *LdapServerMetadataOutput) SetRoleBase(v string) *LdapServerMetadataOutput {
+	s.RoleBase = &v
+	return s
+}
+
+// SetRoleName sets the RoleName field's value.
+func (s *LdapServerMetadataOutput) SetRoleName(v string) *LdapServerMetadataOutput {
+	s.RoleName = &v
+	return s
+}
+
+// SetRoleSearchMatching sets the RoleSearchMatching field's value.
+func (s *LdapServerMetadataOutput) SetRoleSearchMatching(v string) *LdapServerMetadataOutput {
+	s.RoleSearchMatching = &v
+	return s
+}
+
+// SetRoleSearchSubtree sets the RoleSearchSubtree field's value.
+func (s *LdapServerMetadataOutput) SetRoleSearchSubtree(v bool) *LdapServerMetadataOutput {
+	s.RoleSearchSubtree = &v
+	return s
+}
+
+// SetServiceAccountUsername sets the ServiceAccountUsername field's value.
+func (s *LdapServerMetadataOutput) SetServiceAccountUsername(v string) *LdapServerMetadataOutput {
+	s.ServiceAccountUsername = &v
+	return s
+}
+
+// SetUserBase sets the UserBase field's value.
+func (s *LdapServerMetadataOutput) SetUserBase(v string) *LdapServerMetadataOutput {
+	s.UserBase = &v
+	return s
+}
+
+// SetUserRoleName sets the UserRoleName field's value.
+func (s *LdapServerMetadataOutput) SetUserRoleName(v string) *LdapServerMetadataOutput {
+	s.UserRoleName = &v
+	return s
+}
+
+// SetUserSearchMatching sets the UserSearchMatching field's value.
+func (s *LdapServerMetadataOutput) SetUserSearchMatching(v string) *LdapServerMetadataOutput {
+	s.UserSearchMatching = &v
+	return s
+}
+
+// SetUserSearchSubtree sets the UserSearchSubtree field's value.
+func (s *LdapServerMetadataOutput) SetUserSearchSubtree(v bool) *LdapServerMetadataOutput {
+	s.UserSearchSubtree = &v
+	return s
+}
+
+type ListBrokersInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
 	MaxResults *int64 `location:"querystring" locationName:"maxResults" min:"1" type:"integer"`
 
 	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListBrokersInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListBrokersInput) GoString() string {
 	return s.String()
 }
@@ -3943,12 +5540,20 @@ type ListBrokersResponse struct {
 	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListBrokersResponse) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListBrokersResponse) GoString() string {
 	return s.String()
 }
@@ -3966,7 +5571,7 @@ func (s *ListBrokersResponse) SetNextToken(v string) *ListBrokersResponse {
 }
 
 type ListConfigurationRevisionsInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// ConfigurationId is a required field
 	ConfigurationId *string `location:"uri" locationName:"configuration-id" type:"string" required:"true"`
@@ -3976,12 +5581,20 @@ type ListConfigurationRevisionsInput struct {
 	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListConfigurationRevisionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListConfigurationRevisionsInput) GoString() string {
 	return s.String()
 }
@@ -4035,12 +5648,20 @@ type ListConfigurationRevisionsResponse struct {
 	Revisions []*ConfigurationRevision `locationName:"revisions" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListConfigurationRevisionsResponse) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListConfigurationRevisionsResponse) GoString() string {
 	return s.String()
 }
@@ -4070,19 +5691,27 @@ func (s *ListConfigurationRevisionsResponse) SetRevisions(v []*ConfigurationRevi
 }
 
 type ListConfigurationsInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	MaxResults *int64 `location:"querystring" locationName:"maxResults" min:"1" type:"integer"`
 
 	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListConfigurationsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListConfigurationsInput) GoString() string {
 	return s.String()
 }
@@ -4122,12 +5751,20 @@ type ListConfigurationsResponse struct {
 	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListConfigurationsResponse) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListConfigurationsResponse) GoString() string {
 	return s.String()
 }
@@ -4151,18 +5788,26 @@ func (s *ListConfigurationsResponse) SetNextToken(v string) *ListConfigurationsR
 }
 
 type ListTagsInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// ResourceArn is a required field
 	ResourceArn *string `location:"uri" locationName:"resource-arn" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsInput) GoString() string {
 	return s.String()
 }
@@ -4195,12 +5840,20 @@ type ListTagsOutput struct {
 	Tags map[string]*string `locationName:"tags" type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsOutput) GoString() string {
 	return s.String()
 }
@@ -4212,7 +5865,7 @@ func (s *ListTagsOutput) SetTags(v map[string]*string) *ListTagsOutput {
 }
 
 type ListUsersInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// BrokerId is a required field
 	BrokerId *string `location:"uri" locationName:"broker-id" type:"string" required:"true"`
@@ -4222,12 +5875,20 @@ type ListUsersInput struct {
 	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListUsersInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListUsersInput) GoString() string {
 	return s.String()
 }
@@ -4281,12 +5942,20 @@ type ListUsersResponse struct {
 	Users []*UserSummary `locationName:"users" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListUsersResponse) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListUsersResponse) GoString() string {
 	return s.String()
 }
@@ -4320,19 +5989,27 @@ type Logs struct {
 	_ struct{} `type:"structure"`
 
 	// Enables audit logging. Every user management action made using JMX or the
-	// ActiveMQ Web Console is logged.
+	// ActiveMQ Web Console is logged. Does not apply to RabbitMQ brokers.
 	Audit *bool `locationName:"audit" type:"boolean"`
 
 	// Enables general logging.
 	General *bool `locationName:"general" type:"boolean"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Logs) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Logs) GoString() string {
 	return s.String()
 }
@@ -4362,22 +6039,34 @@ type LogsSummary struct {
 	AuditLogGroup *string `locationName:"auditLogGroup" type:"string"`
 
 	// Enables general logging.
-	General *bool `locationName:"general" type:"boolean"`
+	//
+	// General is a required field
+	General *bool `locationName:"general" type:"boolean" required:"true"`
 
 	// The location of the CloudWatch Logs log group where general logs are sent.
-	GeneralLogGroup *string `locationName:"generalLogGroup" type:"string"`
+	//
+	// GeneralLogGroup is a required field
+	GeneralLogGroup *string `locationName:"generalLogGroup" type:"string" required:"true"`
 
 	// The list of information about logs pending to be deployed for the specified
 	// broker.
 	Pending *PendingLogs `locationName:"pending" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s LogsSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s LogsSummary) GoString() string {
 	return s.String()
 }
@@ -4400,65 +6089,238 @@ func (s *LogsSummary) SetGeneral(v bool) *LogsSummary {
 	return s
 }
 
-// SetGeneralLogGroup sets the GeneralLogGroup field's value.
-func (s *LogsSummary) SetGeneralLogGroup(v string) *LogsSummary {
-	s.GeneralLogGroup = &v
+// SetGeneralLogGroup sets the GeneralLogGroup field's value.
+func (s *LogsSummary) SetGeneralLogGroup(v string) *LogsSummary {
+	s.GeneralLogGroup = &v
+	return s
+}
+
+// SetPending sets the Pending field's value.
+func (s *LogsSummary) SetPending(v *PendingLogs) *LogsSummary {
+	s.Pending = v
+	return s
+}
+
+// Returns information about an error.
+type NotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	ErrorAttribute *string `locationName:"errorAttribute" type:"string"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorNotFoundException(v protocol.ResponseMetadata) error {
+	return &NotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *NotFoundException) Code() string {
+	return "NotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *NotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *NotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *NotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *NotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *NotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The list of information about logs to be enabled for the specified broker.
+type PendingLogs struct {
+	_ struct{} `type:"structure"`
+
+	// Enables audit logging. Every user management action made using JMX or the
+	// ActiveMQ Web Console is logged.
+	Audit *bool `locationName:"audit" type:"boolean"`
+
+	// Enables general logging.
+	General *bool `locationName:"general" type:"boolean"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PendingLogs) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PendingLogs) GoString() string {
+	return s.String()
+}
+
+// SetAudit sets the Audit field's value.
+func (s *PendingLogs) SetAudit(v bool) *PendingLogs {
+	s.Audit = &v
+	return s
+}
+
+// SetGeneral sets the General field's value.
+func (s *PendingLogs) SetGeneral(v bool) *PendingLogs {
+	s.General = &v
+	return s
+}
+
+type PromoteInput struct {
+	_ struct{} `type:"structure"`
+
+	// BrokerId is a required field
+	BrokerId *string `location:"uri" locationName:"broker-id" type:"string" required:"true"`
+
+	// The Promote mode requested.
+	//
+	// Mode is a required field
+	Mode *string `locationName:"mode" type:"string" required:"true" enum:"PromoteMode"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PromoteInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PromoteInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *PromoteInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "PromoteInput"}
+	if s.BrokerId == nil {
+		invalidParams.Add(request.NewErrParamRequired("BrokerId"))
+	}
+	if s.BrokerId != nil && len(*s.BrokerId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("BrokerId", 1))
+	}
+	if s.Mode == nil {
+		invalidParams.Add(request.NewErrParamRequired("Mode"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetBrokerId sets the BrokerId field's value.
+func (s *PromoteInput) SetBrokerId(v string) *PromoteInput {
+	s.BrokerId = &v
 	return s
 }
 
-// SetPending sets the Pending field's value.
-func (s *LogsSummary) SetPending(v *PendingLogs) *LogsSummary {
-	s.Pending = v
+// SetMode sets the Mode field's value.
+func (s *PromoteInput) SetMode(v string) *PromoteInput {
+	s.Mode = &v
 	return s
 }
 
-// The list of information about logs to be enabled for the specified broker.
-type PendingLogs struct {
+type PromoteOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Enables audit logging. Every user management action made using JMX or the
-	// ActiveMQ Web Console is logged.
-	Audit *bool `locationName:"audit" type:"boolean"`
-
-	// Enables general logging.
-	General *bool `locationName:"general" type:"boolean"`
+	BrokerId *string `locationName:"brokerId" type:"string"`
 }
 
-// String returns the string representation
-func (s PendingLogs) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PromoteOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s PendingLogs) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PromoteOutput) GoString() string {
 	return s.String()
 }
 
-// SetAudit sets the Audit field's value.
-func (s *PendingLogs) SetAudit(v bool) *PendingLogs {
-	s.Audit = &v
-	return s
-}
-
-// SetGeneral sets the General field's value.
-func (s *PendingLogs) SetGeneral(v bool) *PendingLogs {
-	s.General = &v
+// SetBrokerId sets the BrokerId field's value.
+func (s *PromoteOutput) SetBrokerId(v string) *PromoteOutput {
+	s.BrokerId = &v
 	return s
 }
 
 type RebootBrokerInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// BrokerId is a required field
 	BrokerId *string `location:"uri" locationName:"broker-id" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RebootBrokerInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RebootBrokerInput) GoString() string {
 	return s.String()
 }
@@ -4489,37 +6351,55 @@ type RebootBrokerOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RebootBrokerOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RebootBrokerOutput) GoString() string {
 	return s.String()
 }
 
-// Returns information about the XML element or attribute that was sanitized
-// in the configuration.
+// Returns information about the configuration element or attribute that was
+// sanitized in the configuration.
 type SanitizationWarning struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the XML attribute that has been sanitized.
+	// The name of the configuration attribute that has been sanitized.
 	AttributeName *string `locationName:"attributeName" type:"string"`
 
-	// The name of the XML element that has been sanitized.
+	// The name of the configuration element that has been sanitized.
 	ElementName *string `locationName:"elementName" type:"string"`
 
-	// Required. The reason for which the XML elements or attributes were sanitized.
-	Reason *string `locationName:"reason" type:"string" enum:"SanitizationWarningReason"`
+	// The reason for which the configuration elements or attributes were sanitized.
+	//
+	// Reason is a required field
+	Reason *string `locationName:"reason" type:"string" required:"true" enum:"SanitizationWarningReason"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SanitizationWarning) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SanitizationWarning) GoString() string {
 	return s.String()
 }
@@ -4542,9 +6422,79 @@ func (s *SanitizationWarning) SetReason(v string) *SanitizationWarning {
 	return s
 }
 
+// Returns information about an error.
+type UnauthorizedException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	ErrorAttribute *string `locationName:"errorAttribute" type:"string"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UnauthorizedException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UnauthorizedException) GoString() string {
+	return s.String()
+}
+
+func newErrorUnauthorizedException(v protocol.ResponseMetadata) error {
+	return &UnauthorizedException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *UnauthorizedException) Code() string {
+	return "UnauthorizedException"
+}
+
+// Message returns the exception's message.
+func (s *UnauthorizedException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *UnauthorizedException) OrigErr() error {
+	return nil
+}
+
+func (s *UnauthorizedException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *UnauthorizedException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *UnauthorizedException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 type UpdateBrokerRequest struct {
 	_ struct{} `type:"structure"`
 
+	// Optional. The authentication strategy used to secure the broker. The default
+	// is SIMPLE.
+	AuthenticationStrategy *string `locationName:"authenticationStrategy" type:"string" enum:"AuthenticationStrategy"`
+
 	AutoMinorVersionUpgrade *bool `locationName:"autoMinorVersionUpgrade" type:"boolean"`
 
 	// BrokerId is a required field
@@ -4553,22 +6503,43 @@ type UpdateBrokerRequest struct {
 	// A list of information about the configuration.
 	Configuration *ConfigurationId `locationName:"configuration" type:"structure"`
 
+	// Specifies whether a broker is a part of a data replication pair.
+	DataReplicationMode *string `locationName:"dataReplicationMode" type:"string" enum:"DataReplicationMode"`
+
 	EngineVersion *string `locationName:"engineVersion" type:"string"`
 
 	HostInstanceType *string `locationName:"hostInstanceType" type:"string"`
 
+	// Optional. The metadata of the LDAP server used to authenticate and authorize
+	// connections to the broker.
+	//
+	// Does not apply to RabbitMQ brokers.
+	LdapServerMetadata *LdapServerMetadataInput `locationName:"ldapServerMetadata" type:"structure"`
+
 	// The list of information about logs to be enabled for the specified broker.
 	Logs *Logs `locationName:"logs" type:"structure"`
 
+	// The scheduled time period relative to UTC during which Amazon MQ begins to
+	// apply pending updates or patches to the broker.
+	MaintenanceWindowStartTime *WeeklyStartTime `locationName:"maintenanceWindowStartTime" type:"structure"`
+
 	SecurityGroups []*string `locationName:"securityGroups" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateBrokerRequest) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateBrokerRequest) GoString() string {
 	return s.String()
 }
@@ -4582,6 +6553,21 @@ func (s *UpdateBrokerRequest) Validate() error {
 	if s.BrokerId != nil && len(*s.BrokerId) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("BrokerId", 1))
 	}
+	if s.Configuration != nil {
+		if err := s.Configuration.Validate(); err != nil {
+			invalidParams.AddNested("Configuration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.LdapServerMetadata != nil {
+		if err := s.LdapServerMetadata.Validate(); err != nil {
+			invalidParams.AddNested("LdapServerMetadata", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.MaintenanceWindowStartTime != nil {
+		if err := s.MaintenanceWindowStartTime.Validate(); err != nil {
+			invalidParams.AddNested("MaintenanceWindowStartTime", err.(request.ErrInvalidParams))
+		}
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -4589,6 +6575,12 @@ func (s *UpdateBrokerRequest) Validate() error {
 	return nil
 }
 
+// SetAuthenticationStrategy sets the AuthenticationStrategy field's value.
+func (s *UpdateBrokerRequest) SetAuthenticationStrategy(v string) *UpdateBrokerRequest {
+	s.AuthenticationStrategy = &v
+	return s
+}
+
 // SetAutoMinorVersionUpgrade sets the AutoMinorVersionUpgrade field's value.
 func (s *UpdateBrokerRequest) SetAutoMinorVersionUpgrade(v bool) *UpdateBrokerRequest {
 	s.AutoMinorVersionUpgrade = &v
@@ -4607,6 +6599,12 @@ func (s *UpdateBrokerRequest) SetConfiguration(v *ConfigurationId) *UpdateBroker
 	return s
 }
 
+// SetDataReplicationMode sets the DataReplicationMode field's value.
+func (s *UpdateBrokerRequest) SetDataReplicationMode(v string) *UpdateBrokerRequest {
+	s.DataReplicationMode = &v
+	return s
+}
+
 // SetEngineVersion sets the EngineVersion field's value.
 func (s *UpdateBrokerRequest) SetEngineVersion(v string) *UpdateBrokerRequest {
 	s.EngineVersion = &v
@@ -4619,12 +6617,24 @@ func (s *UpdateBrokerRequest) SetHostInstanceType(v string) *UpdateBrokerRequest
 	return s
 }
 
+// SetLdapServerMetadata sets the LdapServerMetadata field's value.
+func (s *UpdateBrokerRequest) SetLdapServerMetadata(v *LdapServerMetadataInput) *UpdateBrokerRequest {
+	s.LdapServerMetadata = v
+	return s
+}
+
 // SetLogs sets the Logs field's value.
 func (s *UpdateBrokerRequest) SetLogs(v *Logs) *UpdateBrokerRequest {
 	s.Logs = v
 	return s
 }
 
+// SetMaintenanceWindowStartTime sets the MaintenanceWindowStartTime field's value.
+func (s *UpdateBrokerRequest) SetMaintenanceWindowStartTime(v *WeeklyStartTime) *UpdateBrokerRequest {
+	s.MaintenanceWindowStartTime = v
+	return s
+}
+
 // SetSecurityGroups sets the SecurityGroups field's value.
 func (s *UpdateBrokerRequest) SetSecurityGroups(v []*string) *UpdateBrokerRequest {
 	s.SecurityGroups = v
@@ -4634,6 +6644,10 @@ func (s *UpdateBrokerRequest) SetSecurityGroups(v []*string) *UpdateBrokerReques
 type UpdateBrokerResponse struct {
 	_ struct{} `type:"structure"`
 
+	// Optional. The authentication strategy used to secure the broker. The default
+	// is SIMPLE.
+	AuthenticationStrategy *string `locationName:"authenticationStrategy" type:"string" enum:"AuthenticationStrategy"`
+
 	AutoMinorVersionUpgrade *bool `locationName:"autoMinorVersionUpgrade" type:"boolean"`
 
 	BrokerId *string `locationName:"brokerId" type:"string"`
@@ -4641,26 +6655,62 @@ type UpdateBrokerResponse struct {
 	// A list of information about the configuration.
 	Configuration *ConfigurationId `locationName:"configuration" type:"structure"`
 
+	// The replication details of the data replication-enabled broker. Only returned
+	// if dataReplicationMode or pendingDataReplicationMode is set to CRDR.
+	DataReplicationMetadata *DataReplicationMetadataOutput_ `locationName:"dataReplicationMetadata" type:"structure"`
+
+	// Specifies whether a broker is a part of a data replication pair.
+	DataReplicationMode *string `locationName:"dataReplicationMode" type:"string" enum:"DataReplicationMode"`
+
 	EngineVersion *string `locationName:"engineVersion" type:"string"`
 
 	HostInstanceType *string `locationName:"hostInstanceType" type:"string"`
 
+	// Optional. The metadata of the LDAP server used to authenticate and authorize
+	// connections to the broker.
+	LdapServerMetadata *LdapServerMetadataOutput `locationName:"ldapServerMetadata" type:"structure"`
+
 	// The list of information about logs to be enabled for the specified broker.
 	Logs *Logs `locationName:"logs" type:"structure"`
 
+	// The scheduled time period relative to UTC during which Amazon MQ begins to
+	// apply pending updates or patches to the broker.
+	MaintenanceWindowStartTime *WeeklyStartTime `locationName:"maintenanceWindowStartTime" type:"structure"`
+
+	// The replication details of the data replication-enabled broker. Only returned
+	// if dataReplicationMode or pendingDataReplicationMode is set to CRDR.
+	PendingDataReplicationMetadata *DataReplicationMetadataOutput_ `locationName:"pendingDataReplicationMetadata" type:"structure"`
+
+	// Specifies whether a broker is a part of a data replication pair.
+	PendingDataReplicationMode *string `locationName:"pendingDataReplicationMode" type:"string" enum:"DataReplicationMode"`
+
 	SecurityGroups []*string `locationName:"securityGroups" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateBrokerResponse) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateBrokerResponse) GoString() string {
 	return s.String()
 }
 
+// SetAuthenticationStrategy sets the AuthenticationStrategy field's value.
+func (s *UpdateBrokerResponse) SetAuthenticationStrategy(v string) *UpdateBrokerResponse {
+	s.AuthenticationStrategy = &v
+	return s
+}
+
 // SetAutoMinorVersionUpgrade sets the AutoMinorVersionUpgrade field's value.
 func (s *UpdateBrokerResponse) SetAutoMinorVersionUpgrade(v bool) *UpdateBrokerResponse {
 	s.AutoMinorVersionUpgrade = &v
@@ -4679,6 +6729,18 @@ func (s *UpdateBrokerResponse) SetConfiguration(v *ConfigurationId) *UpdateBroke
 	return s
 }
 
+// SetDataReplicationMetadata sets the DataReplicationMetadata field's value.
+func (s *UpdateBrokerResponse) SetDataReplicationMetadata(v *DataReplicationMetadataOutput_) *UpdateBrokerResponse {
+	s.DataReplicationMetadata = v
+	return s
+}
+
+// SetDataReplicationMode sets the DataReplicationMode field's value.
+func (s *UpdateBrokerResponse) SetDataReplicationMode(v string) *UpdateBrokerResponse {
+	s.DataReplicationMode = &v
+	return s
+}
+
 // SetEngineVersion sets the EngineVersion field's value.
 func (s *UpdateBrokerResponse) SetEngineVersion(v string) *UpdateBrokerResponse {
 	s.EngineVersion = &v
@@ -4691,12 +6753,36 @@ func (s *UpdateBrokerResponse) SetHostInstanceType(v string) *UpdateBrokerRespon
 	return s
 }
 
+// SetLdapServerMetadata sets the LdapServerMetadata field's value.
+func (s *UpdateBrokerResponse) SetLdapServerMetadata(v *LdapServerMetadataOutput) *UpdateBrokerResponse {
+	s.LdapServerMetadata = v
+	return s
+}
+
 // SetLogs sets the Logs field's value.
 func (s *UpdateBrokerResponse) SetLogs(v *Logs) *UpdateBrokerResponse {
 	s.Logs = v
 	return s
 }
 
+// SetMaintenanceWindowStartTime sets the MaintenanceWindowStartTime field's value.
+func (s *UpdateBrokerResponse) SetMaintenanceWindowStartTime(v *WeeklyStartTime) *UpdateBrokerResponse {
+	s.MaintenanceWindowStartTime = v
+	return s
+}
+
+// SetPendingDataReplicationMetadata sets the PendingDataReplicationMetadata field's value.
+func (s *UpdateBrokerResponse) SetPendingDataReplicationMetadata(v *DataReplicationMetadataOutput_) *UpdateBrokerResponse {
+	s.PendingDataReplicationMetadata = v
+	return s
+}
+
+// SetPendingDataReplicationMode sets the PendingDataReplicationMode field's value.
+func (s *UpdateBrokerResponse) SetPendingDataReplicationMode(v string) *UpdateBrokerResponse {
+	s.PendingDataReplicationMode = &v
+	return s
+}
+
 // SetSecurityGroups sets the SecurityGroups field's value.
 func (s *UpdateBrokerResponse) SetSecurityGroups(v []*string) *UpdateBrokerResponse {
 	s.SecurityGroups = v
@@ -4709,17 +6795,26 @@ type UpdateConfigurationRequest struct {
 	// ConfigurationId is a required field
 	ConfigurationId *string `location:"uri" locationName:"configuration-id" type:"string" required:"true"`
 
-	Data *string `locationName:"data" type:"string"`
+	// Data is a required field
+	Data *string `locationName:"data" type:"string" required:"true"`
 
 	Description *string `locationName:"description" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConfigurationRequest) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConfigurationRequest) GoString() string {
 	return s.String()
 }
@@ -4733,6 +6828,9 @@ func (s *UpdateConfigurationRequest) Validate() error {
 	if s.ConfigurationId != nil && len(*s.ConfigurationId) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("ConfigurationId", 1))
 	}
+	if s.Data == nil {
+		invalidParams.Add(request.NewErrParamRequired("Data"))
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -4775,12 +6873,20 @@ type UpdateConfigurationResponse struct {
 	Warnings []*SanitizationWarning `locationName:"warnings" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConfigurationResponse) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConfigurationResponse) GoString() string {
 	return s.String()
 }
@@ -4825,12 +6931,20 @@ type UpdateUserOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateUserOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateUserOutput) GoString() string {
 	return s.String()
 }
@@ -4847,16 +6961,26 @@ type UpdateUserRequest struct {
 
 	Password *string `locationName:"password" type:"string"`
 
+	ReplicationUser *bool `locationName:"replicationUser" type:"boolean"`
+
 	// Username is a required field
 	Username *string `location:"uri" locationName:"username" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateUserRequest) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateUserRequest) GoString() string {
 	return s.String()
 }
@@ -4907,45 +7031,100 @@ func (s *UpdateUserRequest) SetPassword(v string) *UpdateUserRequest {
 	return s
 }
 
+// SetReplicationUser sets the ReplicationUser field's value.
+func (s *UpdateUserRequest) SetReplicationUser(v bool) *UpdateUserRequest {
+	s.ReplicationUser = &v
+	return s
+}
+
 // SetUsername sets the Username field's value.
 func (s *UpdateUserRequest) SetUsername(v string) *UpdateUserRequest {
 	s.Username = &v
 	return s
 }
 
-// An ActiveMQ user associated with the broker.
+// A user associated with the broker. For Amazon MQ for RabbitMQ brokers, one
+// and only one administrative user is accepted and created when a broker is
+// first provisioned. All subsequent broker users are created by making RabbitMQ
+// API calls directly to brokers or via the RabbitMQ web console.
 type User struct {
 	_ struct{} `type:"structure"`
 
-	// Enables access to the the ActiveMQ Web Console for the ActiveMQ user.
+	// Enables access to the ActiveMQ Web Console for the ActiveMQ user. Does not
+	// apply to RabbitMQ brokers.
 	ConsoleAccess *bool `locationName:"consoleAccess" type:"boolean"`
 
 	// The list of groups (20 maximum) to which the ActiveMQ user belongs. This
 	// value can contain only alphanumeric characters, dashes, periods, underscores,
-	// and tildes (- . _ ~). This value must be 2-100 characters long.
+	// and tildes (- . _ ~). This value must be 2-100 characters long. Does not
+	// apply to RabbitMQ brokers.
 	Groups []*string `locationName:"groups" type:"list"`
 
-	// Required. The password of the ActiveMQ user. This value must be at least
-	// 12 characters long, must contain at least 4 unique characters, and must not
-	// contain commas.
-	Password *string `locationName:"password" type:"string"`
+	// Required. The password of the user. This value must be at least 12 characters
+	// long, must contain at least 4 unique characters, and must not contain commas,
+	// colons, or equal signs (,:=).
+	//
+	// Password is a required field
+	Password *string `locationName:"password" type:"string" required:"true"`
 
-	// Required. The username of the ActiveMQ user. This value can contain only
-	// alphanumeric characters, dashes, periods, underscores, and tildes (- . _
-	// ~). This value must be 2-100 characters long.
-	Username *string `locationName:"username" type:"string"`
+	// Defines if this user is intended for CRDR replication purposes.
+	ReplicationUser *bool `locationName:"replicationUser" type:"boolean"`
+
+	// The username of the broker user. The following restrictions apply to broker
+	// usernames:
+	//
+	//    * For Amazon MQ for ActiveMQ brokers, this value can contain only alphanumeric
+	//    characters, dashes, periods, underscores, and tildes (- . _ ~). This value
+	//    must be 2-100 characters long.
+	//
+	//    * para>For Amazon MQ for RabbitMQ brokers, this value can contain only
+	//    alphanumeric characters, dashes, periods, underscores (- . _). This value
+	//    must not contain a tilde (~) character. Amazon MQ prohibts using guest
+	//    as a valid usename. This value must be 2-100 characters long.
+	//
+	// Do not add personally identifiable information (PII) or other confidential
+	// or sensitive information in broker usernames. Broker usernames are accessible
+	// to other Amazon Web Services services, including CloudWatch Logs. Broker
+	// usernames are not intended to be used for private or sensitive data.
+	//
+	// Username is a required field
+	Username *string `locationName:"username" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s User) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s User) GoString() string {
 	return s.String()
 }
 
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *User) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "User"}
+	if s.Password == nil {
+		invalidParams.Add(request.NewErrParamRequired("Password"))
+	}
+	if s.Username == nil {
+		invalidParams.Add(request.NewErrParamRequired("Username"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConsoleAccess sets the ConsoleAccess field's value.
 func (s *User) SetConsoleAccess(v bool) *User {
 	s.ConsoleAccess = &v
@@ -4964,6 +7143,12 @@ func (s *User) SetPassword(v string) *User {
 	return s
 }
 
+// SetReplicationUser sets the ReplicationUser field's value.
+func (s *User) SetReplicationUser(v bool) *User {
+	s.ReplicationUser = &v
+	return s
+}
+
 // SetUsername sets the Username field's value.
 func (s *User) SetUsername(v string) *User {
 	s.Username = &v
@@ -4984,15 +7169,25 @@ type UserPendingChanges struct {
 	Groups []*string `locationName:"groups" type:"list"`
 
 	// Required. The type of change pending for the ActiveMQ user.
-	PendingChange *string `locationName:"pendingChange" type:"string" enum:"ChangeType"`
+	//
+	// PendingChange is a required field
+	PendingChange *string `locationName:"pendingChange" type:"string" required:"true" enum:"ChangeType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UserPendingChanges) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UserPendingChanges) GoString() string {
 	return s.String()
 }
@@ -5015,25 +7210,35 @@ func (s *UserPendingChanges) SetPendingChange(v string) *UserPendingChanges {
 	return s
 }
 
-// Returns a list of all ActiveMQ users.
+// Returns a list of all broker users. Does not apply to RabbitMQ brokers.
 type UserSummary struct {
 	_ struct{} `type:"structure"`
 
-	// The type of change pending for the ActiveMQ user.
+	// The type of change pending for the broker user.
 	PendingChange *string `locationName:"pendingChange" type:"string" enum:"ChangeType"`
 
-	// Required. The username of the ActiveMQ user. This value can contain only
-	// alphanumeric characters, dashes, periods, underscores, and tildes (- . _
-	// ~). This value must be 2-100 characters long.
-	Username *string `locationName:"username" type:"string"`
+	// Required. The username of the broker user. This value can contain only alphanumeric
+	// characters, dashes, periods, underscores, and tildes (- . _ ~). This value
+	// must be 2-100 characters long.
+	//
+	// Username is a required field
+	Username *string `locationName:"username" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UserSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UserSummary) GoString() string {
 	return s.String()
 }
@@ -5056,26 +7261,54 @@ type WeeklyStartTime struct {
 	_ struct{} `type:"structure"`
 
 	// Required. The day of the week.
-	DayOfWeek *string `locationName:"dayOfWeek" type:"string" enum:"DayOfWeek"`
+	//
+	// DayOfWeek is a required field
+	DayOfWeek *string `locationName:"dayOfWeek" type:"string" required:"true" enum:"DayOfWeek"`
 
 	// Required. The time, in 24-hour format.
-	TimeOfDay *string `locationName:"timeOfDay" type:"string"`
+	//
+	// TimeOfDay is a required field
+	TimeOfDay *string `locationName:"timeOfDay" type:"string" required:"true"`
 
 	// The time zone, UTC by default, in either the Country/City format, or the
 	// UTC offset format.
 	TimeZone *string `locationName:"timeZone" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s WeeklyStartTime) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s WeeklyStartTime) GoString() string {
 	return s.String()
 }
 
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *WeeklyStartTime) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "WeeklyStartTime"}
+	if s.DayOfWeek == nil {
+		invalidParams.Add(request.NewErrParamRequired("DayOfWeek"))
+	}
+	if s.TimeOfDay == nil {
+		invalidParams.Add(request.NewErrParamRequired("TimeOfDay"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDayOfWeek sets the DayOfWeek field's value.
 func (s *WeeklyStartTime) SetDayOfWeek(v string) *WeeklyStartTime {
 	s.DayOfWeek = &v
@@ -5094,7 +7327,25 @@ func (s *WeeklyStartTime) SetTimeZone(v string) *WeeklyStartTime {
 	return s
 }
 
-// The status of the broker.
+// Optional. The authentication strategy used to secure the broker. The default
+// is SIMPLE.
+const (
+	// AuthenticationStrategySimple is a AuthenticationStrategy enum value
+	AuthenticationStrategySimple = "SIMPLE"
+
+	// AuthenticationStrategyLdap is a AuthenticationStrategy enum value
+	AuthenticationStrategyLdap = "LDAP"
+)
+
+// AuthenticationStrategy_Values returns all elements of the AuthenticationStrategy enum
+func AuthenticationStrategy_Values() []string {
+	return []string{
+		AuthenticationStrategySimple,
+		AuthenticationStrategyLdap,
+	}
+}
+
+// The broker's status.
 const (
 	// BrokerStateCreationInProgress is a BrokerState enum value
 	BrokerStateCreationInProgress = "CREATION_IN_PROGRESS"
@@ -5110,8 +7361,46 @@ const (
 
 	// BrokerStateRebootInProgress is a BrokerState enum value
 	BrokerStateRebootInProgress = "REBOOT_IN_PROGRESS"
+
+	// BrokerStateCriticalActionRequired is a BrokerState enum value
+	BrokerStateCriticalActionRequired = "CRITICAL_ACTION_REQUIRED"
+
+	// BrokerStateReplica is a BrokerState enum value
+	BrokerStateReplica = "REPLICA"
+)
+
+// BrokerState_Values returns all elements of the BrokerState enum
+func BrokerState_Values() []string {
+	return []string{
+		BrokerStateCreationInProgress,
+		BrokerStateCreationFailed,
+		BrokerStateDeletionInProgress,
+		BrokerStateRunning,
+		BrokerStateRebootInProgress,
+		BrokerStateCriticalActionRequired,
+		BrokerStateReplica,
+	}
+}
+
+// The broker's storage type.
+//
+// EFS is not supported for RabbitMQ engine type.
+const (
+	// BrokerStorageTypeEbs is a BrokerStorageType enum value
+	BrokerStorageTypeEbs = "EBS"
+
+	// BrokerStorageTypeEfs is a BrokerStorageType enum value
+	BrokerStorageTypeEfs = "EFS"
 )
 
+// BrokerStorageType_Values returns all elements of the BrokerStorageType enum
+func BrokerStorageType_Values() []string {
+	return []string{
+		BrokerStorageTypeEbs,
+		BrokerStorageTypeEfs,
+	}
+}
+
 // The type of change pending for the ActiveMQ user.
 const (
 	// ChangeTypeCreate is a ChangeType enum value
@@ -5124,6 +7413,32 @@ const (
 	ChangeTypeDelete = "DELETE"
 )
 
+// ChangeType_Values returns all elements of the ChangeType enum
+func ChangeType_Values() []string {
+	return []string{
+		ChangeTypeCreate,
+		ChangeTypeUpdate,
+		ChangeTypeDelete,
+	}
+}
+
+// Specifies whether a broker is a part of a data replication pair.
+const (
+	// DataReplicationModeNone is a DataReplicationMode enum value
+	DataReplicationModeNone = "NONE"
+
+	// DataReplicationModeCrdr is a DataReplicationMode enum value
+	DataReplicationModeCrdr = "CRDR"
+)
+
+// DataReplicationMode_Values returns all elements of the DataReplicationMode enum
+func DataReplicationMode_Values() []string {
+	return []string{
+		DataReplicationModeNone,
+		DataReplicationModeCrdr,
+	}
+}
+
 const (
 	// DayOfWeekMonday is a DayOfWeek enum value
 	DayOfWeekMonday = "MONDAY"
@@ -5147,22 +7462,75 @@ const (
 	DayOfWeekSunday = "SUNDAY"
 )
 
-// The deployment mode of the broker.
+// DayOfWeek_Values returns all elements of the DayOfWeek enum
+func DayOfWeek_Values() []string {
+	return []string{
+		DayOfWeekMonday,
+		DayOfWeekTuesday,
+		DayOfWeekWednesday,
+		DayOfWeekThursday,
+		DayOfWeekFriday,
+		DayOfWeekSaturday,
+		DayOfWeekSunday,
+	}
+}
+
+// The broker's deployment mode.
 const (
 	// DeploymentModeSingleInstance is a DeploymentMode enum value
 	DeploymentModeSingleInstance = "SINGLE_INSTANCE"
 
 	// DeploymentModeActiveStandbyMultiAz is a DeploymentMode enum value
 	DeploymentModeActiveStandbyMultiAz = "ACTIVE_STANDBY_MULTI_AZ"
+
+	// DeploymentModeClusterMultiAz is a DeploymentMode enum value
+	DeploymentModeClusterMultiAz = "CLUSTER_MULTI_AZ"
 )
 
-// The type of broker engine. Note: Currently, Amazon MQ supports only ActiveMQ.
+// DeploymentMode_Values returns all elements of the DeploymentMode enum
+func DeploymentMode_Values() []string {
+	return []string{
+		DeploymentModeSingleInstance,
+		DeploymentModeActiveStandbyMultiAz,
+		DeploymentModeClusterMultiAz,
+	}
+}
+
+// The type of broker engine. Amazon MQ supports ActiveMQ and RabbitMQ.
 const (
 	// EngineTypeActivemq is a EngineType enum value
 	EngineTypeActivemq = "ACTIVEMQ"
+
+	// EngineTypeRabbitmq is a EngineType enum value
+	EngineTypeRabbitmq = "RABBITMQ"
+)
+
+// EngineType_Values returns all elements of the EngineType enum
+func EngineType_Values() []string {
+	return []string{
+		EngineTypeActivemq,
+		EngineTypeRabbitmq,
+	}
+}
+
+// The Promote mode requested.
+const (
+	// PromoteModeSwitchover is a PromoteMode enum value
+	PromoteModeSwitchover = "SWITCHOVER"
+
+	// PromoteModeFailover is a PromoteMode enum value
+	PromoteModeFailover = "FAILOVER"
 )
 
-// The reason for which the XML elements or attributes were sanitized.
+// PromoteMode_Values returns all elements of the PromoteMode enum
+func PromoteMode_Values() []string {
+	return []string{
+		PromoteModeSwitchover,
+		PromoteModeFailover,
+	}
+}
+
+// The reason for which the configuration elements or attributes were sanitized.
 const (
 	// SanitizationWarningReasonDisallowedElementRemoved is a SanitizationWarningReason enum value
 	SanitizationWarningReasonDisallowedElementRemoved = "DISALLOWED_ELEMENT_REMOVED"
@@ -5173,3 +7541,12 @@ const (
 	// SanitizationWarningReasonInvalidAttributeValueRemoved is a SanitizationWarningReason enum value
 	SanitizationWarningReasonInvalidAttributeValueRemoved = "INVALID_ATTRIBUTE_VALUE_REMOVED"
 )
+
+// SanitizationWarningReason_Values returns all elements of the SanitizationWarningReason enum
+func SanitizationWarningReason_Values() []string {
+	return []string{
+		SanitizationWarningReasonDisallowedElementRemoved,
+		SanitizationWarningReasonDisallowedAttributeRemoved,
+		SanitizationWarningReasonInvalidAttributeValueRemoved,
+	}
+}
@@ -28,7 +28,7 @@
 // See neptune package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/neptune/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon Neptune with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
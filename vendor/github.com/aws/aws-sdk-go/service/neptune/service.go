@@ -31,7 +31,7 @@ var initRequest func(*request.Request)
 const (
 	ServiceName = "rds"       // Name of service.
 	EndpointsID = ServiceName // ID to lookup a service endpoint with.
-	ServiceID   = "Neptune"   // ServiceID is a unique identifer of a specific service.
+	ServiceID   = "Neptune"   // ServiceID is a unique identifier of a specific service.
 )
 
 // New creates a new instance of the Neptune client with a session.
@@ -39,31 +39,36 @@ const (
 // aws.Config parameter to add your extra config.
 //
 // Example:
-//     // Create a Neptune client from just a session.
-//     svc := neptune.New(mySession)
 //
-//     // Create a Neptune client with additional configuration
-//     svc := neptune.New(mySession, aws.NewConfig().WithRegion("us-west-2"))
+//	mySession := session.Must(session.NewSession())
+//
+//	// Create a Neptune client from just a session.
+//	svc := neptune.New(mySession)
+//
+//	// Create a Neptune client with additional configuration
+//	svc := neptune.New(mySession, aws.NewConfig().WithRegion("us-west-2"))
 func New(p client.ConfigProvider, cfgs ...*aws.Config) *Neptune {
 	c := p.ClientConfig(EndpointsID, cfgs...)
 	if c.SigningNameDerived || len(c.SigningName) == 0 {
 		c.SigningName = "rds"
 	}
-	return newClient(*c.Config, c.Handlers, c.Endpoint, c.SigningRegion, c.SigningName)
+	return newClient(*c.Config, c.Handlers, c.PartitionID, c.Endpoint, c.SigningRegion, c.SigningName, c.ResolvedRegion)
 }
 
 // newClient creates, initializes and returns a new service client instance.
-func newClient(cfg aws.Config, handlers request.Handlers, endpoint, signingRegion, signingName string) *Neptune {
+func newClient(cfg aws.Config, handlers request.Handlers, partitionID, endpoint, signingRegion, signingName, resolvedRegion string) *Neptune {
 	svc := &Neptune{
 		Client: client.New(
 			cfg,
 			metadata.ClientInfo{
-				ServiceName:   ServiceName,
-				ServiceID:     ServiceID,
-				SigningName:   signingName,
-				SigningRegion: signingRegion,
-				Endpoint:      endpoint,
-				APIVersion:    "2014-10-31",
+				ServiceName:    ServiceName,
+				ServiceID:      ServiceID,
+				SigningName:    signingName,
+				SigningRegion:  signingRegion,
+				PartitionID:    partitionID,
+				Endpoint:       endpoint,
+				APIVersion:     "2014-10-31",
+				ResolvedRegion: resolvedRegion,
 			},
 			handlers,
 		),
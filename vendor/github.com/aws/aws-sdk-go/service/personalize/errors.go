@@ -2,6 +2,10 @@
 
 package personalize
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeInvalidInputException for service response error code
@@ -39,4 +43,28 @@ const (
 	//
 	// Could not find the specified resource.
 	ErrCodeResourceNotFoundException = "ResourceNotFoundException"
+
+	// ErrCodeTooManyTagKeysException for service response error code
+	// "TooManyTagKeysException".
+	//
+	// The request contains more tag keys than can be associated with a resource
+	// (50 tag keys per resource).
+	ErrCodeTooManyTagKeysException = "TooManyTagKeysException"
+
+	// ErrCodeTooManyTagsException for service response error code
+	// "TooManyTagsException".
+	//
+	// You have exceeded the maximum number of tags you can apply to this resource.
+	ErrCodeTooManyTagsException = "TooManyTagsException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"InvalidInputException":          newErrorInvalidInputException,
+	"InvalidNextTokenException":      newErrorInvalidNextTokenException,
+	"LimitExceededException":         newErrorLimitExceededException,
+	"ResourceAlreadyExistsException": newErrorResourceAlreadyExistsException,
+	"ResourceInUseException":         newErrorResourceInUseException,
+	"ResourceNotFoundException":      newErrorResourceNotFoundException,
+	"TooManyTagKeysException":        newErrorTooManyTagKeysException,
+	"TooManyTagsException":           newErrorTooManyTagsException,
+}
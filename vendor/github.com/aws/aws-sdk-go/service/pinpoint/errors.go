@@ -2,6 +2,10 @@
 
 package pinpoint
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeBadRequestException for service response error code
@@ -10,6 +14,12 @@ const (
 	// Provides information about an API request or response.
 	ErrCodeBadRequestException = "BadRequestException"
 
+	// ErrCodeConflictException for service response error code
+	// "ConflictException".
+	//
+	// Provides information about an API request or response.
+	ErrCodeConflictException = "ConflictException"
+
 	// ErrCodeForbiddenException for service response error code
 	// "ForbiddenException".
 	//
@@ -34,9 +44,26 @@ const (
 	// Provides information about an API request or response.
 	ErrCodeNotFoundException = "NotFoundException"
 
+	// ErrCodePayloadTooLargeException for service response error code
+	// "PayloadTooLargeException".
+	//
+	// Provides information about an API request or response.
+	ErrCodePayloadTooLargeException = "PayloadTooLargeException"
+
 	// ErrCodeTooManyRequestsException for service response error code
 	// "TooManyRequestsException".
 	//
 	// Provides information about an API request or response.
 	ErrCodeTooManyRequestsException = "TooManyRequestsException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"BadRequestException":          newErrorBadRequestException,
+	"ConflictException":            newErrorConflictException,
+	"ForbiddenException":           newErrorForbiddenException,
+	"InternalServerErrorException": newErrorInternalServerErrorException,
+	"MethodNotAllowedException":    newErrorMethodNotAllowedException,
+	"NotFoundException":            newErrorNotFoundException,
+	"PayloadTooLargeException":     newErrorPayloadTooLargeException,
+	"TooManyRequestsException":     newErrorTooManyRequestsException,
+}
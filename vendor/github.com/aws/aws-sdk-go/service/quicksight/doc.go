@@ -3,17 +3,17 @@
 // Package quicksight provides the client and types for making API
 // requests to Amazon QuickSight.
 //
-// Amazon QuickSight is a fully managed, serverless, cloud business intelligence
-// service that makes it easy to extend data and insights to every user in your
-// organization. This API interface reference contains documentation for a programming
-// interface that you can use to manage Amazon QuickSight.
+// Amazon QuickSight is a fully managed, serverless business intelligence service
+// for the Amazon Web Services Cloud that makes it easy to extend data and insights
+// to every user in your organization. This API reference contains documentation
+// for a programming interface that you can use to manage Amazon QuickSight.
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/quicksight-2018-04-01 for more information on this service.
 //
 // See quicksight package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/quicksight/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon QuickSight with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
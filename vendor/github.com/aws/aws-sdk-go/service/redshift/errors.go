@@ -4,6 +4,12 @@ package redshift
 
 const (
 
+	// ErrCodeAccessToClusterDeniedFault for service response error code
+	// "AccessToClusterDenied".
+	//
+	// You are not authorized to access the cluster.
+	ErrCodeAccessToClusterDeniedFault = "AccessToClusterDenied"
+
 	// ErrCodeAccessToSnapshotDeniedFault for service response error code
 	// "AccessToSnapshotDenied".
 	//
@@ -11,6 +17,26 @@ const (
 	// the snapshot.
 	ErrCodeAccessToSnapshotDeniedFault = "AccessToSnapshotDenied"
 
+	// ErrCodeAuthenticationProfileAlreadyExistsFault for service response error code
+	// "AuthenticationProfileAlreadyExistsFault".
+	//
+	// The authentication profile already exists.
+	ErrCodeAuthenticationProfileAlreadyExistsFault = "AuthenticationProfileAlreadyExistsFault"
+
+	// ErrCodeAuthenticationProfileNotFoundFault for service response error code
+	// "AuthenticationProfileNotFoundFault".
+	//
+	// The authentication profile can't be found.
+	ErrCodeAuthenticationProfileNotFoundFault = "AuthenticationProfileNotFoundFault"
+
+	// ErrCodeAuthenticationProfileQuotaExceededFault for service response error code
+	// "AuthenticationProfileQuotaExceededFault".
+	//
+	// The size or number of authentication profiles has exceeded the quota. The
+	// maximum length of the JSON string and maximum number of authentication profiles
+	// is determined by a quota for your account.
+	ErrCodeAuthenticationProfileQuotaExceededFault = "AuthenticationProfileQuotaExceededFault"
+
 	// ErrCodeAuthorizationAlreadyExistsFault for service response error code
 	// "AuthorizationAlreadyExists".
 	//
@@ -178,6 +204,18 @@ const (
 	// Cross-region snapshot copy was temporarily disabled. Try your request again.
 	ErrCodeCopyToRegionDisabledFault = "CopyToRegionDisabledFault"
 
+	// ErrCodeCustomCnameAssociationFault for service response error code
+	// "CustomCnameAssociationFault".
+	//
+	// An error occurred when an attempt was made to change the custom domain association.
+	ErrCodeCustomCnameAssociationFault = "CustomCnameAssociationFault"
+
+	// ErrCodeCustomDomainAssociationNotFoundFault for service response error code
+	// "CustomDomainAssociationNotFoundFault".
+	//
+	// An error occurred. The custom domain name couldn't be found.
+	ErrCodeCustomDomainAssociationNotFoundFault = "CustomDomainAssociationNotFoundFault"
+
 	// ErrCodeDependentServiceRequestThrottlingFault for service response error code
 	// "DependentServiceRequestThrottlingFault".
 	//
@@ -192,6 +230,50 @@ const (
 	// temporarily unavailable. Wait 30 to 60 seconds and try again.
 	ErrCodeDependentServiceUnavailableFault = "DependentServiceUnavailableFault"
 
+	// ErrCodeEndpointAlreadyExistsFault for service response error code
+	// "EndpointAlreadyExists".
+	//
+	// The account already has a Redshift-managed VPC endpoint with the given identifier.
+	ErrCodeEndpointAlreadyExistsFault = "EndpointAlreadyExists"
+
+	// ErrCodeEndpointAuthorizationAlreadyExistsFault for service response error code
+	// "EndpointAuthorizationAlreadyExists".
+	//
+	// The authorization already exists for this endpoint.
+	ErrCodeEndpointAuthorizationAlreadyExistsFault = "EndpointAuthorizationAlreadyExists"
+
+	// ErrCodeEndpointAuthorizationNotFoundFault for service response error code
+	// "EndpointAuthorizationNotFound".
+	//
+	// The authorization for this endpoint can't be found.
+	ErrCodeEndpointAuthorizationNotFoundFault = "EndpointAuthorizationNotFound"
+
+	// ErrCodeEndpointAuthorizationsPerClusterLimitExceededFault for service response error code
+	// "EndpointAuthorizationsPerClusterLimitExceeded".
+	//
+	// The number of endpoint authorizations per cluster has exceeded its limit.
+	ErrCodeEndpointAuthorizationsPerClusterLimitExceededFault = "EndpointAuthorizationsPerClusterLimitExceeded"
+
+	// ErrCodeEndpointNotFoundFault for service response error code
+	// "EndpointNotFound".
+	//
+	// The endpoint name doesn't refer to an existing endpoint.
+	ErrCodeEndpointNotFoundFault = "EndpointNotFound"
+
+	// ErrCodeEndpointsPerAuthorizationLimitExceededFault for service response error code
+	// "EndpointsPerAuthorizationLimitExceeded".
+	//
+	// The number of Redshift-managed VPC endpoints per authorization has exceeded
+	// its limit.
+	ErrCodeEndpointsPerAuthorizationLimitExceededFault = "EndpointsPerAuthorizationLimitExceeded"
+
+	// ErrCodeEndpointsPerClusterLimitExceededFault for service response error code
+	// "EndpointsPerClusterLimitExceeded".
+	//
+	// The number of Redshift-managed VPC endpoints per cluster has exceeded its
+	// limit.
+	ErrCodeEndpointsPerClusterLimitExceededFault = "EndpointsPerClusterLimitExceeded"
+
 	// ErrCodeEventSubscriptionQuotaExceededFault for service response error code
 	// "EventSubscriptionQuotaExceeded".
 	//
@@ -269,6 +351,20 @@ const (
 	// bucket specified when enabling logging.
 	ErrCodeInsufficientS3BucketPolicyFault = "InsufficientS3BucketPolicyFault"
 
+	// ErrCodeInvalidAuthenticationProfileRequestFault for service response error code
+	// "InvalidAuthenticationProfileRequestFault".
+	//
+	// The authentication profile request is not valid. The profile name can't be
+	// null or empty. The authentication profile API operation must be available
+	// in the Amazon Web Services Region.
+	ErrCodeInvalidAuthenticationProfileRequestFault = "InvalidAuthenticationProfileRequestFault"
+
+	// ErrCodeInvalidAuthorizationStateFault for service response error code
+	// "InvalidAuthorizationState".
+	//
+	// The status of the authorization is not valid.
+	ErrCodeInvalidAuthorizationStateFault = "InvalidAuthorizationState"
+
 	// ErrCodeInvalidClusterParameterGroupStateFault for service response error code
 	// "InvalidClusterParameterGroupState".
 	//
@@ -320,12 +416,24 @@ const (
 	// The provided cluster track name is not valid.
 	ErrCodeInvalidClusterTrackFault = "InvalidClusterTrack"
 
+	// ErrCodeInvalidDataShareFault for service response error code
+	// "InvalidDataShareFault".
+	//
+	// There is an error with the datashare.
+	ErrCodeInvalidDataShareFault = "InvalidDataShareFault"
+
 	// ErrCodeInvalidElasticIpFault for service response error code
 	// "InvalidElasticIpFault".
 	//
 	// The Elastic IP (EIP) is invalid or cannot be found.
 	ErrCodeInvalidElasticIpFault = "InvalidElasticIpFault"
 
+	// ErrCodeInvalidEndpointStateFault for service response error code
+	// "InvalidEndpointState".
+	//
+	// The status of the endpoint is not valid.
+	ErrCodeInvalidEndpointStateFault = "InvalidEndpointState"
+
 	// ErrCodeInvalidHsmClientCertificateStateFault for service response error code
 	// "InvalidHsmClientCertificateStateFault".
 	//
@@ -340,6 +448,13 @@ const (
 	// in use by one or more Amazon Redshift clusters.
 	ErrCodeInvalidHsmConfigurationStateFault = "InvalidHsmConfigurationStateFault"
 
+	// ErrCodeInvalidNamespaceFault for service response error code
+	// "InvalidNamespaceFault".
+	//
+	// The namespace isn't valid because the namespace doesn't exist. Provide a
+	// valid namespace.
+	ErrCodeInvalidNamespaceFault = "InvalidNamespaceFault"
+
 	// ErrCodeInvalidReservedNodeStateFault for service response error code
 	// "InvalidReservedNodeState".
 	//
@@ -381,6 +496,12 @@ const (
 	// The schedule you submitted isn't valid.
 	ErrCodeInvalidScheduleFault = "InvalidSchedule"
 
+	// ErrCodeInvalidScheduledActionFault for service response error code
+	// "InvalidScheduledAction".
+	//
+	// The scheduled action is not valid.
+	ErrCodeInvalidScheduledActionFault = "InvalidScheduledAction"
+
 	// ErrCodeInvalidSnapshotCopyGrantStateFault for service response error code
 	// "InvalidSnapshotCopyGrantStateFault".
 	//
@@ -415,6 +536,12 @@ const (
 	// The tag is invalid.
 	ErrCodeInvalidTagFault = "InvalidTagFault"
 
+	// ErrCodeInvalidUsageLimitFault for service response error code
+	// "InvalidUsageLimit".
+	//
+	// The usage limit is not valid.
+	ErrCodeInvalidUsageLimitFault = "InvalidUsageLimit"
+
 	// ErrCodeInvalidVPCNetworkStateFault for service response error code
 	// "InvalidVPCNetworkStateFault".
 	//
@@ -424,7 +551,7 @@ const (
 	// ErrCodeLimitExceededFault for service response error code
 	// "LimitExceededFault".
 	//
-	// The encryption key has exceeded its grant limit in AWS KMS.
+	// The encryption key has exceeded its grant limit in Amazon Web Services KMS.
 	ErrCodeLimitExceededFault = "LimitExceededFault"
 
 	// ErrCodeNumberOfNodesPerClusterLimitExceededFault for service response error code
@@ -442,6 +569,12 @@ const (
 	// in the Amazon Redshift Cluster Management Guide.
 	ErrCodeNumberOfNodesQuotaExceededFault = "NumberOfNodesQuotaExceeded"
 
+	// ErrCodePartnerNotFoundFault for service response error code
+	// "PartnerNotFound".
+	//
+	// The name of the partner was not found.
+	ErrCodePartnerNotFoundFault = "PartnerNotFound"
+
 	// ErrCodeReservedNodeAlreadyExistsFault for service response error code
 	// "ReservedNodeAlreadyExists".
 	//
@@ -454,6 +587,12 @@ const (
 	// Indicates that the reserved node has already been exchanged.
 	ErrCodeReservedNodeAlreadyMigratedFault = "ReservedNodeAlreadyMigrated"
 
+	// ErrCodeReservedNodeExchangeNotFoundFault for service response error code
+	// "ReservedNodeExchangeNotFond".
+	//
+	// The reserved-node exchange status wasn't found.
+	ErrCodeReservedNodeExchangeNotFoundFault = "ReservedNodeExchangeNotFond"
+
 	// ErrCodeReservedNodeNotFoundFault for service response error code
 	// "ReservedNodeNotFound".
 	//
@@ -512,6 +651,30 @@ const (
 	// The definition you submitted is not supported.
 	ErrCodeScheduleDefinitionTypeUnsupportedFault = "ScheduleDefinitionTypeUnsupported"
 
+	// ErrCodeScheduledActionAlreadyExistsFault for service response error code
+	// "ScheduledActionAlreadyExists".
+	//
+	// The scheduled action already exists.
+	ErrCodeScheduledActionAlreadyExistsFault = "ScheduledActionAlreadyExists"
+
+	// ErrCodeScheduledActionNotFoundFault for service response error code
+	// "ScheduledActionNotFound".
+	//
+	// The scheduled action cannot be found.
+	ErrCodeScheduledActionNotFoundFault = "ScheduledActionNotFound"
+
+	// ErrCodeScheduledActionQuotaExceededFault for service response error code
+	// "ScheduledActionQuotaExceeded".
+	//
+	// The quota for scheduled actions exceeded.
+	ErrCodeScheduledActionQuotaExceededFault = "ScheduledActionQuotaExceeded"
+
+	// ErrCodeScheduledActionTypeUnsupportedFault for service response error code
+	// "ScheduledActionTypeUnsupported".
+	//
+	// The action type specified for a scheduled action is not supported.
+	ErrCodeScheduledActionTypeUnsupportedFault = "ScheduledActionTypeUnsupported"
+
 	// ErrCodeSnapshotCopyAlreadyDisabledFault for service response error code
 	// "SnapshotCopyAlreadyDisabledFault".
 	//
@@ -547,8 +710,8 @@ const (
 	// ErrCodeSnapshotCopyGrantQuotaExceededFault for service response error code
 	// "SnapshotCopyGrantQuotaExceededFault".
 	//
-	// The AWS account has exceeded the maximum number of snapshot copy grants in
-	// this region.
+	// The Amazon Web Services account has exceeded the maximum number of snapshot
+	// copy grants in this region.
 	ErrCodeSnapshotCopyGrantQuotaExceededFault = "SnapshotCopyGrantQuotaExceededFault"
 
 	// ErrCodeSnapshotScheduleAlreadyExistsFault for service response error code
@@ -648,6 +811,12 @@ const (
 	// Your account is not authorized to perform the requested operation.
 	ErrCodeUnauthorizedOperation = "UnauthorizedOperation"
 
+	// ErrCodeUnauthorizedPartnerIntegrationFault for service response error code
+	// "UnauthorizedPartnerIntegration".
+	//
+	// The partner integration is not authorized.
+	ErrCodeUnauthorizedPartnerIntegrationFault = "UnauthorizedPartnerIntegration"
+
 	// ErrCodeUnknownSnapshotCopyRegionFault for service response error code
 	// "UnknownSnapshotCopyRegionFault".
 	//
@@ -665,4 +834,16 @@ const (
 	//
 	// A request option was specified that is not supported.
 	ErrCodeUnsupportedOptionFault = "UnsupportedOptionFault"
+
+	// ErrCodeUsageLimitAlreadyExistsFault for service response error code
+	// "UsageLimitAlreadyExists".
+	//
+	// The usage limit already exists.
+	ErrCodeUsageLimitAlreadyExistsFault = "UsageLimitAlreadyExists"
+
+	// ErrCodeUsageLimitNotFoundFault for service response error code
+	// "UsageLimitNotFound".
+	//
+	// The usage limit identifier can't be found.
+	ErrCodeUsageLimitNotFoundFault = "UsageLimitNotFound"
 )
@@ -13,6 +13,105 @@ import (
 	"github.com/aws/aws-sdk-go/private/protocol/restxml"
 )
 
+const opActivateKeySigningKey = "ActivateKeySigningKey"
+
+// ActivateKeySigningKeyRequest generates a "aws/request.Request" representing the
+// client's request for the ActivateKeySigningKey operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ActivateKeySigningKey for more information on using the ActivateKeySigningKey
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ActivateKeySigningKeyRequest method.
+//	req, resp := client.ActivateKeySigningKeyRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ActivateKeySigningKey
+func (c *Route53) ActivateKeySigningKeyRequest(input *ActivateKeySigningKeyInput) (req *request.Request, output *ActivateKeySigningKeyOutput) {
+	op := &request.Operation{
+		Name:       opActivateKeySigningKey,
+		HTTPMethod: "POST",
+		HTTPPath:   "/2013-04-01/keysigningkey/{HostedZoneId}/{Name}/activate",
+	}
+
+	if input == nil {
+		input = &ActivateKeySigningKeyInput{}
+	}
+
+	output = &ActivateKeySigningKeyOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ActivateKeySigningKey API operation for Amazon Route 53.
+//
+// Activates a key-signing key (KSK) so that it can be used for signing by DNSSEC.
+// This operation changes the KSK status to ACTIVE.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Route 53's
+// API operation ActivateKeySigningKey for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeConcurrentModification "ConcurrentModification"
+//     Another user submitted a request to create, update, or delete the object
+//     at the same time that you did. Retry the request.
+//
+//   - ErrCodeNoSuchKeySigningKey "NoSuchKeySigningKey"
+//     The specified key-signing key (KSK) doesn't exist.
+//
+//   - ErrCodeInvalidKeySigningKeyStatus "InvalidKeySigningKeyStatus"
+//     The key-signing key (KSK) status isn't valid or another KSK has the status
+//     INTERNAL_FAILURE.
+//
+//   - ErrCodeInvalidSigningStatus "InvalidSigningStatus"
+//     Your hosted zone status isn't valid for this operation. In the hosted zone,
+//     change the status to enable DNSSEC or disable DNSSEC.
+//
+//   - ErrCodeInvalidKMSArn "InvalidKMSArn"
+//     The KeyManagementServiceArn that you specified isn't valid to use with DNSSEC
+//     signing.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ActivateKeySigningKey
+func (c *Route53) ActivateKeySigningKey(input *ActivateKeySigningKeyInput) (*ActivateKeySigningKeyOutput, error) {
+	req, out := c.ActivateKeySigningKeyRequest(input)
+	return out, req.Send()
+}
+
+// ActivateKeySigningKeyWithContext is the same as ActivateKeySigningKey with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ActivateKeySigningKey for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Route53) ActivateKeySigningKeyWithContext(ctx aws.Context, input *ActivateKeySigningKeyInput, opts ...request.Option) (*ActivateKeySigningKeyOutput, error) {
+	req, out := c.ActivateKeySigningKeyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opAssociateVPCWithHostedZone = "AssociateVPCWithHostedZone"
 
 // AssociateVPCWithHostedZoneRequest generates a "aws/request.Request" representing the
@@ -29,14 +128,13 @@ const opAssociateVPCWithHostedZone = "AssociateVPCWithHostedZone"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AssociateVPCWithHostedZoneRequest method.
+//	req, resp := client.AssociateVPCWithHostedZoneRequest(params)
 //
-//    // Example sending a request using the AssociateVPCWithHostedZoneRequest method.
-//    req, resp := client.AssociateVPCWithHostedZoneRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/AssociateVPCWithHostedZone
 func (c *Route53) AssociateVPCWithHostedZoneRequest(input *AssociateVPCWithHostedZoneInput) (req *request.Request, output *AssociateVPCWithHostedZoneOutput) {
@@ -62,11 +160,27 @@ func (c *Route53) AssociateVPCWithHostedZoneRequest(input *AssociateVPCWithHoste
 // To perform the association, the VPC and the private hosted zone must already
 // exist. You can't convert a public hosted zone into a private hosted zone.
 //
-// If you want to associate a VPC that was created by using one AWS account
-// with a private hosted zone that was created by using a different account,
-// the AWS account that created the private hosted zone must first submit a
-// CreateVPCAssociationAuthorization request. Then the account that created
-// the VPC must submit an AssociateVPCWithHostedZone request.
+// If you want to associate a VPC that was created by using one Amazon Web Services
+// account with a private hosted zone that was created by using a different
+// account, the Amazon Web Services account that created the private hosted
+// zone must first submit a CreateVPCAssociationAuthorization request. Then
+// the account that created the VPC must submit an AssociateVPCWithHostedZone
+// request.
+//
+// When granting access, the hosted zone and the Amazon VPC must belong to the
+// same partition. A partition is a group of Amazon Web Services Regions. Each
+// Amazon Web Services account is scoped to one partition.
+//
+// The following are the supported partitions:
+//
+//   - aws - Amazon Web Services Regions
+//
+//   - aws-cn - China Regions
+//
+//   - aws-us-gov - Amazon Web Services GovCloud (US) Region
+//
+// For more information, see Access Management (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
+// in the Amazon Web Services General Reference.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -76,52 +190,55 @@ func (c *Route53) AssociateVPCWithHostedZoneRequest(input *AssociateVPCWithHoste
 // API operation AssociateVPCWithHostedZone for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchHostedZone "NoSuchHostedZone"
-//   No hosted zone exists with the ID that you specified.
-//
-//   * ErrCodeNotAuthorizedException "NotAuthorizedException"
-//   Associating the specified VPC with the specified hosted zone has not been
-//   authorized.
-//
-//   * ErrCodeInvalidVPCId "InvalidVPCId"
-//   The VPC ID that you specified either isn't a valid ID or the current account
-//   is not authorized to access this VPC.
-//
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
-//
-//   * ErrCodePublicZoneVPCAssociation "PublicZoneVPCAssociation"
-//   You're trying to associate a VPC with a public hosted zone. Amazon Route
-//   53 doesn't support associating a VPC with a public hosted zone.
-//
-//   * ErrCodeConflictingDomainExists "ConflictingDomainExists"
-//   The cause of this error depends on whether you're trying to create a public
-//   or a private hosted zone:
-//
-//      * Public hosted zone: Two hosted zones that have the same name or that
-//      have a parent/child relationship (example.com and test.example.com) can't
-//      have any common name servers. You tried to create a hosted zone that has
-//      the same name as an existing hosted zone or that's the parent or child
-//      of an existing hosted zone, and you specified a delegation set that shares
-//      one or more name servers with the existing hosted zone. For more information,
-//      see CreateReusableDelegationSet (https://docs.aws.amazon.com/Route53/latest/APIReference/API_CreateReusableDelegationSet.html).
-//
-//      * Private hosted zone: You specified an Amazon VPC that you're already
-//      using for another hosted zone, and the domain that you specified for one
-//      of the hosted zones is a subdomain of the domain that you specified for
-//      the other hosted zone. For example, you can't use the same Amazon VPC
-//      for the hosted zones for example.com and test.example.com.
-//
-//   * ErrCodeLimitsExceeded "LimitsExceeded"
-//   This operation can't be completed either because the current account has
-//   reached the limit on reusable delegation sets that it can create or because
-//   you've reached the limit on the number of Amazon VPCs that you can associate
-//   with a private hosted zone. To get the current limit on the number of reusable
-//   delegation sets, see GetAccountLimit (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetAccountLimit.html).
-//   To get the current limit on the number of Amazon VPCs that you can associate
-//   with a private hosted zone, see GetHostedZoneLimit (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetHostedZoneLimit.html).
-//   To request a higher limit, create a case (http://aws.amazon.com/route53-request)
-//   with the AWS Support Center.
+//
+//   - ErrCodeNoSuchHostedZone "NoSuchHostedZone"
+//     No hosted zone exists with the ID that you specified.
+//
+//   - ErrCodeNotAuthorizedException "NotAuthorizedException"
+//     Associating the specified VPC with the specified hosted zone has not been
+//     authorized.
+//
+//   - ErrCodeInvalidVPCId "InvalidVPCId"
+//     The VPC ID that you specified either isn't a valid ID or the current account
+//     is not authorized to access this VPC.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+//   - ErrCodePublicZoneVPCAssociation "PublicZoneVPCAssociation"
+//     You're trying to associate a VPC with a public hosted zone. Amazon Route
+//     53 doesn't support associating a VPC with a public hosted zone.
+//
+//   - ErrCodeConflictingDomainExists "ConflictingDomainExists"
+//     The cause of this error depends on the operation that you're performing:
+//
+//   - Create a public hosted zone: Two hosted zones that have the same name
+//     or that have a parent/child relationship (example.com and test.example.com)
+//     can't have any common name servers. You tried to create a hosted zone
+//     that has the same name as an existing hosted zone or that's the parent
+//     or child of an existing hosted zone, and you specified a delegation set
+//     that shares one or more name servers with the existing hosted zone. For
+//     more information, see CreateReusableDelegationSet (https://docs.aws.amazon.com/Route53/latest/APIReference/API_CreateReusableDelegationSet.html).
+//
+//   - Create a private hosted zone: A hosted zone with the specified name
+//     already exists and is already associated with the Amazon VPC that you
+//     specified.
+//
+//   - Associate VPCs with a private hosted zone: The VPC that you specified
+//     is already associated with another hosted zone that has the same name.
+//
+//   - ErrCodeLimitsExceeded "LimitsExceeded"
+//     This operation can't be completed because the current account has reached
+//     the limit on the resource you are trying to create. To request a higher limit,
+//     create a case (http://aws.amazon.com/route53-request) with the Amazon Web
+//     Services Support Center.
+//
+//   - ErrCodePriorRequestNotComplete "PriorRequestNotComplete"
+//     If Amazon Route 53 can't process a request before the next request arrives,
+//     it will reject subsequent requests for the same hosted zone and return an
+//     HTTP 400 error (Bad request). If Route 53 returns this error repeatedly for
+//     the same request, we recommend that you wait, in intervals of increasing
+//     duration, before you try the request again.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/AssociateVPCWithHostedZone
 func (c *Route53) AssociateVPCWithHostedZone(input *AssociateVPCWithHostedZoneInput) (*AssociateVPCWithHostedZoneOutput, error) {
@@ -145,6 +262,123 @@ func (c *Route53) AssociateVPCWithHostedZoneWithContext(ctx aws.Context, input *
 	return out, req.Send()
 }
 
+const opChangeCidrCollection = "ChangeCidrCollection"
+
+// ChangeCidrCollectionRequest generates a "aws/request.Request" representing the
+// client's request for the ChangeCidrCollection operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ChangeCidrCollection for more information on using the ChangeCidrCollection
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ChangeCidrCollectionRequest method.
+//	req, resp := client.ChangeCidrCollectionRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ChangeCidrCollection
+func (c *Route53) ChangeCidrCollectionRequest(input *ChangeCidrCollectionInput) (req *request.Request, output *ChangeCidrCollectionOutput) {
+	op := &request.Operation{
+		Name:       opChangeCidrCollection,
+		HTTPMethod: "POST",
+		HTTPPath:   "/2013-04-01/cidrcollection/{CidrCollectionId}",
+	}
+
+	if input == nil {
+		input = &ChangeCidrCollectionInput{}
+	}
+
+	output = &ChangeCidrCollectionOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ChangeCidrCollection API operation for Amazon Route 53.
+//
+// Creates, changes, or deletes CIDR blocks within a collection. Contains authoritative
+// IP information mapping blocks to one or multiple locations.
+//
+// A change request can update multiple locations in a collection at a time,
+// which is helpful if you want to move one or more CIDR blocks from one location
+// to another in one transaction, without downtime.
+//
+// # Limits
+//
+// The max number of CIDR blocks included in the request is 1000. As a result,
+// big updates require multiple API calls.
+//
+//	PUT and DELETE_IF_EXISTS
+//
+// Use ChangeCidrCollection to perform the following actions:
+//
+//   - PUT: Create a CIDR block within the specified collection.
+//
+//   - DELETE_IF_EXISTS: Delete an existing CIDR block from the collection.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Route 53's
+// API operation ChangeCidrCollection for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeNoSuchCidrCollectionException "NoSuchCidrCollectionException"
+//     The CIDR collection you specified, doesn't exist.
+//
+//   - ErrCodeCidrCollectionVersionMismatchException "CidrCollectionVersionMismatchException"
+//     The CIDR collection version you provided, doesn't match the one in the ListCidrCollections
+//     operation.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+//   - ErrCodeCidrBlockInUseException "CidrBlockInUseException"
+//     This CIDR block is already in use.
+//
+//   - ErrCodeLimitsExceeded "LimitsExceeded"
+//     This operation can't be completed because the current account has reached
+//     the limit on the resource you are trying to create. To request a higher limit,
+//     create a case (http://aws.amazon.com/route53-request) with the Amazon Web
+//     Services Support Center.
+//
+//   - ErrCodeConcurrentModification "ConcurrentModification"
+//     Another user submitted a request to create, update, or delete the object
+//     at the same time that you did. Retry the request.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ChangeCidrCollection
+func (c *Route53) ChangeCidrCollection(input *ChangeCidrCollectionInput) (*ChangeCidrCollectionOutput, error) {
+	req, out := c.ChangeCidrCollectionRequest(input)
+	return out, req.Send()
+}
+
+// ChangeCidrCollectionWithContext is the same as ChangeCidrCollection with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ChangeCidrCollection for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Route53) ChangeCidrCollectionWithContext(ctx aws.Context, input *ChangeCidrCollectionInput, opts ...request.Option) (*ChangeCidrCollectionOutput, error) {
+	req, out := c.ChangeCidrCollectionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opChangeResourceRecordSets = "ChangeResourceRecordSets"
 
 // ChangeResourceRecordSetsRequest generates a "aws/request.Request" representing the
@@ -161,14 +395,13 @@ const opChangeResourceRecordSets = "ChangeResourceRecordSets"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ChangeResourceRecordSetsRequest method.
+//	req, resp := client.ChangeResourceRecordSetsRequest(params)
 //
-//    // Example sending a request using the ChangeResourceRecordSetsRequest method.
-//    req, resp := client.ChangeResourceRecordSetsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ChangeResourceRecordSets
 func (c *Route53) ChangeResourceRecordSetsRequest(input *ChangeResourceRecordSetsInput) (req *request.Request, output *ChangeResourceRecordSetsOutput) {
@@ -195,29 +428,32 @@ func (c *Route53) ChangeResourceRecordSetsRequest(input *ChangeResourceRecordSet
 // routes traffic for test.example.com to a web server that has an IP address
 // of 192.0.2.44.
 //
-// Change Batches and Transactional Changes
+// # Deleting Resource Record Sets
+//
+// To delete a resource record set, you must specify all the same values that
+// you specified when you created it.
+//
+// # Change Batches and Transactional Changes
 //
 // The request body must include a document with a ChangeResourceRecordSetsRequest
 // element. The request body contains a list of change items, known as a change
-// batch. Change batches are considered transactional changes. When using the
-// Amazon Route 53 API to change resource record sets, Route 53 either makes
-// all or none of the changes in a change batch request. This ensures that Route
-// 53 never partially implements the intended changes to the resource record
-// sets in a hosted zone.
-//
-// For example, a change batch request that deletes the CNAME record for www.example.com
-// and creates an alias resource record set for www.example.com. Route 53 deletes
-// the first resource record set and creates the second resource record set
-// in a single operation. If either the DELETE or the CREATE action fails, then
-// both changes (plus any other changes in the batch) fail, and the original
-// CNAME record continues to exist.
-//
-// Due to the nature of transactional changes, you can't delete the same resource
-// record set more than once in a single change batch. If you attempt to delete
-// the same change batch more than once, Route 53 returns an InvalidChangeBatch
-// error.
-//
-// Traffic Flow
+// batch. Change batches are considered transactional changes. Route 53 validates
+// the changes in the request and then either makes all or none of the changes
+// in the change batch request. This ensures that DNS routing isn't adversely
+// affected by partial changes to the resource record sets in a hosted zone.
+//
+// For example, suppose a change batch request contains two changes: it deletes
+// the CNAME resource record set for www.example.com and creates an alias resource
+// record set for www.example.com. If validation for both records succeeds,
+// Route 53 deletes the first resource record set and creates the second resource
+// record set in a single operation. If validation for either the DELETE or
+// the CREATE action fails, then the request is canceled, and the original CNAME
+// record continues to exist.
+//
+// If you try to delete the same resource record set more than once in a single
+// change batch, Route 53 returns an InvalidChangeBatch error.
+//
+// # Traffic Flow
 //
 // To create resource record sets for complex routing configurations, use either
 // the traffic flow visual editor in the Route 53 console or the API actions
@@ -226,23 +462,22 @@ func (c *Route53) ChangeResourceRecordSetsRequest(input *ChangeResourceRecordSet
 // names (such as example.com) or subdomain names (such as www.example.com),
 // in the same hosted zone or in multiple hosted zones. You can roll back the
 // updates if the new configuration isn't performing as expected. For more information,
-// see Using Traffic Flow to Route DNS Traffic (http://docs.aws.amazon.com/Route53/latest/DeveloperGuide/traffic-flow.html)
+// see Using Traffic Flow to Route DNS Traffic (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/traffic-flow.html)
 // in the Amazon Route 53 Developer Guide.
 //
-// Create, Delete, and Upsert
+// # Create, Delete, and Upsert
 //
 // Use ChangeResourceRecordsSetsRequest to perform the following actions:
 //
-//    * CREATE: Creates a resource record set that has the specified values.
+//   - CREATE: Creates a resource record set that has the specified values.
 //
-//    * DELETE: Deletes an existing resource record set that has the specified
-//    values.
+//   - DELETE: Deletes an existing resource record set that has the specified
+//     values.
 //
-//    * UPSERT: If a resource record set does not already exist, AWS creates
-//    it. If a resource set does exist, Route 53 updates it with the values
-//    in the request.
+//   - UPSERT: If a resource set doesn't exist, Route 53 creates it. If a resource
+//     set exists Route 53 updates it with the values in the request.
 //
-// Syntaxes for Creating, Updating, and Deleting Resource Record Sets
+// # Syntaxes for Creating, Updating, and Deleting Resource Record Sets
 //
 // The syntax for a request depends on the type of resource record set that
 // you want to create, delete, or update, such as weighted, alias, or failover.
@@ -254,16 +489,16 @@ func (c *Route53) ChangeResourceRecordSetsRequest(input *ChangeResourceRecordSet
 // all of the elements for every kind of resource record set that you can create,
 // delete, or update by using ChangeResourceRecordSets.
 //
-// Change Propagation to Route 53 DNS Servers
+// # Change Propagation to Route 53 DNS Servers
 //
 // When you submit a ChangeResourceRecordSets request, Route 53 propagates your
-// changes to all of the Route 53 authoritative DNS servers. While your changes
-// are propagating, GetChange returns a status of PENDING. When propagation
-// is complete, GetChange returns a status of INSYNC. Changes generally propagate
-// to all Route 53 name servers within 60 seconds. For more information, see
-// GetChange (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetChange.html).
+// changes to all of the Route 53 authoritative DNS servers managing the hosted
+// zone. While your changes are propagating, GetChange returns a status of PENDING.
+// When propagation is complete, GetChange returns a status of INSYNC. Changes
+// generally propagate to all Route 53 name servers managing the hosted zone
+// within 60 seconds. For more information, see GetChange (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetChange.html).
 //
-// Limits on ChangeResourceRecordSets Requests
+// # Limits on ChangeResourceRecordSets Requests
 //
 // For information about the limits on a ChangeResourceRecordSets request, see
 // Limits (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html)
@@ -277,25 +512,26 @@ func (c *Route53) ChangeResourceRecordSetsRequest(input *ChangeResourceRecordSet
 // API operation ChangeResourceRecordSets for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchHostedZone "NoSuchHostedZone"
-//   No hosted zone exists with the ID that you specified.
 //
-//   * ErrCodeNoSuchHealthCheck "NoSuchHealthCheck"
-//   No health check exists with the specified ID.
+//   - ErrCodeNoSuchHostedZone "NoSuchHostedZone"
+//     No hosted zone exists with the ID that you specified.
 //
-//   * ErrCodeInvalidChangeBatch "InvalidChangeBatch"
-//   This exception contains a list of messages that might contain one or more
-//   error messages. Each error message indicates one error in the change batch.
+//   - ErrCodeNoSuchHealthCheck "NoSuchHealthCheck"
+//     No health check exists with the specified ID.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeInvalidChangeBatch "InvalidChangeBatch"
+//     This exception contains a list of messages that might contain one or more
+//     error messages. Each error message indicates one error in the change batch.
 //
-//   * ErrCodePriorRequestNotComplete "PriorRequestNotComplete"
-//   If Amazon Route 53 can't process a request before the next request arrives,
-//   it will reject subsequent requests for the same hosted zone and return an
-//   HTTP 400 error (Bad request). If Route 53 returns this error repeatedly for
-//   the same request, we recommend that you wait, in intervals of increasing
-//   duration, before you try the request again.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+//   - ErrCodePriorRequestNotComplete "PriorRequestNotComplete"
+//     If Amazon Route 53 can't process a request before the next request arrives,
+//     it will reject subsequent requests for the same hosted zone and return an
+//     HTTP 400 error (Bad request). If Route 53 returns this error repeatedly for
+//     the same request, we recommend that you wait, in intervals of increasing
+//     duration, before you try the request again.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ChangeResourceRecordSets
 func (c *Route53) ChangeResourceRecordSets(input *ChangeResourceRecordSetsInput) (*ChangeResourceRecordSetsOutput, error) {
@@ -335,14 +571,13 @@ const opChangeTagsForResource = "ChangeTagsForResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ChangeTagsForResourceRequest method.
+//	req, resp := client.ChangeTagsForResourceRequest(params)
 //
-//    // Example sending a request using the ChangeTagsForResourceRequest method.
-//    req, resp := client.ChangeTagsForResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ChangeTagsForResource
 func (c *Route53) ChangeTagsForResourceRequest(input *ChangeTagsForResourceInput) (req *request.Request, output *ChangeTagsForResourceOutput) {
@@ -368,7 +603,7 @@ func (c *Route53) ChangeTagsForResourceRequest(input *ChangeTagsForResourceInput
 //
 // For information about using tags for cost allocation, see Using Cost Allocation
 // Tags (https://docs.aws.amazon.com/awsaccountbilling/latest/aboutv2/cost-alloc-tags.html)
-// in the AWS Billing and Cost Management User Guide.
+// in the Billing and Cost Management User Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -378,24 +613,25 @@ func (c *Route53) ChangeTagsForResourceRequest(input *ChangeTagsForResourceInput
 // API operation ChangeTagsForResource for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
 //
-//   * ErrCodeNoSuchHealthCheck "NoSuchHealthCheck"
-//   No health check exists with the specified ID.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-//   * ErrCodeNoSuchHostedZone "NoSuchHostedZone"
-//   No hosted zone exists with the ID that you specified.
+//   - ErrCodeNoSuchHealthCheck "NoSuchHealthCheck"
+//     No health check exists with the specified ID.
 //
-//   * ErrCodePriorRequestNotComplete "PriorRequestNotComplete"
-//   If Amazon Route 53 can't process a request before the next request arrives,
-//   it will reject subsequent requests for the same hosted zone and return an
-//   HTTP 400 error (Bad request). If Route 53 returns this error repeatedly for
-//   the same request, we recommend that you wait, in intervals of increasing
-//   duration, before you try the request again.
+//   - ErrCodeNoSuchHostedZone "NoSuchHostedZone"
+//     No hosted zone exists with the ID that you specified.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The limit on the number of requests per second was exceeded.
+//   - ErrCodePriorRequestNotComplete "PriorRequestNotComplete"
+//     If Amazon Route 53 can't process a request before the next request arrives,
+//     it will reject subsequent requests for the same hosted zone and return an
+//     HTTP 400 error (Bad request). If Route 53 returns this error repeatedly for
+//     the same request, we recommend that you wait, in intervals of increasing
+//     duration, before you try the request again.
+//
+//   - ErrCodeThrottlingException "ThrottlingException"
+//     The limit on the number of requests per second was exceeded.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ChangeTagsForResource
 func (c *Route53) ChangeTagsForResource(input *ChangeTagsForResourceInput) (*ChangeTagsForResourceOutput, error) {
@@ -419,6 +655,99 @@ func (c *Route53) ChangeTagsForResourceWithContext(ctx aws.Context, input *Chang
 	return out, req.Send()
 }
 
+const opCreateCidrCollection = "CreateCidrCollection"
+
+// CreateCidrCollectionRequest generates a "aws/request.Request" representing the
+// client's request for the CreateCidrCollection operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See CreateCidrCollection for more information on using the CreateCidrCollection
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the CreateCidrCollectionRequest method.
+//	req, resp := client.CreateCidrCollectionRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/CreateCidrCollection
+func (c *Route53) CreateCidrCollectionRequest(input *CreateCidrCollectionInput) (req *request.Request, output *CreateCidrCollectionOutput) {
+	op := &request.Operation{
+		Name:       opCreateCidrCollection,
+		HTTPMethod: "POST",
+		HTTPPath:   "/2013-04-01/cidrcollection",
+	}
+
+	if input == nil {
+		input = &CreateCidrCollectionInput{}
+	}
+
+	output = &CreateCidrCollectionOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// CreateCidrCollection API operation for Amazon Route 53.
+//
+// Creates a CIDR collection in the current Amazon Web Services account.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Route 53's
+// API operation CreateCidrCollection for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeLimitsExceeded "LimitsExceeded"
+//     This operation can't be completed because the current account has reached
+//     the limit on the resource you are trying to create. To request a higher limit,
+//     create a case (http://aws.amazon.com/route53-request) with the Amazon Web
+//     Services Support Center.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+//   - ErrCodeCidrCollectionAlreadyExistsException "CidrCollectionAlreadyExistsException"
+//     A CIDR collection with this name and a different caller reference already
+//     exists in this account.
+//
+//   - ErrCodeConcurrentModification "ConcurrentModification"
+//     Another user submitted a request to create, update, or delete the object
+//     at the same time that you did. Retry the request.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/CreateCidrCollection
+func (c *Route53) CreateCidrCollection(input *CreateCidrCollectionInput) (*CreateCidrCollectionOutput, error) {
+	req, out := c.CreateCidrCollectionRequest(input)
+	return out, req.Send()
+}
+
+// CreateCidrCollectionWithContext is the same as CreateCidrCollection with the addition of
+// the ability to pass a context and additional request options.
+//
+// See CreateCidrCollection for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Route53) CreateCidrCollectionWithContext(ctx aws.Context, input *CreateCidrCollectionInput, opts ...request.Option) (*CreateCidrCollectionOutput, error) {
+	req, out := c.CreateCidrCollectionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opCreateHealthCheck = "CreateHealthCheck"
 
 // CreateHealthCheckRequest generates a "aws/request.Request" representing the
@@ -435,14 +764,13 @@ const opCreateHealthCheck = "CreateHealthCheck"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateHealthCheckRequest method.
+//	req, resp := client.CreateHealthCheckRequest(params)
 //
-//    // Example sending a request using the CreateHealthCheckRequest method.
-//    req, resp := client.CreateHealthCheckRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/CreateHealthCheck
 func (c *Route53) CreateHealthCheckRequest(input *CreateHealthCheckInput) (req *request.Request, output *CreateHealthCheckOutput) {
@@ -469,7 +797,7 @@ func (c *Route53) CreateHealthCheckRequest(input *CreateHealthCheckInput) (req *
 // (https://docs.aws.amazon.com/Route53/latest/APIReference/API_ResourceRecordSet.html#Route53-Type-ResourceRecordSet-HealthCheckId)
 // in ChangeResourceRecordSets (https://docs.aws.amazon.com/Route53/latest/APIReference/API_ChangeResourceRecordSets.html).
 //
-// ELB Load Balancers
+// # ELB Load Balancers
 //
 // If you're registering EC2 instances with an Elastic Load Balancing (ELB)
 // load balancer, do not create Amazon Route 53 health checks for the EC2 instances.
@@ -477,25 +805,25 @@ func (c *Route53) CreateHealthCheckRequest(input *CreateHealthCheckInput) (req *
 // for an ELB health check, which performs a similar function to a Route 53
 // health check.
 //
-// Private Hosted Zones
+// # Private Hosted Zones
 //
 // You can associate health checks with failover resource record sets in a private
 // hosted zone. Note the following:
 //
-//    * Route 53 health checkers are outside the VPC. To check the health of
-//    an endpoint within a VPC by IP address, you must assign a public IP address
-//    to the instance in the VPC.
+//   - Route 53 health checkers are outside the VPC. To check the health of
+//     an endpoint within a VPC by IP address, you must assign a public IP address
+//     to the instance in the VPC.
 //
-//    * You can configure a health checker to check the health of an external
-//    resource that the instance relies on, such as a database server.
+//   - You can configure a health checker to check the health of an external
+//     resource that the instance relies on, such as a database server.
 //
-//    * You can create a CloudWatch metric, associate an alarm with the metric,
-//    and then create a health check that is based on the state of the alarm.
-//    For example, you might create a CloudWatch metric that checks the status
-//    of the Amazon EC2 StatusCheckFailed metric, add an alarm to the metric,
-//    and then create a health check that is based on the state of the alarm.
-//    For information about creating CloudWatch metrics and alarms by using
-//    the CloudWatch console, see the Amazon CloudWatch User Guide (http://docs.aws.amazon.com/AmazonCloudWatch/latest/DeveloperGuide/WhatIsCloudWatch.html).
+//   - You can create a CloudWatch metric, associate an alarm with the metric,
+//     and then create a health check that is based on the state of the alarm.
+//     For example, you might create a CloudWatch metric that checks the status
+//     of the Amazon EC2 StatusCheckFailed metric, add an alarm to the metric,
+//     and then create a health check that is based on the state of the alarm.
+//     For information about creating CloudWatch metrics and alarms by using
+//     the CloudWatch console, see the Amazon CloudWatch User Guide (https://docs.aws.amazon.com/AmazonCloudWatch/latest/DeveloperGuide/WhatIsCloudWatch.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -505,35 +833,36 @@ func (c *Route53) CreateHealthCheckRequest(input *CreateHealthCheckInput) (req *
 // API operation CreateHealthCheck for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeTooManyHealthChecks "TooManyHealthChecks"
-//   This health check can't be created because the current account has reached
-//   the limit on the number of active health checks.
 //
-//   For information about default limits, see Limits (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html)
-//   in the Amazon Route 53 Developer Guide.
+//   - ErrCodeTooManyHealthChecks "TooManyHealthChecks"
+//     This health check can't be created because the current account has reached
+//     the limit on the number of active health checks.
 //
-//   For information about how to get the current limit for an account, see GetAccountLimit
-//   (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetAccountLimit.html).
-//   To request a higher limit, create a case (http://aws.amazon.com/route53-request)
-//   with the AWS Support Center.
+//     For information about default limits, see Limits (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html)
+//     in the Amazon Route 53 Developer Guide.
 //
-//   You have reached the maximum number of active health checks for an AWS account.
-//   To request a higher limit, create a case (http://aws.amazon.com/route53-request)
-//   with the AWS Support Center.
+//     For information about how to get the current limit for an account, see GetAccountLimit
+//     (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetAccountLimit.html).
+//     To request a higher limit, create a case (http://aws.amazon.com/route53-request)
+//     with the Amazon Web Services Support Center.
 //
-//   * ErrCodeHealthCheckAlreadyExists "HealthCheckAlreadyExists"
-//   The health check you're attempting to create already exists. Amazon Route
-//   53 returns this error when you submit a request that has the following values:
+//     You have reached the maximum number of active health checks for an Amazon
+//     Web Services account. To request a higher limit, create a case (http://aws.amazon.com/route53-request)
+//     with the Amazon Web Services Support Center.
 //
-//      * The same value for CallerReference as an existing health check, and
-//      one or more values that differ from the existing health check that has
-//      the same caller reference.
+//   - ErrCodeHealthCheckAlreadyExists "HealthCheckAlreadyExists"
+//     The health check you're attempting to create already exists. Amazon Route
+//     53 returns this error when you submit a request that has the following values:
 //
-//      * The same value for CallerReference as a health check that you created
-//      and later deleted, regardless of the other settings in the request.
+//   - The same value for CallerReference as an existing health check, and
+//     one or more values that differ from the existing health check that has
+//     the same caller reference.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - The same value for CallerReference as a health check that you created
+//     and later deleted, regardless of the other settings in the request.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/CreateHealthCheck
 func (c *Route53) CreateHealthCheck(input *CreateHealthCheckInput) (*CreateHealthCheckOutput, error) {
@@ -573,14 +902,13 @@ const opCreateHostedZone = "CreateHostedZone"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateHostedZoneRequest method.
+//	req, resp := client.CreateHostedZoneRequest(params)
 //
-//    // Example sending a request using the CreateHostedZoneRequest method.
-//    req, resp := client.CreateHostedZoneRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/CreateHostedZone
 func (c *Route53) CreateHostedZoneRequest(input *CreateHostedZoneInput) (req *request.Request, output *CreateHostedZoneOutput) {
@@ -617,29 +945,47 @@ func (c *Route53) CreateHostedZoneRequest(input *CreateHostedZoneInput) (req *re
 //
 // Note the following:
 //
-//    * You can't create a hosted zone for a top-level domain (TLD) such as
-//    .com.
+//   - You can't create a hosted zone for a top-level domain (TLD) such as
+//     .com.
 //
-//    * For public hosted zones, Amazon Route 53 automatically creates a default
-//    SOA record and four NS records for the zone. For more information about
-//    SOA and NS records, see NS and SOA Records that Route 53 Creates for a
-//    Hosted Zone (http://docs.aws.amazon.com/Route53/latest/DeveloperGuide/SOA-NSrecords.html)
-//    in the Amazon Route 53 Developer Guide. If you want to use the same name
-//    servers for multiple public hosted zones, you can optionally associate
-//    a reusable delegation set with the hosted zone. See the DelegationSetId
-//    element.
+//   - For public hosted zones, Route 53 automatically creates a default SOA
+//     record and four NS records for the zone. For more information about SOA
+//     and NS records, see NS and SOA Records that Route 53 Creates for a Hosted
+//     Zone (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/SOA-NSrecords.html)
+//     in the Amazon Route 53 Developer Guide. If you want to use the same name
+//     servers for multiple public hosted zones, you can optionally associate
+//     a reusable delegation set with the hosted zone. See the DelegationSetId
+//     element.
 //
-//    * If your domain is registered with a registrar other than Route 53, you
-//    must update the name servers with your registrar to make Route 53 the
-//    DNS service for the domain. For more information, see Migrating DNS Service
-//    for an Existing Domain to Amazon Route 53 (http://docs.aws.amazon.com/Route53/latest/DeveloperGuide/MigratingDNS.html)
-//    in the Amazon Route 53 Developer Guide.
+//   - If your domain is registered with a registrar other than Route 53, you
+//     must update the name servers with your registrar to make Route 53 the
+//     DNS service for the domain. For more information, see Migrating DNS Service
+//     for an Existing Domain to Amazon Route 53 (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/MigratingDNS.html)
+//     in the Amazon Route 53 Developer Guide.
 //
 // When you submit a CreateHostedZone request, the initial status of the hosted
 // zone is PENDING. For public hosted zones, this means that the NS and SOA
 // records are not yet available on all Route 53 DNS servers. When the NS and
 // SOA records are available, the status of the zone changes to INSYNC.
 //
+// The CreateHostedZone request requires the caller to have an ec2:DescribeVpcs
+// permission.
+//
+// When creating private hosted zones, the Amazon VPC must belong to the same
+// partition where the hosted zone is created. A partition is a group of Amazon
+// Web Services Regions. Each Amazon Web Services account is scoped to one partition.
+//
+// The following are the supported partitions:
+//
+//   - aws - Amazon Web Services Regions
+//
+//   - aws-cn - China Regions
+//
+//   - aws-us-gov - Amazon Web Services GovCloud (US) Region
+//
+// For more information, see Access Management (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
+// in the Amazon Web Services General Reference.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -648,69 +994,70 @@ func (c *Route53) CreateHostedZoneRequest(input *CreateHostedZoneInput) (req *re
 // API operation CreateHostedZone for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidDomainName "InvalidDomainName"
-//   The specified domain name is not valid.
-//
-//   * ErrCodeHostedZoneAlreadyExists "HostedZoneAlreadyExists"
-//   The hosted zone you're trying to create already exists. Amazon Route 53 returns
-//   this error when a hosted zone has already been created with the specified
-//   CallerReference.
-//
-//   * ErrCodeTooManyHostedZones "TooManyHostedZones"
-//   This operation can't be completed either because the current account has
-//   reached the limit on the number of hosted zones or because you've reached
-//   the limit on the number of hosted zones that can be associated with a reusable
-//   delegation set.
-//
-//   For information about default limits, see Limits (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html)
-//   in the Amazon Route 53 Developer Guide.
-//
-//   To get the current limit on hosted zones that can be created by an account,
-//   see GetAccountLimit (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetAccountLimit.html).
-//
-//   To get the current limit on hosted zones that can be associated with a reusable
-//   delegation set, see GetReusableDelegationSetLimit (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetReusableDelegationSetLimit.html).
-//
-//   To request a higher limit, create a case (http://aws.amazon.com/route53-request)
-//   with the AWS Support Center.
-//
-//   * ErrCodeInvalidVPCId "InvalidVPCId"
-//   The VPC ID that you specified either isn't a valid ID or the current account
-//   is not authorized to access this VPC.
-//
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
-//
-//   * ErrCodeDelegationSetNotAvailable "DelegationSetNotAvailable"
-//   You can create a hosted zone that has the same name as an existing hosted
-//   zone (example.com is common), but there is a limit to the number of hosted
-//   zones that have the same name. If you get this error, Amazon Route 53 has
-//   reached that limit. If you own the domain name and Route 53 generates this
-//   error, contact Customer Support.
-//
-//   * ErrCodeConflictingDomainExists "ConflictingDomainExists"
-//   The cause of this error depends on whether you're trying to create a public
-//   or a private hosted zone:
-//
-//      * Public hosted zone: Two hosted zones that have the same name or that
-//      have a parent/child relationship (example.com and test.example.com) can't
-//      have any common name servers. You tried to create a hosted zone that has
-//      the same name as an existing hosted zone or that's the parent or child
-//      of an existing hosted zone, and you specified a delegation set that shares
-//      one or more name servers with the existing hosted zone. For more information,
-//      see CreateReusableDelegationSet (https://docs.aws.amazon.com/Route53/latest/APIReference/API_CreateReusableDelegationSet.html).
-//
-//      * Private hosted zone: You specified an Amazon VPC that you're already
-//      using for another hosted zone, and the domain that you specified for one
-//      of the hosted zones is a subdomain of the domain that you specified for
-//      the other hosted zone. For example, you can't use the same Amazon VPC
-//      for the hosted zones for example.com and test.example.com.
-//
-//   * ErrCodeNoSuchDelegationSet "NoSuchDelegationSet"
-//   A reusable delegation set with the specified ID does not exist.
-//
-//   * ErrCodeDelegationSetNotReusable "DelegationSetNotReusable"
-//   A reusable delegation set with the specified ID does not exist.
+//
+//   - ErrCodeInvalidDomainName "InvalidDomainName"
+//     The specified domain name is not valid.
+//
+//   - ErrCodeHostedZoneAlreadyExists "HostedZoneAlreadyExists"
+//     The hosted zone you're trying to create already exists. Amazon Route 53 returns
+//     this error when a hosted zone has already been created with the specified
+//     CallerReference.
+//
+//   - ErrCodeTooManyHostedZones "TooManyHostedZones"
+//     This operation can't be completed either because the current account has
+//     reached the limit on the number of hosted zones or because you've reached
+//     the limit on the number of hosted zones that can be associated with a reusable
+//     delegation set.
+//
+//     For information about default limits, see Limits (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html)
+//     in the Amazon Route 53 Developer Guide.
+//
+//     To get the current limit on hosted zones that can be created by an account,
+//     see GetAccountLimit (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetAccountLimit.html).
+//
+//     To get the current limit on hosted zones that can be associated with a reusable
+//     delegation set, see GetReusableDelegationSetLimit (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetReusableDelegationSetLimit.html).
+//
+//     To request a higher limit, create a case (http://aws.amazon.com/route53-request)
+//     with the Amazon Web Services Support Center.
+//
+//   - ErrCodeInvalidVPCId "InvalidVPCId"
+//     The VPC ID that you specified either isn't a valid ID or the current account
+//     is not authorized to access this VPC.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+//   - ErrCodeDelegationSetNotAvailable "DelegationSetNotAvailable"
+//     You can create a hosted zone that has the same name as an existing hosted
+//     zone (example.com is common), but there is a limit to the number of hosted
+//     zones that have the same name. If you get this error, Amazon Route 53 has
+//     reached that limit. If you own the domain name and Route 53 generates this
+//     error, contact Customer Support.
+//
+//   - ErrCodeConflictingDomainExists "ConflictingDomainExists"
+//     The cause of this error depends on the operation that you're performing:
+//
+//   - Create a public hosted zone: Two hosted zones that have the same name
+//     or that have a parent/child relationship (example.com and test.example.com)
+//     can't have any common name servers. You tried to create a hosted zone
+//     that has the same name as an existing hosted zone or that's the parent
+//     or child of an existing hosted zone, and you specified a delegation set
+//     that shares one or more name servers with the existing hosted zone. For
+//     more information, see CreateReusableDelegationSet (https://docs.aws.amazon.com/Route53/latest/APIReference/API_CreateReusableDelegationSet.html).
+//
+//   - Create a private hosted zone: A hosted zone with the specified name
+//     already exists and is already associated with the Amazon VPC that you
+//     specified.
+//
+//   - Associate VPCs with a private hosted zone: The VPC that you specified
+//     is already associated with another hosted zone that has the same name.
+//
+//   - ErrCodeNoSuchDelegationSet "NoSuchDelegationSet"
+//     A reusable delegation set with the specified ID does not exist.
+//
+//   - ErrCodeDelegationSetNotReusable "DelegationSetNotReusable"
+//     A reusable delegation set with the specified ID does not exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/CreateHostedZone
 func (c *Route53) CreateHostedZone(input *CreateHostedZoneInput) (*CreateHostedZoneOutput, error) {
@@ -734,91 +1081,203 @@ func (c *Route53) CreateHostedZoneWithContext(ctx aws.Context, input *CreateHost
 	return out, req.Send()
 }
 
-const opCreateQueryLoggingConfig = "CreateQueryLoggingConfig"
+const opCreateKeySigningKey = "CreateKeySigningKey"
 
-// CreateQueryLoggingConfigRequest generates a "aws/request.Request" representing the
-// client's request for the CreateQueryLoggingConfig operation. The "output" return
+// CreateKeySigningKeyRequest generates a "aws/request.Request" representing the
+// client's request for the CreateKeySigningKey operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See CreateQueryLoggingConfig for more information on using the CreateQueryLoggingConfig
+// See CreateKeySigningKey for more information on using the CreateKeySigningKey
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateKeySigningKeyRequest method.
+//	req, resp := client.CreateKeySigningKeyRequest(params)
 //
-//    // Example sending a request using the CreateQueryLoggingConfigRequest method.
-//    req, resp := client.CreateQueryLoggingConfigRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/CreateQueryLoggingConfig
-func (c *Route53) CreateQueryLoggingConfigRequest(input *CreateQueryLoggingConfigInput) (req *request.Request, output *CreateQueryLoggingConfigOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/CreateKeySigningKey
+func (c *Route53) CreateKeySigningKeyRequest(input *CreateKeySigningKeyInput) (req *request.Request, output *CreateKeySigningKeyOutput) {
 	op := &request.Operation{
-		Name:       opCreateQueryLoggingConfig,
+		Name:       opCreateKeySigningKey,
 		HTTPMethod: "POST",
-		HTTPPath:   "/2013-04-01/queryloggingconfig",
+		HTTPPath:   "/2013-04-01/keysigningkey",
 	}
 
 	if input == nil {
-		input = &CreateQueryLoggingConfigInput{}
+		input = &CreateKeySigningKeyInput{}
 	}
 
-	output = &CreateQueryLoggingConfigOutput{}
+	output = &CreateKeySigningKeyOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// CreateQueryLoggingConfig API operation for Amazon Route 53.
+// CreateKeySigningKey API operation for Amazon Route 53.
 //
-// Creates a configuration for DNS query logging. After you create a query logging
-// configuration, Amazon Route 53 begins to publish log data to an Amazon CloudWatch
-// Logs log group.
+// Creates a new key-signing key (KSK) associated with a hosted zone. You can
+// only have two KSKs per hosted zone.
 //
-// DNS query logs contain information about the queries that Route 53 receives
-// for a specified public hosted zone, such as the following:
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
 //
-//    * Route 53 edge location that responded to the DNS query
+// See the AWS API reference guide for Amazon Route 53's
+// API operation CreateKeySigningKey for usage and error information.
 //
-//    * Domain or subdomain that was requested
+// Returned Error Codes:
 //
-//    * DNS record type, such as A or AAAA
+//   - ErrCodeNoSuchHostedZone "NoSuchHostedZone"
+//     No hosted zone exists with the ID that you specified.
 //
-//    * DNS response code, such as NoError or ServFail
+//   - ErrCodeInvalidArgument "InvalidArgument"
+//     Parameter name is not valid.
 //
-// Log Group and Resource Policy
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-// Before you create a query logging configuration, perform the following operations.
+//   - ErrCodeInvalidKMSArn "InvalidKMSArn"
+//     The KeyManagementServiceArn that you specified isn't valid to use with DNSSEC
+//     signing.
 //
-// If you create a query logging configuration using the Route 53 console, Route
-// 53 performs these operations automatically.
+//   - ErrCodeInvalidKeySigningKeyStatus "InvalidKeySigningKeyStatus"
+//     The key-signing key (KSK) status isn't valid or another KSK has the status
+//     INTERNAL_FAILURE.
 //
-// Create a CloudWatch Logs log group, and make note of the ARN, which you specify
-// when you create a query logging configuration. Note the following:
+//   - ErrCodeInvalidSigningStatus "InvalidSigningStatus"
+//     Your hosted zone status isn't valid for this operation. In the hosted zone,
+//     change the status to enable DNSSEC or disable DNSSEC.
 //
-//    * You must create the log group in the us-east-1 region.
+//   - ErrCodeInvalidKeySigningKeyName "InvalidKeySigningKeyName"
+//     The key-signing key (KSK) name that you specified isn't a valid name.
 //
-//    * You must use the same AWS account to create the log group and the hosted
-//    zone that you want to configure query logging for.
+//   - ErrCodeKeySigningKeyAlreadyExists "KeySigningKeyAlreadyExists"
+//     You've already created a key-signing key (KSK) with this name or with the
+//     same customer managed key ARN.
 //
-//    * When you create log groups for query logging, we recommend that you
-//    use a consistent prefix, for example: /aws/route53/hosted zone name In
-//    the next step, you'll create a resource policy, which controls access
-//    to one or more log groups and the associated AWS resources, such as Route
-//    53 hosted zones. There's a limit on the number of resource policies that
-//    you can create, so we recommend that you use a consistent prefix so you
-//    can use the same resource policy for all the log groups that you create
-//    for query logging.
+//   - ErrCodeTooManyKeySigningKeys "TooManyKeySigningKeys"
+//     You've reached the limit for the number of key-signing keys (KSKs). Remove
+//     at least one KSK, and then try again.
 //
-// Create a CloudWatch Logs resource policy, and give it the permissions that
-// Route 53 needs to create log streams and to send query logs to log streams.
+//   - ErrCodeConcurrentModification "ConcurrentModification"
+//     Another user submitted a request to create, update, or delete the object
+//     at the same time that you did. Retry the request.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/CreateKeySigningKey
+func (c *Route53) CreateKeySigningKey(input *CreateKeySigningKeyInput) (*CreateKeySigningKeyOutput, error) {
+	req, out := c.CreateKeySigningKeyRequest(input)
+	return out, req.Send()
+}
+
+// CreateKeySigningKeyWithContext is the same as CreateKeySigningKey with the addition of
+// the ability to pass a context and additional request options.
+//
+// See CreateKeySigningKey for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Route53) CreateKeySigningKeyWithContext(ctx aws.Context, input *CreateKeySigningKeyInput, opts ...request.Option) (*CreateKeySigningKeyOutput, error) {
+	req, out := c.CreateKeySigningKeyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opCreateQueryLoggingConfig = "CreateQueryLoggingConfig"
+
+// CreateQueryLoggingConfigRequest generates a "aws/request.Request" representing the
+// client's request for the CreateQueryLoggingConfig operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See CreateQueryLoggingConfig for more information on using the CreateQueryLoggingConfig
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the CreateQueryLoggingConfigRequest method.
+//	req, resp := client.CreateQueryLoggingConfigRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/CreateQueryLoggingConfig
+func (c *Route53) CreateQueryLoggingConfigRequest(input *CreateQueryLoggingConfigInput) (req *request.Request, output *CreateQueryLoggingConfigOutput) {
+	op := &request.Operation{
+		Name:       opCreateQueryLoggingConfig,
+		HTTPMethod: "POST",
+		HTTPPath:   "/2013-04-01/queryloggingconfig",
+	}
+
+	if input == nil {
+		input = &CreateQueryLoggingConfigInput{}
+	}
+
+	output = &CreateQueryLoggingConfigOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// CreateQueryLoggingConfig API operation for Amazon Route 53.
+//
+// Creates a configuration for DNS query logging. After you create a query logging
+// configuration, Amazon Route 53 begins to publish log data to an Amazon CloudWatch
+// Logs log group.
+//
+// DNS query logs contain information about the queries that Route 53 receives
+// for a specified public hosted zone, such as the following:
+//
+//   - Route 53 edge location that responded to the DNS query
+//
+//   - Domain or subdomain that was requested
+//
+//   - DNS record type, such as A or AAAA
+//
+//   - DNS response code, such as NoError or ServFail
+//
+// # Log Group and Resource Policy
+//
+// Before you create a query logging configuration, perform the following operations.
+//
+// If you create a query logging configuration using the Route 53 console, Route
+// 53 performs these operations automatically.
+//
+// Create a CloudWatch Logs log group, and make note of the ARN, which you specify
+// when you create a query logging configuration. Note the following:
+//
+//   - You must create the log group in the us-east-1 region.
+//
+//   - You must use the same Amazon Web Services account to create the log
+//     group and the hosted zone that you want to configure query logging for.
+//
+//   - When you create log groups for query logging, we recommend that you
+//     use a consistent prefix, for example: /aws/route53/hosted zone name In
+//     the next step, you'll create a resource policy, which controls access
+//     to one or more log groups and the associated Amazon Web Services resources,
+//     such as Route 53 hosted zones. There's a limit on the number of resource
+//     policies that you can create, so we recommend that you use a consistent
+//     prefix so you can use the same resource policy for all the log groups
+//     that you create for query logging.
+//
+// Create a CloudWatch Logs resource policy, and give it the permissions that
+// Route 53 needs to create log streams and to send query logs to log streams.
 // For the value of Resource, specify the ARN for the log group that you created
 // in the previous step. To use the same resource policy for all the CloudWatch
 // Logs log groups that you created for query logging configurations, replace
@@ -826,20 +1285,36 @@ func (c *Route53) CreateQueryLoggingConfigRequest(input *CreateQueryLoggingConfi
 //
 // arn:aws:logs:us-east-1:123412341234:log-group:/aws/route53/*
 //
+// To avoid the confused deputy problem, a security issue where an entity without
+// a permission for an action can coerce a more-privileged entity to perform
+// it, you can optionally limit the permissions that a service has to a resource
+// in a resource-based policy by supplying the following values:
+//
+//   - For aws:SourceArn, supply the hosted zone ARN used in creating the query
+//     logging configuration. For example, aws:SourceArn: arn:aws:route53:::hostedzone/hosted
+//     zone ID.
+//
+//   - For aws:SourceAccount, supply the account ID for the account that creates
+//     the query logging configuration. For example, aws:SourceAccount:111111111111.
+//
+// For more information, see The confused deputy problem (https://docs.aws.amazon.com/IAM/latest/UserGuide/confused-deputy.html)
+// in the Amazon Web Services IAM User Guide.
+//
 // You can't use the CloudWatch console to create or edit a resource policy.
-// You must use the CloudWatch API, one of the AWS SDKs, or the AWS CLI.
+// You must use the CloudWatch API, one of the Amazon Web Services SDKs, or
+// the CLI.
 //
-// Log Streams and Edge Locations
+// # Log Streams and Edge Locations
 //
 // When Route 53 finishes creating the configuration for DNS query logging,
 // it does the following:
 //
-//    * Creates a log stream for an edge location the first time that the edge
-//    location responds to DNS queries for the specified hosted zone. That log
-//    stream is used to log all queries that Route 53 responds to for that edge
-//    location.
+//   - Creates a log stream for an edge location the first time that the edge
+//     location responds to DNS queries for the specified hosted zone. That log
+//     stream is used to log all queries that Route 53 responds to for that edge
+//     location.
 //
-//    * Begins to send query logs to the applicable log stream.
+//   - Begins to send query logs to the applicable log stream.
 //
 // The name of each log stream is in the following format:
 //
@@ -852,7 +1327,7 @@ func (c *Route53) CreateQueryLoggingConfigRequest(input *CreateQueryLoggingConfi
 // a list of edge locations, see "The Route 53 Global Network" on the Route
 // 53 Product Details (http://aws.amazon.com/route53/details/) page.
 //
-// Queries That Are Logged
+// # Queries That Are Logged
 //
 // Query logs contain only the queries that DNS resolvers forward to Route 53.
 // If a DNS resolver has already cached the response to a query (such as the
@@ -866,18 +1341,18 @@ func (c *Route53) CreateQueryLoggingConfigRequest(input *CreateQueryLoggingConfi
 // to Your Website or Web Application (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/welcome-dns-service.html)
 // in the Amazon Route 53 Developer Guide.
 //
-// Log File Format
+// # Log File Format
 //
 // For a list of the values in each query log and the format of each value,
 // see Logging DNS Queries (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/query-logs.html)
 // in the Amazon Route 53 Developer Guide.
 //
-// Pricing
+// # Pricing
 //
 // For information about charges for query logs, see Amazon CloudWatch Pricing
 // (http://aws.amazon.com/cloudwatch/pricing/).
 //
-// How to Stop Logging
+// # How to Stop Logging
 //
 // If you want Route 53 to stop sending query logs to CloudWatch Logs, delete
 // the query logging configuration. For more information, see DeleteQueryLoggingConfig
@@ -891,34 +1366,43 @@ func (c *Route53) CreateQueryLoggingConfigRequest(input *CreateQueryLoggingConfi
 // API operation CreateQueryLoggingConfig for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeConcurrentModification "ConcurrentModification"
-//   Another user submitted a request to create, update, or delete the object
-//   at the same time that you did. Retry the request.
 //
-//   * ErrCodeNoSuchHostedZone "NoSuchHostedZone"
-//   No hosted zone exists with the ID that you specified.
+//   - ErrCodeConcurrentModification "ConcurrentModification"
+//     Another user submitted a request to create, update, or delete the object
+//     at the same time that you did. Retry the request.
+//
+//   - ErrCodeNoSuchHostedZone "NoSuchHostedZone"
+//     No hosted zone exists with the ID that you specified.
+//
+//   - ErrCodeNoSuchCloudWatchLogsLogGroup "NoSuchCloudWatchLogsLogGroup"
+//     There is no CloudWatch Logs log group with the specified ARN.
 //
-//   * ErrCodeNoSuchCloudWatchLogsLogGroup "NoSuchCloudWatchLogsLogGroup"
-//   There is no CloudWatch Logs log group with the specified ARN.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeQueryLoggingConfigAlreadyExists "QueryLoggingConfigAlreadyExists"
+//     You can create only one query logging configuration for a hosted zone, and
+//     a query logging configuration already exists for this hosted zone.
 //
-//   * ErrCodeQueryLoggingConfigAlreadyExists "QueryLoggingConfigAlreadyExists"
-//   You can create only one query logging configuration for a hosted zone, and
-//   a query logging configuration already exists for this hosted zone.
+//   - ErrCodeInsufficientCloudWatchLogsResourcePolicy "InsufficientCloudWatchLogsResourcePolicy"
+//     Amazon Route 53 doesn't have the permissions required to create log streams
+//     and send query logs to log streams. Possible causes include the following:
 //
-//   * ErrCodeInsufficientCloudWatchLogsResourcePolicy "InsufficientCloudWatchLogsResourcePolicy"
-//   Amazon Route 53 doesn't have the permissions required to create log streams
-//   and send query logs to log streams. Possible causes include the following:
+//   - There is no resource policy that specifies the log group ARN in the
+//     value for Resource.
 //
-//      * There is no resource policy that specifies the log group ARN in the
-//      value for Resource.
+//   - The resource policy that includes the log group ARN in the value for
+//     Resource doesn't have the necessary permissions.
 //
-//      * The resource policy that includes the log group ARN in the value for
-//      Resource doesn't have the necessary permissions.
+//   - The resource policy hasn't finished propagating yet.
 //
-//      * The resource policy hasn't finished propagating yet.
+//   - The Key management service (KMS) key you specified doesn’t exist or
+//     it can’t be used with the log group associated with query log. Update
+//     or provide a resource policy to grant permissions for the KMS key.
+//
+//   - The Key management service (KMS) key you specified is marked as disabled
+//     for the log group associated with query log. Update or provide a resource
+//     policy to grant permissions for the KMS key.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/CreateQueryLoggingConfig
 func (c *Route53) CreateQueryLoggingConfig(input *CreateQueryLoggingConfigInput) (*CreateQueryLoggingConfigOutput, error) {
@@ -958,14 +1442,13 @@ const opCreateReusableDelegationSet = "CreateReusableDelegationSet"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateReusableDelegationSetRequest method.
+//	req, resp := client.CreateReusableDelegationSetRequest(params)
 //
-//    // Example sending a request using the CreateReusableDelegationSetRequest method.
-//    req, resp := client.CreateReusableDelegationSetRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/CreateReusableDelegationSet
 func (c *Route53) CreateReusableDelegationSetRequest(input *CreateReusableDelegationSetInput) (req *request.Request, output *CreateReusableDelegationSetOutput) {
@@ -987,13 +1470,17 @@ func (c *Route53) CreateReusableDelegationSetRequest(input *CreateReusableDelega
 // CreateReusableDelegationSet API operation for Amazon Route 53.
 //
 // Creates a delegation set (a group of four name servers) that can be reused
-// by multiple hosted zones. If a hosted zoned ID is specified, CreateReusableDelegationSet
-// marks the delegation set associated with that zone as reusable.
+// by multiple hosted zones that were created by the same Amazon Web Services
+// account.
+//
+// You can also create a reusable delegation set that uses the four name servers
+// that are associated with an existing hosted zone. Specify the hosted zone
+// ID in the CreateReusableDelegationSet request.
 //
 // You can't associate a reusable delegation set with a private hosted zone.
 //
 // For information about using a reusable delegation set to configure white
-// label name servers, see Configuring White Label Name Servers (http://docs.aws.amazon.com/Route53/latest/DeveloperGuide/white-label-name-servers.html).
+// label name servers, see Configuring White Label Name Servers (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/white-label-name-servers.html).
 //
 // The process for migrating existing hosted zones to use a reusable delegation
 // set is comparable to the process for configuring white label name servers.
@@ -1018,18 +1505,18 @@ func (c *Route53) CreateReusableDelegationSetRequest(input *CreateReusableDelega
 // one or more name servers that are assigned to the reusable delegation set,
 // you can do one of the following:
 //
-//    * For small numbers of hosted zones—up to a few hundred—it's relatively
-//    easy to create reusable delegation sets until you get one that has four
-//    name servers that don't overlap with any of the name servers in your hosted
-//    zones.
+//   - For small numbers of hosted zones—up to a few hundred—it's relatively
+//     easy to create reusable delegation sets until you get one that has four
+//     name servers that don't overlap with any of the name servers in your hosted
+//     zones.
 //
-//    * For larger numbers of hosted zones, the easiest solution is to use more
-//    than one reusable delegation set.
+//   - For larger numbers of hosted zones, the easiest solution is to use more
+//     than one reusable delegation set.
 //
-//    * For larger numbers of hosted zones, you can also migrate hosted zones
-//    that have overlapping name servers to hosted zones that don't have overlapping
-//    name servers, then migrate the hosted zones again to use the reusable
-//    delegation set.
+//   - For larger numbers of hosted zones, you can also migrate hosted zones
+//     that have overlapping name servers to hosted zones that don't have overlapping
+//     name servers, then migrate the hosted zones again to use the reusable
+//     delegation set.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1039,39 +1526,35 @@ func (c *Route53) CreateReusableDelegationSetRequest(input *CreateReusableDelega
 // API operation CreateReusableDelegationSet for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeDelegationSetAlreadyCreated "DelegationSetAlreadyCreated"
-//   A delegation set with the same owner and caller reference combination has
-//   already been created.
-//
-//   * ErrCodeLimitsExceeded "LimitsExceeded"
-//   This operation can't be completed either because the current account has
-//   reached the limit on reusable delegation sets that it can create or because
-//   you've reached the limit on the number of Amazon VPCs that you can associate
-//   with a private hosted zone. To get the current limit on the number of reusable
-//   delegation sets, see GetAccountLimit (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetAccountLimit.html).
-//   To get the current limit on the number of Amazon VPCs that you can associate
-//   with a private hosted zone, see GetHostedZoneLimit (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetHostedZoneLimit.html).
-//   To request a higher limit, create a case (http://aws.amazon.com/route53-request)
-//   with the AWS Support Center.
-//
-//   * ErrCodeHostedZoneNotFound "HostedZoneNotFound"
-//   The specified HostedZone can't be found.
-//
-//   * ErrCodeInvalidArgument "InvalidArgument"
-//   Parameter name is invalid.
-//
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
-//
-//   * ErrCodeDelegationSetNotAvailable "DelegationSetNotAvailable"
-//   You can create a hosted zone that has the same name as an existing hosted
-//   zone (example.com is common), but there is a limit to the number of hosted
-//   zones that have the same name. If you get this error, Amazon Route 53 has
-//   reached that limit. If you own the domain name and Route 53 generates this
-//   error, contact Customer Support.
-//
-//   * ErrCodeDelegationSetAlreadyReusable "DelegationSetAlreadyReusable"
-//   The specified delegation set has already been marked as reusable.
+//
+//   - ErrCodeDelegationSetAlreadyCreated "DelegationSetAlreadyCreated"
+//     A delegation set with the same owner and caller reference combination has
+//     already been created.
+//
+//   - ErrCodeLimitsExceeded "LimitsExceeded"
+//     This operation can't be completed because the current account has reached
+//     the limit on the resource you are trying to create. To request a higher limit,
+//     create a case (http://aws.amazon.com/route53-request) with the Amazon Web
+//     Services Support Center.
+//
+//   - ErrCodeHostedZoneNotFound "HostedZoneNotFound"
+//     The specified HostedZone can't be found.
+//
+//   - ErrCodeInvalidArgument "InvalidArgument"
+//     Parameter name is not valid.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+//   - ErrCodeDelegationSetNotAvailable "DelegationSetNotAvailable"
+//     You can create a hosted zone that has the same name as an existing hosted
+//     zone (example.com is common), but there is a limit to the number of hosted
+//     zones that have the same name. If you get this error, Amazon Route 53 has
+//     reached that limit. If you own the domain name and Route 53 generates this
+//     error, contact Customer Support.
+//
+//   - ErrCodeDelegationSetAlreadyReusable "DelegationSetAlreadyReusable"
+//     The specified delegation set has already been marked as reusable.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/CreateReusableDelegationSet
 func (c *Route53) CreateReusableDelegationSet(input *CreateReusableDelegationSetInput) (*CreateReusableDelegationSetOutput, error) {
@@ -1111,14 +1594,13 @@ const opCreateTrafficPolicy = "CreateTrafficPolicy"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateTrafficPolicyRequest method.
+//	req, resp := client.CreateTrafficPolicyRequest(params)
 //
-//    // Example sending a request using the CreateTrafficPolicyRequest method.
-//    req, resp := client.CreateTrafficPolicyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/CreateTrafficPolicy
 func (c *Route53) CreateTrafficPolicyRequest(input *CreateTrafficPolicyInput) (req *request.Request, output *CreateTrafficPolicyOutput) {
@@ -1151,27 +1633,28 @@ func (c *Route53) CreateTrafficPolicyRequest(input *CreateTrafficPolicyInput) (r
 // API operation CreateTrafficPolicy for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
 //
-//   * ErrCodeTooManyTrafficPolicies "TooManyTrafficPolicies"
-//   This traffic policy can't be created because the current account has reached
-//   the limit on the number of traffic policies.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+//   - ErrCodeTooManyTrafficPolicies "TooManyTrafficPolicies"
+//     This traffic policy can't be created because the current account has reached
+//     the limit on the number of traffic policies.
 //
-//   For information about default limits, see Limits (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html)
-//   in the Amazon Route 53 Developer Guide.
+//     For information about default limits, see Limits (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html)
+//     in the Amazon Route 53 Developer Guide.
 //
-//   To get the current limit for an account, see GetAccountLimit (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetAccountLimit.html).
+//     To get the current limit for an account, see GetAccountLimit (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetAccountLimit.html).
 //
-//   To request a higher limit, create a case (http://aws.amazon.com/route53-request)
-//   with the AWS Support Center.
+//     To request a higher limit, create a case (http://aws.amazon.com/route53-request)
+//     with the Amazon Web Services Support Center.
 //
-//   * ErrCodeTrafficPolicyAlreadyExists "TrafficPolicyAlreadyExists"
-//   A traffic policy that has the same value for Name already exists.
+//   - ErrCodeTrafficPolicyAlreadyExists "TrafficPolicyAlreadyExists"
+//     A traffic policy that has the same value for Name already exists.
 //
-//   * ErrCodeInvalidTrafficPolicyDocument "InvalidTrafficPolicyDocument"
-//   The format of the traffic policy document that you specified in the Document
-//   element is invalid.
+//   - ErrCodeInvalidTrafficPolicyDocument "InvalidTrafficPolicyDocument"
+//     The format of the traffic policy document that you specified in the Document
+//     element is not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/CreateTrafficPolicy
 func (c *Route53) CreateTrafficPolicy(input *CreateTrafficPolicyInput) (*CreateTrafficPolicyOutput, error) {
@@ -1211,14 +1694,13 @@ const opCreateTrafficPolicyInstance = "CreateTrafficPolicyInstance"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateTrafficPolicyInstanceRequest method.
+//	req, resp := client.CreateTrafficPolicyInstanceRequest(params)
 //
-//    // Example sending a request using the CreateTrafficPolicyInstanceRequest method.
-//    req, resp := client.CreateTrafficPolicyInstanceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/CreateTrafficPolicyInstance
 func (c *Route53) CreateTrafficPolicyInstanceRequest(input *CreateTrafficPolicyInstanceInput) (req *request.Request, output *CreateTrafficPolicyInstanceOutput) {
@@ -1246,6 +1728,13 @@ func (c *Route53) CreateTrafficPolicyInstanceRequest(input *CreateTrafficPolicyI
 // responds to DNS queries for the domain or subdomain name by using the resource
 // record sets that CreateTrafficPolicyInstance created.
 //
+// After you submit an CreateTrafficPolicyInstance request, there's a brief
+// delay while Amazon Route 53 creates the resource record sets that are specified
+// in the traffic policy definition. Use GetTrafficPolicyInstance with the id
+// of new traffic policy instance to confirm that the CreateTrafficPolicyInstance
+// request completed successfully. For more information, see the State response
+// element.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -1254,30 +1743,31 @@ func (c *Route53) CreateTrafficPolicyInstanceRequest(input *CreateTrafficPolicyI
 // API operation CreateTrafficPolicyInstance for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchHostedZone "NoSuchHostedZone"
-//   No hosted zone exists with the ID that you specified.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeNoSuchHostedZone "NoSuchHostedZone"
+//     No hosted zone exists with the ID that you specified.
 //
-//   * ErrCodeTooManyTrafficPolicyInstances "TooManyTrafficPolicyInstances"
-//   This traffic policy instance can't be created because the current account
-//   has reached the limit on the number of traffic policy instances.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-//   For information about default limits, see Limits (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html)
-//   in the Amazon Route 53 Developer Guide.
+//   - ErrCodeTooManyTrafficPolicyInstances "TooManyTrafficPolicyInstances"
+//     This traffic policy instance can't be created because the current account
+//     has reached the limit on the number of traffic policy instances.
 //
-//   For information about how to get the current limit for an account, see GetAccountLimit
-//   (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetAccountLimit.html).
+//     For information about default limits, see Limits (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html)
+//     in the Amazon Route 53 Developer Guide.
 //
-//   To request a higher limit, create a case (http://aws.amazon.com/route53-request)
-//   with the AWS Support Center.
+//     For information about how to get the current limit for an account, see GetAccountLimit
+//     (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetAccountLimit.html).
 //
-//   * ErrCodeNoSuchTrafficPolicy "NoSuchTrafficPolicy"
-//   No traffic policy exists with the specified ID.
+//     To request a higher limit, create a case (http://aws.amazon.com/route53-request)
+//     with the Amazon Web Services Support Center.
 //
-//   * ErrCodeTrafficPolicyInstanceAlreadyExists "TrafficPolicyInstanceAlreadyExists"
-//   There is already a traffic policy instance with the specified ID.
+//   - ErrCodeNoSuchTrafficPolicy "NoSuchTrafficPolicy"
+//     No traffic policy exists with the specified ID.
+//
+//   - ErrCodeTrafficPolicyInstanceAlreadyExists "TrafficPolicyInstanceAlreadyExists"
+//     There is already a traffic policy instance with the specified ID.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/CreateTrafficPolicyInstance
 func (c *Route53) CreateTrafficPolicyInstance(input *CreateTrafficPolicyInstanceInput) (*CreateTrafficPolicyInstanceOutput, error) {
@@ -1317,14 +1807,13 @@ const opCreateTrafficPolicyVersion = "CreateTrafficPolicyVersion"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateTrafficPolicyVersionRequest method.
+//	req, resp := client.CreateTrafficPolicyVersionRequest(params)
 //
-//    // Example sending a request using the CreateTrafficPolicyVersionRequest method.
-//    req, resp := client.CreateTrafficPolicyVersionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/CreateTrafficPolicyVersion
 func (c *Route53) CreateTrafficPolicyVersionRequest(input *CreateTrafficPolicyVersionInput) (req *request.Request, output *CreateTrafficPolicyVersionOutput) {
@@ -1362,29 +1851,30 @@ func (c *Route53) CreateTrafficPolicyVersionRequest(input *CreateTrafficPolicyVe
 // API operation CreateTrafficPolicyVersion for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchTrafficPolicy "NoSuchTrafficPolicy"
-//   No traffic policy exists with the specified ID.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeNoSuchTrafficPolicy "NoSuchTrafficPolicy"
+//     No traffic policy exists with the specified ID.
 //
-//   * ErrCodeTooManyTrafficPolicyVersionsForCurrentPolicy "TooManyTrafficPolicyVersionsForCurrentPolicy"
-//   This traffic policy version can't be created because you've reached the limit
-//   of 1000 on the number of versions that you can create for the current traffic
-//   policy.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-//   To create more traffic policy versions, you can use GetTrafficPolicy (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetTrafficPolicy.html)
-//   to get the traffic policy document for a specified traffic policy version,
-//   and then use CreateTrafficPolicy (https://docs.aws.amazon.com/Route53/latest/APIReference/API_CreateTrafficPolicy.html)
-//   to create a new traffic policy using the traffic policy document.
+//   - ErrCodeTooManyTrafficPolicyVersionsForCurrentPolicy "TooManyTrafficPolicyVersionsForCurrentPolicy"
+//     This traffic policy version can't be created because you've reached the limit
+//     of 1000 on the number of versions that you can create for the current traffic
+//     policy.
 //
-//   * ErrCodeConcurrentModification "ConcurrentModification"
-//   Another user submitted a request to create, update, or delete the object
-//   at the same time that you did. Retry the request.
+//     To create more traffic policy versions, you can use GetTrafficPolicy (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetTrafficPolicy.html)
+//     to get the traffic policy document for a specified traffic policy version,
+//     and then use CreateTrafficPolicy (https://docs.aws.amazon.com/Route53/latest/APIReference/API_CreateTrafficPolicy.html)
+//     to create a new traffic policy using the traffic policy document.
 //
-//   * ErrCodeInvalidTrafficPolicyDocument "InvalidTrafficPolicyDocument"
-//   The format of the traffic policy document that you specified in the Document
-//   element is invalid.
+//   - ErrCodeConcurrentModification "ConcurrentModification"
+//     Another user submitted a request to create, update, or delete the object
+//     at the same time that you did. Retry the request.
+//
+//   - ErrCodeInvalidTrafficPolicyDocument "InvalidTrafficPolicyDocument"
+//     The format of the traffic policy document that you specified in the Document
+//     element is not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/CreateTrafficPolicyVersion
 func (c *Route53) CreateTrafficPolicyVersion(input *CreateTrafficPolicyVersionInput) (*CreateTrafficPolicyVersionOutput, error) {
@@ -1424,14 +1914,13 @@ const opCreateVPCAssociationAuthorization = "CreateVPCAssociationAuthorization"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateVPCAssociationAuthorizationRequest method.
+//	req, resp := client.CreateVPCAssociationAuthorizationRequest(params)
 //
-//    // Example sending a request using the CreateVPCAssociationAuthorizationRequest method.
-//    req, resp := client.CreateVPCAssociationAuthorizationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/CreateVPCAssociationAuthorization
 func (c *Route53) CreateVPCAssociationAuthorizationRequest(input *CreateVPCAssociationAuthorizationInput) (req *request.Request, output *CreateVPCAssociationAuthorizationOutput) {
@@ -1452,12 +1941,12 @@ func (c *Route53) CreateVPCAssociationAuthorizationRequest(input *CreateVPCAssoc
 
 // CreateVPCAssociationAuthorization API operation for Amazon Route 53.
 //
-// Authorizes the AWS account that created a specified VPC to submit an AssociateVPCWithHostedZone
-// request to associate the VPC with a specified hosted zone that was created
-// by a different account. To submit a CreateVPCAssociationAuthorization request,
-// you must use the account that created the hosted zone. After you authorize
-// the association, use the account that created the VPC to submit an AssociateVPCWithHostedZone
-// request.
+// Authorizes the Amazon Web Services account that created a specified VPC to
+// submit an AssociateVPCWithHostedZone request to associate the VPC with a
+// specified hosted zone that was created by a different account. To submit
+// a CreateVPCAssociationAuthorization request, you must use the account that
+// created the hosted zone. After you authorize the association, use the account
+// that created the VPC to submit an AssociateVPCWithHostedZone request.
 //
 // If you want to associate multiple VPCs that you created by using one account
 // with a hosted zone that you created by using a different account, you must
@@ -1471,26 +1960,27 @@ func (c *Route53) CreateVPCAssociationAuthorizationRequest(input *CreateVPCAssoc
 // API operation CreateVPCAssociationAuthorization for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeConcurrentModification "ConcurrentModification"
-//   Another user submitted a request to create, update, or delete the object
-//   at the same time that you did. Retry the request.
 //
-//   * ErrCodeTooManyVPCAssociationAuthorizations "TooManyVPCAssociationAuthorizations"
-//   You've created the maximum number of authorizations that can be created for
-//   the specified hosted zone. To authorize another VPC to be associated with
-//   the hosted zone, submit a DeleteVPCAssociationAuthorization request to remove
-//   an existing authorization. To get a list of existing authorizations, submit
-//   a ListVPCAssociationAuthorizations request.
+//   - ErrCodeConcurrentModification "ConcurrentModification"
+//     Another user submitted a request to create, update, or delete the object
+//     at the same time that you did. Retry the request.
+//
+//   - ErrCodeTooManyVPCAssociationAuthorizations "TooManyVPCAssociationAuthorizations"
+//     You've created the maximum number of authorizations that can be created for
+//     the specified hosted zone. To authorize another VPC to be associated with
+//     the hosted zone, submit a DeleteVPCAssociationAuthorization request to remove
+//     an existing authorization. To get a list of existing authorizations, submit
+//     a ListVPCAssociationAuthorizations request.
 //
-//   * ErrCodeNoSuchHostedZone "NoSuchHostedZone"
-//   No hosted zone exists with the ID that you specified.
+//   - ErrCodeNoSuchHostedZone "NoSuchHostedZone"
+//     No hosted zone exists with the ID that you specified.
 //
-//   * ErrCodeInvalidVPCId "InvalidVPCId"
-//   The VPC ID that you specified either isn't a valid ID or the current account
-//   is not authorized to access this VPC.
+//   - ErrCodeInvalidVPCId "InvalidVPCId"
+//     The VPC ID that you specified either isn't a valid ID or the current account
+//     is not authorized to access this VPC.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/CreateVPCAssociationAuthorization
 func (c *Route53) CreateVPCAssociationAuthorization(input *CreateVPCAssociationAuthorizationInput) (*CreateVPCAssociationAuthorizationOutput, error) {
@@ -1514,6 +2004,200 @@ func (c *Route53) CreateVPCAssociationAuthorizationWithContext(ctx aws.Context,
 	return out, req.Send()
 }
 
+const opDeactivateKeySigningKey = "DeactivateKeySigningKey"
+
+// DeactivateKeySigningKeyRequest generates a "aws/request.Request" representing the
+// client's request for the DeactivateKeySigningKey operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See DeactivateKeySigningKey for more information on using the DeactivateKeySigningKey
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the DeactivateKeySigningKeyRequest method.
+//	req, resp := client.DeactivateKeySigningKeyRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DeactivateKeySigningKey
+func (c *Route53) DeactivateKeySigningKeyRequest(input *DeactivateKeySigningKeyInput) (req *request.Request, output *DeactivateKeySigningKeyOutput) {
+	op := &request.Operation{
+		Name:       opDeactivateKeySigningKey,
+		HTTPMethod: "POST",
+		HTTPPath:   "/2013-04-01/keysigningkey/{HostedZoneId}/{Name}/deactivate",
+	}
+
+	if input == nil {
+		input = &DeactivateKeySigningKeyInput{}
+	}
+
+	output = &DeactivateKeySigningKeyOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// DeactivateKeySigningKey API operation for Amazon Route 53.
+//
+// Deactivates a key-signing key (KSK) so that it will not be used for signing
+// by DNSSEC. This operation changes the KSK status to INACTIVE.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Route 53's
+// API operation DeactivateKeySigningKey for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeConcurrentModification "ConcurrentModification"
+//     Another user submitted a request to create, update, or delete the object
+//     at the same time that you did. Retry the request.
+//
+//   - ErrCodeNoSuchKeySigningKey "NoSuchKeySigningKey"
+//     The specified key-signing key (KSK) doesn't exist.
+//
+//   - ErrCodeInvalidKeySigningKeyStatus "InvalidKeySigningKeyStatus"
+//     The key-signing key (KSK) status isn't valid or another KSK has the status
+//     INTERNAL_FAILURE.
+//
+//   - ErrCodeInvalidSigningStatus "InvalidSigningStatus"
+//     Your hosted zone status isn't valid for this operation. In the hosted zone,
+//     change the status to enable DNSSEC or disable DNSSEC.
+//
+//   - ErrCodeKeySigningKeyInUse "KeySigningKeyInUse"
+//     The key-signing key (KSK) that you specified can't be deactivated because
+//     it's the only KSK for a currently-enabled DNSSEC. Disable DNSSEC signing,
+//     or add or enable another KSK.
+//
+//   - ErrCodeKeySigningKeyInParentDSRecord "KeySigningKeyInParentDSRecord"
+//     The key-signing key (KSK) is specified in a parent DS record.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DeactivateKeySigningKey
+func (c *Route53) DeactivateKeySigningKey(input *DeactivateKeySigningKeyInput) (*DeactivateKeySigningKeyOutput, error) {
+	req, out := c.DeactivateKeySigningKeyRequest(input)
+	return out, req.Send()
+}
+
+// DeactivateKeySigningKeyWithContext is the same as DeactivateKeySigningKey with the addition of
+// the ability to pass a context and additional request options.
+//
+// See DeactivateKeySigningKey for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Route53) DeactivateKeySigningKeyWithContext(ctx aws.Context, input *DeactivateKeySigningKeyInput, opts ...request.Option) (*DeactivateKeySigningKeyOutput, error) {
+	req, out := c.DeactivateKeySigningKeyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opDeleteCidrCollection = "DeleteCidrCollection"
+
+// DeleteCidrCollectionRequest generates a "aws/request.Request" representing the
+// client's request for the DeleteCidrCollection operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See DeleteCidrCollection for more information on using the DeleteCidrCollection
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the DeleteCidrCollectionRequest method.
+//	req, resp := client.DeleteCidrCollectionRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DeleteCidrCollection
+func (c *Route53) DeleteCidrCollectionRequest(input *DeleteCidrCollectionInput) (req *request.Request, output *DeleteCidrCollectionOutput) {
+	op := &request.Operation{
+		Name:       opDeleteCidrCollection,
+		HTTPMethod: "DELETE",
+		HTTPPath:   "/2013-04-01/cidrcollection/{CidrCollectionId}",
+	}
+
+	if input == nil {
+		input = &DeleteCidrCollectionInput{}
+	}
+
+	output = &DeleteCidrCollectionOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(restxml.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// DeleteCidrCollection API operation for Amazon Route 53.
+//
+// Deletes a CIDR collection in the current Amazon Web Services account. The
+// collection must be empty before it can be deleted.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Route 53's
+// API operation DeleteCidrCollection for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeNoSuchCidrCollectionException "NoSuchCidrCollectionException"
+//     The CIDR collection you specified, doesn't exist.
+//
+//   - ErrCodeCidrCollectionInUseException "CidrCollectionInUseException"
+//     This CIDR collection is in use, and isn't empty.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+//   - ErrCodeConcurrentModification "ConcurrentModification"
+//     Another user submitted a request to create, update, or delete the object
+//     at the same time that you did. Retry the request.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DeleteCidrCollection
+func (c *Route53) DeleteCidrCollection(input *DeleteCidrCollectionInput) (*DeleteCidrCollectionOutput, error) {
+	req, out := c.DeleteCidrCollectionRequest(input)
+	return out, req.Send()
+}
+
+// DeleteCidrCollectionWithContext is the same as DeleteCidrCollection with the addition of
+// the ability to pass a context and additional request options.
+//
+// See DeleteCidrCollection for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Route53) DeleteCidrCollectionWithContext(ctx aws.Context, input *DeleteCidrCollectionInput, opts ...request.Option) (*DeleteCidrCollectionOutput, error) {
+	req, out := c.DeleteCidrCollectionRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opDeleteHealthCheck = "DeleteHealthCheck"
 
 // DeleteHealthCheckRequest generates a "aws/request.Request" representing the
@@ -1530,14 +2214,13 @@ const opDeleteHealthCheck = "DeleteHealthCheck"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteHealthCheckRequest method.
+//	req, resp := client.DeleteHealthCheckRequest(params)
 //
-//    // Example sending a request using the DeleteHealthCheckRequest method.
-//    req, resp := client.DeleteHealthCheckRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DeleteHealthCheck
 func (c *Route53) DeleteHealthCheckRequest(input *DeleteHealthCheckInput) (req *request.Request, output *DeleteHealthCheckOutput) {
@@ -1566,9 +2249,15 @@ func (c *Route53) DeleteHealthCheckRequest(input *DeleteHealthCheckInput) (req *
 // you delete a health check and you don't update the associated resource record
 // sets, the future status of the health check can't be predicted and may change.
 // This will affect the routing of DNS queries for your DNS failover configuration.
-// For more information, see Replacing and Deleting Health Checks (http://docs.aws.amazon.com/Route53/latest/DeveloperGuide/health-checks-creating-deleting.html#health-checks-deleting.html)
+// For more information, see Replacing and Deleting Health Checks (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/health-checks-creating-deleting.html#health-checks-deleting.html)
 // in the Amazon Route 53 Developer Guide.
 //
+// If you're using Cloud Map and you configured Cloud Map to create a Route
+// 53 health check when you register an instance, you can't use the Route 53
+// DeleteHealthCheck command to delete the health check. The health check is
+// deleted automatically when you deregister the instance; there can be a delay
+// of several hours before the health check is deleted from Route 53.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -1577,14 +2266,15 @@ func (c *Route53) DeleteHealthCheckRequest(input *DeleteHealthCheckInput) (req *
 // API operation DeleteHealthCheck for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchHealthCheck "NoSuchHealthCheck"
-//   No health check exists with the specified ID.
 //
-//   * ErrCodeHealthCheckInUse "HealthCheckInUse"
-//   This error code is not in use.
+//   - ErrCodeNoSuchHealthCheck "NoSuchHealthCheck"
+//     No health check exists with the specified ID.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeHealthCheckInUse "HealthCheckInUse"
+//     This error code is not in use.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DeleteHealthCheck
 func (c *Route53) DeleteHealthCheck(input *DeleteHealthCheckInput) (*DeleteHealthCheckOutput, error) {
@@ -1624,14 +2314,13 @@ const opDeleteHostedZone = "DeleteHostedZone"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteHostedZoneRequest method.
+//	req, resp := client.DeleteHostedZoneRequest(params)
 //
-//    // Example sending a request using the DeleteHostedZoneRequest method.
-//    req, resp := client.DeleteHostedZoneRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DeleteHostedZone
 func (c *Route53) DeleteHostedZoneRequest(input *DeleteHostedZoneInput) (req *request.Request, output *DeleteHostedZoneOutput) {
@@ -1654,8 +2343,8 @@ func (c *Route53) DeleteHostedZoneRequest(input *DeleteHostedZoneInput) (req *re
 //
 // Deletes a hosted zone.
 //
-// If the hosted zone was created by another service, such as AWS Cloud Map,
-// see Deleting Public Hosted Zones That Were Created by Another Service (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DeleteHostedZone.html#delete-public-hosted-zone-created-by-another-service)
+// If the hosted zone was created by another service, such as Cloud Map, see
+// Deleting Public Hosted Zones That Were Created by Another Service (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DeleteHostedZone.html#delete-public-hosted-zone-created-by-another-service)
 // in the Amazon Route 53 Developer Guide for information about how to delete
 // it. (The process is the same for public and private hosted zones that were
 // created by another service.)
@@ -1693,11 +2382,11 @@ func (c *Route53) DeleteHostedZoneRequest(input *DeleteHostedZoneInput) (req *re
 //
 // To verify that the hosted zone has been deleted, do one of the following:
 //
-//    * Use the GetHostedZone action to request information about the hosted
-//    zone.
+//   - Use the GetHostedZone action to request information about the hosted
+//     zone.
 //
-//    * Use the ListHostedZones action to get a list of the hosted zones associated
-//    with the current AWS account.
+//   - Use the ListHostedZones action to get a list of the hosted zones associated
+//     with the current Amazon Web Services account.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1707,24 +2396,25 @@ func (c *Route53) DeleteHostedZoneRequest(input *DeleteHostedZoneInput) (req *re
 // API operation DeleteHostedZone for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchHostedZone "NoSuchHostedZone"
-//   No hosted zone exists with the ID that you specified.
 //
-//   * ErrCodeHostedZoneNotEmpty "HostedZoneNotEmpty"
-//   The hosted zone contains resource records that are not SOA or NS records.
+//   - ErrCodeNoSuchHostedZone "NoSuchHostedZone"
+//     No hosted zone exists with the ID that you specified.
 //
-//   * ErrCodePriorRequestNotComplete "PriorRequestNotComplete"
-//   If Amazon Route 53 can't process a request before the next request arrives,
-//   it will reject subsequent requests for the same hosted zone and return an
-//   HTTP 400 error (Bad request). If Route 53 returns this error repeatedly for
-//   the same request, we recommend that you wait, in intervals of increasing
-//   duration, before you try the request again.
+//   - ErrCodeHostedZoneNotEmpty "HostedZoneNotEmpty"
+//     The hosted zone contains resource records that are not SOA or NS records.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodePriorRequestNotComplete "PriorRequestNotComplete"
+//     If Amazon Route 53 can't process a request before the next request arrives,
+//     it will reject subsequent requests for the same hosted zone and return an
+//     HTTP 400 error (Bad request). If Route 53 returns this error repeatedly for
+//     the same request, we recommend that you wait, in intervals of increasing
+//     duration, before you try the request again.
 //
-//   * ErrCodeInvalidDomainName "InvalidDomainName"
-//   The specified domain name is not valid.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+//   - ErrCodeInvalidDomainName "InvalidDomainName"
+//     The specified domain name is not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DeleteHostedZone
 func (c *Route53) DeleteHostedZone(input *DeleteHostedZoneInput) (*DeleteHostedZoneOutput, error) {
@@ -1748,100 +2438,206 @@ func (c *Route53) DeleteHostedZoneWithContext(ctx aws.Context, input *DeleteHost
 	return out, req.Send()
 }
 
-const opDeleteQueryLoggingConfig = "DeleteQueryLoggingConfig"
+const opDeleteKeySigningKey = "DeleteKeySigningKey"
 
-// DeleteQueryLoggingConfigRequest generates a "aws/request.Request" representing the
-// client's request for the DeleteQueryLoggingConfig operation. The "output" return
+// DeleteKeySigningKeyRequest generates a "aws/request.Request" representing the
+// client's request for the DeleteKeySigningKey operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See DeleteQueryLoggingConfig for more information on using the DeleteQueryLoggingConfig
+// See DeleteKeySigningKey for more information on using the DeleteKeySigningKey
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteKeySigningKeyRequest method.
+//	req, resp := client.DeleteKeySigningKeyRequest(params)
 //
-//    // Example sending a request using the DeleteQueryLoggingConfigRequest method.
-//    req, resp := client.DeleteQueryLoggingConfigRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DeleteQueryLoggingConfig
-func (c *Route53) DeleteQueryLoggingConfigRequest(input *DeleteQueryLoggingConfigInput) (req *request.Request, output *DeleteQueryLoggingConfigOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DeleteKeySigningKey
+func (c *Route53) DeleteKeySigningKeyRequest(input *DeleteKeySigningKeyInput) (req *request.Request, output *DeleteKeySigningKeyOutput) {
 	op := &request.Operation{
-		Name:       opDeleteQueryLoggingConfig,
+		Name:       opDeleteKeySigningKey,
 		HTTPMethod: "DELETE",
-		HTTPPath:   "/2013-04-01/queryloggingconfig/{Id}",
+		HTTPPath:   "/2013-04-01/keysigningkey/{HostedZoneId}/{Name}",
 	}
 
 	if input == nil {
-		input = &DeleteQueryLoggingConfigInput{}
+		input = &DeleteKeySigningKeyInput{}
 	}
 
-	output = &DeleteQueryLoggingConfigOutput{}
+	output = &DeleteKeySigningKeyOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(restxml.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// DeleteQueryLoggingConfig API operation for Amazon Route 53.
+// DeleteKeySigningKey API operation for Amazon Route 53.
 //
-// Deletes a configuration for DNS query logging. If you delete a configuration,
-// Amazon Route 53 stops sending query logs to CloudWatch Logs. Route 53 doesn't
-// delete any logs that are already in CloudWatch Logs.
+// Deletes a key-signing key (KSK). Before you can delete a KSK, you must deactivate
+// it. The KSK must be deactivated before you can delete it regardless of whether
+// the hosted zone is enabled for DNSSEC signing.
 //
-// For more information about DNS query logs, see CreateQueryLoggingConfig (https://docs.aws.amazon.com/Route53/latest/APIReference/API_CreateQueryLoggingConfig.html).
+// You can use DeactivateKeySigningKey (https://docs.aws.amazon.com/Route53/latest/APIReference/API_DeactivateKeySigningKey.html)
+// to deactivate the key before you delete it.
+//
+// Use GetDNSSEC (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetDNSSEC.html)
+// to verify that the KSK is in an INACTIVE status.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Route 53's
-// API operation DeleteQueryLoggingConfig for usage and error information.
+// API operation DeleteKeySigningKey for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeConcurrentModification "ConcurrentModification"
-//   Another user submitted a request to create, update, or delete the object
-//   at the same time that you did. Retry the request.
 //
-//   * ErrCodeNoSuchQueryLoggingConfig "NoSuchQueryLoggingConfig"
-//   There is no DNS query logging configuration with the specified ID.
+//   - ErrCodeConcurrentModification "ConcurrentModification"
+//     Another user submitted a request to create, update, or delete the object
+//     at the same time that you did. Retry the request.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeNoSuchKeySigningKey "NoSuchKeySigningKey"
+//     The specified key-signing key (KSK) doesn't exist.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DeleteQueryLoggingConfig
-func (c *Route53) DeleteQueryLoggingConfig(input *DeleteQueryLoggingConfigInput) (*DeleteQueryLoggingConfigOutput, error) {
-	req, out := c.DeleteQueryLoggingConfigRequest(input)
+//   - ErrCodeInvalidKeySigningKeyStatus "InvalidKeySigningKeyStatus"
+//     The key-signing key (KSK) status isn't valid or another KSK has the status
+//     INTERNAL_FAILURE.
+//
+//   - ErrCodeInvalidSigningStatus "InvalidSigningStatus"
+//     Your hosted zone status isn't valid for this operation. In the hosted zone,
+//     change the status to enable DNSSEC or disable DNSSEC.
+//
+//   - ErrCodeInvalidKMSArn "InvalidKMSArn"
+//     The KeyManagementServiceArn that you specified isn't valid to use with DNSSEC
+//     signing.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DeleteKeySigningKey
+func (c *Route53) DeleteKeySigningKey(input *DeleteKeySigningKeyInput) (*DeleteKeySigningKeyOutput, error) {
+	req, out := c.DeleteKeySigningKeyRequest(input)
 	return out, req.Send()
 }
 
-// DeleteQueryLoggingConfigWithContext is the same as DeleteQueryLoggingConfig with the addition of
+// DeleteKeySigningKeyWithContext is the same as DeleteKeySigningKey with the addition of
 // the ability to pass a context and additional request options.
 //
-// See DeleteQueryLoggingConfig for details on how to use this API operation.
+// See DeleteKeySigningKey for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *Route53) DeleteQueryLoggingConfigWithContext(ctx aws.Context, input *DeleteQueryLoggingConfigInput, opts ...request.Option) (*DeleteQueryLoggingConfigOutput, error) {
-	req, out := c.DeleteQueryLoggingConfigRequest(input)
+func (c *Route53) DeleteKeySigningKeyWithContext(ctx aws.Context, input *DeleteKeySigningKeyInput, opts ...request.Option) (*DeleteKeySigningKeyOutput, error) {
+	req, out := c.DeleteKeySigningKeyRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opDeleteReusableDelegationSet = "DeleteReusableDelegationSet"
+const opDeleteQueryLoggingConfig = "DeleteQueryLoggingConfig"
 
-// DeleteReusableDelegationSetRequest generates a "aws/request.Request" representing the
+// DeleteQueryLoggingConfigRequest generates a "aws/request.Request" representing the
+// client's request for the DeleteQueryLoggingConfig operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See DeleteQueryLoggingConfig for more information on using the DeleteQueryLoggingConfig
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the DeleteQueryLoggingConfigRequest method.
+//	req, resp := client.DeleteQueryLoggingConfigRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DeleteQueryLoggingConfig
+func (c *Route53) DeleteQueryLoggingConfigRequest(input *DeleteQueryLoggingConfigInput) (req *request.Request, output *DeleteQueryLoggingConfigOutput) {
+	op := &request.Operation{
+		Name:       opDeleteQueryLoggingConfig,
+		HTTPMethod: "DELETE",
+		HTTPPath:   "/2013-04-01/queryloggingconfig/{Id}",
+	}
+
+	if input == nil {
+		input = &DeleteQueryLoggingConfigInput{}
+	}
+
+	output = &DeleteQueryLoggingConfigOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(restxml.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// DeleteQueryLoggingConfig API operation for Amazon Route 53.
+//
+// Deletes a configuration for DNS query logging. If you delete a configuration,
+// Amazon Route 53 stops sending query logs to CloudWatch Logs. Route 53 doesn't
+// delete any logs that are already in CloudWatch Logs.
+//
+// For more information about DNS query logs, see CreateQueryLoggingConfig (https://docs.aws.amazon.com/Route53/latest/APIReference/API_CreateQueryLoggingConfig.html).
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Route 53's
+// API operation DeleteQueryLoggingConfig for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeConcurrentModification "ConcurrentModification"
+//     Another user submitted a request to create, update, or delete the object
+//     at the same time that you did. Retry the request.
+//
+//   - ErrCodeNoSuchQueryLoggingConfig "NoSuchQueryLoggingConfig"
+//     There is no DNS query logging configuration with the specified ID.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DeleteQueryLoggingConfig
+func (c *Route53) DeleteQueryLoggingConfig(input *DeleteQueryLoggingConfigInput) (*DeleteQueryLoggingConfigOutput, error) {
+	req, out := c.DeleteQueryLoggingConfigRequest(input)
+	return out, req.Send()
+}
+
+// DeleteQueryLoggingConfigWithContext is the same as DeleteQueryLoggingConfig with the addition of
+// the ability to pass a context and additional request options.
+//
+// See DeleteQueryLoggingConfig for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Route53) DeleteQueryLoggingConfigWithContext(ctx aws.Context, input *DeleteQueryLoggingConfigInput, opts ...request.Option) (*DeleteQueryLoggingConfigOutput, error) {
+	req, out := c.DeleteQueryLoggingConfigRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opDeleteReusableDelegationSet = "DeleteReusableDelegationSet"
+
+// DeleteReusableDelegationSetRequest generates a "aws/request.Request" representing the
 // client's request for the DeleteReusableDelegationSet operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
@@ -1855,14 +2651,13 @@ const opDeleteReusableDelegationSet = "DeleteReusableDelegationSet"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteReusableDelegationSetRequest method.
+//	req, resp := client.DeleteReusableDelegationSetRequest(params)
 //
-//    // Example sending a request using the DeleteReusableDelegationSetRequest method.
-//    req, resp := client.DeleteReusableDelegationSetRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DeleteReusableDelegationSet
 func (c *Route53) DeleteReusableDelegationSetRequest(input *DeleteReusableDelegationSetInput) (req *request.Request, output *DeleteReusableDelegationSetOutput) {
@@ -1902,18 +2697,19 @@ func (c *Route53) DeleteReusableDelegationSetRequest(input *DeleteReusableDelega
 // API operation DeleteReusableDelegationSet for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchDelegationSet "NoSuchDelegationSet"
-//   A reusable delegation set with the specified ID does not exist.
 //
-//   * ErrCodeDelegationSetInUse "DelegationSetInUse"
-//   The specified delegation contains associated hosted zones which must be deleted
-//   before the reusable delegation set can be deleted.
+//   - ErrCodeNoSuchDelegationSet "NoSuchDelegationSet"
+//     A reusable delegation set with the specified ID does not exist.
 //
-//   * ErrCodeDelegationSetNotReusable "DelegationSetNotReusable"
-//   A reusable delegation set with the specified ID does not exist.
+//   - ErrCodeDelegationSetInUse "DelegationSetInUse"
+//     The specified delegation contains associated hosted zones which must be deleted
+//     before the reusable delegation set can be deleted.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeDelegationSetNotReusable "DelegationSetNotReusable"
+//     A reusable delegation set with the specified ID does not exist.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DeleteReusableDelegationSet
 func (c *Route53) DeleteReusableDelegationSet(input *DeleteReusableDelegationSetInput) (*DeleteReusableDelegationSetOutput, error) {
@@ -1953,14 +2749,13 @@ const opDeleteTrafficPolicy = "DeleteTrafficPolicy"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteTrafficPolicyRequest method.
+//	req, resp := client.DeleteTrafficPolicyRequest(params)
 //
-//    // Example sending a request using the DeleteTrafficPolicyRequest method.
-//    req, resp := client.DeleteTrafficPolicyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DeleteTrafficPolicy
 func (c *Route53) DeleteTrafficPolicyRequest(input *DeleteTrafficPolicyInput) (req *request.Request, output *DeleteTrafficPolicyOutput) {
@@ -1984,6 +2779,19 @@ func (c *Route53) DeleteTrafficPolicyRequest(input *DeleteTrafficPolicyInput) (r
 //
 // Deletes a traffic policy.
 //
+// When you delete a traffic policy, Route 53 sets a flag on the policy to indicate
+// that it has been deleted. However, Route 53 never fully deletes the traffic
+// policy. Note the following:
+//
+//   - Deleted traffic policies aren't listed if you run ListTrafficPolicies
+//     (https://docs.aws.amazon.com/Route53/latest/APIReference/API_ListTrafficPolicies.html).
+//
+//   - There's no way to get a list of deleted policies.
+//
+//   - If you retain the ID of the policy, you can get information about the
+//     policy, including the traffic policy document, by running GetTrafficPolicy
+//     (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetTrafficPolicy.html).
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -1992,19 +2800,20 @@ func (c *Route53) DeleteTrafficPolicyRequest(input *DeleteTrafficPolicyInput) (r
 // API operation DeleteTrafficPolicy for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchTrafficPolicy "NoSuchTrafficPolicy"
-//   No traffic policy exists with the specified ID.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeNoSuchTrafficPolicy "NoSuchTrafficPolicy"
+//     No traffic policy exists with the specified ID.
 //
-//   * ErrCodeTrafficPolicyInUse "TrafficPolicyInUse"
-//   One or more traffic policy instances were created by using the specified
-//   traffic policy.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-//   * ErrCodeConcurrentModification "ConcurrentModification"
-//   Another user submitted a request to create, update, or delete the object
-//   at the same time that you did. Retry the request.
+//   - ErrCodeTrafficPolicyInUse "TrafficPolicyInUse"
+//     One or more traffic policy instances were created by using the specified
+//     traffic policy.
+//
+//   - ErrCodeConcurrentModification "ConcurrentModification"
+//     Another user submitted a request to create, update, or delete the object
+//     at the same time that you did. Retry the request.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DeleteTrafficPolicy
 func (c *Route53) DeleteTrafficPolicy(input *DeleteTrafficPolicyInput) (*DeleteTrafficPolicyOutput, error) {
@@ -2044,14 +2853,13 @@ const opDeleteTrafficPolicyInstance = "DeleteTrafficPolicyInstance"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteTrafficPolicyInstanceRequest method.
+//	req, resp := client.DeleteTrafficPolicyInstanceRequest(params)
 //
-//    // Example sending a request using the DeleteTrafficPolicyInstanceRequest method.
-//    req, resp := client.DeleteTrafficPolicyInstanceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DeleteTrafficPolicyInstance
 func (c *Route53) DeleteTrafficPolicyInstanceRequest(input *DeleteTrafficPolicyInstanceInput) (req *request.Request, output *DeleteTrafficPolicyInstanceOutput) {
@@ -2086,18 +2894,19 @@ func (c *Route53) DeleteTrafficPolicyInstanceRequest(input *DeleteTrafficPolicyI
 // API operation DeleteTrafficPolicyInstance for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchTrafficPolicyInstance "NoSuchTrafficPolicyInstance"
-//   No traffic policy instance exists with the specified ID.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeNoSuchTrafficPolicyInstance "NoSuchTrafficPolicyInstance"
+//     No traffic policy instance exists with the specified ID.
 //
-//   * ErrCodePriorRequestNotComplete "PriorRequestNotComplete"
-//   If Amazon Route 53 can't process a request before the next request arrives,
-//   it will reject subsequent requests for the same hosted zone and return an
-//   HTTP 400 error (Bad request). If Route 53 returns this error repeatedly for
-//   the same request, we recommend that you wait, in intervals of increasing
-//   duration, before you try the request again.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+//   - ErrCodePriorRequestNotComplete "PriorRequestNotComplete"
+//     If Amazon Route 53 can't process a request before the next request arrives,
+//     it will reject subsequent requests for the same hosted zone and return an
+//     HTTP 400 error (Bad request). If Route 53 returns this error repeatedly for
+//     the same request, we recommend that you wait, in intervals of increasing
+//     duration, before you try the request again.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DeleteTrafficPolicyInstance
 func (c *Route53) DeleteTrafficPolicyInstance(input *DeleteTrafficPolicyInstanceInput) (*DeleteTrafficPolicyInstanceOutput, error) {
@@ -2137,14 +2946,13 @@ const opDeleteVPCAssociationAuthorization = "DeleteVPCAssociationAuthorization"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteVPCAssociationAuthorizationRequest method.
+//	req, resp := client.DeleteVPCAssociationAuthorizationRequest(params)
 //
-//    // Example sending a request using the DeleteVPCAssociationAuthorizationRequest method.
-//    req, resp := client.DeleteVPCAssociationAuthorizationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DeleteVPCAssociationAuthorization
 func (c *Route53) DeleteVPCAssociationAuthorizationRequest(input *DeleteVPCAssociationAuthorizationInput) (req *request.Request, output *DeleteVPCAssociationAuthorizationOutput) {
@@ -2171,9 +2979,9 @@ func (c *Route53) DeleteVPCAssociationAuthorizationRequest(input *DeleteVPCAssoc
 // account. You must use the account that created the hosted zone to submit
 // a DeleteVPCAssociationAuthorization request.
 //
-// Sending this request only prevents the AWS account that created the VPC from
-// associating the VPC with the Amazon Route 53 hosted zone in the future. If
-// the VPC is already associated with the hosted zone, DeleteVPCAssociationAuthorization
+// Sending this request only prevents the Amazon Web Services account that created
+// the VPC from associating the VPC with the Amazon Route 53 hosted zone in
+// the future. If the VPC is already associated with the hosted zone, DeleteVPCAssociationAuthorization
 // won't disassociate the VPC from the hosted zone. If you want to delete an
 // existing association, use DisassociateVPCFromHostedZone.
 //
@@ -2185,23 +2993,24 @@ func (c *Route53) DeleteVPCAssociationAuthorizationRequest(input *DeleteVPCAssoc
 // API operation DeleteVPCAssociationAuthorization for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeConcurrentModification "ConcurrentModification"
-//   Another user submitted a request to create, update, or delete the object
-//   at the same time that you did. Retry the request.
 //
-//   * ErrCodeVPCAssociationAuthorizationNotFound "VPCAssociationAuthorizationNotFound"
-//   The VPC that you specified is not authorized to be associated with the hosted
-//   zone.
+//   - ErrCodeConcurrentModification "ConcurrentModification"
+//     Another user submitted a request to create, update, or delete the object
+//     at the same time that you did. Retry the request.
 //
-//   * ErrCodeNoSuchHostedZone "NoSuchHostedZone"
-//   No hosted zone exists with the ID that you specified.
+//   - ErrCodeVPCAssociationAuthorizationNotFound "VPCAssociationAuthorizationNotFound"
+//     The VPC that you specified is not authorized to be associated with the hosted
+//     zone.
 //
-//   * ErrCodeInvalidVPCId "InvalidVPCId"
-//   The VPC ID that you specified either isn't a valid ID or the current account
-//   is not authorized to access this VPC.
+//   - ErrCodeNoSuchHostedZone "NoSuchHostedZone"
+//     No hosted zone exists with the ID that you specified.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeInvalidVPCId "InvalidVPCId"
+//     The VPC ID that you specified either isn't a valid ID or the current account
+//     is not authorized to access this VPC.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DeleteVPCAssociationAuthorization
 func (c *Route53) DeleteVPCAssociationAuthorization(input *DeleteVPCAssociationAuthorizationInput) (*DeleteVPCAssociationAuthorizationOutput, error) {
@@ -2225,6 +3034,110 @@ func (c *Route53) DeleteVPCAssociationAuthorizationWithContext(ctx aws.Context,
 	return out, req.Send()
 }
 
+const opDisableHostedZoneDNSSEC = "DisableHostedZoneDNSSEC"
+
+// DisableHostedZoneDNSSECRequest generates a "aws/request.Request" representing the
+// client's request for the DisableHostedZoneDNSSEC operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See DisableHostedZoneDNSSEC for more information on using the DisableHostedZoneDNSSEC
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the DisableHostedZoneDNSSECRequest method.
+//	req, resp := client.DisableHostedZoneDNSSECRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DisableHostedZoneDNSSEC
+func (c *Route53) DisableHostedZoneDNSSECRequest(input *DisableHostedZoneDNSSECInput) (req *request.Request, output *DisableHostedZoneDNSSECOutput) {
+	op := &request.Operation{
+		Name:       opDisableHostedZoneDNSSEC,
+		HTTPMethod: "POST",
+		HTTPPath:   "/2013-04-01/hostedzone/{Id}/disable-dnssec",
+	}
+
+	if input == nil {
+		input = &DisableHostedZoneDNSSECInput{}
+	}
+
+	output = &DisableHostedZoneDNSSECOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// DisableHostedZoneDNSSEC API operation for Amazon Route 53.
+//
+// Disables DNSSEC signing in a specific hosted zone. This action does not deactivate
+// any key-signing keys (KSKs) that are active in the hosted zone.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Route 53's
+// API operation DisableHostedZoneDNSSEC for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeNoSuchHostedZone "NoSuchHostedZone"
+//     No hosted zone exists with the ID that you specified.
+//
+//   - ErrCodeInvalidArgument "InvalidArgument"
+//     Parameter name is not valid.
+//
+//   - ErrCodeConcurrentModification "ConcurrentModification"
+//     Another user submitted a request to create, update, or delete the object
+//     at the same time that you did. Retry the request.
+//
+//   - ErrCodeKeySigningKeyInParentDSRecord "KeySigningKeyInParentDSRecord"
+//     The key-signing key (KSK) is specified in a parent DS record.
+//
+//   - ErrCodeDNSSECNotFound "DNSSECNotFound"
+//     The hosted zone doesn't have any DNSSEC resources.
+//
+//   - ErrCodeInvalidKeySigningKeyStatus "InvalidKeySigningKeyStatus"
+//     The key-signing key (KSK) status isn't valid or another KSK has the status
+//     INTERNAL_FAILURE.
+//
+//   - ErrCodeInvalidKMSArn "InvalidKMSArn"
+//     The KeyManagementServiceArn that you specified isn't valid to use with DNSSEC
+//     signing.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DisableHostedZoneDNSSEC
+func (c *Route53) DisableHostedZoneDNSSEC(input *DisableHostedZoneDNSSECInput) (*DisableHostedZoneDNSSECOutput, error) {
+	req, out := c.DisableHostedZoneDNSSECRequest(input)
+	return out, req.Send()
+}
+
+// DisableHostedZoneDNSSECWithContext is the same as DisableHostedZoneDNSSEC with the addition of
+// the ability to pass a context and additional request options.
+//
+// See DisableHostedZoneDNSSEC for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Route53) DisableHostedZoneDNSSECWithContext(ctx aws.Context, input *DisableHostedZoneDNSSECInput, opts ...request.Option) (*DisableHostedZoneDNSSECOutput, error) {
+	req, out := c.DisableHostedZoneDNSSECRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opDisassociateVPCFromHostedZone = "DisassociateVPCFromHostedZone"
 
 // DisassociateVPCFromHostedZoneRequest generates a "aws/request.Request" representing the
@@ -2241,14 +3154,13 @@ const opDisassociateVPCFromHostedZone = "DisassociateVPCFromHostedZone"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DisassociateVPCFromHostedZoneRequest method.
+//	req, resp := client.DisassociateVPCFromHostedZoneRequest(params)
 //
-//    // Example sending a request using the DisassociateVPCFromHostedZoneRequest method.
-//    req, resp := client.DisassociateVPCFromHostedZoneRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DisassociateVPCFromHostedZone
 func (c *Route53) DisassociateVPCFromHostedZoneRequest(input *DisassociateVPCFromHostedZoneInput) (req *request.Request, output *DisassociateVPCFromHostedZoneOutput) {
@@ -2269,16 +3181,40 @@ func (c *Route53) DisassociateVPCFromHostedZoneRequest(input *DisassociateVPCFro
 
 // DisassociateVPCFromHostedZone API operation for Amazon Route 53.
 //
-// Disassociates a VPC from a Amazon Route 53 private hosted zone. Note the
-// following:
+// Disassociates an Amazon Virtual Private Cloud (Amazon VPC) from an Amazon
+// Route 53 private hosted zone. Note the following:
+//
+//   - You can't disassociate the last Amazon VPC from a private hosted zone.
+//
+//   - You can't convert a private hosted zone into a public hosted zone.
 //
-//    * You can't disassociate the last VPC from a private hosted zone.
+//   - You can submit a DisassociateVPCFromHostedZone request using either
+//     the account that created the hosted zone or the account that created the
+//     Amazon VPC.
 //
-//    * You can't convert a private hosted zone into a public hosted zone.
+//   - Some services, such as Cloud Map and Amazon Elastic File System (Amazon
+//     EFS) automatically create hosted zones and associate VPCs with the hosted
+//     zones. A service can create a hosted zone using your account or using
+//     its own account. You can disassociate a VPC from a hosted zone only if
+//     the service created the hosted zone using your account. When you run DisassociateVPCFromHostedZone
+//     (https://docs.aws.amazon.com/Route53/latest/APIReference/API_ListHostedZonesByVPC.html),
+//     if the hosted zone has a value for OwningAccount, you can use DisassociateVPCFromHostedZone.
+//     If the hosted zone has a value for OwningService, you can't use DisassociateVPCFromHostedZone.
 //
-//    * You can submit a DisassociateVPCFromHostedZone request using either
-//    the account that created the hosted zone or the account that created the
-//    VPC.
+// When revoking access, the hosted zone and the Amazon VPC must belong to the
+// same partition. A partition is a group of Amazon Web Services Regions. Each
+// Amazon Web Services account is scoped to one partition.
+//
+// The following are the supported partitions:
+//
+//   - aws - Amazon Web Services Regions
+//
+//   - aws-cn - China Regions
+//
+//   - aws-us-gov - Amazon Web Services GovCloud (US) Region
+//
+// For more information, see Access Management (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
+// in the Amazon Web Services General Reference.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2288,23 +3224,24 @@ func (c *Route53) DisassociateVPCFromHostedZoneRequest(input *DisassociateVPCFro
 // API operation DisassociateVPCFromHostedZone for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchHostedZone "NoSuchHostedZone"
-//   No hosted zone exists with the ID that you specified.
 //
-//   * ErrCodeInvalidVPCId "InvalidVPCId"
-//   The VPC ID that you specified either isn't a valid ID or the current account
-//   is not authorized to access this VPC.
+//   - ErrCodeNoSuchHostedZone "NoSuchHostedZone"
+//     No hosted zone exists with the ID that you specified.
+//
+//   - ErrCodeInvalidVPCId "InvalidVPCId"
+//     The VPC ID that you specified either isn't a valid ID or the current account
+//     is not authorized to access this VPC.
 //
-//   * ErrCodeVPCAssociationNotFound "VPCAssociationNotFound"
-//   The specified VPC and hosted zone are not currently associated.
+//   - ErrCodeVPCAssociationNotFound "VPCAssociationNotFound"
+//     The specified VPC and hosted zone are not currently associated.
 //
-//   * ErrCodeLastVPCAssociation "LastVPCAssociation"
-//   The VPC that you're trying to disassociate from the private hosted zone is
-//   the last VPC that is associated with the hosted zone. Amazon Route 53 doesn't
-//   support disassociating the last VPC from a hosted zone.
+//   - ErrCodeLastVPCAssociation "LastVPCAssociation"
+//     The VPC that you're trying to disassociate from the private hosted zone is
+//     the last VPC that is associated with the hosted zone. Amazon Route 53 doesn't
+//     support disassociating the last VPC from a hosted zone.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/DisassociateVPCFromHostedZone
 func (c *Route53) DisassociateVPCFromHostedZone(input *DisassociateVPCFromHostedZoneInput) (*DisassociateVPCFromHostedZoneOutput, error) {
@@ -2328,6 +3265,113 @@ func (c *Route53) DisassociateVPCFromHostedZoneWithContext(ctx aws.Context, inpu
 	return out, req.Send()
 }
 
+const opEnableHostedZoneDNSSEC = "EnableHostedZoneDNSSEC"
+
+// EnableHostedZoneDNSSECRequest generates a "aws/request.Request" representing the
+// client's request for the EnableHostedZoneDNSSEC operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See EnableHostedZoneDNSSEC for more information on using the EnableHostedZoneDNSSEC
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the EnableHostedZoneDNSSECRequest method.
+//	req, resp := client.EnableHostedZoneDNSSECRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/EnableHostedZoneDNSSEC
+func (c *Route53) EnableHostedZoneDNSSECRequest(input *EnableHostedZoneDNSSECInput) (req *request.Request, output *EnableHostedZoneDNSSECOutput) {
+	op := &request.Operation{
+		Name:       opEnableHostedZoneDNSSEC,
+		HTTPMethod: "POST",
+		HTTPPath:   "/2013-04-01/hostedzone/{Id}/enable-dnssec",
+	}
+
+	if input == nil {
+		input = &EnableHostedZoneDNSSECInput{}
+	}
+
+	output = &EnableHostedZoneDNSSECOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// EnableHostedZoneDNSSEC API operation for Amazon Route 53.
+//
+// Enables DNSSEC signing in a specific hosted zone.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Route 53's
+// API operation EnableHostedZoneDNSSEC for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeNoSuchHostedZone "NoSuchHostedZone"
+//     No hosted zone exists with the ID that you specified.
+//
+//   - ErrCodeInvalidArgument "InvalidArgument"
+//     Parameter name is not valid.
+//
+//   - ErrCodeConcurrentModification "ConcurrentModification"
+//     Another user submitted a request to create, update, or delete the object
+//     at the same time that you did. Retry the request.
+//
+//   - ErrCodeKeySigningKeyWithActiveStatusNotFound "KeySigningKeyWithActiveStatusNotFound"
+//     A key-signing key (KSK) with ACTIVE status wasn't found.
+//
+//   - ErrCodeInvalidKMSArn "InvalidKMSArn"
+//     The KeyManagementServiceArn that you specified isn't valid to use with DNSSEC
+//     signing.
+//
+//   - ErrCodeHostedZonePartiallyDelegated "HostedZonePartiallyDelegated"
+//     The hosted zone nameservers don't match the parent nameservers. The hosted
+//     zone and parent must have the same nameservers.
+//
+//   - ErrCodeDNSSECNotFound "DNSSECNotFound"
+//     The hosted zone doesn't have any DNSSEC resources.
+//
+//   - ErrCodeInvalidKeySigningKeyStatus "InvalidKeySigningKeyStatus"
+//     The key-signing key (KSK) status isn't valid or another KSK has the status
+//     INTERNAL_FAILURE.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/EnableHostedZoneDNSSEC
+func (c *Route53) EnableHostedZoneDNSSEC(input *EnableHostedZoneDNSSECInput) (*EnableHostedZoneDNSSECOutput, error) {
+	req, out := c.EnableHostedZoneDNSSECRequest(input)
+	return out, req.Send()
+}
+
+// EnableHostedZoneDNSSECWithContext is the same as EnableHostedZoneDNSSEC with the addition of
+// the ability to pass a context and additional request options.
+//
+// See EnableHostedZoneDNSSEC for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Route53) EnableHostedZoneDNSSECWithContext(ctx aws.Context, input *EnableHostedZoneDNSSECInput, opts ...request.Option) (*EnableHostedZoneDNSSECOutput, error) {
+	req, out := c.EnableHostedZoneDNSSECRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opGetAccountLimit = "GetAccountLimit"
 
 // GetAccountLimitRequest generates a "aws/request.Request" representing the
@@ -2344,14 +3388,13 @@ const opGetAccountLimit = "GetAccountLimit"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetAccountLimitRequest method.
+//	req, resp := client.GetAccountLimitRequest(params)
 //
-//    // Example sending a request using the GetAccountLimitRequest method.
-//    req, resp := client.GetAccountLimitRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetAccountLimit
 func (c *Route53) GetAccountLimitRequest(input *GetAccountLimitInput) (req *request.Request, output *GetAccountLimitOutput) {
@@ -2379,10 +3422,10 @@ func (c *Route53) GetAccountLimitRequest(input *GetAccountLimitInput) (req *requ
 // in the Amazon Route 53 Developer Guide. To request a higher limit, open a
 // case (https://console.aws.amazon.com/support/home#/case/create?issueType=service-limit-increase&limitType=service-code-route53).
 //
-// You can also view account limits in AWS Trusted Advisor. Sign in to the AWS
-// Management Console and open the Trusted Advisor console at https://console.aws.amazon.com/trustedadvisor/
-// (https://console.aws.amazon.com/trustedadvisor). Then choose Service limits
-// in the navigation pane.
+// You can also view account limits in Amazon Web Services Trusted Advisor.
+// Sign in to the Amazon Web Services Management Console and open the Trusted
+// Advisor console at https://console.aws.amazon.com/trustedadvisor/ (https://console.aws.amazon.com/trustedadvisor).
+// Then choose Service limits in the navigation pane.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2392,8 +3435,8 @@ func (c *Route53) GetAccountLimitRequest(input *GetAccountLimitInput) (req *requ
 // API operation GetAccountLimit for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetAccountLimit
 func (c *Route53) GetAccountLimit(input *GetAccountLimitInput) (*GetAccountLimitOutput, error) {
@@ -2433,14 +3476,13 @@ const opGetChange = "GetChange"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetChangeRequest method.
+//	req, resp := client.GetChangeRequest(params)
 //
-//    // Example sending a request using the GetChangeRequest method.
-//    req, resp := client.GetChangeRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetChange
 func (c *Route53) GetChangeRequest(input *GetChangeInput) (req *request.Request, output *GetChangeOutput) {
@@ -2464,12 +3506,12 @@ func (c *Route53) GetChangeRequest(input *GetChangeInput) (req *request.Request,
 // Returns the current status of a change batch request. The status is one of
 // the following values:
 //
-//    * PENDING indicates that the changes in this request have not propagated
-//    to all Amazon Route 53 DNS servers. This is the initial status of all
-//    change batch requests.
+//   - PENDING indicates that the changes in this request have not propagated
+//     to all Amazon Route 53 DNS servers managing the hosted zone. This is the
+//     initial status of all change batch requests.
 //
-//    * INSYNC indicates that the changes have propagated to all Route 53 DNS
-//    servers.
+//   - INSYNC indicates that the changes have propagated to all Route 53 DNS
+//     servers managing the hosted zone.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2479,11 +3521,12 @@ func (c *Route53) GetChangeRequest(input *GetChangeInput) (req *request.Request,
 // API operation GetChange for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchChange "NoSuchChange"
-//   A change with the specified change ID does not exist.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeNoSuchChange "NoSuchChange"
+//     A change with the specified change ID does not exist.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetChange
 func (c *Route53) GetChange(input *GetChangeInput) (*GetChangeOutput, error) {
@@ -2523,14 +3566,13 @@ const opGetCheckerIpRanges = "GetCheckerIpRanges"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetCheckerIpRangesRequest method.
+//	req, resp := client.GetCheckerIpRangesRequest(params)
 //
-//    // Example sending a request using the GetCheckerIpRangesRequest method.
-//    req, resp := client.GetCheckerIpRangesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetCheckerIpRanges
 func (c *Route53) GetCheckerIpRangesRequest(input *GetCheckerIpRangesInput) (req *request.Request, output *GetCheckerIpRangesOutput) {
@@ -2551,10 +3593,12 @@ func (c *Route53) GetCheckerIpRangesRequest(input *GetCheckerIpRangesInput) (req
 
 // GetCheckerIpRanges API operation for Amazon Route 53.
 //
+// Route 53 does not perform authorization for this API because it retrieves
+// information that is already available to the public.
 //
 // GetCheckerIpRanges still works, but we recommend that you download ip-ranges.json,
-// which includes IP address ranges for all AWS services. For more information,
-// see IP Address Ranges of Amazon Route 53 Servers (http://docs.aws.amazon.com/Route53/latest/DeveloperGuide/route-53-ip-addresses.html)
+// which includes IP address ranges for all Amazon Web Services services. For
+// more information, see IP Address Ranges of Amazon Route 53 Servers (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/route-53-ip-addresses.html)
 // in the Amazon Route 53 Developer Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
@@ -2585,87 +3629,178 @@ func (c *Route53) GetCheckerIpRangesWithContext(ctx aws.Context, input *GetCheck
 	return out, req.Send()
 }
 
-const opGetGeoLocation = "GetGeoLocation"
+const opGetDNSSEC = "GetDNSSEC"
 
-// GetGeoLocationRequest generates a "aws/request.Request" representing the
-// client's request for the GetGeoLocation operation. The "output" return
+// GetDNSSECRequest generates a "aws/request.Request" representing the
+// client's request for the GetDNSSEC operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See GetGeoLocation for more information on using the GetGeoLocation
+// See GetDNSSEC for more information on using the GetDNSSEC
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetDNSSECRequest method.
+//	req, resp := client.GetDNSSECRequest(params)
 //
-//    // Example sending a request using the GetGeoLocationRequest method.
-//    req, resp := client.GetGeoLocationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetGeoLocation
-func (c *Route53) GetGeoLocationRequest(input *GetGeoLocationInput) (req *request.Request, output *GetGeoLocationOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetDNSSEC
+func (c *Route53) GetDNSSECRequest(input *GetDNSSECInput) (req *request.Request, output *GetDNSSECOutput) {
 	op := &request.Operation{
-		Name:       opGetGeoLocation,
+		Name:       opGetDNSSEC,
 		HTTPMethod: "GET",
-		HTTPPath:   "/2013-04-01/geolocation",
+		HTTPPath:   "/2013-04-01/hostedzone/{Id}/dnssec",
 	}
 
 	if input == nil {
-		input = &GetGeoLocationInput{}
+		input = &GetDNSSECInput{}
 	}
 
-	output = &GetGeoLocationOutput{}
+	output = &GetDNSSECOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// GetGeoLocation API operation for Amazon Route 53.
-//
-// Gets information about whether a specified geographic location is supported
-// for Amazon Route 53 geolocation resource record sets.
-//
-// Use the following syntax to determine whether a continent is supported for
-// geolocation:
-//
-// GET /2013-04-01/geolocation?continentcode=two-letter abbreviation for a continent
-//
-// Use the following syntax to determine whether a country is supported for
-// geolocation:
-//
-// GET /2013-04-01/geolocation?countrycode=two-character country code
-//
-// Use the following syntax to determine whether a subdivision of a country
-// is supported for geolocation:
+// GetDNSSEC API operation for Amazon Route 53.
 //
-// GET /2013-04-01/geolocation?countrycode=two-character country code&subdivisioncode=subdivision
-// code
+// Returns information about DNSSEC for a specific hosted zone, including the
+// key-signing keys (KSKs) in the hosted zone.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Route 53's
-// API operation GetGeoLocation for usage and error information.
+// API operation GetDNSSEC for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchGeoLocation "NoSuchGeoLocation"
-//   Amazon Route 53 doesn't support the specified geographic location.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeNoSuchHostedZone "NoSuchHostedZone"
+//     No hosted zone exists with the ID that you specified.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetGeoLocation
-func (c *Route53) GetGeoLocation(input *GetGeoLocationInput) (*GetGeoLocationOutput, error) {
-	req, out := c.GetGeoLocationRequest(input)
-	return out, req.Send()
+//   - ErrCodeInvalidArgument "InvalidArgument"
+//     Parameter name is not valid.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetDNSSEC
+func (c *Route53) GetDNSSEC(input *GetDNSSECInput) (*GetDNSSECOutput, error) {
+	req, out := c.GetDNSSECRequest(input)
+	return out, req.Send()
+}
+
+// GetDNSSECWithContext is the same as GetDNSSEC with the addition of
+// the ability to pass a context and additional request options.
+//
+// See GetDNSSEC for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Route53) GetDNSSECWithContext(ctx aws.Context, input *GetDNSSECInput, opts ...request.Option) (*GetDNSSECOutput, error) {
+	req, out := c.GetDNSSECRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opGetGeoLocation = "GetGeoLocation"
+
+// GetGeoLocationRequest generates a "aws/request.Request" representing the
+// client's request for the GetGeoLocation operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See GetGeoLocation for more information on using the GetGeoLocation
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the GetGeoLocationRequest method.
+//	req, resp := client.GetGeoLocationRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetGeoLocation
+func (c *Route53) GetGeoLocationRequest(input *GetGeoLocationInput) (req *request.Request, output *GetGeoLocationOutput) {
+	op := &request.Operation{
+		Name:       opGetGeoLocation,
+		HTTPMethod: "GET",
+		HTTPPath:   "/2013-04-01/geolocation",
+	}
+
+	if input == nil {
+		input = &GetGeoLocationInput{}
+	}
+
+	output = &GetGeoLocationOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// GetGeoLocation API operation for Amazon Route 53.
+//
+// Gets information about whether a specified geographic location is supported
+// for Amazon Route 53 geolocation resource record sets.
+//
+// Route 53 does not perform authorization for this API because it retrieves
+// information that is already available to the public.
+//
+// Use the following syntax to determine whether a continent is supported for
+// geolocation:
+//
+// GET /2013-04-01/geolocation?continentcode=two-letter abbreviation for a continent
+//
+// Use the following syntax to determine whether a country is supported for
+// geolocation:
+//
+// GET /2013-04-01/geolocation?countrycode=two-character country code
+//
+// Use the following syntax to determine whether a subdivision of a country
+// is supported for geolocation:
+//
+// GET /2013-04-01/geolocation?countrycode=two-character country code&subdivisioncode=subdivision
+// code
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Route 53's
+// API operation GetGeoLocation for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeNoSuchGeoLocation "NoSuchGeoLocation"
+//     Amazon Route 53 doesn't support the specified geographic location. For a
+//     list of supported geolocation codes, see the GeoLocation (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GeoLocation.html)
+//     data type.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetGeoLocation
+func (c *Route53) GetGeoLocation(input *GetGeoLocationInput) (*GetGeoLocationOutput, error) {
+	req, out := c.GetGeoLocationRequest(input)
+	return out, req.Send()
 }
 
 // GetGeoLocationWithContext is the same as GetGeoLocation with the addition of
@@ -2700,14 +3835,13 @@ const opGetHealthCheck = "GetHealthCheck"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetHealthCheckRequest method.
+//	req, resp := client.GetHealthCheckRequest(params)
 //
-//    // Example sending a request using the GetHealthCheckRequest method.
-//    req, resp := client.GetHealthCheckRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetHealthCheck
 func (c *Route53) GetHealthCheckRequest(input *GetHealthCheckInput) (req *request.Request, output *GetHealthCheckOutput) {
@@ -2738,15 +3872,16 @@ func (c *Route53) GetHealthCheckRequest(input *GetHealthCheckInput) (req *reques
 // API operation GetHealthCheck for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchHealthCheck "NoSuchHealthCheck"
-//   No health check exists with the specified ID.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeNoSuchHealthCheck "NoSuchHealthCheck"
+//     No health check exists with the specified ID.
 //
-//   * ErrCodeIncompatibleVersion "IncompatibleVersion"
-//   The resource you're trying to access is unsupported on this Amazon Route
-//   53 endpoint.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+//   - ErrCodeIncompatibleVersion "IncompatibleVersion"
+//     The resource you're trying to access is unsupported on this Amazon Route
+//     53 endpoint.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetHealthCheck
 func (c *Route53) GetHealthCheck(input *GetHealthCheckInput) (*GetHealthCheckOutput, error) {
@@ -2786,14 +3921,13 @@ const opGetHealthCheckCount = "GetHealthCheckCount"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetHealthCheckCountRequest method.
+//	req, resp := client.GetHealthCheckCountRequest(params)
 //
-//    // Example sending a request using the GetHealthCheckCountRequest method.
-//    req, resp := client.GetHealthCheckCountRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetHealthCheckCount
 func (c *Route53) GetHealthCheckCountRequest(input *GetHealthCheckCountInput) (req *request.Request, output *GetHealthCheckCountOutput) {
@@ -2815,7 +3949,7 @@ func (c *Route53) GetHealthCheckCountRequest(input *GetHealthCheckCountInput) (r
 // GetHealthCheckCount API operation for Amazon Route 53.
 //
 // Retrieves the number of health checks that are associated with the current
-// AWS account.
+// Amazon Web Services account.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2861,14 +3995,13 @@ const opGetHealthCheckLastFailureReason = "GetHealthCheckLastFailureReason"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetHealthCheckLastFailureReasonRequest method.
+//	req, resp := client.GetHealthCheckLastFailureReasonRequest(params)
 //
-//    // Example sending a request using the GetHealthCheckLastFailureReasonRequest method.
-//    req, resp := client.GetHealthCheckLastFailureReasonRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetHealthCheckLastFailureReason
 func (c *Route53) GetHealthCheckLastFailureReasonRequest(input *GetHealthCheckLastFailureReasonInput) (req *request.Request, output *GetHealthCheckLastFailureReasonOutput) {
@@ -2899,11 +4032,12 @@ func (c *Route53) GetHealthCheckLastFailureReasonRequest(input *GetHealthCheckLa
 // API operation GetHealthCheckLastFailureReason for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchHealthCheck "NoSuchHealthCheck"
-//   No health check exists with the specified ID.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeNoSuchHealthCheck "NoSuchHealthCheck"
+//     No health check exists with the specified ID.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetHealthCheckLastFailureReason
 func (c *Route53) GetHealthCheckLastFailureReason(input *GetHealthCheckLastFailureReasonInput) (*GetHealthCheckLastFailureReasonOutput, error) {
@@ -2943,14 +4077,13 @@ const opGetHealthCheckStatus = "GetHealthCheckStatus"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetHealthCheckStatusRequest method.
+//	req, resp := client.GetHealthCheckStatusRequest(params)
 //
-//    // Example sending a request using the GetHealthCheckStatusRequest method.
-//    req, resp := client.GetHealthCheckStatusRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetHealthCheckStatus
 func (c *Route53) GetHealthCheckStatusRequest(input *GetHealthCheckStatusInput) (req *request.Request, output *GetHealthCheckStatusOutput) {
@@ -2973,6 +4106,10 @@ func (c *Route53) GetHealthCheckStatusRequest(input *GetHealthCheckStatusInput)
 //
 // Gets status of a specified health check.
 //
+// This API is intended for use during development to diagnose behavior. It
+// doesn’t support production use-cases with high query rates that require
+// immediate and actionable responses.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -2981,11 +4118,12 @@ func (c *Route53) GetHealthCheckStatusRequest(input *GetHealthCheckStatusInput)
 // API operation GetHealthCheckStatus for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchHealthCheck "NoSuchHealthCheck"
-//   No health check exists with the specified ID.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeNoSuchHealthCheck "NoSuchHealthCheck"
+//     No health check exists with the specified ID.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetHealthCheckStatus
 func (c *Route53) GetHealthCheckStatus(input *GetHealthCheckStatusInput) (*GetHealthCheckStatusOutput, error) {
@@ -3025,14 +4163,13 @@ const opGetHostedZone = "GetHostedZone"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetHostedZoneRequest method.
+//	req, resp := client.GetHostedZoneRequest(params)
 //
-//    // Example sending a request using the GetHostedZoneRequest method.
-//    req, resp := client.GetHostedZoneRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetHostedZone
 func (c *Route53) GetHostedZoneRequest(input *GetHostedZoneInput) (req *request.Request, output *GetHostedZoneOutput) {
@@ -3064,11 +4201,12 @@ func (c *Route53) GetHostedZoneRequest(input *GetHostedZoneInput) (req *request.
 // API operation GetHostedZone for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchHostedZone "NoSuchHostedZone"
-//   No hosted zone exists with the ID that you specified.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeNoSuchHostedZone "NoSuchHostedZone"
+//     No hosted zone exists with the ID that you specified.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetHostedZone
 func (c *Route53) GetHostedZone(input *GetHostedZoneInput) (*GetHostedZoneOutput, error) {
@@ -3108,14 +4246,13 @@ const opGetHostedZoneCount = "GetHostedZoneCount"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetHostedZoneCountRequest method.
+//	req, resp := client.GetHostedZoneCountRequest(params)
 //
-//    // Example sending a request using the GetHostedZoneCountRequest method.
-//    req, resp := client.GetHostedZoneCountRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetHostedZoneCount
 func (c *Route53) GetHostedZoneCountRequest(input *GetHostedZoneCountInput) (req *request.Request, output *GetHostedZoneCountOutput) {
@@ -3137,7 +4274,7 @@ func (c *Route53) GetHostedZoneCountRequest(input *GetHostedZoneCountInput) (req
 // GetHostedZoneCount API operation for Amazon Route 53.
 //
 // Retrieves the number of hosted zones that are associated with the current
-// AWS account.
+// Amazon Web Services account.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -3147,8 +4284,8 @@ func (c *Route53) GetHostedZoneCountRequest(input *GetHostedZoneCountInput) (req
 // API operation GetHostedZoneCount for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetHostedZoneCount
 func (c *Route53) GetHostedZoneCount(input *GetHostedZoneCountInput) (*GetHostedZoneCountOutput, error) {
@@ -3188,14 +4325,13 @@ const opGetHostedZoneLimit = "GetHostedZoneLimit"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetHostedZoneLimitRequest method.
+//	req, resp := client.GetHostedZoneLimitRequest(params)
 //
-//    // Example sending a request using the GetHostedZoneLimitRequest method.
-//    req, resp := client.GetHostedZoneLimitRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetHostedZoneLimit
 func (c *Route53) GetHostedZoneLimitRequest(input *GetHostedZoneLimitInput) (req *request.Request, output *GetHostedZoneLimitOutput) {
@@ -3231,14 +4367,15 @@ func (c *Route53) GetHostedZoneLimitRequest(input *GetHostedZoneLimitInput) (req
 // API operation GetHostedZoneLimit for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchHostedZone "NoSuchHostedZone"
-//   No hosted zone exists with the ID that you specified.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeNoSuchHostedZone "NoSuchHostedZone"
+//     No hosted zone exists with the ID that you specified.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-//   * ErrCodeHostedZoneNotPrivate "HostedZoneNotPrivate"
-//   The specified hosted zone is a public hosted zone, not a private hosted zone.
+//   - ErrCodeHostedZoneNotPrivate "HostedZoneNotPrivate"
+//     The specified hosted zone is a public hosted zone, not a private hosted zone.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetHostedZoneLimit
 func (c *Route53) GetHostedZoneLimit(input *GetHostedZoneLimitInput) (*GetHostedZoneLimitOutput, error) {
@@ -3278,14 +4415,13 @@ const opGetQueryLoggingConfig = "GetQueryLoggingConfig"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetQueryLoggingConfigRequest method.
+//	req, resp := client.GetQueryLoggingConfigRequest(params)
 //
-//    // Example sending a request using the GetQueryLoggingConfigRequest method.
-//    req, resp := client.GetQueryLoggingConfigRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetQueryLoggingConfig
 func (c *Route53) GetQueryLoggingConfigRequest(input *GetQueryLoggingConfigInput) (req *request.Request, output *GetQueryLoggingConfigOutput) {
@@ -3319,11 +4455,12 @@ func (c *Route53) GetQueryLoggingConfigRequest(input *GetQueryLoggingConfigInput
 // API operation GetQueryLoggingConfig for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchQueryLoggingConfig "NoSuchQueryLoggingConfig"
-//   There is no DNS query logging configuration with the specified ID.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeNoSuchQueryLoggingConfig "NoSuchQueryLoggingConfig"
+//     There is no DNS query logging configuration with the specified ID.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetQueryLoggingConfig
 func (c *Route53) GetQueryLoggingConfig(input *GetQueryLoggingConfigInput) (*GetQueryLoggingConfigOutput, error) {
@@ -3363,14 +4500,13 @@ const opGetReusableDelegationSet = "GetReusableDelegationSet"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetReusableDelegationSetRequest method.
+//	req, resp := client.GetReusableDelegationSetRequest(params)
 //
-//    // Example sending a request using the GetReusableDelegationSetRequest method.
-//    req, resp := client.GetReusableDelegationSetRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetReusableDelegationSet
 func (c *Route53) GetReusableDelegationSetRequest(input *GetReusableDelegationSetInput) (req *request.Request, output *GetReusableDelegationSetOutput) {
@@ -3402,14 +4538,15 @@ func (c *Route53) GetReusableDelegationSetRequest(input *GetReusableDelegationSe
 // API operation GetReusableDelegationSet for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchDelegationSet "NoSuchDelegationSet"
-//   A reusable delegation set with the specified ID does not exist.
 //
-//   * ErrCodeDelegationSetNotReusable "DelegationSetNotReusable"
-//   A reusable delegation set with the specified ID does not exist.
+//   - ErrCodeNoSuchDelegationSet "NoSuchDelegationSet"
+//     A reusable delegation set with the specified ID does not exist.
+//
+//   - ErrCodeDelegationSetNotReusable "DelegationSetNotReusable"
+//     A reusable delegation set with the specified ID does not exist.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetReusableDelegationSet
 func (c *Route53) GetReusableDelegationSet(input *GetReusableDelegationSetInput) (*GetReusableDelegationSetOutput, error) {
@@ -3449,14 +4586,13 @@ const opGetReusableDelegationSetLimit = "GetReusableDelegationSetLimit"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetReusableDelegationSetLimitRequest method.
+//	req, resp := client.GetReusableDelegationSetLimitRequest(params)
 //
-//    // Example sending a request using the GetReusableDelegationSetLimitRequest method.
-//    req, resp := client.GetReusableDelegationSetLimitRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetReusableDelegationSetLimit
 func (c *Route53) GetReusableDelegationSetLimitRequest(input *GetReusableDelegationSetLimitInput) (req *request.Request, output *GetReusableDelegationSetLimitOutput) {
@@ -3492,11 +4628,12 @@ func (c *Route53) GetReusableDelegationSetLimitRequest(input *GetReusableDelegat
 // API operation GetReusableDelegationSetLimit for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
 //
-//   * ErrCodeNoSuchDelegationSet "NoSuchDelegationSet"
-//   A reusable delegation set with the specified ID does not exist.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+//   - ErrCodeNoSuchDelegationSet "NoSuchDelegationSet"
+//     A reusable delegation set with the specified ID does not exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetReusableDelegationSetLimit
 func (c *Route53) GetReusableDelegationSetLimit(input *GetReusableDelegationSetLimitInput) (*GetReusableDelegationSetLimitOutput, error) {
@@ -3536,14 +4673,13 @@ const opGetTrafficPolicy = "GetTrafficPolicy"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetTrafficPolicyRequest method.
+//	req, resp := client.GetTrafficPolicyRequest(params)
 //
-//    // Example sending a request using the GetTrafficPolicyRequest method.
-//    req, resp := client.GetTrafficPolicyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetTrafficPolicy
 func (c *Route53) GetTrafficPolicyRequest(input *GetTrafficPolicyInput) (req *request.Request, output *GetTrafficPolicyOutput) {
@@ -3566,6 +4702,9 @@ func (c *Route53) GetTrafficPolicyRequest(input *GetTrafficPolicyInput) (req *re
 //
 // Gets information about a specific traffic policy version.
 //
+// For information about how of deleting a traffic policy affects the response
+// from GetTrafficPolicy, see DeleteTrafficPolicy (https://docs.aws.amazon.com/Route53/latest/APIReference/API_DeleteTrafficPolicy.html).
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -3574,11 +4713,12 @@ func (c *Route53) GetTrafficPolicyRequest(input *GetTrafficPolicyInput) (req *re
 // API operation GetTrafficPolicy for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchTrafficPolicy "NoSuchTrafficPolicy"
-//   No traffic policy exists with the specified ID.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeNoSuchTrafficPolicy "NoSuchTrafficPolicy"
+//     No traffic policy exists with the specified ID.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetTrafficPolicy
 func (c *Route53) GetTrafficPolicy(input *GetTrafficPolicyInput) (*GetTrafficPolicyOutput, error) {
@@ -3618,14 +4758,13 @@ const opGetTrafficPolicyInstance = "GetTrafficPolicyInstance"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetTrafficPolicyInstanceRequest method.
+//	req, resp := client.GetTrafficPolicyInstanceRequest(params)
 //
-//    // Example sending a request using the GetTrafficPolicyInstanceRequest method.
-//    req, resp := client.GetTrafficPolicyInstanceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetTrafficPolicyInstance
 func (c *Route53) GetTrafficPolicyInstanceRequest(input *GetTrafficPolicyInstanceInput) (req *request.Request, output *GetTrafficPolicyInstanceOutput) {
@@ -3648,10 +4787,10 @@ func (c *Route53) GetTrafficPolicyInstanceRequest(input *GetTrafficPolicyInstanc
 //
 // Gets information about a specified traffic policy instance.
 //
-// After you submit a CreateTrafficPolicyInstance or an UpdateTrafficPolicyInstance
-// request, there's a brief delay while Amazon Route 53 creates the resource
-// record sets that are specified in the traffic policy definition. For more
-// information, see the State response element.
+// Use GetTrafficPolicyInstance with the id of new traffic policy instance to
+// confirm that the CreateTrafficPolicyInstance or an UpdateTrafficPolicyInstance
+// request completed successfully. For more information, see the State response
+// element.
 //
 // In the Route 53 console, traffic policy instances are known as policy records.
 //
@@ -3663,11 +4802,12 @@ func (c *Route53) GetTrafficPolicyInstanceRequest(input *GetTrafficPolicyInstanc
 // API operation GetTrafficPolicyInstance for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchTrafficPolicyInstance "NoSuchTrafficPolicyInstance"
-//   No traffic policy instance exists with the specified ID.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeNoSuchTrafficPolicyInstance "NoSuchTrafficPolicyInstance"
+//     No traffic policy instance exists with the specified ID.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetTrafficPolicyInstance
 func (c *Route53) GetTrafficPolicyInstance(input *GetTrafficPolicyInstanceInput) (*GetTrafficPolicyInstanceOutput, error) {
@@ -3707,14 +4847,13 @@ const opGetTrafficPolicyInstanceCount = "GetTrafficPolicyInstanceCount"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetTrafficPolicyInstanceCountRequest method.
+//	req, resp := client.GetTrafficPolicyInstanceCountRequest(params)
 //
-//    // Example sending a request using the GetTrafficPolicyInstanceCountRequest method.
-//    req, resp := client.GetTrafficPolicyInstanceCountRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/GetTrafficPolicyInstanceCount
 func (c *Route53) GetTrafficPolicyInstanceCountRequest(input *GetTrafficPolicyInstanceCountInput) (req *request.Request, output *GetTrafficPolicyInstanceCountOutput) {
@@ -3736,7 +4875,7 @@ func (c *Route53) GetTrafficPolicyInstanceCountRequest(input *GetTrafficPolicyIn
 // GetTrafficPolicyInstanceCount API operation for Amazon Route 53.
 //
 // Gets the number of traffic policy instances that are associated with the
-// current AWS account.
+// current Amazon Web Services account.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -3766,2327 +4905,3068 @@ func (c *Route53) GetTrafficPolicyInstanceCountWithContext(ctx aws.Context, inpu
 	return out, req.Send()
 }
 
-const opListGeoLocations = "ListGeoLocations"
+const opListCidrBlocks = "ListCidrBlocks"
 
-// ListGeoLocationsRequest generates a "aws/request.Request" representing the
-// client's request for the ListGeoLocations operation. The "output" return
+// ListCidrBlocksRequest generates a "aws/request.Request" representing the
+// client's request for the ListCidrBlocks operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListGeoLocations for more information on using the ListGeoLocations
+// See ListCidrBlocks for more information on using the ListCidrBlocks
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListCidrBlocksRequest method.
+//	req, resp := client.ListCidrBlocksRequest(params)
 //
-//    // Example sending a request using the ListGeoLocationsRequest method.
-//    req, resp := client.ListGeoLocationsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListGeoLocations
-func (c *Route53) ListGeoLocationsRequest(input *ListGeoLocationsInput) (req *request.Request, output *ListGeoLocationsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListCidrBlocks
+func (c *Route53) ListCidrBlocksRequest(input *ListCidrBlocksInput) (req *request.Request, output *ListCidrBlocksOutput) {
 	op := &request.Operation{
-		Name:       opListGeoLocations,
+		Name:       opListCidrBlocks,
 		HTTPMethod: "GET",
-		HTTPPath:   "/2013-04-01/geolocations",
+		HTTPPath:   "/2013-04-01/cidrcollection/{CidrCollectionId}/cidrblocks",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "MaxResults",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &ListGeoLocationsInput{}
+		input = &ListCidrBlocksInput{}
 	}
 
-	output = &ListGeoLocationsOutput{}
+	output = &ListCidrBlocksOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListGeoLocations API operation for Amazon Route 53.
-//
-// Retrieves a list of supported geographic locations.
+// ListCidrBlocks API operation for Amazon Route 53.
 //
-// Countries are listed first, and continents are listed last. If Amazon Route
-// 53 supports subdivisions for a country (for example, states or provinces),
-// the subdivisions for that country are listed in alphabetical order immediately
-// after the corresponding country.
+// Returns a paginated list of location objects and their CIDR blocks.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Route 53's
-// API operation ListGeoLocations for usage and error information.
+// API operation ListCidrBlocks for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListGeoLocations
-func (c *Route53) ListGeoLocations(input *ListGeoLocationsInput) (*ListGeoLocationsOutput, error) {
-	req, out := c.ListGeoLocationsRequest(input)
+//   - ErrCodeNoSuchCidrCollectionException "NoSuchCidrCollectionException"
+//     The CIDR collection you specified, doesn't exist.
+//
+//   - ErrCodeNoSuchCidrLocationException "NoSuchCidrLocationException"
+//     The CIDR collection location doesn't match any locations in your account.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListCidrBlocks
+func (c *Route53) ListCidrBlocks(input *ListCidrBlocksInput) (*ListCidrBlocksOutput, error) {
+	req, out := c.ListCidrBlocksRequest(input)
 	return out, req.Send()
 }
 
-// ListGeoLocationsWithContext is the same as ListGeoLocations with the addition of
+// ListCidrBlocksWithContext is the same as ListCidrBlocks with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListGeoLocations for details on how to use this API operation.
+// See ListCidrBlocks for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *Route53) ListGeoLocationsWithContext(ctx aws.Context, input *ListGeoLocationsInput, opts ...request.Option) (*ListGeoLocationsOutput, error) {
-	req, out := c.ListGeoLocationsRequest(input)
+func (c *Route53) ListCidrBlocksWithContext(ctx aws.Context, input *ListCidrBlocksInput, opts ...request.Option) (*ListCidrBlocksOutput, error) {
+	req, out := c.ListCidrBlocksRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListHealthChecks = "ListHealthChecks"
+// ListCidrBlocksPages iterates over the pages of a ListCidrBlocks operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListCidrBlocks method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListCidrBlocks operation.
+//	pageNum := 0
+//	err := client.ListCidrBlocksPages(params,
+//	    func(page *route53.ListCidrBlocksOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *Route53) ListCidrBlocksPages(input *ListCidrBlocksInput, fn func(*ListCidrBlocksOutput, bool) bool) error {
+	return c.ListCidrBlocksPagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-// ListHealthChecksRequest generates a "aws/request.Request" representing the
-// client's request for the ListHealthChecks operation. The "output" return
+// ListCidrBlocksPagesWithContext same as ListCidrBlocksPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Route53) ListCidrBlocksPagesWithContext(ctx aws.Context, input *ListCidrBlocksInput, fn func(*ListCidrBlocksOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListCidrBlocksInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListCidrBlocksRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListCidrBlocksOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListCidrCollections = "ListCidrCollections"
+
+// ListCidrCollectionsRequest generates a "aws/request.Request" representing the
+// client's request for the ListCidrCollections operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListHealthChecks for more information on using the ListHealthChecks
+// See ListCidrCollections for more information on using the ListCidrCollections
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListCidrCollectionsRequest method.
+//	req, resp := client.ListCidrCollectionsRequest(params)
 //
-//    // Example sending a request using the ListHealthChecksRequest method.
-//    req, resp := client.ListHealthChecksRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListHealthChecks
-func (c *Route53) ListHealthChecksRequest(input *ListHealthChecksInput) (req *request.Request, output *ListHealthChecksOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListCidrCollections
+func (c *Route53) ListCidrCollectionsRequest(input *ListCidrCollectionsInput) (req *request.Request, output *ListCidrCollectionsOutput) {
 	op := &request.Operation{
-		Name:       opListHealthChecks,
+		Name:       opListCidrCollections,
 		HTTPMethod: "GET",
-		HTTPPath:   "/2013-04-01/healthcheck",
+		HTTPPath:   "/2013-04-01/cidrcollection",
 		Paginator: &request.Paginator{
-			InputTokens:     []string{"Marker"},
-			OutputTokens:    []string{"NextMarker"},
-			LimitToken:      "MaxItems",
-			TruncationToken: "IsTruncated",
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "MaxResults",
+			TruncationToken: "",
 		},
 	}
 
 	if input == nil {
-		input = &ListHealthChecksInput{}
+		input = &ListCidrCollectionsInput{}
 	}
 
-	output = &ListHealthChecksOutput{}
+	output = &ListCidrCollectionsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListHealthChecks API operation for Amazon Route 53.
+// ListCidrCollections API operation for Amazon Route 53.
 //
-// Retrieve a list of the health checks that are associated with the current
-// AWS account.
+// Returns a paginated list of CIDR collections in the Amazon Web Services account
+// (metadata only).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Route 53's
-// API operation ListHealthChecks for usage and error information.
+// API operation ListCidrCollections for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-//   * ErrCodeIncompatibleVersion "IncompatibleVersion"
-//   The resource you're trying to access is unsupported on this Amazon Route
-//   53 endpoint.
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListHealthChecks
-func (c *Route53) ListHealthChecks(input *ListHealthChecksInput) (*ListHealthChecksOutput, error) {
-	req, out := c.ListHealthChecksRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListCidrCollections
+func (c *Route53) ListCidrCollections(input *ListCidrCollectionsInput) (*ListCidrCollectionsOutput, error) {
+	req, out := c.ListCidrCollectionsRequest(input)
 	return out, req.Send()
 }
 
-// ListHealthChecksWithContext is the same as ListHealthChecks with the addition of
+// ListCidrCollectionsWithContext is the same as ListCidrCollections with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListHealthChecks for details on how to use this API operation.
+// See ListCidrCollections for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *Route53) ListHealthChecksWithContext(ctx aws.Context, input *ListHealthChecksInput, opts ...request.Option) (*ListHealthChecksOutput, error) {
-	req, out := c.ListHealthChecksRequest(input)
+func (c *Route53) ListCidrCollectionsWithContext(ctx aws.Context, input *ListCidrCollectionsInput, opts ...request.Option) (*ListCidrCollectionsOutput, error) {
+	req, out := c.ListCidrCollectionsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-// ListHealthChecksPages iterates over the pages of a ListHealthChecks operation,
+// ListCidrCollectionsPages iterates over the pages of a ListCidrCollections operation,
 // calling the "fn" function with the response data for each page. To stop
 // iterating, return false from the fn function.
 //
-// See ListHealthChecks method for more information on how to use this operation.
+// See ListCidrCollections method for more information on how to use this operation.
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListHealthChecks operation.
-//    pageNum := 0
-//    err := client.ListHealthChecksPages(params,
-//        func(page *route53.ListHealthChecksOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
-func (c *Route53) ListHealthChecksPages(input *ListHealthChecksInput, fn func(*ListHealthChecksOutput, bool) bool) error {
-	return c.ListHealthChecksPagesWithContext(aws.BackgroundContext(), input, fn)
+//	// Example iterating over at most 3 pages of a ListCidrCollections operation.
+//	pageNum := 0
+//	err := client.ListCidrCollectionsPages(params,
+//	    func(page *route53.ListCidrCollectionsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *Route53) ListCidrCollectionsPages(input *ListCidrCollectionsInput, fn func(*ListCidrCollectionsOutput, bool) bool) error {
+	return c.ListCidrCollectionsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
 
-// ListHealthChecksPagesWithContext same as ListHealthChecksPages except
+// ListCidrCollectionsPagesWithContext same as ListCidrCollectionsPages except
 // it takes a Context and allows setting request options on the pages.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *Route53) ListHealthChecksPagesWithContext(ctx aws.Context, input *ListHealthChecksInput, fn func(*ListHealthChecksOutput, bool) bool, opts ...request.Option) error {
+func (c *Route53) ListCidrCollectionsPagesWithContext(ctx aws.Context, input *ListCidrCollectionsInput, fn func(*ListCidrCollectionsOutput, bool) bool, opts ...request.Option) error {
 	p := request.Pagination{
 		NewRequest: func() (*request.Request, error) {
-			var inCpy *ListHealthChecksInput
+			var inCpy *ListCidrCollectionsInput
 			if input != nil {
 				tmp := *input
 				inCpy = &tmp
 			}
-			req, _ := c.ListHealthChecksRequest(inCpy)
+			req, _ := c.ListCidrCollectionsRequest(inCpy)
 			req.SetContext(ctx)
 			req.ApplyOptions(opts...)
 			return req, nil
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListHealthChecksOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListCidrCollectionsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
-const opListHostedZones = "ListHostedZones"
+const opListCidrLocations = "ListCidrLocations"
 
-// ListHostedZonesRequest generates a "aws/request.Request" representing the
-// client's request for the ListHostedZones operation. The "output" return
+// ListCidrLocationsRequest generates a "aws/request.Request" representing the
+// client's request for the ListCidrLocations operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListHostedZones for more information on using the ListHostedZones
+// See ListCidrLocations for more information on using the ListCidrLocations
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListCidrLocationsRequest method.
+//	req, resp := client.ListCidrLocationsRequest(params)
 //
-//    // Example sending a request using the ListHostedZonesRequest method.
-//    req, resp := client.ListHostedZonesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListHostedZones
-func (c *Route53) ListHostedZonesRequest(input *ListHostedZonesInput) (req *request.Request, output *ListHostedZonesOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListCidrLocations
+func (c *Route53) ListCidrLocationsRequest(input *ListCidrLocationsInput) (req *request.Request, output *ListCidrLocationsOutput) {
 	op := &request.Operation{
-		Name:       opListHostedZones,
+		Name:       opListCidrLocations,
 		HTTPMethod: "GET",
-		HTTPPath:   "/2013-04-01/hostedzone",
+		HTTPPath:   "/2013-04-01/cidrcollection/{CidrCollectionId}",
 		Paginator: &request.Paginator{
-			InputTokens:     []string{"Marker"},
-			OutputTokens:    []string{"NextMarker"},
-			LimitToken:      "MaxItems",
-			TruncationToken: "IsTruncated",
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "MaxResults",
+			TruncationToken: "",
 		},
 	}
 
 	if input == nil {
-		input = &ListHostedZonesInput{}
+		input = &ListCidrLocationsInput{}
 	}
 
-	output = &ListHostedZonesOutput{}
+	output = &ListCidrLocationsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListHostedZones API operation for Amazon Route 53.
-//
-// Retrieves a list of the public and private hosted zones that are associated
-// with the current AWS account. The response includes a HostedZones child element
-// for each hosted zone.
+// ListCidrLocations API operation for Amazon Route 53.
 //
-// Amazon Route 53 returns a maximum of 100 items in each response. If you have
-// a lot of hosted zones, you can use the maxitems parameter to list them in
-// groups of up to 100.
+// Returns a paginated list of CIDR locations for the given collection (metadata
+// only, does not include CIDR blocks).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Route 53's
-// API operation ListHostedZones for usage and error information.
+// API operation ListCidrLocations for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
 //
-//   * ErrCodeNoSuchDelegationSet "NoSuchDelegationSet"
-//   A reusable delegation set with the specified ID does not exist.
+//   - ErrCodeNoSuchCidrCollectionException "NoSuchCidrCollectionException"
+//     The CIDR collection you specified, doesn't exist.
 //
-//   * ErrCodeDelegationSetNotReusable "DelegationSetNotReusable"
-//   A reusable delegation set with the specified ID does not exist.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListHostedZones
-func (c *Route53) ListHostedZones(input *ListHostedZonesInput) (*ListHostedZonesOutput, error) {
-	req, out := c.ListHostedZonesRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListCidrLocations
+func (c *Route53) ListCidrLocations(input *ListCidrLocationsInput) (*ListCidrLocationsOutput, error) {
+	req, out := c.ListCidrLocationsRequest(input)
 	return out, req.Send()
 }
 
-// ListHostedZonesWithContext is the same as ListHostedZones with the addition of
+// ListCidrLocationsWithContext is the same as ListCidrLocations with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListHostedZones for details on how to use this API operation.
+// See ListCidrLocations for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *Route53) ListHostedZonesWithContext(ctx aws.Context, input *ListHostedZonesInput, opts ...request.Option) (*ListHostedZonesOutput, error) {
-	req, out := c.ListHostedZonesRequest(input)
+func (c *Route53) ListCidrLocationsWithContext(ctx aws.Context, input *ListCidrLocationsInput, opts ...request.Option) (*ListCidrLocationsOutput, error) {
+	req, out := c.ListCidrLocationsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-// ListHostedZonesPages iterates over the pages of a ListHostedZones operation,
+// ListCidrLocationsPages iterates over the pages of a ListCidrLocations operation,
 // calling the "fn" function with the response data for each page. To stop
 // iterating, return false from the fn function.
 //
-// See ListHostedZones method for more information on how to use this operation.
+// See ListCidrLocations method for more information on how to use this operation.
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListHostedZones operation.
-//    pageNum := 0
-//    err := client.ListHostedZonesPages(params,
-//        func(page *route53.ListHostedZonesOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
-func (c *Route53) ListHostedZonesPages(input *ListHostedZonesInput, fn func(*ListHostedZonesOutput, bool) bool) error {
-	return c.ListHostedZonesPagesWithContext(aws.BackgroundContext(), input, fn)
+//	// Example iterating over at most 3 pages of a ListCidrLocations operation.
+//	pageNum := 0
+//	err := client.ListCidrLocationsPages(params,
+//	    func(page *route53.ListCidrLocationsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *Route53) ListCidrLocationsPages(input *ListCidrLocationsInput, fn func(*ListCidrLocationsOutput, bool) bool) error {
+	return c.ListCidrLocationsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
 
-// ListHostedZonesPagesWithContext same as ListHostedZonesPages except
+// ListCidrLocationsPagesWithContext same as ListCidrLocationsPages except
 // it takes a Context and allows setting request options on the pages.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *Route53) ListHostedZonesPagesWithContext(ctx aws.Context, input *ListHostedZonesInput, fn func(*ListHostedZonesOutput, bool) bool, opts ...request.Option) error {
+func (c *Route53) ListCidrLocationsPagesWithContext(ctx aws.Context, input *ListCidrLocationsInput, fn func(*ListCidrLocationsOutput, bool) bool, opts ...request.Option) error {
 	p := request.Pagination{
 		NewRequest: func() (*request.Request, error) {
-			var inCpy *ListHostedZonesInput
+			var inCpy *ListCidrLocationsInput
 			if input != nil {
 				tmp := *input
 				inCpy = &tmp
 			}
-			req, _ := c.ListHostedZonesRequest(inCpy)
+			req, _ := c.ListCidrLocationsRequest(inCpy)
 			req.SetContext(ctx)
 			req.ApplyOptions(opts...)
 			return req, nil
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListHostedZonesOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListCidrLocationsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
-const opListHostedZonesByName = "ListHostedZonesByName"
+const opListGeoLocations = "ListGeoLocations"
 
-// ListHostedZonesByNameRequest generates a "aws/request.Request" representing the
-// client's request for the ListHostedZonesByName operation. The "output" return
+// ListGeoLocationsRequest generates a "aws/request.Request" representing the
+// client's request for the ListGeoLocations operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListHostedZonesByName for more information on using the ListHostedZonesByName
+// See ListGeoLocations for more information on using the ListGeoLocations
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListGeoLocationsRequest method.
+//	req, resp := client.ListGeoLocationsRequest(params)
 //
-//    // Example sending a request using the ListHostedZonesByNameRequest method.
-//    req, resp := client.ListHostedZonesByNameRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListHostedZonesByName
-func (c *Route53) ListHostedZonesByNameRequest(input *ListHostedZonesByNameInput) (req *request.Request, output *ListHostedZonesByNameOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListGeoLocations
+func (c *Route53) ListGeoLocationsRequest(input *ListGeoLocationsInput) (req *request.Request, output *ListGeoLocationsOutput) {
 	op := &request.Operation{
-		Name:       opListHostedZonesByName,
+		Name:       opListGeoLocations,
 		HTTPMethod: "GET",
-		HTTPPath:   "/2013-04-01/hostedzonesbyname",
+		HTTPPath:   "/2013-04-01/geolocations",
 	}
 
 	if input == nil {
-		input = &ListHostedZonesByNameInput{}
+		input = &ListGeoLocationsInput{}
 	}
 
-	output = &ListHostedZonesByNameOutput{}
+	output = &ListGeoLocationsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListHostedZonesByName API operation for Amazon Route 53.
-//
-// Retrieves a list of your hosted zones in lexicographic order. The response
-// includes a HostedZones child element for each hosted zone created by the
-// current AWS account.
-//
-// ListHostedZonesByName sorts hosted zones by name with the labels reversed.
-// For example:
-//
-// com.example.www.
-//
-// Note the trailing dot, which can change the sort order in some circumstances.
-//
-// If the domain name includes escape characters or Punycode, ListHostedZonesByName
-// alphabetizes the domain name using the escaped or Punycoded value, which
-// is the format that Amazon Route 53 saves in its database. For example, to
-// create a hosted zone for exämple.com, you specify ex\344mple.com for the
-// domain name. ListHostedZonesByName alphabetizes it as:
-//
-// com.ex\344mple.
-//
-// The labels are reversed and alphabetized using the escaped value. For more
-// information about valid domain name formats, including internationalized
-// domain names, see DNS Domain Name Format (http://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DomainNameFormat.html)
-// in the Amazon Route 53 Developer Guide.
-//
-// Route 53 returns up to 100 items in each response. If you have a lot of hosted
-// zones, use the MaxItems parameter to list them in groups of up to 100. The
-// response includes values that help navigate from one group of MaxItems hosted
-// zones to the next:
+// ListGeoLocations API operation for Amazon Route 53.
 //
-//    * The DNSName and HostedZoneId elements in the response contain the values,
-//    if any, specified for the dnsname and hostedzoneid parameters in the request
-//    that produced the current response.
+// Retrieves a list of supported geographic locations.
 //
-//    * The MaxItems element in the response contains the value, if any, that
-//    you specified for the maxitems parameter in the request that produced
-//    the current response.
+// Countries are listed first, and continents are listed last. If Amazon Route
+// 53 supports subdivisions for a country (for example, states or provinces),
+// the subdivisions for that country are listed in alphabetical order immediately
+// after the corresponding country.
 //
-//    * If the value of IsTruncated in the response is true, there are more
-//    hosted zones associated with the current AWS account. If IsTruncated is
-//    false, this response includes the last hosted zone that is associated
-//    with the current account. The NextDNSName element and NextHostedZoneId
-//    elements are omitted from the response.
+// Route 53 does not perform authorization for this API because it retrieves
+// information that is already available to the public.
 //
-//    * The NextDNSName and NextHostedZoneId elements in the response contain
-//    the domain name and the hosted zone ID of the next hosted zone that is
-//    associated with the current AWS account. If you want to list more hosted
-//    zones, make another call to ListHostedZonesByName, and specify the value
-//    of NextDNSName and NextHostedZoneId in the dnsname and hostedzoneid parameters,
-//    respectively.
+// For a list of supported geolocation codes, see the GeoLocation (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GeoLocation.html)
+// data type.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Route 53's
-// API operation ListHostedZonesByName for usage and error information.
+// API operation ListGeoLocations for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-//   * ErrCodeInvalidDomainName "InvalidDomainName"
-//   The specified domain name is not valid.
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListHostedZonesByName
-func (c *Route53) ListHostedZonesByName(input *ListHostedZonesByNameInput) (*ListHostedZonesByNameOutput, error) {
-	req, out := c.ListHostedZonesByNameRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListGeoLocations
+func (c *Route53) ListGeoLocations(input *ListGeoLocationsInput) (*ListGeoLocationsOutput, error) {
+	req, out := c.ListGeoLocationsRequest(input)
 	return out, req.Send()
 }
 
-// ListHostedZonesByNameWithContext is the same as ListHostedZonesByName with the addition of
+// ListGeoLocationsWithContext is the same as ListGeoLocations with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListHostedZonesByName for details on how to use this API operation.
+// See ListGeoLocations for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *Route53) ListHostedZonesByNameWithContext(ctx aws.Context, input *ListHostedZonesByNameInput, opts ...request.Option) (*ListHostedZonesByNameOutput, error) {
-	req, out := c.ListHostedZonesByNameRequest(input)
+func (c *Route53) ListGeoLocationsWithContext(ctx aws.Context, input *ListGeoLocationsInput, opts ...request.Option) (*ListGeoLocationsOutput, error) {
+	req, out := c.ListGeoLocationsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListQueryLoggingConfigs = "ListQueryLoggingConfigs"
+const opListHealthChecks = "ListHealthChecks"
 
-// ListQueryLoggingConfigsRequest generates a "aws/request.Request" representing the
-// client's request for the ListQueryLoggingConfigs operation. The "output" return
+// ListHealthChecksRequest generates a "aws/request.Request" representing the
+// client's request for the ListHealthChecks operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListQueryLoggingConfigs for more information on using the ListQueryLoggingConfigs
+// See ListHealthChecks for more information on using the ListHealthChecks
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListHealthChecksRequest method.
+//	req, resp := client.ListHealthChecksRequest(params)
 //
-//    // Example sending a request using the ListQueryLoggingConfigsRequest method.
-//    req, resp := client.ListQueryLoggingConfigsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListQueryLoggingConfigs
-func (c *Route53) ListQueryLoggingConfigsRequest(input *ListQueryLoggingConfigsInput) (req *request.Request, output *ListQueryLoggingConfigsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListHealthChecks
+func (c *Route53) ListHealthChecksRequest(input *ListHealthChecksInput) (req *request.Request, output *ListHealthChecksOutput) {
 	op := &request.Operation{
-		Name:       opListQueryLoggingConfigs,
+		Name:       opListHealthChecks,
 		HTTPMethod: "GET",
-		HTTPPath:   "/2013-04-01/queryloggingconfig",
+		HTTPPath:   "/2013-04-01/healthcheck",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"Marker"},
+			OutputTokens:    []string{"NextMarker"},
+			LimitToken:      "MaxItems",
+			TruncationToken: "IsTruncated",
+		},
 	}
 
 	if input == nil {
-		input = &ListQueryLoggingConfigsInput{}
+		input = &ListHealthChecksInput{}
 	}
 
-	output = &ListQueryLoggingConfigsOutput{}
+	output = &ListHealthChecksOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListQueryLoggingConfigs API operation for Amazon Route 53.
-//
-// Lists the configurations for DNS query logging that are associated with the
-// current AWS account or the configuration that is associated with a specified
-// hosted zone.
+// ListHealthChecks API operation for Amazon Route 53.
 //
-// For more information about DNS query logs, see CreateQueryLoggingConfig (https://docs.aws.amazon.com/Route53/latest/APIReference/API_CreateQueryLoggingConfig.html).
-// Additional information, including the format of DNS query logs, appears in
-// Logging DNS Queries (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/query-logs.html)
-// in the Amazon Route 53 Developer Guide.
+// Retrieve a list of the health checks that are associated with the current
+// Amazon Web Services account.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Route 53's
-// API operation ListQueryLoggingConfigs for usage and error information.
+// API operation ListHealthChecks for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
 //
-//   * ErrCodeInvalidPaginationToken "InvalidPaginationToken"
-//   The value that you specified to get the second or subsequent page of results
-//   is invalid.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-//   * ErrCodeNoSuchHostedZone "NoSuchHostedZone"
-//   No hosted zone exists with the ID that you specified.
+//   - ErrCodeIncompatibleVersion "IncompatibleVersion"
+//     The resource you're trying to access is unsupported on this Amazon Route
+//     53 endpoint.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListQueryLoggingConfigs
-func (c *Route53) ListQueryLoggingConfigs(input *ListQueryLoggingConfigsInput) (*ListQueryLoggingConfigsOutput, error) {
-	req, out := c.ListQueryLoggingConfigsRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListHealthChecks
+func (c *Route53) ListHealthChecks(input *ListHealthChecksInput) (*ListHealthChecksOutput, error) {
+	req, out := c.ListHealthChecksRequest(input)
 	return out, req.Send()
 }
 
-// ListQueryLoggingConfigsWithContext is the same as ListQueryLoggingConfigs with the addition of
+// ListHealthChecksWithContext is the same as ListHealthChecks with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListQueryLoggingConfigs for details on how to use this API operation.
+// See ListHealthChecks for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *Route53) ListQueryLoggingConfigsWithContext(ctx aws.Context, input *ListQueryLoggingConfigsInput, opts ...request.Option) (*ListQueryLoggingConfigsOutput, error) {
-	req, out := c.ListQueryLoggingConfigsRequest(input)
+func (c *Route53) ListHealthChecksWithContext(ctx aws.Context, input *ListHealthChecksInput, opts ...request.Option) (*ListHealthChecksOutput, error) {
+	req, out := c.ListHealthChecksRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListResourceRecordSets = "ListResourceRecordSets"
+// ListHealthChecksPages iterates over the pages of a ListHealthChecks operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListHealthChecks method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListHealthChecks operation.
+//	pageNum := 0
+//	err := client.ListHealthChecksPages(params,
+//	    func(page *route53.ListHealthChecksOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *Route53) ListHealthChecksPages(input *ListHealthChecksInput, fn func(*ListHealthChecksOutput, bool) bool) error {
+	return c.ListHealthChecksPagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-// ListResourceRecordSetsRequest generates a "aws/request.Request" representing the
-// client's request for the ListResourceRecordSets operation. The "output" return
+// ListHealthChecksPagesWithContext same as ListHealthChecksPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Route53) ListHealthChecksPagesWithContext(ctx aws.Context, input *ListHealthChecksInput, fn func(*ListHealthChecksOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListHealthChecksInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListHealthChecksRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListHealthChecksOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListHostedZones = "ListHostedZones"
+
+// ListHostedZonesRequest generates a "aws/request.Request" representing the
+// client's request for the ListHostedZones operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListResourceRecordSets for more information on using the ListResourceRecordSets
+// See ListHostedZones for more information on using the ListHostedZones
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListHostedZonesRequest method.
+//	req, resp := client.ListHostedZonesRequest(params)
 //
-//    // Example sending a request using the ListResourceRecordSetsRequest method.
-//    req, resp := client.ListResourceRecordSetsRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListResourceRecordSets
-func (c *Route53) ListResourceRecordSetsRequest(input *ListResourceRecordSetsInput) (req *request.Request, output *ListResourceRecordSetsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListHostedZones
+func (c *Route53) ListHostedZonesRequest(input *ListHostedZonesInput) (req *request.Request, output *ListHostedZonesOutput) {
 	op := &request.Operation{
-		Name:       opListResourceRecordSets,
+		Name:       opListHostedZones,
 		HTTPMethod: "GET",
-		HTTPPath:   "/2013-04-01/hostedzone/{Id}/rrset",
+		HTTPPath:   "/2013-04-01/hostedzone",
 		Paginator: &request.Paginator{
-			InputTokens:     []string{"StartRecordName", "StartRecordType", "StartRecordIdentifier"},
-			OutputTokens:    []string{"NextRecordName", "NextRecordType", "NextRecordIdentifier"},
+			InputTokens:     []string{"Marker"},
+			OutputTokens:    []string{"NextMarker"},
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
 		},
 	}
 
 	if input == nil {
-		input = &ListResourceRecordSetsInput{}
+		input = &ListHostedZonesInput{}
 	}
 
-	output = &ListResourceRecordSetsOutput{}
+	output = &ListHostedZonesOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListResourceRecordSets API operation for Amazon Route 53.
-//
-// Lists the resource record sets in a specified hosted zone.
-//
-// ListResourceRecordSets returns up to 100 resource record sets at a time in
-// ASCII order, beginning at a position specified by the name and type elements.
+// ListHostedZones API operation for Amazon Route 53.
 //
-// Sort order
+// Retrieves a list of the public and private hosted zones that are associated
+// with the current Amazon Web Services account. The response includes a HostedZones
+// child element for each hosted zone.
 //
-// ListResourceRecordSets sorts results first by DNS name with the labels reversed,
-// for example:
+// Amazon Route 53 returns a maximum of 100 items in each response. If you have
+// a lot of hosted zones, you can use the maxitems parameter to list them in
+// groups of up to 100.
 //
-// com.example.www.
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
 //
-// Note the trailing dot, which can change the sort order when the record name
-// contains characters that appear before . (decimal 46) in the ASCII table.
-// These characters include the following: ! " # $ % & ' ( ) * + , -
+// See the AWS API reference guide for Amazon Route 53's
+// API operation ListHostedZones for usage and error information.
 //
-// When multiple records have the same DNS name, ListResourceRecordSets sorts
-// results by the record type.
+// Returned Error Codes:
 //
-// Specifying where to start listing records
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-// You can use the name and type elements to specify the resource record set
-// that the list begins with:
+//   - ErrCodeNoSuchDelegationSet "NoSuchDelegationSet"
+//     A reusable delegation set with the specified ID does not exist.
 //
-// If you do not specify Name or Type
+//   - ErrCodeDelegationSetNotReusable "DelegationSetNotReusable"
+//     A reusable delegation set with the specified ID does not exist.
 //
-// The results begin with the first resource record set that the hosted zone
-// contains.
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListHostedZones
+func (c *Route53) ListHostedZones(input *ListHostedZonesInput) (*ListHostedZonesOutput, error) {
+	req, out := c.ListHostedZonesRequest(input)
+	return out, req.Send()
+}
+
+// ListHostedZonesWithContext is the same as ListHostedZones with the addition of
+// the ability to pass a context and additional request options.
 //
-// If you specify Name but not Type
+// See ListHostedZones for details on how to use this API operation.
 //
-// The results begin with the first resource record set in the list whose name
-// is greater than or equal to Name.
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Route53) ListHostedZonesWithContext(ctx aws.Context, input *ListHostedZonesInput, opts ...request.Option) (*ListHostedZonesOutput, error) {
+	req, out := c.ListHostedZonesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+// ListHostedZonesPages iterates over the pages of a ListHostedZones operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
 //
-// If you specify Type but not Name
+// See ListHostedZones method for more information on how to use this operation.
 //
-// Amazon Route 53 returns the InvalidInput error.
+// Note: This operation can generate multiple requests to a service.
 //
-// If you specify both Name and Type
-//
-// The results begin with the first resource record set in the list whose name
-// is greater than or equal to Name, and whose type is greater than or equal
-// to Type.
-//
-// Resource record sets that are PENDING
-//
-// This action returns the most current version of the records. This includes
-// records that are PENDING, and that are not yet available on all Route 53
-// DNS servers.
-//
-// Changing resource record sets
-//
-// To ensure that you get an accurate listing of the resource record sets for
-// a hosted zone at a point in time, do not submit a ChangeResourceRecordSets
-// request while you're paging through the results of a ListResourceRecordSets
-// request. If you do, some pages may display results without the latest changes
-// while other pages display results with the latest changes.
-//
-// Displaying the next page of results
-//
-// If a ListResourceRecordSets command returns more than one page of results,
-// the value of IsTruncated is true. To display the next page of results, get
-// the values of NextRecordName, NextRecordType, and NextRecordIdentifier (if
-// any) from the response. Then submit another ListResourceRecordSets request,
-// and specify those values for StartRecordName, StartRecordType, and StartRecordIdentifier.
-//
-// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
-// with awserr.Error's Code and Message methods to get detailed information about
-// the error.
-//
-// See the AWS API reference guide for Amazon Route 53's
-// API operation ListResourceRecordSets for usage and error information.
-//
-// Returned Error Codes:
-//   * ErrCodeNoSuchHostedZone "NoSuchHostedZone"
-//   No hosted zone exists with the ID that you specified.
-//
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListResourceRecordSets
-func (c *Route53) ListResourceRecordSets(input *ListResourceRecordSetsInput) (*ListResourceRecordSetsOutput, error) {
-	req, out := c.ListResourceRecordSetsRequest(input)
-	return out, req.Send()
-}
-
-// ListResourceRecordSetsWithContext is the same as ListResourceRecordSets with the addition of
-// the ability to pass a context and additional request options.
-//
-// See ListResourceRecordSets for details on how to use this API operation.
-//
-// The context must be non-nil and will be used for request cancellation. If
-// the context is nil a panic will occur. In the future the SDK may create
-// sub-contexts for http.Requests. See https://golang.org/pkg/context/
-// for more information on using Contexts.
-func (c *Route53) ListResourceRecordSetsWithContext(ctx aws.Context, input *ListResourceRecordSetsInput, opts ...request.Option) (*ListResourceRecordSetsOutput, error) {
-	req, out := c.ListResourceRecordSetsRequest(input)
-	req.SetContext(ctx)
-	req.ApplyOptions(opts...)
-	return out, req.Send()
-}
-
-// ListResourceRecordSetsPages iterates over the pages of a ListResourceRecordSets operation,
-// calling the "fn" function with the response data for each page. To stop
-// iterating, return false from the fn function.
-//
-// See ListResourceRecordSets method for more information on how to use this operation.
-//
-// Note: This operation can generate multiple requests to a service.
-//
-//    // Example iterating over at most 3 pages of a ListResourceRecordSets operation.
-//    pageNum := 0
-//    err := client.ListResourceRecordSetsPages(params,
-//        func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
-func (c *Route53) ListResourceRecordSetsPages(input *ListResourceRecordSetsInput, fn func(*ListResourceRecordSetsOutput, bool) bool) error {
-	return c.ListResourceRecordSetsPagesWithContext(aws.BackgroundContext(), input, fn)
-}
-
-// ListResourceRecordSetsPagesWithContext same as ListResourceRecordSetsPages except
-// it takes a Context and allows setting request options on the pages.
+//	// Example iterating over at most 3 pages of a ListHostedZones operation.
+//	pageNum := 0
+//	err := client.ListHostedZonesPages(params,
+//	    func(page *route53.ListHostedZonesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *Route53) ListHostedZonesPages(input *ListHostedZonesInput, fn func(*ListHostedZonesOutput, bool) bool) error {
+	return c.ListHostedZonesPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListHostedZonesPagesWithContext same as ListHostedZonesPages except
+// it takes a Context and allows setting request options on the pages.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *Route53) ListResourceRecordSetsPagesWithContext(ctx aws.Context, input *ListResourceRecordSetsInput, fn func(*ListResourceRecordSetsOutput, bool) bool, opts ...request.Option) error {
+func (c *Route53) ListHostedZonesPagesWithContext(ctx aws.Context, input *ListHostedZonesInput, fn func(*ListHostedZonesOutput, bool) bool, opts ...request.Option) error {
 	p := request.Pagination{
 		NewRequest: func() (*request.Request, error) {
-			var inCpy *ListResourceRecordSetsInput
+			var inCpy *ListHostedZonesInput
 			if input != nil {
 				tmp := *input
 				inCpy = &tmp
 			}
-			req, _ := c.ListResourceRecordSetsRequest(inCpy)
+			req, _ := c.ListHostedZonesRequest(inCpy)
 			req.SetContext(ctx)
 			req.ApplyOptions(opts...)
 			return req, nil
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListResourceRecordSetsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListHostedZonesOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
-const opListReusableDelegationSets = "ListReusableDelegationSets"
+const opListHostedZonesByName = "ListHostedZonesByName"
 
-// ListReusableDelegationSetsRequest generates a "aws/request.Request" representing the
-// client's request for the ListReusableDelegationSets operation. The "output" return
+// ListHostedZonesByNameRequest generates a "aws/request.Request" representing the
+// client's request for the ListHostedZonesByName operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListReusableDelegationSets for more information on using the ListReusableDelegationSets
+// See ListHostedZonesByName for more information on using the ListHostedZonesByName
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListHostedZonesByNameRequest method.
+//	req, resp := client.ListHostedZonesByNameRequest(params)
 //
-//    // Example sending a request using the ListReusableDelegationSetsRequest method.
-//    req, resp := client.ListReusableDelegationSetsRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListReusableDelegationSets
-func (c *Route53) ListReusableDelegationSetsRequest(input *ListReusableDelegationSetsInput) (req *request.Request, output *ListReusableDelegationSetsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListHostedZonesByName
+func (c *Route53) ListHostedZonesByNameRequest(input *ListHostedZonesByNameInput) (req *request.Request, output *ListHostedZonesByNameOutput) {
 	op := &request.Operation{
-		Name:       opListReusableDelegationSets,
+		Name:       opListHostedZonesByName,
 		HTTPMethod: "GET",
-		HTTPPath:   "/2013-04-01/delegationset",
+		HTTPPath:   "/2013-04-01/hostedzonesbyname",
 	}
 
 	if input == nil {
-		input = &ListReusableDelegationSetsInput{}
+		input = &ListHostedZonesByNameInput{}
 	}
 
-	output = &ListReusableDelegationSetsOutput{}
+	output = &ListHostedZonesByNameOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListReusableDelegationSets API operation for Amazon Route 53.
+// ListHostedZonesByName API operation for Amazon Route 53.
 //
-// Retrieves a list of the reusable delegation sets that are associated with
-// the current AWS account.
+// Retrieves a list of your hosted zones in lexicographic order. The response
+// includes a HostedZones child element for each hosted zone created by the
+// current Amazon Web Services account.
+//
+// ListHostedZonesByName sorts hosted zones by name with the labels reversed.
+// For example:
+//
+// com.example.www.
+//
+// Note the trailing dot, which can change the sort order in some circumstances.
+//
+// If the domain name includes escape characters or Punycode, ListHostedZonesByName
+// alphabetizes the domain name using the escaped or Punycoded value, which
+// is the format that Amazon Route 53 saves in its database. For example, to
+// create a hosted zone for exämple.com, you specify ex\344mple.com for the
+// domain name. ListHostedZonesByName alphabetizes it as:
+//
+// com.ex\344mple.
+//
+// The labels are reversed and alphabetized using the escaped value. For more
+// information about valid domain name formats, including internationalized
+// domain names, see DNS Domain Name Format (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DomainNameFormat.html)
+// in the Amazon Route 53 Developer Guide.
+//
+// Route 53 returns up to 100 items in each response. If you have a lot of hosted
+// zones, use the MaxItems parameter to list them in groups of up to 100. The
+// response includes values that help navigate from one group of MaxItems hosted
+// zones to the next:
+//
+//   - The DNSName and HostedZoneId elements in the response contain the values,
+//     if any, specified for the dnsname and hostedzoneid parameters in the request
+//     that produced the current response.
+//
+//   - The MaxItems element in the response contains the value, if any, that
+//     you specified for the maxitems parameter in the request that produced
+//     the current response.
+//
+//   - If the value of IsTruncated in the response is true, there are more
+//     hosted zones associated with the current Amazon Web Services account.
+//     If IsTruncated is false, this response includes the last hosted zone that
+//     is associated with the current account. The NextDNSName element and NextHostedZoneId
+//     elements are omitted from the response.
+//
+//   - The NextDNSName and NextHostedZoneId elements in the response contain
+//     the domain name and the hosted zone ID of the next hosted zone that is
+//     associated with the current Amazon Web Services account. If you want to
+//     list more hosted zones, make another call to ListHostedZonesByName, and
+//     specify the value of NextDNSName and NextHostedZoneId in the dnsname and
+//     hostedzoneid parameters, respectively.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Route 53's
-// API operation ListReusableDelegationSets for usage and error information.
+// API operation ListHostedZonesByName for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListReusableDelegationSets
-func (c *Route53) ListReusableDelegationSets(input *ListReusableDelegationSetsInput) (*ListReusableDelegationSetsOutput, error) {
-	req, out := c.ListReusableDelegationSetsRequest(input)
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+//   - ErrCodeInvalidDomainName "InvalidDomainName"
+//     The specified domain name is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListHostedZonesByName
+func (c *Route53) ListHostedZonesByName(input *ListHostedZonesByNameInput) (*ListHostedZonesByNameOutput, error) {
+	req, out := c.ListHostedZonesByNameRequest(input)
 	return out, req.Send()
 }
 
-// ListReusableDelegationSetsWithContext is the same as ListReusableDelegationSets with the addition of
+// ListHostedZonesByNameWithContext is the same as ListHostedZonesByName with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListReusableDelegationSets for details on how to use this API operation.
+// See ListHostedZonesByName for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *Route53) ListReusableDelegationSetsWithContext(ctx aws.Context, input *ListReusableDelegationSetsInput, opts ...request.Option) (*ListReusableDelegationSetsOutput, error) {
-	req, out := c.ListReusableDelegationSetsRequest(input)
+func (c *Route53) ListHostedZonesByNameWithContext(ctx aws.Context, input *ListHostedZonesByNameInput, opts ...request.Option) (*ListHostedZonesByNameOutput, error) {
+	req, out := c.ListHostedZonesByNameRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListTagsForResource = "ListTagsForResource"
+const opListHostedZonesByVPC = "ListHostedZonesByVPC"
 
-// ListTagsForResourceRequest generates a "aws/request.Request" representing the
-// client's request for the ListTagsForResource operation. The "output" return
+// ListHostedZonesByVPCRequest generates a "aws/request.Request" representing the
+// client's request for the ListHostedZonesByVPC operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListTagsForResource for more information on using the ListTagsForResource
+// See ListHostedZonesByVPC for more information on using the ListHostedZonesByVPC
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListHostedZonesByVPCRequest method.
+//	req, resp := client.ListHostedZonesByVPCRequest(params)
 //
-//    // Example sending a request using the ListTagsForResourceRequest method.
-//    req, resp := client.ListTagsForResourceRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTagsForResource
-func (c *Route53) ListTagsForResourceRequest(input *ListTagsForResourceInput) (req *request.Request, output *ListTagsForResourceOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListHostedZonesByVPC
+func (c *Route53) ListHostedZonesByVPCRequest(input *ListHostedZonesByVPCInput) (req *request.Request, output *ListHostedZonesByVPCOutput) {
 	op := &request.Operation{
-		Name:       opListTagsForResource,
+		Name:       opListHostedZonesByVPC,
 		HTTPMethod: "GET",
-		HTTPPath:   "/2013-04-01/tags/{ResourceType}/{ResourceId}",
+		HTTPPath:   "/2013-04-01/hostedzonesbyvpc",
 	}
 
 	if input == nil {
-		input = &ListTagsForResourceInput{}
+		input = &ListHostedZonesByVPCInput{}
 	}
 
-	output = &ListTagsForResourceOutput{}
+	output = &ListHostedZonesByVPCOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListTagsForResource API operation for Amazon Route 53.
+// ListHostedZonesByVPC API operation for Amazon Route 53.
 //
-// Lists tags for one health check or hosted zone.
+// Lists all the private hosted zones that a specified VPC is associated with,
+// regardless of which Amazon Web Services account or Amazon Web Services service
+// owns the hosted zones. The HostedZoneOwner structure in the response contains
+// one of the following values:
 //
-// For information about using tags for cost allocation, see Using Cost Allocation
-// Tags (https://docs.aws.amazon.com/awsaccountbilling/latest/aboutv2/cost-alloc-tags.html)
-// in the AWS Billing and Cost Management User Guide.
+//   - An OwningAccount element, which contains the account number of either
+//     the current Amazon Web Services account or another Amazon Web Services
+//     account. Some services, such as Cloud Map, create hosted zones using the
+//     current account.
+//
+//   - An OwningService element, which identifies the Amazon Web Services service
+//     that created and owns the hosted zone. For example, if a hosted zone was
+//     created by Amazon Elastic File System (Amazon EFS), the value of Owner
+//     is efs.amazonaws.com.
+//
+// When listing private hosted zones, the hosted zone and the Amazon VPC must
+// belong to the same partition where the hosted zones were created. A partition
+// is a group of Amazon Web Services Regions. Each Amazon Web Services account
+// is scoped to one partition.
+//
+// The following are the supported partitions:
+//
+//   - aws - Amazon Web Services Regions
+//
+//   - aws-cn - China Regions
+//
+//   - aws-us-gov - Amazon Web Services GovCloud (US) Region
+//
+// For more information, see Access Management (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
+// in the Amazon Web Services General Reference.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Route 53's
-// API operation ListTagsForResource for usage and error information.
+// API operation ListHostedZonesByVPC for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
-//
-//   * ErrCodeNoSuchHealthCheck "NoSuchHealthCheck"
-//   No health check exists with the specified ID.
 //
-//   * ErrCodeNoSuchHostedZone "NoSuchHostedZone"
-//   No hosted zone exists with the ID that you specified.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-//   * ErrCodePriorRequestNotComplete "PriorRequestNotComplete"
-//   If Amazon Route 53 can't process a request before the next request arrives,
-//   it will reject subsequent requests for the same hosted zone and return an
-//   HTTP 400 error (Bad request). If Route 53 returns this error repeatedly for
-//   the same request, we recommend that you wait, in intervals of increasing
-//   duration, before you try the request again.
+//   - ErrCodeInvalidPaginationToken "InvalidPaginationToken"
+//     The value that you specified to get the second or subsequent page of results
+//     is invalid.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The limit on the number of requests per second was exceeded.
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTagsForResource
-func (c *Route53) ListTagsForResource(input *ListTagsForResourceInput) (*ListTagsForResourceOutput, error) {
-	req, out := c.ListTagsForResourceRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListHostedZonesByVPC
+func (c *Route53) ListHostedZonesByVPC(input *ListHostedZonesByVPCInput) (*ListHostedZonesByVPCOutput, error) {
+	req, out := c.ListHostedZonesByVPCRequest(input)
 	return out, req.Send()
 }
 
-// ListTagsForResourceWithContext is the same as ListTagsForResource with the addition of
+// ListHostedZonesByVPCWithContext is the same as ListHostedZonesByVPC with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListTagsForResource for details on how to use this API operation.
+// See ListHostedZonesByVPC for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *Route53) ListTagsForResourceWithContext(ctx aws.Context, input *ListTagsForResourceInput, opts ...request.Option) (*ListTagsForResourceOutput, error) {
-	req, out := c.ListTagsForResourceRequest(input)
+func (c *Route53) ListHostedZonesByVPCWithContext(ctx aws.Context, input *ListHostedZonesByVPCInput, opts ...request.Option) (*ListHostedZonesByVPCOutput, error) {
+	req, out := c.ListHostedZonesByVPCRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListTagsForResources = "ListTagsForResources"
+const opListQueryLoggingConfigs = "ListQueryLoggingConfigs"
 
-// ListTagsForResourcesRequest generates a "aws/request.Request" representing the
-// client's request for the ListTagsForResources operation. The "output" return
+// ListQueryLoggingConfigsRequest generates a "aws/request.Request" representing the
+// client's request for the ListQueryLoggingConfigs operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListTagsForResources for more information on using the ListTagsForResources
+// See ListQueryLoggingConfigs for more information on using the ListQueryLoggingConfigs
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListQueryLoggingConfigsRequest method.
+//	req, resp := client.ListQueryLoggingConfigsRequest(params)
 //
-//    // Example sending a request using the ListTagsForResourcesRequest method.
-//    req, resp := client.ListTagsForResourcesRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTagsForResources
-func (c *Route53) ListTagsForResourcesRequest(input *ListTagsForResourcesInput) (req *request.Request, output *ListTagsForResourcesOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListQueryLoggingConfigs
+func (c *Route53) ListQueryLoggingConfigsRequest(input *ListQueryLoggingConfigsInput) (req *request.Request, output *ListQueryLoggingConfigsOutput) {
 	op := &request.Operation{
-		Name:       opListTagsForResources,
-		HTTPMethod: "POST",
-		HTTPPath:   "/2013-04-01/tags/{ResourceType}",
+		Name:       opListQueryLoggingConfigs,
+		HTTPMethod: "GET",
+		HTTPPath:   "/2013-04-01/queryloggingconfig",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "MaxResults",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &ListTagsForResourcesInput{}
+		input = &ListQueryLoggingConfigsInput{}
 	}
 
-	output = &ListTagsForResourcesOutput{}
+	output = &ListQueryLoggingConfigsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListTagsForResources API operation for Amazon Route 53.
+// ListQueryLoggingConfigs API operation for Amazon Route 53.
 //
-// Lists tags for up to 10 health checks or hosted zones.
+// Lists the configurations for DNS query logging that are associated with the
+// current Amazon Web Services account or the configuration that is associated
+// with a specified hosted zone.
 //
-// For information about using tags for cost allocation, see Using Cost Allocation
-// Tags (https://docs.aws.amazon.com/awsaccountbilling/latest/aboutv2/cost-alloc-tags.html)
-// in the AWS Billing and Cost Management User Guide.
+// For more information about DNS query logs, see CreateQueryLoggingConfig (https://docs.aws.amazon.com/Route53/latest/APIReference/API_CreateQueryLoggingConfig.html).
+// Additional information, including the format of DNS query logs, appears in
+// Logging DNS Queries (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/query-logs.html)
+// in the Amazon Route 53 Developer Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Route 53's
-// API operation ListTagsForResources for usage and error information.
+// API operation ListQueryLoggingConfigs for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
-//
-//   * ErrCodeNoSuchHealthCheck "NoSuchHealthCheck"
-//   No health check exists with the specified ID.
 //
-//   * ErrCodeNoSuchHostedZone "NoSuchHostedZone"
-//   No hosted zone exists with the ID that you specified.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-//   * ErrCodePriorRequestNotComplete "PriorRequestNotComplete"
-//   If Amazon Route 53 can't process a request before the next request arrives,
-//   it will reject subsequent requests for the same hosted zone and return an
-//   HTTP 400 error (Bad request). If Route 53 returns this error repeatedly for
-//   the same request, we recommend that you wait, in intervals of increasing
-//   duration, before you try the request again.
+//   - ErrCodeInvalidPaginationToken "InvalidPaginationToken"
+//     The value that you specified to get the second or subsequent page of results
+//     is invalid.
 //
-//   * ErrCodeThrottlingException "ThrottlingException"
-//   The limit on the number of requests per second was exceeded.
+//   - ErrCodeNoSuchHostedZone "NoSuchHostedZone"
+//     No hosted zone exists with the ID that you specified.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTagsForResources
-func (c *Route53) ListTagsForResources(input *ListTagsForResourcesInput) (*ListTagsForResourcesOutput, error) {
-	req, out := c.ListTagsForResourcesRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListQueryLoggingConfigs
+func (c *Route53) ListQueryLoggingConfigs(input *ListQueryLoggingConfigsInput) (*ListQueryLoggingConfigsOutput, error) {
+	req, out := c.ListQueryLoggingConfigsRequest(input)
 	return out, req.Send()
 }
 
-// ListTagsForResourcesWithContext is the same as ListTagsForResources with the addition of
+// ListQueryLoggingConfigsWithContext is the same as ListQueryLoggingConfigs with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListTagsForResources for details on how to use this API operation.
+// See ListQueryLoggingConfigs for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *Route53) ListTagsForResourcesWithContext(ctx aws.Context, input *ListTagsForResourcesInput, opts ...request.Option) (*ListTagsForResourcesOutput, error) {
-	req, out := c.ListTagsForResourcesRequest(input)
+func (c *Route53) ListQueryLoggingConfigsWithContext(ctx aws.Context, input *ListQueryLoggingConfigsInput, opts ...request.Option) (*ListQueryLoggingConfigsOutput, error) {
+	req, out := c.ListQueryLoggingConfigsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListTrafficPolicies = "ListTrafficPolicies"
-
-// ListTrafficPoliciesRequest generates a "aws/request.Request" representing the
-// client's request for the ListTrafficPolicies operation. The "output" return
-// value will be populated with the request's response once the request completes
-// successfully.
-//
-// Use "Send" method on the returned Request to send the API call to the service.
-// the "output" return value is not valid until after Send returns without error.
-//
-// See ListTrafficPolicies for more information on using the ListTrafficPolicies
-// API call, and error handling.
-//
-// This method is useful when you want to inject custom logic or configuration
-// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+// ListQueryLoggingConfigsPages iterates over the pages of a ListQueryLoggingConfigs operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
 //
+// See ListQueryLoggingConfigs method for more information on how to use this operation.
 //
-//    // Example sending a request using the ListTrafficPoliciesRequest method.
-//    req, resp := client.ListTrafficPoliciesRequest(params)
+// Note: This operation can generate multiple requests to a service.
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	// Example iterating over at most 3 pages of a ListQueryLoggingConfigs operation.
+//	pageNum := 0
+//	err := client.ListQueryLoggingConfigsPages(params,
+//	    func(page *route53.ListQueryLoggingConfigsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *Route53) ListQueryLoggingConfigsPages(input *ListQueryLoggingConfigsInput, fn func(*ListQueryLoggingConfigsOutput, bool) bool) error {
+	return c.ListQueryLoggingConfigsPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListQueryLoggingConfigsPagesWithContext same as ListQueryLoggingConfigsPages except
+// it takes a Context and allows setting request options on the pages.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTrafficPolicies
-func (c *Route53) ListTrafficPoliciesRequest(input *ListTrafficPoliciesInput) (req *request.Request, output *ListTrafficPoliciesOutput) {
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Route53) ListQueryLoggingConfigsPagesWithContext(ctx aws.Context, input *ListQueryLoggingConfigsInput, fn func(*ListQueryLoggingConfigsOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListQueryLoggingConfigsInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListQueryLoggingConfigsRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListQueryLoggingConfigsOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListResourceRecordSets = "ListResourceRecordSets"
+
+// ListResourceRecordSetsRequest generates a "aws/request.Request" representing the
+// client's request for the ListResourceRecordSets operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListResourceRecordSets for more information on using the ListResourceRecordSets
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListResourceRecordSetsRequest method.
+//	req, resp := client.ListResourceRecordSetsRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListResourceRecordSets
+func (c *Route53) ListResourceRecordSetsRequest(input *ListResourceRecordSetsInput) (req *request.Request, output *ListResourceRecordSetsOutput) {
 	op := &request.Operation{
-		Name:       opListTrafficPolicies,
+		Name:       opListResourceRecordSets,
 		HTTPMethod: "GET",
-		HTTPPath:   "/2013-04-01/trafficpolicies",
+		HTTPPath:   "/2013-04-01/hostedzone/{Id}/rrset",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"StartRecordName", "StartRecordType", "StartRecordIdentifier"},
+			OutputTokens:    []string{"NextRecordName", "NextRecordType", "NextRecordIdentifier"},
+			LimitToken:      "MaxItems",
+			TruncationToken: "IsTruncated",
+		},
 	}
 
 	if input == nil {
-		input = &ListTrafficPoliciesInput{}
+		input = &ListResourceRecordSetsInput{}
 	}
 
-	output = &ListTrafficPoliciesOutput{}
+	output = &ListResourceRecordSetsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListTrafficPolicies API operation for Amazon Route 53.
+// ListResourceRecordSets API operation for Amazon Route 53.
 //
-// Gets information about the latest version for every traffic policy that is
-// associated with the current AWS account. Policies are listed in the order
-// that they were created in.
+// Lists the resource record sets in a specified hosted zone.
+//
+// ListResourceRecordSets returns up to 300 resource record sets at a time in
+// ASCII order, beginning at a position specified by the name and type elements.
+//
+// # Sort order
+//
+// ListResourceRecordSets sorts results first by DNS name with the labels reversed,
+// for example:
+//
+// com.example.www.
+//
+// Note the trailing dot, which can change the sort order when the record name
+// contains characters that appear before . (decimal 46) in the ASCII table.
+// These characters include the following: ! " # $ % & ' ( ) * + , -
+//
+// When multiple records have the same DNS name, ListResourceRecordSets sorts
+// results by the record type.
+//
+// # Specifying where to start listing records
+//
+// You can use the name and type elements to specify the resource record set
+// that the list begins with:
+//
+// # If you do not specify Name or Type
+//
+// The results begin with the first resource record set that the hosted zone
+// contains.
+//
+// # If you specify Name but not Type
+//
+// The results begin with the first resource record set in the list whose name
+// is greater than or equal to Name.
+//
+// # If you specify Type but not Name
+//
+// Amazon Route 53 returns the InvalidInput error.
+//
+// # If you specify both Name and Type
+//
+// The results begin with the first resource record set in the list whose name
+// is greater than or equal to Name, and whose type is greater than or equal
+// to Type.
+//
+// # Resource record sets that are PENDING
+//
+// This action returns the most current version of the records. This includes
+// records that are PENDING, and that are not yet available on all Route 53
+// DNS servers.
+//
+// # Changing resource record sets
+//
+// To ensure that you get an accurate listing of the resource record sets for
+// a hosted zone at a point in time, do not submit a ChangeResourceRecordSets
+// request while you're paging through the results of a ListResourceRecordSets
+// request. If you do, some pages may display results without the latest changes
+// while other pages display results with the latest changes.
+//
+// # Displaying the next page of results
+//
+// If a ListResourceRecordSets command returns more than one page of results,
+// the value of IsTruncated is true. To display the next page of results, get
+// the values of NextRecordName, NextRecordType, and NextRecordIdentifier (if
+// any) from the response. Then submit another ListResourceRecordSets request,
+// and specify those values for StartRecordName, StartRecordType, and StartRecordIdentifier.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Route 53's
-// API operation ListTrafficPolicies for usage and error information.
+// API operation ListResourceRecordSets for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTrafficPolicies
-func (c *Route53) ListTrafficPolicies(input *ListTrafficPoliciesInput) (*ListTrafficPoliciesOutput, error) {
-	req, out := c.ListTrafficPoliciesRequest(input)
+//   - ErrCodeNoSuchHostedZone "NoSuchHostedZone"
+//     No hosted zone exists with the ID that you specified.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListResourceRecordSets
+func (c *Route53) ListResourceRecordSets(input *ListResourceRecordSetsInput) (*ListResourceRecordSetsOutput, error) {
+	req, out := c.ListResourceRecordSetsRequest(input)
 	return out, req.Send()
 }
 
-// ListTrafficPoliciesWithContext is the same as ListTrafficPolicies with the addition of
+// ListResourceRecordSetsWithContext is the same as ListResourceRecordSets with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListTrafficPolicies for details on how to use this API operation.
+// See ListResourceRecordSets for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *Route53) ListTrafficPoliciesWithContext(ctx aws.Context, input *ListTrafficPoliciesInput, opts ...request.Option) (*ListTrafficPoliciesOutput, error) {
-	req, out := c.ListTrafficPoliciesRequest(input)
+func (c *Route53) ListResourceRecordSetsWithContext(ctx aws.Context, input *ListResourceRecordSetsInput, opts ...request.Option) (*ListResourceRecordSetsOutput, error) {
+	req, out := c.ListResourceRecordSetsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListTrafficPolicyInstances = "ListTrafficPolicyInstances"
+// ListResourceRecordSetsPages iterates over the pages of a ListResourceRecordSets operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListResourceRecordSets method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListResourceRecordSets operation.
+//	pageNum := 0
+//	err := client.ListResourceRecordSetsPages(params,
+//	    func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *Route53) ListResourceRecordSetsPages(input *ListResourceRecordSetsInput, fn func(*ListResourceRecordSetsOutput, bool) bool) error {
+	return c.ListResourceRecordSetsPagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-// ListTrafficPolicyInstancesRequest generates a "aws/request.Request" representing the
-// client's request for the ListTrafficPolicyInstances operation. The "output" return
+// ListResourceRecordSetsPagesWithContext same as ListResourceRecordSetsPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Route53) ListResourceRecordSetsPagesWithContext(ctx aws.Context, input *ListResourceRecordSetsInput, fn func(*ListResourceRecordSetsOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListResourceRecordSetsInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListResourceRecordSetsRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListResourceRecordSetsOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListReusableDelegationSets = "ListReusableDelegationSets"
+
+// ListReusableDelegationSetsRequest generates a "aws/request.Request" representing the
+// client's request for the ListReusableDelegationSets operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListTrafficPolicyInstances for more information on using the ListTrafficPolicyInstances
+// See ListReusableDelegationSets for more information on using the ListReusableDelegationSets
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListReusableDelegationSetsRequest method.
+//	req, resp := client.ListReusableDelegationSetsRequest(params)
 //
-//    // Example sending a request using the ListTrafficPolicyInstancesRequest method.
-//    req, resp := client.ListTrafficPolicyInstancesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTrafficPolicyInstances
-func (c *Route53) ListTrafficPolicyInstancesRequest(input *ListTrafficPolicyInstancesInput) (req *request.Request, output *ListTrafficPolicyInstancesOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListReusableDelegationSets
+func (c *Route53) ListReusableDelegationSetsRequest(input *ListReusableDelegationSetsInput) (req *request.Request, output *ListReusableDelegationSetsOutput) {
 	op := &request.Operation{
-		Name:       opListTrafficPolicyInstances,
+		Name:       opListReusableDelegationSets,
 		HTTPMethod: "GET",
-		HTTPPath:   "/2013-04-01/trafficpolicyinstances",
+		HTTPPath:   "/2013-04-01/delegationset",
 	}
 
 	if input == nil {
-		input = &ListTrafficPolicyInstancesInput{}
+		input = &ListReusableDelegationSetsInput{}
 	}
 
-	output = &ListTrafficPolicyInstancesOutput{}
+	output = &ListReusableDelegationSetsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListTrafficPolicyInstances API operation for Amazon Route 53.
-//
-// Gets information about the traffic policy instances that you created by using
-// the current AWS account.
-//
-// After you submit an UpdateTrafficPolicyInstance request, there's a brief
-// delay while Amazon Route 53 creates the resource record sets that are specified
-// in the traffic policy definition. For more information, see the State response
-// element.
+// ListReusableDelegationSets API operation for Amazon Route 53.
 //
-// Route 53 returns a maximum of 100 items in each response. If you have a lot
-// of traffic policy instances, you can use the MaxItems parameter to list them
-// in groups of up to 100.
+// Retrieves a list of the reusable delegation sets that are associated with
+// the current Amazon Web Services account.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Route 53's
-// API operation ListTrafficPolicyInstances for usage and error information.
+// API operation ListReusableDelegationSets for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
-//
-//   * ErrCodeNoSuchTrafficPolicyInstance "NoSuchTrafficPolicyInstance"
-//   No traffic policy instance exists with the specified ID.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTrafficPolicyInstances
-func (c *Route53) ListTrafficPolicyInstances(input *ListTrafficPolicyInstancesInput) (*ListTrafficPolicyInstancesOutput, error) {
-	req, out := c.ListTrafficPolicyInstancesRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListReusableDelegationSets
+func (c *Route53) ListReusableDelegationSets(input *ListReusableDelegationSetsInput) (*ListReusableDelegationSetsOutput, error) {
+	req, out := c.ListReusableDelegationSetsRequest(input)
 	return out, req.Send()
 }
 
-// ListTrafficPolicyInstancesWithContext is the same as ListTrafficPolicyInstances with the addition of
+// ListReusableDelegationSetsWithContext is the same as ListReusableDelegationSets with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListTrafficPolicyInstances for details on how to use this API operation.
+// See ListReusableDelegationSets for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *Route53) ListTrafficPolicyInstancesWithContext(ctx aws.Context, input *ListTrafficPolicyInstancesInput, opts ...request.Option) (*ListTrafficPolicyInstancesOutput, error) {
-	req, out := c.ListTrafficPolicyInstancesRequest(input)
+func (c *Route53) ListReusableDelegationSetsWithContext(ctx aws.Context, input *ListReusableDelegationSetsInput, opts ...request.Option) (*ListReusableDelegationSetsOutput, error) {
+	req, out := c.ListReusableDelegationSetsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListTrafficPolicyInstancesByHostedZone = "ListTrafficPolicyInstancesByHostedZone"
+const opListTagsForResource = "ListTagsForResource"
 
-// ListTrafficPolicyInstancesByHostedZoneRequest generates a "aws/request.Request" representing the
-// client's request for the ListTrafficPolicyInstancesByHostedZone operation. The "output" return
+// ListTagsForResourceRequest generates a "aws/request.Request" representing the
+// client's request for the ListTagsForResource operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListTrafficPolicyInstancesByHostedZone for more information on using the ListTrafficPolicyInstancesByHostedZone
+// See ListTagsForResource for more information on using the ListTagsForResource
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTagsForResourceRequest method.
+//	req, resp := client.ListTagsForResourceRequest(params)
 //
-//    // Example sending a request using the ListTrafficPolicyInstancesByHostedZoneRequest method.
-//    req, resp := client.ListTrafficPolicyInstancesByHostedZoneRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTrafficPolicyInstancesByHostedZone
-func (c *Route53) ListTrafficPolicyInstancesByHostedZoneRequest(input *ListTrafficPolicyInstancesByHostedZoneInput) (req *request.Request, output *ListTrafficPolicyInstancesByHostedZoneOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTagsForResource
+func (c *Route53) ListTagsForResourceRequest(input *ListTagsForResourceInput) (req *request.Request, output *ListTagsForResourceOutput) {
 	op := &request.Operation{
-		Name:       opListTrafficPolicyInstancesByHostedZone,
+		Name:       opListTagsForResource,
 		HTTPMethod: "GET",
-		HTTPPath:   "/2013-04-01/trafficpolicyinstances/hostedzone",
+		HTTPPath:   "/2013-04-01/tags/{ResourceType}/{ResourceId}",
 	}
 
 	if input == nil {
-		input = &ListTrafficPolicyInstancesByHostedZoneInput{}
+		input = &ListTagsForResourceInput{}
 	}
 
-	output = &ListTrafficPolicyInstancesByHostedZoneOutput{}
+	output = &ListTagsForResourceOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListTrafficPolicyInstancesByHostedZone API operation for Amazon Route 53.
+// ListTagsForResource API operation for Amazon Route 53.
 //
-// Gets information about the traffic policy instances that you created in a
-// specified hosted zone.
+// Lists tags for one health check or hosted zone.
 //
-// After you submit a CreateTrafficPolicyInstance or an UpdateTrafficPolicyInstance
-// request, there's a brief delay while Amazon Route 53 creates the resource
-// record sets that are specified in the traffic policy definition. For more
-// information, see the State response element.
-//
-// Route 53 returns a maximum of 100 items in each response. If you have a lot
-// of traffic policy instances, you can use the MaxItems parameter to list them
-// in groups of up to 100.
+// For information about using tags for cost allocation, see Using Cost Allocation
+// Tags (https://docs.aws.amazon.com/awsaccountbilling/latest/aboutv2/cost-alloc-tags.html)
+// in the Billing and Cost Management User Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Route 53's
-// API operation ListTrafficPolicyInstancesByHostedZone for usage and error information.
+// API operation ListTagsForResource for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
 //
-//   * ErrCodeNoSuchTrafficPolicyInstance "NoSuchTrafficPolicyInstance"
-//   No traffic policy instance exists with the specified ID.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-//   * ErrCodeNoSuchHostedZone "NoSuchHostedZone"
-//   No hosted zone exists with the ID that you specified.
+//   - ErrCodeNoSuchHealthCheck "NoSuchHealthCheck"
+//     No health check exists with the specified ID.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTrafficPolicyInstancesByHostedZone
-func (c *Route53) ListTrafficPolicyInstancesByHostedZone(input *ListTrafficPolicyInstancesByHostedZoneInput) (*ListTrafficPolicyInstancesByHostedZoneOutput, error) {
-	req, out := c.ListTrafficPolicyInstancesByHostedZoneRequest(input)
+//   - ErrCodeNoSuchHostedZone "NoSuchHostedZone"
+//     No hosted zone exists with the ID that you specified.
+//
+//   - ErrCodePriorRequestNotComplete "PriorRequestNotComplete"
+//     If Amazon Route 53 can't process a request before the next request arrives,
+//     it will reject subsequent requests for the same hosted zone and return an
+//     HTTP 400 error (Bad request). If Route 53 returns this error repeatedly for
+//     the same request, we recommend that you wait, in intervals of increasing
+//     duration, before you try the request again.
+//
+//   - ErrCodeThrottlingException "ThrottlingException"
+//     The limit on the number of requests per second was exceeded.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTagsForResource
+func (c *Route53) ListTagsForResource(input *ListTagsForResourceInput) (*ListTagsForResourceOutput, error) {
+	req, out := c.ListTagsForResourceRequest(input)
 	return out, req.Send()
 }
 
-// ListTrafficPolicyInstancesByHostedZoneWithContext is the same as ListTrafficPolicyInstancesByHostedZone with the addition of
+// ListTagsForResourceWithContext is the same as ListTagsForResource with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListTrafficPolicyInstancesByHostedZone for details on how to use this API operation.
+// See ListTagsForResource for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *Route53) ListTrafficPolicyInstancesByHostedZoneWithContext(ctx aws.Context, input *ListTrafficPolicyInstancesByHostedZoneInput, opts ...request.Option) (*ListTrafficPolicyInstancesByHostedZoneOutput, error) {
-	req, out := c.ListTrafficPolicyInstancesByHostedZoneRequest(input)
+func (c *Route53) ListTagsForResourceWithContext(ctx aws.Context, input *ListTagsForResourceInput, opts ...request.Option) (*ListTagsForResourceOutput, error) {
+	req, out := c.ListTagsForResourceRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListTrafficPolicyInstancesByPolicy = "ListTrafficPolicyInstancesByPolicy"
+const opListTagsForResources = "ListTagsForResources"
 
-// ListTrafficPolicyInstancesByPolicyRequest generates a "aws/request.Request" representing the
-// client's request for the ListTrafficPolicyInstancesByPolicy operation. The "output" return
+// ListTagsForResourcesRequest generates a "aws/request.Request" representing the
+// client's request for the ListTagsForResources operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListTrafficPolicyInstancesByPolicy for more information on using the ListTrafficPolicyInstancesByPolicy
+// See ListTagsForResources for more information on using the ListTagsForResources
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTagsForResourcesRequest method.
+//	req, resp := client.ListTagsForResourcesRequest(params)
 //
-//    // Example sending a request using the ListTrafficPolicyInstancesByPolicyRequest method.
-//    req, resp := client.ListTrafficPolicyInstancesByPolicyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTrafficPolicyInstancesByPolicy
-func (c *Route53) ListTrafficPolicyInstancesByPolicyRequest(input *ListTrafficPolicyInstancesByPolicyInput) (req *request.Request, output *ListTrafficPolicyInstancesByPolicyOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTagsForResources
+func (c *Route53) ListTagsForResourcesRequest(input *ListTagsForResourcesInput) (req *request.Request, output *ListTagsForResourcesOutput) {
 	op := &request.Operation{
-		Name:       opListTrafficPolicyInstancesByPolicy,
-		HTTPMethod: "GET",
-		HTTPPath:   "/2013-04-01/trafficpolicyinstances/trafficpolicy",
+		Name:       opListTagsForResources,
+		HTTPMethod: "POST",
+		HTTPPath:   "/2013-04-01/tags/{ResourceType}",
 	}
 
 	if input == nil {
-		input = &ListTrafficPolicyInstancesByPolicyInput{}
+		input = &ListTagsForResourcesInput{}
 	}
 
-	output = &ListTrafficPolicyInstancesByPolicyOutput{}
+	output = &ListTagsForResourcesOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListTrafficPolicyInstancesByPolicy API operation for Amazon Route 53.
-//
-// Gets information about the traffic policy instances that you created by using
-// a specify traffic policy version.
+// ListTagsForResources API operation for Amazon Route 53.
 //
-// After you submit a CreateTrafficPolicyInstance or an UpdateTrafficPolicyInstance
-// request, there's a brief delay while Amazon Route 53 creates the resource
-// record sets that are specified in the traffic policy definition. For more
-// information, see the State response element.
+// Lists tags for up to 10 health checks or hosted zones.
 //
-// Route 53 returns a maximum of 100 items in each response. If you have a lot
-// of traffic policy instances, you can use the MaxItems parameter to list them
-// in groups of up to 100.
+// For information about using tags for cost allocation, see Using Cost Allocation
+// Tags (https://docs.aws.amazon.com/awsaccountbilling/latest/aboutv2/cost-alloc-tags.html)
+// in the Billing and Cost Management User Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Route 53's
-// API operation ListTrafficPolicyInstancesByPolicy for usage and error information.
+// API operation ListTagsForResources for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
 //
-//   * ErrCodeNoSuchTrafficPolicyInstance "NoSuchTrafficPolicyInstance"
-//   No traffic policy instance exists with the specified ID.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-//   * ErrCodeNoSuchTrafficPolicy "NoSuchTrafficPolicy"
-//   No traffic policy exists with the specified ID.
+//   - ErrCodeNoSuchHealthCheck "NoSuchHealthCheck"
+//     No health check exists with the specified ID.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTrafficPolicyInstancesByPolicy
-func (c *Route53) ListTrafficPolicyInstancesByPolicy(input *ListTrafficPolicyInstancesByPolicyInput) (*ListTrafficPolicyInstancesByPolicyOutput, error) {
-	req, out := c.ListTrafficPolicyInstancesByPolicyRequest(input)
+//   - ErrCodeNoSuchHostedZone "NoSuchHostedZone"
+//     No hosted zone exists with the ID that you specified.
+//
+//   - ErrCodePriorRequestNotComplete "PriorRequestNotComplete"
+//     If Amazon Route 53 can't process a request before the next request arrives,
+//     it will reject subsequent requests for the same hosted zone and return an
+//     HTTP 400 error (Bad request). If Route 53 returns this error repeatedly for
+//     the same request, we recommend that you wait, in intervals of increasing
+//     duration, before you try the request again.
+//
+//   - ErrCodeThrottlingException "ThrottlingException"
+//     The limit on the number of requests per second was exceeded.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTagsForResources
+func (c *Route53) ListTagsForResources(input *ListTagsForResourcesInput) (*ListTagsForResourcesOutput, error) {
+	req, out := c.ListTagsForResourcesRequest(input)
 	return out, req.Send()
 }
 
-// ListTrafficPolicyInstancesByPolicyWithContext is the same as ListTrafficPolicyInstancesByPolicy with the addition of
+// ListTagsForResourcesWithContext is the same as ListTagsForResources with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListTrafficPolicyInstancesByPolicy for details on how to use this API operation.
+// See ListTagsForResources for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *Route53) ListTrafficPolicyInstancesByPolicyWithContext(ctx aws.Context, input *ListTrafficPolicyInstancesByPolicyInput, opts ...request.Option) (*ListTrafficPolicyInstancesByPolicyOutput, error) {
-	req, out := c.ListTrafficPolicyInstancesByPolicyRequest(input)
+func (c *Route53) ListTagsForResourcesWithContext(ctx aws.Context, input *ListTagsForResourcesInput, opts ...request.Option) (*ListTagsForResourcesOutput, error) {
+	req, out := c.ListTagsForResourcesRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListTrafficPolicyVersions = "ListTrafficPolicyVersions"
+const opListTrafficPolicies = "ListTrafficPolicies"
 
-// ListTrafficPolicyVersionsRequest generates a "aws/request.Request" representing the
-// client's request for the ListTrafficPolicyVersions operation. The "output" return
+// ListTrafficPoliciesRequest generates a "aws/request.Request" representing the
+// client's request for the ListTrafficPolicies operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListTrafficPolicyVersions for more information on using the ListTrafficPolicyVersions
+// See ListTrafficPolicies for more information on using the ListTrafficPolicies
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTrafficPoliciesRequest method.
+//	req, resp := client.ListTrafficPoliciesRequest(params)
 //
-//    // Example sending a request using the ListTrafficPolicyVersionsRequest method.
-//    req, resp := client.ListTrafficPolicyVersionsRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTrafficPolicyVersions
-func (c *Route53) ListTrafficPolicyVersionsRequest(input *ListTrafficPolicyVersionsInput) (req *request.Request, output *ListTrafficPolicyVersionsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTrafficPolicies
+func (c *Route53) ListTrafficPoliciesRequest(input *ListTrafficPoliciesInput) (req *request.Request, output *ListTrafficPoliciesOutput) {
 	op := &request.Operation{
-		Name:       opListTrafficPolicyVersions,
+		Name:       opListTrafficPolicies,
 		HTTPMethod: "GET",
-		HTTPPath:   "/2013-04-01/trafficpolicies/{Id}/versions",
+		HTTPPath:   "/2013-04-01/trafficpolicies",
 	}
 
 	if input == nil {
-		input = &ListTrafficPolicyVersionsInput{}
+		input = &ListTrafficPoliciesInput{}
 	}
 
-	output = &ListTrafficPolicyVersionsOutput{}
+	output = &ListTrafficPoliciesOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListTrafficPolicyVersions API operation for Amazon Route 53.
+// ListTrafficPolicies API operation for Amazon Route 53.
 //
-// Gets information about all of the versions for a specified traffic policy.
+// Gets information about the latest version for every traffic policy that is
+// associated with the current Amazon Web Services account. Policies are listed
+// in the order that they were created in.
 //
-// Traffic policy versions are listed in numerical order by VersionNumber.
+// For information about how of deleting a traffic policy affects the response
+// from ListTrafficPolicies, see DeleteTrafficPolicy (https://docs.aws.amazon.com/Route53/latest/APIReference/API_DeleteTrafficPolicy.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Route 53's
-// API operation ListTrafficPolicyVersions for usage and error information.
+// API operation ListTrafficPolicies for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-//   * ErrCodeNoSuchTrafficPolicy "NoSuchTrafficPolicy"
-//   No traffic policy exists with the specified ID.
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTrafficPolicyVersions
-func (c *Route53) ListTrafficPolicyVersions(input *ListTrafficPolicyVersionsInput) (*ListTrafficPolicyVersionsOutput, error) {
-	req, out := c.ListTrafficPolicyVersionsRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTrafficPolicies
+func (c *Route53) ListTrafficPolicies(input *ListTrafficPoliciesInput) (*ListTrafficPoliciesOutput, error) {
+	req, out := c.ListTrafficPoliciesRequest(input)
 	return out, req.Send()
 }
 
-// ListTrafficPolicyVersionsWithContext is the same as ListTrafficPolicyVersions with the addition of
+// ListTrafficPoliciesWithContext is the same as ListTrafficPolicies with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListTrafficPolicyVersions for details on how to use this API operation.
+// See ListTrafficPolicies for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *Route53) ListTrafficPolicyVersionsWithContext(ctx aws.Context, input *ListTrafficPolicyVersionsInput, opts ...request.Option) (*ListTrafficPolicyVersionsOutput, error) {
-	req, out := c.ListTrafficPolicyVersionsRequest(input)
+func (c *Route53) ListTrafficPoliciesWithContext(ctx aws.Context, input *ListTrafficPoliciesInput, opts ...request.Option) (*ListTrafficPoliciesOutput, error) {
+	req, out := c.ListTrafficPoliciesRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opListVPCAssociationAuthorizations = "ListVPCAssociationAuthorizations"
+const opListTrafficPolicyInstances = "ListTrafficPolicyInstances"
 
-// ListVPCAssociationAuthorizationsRequest generates a "aws/request.Request" representing the
-// client's request for the ListVPCAssociationAuthorizations operation. The "output" return
+// ListTrafficPolicyInstancesRequest generates a "aws/request.Request" representing the
+// client's request for the ListTrafficPolicyInstances operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListVPCAssociationAuthorizations for more information on using the ListVPCAssociationAuthorizations
+// See ListTrafficPolicyInstances for more information on using the ListTrafficPolicyInstances
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTrafficPolicyInstancesRequest method.
+//	req, resp := client.ListTrafficPolicyInstancesRequest(params)
 //
-//    // Example sending a request using the ListVPCAssociationAuthorizationsRequest method.
-//    req, resp := client.ListVPCAssociationAuthorizationsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListVPCAssociationAuthorizations
-func (c *Route53) ListVPCAssociationAuthorizationsRequest(input *ListVPCAssociationAuthorizationsInput) (req *request.Request, output *ListVPCAssociationAuthorizationsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTrafficPolicyInstances
+func (c *Route53) ListTrafficPolicyInstancesRequest(input *ListTrafficPolicyInstancesInput) (req *request.Request, output *ListTrafficPolicyInstancesOutput) {
 	op := &request.Operation{
-		Name:       opListVPCAssociationAuthorizations,
+		Name:       opListTrafficPolicyInstances,
 		HTTPMethod: "GET",
-		HTTPPath:   "/2013-04-01/hostedzone/{Id}/authorizevpcassociation",
+		HTTPPath:   "/2013-04-01/trafficpolicyinstances",
 	}
 
 	if input == nil {
-		input = &ListVPCAssociationAuthorizationsInput{}
+		input = &ListTrafficPolicyInstancesInput{}
 	}
 
-	output = &ListVPCAssociationAuthorizationsOutput{}
+	output = &ListTrafficPolicyInstancesOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListVPCAssociationAuthorizations API operation for Amazon Route 53.
+// ListTrafficPolicyInstances API operation for Amazon Route 53.
 //
-// Gets a list of the VPCs that were created by other accounts and that can
-// be associated with a specified hosted zone because you've submitted one or
-// more CreateVPCAssociationAuthorization requests.
+// Gets information about the traffic policy instances that you created by using
+// the current Amazon Web Services account.
 //
-// The response includes a VPCs element with a VPC child element for each VPC
-// that can be associated with the hosted zone.
+// After you submit an UpdateTrafficPolicyInstance request, there's a brief
+// delay while Amazon Route 53 creates the resource record sets that are specified
+// in the traffic policy definition. For more information, see the State response
+// element.
+//
+// Route 53 returns a maximum of 100 items in each response. If you have a lot
+// of traffic policy instances, you can use the MaxItems parameter to list them
+// in groups of up to 100.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Route 53's
-// API operation ListVPCAssociationAuthorizations for usage and error information.
+// API operation ListTrafficPolicyInstances for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchHostedZone "NoSuchHostedZone"
-//   No hosted zone exists with the ID that you specified.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-//   * ErrCodeInvalidPaginationToken "InvalidPaginationToken"
-//   The value that you specified to get the second or subsequent page of results
-//   is invalid.
+//   - ErrCodeNoSuchTrafficPolicyInstance "NoSuchTrafficPolicyInstance"
+//     No traffic policy instance exists with the specified ID.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListVPCAssociationAuthorizations
-func (c *Route53) ListVPCAssociationAuthorizations(input *ListVPCAssociationAuthorizationsInput) (*ListVPCAssociationAuthorizationsOutput, error) {
-	req, out := c.ListVPCAssociationAuthorizationsRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTrafficPolicyInstances
+func (c *Route53) ListTrafficPolicyInstances(input *ListTrafficPolicyInstancesInput) (*ListTrafficPolicyInstancesOutput, error) {
+	req, out := c.ListTrafficPolicyInstancesRequest(input)
 	return out, req.Send()
 }
 
-// ListVPCAssociationAuthorizationsWithContext is the same as ListVPCAssociationAuthorizations with the addition of
+// ListTrafficPolicyInstancesWithContext is the same as ListTrafficPolicyInstances with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListVPCAssociationAuthorizations for details on how to use this API operation.
+// See ListTrafficPolicyInstances for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *Route53) ListVPCAssociationAuthorizationsWithContext(ctx aws.Context, input *ListVPCAssociationAuthorizationsInput, opts ...request.Option) (*ListVPCAssociationAuthorizationsOutput, error) {
-	req, out := c.ListVPCAssociationAuthorizationsRequest(input)
+func (c *Route53) ListTrafficPolicyInstancesWithContext(ctx aws.Context, input *ListTrafficPolicyInstancesInput, opts ...request.Option) (*ListTrafficPolicyInstancesOutput, error) {
+	req, out := c.ListTrafficPolicyInstancesRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opTestDNSAnswer = "TestDNSAnswer"
+const opListTrafficPolicyInstancesByHostedZone = "ListTrafficPolicyInstancesByHostedZone"
 
-// TestDNSAnswerRequest generates a "aws/request.Request" representing the
-// client's request for the TestDNSAnswer operation. The "output" return
+// ListTrafficPolicyInstancesByHostedZoneRequest generates a "aws/request.Request" representing the
+// client's request for the ListTrafficPolicyInstancesByHostedZone operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See TestDNSAnswer for more information on using the TestDNSAnswer
+// See ListTrafficPolicyInstancesByHostedZone for more information on using the ListTrafficPolicyInstancesByHostedZone
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTrafficPolicyInstancesByHostedZoneRequest method.
+//	req, resp := client.ListTrafficPolicyInstancesByHostedZoneRequest(params)
 //
-//    // Example sending a request using the TestDNSAnswerRequest method.
-//    req, resp := client.TestDNSAnswerRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/TestDNSAnswer
-func (c *Route53) TestDNSAnswerRequest(input *TestDNSAnswerInput) (req *request.Request, output *TestDNSAnswerOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTrafficPolicyInstancesByHostedZone
+func (c *Route53) ListTrafficPolicyInstancesByHostedZoneRequest(input *ListTrafficPolicyInstancesByHostedZoneInput) (req *request.Request, output *ListTrafficPolicyInstancesByHostedZoneOutput) {
 	op := &request.Operation{
-		Name:       opTestDNSAnswer,
+		Name:       opListTrafficPolicyInstancesByHostedZone,
 		HTTPMethod: "GET",
-		HTTPPath:   "/2013-04-01/testdnsanswer",
+		HTTPPath:   "/2013-04-01/trafficpolicyinstances/hostedzone",
 	}
 
 	if input == nil {
-		input = &TestDNSAnswerInput{}
+		input = &ListTrafficPolicyInstancesByHostedZoneInput{}
 	}
 
-	output = &TestDNSAnswerOutput{}
+	output = &ListTrafficPolicyInstancesByHostedZoneOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// TestDNSAnswer API operation for Amazon Route 53.
+// ListTrafficPolicyInstancesByHostedZone API operation for Amazon Route 53.
 //
-// Gets the value that Amazon Route 53 returns in response to a DNS request
-// for a specified record name and type. You can optionally specify the IP address
-// of a DNS resolver, an EDNS0 client subnet IP address, and a subnet mask.
+// Gets information about the traffic policy instances that you created in a
+// specified hosted zone.
+//
+// After you submit a CreateTrafficPolicyInstance or an UpdateTrafficPolicyInstance
+// request, there's a brief delay while Amazon Route 53 creates the resource
+// record sets that are specified in the traffic policy definition. For more
+// information, see the State response element.
+//
+// Route 53 returns a maximum of 100 items in each response. If you have a lot
+// of traffic policy instances, you can use the MaxItems parameter to list them
+// in groups of up to 100.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Route 53's
-// API operation TestDNSAnswer for usage and error information.
+// API operation ListTrafficPolicyInstancesByHostedZone for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchHostedZone "NoSuchHostedZone"
-//   No hosted zone exists with the ID that you specified.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/TestDNSAnswer
-func (c *Route53) TestDNSAnswer(input *TestDNSAnswerInput) (*TestDNSAnswerOutput, error) {
-	req, out := c.TestDNSAnswerRequest(input)
+//   - ErrCodeNoSuchTrafficPolicyInstance "NoSuchTrafficPolicyInstance"
+//     No traffic policy instance exists with the specified ID.
+//
+//   - ErrCodeNoSuchHostedZone "NoSuchHostedZone"
+//     No hosted zone exists with the ID that you specified.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTrafficPolicyInstancesByHostedZone
+func (c *Route53) ListTrafficPolicyInstancesByHostedZone(input *ListTrafficPolicyInstancesByHostedZoneInput) (*ListTrafficPolicyInstancesByHostedZoneOutput, error) {
+	req, out := c.ListTrafficPolicyInstancesByHostedZoneRequest(input)
 	return out, req.Send()
 }
 
-// TestDNSAnswerWithContext is the same as TestDNSAnswer with the addition of
+// ListTrafficPolicyInstancesByHostedZoneWithContext is the same as ListTrafficPolicyInstancesByHostedZone with the addition of
 // the ability to pass a context and additional request options.
 //
-// See TestDNSAnswer for details on how to use this API operation.
+// See ListTrafficPolicyInstancesByHostedZone for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *Route53) TestDNSAnswerWithContext(ctx aws.Context, input *TestDNSAnswerInput, opts ...request.Option) (*TestDNSAnswerOutput, error) {
-	req, out := c.TestDNSAnswerRequest(input)
+func (c *Route53) ListTrafficPolicyInstancesByHostedZoneWithContext(ctx aws.Context, input *ListTrafficPolicyInstancesByHostedZoneInput, opts ...request.Option) (*ListTrafficPolicyInstancesByHostedZoneOutput, error) {
+	req, out := c.ListTrafficPolicyInstancesByHostedZoneRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateHealthCheck = "UpdateHealthCheck"
+const opListTrafficPolicyInstancesByPolicy = "ListTrafficPolicyInstancesByPolicy"
 
-// UpdateHealthCheckRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateHealthCheck operation. The "output" return
+// ListTrafficPolicyInstancesByPolicyRequest generates a "aws/request.Request" representing the
+// client's request for the ListTrafficPolicyInstancesByPolicy operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateHealthCheck for more information on using the UpdateHealthCheck
+// See ListTrafficPolicyInstancesByPolicy for more information on using the ListTrafficPolicyInstancesByPolicy
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTrafficPolicyInstancesByPolicyRequest method.
+//	req, resp := client.ListTrafficPolicyInstancesByPolicyRequest(params)
 //
-//    // Example sending a request using the UpdateHealthCheckRequest method.
-//    req, resp := client.UpdateHealthCheckRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/UpdateHealthCheck
-func (c *Route53) UpdateHealthCheckRequest(input *UpdateHealthCheckInput) (req *request.Request, output *UpdateHealthCheckOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTrafficPolicyInstancesByPolicy
+func (c *Route53) ListTrafficPolicyInstancesByPolicyRequest(input *ListTrafficPolicyInstancesByPolicyInput) (req *request.Request, output *ListTrafficPolicyInstancesByPolicyOutput) {
 	op := &request.Operation{
-		Name:       opUpdateHealthCheck,
-		HTTPMethod: "POST",
-		HTTPPath:   "/2013-04-01/healthcheck/{HealthCheckId}",
+		Name:       opListTrafficPolicyInstancesByPolicy,
+		HTTPMethod: "GET",
+		HTTPPath:   "/2013-04-01/trafficpolicyinstances/trafficpolicy",
 	}
 
 	if input == nil {
-		input = &UpdateHealthCheckInput{}
+		input = &ListTrafficPolicyInstancesByPolicyInput{}
 	}
 
-	output = &UpdateHealthCheckOutput{}
+	output = &ListTrafficPolicyInstancesByPolicyOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// UpdateHealthCheck API operation for Amazon Route 53.
+// ListTrafficPolicyInstancesByPolicy API operation for Amazon Route 53.
 //
-// Updates an existing health check. Note that some values can't be updated.
+// Gets information about the traffic policy instances that you created by using
+// a specify traffic policy version.
 //
-// For more information about updating health checks, see Creating, Updating,
-// and Deleting Health Checks (http://docs.aws.amazon.com/Route53/latest/DeveloperGuide/health-checks-creating-deleting.html)
-// in the Amazon Route 53 Developer Guide.
+// After you submit a CreateTrafficPolicyInstance or an UpdateTrafficPolicyInstance
+// request, there's a brief delay while Amazon Route 53 creates the resource
+// record sets that are specified in the traffic policy definition. For more
+// information, see the State response element.
+//
+// Route 53 returns a maximum of 100 items in each response. If you have a lot
+// of traffic policy instances, you can use the MaxItems parameter to list them
+// in groups of up to 100.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Route 53's
-// API operation UpdateHealthCheck for usage and error information.
+// API operation ListTrafficPolicyInstancesByPolicy for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchHealthCheck "NoSuchHealthCheck"
-//   No health check exists with the specified ID.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-//   * ErrCodeHealthCheckVersionMismatch "HealthCheckVersionMismatch"
-//   The value of HealthCheckVersion in the request doesn't match the value of
-//   HealthCheckVersion in the health check.
+//   - ErrCodeNoSuchTrafficPolicyInstance "NoSuchTrafficPolicyInstance"
+//     No traffic policy instance exists with the specified ID.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/UpdateHealthCheck
-func (c *Route53) UpdateHealthCheck(input *UpdateHealthCheckInput) (*UpdateHealthCheckOutput, error) {
-	req, out := c.UpdateHealthCheckRequest(input)
+//   - ErrCodeNoSuchTrafficPolicy "NoSuchTrafficPolicy"
+//     No traffic policy exists with the specified ID.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTrafficPolicyInstancesByPolicy
+func (c *Route53) ListTrafficPolicyInstancesByPolicy(input *ListTrafficPolicyInstancesByPolicyInput) (*ListTrafficPolicyInstancesByPolicyOutput, error) {
+	req, out := c.ListTrafficPolicyInstancesByPolicyRequest(input)
 	return out, req.Send()
 }
 
-// UpdateHealthCheckWithContext is the same as UpdateHealthCheck with the addition of
+// ListTrafficPolicyInstancesByPolicyWithContext is the same as ListTrafficPolicyInstancesByPolicy with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateHealthCheck for details on how to use this API operation.
+// See ListTrafficPolicyInstancesByPolicy for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *Route53) UpdateHealthCheckWithContext(ctx aws.Context, input *UpdateHealthCheckInput, opts ...request.Option) (*UpdateHealthCheckOutput, error) {
-	req, out := c.UpdateHealthCheckRequest(input)
+func (c *Route53) ListTrafficPolicyInstancesByPolicyWithContext(ctx aws.Context, input *ListTrafficPolicyInstancesByPolicyInput, opts ...request.Option) (*ListTrafficPolicyInstancesByPolicyOutput, error) {
+	req, out := c.ListTrafficPolicyInstancesByPolicyRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateHostedZoneComment = "UpdateHostedZoneComment"
+const opListTrafficPolicyVersions = "ListTrafficPolicyVersions"
 
-// UpdateHostedZoneCommentRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateHostedZoneComment operation. The "output" return
+// ListTrafficPolicyVersionsRequest generates a "aws/request.Request" representing the
+// client's request for the ListTrafficPolicyVersions operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateHostedZoneComment for more information on using the UpdateHostedZoneComment
+// See ListTrafficPolicyVersions for more information on using the ListTrafficPolicyVersions
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTrafficPolicyVersionsRequest method.
+//	req, resp := client.ListTrafficPolicyVersionsRequest(params)
 //
-//    // Example sending a request using the UpdateHostedZoneCommentRequest method.
-//    req, resp := client.UpdateHostedZoneCommentRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/UpdateHostedZoneComment
-func (c *Route53) UpdateHostedZoneCommentRequest(input *UpdateHostedZoneCommentInput) (req *request.Request, output *UpdateHostedZoneCommentOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTrafficPolicyVersions
+func (c *Route53) ListTrafficPolicyVersionsRequest(input *ListTrafficPolicyVersionsInput) (req *request.Request, output *ListTrafficPolicyVersionsOutput) {
 	op := &request.Operation{
-		Name:       opUpdateHostedZoneComment,
-		HTTPMethod: "POST",
-		HTTPPath:   "/2013-04-01/hostedzone/{Id}",
+		Name:       opListTrafficPolicyVersions,
+		HTTPMethod: "GET",
+		HTTPPath:   "/2013-04-01/trafficpolicies/{Id}/versions",
 	}
 
 	if input == nil {
-		input = &UpdateHostedZoneCommentInput{}
+		input = &ListTrafficPolicyVersionsInput{}
 	}
 
-	output = &UpdateHostedZoneCommentOutput{}
+	output = &ListTrafficPolicyVersionsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// UpdateHostedZoneComment API operation for Amazon Route 53.
+// ListTrafficPolicyVersions API operation for Amazon Route 53.
 //
-// Updates the comment for a specified hosted zone.
+// Gets information about all of the versions for a specified traffic policy.
+//
+// Traffic policy versions are listed in numerical order by VersionNumber.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Route 53's
-// API operation UpdateHostedZoneComment for usage and error information.
+// API operation ListTrafficPolicyVersions for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeNoSuchHostedZone "NoSuchHostedZone"
-//   No hosted zone exists with the ID that you specified.
 //
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/UpdateHostedZoneComment
-func (c *Route53) UpdateHostedZoneComment(input *UpdateHostedZoneCommentInput) (*UpdateHostedZoneCommentOutput, error) {
-	req, out := c.UpdateHostedZoneCommentRequest(input)
+//   - ErrCodeNoSuchTrafficPolicy "NoSuchTrafficPolicy"
+//     No traffic policy exists with the specified ID.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListTrafficPolicyVersions
+func (c *Route53) ListTrafficPolicyVersions(input *ListTrafficPolicyVersionsInput) (*ListTrafficPolicyVersionsOutput, error) {
+	req, out := c.ListTrafficPolicyVersionsRequest(input)
 	return out, req.Send()
 }
 
-// UpdateHostedZoneCommentWithContext is the same as UpdateHostedZoneComment with the addition of
+// ListTrafficPolicyVersionsWithContext is the same as ListTrafficPolicyVersions with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateHostedZoneComment for details on how to use this API operation.
+// See ListTrafficPolicyVersions for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *Route53) UpdateHostedZoneCommentWithContext(ctx aws.Context, input *UpdateHostedZoneCommentInput, opts ...request.Option) (*UpdateHostedZoneCommentOutput, error) {
-	req, out := c.UpdateHostedZoneCommentRequest(input)
+func (c *Route53) ListTrafficPolicyVersionsWithContext(ctx aws.Context, input *ListTrafficPolicyVersionsInput, opts ...request.Option) (*ListTrafficPolicyVersionsOutput, error) {
+	req, out := c.ListTrafficPolicyVersionsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateTrafficPolicyComment = "UpdateTrafficPolicyComment"
+const opListVPCAssociationAuthorizations = "ListVPCAssociationAuthorizations"
 
-// UpdateTrafficPolicyCommentRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateTrafficPolicyComment operation. The "output" return
+// ListVPCAssociationAuthorizationsRequest generates a "aws/request.Request" representing the
+// client's request for the ListVPCAssociationAuthorizations operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateTrafficPolicyComment for more information on using the UpdateTrafficPolicyComment
+// See ListVPCAssociationAuthorizations for more information on using the ListVPCAssociationAuthorizations
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListVPCAssociationAuthorizationsRequest method.
+//	req, resp := client.ListVPCAssociationAuthorizationsRequest(params)
 //
-//    // Example sending a request using the UpdateTrafficPolicyCommentRequest method.
-//    req, resp := client.UpdateTrafficPolicyCommentRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/UpdateTrafficPolicyComment
-func (c *Route53) UpdateTrafficPolicyCommentRequest(input *UpdateTrafficPolicyCommentInput) (req *request.Request, output *UpdateTrafficPolicyCommentOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListVPCAssociationAuthorizations
+func (c *Route53) ListVPCAssociationAuthorizationsRequest(input *ListVPCAssociationAuthorizationsInput) (req *request.Request, output *ListVPCAssociationAuthorizationsOutput) {
 	op := &request.Operation{
-		Name:       opUpdateTrafficPolicyComment,
-		HTTPMethod: "POST",
-		HTTPPath:   "/2013-04-01/trafficpolicy/{Id}/{Version}",
+		Name:       opListVPCAssociationAuthorizations,
+		HTTPMethod: "GET",
+		HTTPPath:   "/2013-04-01/hostedzone/{Id}/authorizevpcassociation",
 	}
 
 	if input == nil {
-		input = &UpdateTrafficPolicyCommentInput{}
+		input = &ListVPCAssociationAuthorizationsInput{}
 	}
 
-	output = &UpdateTrafficPolicyCommentOutput{}
+	output = &ListVPCAssociationAuthorizationsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// UpdateTrafficPolicyComment API operation for Amazon Route 53.
+// ListVPCAssociationAuthorizations API operation for Amazon Route 53.
 //
-// Updates the comment for a specified traffic policy version.
+// Gets a list of the VPCs that were created by other accounts and that can
+// be associated with a specified hosted zone because you've submitted one or
+// more CreateVPCAssociationAuthorization requests.
+//
+// The response includes a VPCs element with a VPC child element for each VPC
+// that can be associated with the hosted zone.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Route 53's
-// API operation UpdateTrafficPolicyComment for usage and error information.
+// API operation ListVPCAssociationAuthorizations for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
 //
-//   * ErrCodeNoSuchTrafficPolicy "NoSuchTrafficPolicy"
-//   No traffic policy exists with the specified ID.
+//   - ErrCodeNoSuchHostedZone "NoSuchHostedZone"
+//     No hosted zone exists with the ID that you specified.
 //
-//   * ErrCodeConcurrentModification "ConcurrentModification"
-//   Another user submitted a request to create, update, or delete the object
-//   at the same time that you did. Retry the request.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/UpdateTrafficPolicyComment
-func (c *Route53) UpdateTrafficPolicyComment(input *UpdateTrafficPolicyCommentInput) (*UpdateTrafficPolicyCommentOutput, error) {
-	req, out := c.UpdateTrafficPolicyCommentRequest(input)
+//   - ErrCodeInvalidPaginationToken "InvalidPaginationToken"
+//     The value that you specified to get the second or subsequent page of results
+//     is invalid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/ListVPCAssociationAuthorizations
+func (c *Route53) ListVPCAssociationAuthorizations(input *ListVPCAssociationAuthorizationsInput) (*ListVPCAssociationAuthorizationsOutput, error) {
+	req, out := c.ListVPCAssociationAuthorizationsRequest(input)
 	return out, req.Send()
 }
 
-// UpdateTrafficPolicyCommentWithContext is the same as UpdateTrafficPolicyComment with the addition of
+// ListVPCAssociationAuthorizationsWithContext is the same as ListVPCAssociationAuthorizations with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateTrafficPolicyComment for details on how to use this API operation.
+// See ListVPCAssociationAuthorizations for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *Route53) UpdateTrafficPolicyCommentWithContext(ctx aws.Context, input *UpdateTrafficPolicyCommentInput, opts ...request.Option) (*UpdateTrafficPolicyCommentOutput, error) {
-	req, out := c.UpdateTrafficPolicyCommentRequest(input)
+func (c *Route53) ListVPCAssociationAuthorizationsWithContext(ctx aws.Context, input *ListVPCAssociationAuthorizationsInput, opts ...request.Option) (*ListVPCAssociationAuthorizationsOutput, error) {
+	req, out := c.ListVPCAssociationAuthorizationsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateTrafficPolicyInstance = "UpdateTrafficPolicyInstance"
+const opTestDNSAnswer = "TestDNSAnswer"
 
-// UpdateTrafficPolicyInstanceRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateTrafficPolicyInstance operation. The "output" return
+// TestDNSAnswerRequest generates a "aws/request.Request" representing the
+// client's request for the TestDNSAnswer operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateTrafficPolicyInstance for more information on using the UpdateTrafficPolicyInstance
+// See TestDNSAnswer for more information on using the TestDNSAnswer
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the TestDNSAnswerRequest method.
+//	req, resp := client.TestDNSAnswerRequest(params)
 //
-//    // Example sending a request using the UpdateTrafficPolicyInstanceRequest method.
-//    req, resp := client.UpdateTrafficPolicyInstanceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/UpdateTrafficPolicyInstance
-func (c *Route53) UpdateTrafficPolicyInstanceRequest(input *UpdateTrafficPolicyInstanceInput) (req *request.Request, output *UpdateTrafficPolicyInstanceOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/TestDNSAnswer
+func (c *Route53) TestDNSAnswerRequest(input *TestDNSAnswerInput) (req *request.Request, output *TestDNSAnswerOutput) {
 	op := &request.Operation{
-		Name:       opUpdateTrafficPolicyInstance,
-		HTTPMethod: "POST",
-		HTTPPath:   "/2013-04-01/trafficpolicyinstance/{Id}",
+		Name:       opTestDNSAnswer,
+		HTTPMethod: "GET",
+		HTTPPath:   "/2013-04-01/testdnsanswer",
 	}
 
 	if input == nil {
-		input = &UpdateTrafficPolicyInstanceInput{}
+		input = &TestDNSAnswerInput{}
 	}
 
-	output = &UpdateTrafficPolicyInstanceOutput{}
+	output = &TestDNSAnswerOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// UpdateTrafficPolicyInstance API operation for Amazon Route 53.
-//
-// Updates the resource record sets in a specified hosted zone that were created
-// based on the settings in a specified traffic policy version.
-//
-// When you update a traffic policy instance, Amazon Route 53 continues to respond
-// to DNS queries for the root resource record set name (such as example.com)
-// while it replaces one group of resource record sets with another. Route 53
-// performs the following operations:
+// TestDNSAnswer API operation for Amazon Route 53.
 //
-// Route 53 creates a new group of resource record sets based on the specified
-// traffic policy. This is true regardless of how significant the differences
-// are between the existing resource record sets and the new resource record
-// sets.
+// Gets the value that Amazon Route 53 returns in response to a DNS request
+// for a specified record name and type. You can optionally specify the IP address
+// of a DNS resolver, an EDNS0 client subnet IP address, and a subnet mask.
 //
-// When all of the new resource record sets have been created, Route 53 starts
-// to respond to DNS queries for the root resource record set name (such as
-// example.com) by using the new resource record sets.
+// This call only supports querying public hosted zones.
 //
-// Route 53 deletes the old group of resource record sets that are associated
-// with the root resource record set name.
+// The TestDnsAnswer returns information similar to what you would expect from
+// the answer section of the dig command. Therefore, if you query for the name
+// servers of a subdomain that point to the parent name servers, those will
+// not be returned.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Route 53's
-// API operation UpdateTrafficPolicyInstance for usage and error information.
+// API operation TestDNSAnswer for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidInput "InvalidInput"
-//   The input is not valid.
 //
-//   * ErrCodeNoSuchTrafficPolicy "NoSuchTrafficPolicy"
-//   No traffic policy exists with the specified ID.
+//   - ErrCodeNoSuchHostedZone "NoSuchHostedZone"
+//     No hosted zone exists with the ID that you specified.
 //
-//   * ErrCodeNoSuchTrafficPolicyInstance "NoSuchTrafficPolicyInstance"
-//   No traffic policy instance exists with the specified ID.
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
 //
-//   * ErrCodePriorRequestNotComplete "PriorRequestNotComplete"
-//   If Amazon Route 53 can't process a request before the next request arrives,
-//   it will reject subsequent requests for the same hosted zone and return an
-//   HTTP 400 error (Bad request). If Route 53 returns this error repeatedly for
-//   the same request, we recommend that you wait, in intervals of increasing
-//   duration, before you try the request again.
-//
-//   * ErrCodeConflictingTypes "ConflictingTypes"
-//   You tried to update a traffic policy instance by using a traffic policy version
-//   that has a different DNS type than the current type for the instance. You
-//   specified the type in the JSON document in the CreateTrafficPolicy or CreateTrafficPolicyVersionrequest.
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/UpdateTrafficPolicyInstance
-func (c *Route53) UpdateTrafficPolicyInstance(input *UpdateTrafficPolicyInstanceInput) (*UpdateTrafficPolicyInstanceOutput, error) {
-	req, out := c.UpdateTrafficPolicyInstanceRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/TestDNSAnswer
+func (c *Route53) TestDNSAnswer(input *TestDNSAnswerInput) (*TestDNSAnswerOutput, error) {
+	req, out := c.TestDNSAnswerRequest(input)
 	return out, req.Send()
 }
 
-// UpdateTrafficPolicyInstanceWithContext is the same as UpdateTrafficPolicyInstance with the addition of
+// TestDNSAnswerWithContext is the same as TestDNSAnswer with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateTrafficPolicyInstance for details on how to use this API operation.
+// See TestDNSAnswer for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *Route53) UpdateTrafficPolicyInstanceWithContext(ctx aws.Context, input *UpdateTrafficPolicyInstanceInput, opts ...request.Option) (*UpdateTrafficPolicyInstanceOutput, error) {
-	req, out := c.UpdateTrafficPolicyInstanceRequest(input)
+func (c *Route53) TestDNSAnswerWithContext(ctx aws.Context, input *TestDNSAnswerInput, opts ...request.Option) (*TestDNSAnswerOutput, error) {
+	req, out := c.TestDNSAnswerRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-// A complex type that contains the type of limit that you specified in the
-// request and the current value for that limit.
-type AccountLimit struct {
-	_ struct{} `type:"structure"`
-
-	// The limit that you requested. Valid values include the following:
-	//
-	//    * MAX_HEALTH_CHECKS_BY_OWNER: The maximum number of health checks that
-	//    you can create using the current account.
-	//
-	//    * MAX_HOSTED_ZONES_BY_OWNER: The maximum number of hosted zones that you
-	//    can create using the current account.
-	//
-	//    * MAX_REUSABLE_DELEGATION_SETS_BY_OWNER: The maximum number of reusable
-	//    delegation sets that you can create using the current account.
-	//
-	//    * MAX_TRAFFIC_POLICIES_BY_OWNER: The maximum number of traffic policies
-	//    that you can create using the current account.
-	//
-	//    * MAX_TRAFFIC_POLICY_INSTANCES_BY_OWNER: The maximum number of traffic
-	//    policy instances that you can create using the current account. (Traffic
-	//    policy instances are referred to as traffic flow policy records in the
-	//    Amazon Route 53 console.)
-	//
-	// Type is a required field
-	Type *string `type:"string" required:"true" enum:"AccountLimitType"`
+const opUpdateHealthCheck = "UpdateHealthCheck"
 
-	// The current value for the limit that is specified by Type (https://docs.aws.amazon.com/Route53/latest/APIReference/API_AccountLimit.html#Route53-Type-AccountLimit-Type).
-	//
-	// Value is a required field
-	Value *int64 `min:"1" type:"long" required:"true"`
-}
+// UpdateHealthCheckRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateHealthCheck operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateHealthCheck for more information on using the UpdateHealthCheck
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateHealthCheckRequest method.
+//	req, resp := client.UpdateHealthCheckRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/UpdateHealthCheck
+func (c *Route53) UpdateHealthCheckRequest(input *UpdateHealthCheckInput) (req *request.Request, output *UpdateHealthCheckOutput) {
+	op := &request.Operation{
+		Name:       opUpdateHealthCheck,
+		HTTPMethod: "POST",
+		HTTPPath:   "/2013-04-01/healthcheck/{HealthCheckId}",
+	}
 
-// String returns the string representation
-func (s AccountLimit) String() string {
-	return awsutil.Prettify(s)
-}
+	if input == nil {
+		input = &UpdateHealthCheckInput{}
+	}
 
-// GoString returns the string representation
-func (s AccountLimit) GoString() string {
-	return s.String()
+	output = &UpdateHealthCheckOutput{}
+	req = c.newRequest(op, input, output)
+	return
 }
 
-// SetType sets the Type field's value.
-func (s *AccountLimit) SetType(v string) *AccountLimit {
-	s.Type = &v
-	return s
+// UpdateHealthCheck API operation for Amazon Route 53.
+//
+// Updates an existing health check. Note that some values can't be updated.
+//
+// For more information about updating health checks, see Creating, Updating,
+// and Deleting Health Checks (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/health-checks-creating-deleting.html)
+// in the Amazon Route 53 Developer Guide.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Route 53's
+// API operation UpdateHealthCheck for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeNoSuchHealthCheck "NoSuchHealthCheck"
+//     No health check exists with the specified ID.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+//   - ErrCodeHealthCheckVersionMismatch "HealthCheckVersionMismatch"
+//     The value of HealthCheckVersion in the request doesn't match the value of
+//     HealthCheckVersion in the health check.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/UpdateHealthCheck
+func (c *Route53) UpdateHealthCheck(input *UpdateHealthCheckInput) (*UpdateHealthCheckOutput, error) {
+	req, out := c.UpdateHealthCheckRequest(input)
+	return out, req.Send()
 }
 
-// SetValue sets the Value field's value.
-func (s *AccountLimit) SetValue(v int64) *AccountLimit {
-	s.Value = &v
-	return s
+// UpdateHealthCheckWithContext is the same as UpdateHealthCheck with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateHealthCheck for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Route53) UpdateHealthCheckWithContext(ctx aws.Context, input *UpdateHealthCheckInput, opts ...request.Option) (*UpdateHealthCheckOutput, error) {
+	req, out := c.UpdateHealthCheckRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
 }
 
-// A complex type that identifies the CloudWatch alarm that you want Amazon
-// Route 53 health checkers to use to determine whether the specified health
-// check is healthy.
-type AlarmIdentifier struct {
-	_ struct{} `type:"structure"`
+const opUpdateHostedZoneComment = "UpdateHostedZoneComment"
 
-	// The name of the CloudWatch alarm that you want Amazon Route 53 health checkers
-	// to use to determine whether this health check is healthy.
-	//
-	// Route 53 supports CloudWatch alarms with the following features:
-	//
-	//    * Standard-resolution metrics. High-resolution metrics aren't supported.
-	//    For more information, see High-Resolution Metrics (http://docs.aws.amazon.com/AmazonCloudWatch/latest/DeveloperGuide/publishingMetrics.html#high-resolution-metrics)
-	//    in the Amazon CloudWatch User Guide.
-	//
-	//    * Statistics: Average, Minimum, Maximum, Sum, and SampleCount. Extended
-	//    statistics aren't supported.
-	//
-	// Name is a required field
-	Name *string `min:"1" type:"string" required:"true"`
+// UpdateHostedZoneCommentRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateHostedZoneComment operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateHostedZoneComment for more information on using the UpdateHostedZoneComment
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateHostedZoneCommentRequest method.
+//	req, resp := client.UpdateHostedZoneCommentRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/UpdateHostedZoneComment
+func (c *Route53) UpdateHostedZoneCommentRequest(input *UpdateHostedZoneCommentInput) (req *request.Request, output *UpdateHostedZoneCommentOutput) {
+	op := &request.Operation{
+		Name:       opUpdateHostedZoneComment,
+		HTTPMethod: "POST",
+		HTTPPath:   "/2013-04-01/hostedzone/{Id}",
+	}
 
-	// For the CloudWatch alarm that you want Route 53 health checkers to use to
-	// determine whether this health check is healthy, the region that the alarm
-	// was created in.
-	//
-	// For the current list of CloudWatch regions, see Amazon CloudWatch (http://docs.aws.amazon.com/general/latest/gr/rande.html#cw_region)
-	// in the AWS Regions and Endpoints chapter of the Amazon Web Services General
-	// Reference.
-	//
-	// Region is a required field
-	Region *string `min:"1" type:"string" required:"true" enum:"CloudWatchRegion"`
+	if input == nil {
+		input = &UpdateHostedZoneCommentInput{}
+	}
+
+	output = &UpdateHostedZoneCommentOutput{}
+	req = c.newRequest(op, input, output)
+	return
 }
 
-// String returns the string representation
-func (s AlarmIdentifier) String() string {
-	return awsutil.Prettify(s)
+// UpdateHostedZoneComment API operation for Amazon Route 53.
+//
+// Updates the comment for a specified hosted zone.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Route 53's
+// API operation UpdateHostedZoneComment for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeNoSuchHostedZone "NoSuchHostedZone"
+//     No hosted zone exists with the ID that you specified.
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+//   - ErrCodePriorRequestNotComplete "PriorRequestNotComplete"
+//     If Amazon Route 53 can't process a request before the next request arrives,
+//     it will reject subsequent requests for the same hosted zone and return an
+//     HTTP 400 error (Bad request). If Route 53 returns this error repeatedly for
+//     the same request, we recommend that you wait, in intervals of increasing
+//     duration, before you try the request again.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/UpdateHostedZoneComment
+func (c *Route53) UpdateHostedZoneComment(input *UpdateHostedZoneCommentInput) (*UpdateHostedZoneCommentOutput, error) {
+	req, out := c.UpdateHostedZoneCommentRequest(input)
+	return out, req.Send()
 }
 
-// GoString returns the string representation
-func (s AlarmIdentifier) GoString() string {
-	return s.String()
+// UpdateHostedZoneCommentWithContext is the same as UpdateHostedZoneComment with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateHostedZoneComment for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Route53) UpdateHostedZoneCommentWithContext(ctx aws.Context, input *UpdateHostedZoneCommentInput, opts ...request.Option) (*UpdateHostedZoneCommentOutput, error) {
+	req, out := c.UpdateHostedZoneCommentRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *AlarmIdentifier) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "AlarmIdentifier"}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
-	}
-	if s.Name != nil && len(*s.Name) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
-	}
-	if s.Region == nil {
-		invalidParams.Add(request.NewErrParamRequired("Region"))
-	}
-	if s.Region != nil && len(*s.Region) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Region", 1))
+const opUpdateTrafficPolicyComment = "UpdateTrafficPolicyComment"
+
+// UpdateTrafficPolicyCommentRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateTrafficPolicyComment operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateTrafficPolicyComment for more information on using the UpdateTrafficPolicyComment
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateTrafficPolicyCommentRequest method.
+//	req, resp := client.UpdateTrafficPolicyCommentRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/UpdateTrafficPolicyComment
+func (c *Route53) UpdateTrafficPolicyCommentRequest(input *UpdateTrafficPolicyCommentInput) (req *request.Request, output *UpdateTrafficPolicyCommentOutput) {
+	op := &request.Operation{
+		Name:       opUpdateTrafficPolicyComment,
+		HTTPMethod: "POST",
+		HTTPPath:   "/2013-04-01/trafficpolicy/{Id}/{Version}",
 	}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
+	if input == nil {
+		input = &UpdateTrafficPolicyCommentInput{}
 	}
-	return nil
+
+	output = &UpdateTrafficPolicyCommentOutput{}
+	req = c.newRequest(op, input, output)
+	return
 }
 
-// SetName sets the Name field's value.
-func (s *AlarmIdentifier) SetName(v string) *AlarmIdentifier {
-	s.Name = &v
-	return s
+// UpdateTrafficPolicyComment API operation for Amazon Route 53.
+//
+// Updates the comment for a specified traffic policy version.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Route 53's
+// API operation UpdateTrafficPolicyComment for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+//   - ErrCodeNoSuchTrafficPolicy "NoSuchTrafficPolicy"
+//     No traffic policy exists with the specified ID.
+//
+//   - ErrCodeConcurrentModification "ConcurrentModification"
+//     Another user submitted a request to create, update, or delete the object
+//     at the same time that you did. Retry the request.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/UpdateTrafficPolicyComment
+func (c *Route53) UpdateTrafficPolicyComment(input *UpdateTrafficPolicyCommentInput) (*UpdateTrafficPolicyCommentOutput, error) {
+	req, out := c.UpdateTrafficPolicyCommentRequest(input)
+	return out, req.Send()
 }
 
-// SetRegion sets the Region field's value.
-func (s *AlarmIdentifier) SetRegion(v string) *AlarmIdentifier {
-	s.Region = &v
-	return s
+// UpdateTrafficPolicyCommentWithContext is the same as UpdateTrafficPolicyComment with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateTrafficPolicyComment for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Route53) UpdateTrafficPolicyCommentWithContext(ctx aws.Context, input *UpdateTrafficPolicyCommentInput, opts ...request.Option) (*UpdateTrafficPolicyCommentOutput, error) {
+	req, out := c.UpdateTrafficPolicyCommentRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
 }
 
-// Alias resource record sets only: Information about the AWS resource, such
-// as a CloudFront distribution or an Amazon S3 bucket, that you want to route
-// traffic to.
+const opUpdateTrafficPolicyInstance = "UpdateTrafficPolicyInstance"
+
+// UpdateTrafficPolicyInstanceRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateTrafficPolicyInstance operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
 //
-// When creating resource record sets for a private hosted zone, note the following:
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
 //
-//    * Creating geolocation alias resource record sets or latency alias resource
-//    record sets in a private hosted zone is unsupported.
+// See UpdateTrafficPolicyInstance for more information on using the UpdateTrafficPolicyInstance
+// API call, and error handling.
 //
-//    * For information about creating failover resource record sets in a private
-//    hosted zone, see Configuring Failover in a Private Hosted Zone (http://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover-private-hosted-zones.html).
-type AliasTarget struct {
-	_ struct{} `type:"structure"`
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateTrafficPolicyInstanceRequest method.
+//	req, resp := client.UpdateTrafficPolicyInstanceRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/UpdateTrafficPolicyInstance
+func (c *Route53) UpdateTrafficPolicyInstanceRequest(input *UpdateTrafficPolicyInstanceInput) (req *request.Request, output *UpdateTrafficPolicyInstanceOutput) {
+	op := &request.Operation{
+		Name:       opUpdateTrafficPolicyInstance,
+		HTTPMethod: "POST",
+		HTTPPath:   "/2013-04-01/trafficpolicyinstance/{Id}",
+	}
 
-	// Alias resource record sets only: The value that you specify depends on where
-	// you want to route queries:
-	//
-	// Amazon API Gateway custom regional APIs and edge-optimized APIs
-	//
-	// Specify the applicable domain name for your API. You can get the applicable
-	// value using the AWS CLI command get-domain-names (https://docs.aws.amazon.com/cli/latest/reference/apigateway/get-domain-names.html):
-	//
-	//    * For regional APIs, specify the value of regionalDomainName.
-	//
-	//    * For edge-optimized APIs, specify the value of distributionDomainName.
-	//    This is the name of the associated CloudFront distribution, such as da1b2c3d4e5.cloudfront.net.
-	//
-	// The name of the record that you're creating must match a custom domain name
-	// for your API, such as api.example.com.
-	//
-	// Amazon Virtual Private Cloud interface VPC endpoint
-	//
-	// Enter the API endpoint for the interface endpoint, such as vpce-123456789abcdef01-example-us-east-1a.elasticloadbalancing.us-east-1.vpce.amazonaws.com.
-	// For edge-optimized APIs, this is the domain name for the corresponding CloudFront
-	// distribution. You can get the value of DnsName using the AWS CLI command
-	// describe-vpc-endpoints (https://docs.aws.amazon.com/cli/latest/reference/ec2/describe-vpc-endpoints.html).
-	//
-	// CloudFront distribution
-	//
-	// Specify the domain name that CloudFront assigned when you created your distribution.
-	//
-	// Your CloudFront distribution must include an alternate domain name that matches
-	// the name of the resource record set. For example, if the name of the resource
-	// record set is acme.example.com, your CloudFront distribution must include
-	// acme.example.com as one of the alternate domain names. For more information,
-	// see Using Alternate Domain Names (CNAMEs) (http://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/CNAMEs.html)
-	// in the Amazon CloudFront Developer Guide.
+	if input == nil {
+		input = &UpdateTrafficPolicyInstanceInput{}
+	}
+
+	output = &UpdateTrafficPolicyInstanceOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UpdateTrafficPolicyInstance API operation for Amazon Route 53.
+//
+// After you submit a UpdateTrafficPolicyInstance request, there's a brief delay
+// while Route 53 creates the resource record sets that are specified in the
+// traffic policy definition. Use GetTrafficPolicyInstance with the id of updated
+// traffic policy instance confirm that the UpdateTrafficPolicyInstance request
+// completed successfully. For more information, see the State response element.
+//
+// Updates the resource record sets in a specified hosted zone that were created
+// based on the settings in a specified traffic policy version.
+//
+// When you update a traffic policy instance, Amazon Route 53 continues to respond
+// to DNS queries for the root resource record set name (such as example.com)
+// while it replaces one group of resource record sets with another. Route 53
+// performs the following operations:
+//
+// Route 53 creates a new group of resource record sets based on the specified
+// traffic policy. This is true regardless of how significant the differences
+// are between the existing resource record sets and the new resource record
+// sets.
+//
+// When all of the new resource record sets have been created, Route 53 starts
+// to respond to DNS queries for the root resource record set name (such as
+// example.com) by using the new resource record sets.
+//
+// Route 53 deletes the old group of resource record sets that are associated
+// with the root resource record set name.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Route 53's
+// API operation UpdateTrafficPolicyInstance for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeInvalidInput "InvalidInput"
+//     The input is not valid.
+//
+//   - ErrCodeNoSuchTrafficPolicy "NoSuchTrafficPolicy"
+//     No traffic policy exists with the specified ID.
+//
+//   - ErrCodeNoSuchTrafficPolicyInstance "NoSuchTrafficPolicyInstance"
+//     No traffic policy instance exists with the specified ID.
+//
+//   - ErrCodePriorRequestNotComplete "PriorRequestNotComplete"
+//     If Amazon Route 53 can't process a request before the next request arrives,
+//     it will reject subsequent requests for the same hosted zone and return an
+//     HTTP 400 error (Bad request). If Route 53 returns this error repeatedly for
+//     the same request, we recommend that you wait, in intervals of increasing
+//     duration, before you try the request again.
+//
+//   - ErrCodeConflictingTypes "ConflictingTypes"
+//     You tried to update a traffic policy instance by using a traffic policy version
+//     that has a different DNS type than the current type for the instance. You
+//     specified the type in the JSON document in the CreateTrafficPolicy or CreateTrafficPolicyVersionrequest.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01/UpdateTrafficPolicyInstance
+func (c *Route53) UpdateTrafficPolicyInstance(input *UpdateTrafficPolicyInstanceInput) (*UpdateTrafficPolicyInstanceOutput, error) {
+	req, out := c.UpdateTrafficPolicyInstanceRequest(input)
+	return out, req.Send()
+}
+
+// UpdateTrafficPolicyInstanceWithContext is the same as UpdateTrafficPolicyInstance with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateTrafficPolicyInstance for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *Route53) UpdateTrafficPolicyInstanceWithContext(ctx aws.Context, input *UpdateTrafficPolicyInstanceInput, opts ...request.Option) (*UpdateTrafficPolicyInstanceOutput, error) {
+	req, out := c.UpdateTrafficPolicyInstanceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+// A complex type that contains the type of limit that you specified in the
+// request and the current value for that limit.
+type AccountLimit struct {
+	_ struct{} `type:"structure"`
+
+	// The limit that you requested. Valid values include the following:
 	//
-	// You can't create a resource record set in a private hosted zone to route
-	// traffic to a CloudFront distribution.
+	//    * MAX_HEALTH_CHECKS_BY_OWNER: The maximum number of health checks that
+	//    you can create using the current account.
 	//
-	// For failover alias records, you can't specify a CloudFront distribution for
-	// both the primary and secondary records. A distribution must include an alternate
-	// domain name that matches the name of the record. However, the primary and
-	// secondary records have the same name, and you can't include the same alternate
-	// domain name in more than one distribution.
+	//    * MAX_HOSTED_ZONES_BY_OWNER: The maximum number of hosted zones that you
+	//    can create using the current account.
 	//
-	// Elastic Beanstalk environment
+	//    * MAX_REUSABLE_DELEGATION_SETS_BY_OWNER: The maximum number of reusable
+	//    delegation sets that you can create using the current account.
 	//
-	// If the domain name for your Elastic Beanstalk environment includes the region
-	// that you deployed the environment in, you can create an alias record that
-	// routes traffic to the environment. For example, the domain name my-environment.us-west-2.elasticbeanstalk.com
-	// is a regionalized domain name.
+	//    * MAX_TRAFFIC_POLICIES_BY_OWNER: The maximum number of traffic policies
+	//    that you can create using the current account.
 	//
-	// For environments that were created before early 2016, the domain name doesn't
-	// include the region. To route traffic to these environments, you must create
-	// a CNAME record instead of an alias record. Note that you can't create a CNAME
-	// record for the root domain name. For example, if your domain name is example.com,
-	// you can create a record that routes traffic for acme.example.com to your
-	// Elastic Beanstalk environment, but you can't create a record that routes
-	// traffic for example.com to your Elastic Beanstalk environment.
+	//    * MAX_TRAFFIC_POLICY_INSTANCES_BY_OWNER: The maximum number of traffic
+	//    policy instances that you can create using the current account. (Traffic
+	//    policy instances are referred to as traffic flow policy records in the
+	//    Amazon Route 53 console.)
 	//
-	// For Elastic Beanstalk environments that have regionalized subdomains, specify
-	// the CNAME attribute for the environment. You can use the following methods
-	// to get the value of the CNAME attribute:
+	// Type is a required field
+	Type *string `type:"string" required:"true" enum:"AccountLimitType"`
+
+	// The current value for the limit that is specified by Type (https://docs.aws.amazon.com/Route53/latest/APIReference/API_AccountLimit.html#Route53-Type-AccountLimit-Type).
 	//
-	//    * AWS Management Console: For information about how to get the value by
-	//    using the console, see Using Custom Domains with AWS Elastic Beanstalk
-	//    (http://docs.aws.amazon.com/elasticbeanstalk/latest/dg/customdomains.html)
-	//    in the AWS Elastic Beanstalk Developer Guide.
+	// Value is a required field
+	Value *int64 `min:"1" type:"long" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AccountLimit) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AccountLimit) GoString() string {
+	return s.String()
+}
+
+// SetType sets the Type field's value.
+func (s *AccountLimit) SetType(v string) *AccountLimit {
+	s.Type = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *AccountLimit) SetValue(v int64) *AccountLimit {
+	s.Value = &v
+	return s
+}
+
+type ActivateKeySigningKeyInput struct {
+	_ struct{} `locationName:"ActivateKeySigningKeyRequest" type:"structure"`
+
+	// A unique string used to identify a hosted zone.
 	//
-	//    * Elastic Beanstalk API: Use the DescribeEnvironments action to get the
-	//    value of the CNAME attribute. For more information, see DescribeEnvironments
-	//    (http://docs.aws.amazon.com/elasticbeanstalk/latest/api/API_DescribeEnvironments.html)
-	//    in the AWS Elastic Beanstalk API Reference.
+	// HostedZoneId is a required field
+	HostedZoneId *string `location:"uri" locationName:"HostedZoneId" type:"string" required:"true"`
+
+	// A string used to identify a key-signing key (KSK). Name can include numbers,
+	// letters, and underscores (_). Name must be unique for each key-signing key
+	// in the same hosted zone.
 	//
-	//    * AWS CLI: Use the describe-environments command to get the value of the
-	//    CNAME attribute. For more information, see describe-environments (http://docs.aws.amazon.com/cli/latest/reference/elasticbeanstalk/describe-environments.html)
-	//    in the AWS Command Line Interface Reference.
+	// Name is a required field
+	Name *string `location:"uri" locationName:"Name" min:"3" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActivateKeySigningKeyInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActivateKeySigningKeyInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ActivateKeySigningKeyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ActivateKeySigningKeyInput"}
+	if s.HostedZoneId == nil {
+		invalidParams.Add(request.NewErrParamRequired("HostedZoneId"))
+	}
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("HostedZoneId", 1))
+	}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 3 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 3))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetHostedZoneId sets the HostedZoneId field's value.
+func (s *ActivateKeySigningKeyInput) SetHostedZoneId(v string) *ActivateKeySigningKeyInput {
+	s.HostedZoneId = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *ActivateKeySigningKeyInput) SetName(v string) *ActivateKeySigningKeyInput {
+	s.Name = &v
+	return s
+}
+
+type ActivateKeySigningKeyOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A complex type that describes change information about changes made to your
+	// hosted zone.
 	//
-	// ELB load balancer
+	// ChangeInfo is a required field
+	ChangeInfo *ChangeInfo `type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActivateKeySigningKeyOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ActivateKeySigningKeyOutput) GoString() string {
+	return s.String()
+}
+
+// SetChangeInfo sets the ChangeInfo field's value.
+func (s *ActivateKeySigningKeyOutput) SetChangeInfo(v *ChangeInfo) *ActivateKeySigningKeyOutput {
+	s.ChangeInfo = v
+	return s
+}
+
+// A complex type that identifies the CloudWatch alarm that you want Amazon
+// Route 53 health checkers to use to determine whether the specified health
+// check is healthy.
+type AlarmIdentifier struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the CloudWatch alarm that you want Amazon Route 53 health checkers
+	// to use to determine whether this health check is healthy.
 	//
-	// Specify the DNS name that is associated with the load balancer. Get the DNS
-	// name by using the AWS Management Console, the ELB API, or the AWS CLI.
+	// Route 53 supports CloudWatch alarms with the following features:
 	//
-	//    * AWS Management Console: Go to the EC2 page, choose Load Balancers in
-	//    the navigation pane, choose the load balancer, choose the Description
-	//    tab, and get the value of the DNS name field. If you're routing traffic
-	//    to a Classic Load Balancer, get the value that begins with dualstack.
-	//    If you're routing traffic to another type of load balancer, get the value
-	//    that applies to the record type, A or AAAA.
+	//    * Standard-resolution metrics. High-resolution metrics aren't supported.
+	//    For more information, see High-Resolution Metrics (https://docs.aws.amazon.com/AmazonCloudWatch/latest/DeveloperGuide/publishingMetrics.html#high-resolution-metrics)
+	//    in the Amazon CloudWatch User Guide.
 	//
-	//    * Elastic Load Balancing API: Use DescribeLoadBalancers to get the value
-	//    of DNSName. For more information, see the applicable guide: Classic Load
-	//    Balancers: DescribeLoadBalancers (http://docs.aws.amazon.com/elasticloadbalancing/2012-06-01/APIReference/API_DescribeLoadBalancers.html)
-	//    Application and Network Load Balancers: DescribeLoadBalancers (http://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_DescribeLoadBalancers.html)
+	//    * Statistics: Average, Minimum, Maximum, Sum, and SampleCount. Extended
+	//    statistics aren't supported.
 	//
-	//    * AWS CLI: Use describe-load-balancers to get the value of DNSName. For
-	//    more information, see the applicable guide: Classic Load Balancers: describe-load-balancers
-	//    (http://docs.aws.amazon.com/cli/latest/reference/elb/describe-load-balancers.html)
-	//    Application and Network Load Balancers: describe-load-balancers (http://docs.aws.amazon.com/cli/latest/reference/elbv2/describe-load-balancers.html)
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
+
+	// For the CloudWatch alarm that you want Route 53 health checkers to use to
+	// determine whether this health check is healthy, the region that the alarm
+	// was created in.
 	//
-	// Amazon S3 bucket that is configured as a static website
+	// For the current list of CloudWatch regions, see Amazon CloudWatch endpoints
+	// and quotas (https://docs.aws.amazon.com/general/latest/gr/cw_region.html)
+	// in the Amazon Web Services General Reference.
 	//
-	// Specify the domain name of the Amazon S3 website endpoint that you created
+	// Region is a required field
+	Region *string `min:"1" type:"string" required:"true" enum:"CloudWatchRegion"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AlarmIdentifier) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AlarmIdentifier) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *AlarmIdentifier) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AlarmIdentifier"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+	if s.Region == nil {
+		invalidParams.Add(request.NewErrParamRequired("Region"))
+	}
+	if s.Region != nil && len(*s.Region) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Region", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetName sets the Name field's value.
+func (s *AlarmIdentifier) SetName(v string) *AlarmIdentifier {
+	s.Name = &v
+	return s
+}
+
+// SetRegion sets the Region field's value.
+func (s *AlarmIdentifier) SetRegion(v string) *AlarmIdentifier {
+	s.Region = &v
+	return s
+}
+
+// Alias resource record sets only: Information about the Amazon Web Services
+// resource, such as a CloudFront distribution or an Amazon S3 bucket, that
+// you want to route traffic to.
+//
+// When creating resource record sets for a private hosted zone, note the following:
+//
+//   - For information about creating failover resource record sets in a private
+//     hosted zone, see Configuring Failover in a Private Hosted Zone (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover-private-hosted-zones.html).
+type AliasTarget struct {
+	_ struct{} `type:"structure"`
+
+	// Alias resource record sets only: The value that you specify depends on where
+	// you want to route queries:
+	//
+	// Amazon API Gateway custom regional APIs and edge-optimized APIs
+	//
+	// Specify the applicable domain name for your API. You can get the applicable
+	// value using the CLI command get-domain-names (https://docs.aws.amazon.com/cli/latest/reference/apigateway/get-domain-names.html):
+	//
+	//    * For regional APIs, specify the value of regionalDomainName.
+	//
+	//    * For edge-optimized APIs, specify the value of distributionDomainName.
+	//    This is the name of the associated CloudFront distribution, such as da1b2c3d4e5.cloudfront.net.
+	//
+	// The name of the record that you're creating must match a custom domain name
+	// for your API, such as api.example.com.
+	//
+	// Amazon Virtual Private Cloud interface VPC endpoint
+	//
+	// Enter the API endpoint for the interface endpoint, such as vpce-123456789abcdef01-example-us-east-1a.elasticloadbalancing.us-east-1.vpce.amazonaws.com.
+	// For edge-optimized APIs, this is the domain name for the corresponding CloudFront
+	// distribution. You can get the value of DnsName using the CLI command describe-vpc-endpoints
+	// (https://docs.aws.amazon.com/cli/latest/reference/ec2/describe-vpc-endpoints.html).
+	//
+	// CloudFront distribution
+	//
+	// Specify the domain name that CloudFront assigned when you created your distribution.
+	//
+	// Your CloudFront distribution must include an alternate domain name that matches
+	// the name of the resource record set. For example, if the name of the resource
+	// record set is acme.example.com, your CloudFront distribution must include
+	// acme.example.com as one of the alternate domain names. For more information,
+	// see Using Alternate Domain Names (CNAMEs) (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/CNAMEs.html)
+	// in the Amazon CloudFront Developer Guide.
+	//
+	// You can't create a resource record set in a private hosted zone to route
+	// traffic to a CloudFront distribution.
+	//
+	// For failover alias records, you can't specify a CloudFront distribution for
+	// both the primary and secondary records. A distribution must include an alternate
+	// domain name that matches the name of the record. However, the primary and
+	// secondary records have the same name, and you can't include the same alternate
+	// domain name in more than one distribution.
+	//
+	// Elastic Beanstalk environment
+	//
+	// If the domain name for your Elastic Beanstalk environment includes the region
+	// that you deployed the environment in, you can create an alias record that
+	// routes traffic to the environment. For example, the domain name my-environment.us-west-2.elasticbeanstalk.com
+	// is a regionalized domain name.
+	//
+	// For environments that were created before early 2016, the domain name doesn't
+	// include the region. To route traffic to these environments, you must create
+	// a CNAME record instead of an alias record. Note that you can't create a CNAME
+	// record for the root domain name. For example, if your domain name is example.com,
+	// you can create a record that routes traffic for acme.example.com to your
+	// Elastic Beanstalk environment, but you can't create a record that routes
+	// traffic for example.com to your Elastic Beanstalk environment.
+	//
+	// For Elastic Beanstalk environments that have regionalized subdomains, specify
+	// the CNAME attribute for the environment. You can use the following methods
+	// to get the value of the CNAME attribute:
+	//
+	//    * Amazon Web Services Management Console: For information about how to
+	//    get the value by using the console, see Using Custom Domains with Elastic
+	//    Beanstalk (https://docs.aws.amazon.com/elasticbeanstalk/latest/dg/customdomains.html)
+	//    in the Elastic Beanstalk Developer Guide.
+	//
+	//    * Elastic Beanstalk API: Use the DescribeEnvironments action to get the
+	//    value of the CNAME attribute. For more information, see DescribeEnvironments
+	//    (https://docs.aws.amazon.com/elasticbeanstalk/latest/api/API_DescribeEnvironments.html)
+	//    in the Elastic Beanstalk API Reference.
+	//
+	//    * CLI: Use the describe-environments command to get the value of the CNAME
+	//    attribute. For more information, see describe-environments (https://docs.aws.amazon.com/cli/latest/reference/elasticbeanstalk/describe-environments.html)
+	//    in the CLI Command Reference.
+	//
+	// ELB load balancer
+	//
+	// Specify the DNS name that is associated with the load balancer. Get the DNS
+	// name by using the Amazon Web Services Management Console, the ELB API, or
+	// the CLI.
+	//
+	//    * Amazon Web Services Management Console: Go to the EC2 page, choose Load
+	//    Balancers in the navigation pane, choose the load balancer, choose the
+	//    Description tab, and get the value of the DNS name field. If you're routing
+	//    traffic to a Classic Load Balancer, get the value that begins with dualstack.
+	//    If you're routing traffic to another type of load balancer, get the value
+	//    that applies to the record type, A or AAAA.
+	//
+	//    * Elastic Load Balancing API: Use DescribeLoadBalancers to get the value
+	//    of DNSName. For more information, see the applicable guide: Classic Load
+	//    Balancers: DescribeLoadBalancers (https://docs.aws.amazon.com/elasticloadbalancing/2012-06-01/APIReference/API_DescribeLoadBalancers.html)
+	//    Application and Network Load Balancers: DescribeLoadBalancers (https://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_DescribeLoadBalancers.html)
+	//
+	//    * CLI: Use describe-load-balancers to get the value of DNSName. For more
+	//    information, see the applicable guide: Classic Load Balancers: describe-load-balancers
+	//    (http://docs.aws.amazon.com/cli/latest/reference/elb/describe-load-balancers.html)
+	//    Application and Network Load Balancers: describe-load-balancers (http://docs.aws.amazon.com/cli/latest/reference/elbv2/describe-load-balancers.html)
+	//
+	// Global Accelerator accelerator
+	//
+	// Specify the DNS name for your accelerator:
+	//
+	//    * Global Accelerator API: To get the DNS name, use DescribeAccelerator
+	//    (https://docs.aws.amazon.com/global-accelerator/latest/api/API_DescribeAccelerator.html).
+	//
+	//    * CLI: To get the DNS name, use describe-accelerator (https://docs.aws.amazon.com/cli/latest/reference/globalaccelerator/describe-accelerator.html).
+	//
+	// Amazon S3 bucket that is configured as a static website
+	//
+	// Specify the domain name of the Amazon S3 website endpoint that you created
 	// the bucket in, for example, s3-website.us-east-2.amazonaws.com. For more
-	// information about valid values, see the table Amazon Simple Storage Service
-	// (S3) Website Endpoints (http://docs.aws.amazon.com/general/latest/gr/rande.html#s3_region)
+	// information about valid values, see the table Amazon S3 Website Endpoints
+	// (https://docs.aws.amazon.com/general/latest/gr/s3.html#s3_website_region_endpoints)
 	// in the Amazon Web Services General Reference. For more information about
-	// using S3 buckets for websites, see Getting Started with Amazon Route 53 (http://docs.aws.amazon.com/Route53/latest/DeveloperGuide/getting-started.html)
+	// using S3 buckets for websites, see Getting Started with Amazon Route 53 (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/getting-started.html)
 	// in the Amazon Route 53 Developer Guide.
 	//
 	// Another Route 53 resource record set
@@ -6105,9 +7985,9 @@ type AliasTarget struct {
 
 	// Applies only to alias, failover alias, geolocation alias, latency alias,
 	// and weighted alias resource record sets: When EvaluateTargetHealth is true,
-	// an alias resource record set inherits the health of the referenced AWS resource,
-	// such as an ELB load balancer or another resource record set in the hosted
-	// zone.
+	// an alias resource record set inherits the health of the referenced Amazon
+	// Web Services resource, such as an ELB load balancer or another resource record
+	// set in the hosted zone.
 	//
 	// Note the following:
 	//
@@ -6163,15 +8043,15 @@ type AliasTarget struct {
 	//
 	// Other records in the same hosted zone
 	//
-	// If the AWS resource that you specify in DNSName is a record or a group of
-	// records (for example, a group of weighted records) but is not another alias
-	// record, we recommend that you associate a health check with all of the records
-	// in the alias target. For more information, see What Happens When You Omit
-	// Health Checks? (http://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover-complex-configs.html#dns-failover-complex-configs-hc-omitting)
+	// If the Amazon Web Services resource that you specify in DNSName is a record
+	// or a group of records (for example, a group of weighted records) but is not
+	// another alias record, we recommend that you associate a health check with
+	// all of the records in the alias target. For more information, see What Happens
+	// When You Omit Health Checks? (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover-complex-configs.html#dns-failover-complex-configs-hc-omitting)
 	// in the Amazon Route 53 Developer Guide.
 	//
 	// For more information and examples, see Amazon Route 53 Health Checks and
-	// DNS Failover (http://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover.html)
+	// DNS Failover (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover.html)
 	// in the Amazon Route 53 Developer Guide.
 	//
 	// EvaluateTargetHealth is a required field
@@ -6183,7 +8063,7 @@ type AliasTarget struct {
 	// Amazon API Gateway custom regional APIs and edge-optimized APIs
 	//
 	// Specify the hosted zone ID for your API. You can get the applicable value
-	// using the AWS CLI command get-domain-names (https://docs.aws.amazon.com/cli/latest/reference/apigateway/get-domain-names.html):
+	// using the CLI command get-domain-names (https://docs.aws.amazon.com/cli/latest/reference/apigateway/get-domain-names.html):
 	//
 	//    * For regional APIs, specify the value of regionalHostedZoneId.
 	//
@@ -6192,7 +8072,7 @@ type AliasTarget struct {
 	// Amazon Virtual Private Cloud interface VPC endpoint
 	//
 	// Specify the hosted zone ID for your interface endpoint. You can get the value
-	// of HostedZoneId using the AWS CLI command describe-vpc-endpoints (https://docs.aws.amazon.com/cli/latest/reference/ec2/describe-vpc-endpoints.html).
+	// of HostedZoneId using the CLI command describe-vpc-endpoints (https://docs.aws.amazon.com/cli/latest/reference/ec2/describe-vpc-endpoints.html).
 	//
 	// CloudFront distribution
 	//
@@ -6204,78 +8084,2288 @@ type AliasTarget struct {
 	//
 	// Specify the hosted zone ID for the region that you created the environment
 	// in. The environment must have a regionalized subdomain. For a list of regions
-	// and the corresponding hosted zone IDs, see AWS Elastic Beanstalk (http://docs.aws.amazon.com/general/latest/gr/rande.html#elasticbeanstalk_region)
-	// in the "AWS Regions and Endpoints" chapter of the Amazon Web Services General
-	// Reference.
+	// and the corresponding hosted zone IDs, see Elastic Beanstalk endpoints and
+	// quotas (https://docs.aws.amazon.com/general/latest/gr/elasticbeanstalk.html)
+	// in the the Amazon Web Services General Reference.
 	//
 	// ELB load balancer
 	//
 	// Specify the value of the hosted zone ID for the load balancer. Use the following
 	// methods to get the hosted zone ID:
 	//
-	//    * Elastic Load Balancing (https://docs.aws.amazon.com/general/latest/gr/rande.html#elb_region)
-	//    table in the "AWS Regions and Endpoints" chapter of the Amazon Web Services
-	//    General Reference: Use the value that corresponds with the region that
-	//    you created your load balancer in. Note that there are separate columns
-	//    for Application and Classic Load Balancers and for Network Load Balancers.
+	//    * Elastic Load Balancing endpoints and quotas (https://docs.aws.amazon.com/general/latest/gr/elb.html)
+	//    topic in the Amazon Web Services General Reference: Use the value that
+	//    corresponds with the region that you created your load balancer in. Note
+	//    that there are separate columns for Application and Classic Load Balancers
+	//    and for Network Load Balancers.
 	//
-	//    * AWS Management Console: Go to the Amazon EC2 page, choose Load Balancers
-	//    in the navigation pane, select the load balancer, and get the value of
-	//    the Hosted zone field on the Description tab.
+	//    * Amazon Web Services Management Console: Go to the Amazon EC2 page, choose
+	//    Load Balancers in the navigation pane, select the load balancer, and get
+	//    the value of the Hosted zone field on the Description tab.
 	//
 	//    * Elastic Load Balancing API: Use DescribeLoadBalancers to get the applicable
 	//    value. For more information, see the applicable guide: Classic Load Balancers:
-	//    Use DescribeLoadBalancers (http://docs.aws.amazon.com/elasticloadbalancing/2012-06-01/APIReference/API_DescribeLoadBalancers.html)
+	//    Use DescribeLoadBalancers (https://docs.aws.amazon.com/elasticloadbalancing/2012-06-01/APIReference/API_DescribeLoadBalancers.html)
 	//    to get the value of CanonicalHostedZoneNameId. Application and Network
-	//    Load Balancers: Use DescribeLoadBalancers (http://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_DescribeLoadBalancers.html)
+	//    Load Balancers: Use DescribeLoadBalancers (https://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_DescribeLoadBalancers.html)
 	//    to get the value of CanonicalHostedZoneId.
 	//
-	//    * AWS CLI: Use describe-load-balancers to get the applicable value. For
-	//    more information, see the applicable guide: Classic Load Balancers: Use
-	//    describe-load-balancers (http://docs.aws.amazon.com/cli/latest/reference/elb/describe-load-balancers.html)
+	//    * CLI: Use describe-load-balancers to get the applicable value. For more
+	//    information, see the applicable guide: Classic Load Balancers: Use describe-load-balancers
+	//    (http://docs.aws.amazon.com/cli/latest/reference/elb/describe-load-balancers.html)
 	//    to get the value of CanonicalHostedZoneNameId. Application and Network
 	//    Load Balancers: Use describe-load-balancers (http://docs.aws.amazon.com/cli/latest/reference/elbv2/describe-load-balancers.html)
 	//    to get the value of CanonicalHostedZoneId.
 	//
-	// An Amazon S3 bucket configured as a static website
+	// Global Accelerator accelerator
+	//
+	// Specify Z2BJ6XQ5FK7U4H.
+	//
+	// An Amazon S3 bucket configured as a static website
+	//
+	// Specify the hosted zone ID for the region that you created the bucket in.
+	// For more information about valid values, see the table Amazon S3 Website
+	// Endpoints (https://docs.aws.amazon.com/general/latest/gr/s3.html#s3_website_region_endpoints)
+	// in the Amazon Web Services General Reference.
+	//
+	// Another Route 53 resource record set in your hosted zone
+	//
+	// Specify the hosted zone ID of your hosted zone. (An alias resource record
+	// set can't reference a resource record set in a different hosted zone.)
+	//
+	// HostedZoneId is a required field
+	HostedZoneId *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AliasTarget) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AliasTarget) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *AliasTarget) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AliasTarget"}
+	if s.DNSName == nil {
+		invalidParams.Add(request.NewErrParamRequired("DNSName"))
+	}
+	if s.EvaluateTargetHealth == nil {
+		invalidParams.Add(request.NewErrParamRequired("EvaluateTargetHealth"))
+	}
+	if s.HostedZoneId == nil {
+		invalidParams.Add(request.NewErrParamRequired("HostedZoneId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDNSName sets the DNSName field's value.
+func (s *AliasTarget) SetDNSName(v string) *AliasTarget {
+	s.DNSName = &v
+	return s
+}
+
+// SetEvaluateTargetHealth sets the EvaluateTargetHealth field's value.
+func (s *AliasTarget) SetEvaluateTargetHealth(v bool) *AliasTarget {
+	s.EvaluateTargetHealth = &v
+	return s
+}
+
+// SetHostedZoneId sets the HostedZoneId field's value.
+func (s *AliasTarget) SetHostedZoneId(v string) *AliasTarget {
+	s.HostedZoneId = &v
+	return s
+}
+
+// A complex type that contains information about the request to associate a
+// VPC with a private hosted zone.
+type AssociateVPCWithHostedZoneInput struct {
+	_ struct{} `locationName:"AssociateVPCWithHostedZoneRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+
+	// Optional: A comment about the association request.
+	Comment *string `type:"string"`
+
+	// The ID of the private hosted zone that you want to associate an Amazon VPC
+	// with.
+	//
+	// Note that you can't associate a VPC with a hosted zone that doesn't have
+	// an existing VPC association.
+	//
+	// HostedZoneId is a required field
+	HostedZoneId *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+
+	// A complex type that contains information about the VPC that you want to associate
+	// with a private hosted zone.
+	//
+	// VPC is a required field
+	VPC *VPC `type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AssociateVPCWithHostedZoneInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AssociateVPCWithHostedZoneInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *AssociateVPCWithHostedZoneInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssociateVPCWithHostedZoneInput"}
+	if s.HostedZoneId == nil {
+		invalidParams.Add(request.NewErrParamRequired("HostedZoneId"))
+	}
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("HostedZoneId", 1))
+	}
+	if s.VPC == nil {
+		invalidParams.Add(request.NewErrParamRequired("VPC"))
+	}
+	if s.VPC != nil {
+		if err := s.VPC.Validate(); err != nil {
+			invalidParams.AddNested("VPC", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetComment sets the Comment field's value.
+func (s *AssociateVPCWithHostedZoneInput) SetComment(v string) *AssociateVPCWithHostedZoneInput {
+	s.Comment = &v
+	return s
+}
+
+// SetHostedZoneId sets the HostedZoneId field's value.
+func (s *AssociateVPCWithHostedZoneInput) SetHostedZoneId(v string) *AssociateVPCWithHostedZoneInput {
+	s.HostedZoneId = &v
+	return s
+}
+
+// SetVPC sets the VPC field's value.
+func (s *AssociateVPCWithHostedZoneInput) SetVPC(v *VPC) *AssociateVPCWithHostedZoneInput {
+	s.VPC = v
+	return s
+}
+
+// A complex type that contains the response information for the AssociateVPCWithHostedZone
+// request.
+type AssociateVPCWithHostedZoneOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A complex type that describes the changes made to your hosted zone.
+	//
+	// ChangeInfo is a required field
+	ChangeInfo *ChangeInfo `type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AssociateVPCWithHostedZoneOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AssociateVPCWithHostedZoneOutput) GoString() string {
+	return s.String()
+}
+
+// SetChangeInfo sets the ChangeInfo field's value.
+func (s *AssociateVPCWithHostedZoneOutput) SetChangeInfo(v *ChangeInfo) *AssociateVPCWithHostedZoneOutput {
+	s.ChangeInfo = v
+	return s
+}
+
+// The information for each resource record set that you want to change.
+type Change struct {
+	_ struct{} `type:"structure"`
+
+	// The action to perform:
+	//
+	//    * CREATE: Creates a resource record set that has the specified values.
+	//
+	//    * DELETE: Deletes a existing resource record set. To delete the resource
+	//    record set that is associated with a traffic policy instance, use DeleteTrafficPolicyInstance
+	//    (https://docs.aws.amazon.com/Route53/latest/APIReference/API_DeleteTrafficPolicyInstance.html).
+	//    Amazon Route 53 will delete the resource record set automatically. If
+	//    you delete the resource record set by using ChangeResourceRecordSets,
+	//    Route 53 doesn't automatically delete the traffic policy instance, and
+	//    you'll continue to be charged for it even though it's no longer in use.
+	//
+	//    * UPSERT: If a resource record set doesn't already exist, Route 53 creates
+	//    it. If a resource record set does exist, Route 53 updates it with the
+	//    values in the request.
+	//
+	// Action is a required field
+	Action *string `type:"string" required:"true" enum:"ChangeAction"`
+
+	// Information about the resource record set to create, delete, or update.
+	//
+	// ResourceRecordSet is a required field
+	ResourceRecordSet *ResourceRecordSet `type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Change) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Change) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *Change) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "Change"}
+	if s.Action == nil {
+		invalidParams.Add(request.NewErrParamRequired("Action"))
+	}
+	if s.ResourceRecordSet == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceRecordSet"))
+	}
+	if s.ResourceRecordSet != nil {
+		if err := s.ResourceRecordSet.Validate(); err != nil {
+			invalidParams.AddNested("ResourceRecordSet", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAction sets the Action field's value.
+func (s *Change) SetAction(v string) *Change {
+	s.Action = &v
+	return s
+}
+
+// SetResourceRecordSet sets the ResourceRecordSet field's value.
+func (s *Change) SetResourceRecordSet(v *ResourceRecordSet) *Change {
+	s.ResourceRecordSet = v
+	return s
+}
+
+// The information for a change request.
+type ChangeBatch struct {
+	_ struct{} `type:"structure"`
+
+	// Information about the changes to make to the record sets.
+	//
+	// Changes is a required field
+	Changes []*Change `locationNameList:"Change" min:"1" type:"list" required:"true"`
+
+	// Optional: Any comments you want to include about a change batch request.
+	Comment *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ChangeBatch) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ChangeBatch) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ChangeBatch) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ChangeBatch"}
+	if s.Changes == nil {
+		invalidParams.Add(request.NewErrParamRequired("Changes"))
+	}
+	if s.Changes != nil && len(s.Changes) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Changes", 1))
+	}
+	if s.Changes != nil {
+		for i, v := range s.Changes {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Changes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetChanges sets the Changes field's value.
+func (s *ChangeBatch) SetChanges(v []*Change) *ChangeBatch {
+	s.Changes = v
+	return s
+}
+
+// SetComment sets the Comment field's value.
+func (s *ChangeBatch) SetComment(v string) *ChangeBatch {
+	s.Comment = &v
+	return s
+}
+
+type ChangeCidrCollectionInput struct {
+	_ struct{} `locationName:"ChangeCidrCollectionRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+
+	// Information about changes to a CIDR collection.
+	//
+	// Changes is a required field
+	Changes []*CidrCollectionChange `min:"1" type:"list" required:"true"`
+
+	// A sequential counter that Amazon Route 53 sets to 1 when you create a collection
+	// and increments it by 1 each time you update the collection.
+	//
+	// We recommend that you use ListCidrCollection to get the current value of
+	// CollectionVersion for the collection that you want to update, and then include
+	// that value with the change request. This prevents Route 53 from overwriting
+	// an intervening update:
+	//
+	//    * If the value in the request matches the value of CollectionVersion in
+	//    the collection, Route 53 updates the collection.
+	//
+	//    * If the value of CollectionVersion in the collection is greater than
+	//    the value in the request, the collection was changed after you got the
+	//    version number. Route 53 does not update the collection, and it returns
+	//    a CidrCollectionVersionMismatch error.
+	CollectionVersion *int64 `min:"1" type:"long"`
+
+	// The UUID of the CIDR collection to update.
+	//
+	// Id is a required field
+	Id *string `location:"uri" locationName:"CidrCollectionId" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ChangeCidrCollectionInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ChangeCidrCollectionInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ChangeCidrCollectionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ChangeCidrCollectionInput"}
+	if s.Changes == nil {
+		invalidParams.Add(request.NewErrParamRequired("Changes"))
+	}
+	if s.Changes != nil && len(s.Changes) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Changes", 1))
+	}
+	if s.CollectionVersion != nil && *s.CollectionVersion < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("CollectionVersion", 1))
+	}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
+	}
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
+	}
+	if s.Changes != nil {
+		for i, v := range s.Changes {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Changes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetChanges sets the Changes field's value.
+func (s *ChangeCidrCollectionInput) SetChanges(v []*CidrCollectionChange) *ChangeCidrCollectionInput {
+	s.Changes = v
+	return s
+}
+
+// SetCollectionVersion sets the CollectionVersion field's value.
+func (s *ChangeCidrCollectionInput) SetCollectionVersion(v int64) *ChangeCidrCollectionInput {
+	s.CollectionVersion = &v
+	return s
+}
+
+// SetId sets the Id field's value.
+func (s *ChangeCidrCollectionInput) SetId(v string) *ChangeCidrCollectionInput {
+	s.Id = &v
+	return s
+}
+
+type ChangeCidrCollectionOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The ID that is returned by ChangeCidrCollection. You can use it as input
+	// to GetChange to see if a CIDR collection change has propagated or not.
+	//
+	// Id is a required field
+	Id *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ChangeCidrCollectionOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ChangeCidrCollectionOutput) GoString() string {
+	return s.String()
+}
+
+// SetId sets the Id field's value.
+func (s *ChangeCidrCollectionOutput) SetId(v string) *ChangeCidrCollectionOutput {
+	s.Id = &v
+	return s
+}
+
+// A complex type that describes change information about changes made to your
+// hosted zone.
+type ChangeInfo struct {
+	_ struct{} `type:"structure"`
+
+	// A comment you can provide.
+	Comment *string `type:"string"`
+
+	// This element contains an ID that you use when performing a GetChange (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetChange.html)
+	// action to get detailed information about the change.
+	//
+	// Id is a required field
+	Id *string `type:"string" required:"true"`
+
+	// The current state of the request. PENDING indicates that this request has
+	// not yet been applied to all Amazon Route 53 DNS servers.
+	//
+	// Status is a required field
+	Status *string `type:"string" required:"true" enum:"ChangeStatus"`
+
+	// The date and time that the change request was submitted in ISO 8601 format
+	// (https://en.wikipedia.org/wiki/ISO_8601) and Coordinated Universal Time (UTC).
+	// For example, the value 2017-03-27T17:48:16.751Z represents March 27, 2017
+	// at 17:48:16.751 UTC.
+	//
+	// SubmittedAt is a required field
+	SubmittedAt *time.Time `type:"timestamp" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ChangeInfo) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ChangeInfo) GoString() string {
+	return s.String()
+}
+
+// SetComment sets the Comment field's value.
+func (s *ChangeInfo) SetComment(v string) *ChangeInfo {
+	s.Comment = &v
+	return s
+}
+
+// SetId sets the Id field's value.
+func (s *ChangeInfo) SetId(v string) *ChangeInfo {
+	s.Id = &v
+	return s
+}
+
+// SetStatus sets the Status field's value.
+func (s *ChangeInfo) SetStatus(v string) *ChangeInfo {
+	s.Status = &v
+	return s
+}
+
+// SetSubmittedAt sets the SubmittedAt field's value.
+func (s *ChangeInfo) SetSubmittedAt(v time.Time) *ChangeInfo {
+	s.SubmittedAt = &v
+	return s
+}
+
+// A complex type that contains change information for the resource record set.
+type ChangeResourceRecordSetsInput struct {
+	_ struct{} `locationName:"ChangeResourceRecordSetsRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+
+	// A complex type that contains an optional comment and the Changes element.
+	//
+	// ChangeBatch is a required field
+	ChangeBatch *ChangeBatch `type:"structure" required:"true"`
+
+	// The ID of the hosted zone that contains the resource record sets that you
+	// want to change.
+	//
+	// HostedZoneId is a required field
+	HostedZoneId *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ChangeResourceRecordSetsInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ChangeResourceRecordSetsInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ChangeResourceRecordSetsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ChangeResourceRecordSetsInput"}
+	if s.ChangeBatch == nil {
+		invalidParams.Add(request.NewErrParamRequired("ChangeBatch"))
+	}
+	if s.HostedZoneId == nil {
+		invalidParams.Add(request.NewErrParamRequired("HostedZoneId"))
+	}
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("HostedZoneId", 1))
+	}
+	if s.ChangeBatch != nil {
+		if err := s.ChangeBatch.Validate(); err != nil {
+			invalidParams.AddNested("ChangeBatch", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetChangeBatch sets the ChangeBatch field's value.
+func (s *ChangeResourceRecordSetsInput) SetChangeBatch(v *ChangeBatch) *ChangeResourceRecordSetsInput {
+	s.ChangeBatch = v
+	return s
+}
+
+// SetHostedZoneId sets the HostedZoneId field's value.
+func (s *ChangeResourceRecordSetsInput) SetHostedZoneId(v string) *ChangeResourceRecordSetsInput {
+	s.HostedZoneId = &v
+	return s
+}
+
+// A complex type containing the response for the request.
+type ChangeResourceRecordSetsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A complex type that contains information about changes made to your hosted
+	// zone.
+	//
+	// This element contains an ID that you use when performing a GetChange (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetChange.html)
+	// action to get detailed information about the change.
+	//
+	// ChangeInfo is a required field
+	ChangeInfo *ChangeInfo `type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ChangeResourceRecordSetsOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ChangeResourceRecordSetsOutput) GoString() string {
+	return s.String()
+}
+
+// SetChangeInfo sets the ChangeInfo field's value.
+func (s *ChangeResourceRecordSetsOutput) SetChangeInfo(v *ChangeInfo) *ChangeResourceRecordSetsOutput {
+	s.ChangeInfo = v
+	return s
+}
+
+// A complex type that contains information about the tags that you want to
+// add, edit, or delete.
+type ChangeTagsForResourceInput struct {
+	_ struct{} `locationName:"ChangeTagsForResourceRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+
+	// A complex type that contains a list of the tags that you want to add to the
+	// specified health check or hosted zone and/or the tags that you want to edit
+	// Value for.
+	//
+	// You can add a maximum of 10 tags to a health check or a hosted zone.
+	AddTags []*Tag `locationNameList:"Tag" min:"1" type:"list"`
+
+	// A complex type that contains a list of the tags that you want to delete from
+	// the specified health check or hosted zone. You can specify up to 10 keys.
+	RemoveTagKeys []*string `locationNameList:"Key" min:"1" type:"list"`
+
+	// The ID of the resource for which you want to add, change, or delete tags.
+	//
+	// ResourceId is a required field
+	ResourceId *string `location:"uri" locationName:"ResourceId" type:"string" required:"true"`
+
+	// The type of the resource.
+	//
+	//    * The resource type for health checks is healthcheck.
+	//
+	//    * The resource type for hosted zones is hostedzone.
+	//
+	// ResourceType is a required field
+	ResourceType *string `location:"uri" locationName:"ResourceType" type:"string" required:"true" enum:"TagResourceType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ChangeTagsForResourceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ChangeTagsForResourceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ChangeTagsForResourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ChangeTagsForResourceInput"}
+	if s.AddTags != nil && len(s.AddTags) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("AddTags", 1))
+	}
+	if s.RemoveTagKeys != nil && len(s.RemoveTagKeys) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RemoveTagKeys", 1))
+	}
+	if s.ResourceId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceId"))
+	}
+	if s.ResourceId != nil && len(*s.ResourceId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ResourceId", 1))
+	}
+	if s.ResourceType == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceType"))
+	}
+	if s.ResourceType != nil && len(*s.ResourceType) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ResourceType", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAddTags sets the AddTags field's value.
+func (s *ChangeTagsForResourceInput) SetAddTags(v []*Tag) *ChangeTagsForResourceInput {
+	s.AddTags = v
+	return s
+}
+
+// SetRemoveTagKeys sets the RemoveTagKeys field's value.
+func (s *ChangeTagsForResourceInput) SetRemoveTagKeys(v []*string) *ChangeTagsForResourceInput {
+	s.RemoveTagKeys = v
+	return s
+}
+
+// SetResourceId sets the ResourceId field's value.
+func (s *ChangeTagsForResourceInput) SetResourceId(v string) *ChangeTagsForResourceInput {
+	s.ResourceId = &v
+	return s
+}
+
+// SetResourceType sets the ResourceType field's value.
+func (s *ChangeTagsForResourceInput) SetResourceType(v string) *ChangeTagsForResourceInput {
+	s.ResourceType = &v
+	return s
+}
+
+// Empty response for the request.
+type ChangeTagsForResourceOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ChangeTagsForResourceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ChangeTagsForResourceOutput) GoString() string {
+	return s.String()
+}
+
+// A complex type that lists the CIDR blocks.
+type CidrBlockSummary struct {
+	_ struct{} `type:"structure"`
+
+	// Value for the CIDR block.
+	CidrBlock *string `min:"1" type:"string"`
+
+	// The location name of the CIDR block.
+	LocationName *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CidrBlockSummary) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CidrBlockSummary) GoString() string {
+	return s.String()
+}
+
+// SetCidrBlock sets the CidrBlock field's value.
+func (s *CidrBlockSummary) SetCidrBlock(v string) *CidrBlockSummary {
+	s.CidrBlock = &v
+	return s
+}
+
+// SetLocationName sets the LocationName field's value.
+func (s *CidrBlockSummary) SetLocationName(v string) *CidrBlockSummary {
+	s.LocationName = &v
+	return s
+}
+
+// A complex type that identifies a CIDR collection.
+type CidrCollection struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the collection. Can be used to reference the collection in IAM
+	// policy or in another Amazon Web Services account.
+	Arn *string `min:"20" type:"string"`
+
+	// The unique ID of the CIDR collection.
+	Id *string `type:"string"`
+
+	// The name of a CIDR collection.
+	Name *string `min:"1" type:"string"`
+
+	// A sequential counter that Route 53 sets to 1 when you create a CIDR collection
+	// and increments by 1 each time you update settings for the CIDR collection.
+	Version *int64 `min:"1" type:"long"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CidrCollection) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CidrCollection) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *CidrCollection) SetArn(v string) *CidrCollection {
+	s.Arn = &v
+	return s
+}
+
+// SetId sets the Id field's value.
+func (s *CidrCollection) SetId(v string) *CidrCollection {
+	s.Id = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *CidrCollection) SetName(v string) *CidrCollection {
+	s.Name = &v
+	return s
+}
+
+// SetVersion sets the Version field's value.
+func (s *CidrCollection) SetVersion(v int64) *CidrCollection {
+	s.Version = &v
+	return s
+}
+
+// A complex type that contains information about the CIDR collection change.
+type CidrCollectionChange struct {
+	_ struct{} `type:"structure"`
+
+	// CIDR collection change action.
+	//
+	// Action is a required field
+	Action *string `type:"string" required:"true" enum:"CidrCollectionChangeAction"`
+
+	// List of CIDR blocks.
+	//
+	// CidrList is a required field
+	CidrList []*string `locationNameList:"Cidr" min:"1" type:"list" required:"true"`
+
+	// Name of the location that is associated with the CIDR collection.
+	//
+	// LocationName is a required field
+	LocationName *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CidrCollectionChange) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CidrCollectionChange) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CidrCollectionChange) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CidrCollectionChange"}
+	if s.Action == nil {
+		invalidParams.Add(request.NewErrParamRequired("Action"))
+	}
+	if s.CidrList == nil {
+		invalidParams.Add(request.NewErrParamRequired("CidrList"))
+	}
+	if s.CidrList != nil && len(s.CidrList) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CidrList", 1))
+	}
+	if s.LocationName == nil {
+		invalidParams.Add(request.NewErrParamRequired("LocationName"))
+	}
+	if s.LocationName != nil && len(*s.LocationName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("LocationName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAction sets the Action field's value.
+func (s *CidrCollectionChange) SetAction(v string) *CidrCollectionChange {
+	s.Action = &v
+	return s
+}
+
+// SetCidrList sets the CidrList field's value.
+func (s *CidrCollectionChange) SetCidrList(v []*string) *CidrCollectionChange {
+	s.CidrList = v
+	return s
+}
+
+// SetLocationName sets the LocationName field's value.
+func (s *CidrCollectionChange) SetLocationName(v string) *CidrCollectionChange {
+	s.LocationName = &v
+	return s
+}
+
+// The object that is specified in resource record set object when you are linking
+// a resource record set to a CIDR location.
+//
+// A LocationName with an asterisk “*” can be used to create a default CIDR
+// record. CollectionId is still required for default record.
+type CidrRoutingConfig struct {
+	_ struct{} `type:"structure"`
+
+	// The CIDR collection ID.
+	//
+	// CollectionId is a required field
+	CollectionId *string `type:"string" required:"true"`
+
+	// The CIDR collection location name.
+	//
+	// LocationName is a required field
+	LocationName *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CidrRoutingConfig) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CidrRoutingConfig) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CidrRoutingConfig) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CidrRoutingConfig"}
+	if s.CollectionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("CollectionId"))
+	}
+	if s.LocationName == nil {
+		invalidParams.Add(request.NewErrParamRequired("LocationName"))
+	}
+	if s.LocationName != nil && len(*s.LocationName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("LocationName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCollectionId sets the CollectionId field's value.
+func (s *CidrRoutingConfig) SetCollectionId(v string) *CidrRoutingConfig {
+	s.CollectionId = &v
+	return s
+}
+
+// SetLocationName sets the LocationName field's value.
+func (s *CidrRoutingConfig) SetLocationName(v string) *CidrRoutingConfig {
+	s.LocationName = &v
+	return s
+}
+
+// A complex type that contains information about the CloudWatch alarm that
+// Amazon Route 53 is monitoring for this health check.
+type CloudWatchAlarmConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	// For the metric that the CloudWatch alarm is associated with, the arithmetic
+	// operation that is used for the comparison.
+	//
+	// ComparisonOperator is a required field
+	ComparisonOperator *string `type:"string" required:"true" enum:"ComparisonOperator"`
+
+	// For the metric that the CloudWatch alarm is associated with, a complex type
+	// that contains information about the dimensions for the metric. For information,
+	// see Amazon CloudWatch Namespaces, Dimensions, and Metrics Reference (https://docs.aws.amazon.com/AmazonCloudWatch/latest/DeveloperGuide/CW_Support_For_AWS.html)
+	// in the Amazon CloudWatch User Guide.
+	Dimensions []*Dimension `locationNameList:"Dimension" type:"list"`
+
+	// For the metric that the CloudWatch alarm is associated with, the number of
+	// periods that the metric is compared to the threshold.
+	//
+	// EvaluationPeriods is a required field
+	EvaluationPeriods *int64 `min:"1" type:"integer" required:"true"`
+
+	// The name of the CloudWatch metric that the alarm is associated with.
+	//
+	// MetricName is a required field
+	MetricName *string `min:"1" type:"string" required:"true"`
+
+	// The namespace of the metric that the alarm is associated with. For more information,
+	// see Amazon CloudWatch Namespaces, Dimensions, and Metrics Reference (https://docs.aws.amazon.com/AmazonCloudWatch/latest/DeveloperGuide/CW_Support_For_AWS.html)
+	// in the Amazon CloudWatch User Guide.
+	//
+	// Namespace is a required field
+	Namespace *string `min:"1" type:"string" required:"true"`
+
+	// For the metric that the CloudWatch alarm is associated with, the duration
+	// of one evaluation period in seconds.
+	//
+	// Period is a required field
+	Period *int64 `min:"60" type:"integer" required:"true"`
+
+	// For the metric that the CloudWatch alarm is associated with, the statistic
+	// that is applied to the metric.
+	//
+	// Statistic is a required field
+	Statistic *string `type:"string" required:"true" enum:"Statistic"`
+
+	// For the metric that the CloudWatch alarm is associated with, the value the
+	// metric is compared with.
+	//
+	// Threshold is a required field
+	Threshold *float64 `type:"double" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CloudWatchAlarmConfiguration) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CloudWatchAlarmConfiguration) GoString() string {
+	return s.String()
+}
+
+// SetComparisonOperator sets the ComparisonOperator field's value.
+func (s *CloudWatchAlarmConfiguration) SetComparisonOperator(v string) *CloudWatchAlarmConfiguration {
+	s.ComparisonOperator = &v
+	return s
+}
+
+// SetDimensions sets the Dimensions field's value.
+func (s *CloudWatchAlarmConfiguration) SetDimensions(v []*Dimension) *CloudWatchAlarmConfiguration {
+	s.Dimensions = v
+	return s
+}
+
+// SetEvaluationPeriods sets the EvaluationPeriods field's value.
+func (s *CloudWatchAlarmConfiguration) SetEvaluationPeriods(v int64) *CloudWatchAlarmConfiguration {
+	s.EvaluationPeriods = &v
+	return s
+}
+
+// SetMetricName sets the MetricName field's value.
+func (s *CloudWatchAlarmConfiguration) SetMetricName(v string) *CloudWatchAlarmConfiguration {
+	s.MetricName = &v
+	return s
+}
+
+// SetNamespace sets the Namespace field's value.
+func (s *CloudWatchAlarmConfiguration) SetNamespace(v string) *CloudWatchAlarmConfiguration {
+	s.Namespace = &v
+	return s
+}
+
+// SetPeriod sets the Period field's value.
+func (s *CloudWatchAlarmConfiguration) SetPeriod(v int64) *CloudWatchAlarmConfiguration {
+	s.Period = &v
+	return s
+}
+
+// SetStatistic sets the Statistic field's value.
+func (s *CloudWatchAlarmConfiguration) SetStatistic(v string) *CloudWatchAlarmConfiguration {
+	s.Statistic = &v
+	return s
+}
+
+// SetThreshold sets the Threshold field's value.
+func (s *CloudWatchAlarmConfiguration) SetThreshold(v float64) *CloudWatchAlarmConfiguration {
+	s.Threshold = &v
+	return s
+}
+
+// A complex type that is an entry in an CidrCollection (https://docs.aws.amazon.com/Route53/latest/APIReference/API_CidrCollection.html)
+// array.
+type CollectionSummary struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the collection summary. Can be used to reference the collection
+	// in IAM policy or cross-account.
+	Arn *string `min:"20" type:"string"`
+
+	// Unique ID for the CIDR collection.
+	Id *string `type:"string"`
+
+	// The name of a CIDR collection.
+	Name *string `min:"1" type:"string"`
+
+	// A sequential counter that Route 53 sets to 1 when you create a CIDR collection
+	// and increments by 1 each time you update settings for the CIDR collection.
+	Version *int64 `min:"1" type:"long"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CollectionSummary) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CollectionSummary) GoString() string {
+	return s.String()
+}
+
+// SetArn sets the Arn field's value.
+func (s *CollectionSummary) SetArn(v string) *CollectionSummary {
+	s.Arn = &v
+	return s
+}
+
+// SetId sets the Id field's value.
+func (s *CollectionSummary) SetId(v string) *CollectionSummary {
+	s.Id = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *CollectionSummary) SetName(v string) *CollectionSummary {
+	s.Name = &v
+	return s
+}
+
+// SetVersion sets the Version field's value.
+func (s *CollectionSummary) SetVersion(v int64) *CollectionSummary {
+	s.Version = &v
+	return s
+}
+
+type CreateCidrCollectionInput struct {
+	_ struct{} `locationName:"CreateCidrCollectionRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+
+	// A client-specific token that allows requests to be securely retried so that
+	// the intended outcome will only occur once, retries receive a similar response,
+	// and there are no additional edge cases to handle.
+	//
+	// CallerReference is a required field
+	CallerReference *string `min:"1" type:"string" required:"true"`
+
+	// A unique identifier for the account that can be used to reference the collection
+	// from other API calls.
+	//
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateCidrCollectionInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateCidrCollectionInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateCidrCollectionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateCidrCollectionInput"}
+	if s.CallerReference == nil {
+		invalidParams.Add(request.NewErrParamRequired("CallerReference"))
+	}
+	if s.CallerReference != nil && len(*s.CallerReference) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CallerReference", 1))
+	}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCallerReference sets the CallerReference field's value.
+func (s *CreateCidrCollectionInput) SetCallerReference(v string) *CreateCidrCollectionInput {
+	s.CallerReference = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *CreateCidrCollectionInput) SetName(v string) *CreateCidrCollectionInput {
+	s.Name = &v
+	return s
+}
+
+type CreateCidrCollectionOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A complex type that contains information about the CIDR collection.
+	Collection *CidrCollection `type:"structure"`
+
+	// A unique URL that represents the location for the CIDR collection.
+	Location *string `location:"header" locationName:"Location" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateCidrCollectionOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateCidrCollectionOutput) GoString() string {
+	return s.String()
+}
+
+// SetCollection sets the Collection field's value.
+func (s *CreateCidrCollectionOutput) SetCollection(v *CidrCollection) *CreateCidrCollectionOutput {
+	s.Collection = v
+	return s
+}
+
+// SetLocation sets the Location field's value.
+func (s *CreateCidrCollectionOutput) SetLocation(v string) *CreateCidrCollectionOutput {
+	s.Location = &v
+	return s
+}
+
+// A complex type that contains the health check request information.
+type CreateHealthCheckInput struct {
+	_ struct{} `locationName:"CreateHealthCheckRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+
+	// A unique string that identifies the request and that allows you to retry
+	// a failed CreateHealthCheck request without the risk of creating two identical
+	// health checks:
+	//
+	//    * If you send a CreateHealthCheck request with the same CallerReference
+	//    and settings as a previous request, and if the health check doesn't exist,
+	//    Amazon Route 53 creates the health check. If the health check does exist,
+	//    Route 53 returns the settings for the existing health check.
+	//
+	//    * If you send a CreateHealthCheck request with the same CallerReference
+	//    as a deleted health check, regardless of the settings, Route 53 returns
+	//    a HealthCheckAlreadyExists error.
+	//
+	//    * If you send a CreateHealthCheck request with the same CallerReference
+	//    as an existing health check but with different settings, Route 53 returns
+	//    a HealthCheckAlreadyExists error.
+	//
+	//    * If you send a CreateHealthCheck request with a unique CallerReference
+	//    but settings identical to an existing health check, Route 53 creates the
+	//    health check.
+	//
+	// Route 53 does not store the CallerReference for a deleted health check indefinitely.
+	// The CallerReference for a deleted health check will be deleted after a number
+	// of days.
+	//
+	// CallerReference is a required field
+	CallerReference *string `min:"1" type:"string" required:"true"`
+
+	// A complex type that contains settings for a new health check.
+	//
+	// HealthCheckConfig is a required field
+	HealthCheckConfig *HealthCheckConfig `type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateHealthCheckInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateHealthCheckInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateHealthCheckInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateHealthCheckInput"}
+	if s.CallerReference == nil {
+		invalidParams.Add(request.NewErrParamRequired("CallerReference"))
+	}
+	if s.CallerReference != nil && len(*s.CallerReference) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CallerReference", 1))
+	}
+	if s.HealthCheckConfig == nil {
+		invalidParams.Add(request.NewErrParamRequired("HealthCheckConfig"))
+	}
+	if s.HealthCheckConfig != nil {
+		if err := s.HealthCheckConfig.Validate(); err != nil {
+			invalidParams.AddNested("HealthCheckConfig", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCallerReference sets the CallerReference field's value.
+func (s *CreateHealthCheckInput) SetCallerReference(v string) *CreateHealthCheckInput {
+	s.CallerReference = &v
+	return s
+}
+
+// SetHealthCheckConfig sets the HealthCheckConfig field's value.
+func (s *CreateHealthCheckInput) SetHealthCheckConfig(v *HealthCheckConfig) *CreateHealthCheckInput {
+	s.HealthCheckConfig = v
+	return s
+}
+
+// A complex type containing the response information for the new health check.
+type CreateHealthCheckOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A complex type that contains identifying information about the health check.
+	//
+	// HealthCheck is a required field
+	HealthCheck *HealthCheck `type:"structure" required:"true"`
+
+	// The unique URL representing the new health check.
+	//
+	// Location is a required field
+	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateHealthCheckOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateHealthCheckOutput) GoString() string {
+	return s.String()
+}
+
+// SetHealthCheck sets the HealthCheck field's value.
+func (s *CreateHealthCheckOutput) SetHealthCheck(v *HealthCheck) *CreateHealthCheckOutput {
+	s.HealthCheck = v
+	return s
+}
+
+// SetLocation sets the Location field's value.
+func (s *CreateHealthCheckOutput) SetLocation(v string) *CreateHealthCheckOutput {
+	s.Location = &v
+	return s
+}
+
+// A complex type that contains information about the request to create a public
+// or private hosted zone.
+type CreateHostedZoneInput struct {
+	_ struct{} `locationName:"CreateHostedZoneRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+
+	// A unique string that identifies the request and that allows failed CreateHostedZone
+	// requests to be retried without the risk of executing the operation twice.
+	// You must use a unique CallerReference string every time you submit a CreateHostedZone
+	// request. CallerReference can be any unique string, for example, a date/time
+	// stamp.
+	//
+	// CallerReference is a required field
+	CallerReference *string `min:"1" type:"string" required:"true"`
+
+	// If you want to associate a reusable delegation set with this hosted zone,
+	// the ID that Amazon Route 53 assigned to the reusable delegation set when
+	// you created it. For more information about reusable delegation sets, see
+	// CreateReusableDelegationSet (https://docs.aws.amazon.com/Route53/latest/APIReference/API_CreateReusableDelegationSet.html).
+	//
+	// If you are using a reusable delegation set to create a public hosted zone
+	// for a subdomain, make sure that the parent hosted zone doesn't use one or
+	// more of the same name servers. If you have overlapping nameservers, the operation
+	// will cause a ConflictingDomainsExist error.
+	DelegationSetId *string `type:"string"`
+
+	// (Optional) A complex type that contains the following optional values:
+	//
+	//    * For public and private hosted zones, an optional comment
+	//
+	//    * For private hosted zones, an optional PrivateZone element
+	//
+	// If you don't specify a comment or the PrivateZone element, omit HostedZoneConfig
+	// and the other elements.
+	HostedZoneConfig *HostedZoneConfig `type:"structure"`
+
+	// The name of the domain. Specify a fully qualified domain name, for example,
+	// www.example.com. The trailing dot is optional; Amazon Route 53 assumes that
+	// the domain name is fully qualified. This means that Route 53 treats www.example.com
+	// (without a trailing dot) and www.example.com. (with a trailing dot) as identical.
+	//
+	// If you're creating a public hosted zone, this is the name you have registered
+	// with your DNS registrar. If your domain name is registered with a registrar
+	// other than Route 53, change the name servers for your domain to the set of
+	// NameServers that CreateHostedZone returns in DelegationSet.
+	//
+	// Name is a required field
+	Name *string `type:"string" required:"true"`
+
+	// (Private hosted zones only) A complex type that contains information about
+	// the Amazon VPC that you're associating with this hosted zone.
+	//
+	// You can specify only one Amazon VPC when you create a private hosted zone.
+	// If you are associating a VPC with a hosted zone with this request, the paramaters
+	// VPCId and VPCRegion are also required.
+	//
+	// To associate additional Amazon VPCs with the hosted zone, use AssociateVPCWithHostedZone
+	// (https://docs.aws.amazon.com/Route53/latest/APIReference/API_AssociateVPCWithHostedZone.html)
+	// after you create a hosted zone.
+	VPC *VPC `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateHostedZoneInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateHostedZoneInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateHostedZoneInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateHostedZoneInput"}
+	if s.CallerReference == nil {
+		invalidParams.Add(request.NewErrParamRequired("CallerReference"))
+	}
+	if s.CallerReference != nil && len(*s.CallerReference) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CallerReference", 1))
+	}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.VPC != nil {
+		if err := s.VPC.Validate(); err != nil {
+			invalidParams.AddNested("VPC", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCallerReference sets the CallerReference field's value.
+func (s *CreateHostedZoneInput) SetCallerReference(v string) *CreateHostedZoneInput {
+	s.CallerReference = &v
+	return s
+}
+
+// SetDelegationSetId sets the DelegationSetId field's value.
+func (s *CreateHostedZoneInput) SetDelegationSetId(v string) *CreateHostedZoneInput {
+	s.DelegationSetId = &v
+	return s
+}
+
+// SetHostedZoneConfig sets the HostedZoneConfig field's value.
+func (s *CreateHostedZoneInput) SetHostedZoneConfig(v *HostedZoneConfig) *CreateHostedZoneInput {
+	s.HostedZoneConfig = v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *CreateHostedZoneInput) SetName(v string) *CreateHostedZoneInput {
+	s.Name = &v
+	return s
+}
+
+// SetVPC sets the VPC field's value.
+func (s *CreateHostedZoneInput) SetVPC(v *VPC) *CreateHostedZoneInput {
+	s.VPC = v
+	return s
+}
+
+// A complex type containing the response information for the hosted zone.
+type CreateHostedZoneOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A complex type that contains information about the CreateHostedZone request.
+	//
+	// ChangeInfo is a required field
+	ChangeInfo *ChangeInfo `type:"structure" required:"true"`
+
+	// A complex type that describes the name servers for this hosted zone.
+	//
+	// DelegationSet is a required field
+	DelegationSet *DelegationSet `type:"structure" required:"true"`
+
+	// A complex type that contains general information about the hosted zone.
+	//
+	// HostedZone is a required field
+	HostedZone *HostedZone `type:"structure" required:"true"`
+
+	// The unique URL representing the new hosted zone.
+	//
+	// Location is a required field
+	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+
+	// A complex type that contains information about an Amazon VPC that you associated
+	// with this hosted zone.
+	VPC *VPC `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateHostedZoneOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateHostedZoneOutput) GoString() string {
+	return s.String()
+}
+
+// SetChangeInfo sets the ChangeInfo field's value.
+func (s *CreateHostedZoneOutput) SetChangeInfo(v *ChangeInfo) *CreateHostedZoneOutput {
+	s.ChangeInfo = v
+	return s
+}
+
+// SetDelegationSet sets the DelegationSet field's value.
+func (s *CreateHostedZoneOutput) SetDelegationSet(v *DelegationSet) *CreateHostedZoneOutput {
+	s.DelegationSet = v
+	return s
+}
+
+// SetHostedZone sets the HostedZone field's value.
+func (s *CreateHostedZoneOutput) SetHostedZone(v *HostedZone) *CreateHostedZoneOutput {
+	s.HostedZone = v
+	return s
+}
+
+// SetLocation sets the Location field's value.
+func (s *CreateHostedZoneOutput) SetLocation(v string) *CreateHostedZoneOutput {
+	s.Location = &v
+	return s
+}
+
+// SetVPC sets the VPC field's value.
+func (s *CreateHostedZoneOutput) SetVPC(v *VPC) *CreateHostedZoneOutput {
+	s.VPC = v
+	return s
+}
+
+type CreateKeySigningKeyInput struct {
+	_ struct{} `locationName:"CreateKeySigningKeyRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+
+	// A unique string that identifies the request.
+	//
+	// CallerReference is a required field
+	CallerReference *string `min:"1" type:"string" required:"true"`
+
+	// The unique string (ID) used to identify a hosted zone.
+	//
+	// HostedZoneId is a required field
+	HostedZoneId *string `type:"string" required:"true"`
+
+	// The Amazon resource name (ARN) for a customer managed key in Key Management
+	// Service (KMS). The KeyManagementServiceArn must be unique for each key-signing
+	// key (KSK) in a single hosted zone. To see an example of KeyManagementServiceArn
+	// that grants the correct permissions for DNSSEC, scroll down to Example.
+	//
+	// You must configure the customer managed customer managed key as follows:
+	//
+	// Status
+	//
+	// Enabled
+	//
+	// Key spec
+	//
+	// ECC_NIST_P256
+	//
+	// Key usage
+	//
+	// Sign and verify
+	//
+	// Key policy
+	//
+	// The key policy must give permission for the following actions:
+	//
+	//    * DescribeKey
+	//
+	//    * GetPublicKey
+	//
+	//    * Sign
+	//
+	// The key policy must also include the Amazon Route 53 service in the principal
+	// for your account. Specify the following:
+	//
+	//    * "Service": "dnssec-route53.amazonaws.com"
+	//
+	// For more information about working with a customer managed key in KMS, see
+	// Key Management Service concepts (https://docs.aws.amazon.com/kms/latest/developerguide/concepts.html).
+	//
+	// KeyManagementServiceArn is a required field
+	KeyManagementServiceArn *string `type:"string" required:"true"`
+
+	// A string used to identify a key-signing key (KSK). Name can include numbers,
+	// letters, and underscores (_). Name must be unique for each key-signing key
+	// in the same hosted zone.
+	//
+	// Name is a required field
+	Name *string `min:"3" type:"string" required:"true"`
+
+	// A string specifying the initial status of the key-signing key (KSK). You
+	// can set the value to ACTIVE or INACTIVE.
+	//
+	// Status is a required field
+	Status *string `min:"5" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateKeySigningKeyInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateKeySigningKeyInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateKeySigningKeyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateKeySigningKeyInput"}
+	if s.CallerReference == nil {
+		invalidParams.Add(request.NewErrParamRequired("CallerReference"))
+	}
+	if s.CallerReference != nil && len(*s.CallerReference) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CallerReference", 1))
+	}
+	if s.HostedZoneId == nil {
+		invalidParams.Add(request.NewErrParamRequired("HostedZoneId"))
+	}
+	if s.KeyManagementServiceArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("KeyManagementServiceArn"))
+	}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 3 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 3))
+	}
+	if s.Status == nil {
+		invalidParams.Add(request.NewErrParamRequired("Status"))
+	}
+	if s.Status != nil && len(*s.Status) < 5 {
+		invalidParams.Add(request.NewErrParamMinLen("Status", 5))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCallerReference sets the CallerReference field's value.
+func (s *CreateKeySigningKeyInput) SetCallerReference(v string) *CreateKeySigningKeyInput {
+	s.CallerReference = &v
+	return s
+}
+
+// SetHostedZoneId sets the HostedZoneId field's value.
+func (s *CreateKeySigningKeyInput) SetHostedZoneId(v string) *CreateKeySigningKeyInput {
+	s.HostedZoneId = &v
+	return s
+}
+
+// SetKeyManagementServiceArn sets the KeyManagementServiceArn field's value.
+func (s *CreateKeySigningKeyInput) SetKeyManagementServiceArn(v string) *CreateKeySigningKeyInput {
+	s.KeyManagementServiceArn = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *CreateKeySigningKeyInput) SetName(v string) *CreateKeySigningKeyInput {
+	s.Name = &v
+	return s
+}
+
+// SetStatus sets the Status field's value.
+func (s *CreateKeySigningKeyInput) SetStatus(v string) *CreateKeySigningKeyInput {
+	s.Status = &v
+	return s
+}
+
+type CreateKeySigningKeyOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A complex type that describes change information about changes made to your
+	// hosted zone.
+	//
+	// ChangeInfo is a required field
+	ChangeInfo *ChangeInfo `type:"structure" required:"true"`
+
+	// The key-signing key (KSK) that the request creates.
+	//
+	// KeySigningKey is a required field
+	KeySigningKey *KeySigningKey `type:"structure" required:"true"`
+
+	// The unique URL representing the new key-signing key (KSK).
+	//
+	// Location is a required field
+	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateKeySigningKeyOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateKeySigningKeyOutput) GoString() string {
+	return s.String()
+}
+
+// SetChangeInfo sets the ChangeInfo field's value.
+func (s *CreateKeySigningKeyOutput) SetChangeInfo(v *ChangeInfo) *CreateKeySigningKeyOutput {
+	s.ChangeInfo = v
+	return s
+}
+
+// SetKeySigningKey sets the KeySigningKey field's value.
+func (s *CreateKeySigningKeyOutput) SetKeySigningKey(v *KeySigningKey) *CreateKeySigningKeyOutput {
+	s.KeySigningKey = v
+	return s
+}
+
+// SetLocation sets the Location field's value.
+func (s *CreateKeySigningKeyOutput) SetLocation(v string) *CreateKeySigningKeyOutput {
+	s.Location = &v
+	return s
+}
+
+type CreateQueryLoggingConfigInput struct {
+	_ struct{} `locationName:"CreateQueryLoggingConfigRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+
+	// The Amazon Resource Name (ARN) for the log group that you want to Amazon
+	// Route 53 to send query logs to. This is the format of the ARN:
+	//
+	// arn:aws:logs:region:account-id:log-group:log_group_name
+	//
+	// To get the ARN for a log group, you can use the CloudWatch console, the DescribeLogGroups
+	// (https://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_DescribeLogGroups.html)
+	// API action, the describe-log-groups (https://docs.aws.amazon.com/cli/latest/reference/logs/describe-log-groups.html)
+	// command, or the applicable command in one of the Amazon Web Services SDKs.
+	//
+	// CloudWatchLogsLogGroupArn is a required field
+	CloudWatchLogsLogGroupArn *string `type:"string" required:"true"`
+
+	// The ID of the hosted zone that you want to log queries for. You can log queries
+	// only for public hosted zones.
+	//
+	// HostedZoneId is a required field
+	HostedZoneId *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateQueryLoggingConfigInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateQueryLoggingConfigInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateQueryLoggingConfigInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateQueryLoggingConfigInput"}
+	if s.CloudWatchLogsLogGroupArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("CloudWatchLogsLogGroupArn"))
+	}
+	if s.HostedZoneId == nil {
+		invalidParams.Add(request.NewErrParamRequired("HostedZoneId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCloudWatchLogsLogGroupArn sets the CloudWatchLogsLogGroupArn field's value.
+func (s *CreateQueryLoggingConfigInput) SetCloudWatchLogsLogGroupArn(v string) *CreateQueryLoggingConfigInput {
+	s.CloudWatchLogsLogGroupArn = &v
+	return s
+}
+
+// SetHostedZoneId sets the HostedZoneId field's value.
+func (s *CreateQueryLoggingConfigInput) SetHostedZoneId(v string) *CreateQueryLoggingConfigInput {
+	s.HostedZoneId = &v
+	return s
+}
+
+type CreateQueryLoggingConfigOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The unique URL representing the new query logging configuration.
+	//
+	// Location is a required field
+	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+
+	// A complex type that contains the ID for a query logging configuration, the
+	// ID of the hosted zone that you want to log queries for, and the ARN for the
+	// log group that you want Amazon Route 53 to send query logs to.
+	//
+	// QueryLoggingConfig is a required field
+	QueryLoggingConfig *QueryLoggingConfig `type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateQueryLoggingConfigOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateQueryLoggingConfigOutput) GoString() string {
+	return s.String()
+}
+
+// SetLocation sets the Location field's value.
+func (s *CreateQueryLoggingConfigOutput) SetLocation(v string) *CreateQueryLoggingConfigOutput {
+	s.Location = &v
+	return s
+}
+
+// SetQueryLoggingConfig sets the QueryLoggingConfig field's value.
+func (s *CreateQueryLoggingConfigOutput) SetQueryLoggingConfig(v *QueryLoggingConfig) *CreateQueryLoggingConfigOutput {
+	s.QueryLoggingConfig = v
+	return s
+}
+
+type CreateReusableDelegationSetInput struct {
+	_ struct{} `locationName:"CreateReusableDelegationSetRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+
+	// A unique string that identifies the request, and that allows you to retry
+	// failed CreateReusableDelegationSet requests without the risk of executing
+	// the operation twice. You must use a unique CallerReference string every time
+	// you submit a CreateReusableDelegationSet request. CallerReference can be
+	// any unique string, for example a date/time stamp.
+	//
+	// CallerReference is a required field
+	CallerReference *string `min:"1" type:"string" required:"true"`
+
+	// If you want to mark the delegation set for an existing hosted zone as reusable,
+	// the ID for that hosted zone.
+	HostedZoneId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateReusableDelegationSetInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateReusableDelegationSetInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateReusableDelegationSetInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateReusableDelegationSetInput"}
+	if s.CallerReference == nil {
+		invalidParams.Add(request.NewErrParamRequired("CallerReference"))
+	}
+	if s.CallerReference != nil && len(*s.CallerReference) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CallerReference", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCallerReference sets the CallerReference field's value.
+func (s *CreateReusableDelegationSetInput) SetCallerReference(v string) *CreateReusableDelegationSetInput {
+	s.CallerReference = &v
+	return s
+}
+
+// SetHostedZoneId sets the HostedZoneId field's value.
+func (s *CreateReusableDelegationSetInput) SetHostedZoneId(v string) *CreateReusableDelegationSetInput {
+	s.HostedZoneId = &v
+	return s
+}
+
+type CreateReusableDelegationSetOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A complex type that contains name server information.
+	//
+	// DelegationSet is a required field
+	DelegationSet *DelegationSet `type:"structure" required:"true"`
+
+	// The unique URL representing the new reusable delegation set.
+	//
+	// Location is a required field
+	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateReusableDelegationSetOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateReusableDelegationSetOutput) GoString() string {
+	return s.String()
+}
+
+// SetDelegationSet sets the DelegationSet field's value.
+func (s *CreateReusableDelegationSetOutput) SetDelegationSet(v *DelegationSet) *CreateReusableDelegationSetOutput {
+	s.DelegationSet = v
+	return s
+}
+
+// SetLocation sets the Location field's value.
+func (s *CreateReusableDelegationSetOutput) SetLocation(v string) *CreateReusableDelegationSetOutput {
+	s.Location = &v
+	return s
+}
+
+// A complex type that contains information about the traffic policy that you
+// want to create.
+type CreateTrafficPolicyInput struct {
+	_ struct{} `locationName:"CreateTrafficPolicyRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+
+	// (Optional) Any comments that you want to include about the traffic policy.
+	Comment *string `type:"string"`
+
+	// The definition of this traffic policy in JSON format. For more information,
+	// see Traffic Policy Document Format (https://docs.aws.amazon.com/Route53/latest/APIReference/api-policies-traffic-policy-document-format.html).
+	//
+	// Document is a required field
+	Document *string `type:"string" required:"true"`
+
+	// The name of the traffic policy.
+	//
+	// Name is a required field
+	Name *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTrafficPolicyInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTrafficPolicyInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateTrafficPolicyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateTrafficPolicyInput"}
+	if s.Document == nil {
+		invalidParams.Add(request.NewErrParamRequired("Document"))
+	}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetComment sets the Comment field's value.
+func (s *CreateTrafficPolicyInput) SetComment(v string) *CreateTrafficPolicyInput {
+	s.Comment = &v
+	return s
+}
+
+// SetDocument sets the Document field's value.
+func (s *CreateTrafficPolicyInput) SetDocument(v string) *CreateTrafficPolicyInput {
+	s.Document = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *CreateTrafficPolicyInput) SetName(v string) *CreateTrafficPolicyInput {
+	s.Name = &v
+	return s
+}
+
+// A complex type that contains information about the resource record sets that
+// you want to create based on a specified traffic policy.
+type CreateTrafficPolicyInstanceInput struct {
+	_ struct{} `locationName:"CreateTrafficPolicyInstanceRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+
+	// The ID of the hosted zone that you want Amazon Route 53 to create resource
+	// record sets in by using the configuration in a traffic policy.
+	//
+	// HostedZoneId is a required field
+	HostedZoneId *string `type:"string" required:"true"`
+
+	// The domain name (such as example.com) or subdomain name (such as www.example.com)
+	// for which Amazon Route 53 responds to DNS queries by using the resource record
+	// sets that Route 53 creates for this traffic policy instance.
 	//
-	// Specify the hosted zone ID for the region that you created the bucket in.
-	// For more information about valid values, see the Amazon Simple Storage Service
-	// Website Endpoints (http://docs.aws.amazon.com/general/latest/gr/rande.html#s3_region)
-	// table in the "AWS Regions and Endpoints" chapter of the Amazon Web Services
-	// General Reference.
+	// Name is a required field
+	Name *string `type:"string" required:"true"`
+
+	// (Optional) The TTL that you want Amazon Route 53 to assign to all of the
+	// resource record sets that it creates in the specified hosted zone.
 	//
-	// Another Route 53 resource record set in your hosted zone
+	// TTL is a required field
+	TTL *int64 `type:"long" required:"true"`
+
+	// The ID of the traffic policy that you want to use to create resource record
+	// sets in the specified hosted zone.
 	//
-	// Specify the hosted zone ID of your hosted zone. (An alias resource record
-	// set can't reference a resource record set in a different hosted zone.)
+	// TrafficPolicyId is a required field
+	TrafficPolicyId *string `min:"1" type:"string" required:"true"`
+
+	// The version of the traffic policy that you want to use to create resource
+	// record sets in the specified hosted zone.
 	//
-	// HostedZoneId is a required field
-	HostedZoneId *string `type:"string" required:"true"`
+	// TrafficPolicyVersion is a required field
+	TrafficPolicyVersion *int64 `min:"1" type:"integer" required:"true"`
 }
 
-// String returns the string representation
-func (s AliasTarget) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTrafficPolicyInstanceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s AliasTarget) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTrafficPolicyInstanceInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *AliasTarget) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "AliasTarget"}
-	if s.DNSName == nil {
-		invalidParams.Add(request.NewErrParamRequired("DNSName"))
-	}
-	if s.EvaluateTargetHealth == nil {
-		invalidParams.Add(request.NewErrParamRequired("EvaluateTargetHealth"))
-	}
+func (s *CreateTrafficPolicyInstanceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateTrafficPolicyInstanceInput"}
 	if s.HostedZoneId == nil {
 		invalidParams.Add(request.NewErrParamRequired("HostedZoneId"))
 	}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.TTL == nil {
+		invalidParams.Add(request.NewErrParamRequired("TTL"))
+	}
+	if s.TrafficPolicyId == nil {
+		invalidParams.Add(request.NewErrParamRequired("TrafficPolicyId"))
+	}
+	if s.TrafficPolicyId != nil && len(*s.TrafficPolicyId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("TrafficPolicyId", 1))
+	}
+	if s.TrafficPolicyVersion == nil {
+		invalidParams.Add(request.NewErrParamRequired("TrafficPolicyVersion"))
+	}
+	if s.TrafficPolicyVersion != nil && *s.TrafficPolicyVersion < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("TrafficPolicyVersion", 1))
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -6283,74 +10373,179 @@ func (s *AliasTarget) Validate() error {
 	return nil
 }
 
-// SetDNSName sets the DNSName field's value.
-func (s *AliasTarget) SetDNSName(v string) *AliasTarget {
-	s.DNSName = &v
+// SetHostedZoneId sets the HostedZoneId field's value.
+func (s *CreateTrafficPolicyInstanceInput) SetHostedZoneId(v string) *CreateTrafficPolicyInstanceInput {
+	s.HostedZoneId = &v
 	return s
 }
 
-// SetEvaluateTargetHealth sets the EvaluateTargetHealth field's value.
-func (s *AliasTarget) SetEvaluateTargetHealth(v bool) *AliasTarget {
-	s.EvaluateTargetHealth = &v
+// SetName sets the Name field's value.
+func (s *CreateTrafficPolicyInstanceInput) SetName(v string) *CreateTrafficPolicyInstanceInput {
+	s.Name = &v
 	return s
 }
 
-// SetHostedZoneId sets the HostedZoneId field's value.
-func (s *AliasTarget) SetHostedZoneId(v string) *AliasTarget {
-	s.HostedZoneId = &v
+// SetTTL sets the TTL field's value.
+func (s *CreateTrafficPolicyInstanceInput) SetTTL(v int64) *CreateTrafficPolicyInstanceInput {
+	s.TTL = &v
 	return s
 }
 
-// A complex type that contains information about the request to associate a
-// VPC with a private hosted zone.
-type AssociateVPCWithHostedZoneInput struct {
-	_ struct{} `locationName:"AssociateVPCWithHostedZoneRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+// SetTrafficPolicyId sets the TrafficPolicyId field's value.
+func (s *CreateTrafficPolicyInstanceInput) SetTrafficPolicyId(v string) *CreateTrafficPolicyInstanceInput {
+	s.TrafficPolicyId = &v
+	return s
+}
 
-	// Optional: A comment about the association request.
-	Comment *string `type:"string"`
+// SetTrafficPolicyVersion sets the TrafficPolicyVersion field's value.
+func (s *CreateTrafficPolicyInstanceInput) SetTrafficPolicyVersion(v int64) *CreateTrafficPolicyInstanceInput {
+	s.TrafficPolicyVersion = &v
+	return s
+}
 
-	// The ID of the private hosted zone that you want to associate an Amazon VPC
-	// with.
+// A complex type that contains the response information for the CreateTrafficPolicyInstance
+// request.
+type CreateTrafficPolicyInstanceOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A unique URL that represents a new traffic policy instance.
 	//
-	// Note that you can't associate a VPC with a hosted zone that doesn't have
-	// an existing VPC association.
+	// Location is a required field
+	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+
+	// A complex type that contains settings for the new traffic policy instance.
 	//
-	// HostedZoneId is a required field
-	HostedZoneId *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	// TrafficPolicyInstance is a required field
+	TrafficPolicyInstance *TrafficPolicyInstance `type:"structure" required:"true"`
+}
 
-	// A complex type that contains information about the VPC that you want to associate
-	// with a private hosted zone.
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTrafficPolicyInstanceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTrafficPolicyInstanceOutput) GoString() string {
+	return s.String()
+}
+
+// SetLocation sets the Location field's value.
+func (s *CreateTrafficPolicyInstanceOutput) SetLocation(v string) *CreateTrafficPolicyInstanceOutput {
+	s.Location = &v
+	return s
+}
+
+// SetTrafficPolicyInstance sets the TrafficPolicyInstance field's value.
+func (s *CreateTrafficPolicyInstanceOutput) SetTrafficPolicyInstance(v *TrafficPolicyInstance) *CreateTrafficPolicyInstanceOutput {
+	s.TrafficPolicyInstance = v
+	return s
+}
+
+// A complex type that contains the response information for the CreateTrafficPolicy
+// request.
+type CreateTrafficPolicyOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A unique URL that represents a new traffic policy.
 	//
-	// VPC is a required field
-	VPC *VPC `type:"structure" required:"true"`
+	// Location is a required field
+	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+
+	// A complex type that contains settings for the new traffic policy.
+	//
+	// TrafficPolicy is a required field
+	TrafficPolicy *TrafficPolicy `type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s AssociateVPCWithHostedZoneInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTrafficPolicyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s AssociateVPCWithHostedZoneInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTrafficPolicyOutput) GoString() string {
+	return s.String()
+}
+
+// SetLocation sets the Location field's value.
+func (s *CreateTrafficPolicyOutput) SetLocation(v string) *CreateTrafficPolicyOutput {
+	s.Location = &v
+	return s
+}
+
+// SetTrafficPolicy sets the TrafficPolicy field's value.
+func (s *CreateTrafficPolicyOutput) SetTrafficPolicy(v *TrafficPolicy) *CreateTrafficPolicyOutput {
+	s.TrafficPolicy = v
+	return s
+}
+
+// A complex type that contains information about the traffic policy that you
+// want to create a new version for.
+type CreateTrafficPolicyVersionInput struct {
+	_ struct{} `locationName:"CreateTrafficPolicyVersionRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+
+	// The comment that you specified in the CreateTrafficPolicyVersion request,
+	// if any.
+	Comment *string `type:"string"`
+
+	// The definition of this version of the traffic policy, in JSON format. You
+	// specified the JSON in the CreateTrafficPolicyVersion request. For more information
+	// about the JSON format, see CreateTrafficPolicy (https://docs.aws.amazon.com/Route53/latest/APIReference/API_CreateTrafficPolicy.html).
+	//
+	// Document is a required field
+	Document *string `type:"string" required:"true"`
+
+	// The ID of the traffic policy for which you want to create a new version.
+	//
+	// Id is a required field
+	Id *string `location:"uri" locationName:"Id" min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTrafficPolicyVersionInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTrafficPolicyVersionInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *AssociateVPCWithHostedZoneInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "AssociateVPCWithHostedZoneInput"}
-	if s.HostedZoneId == nil {
-		invalidParams.Add(request.NewErrParamRequired("HostedZoneId"))
-	}
-	if s.HostedZoneId != nil && len(*s.HostedZoneId) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("HostedZoneId", 1))
+func (s *CreateTrafficPolicyVersionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateTrafficPolicyVersionInput"}
+	if s.Document == nil {
+		invalidParams.Add(request.NewErrParamRequired("Document"))
 	}
-	if s.VPC == nil {
-		invalidParams.Add(request.NewErrParamRequired("VPC"))
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
 	}
-	if s.VPC != nil {
-		if err := s.VPC.Validate(); err != nil {
-			invalidParams.AddNested("VPC", err.(request.ErrInvalidParams))
-		}
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -6360,101 +10555,122 @@ func (s *AssociateVPCWithHostedZoneInput) Validate() error {
 }
 
 // SetComment sets the Comment field's value.
-func (s *AssociateVPCWithHostedZoneInput) SetComment(v string) *AssociateVPCWithHostedZoneInput {
+func (s *CreateTrafficPolicyVersionInput) SetComment(v string) *CreateTrafficPolicyVersionInput {
 	s.Comment = &v
 	return s
 }
 
-// SetHostedZoneId sets the HostedZoneId field's value.
-func (s *AssociateVPCWithHostedZoneInput) SetHostedZoneId(v string) *AssociateVPCWithHostedZoneInput {
-	s.HostedZoneId = &v
+// SetDocument sets the Document field's value.
+func (s *CreateTrafficPolicyVersionInput) SetDocument(v string) *CreateTrafficPolicyVersionInput {
+	s.Document = &v
 	return s
 }
 
-// SetVPC sets the VPC field's value.
-func (s *AssociateVPCWithHostedZoneInput) SetVPC(v *VPC) *AssociateVPCWithHostedZoneInput {
-	s.VPC = v
+// SetId sets the Id field's value.
+func (s *CreateTrafficPolicyVersionInput) SetId(v string) *CreateTrafficPolicyVersionInput {
+	s.Id = &v
 	return s
 }
 
-// A complex type that contains the response information for the AssociateVPCWithHostedZone
+// A complex type that contains the response information for the CreateTrafficPolicyVersion
 // request.
-type AssociateVPCWithHostedZoneOutput struct {
+type CreateTrafficPolicyVersionOutput struct {
 	_ struct{} `type:"structure"`
 
-	// A complex type that describes the changes made to your hosted zone.
+	// A unique URL that represents a new traffic policy version.
 	//
-	// ChangeInfo is a required field
-	ChangeInfo *ChangeInfo `type:"structure" required:"true"`
+	// Location is a required field
+	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+
+	// A complex type that contains settings for the new version of the traffic
+	// policy.
+	//
+	// TrafficPolicy is a required field
+	TrafficPolicy *TrafficPolicy `type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s AssociateVPCWithHostedZoneOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTrafficPolicyVersionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s AssociateVPCWithHostedZoneOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateTrafficPolicyVersionOutput) GoString() string {
 	return s.String()
 }
 
-// SetChangeInfo sets the ChangeInfo field's value.
-func (s *AssociateVPCWithHostedZoneOutput) SetChangeInfo(v *ChangeInfo) *AssociateVPCWithHostedZoneOutput {
-	s.ChangeInfo = v
+// SetLocation sets the Location field's value.
+func (s *CreateTrafficPolicyVersionOutput) SetLocation(v string) *CreateTrafficPolicyVersionOutput {
+	s.Location = &v
 	return s
 }
 
-// The information for each resource record set that you want to change.
-type Change struct {
-	_ struct{} `type:"structure"`
+// SetTrafficPolicy sets the TrafficPolicy field's value.
+func (s *CreateTrafficPolicyVersionOutput) SetTrafficPolicy(v *TrafficPolicy) *CreateTrafficPolicyVersionOutput {
+	s.TrafficPolicy = v
+	return s
+}
 
-	// The action to perform:
-	//
-	//    * CREATE: Creates a resource record set that has the specified values.
-	//
-	//    * DELETE: Deletes a existing resource record set. To delete the resource
-	//    record set that is associated with a traffic policy instance, use DeleteTrafficPolicyInstance
-	//    (https://docs.aws.amazon.com/Route53/latest/APIReference/API_DeleteTrafficPolicyInstance.html).
-	//    Amazon Route 53 will delete the resource record set automatically. If
-	//    you delete the resource record set by using ChangeResourceRecordSets,
-	//    Route 53 doesn't automatically delete the traffic policy instance, and
-	//    you'll continue to be charged for it even though it's no longer in use.
-	//
-	//    * UPSERT: If a resource record set doesn't already exist, Route 53 creates
-	//    it. If a resource record set does exist, Route 53 updates it with the
-	//    values in the request.
+// A complex type that contains information about the request to authorize associating
+// a VPC with your private hosted zone. Authorization is only required when
+// a private hosted zone and a VPC were created by using different accounts.
+type CreateVPCAssociationAuthorizationInput struct {
+	_ struct{} `locationName:"CreateVPCAssociationAuthorizationRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+
+	// The ID of the private hosted zone that you want to authorize associating
+	// a VPC with.
 	//
-	// Action is a required field
-	Action *string `type:"string" required:"true" enum:"ChangeAction"`
+	// HostedZoneId is a required field
+	HostedZoneId *string `location:"uri" locationName:"Id" type:"string" required:"true"`
 
-	// Information about the resource record set to create, delete, or update.
+	// A complex type that contains the VPC ID and region for the VPC that you want
+	// to authorize associating with your hosted zone.
 	//
-	// ResourceRecordSet is a required field
-	ResourceRecordSet *ResourceRecordSet `type:"structure" required:"true"`
+	// VPC is a required field
+	VPC *VPC `type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s Change) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVPCAssociationAuthorizationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Change) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVPCAssociationAuthorizationInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *Change) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "Change"}
-	if s.Action == nil {
-		invalidParams.Add(request.NewErrParamRequired("Action"))
+func (s *CreateVPCAssociationAuthorizationInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateVPCAssociationAuthorizationInput"}
+	if s.HostedZoneId == nil {
+		invalidParams.Add(request.NewErrParamRequired("HostedZoneId"))
 	}
-	if s.ResourceRecordSet == nil {
-		invalidParams.Add(request.NewErrParamRequired("ResourceRecordSet"))
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("HostedZoneId", 1))
 	}
-	if s.ResourceRecordSet != nil {
-		if err := s.ResourceRecordSet.Validate(); err != nil {
-			invalidParams.AddNested("ResourceRecordSet", err.(request.ErrInvalidParams))
+	if s.VPC == nil {
+		invalidParams.Add(request.NewErrParamRequired("VPC"))
+	}
+	if s.VPC != nil {
+		if err := s.VPC.Validate(); err != nil {
+			invalidParams.AddNested("VPC", err.(request.ErrInvalidParams))
 		}
 	}
 
@@ -6464,187 +10680,180 @@ func (s *Change) Validate() error {
 	return nil
 }
 
-// SetAction sets the Action field's value.
-func (s *Change) SetAction(v string) *Change {
-	s.Action = &v
+// SetHostedZoneId sets the HostedZoneId field's value.
+func (s *CreateVPCAssociationAuthorizationInput) SetHostedZoneId(v string) *CreateVPCAssociationAuthorizationInput {
+	s.HostedZoneId = &v
 	return s
 }
 
-// SetResourceRecordSet sets the ResourceRecordSet field's value.
-func (s *Change) SetResourceRecordSet(v *ResourceRecordSet) *Change {
-	s.ResourceRecordSet = v
+// SetVPC sets the VPC field's value.
+func (s *CreateVPCAssociationAuthorizationInput) SetVPC(v *VPC) *CreateVPCAssociationAuthorizationInput {
+	s.VPC = v
 	return s
 }
 
-// The information for a change request.
-type ChangeBatch struct {
+// A complex type that contains the response information from a CreateVPCAssociationAuthorization
+// request.
+type CreateVPCAssociationAuthorizationOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Information about the changes to make to the record sets.
+	// The ID of the hosted zone that you authorized associating a VPC with.
 	//
-	// Changes is a required field
-	Changes []*Change `locationNameList:"Change" min:"1" type:"list" required:"true"`
+	// HostedZoneId is a required field
+	HostedZoneId *string `type:"string" required:"true"`
 
-	// Optional: Any comments you want to include about a change batch request.
-	Comment *string `type:"string"`
+	// The VPC that you authorized associating with a hosted zone.
+	//
+	// VPC is a required field
+	VPC *VPC `type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s ChangeBatch) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVPCAssociationAuthorizationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ChangeBatch) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateVPCAssociationAuthorizationOutput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *ChangeBatch) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ChangeBatch"}
-	if s.Changes == nil {
-		invalidParams.Add(request.NewErrParamRequired("Changes"))
-	}
-	if s.Changes != nil && len(s.Changes) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Changes", 1))
-	}
-	if s.Changes != nil {
-		for i, v := range s.Changes {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Changes", i), err.(request.ErrInvalidParams))
-			}
-		}
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
-// SetChanges sets the Changes field's value.
-func (s *ChangeBatch) SetChanges(v []*Change) *ChangeBatch {
-	s.Changes = v
+// SetHostedZoneId sets the HostedZoneId field's value.
+func (s *CreateVPCAssociationAuthorizationOutput) SetHostedZoneId(v string) *CreateVPCAssociationAuthorizationOutput {
+	s.HostedZoneId = &v
 	return s
 }
 
-// SetComment sets the Comment field's value.
-func (s *ChangeBatch) SetComment(v string) *ChangeBatch {
-	s.Comment = &v
+// SetVPC sets the VPC field's value.
+func (s *CreateVPCAssociationAuthorizationOutput) SetVPC(v *VPC) *CreateVPCAssociationAuthorizationOutput {
+	s.VPC = v
 	return s
 }
 
-// A complex type that describes change information about changes made to your
-// hosted zone.
-type ChangeInfo struct {
+// A string repesenting the status of DNSSEC signing.
+type DNSSECStatus struct {
 	_ struct{} `type:"structure"`
 
-	// A complex type that describes change information about changes made to your
-	// hosted zone.
+	// A string that represents the current hosted zone signing status.
 	//
-	// This element contains an ID that you use when performing a GetChange (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetChange.html)
-	// action to get detailed information about the change.
-	Comment *string `type:"string"`
-
-	// The ID of the request.
+	// Status can have one of the following values:
 	//
-	// Id is a required field
-	Id *string `type:"string" required:"true"`
-
-	// The current state of the request. PENDING indicates that this request has
-	// not yet been applied to all Amazon Route 53 DNS servers.
+	// SIGNING
 	//
-	// Status is a required field
-	Status *string `type:"string" required:"true" enum:"ChangeStatus"`
-
-	// The date and time that the change request was submitted in ISO 8601 format
-	// (https://en.wikipedia.org/wiki/ISO_8601) and Coordinated Universal Time (UTC).
-	// For example, the value 2017-03-27T17:48:16.751Z represents March 27, 2017
-	// at 17:48:16.751 UTC.
+	// DNSSEC signing is enabled for the hosted zone.
 	//
-	// SubmittedAt is a required field
-	SubmittedAt *time.Time `type:"timestamp" required:"true"`
+	// NOT_SIGNING
+	//
+	// DNSSEC signing is not enabled for the hosted zone.
+	//
+	// DELETING
+	//
+	// DNSSEC signing is in the process of being removed for the hosted zone.
+	//
+	// ACTION_NEEDED
+	//
+	// There is a problem with signing in the hosted zone that requires you to take
+	// action to resolve. For example, the customer managed key might have been
+	// deleted, or the permissions for the customer managed key might have been
+	// changed.
+	//
+	// INTERNAL_FAILURE
+	//
+	// There was an error during a request. Before you can continue to work with
+	// DNSSEC signing, including with key-signing keys (KSKs), you must correct
+	// the problem by enabling or disabling DNSSEC signing for the hosted zone.
+	ServeSignature *string `min:"1" type:"string"`
+
+	// The status message provided for the following DNSSEC signing status: INTERNAL_FAILURE.
+	// The status message includes information about what the problem might be and
+	// steps that you can take to correct the issue.
+	StatusMessage *string `type:"string"`
 }
 
-// String returns the string representation
-func (s ChangeInfo) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DNSSECStatus) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ChangeInfo) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DNSSECStatus) GoString() string {
 	return s.String()
 }
 
-// SetComment sets the Comment field's value.
-func (s *ChangeInfo) SetComment(v string) *ChangeInfo {
-	s.Comment = &v
-	return s
-}
-
-// SetId sets the Id field's value.
-func (s *ChangeInfo) SetId(v string) *ChangeInfo {
-	s.Id = &v
-	return s
-}
-
-// SetStatus sets the Status field's value.
-func (s *ChangeInfo) SetStatus(v string) *ChangeInfo {
-	s.Status = &v
+// SetServeSignature sets the ServeSignature field's value.
+func (s *DNSSECStatus) SetServeSignature(v string) *DNSSECStatus {
+	s.ServeSignature = &v
 	return s
 }
 
-// SetSubmittedAt sets the SubmittedAt field's value.
-func (s *ChangeInfo) SetSubmittedAt(v time.Time) *ChangeInfo {
-	s.SubmittedAt = &v
+// SetStatusMessage sets the StatusMessage field's value.
+func (s *DNSSECStatus) SetStatusMessage(v string) *DNSSECStatus {
+	s.StatusMessage = &v
 	return s
 }
 
-// A complex type that contains change information for the resource record set.
-type ChangeResourceRecordSetsInput struct {
-	_ struct{} `locationName:"ChangeResourceRecordSetsRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+type DeactivateKeySigningKeyInput struct {
+	_ struct{} `locationName:"DeactivateKeySigningKeyRequest" type:"structure"`
 
-	// A complex type that contains an optional comment and the Changes element.
+	// A unique string used to identify a hosted zone.
 	//
-	// ChangeBatch is a required field
-	ChangeBatch *ChangeBatch `type:"structure" required:"true"`
+	// HostedZoneId is a required field
+	HostedZoneId *string `location:"uri" locationName:"HostedZoneId" type:"string" required:"true"`
 
-	// The ID of the hosted zone that contains the resource record sets that you
-	// want to change.
+	// A string used to identify a key-signing key (KSK).
 	//
-	// HostedZoneId is a required field
-	HostedZoneId *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	// Name is a required field
+	Name *string `location:"uri" locationName:"Name" min:"3" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s ChangeResourceRecordSetsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeactivateKeySigningKeyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ChangeResourceRecordSetsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeactivateKeySigningKeyInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ChangeResourceRecordSetsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ChangeResourceRecordSetsInput"}
-	if s.ChangeBatch == nil {
-		invalidParams.Add(request.NewErrParamRequired("ChangeBatch"))
-	}
+func (s *DeactivateKeySigningKeyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeactivateKeySigningKeyInput"}
 	if s.HostedZoneId == nil {
 		invalidParams.Add(request.NewErrParamRequired("HostedZoneId"))
 	}
 	if s.HostedZoneId != nil && len(*s.HostedZoneId) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("HostedZoneId", 1))
 	}
-	if s.ChangeBatch != nil {
-		if err := s.ChangeBatch.Validate(); err != nil {
-			invalidParams.AddNested("ChangeBatch", err.(request.ErrInvalidParams))
-		}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+	if s.Name != nil && len(*s.Name) < 3 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 3))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -6653,109 +10862,142 @@ func (s *ChangeResourceRecordSetsInput) Validate() error {
 	return nil
 }
 
-// SetChangeBatch sets the ChangeBatch field's value.
-func (s *ChangeResourceRecordSetsInput) SetChangeBatch(v *ChangeBatch) *ChangeResourceRecordSetsInput {
-	s.ChangeBatch = v
+// SetHostedZoneId sets the HostedZoneId field's value.
+func (s *DeactivateKeySigningKeyInput) SetHostedZoneId(v string) *DeactivateKeySigningKeyInput {
+	s.HostedZoneId = &v
 	return s
 }
 
-// SetHostedZoneId sets the HostedZoneId field's value.
-func (s *ChangeResourceRecordSetsInput) SetHostedZoneId(v string) *ChangeResourceRecordSetsInput {
-	s.HostedZoneId = &v
+// SetName sets the Name field's value.
+func (s *DeactivateKeySigningKeyInput) SetName(v string) *DeactivateKeySigningKeyInput {
+	s.Name = &v
 	return s
 }
 
-// A complex type containing the response for the request.
-type ChangeResourceRecordSetsOutput struct {
+type DeactivateKeySigningKeyOutput struct {
 	_ struct{} `type:"structure"`
 
-	// A complex type that contains information about changes made to your hosted
-	// zone.
-	//
-	// This element contains an ID that you use when performing a GetChange (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetChange.html)
-	// action to get detailed information about the change.
+	// A complex type that describes change information about changes made to your
+	// hosted zone.
 	//
 	// ChangeInfo is a required field
 	ChangeInfo *ChangeInfo `type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s ChangeResourceRecordSetsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeactivateKeySigningKeyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ChangeResourceRecordSetsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeactivateKeySigningKeyOutput) GoString() string {
 	return s.String()
 }
 
 // SetChangeInfo sets the ChangeInfo field's value.
-func (s *ChangeResourceRecordSetsOutput) SetChangeInfo(v *ChangeInfo) *ChangeResourceRecordSetsOutput {
+func (s *DeactivateKeySigningKeyOutput) SetChangeInfo(v *ChangeInfo) *DeactivateKeySigningKeyOutput {
 	s.ChangeInfo = v
 	return s
 }
 
-// A complex type that contains information about the tags that you want to
-// add, edit, or delete.
-type ChangeTagsForResourceInput struct {
-	_ struct{} `locationName:"ChangeTagsForResourceRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+// A complex type that lists the name servers in a delegation set, as well as
+// the CallerReference and the ID for the delegation set.
+type DelegationSet struct {
+	_ struct{} `type:"structure"`
 
-	// A complex type that contains a list of the tags that you want to add to the
-	// specified health check or hosted zone and/or the tags that you want to edit
-	// Value for.
-	//
-	// You can add a maximum of 10 tags to a health check or a hosted zone.
-	AddTags []*Tag `locationNameList:"Tag" min:"1" type:"list"`
+	// The value that you specified for CallerReference when you created the reusable
+	// delegation set.
+	CallerReference *string `min:"1" type:"string"`
 
-	// A complex type that contains a list of the tags that you want to delete from
-	// the specified health check or hosted zone. You can specify up to 10 keys.
-	RemoveTagKeys []*string `locationNameList:"Key" min:"1" type:"list"`
+	// The ID that Amazon Route 53 assigns to a reusable delegation set.
+	Id *string `type:"string"`
 
-	// The ID of the resource for which you want to add, change, or delete tags.
+	// A complex type that contains a list of the authoritative name servers for
+	// a hosted zone or for a reusable delegation set.
 	//
-	// ResourceId is a required field
-	ResourceId *string `location:"uri" locationName:"ResourceId" type:"string" required:"true"`
+	// NameServers is a required field
+	NameServers []*string `locationNameList:"NameServer" min:"1" type:"list" required:"true"`
+}
 
-	// The type of the resource.
-	//
-	//    * The resource type for health checks is healthcheck.
-	//
-	//    * The resource type for hosted zones is hostedzone.
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DelegationSet) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DelegationSet) GoString() string {
+	return s.String()
+}
+
+// SetCallerReference sets the CallerReference field's value.
+func (s *DelegationSet) SetCallerReference(v string) *DelegationSet {
+	s.CallerReference = &v
+	return s
+}
+
+// SetId sets the Id field's value.
+func (s *DelegationSet) SetId(v string) *DelegationSet {
+	s.Id = &v
+	return s
+}
+
+// SetNameServers sets the NameServers field's value.
+func (s *DelegationSet) SetNameServers(v []*string) *DelegationSet {
+	s.NameServers = v
+	return s
+}
+
+type DeleteCidrCollectionInput struct {
+	_ struct{} `locationName:"DeleteCidrCollectionRequest" type:"structure"`
+
+	// The UUID of the collection to delete.
 	//
-	// ResourceType is a required field
-	ResourceType *string `location:"uri" locationName:"ResourceType" type:"string" required:"true" enum:"TagResourceType"`
+	// Id is a required field
+	Id *string `location:"uri" locationName:"CidrCollectionId" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s ChangeTagsForResourceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteCidrCollectionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ChangeTagsForResourceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteCidrCollectionInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *ChangeTagsForResourceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ChangeTagsForResourceInput"}
-	if s.AddTags != nil && len(s.AddTags) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("AddTags", 1))
-	}
-	if s.RemoveTagKeys != nil && len(s.RemoveTagKeys) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("RemoveTagKeys", 1))
-	}
-	if s.ResourceId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ResourceId"))
-	}
-	if s.ResourceId != nil && len(*s.ResourceId) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("ResourceId", 1))
-	}
-	if s.ResourceType == nil {
-		invalidParams.Add(request.NewErrParamRequired("ResourceType"))
+func (s *DeleteCidrCollectionInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteCidrCollectionInput"}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
 	}
-	if s.ResourceType != nil && len(*s.ResourceType) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("ResourceType", 1))
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -6764,217 +11006,238 @@ func (s *ChangeTagsForResourceInput) Validate() error {
 	return nil
 }
 
-// SetAddTags sets the AddTags field's value.
-func (s *ChangeTagsForResourceInput) SetAddTags(v []*Tag) *ChangeTagsForResourceInput {
-	s.AddTags = v
+// SetId sets the Id field's value.
+func (s *DeleteCidrCollectionInput) SetId(v string) *DeleteCidrCollectionInput {
+	s.Id = &v
 	return s
 }
 
-// SetRemoveTagKeys sets the RemoveTagKeys field's value.
-func (s *ChangeTagsForResourceInput) SetRemoveTagKeys(v []*string) *ChangeTagsForResourceInput {
-	s.RemoveTagKeys = v
-	return s
+type DeleteCidrCollectionOutput struct {
+	_ struct{} `type:"structure"`
 }
 
-// SetResourceId sets the ResourceId field's value.
-func (s *ChangeTagsForResourceInput) SetResourceId(v string) *ChangeTagsForResourceInput {
-	s.ResourceId = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteCidrCollectionOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetResourceType sets the ResourceType field's value.
-func (s *ChangeTagsForResourceInput) SetResourceType(v string) *ChangeTagsForResourceInput {
-	s.ResourceType = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteCidrCollectionOutput) GoString() string {
+	return s.String()
 }
 
-// Empty response for the request.
-type ChangeTagsForResourceOutput struct {
-	_ struct{} `type:"structure"`
+// This action deletes a health check.
+type DeleteHealthCheckInput struct {
+	_ struct{} `locationName:"DeleteHealthCheckRequest" type:"structure"`
+
+	// The ID of the health check that you want to delete.
+	//
+	// HealthCheckId is a required field
+	HealthCheckId *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s ChangeTagsForResourceOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteHealthCheckInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ChangeTagsForResourceOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteHealthCheckInput) GoString() string {
 	return s.String()
 }
 
-// A complex type that contains information about the CloudWatch alarm that
-// Amazon Route 53 is monitoring for this health check.
-type CloudWatchAlarmConfiguration struct {
-	_ struct{} `type:"structure"`
-
-	// For the metric that the CloudWatch alarm is associated with, the arithmetic
-	// operation that is used for the comparison.
-	//
-	// ComparisonOperator is a required field
-	ComparisonOperator *string `type:"string" required:"true" enum:"ComparisonOperator"`
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteHealthCheckInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteHealthCheckInput"}
+	if s.HealthCheckId == nil {
+		invalidParams.Add(request.NewErrParamRequired("HealthCheckId"))
+	}
+	if s.HealthCheckId != nil && len(*s.HealthCheckId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("HealthCheckId", 1))
+	}
 
-	// For the metric that the CloudWatch alarm is associated with, a complex type
-	// that contains information about the dimensions for the metric. For information,
-	// see Amazon CloudWatch Namespaces, Dimensions, and Metrics Reference (http://docs.aws.amazon.com/AmazonCloudWatch/latest/DeveloperGuide/CW_Support_For_AWS.html)
-	// in the Amazon CloudWatch User Guide.
-	Dimensions []*Dimension `locationNameList:"Dimension" type:"list"`
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
 
-	// For the metric that the CloudWatch alarm is associated with, the number of
-	// periods that the metric is compared to the threshold.
-	//
-	// EvaluationPeriods is a required field
-	EvaluationPeriods *int64 `min:"1" type:"integer" required:"true"`
+// SetHealthCheckId sets the HealthCheckId field's value.
+func (s *DeleteHealthCheckInput) SetHealthCheckId(v string) *DeleteHealthCheckInput {
+	s.HealthCheckId = &v
+	return s
+}
 
-	// The name of the CloudWatch metric that the alarm is associated with.
-	//
-	// MetricName is a required field
-	MetricName *string `min:"1" type:"string" required:"true"`
+// An empty element.
+type DeleteHealthCheckOutput struct {
+	_ struct{} `type:"structure"`
+}
 
-	// The namespace of the metric that the alarm is associated with. For more information,
-	// see Amazon CloudWatch Namespaces, Dimensions, and Metrics Reference (http://docs.aws.amazon.com/AmazonCloudWatch/latest/DeveloperGuide/CW_Support_For_AWS.html)
-	// in the Amazon CloudWatch User Guide.
-	//
-	// Namespace is a required field
-	Namespace *string `min:"1" type:"string" required:"true"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteHealthCheckOutput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// For the metric that the CloudWatch alarm is associated with, the duration
-	// of one evaluation period in seconds.
-	//
-	// Period is a required field
-	Period *int64 `min:"60" type:"integer" required:"true"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteHealthCheckOutput) GoString() string {
+	return s.String()
+}
 
-	// For the metric that the CloudWatch alarm is associated with, the statistic
-	// that is applied to the metric.
-	//
-	// Statistic is a required field
-	Statistic *string `type:"string" required:"true" enum:"Statistic"`
+// A request to delete a hosted zone.
+type DeleteHostedZoneInput struct {
+	_ struct{} `locationName:"DeleteHostedZoneRequest" type:"structure"`
 
-	// For the metric that the CloudWatch alarm is associated with, the value the
-	// metric is compared with.
+	// The ID of the hosted zone you want to delete.
 	//
-	// Threshold is a required field
-	Threshold *float64 `type:"double" required:"true"`
+	// Id is a required field
+	Id *string `location:"uri" locationName:"Id" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CloudWatchAlarmConfiguration) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteHostedZoneInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CloudWatchAlarmConfiguration) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteHostedZoneInput) GoString() string {
 	return s.String()
 }
 
-// SetComparisonOperator sets the ComparisonOperator field's value.
-func (s *CloudWatchAlarmConfiguration) SetComparisonOperator(v string) *CloudWatchAlarmConfiguration {
-	s.ComparisonOperator = &v
-	return s
-}
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteHostedZoneInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteHostedZoneInput"}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
+	}
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
+	}
 
-// SetDimensions sets the Dimensions field's value.
-func (s *CloudWatchAlarmConfiguration) SetDimensions(v []*Dimension) *CloudWatchAlarmConfiguration {
-	s.Dimensions = v
-	return s
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetEvaluationPeriods sets the EvaluationPeriods field's value.
-func (s *CloudWatchAlarmConfiguration) SetEvaluationPeriods(v int64) *CloudWatchAlarmConfiguration {
-	s.EvaluationPeriods = &v
+// SetId sets the Id field's value.
+func (s *DeleteHostedZoneInput) SetId(v string) *DeleteHostedZoneInput {
+	s.Id = &v
 	return s
 }
 
-// SetMetricName sets the MetricName field's value.
-func (s *CloudWatchAlarmConfiguration) SetMetricName(v string) *CloudWatchAlarmConfiguration {
-	s.MetricName = &v
-	return s
-}
+// A complex type that contains the response to a DeleteHostedZone request.
+type DeleteHostedZoneOutput struct {
+	_ struct{} `type:"structure"`
 
-// SetNamespace sets the Namespace field's value.
-func (s *CloudWatchAlarmConfiguration) SetNamespace(v string) *CloudWatchAlarmConfiguration {
-	s.Namespace = &v
-	return s
+	// A complex type that contains the ID, the status, and the date and time of
+	// a request to delete a hosted zone.
+	//
+	// ChangeInfo is a required field
+	ChangeInfo *ChangeInfo `type:"structure" required:"true"`
 }
 
-// SetPeriod sets the Period field's value.
-func (s *CloudWatchAlarmConfiguration) SetPeriod(v int64) *CloudWatchAlarmConfiguration {
-	s.Period = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteHostedZoneOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetStatistic sets the Statistic field's value.
-func (s *CloudWatchAlarmConfiguration) SetStatistic(v string) *CloudWatchAlarmConfiguration {
-	s.Statistic = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteHostedZoneOutput) GoString() string {
+	return s.String()
 }
 
-// SetThreshold sets the Threshold field's value.
-func (s *CloudWatchAlarmConfiguration) SetThreshold(v float64) *CloudWatchAlarmConfiguration {
-	s.Threshold = &v
+// SetChangeInfo sets the ChangeInfo field's value.
+func (s *DeleteHostedZoneOutput) SetChangeInfo(v *ChangeInfo) *DeleteHostedZoneOutput {
+	s.ChangeInfo = v
 	return s
 }
 
-// A complex type that contains the health check request information.
-type CreateHealthCheckInput struct {
-	_ struct{} `locationName:"CreateHealthCheckRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+type DeleteKeySigningKeyInput struct {
+	_ struct{} `locationName:"DeleteKeySigningKeyRequest" type:"structure"`
 
-	// A unique string that identifies the request and that allows you to retry
-	// a failed CreateHealthCheck request without the risk of creating two identical
-	// health checks:
-	//
-	//    * If you send a CreateHealthCheck request with the same CallerReference
-	//    and settings as a previous request, and if the health check doesn't exist,
-	//    Amazon Route 53 creates the health check. If the health check does exist,
-	//    Route 53 returns the settings for the existing health check.
-	//
-	//    * If you send a CreateHealthCheck request with the same CallerReference
-	//    as a deleted health check, regardless of the settings, Route 53 returns
-	//    a HealthCheckAlreadyExists error.
-	//
-	//    * If you send a CreateHealthCheck request with the same CallerReference
-	//    as an existing health check but with different settings, Route 53 returns
-	//    a HealthCheckAlreadyExists error.
-	//
-	//    * If you send a CreateHealthCheck request with a unique CallerReference
-	//    but settings identical to an existing health check, Route 53 creates the
-	//    health check.
+	// A unique string used to identify a hosted zone.
 	//
-	// CallerReference is a required field
-	CallerReference *string `min:"1" type:"string" required:"true"`
+	// HostedZoneId is a required field
+	HostedZoneId *string `location:"uri" locationName:"HostedZoneId" type:"string" required:"true"`
 
-	// A complex type that contains settings for a new health check.
+	// A string used to identify a key-signing key (KSK).
 	//
-	// HealthCheckConfig is a required field
-	HealthCheckConfig *HealthCheckConfig `type:"structure" required:"true"`
+	// Name is a required field
+	Name *string `location:"uri" locationName:"Name" min:"3" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateHealthCheckInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteKeySigningKeyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateHealthCheckInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteKeySigningKeyInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateHealthCheckInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateHealthCheckInput"}
-	if s.CallerReference == nil {
-		invalidParams.Add(request.NewErrParamRequired("CallerReference"))
+func (s *DeleteKeySigningKeyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteKeySigningKeyInput"}
+	if s.HostedZoneId == nil {
+		invalidParams.Add(request.NewErrParamRequired("HostedZoneId"))
 	}
-	if s.CallerReference != nil && len(*s.CallerReference) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("CallerReference", 1))
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("HostedZoneId", 1))
 	}
-	if s.HealthCheckConfig == nil {
-		invalidParams.Add(request.NewErrParamRequired("HealthCheckConfig"))
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
 	}
-	if s.HealthCheckConfig != nil {
-		if err := s.HealthCheckConfig.Validate(); err != nil {
-			invalidParams.AddNested("HealthCheckConfig", err.(request.ErrInvalidParams))
-		}
+	if s.Name != nil && len(*s.Name) < 3 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 3))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -6983,134 +11246,159 @@ func (s *CreateHealthCheckInput) Validate() error {
 	return nil
 }
 
-// SetCallerReference sets the CallerReference field's value.
-func (s *CreateHealthCheckInput) SetCallerReference(v string) *CreateHealthCheckInput {
-	s.CallerReference = &v
+// SetHostedZoneId sets the HostedZoneId field's value.
+func (s *DeleteKeySigningKeyInput) SetHostedZoneId(v string) *DeleteKeySigningKeyInput {
+	s.HostedZoneId = &v
 	return s
 }
 
-// SetHealthCheckConfig sets the HealthCheckConfig field's value.
-func (s *CreateHealthCheckInput) SetHealthCheckConfig(v *HealthCheckConfig) *CreateHealthCheckInput {
-	s.HealthCheckConfig = v
+// SetName sets the Name field's value.
+func (s *DeleteKeySigningKeyInput) SetName(v string) *DeleteKeySigningKeyInput {
+	s.Name = &v
 	return s
 }
 
-// A complex type containing the response information for the new health check.
-type CreateHealthCheckOutput struct {
+type DeleteKeySigningKeyOutput struct {
 	_ struct{} `type:"structure"`
 
-	// A complex type that contains identifying information about the health check.
+	// A complex type that describes change information about changes made to your
+	// hosted zone.
 	//
-	// HealthCheck is a required field
-	HealthCheck *HealthCheck `type:"structure" required:"true"`
+	// ChangeInfo is a required field
+	ChangeInfo *ChangeInfo `type:"structure" required:"true"`
+}
 
-	// The unique URL representing the new health check.
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteKeySigningKeyOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteKeySigningKeyOutput) GoString() string {
+	return s.String()
+}
+
+// SetChangeInfo sets the ChangeInfo field's value.
+func (s *DeleteKeySigningKeyOutput) SetChangeInfo(v *ChangeInfo) *DeleteKeySigningKeyOutput {
+	s.ChangeInfo = v
+	return s
+}
+
+type DeleteQueryLoggingConfigInput struct {
+	_ struct{} `locationName:"DeleteQueryLoggingConfigRequest" type:"structure"`
+
+	// The ID of the configuration that you want to delete.
 	//
-	// Location is a required field
-	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+	// Id is a required field
+	Id *string `location:"uri" locationName:"Id" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateHealthCheckOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteQueryLoggingConfigInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateHealthCheckOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteQueryLoggingConfigInput) GoString() string {
 	return s.String()
 }
 
-// SetHealthCheck sets the HealthCheck field's value.
-func (s *CreateHealthCheckOutput) SetHealthCheck(v *HealthCheck) *CreateHealthCheckOutput {
-	s.HealthCheck = v
-	return s
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteQueryLoggingConfigInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteQueryLoggingConfigInput"}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
+	}
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetLocation sets the Location field's value.
-func (s *CreateHealthCheckOutput) SetLocation(v string) *CreateHealthCheckOutput {
-	s.Location = &v
+// SetId sets the Id field's value.
+func (s *DeleteQueryLoggingConfigInput) SetId(v string) *DeleteQueryLoggingConfigInput {
+	s.Id = &v
 	return s
 }
 
-// A complex type that contains information about the request to create a public
-// or private hosted zone.
-type CreateHostedZoneInput struct {
-	_ struct{} `locationName:"CreateHostedZoneRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
-
-	// A unique string that identifies the request and that allows failed CreateHostedZone
-	// requests to be retried without the risk of executing the operation twice.
-	// You must use a unique CallerReference string every time you submit a CreateHostedZone
-	// request. CallerReference can be any unique string, for example, a date/time
-	// stamp.
-	//
-	// CallerReference is a required field
-	CallerReference *string `min:"1" type:"string" required:"true"`
+type DeleteQueryLoggingConfigOutput struct {
+	_ struct{} `type:"structure"`
+}
 
-	// If you want to associate a reusable delegation set with this hosted zone,
-	// the ID that Amazon Route 53 assigned to the reusable delegation set when
-	// you created it. For more information about reusable delegation sets, see
-	// CreateReusableDelegationSet (https://docs.aws.amazon.com/Route53/latest/APIReference/API_CreateReusableDelegationSet.html).
-	DelegationSetId *string `type:"string"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteQueryLoggingConfigOutput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// (Optional) A complex type that contains the following optional values:
-	//
-	//    * For public and private hosted zones, an optional comment
-	//
-	//    * For private hosted zones, an optional PrivateZone element
-	//
-	// If you don't specify a comment or the PrivateZone element, omit HostedZoneConfig
-	// and the other elements.
-	HostedZoneConfig *HostedZoneConfig `type:"structure"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteQueryLoggingConfigOutput) GoString() string {
+	return s.String()
+}
 
-	// The name of the domain. Specify a fully qualified domain name, for example,
-	// www.example.com. The trailing dot is optional; Amazon Route 53 assumes that
-	// the domain name is fully qualified. This means that Route 53 treats www.example.com
-	// (without a trailing dot) and www.example.com. (with a trailing dot) as identical.
-	//
-	// If you're creating a public hosted zone, this is the name you have registered
-	// with your DNS registrar. If your domain name is registered with a registrar
-	// other than Route 53, change the name servers for your domain to the set of
-	// NameServers that CreateHostedZone returns in DelegationSet.
-	//
-	// Name is a required field
-	Name *string `type:"string" required:"true"`
+// A request to delete a reusable delegation set.
+type DeleteReusableDelegationSetInput struct {
+	_ struct{} `locationName:"DeleteReusableDelegationSetRequest" type:"structure"`
 
-	// (Private hosted zones only) A complex type that contains information about
-	// the Amazon VPC that you're associating with this hosted zone.
+	// The ID of the reusable delegation set that you want to delete.
 	//
-	// You can specify only one Amazon VPC when you create a private hosted zone.
-	// To associate additional Amazon VPCs with the hosted zone, use AssociateVPCWithHostedZone
-	// (https://docs.aws.amazon.com/Route53/latest/APIReference/API_AssociateVPCWithHostedZone.html)
-	// after you create a hosted zone.
-	VPC *VPC `type:"structure"`
+	// Id is a required field
+	Id *string `location:"uri" locationName:"Id" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateHostedZoneInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteReusableDelegationSetInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateHostedZoneInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteReusableDelegationSetInput) GoString() string {
 	return s.String()
 }
-
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateHostedZoneInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateHostedZoneInput"}
-	if s.CallerReference == nil {
-		invalidParams.Add(request.NewErrParamRequired("CallerReference"))
-	}
-	if s.CallerReference != nil && len(*s.CallerReference) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("CallerReference", 1))
-	}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteReusableDelegationSetInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteReusableDelegationSetInput"}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
 	}
-	if s.VPC != nil {
-		if err := s.VPC.Validate(); err != nil {
-			invalidParams.AddNested("VPC", err.(request.ErrInvalidParams))
-		}
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -7119,146 +11407,142 @@ func (s *CreateHostedZoneInput) Validate() error {
 	return nil
 }
 
-// SetCallerReference sets the CallerReference field's value.
-func (s *CreateHostedZoneInput) SetCallerReference(v string) *CreateHostedZoneInput {
-	s.CallerReference = &v
-	return s
-}
-
-// SetDelegationSetId sets the DelegationSetId field's value.
-func (s *CreateHostedZoneInput) SetDelegationSetId(v string) *CreateHostedZoneInput {
-	s.DelegationSetId = &v
+// SetId sets the Id field's value.
+func (s *DeleteReusableDelegationSetInput) SetId(v string) *DeleteReusableDelegationSetInput {
+	s.Id = &v
 	return s
 }
 
-// SetHostedZoneConfig sets the HostedZoneConfig field's value.
-func (s *CreateHostedZoneInput) SetHostedZoneConfig(v *HostedZoneConfig) *CreateHostedZoneInput {
-	s.HostedZoneConfig = v
-	return s
+// An empty element.
+type DeleteReusableDelegationSetOutput struct {
+	_ struct{} `type:"structure"`
 }
 
-// SetName sets the Name field's value.
-func (s *CreateHostedZoneInput) SetName(v string) *CreateHostedZoneInput {
-	s.Name = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteReusableDelegationSetOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetVPC sets the VPC field's value.
-func (s *CreateHostedZoneInput) SetVPC(v *VPC) *CreateHostedZoneInput {
-	s.VPC = v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteReusableDelegationSetOutput) GoString() string {
+	return s.String()
 }
 
-// A complex type containing the response information for the hosted zone.
-type CreateHostedZoneOutput struct {
-	_ struct{} `type:"structure"`
-
-	// A complex type that contains information about the CreateHostedZone request.
-	//
-	// ChangeInfo is a required field
-	ChangeInfo *ChangeInfo `type:"structure" required:"true"`
-
-	// A complex type that describes the name servers for this hosted zone.
-	//
-	// DelegationSet is a required field
-	DelegationSet *DelegationSet `type:"structure" required:"true"`
+// A request to delete a specified traffic policy version.
+type DeleteTrafficPolicyInput struct {
+	_ struct{} `locationName:"DeleteTrafficPolicyRequest" type:"structure"`
 
-	// A complex type that contains general information about the hosted zone.
+	// The ID of the traffic policy that you want to delete.
 	//
-	// HostedZone is a required field
-	HostedZone *HostedZone `type:"structure" required:"true"`
+	// Id is a required field
+	Id *string `location:"uri" locationName:"Id" min:"1" type:"string" required:"true"`
 
-	// The unique URL representing the new hosted zone.
+	// The version number of the traffic policy that you want to delete.
 	//
-	// Location is a required field
-	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
-
-	// A complex type that contains information about an Amazon VPC that you associated
-	// with this hosted zone.
-	VPC *VPC `type:"structure"`
+	// Version is a required field
+	Version *int64 `location:"uri" locationName:"Version" min:"1" type:"integer" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateHostedZoneOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteTrafficPolicyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateHostedZoneOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteTrafficPolicyInput) GoString() string {
 	return s.String()
 }
 
-// SetChangeInfo sets the ChangeInfo field's value.
-func (s *CreateHostedZoneOutput) SetChangeInfo(v *ChangeInfo) *CreateHostedZoneOutput {
-	s.ChangeInfo = v
-	return s
-}
-
-// SetDelegationSet sets the DelegationSet field's value.
-func (s *CreateHostedZoneOutput) SetDelegationSet(v *DelegationSet) *CreateHostedZoneOutput {
-	s.DelegationSet = v
-	return s
-}
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteTrafficPolicyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteTrafficPolicyInput"}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
+	}
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
+	}
+	if s.Version == nil {
+		invalidParams.Add(request.NewErrParamRequired("Version"))
+	}
+	if s.Version != nil && *s.Version < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Version", 1))
+	}
 
-// SetHostedZone sets the HostedZone field's value.
-func (s *CreateHostedZoneOutput) SetHostedZone(v *HostedZone) *CreateHostedZoneOutput {
-	s.HostedZone = v
-	return s
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetLocation sets the Location field's value.
-func (s *CreateHostedZoneOutput) SetLocation(v string) *CreateHostedZoneOutput {
-	s.Location = &v
+// SetId sets the Id field's value.
+func (s *DeleteTrafficPolicyInput) SetId(v string) *DeleteTrafficPolicyInput {
+	s.Id = &v
 	return s
 }
 
-// SetVPC sets the VPC field's value.
-func (s *CreateHostedZoneOutput) SetVPC(v *VPC) *CreateHostedZoneOutput {
-	s.VPC = v
+// SetVersion sets the Version field's value.
+func (s *DeleteTrafficPolicyInput) SetVersion(v int64) *DeleteTrafficPolicyInput {
+	s.Version = &v
 	return s
 }
 
-type CreateQueryLoggingConfigInput struct {
-	_ struct{} `locationName:"CreateQueryLoggingConfigRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+// A request to delete a specified traffic policy instance.
+type DeleteTrafficPolicyInstanceInput struct {
+	_ struct{} `locationName:"DeleteTrafficPolicyInstanceRequest" type:"structure"`
 
-	// The Amazon Resource Name (ARN) for the log group that you want to Amazon
-	// Route 53 to send query logs to. This is the format of the ARN:
-	//
-	// arn:aws:logs:region:account-id:log-group:log_group_name
-	//
-	// To get the ARN for a log group, you can use the CloudWatch console, the DescribeLogGroups
-	// (https://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_DescribeLogGroups.html)
-	// API action, the describe-log-groups (https://docs.aws.amazon.com/cli/latest/reference/logs/describe-log-groups.html)
-	// command, or the applicable command in one of the AWS SDKs.
+	// The ID of the traffic policy instance that you want to delete.
 	//
-	// CloudWatchLogsLogGroupArn is a required field
-	CloudWatchLogsLogGroupArn *string `type:"string" required:"true"`
-
-	// The ID of the hosted zone that you want to log queries for. You can log queries
-	// only for public hosted zones.
+	// When you delete a traffic policy instance, Amazon Route 53 also deletes all
+	// of the resource record sets that were created when you created the traffic
+	// policy instance.
 	//
-	// HostedZoneId is a required field
-	HostedZoneId *string `type:"string" required:"true"`
+	// Id is a required field
+	Id *string `location:"uri" locationName:"Id" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateQueryLoggingConfigInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteTrafficPolicyInstanceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateQueryLoggingConfigInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteTrafficPolicyInstanceInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateQueryLoggingConfigInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateQueryLoggingConfigInput"}
-	if s.CloudWatchLogsLogGroupArn == nil {
-		invalidParams.Add(request.NewErrParamRequired("CloudWatchLogsLogGroupArn"))
+func (s *DeleteTrafficPolicyInstanceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteTrafficPolicyInstanceInput"}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
 	}
-	if s.HostedZoneId == nil {
-		invalidParams.Add(request.NewErrParamRequired("HostedZoneId"))
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -7267,91 +11551,114 @@ func (s *CreateQueryLoggingConfigInput) Validate() error {
 	return nil
 }
 
-// SetCloudWatchLogsLogGroupArn sets the CloudWatchLogsLogGroupArn field's value.
-func (s *CreateQueryLoggingConfigInput) SetCloudWatchLogsLogGroupArn(v string) *CreateQueryLoggingConfigInput {
-	s.CloudWatchLogsLogGroupArn = &v
-	return s
-}
-
-// SetHostedZoneId sets the HostedZoneId field's value.
-func (s *CreateQueryLoggingConfigInput) SetHostedZoneId(v string) *CreateQueryLoggingConfigInput {
-	s.HostedZoneId = &v
+// SetId sets the Id field's value.
+func (s *DeleteTrafficPolicyInstanceInput) SetId(v string) *DeleteTrafficPolicyInstanceInput {
+	s.Id = &v
 	return s
 }
 
-type CreateQueryLoggingConfigOutput struct {
+// An empty element.
+type DeleteTrafficPolicyInstanceOutput struct {
 	_ struct{} `type:"structure"`
-
-	// The unique URL representing the new query logging configuration.
-	//
-	// Location is a required field
-	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
-
-	// A complex type that contains the ID for a query logging configuration, the
-	// ID of the hosted zone that you want to log queries for, and the ARN for the
-	// log group that you want Amazon Route 53 to send query logs to.
-	//
-	// QueryLoggingConfig is a required field
-	QueryLoggingConfig *QueryLoggingConfig `type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateQueryLoggingConfigOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteTrafficPolicyInstanceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateQueryLoggingConfigOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteTrafficPolicyInstanceOutput) GoString() string {
 	return s.String()
 }
 
-// SetLocation sets the Location field's value.
-func (s *CreateQueryLoggingConfigOutput) SetLocation(v string) *CreateQueryLoggingConfigOutput {
-	s.Location = &v
-	return s
+// An empty element.
+type DeleteTrafficPolicyOutput struct {
+	_ struct{} `type:"structure"`
 }
 
-// SetQueryLoggingConfig sets the QueryLoggingConfig field's value.
-func (s *CreateQueryLoggingConfigOutput) SetQueryLoggingConfig(v *QueryLoggingConfig) *CreateQueryLoggingConfigOutput {
-	s.QueryLoggingConfig = v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteTrafficPolicyOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-type CreateReusableDelegationSetInput struct {
-	_ struct{} `locationName:"CreateReusableDelegationSetRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteTrafficPolicyOutput) GoString() string {
+	return s.String()
+}
 
-	// A unique string that identifies the request, and that allows you to retry
-	// failed CreateReusableDelegationSet requests without the risk of executing
-	// the operation twice. You must use a unique CallerReference string every time
-	// you submit a CreateReusableDelegationSet request. CallerReference can be
-	// any unique string, for example a date/time stamp.
+// A complex type that contains information about the request to remove authorization
+// to associate a VPC that was created by one Amazon Web Services account with
+// a hosted zone that was created with a different Amazon Web Services account.
+type DeleteVPCAssociationAuthorizationInput struct {
+	_ struct{} `locationName:"DeleteVPCAssociationAuthorizationRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+
+	// When removing authorization to associate a VPC that was created by one Amazon
+	// Web Services account with a hosted zone that was created with a different
+	// Amazon Web Services account, the ID of the hosted zone.
 	//
-	// CallerReference is a required field
-	CallerReference *string `min:"1" type:"string" required:"true"`
+	// HostedZoneId is a required field
+	HostedZoneId *string `location:"uri" locationName:"Id" type:"string" required:"true"`
 
-	// If you want to mark the delegation set for an existing hosted zone as reusable,
-	// the ID for that hosted zone.
-	HostedZoneId *string `type:"string"`
+	// When removing authorization to associate a VPC that was created by one Amazon
+	// Web Services account with a hosted zone that was created with a different
+	// Amazon Web Services account, a complex type that includes the ID and region
+	// of the VPC.
+	//
+	// VPC is a required field
+	VPC *VPC `type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateReusableDelegationSetInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVPCAssociationAuthorizationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateReusableDelegationSetInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVPCAssociationAuthorizationInput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateReusableDelegationSetInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateReusableDelegationSetInput"}
-	if s.CallerReference == nil {
-		invalidParams.Add(request.NewErrParamRequired("CallerReference"))
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteVPCAssociationAuthorizationInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteVPCAssociationAuthorizationInput"}
+	if s.HostedZoneId == nil {
+		invalidParams.Add(request.NewErrParamRequired("HostedZoneId"))
 	}
-	if s.CallerReference != nil && len(*s.CallerReference) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("CallerReference", 1))
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("HostedZoneId", 1))
+	}
+	if s.VPC == nil {
+		invalidParams.Add(request.NewErrParamRequired("VPC"))
+	}
+	if s.VPC != nil {
+		if err := s.VPC.Validate(); err != nil {
+			invalidParams.AddNested("VPC", err.(request.ErrInvalidParams))
+		}
 	}
 
 	if invalidParams.Len() > 0 {
@@ -7360,92 +11667,124 @@ func (s *CreateReusableDelegationSetInput) Validate() error {
 	return nil
 }
 
-// SetCallerReference sets the CallerReference field's value.
-func (s *CreateReusableDelegationSetInput) SetCallerReference(v string) *CreateReusableDelegationSetInput {
-	s.CallerReference = &v
+// SetHostedZoneId sets the HostedZoneId field's value.
+func (s *DeleteVPCAssociationAuthorizationInput) SetHostedZoneId(v string) *DeleteVPCAssociationAuthorizationInput {
+	s.HostedZoneId = &v
 	return s
 }
 
-// SetHostedZoneId sets the HostedZoneId field's value.
-func (s *CreateReusableDelegationSetInput) SetHostedZoneId(v string) *CreateReusableDelegationSetInput {
-	s.HostedZoneId = &v
+// SetVPC sets the VPC field's value.
+func (s *DeleteVPCAssociationAuthorizationInput) SetVPC(v *VPC) *DeleteVPCAssociationAuthorizationInput {
+	s.VPC = v
 	return s
 }
 
-type CreateReusableDelegationSetOutput struct {
+// Empty response for the request.
+type DeleteVPCAssociationAuthorizationOutput struct {
 	_ struct{} `type:"structure"`
+}
 
-	// A complex type that contains name server information.
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVPCAssociationAuthorizationOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteVPCAssociationAuthorizationOutput) GoString() string {
+	return s.String()
+}
+
+// For the metric that the CloudWatch alarm is associated with, a complex type
+// that contains information about one dimension.
+type Dimension struct {
+	_ struct{} `type:"structure"`
+
+	// For the metric that the CloudWatch alarm is associated with, the name of
+	// one dimension.
 	//
-	// DelegationSet is a required field
-	DelegationSet *DelegationSet `type:"structure" required:"true"`
+	// Name is a required field
+	Name *string `min:"1" type:"string" required:"true"`
 
-	// The unique URL representing the new reusable delegation set.
+	// For the metric that the CloudWatch alarm is associated with, the value of
+	// one dimension.
 	//
-	// Location is a required field
-	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+	// Value is a required field
+	Value *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateReusableDelegationSetOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Dimension) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateReusableDelegationSetOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Dimension) GoString() string {
 	return s.String()
 }
 
-// SetDelegationSet sets the DelegationSet field's value.
-func (s *CreateReusableDelegationSetOutput) SetDelegationSet(v *DelegationSet) *CreateReusableDelegationSetOutput {
-	s.DelegationSet = v
+// SetName sets the Name field's value.
+func (s *Dimension) SetName(v string) *Dimension {
+	s.Name = &v
 	return s
 }
 
-// SetLocation sets the Location field's value.
-func (s *CreateReusableDelegationSetOutput) SetLocation(v string) *CreateReusableDelegationSetOutput {
-	s.Location = &v
+// SetValue sets the Value field's value.
+func (s *Dimension) SetValue(v string) *Dimension {
+	s.Value = &v
 	return s
 }
 
-// A complex type that contains information about the traffic policy that you
-// want to create.
-type CreateTrafficPolicyInput struct {
-	_ struct{} `locationName:"CreateTrafficPolicyRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
-
-	// (Optional) Any comments that you want to include about the traffic policy.
-	Comment *string `type:"string"`
-
-	// The definition of this traffic policy in JSON format. For more information,
-	// see Traffic Policy Document Format (https://docs.aws.amazon.com/Route53/latest/APIReference/api-policies-traffic-policy-document-format.html).
-	//
-	// Document is a required field
-	Document *string `type:"string" required:"true"`
+type DisableHostedZoneDNSSECInput struct {
+	_ struct{} `locationName:"DisableHostedZoneDNSSECRequest" type:"structure"`
 
-	// The name of the traffic policy.
+	// A unique string used to identify a hosted zone.
 	//
-	// Name is a required field
-	Name *string `type:"string" required:"true"`
+	// HostedZoneId is a required field
+	HostedZoneId *string `location:"uri" locationName:"Id" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateTrafficPolicyInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableHostedZoneDNSSECInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateTrafficPolicyInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableHostedZoneDNSSECInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateTrafficPolicyInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateTrafficPolicyInput"}
-	if s.Document == nil {
-		invalidParams.Add(request.NewErrParamRequired("Document"))
+func (s *DisableHostedZoneDNSSECInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisableHostedZoneDNSSECInput"}
+	if s.HostedZoneId == nil {
+		invalidParams.Add(request.NewErrParamRequired("HostedZoneId"))
 	}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("HostedZoneId", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -7454,94 +11793,100 @@ func (s *CreateTrafficPolicyInput) Validate() error {
 	return nil
 }
 
-// SetComment sets the Comment field's value.
-func (s *CreateTrafficPolicyInput) SetComment(v string) *CreateTrafficPolicyInput {
-	s.Comment = &v
+// SetHostedZoneId sets the HostedZoneId field's value.
+func (s *DisableHostedZoneDNSSECInput) SetHostedZoneId(v string) *DisableHostedZoneDNSSECInput {
+	s.HostedZoneId = &v
 	return s
 }
 
-// SetDocument sets the Document field's value.
-func (s *CreateTrafficPolicyInput) SetDocument(v string) *CreateTrafficPolicyInput {
-	s.Document = &v
-	return s
+type DisableHostedZoneDNSSECOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A complex type that describes change information about changes made to your
+	// hosted zone.
+	//
+	// ChangeInfo is a required field
+	ChangeInfo *ChangeInfo `type:"structure" required:"true"`
 }
 
-// SetName sets the Name field's value.
-func (s *CreateTrafficPolicyInput) SetName(v string) *CreateTrafficPolicyInput {
-	s.Name = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableHostedZoneDNSSECOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// A complex type that contains information about the resource record sets that
-// you want to create based on a specified traffic policy.
-type CreateTrafficPolicyInstanceInput struct {
-	_ struct{} `locationName:"CreateTrafficPolicyInstanceRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisableHostedZoneDNSSECOutput) GoString() string {
+	return s.String()
+}
 
-	// The ID of the hosted zone that you want Amazon Route 53 to create resource
-	// record sets in by using the configuration in a traffic policy.
-	//
-	// HostedZoneId is a required field
-	HostedZoneId *string `type:"string" required:"true"`
+// SetChangeInfo sets the ChangeInfo field's value.
+func (s *DisableHostedZoneDNSSECOutput) SetChangeInfo(v *ChangeInfo) *DisableHostedZoneDNSSECOutput {
+	s.ChangeInfo = v
+	return s
+}
 
-	// The domain name (such as example.com) or subdomain name (such as www.example.com)
-	// for which Amazon Route 53 responds to DNS queries by using the resource record
-	// sets that Route 53 creates for this traffic policy instance.
-	//
-	// Name is a required field
-	Name *string `type:"string" required:"true"`
+// A complex type that contains information about the VPC that you want to disassociate
+// from a specified private hosted zone.
+type DisassociateVPCFromHostedZoneInput struct {
+	_ struct{} `locationName:"DisassociateVPCFromHostedZoneRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
 
-	// (Optional) The TTL that you want Amazon Route 53 to assign to all of the
-	// resource record sets that it creates in the specified hosted zone.
-	//
-	// TTL is a required field
-	TTL *int64 `type:"long" required:"true"`
+	// Optional: A comment about the disassociation request.
+	Comment *string `type:"string"`
 
-	// The ID of the traffic policy that you want to use to create resource record
-	// sets in the specified hosted zone.
+	// The ID of the private hosted zone that you want to disassociate a VPC from.
 	//
-	// TrafficPolicyId is a required field
-	TrafficPolicyId *string `min:"1" type:"string" required:"true"`
+	// HostedZoneId is a required field
+	HostedZoneId *string `location:"uri" locationName:"Id" type:"string" required:"true"`
 
-	// The version of the traffic policy that you want to use to create resource
-	// record sets in the specified hosted zone.
+	// A complex type that contains information about the VPC that you're disassociating
+	// from the specified hosted zone.
 	//
-	// TrafficPolicyVersion is a required field
-	TrafficPolicyVersion *int64 `min:"1" type:"integer" required:"true"`
+	// VPC is a required field
+	VPC *VPC `type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateTrafficPolicyInstanceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisassociateVPCFromHostedZoneInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateTrafficPolicyInstanceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisassociateVPCFromHostedZoneInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateTrafficPolicyInstanceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateTrafficPolicyInstanceInput"}
+func (s *DisassociateVPCFromHostedZoneInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisassociateVPCFromHostedZoneInput"}
 	if s.HostedZoneId == nil {
 		invalidParams.Add(request.NewErrParamRequired("HostedZoneId"))
 	}
-	if s.Name == nil {
-		invalidParams.Add(request.NewErrParamRequired("Name"))
-	}
-	if s.TTL == nil {
-		invalidParams.Add(request.NewErrParamRequired("TTL"))
-	}
-	if s.TrafficPolicyId == nil {
-		invalidParams.Add(request.NewErrParamRequired("TrafficPolicyId"))
-	}
-	if s.TrafficPolicyId != nil && len(*s.TrafficPolicyId) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("TrafficPolicyId", 1))
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("HostedZoneId", 1))
 	}
-	if s.TrafficPolicyVersion == nil {
-		invalidParams.Add(request.NewErrParamRequired("TrafficPolicyVersion"))
+	if s.VPC == nil {
+		invalidParams.Add(request.NewErrParamRequired("VPC"))
 	}
-	if s.TrafficPolicyVersion != nil && *s.TrafficPolicyVersion < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("TrafficPolicyVersion", 1))
+	if s.VPC != nil {
+		if err := s.VPC.Validate(); err != nil {
+			invalidParams.AddNested("VPC", err.(request.ErrInvalidParams))
+		}
 	}
 
 	if invalidParams.Len() > 0 {
@@ -7550,155 +11895,216 @@ func (s *CreateTrafficPolicyInstanceInput) Validate() error {
 	return nil
 }
 
+// SetComment sets the Comment field's value.
+func (s *DisassociateVPCFromHostedZoneInput) SetComment(v string) *DisassociateVPCFromHostedZoneInput {
+	s.Comment = &v
+	return s
+}
+
 // SetHostedZoneId sets the HostedZoneId field's value.
-func (s *CreateTrafficPolicyInstanceInput) SetHostedZoneId(v string) *CreateTrafficPolicyInstanceInput {
+func (s *DisassociateVPCFromHostedZoneInput) SetHostedZoneId(v string) *DisassociateVPCFromHostedZoneInput {
 	s.HostedZoneId = &v
 	return s
 }
 
-// SetName sets the Name field's value.
-func (s *CreateTrafficPolicyInstanceInput) SetName(v string) *CreateTrafficPolicyInstanceInput {
-	s.Name = &v
+// SetVPC sets the VPC field's value.
+func (s *DisassociateVPCFromHostedZoneInput) SetVPC(v *VPC) *DisassociateVPCFromHostedZoneInput {
+	s.VPC = v
 	return s
 }
 
-// SetTTL sets the TTL field's value.
-func (s *CreateTrafficPolicyInstanceInput) SetTTL(v int64) *CreateTrafficPolicyInstanceInput {
-	s.TTL = &v
-	return s
+// A complex type that contains the response information for the disassociate
+// request.
+type DisassociateVPCFromHostedZoneOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A complex type that describes the changes made to the specified private hosted
+	// zone.
+	//
+	// ChangeInfo is a required field
+	ChangeInfo *ChangeInfo `type:"structure" required:"true"`
 }
 
-// SetTrafficPolicyId sets the TrafficPolicyId field's value.
-func (s *CreateTrafficPolicyInstanceInput) SetTrafficPolicyId(v string) *CreateTrafficPolicyInstanceInput {
-	s.TrafficPolicyId = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisassociateVPCFromHostedZoneOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetTrafficPolicyVersion sets the TrafficPolicyVersion field's value.
-func (s *CreateTrafficPolicyInstanceInput) SetTrafficPolicyVersion(v int64) *CreateTrafficPolicyInstanceInput {
-	s.TrafficPolicyVersion = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DisassociateVPCFromHostedZoneOutput) GoString() string {
+	return s.String()
 }
 
-// A complex type that contains the response information for the CreateTrafficPolicyInstance
-// request.
-type CreateTrafficPolicyInstanceOutput struct {
-	_ struct{} `type:"structure"`
+// SetChangeInfo sets the ChangeInfo field's value.
+func (s *DisassociateVPCFromHostedZoneOutput) SetChangeInfo(v *ChangeInfo) *DisassociateVPCFromHostedZoneOutput {
+	s.ChangeInfo = v
+	return s
+}
 
-	// A unique URL that represents a new traffic policy instance.
-	//
-	// Location is a required field
-	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+type EnableHostedZoneDNSSECInput struct {
+	_ struct{} `locationName:"EnableHostedZoneDNSSECRequest" type:"structure"`
 
-	// A complex type that contains settings for the new traffic policy instance.
+	// A unique string used to identify a hosted zone.
 	//
-	// TrafficPolicyInstance is a required field
-	TrafficPolicyInstance *TrafficPolicyInstance `type:"structure" required:"true"`
+	// HostedZoneId is a required field
+	HostedZoneId *string `location:"uri" locationName:"Id" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateTrafficPolicyInstanceOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableHostedZoneDNSSECInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateTrafficPolicyInstanceOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableHostedZoneDNSSECInput) GoString() string {
 	return s.String()
 }
 
-// SetLocation sets the Location field's value.
-func (s *CreateTrafficPolicyInstanceOutput) SetLocation(v string) *CreateTrafficPolicyInstanceOutput {
-	s.Location = &v
-	return s
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *EnableHostedZoneDNSSECInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnableHostedZoneDNSSECInput"}
+	if s.HostedZoneId == nil {
+		invalidParams.Add(request.NewErrParamRequired("HostedZoneId"))
+	}
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("HostedZoneId", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetTrafficPolicyInstance sets the TrafficPolicyInstance field's value.
-func (s *CreateTrafficPolicyInstanceOutput) SetTrafficPolicyInstance(v *TrafficPolicyInstance) *CreateTrafficPolicyInstanceOutput {
-	s.TrafficPolicyInstance = v
+// SetHostedZoneId sets the HostedZoneId field's value.
+func (s *EnableHostedZoneDNSSECInput) SetHostedZoneId(v string) *EnableHostedZoneDNSSECInput {
+	s.HostedZoneId = &v
 	return s
 }
 
-// A complex type that contains the response information for the CreateTrafficPolicy
-// request.
-type CreateTrafficPolicyOutput struct {
+type EnableHostedZoneDNSSECOutput struct {
 	_ struct{} `type:"structure"`
 
-	// A unique URL that represents a new traffic policy.
-	//
-	// Location is a required field
-	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
-
-	// A complex type that contains settings for the new traffic policy.
+	// A complex type that describes change information about changes made to your
+	// hosted zone.
 	//
-	// TrafficPolicy is a required field
-	TrafficPolicy *TrafficPolicy `type:"structure" required:"true"`
+	// ChangeInfo is a required field
+	ChangeInfo *ChangeInfo `type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateTrafficPolicyOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableHostedZoneDNSSECOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateTrafficPolicyOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EnableHostedZoneDNSSECOutput) GoString() string {
 	return s.String()
 }
 
-// SetLocation sets the Location field's value.
-func (s *CreateTrafficPolicyOutput) SetLocation(v string) *CreateTrafficPolicyOutput {
-	s.Location = &v
-	return s
-}
-
-// SetTrafficPolicy sets the TrafficPolicy field's value.
-func (s *CreateTrafficPolicyOutput) SetTrafficPolicy(v *TrafficPolicy) *CreateTrafficPolicyOutput {
-	s.TrafficPolicy = v
+// SetChangeInfo sets the ChangeInfo field's value.
+func (s *EnableHostedZoneDNSSECOutput) SetChangeInfo(v *ChangeInfo) *EnableHostedZoneDNSSECOutput {
+	s.ChangeInfo = v
 	return s
 }
 
-// A complex type that contains information about the traffic policy that you
-// want to create a new version for.
-type CreateTrafficPolicyVersionInput struct {
-	_ struct{} `locationName:"CreateTrafficPolicyVersionRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+// A complex type that contains information about a geographic location.
+type GeoLocation struct {
+	_ struct{} `type:"structure"`
 
-	// The comment that you specified in the CreateTrafficPolicyVersion request,
-	// if any.
-	Comment *string `type:"string"`
+	// The two-letter code for the continent.
+	//
+	// Amazon Route 53 supports the following continent codes:
+	//
+	//    * AF: Africa
+	//
+	//    * AN: Antarctica
+	//
+	//    * AS: Asia
+	//
+	//    * EU: Europe
+	//
+	//    * OC: Oceania
+	//
+	//    * NA: North America
+	//
+	//    * SA: South America
+	//
+	// Constraint: Specifying ContinentCode with either CountryCode or SubdivisionCode
+	// returns an InvalidInput error.
+	ContinentCode *string `min:"2" type:"string"`
 
-	// The definition of this version of the traffic policy, in JSON format. You
-	// specified the JSON in the CreateTrafficPolicyVersion request. For more information
-	// about the JSON format, see CreateTrafficPolicy (https://docs.aws.amazon.com/Route53/latest/APIReference/API_CreateTrafficPolicy.html).
+	// For geolocation resource record sets, the two-letter code for a country.
 	//
-	// Document is a required field
-	Document *string `type:"string" required:"true"`
+	// Amazon Route 53 uses the two-letter country codes that are specified in ISO
+	// standard 3166-1 alpha-2 (https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2).
+	//
+	// Route 53 also supports the contry code UA forr Ukraine.
+	CountryCode *string `min:"1" type:"string"`
 
-	// The ID of the traffic policy for which you want to create a new version.
+	// For geolocation resource record sets, the two-letter code for a state of
+	// the United States. Route 53 doesn't support any other values for SubdivisionCode.
+	// For a list of state abbreviations, see Appendix B: Two–Letter State and
+	// Possession Abbreviations (https://pe.usps.com/text/pub28/28apb.htm) on the
+	// United States Postal Service website.
 	//
-	// Id is a required field
-	Id *string `location:"uri" locationName:"Id" min:"1" type:"string" required:"true"`
+	// If you specify subdivisioncode, you must also specify US for CountryCode.
+	SubdivisionCode *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s CreateTrafficPolicyVersionInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GeoLocation) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateTrafficPolicyVersionInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GeoLocation) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateTrafficPolicyVersionInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateTrafficPolicyVersionInput"}
-	if s.Document == nil {
-		invalidParams.Add(request.NewErrParamRequired("Document"))
+func (s *GeoLocation) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GeoLocation"}
+	if s.ContinentCode != nil && len(*s.ContinentCode) < 2 {
+		invalidParams.Add(request.NewErrParamMinLen("ContinentCode", 2))
 	}
-	if s.Id == nil {
-		invalidParams.Add(request.NewErrParamRequired("Id"))
+	if s.CountryCode != nil && len(*s.CountryCode) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CountryCode", 1))
 	}
-	if s.Id != nil && len(*s.Id) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
+	if s.SubdivisionCode != nil && len(*s.SubdivisionCode) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("SubdivisionCode", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -7707,108 +12113,162 @@ func (s *CreateTrafficPolicyVersionInput) Validate() error {
 	return nil
 }
 
-// SetComment sets the Comment field's value.
-func (s *CreateTrafficPolicyVersionInput) SetComment(v string) *CreateTrafficPolicyVersionInput {
-	s.Comment = &v
+// SetContinentCode sets the ContinentCode field's value.
+func (s *GeoLocation) SetContinentCode(v string) *GeoLocation {
+	s.ContinentCode = &v
 	return s
 }
 
-// SetDocument sets the Document field's value.
-func (s *CreateTrafficPolicyVersionInput) SetDocument(v string) *CreateTrafficPolicyVersionInput {
-	s.Document = &v
+// SetCountryCode sets the CountryCode field's value.
+func (s *GeoLocation) SetCountryCode(v string) *GeoLocation {
+	s.CountryCode = &v
 	return s
 }
 
-// SetId sets the Id field's value.
-func (s *CreateTrafficPolicyVersionInput) SetId(v string) *CreateTrafficPolicyVersionInput {
-	s.Id = &v
+// SetSubdivisionCode sets the SubdivisionCode field's value.
+func (s *GeoLocation) SetSubdivisionCode(v string) *GeoLocation {
+	s.SubdivisionCode = &v
 	return s
 }
 
-// A complex type that contains the response information for the CreateTrafficPolicyVersion
-// request.
-type CreateTrafficPolicyVersionOutput struct {
+// A complex type that contains the codes and full continent, country, and subdivision
+// names for the specified geolocation code.
+type GeoLocationDetails struct {
 	_ struct{} `type:"structure"`
 
-	// A unique URL that represents a new traffic policy version.
-	//
-	// Location is a required field
-	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+	// The two-letter code for the continent.
+	ContinentCode *string `min:"2" type:"string"`
 
-	// A complex type that contains settings for the new version of the traffic
-	// policy.
-	//
-	// TrafficPolicy is a required field
-	TrafficPolicy *TrafficPolicy `type:"structure" required:"true"`
+	// The full name of the continent.
+	ContinentName *string `min:"1" type:"string"`
+
+	// The two-letter code for the country.
+	CountryCode *string `min:"1" type:"string"`
+
+	// The name of the country.
+	CountryName *string `min:"1" type:"string"`
+
+	// The code for the subdivision, such as a particular state within the United
+	// States. For a list of US state abbreviations, see Appendix B: Two–Letter
+	// State and Possession Abbreviations (https://pe.usps.com/text/pub28/28apb.htm)
+	// on the United States Postal Service website. For a list of all supported
+	// subdivision codes, use the ListGeoLocations (https://docs.aws.amazon.com/Route53/latest/APIReference/API_ListGeoLocations.html)
+	// API.
+	SubdivisionCode *string `min:"1" type:"string"`
+
+	// The full name of the subdivision. Route 53 currently supports only states
+	// in the United States.
+	SubdivisionName *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s CreateTrafficPolicyVersionOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GeoLocationDetails) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateTrafficPolicyVersionOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GeoLocationDetails) GoString() string {
 	return s.String()
 }
 
-// SetLocation sets the Location field's value.
-func (s *CreateTrafficPolicyVersionOutput) SetLocation(v string) *CreateTrafficPolicyVersionOutput {
-	s.Location = &v
+// SetContinentCode sets the ContinentCode field's value.
+func (s *GeoLocationDetails) SetContinentCode(v string) *GeoLocationDetails {
+	s.ContinentCode = &v
 	return s
 }
 
-// SetTrafficPolicy sets the TrafficPolicy field's value.
-func (s *CreateTrafficPolicyVersionOutput) SetTrafficPolicy(v *TrafficPolicy) *CreateTrafficPolicyVersionOutput {
-	s.TrafficPolicy = v
+// SetContinentName sets the ContinentName field's value.
+func (s *GeoLocationDetails) SetContinentName(v string) *GeoLocationDetails {
+	s.ContinentName = &v
 	return s
 }
 
-// A complex type that contains information about the request to authorize associating
-// a VPC with your private hosted zone. Authorization is only required when
-// a private hosted zone and a VPC were created by using different accounts.
-type CreateVPCAssociationAuthorizationInput struct {
-	_ struct{} `locationName:"CreateVPCAssociationAuthorizationRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+// SetCountryCode sets the CountryCode field's value.
+func (s *GeoLocationDetails) SetCountryCode(v string) *GeoLocationDetails {
+	s.CountryCode = &v
+	return s
+}
 
-	// The ID of the private hosted zone that you want to authorize associating
-	// a VPC with.
-	//
-	// HostedZoneId is a required field
-	HostedZoneId *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+// SetCountryName sets the CountryName field's value.
+func (s *GeoLocationDetails) SetCountryName(v string) *GeoLocationDetails {
+	s.CountryName = &v
+	return s
+}
 
-	// A complex type that contains the VPC ID and region for the VPC that you want
-	// to authorize associating with your hosted zone.
+// SetSubdivisionCode sets the SubdivisionCode field's value.
+func (s *GeoLocationDetails) SetSubdivisionCode(v string) *GeoLocationDetails {
+	s.SubdivisionCode = &v
+	return s
+}
+
+// SetSubdivisionName sets the SubdivisionName field's value.
+func (s *GeoLocationDetails) SetSubdivisionName(v string) *GeoLocationDetails {
+	s.SubdivisionName = &v
+	return s
+}
+
+// A complex type that contains information about the request to create a hosted
+// zone.
+type GetAccountLimitInput struct {
+	_ struct{} `locationName:"GetAccountLimitRequest" type:"structure"`
+
+	// The limit that you want to get. Valid values include the following:
+	//
+	//    * MAX_HEALTH_CHECKS_BY_OWNER: The maximum number of health checks that
+	//    you can create using the current account.
+	//
+	//    * MAX_HOSTED_ZONES_BY_OWNER: The maximum number of hosted zones that you
+	//    can create using the current account.
+	//
+	//    * MAX_REUSABLE_DELEGATION_SETS_BY_OWNER: The maximum number of reusable
+	//    delegation sets that you can create using the current account.
 	//
-	// VPC is a required field
-	VPC *VPC `type:"structure" required:"true"`
+	//    * MAX_TRAFFIC_POLICIES_BY_OWNER: The maximum number of traffic policies
+	//    that you can create using the current account.
+	//
+	//    * MAX_TRAFFIC_POLICY_INSTANCES_BY_OWNER: The maximum number of traffic
+	//    policy instances that you can create using the current account. (Traffic
+	//    policy instances are referred to as traffic flow policy records in the
+	//    Amazon Route 53 console.)
+	//
+	// Type is a required field
+	Type *string `location:"uri" locationName:"Type" type:"string" required:"true" enum:"AccountLimitType"`
 }
 
-// String returns the string representation
-func (s CreateVPCAssociationAuthorizationInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetAccountLimitInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateVPCAssociationAuthorizationInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetAccountLimitInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateVPCAssociationAuthorizationInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateVPCAssociationAuthorizationInput"}
-	if s.HostedZoneId == nil {
-		invalidParams.Add(request.NewErrParamRequired("HostedZoneId"))
-	}
-	if s.HostedZoneId != nil && len(*s.HostedZoneId) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("HostedZoneId", 1))
-	}
-	if s.VPC == nil {
-		invalidParams.Add(request.NewErrParamRequired("VPC"))
+func (s *GetAccountLimitInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetAccountLimitInput"}
+	if s.Type == nil {
+		invalidParams.Add(request.NewErrParamRequired("Type"))
 	}
-	if s.VPC != nil {
-		if err := s.VPC.Validate(); err != nil {
-			invalidParams.AddNested("VPC", err.(request.ErrInvalidParams))
-		}
+	if s.Type != nil && len(*s.Type) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Type", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -7817,188 +12277,242 @@ func (s *CreateVPCAssociationAuthorizationInput) Validate() error {
 	return nil
 }
 
-// SetHostedZoneId sets the HostedZoneId field's value.
-func (s *CreateVPCAssociationAuthorizationInput) SetHostedZoneId(v string) *CreateVPCAssociationAuthorizationInput {
-	s.HostedZoneId = &v
-	return s
-}
-
-// SetVPC sets the VPC field's value.
-func (s *CreateVPCAssociationAuthorizationInput) SetVPC(v *VPC) *CreateVPCAssociationAuthorizationInput {
-	s.VPC = v
+// SetType sets the Type field's value.
+func (s *GetAccountLimitInput) SetType(v string) *GetAccountLimitInput {
+	s.Type = &v
 	return s
 }
 
-// A complex type that contains the response information from a CreateVPCAssociationAuthorization
-// request.
-type CreateVPCAssociationAuthorizationOutput struct {
+// A complex type that contains the requested limit.
+type GetAccountLimitOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the hosted zone that you authorized associating a VPC with.
+	// The current number of entities that you have created of the specified type.
+	// For example, if you specified MAX_HEALTH_CHECKS_BY_OWNER for the value of
+	// Type in the request, the value of Count is the current number of health checks
+	// that you have created using the current account.
 	//
-	// HostedZoneId is a required field
-	HostedZoneId *string `type:"string" required:"true"`
+	// Count is a required field
+	Count *int64 `type:"long" required:"true"`
 
-	// The VPC that you authorized associating with a hosted zone.
+	// The current setting for the specified limit. For example, if you specified
+	// MAX_HEALTH_CHECKS_BY_OWNER for the value of Type in the request, the value
+	// of Limit is the maximum number of health checks that you can create using
+	// the current account.
 	//
-	// VPC is a required field
-	VPC *VPC `type:"structure" required:"true"`
+	// Limit is a required field
+	Limit *AccountLimit `type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateVPCAssociationAuthorizationOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetAccountLimitOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateVPCAssociationAuthorizationOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetAccountLimitOutput) GoString() string {
 	return s.String()
 }
 
-// SetHostedZoneId sets the HostedZoneId field's value.
-func (s *CreateVPCAssociationAuthorizationOutput) SetHostedZoneId(v string) *CreateVPCAssociationAuthorizationOutput {
-	s.HostedZoneId = &v
+// SetCount sets the Count field's value.
+func (s *GetAccountLimitOutput) SetCount(v int64) *GetAccountLimitOutput {
+	s.Count = &v
 	return s
 }
 
-// SetVPC sets the VPC field's value.
-func (s *CreateVPCAssociationAuthorizationOutput) SetVPC(v *VPC) *CreateVPCAssociationAuthorizationOutput {
-	s.VPC = v
+// SetLimit sets the Limit field's value.
+func (s *GetAccountLimitOutput) SetLimit(v *AccountLimit) *GetAccountLimitOutput {
+	s.Limit = v
 	return s
 }
 
-// A complex type that lists the name servers in a delegation set, as well as
-// the CallerReference and the ID for the delegation set.
-type DelegationSet struct {
-	_ struct{} `type:"structure"`
-
-	// The value that you specified for CallerReference when you created the reusable
-	// delegation set.
-	CallerReference *string `min:"1" type:"string"`
-
-	// The ID that Amazon Route 53 assigns to a reusable delegation set.
-	Id *string `type:"string"`
+// The input for a GetChange request.
+type GetChangeInput struct {
+	_ struct{} `locationName:"GetChangeRequest" type:"structure"`
 
-	// A complex type that contains a list of the authoritative name servers for
-	// a hosted zone or for a reusable delegation set.
+	// The ID of the change batch request. The value that you specify here is the
+	// value that ChangeResourceRecordSets returned in the Id element when you submitted
+	// the request.
 	//
-	// NameServers is a required field
-	NameServers []*string `locationNameList:"NameServer" min:"1" type:"list" required:"true"`
+	// Id is a required field
+	Id *string `location:"uri" locationName:"Id" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DelegationSet) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetChangeInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DelegationSet) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetChangeInput) GoString() string {
 	return s.String()
 }
 
-// SetCallerReference sets the CallerReference field's value.
-func (s *DelegationSet) SetCallerReference(v string) *DelegationSet {
-	s.CallerReference = &v
-	return s
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetChangeInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetChangeInput"}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
+	}
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
 // SetId sets the Id field's value.
-func (s *DelegationSet) SetId(v string) *DelegationSet {
+func (s *GetChangeInput) SetId(v string) *GetChangeInput {
 	s.Id = &v
 	return s
 }
 
-// SetNameServers sets the NameServers field's value.
-func (s *DelegationSet) SetNameServers(v []*string) *DelegationSet {
-	s.NameServers = v
-	return s
-}
-
-// This action deletes a health check.
-type DeleteHealthCheckInput struct {
-	_ struct{} `locationName:"DeleteHealthCheckRequest" type:"structure"`
+// A complex type that contains the ChangeInfo element.
+type GetChangeOutput struct {
+	_ struct{} `type:"structure"`
 
-	// The ID of the health check that you want to delete.
+	// A complex type that contains information about the specified change batch.
 	//
-	// HealthCheckId is a required field
-	HealthCheckId *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
+	// ChangeInfo is a required field
+	ChangeInfo *ChangeInfo `type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteHealthCheckInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetChangeOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteHealthCheckInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetChangeOutput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteHealthCheckInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteHealthCheckInput"}
-	if s.HealthCheckId == nil {
-		invalidParams.Add(request.NewErrParamRequired("HealthCheckId"))
-	}
-	if s.HealthCheckId != nil && len(*s.HealthCheckId) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("HealthCheckId", 1))
-	}
+// SetChangeInfo sets the ChangeInfo field's value.
+func (s *GetChangeOutput) SetChangeInfo(v *ChangeInfo) *GetChangeOutput {
+	s.ChangeInfo = v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// Empty request.
+type GetCheckerIpRangesInput struct {
+	_ struct{} `locationName:"GetCheckerIpRangesRequest" type:"structure"`
 }
 
-// SetHealthCheckId sets the HealthCheckId field's value.
-func (s *DeleteHealthCheckInput) SetHealthCheckId(v string) *DeleteHealthCheckInput {
-	s.HealthCheckId = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetCheckerIpRangesInput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// An empty element.
-type DeleteHealthCheckOutput struct {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetCheckerIpRangesInput) GoString() string {
+	return s.String()
+}
+
+// A complex type that contains the CheckerIpRanges element.
+type GetCheckerIpRangesOutput struct {
 	_ struct{} `type:"structure"`
+
+	// A complex type that contains sorted list of IP ranges in CIDR format for
+	// Amazon Route 53 health checkers.
+	//
+	// CheckerIpRanges is a required field
+	CheckerIpRanges []*string `type:"list" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteHealthCheckOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetCheckerIpRangesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteHealthCheckOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetCheckerIpRangesOutput) GoString() string {
 	return s.String()
 }
 
-// A request to delete a hosted zone.
-type DeleteHostedZoneInput struct {
-	_ struct{} `locationName:"DeleteHostedZoneRequest" type:"structure"`
+// SetCheckerIpRanges sets the CheckerIpRanges field's value.
+func (s *GetCheckerIpRangesOutput) SetCheckerIpRanges(v []*string) *GetCheckerIpRangesOutput {
+	s.CheckerIpRanges = v
+	return s
+}
 
-	// The ID of the hosted zone you want to delete.
+type GetDNSSECInput struct {
+	_ struct{} `locationName:"GetDNSSECRequest" type:"structure"`
+
+	// A unique string used to identify a hosted zone.
 	//
-	// Id is a required field
-	Id *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	// HostedZoneId is a required field
+	HostedZoneId *string `location:"uri" locationName:"Id" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteHostedZoneInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDNSSECInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteHostedZoneInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDNSSECInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteHostedZoneInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteHostedZoneInput"}
-	if s.Id == nil {
-		invalidParams.Add(request.NewErrParamRequired("Id"))
+func (s *GetDNSSECInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDNSSECInput"}
+	if s.HostedZoneId == nil {
+		invalidParams.Add(request.NewErrParamRequired("HostedZoneId"))
 	}
-	if s.Id != nil && len(*s.Id) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("HostedZoneId", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -8007,66 +12521,123 @@ func (s *DeleteHostedZoneInput) Validate() error {
 	return nil
 }
 
-// SetId sets the Id field's value.
-func (s *DeleteHostedZoneInput) SetId(v string) *DeleteHostedZoneInput {
-	s.Id = &v
+// SetHostedZoneId sets the HostedZoneId field's value.
+func (s *GetDNSSECInput) SetHostedZoneId(v string) *GetDNSSECInput {
+	s.HostedZoneId = &v
 	return s
 }
 
-// A complex type that contains the response to a DeleteHostedZone request.
-type DeleteHostedZoneOutput struct {
+type GetDNSSECOutput struct {
 	_ struct{} `type:"structure"`
 
-	// A complex type that contains the ID, the status, and the date and time of
-	// a request to delete a hosted zone.
+	// The key-signing keys (KSKs) in your account.
 	//
-	// ChangeInfo is a required field
-	ChangeInfo *ChangeInfo `type:"structure" required:"true"`
+	// KeySigningKeys is a required field
+	KeySigningKeys []*KeySigningKey `type:"list" required:"true"`
+
+	// A string repesenting the status of DNSSEC.
+	//
+	// Status is a required field
+	Status *DNSSECStatus `type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteHostedZoneOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDNSSECOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteHostedZoneOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDNSSECOutput) GoString() string {
 	return s.String()
 }
 
-// SetChangeInfo sets the ChangeInfo field's value.
-func (s *DeleteHostedZoneOutput) SetChangeInfo(v *ChangeInfo) *DeleteHostedZoneOutput {
-	s.ChangeInfo = v
+// SetKeySigningKeys sets the KeySigningKeys field's value.
+func (s *GetDNSSECOutput) SetKeySigningKeys(v []*KeySigningKey) *GetDNSSECOutput {
+	s.KeySigningKeys = v
 	return s
 }
 
-type DeleteQueryLoggingConfigInput struct {
-	_ struct{} `locationName:"DeleteQueryLoggingConfigRequest" type:"structure"`
+// SetStatus sets the Status field's value.
+func (s *GetDNSSECOutput) SetStatus(v *DNSSECStatus) *GetDNSSECOutput {
+	s.Status = v
+	return s
+}
 
-	// The ID of the configuration that you want to delete.
+// A request for information about whether a specified geographic location is
+// supported for Amazon Route 53 geolocation resource record sets.
+type GetGeoLocationInput struct {
+	_ struct{} `locationName:"GetGeoLocationRequest" type:"structure"`
+
+	// For geolocation resource record sets, a two-letter abbreviation that identifies
+	// a continent. Amazon Route 53 supports the following continent codes:
 	//
-	// Id is a required field
-	Id *string `location:"uri" locationName:"Id" min:"1" type:"string" required:"true"`
+	//    * AF: Africa
+	//
+	//    * AN: Antarctica
+	//
+	//    * AS: Asia
+	//
+	//    * EU: Europe
+	//
+	//    * OC: Oceania
+	//
+	//    * NA: North America
+	//
+	//    * SA: South America
+	ContinentCode *string `location:"querystring" locationName:"continentcode" min:"2" type:"string"`
+
+	// Amazon Route 53 uses the two-letter country codes that are specified in ISO
+	// standard 3166-1 alpha-2 (https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2).
+	//
+	// Route 53 also supports the contry code UA forr Ukraine.
+	CountryCode *string `location:"querystring" locationName:"countrycode" min:"1" type:"string"`
+
+	// The code for the subdivision, such as a particular state within the United
+	// States. For a list of US state abbreviations, see Appendix B: Two–Letter
+	// State and Possession Abbreviations (https://pe.usps.com/text/pub28/28apb.htm)
+	// on the United States Postal Service website. For a list of all supported
+	// subdivision codes, use the ListGeoLocations (https://docs.aws.amazon.com/Route53/latest/APIReference/API_ListGeoLocations.html)
+	// API.
+	SubdivisionCode *string `location:"querystring" locationName:"subdivisioncode" min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s DeleteQueryLoggingConfigInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetGeoLocationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteQueryLoggingConfigInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetGeoLocationInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteQueryLoggingConfigInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteQueryLoggingConfigInput"}
-	if s.Id == nil {
-		invalidParams.Add(request.NewErrParamRequired("Id"))
+func (s *GetGeoLocationInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetGeoLocationInput"}
+	if s.ContinentCode != nil && len(*s.ContinentCode) < 2 {
+		invalidParams.Add(request.NewErrParamMinLen("ContinentCode", 2))
 	}
-	if s.Id != nil && len(*s.Id) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
+	if s.CountryCode != nil && len(*s.CountryCode) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CountryCode", 1))
+	}
+	if s.SubdivisionCode != nil && len(*s.SubdivisionCode) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("SubdivisionCode", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -8075,122 +12646,158 @@ func (s *DeleteQueryLoggingConfigInput) Validate() error {
 	return nil
 }
 
-// SetId sets the Id field's value.
-func (s *DeleteQueryLoggingConfigInput) SetId(v string) *DeleteQueryLoggingConfigInput {
-	s.Id = &v
+// SetContinentCode sets the ContinentCode field's value.
+func (s *GetGeoLocationInput) SetContinentCode(v string) *GetGeoLocationInput {
+	s.ContinentCode = &v
 	return s
 }
 
-type DeleteQueryLoggingConfigOutput struct {
-	_ struct{} `type:"structure"`
-}
-
-// String returns the string representation
-func (s DeleteQueryLoggingConfigOutput) String() string {
-	return awsutil.Prettify(s)
+// SetCountryCode sets the CountryCode field's value.
+func (s *GetGeoLocationInput) SetCountryCode(v string) *GetGeoLocationInput {
+	s.CountryCode = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s DeleteQueryLoggingConfigOutput) GoString() string {
-	return s.String()
+// SetSubdivisionCode sets the SubdivisionCode field's value.
+func (s *GetGeoLocationInput) SetSubdivisionCode(v string) *GetGeoLocationInput {
+	s.SubdivisionCode = &v
+	return s
 }
 
-// A request to delete a reusable delegation set.
-type DeleteReusableDelegationSetInput struct {
-	_ struct{} `locationName:"DeleteReusableDelegationSetRequest" type:"structure"`
+// A complex type that contains the response information for the specified geolocation
+// code.
+type GetGeoLocationOutput struct {
+	_ struct{} `type:"structure"`
 
-	// The ID of the reusable delegation set that you want to delete.
+	// A complex type that contains the codes and full continent, country, and subdivision
+	// names for the specified geolocation code.
 	//
-	// Id is a required field
-	Id *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	// GeoLocationDetails is a required field
+	GeoLocationDetails *GeoLocationDetails `type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteReusableDelegationSetInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetGeoLocationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteReusableDelegationSetInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetGeoLocationOutput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteReusableDelegationSetInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteReusableDelegationSetInput"}
-	if s.Id == nil {
-		invalidParams.Add(request.NewErrParamRequired("Id"))
-	}
-	if s.Id != nil && len(*s.Id) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
-	}
+// SetGeoLocationDetails sets the GeoLocationDetails field's value.
+func (s *GetGeoLocationOutput) SetGeoLocationDetails(v *GeoLocationDetails) *GetGeoLocationOutput {
+	s.GeoLocationDetails = v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// A request for the number of health checks that are associated with the current
+// Amazon Web Services account.
+type GetHealthCheckCountInput struct {
+	_ struct{} `locationName:"GetHealthCheckCountRequest" type:"structure"`
 }
 
-// SetId sets the Id field's value.
-func (s *DeleteReusableDelegationSetInput) SetId(v string) *DeleteReusableDelegationSetInput {
-	s.Id = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHealthCheckCountInput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// An empty element.
-type DeleteReusableDelegationSetOutput struct {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHealthCheckCountInput) GoString() string {
+	return s.String()
+}
+
+// A complex type that contains the response to a GetHealthCheckCount request.
+type GetHealthCheckCountOutput struct {
 	_ struct{} `type:"structure"`
+
+	// The number of health checks associated with the current Amazon Web Services
+	// account.
+	//
+	// HealthCheckCount is a required field
+	HealthCheckCount *int64 `type:"long" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteReusableDelegationSetOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHealthCheckCountOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteReusableDelegationSetOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHealthCheckCountOutput) GoString() string {
 	return s.String()
 }
 
-// A request to delete a specified traffic policy version.
-type DeleteTrafficPolicyInput struct {
-	_ struct{} `locationName:"DeleteTrafficPolicyRequest" type:"structure"`
+// SetHealthCheckCount sets the HealthCheckCount field's value.
+func (s *GetHealthCheckCountOutput) SetHealthCheckCount(v int64) *GetHealthCheckCountOutput {
+	s.HealthCheckCount = &v
+	return s
+}
 
-	// The ID of the traffic policy that you want to delete.
-	//
-	// Id is a required field
-	Id *string `location:"uri" locationName:"Id" min:"1" type:"string" required:"true"`
+// A request to get information about a specified health check.
+type GetHealthCheckInput struct {
+	_ struct{} `locationName:"GetHealthCheckRequest" type:"structure"`
 
-	// The version number of the traffic policy that you want to delete.
+	// The identifier that Amazon Route 53 assigned to the health check when you
+	// created it. When you add or update a resource record set, you use this value
+	// to specify which health check to use. The value can be up to 64 characters
+	// long.
 	//
-	// Version is a required field
-	Version *int64 `location:"uri" locationName:"Version" min:"1" type:"integer" required:"true"`
+	// HealthCheckId is a required field
+	HealthCheckId *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteTrafficPolicyInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHealthCheckInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteTrafficPolicyInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHealthCheckInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteTrafficPolicyInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteTrafficPolicyInput"}
-	if s.Id == nil {
-		invalidParams.Add(request.NewErrParamRequired("Id"))
-	}
-	if s.Id != nil && len(*s.Id) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
-	}
-	if s.Version == nil {
-		invalidParams.Add(request.NewErrParamRequired("Version"))
+func (s *GetHealthCheckInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetHealthCheckInput"}
+	if s.HealthCheckId == nil {
+		invalidParams.Add(request.NewErrParamRequired("HealthCheckId"))
 	}
-	if s.Version != nil && *s.Version < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("Version", 1))
+	if s.HealthCheckId != nil && len(*s.HealthCheckId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("HealthCheckId", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -8199,50 +12806,54 @@ func (s *DeleteTrafficPolicyInput) Validate() error {
 	return nil
 }
 
-// SetId sets the Id field's value.
-func (s *DeleteTrafficPolicyInput) SetId(v string) *DeleteTrafficPolicyInput {
-	s.Id = &v
-	return s
-}
-
-// SetVersion sets the Version field's value.
-func (s *DeleteTrafficPolicyInput) SetVersion(v int64) *DeleteTrafficPolicyInput {
-	s.Version = &v
+// SetHealthCheckId sets the HealthCheckId field's value.
+func (s *GetHealthCheckInput) SetHealthCheckId(v string) *GetHealthCheckInput {
+	s.HealthCheckId = &v
 	return s
 }
 
-// A request to delete a specified traffic policy instance.
-type DeleteTrafficPolicyInstanceInput struct {
-	_ struct{} `locationName:"DeleteTrafficPolicyInstanceRequest" type:"structure"`
+// A request for the reason that a health check failed most recently.
+type GetHealthCheckLastFailureReasonInput struct {
+	_ struct{} `locationName:"GetHealthCheckLastFailureReasonRequest" type:"structure"`
 
-	// The ID of the traffic policy instance that you want to delete.
+	// The ID for the health check for which you want the last failure reason. When
+	// you created the health check, CreateHealthCheck returned the ID in the response,
+	// in the HealthCheckId element.
 	//
-	// When you delete a traffic policy instance, Amazon Route 53 also deletes all
-	// of the resource record sets that were created when you created the traffic
-	// policy instance.
+	// If you want to get the last failure reason for a calculated health check,
+	// you must use the Amazon Route 53 console or the CloudWatch console. You can't
+	// use GetHealthCheckLastFailureReason for a calculated health check.
 	//
-	// Id is a required field
-	Id *string `location:"uri" locationName:"Id" min:"1" type:"string" required:"true"`
+	// HealthCheckId is a required field
+	HealthCheckId *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteTrafficPolicyInstanceInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHealthCheckLastFailureReasonInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteTrafficPolicyInstanceInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHealthCheckLastFailureReasonInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteTrafficPolicyInstanceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteTrafficPolicyInstanceInput"}
-	if s.Id == nil {
-		invalidParams.Add(request.NewErrParamRequired("Id"))
+func (s *GetHealthCheckLastFailureReasonInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetHealthCheckLastFailureReasonInput"}
+	if s.HealthCheckId == nil {
+		invalidParams.Add(request.NewErrParamRequired("HealthCheckId"))
 	}
-	if s.Id != nil && len(*s.Id) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
+	if s.HealthCheckId != nil && len(*s.HealthCheckId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("HealthCheckId", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -8251,89 +12862,125 @@ func (s *DeleteTrafficPolicyInstanceInput) Validate() error {
 	return nil
 }
 
-// SetId sets the Id field's value.
-func (s *DeleteTrafficPolicyInstanceInput) SetId(v string) *DeleteTrafficPolicyInstanceInput {
-	s.Id = &v
+// SetHealthCheckId sets the HealthCheckId field's value.
+func (s *GetHealthCheckLastFailureReasonInput) SetHealthCheckId(v string) *GetHealthCheckLastFailureReasonInput {
+	s.HealthCheckId = &v
 	return s
 }
 
-// An empty element.
-type DeleteTrafficPolicyInstanceOutput struct {
+// A complex type that contains the response to a GetHealthCheckLastFailureReason
+// request.
+type GetHealthCheckLastFailureReasonOutput struct {
 	_ struct{} `type:"structure"`
+
+	// A list that contains one Observation element for each Amazon Route 53 health
+	// checker that is reporting a last failure reason.
+	//
+	// HealthCheckObservations is a required field
+	HealthCheckObservations []*HealthCheckObservation `locationNameList:"HealthCheckObservation" type:"list" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteTrafficPolicyInstanceOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHealthCheckLastFailureReasonOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteTrafficPolicyInstanceOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHealthCheckLastFailureReasonOutput) GoString() string {
 	return s.String()
 }
 
-// An empty element.
-type DeleteTrafficPolicyOutput struct {
+// SetHealthCheckObservations sets the HealthCheckObservations field's value.
+func (s *GetHealthCheckLastFailureReasonOutput) SetHealthCheckObservations(v []*HealthCheckObservation) *GetHealthCheckLastFailureReasonOutput {
+	s.HealthCheckObservations = v
+	return s
+}
+
+// A complex type that contains the response to a GetHealthCheck request.
+type GetHealthCheckOutput struct {
 	_ struct{} `type:"structure"`
+
+	// A complex type that contains information about one health check that is associated
+	// with the current Amazon Web Services account.
+	//
+	// HealthCheck is a required field
+	HealthCheck *HealthCheck `type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteTrafficPolicyOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHealthCheckOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteTrafficPolicyOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHealthCheckOutput) GoString() string {
 	return s.String()
 }
 
-// A complex type that contains information about the request to remove authorization
-// to associate a VPC that was created by one AWS account with a hosted zone
-// that was created with a different AWS account.
-type DeleteVPCAssociationAuthorizationInput struct {
-	_ struct{} `locationName:"DeleteVPCAssociationAuthorizationRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
+// SetHealthCheck sets the HealthCheck field's value.
+func (s *GetHealthCheckOutput) SetHealthCheck(v *HealthCheck) *GetHealthCheckOutput {
+	s.HealthCheck = v
+	return s
+}
 
-	// When removing authorization to associate a VPC that was created by one AWS
-	// account with a hosted zone that was created with a different AWS account,
-	// the ID of the hosted zone.
-	//
-	// HostedZoneId is a required field
-	HostedZoneId *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+// A request to get the status for a health check.
+type GetHealthCheckStatusInput struct {
+	_ struct{} `locationName:"GetHealthCheckStatusRequest" type:"structure"`
 
-	// When removing authorization to associate a VPC that was created by one AWS
-	// account with a hosted zone that was created with a different AWS account,
-	// a complex type that includes the ID and region of the VPC.
+	// The ID for the health check that you want the current status for. When you
+	// created the health check, CreateHealthCheck returned the ID in the response,
+	// in the HealthCheckId element.
 	//
-	// VPC is a required field
-	VPC *VPC `type:"structure" required:"true"`
+	// If you want to check the status of a calculated health check, you must use
+	// the Amazon Route 53 console or the CloudWatch console. You can't use GetHealthCheckStatus
+	// to get the status of a calculated health check.
+	//
+	// HealthCheckId is a required field
+	HealthCheckId *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DeleteVPCAssociationAuthorizationInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHealthCheckStatusInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteVPCAssociationAuthorizationInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHealthCheckStatusInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteVPCAssociationAuthorizationInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteVPCAssociationAuthorizationInput"}
-	if s.HostedZoneId == nil {
-		invalidParams.Add(request.NewErrParamRequired("HostedZoneId"))
-	}
-	if s.HostedZoneId != nil && len(*s.HostedZoneId) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("HostedZoneId", 1))
-	}
-	if s.VPC == nil {
-		invalidParams.Add(request.NewErrParamRequired("VPC"))
+func (s *GetHealthCheckStatusInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetHealthCheckStatusInput"}
+	if s.HealthCheckId == nil {
+		invalidParams.Add(request.NewErrParamRequired("HealthCheckId"))
 	}
-	if s.VPC != nil {
-		if err := s.VPC.Validate(); err != nil {
-			invalidParams.AddNested("VPC", err.(request.ErrInvalidParams))
-		}
+	if s.HealthCheckId != nil && len(*s.HealthCheckId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("HealthCheckId", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -8342,214 +12989,210 @@ func (s *DeleteVPCAssociationAuthorizationInput) Validate() error {
 	return nil
 }
 
-// SetHostedZoneId sets the HostedZoneId field's value.
-func (s *DeleteVPCAssociationAuthorizationInput) SetHostedZoneId(v string) *DeleteVPCAssociationAuthorizationInput {
-	s.HostedZoneId = &v
+// SetHealthCheckId sets the HealthCheckId field's value.
+func (s *GetHealthCheckStatusInput) SetHealthCheckId(v string) *GetHealthCheckStatusInput {
+	s.HealthCheckId = &v
 	return s
 }
 
-// SetVPC sets the VPC field's value.
-func (s *DeleteVPCAssociationAuthorizationInput) SetVPC(v *VPC) *DeleteVPCAssociationAuthorizationInput {
-	s.VPC = v
+// A complex type that contains the response to a GetHealthCheck request.
+type GetHealthCheckStatusOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A list that contains one HealthCheckObservation element for each Amazon Route
+	// 53 health checker that is reporting a status about the health check endpoint.
+	//
+	// HealthCheckObservations is a required field
+	HealthCheckObservations []*HealthCheckObservation `locationNameList:"HealthCheckObservation" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHealthCheckStatusOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHealthCheckStatusOutput) GoString() string {
+	return s.String()
+}
+
+// SetHealthCheckObservations sets the HealthCheckObservations field's value.
+func (s *GetHealthCheckStatusOutput) SetHealthCheckObservations(v []*HealthCheckObservation) *GetHealthCheckStatusOutput {
+	s.HealthCheckObservations = v
 	return s
 }
 
-// Empty response for the request.
-type DeleteVPCAssociationAuthorizationOutput struct {
-	_ struct{} `type:"structure"`
+// A request to retrieve a count of all the hosted zones that are associated
+// with the current Amazon Web Services account.
+type GetHostedZoneCountInput struct {
+	_ struct{} `locationName:"GetHostedZoneCountRequest" type:"structure"`
 }
 
-// String returns the string representation
-func (s DeleteVPCAssociationAuthorizationOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHostedZoneCountInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteVPCAssociationAuthorizationOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHostedZoneCountInput) GoString() string {
 	return s.String()
 }
 
-// For the metric that the CloudWatch alarm is associated with, a complex type
-// that contains information about one dimension.
-type Dimension struct {
+// A complex type that contains the response to a GetHostedZoneCount request.
+type GetHostedZoneCountOutput struct {
 	_ struct{} `type:"structure"`
 
-	// For the metric that the CloudWatch alarm is associated with, the name of
-	// one dimension.
-	//
-	// Name is a required field
-	Name *string `min:"1" type:"string" required:"true"`
-
-	// For the metric that the CloudWatch alarm is associated with, the value of
-	// one dimension.
+	// The total number of public and private hosted zones that are associated with
+	// the current Amazon Web Services account.
 	//
-	// Value is a required field
-	Value *string `min:"1" type:"string" required:"true"`
+	// HostedZoneCount is a required field
+	HostedZoneCount *int64 `type:"long" required:"true"`
 }
 
-// String returns the string representation
-func (s Dimension) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHostedZoneCountOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Dimension) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHostedZoneCountOutput) GoString() string {
 	return s.String()
 }
 
-// SetName sets the Name field's value.
-func (s *Dimension) SetName(v string) *Dimension {
-	s.Name = &v
-	return s
-}
-
-// SetValue sets the Value field's value.
-func (s *Dimension) SetValue(v string) *Dimension {
-	s.Value = &v
+// SetHostedZoneCount sets the HostedZoneCount field's value.
+func (s *GetHostedZoneCountOutput) SetHostedZoneCount(v int64) *GetHostedZoneCountOutput {
+	s.HostedZoneCount = &v
 	return s
 }
 
-// A complex type that contains information about the VPC that you want to disassociate
-// from a specified private hosted zone.
-type DisassociateVPCFromHostedZoneInput struct {
-	_ struct{} `locationName:"DisassociateVPCFromHostedZoneRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
-
-	// Optional: A comment about the disassociation request.
-	Comment *string `type:"string"`
-
-	// The ID of the private hosted zone that you want to disassociate a VPC from.
-	//
-	// HostedZoneId is a required field
-	HostedZoneId *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+// A request to get information about a specified hosted zone.
+type GetHostedZoneInput struct {
+	_ struct{} `locationName:"GetHostedZoneRequest" type:"structure"`
 
-	// A complex type that contains information about the VPC that you're disassociating
-	// from the specified hosted zone.
+	// The ID of the hosted zone that you want to get information about.
 	//
-	// VPC is a required field
-	VPC *VPC `type:"structure" required:"true"`
+	// Id is a required field
+	Id *string `location:"uri" locationName:"Id" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s DisassociateVPCFromHostedZoneInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHostedZoneInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DisassociateVPCFromHostedZoneInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHostedZoneInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DisassociateVPCFromHostedZoneInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DisassociateVPCFromHostedZoneInput"}
-	if s.HostedZoneId == nil {
-		invalidParams.Add(request.NewErrParamRequired("HostedZoneId"))
-	}
-	if s.HostedZoneId != nil && len(*s.HostedZoneId) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("HostedZoneId", 1))
-	}
-	if s.VPC == nil {
-		invalidParams.Add(request.NewErrParamRequired("VPC"))
+func (s *GetHostedZoneInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetHostedZoneInput"}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
 	}
-	if s.VPC != nil {
-		if err := s.VPC.Validate(); err != nil {
-			invalidParams.AddNested("VPC", err.(request.ErrInvalidParams))
-		}
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
 	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
-	}
-	return nil
-}
-
-// SetComment sets the Comment field's value.
-func (s *DisassociateVPCFromHostedZoneInput) SetComment(v string) *DisassociateVPCFromHostedZoneInput {
-	s.Comment = &v
-	return s
-}
-
-// SetHostedZoneId sets the HostedZoneId field's value.
-func (s *DisassociateVPCFromHostedZoneInput) SetHostedZoneId(v string) *DisassociateVPCFromHostedZoneInput {
-	s.HostedZoneId = &v
-	return s
-}
-
-// SetVPC sets the VPC field's value.
-func (s *DisassociateVPCFromHostedZoneInput) SetVPC(v *VPC) *DisassociateVPCFromHostedZoneInput {
-	s.VPC = v
-	return s
-}
-
-// A complex type that contains the response information for the disassociate
-// request.
-type DisassociateVPCFromHostedZoneOutput struct {
-	_ struct{} `type:"structure"`
-
-	// A complex type that describes the changes made to the specified private hosted
-	// zone.
-	//
-	// ChangeInfo is a required field
-	ChangeInfo *ChangeInfo `type:"structure" required:"true"`
-}
-
-// String returns the string representation
-func (s DisassociateVPCFromHostedZoneOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s DisassociateVPCFromHostedZoneOutput) GoString() string {
-	return s.String()
+	}
+	return nil
 }
 
-// SetChangeInfo sets the ChangeInfo field's value.
-func (s *DisassociateVPCFromHostedZoneOutput) SetChangeInfo(v *ChangeInfo) *DisassociateVPCFromHostedZoneOutput {
-	s.ChangeInfo = v
+// SetId sets the Id field's value.
+func (s *GetHostedZoneInput) SetId(v string) *GetHostedZoneInput {
+	s.Id = &v
 	return s
 }
 
-// A complex type that contains information about a geographic location.
-type GeoLocation struct {
-	_ struct{} `type:"structure"`
+// A complex type that contains information about the request to create a hosted
+// zone.
+type GetHostedZoneLimitInput struct {
+	_ struct{} `locationName:"GetHostedZoneLimitRequest" type:"structure"`
 
-	// The two-letter code for the continent.
-	//
-	// Valid values: AF | AN | AS | EU | OC | NA | SA
+	// The ID of the hosted zone that you want to get a limit for.
 	//
-	// Constraint: Specifying ContinentCode with either CountryCode or SubdivisionCode
-	// returns an InvalidInput error.
-	ContinentCode *string `min:"2" type:"string"`
-
-	// The two-letter code for the country.
-	CountryCode *string `min:"1" type:"string"`
+	// HostedZoneId is a required field
+	HostedZoneId *string `location:"uri" locationName:"Id" type:"string" required:"true"`
 
-	// The code for the subdivision. Route 53 currently supports only states in
-	// the United States.
-	SubdivisionCode *string `min:"1" type:"string"`
+	// The limit that you want to get. Valid values include the following:
+	//
+	//    * MAX_RRSETS_BY_ZONE: The maximum number of records that you can create
+	//    in the specified hosted zone.
+	//
+	//    * MAX_VPCS_ASSOCIATED_BY_ZONE: The maximum number of Amazon VPCs that
+	//    you can associate with the specified private hosted zone.
+	//
+	// Type is a required field
+	Type *string `location:"uri" locationName:"Type" type:"string" required:"true" enum:"HostedZoneLimitType"`
 }
 
-// String returns the string representation
-func (s GeoLocation) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHostedZoneLimitInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GeoLocation) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHostedZoneLimitInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *GeoLocation) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GeoLocation"}
-	if s.ContinentCode != nil && len(*s.ContinentCode) < 2 {
-		invalidParams.Add(request.NewErrParamMinLen("ContinentCode", 2))
+func (s *GetHostedZoneLimitInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetHostedZoneLimitInput"}
+	if s.HostedZoneId == nil {
+		invalidParams.Add(request.NewErrParamRequired("HostedZoneId"))
 	}
-	if s.CountryCode != nil && len(*s.CountryCode) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("CountryCode", 1))
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("HostedZoneId", 1))
 	}
-	if s.SubdivisionCode != nil && len(*s.SubdivisionCode) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("SubdivisionCode", 1))
+	if s.Type == nil {
+		invalidParams.Add(request.NewErrParamRequired("Type"))
+	}
+	if s.Type != nil && len(*s.Type) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Type", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -8558,142 +13201,160 @@ func (s *GeoLocation) Validate() error {
 	return nil
 }
 
-// SetContinentCode sets the ContinentCode field's value.
-func (s *GeoLocation) SetContinentCode(v string) *GeoLocation {
-	s.ContinentCode = &v
-	return s
-}
-
-// SetCountryCode sets the CountryCode field's value.
-func (s *GeoLocation) SetCountryCode(v string) *GeoLocation {
-	s.CountryCode = &v
+// SetHostedZoneId sets the HostedZoneId field's value.
+func (s *GetHostedZoneLimitInput) SetHostedZoneId(v string) *GetHostedZoneLimitInput {
+	s.HostedZoneId = &v
 	return s
 }
 
-// SetSubdivisionCode sets the SubdivisionCode field's value.
-func (s *GeoLocation) SetSubdivisionCode(v string) *GeoLocation {
-	s.SubdivisionCode = &v
+// SetType sets the Type field's value.
+func (s *GetHostedZoneLimitInput) SetType(v string) *GetHostedZoneLimitInput {
+	s.Type = &v
 	return s
 }
 
-// A complex type that contains the codes and full continent, country, and subdivision
-// names for the specified geolocation code.
-type GeoLocationDetails struct {
+// A complex type that contains the requested limit.
+type GetHostedZoneLimitOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The two-letter code for the continent.
-	ContinentCode *string `min:"2" type:"string"`
-
-	// The full name of the continent.
-	ContinentName *string `min:"1" type:"string"`
-
-	// The two-letter code for the country.
-	CountryCode *string `min:"1" type:"string"`
-
-	// The name of the country.
-	CountryName *string `min:"1" type:"string"`
-
-	// The code for the subdivision. Route 53 currently supports only states in
-	// the United States.
-	SubdivisionCode *string `min:"1" type:"string"`
+	// The current number of entities that you have created of the specified type.
+	// For example, if you specified MAX_RRSETS_BY_ZONE for the value of Type in
+	// the request, the value of Count is the current number of records that you
+	// have created in the specified hosted zone.
+	//
+	// Count is a required field
+	Count *int64 `type:"long" required:"true"`
 
-	// The full name of the subdivision. Route 53 currently supports only states
-	// in the United States.
-	SubdivisionName *string `min:"1" type:"string"`
+	// The current setting for the specified limit. For example, if you specified
+	// MAX_RRSETS_BY_ZONE for the value of Type in the request, the value of Limit
+	// is the maximum number of records that you can create in the specified hosted
+	// zone.
+	//
+	// Limit is a required field
+	Limit *HostedZoneLimit `type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s GeoLocationDetails) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHostedZoneLimitOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GeoLocationDetails) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHostedZoneLimitOutput) GoString() string {
 	return s.String()
 }
 
-// SetContinentCode sets the ContinentCode field's value.
-func (s *GeoLocationDetails) SetContinentCode(v string) *GeoLocationDetails {
-	s.ContinentCode = &v
+// SetCount sets the Count field's value.
+func (s *GetHostedZoneLimitOutput) SetCount(v int64) *GetHostedZoneLimitOutput {
+	s.Count = &v
 	return s
 }
 
-// SetContinentName sets the ContinentName field's value.
-func (s *GeoLocationDetails) SetContinentName(v string) *GeoLocationDetails {
-	s.ContinentName = &v
+// SetLimit sets the Limit field's value.
+func (s *GetHostedZoneLimitOutput) SetLimit(v *HostedZoneLimit) *GetHostedZoneLimitOutput {
+	s.Limit = v
 	return s
 }
 
-// SetCountryCode sets the CountryCode field's value.
-func (s *GeoLocationDetails) SetCountryCode(v string) *GeoLocationDetails {
-	s.CountryCode = &v
-	return s
+// A complex type that contain the response to a GetHostedZone request.
+type GetHostedZoneOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A complex type that lists the Amazon Route 53 name servers for the specified
+	// hosted zone.
+	DelegationSet *DelegationSet `type:"structure"`
+
+	// A complex type that contains general information about the specified hosted
+	// zone.
+	//
+	// HostedZone is a required field
+	HostedZone *HostedZone `type:"structure" required:"true"`
+
+	// A complex type that contains information about the VPCs that are associated
+	// with the specified hosted zone.
+	VPCs []*VPC `locationNameList:"VPC" min:"1" type:"list"`
 }
 
-// SetCountryName sets the CountryName field's value.
-func (s *GeoLocationDetails) SetCountryName(v string) *GeoLocationDetails {
-	s.CountryName = &v
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHostedZoneOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetHostedZoneOutput) GoString() string {
+	return s.String()
+}
+
+// SetDelegationSet sets the DelegationSet field's value.
+func (s *GetHostedZoneOutput) SetDelegationSet(v *DelegationSet) *GetHostedZoneOutput {
+	s.DelegationSet = v
 	return s
 }
 
-// SetSubdivisionCode sets the SubdivisionCode field's value.
-func (s *GeoLocationDetails) SetSubdivisionCode(v string) *GeoLocationDetails {
-	s.SubdivisionCode = &v
+// SetHostedZone sets the HostedZone field's value.
+func (s *GetHostedZoneOutput) SetHostedZone(v *HostedZone) *GetHostedZoneOutput {
+	s.HostedZone = v
 	return s
 }
 
-// SetSubdivisionName sets the SubdivisionName field's value.
-func (s *GeoLocationDetails) SetSubdivisionName(v string) *GeoLocationDetails {
-	s.SubdivisionName = &v
+// SetVPCs sets the VPCs field's value.
+func (s *GetHostedZoneOutput) SetVPCs(v []*VPC) *GetHostedZoneOutput {
+	s.VPCs = v
 	return s
 }
 
-// A complex type that contains information about the request to create a hosted
-// zone.
-type GetAccountLimitInput struct {
-	_ struct{} `locationName:"GetAccountLimitRequest" type:"structure"`
+type GetQueryLoggingConfigInput struct {
+	_ struct{} `locationName:"GetQueryLoggingConfigRequest" type:"structure"`
 
-	// The limit that you want to get. Valid values include the following:
-	//
-	//    * MAX_HEALTH_CHECKS_BY_OWNER: The maximum number of health checks that
-	//    you can create using the current account.
-	//
-	//    * MAX_HOSTED_ZONES_BY_OWNER: The maximum number of hosted zones that you
-	//    can create using the current account.
-	//
-	//    * MAX_REUSABLE_DELEGATION_SETS_BY_OWNER: The maximum number of reusable
-	//    delegation sets that you can create using the current account.
-	//
-	//    * MAX_TRAFFIC_POLICIES_BY_OWNER: The maximum number of traffic policies
-	//    that you can create using the current account.
-	//
-	//    * MAX_TRAFFIC_POLICY_INSTANCES_BY_OWNER: The maximum number of traffic
-	//    policy instances that you can create using the current account. (Traffic
-	//    policy instances are referred to as traffic flow policy records in the
-	//    Amazon Route 53 console.)
+	// The ID of the configuration for DNS query logging that you want to get information
+	// about.
 	//
-	// Type is a required field
-	Type *string `location:"uri" locationName:"Type" type:"string" required:"true" enum:"AccountLimitType"`
+	// Id is a required field
+	Id *string `location:"uri" locationName:"Id" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s GetAccountLimitInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetQueryLoggingConfigInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetAccountLimitInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetQueryLoggingConfigInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *GetAccountLimitInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetAccountLimitInput"}
-	if s.Type == nil {
-		invalidParams.Add(request.NewErrParamRequired("Type"))
+func (s *GetQueryLoggingConfigInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetQueryLoggingConfigInput"}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
 	}
-	if s.Type != nil && len(*s.Type) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Type", 1))
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -8702,80 +13363,79 @@ func (s *GetAccountLimitInput) Validate() error {
 	return nil
 }
 
-// SetType sets the Type field's value.
-func (s *GetAccountLimitInput) SetType(v string) *GetAccountLimitInput {
-	s.Type = &v
+// SetId sets the Id field's value.
+func (s *GetQueryLoggingConfigInput) SetId(v string) *GetQueryLoggingConfigInput {
+	s.Id = &v
 	return s
 }
 
-// A complex type that contains the requested limit.
-type GetAccountLimitOutput struct {
+type GetQueryLoggingConfigOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The current number of entities that you have created of the specified type.
-	// For example, if you specified MAX_HEALTH_CHECKS_BY_OWNER for the value of
-	// Type in the request, the value of Count is the current number of health checks
-	// that you have created using the current account.
-	//
-	// Count is a required field
-	Count *int64 `type:"long" required:"true"`
-
-	// The current setting for the specified limit. For example, if you specified
-	// MAX_HEALTH_CHECKS_BY_OWNER for the value of Type in the request, the value
-	// of Limit is the maximum number of health checks that you can create using
-	// the current account.
+	// A complex type that contains information about the query logging configuration
+	// that you specified in a GetQueryLoggingConfig (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetQueryLoggingConfig.html)
+	// request.
 	//
-	// Limit is a required field
-	Limit *AccountLimit `type:"structure" required:"true"`
+	// QueryLoggingConfig is a required field
+	QueryLoggingConfig *QueryLoggingConfig `type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s GetAccountLimitOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetQueryLoggingConfigOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetAccountLimitOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetQueryLoggingConfigOutput) GoString() string {
 	return s.String()
 }
 
-// SetCount sets the Count field's value.
-func (s *GetAccountLimitOutput) SetCount(v int64) *GetAccountLimitOutput {
-	s.Count = &v
-	return s
-}
-
-// SetLimit sets the Limit field's value.
-func (s *GetAccountLimitOutput) SetLimit(v *AccountLimit) *GetAccountLimitOutput {
-	s.Limit = v
+// SetQueryLoggingConfig sets the QueryLoggingConfig field's value.
+func (s *GetQueryLoggingConfigOutput) SetQueryLoggingConfig(v *QueryLoggingConfig) *GetQueryLoggingConfigOutput {
+	s.QueryLoggingConfig = v
 	return s
 }
 
-// The input for a GetChange request.
-type GetChangeInput struct {
-	_ struct{} `locationName:"GetChangeRequest" type:"structure"`
+// A request to get information about a specified reusable delegation set.
+type GetReusableDelegationSetInput struct {
+	_ struct{} `locationName:"GetReusableDelegationSetRequest" type:"structure"`
 
-	// The ID of the change batch request. The value that you specify here is the
-	// value that ChangeResourceRecordSets returned in the Id element when you submitted
-	// the request.
+	// The ID of the reusable delegation set that you want to get a list of name
+	// servers for.
 	//
 	// Id is a required field
 	Id *string `location:"uri" locationName:"Id" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s GetChangeInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetReusableDelegationSetInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetChangeInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetReusableDelegationSetInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *GetChangeInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetChangeInput"}
+func (s *GetReusableDelegationSetInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetReusableDelegationSetInput"}
 	if s.Id == nil {
 		invalidParams.Add(request.NewErrParamRequired("Id"))
 	}
@@ -8790,260 +13450,327 @@ func (s *GetChangeInput) Validate() error {
 }
 
 // SetId sets the Id field's value.
-func (s *GetChangeInput) SetId(v string) *GetChangeInput {
+func (s *GetReusableDelegationSetInput) SetId(v string) *GetReusableDelegationSetInput {
 	s.Id = &v
 	return s
 }
 
-// A complex type that contains the ChangeInfo element.
-type GetChangeOutput struct {
-	_ struct{} `type:"structure"`
+// A complex type that contains information about the request to create a hosted
+// zone.
+type GetReusableDelegationSetLimitInput struct {
+	_ struct{} `locationName:"GetReusableDelegationSetLimitRequest" type:"structure"`
 
-	// A complex type that contains information about the specified change batch.
+	// The ID of the delegation set that you want to get the limit for.
 	//
-	// ChangeInfo is a required field
-	ChangeInfo *ChangeInfo `type:"structure" required:"true"`
+	// DelegationSetId is a required field
+	DelegationSetId *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+
+	// Specify MAX_ZONES_BY_REUSABLE_DELEGATION_SET to get the maximum number of
+	// hosted zones that you can associate with the specified reusable delegation
+	// set.
+	//
+	// Type is a required field
+	Type *string `location:"uri" locationName:"Type" type:"string" required:"true" enum:"ReusableDelegationSetLimitType"`
 }
 
-// String returns the string representation
-func (s GetChangeOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetReusableDelegationSetLimitInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetChangeOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetReusableDelegationSetLimitInput) GoString() string {
 	return s.String()
 }
 
-// SetChangeInfo sets the ChangeInfo field's value.
-func (s *GetChangeOutput) SetChangeInfo(v *ChangeInfo) *GetChangeOutput {
-	s.ChangeInfo = v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetReusableDelegationSetLimitInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetReusableDelegationSetLimitInput"}
+	if s.DelegationSetId == nil {
+		invalidParams.Add(request.NewErrParamRequired("DelegationSetId"))
+	}
+	if s.DelegationSetId != nil && len(*s.DelegationSetId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("DelegationSetId", 1))
+	}
+	if s.Type == nil {
+		invalidParams.Add(request.NewErrParamRequired("Type"))
+	}
+	if s.Type != nil && len(*s.Type) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Type", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDelegationSetId sets the DelegationSetId field's value.
+func (s *GetReusableDelegationSetLimitInput) SetDelegationSetId(v string) *GetReusableDelegationSetLimitInput {
+	s.DelegationSetId = &v
 	return s
 }
 
-// Empty request.
-type GetCheckerIpRangesInput struct {
-	_ struct{} `locationName:"GetCheckerIpRangesRequest" type:"structure"`
+// SetType sets the Type field's value.
+func (s *GetReusableDelegationSetLimitInput) SetType(v string) *GetReusableDelegationSetLimitInput {
+	s.Type = &v
+	return s
 }
 
-// String returns the string representation
-func (s GetCheckerIpRangesInput) String() string {
+// A complex type that contains the requested limit.
+type GetReusableDelegationSetLimitOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The current number of hosted zones that you can associate with the specified
+	// reusable delegation set.
+	//
+	// Count is a required field
+	Count *int64 `type:"long" required:"true"`
+
+	// The current setting for the limit on hosted zones that you can associate
+	// with the specified reusable delegation set.
+	//
+	// Limit is a required field
+	Limit *ReusableDelegationSetLimit `type:"structure" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetReusableDelegationSetLimitOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetCheckerIpRangesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetReusableDelegationSetLimitOutput) GoString() string {
 	return s.String()
 }
 
-// A complex type that contains the CheckerIpRanges element.
-type GetCheckerIpRangesOutput struct {
+// SetCount sets the Count field's value.
+func (s *GetReusableDelegationSetLimitOutput) SetCount(v int64) *GetReusableDelegationSetLimitOutput {
+	s.Count = &v
+	return s
+}
+
+// SetLimit sets the Limit field's value.
+func (s *GetReusableDelegationSetLimitOutput) SetLimit(v *ReusableDelegationSetLimit) *GetReusableDelegationSetLimitOutput {
+	s.Limit = v
+	return s
+}
+
+// A complex type that contains the response to the GetReusableDelegationSet
+// request.
+type GetReusableDelegationSetOutput struct {
 	_ struct{} `type:"structure"`
 
-	// A complex type that contains sorted list of IP ranges in CIDR format for
-	// Amazon Route 53 health checkers.
+	// A complex type that contains information about the reusable delegation set.
 	//
-	// CheckerIpRanges is a required field
-	CheckerIpRanges []*string `type:"list" required:"true"`
+	// DelegationSet is a required field
+	DelegationSet *DelegationSet `type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s GetCheckerIpRangesOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetReusableDelegationSetOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetCheckerIpRangesOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetReusableDelegationSetOutput) GoString() string {
 	return s.String()
 }
 
-// SetCheckerIpRanges sets the CheckerIpRanges field's value.
-func (s *GetCheckerIpRangesOutput) SetCheckerIpRanges(v []*string) *GetCheckerIpRangesOutput {
-	s.CheckerIpRanges = v
+// SetDelegationSet sets the DelegationSet field's value.
+func (s *GetReusableDelegationSetOutput) SetDelegationSet(v *DelegationSet) *GetReusableDelegationSetOutput {
+	s.DelegationSet = v
 	return s
 }
 
-// A request for information about whether a specified geographic location is
-// supported for Amazon Route 53 geolocation resource record sets.
-type GetGeoLocationInput struct {
-	_ struct{} `locationName:"GetGeoLocationRequest" type:"structure"`
+// Gets information about a specific traffic policy version.
+type GetTrafficPolicyInput struct {
+	_ struct{} `locationName:"GetTrafficPolicyRequest" type:"structure"`
 
-	// Amazon Route 53 supports the following continent codes:
-	//
-	//    * AF: Africa
-	//
-	//    * AN: Antarctica
-	//
-	//    * AS: Asia
-	//
-	//    * EU: Europe
-	//
-	//    * OC: Oceania
-	//
-	//    * NA: North America
+	// The ID of the traffic policy that you want to get information about.
 	//
-	//    * SA: South America
-	ContinentCode *string `location:"querystring" locationName:"continentcode" min:"2" type:"string"`
-
-	// Amazon Route 53 uses the two-letter country codes that are specified in ISO
-	// standard 3166-1 alpha-2 (https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2).
-	CountryCode *string `location:"querystring" locationName:"countrycode" min:"1" type:"string"`
+	// Id is a required field
+	Id *string `location:"uri" locationName:"Id" min:"1" type:"string" required:"true"`
 
-	// Amazon Route 53 uses the one- to three-letter subdivision codes that are
-	// specified in ISO standard 3166-1 alpha-2 (https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2).
-	// Route 53 doesn't support subdivision codes for all countries. If you specify
-	// subdivisioncode, you must also specify countrycode.
-	SubdivisionCode *string `location:"querystring" locationName:"subdivisioncode" min:"1" type:"string"`
+	// The version number of the traffic policy that you want to get information
+	// about.
+	//
+	// Version is a required field
+	Version *int64 `location:"uri" locationName:"Version" min:"1" type:"integer" required:"true"`
 }
 
-// String returns the string representation
-func (s GetGeoLocationInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTrafficPolicyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetGeoLocationInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTrafficPolicyInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *GetGeoLocationInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetGeoLocationInput"}
-	if s.ContinentCode != nil && len(*s.ContinentCode) < 2 {
-		invalidParams.Add(request.NewErrParamMinLen("ContinentCode", 2))
-	}
-	if s.CountryCode != nil && len(*s.CountryCode) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("CountryCode", 1))
+func (s *GetTrafficPolicyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetTrafficPolicyInput"}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
 	}
-	if s.SubdivisionCode != nil && len(*s.SubdivisionCode) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("SubdivisionCode", 1))
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
 	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
+	if s.Version == nil {
+		invalidParams.Add(request.NewErrParamRequired("Version"))
+	}
+	if s.Version != nil && *s.Version < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Version", 1))
 	}
-	return nil
-}
-
-// SetContinentCode sets the ContinentCode field's value.
-func (s *GetGeoLocationInput) SetContinentCode(v string) *GetGeoLocationInput {
-	s.ContinentCode = &v
-	return s
-}
-
-// SetCountryCode sets the CountryCode field's value.
-func (s *GetGeoLocationInput) SetCountryCode(v string) *GetGeoLocationInput {
-	s.CountryCode = &v
-	return s
-}
-
-// SetSubdivisionCode sets the SubdivisionCode field's value.
-func (s *GetGeoLocationInput) SetSubdivisionCode(v string) *GetGeoLocationInput {
-	s.SubdivisionCode = &v
-	return s
-}
-
-// A complex type that contains the response information for the specified geolocation
-// code.
-type GetGeoLocationOutput struct {
-	_ struct{} `type:"structure"`
-
-	// A complex type that contains the codes and full continent, country, and subdivision
-	// names for the specified geolocation code.
-	//
-	// GeoLocationDetails is a required field
-	GeoLocationDetails *GeoLocationDetails `type:"structure" required:"true"`
-}
 
-// String returns the string representation
-func (s GetGeoLocationOutput) String() string {
-	return awsutil.Prettify(s)
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// GoString returns the string representation
-func (s GetGeoLocationOutput) GoString() string {
-	return s.String()
+// SetId sets the Id field's value.
+func (s *GetTrafficPolicyInput) SetId(v string) *GetTrafficPolicyInput {
+	s.Id = &v
+	return s
 }
 
-// SetGeoLocationDetails sets the GeoLocationDetails field's value.
-func (s *GetGeoLocationOutput) SetGeoLocationDetails(v *GeoLocationDetails) *GetGeoLocationOutput {
-	s.GeoLocationDetails = v
+// SetVersion sets the Version field's value.
+func (s *GetTrafficPolicyInput) SetVersion(v int64) *GetTrafficPolicyInput {
+	s.Version = &v
 	return s
 }
 
-// A request for the number of health checks that are associated with the current
-// AWS account.
-type GetHealthCheckCountInput struct {
-	_ struct{} `locationName:"GetHealthCheckCountRequest" type:"structure"`
+// Request to get the number of traffic policy instances that are associated
+// with the current Amazon Web Services account.
+type GetTrafficPolicyInstanceCountInput struct {
+	_ struct{} `locationName:"GetTrafficPolicyInstanceCountRequest" type:"structure"`
 }
 
-// String returns the string representation
-func (s GetHealthCheckCountInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTrafficPolicyInstanceCountInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetHealthCheckCountInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTrafficPolicyInstanceCountInput) GoString() string {
 	return s.String()
 }
 
-// A complex type that contains the response to a GetHealthCheckCount request.
-type GetHealthCheckCountOutput struct {
+// A complex type that contains information about the resource record sets that
+// Amazon Route 53 created based on a specified traffic policy.
+type GetTrafficPolicyInstanceCountOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The number of health checks associated with the current AWS account.
+	// The number of traffic policy instances that are associated with the current
+	// Amazon Web Services account.
 	//
-	// HealthCheckCount is a required field
-	HealthCheckCount *int64 `type:"long" required:"true"`
+	// TrafficPolicyInstanceCount is a required field
+	TrafficPolicyInstanceCount *int64 `type:"integer" required:"true"`
 }
 
-// String returns the string representation
-func (s GetHealthCheckCountOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTrafficPolicyInstanceCountOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetHealthCheckCountOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTrafficPolicyInstanceCountOutput) GoString() string {
 	return s.String()
 }
 
-// SetHealthCheckCount sets the HealthCheckCount field's value.
-func (s *GetHealthCheckCountOutput) SetHealthCheckCount(v int64) *GetHealthCheckCountOutput {
-	s.HealthCheckCount = &v
+// SetTrafficPolicyInstanceCount sets the TrafficPolicyInstanceCount field's value.
+func (s *GetTrafficPolicyInstanceCountOutput) SetTrafficPolicyInstanceCount(v int64) *GetTrafficPolicyInstanceCountOutput {
+	s.TrafficPolicyInstanceCount = &v
 	return s
 }
 
-// A request to get information about a specified health check.
-type GetHealthCheckInput struct {
-	_ struct{} `locationName:"GetHealthCheckRequest" type:"structure"`
+// Gets information about a specified traffic policy instance.
+type GetTrafficPolicyInstanceInput struct {
+	_ struct{} `locationName:"GetTrafficPolicyInstanceRequest" type:"structure"`
 
-	// The identifier that Amazon Route 53 assigned to the health check when you
-	// created it. When you add or update a resource record set, you use this value
-	// to specify which health check to use. The value can be up to 64 characters
-	// long.
+	// The ID of the traffic policy instance that you want to get information about.
 	//
-	// HealthCheckId is a required field
-	HealthCheckId *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
+	// Id is a required field
+	Id *string `location:"uri" locationName:"Id" min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s GetHealthCheckInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTrafficPolicyInstanceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetHealthCheckInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTrafficPolicyInstanceInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *GetHealthCheckInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetHealthCheckInput"}
-	if s.HealthCheckId == nil {
-		invalidParams.Add(request.NewErrParamRequired("HealthCheckId"))
+func (s *GetTrafficPolicyInstanceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetTrafficPolicyInstanceInput"}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
 	}
-	if s.HealthCheckId != nil && len(*s.HealthCheckId) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("HealthCheckId", 1))
+	if s.Id != nil && len(*s.Id) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -9052,529 +13779,525 @@ func (s *GetHealthCheckInput) Validate() error {
 	return nil
 }
 
-// SetHealthCheckId sets the HealthCheckId field's value.
-func (s *GetHealthCheckInput) SetHealthCheckId(v string) *GetHealthCheckInput {
-	s.HealthCheckId = &v
+// SetId sets the Id field's value.
+func (s *GetTrafficPolicyInstanceInput) SetId(v string) *GetTrafficPolicyInstanceInput {
+	s.Id = &v
 	return s
 }
 
-// A request for the reason that a health check failed most recently.
-type GetHealthCheckLastFailureReasonInput struct {
-	_ struct{} `locationName:"GetHealthCheckLastFailureReasonRequest" type:"structure"`
+// A complex type that contains information about the resource record sets that
+// Amazon Route 53 created based on a specified traffic policy.
+type GetTrafficPolicyInstanceOutput struct {
+	_ struct{} `type:"structure"`
 
-	// The ID for the health check for which you want the last failure reason. When
-	// you created the health check, CreateHealthCheck returned the ID in the response,
-	// in the HealthCheckId element.
-	//
-	// If you want to get the last failure reason for a calculated health check,
-	// you must use the Amazon Route 53 console or the CloudWatch console. You can't
-	// use GetHealthCheckLastFailureReason for a calculated health check.
+	// A complex type that contains settings for the traffic policy instance.
 	//
-	// HealthCheckId is a required field
-	HealthCheckId *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
+	// TrafficPolicyInstance is a required field
+	TrafficPolicyInstance *TrafficPolicyInstance `type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s GetHealthCheckLastFailureReasonInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTrafficPolicyInstanceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetHealthCheckLastFailureReasonInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTrafficPolicyInstanceOutput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetHealthCheckLastFailureReasonInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetHealthCheckLastFailureReasonInput"}
-	if s.HealthCheckId == nil {
-		invalidParams.Add(request.NewErrParamRequired("HealthCheckId"))
-	}
-	if s.HealthCheckId != nil && len(*s.HealthCheckId) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("HealthCheckId", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
-// SetHealthCheckId sets the HealthCheckId field's value.
-func (s *GetHealthCheckLastFailureReasonInput) SetHealthCheckId(v string) *GetHealthCheckLastFailureReasonInput {
-	s.HealthCheckId = &v
+// SetTrafficPolicyInstance sets the TrafficPolicyInstance field's value.
+func (s *GetTrafficPolicyInstanceOutput) SetTrafficPolicyInstance(v *TrafficPolicyInstance) *GetTrafficPolicyInstanceOutput {
+	s.TrafficPolicyInstance = v
 	return s
 }
 
-// A complex type that contains the response to a GetHealthCheckLastFailureReason
-// request.
-type GetHealthCheckLastFailureReasonOutput struct {
+// A complex type that contains the response information for the request.
+type GetTrafficPolicyOutput struct {
 	_ struct{} `type:"structure"`
 
-	// A list that contains one Observation element for each Amazon Route 53 health
-	// checker that is reporting a last failure reason.
+	// A complex type that contains settings for the specified traffic policy.
 	//
-	// HealthCheckObservations is a required field
-	HealthCheckObservations []*HealthCheckObservation `locationNameList:"HealthCheckObservation" type:"list" required:"true"`
+	// TrafficPolicy is a required field
+	TrafficPolicy *TrafficPolicy `type:"structure" required:"true"`
 }
 
-// String returns the string representation
-func (s GetHealthCheckLastFailureReasonOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTrafficPolicyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetHealthCheckLastFailureReasonOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTrafficPolicyOutput) GoString() string {
 	return s.String()
 }
 
-// SetHealthCheckObservations sets the HealthCheckObservations field's value.
-func (s *GetHealthCheckLastFailureReasonOutput) SetHealthCheckObservations(v []*HealthCheckObservation) *GetHealthCheckLastFailureReasonOutput {
-	s.HealthCheckObservations = v
+// SetTrafficPolicy sets the TrafficPolicy field's value.
+func (s *GetTrafficPolicyOutput) SetTrafficPolicy(v *TrafficPolicy) *GetTrafficPolicyOutput {
+	s.TrafficPolicy = v
 	return s
 }
 
-// A complex type that contains the response to a GetHealthCheck request.
-type GetHealthCheckOutput struct {
+// A complex type that contains information about one health check that is associated
+// with the current Amazon Web Services account.
+type HealthCheck struct {
 	_ struct{} `type:"structure"`
 
-	// A complex type that contains information about one health check that is associated
-	// with the current AWS account.
+	// A unique string that you specified when you created the health check.
 	//
-	// HealthCheck is a required field
-	HealthCheck *HealthCheck `type:"structure" required:"true"`
-}
-
-// String returns the string representation
-func (s GetHealthCheckOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s GetHealthCheckOutput) GoString() string {
-	return s.String()
-}
-
-// SetHealthCheck sets the HealthCheck field's value.
-func (s *GetHealthCheckOutput) SetHealthCheck(v *HealthCheck) *GetHealthCheckOutput {
-	s.HealthCheck = v
-	return s
-}
+	// CallerReference is a required field
+	CallerReference *string `min:"1" type:"string" required:"true"`
 
-// A request to get the status for a health check.
-type GetHealthCheckStatusInput struct {
-	_ struct{} `locationName:"GetHealthCheckStatusRequest" type:"structure"`
+	// A complex type that contains information about the CloudWatch alarm that
+	// Amazon Route 53 is monitoring for this health check.
+	CloudWatchAlarmConfiguration *CloudWatchAlarmConfiguration `type:"structure"`
 
-	// The ID for the health check that you want the current status for. When you
-	// created the health check, CreateHealthCheck returned the ID in the response,
-	// in the HealthCheckId element.
-	//
-	// If you want to check the status of a calculated health check, you must use
-	// the Amazon Route 53 console or the CloudWatch console. You can't use GetHealthCheckStatus
-	// to get the status of a calculated health check.
+	// A complex type that contains detailed information about one health check.
 	//
-	// HealthCheckId is a required field
-	HealthCheckId *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
-}
-
-// String returns the string representation
-func (s GetHealthCheckStatusInput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s GetHealthCheckStatusInput) GoString() string {
-	return s.String()
-}
-
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetHealthCheckStatusInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetHealthCheckStatusInput"}
-	if s.HealthCheckId == nil {
-		invalidParams.Add(request.NewErrParamRequired("HealthCheckId"))
-	}
-	if s.HealthCheckId != nil && len(*s.HealthCheckId) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("HealthCheckId", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
-// SetHealthCheckId sets the HealthCheckId field's value.
-func (s *GetHealthCheckStatusInput) SetHealthCheckId(v string) *GetHealthCheckStatusInput {
-	s.HealthCheckId = &v
-	return s
-}
+	// HealthCheckConfig is a required field
+	HealthCheckConfig *HealthCheckConfig `type:"structure" required:"true"`
 
-// A complex type that contains the response to a GetHealthCheck request.
-type GetHealthCheckStatusOutput struct {
-	_ struct{} `type:"structure"`
+	// The version of the health check. You can optionally pass this value in a
+	// call to UpdateHealthCheck to prevent overwriting another change to the health
+	// check.
+	//
+	// HealthCheckVersion is a required field
+	HealthCheckVersion *int64 `min:"1" type:"long" required:"true"`
 
-	// A list that contains one HealthCheckObservation element for each Amazon Route
-	// 53 health checker that is reporting a status about the health check endpoint.
+	// The identifier that Amazon Route 53 assigned to the health check when you
+	// created it. When you add or update a resource record set, you use this value
+	// to specify which health check to use. The value can be up to 64 characters
+	// long.
 	//
-	// HealthCheckObservations is a required field
-	HealthCheckObservations []*HealthCheckObservation `locationNameList:"HealthCheckObservation" type:"list" required:"true"`
+	// Id is a required field
+	Id *string `type:"string" required:"true"`
+
+	// If the health check was created by another service, the service that created
+	// the health check. When a health check is created by another service, you
+	// can't edit or delete it using Amazon Route 53.
+	LinkedService *LinkedService `type:"structure"`
 }
 
-// String returns the string representation
-func (s GetHealthCheckStatusOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HealthCheck) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetHealthCheckStatusOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HealthCheck) GoString() string {
 	return s.String()
 }
 
-// SetHealthCheckObservations sets the HealthCheckObservations field's value.
-func (s *GetHealthCheckStatusOutput) SetHealthCheckObservations(v []*HealthCheckObservation) *GetHealthCheckStatusOutput {
-	s.HealthCheckObservations = v
+// SetCallerReference sets the CallerReference field's value.
+func (s *HealthCheck) SetCallerReference(v string) *HealthCheck {
+	s.CallerReference = &v
 	return s
 }
 
-// A request to retrieve a count of all the hosted zones that are associated
-// with the current AWS account.
-type GetHostedZoneCountInput struct {
-	_ struct{} `locationName:"GetHostedZoneCountRequest" type:"structure"`
-}
-
-// String returns the string representation
-func (s GetHostedZoneCountInput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s GetHostedZoneCountInput) GoString() string {
-	return s.String()
+// SetCloudWatchAlarmConfiguration sets the CloudWatchAlarmConfiguration field's value.
+func (s *HealthCheck) SetCloudWatchAlarmConfiguration(v *CloudWatchAlarmConfiguration) *HealthCheck {
+	s.CloudWatchAlarmConfiguration = v
+	return s
 }
 
-// A complex type that contains the response to a GetHostedZoneCount request.
-type GetHostedZoneCountOutput struct {
-	_ struct{} `type:"structure"`
-
-	// The total number of public and private hosted zones that are associated with
-	// the current AWS account.
-	//
-	// HostedZoneCount is a required field
-	HostedZoneCount *int64 `type:"long" required:"true"`
+// SetHealthCheckConfig sets the HealthCheckConfig field's value.
+func (s *HealthCheck) SetHealthCheckConfig(v *HealthCheckConfig) *HealthCheck {
+	s.HealthCheckConfig = v
+	return s
 }
 
-// String returns the string representation
-func (s GetHostedZoneCountOutput) String() string {
-	return awsutil.Prettify(s)
+// SetHealthCheckVersion sets the HealthCheckVersion field's value.
+func (s *HealthCheck) SetHealthCheckVersion(v int64) *HealthCheck {
+	s.HealthCheckVersion = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s GetHostedZoneCountOutput) GoString() string {
-	return s.String()
+// SetId sets the Id field's value.
+func (s *HealthCheck) SetId(v string) *HealthCheck {
+	s.Id = &v
+	return s
 }
 
-// SetHostedZoneCount sets the HostedZoneCount field's value.
-func (s *GetHostedZoneCountOutput) SetHostedZoneCount(v int64) *GetHostedZoneCountOutput {
-	s.HostedZoneCount = &v
+// SetLinkedService sets the LinkedService field's value.
+func (s *HealthCheck) SetLinkedService(v *LinkedService) *HealthCheck {
+	s.LinkedService = v
 	return s
 }
 
-// A request to get information about a specified hosted zone.
-type GetHostedZoneInput struct {
-	_ struct{} `locationName:"GetHostedZoneRequest" type:"structure"`
+// A complex type that contains information about the health check.
+type HealthCheckConfig struct {
+	_ struct{} `type:"structure"`
 
-	// The ID of the hosted zone that you want to get information about.
-	//
-	// Id is a required field
-	Id *string `location:"uri" locationName:"Id" type:"string" required:"true"`
-}
+	// A complex type that identifies the CloudWatch alarm that you want Amazon
+	// Route 53 health checkers to use to determine whether the specified health
+	// check is healthy.
+	AlarmIdentifier *AlarmIdentifier `type:"structure"`
 
-// String returns the string representation
-func (s GetHostedZoneInput) String() string {
-	return awsutil.Prettify(s)
-}
+	// (CALCULATED Health Checks Only) A complex type that contains one ChildHealthCheck
+	// element for each health check that you want to associate with a CALCULATED
+	// health check.
+	ChildHealthChecks []*string `locationNameList:"ChildHealthCheck" type:"list"`
 
-// GoString returns the string representation
-func (s GetHostedZoneInput) GoString() string {
-	return s.String()
-}
+	// Stops Route 53 from performing health checks. When you disable a health check,
+	// here's what happens:
+	//
+	//    * Health checks that check the health of endpoints: Route 53 stops submitting
+	//    requests to your application, server, or other resource.
+	//
+	//    * Calculated health checks: Route 53 stops aggregating the status of the
+	//    referenced health checks.
+	//
+	//    * Health checks that monitor CloudWatch alarms: Route 53 stops monitoring
+	//    the corresponding CloudWatch metrics.
+	//
+	// After you disable a health check, Route 53 considers the status of the health
+	// check to always be healthy. If you configured DNS failover, Route 53 continues
+	// to route traffic to the corresponding resources. If you want to stop routing
+	// traffic to a resource, change the value of Inverted (https://docs.aws.amazon.com/Route53/latest/APIReference/API_UpdateHealthCheck.html#Route53-UpdateHealthCheck-request-Inverted).
+	//
+	// Charges for a health check still apply when the health check is disabled.
+	// For more information, see Amazon Route 53 Pricing (http://aws.amazon.com/route53/pricing/).
+	Disabled *bool `type:"boolean"`
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetHostedZoneInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetHostedZoneInput"}
-	if s.Id == nil {
-		invalidParams.Add(request.NewErrParamRequired("Id"))
-	}
-	if s.Id != nil && len(*s.Id) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
-	}
+	// Specify whether you want Amazon Route 53 to send the value of FullyQualifiedDomainName
+	// to the endpoint in the client_hello message during TLS negotiation. This
+	// allows the endpoint to respond to HTTPS health check requests with the applicable
+	// SSL/TLS certificate.
+	//
+	// Some endpoints require that HTTPS requests include the host name in the client_hello
+	// message. If you don't enable SNI, the status of the health check will be
+	// SSL alert handshake_failure. A health check can also have that status for
+	// other reasons. If SNI is enabled and you're still getting the error, check
+	// the SSL/TLS configuration on your endpoint and confirm that your certificate
+	// is valid.
+	//
+	// The SSL/TLS certificate on your endpoint includes a domain name in the Common
+	// Name field and possibly several more in the Subject Alternative Names field.
+	// One of the domain names in the certificate should match the value that you
+	// specify for FullyQualifiedDomainName. If the endpoint responds to the client_hello
+	// message with a certificate that does not include the domain name that you
+	// specified in FullyQualifiedDomainName, a health checker will retry the handshake.
+	// In the second attempt, the health checker will omit FullyQualifiedDomainName
+	// from the client_hello message.
+	EnableSNI *bool `type:"boolean"`
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
+	// The number of consecutive health checks that an endpoint must pass or fail
+	// for Amazon Route 53 to change the current status of the endpoint from unhealthy
+	// to healthy or vice versa. For more information, see How Amazon Route 53 Determines
+	// Whether an Endpoint Is Healthy (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover-determining-health-of-endpoints.html)
+	// in the Amazon Route 53 Developer Guide.
+	//
+	// If you don't specify a value for FailureThreshold, the default value is three
+	// health checks.
+	FailureThreshold *int64 `min:"1" type:"integer"`
 
-// SetId sets the Id field's value.
-func (s *GetHostedZoneInput) SetId(v string) *GetHostedZoneInput {
-	s.Id = &v
-	return s
-}
+	// Amazon Route 53 behavior depends on whether you specify a value for IPAddress.
+	//
+	// If you specify a value for IPAddress:
+	//
+	// Amazon Route 53 sends health check requests to the specified IPv4 or IPv6
+	// address and passes the value of FullyQualifiedDomainName in the Host header
+	// for all health checks except TCP health checks. This is typically the fully
+	// qualified DNS name of the endpoint on which you want Route 53 to perform
+	// health checks.
+	//
+	// When Route 53 checks the health of an endpoint, here is how it constructs
+	// the Host header:
+	//
+	//    * If you specify a value of 80 for Port and HTTP or HTTP_STR_MATCH for
+	//    Type, Route 53 passes the value of FullyQualifiedDomainName to the endpoint
+	//    in the Host header.
+	//
+	//    * If you specify a value of 443 for Port and HTTPS or HTTPS_STR_MATCH
+	//    for Type, Route 53 passes the value of FullyQualifiedDomainName to the
+	//    endpoint in the Host header.
+	//
+	//    * If you specify another value for Port and any value except TCP for Type,
+	//    Route 53 passes FullyQualifiedDomainName:Port to the endpoint in the Host
+	//    header.
+	//
+	// If you don't specify a value for FullyQualifiedDomainName, Route 53 substitutes
+	// the value of IPAddress in the Host header in each of the preceding cases.
+	//
+	// If you don't specify a value for IPAddress:
+	//
+	// Route 53 sends a DNS request to the domain that you specify for FullyQualifiedDomainName
+	// at the interval that you specify for RequestInterval. Using an IPv4 address
+	// that DNS returns, Route 53 then checks the health of the endpoint.
+	//
+	// If you don't specify a value for IPAddress, Route 53 uses only IPv4 to send
+	// health checks to the endpoint. If there's no resource record set with a type
+	// of A for the name that you specify for FullyQualifiedDomainName, the health
+	// check fails with a "DNS resolution failed" error.
+	//
+	// If you want to check the health of weighted, latency, or failover resource
+	// record sets and you choose to specify the endpoint only by FullyQualifiedDomainName,
+	// we recommend that you create a separate health check for each endpoint. For
+	// example, create a health check for each HTTP server that is serving content
+	// for www.example.com. For the value of FullyQualifiedDomainName, specify the
+	// domain name of the server (such as us-east-2-www.example.com), not the name
+	// of the resource record sets (www.example.com).
+	//
+	// In this configuration, if you create a health check for which the value of
+	// FullyQualifiedDomainName matches the name of the resource record sets and
+	// you then associate the health check with those resource record sets, health
+	// check results will be unpredictable.
+	//
+	// In addition, if the value that you specify for Type is HTTP, HTTPS, HTTP_STR_MATCH,
+	// or HTTPS_STR_MATCH, Route 53 passes the value of FullyQualifiedDomainName
+	// in the Host header, as it does when you specify a value for IPAddress. If
+	// the value of Type is TCP, Route 53 doesn't pass a Host header.
+	FullyQualifiedDomainName *string `type:"string"`
 
-// A complex type that contains information about the request to create a hosted
-// zone.
-type GetHostedZoneLimitInput struct {
-	_ struct{} `locationName:"GetHostedZoneLimitRequest" type:"structure"`
+	// The number of child health checks that are associated with a CALCULATED health
+	// check that Amazon Route 53 must consider healthy for the CALCULATED health
+	// check to be considered healthy. To specify the child health checks that you
+	// want to associate with a CALCULATED health check, use the ChildHealthChecks
+	// (https://docs.aws.amazon.com/Route53/latest/APIReference/API_UpdateHealthCheck.html#Route53-UpdateHealthCheck-request-ChildHealthChecks)
+	// element.
+	//
+	// Note the following:
+	//
+	//    * If you specify a number greater than the number of child health checks,
+	//    Route 53 always considers this health check to be unhealthy.
+	//
+	//    * If you specify 0, Route 53 always considers this health check to be
+	//    healthy.
+	HealthThreshold *int64 `type:"integer"`
 
-	// The ID of the hosted zone that you want to get a limit for.
+	// The IPv4 or IPv6 IP address of the endpoint that you want Amazon Route 53
+	// to perform health checks on. If you don't specify a value for IPAddress,
+	// Route 53 sends a DNS request to resolve the domain name that you specify
+	// in FullyQualifiedDomainName at the interval that you specify in RequestInterval.
+	// Using an IP address returned by DNS, Route 53 then checks the health of the
+	// endpoint.
+	//
+	// Use one of the following formats for the value of IPAddress:
+	//
+	//    * IPv4 address: four values between 0 and 255, separated by periods (.),
+	//    for example, 192.0.2.44.
+	//
+	//    * IPv6 address: eight groups of four hexadecimal values, separated by
+	//    colons (:), for example, 2001:0db8:85a3:0000:0000:abcd:0001:2345. You
+	//    can also shorten IPv6 addresses as described in RFC 5952, for example,
+	//    2001:db8:85a3::abcd:1:2345.
+	//
+	// If the endpoint is an EC2 instance, we recommend that you create an Elastic
+	// IP address, associate it with your EC2 instance, and specify the Elastic
+	// IP address for IPAddress. This ensures that the IP address of your instance
+	// will never change.
+	//
+	// For more information, see FullyQualifiedDomainName (https://docs.aws.amazon.com/Route53/latest/APIReference/API_UpdateHealthCheck.html#Route53-UpdateHealthCheck-request-FullyQualifiedDomainName).
 	//
-	// HostedZoneId is a required field
-	HostedZoneId *string `location:"uri" locationName:"Id" type:"string" required:"true"`
-
-	// The limit that you want to get. Valid values include the following:
+	// Constraints: Route 53 can't check the health of endpoints for which the IP
+	// address is in local, private, non-routable, or multicast ranges. For more
+	// information about IP addresses for which you can't create health checks,
+	// see the following documents:
 	//
-	//    * MAX_RRSETS_BY_ZONE: The maximum number of records that you can create
-	//    in the specified hosted zone.
+	//    * RFC 5735, Special Use IPv4 Addresses (https://tools.ietf.org/html/rfc5735)
 	//
-	//    * MAX_VPCS_ASSOCIATED_BY_ZONE: The maximum number of Amazon VPCs that
-	//    you can associate with the specified private hosted zone.
+	//    * RFC 6598, IANA-Reserved IPv4 Prefix for Shared Address Space (https://tools.ietf.org/html/rfc6598)
 	//
-	// Type is a required field
-	Type *string `location:"uri" locationName:"Type" type:"string" required:"true" enum:"HostedZoneLimitType"`
-}
-
-// String returns the string representation
-func (s GetHostedZoneLimitInput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s GetHostedZoneLimitInput) GoString() string {
-	return s.String()
-}
-
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetHostedZoneLimitInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetHostedZoneLimitInput"}
-	if s.HostedZoneId == nil {
-		invalidParams.Add(request.NewErrParamRequired("HostedZoneId"))
-	}
-	if s.HostedZoneId != nil && len(*s.HostedZoneId) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("HostedZoneId", 1))
-	}
-	if s.Type == nil {
-		invalidParams.Add(request.NewErrParamRequired("Type"))
-	}
-	if s.Type != nil && len(*s.Type) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Type", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
-// SetHostedZoneId sets the HostedZoneId field's value.
-func (s *GetHostedZoneLimitInput) SetHostedZoneId(v string) *GetHostedZoneLimitInput {
-	s.HostedZoneId = &v
-	return s
-}
-
-// SetType sets the Type field's value.
-func (s *GetHostedZoneLimitInput) SetType(v string) *GetHostedZoneLimitInput {
-	s.Type = &v
-	return s
-}
-
-// A complex type that contains the requested limit.
-type GetHostedZoneLimitOutput struct {
-	_ struct{} `type:"structure"`
-
-	// The current number of entities that you have created of the specified type.
-	// For example, if you specified MAX_RRSETS_BY_ZONE for the value of Type in
-	// the request, the value of Count is the current number of records that you
-	// have created in the specified hosted zone.
+	//    * RFC 5156, Special-Use IPv6 Addresses (https://tools.ietf.org/html/rfc5156)
 	//
-	// Count is a required field
-	Count *int64 `type:"long" required:"true"`
+	// When the value of Type is CALCULATED or CLOUDWATCH_METRIC, omit IPAddress.
+	IPAddress *string `type:"string"`
 
-	// The current setting for the specified limit. For example, if you specified
-	// MAX_RRSETS_BY_ZONE for the value of Type in the request, the value of Limit
-	// is the maximum number of records that you can create in the specified hosted
-	// zone.
+	// When CloudWatch has insufficient data about the metric to determine the alarm
+	// state, the status that you want Amazon Route 53 to assign to the health check:
 	//
-	// Limit is a required field
-	Limit *HostedZoneLimit `type:"structure" required:"true"`
-}
-
-// String returns the string representation
-func (s GetHostedZoneLimitOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s GetHostedZoneLimitOutput) GoString() string {
-	return s.String()
-}
-
-// SetCount sets the Count field's value.
-func (s *GetHostedZoneLimitOutput) SetCount(v int64) *GetHostedZoneLimitOutput {
-	s.Count = &v
-	return s
-}
-
-// SetLimit sets the Limit field's value.
-func (s *GetHostedZoneLimitOutput) SetLimit(v *HostedZoneLimit) *GetHostedZoneLimitOutput {
-	s.Limit = v
-	return s
-}
-
-// A complex type that contain the response to a GetHostedZone request.
-type GetHostedZoneOutput struct {
-	_ struct{} `type:"structure"`
-
-	// A complex type that lists the Amazon Route 53 name servers for the specified
-	// hosted zone.
-	DelegationSet *DelegationSet `type:"structure"`
-
-	// A complex type that contains general information about the specified hosted
-	// zone.
+	//    * Healthy: Route 53 considers the health check to be healthy.
 	//
-	// HostedZone is a required field
-	HostedZone *HostedZone `type:"structure" required:"true"`
-
-	// A complex type that contains information about the VPCs that are associated
-	// with the specified hosted zone.
-	VPCs []*VPC `locationNameList:"VPC" min:"1" type:"list"`
-}
-
-// String returns the string representation
-func (s GetHostedZoneOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s GetHostedZoneOutput) GoString() string {
-	return s.String()
-}
-
-// SetDelegationSet sets the DelegationSet field's value.
-func (s *GetHostedZoneOutput) SetDelegationSet(v *DelegationSet) *GetHostedZoneOutput {
-	s.DelegationSet = v
-	return s
-}
-
-// SetHostedZone sets the HostedZone field's value.
-func (s *GetHostedZoneOutput) SetHostedZone(v *HostedZone) *GetHostedZoneOutput {
-	s.HostedZone = v
-	return s
-}
-
-// SetVPCs sets the VPCs field's value.
-func (s *GetHostedZoneOutput) SetVPCs(v []*VPC) *GetHostedZoneOutput {
-	s.VPCs = v
-	return s
-}
+	//    * Unhealthy: Route 53 considers the health check to be unhealthy.
+	//
+	//    * LastKnownStatus: Route 53 uses the status of the health check from the
+	//    last time that CloudWatch had sufficient data to determine the alarm state.
+	//    For new health checks that have no last known status, the default status
+	//    for the health check is healthy.
+	InsufficientDataHealthStatus *string `type:"string" enum:"InsufficientDataHealthStatus"`
 
-type GetQueryLoggingConfigInput struct {
-	_ struct{} `locationName:"GetQueryLoggingConfigRequest" type:"structure"`
+	// Specify whether you want Amazon Route 53 to invert the status of a health
+	// check, for example, to consider a health check unhealthy when it otherwise
+	// would be considered healthy.
+	Inverted *bool `type:"boolean"`
 
-	// The ID of the configuration for DNS query logging that you want to get information
-	// about.
+	// Specify whether you want Amazon Route 53 to measure the latency between health
+	// checkers in multiple Amazon Web Services regions and your endpoint, and to
+	// display CloudWatch latency graphs on the Health Checks page in the Route
+	// 53 console.
 	//
-	// Id is a required field
-	Id *string `location:"uri" locationName:"Id" min:"1" type:"string" required:"true"`
-}
+	// You can't change the value of MeasureLatency after you create a health check.
+	MeasureLatency *bool `type:"boolean"`
 
-// String returns the string representation
-func (s GetQueryLoggingConfigInput) String() string {
-	return awsutil.Prettify(s)
-}
+	// The port on the endpoint that you want Amazon Route 53 to perform health
+	// checks on.
+	//
+	// Don't specify a value for Port when you specify a value for Type of CLOUDWATCH_METRIC
+	// or CALCULATED.
+	Port *int64 `min:"1" type:"integer"`
 
-// GoString returns the string representation
-func (s GetQueryLoggingConfigInput) GoString() string {
-	return s.String()
-}
+	// A complex type that contains one Region element for each region from which
+	// you want Amazon Route 53 health checkers to check the specified endpoint.
+	//
+	// If you don't specify any regions, Route 53 health checkers automatically
+	// performs checks from all of the regions that are listed under Valid Values.
+	//
+	// If you update a health check to remove a region that has been performing
+	// health checks, Route 53 will briefly continue to perform checks from that
+	// region to ensure that some health checkers are always checking the endpoint
+	// (for example, if you replace three regions with four different regions).
+	Regions []*string `locationNameList:"Region" min:"3" type:"list" enum:"HealthCheckRegion"`
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetQueryLoggingConfigInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetQueryLoggingConfigInput"}
-	if s.Id == nil {
-		invalidParams.Add(request.NewErrParamRequired("Id"))
-	}
-	if s.Id != nil && len(*s.Id) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
-	}
+	// The number of seconds between the time that Amazon Route 53 gets a response
+	// from your endpoint and the time that it sends the next health check request.
+	// Each Route 53 health checker makes requests at this interval.
+	//
+	// You can't change the value of RequestInterval after you create a health check.
+	//
+	// If you don't specify a value for RequestInterval, the default value is 30
+	// seconds.
+	RequestInterval *int64 `min:"10" type:"integer"`
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
+	// The path, if any, that you want Amazon Route 53 to request when performing
+	// health checks. The path can be any value for which your endpoint will return
+	// an HTTP status code of 2xx or 3xx when the endpoint is healthy, for example,
+	// the file /docs/route53-health-check.html. You can also include query string
+	// parameters, for example, /welcome.html?language=jp&login=y.
+	ResourcePath *string `type:"string"`
 
-// SetId sets the Id field's value.
-func (s *GetQueryLoggingConfigInput) SetId(v string) *GetQueryLoggingConfigInput {
-	s.Id = &v
-	return s
-}
+	// The Amazon Resource Name (ARN) for the Route 53 Application Recovery Controller
+	// routing control.
+	//
+	// For more information about Route 53 Application Recovery Controller, see
+	// Route 53 Application Recovery Controller Developer Guide. (https://docs.aws.amazon.com/r53recovery/latest/dg/what-is-route-53-recovery.html).
+	RoutingControlArn *string `min:"1" type:"string"`
 
-type GetQueryLoggingConfigOutput struct {
-	_ struct{} `type:"structure"`
+	// If the value of Type is HTTP_STR_MATCH or HTTPS_STR_MATCH, the string that
+	// you want Amazon Route 53 to search for in the response body from the specified
+	// resource. If the string appears in the response body, Route 53 considers
+	// the resource healthy.
+	//
+	// Route 53 considers case when searching for SearchString in the response body.
+	SearchString *string `type:"string"`
 
-	// A complex type that contains information about the query logging configuration
-	// that you specified in a GetQueryLoggingConfig (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetQueryLoggingConfig.html)
-	// request.
+	// The type of health check that you want to create, which indicates how Amazon
+	// Route 53 determines whether an endpoint is healthy.
+	//
+	// You can't change the value of Type after you create a health check.
+	//
+	// You can create the following types of health checks:
+	//
+	//    * HTTP: Route 53 tries to establish a TCP connection. If successful, Route
+	//    53 submits an HTTP request and waits for an HTTP status code of 200 or
+	//    greater and less than 400.
+	//
+	//    * HTTPS: Route 53 tries to establish a TCP connection. If successful,
+	//    Route 53 submits an HTTPS request and waits for an HTTP status code of
+	//    200 or greater and less than 400. If you specify HTTPS for the value of
+	//    Type, the endpoint must support TLS v1.0 or later.
+	//
+	//    * HTTP_STR_MATCH: Route 53 tries to establish a TCP connection. If successful,
+	//    Route 53 submits an HTTP request and searches the first 5,120 bytes of
+	//    the response body for the string that you specify in SearchString.
+	//
+	//    * HTTPS_STR_MATCH: Route 53 tries to establish a TCP connection. If successful,
+	//    Route 53 submits an HTTPS request and searches the first 5,120 bytes of
+	//    the response body for the string that you specify in SearchString.
 	//
-	// QueryLoggingConfig is a required field
-	QueryLoggingConfig *QueryLoggingConfig `type:"structure" required:"true"`
-}
-
-// String returns the string representation
-func (s GetQueryLoggingConfigOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s GetQueryLoggingConfigOutput) GoString() string {
-	return s.String()
-}
-
-// SetQueryLoggingConfig sets the QueryLoggingConfig field's value.
-func (s *GetQueryLoggingConfigOutput) SetQueryLoggingConfig(v *QueryLoggingConfig) *GetQueryLoggingConfigOutput {
-	s.QueryLoggingConfig = v
-	return s
-}
-
-// A request to get information about a specified reusable delegation set.
-type GetReusableDelegationSetInput struct {
-	_ struct{} `locationName:"GetReusableDelegationSetRequest" type:"structure"`
-
-	// The ID of the reusable delegation set that you want to get a list of name
-	// servers for.
+	//    * TCP: Route 53 tries to establish a TCP connection.
 	//
-	// Id is a required field
-	Id *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	//    * CLOUDWATCH_METRIC: The health check is associated with a CloudWatch
+	//    alarm. If the state of the alarm is OK, the health check is considered
+	//    healthy. If the state is ALARM, the health check is considered unhealthy.
+	//    If CloudWatch doesn't have sufficient data to determine whether the state
+	//    is OK or ALARM, the health check status depends on the setting for InsufficientDataHealthStatus:
+	//    Healthy, Unhealthy, or LastKnownStatus.
+	//
+	//    * CALCULATED: For health checks that monitor the status of other health
+	//    checks, Route 53 adds up the number of health checks that Route 53 health
+	//    checkers consider to be healthy and compares that number with the value
+	//    of HealthThreshold.
+	//
+	//    * RECOVERY_CONTROL: The health check is assocated with a Route53 Application
+	//    Recovery Controller routing control. If the routing control state is ON,
+	//    the health check is considered healthy. If the state is OFF, the health
+	//    check is considered unhealthy.
+	//
+	// For more information, see How Route 53 Determines Whether an Endpoint Is
+	// Healthy (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover-determining-health-of-endpoints.html)
+	// in the Amazon Route 53 Developer Guide.
+	//
+	// Type is a required field
+	Type *string `type:"string" required:"true" enum:"HealthCheckType"`
 }
 
-// String returns the string representation
-func (s GetReusableDelegationSetInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HealthCheckConfig) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetReusableDelegationSetInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HealthCheckConfig) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *GetReusableDelegationSetInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetReusableDelegationSetInput"}
-	if s.Id == nil {
-		invalidParams.Add(request.NewErrParamRequired("Id"))
+func (s *HealthCheckConfig) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "HealthCheckConfig"}
+	if s.FailureThreshold != nil && *s.FailureThreshold < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("FailureThreshold", 1))
 	}
-	if s.Id != nil && len(*s.Id) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
+	if s.Port != nil && *s.Port < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("Port", 1))
+	}
+	if s.Regions != nil && len(s.Regions) < 3 {
+		invalidParams.Add(request.NewErrParamMinLen("Regions", 3))
+	}
+	if s.RequestInterval != nil && *s.RequestInterval < 10 {
+		invalidParams.Add(request.NewErrParamMinValue("RequestInterval", 10))
+	}
+	if s.RoutingControlArn != nil && len(*s.RoutingControlArn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RoutingControlArn", 1))
+	}
+	if s.Type == nil {
+		invalidParams.Add(request.NewErrParamRequired("Type"))
+	}
+	if s.AlarmIdentifier != nil {
+		if err := s.AlarmIdentifier.Validate(); err != nil {
+			invalidParams.AddNested("AlarmIdentifier", err.(request.ErrInvalidParams))
+		}
 	}
 
 	if invalidParams.Len() > 0 {
@@ -9583,1107 +14306,1085 @@ func (s *GetReusableDelegationSetInput) Validate() error {
 	return nil
 }
 
-// SetId sets the Id field's value.
-func (s *GetReusableDelegationSetInput) SetId(v string) *GetReusableDelegationSetInput {
-	s.Id = &v
+// SetAlarmIdentifier sets the AlarmIdentifier field's value.
+func (s *HealthCheckConfig) SetAlarmIdentifier(v *AlarmIdentifier) *HealthCheckConfig {
+	s.AlarmIdentifier = v
 	return s
 }
 
-// A complex type that contains information about the request to create a hosted
-// zone.
-type GetReusableDelegationSetLimitInput struct {
-	_ struct{} `locationName:"GetReusableDelegationSetLimitRequest" type:"structure"`
-
-	// The ID of the delegation set that you want to get the limit for.
-	//
-	// DelegationSetId is a required field
-	DelegationSetId *string `location:"uri" locationName:"Id" type:"string" required:"true"`
-
-	// Specify MAX_ZONES_BY_REUSABLE_DELEGATION_SET to get the maximum number of
-	// hosted zones that you can associate with the specified reusable delegation
-	// set.
-	//
-	// Type is a required field
-	Type *string `location:"uri" locationName:"Type" type:"string" required:"true" enum:"ReusableDelegationSetLimitType"`
+// SetChildHealthChecks sets the ChildHealthChecks field's value.
+func (s *HealthCheckConfig) SetChildHealthChecks(v []*string) *HealthCheckConfig {
+	s.ChildHealthChecks = v
+	return s
 }
 
-// String returns the string representation
-func (s GetReusableDelegationSetLimitInput) String() string {
-	return awsutil.Prettify(s)
+// SetDisabled sets the Disabled field's value.
+func (s *HealthCheckConfig) SetDisabled(v bool) *HealthCheckConfig {
+	s.Disabled = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s GetReusableDelegationSetLimitInput) GoString() string {
-	return s.String()
+// SetEnableSNI sets the EnableSNI field's value.
+func (s *HealthCheckConfig) SetEnableSNI(v bool) *HealthCheckConfig {
+	s.EnableSNI = &v
+	return s
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetReusableDelegationSetLimitInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetReusableDelegationSetLimitInput"}
-	if s.DelegationSetId == nil {
-		invalidParams.Add(request.NewErrParamRequired("DelegationSetId"))
-	}
-	if s.DelegationSetId != nil && len(*s.DelegationSetId) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("DelegationSetId", 1))
-	}
-	if s.Type == nil {
-		invalidParams.Add(request.NewErrParamRequired("Type"))
-	}
-	if s.Type != nil && len(*s.Type) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Type", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetFailureThreshold sets the FailureThreshold field's value.
+func (s *HealthCheckConfig) SetFailureThreshold(v int64) *HealthCheckConfig {
+	s.FailureThreshold = &v
+	return s
 }
 
-// SetDelegationSetId sets the DelegationSetId field's value.
-func (s *GetReusableDelegationSetLimitInput) SetDelegationSetId(v string) *GetReusableDelegationSetLimitInput {
-	s.DelegationSetId = &v
+// SetFullyQualifiedDomainName sets the FullyQualifiedDomainName field's value.
+func (s *HealthCheckConfig) SetFullyQualifiedDomainName(v string) *HealthCheckConfig {
+	s.FullyQualifiedDomainName = &v
 	return s
 }
 
-// SetType sets the Type field's value.
-func (s *GetReusableDelegationSetLimitInput) SetType(v string) *GetReusableDelegationSetLimitInput {
-	s.Type = &v
+// SetHealthThreshold sets the HealthThreshold field's value.
+func (s *HealthCheckConfig) SetHealthThreshold(v int64) *HealthCheckConfig {
+	s.HealthThreshold = &v
 	return s
 }
 
-// A complex type that contains the requested limit.
-type GetReusableDelegationSetLimitOutput struct {
-	_ struct{} `type:"structure"`
-
-	// The current number of hosted zones that you can associate with the specified
-	// reusable delegation set.
-	//
-	// Count is a required field
-	Count *int64 `type:"long" required:"true"`
+// SetIPAddress sets the IPAddress field's value.
+func (s *HealthCheckConfig) SetIPAddress(v string) *HealthCheckConfig {
+	s.IPAddress = &v
+	return s
+}
 
-	// The current setting for the limit on hosted zones that you can associate
-	// with the specified reusable delegation set.
-	//
-	// Limit is a required field
-	Limit *ReusableDelegationSetLimit `type:"structure" required:"true"`
+// SetInsufficientDataHealthStatus sets the InsufficientDataHealthStatus field's value.
+func (s *HealthCheckConfig) SetInsufficientDataHealthStatus(v string) *HealthCheckConfig {
+	s.InsufficientDataHealthStatus = &v
+	return s
 }
 
-// String returns the string representation
-func (s GetReusableDelegationSetLimitOutput) String() string {
-	return awsutil.Prettify(s)
+// SetInverted sets the Inverted field's value.
+func (s *HealthCheckConfig) SetInverted(v bool) *HealthCheckConfig {
+	s.Inverted = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s GetReusableDelegationSetLimitOutput) GoString() string {
-	return s.String()
+// SetMeasureLatency sets the MeasureLatency field's value.
+func (s *HealthCheckConfig) SetMeasureLatency(v bool) *HealthCheckConfig {
+	s.MeasureLatency = &v
+	return s
 }
 
-// SetCount sets the Count field's value.
-func (s *GetReusableDelegationSetLimitOutput) SetCount(v int64) *GetReusableDelegationSetLimitOutput {
-	s.Count = &v
+// SetPort sets the Port field's value.
+func (s *HealthCheckConfig) SetPort(v int64) *HealthCheckConfig {
+	s.Port = &v
 	return s
 }
 
-// SetLimit sets the Limit field's value.
-func (s *GetReusableDelegationSetLimitOutput) SetLimit(v *ReusableDelegationSetLimit) *GetReusableDelegationSetLimitOutput {
-	s.Limit = v
+// SetRegions sets the Regions field's value.
+func (s *HealthCheckConfig) SetRegions(v []*string) *HealthCheckConfig {
+	s.Regions = v
 	return s
 }
 
-// A complex type that contains the response to the GetReusableDelegationSet
-// request.
-type GetReusableDelegationSetOutput struct {
-	_ struct{} `type:"structure"`
+// SetRequestInterval sets the RequestInterval field's value.
+func (s *HealthCheckConfig) SetRequestInterval(v int64) *HealthCheckConfig {
+	s.RequestInterval = &v
+	return s
+}
 
-	// A complex type that contains information about the reusable delegation set.
-	//
-	// DelegationSet is a required field
-	DelegationSet *DelegationSet `type:"structure" required:"true"`
+// SetResourcePath sets the ResourcePath field's value.
+func (s *HealthCheckConfig) SetResourcePath(v string) *HealthCheckConfig {
+	s.ResourcePath = &v
+	return s
 }
 
-// String returns the string representation
-func (s GetReusableDelegationSetOutput) String() string {
-	return awsutil.Prettify(s)
+// SetRoutingControlArn sets the RoutingControlArn field's value.
+func (s *HealthCheckConfig) SetRoutingControlArn(v string) *HealthCheckConfig {
+	s.RoutingControlArn = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s GetReusableDelegationSetOutput) GoString() string {
-	return s.String()
+// SetSearchString sets the SearchString field's value.
+func (s *HealthCheckConfig) SetSearchString(v string) *HealthCheckConfig {
+	s.SearchString = &v
+	return s
 }
 
-// SetDelegationSet sets the DelegationSet field's value.
-func (s *GetReusableDelegationSetOutput) SetDelegationSet(v *DelegationSet) *GetReusableDelegationSetOutput {
-	s.DelegationSet = v
+// SetType sets the Type field's value.
+func (s *HealthCheckConfig) SetType(v string) *HealthCheckConfig {
+	s.Type = &v
 	return s
 }
 
-// Gets information about a specific traffic policy version.
-type GetTrafficPolicyInput struct {
-	_ struct{} `locationName:"GetTrafficPolicyRequest" type:"structure"`
+// A complex type that contains the last failure reason as reported by one Amazon
+// Route 53 health checker.
+type HealthCheckObservation struct {
+	_ struct{} `type:"structure"`
 
-	// The ID of the traffic policy that you want to get information about.
-	//
-	// Id is a required field
-	Id *string `location:"uri" locationName:"Id" min:"1" type:"string" required:"true"`
+	// The IP address of the Amazon Route 53 health checker that provided the failure
+	// reason in StatusReport.
+	IPAddress *string `type:"string"`
 
-	// The version number of the traffic policy that you want to get information
-	// about.
-	//
-	// Version is a required field
-	Version *int64 `location:"uri" locationName:"Version" min:"1" type:"integer" required:"true"`
+	// The region of the Amazon Route 53 health checker that provided the status
+	// in StatusReport.
+	Region *string `min:"1" type:"string" enum:"HealthCheckRegion"`
+
+	// A complex type that contains the last failure reason as reported by one Amazon
+	// Route 53 health checker and the time of the failed health check.
+	StatusReport *StatusReport `type:"structure"`
 }
 
-// String returns the string representation
-func (s GetTrafficPolicyInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HealthCheckObservation) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetTrafficPolicyInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HealthCheckObservation) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetTrafficPolicyInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetTrafficPolicyInput"}
-	if s.Id == nil {
-		invalidParams.Add(request.NewErrParamRequired("Id"))
-	}
-	if s.Id != nil && len(*s.Id) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
-	}
-	if s.Version == nil {
-		invalidParams.Add(request.NewErrParamRequired("Version"))
-	}
-	if s.Version != nil && *s.Version < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("Version", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetIPAddress sets the IPAddress field's value.
+func (s *HealthCheckObservation) SetIPAddress(v string) *HealthCheckObservation {
+	s.IPAddress = &v
+	return s
 }
 
-// SetId sets the Id field's value.
-func (s *GetTrafficPolicyInput) SetId(v string) *GetTrafficPolicyInput {
-	s.Id = &v
+// SetRegion sets the Region field's value.
+func (s *HealthCheckObservation) SetRegion(v string) *HealthCheckObservation {
+	s.Region = &v
 	return s
 }
 
-// SetVersion sets the Version field's value.
-func (s *GetTrafficPolicyInput) SetVersion(v int64) *GetTrafficPolicyInput {
-	s.Version = &v
+// SetStatusReport sets the StatusReport field's value.
+func (s *HealthCheckObservation) SetStatusReport(v *StatusReport) *HealthCheckObservation {
+	s.StatusReport = v
 	return s
 }
 
-// Request to get the number of traffic policy instances that are associated
-// with the current AWS account.
-type GetTrafficPolicyInstanceCountInput struct {
-	_ struct{} `locationName:"GetTrafficPolicyInstanceCountRequest" type:"structure"`
-}
+// A complex type that contains general information about the hosted zone.
+type HostedZone struct {
+	_ struct{} `type:"structure"`
 
-// String returns the string representation
-func (s GetTrafficPolicyInstanceCountInput) String() string {
-	return awsutil.Prettify(s)
-}
+	// The value that you specified for CallerReference when you created the hosted
+	// zone.
+	//
+	// CallerReference is a required field
+	CallerReference *string `min:"1" type:"string" required:"true"`
 
-// GoString returns the string representation
-func (s GetTrafficPolicyInstanceCountInput) GoString() string {
-	return s.String()
-}
+	// A complex type that includes the Comment and PrivateZone elements. If you
+	// omitted the HostedZoneConfig and Comment elements from the request, the Config
+	// and Comment elements don't appear in the response.
+	Config *HostedZoneConfig `type:"structure"`
 
-// A complex type that contains information about the resource record sets that
-// Amazon Route 53 created based on a specified traffic policy.
-type GetTrafficPolicyInstanceCountOutput struct {
-	_ struct{} `type:"structure"`
+	// The ID that Amazon Route 53 assigned to the hosted zone when you created
+	// it.
+	//
+	// Id is a required field
+	Id *string `type:"string" required:"true"`
 
-	// The number of traffic policy instances that are associated with the current
-	// AWS account.
+	// If the hosted zone was created by another service, the service that created
+	// the hosted zone. When a hosted zone is created by another service, you can't
+	// edit or delete it using Route 53.
+	LinkedService *LinkedService `type:"structure"`
+
+	// The name of the domain. For public hosted zones, this is the name that you
+	// have registered with your DNS registrar.
 	//
-	// TrafficPolicyInstanceCount is a required field
-	TrafficPolicyInstanceCount *int64 `type:"integer" required:"true"`
+	// For information about how to specify characters other than a-z, 0-9, and
+	// - (hyphen) and how to specify internationalized domain names, see CreateHostedZone
+	// (https://docs.aws.amazon.com/Route53/latest/APIReference/API_CreateHostedZone.html).
+	//
+	// Name is a required field
+	Name *string `type:"string" required:"true"`
+
+	// The number of resource record sets in the hosted zone.
+	ResourceRecordSetCount *int64 `type:"long"`
 }
 
-// String returns the string representation
-func (s GetTrafficPolicyInstanceCountOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HostedZone) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetTrafficPolicyInstanceCountOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HostedZone) GoString() string {
 	return s.String()
 }
 
-// SetTrafficPolicyInstanceCount sets the TrafficPolicyInstanceCount field's value.
-func (s *GetTrafficPolicyInstanceCountOutput) SetTrafficPolicyInstanceCount(v int64) *GetTrafficPolicyInstanceCountOutput {
-	s.TrafficPolicyInstanceCount = &v
+// SetCallerReference sets the CallerReference field's value.
+func (s *HostedZone) SetCallerReference(v string) *HostedZone {
+	s.CallerReference = &v
 	return s
 }
 
-// Gets information about a specified traffic policy instance.
-type GetTrafficPolicyInstanceInput struct {
-	_ struct{} `locationName:"GetTrafficPolicyInstanceRequest" type:"structure"`
-
-	// The ID of the traffic policy instance that you want to get information about.
-	//
-	// Id is a required field
-	Id *string `location:"uri" locationName:"Id" min:"1" type:"string" required:"true"`
+// SetConfig sets the Config field's value.
+func (s *HostedZone) SetConfig(v *HostedZoneConfig) *HostedZone {
+	s.Config = v
+	return s
 }
 
-// String returns the string representation
-func (s GetTrafficPolicyInstanceInput) String() string {
-	return awsutil.Prettify(s)
+// SetId sets the Id field's value.
+func (s *HostedZone) SetId(v string) *HostedZone {
+	s.Id = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s GetTrafficPolicyInstanceInput) GoString() string {
-	return s.String()
+// SetLinkedService sets the LinkedService field's value.
+func (s *HostedZone) SetLinkedService(v *LinkedService) *HostedZone {
+	s.LinkedService = v
+	return s
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetTrafficPolicyInstanceInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetTrafficPolicyInstanceInput"}
-	if s.Id == nil {
-		invalidParams.Add(request.NewErrParamRequired("Id"))
-	}
-	if s.Id != nil && len(*s.Id) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetName sets the Name field's value.
+func (s *HostedZone) SetName(v string) *HostedZone {
+	s.Name = &v
+	return s
 }
 
-// SetId sets the Id field's value.
-func (s *GetTrafficPolicyInstanceInput) SetId(v string) *GetTrafficPolicyInstanceInput {
-	s.Id = &v
+// SetResourceRecordSetCount sets the ResourceRecordSetCount field's value.
+func (s *HostedZone) SetResourceRecordSetCount(v int64) *HostedZone {
+	s.ResourceRecordSetCount = &v
 	return s
 }
 
-// A complex type that contains information about the resource record sets that
-// Amazon Route 53 created based on a specified traffic policy.
-type GetTrafficPolicyInstanceOutput struct {
+// A complex type that contains an optional comment about your hosted zone.
+// If you don't want to specify a comment, omit both the HostedZoneConfig and
+// Comment elements.
+type HostedZoneConfig struct {
 	_ struct{} `type:"structure"`
 
-	// A complex type that contains settings for the traffic policy instance.
-	//
-	// TrafficPolicyInstance is a required field
-	TrafficPolicyInstance *TrafficPolicyInstance `type:"structure" required:"true"`
+	// Any comments that you want to include about the hosted zone.
+	Comment *string `type:"string"`
+
+	// A value that indicates whether this is a private hosted zone.
+	PrivateZone *bool `type:"boolean"`
 }
 
-// String returns the string representation
-func (s GetTrafficPolicyInstanceOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HostedZoneConfig) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetTrafficPolicyInstanceOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HostedZoneConfig) GoString() string {
 	return s.String()
 }
 
-// SetTrafficPolicyInstance sets the TrafficPolicyInstance field's value.
-func (s *GetTrafficPolicyInstanceOutput) SetTrafficPolicyInstance(v *TrafficPolicyInstance) *GetTrafficPolicyInstanceOutput {
-	s.TrafficPolicyInstance = v
+// SetComment sets the Comment field's value.
+func (s *HostedZoneConfig) SetComment(v string) *HostedZoneConfig {
+	s.Comment = &v
 	return s
 }
 
-// A complex type that contains the response information for the request.
-type GetTrafficPolicyOutput struct {
+// SetPrivateZone sets the PrivateZone field's value.
+func (s *HostedZoneConfig) SetPrivateZone(v bool) *HostedZoneConfig {
+	s.PrivateZone = &v
+	return s
+}
+
+// A complex type that contains the type of limit that you specified in the
+// request and the current value for that limit.
+type HostedZoneLimit struct {
 	_ struct{} `type:"structure"`
 
-	// A complex type that contains settings for the specified traffic policy.
+	// The limit that you requested. Valid values include the following:
 	//
-	// TrafficPolicy is a required field
-	TrafficPolicy *TrafficPolicy `type:"structure" required:"true"`
+	//    * MAX_RRSETS_BY_ZONE: The maximum number of records that you can create
+	//    in the specified hosted zone.
+	//
+	//    * MAX_VPCS_ASSOCIATED_BY_ZONE: The maximum number of Amazon VPCs that
+	//    you can associate with the specified private hosted zone.
+	//
+	// Type is a required field
+	Type *string `type:"string" required:"true" enum:"HostedZoneLimitType"`
+
+	// The current value for the limit that is specified by Type.
+	//
+	// Value is a required field
+	Value *int64 `min:"1" type:"long" required:"true"`
 }
 
-// String returns the string representation
-func (s GetTrafficPolicyOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HostedZoneLimit) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetTrafficPolicyOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HostedZoneLimit) GoString() string {
 	return s.String()
 }
 
-// SetTrafficPolicy sets the TrafficPolicy field's value.
-func (s *GetTrafficPolicyOutput) SetTrafficPolicy(v *TrafficPolicy) *GetTrafficPolicyOutput {
-	s.TrafficPolicy = v
+// SetType sets the Type field's value.
+func (s *HostedZoneLimit) SetType(v string) *HostedZoneLimit {
+	s.Type = &v
 	return s
 }
 
-// A complex type that contains information about one health check that is associated
-// with the current AWS account.
-type HealthCheck struct {
-	_ struct{} `type:"structure"`
-
-	// A unique string that you specified when you created the health check.
-	//
-	// CallerReference is a required field
-	CallerReference *string `min:"1" type:"string" required:"true"`
-
-	// A complex type that contains information about the CloudWatch alarm that
-	// Amazon Route 53 is monitoring for this health check.
-	CloudWatchAlarmConfiguration *CloudWatchAlarmConfiguration `type:"structure"`
-
-	// A complex type that contains detailed information about one health check.
-	//
-	// HealthCheckConfig is a required field
-	HealthCheckConfig *HealthCheckConfig `type:"structure" required:"true"`
-
-	// The version of the health check. You can optionally pass this value in a
-	// call to UpdateHealthCheck to prevent overwriting another change to the health
-	// check.
-	//
-	// HealthCheckVersion is a required field
-	HealthCheckVersion *int64 `min:"1" type:"long" required:"true"`
-
-	// The identifier that Amazon Route 53assigned to the health check when you
-	// created it. When you add or update a resource record set, you use this value
-	// to specify which health check to use. The value can be up to 64 characters
-	// long.
-	//
-	// Id is a required field
-	Id *string `type:"string" required:"true"`
+// SetValue sets the Value field's value.
+func (s *HostedZoneLimit) SetValue(v int64) *HostedZoneLimit {
+	s.Value = &v
+	return s
+}
 
-	// If the health check was created by another service, the service that created
-	// the health check. When a health check is created by another service, you
-	// can't edit or delete it using Amazon Route 53.
-	LinkedService *LinkedService `type:"structure"`
+// A complex type that identifies a hosted zone that a specified Amazon VPC
+// is associated with and the owner of the hosted zone. If there is a value
+// for OwningAccount, there is no value for OwningService, and vice versa.
+type HostedZoneOwner struct {
+	_ struct{} `type:"structure"`
+
+	// If the hosted zone was created by an Amazon Web Services account, or was
+	// created by an Amazon Web Services service that creates hosted zones using
+	// the current account, OwningAccount contains the account ID of that account.
+	// For example, when you use Cloud Map to create a hosted zone, Cloud Map creates
+	// the hosted zone using the current Amazon Web Services account.
+	OwningAccount *string `type:"string"`
+
+	// If an Amazon Web Services service uses its own account to create a hosted
+	// zone and associate the specified VPC with that hosted zone, OwningService
+	// contains an abbreviation that identifies the service. For example, if Amazon
+	// Elastic File System (Amazon EFS) created a hosted zone and associated a VPC
+	// with the hosted zone, the value of OwningService is efs.amazonaws.com.
+	OwningService *string `type:"string"`
 }
 
-// String returns the string representation
-func (s HealthCheck) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HostedZoneOwner) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s HealthCheck) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HostedZoneOwner) GoString() string {
 	return s.String()
 }
 
-// SetCallerReference sets the CallerReference field's value.
-func (s *HealthCheck) SetCallerReference(v string) *HealthCheck {
-	s.CallerReference = &v
+// SetOwningAccount sets the OwningAccount field's value.
+func (s *HostedZoneOwner) SetOwningAccount(v string) *HostedZoneOwner {
+	s.OwningAccount = &v
 	return s
 }
 
-// SetCloudWatchAlarmConfiguration sets the CloudWatchAlarmConfiguration field's value.
-func (s *HealthCheck) SetCloudWatchAlarmConfiguration(v *CloudWatchAlarmConfiguration) *HealthCheck {
-	s.CloudWatchAlarmConfiguration = v
+// SetOwningService sets the OwningService field's value.
+func (s *HostedZoneOwner) SetOwningService(v string) *HostedZoneOwner {
+	s.OwningService = &v
 	return s
 }
 
-// SetHealthCheckConfig sets the HealthCheckConfig field's value.
-func (s *HealthCheck) SetHealthCheckConfig(v *HealthCheckConfig) *HealthCheck {
-	s.HealthCheckConfig = v
-	return s
+// In the response to a ListHostedZonesByVPC request, the HostedZoneSummaries
+// element contains one HostedZoneSummary element for each hosted zone that
+// the specified Amazon VPC is associated with. Each HostedZoneSummary element
+// contains the hosted zone name and ID, and information about who owns the
+// hosted zone.
+type HostedZoneSummary struct {
+	_ struct{} `type:"structure"`
+
+	// The Route 53 hosted zone ID of a private hosted zone that the specified VPC
+	// is associated with.
+	//
+	// HostedZoneId is a required field
+	HostedZoneId *string `type:"string" required:"true"`
+
+	// The name of the private hosted zone, such as example.com.
+	//
+	// Name is a required field
+	Name *string `type:"string" required:"true"`
+
+	// The owner of a private hosted zone that the specified VPC is associated with.
+	// The owner can be either an Amazon Web Services account or an Amazon Web Services
+	// service.
+	//
+	// Owner is a required field
+	Owner *HostedZoneOwner `type:"structure" required:"true"`
 }
 
-// SetHealthCheckVersion sets the HealthCheckVersion field's value.
-func (s *HealthCheck) SetHealthCheckVersion(v int64) *HealthCheck {
-	s.HealthCheckVersion = &v
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HostedZoneSummary) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HostedZoneSummary) GoString() string {
+	return s.String()
+}
+
+// SetHostedZoneId sets the HostedZoneId field's value.
+func (s *HostedZoneSummary) SetHostedZoneId(v string) *HostedZoneSummary {
+	s.HostedZoneId = &v
 	return s
 }
 
-// SetId sets the Id field's value.
-func (s *HealthCheck) SetId(v string) *HealthCheck {
-	s.Id = &v
+// SetName sets the Name field's value.
+func (s *HostedZoneSummary) SetName(v string) *HostedZoneSummary {
+	s.Name = &v
 	return s
 }
 
-// SetLinkedService sets the LinkedService field's value.
-func (s *HealthCheck) SetLinkedService(v *LinkedService) *HealthCheck {
-	s.LinkedService = v
+// SetOwner sets the Owner field's value.
+func (s *HostedZoneSummary) SetOwner(v *HostedZoneOwner) *HostedZoneSummary {
+	s.Owner = v
 	return s
 }
 
-// A complex type that contains information about the health check.
-type HealthCheckConfig struct {
+// A key-signing key (KSK) is a complex type that represents a public/private
+// key pair. The private key is used to generate a digital signature for the
+// zone signing key (ZSK). The public key is stored in the DNS and is used to
+// authenticate the ZSK. A KSK is always associated with a hosted zone; it cannot
+// exist by itself.
+type KeySigningKey struct {
 	_ struct{} `type:"structure"`
 
-	// A complex type that identifies the CloudWatch alarm that you want Amazon
-	// Route 53 health checkers to use to determine whether the specified health
-	// check is healthy.
-	AlarmIdentifier *AlarmIdentifier `type:"structure"`
+	// The date when the key-signing key (KSK) was created.
+	CreatedDate *time.Time `type:"timestamp"`
 
-	// (CALCULATED Health Checks Only) A complex type that contains one ChildHealthCheck
-	// element for each health check that you want to associate with a CALCULATED
-	// health check.
-	ChildHealthChecks []*string `locationNameList:"ChildHealthCheck" type:"list"`
+	// A string that represents a DNSKEY record.
+	DNSKEYRecord *string `type:"string"`
 
-	// Stops Route 53 from performing health checks. When you disable a health check,
-	// here's what happens:
-	//
-	//    * Health checks that check the health of endpoints: Route 53 stops submitting
-	//    requests to your application, server, or other resource.
-	//
-	//    * Calculated health checks: Route 53 stops aggregating the status of the
-	//    referenced health checks.
-	//
-	//    * Health checks that monitor CloudWatch alarms: Route 53 stops monitoring
-	//    the corresponding CloudWatch metrics.
-	//
-	// After you disable a health check, Route 53 considers the status of the health
-	// check to always be healthy. If you configured DNS failover, Route 53 continues
-	// to route traffic to the corresponding resources. If you want to stop routing
-	// traffic to a resource, change the value of Inverted (https://docs.aws.amazon.com/Route53/latest/APIReference/API_UpdateHealthCheck.html#Route53-UpdateHealthCheck-request-Inverted).
-	//
-	// Charges for a health check still apply when the health check is disabled.
-	// For more information, see Amazon Route 53 Pricing (http://aws.amazon.com/route53/pricing/).
-	Disabled *bool `type:"boolean"`
+	// A string that represents a delegation signer (DS) record.
+	DSRecord *string `type:"string"`
 
-	// Specify whether you want Amazon Route 53 to send the value of FullyQualifiedDomainName
-	// to the endpoint in the client_hello message during TLS negotiation. This
-	// allows the endpoint to respond to HTTPS health check requests with the applicable
-	// SSL/TLS certificate.
-	//
-	// Some endpoints require that HTTPS requests include the host name in the client_hello
-	// message. If you don't enable SNI, the status of the health check will be
-	// SSL alert handshake_failure. A health check can also have that status for
-	// other reasons. If SNI is enabled and you're still getting the error, check
-	// the SSL/TLS configuration on your endpoint and confirm that your certificate
-	// is valid.
-	//
-	// The SSL/TLS certificate on your endpoint includes a domain name in the Common
-	// Name field and possibly several more in the Subject Alternative Names field.
-	// One of the domain names in the certificate should match the value that you
-	// specify for FullyQualifiedDomainName. If the endpoint responds to the client_hello
-	// message with a certificate that does not include the domain name that you
-	// specified in FullyQualifiedDomainName, a health checker will retry the handshake.
-	// In the second attempt, the health checker will omit FullyQualifiedDomainName
-	// from the client_hello message.
-	EnableSNI *bool `type:"boolean"`
+	// A string used to represent the delegation signer digest algorithm. This value
+	// must follow the guidelines provided by RFC-8624 Section 3.3 (https://tools.ietf.org/html/rfc8624#section-3.3).
+	DigestAlgorithmMnemonic *string `type:"string"`
 
-	// The number of consecutive health checks that an endpoint must pass or fail
-	// for Amazon Route 53 to change the current status of the endpoint from unhealthy
-	// to healthy or vice versa. For more information, see How Amazon Route 53 Determines
-	// Whether an Endpoint Is Healthy (http://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover-determining-health-of-endpoints.html)
-	// in the Amazon Route 53 Developer Guide.
-	//
-	// If you don't specify a value for FailureThreshold, the default value is three
-	// health checks.
-	FailureThreshold *int64 `min:"1" type:"integer"`
+	// An integer used to represent the delegation signer digest algorithm. This
+	// value must follow the guidelines provided by RFC-8624 Section 3.3 (https://tools.ietf.org/html/rfc8624#section-3.3).
+	DigestAlgorithmType *int64 `type:"integer"`
 
-	// Amazon Route 53 behavior depends on whether you specify a value for IPAddress.
-	//
-	// If you specify a value for IPAddress:
-	//
-	// Amazon Route 53 sends health check requests to the specified IPv4 or IPv6
-	// address and passes the value of FullyQualifiedDomainName in the Host header
-	// for all health checks except TCP health checks. This is typically the fully
-	// qualified DNS name of the endpoint on which you want Route 53 to perform
-	// health checks.
-	//
-	// When Route 53 checks the health of an endpoint, here is how it constructs
-	// the Host header:
-	//
-	//    * If you specify a value of 80 for Port and HTTP or HTTP_STR_MATCH for
-	//    Type, Route 53 passes the value of FullyQualifiedDomainName to the endpoint
-	//    in the Host header.
-	//
-	//    * If you specify a value of 443 for Port and HTTPS or HTTPS_STR_MATCH
-	//    for Type, Route 53 passes the value of FullyQualifiedDomainName to the
-	//    endpoint in the Host header.
-	//
-	//    * If you specify another value for Port and any value except TCP for Type,
-	//    Route 53 passes FullyQualifiedDomainName:Port to the endpoint in the Host
-	//    header.
-	//
-	// If you don't specify a value for FullyQualifiedDomainName, Route 53 substitutes
-	// the value of IPAddress in the Host header in each of the preceding cases.
-	//
-	// If you don't specify a value for IPAddress :
-	//
-	// Route 53 sends a DNS request to the domain that you specify for FullyQualifiedDomainName
-	// at the interval that you specify for RequestInterval. Using an IPv4 address
-	// that DNS returns, Route 53 then checks the health of the endpoint.
-	//
-	// If you don't specify a value for IPAddress, Route 53 uses only IPv4 to send
-	// health checks to the endpoint. If there's no resource record set with a type
-	// of A for the name that you specify for FullyQualifiedDomainName, the health
-	// check fails with a "DNS resolution failed" error.
-	//
-	// If you want to check the health of weighted, latency, or failover resource
-	// record sets and you choose to specify the endpoint only by FullyQualifiedDomainName,
-	// we recommend that you create a separate health check for each endpoint. For
-	// example, create a health check for each HTTP server that is serving content
-	// for www.example.com. For the value of FullyQualifiedDomainName, specify the
-	// domain name of the server (such as us-east-2-www.example.com), not the name
-	// of the resource record sets (www.example.com).
-	//
-	// In this configuration, if you create a health check for which the value of
-	// FullyQualifiedDomainName matches the name of the resource record sets and
-	// you then associate the health check with those resource record sets, health
-	// check results will be unpredictable.
-	//
-	// In addition, if the value that you specify for Type is HTTP, HTTPS, HTTP_STR_MATCH,
-	// or HTTPS_STR_MATCH, Route 53 passes the value of FullyQualifiedDomainName
-	// in the Host header, as it does when you specify a value for IPAddress. If
-	// the value of Type is TCP, Route 53 doesn't pass a Host header.
-	FullyQualifiedDomainName *string `type:"string"`
+	// A cryptographic digest of a DNSKEY resource record (RR). DNSKEY records are
+	// used to publish the public key that resolvers can use to verify DNSSEC signatures
+	// that are used to secure certain kinds of information provided by the DNS
+	// system.
+	DigestValue *string `type:"string"`
 
-	// The number of child health checks that are associated with a CALCULATED health
-	// check that Amazon Route 53 must consider healthy for the CALCULATED health
-	// check to be considered healthy. To specify the child health checks that you
-	// want to associate with a CALCULATED health check, use the ChildHealthChecks
-	// (https://docs.aws.amazon.com/Route53/latest/APIReference/API_UpdateHealthCheck.html#Route53-UpdateHealthCheck-request-ChildHealthChecks)
-	// element.
-	//
-	// Note the following:
-	//
-	//    * If you specify a number greater than the number of child health checks,
-	//    Route 53 always considers this health check to be unhealthy.
-	//
-	//    * If you specify 0, Route 53 always considers this health check to be
-	//    healthy.
-	HealthThreshold *int64 `type:"integer"`
+	// An integer that specifies how the key is used. For key-signing key (KSK),
+	// this value is always 257.
+	Flag *int64 `type:"integer"`
 
-	// The IPv4 or IPv6 IP address of the endpoint that you want Amazon Route 53
-	// to perform health checks on. If you don't specify a value for IPAddress,
-	// Route 53 sends a DNS request to resolve the domain name that you specify
-	// in FullyQualifiedDomainName at the interval that you specify in RequestInterval.
-	// Using an IP address returned by DNS, Route 53 then checks the health of the
-	// endpoint.
-	//
-	// Use one of the following formats for the value of IPAddress:
-	//
-	//    * IPv4 address: four values between 0 and 255, separated by periods (.),
-	//    for example, 192.0.2.44.
-	//
-	//    * IPv6 address: eight groups of four hexadecimal values, separated by
-	//    colons (:), for example, 2001:0db8:85a3:0000:0000:abcd:0001:2345. You
-	//    can also shorten IPv6 addresses as described in RFC 5952, for example,
-	//    2001:db8:85a3::abcd:1:2345.
-	//
-	// If the endpoint is an EC2 instance, we recommend that you create an Elastic
-	// IP address, associate it with your EC2 instance, and specify the Elastic
-	// IP address for IPAddress. This ensures that the IP address of your instance
-	// will never change.
+	// An integer used to identify the DNSSEC record for the domain name. The process
+	// used to calculate the value is described in RFC-4034 Appendix B (https://tools.ietf.org/rfc/rfc4034.txt).
+	KeyTag *int64 `type:"integer"`
+
+	// The Amazon resource name (ARN) used to identify the customer managed key
+	// in Key Management Service (KMS). The KmsArn must be unique for each key-signing
+	// key (KSK) in a single hosted zone.
 	//
-	// For more information, see FullyQualifiedDomainName (https://docs.aws.amazon.com/Route53/latest/APIReference/API_UpdateHealthCheck.html#Route53-UpdateHealthCheck-request-FullyQualifiedDomainName).
+	// You must configure the customer managed key as follows:
 	//
-	// Constraints: Route 53 can't check the health of endpoints for which the IP
-	// address is in local, private, non-routable, or multicast ranges. For more
-	// information about IP addresses for which you can't create health checks,
-	// see the following documents:
+	// Status
 	//
-	//    * RFC 5735, Special Use IPv4 Addresses (https://tools.ietf.org/html/rfc5735)
+	// Enabled
 	//
-	//    * RFC 6598, IANA-Reserved IPv4 Prefix for Shared Address Space (https://tools.ietf.org/html/rfc6598)
+	// Key spec
 	//
-	//    * RFC 5156, Special-Use IPv6 Addresses (https://tools.ietf.org/html/rfc5156)
+	// ECC_NIST_P256
 	//
-	// When the value of Type is CALCULATED or CLOUDWATCH_METRIC, omit IPAddress.
-	IPAddress *string `type:"string"`
-
-	// When CloudWatch has insufficient data about the metric to determine the alarm
-	// state, the status that you want Amazon Route 53 to assign to the health check:
+	// Key usage
 	//
-	//    * Healthy: Route 53 considers the health check to be healthy.
+	// Sign and verify
 	//
-	//    * Unhealthy: Route 53 considers the health check to be unhealthy.
+	// Key policy
 	//
-	//    * LastKnownStatus: Route 53 uses the status of the health check from the
-	//    last time that CloudWatch had sufficient data to determine the alarm state.
-	//    For new health checks that have no last known status, the default status
-	//    for the health check is healthy.
-	InsufficientDataHealthStatus *string `type:"string" enum:"InsufficientDataHealthStatus"`
-
-	// Specify whether you want Amazon Route 53 to invert the status of a health
-	// check, for example, to consider a health check unhealthy when it otherwise
-	// would be considered healthy.
-	Inverted *bool `type:"boolean"`
-
-	// Specify whether you want Amazon Route 53 to measure the latency between health
-	// checkers in multiple AWS regions and your endpoint, and to display CloudWatch
-	// latency graphs on the Health Checks page in the Route 53 console.
+	// The key policy must give permission for the following actions:
 	//
-	// You can't change the value of MeasureLatency after you create a health check.
-	MeasureLatency *bool `type:"boolean"`
-
-	// The port on the endpoint on which you want Amazon Route 53 to perform health
-	// checks. Specify a value for Port only when you specify a value for IPAddress.
-	Port *int64 `min:"1" type:"integer"`
-
-	// A complex type that contains one Region element for each region from which
-	// you want Amazon Route 53 health checkers to check the specified endpoint.
+	//    * DescribeKey
 	//
-	// If you don't specify any regions, Route 53 health checkers automatically
-	// performs checks from all of the regions that are listed under Valid Values.
+	//    * GetPublicKey
 	//
-	// If you update a health check to remove a region that has been performing
-	// health checks, Route 53 will briefly continue to perform checks from that
-	// region to ensure that some health checkers are always checking the endpoint
-	// (for example, if you replace three regions with four different regions).
-	Regions []*string `locationNameList:"Region" min:"3" type:"list"`
-
-	// The number of seconds between the time that Amazon Route 53 gets a response
-	// from your endpoint and the time that it sends the next health check request.
-	// Each Route 53 health checker makes requests at this interval.
+	//    * Sign
 	//
-	// You can't change the value of RequestInterval after you create a health check.
+	// The key policy must also include the Amazon Route 53 service in the principal
+	// for your account. Specify the following:
 	//
-	// If you don't specify a value for RequestInterval, the default value is 30
-	// seconds.
-	RequestInterval *int64 `min:"10" type:"integer"`
+	//    * "Service": "dnssec-route53.amazonaws.com"
+	//
+	// For more information about working with the customer managed key in KMS,
+	// see Key Management Service concepts (https://docs.aws.amazon.com/kms/latest/developerguide/concepts.html).
+	KmsArn *string `type:"string"`
 
-	// The path, if any, that you want Amazon Route 53 to request when performing
-	// health checks. The path can be any value for which your endpoint will return
-	// an HTTP status code of 2xx or 3xx when the endpoint is healthy, for example,
-	// the file /docs/route53-health-check.html. You can also include query string
-	// parameters, for example, /welcome.html?language=jp&login=y.
-	ResourcePath *string `type:"string"`
+	// The last time that the key-signing key (KSK) was changed.
+	LastModifiedDate *time.Time `type:"timestamp"`
 
-	// If the value of Type is HTTP_STR_MATCH or HTTP_STR_MATCH, the string that
-	// you want Amazon Route 53 to search for in the response body from the specified
-	// resource. If the string appears in the response body, Route 53 considers
-	// the resource healthy.
-	//
-	// Route 53 considers case when searching for SearchString in the response body.
-	SearchString *string `type:"string"`
+	// A string used to identify a key-signing key (KSK). Name can include numbers,
+	// letters, and underscores (_). Name must be unique for each key-signing key
+	// in the same hosted zone.
+	Name *string `min:"3" type:"string"`
 
-	// The type of health check that you want to create, which indicates how Amazon
-	// Route 53 determines whether an endpoint is healthy.
+	// The public key, represented as a Base64 encoding, as required by RFC-4034
+	// Page 5 (https://tools.ietf.org/rfc/rfc4034.txt).
+	PublicKey *string `type:"string"`
+
+	// A string used to represent the signing algorithm. This value must follow
+	// the guidelines provided by RFC-8624 Section 3.1 (https://tools.ietf.org/html/rfc8624#section-3.1).
+	SigningAlgorithmMnemonic *string `type:"string"`
+
+	// An integer used to represent the signing algorithm. This value must follow
+	// the guidelines provided by RFC-8624 Section 3.1 (https://tools.ietf.org/html/rfc8624#section-3.1).
+	SigningAlgorithmType *int64 `type:"integer"`
+
+	// A string that represents the current key-signing key (KSK) status.
 	//
-	// You can't change the value of Type after you create a health check.
+	// Status can have one of the following values:
 	//
-	// You can create the following types of health checks:
+	// ACTIVE
 	//
-	//    * HTTP: Route 53 tries to establish a TCP connection. If successful, Route
-	//    53 submits an HTTP request and waits for an HTTP status code of 200 or
-	//    greater and less than 400.
+	// The KSK is being used for signing.
 	//
-	//    * HTTPS: Route 53 tries to establish a TCP connection. If successful,
-	//    Route 53 submits an HTTPS request and waits for an HTTP status code of
-	//    200 or greater and less than 400. If you specify HTTPS for the value of
-	//    Type, the endpoint must support TLS v1.0 or later.
+	// INACTIVE
 	//
-	//    * HTTP_STR_MATCH: Route 53 tries to establish a TCP connection. If successful,
-	//    Route 53 submits an HTTP request and searches the first 5,120 bytes of
-	//    the response body for the string that you specify in SearchString.
+	// The KSK is not being used for signing.
 	//
-	//    * HTTPS_STR_MATCH: Route 53 tries to establish a TCP connection. If successful,
-	//    Route 53 submits an HTTPS request and searches the first 5,120 bytes of
-	//    the response body for the string that you specify in SearchString.
+	// DELETING
 	//
-	//    * TCP: Route 53 tries to establish a TCP connection.
+	// The KSK is in the process of being deleted.
 	//
-	//    * CLOUDWATCH_METRIC: The health check is associated with a CloudWatch
-	//    alarm. If the state of the alarm is OK, the health check is considered
-	//    healthy. If the state is ALARM, the health check is considered unhealthy.
-	//    If CloudWatch doesn't have sufficient data to determine whether the state
-	//    is OK or ALARM, the health check status depends on the setting for InsufficientDataHealthStatus:
-	//    Healthy, Unhealthy, or LastKnownStatus.
+	// ACTION_NEEDED
 	//
-	//    * CALCULATED: For health checks that monitor the status of other health
-	//    checks, Route 53 adds up the number of health checks that Route 53 health
-	//    checkers consider to be healthy and compares that number with the value
-	//    of HealthThreshold.
+	// There is a problem with the KSK that requires you to take action to resolve.
+	// For example, the customer managed key might have been deleted, or the permissions
+	// for the customer managed key might have been changed.
 	//
-	// For more information, see How Route 53 Determines Whether an Endpoint Is
-	// Healthy (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover-determining-health-of-endpoints.html)
-	// in the Amazon Route 53 Developer Guide.
+	// INTERNAL_FAILURE
 	//
-	// Type is a required field
-	Type *string `type:"string" required:"true" enum:"HealthCheckType"`
+	// There was an error during a request. Before you can continue to work with
+	// DNSSEC signing, including actions that involve this KSK, you must correct
+	// the problem. For example, you may need to activate or deactivate the KSK.
+	Status *string `min:"5" type:"string"`
+
+	// The status message provided for the following key-signing key (KSK) statuses:
+	// ACTION_NEEDED or INTERNAL_FAILURE. The status message includes information
+	// about what the problem might be and steps that you can take to correct the
+	// issue.
+	StatusMessage *string `type:"string"`
 }
 
-// String returns the string representation
-func (s HealthCheckConfig) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s KeySigningKey) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s HealthCheckConfig) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s KeySigningKey) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *HealthCheckConfig) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "HealthCheckConfig"}
-	if s.FailureThreshold != nil && *s.FailureThreshold < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("FailureThreshold", 1))
-	}
-	if s.Port != nil && *s.Port < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("Port", 1))
-	}
-	if s.Regions != nil && len(s.Regions) < 3 {
-		invalidParams.Add(request.NewErrParamMinLen("Regions", 3))
-	}
-	if s.RequestInterval != nil && *s.RequestInterval < 10 {
-		invalidParams.Add(request.NewErrParamMinValue("RequestInterval", 10))
-	}
-	if s.Type == nil {
-		invalidParams.Add(request.NewErrParamRequired("Type"))
-	}
-	if s.AlarmIdentifier != nil {
-		if err := s.AlarmIdentifier.Validate(); err != nil {
-			invalidParams.AddNested("AlarmIdentifier", err.(request.ErrInvalidParams))
-		}
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetCreatedDate sets the CreatedDate field's value.
+func (s *KeySigningKey) SetCreatedDate(v time.Time) *KeySigningKey {
+	s.CreatedDate = &v
+	return s
 }
 
-// SetAlarmIdentifier sets the AlarmIdentifier field's value.
-func (s *HealthCheckConfig) SetAlarmIdentifier(v *AlarmIdentifier) *HealthCheckConfig {
-	s.AlarmIdentifier = v
+// SetDNSKEYRecord sets the DNSKEYRecord field's value.
+func (s *KeySigningKey) SetDNSKEYRecord(v string) *KeySigningKey {
+	s.DNSKEYRecord = &v
 	return s
 }
 
-// SetChildHealthChecks sets the ChildHealthChecks field's value.
-func (s *HealthCheckConfig) SetChildHealthChecks(v []*string) *HealthCheckConfig {
-	s.ChildHealthChecks = v
+// SetDSRecord sets the DSRecord field's value.
+func (s *KeySigningKey) SetDSRecord(v string) *KeySigningKey {
+	s.DSRecord = &v
 	return s
 }
 
-// SetDisabled sets the Disabled field's value.
-func (s *HealthCheckConfig) SetDisabled(v bool) *HealthCheckConfig {
-	s.Disabled = &v
+// SetDigestAlgorithmMnemonic sets the DigestAlgorithmMnemonic field's value.
+func (s *KeySigningKey) SetDigestAlgorithmMnemonic(v string) *KeySigningKey {
+	s.DigestAlgorithmMnemonic = &v
 	return s
 }
 
-// SetEnableSNI sets the EnableSNI field's value.
-func (s *HealthCheckConfig) SetEnableSNI(v bool) *HealthCheckConfig {
-	s.EnableSNI = &v
+// SetDigestAlgorithmType sets the DigestAlgorithmType field's value.
+func (s *KeySigningKey) SetDigestAlgorithmType(v int64) *KeySigningKey {
+	s.DigestAlgorithmType = &v
 	return s
 }
 
-// SetFailureThreshold sets the FailureThreshold field's value.
-func (s *HealthCheckConfig) SetFailureThreshold(v int64) *HealthCheckConfig {
-	s.FailureThreshold = &v
+// SetDigestValue sets the DigestValue field's value.
+func (s *KeySigningKey) SetDigestValue(v string) *KeySigningKey {
+	s.DigestValue = &v
 	return s
 }
 
-// SetFullyQualifiedDomainName sets the FullyQualifiedDomainName field's value.
-func (s *HealthCheckConfig) SetFullyQualifiedDomainName(v string) *HealthCheckConfig {
-	s.FullyQualifiedDomainName = &v
+// SetFlag sets the Flag field's value.
+func (s *KeySigningKey) SetFlag(v int64) *KeySigningKey {
+	s.Flag = &v
 	return s
 }
 
-// SetHealthThreshold sets the HealthThreshold field's value.
-func (s *HealthCheckConfig) SetHealthThreshold(v int64) *HealthCheckConfig {
-	s.HealthThreshold = &v
+// SetKeyTag sets the KeyTag field's value.
+func (s *KeySigningKey) SetKeyTag(v int64) *KeySigningKey {
+	s.KeyTag = &v
 	return s
 }
 
-// SetIPAddress sets the IPAddress field's value.
-func (s *HealthCheckConfig) SetIPAddress(v string) *HealthCheckConfig {
-	s.IPAddress = &v
+// SetKmsArn sets the KmsArn field's value.
+func (s *KeySigningKey) SetKmsArn(v string) *KeySigningKey {
+	s.KmsArn = &v
 	return s
 }
 
-// SetInsufficientDataHealthStatus sets the InsufficientDataHealthStatus field's value.
-func (s *HealthCheckConfig) SetInsufficientDataHealthStatus(v string) *HealthCheckConfig {
-	s.InsufficientDataHealthStatus = &v
+// SetLastModifiedDate sets the LastModifiedDate field's value.
+func (s *KeySigningKey) SetLastModifiedDate(v time.Time) *KeySigningKey {
+	s.LastModifiedDate = &v
 	return s
 }
 
-// SetInverted sets the Inverted field's value.
-func (s *HealthCheckConfig) SetInverted(v bool) *HealthCheckConfig {
-	s.Inverted = &v
+// SetName sets the Name field's value.
+func (s *KeySigningKey) SetName(v string) *KeySigningKey {
+	s.Name = &v
 	return s
 }
 
-// SetMeasureLatency sets the MeasureLatency field's value.
-func (s *HealthCheckConfig) SetMeasureLatency(v bool) *HealthCheckConfig {
-	s.MeasureLatency = &v
+// SetPublicKey sets the PublicKey field's value.
+func (s *KeySigningKey) SetPublicKey(v string) *KeySigningKey {
+	s.PublicKey = &v
 	return s
 }
 
-// SetPort sets the Port field's value.
-func (s *HealthCheckConfig) SetPort(v int64) *HealthCheckConfig {
-	s.Port = &v
+// SetSigningAlgorithmMnemonic sets the SigningAlgorithmMnemonic field's value.
+func (s *KeySigningKey) SetSigningAlgorithmMnemonic(v string) *KeySigningKey {
+	s.SigningAlgorithmMnemonic = &v
 	return s
 }
 
-// SetRegions sets the Regions field's value.
-func (s *HealthCheckConfig) SetRegions(v []*string) *HealthCheckConfig {
-	s.Regions = v
+// SetSigningAlgorithmType sets the SigningAlgorithmType field's value.
+func (s *KeySigningKey) SetSigningAlgorithmType(v int64) *KeySigningKey {
+	s.SigningAlgorithmType = &v
 	return s
 }
 
-// SetRequestInterval sets the RequestInterval field's value.
-func (s *HealthCheckConfig) SetRequestInterval(v int64) *HealthCheckConfig {
-	s.RequestInterval = &v
+// SetStatus sets the Status field's value.
+func (s *KeySigningKey) SetStatus(v string) *KeySigningKey {
+	s.Status = &v
 	return s
 }
 
-// SetResourcePath sets the ResourcePath field's value.
-func (s *HealthCheckConfig) SetResourcePath(v string) *HealthCheckConfig {
-	s.ResourcePath = &v
+// SetStatusMessage sets the StatusMessage field's value.
+func (s *KeySigningKey) SetStatusMessage(v string) *KeySigningKey {
+	s.StatusMessage = &v
 	return s
 }
 
-// SetSearchString sets the SearchString field's value.
-func (s *HealthCheckConfig) SetSearchString(v string) *HealthCheckConfig {
-	s.SearchString = &v
+// If a health check or hosted zone was created by another service, LinkedService
+// is a complex type that describes the service that created the resource. When
+// a resource is created by another service, you can't edit or delete it using
+// Amazon Route 53.
+type LinkedService struct {
+	_ struct{} `type:"structure"`
+
+	// If the health check or hosted zone was created by another service, an optional
+	// description that can be provided by the other service. When a resource is
+	// created by another service, you can't edit or delete it using Amazon Route
+	// 53.
+	Description *string `type:"string"`
+
+	// If the health check or hosted zone was created by another service, the service
+	// that created the resource. When a resource is created by another service,
+	// you can't edit or delete it using Amazon Route 53.
+	ServicePrincipal *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LinkedService) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LinkedService) GoString() string {
+	return s.String()
+}
+
+// SetDescription sets the Description field's value.
+func (s *LinkedService) SetDescription(v string) *LinkedService {
+	s.Description = &v
 	return s
 }
 
-// SetType sets the Type field's value.
-func (s *HealthCheckConfig) SetType(v string) *HealthCheckConfig {
-	s.Type = &v
+// SetServicePrincipal sets the ServicePrincipal field's value.
+func (s *LinkedService) SetServicePrincipal(v string) *LinkedService {
+	s.ServicePrincipal = &v
 	return s
 }
 
-// A complex type that contains the last failure reason as reported by one Amazon
-// Route 53 health checker.
-type HealthCheckObservation struct {
-	_ struct{} `type:"structure"`
+type ListCidrBlocksInput struct {
+	_ struct{} `locationName:"ListCidrBlocksRequest" type:"structure"`
 
-	// The IP address of the Amazon Route 53 health checker that provided the failure
-	// reason in StatusReport.
-	IPAddress *string `type:"string"`
+	// The UUID of the CIDR collection.
+	//
+	// CollectionId is a required field
+	CollectionId *string `location:"uri" locationName:"CidrCollectionId" type:"string" required:"true"`
 
-	// The region of the Amazon Route 53 health checker that provided the status
-	// in StatusReport.
-	Region *string `min:"1" type:"string" enum:"HealthCheckRegion"`
+	// The name of the CIDR collection location.
+	LocationName *string `location:"querystring" locationName:"location" min:"1" type:"string"`
 
-	// A complex type that contains the last failure reason as reported by one Amazon
-	// Route 53 health checker and the time of the failed health check.
-	StatusReport *StatusReport `type:"structure"`
+	// Maximum number of results you want returned.
+	MaxResults *string `location:"querystring" locationName:"maxresults" type:"string"`
+
+	// An opaque pagination token to indicate where the service is to begin enumerating
+	// results.
+	NextToken *string `location:"querystring" locationName:"nexttoken" type:"string"`
 }
 
-// String returns the string representation
-func (s HealthCheckObservation) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCidrBlocksInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s HealthCheckObservation) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCidrBlocksInput) GoString() string {
 	return s.String()
 }
 
-// SetIPAddress sets the IPAddress field's value.
-func (s *HealthCheckObservation) SetIPAddress(v string) *HealthCheckObservation {
-	s.IPAddress = &v
-	return s
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListCidrBlocksInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListCidrBlocksInput"}
+	if s.CollectionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("CollectionId"))
+	}
+	if s.CollectionId != nil && len(*s.CollectionId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CollectionId", 1))
+	}
+	if s.LocationName != nil && len(*s.LocationName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("LocationName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetRegion sets the Region field's value.
-func (s *HealthCheckObservation) SetRegion(v string) *HealthCheckObservation {
-	s.Region = &v
+// SetCollectionId sets the CollectionId field's value.
+func (s *ListCidrBlocksInput) SetCollectionId(v string) *ListCidrBlocksInput {
+	s.CollectionId = &v
 	return s
 }
 
-// SetStatusReport sets the StatusReport field's value.
-func (s *HealthCheckObservation) SetStatusReport(v *StatusReport) *HealthCheckObservation {
-	s.StatusReport = v
+// SetLocationName sets the LocationName field's value.
+func (s *ListCidrBlocksInput) SetLocationName(v string) *ListCidrBlocksInput {
+	s.LocationName = &v
 	return s
 }
 
-// A complex type that contains general information about the hosted zone.
-type HostedZone struct {
-	_ struct{} `type:"structure"`
-
-	// The value that you specified for CallerReference when you created the hosted
-	// zone.
-	//
-	// CallerReference is a required field
-	CallerReference *string `min:"1" type:"string" required:"true"`
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListCidrBlocksInput) SetMaxResults(v string) *ListCidrBlocksInput {
+	s.MaxResults = &v
+	return s
+}
 
-	// A complex type that includes the Comment and PrivateZone elements. If you
-	// omitted the HostedZoneConfig and Comment elements from the request, the Config
-	// and Comment elements don't appear in the response.
-	Config *HostedZoneConfig `type:"structure"`
+// SetNextToken sets the NextToken field's value.
+func (s *ListCidrBlocksInput) SetNextToken(v string) *ListCidrBlocksInput {
+	s.NextToken = &v
+	return s
+}
 
-	// The ID that Amazon Route 53 assigned to the hosted zone when you created
-	// it.
-	//
-	// Id is a required field
-	Id *string `type:"string" required:"true"`
+type ListCidrBlocksOutput struct {
+	_ struct{} `type:"structure"`
 
-	// If the hosted zone was created by another service, the service that created
-	// the hosted zone. When a hosted zone is created by another service, you can't
-	// edit or delete it using Route 53.
-	LinkedService *LinkedService `type:"structure"`
+	// A complex type that contains information about the CIDR blocks.
+	CidrBlocks []*CidrBlockSummary `type:"list"`
 
-	// The name of the domain. For public hosted zones, this is the name that you
-	// have registered with your DNS registrar.
-	//
-	// For information about how to specify characters other than a-z, 0-9, and
-	// - (hyphen) and how to specify internationalized domain names, see CreateHostedZone
-	// (https://docs.aws.amazon.com/Route53/latest/APIReference/API_CreateHostedZone.html).
+	// An opaque pagination token to indicate where the service is to begin enumerating
+	// results.
 	//
-	// Name is a required field
-	Name *string `type:"string" required:"true"`
-
-	// The number of resource record sets in the hosted zone.
-	ResourceRecordSetCount *int64 `type:"long"`
+	// If no value is provided, the listing of results starts from the beginning.
+	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
-func (s HostedZone) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCidrBlocksOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s HostedZone) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCidrBlocksOutput) GoString() string {
 	return s.String()
 }
 
-// SetCallerReference sets the CallerReference field's value.
-func (s *HostedZone) SetCallerReference(v string) *HostedZone {
-	s.CallerReference = &v
+// SetCidrBlocks sets the CidrBlocks field's value.
+func (s *ListCidrBlocksOutput) SetCidrBlocks(v []*CidrBlockSummary) *ListCidrBlocksOutput {
+	s.CidrBlocks = v
 	return s
 }
 
-// SetConfig sets the Config field's value.
-func (s *HostedZone) SetConfig(v *HostedZoneConfig) *HostedZone {
-	s.Config = v
+// SetNextToken sets the NextToken field's value.
+func (s *ListCidrBlocksOutput) SetNextToken(v string) *ListCidrBlocksOutput {
+	s.NextToken = &v
 	return s
 }
 
-// SetId sets the Id field's value.
-func (s *HostedZone) SetId(v string) *HostedZone {
-	s.Id = &v
-	return s
+type ListCidrCollectionsInput struct {
+	_ struct{} `locationName:"ListCidrCollectionsRequest" type:"structure"`
+
+	// The maximum number of CIDR collections to return in the response.
+	MaxResults *string `location:"querystring" locationName:"maxresults" type:"string"`
+
+	// An opaque pagination token to indicate where the service is to begin enumerating
+	// results.
+	//
+	// If no value is provided, the listing of results starts from the beginning.
+	NextToken *string `location:"querystring" locationName:"nexttoken" type:"string"`
 }
 
-// SetLinkedService sets the LinkedService field's value.
-func (s *HostedZone) SetLinkedService(v *LinkedService) *HostedZone {
-	s.LinkedService = v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCidrCollectionsInput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetName sets the Name field's value.
-func (s *HostedZone) SetName(v string) *HostedZone {
-	s.Name = &v
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCidrCollectionsInput) GoString() string {
+	return s.String()
+}
+
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListCidrCollectionsInput) SetMaxResults(v string) *ListCidrCollectionsInput {
+	s.MaxResults = &v
 	return s
 }
 
-// SetResourceRecordSetCount sets the ResourceRecordSetCount field's value.
-func (s *HostedZone) SetResourceRecordSetCount(v int64) *HostedZone {
-	s.ResourceRecordSetCount = &v
+// SetNextToken sets the NextToken field's value.
+func (s *ListCidrCollectionsInput) SetNextToken(v string) *ListCidrCollectionsInput {
+	s.NextToken = &v
 	return s
 }
 
-// A complex type that contains an optional comment about your hosted zone.
-// If you don't want to specify a comment, omit both the HostedZoneConfig and
-// Comment elements.
-type HostedZoneConfig struct {
+type ListCidrCollectionsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Any comments that you want to include about the hosted zone.
-	Comment *string `type:"string"`
+	// A complex type with information about the CIDR collection.
+	CidrCollections []*CollectionSummary `type:"list"`
 
-	// A value that indicates whether this is a private hosted zone.
-	PrivateZone *bool `type:"boolean"`
+	// An opaque pagination token to indicate where the service is to begin enumerating
+	// results.
+	//
+	// If no value is provided, the listing of results starts from the beginning.
+	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
-func (s HostedZoneConfig) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCidrCollectionsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s HostedZoneConfig) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCidrCollectionsOutput) GoString() string {
 	return s.String()
 }
 
-// SetComment sets the Comment field's value.
-func (s *HostedZoneConfig) SetComment(v string) *HostedZoneConfig {
-	s.Comment = &v
+// SetCidrCollections sets the CidrCollections field's value.
+func (s *ListCidrCollectionsOutput) SetCidrCollections(v []*CollectionSummary) *ListCidrCollectionsOutput {
+	s.CidrCollections = v
 	return s
 }
 
-// SetPrivateZone sets the PrivateZone field's value.
-func (s *HostedZoneConfig) SetPrivateZone(v bool) *HostedZoneConfig {
-	s.PrivateZone = &v
+// SetNextToken sets the NextToken field's value.
+func (s *ListCidrCollectionsOutput) SetNextToken(v string) *ListCidrCollectionsOutput {
+	s.NextToken = &v
 	return s
 }
 
-// A complex type that contains the type of limit that you specified in the
-// request and the current value for that limit.
-type HostedZoneLimit struct {
-	_ struct{} `type:"structure"`
+type ListCidrLocationsInput struct {
+	_ struct{} `locationName:"ListCidrLocationsRequest" type:"structure"`
 
-	// The limit that you requested. Valid values include the following:
-	//
-	//    * MAX_RRSETS_BY_ZONE: The maximum number of records that you can create
-	//    in the specified hosted zone.
-	//
-	//    * MAX_VPCS_ASSOCIATED_BY_ZONE: The maximum number of Amazon VPCs that
-	//    you can associate with the specified private hosted zone.
+	// The CIDR collection ID.
 	//
-	// Type is a required field
-	Type *string `type:"string" required:"true" enum:"HostedZoneLimitType"`
+	// CollectionId is a required field
+	CollectionId *string `location:"uri" locationName:"CidrCollectionId" type:"string" required:"true"`
 
-	// The current value for the limit that is specified by Type.
+	// The maximum number of CIDR collection locations to return in the response.
+	MaxResults *string `location:"querystring" locationName:"maxresults" type:"string"`
+
+	// An opaque pagination token to indicate where the service is to begin enumerating
+	// results.
 	//
-	// Value is a required field
-	Value *int64 `min:"1" type:"long" required:"true"`
+	// If no value is provided, the listing of results starts from the beginning.
+	NextToken *string `location:"querystring" locationName:"nexttoken" type:"string"`
 }
 
-// String returns the string representation
-func (s HostedZoneLimit) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCidrLocationsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s HostedZoneLimit) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCidrLocationsInput) GoString() string {
 	return s.String()
 }
 
-// SetType sets the Type field's value.
-func (s *HostedZoneLimit) SetType(v string) *HostedZoneLimit {
-	s.Type = &v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListCidrLocationsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListCidrLocationsInput"}
+	if s.CollectionId == nil {
+		invalidParams.Add(request.NewErrParamRequired("CollectionId"))
+	}
+	if s.CollectionId != nil && len(*s.CollectionId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("CollectionId", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCollectionId sets the CollectionId field's value.
+func (s *ListCidrLocationsInput) SetCollectionId(v string) *ListCidrLocationsInput {
+	s.CollectionId = &v
 	return s
 }
 
-// SetValue sets the Value field's value.
-func (s *HostedZoneLimit) SetValue(v int64) *HostedZoneLimit {
-	s.Value = &v
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListCidrLocationsInput) SetMaxResults(v string) *ListCidrLocationsInput {
+	s.MaxResults = &v
 	return s
 }
 
-// If a health check or hosted zone was created by another service, LinkedService
-// is a complex type that describes the service that created the resource. When
-// a resource is created by another service, you can't edit or delete it using
-// Amazon Route 53.
-type LinkedService struct {
+// SetNextToken sets the NextToken field's value.
+func (s *ListCidrLocationsInput) SetNextToken(v string) *ListCidrLocationsInput {
+	s.NextToken = &v
+	return s
+}
+
+type ListCidrLocationsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// If the health check or hosted zone was created by another service, an optional
-	// description that can be provided by the other service. When a resource is
-	// created by another service, you can't edit or delete it using Amazon Route
-	// 53.
-	Description *string `type:"string"`
+	// A complex type that contains information about the list of CIDR locations.
+	CidrLocations []*LocationSummary `type:"list"`
 
-	// If the health check or hosted zone was created by another service, the service
-	// that created the resource. When a resource is created by another service,
-	// you can't edit or delete it using Amazon Route 53.
-	ServicePrincipal *string `type:"string"`
+	// An opaque pagination token to indicate where the service is to begin enumerating
+	// results.
+	//
+	// If no value is provided, the listing of results starts from the beginning.
+	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
-func (s LinkedService) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCidrLocationsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s LinkedService) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListCidrLocationsOutput) GoString() string {
 	return s.String()
 }
 
-// SetDescription sets the Description field's value.
-func (s *LinkedService) SetDescription(v string) *LinkedService {
-	s.Description = &v
+// SetCidrLocations sets the CidrLocations field's value.
+func (s *ListCidrLocationsOutput) SetCidrLocations(v []*LocationSummary) *ListCidrLocationsOutput {
+	s.CidrLocations = v
 	return s
 }
 
-// SetServicePrincipal sets the ServicePrincipal field's value.
-func (s *LinkedService) SetServicePrincipal(v string) *LinkedService {
-	s.ServicePrincipal = &v
+// SetNextToken sets the NextToken field's value.
+func (s *ListCidrLocationsOutput) SetNextToken(v string) *ListCidrLocationsOutput {
+	s.NextToken = &v
 	return s
 }
 
@@ -10713,28 +15414,33 @@ type ListGeoLocationsInput struct {
 	// a page or more of results, if IsTruncated is true, and if NextCountryCode
 	// from the previous response has a value, enter that value in startcountrycode
 	// to return the next page of results.
-	//
-	// Route 53 uses the two-letter country codes that are specified in ISO standard
-	// 3166-1 alpha-2 (https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2).
 	StartCountryCode *string `location:"querystring" locationName:"startcountrycode" min:"1" type:"string"`
 
-	// The code for the subdivision (for example, state or province) with which
-	// you want to start listing locations that Amazon Route 53 supports for geolocation.
-	// If Route 53 has already returned a page or more of results, if IsTruncated
-	// is true, and if NextSubdivisionCode from the previous response has a value,
-	// enter that value in startsubdivisioncode to return the next page of results.
+	// The code for the state of the United States with which you want to start
+	// listing locations that Amazon Route 53 supports for geolocation. If Route
+	// 53 has already returned a page or more of results, if IsTruncated is true,
+	// and if NextSubdivisionCode from the previous response has a value, enter
+	// that value in startsubdivisioncode to return the next page of results.
 	//
-	// To list subdivisions of a country, you must include both startcountrycode
+	// To list subdivisions (U.S. states), you must include both startcountrycode
 	// and startsubdivisioncode.
 	StartSubdivisionCode *string `location:"querystring" locationName:"startsubdivisioncode" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListGeoLocationsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListGeoLocationsInput) GoString() string {
 	return s.String()
 }
@@ -10822,12 +15528,20 @@ type ListGeoLocationsOutput struct {
 	NextSubdivisionCode *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListGeoLocationsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListGeoLocationsOutput) GoString() string {
 	return s.String()
 }
@@ -10869,7 +15583,7 @@ func (s *ListGeoLocationsOutput) SetNextSubdivisionCode(v string) *ListGeoLocati
 }
 
 // A request to retrieve a list of the health checks that are associated with
-// the current AWS account.
+// the current Amazon Web Services account.
 type ListHealthChecksInput struct {
 	_ struct{} `locationName:"ListHealthChecksRequest" type:"structure"`
 
@@ -10886,17 +15600,25 @@ type ListHealthChecksInput struct {
 
 	// The maximum number of health checks that you want ListHealthChecks to return
 	// in response to the current request. Amazon Route 53 returns a maximum of
-	// 100 items. If you set MaxItems to a value greater than 100, Route 53 returns
-	// only the first 100 health checks.
+	// 1000 items. If you set MaxItems to a value greater than 1000, Route 53 returns
+	// only the first 1000 health checks.
 	MaxItems *string `location:"querystring" locationName:"maxitems" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListHealthChecksInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListHealthChecksInput) GoString() string {
 	return s.String()
 }
@@ -10918,7 +15640,7 @@ type ListHealthChecksOutput struct {
 	_ struct{} `type:"structure"`
 
 	// A complex type that contains one HealthCheck element for each health check
-	// that is associated with the current AWS account.
+	// that is associated with the current Amazon Web Services account.
 	//
 	// HealthChecks is a required field
 	HealthChecks []*HealthCheck `locationNameList:"HealthCheck" type:"list" required:"true"`
@@ -10949,12 +15671,20 @@ type ListHealthChecksOutput struct {
 	NextMarker *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListHealthChecksOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListHealthChecksOutput) GoString() string {
 	return s.String()
 }
@@ -10990,17 +15720,17 @@ func (s *ListHealthChecksOutput) SetNextMarker(v string) *ListHealthChecksOutput
 }
 
 // Retrieves a list of the public and private hosted zones that are associated
-// with the current AWS account in ASCII order by domain name.
+// with the current Amazon Web Services account in ASCII order by domain name.
 type ListHostedZonesByNameInput struct {
 	_ struct{} `locationName:"ListHostedZonesByNameRequest" type:"structure"`
 
 	// (Optional) For your first request to ListHostedZonesByName, include the dnsname
 	// parameter only if you want to specify the name of the first hosted zone in
 	// the response. If you don't include the dnsname parameter, Amazon Route 53
-	// returns all of the hosted zones that were created by the current AWS account,
-	// in ASCII order. For subsequent requests, include both dnsname and hostedzoneid
-	// parameters. For dnsname, specify the value of NextDNSName from the previous
-	// response.
+	// returns all of the hosted zones that were created by the current Amazon Web
+	// Services account, in ASCII order. For subsequent requests, include both dnsname
+	// and hostedzoneid parameters. For dnsname, specify the value of NextDNSName
+	// from the previous response.
 	DNSName *string `location:"querystring" locationName:"dnsname" type:"string"`
 
 	// (Optional) For your first request to ListHostedZonesByName, do not include
@@ -11021,12 +15751,20 @@ type ListHostedZonesByNameInput struct {
 	MaxItems *string `location:"querystring" locationName:"maxitems" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListHostedZonesByNameInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListHostedZonesByNameInput) GoString() string {
 	return s.String()
 }
@@ -11099,12 +15837,20 @@ type ListHostedZonesByNameOutput struct {
 	NextHostedZoneId *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListHostedZonesByNameOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListHostedZonesByNameOutput) GoString() string {
 	return s.String()
 }
@@ -11115,44 +15861,198 @@ func (s *ListHostedZonesByNameOutput) SetDNSName(v string) *ListHostedZonesByNam
 	return s
 }
 
-// SetHostedZoneId sets the HostedZoneId field's value.
-func (s *ListHostedZonesByNameOutput) SetHostedZoneId(v string) *ListHostedZonesByNameOutput {
-	s.HostedZoneId = &v
-	return s
+// SetHostedZoneId sets the HostedZoneId field's value.
+func (s *ListHostedZonesByNameOutput) SetHostedZoneId(v string) *ListHostedZonesByNameOutput {
+	s.HostedZoneId = &v
+	return s
+}
+
+// SetHostedZones sets the HostedZones field's value.
+func (s *ListHostedZonesByNameOutput) SetHostedZones(v []*HostedZone) *ListHostedZonesByNameOutput {
+	s.HostedZones = v
+	return s
+}
+
+// SetIsTruncated sets the IsTruncated field's value.
+func (s *ListHostedZonesByNameOutput) SetIsTruncated(v bool) *ListHostedZonesByNameOutput {
+	s.IsTruncated = &v
+	return s
+}
+
+// SetMaxItems sets the MaxItems field's value.
+func (s *ListHostedZonesByNameOutput) SetMaxItems(v string) *ListHostedZonesByNameOutput {
+	s.MaxItems = &v
+	return s
+}
+
+// SetNextDNSName sets the NextDNSName field's value.
+func (s *ListHostedZonesByNameOutput) SetNextDNSName(v string) *ListHostedZonesByNameOutput {
+	s.NextDNSName = &v
+	return s
+}
+
+// SetNextHostedZoneId sets the NextHostedZoneId field's value.
+func (s *ListHostedZonesByNameOutput) SetNextHostedZoneId(v string) *ListHostedZonesByNameOutput {
+	s.NextHostedZoneId = &v
+	return s
+}
+
+// Lists all the private hosted zones that a specified VPC is associated with,
+// regardless of which Amazon Web Services account created the hosted zones.
+type ListHostedZonesByVPCInput struct {
+	_ struct{} `locationName:"ListHostedZonesByVPCRequest" type:"structure"`
+
+	// (Optional) The maximum number of hosted zones that you want Amazon Route
+	// 53 to return. If the specified VPC is associated with more than MaxItems
+	// hosted zones, the response includes a NextToken element. NextToken contains
+	// an encrypted token that identifies the first hosted zone that Route 53 will
+	// return if you submit another request.
+	MaxItems *string `location:"querystring" locationName:"maxitems" type:"string"`
+
+	// If the previous response included a NextToken element, the specified VPC
+	// is associated with more hosted zones. To get more hosted zones, submit another
+	// ListHostedZonesByVPC request.
+	//
+	// For the value of NextToken, specify the value of NextToken from the previous
+	// response.
+	//
+	// If the previous response didn't include a NextToken element, there are no
+	// more hosted zones to get.
+	NextToken *string `location:"querystring" locationName:"nexttoken" type:"string"`
+
+	// The ID of the Amazon VPC that you want to list hosted zones for.
+	//
+	// VPCId is a required field
+	VPCId *string `location:"querystring" locationName:"vpcid" type:"string" required:"true"`
+
+	// For the Amazon VPC that you specified for VPCId, the Amazon Web Services
+	// Region that you created the VPC in.
+	//
+	// VPCRegion is a required field
+	VPCRegion *string `location:"querystring" locationName:"vpcregion" min:"1" type:"string" required:"true" enum:"VPCRegion"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListHostedZonesByVPCInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListHostedZonesByVPCInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListHostedZonesByVPCInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListHostedZonesByVPCInput"}
+	if s.VPCId == nil {
+		invalidParams.Add(request.NewErrParamRequired("VPCId"))
+	}
+	if s.VPCRegion == nil {
+		invalidParams.Add(request.NewErrParamRequired("VPCRegion"))
+	}
+	if s.VPCRegion != nil && len(*s.VPCRegion) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VPCRegion", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetMaxItems sets the MaxItems field's value.
+func (s *ListHostedZonesByVPCInput) SetMaxItems(v string) *ListHostedZonesByVPCInput {
+	s.MaxItems = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListHostedZonesByVPCInput) SetNextToken(v string) *ListHostedZonesByVPCInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetVPCId sets the VPCId field's value.
+func (s *ListHostedZonesByVPCInput) SetVPCId(v string) *ListHostedZonesByVPCInput {
+	s.VPCId = &v
+	return s
+}
+
+// SetVPCRegion sets the VPCRegion field's value.
+func (s *ListHostedZonesByVPCInput) SetVPCRegion(v string) *ListHostedZonesByVPCInput {
+	s.VPCRegion = &v
+	return s
+}
+
+type ListHostedZonesByVPCOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A list that contains one HostedZoneSummary element for each hosted zone that
+	// the specified Amazon VPC is associated with. Each HostedZoneSummary element
+	// contains the hosted zone name and ID, and information about who owns the
+	// hosted zone.
+	//
+	// HostedZoneSummaries is a required field
+	HostedZoneSummaries []*HostedZoneSummary `locationNameList:"HostedZoneSummary" type:"list" required:"true"`
+
+	// The value that you specified for MaxItems in the most recent ListHostedZonesByVPC
+	// request.
+	//
+	// MaxItems is a required field
+	MaxItems *string `type:"string" required:"true"`
+
+	// The value that you will use for NextToken in the next ListHostedZonesByVPC
+	// request.
+	NextToken *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListHostedZonesByVPCOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetHostedZones sets the HostedZones field's value.
-func (s *ListHostedZonesByNameOutput) SetHostedZones(v []*HostedZone) *ListHostedZonesByNameOutput {
-	s.HostedZones = v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListHostedZonesByVPCOutput) GoString() string {
+	return s.String()
 }
 
-// SetIsTruncated sets the IsTruncated field's value.
-func (s *ListHostedZonesByNameOutput) SetIsTruncated(v bool) *ListHostedZonesByNameOutput {
-	s.IsTruncated = &v
+// SetHostedZoneSummaries sets the HostedZoneSummaries field's value.
+func (s *ListHostedZonesByVPCOutput) SetHostedZoneSummaries(v []*HostedZoneSummary) *ListHostedZonesByVPCOutput {
+	s.HostedZoneSummaries = v
 	return s
 }
 
 // SetMaxItems sets the MaxItems field's value.
-func (s *ListHostedZonesByNameOutput) SetMaxItems(v string) *ListHostedZonesByNameOutput {
+func (s *ListHostedZonesByVPCOutput) SetMaxItems(v string) *ListHostedZonesByVPCOutput {
 	s.MaxItems = &v
 	return s
 }
 
-// SetNextDNSName sets the NextDNSName field's value.
-func (s *ListHostedZonesByNameOutput) SetNextDNSName(v string) *ListHostedZonesByNameOutput {
-	s.NextDNSName = &v
-	return s
-}
-
-// SetNextHostedZoneId sets the NextHostedZoneId field's value.
-func (s *ListHostedZonesByNameOutput) SetNextHostedZoneId(v string) *ListHostedZonesByNameOutput {
-	s.NextHostedZoneId = &v
+// SetNextToken sets the NextToken field's value.
+func (s *ListHostedZonesByVPCOutput) SetNextToken(v string) *ListHostedZonesByVPCOutput {
+	s.NextToken = &v
 	return s
 }
 
 // A request to retrieve a list of the public and private hosted zones that
-// are associated with the current AWS account.
+// are associated with the current Amazon Web Services account.
 type ListHostedZonesInput struct {
 	_ struct{} `locationName:"ListHostedZonesRequest" type:"structure"`
 
@@ -11161,6 +16061,9 @@ type ListHostedZonesInput struct {
 	// the ID of that reusable delegation set.
 	DelegationSetId *string `location:"querystring" locationName:"delegationsetid" type:"string"`
 
+	// (Optional) Specifies if the hosted zone is private.
+	HostedZoneType *string `location:"querystring" locationName:"hostedzonetype" type:"string" enum:"HostedZoneType"`
+
 	// If the value of IsTruncated in the previous response was true, you have more
 	// hosted zones. To get more hosted zones, submit another ListHostedZones request.
 	//
@@ -11180,12 +16083,20 @@ type ListHostedZonesInput struct {
 	MaxItems *string `location:"querystring" locationName:"maxitems" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListHostedZonesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListHostedZonesInput) GoString() string {
 	return s.String()
 }
@@ -11196,6 +16107,12 @@ func (s *ListHostedZonesInput) SetDelegationSetId(v string) *ListHostedZonesInpu
 	return s
 }
 
+// SetHostedZoneType sets the HostedZoneType field's value.
+func (s *ListHostedZonesInput) SetHostedZoneType(v string) *ListHostedZonesInput {
+	s.HostedZoneType = &v
+	return s
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListHostedZonesInput) SetMarker(v string) *ListHostedZonesInput {
 	s.Marker = &v
@@ -11245,12 +16162,20 @@ type ListHostedZonesOutput struct {
 	NextMarker *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListHostedZonesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListHostedZonesOutput) GoString() string {
 	return s.String()
 }
@@ -11292,20 +16217,22 @@ type ListQueryLoggingConfigsInput struct {
 	// with a hosted zone, specify the ID in HostedZoneId.
 	//
 	// If you don't specify a hosted zone ID, ListQueryLoggingConfigs returns all
-	// of the configurations that are associated with the current AWS account.
+	// of the configurations that are associated with the current Amazon Web Services
+	// account.
 	HostedZoneId *string `location:"querystring" locationName:"hostedzoneid" type:"string"`
 
 	// (Optional) The maximum number of query logging configurations that you want
 	// Amazon Route 53 to return in response to the current request. If the current
-	// AWS account has more than MaxResults configurations, use the value of NextToken
-	// (https://docs.aws.amazon.com/Route53/latest/APIReference/API_ListQueryLoggingConfigs.html#API_ListQueryLoggingConfigs_RequestSyntax)
+	// Amazon Web Services account has more than MaxResults configurations, use
+	// the value of NextToken (https://docs.aws.amazon.com/Route53/latest/APIReference/API_ListQueryLoggingConfigs.html#API_ListQueryLoggingConfigs_RequestSyntax)
 	// in the response to get the next page of results.
 	//
 	// If you don't specify a value for MaxResults, Route 53 returns up to 100 configurations.
 	MaxResults *string `location:"querystring" locationName:"maxresults" type:"string"`
 
-	// (Optional) If the current AWS account has more than MaxResults query logging
-	// configurations, use NextToken to get the second and subsequent pages of results.
+	// (Optional) If the current Amazon Web Services account has more than MaxResults
+	// query logging configurations, use NextToken to get the second and subsequent
+	// pages of results.
 	//
 	// For the first ListQueryLoggingConfigs request, omit this value.
 	//
@@ -11314,12 +16241,20 @@ type ListQueryLoggingConfigsInput struct {
 	NextToken *string `location:"querystring" locationName:"nexttoken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListQueryLoggingConfigsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListQueryLoggingConfigsInput) GoString() string {
 	return s.String()
 }
@@ -11346,8 +16281,8 @@ type ListQueryLoggingConfigsOutput struct {
 	_ struct{} `type:"structure"`
 
 	// If a response includes the last of the query logging configurations that
-	// are associated with the current AWS account, NextToken doesn't appear in
-	// the response.
+	// are associated with the current Amazon Web Services account, NextToken doesn't
+	// appear in the response.
 	//
 	// If a response doesn't include the last of the configurations, you can get
 	// more configurations by submitting another ListQueryLoggingConfigs (https://docs.aws.amazon.com/Route53/latest/APIReference/API_ListQueryLoggingConfigs.html)
@@ -11357,18 +16292,26 @@ type ListQueryLoggingConfigsOutput struct {
 
 	// An array that contains one QueryLoggingConfig (https://docs.aws.amazon.com/Route53/latest/APIReference/API_QueryLoggingConfig.html)
 	// element for each configuration for DNS query logging that is associated with
-	// the current AWS account.
+	// the current Amazon Web Services account.
 	//
 	// QueryLoggingConfigs is a required field
 	QueryLoggingConfigs []*QueryLoggingConfig `locationNameList:"QueryLoggingConfig" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListQueryLoggingConfigsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListQueryLoggingConfigsOutput) GoString() string {
 	return s.String()
 }
@@ -11411,7 +16354,9 @@ type ListResourceRecordSetsInput struct {
 	StartRecordIdentifier *string `location:"querystring" locationName:"identifier" min:"1" type:"string"`
 
 	// The first name in the lexicographic ordering of resource record sets that
-	// you want to list.
+	// you want to list. If the specified record name doesn't exist, the results
+	// begin with the first resource record set that has a name greater than the
+	// value of name.
 	StartRecordName *string `location:"querystring" locationName:"name" type:"string"`
 
 	// The type of resource record set to begin the record listing from.
@@ -11432,9 +16377,9 @@ type ListResourceRecordSetsInput struct {
 	//
 	//    * Elastic Load Balancing load balancer: A | AAAA
 	//
-	//    * Amazon S3 bucket: A
+	//    * S3 bucket: A
 	//
-	//    * Amazon VPC interface VPC endpoint: A
+	//    * VPC interface VPC endpoint: A
 	//
 	//    * Another resource record set in this hosted zone: The type of the resource
 	//    record set that the alias references.
@@ -11444,12 +16389,20 @@ type ListResourceRecordSetsInput struct {
 	StartRecordType *string `location:"querystring" locationName:"type" type:"string" enum:"RRType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListResourceRecordSetsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListResourceRecordSetsInput) GoString() string {
 	return s.String()
 }
@@ -11543,12 +16496,20 @@ type ListResourceRecordSetsOutput struct {
 	ResourceRecordSets []*ResourceRecordSet `locationNameList:"ResourceRecordSet" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListResourceRecordSetsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListResourceRecordSetsOutput) GoString() string {
 	return s.String()
 }
@@ -11590,7 +16551,7 @@ func (s *ListResourceRecordSetsOutput) SetResourceRecordSets(v []*ResourceRecord
 }
 
 // A request to get a list of the reusable delegation sets that are associated
-// with the current AWS account.
+// with the current Amazon Web Services account.
 type ListReusableDelegationSetsInput struct {
 	_ struct{} `locationName:"ListReusableDelegationSetsRequest" type:"structure"`
 
@@ -11612,12 +16573,20 @@ type ListReusableDelegationSetsInput struct {
 	MaxItems *string `location:"querystring" locationName:"maxitems" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListReusableDelegationSetsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListReusableDelegationSetsInput) GoString() string {
 	return s.String()
 }
@@ -11635,12 +16604,12 @@ func (s *ListReusableDelegationSetsInput) SetMaxItems(v string) *ListReusableDel
 }
 
 // A complex type that contains information about the reusable delegation sets
-// that are associated with the current AWS account.
+// that are associated with the current Amazon Web Services account.
 type ListReusableDelegationSetsOutput struct {
 	_ struct{} `type:"structure"`
 
 	// A complex type that contains one DelegationSet element for each reusable
-	// delegation set that was created by the current AWS account.
+	// delegation set that was created by the current Amazon Web Services account.
 	//
 	// DelegationSets is a required field
 	DelegationSets []*DelegationSet `locationNameList:"DelegationSet" type:"list" required:"true"`
@@ -11670,12 +16639,20 @@ type ListReusableDelegationSetsOutput struct {
 	NextMarker *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListReusableDelegationSetsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListReusableDelegationSetsOutput) GoString() string {
 	return s.String()
 }
@@ -11730,12 +16707,20 @@ type ListTagsForResourceInput struct {
 	ResourceType *string `location:"uri" locationName:"ResourceType" type:"string" required:"true" enum:"TagResourceType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceInput) GoString() string {
 	return s.String()
 }
@@ -11785,12 +16770,20 @@ type ListTagsForResourceOutput struct {
 	ResourceTagSet *ResourceTagSet `type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceOutput) GoString() string {
 	return s.String()
 }
@@ -11822,12 +16815,20 @@ type ListTagsForResourcesInput struct {
 	ResourceType *string `location:"uri" locationName:"ResourceType" type:"string" required:"true" enum:"TagResourceType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourcesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourcesInput) GoString() string {
 	return s.String()
 }
@@ -11876,12 +16877,20 @@ type ListTagsForResourcesOutput struct {
 	ResourceTagSets []*ResourceTagSet `locationNameList:"ResourceTagSet" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourcesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourcesOutput) GoString() string {
 	return s.String()
 }
@@ -11893,7 +16902,8 @@ func (s *ListTagsForResourcesOutput) SetResourceTagSets(v []*ResourceTagSet) *Li
 }
 
 // A complex type that contains the information about the request to list the
-// traffic policies that are associated with the current AWS account.
+// traffic policies that are associated with the current Amazon Web Services
+// account.
 type ListTrafficPoliciesInput struct {
 	_ struct{} `locationName:"ListTrafficPoliciesRequest" type:"structure"`
 
@@ -11915,12 +16925,20 @@ type ListTrafficPoliciesInput struct {
 	TrafficPolicyIdMarker *string `location:"querystring" locationName:"trafficpolicyid" min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTrafficPoliciesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTrafficPoliciesInput) GoString() string {
 	return s.String()
 }
@@ -11975,18 +16993,26 @@ type ListTrafficPoliciesOutput struct {
 	TrafficPolicyIdMarker *string `min:"1" type:"string" required:"true"`
 
 	// A list that contains one TrafficPolicySummary element for each traffic policy
-	// that was created by the current AWS account.
+	// that was created by the current Amazon Web Services account.
 	//
 	// TrafficPolicySummaries is a required field
 	TrafficPolicySummaries []*TrafficPolicySummary `locationNameList:"TrafficPolicySummary" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTrafficPoliciesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTrafficPoliciesOutput) GoString() string {
 	return s.String()
 }
@@ -12057,12 +17083,20 @@ type ListTrafficPolicyInstancesByHostedZoneInput struct {
 	TrafficPolicyInstanceTypeMarker *string `location:"querystring" locationName:"trafficpolicyinstancetype" type:"string" enum:"RRType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTrafficPolicyInstancesByHostedZoneInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTrafficPolicyInstancesByHostedZoneInput) GoString() string {
 	return s.String()
 }
@@ -12139,12 +17173,20 @@ type ListTrafficPolicyInstancesByHostedZoneOutput struct {
 	TrafficPolicyInstances []*TrafficPolicyInstance `locationNameList:"TrafficPolicyInstance" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTrafficPolicyInstancesByHostedZoneOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTrafficPolicyInstancesByHostedZoneOutput) GoString() string {
 	return s.String()
 }
@@ -12241,12 +17283,20 @@ type ListTrafficPolicyInstancesByPolicyInput struct {
 	TrafficPolicyVersion *int64 `location:"querystring" locationName:"version" min:"1" type:"integer" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTrafficPolicyInstancesByPolicyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTrafficPolicyInstancesByPolicyInput) GoString() string {
 	return s.String()
 }
@@ -12350,12 +17400,20 @@ type ListTrafficPolicyInstancesByPolicyOutput struct {
 	TrafficPolicyInstances []*TrafficPolicyInstance `locationNameList:"TrafficPolicyInstance" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTrafficPolicyInstancesByPolicyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTrafficPolicyInstancesByPolicyOutput) GoString() string {
 	return s.String()
 }
@@ -12397,7 +17455,7 @@ func (s *ListTrafficPolicyInstancesByPolicyOutput) SetTrafficPolicyInstances(v [
 }
 
 // A request to get information about the traffic policy instances that you
-// created by using the current AWS account.
+// created by using the current Amazon Web Services account.
 type ListTrafficPolicyInstancesInput struct {
 	_ struct{} `locationName:"ListTrafficPolicyInstancesRequest" type:"structure"`
 
@@ -12443,12 +17501,20 @@ type ListTrafficPolicyInstancesInput struct {
 	TrafficPolicyInstanceTypeMarker *string `location:"querystring" locationName:"trafficpolicyinstancetype" type:"string" enum:"RRType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTrafficPolicyInstancesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTrafficPolicyInstancesInput) GoString() string {
 	return s.String()
 }
@@ -12519,12 +17585,20 @@ type ListTrafficPolicyInstancesOutput struct {
 	TrafficPolicyInstances []*TrafficPolicyInstance `locationNameList:"TrafficPolicyInstance" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTrafficPolicyInstancesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTrafficPolicyInstancesOutput) GoString() string {
 	return s.String()
 }
@@ -12594,12 +17668,20 @@ type ListTrafficPolicyVersionsInput struct {
 	TrafficPolicyVersionMarker *string `location:"querystring" locationName:"trafficpolicyversion" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTrafficPolicyVersionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTrafficPolicyVersionsInput) GoString() string {
 	return s.String()
 }
@@ -12673,12 +17755,20 @@ type ListTrafficPolicyVersionsOutput struct {
 	TrafficPolicyVersionMarker *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTrafficPolicyVersionsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTrafficPolicyVersionsOutput) GoString() string {
 	return s.String()
 }
@@ -12731,12 +17821,20 @@ type ListVPCAssociationAuthorizationsInput struct {
 	NextToken *string `location:"querystring" locationName:"nexttoken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListVPCAssociationAuthorizationsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListVPCAssociationAuthorizationsInput) GoString() string {
 	return s.String()
 }
@@ -12798,12 +17896,20 @@ type ListVPCAssociationAuthorizationsOutput struct {
 	VPCs []*VPC `locationNameList:"VPC" min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListVPCAssociationAuthorizationsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListVPCAssociationAuthorizationsOutput) GoString() string {
 	return s.String()
 }
@@ -12826,6 +17932,38 @@ func (s *ListVPCAssociationAuthorizationsOutput) SetVPCs(v []*VPC) *ListVPCAssoc
 	return s
 }
 
+// A complex type that contains information about the CIDR location.
+type LocationSummary struct {
+	_ struct{} `type:"structure"`
+
+	// A string that specifies a location name.
+	LocationName *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LocationSummary) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LocationSummary) GoString() string {
+	return s.String()
+}
+
+// SetLocationName sets the LocationName field's value.
+func (s *LocationSummary) SetLocationName(v string) *LocationSummary {
+	s.LocationName = &v
+	return s
+}
+
 // A complex type that contains information about a configuration for DNS query
 // logging.
 type QueryLoggingConfig struct {
@@ -12848,12 +17986,20 @@ type QueryLoggingConfig struct {
 	Id *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s QueryLoggingConfig) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s QueryLoggingConfig) GoString() string {
 	return s.String()
 }
@@ -12897,12 +18043,20 @@ type ResourceRecord struct {
 	Value *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResourceRecord) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResourceRecord) GoString() string {
 	return s.String()
 }
@@ -12930,9 +18084,9 @@ func (s *ResourceRecord) SetValue(v string) *ResourceRecord {
 type ResourceRecordSet struct {
 	_ struct{} `type:"structure"`
 
-	// Alias resource record sets only: Information about the AWS resource, such
-	// as a CloudFront distribution or an Amazon S3 bucket, that you want to route
-	// traffic to.
+	// Alias resource record sets only: Information about the Amazon Web Services
+	// resource, such as a CloudFront distribution or an Amazon S3 bucket, that
+	// you want to route traffic to.
 	//
 	// If you're creating resource records sets for a private hosted zone, note
 	// the following:
@@ -12940,14 +18094,18 @@ type ResourceRecordSet struct {
 	//    * You can't create an alias resource record set in a private hosted zone
 	//    to route traffic to a CloudFront distribution.
 	//
-	//    * Creating geolocation alias resource record sets or latency alias resource
-	//    record sets in a private hosted zone is unsupported.
-	//
 	//    * For information about creating failover resource record sets in a private
-	//    hosted zone, see Configuring Failover in a Private Hosted Zone (http://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover-private-hosted-zones.html)
+	//    hosted zone, see Configuring Failover in a Private Hosted Zone (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover-private-hosted-zones.html)
 	//    in the Amazon Route 53 Developer Guide.
 	AliasTarget *AliasTarget `type:"structure"`
 
+	// The object that is specified in resource record set object when you are linking
+	// a resource record set to a CIDR location.
+	//
+	// A LocationName with an asterisk “*” can be used to create a default CIDR
+	// record. CollectionId is still required for default record.
+	CidrRoutingConfig *CidrRoutingConfig `type:"structure"`
+
 	// Failover resource record sets only: To configure failover, you add the Failover
 	// element to two resource record sets. For one resource record set, you specify
 	// PRIMARY as the value for Failover; for the other resource record set, you
@@ -12984,9 +18142,9 @@ type ResourceRecordSet struct {
 	// For more information about configuring failover for Route 53, see the following
 	// topics in the Amazon Route 53 Developer Guide:
 	//
-	//    * Route 53 Health Checks and DNS Failover (http://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover.html)
+	//    * Route 53 Health Checks and DNS Failover (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover.html)
 	//
-	//    * Configuring Failover in a Private Hosted Zone (http://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover-private-hosted-zones.html)
+	//    * Configuring Failover in a Private Hosted Zone (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover-private-hosted-zones.html)
 	Failover *string `type:"string" enum:"ResourceRecordSetFailover"`
 
 	// Geolocation resource record sets only: A complex type that lets you control
@@ -12995,8 +18153,8 @@ type ResourceRecordSet struct {
 	// to a web server with an IP address of 192.0.2.111, create a resource record
 	// set with a Type of A and a ContinentCode of AF.
 	//
-	// Creating geolocation and geolocation alias resource record sets in private
-	// hosted zones is not supported.
+	// Although creating geolocation and geolocation alias resource record sets
+	// in a private hosted zone is allowed, it's not supported.
 	//
 	// If you create separate resource record sets for overlapping geographic regions
 	// (for example, one resource record set for a continent and one for a country
@@ -13015,11 +18173,12 @@ type ResourceRecordSet struct {
 	// addresses aren't mapped to geographic locations, so even if you create geolocation
 	// resource record sets that cover all seven continents, Route 53 will receive
 	// some DNS queries from locations that it can't identify. We recommend that
-	// you create a resource record set for which the value of CountryCode is *,
-	// which handles both queries that come from locations for which you haven't
-	// created geolocation resource record sets and queries from IP addresses that
-	// aren't mapped to a location. If you don't create a * resource record set,
-	// Route 53 returns a "no answer" response for queries from those locations.
+	// you create a resource record set for which the value of CountryCode is *.
+	// Two groups of queries are routed to the resource that you specify in this
+	// record: queries that come from locations for which you haven't created geolocation
+	// resource record sets and queries from IP addresses that aren't mapped to
+	// a location. If you don't create a * resource record set, Route 53 returns
+	// a "no answer" response for queries from those locations.
 	//
 	// You can't create non-geolocation resource record sets that have the same
 	// values for the Name and Type elements as geolocation resource record sets.
@@ -13052,9 +18211,9 @@ type ResourceRecordSet struct {
 	//
 	//    * How Amazon Route 53 Determines Whether an Endpoint Is Healthy (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover-determining-health-of-endpoints.html)
 	//
-	//    * Route 53 Health Checks and DNS Failover (http://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover.html)
+	//    * Route 53 Health Checks and DNS Failover (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover.html)
 	//
-	//    * Configuring Failover in a Private Hosted Zone (http://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover-private-hosted-zones.html)
+	//    * Configuring Failover in a Private Hosted Zone (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover-private-hosted-zones.html)
 	//
 	// When to Specify HealthCheckId
 	//
@@ -13167,7 +18326,7 @@ type ResourceRecordSet struct {
 	//
 	// For information about how to specify characters other than a-z, 0-9, and
 	// - (hyphen) and how to specify internationalized domain names, see DNS Domain
-	// Name Format (http://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DomainNameFormat.html)
+	// Name Format (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DomainNameFormat.html)
 	// in the Amazon Route 53 Developer Guide.
 	//
 	// You can use the asterisk (*) wildcard to replace the leftmost label in a
@@ -13193,12 +18352,9 @@ type ResourceRecordSet struct {
 
 	// Latency-based resource record sets only: The Amazon EC2 Region where you
 	// created the resource that this resource record set refers to. The resource
-	// typically is an AWS resource, such as an EC2 instance or an ELB load balancer,
-	// and is referred to by an IP address or a DNS domain name, depending on the
-	// record type.
-	//
-	// Creating latency and latency alias resource record sets in private hosted
-	// zones is not supported.
+	// typically is an Amazon Web Services resource, such as an EC2 instance or
+	// an ELB load balancer, and is referred to by an IP address or a DNS domain
+	// name, depending on the record type.
 	//
 	// When Amazon Route 53 receives a DNS query for a domain name and type for
 	// which you have created latency resource record sets, Route 53 selects the
@@ -13272,11 +18428,11 @@ type ResourceRecordSet struct {
 	TrafficPolicyInstanceId *string `min:"1" type:"string"`
 
 	// The DNS record type. For information about different record types and how
-	// data is encoded for them, see Supported DNS Resource Record Types (http://docs.aws.amazon.com/Route53/latest/DeveloperGuide/ResourceRecordTypes.html)
+	// data is encoded for them, see Supported DNS Resource Record Types (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/ResourceRecordTypes.html)
 	// in the Amazon Route 53 Developer Guide.
 	//
-	// Valid values for basic resource record sets: A | AAAA | CAA | CNAME | MX
-	// | NAPTR | NS | PTR | SOA | SPF | SRV | TXT
+	// Valid values for basic resource record sets: A | AAAA | CAA | CNAME | DS
+	// |MX | NAPTR | NS | PTR | SOA | SPF | SRV | TXT
 	//
 	// Values for weighted, latency, geolocation, and failover resource record sets:
 	// A | AAAA | CAA | CNAME | MX | NAPTR | PTR | SPF | SRV | TXT. When creating
@@ -13303,8 +18459,7 @@ type ResourceRecordSet struct {
 	//    create two resource record sets to route traffic to your distribution,
 	//    one with a value of A and one with a value of AAAA.
 	//
-	//    * AWS Elastic Beanstalk environment that has a regionalized subdomain:
-	//    A
+	//    * Amazon API Gateway environment that has a regionalized subdomain: A
 	//
 	//    * ELB load balancers: A | AAAA
 	//
@@ -13352,17 +18507,25 @@ type ResourceRecordSet struct {
 	//    of DNS name and type, traffic is routed to all resources with equal probability.
 	//    The effect of setting Weight to 0 is different when you associate health
 	//    checks with weighted resource record sets. For more information, see Options
-	//    for Configuring Route 53 Active-Active and Active-Passive Failover (http://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover-configuring-options.html)
+	//    for Configuring Route 53 Active-Active and Active-Passive Failover (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover-configuring-options.html)
 	//    in the Amazon Route 53 Developer Guide.
 	Weight *int64 `type:"long"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResourceRecordSet) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResourceRecordSet) GoString() string {
 	return s.String()
 }
@@ -13393,6 +18556,11 @@ func (s *ResourceRecordSet) Validate() error {
 			invalidParams.AddNested("AliasTarget", err.(request.ErrInvalidParams))
 		}
 	}
+	if s.CidrRoutingConfig != nil {
+		if err := s.CidrRoutingConfig.Validate(); err != nil {
+			invalidParams.AddNested("CidrRoutingConfig", err.(request.ErrInvalidParams))
+		}
+	}
 	if s.GeoLocation != nil {
 		if err := s.GeoLocation.Validate(); err != nil {
 			invalidParams.AddNested("GeoLocation", err.(request.ErrInvalidParams))
@@ -13421,6 +18589,12 @@ func (s *ResourceRecordSet) SetAliasTarget(v *AliasTarget) *ResourceRecordSet {
 	return s
 }
 
+// SetCidrRoutingConfig sets the CidrRoutingConfig field's value.
+func (s *ResourceRecordSet) SetCidrRoutingConfig(v *CidrRoutingConfig) *ResourceRecordSet {
+	s.CidrRoutingConfig = v
+	return s
+}
+
 // SetFailover sets the Failover field's value.
 func (s *ResourceRecordSet) SetFailover(v string) *ResourceRecordSet {
 	s.Failover = &v
@@ -13511,12 +18685,20 @@ type ResourceTagSet struct {
 	Tags []*Tag `locationNameList:"Tag" min:"1" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResourceTagSet) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResourceTagSet) GoString() string {
 	return s.String()
 }
@@ -13557,12 +18739,20 @@ type ReusableDelegationSetLimit struct {
 	Value *int64 `min:"1" type:"long" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReusableDelegationSetLimit) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReusableDelegationSetLimit) GoString() string {
 	return s.String()
 }
@@ -13595,12 +18785,20 @@ type StatusReport struct {
 	Status *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StatusReport) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StatusReport) GoString() string {
 	return s.String()
 }
@@ -13646,12 +18844,20 @@ type Tag struct {
 	Value *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) GoString() string {
 	return s.String()
 }
@@ -13713,17 +18919,25 @@ type TestDNSAnswerInput struct {
 
 	// If you want to simulate a request from a specific DNS resolver, specify the
 	// IP address for that resolver. If you omit this value, TestDnsAnswer uses
-	// the IP address of a DNS resolver in the AWS US East (N. Virginia) Region
-	// (us-east-1).
+	// the IP address of a DNS resolver in the Amazon Web Services US East (N. Virginia)
+	// Region (us-east-1).
 	ResolverIP *string `location:"querystring" locationName:"resolverip" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TestDNSAnswerInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TestDNSAnswerInput) GoString() string {
 	return s.String()
 }
@@ -13824,12 +19038,20 @@ type TestDNSAnswerOutput struct {
 	ResponseCode *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TestDNSAnswerOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TestDNSAnswerOutput) GoString() string {
 	return s.String()
 }
@@ -13908,12 +19130,20 @@ type TrafficPolicy struct {
 	Version *int64 `min:"1" type:"integer" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TrafficPolicy) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TrafficPolicy) GoString() string {
 	return s.String()
 }
@@ -14028,12 +19258,20 @@ type TrafficPolicyInstance struct {
 	TrafficPolicyVersion *int64 `min:"1" type:"integer" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TrafficPolicyInstance) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TrafficPolicyInstance) GoString() string {
 	return s.String()
 }
@@ -14093,7 +19331,7 @@ func (s *TrafficPolicyInstance) SetTrafficPolicyVersion(v int64) *TrafficPolicyI
 }
 
 // A complex type that contains information about the latest version of one
-// traffic policy that is associated with the current AWS account.
+// traffic policy that is associated with the current Amazon Web Services account.
 type TrafficPolicySummary struct {
 	_ struct{} `type:"structure"`
 
@@ -14113,7 +19351,8 @@ type TrafficPolicySummary struct {
 	// Name is a required field
 	Name *string `type:"string" required:"true"`
 
-	// The number of traffic policies that are associated with the current AWS account.
+	// The number of traffic policies that are associated with the current Amazon
+	// Web Services account.
 	//
 	// TrafficPolicyCount is a required field
 	TrafficPolicyCount *int64 `min:"1" type:"integer" required:"true"`
@@ -14125,12 +19364,20 @@ type TrafficPolicySummary struct {
 	Type *string `type:"string" required:"true" enum:"RRType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TrafficPolicySummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TrafficPolicySummary) GoString() string {
 	return s.String()
 }
@@ -14225,7 +19472,7 @@ type UpdateHealthCheckInput struct {
 	// The number of consecutive health checks that an endpoint must pass or fail
 	// for Amazon Route 53 to change the current status of the endpoint from unhealthy
 	// to healthy or vice versa. For more information, see How Amazon Route 53 Determines
-	// Whether an Endpoint Is Healthy (http://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover-determining-health-of-endpoints.html)
+	// Whether an Endpoint Is Healthy (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover-determining-health-of-endpoints.html)
 	// in the Amazon Route 53 Developer Guide.
 	//
 	// If you don't specify a value for FailureThreshold, the default value is three
@@ -14385,10 +19632,10 @@ type UpdateHealthCheckInput struct {
 	//
 	//    * Unhealthy: Route 53 considers the health check to be unhealthy.
 	//
-	//    * LastKnownStatus: Route 53 uses the status of the health check from the
-	//    last time CloudWatch had sufficient data to determine the alarm state.
-	//    For new health checks that have no last known status, the default status
-	//    for the health check is healthy.
+	//    * LastKnownStatus: By default, Route 53 uses the status of the health
+	//    check from the last time CloudWatch had sufficient data to determine the
+	//    alarm state. For new health checks that have no last known status, the
+	//    status for the health check is healthy.
 	InsufficientDataHealthStatus *string `type:"string" enum:"InsufficientDataHealthStatus"`
 
 	// Specify whether you want Amazon Route 53 to invert the status of a health
@@ -14396,13 +19643,16 @@ type UpdateHealthCheckInput struct {
 	// would be considered healthy.
 	Inverted *bool `type:"boolean"`
 
-	// The port on the endpoint on which you want Amazon Route 53 to perform health
-	// checks.
+	// The port on the endpoint that you want Amazon Route 53 to perform health
+	// checks on.
+	//
+	// Don't specify a value for Port when you specify a value for Type of CLOUDWATCH_METRIC
+	// or CALCULATED.
 	Port *int64 `min:"1" type:"integer"`
 
 	// A complex type that contains one Region element for each region that you
 	// want Amazon Route 53 health checkers to check the specified endpoint from.
-	Regions []*string `locationNameList:"Region" min:"3" type:"list"`
+	Regions []*string `locationNameList:"Region" min:"3" type:"list" enum:"HealthCheckRegion"`
 
 	// A complex type that contains one ResettableElementName element for each element
 	// that you want to reset to the default value. Valid values for ResettableElementName
@@ -14419,7 +19669,7 @@ type UpdateHealthCheckInput struct {
 	//
 	//    * ResourcePath: Route 53 resets ResourcePath (https://docs.aws.amazon.com/Route53/latest/APIReference/API_HealthCheckConfig.html#Route53-Type-HealthCheckConfig-ResourcePath)
 	//    to null.
-	ResetElements []*string `locationNameList:"ResettableElementName" type:"list"`
+	ResetElements []*string `locationNameList:"ResettableElementName" type:"list" enum:"ResettableElementName"`
 
 	// The path that you want Amazon Route 53 to request when performing health
 	// checks. The path can be any value for which your endpoint will return an
@@ -14430,7 +19680,7 @@ type UpdateHealthCheckInput struct {
 	// Specify this value only if you want to change it.
 	ResourcePath *string `type:"string"`
 
-	// If the value of Type is HTTP_STR_MATCH or HTTP_STR_MATCH, the string that
+	// If the value of Type is HTTP_STR_MATCH or HTTPS_STR_MATCH, the string that
 	// you want Amazon Route 53 to search for in the response body from the specified
 	// resource. If the string appears in the response body, Route 53 considers
 	// the resource healthy. (You can't change the value of Type when you update
@@ -14438,12 +19688,20 @@ type UpdateHealthCheckInput struct {
 	SearchString *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateHealthCheckInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateHealthCheckInput) GoString() string {
 	return s.String()
 }
@@ -14593,12 +19851,20 @@ type UpdateHealthCheckOutput struct {
 	HealthCheck *HealthCheck `type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateHealthCheckOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateHealthCheckOutput) GoString() string {
 	return s.String()
 }
@@ -14623,12 +19889,20 @@ type UpdateHostedZoneCommentInput struct {
 	Id *string `location:"uri" locationName:"Id" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateHostedZoneCommentInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateHostedZoneCommentInput) GoString() string {
 	return s.String()
 }
@@ -14673,12 +19947,20 @@ type UpdateHostedZoneCommentOutput struct {
 	HostedZone *HostedZone `type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateHostedZoneCommentOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateHostedZoneCommentOutput) GoString() string {
 	return s.String()
 }
@@ -14712,12 +19994,20 @@ type UpdateTrafficPolicyCommentInput struct {
 	Version *int64 `location:"uri" locationName:"Version" min:"1" type:"integer" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateTrafficPolicyCommentInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateTrafficPolicyCommentInput) GoString() string {
 	return s.String()
 }
@@ -14775,12 +20065,20 @@ type UpdateTrafficPolicyCommentOutput struct {
 	TrafficPolicy *TrafficPolicy `type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateTrafficPolicyCommentOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateTrafficPolicyCommentOutput) GoString() string {
 	return s.String()
 }
@@ -14820,12 +20118,20 @@ type UpdateTrafficPolicyInstanceInput struct {
 	TrafficPolicyVersion *int64 `min:"1" type:"integer" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateTrafficPolicyInstanceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateTrafficPolicyInstanceInput) GoString() string {
 	return s.String()
 }
@@ -14896,12 +20202,20 @@ type UpdateTrafficPolicyInstanceOutput struct {
 	TrafficPolicyInstance *TrafficPolicyInstance `type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateTrafficPolicyInstanceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateTrafficPolicyInstanceOutput) GoString() string {
 	return s.String()
 }
@@ -14914,6 +20228,10 @@ func (s *UpdateTrafficPolicyInstanceOutput) SetTrafficPolicyInstance(v *TrafficP
 
 // (Private hosted zones only) A complex type that contains information about
 // an Amazon VPC.
+//
+// If you associate a private hosted zone with an Amazon VPC when you make a
+// CreateHostedZone (https://docs.aws.amazon.com/Route53/latest/APIReference/API_CreateHostedZone.html)
+// request, the following parameters are also required.
 type VPC struct {
 	_ struct{} `type:"structure"`
 
@@ -14924,12 +20242,20 @@ type VPC struct {
 	VPCRegion *string `min:"1" type:"string" enum:"VPCRegion"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VPC) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VPC) GoString() string {
 	return s.String()
 }
@@ -14976,6 +20302,17 @@ const (
 	AccountLimitTypeMaxTrafficPoliciesByOwner = "MAX_TRAFFIC_POLICIES_BY_OWNER"
 )
 
+// AccountLimitType_Values returns all elements of the AccountLimitType enum
+func AccountLimitType_Values() []string {
+	return []string{
+		AccountLimitTypeMaxHealthChecksByOwner,
+		AccountLimitTypeMaxHostedZonesByOwner,
+		AccountLimitTypeMaxTrafficPolicyInstancesByOwner,
+		AccountLimitTypeMaxReusableDelegationSetsByOwner,
+		AccountLimitTypeMaxTrafficPoliciesByOwner,
+	}
+}
+
 const (
 	// ChangeActionCreate is a ChangeAction enum value
 	ChangeActionCreate = "CREATE"
@@ -14987,6 +20324,15 @@ const (
 	ChangeActionUpsert = "UPSERT"
 )
 
+// ChangeAction_Values returns all elements of the ChangeAction enum
+func ChangeAction_Values() []string {
+	return []string{
+		ChangeActionCreate,
+		ChangeActionDelete,
+		ChangeActionUpsert,
+	}
+}
+
 const (
 	// ChangeStatusPending is a ChangeStatus enum value
 	ChangeStatusPending = "PENDING"
@@ -14995,6 +20341,30 @@ const (
 	ChangeStatusInsync = "INSYNC"
 )
 
+// ChangeStatus_Values returns all elements of the ChangeStatus enum
+func ChangeStatus_Values() []string {
+	return []string{
+		ChangeStatusPending,
+		ChangeStatusInsync,
+	}
+}
+
+const (
+	// CidrCollectionChangeActionPut is a CidrCollectionChangeAction enum value
+	CidrCollectionChangeActionPut = "PUT"
+
+	// CidrCollectionChangeActionDeleteIfExists is a CidrCollectionChangeAction enum value
+	CidrCollectionChangeActionDeleteIfExists = "DELETE_IF_EXISTS"
+)
+
+// CidrCollectionChangeAction_Values returns all elements of the CidrCollectionChangeAction enum
+func CidrCollectionChangeAction_Values() []string {
+	return []string{
+		CidrCollectionChangeActionPut,
+		CidrCollectionChangeActionDeleteIfExists,
+	}
+}
+
 const (
 	// CloudWatchRegionUsEast1 is a CloudWatchRegion enum value
 	CloudWatchRegionUsEast1 = "us-east-1"
@@ -15014,6 +20384,9 @@ const (
 	// CloudWatchRegionEuCentral1 is a CloudWatchRegion enum value
 	CloudWatchRegionEuCentral1 = "eu-central-1"
 
+	// CloudWatchRegionEuCentral2 is a CloudWatchRegion enum value
+	CloudWatchRegionEuCentral2 = "eu-central-2"
+
 	// CloudWatchRegionEuWest1 is a CloudWatchRegion enum value
 	CloudWatchRegionEuWest1 = "eu-west-1"
 
@@ -15029,15 +20402,24 @@ const (
 	// CloudWatchRegionMeSouth1 is a CloudWatchRegion enum value
 	CloudWatchRegionMeSouth1 = "me-south-1"
 
+	// CloudWatchRegionMeCentral1 is a CloudWatchRegion enum value
+	CloudWatchRegionMeCentral1 = "me-central-1"
+
 	// CloudWatchRegionApSouth1 is a CloudWatchRegion enum value
 	CloudWatchRegionApSouth1 = "ap-south-1"
 
+	// CloudWatchRegionApSouth2 is a CloudWatchRegion enum value
+	CloudWatchRegionApSouth2 = "ap-south-2"
+
 	// CloudWatchRegionApSoutheast1 is a CloudWatchRegion enum value
 	CloudWatchRegionApSoutheast1 = "ap-southeast-1"
 
 	// CloudWatchRegionApSoutheast2 is a CloudWatchRegion enum value
 	CloudWatchRegionApSoutheast2 = "ap-southeast-2"
 
+	// CloudWatchRegionApSoutheast3 is a CloudWatchRegion enum value
+	CloudWatchRegionApSoutheast3 = "ap-southeast-3"
+
 	// CloudWatchRegionApNortheast1 is a CloudWatchRegion enum value
 	CloudWatchRegionApNortheast1 = "ap-northeast-1"
 
@@ -15058,8 +20440,79 @@ const (
 
 	// CloudWatchRegionCnNorth1 is a CloudWatchRegion enum value
 	CloudWatchRegionCnNorth1 = "cn-north-1"
+
+	// CloudWatchRegionAfSouth1 is a CloudWatchRegion enum value
+	CloudWatchRegionAfSouth1 = "af-south-1"
+
+	// CloudWatchRegionEuSouth1 is a CloudWatchRegion enum value
+	CloudWatchRegionEuSouth1 = "eu-south-1"
+
+	// CloudWatchRegionEuSouth2 is a CloudWatchRegion enum value
+	CloudWatchRegionEuSouth2 = "eu-south-2"
+
+	// CloudWatchRegionUsGovWest1 is a CloudWatchRegion enum value
+	CloudWatchRegionUsGovWest1 = "us-gov-west-1"
+
+	// CloudWatchRegionUsGovEast1 is a CloudWatchRegion enum value
+	CloudWatchRegionUsGovEast1 = "us-gov-east-1"
+
+	// CloudWatchRegionUsIsoEast1 is a CloudWatchRegion enum value
+	CloudWatchRegionUsIsoEast1 = "us-iso-east-1"
+
+	// CloudWatchRegionUsIsoWest1 is a CloudWatchRegion enum value
+	CloudWatchRegionUsIsoWest1 = "us-iso-west-1"
+
+	// CloudWatchRegionUsIsobEast1 is a CloudWatchRegion enum value
+	CloudWatchRegionUsIsobEast1 = "us-isob-east-1"
+
+	// CloudWatchRegionApSoutheast4 is a CloudWatchRegion enum value
+	CloudWatchRegionApSoutheast4 = "ap-southeast-4"
+
+	// CloudWatchRegionIlCentral1 is a CloudWatchRegion enum value
+	CloudWatchRegionIlCentral1 = "il-central-1"
 )
 
+// CloudWatchRegion_Values returns all elements of the CloudWatchRegion enum
+func CloudWatchRegion_Values() []string {
+	return []string{
+		CloudWatchRegionUsEast1,
+		CloudWatchRegionUsEast2,
+		CloudWatchRegionUsWest1,
+		CloudWatchRegionUsWest2,
+		CloudWatchRegionCaCentral1,
+		CloudWatchRegionEuCentral1,
+		CloudWatchRegionEuCentral2,
+		CloudWatchRegionEuWest1,
+		CloudWatchRegionEuWest2,
+		CloudWatchRegionEuWest3,
+		CloudWatchRegionApEast1,
+		CloudWatchRegionMeSouth1,
+		CloudWatchRegionMeCentral1,
+		CloudWatchRegionApSouth1,
+		CloudWatchRegionApSouth2,
+		CloudWatchRegionApSoutheast1,
+		CloudWatchRegionApSoutheast2,
+		CloudWatchRegionApSoutheast3,
+		CloudWatchRegionApNortheast1,
+		CloudWatchRegionApNortheast2,
+		CloudWatchRegionApNortheast3,
+		CloudWatchRegionEuNorth1,
+		CloudWatchRegionSaEast1,
+		CloudWatchRegionCnNorthwest1,
+		CloudWatchRegionCnNorth1,
+		CloudWatchRegionAfSouth1,
+		CloudWatchRegionEuSouth1,
+		CloudWatchRegionEuSouth2,
+		CloudWatchRegionUsGovWest1,
+		CloudWatchRegionUsGovEast1,
+		CloudWatchRegionUsIsoEast1,
+		CloudWatchRegionUsIsoWest1,
+		CloudWatchRegionUsIsobEast1,
+		CloudWatchRegionApSoutheast4,
+		CloudWatchRegionIlCentral1,
+	}
+}
+
 const (
 	// ComparisonOperatorGreaterThanOrEqualToThreshold is a ComparisonOperator enum value
 	ComparisonOperatorGreaterThanOrEqualToThreshold = "GreaterThanOrEqualToThreshold"
@@ -15074,6 +20527,16 @@ const (
 	ComparisonOperatorLessThanOrEqualToThreshold = "LessThanOrEqualToThreshold"
 )
 
+// ComparisonOperator_Values returns all elements of the ComparisonOperator enum
+func ComparisonOperator_Values() []string {
+	return []string{
+		ComparisonOperatorGreaterThanOrEqualToThreshold,
+		ComparisonOperatorGreaterThanThreshold,
+		ComparisonOperatorLessThanThreshold,
+		ComparisonOperatorLessThanOrEqualToThreshold,
+	}
+}
+
 const (
 	// HealthCheckRegionUsEast1 is a HealthCheckRegion enum value
 	HealthCheckRegionUsEast1 = "us-east-1"
@@ -15100,6 +20563,20 @@ const (
 	HealthCheckRegionSaEast1 = "sa-east-1"
 )
 
+// HealthCheckRegion_Values returns all elements of the HealthCheckRegion enum
+func HealthCheckRegion_Values() []string {
+	return []string{
+		HealthCheckRegionUsEast1,
+		HealthCheckRegionUsWest1,
+		HealthCheckRegionUsWest2,
+		HealthCheckRegionEuWest1,
+		HealthCheckRegionApSoutheast1,
+		HealthCheckRegionApSoutheast2,
+		HealthCheckRegionApNortheast1,
+		HealthCheckRegionSaEast1,
+	}
+}
+
 const (
 	// HealthCheckTypeHttp is a HealthCheckType enum value
 	HealthCheckTypeHttp = "HTTP"
@@ -15121,8 +20598,25 @@ const (
 
 	// HealthCheckTypeCloudwatchMetric is a HealthCheckType enum value
 	HealthCheckTypeCloudwatchMetric = "CLOUDWATCH_METRIC"
+
+	// HealthCheckTypeRecoveryControl is a HealthCheckType enum value
+	HealthCheckTypeRecoveryControl = "RECOVERY_CONTROL"
 )
 
+// HealthCheckType_Values returns all elements of the HealthCheckType enum
+func HealthCheckType_Values() []string {
+	return []string{
+		HealthCheckTypeHttp,
+		HealthCheckTypeHttps,
+		HealthCheckTypeHttpStrMatch,
+		HealthCheckTypeHttpsStrMatch,
+		HealthCheckTypeTcp,
+		HealthCheckTypeCalculated,
+		HealthCheckTypeCloudwatchMetric,
+		HealthCheckTypeRecoveryControl,
+	}
+}
+
 const (
 	// HostedZoneLimitTypeMaxRrsetsByZone is a HostedZoneLimitType enum value
 	HostedZoneLimitTypeMaxRrsetsByZone = "MAX_RRSETS_BY_ZONE"
@@ -15131,6 +20625,26 @@ const (
 	HostedZoneLimitTypeMaxVpcsAssociatedByZone = "MAX_VPCS_ASSOCIATED_BY_ZONE"
 )
 
+// HostedZoneLimitType_Values returns all elements of the HostedZoneLimitType enum
+func HostedZoneLimitType_Values() []string {
+	return []string{
+		HostedZoneLimitTypeMaxRrsetsByZone,
+		HostedZoneLimitTypeMaxVpcsAssociatedByZone,
+	}
+}
+
+const (
+	// HostedZoneTypePrivateHostedZone is a HostedZoneType enum value
+	HostedZoneTypePrivateHostedZone = "PrivateHostedZone"
+)
+
+// HostedZoneType_Values returns all elements of the HostedZoneType enum
+func HostedZoneType_Values() []string {
+	return []string{
+		HostedZoneTypePrivateHostedZone,
+	}
+}
+
 const (
 	// InsufficientDataHealthStatusHealthy is a InsufficientDataHealthStatus enum value
 	InsufficientDataHealthStatusHealthy = "Healthy"
@@ -15142,6 +20656,15 @@ const (
 	InsufficientDataHealthStatusLastKnownStatus = "LastKnownStatus"
 )
 
+// InsufficientDataHealthStatus_Values returns all elements of the InsufficientDataHealthStatus enum
+func InsufficientDataHealthStatus_Values() []string {
+	return []string{
+		InsufficientDataHealthStatusHealthy,
+		InsufficientDataHealthStatusUnhealthy,
+		InsufficientDataHealthStatusLastKnownStatus,
+	}
+}
+
 const (
 	// RRTypeSoa is a RRType enum value
 	RRTypeSoa = "SOA"
@@ -15178,8 +20701,30 @@ const (
 
 	// RRTypeCaa is a RRType enum value
 	RRTypeCaa = "CAA"
+
+	// RRTypeDs is a RRType enum value
+	RRTypeDs = "DS"
 )
 
+// RRType_Values returns all elements of the RRType enum
+func RRType_Values() []string {
+	return []string{
+		RRTypeSoa,
+		RRTypeA,
+		RRTypeTxt,
+		RRTypeNs,
+		RRTypeCname,
+		RRTypeMx,
+		RRTypeNaptr,
+		RRTypePtr,
+		RRTypeSrv,
+		RRTypeSpf,
+		RRTypeAaaa,
+		RRTypeCaa,
+		RRTypeDs,
+	}
+}
+
 const (
 	// ResettableElementNameFullyQualifiedDomainName is a ResettableElementName enum value
 	ResettableElementNameFullyQualifiedDomainName = "FullyQualifiedDomainName"
@@ -15194,6 +20739,16 @@ const (
 	ResettableElementNameChildHealthChecks = "ChildHealthChecks"
 )
 
+// ResettableElementName_Values returns all elements of the ResettableElementName enum
+func ResettableElementName_Values() []string {
+	return []string{
+		ResettableElementNameFullyQualifiedDomainName,
+		ResettableElementNameRegions,
+		ResettableElementNameResourcePath,
+		ResettableElementNameChildHealthChecks,
+	}
+}
+
 const (
 	// ResourceRecordSetFailoverPrimary is a ResourceRecordSetFailover enum value
 	ResourceRecordSetFailoverPrimary = "PRIMARY"
@@ -15202,6 +20757,14 @@ const (
 	ResourceRecordSetFailoverSecondary = "SECONDARY"
 )
 
+// ResourceRecordSetFailover_Values returns all elements of the ResourceRecordSetFailover enum
+func ResourceRecordSetFailover_Values() []string {
+	return []string{
+		ResourceRecordSetFailoverPrimary,
+		ResourceRecordSetFailoverSecondary,
+	}
+}
+
 const (
 	// ResourceRecordSetRegionUsEast1 is a ResourceRecordSetRegion enum value
 	ResourceRecordSetRegionUsEast1 = "us-east-1"
@@ -15230,12 +20793,18 @@ const (
 	// ResourceRecordSetRegionEuCentral1 is a ResourceRecordSetRegion enum value
 	ResourceRecordSetRegionEuCentral1 = "eu-central-1"
 
+	// ResourceRecordSetRegionEuCentral2 is a ResourceRecordSetRegion enum value
+	ResourceRecordSetRegionEuCentral2 = "eu-central-2"
+
 	// ResourceRecordSetRegionApSoutheast1 is a ResourceRecordSetRegion enum value
 	ResourceRecordSetRegionApSoutheast1 = "ap-southeast-1"
 
 	// ResourceRecordSetRegionApSoutheast2 is a ResourceRecordSetRegion enum value
 	ResourceRecordSetRegionApSoutheast2 = "ap-southeast-2"
 
+	// ResourceRecordSetRegionApSoutheast3 is a ResourceRecordSetRegion enum value
+	ResourceRecordSetRegionApSoutheast3 = "ap-southeast-3"
+
 	// ResourceRecordSetRegionApNortheast1 is a ResourceRecordSetRegion enum value
 	ResourceRecordSetRegionApNortheast1 = "ap-northeast-1"
 
@@ -15263,15 +20832,79 @@ const (
 	// ResourceRecordSetRegionMeSouth1 is a ResourceRecordSetRegion enum value
 	ResourceRecordSetRegionMeSouth1 = "me-south-1"
 
+	// ResourceRecordSetRegionMeCentral1 is a ResourceRecordSetRegion enum value
+	ResourceRecordSetRegionMeCentral1 = "me-central-1"
+
 	// ResourceRecordSetRegionApSouth1 is a ResourceRecordSetRegion enum value
 	ResourceRecordSetRegionApSouth1 = "ap-south-1"
+
+	// ResourceRecordSetRegionApSouth2 is a ResourceRecordSetRegion enum value
+	ResourceRecordSetRegionApSouth2 = "ap-south-2"
+
+	// ResourceRecordSetRegionAfSouth1 is a ResourceRecordSetRegion enum value
+	ResourceRecordSetRegionAfSouth1 = "af-south-1"
+
+	// ResourceRecordSetRegionEuSouth1 is a ResourceRecordSetRegion enum value
+	ResourceRecordSetRegionEuSouth1 = "eu-south-1"
+
+	// ResourceRecordSetRegionEuSouth2 is a ResourceRecordSetRegion enum value
+	ResourceRecordSetRegionEuSouth2 = "eu-south-2"
+
+	// ResourceRecordSetRegionApSoutheast4 is a ResourceRecordSetRegion enum value
+	ResourceRecordSetRegionApSoutheast4 = "ap-southeast-4"
+
+	// ResourceRecordSetRegionIlCentral1 is a ResourceRecordSetRegion enum value
+	ResourceRecordSetRegionIlCentral1 = "il-central-1"
 )
 
+// ResourceRecordSetRegion_Values returns all elements of the ResourceRecordSetRegion enum
+func ResourceRecordSetRegion_Values() []string {
+	return []string{
+		ResourceRecordSetRegionUsEast1,
+		ResourceRecordSetRegionUsEast2,
+		ResourceRecordSetRegionUsWest1,
+		ResourceRecordSetRegionUsWest2,
+		ResourceRecordSetRegionCaCentral1,
+		ResourceRecordSetRegionEuWest1,
+		ResourceRecordSetRegionEuWest2,
+		ResourceRecordSetRegionEuWest3,
+		ResourceRecordSetRegionEuCentral1,
+		ResourceRecordSetRegionEuCentral2,
+		ResourceRecordSetRegionApSoutheast1,
+		ResourceRecordSetRegionApSoutheast2,
+		ResourceRecordSetRegionApSoutheast3,
+		ResourceRecordSetRegionApNortheast1,
+		ResourceRecordSetRegionApNortheast2,
+		ResourceRecordSetRegionApNortheast3,
+		ResourceRecordSetRegionEuNorth1,
+		ResourceRecordSetRegionSaEast1,
+		ResourceRecordSetRegionCnNorth1,
+		ResourceRecordSetRegionCnNorthwest1,
+		ResourceRecordSetRegionApEast1,
+		ResourceRecordSetRegionMeSouth1,
+		ResourceRecordSetRegionMeCentral1,
+		ResourceRecordSetRegionApSouth1,
+		ResourceRecordSetRegionApSouth2,
+		ResourceRecordSetRegionAfSouth1,
+		ResourceRecordSetRegionEuSouth1,
+		ResourceRecordSetRegionEuSouth2,
+		ResourceRecordSetRegionApSoutheast4,
+		ResourceRecordSetRegionIlCentral1,
+	}
+}
+
 const (
 	// ReusableDelegationSetLimitTypeMaxZonesByReusableDelegationSet is a ReusableDelegationSetLimitType enum value
 	ReusableDelegationSetLimitTypeMaxZonesByReusableDelegationSet = "MAX_ZONES_BY_REUSABLE_DELEGATION_SET"
 )
 
+// ReusableDelegationSetLimitType_Values returns all elements of the ReusableDelegationSetLimitType enum
+func ReusableDelegationSetLimitType_Values() []string {
+	return []string{
+		ReusableDelegationSetLimitTypeMaxZonesByReusableDelegationSet,
+	}
+}
+
 const (
 	// StatisticAverage is a Statistic enum value
 	StatisticAverage = "Average"
@@ -15289,6 +20922,17 @@ const (
 	StatisticMinimum = "Minimum"
 )
 
+// Statistic_Values returns all elements of the Statistic enum
+func Statistic_Values() []string {
+	return []string{
+		StatisticAverage,
+		StatisticSum,
+		StatisticSampleCount,
+		StatisticMaximum,
+		StatisticMinimum,
+	}
+}
+
 const (
 	// TagResourceTypeHealthcheck is a TagResourceType enum value
 	TagResourceTypeHealthcheck = "healthcheck"
@@ -15297,6 +20941,14 @@ const (
 	TagResourceTypeHostedzone = "hostedzone"
 )
 
+// TagResourceType_Values returns all elements of the TagResourceType enum
+func TagResourceType_Values() []string {
+	return []string{
+		TagResourceTypeHealthcheck,
+		TagResourceTypeHostedzone,
+	}
+}
+
 const (
 	// VPCRegionUsEast1 is a VPCRegion enum value
 	VPCRegionUsEast1 = "us-east-1"
@@ -15322,21 +20974,48 @@ const (
 	// VPCRegionEuCentral1 is a VPCRegion enum value
 	VPCRegionEuCentral1 = "eu-central-1"
 
+	// VPCRegionEuCentral2 is a VPCRegion enum value
+	VPCRegionEuCentral2 = "eu-central-2"
+
 	// VPCRegionApEast1 is a VPCRegion enum value
 	VPCRegionApEast1 = "ap-east-1"
 
 	// VPCRegionMeSouth1 is a VPCRegion enum value
 	VPCRegionMeSouth1 = "me-south-1"
 
+	// VPCRegionUsGovWest1 is a VPCRegion enum value
+	VPCRegionUsGovWest1 = "us-gov-west-1"
+
+	// VPCRegionUsGovEast1 is a VPCRegion enum value
+	VPCRegionUsGovEast1 = "us-gov-east-1"
+
+	// VPCRegionUsIsoEast1 is a VPCRegion enum value
+	VPCRegionUsIsoEast1 = "us-iso-east-1"
+
+	// VPCRegionUsIsoWest1 is a VPCRegion enum value
+	VPCRegionUsIsoWest1 = "us-iso-west-1"
+
+	// VPCRegionUsIsobEast1 is a VPCRegion enum value
+	VPCRegionUsIsobEast1 = "us-isob-east-1"
+
+	// VPCRegionMeCentral1 is a VPCRegion enum value
+	VPCRegionMeCentral1 = "me-central-1"
+
 	// VPCRegionApSoutheast1 is a VPCRegion enum value
 	VPCRegionApSoutheast1 = "ap-southeast-1"
 
 	// VPCRegionApSoutheast2 is a VPCRegion enum value
 	VPCRegionApSoutheast2 = "ap-southeast-2"
 
+	// VPCRegionApSoutheast3 is a VPCRegion enum value
+	VPCRegionApSoutheast3 = "ap-southeast-3"
+
 	// VPCRegionApSouth1 is a VPCRegion enum value
 	VPCRegionApSouth1 = "ap-south-1"
 
+	// VPCRegionApSouth2 is a VPCRegion enum value
+	VPCRegionApSouth2 = "ap-south-2"
+
 	// VPCRegionApNortheast1 is a VPCRegion enum value
 	VPCRegionApNortheast1 = "ap-northeast-1"
 
@@ -15357,4 +21036,59 @@ const (
 
 	// VPCRegionCnNorth1 is a VPCRegion enum value
 	VPCRegionCnNorth1 = "cn-north-1"
+
+	// VPCRegionAfSouth1 is a VPCRegion enum value
+	VPCRegionAfSouth1 = "af-south-1"
+
+	// VPCRegionEuSouth1 is a VPCRegion enum value
+	VPCRegionEuSouth1 = "eu-south-1"
+
+	// VPCRegionEuSouth2 is a VPCRegion enum value
+	VPCRegionEuSouth2 = "eu-south-2"
+
+	// VPCRegionApSoutheast4 is a VPCRegion enum value
+	VPCRegionApSoutheast4 = "ap-southeast-4"
+
+	// VPCRegionIlCentral1 is a VPCRegion enum value
+	VPCRegionIlCentral1 = "il-central-1"
 )
+
+// VPCRegion_Values returns all elements of the VPCRegion enum
+func VPCRegion_Values() []string {
+	return []string{
+		VPCRegionUsEast1,
+		VPCRegionUsEast2,
+		VPCRegionUsWest1,
+		VPCRegionUsWest2,
+		VPCRegionEuWest1,
+		VPCRegionEuWest2,
+		VPCRegionEuWest3,
+		VPCRegionEuCentral1,
+		VPCRegionEuCentral2,
+		VPCRegionApEast1,
+		VPCRegionMeSouth1,
+		VPCRegionUsGovWest1,
+		VPCRegionUsGovEast1,
+		VPCRegionUsIsoEast1,
+		VPCRegionUsIsoWest1,
+		VPCRegionUsIsobEast1,
+		VPCRegionMeCentral1,
+		VPCRegionApSoutheast1,
+		VPCRegionApSoutheast2,
+		VPCRegionApSoutheast3,
+		VPCRegionApSouth1,
+		VPCRegionApSouth2,
+		VPCRegionApNortheast1,
+		VPCRegionApNortheast2,
+		VPCRegionApNortheast3,
+		VPCRegionEuNorth1,
+		VPCRegionSaEast1,
+		VPCRegionCaCentral1,
+		VPCRegionCnNorth1,
+		VPCRegionAfSouth1,
+		VPCRegionEuSouth1,
+		VPCRegionEuSouth2,
+		VPCRegionApSoutheast4,
+		VPCRegionIlCentral1,
+	}
+}
@@ -6,12 +6,24 @@
 // Amazon Route 53 is a highly available and scalable Domain Name System (DNS)
 // web service.
 //
+// You can use Route 53 to:
+//
+//   - Register domain names. For more information, see How domain registration
+//     works (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/welcome-domain-registration.html).
+//
+//   - Route internet traffic to the resources for your domain For more information,
+//     see How internet traffic is routed to your website or web application
+//     (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/welcome-dns-service.html).
+//
+//   - Check the health of your resources. For more information, see How Route
+//     53 checks the health of your resources (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/welcome-health-checks.html).
+//
 // See https://docs.aws.amazon.com/goto/WebAPI/route53-2013-04-01 for more information on this service.
 //
 // See route53 package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/route53/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon Route 53 with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -4,6 +4,32 @@ package route53
 
 const (
 
+	// ErrCodeCidrBlockInUseException for service response error code
+	// "CidrBlockInUseException".
+	//
+	// This CIDR block is already in use.
+	ErrCodeCidrBlockInUseException = "CidrBlockInUseException"
+
+	// ErrCodeCidrCollectionAlreadyExistsException for service response error code
+	// "CidrCollectionAlreadyExistsException".
+	//
+	// A CIDR collection with this name and a different caller reference already
+	// exists in this account.
+	ErrCodeCidrCollectionAlreadyExistsException = "CidrCollectionAlreadyExistsException"
+
+	// ErrCodeCidrCollectionInUseException for service response error code
+	// "CidrCollectionInUseException".
+	//
+	// This CIDR collection is in use, and isn't empty.
+	ErrCodeCidrCollectionInUseException = "CidrCollectionInUseException"
+
+	// ErrCodeCidrCollectionVersionMismatchException for service response error code
+	// "CidrCollectionVersionMismatchException".
+	//
+	// The CIDR collection version you provided, doesn't match the one in the ListCidrCollections
+	// operation.
+	ErrCodeCidrCollectionVersionMismatchException = "CidrCollectionVersionMismatchException"
+
 	// ErrCodeConcurrentModification for service response error code
 	// "ConcurrentModification".
 	//
@@ -14,22 +40,22 @@ const (
 	// ErrCodeConflictingDomainExists for service response error code
 	// "ConflictingDomainExists".
 	//
-	// The cause of this error depends on whether you're trying to create a public
-	// or a private hosted zone:
-	//
-	//    * Public hosted zone: Two hosted zones that have the same name or that
-	//    have a parent/child relationship (example.com and test.example.com) can't
-	//    have any common name servers. You tried to create a hosted zone that has
-	//    the same name as an existing hosted zone or that's the parent or child
-	//    of an existing hosted zone, and you specified a delegation set that shares
-	//    one or more name servers with the existing hosted zone. For more information,
-	//    see CreateReusableDelegationSet (https://docs.aws.amazon.com/Route53/latest/APIReference/API_CreateReusableDelegationSet.html).
-	//
-	//    * Private hosted zone: You specified an Amazon VPC that you're already
-	//    using for another hosted zone, and the domain that you specified for one
-	//    of the hosted zones is a subdomain of the domain that you specified for
-	//    the other hosted zone. For example, you can't use the same Amazon VPC
-	//    for the hosted zones for example.com and test.example.com.
+	// The cause of this error depends on the operation that you're performing:
+	//
+	//    * Create a public hosted zone: Two hosted zones that have the same name
+	//    or that have a parent/child relationship (example.com and test.example.com)
+	//    can't have any common name servers. You tried to create a hosted zone
+	//    that has the same name as an existing hosted zone or that's the parent
+	//    or child of an existing hosted zone, and you specified a delegation set
+	//    that shares one or more name servers with the existing hosted zone. For
+	//    more information, see CreateReusableDelegationSet (https://docs.aws.amazon.com/Route53/latest/APIReference/API_CreateReusableDelegationSet.html).
+	//
+	//    * Create a private hosted zone: A hosted zone with the specified name
+	//    already exists and is already associated with the Amazon VPC that you
+	//    specified.
+	//
+	//    * Associate VPCs with a private hosted zone: The VPC that you specified
+	//    is already associated with another hosted zone that has the same name.
 	ErrCodeConflictingDomainExists = "ConflictingDomainExists"
 
 	// ErrCodeConflictingTypes for service response error code
@@ -40,6 +66,12 @@ const (
 	// specified the type in the JSON document in the CreateTrafficPolicy or CreateTrafficPolicyVersionrequest.
 	ErrCodeConflictingTypes = "ConflictingTypes"
 
+	// ErrCodeDNSSECNotFound for service response error code
+	// "DNSSECNotFound".
+	//
+	// The hosted zone doesn't have any DNSSEC resources.
+	ErrCodeDNSSECNotFound = "DNSSECNotFound"
+
 	// ErrCodeDelegationSetAlreadyCreated for service response error code
 	// "DelegationSetAlreadyCreated".
 	//
@@ -129,6 +161,13 @@ const (
 	// The specified hosted zone is a public hosted zone, not a private hosted zone.
 	ErrCodeHostedZoneNotPrivate = "HostedZoneNotPrivate"
 
+	// ErrCodeHostedZonePartiallyDelegated for service response error code
+	// "HostedZonePartiallyDelegated".
+	//
+	// The hosted zone nameservers don't match the parent nameservers. The hosted
+	// zone and parent must have the same nameservers.
+	ErrCodeHostedZonePartiallyDelegated = "HostedZonePartiallyDelegated"
+
 	// ErrCodeIncompatibleVersion for service response error code
 	// "IncompatibleVersion".
 	//
@@ -149,12 +188,20 @@ const (
 	//    Resource doesn't have the necessary permissions.
 	//
 	//    * The resource policy hasn't finished propagating yet.
+	//
+	//    * The Key management service (KMS) key you specified doesn’t exist or
+	//    it can’t be used with the log group associated with query log. Update
+	//    or provide a resource policy to grant permissions for the KMS key.
+	//
+	//    * The Key management service (KMS) key you specified is marked as disabled
+	//    for the log group associated with query log. Update or provide a resource
+	//    policy to grant permissions for the KMS key.
 	ErrCodeInsufficientCloudWatchLogsResourcePolicy = "InsufficientCloudWatchLogsResourcePolicy"
 
 	// ErrCodeInvalidArgument for service response error code
 	// "InvalidArgument".
 	//
-	// Parameter name is invalid.
+	// Parameter name is not valid.
 	ErrCodeInvalidArgument = "InvalidArgument"
 
 	// ErrCodeInvalidChangeBatch for service response error code
@@ -176,6 +223,26 @@ const (
 	// The input is not valid.
 	ErrCodeInvalidInput = "InvalidInput"
 
+	// ErrCodeInvalidKMSArn for service response error code
+	// "InvalidKMSArn".
+	//
+	// The KeyManagementServiceArn that you specified isn't valid to use with DNSSEC
+	// signing.
+	ErrCodeInvalidKMSArn = "InvalidKMSArn"
+
+	// ErrCodeInvalidKeySigningKeyName for service response error code
+	// "InvalidKeySigningKeyName".
+	//
+	// The key-signing key (KSK) name that you specified isn't a valid name.
+	ErrCodeInvalidKeySigningKeyName = "InvalidKeySigningKeyName"
+
+	// ErrCodeInvalidKeySigningKeyStatus for service response error code
+	// "InvalidKeySigningKeyStatus".
+	//
+	// The key-signing key (KSK) status isn't valid or another KSK has the status
+	// INTERNAL_FAILURE.
+	ErrCodeInvalidKeySigningKeyStatus = "InvalidKeySigningKeyStatus"
+
 	// ErrCodeInvalidPaginationToken for service response error code
 	// "InvalidPaginationToken".
 	//
@@ -183,11 +250,18 @@ const (
 	// is invalid.
 	ErrCodeInvalidPaginationToken = "InvalidPaginationToken"
 
+	// ErrCodeInvalidSigningStatus for service response error code
+	// "InvalidSigningStatus".
+	//
+	// Your hosted zone status isn't valid for this operation. In the hosted zone,
+	// change the status to enable DNSSEC or disable DNSSEC.
+	ErrCodeInvalidSigningStatus = "InvalidSigningStatus"
+
 	// ErrCodeInvalidTrafficPolicyDocument for service response error code
 	// "InvalidTrafficPolicyDocument".
 	//
 	// The format of the traffic policy document that you specified in the Document
-	// element is invalid.
+	// element is not valid.
 	ErrCodeInvalidTrafficPolicyDocument = "InvalidTrafficPolicyDocument"
 
 	// ErrCodeInvalidVPCId for service response error code
@@ -197,6 +271,33 @@ const (
 	// is not authorized to access this VPC.
 	ErrCodeInvalidVPCId = "InvalidVPCId"
 
+	// ErrCodeKeySigningKeyAlreadyExists for service response error code
+	// "KeySigningKeyAlreadyExists".
+	//
+	// You've already created a key-signing key (KSK) with this name or with the
+	// same customer managed key ARN.
+	ErrCodeKeySigningKeyAlreadyExists = "KeySigningKeyAlreadyExists"
+
+	// ErrCodeKeySigningKeyInParentDSRecord for service response error code
+	// "KeySigningKeyInParentDSRecord".
+	//
+	// The key-signing key (KSK) is specified in a parent DS record.
+	ErrCodeKeySigningKeyInParentDSRecord = "KeySigningKeyInParentDSRecord"
+
+	// ErrCodeKeySigningKeyInUse for service response error code
+	// "KeySigningKeyInUse".
+	//
+	// The key-signing key (KSK) that you specified can't be deactivated because
+	// it's the only KSK for a currently-enabled DNSSEC. Disable DNSSEC signing,
+	// or add or enable another KSK.
+	ErrCodeKeySigningKeyInUse = "KeySigningKeyInUse"
+
+	// ErrCodeKeySigningKeyWithActiveStatusNotFound for service response error code
+	// "KeySigningKeyWithActiveStatusNotFound".
+	//
+	// A key-signing key (KSK) with ACTIVE status wasn't found.
+	ErrCodeKeySigningKeyWithActiveStatusNotFound = "KeySigningKeyWithActiveStatusNotFound"
+
 	// ErrCodeLastVPCAssociation for service response error code
 	// "LastVPCAssociation".
 	//
@@ -208,15 +309,10 @@ const (
 	// ErrCodeLimitsExceeded for service response error code
 	// "LimitsExceeded".
 	//
-	// This operation can't be completed either because the current account has
-	// reached the limit on reusable delegation sets that it can create or because
-	// you've reached the limit on the number of Amazon VPCs that you can associate
-	// with a private hosted zone. To get the current limit on the number of reusable
-	// delegation sets, see GetAccountLimit (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetAccountLimit.html).
-	// To get the current limit on the number of Amazon VPCs that you can associate
-	// with a private hosted zone, see GetHostedZoneLimit (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetHostedZoneLimit.html).
-	// To request a higher limit, create a case (http://aws.amazon.com/route53-request)
-	// with the AWS Support Center.
+	// This operation can't be completed because the current account has reached
+	// the limit on the resource you are trying to create. To request a higher limit,
+	// create a case (http://aws.amazon.com/route53-request) with the Amazon Web
+	// Services Support Center.
 	ErrCodeLimitsExceeded = "LimitsExceeded"
 
 	// ErrCodeNoSuchChange for service response error code
@@ -225,6 +321,18 @@ const (
 	// A change with the specified change ID does not exist.
 	ErrCodeNoSuchChange = "NoSuchChange"
 
+	// ErrCodeNoSuchCidrCollectionException for service response error code
+	// "NoSuchCidrCollectionException".
+	//
+	// The CIDR collection you specified, doesn't exist.
+	ErrCodeNoSuchCidrCollectionException = "NoSuchCidrCollectionException"
+
+	// ErrCodeNoSuchCidrLocationException for service response error code
+	// "NoSuchCidrLocationException".
+	//
+	// The CIDR collection location doesn't match any locations in your account.
+	ErrCodeNoSuchCidrLocationException = "NoSuchCidrLocationException"
+
 	// ErrCodeNoSuchCloudWatchLogsLogGroup for service response error code
 	// "NoSuchCloudWatchLogsLogGroup".
 	//
@@ -240,7 +348,9 @@ const (
 	// ErrCodeNoSuchGeoLocation for service response error code
 	// "NoSuchGeoLocation".
 	//
-	// Amazon Route 53 doesn't support the specified geographic location.
+	// Amazon Route 53 doesn't support the specified geographic location. For a
+	// list of supported geolocation codes, see the GeoLocation (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GeoLocation.html)
+	// data type.
 	ErrCodeNoSuchGeoLocation = "NoSuchGeoLocation"
 
 	// ErrCodeNoSuchHealthCheck for service response error code
@@ -255,6 +365,12 @@ const (
 	// No hosted zone exists with the ID that you specified.
 	ErrCodeNoSuchHostedZone = "NoSuchHostedZone"
 
+	// ErrCodeNoSuchKeySigningKey for service response error code
+	// "NoSuchKeySigningKey".
+	//
+	// The specified key-signing key (KSK) doesn't exist.
+	ErrCodeNoSuchKeySigningKey = "NoSuchKeySigningKey"
+
 	// ErrCodeNoSuchQueryLoggingConfig for service response error code
 	// "NoSuchQueryLoggingConfig".
 	//
@@ -322,11 +438,11 @@ const (
 	// For information about how to get the current limit for an account, see GetAccountLimit
 	// (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetAccountLimit.html).
 	// To request a higher limit, create a case (http://aws.amazon.com/route53-request)
-	// with the AWS Support Center.
+	// with the Amazon Web Services Support Center.
 	//
-	// You have reached the maximum number of active health checks for an AWS account.
-	// To request a higher limit, create a case (http://aws.amazon.com/route53-request)
-	// with the AWS Support Center.
+	// You have reached the maximum number of active health checks for an Amazon
+	// Web Services account. To request a higher limit, create a case (http://aws.amazon.com/route53-request)
+	// with the Amazon Web Services Support Center.
 	ErrCodeTooManyHealthChecks = "TooManyHealthChecks"
 
 	// ErrCodeTooManyHostedZones for service response error code
@@ -347,9 +463,16 @@ const (
 	// delegation set, see GetReusableDelegationSetLimit (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetReusableDelegationSetLimit.html).
 	//
 	// To request a higher limit, create a case (http://aws.amazon.com/route53-request)
-	// with the AWS Support Center.
+	// with the Amazon Web Services Support Center.
 	ErrCodeTooManyHostedZones = "TooManyHostedZones"
 
+	// ErrCodeTooManyKeySigningKeys for service response error code
+	// "TooManyKeySigningKeys".
+	//
+	// You've reached the limit for the number of key-signing keys (KSKs). Remove
+	// at least one KSK, and then try again.
+	ErrCodeTooManyKeySigningKeys = "TooManyKeySigningKeys"
+
 	// ErrCodeTooManyTrafficPolicies for service response error code
 	// "TooManyTrafficPolicies".
 	//
@@ -362,7 +485,7 @@ const (
 	// To get the current limit for an account, see GetAccountLimit (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetAccountLimit.html).
 	//
 	// To request a higher limit, create a case (http://aws.amazon.com/route53-request)
-	// with the AWS Support Center.
+	// with the Amazon Web Services Support Center.
 	ErrCodeTooManyTrafficPolicies = "TooManyTrafficPolicies"
 
 	// ErrCodeTooManyTrafficPolicyInstances for service response error code
@@ -378,7 +501,7 @@ const (
 	// (https://docs.aws.amazon.com/Route53/latest/APIReference/API_GetAccountLimit.html).
 	//
 	// To request a higher limit, create a case (http://aws.amazon.com/route53-request)
-	// with the AWS Support Center.
+	// with the Amazon Web Services Support Center.
 	ErrCodeTooManyTrafficPolicyInstances = "TooManyTrafficPolicyInstances"
 
 	// ErrCodeTooManyTrafficPolicyVersionsForCurrentPolicy for service response error code
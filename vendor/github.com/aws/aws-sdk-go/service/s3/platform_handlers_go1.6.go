@@ -1,3 +1,4 @@
+//go:build go1.6
 // +build go1.6
 
 package s3
@@ -24,5 +25,5 @@ func add100Continue(r *request.Request) {
 		return
 	}
 
-	r.HTTPRequest.Header.Set("Expect", "100-Continue")
+	r.HTTPRequest.Header.Set("Expect", "100-continue")
 }
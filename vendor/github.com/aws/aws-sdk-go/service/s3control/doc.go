@@ -3,14 +3,15 @@
 // Package s3control provides the client and types for making API
 // requests to AWS S3 Control.
 //
-// AWS S3 Control provides access to Amazon S3 control plane operations.
+// Amazon Web Services S3 Control provides access to Amazon S3 control plane
+// actions.
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/s3control-2018-08-20 for more information on this service.
 //
 // See s3control package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/s3control/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact AWS S3 Control with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
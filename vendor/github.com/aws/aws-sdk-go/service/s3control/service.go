@@ -31,7 +31,7 @@ var initRequest func(*request.Request)
 const (
 	ServiceName = "S3 Control" // Name of service.
 	EndpointsID = "s3-control" // ID to lookup a service endpoint with.
-	ServiceID   = "S3 Control" // ServiceID is a unique identifer of a specific service.
+	ServiceID   = "S3 Control" // ServiceID is a unique identifier of a specific service.
 )
 
 // New creates a new instance of the S3Control client with a session.
@@ -39,31 +39,36 @@ const (
 // aws.Config parameter to add your extra config.
 //
 // Example:
-//     // Create a S3Control client from just a session.
-//     svc := s3control.New(mySession)
 //
-//     // Create a S3Control client with additional configuration
-//     svc := s3control.New(mySession, aws.NewConfig().WithRegion("us-west-2"))
+//	mySession := session.Must(session.NewSession())
+//
+//	// Create a S3Control client from just a session.
+//	svc := s3control.New(mySession)
+//
+//	// Create a S3Control client with additional configuration
+//	svc := s3control.New(mySession, aws.NewConfig().WithRegion("us-west-2"))
 func New(p client.ConfigProvider, cfgs ...*aws.Config) *S3Control {
 	c := p.ClientConfig(EndpointsID, cfgs...)
 	if c.SigningNameDerived || len(c.SigningName) == 0 {
 		c.SigningName = "s3"
 	}
-	return newClient(*c.Config, c.Handlers, c.Endpoint, c.SigningRegion, c.SigningName)
+	return newClient(*c.Config, c.Handlers, c.PartitionID, c.Endpoint, c.SigningRegion, c.SigningName, c.ResolvedRegion)
 }
 
 // newClient creates, initializes and returns a new service client instance.
-func newClient(cfg aws.Config, handlers request.Handlers, endpoint, signingRegion, signingName string) *S3Control {
+func newClient(cfg aws.Config, handlers request.Handlers, partitionID, endpoint, signingRegion, signingName, resolvedRegion string) *S3Control {
 	svc := &S3Control{
 		Client: client.New(
 			cfg,
 			metadata.ClientInfo{
-				ServiceName:   ServiceName,
-				ServiceID:     ServiceID,
-				SigningName:   signingName,
-				SigningRegion: signingRegion,
-				Endpoint:      endpoint,
-				APIVersion:    "2018-08-20",
+				ServiceName:    ServiceName,
+				ServiceID:      ServiceID,
+				SigningName:    signingName,
+				SigningRegion:  signingRegion,
+				PartitionID:    partitionID,
+				Endpoint:       endpoint,
+				APIVersion:     "2018-08-20",
+				ResolvedRegion: resolvedRegion,
 			},
 			handlers,
 		),
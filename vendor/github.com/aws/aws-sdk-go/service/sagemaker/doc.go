@@ -3,14 +3,20 @@
 // Package sagemaker provides the client and types for making API
 // requests to Amazon SageMaker Service.
 //
-// Provides APIs for creating and managing Amazon SageMaker resources.
+// Provides APIs for creating and managing SageMaker resources.
+//
+// Other Resources:
+//
+//   - SageMaker Developer Guide (https://docs.aws.amazon.com/sagemaker/latest/dg/whatis.html#first-time-user)
+//
+//   - Amazon Augmented AI Runtime API Reference (https://docs.aws.amazon.com/augmented-ai/2019-11-07/APIReference/Welcome.html)
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/sagemaker-2017-07-24 for more information on this service.
 //
 // See sagemaker package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/sagemaker/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon SageMaker Service with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -2,6 +2,10 @@
 
 package secretsmanager
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeDecryptionFailure for service response error code
@@ -15,9 +19,8 @@ const (
 	// "EncryptionFailure".
 	//
 	// Secrets Manager can't encrypt the protected secret text using the provided
-	// KMS key. Check that the customer master key (CMK) is available, enabled,
-	// and not in an invalid state. For more information, see How Key State Affects
-	// Use of a Customer Master Key (http://docs.aws.amazon.com/kms/latest/developerguide/key-state.html).
+	// KMS key. Check that the KMS key is available, enabled, and not in an invalid
+	// state. For more information, see Key state: Effect on your KMS key (https://docs.aws.amazon.com/kms/latest/developerguide/key-state.html).
 	ErrCodeEncryptionFailure = "EncryptionFailure"
 
 	// ErrCodeInternalServiceError for service response error code
@@ -29,42 +32,43 @@ const (
 	// ErrCodeInvalidNextTokenException for service response error code
 	// "InvalidNextTokenException".
 	//
-	// You provided an invalid NextToken value.
+	// The NextToken value is invalid.
 	ErrCodeInvalidNextTokenException = "InvalidNextTokenException"
 
 	// ErrCodeInvalidParameterException for service response error code
 	// "InvalidParameterException".
 	//
-	// You provided an invalid value for a parameter.
+	// The parameter name or value is invalid.
 	ErrCodeInvalidParameterException = "InvalidParameterException"
 
 	// ErrCodeInvalidRequestException for service response error code
 	// "InvalidRequestException".
 	//
-	// You provided a parameter value that is not valid for the current state of
-	// the resource.
+	// A parameter value is not valid for the current state of the resource.
 	//
 	// Possible causes:
 	//
-	//    * You tried to perform the operation on a secret that's currently marked
-	//    deleted.
+	//    * The secret is scheduled for deletion.
 	//
 	//    * You tried to enable rotation on a secret that doesn't already have a
 	//    Lambda function ARN configured and you didn't include such an ARN as a
 	//    parameter in this call.
+	//
+	//    * The secret is managed by another service, and you must use that service
+	//    to update it. For more information, see Secrets managed by other Amazon
+	//    Web Services services (https://docs.aws.amazon.com/secretsmanager/latest/userguide/service-linked-secrets.html).
 	ErrCodeInvalidRequestException = "InvalidRequestException"
 
 	// ErrCodeLimitExceededException for service response error code
 	// "LimitExceededException".
 	//
-	// The request failed because it would exceed one of the Secrets Manager internal
-	// limits.
+	// The request failed because it would exceed one of the Secrets Manager quotas.
 	ErrCodeLimitExceededException = "LimitExceededException"
 
 	// ErrCodeMalformedPolicyDocumentException for service response error code
 	// "MalformedPolicyDocumentException".
 	//
-	// The policy document that you provided isn't valid.
+	// The resource policy has syntax errors.
 	ErrCodeMalformedPolicyDocumentException = "MalformedPolicyDocumentException"
 
 	// ErrCodePreconditionNotMetException for service response error code
@@ -73,6 +77,13 @@ const (
 	// The request failed because you did not complete all the prerequisite steps.
 	ErrCodePreconditionNotMetException = "PreconditionNotMetException"
 
+	// ErrCodePublicPolicyException for service response error code
+	// "PublicPolicyException".
+	//
+	// The BlockPublicPolicy parameter is set to true, and the resource policy did
+	// not prevent broad access to the secret.
+	ErrCodePublicPolicyException = "PublicPolicyException"
+
 	// ErrCodeResourceExistsException for service response error code
 	// "ResourceExistsException".
 	//
@@ -82,6 +93,21 @@ const (
 	// ErrCodeResourceNotFoundException for service response error code
 	// "ResourceNotFoundException".
 	//
-	// We can't find the resource that you asked for.
+	// Secrets Manager can't find the resource that you asked for.
 	ErrCodeResourceNotFoundException = "ResourceNotFoundException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"DecryptionFailure":                newErrorDecryptionFailure,
+	"EncryptionFailure":                newErrorEncryptionFailure,
+	"InternalServiceError":             newErrorInternalServiceError,
+	"InvalidNextTokenException":        newErrorInvalidNextTokenException,
+	"InvalidParameterException":        newErrorInvalidParameterException,
+	"InvalidRequestException":          newErrorInvalidRequestException,
+	"LimitExceededException":           newErrorLimitExceededException,
+	"MalformedPolicyDocumentException": newErrorMalformedPolicyDocumentException,
+	"PreconditionNotMetException":      newErrorPreconditionNotMetException,
+	"PublicPolicyException":            newErrorPublicPolicyException,
+	"ResourceExistsException":          newErrorResourceExistsException,
+	"ResourceNotFoundException":        newErrorResourceNotFoundException,
+}
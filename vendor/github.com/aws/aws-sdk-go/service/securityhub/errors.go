@@ -2,6 +2,10 @@
 
 package securityhub
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeAccessDeniedException for service response error code
@@ -19,7 +23,7 @@ const (
 	// ErrCodeInvalidAccessException for service response error code
 	// "InvalidAccessException".
 	//
-	// AWS Security Hub isn't enabled for the account used to make this request.
+	// The account doesn't have permission to perform this action.
 	ErrCodeInvalidAccessException = "InvalidAccessException"
 
 	// ErrCodeInvalidInputException for service response error code
@@ -33,7 +37,8 @@ const (
 	// "LimitExceededException".
 	//
 	// The request was rejected because it attempted to create resources beyond
-	// the current AWS account limits. The error code describes the limit exceeded.
+	// the current Amazon Web Services account or throttling limits. The error code
+	// describes the limit exceeded.
 	ErrCodeLimitExceededException = "LimitExceededException"
 
 	// ErrCodeResourceConflictException for service response error code
@@ -48,3 +53,13 @@ const (
 	// The request was rejected because we can't find the specified resource.
 	ErrCodeResourceNotFoundException = "ResourceNotFoundException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"AccessDeniedException":     newErrorAccessDeniedException,
+	"InternalException":         newErrorInternalException,
+	"InvalidAccessException":    newErrorInvalidAccessException,
+	"InvalidInputException":     newErrorInvalidInputException,
+	"LimitExceededException":    newErrorLimitExceededException,
+	"ResourceConflictException": newErrorResourceConflictException,
+	"ResourceNotFoundException": newErrorResourceNotFoundException,
+}
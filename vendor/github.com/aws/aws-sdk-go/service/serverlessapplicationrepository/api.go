@@ -28,14 +28,13 @@ const opCreateApplication = "CreateApplication"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateApplicationRequest method.
+//	req, resp := client.CreateApplicationRequest(params)
 //
-//    // Example sending a request using the CreateApplicationRequest method.
-//    req, resp := client.CreateApplicationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/CreateApplication
 func (c *ServerlessApplicationRepository) CreateApplicationRequest(input *CreateApplicationRequest) (req *request.Request, output *CreateApplicationOutput) {
@@ -66,23 +65,24 @@ func (c *ServerlessApplicationRepository) CreateApplicationRequest(input *Create
 // See the AWS API reference guide for AWSServerlessApplicationRepository's
 // API operation CreateApplication for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The client is sending more than the allowed number of requests per unit of
-//   time.
+// Returned Error Types:
+//
+//   - TooManyRequestsException
+//     The client is sending more than the allowed number of requests per unit of
+//     time.
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   One of the parameters in the request is invalid.
+//   - BadRequestException
+//     One of the parameters in the request is invalid.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The AWS Serverless Application Repository service encountered an internal
-//   error.
+//   - InternalServerErrorException
+//     The AWS Serverless Application Repository service encountered an internal
+//     error.
 //
-//   * ErrCodeConflictException "ConflictException"
-//   The resource already exists.
+//   - ConflictException
+//     The resource already exists.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   The client is not authenticated.
+//   - ForbiddenException
+//     The client is not authenticated.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/CreateApplication
 func (c *ServerlessApplicationRepository) CreateApplication(input *CreateApplicationRequest) (*CreateApplicationOutput, error) {
@@ -122,14 +122,13 @@ const opCreateApplicationVersion = "CreateApplicationVersion"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateApplicationVersionRequest method.
+//	req, resp := client.CreateApplicationVersionRequest(params)
 //
-//    // Example sending a request using the CreateApplicationVersionRequest method.
-//    req, resp := client.CreateApplicationVersionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/CreateApplicationVersion
 func (c *ServerlessApplicationRepository) CreateApplicationVersionRequest(input *CreateApplicationVersionRequest) (req *request.Request, output *CreateApplicationVersionOutput) {
@@ -159,23 +158,24 @@ func (c *ServerlessApplicationRepository) CreateApplicationVersionRequest(input
 // See the AWS API reference guide for AWSServerlessApplicationRepository's
 // API operation CreateApplicationVersion for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The client is sending more than the allowed number of requests per unit of
-//   time.
+// Returned Error Types:
+//
+//   - TooManyRequestsException
+//     The client is sending more than the allowed number of requests per unit of
+//     time.
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   One of the parameters in the request is invalid.
+//   - BadRequestException
+//     One of the parameters in the request is invalid.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The AWS Serverless Application Repository service encountered an internal
-//   error.
+//   - InternalServerErrorException
+//     The AWS Serverless Application Repository service encountered an internal
+//     error.
 //
-//   * ErrCodeConflictException "ConflictException"
-//   The resource already exists.
+//   - ConflictException
+//     The resource already exists.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   The client is not authenticated.
+//   - ForbiddenException
+//     The client is not authenticated.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/CreateApplicationVersion
 func (c *ServerlessApplicationRepository) CreateApplicationVersion(input *CreateApplicationVersionRequest) (*CreateApplicationVersionOutput, error) {
@@ -215,14 +215,13 @@ const opCreateCloudFormationChangeSet = "CreateCloudFormationChangeSet"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateCloudFormationChangeSetRequest method.
+//	req, resp := client.CreateCloudFormationChangeSetRequest(params)
 //
-//    // Example sending a request using the CreateCloudFormationChangeSetRequest method.
-//    req, resp := client.CreateCloudFormationChangeSetRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/CreateCloudFormationChangeSet
 func (c *ServerlessApplicationRepository) CreateCloudFormationChangeSetRequest(input *CreateCloudFormationChangeSetRequest) (req *request.Request, output *CreateCloudFormationChangeSetOutput) {
@@ -252,20 +251,21 @@ func (c *ServerlessApplicationRepository) CreateCloudFormationChangeSetRequest(i
 // See the AWS API reference guide for AWSServerlessApplicationRepository's
 // API operation CreateCloudFormationChangeSet for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The client is sending more than the allowed number of requests per unit of
-//   time.
+// Returned Error Types:
+//
+//   - TooManyRequestsException
+//     The client is sending more than the allowed number of requests per unit of
+//     time.
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   One of the parameters in the request is invalid.
+//   - BadRequestException
+//     One of the parameters in the request is invalid.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The AWS Serverless Application Repository service encountered an internal
-//   error.
+//   - InternalServerErrorException
+//     The AWS Serverless Application Repository service encountered an internal
+//     error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   The client is not authenticated.
+//   - ForbiddenException
+//     The client is not authenticated.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/CreateCloudFormationChangeSet
 func (c *ServerlessApplicationRepository) CreateCloudFormationChangeSet(input *CreateCloudFormationChangeSetRequest) (*CreateCloudFormationChangeSetOutput, error) {
@@ -305,14 +305,13 @@ const opCreateCloudFormationTemplate = "CreateCloudFormationTemplate"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateCloudFormationTemplateRequest method.
+//	req, resp := client.CreateCloudFormationTemplateRequest(params)
 //
-//    // Example sending a request using the CreateCloudFormationTemplateRequest method.
-//    req, resp := client.CreateCloudFormationTemplateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/CreateCloudFormationTemplate
 func (c *ServerlessApplicationRepository) CreateCloudFormationTemplateRequest(input *CreateCloudFormationTemplateInput) (req *request.Request, output *CreateCloudFormationTemplateOutput) {
@@ -342,24 +341,25 @@ func (c *ServerlessApplicationRepository) CreateCloudFormationTemplateRequest(in
 // See the AWS API reference guide for AWSServerlessApplicationRepository's
 // API operation CreateCloudFormationTemplate for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The resource (for example, an access policy statement) specified in the request
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The client is sending more than the allowed number of requests per unit of
-//   time.
+//   - NotFoundException
+//     The resource (for example, an access policy statement) specified in the request
+//     doesn't exist.
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   One of the parameters in the request is invalid.
+//   - TooManyRequestsException
+//     The client is sending more than the allowed number of requests per unit of
+//     time.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The AWS Serverless Application Repository service encountered an internal
-//   error.
+//   - BadRequestException
+//     One of the parameters in the request is invalid.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   The client is not authenticated.
+//   - InternalServerErrorException
+//     The AWS Serverless Application Repository service encountered an internal
+//     error.
+//
+//   - ForbiddenException
+//     The client is not authenticated.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/CreateCloudFormationTemplate
 func (c *ServerlessApplicationRepository) CreateCloudFormationTemplate(input *CreateCloudFormationTemplateInput) (*CreateCloudFormationTemplateOutput, error) {
@@ -399,14 +399,13 @@ const opDeleteApplication = "DeleteApplication"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteApplicationRequest method.
+//	req, resp := client.DeleteApplicationRequest(params)
 //
-//    // Example sending a request using the DeleteApplicationRequest method.
-//    req, resp := client.DeleteApplicationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/DeleteApplication
 func (c *ServerlessApplicationRepository) DeleteApplicationRequest(input *DeleteApplicationInput) (req *request.Request, output *DeleteApplicationOutput) {
@@ -437,27 +436,28 @@ func (c *ServerlessApplicationRepository) DeleteApplicationRequest(input *Delete
 // See the AWS API reference guide for AWSServerlessApplicationRepository's
 // API operation DeleteApplication for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   One of the parameters in the request is invalid.
+// Returned Error Types:
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The AWS Serverless Application Repository service encountered an internal
-//   error.
+//   - BadRequestException
+//     One of the parameters in the request is invalid.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   The client is not authenticated.
+//   - InternalServerErrorException
+//     The AWS Serverless Application Repository service encountered an internal
+//     error.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The resource (for example, an access policy statement) specified in the request
-//   doesn't exist.
+//   - ForbiddenException
+//     The client is not authenticated.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The client is sending more than the allowed number of requests per unit of
-//   time.
+//   - NotFoundException
+//     The resource (for example, an access policy statement) specified in the request
+//     doesn't exist.
 //
-//   * ErrCodeConflictException "ConflictException"
-//   The resource already exists.
+//   - TooManyRequestsException
+//     The client is sending more than the allowed number of requests per unit of
+//     time.
+//
+//   - ConflictException
+//     The resource already exists.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/DeleteApplication
 func (c *ServerlessApplicationRepository) DeleteApplication(input *DeleteApplicationInput) (*DeleteApplicationOutput, error) {
@@ -497,14 +497,13 @@ const opGetApplication = "GetApplication"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetApplicationRequest method.
+//	req, resp := client.GetApplicationRequest(params)
 //
-//    // Example sending a request using the GetApplicationRequest method.
-//    req, resp := client.GetApplicationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/GetApplication
 func (c *ServerlessApplicationRepository) GetApplicationRequest(input *GetApplicationInput) (req *request.Request, output *GetApplicationOutput) {
@@ -534,24 +533,25 @@ func (c *ServerlessApplicationRepository) GetApplicationRequest(input *GetApplic
 // See the AWS API reference guide for AWSServerlessApplicationRepository's
 // API operation GetApplication for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The resource (for example, an access policy statement) specified in the request
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The client is sending more than the allowed number of requests per unit of
-//   time.
+//   - NotFoundException
+//     The resource (for example, an access policy statement) specified in the request
+//     doesn't exist.
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   One of the parameters in the request is invalid.
+//   - TooManyRequestsException
+//     The client is sending more than the allowed number of requests per unit of
+//     time.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The AWS Serverless Application Repository service encountered an internal
-//   error.
+//   - BadRequestException
+//     One of the parameters in the request is invalid.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   The client is not authenticated.
+//   - InternalServerErrorException
+//     The AWS Serverless Application Repository service encountered an internal
+//     error.
+//
+//   - ForbiddenException
+//     The client is not authenticated.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/GetApplication
 func (c *ServerlessApplicationRepository) GetApplication(input *GetApplicationInput) (*GetApplicationOutput, error) {
@@ -591,14 +591,13 @@ const opGetApplicationPolicy = "GetApplicationPolicy"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetApplicationPolicyRequest method.
+//	req, resp := client.GetApplicationPolicyRequest(params)
 //
-//    // Example sending a request using the GetApplicationPolicyRequest method.
-//    req, resp := client.GetApplicationPolicyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/GetApplicationPolicy
 func (c *ServerlessApplicationRepository) GetApplicationPolicyRequest(input *GetApplicationPolicyInput) (req *request.Request, output *GetApplicationPolicyOutput) {
@@ -628,24 +627,25 @@ func (c *ServerlessApplicationRepository) GetApplicationPolicyRequest(input *Get
 // See the AWS API reference guide for AWSServerlessApplicationRepository's
 // API operation GetApplicationPolicy for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The resource (for example, an access policy statement) specified in the request
-//   doesn't exist.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The resource (for example, an access policy statement) specified in the request
+//     doesn't exist.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The client is sending more than the allowed number of requests per unit of
-//   time.
+//   - TooManyRequestsException
+//     The client is sending more than the allowed number of requests per unit of
+//     time.
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   One of the parameters in the request is invalid.
+//   - BadRequestException
+//     One of the parameters in the request is invalid.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The AWS Serverless Application Repository service encountered an internal
-//   error.
+//   - InternalServerErrorException
+//     The AWS Serverless Application Repository service encountered an internal
+//     error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   The client is not authenticated.
+//   - ForbiddenException
+//     The client is not authenticated.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/GetApplicationPolicy
 func (c *ServerlessApplicationRepository) GetApplicationPolicy(input *GetApplicationPolicyInput) (*GetApplicationPolicyOutput, error) {
@@ -685,14 +685,13 @@ const opGetCloudFormationTemplate = "GetCloudFormationTemplate"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetCloudFormationTemplateRequest method.
+//	req, resp := client.GetCloudFormationTemplateRequest(params)
 //
-//    // Example sending a request using the GetCloudFormationTemplateRequest method.
-//    req, resp := client.GetCloudFormationTemplateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/GetCloudFormationTemplate
 func (c *ServerlessApplicationRepository) GetCloudFormationTemplateRequest(input *GetCloudFormationTemplateInput) (req *request.Request, output *GetCloudFormationTemplateOutput) {
@@ -722,24 +721,25 @@ func (c *ServerlessApplicationRepository) GetCloudFormationTemplateRequest(input
 // See the AWS API reference guide for AWSServerlessApplicationRepository's
 // API operation GetCloudFormationTemplate for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The resource (for example, an access policy statement) specified in the request
-//   doesn't exist.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The resource (for example, an access policy statement) specified in the request
+//     doesn't exist.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The client is sending more than the allowed number of requests per unit of
-//   time.
+//   - TooManyRequestsException
+//     The client is sending more than the allowed number of requests per unit of
+//     time.
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   One of the parameters in the request is invalid.
+//   - BadRequestException
+//     One of the parameters in the request is invalid.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The AWS Serverless Application Repository service encountered an internal
-//   error.
+//   - InternalServerErrorException
+//     The AWS Serverless Application Repository service encountered an internal
+//     error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   The client is not authenticated.
+//   - ForbiddenException
+//     The client is not authenticated.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/GetCloudFormationTemplate
 func (c *ServerlessApplicationRepository) GetCloudFormationTemplate(input *GetCloudFormationTemplateInput) (*GetCloudFormationTemplateOutput, error) {
@@ -779,14 +779,13 @@ const opListApplicationDependencies = "ListApplicationDependencies"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListApplicationDependenciesRequest method.
+//	req, resp := client.ListApplicationDependenciesRequest(params)
 //
-//    // Example sending a request using the ListApplicationDependenciesRequest method.
-//    req, resp := client.ListApplicationDependenciesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/ListApplicationDependencies
 func (c *ServerlessApplicationRepository) ListApplicationDependenciesRequest(input *ListApplicationDependenciesInput) (req *request.Request, output *ListApplicationDependenciesOutput) {
@@ -822,24 +821,25 @@ func (c *ServerlessApplicationRepository) ListApplicationDependenciesRequest(inp
 // See the AWS API reference guide for AWSServerlessApplicationRepository's
 // API operation ListApplicationDependencies for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The resource (for example, an access policy statement) specified in the request
-//   doesn't exist.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The resource (for example, an access policy statement) specified in the request
+//     doesn't exist.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The client is sending more than the allowed number of requests per unit of
-//   time.
+//   - TooManyRequestsException
+//     The client is sending more than the allowed number of requests per unit of
+//     time.
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   One of the parameters in the request is invalid.
+//   - BadRequestException
+//     One of the parameters in the request is invalid.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The AWS Serverless Application Repository service encountered an internal
-//   error.
+//   - InternalServerErrorException
+//     The AWS Serverless Application Repository service encountered an internal
+//     error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   The client is not authenticated.
+//   - ForbiddenException
+//     The client is not authenticated.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/ListApplicationDependencies
 func (c *ServerlessApplicationRepository) ListApplicationDependencies(input *ListApplicationDependenciesInput) (*ListApplicationDependenciesOutput, error) {
@@ -871,15 +871,14 @@ func (c *ServerlessApplicationRepository) ListApplicationDependenciesWithContext
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListApplicationDependencies operation.
-//    pageNum := 0
-//    err := client.ListApplicationDependenciesPages(params,
-//        func(page *serverlessapplicationrepository.ListApplicationDependenciesOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListApplicationDependencies operation.
+//	pageNum := 0
+//	err := client.ListApplicationDependenciesPages(params,
+//	    func(page *serverlessapplicationrepository.ListApplicationDependenciesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServerlessApplicationRepository) ListApplicationDependenciesPages(input *ListApplicationDependenciesInput, fn func(*ListApplicationDependenciesOutput, bool) bool) error {
 	return c.ListApplicationDependenciesPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -906,10 +905,12 @@ func (c *ServerlessApplicationRepository) ListApplicationDependenciesPagesWithCo
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListApplicationDependenciesOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListApplicationDependenciesOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -929,14 +930,13 @@ const opListApplicationVersions = "ListApplicationVersions"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListApplicationVersionsRequest method.
+//	req, resp := client.ListApplicationVersionsRequest(params)
 //
-//    // Example sending a request using the ListApplicationVersionsRequest method.
-//    req, resp := client.ListApplicationVersionsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/ListApplicationVersions
 func (c *ServerlessApplicationRepository) ListApplicationVersionsRequest(input *ListApplicationVersionsInput) (req *request.Request, output *ListApplicationVersionsOutput) {
@@ -972,24 +972,25 @@ func (c *ServerlessApplicationRepository) ListApplicationVersionsRequest(input *
 // See the AWS API reference guide for AWSServerlessApplicationRepository's
 // API operation ListApplicationVersions for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The resource (for example, an access policy statement) specified in the request
-//   doesn't exist.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The resource (for example, an access policy statement) specified in the request
+//     doesn't exist.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The client is sending more than the allowed number of requests per unit of
-//   time.
+//   - TooManyRequestsException
+//     The client is sending more than the allowed number of requests per unit of
+//     time.
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   One of the parameters in the request is invalid.
+//   - BadRequestException
+//     One of the parameters in the request is invalid.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The AWS Serverless Application Repository service encountered an internal
-//   error.
+//   - InternalServerErrorException
+//     The AWS Serverless Application Repository service encountered an internal
+//     error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   The client is not authenticated.
+//   - ForbiddenException
+//     The client is not authenticated.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/ListApplicationVersions
 func (c *ServerlessApplicationRepository) ListApplicationVersions(input *ListApplicationVersionsInput) (*ListApplicationVersionsOutput, error) {
@@ -1021,15 +1022,14 @@ func (c *ServerlessApplicationRepository) ListApplicationVersionsWithContext(ctx
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListApplicationVersions operation.
-//    pageNum := 0
-//    err := client.ListApplicationVersionsPages(params,
-//        func(page *serverlessapplicationrepository.ListApplicationVersionsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListApplicationVersions operation.
+//	pageNum := 0
+//	err := client.ListApplicationVersionsPages(params,
+//	    func(page *serverlessapplicationrepository.ListApplicationVersionsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServerlessApplicationRepository) ListApplicationVersionsPages(input *ListApplicationVersionsInput, fn func(*ListApplicationVersionsOutput, bool) bool) error {
 	return c.ListApplicationVersionsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1056,10 +1056,12 @@ func (c *ServerlessApplicationRepository) ListApplicationVersionsPagesWithContex
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListApplicationVersionsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListApplicationVersionsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -1079,14 +1081,13 @@ const opListApplications = "ListApplications"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListApplicationsRequest method.
+//	req, resp := client.ListApplicationsRequest(params)
 //
-//    // Example sending a request using the ListApplicationsRequest method.
-//    req, resp := client.ListApplicationsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/ListApplications
 func (c *ServerlessApplicationRepository) ListApplicationsRequest(input *ListApplicationsInput) (req *request.Request, output *ListApplicationsOutput) {
@@ -1122,20 +1123,21 @@ func (c *ServerlessApplicationRepository) ListApplicationsRequest(input *ListApp
 // See the AWS API reference guide for AWSServerlessApplicationRepository's
 // API operation ListApplications for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The resource (for example, an access policy statement) specified in the request
-//   doesn't exist.
+// Returned Error Types:
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   One of the parameters in the request is invalid.
+//   - NotFoundException
+//     The resource (for example, an access policy statement) specified in the request
+//     doesn't exist.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The AWS Serverless Application Repository service encountered an internal
-//   error.
+//   - BadRequestException
+//     One of the parameters in the request is invalid.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   The client is not authenticated.
+//   - InternalServerErrorException
+//     The AWS Serverless Application Repository service encountered an internal
+//     error.
+//
+//   - ForbiddenException
+//     The client is not authenticated.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/ListApplications
 func (c *ServerlessApplicationRepository) ListApplications(input *ListApplicationsInput) (*ListApplicationsOutput, error) {
@@ -1167,15 +1169,14 @@ func (c *ServerlessApplicationRepository) ListApplicationsWithContext(ctx aws.Co
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListApplications operation.
-//    pageNum := 0
-//    err := client.ListApplicationsPages(params,
-//        func(page *serverlessapplicationrepository.ListApplicationsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListApplications operation.
+//	pageNum := 0
+//	err := client.ListApplicationsPages(params,
+//	    func(page *serverlessapplicationrepository.ListApplicationsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServerlessApplicationRepository) ListApplicationsPages(input *ListApplicationsInput, fn func(*ListApplicationsOutput, bool) bool) error {
 	return c.ListApplicationsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1202,10 +1203,12 @@ func (c *ServerlessApplicationRepository) ListApplicationsPagesWithContext(ctx a
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListApplicationsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListApplicationsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -1225,14 +1228,13 @@ const opPutApplicationPolicy = "PutApplicationPolicy"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PutApplicationPolicyRequest method.
+//	req, resp := client.PutApplicationPolicyRequest(params)
 //
-//    // Example sending a request using the PutApplicationPolicyRequest method.
-//    req, resp := client.PutApplicationPolicyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/PutApplicationPolicy
 func (c *ServerlessApplicationRepository) PutApplicationPolicyRequest(input *PutApplicationPolicyInput) (req *request.Request, output *PutApplicationPolicyOutput) {
@@ -1263,24 +1265,25 @@ func (c *ServerlessApplicationRepository) PutApplicationPolicyRequest(input *Put
 // See the AWS API reference guide for AWSServerlessApplicationRepository's
 // API operation PutApplicationPolicy for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The resource (for example, an access policy statement) specified in the request
-//   doesn't exist.
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The resource (for example, an access policy statement) specified in the request
+//     doesn't exist.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The client is sending more than the allowed number of requests per unit of
-//   time.
+//   - TooManyRequestsException
+//     The client is sending more than the allowed number of requests per unit of
+//     time.
 //
-//   * ErrCodeBadRequestException "BadRequestException"
-//   One of the parameters in the request is invalid.
+//   - BadRequestException
+//     One of the parameters in the request is invalid.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The AWS Serverless Application Repository service encountered an internal
-//   error.
+//   - InternalServerErrorException
+//     The AWS Serverless Application Repository service encountered an internal
+//     error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   The client is not authenticated.
+//   - ForbiddenException
+//     The client is not authenticated.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/PutApplicationPolicy
 func (c *ServerlessApplicationRepository) PutApplicationPolicy(input *PutApplicationPolicyInput) (*PutApplicationPolicyOutput, error) {
@@ -1304,6 +1307,103 @@ func (c *ServerlessApplicationRepository) PutApplicationPolicyWithContext(ctx aw
 	return out, req.Send()
 }
 
+const opUnshareApplication = "UnshareApplication"
+
+// UnshareApplicationRequest generates a "aws/request.Request" representing the
+// client's request for the UnshareApplication operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UnshareApplication for more information on using the UnshareApplication
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UnshareApplicationRequest method.
+//	req, resp := client.UnshareApplicationRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/UnshareApplication
+func (c *ServerlessApplicationRepository) UnshareApplicationRequest(input *UnshareApplicationInput) (req *request.Request, output *UnshareApplicationOutput) {
+	op := &request.Operation{
+		Name:       opUnshareApplication,
+		HTTPMethod: "POST",
+		HTTPPath:   "/applications/{applicationId}/unshare",
+	}
+
+	if input == nil {
+		input = &UnshareApplicationInput{}
+	}
+
+	output = &UnshareApplicationOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(restjson.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// UnshareApplication API operation for AWSServerlessApplicationRepository.
+//
+// Unshares an application from an AWS Organization.
+//
+// This operation can be called only from the organization's master account.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWSServerlessApplicationRepository's
+// API operation UnshareApplication for usage and error information.
+//
+// Returned Error Types:
+//
+//   - NotFoundException
+//     The resource (for example, an access policy statement) specified in the request
+//     doesn't exist.
+//
+//   - TooManyRequestsException
+//     The client is sending more than the allowed number of requests per unit of
+//     time.
+//
+//   - BadRequestException
+//     One of the parameters in the request is invalid.
+//
+//   - InternalServerErrorException
+//     The AWS Serverless Application Repository service encountered an internal
+//     error.
+//
+//   - ForbiddenException
+//     The client is not authenticated.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/UnshareApplication
+func (c *ServerlessApplicationRepository) UnshareApplication(input *UnshareApplicationInput) (*UnshareApplicationOutput, error) {
+	req, out := c.UnshareApplicationRequest(input)
+	return out, req.Send()
+}
+
+// UnshareApplicationWithContext is the same as UnshareApplication with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UnshareApplication for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *ServerlessApplicationRepository) UnshareApplicationWithContext(ctx aws.Context, input *UnshareApplicationInput, opts ...request.Option) (*UnshareApplicationOutput, error) {
+	req, out := c.UnshareApplicationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opUpdateApplication = "UpdateApplication"
 
 // UpdateApplicationRequest generates a "aws/request.Request" representing the
@@ -1320,14 +1420,13 @@ const opUpdateApplication = "UpdateApplication"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateApplicationRequest method.
+//	req, resp := client.UpdateApplicationRequest(params)
 //
-//    // Example sending a request using the UpdateApplicationRequest method.
-//    req, resp := client.UpdateApplicationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/UpdateApplication
 func (c *ServerlessApplicationRepository) UpdateApplicationRequest(input *UpdateApplicationRequest) (req *request.Request, output *UpdateApplicationOutput) {
@@ -1357,27 +1456,28 @@ func (c *ServerlessApplicationRepository) UpdateApplicationRequest(input *Update
 // See the AWS API reference guide for AWSServerlessApplicationRepository's
 // API operation UpdateApplication for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeBadRequestException "BadRequestException"
-//   One of the parameters in the request is invalid.
+// Returned Error Types:
+//
+//   - BadRequestException
+//     One of the parameters in the request is invalid.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The AWS Serverless Application Repository service encountered an internal
-//   error.
+//   - InternalServerErrorException
+//     The AWS Serverless Application Repository service encountered an internal
+//     error.
 //
-//   * ErrCodeForbiddenException "ForbiddenException"
-//   The client is not authenticated.
+//   - ForbiddenException
+//     The client is not authenticated.
 //
-//   * ErrCodeNotFoundException "NotFoundException"
-//   The resource (for example, an access policy statement) specified in the request
-//   doesn't exist.
+//   - NotFoundException
+//     The resource (for example, an access policy statement) specified in the request
+//     doesn't exist.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The client is sending more than the allowed number of requests per unit of
-//   time.
+//   - TooManyRequestsException
+//     The client is sending more than the allowed number of requests per unit of
+//     time.
 //
-//   * ErrCodeConflictException "ConflictException"
-//   The resource already exists.
+//   - ConflictException
+//     The resource already exists.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/serverlessrepo-2017-09-08/UpdateApplication
 func (c *ServerlessApplicationRepository) UpdateApplication(input *UpdateApplicationRequest) (*UpdateApplicationOutput, error) {
@@ -1416,12 +1516,20 @@ type ApplicationDependencySummary struct {
 	SemanticVersion *string `locationName:"semanticVersion" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ApplicationDependencySummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ApplicationDependencySummary) GoString() string {
 	return s.String()
 }
@@ -1448,6 +1556,11 @@ type ApplicationPolicyStatement struct {
 	// Actions is a required field
 	Actions []*string `locationName:"actions" type:"list" required:"true"`
 
+	// An array of PrinciplalOrgIDs, which corresponds to AWS IAM aws:PrincipalOrgID
+	// (https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_condition-keys.html#principal-org-id)
+	// global condition key.
+	PrincipalOrgIDs []*string `locationName:"principalOrgIDs" type:"list"`
+
 	// An array of AWS account IDs, or * to make the application public.
 	//
 	// Principals is a required field
@@ -1457,12 +1570,20 @@ type ApplicationPolicyStatement struct {
 	StatementId *string `locationName:"statementId" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ApplicationPolicyStatement) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ApplicationPolicyStatement) GoString() string {
 	return s.String()
 }
@@ -1489,6 +1610,12 @@ func (s *ApplicationPolicyStatement) SetActions(v []*string) *ApplicationPolicyS
 	return s
 }
 
+// SetPrincipalOrgIDs sets the PrincipalOrgIDs field's value.
+func (s *ApplicationPolicyStatement) SetPrincipalOrgIDs(v []*string) *ApplicationPolicyStatement {
+	s.PrincipalOrgIDs = v
+	return s
+}
+
 // SetPrincipals sets the Principals field's value.
 func (s *ApplicationPolicyStatement) SetPrincipals(v []*string) *ApplicationPolicyStatement {
 	s.Principals = v
@@ -1553,12 +1680,20 @@ type ApplicationSummary struct {
 	SpdxLicenseId *string `locationName:"spdxLicenseId" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ApplicationSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ApplicationSummary) GoString() string {
 	return s.String()
 }
@@ -1611,6 +1746,142 @@ func (s *ApplicationSummary) SetSpdxLicenseId(v string) *ApplicationSummary {
 	return s
 }
 
+// One of the parameters in the request is invalid.
+type BadRequestException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// 400
+	ErrorCode *string `locationName:"errorCode" type:"string"`
+
+	// One of the parameters in the request is invalid.
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BadRequestException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BadRequestException) GoString() string {
+	return s.String()
+}
+
+func newErrorBadRequestException(v protocol.ResponseMetadata) error {
+	return &BadRequestException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *BadRequestException) Code() string {
+	return "BadRequestException"
+}
+
+// Message returns the exception's message.
+func (s *BadRequestException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *BadRequestException) OrigErr() error {
+	return nil
+}
+
+func (s *BadRequestException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *BadRequestException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *BadRequestException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The resource already exists.
+type ConflictException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// 409
+	ErrorCode *string `locationName:"errorCode" type:"string"`
+
+	// The resource already exists.
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConflictException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ConflictException) GoString() string {
+	return s.String()
+}
+
+func newErrorConflictException(v protocol.ResponseMetadata) error {
+	return &ConflictException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ConflictException) Code() string {
+	return "ConflictException"
+}
+
+// Message returns the exception's message.
+func (s *ConflictException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ConflictException) OrigErr() error {
+	return nil
+}
+
+func (s *ConflictException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ConflictException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ConflictException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 type CreateApplicationOutput struct {
 	_ struct{} `type:"structure"`
 
@@ -1624,6 +1895,8 @@ type CreateApplicationOutput struct {
 
 	HomePageUrl *string `locationName:"homePageUrl" type:"string"`
 
+	IsVerifiedAuthor *bool `locationName:"isVerifiedAuthor" type:"boolean"`
+
 	Labels []*string `locationName:"labels" type:"list"`
 
 	LicenseUrl *string `locationName:"licenseUrl" type:"string"`
@@ -1634,16 +1907,26 @@ type CreateApplicationOutput struct {
 
 	SpdxLicenseId *string `locationName:"spdxLicenseId" type:"string"`
 
+	VerifiedAuthorUrl *string `locationName:"verifiedAuthorUrl" type:"string"`
+
 	// Application version details.
 	Version *Version `locationName:"version" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateApplicationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateApplicationOutput) GoString() string {
 	return s.String()
 }
@@ -1678,6 +1961,12 @@ func (s *CreateApplicationOutput) SetHomePageUrl(v string) *CreateApplicationOut
 	return s
 }
 
+// SetIsVerifiedAuthor sets the IsVerifiedAuthor field's value.
+func (s *CreateApplicationOutput) SetIsVerifiedAuthor(v bool) *CreateApplicationOutput {
+	s.IsVerifiedAuthor = &v
+	return s
+}
+
 // SetLabels sets the Labels field's value.
 func (s *CreateApplicationOutput) SetLabels(v []*string) *CreateApplicationOutput {
 	s.Labels = v
@@ -1708,6 +1997,12 @@ func (s *CreateApplicationOutput) SetSpdxLicenseId(v string) *CreateApplicationO
 	return s
 }
 
+// SetVerifiedAuthorUrl sets the VerifiedAuthorUrl field's value.
+func (s *CreateApplicationOutput) SetVerifiedAuthorUrl(v string) *CreateApplicationOutput {
+	s.VerifiedAuthorUrl = &v
+	return s
+}
+
 // SetVersion sets the Version field's value.
 func (s *CreateApplicationOutput) SetVersion(v *Version) *CreateApplicationOutput {
 	s.Version = v
@@ -1751,12 +2046,20 @@ type CreateApplicationRequest struct {
 	TemplateUrl *string `locationName:"templateUrl" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateApplicationRequest) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateApplicationRequest) GoString() string {
 	return s.String()
 }
@@ -1879,7 +2182,7 @@ type CreateApplicationVersionOutput struct {
 
 	ParameterDefinitions []*ParameterDefinition `locationName:"parameterDefinitions" type:"list"`
 
-	RequiredCapabilities []*string `locationName:"requiredCapabilities" type:"list"`
+	RequiredCapabilities []*string `locationName:"requiredCapabilities" type:"list" enum:"Capability"`
 
 	ResourcesSupported *bool `locationName:"resourcesSupported" type:"boolean"`
 
@@ -1892,12 +2195,20 @@ type CreateApplicationVersionOutput struct {
 	TemplateUrl *string `locationName:"templateUrl" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateApplicationVersionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateApplicationVersionOutput) GoString() string {
 	return s.String()
 }
@@ -1974,12 +2285,20 @@ type CreateApplicationVersionRequest struct {
 	TemplateUrl *string `locationName:"templateUrl" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateApplicationVersionRequest) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateApplicationVersionRequest) GoString() string {
 	return s.String()
 }
@@ -2054,12 +2373,20 @@ type CreateCloudFormationChangeSetOutput struct {
 	StackId *string `locationName:"stackId" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateCloudFormationChangeSetOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateCloudFormationChangeSetOutput) GoString() string {
 	return s.String()
 }
@@ -2123,12 +2450,20 @@ type CreateCloudFormationChangeSetRequest struct {
 	TemplateId *string `locationName:"templateId" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateCloudFormationChangeSetRequest) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateCloudFormationChangeSetRequest) GoString() string {
 	return s.String()
 }
@@ -2264,12 +2599,20 @@ type CreateCloudFormationTemplateInput struct {
 	SemanticVersion *string `locationName:"semanticVersion" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateCloudFormationTemplateInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateCloudFormationTemplateInput) GoString() string {
 	return s.String()
 }
@@ -2320,12 +2663,20 @@ type CreateCloudFormationTemplateOutput struct {
 	TemplateUrl *string `locationName:"templateUrl" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateCloudFormationTemplateOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateCloudFormationTemplateOutput) GoString() string {
 	return s.String()
 }
@@ -2373,18 +2724,26 @@ func (s *CreateCloudFormationTemplateOutput) SetTemplateUrl(v string) *CreateClo
 }
 
 type DeleteApplicationInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// ApplicationId is a required field
 	ApplicationId *string `location:"uri" locationName:"applicationId" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteApplicationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteApplicationInput) GoString() string {
 	return s.String()
 }
@@ -2415,18 +2774,94 @@ type DeleteApplicationOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteApplicationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteApplicationOutput) GoString() string {
 	return s.String()
 }
 
+// The client is not authenticated.
+type ForbiddenException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// 403
+	ErrorCode *string `locationName:"errorCode" type:"string"`
+
+	// The client is not authenticated.
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ForbiddenException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ForbiddenException) GoString() string {
+	return s.String()
+}
+
+func newErrorForbiddenException(v protocol.ResponseMetadata) error {
+	return &ForbiddenException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ForbiddenException) Code() string {
+	return "ForbiddenException"
+}
+
+// Message returns the exception's message.
+func (s *ForbiddenException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ForbiddenException) OrigErr() error {
+	return nil
+}
+
+func (s *ForbiddenException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ForbiddenException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ForbiddenException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 type GetApplicationInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// ApplicationId is a required field
 	ApplicationId *string `location:"uri" locationName:"applicationId" type:"string" required:"true"`
@@ -2434,12 +2869,20 @@ type GetApplicationInput struct {
 	SemanticVersion *string `location:"querystring" locationName:"semanticVersion" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetApplicationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetApplicationInput) GoString() string {
 	return s.String()
 }
@@ -2485,6 +2928,8 @@ type GetApplicationOutput struct {
 
 	HomePageUrl *string `locationName:"homePageUrl" type:"string"`
 
+	IsVerifiedAuthor *bool `locationName:"isVerifiedAuthor" type:"boolean"`
+
 	Labels []*string `locationName:"labels" type:"list"`
 
 	LicenseUrl *string `locationName:"licenseUrl" type:"string"`
@@ -2495,16 +2940,26 @@ type GetApplicationOutput struct {
 
 	SpdxLicenseId *string `locationName:"spdxLicenseId" type:"string"`
 
+	VerifiedAuthorUrl *string `locationName:"verifiedAuthorUrl" type:"string"`
+
 	// Application version details.
 	Version *Version `locationName:"version" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetApplicationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetApplicationOutput) GoString() string {
 	return s.String()
 }
@@ -2539,6 +2994,12 @@ func (s *GetApplicationOutput) SetHomePageUrl(v string) *GetApplicationOutput {
 	return s
 }
 
+// SetIsVerifiedAuthor sets the IsVerifiedAuthor field's value.
+func (s *GetApplicationOutput) SetIsVerifiedAuthor(v bool) *GetApplicationOutput {
+	s.IsVerifiedAuthor = &v
+	return s
+}
+
 // SetLabels sets the Labels field's value.
 func (s *GetApplicationOutput) SetLabels(v []*string) *GetApplicationOutput {
 	s.Labels = v
@@ -2569,6 +3030,12 @@ func (s *GetApplicationOutput) SetSpdxLicenseId(v string) *GetApplicationOutput
 	return s
 }
 
+// SetVerifiedAuthorUrl sets the VerifiedAuthorUrl field's value.
+func (s *GetApplicationOutput) SetVerifiedAuthorUrl(v string) *GetApplicationOutput {
+	s.VerifiedAuthorUrl = &v
+	return s
+}
+
 // SetVersion sets the Version field's value.
 func (s *GetApplicationOutput) SetVersion(v *Version) *GetApplicationOutput {
 	s.Version = v
@@ -2576,18 +3043,26 @@ func (s *GetApplicationOutput) SetVersion(v *Version) *GetApplicationOutput {
 }
 
 type GetApplicationPolicyInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// ApplicationId is a required field
 	ApplicationId *string `location:"uri" locationName:"applicationId" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetApplicationPolicyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetApplicationPolicyInput) GoString() string {
 	return s.String()
 }
@@ -2620,12 +3095,20 @@ type GetApplicationPolicyOutput struct {
 	Statements []*ApplicationPolicyStatement `locationName:"statements" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetApplicationPolicyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetApplicationPolicyOutput) GoString() string {
 	return s.String()
 }
@@ -2637,7 +3120,7 @@ func (s *GetApplicationPolicyOutput) SetStatements(v []*ApplicationPolicyStateme
 }
 
 type GetCloudFormationTemplateInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// ApplicationId is a required field
 	ApplicationId *string `location:"uri" locationName:"applicationId" type:"string" required:"true"`
@@ -2646,12 +3129,20 @@ type GetCloudFormationTemplateInput struct {
 	TemplateId *string `location:"uri" locationName:"templateId" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCloudFormationTemplateInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCloudFormationTemplateInput) GoString() string {
 	return s.String()
 }
@@ -2708,12 +3199,20 @@ type GetCloudFormationTemplateOutput struct {
 	TemplateUrl *string `locationName:"templateUrl" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCloudFormationTemplateOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCloudFormationTemplateOutput) GoString() string {
 	return s.String()
 }
@@ -2760,8 +3259,78 @@ func (s *GetCloudFormationTemplateOutput) SetTemplateUrl(v string) *GetCloudForm
 	return s
 }
 
+// The AWS Serverless Application Repository service encountered an internal
+// error.
+type InternalServerErrorException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// 500
+	ErrorCode *string `locationName:"errorCode" type:"string"`
+
+	// The AWS Serverless Application Repository service encountered an internal
+	// error.
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InternalServerErrorException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InternalServerErrorException) GoString() string {
+	return s.String()
+}
+
+func newErrorInternalServerErrorException(v protocol.ResponseMetadata) error {
+	return &InternalServerErrorException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InternalServerErrorException) Code() string {
+	return "InternalServerErrorException"
+}
+
+// Message returns the exception's message.
+func (s *InternalServerErrorException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InternalServerErrorException) OrigErr() error {
+	return nil
+}
+
+func (s *InternalServerErrorException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InternalServerErrorException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InternalServerErrorException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 type ListApplicationDependenciesInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// ApplicationId is a required field
 	ApplicationId *string `location:"uri" locationName:"applicationId" type:"string" required:"true"`
@@ -2773,12 +3342,20 @@ type ListApplicationDependenciesInput struct {
 	SemanticVersion *string `location:"querystring" locationName:"semanticVersion" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListApplicationDependenciesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListApplicationDependenciesInput) GoString() string {
 	return s.String()
 }
@@ -2834,12 +3411,20 @@ type ListApplicationDependenciesOutput struct {
 	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListApplicationDependenciesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListApplicationDependenciesOutput) GoString() string {
 	return s.String()
 }
@@ -2857,7 +3442,7 @@ func (s *ListApplicationDependenciesOutput) SetNextToken(v string) *ListApplicat
 }
 
 type ListApplicationVersionsInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	// ApplicationId is a required field
 	ApplicationId *string `location:"uri" locationName:"applicationId" type:"string" required:"true"`
@@ -2867,12 +3452,20 @@ type ListApplicationVersionsInput struct {
 	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListApplicationVersionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListApplicationVersionsInput) GoString() string {
 	return s.String()
 }
@@ -2922,12 +3515,20 @@ type ListApplicationVersionsOutput struct {
 	Versions []*VersionSummary `locationName:"versions" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListApplicationVersionsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListApplicationVersionsOutput) GoString() string {
 	return s.String()
 }
@@ -2945,19 +3546,27 @@ func (s *ListApplicationVersionsOutput) SetVersions(v []*VersionSummary) *ListAp
 }
 
 type ListApplicationsInput struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" nopayload:"true"`
 
 	MaxItems *int64 `location:"querystring" locationName:"maxItems" min:"1" type:"integer"`
 
 	NextToken *string `location:"querystring" locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListApplicationsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListApplicationsInput) GoString() string {
 	return s.String()
 }
@@ -2995,12 +3604,20 @@ type ListApplicationsOutput struct {
 	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListApplicationsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListApplicationsOutput) GoString() string {
 	return s.String()
 }
@@ -3017,6 +3634,76 @@ func (s *ListApplicationsOutput) SetNextToken(v string) *ListApplicationsOutput
 	return s
 }
 
+// The resource (for example, an access policy statement) specified in the request
+// doesn't exist.
+type NotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// 404
+	ErrorCode *string `locationName:"errorCode" type:"string"`
+
+	// The resource (for example, an access policy statement) specified in the request
+	// doesn't exist.
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorNotFoundException(v protocol.ResponseMetadata) error {
+	return &NotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *NotFoundException) Code() string {
+	return "NotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *NotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *NotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *NotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *NotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *NotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Parameters supported by the application.
 type ParameterDefinition struct {
 	_ struct{} `type:"structure"`
@@ -3112,12 +3799,20 @@ type ParameterDefinition struct {
 	Type *string `locationName:"type" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ParameterDefinition) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ParameterDefinition) GoString() string {
 	return s.String()
 }
@@ -3217,12 +3912,20 @@ type ParameterValue struct {
 	Value *string `locationName:"value" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ParameterValue) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ParameterValue) GoString() string {
 	return s.String()
 }
@@ -3265,12 +3968,20 @@ type PutApplicationPolicyInput struct {
 	Statements []*ApplicationPolicyStatement `locationName:"statements" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutApplicationPolicyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutApplicationPolicyInput) GoString() string {
 	return s.String()
 }
@@ -3322,12 +4033,20 @@ type PutApplicationPolicyOutput struct {
 	Statements []*ApplicationPolicyStatement `locationName:"statements" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutApplicationPolicyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutApplicationPolicyOutput) GoString() string {
 	return s.String()
 }
@@ -3355,12 +4074,20 @@ type RollbackConfiguration struct {
 	RollbackTriggers []*RollbackTrigger `locationName:"rollbackTriggers" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RollbackConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RollbackConfiguration) GoString() string {
 	return s.String()
 }
@@ -3417,12 +4144,20 @@ type RollbackTrigger struct {
 	Type *string `locationName:"type" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RollbackTrigger) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RollbackTrigger) GoString() string {
 	return s.String()
 }
@@ -3475,12 +4210,20 @@ type Tag struct {
 	Value *string `locationName:"value" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) GoString() string {
 	return s.String()
 }
@@ -3513,6 +4256,157 @@ func (s *Tag) SetValue(v string) *Tag {
 	return s
 }
 
+// The client is sending more than the allowed number of requests per unit of
+// time.
+type TooManyRequestsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	// 429
+	ErrorCode *string `locationName:"errorCode" type:"string"`
+
+	// The client is sending more than the allowed number of requests per unit of
+	// time.
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyRequestsException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyRequestsException) GoString() string {
+	return s.String()
+}
+
+func newErrorTooManyRequestsException(v protocol.ResponseMetadata) error {
+	return &TooManyRequestsException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *TooManyRequestsException) Code() string {
+	return "TooManyRequestsException"
+}
+
+// Message returns the exception's message.
+func (s *TooManyRequestsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *TooManyRequestsException) OrigErr() error {
+	return nil
+}
+
+func (s *TooManyRequestsException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *TooManyRequestsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *TooManyRequestsException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type UnshareApplicationInput struct {
+	_ struct{} `type:"structure"`
+
+	// ApplicationId is a required field
+	ApplicationId *string `location:"uri" locationName:"applicationId" type:"string" required:"true"`
+
+	// OrganizationId is a required field
+	OrganizationId *string `locationName:"organizationId" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UnshareApplicationInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UnshareApplicationInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *UnshareApplicationInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UnshareApplicationInput"}
+	if s.ApplicationId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ApplicationId"))
+	}
+	if s.ApplicationId != nil && len(*s.ApplicationId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ApplicationId", 1))
+	}
+	if s.OrganizationId == nil {
+		invalidParams.Add(request.NewErrParamRequired("OrganizationId"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetApplicationId sets the ApplicationId field's value.
+func (s *UnshareApplicationInput) SetApplicationId(v string) *UnshareApplicationInput {
+	s.ApplicationId = &v
+	return s
+}
+
+// SetOrganizationId sets the OrganizationId field's value.
+func (s *UnshareApplicationInput) SetOrganizationId(v string) *UnshareApplicationInput {
+	s.OrganizationId = &v
+	return s
+}
+
+type UnshareApplicationOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UnshareApplicationOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UnshareApplicationOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateApplicationOutput struct {
 	_ struct{} `type:"structure"`
 
@@ -3526,6 +4420,8 @@ type UpdateApplicationOutput struct {
 
 	HomePageUrl *string `locationName:"homePageUrl" type:"string"`
 
+	IsVerifiedAuthor *bool `locationName:"isVerifiedAuthor" type:"boolean"`
+
 	Labels []*string `locationName:"labels" type:"list"`
 
 	LicenseUrl *string `locationName:"licenseUrl" type:"string"`
@@ -3536,16 +4432,26 @@ type UpdateApplicationOutput struct {
 
 	SpdxLicenseId *string `locationName:"spdxLicenseId" type:"string"`
 
+	VerifiedAuthorUrl *string `locationName:"verifiedAuthorUrl" type:"string"`
+
 	// Application version details.
 	Version *Version `locationName:"version" type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateApplicationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateApplicationOutput) GoString() string {
 	return s.String()
 }
@@ -3580,6 +4486,12 @@ func (s *UpdateApplicationOutput) SetHomePageUrl(v string) *UpdateApplicationOut
 	return s
 }
 
+// SetIsVerifiedAuthor sets the IsVerifiedAuthor field's value.
+func (s *UpdateApplicationOutput) SetIsVerifiedAuthor(v bool) *UpdateApplicationOutput {
+	s.IsVerifiedAuthor = &v
+	return s
+}
+
 // SetLabels sets the Labels field's value.
 func (s *UpdateApplicationOutput) SetLabels(v []*string) *UpdateApplicationOutput {
 	s.Labels = v
@@ -3610,6 +4522,12 @@ func (s *UpdateApplicationOutput) SetSpdxLicenseId(v string) *UpdateApplicationO
 	return s
 }
 
+// SetVerifiedAuthorUrl sets the VerifiedAuthorUrl field's value.
+func (s *UpdateApplicationOutput) SetVerifiedAuthorUrl(v string) *UpdateApplicationOutput {
+	s.VerifiedAuthorUrl = &v
+	return s
+}
+
 // SetVersion sets the Version field's value.
 func (s *UpdateApplicationOutput) SetVersion(v *Version) *UpdateApplicationOutput {
 	s.Version = v
@@ -3635,12 +4553,20 @@ type UpdateApplicationRequest struct {
 	ReadmeUrl *string `locationName:"readmeUrl" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateApplicationRequest) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateApplicationRequest) GoString() string {
 	return s.String()
 }
@@ -3757,7 +4683,7 @@ type Version struct {
 	// the call will fail.
 	//
 	// RequiredCapabilities is a required field
-	RequiredCapabilities []*string `locationName:"requiredCapabilities" type:"list" required:"true"`
+	RequiredCapabilities []*string `locationName:"requiredCapabilities" type:"list" required:"true" enum:"Capability"`
 
 	// Whether all of the AWS resources contained in this application are supported
 	// in the region in which it is being retrieved.
@@ -3788,12 +4714,20 @@ type Version struct {
 	TemplateUrl *string `locationName:"templateUrl" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Version) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Version) GoString() string {
 	return s.String()
 }
@@ -3878,12 +4812,20 @@ type VersionSummary struct {
 	SourceCodeUrl *string `locationName:"sourceCodeUrl" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VersionSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VersionSummary) GoString() string {
 	return s.String()
 }
@@ -3927,6 +4869,16 @@ const (
 	CapabilityCapabilityResourcePolicy = "CAPABILITY_RESOURCE_POLICY"
 )
 
+// Capability_Values returns all elements of the Capability enum
+func Capability_Values() []string {
+	return []string{
+		CapabilityCapabilityIam,
+		CapabilityCapabilityNamedIam,
+		CapabilityCapabilityAutoExpand,
+		CapabilityCapabilityResourcePolicy,
+	}
+}
+
 const (
 	// StatusPreparing is a Status enum value
 	StatusPreparing = "PREPARING"
@@ -3937,3 +4889,12 @@ const (
 	// StatusExpired is a Status enum value
 	StatusExpired = "EXPIRED"
 )
+
+// Status_Values returns all elements of the Status enum
+func Status_Values() []string {
+	return []string{
+		StatusPreparing,
+		StatusActive,
+		StatusExpired,
+	}
+}
@@ -29,14 +29,13 @@ const opAcceptPortfolioShare = "AcceptPortfolioShare"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AcceptPortfolioShareRequest method.
+//	req, resp := client.AcceptPortfolioShareRequest(params)
 //
-//    // Example sending a request using the AcceptPortfolioShareRequest method.
-//    req, resp := client.AcceptPortfolioShareRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/AcceptPortfolioShare
 func (c *ServiceCatalog) AcceptPortfolioShareRequest(input *AcceptPortfolioShareInput) (req *request.Request, output *AcceptPortfolioShareOutput) {
@@ -67,17 +66,18 @@ func (c *ServiceCatalog) AcceptPortfolioShareRequest(input *AcceptPortfolioShare
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation AcceptPortfolioShare for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The current limits of the service would have been exceeded by this operation.
-//   Decrease your resource use or increase your service limits and retry the
-//   operation.
+//   - LimitExceededException
+//     The current limits of the service would have been exceeded by this operation.
+//     Decrease your resource use or increase your service limits and retry the
+//     operation.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/AcceptPortfolioShare
 func (c *ServiceCatalog) AcceptPortfolioShare(input *AcceptPortfolioShareInput) (*AcceptPortfolioShareOutput, error) {
@@ -117,14 +117,13 @@ const opAssociateBudgetWithResource = "AssociateBudgetWithResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AssociateBudgetWithResourceRequest method.
+//	req, resp := client.AssociateBudgetWithResourceRequest(params)
 //
-//    // Example sending a request using the AssociateBudgetWithResourceRequest method.
-//    req, resp := client.AssociateBudgetWithResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/AssociateBudgetWithResource
 func (c *ServiceCatalog) AssociateBudgetWithResourceRequest(input *AssociateBudgetWithResourceInput) (req *request.Request, output *AssociateBudgetWithResourceOutput) {
@@ -155,20 +154,21 @@ func (c *ServiceCatalog) AssociateBudgetWithResourceRequest(input *AssociateBudg
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation AssociateBudgetWithResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-//   * ErrCodeDuplicateResourceException "DuplicateResourceException"
-//   The specified resource is a duplicate.
+//   - DuplicateResourceException
+//     The specified resource is a duplicate.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The current limits of the service would have been exceeded by this operation.
-//   Decrease your resource use or increase your service limits and retry the
-//   operation.
+//   - LimitExceededException
+//     The current limits of the service would have been exceeded by this operation.
+//     Decrease your resource use or increase your service limits and retry the
+//     operation.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/AssociateBudgetWithResource
 func (c *ServiceCatalog) AssociateBudgetWithResource(input *AssociateBudgetWithResourceInput) (*AssociateBudgetWithResourceOutput, error) {
@@ -208,14 +208,13 @@ const opAssociatePrincipalWithPortfolio = "AssociatePrincipalWithPortfolio"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AssociatePrincipalWithPortfolioRequest method.
+//	req, resp := client.AssociatePrincipalWithPortfolioRequest(params)
 //
-//    // Example sending a request using the AssociatePrincipalWithPortfolioRequest method.
-//    req, resp := client.AssociatePrincipalWithPortfolioRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/AssociatePrincipalWithPortfolio
 func (c *ServiceCatalog) AssociatePrincipalWithPortfolioRequest(input *AssociatePrincipalWithPortfolioInput) (req *request.Request, output *AssociatePrincipalWithPortfolioOutput) {
@@ -239,6 +238,25 @@ func (c *ServiceCatalog) AssociatePrincipalWithPortfolioRequest(input *Associate
 //
 // Associates the specified principal ARN with the specified portfolio.
 //
+// If you share the portfolio with principal name sharing enabled, the PrincipalARN
+// association is included in the share.
+//
+// The PortfolioID, PrincipalARN, and PrincipalType parameters are required.
+//
+// You can associate a maximum of 10 Principals with a portfolio using PrincipalType
+// as IAM_PATTERN.
+//
+// When you associate a principal with portfolio, a potential privilege escalation
+// path may occur when that portfolio is then shared with other accounts. For
+// a user in a recipient account who is not an Service Catalog Admin, but still
+// has the ability to create Principals (Users/Groups/Roles), that user could
+// create a role that matches a principal name association for the portfolio.
+// Although this user may not know which principal names are associated through
+// Service Catalog, they may be able to guess the user. If this potential escalation
+// path is a concern, then Service Catalog recommends using PrincipalType as
+// IAM. With this configuration, the PrincipalARN must already exist in the
+// recipient account before it can be associated.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -246,17 +264,18 @@ func (c *ServiceCatalog) AssociatePrincipalWithPortfolioRequest(input *Associate
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation AssociatePrincipalWithPortfolio for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The current limits of the service would have been exceeded by this operation.
-//   Decrease your resource use or increase your service limits and retry the
-//   operation.
+//   - LimitExceededException
+//     The current limits of the service would have been exceeded by this operation.
+//     Decrease your resource use or increase your service limits and retry the
+//     operation.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/AssociatePrincipalWithPortfolio
 func (c *ServiceCatalog) AssociatePrincipalWithPortfolio(input *AssociatePrincipalWithPortfolioInput) (*AssociatePrincipalWithPortfolioOutput, error) {
@@ -296,14 +315,13 @@ const opAssociateProductWithPortfolio = "AssociateProductWithPortfolio"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AssociateProductWithPortfolioRequest method.
+//	req, resp := client.AssociateProductWithPortfolioRequest(params)
 //
-//    // Example sending a request using the AssociateProductWithPortfolioRequest method.
-//    req, resp := client.AssociateProductWithPortfolioRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/AssociateProductWithPortfolio
 func (c *ServiceCatalog) AssociateProductWithPortfolioRequest(input *AssociateProductWithPortfolioInput) (req *request.Request, output *AssociateProductWithPortfolioOutput) {
@@ -327,6 +345,8 @@ func (c *ServiceCatalog) AssociateProductWithPortfolioRequest(input *AssociatePr
 //
 // Associates the specified product with the specified portfolio.
 //
+// A delegated admin is authorized to invoke this command.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -334,17 +354,18 @@ func (c *ServiceCatalog) AssociateProductWithPortfolioRequest(input *AssociatePr
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation AssociateProductWithPortfolio for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The current limits of the service would have been exceeded by this operation.
-//   Decrease your resource use or increase your service limits and retry the
-//   operation.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
+//
+//   - LimitExceededException
+//     The current limits of the service would have been exceeded by this operation.
+//     Decrease your resource use or increase your service limits and retry the
+//     operation.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/AssociateProductWithPortfolio
 func (c *ServiceCatalog) AssociateProductWithPortfolio(input *AssociateProductWithPortfolioInput) (*AssociateProductWithPortfolioOutput, error) {
@@ -384,14 +405,13 @@ const opAssociateServiceActionWithProvisioningArtifact = "AssociateServiceAction
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AssociateServiceActionWithProvisioningArtifactRequest method.
+//	req, resp := client.AssociateServiceActionWithProvisioningArtifactRequest(params)
 //
-//    // Example sending a request using the AssociateServiceActionWithProvisioningArtifactRequest method.
-//    req, resp := client.AssociateServiceActionWithProvisioningArtifactRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/AssociateServiceActionWithProvisioningArtifact
 func (c *ServiceCatalog) AssociateServiceActionWithProvisioningArtifactRequest(input *AssociateServiceActionWithProvisioningArtifactInput) (req *request.Request, output *AssociateServiceActionWithProvisioningArtifactOutput) {
@@ -422,17 +442,21 @@ func (c *ServiceCatalog) AssociateServiceActionWithProvisioningArtifactRequest(i
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation AssociateServiceActionWithProvisioningArtifact for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
+//
+//   - DuplicateResourceException
+//     The specified resource is a duplicate.
 //
-//   * ErrCodeDuplicateResourceException "DuplicateResourceException"
-//   The specified resource is a duplicate.
+//   - LimitExceededException
+//     The current limits of the service would have been exceeded by this operation.
+//     Decrease your resource use or increase your service limits and retry the
+//     operation.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The current limits of the service would have been exceeded by this operation.
-//   Decrease your resource use or increase your service limits and retry the
-//   operation.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/AssociateServiceActionWithProvisioningArtifact
 func (c *ServiceCatalog) AssociateServiceActionWithProvisioningArtifact(input *AssociateServiceActionWithProvisioningArtifactInput) (*AssociateServiceActionWithProvisioningArtifactOutput, error) {
@@ -472,14 +496,13 @@ const opAssociateTagOptionWithResource = "AssociateTagOptionWithResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AssociateTagOptionWithResourceRequest method.
+//	req, resp := client.AssociateTagOptionWithResourceRequest(params)
 //
-//    // Example sending a request using the AssociateTagOptionWithResourceRequest method.
-//    req, resp := client.AssociateTagOptionWithResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/AssociateTagOptionWithResource
 func (c *ServiceCatalog) AssociateTagOptionWithResourceRequest(input *AssociateTagOptionWithResourceInput) (req *request.Request, output *AssociateTagOptionWithResourceOutput) {
@@ -510,30 +533,31 @@ func (c *ServiceCatalog) AssociateTagOptionWithResourceRequest(input *AssociateT
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation AssociateTagOptionWithResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeTagOptionNotMigratedException "TagOptionNotMigratedException"
-//   An operation requiring TagOptions failed because the TagOptions migration
-//   process has not been performed for this account. Please use the AWS console
-//   to perform the migration process before retrying the operation.
+// Returned Error Types:
+//
+//   - TagOptionNotMigratedException
+//     An operation requiring TagOptions failed because the TagOptions migration
+//     process has not been performed for this account. Use the Amazon Web Services
+//     Management Console to perform the migration process before retrying the operation.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The current limits of the service would have been exceeded by this operation.
-//   Decrease your resource use or increase your service limits and retry the
-//   operation.
+//   - LimitExceededException
+//     The current limits of the service would have been exceeded by this operation.
+//     Decrease your resource use or increase your service limits and retry the
+//     operation.
 //
-//   * ErrCodeDuplicateResourceException "DuplicateResourceException"
-//   The specified resource is a duplicate.
+//   - DuplicateResourceException
+//     The specified resource is a duplicate.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   An attempt was made to modify a resource that is in a state that is not valid.
-//   Check your resources to ensure that they are in valid states before retrying
-//   the operation.
+//   - InvalidStateException
+//     An attempt was made to modify a resource that is in a state that is not valid.
+//     Check your resources to ensure that they are in valid states before retrying
+//     the operation.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/AssociateTagOptionWithResource
 func (c *ServiceCatalog) AssociateTagOptionWithResource(input *AssociateTagOptionWithResourceInput) (*AssociateTagOptionWithResourceOutput, error) {
@@ -573,14 +597,13 @@ const opBatchAssociateServiceActionWithProvisioningArtifact = "BatchAssociateSer
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the BatchAssociateServiceActionWithProvisioningArtifactRequest method.
+//	req, resp := client.BatchAssociateServiceActionWithProvisioningArtifactRequest(params)
 //
-//    // Example sending a request using the BatchAssociateServiceActionWithProvisioningArtifactRequest method.
-//    req, resp := client.BatchAssociateServiceActionWithProvisioningArtifactRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/BatchAssociateServiceActionWithProvisioningArtifact
 func (c *ServiceCatalog) BatchAssociateServiceActionWithProvisioningArtifactRequest(input *BatchAssociateServiceActionWithProvisioningArtifactInput) (req *request.Request, output *BatchAssociateServiceActionWithProvisioningArtifactOutput) {
@@ -610,9 +633,9 @@ func (c *ServiceCatalog) BatchAssociateServiceActionWithProvisioningArtifactRequ
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation BatchAssociateServiceActionWithProvisioningArtifact for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/BatchAssociateServiceActionWithProvisioningArtifact
 func (c *ServiceCatalog) BatchAssociateServiceActionWithProvisioningArtifact(input *BatchAssociateServiceActionWithProvisioningArtifactInput) (*BatchAssociateServiceActionWithProvisioningArtifactOutput, error) {
@@ -652,14 +675,13 @@ const opBatchDisassociateServiceActionFromProvisioningArtifact = "BatchDisassoci
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the BatchDisassociateServiceActionFromProvisioningArtifactRequest method.
+//	req, resp := client.BatchDisassociateServiceActionFromProvisioningArtifactRequest(params)
 //
-//    // Example sending a request using the BatchDisassociateServiceActionFromProvisioningArtifactRequest method.
-//    req, resp := client.BatchDisassociateServiceActionFromProvisioningArtifactRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/BatchDisassociateServiceActionFromProvisioningArtifact
 func (c *ServiceCatalog) BatchDisassociateServiceActionFromProvisioningArtifactRequest(input *BatchDisassociateServiceActionFromProvisioningArtifactInput) (req *request.Request, output *BatchDisassociateServiceActionFromProvisioningArtifactOutput) {
@@ -690,9 +712,9 @@ func (c *ServiceCatalog) BatchDisassociateServiceActionFromProvisioningArtifactR
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation BatchDisassociateServiceActionFromProvisioningArtifact for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/BatchDisassociateServiceActionFromProvisioningArtifact
 func (c *ServiceCatalog) BatchDisassociateServiceActionFromProvisioningArtifact(input *BatchDisassociateServiceActionFromProvisioningArtifactInput) (*BatchDisassociateServiceActionFromProvisioningArtifactOutput, error) {
@@ -732,14 +754,13 @@ const opCopyProduct = "CopyProduct"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CopyProductRequest method.
+//	req, resp := client.CopyProductRequest(params)
 //
-//    // Example sending a request using the CopyProductRequest method.
-//    req, resp := client.CopyProductRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/CopyProduct
 func (c *ServiceCatalog) CopyProductRequest(input *CopyProductInput) (req *request.Request, output *CopyProductOutput) {
@@ -764,7 +785,8 @@ func (c *ServiceCatalog) CopyProductRequest(input *CopyProductInput) (req *reque
 // new product.
 //
 // You can copy a product to the same account or another account. You can copy
-// a product to the same region or another region.
+// a product to the same Region or another Region. If you copy a product to
+// another account, you must first share the product in a portfolio using CreatePortfolioShare.
 //
 // This operation is performed asynchronously. To track the progress of the
 // operation, use DescribeCopyProductStatus.
@@ -776,12 +798,13 @@ func (c *ServiceCatalog) CopyProductRequest(input *CopyProductInput) (req *reque
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation CopyProduct for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/CopyProduct
 func (c *ServiceCatalog) CopyProduct(input *CopyProductInput) (*CopyProductOutput, error) {
@@ -821,14 +844,13 @@ const opCreateConstraint = "CreateConstraint"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateConstraintRequest method.
+//	req, resp := client.CreateConstraintRequest(params)
 //
-//    // Example sending a request using the CreateConstraintRequest method.
-//    req, resp := client.CreateConstraintRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/CreateConstraint
 func (c *ServiceCatalog) CreateConstraintRequest(input *CreateConstraintInput) (req *request.Request, output *CreateConstraintOutput) {
@@ -851,6 +873,8 @@ func (c *ServiceCatalog) CreateConstraintRequest(input *CreateConstraintInput) (
 //
 // Creates a constraint.
 //
+// A delegated admin is authorized to invoke this command.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -858,20 +882,21 @@ func (c *ServiceCatalog) CreateConstraintRequest(input *CreateConstraintInput) (
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation CreateConstraint for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The current limits of the service would have been exceeded by this operation.
-//   Decrease your resource use or increase your service limits and retry the
-//   operation.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-//   * ErrCodeDuplicateResourceException "DuplicateResourceException"
-//   The specified resource is a duplicate.
+//   - LimitExceededException
+//     The current limits of the service would have been exceeded by this operation.
+//     Decrease your resource use or increase your service limits and retry the
+//     operation.
+//
+//   - DuplicateResourceException
+//     The specified resource is a duplicate.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/CreateConstraint
 func (c *ServiceCatalog) CreateConstraint(input *CreateConstraintInput) (*CreateConstraintOutput, error) {
@@ -911,14 +936,13 @@ const opCreatePortfolio = "CreatePortfolio"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreatePortfolioRequest method.
+//	req, resp := client.CreatePortfolioRequest(params)
 //
-//    // Example sending a request using the CreatePortfolioRequest method.
-//    req, resp := client.CreatePortfolioRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/CreatePortfolio
 func (c *ServiceCatalog) CreatePortfolioRequest(input *CreatePortfolioInput) (req *request.Request, output *CreatePortfolioOutput) {
@@ -941,6 +965,8 @@ func (c *ServiceCatalog) CreatePortfolioRequest(input *CreatePortfolioInput) (re
 //
 // Creates a portfolio.
 //
+// A delegated admin is authorized to invoke this command.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -948,19 +974,20 @@ func (c *ServiceCatalog) CreatePortfolioRequest(input *CreatePortfolioInput) (re
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation CreatePortfolio for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The current limits of the service would have been exceeded by this operation.
-//   Decrease your resource use or increase your service limits and retry the
-//   operation.
+//   - LimitExceededException
+//     The current limits of the service would have been exceeded by this operation.
+//     Decrease your resource use or increase your service limits and retry the
+//     operation.
 //
-//   * ErrCodeTagOptionNotMigratedException "TagOptionNotMigratedException"
-//   An operation requiring TagOptions failed because the TagOptions migration
-//   process has not been performed for this account. Please use the AWS console
-//   to perform the migration process before retrying the operation.
+//   - TagOptionNotMigratedException
+//     An operation requiring TagOptions failed because the TagOptions migration
+//     process has not been performed for this account. Use the Amazon Web Services
+//     Management Console to perform the migration process before retrying the operation.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/CreatePortfolio
 func (c *ServiceCatalog) CreatePortfolio(input *CreatePortfolioInput) (*CreatePortfolioOutput, error) {
@@ -1000,14 +1027,13 @@ const opCreatePortfolioShare = "CreatePortfolioShare"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreatePortfolioShareRequest method.
+//	req, resp := client.CreatePortfolioShareRequest(params)
 //
-//    // Example sending a request using the CreatePortfolioShareRequest method.
-//    req, resp := client.CreatePortfolioShareRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/CreatePortfolioShare
 func (c *ServiceCatalog) CreatePortfolioShareRequest(input *CreatePortfolioShareInput) (req *request.Request, output *CreatePortfolioShareOutput) {
@@ -1029,9 +1055,33 @@ func (c *ServiceCatalog) CreatePortfolioShareRequest(input *CreatePortfolioShare
 // CreatePortfolioShare API operation for AWS Service Catalog.
 //
 // Shares the specified portfolio with the specified account or organization
-// node. Shares to an organization node can only be created by the master account
-// of an Organization. AWSOrganizationsAccess must be enabled in order to create
-// a portfolio share to an organization node.
+// node. Shares to an organization node can only be created by the management
+// account of an organization or by a delegated administrator. You can share
+// portfolios to an organization, an organizational unit, or a specific account.
+//
+// Note that if a delegated admin is de-registered, they can no longer create
+// portfolio shares.
+//
+// AWSOrganizationsAccess must be enabled in order to create a portfolio share
+// to an organization node.
+//
+// You can't share a shared resource, including portfolios that contain a shared
+// product.
+//
+// If the portfolio share with the specified account or organization node already
+// exists, this action will have no effect and will not return an error. To
+// update an existing share, you must use the UpdatePortfolioShare API instead.
+//
+// When you associate a principal with portfolio, a potential privilege escalation
+// path may occur when that portfolio is then shared with other accounts. For
+// a user in a recipient account who is not an Service Catalog Admin, but still
+// has the ability to create Principals (Users/Groups/Roles), that user could
+// create a role that matches a principal name association for the portfolio.
+// Although this user may not know which principal names are associated through
+// Service Catalog, they may be able to guess the user. If this potential escalation
+// path is a concern, then Service Catalog recommends using PrincipalType as
+// IAM. With this configuration, the PrincipalARN must already exist in the
+// recipient account before it can be associated.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1040,25 +1090,26 @@ func (c *ServiceCatalog) CreatePortfolioShareRequest(input *CreatePortfolioShare
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation CreatePortfolioShare for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The current limits of the service would have been exceeded by this operation.
-//   Decrease your resource use or increase your service limits and retry the
-//   operation.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - LimitExceededException
+//     The current limits of the service would have been exceeded by this operation.
+//     Decrease your resource use or increase your service limits and retry the
+//     operation.
 //
-//   * ErrCodeOperationNotSupportedException "OperationNotSupportedException"
-//   The operation is not supported.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   An attempt was made to modify a resource that is in a state that is not valid.
-//   Check your resources to ensure that they are in valid states before retrying
-//   the operation.
+//   - OperationNotSupportedException
+//     The operation is not supported.
+//
+//   - InvalidStateException
+//     An attempt was made to modify a resource that is in a state that is not valid.
+//     Check your resources to ensure that they are in valid states before retrying
+//     the operation.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/CreatePortfolioShare
 func (c *ServiceCatalog) CreatePortfolioShare(input *CreatePortfolioShareInput) (*CreatePortfolioShareOutput, error) {
@@ -1098,14 +1149,13 @@ const opCreateProduct = "CreateProduct"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateProductRequest method.
+//	req, resp := client.CreateProductRequest(params)
 //
-//    // Example sending a request using the CreateProductRequest method.
-//    req, resp := client.CreateProductRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/CreateProduct
 func (c *ServiceCatalog) CreateProductRequest(input *CreateProductInput) (req *request.Request, output *CreateProductOutput) {
@@ -1128,6 +1178,12 @@ func (c *ServiceCatalog) CreateProductRequest(input *CreateProductInput) (req *r
 //
 // Creates a product.
 //
+// A delegated admin is authorized to invoke this command.
+//
+// The user or role that performs this operation must have the cloudformation:GetTemplate
+// IAM policy permission. This policy permission is required when using the
+// ImportFromPhysicalId template source in the information data section.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -1135,19 +1191,20 @@ func (c *ServiceCatalog) CreateProductRequest(input *CreateProductInput) (req *r
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation CreateProduct for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The current limits of the service would have been exceeded by this operation.
-//   Decrease your resource use or increase your service limits and retry the
-//   operation.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-//   * ErrCodeTagOptionNotMigratedException "TagOptionNotMigratedException"
-//   An operation requiring TagOptions failed because the TagOptions migration
-//   process has not been performed for this account. Please use the AWS console
-//   to perform the migration process before retrying the operation.
+//   - LimitExceededException
+//     The current limits of the service would have been exceeded by this operation.
+//     Decrease your resource use or increase your service limits and retry the
+//     operation.
+//
+//   - TagOptionNotMigratedException
+//     An operation requiring TagOptions failed because the TagOptions migration
+//     process has not been performed for this account. Use the Amazon Web Services
+//     Management Console to perform the migration process before retrying the operation.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/CreateProduct
 func (c *ServiceCatalog) CreateProduct(input *CreateProductInput) (*CreateProductOutput, error) {
@@ -1187,14 +1244,13 @@ const opCreateProvisionedProductPlan = "CreateProvisionedProductPlan"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateProvisionedProductPlanRequest method.
+//	req, resp := client.CreateProvisionedProductPlanRequest(params)
 //
-//    // Example sending a request using the CreateProvisionedProductPlanRequest method.
-//    req, resp := client.CreateProvisionedProductPlanRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/CreateProvisionedProductPlan
 func (c *ServiceCatalog) CreateProvisionedProductPlanRequest(input *CreateProvisionedProductPlanInput) (req *request.Request, output *CreateProvisionedProductPlanOutput) {
@@ -1215,12 +1271,15 @@ func (c *ServiceCatalog) CreateProvisionedProductPlanRequest(input *CreateProvis
 
 // CreateProvisionedProductPlan API operation for AWS Service Catalog.
 //
-// Creates a plan. A plan includes the list of resources to be created (when
-// provisioning a new product) or modified (when updating a provisioned product)
-// when the plan is executed.
+// Creates a plan.
 //
-// You can create one plan per provisioned product. To create a plan for an
-// existing provisioned product, the product status must be AVAILBLE or TAINTED.
+// A plan includes the list of resources to be created (when provisioning a
+// new product) or modified (when updating a provisioned product) when the plan
+// is executed.
+//
+// You can create one plan for each provisioned product. To create a plan for
+// an existing provisioned product, the product status must be AVAILABLE or
+// TAINTED.
 //
 // To view the resource changes in the change set, use DescribeProvisionedProductPlan.
 // To create or modify the provisioned product, use ExecuteProvisionedProductPlan.
@@ -1232,17 +1291,18 @@ func (c *ServiceCatalog) CreateProvisionedProductPlanRequest(input *CreateProvis
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation CreateProvisionedProductPlan for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   An attempt was made to modify a resource that is in a state that is not valid.
-//   Check your resources to ensure that they are in valid states before retrying
-//   the operation.
+//   - InvalidStateException
+//     An attempt was made to modify a resource that is in a state that is not valid.
+//     Check your resources to ensure that they are in valid states before retrying
+//     the operation.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/CreateProvisionedProductPlan
 func (c *ServiceCatalog) CreateProvisionedProductPlan(input *CreateProvisionedProductPlanInput) (*CreateProvisionedProductPlanOutput, error) {
@@ -1282,14 +1342,13 @@ const opCreateProvisioningArtifact = "CreateProvisioningArtifact"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateProvisioningArtifactRequest method.
+//	req, resp := client.CreateProvisioningArtifactRequest(params)
 //
-//    // Example sending a request using the CreateProvisioningArtifactRequest method.
-//    req, resp := client.CreateProvisioningArtifactRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/CreateProvisioningArtifact
 func (c *ServiceCatalog) CreateProvisioningArtifactRequest(input *CreateProvisioningArtifactInput) (req *request.Request, output *CreateProvisioningArtifactOutput) {
@@ -1316,6 +1375,10 @@ func (c *ServiceCatalog) CreateProvisioningArtifactRequest(input *CreateProvisio
 // You cannot create a provisioning artifact for a product that was shared with
 // you.
 //
+// The user or role that performs this operation must have the cloudformation:GetTemplate
+// IAM policy permission. This policy permission is required when using the
+// ImportFromPhysicalId template source in the information data section.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -1323,17 +1386,18 @@ func (c *ServiceCatalog) CreateProvisioningArtifactRequest(input *CreateProvisio
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation CreateProvisioningArtifact for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The current limits of the service would have been exceeded by this operation.
-//   Decrease your resource use or increase your service limits and retry the
-//   operation.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
+//
+//   - LimitExceededException
+//     The current limits of the service would have been exceeded by this operation.
+//     Decrease your resource use or increase your service limits and retry the
+//     operation.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/CreateProvisioningArtifact
 func (c *ServiceCatalog) CreateProvisioningArtifact(input *CreateProvisioningArtifactInput) (*CreateProvisioningArtifactOutput, error) {
@@ -1373,14 +1437,13 @@ const opCreateServiceAction = "CreateServiceAction"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateServiceActionRequest method.
+//	req, resp := client.CreateServiceActionRequest(params)
 //
-//    // Example sending a request using the CreateServiceActionRequest method.
-//    req, resp := client.CreateServiceActionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/CreateServiceAction
 func (c *ServiceCatalog) CreateServiceActionRequest(input *CreateServiceActionInput) (req *request.Request, output *CreateServiceActionOutput) {
@@ -1410,14 +1473,15 @@ func (c *ServiceCatalog) CreateServiceActionRequest(input *CreateServiceActionIn
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation CreateServiceAction for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The current limits of the service would have been exceeded by this operation.
-//   Decrease your resource use or increase your service limits and retry the
-//   operation.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
+//
+//   - LimitExceededException
+//     The current limits of the service would have been exceeded by this operation.
+//     Decrease your resource use or increase your service limits and retry the
+//     operation.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/CreateServiceAction
 func (c *ServiceCatalog) CreateServiceAction(input *CreateServiceActionInput) (*CreateServiceActionOutput, error) {
@@ -1457,14 +1521,13 @@ const opCreateTagOption = "CreateTagOption"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateTagOptionRequest method.
+//	req, resp := client.CreateTagOptionRequest(params)
 //
-//    // Example sending a request using the CreateTagOptionRequest method.
-//    req, resp := client.CreateTagOptionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/CreateTagOption
 func (c *ServiceCatalog) CreateTagOptionRequest(input *CreateTagOptionInput) (req *request.Request, output *CreateTagOptionOutput) {
@@ -1494,19 +1557,20 @@ func (c *ServiceCatalog) CreateTagOptionRequest(input *CreateTagOptionInput) (re
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation CreateTagOption for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeTagOptionNotMigratedException "TagOptionNotMigratedException"
-//   An operation requiring TagOptions failed because the TagOptions migration
-//   process has not been performed for this account. Please use the AWS console
-//   to perform the migration process before retrying the operation.
+// Returned Error Types:
 //
-//   * ErrCodeDuplicateResourceException "DuplicateResourceException"
-//   The specified resource is a duplicate.
+//   - TagOptionNotMigratedException
+//     An operation requiring TagOptions failed because the TagOptions migration
+//     process has not been performed for this account. Use the Amazon Web Services
+//     Management Console to perform the migration process before retrying the operation.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The current limits of the service would have been exceeded by this operation.
-//   Decrease your resource use or increase your service limits and retry the
-//   operation.
+//   - DuplicateResourceException
+//     The specified resource is a duplicate.
+//
+//   - LimitExceededException
+//     The current limits of the service would have been exceeded by this operation.
+//     Decrease your resource use or increase your service limits and retry the
+//     operation.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/CreateTagOption
 func (c *ServiceCatalog) CreateTagOption(input *CreateTagOptionInput) (*CreateTagOptionOutput, error) {
@@ -1546,14 +1610,13 @@ const opDeleteConstraint = "DeleteConstraint"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteConstraintRequest method.
+//	req, resp := client.DeleteConstraintRequest(params)
 //
-//    // Example sending a request using the DeleteConstraintRequest method.
-//    req, resp := client.DeleteConstraintRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DeleteConstraint
 func (c *ServiceCatalog) DeleteConstraintRequest(input *DeleteConstraintInput) (req *request.Request, output *DeleteConstraintOutput) {
@@ -1577,6 +1640,8 @@ func (c *ServiceCatalog) DeleteConstraintRequest(input *DeleteConstraintInput) (
 //
 // Deletes the specified constraint.
 //
+// A delegated admin is authorized to invoke this command.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -1584,12 +1649,13 @@ func (c *ServiceCatalog) DeleteConstraintRequest(input *DeleteConstraintInput) (
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DeleteConstraint for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DeleteConstraint
 func (c *ServiceCatalog) DeleteConstraint(input *DeleteConstraintInput) (*DeleteConstraintOutput, error) {
@@ -1629,14 +1695,13 @@ const opDeletePortfolio = "DeletePortfolio"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeletePortfolioRequest method.
+//	req, resp := client.DeletePortfolioRequest(params)
 //
-//    // Example sending a request using the DeletePortfolioRequest method.
-//    req, resp := client.DeletePortfolioRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DeletePortfolio
 func (c *ServiceCatalog) DeletePortfolioRequest(input *DeletePortfolioInput) (req *request.Request, output *DeletePortfolioOutput) {
@@ -1663,6 +1728,8 @@ func (c *ServiceCatalog) DeletePortfolioRequest(input *DeletePortfolioInput) (re
 // You cannot delete a portfolio if it was shared with you or if it has associated
 // products, users, constraints, or shared accounts.
 //
+// A delegated admin is authorized to invoke this command.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -1670,21 +1737,22 @@ func (c *ServiceCatalog) DeletePortfolioRequest(input *DeletePortfolioInput) (re
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DeletePortfolio for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeResourceInUseException "ResourceInUseException"
-//   A resource that is currently in use. Ensure that the resource is not in use
-//   and retry the operation.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-//   * ErrCodeTagOptionNotMigratedException "TagOptionNotMigratedException"
-//   An operation requiring TagOptions failed because the TagOptions migration
-//   process has not been performed for this account. Please use the AWS console
-//   to perform the migration process before retrying the operation.
+//   - ResourceInUseException
+//     A resource that is currently in use. Ensure that the resource is not in use
+//     and retry the operation.
+//
+//   - TagOptionNotMigratedException
+//     An operation requiring TagOptions failed because the TagOptions migration
+//     process has not been performed for this account. Use the Amazon Web Services
+//     Management Console to perform the migration process before retrying the operation.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DeletePortfolio
 func (c *ServiceCatalog) DeletePortfolio(input *DeletePortfolioInput) (*DeletePortfolioOutput, error) {
@@ -1724,14 +1792,13 @@ const opDeletePortfolioShare = "DeletePortfolioShare"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeletePortfolioShareRequest method.
+//	req, resp := client.DeletePortfolioShareRequest(params)
 //
-//    // Example sending a request using the DeletePortfolioShareRequest method.
-//    req, resp := client.DeletePortfolioShareRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DeletePortfolioShare
 func (c *ServiceCatalog) DeletePortfolioShareRequest(input *DeletePortfolioShareInput) (req *request.Request, output *DeletePortfolioShareOutput) {
@@ -1753,8 +1820,11 @@ func (c *ServiceCatalog) DeletePortfolioShareRequest(input *DeletePortfolioShare
 // DeletePortfolioShare API operation for AWS Service Catalog.
 //
 // Stops sharing the specified portfolio with the specified account or organization
-// node. Shares to an organization node can only be deleted by the master account
-// of an Organization.
+// node. Shares to an organization node can only be deleted by the management
+// account of an organization or by a delegated administrator.
+//
+// Note that if a delegated admin is de-registered, portfolio shares created
+// from that account are removed.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1763,20 +1833,21 @@ func (c *ServiceCatalog) DeletePortfolioShareRequest(input *DeletePortfolioShare
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DeletePortfolioShare for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-//   * ErrCodeOperationNotSupportedException "OperationNotSupportedException"
-//   The operation is not supported.
+//   - OperationNotSupportedException
+//     The operation is not supported.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   An attempt was made to modify a resource that is in a state that is not valid.
-//   Check your resources to ensure that they are in valid states before retrying
-//   the operation.
+//   - InvalidStateException
+//     An attempt was made to modify a resource that is in a state that is not valid.
+//     Check your resources to ensure that they are in valid states before retrying
+//     the operation.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DeletePortfolioShare
 func (c *ServiceCatalog) DeletePortfolioShare(input *DeletePortfolioShareInput) (*DeletePortfolioShareOutput, error) {
@@ -1816,14 +1887,13 @@ const opDeleteProduct = "DeleteProduct"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteProductRequest method.
+//	req, resp := client.DeleteProductRequest(params)
 //
-//    // Example sending a request using the DeleteProductRequest method.
-//    req, resp := client.DeleteProductRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DeleteProduct
 func (c *ServiceCatalog) DeleteProductRequest(input *DeleteProductInput) (req *request.Request, output *DeleteProductOutput) {
@@ -1850,6 +1920,8 @@ func (c *ServiceCatalog) DeleteProductRequest(input *DeleteProductInput) (req *r
 // You cannot delete a product if it was shared with you or is associated with
 // a portfolio.
 //
+// A delegated admin is authorized to invoke this command.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -1857,21 +1929,22 @@ func (c *ServiceCatalog) DeleteProductRequest(input *DeleteProductInput) (req *r
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DeleteProduct for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
 //
-//   * ErrCodeResourceInUseException "ResourceInUseException"
-//   A resource that is currently in use. Ensure that the resource is not in use
-//   and retry the operation.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - ResourceInUseException
+//     A resource that is currently in use. Ensure that the resource is not in use
+//     and retry the operation.
 //
-//   * ErrCodeTagOptionNotMigratedException "TagOptionNotMigratedException"
-//   An operation requiring TagOptions failed because the TagOptions migration
-//   process has not been performed for this account. Please use the AWS console
-//   to perform the migration process before retrying the operation.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
+//
+//   - TagOptionNotMigratedException
+//     An operation requiring TagOptions failed because the TagOptions migration
+//     process has not been performed for this account. Use the Amazon Web Services
+//     Management Console to perform the migration process before retrying the operation.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DeleteProduct
 func (c *ServiceCatalog) DeleteProduct(input *DeleteProductInput) (*DeleteProductOutput, error) {
@@ -1911,14 +1984,13 @@ const opDeleteProvisionedProductPlan = "DeleteProvisionedProductPlan"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteProvisionedProductPlanRequest method.
+//	req, resp := client.DeleteProvisionedProductPlanRequest(params)
 //
-//    // Example sending a request using the DeleteProvisionedProductPlanRequest method.
-//    req, resp := client.DeleteProvisionedProductPlanRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DeleteProvisionedProductPlan
 func (c *ServiceCatalog) DeleteProvisionedProductPlanRequest(input *DeleteProvisionedProductPlanInput) (req *request.Request, output *DeleteProvisionedProductPlanOutput) {
@@ -1949,12 +2021,13 @@ func (c *ServiceCatalog) DeleteProvisionedProductPlanRequest(input *DeleteProvis
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DeleteProvisionedProductPlan for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DeleteProvisionedProductPlan
 func (c *ServiceCatalog) DeleteProvisionedProductPlan(input *DeleteProvisionedProductPlanInput) (*DeleteProvisionedProductPlanOutput, error) {
@@ -1994,14 +2067,13 @@ const opDeleteProvisioningArtifact = "DeleteProvisioningArtifact"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteProvisioningArtifactRequest method.
+//	req, resp := client.DeleteProvisioningArtifactRequest(params)
 //
-//    // Example sending a request using the DeleteProvisioningArtifactRequest method.
-//    req, resp := client.DeleteProvisioningArtifactRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DeleteProvisioningArtifact
 func (c *ServiceCatalog) DeleteProvisioningArtifactRequest(input *DeleteProvisioningArtifactInput) (req *request.Request, output *DeleteProvisioningArtifactOutput) {
@@ -2037,16 +2109,17 @@ func (c *ServiceCatalog) DeleteProvisioningArtifactRequest(input *DeleteProvisio
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DeleteProvisioningArtifact for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
 //
-//   * ErrCodeResourceInUseException "ResourceInUseException"
-//   A resource that is currently in use. Ensure that the resource is not in use
-//   and retry the operation.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - ResourceInUseException
+//     A resource that is currently in use. Ensure that the resource is not in use
+//     and retry the operation.
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DeleteProvisioningArtifact
 func (c *ServiceCatalog) DeleteProvisioningArtifact(input *DeleteProvisioningArtifactInput) (*DeleteProvisioningArtifactOutput, error) {
@@ -2086,14 +2159,13 @@ const opDeleteServiceAction = "DeleteServiceAction"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteServiceActionRequest method.
+//	req, resp := client.DeleteServiceActionRequest(params)
 //
-//    // Example sending a request using the DeleteServiceActionRequest method.
-//    req, resp := client.DeleteServiceActionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DeleteServiceAction
 func (c *ServiceCatalog) DeleteServiceActionRequest(input *DeleteServiceActionInput) (req *request.Request, output *DeleteServiceActionOutput) {
@@ -2124,13 +2196,14 @@ func (c *ServiceCatalog) DeleteServiceActionRequest(input *DeleteServiceActionIn
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DeleteServiceAction for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
 //
-//   * ErrCodeResourceInUseException "ResourceInUseException"
-//   A resource that is currently in use. Ensure that the resource is not in use
-//   and retry the operation.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
+//
+//   - ResourceInUseException
+//     A resource that is currently in use. Ensure that the resource is not in use
+//     and retry the operation.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DeleteServiceAction
 func (c *ServiceCatalog) DeleteServiceAction(input *DeleteServiceActionInput) (*DeleteServiceActionOutput, error) {
@@ -2170,14 +2243,13 @@ const opDeleteTagOption = "DeleteTagOption"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteTagOptionRequest method.
+//	req, resp := client.DeleteTagOptionRequest(params)
 //
-//    // Example sending a request using the DeleteTagOptionRequest method.
-//    req, resp := client.DeleteTagOptionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DeleteTagOption
 func (c *ServiceCatalog) DeleteTagOptionRequest(input *DeleteTagOptionInput) (req *request.Request, output *DeleteTagOptionOutput) {
@@ -2210,18 +2282,19 @@ func (c *ServiceCatalog) DeleteTagOptionRequest(input *DeleteTagOptionInput) (re
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DeleteTagOption for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeTagOptionNotMigratedException "TagOptionNotMigratedException"
-//   An operation requiring TagOptions failed because the TagOptions migration
-//   process has not been performed for this account. Please use the AWS console
-//   to perform the migration process before retrying the operation.
+// Returned Error Types:
 //
-//   * ErrCodeResourceInUseException "ResourceInUseException"
-//   A resource that is currently in use. Ensure that the resource is not in use
-//   and retry the operation.
+//   - TagOptionNotMigratedException
+//     An operation requiring TagOptions failed because the TagOptions migration
+//     process has not been performed for this account. Use the Amazon Web Services
+//     Management Console to perform the migration process before retrying the operation.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - ResourceInUseException
+//     A resource that is currently in use. Ensure that the resource is not in use
+//     and retry the operation.
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DeleteTagOption
 func (c *ServiceCatalog) DeleteTagOption(input *DeleteTagOptionInput) (*DeleteTagOptionOutput, error) {
@@ -2261,14 +2334,13 @@ const opDescribeConstraint = "DescribeConstraint"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeConstraintRequest method.
+//	req, resp := client.DescribeConstraintRequest(params)
 //
-//    // Example sending a request using the DescribeConstraintRequest method.
-//    req, resp := client.DescribeConstraintRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeConstraint
 func (c *ServiceCatalog) DescribeConstraintRequest(input *DescribeConstraintInput) (req *request.Request, output *DescribeConstraintOutput) {
@@ -2298,9 +2370,9 @@ func (c *ServiceCatalog) DescribeConstraintRequest(input *DescribeConstraintInpu
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DescribeConstraint for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeConstraint
 func (c *ServiceCatalog) DescribeConstraint(input *DescribeConstraintInput) (*DescribeConstraintOutput, error) {
@@ -2340,14 +2412,13 @@ const opDescribeCopyProductStatus = "DescribeCopyProductStatus"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeCopyProductStatusRequest method.
+//	req, resp := client.DescribeCopyProductStatusRequest(params)
 //
-//    // Example sending a request using the DescribeCopyProductStatusRequest method.
-//    req, resp := client.DescribeCopyProductStatusRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeCopyProductStatus
 func (c *ServiceCatalog) DescribeCopyProductStatusRequest(input *DescribeCopyProductStatusInput) (req *request.Request, output *DescribeCopyProductStatusOutput) {
@@ -2377,9 +2448,9 @@ func (c *ServiceCatalog) DescribeCopyProductStatusRequest(input *DescribeCopyPro
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DescribeCopyProductStatus for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeCopyProductStatus
 func (c *ServiceCatalog) DescribeCopyProductStatus(input *DescribeCopyProductStatusInput) (*DescribeCopyProductStatusOutput, error) {
@@ -2419,14 +2490,13 @@ const opDescribePortfolio = "DescribePortfolio"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribePortfolioRequest method.
+//	req, resp := client.DescribePortfolioRequest(params)
 //
-//    // Example sending a request using the DescribePortfolioRequest method.
-//    req, resp := client.DescribePortfolioRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribePortfolio
 func (c *ServiceCatalog) DescribePortfolioRequest(input *DescribePortfolioInput) (req *request.Request, output *DescribePortfolioOutput) {
@@ -2449,6 +2519,8 @@ func (c *ServiceCatalog) DescribePortfolioRequest(input *DescribePortfolioInput)
 //
 // Gets information about the specified portfolio.
 //
+// A delegated admin is authorized to invoke this command.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -2456,9 +2528,9 @@ func (c *ServiceCatalog) DescribePortfolioRequest(input *DescribePortfolioInput)
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DescribePortfolio for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribePortfolio
 func (c *ServiceCatalog) DescribePortfolio(input *DescribePortfolioInput) (*DescribePortfolioOutput, error) {
@@ -2498,14 +2570,13 @@ const opDescribePortfolioShareStatus = "DescribePortfolioShareStatus"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribePortfolioShareStatusRequest method.
+//	req, resp := client.DescribePortfolioShareStatusRequest(params)
 //
-//    // Example sending a request using the DescribePortfolioShareStatusRequest method.
-//    req, resp := client.DescribePortfolioShareStatusRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribePortfolioShareStatus
 func (c *ServiceCatalog) DescribePortfolioShareStatusRequest(input *DescribePortfolioShareStatusInput) (req *request.Request, output *DescribePortfolioShareStatusOutput) {
@@ -2527,7 +2598,8 @@ func (c *ServiceCatalog) DescribePortfolioShareStatusRequest(input *DescribePort
 // DescribePortfolioShareStatus API operation for AWS Service Catalog.
 //
 // Gets the status of the specified portfolio share operation. This API can
-// only be called by the master account in the organization.
+// only be called by the management account in the organization or by a delegated
+// admin.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2536,15 +2608,16 @@ func (c *ServiceCatalog) DescribePortfolioShareStatusRequest(input *DescribePort
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DescribePortfolioShareStatus for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeOperationNotSupportedException "OperationNotSupportedException"
-//   The operation is not supported.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
+//
+//   - OperationNotSupportedException
+//     The operation is not supported.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribePortfolioShareStatus
 func (c *ServiceCatalog) DescribePortfolioShareStatus(input *DescribePortfolioShareStatusInput) (*DescribePortfolioShareStatusOutput, error) {
@@ -2568,6 +2641,152 @@ func (c *ServiceCatalog) DescribePortfolioShareStatusWithContext(ctx aws.Context
 	return out, req.Send()
 }
 
+const opDescribePortfolioShares = "DescribePortfolioShares"
+
+// DescribePortfolioSharesRequest generates a "aws/request.Request" representing the
+// client's request for the DescribePortfolioShares operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See DescribePortfolioShares for more information on using the DescribePortfolioShares
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the DescribePortfolioSharesRequest method.
+//	req, resp := client.DescribePortfolioSharesRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribePortfolioShares
+func (c *ServiceCatalog) DescribePortfolioSharesRequest(input *DescribePortfolioSharesInput) (req *request.Request, output *DescribePortfolioSharesOutput) {
+	op := &request.Operation{
+		Name:       opDescribePortfolioShares,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"PageToken"},
+			OutputTokens:    []string{"NextPageToken"},
+			LimitToken:      "PageSize",
+			TruncationToken: "",
+		},
+	}
+
+	if input == nil {
+		input = &DescribePortfolioSharesInput{}
+	}
+
+	output = &DescribePortfolioSharesOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// DescribePortfolioShares API operation for AWS Service Catalog.
+//
+// Returns a summary of each of the portfolio shares that were created for the
+// specified portfolio.
+//
+// You can use this API to determine which accounts or organizational nodes
+// this portfolio have been shared, whether the recipient entity has imported
+// the share, and whether TagOptions are included with the share.
+//
+// The PortfolioId and Type parameters are both required.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Service Catalog's
+// API operation DescribePortfolioShares for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribePortfolioShares
+func (c *ServiceCatalog) DescribePortfolioShares(input *DescribePortfolioSharesInput) (*DescribePortfolioSharesOutput, error) {
+	req, out := c.DescribePortfolioSharesRequest(input)
+	return out, req.Send()
+}
+
+// DescribePortfolioSharesWithContext is the same as DescribePortfolioShares with the addition of
+// the ability to pass a context and additional request options.
+//
+// See DescribePortfolioShares for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *ServiceCatalog) DescribePortfolioSharesWithContext(ctx aws.Context, input *DescribePortfolioSharesInput, opts ...request.Option) (*DescribePortfolioSharesOutput, error) {
+	req, out := c.DescribePortfolioSharesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+// DescribePortfolioSharesPages iterates over the pages of a DescribePortfolioShares operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See DescribePortfolioShares method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a DescribePortfolioShares operation.
+//	pageNum := 0
+//	err := client.DescribePortfolioSharesPages(params,
+//	    func(page *servicecatalog.DescribePortfolioSharesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *ServiceCatalog) DescribePortfolioSharesPages(input *DescribePortfolioSharesInput, fn func(*DescribePortfolioSharesOutput, bool) bool) error {
+	return c.DescribePortfolioSharesPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// DescribePortfolioSharesPagesWithContext same as DescribePortfolioSharesPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *ServiceCatalog) DescribePortfolioSharesPagesWithContext(ctx aws.Context, input *DescribePortfolioSharesInput, fn func(*DescribePortfolioSharesOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *DescribePortfolioSharesInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.DescribePortfolioSharesRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*DescribePortfolioSharesOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
 const opDescribeProduct = "DescribeProduct"
 
 // DescribeProductRequest generates a "aws/request.Request" representing the
@@ -2584,14 +2803,13 @@ const opDescribeProduct = "DescribeProduct"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeProductRequest method.
+//	req, resp := client.DescribeProductRequest(params)
 //
-//    // Example sending a request using the DescribeProductRequest method.
-//    req, resp := client.DescribeProductRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeProduct
 func (c *ServiceCatalog) DescribeProductRequest(input *DescribeProductInput) (req *request.Request, output *DescribeProductOutput) {
@@ -2614,6 +2832,9 @@ func (c *ServiceCatalog) DescribeProductRequest(input *DescribeProductInput) (re
 //
 // Gets information about the specified product.
 //
+// Running this operation with administrator access results in a failure. DescribeProductAsAdmin
+// should be used instead.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -2621,12 +2842,13 @@ func (c *ServiceCatalog) DescribeProductRequest(input *DescribeProductInput) (re
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DescribeProduct for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeProduct
 func (c *ServiceCatalog) DescribeProduct(input *DescribeProductInput) (*DescribeProductOutput, error) {
@@ -2666,14 +2888,13 @@ const opDescribeProductAsAdmin = "DescribeProductAsAdmin"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeProductAsAdminRequest method.
+//	req, resp := client.DescribeProductAsAdminRequest(params)
 //
-//    // Example sending a request using the DescribeProductAsAdminRequest method.
-//    req, resp := client.DescribeProductAsAdminRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeProductAsAdmin
 func (c *ServiceCatalog) DescribeProductAsAdminRequest(input *DescribeProductAsAdminInput) (req *request.Request, output *DescribeProductAsAdminOutput) {
@@ -2704,9 +2925,13 @@ func (c *ServiceCatalog) DescribeProductAsAdminRequest(input *DescribeProductAsA
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DescribeProductAsAdmin for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeProductAsAdmin
 func (c *ServiceCatalog) DescribeProductAsAdmin(input *DescribeProductAsAdminInput) (*DescribeProductAsAdminOutput, error) {
@@ -2746,14 +2971,13 @@ const opDescribeProductView = "DescribeProductView"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeProductViewRequest method.
+//	req, resp := client.DescribeProductViewRequest(params)
 //
-//    // Example sending a request using the DescribeProductViewRequest method.
-//    req, resp := client.DescribeProductViewRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeProductView
 func (c *ServiceCatalog) DescribeProductViewRequest(input *DescribeProductViewInput) (req *request.Request, output *DescribeProductViewOutput) {
@@ -2783,12 +3007,13 @@ func (c *ServiceCatalog) DescribeProductViewRequest(input *DescribeProductViewIn
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DescribeProductView for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeProductView
 func (c *ServiceCatalog) DescribeProductView(input *DescribeProductViewInput) (*DescribeProductViewOutput, error) {
@@ -2828,14 +3053,13 @@ const opDescribeProvisionedProduct = "DescribeProvisionedProduct"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeProvisionedProductRequest method.
+//	req, resp := client.DescribeProvisionedProductRequest(params)
 //
-//    // Example sending a request using the DescribeProvisionedProductRequest method.
-//    req, resp := client.DescribeProvisionedProductRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeProvisionedProduct
 func (c *ServiceCatalog) DescribeProvisionedProductRequest(input *DescribeProvisionedProductInput) (req *request.Request, output *DescribeProvisionedProductOutput) {
@@ -2865,9 +3089,13 @@ func (c *ServiceCatalog) DescribeProvisionedProductRequest(input *DescribeProvis
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DescribeProvisionedProduct for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeProvisionedProduct
 func (c *ServiceCatalog) DescribeProvisionedProduct(input *DescribeProvisionedProductInput) (*DescribeProvisionedProductOutput, error) {
@@ -2907,14 +3135,13 @@ const opDescribeProvisionedProductPlan = "DescribeProvisionedProductPlan"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeProvisionedProductPlanRequest method.
+//	req, resp := client.DescribeProvisionedProductPlanRequest(params)
 //
-//    // Example sending a request using the DescribeProvisionedProductPlanRequest method.
-//    req, resp := client.DescribeProvisionedProductPlanRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeProvisionedProductPlan
 func (c *ServiceCatalog) DescribeProvisionedProductPlanRequest(input *DescribeProvisionedProductPlanInput) (req *request.Request, output *DescribeProvisionedProductPlanOutput) {
@@ -2944,12 +3171,13 @@ func (c *ServiceCatalog) DescribeProvisionedProductPlanRequest(input *DescribePr
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DescribeProvisionedProductPlan for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeProvisionedProductPlan
 func (c *ServiceCatalog) DescribeProvisionedProductPlan(input *DescribeProvisionedProductPlanInput) (*DescribeProvisionedProductPlanOutput, error) {
@@ -2989,14 +3217,13 @@ const opDescribeProvisioningArtifact = "DescribeProvisioningArtifact"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeProvisioningArtifactRequest method.
+//	req, resp := client.DescribeProvisioningArtifactRequest(params)
 //
-//    // Example sending a request using the DescribeProvisioningArtifactRequest method.
-//    req, resp := client.DescribeProvisioningArtifactRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeProvisioningArtifact
 func (c *ServiceCatalog) DescribeProvisioningArtifactRequest(input *DescribeProvisioningArtifactInput) (req *request.Request, output *DescribeProvisioningArtifactOutput) {
@@ -3027,9 +3254,13 @@ func (c *ServiceCatalog) DescribeProvisioningArtifactRequest(input *DescribeProv
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DescribeProvisioningArtifact for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeProvisioningArtifact
 func (c *ServiceCatalog) DescribeProvisioningArtifact(input *DescribeProvisioningArtifactInput) (*DescribeProvisioningArtifactOutput, error) {
@@ -3069,14 +3300,13 @@ const opDescribeProvisioningParameters = "DescribeProvisioningParameters"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeProvisioningParametersRequest method.
+//	req, resp := client.DescribeProvisioningParametersRequest(params)
 //
-//    // Example sending a request using the DescribeProvisioningParametersRequest method.
-//    req, resp := client.DescribeProvisioningParametersRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeProvisioningParameters
 func (c *ServiceCatalog) DescribeProvisioningParametersRequest(input *DescribeProvisioningParametersInput) (req *request.Request, output *DescribeProvisioningParametersOutput) {
@@ -3114,12 +3344,13 @@ func (c *ServiceCatalog) DescribeProvisioningParametersRequest(input *DescribePr
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DescribeProvisioningParameters for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeProvisioningParameters
 func (c *ServiceCatalog) DescribeProvisioningParameters(input *DescribeProvisioningParametersInput) (*DescribeProvisioningParametersOutput, error) {
@@ -3159,14 +3390,13 @@ const opDescribeRecord = "DescribeRecord"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeRecordRequest method.
+//	req, resp := client.DescribeRecordRequest(params)
 //
-//    // Example sending a request using the DescribeRecordRequest method.
-//    req, resp := client.DescribeRecordRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeRecord
 func (c *ServiceCatalog) DescribeRecordRequest(input *DescribeRecordInput) (req *request.Request, output *DescribeRecordOutput) {
@@ -3205,9 +3435,9 @@ func (c *ServiceCatalog) DescribeRecordRequest(input *DescribeRecordInput) (req
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DescribeRecord for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeRecord
 func (c *ServiceCatalog) DescribeRecord(input *DescribeRecordInput) (*DescribeRecordOutput, error) {
@@ -3247,14 +3477,13 @@ const opDescribeServiceAction = "DescribeServiceAction"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeServiceActionRequest method.
+//	req, resp := client.DescribeServiceActionRequest(params)
 //
-//    // Example sending a request using the DescribeServiceActionRequest method.
-//    req, resp := client.DescribeServiceActionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeServiceAction
 func (c *ServiceCatalog) DescribeServiceActionRequest(input *DescribeServiceActionInput) (req *request.Request, output *DescribeServiceActionOutput) {
@@ -3284,9 +3513,9 @@ func (c *ServiceCatalog) DescribeServiceActionRequest(input *DescribeServiceActi
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DescribeServiceAction for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeServiceAction
 func (c *ServiceCatalog) DescribeServiceAction(input *DescribeServiceActionInput) (*DescribeServiceActionOutput, error) {
@@ -3326,14 +3555,13 @@ const opDescribeServiceActionExecutionParameters = "DescribeServiceActionExecuti
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeServiceActionExecutionParametersRequest method.
+//	req, resp := client.DescribeServiceActionExecutionParametersRequest(params)
 //
-//    // Example sending a request using the DescribeServiceActionExecutionParametersRequest method.
-//    req, resp := client.DescribeServiceActionExecutionParametersRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeServiceActionExecutionParameters
 func (c *ServiceCatalog) DescribeServiceActionExecutionParametersRequest(input *DescribeServiceActionExecutionParametersInput) (req *request.Request, output *DescribeServiceActionExecutionParametersOutput) {
@@ -3354,6 +3582,9 @@ func (c *ServiceCatalog) DescribeServiceActionExecutionParametersRequest(input *
 
 // DescribeServiceActionExecutionParameters API operation for AWS Service Catalog.
 //
+// Finds the default parameters for a specific self-service action on a specific
+// provisioned product and returns a map of the results to the user.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -3361,12 +3592,13 @@ func (c *ServiceCatalog) DescribeServiceActionExecutionParametersRequest(input *
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DescribeServiceActionExecutionParameters for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeServiceActionExecutionParameters
 func (c *ServiceCatalog) DescribeServiceActionExecutionParameters(input *DescribeServiceActionExecutionParametersInput) (*DescribeServiceActionExecutionParametersOutput, error) {
@@ -3406,14 +3638,13 @@ const opDescribeTagOption = "DescribeTagOption"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeTagOptionRequest method.
+//	req, resp := client.DescribeTagOptionRequest(params)
 //
-//    // Example sending a request using the DescribeTagOptionRequest method.
-//    req, resp := client.DescribeTagOptionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeTagOption
 func (c *ServiceCatalog) DescribeTagOptionRequest(input *DescribeTagOptionInput) (req *request.Request, output *DescribeTagOptionOutput) {
@@ -3443,14 +3674,15 @@ func (c *ServiceCatalog) DescribeTagOptionRequest(input *DescribeTagOptionInput)
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DescribeTagOption for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeTagOptionNotMigratedException "TagOptionNotMigratedException"
-//   An operation requiring TagOptions failed because the TagOptions migration
-//   process has not been performed for this account. Please use the AWS console
-//   to perform the migration process before retrying the operation.
+// Returned Error Types:
+//
+//   - TagOptionNotMigratedException
+//     An operation requiring TagOptions failed because the TagOptions migration
+//     process has not been performed for this account. Use the Amazon Web Services
+//     Management Console to perform the migration process before retrying the operation.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DescribeTagOption
 func (c *ServiceCatalog) DescribeTagOption(input *DescribeTagOptionInput) (*DescribeTagOptionOutput, error) {
@@ -3490,14 +3722,13 @@ const opDisableAWSOrganizationsAccess = "DisableAWSOrganizationsAccess"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DisableAWSOrganizationsAccessRequest method.
+//	req, resp := client.DisableAWSOrganizationsAccessRequest(params)
 //
-//    // Example sending a request using the DisableAWSOrganizationsAccessRequest method.
-//    req, resp := client.DisableAWSOrganizationsAccessRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DisableAWSOrganizationsAccess
 func (c *ServiceCatalog) DisableAWSOrganizationsAccessRequest(input *DisableAWSOrganizationsAccessInput) (req *request.Request, output *DisableAWSOrganizationsAccessOutput) {
@@ -3519,11 +3750,23 @@ func (c *ServiceCatalog) DisableAWSOrganizationsAccessRequest(input *DisableAWSO
 
 // DisableAWSOrganizationsAccess API operation for AWS Service Catalog.
 //
-// Disable portfolio sharing through AWS Organizations feature. This feature
-// will not delete your current shares but it will prevent you from creating
-// new shares throughout your organization. Current shares will not be in sync
-// with your organization structure if it changes after calling this API. This
-// API can only be called by the master account in the organization.
+// Disable portfolio sharing through the Organizations service. This command
+// will not delete your current shares, but prevents you from creating new shares
+// throughout your organization. Current shares are not kept in sync with your
+// organization structure if the structure changes after calling this API. Only
+// the management account in the organization can call this API.
+//
+// You cannot call this API if there are active delegated administrators in
+// the organization.
+//
+// Note that a delegated administrator is not authorized to invoke DisableAWSOrganizationsAccess.
+//
+// If you share an Service Catalog portfolio in an organization within Organizations,
+// and then disable Organizations access for Service Catalog, the portfolio
+// access permissions will not sync with the latest changes to the organization
+// structure. Specifically, accounts that you removed from the organization
+// after disabling Service Catalog access will retain access to the previously
+// shared portfolio.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -3532,17 +3775,18 @@ func (c *ServiceCatalog) DisableAWSOrganizationsAccessRequest(input *DisableAWSO
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DisableAWSOrganizationsAccess for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   An attempt was made to modify a resource that is in a state that is not valid.
-//   Check your resources to ensure that they are in valid states before retrying
-//   the operation.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeOperationNotSupportedException "OperationNotSupportedException"
-//   The operation is not supported.
+//   - InvalidStateException
+//     An attempt was made to modify a resource that is in a state that is not valid.
+//     Check your resources to ensure that they are in valid states before retrying
+//     the operation.
+//
+//   - OperationNotSupportedException
+//     The operation is not supported.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DisableAWSOrganizationsAccess
 func (c *ServiceCatalog) DisableAWSOrganizationsAccess(input *DisableAWSOrganizationsAccessInput) (*DisableAWSOrganizationsAccessOutput, error) {
@@ -3582,14 +3826,13 @@ const opDisassociateBudgetFromResource = "DisassociateBudgetFromResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DisassociateBudgetFromResourceRequest method.
+//	req, resp := client.DisassociateBudgetFromResourceRequest(params)
 //
-//    // Example sending a request using the DisassociateBudgetFromResourceRequest method.
-//    req, resp := client.DisassociateBudgetFromResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DisassociateBudgetFromResource
 func (c *ServiceCatalog) DisassociateBudgetFromResourceRequest(input *DisassociateBudgetFromResourceInput) (req *request.Request, output *DisassociateBudgetFromResourceOutput) {
@@ -3620,9 +3863,9 @@ func (c *ServiceCatalog) DisassociateBudgetFromResourceRequest(input *Disassocia
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DisassociateBudgetFromResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DisassociateBudgetFromResource
 func (c *ServiceCatalog) DisassociateBudgetFromResource(input *DisassociateBudgetFromResourceInput) (*DisassociateBudgetFromResourceOutput, error) {
@@ -3662,14 +3905,13 @@ const opDisassociatePrincipalFromPortfolio = "DisassociatePrincipalFromPortfolio
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DisassociatePrincipalFromPortfolioRequest method.
+//	req, resp := client.DisassociatePrincipalFromPortfolioRequest(params)
 //
-//    // Example sending a request using the DisassociatePrincipalFromPortfolioRequest method.
-//    req, resp := client.DisassociatePrincipalFromPortfolioRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DisassociatePrincipalFromPortfolio
 func (c *ServiceCatalog) DisassociatePrincipalFromPortfolioRequest(input *DisassociatePrincipalFromPortfolioInput) (req *request.Request, output *DisassociatePrincipalFromPortfolioOutput) {
@@ -3693,6 +3935,26 @@ func (c *ServiceCatalog) DisassociatePrincipalFromPortfolioRequest(input *Disass
 //
 // Disassociates a previously associated principal ARN from a specified portfolio.
 //
+// The PrincipalType and PrincipalARN must match the AssociatePrincipalWithPortfolio
+// call request details. For example, to disassociate an association created
+// with a PrincipalARN of PrincipalType IAM you must use the PrincipalType IAM
+// when calling DisassociatePrincipalFromPortfolio.
+//
+// For portfolios that have been shared with principal name sharing enabled:
+// after disassociating a principal, share recipient accounts will no longer
+// be able to provision products in this portfolio using a role matching the
+// name of the associated principal.
+//
+// For more information, review associate-principal-with-portfolio (https://docs.aws.amazon.com/cli/latest/reference/servicecatalog/associate-principal-with-portfolio.html#options)
+// in the Amazon Web Services CLI Command Reference.
+//
+// If you disassociate a principal from a portfolio, with PrincipalType as IAM,
+// the same principal will still have access to the portfolio if it matches
+// one of the associated principals of type IAM_PATTERN. To fully remove access
+// for a principal, verify all the associated Principals of type IAM_PATTERN,
+// and then ensure you disassociate any IAM_PATTERN principals that match the
+// principal whose access you are removing.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -3700,12 +3962,13 @@ func (c *ServiceCatalog) DisassociatePrincipalFromPortfolioRequest(input *Disass
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DisassociatePrincipalFromPortfolio for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DisassociatePrincipalFromPortfolio
 func (c *ServiceCatalog) DisassociatePrincipalFromPortfolio(input *DisassociatePrincipalFromPortfolioInput) (*DisassociatePrincipalFromPortfolioOutput, error) {
@@ -3745,14 +4008,13 @@ const opDisassociateProductFromPortfolio = "DisassociateProductFromPortfolio"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DisassociateProductFromPortfolioRequest method.
+//	req, resp := client.DisassociateProductFromPortfolioRequest(params)
 //
-//    // Example sending a request using the DisassociateProductFromPortfolioRequest method.
-//    req, resp := client.DisassociateProductFromPortfolioRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DisassociateProductFromPortfolio
 func (c *ServiceCatalog) DisassociateProductFromPortfolioRequest(input *DisassociateProductFromPortfolioInput) (req *request.Request, output *DisassociateProductFromPortfolioOutput) {
@@ -3776,6 +4038,8 @@ func (c *ServiceCatalog) DisassociateProductFromPortfolioRequest(input *Disassoc
 //
 // Disassociates the specified product from the specified portfolio.
 //
+// A delegated admin is authorized to invoke this command.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -3783,16 +4047,17 @@ func (c *ServiceCatalog) DisassociateProductFromPortfolioRequest(input *Disassoc
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DisassociateProductFromPortfolio for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
 //
-//   * ErrCodeResourceInUseException "ResourceInUseException"
-//   A resource that is currently in use. Ensure that the resource is not in use
-//   and retry the operation.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - ResourceInUseException
+//     A resource that is currently in use. Ensure that the resource is not in use
+//     and retry the operation.
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DisassociateProductFromPortfolio
 func (c *ServiceCatalog) DisassociateProductFromPortfolio(input *DisassociateProductFromPortfolioInput) (*DisassociateProductFromPortfolioOutput, error) {
@@ -3832,14 +4097,13 @@ const opDisassociateServiceActionFromProvisioningArtifact = "DisassociateService
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DisassociateServiceActionFromProvisioningArtifactRequest method.
+//	req, resp := client.DisassociateServiceActionFromProvisioningArtifactRequest(params)
 //
-//    // Example sending a request using the DisassociateServiceActionFromProvisioningArtifactRequest method.
-//    req, resp := client.DisassociateServiceActionFromProvisioningArtifactRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DisassociateServiceActionFromProvisioningArtifact
 func (c *ServiceCatalog) DisassociateServiceActionFromProvisioningArtifactRequest(input *DisassociateServiceActionFromProvisioningArtifactInput) (req *request.Request, output *DisassociateServiceActionFromProvisioningArtifactOutput) {
@@ -3871,9 +4135,9 @@ func (c *ServiceCatalog) DisassociateServiceActionFromProvisioningArtifactReques
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DisassociateServiceActionFromProvisioningArtifact for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DisassociateServiceActionFromProvisioningArtifact
 func (c *ServiceCatalog) DisassociateServiceActionFromProvisioningArtifact(input *DisassociateServiceActionFromProvisioningArtifactInput) (*DisassociateServiceActionFromProvisioningArtifactOutput, error) {
@@ -3913,14 +4177,13 @@ const opDisassociateTagOptionFromResource = "DisassociateTagOptionFromResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DisassociateTagOptionFromResourceRequest method.
+//	req, resp := client.DisassociateTagOptionFromResourceRequest(params)
 //
-//    // Example sending a request using the DisassociateTagOptionFromResourceRequest method.
-//    req, resp := client.DisassociateTagOptionFromResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DisassociateTagOptionFromResource
 func (c *ServiceCatalog) DisassociateTagOptionFromResourceRequest(input *DisassociateTagOptionFromResourceInput) (req *request.Request, output *DisassociateTagOptionFromResourceOutput) {
@@ -3951,14 +4214,15 @@ func (c *ServiceCatalog) DisassociateTagOptionFromResourceRequest(input *Disasso
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation DisassociateTagOptionFromResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeTagOptionNotMigratedException "TagOptionNotMigratedException"
-//   An operation requiring TagOptions failed because the TagOptions migration
-//   process has not been performed for this account. Please use the AWS console
-//   to perform the migration process before retrying the operation.
+// Returned Error Types:
+//
+//   - TagOptionNotMigratedException
+//     An operation requiring TagOptions failed because the TagOptions migration
+//     process has not been performed for this account. Use the Amazon Web Services
+//     Management Console to perform the migration process before retrying the operation.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/DisassociateTagOptionFromResource
 func (c *ServiceCatalog) DisassociateTagOptionFromResource(input *DisassociateTagOptionFromResourceInput) (*DisassociateTagOptionFromResourceOutput, error) {
@@ -3998,14 +4262,13 @@ const opEnableAWSOrganizationsAccess = "EnableAWSOrganizationsAccess"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the EnableAWSOrganizationsAccessRequest method.
+//	req, resp := client.EnableAWSOrganizationsAccessRequest(params)
 //
-//    // Example sending a request using the EnableAWSOrganizationsAccessRequest method.
-//    req, resp := client.EnableAWSOrganizationsAccessRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/EnableAWSOrganizationsAccess
 func (c *ServiceCatalog) EnableAWSOrganizationsAccessRequest(input *EnableAWSOrganizationsAccessInput) (req *request.Request, output *EnableAWSOrganizationsAccessOutput) {
@@ -4027,14 +4290,26 @@ func (c *ServiceCatalog) EnableAWSOrganizationsAccessRequest(input *EnableAWSOrg
 
 // EnableAWSOrganizationsAccess API operation for AWS Service Catalog.
 //
-// Enable portfolio sharing feature through AWS Organizations. This API will
-// allow Service Catalog to receive updates on your organization in order to
-// sync your shares with the current structure. This API can only be called
-// by the master account in the organization.
+// Enable portfolio sharing feature through Organizations. This API will allow
+// Service Catalog to receive updates on your organization in order to sync
+// your shares with the current structure. This API can only be called by the
+// management account in the organization.
+//
+// When you call this API, Service Catalog calls organizations:EnableAWSServiceAccess
+// on your behalf so that your shares stay in sync with any changes in your
+// Organizations structure.
 //
-// By calling this API Service Catalog will make a call to organizations:EnableAWSServiceAccess
-// on your behalf so that your shares can be in sync with any changes in your
-// AWS Organizations structure.
+// Note that a delegated administrator is not authorized to invoke EnableAWSOrganizationsAccess.
+//
+// If you have previously disabled Organizations access for Service Catalog,
+// and then enable access again, the portfolio access permissions might not
+// sync with the latest changes to the organization structure. Specifically,
+// accounts that you removed from the organization after disabling Service Catalog
+// access, and before you enabled access again, can retain access to the previously
+// shared portfolio. As a result, an account that has been removed from the
+// organization might still be able to create or manage Amazon Web Services
+// resources when it is no longer authorized to do so. Amazon Web Services is
+// working to resolve this issue.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -4043,17 +4318,18 @@ func (c *ServiceCatalog) EnableAWSOrganizationsAccessRequest(input *EnableAWSOrg
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation EnableAWSOrganizationsAccess for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   An attempt was made to modify a resource that is in a state that is not valid.
-//   Check your resources to ensure that they are in valid states before retrying
-//   the operation.
+//   - InvalidStateException
+//     An attempt was made to modify a resource that is in a state that is not valid.
+//     Check your resources to ensure that they are in valid states before retrying
+//     the operation.
 //
-//   * ErrCodeOperationNotSupportedException "OperationNotSupportedException"
-//   The operation is not supported.
+//   - OperationNotSupportedException
+//     The operation is not supported.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/EnableAWSOrganizationsAccess
 func (c *ServiceCatalog) EnableAWSOrganizationsAccess(input *EnableAWSOrganizationsAccessInput) (*EnableAWSOrganizationsAccessOutput, error) {
@@ -4093,14 +4369,13 @@ const opExecuteProvisionedProductPlan = "ExecuteProvisionedProductPlan"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ExecuteProvisionedProductPlanRequest method.
+//	req, resp := client.ExecuteProvisionedProductPlanRequest(params)
 //
-//    // Example sending a request using the ExecuteProvisionedProductPlanRequest method.
-//    req, resp := client.ExecuteProvisionedProductPlanRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ExecuteProvisionedProductPlan
 func (c *ServiceCatalog) ExecuteProvisionedProductPlanRequest(input *ExecuteProvisionedProductPlanInput) (req *request.Request, output *ExecuteProvisionedProductPlanOutput) {
@@ -4131,17 +4406,18 @@ func (c *ServiceCatalog) ExecuteProvisionedProductPlanRequest(input *ExecuteProv
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation ExecuteProvisionedProductPlan for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   An attempt was made to modify a resource that is in a state that is not valid.
-//   Check your resources to ensure that they are in valid states before retrying
-//   the operation.
+//   - InvalidStateException
+//     An attempt was made to modify a resource that is in a state that is not valid.
+//     Check your resources to ensure that they are in valid states before retrying
+//     the operation.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ExecuteProvisionedProductPlan
 func (c *ServiceCatalog) ExecuteProvisionedProductPlan(input *ExecuteProvisionedProductPlanInput) (*ExecuteProvisionedProductPlanOutput, error) {
@@ -4181,14 +4457,13 @@ const opExecuteProvisionedProductServiceAction = "ExecuteProvisionedProductServi
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ExecuteProvisionedProductServiceActionRequest method.
+//	req, resp := client.ExecuteProvisionedProductServiceActionRequest(params)
 //
-//    // Example sending a request using the ExecuteProvisionedProductServiceActionRequest method.
-//    req, resp := client.ExecuteProvisionedProductServiceActionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ExecuteProvisionedProductServiceAction
 func (c *ServiceCatalog) ExecuteProvisionedProductServiceActionRequest(input *ExecuteProvisionedProductServiceActionInput) (req *request.Request, output *ExecuteProvisionedProductServiceActionOutput) {
@@ -4218,17 +4493,18 @@ func (c *ServiceCatalog) ExecuteProvisionedProductServiceActionRequest(input *Ex
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation ExecuteProvisionedProductServiceAction for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   An attempt was made to modify a resource that is in a state that is not valid.
-//   Check your resources to ensure that they are in valid states before retrying
-//   the operation.
+//   - InvalidStateException
+//     An attempt was made to modify a resource that is in a state that is not valid.
+//     Check your resources to ensure that they are in valid states before retrying
+//     the operation.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ExecuteProvisionedProductServiceAction
 func (c *ServiceCatalog) ExecuteProvisionedProductServiceAction(input *ExecuteProvisionedProductServiceActionInput) (*ExecuteProvisionedProductServiceActionOutput, error) {
@@ -4268,14 +4544,13 @@ const opGetAWSOrganizationsAccessStatus = "GetAWSOrganizationsAccessStatus"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetAWSOrganizationsAccessStatusRequest method.
+//	req, resp := client.GetAWSOrganizationsAccessStatusRequest(params)
 //
-//    // Example sending a request using the GetAWSOrganizationsAccessStatusRequest method.
-//    req, resp := client.GetAWSOrganizationsAccessStatusRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/GetAWSOrganizationsAccessStatus
 func (c *ServiceCatalog) GetAWSOrganizationsAccessStatusRequest(input *GetAWSOrganizationsAccessStatusInput) (req *request.Request, output *GetAWSOrganizationsAccessStatusOutput) {
@@ -4296,8 +4571,9 @@ func (c *ServiceCatalog) GetAWSOrganizationsAccessStatusRequest(input *GetAWSOrg
 
 // GetAWSOrganizationsAccessStatus API operation for AWS Service Catalog.
 //
-// Get the Access Status for AWS Organization portfolio share feature. This
-// API can only be called by the master account in the organization.
+// Get the Access Status for Organizations portfolio share feature. This API
+// can only be called by the management account in the organization or by a
+// delegated admin.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -4306,12 +4582,13 @@ func (c *ServiceCatalog) GetAWSOrganizationsAccessStatusRequest(input *GetAWSOrg
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation GetAWSOrganizationsAccessStatus for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeOperationNotSupportedException "OperationNotSupportedException"
-//   The operation is not supported.
+//   - OperationNotSupportedException
+//     The operation is not supported.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/GetAWSOrganizationsAccessStatus
 func (c *ServiceCatalog) GetAWSOrganizationsAccessStatus(input *GetAWSOrganizationsAccessStatusInput) (*GetAWSOrganizationsAccessStatusOutput, error) {
@@ -4335,35 +4612,34 @@ func (c *ServiceCatalog) GetAWSOrganizationsAccessStatusWithContext(ctx aws.Cont
 	return out, req.Send()
 }
 
-const opListAcceptedPortfolioShares = "ListAcceptedPortfolioShares"
+const opGetProvisionedProductOutputs = "GetProvisionedProductOutputs"
 
-// ListAcceptedPortfolioSharesRequest generates a "aws/request.Request" representing the
-// client's request for the ListAcceptedPortfolioShares operation. The "output" return
+// GetProvisionedProductOutputsRequest generates a "aws/request.Request" representing the
+// client's request for the GetProvisionedProductOutputs operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListAcceptedPortfolioShares for more information on using the ListAcceptedPortfolioShares
+// See GetProvisionedProductOutputs for more information on using the GetProvisionedProductOutputs
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetProvisionedProductOutputsRequest method.
+//	req, resp := client.GetProvisionedProductOutputsRequest(params)
 //
-//    // Example sending a request using the ListAcceptedPortfolioSharesRequest method.
-//    req, resp := client.ListAcceptedPortfolioSharesRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListAcceptedPortfolioShares
-func (c *ServiceCatalog) ListAcceptedPortfolioSharesRequest(input *ListAcceptedPortfolioSharesInput) (req *request.Request, output *ListAcceptedPortfolioSharesOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/GetProvisionedProductOutputs
+func (c *ServiceCatalog) GetProvisionedProductOutputsRequest(input *GetProvisionedProductOutputsInput) (req *request.Request, output *GetProvisionedProductOutputsOutput) {
 	op := &request.Operation{
-		Name:       opListAcceptedPortfolioShares,
+		Name:       opGetProvisionedProductOutputs,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 		Paginator: &request.Paginator{
@@ -4375,128 +4651,386 @@ func (c *ServiceCatalog) ListAcceptedPortfolioSharesRequest(input *ListAcceptedP
 	}
 
 	if input == nil {
-		input = &ListAcceptedPortfolioSharesInput{}
+		input = &GetProvisionedProductOutputsInput{}
 	}
 
-	output = &ListAcceptedPortfolioSharesOutput{}
+	output = &GetProvisionedProductOutputsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListAcceptedPortfolioShares API operation for AWS Service Catalog.
+// GetProvisionedProductOutputs API operation for AWS Service Catalog.
 //
-// Lists all portfolios for which sharing was accepted by this account.
+// This API takes either a ProvisonedProductId or a ProvisionedProductName,
+// along with a list of one or more output keys, and responds with the key/value
+// pairs of those outputs.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Service Catalog's
-// API operation ListAcceptedPortfolioShares for usage and error information.
+// API operation GetProvisionedProductOutputs for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
 //
-//   * ErrCodeOperationNotSupportedException "OperationNotSupportedException"
-//   The operation is not supported.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListAcceptedPortfolioShares
-func (c *ServiceCatalog) ListAcceptedPortfolioShares(input *ListAcceptedPortfolioSharesInput) (*ListAcceptedPortfolioSharesOutput, error) {
-	req, out := c.ListAcceptedPortfolioSharesRequest(input)
+//   - ResourceNotFoundException
+//     The specified resource was not found.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/GetProvisionedProductOutputs
+func (c *ServiceCatalog) GetProvisionedProductOutputs(input *GetProvisionedProductOutputsInput) (*GetProvisionedProductOutputsOutput, error) {
+	req, out := c.GetProvisionedProductOutputsRequest(input)
 	return out, req.Send()
 }
 
-// ListAcceptedPortfolioSharesWithContext is the same as ListAcceptedPortfolioShares with the addition of
+// GetProvisionedProductOutputsWithContext is the same as GetProvisionedProductOutputs with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListAcceptedPortfolioShares for details on how to use this API operation.
+// See GetProvisionedProductOutputs for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *ServiceCatalog) ListAcceptedPortfolioSharesWithContext(ctx aws.Context, input *ListAcceptedPortfolioSharesInput, opts ...request.Option) (*ListAcceptedPortfolioSharesOutput, error) {
-	req, out := c.ListAcceptedPortfolioSharesRequest(input)
+func (c *ServiceCatalog) GetProvisionedProductOutputsWithContext(ctx aws.Context, input *GetProvisionedProductOutputsInput, opts ...request.Option) (*GetProvisionedProductOutputsOutput, error) {
+	req, out := c.GetProvisionedProductOutputsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-// ListAcceptedPortfolioSharesPages iterates over the pages of a ListAcceptedPortfolioShares operation,
+// GetProvisionedProductOutputsPages iterates over the pages of a GetProvisionedProductOutputs operation,
 // calling the "fn" function with the response data for each page. To stop
 // iterating, return false from the fn function.
 //
-// See ListAcceptedPortfolioShares method for more information on how to use this operation.
+// See GetProvisionedProductOutputs method for more information on how to use this operation.
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListAcceptedPortfolioShares operation.
-//    pageNum := 0
-//    err := client.ListAcceptedPortfolioSharesPages(params,
-//        func(page *servicecatalog.ListAcceptedPortfolioSharesOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
-func (c *ServiceCatalog) ListAcceptedPortfolioSharesPages(input *ListAcceptedPortfolioSharesInput, fn func(*ListAcceptedPortfolioSharesOutput, bool) bool) error {
-	return c.ListAcceptedPortfolioSharesPagesWithContext(aws.BackgroundContext(), input, fn)
+//	// Example iterating over at most 3 pages of a GetProvisionedProductOutputs operation.
+//	pageNum := 0
+//	err := client.GetProvisionedProductOutputsPages(params,
+//	    func(page *servicecatalog.GetProvisionedProductOutputsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *ServiceCatalog) GetProvisionedProductOutputsPages(input *GetProvisionedProductOutputsInput, fn func(*GetProvisionedProductOutputsOutput, bool) bool) error {
+	return c.GetProvisionedProductOutputsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
 
-// ListAcceptedPortfolioSharesPagesWithContext same as ListAcceptedPortfolioSharesPages except
+// GetProvisionedProductOutputsPagesWithContext same as GetProvisionedProductOutputsPages except
 // it takes a Context and allows setting request options on the pages.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *ServiceCatalog) ListAcceptedPortfolioSharesPagesWithContext(ctx aws.Context, input *ListAcceptedPortfolioSharesInput, fn func(*ListAcceptedPortfolioSharesOutput, bool) bool, opts ...request.Option) error {
+func (c *ServiceCatalog) GetProvisionedProductOutputsPagesWithContext(ctx aws.Context, input *GetProvisionedProductOutputsInput, fn func(*GetProvisionedProductOutputsOutput, bool) bool, opts ...request.Option) error {
 	p := request.Pagination{
 		NewRequest: func() (*request.Request, error) {
-			var inCpy *ListAcceptedPortfolioSharesInput
+			var inCpy *GetProvisionedProductOutputsInput
 			if input != nil {
 				tmp := *input
 				inCpy = &tmp
 			}
-			req, _ := c.ListAcceptedPortfolioSharesRequest(inCpy)
+			req, _ := c.GetProvisionedProductOutputsRequest(inCpy)
 			req.SetContext(ctx)
 			req.ApplyOptions(opts...)
 			return req, nil
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListAcceptedPortfolioSharesOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*GetProvisionedProductOutputsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
-const opListBudgetsForResource = "ListBudgetsForResource"
+const opImportAsProvisionedProduct = "ImportAsProvisionedProduct"
 
-// ListBudgetsForResourceRequest generates a "aws/request.Request" representing the
-// client's request for the ListBudgetsForResource operation. The "output" return
+// ImportAsProvisionedProductRequest generates a "aws/request.Request" representing the
+// client's request for the ImportAsProvisionedProduct operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListBudgetsForResource for more information on using the ListBudgetsForResource
+// See ImportAsProvisionedProduct for more information on using the ImportAsProvisionedProduct
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ImportAsProvisionedProductRequest method.
+//	req, resp := client.ImportAsProvisionedProductRequest(params)
 //
-//    // Example sending a request using the ListBudgetsForResourceRequest method.
-//    req, resp := client.ListBudgetsForResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ImportAsProvisionedProduct
+func (c *ServiceCatalog) ImportAsProvisionedProductRequest(input *ImportAsProvisionedProductInput) (req *request.Request, output *ImportAsProvisionedProductOutput) {
+	op := &request.Operation{
+		Name:       opImportAsProvisionedProduct,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &ImportAsProvisionedProductInput{}
+	}
+
+	output = &ImportAsProvisionedProductOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ImportAsProvisionedProduct API operation for AWS Service Catalog.
+//
+// Requests the import of a resource as an Service Catalog provisioned product
+// that is associated to an Service Catalog product and provisioning artifact.
+// Once imported, all supported governance actions are supported on the provisioned
+// product.
+//
+// Resource import only supports CloudFormation stack ARNs. CloudFormation StackSets,
+// and non-root nested stacks, are not supported.
+//
+// The CloudFormation stack must have one of the following statuses to be imported:
+// CREATE_COMPLETE, UPDATE_COMPLETE, UPDATE_ROLLBACK_COMPLETE, IMPORT_COMPLETE,
+// and IMPORT_ROLLBACK_COMPLETE.
+//
+// Import of the resource requires that the CloudFormation stack template matches
+// the associated Service Catalog product provisioning artifact.
+//
+// When you import an existing CloudFormation stack into a portfolio, Service
+// Catalog does not apply the product's associated constraints during the import
+// process. Service Catalog applies the constraints after you call UpdateProvisionedProduct
+// for the provisioned product.
+//
+// The user or role that performs this operation must have the cloudformation:GetTemplate
+// and cloudformation:DescribeStacks IAM policy permissions.
+//
+// You can only import one provisioned product at a time. The product's CloudFormation
+// stack must have the IMPORT_COMPLETE status before you import another.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Service Catalog's
+// API operation ImportAsProvisionedProduct for usage and error information.
+//
+// Returned Error Types:
+//
+//   - DuplicateResourceException
+//     The specified resource is a duplicate.
+//
+//   - InvalidStateException
+//     An attempt was made to modify a resource that is in a state that is not valid.
+//     Check your resources to ensure that they are in valid states before retrying
+//     the operation.
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ImportAsProvisionedProduct
+func (c *ServiceCatalog) ImportAsProvisionedProduct(input *ImportAsProvisionedProductInput) (*ImportAsProvisionedProductOutput, error) {
+	req, out := c.ImportAsProvisionedProductRequest(input)
+	return out, req.Send()
+}
+
+// ImportAsProvisionedProductWithContext is the same as ImportAsProvisionedProduct with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ImportAsProvisionedProduct for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *ServiceCatalog) ImportAsProvisionedProductWithContext(ctx aws.Context, input *ImportAsProvisionedProductInput, opts ...request.Option) (*ImportAsProvisionedProductOutput, error) {
+	req, out := c.ImportAsProvisionedProductRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opListAcceptedPortfolioShares = "ListAcceptedPortfolioShares"
+
+// ListAcceptedPortfolioSharesRequest generates a "aws/request.Request" representing the
+// client's request for the ListAcceptedPortfolioShares operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListAcceptedPortfolioShares for more information on using the ListAcceptedPortfolioShares
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListAcceptedPortfolioSharesRequest method.
+//	req, resp := client.ListAcceptedPortfolioSharesRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListAcceptedPortfolioShares
+func (c *ServiceCatalog) ListAcceptedPortfolioSharesRequest(input *ListAcceptedPortfolioSharesInput) (req *request.Request, output *ListAcceptedPortfolioSharesOutput) {
+	op := &request.Operation{
+		Name:       opListAcceptedPortfolioShares,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"PageToken"},
+			OutputTokens:    []string{"NextPageToken"},
+			LimitToken:      "PageSize",
+			TruncationToken: "",
+		},
+	}
+
+	if input == nil {
+		input = &ListAcceptedPortfolioSharesInput{}
+	}
+
+	output = &ListAcceptedPortfolioSharesOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListAcceptedPortfolioShares API operation for AWS Service Catalog.
+//
+// Lists all imported portfolios for which account-to-account shares were accepted
+// by this account. By specifying the PortfolioShareType, you can list portfolios
+// for which organizational shares were accepted by this account.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Service Catalog's
+// API operation ListAcceptedPortfolioShares for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
+//
+//   - OperationNotSupportedException
+//     The operation is not supported.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListAcceptedPortfolioShares
+func (c *ServiceCatalog) ListAcceptedPortfolioShares(input *ListAcceptedPortfolioSharesInput) (*ListAcceptedPortfolioSharesOutput, error) {
+	req, out := c.ListAcceptedPortfolioSharesRequest(input)
+	return out, req.Send()
+}
+
+// ListAcceptedPortfolioSharesWithContext is the same as ListAcceptedPortfolioShares with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListAcceptedPortfolioShares for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *ServiceCatalog) ListAcceptedPortfolioSharesWithContext(ctx aws.Context, input *ListAcceptedPortfolioSharesInput, opts ...request.Option) (*ListAcceptedPortfolioSharesOutput, error) {
+	req, out := c.ListAcceptedPortfolioSharesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+// ListAcceptedPortfolioSharesPages iterates over the pages of a ListAcceptedPortfolioShares operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListAcceptedPortfolioShares method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListAcceptedPortfolioShares operation.
+//	pageNum := 0
+//	err := client.ListAcceptedPortfolioSharesPages(params,
+//	    func(page *servicecatalog.ListAcceptedPortfolioSharesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *ServiceCatalog) ListAcceptedPortfolioSharesPages(input *ListAcceptedPortfolioSharesInput, fn func(*ListAcceptedPortfolioSharesOutput, bool) bool) error {
+	return c.ListAcceptedPortfolioSharesPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListAcceptedPortfolioSharesPagesWithContext same as ListAcceptedPortfolioSharesPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *ServiceCatalog) ListAcceptedPortfolioSharesPagesWithContext(ctx aws.Context, input *ListAcceptedPortfolioSharesInput, fn func(*ListAcceptedPortfolioSharesOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListAcceptedPortfolioSharesInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListAcceptedPortfolioSharesRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListAcceptedPortfolioSharesOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListBudgetsForResource = "ListBudgetsForResource"
+
+// ListBudgetsForResourceRequest generates a "aws/request.Request" representing the
+// client's request for the ListBudgetsForResource operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListBudgetsForResource for more information on using the ListBudgetsForResource
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListBudgetsForResourceRequest method.
+//	req, resp := client.ListBudgetsForResourceRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListBudgetsForResource
 func (c *ServiceCatalog) ListBudgetsForResourceRequest(input *ListBudgetsForResourceInput) (req *request.Request, output *ListBudgetsForResourceOutput) {
@@ -4532,12 +5066,13 @@ func (c *ServiceCatalog) ListBudgetsForResourceRequest(input *ListBudgetsForReso
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation ListBudgetsForResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListBudgetsForResource
 func (c *ServiceCatalog) ListBudgetsForResource(input *ListBudgetsForResourceInput) (*ListBudgetsForResourceOutput, error) {
@@ -4569,15 +5104,14 @@ func (c *ServiceCatalog) ListBudgetsForResourceWithContext(ctx aws.Context, inpu
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListBudgetsForResource operation.
-//    pageNum := 0
-//    err := client.ListBudgetsForResourcePages(params,
-//        func(page *servicecatalog.ListBudgetsForResourceOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListBudgetsForResource operation.
+//	pageNum := 0
+//	err := client.ListBudgetsForResourcePages(params,
+//	    func(page *servicecatalog.ListBudgetsForResourceOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServiceCatalog) ListBudgetsForResourcePages(input *ListBudgetsForResourceInput, fn func(*ListBudgetsForResourceOutput, bool) bool) error {
 	return c.ListBudgetsForResourcePagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -4604,10 +5138,12 @@ func (c *ServiceCatalog) ListBudgetsForResourcePagesWithContext(ctx aws.Context,
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListBudgetsForResourceOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListBudgetsForResourceOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -4627,14 +5163,13 @@ const opListConstraintsForPortfolio = "ListConstraintsForPortfolio"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListConstraintsForPortfolioRequest method.
+//	req, resp := client.ListConstraintsForPortfolioRequest(params)
 //
-//    // Example sending a request using the ListConstraintsForPortfolioRequest method.
-//    req, resp := client.ListConstraintsForPortfolioRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListConstraintsForPortfolio
 func (c *ServiceCatalog) ListConstraintsForPortfolioRequest(input *ListConstraintsForPortfolioInput) (req *request.Request, output *ListConstraintsForPortfolioOutput) {
@@ -4670,12 +5205,13 @@ func (c *ServiceCatalog) ListConstraintsForPortfolioRequest(input *ListConstrain
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation ListConstraintsForPortfolio for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListConstraintsForPortfolio
 func (c *ServiceCatalog) ListConstraintsForPortfolio(input *ListConstraintsForPortfolioInput) (*ListConstraintsForPortfolioOutput, error) {
@@ -4707,15 +5243,14 @@ func (c *ServiceCatalog) ListConstraintsForPortfolioWithContext(ctx aws.Context,
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListConstraintsForPortfolio operation.
-//    pageNum := 0
-//    err := client.ListConstraintsForPortfolioPages(params,
-//        func(page *servicecatalog.ListConstraintsForPortfolioOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListConstraintsForPortfolio operation.
+//	pageNum := 0
+//	err := client.ListConstraintsForPortfolioPages(params,
+//	    func(page *servicecatalog.ListConstraintsForPortfolioOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServiceCatalog) ListConstraintsForPortfolioPages(input *ListConstraintsForPortfolioInput, fn func(*ListConstraintsForPortfolioOutput, bool) bool) error {
 	return c.ListConstraintsForPortfolioPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -4742,10 +5277,12 @@ func (c *ServiceCatalog) ListConstraintsForPortfolioPagesWithContext(ctx aws.Con
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListConstraintsForPortfolioOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListConstraintsForPortfolioOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -4765,14 +5302,13 @@ const opListLaunchPaths = "ListLaunchPaths"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListLaunchPathsRequest method.
+//	req, resp := client.ListLaunchPathsRequest(params)
 //
-//    // Example sending a request using the ListLaunchPathsRequest method.
-//    req, resp := client.ListLaunchPathsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListLaunchPaths
 func (c *ServiceCatalog) ListLaunchPathsRequest(input *ListLaunchPathsInput) (req *request.Request, output *ListLaunchPathsOutput) {
@@ -4799,9 +5335,15 @@ func (c *ServiceCatalog) ListLaunchPathsRequest(input *ListLaunchPathsInput) (re
 
 // ListLaunchPaths API operation for AWS Service Catalog.
 //
-// Lists the paths to the specified product. A path is how the user has access
-// to a specified product, and is necessary when provisioning a product. A path
-// also determines the constraints put on the product.
+// Lists the paths to the specified product. A path describes how the user gets
+// access to a specified product and is necessary when provisioning a product.
+// A path also determines the constraints that are put on a product. A path
+// is dependent on a specific product, porfolio, and principal.
+//
+// When provisioning a product that's been added to a portfolio, you must grant
+// your user, group, or role access to the portfolio. For more information,
+// see Granting users access (https://docs.aws.amazon.com/servicecatalog/latest/adminguide/catalogs_portfolios_users.html)
+// in the Service Catalog User Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -4810,12 +5352,13 @@ func (c *ServiceCatalog) ListLaunchPathsRequest(input *ListLaunchPathsInput) (re
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation ListLaunchPaths for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListLaunchPaths
 func (c *ServiceCatalog) ListLaunchPaths(input *ListLaunchPathsInput) (*ListLaunchPathsOutput, error) {
@@ -4847,15 +5390,14 @@ func (c *ServiceCatalog) ListLaunchPathsWithContext(ctx aws.Context, input *List
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListLaunchPaths operation.
-//    pageNum := 0
-//    err := client.ListLaunchPathsPages(params,
-//        func(page *servicecatalog.ListLaunchPathsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListLaunchPaths operation.
+//	pageNum := 0
+//	err := client.ListLaunchPathsPages(params,
+//	    func(page *servicecatalog.ListLaunchPathsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServiceCatalog) ListLaunchPathsPages(input *ListLaunchPathsInput, fn func(*ListLaunchPathsOutput, bool) bool) error {
 	return c.ListLaunchPathsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -4882,10 +5424,12 @@ func (c *ServiceCatalog) ListLaunchPathsPagesWithContext(ctx aws.Context, input
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListLaunchPathsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListLaunchPathsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -4905,14 +5449,13 @@ const opListOrganizationPortfolioAccess = "ListOrganizationPortfolioAccess"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListOrganizationPortfolioAccessRequest method.
+//	req, resp := client.ListOrganizationPortfolioAccessRequest(params)
 //
-//    // Example sending a request using the ListOrganizationPortfolioAccessRequest method.
-//    req, resp := client.ListOrganizationPortfolioAccessRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListOrganizationPortfolioAccess
 func (c *ServiceCatalog) ListOrganizationPortfolioAccessRequest(input *ListOrganizationPortfolioAccessInput) (req *request.Request, output *ListOrganizationPortfolioAccessOutput) {
@@ -4940,7 +5483,10 @@ func (c *ServiceCatalog) ListOrganizationPortfolioAccessRequest(input *ListOrgan
 // ListOrganizationPortfolioAccess API operation for AWS Service Catalog.
 //
 // Lists the organization nodes that have access to the specified portfolio.
-// This API can only be called by the master account in the organization.
+// This API can only be called by the management account in the organization
+// or by a delegated admin.
+//
+// If a delegated admin is de-registered, they can no longer perform this operation.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -4949,15 +5495,16 @@ func (c *ServiceCatalog) ListOrganizationPortfolioAccessRequest(input *ListOrgan
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation ListOrganizationPortfolioAccess for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeOperationNotSupportedException "OperationNotSupportedException"
-//   The operation is not supported.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
+//
+//   - OperationNotSupportedException
+//     The operation is not supported.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListOrganizationPortfolioAccess
 func (c *ServiceCatalog) ListOrganizationPortfolioAccess(input *ListOrganizationPortfolioAccessInput) (*ListOrganizationPortfolioAccessOutput, error) {
@@ -4989,15 +5536,14 @@ func (c *ServiceCatalog) ListOrganizationPortfolioAccessWithContext(ctx aws.Cont
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListOrganizationPortfolioAccess operation.
-//    pageNum := 0
-//    err := client.ListOrganizationPortfolioAccessPages(params,
-//        func(page *servicecatalog.ListOrganizationPortfolioAccessOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListOrganizationPortfolioAccess operation.
+//	pageNum := 0
+//	err := client.ListOrganizationPortfolioAccessPages(params,
+//	    func(page *servicecatalog.ListOrganizationPortfolioAccessOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServiceCatalog) ListOrganizationPortfolioAccessPages(input *ListOrganizationPortfolioAccessInput, fn func(*ListOrganizationPortfolioAccessOutput, bool) bool) error {
 	return c.ListOrganizationPortfolioAccessPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -5024,10 +5570,12 @@ func (c *ServiceCatalog) ListOrganizationPortfolioAccessPagesWithContext(ctx aws
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListOrganizationPortfolioAccessOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListOrganizationPortfolioAccessOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -5047,14 +5595,13 @@ const opListPortfolioAccess = "ListPortfolioAccess"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListPortfolioAccessRequest method.
+//	req, resp := client.ListPortfolioAccessRequest(params)
 //
-//    // Example sending a request using the ListPortfolioAccessRequest method.
-//    req, resp := client.ListPortfolioAccessRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListPortfolioAccess
 func (c *ServiceCatalog) ListPortfolioAccessRequest(input *ListPortfolioAccessInput) (req *request.Request, output *ListPortfolioAccessOutput) {
@@ -5062,6 +5609,12 @@ func (c *ServiceCatalog) ListPortfolioAccessRequest(input *ListPortfolioAccessIn
 		Name:       opListPortfolioAccess,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"PageToken"},
+			OutputTokens:    []string{"NextPageToken"},
+			LimitToken:      "PageSize",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
@@ -5077,6 +5630,10 @@ func (c *ServiceCatalog) ListPortfolioAccessRequest(input *ListPortfolioAccessIn
 //
 // Lists the account IDs that have access to the specified portfolio.
 //
+// A delegated admin can list the accounts that have access to the shared portfolio.
+// Note that if a delegated admin is de-registered, they can no longer perform
+// this operation.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -5084,9 +5641,13 @@ func (c *ServiceCatalog) ListPortfolioAccessRequest(input *ListPortfolioAccessIn
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation ListPortfolioAccess for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListPortfolioAccess
 func (c *ServiceCatalog) ListPortfolioAccess(input *ListPortfolioAccessInput) (*ListPortfolioAccessOutput, error) {
@@ -5110,6 +5671,57 @@ func (c *ServiceCatalog) ListPortfolioAccessWithContext(ctx aws.Context, input *
 	return out, req.Send()
 }
 
+// ListPortfolioAccessPages iterates over the pages of a ListPortfolioAccess operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListPortfolioAccess method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListPortfolioAccess operation.
+//	pageNum := 0
+//	err := client.ListPortfolioAccessPages(params,
+//	    func(page *servicecatalog.ListPortfolioAccessOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *ServiceCatalog) ListPortfolioAccessPages(input *ListPortfolioAccessInput, fn func(*ListPortfolioAccessOutput, bool) bool) error {
+	return c.ListPortfolioAccessPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListPortfolioAccessPagesWithContext same as ListPortfolioAccessPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *ServiceCatalog) ListPortfolioAccessPagesWithContext(ctx aws.Context, input *ListPortfolioAccessInput, fn func(*ListPortfolioAccessOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListPortfolioAccessInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListPortfolioAccessRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListPortfolioAccessOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
 const opListPortfolios = "ListPortfolios"
 
 // ListPortfoliosRequest generates a "aws/request.Request" representing the
@@ -5126,14 +5738,13 @@ const opListPortfolios = "ListPortfolios"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListPortfoliosRequest method.
+//	req, resp := client.ListPortfoliosRequest(params)
 //
-//    // Example sending a request using the ListPortfoliosRequest method.
-//    req, resp := client.ListPortfoliosRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListPortfolios
 func (c *ServiceCatalog) ListPortfoliosRequest(input *ListPortfoliosInput) (req *request.Request, output *ListPortfoliosOutput) {
@@ -5169,9 +5780,9 @@ func (c *ServiceCatalog) ListPortfoliosRequest(input *ListPortfoliosInput) (req
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation ListPortfolios for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListPortfolios
 func (c *ServiceCatalog) ListPortfolios(input *ListPortfoliosInput) (*ListPortfoliosOutput, error) {
@@ -5203,15 +5814,14 @@ func (c *ServiceCatalog) ListPortfoliosWithContext(ctx aws.Context, input *ListP
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListPortfolios operation.
-//    pageNum := 0
-//    err := client.ListPortfoliosPages(params,
-//        func(page *servicecatalog.ListPortfoliosOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListPortfolios operation.
+//	pageNum := 0
+//	err := client.ListPortfoliosPages(params,
+//	    func(page *servicecatalog.ListPortfoliosOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServiceCatalog) ListPortfoliosPages(input *ListPortfoliosInput, fn func(*ListPortfoliosOutput, bool) bool) error {
 	return c.ListPortfoliosPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -5238,10 +5848,12 @@ func (c *ServiceCatalog) ListPortfoliosPagesWithContext(ctx aws.Context, input *
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListPortfoliosOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListPortfoliosOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -5261,14 +5873,13 @@ const opListPortfoliosForProduct = "ListPortfoliosForProduct"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListPortfoliosForProductRequest method.
+//	req, resp := client.ListPortfoliosForProductRequest(params)
 //
-//    // Example sending a request using the ListPortfoliosForProductRequest method.
-//    req, resp := client.ListPortfoliosForProductRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListPortfoliosForProduct
 func (c *ServiceCatalog) ListPortfoliosForProductRequest(input *ListPortfoliosForProductInput) (req *request.Request, output *ListPortfoliosForProductOutput) {
@@ -5304,12 +5915,13 @@ func (c *ServiceCatalog) ListPortfoliosForProductRequest(input *ListPortfoliosFo
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation ListPortfoliosForProduct for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListPortfoliosForProduct
 func (c *ServiceCatalog) ListPortfoliosForProduct(input *ListPortfoliosForProductInput) (*ListPortfoliosForProductOutput, error) {
@@ -5341,15 +5953,14 @@ func (c *ServiceCatalog) ListPortfoliosForProductWithContext(ctx aws.Context, in
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListPortfoliosForProduct operation.
-//    pageNum := 0
-//    err := client.ListPortfoliosForProductPages(params,
-//        func(page *servicecatalog.ListPortfoliosForProductOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListPortfoliosForProduct operation.
+//	pageNum := 0
+//	err := client.ListPortfoliosForProductPages(params,
+//	    func(page *servicecatalog.ListPortfoliosForProductOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServiceCatalog) ListPortfoliosForProductPages(input *ListPortfoliosForProductInput, fn func(*ListPortfoliosForProductOutput, bool) bool) error {
 	return c.ListPortfoliosForProductPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -5376,10 +5987,12 @@ func (c *ServiceCatalog) ListPortfoliosForProductPagesWithContext(ctx aws.Contex
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListPortfoliosForProductOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListPortfoliosForProductOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -5399,14 +6012,13 @@ const opListPrincipalsForPortfolio = "ListPrincipalsForPortfolio"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListPrincipalsForPortfolioRequest method.
+//	req, resp := client.ListPrincipalsForPortfolioRequest(params)
 //
-//    // Example sending a request using the ListPrincipalsForPortfolioRequest method.
-//    req, resp := client.ListPrincipalsForPortfolioRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListPrincipalsForPortfolio
 func (c *ServiceCatalog) ListPrincipalsForPortfolioRequest(input *ListPrincipalsForPortfolioInput) (req *request.Request, output *ListPrincipalsForPortfolioOutput) {
@@ -5433,7 +6045,8 @@ func (c *ServiceCatalog) ListPrincipalsForPortfolioRequest(input *ListPrincipals
 
 // ListPrincipalsForPortfolio API operation for AWS Service Catalog.
 //
-// Lists all principal ARNs associated with the specified portfolio.
+// Lists all PrincipalARNs and corresponding PrincipalTypes associated with
+// the specified portfolio.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -5442,12 +6055,13 @@ func (c *ServiceCatalog) ListPrincipalsForPortfolioRequest(input *ListPrincipals
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation ListPrincipalsForPortfolio for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListPrincipalsForPortfolio
 func (c *ServiceCatalog) ListPrincipalsForPortfolio(input *ListPrincipalsForPortfolioInput) (*ListPrincipalsForPortfolioOutput, error) {
@@ -5479,15 +6093,14 @@ func (c *ServiceCatalog) ListPrincipalsForPortfolioWithContext(ctx aws.Context,
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListPrincipalsForPortfolio operation.
-//    pageNum := 0
-//    err := client.ListPrincipalsForPortfolioPages(params,
-//        func(page *servicecatalog.ListPrincipalsForPortfolioOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListPrincipalsForPortfolio operation.
+//	pageNum := 0
+//	err := client.ListPrincipalsForPortfolioPages(params,
+//	    func(page *servicecatalog.ListPrincipalsForPortfolioOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServiceCatalog) ListPrincipalsForPortfolioPages(input *ListPrincipalsForPortfolioInput, fn func(*ListPrincipalsForPortfolioOutput, bool) bool) error {
 	return c.ListPrincipalsForPortfolioPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -5514,10 +6127,12 @@ func (c *ServiceCatalog) ListPrincipalsForPortfolioPagesWithContext(ctx aws.Cont
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListPrincipalsForPortfolioOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListPrincipalsForPortfolioOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -5537,14 +6152,13 @@ const opListProvisionedProductPlans = "ListProvisionedProductPlans"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListProvisionedProductPlansRequest method.
+//	req, resp := client.ListProvisionedProductPlansRequest(params)
 //
-//    // Example sending a request using the ListProvisionedProductPlansRequest method.
-//    req, resp := client.ListProvisionedProductPlansRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListProvisionedProductPlans
 func (c *ServiceCatalog) ListProvisionedProductPlansRequest(input *ListProvisionedProductPlansInput) (req *request.Request, output *ListProvisionedProductPlansOutput) {
@@ -5575,12 +6189,13 @@ func (c *ServiceCatalog) ListProvisionedProductPlansRequest(input *ListProvision
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation ListProvisionedProductPlans for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListProvisionedProductPlans
 func (c *ServiceCatalog) ListProvisionedProductPlans(input *ListProvisionedProductPlansInput) (*ListProvisionedProductPlansOutput, error) {
@@ -5620,14 +6235,13 @@ const opListProvisioningArtifacts = "ListProvisioningArtifacts"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListProvisioningArtifactsRequest method.
+//	req, resp := client.ListProvisioningArtifactsRequest(params)
 //
-//    // Example sending a request using the ListProvisioningArtifactsRequest method.
-//    req, resp := client.ListProvisioningArtifactsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListProvisioningArtifacts
 func (c *ServiceCatalog) ListProvisioningArtifactsRequest(input *ListProvisioningArtifactsInput) (req *request.Request, output *ListProvisioningArtifactsOutput) {
@@ -5658,12 +6272,13 @@ func (c *ServiceCatalog) ListProvisioningArtifactsRequest(input *ListProvisionin
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation ListProvisioningArtifacts for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListProvisioningArtifacts
 func (c *ServiceCatalog) ListProvisioningArtifacts(input *ListProvisioningArtifactsInput) (*ListProvisioningArtifactsOutput, error) {
@@ -5703,14 +6318,13 @@ const opListProvisioningArtifactsForServiceAction = "ListProvisioningArtifactsFo
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListProvisioningArtifactsForServiceActionRequest method.
+//	req, resp := client.ListProvisioningArtifactsForServiceActionRequest(params)
 //
-//    // Example sending a request using the ListProvisioningArtifactsForServiceActionRequest method.
-//    req, resp := client.ListProvisioningArtifactsForServiceActionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListProvisioningArtifactsForServiceAction
 func (c *ServiceCatalog) ListProvisioningArtifactsForServiceActionRequest(input *ListProvisioningArtifactsForServiceActionInput) (req *request.Request, output *ListProvisioningArtifactsForServiceActionOutput) {
@@ -5747,12 +6361,13 @@ func (c *ServiceCatalog) ListProvisioningArtifactsForServiceActionRequest(input
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation ListProvisioningArtifactsForServiceAction for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListProvisioningArtifactsForServiceAction
 func (c *ServiceCatalog) ListProvisioningArtifactsForServiceAction(input *ListProvisioningArtifactsForServiceActionInput) (*ListProvisioningArtifactsForServiceActionOutput, error) {
@@ -5784,15 +6399,14 @@ func (c *ServiceCatalog) ListProvisioningArtifactsForServiceActionWithContext(ct
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListProvisioningArtifactsForServiceAction operation.
-//    pageNum := 0
-//    err := client.ListProvisioningArtifactsForServiceActionPages(params,
-//        func(page *servicecatalog.ListProvisioningArtifactsForServiceActionOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListProvisioningArtifactsForServiceAction operation.
+//	pageNum := 0
+//	err := client.ListProvisioningArtifactsForServiceActionPages(params,
+//	    func(page *servicecatalog.ListProvisioningArtifactsForServiceActionOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServiceCatalog) ListProvisioningArtifactsForServiceActionPages(input *ListProvisioningArtifactsForServiceActionInput, fn func(*ListProvisioningArtifactsForServiceActionOutput, bool) bool) error {
 	return c.ListProvisioningArtifactsForServiceActionPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -5819,10 +6433,12 @@ func (c *ServiceCatalog) ListProvisioningArtifactsForServiceActionPagesWithConte
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListProvisioningArtifactsForServiceActionOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListProvisioningArtifactsForServiceActionOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -5842,14 +6458,13 @@ const opListRecordHistory = "ListRecordHistory"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListRecordHistoryRequest method.
+//	req, resp := client.ListRecordHistoryRequest(params)
 //
-//    // Example sending a request using the ListRecordHistoryRequest method.
-//    req, resp := client.ListRecordHistoryRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListRecordHistory
 func (c *ServiceCatalog) ListRecordHistoryRequest(input *ListRecordHistoryInput) (req *request.Request, output *ListRecordHistoryOutput) {
@@ -5879,9 +6494,9 @@ func (c *ServiceCatalog) ListRecordHistoryRequest(input *ListRecordHistoryInput)
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation ListRecordHistory for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListRecordHistory
 func (c *ServiceCatalog) ListRecordHistory(input *ListRecordHistoryInput) (*ListRecordHistoryOutput, error) {
@@ -5921,14 +6536,13 @@ const opListResourcesForTagOption = "ListResourcesForTagOption"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListResourcesForTagOptionRequest method.
+//	req, resp := client.ListResourcesForTagOptionRequest(params)
 //
-//    // Example sending a request using the ListResourcesForTagOptionRequest method.
-//    req, resp := client.ListResourcesForTagOptionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListResourcesForTagOption
 func (c *ServiceCatalog) ListResourcesForTagOptionRequest(input *ListResourcesForTagOptionInput) (req *request.Request, output *ListResourcesForTagOptionOutput) {
@@ -5964,17 +6578,18 @@ func (c *ServiceCatalog) ListResourcesForTagOptionRequest(input *ListResourcesFo
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation ListResourcesForTagOption for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeTagOptionNotMigratedException "TagOptionNotMigratedException"
-//   An operation requiring TagOptions failed because the TagOptions migration
-//   process has not been performed for this account. Please use the AWS console
-//   to perform the migration process before retrying the operation.
+// Returned Error Types:
+//
+//   - TagOptionNotMigratedException
+//     An operation requiring TagOptions failed because the TagOptions migration
+//     process has not been performed for this account. Use the Amazon Web Services
+//     Management Console to perform the migration process before retrying the operation.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListResourcesForTagOption
 func (c *ServiceCatalog) ListResourcesForTagOption(input *ListResourcesForTagOptionInput) (*ListResourcesForTagOptionOutput, error) {
@@ -6006,15 +6621,14 @@ func (c *ServiceCatalog) ListResourcesForTagOptionWithContext(ctx aws.Context, i
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListResourcesForTagOption operation.
-//    pageNum := 0
-//    err := client.ListResourcesForTagOptionPages(params,
-//        func(page *servicecatalog.ListResourcesForTagOptionOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListResourcesForTagOption operation.
+//	pageNum := 0
+//	err := client.ListResourcesForTagOptionPages(params,
+//	    func(page *servicecatalog.ListResourcesForTagOptionOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServiceCatalog) ListResourcesForTagOptionPages(input *ListResourcesForTagOptionInput, fn func(*ListResourcesForTagOptionOutput, bool) bool) error {
 	return c.ListResourcesForTagOptionPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -6041,10 +6655,12 @@ func (c *ServiceCatalog) ListResourcesForTagOptionPagesWithContext(ctx aws.Conte
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListResourcesForTagOptionOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListResourcesForTagOptionOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -6064,14 +6680,13 @@ const opListServiceActions = "ListServiceActions"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListServiceActionsRequest method.
+//	req, resp := client.ListServiceActionsRequest(params)
 //
-//    // Example sending a request using the ListServiceActionsRequest method.
-//    req, resp := client.ListServiceActionsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListServiceActions
 func (c *ServiceCatalog) ListServiceActionsRequest(input *ListServiceActionsInput) (req *request.Request, output *ListServiceActionsOutput) {
@@ -6107,9 +6722,9 @@ func (c *ServiceCatalog) ListServiceActionsRequest(input *ListServiceActionsInpu
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation ListServiceActions for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListServiceActions
 func (c *ServiceCatalog) ListServiceActions(input *ListServiceActionsInput) (*ListServiceActionsOutput, error) {
@@ -6141,15 +6756,14 @@ func (c *ServiceCatalog) ListServiceActionsWithContext(ctx aws.Context, input *L
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListServiceActions operation.
-//    pageNum := 0
-//    err := client.ListServiceActionsPages(params,
-//        func(page *servicecatalog.ListServiceActionsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListServiceActions operation.
+//	pageNum := 0
+//	err := client.ListServiceActionsPages(params,
+//	    func(page *servicecatalog.ListServiceActionsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServiceCatalog) ListServiceActionsPages(input *ListServiceActionsInput, fn func(*ListServiceActionsOutput, bool) bool) error {
 	return c.ListServiceActionsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -6176,10 +6790,12 @@ func (c *ServiceCatalog) ListServiceActionsPagesWithContext(ctx aws.Context, inp
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListServiceActionsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListServiceActionsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -6199,14 +6815,13 @@ const opListServiceActionsForProvisioningArtifact = "ListServiceActionsForProvis
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListServiceActionsForProvisioningArtifactRequest method.
+//	req, resp := client.ListServiceActionsForProvisioningArtifactRequest(params)
 //
-//    // Example sending a request using the ListServiceActionsForProvisioningArtifactRequest method.
-//    req, resp := client.ListServiceActionsForProvisioningArtifactRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListServiceActionsForProvisioningArtifact
 func (c *ServiceCatalog) ListServiceActionsForProvisioningArtifactRequest(input *ListServiceActionsForProvisioningArtifactInput) (req *request.Request, output *ListServiceActionsForProvisioningArtifactOutput) {
@@ -6243,12 +6858,13 @@ func (c *ServiceCatalog) ListServiceActionsForProvisioningArtifactRequest(input
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation ListServiceActionsForProvisioningArtifact for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListServiceActionsForProvisioningArtifact
 func (c *ServiceCatalog) ListServiceActionsForProvisioningArtifact(input *ListServiceActionsForProvisioningArtifactInput) (*ListServiceActionsForProvisioningArtifactOutput, error) {
@@ -6280,15 +6896,14 @@ func (c *ServiceCatalog) ListServiceActionsForProvisioningArtifactWithContext(ct
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListServiceActionsForProvisioningArtifact operation.
-//    pageNum := 0
-//    err := client.ListServiceActionsForProvisioningArtifactPages(params,
-//        func(page *servicecatalog.ListServiceActionsForProvisioningArtifactOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListServiceActionsForProvisioningArtifact operation.
+//	pageNum := 0
+//	err := client.ListServiceActionsForProvisioningArtifactPages(params,
+//	    func(page *servicecatalog.ListServiceActionsForProvisioningArtifactOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServiceCatalog) ListServiceActionsForProvisioningArtifactPages(input *ListServiceActionsForProvisioningArtifactInput, fn func(*ListServiceActionsForProvisioningArtifactOutput, bool) bool) error {
 	return c.ListServiceActionsForProvisioningArtifactPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -6315,10 +6930,12 @@ func (c *ServiceCatalog) ListServiceActionsForProvisioningArtifactPagesWithConte
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListServiceActionsForProvisioningArtifactOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListServiceActionsForProvisioningArtifactOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -6338,14 +6955,13 @@ const opListStackInstancesForProvisionedProduct = "ListStackInstancesForProvisio
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListStackInstancesForProvisionedProductRequest method.
+//	req, resp := client.ListStackInstancesForProvisionedProductRequest(params)
 //
-//    // Example sending a request using the ListStackInstancesForProvisionedProductRequest method.
-//    req, resp := client.ListStackInstancesForProvisionedProductRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListStackInstancesForProvisionedProduct
 func (c *ServiceCatalog) ListStackInstancesForProvisionedProductRequest(input *ListStackInstancesForProvisionedProductInput) (req *request.Request, output *ListStackInstancesForProvisionedProductOutput) {
@@ -6368,7 +6984,8 @@ func (c *ServiceCatalog) ListStackInstancesForProvisionedProductRequest(input *L
 //
 // Returns summary information about stack instances that are associated with
 // the specified CFN_STACKSET type provisioned product. You can filter for stack
-// instances that are associated with a specific AWS account name or region.
+// instances that are associated with a specific Amazon Web Services account
+// name or Region.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -6377,12 +6994,13 @@ func (c *ServiceCatalog) ListStackInstancesForProvisionedProductRequest(input *L
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation ListStackInstancesForProvisionedProduct for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListStackInstancesForProvisionedProduct
 func (c *ServiceCatalog) ListStackInstancesForProvisionedProduct(input *ListStackInstancesForProvisionedProductInput) (*ListStackInstancesForProvisionedProductOutput, error) {
@@ -6422,14 +7040,13 @@ const opListTagOptions = "ListTagOptions"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTagOptionsRequest method.
+//	req, resp := client.ListTagOptionsRequest(params)
 //
-//    // Example sending a request using the ListTagOptionsRequest method.
-//    req, resp := client.ListTagOptionsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListTagOptions
 func (c *ServiceCatalog) ListTagOptionsRequest(input *ListTagOptionsInput) (req *request.Request, output *ListTagOptionsOutput) {
@@ -6465,14 +7082,15 @@ func (c *ServiceCatalog) ListTagOptionsRequest(input *ListTagOptionsInput) (req
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation ListTagOptions for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeTagOptionNotMigratedException "TagOptionNotMigratedException"
-//   An operation requiring TagOptions failed because the TagOptions migration
-//   process has not been performed for this account. Please use the AWS console
-//   to perform the migration process before retrying the operation.
+// Returned Error Types:
+//
+//   - TagOptionNotMigratedException
+//     An operation requiring TagOptions failed because the TagOptions migration
+//     process has not been performed for this account. Use the Amazon Web Services
+//     Management Console to perform the migration process before retrying the operation.
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ListTagOptions
 func (c *ServiceCatalog) ListTagOptions(input *ListTagOptionsInput) (*ListTagOptionsOutput, error) {
@@ -6504,15 +7122,14 @@ func (c *ServiceCatalog) ListTagOptionsWithContext(ctx aws.Context, input *ListT
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListTagOptions operation.
-//    pageNum := 0
-//    err := client.ListTagOptionsPages(params,
-//        func(page *servicecatalog.ListTagOptionsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListTagOptions operation.
+//	pageNum := 0
+//	err := client.ListTagOptionsPages(params,
+//	    func(page *servicecatalog.ListTagOptionsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServiceCatalog) ListTagOptionsPages(input *ListTagOptionsInput, fn func(*ListTagOptionsOutput, bool) bool) error {
 	return c.ListTagOptionsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -6539,13 +7156,264 @@ func (c *ServiceCatalog) ListTagOptionsPagesWithContext(ctx aws.Context, input *
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListTagOptionsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListTagOptionsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
+const opNotifyProvisionProductEngineWorkflowResult = "NotifyProvisionProductEngineWorkflowResult"
+
+// NotifyProvisionProductEngineWorkflowResultRequest generates a "aws/request.Request" representing the
+// client's request for the NotifyProvisionProductEngineWorkflowResult operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See NotifyProvisionProductEngineWorkflowResult for more information on using the NotifyProvisionProductEngineWorkflowResult
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the NotifyProvisionProductEngineWorkflowResultRequest method.
+//	req, resp := client.NotifyProvisionProductEngineWorkflowResultRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/NotifyProvisionProductEngineWorkflowResult
+func (c *ServiceCatalog) NotifyProvisionProductEngineWorkflowResultRequest(input *NotifyProvisionProductEngineWorkflowResultInput) (req *request.Request, output *NotifyProvisionProductEngineWorkflowResultOutput) {
+	op := &request.Operation{
+		Name:       opNotifyProvisionProductEngineWorkflowResult,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &NotifyProvisionProductEngineWorkflowResultInput{}
+	}
+
+	output = &NotifyProvisionProductEngineWorkflowResultOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// NotifyProvisionProductEngineWorkflowResult API operation for AWS Service Catalog.
+//
+// Notifies the result of the provisioning engine execution.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Service Catalog's
+// API operation NotifyProvisionProductEngineWorkflowResult for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/NotifyProvisionProductEngineWorkflowResult
+func (c *ServiceCatalog) NotifyProvisionProductEngineWorkflowResult(input *NotifyProvisionProductEngineWorkflowResultInput) (*NotifyProvisionProductEngineWorkflowResultOutput, error) {
+	req, out := c.NotifyProvisionProductEngineWorkflowResultRequest(input)
+	return out, req.Send()
+}
+
+// NotifyProvisionProductEngineWorkflowResultWithContext is the same as NotifyProvisionProductEngineWorkflowResult with the addition of
+// the ability to pass a context and additional request options.
+//
+// See NotifyProvisionProductEngineWorkflowResult for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *ServiceCatalog) NotifyProvisionProductEngineWorkflowResultWithContext(ctx aws.Context, input *NotifyProvisionProductEngineWorkflowResultInput, opts ...request.Option) (*NotifyProvisionProductEngineWorkflowResultOutput, error) {
+	req, out := c.NotifyProvisionProductEngineWorkflowResultRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opNotifyTerminateProvisionedProductEngineWorkflowResult = "NotifyTerminateProvisionedProductEngineWorkflowResult"
+
+// NotifyTerminateProvisionedProductEngineWorkflowResultRequest generates a "aws/request.Request" representing the
+// client's request for the NotifyTerminateProvisionedProductEngineWorkflowResult operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See NotifyTerminateProvisionedProductEngineWorkflowResult for more information on using the NotifyTerminateProvisionedProductEngineWorkflowResult
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the NotifyTerminateProvisionedProductEngineWorkflowResultRequest method.
+//	req, resp := client.NotifyTerminateProvisionedProductEngineWorkflowResultRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/NotifyTerminateProvisionedProductEngineWorkflowResult
+func (c *ServiceCatalog) NotifyTerminateProvisionedProductEngineWorkflowResultRequest(input *NotifyTerminateProvisionedProductEngineWorkflowResultInput) (req *request.Request, output *NotifyTerminateProvisionedProductEngineWorkflowResultOutput) {
+	op := &request.Operation{
+		Name:       opNotifyTerminateProvisionedProductEngineWorkflowResult,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &NotifyTerminateProvisionedProductEngineWorkflowResultInput{}
+	}
+
+	output = &NotifyTerminateProvisionedProductEngineWorkflowResultOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// NotifyTerminateProvisionedProductEngineWorkflowResult API operation for AWS Service Catalog.
+//
+// Notifies the result of the terminate engine execution.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Service Catalog's
+// API operation NotifyTerminateProvisionedProductEngineWorkflowResult for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/NotifyTerminateProvisionedProductEngineWorkflowResult
+func (c *ServiceCatalog) NotifyTerminateProvisionedProductEngineWorkflowResult(input *NotifyTerminateProvisionedProductEngineWorkflowResultInput) (*NotifyTerminateProvisionedProductEngineWorkflowResultOutput, error) {
+	req, out := c.NotifyTerminateProvisionedProductEngineWorkflowResultRequest(input)
+	return out, req.Send()
+}
+
+// NotifyTerminateProvisionedProductEngineWorkflowResultWithContext is the same as NotifyTerminateProvisionedProductEngineWorkflowResult with the addition of
+// the ability to pass a context and additional request options.
+//
+// See NotifyTerminateProvisionedProductEngineWorkflowResult for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *ServiceCatalog) NotifyTerminateProvisionedProductEngineWorkflowResultWithContext(ctx aws.Context, input *NotifyTerminateProvisionedProductEngineWorkflowResultInput, opts ...request.Option) (*NotifyTerminateProvisionedProductEngineWorkflowResultOutput, error) {
+	req, out := c.NotifyTerminateProvisionedProductEngineWorkflowResultRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opNotifyUpdateProvisionedProductEngineWorkflowResult = "NotifyUpdateProvisionedProductEngineWorkflowResult"
+
+// NotifyUpdateProvisionedProductEngineWorkflowResultRequest generates a "aws/request.Request" representing the
+// client's request for the NotifyUpdateProvisionedProductEngineWorkflowResult operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See NotifyUpdateProvisionedProductEngineWorkflowResult for more information on using the NotifyUpdateProvisionedProductEngineWorkflowResult
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the NotifyUpdateProvisionedProductEngineWorkflowResultRequest method.
+//	req, resp := client.NotifyUpdateProvisionedProductEngineWorkflowResultRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/NotifyUpdateProvisionedProductEngineWorkflowResult
+func (c *ServiceCatalog) NotifyUpdateProvisionedProductEngineWorkflowResultRequest(input *NotifyUpdateProvisionedProductEngineWorkflowResultInput) (req *request.Request, output *NotifyUpdateProvisionedProductEngineWorkflowResultOutput) {
+	op := &request.Operation{
+		Name:       opNotifyUpdateProvisionedProductEngineWorkflowResult,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &NotifyUpdateProvisionedProductEngineWorkflowResultInput{}
+	}
+
+	output = &NotifyUpdateProvisionedProductEngineWorkflowResultOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// NotifyUpdateProvisionedProductEngineWorkflowResult API operation for AWS Service Catalog.
+//
+// Notifies the result of the update engine execution.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Service Catalog's
+// API operation NotifyUpdateProvisionedProductEngineWorkflowResult for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/NotifyUpdateProvisionedProductEngineWorkflowResult
+func (c *ServiceCatalog) NotifyUpdateProvisionedProductEngineWorkflowResult(input *NotifyUpdateProvisionedProductEngineWorkflowResultInput) (*NotifyUpdateProvisionedProductEngineWorkflowResultOutput, error) {
+	req, out := c.NotifyUpdateProvisionedProductEngineWorkflowResultRequest(input)
+	return out, req.Send()
+}
+
+// NotifyUpdateProvisionedProductEngineWorkflowResultWithContext is the same as NotifyUpdateProvisionedProductEngineWorkflowResult with the addition of
+// the ability to pass a context and additional request options.
+//
+// See NotifyUpdateProvisionedProductEngineWorkflowResult for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *ServiceCatalog) NotifyUpdateProvisionedProductEngineWorkflowResultWithContext(ctx aws.Context, input *NotifyUpdateProvisionedProductEngineWorkflowResultInput, opts ...request.Option) (*NotifyUpdateProvisionedProductEngineWorkflowResultOutput, error) {
+	req, out := c.NotifyUpdateProvisionedProductEngineWorkflowResultRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opProvisionProduct = "ProvisionProduct"
 
 // ProvisionProductRequest generates a "aws/request.Request" representing the
@@ -6562,14 +7430,13 @@ const opProvisionProduct = "ProvisionProduct"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ProvisionProductRequest method.
+//	req, resp := client.ProvisionProductRequest(params)
 //
-//    // Example sending a request using the ProvisionProductRequest method.
-//    req, resp := client.ProvisionProductRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ProvisionProduct
 func (c *ServiceCatalog) ProvisionProductRequest(input *ProvisionProductInput) (req *request.Request, output *ProvisionProductOutput) {
@@ -6593,15 +7460,20 @@ func (c *ServiceCatalog) ProvisionProductRequest(input *ProvisionProductInput) (
 // Provisions the specified product.
 //
 // A provisioned product is a resourced instance of a product. For example,
-// provisioning a product based on a CloudFormation template launches a CloudFormation
-// stack and its underlying resources. You can check the status of this request
-// using DescribeRecord.
+// provisioning a product that's based on an CloudFormation template launches
+// an CloudFormation stack and its underlying resources. You can check the status
+// of this request using DescribeRecord.
 //
-// If the request contains a tag key with an empty list of values, there is
-// a tag conflict for that key. Do not include conflicted keys as tags, or this
-// causes the error "Parameter validation failed: Missing required parameter
+// If the request contains a tag key with an empty list of values, there's a
+// tag conflict for that key. Don't include conflicted keys as tags, or this
+// will cause the error "Parameter validation failed: Missing required parameter
 // in Tags[N]:Value".
 //
+// When provisioning a product that's been added to a portfolio, you must grant
+// your user, group, or role access to the portfolio. For more information,
+// see Granting users access (https://docs.aws.amazon.com/servicecatalog/latest/adminguide/catalogs_portfolios_users.html)
+// in the Service Catalog User Guide.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -6609,15 +7481,16 @@ func (c *ServiceCatalog) ProvisionProductRequest(input *ProvisionProductInput) (
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation ProvisionProduct for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-//   * ErrCodeDuplicateResourceException "DuplicateResourceException"
-//   The specified resource is a duplicate.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
+//
+//   - DuplicateResourceException
+//     The specified resource is a duplicate.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ProvisionProduct
 func (c *ServiceCatalog) ProvisionProduct(input *ProvisionProductInput) (*ProvisionProductOutput, error) {
@@ -6657,14 +7530,13 @@ const opRejectPortfolioShare = "RejectPortfolioShare"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the RejectPortfolioShareRequest method.
+//	req, resp := client.RejectPortfolioShareRequest(params)
 //
-//    // Example sending a request using the RejectPortfolioShareRequest method.
-//    req, resp := client.RejectPortfolioShareRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/RejectPortfolioShare
 func (c *ServiceCatalog) RejectPortfolioShareRequest(input *RejectPortfolioShareInput) (req *request.Request, output *RejectPortfolioShareOutput) {
@@ -6695,9 +7567,9 @@ func (c *ServiceCatalog) RejectPortfolioShareRequest(input *RejectPortfolioShare
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation RejectPortfolioShare for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/RejectPortfolioShare
 func (c *ServiceCatalog) RejectPortfolioShare(input *RejectPortfolioShareInput) (*RejectPortfolioShareOutput, error) {
@@ -6737,14 +7609,13 @@ const opScanProvisionedProducts = "ScanProvisionedProducts"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ScanProvisionedProductsRequest method.
+//	req, resp := client.ScanProvisionedProductsRequest(params)
 //
-//    // Example sending a request using the ScanProvisionedProductsRequest method.
-//    req, resp := client.ScanProvisionedProductsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ScanProvisionedProducts
 func (c *ServiceCatalog) ScanProvisionedProductsRequest(input *ScanProvisionedProductsInput) (req *request.Request, output *ScanProvisionedProductsOutput) {
@@ -6776,9 +7647,9 @@ func (c *ServiceCatalog) ScanProvisionedProductsRequest(input *ScanProvisionedPr
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation ScanProvisionedProducts for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/ScanProvisionedProducts
 func (c *ServiceCatalog) ScanProvisionedProducts(input *ScanProvisionedProductsInput) (*ScanProvisionedProductsOutput, error) {
@@ -6818,14 +7689,13 @@ const opSearchProducts = "SearchProducts"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SearchProductsRequest method.
+//	req, resp := client.SearchProductsRequest(params)
 //
-//    // Example sending a request using the SearchProductsRequest method.
-//    req, resp := client.SearchProductsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/SearchProducts
 func (c *ServiceCatalog) SearchProductsRequest(input *SearchProductsInput) (req *request.Request, output *SearchProductsOutput) {
@@ -6861,9 +7731,9 @@ func (c *ServiceCatalog) SearchProductsRequest(input *SearchProductsInput) (req
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation SearchProducts for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/SearchProducts
 func (c *ServiceCatalog) SearchProducts(input *SearchProductsInput) (*SearchProductsOutput, error) {
@@ -6895,15 +7765,14 @@ func (c *ServiceCatalog) SearchProductsWithContext(ctx aws.Context, input *Searc
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a SearchProducts operation.
-//    pageNum := 0
-//    err := client.SearchProductsPages(params,
-//        func(page *servicecatalog.SearchProductsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a SearchProducts operation.
+//	pageNum := 0
+//	err := client.SearchProductsPages(params,
+//	    func(page *servicecatalog.SearchProductsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServiceCatalog) SearchProductsPages(input *SearchProductsInput, fn func(*SearchProductsOutput, bool) bool) error {
 	return c.SearchProductsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -6930,10 +7799,12 @@ func (c *ServiceCatalog) SearchProductsPagesWithContext(ctx aws.Context, input *
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*SearchProductsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*SearchProductsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -6953,14 +7824,13 @@ const opSearchProductsAsAdmin = "SearchProductsAsAdmin"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SearchProductsAsAdminRequest method.
+//	req, resp := client.SearchProductsAsAdminRequest(params)
 //
-//    // Example sending a request using the SearchProductsAsAdminRequest method.
-//    req, resp := client.SearchProductsAsAdminRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/SearchProductsAsAdmin
 func (c *ServiceCatalog) SearchProductsAsAdminRequest(input *SearchProductsAsAdminInput) (req *request.Request, output *SearchProductsAsAdminOutput) {
@@ -6996,12 +7866,13 @@ func (c *ServiceCatalog) SearchProductsAsAdminRequest(input *SearchProductsAsAdm
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation SearchProductsAsAdmin for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/SearchProductsAsAdmin
 func (c *ServiceCatalog) SearchProductsAsAdmin(input *SearchProductsAsAdminInput) (*SearchProductsAsAdminOutput, error) {
@@ -7033,15 +7904,14 @@ func (c *ServiceCatalog) SearchProductsAsAdminWithContext(ctx aws.Context, input
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a SearchProductsAsAdmin operation.
-//    pageNum := 0
-//    err := client.SearchProductsAsAdminPages(params,
-//        func(page *servicecatalog.SearchProductsAsAdminOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a SearchProductsAsAdmin operation.
+//	pageNum := 0
+//	err := client.SearchProductsAsAdminPages(params,
+//	    func(page *servicecatalog.SearchProductsAsAdminOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServiceCatalog) SearchProductsAsAdminPages(input *SearchProductsAsAdminInput, fn func(*SearchProductsAsAdminOutput, bool) bool) error {
 	return c.SearchProductsAsAdminPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -7068,10 +7938,12 @@ func (c *ServiceCatalog) SearchProductsAsAdminPagesWithContext(ctx aws.Context,
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*SearchProductsAsAdminOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*SearchProductsAsAdminOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -7091,14 +7963,13 @@ const opSearchProvisionedProducts = "SearchProvisionedProducts"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SearchProvisionedProductsRequest method.
+//	req, resp := client.SearchProvisionedProductsRequest(params)
 //
-//    // Example sending a request using the SearchProvisionedProductsRequest method.
-//    req, resp := client.SearchProvisionedProductsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/SearchProvisionedProducts
 func (c *ServiceCatalog) SearchProvisionedProductsRequest(input *SearchProvisionedProductsInput) (req *request.Request, output *SearchProvisionedProductsOutput) {
@@ -7134,9 +8005,9 @@ func (c *ServiceCatalog) SearchProvisionedProductsRequest(input *SearchProvision
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation SearchProvisionedProducts for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/SearchProvisionedProducts
 func (c *ServiceCatalog) SearchProvisionedProducts(input *SearchProvisionedProductsInput) (*SearchProvisionedProductsOutput, error) {
@@ -7168,15 +8039,14 @@ func (c *ServiceCatalog) SearchProvisionedProductsWithContext(ctx aws.Context, i
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a SearchProvisionedProducts operation.
-//    pageNum := 0
-//    err := client.SearchProvisionedProductsPages(params,
-//        func(page *servicecatalog.SearchProvisionedProductsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a SearchProvisionedProducts operation.
+//	pageNum := 0
+//	err := client.SearchProvisionedProductsPages(params,
+//	    func(page *servicecatalog.SearchProvisionedProductsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServiceCatalog) SearchProvisionedProductsPages(input *SearchProvisionedProductsInput, fn func(*SearchProvisionedProductsOutput, bool) bool) error {
 	return c.SearchProvisionedProductsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -7203,10 +8073,12 @@ func (c *ServiceCatalog) SearchProvisionedProductsPagesWithContext(ctx aws.Conte
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*SearchProvisionedProductsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*SearchProvisionedProductsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -7226,14 +8098,13 @@ const opTerminateProvisionedProduct = "TerminateProvisionedProduct"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the TerminateProvisionedProductRequest method.
+//	req, resp := client.TerminateProvisionedProductRequest(params)
 //
-//    // Example sending a request using the TerminateProvisionedProductRequest method.
-//    req, resp := client.TerminateProvisionedProductRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/TerminateProvisionedProduct
 func (c *ServiceCatalog) TerminateProvisionedProductRequest(input *TerminateProvisionedProductInput) (req *request.Request, output *TerminateProvisionedProductOutput) {
@@ -7268,9 +8139,9 @@ func (c *ServiceCatalog) TerminateProvisionedProductRequest(input *TerminateProv
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation TerminateProvisionedProduct for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/TerminateProvisionedProduct
 func (c *ServiceCatalog) TerminateProvisionedProduct(input *TerminateProvisionedProductInput) (*TerminateProvisionedProductOutput, error) {
@@ -7310,14 +8181,13 @@ const opUpdateConstraint = "UpdateConstraint"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateConstraintRequest method.
+//	req, resp := client.UpdateConstraintRequest(params)
 //
-//    // Example sending a request using the UpdateConstraintRequest method.
-//    req, resp := client.UpdateConstraintRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/UpdateConstraint
 func (c *ServiceCatalog) UpdateConstraintRequest(input *UpdateConstraintInput) (req *request.Request, output *UpdateConstraintOutput) {
@@ -7347,12 +8217,13 @@ func (c *ServiceCatalog) UpdateConstraintRequest(input *UpdateConstraintInput) (
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation UpdateConstraint for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/UpdateConstraint
 func (c *ServiceCatalog) UpdateConstraint(input *UpdateConstraintInput) (*UpdateConstraintOutput, error) {
@@ -7392,14 +8263,13 @@ const opUpdatePortfolio = "UpdatePortfolio"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdatePortfolioRequest method.
+//	req, resp := client.UpdatePortfolioRequest(params)
 //
-//    // Example sending a request using the UpdatePortfolioRequest method.
-//    req, resp := client.UpdatePortfolioRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/UpdatePortfolio
 func (c *ServiceCatalog) UpdatePortfolioRequest(input *UpdatePortfolioInput) (req *request.Request, output *UpdatePortfolioOutput) {
@@ -7431,22 +8301,23 @@ func (c *ServiceCatalog) UpdatePortfolioRequest(input *UpdatePortfolioInput) (re
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation UpdatePortfolio for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-//   * ErrCodeLimitExceededException "LimitExceededException"
-//   The current limits of the service would have been exceeded by this operation.
-//   Decrease your resource use or increase your service limits and retry the
-//   operation.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeTagOptionNotMigratedException "TagOptionNotMigratedException"
-//   An operation requiring TagOptions failed because the TagOptions migration
-//   process has not been performed for this account. Please use the AWS console
-//   to perform the migration process before retrying the operation.
+//   - LimitExceededException
+//     The current limits of the service would have been exceeded by this operation.
+//     Decrease your resource use or increase your service limits and retry the
+//     operation.
+//
+//   - TagOptionNotMigratedException
+//     An operation requiring TagOptions failed because the TagOptions migration
+//     process has not been performed for this account. Use the Amazon Web Services
+//     Management Console to perform the migration process before retrying the operation.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/UpdatePortfolio
 func (c *ServiceCatalog) UpdatePortfolio(input *UpdatePortfolioInput) (*UpdatePortfolioOutput, error) {
@@ -7470,122 +8341,238 @@ func (c *ServiceCatalog) UpdatePortfolioWithContext(ctx aws.Context, input *Upda
 	return out, req.Send()
 }
 
-const opUpdateProduct = "UpdateProduct"
+const opUpdatePortfolioShare = "UpdatePortfolioShare"
 
-// UpdateProductRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateProduct operation. The "output" return
+// UpdatePortfolioShareRequest generates a "aws/request.Request" representing the
+// client's request for the UpdatePortfolioShare operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateProduct for more information on using the UpdateProduct
+// See UpdatePortfolioShare for more information on using the UpdatePortfolioShare
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdatePortfolioShareRequest method.
+//	req, resp := client.UpdatePortfolioShareRequest(params)
 //
-//    // Example sending a request using the UpdateProductRequest method.
-//    req, resp := client.UpdateProductRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/UpdateProduct
-func (c *ServiceCatalog) UpdateProductRequest(input *UpdateProductInput) (req *request.Request, output *UpdateProductOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/UpdatePortfolioShare
+func (c *ServiceCatalog) UpdatePortfolioShareRequest(input *UpdatePortfolioShareInput) (req *request.Request, output *UpdatePortfolioShareOutput) {
 	op := &request.Operation{
-		Name:       opUpdateProduct,
+		Name:       opUpdatePortfolioShare,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &UpdateProductInput{}
+		input = &UpdatePortfolioShareInput{}
 	}
 
-	output = &UpdateProductOutput{}
+	output = &UpdatePortfolioShareOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// UpdateProduct API operation for AWS Service Catalog.
+// UpdatePortfolioShare API operation for AWS Service Catalog.
 //
-// Updates the specified product.
+// Updates the specified portfolio share. You can use this API to enable or
+// disable TagOptions sharing or Principal sharing for an existing portfolio
+// share.
+//
+// The portfolio share cannot be updated if the CreatePortfolioShare operation
+// is IN_PROGRESS, as the share is not available to recipient entities. In this
+// case, you must wait for the portfolio share to be completed.
+//
+// You must provide the accountId or organization node in the input, but not
+// both.
+//
+// If the portfolio is shared to both an external account and an organization
+// node, and both shares need to be updated, you must invoke UpdatePortfolioShare
+// separately for each share type.
+//
+// This API cannot be used for removing the portfolio share. You must use DeletePortfolioShare
+// API for that action.
+//
+// When you associate a principal with portfolio, a potential privilege escalation
+// path may occur when that portfolio is then shared with other accounts. For
+// a user in a recipient account who is not an Service Catalog Admin, but still
+// has the ability to create Principals (Users/Groups/Roles), that user could
+// create a role that matches a principal name association for the portfolio.
+// Although this user may not know which principal names are associated through
+// Service Catalog, they may be able to guess the user. If this potential escalation
+// path is a concern, then Service Catalog recommends using PrincipalType as
+// IAM. With this configuration, the PrincipalARN must already exist in the
+// recipient account before it can be associated.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS Service Catalog's
-// API operation UpdateProduct for usage and error information.
+// API operation UpdatePortfolioShare for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeTagOptionNotMigratedException "TagOptionNotMigratedException"
-//   An operation requiring TagOptions failed because the TagOptions migration
-//   process has not been performed for this account. Please use the AWS console
-//   to perform the migration process before retrying the operation.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/UpdateProduct
-func (c *ServiceCatalog) UpdateProduct(input *UpdateProductInput) (*UpdateProductOutput, error) {
-	req, out := c.UpdateProductRequest(input)
+//   - OperationNotSupportedException
+//     The operation is not supported.
+//
+//   - InvalidStateException
+//     An attempt was made to modify a resource that is in a state that is not valid.
+//     Check your resources to ensure that they are in valid states before retrying
+//     the operation.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/UpdatePortfolioShare
+func (c *ServiceCatalog) UpdatePortfolioShare(input *UpdatePortfolioShareInput) (*UpdatePortfolioShareOutput, error) {
+	req, out := c.UpdatePortfolioShareRequest(input)
 	return out, req.Send()
 }
 
-// UpdateProductWithContext is the same as UpdateProduct with the addition of
+// UpdatePortfolioShareWithContext is the same as UpdatePortfolioShare with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateProduct for details on how to use this API operation.
+// See UpdatePortfolioShare for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *ServiceCatalog) UpdateProductWithContext(ctx aws.Context, input *UpdateProductInput, opts ...request.Option) (*UpdateProductOutput, error) {
-	req, out := c.UpdateProductRequest(input)
+func (c *ServiceCatalog) UpdatePortfolioShareWithContext(ctx aws.Context, input *UpdatePortfolioShareInput, opts ...request.Option) (*UpdatePortfolioShareOutput, error) {
+	req, out := c.UpdatePortfolioShareRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateProvisionedProduct = "UpdateProvisionedProduct"
+const opUpdateProduct = "UpdateProduct"
 
-// UpdateProvisionedProductRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateProvisionedProduct operation. The "output" return
+// UpdateProductRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateProduct operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateProvisionedProduct for more information on using the UpdateProvisionedProduct
+// See UpdateProduct for more information on using the UpdateProduct
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateProductRequest method.
+//	req, resp := client.UpdateProductRequest(params)
 //
-//    // Example sending a request using the UpdateProvisionedProductRequest method.
-//    req, resp := client.UpdateProvisionedProductRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/UpdateProvisionedProduct
-func (c *ServiceCatalog) UpdateProvisionedProductRequest(input *UpdateProvisionedProductInput) (req *request.Request, output *UpdateProvisionedProductOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/UpdateProduct
+func (c *ServiceCatalog) UpdateProductRequest(input *UpdateProductInput) (req *request.Request, output *UpdateProductOutput) {
 	op := &request.Operation{
-		Name:       opUpdateProvisionedProduct,
+		Name:       opUpdateProduct,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UpdateProductInput{}
+	}
+
+	output = &UpdateProductOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UpdateProduct API operation for AWS Service Catalog.
+//
+// Updates the specified product.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS Service Catalog's
+// API operation UpdateProduct for usage and error information.
+//
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
+//
+//   - TagOptionNotMigratedException
+//     An operation requiring TagOptions failed because the TagOptions migration
+//     process has not been performed for this account. Use the Amazon Web Services
+//     Management Console to perform the migration process before retrying the operation.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/UpdateProduct
+func (c *ServiceCatalog) UpdateProduct(input *UpdateProductInput) (*UpdateProductOutput, error) {
+	req, out := c.UpdateProductRequest(input)
+	return out, req.Send()
+}
+
+// UpdateProductWithContext is the same as UpdateProduct with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateProduct for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *ServiceCatalog) UpdateProductWithContext(ctx aws.Context, input *UpdateProductInput, opts ...request.Option) (*UpdateProductOutput, error) {
+	req, out := c.UpdateProductRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateProvisionedProduct = "UpdateProvisionedProduct"
+
+// UpdateProvisionedProductRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateProvisionedProduct operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateProvisionedProduct for more information on using the UpdateProvisionedProduct
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateProvisionedProductRequest method.
+//	req, resp := client.UpdateProvisionedProductRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/UpdateProvisionedProduct
+func (c *ServiceCatalog) UpdateProvisionedProductRequest(input *UpdateProvisionedProductInput) (req *request.Request, output *UpdateProvisionedProductOutput) {
+	op := &request.Operation{
+		Name:       opUpdateProvisionedProduct,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
@@ -7617,12 +8604,13 @@ func (c *ServiceCatalog) UpdateProvisionedProductRequest(input *UpdateProvisione
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation UpdateProvisionedProduct for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/UpdateProvisionedProduct
 func (c *ServiceCatalog) UpdateProvisionedProduct(input *UpdateProvisionedProductInput) (*UpdateProvisionedProductOutput, error) {
@@ -7662,14 +8650,13 @@ const opUpdateProvisionedProductProperties = "UpdateProvisionedProductProperties
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateProvisionedProductPropertiesRequest method.
+//	req, resp := client.UpdateProvisionedProductPropertiesRequest(params)
 //
-//    // Example sending a request using the UpdateProvisionedProductPropertiesRequest method.
-//    req, resp := client.UpdateProvisionedProductPropertiesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/UpdateProvisionedProductProperties
 func (c *ServiceCatalog) UpdateProvisionedProductPropertiesRequest(input *UpdateProvisionedProductPropertiesInput) (req *request.Request, output *UpdateProvisionedProductPropertiesOutput) {
@@ -7699,17 +8686,18 @@ func (c *ServiceCatalog) UpdateProvisionedProductPropertiesRequest(input *Update
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation UpdateProvisionedProductProperties for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+// Returned Error Types:
+//
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeInvalidStateException "InvalidStateException"
-//   An attempt was made to modify a resource that is in a state that is not valid.
-//   Check your resources to ensure that they are in valid states before retrying
-//   the operation.
+//   - InvalidStateException
+//     An attempt was made to modify a resource that is in a state that is not valid.
+//     Check your resources to ensure that they are in valid states before retrying
+//     the operation.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/UpdateProvisionedProductProperties
 func (c *ServiceCatalog) UpdateProvisionedProductProperties(input *UpdateProvisionedProductPropertiesInput) (*UpdateProvisionedProductPropertiesOutput, error) {
@@ -7749,14 +8737,13 @@ const opUpdateProvisioningArtifact = "UpdateProvisioningArtifact"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateProvisioningArtifactRequest method.
+//	req, resp := client.UpdateProvisioningArtifactRequest(params)
 //
-//    // Example sending a request using the UpdateProvisioningArtifactRequest method.
-//    req, resp := client.UpdateProvisioningArtifactRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/UpdateProvisioningArtifact
 func (c *ServiceCatalog) UpdateProvisioningArtifactRequest(input *UpdateProvisioningArtifactInput) (req *request.Request, output *UpdateProvisioningArtifactOutput) {
@@ -7790,12 +8777,13 @@ func (c *ServiceCatalog) UpdateProvisioningArtifactRequest(input *UpdateProvisio
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation UpdateProvisioningArtifact for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/UpdateProvisioningArtifact
 func (c *ServiceCatalog) UpdateProvisioningArtifact(input *UpdateProvisioningArtifactInput) (*UpdateProvisioningArtifactOutput, error) {
@@ -7835,14 +8823,13 @@ const opUpdateServiceAction = "UpdateServiceAction"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateServiceActionRequest method.
+//	req, resp := client.UpdateServiceActionRequest(params)
 //
-//    // Example sending a request using the UpdateServiceActionRequest method.
-//    req, resp := client.UpdateServiceActionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/UpdateServiceAction
 func (c *ServiceCatalog) UpdateServiceActionRequest(input *UpdateServiceActionInput) (req *request.Request, output *UpdateServiceActionOutput) {
@@ -7872,12 +8859,13 @@ func (c *ServiceCatalog) UpdateServiceActionRequest(input *UpdateServiceActionIn
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation UpdateServiceAction for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+// Returned Error Types:
+//
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/UpdateServiceAction
 func (c *ServiceCatalog) UpdateServiceAction(input *UpdateServiceActionInput) (*UpdateServiceActionOutput, error) {
@@ -7917,14 +8905,13 @@ const opUpdateTagOption = "UpdateTagOption"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateTagOptionRequest method.
+//	req, resp := client.UpdateTagOptionRequest(params)
 //
-//    // Example sending a request using the UpdateTagOptionRequest method.
-//    req, resp := client.UpdateTagOptionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/UpdateTagOption
 func (c *ServiceCatalog) UpdateTagOptionRequest(input *UpdateTagOptionInput) (req *request.Request, output *UpdateTagOptionOutput) {
@@ -7954,20 +8941,21 @@ func (c *ServiceCatalog) UpdateTagOptionRequest(input *UpdateTagOptionInput) (re
 // See the AWS API reference guide for AWS Service Catalog's
 // API operation UpdateTagOption for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeTagOptionNotMigratedException "TagOptionNotMigratedException"
-//   An operation requiring TagOptions failed because the TagOptions migration
-//   process has not been performed for this account. Please use the AWS console
-//   to perform the migration process before retrying the operation.
+// Returned Error Types:
+//
+//   - TagOptionNotMigratedException
+//     An operation requiring TagOptions failed because the TagOptions migration
+//     process has not been performed for this account. Use the Amazon Web Services
+//     Management Console to perform the migration process before retrying the operation.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The specified resource was not found.
+//   - ResourceNotFoundException
+//     The specified resource was not found.
 //
-//   * ErrCodeDuplicateResourceException "DuplicateResourceException"
-//   The specified resource is a duplicate.
+//   - DuplicateResourceException
+//     The specified resource is a duplicate.
 //
-//   * ErrCodeInvalidParametersException "InvalidParametersException"
-//   One or more parameters provided to the operation are not valid.
+//   - InvalidParametersException
+//     One or more parameters provided to the operation are not valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/servicecatalog-2015-12-10/UpdateTagOption
 func (c *ServiceCatalog) UpdateTagOption(input *UpdateTagOptionInput) (*UpdateTagOptionOutput, error) {
@@ -7996,8 +8984,6 @@ type AcceptPortfolioShareInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -8011,8 +8997,8 @@ type AcceptPortfolioShareInput struct {
 	// The type of shared portfolios to accept. The default is to accept imported
 	// portfolios.
 	//
-	//    * AWS_ORGANIZATIONS - Accept portfolios shared by the master account of
-	//    your organization.
+	//    * AWS_ORGANIZATIONS - Accept portfolios shared by the management account
+	//    of your organization.
 	//
 	//    * IMPORTED - Accept imported portfolios.
 	//
@@ -8023,12 +9009,20 @@ type AcceptPortfolioShareInput struct {
 	PortfolioShareType *string `type:"string" enum:"PortfolioShareType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AcceptPortfolioShareInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AcceptPortfolioShareInput) GoString() string {
 	return s.String()
 }
@@ -8071,12 +9065,20 @@ type AcceptPortfolioShareOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AcceptPortfolioShareOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AcceptPortfolioShareOutput) GoString() string {
 	return s.String()
 }
@@ -8094,16 +9096,24 @@ type AccessLevelFilter struct {
 	//    * User - Filter results based on the specified user.
 	Key *string `type:"string" enum:"AccessLevelFilterKey"`
 
-	// The user to which the access level applies. The only supported value is Self.
+	// The user to which the access level applies. The only supported value is self.
 	Value *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AccessLevelFilter) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AccessLevelFilter) GoString() string {
 	return s.String()
 }
@@ -8134,12 +9144,20 @@ type AssociateBudgetWithResourceInput struct {
 	ResourceId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateBudgetWithResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateBudgetWithResourceInput) GoString() string {
 	return s.String()
 }
@@ -8182,12 +9200,20 @@ type AssociateBudgetWithResourceOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateBudgetWithResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateBudgetWithResourceOutput) GoString() string {
 	return s.String()
 }
@@ -8197,8 +9223,6 @@ type AssociatePrincipalWithPortfolioInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -8209,23 +9233,76 @@ type AssociatePrincipalWithPortfolioInput struct {
 	// PortfolioId is a required field
 	PortfolioId *string `min:"1" type:"string" required:"true"`
 
-	// The ARN of the principal (IAM user, role, or group).
+	// The ARN of the principal (user, role, or group). If the PrincipalType is
+	// IAM, the supported value is a fully defined IAM Amazon Resource Name (ARN)
+	// (https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_identifiers.html#identifiers-arns).
+	// If the PrincipalType is IAM_PATTERN, the supported value is an IAM ARN without
+	// an AccountID in the following format:
+	//
+	// arn:partition:iam:::resource-type/resource-id
+	//
+	// The ARN resource-id can be either:
+	//
+	//    * A fully formed resource-id. For example, arn:aws:iam:::role/resource-name
+	//    or arn:aws:iam:::role/resource-path/resource-name
+	//
+	//    * A wildcard ARN. The wildcard ARN accepts IAM_PATTERN values with a "*"
+	//    or "?" in the resource-id segment of the ARN. For example arn:partition:service:::resource-type/resource-path/resource-name.
+	//    The new symbols are exclusive to the resource-path and resource-name and
+	//    cannot replace the resource-type or other ARN values. The ARN path and
+	//    principal name allow unlimited wildcard characters.
+	//
+	// Examples of an acceptable wildcard ARN:
+	//
+	//    * arn:aws:iam:::role/ResourceName_*
+	//
+	//    * arn:aws:iam:::role/*/ResourceName_?
+	//
+	// Examples of an unacceptable wildcard ARN:
+	//
+	//    * arn:aws:iam:::*/ResourceName
+	//
+	// You can associate multiple IAM_PATTERNs even if the account has no principal
+	// with that name.
+	//
+	// The "?" wildcard character matches zero or one of any character. This is
+	// similar to ".?" in regular regex context. The "*" wildcard character matches
+	// any number of any characters. This is similar to ".*" in regular regex context.
+	//
+	// In the IAM Principal ARN format (arn:partition:iam:::resource-type/resource-path/resource-name),
+	// valid resource-type values include user/, group/, or role/. The "?" and "*"
+	// characters are allowed only after the resource-type in the resource-id segment.
+	// You can use special characters anywhere within the resource-id.
+	//
+	// The "*" character also matches the "/" character, allowing paths to be formed
+	// within the resource-id. For example, arn:aws:iam:::role/*/ResourceName_?
+	// matches both arn:aws:iam:::role/pathA/pathB/ResourceName_1 and arn:aws:iam:::role/pathA/ResourceName_1.
 	//
 	// PrincipalARN is a required field
 	PrincipalARN *string `min:"1" type:"string" required:"true"`
 
-	// The principal type. The supported value is IAM.
+	// The principal type. The supported value is IAM if you use a fully defined
+	// Amazon Resource Name (ARN), or IAM_PATTERN if you use an ARN with no accountID,
+	// with or without wildcard characters.
 	//
 	// PrincipalType is a required field
 	PrincipalType *string `type:"string" required:"true" enum:"PrincipalType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociatePrincipalWithPortfolioInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociatePrincipalWithPortfolioInput) GoString() string {
 	return s.String()
 }
@@ -8283,12 +9360,20 @@ type AssociatePrincipalWithPortfolioOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociatePrincipalWithPortfolioOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociatePrincipalWithPortfolioOutput) GoString() string {
 	return s.String()
 }
@@ -8298,8 +9383,6 @@ type AssociateProductWithPortfolioInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -8319,12 +9402,20 @@ type AssociateProductWithPortfolioInput struct {
 	SourcePortfolioId *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateProductWithPortfolioInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateProductWithPortfolioInput) GoString() string {
 	return s.String()
 }
@@ -8382,12 +9473,20 @@ type AssociateProductWithPortfolioOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateProductWithPortfolioOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateProductWithPortfolioOutput) GoString() string {
 	return s.String()
 }
@@ -8397,8 +9496,6 @@ type AssociateServiceActionWithProvisioningArtifactInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -8420,12 +9517,20 @@ type AssociateServiceActionWithProvisioningArtifactInput struct {
 	ServiceActionId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateServiceActionWithProvisioningArtifactInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateServiceActionWithProvisioningArtifactInput) GoString() string {
 	return s.String()
 }
@@ -8486,12 +9591,20 @@ type AssociateServiceActionWithProvisioningArtifactOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateServiceActionWithProvisioningArtifactOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateServiceActionWithProvisioningArtifactOutput) GoString() string {
 	return s.String()
 }
@@ -8510,12 +9623,20 @@ type AssociateTagOptionWithResourceInput struct {
 	TagOptionId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateTagOptionWithResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateTagOptionWithResourceInput) GoString() string {
 	return s.String()
 }
@@ -8555,12 +9676,20 @@ type AssociateTagOptionWithResourceOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateTagOptionWithResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateTagOptionWithResourceOutput) GoString() string {
 	return s.String()
 }
@@ -8570,8 +9699,6 @@ type BatchAssociateServiceActionWithProvisioningArtifactInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -8584,12 +9711,20 @@ type BatchAssociateServiceActionWithProvisioningArtifactInput struct {
 	ServiceActionAssociations []*ServiceActionAssociation `min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BatchAssociateServiceActionWithProvisioningArtifactInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BatchAssociateServiceActionWithProvisioningArtifactInput) GoString() string {
 	return s.String()
 }
@@ -8640,12 +9775,20 @@ type BatchAssociateServiceActionWithProvisioningArtifactOutput struct {
 	FailedServiceActionAssociations []*FailedServiceActionAssociation `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BatchAssociateServiceActionWithProvisioningArtifactOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BatchAssociateServiceActionWithProvisioningArtifactOutput) GoString() string {
 	return s.String()
 }
@@ -8661,8 +9804,6 @@ type BatchDisassociateServiceActionFromProvisioningArtifactInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -8675,12 +9816,20 @@ type BatchDisassociateServiceActionFromProvisioningArtifactInput struct {
 	ServiceActionAssociations []*ServiceActionAssociation `min:"1" type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BatchDisassociateServiceActionFromProvisioningArtifactInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BatchDisassociateServiceActionFromProvisioningArtifactInput) GoString() string {
 	return s.String()
 }
@@ -8731,12 +9880,20 @@ type BatchDisassociateServiceActionFromProvisioningArtifactOutput struct {
 	FailedServiceActionAssociations []*FailedServiceActionAssociation `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BatchDisassociateServiceActionFromProvisioningArtifactOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BatchDisassociateServiceActionFromProvisioningArtifactOutput) GoString() string {
 	return s.String()
 }
@@ -8755,12 +9912,20 @@ type BudgetDetail struct {
 	BudgetName *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BudgetDetail) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BudgetDetail) GoString() string {
 	return s.String()
 }
@@ -8779,12 +9944,20 @@ type CloudWatchDashboard struct {
 	Name *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CloudWatchDashboard) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CloudWatchDashboard) GoString() string {
 	return s.String()
 }
@@ -8795,6 +9968,110 @@ func (s *CloudWatchDashboard) SetName(v string) *CloudWatchDashboard {
 	return s
 }
 
+// The subtype containing details about the Codestar connection Type.
+type CodeStarParameters struct {
+	_ struct{} `type:"structure"`
+
+	// The absolute path wehre the artifact resides within the repo and branch,
+	// formatted as "folder/file.json."
+	//
+	// ArtifactPath is a required field
+	ArtifactPath *string `min:"1" type:"string" required:"true"`
+
+	// The specific branch where the artifact resides.
+	//
+	// Branch is a required field
+	Branch *string `min:"1" type:"string" required:"true"`
+
+	// The CodeStar ARN, which is the connection between Service Catalog and the
+	// external repository.
+	//
+	// ConnectionArn is a required field
+	ConnectionArn *string `min:"1" type:"string" required:"true"`
+
+	// The specific repository where the product’s artifact-to-be-synced resides,
+	// formatted as "Account/Repo."
+	//
+	// Repository is a required field
+	Repository *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CodeStarParameters) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CodeStarParameters) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CodeStarParameters) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CodeStarParameters"}
+	if s.ArtifactPath == nil {
+		invalidParams.Add(request.NewErrParamRequired("ArtifactPath"))
+	}
+	if s.ArtifactPath != nil && len(*s.ArtifactPath) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ArtifactPath", 1))
+	}
+	if s.Branch == nil {
+		invalidParams.Add(request.NewErrParamRequired("Branch"))
+	}
+	if s.Branch != nil && len(*s.Branch) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Branch", 1))
+	}
+	if s.ConnectionArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionArn"))
+	}
+	if s.ConnectionArn != nil && len(*s.ConnectionArn) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ConnectionArn", 1))
+	}
+	if s.Repository == nil {
+		invalidParams.Add(request.NewErrParamRequired("Repository"))
+	}
+	if s.Repository != nil && len(*s.Repository) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Repository", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetArtifactPath sets the ArtifactPath field's value.
+func (s *CodeStarParameters) SetArtifactPath(v string) *CodeStarParameters {
+	s.ArtifactPath = &v
+	return s
+}
+
+// SetBranch sets the Branch field's value.
+func (s *CodeStarParameters) SetBranch(v string) *CodeStarParameters {
+	s.Branch = &v
+	return s
+}
+
+// SetConnectionArn sets the ConnectionArn field's value.
+func (s *CodeStarParameters) SetConnectionArn(v string) *CodeStarParameters {
+	s.ConnectionArn = &v
+	return s
+}
+
+// SetRepository sets the Repository field's value.
+func (s *CodeStarParameters) SetRepository(v string) *CodeStarParameters {
+	s.Repository = &v
+	return s
+}
+
 // Information about a constraint.
 type ConstraintDetail struct {
 	_ struct{} `type:"structure"`
@@ -8808,6 +10085,14 @@ type ConstraintDetail struct {
 	// The owner of the constraint.
 	Owner *string `type:"string"`
 
+	// The identifier of the portfolio the product resides in. The constraint applies
+	// only to the instance of the product that lives within this portfolio.
+	PortfolioId *string `min:"1" type:"string"`
+
+	// The identifier of the product the constraint applies to. Note that a constraint
+	// applies to a specific instance of a product within a certain portfolio.
+	ProductId *string `min:"1" type:"string"`
+
 	// The type of constraint.
 	//
 	//    * LAUNCH
@@ -8820,12 +10105,20 @@ type ConstraintDetail struct {
 	Type *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ConstraintDetail) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ConstraintDetail) GoString() string {
 	return s.String()
 }
@@ -8848,6 +10141,18 @@ func (s *ConstraintDetail) SetOwner(v string) *ConstraintDetail {
 	return s
 }
 
+// SetPortfolioId sets the PortfolioId field's value.
+func (s *ConstraintDetail) SetPortfolioId(v string) *ConstraintDetail {
+	s.PortfolioId = &v
+	return s
+}
+
+// SetProductId sets the ProductId field's value.
+func (s *ConstraintDetail) SetProductId(v string) *ConstraintDetail {
+	s.ProductId = &v
+	return s
+}
+
 // SetType sets the Type field's value.
 func (s *ConstraintDetail) SetType(v string) *ConstraintDetail {
 	s.Type = &v
@@ -8873,12 +10178,20 @@ type ConstraintSummary struct {
 	Type *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ConstraintSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ConstraintSummary) GoString() string {
 	return s.String()
 }
@@ -8900,8 +10213,6 @@ type CopyProductInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -8909,7 +10220,7 @@ type CopyProductInput struct {
 
 	// The copy options. If the value is CopyTags, the tags from the source product
 	// are copied to the target product.
-	CopyOptions []*string `type:"list"`
+	CopyOptions []*string `type:"list" enum:"CopyOption"`
 
 	// A unique identifier that you provide to ensure idempotency. If multiple requests
 	// differ only by the idempotency token, the same response is returned for each
@@ -8932,12 +10243,20 @@ type CopyProductInput struct {
 	TargetProductName *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CopyProductInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CopyProductInput) GoString() string {
 	return s.String()
 }
@@ -9013,12 +10332,20 @@ type CopyProductOutput struct {
 	CopyProductToken *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CopyProductOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CopyProductOutput) GoString() string {
 	return s.String()
 }
@@ -9034,8 +10361,6 @@ type CreateConstraintInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -9054,10 +10379,25 @@ type CreateConstraintInput struct {
 	//
 	// LAUNCH
 	//
+	// You are required to specify either the RoleArn or the LocalRoleName but can't
+	// use both.
+	//
 	// Specify the RoleArn property as follows:
 	//
 	// {"RoleArn" : "arn:aws:iam::123456789012:role/LaunchRole"}
 	//
+	// Specify the LocalRoleName property as follows:
+	//
+	// {"LocalRoleName": "SCBasicLaunchRole"}
+	//
+	// If you specify the LocalRoleName property, when an account uses the launch
+	// constraint, the IAM role with that name in the account will be used. This
+	// allows launch-role constraints to be account-agnostic so the administrator
+	// can create fewer resources per shared account.
+	//
+	// The given role name must exist in the account used to create the launch constraint
+	// and the account of the user who launches a product with this launch constraint.
+	//
 	// You cannot have both a LAUNCH and a STACKSET constraint.
 	//
 	// You also cannot have more than one LAUNCH constraint on a product and portfolio.
@@ -9088,8 +10428,7 @@ type CreateConstraintInput struct {
 	//
 	// You also cannot have more than one STACKSET constraint on a product and portfolio.
 	//
-	// Products with a STACKSET constraint will launch an AWS CloudFormation stack
-	// set.
+	// Products with a STACKSET constraint will launch an CloudFormation stack set.
 	//
 	// TEMPLATE
 	//
@@ -9125,12 +10464,20 @@ type CreateConstraintInput struct {
 	Type *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateConstraintInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateConstraintInput) GoString() string {
 	return s.String()
 }
@@ -9224,12 +10571,20 @@ type CreateConstraintOutput struct {
 	Status *string `type:"string" enum:"Status"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateConstraintOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateConstraintOutput) GoString() string {
 	return s.String()
 }
@@ -9257,8 +10612,6 @@ type CreatePortfolioInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -9286,12 +10639,20 @@ type CreatePortfolioInput struct {
 	Tags []*Tag `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePortfolioInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePortfolioInput) GoString() string {
 	return s.String()
 }
@@ -9377,12 +10738,20 @@ type CreatePortfolioOutput struct {
 	Tags []*Tag `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePortfolioOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePortfolioOutput) GoString() string {
 	return s.String()
 }
@@ -9404,20 +10773,18 @@ type CreatePortfolioShareInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
 	AcceptLanguage *string `type:"string"`
 
-	// The AWS account ID. For example, 123456789012.
+	// The Amazon Web Services account ID. For example, 123456789012.
 	AccountId *string `type:"string"`
 
-	// The organization node to whom you are going to share. If OrganizationNode
-	// is passed in, PortfolioShare will be created for the node and its children
-	// (when applies), and a PortfolioShareToken will be returned in the output
-	// in order for the administrator to monitor the status of the PortfolioShare
+	// The organization node to whom you are going to share. When you pass OrganizationNode,
+	// it creates PortfolioShare for all of the Amazon Web Services accounts that
+	// are associated to the OrganizationNode. The output returns a PortfolioShareToken,
+	// which enables the administrator to monitor the status of the PortfolioShare
 	// creation process.
 	OrganizationNode *OrganizationNode `type:"structure"`
 
@@ -9425,14 +10792,37 @@ type CreatePortfolioShareInput struct {
 	//
 	// PortfolioId is a required field
 	PortfolioId *string `min:"1" type:"string" required:"true"`
+
+	// Enables or disables Principal sharing when creating the portfolio share.
+	// If this flag is not provided, principal sharing is disabled.
+	//
+	// When you enable Principal Name Sharing for a portfolio share, the share recipient
+	// account end users with a principal that matches any of the associated IAM
+	// patterns can provision products from the portfolio. Once shared, the share
+	// recipient can view associations of PrincipalType: IAM_PATTERN on their portfolio.
+	// You can create the principals in the recipient account before or after creating
+	// the share.
+	SharePrincipals *bool `type:"boolean"`
+
+	// Enables or disables TagOptions sharing when creating the portfolio share.
+	// If this flag is not provided, TagOptions sharing is disabled.
+	ShareTagOptions *bool `type:"boolean"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePortfolioShareInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePortfolioShareInput) GoString() string {
 	return s.String()
 }
@@ -9477,20 +10867,40 @@ func (s *CreatePortfolioShareInput) SetPortfolioId(v string) *CreatePortfolioSha
 	return s
 }
 
+// SetSharePrincipals sets the SharePrincipals field's value.
+func (s *CreatePortfolioShareInput) SetSharePrincipals(v bool) *CreatePortfolioShareInput {
+	s.SharePrincipals = &v
+	return s
+}
+
+// SetShareTagOptions sets the ShareTagOptions field's value.
+func (s *CreatePortfolioShareInput) SetShareTagOptions(v bool) *CreatePortfolioShareInput {
+	s.ShareTagOptions = &v
+	return s
+}
+
 type CreatePortfolioShareOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The portfolio share unique identifier. This will only be returned if portfolio
+	// The portfolio shares a unique identifier that only returns if the portfolio
 	// is shared to an organization node.
 	PortfolioShareToken *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePortfolioShareOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePortfolioShareOutput) GoString() string {
 	return s.String()
 }
@@ -9506,8 +10916,6 @@ type CreateProductInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -9540,9 +10948,17 @@ type CreateProductInput struct {
 	ProductType *string `type:"string" required:"true" enum:"ProductType"`
 
 	// The configuration of the provisioning artifact.
+	ProvisioningArtifactParameters *ProvisioningArtifactProperties `type:"structure"`
+
+	// Specifies connection details for the created product and syncs the product
+	// to the connection source artifact. This automatically manages the product's
+	// artifacts based on changes to the source. The SourceConnection parameter
+	// consists of the following sub-fields.
 	//
-	// ProvisioningArtifactParameters is a required field
-	ProvisioningArtifactParameters *ProvisioningArtifactProperties `type:"structure" required:"true"`
+	//    * Type
+	//
+	//    * ConnectionParamters
+	SourceConnection *SourceConnection `type:"structure"`
 
 	// The support information about the product.
 	SupportDescription *string `type:"string"`
@@ -9551,18 +10967,28 @@ type CreateProductInput struct {
 	SupportEmail *string `type:"string"`
 
 	// The contact URL for product support.
+	//
+	// ^https?:\/\// / is the pattern used to validate SupportUrl.
 	SupportUrl *string `type:"string"`
 
 	// One or more tags.
 	Tags []*Tag `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateProductInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateProductInput) GoString() string {
 	return s.String()
 }
@@ -9582,14 +11008,16 @@ func (s *CreateProductInput) Validate() error {
 	if s.ProductType == nil {
 		invalidParams.Add(request.NewErrParamRequired("ProductType"))
 	}
-	if s.ProvisioningArtifactParameters == nil {
-		invalidParams.Add(request.NewErrParamRequired("ProvisioningArtifactParameters"))
-	}
 	if s.ProvisioningArtifactParameters != nil {
 		if err := s.ProvisioningArtifactParameters.Validate(); err != nil {
 			invalidParams.AddNested("ProvisioningArtifactParameters", err.(request.ErrInvalidParams))
 		}
 	}
+	if s.SourceConnection != nil {
+		if err := s.SourceConnection.Validate(); err != nil {
+			invalidParams.AddNested("SourceConnection", err.(request.ErrInvalidParams))
+		}
+	}
 	if s.Tags != nil {
 		for i, v := range s.Tags {
 			if v == nil {
@@ -9655,6 +11083,12 @@ func (s *CreateProductInput) SetProvisioningArtifactParameters(v *ProvisioningAr
 	return s
 }
 
+// SetSourceConnection sets the SourceConnection field's value.
+func (s *CreateProductInput) SetSourceConnection(v *SourceConnection) *CreateProductInput {
+	s.SourceConnection = v
+	return s
+}
+
 // SetSupportDescription sets the SupportDescription field's value.
 func (s *CreateProductInput) SetSupportDescription(v string) *CreateProductInput {
 	s.SupportDescription = &v
@@ -9692,12 +11126,20 @@ type CreateProductOutput struct {
 	Tags []*Tag `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateProductOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateProductOutput) GoString() string {
 	return s.String()
 }
@@ -9725,8 +11167,6 @@ type CreateProvisionedProductPlanInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -9762,7 +11202,8 @@ type CreateProvisionedProductPlanInput struct {
 	ProductId *string `min:"1" type:"string" required:"true"`
 
 	// A user-friendly name for the provisioned product. This value must be unique
-	// for the AWS account and cannot be updated after the product is provisioned.
+	// for the Amazon Web Services account and cannot be updated after the product
+	// is provisioned.
 	//
 	// ProvisionedProductName is a required field
 	ProvisionedProductName *string `min:"1" type:"string" required:"true"`
@@ -9784,12 +11225,20 @@ type CreateProvisionedProductPlanInput struct {
 	Tags []*Tag `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateProvisionedProductPlanInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateProvisionedProductPlanInput) GoString() string {
 	return s.String()
 }
@@ -9939,12 +11388,20 @@ type CreateProvisionedProductPlanOutput struct {
 	ProvisioningArtifactId *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateProvisionedProductPlanOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateProvisionedProductPlanOutput) GoString() string {
 	return s.String()
 }
@@ -9984,8 +11441,6 @@ type CreateProvisioningArtifactInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -10007,12 +11462,20 @@ type CreateProvisioningArtifactInput struct {
 	ProductId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateProvisioningArtifactInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateProvisioningArtifactInput) GoString() string {
 	return s.String()
 }
@@ -10071,7 +11534,21 @@ func (s *CreateProvisioningArtifactInput) SetProductId(v string) *CreateProvisio
 type CreateProvisioningArtifactOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The URL of the CloudFormation template in Amazon S3, in JSON format.
+	// Specify the template source with one of the following options, but not both.
+	// Keys accepted: [ LoadTemplateFromURL, ImportFromPhysicalId ].
+	//
+	// Use the URL of the CloudFormation template in Amazon S3 or GitHub in JSON
+	// format.
+	//
+	// LoadTemplateFromURL
+	//
+	// Use the URL of the CloudFormation template in Amazon S3 or GitHub in JSON
+	// format.
+	//
+	// ImportFromPhysicalId
+	//
+	// Use the physical id of the resource that contains the template; currently
+	// supports CloudFormation stack ARN.
 	Info map[string]*string `min:"1" type:"map"`
 
 	// Information about the provisioning artifact.
@@ -10081,12 +11558,20 @@ type CreateProvisioningArtifactOutput struct {
 	Status *string `type:"string" enum:"Status"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateProvisioningArtifactOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateProvisioningArtifactOutput) GoString() string {
 	return s.String()
 }
@@ -10114,8 +11599,6 @@ type CreateServiceActionInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -10125,12 +11608,16 @@ type CreateServiceActionInput struct {
 	//
 	// Name
 	//
-	// The name of the AWS Systems Manager Document. For example, AWS-RestartEC2Instance.
+	// The name of the Amazon Web Services Systems Manager document (SSM document).
+	// For example, AWS-RestartEC2Instance.
+	//
+	// If you are using a shared SSM document, you must provide the ARN instead
+	// of the name.
 	//
 	// Version
 	//
-	// The AWS Systems Manager automation document version. For example, "Version":
-	// "1"
+	// The Amazon Web Services Systems Manager automation document version. For
+	// example, "Version": "1"
 	//
 	// AssumeRole
 	//
@@ -10143,7 +11630,7 @@ type CreateServiceActionInput struct {
 	//
 	// The list of parameters in JSON format.
 	//
-	// For example: [{\"Name\":\"InstanceId\",\"Type\":\"TARGET\"}].
+	// For example: [{\"Name\":\"InstanceId\",\"Type\":\"TARGET\"}] or [{\"Name\":\"InstanceId\",\"Type\":\"TEXT_VALUE\"}].
 	//
 	// Definition is a required field
 	Definition map[string]*string `min:"1" type:"map" required:"true"`
@@ -10167,12 +11654,20 @@ type CreateServiceActionInput struct {
 	Name *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateServiceActionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateServiceActionInput) GoString() string {
 	return s.String()
 }
@@ -10248,12 +11743,20 @@ type CreateServiceActionOutput struct {
 	ServiceActionDetail *ServiceActionDetail `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateServiceActionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateServiceActionOutput) GoString() string {
 	return s.String()
 }
@@ -10278,12 +11781,20 @@ type CreateTagOptionInput struct {
 	Value *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateTagOptionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateTagOptionInput) GoString() string {
 	return s.String()
 }
@@ -10329,12 +11840,20 @@ type CreateTagOptionOutput struct {
 	TagOptionDetail *TagOptionDetail `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateTagOptionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateTagOptionOutput) GoString() string {
 	return s.String()
 }
@@ -10350,8 +11869,6 @@ type DeleteConstraintInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -10363,12 +11880,20 @@ type DeleteConstraintInput struct {
 	Id *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteConstraintInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteConstraintInput) GoString() string {
 	return s.String()
 }
@@ -10405,12 +11930,20 @@ type DeleteConstraintOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteConstraintOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteConstraintOutput) GoString() string {
 	return s.String()
 }
@@ -10420,8 +11953,6 @@ type DeletePortfolioInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -10433,12 +11964,20 @@ type DeletePortfolioInput struct {
 	Id *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeletePortfolioInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeletePortfolioInput) GoString() string {
 	return s.String()
 }
@@ -10475,12 +12014,20 @@ type DeletePortfolioOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeletePortfolioOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeletePortfolioOutput) GoString() string {
 	return s.String()
 }
@@ -10490,14 +12037,12 @@ type DeletePortfolioShareInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
 	AcceptLanguage *string `type:"string"`
 
-	// The AWS account ID.
+	// The Amazon Web Services account ID.
 	AccountId *string `type:"string"`
 
 	// The organization node to whom you are going to stop sharing.
@@ -10509,12 +12054,20 @@ type DeletePortfolioShareInput struct {
 	PortfolioId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeletePortfolioShareInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeletePortfolioShareInput) GoString() string {
 	return s.String()
 }
@@ -10567,12 +12120,20 @@ type DeletePortfolioShareOutput struct {
 	PortfolioShareToken *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeletePortfolioShareOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeletePortfolioShareOutput) GoString() string {
 	return s.String()
 }
@@ -10588,8 +12149,6 @@ type DeleteProductInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -10601,12 +12160,20 @@ type DeleteProductInput struct {
 	Id *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteProductInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteProductInput) GoString() string {
 	return s.String()
 }
@@ -10643,12 +12210,20 @@ type DeleteProductOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteProductOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteProductOutput) GoString() string {
 	return s.String()
 }
@@ -10658,14 +12233,12 @@ type DeleteProvisionedProductPlanInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
 	AcceptLanguage *string `type:"string"`
 
-	// If set to true, AWS Service Catalog stops managing the specified provisioned
+	// If set to true, Service Catalog stops managing the specified provisioned
 	// product even if it cannot delete the underlying resources.
 	IgnoreErrors *bool `type:"boolean"`
 
@@ -10675,12 +12248,20 @@ type DeleteProvisionedProductPlanInput struct {
 	PlanId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteProvisionedProductPlanInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteProvisionedProductPlanInput) GoString() string {
 	return s.String()
 }
@@ -10723,12 +12304,20 @@ type DeleteProvisionedProductPlanOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteProvisionedProductPlanOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteProvisionedProductPlanOutput) GoString() string {
 	return s.String()
 }
@@ -10738,8 +12327,6 @@ type DeleteProvisioningArtifactInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -10756,12 +12343,20 @@ type DeleteProvisioningArtifactInput struct {
 	ProvisioningArtifactId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteProvisioningArtifactInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteProvisioningArtifactInput) GoString() string {
 	return s.String()
 }
@@ -10810,12 +12405,20 @@ type DeleteProvisioningArtifactOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteProvisioningArtifactOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteProvisioningArtifactOutput) GoString() string {
 	return s.String()
 }
@@ -10825,8 +12428,6 @@ type DeleteServiceActionInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -10838,12 +12439,20 @@ type DeleteServiceActionInput struct {
 	Id *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteServiceActionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteServiceActionInput) GoString() string {
 	return s.String()
 }
@@ -10880,12 +12489,20 @@ type DeleteServiceActionOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteServiceActionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteServiceActionOutput) GoString() string {
 	return s.String()
 }
@@ -10899,12 +12516,20 @@ type DeleteTagOptionInput struct {
 	Id *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteTagOptionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteTagOptionInput) GoString() string {
 	return s.String()
 }
@@ -10935,12 +12560,20 @@ type DeleteTagOptionOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteTagOptionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteTagOptionOutput) GoString() string {
 	return s.String()
 }
@@ -10950,8 +12583,6 @@ type DescribeConstraintInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -10963,12 +12594,20 @@ type DescribeConstraintInput struct {
 	Id *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeConstraintInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeConstraintInput) GoString() string {
 	return s.String()
 }
@@ -11014,12 +12653,20 @@ type DescribeConstraintOutput struct {
 	Status *string `type:"string" enum:"Status"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeConstraintOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeConstraintOutput) GoString() string {
 	return s.String()
 }
@@ -11047,8 +12694,6 @@ type DescribeCopyProductStatusInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -11060,12 +12705,20 @@ type DescribeCopyProductStatusInput struct {
 	CopyProductToken *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeCopyProductStatusInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeCopyProductStatusInput) GoString() string {
 	return s.String()
 }
@@ -11111,12 +12764,20 @@ type DescribeCopyProductStatusOutput struct {
 	TargetProductId *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeCopyProductStatusOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeCopyProductStatusOutput) GoString() string {
 	return s.String()
 }
@@ -11144,8 +12805,6 @@ type DescribePortfolioInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -11157,12 +12816,20 @@ type DescribePortfolioInput struct {
 	Id *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribePortfolioInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribePortfolioInput) GoString() string {
 	return s.String()
 }
@@ -11211,12 +12878,20 @@ type DescribePortfolioOutput struct {
 	Tags []*Tag `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribePortfolioOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribePortfolioOutput) GoString() string {
 	return s.String()
 }
@@ -11255,12 +12930,20 @@ type DescribePortfolioShareStatusInput struct {
 	PortfolioShareToken *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribePortfolioShareStatusInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribePortfolioShareStatusInput) GoString() string {
 	return s.String()
 }
@@ -11307,12 +12990,20 @@ type DescribePortfolioShareStatusOutput struct {
 	Status *string `type:"string" enum:"ShareStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribePortfolioShareStatusOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribePortfolioShareStatusOutput) GoString() string {
 	return s.String()
 }
@@ -11347,30 +13038,181 @@ func (s *DescribePortfolioShareStatusOutput) SetStatus(v string) *DescribePortfo
 	return s
 }
 
+type DescribePortfolioSharesInput struct {
+	_ struct{} `type:"structure"`
+
+	// The maximum number of items to return with this call.
+	PageSize *int64 `type:"integer"`
+
+	// The page token for the next set of results. To retrieve the first set of
+	// results, use null.
+	PageToken *string `type:"string"`
+
+	// The unique identifier of the portfolio for which shares will be retrieved.
+	//
+	// PortfolioId is a required field
+	PortfolioId *string `min:"1" type:"string" required:"true"`
+
+	// The type of portfolio share to summarize. This field acts as a filter on
+	// the type of portfolio share, which can be one of the following:
+	//
+	// 1. ACCOUNT - Represents an external account to account share.
+	//
+	// 2. ORGANIZATION - Represents a share to an organization. This share is available
+	// to every account in the organization.
+	//
+	// 3. ORGANIZATIONAL_UNIT - Represents a share to an organizational unit.
+	//
+	// 4. ORGANIZATION_MEMBER_ACCOUNT - Represents a share to an account in the
+	// organization.
+	//
+	// Type is a required field
+	Type *string `type:"string" required:"true" enum:"DescribePortfolioShareType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribePortfolioSharesInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribePortfolioSharesInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DescribePortfolioSharesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribePortfolioSharesInput"}
+	if s.PortfolioId == nil {
+		invalidParams.Add(request.NewErrParamRequired("PortfolioId"))
+	}
+	if s.PortfolioId != nil && len(*s.PortfolioId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("PortfolioId", 1))
+	}
+	if s.Type == nil {
+		invalidParams.Add(request.NewErrParamRequired("Type"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetPageSize sets the PageSize field's value.
+func (s *DescribePortfolioSharesInput) SetPageSize(v int64) *DescribePortfolioSharesInput {
+	s.PageSize = &v
+	return s
+}
+
+// SetPageToken sets the PageToken field's value.
+func (s *DescribePortfolioSharesInput) SetPageToken(v string) *DescribePortfolioSharesInput {
+	s.PageToken = &v
+	return s
+}
+
+// SetPortfolioId sets the PortfolioId field's value.
+func (s *DescribePortfolioSharesInput) SetPortfolioId(v string) *DescribePortfolioSharesInput {
+	s.PortfolioId = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *DescribePortfolioSharesInput) SetType(v string) *DescribePortfolioSharesInput {
+	s.Type = &v
+	return s
+}
+
+type DescribePortfolioSharesOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The page token to use to retrieve the next set of results. If there are no
+	// additional results, this value is null.
+	NextPageToken *string `type:"string"`
+
+	// Summaries about each of the portfolio shares.
+	PortfolioShareDetails []*PortfolioShareDetail `type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribePortfolioSharesOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DescribePortfolioSharesOutput) GoString() string {
+	return s.String()
+}
+
+// SetNextPageToken sets the NextPageToken field's value.
+func (s *DescribePortfolioSharesOutput) SetNextPageToken(v string) *DescribePortfolioSharesOutput {
+	s.NextPageToken = &v
+	return s
+}
+
+// SetPortfolioShareDetails sets the PortfolioShareDetails field's value.
+func (s *DescribePortfolioSharesOutput) SetPortfolioShareDetails(v []*PortfolioShareDetail) *DescribePortfolioSharesOutput {
+	s.PortfolioShareDetails = v
+	return s
+}
+
 type DescribeProductAsAdminInput struct {
 	_ struct{} `type:"structure"`
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
 	AcceptLanguage *string `type:"string"`
 
 	// The product identifier.
+	Id *string `min:"1" type:"string"`
+
+	// The product name.
+	Name *string `type:"string"`
+
+	// The unique identifier of the shared portfolio that the specified product
+	// is associated with.
 	//
-	// Id is a required field
-	Id *string `min:"1" type:"string" required:"true"`
+	// You can provide this parameter to retrieve the shared TagOptions associated
+	// with the product. If this parameter is provided and if TagOptions sharing
+	// is enabled in the portfolio share, the API returns both local and shared
+	// TagOptions associated with the product. Otherwise only local TagOptions will
+	// be returned.
+	SourcePortfolioId *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProductAsAdminInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProductAsAdminInput) GoString() string {
 	return s.String()
 }
@@ -11378,12 +13220,12 @@ func (s DescribeProductAsAdminInput) GoString() string {
 // Validate inspects the fields of the type to determine if they are valid.
 func (s *DescribeProductAsAdminInput) Validate() error {
 	invalidParams := request.ErrInvalidParams{Context: "DescribeProductAsAdminInput"}
-	if s.Id == nil {
-		invalidParams.Add(request.NewErrParamRequired("Id"))
-	}
 	if s.Id != nil && len(*s.Id) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
 	}
+	if s.SourcePortfolioId != nil && len(*s.SourcePortfolioId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("SourcePortfolioId", 1))
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -11403,6 +13245,18 @@ func (s *DescribeProductAsAdminInput) SetId(v string) *DescribeProductAsAdminInp
 	return s
 }
 
+// SetName sets the Name field's value.
+func (s *DescribeProductAsAdminInput) SetName(v string) *DescribeProductAsAdminInput {
+	s.Name = &v
+	return s
+}
+
+// SetSourcePortfolioId sets the SourcePortfolioId field's value.
+func (s *DescribeProductAsAdminInput) SetSourcePortfolioId(v string) *DescribeProductAsAdminInput {
+	s.SourcePortfolioId = &v
+	return s
+}
+
 type DescribeProductAsAdminOutput struct {
 	_ struct{} `type:"structure"`
 
@@ -11423,12 +13277,20 @@ type DescribeProductAsAdminOutput struct {
 	Tags []*Tag `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProductAsAdminOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProductAsAdminOutput) GoString() string {
 	return s.String()
 }
@@ -11468,25 +13330,32 @@ type DescribeProductInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
 	AcceptLanguage *string `type:"string"`
 
 	// The product identifier.
-	//
-	// Id is a required field
-	Id *string `min:"1" type:"string" required:"true"`
+	Id *string `min:"1" type:"string"`
+
+	// The product name.
+	Name *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProductInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProductInput) GoString() string {
 	return s.String()
 }
@@ -11494,9 +13363,6 @@ func (s DescribeProductInput) GoString() string {
 // Validate inspects the fields of the type to determine if they are valid.
 func (s *DescribeProductInput) Validate() error {
 	invalidParams := request.ErrInvalidParams{Context: "DescribeProductInput"}
-	if s.Id == nil {
-		invalidParams.Add(request.NewErrParamRequired("Id"))
-	}
 	if s.Id != nil && len(*s.Id) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
 	}
@@ -11519,12 +13385,21 @@ func (s *DescribeProductInput) SetId(v string) *DescribeProductInput {
 	return s
 }
 
+// SetName sets the Name field's value.
+func (s *DescribeProductInput) SetName(v string) *DescribeProductInput {
+	s.Name = &v
+	return s
+}
+
 type DescribeProductOutput struct {
 	_ struct{} `type:"structure"`
 
 	// Information about the associated budgets.
 	Budgets []*BudgetDetail `type:"list"`
 
+	// Information about the associated launch paths.
+	LaunchPaths []*LaunchPath `type:"list"`
+
 	// Summary information about the product view.
 	ProductViewSummary *ProductViewSummary `type:"structure"`
 
@@ -11532,12 +13407,20 @@ type DescribeProductOutput struct {
 	ProvisioningArtifacts []*ProvisioningArtifact `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProductOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProductOutput) GoString() string {
 	return s.String()
 }
@@ -11548,6 +13431,12 @@ func (s *DescribeProductOutput) SetBudgets(v []*BudgetDetail) *DescribeProductOu
 	return s
 }
 
+// SetLaunchPaths sets the LaunchPaths field's value.
+func (s *DescribeProductOutput) SetLaunchPaths(v []*LaunchPath) *DescribeProductOutput {
+	s.LaunchPaths = v
+	return s
+}
+
 // SetProductViewSummary sets the ProductViewSummary field's value.
 func (s *DescribeProductOutput) SetProductViewSummary(v *ProductViewSummary) *DescribeProductOutput {
 	s.ProductViewSummary = v
@@ -11565,8 +13454,6 @@ type DescribeProductViewInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -11578,12 +13465,20 @@ type DescribeProductViewInput struct {
 	Id *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProductViewInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProductViewInput) GoString() string {
 	return s.String()
 }
@@ -11626,12 +13521,20 @@ type DescribeProductViewOutput struct {
 	ProvisioningArtifacts []*ProvisioningArtifact `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProductViewOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProductViewOutput) GoString() string {
 	return s.String()
 }
@@ -11648,30 +13551,49 @@ func (s *DescribeProductViewOutput) SetProvisioningArtifacts(v []*ProvisioningAr
 	return s
 }
 
+// DescribeProvisionedProductAPI input structure. AcceptLanguage - [Optional]
+// The language code for localization. Id - [Optional] The provisioned product
+// identifier. Name - [Optional] Another provisioned product identifier. Customers
+// must provide either Id or Name.
 type DescribeProvisionedProductInput struct {
 	_ struct{} `type:"structure"`
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
 	AcceptLanguage *string `type:"string"`
 
-	// The provisioned product identifier.
+	// The provisioned product identifier. You must provide the name or ID, but
+	// not both.
 	//
-	// Id is a required field
-	Id *string `min:"1" type:"string" required:"true"`
+	// If you do not provide a name or ID, or you provide both name and ID, an InvalidParametersException
+	// will occur.
+	Id *string `min:"1" type:"string"`
+
+	// The name of the provisioned product. You must provide the name or ID, but
+	// not both.
+	//
+	// If you do not provide a name or ID, or you provide both name and ID, an InvalidParametersException
+	// will occur.
+	Name *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProvisionedProductInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProvisionedProductInput) GoString() string {
 	return s.String()
 }
@@ -11679,12 +13601,12 @@ func (s DescribeProvisionedProductInput) GoString() string {
 // Validate inspects the fields of the type to determine if they are valid.
 func (s *DescribeProvisionedProductInput) Validate() error {
 	invalidParams := request.ErrInvalidParams{Context: "DescribeProvisionedProductInput"}
-	if s.Id == nil {
-		invalidParams.Add(request.NewErrParamRequired("Id"))
-	}
 	if s.Id != nil && len(*s.Id) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("Id", 1))
 	}
+	if s.Name != nil && len(*s.Name) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Name", 1))
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -11704,6 +13626,12 @@ func (s *DescribeProvisionedProductInput) SetId(v string) *DescribeProvisionedPr
 	return s
 }
 
+// SetName sets the Name field's value.
+func (s *DescribeProvisionedProductInput) SetName(v string) *DescribeProvisionedProductInput {
+	s.Name = &v
+	return s
+}
+
 type DescribeProvisionedProductOutput struct {
 	_ struct{} `type:"structure"`
 
@@ -11714,12 +13642,20 @@ type DescribeProvisionedProductOutput struct {
 	ProvisionedProductDetail *ProvisionedProductDetail `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProvisionedProductOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProvisionedProductOutput) GoString() string {
 	return s.String()
 }
@@ -11741,8 +13677,6 @@ type DescribeProvisionedProductPlanInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -11761,12 +13695,20 @@ type DescribeProvisionedProductPlanInput struct {
 	PlanId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProvisionedProductPlanInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProvisionedProductPlanInput) GoString() string {
 	return s.String()
 }
@@ -11825,12 +13767,20 @@ type DescribeProvisionedProductPlanOutput struct {
 	ResourceChanges []*ResourceChange `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProvisionedProductPlanOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProvisionedProductPlanOutput) GoString() string {
 	return s.String()
 }
@@ -11858,33 +13808,45 @@ type DescribeProvisioningArtifactInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
 	AcceptLanguage *string `type:"string"`
 
+	// Indicates if the API call response does or does not include additional details
+	// about the provisioning parameters.
+	IncludeProvisioningArtifactParameters *bool `type:"boolean"`
+
 	// The product identifier.
-	//
-	// ProductId is a required field
-	ProductId *string `min:"1" type:"string" required:"true"`
+	ProductId *string `min:"1" type:"string"`
+
+	// The product name.
+	ProductName *string `type:"string"`
 
 	// The identifier of the provisioning artifact.
-	//
-	// ProvisioningArtifactId is a required field
-	ProvisioningArtifactId *string `min:"1" type:"string" required:"true"`
+	ProvisioningArtifactId *string `min:"1" type:"string"`
+
+	// The provisioning artifact name.
+	ProvisioningArtifactName *string `type:"string"`
 
 	// Indicates whether a verbose level of detail is enabled.
 	Verbose *bool `type:"boolean"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProvisioningArtifactInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProvisioningArtifactInput) GoString() string {
 	return s.String()
 }
@@ -11892,15 +13854,9 @@ func (s DescribeProvisioningArtifactInput) GoString() string {
 // Validate inspects the fields of the type to determine if they are valid.
 func (s *DescribeProvisioningArtifactInput) Validate() error {
 	invalidParams := request.ErrInvalidParams{Context: "DescribeProvisioningArtifactInput"}
-	if s.ProductId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ProductId"))
-	}
 	if s.ProductId != nil && len(*s.ProductId) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("ProductId", 1))
 	}
-	if s.ProvisioningArtifactId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ProvisioningArtifactId"))
-	}
 	if s.ProvisioningArtifactId != nil && len(*s.ProvisioningArtifactId) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("ProvisioningArtifactId", 1))
 	}
@@ -11917,18 +13873,36 @@ func (s *DescribeProvisioningArtifactInput) SetAcceptLanguage(v string) *Describ
 	return s
 }
 
+// SetIncludeProvisioningArtifactParameters sets the IncludeProvisioningArtifactParameters field's value.
+func (s *DescribeProvisioningArtifactInput) SetIncludeProvisioningArtifactParameters(v bool) *DescribeProvisioningArtifactInput {
+	s.IncludeProvisioningArtifactParameters = &v
+	return s
+}
+
 // SetProductId sets the ProductId field's value.
 func (s *DescribeProvisioningArtifactInput) SetProductId(v string) *DescribeProvisioningArtifactInput {
 	s.ProductId = &v
 	return s
 }
 
+// SetProductName sets the ProductName field's value.
+func (s *DescribeProvisioningArtifactInput) SetProductName(v string) *DescribeProvisioningArtifactInput {
+	s.ProductName = &v
+	return s
+}
+
 // SetProvisioningArtifactId sets the ProvisioningArtifactId field's value.
 func (s *DescribeProvisioningArtifactInput) SetProvisioningArtifactId(v string) *DescribeProvisioningArtifactInput {
 	s.ProvisioningArtifactId = &v
 	return s
 }
 
+// SetProvisioningArtifactName sets the ProvisioningArtifactName field's value.
+func (s *DescribeProvisioningArtifactInput) SetProvisioningArtifactName(v string) *DescribeProvisioningArtifactInput {
+	s.ProvisioningArtifactName = &v
+	return s
+}
+
 // SetVerbose sets the Verbose field's value.
 func (s *DescribeProvisioningArtifactInput) SetVerbose(v bool) *DescribeProvisioningArtifactInput {
 	s.Verbose = &v
@@ -11938,22 +13912,33 @@ func (s *DescribeProvisioningArtifactInput) SetVerbose(v bool) *DescribeProvisio
 type DescribeProvisioningArtifactOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The URL of the CloudFormation template in Amazon S3.
+	// The URL of the CloudFormation template in Amazon S3 or GitHub in JSON format.
 	Info map[string]*string `min:"1" type:"map"`
 
 	// Information about the provisioning artifact.
 	ProvisioningArtifactDetail *ProvisioningArtifactDetail `type:"structure"`
 
+	// Information about the parameters used to provision the product.
+	ProvisioningArtifactParameters []*ProvisioningArtifactParameter `type:"list"`
+
 	// The status of the current request.
 	Status *string `type:"string" enum:"Status"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProvisioningArtifactOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProvisioningArtifactOutput) GoString() string {
 	return s.String()
 }
@@ -11970,6 +13955,12 @@ func (s *DescribeProvisioningArtifactOutput) SetProvisioningArtifactDetail(v *Pr
 	return s
 }
 
+// SetProvisioningArtifactParameters sets the ProvisioningArtifactParameters field's value.
+func (s *DescribeProvisioningArtifactOutput) SetProvisioningArtifactParameters(v []*ProvisioningArtifactParameter) *DescribeProvisioningArtifactOutput {
+	s.ProvisioningArtifactParameters = v
+	return s
+}
+
 // SetStatus sets the Status field's value.
 func (s *DescribeProvisioningArtifactOutput) SetStatus(v string) *DescribeProvisioningArtifactOutput {
 	s.Status = &v
@@ -11981,8 +13972,6 @@ type DescribeProvisioningParametersInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -11990,26 +13979,43 @@ type DescribeProvisioningParametersInput struct {
 
 	// The path identifier of the product. This value is optional if the product
 	// has a default path, and required if the product has more than one path. To
-	// list the paths for a product, use ListLaunchPaths.
+	// list the paths for a product, use ListLaunchPaths. You must provide the name
+	// or ID, but not both.
 	PathId *string `min:"1" type:"string"`
 
-	// The product identifier.
-	//
-	// ProductId is a required field
-	ProductId *string `min:"1" type:"string" required:"true"`
+	// The name of the path. You must provide the name or ID, but not both.
+	PathName *string `min:"1" type:"string"`
 
-	// The identifier of the provisioning artifact.
-	//
-	// ProvisioningArtifactId is a required field
-	ProvisioningArtifactId *string `min:"1" type:"string" required:"true"`
+	// The product identifier. You must provide the product name or ID, but not
+	// both.
+	ProductId *string `min:"1" type:"string"`
+
+	// The name of the product. You must provide the name or ID, but not both.
+	ProductName *string `type:"string"`
+
+	// The identifier of the provisioning artifact. You must provide the name or
+	// ID, but not both.
+	ProvisioningArtifactId *string `min:"1" type:"string"`
+
+	// The name of the provisioning artifact. You must provide the name or ID, but
+	// not both.
+	ProvisioningArtifactName *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProvisioningParametersInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProvisioningParametersInput) GoString() string {
 	return s.String()
 }
@@ -12020,15 +14026,12 @@ func (s *DescribeProvisioningParametersInput) Validate() error {
 	if s.PathId != nil && len(*s.PathId) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("PathId", 1))
 	}
-	if s.ProductId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ProductId"))
+	if s.PathName != nil && len(*s.PathName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("PathName", 1))
 	}
 	if s.ProductId != nil && len(*s.ProductId) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("ProductId", 1))
 	}
-	if s.ProvisioningArtifactId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ProvisioningArtifactId"))
-	}
 	if s.ProvisioningArtifactId != nil && len(*s.ProvisioningArtifactId) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("ProvisioningArtifactId", 1))
 	}
@@ -12051,28 +14054,55 @@ func (s *DescribeProvisioningParametersInput) SetPathId(v string) *DescribeProvi
 	return s
 }
 
+// SetPathName sets the PathName field's value.
+func (s *DescribeProvisioningParametersInput) SetPathName(v string) *DescribeProvisioningParametersInput {
+	s.PathName = &v
+	return s
+}
+
 // SetProductId sets the ProductId field's value.
 func (s *DescribeProvisioningParametersInput) SetProductId(v string) *DescribeProvisioningParametersInput {
 	s.ProductId = &v
 	return s
 }
 
+// SetProductName sets the ProductName field's value.
+func (s *DescribeProvisioningParametersInput) SetProductName(v string) *DescribeProvisioningParametersInput {
+	s.ProductName = &v
+	return s
+}
+
 // SetProvisioningArtifactId sets the ProvisioningArtifactId field's value.
 func (s *DescribeProvisioningParametersInput) SetProvisioningArtifactId(v string) *DescribeProvisioningParametersInput {
 	s.ProvisioningArtifactId = &v
 	return s
 }
 
+// SetProvisioningArtifactName sets the ProvisioningArtifactName field's value.
+func (s *DescribeProvisioningParametersInput) SetProvisioningArtifactName(v string) *DescribeProvisioningParametersInput {
+	s.ProvisioningArtifactName = &v
+	return s
+}
+
 type DescribeProvisioningParametersOutput struct {
 	_ struct{} `type:"structure"`
 
 	// Information about the constraints used to provision the product.
 	ConstraintSummaries []*ConstraintSummary `type:"list"`
 
+	// A list of the keys and descriptions of the outputs. These outputs can be
+	// referenced from a provisioned product launched from this provisioning artifact.
+	ProvisioningArtifactOutputKeys []*ProvisioningArtifactOutput `type:"list"`
+
+	// The output of the provisioning artifact.
+	//
+	// Deprecated: This property is deprecated and returns the Id and Description of the Provisioning Artifact. Use ProvisioningArtifactOutputKeys instead to get the Keys and Descriptions of the outputs.
+	ProvisioningArtifactOutputs []*ProvisioningArtifactOutput `deprecated:"true" type:"list"`
+
 	// Information about the parameters used to provision the product.
 	ProvisioningArtifactParameters []*ProvisioningArtifactParameter `type:"list"`
 
-	// An object that contains information about preferences, such as regions and
+	// An object that contains information about preferences, such as Regions and
 	// accounts, for the provisioning artifact.
 	ProvisioningArtifactPreferences *ProvisioningArtifactPreferences `type:"structure"`
 
@@ -12084,12 +14114,20 @@ type DescribeProvisioningParametersOutput struct {
 	UsageInstructions []*UsageInstruction `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProvisioningParametersOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeProvisioningParametersOutput) GoString() string {
 	return s.String()
 }
@@ -12100,6 +14138,18 @@ func (s *DescribeProvisioningParametersOutput) SetConstraintSummaries(v []*Const
 	return s
 }
 
+// SetProvisioningArtifactOutputKeys sets the ProvisioningArtifactOutputKeys field's value.
+func (s *DescribeProvisioningParametersOutput) SetProvisioningArtifactOutputKeys(v []*ProvisioningArtifactOutput) *DescribeProvisioningParametersOutput {
+	s.ProvisioningArtifactOutputKeys = v
+	return s
+}
+
+// SetProvisioningArtifactOutputs sets the ProvisioningArtifactOutputs field's value.
+func (s *DescribeProvisioningParametersOutput) SetProvisioningArtifactOutputs(v []*ProvisioningArtifactOutput) *DescribeProvisioningParametersOutput {
+	s.ProvisioningArtifactOutputs = v
+	return s
+}
+
 // SetProvisioningArtifactParameters sets the ProvisioningArtifactParameters field's value.
 func (s *DescribeProvisioningParametersOutput) SetProvisioningArtifactParameters(v []*ProvisioningArtifactParameter) *DescribeProvisioningParametersOutput {
 	s.ProvisioningArtifactParameters = v
@@ -12129,8 +14179,6 @@ type DescribeRecordInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -12150,12 +14198,20 @@ type DescribeRecordInput struct {
 	PageToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeRecordInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeRecordInput) GoString() string {
 	return s.String()
 }
@@ -12216,12 +14272,20 @@ type DescribeRecordOutput struct {
 	RecordOutputs []*RecordOutput `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeRecordOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeRecordOutput) GoString() string {
 	return s.String()
 }
@@ -12247,21 +14311,38 @@ func (s *DescribeRecordOutput) SetRecordOutputs(v []*RecordOutput) *DescribeReco
 type DescribeServiceActionExecutionParametersInput struct {
 	_ struct{} `type:"structure"`
 
+	// The language code.
+	//
+	//    * jp - Japanese
+	//
+	//    * zh - Chinese
 	AcceptLanguage *string `type:"string"`
 
+	// The identifier of the provisioned product.
+	//
 	// ProvisionedProductId is a required field
 	ProvisionedProductId *string `min:"1" type:"string" required:"true"`
 
+	// The self-service action identifier.
+	//
 	// ServiceActionId is a required field
 	ServiceActionId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeServiceActionExecutionParametersInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeServiceActionExecutionParametersInput) GoString() string {
 	return s.String()
 }
@@ -12309,15 +14390,24 @@ func (s *DescribeServiceActionExecutionParametersInput) SetServiceActionId(v str
 type DescribeServiceActionExecutionParametersOutput struct {
 	_ struct{} `type:"structure"`
 
+	// The parameters of the self-service action.
 	ServiceActionParameters []*ExecutionParameter `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeServiceActionExecutionParametersOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeServiceActionExecutionParametersOutput) GoString() string {
 	return s.String()
 }
@@ -12333,8 +14423,6 @@ type DescribeServiceActionInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -12346,12 +14434,20 @@ type DescribeServiceActionInput struct {
 	Id *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeServiceActionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeServiceActionInput) GoString() string {
 	return s.String()
 }
@@ -12391,12 +14487,20 @@ type DescribeServiceActionOutput struct {
 	ServiceActionDetail *ServiceActionDetail `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeServiceActionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeServiceActionOutput) GoString() string {
 	return s.String()
 }
@@ -12416,12 +14520,20 @@ type DescribeTagOptionInput struct {
 	Id *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeTagOptionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeTagOptionInput) GoString() string {
 	return s.String()
 }
@@ -12455,12 +14567,20 @@ type DescribeTagOptionOutput struct {
 	TagOptionDetail *TagOptionDetail `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeTagOptionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeTagOptionOutput) GoString() string {
 	return s.String()
 }
@@ -12475,12 +14595,20 @@ type DisableAWSOrganizationsAccessInput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisableAWSOrganizationsAccessInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisableAWSOrganizationsAccessInput) GoString() string {
 	return s.String()
 }
@@ -12489,12 +14617,20 @@ type DisableAWSOrganizationsAccessOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisableAWSOrganizationsAccessOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisableAWSOrganizationsAccessOutput) GoString() string {
 	return s.String()
 }
@@ -12514,12 +14650,20 @@ type DisassociateBudgetFromResourceInput struct {
 	ResourceId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateBudgetFromResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateBudgetFromResourceInput) GoString() string {
 	return s.String()
 }
@@ -12562,12 +14706,20 @@ type DisassociateBudgetFromResourceOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateBudgetFromResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateBudgetFromResourceOutput) GoString() string {
 	return s.String()
 }
@@ -12577,8 +14729,6 @@ type DisassociatePrincipalFromPortfolioInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -12589,18 +14739,32 @@ type DisassociatePrincipalFromPortfolioInput struct {
 	// PortfolioId is a required field
 	PortfolioId *string `min:"1" type:"string" required:"true"`
 
-	// The ARN of the principal (IAM user, role, or group).
+	// The ARN of the principal (user, role, or group). This field allows an ARN
+	// with no accountID with or without wildcard characters if PrincipalType is
+	// IAM_PATTERN.
 	//
 	// PrincipalARN is a required field
 	PrincipalARN *string `min:"1" type:"string" required:"true"`
+
+	// The supported value is IAM if you use a fully defined ARN, or IAM_PATTERN
+	// if you specify an IAM ARN with no AccountId, with or without wildcard characters.
+	PrincipalType *string `type:"string" enum:"PrincipalType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociatePrincipalFromPortfolioInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociatePrincipalFromPortfolioInput) GoString() string {
 	return s.String()
 }
@@ -12645,16 +14809,30 @@ func (s *DisassociatePrincipalFromPortfolioInput) SetPrincipalARN(v string) *Dis
 	return s
 }
 
+// SetPrincipalType sets the PrincipalType field's value.
+func (s *DisassociatePrincipalFromPortfolioInput) SetPrincipalType(v string) *DisassociatePrincipalFromPortfolioInput {
+	s.PrincipalType = &v
+	return s
+}
+
 type DisassociatePrincipalFromPortfolioOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociatePrincipalFromPortfolioOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociatePrincipalFromPortfolioOutput) GoString() string {
 	return s.String()
 }
@@ -12664,8 +14842,6 @@ type DisassociateProductFromPortfolioInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -12682,12 +14858,20 @@ type DisassociateProductFromPortfolioInput struct {
 	ProductId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateProductFromPortfolioInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateProductFromPortfolioInput) GoString() string {
 	return s.String()
 }
@@ -12736,12 +14920,20 @@ type DisassociateProductFromPortfolioOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateProductFromPortfolioOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateProductFromPortfolioOutput) GoString() string {
 	return s.String()
 }
@@ -12751,8 +14943,6 @@ type DisassociateServiceActionFromProvisioningArtifactInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -12774,12 +14964,20 @@ type DisassociateServiceActionFromProvisioningArtifactInput struct {
 	ServiceActionId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateServiceActionFromProvisioningArtifactInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateServiceActionFromProvisioningArtifactInput) GoString() string {
 	return s.String()
 }
@@ -12840,12 +15038,20 @@ type DisassociateServiceActionFromProvisioningArtifactOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateServiceActionFromProvisioningArtifactOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateServiceActionFromProvisioningArtifactOutput) GoString() string {
 	return s.String()
 }
@@ -12864,12 +15070,20 @@ type DisassociateTagOptionFromResourceInput struct {
 	TagOptionId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateTagOptionFromResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateTagOptionFromResourceInput) GoString() string {
 	return s.String()
 }
@@ -12909,26 +15123,106 @@ type DisassociateTagOptionFromResourceOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateTagOptionFromResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateTagOptionFromResourceOutput) GoString() string {
 	return s.String()
 }
 
+// The specified resource is a duplicate.
+type DuplicateResourceException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DuplicateResourceException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DuplicateResourceException) GoString() string {
+	return s.String()
+}
+
+func newErrorDuplicateResourceException(v protocol.ResponseMetadata) error {
+	return &DuplicateResourceException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *DuplicateResourceException) Code() string {
+	return "DuplicateResourceException"
+}
+
+// Message returns the exception's message.
+func (s *DuplicateResourceException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *DuplicateResourceException) OrigErr() error {
+	return nil
+}
+
+func (s *DuplicateResourceException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *DuplicateResourceException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *DuplicateResourceException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 type EnableAWSOrganizationsAccessInput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s EnableAWSOrganizationsAccessInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s EnableAWSOrganizationsAccessInput) GoString() string {
 	return s.String()
 }
@@ -12937,23 +15231,77 @@ type EnableAWSOrganizationsAccessOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s EnableAWSOrganizationsAccessOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s EnableAWSOrganizationsAccessOutput) GoString() string {
 	return s.String()
 }
 
+// The ID for the provisioned product resources that are part of a resource
+// group.
+type EngineWorkflowResourceIdentifier struct {
+	_ struct{} `type:"structure"`
+
+	// The unique key-value pair for a tag that identifies provisioned product resources.
+	UniqueTag *UniqueTagResourceIdentifier `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EngineWorkflowResourceIdentifier) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EngineWorkflowResourceIdentifier) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *EngineWorkflowResourceIdentifier) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "EngineWorkflowResourceIdentifier"}
+	if s.UniqueTag != nil {
+		if err := s.UniqueTag.Validate(); err != nil {
+			invalidParams.AddNested("UniqueTag", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetUniqueTag sets the UniqueTag field's value.
+func (s *EngineWorkflowResourceIdentifier) SetUniqueTag(v *UniqueTagResourceIdentifier) *EngineWorkflowResourceIdentifier {
+	s.UniqueTag = v
+	return s
+}
+
 type ExecuteProvisionedProductPlanInput struct {
 	_ struct{} `type:"structure"`
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -12970,12 +15318,20 @@ type ExecuteProvisionedProductPlanInput struct {
 	PlanId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ExecuteProvisionedProductPlanInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ExecuteProvisionedProductPlanInput) GoString() string {
 	return s.String()
 }
@@ -13024,12 +15380,20 @@ type ExecuteProvisionedProductPlanOutput struct {
 	RecordDetail *RecordDetail `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ExecuteProvisionedProductPlanOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ExecuteProvisionedProductPlanOutput) GoString() string {
 	return s.String()
 }
@@ -13045,8 +15409,6 @@ type ExecuteProvisionedProductServiceActionInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -13055,6 +15417,11 @@ type ExecuteProvisionedProductServiceActionInput struct {
 	// An idempotency token that uniquely identifies the execute request.
 	ExecuteToken *string `min:"1" type:"string" idempotencyToken:"true"`
 
+	// A map of all self-service action parameters and their values. If a provided
+	// parameter is of a special type, such as TARGET, the provided value will override
+	// the default value generated by Service Catalog. If the parameters field is
+	// not provided, no additional parameters are passed and default values will
+	// be used for any special parameters such as TARGET.
 	Parameters map[string][]*string `min:"1" type:"map"`
 
 	// The identifier of the provisioned product.
@@ -13068,12 +15435,20 @@ type ExecuteProvisionedProductServiceActionInput struct {
 	ServiceActionId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ExecuteProvisionedProductServiceActionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ExecuteProvisionedProductServiceActionInput) GoString() string {
 	return s.String()
 }
@@ -13144,12 +15519,20 @@ type ExecuteProvisionedProductServiceActionOutput struct {
 	RecordDetail *RecordDetail `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ExecuteProvisionedProductServiceActionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ExecuteProvisionedProductServiceActionOutput) GoString() string {
 	return s.String()
 }
@@ -13160,22 +15543,35 @@ func (s *ExecuteProvisionedProductServiceActionOutput) SetRecordDetail(v *Record
 	return s
 }
 
+// Details of an execution parameter value that is passed to a self-service
+// action when executed on a provisioned product.
 type ExecutionParameter struct {
 	_ struct{} `type:"structure"`
 
+	// The default values for the execution parameter.
 	DefaultValues []*string `type:"list"`
 
+	// The name of the execution parameter.
 	Name *string `min:"1" type:"string"`
 
+	// The execution parameter type.
 	Type *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ExecutionParameter) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ExecutionParameter) GoString() string {
 	return s.String()
 }
@@ -13219,12 +15615,20 @@ type FailedServiceActionAssociation struct {
 	ServiceActionId *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s FailedServiceActionAssociation) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s FailedServiceActionAssociation) GoString() string {
 	return s.String()
 }
@@ -13263,12 +15667,20 @@ type GetAWSOrganizationsAccessStatusInput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetAWSOrganizationsAccessStatusInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetAWSOrganizationsAccessStatusInput) GoString() string {
 	return s.String()
 }
@@ -13280,12 +15692,20 @@ type GetAWSOrganizationsAccessStatusOutput struct {
 	AccessStatus *string `type:"string" enum:"AccessStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetAWSOrganizationsAccessStatusOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetAWSOrganizationsAccessStatusOutput) GoString() string {
 	return s.String()
 }
@@ -13296,41 +15716,601 @@ func (s *GetAWSOrganizationsAccessStatusOutput) SetAccessStatus(v string) *GetAW
 	return s
 }
 
-// Summary information about a product path for a user.
-type LaunchPathSummary struct {
+type GetProvisionedProductOutputsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The constraints on the portfolio-product relationship.
-	ConstraintSummaries []*ConstraintSummary `type:"list"`
+	// The language code.
+	//
+	//    * jp - Japanese
+	//
+	//    * zh - Chinese
+	AcceptLanguage *string `type:"string"`
 
-	// The identifier of the product path.
-	Id *string `min:"1" type:"string"`
+	// The list of keys that the API should return with their values. If none are
+	// provided, the API will return all outputs of the provisioned product.
+	OutputKeys []*string `type:"list"`
 
-	// The name of the portfolio to which the user was assigned.
-	Name *string `type:"string"`
+	// The maximum number of items to return with this call.
+	PageSize *int64 `type:"integer"`
 
-	// The tags associated with this product path.
-	Tags []*Tag `type:"list"`
+	// The page token for the next set of results. To retrieve the first set of
+	// results, use null.
+	PageToken *string `type:"string"`
+
+	// The identifier of the provisioned product that you want the outputs from.
+	ProvisionedProductId *string `min:"1" type:"string"`
+
+	// The name of the provisioned product that you want the outputs from.
+	ProvisionedProductName *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s LaunchPathSummary) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetProvisionedProductOutputsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s LaunchPathSummary) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetProvisionedProductOutputsInput) GoString() string {
 	return s.String()
 }
 
-// SetConstraintSummaries sets the ConstraintSummaries field's value.
-func (s *LaunchPathSummary) SetConstraintSummaries(v []*ConstraintSummary) *LaunchPathSummary {
-	s.ConstraintSummaries = v
-	return s
-}
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetProvisionedProductOutputsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetProvisionedProductOutputsInput"}
+	if s.ProvisionedProductId != nil && len(*s.ProvisionedProductId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ProvisionedProductId", 1))
+	}
+	if s.ProvisionedProductName != nil && len(*s.ProvisionedProductName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ProvisionedProductName", 1))
+	}
 
-// SetId sets the Id field's value.
-func (s *LaunchPathSummary) SetId(v string) *LaunchPathSummary {
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAcceptLanguage sets the AcceptLanguage field's value.
+func (s *GetProvisionedProductOutputsInput) SetAcceptLanguage(v string) *GetProvisionedProductOutputsInput {
+	s.AcceptLanguage = &v
+	return s
+}
+
+// SetOutputKeys sets the OutputKeys field's value.
+func (s *GetProvisionedProductOutputsInput) SetOutputKeys(v []*string) *GetProvisionedProductOutputsInput {
+	s.OutputKeys = v
+	return s
+}
+
+// SetPageSize sets the PageSize field's value.
+func (s *GetProvisionedProductOutputsInput) SetPageSize(v int64) *GetProvisionedProductOutputsInput {
+	s.PageSize = &v
+	return s
+}
+
+// SetPageToken sets the PageToken field's value.
+func (s *GetProvisionedProductOutputsInput) SetPageToken(v string) *GetProvisionedProductOutputsInput {
+	s.PageToken = &v
+	return s
+}
+
+// SetProvisionedProductId sets the ProvisionedProductId field's value.
+func (s *GetProvisionedProductOutputsInput) SetProvisionedProductId(v string) *GetProvisionedProductOutputsInput {
+	s.ProvisionedProductId = &v
+	return s
+}
+
+// SetProvisionedProductName sets the ProvisionedProductName field's value.
+func (s *GetProvisionedProductOutputsInput) SetProvisionedProductName(v string) *GetProvisionedProductOutputsInput {
+	s.ProvisionedProductName = &v
+	return s
+}
+
+type GetProvisionedProductOutputsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The page token to use to retrieve the next set of results. If there are no
+	// additional results, this value is null.
+	NextPageToken *string `type:"string"`
+
+	// Information about the product created as the result of a request. For example,
+	// the output for a CloudFormation-backed product that creates an S3 bucket
+	// would include the S3 bucket URL.
+	Outputs []*RecordOutput `type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetProvisionedProductOutputsOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetProvisionedProductOutputsOutput) GoString() string {
+	return s.String()
+}
+
+// SetNextPageToken sets the NextPageToken field's value.
+func (s *GetProvisionedProductOutputsOutput) SetNextPageToken(v string) *GetProvisionedProductOutputsOutput {
+	s.NextPageToken = &v
+	return s
+}
+
+// SetOutputs sets the Outputs field's value.
+func (s *GetProvisionedProductOutputsOutput) SetOutputs(v []*RecordOutput) *GetProvisionedProductOutputsOutput {
+	s.Outputs = v
+	return s
+}
+
+type ImportAsProvisionedProductInput struct {
+	_ struct{} `type:"structure"`
+
+	// The language code.
+	//
+	//    * jp - Japanese
+	//
+	//    * zh - Chinese
+	AcceptLanguage *string `type:"string"`
+
+	// A unique identifier that you provide to ensure idempotency. If multiple requests
+	// differ only by the idempotency token, the same response is returned for each
+	// repeated request.
+	IdempotencyToken *string `min:"1" type:"string" idempotencyToken:"true"`
+
+	// The unique identifier of the resource to be imported. It only currently supports
+	// CloudFormation stack IDs.
+	//
+	// PhysicalId is a required field
+	PhysicalId *string `type:"string" required:"true"`
+
+	// The product identifier.
+	//
+	// ProductId is a required field
+	ProductId *string `min:"1" type:"string" required:"true"`
+
+	// The user-friendly name of the provisioned product. The value must be unique
+	// for the Amazon Web Services account. The name cannot be updated after the
+	// product is provisioned.
+	//
+	// ProvisionedProductName is a required field
+	ProvisionedProductName *string `min:"1" type:"string" required:"true"`
+
+	// The identifier of the provisioning artifact.
+	//
+	// ProvisioningArtifactId is a required field
+	ProvisioningArtifactId *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ImportAsProvisionedProductInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ImportAsProvisionedProductInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ImportAsProvisionedProductInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ImportAsProvisionedProductInput"}
+	if s.IdempotencyToken != nil && len(*s.IdempotencyToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("IdempotencyToken", 1))
+	}
+	if s.PhysicalId == nil {
+		invalidParams.Add(request.NewErrParamRequired("PhysicalId"))
+	}
+	if s.ProductId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ProductId"))
+	}
+	if s.ProductId != nil && len(*s.ProductId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ProductId", 1))
+	}
+	if s.ProvisionedProductName == nil {
+		invalidParams.Add(request.NewErrParamRequired("ProvisionedProductName"))
+	}
+	if s.ProvisionedProductName != nil && len(*s.ProvisionedProductName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ProvisionedProductName", 1))
+	}
+	if s.ProvisioningArtifactId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ProvisioningArtifactId"))
+	}
+	if s.ProvisioningArtifactId != nil && len(*s.ProvisioningArtifactId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ProvisioningArtifactId", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAcceptLanguage sets the AcceptLanguage field's value.
+func (s *ImportAsProvisionedProductInput) SetAcceptLanguage(v string) *ImportAsProvisionedProductInput {
+	s.AcceptLanguage = &v
+	return s
+}
+
+// SetIdempotencyToken sets the IdempotencyToken field's value.
+func (s *ImportAsProvisionedProductInput) SetIdempotencyToken(v string) *ImportAsProvisionedProductInput {
+	s.IdempotencyToken = &v
+	return s
+}
+
+// SetPhysicalId sets the PhysicalId field's value.
+func (s *ImportAsProvisionedProductInput) SetPhysicalId(v string) *ImportAsProvisionedProductInput {
+	s.PhysicalId = &v
+	return s
+}
+
+// SetProductId sets the ProductId field's value.
+func (s *ImportAsProvisionedProductInput) SetProductId(v string) *ImportAsProvisionedProductInput {
+	s.ProductId = &v
+	return s
+}
+
+// SetProvisionedProductName sets the ProvisionedProductName field's value.
+func (s *ImportAsProvisionedProductInput) SetProvisionedProductName(v string) *ImportAsProvisionedProductInput {
+	s.ProvisionedProductName = &v
+	return s
+}
+
+// SetProvisioningArtifactId sets the ProvisioningArtifactId field's value.
+func (s *ImportAsProvisionedProductInput) SetProvisioningArtifactId(v string) *ImportAsProvisionedProductInput {
+	s.ProvisioningArtifactId = &v
+	return s
+}
+
+type ImportAsProvisionedProductOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Information about a request operation.
+	RecordDetail *RecordDetail `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ImportAsProvisionedProductOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ImportAsProvisionedProductOutput) GoString() string {
+	return s.String()
+}
+
+// SetRecordDetail sets the RecordDetail field's value.
+func (s *ImportAsProvisionedProductOutput) SetRecordDetail(v *RecordDetail) *ImportAsProvisionedProductOutput {
+	s.RecordDetail = v
+	return s
+}
+
+// One or more parameters provided to the operation are not valid.
+type InvalidParametersException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidParametersException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidParametersException) GoString() string {
+	return s.String()
+}
+
+func newErrorInvalidParametersException(v protocol.ResponseMetadata) error {
+	return &InvalidParametersException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InvalidParametersException) Code() string {
+	return "InvalidParametersException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidParametersException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidParametersException) OrigErr() error {
+	return nil
+}
+
+func (s *InvalidParametersException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidParametersException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidParametersException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// An attempt was made to modify a resource that is in a state that is not valid.
+// Check your resources to ensure that they are in valid states before retrying
+// the operation.
+type InvalidStateException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidStateException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidStateException) GoString() string {
+	return s.String()
+}
+
+func newErrorInvalidStateException(v protocol.ResponseMetadata) error {
+	return &InvalidStateException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InvalidStateException) Code() string {
+	return "InvalidStateException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidStateException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidStateException) OrigErr() error {
+	return nil
+}
+
+func (s *InvalidStateException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidStateException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidStateException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Provides details about the product's connection sync and contains the following
+// sub-fields.
+//
+//   - LastSyncTime
+//
+//   - LastSyncStatus
+//
+//   - LastSyncStatusMessage
+//
+//   - LastSuccessfulSyncTime
+//
+//   - LastSuccessfulSyncProvisioningArtifactID
+type LastSync struct {
+	_ struct{} `type:"structure"`
+
+	// The ProvisioningArtifactID of the ProvisioningArtifact created from the latest
+	// successful sync.
+	LastSuccessfulSyncProvisioningArtifactId *string `min:"1" type:"string"`
+
+	// The time of the latest successful sync from the source repo artifact to the
+	// Service Catalog product.
+	LastSuccessfulSyncTime *time.Time `type:"timestamp"`
+
+	// The current status of the sync. Responses include SUCCEEDED or FAILED.
+	LastSyncStatus *string `type:"string" enum:"LastSyncStatus"`
+
+	// The sync's status message.
+	LastSyncStatusMessage *string `type:"string"`
+
+	// The time of the last attempted sync from the repository to the Service Catalog
+	// product.
+	LastSyncTime *time.Time `type:"timestamp"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LastSync) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LastSync) GoString() string {
+	return s.String()
+}
+
+// SetLastSuccessfulSyncProvisioningArtifactId sets the LastSuccessfulSyncProvisioningArtifactId field's value.
+func (s *LastSync) SetLastSuccessfulSyncProvisioningArtifactId(v string) *LastSync {
+	s.LastSuccessfulSyncProvisioningArtifactId = &v
+	return s
+}
+
+// SetLastSuccessfulSyncTime sets the LastSuccessfulSyncTime field's value.
+func (s *LastSync) SetLastSuccessfulSyncTime(v time.Time) *LastSync {
+	s.LastSuccessfulSyncTime = &v
+	return s
+}
+
+// SetLastSyncStatus sets the LastSyncStatus field's value.
+func (s *LastSync) SetLastSyncStatus(v string) *LastSync {
+	s.LastSyncStatus = &v
+	return s
+}
+
+// SetLastSyncStatusMessage sets the LastSyncStatusMessage field's value.
+func (s *LastSync) SetLastSyncStatusMessage(v string) *LastSync {
+	s.LastSyncStatusMessage = &v
+	return s
+}
+
+// SetLastSyncTime sets the LastSyncTime field's value.
+func (s *LastSync) SetLastSyncTime(v time.Time) *LastSync {
+	s.LastSyncTime = &v
+	return s
+}
+
+// A launch path object.
+type LaunchPath struct {
+	_ struct{} `type:"structure"`
+
+	// The identifier of the launch path.
+	Id *string `min:"1" type:"string"`
+
+	// The name of the launch path.
+	Name *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LaunchPath) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LaunchPath) GoString() string {
+	return s.String()
+}
+
+// SetId sets the Id field's value.
+func (s *LaunchPath) SetId(v string) *LaunchPath {
+	s.Id = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *LaunchPath) SetName(v string) *LaunchPath {
+	s.Name = &v
+	return s
+}
+
+// Summary information about a product path for a user.
+type LaunchPathSummary struct {
+	_ struct{} `type:"structure"`
+
+	// The constraints on the portfolio-product relationship.
+	ConstraintSummaries []*ConstraintSummary `type:"list"`
+
+	// The identifier of the product path.
+	Id *string `min:"1" type:"string"`
+
+	// The name of the portfolio that contains the product.
+	Name *string `type:"string"`
+
+	// The tags associated with this product path.
+	Tags []*Tag `type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LaunchPathSummary) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LaunchPathSummary) GoString() string {
+	return s.String()
+}
+
+// SetConstraintSummaries sets the ConstraintSummaries field's value.
+func (s *LaunchPathSummary) SetConstraintSummaries(v []*ConstraintSummary) *LaunchPathSummary {
+	s.ConstraintSummaries = v
+	return s
+}
+
+// SetId sets the Id field's value.
+func (s *LaunchPathSummary) SetId(v string) *LaunchPathSummary {
 	s.Id = &v
 	return s
 }
@@ -13347,13 +16327,77 @@ func (s *LaunchPathSummary) SetTags(v []*Tag) *LaunchPathSummary {
 	return s
 }
 
+// The current limits of the service would have been exceeded by this operation.
+// Decrease your resource use or increase your service limits and retry the
+// operation.
+type LimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LimitExceededException) GoString() string {
+	return s.String()
+}
+
+func newErrorLimitExceededException(v protocol.ResponseMetadata) error {
+	return &LimitExceededException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *LimitExceededException) Code() string {
+	return "LimitExceededException"
+}
+
+// Message returns the exception's message.
+func (s *LimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *LimitExceededException) OrigErr() error {
+	return nil
+}
+
+func (s *LimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *LimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *LimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 type ListAcceptedPortfolioSharesInput struct {
 	_ struct{} `type:"structure"`
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -13368,21 +16412,30 @@ type ListAcceptedPortfolioSharesInput struct {
 
 	// The type of shared portfolios to list. The default is to list imported portfolios.
 	//
-	//    * AWS_ORGANIZATIONS - List portfolios shared by the master account of
-	//    your organization
+	//    * AWS_ORGANIZATIONS - List portfolios accepted and shared via organizational
+	//    sharing by the management account or delegated administrator of your organization.
 	//
-	//    * AWS_SERVICECATALOG - List default portfolios
+	//    * AWS_SERVICECATALOG - Deprecated type.
 	//
-	//    * IMPORTED - List imported portfolios
+	//    * IMPORTED - List imported portfolios that have been accepted and shared
+	//    through account-to-account sharing.
 	PortfolioShareType *string `type:"string" enum:"PortfolioShareType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListAcceptedPortfolioSharesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListAcceptedPortfolioSharesInput) GoString() string {
 	return s.String()
 }
@@ -13422,12 +16475,20 @@ type ListAcceptedPortfolioSharesOutput struct {
 	PortfolioDetails []*PortfolioDetail `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListAcceptedPortfolioSharesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListAcceptedPortfolioSharesOutput) GoString() string {
 	return s.String()
 }
@@ -13449,8 +16510,6 @@ type ListBudgetsForResourceInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -13469,12 +16528,20 @@ type ListBudgetsForResourceInput struct {
 	ResourceId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListBudgetsForResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListBudgetsForResourceInput) GoString() string {
 	return s.String()
 }
@@ -13530,12 +16597,20 @@ type ListBudgetsForResourceOutput struct {
 	NextPageToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListBudgetsForResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListBudgetsForResourceOutput) GoString() string {
 	return s.String()
 }
@@ -13557,8 +16632,6 @@ type ListConstraintsForPortfolioInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -13580,12 +16653,20 @@ type ListConstraintsForPortfolioInput struct {
 	ProductId *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListConstraintsForPortfolioInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListConstraintsForPortfolioInput) GoString() string {
 	return s.String()
 }
@@ -13650,12 +16731,20 @@ type ListConstraintsForPortfolioOutput struct {
 	NextPageToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListConstraintsForPortfolioOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListConstraintsForPortfolioOutput) GoString() string {
 	return s.String()
 }
@@ -13677,8 +16766,6 @@ type ListLaunchPathsInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -13697,12 +16784,20 @@ type ListLaunchPathsInput struct {
 	ProductId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListLaunchPathsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListLaunchPathsInput) GoString() string {
 	return s.String()
 }
@@ -13758,12 +16853,20 @@ type ListLaunchPathsOutput struct {
 	NextPageToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListLaunchPathsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListLaunchPathsOutput) GoString() string {
 	return s.String()
 }
@@ -13785,8 +16888,6 @@ type ListOrganizationPortfolioAccessInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -13817,12 +16918,20 @@ type ListOrganizationPortfolioAccessInput struct {
 	PortfolioId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListOrganizationPortfolioAccessInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListOrganizationPortfolioAccessInput) GoString() string {
 	return s.String()
 }
@@ -13887,12 +16996,20 @@ type ListOrganizationPortfolioAccessOutput struct {
 	OrganizationNodes []*OrganizationNode `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListOrganizationPortfolioAccessOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListOrganizationPortfolioAccessOutput) GoString() string {
 	return s.String()
 }
@@ -13914,25 +17031,42 @@ type ListPortfolioAccessInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
 	AcceptLanguage *string `type:"string"`
 
+	// The ID of an organization node the portfolio is shared with. All children
+	// of this node with an inherited portfolio share will be returned.
+	OrganizationParentId *string `min:"1" type:"string"`
+
+	// The maximum number of items to return with this call.
+	PageSize *int64 `type:"integer"`
+
+	// The page token for the next set of results. To retrieve the first set of
+	// results, use null.
+	PageToken *string `type:"string"`
+
 	// The portfolio identifier.
 	//
 	// PortfolioId is a required field
 	PortfolioId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPortfolioAccessInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPortfolioAccessInput) GoString() string {
 	return s.String()
 }
@@ -13940,6 +17074,9 @@ func (s ListPortfolioAccessInput) GoString() string {
 // Validate inspects the fields of the type to determine if they are valid.
 func (s *ListPortfolioAccessInput) Validate() error {
 	invalidParams := request.ErrInvalidParams{Context: "ListPortfolioAccessInput"}
+	if s.OrganizationParentId != nil && len(*s.OrganizationParentId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("OrganizationParentId", 1))
+	}
 	if s.PortfolioId == nil {
 		invalidParams.Add(request.NewErrParamRequired("PortfolioId"))
 	}
@@ -13959,6 +17096,24 @@ func (s *ListPortfolioAccessInput) SetAcceptLanguage(v string) *ListPortfolioAcc
 	return s
 }
 
+// SetOrganizationParentId sets the OrganizationParentId field's value.
+func (s *ListPortfolioAccessInput) SetOrganizationParentId(v string) *ListPortfolioAccessInput {
+	s.OrganizationParentId = &v
+	return s
+}
+
+// SetPageSize sets the PageSize field's value.
+func (s *ListPortfolioAccessInput) SetPageSize(v int64) *ListPortfolioAccessInput {
+	s.PageSize = &v
+	return s
+}
+
+// SetPageToken sets the PageToken field's value.
+func (s *ListPortfolioAccessInput) SetPageToken(v string) *ListPortfolioAccessInput {
+	s.PageToken = &v
+	return s
+}
+
 // SetPortfolioId sets the PortfolioId field's value.
 func (s *ListPortfolioAccessInput) SetPortfolioId(v string) *ListPortfolioAccessInput {
 	s.PortfolioId = &v
@@ -13968,7 +17123,7 @@ func (s *ListPortfolioAccessInput) SetPortfolioId(v string) *ListPortfolioAccess
 type ListPortfolioAccessOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Information about the AWS accounts with access to the portfolio.
+	// Information about the Amazon Web Services accounts with access to the portfolio.
 	AccountIds []*string `type:"list"`
 
 	// The page token to use to retrieve the next set of results. If there are no
@@ -13976,12 +17131,20 @@ type ListPortfolioAccessOutput struct {
 	NextPageToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPortfolioAccessOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPortfolioAccessOutput) GoString() string {
 	return s.String()
 }
@@ -14003,8 +17166,6 @@ type ListPortfoliosForProductInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -14023,12 +17184,20 @@ type ListPortfoliosForProductInput struct {
 	ProductId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPortfoliosForProductInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPortfoliosForProductInput) GoString() string {
 	return s.String()
 }
@@ -14084,12 +17253,20 @@ type ListPortfoliosForProductOutput struct {
 	PortfolioDetails []*PortfolioDetail `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPortfoliosForProductOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPortfoliosForProductOutput) GoString() string {
 	return s.String()
 }
@@ -14111,8 +17288,6 @@ type ListPortfoliosInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -14126,12 +17301,20 @@ type ListPortfoliosInput struct {
 	PageToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPortfoliosInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPortfoliosInput) GoString() string {
 	return s.String()
 }
@@ -14165,12 +17348,20 @@ type ListPortfoliosOutput struct {
 	PortfolioDetails []*PortfolioDetail `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPortfoliosOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPortfoliosOutput) GoString() string {
 	return s.String()
 }
@@ -14192,8 +17383,6 @@ type ListPrincipalsForPortfolioInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -14212,12 +17401,20 @@ type ListPrincipalsForPortfolioInput struct {
 	PortfolioId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPrincipalsForPortfolioInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPrincipalsForPortfolioInput) GoString() string {
 	return s.String()
 }
@@ -14269,16 +17466,24 @@ type ListPrincipalsForPortfolioOutput struct {
 	// additional results, this value is null.
 	NextPageToken *string `type:"string"`
 
-	// The IAM principals (users or roles) associated with the portfolio.
+	// The PrincipalARNs and corresponding PrincipalTypes associated with the portfolio.
 	Principals []*Principal `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPrincipalsForPortfolioOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPrincipalsForPortfolioOutput) GoString() string {
 	return s.String()
 }
@@ -14300,8 +17505,6 @@ type ListProvisionedProductPlansInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -14321,12 +17524,20 @@ type ListProvisionedProductPlansInput struct {
 	ProvisionProductId *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListProvisionedProductPlansInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListProvisionedProductPlansInput) GoString() string {
 	return s.String()
 }
@@ -14385,12 +17596,20 @@ type ListProvisionedProductPlansOutput struct {
 	ProvisionedProductPlans []*ProvisionedProductPlanSummary `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListProvisionedProductPlansOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListProvisionedProductPlansOutput) GoString() string {
 	return s.String()
 }
@@ -14412,8 +17631,6 @@ type ListProvisioningArtifactsForServiceActionInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -14432,12 +17649,20 @@ type ListProvisioningArtifactsForServiceActionInput struct {
 	ServiceActionId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListProvisioningArtifactsForServiceActionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListProvisioningArtifactsForServiceActionInput) GoString() string {
 	return s.String()
 }
@@ -14494,12 +17719,20 @@ type ListProvisioningArtifactsForServiceActionOutput struct {
 	ProvisioningArtifactViews []*ProvisioningArtifactView `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListProvisioningArtifactsForServiceActionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListProvisioningArtifactsForServiceActionOutput) GoString() string {
 	return s.String()
 }
@@ -14521,8 +17754,6 @@ type ListProvisioningArtifactsInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -14534,12 +17765,20 @@ type ListProvisioningArtifactsInput struct {
 	ProductId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListProvisioningArtifactsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListProvisioningArtifactsInput) GoString() string {
 	return s.String()
 }
@@ -14583,12 +17822,20 @@ type ListProvisioningArtifactsOutput struct {
 	ProvisioningArtifactDetails []*ProvisioningArtifactDetail `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListProvisioningArtifactsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListProvisioningArtifactsOutput) GoString() string {
 	return s.String()
 }
@@ -14610,8 +17857,6 @@ type ListRecordHistoryInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -14631,12 +17876,20 @@ type ListRecordHistoryInput struct {
 	SearchFilter *ListRecordHistorySearchFilter `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListRecordHistoryInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListRecordHistoryInput) GoString() string {
 	return s.String()
 }
@@ -14682,12 +17935,20 @@ type ListRecordHistoryOutput struct {
 	RecordDetails []*RecordDetail `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListRecordHistoryOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListRecordHistoryOutput) GoString() string {
 	return s.String()
 }
@@ -14720,12 +17981,20 @@ type ListRecordHistorySearchFilter struct {
 	Value *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListRecordHistorySearchFilter) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListRecordHistorySearchFilter) GoString() string {
 	return s.String()
 }
@@ -14765,12 +18034,20 @@ type ListResourcesForTagOptionInput struct {
 	TagOptionId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListResourcesForTagOptionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListResourcesForTagOptionInput) GoString() string {
 	return s.String()
 }
@@ -14826,12 +18103,20 @@ type ListResourcesForTagOptionOutput struct {
 	ResourceDetails []*ResourceDetail `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListResourcesForTagOptionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListResourcesForTagOptionOutput) GoString() string {
 	return s.String()
 }
@@ -14853,8 +18138,6 @@ type ListServiceActionsForProvisioningArtifactInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -14878,12 +18161,20 @@ type ListServiceActionsForProvisioningArtifactInput struct {
 	ProvisioningArtifactId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListServiceActionsForProvisioningArtifactInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListServiceActionsForProvisioningArtifactInput) GoString() string {
 	return s.String()
 }
@@ -14952,12 +18243,20 @@ type ListServiceActionsForProvisioningArtifactOutput struct {
 	ServiceActionSummaries []*ServiceActionSummary `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListServiceActionsForProvisioningArtifactOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListServiceActionsForProvisioningArtifactOutput) GoString() string {
 	return s.String()
 }
@@ -14979,8 +18278,6 @@ type ListServiceActionsInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -14994,12 +18291,20 @@ type ListServiceActionsInput struct {
 	PageToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListServiceActionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListServiceActionsInput) GoString() string {
 	return s.String()
 }
@@ -15034,12 +18339,20 @@ type ListServiceActionsOutput struct {
 	ServiceActionSummaries []*ServiceActionSummary `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListServiceActionsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListServiceActionsOutput) GoString() string {
 	return s.String()
 }
@@ -15061,8 +18374,6 @@ type ListStackInstancesForProvisionedProductInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -15081,12 +18392,20 @@ type ListStackInstancesForProvisionedProductInput struct {
 	ProvisionedProductId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListStackInstancesForProvisionedProductInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListStackInstancesForProvisionedProductInput) GoString() string {
 	return s.String()
 }
@@ -15142,12 +18461,20 @@ type ListStackInstancesForProvisionedProductOutput struct {
 	StackInstances []*StackInstance `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListStackInstancesForProvisionedProductOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListStackInstancesForProvisionedProductOutput) GoString() string {
 	return s.String()
 }
@@ -15178,12 +18505,20 @@ type ListTagOptionsFilters struct {
 	Value *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagOptionsFilters) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagOptionsFilters) GoString() string {
 	return s.String()
 }
@@ -15237,12 +18572,20 @@ type ListTagOptionsInput struct {
 	PageToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagOptionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagOptionsInput) GoString() string {
 	return s.String()
 }
@@ -15268,49 +18611,535 @@ func (s *ListTagOptionsInput) SetFilters(v *ListTagOptionsFilters) *ListTagOptio
 	return s
 }
 
-// SetPageSize sets the PageSize field's value.
-func (s *ListTagOptionsInput) SetPageSize(v int64) *ListTagOptionsInput {
-	s.PageSize = &v
+// SetPageSize sets the PageSize field's value.
+func (s *ListTagOptionsInput) SetPageSize(v int64) *ListTagOptionsInput {
+	s.PageSize = &v
+	return s
+}
+
+// SetPageToken sets the PageToken field's value.
+func (s *ListTagOptionsInput) SetPageToken(v string) *ListTagOptionsInput {
+	s.PageToken = &v
+	return s
+}
+
+type ListTagOptionsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The page token for the next set of results. To retrieve the first set of
+	// results, use null.
+	PageToken *string `type:"string"`
+
+	// Information about the TagOptions.
+	TagOptionDetails []*TagOptionDetail `type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagOptionsOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagOptionsOutput) GoString() string {
+	return s.String()
+}
+
+// SetPageToken sets the PageToken field's value.
+func (s *ListTagOptionsOutput) SetPageToken(v string) *ListTagOptionsOutput {
+	s.PageToken = &v
+	return s
+}
+
+// SetTagOptionDetails sets the TagOptionDetails field's value.
+func (s *ListTagOptionsOutput) SetTagOptionDetails(v []*TagOptionDetail) *ListTagOptionsOutput {
+	s.TagOptionDetails = v
+	return s
+}
+
+type NotifyProvisionProductEngineWorkflowResultInput struct {
+	_ struct{} `type:"structure"`
+
+	// The reason why the provisioning engine execution failed.
+	FailureReason *string `min:"1" type:"string"`
+
+	// The idempotency token that identifies the provisioning engine execution.
+	IdempotencyToken *string `min:"1" type:"string" idempotencyToken:"true"`
+
+	// The output of the provisioning engine execution.
+	Outputs []*RecordOutput `type:"list"`
+
+	// The identifier of the record.
+	//
+	// RecordId is a required field
+	RecordId *string `min:"1" type:"string" required:"true"`
+
+	// The ID for the provisioned product resources that are part of a resource
+	// group.
+	ResourceIdentifier *EngineWorkflowResourceIdentifier `type:"structure"`
+
+	// The status of the provisioning engine execution.
+	//
+	// Status is a required field
+	Status *string `type:"string" required:"true" enum:"EngineWorkflowStatus"`
+
+	// The encrypted contents of the provisioning engine execution payload that
+	// Service Catalog sends after the Terraform product provisioning workflow starts.
+	//
+	// WorkflowToken is a required field
+	WorkflowToken *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotifyProvisionProductEngineWorkflowResultInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotifyProvisionProductEngineWorkflowResultInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *NotifyProvisionProductEngineWorkflowResultInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "NotifyProvisionProductEngineWorkflowResultInput"}
+	if s.FailureReason != nil && len(*s.FailureReason) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("FailureReason", 1))
+	}
+	if s.IdempotencyToken != nil && len(*s.IdempotencyToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("IdempotencyToken", 1))
+	}
+	if s.RecordId == nil {
+		invalidParams.Add(request.NewErrParamRequired("RecordId"))
+	}
+	if s.RecordId != nil && len(*s.RecordId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RecordId", 1))
+	}
+	if s.Status == nil {
+		invalidParams.Add(request.NewErrParamRequired("Status"))
+	}
+	if s.WorkflowToken == nil {
+		invalidParams.Add(request.NewErrParamRequired("WorkflowToken"))
+	}
+	if s.WorkflowToken != nil && len(*s.WorkflowToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("WorkflowToken", 1))
+	}
+	if s.ResourceIdentifier != nil {
+		if err := s.ResourceIdentifier.Validate(); err != nil {
+			invalidParams.AddNested("ResourceIdentifier", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetFailureReason sets the FailureReason field's value.
+func (s *NotifyProvisionProductEngineWorkflowResultInput) SetFailureReason(v string) *NotifyProvisionProductEngineWorkflowResultInput {
+	s.FailureReason = &v
+	return s
+}
+
+// SetIdempotencyToken sets the IdempotencyToken field's value.
+func (s *NotifyProvisionProductEngineWorkflowResultInput) SetIdempotencyToken(v string) *NotifyProvisionProductEngineWorkflowResultInput {
+	s.IdempotencyToken = &v
+	return s
+}
+
+// SetOutputs sets the Outputs field's value.
+func (s *NotifyProvisionProductEngineWorkflowResultInput) SetOutputs(v []*RecordOutput) *NotifyProvisionProductEngineWorkflowResultInput {
+	s.Outputs = v
+	return s
+}
+
+// SetRecordId sets the RecordId field's value.
+func (s *NotifyProvisionProductEngineWorkflowResultInput) SetRecordId(v string) *NotifyProvisionProductEngineWorkflowResultInput {
+	s.RecordId = &v
+	return s
+}
+
+// SetResourceIdentifier sets the ResourceIdentifier field's value.
+func (s *NotifyProvisionProductEngineWorkflowResultInput) SetResourceIdentifier(v *EngineWorkflowResourceIdentifier) *NotifyProvisionProductEngineWorkflowResultInput {
+	s.ResourceIdentifier = v
+	return s
+}
+
+// SetStatus sets the Status field's value.
+func (s *NotifyProvisionProductEngineWorkflowResultInput) SetStatus(v string) *NotifyProvisionProductEngineWorkflowResultInput {
+	s.Status = &v
+	return s
+}
+
+// SetWorkflowToken sets the WorkflowToken field's value.
+func (s *NotifyProvisionProductEngineWorkflowResultInput) SetWorkflowToken(v string) *NotifyProvisionProductEngineWorkflowResultInput {
+	s.WorkflowToken = &v
+	return s
+}
+
+type NotifyProvisionProductEngineWorkflowResultOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotifyProvisionProductEngineWorkflowResultOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotifyProvisionProductEngineWorkflowResultOutput) GoString() string {
+	return s.String()
+}
+
+type NotifyTerminateProvisionedProductEngineWorkflowResultInput struct {
+	_ struct{} `type:"structure"`
+
+	// The reason why the terminate engine execution failed.
+	FailureReason *string `min:"1" type:"string"`
+
+	// The idempotency token that identifies the terminate engine execution.
+	IdempotencyToken *string `min:"1" type:"string" idempotencyToken:"true"`
+
+	// The identifier of the record.
+	//
+	// RecordId is a required field
+	RecordId *string `min:"1" type:"string" required:"true"`
+
+	// The status of the terminate engine execution.
+	//
+	// Status is a required field
+	Status *string `type:"string" required:"true" enum:"EngineWorkflowStatus"`
+
+	// The encrypted contents of the terminate engine execution payload that Service
+	// Catalog sends after the Terraform product terminate workflow starts.
+	//
+	// WorkflowToken is a required field
+	WorkflowToken *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotifyTerminateProvisionedProductEngineWorkflowResultInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotifyTerminateProvisionedProductEngineWorkflowResultInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *NotifyTerminateProvisionedProductEngineWorkflowResultInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "NotifyTerminateProvisionedProductEngineWorkflowResultInput"}
+	if s.FailureReason != nil && len(*s.FailureReason) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("FailureReason", 1))
+	}
+	if s.IdempotencyToken != nil && len(*s.IdempotencyToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("IdempotencyToken", 1))
+	}
+	if s.RecordId == nil {
+		invalidParams.Add(request.NewErrParamRequired("RecordId"))
+	}
+	if s.RecordId != nil && len(*s.RecordId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RecordId", 1))
+	}
+	if s.Status == nil {
+		invalidParams.Add(request.NewErrParamRequired("Status"))
+	}
+	if s.WorkflowToken == nil {
+		invalidParams.Add(request.NewErrParamRequired("WorkflowToken"))
+	}
+	if s.WorkflowToken != nil && len(*s.WorkflowToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("WorkflowToken", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetFailureReason sets the FailureReason field's value.
+func (s *NotifyTerminateProvisionedProductEngineWorkflowResultInput) SetFailureReason(v string) *NotifyTerminateProvisionedProductEngineWorkflowResultInput {
+	s.FailureReason = &v
+	return s
+}
+
+// SetIdempotencyToken sets the IdempotencyToken field's value.
+func (s *NotifyTerminateProvisionedProductEngineWorkflowResultInput) SetIdempotencyToken(v string) *NotifyTerminateProvisionedProductEngineWorkflowResultInput {
+	s.IdempotencyToken = &v
+	return s
+}
+
+// SetRecordId sets the RecordId field's value.
+func (s *NotifyTerminateProvisionedProductEngineWorkflowResultInput) SetRecordId(v string) *NotifyTerminateProvisionedProductEngineWorkflowResultInput {
+	s.RecordId = &v
+	return s
+}
+
+// SetStatus sets the Status field's value.
+func (s *NotifyTerminateProvisionedProductEngineWorkflowResultInput) SetStatus(v string) *NotifyTerminateProvisionedProductEngineWorkflowResultInput {
+	s.Status = &v
+	return s
+}
+
+// SetWorkflowToken sets the WorkflowToken field's value.
+func (s *NotifyTerminateProvisionedProductEngineWorkflowResultInput) SetWorkflowToken(v string) *NotifyTerminateProvisionedProductEngineWorkflowResultInput {
+	s.WorkflowToken = &v
+	return s
+}
+
+type NotifyTerminateProvisionedProductEngineWorkflowResultOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotifyTerminateProvisionedProductEngineWorkflowResultOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotifyTerminateProvisionedProductEngineWorkflowResultOutput) GoString() string {
+	return s.String()
+}
+
+type NotifyUpdateProvisionedProductEngineWorkflowResultInput struct {
+	_ struct{} `type:"structure"`
+
+	// The reason why the update engine execution failed.
+	FailureReason *string `min:"1" type:"string"`
+
+	// The idempotency token that identifies the update engine execution.
+	IdempotencyToken *string `min:"1" type:"string" idempotencyToken:"true"`
+
+	// The output of the update engine execution.
+	Outputs []*RecordOutput `type:"list"`
+
+	// The identifier of the record.
+	//
+	// RecordId is a required field
+	RecordId *string `min:"1" type:"string" required:"true"`
+
+	// The status of the update engine execution.
+	//
+	// Status is a required field
+	Status *string `type:"string" required:"true" enum:"EngineWorkflowStatus"`
+
+	// The encrypted contents of the update engine execution payload that Service
+	// Catalog sends after the Terraform product update workflow starts.
+	//
+	// WorkflowToken is a required field
+	WorkflowToken *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotifyUpdateProvisionedProductEngineWorkflowResultInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotifyUpdateProvisionedProductEngineWorkflowResultInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *NotifyUpdateProvisionedProductEngineWorkflowResultInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "NotifyUpdateProvisionedProductEngineWorkflowResultInput"}
+	if s.FailureReason != nil && len(*s.FailureReason) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("FailureReason", 1))
+	}
+	if s.IdempotencyToken != nil && len(*s.IdempotencyToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("IdempotencyToken", 1))
+	}
+	if s.RecordId == nil {
+		invalidParams.Add(request.NewErrParamRequired("RecordId"))
+	}
+	if s.RecordId != nil && len(*s.RecordId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("RecordId", 1))
+	}
+	if s.Status == nil {
+		invalidParams.Add(request.NewErrParamRequired("Status"))
+	}
+	if s.WorkflowToken == nil {
+		invalidParams.Add(request.NewErrParamRequired("WorkflowToken"))
+	}
+	if s.WorkflowToken != nil && len(*s.WorkflowToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("WorkflowToken", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetFailureReason sets the FailureReason field's value.
+func (s *NotifyUpdateProvisionedProductEngineWorkflowResultInput) SetFailureReason(v string) *NotifyUpdateProvisionedProductEngineWorkflowResultInput {
+	s.FailureReason = &v
+	return s
+}
+
+// SetIdempotencyToken sets the IdempotencyToken field's value.
+func (s *NotifyUpdateProvisionedProductEngineWorkflowResultInput) SetIdempotencyToken(v string) *NotifyUpdateProvisionedProductEngineWorkflowResultInput {
+	s.IdempotencyToken = &v
+	return s
+}
+
+// SetOutputs sets the Outputs field's value.
+func (s *NotifyUpdateProvisionedProductEngineWorkflowResultInput) SetOutputs(v []*RecordOutput) *NotifyUpdateProvisionedProductEngineWorkflowResultInput {
+	s.Outputs = v
+	return s
+}
+
+// SetRecordId sets the RecordId field's value.
+func (s *NotifyUpdateProvisionedProductEngineWorkflowResultInput) SetRecordId(v string) *NotifyUpdateProvisionedProductEngineWorkflowResultInput {
+	s.RecordId = &v
+	return s
+}
+
+// SetStatus sets the Status field's value.
+func (s *NotifyUpdateProvisionedProductEngineWorkflowResultInput) SetStatus(v string) *NotifyUpdateProvisionedProductEngineWorkflowResultInput {
+	s.Status = &v
 	return s
 }
 
-// SetPageToken sets the PageToken field's value.
-func (s *ListTagOptionsInput) SetPageToken(v string) *ListTagOptionsInput {
-	s.PageToken = &v
+// SetWorkflowToken sets the WorkflowToken field's value.
+func (s *NotifyUpdateProvisionedProductEngineWorkflowResultInput) SetWorkflowToken(v string) *NotifyUpdateProvisionedProductEngineWorkflowResultInput {
+	s.WorkflowToken = &v
 	return s
 }
 
-type ListTagOptionsOutput struct {
+type NotifyUpdateProvisionedProductEngineWorkflowResultOutput struct {
 	_ struct{} `type:"structure"`
+}
 
-	// The page token for the next set of results. To retrieve the first set of
-	// results, use null.
-	PageToken *string `type:"string"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotifyUpdateProvisionedProductEngineWorkflowResultOutput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// Information about the TagOptions.
-	TagOptionDetails []*TagOptionDetail `type:"list"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NotifyUpdateProvisionedProductEngineWorkflowResultOutput) GoString() string {
+	return s.String()
 }
 
-// String returns the string representation
-func (s ListTagOptionsOutput) String() string {
+// The operation is not supported.
+type OperationNotSupportedException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s OperationNotSupportedException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListTagOptionsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s OperationNotSupportedException) GoString() string {
 	return s.String()
 }
 
-// SetPageToken sets the PageToken field's value.
-func (s *ListTagOptionsOutput) SetPageToken(v string) *ListTagOptionsOutput {
-	s.PageToken = &v
-	return s
+func newErrorOperationNotSupportedException(v protocol.ResponseMetadata) error {
+	return &OperationNotSupportedException{
+		RespMetadata: v,
+	}
 }
 
-// SetTagOptionDetails sets the TagOptionDetails field's value.
-func (s *ListTagOptionsOutput) SetTagOptionDetails(v []*TagOptionDetail) *ListTagOptionsOutput {
-	s.TagOptionDetails = v
-	return s
+// Code returns the exception type name.
+func (s *OperationNotSupportedException) Code() string {
+	return "OperationNotSupportedException"
+}
+
+// Message returns the exception's message.
+func (s *OperationNotSupportedException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *OperationNotSupportedException) OrigErr() error {
+	return nil
+}
+
+func (s *OperationNotSupportedException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *OperationNotSupportedException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *OperationNotSupportedException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
 // Information about the organization node.
@@ -15324,12 +19153,20 @@ type OrganizationNode struct {
 	Value *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OrganizationNode) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OrganizationNode) GoString() string {
 	return s.String()
 }
@@ -15350,26 +19187,105 @@ func (s *OrganizationNode) SetValue(v string) *OrganizationNode {
 type ParameterConstraints struct {
 	_ struct{} `type:"structure"`
 
+	// A regular expression that represents the patterns that allow for String types.
+	// The pattern must match the entire parameter value provided.
+	AllowedPattern *string `type:"string"`
+
 	// The values that the administrator has allowed for the parameter.
 	AllowedValues []*string `type:"list"`
+
+	// A string that explains a constraint when the constraint is violated. For
+	// example, without a constraint description, a parameter that has an allowed
+	// pattern of [A-Za-z0-9]+ displays the following error message when the user
+	// specifies an invalid value:
+	//
+	// Malformed input-Parameter MyParameter must match pattern [A-Za-z0-9]+
+	//
+	// By adding a constraint description, such as must only contain letters (uppercase
+	// and lowercase) and numbers, you can display the following customized error
+	// message:
+	//
+	// Malformed input-Parameter MyParameter must only contain uppercase and lowercase
+	// letters and numbers.
+	ConstraintDescription *string `type:"string"`
+
+	// An integer value that determines the largest number of characters you want
+	// to allow for String types.
+	MaxLength *string `type:"string"`
+
+	// A numeric value that determines the largest numeric value you want to allow
+	// for Number types.
+	MaxValue *string `type:"string"`
+
+	// An integer value that determines the smallest number of characters you want
+	// to allow for String types.
+	MinLength *string `type:"string"`
+
+	// A numeric value that determines the smallest numeric value you want to allow
+	// for Number types.
+	MinValue *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ParameterConstraints) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ParameterConstraints) GoString() string {
 	return s.String()
 }
 
+// SetAllowedPattern sets the AllowedPattern field's value.
+func (s *ParameterConstraints) SetAllowedPattern(v string) *ParameterConstraints {
+	s.AllowedPattern = &v
+	return s
+}
+
 // SetAllowedValues sets the AllowedValues field's value.
 func (s *ParameterConstraints) SetAllowedValues(v []*string) *ParameterConstraints {
 	s.AllowedValues = v
 	return s
 }
 
+// SetConstraintDescription sets the ConstraintDescription field's value.
+func (s *ParameterConstraints) SetConstraintDescription(v string) *ParameterConstraints {
+	s.ConstraintDescription = &v
+	return s
+}
+
+// SetMaxLength sets the MaxLength field's value.
+func (s *ParameterConstraints) SetMaxLength(v string) *ParameterConstraints {
+	s.MaxLength = &v
+	return s
+}
+
+// SetMaxValue sets the MaxValue field's value.
+func (s *ParameterConstraints) SetMaxValue(v string) *ParameterConstraints {
+	s.MaxValue = &v
+	return s
+}
+
+// SetMinLength sets the MinLength field's value.
+func (s *ParameterConstraints) SetMinLength(v string) *ParameterConstraints {
+	s.MinLength = &v
+	return s
+}
+
+// SetMinValue sets the MinValue field's value.
+func (s *ParameterConstraints) SetMinValue(v string) *ParameterConstraints {
+	s.MinValue = &v
+	return s
+}
+
 // Information about a portfolio.
 type PortfolioDetail struct {
 	_ struct{} `type:"structure"`
@@ -15393,12 +19309,20 @@ type PortfolioDetail struct {
 	ProviderName *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PortfolioDetail) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PortfolioDetail) GoString() string {
 	return s.String()
 }
@@ -15439,23 +19363,118 @@ func (s *PortfolioDetail) SetProviderName(v string) *PortfolioDetail {
 	return s
 }
 
+// Information about the portfolio share.
+type PortfolioShareDetail struct {
+	_ struct{} `type:"structure"`
+
+	// Indicates whether the shared portfolio is imported by the recipient account.
+	// If the recipient is in an organization node, the share is automatically imported,
+	// and the field is always set to true.
+	Accepted *bool `type:"boolean"`
+
+	// The identifier of the recipient entity that received the portfolio share.
+	// The recipient entity can be one of the following:
+	//
+	// 1. An external account.
+	//
+	// 2. An organziation member account.
+	//
+	// 3. An organzational unit (OU).
+	//
+	// 4. The organization itself. (This shares with every account in the organization).
+	PrincipalId *string `min:"1" type:"string"`
+
+	// Indicates if Principal sharing is enabled or disabled for the portfolio share.
+	SharePrincipals *bool `type:"boolean"`
+
+	// Indicates whether TagOptions sharing is enabled or disabled for the portfolio
+	// share.
+	ShareTagOptions *bool `type:"boolean"`
+
+	// The type of the portfolio share.
+	Type *string `type:"string" enum:"DescribePortfolioShareType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PortfolioShareDetail) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PortfolioShareDetail) GoString() string {
+	return s.String()
+}
+
+// SetAccepted sets the Accepted field's value.
+func (s *PortfolioShareDetail) SetAccepted(v bool) *PortfolioShareDetail {
+	s.Accepted = &v
+	return s
+}
+
+// SetPrincipalId sets the PrincipalId field's value.
+func (s *PortfolioShareDetail) SetPrincipalId(v string) *PortfolioShareDetail {
+	s.PrincipalId = &v
+	return s
+}
+
+// SetSharePrincipals sets the SharePrincipals field's value.
+func (s *PortfolioShareDetail) SetSharePrincipals(v bool) *PortfolioShareDetail {
+	s.SharePrincipals = &v
+	return s
+}
+
+// SetShareTagOptions sets the ShareTagOptions field's value.
+func (s *PortfolioShareDetail) SetShareTagOptions(v bool) *PortfolioShareDetail {
+	s.ShareTagOptions = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *PortfolioShareDetail) SetType(v string) *PortfolioShareDetail {
+	s.Type = &v
+	return s
+}
+
 // Information about a principal.
 type Principal struct {
 	_ struct{} `type:"structure"`
 
-	// The ARN of the principal (IAM user, role, or group).
+	// The ARN of the principal (user, role, or group). This field allows for an
+	// ARN with no accountID, with or without wildcard characters if the PrincipalType
+	// is an IAM_PATTERN.
+	//
+	// For more information, review associate-principal-with-portfolio (https://docs.aws.amazon.com/cli/latest/reference/servicecatalog/associate-principal-with-portfolio.html#options)
+	// in the Amazon Web Services CLI Command Reference.
 	PrincipalARN *string `min:"1" type:"string"`
 
-	// The principal type. The supported value is IAM.
+	// The principal type. The supported value is IAM if you use a fully defined
+	// ARN, or IAM_PATTERN if you use an ARN with no accountID, with or without
+	// wildcard characters.
 	PrincipalType *string `type:"string" enum:"PrincipalType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Principal) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Principal) GoString() string {
 	return s.String()
 }
@@ -15484,12 +19503,20 @@ type ProductViewAggregationValue struct {
 	Value *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProductViewAggregationValue) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProductViewAggregationValue) GoString() string {
 	return s.String()
 }
@@ -15519,6 +19546,13 @@ type ProductViewDetail struct {
 	// Summary information about the product view.
 	ProductViewSummary *ProductViewSummary `type:"structure"`
 
+	// A top level ProductViewDetail response containing details about the product’s
+	// connection. Service Catalog returns this field for the CreateProduct, UpdateProduct,
+	// DescribeProductAsAdmin, and SearchProductAsAdmin APIs. This response contains
+	// the same fields as the ConnectionParameters request, with the addition of
+	// the LastSync response.
+	SourceConnection *SourceConnectionDetail `type:"structure"`
+
 	// The status of the product.
 	//
 	//    * AVAILABLE - The product is ready for use.
@@ -15530,12 +19564,20 @@ type ProductViewDetail struct {
 	Status *string `type:"string" enum:"Status"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProductViewDetail) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProductViewDetail) GoString() string {
 	return s.String()
 }
@@ -15558,6 +19600,12 @@ func (s *ProductViewDetail) SetProductViewSummary(v *ProductViewSummary) *Produc
 	return s
 }
 
+// SetSourceConnection sets the SourceConnection field's value.
+func (s *ProductViewDetail) SetSourceConnection(v *SourceConnectionDetail) *ProductViewDetail {
+	s.SourceConnection = v
+	return s
+}
+
 // SetStatus sets the Status field's value.
 func (s *ProductViewDetail) SetStatus(v string) *ProductViewDetail {
 	s.Status = &v
@@ -15604,17 +19652,25 @@ type ProductViewSummary struct {
 	SupportUrl *string `type:"string"`
 
 	// The product type. Contact the product administrator for the significance
-	// of this value. If this value is MARKETPLACE, the product was created by AWS
-	// Marketplace.
+	// of this value. If this value is MARKETPLACE, the product was created by Amazon
+	// Web Services Marketplace.
 	Type *string `type:"string" enum:"ProductType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProductViewSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProductViewSummary) GoString() string {
 	return s.String()
 }
@@ -15690,8 +19746,6 @@ type ProvisionProductInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -15703,27 +19757,36 @@ type ProvisionProductInput struct {
 
 	// The path identifier of the product. This value is optional if the product
 	// has a default path, and required if the product has more than one path. To
-	// list the paths for a product, use ListLaunchPaths.
+	// list the paths for a product, use ListLaunchPaths. You must provide the name
+	// or ID, but not both.
 	PathId *string `min:"1" type:"string"`
 
-	// The product identifier.
-	//
-	// ProductId is a required field
-	ProductId *string `min:"1" type:"string" required:"true"`
+	// The name of the path. You must provide the name or ID, but not both.
+	PathName *string `min:"1" type:"string"`
+
+	// The product identifier. You must provide the name or ID, but not both.
+	ProductId *string `min:"1" type:"string"`
+
+	// The name of the product. You must provide the name or ID, but not both.
+	ProductName *string `type:"string"`
 
 	// An idempotency token that uniquely identifies the provisioning request.
 	ProvisionToken *string `min:"1" type:"string" idempotencyToken:"true"`
 
 	// A user-friendly name for the provisioned product. This value must be unique
-	// for the AWS account and cannot be updated after the product is provisioned.
+	// for the Amazon Web Services account and cannot be updated after the product
+	// is provisioned.
 	//
 	// ProvisionedProductName is a required field
 	ProvisionedProductName *string `min:"1" type:"string" required:"true"`
 
-	// The identifier of the provisioning artifact.
-	//
-	// ProvisioningArtifactId is a required field
-	ProvisioningArtifactId *string `min:"1" type:"string" required:"true"`
+	// The identifier of the provisioning artifact. You must provide the name or
+	// ID, but not both.
+	ProvisioningArtifactId *string `min:"1" type:"string"`
+
+	// The name of the provisioning artifact. You must provide the name or ID, but
+	// not both.
+	ProvisioningArtifactName *string `type:"string"`
 
 	// Parameters specified by the administrator that are required for provisioning
 	// the product.
@@ -15737,12 +19800,20 @@ type ProvisionProductInput struct {
 	Tags []*Tag `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisionProductInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisionProductInput) GoString() string {
 	return s.String()
 }
@@ -15753,8 +19824,8 @@ func (s *ProvisionProductInput) Validate() error {
 	if s.PathId != nil && len(*s.PathId) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("PathId", 1))
 	}
-	if s.ProductId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ProductId"))
+	if s.PathName != nil && len(*s.PathName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("PathName", 1))
 	}
 	if s.ProductId != nil && len(*s.ProductId) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("ProductId", 1))
@@ -15768,9 +19839,6 @@ func (s *ProvisionProductInput) Validate() error {
 	if s.ProvisionedProductName != nil && len(*s.ProvisionedProductName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("ProvisionedProductName", 1))
 	}
-	if s.ProvisioningArtifactId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ProvisioningArtifactId"))
-	}
 	if s.ProvisioningArtifactId != nil && len(*s.ProvisioningArtifactId) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("ProvisioningArtifactId", 1))
 	}
@@ -15824,12 +19892,24 @@ func (s *ProvisionProductInput) SetPathId(v string) *ProvisionProductInput {
 	return s
 }
 
+// SetPathName sets the PathName field's value.
+func (s *ProvisionProductInput) SetPathName(v string) *ProvisionProductInput {
+	s.PathName = &v
+	return s
+}
+
 // SetProductId sets the ProductId field's value.
 func (s *ProvisionProductInput) SetProductId(v string) *ProvisionProductInput {
 	s.ProductId = &v
 	return s
 }
 
+// SetProductName sets the ProductName field's value.
+func (s *ProvisionProductInput) SetProductName(v string) *ProvisionProductInput {
+	s.ProductName = &v
+	return s
+}
+
 // SetProvisionToken sets the ProvisionToken field's value.
 func (s *ProvisionProductInput) SetProvisionToken(v string) *ProvisionProductInput {
 	s.ProvisionToken = &v
@@ -15848,6 +19928,12 @@ func (s *ProvisionProductInput) SetProvisioningArtifactId(v string) *ProvisionPr
 	return s
 }
 
+// SetProvisioningArtifactName sets the ProvisioningArtifactName field's value.
+func (s *ProvisionProductInput) SetProvisioningArtifactName(v string) *ProvisionProductInput {
+	s.ProvisioningArtifactName = &v
+	return s
+}
+
 // SetProvisioningParameters sets the ProvisioningParameters field's value.
 func (s *ProvisionProductInput) SetProvisioningParameters(v []*ProvisioningParameter) *ProvisionProductInput {
 	s.ProvisioningParameters = v
@@ -15873,12 +19959,20 @@ type ProvisionProductOutput struct {
 	RecordDetail *RecordDetail `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisionProductOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisionProductOutput) GoString() string {
 	return s.String()
 }
@@ -15907,9 +20001,33 @@ type ProvisionedProductAttribute struct {
 	// repeated request.
 	IdempotencyToken *string `min:"1" type:"string"`
 
+	// The record identifier of the last request performed on this provisioned product
+	// of the following types:
+	//
+	//    * ProvisionProduct
+	//
+	//    * UpdateProvisionedProduct
+	//
+	//    * ExecuteProvisionedProductPlan
+	//
+	//    * TerminateProvisionedProduct
+	LastProvisioningRecordId *string `min:"1" type:"string"`
+
 	// The record identifier of the last request performed on this provisioned product.
 	LastRecordId *string `min:"1" type:"string"`
 
+	// The record identifier of the last successful request performed on this provisioned
+	// product of the following types:
+	//
+	//    * ProvisionProduct
+	//
+	//    * UpdateProvisionedProduct
+	//
+	//    * ExecuteProvisionedProductPlan
+	//
+	//    * TerminateProvisionedProduct
+	LastSuccessfulProvisioningRecordId *string `min:"1" type:"string"`
+
 	// The user-friendly name of the provisioned product.
 	Name *string `min:"1" type:"string"`
 
@@ -15920,9 +20038,15 @@ type ProvisionedProductAttribute struct {
 	// The product identifier.
 	ProductId *string `min:"1" type:"string"`
 
+	// The name of the product.
+	ProductName *string `type:"string"`
+
 	// The identifier of the provisioning artifact.
 	ProvisioningArtifactId *string `min:"1" type:"string"`
 
+	// The name of the provisioning artifact.
+	ProvisioningArtifactName *string `type:"string"`
+
 	// The current status of the provisioned product.
 	//
 	//    * AVAILABLE - Stable state, ready to perform any operation. The most recent
@@ -15952,23 +20076,31 @@ type ProvisionedProductAttribute struct {
 	// One or more tags.
 	Tags []*Tag `type:"list"`
 
-	// The type of provisioned product. The supported values are CFN_STACK and CFN_STACKSET.
+	// The type of provisioned product. The supported values are CFN_STACK, CFN_STACKSET,
+	// TERRAFORM_OPEN_SOURCE, TERRAFORM_CLOUD, and EXTERNAL.
 	Type *string `type:"string"`
 
-	// The Amazon Resource Name (ARN) of the IAM user.
+	// The Amazon Resource Name (ARN) of the user.
 	UserArn *string `type:"string"`
 
-	// The ARN of the IAM user in the session. This ARN might contain a session
-	// ID.
+	// The ARN of the user in the session. This ARN might contain a session ID.
 	UserArnSession *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisionedProductAttribute) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisionedProductAttribute) GoString() string {
 	return s.String()
 }
@@ -15997,12 +20129,24 @@ func (s *ProvisionedProductAttribute) SetIdempotencyToken(v string) *Provisioned
 	return s
 }
 
+// SetLastProvisioningRecordId sets the LastProvisioningRecordId field's value.
+func (s *ProvisionedProductAttribute) SetLastProvisioningRecordId(v string) *ProvisionedProductAttribute {
+	s.LastProvisioningRecordId = &v
+	return s
+}
+
 // SetLastRecordId sets the LastRecordId field's value.
 func (s *ProvisionedProductAttribute) SetLastRecordId(v string) *ProvisionedProductAttribute {
 	s.LastRecordId = &v
 	return s
 }
 
+// SetLastSuccessfulProvisioningRecordId sets the LastSuccessfulProvisioningRecordId field's value.
+func (s *ProvisionedProductAttribute) SetLastSuccessfulProvisioningRecordId(v string) *ProvisionedProductAttribute {
+	s.LastSuccessfulProvisioningRecordId = &v
+	return s
+}
+
 // SetName sets the Name field's value.
 func (s *ProvisionedProductAttribute) SetName(v string) *ProvisionedProductAttribute {
 	s.Name = &v
@@ -16021,12 +20165,24 @@ func (s *ProvisionedProductAttribute) SetProductId(v string) *ProvisionedProduct
 	return s
 }
 
+// SetProductName sets the ProductName field's value.
+func (s *ProvisionedProductAttribute) SetProductName(v string) *ProvisionedProductAttribute {
+	s.ProductName = &v
+	return s
+}
+
 // SetProvisioningArtifactId sets the ProvisioningArtifactId field's value.
 func (s *ProvisionedProductAttribute) SetProvisioningArtifactId(v string) *ProvisionedProductAttribute {
 	s.ProvisioningArtifactId = &v
 	return s
 }
 
+// SetProvisioningArtifactName sets the ProvisioningArtifactName field's value.
+func (s *ProvisionedProductAttribute) SetProvisioningArtifactName(v string) *ProvisionedProductAttribute {
+	s.ProvisioningArtifactName = &v
+	return s
+}
+
 // SetStatus sets the Status field's value.
 func (s *ProvisionedProductAttribute) SetStatus(v string) *ProvisionedProductAttribute {
 	s.Status = &v
@@ -16081,9 +20237,36 @@ type ProvisionedProductDetail struct {
 	// repeated request.
 	IdempotencyToken *string `min:"1" type:"string"`
 
+	// The record identifier of the last request performed on this provisioned product
+	// of the following types:
+	//
+	//    * ProvisionProduct
+	//
+	//    * UpdateProvisionedProduct
+	//
+	//    * ExecuteProvisionedProductPlan
+	//
+	//    * TerminateProvisionedProduct
+	LastProvisioningRecordId *string `min:"1" type:"string"`
+
 	// The record identifier of the last request performed on this provisioned product.
 	LastRecordId *string `type:"string"`
 
+	// The record identifier of the last successful request performed on this provisioned
+	// product of the following types:
+	//
+	//    * ProvisionProduct
+	//
+	//    * UpdateProvisionedProduct
+	//
+	//    * ExecuteProvisionedProductPlan
+	//
+	//    * TerminateProvisionedProduct
+	LastSuccessfulProvisioningRecordId *string `min:"1" type:"string"`
+
+	// The ARN of the launch role associated with the provisioned product.
+	LaunchRoleArn *string `min:"1" type:"string"`
+
 	// The user-friendly name of the provisioned product.
 	Name *string `min:"1" type:"string"`
 
@@ -16119,16 +20302,25 @@ type ProvisionedProductDetail struct {
 	// The current status message of the provisioned product.
 	StatusMessage *string `type:"string"`
 
-	// The type of provisioned product. The supported values are CFN_STACK and CFN_STACKSET.
+	// The type of provisioned product. The supported values are CFN_STACK, CFN_STACKSET,
+	// TERRAFORM_OPEN_SOURCE, TERRAFORM_CLOUD, and EXTERNAL.
 	Type *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisionedProductDetail) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisionedProductDetail) GoString() string {
 	return s.String()
 }
@@ -16157,12 +20349,30 @@ func (s *ProvisionedProductDetail) SetIdempotencyToken(v string) *ProvisionedPro
 	return s
 }
 
+// SetLastProvisioningRecordId sets the LastProvisioningRecordId field's value.
+func (s *ProvisionedProductDetail) SetLastProvisioningRecordId(v string) *ProvisionedProductDetail {
+	s.LastProvisioningRecordId = &v
+	return s
+}
+
 // SetLastRecordId sets the LastRecordId field's value.
 func (s *ProvisionedProductDetail) SetLastRecordId(v string) *ProvisionedProductDetail {
 	s.LastRecordId = &v
 	return s
 }
 
+// SetLastSuccessfulProvisioningRecordId sets the LastSuccessfulProvisioningRecordId field's value.
+func (s *ProvisionedProductDetail) SetLastSuccessfulProvisioningRecordId(v string) *ProvisionedProductDetail {
+	s.LastSuccessfulProvisioningRecordId = &v
+	return s
+}
+
+// SetLaunchRoleArn sets the LaunchRoleArn field's value.
+func (s *ProvisionedProductDetail) SetLaunchRoleArn(v string) *ProvisionedProductDetail {
+	s.LaunchRoleArn = &v
+	return s
+}
+
 // SetName sets the Name field's value.
 func (s *ProvisionedProductDetail) SetName(v string) *ProvisionedProductDetail {
 	s.Name = &v
@@ -16249,16 +20459,24 @@ type ProvisionedProductPlanDetails struct {
 	// One or more tags.
 	Tags []*Tag `type:"list"`
 
-	// The time when the plan was last updated.
+	// The UTC time stamp when the plan was last updated.
 	UpdatedTime *time.Time `type:"timestamp"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisionedProductPlanDetails) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisionedProductPlanDetails) GoString() string {
 	return s.String()
 }
@@ -16376,12 +20594,20 @@ type ProvisionedProductPlanSummary struct {
 	ProvisioningArtifactId *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisionedProductPlanSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisionedProductPlanSummary) GoString() string {
 	return s.String()
 }
@@ -16444,12 +20670,20 @@ type ProvisioningArtifact struct {
 	Name *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisioningArtifact) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisioningArtifact) GoString() string {
 	return s.String()
 }
@@ -16508,22 +20742,42 @@ type ProvisioningArtifactDetail struct {
 	// The name of the provisioning artifact.
 	Name *string `type:"string"`
 
+	// Specifies the revision of the external artifact that was used to automatically
+	// sync the Service Catalog product and create the provisioning artifact. Service
+	// Catalog includes this response parameter as a high level field to the existing
+	// ProvisioningArtifactDetail type, which is returned as part of the response
+	// for CreateProduct, UpdateProduct, DescribeProductAsAdmin, DescribeProvisioningArtifact,
+	// ListProvisioningArtifact, and UpdateProvisioningArticat APIs.
+	//
+	// This field only exists for Repo-Synced products.
+	SourceRevision *string `min:"1" type:"string"`
+
 	// The type of provisioning artifact.
 	//
-	//    * CLOUD_FORMATION_TEMPLATE - AWS CloudFormation template
+	//    * CLOUD_FORMATION_TEMPLATE - CloudFormation template
 	//
-	//    * MARKETPLACE_AMI - AWS Marketplace AMI
+	//    * TERRAFORM_OPEN_SOURCE - Terraform Open Source configuration file
 	//
-	//    * MARKETPLACE_CAR - AWS Marketplace Clusters and AWS Resources
+	//    * TERRAFORM_CLOUD - Terraform Cloud configuration file
+	//
+	//    * EXTERNAL - External configuration file
 	Type *string `type:"string" enum:"ProvisioningArtifactType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisioningArtifactDetail) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisioningArtifactDetail) GoString() string {
 	return s.String()
 }
@@ -16558,15 +20812,62 @@ func (s *ProvisioningArtifactDetail) SetId(v string) *ProvisioningArtifactDetail
 	return s
 }
 
-// SetName sets the Name field's value.
-func (s *ProvisioningArtifactDetail) SetName(v string) *ProvisioningArtifactDetail {
-	s.Name = &v
+// SetName sets the Name field's value.
+func (s *ProvisioningArtifactDetail) SetName(v string) *ProvisioningArtifactDetail {
+	s.Name = &v
+	return s
+}
+
+// SetSourceRevision sets the SourceRevision field's value.
+func (s *ProvisioningArtifactDetail) SetSourceRevision(v string) *ProvisioningArtifactDetail {
+	s.SourceRevision = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *ProvisioningArtifactDetail) SetType(v string) *ProvisioningArtifactDetail {
+	s.Type = &v
+	return s
+}
+
+// Provisioning artifact output.
+type ProvisioningArtifactOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Description of the provisioning artifact output key.
+	Description *string `type:"string"`
+
+	// The provisioning artifact output key.
+	Key *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ProvisioningArtifactOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ProvisioningArtifactOutput) GoString() string {
+	return s.String()
+}
+
+// SetDescription sets the Description field's value.
+func (s *ProvisioningArtifactOutput) SetDescription(v string) *ProvisioningArtifactOutput {
+	s.Description = &v
 	return s
 }
 
-// SetType sets the Type field's value.
-func (s *ProvisioningArtifactDetail) SetType(v string) *ProvisioningArtifactDetail {
-	s.Type = &v
+// SetKey sets the Key field's value.
+func (s *ProvisioningArtifactOutput) SetKey(v string) *ProvisioningArtifactOutput {
+	s.Key = &v
 	return s
 }
 
@@ -16595,12 +20896,20 @@ type ProvisioningArtifactParameter struct {
 	ParameterType *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisioningArtifactParameter) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisioningArtifactParameter) GoString() string {
 	return s.String()
 }
@@ -16646,31 +20955,39 @@ func (s *ProvisioningArtifactParameter) SetParameterType(v string) *Provisioning
 //
 // For more information on maximum concurrent accounts and failure tolerance,
 // see Stack set operation options (https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/stacksets-concepts.html#stackset-ops-options)
-// in the AWS CloudFormation User Guide.
+// in the CloudFormation User Guide.
 type ProvisioningArtifactPreferences struct {
 	_ struct{} `type:"structure"`
 
-	// One or more AWS accounts where stack instances are deployed from the stack
-	// set. These accounts can be scoped in ProvisioningPreferences$StackSetAccounts
+	// One or more Amazon Web Services accounts where stack instances are deployed
+	// from the stack set. These accounts can be scoped in ProvisioningPreferences$StackSetAccounts
 	// and UpdateProvisioningPreferences$StackSetAccounts.
 	//
 	// Applicable only to a CFN_STACKSET provisioned product type.
 	StackSetAccounts []*string `type:"list"`
 
-	// One or more AWS Regions where stack instances are deployed from the stack
-	// set. These regions can be scoped in ProvisioningPreferences$StackSetRegions
+	// One or more Amazon Web Services Regions where stack instances are deployed
+	// from the stack set. These Regions can be scoped in ProvisioningPreferences$StackSetRegions
 	// and UpdateProvisioningPreferences$StackSetRegions.
 	//
 	// Applicable only to a CFN_STACKSET provisioned product type.
 	StackSetRegions []*string `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisioningArtifactPreferences) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisioningArtifactPreferences) GoString() string {
 	return s.String()
 }
@@ -16696,17 +21013,25 @@ type ProvisioningArtifactProperties struct {
 	// the previous provisioning artifact.
 	Description *string `type:"string"`
 
-	// If set to true, AWS Service Catalog stops validating the specified provisioning
+	// If set to true, Service Catalog stops validating the specified provisioning
 	// artifact even if it is invalid.
+	//
+	// Service Catalog does not support template validation for the TERRAFORM_OS
+	// product type.
 	DisableTemplateValidation *bool `type:"boolean"`
 
-	// The URL of the CloudFormation template in Amazon S3. Specify the URL in JSON
-	// format as follows:
+	// Specify the template source with one of the following options, but not both.
+	// Keys accepted: [ LoadTemplateFromURL, ImportFromPhysicalId ]
+	//
+	// The URL of the CloudFormation template in Amazon S3 or GitHub in JSON format.
+	// Specify the URL in JSON format as follows:
 	//
 	// "LoadTemplateFromURL": "https://s3.amazonaws.com/cf-templates-ozkq9d3hgiq2-us-east-1/..."
 	//
-	// Info is a required field
-	Info map[string]*string `min:"1" type:"map" required:"true"`
+	// ImportFromPhysicalId: The physical id of the resource that contains the template.
+	// Currently only supports CloudFormation stack arn. Specify the physical id
+	// in JSON format as follows: ImportFromPhysicalId: “arn:aws:cloudformation:[us-east-1]:[accountId]:stack/[StackName]/[resourceId]
+	Info map[string]*string `min:"1" type:"map"`
 
 	// The name of the provisioning artifact (for example, v1 v2beta). No spaces
 	// are allowed.
@@ -16714,20 +21039,30 @@ type ProvisioningArtifactProperties struct {
 
 	// The type of provisioning artifact.
 	//
-	//    * CLOUD_FORMATION_TEMPLATE - AWS CloudFormation template
+	//    * CLOUD_FORMATION_TEMPLATE - CloudFormation template
 	//
-	//    * MARKETPLACE_AMI - AWS Marketplace AMI
+	//    * TERRAFORM_OPEN_SOURCE - Terraform Open Source configuration file
 	//
-	//    * MARKETPLACE_CAR - AWS Marketplace Clusters and AWS Resources
+	//    * TERRAFORM_CLOUD - Terraform Cloud configuration file
+	//
+	//    * EXTERNAL - External configuration file
 	Type *string `type:"string" enum:"ProvisioningArtifactType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisioningArtifactProperties) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisioningArtifactProperties) GoString() string {
 	return s.String()
 }
@@ -16735,9 +21070,6 @@ func (s ProvisioningArtifactProperties) GoString() string {
 // Validate inspects the fields of the type to determine if they are valid.
 func (s *ProvisioningArtifactProperties) Validate() error {
 	invalidParams := request.ErrInvalidParams{Context: "ProvisioningArtifactProperties"}
-	if s.Info == nil {
-		invalidParams.Add(request.NewErrParamRequired("Info"))
-	}
 	if s.Info != nil && len(s.Info) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("Info", 1))
 	}
@@ -16795,17 +21127,25 @@ type ProvisioningArtifactSummary struct {
 	// The name of the provisioning artifact.
 	Name *string `type:"string"`
 
-	// The metadata for the provisioning artifact. This is used with AWS Marketplace
-	// products.
+	// The metadata for the provisioning artifact. This is used with Amazon Web
+	// Services Marketplace products.
 	ProvisioningArtifactMetadata map[string]*string `min:"1" type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisioningArtifactSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisioningArtifactSummary) GoString() string {
 	return s.String()
 }
@@ -16853,12 +21193,20 @@ type ProvisioningArtifactView struct {
 	ProvisioningArtifact *ProvisioningArtifact `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisioningArtifactView) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisioningArtifactView) GoString() string {
 	return s.String()
 }
@@ -16886,12 +21234,20 @@ type ProvisioningParameter struct {
 	Value *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisioningParameter) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisioningParameter) GoString() string {
 	return s.String()
 }
@@ -16922,26 +21278,39 @@ func (s *ProvisioningParameter) SetValue(v string) *ProvisioningParameter {
 }
 
 // The user-defined preferences that will be applied when updating a provisioned
-// product. Not all preferences are applicable to all provisioned product types.
+// product. Not all preferences are applicable to all provisioned product type
+//
+// One or more Amazon Web Services accounts that will have access to the provisioned
+// product.
+//
+// Applicable only to a CFN_STACKSET provisioned product type.
+//
+// The Amazon Web Services accounts specified should be within the list of accounts
+// in the STACKSET constraint. To get the list of accounts in the STACKSET constraint,
+// use the DescribeProvisioningParameters operation.
+//
+// If no values are specified, the default value is all accounts from the STACKSET
+// constraint.
 type ProvisioningPreferences struct {
 	_ struct{} `type:"structure"`
 
-	// One or more AWS accounts that will have access to the provisioned product.
+	// One or more Amazon Web Services accounts where the provisioned product will
+	// be available.
 	//
 	// Applicable only to a CFN_STACKSET provisioned product type.
 	//
-	// The AWS accounts specified should be within the list of accounts in the STACKSET
+	// The specified accounts should be within the list of accounts from the STACKSET
 	// constraint. To get the list of accounts in the STACKSET constraint, use the
 	// DescribeProvisioningParameters operation.
 	//
-	// If no values are specified, the default value is all accounts from the STACKSET
+	// If no values are specified, the default value is all acounts from the STACKSET
 	// constraint.
 	StackSetAccounts []*string `type:"list"`
 
-	// The number of accounts, per region, for which this operation can fail before
-	// AWS Service Catalog stops the operation in that region. If the operation
-	// is stopped in a region, AWS Service Catalog doesn't attempt the operation
-	// in any subsequent regions.
+	// The number of accounts, per Region, for which this operation can fail before
+	// Service Catalog stops the operation in that Region. If the operation is stopped
+	// in a Region, Service Catalog doesn't attempt the operation in any subsequent
+	// Regions.
 	//
 	// Applicable only to a CFN_STACKSET provisioned product type.
 	//
@@ -16951,13 +21320,13 @@ type ProvisioningPreferences struct {
 	// The default value is 0 if no value is specified.
 	StackSetFailureToleranceCount *int64 `type:"integer"`
 
-	// The percentage of accounts, per region, for which this stack operation can
-	// fail before AWS Service Catalog stops the operation in that region. If the
-	// operation is stopped in a region, AWS Service Catalog doesn't attempt the
-	// operation in any subsequent regions.
+	// The percentage of accounts, per Region, for which this stack operation can
+	// fail before Service Catalog stops the operation in that Region. If the operation
+	// is stopped in a Region, Service Catalog doesn't attempt the operation in
+	// any subsequent Regions.
 	//
 	// When calculating the number of accounts based on the specified percentage,
-	// AWS Service Catalog rounds down to the next whole number.
+	// Service Catalog rounds down to the next whole number.
 	//
 	// Applicable only to a CFN_STACKSET provisioned product type.
 	//
@@ -16983,8 +21352,8 @@ type ProvisioningPreferences struct {
 	// one time.
 	//
 	// When calculating the number of accounts based on the specified percentage,
-	// AWS Service Catalog rounds down to the next whole number. This is true except
-	// in cases where rounding down would result is zero. In this case, AWS Service
+	// Service Catalog rounds down to the next whole number. This is true except
+	// in cases where rounding down would result is zero. In this case, Service
 	// Catalog sets the number as 1 instead.
 	//
 	// Note that this setting lets you specify the maximum for operations. For large
@@ -16997,25 +21366,34 @@ type ProvisioningPreferences struct {
 	// but not both.
 	StackSetMaxConcurrencyPercentage *int64 `min:"1" type:"integer"`
 
-	// One or more AWS Regions where the provisioned product will be available.
+	// One or more Amazon Web Services Regions where the provisioned product will
+	// be available.
 	//
 	// Applicable only to a CFN_STACKSET provisioned product type.
 	//
-	// The specified regions should be within the list of regions from the STACKSET
-	// constraint. To get the list of regions in the STACKSET constraint, use the
+	// The specified Regions should be within the list of Regions from the STACKSET
+	// constraint. To get the list of Regions in the STACKSET constraint, use the
 	// DescribeProvisioningParameters operation.
 	//
-	// If no values are specified, the default value is all regions from the STACKSET
+	// If no values are specified, the default value is all Regions from the STACKSET
 	// constraint.
 	StackSetRegions []*string `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisioningPreferences) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ProvisioningPreferences) GoString() string {
 	return s.String()
 }
@@ -17079,6 +21457,9 @@ type RecordDetail struct {
 	// The UTC time stamp of the creation time.
 	CreatedTime *time.Time `type:"timestamp"`
 
+	// The ARN of the launch role associated with the provisioned product.
+	LaunchRoleArn *string `min:"1" type:"string"`
+
 	// The path identifier.
 	PathId *string `min:"1" type:"string"`
 
@@ -17091,7 +21472,8 @@ type RecordDetail struct {
 	// The user-friendly name of the provisioned product.
 	ProvisionedProductName *string `min:"1" type:"string"`
 
-	// The type of provisioned product. The supported values are CFN_STACK and CFN_STACKSET.
+	// The type of provisioned product. The supported values are CFN_STACK, CFN_STACKSET,
+	// TERRAFORM_OPEN_SOURCE, TERRAFORM_CLOUD, and EXTERNAL.
 	ProvisionedProductType *string `type:"string"`
 
 	// The identifier of the provisioning artifact.
@@ -17135,12 +21517,20 @@ type RecordDetail struct {
 	UpdatedTime *time.Time `type:"timestamp"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RecordDetail) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RecordDetail) GoString() string {
 	return s.String()
 }
@@ -17151,6 +21541,12 @@ func (s *RecordDetail) SetCreatedTime(v time.Time) *RecordDetail {
 	return s
 }
 
+// SetLaunchRoleArn sets the LaunchRoleArn field's value.
+func (s *RecordDetail) SetLaunchRoleArn(v string) *RecordDetail {
+	s.LaunchRoleArn = &v
+	return s
+}
+
 // SetPathId sets the PathId field's value.
 func (s *RecordDetail) SetPathId(v string) *RecordDetail {
 	s.PathId = &v
@@ -17234,12 +21630,20 @@ type RecordError struct {
 	Description *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RecordError) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RecordError) GoString() string {
 	return s.String()
 }
@@ -17272,12 +21676,20 @@ type RecordOutput struct {
 	OutputValue *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RecordOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RecordOutput) GoString() string {
 	return s.String()
 }
@@ -17311,12 +21723,20 @@ type RecordTag struct {
 	Value *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RecordTag) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RecordTag) GoString() string {
 	return s.String()
 }
@@ -17338,8 +21758,6 @@ type RejectPortfolioShareInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -17353,8 +21771,8 @@ type RejectPortfolioShareInput struct {
 	// The type of shared portfolios to reject. The default is to reject imported
 	// portfolios.
 	//
-	//    * AWS_ORGANIZATIONS - Reject portfolios shared by the master account of
-	//    your organization.
+	//    * AWS_ORGANIZATIONS - Reject portfolios shared by the management account
+	//    of your organization.
 	//
 	//    * IMPORTED - Reject imported portfolios.
 	//
@@ -17365,12 +21783,20 @@ type RejectPortfolioShareInput struct {
 	PortfolioShareType *string `type:"string" enum:"PortfolioShareType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RejectPortfolioShareInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RejectPortfolioShareInput) GoString() string {
 	return s.String()
 }
@@ -17413,12 +21839,20 @@ type RejectPortfolioShareOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RejectPortfolioShareOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RejectPortfolioShareOutput) GoString() string {
 	return s.String()
 }
@@ -17447,15 +21881,23 @@ type ResourceChange struct {
 	ResourceType *string `min:"1" type:"string"`
 
 	// The change scope.
-	Scope []*string `type:"list"`
+	Scope []*string `type:"list" enum:"ResourceAttribute"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResourceChange) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResourceChange) GoString() string {
 	return s.String()
 }
@@ -17518,12 +21960,20 @@ type ResourceChangeDetail struct {
 	Target *ResourceTargetDefinition `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResourceChangeDetail) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResourceChangeDetail) GoString() string {
 	return s.String()
 }
@@ -17566,12 +22016,20 @@ type ResourceDetail struct {
 	Name *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResourceDetail) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResourceDetail) GoString() string {
 	return s.String()
 }
@@ -17606,6 +22064,135 @@ func (s *ResourceDetail) SetName(v string) *ResourceDetail {
 	return s
 }
 
+// A resource that is currently in use. Ensure that the resource is not in use
+// and retry the operation.
+type ResourceInUseException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceInUseException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceInUseException) GoString() string {
+	return s.String()
+}
+
+func newErrorResourceInUseException(v protocol.ResponseMetadata) error {
+	return &ResourceInUseException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ResourceInUseException) Code() string {
+	return "ResourceInUseException"
+}
+
+// Message returns the exception's message.
+func (s *ResourceInUseException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ResourceInUseException) OrigErr() error {
+	return nil
+}
+
+func (s *ResourceInUseException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ResourceInUseException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ResourceInUseException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The specified resource was not found.
+type ResourceNotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceNotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceNotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorResourceNotFoundException(v protocol.ResponseMetadata) error {
+	return &ResourceNotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ResourceNotFoundException) Code() string {
+	return "ResourceNotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *ResourceNotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ResourceNotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *ResourceNotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ResourceNotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ResourceNotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Information about a change to a resource attribute.
 type ResourceTargetDefinition struct {
 	_ struct{} `type:"structure"`
@@ -17622,12 +22209,20 @@ type ResourceTargetDefinition struct {
 	RequiresRecreation *string `type:"string" enum:"RequiresRecreation"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResourceTargetDefinition) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResourceTargetDefinition) GoString() string {
 	return s.String()
 }
@@ -17655,8 +22250,6 @@ type ScanProvisionedProductsInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -17673,12 +22266,20 @@ type ScanProvisionedProductsInput struct {
 	PageToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ScanProvisionedProductsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ScanProvisionedProductsInput) GoString() string {
 	return s.String()
 }
@@ -17718,12 +22319,20 @@ type ScanProvisionedProductsOutput struct {
 	ProvisionedProducts []*ProvisionedProductDetail `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ScanProvisionedProductsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ScanProvisionedProductsOutput) GoString() string {
 	return s.String()
 }
@@ -17745,8 +22354,6 @@ type SearchProductsAsAdminInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -17776,12 +22383,20 @@ type SearchProductsAsAdminInput struct {
 	SortOrder *string `type:"string" enum:"SortOrder"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SearchProductsAsAdminInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SearchProductsAsAdminInput) GoString() string {
 	return s.String()
 }
@@ -17858,12 +22473,20 @@ type SearchProductsAsAdminOutput struct {
 	ProductViewDetails []*ProductViewDetail `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SearchProductsAsAdminOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SearchProductsAsAdminOutput) GoString() string {
 	return s.String()
 }
@@ -17885,8 +22508,6 @@ type SearchProductsInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -17910,12 +22531,20 @@ type SearchProductsInput struct {
 	SortOrder *string `type:"string" enum:"SortOrder"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SearchProductsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SearchProductsInput) GoString() string {
 	return s.String()
 }
@@ -17970,12 +22599,20 @@ type SearchProductsOutput struct {
 	ProductViewSummaries []*ProductViewSummary `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SearchProductsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SearchProductsOutput) GoString() string {
 	return s.String()
 }
@@ -18003,8 +22640,6 @@ type SearchProvisionedProductsInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -18016,8 +22651,9 @@ type SearchProvisionedProductsInput struct {
 	// The search filters.
 	//
 	// When the key is SearchQuery, the searchable fields are arn, createdTime,
-	// id, lastRecordId, idempotencyToken, name, physicalId, productId, provisioningArtifact,
-	// type, status, tags, userArn, and userArnSession.
+	// id, lastRecordId, idempotencyToken, name, physicalId, productId, provisioningArtifactId,
+	// type, status, tags, userArn, userArnSession, lastProvisioningRecordId, lastSuccessfulProvisioningRecordId,
+	// productName, and provisioningArtifactName.
 	//
 	// Example: "SearchQuery":["status:AVAILABLE"]
 	Filters map[string][]*string `type:"map"`
@@ -18037,12 +22673,20 @@ type SearchProvisionedProductsInput struct {
 	SortOrder *string `type:"string" enum:"SortOrder"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SearchProvisionedProductsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SearchProvisionedProductsInput) GoString() string {
 	return s.String()
 }
@@ -18103,12 +22747,20 @@ type SearchProvisionedProductsOutput struct {
 	TotalResultsCount *int64 `type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SearchProvisionedProductsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SearchProvisionedProductsOutput) GoString() string {
 	return s.String()
 }
@@ -18152,12 +22804,20 @@ type ServiceActionAssociation struct {
 	ServiceActionId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ServiceActionAssociation) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ServiceActionAssociation) GoString() string {
 	return s.String()
 }
@@ -18219,12 +22879,20 @@ type ServiceActionDetail struct {
 	ServiceActionSummary *ServiceActionSummary `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ServiceActionDetail) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ServiceActionDetail) GoString() string {
 	return s.String()
 }
@@ -18258,12 +22926,20 @@ type ServiceActionSummary struct {
 	Name *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ServiceActionSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ServiceActionSummary) GoString() string {
 	return s.String()
 }
@@ -18303,84 +22979,275 @@ type ShareDetails struct {
 	SuccessfulShares []*string `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ShareDetails) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ShareDetails) GoString() string {
 	return s.String()
 }
 
-// SetShareErrors sets the ShareErrors field's value.
-func (s *ShareDetails) SetShareErrors(v []*ShareError) *ShareDetails {
-	s.ShareErrors = v
+// SetShareErrors sets the ShareErrors field's value.
+func (s *ShareDetails) SetShareErrors(v []*ShareError) *ShareDetails {
+	s.ShareErrors = v
+	return s
+}
+
+// SetSuccessfulShares sets the SuccessfulShares field's value.
+func (s *ShareDetails) SetSuccessfulShares(v []*string) *ShareDetails {
+	s.SuccessfulShares = v
+	return s
+}
+
+// Errors that occurred during the portfolio share operation.
+type ShareError struct {
+	_ struct{} `type:"structure"`
+
+	// List of accounts impacted by the error.
+	Accounts []*string `type:"list"`
+
+	// Error type that happened when processing the operation.
+	Error *string `type:"string"`
+
+	// Information about the error.
+	Message *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ShareError) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ShareError) GoString() string {
+	return s.String()
+}
+
+// SetAccounts sets the Accounts field's value.
+func (s *ShareError) SetAccounts(v []*string) *ShareError {
+	s.Accounts = v
+	return s
+}
+
+// SetError sets the Error field's value.
+func (s *ShareError) SetError(v string) *ShareError {
+	s.Error = &v
+	return s
+}
+
+// SetMessage sets the Message field's value.
+func (s *ShareError) SetMessage(v string) *ShareError {
+	s.Message = &v
+	return s
+}
+
+// A top level ProductViewDetail response containing details about the product’s
+// connection. Service Catalog returns this field for the CreateProduct, UpdateProduct,
+// DescribeProductAsAdmin, and SearchProductAsAdmin APIs. This response contains
+// the same fields as the ConnectionParameters request, with the addition of
+// the LastSync response.
+type SourceConnection struct {
+	_ struct{} `type:"structure"`
+
+	// The connection details based on the connection Type.
+	//
+	// ConnectionParameters is a required field
+	ConnectionParameters *SourceConnectionParameters `type:"structure" required:"true"`
+
+	// The only supported SourceConnection type is Codestar.
+	Type *string `type:"string" enum:"SourceType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SourceConnection) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SourceConnection) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *SourceConnection) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "SourceConnection"}
+	if s.ConnectionParameters == nil {
+		invalidParams.Add(request.NewErrParamRequired("ConnectionParameters"))
+	}
+	if s.ConnectionParameters != nil {
+		if err := s.ConnectionParameters.Validate(); err != nil {
+			invalidParams.AddNested("ConnectionParameters", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetConnectionParameters sets the ConnectionParameters field's value.
+func (s *SourceConnection) SetConnectionParameters(v *SourceConnectionParameters) *SourceConnection {
+	s.ConnectionParameters = v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *SourceConnection) SetType(v string) *SourceConnection {
+	s.Type = &v
+	return s
+}
+
+// Provides details about the configured SourceConnection.
+type SourceConnectionDetail struct {
+	_ struct{} `type:"structure"`
+
+	// The connection details based on the connection Type.
+	ConnectionParameters *SourceConnectionParameters `type:"structure"`
+
+	// Provides details about the product's connection sync and contains the following
+	// sub-fields.
+	//
+	//    * LastSyncTime
+	//
+	//    * LastSyncStatus
+	//
+	//    * LastSyncStatusMessage
+	//
+	//    * LastSuccessfulSyncTime
+	//
+	//    * LastSuccessfulSyncProvisioningArtifactID
+	LastSync *LastSync `type:"structure"`
+
+	// The only supported SourceConnection type is Codestar.
+	Type *string `type:"string" enum:"SourceType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SourceConnectionDetail) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SourceConnectionDetail) GoString() string {
+	return s.String()
+}
+
+// SetConnectionParameters sets the ConnectionParameters field's value.
+func (s *SourceConnectionDetail) SetConnectionParameters(v *SourceConnectionParameters) *SourceConnectionDetail {
+	s.ConnectionParameters = v
+	return s
+}
+
+// SetLastSync sets the LastSync field's value.
+func (s *SourceConnectionDetail) SetLastSync(v *LastSync) *SourceConnectionDetail {
+	s.LastSync = v
 	return s
 }
 
-// SetSuccessfulShares sets the SuccessfulShares field's value.
-func (s *ShareDetails) SetSuccessfulShares(v []*string) *ShareDetails {
-	s.SuccessfulShares = v
+// SetType sets the Type field's value.
+func (s *SourceConnectionDetail) SetType(v string) *SourceConnectionDetail {
+	s.Type = &v
 	return s
 }
 
-// Errors that occurred during the portfolio share operation.
-type ShareError struct {
+// Provides connection details.
+type SourceConnectionParameters struct {
 	_ struct{} `type:"structure"`
 
-	// List of accounts impacted by the error.
-	Accounts []*string `type:"list"`
-
-	// Error type that happened when processing the operation.
-	Error *string `type:"string"`
-
-	// Information about the error.
-	Message *string `type:"string"`
+	// Provides ConnectionType details.
+	CodeStar *CodeStarParameters `type:"structure"`
 }
 
-// String returns the string representation
-func (s ShareError) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SourceConnectionParameters) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ShareError) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SourceConnectionParameters) GoString() string {
 	return s.String()
 }
 
-// SetAccounts sets the Accounts field's value.
-func (s *ShareError) SetAccounts(v []*string) *ShareError {
-	s.Accounts = v
-	return s
-}
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *SourceConnectionParameters) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "SourceConnectionParameters"}
+	if s.CodeStar != nil {
+		if err := s.CodeStar.Validate(); err != nil {
+			invalidParams.AddNested("CodeStar", err.(request.ErrInvalidParams))
+		}
+	}
 
-// SetError sets the Error field's value.
-func (s *ShareError) SetError(v string) *ShareError {
-	s.Error = &v
-	return s
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetMessage sets the Message field's value.
-func (s *ShareError) SetMessage(v string) *ShareError {
-	s.Message = &v
+// SetCodeStar sets the CodeStar field's value.
+func (s *SourceConnectionParameters) SetCodeStar(v *CodeStarParameters) *SourceConnectionParameters {
+	s.CodeStar = v
 	return s
 }
 
-// An AWS CloudFormation stack, in a specific account and region, that's part
-// of a stack set operation. A stack instance is a reference to an attempted
-// or actual stack in a given account within a given region. A stack instance
-// can exist without a stack—for example, if the stack couldn't be created
-// for some reason. A stack instance is associated with only one stack set.
-// Each stack instance contains the ID of its associated stack set, as well
-// as the ID of the actual stack and the stack status.
+// An CloudFormation stack, in a specific account and Region, that's part of
+// a stack set operation. A stack instance is a reference to an attempted or
+// actual stack in a given account within a given Region. A stack instance can
+// exist without a stack—for example, if the stack couldn't be created for
+// some reason. A stack instance is associated with only one stack set. Each
+// stack instance contains the ID of its associated stack set, as well as the
+// ID of the actual stack and the stack status.
 type StackInstance struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the AWS account that the stack instance is associated with.
+	// The name of the Amazon Web Services account that the stack instance is associated
+	// with.
 	Account *string `type:"string"`
 
-	// The name of the AWS region that the stack instance is associated with.
+	// The name of the Amazon Web Services Region that the stack instance is associated
+	// with.
 	Region *string `type:"string"`
 
 	// The status of the stack instance, in terms of its synchronization with its
@@ -18401,12 +23268,20 @@ type StackInstance struct {
 	StackInstanceStatus *string `type:"string" enum:"StackInstanceStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StackInstance) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StackInstance) GoString() string {
 	return s.String()
 }
@@ -18445,12 +23320,20 @@ type Tag struct {
 	Value *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) GoString() string {
 	return s.String()
 }
@@ -18502,16 +23385,28 @@ type TagOptionDetail struct {
 	// The TagOption key.
 	Key *string `min:"1" type:"string"`
 
+	// The Amazon Web Services account Id of the owner account that created the
+	// TagOption.
+	Owner *string `type:"string"`
+
 	// The TagOption value.
 	Value *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagOptionDetail) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagOptionDetail) GoString() string {
 	return s.String()
 }
@@ -18534,12 +23429,84 @@ func (s *TagOptionDetail) SetKey(v string) *TagOptionDetail {
 	return s
 }
 
+// SetOwner sets the Owner field's value.
+func (s *TagOptionDetail) SetOwner(v string) *TagOptionDetail {
+	s.Owner = &v
+	return s
+}
+
 // SetValue sets the Value field's value.
 func (s *TagOptionDetail) SetValue(v string) *TagOptionDetail {
 	s.Value = &v
 	return s
 }
 
+// An operation requiring TagOptions failed because the TagOptions migration
+// process has not been performed for this account. Use the Amazon Web Services
+// Management Console to perform the migration process before retrying the operation.
+type TagOptionNotMigratedException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagOptionNotMigratedException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagOptionNotMigratedException) GoString() string {
+	return s.String()
+}
+
+func newErrorTagOptionNotMigratedException(v protocol.ResponseMetadata) error {
+	return &TagOptionNotMigratedException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *TagOptionNotMigratedException) Code() string {
+	return "TagOptionNotMigratedException"
+}
+
+// Message returns the exception's message.
+func (s *TagOptionNotMigratedException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *TagOptionNotMigratedException) OrigErr() error {
+	return nil
+}
+
+func (s *TagOptionNotMigratedException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *TagOptionNotMigratedException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *TagOptionNotMigratedException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // Summary information about a TagOption.
 type TagOptionSummary struct {
 	_ struct{} `type:"structure"`
@@ -18551,12 +23518,20 @@ type TagOptionSummary struct {
 	Values []*string `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagOptionSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagOptionSummary) GoString() string {
 	return s.String()
 }
@@ -18578,14 +23553,12 @@ type TerminateProvisionedProductInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
 	AcceptLanguage *string `type:"string"`
 
-	// If set to true, AWS Service Catalog stops managing the specified provisioned
+	// If set to true, Service Catalog stops managing the specified provisioned
 	// product even if it cannot delete the underlying resources.
 	IgnoreErrors *bool `type:"boolean"`
 
@@ -18597,6 +23570,12 @@ type TerminateProvisionedProductInput struct {
 	// and ProvisionedProductId.
 	ProvisionedProductName *string `min:"1" type:"string"`
 
+	// When this boolean parameter is set to true, the TerminateProvisionedProduct
+	// API deletes the Service Catalog provisioned product. However, it does not
+	// remove the CloudFormation stack, stack set, or the underlying resources of
+	// the deleted provisioned product. The default value is false.
+	RetainPhysicalResources *bool `type:"boolean"`
+
 	// An idempotency token that uniquely identifies the termination request. This
 	// token is only valid during the termination process. After the provisioned
 	// product is terminated, subsequent requests to terminate the same provisioned
@@ -18604,12 +23583,20 @@ type TerminateProvisionedProductInput struct {
 	TerminateToken *string `min:"1" type:"string" idempotencyToken:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TerminateProvisionedProductInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TerminateProvisionedProductInput) GoString() string {
 	return s.String()
 }
@@ -18657,6 +23644,12 @@ func (s *TerminateProvisionedProductInput) SetProvisionedProductName(v string) *
 	return s
 }
 
+// SetRetainPhysicalResources sets the RetainPhysicalResources field's value.
+func (s *TerminateProvisionedProductInput) SetRetainPhysicalResources(v bool) *TerminateProvisionedProductInput {
+	s.RetainPhysicalResources = &v
+	return s
+}
+
 // SetTerminateToken sets the TerminateToken field's value.
 func (s *TerminateProvisionedProductInput) SetTerminateToken(v string) *TerminateProvisionedProductInput {
 	s.TerminateToken = &v
@@ -18670,12 +23663,20 @@ type TerminateProvisionedProductOutput struct {
 	RecordDetail *RecordDetail `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TerminateProvisionedProductOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TerminateProvisionedProductOutput) GoString() string {
 	return s.String()
 }
@@ -18686,13 +23687,68 @@ func (s *TerminateProvisionedProductOutput) SetRecordDetail(v *RecordDetail) *Te
 	return s
 }
 
+// The unique key-value pair for a tag that identifies provisioned product resources.
+type UniqueTagResourceIdentifier struct {
+	_ struct{} `type:"structure"`
+
+	// A unique key that's attached to a resource.
+	Key *string `min:"1" type:"string"`
+
+	// A unique value that's attached to a resource.
+	Value *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UniqueTagResourceIdentifier) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UniqueTagResourceIdentifier) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *UniqueTagResourceIdentifier) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UniqueTagResourceIdentifier"}
+	if s.Key != nil && len(*s.Key) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Key", 1))
+	}
+	if s.Value != nil && len(*s.Value) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Value", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetKey sets the Key field's value.
+func (s *UniqueTagResourceIdentifier) SetKey(v string) *UniqueTagResourceIdentifier {
+	s.Key = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *UniqueTagResourceIdentifier) SetValue(v string) *UniqueTagResourceIdentifier {
+	s.Value = &v
+	return s
+}
+
 type UpdateConstraintInput struct {
 	_ struct{} `type:"structure"`
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -18711,10 +23767,25 @@ type UpdateConstraintInput struct {
 	//
 	// LAUNCH
 	//
+	// You are required to specify either the RoleArn or the LocalRoleName but can't
+	// use both.
+	//
 	// Specify the RoleArn property as follows:
 	//
 	// {"RoleArn" : "arn:aws:iam::123456789012:role/LaunchRole"}
 	//
+	// Specify the LocalRoleName property as follows:
+	//
+	// {"LocalRoleName": "SCBasicLaunchRole"}
+	//
+	// If you specify the LocalRoleName property, when an account uses the launch
+	// constraint, the IAM role with that name in the account will be used. This
+	// allows launch-role constraints to be account-agnostic so the administrator
+	// can create fewer resources per shared account.
+	//
+	// The given role name must exist in the account used to create the launch constraint
+	// and the account of the user who launches a product with this launch constraint.
+	//
 	// You cannot have both a LAUNCH and a STACKSET constraint.
 	//
 	// You also cannot have more than one LAUNCH constraint on a product and portfolio.
@@ -18745,8 +23816,7 @@ type UpdateConstraintInput struct {
 	//
 	// You also cannot have more than one STACKSET constraint on a product and portfolio.
 	//
-	// Products with a STACKSET constraint will launch an AWS CloudFormation stack
-	// set.
+	// Products with a STACKSET constraint will launch an CloudFormation stack set.
 	//
 	// TEMPLATE
 	//
@@ -18755,12 +23825,20 @@ type UpdateConstraintInput struct {
 	Parameters *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConstraintInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConstraintInput) GoString() string {
 	return s.String()
 }
@@ -18818,12 +23896,20 @@ type UpdateConstraintOutput struct {
 	Status *string `type:"string" enum:"Status"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConstraintOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConstraintOutput) GoString() string {
 	return s.String()
 }
@@ -18851,8 +23937,6 @@ type UpdatePortfolioInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -18879,12 +23963,20 @@ type UpdatePortfolioInput struct {
 	RemoveTags []*string `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdatePortfolioInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdatePortfolioInput) GoString() string {
 	return s.String()
 }
@@ -18922,76 +24014,229 @@ func (s *UpdatePortfolioInput) Validate() error {
 }
 
 // SetAcceptLanguage sets the AcceptLanguage field's value.
-func (s *UpdatePortfolioInput) SetAcceptLanguage(v string) *UpdatePortfolioInput {
+func (s *UpdatePortfolioInput) SetAcceptLanguage(v string) *UpdatePortfolioInput {
+	s.AcceptLanguage = &v
+	return s
+}
+
+// SetAddTags sets the AddTags field's value.
+func (s *UpdatePortfolioInput) SetAddTags(v []*Tag) *UpdatePortfolioInput {
+	s.AddTags = v
+	return s
+}
+
+// SetDescription sets the Description field's value.
+func (s *UpdatePortfolioInput) SetDescription(v string) *UpdatePortfolioInput {
+	s.Description = &v
+	return s
+}
+
+// SetDisplayName sets the DisplayName field's value.
+func (s *UpdatePortfolioInput) SetDisplayName(v string) *UpdatePortfolioInput {
+	s.DisplayName = &v
+	return s
+}
+
+// SetId sets the Id field's value.
+func (s *UpdatePortfolioInput) SetId(v string) *UpdatePortfolioInput {
+	s.Id = &v
+	return s
+}
+
+// SetProviderName sets the ProviderName field's value.
+func (s *UpdatePortfolioInput) SetProviderName(v string) *UpdatePortfolioInput {
+	s.ProviderName = &v
+	return s
+}
+
+// SetRemoveTags sets the RemoveTags field's value.
+func (s *UpdatePortfolioInput) SetRemoveTags(v []*string) *UpdatePortfolioInput {
+	s.RemoveTags = v
+	return s
+}
+
+type UpdatePortfolioOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Information about the portfolio.
+	PortfolioDetail *PortfolioDetail `type:"structure"`
+
+	// Information about the tags associated with the portfolio.
+	Tags []*Tag `type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdatePortfolioOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdatePortfolioOutput) GoString() string {
+	return s.String()
+}
+
+// SetPortfolioDetail sets the PortfolioDetail field's value.
+func (s *UpdatePortfolioOutput) SetPortfolioDetail(v *PortfolioDetail) *UpdatePortfolioOutput {
+	s.PortfolioDetail = v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *UpdatePortfolioOutput) SetTags(v []*Tag) *UpdatePortfolioOutput {
+	s.Tags = v
+	return s
+}
+
+type UpdatePortfolioShareInput struct {
+	_ struct{} `type:"structure"`
+
+	// The language code.
+	//
+	//    * jp - Japanese
+	//
+	//    * zh - Chinese
+	AcceptLanguage *string `type:"string"`
+
+	// The Amazon Web Services account Id of the recipient account. This field is
+	// required when updating an external account to account type share.
+	AccountId *string `type:"string"`
+
+	// Information about the organization node.
+	OrganizationNode *OrganizationNode `type:"structure"`
+
+	// The unique identifier of the portfolio for which the share will be updated.
+	//
+	// PortfolioId is a required field
+	PortfolioId *string `min:"1" type:"string" required:"true"`
+
+	// A flag to enables or disables Principals sharing in the portfolio. If this
+	// field is not provided, the current state of the Principals sharing on the
+	// portfolio share will not be modified.
+	SharePrincipals *bool `type:"boolean"`
+
+	// Enables or disables TagOptions sharing for the portfolio share. If this field
+	// is not provided, the current state of TagOptions sharing on the portfolio
+	// share will not be modified.
+	ShareTagOptions *bool `type:"boolean"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdatePortfolioShareInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdatePortfolioShareInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *UpdatePortfolioShareInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdatePortfolioShareInput"}
+	if s.PortfolioId == nil {
+		invalidParams.Add(request.NewErrParamRequired("PortfolioId"))
+	}
+	if s.PortfolioId != nil && len(*s.PortfolioId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("PortfolioId", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAcceptLanguage sets the AcceptLanguage field's value.
+func (s *UpdatePortfolioShareInput) SetAcceptLanguage(v string) *UpdatePortfolioShareInput {
 	s.AcceptLanguage = &v
 	return s
 }
 
-// SetAddTags sets the AddTags field's value.
-func (s *UpdatePortfolioInput) SetAddTags(v []*Tag) *UpdatePortfolioInput {
-	s.AddTags = v
-	return s
-}
-
-// SetDescription sets the Description field's value.
-func (s *UpdatePortfolioInput) SetDescription(v string) *UpdatePortfolioInput {
-	s.Description = &v
+// SetAccountId sets the AccountId field's value.
+func (s *UpdatePortfolioShareInput) SetAccountId(v string) *UpdatePortfolioShareInput {
+	s.AccountId = &v
 	return s
 }
 
-// SetDisplayName sets the DisplayName field's value.
-func (s *UpdatePortfolioInput) SetDisplayName(v string) *UpdatePortfolioInput {
-	s.DisplayName = &v
+// SetOrganizationNode sets the OrganizationNode field's value.
+func (s *UpdatePortfolioShareInput) SetOrganizationNode(v *OrganizationNode) *UpdatePortfolioShareInput {
+	s.OrganizationNode = v
 	return s
 }
 
-// SetId sets the Id field's value.
-func (s *UpdatePortfolioInput) SetId(v string) *UpdatePortfolioInput {
-	s.Id = &v
+// SetPortfolioId sets the PortfolioId field's value.
+func (s *UpdatePortfolioShareInput) SetPortfolioId(v string) *UpdatePortfolioShareInput {
+	s.PortfolioId = &v
 	return s
 }
 
-// SetProviderName sets the ProviderName field's value.
-func (s *UpdatePortfolioInput) SetProviderName(v string) *UpdatePortfolioInput {
-	s.ProviderName = &v
+// SetSharePrincipals sets the SharePrincipals field's value.
+func (s *UpdatePortfolioShareInput) SetSharePrincipals(v bool) *UpdatePortfolioShareInput {
+	s.SharePrincipals = &v
 	return s
 }
 
-// SetRemoveTags sets the RemoveTags field's value.
-func (s *UpdatePortfolioInput) SetRemoveTags(v []*string) *UpdatePortfolioInput {
-	s.RemoveTags = v
+// SetShareTagOptions sets the ShareTagOptions field's value.
+func (s *UpdatePortfolioShareInput) SetShareTagOptions(v bool) *UpdatePortfolioShareInput {
+	s.ShareTagOptions = &v
 	return s
 }
 
-type UpdatePortfolioOutput struct {
+type UpdatePortfolioShareOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Information about the portfolio.
-	PortfolioDetail *PortfolioDetail `type:"structure"`
+	// The token that tracks the status of the UpdatePortfolioShare operation for
+	// external account to account or organizational type sharing.
+	PortfolioShareToken *string `min:"1" type:"string"`
 
-	// Information about the tags associated with the portfolio.
-	Tags []*Tag `type:"list"`
+	// The status of UpdatePortfolioShare operation. You can also obtain the operation
+	// status using DescribePortfolioShareStatus API.
+	Status *string `type:"string" enum:"ShareStatus"`
 }
 
-// String returns the string representation
-func (s UpdatePortfolioOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdatePortfolioShareOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s UpdatePortfolioOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UpdatePortfolioShareOutput) GoString() string {
 	return s.String()
 }
 
-// SetPortfolioDetail sets the PortfolioDetail field's value.
-func (s *UpdatePortfolioOutput) SetPortfolioDetail(v *PortfolioDetail) *UpdatePortfolioOutput {
-	s.PortfolioDetail = v
+// SetPortfolioShareToken sets the PortfolioShareToken field's value.
+func (s *UpdatePortfolioShareOutput) SetPortfolioShareToken(v string) *UpdatePortfolioShareOutput {
+	s.PortfolioShareToken = &v
 	return s
 }
 
-// SetTags sets the Tags field's value.
-func (s *UpdatePortfolioOutput) SetTags(v []*Tag) *UpdatePortfolioOutput {
-	s.Tags = v
+// SetStatus sets the Status field's value.
+func (s *UpdatePortfolioShareOutput) SetStatus(v string) *UpdatePortfolioShareOutput {
+	s.Status = &v
 	return s
 }
 
@@ -19000,8 +24245,6 @@ type UpdateProductInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -19030,6 +24273,16 @@ type UpdateProductInput struct {
 	// The tags to remove from the product.
 	RemoveTags []*string `type:"list"`
 
+	// Specifies connection details for the updated product and syncs the product
+	// to the connection source artifact. This automatically manages the product's
+	// artifacts based on changes to the source. The SourceConnection parameter
+	// consists of the following sub-fields.
+	//
+	//    * Type
+	//
+	//    * ConnectionParamters
+	SourceConnection *SourceConnection `type:"structure"`
+
 	// The updated support description for the product.
 	SupportDescription *string `type:"string"`
 
@@ -19040,12 +24293,20 @@ type UpdateProductInput struct {
 	SupportUrl *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProductInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProductInput) GoString() string {
 	return s.String()
 }
@@ -19069,6 +24330,11 @@ func (s *UpdateProductInput) Validate() error {
 			}
 		}
 	}
+	if s.SourceConnection != nil {
+		if err := s.SourceConnection.Validate(); err != nil {
+			invalidParams.AddNested("SourceConnection", err.(request.ErrInvalidParams))
+		}
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -19124,6 +24390,12 @@ func (s *UpdateProductInput) SetRemoveTags(v []*string) *UpdateProductInput {
 	return s
 }
 
+// SetSourceConnection sets the SourceConnection field's value.
+func (s *UpdateProductInput) SetSourceConnection(v *SourceConnection) *UpdateProductInput {
+	s.SourceConnection = v
+	return s
+}
+
 // SetSupportDescription sets the SupportDescription field's value.
 func (s *UpdateProductInput) SetSupportDescription(v string) *UpdateProductInput {
 	s.SupportDescription = &v
@@ -19152,12 +24424,20 @@ type UpdateProductOutput struct {
 	Tags []*Tag `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProductOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProductOutput) GoString() string {
 	return s.String()
 }
@@ -19179,22 +24459,27 @@ type UpdateProvisionedProductInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
 	AcceptLanguage *string `type:"string"`
 
-	// The new path identifier. This value is optional if the product has a default
-	// path, and required if the product has more than one path.
+	// The path identifier. This value is optional if the product has a default
+	// path, and required if the product has more than one path. You must provide
+	// the name or ID, but not both.
 	PathId *string `min:"1" type:"string"`
 
-	// The identifier of the product.
+	// The name of the path. You must provide the name or ID, but not both.
+	PathName *string `min:"1" type:"string"`
+
+	// The identifier of the product. You must provide the name or ID, but not both.
 	ProductId *string `min:"1" type:"string"`
 
-	// The identifier of the provisioned product. You cannot specify both ProvisionedProductName
-	// and ProvisionedProductId.
+	// The name of the product. You must provide the name or ID, but not both.
+	ProductName *string `type:"string"`
+
+	// The identifier of the provisioned product. You must provide the name or ID,
+	// but not both.
 	ProvisionedProductId *string `min:"1" type:"string"`
 
 	// The name of the provisioned product. You cannot specify both ProvisionedProductName
@@ -19204,6 +24489,10 @@ type UpdateProvisionedProductInput struct {
 	// The identifier of the provisioning artifact.
 	ProvisioningArtifactId *string `min:"1" type:"string"`
 
+	// The name of the provisioning artifact. You must provide the name or ID, but
+	// not both.
+	ProvisioningArtifactName *string `type:"string"`
+
 	// The new parameters.
 	ProvisioningParameters []*UpdateProvisioningParameter `type:"list"`
 
@@ -19219,12 +24508,20 @@ type UpdateProvisionedProductInput struct {
 	UpdateToken *string `min:"1" type:"string" idempotencyToken:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProvisionedProductInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProvisionedProductInput) GoString() string {
 	return s.String()
 }
@@ -19235,6 +24532,9 @@ func (s *UpdateProvisionedProductInput) Validate() error {
 	if s.PathId != nil && len(*s.PathId) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("PathId", 1))
 	}
+	if s.PathName != nil && len(*s.PathName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("PathName", 1))
+	}
 	if s.ProductId != nil && len(*s.ProductId) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("ProductId", 1))
 	}
@@ -19294,12 +24594,24 @@ func (s *UpdateProvisionedProductInput) SetPathId(v string) *UpdateProvisionedPr
 	return s
 }
 
+// SetPathName sets the PathName field's value.
+func (s *UpdateProvisionedProductInput) SetPathName(v string) *UpdateProvisionedProductInput {
+	s.PathName = &v
+	return s
+}
+
 // SetProductId sets the ProductId field's value.
 func (s *UpdateProvisionedProductInput) SetProductId(v string) *UpdateProvisionedProductInput {
 	s.ProductId = &v
 	return s
 }
 
+// SetProductName sets the ProductName field's value.
+func (s *UpdateProvisionedProductInput) SetProductName(v string) *UpdateProvisionedProductInput {
+	s.ProductName = &v
+	return s
+}
+
 // SetProvisionedProductId sets the ProvisionedProductId field's value.
 func (s *UpdateProvisionedProductInput) SetProvisionedProductId(v string) *UpdateProvisionedProductInput {
 	s.ProvisionedProductId = &v
@@ -19318,6 +24630,12 @@ func (s *UpdateProvisionedProductInput) SetProvisioningArtifactId(v string) *Upd
 	return s
 }
 
+// SetProvisioningArtifactName sets the ProvisioningArtifactName field's value.
+func (s *UpdateProvisionedProductInput) SetProvisioningArtifactName(v string) *UpdateProvisionedProductInput {
+	s.ProvisioningArtifactName = &v
+	return s
+}
+
 // SetProvisioningParameters sets the ProvisioningParameters field's value.
 func (s *UpdateProvisionedProductInput) SetProvisioningParameters(v []*UpdateProvisioningParameter) *UpdateProvisionedProductInput {
 	s.ProvisioningParameters = v
@@ -19349,12 +24667,20 @@ type UpdateProvisionedProductOutput struct {
 	RecordDetail *RecordDetail `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProvisionedProductOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProvisionedProductOutput) GoString() string {
 	return s.String()
 }
@@ -19370,8 +24696,6 @@ type UpdateProvisionedProductPropertiesInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -19388,12 +24712,19 @@ type UpdateProvisionedProductPropertiesInput struct {
 
 	// A map that contains the provisioned product properties to be updated.
 	//
-	// The OWNER key only accepts user ARNs. The owner is the user that is allowed
-	// to see, update, terminate, and execute service actions in the provisioned
-	// product.
+	// The LAUNCH_ROLE key accepts role ARNs. This key allows an administrator to
+	// call UpdateProvisionedProductProperties to update the launch role that is
+	// associated with a provisioned product. This role is used when an end user
+	// calls a provisioning operation such as UpdateProvisionedProduct, TerminateProvisionedProduct,
+	// or ExecuteProvisionedProductServiceAction. Only a role ARN is valid. A user
+	// ARN is invalid.
+	//
+	// The OWNER key accepts user ARNs, IAM role ARNs, and STS assumed-role ARNs.
+	// The owner is the user that has permission to see, update, terminate, and
+	// execute service actions in the provisioned product.
 	//
 	// The administrator can change the owner of a provisioned product to another
-	// IAM user within the same account. Both end user owners and administrators
+	// IAM or STS entity within the same account. Both end user owners and administrators
 	// can see ownership history of the provisioned product using the ListRecordHistory
 	// API. The new owner can describe all past records for the provisioned product
 	// using the DescribeRecord API. The previous owner can no longer use DescribeRecord,
@@ -19410,12 +24741,20 @@ type UpdateProvisionedProductPropertiesInput struct {
 	ProvisionedProductProperties map[string]*string `min:"1" type:"map" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProvisionedProductPropertiesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProvisionedProductPropertiesInput) GoString() string {
 	return s.String()
 }
@@ -19485,12 +24824,20 @@ type UpdateProvisionedProductPropertiesOutput struct {
 	Status *string `type:"string" enum:"RecordStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProvisionedProductPropertiesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProvisionedProductPropertiesOutput) GoString() string {
 	return s.String()
 }
@@ -19524,14 +24871,15 @@ type UpdateProvisioningArtifactInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
 	AcceptLanguage *string `type:"string"`
 
 	// Indicates whether the product version is active.
+	//
+	// Inactive provisioning artifacts are invisible to end users. End users cannot
+	// launch or update a provisioned product from an inactive provisioning artifact.
 	Active *bool `type:"boolean"`
 
 	// The updated description of the provisioning artifact.
@@ -19562,12 +24910,20 @@ type UpdateProvisioningArtifactInput struct {
 	ProvisioningArtifactId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProvisioningArtifactInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProvisioningArtifactInput) GoString() string {
 	return s.String()
 }
@@ -19639,7 +24995,7 @@ func (s *UpdateProvisioningArtifactInput) SetProvisioningArtifactId(v string) *U
 type UpdateProvisioningArtifactOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The URL of the CloudFormation template in Amazon S3.
+	// The URL of the CloudFormation template in Amazon S3 or GitHub in JSON format.
 	Info map[string]*string `min:"1" type:"map"`
 
 	// Information about the provisioning artifact.
@@ -19649,12 +25005,20 @@ type UpdateProvisioningArtifactOutput struct {
 	Status *string `type:"string" enum:"Status"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProvisioningArtifactOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProvisioningArtifactOutput) GoString() string {
 	return s.String()
 }
@@ -19691,12 +25055,20 @@ type UpdateProvisioningParameter struct {
 	Value *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProvisioningParameter) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProvisioningParameter) GoString() string {
 	return s.String()
 }
@@ -19737,22 +25109,23 @@ func (s *UpdateProvisioningParameter) SetValue(v string) *UpdateProvisioningPara
 type UpdateProvisioningPreferences struct {
 	_ struct{} `type:"structure"`
 
-	// One or more AWS accounts that will have access to the provisioned product.
+	// One or more Amazon Web Services accounts that will have access to the provisioned
+	// product.
 	//
 	// Applicable only to a CFN_STACKSET provisioned product type.
 	//
-	// The AWS accounts specified should be within the list of accounts in the STACKSET
-	// constraint. To get the list of accounts in the STACKSET constraint, use the
-	// DescribeProvisioningParameters operation.
+	// The Amazon Web Services accounts specified should be within the list of accounts
+	// in the STACKSET constraint. To get the list of accounts in the STACKSET constraint,
+	// use the DescribeProvisioningParameters operation.
 	//
 	// If no values are specified, the default value is all accounts from the STACKSET
 	// constraint.
 	StackSetAccounts []*string `type:"list"`
 
-	// The number of accounts, per region, for which this operation can fail before
-	// AWS Service Catalog stops the operation in that region. If the operation
-	// is stopped in a region, AWS Service Catalog doesn't attempt the operation
-	// in any subsequent regions.
+	// The number of accounts, per Region, for which this operation can fail before
+	// Service Catalog stops the operation in that Region. If the operation is stopped
+	// in a Region, Service Catalog doesn't attempt the operation in any subsequent
+	// Regions.
 	//
 	// Applicable only to a CFN_STACKSET provisioned product type.
 	//
@@ -19762,13 +25135,13 @@ type UpdateProvisioningPreferences struct {
 	// The default value is 0 if no value is specified.
 	StackSetFailureToleranceCount *int64 `type:"integer"`
 
-	// The percentage of accounts, per region, for which this stack operation can
-	// fail before AWS Service Catalog stops the operation in that region. If the
-	// operation is stopped in a region, AWS Service Catalog doesn't attempt the
-	// operation in any subsequent regions.
+	// The percentage of accounts, per Region, for which this stack operation can
+	// fail before Service Catalog stops the operation in that Region. If the operation
+	// is stopped in a Region, Service Catalog doesn't attempt the operation in
+	// any subsequent Regions.
 	//
 	// When calculating the number of accounts based on the specified percentage,
-	// AWS Service Catalog rounds down to the next whole number.
+	// Service Catalog rounds down to the next whole number.
 	//
 	// Applicable only to a CFN_STACKSET provisioned product type.
 	//
@@ -19794,8 +25167,8 @@ type UpdateProvisioningPreferences struct {
 	// one time.
 	//
 	// When calculating the number of accounts based on the specified percentage,
-	// AWS Service Catalog rounds down to the next whole number. This is true except
-	// in cases where rounding down would result is zero. In this case, AWS Service
+	// Service Catalog rounds down to the next whole number. This is true except
+	// in cases where rounding down would result is zero. In this case, Service
 	// Catalog sets the number as 1 instead.
 	//
 	// Note that this setting lets you specify the maximum for operations. For large
@@ -19808,7 +25181,7 @@ type UpdateProvisioningPreferences struct {
 	// but not both.
 	StackSetMaxConcurrencyPercentage *int64 `min:"1" type:"integer"`
 
-	// Determines what action AWS Service Catalog performs to a stack set or a stack
+	// Determines what action Service Catalog performs to a stack set or a stack
 	// instance represented by the provisioned product. The default value is UPDATE
 	// if nothing is specified.
 	//
@@ -19818,7 +25191,7 @@ type UpdateProvisioningPreferences struct {
 	//
 	// Creates a new stack instance in the stack set represented by the provisioned
 	// product. In this case, only new stack instances are created based on accounts
-	// and regions; if new ProductId or ProvisioningArtifactID are passed, they
+	// and Regions; if new ProductId or ProvisioningArtifactID are passed, they
 	// will be ignored.
 	//
 	// UPDATE
@@ -19832,25 +25205,34 @@ type UpdateProvisioningPreferences struct {
 	// product.
 	StackSetOperationType *string `type:"string" enum:"StackSetOperationType"`
 
-	// One or more AWS Regions where the provisioned product will be available.
+	// One or more Amazon Web Services Regions where the provisioned product will
+	// be available.
 	//
 	// Applicable only to a CFN_STACKSET provisioned product type.
 	//
-	// The specified regions should be within the list of regions from the STACKSET
-	// constraint. To get the list of regions in the STACKSET constraint, use the
+	// The specified Regions should be within the list of Regions from the STACKSET
+	// constraint. To get the list of Regions in the STACKSET constraint, use the
 	// DescribeProvisioningParameters operation.
 	//
-	// If no values are specified, the default value is all regions from the STACKSET
+	// If no values are specified, the default value is all Regions from the STACKSET
 	// constraint.
 	StackSetRegions []*string `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProvisioningPreferences) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateProvisioningPreferences) GoString() string {
 	return s.String()
 }
@@ -19918,8 +25300,6 @@ type UpdateServiceActionInput struct {
 
 	// The language code.
 	//
-	//    * en - English (default)
-	//
 	//    * jp - Japanese
 	//
 	//    * zh - Chinese
@@ -19940,12 +25320,20 @@ type UpdateServiceActionInput struct {
 	Name *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateServiceActionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateServiceActionInput) GoString() string {
 	return s.String()
 }
@@ -20009,12 +25397,20 @@ type UpdateServiceActionOutput struct {
 	ServiceActionDetail *ServiceActionDetail `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateServiceActionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateServiceActionOutput) GoString() string {
 	return s.String()
 }
@@ -20040,12 +25436,20 @@ type UpdateTagOptionInput struct {
 	Value *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateTagOptionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateTagOptionInput) GoString() string {
 	return s.String()
 }
@@ -20094,12 +25498,20 @@ type UpdateTagOptionOutput struct {
 	TagOptionDetail *TagOptionDetail `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateTagOptionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateTagOptionOutput) GoString() string {
 	return s.String()
 }
@@ -20121,12 +25533,20 @@ type UsageInstruction struct {
 	Value *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UsageInstruction) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UsageInstruction) GoString() string {
 	return s.String()
 }
@@ -20154,6 +25574,15 @@ const (
 	AccessLevelFilterKeyUser = "User"
 )
 
+// AccessLevelFilterKey_Values returns all elements of the AccessLevelFilterKey enum
+func AccessLevelFilterKey_Values() []string {
+	return []string{
+		AccessLevelFilterKeyAccount,
+		AccessLevelFilterKeyRole,
+		AccessLevelFilterKeyUser,
+	}
+}
+
 const (
 	// AccessStatusEnabled is a AccessStatus enum value
 	AccessStatusEnabled = "ENABLED"
@@ -20165,6 +25594,15 @@ const (
 	AccessStatusDisabled = "DISABLED"
 )
 
+// AccessStatus_Values returns all elements of the AccessStatus enum
+func AccessStatus_Values() []string {
+	return []string{
+		AccessStatusEnabled,
+		AccessStatusUnderChange,
+		AccessStatusDisabled,
+	}
+}
+
 const (
 	// ChangeActionAdd is a ChangeAction enum value
 	ChangeActionAdd = "ADD"
@@ -20176,11 +25614,27 @@ const (
 	ChangeActionRemove = "REMOVE"
 )
 
+// ChangeAction_Values returns all elements of the ChangeAction enum
+func ChangeAction_Values() []string {
+	return []string{
+		ChangeActionAdd,
+		ChangeActionModify,
+		ChangeActionRemove,
+	}
+}
+
 const (
 	// CopyOptionCopyTags is a CopyOption enum value
 	CopyOptionCopyTags = "CopyTags"
 )
 
+// CopyOption_Values returns all elements of the CopyOption enum
+func CopyOption_Values() []string {
+	return []string{
+		CopyOptionCopyTags,
+	}
+}
+
 const (
 	// CopyProductStatusSucceeded is a CopyProductStatus enum value
 	CopyProductStatusSucceeded = "SUCCEEDED"
@@ -20192,6 +25646,55 @@ const (
 	CopyProductStatusFailed = "FAILED"
 )
 
+// CopyProductStatus_Values returns all elements of the CopyProductStatus enum
+func CopyProductStatus_Values() []string {
+	return []string{
+		CopyProductStatusSucceeded,
+		CopyProductStatusInProgress,
+		CopyProductStatusFailed,
+	}
+}
+
+const (
+	// DescribePortfolioShareTypeAccount is a DescribePortfolioShareType enum value
+	DescribePortfolioShareTypeAccount = "ACCOUNT"
+
+	// DescribePortfolioShareTypeOrganization is a DescribePortfolioShareType enum value
+	DescribePortfolioShareTypeOrganization = "ORGANIZATION"
+
+	// DescribePortfolioShareTypeOrganizationalUnit is a DescribePortfolioShareType enum value
+	DescribePortfolioShareTypeOrganizationalUnit = "ORGANIZATIONAL_UNIT"
+
+	// DescribePortfolioShareTypeOrganizationMemberAccount is a DescribePortfolioShareType enum value
+	DescribePortfolioShareTypeOrganizationMemberAccount = "ORGANIZATION_MEMBER_ACCOUNT"
+)
+
+// DescribePortfolioShareType_Values returns all elements of the DescribePortfolioShareType enum
+func DescribePortfolioShareType_Values() []string {
+	return []string{
+		DescribePortfolioShareTypeAccount,
+		DescribePortfolioShareTypeOrganization,
+		DescribePortfolioShareTypeOrganizationalUnit,
+		DescribePortfolioShareTypeOrganizationMemberAccount,
+	}
+}
+
+const (
+	// EngineWorkflowStatusSucceeded is a EngineWorkflowStatus enum value
+	EngineWorkflowStatusSucceeded = "SUCCEEDED"
+
+	// EngineWorkflowStatusFailed is a EngineWorkflowStatus enum value
+	EngineWorkflowStatusFailed = "FAILED"
+)
+
+// EngineWorkflowStatus_Values returns all elements of the EngineWorkflowStatus enum
+func EngineWorkflowStatus_Values() []string {
+	return []string{
+		EngineWorkflowStatusSucceeded,
+		EngineWorkflowStatusFailed,
+	}
+}
+
 const (
 	// EvaluationTypeStatic is a EvaluationType enum value
 	EvaluationTypeStatic = "STATIC"
@@ -20200,6 +25703,30 @@ const (
 	EvaluationTypeDynamic = "DYNAMIC"
 )
 
+// EvaluationType_Values returns all elements of the EvaluationType enum
+func EvaluationType_Values() []string {
+	return []string{
+		EvaluationTypeStatic,
+		EvaluationTypeDynamic,
+	}
+}
+
+const (
+	// LastSyncStatusSucceeded is a LastSyncStatus enum value
+	LastSyncStatusSucceeded = "SUCCEEDED"
+
+	// LastSyncStatusFailed is a LastSyncStatus enum value
+	LastSyncStatusFailed = "FAILED"
+)
+
+// LastSyncStatus_Values returns all elements of the LastSyncStatus enum
+func LastSyncStatus_Values() []string {
+	return []string{
+		LastSyncStatusSucceeded,
+		LastSyncStatusFailed,
+	}
+}
+
 const (
 	// OrganizationNodeTypeOrganization is a OrganizationNodeType enum value
 	OrganizationNodeTypeOrganization = "ORGANIZATION"
@@ -20211,6 +25738,15 @@ const (
 	OrganizationNodeTypeAccount = "ACCOUNT"
 )
 
+// OrganizationNodeType_Values returns all elements of the OrganizationNodeType enum
+func OrganizationNodeType_Values() []string {
+	return []string{
+		OrganizationNodeTypeOrganization,
+		OrganizationNodeTypeOrganizationalUnit,
+		OrganizationNodeTypeAccount,
+	}
+}
+
 const (
 	// PortfolioShareTypeImported is a PortfolioShareType enum value
 	PortfolioShareTypeImported = "IMPORTED"
@@ -20222,24 +25758,71 @@ const (
 	PortfolioShareTypeAwsOrganizations = "AWS_ORGANIZATIONS"
 )
 
+// PortfolioShareType_Values returns all elements of the PortfolioShareType enum
+func PortfolioShareType_Values() []string {
+	return []string{
+		PortfolioShareTypeImported,
+		PortfolioShareTypeAwsServicecatalog,
+		PortfolioShareTypeAwsOrganizations,
+	}
+}
+
 const (
 	// PrincipalTypeIam is a PrincipalType enum value
 	PrincipalTypeIam = "IAM"
+
+	// PrincipalTypeIamPattern is a PrincipalType enum value
+	PrincipalTypeIamPattern = "IAM_PATTERN"
 )
 
+// PrincipalType_Values returns all elements of the PrincipalType enum
+func PrincipalType_Values() []string {
+	return []string{
+		PrincipalTypeIam,
+		PrincipalTypeIamPattern,
+	}
+}
+
 const (
 	// ProductSourceAccount is a ProductSource enum value
 	ProductSourceAccount = "ACCOUNT"
 )
 
+// ProductSource_Values returns all elements of the ProductSource enum
+func ProductSource_Values() []string {
+	return []string{
+		ProductSourceAccount,
+	}
+}
+
 const (
 	// ProductTypeCloudFormationTemplate is a ProductType enum value
 	ProductTypeCloudFormationTemplate = "CLOUD_FORMATION_TEMPLATE"
 
 	// ProductTypeMarketplace is a ProductType enum value
 	ProductTypeMarketplace = "MARKETPLACE"
+
+	// ProductTypeTerraformOpenSource is a ProductType enum value
+	ProductTypeTerraformOpenSource = "TERRAFORM_OPEN_SOURCE"
+
+	// ProductTypeTerraformCloud is a ProductType enum value
+	ProductTypeTerraformCloud = "TERRAFORM_CLOUD"
+
+	// ProductTypeExternal is a ProductType enum value
+	ProductTypeExternal = "EXTERNAL"
 )
 
+// ProductType_Values returns all elements of the ProductType enum
+func ProductType_Values() []string {
+	return []string{
+		ProductTypeCloudFormationTemplate,
+		ProductTypeMarketplace,
+		ProductTypeTerraformOpenSource,
+		ProductTypeTerraformCloud,
+		ProductTypeExternal,
+	}
+}
+
 const (
 	// ProductViewFilterByFullTextSearch is a ProductViewFilterBy enum value
 	ProductViewFilterByFullTextSearch = "FullTextSearch"
@@ -20254,6 +25837,16 @@ const (
 	ProductViewFilterBySourceProductId = "SourceProductId"
 )
 
+// ProductViewFilterBy_Values returns all elements of the ProductViewFilterBy enum
+func ProductViewFilterBy_Values() []string {
+	return []string{
+		ProductViewFilterByFullTextSearch,
+		ProductViewFilterByOwner,
+		ProductViewFilterByProductType,
+		ProductViewFilterBySourceProductId,
+	}
+}
+
 const (
 	// ProductViewSortByTitle is a ProductViewSortBy enum value
 	ProductViewSortByTitle = "Title"
@@ -20265,11 +25858,31 @@ const (
 	ProductViewSortByCreationDate = "CreationDate"
 )
 
+// ProductViewSortBy_Values returns all elements of the ProductViewSortBy enum
+func ProductViewSortBy_Values() []string {
+	return []string{
+		ProductViewSortByTitle,
+		ProductViewSortByVersionCount,
+		ProductViewSortByCreationDate,
+	}
+}
+
 const (
 	// PropertyKeyOwner is a PropertyKey enum value
 	PropertyKeyOwner = "OWNER"
+
+	// PropertyKeyLaunchRole is a PropertyKey enum value
+	PropertyKeyLaunchRole = "LAUNCH_ROLE"
 )
 
+// PropertyKey_Values returns all elements of the PropertyKey enum
+func PropertyKey_Values() []string {
+	return []string{
+		PropertyKeyOwner,
+		PropertyKeyLaunchRole,
+	}
+}
+
 const (
 	// ProvisionedProductPlanStatusCreateInProgress is a ProvisionedProductPlanStatus enum value
 	ProvisionedProductPlanStatusCreateInProgress = "CREATE_IN_PROGRESS"
@@ -20290,11 +25903,30 @@ const (
 	ProvisionedProductPlanStatusExecuteFailed = "EXECUTE_FAILED"
 )
 
+// ProvisionedProductPlanStatus_Values returns all elements of the ProvisionedProductPlanStatus enum
+func ProvisionedProductPlanStatus_Values() []string {
+	return []string{
+		ProvisionedProductPlanStatusCreateInProgress,
+		ProvisionedProductPlanStatusCreateSuccess,
+		ProvisionedProductPlanStatusCreateFailed,
+		ProvisionedProductPlanStatusExecuteInProgress,
+		ProvisionedProductPlanStatusExecuteSuccess,
+		ProvisionedProductPlanStatusExecuteFailed,
+	}
+}
+
 const (
 	// ProvisionedProductPlanTypeCloudformation is a ProvisionedProductPlanType enum value
 	ProvisionedProductPlanTypeCloudformation = "CLOUDFORMATION"
 )
 
+// ProvisionedProductPlanType_Values returns all elements of the ProvisionedProductPlanType enum
+func ProvisionedProductPlanType_Values() []string {
+	return []string{
+		ProvisionedProductPlanTypeCloudformation,
+	}
+}
+
 const (
 	// ProvisionedProductStatusAvailable is a ProvisionedProductStatus enum value
 	ProvisionedProductStatusAvailable = "AVAILABLE"
@@ -20312,11 +25944,29 @@ const (
 	ProvisionedProductStatusPlanInProgress = "PLAN_IN_PROGRESS"
 )
 
+// ProvisionedProductStatus_Values returns all elements of the ProvisionedProductStatus enum
+func ProvisionedProductStatus_Values() []string {
+	return []string{
+		ProvisionedProductStatusAvailable,
+		ProvisionedProductStatusUnderChange,
+		ProvisionedProductStatusTainted,
+		ProvisionedProductStatusError,
+		ProvisionedProductStatusPlanInProgress,
+	}
+}
+
 const (
 	// ProvisionedProductViewFilterBySearchQuery is a ProvisionedProductViewFilterBy enum value
 	ProvisionedProductViewFilterBySearchQuery = "SearchQuery"
 )
 
+// ProvisionedProductViewFilterBy_Values returns all elements of the ProvisionedProductViewFilterBy enum
+func ProvisionedProductViewFilterBy_Values() []string {
+	return []string{
+		ProvisionedProductViewFilterBySearchQuery,
+	}
+}
+
 const (
 	// ProvisioningArtifactGuidanceDefault is a ProvisioningArtifactGuidance enum value
 	ProvisioningArtifactGuidanceDefault = "DEFAULT"
@@ -20325,11 +25975,26 @@ const (
 	ProvisioningArtifactGuidanceDeprecated = "DEPRECATED"
 )
 
+// ProvisioningArtifactGuidance_Values returns all elements of the ProvisioningArtifactGuidance enum
+func ProvisioningArtifactGuidance_Values() []string {
+	return []string{
+		ProvisioningArtifactGuidanceDefault,
+		ProvisioningArtifactGuidanceDeprecated,
+	}
+}
+
 const (
 	// ProvisioningArtifactPropertyNameId is a ProvisioningArtifactPropertyName enum value
 	ProvisioningArtifactPropertyNameId = "Id"
 )
 
+// ProvisioningArtifactPropertyName_Values returns all elements of the ProvisioningArtifactPropertyName enum
+func ProvisioningArtifactPropertyName_Values() []string {
+	return []string{
+		ProvisioningArtifactPropertyNameId,
+	}
+}
+
 const (
 	// ProvisioningArtifactTypeCloudFormationTemplate is a ProvisioningArtifactType enum value
 	ProvisioningArtifactTypeCloudFormationTemplate = "CLOUD_FORMATION_TEMPLATE"
@@ -20339,8 +26004,29 @@ const (
 
 	// ProvisioningArtifactTypeMarketplaceCar is a ProvisioningArtifactType enum value
 	ProvisioningArtifactTypeMarketplaceCar = "MARKETPLACE_CAR"
+
+	// ProvisioningArtifactTypeTerraformOpenSource is a ProvisioningArtifactType enum value
+	ProvisioningArtifactTypeTerraformOpenSource = "TERRAFORM_OPEN_SOURCE"
+
+	// ProvisioningArtifactTypeTerraformCloud is a ProvisioningArtifactType enum value
+	ProvisioningArtifactTypeTerraformCloud = "TERRAFORM_CLOUD"
+
+	// ProvisioningArtifactTypeExternal is a ProvisioningArtifactType enum value
+	ProvisioningArtifactTypeExternal = "EXTERNAL"
 )
 
+// ProvisioningArtifactType_Values returns all elements of the ProvisioningArtifactType enum
+func ProvisioningArtifactType_Values() []string {
+	return []string{
+		ProvisioningArtifactTypeCloudFormationTemplate,
+		ProvisioningArtifactTypeMarketplaceAmi,
+		ProvisioningArtifactTypeMarketplaceCar,
+		ProvisioningArtifactTypeTerraformOpenSource,
+		ProvisioningArtifactTypeTerraformCloud,
+		ProvisioningArtifactTypeExternal,
+	}
+}
+
 const (
 	// RecordStatusCreated is a RecordStatus enum value
 	RecordStatusCreated = "CREATED"
@@ -20358,6 +26044,17 @@ const (
 	RecordStatusFailed = "FAILED"
 )
 
+// RecordStatus_Values returns all elements of the RecordStatus enum
+func RecordStatus_Values() []string {
+	return []string{
+		RecordStatusCreated,
+		RecordStatusInProgress,
+		RecordStatusInProgressInError,
+		RecordStatusSucceeded,
+		RecordStatusFailed,
+	}
+}
+
 const (
 	// ReplacementTrue is a Replacement enum value
 	ReplacementTrue = "TRUE"
@@ -20369,6 +26066,15 @@ const (
 	ReplacementConditional = "CONDITIONAL"
 )
 
+// Replacement_Values returns all elements of the Replacement enum
+func Replacement_Values() []string {
+	return []string{
+		ReplacementTrue,
+		ReplacementFalse,
+		ReplacementConditional,
+	}
+}
+
 const (
 	// RequiresRecreationNever is a RequiresRecreation enum value
 	RequiresRecreationNever = "NEVER"
@@ -20380,6 +26086,15 @@ const (
 	RequiresRecreationAlways = "ALWAYS"
 )
 
+// RequiresRecreation_Values returns all elements of the RequiresRecreation enum
+func RequiresRecreation_Values() []string {
+	return []string{
+		RequiresRecreationNever,
+		RequiresRecreationConditionally,
+		RequiresRecreationAlways,
+	}
+}
+
 const (
 	// ResourceAttributeProperties is a ResourceAttribute enum value
 	ResourceAttributeProperties = "PROPERTIES"
@@ -20400,6 +26115,18 @@ const (
 	ResourceAttributeTags = "TAGS"
 )
 
+// ResourceAttribute_Values returns all elements of the ResourceAttribute enum
+func ResourceAttribute_Values() []string {
+	return []string{
+		ResourceAttributeProperties,
+		ResourceAttributeMetadata,
+		ResourceAttributeCreationpolicy,
+		ResourceAttributeUpdatepolicy,
+		ResourceAttributeDeletionpolicy,
+		ResourceAttributeTags,
+	}
+}
+
 const (
 	// ServiceActionAssociationErrorCodeDuplicateResource is a ServiceActionAssociationErrorCode enum value
 	ServiceActionAssociationErrorCodeDuplicateResource = "DUPLICATE_RESOURCE"
@@ -20415,8 +26142,23 @@ const (
 
 	// ServiceActionAssociationErrorCodeThrottling is a ServiceActionAssociationErrorCode enum value
 	ServiceActionAssociationErrorCodeThrottling = "THROTTLING"
+
+	// ServiceActionAssociationErrorCodeInvalidParameter is a ServiceActionAssociationErrorCode enum value
+	ServiceActionAssociationErrorCodeInvalidParameter = "INVALID_PARAMETER"
 )
 
+// ServiceActionAssociationErrorCode_Values returns all elements of the ServiceActionAssociationErrorCode enum
+func ServiceActionAssociationErrorCode_Values() []string {
+	return []string{
+		ServiceActionAssociationErrorCodeDuplicateResource,
+		ServiceActionAssociationErrorCodeInternalFailure,
+		ServiceActionAssociationErrorCodeLimitExceeded,
+		ServiceActionAssociationErrorCodeResourceNotFound,
+		ServiceActionAssociationErrorCodeThrottling,
+		ServiceActionAssociationErrorCodeInvalidParameter,
+	}
+}
+
 const (
 	// ServiceActionDefinitionKeyName is a ServiceActionDefinitionKey enum value
 	ServiceActionDefinitionKeyName = "Name"
@@ -20431,11 +26173,28 @@ const (
 	ServiceActionDefinitionKeyParameters = "Parameters"
 )
 
+// ServiceActionDefinitionKey_Values returns all elements of the ServiceActionDefinitionKey enum
+func ServiceActionDefinitionKey_Values() []string {
+	return []string{
+		ServiceActionDefinitionKeyName,
+		ServiceActionDefinitionKeyVersion,
+		ServiceActionDefinitionKeyAssumeRole,
+		ServiceActionDefinitionKeyParameters,
+	}
+}
+
 const (
 	// ServiceActionDefinitionTypeSsmAutomation is a ServiceActionDefinitionType enum value
 	ServiceActionDefinitionTypeSsmAutomation = "SSM_AUTOMATION"
 )
 
+// ServiceActionDefinitionType_Values returns all elements of the ServiceActionDefinitionType enum
+func ServiceActionDefinitionType_Values() []string {
+	return []string{
+		ServiceActionDefinitionTypeSsmAutomation,
+	}
+}
+
 const (
 	// ShareStatusNotStarted is a ShareStatus enum value
 	ShareStatusNotStarted = "NOT_STARTED"
@@ -20453,6 +26212,17 @@ const (
 	ShareStatusError = "ERROR"
 )
 
+// ShareStatus_Values returns all elements of the ShareStatus enum
+func ShareStatus_Values() []string {
+	return []string{
+		ShareStatusNotStarted,
+		ShareStatusInProgress,
+		ShareStatusCompleted,
+		ShareStatusCompletedWithErrors,
+		ShareStatusError,
+	}
+}
+
 const (
 	// SortOrderAscending is a SortOrder enum value
 	SortOrderAscending = "ASCENDING"
@@ -20461,6 +26231,26 @@ const (
 	SortOrderDescending = "DESCENDING"
 )
 
+// SortOrder_Values returns all elements of the SortOrder enum
+func SortOrder_Values() []string {
+	return []string{
+		SortOrderAscending,
+		SortOrderDescending,
+	}
+}
+
+const (
+	// SourceTypeCodestar is a SourceType enum value
+	SourceTypeCodestar = "CODESTAR"
+)
+
+// SourceType_Values returns all elements of the SourceType enum
+func SourceType_Values() []string {
+	return []string{
+		SourceTypeCodestar,
+	}
+}
+
 const (
 	// StackInstanceStatusCurrent is a StackInstanceStatus enum value
 	StackInstanceStatusCurrent = "CURRENT"
@@ -20472,6 +26262,15 @@ const (
 	StackInstanceStatusInoperable = "INOPERABLE"
 )
 
+// StackInstanceStatus_Values returns all elements of the StackInstanceStatus enum
+func StackInstanceStatus_Values() []string {
+	return []string{
+		StackInstanceStatusCurrent,
+		StackInstanceStatusOutdated,
+		StackInstanceStatusInoperable,
+	}
+}
+
 const (
 	// StackSetOperationTypeCreate is a StackSetOperationType enum value
 	StackSetOperationTypeCreate = "CREATE"
@@ -20483,6 +26282,15 @@ const (
 	StackSetOperationTypeDelete = "DELETE"
 )
 
+// StackSetOperationType_Values returns all elements of the StackSetOperationType enum
+func StackSetOperationType_Values() []string {
+	return []string{
+		StackSetOperationTypeCreate,
+		StackSetOperationTypeUpdate,
+		StackSetOperationTypeDelete,
+	}
+}
+
 const (
 	// StatusAvailable is a Status enum value
 	StatusAvailable = "AVAILABLE"
@@ -20493,3 +26301,12 @@ const (
 	// StatusFailed is a Status enum value
 	StatusFailed = "FAILED"
 )
+
+// Status_Values returns all elements of the Status enum
+func Status_Values() []string {
+	return []string{
+		StatusAvailable,
+		StatusCreating,
+		StatusFailed,
+	}
+}
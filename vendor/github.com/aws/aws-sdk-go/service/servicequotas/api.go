@@ -3,6 +3,7 @@
 package servicequotas
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -28,14 +29,13 @@ const opAssociateServiceQuotaTemplate = "AssociateServiceQuotaTemplate"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AssociateServiceQuotaTemplateRequest method.
+//	req, resp := client.AssociateServiceQuotaTemplateRequest(params)
 //
-//    // Example sending a request using the AssociateServiceQuotaTemplateRequest method.
-//    req, resp := client.AssociateServiceQuotaTemplateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/AssociateServiceQuotaTemplate
 func (c *ServiceQuotas) AssociateServiceQuotaTemplateRequest(input *AssociateServiceQuotaTemplateInput) (req *request.Request, output *AssociateServiceQuotaTemplateOutput) {
@@ -57,12 +57,10 @@ func (c *ServiceQuotas) AssociateServiceQuotaTemplateRequest(input *AssociateSer
 
 // AssociateServiceQuotaTemplate API operation for Service Quotas.
 //
-// Associates the Service Quotas template with your organization so that when
-// new accounts are created in your organization, the template submits increase
-// requests for the specified service quotas. Use the Service Quotas template
-// to request an increase for any adjustable quota value. After you define the
-// Service Quotas template, use this operation to associate, or enable, the
-// template.
+// Associates your quota request template with your organization. When a new
+// Amazon Web Services account is created in your organization, the quota increase
+// requests in the template are automatically applied to the account. You can
+// add a quota increase request for any adjustable quota to your template.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -71,34 +69,35 @@ func (c *ServiceQuotas) AssociateServiceQuotaTemplateRequest(input *AssociateSer
 // See the AWS API reference guide for Service Quotas's
 // API operation AssociateServiceQuotaTemplate for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeDependencyAccessDeniedException "DependencyAccessDeniedException"
-//   You can't perform this action because a dependency does not have access.
+// Returned Error Types:
+//
+//   - DependencyAccessDeniedException
+//     You can't perform this action because a dependency does not have access.
 //
-//   * ErrCodeAccessDeniedException "AccessDeniedException"
-//   You do not have sufficient access to perform this action.
+//   - AccessDeniedException
+//     You do not have sufficient permission to perform this action.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   Something went wrong.
+//   - ServiceException
+//     Something went wrong.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Due to throttling, the request was denied. Slow down the rate of request
-//   calls, or request an increase for this quota.
+//   - TooManyRequestsException
+//     Due to throttling, the request was denied. Slow down the rate of request
+//     calls, or request an increase for this quota.
 //
-//   * ErrCodeAWSServiceAccessNotEnabledException "AWSServiceAccessNotEnabledException"
-//   The action you attempted is not allowed unless Service Access with Service
-//   Quotas is enabled in your organization. To enable, call AssociateServiceQuotaTemplate.
+//   - AWSServiceAccessNotEnabledException
+//     The action you attempted is not allowed unless Service Access with Service
+//     Quotas is enabled in your organization.
 //
-//   * ErrCodeOrganizationNotInAllFeaturesModeException "OrganizationNotInAllFeaturesModeException"
-//   The organization that your account belongs to, is not in All Features mode.
-//   To enable all features mode, see EnableAllFeatures (https://docs.aws.amazon.com/organizations/latest/APIReference/API_EnableAllFeatures.html).
+//   - OrganizationNotInAllFeaturesModeException
+//     The organization that your Amazon Web Services account belongs to is not
+//     in All Features mode.
 //
-//   * ErrCodeTemplatesNotAvailableInRegionException "TemplatesNotAvailableInRegionException"
-//   The Service Quotas template is not available in the Region where you are
-//   making the request. Please make the request in us-east-1.
+//   - TemplatesNotAvailableInRegionException
+//     The Service Quotas template is not available in this Amazon Web Services
+//     Region.
 //
-//   * ErrCodeNoAvailableOrganizationException "NoAvailableOrganizationException"
-//   The account making this call is not a member of an organization.
+//   - NoAvailableOrganizationException
+//     The Amazon Web Services account making this call is not a member of an organization.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/AssociateServiceQuotaTemplate
 func (c *ServiceQuotas) AssociateServiceQuotaTemplate(input *AssociateServiceQuotaTemplateInput) (*AssociateServiceQuotaTemplateOutput, error) {
@@ -138,14 +137,13 @@ const opDeleteServiceQuotaIncreaseRequestFromTemplate = "DeleteServiceQuotaIncre
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteServiceQuotaIncreaseRequestFromTemplateRequest method.
+//	req, resp := client.DeleteServiceQuotaIncreaseRequestFromTemplateRequest(params)
 //
-//    // Example sending a request using the DeleteServiceQuotaIncreaseRequestFromTemplateRequest method.
-//    req, resp := client.DeleteServiceQuotaIncreaseRequestFromTemplateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/DeleteServiceQuotaIncreaseRequestFromTemplate
 func (c *ServiceQuotas) DeleteServiceQuotaIncreaseRequestFromTemplateRequest(input *DeleteServiceQuotaIncreaseRequestFromTemplateInput) (req *request.Request, output *DeleteServiceQuotaIncreaseRequestFromTemplateOutput) {
@@ -167,7 +165,8 @@ func (c *ServiceQuotas) DeleteServiceQuotaIncreaseRequestFromTemplateRequest(inp
 
 // DeleteServiceQuotaIncreaseRequestFromTemplate API operation for Service Quotas.
 //
-// Removes a service quota increase request from the Service Quotas template.
+// Deletes the quota increase request for the specified quota from your quota
+// request template.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -176,36 +175,37 @@ func (c *ServiceQuotas) DeleteServiceQuotaIncreaseRequestFromTemplateRequest(inp
 // See the AWS API reference guide for Service Quotas's
 // API operation DeleteServiceQuotaIncreaseRequestFromTemplate for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeAccessDeniedException "AccessDeniedException"
-//   You do not have sufficient access to perform this action.
+// Returned Error Types:
+//
+//   - AccessDeniedException
+//     You do not have sufficient permission to perform this action.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   Something went wrong.
+//   - ServiceException
+//     Something went wrong.
 //
-//   * ErrCodeDependencyAccessDeniedException "DependencyAccessDeniedException"
-//   You can't perform this action because a dependency does not have access.
+//   - DependencyAccessDeniedException
+//     You can't perform this action because a dependency does not have access.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Due to throttling, the request was denied. Slow down the rate of request
-//   calls, or request an increase for this quota.
+//   - TooManyRequestsException
+//     Due to throttling, the request was denied. Slow down the rate of request
+//     calls, or request an increase for this quota.
 //
-//   * ErrCodeNoSuchResourceException "NoSuchResourceException"
-//   The specified resource does not exist.
+//   - NoSuchResourceException
+//     The specified resource does not exist.
 //
-//   * ErrCodeIllegalArgumentException "IllegalArgumentException"
-//   Invalid input was provided.
+//   - IllegalArgumentException
+//     Invalid input was provided.
 //
-//   * ErrCodeAWSServiceAccessNotEnabledException "AWSServiceAccessNotEnabledException"
-//   The action you attempted is not allowed unless Service Access with Service
-//   Quotas is enabled in your organization. To enable, call AssociateServiceQuotaTemplate.
+//   - AWSServiceAccessNotEnabledException
+//     The action you attempted is not allowed unless Service Access with Service
+//     Quotas is enabled in your organization.
 //
-//   * ErrCodeTemplatesNotAvailableInRegionException "TemplatesNotAvailableInRegionException"
-//   The Service Quotas template is not available in the Region where you are
-//   making the request. Please make the request in us-east-1.
+//   - TemplatesNotAvailableInRegionException
+//     The Service Quotas template is not available in this Amazon Web Services
+//     Region.
 //
-//   * ErrCodeNoAvailableOrganizationException "NoAvailableOrganizationException"
-//   The account making this call is not a member of an organization.
+//   - NoAvailableOrganizationException
+//     The Amazon Web Services account making this call is not a member of an organization.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/DeleteServiceQuotaIncreaseRequestFromTemplate
 func (c *ServiceQuotas) DeleteServiceQuotaIncreaseRequestFromTemplate(input *DeleteServiceQuotaIncreaseRequestFromTemplateInput) (*DeleteServiceQuotaIncreaseRequestFromTemplateOutput, error) {
@@ -245,14 +245,13 @@ const opDisassociateServiceQuotaTemplate = "DisassociateServiceQuotaTemplate"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DisassociateServiceQuotaTemplateRequest method.
+//	req, resp := client.DisassociateServiceQuotaTemplateRequest(params)
 //
-//    // Example sending a request using the DisassociateServiceQuotaTemplateRequest method.
-//    req, resp := client.DisassociateServiceQuotaTemplateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/DisassociateServiceQuotaTemplate
 func (c *ServiceQuotas) DisassociateServiceQuotaTemplateRequest(input *DisassociateServiceQuotaTemplateInput) (req *request.Request, output *DisassociateServiceQuotaTemplateOutput) {
@@ -274,15 +273,10 @@ func (c *ServiceQuotas) DisassociateServiceQuotaTemplateRequest(input *Disassoci
 
 // DisassociateServiceQuotaTemplate API operation for Service Quotas.
 //
-// Disables the Service Quotas template. Once the template is disabled, it does
-// not request quota increases for new accounts in your organization. Disabling
-// the quota template does not apply the quota increase requests from the template.
-//
-// Related operations
-//
-//    * To enable the quota template, call AssociateServiceQuotaTemplate.
-//
-//    * To delete a specific service quota from the template, use DeleteServiceQuotaIncreaseRequestFromTemplate.
+// Disables your quota request template. After a template is disabled, the quota
+// increase requests in the template are not applied to new Amazon Web Services
+// accounts in your organization. Disabling a quota request template does not
+// apply its quota increase requests.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -291,35 +285,34 @@ func (c *ServiceQuotas) DisassociateServiceQuotaTemplateRequest(input *Disassoci
 // See the AWS API reference guide for Service Quotas's
 // API operation DisassociateServiceQuotaTemplate for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeDependencyAccessDeniedException "DependencyAccessDeniedException"
-//   You can't perform this action because a dependency does not have access.
+// Returned Error Types:
 //
-//   * ErrCodeServiceQuotaTemplateNotInUseException "ServiceQuotaTemplateNotInUseException"
-//   The quota request template is not associated with your organization.
+//   - DependencyAccessDeniedException
+//     You can't perform this action because a dependency does not have access.
 //
-//   To use the template, call AssociateServiceQuotaTemplate.
+//   - ServiceQuotaTemplateNotInUseException
+//     The quota request template is not associated with your organization.
 //
-//   * ErrCodeAccessDeniedException "AccessDeniedException"
-//   You do not have sufficient access to perform this action.
+//   - AccessDeniedException
+//     You do not have sufficient permission to perform this action.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   Something went wrong.
+//   - ServiceException
+//     Something went wrong.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Due to throttling, the request was denied. Slow down the rate of request
-//   calls, or request an increase for this quota.
+//   - TooManyRequestsException
+//     Due to throttling, the request was denied. Slow down the rate of request
+//     calls, or request an increase for this quota.
 //
-//   * ErrCodeAWSServiceAccessNotEnabledException "AWSServiceAccessNotEnabledException"
-//   The action you attempted is not allowed unless Service Access with Service
-//   Quotas is enabled in your organization. To enable, call AssociateServiceQuotaTemplate.
+//   - AWSServiceAccessNotEnabledException
+//     The action you attempted is not allowed unless Service Access with Service
+//     Quotas is enabled in your organization.
 //
-//   * ErrCodeTemplatesNotAvailableInRegionException "TemplatesNotAvailableInRegionException"
-//   The Service Quotas template is not available in the Region where you are
-//   making the request. Please make the request in us-east-1.
+//   - TemplatesNotAvailableInRegionException
+//     The Service Quotas template is not available in this Amazon Web Services
+//     Region.
 //
-//   * ErrCodeNoAvailableOrganizationException "NoAvailableOrganizationException"
-//   The account making this call is not a member of an organization.
+//   - NoAvailableOrganizationException
+//     The Amazon Web Services account making this call is not a member of an organization.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/DisassociateServiceQuotaTemplate
 func (c *ServiceQuotas) DisassociateServiceQuotaTemplate(input *DisassociateServiceQuotaTemplateInput) (*DisassociateServiceQuotaTemplateOutput, error) {
@@ -359,14 +352,13 @@ const opGetAWSDefaultServiceQuota = "GetAWSDefaultServiceQuota"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetAWSDefaultServiceQuotaRequest method.
+//	req, resp := client.GetAWSDefaultServiceQuotaRequest(params)
 //
-//    // Example sending a request using the GetAWSDefaultServiceQuotaRequest method.
-//    req, resp := client.GetAWSDefaultServiceQuotaRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/GetAWSDefaultServiceQuota
 func (c *ServiceQuotas) GetAWSDefaultServiceQuotaRequest(input *GetAWSDefaultServiceQuotaInput) (req *request.Request, output *GetAWSDefaultServiceQuotaOutput) {
@@ -387,8 +379,8 @@ func (c *ServiceQuotas) GetAWSDefaultServiceQuotaRequest(input *GetAWSDefaultSer
 
 // GetAWSDefaultServiceQuota API operation for Service Quotas.
 //
-// Retrieves the default service quotas values. The Value returned for each
-// quota is the AWS default value, even if the quotas have been increased..
+// Retrieves the default value for the specified quota. The default value does
+// not reflect any quota increases.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -397,22 +389,23 @@ func (c *ServiceQuotas) GetAWSDefaultServiceQuotaRequest(input *GetAWSDefaultSer
 // See the AWS API reference guide for Service Quotas's
 // API operation GetAWSDefaultServiceQuota for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeAccessDeniedException "AccessDeniedException"
-//   You do not have sufficient access to perform this action.
+// Returned Error Types:
+//
+//   - AccessDeniedException
+//     You do not have sufficient permission to perform this action.
 //
-//   * ErrCodeNoSuchResourceException "NoSuchResourceException"
-//   The specified resource does not exist.
+//   - NoSuchResourceException
+//     The specified resource does not exist.
 //
-//   * ErrCodeIllegalArgumentException "IllegalArgumentException"
-//   Invalid input was provided.
+//   - IllegalArgumentException
+//     Invalid input was provided.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   Something went wrong.
+//   - ServiceException
+//     Something went wrong.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Due to throttling, the request was denied. Slow down the rate of request
-//   calls, or request an increase for this quota.
+//   - TooManyRequestsException
+//     Due to throttling, the request was denied. Slow down the rate of request
+//     calls, or request an increase for this quota.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/GetAWSDefaultServiceQuota
 func (c *ServiceQuotas) GetAWSDefaultServiceQuota(input *GetAWSDefaultServiceQuotaInput) (*GetAWSDefaultServiceQuotaOutput, error) {
@@ -452,14 +445,13 @@ const opGetAssociationForServiceQuotaTemplate = "GetAssociationForServiceQuotaTe
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetAssociationForServiceQuotaTemplateRequest method.
+//	req, resp := client.GetAssociationForServiceQuotaTemplateRequest(params)
 //
-//    // Example sending a request using the GetAssociationForServiceQuotaTemplateRequest method.
-//    req, resp := client.GetAssociationForServiceQuotaTemplateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/GetAssociationForServiceQuotaTemplate
 func (c *ServiceQuotas) GetAssociationForServiceQuotaTemplateRequest(input *GetAssociationForServiceQuotaTemplateInput) (req *request.Request, output *GetAssociationForServiceQuotaTemplateOutput) {
@@ -480,9 +472,7 @@ func (c *ServiceQuotas) GetAssociationForServiceQuotaTemplateRequest(input *GetA
 
 // GetAssociationForServiceQuotaTemplate API operation for Service Quotas.
 //
-// Retrieves the ServiceQuotaTemplateAssociationStatus value from the service.
-// Use this action to determine if the Service Quota template is associated,
-// or enabled.
+// Retrieves the status of the association for the quota request template.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -491,35 +481,34 @@ func (c *ServiceQuotas) GetAssociationForServiceQuotaTemplateRequest(input *GetA
 // See the AWS API reference guide for Service Quotas's
 // API operation GetAssociationForServiceQuotaTemplate for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeDependencyAccessDeniedException "DependencyAccessDeniedException"
-//   You can't perform this action because a dependency does not have access.
+// Returned Error Types:
 //
-//   * ErrCodeServiceQuotaTemplateNotInUseException "ServiceQuotaTemplateNotInUseException"
-//   The quota request template is not associated with your organization.
+//   - DependencyAccessDeniedException
+//     You can't perform this action because a dependency does not have access.
 //
-//   To use the template, call AssociateServiceQuotaTemplate.
+//   - ServiceQuotaTemplateNotInUseException
+//     The quota request template is not associated with your organization.
 //
-//   * ErrCodeAccessDeniedException "AccessDeniedException"
-//   You do not have sufficient access to perform this action.
+//   - AccessDeniedException
+//     You do not have sufficient permission to perform this action.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   Something went wrong.
+//   - ServiceException
+//     Something went wrong.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Due to throttling, the request was denied. Slow down the rate of request
-//   calls, or request an increase for this quota.
+//   - TooManyRequestsException
+//     Due to throttling, the request was denied. Slow down the rate of request
+//     calls, or request an increase for this quota.
 //
-//   * ErrCodeAWSServiceAccessNotEnabledException "AWSServiceAccessNotEnabledException"
-//   The action you attempted is not allowed unless Service Access with Service
-//   Quotas is enabled in your organization. To enable, call AssociateServiceQuotaTemplate.
+//   - AWSServiceAccessNotEnabledException
+//     The action you attempted is not allowed unless Service Access with Service
+//     Quotas is enabled in your organization.
 //
-//   * ErrCodeTemplatesNotAvailableInRegionException "TemplatesNotAvailableInRegionException"
-//   The Service Quotas template is not available in the Region where you are
-//   making the request. Please make the request in us-east-1.
+//   - TemplatesNotAvailableInRegionException
+//     The Service Quotas template is not available in this Amazon Web Services
+//     Region.
 //
-//   * ErrCodeNoAvailableOrganizationException "NoAvailableOrganizationException"
-//   The account making this call is not a member of an organization.
+//   - NoAvailableOrganizationException
+//     The Amazon Web Services account making this call is not a member of an organization.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/GetAssociationForServiceQuotaTemplate
 func (c *ServiceQuotas) GetAssociationForServiceQuotaTemplate(input *GetAssociationForServiceQuotaTemplateInput) (*GetAssociationForServiceQuotaTemplateOutput, error) {
@@ -559,14 +548,13 @@ const opGetRequestedServiceQuotaChange = "GetRequestedServiceQuotaChange"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetRequestedServiceQuotaChangeRequest method.
+//	req, resp := client.GetRequestedServiceQuotaChangeRequest(params)
 //
-//    // Example sending a request using the GetRequestedServiceQuotaChangeRequest method.
-//    req, resp := client.GetRequestedServiceQuotaChangeRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/GetRequestedServiceQuotaChange
 func (c *ServiceQuotas) GetRequestedServiceQuotaChangeRequest(input *GetRequestedServiceQuotaChangeInput) (req *request.Request, output *GetRequestedServiceQuotaChangeOutput) {
@@ -587,7 +575,7 @@ func (c *ServiceQuotas) GetRequestedServiceQuotaChangeRequest(input *GetRequeste
 
 // GetRequestedServiceQuotaChange API operation for Service Quotas.
 //
-// Retrieves the details for a particular increase request.
+// Retrieves information about the specified quota increase request.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -596,22 +584,23 @@ func (c *ServiceQuotas) GetRequestedServiceQuotaChangeRequest(input *GetRequeste
 // See the AWS API reference guide for Service Quotas's
 // API operation GetRequestedServiceQuotaChange for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeAccessDeniedException "AccessDeniedException"
-//   You do not have sufficient access to perform this action.
+// Returned Error Types:
 //
-//   * ErrCodeNoSuchResourceException "NoSuchResourceException"
-//   The specified resource does not exist.
+//   - AccessDeniedException
+//     You do not have sufficient permission to perform this action.
 //
-//   * ErrCodeIllegalArgumentException "IllegalArgumentException"
-//   Invalid input was provided.
+//   - NoSuchResourceException
+//     The specified resource does not exist.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   Something went wrong.
+//   - IllegalArgumentException
+//     Invalid input was provided.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Due to throttling, the request was denied. Slow down the rate of request
-//   calls, or request an increase for this quota.
+//   - ServiceException
+//     Something went wrong.
+//
+//   - TooManyRequestsException
+//     Due to throttling, the request was denied. Slow down the rate of request
+//     calls, or request an increase for this quota.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/GetRequestedServiceQuotaChange
 func (c *ServiceQuotas) GetRequestedServiceQuotaChange(input *GetRequestedServiceQuotaChangeInput) (*GetRequestedServiceQuotaChangeOutput, error) {
@@ -651,14 +640,13 @@ const opGetServiceQuota = "GetServiceQuota"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetServiceQuotaRequest method.
+//	req, resp := client.GetServiceQuotaRequest(params)
 //
-//    // Example sending a request using the GetServiceQuotaRequest method.
-//    req, resp := client.GetServiceQuotaRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/GetServiceQuota
 func (c *ServiceQuotas) GetServiceQuotaRequest(input *GetServiceQuotaInput) (req *request.Request, output *GetServiceQuotaOutput) {
@@ -679,10 +667,9 @@ func (c *ServiceQuotas) GetServiceQuotaRequest(input *GetServiceQuotaInput) (req
 
 // GetServiceQuota API operation for Service Quotas.
 //
-// Returns the details for the specified service quota. This operation provides
-// a different Value than the GetAWSDefaultServiceQuota operation. This operation
-// returns the applied value for each quota. GetAWSDefaultServiceQuota returns
-// the default AWS value for each quota.
+// Retrieves the applied quota value for the specified quota. For some quotas,
+// only the default values are available. If the applied quota value is not
+// available for a quota, the quota is not retrieved.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -691,22 +678,23 @@ func (c *ServiceQuotas) GetServiceQuotaRequest(input *GetServiceQuotaInput) (req
 // See the AWS API reference guide for Service Quotas's
 // API operation GetServiceQuota for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeAccessDeniedException "AccessDeniedException"
-//   You do not have sufficient access to perform this action.
+// Returned Error Types:
 //
-//   * ErrCodeNoSuchResourceException "NoSuchResourceException"
-//   The specified resource does not exist.
+//   - AccessDeniedException
+//     You do not have sufficient permission to perform this action.
 //
-//   * ErrCodeIllegalArgumentException "IllegalArgumentException"
-//   Invalid input was provided.
+//   - NoSuchResourceException
+//     The specified resource does not exist.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   Something went wrong.
+//   - IllegalArgumentException
+//     Invalid input was provided.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Due to throttling, the request was denied. Slow down the rate of request
-//   calls, or request an increase for this quota.
+//   - ServiceException
+//     Something went wrong.
+//
+//   - TooManyRequestsException
+//     Due to throttling, the request was denied. Slow down the rate of request
+//     calls, or request an increase for this quota.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/GetServiceQuota
 func (c *ServiceQuotas) GetServiceQuota(input *GetServiceQuotaInput) (*GetServiceQuotaOutput, error) {
@@ -746,14 +734,13 @@ const opGetServiceQuotaIncreaseRequestFromTemplate = "GetServiceQuotaIncreaseReq
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetServiceQuotaIncreaseRequestFromTemplateRequest method.
+//	req, resp := client.GetServiceQuotaIncreaseRequestFromTemplateRequest(params)
 //
-//    // Example sending a request using the GetServiceQuotaIncreaseRequestFromTemplateRequest method.
-//    req, resp := client.GetServiceQuotaIncreaseRequestFromTemplateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/GetServiceQuotaIncreaseRequestFromTemplate
 func (c *ServiceQuotas) GetServiceQuotaIncreaseRequestFromTemplateRequest(input *GetServiceQuotaIncreaseRequestFromTemplateInput) (req *request.Request, output *GetServiceQuotaIncreaseRequestFromTemplateOutput) {
@@ -774,7 +761,8 @@ func (c *ServiceQuotas) GetServiceQuotaIncreaseRequestFromTemplateRequest(input
 
 // GetServiceQuotaIncreaseRequestFromTemplate API operation for Service Quotas.
 //
-// Returns the details of the service quota increase request in your template.
+// Retrieves information about the specified quota increase request in your
+// quota request template.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -783,36 +771,37 @@ func (c *ServiceQuotas) GetServiceQuotaIncreaseRequestFromTemplateRequest(input
 // See the AWS API reference guide for Service Quotas's
 // API operation GetServiceQuotaIncreaseRequestFromTemplate for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeAccessDeniedException "AccessDeniedException"
-//   You do not have sufficient access to perform this action.
+// Returned Error Types:
 //
-//   * ErrCodeDependencyAccessDeniedException "DependencyAccessDeniedException"
-//   You can't perform this action because a dependency does not have access.
+//   - AccessDeniedException
+//     You do not have sufficient permission to perform this action.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   Something went wrong.
+//   - DependencyAccessDeniedException
+//     You can't perform this action because a dependency does not have access.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Due to throttling, the request was denied. Slow down the rate of request
-//   calls, or request an increase for this quota.
+//   - ServiceException
+//     Something went wrong.
 //
-//   * ErrCodeNoSuchResourceException "NoSuchResourceException"
-//   The specified resource does not exist.
+//   - TooManyRequestsException
+//     Due to throttling, the request was denied. Slow down the rate of request
+//     calls, or request an increase for this quota.
 //
-//   * ErrCodeIllegalArgumentException "IllegalArgumentException"
-//   Invalid input was provided.
+//   - NoSuchResourceException
+//     The specified resource does not exist.
 //
-//   * ErrCodeAWSServiceAccessNotEnabledException "AWSServiceAccessNotEnabledException"
-//   The action you attempted is not allowed unless Service Access with Service
-//   Quotas is enabled in your organization. To enable, call AssociateServiceQuotaTemplate.
+//   - IllegalArgumentException
+//     Invalid input was provided.
 //
-//   * ErrCodeTemplatesNotAvailableInRegionException "TemplatesNotAvailableInRegionException"
-//   The Service Quotas template is not available in the Region where you are
-//   making the request. Please make the request in us-east-1.
+//   - AWSServiceAccessNotEnabledException
+//     The action you attempted is not allowed unless Service Access with Service
+//     Quotas is enabled in your organization.
 //
-//   * ErrCodeNoAvailableOrganizationException "NoAvailableOrganizationException"
-//   The account making this call is not a member of an organization.
+//   - TemplatesNotAvailableInRegionException
+//     The Service Quotas template is not available in this Amazon Web Services
+//     Region.
+//
+//   - NoAvailableOrganizationException
+//     The Amazon Web Services account making this call is not a member of an organization.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/GetServiceQuotaIncreaseRequestFromTemplate
 func (c *ServiceQuotas) GetServiceQuotaIncreaseRequestFromTemplate(input *GetServiceQuotaIncreaseRequestFromTemplateInput) (*GetServiceQuotaIncreaseRequestFromTemplateOutput, error) {
@@ -852,14 +841,13 @@ const opListAWSDefaultServiceQuotas = "ListAWSDefaultServiceQuotas"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListAWSDefaultServiceQuotasRequest method.
+//	req, resp := client.ListAWSDefaultServiceQuotasRequest(params)
 //
-//    // Example sending a request using the ListAWSDefaultServiceQuotasRequest method.
-//    req, resp := client.ListAWSDefaultServiceQuotasRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/ListAWSDefaultServiceQuotas
 func (c *ServiceQuotas) ListAWSDefaultServiceQuotasRequest(input *ListAWSDefaultServiceQuotasInput) (req *request.Request, output *ListAWSDefaultServiceQuotasOutput) {
@@ -886,18 +874,8 @@ func (c *ServiceQuotas) ListAWSDefaultServiceQuotasRequest(input *ListAWSDefault
 
 // ListAWSDefaultServiceQuotas API operation for Service Quotas.
 //
-// Lists all default service quotas for the specified AWS service or all AWS
-// services. ListAWSDefaultServiceQuotas is similar to ListServiceQuotas except
-// for the Value object. The Value object returned by ListAWSDefaultServiceQuotas
-// is the default value assigned by AWS. This request returns a list of all
-// service quotas for the specified service. The listing of each you'll see
-// the default values are the values that AWS provides for the quotas.
-//
-// Always check the NextToken response parameter when calling any of the List*
-// operations. These operations can return an unexpected list of results, even
-// when there are more results available. When this happens, the NextToken response
-// parameter contains a value to pass the next call to the same API to request
-// the next part of the list.
+// Lists the default values for the quotas for the specified Amazon Web Service.
+// A default value does not reflect any quota increases.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -906,25 +884,26 @@ func (c *ServiceQuotas) ListAWSDefaultServiceQuotasRequest(input *ListAWSDefault
 // See the AWS API reference guide for Service Quotas's
 // API operation ListAWSDefaultServiceQuotas for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeAccessDeniedException "AccessDeniedException"
-//   You do not have sufficient access to perform this action.
+// Returned Error Types:
+//
+//   - AccessDeniedException
+//     You do not have sufficient permission to perform this action.
 //
-//   * ErrCodeNoSuchResourceException "NoSuchResourceException"
-//   The specified resource does not exist.
+//   - NoSuchResourceException
+//     The specified resource does not exist.
 //
-//   * ErrCodeIllegalArgumentException "IllegalArgumentException"
-//   Invalid input was provided.
+//   - IllegalArgumentException
+//     Invalid input was provided.
 //
-//   * ErrCodeInvalidPaginationTokenException "InvalidPaginationTokenException"
-//   Invalid input was provided.
+//   - InvalidPaginationTokenException
+//     Invalid input was provided.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   Something went wrong.
+//   - ServiceException
+//     Something went wrong.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Due to throttling, the request was denied. Slow down the rate of request
-//   calls, or request an increase for this quota.
+//   - TooManyRequestsException
+//     Due to throttling, the request was denied. Slow down the rate of request
+//     calls, or request an increase for this quota.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/ListAWSDefaultServiceQuotas
 func (c *ServiceQuotas) ListAWSDefaultServiceQuotas(input *ListAWSDefaultServiceQuotasInput) (*ListAWSDefaultServiceQuotasOutput, error) {
@@ -956,15 +935,14 @@ func (c *ServiceQuotas) ListAWSDefaultServiceQuotasWithContext(ctx aws.Context,
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListAWSDefaultServiceQuotas operation.
-//    pageNum := 0
-//    err := client.ListAWSDefaultServiceQuotasPages(params,
-//        func(page *servicequotas.ListAWSDefaultServiceQuotasOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListAWSDefaultServiceQuotas operation.
+//	pageNum := 0
+//	err := client.ListAWSDefaultServiceQuotasPages(params,
+//	    func(page *servicequotas.ListAWSDefaultServiceQuotasOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServiceQuotas) ListAWSDefaultServiceQuotasPages(input *ListAWSDefaultServiceQuotasInput, fn func(*ListAWSDefaultServiceQuotasOutput, bool) bool) error {
 	return c.ListAWSDefaultServiceQuotasPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -991,10 +969,12 @@ func (c *ServiceQuotas) ListAWSDefaultServiceQuotasPagesWithContext(ctx aws.Cont
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListAWSDefaultServiceQuotasOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListAWSDefaultServiceQuotasOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -1014,14 +994,13 @@ const opListRequestedServiceQuotaChangeHistory = "ListRequestedServiceQuotaChang
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListRequestedServiceQuotaChangeHistoryRequest method.
+//	req, resp := client.ListRequestedServiceQuotaChangeHistoryRequest(params)
 //
-//    // Example sending a request using the ListRequestedServiceQuotaChangeHistoryRequest method.
-//    req, resp := client.ListRequestedServiceQuotaChangeHistoryRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/ListRequestedServiceQuotaChangeHistory
 func (c *ServiceQuotas) ListRequestedServiceQuotaChangeHistoryRequest(input *ListRequestedServiceQuotaChangeHistoryInput) (req *request.Request, output *ListRequestedServiceQuotaChangeHistoryOutput) {
@@ -1048,7 +1027,7 @@ func (c *ServiceQuotas) ListRequestedServiceQuotaChangeHistoryRequest(input *Lis
 
 // ListRequestedServiceQuotaChangeHistory API operation for Service Quotas.
 //
-// Requests a list of the changes to quotas for a service.
+// Retrieves the quota increase requests for the specified Amazon Web Service.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1057,25 +1036,26 @@ func (c *ServiceQuotas) ListRequestedServiceQuotaChangeHistoryRequest(input *Lis
 // See the AWS API reference guide for Service Quotas's
 // API operation ListRequestedServiceQuotaChangeHistory for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeAccessDeniedException "AccessDeniedException"
-//   You do not have sufficient access to perform this action.
+// Returned Error Types:
+//
+//   - AccessDeniedException
+//     You do not have sufficient permission to perform this action.
 //
-//   * ErrCodeNoSuchResourceException "NoSuchResourceException"
-//   The specified resource does not exist.
+//   - NoSuchResourceException
+//     The specified resource does not exist.
 //
-//   * ErrCodeIllegalArgumentException "IllegalArgumentException"
-//   Invalid input was provided.
+//   - IllegalArgumentException
+//     Invalid input was provided.
 //
-//   * ErrCodeInvalidPaginationTokenException "InvalidPaginationTokenException"
-//   Invalid input was provided.
+//   - InvalidPaginationTokenException
+//     Invalid input was provided.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   Something went wrong.
+//   - ServiceException
+//     Something went wrong.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Due to throttling, the request was denied. Slow down the rate of request
-//   calls, or request an increase for this quota.
+//   - TooManyRequestsException
+//     Due to throttling, the request was denied. Slow down the rate of request
+//     calls, or request an increase for this quota.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/ListRequestedServiceQuotaChangeHistory
 func (c *ServiceQuotas) ListRequestedServiceQuotaChangeHistory(input *ListRequestedServiceQuotaChangeHistoryInput) (*ListRequestedServiceQuotaChangeHistoryOutput, error) {
@@ -1107,15 +1087,14 @@ func (c *ServiceQuotas) ListRequestedServiceQuotaChangeHistoryWithContext(ctx aw
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListRequestedServiceQuotaChangeHistory operation.
-//    pageNum := 0
-//    err := client.ListRequestedServiceQuotaChangeHistoryPages(params,
-//        func(page *servicequotas.ListRequestedServiceQuotaChangeHistoryOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListRequestedServiceQuotaChangeHistory operation.
+//	pageNum := 0
+//	err := client.ListRequestedServiceQuotaChangeHistoryPages(params,
+//	    func(page *servicequotas.ListRequestedServiceQuotaChangeHistoryOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServiceQuotas) ListRequestedServiceQuotaChangeHistoryPages(input *ListRequestedServiceQuotaChangeHistoryInput, fn func(*ListRequestedServiceQuotaChangeHistoryOutput, bool) bool) error {
 	return c.ListRequestedServiceQuotaChangeHistoryPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1142,10 +1121,12 @@ func (c *ServiceQuotas) ListRequestedServiceQuotaChangeHistoryPagesWithContext(c
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListRequestedServiceQuotaChangeHistoryOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListRequestedServiceQuotaChangeHistoryOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -1165,14 +1146,13 @@ const opListRequestedServiceQuotaChangeHistoryByQuota = "ListRequestedServiceQuo
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListRequestedServiceQuotaChangeHistoryByQuotaRequest method.
+//	req, resp := client.ListRequestedServiceQuotaChangeHistoryByQuotaRequest(params)
 //
-//    // Example sending a request using the ListRequestedServiceQuotaChangeHistoryByQuotaRequest method.
-//    req, resp := client.ListRequestedServiceQuotaChangeHistoryByQuotaRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/ListRequestedServiceQuotaChangeHistoryByQuota
 func (c *ServiceQuotas) ListRequestedServiceQuotaChangeHistoryByQuotaRequest(input *ListRequestedServiceQuotaChangeHistoryByQuotaInput) (req *request.Request, output *ListRequestedServiceQuotaChangeHistoryByQuotaOutput) {
@@ -1199,10 +1179,7 @@ func (c *ServiceQuotas) ListRequestedServiceQuotaChangeHistoryByQuotaRequest(inp
 
 // ListRequestedServiceQuotaChangeHistoryByQuota API operation for Service Quotas.
 //
-// Requests a list of the changes to specific service quotas. This command provides
-// additional granularity over the ListRequestedServiceQuotaChangeHistory command.
-// Once a quota change request has reached CASE_CLOSED, APPROVED, or DENIED,
-// the history has been kept for 90 days.
+// Retrieves the quota increase requests for the specified quota.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1211,25 +1188,26 @@ func (c *ServiceQuotas) ListRequestedServiceQuotaChangeHistoryByQuotaRequest(inp
 // See the AWS API reference guide for Service Quotas's
 // API operation ListRequestedServiceQuotaChangeHistoryByQuota for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeAccessDeniedException "AccessDeniedException"
-//   You do not have sufficient access to perform this action.
+// Returned Error Types:
+//
+//   - AccessDeniedException
+//     You do not have sufficient permission to perform this action.
 //
-//   * ErrCodeNoSuchResourceException "NoSuchResourceException"
-//   The specified resource does not exist.
+//   - NoSuchResourceException
+//     The specified resource does not exist.
 //
-//   * ErrCodeIllegalArgumentException "IllegalArgumentException"
-//   Invalid input was provided.
+//   - IllegalArgumentException
+//     Invalid input was provided.
 //
-//   * ErrCodeInvalidPaginationTokenException "InvalidPaginationTokenException"
-//   Invalid input was provided.
+//   - InvalidPaginationTokenException
+//     Invalid input was provided.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   Something went wrong.
+//   - ServiceException
+//     Something went wrong.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Due to throttling, the request was denied. Slow down the rate of request
-//   calls, or request an increase for this quota.
+//   - TooManyRequestsException
+//     Due to throttling, the request was denied. Slow down the rate of request
+//     calls, or request an increase for this quota.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/ListRequestedServiceQuotaChangeHistoryByQuota
 func (c *ServiceQuotas) ListRequestedServiceQuotaChangeHistoryByQuota(input *ListRequestedServiceQuotaChangeHistoryByQuotaInput) (*ListRequestedServiceQuotaChangeHistoryByQuotaOutput, error) {
@@ -1261,15 +1239,14 @@ func (c *ServiceQuotas) ListRequestedServiceQuotaChangeHistoryByQuotaWithContext
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListRequestedServiceQuotaChangeHistoryByQuota operation.
-//    pageNum := 0
-//    err := client.ListRequestedServiceQuotaChangeHistoryByQuotaPages(params,
-//        func(page *servicequotas.ListRequestedServiceQuotaChangeHistoryByQuotaOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListRequestedServiceQuotaChangeHistoryByQuota operation.
+//	pageNum := 0
+//	err := client.ListRequestedServiceQuotaChangeHistoryByQuotaPages(params,
+//	    func(page *servicequotas.ListRequestedServiceQuotaChangeHistoryByQuotaOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServiceQuotas) ListRequestedServiceQuotaChangeHistoryByQuotaPages(input *ListRequestedServiceQuotaChangeHistoryByQuotaInput, fn func(*ListRequestedServiceQuotaChangeHistoryByQuotaOutput, bool) bool) error {
 	return c.ListRequestedServiceQuotaChangeHistoryByQuotaPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1296,10 +1273,12 @@ func (c *ServiceQuotas) ListRequestedServiceQuotaChangeHistoryByQuotaPagesWithCo
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListRequestedServiceQuotaChangeHistoryByQuotaOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListRequestedServiceQuotaChangeHistoryByQuotaOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -1319,14 +1298,13 @@ const opListServiceQuotaIncreaseRequestsInTemplate = "ListServiceQuotaIncreaseRe
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListServiceQuotaIncreaseRequestsInTemplateRequest method.
+//	req, resp := client.ListServiceQuotaIncreaseRequestsInTemplateRequest(params)
 //
-//    // Example sending a request using the ListServiceQuotaIncreaseRequestsInTemplateRequest method.
-//    req, resp := client.ListServiceQuotaIncreaseRequestsInTemplateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/ListServiceQuotaIncreaseRequestsInTemplate
 func (c *ServiceQuotas) ListServiceQuotaIncreaseRequestsInTemplateRequest(input *ListServiceQuotaIncreaseRequestsInTemplateInput) (req *request.Request, output *ListServiceQuotaIncreaseRequestsInTemplateOutput) {
@@ -1353,7 +1331,7 @@ func (c *ServiceQuotas) ListServiceQuotaIncreaseRequestsInTemplateRequest(input
 
 // ListServiceQuotaIncreaseRequestsInTemplate API operation for Service Quotas.
 //
-// Returns a list of the quota increase requests in the template.
+// Lists the quota increase requests in the specified quota request template.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1362,33 +1340,34 @@ func (c *ServiceQuotas) ListServiceQuotaIncreaseRequestsInTemplateRequest(input
 // See the AWS API reference guide for Service Quotas's
 // API operation ListServiceQuotaIncreaseRequestsInTemplate for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeAccessDeniedException "AccessDeniedException"
-//   You do not have sufficient access to perform this action.
+// Returned Error Types:
+//
+//   - AccessDeniedException
+//     You do not have sufficient permission to perform this action.
 //
-//   * ErrCodeDependencyAccessDeniedException "DependencyAccessDeniedException"
-//   You can't perform this action because a dependency does not have access.
+//   - DependencyAccessDeniedException
+//     You can't perform this action because a dependency does not have access.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   Something went wrong.
+//   - ServiceException
+//     Something went wrong.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Due to throttling, the request was denied. Slow down the rate of request
-//   calls, or request an increase for this quota.
+//   - TooManyRequestsException
+//     Due to throttling, the request was denied. Slow down the rate of request
+//     calls, or request an increase for this quota.
 //
-//   * ErrCodeIllegalArgumentException "IllegalArgumentException"
-//   Invalid input was provided.
+//   - IllegalArgumentException
+//     Invalid input was provided.
 //
-//   * ErrCodeAWSServiceAccessNotEnabledException "AWSServiceAccessNotEnabledException"
-//   The action you attempted is not allowed unless Service Access with Service
-//   Quotas is enabled in your organization. To enable, call AssociateServiceQuotaTemplate.
+//   - AWSServiceAccessNotEnabledException
+//     The action you attempted is not allowed unless Service Access with Service
+//     Quotas is enabled in your organization.
 //
-//   * ErrCodeTemplatesNotAvailableInRegionException "TemplatesNotAvailableInRegionException"
-//   The Service Quotas template is not available in the Region where you are
-//   making the request. Please make the request in us-east-1.
+//   - TemplatesNotAvailableInRegionException
+//     The Service Quotas template is not available in this Amazon Web Services
+//     Region.
 //
-//   * ErrCodeNoAvailableOrganizationException "NoAvailableOrganizationException"
-//   The account making this call is not a member of an organization.
+//   - NoAvailableOrganizationException
+//     The Amazon Web Services account making this call is not a member of an organization.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/ListServiceQuotaIncreaseRequestsInTemplate
 func (c *ServiceQuotas) ListServiceQuotaIncreaseRequestsInTemplate(input *ListServiceQuotaIncreaseRequestsInTemplateInput) (*ListServiceQuotaIncreaseRequestsInTemplateOutput, error) {
@@ -1420,15 +1399,14 @@ func (c *ServiceQuotas) ListServiceQuotaIncreaseRequestsInTemplateWithContext(ct
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListServiceQuotaIncreaseRequestsInTemplate operation.
-//    pageNum := 0
-//    err := client.ListServiceQuotaIncreaseRequestsInTemplatePages(params,
-//        func(page *servicequotas.ListServiceQuotaIncreaseRequestsInTemplateOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListServiceQuotaIncreaseRequestsInTemplate operation.
+//	pageNum := 0
+//	err := client.ListServiceQuotaIncreaseRequestsInTemplatePages(params,
+//	    func(page *servicequotas.ListServiceQuotaIncreaseRequestsInTemplateOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServiceQuotas) ListServiceQuotaIncreaseRequestsInTemplatePages(input *ListServiceQuotaIncreaseRequestsInTemplateInput, fn func(*ListServiceQuotaIncreaseRequestsInTemplateOutput, bool) bool) error {
 	return c.ListServiceQuotaIncreaseRequestsInTemplatePagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1455,10 +1433,12 @@ func (c *ServiceQuotas) ListServiceQuotaIncreaseRequestsInTemplatePagesWithConte
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListServiceQuotaIncreaseRequestsInTemplateOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListServiceQuotaIncreaseRequestsInTemplateOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -1478,14 +1458,13 @@ const opListServiceQuotas = "ListServiceQuotas"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListServiceQuotasRequest method.
+//	req, resp := client.ListServiceQuotasRequest(params)
 //
-//    // Example sending a request using the ListServiceQuotasRequest method.
-//    req, resp := client.ListServiceQuotasRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/ListServiceQuotas
 func (c *ServiceQuotas) ListServiceQuotasRequest(input *ListServiceQuotasInput) (req *request.Request, output *ListServiceQuotasOutput) {
@@ -1512,15 +1491,9 @@ func (c *ServiceQuotas) ListServiceQuotasRequest(input *ListServiceQuotasInput)
 
 // ListServiceQuotas API operation for Service Quotas.
 //
-// Lists all service quotas for the specified AWS service. This request returns
-// a list of the service quotas for the specified service. you'll see the default
-// values are the values that AWS provides for the quotas.
-//
-// Always check the NextToken response parameter when calling any of the List*
-// operations. These operations can return an unexpected list of results, even
-// when there are more results available. When this happens, the NextToken response
-// parameter contains a value to pass the next call to the same API to request
-// the next part of the list.
+// Lists the applied quota values for the specified Amazon Web Service. For
+// some quotas, only the default values are available. If the applied quota
+// value is not available for a quota, the quota is not retrieved.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1529,25 +1502,26 @@ func (c *ServiceQuotas) ListServiceQuotasRequest(input *ListServiceQuotasInput)
 // See the AWS API reference guide for Service Quotas's
 // API operation ListServiceQuotas for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeAccessDeniedException "AccessDeniedException"
-//   You do not have sufficient access to perform this action.
+// Returned Error Types:
 //
-//   * ErrCodeNoSuchResourceException "NoSuchResourceException"
-//   The specified resource does not exist.
+//   - AccessDeniedException
+//     You do not have sufficient permission to perform this action.
 //
-//   * ErrCodeIllegalArgumentException "IllegalArgumentException"
-//   Invalid input was provided.
+//   - NoSuchResourceException
+//     The specified resource does not exist.
 //
-//   * ErrCodeInvalidPaginationTokenException "InvalidPaginationTokenException"
-//   Invalid input was provided.
+//   - IllegalArgumentException
+//     Invalid input was provided.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   Something went wrong.
+//   - InvalidPaginationTokenException
+//     Invalid input was provided.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Due to throttling, the request was denied. Slow down the rate of request
-//   calls, or request an increase for this quota.
+//   - ServiceException
+//     Something went wrong.
+//
+//   - TooManyRequestsException
+//     Due to throttling, the request was denied. Slow down the rate of request
+//     calls, or request an increase for this quota.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/ListServiceQuotas
 func (c *ServiceQuotas) ListServiceQuotas(input *ListServiceQuotasInput) (*ListServiceQuotasOutput, error) {
@@ -1579,15 +1553,14 @@ func (c *ServiceQuotas) ListServiceQuotasWithContext(ctx aws.Context, input *Lis
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListServiceQuotas operation.
-//    pageNum := 0
-//    err := client.ListServiceQuotasPages(params,
-//        func(page *servicequotas.ListServiceQuotasOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListServiceQuotas operation.
+//	pageNum := 0
+//	err := client.ListServiceQuotasPages(params,
+//	    func(page *servicequotas.ListServiceQuotasOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServiceQuotas) ListServiceQuotasPages(input *ListServiceQuotasInput, fn func(*ListServiceQuotasOutput, bool) bool) error {
 	return c.ListServiceQuotasPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1614,10 +1587,12 @@ func (c *ServiceQuotas) ListServiceQuotasPagesWithContext(ctx aws.Context, input
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListServiceQuotasOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListServiceQuotasOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -1637,14 +1612,13 @@ const opListServices = "ListServices"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListServicesRequest method.
+//	req, resp := client.ListServicesRequest(params)
 //
-//    // Example sending a request using the ListServicesRequest method.
-//    req, resp := client.ListServicesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/ListServices
 func (c *ServiceQuotas) ListServicesRequest(input *ListServicesInput) (req *request.Request, output *ListServicesOutput) {
@@ -1671,9 +1645,8 @@ func (c *ServiceQuotas) ListServicesRequest(input *ListServicesInput) (req *requ
 
 // ListServices API operation for Service Quotas.
 //
-// Lists the AWS services available in Service Quotas. Not all AWS services
-// are available in Service Quotas. To list the see the list of the service
-// quotas for a specific service, use ListServiceQuotas.
+// Lists the names and codes for the Amazon Web Services integrated with Service
+// Quotas.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1682,22 +1655,23 @@ func (c *ServiceQuotas) ListServicesRequest(input *ListServicesInput) (req *requ
 // See the AWS API reference guide for Service Quotas's
 // API operation ListServices for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeAccessDeniedException "AccessDeniedException"
-//   You do not have sufficient access to perform this action.
+// Returned Error Types:
 //
-//   * ErrCodeIllegalArgumentException "IllegalArgumentException"
-//   Invalid input was provided.
+//   - AccessDeniedException
+//     You do not have sufficient permission to perform this action.
 //
-//   * ErrCodeInvalidPaginationTokenException "InvalidPaginationTokenException"
-//   Invalid input was provided.
+//   - IllegalArgumentException
+//     Invalid input was provided.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   Something went wrong.
+//   - InvalidPaginationTokenException
+//     Invalid input was provided.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Due to throttling, the request was denied. Slow down the rate of request
-//   calls, or request an increase for this quota.
+//   - ServiceException
+//     Something went wrong.
+//
+//   - TooManyRequestsException
+//     Due to throttling, the request was denied. Slow down the rate of request
+//     calls, or request an increase for this quota.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/ListServices
 func (c *ServiceQuotas) ListServices(input *ListServicesInput) (*ListServicesOutput, error) {
@@ -1729,15 +1703,14 @@ func (c *ServiceQuotas) ListServicesWithContext(ctx aws.Context, input *ListServ
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListServices operation.
-//    pageNum := 0
-//    err := client.ListServicesPages(params,
-//        func(page *servicequotas.ListServicesOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListServices operation.
+//	pageNum := 0
+//	err := client.ListServicesPages(params,
+//	    func(page *servicequotas.ListServicesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *ServiceQuotas) ListServicesPages(input *ListServicesInput, fn func(*ListServicesOutput, bool) bool) error {
 	return c.ListServicesPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1764,13 +1737,107 @@ func (c *ServiceQuotas) ListServicesPagesWithContext(ctx aws.Context, input *Lis
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListServicesOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListServicesOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
+const opListTagsForResource = "ListTagsForResource"
+
+// ListTagsForResourceRequest generates a "aws/request.Request" representing the
+// client's request for the ListTagsForResource operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListTagsForResource for more information on using the ListTagsForResource
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListTagsForResourceRequest method.
+//	req, resp := client.ListTagsForResourceRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/ListTagsForResource
+func (c *ServiceQuotas) ListTagsForResourceRequest(input *ListTagsForResourceInput) (req *request.Request, output *ListTagsForResourceOutput) {
+	op := &request.Operation{
+		Name:       opListTagsForResource,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &ListTagsForResourceInput{}
+	}
+
+	output = &ListTagsForResourceOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListTagsForResource API operation for Service Quotas.
+//
+// Returns a list of the tags assigned to the specified applied quota.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Service Quotas's
+// API operation ListTagsForResource for usage and error information.
+//
+// Returned Error Types:
+//
+//   - TooManyRequestsException
+//     Due to throttling, the request was denied. Slow down the rate of request
+//     calls, or request an increase for this quota.
+//
+//   - NoSuchResourceException
+//     The specified resource does not exist.
+//
+//   - IllegalArgumentException
+//     Invalid input was provided.
+//
+//   - AccessDeniedException
+//     You do not have sufficient permission to perform this action.
+//
+//   - ServiceException
+//     Something went wrong.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/ListTagsForResource
+func (c *ServiceQuotas) ListTagsForResource(input *ListTagsForResourceInput) (*ListTagsForResourceOutput, error) {
+	req, out := c.ListTagsForResourceRequest(input)
+	return out, req.Send()
+}
+
+// ListTagsForResourceWithContext is the same as ListTagsForResource with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListTagsForResource for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *ServiceQuotas) ListTagsForResourceWithContext(ctx aws.Context, input *ListTagsForResourceInput, opts ...request.Option) (*ListTagsForResourceOutput, error) {
+	req, out := c.ListTagsForResourceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opPutServiceQuotaIncreaseRequestIntoTemplate = "PutServiceQuotaIncreaseRequestIntoTemplate"
 
 // PutServiceQuotaIncreaseRequestIntoTemplateRequest generates a "aws/request.Request" representing the
@@ -1787,14 +1854,13 @@ const opPutServiceQuotaIncreaseRequestIntoTemplate = "PutServiceQuotaIncreaseReq
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PutServiceQuotaIncreaseRequestIntoTemplateRequest method.
+//	req, resp := client.PutServiceQuotaIncreaseRequestIntoTemplateRequest(params)
 //
-//    // Example sending a request using the PutServiceQuotaIncreaseRequestIntoTemplateRequest method.
-//    req, resp := client.PutServiceQuotaIncreaseRequestIntoTemplateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/PutServiceQuotaIncreaseRequestIntoTemplate
 func (c *ServiceQuotas) PutServiceQuotaIncreaseRequestIntoTemplateRequest(input *PutServiceQuotaIncreaseRequestIntoTemplateInput) (req *request.Request, output *PutServiceQuotaIncreaseRequestIntoTemplateOutput) {
@@ -1815,10 +1881,7 @@ func (c *ServiceQuotas) PutServiceQuotaIncreaseRequestIntoTemplateRequest(input
 
 // PutServiceQuotaIncreaseRequestIntoTemplate API operation for Service Quotas.
 //
-// Defines and adds a quota to the service quota template. To add a quota to
-// the template, you must provide the ServiceCode, QuotaCode, AwsRegion, and
-// DesiredValue. Once you add a quota to the template, use ListServiceQuotaIncreaseRequestsInTemplate
-// to see the list of quotas in the template.
+// Adds a quota increase request to your quota request template.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1827,41 +1890,42 @@ func (c *ServiceQuotas) PutServiceQuotaIncreaseRequestIntoTemplateRequest(input
 // See the AWS API reference guide for Service Quotas's
 // API operation PutServiceQuotaIncreaseRequestIntoTemplate for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeAccessDeniedException "AccessDeniedException"
-//   You do not have sufficient access to perform this action.
+// Returned Error Types:
+//
+//   - AccessDeniedException
+//     You do not have sufficient permission to perform this action.
 //
-//   * ErrCodeDependencyAccessDeniedException "DependencyAccessDeniedException"
-//   You can't perform this action because a dependency does not have access.
+//   - DependencyAccessDeniedException
+//     You can't perform this action because a dependency does not have access.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   Something went wrong.
+//   - ServiceException
+//     Something went wrong.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Due to throttling, the request was denied. Slow down the rate of request
-//   calls, or request an increase for this quota.
+//   - TooManyRequestsException
+//     Due to throttling, the request was denied. Slow down the rate of request
+//     calls, or request an increase for this quota.
 //
-//   * ErrCodeIllegalArgumentException "IllegalArgumentException"
-//   Invalid input was provided.
+//   - IllegalArgumentException
+//     Invalid input was provided.
 //
-//   * ErrCodeQuotaExceededException "QuotaExceededException"
-//   You have exceeded your service quota. To perform the requested action, remove
-//   some of the relevant resources, or use Service Quotas to request a service
-//   quota increase.
+//   - QuotaExceededException
+//     You have exceeded your service quota. To perform the requested action, remove
+//     some of the relevant resources, or use Service Quotas to request a service
+//     quota increase.
 //
-//   * ErrCodeNoSuchResourceException "NoSuchResourceException"
-//   The specified resource does not exist.
+//   - NoSuchResourceException
+//     The specified resource does not exist.
 //
-//   * ErrCodeAWSServiceAccessNotEnabledException "AWSServiceAccessNotEnabledException"
-//   The action you attempted is not allowed unless Service Access with Service
-//   Quotas is enabled in your organization. To enable, call AssociateServiceQuotaTemplate.
+//   - AWSServiceAccessNotEnabledException
+//     The action you attempted is not allowed unless Service Access with Service
+//     Quotas is enabled in your organization.
 //
-//   * ErrCodeTemplatesNotAvailableInRegionException "TemplatesNotAvailableInRegionException"
-//   The Service Quotas template is not available in the Region where you are
-//   making the request. Please make the request in us-east-1.
+//   - TemplatesNotAvailableInRegionException
+//     The Service Quotas template is not available in this Amazon Web Services
+//     Region.
 //
-//   * ErrCodeNoAvailableOrganizationException "NoAvailableOrganizationException"
-//   The account making this call is not a member of an organization.
+//   - NoAvailableOrganizationException
+//     The Amazon Web Services account making this call is not a member of an organization.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/PutServiceQuotaIncreaseRequestIntoTemplate
 func (c *ServiceQuotas) PutServiceQuotaIncreaseRequestIntoTemplate(input *PutServiceQuotaIncreaseRequestIntoTemplateInput) (*PutServiceQuotaIncreaseRequestIntoTemplateOutput, error) {
@@ -1901,14 +1965,13 @@ const opRequestServiceQuotaIncrease = "RequestServiceQuotaIncrease"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the RequestServiceQuotaIncreaseRequest method.
+//	req, resp := client.RequestServiceQuotaIncreaseRequest(params)
 //
-//    // Example sending a request using the RequestServiceQuotaIncreaseRequest method.
-//    req, resp := client.RequestServiceQuotaIncreaseRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/RequestServiceQuotaIncrease
 func (c *ServiceQuotas) RequestServiceQuotaIncreaseRequest(input *RequestServiceQuotaIncreaseInput) (req *request.Request, output *RequestServiceQuotaIncreaseOutput) {
@@ -1929,8 +1992,7 @@ func (c *ServiceQuotas) RequestServiceQuotaIncreaseRequest(input *RequestService
 
 // RequestServiceQuotaIncrease API operation for Service Quotas.
 //
-// Retrieves the details of a service quota increase request. The response to
-// this command provides the details in the RequestedServiceQuotaChange object.
+// Submits a quota increase request for the specified quota.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1939,36 +2001,37 @@ func (c *ServiceQuotas) RequestServiceQuotaIncreaseRequest(input *RequestService
 // See the AWS API reference guide for Service Quotas's
 // API operation RequestServiceQuotaIncrease for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeDependencyAccessDeniedException "DependencyAccessDeniedException"
-//   You can't perform this action because a dependency does not have access.
+// Returned Error Types:
+//
+//   - DependencyAccessDeniedException
+//     You can't perform this action because a dependency does not have access.
 //
-//   * ErrCodeQuotaExceededException "QuotaExceededException"
-//   You have exceeded your service quota. To perform the requested action, remove
-//   some of the relevant resources, or use Service Quotas to request a service
-//   quota increase.
+//   - QuotaExceededException
+//     You have exceeded your service quota. To perform the requested action, remove
+//     some of the relevant resources, or use Service Quotas to request a service
+//     quota increase.
 //
-//   * ErrCodeResourceAlreadyExistsException "ResourceAlreadyExistsException"
-//   The specified resource already exists.
+//   - ResourceAlreadyExistsException
+//     The specified resource already exists.
 //
-//   * ErrCodeAccessDeniedException "AccessDeniedException"
-//   You do not have sufficient access to perform this action.
+//   - AccessDeniedException
+//     You do not have sufficient permission to perform this action.
 //
-//   * ErrCodeNoSuchResourceException "NoSuchResourceException"
-//   The specified resource does not exist.
+//   - NoSuchResourceException
+//     The specified resource does not exist.
 //
-//   * ErrCodeIllegalArgumentException "IllegalArgumentException"
-//   Invalid input was provided.
+//   - IllegalArgumentException
+//     Invalid input was provided.
 //
-//   * ErrCodeInvalidResourceStateException "InvalidResourceStateException"
-//   Invalid input was provided for the .
+//   - InvalidResourceStateException
+//     The resource is in an invalid state.
 //
-//   * ErrCodeServiceException "ServiceException"
-//   Something went wrong.
+//   - ServiceException
+//     Something went wrong.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   Due to throttling, the request was denied. Slow down the rate of request
-//   calls, or request an increase for this quota.
+//   - TooManyRequestsException
+//     Due to throttling, the request was denied. Slow down the rate of request
+//     calls, or request an increase for this quota.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/RequestServiceQuotaIncrease
 func (c *ServiceQuotas) RequestServiceQuotaIncrease(input *RequestServiceQuotaIncreaseInput) (*RequestServiceQuotaIncreaseOutput, error) {
@@ -1992,66 +2055,418 @@ func (c *ServiceQuotas) RequestServiceQuotaIncreaseWithContext(ctx aws.Context,
 	return out, req.Send()
 }
 
-type AssociateServiceQuotaTemplateInput struct {
-	_ struct{} `type:"structure"`
+const opTagResource = "TagResource"
+
+// TagResourceRequest generates a "aws/request.Request" representing the
+// client's request for the TagResource operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See TagResource for more information on using the TagResource
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the TagResourceRequest method.
+//	req, resp := client.TagResourceRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/TagResource
+func (c *ServiceQuotas) TagResourceRequest(input *TagResourceInput) (req *request.Request, output *TagResourceOutput) {
+	op := &request.Operation{
+		Name:       opTagResource,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &TagResourceInput{}
+	}
+
+	output = &TagResourceOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
 }
 
-// String returns the string representation
-func (s AssociateServiceQuotaTemplateInput) String() string {
-	return awsutil.Prettify(s)
+// TagResource API operation for Service Quotas.
+//
+// Adds tags to the specified applied quota. You can include one or more tags
+// to add to the quota.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Service Quotas's
+// API operation TagResource for usage and error information.
+//
+// Returned Error Types:
+//
+//   - TooManyRequestsException
+//     Due to throttling, the request was denied. Slow down the rate of request
+//     calls, or request an increase for this quota.
+//
+//   - NoSuchResourceException
+//     The specified resource does not exist.
+//
+//   - TooManyTagsException
+//     You've exceeded the number of tags allowed for a resource. For more information,
+//     see Tag restrictions (https://docs.aws.amazon.com/servicequotas/latest/userguide/sq-tagging.html#sq-tagging-restrictions)
+//     in the Service Quotas User Guide.
+//
+//   - TagPolicyViolationException
+//     The specified tag is a reserved word and cannot be used.
+//
+//   - IllegalArgumentException
+//     Invalid input was provided.
+//
+//   - AccessDeniedException
+//     You do not have sufficient permission to perform this action.
+//
+//   - ServiceException
+//     Something went wrong.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/TagResource
+func (c *ServiceQuotas) TagResource(input *TagResourceInput) (*TagResourceOutput, error) {
+	req, out := c.TagResourceRequest(input)
+	return out, req.Send()
 }
 
-// GoString returns the string representation
-func (s AssociateServiceQuotaTemplateInput) GoString() string {
-	return s.String()
+// TagResourceWithContext is the same as TagResource with the addition of
+// the ability to pass a context and additional request options.
+//
+// See TagResource for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *ServiceQuotas) TagResourceWithContext(ctx aws.Context, input *TagResourceInput, opts ...request.Option) (*TagResourceOutput, error) {
+	req, out := c.TagResourceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
 }
 
-type AssociateServiceQuotaTemplateOutput struct {
-	_ struct{} `type:"structure"`
+const opUntagResource = "UntagResource"
+
+// UntagResourceRequest generates a "aws/request.Request" representing the
+// client's request for the UntagResource operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UntagResource for more information on using the UntagResource
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UntagResourceRequest method.
+//	req, resp := client.UntagResourceRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/UntagResource
+func (c *ServiceQuotas) UntagResourceRequest(input *UntagResourceInput) (req *request.Request, output *UntagResourceOutput) {
+	op := &request.Operation{
+		Name:       opUntagResource,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &UntagResourceInput{}
+	}
+
+	output = &UntagResourceOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(jsonrpc.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
 }
 
-// String returns the string representation
-func (s AssociateServiceQuotaTemplateOutput) String() string {
+// UntagResource API operation for Service Quotas.
+//
+// Removes tags from the specified applied quota. You can specify one or more
+// tags to remove.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Service Quotas's
+// API operation UntagResource for usage and error information.
+//
+// Returned Error Types:
+//
+//   - TooManyRequestsException
+//     Due to throttling, the request was denied. Slow down the rate of request
+//     calls, or request an increase for this quota.
+//
+//   - NoSuchResourceException
+//     The specified resource does not exist.
+//
+//   - IllegalArgumentException
+//     Invalid input was provided.
+//
+//   - AccessDeniedException
+//     You do not have sufficient permission to perform this action.
+//
+//   - ServiceException
+//     Something went wrong.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/service-quotas-2019-06-24/UntagResource
+func (c *ServiceQuotas) UntagResource(input *UntagResourceInput) (*UntagResourceOutput, error) {
+	req, out := c.UntagResourceRequest(input)
+	return out, req.Send()
+}
+
+// UntagResourceWithContext is the same as UntagResource with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UntagResource for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *ServiceQuotas) UntagResourceWithContext(ctx aws.Context, input *UntagResourceInput, opts ...request.Option) (*UntagResourceOutput, error) {
+	req, out := c.UntagResourceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+// The action you attempted is not allowed unless Service Access with Service
+// Quotas is enabled in your organization.
+type AWSServiceAccessNotEnabledException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AWSServiceAccessNotEnabledException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s AssociateServiceQuotaTemplateOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AWSServiceAccessNotEnabledException) GoString() string {
 	return s.String()
 }
 
-type DeleteServiceQuotaIncreaseRequestFromTemplateInput struct {
-	_ struct{} `type:"structure"`
+func newErrorAWSServiceAccessNotEnabledException(v protocol.ResponseMetadata) error {
+	return &AWSServiceAccessNotEnabledException{
+		RespMetadata: v,
+	}
+}
 
-	// Specifies the AWS Region for the quota that you want to delete.
-	//
-	// AwsRegion is a required field
-	AwsRegion *string `min:"1" type:"string" required:"true"`
+// Code returns the exception type name.
+func (s *AWSServiceAccessNotEnabledException) Code() string {
+	return "AWSServiceAccessNotEnabledException"
+}
 
-	// Specifies the code for the quota that you want to delete.
-	//
-	// QuotaCode is a required field
-	QuotaCode *string `min:"1" type:"string" required:"true"`
+// Message returns the exception's message.
+func (s *AWSServiceAccessNotEnabledException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
 
-	// Specifies the code for the service that you want to delete.
-	//
-	// ServiceCode is a required field
-	ServiceCode *string `min:"1" type:"string" required:"true"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *AWSServiceAccessNotEnabledException) OrigErr() error {
+	return nil
 }
 
-// String returns the string representation
-func (s DeleteServiceQuotaIncreaseRequestFromTemplateInput) String() string {
+func (s *AWSServiceAccessNotEnabledException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *AWSServiceAccessNotEnabledException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *AWSServiceAccessNotEnabledException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// You do not have sufficient permission to perform this action.
+type AccessDeniedException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AccessDeniedException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteServiceQuotaIncreaseRequestFromTemplateInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AccessDeniedException) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteServiceQuotaIncreaseRequestFromTemplateInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteServiceQuotaIncreaseRequestFromTemplateInput"}
+func newErrorAccessDeniedException(v protocol.ResponseMetadata) error {
+	return &AccessDeniedException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *AccessDeniedException) Code() string {
+	return "AccessDeniedException"
+}
+
+// Message returns the exception's message.
+func (s *AccessDeniedException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *AccessDeniedException) OrigErr() error {
+	return nil
+}
+
+func (s *AccessDeniedException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *AccessDeniedException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *AccessDeniedException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type AssociateServiceQuotaTemplateInput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AssociateServiceQuotaTemplateInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AssociateServiceQuotaTemplateInput) GoString() string {
+	return s.String()
+}
+
+type AssociateServiceQuotaTemplateOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AssociateServiceQuotaTemplateOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AssociateServiceQuotaTemplateOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteServiceQuotaIncreaseRequestFromTemplateInput struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies the Amazon Web Services Region for which the request was made.
+	//
+	// AwsRegion is a required field
+	AwsRegion *string `min:"1" type:"string" required:"true"`
+
+	// Specifies the quota identifier. To find the quota code for a specific quota,
+	// use the ListServiceQuotas operation, and look for the QuotaCode response
+	// in the output for the quota you want.
+	//
+	// QuotaCode is a required field
+	QuotaCode *string `min:"1" type:"string" required:"true"`
+
+	// Specifies the service identifier. To find the service code value for an Amazon
+	// Web Services service, use the ListServices operation.
+	//
+	// ServiceCode is a required field
+	ServiceCode *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteServiceQuotaIncreaseRequestFromTemplateInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteServiceQuotaIncreaseRequestFromTemplateInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteServiceQuotaIncreaseRequestFromTemplateInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteServiceQuotaIncreaseRequestFromTemplateInput"}
 	if s.AwsRegion == nil {
 		invalidParams.Add(request.NewErrParamRequired("AwsRegion"))
 	}
@@ -2099,26 +2514,106 @@ type DeleteServiceQuotaIncreaseRequestFromTemplateOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteServiceQuotaIncreaseRequestFromTemplateOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteServiceQuotaIncreaseRequestFromTemplateOutput) GoString() string {
 	return s.String()
 }
 
+// You can't perform this action because a dependency does not have access.
+type DependencyAccessDeniedException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DependencyAccessDeniedException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DependencyAccessDeniedException) GoString() string {
+	return s.String()
+}
+
+func newErrorDependencyAccessDeniedException(v protocol.ResponseMetadata) error {
+	return &DependencyAccessDeniedException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *DependencyAccessDeniedException) Code() string {
+	return "DependencyAccessDeniedException"
+}
+
+// Message returns the exception's message.
+func (s *DependencyAccessDeniedException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *DependencyAccessDeniedException) OrigErr() error {
+	return nil
+}
+
+func (s *DependencyAccessDeniedException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *DependencyAccessDeniedException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *DependencyAccessDeniedException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 type DisassociateServiceQuotaTemplateInput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateServiceQuotaTemplateInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateServiceQuotaTemplateInput) GoString() string {
 	return s.String()
 }
@@ -2127,46 +2622,60 @@ type DisassociateServiceQuotaTemplateOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateServiceQuotaTemplateOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateServiceQuotaTemplateOutput) GoString() string {
 	return s.String()
 }
 
-// Returns an error that explains why the action did not succeed.
+// An error that explains why an action did not succeed.
 type ErrorReason struct {
 	_ struct{} `type:"structure"`
 
-	// Service Quotas returns the following error values.
+	// Service Quotas returns the following error values:
 	//
-	// DEPENDENCY_ACCESS_DENIED_ERROR is returned when the caller does not have
-	// permission to call the service or service quota. To resolve the error, you
-	// need permission to access the service or service quota.
+	//    * DEPENDENCY_ACCESS_DENIED_ERROR - The caller does not have the required
+	//    permissions to complete the action. To resolve the error, you must have
+	//    permission to access the Amazon Web Service or quota.
 	//
-	// DEPENDENCY_THROTTLING_ERROR is returned when the service being called is
-	// throttling Service Quotas.
+	//    * DEPENDENCY_THROTTLING_ERROR - The Amazon Web Service is throttling Service
+	//    Quotas.
 	//
-	// DEPENDENCY_SERVICE_ERROR is returned when the service being called has availability
-	// issues.
+	//    * DEPENDENCY_SERVICE_ERROR - The Amazon Web Service is not available.
 	//
-	// SERVICE_QUOTA_NOT_AVAILABLE_ERROR is returned when there was an error in
-	// Service Quotas.
+	//    * SERVICE_QUOTA_NOT_AVAILABLE_ERROR - There was an error in Service Quotas.
 	ErrorCode *string `type:"string" enum:"ErrorCode"`
 
-	// The error message that provides more detail.
+	// The error message.
 	ErrorMessage *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ErrorReason) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ErrorReason) GoString() string {
 	return s.String()
 }
@@ -2186,23 +2695,34 @@ func (s *ErrorReason) SetErrorMessage(v string) *ErrorReason {
 type GetAWSDefaultServiceQuotaInput struct {
 	_ struct{} `type:"structure"`
 
-	// Identifies the service quota you want to select.
+	// Specifies the quota identifier. To find the quota code for a specific quota,
+	// use the ListServiceQuotas operation, and look for the QuotaCode response
+	// in the output for the quota you want.
 	//
 	// QuotaCode is a required field
 	QuotaCode *string `min:"1" type:"string" required:"true"`
 
-	// Specifies the service that you want to use.
+	// Specifies the service identifier. To find the service code value for an Amazon
+	// Web Services service, use the ListServices operation.
 	//
 	// ServiceCode is a required field
 	ServiceCode *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetAWSDefaultServiceQuotaInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetAWSDefaultServiceQuotaInput) GoString() string {
 	return s.String()
 }
@@ -2244,16 +2764,24 @@ func (s *GetAWSDefaultServiceQuotaInput) SetServiceCode(v string) *GetAWSDefault
 type GetAWSDefaultServiceQuotaOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Returns the ServiceQuota object which contains all values for a quota.
+	// Information about the quota.
 	Quota *ServiceQuota `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetAWSDefaultServiceQuotaOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetAWSDefaultServiceQuotaOutput) GoString() string {
 	return s.String()
 }
@@ -2268,12 +2796,20 @@ type GetAssociationForServiceQuotaTemplateInput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetAssociationForServiceQuotaTemplateInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetAssociationForServiceQuotaTemplateInput) GoString() string {
 	return s.String()
 }
@@ -2281,18 +2817,26 @@ func (s GetAssociationForServiceQuotaTemplateInput) GoString() string {
 type GetAssociationForServiceQuotaTemplateOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Specifies whether the template is ASSOCIATED or DISASSOCIATED. If the template
-	// is ASSOCIATED, then it requests service quota increases for all new accounts
-	// created in your organization.
+	// The association status. If the status is ASSOCIATED, the quota increase requests
+	// in the template are automatically applied to new Amazon Web Services accounts
+	// in your organization.
 	ServiceQuotaTemplateAssociationStatus *string `type:"string" enum:"ServiceQuotaTemplateAssociationStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetAssociationForServiceQuotaTemplateOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetAssociationForServiceQuotaTemplateOutput) GoString() string {
 	return s.String()
 }
@@ -2306,18 +2850,26 @@ func (s *GetAssociationForServiceQuotaTemplateOutput) SetServiceQuotaTemplateAss
 type GetRequestedServiceQuotaChangeInput struct {
 	_ struct{} `type:"structure"`
 
-	// Identifies the quota increase request.
+	// Specifies the ID of the quota increase request.
 	//
 	// RequestId is a required field
 	RequestId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetRequestedServiceQuotaChangeInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetRequestedServiceQuotaChangeInput) GoString() string {
 	return s.String()
 }
@@ -2347,17 +2899,24 @@ func (s *GetRequestedServiceQuotaChangeInput) SetRequestId(v string) *GetRequest
 type GetRequestedServiceQuotaChangeOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Returns the RequestedServiceQuotaChange object for the specific increase
-	// request.
+	// Information about the quota increase request.
 	RequestedQuota *RequestedServiceQuotaChange `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetRequestedServiceQuotaChangeOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetRequestedServiceQuotaChangeOutput) GoString() string {
 	return s.String()
 }
@@ -2371,28 +2930,39 @@ func (s *GetRequestedServiceQuotaChangeOutput) SetRequestedQuota(v *RequestedSer
 type GetServiceQuotaIncreaseRequestFromTemplateInput struct {
 	_ struct{} `type:"structure"`
 
-	// Specifies the AWS Region for the quota that you want to use.
+	// Specifies the Amazon Web Services Region for which you made the request.
 	//
 	// AwsRegion is a required field
 	AwsRegion *string `min:"1" type:"string" required:"true"`
 
-	// Specifies the quota you want.
+	// Specifies the quota identifier. To find the quota code for a specific quota,
+	// use the ListServiceQuotas operation, and look for the QuotaCode response
+	// in the output for the quota you want.
 	//
 	// QuotaCode is a required field
 	QuotaCode *string `min:"1" type:"string" required:"true"`
 
-	// Specifies the service that you want to use.
+	// Specifies the service identifier. To find the service code value for an Amazon
+	// Web Services service, use the ListServices operation.
 	//
 	// ServiceCode is a required field
 	ServiceCode *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetServiceQuotaIncreaseRequestFromTemplateInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetServiceQuotaIncreaseRequestFromTemplateInput) GoString() string {
 	return s.String()
 }
@@ -2446,16 +3016,24 @@ func (s *GetServiceQuotaIncreaseRequestFromTemplateInput) SetServiceCode(v strin
 type GetServiceQuotaIncreaseRequestFromTemplateOutput struct {
 	_ struct{} `type:"structure"`
 
-	// This object contains the details about the quota increase request.
+	// Information about the quota increase request.
 	ServiceQuotaIncreaseRequestInTemplate *ServiceQuotaIncreaseRequestInTemplate `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetServiceQuotaIncreaseRequestFromTemplateOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetServiceQuotaIncreaseRequestFromTemplateOutput) GoString() string {
 	return s.String()
 }
@@ -2469,23 +3047,39 @@ func (s *GetServiceQuotaIncreaseRequestFromTemplateOutput) SetServiceQuotaIncrea
 type GetServiceQuotaInput struct {
 	_ struct{} `type:"structure"`
 
-	// Identifies the service quota you want to select.
+	// Specifies the Amazon Web Services account or resource to which the quota
+	// applies. The value in this field depends on the context scope associated
+	// with the specified service quota.
+	ContextId *string `type:"string"`
+
+	// Specifies the quota identifier. To find the quota code for a specific quota,
+	// use the ListServiceQuotas operation, and look for the QuotaCode response
+	// in the output for the quota you want.
 	//
 	// QuotaCode is a required field
 	QuotaCode *string `min:"1" type:"string" required:"true"`
 
-	// Specifies the service that you want to use.
+	// Specifies the service identifier. To find the service code value for an Amazon
+	// Web Services service, use the ListServices operation.
 	//
 	// ServiceCode is a required field
 	ServiceCode *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetServiceQuotaInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetServiceQuotaInput) GoString() string {
 	return s.String()
 }
@@ -2512,6 +3106,12 @@ func (s *GetServiceQuotaInput) Validate() error {
 	return nil
 }
 
+// SetContextId sets the ContextId field's value.
+func (s *GetServiceQuotaInput) SetContextId(v string) *GetServiceQuotaInput {
+	s.ContextId = &v
+	return s
+}
+
 // SetQuotaCode sets the QuotaCode field's value.
 func (s *GetServiceQuotaInput) SetQuotaCode(v string) *GetServiceQuotaInput {
 	s.QuotaCode = &v
@@ -2527,16 +3127,24 @@ func (s *GetServiceQuotaInput) SetServiceCode(v string) *GetServiceQuotaInput {
 type GetServiceQuotaOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Returns the ServiceQuota object which contains all values for a quota.
+	// Information about the quota.
 	Quota *ServiceQuota `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetServiceQuotaOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetServiceQuotaOutput) GoString() string {
 	return s.String()
 }
@@ -2547,41 +3155,240 @@ func (s *GetServiceQuotaOutput) SetQuota(v *ServiceQuota) *GetServiceQuotaOutput
 	return s
 }
 
+// Invalid input was provided.
+type IllegalArgumentException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IllegalArgumentException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s IllegalArgumentException) GoString() string {
+	return s.String()
+}
+
+func newErrorIllegalArgumentException(v protocol.ResponseMetadata) error {
+	return &IllegalArgumentException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *IllegalArgumentException) Code() string {
+	return "IllegalArgumentException"
+}
+
+// Message returns the exception's message.
+func (s *IllegalArgumentException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *IllegalArgumentException) OrigErr() error {
+	return nil
+}
+
+func (s *IllegalArgumentException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *IllegalArgumentException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *IllegalArgumentException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Invalid input was provided.
+type InvalidPaginationTokenException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidPaginationTokenException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidPaginationTokenException) GoString() string {
+	return s.String()
+}
+
+func newErrorInvalidPaginationTokenException(v protocol.ResponseMetadata) error {
+	return &InvalidPaginationTokenException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InvalidPaginationTokenException) Code() string {
+	return "InvalidPaginationTokenException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidPaginationTokenException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidPaginationTokenException) OrigErr() error {
+	return nil
+}
+
+func (s *InvalidPaginationTokenException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidPaginationTokenException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidPaginationTokenException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The resource is in an invalid state.
+type InvalidResourceStateException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidResourceStateException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidResourceStateException) GoString() string {
+	return s.String()
+}
+
+func newErrorInvalidResourceStateException(v protocol.ResponseMetadata) error {
+	return &InvalidResourceStateException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InvalidResourceStateException) Code() string {
+	return "InvalidResourceStateException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidResourceStateException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidResourceStateException) OrigErr() error {
+	return nil
+}
+
+func (s *InvalidResourceStateException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidResourceStateException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidResourceStateException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 type ListAWSDefaultServiceQuotasInput struct {
 	_ struct{} `type:"structure"`
 
-	// (Optional) Limits the number of results that you want to include in the response.
-	// If you don't include this parameter, the response defaults to a value that's
-	// specific to the operation. If additional items exist beyond the specified
-	// maximum, the NextToken element is present and has a value (isn't null). Include
-	// that value as the NextToken request parameter in the call to the operation
-	// to get the next part of the results. You should check NextToken after every
-	// operation to ensure that you receive all of the results.
+	// Specifies the maximum number of results that you want included on each page
+	// of the response. If you do not include this parameter, it defaults to a value
+	// appropriate to the operation. If additional items exist beyond those included
+	// in the current response, the NextToken response element is present and has
+	// a value (is not null). Include that value as the NextToken request parameter
+	// in the next call to the operation to get the next part of the results.
+	//
+	// An API operation can return fewer results than the maximum even when there
+	// are more results available. You should check NextToken after every operation
+	// to ensure that you receive all of the results.
 	MaxResults *int64 `min:"1" type:"integer"`
 
-	// (Optional) Use this parameter in a request if you receive a NextToken response
-	// in a previous request that indicates that there's more output available.
-	// In a subsequent call, set it to the value of the previous call's NextToken
-	// response to indicate where the output should continue from. If additional
-	// items exist beyond the specified maximum, the NextToken element is present
-	// and has a value (isn't null). Include that value as the NextToken request
-	// parameter in the call to the operation to get the next part of the results.
-	// You should check NextToken after every operation to ensure that you receive
-	// all of the results.
+	// Specifies a value for receiving additional results after you receive a NextToken
+	// response in a previous request. A NextToken response indicates that more
+	// output is available. Set this parameter to the value of the previous call's
+	// NextToken response to indicate where the output should continue from.
 	NextToken *string `type:"string"`
 
-	// Specifies the service that you want to use.
+	// Specifies the service identifier. To find the service code value for an Amazon
+	// Web Services service, use the ListServices operation.
 	//
 	// ServiceCode is a required field
 	ServiceCode *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListAWSDefaultServiceQuotasInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListAWSDefaultServiceQuotasInput) GoString() string {
 	return s.String()
 }
@@ -2626,22 +3433,30 @@ func (s *ListAWSDefaultServiceQuotasInput) SetServiceCode(v string) *ListAWSDefa
 type ListAWSDefaultServiceQuotasOutput struct {
 	_ struct{} `type:"structure"`
 
-	// (Optional) Use this parameter in a request if you receive a NextToken response
-	// in a previous request that indicates that there's more output available.
-	// In a subsequent call, set it to the value of the previous call's NextToken
-	// response to indicate where the output should continue from.
+	// If present, indicates that more output is available than is included in the
+	// current response. Use this value in the NextToken request parameter in a
+	// subsequent call to the operation to get the next part of the output. You
+	// should repeat this until the NextToken response element comes back as null.
 	NextToken *string `type:"string"`
 
-	// A list of the quotas in the account with the AWS default values.
+	// Information about the quotas.
 	Quotas []*ServiceQuota `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListAWSDefaultServiceQuotasOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListAWSDefaultServiceQuotasOutput) GoString() string {
 	return s.String()
 }
@@ -2661,41 +3476,60 @@ func (s *ListAWSDefaultServiceQuotasOutput) SetQuotas(v []*ServiceQuota) *ListAW
 type ListRequestedServiceQuotaChangeHistoryByQuotaInput struct {
 	_ struct{} `type:"structure"`
 
-	// (Optional) Limits the number of results that you want to include in the response.
-	// If you don't include this parameter, the response defaults to a value that's
-	// specific to the operation. If additional items exist beyond the specified
-	// maximum, the NextToken element is present and has a value (isn't null). Include
-	// that value as the NextToken request parameter in the call to the operation
-	// to get the next part of the results. You should check NextToken after every
-	// operation to ensure that you receive all of the results.
+	// Specifies the maximum number of results that you want included on each page
+	// of the response. If you do not include this parameter, it defaults to a value
+	// appropriate to the operation. If additional items exist beyond those included
+	// in the current response, the NextToken response element is present and has
+	// a value (is not null). Include that value as the NextToken request parameter
+	// in the next call to the operation to get the next part of the results.
+	//
+	// An API operation can return fewer results than the maximum even when there
+	// are more results available. You should check NextToken after every operation
+	// to ensure that you receive all of the results.
 	MaxResults *int64 `min:"1" type:"integer"`
 
-	// (Optional) Use this parameter in a request if you receive a NextToken response
-	// in a previous request that indicates that there's more output available.
-	// In a subsequent call, set it to the value of the previous call's NextToken
-	// response to indicate where the output should continue from.
+	// Specifies a value for receiving additional results after you receive a NextToken
+	// response in a previous request. A NextToken response indicates that more
+	// output is available. Set this parameter to the value of the previous call's
+	// NextToken response to indicate where the output should continue from.
 	NextToken *string `type:"string"`
 
-	// Specifies the service quota that you want to use
+	// Specifies the quota identifier. To find the quota code for a specific quota,
+	// use the ListServiceQuotas operation, and look for the QuotaCode response
+	// in the output for the quota you want.
 	//
 	// QuotaCode is a required field
 	QuotaCode *string `min:"1" type:"string" required:"true"`
 
-	// Specifies the service that you want to use.
+	// Specifies at which level within the Amazon Web Services account the quota
+	// request applies to.
+	QuotaRequestedAtLevel *string `type:"string" enum:"AppliedLevelEnum"`
+
+	// Specifies the service identifier. To find the service code value for an Amazon
+	// Web Services service, use the ListServices operation.
 	//
 	// ServiceCode is a required field
 	ServiceCode *string `min:"1" type:"string" required:"true"`
 
-	// Specifies the status value of the quota increase request.
+	// Specifies that you want to filter the results to only the requests with the
+	// matching status.
 	Status *string `type:"string" enum:"RequestStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListRequestedServiceQuotaChangeHistoryByQuotaInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListRequestedServiceQuotaChangeHistoryByQuotaInput) GoString() string {
 	return s.String()
 }
@@ -2743,6 +3577,12 @@ func (s *ListRequestedServiceQuotaChangeHistoryByQuotaInput) SetQuotaCode(v stri
 	return s
 }
 
+// SetQuotaRequestedAtLevel sets the QuotaRequestedAtLevel field's value.
+func (s *ListRequestedServiceQuotaChangeHistoryByQuotaInput) SetQuotaRequestedAtLevel(v string) *ListRequestedServiceQuotaChangeHistoryByQuotaInput {
+	s.QuotaRequestedAtLevel = &v
+	return s
+}
+
 // SetServiceCode sets the ServiceCode field's value.
 func (s *ListRequestedServiceQuotaChangeHistoryByQuotaInput) SetServiceCode(v string) *ListRequestedServiceQuotaChangeHistoryByQuotaInput {
 	s.ServiceCode = &v
@@ -2758,25 +3598,30 @@ func (s *ListRequestedServiceQuotaChangeHistoryByQuotaInput) SetStatus(v string)
 type ListRequestedServiceQuotaChangeHistoryByQuotaOutput struct {
 	_ struct{} `type:"structure"`
 
-	// If present in the response, this value indicates there's more output available
-	// that what's included in the current response. This can occur even when the
-	// response includes no values at all, such as when you ask for a filtered view
-	// of a very long list. Use this value in the NextToken request parameter in
-	// a subsequent call to the operation to continue processing and get the next
-	// part of the output. You should repeat this until the NextToken response element
-	// comes back empty (as null).
+	// If present, indicates that more output is available than is included in the
+	// current response. Use this value in the NextToken request parameter in a
+	// subsequent call to the operation to get the next part of the output. You
+	// should repeat this until the NextToken response element comes back as null.
 	NextToken *string `type:"string"`
 
-	// Returns a list of service quota requests.
+	// Information about the quota increase requests.
 	RequestedQuotas []*RequestedServiceQuotaChange `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListRequestedServiceQuotaChangeHistoryByQuotaOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListRequestedServiceQuotaChangeHistoryByQuotaOutput) GoString() string {
 	return s.String()
 }
@@ -2796,34 +3641,51 @@ func (s *ListRequestedServiceQuotaChangeHistoryByQuotaOutput) SetRequestedQuotas
 type ListRequestedServiceQuotaChangeHistoryInput struct {
 	_ struct{} `type:"structure"`
 
-	// (Optional) Limits the number of results that you want to include in the response.
-	// If you don't include this parameter, the response defaults to a value that's
-	// specific to the operation. If additional items exist beyond the specified
-	// maximum, the NextToken element is present and has a value (isn't null). Include
-	// that value as the NextToken request parameter in the call to the operation
-	// to get the next part of the results. You should check NextToken after every
-	// operation to ensure that you receive all of the results.
+	// Specifies the maximum number of results that you want included on each page
+	// of the response. If you do not include this parameter, it defaults to a value
+	// appropriate to the operation. If additional items exist beyond those included
+	// in the current response, the NextToken response element is present and has
+	// a value (is not null). Include that value as the NextToken request parameter
+	// in the next call to the operation to get the next part of the results.
+	//
+	// An API operation can return fewer results than the maximum even when there
+	// are more results available. You should check NextToken after every operation
+	// to ensure that you receive all of the results.
 	MaxResults *int64 `min:"1" type:"integer"`
 
-	// (Optional) Use this parameter in a request if you receive a NextToken response
-	// in a previous request that indicates that there's more output available.
-	// In a subsequent call, set it to the value of the previous call's NextToken
-	// response to indicate where the output should continue from.
+	// Specifies a value for receiving additional results after you receive a NextToken
+	// response in a previous request. A NextToken response indicates that more
+	// output is available. Set this parameter to the value of the previous call's
+	// NextToken response to indicate where the output should continue from.
 	NextToken *string `type:"string"`
 
-	// Specifies the service that you want to use.
+	// Specifies at which level within the Amazon Web Services account the quota
+	// request applies to.
+	QuotaRequestedAtLevel *string `type:"string" enum:"AppliedLevelEnum"`
+
+	// Specifies the service identifier. To find the service code value for an Amazon
+	// Web Services service, use the ListServices operation.
 	ServiceCode *string `min:"1" type:"string"`
 
-	// Specifies the status value of the quota increase request.
+	// Specifies that you want to filter the results to only the requests with the
+	// matching status.
 	Status *string `type:"string" enum:"RequestStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListRequestedServiceQuotaChangeHistoryInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListRequestedServiceQuotaChangeHistoryInput) GoString() string {
 	return s.String()
 }
@@ -2856,6 +3718,12 @@ func (s *ListRequestedServiceQuotaChangeHistoryInput) SetNextToken(v string) *Li
 	return s
 }
 
+// SetQuotaRequestedAtLevel sets the QuotaRequestedAtLevel field's value.
+func (s *ListRequestedServiceQuotaChangeHistoryInput) SetQuotaRequestedAtLevel(v string) *ListRequestedServiceQuotaChangeHistoryInput {
+	s.QuotaRequestedAtLevel = &v
+	return s
+}
+
 // SetServiceCode sets the ServiceCode field's value.
 func (s *ListRequestedServiceQuotaChangeHistoryInput) SetServiceCode(v string) *ListRequestedServiceQuotaChangeHistoryInput {
 	s.ServiceCode = &v
@@ -2871,25 +3739,30 @@ func (s *ListRequestedServiceQuotaChangeHistoryInput) SetStatus(v string) *ListR
 type ListRequestedServiceQuotaChangeHistoryOutput struct {
 	_ struct{} `type:"structure"`
 
-	// If present in the response, this value indicates there's more output available
-	// that what's included in the current response. This can occur even when the
-	// response includes no values at all, such as when you ask for a filtered view
-	// of a very long list. Use this value in the NextToken request parameter in
-	// a subsequent call to the operation to continue processing and get the next
-	// part of the output. You should repeat this until the NextToken response element
-	// comes back empty (as null).
+	// If present, indicates that more output is available than is included in the
+	// current response. Use this value in the NextToken request parameter in a
+	// subsequent call to the operation to get the next part of the output. You
+	// should repeat this until the NextToken response element comes back as null.
 	NextToken *string `type:"string"`
 
-	// Returns a list of service quota requests.
+	// Information about the quota increase requests.
 	RequestedQuotas []*RequestedServiceQuotaChange `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListRequestedServiceQuotaChangeHistoryOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListRequestedServiceQuotaChangeHistoryOutput) GoString() string {
 	return s.String()
 }
@@ -2909,35 +3782,46 @@ func (s *ListRequestedServiceQuotaChangeHistoryOutput) SetRequestedQuotas(v []*R
 type ListServiceQuotaIncreaseRequestsInTemplateInput struct {
 	_ struct{} `type:"structure"`
 
-	// Specifies the AWS Region for the quota that you want to use.
+	// Specifies the Amazon Web Services Region for which you made the request.
 	AwsRegion *string `min:"1" type:"string"`
 
-	// (Optional) Limits the number of results that you want to include in the response.
-	// If you don't include this parameter, the response defaults to a value that's
-	// specific to the operation. If additional items exist beyond the specified
-	// maximum, the NextToken element is present and has a value (isn't null). Include
-	// that value as the NextToken request parameter in the call to the operation
-	// to get the next part of the results. You should check NextToken after every
-	// operation to ensure that you receive all of the results.
+	// Specifies the maximum number of results that you want included on each page
+	// of the response. If you do not include this parameter, it defaults to a value
+	// appropriate to the operation. If additional items exist beyond those included
+	// in the current response, the NextToken response element is present and has
+	// a value (is not null). Include that value as the NextToken request parameter
+	// in the next call to the operation to get the next part of the results.
+	//
+	// An API operation can return fewer results than the maximum even when there
+	// are more results available. You should check NextToken after every operation
+	// to ensure that you receive all of the results.
 	MaxResults *int64 `min:"1" type:"integer"`
 
-	// (Optional) Use this parameter in a request if you receive a NextToken response
-	// in a previous request that indicates that there's more output available.
-	// In a subsequent call, set it to the value of the previous call's NextToken
-	// response to indicate where the output should continue from.
+	// Specifies a value for receiving additional results after you receive a NextToken
+	// response in a previous request. A NextToken response indicates that more
+	// output is available. Set this parameter to the value of the previous call's
+	// NextToken response to indicate where the output should continue from.
 	NextToken *string `type:"string"`
 
-	// The identifier for a service. When performing an operation, use the ServiceCode
-	// to specify a particular service.
+	// Specifies the service identifier. To find the service code value for an Amazon
+	// Web Services service, use the ListServices operation.
 	ServiceCode *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListServiceQuotaIncreaseRequestsInTemplateInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListServiceQuotaIncreaseRequestsInTemplateInput) GoString() string {
 	return s.String()
 }
@@ -2988,25 +3872,30 @@ func (s *ListServiceQuotaIncreaseRequestsInTemplateInput) SetServiceCode(v strin
 type ListServiceQuotaIncreaseRequestsInTemplateOutput struct {
 	_ struct{} `type:"structure"`
 
-	// If present in the response, this value indicates there's more output available
-	// that what's included in the current response. This can occur even when the
-	// response includes no values at all, such as when you ask for a filtered view
-	// of a very long list. Use this value in the NextToken request parameter in
-	// a subsequent call to the operation to continue processing and get the next
-	// part of the output. You should repeat this until the NextToken response element
-	// comes back empty (as null).
+	// If present, indicates that more output is available than is included in the
+	// current response. Use this value in the NextToken request parameter in a
+	// subsequent call to the operation to get the next part of the output. You
+	// should repeat this until the NextToken response element comes back as null.
 	NextToken *string `type:"string"`
 
-	// Returns the list of values of the quota increase request in the template.
+	// Information about the quota increase requests.
 	ServiceQuotaIncreaseRequestInTemplateList []*ServiceQuotaIncreaseRequestInTemplate `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListServiceQuotaIncreaseRequestsInTemplateOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListServiceQuotaIncreaseRequestsInTemplateOutput) GoString() string {
 	return s.String()
 }
@@ -3026,34 +3915,53 @@ func (s *ListServiceQuotaIncreaseRequestsInTemplateOutput) SetServiceQuotaIncrea
 type ListServiceQuotasInput struct {
 	_ struct{} `type:"structure"`
 
-	// (Optional) Limits the number of results that you want to include in the response.
-	// If you don't include this parameter, the response defaults to a value that's
-	// specific to the operation. If additional items exist beyond the specified
-	// maximum, the NextToken element is present and has a value (isn't null). Include
-	// that value as the NextToken request parameter in the call to the operation
-	// to get the next part of the results. You should check NextToken after every
-	// operation to ensure that you receive all of the results.
+	// Specifies the maximum number of results that you want included on each page
+	// of the response. If you do not include this parameter, it defaults to a value
+	// appropriate to the operation. If additional items exist beyond those included
+	// in the current response, the NextToken response element is present and has
+	// a value (is not null). Include that value as the NextToken request parameter
+	// in the next call to the operation to get the next part of the results.
+	//
+	// An API operation can return fewer results than the maximum even when there
+	// are more results available. You should check NextToken after every operation
+	// to ensure that you receive all of the results.
 	MaxResults *int64 `min:"1" type:"integer"`
 
-	// (Optional) Use this parameter in a request if you receive a NextToken response
-	// in a previous request that indicates that there's more output available.
-	// In a subsequent call, set it to the value of the previous call's NextToken
-	// response to indicate where the output should continue from.
+	// Specifies a value for receiving additional results after you receive a NextToken
+	// response in a previous request. A NextToken response indicates that more
+	// output is available. Set this parameter to the value of the previous call's
+	// NextToken response to indicate where the output should continue from.
 	NextToken *string `type:"string"`
 
-	// The identifier for a service. When performing an operation, use the ServiceCode
-	// to specify a particular service.
+	// Specifies at which level of granularity that the quota value is applied.
+	QuotaAppliedAtLevel *string `type:"string" enum:"AppliedLevelEnum"`
+
+	// Specifies the quota identifier. To find the quota code for a specific quota,
+	// use the ListServiceQuotas operation, and look for the QuotaCode response
+	// in the output for the quota you want.
+	QuotaCode *string `min:"1" type:"string"`
+
+	// Specifies the service identifier. To find the service code value for an Amazon
+	// Web Services service, use the ListServices operation.
 	//
 	// ServiceCode is a required field
 	ServiceCode *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListServiceQuotasInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListServiceQuotasInput) GoString() string {
 	return s.String()
 }
@@ -3064,6 +3972,9 @@ func (s *ListServiceQuotasInput) Validate() error {
 	if s.MaxResults != nil && *s.MaxResults < 1 {
 		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
 	}
+	if s.QuotaCode != nil && len(*s.QuotaCode) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("QuotaCode", 1))
+	}
 	if s.ServiceCode == nil {
 		invalidParams.Add(request.NewErrParamRequired("ServiceCode"))
 	}
@@ -3089,7 +4000,19 @@ func (s *ListServiceQuotasInput) SetNextToken(v string) *ListServiceQuotasInput
 	return s
 }
 
-// SetServiceCode sets the ServiceCode field's value.
+// SetQuotaAppliedAtLevel sets the QuotaAppliedAtLevel field's value.
+func (s *ListServiceQuotasInput) SetQuotaAppliedAtLevel(v string) *ListServiceQuotasInput {
+	s.QuotaAppliedAtLevel = &v
+	return s
+}
+
+// SetQuotaCode sets the QuotaCode field's value.
+func (s *ListServiceQuotasInput) SetQuotaCode(v string) *ListServiceQuotasInput {
+	s.QuotaCode = &v
+	return s
+}
+
+// SetServiceCode sets the ServiceCode field's value.
 func (s *ListServiceQuotasInput) SetServiceCode(v string) *ListServiceQuotasInput {
 	s.ServiceCode = &v
 	return s
@@ -3098,26 +4021,30 @@ func (s *ListServiceQuotasInput) SetServiceCode(v string) *ListServiceQuotasInpu
 type ListServiceQuotasOutput struct {
 	_ struct{} `type:"structure"`
 
-	// If present in the response, this value indicates there's more output available
-	// that what's included in the current response. This can occur even when the
-	// response includes no values at all, such as when you ask for a filtered view
-	// of a very long list. Use this value in the NextToken request parameter in
-	// a subsequent call to the operation to continue processing and get the next
-	// part of the output. You should repeat this until the NextToken response element
-	// comes back empty (as null).
+	// If present, indicates that more output is available than is included in the
+	// current response. Use this value in the NextToken request parameter in a
+	// subsequent call to the operation to get the next part of the output. You
+	// should repeat this until the NextToken response element comes back as null.
 	NextToken *string `type:"string"`
 
-	// The response information for a quota lists all attribute information for
-	// the quota.
+	// Information about the quotas.
 	Quotas []*ServiceQuota `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListServiceQuotasOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListServiceQuotasOutput) GoString() string {
 	return s.String()
 }
@@ -3137,28 +4064,39 @@ func (s *ListServiceQuotasOutput) SetQuotas(v []*ServiceQuota) *ListServiceQuota
 type ListServicesInput struct {
 	_ struct{} `type:"structure"`
 
-	// (Optional) Limits the number of results that you want to include in the response.
-	// If you don't include this parameter, the response defaults to a value that's
-	// specific to the operation. If additional items exist beyond the specified
-	// maximum, the NextToken element is present and has a value (isn't null). Include
-	// that value as the NextToken request parameter in the call to the operation
-	// to get the next part of the results. You should check NextToken after every
-	// operation to ensure that you receive all of the results.
+	// Specifies the maximum number of results that you want included on each page
+	// of the response. If you do not include this parameter, it defaults to a value
+	// appropriate to the operation. If additional items exist beyond those included
+	// in the current response, the NextToken response element is present and has
+	// a value (is not null). Include that value as the NextToken request parameter
+	// in the next call to the operation to get the next part of the results.
+	//
+	// An API operation can return fewer results than the maximum even when there
+	// are more results available. You should check NextToken after every operation
+	// to ensure that you receive all of the results.
 	MaxResults *int64 `min:"1" type:"integer"`
 
-	// (Optional) Use this parameter in a request if you receive a NextToken response
-	// in a previous request that indicates that there's more output available.
-	// In a subsequent call, set it to the value of the previous call's NextToken
-	// response to indicate where the output should continue from.
+	// Specifies a value for receiving additional results after you receive a NextToken
+	// response in a previous request. A NextToken response indicates that more
+	// output is available. Set this parameter to the value of the previous call's
+	// NextToken response to indicate where the output should continue from.
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListServicesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListServicesInput) GoString() string {
 	return s.String()
 }
@@ -3191,25 +4129,30 @@ func (s *ListServicesInput) SetNextToken(v string) *ListServicesInput {
 type ListServicesOutput struct {
 	_ struct{} `type:"structure"`
 
-	// If present in the response, this value indicates there's more output available
-	// that what's included in the current response. This can occur even when the
-	// response includes no values at all, such as when you ask for a filtered view
-	// of a very long list. Use this value in the NextToken request parameter in
-	// a subsequent call to the operation to continue processing and get the next
-	// part of the output. You should repeat this until the NextToken response element
-	// comes back empty (as null).
+	// If present, indicates that more output is available than is included in the
+	// current response. Use this value in the NextToken request parameter in a
+	// subsequent call to the operation to get the next part of the output. You
+	// should repeat this until the NextToken response element comes back as null.
 	NextToken *string `type:"string"`
 
-	// Returns a list of services.
+	// The list of the Amazon Web Service names and service codes.
 	Services []*ServiceInfo `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListServicesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListServicesOutput) GoString() string {
 	return s.String()
 }
@@ -3226,38 +4169,122 @@ func (s *ListServicesOutput) SetServices(v []*ServiceInfo) *ListServicesOutput {
 	return s
 }
 
-// A structure that uses CloudWatch metrics to gather data about the service
-// quota.
+type ListTagsForResourceInput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) for the applied quota for which you want to
+	// list tags. You can get this information by using the Service Quotas console,
+	// or by listing the quotas using the list-service-quotas (https://docs.aws.amazon.com/cli/latest/reference/service-quotas/list-service-quotas.html)
+	// CLI command or the ListServiceQuotas (https://docs.aws.amazon.com/servicequotas/2019-06-24/apireference/API_ListServiceQuotas.html)
+	// Amazon Web Services API operation.
+	//
+	// ResourceARN is a required field
+	ResourceARN *string `min:"1" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListTagsForResourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsForResourceInput"}
+	if s.ResourceARN == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceARN"))
+	}
+	if s.ResourceARN != nil && len(*s.ResourceARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ResourceARN", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetResourceARN sets the ResourceARN field's value.
+func (s *ListTagsForResourceInput) SetResourceARN(v string) *ListTagsForResourceInput {
+	s.ResourceARN = &v
+	return s
+}
+
+type ListTagsForResourceOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A complex data type that contains zero or more tag elements.
+	Tags []*Tag `type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceOutput) GoString() string {
+	return s.String()
+}
+
+// SetTags sets the Tags field's value.
+func (s *ListTagsForResourceOutput) SetTags(v []*Tag) *ListTagsForResourceOutput {
+	s.Tags = v
+	return s
+}
+
+// Information about the CloudWatch metric that reflects quota usage.
 type MetricInfo struct {
 	_ struct{} `type:"structure"`
 
-	// A dimension is a name/value pair that is part of the identity of a metric.
-	// Every metric has specific characteristics that describe it, and you can think
-	// of dimensions as categories for those characteristics. These dimensions are
-	// part of the CloudWatch Metric Identity that measures usage against a particular
-	// service quota.
+	// The metric dimension. This is a name/value pair that is part of the identity
+	// of a metric.
 	MetricDimensions map[string]*string `type:"map"`
 
-	// The name of the CloudWatch metric that measures usage of a service quota.
-	// This is a required field.
+	// The name of the metric.
 	MetricName *string `type:"string"`
 
-	// The namespace of the metric. The namespace is a container for CloudWatch
-	// metrics. You can specify a name for the namespace when you create a metric.
+	// The namespace of the metric.
 	MetricNamespace *string `type:"string"`
 
-	// Statistics are metric data aggregations over specified periods of time. This
-	// is the recommended statistic to use when comparing usage in the CloudWatch
-	// Metric against your Service Quota.
+	// The metric statistic that we recommend you use when determining quota usage.
 	MetricStatisticRecommendation *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s MetricInfo) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s MetricInfo) GoString() string {
 	return s.String()
 }
@@ -3286,10 +4313,203 @@ func (s *MetricInfo) SetMetricStatisticRecommendation(v string) *MetricInfo {
 	return s
 }
 
+// The Amazon Web Services account making this call is not a member of an organization.
+type NoAvailableOrganizationException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NoAvailableOrganizationException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NoAvailableOrganizationException) GoString() string {
+	return s.String()
+}
+
+func newErrorNoAvailableOrganizationException(v protocol.ResponseMetadata) error {
+	return &NoAvailableOrganizationException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *NoAvailableOrganizationException) Code() string {
+	return "NoAvailableOrganizationException"
+}
+
+// Message returns the exception's message.
+func (s *NoAvailableOrganizationException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *NoAvailableOrganizationException) OrigErr() error {
+	return nil
+}
+
+func (s *NoAvailableOrganizationException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *NoAvailableOrganizationException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *NoAvailableOrganizationException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The specified resource does not exist.
+type NoSuchResourceException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NoSuchResourceException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s NoSuchResourceException) GoString() string {
+	return s.String()
+}
+
+func newErrorNoSuchResourceException(v protocol.ResponseMetadata) error {
+	return &NoSuchResourceException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *NoSuchResourceException) Code() string {
+	return "NoSuchResourceException"
+}
+
+// Message returns the exception's message.
+func (s *NoSuchResourceException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *NoSuchResourceException) OrigErr() error {
+	return nil
+}
+
+func (s *NoSuchResourceException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *NoSuchResourceException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *NoSuchResourceException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The organization that your Amazon Web Services account belongs to is not
+// in All Features mode.
+type OrganizationNotInAllFeaturesModeException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s OrganizationNotInAllFeaturesModeException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s OrganizationNotInAllFeaturesModeException) GoString() string {
+	return s.String()
+}
+
+func newErrorOrganizationNotInAllFeaturesModeException(v protocol.ResponseMetadata) error {
+	return &OrganizationNotInAllFeaturesModeException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *OrganizationNotInAllFeaturesModeException) Code() string {
+	return "OrganizationNotInAllFeaturesModeException"
+}
+
+// Message returns the exception's message.
+func (s *OrganizationNotInAllFeaturesModeException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *OrganizationNotInAllFeaturesModeException) OrigErr() error {
+	return nil
+}
+
+func (s *OrganizationNotInAllFeaturesModeException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *OrganizationNotInAllFeaturesModeException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *OrganizationNotInAllFeaturesModeException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 type PutServiceQuotaIncreaseRequestIntoTemplateInput struct {
 	_ struct{} `type:"structure"`
 
-	// Specifies the AWS Region for the quota.
+	// Specifies the Amazon Web Services Region to which the template applies.
 	//
 	// AwsRegion is a required field
 	AwsRegion *string `min:"1" type:"string" required:"true"`
@@ -3299,23 +4519,34 @@ type PutServiceQuotaIncreaseRequestIntoTemplateInput struct {
 	// DesiredValue is a required field
 	DesiredValue *float64 `type:"double" required:"true"`
 
-	// Specifies the service quota that you want to use.
+	// Specifies the quota identifier. To find the quota code for a specific quota,
+	// use the ListServiceQuotas operation, and look for the QuotaCode response
+	// in the output for the quota you want.
 	//
 	// QuotaCode is a required field
 	QuotaCode *string `min:"1" type:"string" required:"true"`
 
-	// Specifies the service that you want to use.
+	// Specifies the service identifier. To find the service code value for an Amazon
+	// Web Services service, use the ListServices operation.
 	//
 	// ServiceCode is a required field
 	ServiceCode *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutServiceQuotaIncreaseRequestIntoTemplateInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutServiceQuotaIncreaseRequestIntoTemplateInput) GoString() string {
 	return s.String()
 }
@@ -3378,16 +4609,24 @@ func (s *PutServiceQuotaIncreaseRequestIntoTemplateInput) SetServiceCode(v strin
 type PutServiceQuotaIncreaseRequestIntoTemplateOutput struct {
 	_ struct{} `type:"structure"`
 
-	// A structure that contains information about one service quota increase request.
+	// Information about the quota increase request.
 	ServiceQuotaIncreaseRequestInTemplate *ServiceQuotaIncreaseRequestInTemplate `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutServiceQuotaIncreaseRequestIntoTemplateOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutServiceQuotaIncreaseRequestIntoTemplateOutput) GoString() string {
 	return s.String()
 }
@@ -3398,23 +4637,152 @@ func (s *PutServiceQuotaIncreaseRequestIntoTemplateOutput) SetServiceQuotaIncrea
 	return s
 }
 
-// A structure that contains information about the quota period.
+// A structure that describes the context for a service quota. The context identifies
+// what the quota applies to.
+type QuotaContextInfo struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies the Amazon Web Services account or resource to which the quota
+	// applies. The value in this field depends on the context scope associated
+	// with the specified service quota.
+	ContextId *string `type:"string"`
+
+	// Specifies whether the quota applies to an Amazon Web Services account, or
+	// to a resource.
+	ContextScope *string `type:"string" enum:"QuotaContextScope"`
+
+	// When the ContextScope is RESOURCE, then this specifies the resource type
+	// of the specified resource.
+	ContextScopeType *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s QuotaContextInfo) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s QuotaContextInfo) GoString() string {
+	return s.String()
+}
+
+// SetContextId sets the ContextId field's value.
+func (s *QuotaContextInfo) SetContextId(v string) *QuotaContextInfo {
+	s.ContextId = &v
+	return s
+}
+
+// SetContextScope sets the ContextScope field's value.
+func (s *QuotaContextInfo) SetContextScope(v string) *QuotaContextInfo {
+	s.ContextScope = &v
+	return s
+}
+
+// SetContextScopeType sets the ContextScopeType field's value.
+func (s *QuotaContextInfo) SetContextScopeType(v string) *QuotaContextInfo {
+	s.ContextScopeType = &v
+	return s
+}
+
+// You have exceeded your service quota. To perform the requested action, remove
+// some of the relevant resources, or use Service Quotas to request a service
+// quota increase.
+type QuotaExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s QuotaExceededException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s QuotaExceededException) GoString() string {
+	return s.String()
+}
+
+func newErrorQuotaExceededException(v protocol.ResponseMetadata) error {
+	return &QuotaExceededException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *QuotaExceededException) Code() string {
+	return "QuotaExceededException"
+}
+
+// Message returns the exception's message.
+func (s *QuotaExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *QuotaExceededException) OrigErr() error {
+	return nil
+}
+
+func (s *QuotaExceededException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *QuotaExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *QuotaExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Information about the quota period.
 type QuotaPeriod struct {
 	_ struct{} `type:"structure"`
 
-	// The time unit of a period.
+	// The time unit.
 	PeriodUnit *string `type:"string" enum:"PeriodUnit"`
 
-	// The value of a period.
+	// The value associated with the reported PeriodUnit.
 	PeriodValue *int64 `type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s QuotaPeriod) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s QuotaPeriod) GoString() string {
 	return s.String()
 }
@@ -3434,28 +4802,44 @@ func (s *QuotaPeriod) SetPeriodValue(v int64) *QuotaPeriod {
 type RequestServiceQuotaIncreaseInput struct {
 	_ struct{} `type:"structure"`
 
-	// Specifies the value submitted in the service quota increase request.
+	// Specifies the Amazon Web Services account or resource to which the quota
+	// applies. The value in this field depends on the context scope associated
+	// with the specified service quota.
+	ContextId *string `type:"string"`
+
+	// Specifies the new, increased value for the quota.
 	//
 	// DesiredValue is a required field
 	DesiredValue *float64 `type:"double" required:"true"`
 
-	// Specifies the service quota that you want to use.
+	// Specifies the quota identifier. To find the quota code for a specific quota,
+	// use the ListServiceQuotas operation, and look for the QuotaCode response
+	// in the output for the quota you want.
 	//
 	// QuotaCode is a required field
 	QuotaCode *string `min:"1" type:"string" required:"true"`
 
-	// Specifies the service that you want to use.
+	// Specifies the service identifier. To find the service code value for an Amazon
+	// Web Services service, use the ListServices operation.
 	//
 	// ServiceCode is a required field
 	ServiceCode *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RequestServiceQuotaIncreaseInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RequestServiceQuotaIncreaseInput) GoString() string {
 	return s.String()
 }
@@ -3485,6 +4869,12 @@ func (s *RequestServiceQuotaIncreaseInput) Validate() error {
 	return nil
 }
 
+// SetContextId sets the ContextId field's value.
+func (s *RequestServiceQuotaIncreaseInput) SetContextId(v string) *RequestServiceQuotaIncreaseInput {
+	s.ContextId = &v
+	return s
+}
+
 // SetDesiredValue sets the DesiredValue field's value.
 func (s *RequestServiceQuotaIncreaseInput) SetDesiredValue(v float64) *RequestServiceQuotaIncreaseInput {
 	s.DesiredValue = &v
@@ -3506,16 +4896,24 @@ func (s *RequestServiceQuotaIncreaseInput) SetServiceCode(v string) *RequestServ
 type RequestServiceQuotaIncreaseOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Returns a list of service quota requests.
+	// Information about the quota increase request.
 	RequestedQuota *RequestedServiceQuotaChange `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RequestServiceQuotaIncreaseOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RequestServiceQuotaIncreaseOutput) GoString() string {
 	return s.String()
 }
@@ -3526,61 +4924,78 @@ func (s *RequestServiceQuotaIncreaseOutput) SetRequestedQuota(v *RequestedServic
 	return s
 }
 
-// A structure that contains information about a requested change for a quota.
+// Information about a quota increase request.
 type RequestedServiceQuotaChange struct {
 	_ struct{} `type:"structure"`
 
-	// The case Id for the service quota increase request.
+	// The case ID.
 	CaseId *string `type:"string"`
 
-	// The date and time when the service quota increase request was received and
-	// the case Id was created.
+	// The date and time when the quota increase request was received and the case
+	// ID was created.
 	Created *time.Time `type:"timestamp"`
 
-	// New increased value for the service quota.
+	// The new, increased value for the quota.
 	DesiredValue *float64 `type:"double"`
 
-	// Identifies if the quota is global.
+	// Indicates whether the quota is global.
 	GlobalQuota *bool `type:"boolean"`
 
-	// The unique identifier of a requested service quota change.
+	// The unique identifier.
 	Id *string `min:"1" type:"string"`
 
-	// The date and time of the most recent change in the service quota increase
-	// request.
+	// The date and time of the most recent change.
 	LastUpdated *time.Time `type:"timestamp"`
 
-	// The Amazon Resource Name (ARN) of the service quota.
+	// The Amazon Resource Name (ARN) of the quota.
 	QuotaArn *string `type:"string"`
 
-	// Specifies the service quota that you want to use.
+	// Specifies the quota identifier. To find the quota code for a specific quota,
+	// use the ListServiceQuotas operation, and look for the QuotaCode response
+	// in the output for the quota you want.
 	QuotaCode *string `min:"1" type:"string"`
 
-	// Name of the service quota.
+	// The context for this service quota.
+	QuotaContext *QuotaContextInfo `type:"structure"`
+
+	// Specifies the quota name.
 	QuotaName *string `type:"string"`
 
-	// The IAM identity who submitted the service quota increase request.
+	// Specifies at which level within the Amazon Web Services account the quota
+	// request applies to.
+	QuotaRequestedAtLevel *string `type:"string" enum:"AppliedLevelEnum"`
+
+	// The IAM identity of the requester.
 	Requester *string `type:"string"`
 
-	// Specifies the service that you want to use.
+	// Specifies the service identifier. To find the service code value for an Amazon
+	// Web Services service, use the ListServices operation.
 	ServiceCode *string `min:"1" type:"string"`
 
-	// The name of the AWS service specified in the increase request.
+	// Specifies the service name.
 	ServiceName *string `type:"string"`
 
-	// State of the service quota increase request.
+	// The state of the quota increase request.
 	Status *string `type:"string" enum:"RequestStatus"`
 
-	// Specifies the unit used for the quota.
+	// The unit of measurement.
 	Unit *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RequestedServiceQuotaChange) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RequestedServiceQuotaChange) GoString() string {
 	return s.String()
 }
@@ -3633,12 +5048,24 @@ func (s *RequestedServiceQuotaChange) SetQuotaCode(v string) *RequestedServiceQu
 	return s
 }
 
+// SetQuotaContext sets the QuotaContext field's value.
+func (s *RequestedServiceQuotaChange) SetQuotaContext(v *QuotaContextInfo) *RequestedServiceQuotaChange {
+	s.QuotaContext = v
+	return s
+}
+
 // SetQuotaName sets the QuotaName field's value.
 func (s *RequestedServiceQuotaChange) SetQuotaName(v string) *RequestedServiceQuotaChange {
 	s.QuotaName = &v
 	return s
 }
 
+// SetQuotaRequestedAtLevel sets the QuotaRequestedAtLevel field's value.
+func (s *RequestedServiceQuotaChange) SetQuotaRequestedAtLevel(v string) *RequestedServiceQuotaChange {
+	s.QuotaRequestedAtLevel = &v
+	return s
+}
+
 // SetRequester sets the Requester field's value.
 func (s *RequestedServiceQuotaChange) SetRequester(v string) *RequestedServiceQuotaChange {
 	s.Requester = &v
@@ -3669,89 +5096,240 @@ func (s *RequestedServiceQuotaChange) SetUnit(v string) *RequestedServiceQuotaCh
 	return s
 }
 
-// A structure that contains the ServiceName and ServiceCode. It does not include
-// all details of the service quota. To get those values, use the ListServiceQuotas
-// operation.
-type ServiceInfo struct {
-	_ struct{} `type:"structure"`
-
-	// Specifies the service that you want to use.
-	ServiceCode *string `min:"1" type:"string"`
+// The specified resource already exists.
+type ResourceAlreadyExistsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The name of the AWS service specified in the increase request.
-	ServiceName *string `type:"string"`
+	Message_ *string `locationName:"Message" type:"string"`
 }
 
-// String returns the string representation
-func (s ServiceInfo) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceAlreadyExistsException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ServiceInfo) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceAlreadyExistsException) GoString() string {
 	return s.String()
 }
 
-// SetServiceCode sets the ServiceCode field's value.
-func (s *ServiceInfo) SetServiceCode(v string) *ServiceInfo {
-	s.ServiceCode = &v
-	return s
+func newErrorResourceAlreadyExistsException(v protocol.ResponseMetadata) error {
+	return &ResourceAlreadyExistsException{
+		RespMetadata: v,
+	}
 }
 
-// SetServiceName sets the ServiceName field's value.
-func (s *ServiceInfo) SetServiceName(v string) *ServiceInfo {
-	s.ServiceName = &v
-	return s
+// Code returns the exception type name.
+func (s *ResourceAlreadyExistsException) Code() string {
+	return "ResourceAlreadyExistsException"
 }
 
-// A structure that contains the full set of details that define the service
-// quota.
-type ServiceQuota struct {
-	_ struct{} `type:"structure"`
-
-	// Specifies if the quota value can be increased.
-	Adjustable *bool `type:"boolean"`
+// Message returns the exception's message.
+func (s *ResourceAlreadyExistsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
 
-	// Specifies the ErrorCode and ErrorMessage when success isn't achieved.
-	ErrorReason *ErrorReason `type:"structure"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ResourceAlreadyExistsException) OrigErr() error {
+	return nil
+}
 
-	// Specifies if the quota is global.
-	GlobalQuota *bool `type:"boolean"`
+func (s *ResourceAlreadyExistsException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
 
-	// Identifies the unit and value of how time is measured.
-	Period *QuotaPeriod `type:"structure"`
+// Status code returns the HTTP status code for the request's response error.
+func (s *ResourceAlreadyExistsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
 
-	// The Amazon Resource Name (ARN) of the service quota.
-	QuotaArn *string `type:"string"`
+// RequestID returns the service's response RequestID for request.
+func (s *ResourceAlreadyExistsException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// The code identifier for the service quota specified.
-	QuotaCode *string `min:"1" type:"string"`
+// Something went wrong.
+type ServiceException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The name identifier of the service quota.
-	QuotaName *string `type:"string"`
+	Message_ *string `locationName:"Message" type:"string"`
+}
 
-	// Specifies the service that you want to use.
-	ServiceCode *string `min:"1" type:"string"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceException) GoString() string {
+	return s.String()
+}
+
+func newErrorServiceException(v protocol.ResponseMetadata) error {
+	return &ServiceException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ServiceException) Code() string {
+	return "ServiceException"
+}
+
+// Message returns the exception's message.
+func (s *ServiceException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ServiceException) OrigErr() error {
+	return nil
+}
+
+func (s *ServiceException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ServiceException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ServiceException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// The name of the AWS service specified in the increase request.
+// Information about an Amazon Web Service.
+type ServiceInfo struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies the service identifier. To find the service code value for an Amazon
+	// Web Services service, use the ListServices operation.
+	ServiceCode *string `min:"1" type:"string"`
+
+	// Specifies the service name.
 	ServiceName *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceInfo) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceInfo) GoString() string {
+	return s.String()
+}
+
+// SetServiceCode sets the ServiceCode field's value.
+func (s *ServiceInfo) SetServiceCode(v string) *ServiceInfo {
+	s.ServiceCode = &v
+	return s
+}
 
-	// The unit of measurement for the value of the service quota.
+// SetServiceName sets the ServiceName field's value.
+func (s *ServiceInfo) SetServiceName(v string) *ServiceInfo {
+	s.ServiceName = &v
+	return s
+}
+
+// Information about a quota.
+type ServiceQuota struct {
+	_ struct{} `type:"structure"`
+
+	// Indicates whether the quota value can be increased.
+	Adjustable *bool `type:"boolean"`
+
+	// The error code and error reason.
+	ErrorReason *ErrorReason `type:"structure"`
+
+	// Indicates whether the quota is global.
+	GlobalQuota *bool `type:"boolean"`
+
+	// The period of time.
+	Period *QuotaPeriod `type:"structure"`
+
+	// Specifies at which level of granularity that the quota value is applied.
+	QuotaAppliedAtLevel *string `type:"string" enum:"AppliedLevelEnum"`
+
+	// The Amazon Resource Name (ARN) of the quota.
+	QuotaArn *string `type:"string"`
+
+	// Specifies the quota identifier. To find the quota code for a specific quota,
+	// use the ListServiceQuotas operation, and look for the QuotaCode response
+	// in the output for the quota you want.
+	QuotaCode *string `min:"1" type:"string"`
+
+	// The context for this service quota.
+	QuotaContext *QuotaContextInfo `type:"structure"`
+
+	// Specifies the quota name.
+	QuotaName *string `type:"string"`
+
+	// Specifies the service identifier. To find the service code value for an Amazon
+	// Web Services service, use the ListServices operation.
+	ServiceCode *string `min:"1" type:"string"`
+
+	// Specifies the service name.
+	ServiceName *string `type:"string"`
+
+	// The unit of measurement.
 	Unit *string `type:"string"`
 
-	// Specifies the details about the measurement.
+	// Information about the measurement.
 	UsageMetric *MetricInfo `type:"structure"`
 
-	// The value of service quota.
+	// The quota value.
 	Value *float64 `type:"double"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ServiceQuota) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ServiceQuota) GoString() string {
 	return s.String()
 }
@@ -3780,6 +5358,12 @@ func (s *ServiceQuota) SetPeriod(v *QuotaPeriod) *ServiceQuota {
 	return s
 }
 
+// SetQuotaAppliedAtLevel sets the QuotaAppliedAtLevel field's value.
+func (s *ServiceQuota) SetQuotaAppliedAtLevel(v string) *ServiceQuota {
+	s.QuotaAppliedAtLevel = &v
+	return s
+}
+
 // SetQuotaArn sets the QuotaArn field's value.
 func (s *ServiceQuota) SetQuotaArn(v string) *ServiceQuota {
 	s.QuotaArn = &v
@@ -3792,6 +5376,12 @@ func (s *ServiceQuota) SetQuotaCode(v string) *ServiceQuota {
 	return s
 }
 
+// SetQuotaContext sets the QuotaContext field's value.
+func (s *ServiceQuota) SetQuotaContext(v *QuotaContextInfo) *ServiceQuota {
+	s.QuotaContext = v
+	return s
+}
+
 // SetQuotaName sets the QuotaName field's value.
 func (s *ServiceQuota) SetQuotaName(v string) *ServiceQuota {
 	s.QuotaName = &v
@@ -3828,42 +5418,52 @@ func (s *ServiceQuota) SetValue(v float64) *ServiceQuota {
 	return s
 }
 
-// A structure that contains information about one service quota increase request.
+// Information about a quota increase request.
 type ServiceQuotaIncreaseRequestInTemplate struct {
 	_ struct{} `type:"structure"`
 
-	// The AWS Region where the increase request occurs.
+	// The Amazon Web Services Region.
 	AwsRegion *string `min:"1" type:"string"`
 
-	// Identifies the new, increased value of the service quota in the increase
-	// request.
+	// The new, increased value of the quota.
 	DesiredValue *float64 `type:"double"`
 
-	// Specifies if the quota is a global quota.
+	// Indicates whether the quota is global.
 	GlobalQuota *bool `type:"boolean"`
 
-	// The code identifier for the service quota specified in the increase request.
+	// Specifies the quota identifier. To find the quota code for a specific quota,
+	// use the ListServiceQuotas operation, and look for the QuotaCode response
+	// in the output for the quota you want.
 	QuotaCode *string `min:"1" type:"string"`
 
-	// The name of the service quota in the increase request.
+	// Specifies the quota name.
 	QuotaName *string `type:"string"`
 
-	// The code identifier for the AWS service specified in the increase request.
+	// Specifies the service identifier. To find the service code value for an Amazon
+	// Web Services service, use the ListServices operation.
 	ServiceCode *string `min:"1" type:"string"`
 
-	// The name of the AWS service specified in the increase request.
+	// Specifies the service name.
 	ServiceName *string `type:"string"`
 
-	// The unit of measure for the increase request.
+	// The unit of measurement.
 	Unit *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ServiceQuotaIncreaseRequestInTemplate) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ServiceQuotaIncreaseRequestInTemplate) GoString() string {
 	return s.String()
 }
@@ -3916,6 +5516,609 @@ func (s *ServiceQuotaIncreaseRequestInTemplate) SetUnit(v string) *ServiceQuotaI
 	return s
 }
 
+// The quota request template is not associated with your organization.
+type ServiceQuotaTemplateNotInUseException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceQuotaTemplateNotInUseException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceQuotaTemplateNotInUseException) GoString() string {
+	return s.String()
+}
+
+func newErrorServiceQuotaTemplateNotInUseException(v protocol.ResponseMetadata) error {
+	return &ServiceQuotaTemplateNotInUseException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ServiceQuotaTemplateNotInUseException) Code() string {
+	return "ServiceQuotaTemplateNotInUseException"
+}
+
+// Message returns the exception's message.
+func (s *ServiceQuotaTemplateNotInUseException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ServiceQuotaTemplateNotInUseException) OrigErr() error {
+	return nil
+}
+
+func (s *ServiceQuotaTemplateNotInUseException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ServiceQuotaTemplateNotInUseException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ServiceQuotaTemplateNotInUseException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// A complex data type that contains a tag key and tag value.
+type Tag struct {
+	_ struct{} `type:"structure"`
+
+	// A string that contains a tag key. The string length should be between 1 and
+	// 128 characters. Valid characters include a-z, A-Z, 0-9, space, and the special
+	// characters _ - . : / = + @.
+	//
+	// Key is a required field
+	Key *string `min:"1" type:"string" required:"true"`
+
+	// A string that contains an optional tag value. The string length should be
+	// between 0 and 256 characters. Valid characters include a-z, A-Z, 0-9, space,
+	// and the special characters _ - . : / = + @.
+	//
+	// Value is a required field
+	Value *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Tag) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Tag) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *Tag) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key == nil {
+		invalidParams.Add(request.NewErrParamRequired("Key"))
+	}
+	if s.Key != nil && len(*s.Key) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Key", 1))
+	}
+	if s.Value == nil {
+		invalidParams.Add(request.NewErrParamRequired("Value"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetKey sets the Key field's value.
+func (s *Tag) SetKey(v string) *Tag {
+	s.Key = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *Tag) SetValue(v string) *Tag {
+	s.Value = &v
+	return s
+}
+
+// The specified tag is a reserved word and cannot be used.
+type TagPolicyViolationException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagPolicyViolationException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagPolicyViolationException) GoString() string {
+	return s.String()
+}
+
+func newErrorTagPolicyViolationException(v protocol.ResponseMetadata) error {
+	return &TagPolicyViolationException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *TagPolicyViolationException) Code() string {
+	return "TagPolicyViolationException"
+}
+
+// Message returns the exception's message.
+func (s *TagPolicyViolationException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *TagPolicyViolationException) OrigErr() error {
+	return nil
+}
+
+func (s *TagPolicyViolationException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *TagPolicyViolationException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *TagPolicyViolationException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type TagResourceInput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) for the applied quota. You can get this information
+	// by using the Service Quotas console, or by listing the quotas using the list-service-quotas
+	// (https://docs.aws.amazon.com/cli/latest/reference/service-quotas/list-service-quotas.html)
+	// CLI command or the ListServiceQuotas (https://docs.aws.amazon.com/servicequotas/2019-06-24/apireference/API_ListServiceQuotas.html)
+	// Amazon Web Services API operation.
+	//
+	// ResourceARN is a required field
+	ResourceARN *string `min:"1" type:"string" required:"true"`
+
+	// The tags that you want to add to the resource.
+	//
+	// Tags is a required field
+	Tags []*Tag `min:"1" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *TagResourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "TagResourceInput"}
+	if s.ResourceARN == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceARN"))
+	}
+	if s.ResourceARN != nil && len(*s.ResourceARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ResourceARN", 1))
+	}
+	if s.Tags == nil {
+		invalidParams.Add(request.NewErrParamRequired("Tags"))
+	}
+	if s.Tags != nil && len(s.Tags) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetResourceARN sets the ResourceARN field's value.
+func (s *TagResourceInput) SetResourceARN(v string) *TagResourceInput {
+	s.ResourceARN = &v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *TagResourceInput) SetTags(v []*Tag) *TagResourceInput {
+	s.Tags = v
+	return s
+}
+
+type TagResourceOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceOutput) GoString() string {
+	return s.String()
+}
+
+// The Service Quotas template is not available in this Amazon Web Services
+// Region.
+type TemplatesNotAvailableInRegionException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TemplatesNotAvailableInRegionException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TemplatesNotAvailableInRegionException) GoString() string {
+	return s.String()
+}
+
+func newErrorTemplatesNotAvailableInRegionException(v protocol.ResponseMetadata) error {
+	return &TemplatesNotAvailableInRegionException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *TemplatesNotAvailableInRegionException) Code() string {
+	return "TemplatesNotAvailableInRegionException"
+}
+
+// Message returns the exception's message.
+func (s *TemplatesNotAvailableInRegionException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *TemplatesNotAvailableInRegionException) OrigErr() error {
+	return nil
+}
+
+func (s *TemplatesNotAvailableInRegionException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *TemplatesNotAvailableInRegionException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *TemplatesNotAvailableInRegionException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// Due to throttling, the request was denied. Slow down the rate of request
+// calls, or request an increase for this quota.
+type TooManyRequestsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyRequestsException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyRequestsException) GoString() string {
+	return s.String()
+}
+
+func newErrorTooManyRequestsException(v protocol.ResponseMetadata) error {
+	return &TooManyRequestsException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *TooManyRequestsException) Code() string {
+	return "TooManyRequestsException"
+}
+
+// Message returns the exception's message.
+func (s *TooManyRequestsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *TooManyRequestsException) OrigErr() error {
+	return nil
+}
+
+func (s *TooManyRequestsException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *TooManyRequestsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *TooManyRequestsException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// You've exceeded the number of tags allowed for a resource. For more information,
+// see Tag restrictions (https://docs.aws.amazon.com/servicequotas/latest/userguide/sq-tagging.html#sq-tagging-restrictions)
+// in the Service Quotas User Guide.
+type TooManyTagsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyTagsException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyTagsException) GoString() string {
+	return s.String()
+}
+
+func newErrorTooManyTagsException(v protocol.ResponseMetadata) error {
+	return &TooManyTagsException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *TooManyTagsException) Code() string {
+	return "TooManyTagsException"
+}
+
+// Message returns the exception's message.
+func (s *TooManyTagsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *TooManyTagsException) OrigErr() error {
+	return nil
+}
+
+func (s *TooManyTagsException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *TooManyTagsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *TooManyTagsException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type UntagResourceInput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) for the applied quota that you want to untag.
+	// You can get this information by using the Service Quotas console, or by listing
+	// the quotas using the list-service-quotas (https://docs.aws.amazon.com/cli/latest/reference/service-quotas/list-service-quotas.html)
+	// CLI command or the ListServiceQuotas (https://docs.aws.amazon.com/servicequotas/2019-06-24/apireference/API_ListServiceQuotas.html)
+	// Amazon Web Services API operation.
+	//
+	// ResourceARN is a required field
+	ResourceARN *string `min:"1" type:"string" required:"true"`
+
+	// The keys of the tags that you want to remove from the resource.
+	//
+	// TagKeys is a required field
+	TagKeys []*string `type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *UntagResourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UntagResourceInput"}
+	if s.ResourceARN == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceARN"))
+	}
+	if s.ResourceARN != nil && len(*s.ResourceARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ResourceARN", 1))
+	}
+	if s.TagKeys == nil {
+		invalidParams.Add(request.NewErrParamRequired("TagKeys"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetResourceARN sets the ResourceARN field's value.
+func (s *UntagResourceInput) SetResourceARN(v string) *UntagResourceInput {
+	s.ResourceARN = &v
+	return s
+}
+
+// SetTagKeys sets the TagKeys field's value.
+func (s *UntagResourceInput) SetTagKeys(v []*string) *UntagResourceInput {
+	s.TagKeys = v
+	return s
+}
+
+type UntagResourceOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceOutput) GoString() string {
+	return s.String()
+}
+
+const (
+	// AppliedLevelEnumAccount is a AppliedLevelEnum enum value
+	AppliedLevelEnumAccount = "ACCOUNT"
+
+	// AppliedLevelEnumResource is a AppliedLevelEnum enum value
+	AppliedLevelEnumResource = "RESOURCE"
+
+	// AppliedLevelEnumAll is a AppliedLevelEnum enum value
+	AppliedLevelEnumAll = "ALL"
+)
+
+// AppliedLevelEnum_Values returns all elements of the AppliedLevelEnum enum
+func AppliedLevelEnum_Values() []string {
+	return []string{
+		AppliedLevelEnumAccount,
+		AppliedLevelEnumResource,
+		AppliedLevelEnumAll,
+	}
+}
+
 const (
 	// ErrorCodeDependencyAccessDeniedError is a ErrorCode enum value
 	ErrorCodeDependencyAccessDeniedError = "DEPENDENCY_ACCESS_DENIED_ERROR"
@@ -3930,6 +6133,16 @@ const (
 	ErrorCodeServiceQuotaNotAvailableError = "SERVICE_QUOTA_NOT_AVAILABLE_ERROR"
 )
 
+// ErrorCode_Values returns all elements of the ErrorCode enum
+func ErrorCode_Values() []string {
+	return []string{
+		ErrorCodeDependencyAccessDeniedError,
+		ErrorCodeDependencyThrottlingError,
+		ErrorCodeDependencyServiceError,
+		ErrorCodeServiceQuotaNotAvailableError,
+	}
+}
+
 const (
 	// PeriodUnitMicrosecond is a PeriodUnit enum value
 	PeriodUnitMicrosecond = "MICROSECOND"
@@ -3953,6 +6166,35 @@ const (
 	PeriodUnitWeek = "WEEK"
 )
 
+// PeriodUnit_Values returns all elements of the PeriodUnit enum
+func PeriodUnit_Values() []string {
+	return []string{
+		PeriodUnitMicrosecond,
+		PeriodUnitMillisecond,
+		PeriodUnitSecond,
+		PeriodUnitMinute,
+		PeriodUnitHour,
+		PeriodUnitDay,
+		PeriodUnitWeek,
+	}
+}
+
+const (
+	// QuotaContextScopeResource is a QuotaContextScope enum value
+	QuotaContextScopeResource = "RESOURCE"
+
+	// QuotaContextScopeAccount is a QuotaContextScope enum value
+	QuotaContextScopeAccount = "ACCOUNT"
+)
+
+// QuotaContextScope_Values returns all elements of the QuotaContextScope enum
+func QuotaContextScope_Values() []string {
+	return []string{
+		QuotaContextScopeResource,
+		QuotaContextScopeAccount,
+	}
+}
+
 const (
 	// RequestStatusPending is a RequestStatus enum value
 	RequestStatusPending = "PENDING"
@@ -3968,8 +6210,27 @@ const (
 
 	// RequestStatusCaseClosed is a RequestStatus enum value
 	RequestStatusCaseClosed = "CASE_CLOSED"
+
+	// RequestStatusNotApproved is a RequestStatus enum value
+	RequestStatusNotApproved = "NOT_APPROVED"
+
+	// RequestStatusInvalidRequest is a RequestStatus enum value
+	RequestStatusInvalidRequest = "INVALID_REQUEST"
 )
 
+// RequestStatus_Values returns all elements of the RequestStatus enum
+func RequestStatus_Values() []string {
+	return []string{
+		RequestStatusPending,
+		RequestStatusCaseOpened,
+		RequestStatusApproved,
+		RequestStatusDenied,
+		RequestStatusCaseClosed,
+		RequestStatusNotApproved,
+		RequestStatusInvalidRequest,
+	}
+}
+
 const (
 	// ServiceQuotaTemplateAssociationStatusAssociated is a ServiceQuotaTemplateAssociationStatus enum value
 	ServiceQuotaTemplateAssociationStatusAssociated = "ASSOCIATED"
@@ -3977,3 +6238,11 @@ const (
 	// ServiceQuotaTemplateAssociationStatusDisassociated is a ServiceQuotaTemplateAssociationStatus enum value
 	ServiceQuotaTemplateAssociationStatusDisassociated = "DISASSOCIATED"
 )
+
+// ServiceQuotaTemplateAssociationStatus_Values returns all elements of the ServiceQuotaTemplateAssociationStatus enum
+func ServiceQuotaTemplateAssociationStatus_Values() []string {
+	return []string{
+		ServiceQuotaTemplateAssociationStatusAssociated,
+		ServiceQuotaTemplateAssociationStatusDisassociated,
+	}
+}
@@ -2,19 +2,23 @@
 
 package servicequotas
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeAWSServiceAccessNotEnabledException for service response error code
 	// "AWSServiceAccessNotEnabledException".
 	//
 	// The action you attempted is not allowed unless Service Access with Service
-	// Quotas is enabled in your organization. To enable, call AssociateServiceQuotaTemplate.
+	// Quotas is enabled in your organization.
 	ErrCodeAWSServiceAccessNotEnabledException = "AWSServiceAccessNotEnabledException"
 
 	// ErrCodeAccessDeniedException for service response error code
 	// "AccessDeniedException".
 	//
-	// You do not have sufficient access to perform this action.
+	// You do not have sufficient permission to perform this action.
 	ErrCodeAccessDeniedException = "AccessDeniedException"
 
 	// ErrCodeDependencyAccessDeniedException for service response error code
@@ -38,13 +42,13 @@ const (
 	// ErrCodeInvalidResourceStateException for service response error code
 	// "InvalidResourceStateException".
 	//
-	// Invalid input was provided for the .
+	// The resource is in an invalid state.
 	ErrCodeInvalidResourceStateException = "InvalidResourceStateException"
 
 	// ErrCodeNoAvailableOrganizationException for service response error code
 	// "NoAvailableOrganizationException".
 	//
-	// The account making this call is not a member of an organization.
+	// The Amazon Web Services account making this call is not a member of an organization.
 	ErrCodeNoAvailableOrganizationException = "NoAvailableOrganizationException"
 
 	// ErrCodeNoSuchResourceException for service response error code
@@ -56,8 +60,8 @@ const (
 	// ErrCodeOrganizationNotInAllFeaturesModeException for service response error code
 	// "OrganizationNotInAllFeaturesModeException".
 	//
-	// The organization that your account belongs to, is not in All Features mode.
-	// To enable all features mode, see EnableAllFeatures (https://docs.aws.amazon.com/organizations/latest/APIReference/API_EnableAllFeatures.html).
+	// The organization that your Amazon Web Services account belongs to is not
+	// in All Features mode.
 	ErrCodeOrganizationNotInAllFeaturesModeException = "OrganizationNotInAllFeaturesModeException"
 
 	// ErrCodeQuotaExceededException for service response error code
@@ -84,15 +88,19 @@ const (
 	// "ServiceQuotaTemplateNotInUseException".
 	//
 	// The quota request template is not associated with your organization.
-	//
-	// To use the template, call AssociateServiceQuotaTemplate.
 	ErrCodeServiceQuotaTemplateNotInUseException = "ServiceQuotaTemplateNotInUseException"
 
+	// ErrCodeTagPolicyViolationException for service response error code
+	// "TagPolicyViolationException".
+	//
+	// The specified tag is a reserved word and cannot be used.
+	ErrCodeTagPolicyViolationException = "TagPolicyViolationException"
+
 	// ErrCodeTemplatesNotAvailableInRegionException for service response error code
 	// "TemplatesNotAvailableInRegionException".
 	//
-	// The Service Quotas template is not available in the Region where you are
-	// making the request. Please make the request in us-east-1.
+	// The Service Quotas template is not available in this Amazon Web Services
+	// Region.
 	ErrCodeTemplatesNotAvailableInRegionException = "TemplatesNotAvailableInRegionException"
 
 	// ErrCodeTooManyRequestsException for service response error code
@@ -101,4 +109,32 @@ const (
 	// Due to throttling, the request was denied. Slow down the rate of request
 	// calls, or request an increase for this quota.
 	ErrCodeTooManyRequestsException = "TooManyRequestsException"
+
+	// ErrCodeTooManyTagsException for service response error code
+	// "TooManyTagsException".
+	//
+	// You've exceeded the number of tags allowed for a resource. For more information,
+	// see Tag restrictions (https://docs.aws.amazon.com/servicequotas/latest/userguide/sq-tagging.html#sq-tagging-restrictions)
+	// in the Service Quotas User Guide.
+	ErrCodeTooManyTagsException = "TooManyTagsException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"AWSServiceAccessNotEnabledException":       newErrorAWSServiceAccessNotEnabledException,
+	"AccessDeniedException":                     newErrorAccessDeniedException,
+	"DependencyAccessDeniedException":           newErrorDependencyAccessDeniedException,
+	"IllegalArgumentException":                  newErrorIllegalArgumentException,
+	"InvalidPaginationTokenException":           newErrorInvalidPaginationTokenException,
+	"InvalidResourceStateException":             newErrorInvalidResourceStateException,
+	"NoAvailableOrganizationException":          newErrorNoAvailableOrganizationException,
+	"NoSuchResourceException":                   newErrorNoSuchResourceException,
+	"OrganizationNotInAllFeaturesModeException": newErrorOrganizationNotInAllFeaturesModeException,
+	"QuotaExceededException":                    newErrorQuotaExceededException,
+	"ResourceAlreadyExistsException":            newErrorResourceAlreadyExistsException,
+	"ServiceException":                          newErrorServiceException,
+	"ServiceQuotaTemplateNotInUseException":     newErrorServiceQuotaTemplateNotInUseException,
+	"TagPolicyViolationException":               newErrorTagPolicyViolationException,
+	"TemplatesNotAvailableInRegionException":    newErrorTemplatesNotAvailableInRegionException,
+	"TooManyRequestsException":                  newErrorTooManyRequestsException,
+	"TooManyTagsException":                      newErrorTooManyTagsException,
+}
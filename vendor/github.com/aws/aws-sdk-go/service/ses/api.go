@@ -29,14 +29,13 @@ const opCloneReceiptRuleSet = "CloneReceiptRuleSet"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CloneReceiptRuleSetRequest method.
+//	req, resp := client.CloneReceiptRuleSetRequest(params)
 //
-//    // Example sending a request using the CloneReceiptRuleSetRequest method.
-//    req, resp := client.CloneReceiptRuleSetRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/CloneReceiptRuleSet
 func (c *SES) CloneReceiptRuleSetRequest(input *CloneReceiptRuleSetInput) (req *request.Request, output *CloneReceiptRuleSetOutput) {
@@ -63,7 +62,7 @@ func (c *SES) CloneReceiptRuleSetRequest(input *CloneReceiptRuleSetInput) (req *
 // independent of the source rule set.
 //
 // For information about setting up rule sets, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-receipt-rule-set.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-concepts.html#receiving-email-concepts-rules).
 //
 // You can execute this operation no more than once per second.
 //
@@ -75,15 +74,16 @@ func (c *SES) CloneReceiptRuleSetRequest(input *CloneReceiptRuleSetInput) (req *
 // API operation CloneReceiptRuleSet for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeRuleSetDoesNotExistException "RuleSetDoesNotExist"
-//   Indicates that the provided receipt rule set does not exist.
 //
-//   * ErrCodeAlreadyExistsException "AlreadyExists"
-//   Indicates that a resource could not be created because of a naming conflict.
+//   - ErrCodeRuleSetDoesNotExistException "RuleSetDoesNotExist"
+//     Indicates that the provided receipt rule set does not exist.
+//
+//   - ErrCodeAlreadyExistsException "AlreadyExists"
+//     Indicates that a resource could not be created because of a naming conflict.
 //
-//   * ErrCodeLimitExceededException "LimitExceeded"
-//   Indicates that a resource could not be created because of service limits.
-//   For a list of Amazon SES limits, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/limits.html).
+//   - ErrCodeLimitExceededException "LimitExceeded"
+//     Indicates that a resource could not be created because of service limits.
+//     For a list of Amazon SES limits, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/limits.html).
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/CloneReceiptRuleSet
 func (c *SES) CloneReceiptRuleSet(input *CloneReceiptRuleSetInput) (*CloneReceiptRuleSetOutput, error) {
@@ -123,14 +123,13 @@ const opCreateConfigurationSet = "CreateConfigurationSet"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateConfigurationSetRequest method.
+//	req, resp := client.CreateConfigurationSetRequest(params)
 //
-//    // Example sending a request using the CreateConfigurationSetRequest method.
-//    req, resp := client.CreateConfigurationSetRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/CreateConfigurationSet
 func (c *SES) CreateConfigurationSetRequest(input *CreateConfigurationSetInput) (req *request.Request, output *CreateConfigurationSetOutput) {
@@ -155,7 +154,7 @@ func (c *SES) CreateConfigurationSetRequest(input *CreateConfigurationSetInput)
 // Creates a configuration set.
 //
 // Configuration sets enable you to publish email sending events. For information
-// about using configuration sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/monitor-sending-activity.html).
+// about using configuration sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -167,17 +166,18 @@ func (c *SES) CreateConfigurationSetRequest(input *CreateConfigurationSetInput)
 // API operation CreateConfigurationSet for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeConfigurationSetAlreadyExistsException "ConfigurationSetAlreadyExists"
-//   Indicates that the configuration set could not be created because of a naming
-//   conflict.
 //
-//   * ErrCodeInvalidConfigurationSetException "InvalidConfigurationSet"
-//   Indicates that the configuration set is invalid. See the error message for
-//   details.
+//   - ErrCodeConfigurationSetAlreadyExistsException "ConfigurationSetAlreadyExists"
+//     Indicates that the configuration set could not be created because of a naming
+//     conflict.
+//
+//   - ErrCodeInvalidConfigurationSetException "InvalidConfigurationSet"
+//     Indicates that the configuration set is invalid. See the error message for
+//     details.
 //
-//   * ErrCodeLimitExceededException "LimitExceeded"
-//   Indicates that a resource could not be created because of service limits.
-//   For a list of Amazon SES limits, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/limits.html).
+//   - ErrCodeLimitExceededException "LimitExceeded"
+//     Indicates that a resource could not be created because of service limits.
+//     For a list of Amazon SES limits, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/limits.html).
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/CreateConfigurationSet
 func (c *SES) CreateConfigurationSet(input *CreateConfigurationSetInput) (*CreateConfigurationSetOutput, error) {
@@ -217,14 +217,13 @@ const opCreateConfigurationSetEventDestination = "CreateConfigurationSetEventDes
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateConfigurationSetEventDestinationRequest method.
+//	req, resp := client.CreateConfigurationSetEventDestinationRequest(params)
 //
-//    // Example sending a request using the CreateConfigurationSetEventDestinationRequest method.
-//    req, resp := client.CreateConfigurationSetEventDestinationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/CreateConfigurationSetEventDestination
 func (c *SES) CreateConfigurationSetEventDestinationRequest(input *CreateConfigurationSetEventDestinationInput) (req *request.Request, output *CreateConfigurationSetEventDestinationOutput) {
@@ -252,9 +251,10 @@ func (c *SES) CreateConfigurationSetEventDestinationRequest(input *CreateConfigu
 // only one, destination. The destination can be CloudWatch, Amazon Kinesis
 // Firehose, or Amazon Simple Notification Service (Amazon SNS).
 //
-// An event destination is the AWS service to which Amazon SES publishes the
-// email sending events associated with a configuration set. For information
-// about using configuration sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/monitor-sending-activity.html).
+// An event destination is the Amazon Web Services service to which Amazon SES
+// publishes the email sending events associated with a configuration set. For
+// information about using configuration sets, see the Amazon SES Developer
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -266,28 +266,29 @@ func (c *SES) CreateConfigurationSetEventDestinationRequest(input *CreateConfigu
 // API operation CreateConfigurationSetEventDestination for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
-//   Indicates that the configuration set does not exist.
 //
-//   * ErrCodeEventDestinationAlreadyExistsException "EventDestinationAlreadyExists"
-//   Indicates that the event destination could not be created because of a naming
-//   conflict.
+//   - ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
+//     Indicates that the configuration set does not exist.
 //
-//   * ErrCodeInvalidCloudWatchDestinationException "InvalidCloudWatchDestination"
-//   Indicates that the Amazon CloudWatch destination is invalid. See the error
-//   message for details.
+//   - ErrCodeEventDestinationAlreadyExistsException "EventDestinationAlreadyExists"
+//     Indicates that the event destination could not be created because of a naming
+//     conflict.
 //
-//   * ErrCodeInvalidFirehoseDestinationException "InvalidFirehoseDestination"
-//   Indicates that the Amazon Kinesis Firehose destination is invalid. See the
-//   error message for details.
+//   - ErrCodeInvalidCloudWatchDestinationException "InvalidCloudWatchDestination"
+//     Indicates that the Amazon CloudWatch destination is invalid. See the error
+//     message for details.
 //
-//   * ErrCodeInvalidSNSDestinationException "InvalidSNSDestination"
-//   Indicates that the Amazon Simple Notification Service (Amazon SNS) destination
-//   is invalid. See the error message for details.
+//   - ErrCodeInvalidFirehoseDestinationException "InvalidFirehoseDestination"
+//     Indicates that the Amazon Kinesis Firehose destination is invalid. See the
+//     error message for details.
 //
-//   * ErrCodeLimitExceededException "LimitExceeded"
-//   Indicates that a resource could not be created because of service limits.
-//   For a list of Amazon SES limits, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/limits.html).
+//   - ErrCodeInvalidSNSDestinationException "InvalidSNSDestination"
+//     Indicates that the Amazon Simple Notification Service (Amazon SNS) destination
+//     is invalid. See the error message for details.
+//
+//   - ErrCodeLimitExceededException "LimitExceeded"
+//     Indicates that a resource could not be created because of service limits.
+//     For a list of Amazon SES limits, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/limits.html).
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/CreateConfigurationSetEventDestination
 func (c *SES) CreateConfigurationSetEventDestination(input *CreateConfigurationSetEventDestinationInput) (*CreateConfigurationSetEventDestinationOutput, error) {
@@ -327,14 +328,13 @@ const opCreateConfigurationSetTrackingOptions = "CreateConfigurationSetTrackingO
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateConfigurationSetTrackingOptionsRequest method.
+//	req, resp := client.CreateConfigurationSetTrackingOptionsRequest(params)
 //
-//    // Example sending a request using the CreateConfigurationSetTrackingOptionsRequest method.
-//    req, resp := client.CreateConfigurationSetTrackingOptionsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/CreateConfigurationSetTrackingOptions
 func (c *SES) CreateConfigurationSetTrackingOptionsRequest(input *CreateConfigurationSetTrackingOptionsInput) (req *request.Request, output *CreateConfigurationSetTrackingOptionsOutput) {
@@ -362,7 +362,7 @@ func (c *SES) CreateConfigurationSetTrackingOptionsRequest(input *CreateConfigur
 // By default, images and links used for tracking open and click events are
 // hosted on domains operated by Amazon SES. You can configure a subdomain of
 // your own to handle these events. For information about using custom domains,
-// see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/configure-custom-open-click-domains.html).
+// see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/configure-custom-open-click-domains.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -372,20 +372,21 @@ func (c *SES) CreateConfigurationSetTrackingOptionsRequest(input *CreateConfigur
 // API operation CreateConfigurationSetTrackingOptions for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
-//   Indicates that the configuration set does not exist.
 //
-//   * ErrCodeTrackingOptionsAlreadyExistsException "TrackingOptionsAlreadyExistsException"
-//   Indicates that the configuration set you specified already contains a TrackingOptions
-//   object.
+//   - ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
+//     Indicates that the configuration set does not exist.
 //
-//   * ErrCodeInvalidTrackingOptionsException "InvalidTrackingOptions"
-//   Indicates that the custom domain to be used for open and click tracking redirects
-//   is invalid. This error appears most often in the following situations:
+//   - ErrCodeTrackingOptionsAlreadyExistsException "TrackingOptionsAlreadyExistsException"
+//     Indicates that the configuration set you specified already contains a TrackingOptions
+//     object.
 //
-//      * When the tracking domain you specified is not verified in Amazon SES.
+//   - ErrCodeInvalidTrackingOptionsException "InvalidTrackingOptions"
+//     Indicates that the custom domain to be used for open and click tracking redirects
+//     is invalid. This error appears most often in the following situations:
 //
-//      * When the tracking domain you specified is not a valid domain or subdomain.
+//   - When the tracking domain you specified is not verified in Amazon SES.
+//
+//   - When the tracking domain you specified is not a valid domain or subdomain.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/CreateConfigurationSetTrackingOptions
 func (c *SES) CreateConfigurationSetTrackingOptions(input *CreateConfigurationSetTrackingOptionsInput) (*CreateConfigurationSetTrackingOptionsOutput, error) {
@@ -425,14 +426,13 @@ const opCreateCustomVerificationEmailTemplate = "CreateCustomVerificationEmailTe
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateCustomVerificationEmailTemplateRequest method.
+//	req, resp := client.CreateCustomVerificationEmailTemplateRequest(params)
 //
-//    // Example sending a request using the CreateCustomVerificationEmailTemplateRequest method.
-//    req, resp := client.CreateCustomVerificationEmailTemplateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/CreateCustomVerificationEmailTemplate
 func (c *SES) CreateCustomVerificationEmailTemplateRequest(input *CreateCustomVerificationEmailTemplateInput) (req *request.Request, output *CreateCustomVerificationEmailTemplateOutput) {
@@ -457,7 +457,7 @@ func (c *SES) CreateCustomVerificationEmailTemplateRequest(input *CreateCustomVe
 // Creates a new custom verification email template.
 //
 // For more information about custom verification email templates, see Using
-// Custom Verification Email Templates (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/custom-verification-emails.html)
+// Custom Verification Email Templates (https://docs.aws.amazon.com/ses/latest/dg/creating-identities.html#send-email-verify-address-custom)
 // in the Amazon SES Developer Guide.
 //
 // You can execute this operation no more than once per second.
@@ -470,21 +470,22 @@ func (c *SES) CreateCustomVerificationEmailTemplateRequest(input *CreateCustomVe
 // API operation CreateCustomVerificationEmailTemplate for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeCustomVerificationEmailTemplateAlreadyExistsException "CustomVerificationEmailTemplateAlreadyExists"
-//   Indicates that a custom verification email template with the name you specified
-//   already exists.
 //
-//   * ErrCodeFromEmailAddressNotVerifiedException "FromEmailAddressNotVerified"
-//   Indicates that the sender address specified for a custom verification email
-//   is not verified, and is therefore not eligible to send the custom verification
-//   email.
+//   - ErrCodeCustomVerificationEmailTemplateAlreadyExistsException "CustomVerificationEmailTemplateAlreadyExists"
+//     Indicates that a custom verification email template with the name you specified
+//     already exists.
 //
-//   * ErrCodeCustomVerificationEmailInvalidContentException "CustomVerificationEmailInvalidContent"
-//   Indicates that custom verification email template provided content is invalid.
+//   - ErrCodeFromEmailAddressNotVerifiedException "FromEmailAddressNotVerified"
+//     Indicates that the sender address specified for a custom verification email
+//     is not verified, and is therefore not eligible to send the custom verification
+//     email.
 //
-//   * ErrCodeLimitExceededException "LimitExceeded"
-//   Indicates that a resource could not be created because of service limits.
-//   For a list of Amazon SES limits, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/limits.html).
+//   - ErrCodeCustomVerificationEmailInvalidContentException "CustomVerificationEmailInvalidContent"
+//     Indicates that custom verification email template provided content is invalid.
+//
+//   - ErrCodeLimitExceededException "LimitExceeded"
+//     Indicates that a resource could not be created because of service limits.
+//     For a list of Amazon SES limits, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/limits.html).
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/CreateCustomVerificationEmailTemplate
 func (c *SES) CreateCustomVerificationEmailTemplate(input *CreateCustomVerificationEmailTemplateInput) (*CreateCustomVerificationEmailTemplateOutput, error) {
@@ -524,14 +525,13 @@ const opCreateReceiptFilter = "CreateReceiptFilter"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateReceiptFilterRequest method.
+//	req, resp := client.CreateReceiptFilterRequest(params)
 //
-//    // Example sending a request using the CreateReceiptFilterRequest method.
-//    req, resp := client.CreateReceiptFilterRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/CreateReceiptFilter
 func (c *SES) CreateReceiptFilterRequest(input *CreateReceiptFilterInput) (req *request.Request, output *CreateReceiptFilterOutput) {
@@ -556,7 +556,7 @@ func (c *SES) CreateReceiptFilterRequest(input *CreateReceiptFilterInput) (req *
 // Creates a new IP address filter.
 //
 // For information about setting up IP address filters, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-ip-filters.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-ip-filtering-console-walkthrough.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -568,12 +568,13 @@ func (c *SES) CreateReceiptFilterRequest(input *CreateReceiptFilterInput) (req *
 // API operation CreateReceiptFilter for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeLimitExceededException "LimitExceeded"
-//   Indicates that a resource could not be created because of service limits.
-//   For a list of Amazon SES limits, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/limits.html).
 //
-//   * ErrCodeAlreadyExistsException "AlreadyExists"
-//   Indicates that a resource could not be created because of a naming conflict.
+//   - ErrCodeLimitExceededException "LimitExceeded"
+//     Indicates that a resource could not be created because of service limits.
+//     For a list of Amazon SES limits, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/limits.html).
+//
+//   - ErrCodeAlreadyExistsException "AlreadyExists"
+//     Indicates that a resource could not be created because of a naming conflict.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/CreateReceiptFilter
 func (c *SES) CreateReceiptFilter(input *CreateReceiptFilterInput) (*CreateReceiptFilterOutput, error) {
@@ -613,14 +614,13 @@ const opCreateReceiptRule = "CreateReceiptRule"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateReceiptRuleRequest method.
+//	req, resp := client.CreateReceiptRuleRequest(params)
 //
-//    // Example sending a request using the CreateReceiptRuleRequest method.
-//    req, resp := client.CreateReceiptRuleRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/CreateReceiptRule
 func (c *SES) CreateReceiptRuleRequest(input *CreateReceiptRuleInput) (req *request.Request, output *CreateReceiptRuleOutput) {
@@ -645,7 +645,7 @@ func (c *SES) CreateReceiptRuleRequest(input *CreateReceiptRuleInput) (req *requ
 // Creates a receipt rule.
 //
 // For information about setting up receipt rules, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-receipt-rules.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-receipt-rules-console-walkthrough.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -657,35 +657,36 @@ func (c *SES) CreateReceiptRuleRequest(input *CreateReceiptRuleInput) (req *requ
 // API operation CreateReceiptRule for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidSnsTopicException "InvalidSnsTopic"
-//   Indicates that the provided Amazon SNS topic is invalid, or that Amazon SES
-//   could not publish to the topic, possibly due to permissions issues. For information
-//   about giving permissions, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-permissions.html).
 //
-//   * ErrCodeInvalidS3ConfigurationException "InvalidS3Configuration"
-//   Indicates that the provided Amazon S3 bucket or AWS KMS encryption key is
-//   invalid, or that Amazon SES could not publish to the bucket, possibly due
-//   to permissions issues. For information about giving permissions, see the
-//   Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-permissions.html).
+//   - ErrCodeInvalidSnsTopicException "InvalidSnsTopic"
+//     Indicates that the provided Amazon SNS topic is invalid, or that Amazon SES
+//     could not publish to the topic, possibly due to permissions issues. For information
+//     about giving permissions, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-permissions.html).
 //
-//   * ErrCodeInvalidLambdaFunctionException "InvalidLambdaFunction"
-//   Indicates that the provided AWS Lambda function is invalid, or that Amazon
-//   SES could not execute the provided function, possibly due to permissions
-//   issues. For information about giving permissions, see the Amazon SES Developer
-//   Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-permissions.html).
+//   - ErrCodeInvalidS3ConfigurationException "InvalidS3Configuration"
+//     Indicates that the provided Amazon S3 bucket or Amazon Web Services KMS encryption
+//     key is invalid, or that Amazon SES could not publish to the bucket, possibly
+//     due to permissions issues. For information about giving permissions, see
+//     the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-permissions.html).
 //
-//   * ErrCodeAlreadyExistsException "AlreadyExists"
-//   Indicates that a resource could not be created because of a naming conflict.
+//   - ErrCodeInvalidLambdaFunctionException "InvalidLambdaFunction"
+//     Indicates that the provided Amazon Web Services Lambda function is invalid,
+//     or that Amazon SES could not execute the provided function, possibly due
+//     to permissions issues. For information about giving permissions, see the
+//     Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-permissions.html).
 //
-//   * ErrCodeRuleDoesNotExistException "RuleDoesNotExist"
-//   Indicates that the provided receipt rule does not exist.
+//   - ErrCodeAlreadyExistsException "AlreadyExists"
+//     Indicates that a resource could not be created because of a naming conflict.
 //
-//   * ErrCodeRuleSetDoesNotExistException "RuleSetDoesNotExist"
-//   Indicates that the provided receipt rule set does not exist.
+//   - ErrCodeRuleDoesNotExistException "RuleDoesNotExist"
+//     Indicates that the provided receipt rule does not exist.
 //
-//   * ErrCodeLimitExceededException "LimitExceeded"
-//   Indicates that a resource could not be created because of service limits.
-//   For a list of Amazon SES limits, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/limits.html).
+//   - ErrCodeRuleSetDoesNotExistException "RuleSetDoesNotExist"
+//     Indicates that the provided receipt rule set does not exist.
+//
+//   - ErrCodeLimitExceededException "LimitExceeded"
+//     Indicates that a resource could not be created because of service limits.
+//     For a list of Amazon SES limits, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/limits.html).
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/CreateReceiptRule
 func (c *SES) CreateReceiptRule(input *CreateReceiptRuleInput) (*CreateReceiptRuleOutput, error) {
@@ -725,14 +726,13 @@ const opCreateReceiptRuleSet = "CreateReceiptRuleSet"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateReceiptRuleSetRequest method.
+//	req, resp := client.CreateReceiptRuleSetRequest(params)
 //
-//    // Example sending a request using the CreateReceiptRuleSetRequest method.
-//    req, resp := client.CreateReceiptRuleSetRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/CreateReceiptRuleSet
 func (c *SES) CreateReceiptRuleSetRequest(input *CreateReceiptRuleSetInput) (req *request.Request, output *CreateReceiptRuleSetOutput) {
@@ -757,7 +757,7 @@ func (c *SES) CreateReceiptRuleSetRequest(input *CreateReceiptRuleSetInput) (req
 // Creates an empty receipt rule set.
 //
 // For information about setting up receipt rule sets, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-receipt-rule-set.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-concepts.html#receiving-email-concepts-rules).
 //
 // You can execute this operation no more than once per second.
 //
@@ -769,12 +769,13 @@ func (c *SES) CreateReceiptRuleSetRequest(input *CreateReceiptRuleSetInput) (req
 // API operation CreateReceiptRuleSet for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeAlreadyExistsException "AlreadyExists"
-//   Indicates that a resource could not be created because of a naming conflict.
 //
-//   * ErrCodeLimitExceededException "LimitExceeded"
-//   Indicates that a resource could not be created because of service limits.
-//   For a list of Amazon SES limits, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/limits.html).
+//   - ErrCodeAlreadyExistsException "AlreadyExists"
+//     Indicates that a resource could not be created because of a naming conflict.
+//
+//   - ErrCodeLimitExceededException "LimitExceeded"
+//     Indicates that a resource could not be created because of service limits.
+//     For a list of Amazon SES limits, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/limits.html).
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/CreateReceiptRuleSet
 func (c *SES) CreateReceiptRuleSet(input *CreateReceiptRuleSetInput) (*CreateReceiptRuleSetOutput, error) {
@@ -814,14 +815,13 @@ const opCreateTemplate = "CreateTemplate"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateTemplateRequest method.
+//	req, resp := client.CreateTemplateRequest(params)
 //
-//    // Example sending a request using the CreateTemplateRequest method.
-//    req, resp := client.CreateTemplateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/CreateTemplate
 func (c *SES) CreateTemplateRequest(input *CreateTemplateInput) (req *request.Request, output *CreateTemplateOutput) {
@@ -844,8 +844,8 @@ func (c *SES) CreateTemplateRequest(input *CreateTemplateInput) (req *request.Re
 // CreateTemplate API operation for Amazon Simple Email Service.
 //
 // Creates an email template. Email templates enable you to send personalized
-// email to one or more destinations in a single API operation. For more information,
-// see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/send-personalized-email-api.html).
+// email to one or more destinations in a single operation. For more information,
+// see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/send-personalized-email-api.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -857,16 +857,17 @@ func (c *SES) CreateTemplateRequest(input *CreateTemplateInput) (req *request.Re
 // API operation CreateTemplate for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeAlreadyExistsException "AlreadyExists"
-//   Indicates that a resource could not be created because of a naming conflict.
 //
-//   * ErrCodeInvalidTemplateException "InvalidTemplate"
-//   Indicates that the template that you specified could not be rendered. This
-//   issue may occur when a template refers to a partial that does not exist.
+//   - ErrCodeAlreadyExistsException "AlreadyExists"
+//     Indicates that a resource could not be created because of a naming conflict.
 //
-//   * ErrCodeLimitExceededException "LimitExceeded"
-//   Indicates that a resource could not be created because of service limits.
-//   For a list of Amazon SES limits, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/limits.html).
+//   - ErrCodeInvalidTemplateException "InvalidTemplate"
+//     Indicates that the template that you specified could not be rendered. This
+//     issue may occur when a template refers to a partial that does not exist.
+//
+//   - ErrCodeLimitExceededException "LimitExceeded"
+//     Indicates that a resource could not be created because of service limits.
+//     For a list of Amazon SES limits, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/limits.html).
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/CreateTemplate
 func (c *SES) CreateTemplate(input *CreateTemplateInput) (*CreateTemplateOutput, error) {
@@ -906,14 +907,13 @@ const opDeleteConfigurationSet = "DeleteConfigurationSet"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteConfigurationSetRequest method.
+//	req, resp := client.DeleteConfigurationSetRequest(params)
 //
-//    // Example sending a request using the DeleteConfigurationSetRequest method.
-//    req, resp := client.DeleteConfigurationSetRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/DeleteConfigurationSet
 func (c *SES) DeleteConfigurationSetRequest(input *DeleteConfigurationSetInput) (req *request.Request, output *DeleteConfigurationSetOutput) {
@@ -937,7 +937,7 @@ func (c *SES) DeleteConfigurationSetRequest(input *DeleteConfigurationSetInput)
 //
 // Deletes a configuration set. Configuration sets enable you to publish email
 // sending events. For information about using configuration sets, see the Amazon
-// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/monitor-sending-activity.html).
+// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -949,8 +949,8 @@ func (c *SES) DeleteConfigurationSetRequest(input *DeleteConfigurationSetInput)
 // API operation DeleteConfigurationSet for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
-//   Indicates that the configuration set does not exist.
+//   - ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
+//     Indicates that the configuration set does not exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/DeleteConfigurationSet
 func (c *SES) DeleteConfigurationSet(input *DeleteConfigurationSetInput) (*DeleteConfigurationSetOutput, error) {
@@ -990,14 +990,13 @@ const opDeleteConfigurationSetEventDestination = "DeleteConfigurationSetEventDes
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteConfigurationSetEventDestinationRequest method.
+//	req, resp := client.DeleteConfigurationSetEventDestinationRequest(params)
 //
-//    // Example sending a request using the DeleteConfigurationSetEventDestinationRequest method.
-//    req, resp := client.DeleteConfigurationSetEventDestinationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/DeleteConfigurationSetEventDestination
 func (c *SES) DeleteConfigurationSetEventDestinationRequest(input *DeleteConfigurationSetEventDestinationInput) (req *request.Request, output *DeleteConfigurationSetEventDestinationOutput) {
@@ -1022,7 +1021,7 @@ func (c *SES) DeleteConfigurationSetEventDestinationRequest(input *DeleteConfigu
 // Deletes a configuration set event destination. Configuration set event destinations
 // are associated with configuration sets, which enable you to publish email
 // sending events. For information about using configuration sets, see the Amazon
-// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/monitor-sending-activity.html).
+// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -1034,11 +1033,12 @@ func (c *SES) DeleteConfigurationSetEventDestinationRequest(input *DeleteConfigu
 // API operation DeleteConfigurationSetEventDestination for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
-//   Indicates that the configuration set does not exist.
 //
-//   * ErrCodeEventDestinationDoesNotExistException "EventDestinationDoesNotExist"
-//   Indicates that the event destination does not exist.
+//   - ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
+//     Indicates that the configuration set does not exist.
+//
+//   - ErrCodeEventDestinationDoesNotExistException "EventDestinationDoesNotExist"
+//     Indicates that the event destination does not exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/DeleteConfigurationSetEventDestination
 func (c *SES) DeleteConfigurationSetEventDestination(input *DeleteConfigurationSetEventDestinationInput) (*DeleteConfigurationSetEventDestinationOutput, error) {
@@ -1078,14 +1078,13 @@ const opDeleteConfigurationSetTrackingOptions = "DeleteConfigurationSetTrackingO
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteConfigurationSetTrackingOptionsRequest method.
+//	req, resp := client.DeleteConfigurationSetTrackingOptionsRequest(params)
 //
-//    // Example sending a request using the DeleteConfigurationSetTrackingOptionsRequest method.
-//    req, resp := client.DeleteConfigurationSetTrackingOptionsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/DeleteConfigurationSetTrackingOptions
 func (c *SES) DeleteConfigurationSetTrackingOptionsRequest(input *DeleteConfigurationSetTrackingOptionsInput) (req *request.Request, output *DeleteConfigurationSetTrackingOptionsOutput) {
@@ -1113,9 +1112,9 @@ func (c *SES) DeleteConfigurationSetTrackingOptionsRequest(input *DeleteConfigur
 // By default, images and links used for tracking open and click events are
 // hosted on domains operated by Amazon SES. You can configure a subdomain of
 // your own to handle these events. For information about using custom domains,
-// see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/configure-custom-open-click-domains.html).
+// see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/configure-custom-open-click-domains.html).
 //
-// Deleting this kind of association will result in emails sent using the specified
+// Deleting this kind of association results in emails sent using the specified
 // configuration set to capture open and click events using the standard, Amazon
 // SES-operated domains.
 //
@@ -1127,11 +1126,12 @@ func (c *SES) DeleteConfigurationSetTrackingOptionsRequest(input *DeleteConfigur
 // API operation DeleteConfigurationSetTrackingOptions for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
-//   Indicates that the configuration set does not exist.
 //
-//   * ErrCodeTrackingOptionsDoesNotExistException "TrackingOptionsDoesNotExistException"
-//   Indicates that the TrackingOptions object you specified does not exist.
+//   - ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
+//     Indicates that the configuration set does not exist.
+//
+//   - ErrCodeTrackingOptionsDoesNotExistException "TrackingOptionsDoesNotExistException"
+//     Indicates that the TrackingOptions object you specified does not exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/DeleteConfigurationSetTrackingOptions
 func (c *SES) DeleteConfigurationSetTrackingOptions(input *DeleteConfigurationSetTrackingOptionsInput) (*DeleteConfigurationSetTrackingOptionsOutput, error) {
@@ -1171,14 +1171,13 @@ const opDeleteCustomVerificationEmailTemplate = "DeleteCustomVerificationEmailTe
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteCustomVerificationEmailTemplateRequest method.
+//	req, resp := client.DeleteCustomVerificationEmailTemplateRequest(params)
 //
-//    // Example sending a request using the DeleteCustomVerificationEmailTemplateRequest method.
-//    req, resp := client.DeleteCustomVerificationEmailTemplateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/DeleteCustomVerificationEmailTemplate
 func (c *SES) DeleteCustomVerificationEmailTemplateRequest(input *DeleteCustomVerificationEmailTemplateInput) (req *request.Request, output *DeleteCustomVerificationEmailTemplateOutput) {
@@ -1203,7 +1202,7 @@ func (c *SES) DeleteCustomVerificationEmailTemplateRequest(input *DeleteCustomVe
 // Deletes an existing custom verification email template.
 //
 // For more information about custom verification email templates, see Using
-// Custom Verification Email Templates (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/custom-verification-emails.html)
+// Custom Verification Email Templates (https://docs.aws.amazon.com/ses/latest/dg/creating-identities.html#send-email-verify-address-custom)
 // in the Amazon SES Developer Guide.
 //
 // You can execute this operation no more than once per second.
@@ -1252,14 +1251,13 @@ const opDeleteIdentity = "DeleteIdentity"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteIdentityRequest method.
+//	req, resp := client.DeleteIdentityRequest(params)
 //
-//    // Example sending a request using the DeleteIdentityRequest method.
-//    req, resp := client.DeleteIdentityRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/DeleteIdentity
 func (c *SES) DeleteIdentityRequest(input *DeleteIdentityInput) (req *request.Request, output *DeleteIdentityOutput) {
@@ -1330,14 +1328,13 @@ const opDeleteIdentityPolicy = "DeleteIdentityPolicy"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteIdentityPolicyRequest method.
+//	req, resp := client.DeleteIdentityPolicyRequest(params)
 //
-//    // Example sending a request using the DeleteIdentityPolicyRequest method.
-//    req, resp := client.DeleteIdentityPolicyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/DeleteIdentityPolicy
 func (c *SES) DeleteIdentityPolicyRequest(input *DeleteIdentityPolicyInput) (req *request.Request, output *DeleteIdentityPolicyOutput) {
@@ -1360,15 +1357,15 @@ func (c *SES) DeleteIdentityPolicyRequest(input *DeleteIdentityPolicyInput) (req
 // DeleteIdentityPolicy API operation for Amazon Simple Email Service.
 //
 // Deletes the specified sending authorization policy for the given identity
-// (an email address or a domain). This API returns successfully even if a policy
-// with the specified name does not exist.
+// (an email address or a domain). This operation returns successfully even
+// if a policy with the specified name does not exist.
 //
-// This API is for the identity owner only. If you have not verified the identity,
-// this API will return an error.
+// This operation is for the identity owner only. If you have not verified the
+// identity, it returns an error.
 //
 // Sending authorization is a feature that enables an identity owner to authorize
 // other senders to use its identities. For information about using sending
-// authorization, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization.html).
+// authorization, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -1416,14 +1413,13 @@ const opDeleteReceiptFilter = "DeleteReceiptFilter"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteReceiptFilterRequest method.
+//	req, resp := client.DeleteReceiptFilterRequest(params)
 //
-//    // Example sending a request using the DeleteReceiptFilterRequest method.
-//    req, resp := client.DeleteReceiptFilterRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/DeleteReceiptFilter
 func (c *SES) DeleteReceiptFilterRequest(input *DeleteReceiptFilterInput) (req *request.Request, output *DeleteReceiptFilterOutput) {
@@ -1448,7 +1444,7 @@ func (c *SES) DeleteReceiptFilterRequest(input *DeleteReceiptFilterInput) (req *
 // Deletes the specified IP address filter.
 //
 // For information about managing IP address filters, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-managing-ip-filters.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-ip-filtering-console-walkthrough.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -1496,14 +1492,13 @@ const opDeleteReceiptRule = "DeleteReceiptRule"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteReceiptRuleRequest method.
+//	req, resp := client.DeleteReceiptRuleRequest(params)
 //
-//    // Example sending a request using the DeleteReceiptRuleRequest method.
-//    req, resp := client.DeleteReceiptRuleRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/DeleteReceiptRule
 func (c *SES) DeleteReceiptRuleRequest(input *DeleteReceiptRuleInput) (req *request.Request, output *DeleteReceiptRuleOutput) {
@@ -1528,7 +1523,7 @@ func (c *SES) DeleteReceiptRuleRequest(input *DeleteReceiptRuleInput) (req *requ
 // Deletes the specified receipt rule.
 //
 // For information about managing receipt rules, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-managing-receipt-rules.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-receipt-rules-console-walkthrough.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -1540,8 +1535,8 @@ func (c *SES) DeleteReceiptRuleRequest(input *DeleteReceiptRuleInput) (req *requ
 // API operation DeleteReceiptRule for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeRuleSetDoesNotExistException "RuleSetDoesNotExist"
-//   Indicates that the provided receipt rule set does not exist.
+//   - ErrCodeRuleSetDoesNotExistException "RuleSetDoesNotExist"
+//     Indicates that the provided receipt rule set does not exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/DeleteReceiptRule
 func (c *SES) DeleteReceiptRule(input *DeleteReceiptRuleInput) (*DeleteReceiptRuleOutput, error) {
@@ -1581,14 +1576,13 @@ const opDeleteReceiptRuleSet = "DeleteReceiptRuleSet"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteReceiptRuleSetRequest method.
+//	req, resp := client.DeleteReceiptRuleSetRequest(params)
 //
-//    // Example sending a request using the DeleteReceiptRuleSetRequest method.
-//    req, resp := client.DeleteReceiptRuleSetRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/DeleteReceiptRuleSet
 func (c *SES) DeleteReceiptRuleSetRequest(input *DeleteReceiptRuleSetInput) (req *request.Request, output *DeleteReceiptRuleSetOutput) {
@@ -1615,7 +1609,7 @@ func (c *SES) DeleteReceiptRuleSetRequest(input *DeleteReceiptRuleSetInput) (req
 // The currently active rule set cannot be deleted.
 //
 // For information about managing receipt rule sets, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-managing-receipt-rule-sets.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-receipt-rules-console-walkthrough.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -1627,8 +1621,8 @@ func (c *SES) DeleteReceiptRuleSetRequest(input *DeleteReceiptRuleSetInput) (req
 // API operation DeleteReceiptRuleSet for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeCannotDeleteException "CannotDelete"
-//   Indicates that the delete operation could not be completed.
+//   - ErrCodeCannotDeleteException "CannotDelete"
+//     Indicates that the delete operation could not be completed.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/DeleteReceiptRuleSet
 func (c *SES) DeleteReceiptRuleSet(input *DeleteReceiptRuleSetInput) (*DeleteReceiptRuleSetOutput, error) {
@@ -1668,14 +1662,13 @@ const opDeleteTemplate = "DeleteTemplate"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteTemplateRequest method.
+//	req, resp := client.DeleteTemplateRequest(params)
 //
-//    // Example sending a request using the DeleteTemplateRequest method.
-//    req, resp := client.DeleteTemplateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/DeleteTemplate
 func (c *SES) DeleteTemplateRequest(input *DeleteTemplateInput) (req *request.Request, output *DeleteTemplateOutput) {
@@ -1745,14 +1738,13 @@ const opDeleteVerifiedEmailAddress = "DeleteVerifiedEmailAddress"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteVerifiedEmailAddressRequest method.
+//	req, resp := client.DeleteVerifiedEmailAddressRequest(params)
 //
-//    // Example sending a request using the DeleteVerifiedEmailAddressRequest method.
-//    req, resp := client.DeleteVerifiedEmailAddressRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/DeleteVerifiedEmailAddress
 func (c *SES) DeleteVerifiedEmailAddressRequest(input *DeleteVerifiedEmailAddressInput) (req *request.Request, output *DeleteVerifiedEmailAddressOutput) {
@@ -1821,14 +1813,13 @@ const opDescribeActiveReceiptRuleSet = "DescribeActiveReceiptRuleSet"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeActiveReceiptRuleSetRequest method.
+//	req, resp := client.DescribeActiveReceiptRuleSetRequest(params)
 //
-//    // Example sending a request using the DescribeActiveReceiptRuleSetRequest method.
-//    req, resp := client.DescribeActiveReceiptRuleSetRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/DescribeActiveReceiptRuleSet
 func (c *SES) DescribeActiveReceiptRuleSetRequest(input *DescribeActiveReceiptRuleSetInput) (req *request.Request, output *DescribeActiveReceiptRuleSetOutput) {
@@ -1853,7 +1844,7 @@ func (c *SES) DescribeActiveReceiptRuleSetRequest(input *DescribeActiveReceiptRu
 // active.
 //
 // For information about setting up receipt rule sets, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-receipt-rule-set.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-concepts.html#receiving-email-concepts-rules).
 //
 // You can execute this operation no more than once per second.
 //
@@ -1901,14 +1892,13 @@ const opDescribeConfigurationSet = "DescribeConfigurationSet"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeConfigurationSetRequest method.
+//	req, resp := client.DescribeConfigurationSetRequest(params)
 //
-//    // Example sending a request using the DescribeConfigurationSetRequest method.
-//    req, resp := client.DescribeConfigurationSetRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/DescribeConfigurationSet
 func (c *SES) DescribeConfigurationSetRequest(input *DescribeConfigurationSetInput) (req *request.Request, output *DescribeConfigurationSetOutput) {
@@ -1930,7 +1920,7 @@ func (c *SES) DescribeConfigurationSetRequest(input *DescribeConfigurationSetInp
 // DescribeConfigurationSet API operation for Amazon Simple Email Service.
 //
 // Returns the details of the specified configuration set. For information about
-// using configuration sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/monitor-sending-activity.html).
+// using configuration sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -1942,8 +1932,8 @@ func (c *SES) DescribeConfigurationSetRequest(input *DescribeConfigurationSetInp
 // API operation DescribeConfigurationSet for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
-//   Indicates that the configuration set does not exist.
+//   - ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
+//     Indicates that the configuration set does not exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/DescribeConfigurationSet
 func (c *SES) DescribeConfigurationSet(input *DescribeConfigurationSetInput) (*DescribeConfigurationSetOutput, error) {
@@ -1983,14 +1973,13 @@ const opDescribeReceiptRule = "DescribeReceiptRule"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeReceiptRuleRequest method.
+//	req, resp := client.DescribeReceiptRuleRequest(params)
 //
-//    // Example sending a request using the DescribeReceiptRuleRequest method.
-//    req, resp := client.DescribeReceiptRuleRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/DescribeReceiptRule
 func (c *SES) DescribeReceiptRuleRequest(input *DescribeReceiptRuleInput) (req *request.Request, output *DescribeReceiptRuleOutput) {
@@ -2014,7 +2003,7 @@ func (c *SES) DescribeReceiptRuleRequest(input *DescribeReceiptRuleInput) (req *
 // Returns the details of the specified receipt rule.
 //
 // For information about setting up receipt rules, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-receipt-rules.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-receipt-rules-console-walkthrough.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -2026,11 +2015,12 @@ func (c *SES) DescribeReceiptRuleRequest(input *DescribeReceiptRuleInput) (req *
 // API operation DescribeReceiptRule for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeRuleDoesNotExistException "RuleDoesNotExist"
-//   Indicates that the provided receipt rule does not exist.
 //
-//   * ErrCodeRuleSetDoesNotExistException "RuleSetDoesNotExist"
-//   Indicates that the provided receipt rule set does not exist.
+//   - ErrCodeRuleDoesNotExistException "RuleDoesNotExist"
+//     Indicates that the provided receipt rule does not exist.
+//
+//   - ErrCodeRuleSetDoesNotExistException "RuleSetDoesNotExist"
+//     Indicates that the provided receipt rule set does not exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/DescribeReceiptRule
 func (c *SES) DescribeReceiptRule(input *DescribeReceiptRuleInput) (*DescribeReceiptRuleOutput, error) {
@@ -2070,14 +2060,13 @@ const opDescribeReceiptRuleSet = "DescribeReceiptRuleSet"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeReceiptRuleSetRequest method.
+//	req, resp := client.DescribeReceiptRuleSetRequest(params)
 //
-//    // Example sending a request using the DescribeReceiptRuleSetRequest method.
-//    req, resp := client.DescribeReceiptRuleSetRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/DescribeReceiptRuleSet
 func (c *SES) DescribeReceiptRuleSetRequest(input *DescribeReceiptRuleSetInput) (req *request.Request, output *DescribeReceiptRuleSetOutput) {
@@ -2101,7 +2090,7 @@ func (c *SES) DescribeReceiptRuleSetRequest(input *DescribeReceiptRuleSetInput)
 // Returns the details of the specified receipt rule set.
 //
 // For information about managing receipt rule sets, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-managing-receipt-rule-sets.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-receipt-rules-console-walkthrough.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -2113,8 +2102,8 @@ func (c *SES) DescribeReceiptRuleSetRequest(input *DescribeReceiptRuleSetInput)
 // API operation DescribeReceiptRuleSet for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeRuleSetDoesNotExistException "RuleSetDoesNotExist"
-//   Indicates that the provided receipt rule set does not exist.
+//   - ErrCodeRuleSetDoesNotExistException "RuleSetDoesNotExist"
+//     Indicates that the provided receipt rule set does not exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/DescribeReceiptRuleSet
 func (c *SES) DescribeReceiptRuleSet(input *DescribeReceiptRuleSetInput) (*DescribeReceiptRuleSetOutput, error) {
@@ -2154,14 +2143,13 @@ const opGetAccountSendingEnabled = "GetAccountSendingEnabled"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetAccountSendingEnabledRequest method.
+//	req, resp := client.GetAccountSendingEnabledRequest(params)
 //
-//    // Example sending a request using the GetAccountSendingEnabledRequest method.
-//    req, resp := client.GetAccountSendingEnabledRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/GetAccountSendingEnabled
 func (c *SES) GetAccountSendingEnabledRequest(input *GetAccountSendingEnabledInput) (req *request.Request, output *GetAccountSendingEnabledOutput) {
@@ -2183,7 +2171,7 @@ func (c *SES) GetAccountSendingEnabledRequest(input *GetAccountSendingEnabledInp
 // GetAccountSendingEnabled API operation for Amazon Simple Email Service.
 //
 // Returns the email sending status of the Amazon SES account for the current
-// region.
+// Region.
 //
 // You can execute this operation no more than once per second.
 //
@@ -2231,14 +2219,13 @@ const opGetCustomVerificationEmailTemplate = "GetCustomVerificationEmailTemplate
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetCustomVerificationEmailTemplateRequest method.
+//	req, resp := client.GetCustomVerificationEmailTemplateRequest(params)
 //
-//    // Example sending a request using the GetCustomVerificationEmailTemplateRequest method.
-//    req, resp := client.GetCustomVerificationEmailTemplateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/GetCustomVerificationEmailTemplate
 func (c *SES) GetCustomVerificationEmailTemplateRequest(input *GetCustomVerificationEmailTemplateInput) (req *request.Request, output *GetCustomVerificationEmailTemplateOutput) {
@@ -2263,7 +2250,7 @@ func (c *SES) GetCustomVerificationEmailTemplateRequest(input *GetCustomVerifica
 // specify.
 //
 // For more information about custom verification email templates, see Using
-// Custom Verification Email Templates (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/custom-verification-emails.html)
+// Custom Verification Email Templates (https://docs.aws.amazon.com/ses/latest/dg/creating-identities.html#send-email-verify-address-custom)
 // in the Amazon SES Developer Guide.
 //
 // You can execute this operation no more than once per second.
@@ -2276,9 +2263,9 @@ func (c *SES) GetCustomVerificationEmailTemplateRequest(input *GetCustomVerifica
 // API operation GetCustomVerificationEmailTemplate for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeCustomVerificationEmailTemplateDoesNotExistException "CustomVerificationEmailTemplateDoesNotExist"
-//   Indicates that a custom verification email template with the name you specified
-//   does not exist.
+//   - ErrCodeCustomVerificationEmailTemplateDoesNotExistException "CustomVerificationEmailTemplateDoesNotExist"
+//     Indicates that a custom verification email template with the name you specified
+//     does not exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/GetCustomVerificationEmailTemplate
 func (c *SES) GetCustomVerificationEmailTemplate(input *GetCustomVerificationEmailTemplateInput) (*GetCustomVerificationEmailTemplateOutput, error) {
@@ -2318,14 +2305,13 @@ const opGetIdentityDkimAttributes = "GetIdentityDkimAttributes"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetIdentityDkimAttributesRequest method.
+//	req, resp := client.GetIdentityDkimAttributesRequest(params)
 //
-//    // Example sending a request using the GetIdentityDkimAttributesRequest method.
-//    req, resp := client.GetIdentityDkimAttributesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/GetIdentityDkimAttributes
 func (c *SES) GetIdentityDkimAttributesRequest(input *GetIdentityDkimAttributesInput) (req *request.Request, output *GetIdentityDkimAttributesOutput) {
@@ -2354,20 +2340,20 @@ func (c *SES) GetIdentityDkimAttributesRequest(input *GetIdentityDkimAttributesI
 // This operation takes a list of identities as input and returns the following
 // information for each:
 //
-//    * Whether Easy DKIM signing is enabled or disabled.
+//   - Whether Easy DKIM signing is enabled or disabled.
 //
-//    * A set of DKIM tokens that represent the identity. If the identity is
-//    an email address, the tokens represent the domain of that address.
+//   - A set of DKIM tokens that represent the identity. If the identity is
+//     an email address, the tokens represent the domain of that address.
 //
-//    * Whether Amazon SES has successfully verified the DKIM tokens published
-//    in the domain's DNS. This information is only returned for domain name
-//    identities, not for email addresses.
+//   - Whether Amazon SES has successfully verified the DKIM tokens published
+//     in the domain's DNS. This information is only returned for domain name
+//     identities, not for email addresses.
 //
 // This operation is throttled at one request per second and can only get DKIM
 // attributes for up to 100 identities at a time.
 //
 // For more information about creating DNS records using DKIM tokens, go to
-// the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/easy-dkim-dns-records.html).
+// the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/send-email-authentication-dkim-easy-managing.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2413,14 +2399,13 @@ const opGetIdentityMailFromDomainAttributes = "GetIdentityMailFromDomainAttribut
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetIdentityMailFromDomainAttributesRequest method.
+//	req, resp := client.GetIdentityMailFromDomainAttributesRequest(params)
 //
-//    // Example sending a request using the GetIdentityMailFromDomainAttributesRequest method.
-//    req, resp := client.GetIdentityMailFromDomainAttributesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/GetIdentityMailFromDomainAttributes
 func (c *SES) GetIdentityMailFromDomainAttributesRequest(input *GetIdentityMailFromDomainAttributesInput) (req *request.Request, output *GetIdentityMailFromDomainAttributesOutput) {
@@ -2491,14 +2476,13 @@ const opGetIdentityNotificationAttributes = "GetIdentityNotificationAttributes"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetIdentityNotificationAttributesRequest method.
+//	req, resp := client.GetIdentityNotificationAttributesRequest(params)
 //
-//    // Example sending a request using the GetIdentityNotificationAttributesRequest method.
-//    req, resp := client.GetIdentityNotificationAttributesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/GetIdentityNotificationAttributes
 func (c *SES) GetIdentityNotificationAttributesRequest(input *GetIdentityNotificationAttributesInput) (req *request.Request, output *GetIdentityNotificationAttributesOutput) {
@@ -2526,7 +2510,7 @@ func (c *SES) GetIdentityNotificationAttributesRequest(input *GetIdentityNotific
 // attributes for up to 100 identities at a time.
 //
 // For more information about using notifications with Amazon SES, see the Amazon
-// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/notifications.html).
+// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity-using-notifications.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2572,14 +2556,13 @@ const opGetIdentityPolicies = "GetIdentityPolicies"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetIdentityPoliciesRequest method.
+//	req, resp := client.GetIdentityPoliciesRequest(params)
 //
-//    // Example sending a request using the GetIdentityPoliciesRequest method.
-//    req, resp := client.GetIdentityPoliciesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/GetIdentityPolicies
 func (c *SES) GetIdentityPoliciesRequest(input *GetIdentityPoliciesInput) (req *request.Request, output *GetIdentityPoliciesOutput) {
@@ -2605,12 +2588,12 @@ func (c *SES) GetIdentityPoliciesRequest(input *GetIdentityPoliciesInput) (req *
 // names to policy contents. You can retrieve a maximum of 20 policies at a
 // time.
 //
-// This API is for the identity owner only. If you have not verified the identity,
-// this API will return an error.
+// This operation is for the identity owner only. If you have not verified the
+// identity, it returns an error.
 //
 // Sending authorization is a feature that enables an identity owner to authorize
 // other senders to use its identities. For information about using sending
-// authorization, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization.html).
+// authorization, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -2658,14 +2641,13 @@ const opGetIdentityVerificationAttributes = "GetIdentityVerificationAttributes"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetIdentityVerificationAttributesRequest method.
+//	req, resp := client.GetIdentityVerificationAttributesRequest(params)
 //
-//    // Example sending a request using the GetIdentityVerificationAttributesRequest method.
-//    req, resp := client.GetIdentityVerificationAttributesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/GetIdentityVerificationAttributes
 func (c *SES) GetIdentityVerificationAttributesRequest(input *GetIdentityVerificationAttributesInput) (req *request.Request, output *GetIdentityVerificationAttributesOutput) {
@@ -2695,16 +2677,15 @@ func (c *SES) GetIdentityVerificationAttributesRequest(input *GetIdentityVerific
 // sent to that address. If the email address owner clicks the link within 24
 // hours, the verification status of the email address changes to "Success".
 // If the link is not clicked within 24 hours, the verification status changes
-// to "Failed." In that case, if you still want to verify the email address,
-// you must restart the verification process from the beginning.
+// to "Failed." In that case, to verify the email address, you must restart
+// the verification process from the beginning.
 //
 // For domain identities, the domain's verification status is "Pending" as Amazon
 // SES searches for the required TXT record in the DNS settings of the domain.
 // When Amazon SES detects the record, the domain's verification status changes
 // to "Success". If Amazon SES is unable to detect the record within 72 hours,
-// the domain's verification status changes to "Failed." In that case, if you
-// still want to verify the domain, you must restart the verification process
-// from the beginning.
+// the domain's verification status changes to "Failed." In that case, to verify
+// the domain, you must restart the verification process from the beginning.
 //
 // This operation is throttled at one request per second and can only get verification
 // attributes for up to 100 identities at a time.
@@ -2753,14 +2734,13 @@ const opGetSendQuota = "GetSendQuota"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetSendQuotaRequest method.
+//	req, resp := client.GetSendQuotaRequest(params)
 //
-//    // Example sending a request using the GetSendQuotaRequest method.
-//    req, resp := client.GetSendQuotaRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/GetSendQuota
 func (c *SES) GetSendQuotaRequest(input *GetSendQuotaInput) (req *request.Request, output *GetSendQuotaOutput) {
@@ -2829,14 +2809,13 @@ const opGetSendStatistics = "GetSendStatistics"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetSendStatisticsRequest method.
+//	req, resp := client.GetSendStatisticsRequest(params)
 //
-//    // Example sending a request using the GetSendStatisticsRequest method.
-//    req, resp := client.GetSendStatisticsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/GetSendStatistics
 func (c *SES) GetSendStatisticsRequest(input *GetSendStatisticsInput) (req *request.Request, output *GetSendStatisticsOutput) {
@@ -2857,9 +2836,10 @@ func (c *SES) GetSendStatisticsRequest(input *GetSendStatisticsInput) (req *requ
 
 // GetSendStatistics API operation for Amazon Simple Email Service.
 //
-// Provides sending statistics for the current AWS Region. The result is a list
-// of data points, representing the last two weeks of sending activity. Each
-// data point in the list contains statistics for a 15-minute period of time.
+// Provides sending statistics for the current Amazon Web Services Region. The
+// result is a list of data points, representing the last two weeks of sending
+// activity. Each data point in the list contains statistics for a 15-minute
+// period of time.
 //
 // You can execute this operation no more than once per second.
 //
@@ -2907,14 +2887,13 @@ const opGetTemplate = "GetTemplate"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetTemplateRequest method.
+//	req, resp := client.GetTemplateRequest(params)
 //
-//    // Example sending a request using the GetTemplateRequest method.
-//    req, resp := client.GetTemplateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/GetTemplate
 func (c *SES) GetTemplateRequest(input *GetTemplateInput) (req *request.Request, output *GetTemplateOutput) {
@@ -2948,9 +2927,9 @@ func (c *SES) GetTemplateRequest(input *GetTemplateInput) (req *request.Request,
 // API operation GetTemplate for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeTemplateDoesNotExistException "TemplateDoesNotExist"
-//   Indicates that the Template object you specified does not exist in your Amazon
-//   SES account.
+//   - ErrCodeTemplateDoesNotExistException "TemplateDoesNotExist"
+//     Indicates that the Template object you specified does not exist in your Amazon
+//     SES account.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/GetTemplate
 func (c *SES) GetTemplate(input *GetTemplateInput) (*GetTemplateOutput, error) {
@@ -2990,14 +2969,13 @@ const opListConfigurationSets = "ListConfigurationSets"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListConfigurationSetsRequest method.
+//	req, resp := client.ListConfigurationSetsRequest(params)
 //
-//    // Example sending a request using the ListConfigurationSetsRequest method.
-//    req, resp := client.ListConfigurationSetsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/ListConfigurationSets
 func (c *SES) ListConfigurationSetsRequest(input *ListConfigurationSetsInput) (req *request.Request, output *ListConfigurationSetsOutput) {
@@ -3019,16 +2997,17 @@ func (c *SES) ListConfigurationSetsRequest(input *ListConfigurationSetsInput) (r
 // ListConfigurationSets API operation for Amazon Simple Email Service.
 //
 // Provides a list of the configuration sets associated with your Amazon SES
-// account in the current AWS Region. For information about using configuration
-// sets, see Monitoring Your Amazon SES Sending Activity (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/monitor-sending-activity.html)
+// account in the current Amazon Web Services Region. For information about
+// using configuration sets, see Monitoring Your Amazon SES Sending Activity
+// (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity.html)
 // in the Amazon SES Developer Guide.
 //
 // You can execute this operation no more than once per second. This operation
-// will return up to 1,000 configuration sets each time it is run. If your Amazon
-// SES account has more than 1,000 configuration sets, this operation will also
-// return a NextToken element. You can then execute the ListConfigurationSets
-// operation again, passing the NextToken parameter and the value of the NextToken
-// element to retrieve additional results.
+// returns up to 1,000 configuration sets each time it is run. If your Amazon
+// SES account has more than 1,000 configuration sets, this operation also returns
+// NextToken. You can then execute the ListConfigurationSets operation again,
+// passing the NextToken parameter and the value of the NextToken element to
+// retrieve additional results.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -3074,14 +3053,13 @@ const opListCustomVerificationEmailTemplates = "ListCustomVerificationEmailTempl
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListCustomVerificationEmailTemplatesRequest method.
+//	req, resp := client.ListCustomVerificationEmailTemplatesRequest(params)
 //
-//    // Example sending a request using the ListCustomVerificationEmailTemplatesRequest method.
-//    req, resp := client.ListCustomVerificationEmailTemplatesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/ListCustomVerificationEmailTemplates
 func (c *SES) ListCustomVerificationEmailTemplatesRequest(input *ListCustomVerificationEmailTemplatesInput) (req *request.Request, output *ListCustomVerificationEmailTemplatesOutput) {
@@ -3109,10 +3087,10 @@ func (c *SES) ListCustomVerificationEmailTemplatesRequest(input *ListCustomVerif
 // ListCustomVerificationEmailTemplates API operation for Amazon Simple Email Service.
 //
 // Lists the existing custom verification email templates for your account in
-// the current AWS Region.
+// the current Amazon Web Services Region.
 //
 // For more information about custom verification email templates, see Using
-// Custom Verification Email Templates (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/custom-verification-emails.html)
+// Custom Verification Email Templates (https://docs.aws.amazon.com/ses/latest/dg/creating-identities.html#send-email-verify-address-custom)
 // in the Amazon SES Developer Guide.
 //
 // You can execute this operation no more than once per second.
@@ -3153,15 +3131,14 @@ func (c *SES) ListCustomVerificationEmailTemplatesWithContext(ctx aws.Context, i
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListCustomVerificationEmailTemplates operation.
-//    pageNum := 0
-//    err := client.ListCustomVerificationEmailTemplatesPages(params,
-//        func(page *ses.ListCustomVerificationEmailTemplatesOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListCustomVerificationEmailTemplates operation.
+//	pageNum := 0
+//	err := client.ListCustomVerificationEmailTemplatesPages(params,
+//	    func(page *ses.ListCustomVerificationEmailTemplatesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *SES) ListCustomVerificationEmailTemplatesPages(input *ListCustomVerificationEmailTemplatesInput, fn func(*ListCustomVerificationEmailTemplatesOutput, bool) bool) error {
 	return c.ListCustomVerificationEmailTemplatesPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -3188,10 +3165,12 @@ func (c *SES) ListCustomVerificationEmailTemplatesPagesWithContext(ctx aws.Conte
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListCustomVerificationEmailTemplatesOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListCustomVerificationEmailTemplatesOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -3211,14 +3190,13 @@ const opListIdentities = "ListIdentities"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListIdentitiesRequest method.
+//	req, resp := client.ListIdentitiesRequest(params)
 //
-//    // Example sending a request using the ListIdentitiesRequest method.
-//    req, resp := client.ListIdentitiesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/ListIdentities
 func (c *SES) ListIdentitiesRequest(input *ListIdentitiesInput) (req *request.Request, output *ListIdentitiesOutput) {
@@ -3246,11 +3224,19 @@ func (c *SES) ListIdentitiesRequest(input *ListIdentitiesInput) (req *request.Re
 // ListIdentities API operation for Amazon Simple Email Service.
 //
 // Returns a list containing all of the identities (email addresses and domains)
-// for your AWS account in the current AWS Region, regardless of verification
-// status.
+// for your Amazon Web Services account in the current Amazon Web Services Region,
+// regardless of verification status.
 //
 // You can execute this operation no more than once per second.
 //
+// It's recommended that for successive pagination calls of this API, you continue
+// to the use the same parameter/value pairs as used in the original call, e.g.,
+// if you used IdentityType=Domain in the the original call and received a NextToken
+// in the response, you should continue providing the IdentityType=Domain parameter
+// for further NextToken calls; however, if you didn't provide the IdentityType
+// parameter in the original call, then continue to not provide it for successive
+// pagination calls. Using this protocol will ensure consistent results.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -3287,15 +3273,14 @@ func (c *SES) ListIdentitiesWithContext(ctx aws.Context, input *ListIdentitiesIn
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListIdentities operation.
-//    pageNum := 0
-//    err := client.ListIdentitiesPages(params,
-//        func(page *ses.ListIdentitiesOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListIdentities operation.
+//	pageNum := 0
+//	err := client.ListIdentitiesPages(params,
+//	    func(page *ses.ListIdentitiesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *SES) ListIdentitiesPages(input *ListIdentitiesInput, fn func(*ListIdentitiesOutput, bool) bool) error {
 	return c.ListIdentitiesPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -3322,10 +3307,12 @@ func (c *SES) ListIdentitiesPagesWithContext(ctx aws.Context, input *ListIdentit
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListIdentitiesOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListIdentitiesOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -3345,14 +3332,13 @@ const opListIdentityPolicies = "ListIdentityPolicies"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListIdentityPoliciesRequest method.
+//	req, resp := client.ListIdentityPoliciesRequest(params)
 //
-//    // Example sending a request using the ListIdentityPoliciesRequest method.
-//    req, resp := client.ListIdentityPoliciesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/ListIdentityPolicies
 func (c *SES) ListIdentityPoliciesRequest(input *ListIdentityPoliciesInput) (req *request.Request, output *ListIdentityPoliciesOutput) {
@@ -3374,15 +3360,15 @@ func (c *SES) ListIdentityPoliciesRequest(input *ListIdentityPoliciesInput) (req
 // ListIdentityPolicies API operation for Amazon Simple Email Service.
 //
 // Returns a list of sending authorization policies that are attached to the
-// given identity (an email address or a domain). This API returns only a list.
-// If you want the actual policy content, you can use GetIdentityPolicies.
+// given identity (an email address or a domain). This operation returns only
+// a list. To get the actual policy content, use GetIdentityPolicies.
 //
-// This API is for the identity owner only. If you have not verified the identity,
-// this API will return an error.
+// This operation is for the identity owner only. If you have not verified the
+// identity, it returns an error.
 //
 // Sending authorization is a feature that enables an identity owner to authorize
 // other senders to use its identities. For information about using sending
-// authorization, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization.html).
+// authorization, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -3430,14 +3416,13 @@ const opListReceiptFilters = "ListReceiptFilters"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListReceiptFiltersRequest method.
+//	req, resp := client.ListReceiptFiltersRequest(params)
 //
-//    // Example sending a request using the ListReceiptFiltersRequest method.
-//    req, resp := client.ListReceiptFiltersRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/ListReceiptFilters
 func (c *SES) ListReceiptFiltersRequest(input *ListReceiptFiltersInput) (req *request.Request, output *ListReceiptFiltersOutput) {
@@ -3458,11 +3443,11 @@ func (c *SES) ListReceiptFiltersRequest(input *ListReceiptFiltersInput) (req *re
 
 // ListReceiptFilters API operation for Amazon Simple Email Service.
 //
-// Lists the IP address filters associated with your AWS account in the current
-// AWS Region.
+// Lists the IP address filters associated with your Amazon Web Services account
+// in the current Amazon Web Services Region.
 //
 // For information about managing IP address filters, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-managing-ip-filters.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-ip-filtering-console-walkthrough.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -3510,14 +3495,13 @@ const opListReceiptRuleSets = "ListReceiptRuleSets"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListReceiptRuleSetsRequest method.
+//	req, resp := client.ListReceiptRuleSetsRequest(params)
 //
-//    // Example sending a request using the ListReceiptRuleSetsRequest method.
-//    req, resp := client.ListReceiptRuleSetsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/ListReceiptRuleSets
 func (c *SES) ListReceiptRuleSetsRequest(input *ListReceiptRuleSetsInput) (req *request.Request, output *ListReceiptRuleSetsOutput) {
@@ -3538,13 +3522,13 @@ func (c *SES) ListReceiptRuleSetsRequest(input *ListReceiptRuleSetsInput) (req *
 
 // ListReceiptRuleSets API operation for Amazon Simple Email Service.
 //
-// Lists the receipt rule sets that exist under your AWS account in the current
-// AWS Region. If there are additional receipt rule sets to be retrieved, you
-// will receive a NextToken that you can provide to the next call to ListReceiptRuleSets
-// to retrieve the additional entries.
+// Lists the receipt rule sets that exist under your Amazon Web Services account
+// in the current Amazon Web Services Region. If there are additional receipt
+// rule sets to be retrieved, you receive a NextToken that you can provide to
+// the next call to ListReceiptRuleSets to retrieve the additional entries.
 //
 // For information about managing receipt rule sets, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-managing-receipt-rule-sets.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-receipt-rules-console-walkthrough.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -3592,14 +3576,13 @@ const opListTemplates = "ListTemplates"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTemplatesRequest method.
+//	req, resp := client.ListTemplatesRequest(params)
 //
-//    // Example sending a request using the ListTemplatesRequest method.
-//    req, resp := client.ListTemplatesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/ListTemplates
 func (c *SES) ListTemplatesRequest(input *ListTemplatesInput) (req *request.Request, output *ListTemplatesOutput) {
@@ -3621,7 +3604,7 @@ func (c *SES) ListTemplatesRequest(input *ListTemplatesInput) (req *request.Requ
 // ListTemplates API operation for Amazon Simple Email Service.
 //
 // Lists the email templates present in your Amazon SES account in the current
-// AWS Region.
+// Amazon Web Services Region.
 //
 // You can execute this operation no more than once per second.
 //
@@ -3669,14 +3652,13 @@ const opListVerifiedEmailAddresses = "ListVerifiedEmailAddresses"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListVerifiedEmailAddressesRequest method.
+//	req, resp := client.ListVerifiedEmailAddressesRequest(params)
 //
-//    // Example sending a request using the ListVerifiedEmailAddressesRequest method.
-//    req, resp := client.ListVerifiedEmailAddressesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/ListVerifiedEmailAddresses
 func (c *SES) ListVerifiedEmailAddressesRequest(input *ListVerifiedEmailAddressesInput) (req *request.Request, output *ListVerifiedEmailAddressesOutput) {
@@ -3744,14 +3726,13 @@ const opPutConfigurationSetDeliveryOptions = "PutConfigurationSetDeliveryOptions
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PutConfigurationSetDeliveryOptionsRequest method.
+//	req, resp := client.PutConfigurationSetDeliveryOptionsRequest(params)
 //
-//    // Example sending a request using the PutConfigurationSetDeliveryOptionsRequest method.
-//    req, resp := client.PutConfigurationSetDeliveryOptionsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/PutConfigurationSetDeliveryOptions
 func (c *SES) PutConfigurationSetDeliveryOptionsRequest(input *PutConfigurationSetDeliveryOptionsInput) (req *request.Request, output *PutConfigurationSetDeliveryOptionsOutput) {
@@ -3783,11 +3764,12 @@ func (c *SES) PutConfigurationSetDeliveryOptionsRequest(input *PutConfigurationS
 // API operation PutConfigurationSetDeliveryOptions for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
-//   Indicates that the configuration set does not exist.
 //
-//   * ErrCodeInvalidDeliveryOptionsException "InvalidDeliveryOptions"
-//   Indicates that provided delivery option is invalid.
+//   - ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
+//     Indicates that the configuration set does not exist.
+//
+//   - ErrCodeInvalidDeliveryOptionsException "InvalidDeliveryOptions"
+//     Indicates that provided delivery option is invalid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/PutConfigurationSetDeliveryOptions
 func (c *SES) PutConfigurationSetDeliveryOptions(input *PutConfigurationSetDeliveryOptionsInput) (*PutConfigurationSetDeliveryOptionsOutput, error) {
@@ -3827,14 +3809,13 @@ const opPutIdentityPolicy = "PutIdentityPolicy"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PutIdentityPolicyRequest method.
+//	req, resp := client.PutIdentityPolicyRequest(params)
 //
-//    // Example sending a request using the PutIdentityPolicyRequest method.
-//    req, resp := client.PutIdentityPolicyRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/PutIdentityPolicy
 func (c *SES) PutIdentityPolicyRequest(input *PutIdentityPolicyInput) (req *request.Request, output *PutIdentityPolicyOutput) {
@@ -3859,12 +3840,12 @@ func (c *SES) PutIdentityPolicyRequest(input *PutIdentityPolicyInput) (req *requ
 // Adds or updates a sending authorization policy for the specified identity
 // (an email address or a domain).
 //
-// This API is for the identity owner only. If you have not verified the identity,
-// this API will return an error.
+// This operation is for the identity owner only. If you have not verified the
+// identity, it returns an error.
 //
 // Sending authorization is a feature that enables an identity owner to authorize
 // other senders to use its identities. For information about using sending
-// authorization, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization.html).
+// authorization, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -3876,9 +3857,9 @@ func (c *SES) PutIdentityPolicyRequest(input *PutIdentityPolicyInput) (req *requ
 // API operation PutIdentityPolicy for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidPolicyException "InvalidPolicy"
-//   Indicates that the provided policy is invalid. Check the error stack for
-//   more information about what caused the error.
+//   - ErrCodeInvalidPolicyException "InvalidPolicy"
+//     Indicates that the provided policy is invalid. Check the error stack for
+//     more information about what caused the error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/PutIdentityPolicy
 func (c *SES) PutIdentityPolicy(input *PutIdentityPolicyInput) (*PutIdentityPolicyOutput, error) {
@@ -3918,14 +3899,13 @@ const opReorderReceiptRuleSet = "ReorderReceiptRuleSet"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ReorderReceiptRuleSetRequest method.
+//	req, resp := client.ReorderReceiptRuleSetRequest(params)
 //
-//    // Example sending a request using the ReorderReceiptRuleSetRequest method.
-//    req, resp := client.ReorderReceiptRuleSetRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/ReorderReceiptRuleSet
 func (c *SES) ReorderReceiptRuleSetRequest(input *ReorderReceiptRuleSetInput) (req *request.Request, output *ReorderReceiptRuleSetOutput) {
@@ -3950,11 +3930,11 @@ func (c *SES) ReorderReceiptRuleSetRequest(input *ReorderReceiptRuleSetInput) (r
 // Reorders the receipt rules within a receipt rule set.
 //
 // All of the rules in the rule set must be represented in this request. That
-// is, this API will return an error if the reorder request doesn't explicitly
-// position all of the rules.
+// is, it is error if the reorder request doesn't explicitly position all of
+// the rules.
 //
 // For information about managing receipt rule sets, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-managing-receipt-rule-sets.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-receipt-rules-console-walkthrough.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -3966,11 +3946,12 @@ func (c *SES) ReorderReceiptRuleSetRequest(input *ReorderReceiptRuleSetInput) (r
 // API operation ReorderReceiptRuleSet for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeRuleSetDoesNotExistException "RuleSetDoesNotExist"
-//   Indicates that the provided receipt rule set does not exist.
 //
-//   * ErrCodeRuleDoesNotExistException "RuleDoesNotExist"
-//   Indicates that the provided receipt rule does not exist.
+//   - ErrCodeRuleSetDoesNotExistException "RuleSetDoesNotExist"
+//     Indicates that the provided receipt rule set does not exist.
+//
+//   - ErrCodeRuleDoesNotExistException "RuleDoesNotExist"
+//     Indicates that the provided receipt rule does not exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/ReorderReceiptRuleSet
 func (c *SES) ReorderReceiptRuleSet(input *ReorderReceiptRuleSetInput) (*ReorderReceiptRuleSetOutput, error) {
@@ -4010,14 +3991,13 @@ const opSendBounce = "SendBounce"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SendBounceRequest method.
+//	req, resp := client.SendBounceRequest(params)
 //
-//    // Example sending a request using the SendBounceRequest method.
-//    req, resp := client.SendBounceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/SendBounce
 func (c *SES) SendBounceRequest(input *SendBounceInput) (req *request.Request, output *SendBounceOutput) {
@@ -4039,14 +4019,14 @@ func (c *SES) SendBounceRequest(input *SendBounceInput) (req *request.Request, o
 // SendBounce API operation for Amazon Simple Email Service.
 //
 // Generates and sends a bounce message to the sender of an email you received
-// through Amazon SES. You can only use this API on an email up to 24 hours
-// after you receive it.
+// through Amazon SES. You can only use this operation on an email up to 24
+// hours after you receive it.
 //
-// You cannot use this API to send generic bounces for mail that was not received
-// by Amazon SES.
+// You cannot use this operation to send generic bounces for mail that was not
+// received by Amazon SES.
 //
 // For information about receiving email through Amazon SES, see the Amazon
-// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email.html).
+// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -4058,9 +4038,9 @@ func (c *SES) SendBounceRequest(input *SendBounceInput) (req *request.Request, o
 // API operation SendBounce for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeMessageRejected "MessageRejected"
-//   Indicates that the action failed, and the message could not be sent. Check
-//   the error stack for more information about what caused the error.
+//   - ErrCodeMessageRejected "MessageRejected"
+//     Indicates that the action failed, and the message could not be sent. Check
+//     the error stack for more information about what caused the error.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/SendBounce
 func (c *SES) SendBounce(input *SendBounceInput) (*SendBounceOutput, error) {
@@ -4100,14 +4080,13 @@ const opSendBulkTemplatedEmail = "SendBulkTemplatedEmail"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SendBulkTemplatedEmailRequest method.
+//	req, resp := client.SendBulkTemplatedEmailRequest(params)
 //
-//    // Example sending a request using the SendBulkTemplatedEmailRequest method.
-//    req, resp := client.SendBulkTemplatedEmailRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/SendBulkTemplatedEmail
 func (c *SES) SendBulkTemplatedEmailRequest(input *SendBulkTemplatedEmailInput) (req *request.Request, output *SendBulkTemplatedEmailOutput) {
@@ -4131,37 +4110,36 @@ func (c *SES) SendBulkTemplatedEmailRequest(input *SendBulkTemplatedEmailInput)
 // Composes an email message to multiple destinations. The message body is created
 // using an email template.
 //
-// In order to send email using the SendBulkTemplatedEmail operation, your call
-// to the API must meet the following requirements:
+// To send email using this operation, your call must meet the following requirements:
 //
-//    * The call must refer to an existing email template. You can create email
-//    templates using the CreateTemplate operation.
+//   - The call must refer to an existing email template. You can create email
+//     templates using CreateTemplate.
 //
-//    * The message must be sent from a verified email address or domain.
+//   - The message must be sent from a verified email address or domain.
 //
-//    * If your account is still in the Amazon SES sandbox, you may only send
-//    to verified addresses or domains, or to email addresses associated with
-//    the Amazon SES Mailbox Simulator. For more information, see Verifying
-//    Email Addresses and Domains (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/verify-addresses-and-domains.html)
-//    in the Amazon SES Developer Guide.
+//   - If your account is still in the Amazon SES sandbox, you may send only
+//     to verified addresses or domains, or to email addresses associated with
+//     the Amazon SES Mailbox Simulator. For more information, see Verifying
+//     Email Addresses and Domains (https://docs.aws.amazon.com/ses/latest/dg/verify-addresses-and-domains.html)
+//     in the Amazon SES Developer Guide.
 //
-//    * The maximum message size is 10 MB.
+//   - The maximum message size is 10 MB.
 //
-//    * Each Destination parameter must include at least one recipient email
-//    address. The recipient address can be a To: address, a CC: address, or
-//    a BCC: address. If a recipient email address is invalid (that is, it is
-//    not in the format UserName@[SubDomain.]Domain.TopLevelDomain), the entire
-//    message will be rejected, even if the message contains other recipients
-//    that are valid.
+//   - Each Destination parameter must include at least one recipient email
+//     address. The recipient address can be a To: address, a CC: address, or
+//     a BCC: address. If a recipient email address is invalid (that is, it is
+//     not in the format UserName@[SubDomain.]Domain.TopLevelDomain), the entire
+//     message is rejected, even if the message contains other recipients that
+//     are valid.
 //
-//    * The message may not include more than 50 recipients, across the To:,
-//    CC: and BCC: fields. If you need to send an email message to a larger
-//    audience, you can divide your recipient list into groups of 50 or fewer,
-//    and then call the SendBulkTemplatedEmail operation several times to send
-//    the message to each group.
+//   - The message may not include more than 50 recipients, across the To:,
+//     CC: and BCC: fields. If you need to send an email message to a larger
+//     audience, you can divide your recipient list into groups of 50 or fewer,
+//     and then call the SendBulkTemplatedEmail operation several times to send
+//     the message to each group.
 //
-//    * The number of destinations you can contact in a single call to the API
-//    may be limited by your account's maximum sending rate.
+//   - The number of destinations you can contact in a single call can be limited
+//     by your account's maximum sending rate.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -4171,33 +4149,34 @@ func (c *SES) SendBulkTemplatedEmailRequest(input *SendBulkTemplatedEmailInput)
 // API operation SendBulkTemplatedEmail for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeMessageRejected "MessageRejected"
-//   Indicates that the action failed, and the message could not be sent. Check
-//   the error stack for more information about what caused the error.
 //
-//   * ErrCodeMailFromDomainNotVerifiedException "MailFromDomainNotVerifiedException"
-//   Indicates that the message could not be sent because Amazon SES could not
-//   read the MX record required to use the specified MAIL FROM domain. For information
-//   about editing the custom MAIL FROM domain settings for an identity, see the
-//   Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/mail-from-edit.html).
+//   - ErrCodeMessageRejected "MessageRejected"
+//     Indicates that the action failed, and the message could not be sent. Check
+//     the error stack for more information about what caused the error.
+//
+//   - ErrCodeMailFromDomainNotVerifiedException "MailFromDomainNotVerifiedException"
+//     Indicates that the message could not be sent because Amazon SES could not
+//     read the MX record required to use the specified MAIL FROM domain. For information
+//     about editing the custom MAIL FROM domain settings for an identity, see the
+//     Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/mail-from-edit.html).
 //
-//   * ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
-//   Indicates that the configuration set does not exist.
+//   - ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
+//     Indicates that the configuration set does not exist.
 //
-//   * ErrCodeTemplateDoesNotExistException "TemplateDoesNotExist"
-//   Indicates that the Template object you specified does not exist in your Amazon
-//   SES account.
+//   - ErrCodeTemplateDoesNotExistException "TemplateDoesNotExist"
+//     Indicates that the Template object you specified does not exist in your Amazon
+//     SES account.
 //
-//   * ErrCodeConfigurationSetSendingPausedException "ConfigurationSetSendingPausedException"
-//   Indicates that email sending is disabled for the configuration set.
+//   - ErrCodeConfigurationSetSendingPausedException "ConfigurationSetSendingPausedException"
+//     Indicates that email sending is disabled for the configuration set.
 //
-//   You can enable or disable email sending for a configuration set using UpdateConfigurationSetSendingEnabled.
+//     You can enable or disable email sending for a configuration set using UpdateConfigurationSetSendingEnabled.
 //
-//   * ErrCodeAccountSendingPausedException "AccountSendingPausedException"
-//   Indicates that email sending is disabled for your entire Amazon SES account.
+//   - ErrCodeAccountSendingPausedException "AccountSendingPausedException"
+//     Indicates that email sending is disabled for your entire Amazon SES account.
 //
-//   You can enable or disable email sending for your Amazon SES account using
-//   UpdateAccountSendingEnabled.
+//     You can enable or disable email sending for your Amazon SES account using
+//     UpdateAccountSendingEnabled.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/SendBulkTemplatedEmail
 func (c *SES) SendBulkTemplatedEmail(input *SendBulkTemplatedEmailInput) (*SendBulkTemplatedEmailOutput, error) {
@@ -4237,14 +4216,13 @@ const opSendCustomVerificationEmail = "SendCustomVerificationEmail"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SendCustomVerificationEmailRequest method.
+//	req, resp := client.SendCustomVerificationEmailRequest(params)
 //
-//    // Example sending a request using the SendCustomVerificationEmailRequest method.
-//    req, resp := client.SendCustomVerificationEmailRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/SendCustomVerificationEmail
 func (c *SES) SendCustomVerificationEmailRequest(input *SendCustomVerificationEmailInput) (req *request.Request, output *SendCustomVerificationEmailOutput) {
@@ -4266,13 +4244,13 @@ func (c *SES) SendCustomVerificationEmailRequest(input *SendCustomVerificationEm
 // SendCustomVerificationEmail API operation for Amazon Simple Email Service.
 //
 // Adds an email address to the list of identities for your Amazon SES account
-// in the current AWS Region and attempts to verify it. As a result of executing
-// this operation, a customized verification email is sent to the specified
-// address.
+// in the current Amazon Web Services Region and attempts to verify it. As a
+// result of executing this operation, a customized verification email is sent
+// to the specified address.
 //
 // To use this operation, you must first create a custom verification email
 // template. For more information about creating and using custom verification
-// email templates, see Using Custom Verification Email Templates (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/custom-verification-emails.html)
+// email templates, see Using Custom Verification Email Templates (https://docs.aws.amazon.com/ses/latest/dg/creating-identities.html#send-email-verify-address-custom)
 // in the Amazon SES Developer Guide.
 //
 // You can execute this operation no more than once per second.
@@ -4285,24 +4263,25 @@ func (c *SES) SendCustomVerificationEmailRequest(input *SendCustomVerificationEm
 // API operation SendCustomVerificationEmail for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeMessageRejected "MessageRejected"
-//   Indicates that the action failed, and the message could not be sent. Check
-//   the error stack for more information about what caused the error.
 //
-//   * ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
-//   Indicates that the configuration set does not exist.
+//   - ErrCodeMessageRejected "MessageRejected"
+//     Indicates that the action failed, and the message could not be sent. Check
+//     the error stack for more information about what caused the error.
 //
-//   * ErrCodeCustomVerificationEmailTemplateDoesNotExistException "CustomVerificationEmailTemplateDoesNotExist"
-//   Indicates that a custom verification email template with the name you specified
-//   does not exist.
+//   - ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
+//     Indicates that the configuration set does not exist.
 //
-//   * ErrCodeFromEmailAddressNotVerifiedException "FromEmailAddressNotVerified"
-//   Indicates that the sender address specified for a custom verification email
-//   is not verified, and is therefore not eligible to send the custom verification
-//   email.
+//   - ErrCodeCustomVerificationEmailTemplateDoesNotExistException "CustomVerificationEmailTemplateDoesNotExist"
+//     Indicates that a custom verification email template with the name you specified
+//     does not exist.
 //
-//   * ErrCodeProductionAccessNotGrantedException "ProductionAccessNotGranted"
-//   Indicates that the account has not been granted production access.
+//   - ErrCodeFromEmailAddressNotVerifiedException "FromEmailAddressNotVerified"
+//     Indicates that the sender address specified for a custom verification email
+//     is not verified, and is therefore not eligible to send the custom verification
+//     email.
+//
+//   - ErrCodeProductionAccessNotGrantedException "ProductionAccessNotGranted"
+//     Indicates that the account has not been granted production access.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/SendCustomVerificationEmail
 func (c *SES) SendCustomVerificationEmail(input *SendCustomVerificationEmailInput) (*SendCustomVerificationEmailOutput, error) {
@@ -4342,14 +4321,13 @@ const opSendEmail = "SendEmail"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SendEmailRequest method.
+//	req, resp := client.SendEmailRequest(params)
 //
-//    // Example sending a request using the SendEmailRequest method.
-//    req, resp := client.SendEmailRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/SendEmail
 func (c *SES) SendEmailRequest(input *SendEmailInput) (req *request.Request, output *SendEmailOutput) {
@@ -4370,39 +4348,38 @@ func (c *SES) SendEmailRequest(input *SendEmailInput) (req *request.Request, out
 
 // SendEmail API operation for Amazon Simple Email Service.
 //
-// Composes an email message and immediately queues it for sending. In order
-// to send email using the SendEmail operation, your message must meet the following
-// requirements:
+// Composes an email message and immediately queues it for sending. To send
+// email using this operation, your message must meet the following requirements:
 //
-//    * The message must be sent from a verified email address or domain. If
-//    you attempt to send email using a non-verified address or domain, the
-//    operation will result in an "Email address not verified" error.
+//   - The message must be sent from a verified email address or domain. If
+//     you attempt to send email using a non-verified address or domain, the
+//     operation results in an "Email address not verified" error.
 //
-//    * If your account is still in the Amazon SES sandbox, you may only send
-//    to verified addresses or domains, or to email addresses associated with
-//    the Amazon SES Mailbox Simulator. For more information, see Verifying
-//    Email Addresses and Domains (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/verify-addresses-and-domains.html)
-//    in the Amazon SES Developer Guide.
+//   - If your account is still in the Amazon SES sandbox, you may only send
+//     to verified addresses or domains, or to email addresses associated with
+//     the Amazon SES Mailbox Simulator. For more information, see Verifying
+//     Email Addresses and Domains (https://docs.aws.amazon.com/ses/latest/dg/verify-addresses-and-domains.html)
+//     in the Amazon SES Developer Guide.
 //
-//    * The maximum message size is 10 MB.
+//   - The maximum message size is 10 MB.
 //
-//    * The message must include at least one recipient email address. The recipient
-//    address can be a To: address, a CC: address, or a BCC: address. If a recipient
-//    email address is invalid (that is, it is not in the format UserName@[SubDomain.]Domain.TopLevelDomain),
-//    the entire message will be rejected, even if the message contains other
-//    recipients that are valid.
+//   - The message must include at least one recipient email address. The recipient
+//     address can be a To: address, a CC: address, or a BCC: address. If a recipient
+//     email address is invalid (that is, it is not in the format UserName@[SubDomain.]Domain.TopLevelDomain),
+//     the entire message is rejected, even if the message contains other recipients
+//     that are valid.
 //
-//    * The message may not include more than 50 recipients, across the To:,
-//    CC: and BCC: fields. If you need to send an email message to a larger
-//    audience, you can divide your recipient list into groups of 50 or fewer,
-//    and then call the SendEmail operation several times to send the message
-//    to each group.
+//   - The message may not include more than 50 recipients, across the To:,
+//     CC: and BCC: fields. If you need to send an email message to a larger
+//     audience, you can divide your recipient list into groups of 50 or fewer,
+//     and then call the SendEmail operation several times to send the message
+//     to each group.
 //
 // For every message that you send, the total number of recipients (including
 // each recipient in the To:, CC: and BCC: fields) is counted against the maximum
 // number of emails you can send in a 24-hour period (your sending quota). For
 // more information about sending quotas in Amazon SES, see Managing Your Amazon
-// SES Sending Limits (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/manage-sending-limits.html)
+// SES Sending Limits (https://docs.aws.amazon.com/ses/latest/dg/manage-sending-quotas.html)
 // in the Amazon SES Developer Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
@@ -4413,29 +4390,30 @@ func (c *SES) SendEmailRequest(input *SendEmailInput) (req *request.Request, out
 // API operation SendEmail for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeMessageRejected "MessageRejected"
-//   Indicates that the action failed, and the message could not be sent. Check
-//   the error stack for more information about what caused the error.
 //
-//   * ErrCodeMailFromDomainNotVerifiedException "MailFromDomainNotVerifiedException"
-//   Indicates that the message could not be sent because Amazon SES could not
-//   read the MX record required to use the specified MAIL FROM domain. For information
-//   about editing the custom MAIL FROM domain settings for an identity, see the
-//   Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/mail-from-edit.html).
+//   - ErrCodeMessageRejected "MessageRejected"
+//     Indicates that the action failed, and the message could not be sent. Check
+//     the error stack for more information about what caused the error.
 //
-//   * ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
-//   Indicates that the configuration set does not exist.
+//   - ErrCodeMailFromDomainNotVerifiedException "MailFromDomainNotVerifiedException"
+//     Indicates that the message could not be sent because Amazon SES could not
+//     read the MX record required to use the specified MAIL FROM domain. For information
+//     about editing the custom MAIL FROM domain settings for an identity, see the
+//     Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/mail-from-edit.html).
 //
-//   * ErrCodeConfigurationSetSendingPausedException "ConfigurationSetSendingPausedException"
-//   Indicates that email sending is disabled for the configuration set.
+//   - ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
+//     Indicates that the configuration set does not exist.
 //
-//   You can enable or disable email sending for a configuration set using UpdateConfigurationSetSendingEnabled.
+//   - ErrCodeConfigurationSetSendingPausedException "ConfigurationSetSendingPausedException"
+//     Indicates that email sending is disabled for the configuration set.
 //
-//   * ErrCodeAccountSendingPausedException "AccountSendingPausedException"
-//   Indicates that email sending is disabled for your entire Amazon SES account.
+//     You can enable or disable email sending for a configuration set using UpdateConfigurationSetSendingEnabled.
 //
-//   You can enable or disable email sending for your Amazon SES account using
-//   UpdateAccountSendingEnabled.
+//   - ErrCodeAccountSendingPausedException "AccountSendingPausedException"
+//     Indicates that email sending is disabled for your entire Amazon SES account.
+//
+//     You can enable or disable email sending for your Amazon SES account using
+//     UpdateAccountSendingEnabled.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/SendEmail
 func (c *SES) SendEmail(input *SendEmailInput) (*SendEmailOutput, error) {
@@ -4475,14 +4453,13 @@ const opSendRawEmail = "SendRawEmail"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SendRawEmailRequest method.
+//	req, resp := client.SendRawEmailRequest(params)
 //
-//    // Example sending a request using the SendRawEmailRequest method.
-//    req, resp := client.SendRawEmailRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/SendRawEmail
 func (c *SES) SendRawEmailRequest(input *SendRawEmailInput) (req *request.Request, output *SendRawEmailOutput) {
@@ -4505,75 +4482,75 @@ func (c *SES) SendRawEmailRequest(input *SendRawEmailInput) (req *request.Reques
 //
 // Composes an email message and immediately queues it for sending.
 //
-// This operation is more flexible than the SendEmail API operation. When you
-// use the SendRawEmail operation, you can specify the headers of the message
-// as well as its content. This flexibility is useful, for example, when you
-// want to send a multipart MIME email (such a message that contains both a
-// text and an HTML version). You can also use this operation to send messages
-// that include attachments.
+// This operation is more flexible than the SendEmail operation. When you use
+// the SendRawEmail operation, you can specify the headers of the message as
+// well as its content. This flexibility is useful, for example, when you need
+// to send a multipart MIME email (such a message that contains both a text
+// and an HTML version). You can also use this operation to send messages that
+// include attachments.
 //
 // The SendRawEmail operation has the following requirements:
 //
-//    * You can only send email from verified email addresses or domains (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/verify-addresses-and-domains.html).
-//    If you try to send email from an address that isn't verified, the operation
-//    results in an "Email address not verified" error.
+//   - You can only send email from verified email addresses or domains (https://docs.aws.amazon.com/ses/latest/dg/verify-addresses-and-domains.html).
+//     If you try to send email from an address that isn't verified, the operation
+//     results in an "Email address not verified" error.
 //
-//    * If your account is still in the Amazon SES sandbox (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/request-production-access.html),
-//    you can only send email to other verified addresses in your account, or
-//    to addresses that are associated with the Amazon SES mailbox simulator
-//    (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/mailbox-simulator.html).
+//   - If your account is still in the Amazon SES sandbox (https://docs.aws.amazon.com/ses/latest/dg/request-production-access.html),
+//     you can only send email to other verified addresses in your account, or
+//     to addresses that are associated with the Amazon SES mailbox simulator
+//     (https://docs.aws.amazon.com/ses/latest/dg/send-an-email-from-console.html).
 //
-//    * The maximum message size, including attachments, is 10 MB.
+//   - The maximum message size, including attachments, is 10 MB.
 //
-//    * Each message has to include at least one recipient address. A recipient
-//    address includes any address on the To:, CC:, or BCC: lines.
+//   - Each message has to include at least one recipient address. A recipient
+//     address includes any address on the To:, CC:, or BCC: lines.
 //
-//    * If you send a single message to more than one recipient address, and
-//    one of the recipient addresses isn't in a valid format (that is, it's
-//    not in the format UserName@[SubDomain.]Domain.TopLevelDomain), Amazon
-//    SES rejects the entire message, even if the other addresses are valid.
+//   - If you send a single message to more than one recipient address, and
+//     one of the recipient addresses isn't in a valid format (that is, it's
+//     not in the format UserName@[SubDomain.]Domain.TopLevelDomain), Amazon
+//     SES rejects the entire message, even if the other addresses are valid.
 //
-//    * Each message can include up to 50 recipient addresses across the To:,
-//    CC:, or BCC: lines. If you need to send a single message to more than
-//    50 recipients, you have to split the list of recipient addresses into
-//    groups of less than 50 recipients, and send separate messages to each
-//    group.
+//   - Each message can include up to 50 recipient addresses across the To:,
+//     CC:, or BCC: lines. If you need to send a single message to more than
+//     50 recipients, you have to split the list of recipient addresses into
+//     groups of less than 50 recipients, and send separate messages to each
+//     group.
 //
-//    * Amazon SES allows you to specify 8-bit Content-Transfer-Encoding for
-//    MIME message parts. However, if Amazon SES has to modify the contents
-//    of your message (for example, if you use open and click tracking), 8-bit
-//    content isn't preserved. For this reason, we highly recommend that you
-//    encode all content that isn't 7-bit ASCII. For more information, see MIME
-//    Encoding (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/send-email-raw.html#send-email-mime-encoding)
-//    in the Amazon SES Developer Guide.
+//   - Amazon SES allows you to specify 8-bit Content-Transfer-Encoding for
+//     MIME message parts. However, if Amazon SES has to modify the contents
+//     of your message (for example, if you use open and click tracking), 8-bit
+//     content isn't preserved. For this reason, we highly recommend that you
+//     encode all content that isn't 7-bit ASCII. For more information, see MIME
+//     Encoding (https://docs.aws.amazon.com/ses/latest/dg/send-email-raw.html#send-email-mime-encoding)
+//     in the Amazon SES Developer Guide.
 //
 // Additionally, keep the following considerations in mind when using the SendRawEmail
 // operation:
 //
-//    * Although you can customize the message headers when using the SendRawEmail
-//    operation, Amazon SES will automatically apply its own Message-ID and
-//    Date headers; if you passed these headers when creating the message, they
-//    will be overwritten by the values that Amazon SES provides.
-//
-//    * If you are using sending authorization to send on behalf of another
-//    user, SendRawEmail enables you to specify the cross-account identity for
-//    the email's Source, From, and Return-Path parameters in one of two ways:
-//    you can pass optional parameters SourceArn, FromArn, and/or ReturnPathArn
-//    to the API, or you can include the following X-headers in the header of
-//    your raw email: X-SES-SOURCE-ARN X-SES-FROM-ARN X-SES-RETURN-PATH-ARN
-//    Don't include these X-headers in the DKIM signature. Amazon SES removes
-//    these before it sends the email. If you only specify the SourceIdentityArn
-//    parameter, Amazon SES sets the From and Return-Path addresses to the same
-//    identity that you specified. For more information about sending authorization,
-//    see the Using Sending Authorization with Amazon SES (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization.html)
-//    in the Amazon SES Developer Guide.
-//
-//    * For every message that you send, the total number of recipients (including
-//    each recipient in the To:, CC: and BCC: fields) is counted against the
-//    maximum number of emails you can send in a 24-hour period (your sending
-//    quota). For more information about sending quotas in Amazon SES, see Managing
-//    Your Amazon SES Sending Limits (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/manage-sending-limits.html)
-//    in the Amazon SES Developer Guide.
+//   - Although you can customize the message headers when using the SendRawEmail
+//     operation, Amazon SES automatically applies its own Message-ID and Date
+//     headers; if you passed these headers when creating the message, they are
+//     overwritten by the values that Amazon SES provides.
+//
+//   - If you are using sending authorization to send on behalf of another
+//     user, SendRawEmail enables you to specify the cross-account identity for
+//     the email's Source, From, and Return-Path parameters in one of two ways:
+//     you can pass optional parameters SourceArn, FromArn, and/or ReturnPathArn,
+//     or you can include the following X-headers in the header of your raw email:
+//     X-SES-SOURCE-ARN X-SES-FROM-ARN X-SES-RETURN-PATH-ARN Don't include these
+//     X-headers in the DKIM signature. Amazon SES removes these before it sends
+//     the email. If you only specify the SourceIdentityArn parameter, Amazon
+//     SES sets the From and Return-Path addresses to the same identity that
+//     you specified. For more information about sending authorization, see the
+//     Using Sending Authorization with Amazon SES (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization.html)
+//     in the Amazon SES Developer Guide.
+//
+//   - For every message that you send, the total number of recipients (including
+//     each recipient in the To:, CC: and BCC: fields) is counted against the
+//     maximum number of emails you can send in a 24-hour period (your sending
+//     quota). For more information about sending quotas in Amazon SES, see Managing
+//     Your Amazon SES Sending Limits (https://docs.aws.amazon.com/ses/latest/dg/manage-sending-quotas.html)
+//     in the Amazon SES Developer Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -4583,29 +4560,30 @@ func (c *SES) SendRawEmailRequest(input *SendRawEmailInput) (req *request.Reques
 // API operation SendRawEmail for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeMessageRejected "MessageRejected"
-//   Indicates that the action failed, and the message could not be sent. Check
-//   the error stack for more information about what caused the error.
 //
-//   * ErrCodeMailFromDomainNotVerifiedException "MailFromDomainNotVerifiedException"
-//   Indicates that the message could not be sent because Amazon SES could not
-//   read the MX record required to use the specified MAIL FROM domain. For information
-//   about editing the custom MAIL FROM domain settings for an identity, see the
-//   Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/mail-from-edit.html).
+//   - ErrCodeMessageRejected "MessageRejected"
+//     Indicates that the action failed, and the message could not be sent. Check
+//     the error stack for more information about what caused the error.
 //
-//   * ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
-//   Indicates that the configuration set does not exist.
+//   - ErrCodeMailFromDomainNotVerifiedException "MailFromDomainNotVerifiedException"
+//     Indicates that the message could not be sent because Amazon SES could not
+//     read the MX record required to use the specified MAIL FROM domain. For information
+//     about editing the custom MAIL FROM domain settings for an identity, see the
+//     Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/mail-from-edit.html).
 //
-//   * ErrCodeConfigurationSetSendingPausedException "ConfigurationSetSendingPausedException"
-//   Indicates that email sending is disabled for the configuration set.
+//   - ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
+//     Indicates that the configuration set does not exist.
 //
-//   You can enable or disable email sending for a configuration set using UpdateConfigurationSetSendingEnabled.
+//   - ErrCodeConfigurationSetSendingPausedException "ConfigurationSetSendingPausedException"
+//     Indicates that email sending is disabled for the configuration set.
 //
-//   * ErrCodeAccountSendingPausedException "AccountSendingPausedException"
-//   Indicates that email sending is disabled for your entire Amazon SES account.
+//     You can enable or disable email sending for a configuration set using UpdateConfigurationSetSendingEnabled.
 //
-//   You can enable or disable email sending for your Amazon SES account using
-//   UpdateAccountSendingEnabled.
+//   - ErrCodeAccountSendingPausedException "AccountSendingPausedException"
+//     Indicates that email sending is disabled for your entire Amazon SES account.
+//
+//     You can enable or disable email sending for your Amazon SES account using
+//     UpdateAccountSendingEnabled.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/SendRawEmail
 func (c *SES) SendRawEmail(input *SendRawEmailInput) (*SendRawEmailOutput, error) {
@@ -4645,14 +4623,13 @@ const opSendTemplatedEmail = "SendTemplatedEmail"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SendTemplatedEmailRequest method.
+//	req, resp := client.SendTemplatedEmailRequest(params)
 //
-//    // Example sending a request using the SendTemplatedEmailRequest method.
-//    req, resp := client.SendTemplatedEmailRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/SendTemplatedEmail
 func (c *SES) SendTemplatedEmailRequest(input *SendTemplatedEmailInput) (req *request.Request, output *SendTemplatedEmailOutput) {
@@ -4676,33 +4653,32 @@ func (c *SES) SendTemplatedEmailRequest(input *SendTemplatedEmailInput) (req *re
 // Composes an email message using an email template and immediately queues
 // it for sending.
 //
-// In order to send email using the SendTemplatedEmail operation, your call
-// to the API must meet the following requirements:
+// To send email using this operation, your call must meet the following requirements:
 //
-//    * The call must refer to an existing email template. You can create email
-//    templates using the CreateTemplate operation.
+//   - The call must refer to an existing email template. You can create email
+//     templates using the CreateTemplate operation.
 //
-//    * The message must be sent from a verified email address or domain.
+//   - The message must be sent from a verified email address or domain.
 //
-//    * If your account is still in the Amazon SES sandbox, you may only send
-//    to verified addresses or domains, or to email addresses associated with
-//    the Amazon SES Mailbox Simulator. For more information, see Verifying
-//    Email Addresses and Domains (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/verify-addresses-and-domains.html)
-//    in the Amazon SES Developer Guide.
+//   - If your account is still in the Amazon SES sandbox, you may only send
+//     to verified addresses or domains, or to email addresses associated with
+//     the Amazon SES Mailbox Simulator. For more information, see Verifying
+//     Email Addresses and Domains (https://docs.aws.amazon.com/ses/latest/dg/verify-addresses-and-domains.html)
+//     in the Amazon SES Developer Guide.
 //
-//    * The maximum message size is 10 MB.
+//   - The maximum message size is 10 MB.
 //
-//    * Calls to the SendTemplatedEmail operation may only include one Destination
-//    parameter. A destination is a set of recipients who will receive the same
-//    version of the email. The Destination parameter can include up to 50 recipients,
-//    across the To:, CC: and BCC: fields.
+//   - Calls to the SendTemplatedEmail operation may only include one Destination
+//     parameter. A destination is a set of recipients that receives the same
+//     version of the email. The Destination parameter can include up to 50 recipients,
+//     across the To:, CC: and BCC: fields.
 //
-//    * The Destination parameter must include at least one recipient email
-//    address. The recipient address can be a To: address, a CC: address, or
-//    a BCC: address. If a recipient email address is invalid (that is, it is
-//    not in the format UserName@[SubDomain.]Domain.TopLevelDomain), the entire
-//    message will be rejected, even if the message contains other recipients
-//    that are valid.
+//   - The Destination parameter must include at least one recipient email
+//     address. The recipient address can be a To: address, a CC: address, or
+//     a BCC: address. If a recipient email address is invalid (that is, it is
+//     not in the format UserName@[SubDomain.]Domain.TopLevelDomain), the entire
+//     message is rejected, even if the message contains other recipients that
+//     are valid.
 //
 // If your call to the SendTemplatedEmail operation includes all of the required
 // parameters, Amazon SES accepts it and returns a Message ID. However, if Amazon
@@ -4712,7 +4688,7 @@ func (c *SES) SendTemplatedEmailRequest(input *SendTemplatedEmailInput) (req *re
 //
 // For these reasons, we highly recommend that you set up Amazon SES to send
 // you notifications when Rendering Failure events occur. For more information,
-// see Sending Personalized Email Using the Amazon SES API (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/send-personalized-email-api.html)
+// see Sending Personalized Email Using the Amazon SES API (https://docs.aws.amazon.com/ses/latest/dg/send-personalized-email-api.html)
 // in the Amazon Simple Email Service Developer Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
@@ -4723,33 +4699,34 @@ func (c *SES) SendTemplatedEmailRequest(input *SendTemplatedEmailInput) (req *re
 // API operation SendTemplatedEmail for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeMessageRejected "MessageRejected"
-//   Indicates that the action failed, and the message could not be sent. Check
-//   the error stack for more information about what caused the error.
 //
-//   * ErrCodeMailFromDomainNotVerifiedException "MailFromDomainNotVerifiedException"
-//   Indicates that the message could not be sent because Amazon SES could not
-//   read the MX record required to use the specified MAIL FROM domain. For information
-//   about editing the custom MAIL FROM domain settings for an identity, see the
-//   Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/mail-from-edit.html).
+//   - ErrCodeMessageRejected "MessageRejected"
+//     Indicates that the action failed, and the message could not be sent. Check
+//     the error stack for more information about what caused the error.
 //
-//   * ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
-//   Indicates that the configuration set does not exist.
+//   - ErrCodeMailFromDomainNotVerifiedException "MailFromDomainNotVerifiedException"
+//     Indicates that the message could not be sent because Amazon SES could not
+//     read the MX record required to use the specified MAIL FROM domain. For information
+//     about editing the custom MAIL FROM domain settings for an identity, see the
+//     Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/mail-from-edit.html).
 //
-//   * ErrCodeTemplateDoesNotExistException "TemplateDoesNotExist"
-//   Indicates that the Template object you specified does not exist in your Amazon
-//   SES account.
+//   - ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
+//     Indicates that the configuration set does not exist.
 //
-//   * ErrCodeConfigurationSetSendingPausedException "ConfigurationSetSendingPausedException"
-//   Indicates that email sending is disabled for the configuration set.
+//   - ErrCodeTemplateDoesNotExistException "TemplateDoesNotExist"
+//     Indicates that the Template object you specified does not exist in your Amazon
+//     SES account.
 //
-//   You can enable or disable email sending for a configuration set using UpdateConfigurationSetSendingEnabled.
+//   - ErrCodeConfigurationSetSendingPausedException "ConfigurationSetSendingPausedException"
+//     Indicates that email sending is disabled for the configuration set.
 //
-//   * ErrCodeAccountSendingPausedException "AccountSendingPausedException"
-//   Indicates that email sending is disabled for your entire Amazon SES account.
+//     You can enable or disable email sending for a configuration set using UpdateConfigurationSetSendingEnabled.
 //
-//   You can enable or disable email sending for your Amazon SES account using
-//   UpdateAccountSendingEnabled.
+//   - ErrCodeAccountSendingPausedException "AccountSendingPausedException"
+//     Indicates that email sending is disabled for your entire Amazon SES account.
+//
+//     You can enable or disable email sending for your Amazon SES account using
+//     UpdateAccountSendingEnabled.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/SendTemplatedEmail
 func (c *SES) SendTemplatedEmail(input *SendTemplatedEmailInput) (*SendTemplatedEmailOutput, error) {
@@ -4789,14 +4766,13 @@ const opSetActiveReceiptRuleSet = "SetActiveReceiptRuleSet"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SetActiveReceiptRuleSetRequest method.
+//	req, resp := client.SetActiveReceiptRuleSetRequest(params)
 //
-//    // Example sending a request using the SetActiveReceiptRuleSetRequest method.
-//    req, resp := client.SetActiveReceiptRuleSetRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/SetActiveReceiptRuleSet
 func (c *SES) SetActiveReceiptRuleSetRequest(input *SetActiveReceiptRuleSetInput) (req *request.Request, output *SetActiveReceiptRuleSetOutput) {
@@ -4821,10 +4797,10 @@ func (c *SES) SetActiveReceiptRuleSetRequest(input *SetActiveReceiptRuleSetInput
 // Sets the specified receipt rule set as the active receipt rule set.
 //
 // To disable your email-receiving through Amazon SES completely, you can call
-// this API with RuleSetName set to null.
+// this operation with RuleSetName set to null.
 //
 // For information about managing receipt rule sets, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-managing-receipt-rule-sets.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-receipt-rules-console-walkthrough.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -4836,8 +4812,8 @@ func (c *SES) SetActiveReceiptRuleSetRequest(input *SetActiveReceiptRuleSetInput
 // API operation SetActiveReceiptRuleSet for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeRuleSetDoesNotExistException "RuleSetDoesNotExist"
-//   Indicates that the provided receipt rule set does not exist.
+//   - ErrCodeRuleSetDoesNotExistException "RuleSetDoesNotExist"
+//     Indicates that the provided receipt rule set does not exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/SetActiveReceiptRuleSet
 func (c *SES) SetActiveReceiptRuleSet(input *SetActiveReceiptRuleSetInput) (*SetActiveReceiptRuleSetOutput, error) {
@@ -4877,14 +4853,13 @@ const opSetIdentityDkimEnabled = "SetIdentityDkimEnabled"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SetIdentityDkimEnabledRequest method.
+//	req, resp := client.SetIdentityDkimEnabledRequest(params)
 //
-//    // Example sending a request using the SetIdentityDkimEnabledRequest method.
-//    req, resp := client.SetIdentityDkimEnabledRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/SetIdentityDkimEnabled
 func (c *SES) SetIdentityDkimEnabledRequest(input *SetIdentityDkimEnabledInput) (req *request.Request, output *SetIdentityDkimEnabledOutput) {
@@ -4923,7 +4898,7 @@ func (c *SES) SetIdentityDkimEnabledRequest(input *SetIdentityDkimEnabledInput)
 // You can execute this operation no more than once per second.
 //
 // For more information about Easy DKIM signing, go to the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/easy-dkim.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/send-email-authentication-dkim-easy.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -4969,14 +4944,13 @@ const opSetIdentityFeedbackForwardingEnabled = "SetIdentityFeedbackForwardingEna
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SetIdentityFeedbackForwardingEnabledRequest method.
+//	req, resp := client.SetIdentityFeedbackForwardingEnabledRequest(params)
 //
-//    // Example sending a request using the SetIdentityFeedbackForwardingEnabledRequest method.
-//    req, resp := client.SetIdentityFeedbackForwardingEnabledRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/SetIdentityFeedbackForwardingEnabled
 func (c *SES) SetIdentityFeedbackForwardingEnabledRequest(input *SetIdentityFeedbackForwardingEnabledInput) (req *request.Request, output *SetIdentityFeedbackForwardingEnabledOutput) {
@@ -5009,7 +4983,7 @@ func (c *SES) SetIdentityFeedbackForwardingEnabledRequest(input *SetIdentityFeed
 // You can execute this operation no more than once per second.
 //
 // For more information about using notifications with Amazon SES, see the Amazon
-// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/notifications.html).
+// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity-using-notifications.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -5055,14 +5029,13 @@ const opSetIdentityHeadersInNotificationsEnabled = "SetIdentityHeadersInNotifica
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SetIdentityHeadersInNotificationsEnabledRequest method.
+//	req, resp := client.SetIdentityHeadersInNotificationsEnabledRequest(params)
 //
-//    // Example sending a request using the SetIdentityHeadersInNotificationsEnabledRequest method.
-//    req, resp := client.SetIdentityHeadersInNotificationsEnabledRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/SetIdentityHeadersInNotificationsEnabled
 func (c *SES) SetIdentityHeadersInNotificationsEnabledRequest(input *SetIdentityHeadersInNotificationsEnabledInput) (req *request.Request, output *SetIdentityHeadersInNotificationsEnabledOutput) {
@@ -5091,7 +5064,7 @@ func (c *SES) SetIdentityHeadersInNotificationsEnabledRequest(input *SetIdentity
 // You can execute this operation no more than once per second.
 //
 // For more information about using notifications with Amazon SES, see the Amazon
-// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/notifications.html).
+// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity-using-notifications.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -5137,14 +5110,13 @@ const opSetIdentityMailFromDomain = "SetIdentityMailFromDomain"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SetIdentityMailFromDomainRequest method.
+//	req, resp := client.SetIdentityMailFromDomainRequest(params)
 //
-//    // Example sending a request using the SetIdentityMailFromDomainRequest method.
-//    req, resp := client.SetIdentityMailFromDomainRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/SetIdentityMailFromDomain
 func (c *SES) SetIdentityMailFromDomainRequest(input *SetIdentityMailFromDomainInput) (req *request.Request, output *SetIdentityMailFromDomainOutput) {
@@ -5170,9 +5142,9 @@ func (c *SES) SetIdentityMailFromDomainRequest(input *SetIdentityMailFromDomainI
 // (an email address or a domain).
 //
 // To send emails using the specified MAIL FROM domain, you must add an MX record
-// to your MAIL FROM domain's DNS settings. If you want your emails to pass
+// to your MAIL FROM domain's DNS settings. To ensure that your emails pass
 // Sender Policy Framework (SPF) checks, you must also add or update an SPF
-// record. For more information, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/mail-from-set.html).
+// record. For more information, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/mail-from.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -5220,14 +5192,13 @@ const opSetIdentityNotificationTopic = "SetIdentityNotificationTopic"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SetIdentityNotificationTopicRequest method.
+//	req, resp := client.SetIdentityNotificationTopicRequest(params)
 //
-//    // Example sending a request using the SetIdentityNotificationTopicRequest method.
-//    req, resp := client.SetIdentityNotificationTopicRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/SetIdentityNotificationTopic
 func (c *SES) SetIdentityNotificationTopicRequest(input *SetIdentityNotificationTopicInput) (req *request.Request, output *SetIdentityNotificationTopicOutput) {
@@ -5259,7 +5230,7 @@ func (c *SES) SetIdentityNotificationTopicRequest(input *SetIdentityNotification
 // You can execute this operation no more than once per second.
 //
 // For more information about feedback notification, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/notifications.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity-using-notifications.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -5305,14 +5276,13 @@ const opSetReceiptRulePosition = "SetReceiptRulePosition"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SetReceiptRulePositionRequest method.
+//	req, resp := client.SetReceiptRulePositionRequest(params)
 //
-//    // Example sending a request using the SetReceiptRulePositionRequest method.
-//    req, resp := client.SetReceiptRulePositionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/SetReceiptRulePosition
 func (c *SES) SetReceiptRulePositionRequest(input *SetReceiptRulePositionInput) (req *request.Request, output *SetReceiptRulePositionOutput) {
@@ -5337,7 +5307,7 @@ func (c *SES) SetReceiptRulePositionRequest(input *SetReceiptRulePositionInput)
 // Sets the position of the specified receipt rule in the receipt rule set.
 //
 // For information about managing receipt rules, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-managing-receipt-rules.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-receipt-rules-console-walkthrough.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -5349,11 +5319,12 @@ func (c *SES) SetReceiptRulePositionRequest(input *SetReceiptRulePositionInput)
 // API operation SetReceiptRulePosition for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeRuleSetDoesNotExistException "RuleSetDoesNotExist"
-//   Indicates that the provided receipt rule set does not exist.
 //
-//   * ErrCodeRuleDoesNotExistException "RuleDoesNotExist"
-//   Indicates that the provided receipt rule does not exist.
+//   - ErrCodeRuleSetDoesNotExistException "RuleSetDoesNotExist"
+//     Indicates that the provided receipt rule set does not exist.
+//
+//   - ErrCodeRuleDoesNotExistException "RuleDoesNotExist"
+//     Indicates that the provided receipt rule does not exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/SetReceiptRulePosition
 func (c *SES) SetReceiptRulePosition(input *SetReceiptRulePositionInput) (*SetReceiptRulePositionOutput, error) {
@@ -5393,14 +5364,13 @@ const opTestRenderTemplate = "TestRenderTemplate"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the TestRenderTemplateRequest method.
+//	req, resp := client.TestRenderTemplateRequest(params)
 //
-//    // Example sending a request using the TestRenderTemplateRequest method.
-//    req, resp := client.TestRenderTemplateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/TestRenderTemplate
 func (c *SES) TestRenderTemplateRequest(input *TestRenderTemplateInput) (req *request.Request, output *TestRenderTemplateOutput) {
@@ -5434,18 +5404,19 @@ func (c *SES) TestRenderTemplateRequest(input *TestRenderTemplateInput) (req *re
 // API operation TestRenderTemplate for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeTemplateDoesNotExistException "TemplateDoesNotExist"
-//   Indicates that the Template object you specified does not exist in your Amazon
-//   SES account.
 //
-//   * ErrCodeInvalidRenderingParameterException "InvalidRenderingParameter"
-//   Indicates that one or more of the replacement values you provided is invalid.
-//   This error may occur when the TemplateData object contains invalid JSON.
+//   - ErrCodeTemplateDoesNotExistException "TemplateDoesNotExist"
+//     Indicates that the Template object you specified does not exist in your Amazon
+//     SES account.
 //
-//   * ErrCodeMissingRenderingAttributeException "MissingRenderingAttribute"
-//   Indicates that one or more of the replacement values for the specified template
-//   was not specified. Ensure that the TemplateData object contains references
-//   to all of the replacement tags in the specified template.
+//   - ErrCodeInvalidRenderingParameterException "InvalidRenderingParameter"
+//     Indicates that one or more of the replacement values you provided is invalid.
+//     This error may occur when the TemplateData object contains invalid JSON.
+//
+//   - ErrCodeMissingRenderingAttributeException "MissingRenderingAttribute"
+//     Indicates that one or more of the replacement values for the specified template
+//     was not specified. Ensure that the TemplateData object contains references
+//     to all of the replacement tags in the specified template.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/TestRenderTemplate
 func (c *SES) TestRenderTemplate(input *TestRenderTemplateInput) (*TestRenderTemplateOutput, error) {
@@ -5485,14 +5456,13 @@ const opUpdateAccountSendingEnabled = "UpdateAccountSendingEnabled"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateAccountSendingEnabledRequest method.
+//	req, resp := client.UpdateAccountSendingEnabledRequest(params)
 //
-//    // Example sending a request using the UpdateAccountSendingEnabledRequest method.
-//    req, resp := client.UpdateAccountSendingEnabledRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/UpdateAccountSendingEnabled
 func (c *SES) UpdateAccountSendingEnabledRequest(input *UpdateAccountSendingEnabledInput) (req *request.Request, output *UpdateAccountSendingEnabledOutput) {
@@ -5515,10 +5485,10 @@ func (c *SES) UpdateAccountSendingEnabledRequest(input *UpdateAccountSendingEnab
 // UpdateAccountSendingEnabled API operation for Amazon Simple Email Service.
 //
 // Enables or disables email sending across your entire Amazon SES account in
-// the current AWS Region. You can use this operation in conjunction with Amazon
-// CloudWatch alarms to temporarily pause email sending across your Amazon SES
-// account in a given AWS Region when reputation metrics (such as your bounce
-// or complaint rates) reach certain thresholds.
+// the current Amazon Web Services Region. You can use this operation in conjunction
+// with Amazon CloudWatch alarms to temporarily pause email sending across your
+// Amazon SES account in a given Amazon Web Services Region when reputation
+// metrics (such as your bounce or complaint rates) reach certain thresholds.
 //
 // You can execute this operation no more than once per second.
 //
@@ -5566,14 +5536,13 @@ const opUpdateConfigurationSetEventDestination = "UpdateConfigurationSetEventDes
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateConfigurationSetEventDestinationRequest method.
+//	req, resp := client.UpdateConfigurationSetEventDestinationRequest(params)
 //
-//    // Example sending a request using the UpdateConfigurationSetEventDestinationRequest method.
-//    req, resp := client.UpdateConfigurationSetEventDestinationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/UpdateConfigurationSetEventDestination
 func (c *SES) UpdateConfigurationSetEventDestinationRequest(input *UpdateConfigurationSetEventDestinationInput) (req *request.Request, output *UpdateConfigurationSetEventDestinationOutput) {
@@ -5599,7 +5568,7 @@ func (c *SES) UpdateConfigurationSetEventDestinationRequest(input *UpdateConfigu
 // are associated with configuration sets, which enable you to publish email
 // sending events to Amazon CloudWatch, Amazon Kinesis Firehose, or Amazon Simple
 // Notification Service (Amazon SNS). For information about using configuration
-// sets, see Monitoring Your Amazon SES Sending Activity (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/monitor-sending-activity.html)
+// sets, see Monitoring Your Amazon SES Sending Activity (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity.html)
 // in the Amazon SES Developer Guide.
 //
 // When you create or update an event destination, you must provide one, and
@@ -5616,23 +5585,24 @@ func (c *SES) UpdateConfigurationSetEventDestinationRequest(input *UpdateConfigu
 // API operation UpdateConfigurationSetEventDestination for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
-//   Indicates that the configuration set does not exist.
 //
-//   * ErrCodeEventDestinationDoesNotExistException "EventDestinationDoesNotExist"
-//   Indicates that the event destination does not exist.
+//   - ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
+//     Indicates that the configuration set does not exist.
+//
+//   - ErrCodeEventDestinationDoesNotExistException "EventDestinationDoesNotExist"
+//     Indicates that the event destination does not exist.
 //
-//   * ErrCodeInvalidCloudWatchDestinationException "InvalidCloudWatchDestination"
-//   Indicates that the Amazon CloudWatch destination is invalid. See the error
-//   message for details.
+//   - ErrCodeInvalidCloudWatchDestinationException "InvalidCloudWatchDestination"
+//     Indicates that the Amazon CloudWatch destination is invalid. See the error
+//     message for details.
 //
-//   * ErrCodeInvalidFirehoseDestinationException "InvalidFirehoseDestination"
-//   Indicates that the Amazon Kinesis Firehose destination is invalid. See the
-//   error message for details.
+//   - ErrCodeInvalidFirehoseDestinationException "InvalidFirehoseDestination"
+//     Indicates that the Amazon Kinesis Firehose destination is invalid. See the
+//     error message for details.
 //
-//   * ErrCodeInvalidSNSDestinationException "InvalidSNSDestination"
-//   Indicates that the Amazon Simple Notification Service (Amazon SNS) destination
-//   is invalid. See the error message for details.
+//   - ErrCodeInvalidSNSDestinationException "InvalidSNSDestination"
+//     Indicates that the Amazon Simple Notification Service (Amazon SNS) destination
+//     is invalid. See the error message for details.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/UpdateConfigurationSetEventDestination
 func (c *SES) UpdateConfigurationSetEventDestination(input *UpdateConfigurationSetEventDestinationInput) (*UpdateConfigurationSetEventDestinationOutput, error) {
@@ -5672,14 +5642,13 @@ const opUpdateConfigurationSetReputationMetricsEnabled = "UpdateConfigurationSet
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateConfigurationSetReputationMetricsEnabledRequest method.
+//	req, resp := client.UpdateConfigurationSetReputationMetricsEnabledRequest(params)
 //
-//    // Example sending a request using the UpdateConfigurationSetReputationMetricsEnabledRequest method.
-//    req, resp := client.UpdateConfigurationSetReputationMetricsEnabledRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/UpdateConfigurationSetReputationMetricsEnabled
 func (c *SES) UpdateConfigurationSetReputationMetricsEnabledRequest(input *UpdateConfigurationSetReputationMetricsEnabledInput) (req *request.Request, output *UpdateConfigurationSetReputationMetricsEnabledOutput) {
@@ -5702,10 +5671,10 @@ func (c *SES) UpdateConfigurationSetReputationMetricsEnabledRequest(input *Updat
 // UpdateConfigurationSetReputationMetricsEnabled API operation for Amazon Simple Email Service.
 //
 // Enables or disables the publishing of reputation metrics for emails sent
-// using a specific configuration set in a given AWS Region. Reputation metrics
-// include bounce and complaint rates. These metrics are published to Amazon
-// CloudWatch. By using CloudWatch, you can create alarms when bounce or complaint
-// rates exceed certain thresholds.
+// using a specific configuration set in a given Amazon Web Services Region.
+// Reputation metrics include bounce and complaint rates. These metrics are
+// published to Amazon CloudWatch. By using CloudWatch, you can create alarms
+// when bounce or complaint rates exceed certain thresholds.
 //
 // You can execute this operation no more than once per second.
 //
@@ -5717,8 +5686,8 @@ func (c *SES) UpdateConfigurationSetReputationMetricsEnabledRequest(input *Updat
 // API operation UpdateConfigurationSetReputationMetricsEnabled for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
-//   Indicates that the configuration set does not exist.
+//   - ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
+//     Indicates that the configuration set does not exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/UpdateConfigurationSetReputationMetricsEnabled
 func (c *SES) UpdateConfigurationSetReputationMetricsEnabled(input *UpdateConfigurationSetReputationMetricsEnabledInput) (*UpdateConfigurationSetReputationMetricsEnabledOutput, error) {
@@ -5758,14 +5727,13 @@ const opUpdateConfigurationSetSendingEnabled = "UpdateConfigurationSetSendingEna
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateConfigurationSetSendingEnabledRequest method.
+//	req, resp := client.UpdateConfigurationSetSendingEnabledRequest(params)
 //
-//    // Example sending a request using the UpdateConfigurationSetSendingEnabledRequest method.
-//    req, resp := client.UpdateConfigurationSetSendingEnabledRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/UpdateConfigurationSetSendingEnabled
 func (c *SES) UpdateConfigurationSetSendingEnabledRequest(input *UpdateConfigurationSetSendingEnabledInput) (req *request.Request, output *UpdateConfigurationSetSendingEnabledOutput) {
@@ -5788,10 +5756,10 @@ func (c *SES) UpdateConfigurationSetSendingEnabledRequest(input *UpdateConfigura
 // UpdateConfigurationSetSendingEnabled API operation for Amazon Simple Email Service.
 //
 // Enables or disables email sending for messages sent using a specific configuration
-// set in a given AWS Region. You can use this operation in conjunction with
-// Amazon CloudWatch alarms to temporarily pause email sending for a configuration
-// set when the reputation metrics for that configuration set (such as your
-// bounce on complaint rate) exceed certain thresholds.
+// set in a given Amazon Web Services Region. You can use this operation in
+// conjunction with Amazon CloudWatch alarms to temporarily pause email sending
+// for a configuration set when the reputation metrics for that configuration
+// set (such as your bounce on complaint rate) exceed certain thresholds.
 //
 // You can execute this operation no more than once per second.
 //
@@ -5803,8 +5771,8 @@ func (c *SES) UpdateConfigurationSetSendingEnabledRequest(input *UpdateConfigura
 // API operation UpdateConfigurationSetSendingEnabled for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
-//   Indicates that the configuration set does not exist.
+//   - ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
+//     Indicates that the configuration set does not exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/UpdateConfigurationSetSendingEnabled
 func (c *SES) UpdateConfigurationSetSendingEnabled(input *UpdateConfigurationSetSendingEnabledInput) (*UpdateConfigurationSetSendingEnabledOutput, error) {
@@ -5844,14 +5812,13 @@ const opUpdateConfigurationSetTrackingOptions = "UpdateConfigurationSetTrackingO
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateConfigurationSetTrackingOptionsRequest method.
+//	req, resp := client.UpdateConfigurationSetTrackingOptionsRequest(params)
 //
-//    // Example sending a request using the UpdateConfigurationSetTrackingOptionsRequest method.
-//    req, resp := client.UpdateConfigurationSetTrackingOptionsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/UpdateConfigurationSetTrackingOptions
 func (c *SES) UpdateConfigurationSetTrackingOptionsRequest(input *UpdateConfigurationSetTrackingOptionsInput) (req *request.Request, output *UpdateConfigurationSetTrackingOptionsOutput) {
@@ -5879,7 +5846,7 @@ func (c *SES) UpdateConfigurationSetTrackingOptionsRequest(input *UpdateConfigur
 // By default, images and links used for tracking open and click events are
 // hosted on domains operated by Amazon SES. You can configure a subdomain of
 // your own to handle these events. For information about using custom domains,
-// see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/configure-custom-open-click-domains.html).
+// see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/configure-custom-open-click-domains.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -5889,19 +5856,20 @@ func (c *SES) UpdateConfigurationSetTrackingOptionsRequest(input *UpdateConfigur
 // API operation UpdateConfigurationSetTrackingOptions for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
-//   Indicates that the configuration set does not exist.
 //
-//   * ErrCodeTrackingOptionsDoesNotExistException "TrackingOptionsDoesNotExistException"
-//   Indicates that the TrackingOptions object you specified does not exist.
+//   - ErrCodeConfigurationSetDoesNotExistException "ConfigurationSetDoesNotExist"
+//     Indicates that the configuration set does not exist.
+//
+//   - ErrCodeTrackingOptionsDoesNotExistException "TrackingOptionsDoesNotExistException"
+//     Indicates that the TrackingOptions object you specified does not exist.
 //
-//   * ErrCodeInvalidTrackingOptionsException "InvalidTrackingOptions"
-//   Indicates that the custom domain to be used for open and click tracking redirects
-//   is invalid. This error appears most often in the following situations:
+//   - ErrCodeInvalidTrackingOptionsException "InvalidTrackingOptions"
+//     Indicates that the custom domain to be used for open and click tracking redirects
+//     is invalid. This error appears most often in the following situations:
 //
-//      * When the tracking domain you specified is not verified in Amazon SES.
+//   - When the tracking domain you specified is not verified in Amazon SES.
 //
-//      * When the tracking domain you specified is not a valid domain or subdomain.
+//   - When the tracking domain you specified is not a valid domain or subdomain.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/UpdateConfigurationSetTrackingOptions
 func (c *SES) UpdateConfigurationSetTrackingOptions(input *UpdateConfigurationSetTrackingOptionsInput) (*UpdateConfigurationSetTrackingOptionsOutput, error) {
@@ -5941,14 +5909,13 @@ const opUpdateCustomVerificationEmailTemplate = "UpdateCustomVerificationEmailTe
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateCustomVerificationEmailTemplateRequest method.
+//	req, resp := client.UpdateCustomVerificationEmailTemplateRequest(params)
 //
-//    // Example sending a request using the UpdateCustomVerificationEmailTemplateRequest method.
-//    req, resp := client.UpdateCustomVerificationEmailTemplateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/UpdateCustomVerificationEmailTemplate
 func (c *SES) UpdateCustomVerificationEmailTemplateRequest(input *UpdateCustomVerificationEmailTemplateInput) (req *request.Request, output *UpdateCustomVerificationEmailTemplateOutput) {
@@ -5973,7 +5940,7 @@ func (c *SES) UpdateCustomVerificationEmailTemplateRequest(input *UpdateCustomVe
 // Updates an existing custom verification email template.
 //
 // For more information about custom verification email templates, see Using
-// Custom Verification Email Templates (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/custom-verification-emails.html)
+// Custom Verification Email Templates (https://docs.aws.amazon.com/ses/latest/dg/creating-identities.html#send-email-verify-address-custom)
 // in the Amazon SES Developer Guide.
 //
 // You can execute this operation no more than once per second.
@@ -5986,17 +5953,18 @@ func (c *SES) UpdateCustomVerificationEmailTemplateRequest(input *UpdateCustomVe
 // API operation UpdateCustomVerificationEmailTemplate for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeCustomVerificationEmailTemplateDoesNotExistException "CustomVerificationEmailTemplateDoesNotExist"
-//   Indicates that a custom verification email template with the name you specified
-//   does not exist.
 //
-//   * ErrCodeFromEmailAddressNotVerifiedException "FromEmailAddressNotVerified"
-//   Indicates that the sender address specified for a custom verification email
-//   is not verified, and is therefore not eligible to send the custom verification
-//   email.
+//   - ErrCodeCustomVerificationEmailTemplateDoesNotExistException "CustomVerificationEmailTemplateDoesNotExist"
+//     Indicates that a custom verification email template with the name you specified
+//     does not exist.
+//
+//   - ErrCodeFromEmailAddressNotVerifiedException "FromEmailAddressNotVerified"
+//     Indicates that the sender address specified for a custom verification email
+//     is not verified, and is therefore not eligible to send the custom verification
+//     email.
 //
-//   * ErrCodeCustomVerificationEmailInvalidContentException "CustomVerificationEmailInvalidContent"
-//   Indicates that custom verification email template provided content is invalid.
+//   - ErrCodeCustomVerificationEmailInvalidContentException "CustomVerificationEmailInvalidContent"
+//     Indicates that custom verification email template provided content is invalid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/UpdateCustomVerificationEmailTemplate
 func (c *SES) UpdateCustomVerificationEmailTemplate(input *UpdateCustomVerificationEmailTemplateInput) (*UpdateCustomVerificationEmailTemplateOutput, error) {
@@ -6036,14 +6004,13 @@ const opUpdateReceiptRule = "UpdateReceiptRule"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateReceiptRuleRequest method.
+//	req, resp := client.UpdateReceiptRuleRequest(params)
 //
-//    // Example sending a request using the UpdateReceiptRuleRequest method.
-//    req, resp := client.UpdateReceiptRuleRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/UpdateReceiptRule
 func (c *SES) UpdateReceiptRuleRequest(input *UpdateReceiptRuleInput) (req *request.Request, output *UpdateReceiptRuleOutput) {
@@ -6068,7 +6035,7 @@ func (c *SES) UpdateReceiptRuleRequest(input *UpdateReceiptRuleInput) (req *requ
 // Updates a receipt rule.
 //
 // For information about managing receipt rules, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-managing-receipt-rules.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-receipt-rules-console-walkthrough.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -6080,32 +6047,33 @@ func (c *SES) UpdateReceiptRuleRequest(input *UpdateReceiptRuleInput) (req *requ
 // API operation UpdateReceiptRule for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidSnsTopicException "InvalidSnsTopic"
-//   Indicates that the provided Amazon SNS topic is invalid, or that Amazon SES
-//   could not publish to the topic, possibly due to permissions issues. For information
-//   about giving permissions, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-permissions.html).
 //
-//   * ErrCodeInvalidS3ConfigurationException "InvalidS3Configuration"
-//   Indicates that the provided Amazon S3 bucket or AWS KMS encryption key is
-//   invalid, or that Amazon SES could not publish to the bucket, possibly due
-//   to permissions issues. For information about giving permissions, see the
-//   Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-permissions.html).
+//   - ErrCodeInvalidSnsTopicException "InvalidSnsTopic"
+//     Indicates that the provided Amazon SNS topic is invalid, or that Amazon SES
+//     could not publish to the topic, possibly due to permissions issues. For information
+//     about giving permissions, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-permissions.html).
+//
+//   - ErrCodeInvalidS3ConfigurationException "InvalidS3Configuration"
+//     Indicates that the provided Amazon S3 bucket or Amazon Web Services KMS encryption
+//     key is invalid, or that Amazon SES could not publish to the bucket, possibly
+//     due to permissions issues. For information about giving permissions, see
+//     the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-permissions.html).
 //
-//   * ErrCodeInvalidLambdaFunctionException "InvalidLambdaFunction"
-//   Indicates that the provided AWS Lambda function is invalid, or that Amazon
-//   SES could not execute the provided function, possibly due to permissions
-//   issues. For information about giving permissions, see the Amazon SES Developer
-//   Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-permissions.html).
+//   - ErrCodeInvalidLambdaFunctionException "InvalidLambdaFunction"
+//     Indicates that the provided Amazon Web Services Lambda function is invalid,
+//     or that Amazon SES could not execute the provided function, possibly due
+//     to permissions issues. For information about giving permissions, see the
+//     Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-permissions.html).
 //
-//   * ErrCodeRuleSetDoesNotExistException "RuleSetDoesNotExist"
-//   Indicates that the provided receipt rule set does not exist.
+//   - ErrCodeRuleSetDoesNotExistException "RuleSetDoesNotExist"
+//     Indicates that the provided receipt rule set does not exist.
 //
-//   * ErrCodeRuleDoesNotExistException "RuleDoesNotExist"
-//   Indicates that the provided receipt rule does not exist.
+//   - ErrCodeRuleDoesNotExistException "RuleDoesNotExist"
+//     Indicates that the provided receipt rule does not exist.
 //
-//   * ErrCodeLimitExceededException "LimitExceeded"
-//   Indicates that a resource could not be created because of service limits.
-//   For a list of Amazon SES limits, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/limits.html).
+//   - ErrCodeLimitExceededException "LimitExceeded"
+//     Indicates that a resource could not be created because of service limits.
+//     For a list of Amazon SES limits, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/limits.html).
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/UpdateReceiptRule
 func (c *SES) UpdateReceiptRule(input *UpdateReceiptRuleInput) (*UpdateReceiptRuleOutput, error) {
@@ -6145,14 +6113,13 @@ const opUpdateTemplate = "UpdateTemplate"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateTemplateRequest method.
+//	req, resp := client.UpdateTemplateRequest(params)
 //
-//    // Example sending a request using the UpdateTemplateRequest method.
-//    req, resp := client.UpdateTemplateRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/UpdateTemplate
 func (c *SES) UpdateTemplateRequest(input *UpdateTemplateInput) (req *request.Request, output *UpdateTemplateOutput) {
@@ -6175,8 +6142,8 @@ func (c *SES) UpdateTemplateRequest(input *UpdateTemplateInput) (req *request.Re
 // UpdateTemplate API operation for Amazon Simple Email Service.
 //
 // Updates an email template. Email templates enable you to send personalized
-// email to one or more destinations in a single API operation. For more information,
-// see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/send-personalized-email-api.html).
+// email to one or more destinations in a single operation. For more information,
+// see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/send-personalized-email-api.html).
 //
 // You can execute this operation no more than once per second.
 //
@@ -6188,13 +6155,14 @@ func (c *SES) UpdateTemplateRequest(input *UpdateTemplateInput) (req *request.Re
 // API operation UpdateTemplate for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeTemplateDoesNotExistException "TemplateDoesNotExist"
-//   Indicates that the Template object you specified does not exist in your Amazon
-//   SES account.
 //
-//   * ErrCodeInvalidTemplateException "InvalidTemplate"
-//   Indicates that the template that you specified could not be rendered. This
-//   issue may occur when a template refers to a partial that does not exist.
+//   - ErrCodeTemplateDoesNotExistException "TemplateDoesNotExist"
+//     Indicates that the Template object you specified does not exist in your Amazon
+//     SES account.
+//
+//   - ErrCodeInvalidTemplateException "InvalidTemplate"
+//     Indicates that the template that you specified could not be rendered. This
+//     issue may occur when a template refers to a partial that does not exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/UpdateTemplate
 func (c *SES) UpdateTemplate(input *UpdateTemplateInput) (*UpdateTemplateOutput, error) {
@@ -6234,14 +6202,13 @@ const opVerifyDomainDkim = "VerifyDomainDkim"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the VerifyDomainDkimRequest method.
+//	req, resp := client.VerifyDomainDkimRequest(params)
 //
-//    // Example sending a request using the VerifyDomainDkimRequest method.
-//    req, resp := client.VerifyDomainDkimRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/VerifyDomainDkim
 func (c *SES) VerifyDomainDkimRequest(input *VerifyDomainDkimInput) (req *request.Request, output *VerifyDomainDkimOutput) {
@@ -6268,8 +6235,8 @@ func (c *SES) VerifyDomainDkimRequest(input *VerifyDomainDkimInput) (req *reques
 // is added to the list of identities that are associated with your account.
 // This is true even if you haven't already associated the domain with your
 // account by using the VerifyDomainIdentity operation. However, you can't send
-// email from the domain until you either successfully verify it (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/verify-domains.html)
-// or you successfully set up DKIM for it (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/easy-dkim.html).
+// email from the domain until you either successfully verify it (https://docs.aws.amazon.com/ses/latest/dg/creating-identities.html#verify-domain-procedure)
+// or you successfully set up DKIM for it (https://docs.aws.amazon.com/ses/latest/dg/send-email-authentication-dkim-easy.html).
 //
 // You use the tokens that are generated by this operation to create CNAME records.
 // When Amazon SES detects that you've added these records to the DNS configuration
@@ -6280,11 +6247,11 @@ func (c *SES) VerifyDomainDkimRequest(input *VerifyDomainDkimInput) (req *reques
 //
 // To create the CNAME records for DKIM authentication, use the following values:
 //
-//    * Name: token._domainkey.example.com
+//   - Name: token._domainkey.example.com
 //
-//    * Type: CNAME
+//   - Type: CNAME
 //
-//    * Value: token.dkim.amazonses.com
+//   - Value: token.dkim.amazonses.com
 //
 // In the preceding example, replace token with one of the tokens that are generated
 // when you execute this operation. Replace example.com with your domain. Repeat
@@ -6336,14 +6303,13 @@ const opVerifyDomainIdentity = "VerifyDomainIdentity"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the VerifyDomainIdentityRequest method.
+//	req, resp := client.VerifyDomainIdentityRequest(params)
 //
-//    // Example sending a request using the VerifyDomainIdentityRequest method.
-//    req, resp := client.VerifyDomainIdentityRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/VerifyDomainIdentity
 func (c *SES) VerifyDomainIdentityRequest(input *VerifyDomainIdentityInput) (req *request.Request, output *VerifyDomainIdentityOutput) {
@@ -6365,8 +6331,8 @@ func (c *SES) VerifyDomainIdentityRequest(input *VerifyDomainIdentityInput) (req
 // VerifyDomainIdentity API operation for Amazon Simple Email Service.
 //
 // Adds a domain to the list of identities for your Amazon SES account in the
-// current AWS Region and attempts to verify it. For more information about
-// verifying domains, see Verifying Email Addresses and Domains (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/verify-addresses-and-domains.html)
+// current Amazon Web Services Region and attempts to verify it. For more information
+// about verifying domains, see Verifying Email Addresses and Domains (https://docs.aws.amazon.com/ses/latest/dg/verify-addresses-and-domains.html)
 // in the Amazon SES Developer Guide.
 //
 // You can execute this operation no more than once per second.
@@ -6415,14 +6381,13 @@ const opVerifyEmailAddress = "VerifyEmailAddress"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the VerifyEmailAddressRequest method.
+//	req, resp := client.VerifyEmailAddressRequest(params)
 //
-//    // Example sending a request using the VerifyEmailAddressRequest method.
-//    req, resp := client.VerifyEmailAddressRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/VerifyEmailAddress
 func (c *SES) VerifyEmailAddressRequest(input *VerifyEmailAddressInput) (req *request.Request, output *VerifyEmailAddressOutput) {
@@ -6490,14 +6455,13 @@ const opVerifyEmailIdentity = "VerifyEmailIdentity"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the VerifyEmailIdentityRequest method.
+//	req, resp := client.VerifyEmailIdentityRequest(params)
 //
-//    // Example sending a request using the VerifyEmailIdentityRequest method.
-//    req, resp := client.VerifyEmailIdentityRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/email-2010-12-01/VerifyEmailIdentity
 func (c *SES) VerifyEmailIdentityRequest(input *VerifyEmailIdentityInput) (req *request.Request, output *VerifyEmailIdentityOutput) {
@@ -6520,8 +6484,9 @@ func (c *SES) VerifyEmailIdentityRequest(input *VerifyEmailIdentityInput) (req *
 // VerifyEmailIdentity API operation for Amazon Simple Email Service.
 //
 // Adds an email address to the list of identities for your Amazon SES account
-// in the current AWS region and attempts to verify it. As a result of executing
-// this operation, a verification email is sent to the specified address.
+// in the current Amazon Web Services Region and attempts to verify it. As a
+// result of executing this operation, a verification email is sent to the specified
+// address.
 //
 // You can execute this operation no more than once per second.
 //
@@ -6557,29 +6522,38 @@ func (c *SES) VerifyEmailIdentityWithContext(ctx aws.Context, input *VerifyEmail
 // email.
 //
 // For information about adding a header using a receipt rule, see the Amazon
-// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-action-add-header.html).
+// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-action-add-header.html).
 type AddHeaderAction struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the header to add. Must be between 1 and 50 characters, inclusive,
-	// and consist of alphanumeric (a-z, A-Z, 0-9) characters and dashes only.
+	// The name of the header to add to the incoming message. The name must contain
+	// at least one character, and can contain up to 50 characters. It consists
+	// of alphanumeric (a–z, A–Z, 0–9) characters and dashes.
 	//
 	// HeaderName is a required field
 	HeaderName *string `type:"string" required:"true"`
 
-	// Must be less than 2048 characters, and must not contain newline characters
-	// ("\r" or "\n").
+	// The content to include in the header. This value can contain up to 2048 characters.
+	// It can't contain newline (\n) or carriage return (\r) characters.
 	//
 	// HeaderValue is a required field
 	HeaderValue *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AddHeaderAction) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AddHeaderAction) GoString() string {
 	return s.String()
 }
@@ -6628,12 +6602,20 @@ type Body struct {
 	Text *Content `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Body) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Body) GoString() string {
 	return s.String()
 }
@@ -6675,7 +6657,7 @@ func (s *Body) SetText(v *Content) *Body {
 // to Amazon Simple Notification Service (Amazon SNS).
 //
 // For information about sending a bounce message in response to a received
-// email, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-action-bounce.html).
+// email, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-action-bounce.html).
 type BounceAction struct {
 	_ struct{} `type:"structure"`
 
@@ -6685,7 +6667,7 @@ type BounceAction struct {
 	Message *string `type:"string" required:"true"`
 
 	// The email address of the sender of the bounced email. This is the address
-	// from which the bounce message will be sent.
+	// from which the bounce message is sent.
 	//
 	// Sender is a required field
 	Sender *string `type:"string" required:"true"`
@@ -6699,18 +6681,29 @@ type BounceAction struct {
 	StatusCode *string `type:"string"`
 
 	// The Amazon Resource Name (ARN) of the Amazon SNS topic to notify when the
-	// bounce action is taken. An example of an Amazon SNS topic ARN is arn:aws:sns:us-west-2:123456789012:MyTopic.
+	// bounce action is taken. You can find the ARN of a topic by using the ListTopics
+	// (https://docs.aws.amazon.com/sns/latest/api/API_ListTopics.html) operation
+	// in Amazon SNS.
+	//
 	// For more information about Amazon SNS topics, see the Amazon SNS Developer
 	// Guide (https://docs.aws.amazon.com/sns/latest/dg/CreateTopic.html).
 	TopicArn *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BounceAction) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BounceAction) GoString() string {
 	return s.String()
 }
@@ -6768,7 +6761,7 @@ func (s *BounceAction) SetTopicArn(v string) *BounceAction {
 // (DSN) when an email that Amazon SES receives on your behalf bounces.
 //
 // For information about receiving email through Amazon SES, see the Amazon
-// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email.html).
+// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email.html).
 type BouncedRecipientInfo struct {
 	_ struct{} `type:"structure"`
 
@@ -6783,7 +6776,7 @@ type BouncedRecipientInfo struct {
 	// This parameter is used only for sending authorization. It is the ARN of the
 	// identity that is associated with the sending authorization policy that permits
 	// you to receive email for the recipient of the bounced email. For more information
-	// about sending authorization, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization.html).
+	// about sending authorization, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization.html).
 	RecipientArn *string `type:"string"`
 
 	// Recipient-related DSN fields, most of which would normally be filled in automatically
@@ -6792,12 +6785,20 @@ type BouncedRecipientInfo struct {
 	RecipientDsnFields *RecipientDsnFields `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BouncedRecipientInfo) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BouncedRecipientInfo) GoString() string {
 	return s.String()
 }
@@ -6853,12 +6854,15 @@ type BulkEmailDestination struct {
 	// fields.
 	//
 	// Amazon SES does not support the SMTPUTF8 extension, as described in RFC6531
-	// (https://tools.ietf.org/html/rfc6531). For this reason, the local part of
-	// a destination email address (the part of the email address that precedes
-	// the @ sign) may only contain 7-bit ASCII characters (https://en.wikipedia.org/wiki/Email_address#Local-part).
-	// If the domain part of an address (the part after the @ sign) contains non-ASCII
-	// characters, they must be encoded using Punycode, as described in RFC3492
-	// (https://tools.ietf.org/html/rfc3492.html).
+	// (https://tools.ietf.org/html/rfc6531). For this reason, the email address
+	// string must be 7-bit ASCII. If you want to send to or from email addresses
+	// that contain Unicode characters in the domain part of an address, you must
+	// encode the domain using Punycode. Punycode is not permitted in the local
+	// part of the email address (the part before the @ sign) nor in the "friendly
+	// from" name. If you want to use Unicode characters in the "friendly from"
+	// name, you must encode the "friendly from" name using MIME encoded-word syntax,
+	// as described in Sending raw email using the Amazon SES API (https://docs.aws.amazon.com/ses/latest/dg/send-email-raw.html).
+	// For more information about Punycode, see RFC 3492 (http://tools.ietf.org/html/rfc3492).
 	//
 	// Destination is a required field
 	Destination *Destination `type:"structure" required:"true"`
@@ -6874,12 +6878,20 @@ type BulkEmailDestination struct {
 	ReplacementTemplateData *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BulkEmailDestination) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BulkEmailDestination) GoString() string {
 	return s.String()
 }
@@ -6940,8 +6952,8 @@ type BulkEmailDestinationStatus struct {
 	//
 	// Possible values for this parameter include:
 	//
-	//    * Success: Amazon SES accepted the message, and will attempt to deliver
-	//    it to the recipients.
+	//    * Success: Amazon SES accepted the message, and attempts to deliver it
+	//    to the recipients.
 	//
 	//    * MessageRejected: The message was rejected because it contained a virus.
 	//
@@ -6983,12 +6995,20 @@ type BulkEmailDestinationStatus struct {
 	Status *string `type:"string" enum:"BulkEmailStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BulkEmailDestinationStatus) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BulkEmailDestinationStatus) GoString() string {
 	return s.String()
 }
@@ -7013,7 +7033,7 @@ func (s *BulkEmailDestinationStatus) SetStatus(v string) *BulkEmailDestinationSt
 
 // Represents a request to create a receipt rule set by cloning an existing
 // one. You use receipt rule sets to receive email with Amazon SES. For more
-// information, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-concepts.html).
+// information, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-concepts.html).
 type CloneReceiptRuleSetInput struct {
 	_ struct{} `type:"structure"`
 
@@ -7022,25 +7042,33 @@ type CloneReceiptRuleSetInput struct {
 	// OriginalRuleSetName is a required field
 	OriginalRuleSetName *string `type:"string" required:"true"`
 
-	// The name of the rule set to create. The name must:
+	// The name of the rule set to create. The name must meet the following requirements:
 	//
-	//    * This value can only contain ASCII letters (a-z, A-Z), numbers (0-9),
-	//    underscores (_), or dashes (-).
+	//    * Contain only ASCII letters (a-z, A-Z), numbers (0-9), underscores (_),
+	//    or dashes (-).
 	//
 	//    * Start and end with a letter or number.
 	//
-	//    * Contain less than 64 characters.
+	//    * Contain 64 characters or fewer.
 	//
 	// RuleSetName is a required field
 	RuleSetName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CloneReceiptRuleSetInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CloneReceiptRuleSetInput) GoString() string {
 	return s.String()
 }
@@ -7078,12 +7106,20 @@ type CloneReceiptRuleSetOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CloneReceiptRuleSetOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CloneReceiptRuleSetOutput) GoString() string {
 	return s.String()
 }
@@ -7093,7 +7129,7 @@ func (s CloneReceiptRuleSetOutput) GoString() string {
 //
 // Event destinations, such as Amazon CloudWatch, are associated with configuration
 // sets, which enable you to publish email sending events. For information about
-// using configuration sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/monitor-sending-activity.html).
+// using configuration sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity.html).
 type CloudWatchDestination struct {
 	_ struct{} `type:"structure"`
 
@@ -7104,12 +7140,20 @@ type CloudWatchDestination struct {
 	DimensionConfigurations []*CloudWatchDimensionConfiguration `type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CloudWatchDestination) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CloudWatchDestination) GoString() string {
 	return s.String()
 }
@@ -7147,49 +7191,57 @@ func (s *CloudWatchDestination) SetDimensionConfigurations(v []*CloudWatchDimens
 // events to Amazon CloudWatch.
 //
 // For information about publishing email sending events to Amazon CloudWatch,
-// see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/monitor-sending-activity.html).
+// see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity.html).
 type CloudWatchDimensionConfiguration struct {
 	_ struct{} `type:"structure"`
 
 	// The default value of the dimension that is published to Amazon CloudWatch
 	// if you do not provide the value of the dimension when you send an email.
-	// The default value must:
+	// The default value must meet the following requirements:
 	//
-	//    * This value can only contain ASCII letters (a-z, A-Z), numbers (0-9),
-	//    underscores (_), or dashes (-).
+	//    * Contain only ASCII letters (a-z, A-Z), numbers (0-9), underscores (_),
+	//    dashes (-), at signs (@), or periods (.).
 	//
-	//    * Contain less than 256 characters.
+	//    * Contain 256 characters or fewer.
 	//
 	// DefaultDimensionValue is a required field
 	DefaultDimensionValue *string `type:"string" required:"true"`
 
 	// The name of an Amazon CloudWatch dimension associated with an email sending
-	// metric. The name must:
+	// metric. The name must meet the following requirements:
 	//
-	//    * This value can only contain ASCII letters (a-z, A-Z), numbers (0-9),
-	//    underscores (_), or dashes (-).
+	//    * Contain only ASCII letters (a-z, A-Z), numbers (0-9), underscores (_),
+	//    dashes (-), or colons (:).
 	//
-	//    * Contain less than 256 characters.
+	//    * Contain 256 characters or fewer.
 	//
 	// DimensionName is a required field
 	DimensionName *string `type:"string" required:"true"`
 
 	// The place where Amazon SES finds the value of a dimension to publish to Amazon
-	// CloudWatch. If you want Amazon SES to use the message tags that you specify
-	// using an X-SES-MESSAGE-TAGS header or a parameter to the SendEmail/SendRawEmail
-	// API, choose messageTag. If you want Amazon SES to use your own email headers,
-	// choose emailHeader.
+	// CloudWatch. To use the message tags that you specify using an X-SES-MESSAGE-TAGS
+	// header or a parameter to the SendEmail/SendRawEmail API, specify messageTag.
+	// To use your own email headers, specify emailHeader. To put a custom tag on
+	// any link included in your email, specify linkTag.
 	//
 	// DimensionValueSource is a required field
 	DimensionValueSource *string `type:"string" required:"true" enum:"DimensionValueSource"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CloudWatchDimensionConfiguration) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CloudWatchDimensionConfiguration) GoString() string {
 	return s.String()
 }
@@ -7235,8 +7287,8 @@ func (s *CloudWatchDimensionConfiguration) SetDimensionValueSource(v string) *Cl
 //
 // Configuration sets let you create groups of rules that you can apply to the
 // emails you send using Amazon SES. For more information about using configuration
-// sets, see Using Amazon SES Configuration Sets (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/using-configuration-sets.html)
-// in the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/).
+// sets, see Using Amazon SES Configuration Sets (https://docs.aws.amazon.com/ses/latest/dg/using-configuration-sets.html)
+// in the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/).
 type ConfigurationSet struct {
 	_ struct{} `type:"structure"`
 
@@ -7251,12 +7303,20 @@ type ConfigurationSet struct {
 	Name *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ConfigurationSet) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ConfigurationSet) GoString() string {
 	return s.String()
 }
@@ -7297,12 +7357,20 @@ type Content struct {
 	Data *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Content) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Content) GoString() string {
 	return s.String()
 }
@@ -7334,9 +7402,9 @@ func (s *Content) SetData(v string) *Content {
 
 // Represents a request to create a configuration set event destination. A configuration
 // set event destination, which can be either Amazon CloudWatch or Amazon Kinesis
-// Firehose, describes an AWS service in which Amazon SES publishes the email
-// sending events associated with a configuration set. For information about
-// using configuration sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/monitor-sending-activity.html).
+// Firehose, describes an Amazon Web Services service in which Amazon SES publishes
+// the email sending events associated with a configuration set. For information
+// about using configuration sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity.html).
 type CreateConfigurationSetEventDestinationInput struct {
 	_ struct{} `type:"structure"`
 
@@ -7346,19 +7414,27 @@ type CreateConfigurationSetEventDestinationInput struct {
 	// ConfigurationSetName is a required field
 	ConfigurationSetName *string `type:"string" required:"true"`
 
-	// An object that describes the AWS service that email sending event information
-	// will be published to.
+	// An object that describes the Amazon Web Services service that email sending
+	// event where information is published.
 	//
 	// EventDestination is a required field
 	EventDestination *EventDestination `type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateConfigurationSetEventDestinationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateConfigurationSetEventDestinationInput) GoString() string {
 	return s.String()
 }
@@ -7401,19 +7477,27 @@ type CreateConfigurationSetEventDestinationOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateConfigurationSetEventDestinationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateConfigurationSetEventDestinationOutput) GoString() string {
 	return s.String()
 }
 
 // Represents a request to create a configuration set. Configuration sets enable
 // you to publish email sending events. For information about using configuration
-// sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/monitor-sending-activity.html).
+// sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity.html).
 type CreateConfigurationSetInput struct {
 	_ struct{} `type:"structure"`
 
@@ -7423,12 +7507,20 @@ type CreateConfigurationSetInput struct {
 	ConfigurationSet *ConfigurationSet `type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateConfigurationSetInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateConfigurationSetInput) GoString() string {
 	return s.String()
 }
@@ -7462,12 +7554,20 @@ type CreateConfigurationSetOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateConfigurationSetOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateConfigurationSetOutput) GoString() string {
 	return s.String()
 }
@@ -7488,19 +7588,27 @@ type CreateConfigurationSetTrackingOptionsInput struct {
 	// emails.
 	//
 	// For more information, see Configuring Custom Domains to Handle Open and Click
-	// Tracking (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/configure-custom-open-click-domains.html)
+	// Tracking (https://docs.aws.amazon.com/ses/latest/dg/configure-custom-open-click-domains.html)
 	// in the Amazon SES Developer Guide.
 	//
 	// TrackingOptions is a required field
 	TrackingOptions *TrackingOptions `type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateConfigurationSetTrackingOptionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateConfigurationSetTrackingOptionsInput) GoString() string {
 	return s.String()
 }
@@ -7538,12 +7646,20 @@ type CreateConfigurationSetTrackingOptionsOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateConfigurationSetTrackingOptionsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateConfigurationSetTrackingOptionsOutput) GoString() string {
 	return s.String()
 }
@@ -7572,7 +7688,7 @@ type CreateCustomVerificationEmailTemplateInput struct {
 	// The content of the custom verification email. The total size of the email
 	// must be less than 10 MB. The message body may contain HTML, with some limitations.
 	// For more information, see Custom Verification Email Frequently Asked Questions
-	// (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/custom-verification-emails.html#custom-verification-emails-faq)
+	// (https://docs.aws.amazon.com/ses/latest/dg/creating-identities.html#send-email-verify-address-custom)
 	// in the Amazon SES Developer Guide.
 	//
 	// TemplateContent is a required field
@@ -7589,12 +7705,20 @@ type CreateCustomVerificationEmailTemplateInput struct {
 	TemplateSubject *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateCustomVerificationEmailTemplateInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateCustomVerificationEmailTemplateInput) GoString() string {
 	return s.String()
 }
@@ -7667,19 +7791,27 @@ type CreateCustomVerificationEmailTemplateOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateCustomVerificationEmailTemplateOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateCustomVerificationEmailTemplateOutput) GoString() string {
 	return s.String()
 }
 
 // Represents a request to create a new IP address filter. You use IP address
 // filters when you receive email with Amazon SES. For more information, see
-// the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-concepts.html).
+// the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-concepts.html).
 type CreateReceiptFilterInput struct {
 	_ struct{} `type:"structure"`
 
@@ -7690,12 +7822,20 @@ type CreateReceiptFilterInput struct {
 	Filter *ReceiptFilter `type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateReceiptFilterInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateReceiptFilterInput) GoString() string {
 	return s.String()
 }
@@ -7729,25 +7869,33 @@ type CreateReceiptFilterOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateReceiptFilterOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateReceiptFilterOutput) GoString() string {
 	return s.String()
 }
 
 // Represents a request to create a receipt rule. You use receipt rules to receive
 // email with Amazon SES. For more information, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-concepts.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-concepts.html).
 type CreateReceiptRuleInput struct {
 	_ struct{} `type:"structure"`
 
-	// The name of an existing rule after which the new rule will be placed. If
-	// this parameter is null, the new rule will be inserted at the beginning of
-	// the rule list.
+	// The name of an existing rule after which the new rule is placed. If this
+	// parameter is null, the new rule is inserted at the beginning of the rule
+	// list.
 	After *string `type:"string"`
 
 	// A data structure that contains the specified rule's name, actions, recipients,
@@ -7756,18 +7904,26 @@ type CreateReceiptRuleInput struct {
 	// Rule is a required field
 	Rule *ReceiptRule `type:"structure" required:"true"`
 
-	// The name of the rule set that the receipt rule will be added to.
+	// The name of the rule set where the receipt rule is added.
 	//
 	// RuleSetName is a required field
 	RuleSetName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateReceiptRuleInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateReceiptRuleInput) GoString() string {
 	return s.String()
 }
@@ -7816,41 +7972,57 @@ type CreateReceiptRuleOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateReceiptRuleOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateReceiptRuleOutput) GoString() string {
 	return s.String()
 }
 
 // Represents a request to create an empty receipt rule set. You use receipt
 // rule sets to receive email with Amazon SES. For more information, see the
-// Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-concepts.html).
+// Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-concepts.html).
 type CreateReceiptRuleSetInput struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the rule set to create. The name must:
+	// The name of the rule set to create. The name must meet the following requirements:
 	//
-	//    * This value can only contain ASCII letters (a-z, A-Z), numbers (0-9),
-	//    underscores (_), or dashes (-).
+	//    * Contain only ASCII letters (a-z, A-Z), numbers (0-9), underscores (_),
+	//    or dashes (-).
 	//
 	//    * Start and end with a letter or number.
 	//
-	//    * Contain less than 64 characters.
+	//    * Contain 64 characters or fewer.
 	//
 	// RuleSetName is a required field
 	RuleSetName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateReceiptRuleSetInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateReceiptRuleSetInput) GoString() string {
 	return s.String()
 }
@@ -7879,34 +8051,50 @@ type CreateReceiptRuleSetOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateReceiptRuleSetOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateReceiptRuleSetOutput) GoString() string {
 	return s.String()
 }
 
 // Represents a request to create an email template. For more information, see
-// the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/send-personalized-email-api.html).
+// the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/send-personalized-email-api.html).
 type CreateTemplateInput struct {
 	_ struct{} `type:"structure"`
 
-	// The content of the email, composed of a subject line, an HTML part, and a
-	// text-only part.
+	// The content of the email, composed of a subject line and either an HTML part
+	// or a text-only part.
 	//
 	// Template is a required field
 	Template *Template `type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateTemplateInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateTemplateInput) GoString() string {
 	return s.String()
 }
@@ -7939,12 +8127,20 @@ type CreateTemplateOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateTemplateOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateTemplateOutput) GoString() string {
 	return s.String()
 }
@@ -7971,12 +8167,20 @@ type CustomVerificationEmailTemplate struct {
 	TemplateSubject *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CustomVerificationEmailTemplate) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CustomVerificationEmailTemplate) GoString() string {
 	return s.String()
 }
@@ -8014,7 +8218,7 @@ func (s *CustomVerificationEmailTemplate) SetTemplateSubject(v string) *CustomVe
 // Represents a request to delete a configuration set event destination. Configuration
 // set event destinations are associated with configuration sets, which enable
 // you to publish email sending events. For information about using configuration
-// sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/monitor-sending-activity.html).
+// sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity.html).
 type DeleteConfigurationSetEventDestinationInput struct {
 	_ struct{} `type:"structure"`
 
@@ -8029,12 +8233,20 @@ type DeleteConfigurationSetEventDestinationInput struct {
 	EventDestinationName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteConfigurationSetEventDestinationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteConfigurationSetEventDestinationInput) GoString() string {
 	return s.String()
 }
@@ -8072,19 +8284,27 @@ type DeleteConfigurationSetEventDestinationOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteConfigurationSetEventDestinationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteConfigurationSetEventDestinationOutput) GoString() string {
 	return s.String()
 }
 
 // Represents a request to delete a configuration set. Configuration sets enable
 // you to publish email sending events. For information about using configuration
-// sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/monitor-sending-activity.html).
+// sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity.html).
 type DeleteConfigurationSetInput struct {
 	_ struct{} `type:"structure"`
 
@@ -8094,12 +8314,20 @@ type DeleteConfigurationSetInput struct {
 	ConfigurationSetName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteConfigurationSetInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteConfigurationSetInput) GoString() string {
 	return s.String()
 }
@@ -8128,12 +8356,20 @@ type DeleteConfigurationSetOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteConfigurationSetOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteConfigurationSetOutput) GoString() string {
 	return s.String()
 }
@@ -8143,19 +8379,26 @@ func (s DeleteConfigurationSetOutput) GoString() string {
 type DeleteConfigurationSetTrackingOptionsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the configuration set from which you want to delete the tracking
-	// options.
+	// The name of the configuration set.
 	//
 	// ConfigurationSetName is a required field
 	ConfigurationSetName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteConfigurationSetTrackingOptionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteConfigurationSetTrackingOptionsInput) GoString() string {
 	return s.String()
 }
@@ -8184,12 +8427,20 @@ type DeleteConfigurationSetTrackingOptionsOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteConfigurationSetTrackingOptionsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteConfigurationSetTrackingOptionsOutput) GoString() string {
 	return s.String()
 }
@@ -8198,18 +8449,26 @@ func (s DeleteConfigurationSetTrackingOptionsOutput) GoString() string {
 type DeleteCustomVerificationEmailTemplateInput struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the custom verification email template that you want to delete.
+	// The name of the custom verification email template to delete.
 	//
 	// TemplateName is a required field
 	TemplateName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteCustomVerificationEmailTemplateInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteCustomVerificationEmailTemplateInput) GoString() string {
 	return s.String()
 }
@@ -8237,12 +8496,20 @@ type DeleteCustomVerificationEmailTemplateOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteCustomVerificationEmailTemplateOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteCustomVerificationEmailTemplateOutput) GoString() string {
 	return s.String()
 }
@@ -8252,18 +8519,27 @@ func (s DeleteCustomVerificationEmailTemplateOutput) GoString() string {
 type DeleteIdentityInput struct {
 	_ struct{} `type:"structure"`
 
-	// The identity to be removed from the list of identities for the AWS Account.
+	// The identity to be removed from the list of identities for the Amazon Web
+	// Services account.
 	//
 	// Identity is a required field
 	Identity *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteIdentityInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteIdentityInput) GoString() string {
 	return s.String()
 }
@@ -8292,12 +8568,20 @@ type DeleteIdentityOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteIdentityOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteIdentityOutput) GoString() string {
 	return s.String()
 }
@@ -8305,15 +8589,15 @@ func (s DeleteIdentityOutput) GoString() string {
 // Represents a request to delete a sending authorization policy for an identity.
 // Sending authorization is an Amazon SES feature that enables you to authorize
 // other senders to use your identities. For information, see the Amazon SES
-// Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization.html).
+// Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization.html).
 type DeleteIdentityPolicyInput struct {
 	_ struct{} `type:"structure"`
 
-	// The identity that is associated with the policy that you want to delete.
-	// You can specify the identity by using its name or by using its Amazon Resource
-	// Name (ARN). Examples: user@example.com, example.com, arn:aws:ses:us-east-1:123456789012:identity/example.com.
+	// The identity that is associated with the policy to delete. You can specify
+	// the identity by using its name or by using its Amazon Resource Name (ARN).
+	// Examples: user@example.com, example.com, arn:aws:ses:us-east-1:123456789012:identity/example.com.
 	//
-	// To successfully call this API, you must own the identity.
+	// To successfully call this operation, you must own the identity.
 	//
 	// Identity is a required field
 	Identity *string `type:"string" required:"true"`
@@ -8324,12 +8608,20 @@ type DeleteIdentityPolicyInput struct {
 	PolicyName *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteIdentityPolicyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteIdentityPolicyInput) GoString() string {
 	return s.String()
 }
@@ -8370,19 +8662,27 @@ type DeleteIdentityPolicyOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteIdentityPolicyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteIdentityPolicyOutput) GoString() string {
 	return s.String()
 }
 
 // Represents a request to delete an IP address filter. You use IP address filters
 // when you receive email with Amazon SES. For more information, see the Amazon
-// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-concepts.html).
+// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-concepts.html).
 type DeleteReceiptFilterInput struct {
 	_ struct{} `type:"structure"`
 
@@ -8392,12 +8692,20 @@ type DeleteReceiptFilterInput struct {
 	FilterName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteReceiptFilterInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteReceiptFilterInput) GoString() string {
 	return s.String()
 }
@@ -8426,19 +8734,27 @@ type DeleteReceiptFilterOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteReceiptFilterOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteReceiptFilterOutput) GoString() string {
 	return s.String()
 }
 
 // Represents a request to delete a receipt rule. You use receipt rules to receive
 // email with Amazon SES. For more information, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-concepts.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-concepts.html).
 type DeleteReceiptRuleInput struct {
 	_ struct{} `type:"structure"`
 
@@ -8453,12 +8769,20 @@ type DeleteReceiptRuleInput struct {
 	RuleSetName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteReceiptRuleInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteReceiptRuleInput) GoString() string {
 	return s.String()
 }
@@ -8496,19 +8820,27 @@ type DeleteReceiptRuleOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteReceiptRuleOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteReceiptRuleOutput) GoString() string {
 	return s.String()
 }
 
 // Represents a request to delete a receipt rule set and all of the receipt
 // rules it contains. You use receipt rule sets to receive email with Amazon
-// SES. For more information, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-concepts.html).
+// SES. For more information, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-concepts.html).
 type DeleteReceiptRuleSetInput struct {
 	_ struct{} `type:"structure"`
 
@@ -8518,12 +8850,20 @@ type DeleteReceiptRuleSetInput struct {
 	RuleSetName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteReceiptRuleSetInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteReceiptRuleSetInput) GoString() string {
 	return s.String()
 }
@@ -8552,18 +8892,26 @@ type DeleteReceiptRuleSetOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteReceiptRuleSetOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteReceiptRuleSetOutput) GoString() string {
 	return s.String()
 }
 
 // Represents a request to delete an email template. For more information, see
-// the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/send-personalized-email-api.html).
+// the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/send-personalized-email-api.html).
 type DeleteTemplateInput struct {
 	_ struct{} `type:"structure"`
 
@@ -8573,12 +8921,20 @@ type DeleteTemplateInput struct {
 	TemplateName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteTemplateInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteTemplateInput) GoString() string {
 	return s.String()
 }
@@ -8606,18 +8962,26 @@ type DeleteTemplateOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteTemplateOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteTemplateOutput) GoString() string {
 	return s.String()
 }
 
 // Represents a request to delete an email address from the list of email addresses
-// you have attempted to verify under your AWS account.
+// you have attempted to verify under your Amazon Web Services account.
 type DeleteVerifiedEmailAddressInput struct {
 	_ struct{} `type:"structure"`
 
@@ -8627,12 +8991,20 @@ type DeleteVerifiedEmailAddressInput struct {
 	EmailAddress *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteVerifiedEmailAddressInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteVerifiedEmailAddressInput) GoString() string {
 	return s.String()
 }
@@ -8660,12 +9032,20 @@ type DeleteVerifiedEmailAddressOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteVerifiedEmailAddressOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteVerifiedEmailAddressOutput) GoString() string {
 	return s.String()
 }
@@ -8682,12 +9062,20 @@ type DeliveryOptions struct {
 	TlsPolicy *string `type:"string" enum:"TlsPolicy"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeliveryOptions) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeliveryOptions) GoString() string {
 	return s.String()
 }
@@ -8701,17 +9089,25 @@ func (s *DeliveryOptions) SetTlsPolicy(v string) *DeliveryOptions {
 // Represents a request to return the metadata and receipt rules for the receipt
 // rule set that is currently active. You use receipt rule sets to receive email
 // with Amazon SES. For more information, see the Amazon SES Developer Guide
-// (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-concepts.html).
+// (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-concepts.html).
 type DescribeActiveReceiptRuleSetInput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeActiveReceiptRuleSetInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeActiveReceiptRuleSetInput) GoString() string {
 	return s.String()
 }
@@ -8729,12 +9125,20 @@ type DescribeActiveReceiptRuleSetOutput struct {
 	Rules []*ReceiptRule `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeActiveReceiptRuleSetOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeActiveReceiptRuleSetOutput) GoString() string {
 	return s.String()
 }
@@ -8753,12 +9157,12 @@ func (s *DescribeActiveReceiptRuleSetOutput) SetRules(v []*ReceiptRule) *Describ
 
 // Represents a request to return the details of a configuration set. Configuration
 // sets enable you to publish email sending events. For information about using
-// configuration sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/monitor-sending-activity.html).
+// configuration sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity.html).
 type DescribeConfigurationSetInput struct {
 	_ struct{} `type:"structure"`
 
 	// A list of configuration set attributes to return.
-	ConfigurationSetAttributeNames []*string `type:"list"`
+	ConfigurationSetAttributeNames []*string `type:"list" enum:"ConfigurationSetAttribute"`
 
 	// The name of the configuration set to describe.
 	//
@@ -8766,12 +9170,20 @@ type DescribeConfigurationSetInput struct {
 	ConfigurationSetName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeConfigurationSetInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeConfigurationSetInput) GoString() string {
 	return s.String()
 }
@@ -8803,7 +9215,7 @@ func (s *DescribeConfigurationSetInput) SetConfigurationSetName(v string) *Descr
 
 // Represents the details of a configuration set. Configuration sets enable
 // you to publish email sending events. For information about using configuration
-// sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/monitor-sending-activity.html).
+// sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity.html).
 type DescribeConfigurationSetOutput struct {
 	_ struct{} `type:"structure"`
 
@@ -8826,12 +9238,20 @@ type DescribeConfigurationSetOutput struct {
 	TrackingOptions *TrackingOptions `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeConfigurationSetOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeConfigurationSetOutput) GoString() string {
 	return s.String()
 }
@@ -8868,7 +9288,7 @@ func (s *DescribeConfigurationSetOutput) SetTrackingOptions(v *TrackingOptions)
 
 // Represents a request to return the details of a receipt rule. You use receipt
 // rules to receive email with Amazon SES. For more information, see the Amazon
-// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-concepts.html).
+// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-concepts.html).
 type DescribeReceiptRuleInput struct {
 	_ struct{} `type:"structure"`
 
@@ -8883,12 +9303,20 @@ type DescribeReceiptRuleInput struct {
 	RuleSetName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeReceiptRuleInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeReceiptRuleInput) GoString() string {
 	return s.String()
 }
@@ -8931,12 +9359,20 @@ type DescribeReceiptRuleOutput struct {
 	Rule *ReceiptRule `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeReceiptRuleOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeReceiptRuleOutput) GoString() string {
 	return s.String()
 }
@@ -8949,7 +9385,7 @@ func (s *DescribeReceiptRuleOutput) SetRule(v *ReceiptRule) *DescribeReceiptRule
 
 // Represents a request to return the details of a receipt rule set. You use
 // receipt rule sets to receive email with Amazon SES. For more information,
-// see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-concepts.html).
+// see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-concepts.html).
 type DescribeReceiptRuleSetInput struct {
 	_ struct{} `type:"structure"`
 
@@ -8959,12 +9395,20 @@ type DescribeReceiptRuleSetInput struct {
 	RuleSetName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeReceiptRuleSetInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeReceiptRuleSetInput) GoString() string {
 	return s.String()
 }
@@ -9000,12 +9444,20 @@ type DescribeReceiptRuleSetOutput struct {
 	Rules []*ReceiptRule `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeReceiptRuleSetOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeReceiptRuleSetOutput) GoString() string {
 	return s.String()
 }
@@ -9026,12 +9478,15 @@ func (s *DescribeReceiptRuleSetOutput) SetRules(v []*ReceiptRule) *DescribeRecei
 // fields.
 //
 // Amazon SES does not support the SMTPUTF8 extension, as described in RFC6531
-// (https://tools.ietf.org/html/rfc6531). For this reason, the local part of
-// a destination email address (the part of the email address that precedes
-// the @ sign) may only contain 7-bit ASCII characters (https://en.wikipedia.org/wiki/Email_address#Local-part).
-// If the domain part of an address (the part after the @ sign) contains non-ASCII
-// characters, they must be encoded using Punycode, as described in RFC3492
-// (https://tools.ietf.org/html/rfc3492.html).
+// (https://tools.ietf.org/html/rfc6531). For this reason, the email address
+// string must be 7-bit ASCII. If you want to send to or from email addresses
+// that contain Unicode characters in the domain part of an address, you must
+// encode the domain using Punycode. Punycode is not permitted in the local
+// part of the email address (the part before the @ sign) nor in the "friendly
+// from" name. If you want to use Unicode characters in the "friendly from"
+// name, you must encode the "friendly from" name using MIME encoded-word syntax,
+// as described in Sending raw email using the Amazon SES API (https://docs.aws.amazon.com/ses/latest/dg/send-email-raw.html).
+// For more information about Punycode, see RFC 3492 (http://tools.ietf.org/html/rfc3492).
 type Destination struct {
 	_ struct{} `type:"structure"`
 
@@ -9045,12 +9500,20 @@ type Destination struct {
 	ToAddresses []*string `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Destination) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Destination) GoString() string {
 	return s.String()
 }
@@ -9073,8 +9536,7 @@ func (s *Destination) SetToAddresses(v []*string) *Destination {
 	return s
 }
 
-// Contains information about the event destination that the specified email
-// sending events will be published to.
+// Contains information about an event destination.
 //
 // When you create or update an event destination, you must provide one, and
 // only one, destination. The destination can be Amazon CloudWatch, Amazon Kinesis
@@ -9083,7 +9545,7 @@ func (s *Destination) SetToAddresses(v []*string) *Destination {
 // Event destinations are associated with configuration sets, which enable you
 // to publish email sending events to Amazon CloudWatch, Amazon Kinesis Firehose,
 // or Amazon Simple Notification Service (Amazon SNS). For information about
-// using configuration sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/monitor-sending-activity.html).
+// using configuration sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity.html).
 type EventDestination struct {
 	_ struct{} `type:"structure"`
 
@@ -9103,15 +9565,37 @@ type EventDestination struct {
 
 	// The type of email sending events to publish to the event destination.
 	//
+	//    * send - The call was successful and Amazon SES is attempting to deliver
+	//    the email.
+	//
+	//    * reject - Amazon SES determined that the email contained a virus and
+	//    rejected it.
+	//
+	//    * bounce - The recipient's mail server permanently rejected the email.
+	//    This corresponds to a hard bounce.
+	//
+	//    * complaint - The recipient marked the email as spam.
+	//
+	//    * delivery - Amazon SES successfully delivered the email to the recipient's
+	//    mail server.
+	//
+	//    * open - The recipient received the email and opened it in their email
+	//    client.
+	//
+	//    * click - The recipient clicked one or more links in the email.
+	//
+	//    * renderingFailure - Amazon SES did not send the email because of a template
+	//    rendering issue.
+	//
 	// MatchingEventTypes is a required field
-	MatchingEventTypes []*string `type:"list" required:"true"`
+	MatchingEventTypes []*string `type:"list" required:"true" enum:"EventType"`
 
-	// The name of the event destination. The name must:
+	// The name of the event destination. The name must meet the following requirements:
 	//
-	//    * This value can only contain ASCII letters (a-z, A-Z), numbers (0-9),
-	//    underscores (_), or dashes (-).
+	//    * Contain only ASCII letters (a-z, A-Z), numbers (0-9), underscores (_),
+	//    or dashes (-).
 	//
-	//    * Contain less than 64 characters.
+	//    * Contain 64 characters or fewer.
 	//
 	// Name is a required field
 	Name *string `type:"string" required:"true"`
@@ -9121,12 +9605,20 @@ type EventDestination struct {
 	SNSDestination *SNSDestination `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s EventDestination) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s EventDestination) GoString() string {
 	return s.String()
 }
@@ -9202,7 +9694,7 @@ func (s *EventDestination) SetSNSDestination(v *SNSDestination) *EventDestinatio
 // when an email that Amazon SES receives on your behalf bounces.
 //
 // For information about receiving email through Amazon SES, see the Amazon
-// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email.html).
+// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email.html).
 type ExtensionField struct {
 	_ struct{} `type:"structure"`
 
@@ -9212,19 +9704,27 @@ type ExtensionField struct {
 	// Name is a required field
 	Name *string `type:"string" required:"true"`
 
-	// The value of the header to add. Must be less than 2048 characters, and must
-	// not contain newline characters ("\r" or "\n").
+	// The value of the header to add. Must contain 2048 characters or fewer, and
+	// must not contain newline characters ("\r" or "\n").
 	//
 	// Value is a required field
 	Value *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ExtensionField) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ExtensionField) GoString() string {
 	return s.String()
 }
@@ -9261,32 +9761,48 @@ type GetAccountSendingEnabledInput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetAccountSendingEnabledInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetAccountSendingEnabledInput) GoString() string {
 	return s.String()
 }
 
 // Represents a request to return the email sending status for your Amazon SES
-// account in the current AWS Region.
+// account in the current Amazon Web Services Region.
 type GetAccountSendingEnabledOutput struct {
 	_ struct{} `type:"structure"`
 
 	// Describes whether email sending is enabled or disabled for your Amazon SES
-	// account in the current AWS Region.
+	// account in the current Amazon Web Services Region.
 	Enabled *bool `type:"boolean"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetAccountSendingEnabledOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetAccountSendingEnabledOutput) GoString() string {
 	return s.String()
 }
@@ -9301,18 +9817,26 @@ func (s *GetAccountSendingEnabledOutput) SetEnabled(v bool) *GetAccountSendingEn
 type GetCustomVerificationEmailTemplateInput struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the custom verification email template that you want to retrieve.
+	// The name of the custom verification email template to retrieve.
 	//
 	// TemplateName is a required field
 	TemplateName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCustomVerificationEmailTemplateInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCustomVerificationEmailTemplateInput) GoString() string {
 	return s.String()
 }
@@ -9361,12 +9885,20 @@ type GetCustomVerificationEmailTemplateOutput struct {
 	TemplateSubject *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCustomVerificationEmailTemplateOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetCustomVerificationEmailTemplateOutput) GoString() string {
 	return s.String()
 }
@@ -9411,7 +9943,7 @@ func (s *GetCustomVerificationEmailTemplateOutput) SetTemplateSubject(v string)
 // identity. For domain identities, this request also returns the DKIM tokens
 // that are required for Easy DKIM signing, and whether Amazon SES successfully
 // verified that these tokens were published. For more information about Easy
-// DKIM, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/easy-dkim.html).
+// DKIM, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/send-email-authentication-dkim-easy.html).
 type GetIdentityDkimAttributesInput struct {
 	_ struct{} `type:"structure"`
 
@@ -9422,12 +9954,20 @@ type GetIdentityDkimAttributesInput struct {
 	Identities []*string `type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetIdentityDkimAttributesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetIdentityDkimAttributesInput) GoString() string {
 	return s.String()
 }
@@ -9464,12 +10004,20 @@ type GetIdentityDkimAttributesOutput struct {
 	DkimAttributes map[string]*IdentityDkimAttributes `type:"map" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetIdentityDkimAttributesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetIdentityDkimAttributesOutput) GoString() string {
 	return s.String()
 }
@@ -9482,7 +10030,7 @@ func (s *GetIdentityDkimAttributesOutput) SetDkimAttributes(v map[string]*Identi
 
 // Represents a request to return the Amazon SES custom MAIL FROM attributes
 // for a list of identities. For information about using a custom MAIL FROM
-// domain, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/mail-from.html).
+// domain, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/mail-from.html).
 type GetIdentityMailFromDomainAttributesInput struct {
 	_ struct{} `type:"structure"`
 
@@ -9492,12 +10040,20 @@ type GetIdentityMailFromDomainAttributesInput struct {
 	Identities []*string `type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetIdentityMailFromDomainAttributesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetIdentityMailFromDomainAttributesInput) GoString() string {
 	return s.String()
 }
@@ -9531,12 +10087,20 @@ type GetIdentityMailFromDomainAttributesOutput struct {
 	MailFromDomainAttributes map[string]*IdentityMailFromDomainAttributes `type:"map" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetIdentityMailFromDomainAttributesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetIdentityMailFromDomainAttributesOutput) GoString() string {
 	return s.String()
 }
@@ -9549,7 +10113,7 @@ func (s *GetIdentityMailFromDomainAttributesOutput) SetMailFromDomainAttributes(
 
 // Represents a request to return the notification attributes for a list of
 // identities you verified with Amazon SES. For information about Amazon SES
-// notifications, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/notifications.html).
+// notifications, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity-using-notifications.html).
 type GetIdentityNotificationAttributesInput struct {
 	_ struct{} `type:"structure"`
 
@@ -9561,12 +10125,20 @@ type GetIdentityNotificationAttributesInput struct {
 	Identities []*string `type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetIdentityNotificationAttributesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetIdentityNotificationAttributesInput) GoString() string {
 	return s.String()
 }
@@ -9600,12 +10172,20 @@ type GetIdentityNotificationAttributesOutput struct {
 	NotificationAttributes map[string]*IdentityNotificationAttributes `type:"map" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetIdentityNotificationAttributesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetIdentityNotificationAttributesOutput) GoString() string {
 	return s.String()
 }
@@ -9619,15 +10199,15 @@ func (s *GetIdentityNotificationAttributesOutput) SetNotificationAttributes(v ma
 // Represents a request to return the requested sending authorization policies
 // for an identity. Sending authorization is an Amazon SES feature that enables
 // you to authorize other senders to use your identities. For information, see
-// the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization.html).
+// the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization.html).
 type GetIdentityPoliciesInput struct {
 	_ struct{} `type:"structure"`
 
-	// The identity for which the policies will be retrieved. You can specify an
-	// identity by using its name or by using its Amazon Resource Name (ARN). Examples:
-	// user@example.com, example.com, arn:aws:ses:us-east-1:123456789012:identity/example.com.
+	// The identity for which the policies are retrieved. You can specify an identity
+	// by using its name or by using its Amazon Resource Name (ARN). Examples: user@example.com,
+	// example.com, arn:aws:ses:us-east-1:123456789012:identity/example.com.
 	//
-	// To successfully call this API, you must own the identity.
+	// To successfully call this operation, you must own the identity.
 	//
 	// Identity is a required field
 	Identity *string `type:"string" required:"true"`
@@ -9640,12 +10220,20 @@ type GetIdentityPoliciesInput struct {
 	PolicyNames []*string `type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetIdentityPoliciesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetIdentityPoliciesInput) GoString() string {
 	return s.String()
 }
@@ -9688,12 +10276,20 @@ type GetIdentityPoliciesOutput struct {
 	Policies map[string]*string `type:"map" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetIdentityPoliciesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetIdentityPoliciesOutput) GoString() string {
 	return s.String()
 }
@@ -9707,7 +10303,7 @@ func (s *GetIdentityPoliciesOutput) SetPolicies(v map[string]*string) *GetIdenti
 // Represents a request to return the Amazon SES verification status of a list
 // of identities. For domain identities, this request also returns the verification
 // token. For information about verifying identities with Amazon SES, see the
-// Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/verify-addresses-and-domains.html).
+// Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/creating-identities.html).
 type GetIdentityVerificationAttributesInput struct {
 	_ struct{} `type:"structure"`
 
@@ -9717,12 +10313,20 @@ type GetIdentityVerificationAttributesInput struct {
 	Identities []*string `type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetIdentityVerificationAttributesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetIdentityVerificationAttributesInput) GoString() string {
 	return s.String()
 }
@@ -9757,12 +10361,20 @@ type GetIdentityVerificationAttributesOutput struct {
 	VerificationAttributes map[string]*IdentityVerificationAttributes `type:"map" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetIdentityVerificationAttributesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetIdentityVerificationAttributesOutput) GoString() string {
 	return s.String()
 }
@@ -9777,12 +10389,20 @@ type GetSendQuotaInput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetSendQuotaInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetSendQuotaInput) GoString() string {
 	return s.String()
 }
@@ -9807,12 +10427,20 @@ type GetSendQuotaOutput struct {
 	SentLast24Hours *float64 `type:"double"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetSendQuotaOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetSendQuotaOutput) GoString() string {
 	return s.String()
 }
@@ -9839,12 +10467,20 @@ type GetSendStatisticsInput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetSendStatisticsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetSendStatisticsInput) GoString() string {
 	return s.String()
 }
@@ -9858,12 +10494,20 @@ type GetSendStatisticsOutput struct {
 	SendDataPoints []*SendDataPoint `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetSendStatisticsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetSendStatisticsOutput) GoString() string {
 	return s.String()
 }
@@ -9877,18 +10521,26 @@ func (s *GetSendStatisticsOutput) SetSendDataPoints(v []*SendDataPoint) *GetSend
 type GetTemplateInput struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the template you want to retrieve.
+	// The name of the template to retrieve.
 	//
 	// TemplateName is a required field
 	TemplateName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetTemplateInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetTemplateInput) GoString() string {
 	return s.String()
 }
@@ -9915,17 +10567,25 @@ func (s *GetTemplateInput) SetTemplateName(v string) *GetTemplateInput {
 type GetTemplateOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The content of the email, composed of a subject line, an HTML part, and a
-	// text-only part.
+	// The content of the email, composed of a subject line and either an HTML part
+	// or a text-only part.
 	Template *Template `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetTemplateOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetTemplateOutput) GoString() string {
 	return s.String()
 }
@@ -9955,7 +10615,7 @@ type IdentityDkimAttributes struct {
 	// email address identities.)
 	//
 	// For more information about creating DNS records using DKIM tokens, see the
-	// Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/easy-dkim.html).
+	// Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/send-email-authentication-dkim-easy.html).
 	DkimTokens []*string `type:"list"`
 
 	// Describes whether Amazon SES has successfully verified the DKIM DNS records
@@ -9966,12 +10626,20 @@ type IdentityDkimAttributes struct {
 	DkimVerificationStatus *string `type:"string" required:"true" enum:"VerificationStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s IdentityDkimAttributes) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s IdentityDkimAttributes) GoString() string {
 	return s.String()
 }
@@ -10027,12 +10695,20 @@ type IdentityMailFromDomainAttributes struct {
 	MailFromDomainStatus *string `type:"string" required:"true" enum:"CustomMailFromStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s IdentityMailFromDomainAttributes) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s IdentityMailFromDomainAttributes) GoString() string {
 	return s.String()
 }
@@ -10062,57 +10738,65 @@ func (s *IdentityMailFromDomainAttributes) SetMailFromDomainStatus(v string) *Id
 type IdentityNotificationAttributes struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the Amazon SNS topic where Amazon SES will
-	// publish bounce notifications.
+	// The Amazon Resource Name (ARN) of the Amazon SNS topic where Amazon SES publishes
+	// bounce notifications.
 	//
 	// BounceTopic is a required field
 	BounceTopic *string `type:"string" required:"true"`
 
-	// The Amazon Resource Name (ARN) of the Amazon SNS topic where Amazon SES will
-	// publish complaint notifications.
+	// The Amazon Resource Name (ARN) of the Amazon SNS topic where Amazon SES publishes
+	// complaint notifications.
 	//
 	// ComplaintTopic is a required field
 	ComplaintTopic *string `type:"string" required:"true"`
 
-	// The Amazon Resource Name (ARN) of the Amazon SNS topic where Amazon SES will
-	// publish delivery notifications.
+	// The Amazon Resource Name (ARN) of the Amazon SNS topic where Amazon SES publishes
+	// delivery notifications.
 	//
 	// DeliveryTopic is a required field
 	DeliveryTopic *string `type:"string" required:"true"`
 
-	// Describes whether Amazon SES will forward bounce and complaint notifications
-	// as email. true indicates that Amazon SES will forward bounce and complaint
-	// notifications as email, while false indicates that bounce and complaint notifications
-	// will be published only to the specified bounce and complaint Amazon SNS topics.
+	// Describes whether Amazon SES forwards bounce and complaint notifications
+	// as email. true indicates that Amazon SES forwards bounce and complaint notifications
+	// as email, while false indicates that bounce and complaint notifications are
+	// published only to the specified bounce and complaint Amazon SNS topics.
 	//
 	// ForwardingEnabled is a required field
 	ForwardingEnabled *bool `type:"boolean" required:"true"`
 
 	// Describes whether Amazon SES includes the original email headers in Amazon
 	// SNS notifications of type Bounce. A value of true specifies that Amazon SES
-	// will include headers in bounce notifications, and a value of false specifies
-	// that Amazon SES will not include headers in bounce notifications.
+	// includes headers in bounce notifications, and a value of false specifies
+	// that Amazon SES does not include headers in bounce notifications.
 	HeadersInBounceNotificationsEnabled *bool `type:"boolean"`
 
 	// Describes whether Amazon SES includes the original email headers in Amazon
 	// SNS notifications of type Complaint. A value of true specifies that Amazon
-	// SES will include headers in complaint notifications, and a value of false
-	// specifies that Amazon SES will not include headers in complaint notifications.
+	// SES includes headers in complaint notifications, and a value of false specifies
+	// that Amazon SES does not include headers in complaint notifications.
 	HeadersInComplaintNotificationsEnabled *bool `type:"boolean"`
 
 	// Describes whether Amazon SES includes the original email headers in Amazon
 	// SNS notifications of type Delivery. A value of true specifies that Amazon
-	// SES will include headers in delivery notifications, and a value of false
-	// specifies that Amazon SES will not include headers in delivery notifications.
+	// SES includes headers in delivery notifications, and a value of false specifies
+	// that Amazon SES does not include headers in delivery notifications.
 	HeadersInDeliveryNotificationsEnabled *bool `type:"boolean"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s IdentityNotificationAttributes) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s IdentityNotificationAttributes) GoString() string {
 	return s.String()
 }
@@ -10173,12 +10857,20 @@ type IdentityVerificationAttributes struct {
 	VerificationToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s IdentityVerificationAttributes) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s IdentityVerificationAttributes) GoString() string {
 	return s.String()
 }
@@ -10201,7 +10893,7 @@ func (s *IdentityVerificationAttributes) SetVerificationToken(v string) *Identit
 // Event destinations, such as Amazon Kinesis Firehose, are associated with
 // configuration sets, which enable you to publish email sending events. For
 // information about using configuration sets, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/monitor-sending-activity.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity.html).
 type KinesisFirehoseDestination struct {
 	_ struct{} `type:"structure"`
 
@@ -10218,12 +10910,20 @@ type KinesisFirehoseDestination struct {
 	IAMRoleARN *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s KinesisFirehoseDestination) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s KinesisFirehoseDestination) GoString() string {
 	return s.String()
 }
@@ -10256,53 +10956,64 @@ func (s *KinesisFirehoseDestination) SetIAMRoleARN(v string) *KinesisFirehoseDes
 	return s
 }
 
-// When included in a receipt rule, this action calls an AWS Lambda function
-// and, optionally, publishes a notification to Amazon Simple Notification Service
-// (Amazon SNS).
+// When included in a receipt rule, this action calls an Amazon Web Services
+// Lambda function and, optionally, publishes a notification to Amazon Simple
+// Notification Service (Amazon SNS).
 //
-// To enable Amazon SES to call your AWS Lambda function or to publish to an
-// Amazon SNS topic of another account, Amazon SES must have permission to access
-// those resources. For information about giving permissions, see the Amazon
-// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-permissions.html).
+// To enable Amazon SES to call your Amazon Web Services Lambda function or
+// to publish to an Amazon SNS topic of another account, Amazon SES must have
+// permission to access those resources. For information about giving permissions,
+// see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-permissions.html).
 //
-// For information about using AWS Lambda actions in receipt rules, see the
-// Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-action-lambda.html).
+// For information about using Amazon Web Services Lambda actions in receipt
+// rules, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-action-lambda.html).
 type LambdaAction struct {
 	_ struct{} `type:"structure"`
 
-	// The Amazon Resource Name (ARN) of the AWS Lambda function. An example of
-	// an AWS Lambda function ARN is arn:aws:lambda:us-west-2:account-id:function:MyFunction.
-	// For more information about AWS Lambda, see the AWS Lambda Developer Guide
-	// (https://docs.aws.amazon.com/lambda/latest/dg/welcome.html).
+	// The Amazon Resource Name (ARN) of the Amazon Web Services Lambda function.
+	// An example of an Amazon Web Services Lambda function ARN is arn:aws:lambda:us-west-2:account-id:function:MyFunction.
+	// For more information about Amazon Web Services Lambda, see the Amazon Web
+	// Services Lambda Developer Guide (https://docs.aws.amazon.com/lambda/latest/dg/welcome.html).
 	//
 	// FunctionArn is a required field
 	FunctionArn *string `type:"string" required:"true"`
 
-	// The invocation type of the AWS Lambda function. An invocation type of RequestResponse
-	// means that the execution of the function will immediately result in a response,
-	// and a value of Event means that the function will be invoked asynchronously.
-	// The default value is Event. For information about AWS Lambda invocation types,
-	// see the AWS Lambda Developer Guide (https://docs.aws.amazon.com/lambda/latest/dg/API_Invoke.html).
+	// The invocation type of the Amazon Web Services Lambda function. An invocation
+	// type of RequestResponse means that the execution of the function immediately
+	// results in a response, and a value of Event means that the function is invoked
+	// asynchronously. The default value is Event. For information about Amazon
+	// Web Services Lambda invocation types, see the Amazon Web Services Lambda
+	// Developer Guide (https://docs.aws.amazon.com/lambda/latest/dg/API_Invoke.html).
 	//
 	// There is a 30-second timeout on RequestResponse invocations. You should use
-	// Event invocation in most cases. Use RequestResponse only when you want to
-	// make a mail flow decision, such as whether to stop the receipt rule or the
-	// receipt rule set.
+	// Event invocation in most cases. Use RequestResponse only to make a mail flow
+	// decision, such as whether to stop the receipt rule or the receipt rule set.
 	InvocationType *string `type:"string" enum:"InvocationType"`
 
 	// The Amazon Resource Name (ARN) of the Amazon SNS topic to notify when the
-	// Lambda action is taken. An example of an Amazon SNS topic ARN is arn:aws:sns:us-west-2:123456789012:MyTopic.
+	// Lambda action is executed. You can find the ARN of a topic by using the ListTopics
+	// (https://docs.aws.amazon.com/sns/latest/api/API_ListTopics.html) operation
+	// in Amazon SNS.
+	//
 	// For more information about Amazon SNS topics, see the Amazon SNS Developer
 	// Guide (https://docs.aws.amazon.com/sns/latest/dg/CreateTopic.html).
 	TopicArn *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s LambdaAction) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s LambdaAction) GoString() string {
 	return s.String()
 }
@@ -10339,9 +11050,9 @@ func (s *LambdaAction) SetTopicArn(v string) *LambdaAction {
 }
 
 // Represents a request to list the configuration sets associated with your
-// AWS account. Configuration sets enable you to publish email sending events.
-// For information about using configuration sets, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/monitor-sending-activity.html).
+// Amazon Web Services account. Configuration sets enable you to publish email
+// sending events. For information about using configuration sets, see the Amazon
+// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity.html).
 type ListConfigurationSetsInput struct {
 	_ struct{} `type:"structure"`
 
@@ -10353,12 +11064,20 @@ type ListConfigurationSetsInput struct {
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListConfigurationSetsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListConfigurationSetsInput) GoString() string {
 	return s.String()
 }
@@ -10375,9 +11094,9 @@ func (s *ListConfigurationSetsInput) SetNextToken(v string) *ListConfigurationSe
 	return s
 }
 
-// A list of configuration sets associated with your AWS account. Configuration
-// sets enable you to publish email sending events. For information about using
-// configuration sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/monitor-sending-activity.html).
+// A list of configuration sets associated with your Amazon Web Services account.
+// Configuration sets enable you to publish email sending events. For information
+// about using configuration sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity.html).
 type ListConfigurationSetsOutput struct {
 	_ struct{} `type:"structure"`
 
@@ -10389,12 +11108,20 @@ type ListConfigurationSetsOutput struct {
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListConfigurationSetsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListConfigurationSetsOutput) GoString() string {
 	return s.String()
 }
@@ -10415,7 +11142,7 @@ func (s *ListConfigurationSetsOutput) SetNextToken(v string) *ListConfigurationS
 // for your account.
 //
 // For more information about custom verification email templates, see Using
-// Custom Verification Email Templates (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/custom-verification-emails.html)
+// Custom Verification Email Templates (https://docs.aws.amazon.com/ses/latest/dg/creating-identities.html#send-email-verify-address-custom)
 // in the Amazon SES Developer Guide.
 type ListCustomVerificationEmailTemplatesInput struct {
 	_ struct{} `type:"structure"`
@@ -10423,7 +11150,7 @@ type ListCustomVerificationEmailTemplatesInput struct {
 	// The maximum number of custom verification email templates to return. This
 	// value must be at least 1 and less than or equal to 50. If you do not specify
 	// a value, or if you specify a value less than 1 or greater than 50, the operation
-	// will return up to 50 results.
+	// returns up to 50 results.
 	MaxResults *int64 `min:"1" type:"integer"`
 
 	// An array the contains the name and creation time stamp for each template
@@ -10431,12 +11158,20 @@ type ListCustomVerificationEmailTemplatesInput struct {
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListCustomVerificationEmailTemplatesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListCustomVerificationEmailTemplatesInput) GoString() string {
 	return s.String()
 }
@@ -10479,12 +11214,20 @@ type ListCustomVerificationEmailTemplatesOutput struct {
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListCustomVerificationEmailTemplatesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListCustomVerificationEmailTemplatesOutput) GoString() string {
 	return s.String()
 }
@@ -10502,13 +11245,13 @@ func (s *ListCustomVerificationEmailTemplatesOutput) SetNextToken(v string) *Lis
 }
 
 // Represents a request to return a list of all identities (email addresses
-// and domains) that you have attempted to verify under your AWS account, regardless
-// of verification status.
+// and domains) that you have attempted to verify under your Amazon Web Services
+// account, regardless of verification status.
 type ListIdentitiesInput struct {
 	_ struct{} `type:"structure"`
 
 	// The type of the identities to list. Possible values are "EmailAddress" and
-	// "Domain". If this parameter is omitted, then all identities will be listed.
+	// "Domain". If this parameter is omitted, then all identities are listed.
 	IdentityType *string `type:"string" enum:"IdentityType"`
 
 	// The maximum number of identities per page. Possible values are 1-1000 inclusive.
@@ -10518,12 +11261,20 @@ type ListIdentitiesInput struct {
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListIdentitiesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListIdentitiesInput) GoString() string {
 	return s.String()
 }
@@ -10546,8 +11297,8 @@ func (s *ListIdentitiesInput) SetNextToken(v string) *ListIdentitiesInput {
 	return s
 }
 
-// A list of all identities that you have attempted to verify under your AWS
-// account, regardless of verification status.
+// A list of all identities that you have attempted to verify under your Amazon
+// Web Services account, regardless of verification status.
 type ListIdentitiesOutput struct {
 	_ struct{} `type:"structure"`
 
@@ -10560,12 +11311,20 @@ type ListIdentitiesOutput struct {
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListIdentitiesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListIdentitiesOutput) GoString() string {
 	return s.String()
 }
@@ -10585,26 +11344,34 @@ func (s *ListIdentitiesOutput) SetNextToken(v string) *ListIdentitiesOutput {
 // Represents a request to return a list of sending authorization policies that
 // are attached to an identity. Sending authorization is an Amazon SES feature
 // that enables you to authorize other senders to use your identities. For information,
-// see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization.html).
+// see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization.html).
 type ListIdentityPoliciesInput struct {
 	_ struct{} `type:"structure"`
 
-	// The identity that is associated with the policy for which the policies will
-	// be listed. You can specify an identity by using its name or by using its
-	// Amazon Resource Name (ARN). Examples: user@example.com, example.com, arn:aws:ses:us-east-1:123456789012:identity/example.com.
+	// The identity that is associated with the policy for which the policies are
+	// listed. You can specify an identity by using its name or by using its Amazon
+	// Resource Name (ARN). Examples: user@example.com, example.com, arn:aws:ses:us-east-1:123456789012:identity/example.com.
 	//
-	// To successfully call this API, you must own the identity.
+	// To successfully call this operation, you must own the identity.
 	//
 	// Identity is a required field
 	Identity *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListIdentityPoliciesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListIdentityPoliciesInput) GoString() string {
 	return s.String()
 }
@@ -10638,12 +11405,20 @@ type ListIdentityPoliciesOutput struct {
 	PolicyNames []*string `type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListIdentityPoliciesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListIdentityPoliciesOutput) GoString() string {
 	return s.String()
 }
@@ -10655,23 +11430,32 @@ func (s *ListIdentityPoliciesOutput) SetPolicyNames(v []*string) *ListIdentityPo
 }
 
 // Represents a request to list the IP address filters that exist under your
-// AWS account. You use IP address filters when you receive email with Amazon
-// SES. For more information, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-concepts.html).
+// Amazon Web Services account. You use IP address filters when you receive
+// email with Amazon SES. For more information, see the Amazon SES Developer
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-concepts.html).
 type ListReceiptFiltersInput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListReceiptFiltersInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListReceiptFiltersInput) GoString() string {
 	return s.String()
 }
 
-// A list of IP address filters that exist under your AWS account.
+// A list of IP address filters that exist under your Amazon Web Services account.
 type ListReceiptFiltersOutput struct {
 	_ struct{} `type:"structure"`
 
@@ -10680,12 +11464,20 @@ type ListReceiptFiltersOutput struct {
 	Filters []*ReceiptFilter `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListReceiptFiltersOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListReceiptFiltersOutput) GoString() string {
 	return s.String()
 }
@@ -10697,8 +11489,8 @@ func (s *ListReceiptFiltersOutput) SetFilters(v []*ReceiptFilter) *ListReceiptFi
 }
 
 // Represents a request to list the receipt rule sets that exist under your
-// AWS account. You use receipt rule sets to receive email with Amazon SES.
-// For more information, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-concepts.html).
+// Amazon Web Services account. You use receipt rule sets to receive email with
+// Amazon SES. For more information, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-concepts.html).
 type ListReceiptRuleSetsInput struct {
 	_ struct{} `type:"structure"`
 
@@ -10707,12 +11499,20 @@ type ListReceiptRuleSetsInput struct {
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListReceiptRuleSetsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListReceiptRuleSetsInput) GoString() string {
 	return s.String()
 }
@@ -10723,7 +11523,7 @@ func (s *ListReceiptRuleSetsInput) SetNextToken(v string) *ListReceiptRuleSetsIn
 	return s
 }
 
-// A list of receipt rule sets that exist under your AWS account.
+// A list of receipt rule sets that exist under your Amazon Web Services account.
 type ListReceiptRuleSetsOutput struct {
 	_ struct{} `type:"structure"`
 
@@ -10737,12 +11537,20 @@ type ListReceiptRuleSetsOutput struct {
 	RuleSets []*ReceiptRuleSetMetadata `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListReceiptRuleSetsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListReceiptRuleSetsOutput) GoString() string {
 	return s.String()
 }
@@ -10763,9 +11571,9 @@ type ListTemplatesInput struct {
 	_ struct{} `type:"structure"`
 
 	// The maximum number of templates to return. This value must be at least 1
-	// and less than or equal to 10. If you do not specify a value, or if you specify
-	// a value less than 1 or greater than 10, the operation will return up to 10
-	// results.
+	// and less than or equal to 100. If more than 100 items are requested, the
+	// page size will automatically set to 100. If you do not specify a value, 10
+	// is the default page size.
 	MaxItems *int64 `type:"integer"`
 
 	// A token returned from a previous call to ListTemplates to indicate the position
@@ -10773,12 +11581,20 @@ type ListTemplatesInput struct {
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTemplatesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTemplatesInput) GoString() string {
 	return s.String()
 }
@@ -10800,7 +11616,7 @@ type ListTemplatesOutput struct {
 
 	// A token indicating that there are additional email templates available to
 	// be listed. Pass this token to a subsequent call to ListTemplates to retrieve
-	// the next 50 email templates.
+	// the next set of email templates within your page size.
 	NextToken *string `type:"string"`
 
 	// An array the contains the name and creation time stamp for each template
@@ -10808,12 +11624,20 @@ type ListTemplatesOutput struct {
 	TemplatesMetadata []*TemplateMetadata `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTemplatesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTemplatesOutput) GoString() string {
 	return s.String()
 }
@@ -10834,18 +11658,26 @@ type ListVerifiedEmailAddressesInput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListVerifiedEmailAddressesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListVerifiedEmailAddressesInput) GoString() string {
 	return s.String()
 }
 
 // A list of email addresses that you have verified with Amazon SES under your
-// AWS account.
+// Amazon Web Services account.
 type ListVerifiedEmailAddressesOutput struct {
 	_ struct{} `type:"structure"`
 
@@ -10853,12 +11685,20 @@ type ListVerifiedEmailAddressesOutput struct {
 	VerifiedEmailAddresses []*string `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListVerifiedEmailAddressesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListVerifiedEmailAddressesOutput) GoString() string {
 	return s.String()
 }
@@ -10878,19 +11718,27 @@ type Message struct {
 	// Body is a required field
 	Body *Body `type:"structure" required:"true"`
 
-	// The subject of the message: A short summary of the content, which will appear
+	// The subject of the message: A short summary of the content, which appears
 	// in the recipient's inbox.
 	//
 	// Subject is a required field
 	Subject *Content `type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Message) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Message) GoString() string {
 	return s.String()
 }
@@ -10937,7 +11785,7 @@ func (s *Message) SetSubject(v *Content) *Message {
 // (DSN) when an email that Amazon SES receives on your behalf bounces.
 //
 // For information about receiving email through Amazon SES, see the Amazon
-// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email.html).
+// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email.html).
 type MessageDsn struct {
 	_ struct{} `type:"structure"`
 
@@ -10956,12 +11804,20 @@ type MessageDsn struct {
 	ReportingMta *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s MessageDsn) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s MessageDsn) GoString() string {
 	return s.String()
 }
@@ -11012,37 +11868,45 @@ func (s *MessageDsn) SetReportingMta(v string) *MessageDsn {
 //
 // Message tags, which you use with configuration sets, enable you to publish
 // email sending events. For information about using configuration sets, see
-// the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/monitor-sending-activity.html).
+// the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity.html).
 type MessageTag struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the tag. The name must:
+	// The name of the tag. The name must meet the following requirements:
 	//
-	//    * This value can only contain ASCII letters (a-z, A-Z), numbers (0-9),
-	//    underscores (_), or dashes (-).
+	//    * Contain only ASCII letters (a-z, A-Z), numbers (0-9), underscores (_),
+	//    or dashes (-).
 	//
-	//    * Contain less than 256 characters.
+	//    * Contain 256 characters or fewer.
 	//
 	// Name is a required field
 	Name *string `type:"string" required:"true"`
 
-	// The value of the tag. The value must:
+	// The value of the tag. The value must meet the following requirements:
 	//
-	//    * This value can only contain ASCII letters (a-z, A-Z), numbers (0-9),
-	//    underscores (_), or dashes (-).
+	//    * Contain only ASCII letters (a-z, A-Z), numbers (0-9), underscores (_),
+	//    or dashes (-).
 	//
-	//    * Contain less than 256 characters.
+	//    * Contain 256 characters or fewer.
 	//
 	// Value is a required field
 	Value *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s MessageTag) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s MessageTag) GoString() string {
 	return s.String()
 }
@@ -11079,8 +11943,7 @@ func (s *MessageTag) SetValue(v string) *MessageTag {
 type PutConfigurationSetDeliveryOptionsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the configuration set that you want to specify the delivery options
-	// for.
+	// The name of the configuration set.
 	//
 	// ConfigurationSetName is a required field
 	ConfigurationSetName *string `type:"string" required:"true"`
@@ -11090,12 +11953,20 @@ type PutConfigurationSetDeliveryOptionsInput struct {
 	DeliveryOptions *DeliveryOptions `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutConfigurationSetDeliveryOptionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutConfigurationSetDeliveryOptionsInput) GoString() string {
 	return s.String()
 }
@@ -11131,12 +12002,20 @@ type PutConfigurationSetDeliveryOptionsOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutConfigurationSetDeliveryOptionsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutConfigurationSetDeliveryOptionsOutput) GoString() string {
 	return s.String()
 }
@@ -11144,15 +12023,15 @@ func (s PutConfigurationSetDeliveryOptionsOutput) GoString() string {
 // Represents a request to add or update a sending authorization policy for
 // an identity. Sending authorization is an Amazon SES feature that enables
 // you to authorize other senders to use your identities. For information, see
-// the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization.html).
+// the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization.html).
 type PutIdentityPolicyInput struct {
 	_ struct{} `type:"structure"`
 
-	// The identity that the policy will apply to. You can specify an identity by
-	// using its name or by using its Amazon Resource Name (ARN). Examples: user@example.com,
+	// The identity to which that the policy applies. You can specify an identity
+	// by using its name or by using its Amazon Resource Name (ARN). Examples: user@example.com,
 	// example.com, arn:aws:ses:us-east-1:123456789012:identity/example.com.
 	//
-	// To successfully call this API, you must own the identity.
+	// To successfully call this operation, you must own the identity.
 	//
 	// Identity is a required field
 	Identity *string `type:"string" required:"true"`
@@ -11160,7 +12039,7 @@ type PutIdentityPolicyInput struct {
 	// The text of the policy in JSON format. The policy cannot exceed 4 KB.
 	//
 	// For information about the syntax of sending authorization policies, see the
-	// Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization-policies.html).
+	// Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization-policies.html).
 	//
 	// Policy is a required field
 	Policy *string `min:"1" type:"string" required:"true"`
@@ -11174,12 +12053,20 @@ type PutIdentityPolicyInput struct {
 	PolicyName *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutIdentityPolicyInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutIdentityPolicyInput) GoString() string {
 	return s.String()
 }
@@ -11232,12 +12119,20 @@ type PutIdentityPolicyOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutIdentityPolicyOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutIdentityPolicyOutput) GoString() string {
 	return s.String()
 }
@@ -11248,10 +12143,10 @@ type RawMessage struct {
 
 	// The raw data of the message. This data needs to base64-encoded if you are
 	// accessing Amazon SES directly through the HTTPS interface. If you are accessing
-	// Amazon SES using an AWS SDK, the SDK takes care of the base 64-encoding for
-	// you. In all cases, the client must ensure that the message format complies
-	// with Internet email standards regarding email header fields, MIME types,
-	// and MIME encoding.
+	// Amazon SES using an Amazon Web Services SDK, the SDK takes care of the base
+	// 64-encoding for you. In all cases, the client must ensure that the message
+	// format complies with Internet email standards regarding email header fields,
+	// MIME types, and MIME encoding.
 	//
 	// The To:, CC:, and BCC: headers in the raw message can contain a group list.
 	//
@@ -11262,20 +12157,27 @@ type RawMessage struct {
 	// Do not include these X-headers in the DKIM signature, because they are removed
 	// by Amazon SES before sending the email.
 	//
-	// For more information, go to the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/send-email-raw.html).
-	//
+	// For more information, go to the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/send-email-raw.html).
 	// Data is automatically base64 encoded/decoded by the SDK.
 	//
 	// Data is a required field
 	Data []byte `type:"blob" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RawMessage) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RawMessage) GoString() string {
 	return s.String()
 }
@@ -11304,7 +12206,7 @@ func (s *RawMessage) SetData(v []byte) *RawMessage {
 // data type can represent only one action.
 //
 // For information about setting up receipt rules, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-receipt-rules.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-receipt-rules-console-walkthrough.html).
 type ReceiptAction struct {
 	_ struct{} `type:"structure"`
 
@@ -11316,8 +12218,8 @@ type ReceiptAction struct {
 	// (Amazon SNS).
 	BounceAction *BounceAction `type:"structure"`
 
-	// Calls an AWS Lambda function, and optionally, publishes a notification to
-	// Amazon SNS.
+	// Calls an Amazon Web Services Lambda function, and optionally, publishes a
+	// notification to Amazon SNS.
 	LambdaAction *LambdaAction `type:"structure"`
 
 	// Saves the received message to an Amazon Simple Storage Service (Amazon S3)
@@ -11336,12 +12238,20 @@ type ReceiptAction struct {
 	WorkmailAction *WorkmailAction `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReceiptAction) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReceiptAction) GoString() string {
 	return s.String()
 }
@@ -11437,7 +12347,7 @@ func (s *ReceiptAction) SetWorkmailAction(v *WorkmailAction) *ReceiptAction {
 // mail originating from an IP address or range of IP addresses.
 //
 // For information about setting up IP address filters, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-ip-filters.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-ip-filtering-console-walkthrough.html).
 type ReceiptFilter struct {
 	_ struct{} `type:"structure"`
 
@@ -11447,25 +12357,33 @@ type ReceiptFilter struct {
 	// IpFilter is a required field
 	IpFilter *ReceiptIpFilter `type:"structure" required:"true"`
 
-	// The name of the IP address filter. The name must:
+	// The name of the IP address filter. The name must meet the following requirements:
 	//
-	//    * This value can only contain ASCII letters (a-z, A-Z), numbers (0-9),
-	//    underscores (_), or dashes (-).
+	//    * Contain only ASCII letters (a-z, A-Z), numbers (0-9), underscores (_),
+	//    or dashes (-).
 	//
 	//    * Start and end with a letter or number.
 	//
-	//    * Contain less than 64 characters.
+	//    * Contain 64 characters or fewer.
 	//
 	// Name is a required field
 	Name *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReceiptFilter) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReceiptFilter) GoString() string {
 	return s.String()
 }
@@ -11507,14 +12425,14 @@ func (s *ReceiptFilter) SetName(v string) *ReceiptFilter {
 // mail originating from an IP address or range of IP addresses.
 //
 // For information about setting up IP address filters, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-ip-filters.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-ip-filtering-console-walkthrough.html).
 type ReceiptIpFilter struct {
 	_ struct{} `type:"structure"`
 
-	// A single IP address or a range of IP addresses that you want to block or
-	// allow, specified in Classless Inter-Domain Routing (CIDR) notation. An example
-	// of a single email address is 10.0.0.1. An example of a range of IP addresses
-	// is 10.0.0.1/24. For more information about CIDR notation, see RFC 2317 (https://tools.ietf.org/html/rfc2317).
+	// A single IP address or a range of IP addresses to block or allow, specified
+	// in Classless Inter-Domain Routing (CIDR) notation. An example of a single
+	// email address is 10.0.0.1. An example of a range of IP addresses is 10.0.0.1/24.
+	// For more information about CIDR notation, see RFC 2317 (https://tools.ietf.org/html/rfc2317).
 	//
 	// Cidr is a required field
 	Cidr *string `type:"string" required:"true"`
@@ -11525,12 +12443,20 @@ type ReceiptIpFilter struct {
 	Policy *string `type:"string" required:"true" enum:"ReceiptFilterPolicy"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReceiptIpFilter) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReceiptIpFilter) GoString() string {
 	return s.String()
 }
@@ -11573,7 +12499,7 @@ func (s *ReceiptIpFilter) SetPolicy(v string) *ReceiptIpFilter {
 // the message.
 //
 // For information about setting up receipt rules, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-receipt-rules.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-receipt-rules-console-walkthrough.html).
 type ReceiptRule struct {
 	_ struct{} `type:"structure"`
 
@@ -11584,21 +12510,21 @@ type ReceiptRule struct {
 	// If true, the receipt rule is active. The default value is false.
 	Enabled *bool `type:"boolean"`
 
-	// The name of the receipt rule. The name must:
+	// The name of the receipt rule. The name must meet the following requirements:
 	//
-	//    * This value can only contain ASCII letters (a-z, A-Z), numbers (0-9),
-	//    underscores (_), or dashes (-).
+	//    * Contain only ASCII letters (a-z, A-Z), numbers (0-9), underscores (_),
+	//    dashes (-), or periods (.).
 	//
 	//    * Start and end with a letter or number.
 	//
-	//    * Contain less than 64 characters.
+	//    * Contain 64 characters or fewer.
 	//
 	// Name is a required field
 	Name *string `type:"string" required:"true"`
 
 	// The recipient domains and email addresses that the receipt rule applies to.
-	// If this field is not specified, this rule will match all recipients under
-	// all verified domains.
+	// If this field is not specified, this rule matches all recipients on all verified
+	// domains.
 	Recipients []*string `type:"list"`
 
 	// If true, then messages that this receipt rule applies to are scanned for
@@ -11607,17 +12533,25 @@ type ReceiptRule struct {
 
 	// Specifies whether Amazon SES should require that incoming email is delivered
 	// over a connection encrypted with Transport Layer Security (TLS). If this
-	// parameter is set to Require, Amazon SES will bounce emails that are not received
+	// parameter is set to Require, Amazon SES bounces emails that are not received
 	// over TLS. The default is Optional.
 	TlsPolicy *string `type:"string" enum:"TlsPolicy"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReceiptRule) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReceiptRule) GoString() string {
 	return s.String()
 }
@@ -11687,30 +12621,38 @@ func (s *ReceiptRule) SetTlsPolicy(v string) *ReceiptRule {
 // should do with mail it receives on behalf of your account's verified domains.
 //
 // For information about setting up receipt rule sets, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-receipt-rule-set.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-concepts.html#receiving-email-concepts-rules).
 type ReceiptRuleSetMetadata struct {
 	_ struct{} `type:"structure"`
 
 	// The date and time the receipt rule set was created.
 	CreatedTimestamp *time.Time `type:"timestamp"`
 
-	// The name of the receipt rule set. The name must:
+	// The name of the receipt rule set. The name must meet the following requirements:
 	//
-	//    * This value can only contain ASCII letters (a-z, A-Z), numbers (0-9),
-	//    underscores (_), or dashes (-).
+	//    * Contain only ASCII letters (a-z, A-Z), numbers (0-9), underscores (_),
+	//    or dashes (-).
 	//
 	//    * Start and end with a letter or number.
 	//
-	//    * Contain less than 64 characters.
+	//    * Contain 64 characters or fewer.
 	Name *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReceiptRuleSetMetadata) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReceiptRuleSetMetadata) GoString() string {
 	return s.String()
 }
@@ -11731,7 +12673,7 @@ func (s *ReceiptRuleSetMetadata) SetName(v string) *ReceiptRuleSetMetadata {
 // (DSN) when an email that Amazon SES receives on your behalf bounces.
 //
 // For information about receiving email through Amazon SES, see the Amazon
-// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email.html).
+// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email.html).
 type RecipientDsnFields struct {
 	_ struct{} `type:"structure"`
 
@@ -11751,10 +12693,10 @@ type RecipientDsnFields struct {
 	ExtensionFields []*ExtensionField `type:"list"`
 
 	// The email address that the message was ultimately delivered to. This corresponds
-	// to the Final-Recipient in the DSN. If not specified, FinalRecipient will
-	// be set to the Recipient specified in the BouncedRecipientInfo structure.
-	// Either FinalRecipient or the recipient in BouncedRecipientInfo must be a
-	// recipient of the original bounced message.
+	// to the Final-Recipient in the DSN. If not specified, FinalRecipient is set
+	// to the Recipient specified in the BouncedRecipientInfo structure. Either
+	// FinalRecipient or the recipient in BouncedRecipientInfo must be a recipient
+	// of the original bounced message.
 	//
 	// Do not prepend the FinalRecipient email address with rfc 822;, as described
 	// in RFC 3798 (https://tools.ietf.org/html/rfc3798).
@@ -11777,12 +12719,20 @@ type RecipientDsnFields struct {
 	Status *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RecipientDsnFields) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RecipientDsnFields) GoString() string {
 	return s.String()
 }
@@ -11857,12 +12807,11 @@ func (s *RecipientDsnFields) SetStatus(v string) *RecipientDsnFields {
 
 // Represents a request to reorder the receipt rules within a receipt rule set.
 // You use receipt rule sets to receive email with Amazon SES. For more information,
-// see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-concepts.html).
+// see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-concepts.html).
 type ReorderReceiptRuleSetInput struct {
 	_ struct{} `type:"structure"`
 
-	// A list of the specified receipt rule set's receipt rules in the order that
-	// you want to put them.
+	// The specified receipt rule set's receipt rules, in order.
 	//
 	// RuleNames is a required field
 	RuleNames []*string `type:"list" required:"true"`
@@ -11873,12 +12822,20 @@ type ReorderReceiptRuleSetInput struct {
 	RuleSetName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReorderReceiptRuleSetInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReorderReceiptRuleSetInput) GoString() string {
 	return s.String()
 }
@@ -11916,12 +12873,20 @@ type ReorderReceiptRuleSetOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReorderReceiptRuleSetOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReorderReceiptRuleSetOutput) GoString() string {
 	return s.String()
 }
@@ -11949,19 +12914,27 @@ type ReputationOptions struct {
 	ReputationMetricsEnabled *bool `type:"boolean"`
 
 	// Describes whether email sending is enabled or disabled for the configuration
-	// set. If the value is true, then Amazon SES will send emails that use the
-	// configuration set. If the value is false, Amazon SES will not send emails
-	// that use the configuration set. The default value is true. You can change
-	// this setting using UpdateConfigurationSetSendingEnabled.
+	// set. If the value is true, then Amazon SES sends emails that use the configuration
+	// set. If the value is false, Amazon SES does not send emails that use the
+	// configuration set. The default value is true. You can change this setting
+	// using UpdateConfigurationSetSendingEnabled.
 	SendingEnabled *bool `type:"boolean"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReputationOptions) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReputationOptions) GoString() string {
 	return s.String()
 }
@@ -11988,53 +12961,57 @@ func (s *ReputationOptions) SetSendingEnabled(v bool) *ReputationOptions {
 // an Amazon Simple Storage Service (Amazon S3) bucket and, optionally, publishes
 // a notification to Amazon Simple Notification Service (Amazon SNS).
 //
-// To enable Amazon SES to write emails to your Amazon S3 bucket, use an AWS
-// KMS key to encrypt your emails, or publish to an Amazon SNS topic of another
-// account, Amazon SES must have permission to access those resources. For information
-// about giving permissions, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-permissions.html).
+// To enable Amazon SES to write emails to your Amazon S3 bucket, use an Amazon
+// Web Services KMS key to encrypt your emails, or publish to an Amazon SNS
+// topic of another account, Amazon SES must have permission to access those
+// resources. For information about granting permissions, see the Amazon SES
+// Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-permissions.html).
 //
 // When you save your emails to an Amazon S3 bucket, the maximum email size
-// (including headers) is 30 MB. Emails larger than that will bounce.
+// (including headers) is 40 MB. Emails larger than that bounces.
 //
 // For information about specifying Amazon S3 actions in receipt rules, see
-// the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-action-s3.html).
+// the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-action-s3.html).
 type S3Action struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the Amazon S3 bucket that incoming email will be saved to.
+	// The name of the Amazon S3 bucket for incoming email.
 	//
 	// BucketName is a required field
 	BucketName *string `type:"string" required:"true"`
 
 	// The customer master key that Amazon SES should use to encrypt your emails
 	// before saving them to the Amazon S3 bucket. You can use the default master
-	// key or a custom master key you created in AWS KMS as follows:
+	// key or a custom master key that you created in Amazon Web Services KMS as
+	// follows:
 	//
 	//    * To use the default master key, provide an ARN in the form of arn:aws:kms:REGION:ACCOUNT-ID-WITHOUT-HYPHENS:alias/aws/ses.
-	//    For example, if your AWS account ID is 123456789012 and you want to use
-	//    the default master key in the US West (Oregon) region, the ARN of the
-	//    default master key would be arn:aws:kms:us-west-2:123456789012:alias/aws/ses.
+	//    For example, if your Amazon Web Services account ID is 123456789012 and
+	//    you want to use the default master key in the US West (Oregon) Region,
+	//    the ARN of the default master key would be arn:aws:kms:us-west-2:123456789012:alias/aws/ses.
 	//    If you use the default master key, you don't need to perform any extra
 	//    steps to give Amazon SES permission to use the key.
 	//
-	//    * To use a custom master key you created in AWS KMS, provide the ARN of
-	//    the master key and ensure that you add a statement to your key's policy
-	//    to give Amazon SES permission to use it. For more information about giving
-	//    permissions, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-permissions.html).
+	//    * To use a custom master key that you created in Amazon Web Services KMS,
+	//    provide the ARN of the master key and ensure that you add a statement
+	//    to your key's policy to give Amazon SES permission to use it. For more
+	//    information about giving permissions, see the Amazon SES Developer Guide
+	//    (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-permissions.html).
 	//
-	// For more information about key policies, see the AWS KMS Developer Guide
-	// (https://docs.aws.amazon.com/kms/latest/developerguide/concepts.html). If
-	// you do not specify a master key, Amazon SES will not encrypt your emails.
+	// For more information about key policies, see the Amazon Web Services KMS
+	// Developer Guide (https://docs.aws.amazon.com/kms/latest/developerguide/concepts.html).
+	// If you do not specify a master key, Amazon SES does not encrypt your emails.
 	//
 	// Your mail is encrypted by Amazon SES using the Amazon S3 encryption client
 	// before the mail is submitted to Amazon S3 for storage. It is not encrypted
 	// using Amazon S3 server-side encryption. This means that you must use the
 	// Amazon S3 encryption client to decrypt the email after retrieving it from
-	// Amazon S3, as the service has no access to use your AWS KMS keys for decryption.
-	// This encryption client is currently available with the AWS SDK for Java (http://aws.amazon.com/sdk-for-java/)
-	// and AWS SDK for Ruby (http://aws.amazon.com/sdk-for-ruby/) only. For more
-	// information about client-side encryption using AWS KMS master keys, see the
-	// Amazon S3 Developer Guide (https://docs.aws.amazon.com/AmazonS3/latest/dev/UsingClientSideEncryption.html).
+	// Amazon S3, as the service has no access to use your Amazon Web Services KMS
+	// keys for decryption. This encryption client is currently available with the
+	// Amazon Web Services SDK for Java (http://aws.amazon.com/sdk-for-java/) and
+	// Amazon Web Services SDK for Ruby (http://aws.amazon.com/sdk-for-ruby/) only.
+	// For more information about client-side encryption using Amazon Web Services
+	// KMS master keys, see the Amazon S3 Developer Guide (https://docs.aws.amazon.com/AmazonS3/latest/dev/UsingClientSideEncryption.html).
 	KmsKeyArn *string `type:"string"`
 
 	// The key prefix of the Amazon S3 bucket. The key prefix is similar to a directory
@@ -12043,18 +13020,29 @@ type S3Action struct {
 	ObjectKeyPrefix *string `type:"string"`
 
 	// The ARN of the Amazon SNS topic to notify when the message is saved to the
-	// Amazon S3 bucket. An example of an Amazon SNS topic ARN is arn:aws:sns:us-west-2:123456789012:MyTopic.
+	// Amazon S3 bucket. You can find the ARN of a topic by using the ListTopics
+	// (https://docs.aws.amazon.com/sns/latest/api/API_ListTopics.html) operation
+	// in Amazon SNS.
+	//
 	// For more information about Amazon SNS topics, see the Amazon SNS Developer
 	// Guide (https://docs.aws.amazon.com/sns/latest/dg/CreateTopic.html).
 	TopicArn *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s S3Action) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s S3Action) GoString() string {
 	return s.String()
 }
@@ -12106,14 +13094,14 @@ func (s *S3Action) SetTopicArn(v string) *S3Action {
 // SES permission to publish emails to it. However, if you don't own the Amazon
 // SNS topic, you need to attach a policy to the topic to give Amazon SES permissions
 // to access it. For information about giving permissions, see the Amazon SES
-// Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-permissions.html).
+// Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-permissions.html).
 //
 // You can only publish emails that are 150 KB or less (including the header)
-// to Amazon SNS. Larger emails will bounce. If you anticipate emails larger
-// than 150 KB, use the S3 action instead.
+// to Amazon SNS. Larger emails bounce. If you anticipate emails larger than
+// 150 KB, use the S3 action instead.
 //
 // For information about using a receipt rule to publish an Amazon SNS notification,
-// see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-action-sns.html).
+// see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-action-sns.html).
 type SNSAction struct {
 	_ struct{} `type:"structure"`
 
@@ -12123,8 +13111,10 @@ type SNSAction struct {
 	// characters. The default value is UTF-8.
 	Encoding *string `type:"string" enum:"SNSActionEncoding"`
 
-	// The Amazon Resource Name (ARN) of the Amazon SNS topic to notify. An example
-	// of an Amazon SNS topic ARN is arn:aws:sns:us-west-2:123456789012:MyTopic.
+	// The Amazon Resource Name (ARN) of the Amazon SNS topic to notify. You can
+	// find the ARN of a topic by using the ListTopics (https://docs.aws.amazon.com/sns/latest/api/API_ListTopics.html)
+	// operation in Amazon SNS.
+	//
 	// For more information about Amazon SNS topics, see the Amazon SNS Developer
 	// Guide (https://docs.aws.amazon.com/sns/latest/dg/CreateTopic.html).
 	//
@@ -12132,12 +13122,20 @@ type SNSAction struct {
 	TopicArn *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SNSAction) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SNSAction) GoString() string {
 	return s.String()
 }
@@ -12172,12 +13170,14 @@ func (s *SNSAction) SetTopicArn(v string) *SNSAction {
 //
 // Event destinations, such as Amazon SNS, are associated with configuration
 // sets, which enable you to publish email sending events. For information about
-// using configuration sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/monitor-sending-activity.html).
+// using configuration sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity.html).
 type SNSDestination struct {
 	_ struct{} `type:"structure"`
 
-	// The ARN of the Amazon SNS topic that email sending events will be published
-	// to. An example of an Amazon SNS topic ARN is arn:aws:sns:us-west-2:123456789012:MyTopic.
+	// The ARN of the Amazon SNS topic for email sending events. You can find the
+	// ARN of a topic by using the ListTopics (https://docs.aws.amazon.com/sns/latest/api/API_ListTopics.html)
+	// Amazon SNS operation.
+	//
 	// For more information about Amazon SNS topics, see the Amazon SNS Developer
 	// Guide (https://docs.aws.amazon.com/sns/latest/dg/CreateTopic.html).
 	//
@@ -12185,12 +13185,20 @@ type SNSDestination struct {
 	TopicARN *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SNSDestination) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SNSDestination) GoString() string {
 	return s.String()
 }
@@ -12228,7 +13236,7 @@ type SendBounceInput struct {
 	// This parameter is used only for sending authorization. It is the ARN of the
 	// identity that is associated with the sending authorization policy that permits
 	// you to use the address in the "From" header of the bounce. For more information
-	// about sending authorization, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization.html).
+	// about sending authorization, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization.html).
 	BounceSenderArn *string `type:"string"`
 
 	// A list of recipients of the bounced message, including the information required
@@ -12239,12 +13247,10 @@ type SendBounceInput struct {
 	BouncedRecipientInfoList []*BouncedRecipientInfo `type:"list" required:"true"`
 
 	// Human-readable text for the bounce message to explain the failure. If not
-	// specified, the text will be auto-generated based on the bounced recipient
-	// information.
+	// specified, the text is auto-generated based on the bounced recipient information.
 	Explanation *string `type:"string"`
 
-	// Message-related DSN fields. If not specified, Amazon SES will choose the
-	// values.
+	// Message-related DSN fields. If not specified, Amazon SES chooses the values.
 	MessageDsn *MessageDsn `type:"structure"`
 
 	// The message ID of the message to be bounced.
@@ -12253,12 +13259,20 @@ type SendBounceInput struct {
 	OriginalMessageId *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendBounceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendBounceInput) GoString() string {
 	return s.String()
 }
@@ -12341,12 +13355,20 @@ type SendBounceOutput struct {
 	MessageId *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendBounceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendBounceOutput) GoString() string {
 	return s.String()
 }
@@ -12358,7 +13380,7 @@ func (s *SendBounceOutput) SetMessageId(v string) *SendBounceOutput {
 }
 
 // Represents a request to send a templated email to multiple destinations using
-// Amazon SES. For more information, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/send-personalized-email-api.html).
+// Amazon SES. For more information, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/send-personalized-email-api.html).
 type SendBulkTemplatedEmailInput struct {
 	_ struct{} `type:"structure"`
 
@@ -12377,21 +13399,21 @@ type SendBulkTemplatedEmailInput struct {
 	// in which the keys correspond to replacement tags in the email template.
 	DefaultTemplateData *string `type:"string"`
 
-	// One or more Destination objects. All of the recipients in a Destination will
-	// receive the same version of the email. You can specify up to 50 Destination
-	// objects within a Destinations array.
+	// One or more Destination objects. All of the recipients in a Destination receive
+	// the same version of the email. You can specify up to 50 Destination objects
+	// within a Destinations array.
 	//
 	// Destinations is a required field
 	Destinations []*BulkEmailDestination `type:"list" required:"true"`
 
 	// The reply-to email address(es) for the message. If the recipient replies
-	// to the message, each reply-to address will receive the reply.
+	// to the message, each reply-to address receives the reply.
 	ReplyToAddresses []*string `type:"list"`
 
-	// The email address that bounces and complaints will be forwarded to when feedback
+	// The email address that bounces and complaints are forwarded to when feedback
 	// forwarding is enabled. If the message cannot be delivered to the recipient,
-	// then an error message will be returned from the recipient's ISP; this message
-	// will then be forwarded to the email address specified by the ReturnPath parameter.
+	// then an error message is returned from the recipient's ISP; this message
+	// is forwarded to the email address specified by the ReturnPath parameter.
 	// The ReturnPath parameter is never overwritten. This email address must be
 	// either individually verified with Amazon SES, or from a domain that has been
 	// verified with Amazon SES.
@@ -12407,29 +13429,29 @@ type SendBulkTemplatedEmailInput struct {
 	// and the ReturnPath to be feedback@example.com.
 	//
 	// For more information about sending authorization, see the Amazon SES Developer
-	// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization.html).
+	// Guide (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization.html).
 	ReturnPathArn *string `type:"string"`
 
 	// The email address that is sending the email. This email address must be either
 	// individually verified with Amazon SES, or from a domain that has been verified
 	// with Amazon SES. For information about verifying identities, see the Amazon
-	// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/verify-addresses-and-domains.html).
+	// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/creating-identities.html).
 	//
 	// If you are sending on behalf of another user and have been permitted to do
 	// so by a sending authorization policy, then you must also specify the SourceArn
 	// parameter. For more information about sending authorization, see the Amazon
-	// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization.html).
+	// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization.html).
 	//
 	// Amazon SES does not support the SMTPUTF8 extension, as described in RFC6531
-	// (https://tools.ietf.org/html/rfc6531). For this reason, the local part of
-	// a source email address (the part of the email address that precedes the @
-	// sign) may only contain 7-bit ASCII characters (https://en.wikipedia.org/wiki/Email_address#Local-part).
-	// If the domain part of an address (the part after the @ sign) contains non-ASCII
-	// characters, they must be encoded using Punycode, as described in RFC3492
-	// (https://tools.ietf.org/html/rfc3492.html). The sender name (also known as
-	// the friendly name) may contain non-ASCII characters. These characters must
-	// be encoded using MIME encoded-word syntax, as described in RFC 2047 (https://tools.ietf.org/html/rfc2047).
-	// MIME encoded-word syntax uses the following form: =?charset?encoding?encoded-text?=.
+	// (https://tools.ietf.org/html/rfc6531). For this reason, the email address
+	// string must be 7-bit ASCII. If you want to send to or from email addresses
+	// that contain Unicode characters in the domain part of an address, you must
+	// encode the domain using Punycode. Punycode is not permitted in the local
+	// part of the email address (the part before the @ sign) nor in the "friendly
+	// from" name. If you want to use Unicode characters in the "friendly from"
+	// name, you must encode the "friendly from" name using MIME encoded-word syntax,
+	// as described in Sending raw email using the Amazon SES API (https://docs.aws.amazon.com/ses/latest/dg/send-email-raw.html).
+	// For more information about Punycode, see RFC 3492 (http://tools.ietf.org/html/rfc3492).
 	//
 	// Source is a required field
 	Source *string `type:"string" required:"true"`
@@ -12444,7 +13466,7 @@ type SendBulkTemplatedEmailInput struct {
 	// and the Source to be user@example.com.
 	//
 	// For more information about sending authorization, see the Amazon SES Developer
-	// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization.html).
+	// Guide (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization.html).
 	SourceArn *string `type:"string"`
 
 	// The template to use when sending this email.
@@ -12456,12 +13478,20 @@ type SendBulkTemplatedEmailInput struct {
 	TemplateArn *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendBulkTemplatedEmailInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendBulkTemplatedEmailInput) GoString() string {
 	return s.String()
 }
@@ -12574,18 +13604,29 @@ func (s *SendBulkTemplatedEmailInput) SetTemplateArn(v string) *SendBulkTemplate
 type SendBulkTemplatedEmailOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The unique message identifier returned from the SendBulkTemplatedEmail action.
+	// One object per intended recipient. Check each response object and retry any
+	// messages with a failure status. (Note that order of responses will be respective
+	// to order of destinations in the request.)Receipt rules enable you to specify
+	// which actions
 	//
 	// Status is a required field
 	Status []*BulkEmailDestinationStatus `type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendBulkTemplatedEmailOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendBulkTemplatedEmailOutput) GoString() string {
 	return s.String()
 }
@@ -12615,12 +13656,20 @@ type SendCustomVerificationEmailInput struct {
 	TemplateName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendCustomVerificationEmailInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendCustomVerificationEmailInput) GoString() string {
 	return s.String()
 }
@@ -12668,12 +13717,20 @@ type SendCustomVerificationEmailOutput struct {
 	MessageId *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendCustomVerificationEmailOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendCustomVerificationEmailOutput) GoString() string {
 	return s.String()
 }
@@ -12705,12 +13762,20 @@ type SendDataPoint struct {
 	Timestamp *time.Time `type:"timestamp"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendDataPoint) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendDataPoint) GoString() string {
 	return s.String()
 }
@@ -12746,7 +13811,7 @@ func (s *SendDataPoint) SetTimestamp(v time.Time) *SendDataPoint {
 }
 
 // Represents a request to send a single formatted email using Amazon SES. For
-// more information, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/send-email-formatted.html).
+// more information, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/send-email-formatted.html).
 type SendEmailInput struct {
 	_ struct{} `type:"structure"`
 
@@ -12764,13 +13829,13 @@ type SendEmailInput struct {
 	Message *Message `type:"structure" required:"true"`
 
 	// The reply-to email address(es) for the message. If the recipient replies
-	// to the message, each reply-to address will receive the reply.
+	// to the message, each reply-to address receives the reply.
 	ReplyToAddresses []*string `type:"list"`
 
-	// The email address that bounces and complaints will be forwarded to when feedback
+	// The email address that bounces and complaints are forwarded to when feedback
 	// forwarding is enabled. If the message cannot be delivered to the recipient,
-	// then an error message will be returned from the recipient's ISP; this message
-	// will then be forwarded to the email address specified by the ReturnPath parameter.
+	// then an error message is returned from the recipient's ISP; this message
+	// is forwarded to the email address specified by the ReturnPath parameter.
 	// The ReturnPath parameter is never overwritten. This email address must be
 	// either individually verified with Amazon SES, or from a domain that has been
 	// verified with Amazon SES.
@@ -12786,29 +13851,29 @@ type SendEmailInput struct {
 	// and the ReturnPath to be feedback@example.com.
 	//
 	// For more information about sending authorization, see the Amazon SES Developer
-	// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization.html).
+	// Guide (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization.html).
 	ReturnPathArn *string `type:"string"`
 
 	// The email address that is sending the email. This email address must be either
 	// individually verified with Amazon SES, or from a domain that has been verified
 	// with Amazon SES. For information about verifying identities, see the Amazon
-	// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/verify-addresses-and-domains.html).
+	// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/creating-identities.html).
 	//
 	// If you are sending on behalf of another user and have been permitted to do
 	// so by a sending authorization policy, then you must also specify the SourceArn
 	// parameter. For more information about sending authorization, see the Amazon
-	// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization.html).
+	// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization.html).
 	//
 	// Amazon SES does not support the SMTPUTF8 extension, as described in RFC6531
-	// (https://tools.ietf.org/html/rfc6531). For this reason, the local part of
-	// a source email address (the part of the email address that precedes the @
-	// sign) may only contain 7-bit ASCII characters (https://en.wikipedia.org/wiki/Email_address#Local-part).
-	// If the domain part of an address (the part after the @ sign) contains non-ASCII
-	// characters, they must be encoded using Punycode, as described in RFC3492
-	// (https://tools.ietf.org/html/rfc3492.html). The sender name (also known as
-	// the friendly name) may contain non-ASCII characters. These characters must
-	// be encoded using MIME encoded-word syntax, as described in RFC 2047 (https://tools.ietf.org/html/rfc2047).
-	// MIME encoded-word syntax uses the following form: =?charset?encoding?encoded-text?=.
+	// (https://tools.ietf.org/html/rfc6531). For this reason, the email address
+	// string must be 7-bit ASCII. If you want to send to or from email addresses
+	// that contain Unicode characters in the domain part of an address, you must
+	// encode the domain using Punycode. Punycode is not permitted in the local
+	// part of the email address (the part before the @ sign) nor in the "friendly
+	// from" name. If you want to use Unicode characters in the "friendly from"
+	// name, you must encode the "friendly from" name using MIME encoded-word syntax,
+	// as described in Sending raw email using the Amazon SES API (https://docs.aws.amazon.com/ses/latest/dg/send-email-raw.html).
+	// For more information about Punycode, see RFC 3492 (http://tools.ietf.org/html/rfc3492).
 	//
 	// Source is a required field
 	Source *string `type:"string" required:"true"`
@@ -12823,7 +13888,7 @@ type SendEmailInput struct {
 	// and the Source to be user@example.com.
 	//
 	// For more information about sending authorization, see the Amazon SES Developer
-	// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization.html).
+	// Guide (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization.html).
 	SourceArn *string `type:"string"`
 
 	// A list of tags, in the form of name/value pairs, to apply to an email that
@@ -12832,12 +13897,20 @@ type SendEmailInput struct {
 	Tags []*MessageTag `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendEmailInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendEmailInput) GoString() string {
 	return s.String()
 }
@@ -12940,12 +14013,20 @@ type SendEmailOutput struct {
 	MessageId *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendEmailOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendEmailOutput) GoString() string {
 	return s.String()
 }
@@ -12957,7 +14038,7 @@ func (s *SendEmailOutput) SetMessageId(v string) *SendEmailOutput {
 }
 
 // Represents a request to send a single raw email using Amazon SES. For more
-// information, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/send-email-raw.html).
+// information, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/send-email-raw.html).
 type SendRawEmailInput struct {
 	_ struct{} `type:"structure"`
 
@@ -12977,7 +14058,7 @@ type SendRawEmailInput struct {
 	// the corresponding X-header, Amazon SES uses the value of the FromArn parameter.
 	//
 	// For information about when to use this parameter, see the description of
-	// SendRawEmail in this guide, or see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization-delegate-sender-tasks-email.html).
+	// SendRawEmail in this guide, or see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization-delegate-sender-tasks-email.html).
 	FromArn *string `type:"string"`
 
 	// The raw email message itself. The message has to meet the following criteria:
@@ -12991,14 +14072,14 @@ type SendRawEmailInput struct {
 	//
 	//    * Attachments must be of a content type that Amazon SES supports. For
 	//    a list on unsupported content types, see Unsupported Attachment Types
-	//    (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/mime-types.html)
-	//    in the Amazon SES Developer Guide.
+	//    (https://docs.aws.amazon.com/ses/latest/dg/mime-types.html) in the Amazon
+	//    SES Developer Guide.
 	//
 	//    * The entire message must be base64-encoded.
 	//
 	//    * If any of the MIME parts in your message contain content that is outside
 	//    of the 7-bit ASCII character range, we highly recommend that you encode
-	//    that content. For more information, see Sending Raw Email (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/send-email-raw.html)
+	//    that content. For more information, see Sending Raw Email (https://docs.aws.amazon.com/ses/latest/dg/send-email-raw.html)
 	//    in the Amazon SES Developer Guide.
 	//
 	//    * Per RFC 5321 (https://tools.ietf.org/html/rfc5321#section-4.5.3.1.6),
@@ -13023,7 +14104,7 @@ type SendRawEmailInput struct {
 	// parameter.
 	//
 	// For information about when to use this parameter, see the description of
-	// SendRawEmail in this guide, or see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization-delegate-sender-tasks-email.html).
+	// SendRawEmail in this guide, or see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization-delegate-sender-tasks-email.html).
 	ReturnPathArn *string `type:"string"`
 
 	// The identity's email address. If you do not provide a value for this parameter,
@@ -13031,20 +14112,20 @@ type SendRawEmailInput struct {
 	// also specify both.)
 	//
 	// Amazon SES does not support the SMTPUTF8 extension, as described inRFC6531
-	// (https://tools.ietf.org/html/rfc6531). For this reason, the local part of
-	// a source email address (the part of the email address that precedes the @
-	// sign) may only contain 7-bit ASCII characters (https://en.wikipedia.org/wiki/Email_address#Local-part).
-	// If the domain part of an address (the part after the @ sign) contains non-ASCII
-	// characters, they must be encoded using Punycode, as described in RFC3492
-	// (https://tools.ietf.org/html/rfc3492.html). The sender name (also known as
-	// the friendly name) may contain non-ASCII characters. These characters must
-	// be encoded using MIME encoded-word syntax, as described in RFC 2047 (https://tools.ietf.org/html/rfc2047).
-	// MIME encoded-word syntax uses the following form: =?charset?encoding?encoded-text?=.
+	// (https://tools.ietf.org/html/rfc6531). For this reason, the email address
+	// string must be 7-bit ASCII. If you want to send to or from email addresses
+	// that contain Unicode characters in the domain part of an address, you must
+	// encode the domain using Punycode. Punycode is not permitted in the local
+	// part of the email address (the part before the @ sign) nor in the "friendly
+	// from" name. If you want to use Unicode characters in the "friendly from"
+	// name, you must encode the "friendly from" name using MIME encoded-word syntax,
+	// as described in Sending raw email using the Amazon SES API (https://docs.aws.amazon.com/ses/latest/dg/send-email-raw.html).
+	// For more information about Punycode, see RFC 3492 (http://tools.ietf.org/html/rfc3492).
 	//
 	// If you specify the Source parameter and have feedback forwarding enabled,
-	// then bounces and complaints will be sent to this email address. This takes
-	// precedence over any Return-Path header that you might include in the raw
-	// text of the message.
+	// then bounces and complaints are sent to this email address. This takes precedence
+	// over any Return-Path header that you might include in the raw text of the
+	// message.
 	Source *string `type:"string"`
 
 	// This parameter is used only for sending authorization. It is the ARN of the
@@ -13062,7 +14143,7 @@ type SendRawEmailInput struct {
 	// parameter.
 	//
 	// For information about when to use this parameter, see the description of
-	// SendRawEmail in this guide, or see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization-delegate-sender-tasks-email.html).
+	// SendRawEmail in this guide, or see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization-delegate-sender-tasks-email.html).
 	SourceArn *string `type:"string"`
 
 	// A list of tags, in the form of name/value pairs, to apply to an email that
@@ -13071,12 +14152,20 @@ type SendRawEmailInput struct {
 	Tags []*MessageTag `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendRawEmailInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendRawEmailInput) GoString() string {
 	return s.String()
 }
@@ -13167,12 +14256,20 @@ type SendRawEmailOutput struct {
 	MessageId *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendRawEmailOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendRawEmailOutput) GoString() string {
 	return s.String()
 }
@@ -13184,7 +14281,7 @@ func (s *SendRawEmailOutput) SetMessageId(v string) *SendRawEmailOutput {
 }
 
 // Represents a request to send a templated email using Amazon SES. For more
-// information, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/send-personalized-email-api.html).
+// information, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/send-personalized-email-api.html).
 type SendTemplatedEmailInput struct {
 	_ struct{} `type:"structure"`
 
@@ -13198,13 +14295,13 @@ type SendTemplatedEmailInput struct {
 	Destination *Destination `type:"structure" required:"true"`
 
 	// The reply-to email address(es) for the message. If the recipient replies
-	// to the message, each reply-to address will receive the reply.
+	// to the message, each reply-to address receives the reply.
 	ReplyToAddresses []*string `type:"list"`
 
-	// The email address that bounces and complaints will be forwarded to when feedback
+	// The email address that bounces and complaints are forwarded to when feedback
 	// forwarding is enabled. If the message cannot be delivered to the recipient,
-	// then an error message will be returned from the recipient's ISP; this message
-	// will then be forwarded to the email address specified by the ReturnPath parameter.
+	// then an error message is returned from the recipient's ISP; this message
+	// is forwarded to the email address specified by the ReturnPath parameter.
 	// The ReturnPath parameter is never overwritten. This email address must be
 	// either individually verified with Amazon SES, or from a domain that has been
 	// verified with Amazon SES.
@@ -13220,29 +14317,29 @@ type SendTemplatedEmailInput struct {
 	// and the ReturnPath to be feedback@example.com.
 	//
 	// For more information about sending authorization, see the Amazon SES Developer
-	// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization.html).
+	// Guide (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization.html).
 	ReturnPathArn *string `type:"string"`
 
 	// The email address that is sending the email. This email address must be either
 	// individually verified with Amazon SES, or from a domain that has been verified
 	// with Amazon SES. For information about verifying identities, see the Amazon
-	// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/verify-addresses-and-domains.html).
+	// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/creating-identities.html).
 	//
 	// If you are sending on behalf of another user and have been permitted to do
 	// so by a sending authorization policy, then you must also specify the SourceArn
 	// parameter. For more information about sending authorization, see the Amazon
-	// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization.html).
+	// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization.html).
 	//
 	// Amazon SES does not support the SMTPUTF8 extension, as described in RFC6531
-	// (https://tools.ietf.org/html/rfc6531). For this reason, the local part of
-	// a source email address (the part of the email address that precedes the @
-	// sign) may only contain 7-bit ASCII characters (https://en.wikipedia.org/wiki/Email_address#Local-part).
-	// If the domain part of an address (the part after the @ sign) contains non-ASCII
-	// characters, they must be encoded using Punycode, as described in RFC3492
-	// (https://tools.ietf.org/html/rfc3492.html). The sender name (also known as
-	// the friendly name) may contain non-ASCII characters. These characters must
-	// be encoded using MIME encoded-word syntax, as described inRFC 2047 (https://tools.ietf.org/html/rfc2047).
-	// MIME encoded-word syntax uses the following form: =?charset?encoding?encoded-text?=.
+	// (https://tools.ietf.org/html/rfc6531). for this reason, The email address
+	// string must be 7-bit ASCII. If you want to send to or from email addresses
+	// that contain Unicode characters in the domain part of an address, you must
+	// encode the domain using Punycode. Punycode is not permitted in the local
+	// part of the email address (the part before the @ sign) nor in the "friendly
+	// from" name. If you want to use Unicode characters in the "friendly from"
+	// name, you must encode the "friendly from" name using MIME encoded-word syntax,
+	// as described in Sending raw email using the Amazon SES API (https://docs.aws.amazon.com/ses/latest/dg/send-email-raw.html).
+	// For more information about Punycode, see RFC 3492 (http://tools.ietf.org/html/rfc3492).
 	//
 	// Source is a required field
 	Source *string `type:"string" required:"true"`
@@ -13257,7 +14354,7 @@ type SendTemplatedEmailInput struct {
 	// and the Source to be user@example.com.
 	//
 	// For more information about sending authorization, see the Amazon SES Developer
-	// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/sending-authorization.html).
+	// Guide (https://docs.aws.amazon.com/ses/latest/dg/sending-authorization.html).
 	SourceArn *string `type:"string"`
 
 	// A list of tags, in the form of name/value pairs, to apply to an email that
@@ -13281,12 +14378,20 @@ type SendTemplatedEmailInput struct {
 	TemplateData *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendTemplatedEmailInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendTemplatedEmailInput) GoString() string {
 	return s.String()
 }
@@ -13398,12 +14503,20 @@ type SendTemplatedEmailOutput struct {
 	MessageId *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendTemplatedEmailOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendTemplatedEmailOutput) GoString() string {
 	return s.String()
 }
@@ -13416,7 +14529,7 @@ func (s *SendTemplatedEmailOutput) SetMessageId(v string) *SendTemplatedEmailOut
 
 // Represents a request to set a receipt rule set as the active receipt rule
 // set. You use receipt rule sets to receive email with Amazon SES. For more
-// information, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-concepts.html).
+// information, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-concepts.html).
 type SetActiveReceiptRuleSetInput struct {
 	_ struct{} `type:"structure"`
 
@@ -13425,12 +14538,20 @@ type SetActiveReceiptRuleSetInput struct {
 	RuleSetName *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetActiveReceiptRuleSetInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetActiveReceiptRuleSetInput) GoString() string {
 	return s.String()
 }
@@ -13446,19 +14567,27 @@ type SetActiveReceiptRuleSetOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetActiveReceiptRuleSetOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetActiveReceiptRuleSetOutput) GoString() string {
 	return s.String()
 }
 
 // Represents a request to enable or disable Amazon SES Easy DKIM signing for
 // an identity. For more information about setting up Easy DKIM, see the Amazon
-// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/easy-dkim.html).
+// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/send-email-authentication-dkim-easy.html).
 type SetIdentityDkimEnabledInput struct {
 	_ struct{} `type:"structure"`
 
@@ -13474,12 +14603,20 @@ type SetIdentityDkimEnabledInput struct {
 	Identity *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetIdentityDkimEnabledInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetIdentityDkimEnabledInput) GoString() string {
 	return s.String()
 }
@@ -13517,26 +14654,34 @@ type SetIdentityDkimEnabledOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetIdentityDkimEnabledOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetIdentityDkimEnabledOutput) GoString() string {
 	return s.String()
 }
 
 // Represents a request to enable or disable whether Amazon SES forwards you
 // bounce and complaint notifications through email. For information about email
-// feedback forwarding, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/notifications-via-email.html).
+// feedback forwarding, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity-using-notifications-email.html).
 type SetIdentityFeedbackForwardingEnabledInput struct {
 	_ struct{} `type:"structure"`
 
-	// Sets whether Amazon SES will forward bounce and complaint notifications as
-	// email. true specifies that Amazon SES will forward bounce and complaint notifications
+	// Sets whether Amazon SES forwards bounce and complaint notifications as email.
+	// true specifies that Amazon SES forwards bounce and complaint notifications
 	// as email, in addition to any Amazon SNS topic publishing otherwise specified.
-	// false specifies that Amazon SES will publish bounce and complaint notifications
+	// false specifies that Amazon SES publishes bounce and complaint notifications
 	// only through Amazon SNS. This value can only be set to false when Amazon
 	// SNS topics are set for both Bounce and Complaint notification types.
 	//
@@ -13550,12 +14695,20 @@ type SetIdentityFeedbackForwardingEnabledInput struct {
 	Identity *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetIdentityFeedbackForwardingEnabledInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetIdentityFeedbackForwardingEnabledInput) GoString() string {
 	return s.String()
 }
@@ -13593,26 +14746,34 @@ type SetIdentityFeedbackForwardingEnabledOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetIdentityFeedbackForwardingEnabledOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetIdentityFeedbackForwardingEnabledOutput) GoString() string {
 	return s.String()
 }
 
 // Represents a request to set whether Amazon SES includes the original email
 // headers in the Amazon SNS notifications of a specified type. For information
-// about notifications, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/notifications-via-sns.html).
+// about notifications, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity-using-notifications-sns.html).
 type SetIdentityHeadersInNotificationsEnabledInput struct {
 	_ struct{} `type:"structure"`
 
 	// Sets whether Amazon SES includes the original email headers in Amazon SNS
 	// notifications of the specified notification type. A value of true specifies
-	// that Amazon SES will include headers in notifications, and a value of false
-	// specifies that Amazon SES will not include headers in notifications.
+	// that Amazon SES includes headers in notifications, and a value of false specifies
+	// that Amazon SES does not include headers in notifications.
 	//
 	// This value can only be set when NotificationType is already set to use a
 	// particular Amazon SNS topic.
@@ -13632,12 +14793,20 @@ type SetIdentityHeadersInNotificationsEnabledInput struct {
 	NotificationType *string `type:"string" required:"true" enum:"NotificationType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetIdentityHeadersInNotificationsEnabledInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetIdentityHeadersInNotificationsEnabledInput) GoString() string {
 	return s.String()
 }
@@ -13684,54 +14853,68 @@ type SetIdentityHeadersInNotificationsEnabledOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetIdentityHeadersInNotificationsEnabledOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetIdentityHeadersInNotificationsEnabledOutput) GoString() string {
 	return s.String()
 }
 
 // Represents a request to enable or disable the Amazon SES custom MAIL FROM
 // domain setup for a verified identity. For information about using a custom
-// MAIL FROM domain, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/mail-from.html).
+// MAIL FROM domain, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/mail-from.html).
 type SetIdentityMailFromDomainInput struct {
 	_ struct{} `type:"structure"`
 
-	// The action that you want Amazon SES to take if it cannot successfully read
-	// the required MX record when you send an email. If you choose UseDefaultValue,
-	// Amazon SES will use amazonses.com (or a subdomain of that) as the MAIL FROM
-	// domain. If you choose RejectMessage, Amazon SES will return a MailFromDomainNotVerified
-	// error and not send the email.
+	// The action for Amazon SES to take if it cannot successfully read the required
+	// MX record when you send an email. If you choose UseDefaultValue, Amazon SES
+	// uses amazonses.com (or a subdomain of that) as the MAIL FROM domain. If you
+	// choose RejectMessage, Amazon SES returns a MailFromDomainNotVerified error
+	// and not send the email.
 	//
 	// The action specified in BehaviorOnMXFailure is taken when the custom MAIL
 	// FROM domain setup is in the Pending, Failed, and TemporaryFailure states.
 	BehaviorOnMXFailure *string `type:"string" enum:"BehaviorOnMXFailure"`
 
-	// The verified identity for which you want to enable or disable the specified
-	// custom MAIL FROM domain.
+	// The verified identity.
 	//
 	// Identity is a required field
 	Identity *string `type:"string" required:"true"`
 
-	// The custom MAIL FROM domain that you want the verified identity to use. The
-	// MAIL FROM domain must 1) be a subdomain of the verified identity, 2) not
-	// be used in a "From" address if the MAIL FROM domain is the destination of
-	// email feedback forwarding (for more information, see the Amazon SES Developer
-	// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/mail-from.html)),
+	// The custom MAIL FROM domain for the verified identity to use. The MAIL FROM
+	// domain must 1) be a subdomain of the verified identity, 2) not be used in
+	// a "From" address if the MAIL FROM domain is the destination of email feedback
+	// forwarding (for more information, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/mail-from.html)),
 	// and 3) not be used to receive emails. A value of null disables the custom
 	// MAIL FROM setting for the identity.
 	MailFromDomain *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetIdentityMailFromDomainInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetIdentityMailFromDomainInput) GoString() string {
 	return s.String()
 }
@@ -13772,25 +14955,32 @@ type SetIdentityMailFromDomainOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetIdentityMailFromDomainOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetIdentityMailFromDomainOutput) GoString() string {
 	return s.String()
 }
 
 // Represents a request to specify the Amazon SNS topic to which Amazon SES
-// will publish bounce, complaint, or delivery notifications for emails sent
-// with that identity as the Source. For information about Amazon SES notifications,
-// see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/notifications-via-sns.html).
+// publishes bounce, complaint, or delivery notifications for emails sent with
+// that identity as the source. For information about Amazon SES notifications,
+// see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity-using-notifications-sns.html).
 type SetIdentityNotificationTopicInput struct {
 	_ struct{} `type:"structure"`
 
-	// The identity (email address or domain) that you want to set the Amazon SNS
-	// topic for.
+	// The identity (email address or domain) for the Amazon SNS topic.
 	//
 	// You can only specify a verified identity for this parameter.
 	//
@@ -13801,8 +14991,8 @@ type SetIdentityNotificationTopicInput struct {
 	// Identity is a required field
 	Identity *string `type:"string" required:"true"`
 
-	// The type of notifications that will be published to the specified Amazon
-	// SNS topic.
+	// The type of notifications that are published to the specified Amazon SNS
+	// topic.
 	//
 	// NotificationType is a required field
 	NotificationType *string `type:"string" required:"true" enum:"NotificationType"`
@@ -13813,12 +15003,20 @@ type SetIdentityNotificationTopicInput struct {
 	SnsTopic *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetIdentityNotificationTopicInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetIdentityNotificationTopicInput) GoString() string {
 	return s.String()
 }
@@ -13862,19 +15060,27 @@ type SetIdentityNotificationTopicOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetIdentityNotificationTopicOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetIdentityNotificationTopicOutput) GoString() string {
 	return s.String()
 }
 
 // Represents a request to set the position of a receipt rule in a receipt rule
 // set. You use receipt rule sets to receive email with Amazon SES. For more
-// information, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-concepts.html).
+// information, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-concepts.html).
 type SetReceiptRulePositionInput struct {
 	_ struct{} `type:"structure"`
 
@@ -13892,12 +15098,20 @@ type SetReceiptRulePositionInput struct {
 	RuleSetName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetReceiptRulePositionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetReceiptRulePositionInput) GoString() string {
 	return s.String()
 }
@@ -13941,12 +15155,20 @@ type SetReceiptRulePositionOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetReceiptRulePositionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetReceiptRulePositionOutput) GoString() string {
 	return s.String()
 }
@@ -13956,7 +15178,7 @@ func (s SetReceiptRulePositionOutput) GoString() string {
 // Simple Notification Service (Amazon SNS).
 //
 // For information about setting a stop action in a receipt rule, see the Amazon
-// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-action-stop.html).
+// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-action-stop.html).
 type StopAction struct {
 	_ struct{} `type:"structure"`
 
@@ -13966,18 +15188,29 @@ type StopAction struct {
 	Scope *string `type:"string" required:"true" enum:"StopScope"`
 
 	// The Amazon Resource Name (ARN) of the Amazon SNS topic to notify when the
-	// stop action is taken. An example of an Amazon SNS topic ARN is arn:aws:sns:us-west-2:123456789012:MyTopic.
+	// stop action is taken. You can find the ARN of a topic by using the ListTopics
+	// (https://docs.aws.amazon.com/sns/latest/api/API_ListTopics.html) Amazon SNS
+	// operation.
+	//
 	// For more information about Amazon SNS topics, see the Amazon SNS Developer
 	// Guide (https://docs.aws.amazon.com/sns/latest/dg/CreateTopic.html).
 	TopicArn *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopAction) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s StopAction) GoString() string {
 	return s.String()
 }
@@ -14007,8 +15240,8 @@ func (s *StopAction) SetTopicArn(v string) *StopAction {
 	return s
 }
 
-// The content of the email, composed of a subject line, an HTML part, and a
-// text-only part.
+// The content of the email, composed of a subject line and either an HTML part
+// or a text-only part.
 type Template struct {
 	_ struct{} `type:"structure"`
 
@@ -14018,23 +15251,31 @@ type Template struct {
 	// The subject line of the email.
 	SubjectPart *string `type:"string"`
 
-	// The name of the template. You will refer to this name when you send email
-	// using the SendTemplatedEmail or SendBulkTemplatedEmail operations.
+	// The name of the template. You use this name when you send email using the
+	// SendTemplatedEmail or SendBulkTemplatedEmail operations.
 	//
 	// TemplateName is a required field
 	TemplateName *string `type:"string" required:"true"`
 
-	// The email body that will be visible to recipients whose email clients do
-	// not display HTML.
+	// The email body that is visible to recipients whose email clients do not display
+	// HTML content.
 	TextPart *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Template) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Template) GoString() string {
 	return s.String()
 }
@@ -14087,12 +15328,20 @@ type TemplateMetadata struct {
 	Name *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TemplateMetadata) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TemplateMetadata) GoString() string {
 	return s.String()
 }
@@ -14119,18 +15368,26 @@ type TestRenderTemplateInput struct {
 	// TemplateData is a required field
 	TemplateData *string `type:"string" required:"true"`
 
-	// The name of the template that you want to render.
+	// The name of the template to render.
 	//
 	// TemplateName is a required field
 	TemplateName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TestRenderTemplateInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TestRenderTemplateInput) GoString() string {
 	return s.String()
 }
@@ -14171,12 +15428,20 @@ type TestRenderTemplateOutput struct {
 	RenderedTemplate *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TestRenderTemplateOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TestRenderTemplateOutput) GoString() string {
 	return s.String()
 }
@@ -14192,22 +15457,30 @@ func (s *TestRenderTemplateOutput) SetRenderedTemplate(v string) *TestRenderTemp
 // emails.
 //
 // For more information, see Configuring Custom Domains to Handle Open and Click
-// Tracking (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/configure-custom-open-click-domains.html)
+// Tracking (https://docs.aws.amazon.com/ses/latest/dg/configure-custom-open-click-domains.html)
 // in the Amazon SES Developer Guide.
 type TrackingOptions struct {
 	_ struct{} `type:"structure"`
 
-	// The custom subdomain that will be used to redirect email recipients to the
-	// Amazon SES event tracking domain.
+	// The custom subdomain that is used to redirect email recipients to the Amazon
+	// SES event tracking domain.
 	CustomRedirectDomain *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TrackingOptions) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TrackingOptions) GoString() string {
 	return s.String()
 }
@@ -14224,16 +15497,24 @@ type UpdateAccountSendingEnabledInput struct {
 	_ struct{} `type:"structure"`
 
 	// Describes whether email sending is enabled or disabled for your Amazon SES
-	// account in the current AWS Region.
+	// account in the current Amazon Web Services Region.
 	Enabled *bool `type:"boolean"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateAccountSendingEnabledInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateAccountSendingEnabledInput) GoString() string {
 	return s.String()
 }
@@ -14248,41 +15529,55 @@ type UpdateAccountSendingEnabledOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateAccountSendingEnabledOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateAccountSendingEnabledOutput) GoString() string {
 	return s.String()
 }
 
 // Represents a request to update the event destination of a configuration set.
 // Configuration sets enable you to publish email sending events. For information
-// about using configuration sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/monitor-sending-activity.html).
+// about using configuration sets, see the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/monitor-sending-activity.html).
 type UpdateConfigurationSetEventDestinationInput struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the configuration set that contains the event destination that
-	// you want to update.
+	// The name of the configuration set that contains the event destination.
 	//
 	// ConfigurationSetName is a required field
 	ConfigurationSetName *string `type:"string" required:"true"`
 
-	// The event destination object that you want to apply to the specified configuration
-	// set.
+	// The event destination object.
 	//
 	// EventDestination is a required field
 	EventDestination *EventDestination `type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConfigurationSetEventDestinationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConfigurationSetEventDestinationInput) GoString() string {
 	return s.String()
 }
@@ -14325,12 +15620,20 @@ type UpdateConfigurationSetEventDestinationOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConfigurationSetEventDestinationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConfigurationSetEventDestinationOutput) GoString() string {
 	return s.String()
 }
@@ -14340,24 +15643,32 @@ func (s UpdateConfigurationSetEventDestinationOutput) GoString() string {
 type UpdateConfigurationSetReputationMetricsEnabledInput struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the configuration set that you want to update.
+	// The name of the configuration set to update.
 	//
 	// ConfigurationSetName is a required field
 	ConfigurationSetName *string `type:"string" required:"true"`
 
-	// Describes whether or not Amazon SES will publish reputation metrics for the
+	// Describes whether or not Amazon SES publishes reputation metrics for the
 	// configuration set, such as bounce and complaint rates, to Amazon CloudWatch.
 	//
 	// Enabled is a required field
 	Enabled *bool `type:"boolean" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConfigurationSetReputationMetricsEnabledInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConfigurationSetReputationMetricsEnabledInput) GoString() string {
 	return s.String()
 }
@@ -14394,12 +15705,20 @@ type UpdateConfigurationSetReputationMetricsEnabledOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConfigurationSetReputationMetricsEnabledOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConfigurationSetReputationMetricsEnabledOutput) GoString() string {
 	return s.String()
 }
@@ -14409,7 +15728,7 @@ func (s UpdateConfigurationSetReputationMetricsEnabledOutput) GoString() string
 type UpdateConfigurationSetSendingEnabledInput struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the configuration set that you want to update.
+	// The name of the configuration set to update.
 	//
 	// ConfigurationSetName is a required field
 	ConfigurationSetName *string `type:"string" required:"true"`
@@ -14421,12 +15740,20 @@ type UpdateConfigurationSetSendingEnabledInput struct {
 	Enabled *bool `type:"boolean" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConfigurationSetSendingEnabledInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConfigurationSetSendingEnabledInput) GoString() string {
 	return s.String()
 }
@@ -14463,12 +15790,20 @@ type UpdateConfigurationSetSendingEnabledOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConfigurationSetSendingEnabledOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConfigurationSetSendingEnabledOutput) GoString() string {
 	return s.String()
 }
@@ -14477,8 +15812,7 @@ func (s UpdateConfigurationSetSendingEnabledOutput) GoString() string {
 type UpdateConfigurationSetTrackingOptionsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the configuration set for which you want to update the custom
-	// tracking domain.
+	// The name of the configuration set.
 	//
 	// ConfigurationSetName is a required field
 	ConfigurationSetName *string `type:"string" required:"true"`
@@ -14488,19 +15822,27 @@ type UpdateConfigurationSetTrackingOptionsInput struct {
 	// emails.
 	//
 	// For more information, see Configuring Custom Domains to Handle Open and Click
-	// Tracking (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/configure-custom-open-click-domains.html)
+	// Tracking (https://docs.aws.amazon.com/ses/latest/dg/configure-custom-open-click-domains.html)
 	// in the Amazon SES Developer Guide.
 	//
 	// TrackingOptions is a required field
 	TrackingOptions *TrackingOptions `type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConfigurationSetTrackingOptionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConfigurationSetTrackingOptionsInput) GoString() string {
 	return s.String()
 }
@@ -14538,12 +15880,20 @@ type UpdateConfigurationSetTrackingOptionsOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConfigurationSetTrackingOptionsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateConfigurationSetTrackingOptionsOutput) GoString() string {
 	return s.String()
 }
@@ -14566,11 +15916,11 @@ type UpdateCustomVerificationEmailTemplateInput struct {
 	// The content of the custom verification email. The total size of the email
 	// must be less than 10 MB. The message body may contain HTML, with some limitations.
 	// For more information, see Custom Verification Email Frequently Asked Questions
-	// (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/custom-verification-emails.html#custom-verification-emails-faq)
+	// (https://docs.aws.amazon.com/ses/latest/dg/creating-identities.html#send-email-verify-address-custom)
 	// in the Amazon SES Developer Guide.
 	TemplateContent *string `type:"string"`
 
-	// The name of the custom verification email template that you want to update.
+	// The name of the custom verification email template to update.
 	//
 	// TemplateName is a required field
 	TemplateName *string `type:"string" required:"true"`
@@ -14579,12 +15929,20 @@ type UpdateCustomVerificationEmailTemplateInput struct {
 	TemplateSubject *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateCustomVerificationEmailTemplateInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateCustomVerificationEmailTemplateInput) GoString() string {
 	return s.String()
 }
@@ -14642,19 +16000,27 @@ type UpdateCustomVerificationEmailTemplateOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateCustomVerificationEmailTemplateOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateCustomVerificationEmailTemplateOutput) GoString() string {
 	return s.String()
 }
 
 // Represents a request to update a receipt rule. You use receipt rules to receive
 // email with Amazon SES. For more information, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-concepts.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-concepts.html).
 type UpdateReceiptRuleInput struct {
 	_ struct{} `type:"structure"`
 
@@ -14669,12 +16035,20 @@ type UpdateReceiptRuleInput struct {
 	RuleSetName *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateReceiptRuleInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateReceiptRuleInput) GoString() string {
 	return s.String()
 }
@@ -14717,12 +16091,20 @@ type UpdateReceiptRuleOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateReceiptRuleOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateReceiptRuleOutput) GoString() string {
 	return s.String()
 }
@@ -14730,19 +16112,27 @@ func (s UpdateReceiptRuleOutput) GoString() string {
 type UpdateTemplateInput struct {
 	_ struct{} `type:"structure"`
 
-	// The content of the email, composed of a subject line, an HTML part, and a
-	// text-only part.
+	// The content of the email, composed of a subject line and either an HTML part
+	// or a text-only part.
 	//
 	// Template is a required field
 	Template *Template `type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateTemplateInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateTemplateInput) GoString() string {
 	return s.String()
 }
@@ -14775,19 +16165,27 @@ type UpdateTemplateOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateTemplateOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateTemplateOutput) GoString() string {
 	return s.String()
 }
 
 // Represents a request to generate the CNAME records needed to set up Easy
 // DKIM with Amazon SES. For more information about setting up Easy DKIM, see
-// the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/easy-dkim.html).
+// the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/send-email-authentication-dkim-easy.html).
 type VerifyDomainDkimInput struct {
 	_ struct{} `type:"structure"`
 
@@ -14797,12 +16195,20 @@ type VerifyDomainDkimInput struct {
 	Domain *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VerifyDomainDkimInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VerifyDomainDkimInput) GoString() string {
 	return s.String()
 }
@@ -14842,18 +16248,26 @@ type VerifyDomainDkimOutput struct {
 	// not email address identities.)
 	//
 	// For more information about creating DNS records using DKIM tokens, see the
-	// Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/easy-dkim.html).
+	// Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/send-email-authentication-dkim-easy.html).
 	//
 	// DkimTokens is a required field
 	DkimTokens []*string `type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VerifyDomainDkimOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VerifyDomainDkimOutput) GoString() string {
 	return s.String()
 }
@@ -14867,7 +16281,7 @@ func (s *VerifyDomainDkimOutput) SetDkimTokens(v []*string) *VerifyDomainDkimOut
 // Represents a request to begin Amazon SES domain verification and to generate
 // the TXT records that you must publish to the DNS server of your domain to
 // complete the verification. For information about domain verification, see
-// the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/verify-domains.html).
+// the Amazon SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/creating-identities.html#verify-domain-procedure).
 type VerifyDomainIdentityInput struct {
 	_ struct{} `type:"structure"`
 
@@ -14877,12 +16291,20 @@ type VerifyDomainIdentityInput struct {
 	Domain *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VerifyDomainIdentityInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VerifyDomainIdentityInput) GoString() string {
 	return s.String()
 }
@@ -14918,19 +16340,28 @@ type VerifyDomainIdentityOutput struct {
 	// is "Pending". When Amazon SES detects the record, the domain's verification
 	// status changes to "Success". If Amazon SES is unable to detect the record
 	// within 72 hours, the domain's verification status changes to "Failed." In
-	// that case, if you still want to verify the domain, you must restart the verification
-	// process from the beginning.
+	// that case, to verify the domain, you must restart the verification process
+	// from the beginning. The domain's verification status also changes to "Success"
+	// when it is DKIM verified.
 	//
 	// VerificationToken is a required field
 	VerificationToken *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VerifyDomainIdentityOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VerifyDomainIdentityOutput) GoString() string {
 	return s.String()
 }
@@ -14943,7 +16374,7 @@ func (s *VerifyDomainIdentityOutput) SetVerificationToken(v string) *VerifyDomai
 
 // Represents a request to begin email address verification with Amazon SES.
 // For information about email address verification, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/verify-email-addresses.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/creating-identities.html#verify-email-addresses-procedure).
 type VerifyEmailAddressInput struct {
 	_ struct{} `type:"structure"`
 
@@ -14953,12 +16384,20 @@ type VerifyEmailAddressInput struct {
 	EmailAddress *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VerifyEmailAddressInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VerifyEmailAddressInput) GoString() string {
 	return s.String()
 }
@@ -14986,19 +16425,27 @@ type VerifyEmailAddressOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VerifyEmailAddressOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VerifyEmailAddressOutput) GoString() string {
 	return s.String()
 }
 
 // Represents a request to begin email address verification with Amazon SES.
 // For information about email address verification, see the Amazon SES Developer
-// Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/verify-email-addresses.html).
+// Guide (https://docs.aws.amazon.com/ses/latest/dg/creating-identities.html#verify-email-addresses-procedure).
 type VerifyEmailIdentityInput struct {
 	_ struct{} `type:"structure"`
 
@@ -15008,12 +16455,20 @@ type VerifyEmailIdentityInput struct {
 	EmailAddress *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VerifyEmailIdentityInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VerifyEmailIdentityInput) GoString() string {
 	return s.String()
 }
@@ -15042,28 +16497,43 @@ type VerifyEmailIdentityOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VerifyEmailIdentityOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s VerifyEmailIdentityOutput) GoString() string {
 	return s.String()
 }
 
 // When included in a receipt rule, this action calls Amazon WorkMail and, optionally,
 // publishes a notification to Amazon Simple Notification Service (Amazon SNS).
-// You will typically not use this action directly because Amazon WorkMail adds
-// the rule automatically during its setup procedure.
+// It usually isn't necessary to set this up manually, because Amazon WorkMail
+// adds the rule automatically during its setup procedure.
 //
 // For information using a receipt rule to call Amazon WorkMail, see the Amazon
-// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/DeveloperGuide/receiving-email-action-workmail.html).
+// SES Developer Guide (https://docs.aws.amazon.com/ses/latest/dg/receiving-email-action-workmail.html).
 type WorkmailAction struct {
 	_ struct{} `type:"structure"`
 
-	// The ARN of the Amazon WorkMail organization. An example of an Amazon WorkMail
-	// organization ARN is arn:aws:workmail:us-west-2:123456789012:organization/m-68755160c4cb4e29a2b2f8fb58f359d7.
+	// The Amazon Resource Name (ARN) of the Amazon WorkMail organization. Amazon
+	// WorkMail ARNs use the following format:
+	//
+	// arn:aws:workmail:<region>:<awsAccountId>:organization/<workmailOrganizationId>
+	//
+	// You can find the ID of your organization by using the ListOrganizations (https://docs.aws.amazon.com/workmail/latest/APIReference/API_ListOrganizations.html)
+	// operation in Amazon WorkMail. Amazon WorkMail organization IDs begin with
+	// "m-", followed by a string of alphanumeric characters.
+	//
 	// For information about Amazon WorkMail organizations, see the Amazon WorkMail
 	// Administrator Guide (https://docs.aws.amazon.com/workmail/latest/adminguide/organizations_overview.html).
 	//
@@ -15071,18 +16541,29 @@ type WorkmailAction struct {
 	OrganizationArn *string `type:"string" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the Amazon SNS topic to notify when the
-	// WorkMail action is called. An example of an Amazon SNS topic ARN is arn:aws:sns:us-west-2:123456789012:MyTopic.
+	// WorkMail action is called. You can find the ARN of a topic by using the ListTopics
+	// (https://docs.aws.amazon.com/sns/latest/api/API_ListTopics.html) operation
+	// in Amazon SNS.
+	//
 	// For more information about Amazon SNS topics, see the Amazon SNS Developer
 	// Guide (https://docs.aws.amazon.com/sns/latest/dg/CreateTopic.html).
 	TopicArn *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s WorkmailAction) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s WorkmailAction) GoString() string {
 	return s.String()
 }
@@ -15120,6 +16601,14 @@ const (
 	BehaviorOnMXFailureRejectMessage = "RejectMessage"
 )
 
+// BehaviorOnMXFailure_Values returns all elements of the BehaviorOnMXFailure enum
+func BehaviorOnMXFailure_Values() []string {
+	return []string{
+		BehaviorOnMXFailureUseDefaultValue,
+		BehaviorOnMXFailureRejectMessage,
+	}
+}
+
 const (
 	// BounceTypeDoesNotExist is a BounceType enum value
 	BounceTypeDoesNotExist = "DoesNotExist"
@@ -15140,6 +16629,18 @@ const (
 	BounceTypeTemporaryFailure = "TemporaryFailure"
 )
 
+// BounceType_Values returns all elements of the BounceType enum
+func BounceType_Values() []string {
+	return []string{
+		BounceTypeDoesNotExist,
+		BounceTypeMessageTooLarge,
+		BounceTypeExceededQuota,
+		BounceTypeContentRejected,
+		BounceTypeUndefined,
+		BounceTypeTemporaryFailure,
+	}
+}
+
 const (
 	// BulkEmailStatusSuccess is a BulkEmailStatus enum value
 	BulkEmailStatusSuccess = "Success"
@@ -15184,6 +16685,26 @@ const (
 	BulkEmailStatusFailed = "Failed"
 )
 
+// BulkEmailStatus_Values returns all elements of the BulkEmailStatus enum
+func BulkEmailStatus_Values() []string {
+	return []string{
+		BulkEmailStatusSuccess,
+		BulkEmailStatusMessageRejected,
+		BulkEmailStatusMailFromDomainNotVerified,
+		BulkEmailStatusConfigurationSetDoesNotExist,
+		BulkEmailStatusTemplateDoesNotExist,
+		BulkEmailStatusAccountSuspended,
+		BulkEmailStatusAccountThrottled,
+		BulkEmailStatusAccountDailyQuotaExceeded,
+		BulkEmailStatusInvalidSendingPoolName,
+		BulkEmailStatusAccountSendingPaused,
+		BulkEmailStatusConfigurationSetSendingPaused,
+		BulkEmailStatusInvalidParameterValue,
+		BulkEmailStatusTransientFailure,
+		BulkEmailStatusFailed,
+	}
+}
+
 const (
 	// ConfigurationSetAttributeEventDestinations is a ConfigurationSetAttribute enum value
 	ConfigurationSetAttributeEventDestinations = "eventDestinations"
@@ -15198,6 +16719,16 @@ const (
 	ConfigurationSetAttributeReputationOptions = "reputationOptions"
 )
 
+// ConfigurationSetAttribute_Values returns all elements of the ConfigurationSetAttribute enum
+func ConfigurationSetAttribute_Values() []string {
+	return []string{
+		ConfigurationSetAttributeEventDestinations,
+		ConfigurationSetAttributeTrackingOptions,
+		ConfigurationSetAttributeDeliveryOptions,
+		ConfigurationSetAttributeReputationOptions,
+	}
+}
+
 const (
 	// CustomMailFromStatusPending is a CustomMailFromStatus enum value
 	CustomMailFromStatusPending = "Pending"
@@ -15212,6 +16743,16 @@ const (
 	CustomMailFromStatusTemporaryFailure = "TemporaryFailure"
 )
 
+// CustomMailFromStatus_Values returns all elements of the CustomMailFromStatus enum
+func CustomMailFromStatus_Values() []string {
+	return []string{
+		CustomMailFromStatusPending,
+		CustomMailFromStatusSuccess,
+		CustomMailFromStatusFailed,
+		CustomMailFromStatusTemporaryFailure,
+	}
+}
+
 const (
 	// DimensionValueSourceMessageTag is a DimensionValueSource enum value
 	DimensionValueSourceMessageTag = "messageTag"
@@ -15223,6 +16764,15 @@ const (
 	DimensionValueSourceLinkTag = "linkTag"
 )
 
+// DimensionValueSource_Values returns all elements of the DimensionValueSource enum
+func DimensionValueSource_Values() []string {
+	return []string{
+		DimensionValueSourceMessageTag,
+		DimensionValueSourceEmailHeader,
+		DimensionValueSourceLinkTag,
+	}
+}
+
 const (
 	// DsnActionFailed is a DsnAction enum value
 	DsnActionFailed = "failed"
@@ -15240,6 +16790,17 @@ const (
 	DsnActionExpanded = "expanded"
 )
 
+// DsnAction_Values returns all elements of the DsnAction enum
+func DsnAction_Values() []string {
+	return []string{
+		DsnActionFailed,
+		DsnActionDelayed,
+		DsnActionDelivered,
+		DsnActionRelayed,
+		DsnActionExpanded,
+	}
+}
+
 const (
 	// EventTypeSend is a EventType enum value
 	EventTypeSend = "send"
@@ -15266,6 +16827,20 @@ const (
 	EventTypeRenderingFailure = "renderingFailure"
 )
 
+// EventType_Values returns all elements of the EventType enum
+func EventType_Values() []string {
+	return []string{
+		EventTypeSend,
+		EventTypeReject,
+		EventTypeBounce,
+		EventTypeComplaint,
+		EventTypeDelivery,
+		EventTypeOpen,
+		EventTypeClick,
+		EventTypeRenderingFailure,
+	}
+}
+
 const (
 	// IdentityTypeEmailAddress is a IdentityType enum value
 	IdentityTypeEmailAddress = "EmailAddress"
@@ -15274,6 +16849,14 @@ const (
 	IdentityTypeDomain = "Domain"
 )
 
+// IdentityType_Values returns all elements of the IdentityType enum
+func IdentityType_Values() []string {
+	return []string{
+		IdentityTypeEmailAddress,
+		IdentityTypeDomain,
+	}
+}
+
 const (
 	// InvocationTypeEvent is a InvocationType enum value
 	InvocationTypeEvent = "Event"
@@ -15282,6 +16865,14 @@ const (
 	InvocationTypeRequestResponse = "RequestResponse"
 )
 
+// InvocationType_Values returns all elements of the InvocationType enum
+func InvocationType_Values() []string {
+	return []string{
+		InvocationTypeEvent,
+		InvocationTypeRequestResponse,
+	}
+}
+
 const (
 	// NotificationTypeBounce is a NotificationType enum value
 	NotificationTypeBounce = "Bounce"
@@ -15293,6 +16884,15 @@ const (
 	NotificationTypeDelivery = "Delivery"
 )
 
+// NotificationType_Values returns all elements of the NotificationType enum
+func NotificationType_Values() []string {
+	return []string{
+		NotificationTypeBounce,
+		NotificationTypeComplaint,
+		NotificationTypeDelivery,
+	}
+}
+
 const (
 	// ReceiptFilterPolicyBlock is a ReceiptFilterPolicy enum value
 	ReceiptFilterPolicyBlock = "Block"
@@ -15301,6 +16901,14 @@ const (
 	ReceiptFilterPolicyAllow = "Allow"
 )
 
+// ReceiptFilterPolicy_Values returns all elements of the ReceiptFilterPolicy enum
+func ReceiptFilterPolicy_Values() []string {
+	return []string{
+		ReceiptFilterPolicyBlock,
+		ReceiptFilterPolicyAllow,
+	}
+}
+
 const (
 	// SNSActionEncodingUtf8 is a SNSActionEncoding enum value
 	SNSActionEncodingUtf8 = "UTF-8"
@@ -15309,11 +16917,26 @@ const (
 	SNSActionEncodingBase64 = "Base64"
 )
 
+// SNSActionEncoding_Values returns all elements of the SNSActionEncoding enum
+func SNSActionEncoding_Values() []string {
+	return []string{
+		SNSActionEncodingUtf8,
+		SNSActionEncodingBase64,
+	}
+}
+
 const (
 	// StopScopeRuleSet is a StopScope enum value
 	StopScopeRuleSet = "RuleSet"
 )
 
+// StopScope_Values returns all elements of the StopScope enum
+func StopScope_Values() []string {
+	return []string{
+		StopScopeRuleSet,
+	}
+}
+
 const (
 	// TlsPolicyRequire is a TlsPolicy enum value
 	TlsPolicyRequire = "Require"
@@ -15322,6 +16945,14 @@ const (
 	TlsPolicyOptional = "Optional"
 )
 
+// TlsPolicy_Values returns all elements of the TlsPolicy enum
+func TlsPolicy_Values() []string {
+	return []string{
+		TlsPolicyRequire,
+		TlsPolicyOptional,
+	}
+}
+
 const (
 	// VerificationStatusPending is a VerificationStatus enum value
 	VerificationStatusPending = "Pending"
@@ -15338,3 +16969,14 @@ const (
 	// VerificationStatusNotStarted is a VerificationStatus enum value
 	VerificationStatusNotStarted = "NotStarted"
 )
+
+// VerificationStatus_Values returns all elements of the VerificationStatus enum
+func VerificationStatus_Values() []string {
+	return []string{
+		VerificationStatusPending,
+		VerificationStatusSuccess,
+		VerificationStatusFailed,
+		VerificationStatusTemporaryFailure,
+		VerificationStatusNotStarted,
+	}
+}
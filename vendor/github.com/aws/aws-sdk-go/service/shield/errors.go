@@ -2,6 +2,10 @@
 
 package shield
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeAccessDeniedException for service response error code
@@ -14,10 +18,11 @@ const (
 	// ErrCodeAccessDeniedForDependencyException for service response error code
 	// "AccessDeniedForDependencyException".
 	//
-	// In order to grant the necessary access to the DDoS Response Team, the user
-	// submitting AssociateDRTRole must have the iam:PassRole permission. This error
-	// indicates the user did not have the appropriate permissions. For more information,
-	// see Granting a User Permissions to Pass a Role to an AWS Service (https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_use_passrole.html).
+	// In order to grant the necessary access to the Shield Response Team (SRT)
+	// the user submitting the request must have the iam:PassRole permission. This
+	// error indicates the user did not have the appropriate permissions. For more
+	// information, see Granting a User Permissions to Pass a Role to an Amazon
+	// Web Services Service (https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_use_passrole.html).
 	ErrCodeAccessDeniedForDependencyException = "AccessDeniedForDependencyException"
 
 	// ErrCodeInternalErrorException for service response error code
@@ -38,13 +43,15 @@ const (
 	// "InvalidPaginationTokenException".
 	//
 	// Exception that indicates that the NextToken specified in the request is invalid.
-	// Submit the request using the NextToken value that was returned in the response.
+	// Submit the request using the NextToken value that was returned in the prior
+	// response.
 	ErrCodeInvalidPaginationTokenException = "InvalidPaginationTokenException"
 
 	// ErrCodeInvalidParameterException for service response error code
 	// "InvalidParameterException".
 	//
 	// Exception that indicates that the parameters passed to the API are invalid.
+	// If available, this exception includes details in additional properties.
 	ErrCodeInvalidParameterException = "InvalidParameterException"
 
 	// ErrCodeInvalidResourceException for service response error code
@@ -58,10 +65,6 @@ const (
 	// "LimitsExceededException".
 	//
 	// Exception that indicates that the operation would exceed a limit.
-	//
-	// Type is the type of limit that would be exceeded.
-	//
-	// Limit is the threshold that would be exceeded.
 	ErrCodeLimitsExceededException = "LimitsExceededException"
 
 	// ErrCodeLockedSubscriptionException for service response error code
@@ -76,25 +79,43 @@ const (
 	// ErrCodeNoAssociatedRoleException for service response error code
 	// "NoAssociatedRoleException".
 	//
-	// The ARN of the role that you specifed does not exist.
+	// The ARN of the role that you specified does not exist.
 	ErrCodeNoAssociatedRoleException = "NoAssociatedRoleException"
 
 	// ErrCodeOptimisticLockException for service response error code
 	// "OptimisticLockException".
 	//
-	// Exception that indicates that the protection state has been modified by another
-	// client. You can retry the request.
+	// Exception that indicates that the resource state has been modified by another
+	// client. Retrieve the resource and then retry your request.
 	ErrCodeOptimisticLockException = "OptimisticLockException"
 
 	// ErrCodeResourceAlreadyExistsException for service response error code
 	// "ResourceAlreadyExistsException".
 	//
-	// Exception indicating the specified resource already exists.
+	// Exception indicating the specified resource already exists. If available,
+	// this exception includes details in additional properties.
 	ErrCodeResourceAlreadyExistsException = "ResourceAlreadyExistsException"
 
 	// ErrCodeResourceNotFoundException for service response error code
 	// "ResourceNotFoundException".
 	//
-	// Exception indicating the specified resource does not exist.
+	// Exception indicating the specified resource does not exist. If available,
+	// this exception includes details in additional properties.
 	ErrCodeResourceNotFoundException = "ResourceNotFoundException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"AccessDeniedException":              newErrorAccessDeniedException,
+	"AccessDeniedForDependencyException": newErrorAccessDeniedForDependencyException,
+	"InternalErrorException":             newErrorInternalErrorException,
+	"InvalidOperationException":          newErrorInvalidOperationException,
+	"InvalidPaginationTokenException":    newErrorInvalidPaginationTokenException,
+	"InvalidParameterException":          newErrorInvalidParameterException,
+	"InvalidResourceException":           newErrorInvalidResourceException,
+	"LimitsExceededException":            newErrorLimitsExceededException,
+	"LockedSubscriptionException":        newErrorLockedSubscriptionException,
+	"NoAssociatedRoleException":          newErrorNoAssociatedRoleException,
+	"OptimisticLockException":            newErrorOptimisticLockException,
+	"ResourceAlreadyExistsException":     newErrorResourceAlreadyExistsException,
+	"ResourceNotFoundException":          newErrorResourceNotFoundException,
+}
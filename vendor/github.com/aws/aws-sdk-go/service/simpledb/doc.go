@@ -22,7 +22,7 @@
 // See simpledb package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/simpledb/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon SimpleDB with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
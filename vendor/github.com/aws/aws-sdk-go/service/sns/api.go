@@ -4,6 +4,7 @@ package sns
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awsutil"
@@ -28,14 +29,13 @@ const opAddPermission = "AddPermission"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AddPermissionRequest method.
+//	req, resp := client.AddPermissionRequest(params)
 //
-//    // Example sending a request using the AddPermissionRequest method.
-//    req, resp := client.AddPermissionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/AddPermission
 func (c *SNS) AddPermissionRequest(input *AddPermissionInput) (req *request.Request, output *AddPermissionOutput) {
@@ -58,7 +58,11 @@ func (c *SNS) AddPermissionRequest(input *AddPermissionInput) (req *request.Requ
 // AddPermission API operation for Amazon Simple Notification Service.
 //
 // Adds a statement to a topic's access control policy, granting access for
-// the specified AWS accounts to the specified actions.
+// the specified Amazon Web Services accounts to the specified actions.
+//
+// To remove the ability to change topic permissions, you must deny permissions
+// to the AddPermission, RemovePermission, and SetTopicAttributes actions in
+// your IAM policy.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -68,17 +72,18 @@ func (c *SNS) AddPermissionRequest(input *AddPermissionInput) (req *request.Requ
 // API operation AddPermission for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeNotFoundException "NotFound"
-//   Indicates that the requested resource does not exist.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
+//
+//   - ErrCodeNotFoundException "NotFound"
+//     Indicates that the requested resource does not exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/AddPermission
 func (c *SNS) AddPermission(input *AddPermissionInput) (*AddPermissionOutput, error) {
@@ -118,14 +123,13 @@ const opCheckIfPhoneNumberIsOptedOut = "CheckIfPhoneNumberIsOptedOut"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CheckIfPhoneNumberIsOptedOutRequest method.
+//	req, resp := client.CheckIfPhoneNumberIsOptedOutRequest(params)
 //
-//    // Example sending a request using the CheckIfPhoneNumberIsOptedOutRequest method.
-//    req, resp := client.CheckIfPhoneNumberIsOptedOutRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/CheckIfPhoneNumberIsOptedOut
 func (c *SNS) CheckIfPhoneNumberIsOptedOutRequest(input *CheckIfPhoneNumberIsOptedOutInput) (req *request.Request, output *CheckIfPhoneNumberIsOptedOutOutput) {
@@ -147,8 +151,8 @@ func (c *SNS) CheckIfPhoneNumberIsOptedOutRequest(input *CheckIfPhoneNumberIsOpt
 // CheckIfPhoneNumberIsOptedOut API operation for Amazon Simple Notification Service.
 //
 // Accepts a phone number and indicates whether the phone holder has opted out
-// of receiving SMS messages from your account. You cannot send SMS messages
-// to a number that is opted out.
+// of receiving SMS messages from your Amazon Web Services account. You cannot
+// send SMS messages to a number that is opted out.
 //
 // To resume sending messages, you can opt in the number by using the OptInPhoneNumber
 // action.
@@ -161,18 +165,19 @@ func (c *SNS) CheckIfPhoneNumberIsOptedOutRequest(input *CheckIfPhoneNumberIsOpt
 // API operation CheckIfPhoneNumberIsOptedOut for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeThrottledException "Throttled"
-//   Indicates that the rate at which requests have been submitted for this action
-//   exceeds the limit for your account.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeThrottledException "Throttled"
+//     Indicates that the rate at which requests have been submitted for this action
+//     exceeds the limit for your Amazon Web Services account.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
+//
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/CheckIfPhoneNumberIsOptedOut
 func (c *SNS) CheckIfPhoneNumberIsOptedOut(input *CheckIfPhoneNumberIsOptedOutInput) (*CheckIfPhoneNumberIsOptedOutOutput, error) {
@@ -212,14 +217,13 @@ const opConfirmSubscription = "ConfirmSubscription"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ConfirmSubscriptionRequest method.
+//	req, resp := client.ConfirmSubscriptionRequest(params)
 //
-//    // Example sending a request using the ConfirmSubscriptionRequest method.
-//    req, resp := client.ConfirmSubscriptionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/ConfirmSubscription
 func (c *SNS) ConfirmSubscriptionRequest(input *ConfirmSubscriptionInput) (req *request.Request, output *ConfirmSubscriptionOutput) {
@@ -254,25 +258,26 @@ func (c *SNS) ConfirmSubscriptionRequest(input *ConfirmSubscriptionInput) (req *
 // API operation ConfirmSubscription for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeSubscriptionLimitExceededException "SubscriptionLimitExceeded"
-//   Indicates that the customer already owns the maximum allowed number of subscriptions.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
+//   - ErrCodeSubscriptionLimitExceededException "SubscriptionLimitExceeded"
+//     Indicates that the customer already owns the maximum allowed number of subscriptions.
 //
-//   * ErrCodeNotFoundException "NotFound"
-//   Indicates that the requested resource does not exist.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeNotFoundException "NotFound"
+//     Indicates that the requested resource does not exist.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeFilterPolicyLimitExceededException "FilterPolicyLimitExceeded"
-//   Indicates that the number of filter polices in your AWS account exceeds the
-//   limit. To add more filter polices, submit an SNS Limit Increase case in the
-//   AWS Support Center.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
+//
+//   - ErrCodeFilterPolicyLimitExceededException "FilterPolicyLimitExceeded"
+//     Indicates that the number of filter polices in your Amazon Web Services account
+//     exceeds the limit. To add more filter polices, submit an Amazon SNS Limit
+//     Increase case in the Amazon Web Services Support Center.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/ConfirmSubscription
 func (c *SNS) ConfirmSubscription(input *ConfirmSubscriptionInput) (*ConfirmSubscriptionOutput, error) {
@@ -312,14 +317,13 @@ const opCreatePlatformApplication = "CreatePlatformApplication"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreatePlatformApplicationRequest method.
+//	req, resp := client.CreatePlatformApplicationRequest(params)
 //
-//    // Example sending a request using the CreatePlatformApplicationRequest method.
-//    req, resp := client.CreatePlatformApplicationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/CreatePlatformApplication
 func (c *SNS) CreatePlatformApplicationRequest(input *CreatePlatformApplicationInput) (req *request.Request, output *CreatePlatformApplicationOutput) {
@@ -341,31 +345,36 @@ func (c *SNS) CreatePlatformApplicationRequest(input *CreatePlatformApplicationI
 // CreatePlatformApplication API operation for Amazon Simple Notification Service.
 //
 // Creates a platform application object for one of the supported push notification
-// services, such as APNS and FCM, to which devices and mobile apps may register.
-// You must specify PlatformPrincipal and PlatformCredential attributes when
-// using the CreatePlatformApplication action. The PlatformPrincipal is received
-// from the notification service. For APNS/APNS_SANDBOX, PlatformPrincipal is
-// "SSL certificate". For GCM, PlatformPrincipal is not applicable. For ADM,
-// PlatformPrincipal is "client id". The PlatformCredential is also received
-// from the notification service. For WNS, PlatformPrincipal is "Package Security
-// Identifier". For MPNS, PlatformPrincipal is "TLS certificate". For Baidu,
-// PlatformPrincipal is "API key".
-//
-// For APNS/APNS_SANDBOX, PlatformCredential is "private key". For GCM, PlatformCredential
-// is "API key". For ADM, PlatformCredential is "client secret". For WNS, PlatformCredential
-// is "secret key". For MPNS, PlatformCredential is "private key". For Baidu,
-// PlatformCredential is "secret key". The PlatformApplicationArn that is returned
-// when using CreatePlatformApplication is then used as an attribute for the
-// CreatePlatformEndpoint action. For more information, see Using Amazon SNS
-// Mobile Push Notifications (https://docs.aws.amazon.com/sns/latest/dg/SNSMobilePush.html).
-// For more information about obtaining the PlatformPrincipal and PlatformCredential
-// for each of the supported push notification services, see Getting Started
-// with Apple Push Notification Service (https://docs.aws.amazon.com/sns/latest/dg/mobile-push-apns.html),
-// Getting Started with Amazon Device Messaging (https://docs.aws.amazon.com/sns/latest/dg/mobile-push-adm.html),
-// Getting Started with Baidu Cloud Push (https://docs.aws.amazon.com/sns/latest/dg/mobile-push-baidu.html),
-// Getting Started with Google Cloud Messaging for Android (https://docs.aws.amazon.com/sns/latest/dg/mobile-push-gcm.html),
-// Getting Started with MPNS (https://docs.aws.amazon.com/sns/latest/dg/mobile-push-mpns.html),
-// or Getting Started with WNS (https://docs.aws.amazon.com/sns/latest/dg/mobile-push-wns.html).
+// services, such as APNS and GCM (Firebase Cloud Messaging), to which devices
+// and mobile apps may register. You must specify PlatformPrincipal and PlatformCredential
+// attributes when using the CreatePlatformApplication action.
+//
+// PlatformPrincipal and PlatformCredential are received from the notification
+// service.
+//
+//   - For ADM, PlatformPrincipal is client id and PlatformCredential is client
+//     secret.
+//
+//   - For Baidu, PlatformPrincipal is API key and PlatformCredential is secret
+//     key.
+//
+//   - For APNS and APNS_SANDBOX using certificate credentials, PlatformPrincipal
+//     is SSL certificate and PlatformCredential is private key.
+//
+//   - For APNS and APNS_SANDBOX using token credentials, PlatformPrincipal
+//     is signing key ID and PlatformCredential is signing key.
+//
+//   - For GCM (Firebase Cloud Messaging), there is no PlatformPrincipal and
+//     the PlatformCredential is API key.
+//
+//   - For MPNS, PlatformPrincipal is TLS certificate and PlatformCredential
+//     is private key.
+//
+//   - For WNS, PlatformPrincipal is Package Security Identifier and PlatformCredential
+//     is secret key.
+//
+// You can use the returned PlatformApplicationArn as an attribute for the CreatePlatformEndpoint
+// action.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -375,14 +384,15 @@ func (c *SNS) CreatePlatformApplicationRequest(input *CreatePlatformApplicationI
 // API operation CreatePlatformApplication for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
+//
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/CreatePlatformApplication
 func (c *SNS) CreatePlatformApplication(input *CreatePlatformApplicationInput) (*CreatePlatformApplicationOutput, error) {
@@ -422,14 +432,13 @@ const opCreatePlatformEndpoint = "CreatePlatformEndpoint"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreatePlatformEndpointRequest method.
+//	req, resp := client.CreatePlatformEndpointRequest(params)
 //
-//    // Example sending a request using the CreatePlatformEndpointRequest method.
-//    req, resp := client.CreatePlatformEndpointRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/CreatePlatformEndpoint
 func (c *SNS) CreatePlatformEndpointRequest(input *CreatePlatformEndpointInput) (req *request.Request, output *CreatePlatformEndpointOutput) {
@@ -451,11 +460,10 @@ func (c *SNS) CreatePlatformEndpointRequest(input *CreatePlatformEndpointInput)
 // CreatePlatformEndpoint API operation for Amazon Simple Notification Service.
 //
 // Creates an endpoint for a device and mobile app on one of the supported push
-// notification services, such as GCM and APNS. CreatePlatformEndpoint requires
-// the PlatformApplicationArn that is returned from CreatePlatformApplication.
-// The EndpointArn that is returned when using CreatePlatformEndpoint can then
-// be used by the Publish action to send a message to a mobile app or by the
-// Subscribe action for subscription to a topic. The CreatePlatformEndpoint
+// notification services, such as GCM (Firebase Cloud Messaging) and APNS. CreatePlatformEndpoint
+// requires the PlatformApplicationArn that is returned from CreatePlatformApplication.
+// You can use the returned EndpointArn to send a message to a mobile app or
+// by the Subscribe action for subscription to a topic. The CreatePlatformEndpoint
 // action is idempotent, so if the requester already owns an endpoint with the
 // same device token and attributes, that endpoint's ARN is returned without
 // creating a new endpoint. For more information, see Using Amazon SNS Mobile
@@ -473,17 +481,18 @@ func (c *SNS) CreatePlatformEndpointRequest(input *CreatePlatformEndpointInput)
 // API operation CreatePlatformEndpoint for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeNotFoundException "NotFound"
-//   Indicates that the requested resource does not exist.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
+//
+//   - ErrCodeNotFoundException "NotFound"
+//     Indicates that the requested resource does not exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/CreatePlatformEndpoint
 func (c *SNS) CreatePlatformEndpoint(input *CreatePlatformEndpointInput) (*CreatePlatformEndpointOutput, error) {
@@ -507,6 +516,115 @@ func (c *SNS) CreatePlatformEndpointWithContext(ctx aws.Context, input *CreatePl
 	return out, req.Send()
 }
 
+const opCreateSMSSandboxPhoneNumber = "CreateSMSSandboxPhoneNumber"
+
+// CreateSMSSandboxPhoneNumberRequest generates a "aws/request.Request" representing the
+// client's request for the CreateSMSSandboxPhoneNumber operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See CreateSMSSandboxPhoneNumber for more information on using the CreateSMSSandboxPhoneNumber
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the CreateSMSSandboxPhoneNumberRequest method.
+//	req, resp := client.CreateSMSSandboxPhoneNumberRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/CreateSMSSandboxPhoneNumber
+func (c *SNS) CreateSMSSandboxPhoneNumberRequest(input *CreateSMSSandboxPhoneNumberInput) (req *request.Request, output *CreateSMSSandboxPhoneNumberOutput) {
+	op := &request.Operation{
+		Name:       opCreateSMSSandboxPhoneNumber,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &CreateSMSSandboxPhoneNumberInput{}
+	}
+
+	output = &CreateSMSSandboxPhoneNumberOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(query.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// CreateSMSSandboxPhoneNumber API operation for Amazon Simple Notification Service.
+//
+// Adds a destination phone number to an Amazon Web Services account in the
+// SMS sandbox and sends a one-time password (OTP) to that phone number.
+//
+// When you start using Amazon SNS to send SMS messages, your Amazon Web Services
+// account is in the SMS sandbox. The SMS sandbox provides a safe environment
+// for you to try Amazon SNS features without risking your reputation as an
+// SMS sender. While your Amazon Web Services account is in the SMS sandbox,
+// you can use all of the features of Amazon SNS. However, you can send SMS
+// messages only to verified destination phone numbers. For more information,
+// including how to move out of the sandbox to send messages without restrictions,
+// see SMS sandbox (https://docs.aws.amazon.com/sns/latest/dg/sns-sms-sandbox.html)
+// in the Amazon SNS Developer Guide.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Simple Notification Service's
+// API operation CreateSMSSandboxPhoneNumber for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
+//
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
+//
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
+//
+//   - ErrCodeOptedOutException "OptedOut"
+//     Indicates that the specified phone number opted out of receiving SMS messages
+//     from your Amazon Web Services account. You can't send SMS messages to phone
+//     numbers that opt out.
+//
+//   - ErrCodeUserErrorException "UserError"
+//     Indicates that a request parameter does not comply with the associated constraints.
+//
+//   - ErrCodeThrottledException "Throttled"
+//     Indicates that the rate at which requests have been submitted for this action
+//     exceeds the limit for your Amazon Web Services account.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/CreateSMSSandboxPhoneNumber
+func (c *SNS) CreateSMSSandboxPhoneNumber(input *CreateSMSSandboxPhoneNumberInput) (*CreateSMSSandboxPhoneNumberOutput, error) {
+	req, out := c.CreateSMSSandboxPhoneNumberRequest(input)
+	return out, req.Send()
+}
+
+// CreateSMSSandboxPhoneNumberWithContext is the same as CreateSMSSandboxPhoneNumber with the addition of
+// the ability to pass a context and additional request options.
+//
+// See CreateSMSSandboxPhoneNumber for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *SNS) CreateSMSSandboxPhoneNumberWithContext(ctx aws.Context, input *CreateSMSSandboxPhoneNumberInput, opts ...request.Option) (*CreateSMSSandboxPhoneNumberOutput, error) {
+	req, out := c.CreateSMSSandboxPhoneNumberRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opCreateTopic = "CreateTopic"
 
 // CreateTopicRequest generates a "aws/request.Request" representing the
@@ -523,14 +641,13 @@ const opCreateTopic = "CreateTopic"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateTopicRequest method.
+//	req, resp := client.CreateTopicRequest(params)
 //
-//    // Example sending a request using the CreateTopicRequest method.
-//    req, resp := client.CreateTopicRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/CreateTopic
 func (c *SNS) CreateTopicRequest(input *CreateTopicInput) (req *request.Request, output *CreateTopicOutput) {
@@ -552,8 +669,9 @@ func (c *SNS) CreateTopicRequest(input *CreateTopicInput) (req *request.Request,
 // CreateTopic API operation for Amazon Simple Notification Service.
 //
 // Creates a topic to which notifications can be published. Users can create
-// at most 100,000 topics. For more information, see https://aws.amazon.com/sns
-// (http://aws.amazon.com/sns/). This action is idempotent, so if the requester
+// at most 100,000 standard topics (at most 1,000 FIFO topics). For more information,
+// see Creating an Amazon SNS topic (https://docs.aws.amazon.com/sns/latest/dg/sns-create-topic.html)
+// in the Amazon SNS Developer Guide. This action is idempotent, so if the requester
 // already owns a topic with the specified name, that topic's ARN is returned
 // without creating a new topic.
 //
@@ -565,36 +683,37 @@ func (c *SNS) CreateTopicRequest(input *CreateTopicInput) (req *request.Request,
 // API operation CreateTopic for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeTopicLimitExceededException "TopicLimitExceeded"
-//   Indicates that the customer already owns the maximum allowed number of topics.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeTopicLimitExceededException "TopicLimitExceeded"
+//     Indicates that the customer already owns the maximum allowed number of topics.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeInvalidSecurityException "InvalidSecurity"
-//   The credential signature isn't valid. You must use an HTTPS endpoint and
-//   sign your request using Signature Version 4.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
 //
-//   * ErrCodeTagLimitExceededException "TagLimitExceeded"
-//   Can't add more than 50 tags to a topic.
+//   - ErrCodeInvalidSecurityException "InvalidSecurity"
+//     The credential signature isn't valid. You must use an HTTPS endpoint and
+//     sign your request using Signature Version 4.
 //
-//   * ErrCodeStaleTagException "StaleTag"
-//   A tag has been added to a resource with the same ARN as a deleted resource.
-//   Wait a short while and then retry the operation.
+//   - ErrCodeTagLimitExceededException "TagLimitExceeded"
+//     Can't add more than 50 tags to a topic.
 //
-//   * ErrCodeTagPolicyException "TagPolicy"
-//   The request doesn't comply with the IAM tag policy. Correct your request
-//   and then retry it.
+//   - ErrCodeStaleTagException "StaleTag"
+//     A tag has been added to a resource with the same ARN as a deleted resource.
+//     Wait a short while and then retry the operation.
 //
-//   * ErrCodeConcurrentAccessException "ConcurrentAccess"
-//   Can't perform multiple operations on a tag simultaneously. Perform the operations
-//   sequentially.
+//   - ErrCodeTagPolicyException "TagPolicy"
+//     The request doesn't comply with the IAM tag policy. Correct your request
+//     and then retry it.
+//
+//   - ErrCodeConcurrentAccessException "ConcurrentAccess"
+//     Can't perform multiple operations on a tag simultaneously. Perform the operations
+//     sequentially.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/CreateTopic
 func (c *SNS) CreateTopic(input *CreateTopicInput) (*CreateTopicOutput, error) {
@@ -634,14 +753,13 @@ const opDeleteEndpoint = "DeleteEndpoint"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteEndpointRequest method.
+//	req, resp := client.DeleteEndpointRequest(params)
 //
-//    // Example sending a request using the DeleteEndpointRequest method.
-//    req, resp := client.DeleteEndpointRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/DeleteEndpoint
 func (c *SNS) DeleteEndpointRequest(input *DeleteEndpointInput) (req *request.Request, output *DeleteEndpointOutput) {
@@ -678,14 +796,15 @@ func (c *SNS) DeleteEndpointRequest(input *DeleteEndpointInput) (req *request.Re
 // API operation DeleteEndpoint for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
+//
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/DeleteEndpoint
 func (c *SNS) DeleteEndpoint(input *DeleteEndpointInput) (*DeleteEndpointOutput, error) {
@@ -725,14 +844,13 @@ const opDeletePlatformApplication = "DeletePlatformApplication"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeletePlatformApplicationRequest method.
+//	req, resp := client.DeletePlatformApplicationRequest(params)
 //
-//    // Example sending a request using the DeletePlatformApplicationRequest method.
-//    req, resp := client.DeletePlatformApplicationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/DeletePlatformApplication
 func (c *SNS) DeletePlatformApplicationRequest(input *DeletePlatformApplicationInput) (req *request.Request, output *DeletePlatformApplicationOutput) {
@@ -755,8 +873,8 @@ func (c *SNS) DeletePlatformApplicationRequest(input *DeletePlatformApplicationI
 // DeletePlatformApplication API operation for Amazon Simple Notification Service.
 //
 // Deletes a platform application object for one of the supported push notification
-// services, such as APNS and GCM. For more information, see Using Amazon SNS
-// Mobile Push Notifications (https://docs.aws.amazon.com/sns/latest/dg/SNSMobilePush.html).
+// services, such as APNS and GCM (Firebase Cloud Messaging). For more information,
+// see Using Amazon SNS Mobile Push Notifications (https://docs.aws.amazon.com/sns/latest/dg/SNSMobilePush.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -766,14 +884,15 @@ func (c *SNS) DeletePlatformApplicationRequest(input *DeletePlatformApplicationI
 // API operation DeletePlatformApplication for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
+//
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/DeletePlatformApplication
 func (c *SNS) DeletePlatformApplication(input *DeletePlatformApplicationInput) (*DeletePlatformApplicationOutput, error) {
@@ -797,6 +916,114 @@ func (c *SNS) DeletePlatformApplicationWithContext(ctx aws.Context, input *Delet
 	return out, req.Send()
 }
 
+const opDeleteSMSSandboxPhoneNumber = "DeleteSMSSandboxPhoneNumber"
+
+// DeleteSMSSandboxPhoneNumberRequest generates a "aws/request.Request" representing the
+// client's request for the DeleteSMSSandboxPhoneNumber operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See DeleteSMSSandboxPhoneNumber for more information on using the DeleteSMSSandboxPhoneNumber
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the DeleteSMSSandboxPhoneNumberRequest method.
+//	req, resp := client.DeleteSMSSandboxPhoneNumberRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/DeleteSMSSandboxPhoneNumber
+func (c *SNS) DeleteSMSSandboxPhoneNumberRequest(input *DeleteSMSSandboxPhoneNumberInput) (req *request.Request, output *DeleteSMSSandboxPhoneNumberOutput) {
+	op := &request.Operation{
+		Name:       opDeleteSMSSandboxPhoneNumber,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &DeleteSMSSandboxPhoneNumberInput{}
+	}
+
+	output = &DeleteSMSSandboxPhoneNumberOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(query.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// DeleteSMSSandboxPhoneNumber API operation for Amazon Simple Notification Service.
+//
+// Deletes an Amazon Web Services account's verified or pending phone number
+// from the SMS sandbox.
+//
+// When you start using Amazon SNS to send SMS messages, your Amazon Web Services
+// account is in the SMS sandbox. The SMS sandbox provides a safe environment
+// for you to try Amazon SNS features without risking your reputation as an
+// SMS sender. While your Amazon Web Services account is in the SMS sandbox,
+// you can use all of the features of Amazon SNS. However, you can send SMS
+// messages only to verified destination phone numbers. For more information,
+// including how to move out of the sandbox to send messages without restrictions,
+// see SMS sandbox (https://docs.aws.amazon.com/sns/latest/dg/sns-sms-sandbox.html)
+// in the Amazon SNS Developer Guide.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Simple Notification Service's
+// API operation DeleteSMSSandboxPhoneNumber for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
+//
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
+//
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
+//
+//   - ErrCodeResourceNotFoundException "ResourceNotFound"
+//     Can’t perform the action on the specified resource. Make sure that the
+//     resource exists.
+//
+//   - ErrCodeUserErrorException "UserError"
+//     Indicates that a request parameter does not comply with the associated constraints.
+//
+//   - ErrCodeThrottledException "Throttled"
+//     Indicates that the rate at which requests have been submitted for this action
+//     exceeds the limit for your Amazon Web Services account.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/DeleteSMSSandboxPhoneNumber
+func (c *SNS) DeleteSMSSandboxPhoneNumber(input *DeleteSMSSandboxPhoneNumberInput) (*DeleteSMSSandboxPhoneNumberOutput, error) {
+	req, out := c.DeleteSMSSandboxPhoneNumberRequest(input)
+	return out, req.Send()
+}
+
+// DeleteSMSSandboxPhoneNumberWithContext is the same as DeleteSMSSandboxPhoneNumber with the addition of
+// the ability to pass a context and additional request options.
+//
+// See DeleteSMSSandboxPhoneNumber for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *SNS) DeleteSMSSandboxPhoneNumberWithContext(ctx aws.Context, input *DeleteSMSSandboxPhoneNumberInput, opts ...request.Option) (*DeleteSMSSandboxPhoneNumberOutput, error) {
+	req, out := c.DeleteSMSSandboxPhoneNumberRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opDeleteTopic = "DeleteTopic"
 
 // DeleteTopicRequest generates a "aws/request.Request" representing the
@@ -813,14 +1040,13 @@ const opDeleteTopic = "DeleteTopic"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteTopicRequest method.
+//	req, resp := client.DeleteTopicRequest(params)
 //
-//    // Example sending a request using the DeleteTopicRequest method.
-//    req, resp := client.DeleteTopicRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/DeleteTopic
 func (c *SNS) DeleteTopicRequest(input *DeleteTopicInput) (req *request.Request, output *DeleteTopicOutput) {
@@ -855,29 +1081,30 @@ func (c *SNS) DeleteTopicRequest(input *DeleteTopicInput) (req *request.Request,
 // API operation DeleteTopic for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
+//
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
 //
-//   * ErrCodeNotFoundException "NotFound"
-//   Indicates that the requested resource does not exist.
+//   - ErrCodeNotFoundException "NotFound"
+//     Indicates that the requested resource does not exist.
 //
-//   * ErrCodeStaleTagException "StaleTag"
-//   A tag has been added to a resource with the same ARN as a deleted resource.
-//   Wait a short while and then retry the operation.
+//   - ErrCodeStaleTagException "StaleTag"
+//     A tag has been added to a resource with the same ARN as a deleted resource.
+//     Wait a short while and then retry the operation.
 //
-//   * ErrCodeTagPolicyException "TagPolicy"
-//   The request doesn't comply with the IAM tag policy. Correct your request
-//   and then retry it.
+//   - ErrCodeTagPolicyException "TagPolicy"
+//     The request doesn't comply with the IAM tag policy. Correct your request
+//     and then retry it.
 //
-//   * ErrCodeConcurrentAccessException "ConcurrentAccess"
-//   Can't perform multiple operations on a tag simultaneously. Perform the operations
-//   sequentially.
+//   - ErrCodeConcurrentAccessException "ConcurrentAccess"
+//     Can't perform multiple operations on a tag simultaneously. Perform the operations
+//     sequentially.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/DeleteTopic
 func (c *SNS) DeleteTopic(input *DeleteTopicInput) (*DeleteTopicOutput, error) {
@@ -901,6 +1128,99 @@ func (c *SNS) DeleteTopicWithContext(ctx aws.Context, input *DeleteTopicInput, o
 	return out, req.Send()
 }
 
+const opGetDataProtectionPolicy = "GetDataProtectionPolicy"
+
+// GetDataProtectionPolicyRequest generates a "aws/request.Request" representing the
+// client's request for the GetDataProtectionPolicy operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See GetDataProtectionPolicy for more information on using the GetDataProtectionPolicy
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the GetDataProtectionPolicyRequest method.
+//	req, resp := client.GetDataProtectionPolicyRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/GetDataProtectionPolicy
+func (c *SNS) GetDataProtectionPolicyRequest(input *GetDataProtectionPolicyInput) (req *request.Request, output *GetDataProtectionPolicyOutput) {
+	op := &request.Operation{
+		Name:       opGetDataProtectionPolicy,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &GetDataProtectionPolicyInput{}
+	}
+
+	output = &GetDataProtectionPolicyOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// GetDataProtectionPolicy API operation for Amazon Simple Notification Service.
+//
+// Retrieves the specified inline DataProtectionPolicy document that is stored
+// in the specified Amazon SNS topic.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Simple Notification Service's
+// API operation GetDataProtectionPolicy for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
+//
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
+//
+//   - ErrCodeNotFoundException "NotFound"
+//     Indicates that the requested resource does not exist.
+//
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
+//
+//   - ErrCodeInvalidSecurityException "InvalidSecurity"
+//     The credential signature isn't valid. You must use an HTTPS endpoint and
+//     sign your request using Signature Version 4.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/GetDataProtectionPolicy
+func (c *SNS) GetDataProtectionPolicy(input *GetDataProtectionPolicyInput) (*GetDataProtectionPolicyOutput, error) {
+	req, out := c.GetDataProtectionPolicyRequest(input)
+	return out, req.Send()
+}
+
+// GetDataProtectionPolicyWithContext is the same as GetDataProtectionPolicy with the addition of
+// the ability to pass a context and additional request options.
+//
+// See GetDataProtectionPolicy for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *SNS) GetDataProtectionPolicyWithContext(ctx aws.Context, input *GetDataProtectionPolicyInput, opts ...request.Option) (*GetDataProtectionPolicyOutput, error) {
+	req, out := c.GetDataProtectionPolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opGetEndpointAttributes = "GetEndpointAttributes"
 
 // GetEndpointAttributesRequest generates a "aws/request.Request" representing the
@@ -917,14 +1237,13 @@ const opGetEndpointAttributes = "GetEndpointAttributes"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetEndpointAttributesRequest method.
+//	req, resp := client.GetEndpointAttributesRequest(params)
 //
-//    // Example sending a request using the GetEndpointAttributesRequest method.
-//    req, resp := client.GetEndpointAttributesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/GetEndpointAttributes
 func (c *SNS) GetEndpointAttributesRequest(input *GetEndpointAttributesInput) (req *request.Request, output *GetEndpointAttributesOutput) {
@@ -946,8 +1265,8 @@ func (c *SNS) GetEndpointAttributesRequest(input *GetEndpointAttributesInput) (r
 // GetEndpointAttributes API operation for Amazon Simple Notification Service.
 //
 // Retrieves the endpoint attributes for a device on one of the supported push
-// notification services, such as GCM and APNS. For more information, see Using
-// Amazon SNS Mobile Push Notifications (https://docs.aws.amazon.com/sns/latest/dg/SNSMobilePush.html).
+// notification services, such as GCM (Firebase Cloud Messaging) and APNS. For
+// more information, see Using Amazon SNS Mobile Push Notifications (https://docs.aws.amazon.com/sns/latest/dg/SNSMobilePush.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -957,17 +1276,18 @@ func (c *SNS) GetEndpointAttributesRequest(input *GetEndpointAttributesInput) (r
 // API operation GetEndpointAttributes for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
+//
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
 //
-//   * ErrCodeNotFoundException "NotFound"
-//   Indicates that the requested resource does not exist.
+//   - ErrCodeNotFoundException "NotFound"
+//     Indicates that the requested resource does not exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/GetEndpointAttributes
 func (c *SNS) GetEndpointAttributes(input *GetEndpointAttributesInput) (*GetEndpointAttributesOutput, error) {
@@ -1007,14 +1327,13 @@ const opGetPlatformApplicationAttributes = "GetPlatformApplicationAttributes"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetPlatformApplicationAttributesRequest method.
+//	req, resp := client.GetPlatformApplicationAttributesRequest(params)
 //
-//    // Example sending a request using the GetPlatformApplicationAttributesRequest method.
-//    req, resp := client.GetPlatformApplicationAttributesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/GetPlatformApplicationAttributes
 func (c *SNS) GetPlatformApplicationAttributesRequest(input *GetPlatformApplicationAttributesInput) (req *request.Request, output *GetPlatformApplicationAttributesOutput) {
@@ -1036,8 +1355,8 @@ func (c *SNS) GetPlatformApplicationAttributesRequest(input *GetPlatformApplicat
 // GetPlatformApplicationAttributes API operation for Amazon Simple Notification Service.
 //
 // Retrieves the attributes of the platform application object for the supported
-// push notification services, such as APNS and GCM. For more information, see
-// Using Amazon SNS Mobile Push Notifications (https://docs.aws.amazon.com/sns/latest/dg/SNSMobilePush.html).
+// push notification services, such as APNS and GCM (Firebase Cloud Messaging).
+// For more information, see Using Amazon SNS Mobile Push Notifications (https://docs.aws.amazon.com/sns/latest/dg/SNSMobilePush.html).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1047,17 +1366,18 @@ func (c *SNS) GetPlatformApplicationAttributesRequest(input *GetPlatformApplicat
 // API operation GetPlatformApplicationAttributes for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
+//
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
 //
-//   * ErrCodeNotFoundException "NotFound"
-//   Indicates that the requested resource does not exist.
+//   - ErrCodeNotFoundException "NotFound"
+//     Indicates that the requested resource does not exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/GetPlatformApplicationAttributes
 func (c *SNS) GetPlatformApplicationAttributes(input *GetPlatformApplicationAttributesInput) (*GetPlatformApplicationAttributesOutput, error) {
@@ -1097,14 +1417,13 @@ const opGetSMSAttributes = "GetSMSAttributes"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetSMSAttributesRequest method.
+//	req, resp := client.GetSMSAttributesRequest(params)
 //
-//    // Example sending a request using the GetSMSAttributesRequest method.
-//    req, resp := client.GetSMSAttributesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/GetSMSAttributes
 func (c *SNS) GetSMSAttributesRequest(input *GetSMSAttributesInput) (req *request.Request, output *GetSMSAttributesOutput) {
@@ -1125,7 +1444,8 @@ func (c *SNS) GetSMSAttributesRequest(input *GetSMSAttributesInput) (req *reques
 
 // GetSMSAttributes API operation for Amazon Simple Notification Service.
 //
-// Returns the settings for sending SMS messages from your account.
+// Returns the settings for sending SMS messages from your Amazon Web Services
+// account.
 //
 // These settings are set with the SetSMSAttributes action.
 //
@@ -1137,18 +1457,19 @@ func (c *SNS) GetSMSAttributesRequest(input *GetSMSAttributesInput) (req *reques
 // API operation GetSMSAttributes for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeThrottledException "Throttled"
-//   Indicates that the rate at which requests have been submitted for this action
-//   exceeds the limit for your account.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeThrottledException "Throttled"
+//     Indicates that the rate at which requests have been submitted for this action
+//     exceeds the limit for your Amazon Web Services account.
+//
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/GetSMSAttributes
 func (c *SNS) GetSMSAttributes(input *GetSMSAttributesInput) (*GetSMSAttributesOutput, error) {
@@ -1172,139 +1493,235 @@ func (c *SNS) GetSMSAttributesWithContext(ctx aws.Context, input *GetSMSAttribut
 	return out, req.Send()
 }
 
-const opGetSubscriptionAttributes = "GetSubscriptionAttributes"
+const opGetSMSSandboxAccountStatus = "GetSMSSandboxAccountStatus"
 
-// GetSubscriptionAttributesRequest generates a "aws/request.Request" representing the
-// client's request for the GetSubscriptionAttributes operation. The "output" return
+// GetSMSSandboxAccountStatusRequest generates a "aws/request.Request" representing the
+// client's request for the GetSMSSandboxAccountStatus operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See GetSubscriptionAttributes for more information on using the GetSubscriptionAttributes
+// See GetSMSSandboxAccountStatus for more information on using the GetSMSSandboxAccountStatus
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetSMSSandboxAccountStatusRequest method.
+//	req, resp := client.GetSMSSandboxAccountStatusRequest(params)
 //
-//    // Example sending a request using the GetSubscriptionAttributesRequest method.
-//    req, resp := client.GetSubscriptionAttributesRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/GetSubscriptionAttributes
-func (c *SNS) GetSubscriptionAttributesRequest(input *GetSubscriptionAttributesInput) (req *request.Request, output *GetSubscriptionAttributesOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/GetSMSSandboxAccountStatus
+func (c *SNS) GetSMSSandboxAccountStatusRequest(input *GetSMSSandboxAccountStatusInput) (req *request.Request, output *GetSMSSandboxAccountStatusOutput) {
 	op := &request.Operation{
-		Name:       opGetSubscriptionAttributes,
+		Name:       opGetSMSSandboxAccountStatus,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &GetSubscriptionAttributesInput{}
+		input = &GetSMSSandboxAccountStatusInput{}
 	}
 
-	output = &GetSubscriptionAttributesOutput{}
+	output = &GetSMSSandboxAccountStatusOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// GetSubscriptionAttributes API operation for Amazon Simple Notification Service.
+// GetSMSSandboxAccountStatus API operation for Amazon Simple Notification Service.
 //
-// Returns all of the properties of a subscription.
+// Retrieves the SMS sandbox status for the calling Amazon Web Services account
+// in the target Amazon Web Services Region.
+//
+// When you start using Amazon SNS to send SMS messages, your Amazon Web Services
+// account is in the SMS sandbox. The SMS sandbox provides a safe environment
+// for you to try Amazon SNS features without risking your reputation as an
+// SMS sender. While your Amazon Web Services account is in the SMS sandbox,
+// you can use all of the features of Amazon SNS. However, you can send SMS
+// messages only to verified destination phone numbers. For more information,
+// including how to move out of the sandbox to send messages without restrictions,
+// see SMS sandbox (https://docs.aws.amazon.com/sns/latest/dg/sns-sms-sandbox.html)
+// in the Amazon SNS Developer Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Simple Notification Service's
-// API operation GetSubscriptionAttributes for usage and error information.
+// API operation GetSMSSandboxAccountStatus for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
 //
-//   * ErrCodeNotFoundException "NotFound"
-//   Indicates that the requested resource does not exist.
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeThrottledException "Throttled"
+//     Indicates that the rate at which requests have been submitted for this action
+//     exceeds the limit for your Amazon Web Services account.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/GetSubscriptionAttributes
-func (c *SNS) GetSubscriptionAttributes(input *GetSubscriptionAttributesInput) (*GetSubscriptionAttributesOutput, error) {
-	req, out := c.GetSubscriptionAttributesRequest(input)
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/GetSMSSandboxAccountStatus
+func (c *SNS) GetSMSSandboxAccountStatus(input *GetSMSSandboxAccountStatusInput) (*GetSMSSandboxAccountStatusOutput, error) {
+	req, out := c.GetSMSSandboxAccountStatusRequest(input)
 	return out, req.Send()
 }
 
-// GetSubscriptionAttributesWithContext is the same as GetSubscriptionAttributes with the addition of
+// GetSMSSandboxAccountStatusWithContext is the same as GetSMSSandboxAccountStatus with the addition of
 // the ability to pass a context and additional request options.
 //
-// See GetSubscriptionAttributes for details on how to use this API operation.
+// See GetSMSSandboxAccountStatus for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *SNS) GetSubscriptionAttributesWithContext(ctx aws.Context, input *GetSubscriptionAttributesInput, opts ...request.Option) (*GetSubscriptionAttributesOutput, error) {
-	req, out := c.GetSubscriptionAttributesRequest(input)
+func (c *SNS) GetSMSSandboxAccountStatusWithContext(ctx aws.Context, input *GetSMSSandboxAccountStatusInput, opts ...request.Option) (*GetSMSSandboxAccountStatusOutput, error) {
+	req, out := c.GetSMSSandboxAccountStatusRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opGetTopicAttributes = "GetTopicAttributes"
+const opGetSubscriptionAttributes = "GetSubscriptionAttributes"
 
-// GetTopicAttributesRequest generates a "aws/request.Request" representing the
-// client's request for the GetTopicAttributes operation. The "output" return
+// GetSubscriptionAttributesRequest generates a "aws/request.Request" representing the
+// client's request for the GetSubscriptionAttributes operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See GetTopicAttributes for more information on using the GetTopicAttributes
+// See GetSubscriptionAttributes for more information on using the GetSubscriptionAttributes
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetSubscriptionAttributesRequest method.
+//	req, resp := client.GetSubscriptionAttributesRequest(params)
 //
-//    // Example sending a request using the GetTopicAttributesRequest method.
-//    req, resp := client.GetTopicAttributesRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/GetTopicAttributes
-func (c *SNS) GetTopicAttributesRequest(input *GetTopicAttributesInput) (req *request.Request, output *GetTopicAttributesOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/GetSubscriptionAttributes
+func (c *SNS) GetSubscriptionAttributesRequest(input *GetSubscriptionAttributesInput) (req *request.Request, output *GetSubscriptionAttributesOutput) {
 	op := &request.Operation{
-		Name:       opGetTopicAttributes,
+		Name:       opGetSubscriptionAttributes,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &GetTopicAttributesInput{}
+		input = &GetSubscriptionAttributesInput{}
 	}
 
-	output = &GetTopicAttributesOutput{}
+	output = &GetSubscriptionAttributesOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// GetTopicAttributes API operation for Amazon Simple Notification Service.
+// GetSubscriptionAttributes API operation for Amazon Simple Notification Service.
 //
-// Returns all of the properties of a topic. Topic properties returned might
+// Returns all of the properties of a subscription.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Simple Notification Service's
+// API operation GetSubscriptionAttributes for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
+//
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
+//
+//   - ErrCodeNotFoundException "NotFound"
+//     Indicates that the requested resource does not exist.
+//
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/GetSubscriptionAttributes
+func (c *SNS) GetSubscriptionAttributes(input *GetSubscriptionAttributesInput) (*GetSubscriptionAttributesOutput, error) {
+	req, out := c.GetSubscriptionAttributesRequest(input)
+	return out, req.Send()
+}
+
+// GetSubscriptionAttributesWithContext is the same as GetSubscriptionAttributes with the addition of
+// the ability to pass a context and additional request options.
+//
+// See GetSubscriptionAttributes for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *SNS) GetSubscriptionAttributesWithContext(ctx aws.Context, input *GetSubscriptionAttributesInput, opts ...request.Option) (*GetSubscriptionAttributesOutput, error) {
+	req, out := c.GetSubscriptionAttributesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opGetTopicAttributes = "GetTopicAttributes"
+
+// GetTopicAttributesRequest generates a "aws/request.Request" representing the
+// client's request for the GetTopicAttributes operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See GetTopicAttributes for more information on using the GetTopicAttributes
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the GetTopicAttributesRequest method.
+//	req, resp := client.GetTopicAttributesRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/GetTopicAttributes
+func (c *SNS) GetTopicAttributesRequest(input *GetTopicAttributesInput) (req *request.Request, output *GetTopicAttributesOutput) {
+	op := &request.Operation{
+		Name:       opGetTopicAttributes,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &GetTopicAttributesInput{}
+	}
+
+	output = &GetTopicAttributesOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// GetTopicAttributes API operation for Amazon Simple Notification Service.
+//
+// Returns all of the properties of a topic. Topic properties returned might
 // differ based on the authorization of the user.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
@@ -1315,21 +1732,22 @@ func (c *SNS) GetTopicAttributesRequest(input *GetTopicAttributesInput) (req *re
 // API operation GetTopicAttributes for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
+//
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeNotFoundException "NotFound"
-//   Indicates that the requested resource does not exist.
+//   - ErrCodeNotFoundException "NotFound"
+//     Indicates that the requested resource does not exist.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
 //
-//   * ErrCodeInvalidSecurityException "InvalidSecurity"
-//   The credential signature isn't valid. You must use an HTTPS endpoint and
-//   sign your request using Signature Version 4.
+//   - ErrCodeInvalidSecurityException "InvalidSecurity"
+//     The credential signature isn't valid. You must use an HTTPS endpoint and
+//     sign your request using Signature Version 4.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/GetTopicAttributes
 func (c *SNS) GetTopicAttributes(input *GetTopicAttributesInput) (*GetTopicAttributesOutput, error) {
@@ -1369,14 +1787,13 @@ const opListEndpointsByPlatformApplication = "ListEndpointsByPlatformApplication
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListEndpointsByPlatformApplicationRequest method.
+//	req, resp := client.ListEndpointsByPlatformApplicationRequest(params)
 //
-//    // Example sending a request using the ListEndpointsByPlatformApplicationRequest method.
-//    req, resp := client.ListEndpointsByPlatformApplicationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/ListEndpointsByPlatformApplication
 func (c *SNS) ListEndpointsByPlatformApplicationRequest(input *ListEndpointsByPlatformApplicationInput) (req *request.Request, output *ListEndpointsByPlatformApplicationOutput) {
@@ -1404,12 +1821,13 @@ func (c *SNS) ListEndpointsByPlatformApplicationRequest(input *ListEndpointsByPl
 // ListEndpointsByPlatformApplication API operation for Amazon Simple Notification Service.
 //
 // Lists the endpoints and endpoint attributes for devices in a supported push
-// notification service, such as GCM and APNS. The results for ListEndpointsByPlatformApplication
-// are paginated and return a limited list of endpoints, up to 100. If additional
-// records are available after the first page results, then a NextToken string
-// will be returned. To receive the next page, you call ListEndpointsByPlatformApplication
-// again using the NextToken string received from the previous call. When there
-// are no more records to return, NextToken will be null. For more information,
+// notification service, such as GCM (Firebase Cloud Messaging) and APNS. The
+// results for ListEndpointsByPlatformApplication are paginated and return a
+// limited list of endpoints, up to 100. If additional records are available
+// after the first page results, then a NextToken string will be returned. To
+// receive the next page, you call ListEndpointsByPlatformApplication again
+// using the NextToken string received from the previous call. When there are
+// no more records to return, NextToken will be null. For more information,
 // see Using Amazon SNS Mobile Push Notifications (https://docs.aws.amazon.com/sns/latest/dg/SNSMobilePush.html).
 //
 // This action is throttled at 30 transactions per second (TPS).
@@ -1422,17 +1840,18 @@ func (c *SNS) ListEndpointsByPlatformApplicationRequest(input *ListEndpointsByPl
 // API operation ListEndpointsByPlatformApplication for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
+//
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
 //
-//   * ErrCodeNotFoundException "NotFound"
-//   Indicates that the requested resource does not exist.
+//   - ErrCodeNotFoundException "NotFound"
+//     Indicates that the requested resource does not exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/ListEndpointsByPlatformApplication
 func (c *SNS) ListEndpointsByPlatformApplication(input *ListEndpointsByPlatformApplicationInput) (*ListEndpointsByPlatformApplicationOutput, error) {
@@ -1464,15 +1883,14 @@ func (c *SNS) ListEndpointsByPlatformApplicationWithContext(ctx aws.Context, inp
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListEndpointsByPlatformApplication operation.
-//    pageNum := 0
-//    err := client.ListEndpointsByPlatformApplicationPages(params,
-//        func(page *sns.ListEndpointsByPlatformApplicationOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListEndpointsByPlatformApplication operation.
+//	pageNum := 0
+//	err := client.ListEndpointsByPlatformApplicationPages(params,
+//	    func(page *sns.ListEndpointsByPlatformApplicationOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *SNS) ListEndpointsByPlatformApplicationPages(input *ListEndpointsByPlatformApplicationInput, fn func(*ListEndpointsByPlatformApplicationOutput, bool) bool) error {
 	return c.ListEndpointsByPlatformApplicationPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1499,10 +1917,164 @@ func (c *SNS) ListEndpointsByPlatformApplicationPagesWithContext(ctx aws.Context
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListEndpointsByPlatformApplicationOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListEndpointsByPlatformApplicationOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListOriginationNumbers = "ListOriginationNumbers"
+
+// ListOriginationNumbersRequest generates a "aws/request.Request" representing the
+// client's request for the ListOriginationNumbers operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListOriginationNumbers for more information on using the ListOriginationNumbers
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListOriginationNumbersRequest method.
+//	req, resp := client.ListOriginationNumbersRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/ListOriginationNumbers
+func (c *SNS) ListOriginationNumbersRequest(input *ListOriginationNumbersInput) (req *request.Request, output *ListOriginationNumbersOutput) {
+	op := &request.Operation{
+		Name:       opListOriginationNumbers,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "MaxResults",
+			TruncationToken: "",
+		},
+	}
+
+	if input == nil {
+		input = &ListOriginationNumbersInput{}
+	}
+
+	output = &ListOriginationNumbersOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListOriginationNumbers API operation for Amazon Simple Notification Service.
+//
+// Lists the calling Amazon Web Services account's dedicated origination numbers
+// and their metadata. For more information about origination numbers, see Origination
+// numbers (https://docs.aws.amazon.com/sns/latest/dg/channels-sms-originating-identities-origination-numbers.html)
+// in the Amazon SNS Developer Guide.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Simple Notification Service's
+// API operation ListOriginationNumbers for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
+//
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
+//
+//   - ErrCodeThrottledException "Throttled"
+//     Indicates that the rate at which requests have been submitted for this action
+//     exceeds the limit for your Amazon Web Services account.
+//
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
+//
+//   - ErrCodeValidationException "ValidationException"
+//     Indicates that a parameter in the request is invalid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/ListOriginationNumbers
+func (c *SNS) ListOriginationNumbers(input *ListOriginationNumbersInput) (*ListOriginationNumbersOutput, error) {
+	req, out := c.ListOriginationNumbersRequest(input)
+	return out, req.Send()
+}
+
+// ListOriginationNumbersWithContext is the same as ListOriginationNumbers with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListOriginationNumbers for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *SNS) ListOriginationNumbersWithContext(ctx aws.Context, input *ListOriginationNumbersInput, opts ...request.Option) (*ListOriginationNumbersOutput, error) {
+	req, out := c.ListOriginationNumbersRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+// ListOriginationNumbersPages iterates over the pages of a ListOriginationNumbers operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListOriginationNumbers method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListOriginationNumbers operation.
+//	pageNum := 0
+//	err := client.ListOriginationNumbersPages(params,
+//	    func(page *sns.ListOriginationNumbersOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *SNS) ListOriginationNumbersPages(input *ListOriginationNumbersInput, fn func(*ListOriginationNumbersOutput, bool) bool) error {
+	return c.ListOriginationNumbersPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListOriginationNumbersPagesWithContext same as ListOriginationNumbersPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *SNS) ListOriginationNumbersPagesWithContext(ctx aws.Context, input *ListOriginationNumbersInput, fn func(*ListOriginationNumbersOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListOriginationNumbersInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListOriginationNumbersRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListOriginationNumbersOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -1522,14 +2094,13 @@ const opListPhoneNumbersOptedOut = "ListPhoneNumbersOptedOut"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListPhoneNumbersOptedOutRequest method.
+//	req, resp := client.ListPhoneNumbersOptedOutRequest(params)
 //
-//    // Example sending a request using the ListPhoneNumbersOptedOutRequest method.
-//    req, resp := client.ListPhoneNumbersOptedOutRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/ListPhoneNumbersOptedOut
 func (c *SNS) ListPhoneNumbersOptedOutRequest(input *ListPhoneNumbersOptedOutInput) (req *request.Request, output *ListPhoneNumbersOptedOutOutput) {
@@ -1537,6 +2108,12 @@ func (c *SNS) ListPhoneNumbersOptedOutRequest(input *ListPhoneNumbersOptedOutInp
 		Name:       opListPhoneNumbersOptedOut,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"nextToken"},
+			OutputTokens:    []string{"nextToken"},
+			LimitToken:      "",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
@@ -1568,18 +2145,19 @@ func (c *SNS) ListPhoneNumbersOptedOutRequest(input *ListPhoneNumbersOptedOutInp
 // API operation ListPhoneNumbersOptedOut for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeThrottledException "Throttled"
-//   Indicates that the rate at which requests have been submitted for this action
-//   exceeds the limit for your account.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeThrottledException "Throttled"
+//     Indicates that the rate at which requests have been submitted for this action
+//     exceeds the limit for your Amazon Web Services account.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
+//
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/ListPhoneNumbersOptedOut
 func (c *SNS) ListPhoneNumbersOptedOut(input *ListPhoneNumbersOptedOutInput) (*ListPhoneNumbersOptedOutOutput, error) {
@@ -1603,6 +2181,57 @@ func (c *SNS) ListPhoneNumbersOptedOutWithContext(ctx aws.Context, input *ListPh
 	return out, req.Send()
 }
 
+// ListPhoneNumbersOptedOutPages iterates over the pages of a ListPhoneNumbersOptedOut operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListPhoneNumbersOptedOut method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListPhoneNumbersOptedOut operation.
+//	pageNum := 0
+//	err := client.ListPhoneNumbersOptedOutPages(params,
+//	    func(page *sns.ListPhoneNumbersOptedOutOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *SNS) ListPhoneNumbersOptedOutPages(input *ListPhoneNumbersOptedOutInput, fn func(*ListPhoneNumbersOptedOutOutput, bool) bool) error {
+	return c.ListPhoneNumbersOptedOutPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListPhoneNumbersOptedOutPagesWithContext same as ListPhoneNumbersOptedOutPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *SNS) ListPhoneNumbersOptedOutPagesWithContext(ctx aws.Context, input *ListPhoneNumbersOptedOutInput, fn func(*ListPhoneNumbersOptedOutOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListPhoneNumbersOptedOutInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListPhoneNumbersOptedOutRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListPhoneNumbersOptedOutOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
 const opListPlatformApplications = "ListPlatformApplications"
 
 // ListPlatformApplicationsRequest generates a "aws/request.Request" representing the
@@ -1619,14 +2248,13 @@ const opListPlatformApplications = "ListPlatformApplications"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListPlatformApplicationsRequest method.
+//	req, resp := client.ListPlatformApplicationsRequest(params)
 //
-//    // Example sending a request using the ListPlatformApplicationsRequest method.
-//    req, resp := client.ListPlatformApplicationsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/ListPlatformApplications
 func (c *SNS) ListPlatformApplicationsRequest(input *ListPlatformApplicationsInput) (req *request.Request, output *ListPlatformApplicationsOutput) {
@@ -1654,13 +2282,13 @@ func (c *SNS) ListPlatformApplicationsRequest(input *ListPlatformApplicationsInp
 // ListPlatformApplications API operation for Amazon Simple Notification Service.
 //
 // Lists the platform application objects for the supported push notification
-// services, such as APNS and GCM. The results for ListPlatformApplications
-// are paginated and return a limited list of applications, up to 100. If additional
-// records are available after the first page results, then a NextToken string
-// will be returned. To receive the next page, you call ListPlatformApplications
-// using the NextToken string received from the previous call. When there are
-// no more records to return, NextToken will be null. For more information,
-// see Using Amazon SNS Mobile Push Notifications (https://docs.aws.amazon.com/sns/latest/dg/SNSMobilePush.html).
+// services, such as APNS and GCM (Firebase Cloud Messaging). The results for
+// ListPlatformApplications are paginated and return a limited list of applications,
+// up to 100. If additional records are available after the first page results,
+// then a NextToken string will be returned. To receive the next page, you call
+// ListPlatformApplications using the NextToken string received from the previous
+// call. When there are no more records to return, NextToken will be null. For
+// more information, see Using Amazon SNS Mobile Push Notifications (https://docs.aws.amazon.com/sns/latest/dg/SNSMobilePush.html).
 //
 // This action is throttled at 15 transactions per second (TPS).
 //
@@ -1672,14 +2300,15 @@ func (c *SNS) ListPlatformApplicationsRequest(input *ListPlatformApplicationsInp
 // API operation ListPlatformApplications for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
+//
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/ListPlatformApplications
 func (c *SNS) ListPlatformApplications(input *ListPlatformApplicationsInput) (*ListPlatformApplicationsOutput, error) {
@@ -1711,15 +2340,14 @@ func (c *SNS) ListPlatformApplicationsWithContext(ctx aws.Context, input *ListPl
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListPlatformApplications operation.
-//    pageNum := 0
-//    err := client.ListPlatformApplicationsPages(params,
-//        func(page *sns.ListPlatformApplicationsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListPlatformApplications operation.
+//	pageNum := 0
+//	err := client.ListPlatformApplicationsPages(params,
+//	    func(page *sns.ListPlatformApplicationsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *SNS) ListPlatformApplicationsPages(input *ListPlatformApplicationsInput, fn func(*ListPlatformApplicationsOutput, bool) bool) error {
 	return c.ListPlatformApplicationsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1746,127 +2374,289 @@ func (c *SNS) ListPlatformApplicationsPagesWithContext(ctx aws.Context, input *L
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListPlatformApplicationsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListPlatformApplicationsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
-const opListSubscriptions = "ListSubscriptions"
+const opListSMSSandboxPhoneNumbers = "ListSMSSandboxPhoneNumbers"
 
-// ListSubscriptionsRequest generates a "aws/request.Request" representing the
-// client's request for the ListSubscriptions operation. The "output" return
+// ListSMSSandboxPhoneNumbersRequest generates a "aws/request.Request" representing the
+// client's request for the ListSMSSandboxPhoneNumbers operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See ListSubscriptions for more information on using the ListSubscriptions
+// See ListSMSSandboxPhoneNumbers for more information on using the ListSMSSandboxPhoneNumbers
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListSMSSandboxPhoneNumbersRequest method.
+//	req, resp := client.ListSMSSandboxPhoneNumbersRequest(params)
 //
-//    // Example sending a request using the ListSubscriptionsRequest method.
-//    req, resp := client.ListSubscriptionsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/ListSubscriptions
-func (c *SNS) ListSubscriptionsRequest(input *ListSubscriptionsInput) (req *request.Request, output *ListSubscriptionsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/ListSMSSandboxPhoneNumbers
+func (c *SNS) ListSMSSandboxPhoneNumbersRequest(input *ListSMSSandboxPhoneNumbersInput) (req *request.Request, output *ListSMSSandboxPhoneNumbersOutput) {
 	op := &request.Operation{
-		Name:       opListSubscriptions,
+		Name:       opListSMSSandboxPhoneNumbers,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 		Paginator: &request.Paginator{
 			InputTokens:     []string{"NextToken"},
 			OutputTokens:    []string{"NextToken"},
-			LimitToken:      "",
+			LimitToken:      "MaxResults",
 			TruncationToken: "",
 		},
 	}
 
 	if input == nil {
-		input = &ListSubscriptionsInput{}
+		input = &ListSMSSandboxPhoneNumbersInput{}
 	}
 
-	output = &ListSubscriptionsOutput{}
+	output = &ListSMSSandboxPhoneNumbersOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// ListSubscriptions API operation for Amazon Simple Notification Service.
+// ListSMSSandboxPhoneNumbers API operation for Amazon Simple Notification Service.
 //
-// Returns a list of the requester's subscriptions. Each call returns a limited
-// list of subscriptions, up to 100. If there are more subscriptions, a NextToken
-// is also returned. Use the NextToken parameter in a new ListSubscriptions
-// call to get further results.
+// Lists the calling Amazon Web Services account's current verified and pending
+// destination phone numbers in the SMS sandbox.
 //
-// This action is throttled at 30 transactions per second (TPS).
+// When you start using Amazon SNS to send SMS messages, your Amazon Web Services
+// account is in the SMS sandbox. The SMS sandbox provides a safe environment
+// for you to try Amazon SNS features without risking your reputation as an
+// SMS sender. While your Amazon Web Services account is in the SMS sandbox,
+// you can use all of the features of Amazon SNS. However, you can send SMS
+// messages only to verified destination phone numbers. For more information,
+// including how to move out of the sandbox to send messages without restrictions,
+// see SMS sandbox (https://docs.aws.amazon.com/sns/latest/dg/sns-sms-sandbox.html)
+// in the Amazon SNS Developer Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Simple Notification Service's
-// API operation ListSubscriptions for usage and error information.
+// API operation ListSMSSandboxPhoneNumbers for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/ListSubscriptions
-func (c *SNS) ListSubscriptions(input *ListSubscriptionsInput) (*ListSubscriptionsOutput, error) {
-	req, out := c.ListSubscriptionsRequest(input)
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
+//
+//   - ErrCodeResourceNotFoundException "ResourceNotFound"
+//     Can’t perform the action on the specified resource. Make sure that the
+//     resource exists.
+//
+//   - ErrCodeThrottledException "Throttled"
+//     Indicates that the rate at which requests have been submitted for this action
+//     exceeds the limit for your Amazon Web Services account.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/ListSMSSandboxPhoneNumbers
+func (c *SNS) ListSMSSandboxPhoneNumbers(input *ListSMSSandboxPhoneNumbersInput) (*ListSMSSandboxPhoneNumbersOutput, error) {
+	req, out := c.ListSMSSandboxPhoneNumbersRequest(input)
 	return out, req.Send()
 }
 
-// ListSubscriptionsWithContext is the same as ListSubscriptions with the addition of
+// ListSMSSandboxPhoneNumbersWithContext is the same as ListSMSSandboxPhoneNumbers with the addition of
 // the ability to pass a context and additional request options.
 //
-// See ListSubscriptions for details on how to use this API operation.
+// See ListSMSSandboxPhoneNumbers for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *SNS) ListSubscriptionsWithContext(ctx aws.Context, input *ListSubscriptionsInput, opts ...request.Option) (*ListSubscriptionsOutput, error) {
-	req, out := c.ListSubscriptionsRequest(input)
+func (c *SNS) ListSMSSandboxPhoneNumbersWithContext(ctx aws.Context, input *ListSMSSandboxPhoneNumbersInput, opts ...request.Option) (*ListSMSSandboxPhoneNumbersOutput, error) {
+	req, out := c.ListSMSSandboxPhoneNumbersRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-// ListSubscriptionsPages iterates over the pages of a ListSubscriptions operation,
+// ListSMSSandboxPhoneNumbersPages iterates over the pages of a ListSMSSandboxPhoneNumbers operation,
 // calling the "fn" function with the response data for each page. To stop
 // iterating, return false from the fn function.
 //
-// See ListSubscriptions method for more information on how to use this operation.
+// See ListSMSSandboxPhoneNumbers method for more information on how to use this operation.
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListSubscriptions operation.
-//    pageNum := 0
-//    err := client.ListSubscriptionsPages(params,
-//        func(page *sns.ListSubscriptionsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
-func (c *SNS) ListSubscriptionsPages(input *ListSubscriptionsInput, fn func(*ListSubscriptionsOutput, bool) bool) error {
+//	// Example iterating over at most 3 pages of a ListSMSSandboxPhoneNumbers operation.
+//	pageNum := 0
+//	err := client.ListSMSSandboxPhoneNumbersPages(params,
+//	    func(page *sns.ListSMSSandboxPhoneNumbersOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *SNS) ListSMSSandboxPhoneNumbersPages(input *ListSMSSandboxPhoneNumbersInput, fn func(*ListSMSSandboxPhoneNumbersOutput, bool) bool) error {
+	return c.ListSMSSandboxPhoneNumbersPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListSMSSandboxPhoneNumbersPagesWithContext same as ListSMSSandboxPhoneNumbersPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *SNS) ListSMSSandboxPhoneNumbersPagesWithContext(ctx aws.Context, input *ListSMSSandboxPhoneNumbersInput, fn func(*ListSMSSandboxPhoneNumbersOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListSMSSandboxPhoneNumbersInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListSMSSandboxPhoneNumbersRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListSMSSandboxPhoneNumbersOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListSubscriptions = "ListSubscriptions"
+
+// ListSubscriptionsRequest generates a "aws/request.Request" representing the
+// client's request for the ListSubscriptions operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListSubscriptions for more information on using the ListSubscriptions
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListSubscriptionsRequest method.
+//	req, resp := client.ListSubscriptionsRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/ListSubscriptions
+func (c *SNS) ListSubscriptionsRequest(input *ListSubscriptionsInput) (req *request.Request, output *ListSubscriptionsOutput) {
+	op := &request.Operation{
+		Name:       opListSubscriptions,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "",
+			TruncationToken: "",
+		},
+	}
+
+	if input == nil {
+		input = &ListSubscriptionsInput{}
+	}
+
+	output = &ListSubscriptionsOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListSubscriptions API operation for Amazon Simple Notification Service.
+//
+// Returns a list of the requester's subscriptions. Each call returns a limited
+// list of subscriptions, up to 100. If there are more subscriptions, a NextToken
+// is also returned. Use the NextToken parameter in a new ListSubscriptions
+// call to get further results.
+//
+// This action is throttled at 30 transactions per second (TPS).
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Simple Notification Service's
+// API operation ListSubscriptions for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
+//
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
+//
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/ListSubscriptions
+func (c *SNS) ListSubscriptions(input *ListSubscriptionsInput) (*ListSubscriptionsOutput, error) {
+	req, out := c.ListSubscriptionsRequest(input)
+	return out, req.Send()
+}
+
+// ListSubscriptionsWithContext is the same as ListSubscriptions with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListSubscriptions for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *SNS) ListSubscriptionsWithContext(ctx aws.Context, input *ListSubscriptionsInput, opts ...request.Option) (*ListSubscriptionsOutput, error) {
+	req, out := c.ListSubscriptionsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+// ListSubscriptionsPages iterates over the pages of a ListSubscriptions operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListSubscriptions method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListSubscriptions operation.
+//	pageNum := 0
+//	err := client.ListSubscriptionsPages(params,
+//	    func(page *sns.ListSubscriptionsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *SNS) ListSubscriptionsPages(input *ListSubscriptionsInput, fn func(*ListSubscriptionsOutput, bool) bool) error {
 	return c.ListSubscriptionsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
 
@@ -1892,10 +2682,12 @@ func (c *SNS) ListSubscriptionsPagesWithContext(ctx aws.Context, input *ListSubs
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListSubscriptionsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListSubscriptionsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -1915,14 +2707,13 @@ const opListSubscriptionsByTopic = "ListSubscriptionsByTopic"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListSubscriptionsByTopicRequest method.
+//	req, resp := client.ListSubscriptionsByTopicRequest(params)
 //
-//    // Example sending a request using the ListSubscriptionsByTopicRequest method.
-//    req, resp := client.ListSubscriptionsByTopicRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/ListSubscriptionsByTopic
 func (c *SNS) ListSubscriptionsByTopicRequest(input *ListSubscriptionsByTopicInput) (req *request.Request, output *ListSubscriptionsByTopicOutput) {
@@ -1964,17 +2755,18 @@ func (c *SNS) ListSubscriptionsByTopicRequest(input *ListSubscriptionsByTopicInp
 // API operation ListSubscriptionsByTopic for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeNotFoundException "NotFound"
-//   Indicates that the requested resource does not exist.
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeNotFoundException "NotFound"
+//     Indicates that the requested resource does not exist.
+//
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/ListSubscriptionsByTopic
 func (c *SNS) ListSubscriptionsByTopic(input *ListSubscriptionsByTopicInput) (*ListSubscriptionsByTopicOutput, error) {
@@ -2006,15 +2798,14 @@ func (c *SNS) ListSubscriptionsByTopicWithContext(ctx aws.Context, input *ListSu
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListSubscriptionsByTopic operation.
-//    pageNum := 0
-//    err := client.ListSubscriptionsByTopicPages(params,
-//        func(page *sns.ListSubscriptionsByTopicOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListSubscriptionsByTopic operation.
+//	pageNum := 0
+//	err := client.ListSubscriptionsByTopicPages(params,
+//	    func(page *sns.ListSubscriptionsByTopicOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *SNS) ListSubscriptionsByTopicPages(input *ListSubscriptionsByTopicInput, fn func(*ListSubscriptionsByTopicOutput, bool) bool) error {
 	return c.ListSubscriptionsByTopicPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -2041,10 +2832,12 @@ func (c *SNS) ListSubscriptionsByTopicPagesWithContext(ctx aws.Context, input *L
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListSubscriptionsByTopicOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListSubscriptionsByTopicOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -2064,14 +2857,13 @@ const opListTagsForResource = "ListTagsForResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTagsForResourceRequest method.
+//	req, resp := client.ListTagsForResourceRequest(params)
 //
-//    // Example sending a request using the ListTagsForResourceRequest method.
-//    req, resp := client.ListTagsForResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/ListTagsForResource
 func (c *SNS) ListTagsForResourceRequest(input *ListTagsForResourceInput) (req *request.Request, output *ListTagsForResourceOutput) {
@@ -2104,22 +2896,24 @@ func (c *SNS) ListTagsForResourceRequest(input *ListTagsForResourceInput) (req *
 // API operation ListTagsForResource for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFound"
-//   Can't tag resource. Verify that the topic exists.
 //
-//   * ErrCodeTagPolicyException "TagPolicy"
-//   The request doesn't comply with the IAM tag policy. Correct your request
-//   and then retry it.
+//   - ErrCodeResourceNotFoundException "ResourceNotFound"
+//     Can’t perform the action on the specified resource. Make sure that the
+//     resource exists.
+//
+//   - ErrCodeTagPolicyException "TagPolicy"
+//     The request doesn't comply with the IAM tag policy. Correct your request
+//     and then retry it.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
 //
-//   * ErrCodeConcurrentAccessException "ConcurrentAccess"
-//   Can't perform multiple operations on a tag simultaneously. Perform the operations
-//   sequentially.
+//   - ErrCodeConcurrentAccessException "ConcurrentAccess"
+//     Can't perform multiple operations on a tag simultaneously. Perform the operations
+//     sequentially.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/ListTagsForResource
 func (c *SNS) ListTagsForResource(input *ListTagsForResourceInput) (*ListTagsForResourceOutput, error) {
@@ -2159,14 +2953,13 @@ const opListTopics = "ListTopics"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTopicsRequest method.
+//	req, resp := client.ListTopicsRequest(params)
 //
-//    // Example sending a request using the ListTopicsRequest method.
-//    req, resp := client.ListTopicsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/ListTopics
 func (c *SNS) ListTopicsRequest(input *ListTopicsInput) (req *request.Request, output *ListTopicsOutput) {
@@ -2207,14 +3000,15 @@ func (c *SNS) ListTopicsRequest(input *ListTopicsInput) (req *request.Request, o
 // API operation ListTopics for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
+//
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/ListTopics
 func (c *SNS) ListTopics(input *ListTopicsInput) (*ListTopicsOutput, error) {
@@ -2246,15 +3040,14 @@ func (c *SNS) ListTopicsWithContext(ctx aws.Context, input *ListTopicsInput, opt
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListTopics operation.
-//    pageNum := 0
-//    err := client.ListTopicsPages(params,
-//        func(page *sns.ListTopicsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a ListTopics operation.
+//	pageNum := 0
+//	err := client.ListTopicsPages(params,
+//	    func(page *sns.ListTopicsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *SNS) ListTopicsPages(input *ListTopicsInput, fn func(*ListTopicsOutput, bool) bool) error {
 	return c.ListTopicsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -2281,10 +3074,12 @@ func (c *SNS) ListTopicsPagesWithContext(ctx aws.Context, input *ListTopicsInput
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListTopicsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListTopicsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -2304,14 +3099,13 @@ const opOptInPhoneNumber = "OptInPhoneNumber"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the OptInPhoneNumberRequest method.
+//	req, resp := client.OptInPhoneNumberRequest(params)
 //
-//    // Example sending a request using the OptInPhoneNumberRequest method.
-//    req, resp := client.OptInPhoneNumberRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/OptInPhoneNumber
 func (c *SNS) OptInPhoneNumberRequest(input *OptInPhoneNumberInput) (req *request.Request, output *OptInPhoneNumberOutput) {
@@ -2346,18 +3140,19 @@ func (c *SNS) OptInPhoneNumberRequest(input *OptInPhoneNumberInput) (req *reques
 // API operation OptInPhoneNumber for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeThrottledException "Throttled"
-//   Indicates that the rate at which requests have been submitted for this action
-//   exceeds the limit for your account.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeThrottledException "Throttled"
+//     Indicates that the rate at which requests have been submitted for this action
+//     exceeds the limit for your Amazon Web Services account.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
+//
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/OptInPhoneNumber
 func (c *SNS) OptInPhoneNumber(input *OptInPhoneNumberInput) (*OptInPhoneNumberOutput, error) {
@@ -2397,14 +3192,13 @@ const opPublish = "Publish"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PublishRequest method.
+//	req, resp := client.PublishRequest(params)
 //
-//    // Example sending a request using the PublishRequest method.
-//    req, resp := client.PublishRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/Publish
 func (c *SNS) PublishRequest(input *PublishInput) (req *request.Request, output *PublishOutput) {
@@ -2425,24 +3219,28 @@ func (c *SNS) PublishRequest(input *PublishInput) (req *request.Request, output
 
 // Publish API operation for Amazon Simple Notification Service.
 //
-// Sends a message to an Amazon SNS topic or sends a text message (SMS message)
-// directly to a phone number.
+// Sends a message to an Amazon SNS topic, a text message (SMS message) directly
+// to a phone number, or a message to a mobile platform endpoint (when you specify
+// the TargetArn).
 //
 // If you send a message to a topic, Amazon SNS delivers the message to each
 // endpoint that is subscribed to the topic. The format of the message depends
 // on the notification protocol for each subscribed endpoint.
 //
-// When a messageId is returned, the message has been saved and Amazon SNS will
-// attempt to deliver it shortly.
+// When a messageId is returned, the message is saved and Amazon SNS immediately
+// delivers it to subscribers.
 //
-// To use the Publish action for sending a message to a mobile endpoint, such
-// as an app on a Kindle device or mobile phone, you must specify the EndpointArn
+// To use the Publish action for publishing a message to a mobile endpoint,
+// such as an app on a Kindle device or mobile phone, you must specify the EndpointArn
 // for the TargetArn parameter. The EndpointArn is returned when making a call
 // with the CreatePlatformEndpoint action.
 //
 // For more information about formatting messages, see Send Custom Platform-Specific
 // Payloads in Messages to Mobile Devices (https://docs.aws.amazon.com/sns/latest/dg/mobile-push-send-custommessage.html).
 //
+// You can publish messages only to topics and endpoints in the same Amazon
+// Web Services Region.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -2451,56 +3249,60 @@ func (c *SNS) PublishRequest(input *PublishInput) (req *request.Request, output
 // API operation Publish for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInvalidParameterValueException "ParameterValueInvalid"
-//   Indicates that a request parameter does not comply with the associated constraints.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
+//
+//   - ErrCodeInvalidParameterValueException "ParameterValueInvalid"
+//     Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeNotFoundException "NotFound"
-//   Indicates that the requested resource does not exist.
+//   - ErrCodeNotFoundException "NotFound"
+//     Indicates that the requested resource does not exist.
 //
-//   * ErrCodeEndpointDisabledException "EndpointDisabled"
-//   Exception error indicating endpoint disabled.
+//   - ErrCodeEndpointDisabledException "EndpointDisabled"
+//     Exception error indicating endpoint disabled.
 //
-//   * ErrCodePlatformApplicationDisabledException "PlatformApplicationDisabled"
-//   Exception error indicating platform application disabled.
+//   - ErrCodePlatformApplicationDisabledException "PlatformApplicationDisabled"
+//     Exception error indicating platform application disabled.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
 //
-//   * ErrCodeKMSDisabledException "KMSDisabled"
-//   The request was rejected because the specified customer master key (CMK)
-//   isn't enabled.
+//   - ErrCodeKMSDisabledException "KMSDisabled"
+//     The request was rejected because the specified customer master key (CMK)
+//     isn't enabled.
 //
-//   * ErrCodeKMSInvalidStateException "KMSInvalidState"
-//   The request was rejected because the state of the specified resource isn't
-//   valid for this request. For more information, see How Key State Affects Use
-//   of a Customer Master Key (https://docs.aws.amazon.com/kms/latest/developerguide/key-state.html)
-//   in the AWS Key Management Service Developer Guide.
+//   - ErrCodeKMSInvalidStateException "KMSInvalidState"
+//     The request was rejected because the state of the specified resource isn't
+//     valid for this request. For more information, see How Key State Affects Use
+//     of a Customer Master Key (https://docs.aws.amazon.com/kms/latest/developerguide/key-state.html)
+//     in the Key Management Service Developer Guide.
 //
-//   * ErrCodeKMSNotFoundException "KMSNotFound"
-//   The request was rejected because the specified entity or resource can't be
-//   found.
+//   - ErrCodeKMSNotFoundException "KMSNotFound"
+//     The request was rejected because the specified entity or resource can't be
+//     found.
 //
-//   * ErrCodeKMSOptInRequired "KMSOptInRequired"
-//   The AWS access key ID needs a subscription for the service.
+//   - ErrCodeKMSOptInRequired "KMSOptInRequired"
+//     The Amazon Web Services access key ID needs a subscription for the service.
 //
-//   * ErrCodeKMSThrottlingException "KMSThrottling"
-//   The request was denied due to request throttling. For more information about
-//   throttling, see Limits (https://docs.aws.amazon.com/kms/latest/developerguide/limits.html#requests-per-second)
-//   in the AWS Key Management Service Developer Guide.
+//   - ErrCodeKMSThrottlingException "KMSThrottling"
+//     The request was denied due to request throttling. For more information about
+//     throttling, see Limits (https://docs.aws.amazon.com/kms/latest/developerguide/limits.html#requests-per-second)
+//     in the Key Management Service Developer Guide.
 //
-//   * ErrCodeKMSAccessDeniedException "KMSAccessDenied"
-//   The ciphertext references a key that doesn't exist or that you don't have
-//   access to.
+//   - ErrCodeKMSAccessDeniedException "KMSAccessDenied"
+//     The ciphertext references a key that doesn't exist or that you don't have
+//     access to.
 //
-//   * ErrCodeInvalidSecurityException "InvalidSecurity"
-//   The credential signature isn't valid. You must use an HTTPS endpoint and
-//   sign your request using Signature Version 4.
+//   - ErrCodeInvalidSecurityException "InvalidSecurity"
+//     The credential signature isn't valid. You must use an HTTPS endpoint and
+//     sign your request using Signature Version 4.
+//
+//   - ErrCodeValidationException "ValidationException"
+//     Indicates that a parameter in the request is invalid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/Publish
 func (c *SNS) Publish(input *PublishInput) (*PublishOutput, error) {
@@ -2524,303 +3326,572 @@ func (c *SNS) PublishWithContext(ctx aws.Context, input *PublishInput, opts ...r
 	return out, req.Send()
 }
 
-const opRemovePermission = "RemovePermission"
+const opPublishBatch = "PublishBatch"
 
-// RemovePermissionRequest generates a "aws/request.Request" representing the
-// client's request for the RemovePermission operation. The "output" return
+// PublishBatchRequest generates a "aws/request.Request" representing the
+// client's request for the PublishBatch operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See RemovePermission for more information on using the RemovePermission
+// See PublishBatch for more information on using the PublishBatch
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PublishBatchRequest method.
+//	req, resp := client.PublishBatchRequest(params)
 //
-//    // Example sending a request using the RemovePermissionRequest method.
-//    req, resp := client.RemovePermissionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/RemovePermission
-func (c *SNS) RemovePermissionRequest(input *RemovePermissionInput) (req *request.Request, output *RemovePermissionOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/PublishBatch
+func (c *SNS) PublishBatchRequest(input *PublishBatchInput) (req *request.Request, output *PublishBatchOutput) {
 	op := &request.Operation{
-		Name:       opRemovePermission,
+		Name:       opPublishBatch,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &RemovePermissionInput{}
+		input = &PublishBatchInput{}
 	}
 
-	output = &RemovePermissionOutput{}
+	output = &PublishBatchOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(query.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// RemovePermission API operation for Amazon Simple Notification Service.
+// PublishBatch API operation for Amazon Simple Notification Service.
 //
-// Removes a statement from a topic's access control policy.
+// Publishes up to ten messages to the specified topic. This is a batch version
+// of Publish. For FIFO topics, multiple messages within a single batch are
+// published in the order they are sent, and messages are deduplicated within
+// the batch and across batches for 5 minutes.
+//
+// The result of publishing each message is reported individually in the response.
+// Because the batch request can result in a combination of successful and unsuccessful
+// actions, you should check for batch errors even when the call returns an
+// HTTP status code of 200.
+//
+// The maximum allowed individual message size and the maximum total payload
+// size (the sum of the individual lengths of all of the batched messages) are
+// both 256 KB (262,144 bytes).
+//
+// Some actions take lists of parameters. These lists are specified using the
+// param.n notation. Values of n are integers starting from 1. For example,
+// a parameter list with two elements looks like this:
+//
+// &AttributeName.1=first
+//
+// &AttributeName.2=second
+//
+// If you send a batch message to a topic, Amazon SNS publishes the batch message
+// to each endpoint that is subscribed to the topic. The format of the batch
+// message depends on the notification protocol for each subscribed endpoint.
+//
+// When a messageId is returned, the batch message is saved and Amazon SNS immediately
+// delivers the message to subscribers.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Simple Notification Service's
-// API operation RemovePermission for usage and error information.
+// API operation PublishBatch for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeInvalidParameterValueException "ParameterValueInvalid"
+//     Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeNotFoundException "NotFound"
-//   Indicates that the requested resource does not exist.
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/RemovePermission
-func (c *SNS) RemovePermission(input *RemovePermissionInput) (*RemovePermissionOutput, error) {
-	req, out := c.RemovePermissionRequest(input)
+//   - ErrCodeNotFoundException "NotFound"
+//     Indicates that the requested resource does not exist.
+//
+//   - ErrCodeEndpointDisabledException "EndpointDisabled"
+//     Exception error indicating endpoint disabled.
+//
+//   - ErrCodePlatformApplicationDisabledException "PlatformApplicationDisabled"
+//     Exception error indicating platform application disabled.
+//
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
+//
+//   - ErrCodeBatchEntryIdsNotDistinctException "BatchEntryIdsNotDistinct"
+//     Two or more batch entries in the request have the same Id.
+//
+//   - ErrCodeBatchRequestTooLongException "BatchRequestTooLong"
+//     The length of all the batch messages put together is more than the limit.
+//
+//   - ErrCodeEmptyBatchRequestException "EmptyBatchRequest"
+//     The batch request doesn't contain any entries.
+//
+//   - ErrCodeInvalidBatchEntryIdException "InvalidBatchEntryId"
+//     The Id of a batch entry in a batch request doesn't abide by the specification.
+//
+//   - ErrCodeTooManyEntriesInBatchRequestException "TooManyEntriesInBatchRequest"
+//     The batch request contains more entries than permissible.
+//
+//   - ErrCodeKMSDisabledException "KMSDisabled"
+//     The request was rejected because the specified customer master key (CMK)
+//     isn't enabled.
+//
+//   - ErrCodeKMSInvalidStateException "KMSInvalidState"
+//     The request was rejected because the state of the specified resource isn't
+//     valid for this request. For more information, see How Key State Affects Use
+//     of a Customer Master Key (https://docs.aws.amazon.com/kms/latest/developerguide/key-state.html)
+//     in the Key Management Service Developer Guide.
+//
+//   - ErrCodeKMSNotFoundException "KMSNotFound"
+//     The request was rejected because the specified entity or resource can't be
+//     found.
+//
+//   - ErrCodeKMSOptInRequired "KMSOptInRequired"
+//     The Amazon Web Services access key ID needs a subscription for the service.
+//
+//   - ErrCodeKMSThrottlingException "KMSThrottling"
+//     The request was denied due to request throttling. For more information about
+//     throttling, see Limits (https://docs.aws.amazon.com/kms/latest/developerguide/limits.html#requests-per-second)
+//     in the Key Management Service Developer Guide.
+//
+//   - ErrCodeKMSAccessDeniedException "KMSAccessDenied"
+//     The ciphertext references a key that doesn't exist or that you don't have
+//     access to.
+//
+//   - ErrCodeInvalidSecurityException "InvalidSecurity"
+//     The credential signature isn't valid. You must use an HTTPS endpoint and
+//     sign your request using Signature Version 4.
+//
+//   - ErrCodeValidationException "ValidationException"
+//     Indicates that a parameter in the request is invalid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/PublishBatch
+func (c *SNS) PublishBatch(input *PublishBatchInput) (*PublishBatchOutput, error) {
+	req, out := c.PublishBatchRequest(input)
 	return out, req.Send()
 }
 
-// RemovePermissionWithContext is the same as RemovePermission with the addition of
+// PublishBatchWithContext is the same as PublishBatch with the addition of
 // the ability to pass a context and additional request options.
 //
-// See RemovePermission for details on how to use this API operation.
+// See PublishBatch for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *SNS) RemovePermissionWithContext(ctx aws.Context, input *RemovePermissionInput, opts ...request.Option) (*RemovePermissionOutput, error) {
-	req, out := c.RemovePermissionRequest(input)
+func (c *SNS) PublishBatchWithContext(ctx aws.Context, input *PublishBatchInput, opts ...request.Option) (*PublishBatchOutput, error) {
+	req, out := c.PublishBatchRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opSetEndpointAttributes = "SetEndpointAttributes"
+const opPutDataProtectionPolicy = "PutDataProtectionPolicy"
 
-// SetEndpointAttributesRequest generates a "aws/request.Request" representing the
-// client's request for the SetEndpointAttributes operation. The "output" return
+// PutDataProtectionPolicyRequest generates a "aws/request.Request" representing the
+// client's request for the PutDataProtectionPolicy operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See SetEndpointAttributes for more information on using the SetEndpointAttributes
+// See PutDataProtectionPolicy for more information on using the PutDataProtectionPolicy
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PutDataProtectionPolicyRequest method.
+//	req, resp := client.PutDataProtectionPolicyRequest(params)
 //
-//    // Example sending a request using the SetEndpointAttributesRequest method.
-//    req, resp := client.SetEndpointAttributesRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/SetEndpointAttributes
-func (c *SNS) SetEndpointAttributesRequest(input *SetEndpointAttributesInput) (req *request.Request, output *SetEndpointAttributesOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/PutDataProtectionPolicy
+func (c *SNS) PutDataProtectionPolicyRequest(input *PutDataProtectionPolicyInput) (req *request.Request, output *PutDataProtectionPolicyOutput) {
 	op := &request.Operation{
-		Name:       opSetEndpointAttributes,
+		Name:       opPutDataProtectionPolicy,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &SetEndpointAttributesInput{}
+		input = &PutDataProtectionPolicyInput{}
 	}
 
-	output = &SetEndpointAttributesOutput{}
+	output = &PutDataProtectionPolicyOutput{}
 	req = c.newRequest(op, input, output)
 	req.Handlers.Unmarshal.Swap(query.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// SetEndpointAttributes API operation for Amazon Simple Notification Service.
+// PutDataProtectionPolicy API operation for Amazon Simple Notification Service.
 //
-// Sets the attributes for an endpoint for a device on one of the supported
-// push notification services, such as GCM and APNS. For more information, see
-// Using Amazon SNS Mobile Push Notifications (https://docs.aws.amazon.com/sns/latest/dg/SNSMobilePush.html).
+// Adds or updates an inline policy document that is stored in the specified
+// Amazon SNS topic.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Simple Notification Service's
-// API operation SetEndpointAttributes for usage and error information.
+// API operation PutDataProtectionPolicy for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeNotFoundException "NotFound"
-//   Indicates that the requested resource does not exist.
+//   - ErrCodeNotFoundException "NotFound"
+//     Indicates that the requested resource does not exist.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/SetEndpointAttributes
-func (c *SNS) SetEndpointAttributes(input *SetEndpointAttributesInput) (*SetEndpointAttributesOutput, error) {
-	req, out := c.SetEndpointAttributesRequest(input)
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
+//
+//   - ErrCodeInvalidSecurityException "InvalidSecurity"
+//     The credential signature isn't valid. You must use an HTTPS endpoint and
+//     sign your request using Signature Version 4.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/PutDataProtectionPolicy
+func (c *SNS) PutDataProtectionPolicy(input *PutDataProtectionPolicyInput) (*PutDataProtectionPolicyOutput, error) {
+	req, out := c.PutDataProtectionPolicyRequest(input)
 	return out, req.Send()
 }
 
-// SetEndpointAttributesWithContext is the same as SetEndpointAttributes with the addition of
+// PutDataProtectionPolicyWithContext is the same as PutDataProtectionPolicy with the addition of
 // the ability to pass a context and additional request options.
 //
-// See SetEndpointAttributes for details on how to use this API operation.
+// See PutDataProtectionPolicy for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *SNS) SetEndpointAttributesWithContext(ctx aws.Context, input *SetEndpointAttributesInput, opts ...request.Option) (*SetEndpointAttributesOutput, error) {
-	req, out := c.SetEndpointAttributesRequest(input)
+func (c *SNS) PutDataProtectionPolicyWithContext(ctx aws.Context, input *PutDataProtectionPolicyInput, opts ...request.Option) (*PutDataProtectionPolicyOutput, error) {
+	req, out := c.PutDataProtectionPolicyRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opSetPlatformApplicationAttributes = "SetPlatformApplicationAttributes"
+const opRemovePermission = "RemovePermission"
 
-// SetPlatformApplicationAttributesRequest generates a "aws/request.Request" representing the
-// client's request for the SetPlatformApplicationAttributes operation. The "output" return
+// RemovePermissionRequest generates a "aws/request.Request" representing the
+// client's request for the RemovePermission operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See SetPlatformApplicationAttributes for more information on using the SetPlatformApplicationAttributes
+// See RemovePermission for more information on using the RemovePermission
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the RemovePermissionRequest method.
+//	req, resp := client.RemovePermissionRequest(params)
 //
-//    // Example sending a request using the SetPlatformApplicationAttributesRequest method.
-//    req, resp := client.SetPlatformApplicationAttributesRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/SetPlatformApplicationAttributes
-func (c *SNS) SetPlatformApplicationAttributesRequest(input *SetPlatformApplicationAttributesInput) (req *request.Request, output *SetPlatformApplicationAttributesOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/RemovePermission
+func (c *SNS) RemovePermissionRequest(input *RemovePermissionInput) (req *request.Request, output *RemovePermissionOutput) {
 	op := &request.Operation{
-		Name:       opSetPlatformApplicationAttributes,
+		Name:       opRemovePermission,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
 	}
 
 	if input == nil {
-		input = &SetPlatformApplicationAttributesInput{}
+		input = &RemovePermissionInput{}
 	}
 
-	output = &SetPlatformApplicationAttributesOutput{}
+	output = &RemovePermissionOutput{}
 	req = c.newRequest(op, input, output)
 	req.Handlers.Unmarshal.Swap(query.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// SetPlatformApplicationAttributes API operation for Amazon Simple Notification Service.
+// RemovePermission API operation for Amazon Simple Notification Service.
 //
-// Sets the attributes of the platform application object for the supported
-// push notification services, such as APNS and GCM. For more information, see
-// Using Amazon SNS Mobile Push Notifications (https://docs.aws.amazon.com/sns/latest/dg/SNSMobilePush.html).
-// For information on configuring attributes for message delivery status, see
-// Using Amazon SNS Application Attributes for Message Delivery Status (https://docs.aws.amazon.com/sns/latest/dg/sns-msg-status.html).
+// Removes a statement from a topic's access control policy.
+//
+// To remove the ability to change topic permissions, you must deny permissions
+// to the AddPermission, RemovePermission, and SetTopicAttributes actions in
+// your IAM policy.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon Simple Notification Service's
-// API operation SetPlatformApplicationAttributes for usage and error information.
+// API operation RemovePermission for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeNotFoundException "NotFound"
-//   Indicates that the requested resource does not exist.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/SetPlatformApplicationAttributes
-func (c *SNS) SetPlatformApplicationAttributes(input *SetPlatformApplicationAttributesInput) (*SetPlatformApplicationAttributesOutput, error) {
-	req, out := c.SetPlatformApplicationAttributesRequest(input)
+//   - ErrCodeNotFoundException "NotFound"
+//     Indicates that the requested resource does not exist.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/RemovePermission
+func (c *SNS) RemovePermission(input *RemovePermissionInput) (*RemovePermissionOutput, error) {
+	req, out := c.RemovePermissionRequest(input)
 	return out, req.Send()
 }
 
-// SetPlatformApplicationAttributesWithContext is the same as SetPlatformApplicationAttributes with the addition of
+// RemovePermissionWithContext is the same as RemovePermission with the addition of
 // the ability to pass a context and additional request options.
 //
-// See SetPlatformApplicationAttributes for details on how to use this API operation.
+// See RemovePermission for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *SNS) SetPlatformApplicationAttributesWithContext(ctx aws.Context, input *SetPlatformApplicationAttributesInput, opts ...request.Option) (*SetPlatformApplicationAttributesOutput, error) {
-	req, out := c.SetPlatformApplicationAttributesRequest(input)
+func (c *SNS) RemovePermissionWithContext(ctx aws.Context, input *RemovePermissionInput, opts ...request.Option) (*RemovePermissionOutput, error) {
+	req, out := c.RemovePermissionRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opSetSMSAttributes = "SetSMSAttributes"
+const opSetEndpointAttributes = "SetEndpointAttributes"
 
-// SetSMSAttributesRequest generates a "aws/request.Request" representing the
-// client's request for the SetSMSAttributes operation. The "output" return
+// SetEndpointAttributesRequest generates a "aws/request.Request" representing the
+// client's request for the SetEndpointAttributes operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See SetSMSAttributes for more information on using the SetSMSAttributes
+// See SetEndpointAttributes for more information on using the SetEndpointAttributes
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the SetEndpointAttributesRequest method.
+//	req, resp := client.SetEndpointAttributesRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/SetEndpointAttributes
+func (c *SNS) SetEndpointAttributesRequest(input *SetEndpointAttributesInput) (req *request.Request, output *SetEndpointAttributesOutput) {
+	op := &request.Operation{
+		Name:       opSetEndpointAttributes,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &SetEndpointAttributesInput{}
+	}
+
+	output = &SetEndpointAttributesOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(query.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// SetEndpointAttributes API operation for Amazon Simple Notification Service.
+//
+// Sets the attributes for an endpoint for a device on one of the supported
+// push notification services, such as GCM (Firebase Cloud Messaging) and APNS.
+// For more information, see Using Amazon SNS Mobile Push Notifications (https://docs.aws.amazon.com/sns/latest/dg/SNSMobilePush.html).
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Simple Notification Service's
+// API operation SetEndpointAttributes for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
+//
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
+//
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
+//
+//   - ErrCodeNotFoundException "NotFound"
+//     Indicates that the requested resource does not exist.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/SetEndpointAttributes
+func (c *SNS) SetEndpointAttributes(input *SetEndpointAttributesInput) (*SetEndpointAttributesOutput, error) {
+	req, out := c.SetEndpointAttributesRequest(input)
+	return out, req.Send()
+}
+
+// SetEndpointAttributesWithContext is the same as SetEndpointAttributes with the addition of
+// the ability to pass a context and additional request options.
+//
+// See SetEndpointAttributes for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *SNS) SetEndpointAttributesWithContext(ctx aws.Context, input *SetEndpointAttributesInput, opts ...request.Option) (*SetEndpointAttributesOutput, error) {
+	req, out := c.SetEndpointAttributesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opSetPlatformApplicationAttributes = "SetPlatformApplicationAttributes"
+
+// SetPlatformApplicationAttributesRequest generates a "aws/request.Request" representing the
+// client's request for the SetPlatformApplicationAttributes operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See SetPlatformApplicationAttributes for more information on using the SetPlatformApplicationAttributes
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SetPlatformApplicationAttributesRequest method.
+//	req, resp := client.SetPlatformApplicationAttributesRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/SetPlatformApplicationAttributes
+func (c *SNS) SetPlatformApplicationAttributesRequest(input *SetPlatformApplicationAttributesInput) (req *request.Request, output *SetPlatformApplicationAttributesOutput) {
+	op := &request.Operation{
+		Name:       opSetPlatformApplicationAttributes,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &SetPlatformApplicationAttributesInput{}
+	}
+
+	output = &SetPlatformApplicationAttributesOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(query.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// SetPlatformApplicationAttributes API operation for Amazon Simple Notification Service.
+//
+// Sets the attributes of the platform application object for the supported
+// push notification services, such as APNS and GCM (Firebase Cloud Messaging).
+// For more information, see Using Amazon SNS Mobile Push Notifications (https://docs.aws.amazon.com/sns/latest/dg/SNSMobilePush.html).
+// For information on configuring attributes for message delivery status, see
+// Using Amazon SNS Application Attributes for Message Delivery Status (https://docs.aws.amazon.com/sns/latest/dg/sns-msg-status.html).
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Simple Notification Service's
+// API operation SetPlatformApplicationAttributes for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
+//
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
+//
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
+//
+//   - ErrCodeNotFoundException "NotFound"
+//     Indicates that the requested resource does not exist.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/SetPlatformApplicationAttributes
+func (c *SNS) SetPlatformApplicationAttributes(input *SetPlatformApplicationAttributesInput) (*SetPlatformApplicationAttributesOutput, error) {
+	req, out := c.SetPlatformApplicationAttributesRequest(input)
+	return out, req.Send()
+}
+
+// SetPlatformApplicationAttributesWithContext is the same as SetPlatformApplicationAttributes with the addition of
+// the ability to pass a context and additional request options.
+//
+// See SetPlatformApplicationAttributes for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *SNS) SetPlatformApplicationAttributesWithContext(ctx aws.Context, input *SetPlatformApplicationAttributesInput, opts ...request.Option) (*SetPlatformApplicationAttributesOutput, error) {
+	req, out := c.SetPlatformApplicationAttributesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opSetSMSAttributes = "SetSMSAttributes"
+
+// SetSMSAttributesRequest generates a "aws/request.Request" representing the
+// client's request for the SetSMSAttributes operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See SetSMSAttributes for more information on using the SetSMSAttributes
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
-//    // Example sending a request using the SetSMSAttributesRequest method.
-//    req, resp := client.SetSMSAttributesRequest(params)
+//	// Example sending a request using the SetSMSAttributesRequest method.
+//	req, resp := client.SetSMSAttributesRequest(params)
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/SetSMSAttributes
 func (c *SNS) SetSMSAttributesRequest(input *SetSMSAttributesInput) (req *request.Request, output *SetSMSAttributesOutput) {
@@ -2847,9 +3918,12 @@ func (c *SNS) SetSMSAttributesRequest(input *SetSMSAttributesInput) (req *reques
 //
 // You can override some of these settings for a single message when you use
 // the Publish action with the MessageAttributes.entry.N parameter. For more
-// information, see Sending an SMS Message (https://docs.aws.amazon.com/sns/latest/dg/sms_publish-to-phone.html)
+// information, see Publishing to a mobile phone (https://docs.aws.amazon.com/sns/latest/dg/sms_publish-to-phone.html)
 // in the Amazon SNS Developer Guide.
 //
+// To use this operation, you must grant the Amazon SNS service principal (sns.amazonaws.com)
+// permission to perform the s3:ListBucket action.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -2858,18 +3932,19 @@ func (c *SNS) SetSMSAttributesRequest(input *SetSMSAttributesInput) (req *reques
 // API operation SetSMSAttributes for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeThrottledException "Throttled"
-//   Indicates that the rate at which requests have been submitted for this action
-//   exceeds the limit for your account.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
+//
+//   - ErrCodeThrottledException "Throttled"
+//     Indicates that the rate at which requests have been submitted for this action
+//     exceeds the limit for your Amazon Web Services account.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/SetSMSAttributes
 func (c *SNS) SetSMSAttributes(input *SetSMSAttributesInput) (*SetSMSAttributesOutput, error) {
@@ -2909,14 +3984,13 @@ const opSetSubscriptionAttributes = "SetSubscriptionAttributes"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SetSubscriptionAttributesRequest method.
+//	req, resp := client.SetSubscriptionAttributesRequest(params)
 //
-//    // Example sending a request using the SetSubscriptionAttributesRequest method.
-//    req, resp := client.SetSubscriptionAttributesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/SetSubscriptionAttributes
 func (c *SNS) SetSubscriptionAttributesRequest(input *SetSubscriptionAttributesInput) (req *request.Request, output *SetSubscriptionAttributesOutput) {
@@ -2949,22 +4023,23 @@ func (c *SNS) SetSubscriptionAttributesRequest(input *SetSubscriptionAttributesI
 // API operation SetSubscriptionAttributes for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeFilterPolicyLimitExceededException "FilterPolicyLimitExceeded"
-//   Indicates that the number of filter polices in your AWS account exceeds the
-//   limit. To add more filter polices, submit an SNS Limit Increase case in the
-//   AWS Support Center.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
+//
+//   - ErrCodeFilterPolicyLimitExceededException "FilterPolicyLimitExceeded"
+//     Indicates that the number of filter polices in your Amazon Web Services account
+//     exceeds the limit. To add more filter polices, submit an Amazon SNS Limit
+//     Increase case in the Amazon Web Services Support Center.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeNotFoundException "NotFound"
-//   Indicates that the requested resource does not exist.
+//   - ErrCodeNotFoundException "NotFound"
+//     Indicates that the requested resource does not exist.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/SetSubscriptionAttributes
 func (c *SNS) SetSubscriptionAttributes(input *SetSubscriptionAttributesInput) (*SetSubscriptionAttributesOutput, error) {
@@ -3004,14 +4079,13 @@ const opSetTopicAttributes = "SetTopicAttributes"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SetTopicAttributesRequest method.
+//	req, resp := client.SetTopicAttributesRequest(params)
 //
-//    // Example sending a request using the SetTopicAttributesRequest method.
-//    req, resp := client.SetTopicAttributesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/SetTopicAttributes
 func (c *SNS) SetTopicAttributesRequest(input *SetTopicAttributesInput) (req *request.Request, output *SetTopicAttributesOutput) {
@@ -3035,6 +4109,10 @@ func (c *SNS) SetTopicAttributesRequest(input *SetTopicAttributesInput) (req *re
 //
 // Allows a topic owner to set an attribute of the topic to a new value.
 //
+// To remove the ability to change topic permissions, you must deny permissions
+// to the AddPermission, RemovePermission, and SetTopicAttributes actions in
+// your IAM policy.
+//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -3043,21 +4121,22 @@ func (c *SNS) SetTopicAttributesRequest(input *SetTopicAttributesInput) (req *re
 // API operation SetTopicAttributes for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeNotFoundException "NotFound"
-//   Indicates that the requested resource does not exist.
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeNotFoundException "NotFound"
+//     Indicates that the requested resource does not exist.
 //
-//   * ErrCodeInvalidSecurityException "InvalidSecurity"
-//   The credential signature isn't valid. You must use an HTTPS endpoint and
-//   sign your request using Signature Version 4.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
+//
+//   - ErrCodeInvalidSecurityException "InvalidSecurity"
+//     The credential signature isn't valid. You must use an HTTPS endpoint and
+//     sign your request using Signature Version 4.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/SetTopicAttributes
 func (c *SNS) SetTopicAttributes(input *SetTopicAttributesInput) (*SetTopicAttributesOutput, error) {
@@ -3097,14 +4176,13 @@ const opSubscribe = "Subscribe"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SubscribeRequest method.
+//	req, resp := client.SubscribeRequest(params)
 //
-//    // Example sending a request using the SubscribeRequest method.
-//    req, resp := client.SubscribeRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/Subscribe
 func (c *SNS) SubscribeRequest(input *SubscribeInput) (req *request.Request, output *SubscribeOutput) {
@@ -3125,10 +4203,13 @@ func (c *SNS) SubscribeRequest(input *SubscribeInput) (req *request.Request, out
 
 // Subscribe API operation for Amazon Simple Notification Service.
 //
-// Prepares to subscribe an endpoint by sending the endpoint a confirmation
-// message. To actually create a subscription, the endpoint owner must call
-// the ConfirmSubscription action with the token from the confirmation message.
-// Confirmation tokens are valid for three days.
+// Subscribes an endpoint to an Amazon SNS topic. If the endpoint type is HTTP/S
+// or email, or if the endpoint and the topic are not in the same Amazon Web
+// Services account, the endpoint owner must run the ConfirmSubscription action
+// to confirm the subscription.
+//
+// You call the ConfirmSubscription action with the token from the subscription
+// response. Confirmation tokens are valid for three days.
 //
 // This action is throttled at 100 transactions per second (TPS).
 //
@@ -3140,29 +4221,30 @@ func (c *SNS) SubscribeRequest(input *SubscribeInput) (req *request.Request, out
 // API operation Subscribe for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeSubscriptionLimitExceededException "SubscriptionLimitExceeded"
-//   Indicates that the customer already owns the maximum allowed number of subscriptions.
 //
-//   * ErrCodeFilterPolicyLimitExceededException "FilterPolicyLimitExceeded"
-//   Indicates that the number of filter polices in your AWS account exceeds the
-//   limit. To add more filter polices, submit an SNS Limit Increase case in the
-//   AWS Support Center.
+//   - ErrCodeSubscriptionLimitExceededException "SubscriptionLimitExceeded"
+//     Indicates that the customer already owns the maximum allowed number of subscriptions.
+//
+//   - ErrCodeFilterPolicyLimitExceededException "FilterPolicyLimitExceeded"
+//     Indicates that the number of filter polices in your Amazon Web Services account
+//     exceeds the limit. To add more filter polices, submit an Amazon SNS Limit
+//     Increase case in the Amazon Web Services Support Center.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeNotFoundException "NotFound"
-//   Indicates that the requested resource does not exist.
+//   - ErrCodeNotFoundException "NotFound"
+//     Indicates that the requested resource does not exist.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
 //
-//   * ErrCodeInvalidSecurityException "InvalidSecurity"
-//   The credential signature isn't valid. You must use an HTTPS endpoint and
-//   sign your request using Signature Version 4.
+//   - ErrCodeInvalidSecurityException "InvalidSecurity"
+//     The credential signature isn't valid. You must use an HTTPS endpoint and
+//     sign your request using Signature Version 4.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/Subscribe
 func (c *SNS) Subscribe(input *SubscribeInput) (*SubscribeOutput, error) {
@@ -3202,14 +4284,13 @@ const opTagResource = "TagResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the TagResourceRequest method.
+//	req, resp := client.TagResourceRequest(params)
 //
-//    // Example sending a request using the TagResourceRequest method.
-//    req, resp := client.TagResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/TagResource
 func (c *SNS) TagResourceRequest(input *TagResourceInput) (req *request.Request, output *TagResourceOutput) {
@@ -3237,21 +4318,19 @@ func (c *SNS) TagResourceRequest(input *TagResourceInput) (req *request.Request,
 //
 // When you use topic tags, keep the following guidelines in mind:
 //
-//    * Adding more than 50 tags to a topic isn't recommended.
-//
-//    * Tags don't have any semantic meaning. Amazon SNS interprets tags as
-//    character strings.
+//   - Adding more than 50 tags to a topic isn't recommended.
 //
-//    * Tags are case-sensitive.
+//   - Tags don't have any semantic meaning. Amazon SNS interprets tags as
+//     character strings.
 //
-//    * A new tag with a key identical to that of an existing tag overwrites
-//    the existing tag.
+//   - Tags are case-sensitive.
 //
-//    * Tagging actions are limited to 10 TPS per AWS account. If your application
-//    requires a higher throughput, file a technical support request (https://console.aws.amazon.com/support/home#/case/create?issueType=technical).
+//   - A new tag with a key identical to that of an existing tag overwrites
+//     the existing tag.
 //
-// For a full list of tag restrictions, see Limits Related to Topics (https://docs.aws.amazon.com/sns/latest/dg/sns-limits.html#limits-topics)
-// in the Amazon SNS Developer Guide.
+//   - Tagging actions are limited to 10 TPS per Amazon Web Services account,
+//     per Amazon Web Services Region. If your application requires a higher
+//     throughput, file a technical support request (https://console.aws.amazon.com/support/home#/case/create?issueType=technical).
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -3261,29 +4340,31 @@ func (c *SNS) TagResourceRequest(input *TagResourceInput) (req *request.Request,
 // API operation TagResource for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFound"
-//   Can't tag resource. Verify that the topic exists.
 //
-//   * ErrCodeTagLimitExceededException "TagLimitExceeded"
-//   Can't add more than 50 tags to a topic.
+//   - ErrCodeResourceNotFoundException "ResourceNotFound"
+//     Can’t perform the action on the specified resource. Make sure that the
+//     resource exists.
+//
+//   - ErrCodeTagLimitExceededException "TagLimitExceeded"
+//     Can't add more than 50 tags to a topic.
 //
-//   * ErrCodeStaleTagException "StaleTag"
-//   A tag has been added to a resource with the same ARN as a deleted resource.
-//   Wait a short while and then retry the operation.
+//   - ErrCodeStaleTagException "StaleTag"
+//     A tag has been added to a resource with the same ARN as a deleted resource.
+//     Wait a short while and then retry the operation.
 //
-//   * ErrCodeTagPolicyException "TagPolicy"
-//   The request doesn't comply with the IAM tag policy. Correct your request
-//   and then retry it.
+//   - ErrCodeTagPolicyException "TagPolicy"
+//     The request doesn't comply with the IAM tag policy. Correct your request
+//     and then retry it.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
 //
-//   * ErrCodeConcurrentAccessException "ConcurrentAccess"
-//   Can't perform multiple operations on a tag simultaneously. Perform the operations
-//   sequentially.
+//   - ErrCodeConcurrentAccessException "ConcurrentAccess"
+//     Can't perform multiple operations on a tag simultaneously. Perform the operations
+//     sequentially.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/TagResource
 func (c *SNS) TagResource(input *TagResourceInput) (*TagResourceOutput, error) {
@@ -3323,14 +4404,13 @@ const opUnsubscribe = "Unsubscribe"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UnsubscribeRequest method.
+//	req, resp := client.UnsubscribeRequest(params)
 //
-//    // Example sending a request using the UnsubscribeRequest method.
-//    req, resp := client.UnsubscribeRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/Unsubscribe
 func (c *SNS) UnsubscribeRequest(input *UnsubscribeInput) (req *request.Request, output *UnsubscribeOutput) {
@@ -3354,10 +4434,15 @@ func (c *SNS) UnsubscribeRequest(input *UnsubscribeInput) (req *request.Request,
 //
 // Deletes a subscription. If the subscription requires authentication for deletion,
 // only the owner of the subscription or the topic's owner can unsubscribe,
-// and an AWS signature is required. If the Unsubscribe call does not require
-// authentication and the requester is not the subscription owner, a final cancellation
-// message is delivered to the endpoint, so that the endpoint owner can easily
-// resubscribe to the topic if the Unsubscribe request was unintended.
+// and an Amazon Web Services signature is required. If the Unsubscribe call
+// does not require authentication and the requester is not the subscription
+// owner, a final cancellation message is delivered to the endpoint, so that
+// the endpoint owner can easily resubscribe to the topic if the Unsubscribe
+// request was unintended.
+//
+// Amazon SQS queue subscriptions require authentication for deletion. Only
+// the owner of the subscription, or the owner of the topic can unsubscribe
+// using the required Amazon Web Services signature.
 //
 // This action is throttled at 100 transactions per second (TPS).
 //
@@ -3369,21 +4454,22 @@ func (c *SNS) UnsubscribeRequest(input *UnsubscribeInput) (req *request.Request,
 // API operation Unsubscribe for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeInternalErrorException "InternalError"
-//   Indicates an internal service error.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
 //
-//   * ErrCodeNotFoundException "NotFound"
-//   Indicates that the requested resource does not exist.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
 //
-//   * ErrCodeInvalidSecurityException "InvalidSecurity"
-//   The credential signature isn't valid. You must use an HTTPS endpoint and
-//   sign your request using Signature Version 4.
+//   - ErrCodeNotFoundException "NotFound"
+//     Indicates that the requested resource does not exist.
+//
+//   - ErrCodeInvalidSecurityException "InvalidSecurity"
+//     The credential signature isn't valid. You must use an HTTPS endpoint and
+//     sign your request using Signature Version 4.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/Unsubscribe
 func (c *SNS) Unsubscribe(input *UnsubscribeInput) (*UnsubscribeOutput, error) {
@@ -3423,14 +4509,13 @@ const opUntagResource = "UntagResource"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UntagResourceRequest method.
+//	req, resp := client.UntagResourceRequest(params)
 //
-//    // Example sending a request using the UntagResourceRequest method.
-//    req, resp := client.UntagResourceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/UntagResource
 func (c *SNS) UntagResourceRequest(input *UntagResourceInput) (req *request.Request, output *UntagResourceOutput) {
@@ -3464,29 +4549,31 @@ func (c *SNS) UntagResourceRequest(input *UntagResourceInput) (req *request.Requ
 // API operation UntagResource for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeResourceNotFoundException "ResourceNotFound"
-//   Can't tag resource. Verify that the topic exists.
 //
-//   * ErrCodeTagLimitExceededException "TagLimitExceeded"
-//   Can't add more than 50 tags to a topic.
+//   - ErrCodeResourceNotFoundException "ResourceNotFound"
+//     Can’t perform the action on the specified resource. Make sure that the
+//     resource exists.
 //
-//   * ErrCodeStaleTagException "StaleTag"
-//   A tag has been added to a resource with the same ARN as a deleted resource.
-//   Wait a short while and then retry the operation.
+//   - ErrCodeTagLimitExceededException "TagLimitExceeded"
+//     Can't add more than 50 tags to a topic.
 //
-//   * ErrCodeTagPolicyException "TagPolicy"
-//   The request doesn't comply with the IAM tag policy. Correct your request
-//   and then retry it.
+//   - ErrCodeStaleTagException "StaleTag"
+//     A tag has been added to a resource with the same ARN as a deleted resource.
+//     Wait a short while and then retry the operation.
 //
-//   * ErrCodeInvalidParameterException "InvalidParameter"
-//   Indicates that a request parameter does not comply with the associated constraints.
+//   - ErrCodeTagPolicyException "TagPolicy"
+//     The request doesn't comply with the IAM tag policy. Correct your request
+//     and then retry it.
 //
-//   * ErrCodeAuthorizationErrorException "AuthorizationError"
-//   Indicates that the user has been denied access to the requested resource.
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
 //
-//   * ErrCodeConcurrentAccessException "ConcurrentAccess"
-//   Can't perform multiple operations on a tag simultaneously. Perform the operations
-//   sequentially.
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
+//
+//   - ErrCodeConcurrentAccessException "ConcurrentAccess"
+//     Can't perform multiple operations on a tag simultaneously. Perform the operations
+//     sequentially.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/UntagResource
 func (c *SNS) UntagResource(input *UntagResourceInput) (*UntagResourceOutput, error) {
@@ -3510,19 +4597,127 @@ func (c *SNS) UntagResourceWithContext(ctx aws.Context, input *UntagResourceInpu
 	return out, req.Send()
 }
 
+const opVerifySMSSandboxPhoneNumber = "VerifySMSSandboxPhoneNumber"
+
+// VerifySMSSandboxPhoneNumberRequest generates a "aws/request.Request" representing the
+// client's request for the VerifySMSSandboxPhoneNumber operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See VerifySMSSandboxPhoneNumber for more information on using the VerifySMSSandboxPhoneNumber
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the VerifySMSSandboxPhoneNumberRequest method.
+//	req, resp := client.VerifySMSSandboxPhoneNumberRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/VerifySMSSandboxPhoneNumber
+func (c *SNS) VerifySMSSandboxPhoneNumberRequest(input *VerifySMSSandboxPhoneNumberInput) (req *request.Request, output *VerifySMSSandboxPhoneNumberOutput) {
+	op := &request.Operation{
+		Name:       opVerifySMSSandboxPhoneNumber,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &VerifySMSSandboxPhoneNumberInput{}
+	}
+
+	output = &VerifySMSSandboxPhoneNumberOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(query.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// VerifySMSSandboxPhoneNumber API operation for Amazon Simple Notification Service.
+//
+// Verifies a destination phone number with a one-time password (OTP) for the
+// calling Amazon Web Services account.
+//
+// When you start using Amazon SNS to send SMS messages, your Amazon Web Services
+// account is in the SMS sandbox. The SMS sandbox provides a safe environment
+// for you to try Amazon SNS features without risking your reputation as an
+// SMS sender. While your Amazon Web Services account is in the SMS sandbox,
+// you can use all of the features of Amazon SNS. However, you can send SMS
+// messages only to verified destination phone numbers. For more information,
+// including how to move out of the sandbox to send messages without restrictions,
+// see SMS sandbox (https://docs.aws.amazon.com/sns/latest/dg/sns-sms-sandbox.html)
+// in the Amazon SNS Developer Guide.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Simple Notification Service's
+// API operation VerifySMSSandboxPhoneNumber for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeAuthorizationErrorException "AuthorizationError"
+//     Indicates that the user has been denied access to the requested resource.
+//
+//   - ErrCodeInternalErrorException "InternalError"
+//     Indicates an internal service error.
+//
+//   - ErrCodeInvalidParameterException "InvalidParameter"
+//     Indicates that a request parameter does not comply with the associated constraints.
+//
+//   - ErrCodeResourceNotFoundException "ResourceNotFound"
+//     Can’t perform the action on the specified resource. Make sure that the
+//     resource exists.
+//
+//   - ErrCodeVerificationException "VerificationException"
+//     Indicates that the one-time password (OTP) used for verification is invalid.
+//
+//   - ErrCodeThrottledException "Throttled"
+//     Indicates that the rate at which requests have been submitted for this action
+//     exceeds the limit for your Amazon Web Services account.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sns-2010-03-31/VerifySMSSandboxPhoneNumber
+func (c *SNS) VerifySMSSandboxPhoneNumber(input *VerifySMSSandboxPhoneNumberInput) (*VerifySMSSandboxPhoneNumberOutput, error) {
+	req, out := c.VerifySMSSandboxPhoneNumberRequest(input)
+	return out, req.Send()
+}
+
+// VerifySMSSandboxPhoneNumberWithContext is the same as VerifySMSSandboxPhoneNumber with the addition of
+// the ability to pass a context and additional request options.
+//
+// See VerifySMSSandboxPhoneNumber for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *SNS) VerifySMSSandboxPhoneNumberWithContext(ctx aws.Context, input *VerifySMSSandboxPhoneNumberInput, opts ...request.Option) (*VerifySMSSandboxPhoneNumberOutput, error) {
+	req, out := c.VerifySMSSandboxPhoneNumberRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 type AddPermissionInput struct {
 	_ struct{} `type:"structure"`
 
-	// The AWS account IDs of the users (principals) who will be given access to
-	// the specified actions. The users must have AWS accounts, but do not need
-	// to be signed up for this service.
+	// The Amazon Web Services account IDs of the users (principals) who will be
+	// given access to the specified actions. The users must have Amazon Web Services
+	// account, but do not need to be signed up for this service.
 	//
 	// AWSAccountId is a required field
 	AWSAccountId []*string `type:"list" required:"true"`
 
 	// The action you want to allow for the specified principal(s).
 	//
-	// Valid values: any Amazon SNS action name.
+	// Valid values: Any Amazon SNS action name, for example Publish.
 	//
 	// ActionName is a required field
 	ActionName []*string `type:"list" required:"true"`
@@ -3538,12 +4733,20 @@ type AddPermissionInput struct {
 	TopicArn *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AddPermissionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AddPermissionInput) GoString() string {
 	return s.String()
 }
@@ -3598,43 +4801,124 @@ type AddPermissionOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AddPermissionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AddPermissionOutput) GoString() string {
 	return s.String()
 }
 
-// The input for the CheckIfPhoneNumberIsOptedOut action.
-type CheckIfPhoneNumberIsOptedOutInput struct {
+// Gives a detailed description of failed messages in the batch.
+type BatchResultErrorEntry struct {
 	_ struct{} `type:"structure"`
 
-	// The phone number for which you want to check the opt out status.
+	// An error code representing why the action failed on this entry.
 	//
-	// PhoneNumber is a required field
-	PhoneNumber *string `locationName:"phoneNumber" type:"string" required:"true"`
+	// Code is a required field
+	Code *string `type:"string" required:"true"`
+
+	// The Id of an entry in a batch request
+	//
+	// Id is a required field
+	Id *string `type:"string" required:"true"`
+
+	// A message explaining why the action failed on this entry.
+	Message *string `type:"string"`
+
+	// Specifies whether the error happened due to the caller of the batch API action.
+	//
+	// SenderFault is a required field
+	SenderFault *bool `type:"boolean" required:"true"`
 }
 
-// String returns the string representation
-func (s CheckIfPhoneNumberIsOptedOutInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchResultErrorEntry) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CheckIfPhoneNumberIsOptedOutInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchResultErrorEntry) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *CheckIfPhoneNumberIsOptedOutInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CheckIfPhoneNumberIsOptedOutInput"}
-	if s.PhoneNumber == nil {
-		invalidParams.Add(request.NewErrParamRequired("PhoneNumber"))
-	}
-
+// SetCode sets the Code field's value.
+func (s *BatchResultErrorEntry) SetCode(v string) *BatchResultErrorEntry {
+	s.Code = &v
+	return s
+}
+
+// SetId sets the Id field's value.
+func (s *BatchResultErrorEntry) SetId(v string) *BatchResultErrorEntry {
+	s.Id = &v
+	return s
+}
+
+// SetMessage sets the Message field's value.
+func (s *BatchResultErrorEntry) SetMessage(v string) *BatchResultErrorEntry {
+	s.Message = &v
+	return s
+}
+
+// SetSenderFault sets the SenderFault field's value.
+func (s *BatchResultErrorEntry) SetSenderFault(v bool) *BatchResultErrorEntry {
+	s.SenderFault = &v
+	return s
+}
+
+// The input for the CheckIfPhoneNumberIsOptedOut action.
+type CheckIfPhoneNumberIsOptedOutInput struct {
+	_ struct{} `type:"structure"`
+
+	// The phone number for which you want to check the opt out status.
+	//
+	// PhoneNumber is a required field
+	PhoneNumber *string `locationName:"phoneNumber" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CheckIfPhoneNumberIsOptedOutInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CheckIfPhoneNumberIsOptedOutInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CheckIfPhoneNumberIsOptedOutInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CheckIfPhoneNumberIsOptedOutInput"}
+	if s.PhoneNumber == nil {
+		invalidParams.Add(request.NewErrParamRequired("PhoneNumber"))
+	}
+
 	if invalidParams.Len() > 0 {
 		return invalidParams
 	}
@@ -3661,12 +4945,20 @@ type CheckIfPhoneNumberIsOptedOutOutput struct {
 	IsOptedOut *bool `locationName:"isOptedOut" type:"boolean"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CheckIfPhoneNumberIsOptedOutOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CheckIfPhoneNumberIsOptedOutOutput) GoString() string {
 	return s.String()
 }
@@ -3682,9 +4974,9 @@ type ConfirmSubscriptionInput struct {
 	_ struct{} `type:"structure"`
 
 	// Disallows unauthenticated unsubscribes of the subscription. If the value
-	// of this parameter is true and the request has an AWS signature, then only
-	// the topic owner and the subscription owner can unsubscribe the endpoint.
-	// The unsubscribe action requires AWS authentication.
+	// of this parameter is true and the request has an Amazon Web Services signature,
+	// then only the topic owner and the subscription owner can unsubscribe the
+	// endpoint. The unsubscribe action requires Amazon Web Services authentication.
 	AuthenticateOnUnsubscribe *string `type:"string"`
 
 	// Short-lived token sent to an endpoint during the Subscribe action.
@@ -3698,12 +4990,20 @@ type ConfirmSubscriptionInput struct {
 	TopicArn *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ConfirmSubscriptionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ConfirmSubscriptionInput) GoString() string {
 	return s.String()
 }
@@ -3750,12 +5050,20 @@ type ConfirmSubscriptionOutput struct {
 	SubscriptionArn *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ConfirmSubscriptionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ConfirmSubscriptionOutput) GoString() string {
 	return s.String()
 }
@@ -3770,7 +5078,7 @@ func (s *ConfirmSubscriptionOutput) SetSubscriptionArn(v string) *ConfirmSubscri
 type CreatePlatformApplicationInput struct {
 	_ struct{} `type:"structure"`
 
-	// For a list of attributes, see SetPlatformApplicationAttributes (https://docs.aws.amazon.com/sns/latest/api/API_SetPlatformApplicationAttributes.html)
+	// For a list of attributes, see SetPlatformApplicationAttributes (https://docs.aws.amazon.com/sns/latest/api/API_SetPlatformApplicationAttributes.html).
 	//
 	// Attributes is a required field
 	Attributes map[string]*string `type:"map" required:"true"`
@@ -3783,18 +5091,27 @@ type CreatePlatformApplicationInput struct {
 	Name *string `type:"string" required:"true"`
 
 	// The following platforms are supported: ADM (Amazon Device Messaging), APNS
-	// (Apple Push Notification Service), APNS_SANDBOX, and GCM (Google Cloud Messaging).
+	// (Apple Push Notification Service), APNS_SANDBOX, and GCM (Firebase Cloud
+	// Messaging).
 	//
 	// Platform is a required field
 	Platform *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePlatformApplicationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePlatformApplicationInput) GoString() string {
 	return s.String()
 }
@@ -3844,12 +5161,20 @@ type CreatePlatformApplicationOutput struct {
 	PlatformApplicationArn *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePlatformApplicationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePlatformApplicationOutput) GoString() string {
 	return s.String()
 }
@@ -3880,19 +5205,28 @@ type CreatePlatformEndpointInput struct {
 	// Unique identifier created by the notification service for an app on a device.
 	// The specific name for Token will vary, depending on which notification service
 	// is being used. For example, when using APNS as the notification service,
-	// you need the device token. Alternatively, when using GCM or ADM, the device
-	// token equivalent is called the registration ID.
+	// you need the device token. Alternatively, when using GCM (Firebase Cloud
+	// Messaging) or ADM, the device token equivalent is called the registration
+	// ID.
 	//
 	// Token is a required field
 	Token *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePlatformEndpointInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePlatformEndpointInput) GoString() string {
 	return s.String()
 }
@@ -3945,12 +5279,20 @@ type CreatePlatformEndpointOutput struct {
 	EndpointArn *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePlatformEndpointOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreatePlatformEndpointOutput) GoString() string {
 	return s.String()
 }
@@ -3961,6 +5303,85 @@ func (s *CreatePlatformEndpointOutput) SetEndpointArn(v string) *CreatePlatformE
 	return s
 }
 
+type CreateSMSSandboxPhoneNumberInput struct {
+	_ struct{} `type:"structure"`
+
+	// The language to use for sending the OTP. The default value is en-US.
+	LanguageCode *string `type:"string" enum:"LanguageCodeString"`
+
+	// The destination phone number to verify. On verification, Amazon SNS adds
+	// this phone number to the list of verified phone numbers that you can send
+	// SMS messages to.
+	//
+	// PhoneNumber is a required field
+	PhoneNumber *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateSMSSandboxPhoneNumberInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateSMSSandboxPhoneNumberInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateSMSSandboxPhoneNumberInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateSMSSandboxPhoneNumberInput"}
+	if s.PhoneNumber == nil {
+		invalidParams.Add(request.NewErrParamRequired("PhoneNumber"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetLanguageCode sets the LanguageCode field's value.
+func (s *CreateSMSSandboxPhoneNumberInput) SetLanguageCode(v string) *CreateSMSSandboxPhoneNumberInput {
+	s.LanguageCode = &v
+	return s
+}
+
+// SetPhoneNumber sets the PhoneNumber field's value.
+func (s *CreateSMSSandboxPhoneNumberInput) SetPhoneNumber(v string) *CreateSMSSandboxPhoneNumberInput {
+	s.PhoneNumber = &v
+	return s
+}
+
+type CreateSMSSandboxPhoneNumberOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateSMSSandboxPhoneNumberOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateSMSSandboxPhoneNumberOutput) GoString() string {
+	return s.String()
+}
+
 // Input for CreateTopic action.
 type CreateTopicInput struct {
 	_ struct{} `type:"structure"`
@@ -3975,36 +5396,87 @@ type CreateTopicInput struct {
 	//
 	//    * DisplayName – The display name to use for a topic with SMS subscriptions.
 	//
+	//    * FifoTopic – Set to true to create a FIFO topic.
+	//
 	//    * Policy – The policy that defines who can access your topic. By default,
 	//    only the topic owner can publish or subscribe to the topic.
 	//
-	// The following attribute applies only to server-side-encryption (https://docs.aws.amazon.com/sns/latest/dg/sns-server-side-encryption.html):
+	//    * SignatureVersion – The signature version corresponds to the hashing
+	//    algorithm used while creating the signature of the notifications, subscription
+	//    confirmations, or unsubscribe confirmation messages sent by Amazon SNS.
+	//    By default, SignatureVersion is set to 1.
+	//
+	//    * TracingConfig – Tracing mode of an Amazon SNS topic. By default TracingConfig
+	//    is set to PassThrough, and the topic passes through the tracing header
+	//    it receives from an Amazon SNS publisher to its subscriptions. If set
+	//    to Active, Amazon SNS will vend X-Ray segment data to topic owner account
+	//    if the sampled flag in the tracing header is true. This is only supported
+	//    on standard topics.
+	//
+	// The following attribute applies only to server-side encryption (https://docs.aws.amazon.com/sns/latest/dg/sns-server-side-encryption.html):
 	//
-	//    * KmsMasterKeyId - The ID of an AWS-managed customer master key (CMK)
-	//    for Amazon SNS or a custom CMK. For more information, see Key Terms (https://docs.aws.amazon.com/sns/latest/dg/sns-server-side-encryption.html#sse-key-terms).
+	//    * KmsMasterKeyId – The ID of an Amazon Web Services managed customer
+	//    master key (CMK) for Amazon SNS or a custom CMK. For more information,
+	//    see Key Terms (https://docs.aws.amazon.com/sns/latest/dg/sns-server-side-encryption.html#sse-key-terms).
 	//    For more examples, see KeyId (https://docs.aws.amazon.com/kms/latest/APIReference/API_DescribeKey.html#API_DescribeKey_RequestParameters)
-	//    in the AWS Key Management Service API Reference.
+	//    in the Key Management Service API Reference.
+	//
+	// The following attributes apply only to FIFO topics (https://docs.aws.amazon.com/sns/latest/dg/sns-fifo-topics.html):
+	//
+	//    * FifoTopic – When this is set to true, a FIFO topic is created.
+	//
+	//    * ContentBasedDeduplication – Enables content-based deduplication for
+	//    FIFO topics. By default, ContentBasedDeduplication is set to false. If
+	//    you create a FIFO topic and this attribute is false, you must specify
+	//    a value for the MessageDeduplicationId parameter for the Publish (https://docs.aws.amazon.com/sns/latest/api/API_Publish.html)
+	//    action. When you set ContentBasedDeduplication to true, Amazon SNS uses
+	//    a SHA-256 hash to generate the MessageDeduplicationId using the body of
+	//    the message (but not the attributes of the message). (Optional) To override
+	//    the generated value, you can specify a value for the MessageDeduplicationId
+	//    parameter for the Publish action.
 	Attributes map[string]*string `type:"map"`
 
+	// The body of the policy document you want to use for this topic.
+	//
+	// You can only add one policy per topic.
+	//
+	// The policy must be in JSON string format.
+	//
+	// Length Constraints: Maximum length of 30,720.
+	DataProtectionPolicy *string `type:"string"`
+
 	// The name of the topic you want to create.
 	//
 	// Constraints: Topic names must be made up of only uppercase and lowercase
 	// ASCII letters, numbers, underscores, and hyphens, and must be between 1 and
 	// 256 characters long.
 	//
+	// For a FIFO (first-in-first-out) topic, the name must end with the .fifo suffix.
+	//
 	// Name is a required field
 	Name *string `type:"string" required:"true"`
 
 	// The list of tags to add to a new topic.
+	//
+	// To be able to tag a topic on creation, you must have the sns:CreateTopic
+	// and sns:TagResource permissions.
 	Tags []*Tag `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateTopicInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateTopicInput) GoString() string {
 	return s.String()
 }
@@ -4038,6 +5510,12 @@ func (s *CreateTopicInput) SetAttributes(v map[string]*string) *CreateTopicInput
 	return s
 }
 
+// SetDataProtectionPolicy sets the DataProtectionPolicy field's value.
+func (s *CreateTopicInput) SetDataProtectionPolicy(v string) *CreateTopicInput {
+	s.DataProtectionPolicy = &v
+	return s
+}
+
 // SetName sets the Name field's value.
 func (s *CreateTopicInput) SetName(v string) *CreateTopicInput {
 	s.Name = &v
@@ -4058,12 +5536,20 @@ type CreateTopicOutput struct {
 	TopicArn *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateTopicOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateTopicOutput) GoString() string {
 	return s.String()
 }
@@ -4084,12 +5570,20 @@ type DeleteEndpointInput struct {
 	EndpointArn *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteEndpointInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteEndpointInput) GoString() string {
 	return s.String()
 }
@@ -4117,12 +5611,20 @@ type DeleteEndpointOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteEndpointOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteEndpointOutput) GoString() string {
 	return s.String()
 }
@@ -4137,12 +5639,20 @@ type DeletePlatformApplicationInput struct {
 	PlatformApplicationArn *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeletePlatformApplicationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeletePlatformApplicationInput) GoString() string {
 	return s.String()
 }
@@ -4170,16 +5680,92 @@ type DeletePlatformApplicationOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeletePlatformApplicationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeletePlatformApplicationOutput) GoString() string {
 	return s.String()
 }
 
+type DeleteSMSSandboxPhoneNumberInput struct {
+	_ struct{} `type:"structure"`
+
+	// The destination phone number to delete.
+	//
+	// PhoneNumber is a required field
+	PhoneNumber *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteSMSSandboxPhoneNumberInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteSMSSandboxPhoneNumberInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteSMSSandboxPhoneNumberInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteSMSSandboxPhoneNumberInput"}
+	if s.PhoneNumber == nil {
+		invalidParams.Add(request.NewErrParamRequired("PhoneNumber"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetPhoneNumber sets the PhoneNumber field's value.
+func (s *DeleteSMSSandboxPhoneNumberInput) SetPhoneNumber(v string) *DeleteSMSSandboxPhoneNumberInput {
+	s.PhoneNumber = &v
+	return s
+}
+
+type DeleteSMSSandboxPhoneNumberOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteSMSSandboxPhoneNumberOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteSMSSandboxPhoneNumberOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteTopicInput struct {
 	_ struct{} `type:"structure"`
 
@@ -4189,12 +5775,20 @@ type DeleteTopicInput struct {
 	TopicArn *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteTopicInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteTopicInput) GoString() string {
 	return s.String()
 }
@@ -4222,33 +5816,49 @@ type DeleteTopicOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteTopicOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteTopicOutput) GoString() string {
 	return s.String()
 }
 
-// Endpoint for mobile app and device.
+// The endpoint for mobile app and device.
 type Endpoint struct {
 	_ struct{} `type:"structure"`
 
 	// Attributes for endpoint.
 	Attributes map[string]*string `type:"map"`
 
-	// EndpointArn for mobile app and device.
+	// The EndpointArn for mobile app and device.
 	EndpointArn *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Endpoint) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Endpoint) GoString() string {
 	return s.String()
 }
@@ -4265,6 +5875,86 @@ func (s *Endpoint) SetEndpointArn(v string) *Endpoint {
 	return s
 }
 
+type GetDataProtectionPolicyInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the topic whose DataProtectionPolicy you want to get.
+	//
+	// For more information about ARNs, see Amazon Resource Names (ARNs) (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
+	// in the Amazon Web Services General Reference.
+	//
+	// ResourceArn is a required field
+	ResourceArn *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDataProtectionPolicyInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDataProtectionPolicyInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetDataProtectionPolicyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDataProtectionPolicyInput"}
+	if s.ResourceArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceArn"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetResourceArn sets the ResourceArn field's value.
+func (s *GetDataProtectionPolicyInput) SetResourceArn(v string) *GetDataProtectionPolicyInput {
+	s.ResourceArn = &v
+	return s
+}
+
+type GetDataProtectionPolicyOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Retrieves the DataProtectionPolicy in JSON string format.
+	DataProtectionPolicy *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDataProtectionPolicyOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetDataProtectionPolicyOutput) GoString() string {
+	return s.String()
+}
+
+// SetDataProtectionPolicy sets the DataProtectionPolicy field's value.
+func (s *GetDataProtectionPolicyOutput) SetDataProtectionPolicy(v string) *GetDataProtectionPolicyOutput {
+	s.DataProtectionPolicy = &v
+	return s
+}
+
 // Input for GetEndpointAttributes action.
 type GetEndpointAttributesInput struct {
 	_ struct{} `type:"structure"`
@@ -4275,12 +5965,20 @@ type GetEndpointAttributesInput struct {
 	EndpointArn *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetEndpointAttributesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetEndpointAttributesInput) GoString() string {
 	return s.String()
 }
@@ -4322,15 +6020,24 @@ type GetEndpointAttributesOutput struct {
 	//    * Token – device token, also referred to as a registration id, for an
 	//    app and mobile device. This is returned from the notification service
 	//    when an app and mobile device are registered with the notification service.
+	//    The device token for the iOS platform is returned in lowercase.
 	Attributes map[string]*string `type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetEndpointAttributesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetEndpointAttributesOutput) GoString() string {
 	return s.String()
 }
@@ -4351,12 +6058,20 @@ type GetPlatformApplicationAttributesInput struct {
 	PlatformApplicationArn *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetPlatformApplicationAttributesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetPlatformApplicationAttributesInput) GoString() string {
 	return s.String()
 }
@@ -4386,6 +6101,15 @@ type GetPlatformApplicationAttributesOutput struct {
 
 	// Attributes include the following:
 	//
+	//    * AppleCertificateExpiryDate – The expiry date of the SSL certificate
+	//    used to configure certificate-based authentication.
+	//
+	//    * ApplePlatformTeamID – The Apple developer account ID used to configure
+	//    token-based authentication.
+	//
+	//    * ApplePlatformBundleID – The app identifier used to configure token-based
+	//    authentication.
+	//
 	//    * EventEndpointCreated – Topic ARN to which EndpointCreated event notifications
 	//    should be sent.
 	//
@@ -4401,12 +6125,20 @@ type GetPlatformApplicationAttributesOutput struct {
 	Attributes map[string]*string `type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetPlatformApplicationAttributesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetPlatformApplicationAttributesOutput) GoString() string {
 	return s.String()
 }
@@ -4430,12 +6162,20 @@ type GetSMSAttributesInput struct {
 	Attributes []*string `locationName:"attributes" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetSMSAttributesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetSMSAttributesInput) GoString() string {
 	return s.String()
 }
@@ -4454,12 +6194,20 @@ type GetSMSAttributesOutput struct {
 	Attributes map[string]*string `locationName:"attributes" type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetSMSAttributesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetSMSAttributesOutput) GoString() string {
 	return s.String()
 }
@@ -4470,23 +6218,86 @@ func (s *GetSMSAttributesOutput) SetAttributes(v map[string]*string) *GetSMSAttr
 	return s
 }
 
-// Input for GetSubscriptionAttributes.
-type GetSubscriptionAttributesInput struct {
+type GetSMSSandboxAccountStatusInput struct {
 	_ struct{} `type:"structure"`
+}
 
-	// The ARN of the subscription whose properties you want to get.
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSMSSandboxAccountStatusInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSMSSandboxAccountStatusInput) GoString() string {
+	return s.String()
+}
+
+type GetSMSSandboxAccountStatusOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Indicates whether the calling Amazon Web Services account is in the SMS sandbox.
 	//
-	// SubscriptionArn is a required field
-	SubscriptionArn *string `type:"string" required:"true"`
+	// IsInSandbox is a required field
+	IsInSandbox *bool `type:"boolean" required:"true"`
 }
 
-// String returns the string representation
-func (s GetSubscriptionAttributesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSMSSandboxAccountStatusOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetSubscriptionAttributesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSMSSandboxAccountStatusOutput) GoString() string {
+	return s.String()
+}
+
+// SetIsInSandbox sets the IsInSandbox field's value.
+func (s *GetSMSSandboxAccountStatusOutput) SetIsInSandbox(v bool) *GetSMSSandboxAccountStatusOutput {
+	s.IsInSandbox = &v
+	return s
+}
+
+// Input for GetSubscriptionAttributes.
+type GetSubscriptionAttributesInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the subscription whose properties you want to get.
+	//
+	// SubscriptionArn is a required field
+	SubscriptionArn *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSubscriptionAttributesInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSubscriptionAttributesInput) GoString() string {
 	return s.String()
 }
 
@@ -4527,8 +6338,15 @@ type GetSubscriptionAttributesOutput struct {
 	//    account system defaults.
 	//
 	//    * FilterPolicy – The filter policy JSON that is assigned to the subscription.
+	//    For more information, see Amazon SNS Message Filtering (https://docs.aws.amazon.com/sns/latest/dg/sns-message-filtering.html)
+	//    in the Amazon SNS Developer Guide.
+	//
+	//    * FilterPolicyScope – This attribute lets you choose the filtering scope
+	//    by using one of the following string value types: MessageAttributes (default)
+	//    – The filter is applied on the message attributes. MessageBody – The
+	//    filter is applied on the message body.
 	//
-	//    * Owner – The AWS account ID of the subscription's owner.
+	//    * Owner – The Amazon Web Services account ID of the subscription's owner.
 	//
 	//    * PendingConfirmation – true if the subscription hasn't been confirmed.
 	//    To confirm a pending subscription, call the ConfirmSubscription action
@@ -4538,18 +6356,44 @@ type GetSubscriptionAttributesOutput struct {
 	//    subscription. Raw messages are free of JSON formatting and can be sent
 	//    to HTTP/S and Amazon SQS endpoints.
 	//
+	//    * RedrivePolicy – When specified, sends undeliverable messages to the
+	//    specified Amazon SQS dead-letter queue. Messages that can't be delivered
+	//    due to client errors (for example, when the subscribed endpoint is unreachable)
+	//    or server errors (for example, when the service that powers the subscribed
+	//    endpoint becomes unavailable) are held in the dead-letter queue for further
+	//    analysis or reprocessing.
+	//
 	//    * SubscriptionArn – The subscription's ARN.
 	//
 	//    * TopicArn – The topic ARN that the subscription is associated with.
+	//
+	// The following attribute applies only to Amazon Kinesis Data Firehose delivery
+	// stream subscriptions:
+	//
+	//    * SubscriptionRoleArn – The ARN of the IAM role that has the following:
+	//    Permission to write to the Kinesis Data Firehose delivery stream Amazon
+	//    SNS listed as a trusted entity Specifying a valid ARN for this attribute
+	//    is required for Kinesis Data Firehose delivery stream subscriptions. For
+	//    more information, see Fanout to Kinesis Data Firehose delivery streams
+	//    (https://docs.aws.amazon.com/sns/latest/dg/sns-firehose-as-subscriber.html)
+	//    in the Amazon SNS Developer Guide.
 	Attributes map[string]*string `type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetSubscriptionAttributesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetSubscriptionAttributesOutput) GoString() string {
 	return s.String()
 }
@@ -4570,12 +6414,20 @@ type GetTopicAttributesInput struct {
 	TopicArn *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetTopicAttributesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetTopicAttributesInput) GoString() string {
 	return s.String()
 }
@@ -4605,37 +6457,83 @@ type GetTopicAttributesOutput struct {
 
 	// A map of the topic's attributes. Attributes in this map include the following:
 	//
-	//    * TopicArn – the topic's ARN
+	//    * DeliveryPolicy – The JSON serialization of the topic's delivery policy.
+	//
+	//    * DisplayName – The human-readable name used in the From field for notifications
+	//    to email and email-json endpoints.
+	//
+	//    * EffectiveDeliveryPolicy – The JSON serialization of the effective
+	//    delivery policy, taking system defaults into account.
+	//
+	//    * Owner – The Amazon Web Services account ID of the topic's owner.
+	//
+	//    * Policy – The JSON serialization of the topic's access control policy.
+	//
+	//    * SignatureVersion – The signature version corresponds to the hashing
+	//    algorithm used while creating the signature of the notifications, subscription
+	//    confirmations, or unsubscribe confirmation messages sent by Amazon SNS.
+	//    By default, SignatureVersion is set to 1. The signature is a Base64-encoded
+	//    SHA1withRSA signature. When you set SignatureVersion to 2. Amazon SNS
+	//    uses a Base64-encoded SHA256withRSA signature. If the API response does
+	//    not include the SignatureVersion attribute, it means that the SignatureVersion
+	//    for the topic has value 1.
+	//
+	//    * SubscriptionsConfirmed – The number of confirmed subscriptions for
+	//    the topic.
 	//
-	//    * Owner – the AWS account ID of the topic's owner
+	//    * SubscriptionsDeleted – The number of deleted subscriptions for the
+	//    topic.
 	//
-	//    * Policy – the JSON serialization of the topic's access control policy
+	//    * SubscriptionsPending – The number of subscriptions pending confirmation
+	//    for the topic.
 	//
-	//    * DisplayName – the human-readable name used in the "From" field for
-	//    notifications to email and email-json endpoints
+	//    * TopicArn – The topic's ARN.
 	//
-	//    * SubscriptionsPending – the number of subscriptions pending confirmation
-	//    on this topic
+	//    * TracingConfig – Tracing mode of an Amazon SNS topic. By default TracingConfig
+	//    is set to PassThrough, and the topic passes through the tracing header
+	//    it receives from an Amazon SNS publisher to its subscriptions. If set
+	//    to Active, Amazon SNS will vend X-Ray segment data to topic owner account
+	//    if the sampled flag in the tracing header is true. This is only supported
+	//    on standard topics.
 	//
-	//    * SubscriptionsConfirmed – the number of confirmed subscriptions on
-	//    this topic
+	// The following attribute applies only to server-side-encryption (https://docs.aws.amazon.com/sns/latest/dg/sns-server-side-encryption.html):
+	//
+	//    * KmsMasterKeyId - The ID of an Amazon Web Services managed customer master
+	//    key (CMK) for Amazon SNS or a custom CMK. For more information, see Key
+	//    Terms (https://docs.aws.amazon.com/sns/latest/dg/sns-server-side-encryption.html#sse-key-terms).
+	//    For more examples, see KeyId (https://docs.aws.amazon.com/kms/latest/APIReference/API_DescribeKey.html#API_DescribeKey_RequestParameters)
+	//    in the Key Management Service API Reference.
 	//
-	//    * SubscriptionsDeleted – the number of deleted subscriptions on this
-	//    topic
+	// The following attributes apply only to FIFO topics (https://docs.aws.amazon.com/sns/latest/dg/sns-fifo-topics.html):
 	//
-	//    * DeliveryPolicy – the JSON serialization of the topic's delivery policy
+	//    * FifoTopic – When this is set to true, a FIFO topic is created.
 	//
-	//    * EffectiveDeliveryPolicy – the JSON serialization of the effective
-	//    delivery policy that takes into account system defaults
+	//    * ContentBasedDeduplication – Enables content-based deduplication for
+	//    FIFO topics. By default, ContentBasedDeduplication is set to false. If
+	//    you create a FIFO topic and this attribute is false, you must specify
+	//    a value for the MessageDeduplicationId parameter for the Publish (https://docs.aws.amazon.com/sns/latest/api/API_Publish.html)
+	//    action. When you set ContentBasedDeduplication to true, Amazon SNS uses
+	//    a SHA-256 hash to generate the MessageDeduplicationId using the body of
+	//    the message (but not the attributes of the message). (Optional) To override
+	//    the generated value, you can specify a value for the MessageDeduplicationId
+	//    parameter for the Publish action.
 	Attributes map[string]*string `type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetTopicAttributesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetTopicAttributesOutput) GoString() string {
 	return s.String()
 }
@@ -4661,12 +6559,20 @@ type ListEndpointsByPlatformApplicationInput struct {
 	PlatformApplicationArn *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListEndpointsByPlatformApplicationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListEndpointsByPlatformApplicationInput) GoString() string {
 	return s.String()
 }
@@ -4708,12 +6614,20 @@ type ListEndpointsByPlatformApplicationOutput struct {
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListEndpointsByPlatformApplicationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListEndpointsByPlatformApplicationOutput) GoString() string {
 	return s.String()
 }
@@ -4730,6 +6644,100 @@ func (s *ListEndpointsByPlatformApplicationOutput) SetNextToken(v string) *ListE
 	return s
 }
 
+type ListOriginationNumbersInput struct {
+	_ struct{} `type:"structure"`
+
+	// The maximum number of origination numbers to return.
+	MaxResults *int64 `min:"1" type:"integer"`
+
+	// Token that the previous ListOriginationNumbers request returns.
+	NextToken *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListOriginationNumbersInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListOriginationNumbersInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListOriginationNumbersInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListOriginationNumbersInput"}
+	if s.MaxResults != nil && *s.MaxResults < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListOriginationNumbersInput) SetMaxResults(v int64) *ListOriginationNumbersInput {
+	s.MaxResults = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListOriginationNumbersInput) SetNextToken(v string) *ListOriginationNumbersInput {
+	s.NextToken = &v
+	return s
+}
+
+type ListOriginationNumbersOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A NextToken string is returned when you call the ListOriginationNumbers operation
+	// if additional pages of records are available.
+	NextToken *string `type:"string"`
+
+	// A list of the calling account's verified and pending origination numbers.
+	PhoneNumbers []*PhoneNumberInformation `type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListOriginationNumbersOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListOriginationNumbersOutput) GoString() string {
+	return s.String()
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListOriginationNumbersOutput) SetNextToken(v string) *ListOriginationNumbersOutput {
+	s.NextToken = &v
+	return s
+}
+
+// SetPhoneNumbers sets the PhoneNumbers field's value.
+func (s *ListOriginationNumbersOutput) SetPhoneNumbers(v []*PhoneNumberInformation) *ListOriginationNumbersOutput {
+	s.PhoneNumbers = v
+	return s
+}
+
 // The input for the ListPhoneNumbersOptedOut action.
 type ListPhoneNumbersOptedOutInput struct {
 	_ struct{} `type:"structure"`
@@ -4740,12 +6748,20 @@ type ListPhoneNumbersOptedOutInput struct {
 	NextToken *string `locationName:"nextToken" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPhoneNumbersOptedOutInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPhoneNumbersOptedOutInput) GoString() string {
 	return s.String()
 }
@@ -4769,12 +6785,20 @@ type ListPhoneNumbersOptedOutOutput struct {
 	PhoneNumbers []*string `locationName:"phoneNumbers" type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPhoneNumbersOptedOutOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPhoneNumbersOptedOutOutput) GoString() string {
 	return s.String()
 }
@@ -4800,12 +6824,20 @@ type ListPlatformApplicationsInput struct {
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPlatformApplicationsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPlatformApplicationsInput) GoString() string {
 	return s.String()
 }
@@ -4828,12 +6860,20 @@ type ListPlatformApplicationsOutput struct {
 	PlatformApplications []*PlatformApplication `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPlatformApplicationsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListPlatformApplicationsOutput) GoString() string {
 	return s.String()
 }
@@ -4850,6 +6890,102 @@ func (s *ListPlatformApplicationsOutput) SetPlatformApplications(v []*PlatformAp
 	return s
 }
 
+type ListSMSSandboxPhoneNumbersInput struct {
+	_ struct{} `type:"structure"`
+
+	// The maximum number of phone numbers to return.
+	MaxResults *int64 `min:"1" type:"integer"`
+
+	// Token that the previous ListSMSSandboxPhoneNumbersInput request returns.
+	NextToken *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSMSSandboxPhoneNumbersInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSMSSandboxPhoneNumbersInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListSMSSandboxPhoneNumbersInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListSMSSandboxPhoneNumbersInput"}
+	if s.MaxResults != nil && *s.MaxResults < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListSMSSandboxPhoneNumbersInput) SetMaxResults(v int64) *ListSMSSandboxPhoneNumbersInput {
+	s.MaxResults = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListSMSSandboxPhoneNumbersInput) SetNextToken(v string) *ListSMSSandboxPhoneNumbersInput {
+	s.NextToken = &v
+	return s
+}
+
+type ListSMSSandboxPhoneNumbersOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A NextToken string is returned when you call the ListSMSSandboxPhoneNumbersInput
+	// operation if additional pages of records are available.
+	NextToken *string `type:"string"`
+
+	// A list of the calling account's pending and verified phone numbers.
+	//
+	// PhoneNumbers is a required field
+	PhoneNumbers []*SMSSandboxPhoneNumber `type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSMSSandboxPhoneNumbersOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListSMSSandboxPhoneNumbersOutput) GoString() string {
+	return s.String()
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListSMSSandboxPhoneNumbersOutput) SetNextToken(v string) *ListSMSSandboxPhoneNumbersOutput {
+	s.NextToken = &v
+	return s
+}
+
+// SetPhoneNumbers sets the PhoneNumbers field's value.
+func (s *ListSMSSandboxPhoneNumbersOutput) SetPhoneNumbers(v []*SMSSandboxPhoneNumber) *ListSMSSandboxPhoneNumbersOutput {
+	s.PhoneNumbers = v
+	return s
+}
+
 // Input for ListSubscriptionsByTopic action.
 type ListSubscriptionsByTopicInput struct {
 	_ struct{} `type:"structure"`
@@ -4863,12 +6999,20 @@ type ListSubscriptionsByTopicInput struct {
 	TopicArn *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListSubscriptionsByTopicInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListSubscriptionsByTopicInput) GoString() string {
 	return s.String()
 }
@@ -4910,12 +7054,20 @@ type ListSubscriptionsByTopicOutput struct {
 	Subscriptions []*Subscription `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListSubscriptionsByTopicOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListSubscriptionsByTopicOutput) GoString() string {
 	return s.String()
 }
@@ -4940,12 +7092,20 @@ type ListSubscriptionsInput struct {
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListSubscriptionsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListSubscriptionsInput) GoString() string {
 	return s.String()
 }
@@ -4968,12 +7128,20 @@ type ListSubscriptionsOutput struct {
 	Subscriptions []*Subscription `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListSubscriptionsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListSubscriptionsOutput) GoString() string {
 	return s.String()
 }
@@ -4999,12 +7167,20 @@ type ListTagsForResourceInput struct {
 	ResourceArn *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceInput) GoString() string {
 	return s.String()
 }
@@ -5038,12 +7214,20 @@ type ListTagsForResourceOutput struct {
 	Tags []*Tag `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTagsForResourceOutput) GoString() string {
 	return s.String()
 }
@@ -5061,12 +7245,20 @@ type ListTopicsInput struct {
 	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTopicsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTopicsInput) GoString() string {
 	return s.String()
 }
@@ -5089,12 +7281,20 @@ type ListTopicsOutput struct {
 	Topics []*Topic `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTopicsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListTopicsOutput) GoString() string {
 	return s.String()
 }
@@ -5118,14 +7318,15 @@ func (s *ListTopicsOutput) SetTopics(v []*Topic) *ListTopicsOutput {
 // Name, type, and value must not be empty or null. In addition, the message
 // body should not be empty or null. All parts of the message attribute, including
 // name, type, and value, are included in the message size restriction, which
-// is currently 256 KB (262,144 bytes). For more information, see Using Amazon
-// SNS Message Attributes (https://docs.aws.amazon.com/sns/latest/dg/SNSMessageAttributes.html).
+// is currently 256 KB (262,144 bytes). For more information, see Amazon SNS
+// message attributes (https://docs.aws.amazon.com/sns/latest/dg/SNSMessageAttributes.html)
+// and Publishing to a mobile phone (https://docs.aws.amazon.com/sns/latest/dg/sms_publish-to-phone.html)
+// in the Amazon SNS Developer Guide.
 type MessageAttributeValue struct {
 	_ struct{} `type:"structure"`
 
 	// Binary type attributes can store any binary data, for example, compressed
 	// data, encrypted data, or images.
-	//
 	// BinaryValue is automatically base64 encoded/decoded by the SDK.
 	BinaryValue []byte `type:"blob"`
 
@@ -5141,12 +7342,20 @@ type MessageAttributeValue struct {
 	StringValue *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s MessageAttributeValue) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s MessageAttributeValue) GoString() string {
 	return s.String()
 }
@@ -5186,18 +7395,26 @@ func (s *MessageAttributeValue) SetStringValue(v string) *MessageAttributeValue
 type OptInPhoneNumberInput struct {
 	_ struct{} `type:"structure"`
 
-	// The phone number to opt in.
+	// The phone number to opt in. Use E.164 format.
 	//
 	// PhoneNumber is a required field
 	PhoneNumber *string `locationName:"phoneNumber" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OptInPhoneNumberInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OptInPhoneNumberInput) GoString() string {
 	return s.String()
 }
@@ -5226,16 +7443,101 @@ type OptInPhoneNumberOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OptInPhoneNumberOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s OptInPhoneNumberOutput) GoString() string {
 	return s.String()
 }
 
+// A list of phone numbers and their metadata.
+type PhoneNumberInformation struct {
+	_ struct{} `type:"structure"`
+
+	// The date and time when the phone number was created.
+	CreatedAt *time.Time `type:"timestamp"`
+
+	// The two-character code for the country or region, in ISO 3166-1 alpha-2 format.
+	Iso2CountryCode *string `type:"string"`
+
+	// The capabilities of each phone number.
+	NumberCapabilities []*string `type:"list" enum:"NumberCapability"`
+
+	// The phone number.
+	PhoneNumber *string `type:"string"`
+
+	// The list of supported routes.
+	RouteType *string `type:"string" enum:"RouteType"`
+
+	// The status of the phone number.
+	Status *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PhoneNumberInformation) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PhoneNumberInformation) GoString() string {
+	return s.String()
+}
+
+// SetCreatedAt sets the CreatedAt field's value.
+func (s *PhoneNumberInformation) SetCreatedAt(v time.Time) *PhoneNumberInformation {
+	s.CreatedAt = &v
+	return s
+}
+
+// SetIso2CountryCode sets the Iso2CountryCode field's value.
+func (s *PhoneNumberInformation) SetIso2CountryCode(v string) *PhoneNumberInformation {
+	s.Iso2CountryCode = &v
+	return s
+}
+
+// SetNumberCapabilities sets the NumberCapabilities field's value.
+func (s *PhoneNumberInformation) SetNumberCapabilities(v []*string) *PhoneNumberInformation {
+	s.NumberCapabilities = v
+	return s
+}
+
+// SetPhoneNumber sets the PhoneNumber field's value.
+func (s *PhoneNumberInformation) SetPhoneNumber(v string) *PhoneNumberInformation {
+	s.PhoneNumber = &v
+	return s
+}
+
+// SetRouteType sets the RouteType field's value.
+func (s *PhoneNumberInformation) SetRouteType(v string) *PhoneNumberInformation {
+	s.RouteType = &v
+	return s
+}
+
+// SetStatus sets the Status field's value.
+func (s *PhoneNumberInformation) SetStatus(v string) *PhoneNumberInformation {
+	s.Status = &v
+	return s
+}
+
 // Platform application object.
 type PlatformApplication struct {
 	_ struct{} `type:"structure"`
@@ -5247,25 +7549,390 @@ type PlatformApplication struct {
 	PlatformApplicationArn *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PlatformApplication) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PlatformApplication) GoString() string {
 	return s.String()
 }
 
-// SetAttributes sets the Attributes field's value.
-func (s *PlatformApplication) SetAttributes(v map[string]*string) *PlatformApplication {
-	s.Attributes = v
+// SetAttributes sets the Attributes field's value.
+func (s *PlatformApplication) SetAttributes(v map[string]*string) *PlatformApplication {
+	s.Attributes = v
+	return s
+}
+
+// SetPlatformApplicationArn sets the PlatformApplicationArn field's value.
+func (s *PlatformApplication) SetPlatformApplicationArn(v string) *PlatformApplication {
+	s.PlatformApplicationArn = &v
+	return s
+}
+
+type PublishBatchInput struct {
+	_ struct{} `type:"structure"`
+
+	// A list of PublishBatch request entries to be sent to the SNS topic.
+	//
+	// PublishBatchRequestEntries is a required field
+	PublishBatchRequestEntries []*PublishBatchRequestEntry `type:"list" required:"true"`
+
+	// The Amazon resource name (ARN) of the topic you want to batch publish to.
+	//
+	// TopicArn is a required field
+	TopicArn *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PublishBatchInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PublishBatchInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *PublishBatchInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "PublishBatchInput"}
+	if s.PublishBatchRequestEntries == nil {
+		invalidParams.Add(request.NewErrParamRequired("PublishBatchRequestEntries"))
+	}
+	if s.TopicArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("TopicArn"))
+	}
+	if s.PublishBatchRequestEntries != nil {
+		for i, v := range s.PublishBatchRequestEntries {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PublishBatchRequestEntries", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetPublishBatchRequestEntries sets the PublishBatchRequestEntries field's value.
+func (s *PublishBatchInput) SetPublishBatchRequestEntries(v []*PublishBatchRequestEntry) *PublishBatchInput {
+	s.PublishBatchRequestEntries = v
+	return s
+}
+
+// SetTopicArn sets the TopicArn field's value.
+func (s *PublishBatchInput) SetTopicArn(v string) *PublishBatchInput {
+	s.TopicArn = &v
+	return s
+}
+
+type PublishBatchOutput struct {
+	_ struct{} `type:"structure"`
+
+	// A list of failed PublishBatch responses.
+	Failed []*BatchResultErrorEntry `type:"list"`
+
+	// A list of successful PublishBatch responses.
+	Successful []*PublishBatchResultEntry `type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PublishBatchOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PublishBatchOutput) GoString() string {
+	return s.String()
+}
+
+// SetFailed sets the Failed field's value.
+func (s *PublishBatchOutput) SetFailed(v []*BatchResultErrorEntry) *PublishBatchOutput {
+	s.Failed = v
+	return s
+}
+
+// SetSuccessful sets the Successful field's value.
+func (s *PublishBatchOutput) SetSuccessful(v []*PublishBatchResultEntry) *PublishBatchOutput {
+	s.Successful = v
+	return s
+}
+
+// Contains the details of a single Amazon SNS message along with an Id that
+// identifies a message within the batch.
+type PublishBatchRequestEntry struct {
+	_ struct{} `type:"structure"`
+
+	// An identifier for the message in this batch.
+	//
+	// The Ids of a batch request must be unique within a request.
+	//
+	// This identifier can have up to 80 characters. The following characters are
+	// accepted: alphanumeric characters, hyphens(-), and underscores (_).
+	//
+	// Id is a required field
+	Id *string `type:"string" required:"true"`
+
+	// The body of the message.
+	//
+	// Message is a required field
+	Message *string `type:"string" required:"true"`
+
+	// Each message attribute consists of a Name, Type, and Value. For more information,
+	// see Amazon SNS message attributes (https://docs.aws.amazon.com/sns/latest/dg/sns-message-attributes.html)
+	// in the Amazon SNS Developer Guide.
+	MessageAttributes map[string]*MessageAttributeValue `locationNameKey:"Name" locationNameValue:"Value" type:"map"`
+
+	// This parameter applies only to FIFO (first-in-first-out) topics.
+	//
+	// The token used for deduplication of messages within a 5-minute minimum deduplication
+	// interval. If a message with a particular MessageDeduplicationId is sent successfully,
+	// subsequent messages with the same MessageDeduplicationId are accepted successfully
+	// but aren't delivered.
+	//
+	//    * Every message must have a unique MessageDeduplicationId. You may provide
+	//    a MessageDeduplicationId explicitly. If you aren't able to provide a MessageDeduplicationId
+	//    and you enable ContentBasedDeduplication for your topic, Amazon SNS uses
+	//    a SHA-256 hash to generate the MessageDeduplicationId using the body of
+	//    the message (but not the attributes of the message). If you don't provide
+	//    a MessageDeduplicationId and the topic doesn't have ContentBasedDeduplication
+	//    set, the action fails with an error. If the topic has a ContentBasedDeduplication
+	//    set, your MessageDeduplicationId overrides the generated one.
+	//
+	//    * When ContentBasedDeduplication is in effect, messages with identical
+	//    content sent within the deduplication interval are treated as duplicates
+	//    and only one copy of the message is delivered.
+	//
+	//    * If you send one message with ContentBasedDeduplication enabled, and
+	//    then another message with a MessageDeduplicationId that is the same as
+	//    the one generated for the first MessageDeduplicationId, the two messages
+	//    are treated as duplicates and only one copy of the message is delivered.
+	//
+	// The MessageDeduplicationId is available to the consumer of the message (this
+	// can be useful for troubleshooting delivery issues).
+	//
+	// If a message is sent successfully but the acknowledgement is lost and the
+	// message is resent with the same MessageDeduplicationId after the deduplication
+	// interval, Amazon SNS can't detect duplicate messages.
+	//
+	// Amazon SNS continues to keep track of the message deduplication ID even after
+	// the message is received and deleted.
+	//
+	// The length of MessageDeduplicationId is 128 characters.
+	//
+	// MessageDeduplicationId can contain alphanumeric characters (a-z, A-Z, 0-9)
+	// and punctuation (!"#$%&'()*+,-./:;<=>?@[\]^_`{|}~).
+	MessageDeduplicationId *string `type:"string"`
+
+	// This parameter applies only to FIFO (first-in-first-out) topics.
+	//
+	// The tag that specifies that a message belongs to a specific message group.
+	// Messages that belong to the same message group are processed in a FIFO manner
+	// (however, messages in different message groups might be processed out of
+	// order). To interleave multiple ordered streams within a single topic, use
+	// MessageGroupId values (for example, session data for multiple users). In
+	// this scenario, multiple consumers can process the topic, but the session
+	// data of each user is processed in a FIFO fashion.
+	//
+	// You must associate a non-empty MessageGroupId with a message. If you don't
+	// provide a MessageGroupId, the action fails.
+	//
+	// The length of MessageGroupId is 128 characters.
+	//
+	// MessageGroupId can contain alphanumeric characters (a-z, A-Z, 0-9) and punctuation
+	// (!"#$%&'()*+,-./:;<=>?@[\]^_`{|}~).
+	//
+	// MessageGroupId is required for FIFO topics. You can't use it for standard
+	// topics.
+	MessageGroupId *string `type:"string"`
+
+	// Set MessageStructure to json if you want to send a different message for
+	// each protocol. For example, using one publish action, you can send a short
+	// message to your SMS subscribers and a longer message to your email subscribers.
+	// If you set MessageStructure to json, the value of the Message parameter must:
+	//
+	//    * be a syntactically valid JSON object; and
+	//
+	//    * contain at least a top-level JSON key of "default" with a value that
+	//    is a string.
+	//
+	// You can define other top-level keys that define the message you want to send
+	// to a specific transport protocol (e.g. http).
+	MessageStructure *string `type:"string"`
+
+	// The subject of the batch message.
+	Subject *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PublishBatchRequestEntry) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PublishBatchRequestEntry) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *PublishBatchRequestEntry) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "PublishBatchRequestEntry"}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
+	}
+	if s.Message == nil {
+		invalidParams.Add(request.NewErrParamRequired("Message"))
+	}
+	if s.MessageAttributes != nil {
+		for i, v := range s.MessageAttributes {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "MessageAttributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetId sets the Id field's value.
+func (s *PublishBatchRequestEntry) SetId(v string) *PublishBatchRequestEntry {
+	s.Id = &v
+	return s
+}
+
+// SetMessage sets the Message field's value.
+func (s *PublishBatchRequestEntry) SetMessage(v string) *PublishBatchRequestEntry {
+	s.Message = &v
+	return s
+}
+
+// SetMessageAttributes sets the MessageAttributes field's value.
+func (s *PublishBatchRequestEntry) SetMessageAttributes(v map[string]*MessageAttributeValue) *PublishBatchRequestEntry {
+	s.MessageAttributes = v
+	return s
+}
+
+// SetMessageDeduplicationId sets the MessageDeduplicationId field's value.
+func (s *PublishBatchRequestEntry) SetMessageDeduplicationId(v string) *PublishBatchRequestEntry {
+	s.MessageDeduplicationId = &v
+	return s
+}
+
+// SetMessageGroupId sets the MessageGroupId field's value.
+func (s *PublishBatchRequestEntry) SetMessageGroupId(v string) *PublishBatchRequestEntry {
+	s.MessageGroupId = &v
+	return s
+}
+
+// SetMessageStructure sets the MessageStructure field's value.
+func (s *PublishBatchRequestEntry) SetMessageStructure(v string) *PublishBatchRequestEntry {
+	s.MessageStructure = &v
+	return s
+}
+
+// SetSubject sets the Subject field's value.
+func (s *PublishBatchRequestEntry) SetSubject(v string) *PublishBatchRequestEntry {
+	s.Subject = &v
+	return s
+}
+
+// Encloses data related to a successful message in a batch request for topic.
+type PublishBatchResultEntry struct {
+	_ struct{} `type:"structure"`
+
+	// The Id of an entry in a batch request.
+	Id *string `type:"string"`
+
+	// An identifier for the message.
+	MessageId *string `type:"string"`
+
+	// This parameter applies only to FIFO (first-in-first-out) topics.
+	//
+	// The large, non-consecutive number that Amazon SNS assigns to each message.
+	//
+	// The length of SequenceNumber is 128 bits. SequenceNumber continues to increase
+	// for a particular MessageGroupId.
+	SequenceNumber *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PublishBatchResultEntry) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PublishBatchResultEntry) GoString() string {
+	return s.String()
+}
+
+// SetId sets the Id field's value.
+func (s *PublishBatchResultEntry) SetId(v string) *PublishBatchResultEntry {
+	s.Id = &v
+	return s
+}
+
+// SetMessageId sets the MessageId field's value.
+func (s *PublishBatchResultEntry) SetMessageId(v string) *PublishBatchResultEntry {
+	s.MessageId = &v
 	return s
 }
 
-// SetPlatformApplicationArn sets the PlatformApplicationArn field's value.
-func (s *PlatformApplication) SetPlatformApplicationArn(v string) *PlatformApplication {
-	s.PlatformApplicationArn = &v
+// SetSequenceNumber sets the SequenceNumber field's value.
+func (s *PublishBatchResultEntry) SetSequenceNumber(v string) *PublishBatchResultEntry {
+	s.SequenceNumber = &v
 	return s
 }
 
@@ -5275,9 +7942,6 @@ type PublishInput struct {
 
 	// The message you want to send.
 	//
-	// The Message parameter is always a string. If you set MessageStructure to
-	// json, you must string-encode the Message parameter.
-	//
 	// If you are publishing to a topic and you want to send the same message to
 	// all transport protocols, include the text of the message as a String value.
 	// If you want to send different messages for each transport protocol, set the
@@ -5328,6 +7992,30 @@ type PublishInput struct {
 	// Message attributes for Publish action.
 	MessageAttributes map[string]*MessageAttributeValue `locationNameKey:"Name" locationNameValue:"Value" type:"map"`
 
+	// This parameter applies only to FIFO (first-in-first-out) topics. The MessageDeduplicationId
+	// can contain up to 128 alphanumeric characters (a-z, A-Z, 0-9) and punctuation
+	// (!"#$%&'()*+,-./:;<=>?@[\]^_`{|}~).
+	//
+	// Every message must have a unique MessageDeduplicationId, which is a token
+	// used for deduplication of sent messages. If a message with a particular MessageDeduplicationId
+	// is sent successfully, any message sent with the same MessageDeduplicationId
+	// during the 5-minute deduplication interval is treated as a duplicate.
+	//
+	// If the topic has ContentBasedDeduplication set, the system generates a MessageDeduplicationId
+	// based on the contents of the message. Your MessageDeduplicationId overrides
+	// the generated one.
+	MessageDeduplicationId *string `type:"string"`
+
+	// This parameter applies only to FIFO (first-in-first-out) topics. The MessageGroupId
+	// can contain up to 128 alphanumeric characters (a-z, A-Z, 0-9) and punctuation
+	// (!"#$%&'()*+,-./:;<=>?@[\]^_`{|}~).
+	//
+	// The MessageGroupId is a tag that specifies that a message belongs to a specific
+	// message group. Messages that belong to the same message group are processed
+	// in a FIFO manner (however, messages in different message groups might be
+	// processed out of order). Every message must include a MessageGroupId.
+	MessageGroupId *string `type:"string"`
+
 	// Set MessageStructure to json if you want to send a different message for
 	// each protocol. For example, using one publish action, you can send a short
 	// message to your SMS subscribers and a longer message to your email subscribers.
@@ -5341,11 +8029,6 @@ type PublishInput struct {
 	// You can define other top-level keys that define the message you want to send
 	// to a specific transport protocol (e.g., "http").
 	//
-	// For information about sending different messages for each protocol using
-	// the AWS Management Console, go to Create Different Messages for Each Protocol
-	// (https://docs.aws.amazon.com/sns/latest/gsg/Publish.html#sns-message-formatting-by-protocol)
-	// in the Amazon Simple Notification Service Getting Started Guide.
-	//
 	// Valid value: json
 	MessageStructure *string `type:"string"`
 
@@ -5375,12 +8058,20 @@ type PublishInput struct {
 	TopicArn *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PublishInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PublishInput) GoString() string {
 	return s.String()
 }
@@ -5420,6 +8111,18 @@ func (s *PublishInput) SetMessageAttributes(v map[string]*MessageAttributeValue)
 	return s
 }
 
+// SetMessageDeduplicationId sets the MessageDeduplicationId field's value.
+func (s *PublishInput) SetMessageDeduplicationId(v string) *PublishInput {
+	s.MessageDeduplicationId = &v
+	return s
+}
+
+// SetMessageGroupId sets the MessageGroupId field's value.
+func (s *PublishInput) SetMessageGroupId(v string) *PublishInput {
+	s.MessageGroupId = &v
+	return s
+}
+
 // SetMessageStructure sets the MessageStructure field's value.
 func (s *PublishInput) SetMessageStructure(v string) *PublishInput {
 	s.MessageStructure = &v
@@ -5458,14 +8161,29 @@ type PublishOutput struct {
 	//
 	// Length Constraint: Maximum 100 characters
 	MessageId *string `type:"string"`
+
+	// This response element applies only to FIFO (first-in-first-out) topics.
+	//
+	// The sequence number is a large, non-consecutive number that Amazon SNS assigns
+	// to each message. The length of SequenceNumber is 128 bits. SequenceNumber
+	// continues to increase for each MessageGroupId.
+	SequenceNumber *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PublishOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PublishOutput) GoString() string {
 	return s.String()
 }
@@ -5476,6 +8194,101 @@ func (s *PublishOutput) SetMessageId(v string) *PublishOutput {
 	return s
 }
 
+// SetSequenceNumber sets the SequenceNumber field's value.
+func (s *PublishOutput) SetSequenceNumber(v string) *PublishOutput {
+	s.SequenceNumber = &v
+	return s
+}
+
+type PutDataProtectionPolicyInput struct {
+	_ struct{} `type:"structure"`
+
+	// The JSON serialization of the topic's DataProtectionPolicy.
+	//
+	// The DataProtectionPolicy must be in JSON string format.
+	//
+	// Length Constraints: Maximum length of 30,720.
+	//
+	// DataProtectionPolicy is a required field
+	DataProtectionPolicy *string `type:"string" required:"true"`
+
+	// The ARN of the topic whose DataProtectionPolicy you want to add or update.
+	//
+	// For more information about ARNs, see Amazon Resource Names (ARNs) (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
+	// in the Amazon Web Services General Reference.
+	//
+	// ResourceArn is a required field
+	ResourceArn *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutDataProtectionPolicyInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutDataProtectionPolicyInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *PutDataProtectionPolicyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutDataProtectionPolicyInput"}
+	if s.DataProtectionPolicy == nil {
+		invalidParams.Add(request.NewErrParamRequired("DataProtectionPolicy"))
+	}
+	if s.ResourceArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceArn"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDataProtectionPolicy sets the DataProtectionPolicy field's value.
+func (s *PutDataProtectionPolicyInput) SetDataProtectionPolicy(v string) *PutDataProtectionPolicyInput {
+	s.DataProtectionPolicy = &v
+	return s
+}
+
+// SetResourceArn sets the ResourceArn field's value.
+func (s *PutDataProtectionPolicyInput) SetResourceArn(v string) *PutDataProtectionPolicyInput {
+	s.ResourceArn = &v
+	return s
+}
+
+type PutDataProtectionPolicyOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutDataProtectionPolicyOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutDataProtectionPolicyOutput) GoString() string {
+	return s.String()
+}
+
 // Input for RemovePermission action.
 type RemovePermissionInput struct {
 	_ struct{} `type:"structure"`
@@ -5491,12 +8304,20 @@ type RemovePermissionInput struct {
 	TopicArn *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemovePermissionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemovePermissionInput) GoString() string {
 	return s.String()
 }
@@ -5533,16 +8354,75 @@ type RemovePermissionOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemovePermissionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemovePermissionOutput) GoString() string {
 	return s.String()
 }
 
+// A verified or pending destination phone number in the SMS sandbox.
+//
+// When you start using Amazon SNS to send SMS messages, your Amazon Web Services
+// account is in the SMS sandbox. The SMS sandbox provides a safe environment
+// for you to try Amazon SNS features without risking your reputation as an
+// SMS sender. While your Amazon Web Services account is in the SMS sandbox,
+// you can use all of the features of Amazon SNS. However, you can send SMS
+// messages only to verified destination phone numbers. For more information,
+// including how to move out of the sandbox to send messages without restrictions,
+// see SMS sandbox (https://docs.aws.amazon.com/sns/latest/dg/sns-sms-sandbox.html)
+// in the Amazon SNS Developer Guide.
+type SMSSandboxPhoneNumber struct {
+	_ struct{} `type:"structure"`
+
+	// The destination phone number.
+	PhoneNumber *string `type:"string"`
+
+	// The destination phone number's verification status.
+	Status *string `type:"string" enum:"SMSSandboxPhoneNumberVerificationStatus"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SMSSandboxPhoneNumber) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s SMSSandboxPhoneNumber) GoString() string {
+	return s.String()
+}
+
+// SetPhoneNumber sets the PhoneNumber field's value.
+func (s *SMSSandboxPhoneNumber) SetPhoneNumber(v string) *SMSSandboxPhoneNumber {
+	s.PhoneNumber = &v
+	return s
+}
+
+// SetStatus sets the Status field's value.
+func (s *SMSSandboxPhoneNumber) SetStatus(v string) *SMSSandboxPhoneNumber {
+	s.Status = &v
+	return s
+}
+
 // Input for SetEndpointAttributes action.
 type SetEndpointAttributesInput struct {
 	_ struct{} `type:"structure"`
@@ -5571,12 +8451,20 @@ type SetEndpointAttributesInput struct {
 	EndpointArn *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetEndpointAttributesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetEndpointAttributesInput) GoString() string {
 	return s.String()
 }
@@ -5613,12 +8501,20 @@ type SetEndpointAttributesOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetEndpointAttributesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetEndpointAttributesOutput) GoString() string {
 	return s.String()
 }
@@ -5631,27 +8527,29 @@ type SetPlatformApplicationAttributesInput struct {
 	// the following:
 	//
 	//    * PlatformCredential – The credential received from the notification
-	//    service. For APNS/APNS_SANDBOX, PlatformCredential is private key. For
-	//    GCM, PlatformCredential is "API key". For ADM, PlatformCredential is "client
-	//    secret".
+	//    service. For ADM, PlatformCredentialis client secret. For Apple Services
+	//    using certificate credentials, PlatformCredential is private key. For
+	//    Apple Services using token credentials, PlatformCredential is signing
+	//    key. For GCM (Firebase Cloud Messaging), PlatformCredential is API key.
 	//
 	//    * PlatformPrincipal – The principal received from the notification service.
-	//    For APNS/APNS_SANDBOX, PlatformPrincipal is SSL certificate. For GCM,
-	//    PlatformPrincipal is not applicable. For ADM, PlatformPrincipal is "client
-	//    id".
+	//    For ADM, PlatformPrincipalis client id. For Apple Services using certificate
+	//    credentials, PlatformPrincipal is SSL certificate. For Apple Services
+	//    using token credentials, PlatformPrincipal is signing key ID. For GCM
+	//    (Firebase Cloud Messaging), there is no PlatformPrincipal.
 	//
 	//    * EventEndpointCreated – Topic ARN to which EndpointCreated event notifications
-	//    should be sent.
+	//    are sent.
 	//
 	//    * EventEndpointDeleted – Topic ARN to which EndpointDeleted event notifications
-	//    should be sent.
+	//    are sent.
 	//
 	//    * EventEndpointUpdated – Topic ARN to which EndpointUpdate event notifications
-	//    should be sent.
+	//    are sent.
 	//
 	//    * EventDeliveryFailure – Topic ARN to which DeliveryFailure event notifications
-	//    should be sent upon Direct Publish delivery failure (permanent) to one
-	//    of the application's endpoints.
+	//    are sent upon Direct Publish delivery failure (permanent) to one of the
+	//    application's endpoints.
 	//
 	//    * SuccessFeedbackRoleArn – IAM role ARN used to give Amazon SNS write
 	//    access to use CloudWatch Logs on your behalf.
@@ -5662,6 +8560,14 @@ type SetPlatformApplicationAttributesInput struct {
 	//    * SuccessFeedbackSampleRate – Sample rate percentage (0-100) of successfully
 	//    delivered messages.
 	//
+	// The following attributes only apply to APNs token-based authentication:
+	//
+	//    * ApplePlatformTeamID – The identifier that's assigned to your Apple
+	//    developer account team.
+	//
+	//    * ApplePlatformBundleID – The bundle identifier that's assigned to your
+	//    iOS app.
+	//
 	// Attributes is a required field
 	Attributes map[string]*string `type:"map" required:"true"`
 
@@ -5671,12 +8577,20 @@ type SetPlatformApplicationAttributesInput struct {
 	PlatformApplicationArn *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetPlatformApplicationAttributesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetPlatformApplicationAttributesInput) GoString() string {
 	return s.String()
 }
@@ -5713,12 +8627,20 @@ type SetPlatformApplicationAttributesOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetPlatformApplicationAttributesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetPlatformApplicationAttributesOutput) GoString() string {
 	return s.String()
 }
@@ -5727,8 +8649,8 @@ func (s SetPlatformApplicationAttributesOutput) GoString() string {
 type SetSMSAttributesInput struct {
 	_ struct{} `type:"structure"`
 
-	// The default settings for sending SMS messages from your account. You can
-	// set values for the following attribute names:
+	// The default settings for sending SMS messages from your Amazon Web Services
+	// account. You can set values for the following attribute names:
 	//
 	// MonthlySpendLimit – The maximum amount in USD that you are willing to spend
 	// each month to send SMS messages. When Amazon SNS determines that sending
@@ -5775,7 +8697,8 @@ type SetSMSAttributesInput struct {
 	// UsageReportS3Bucket – The name of the Amazon S3 bucket to receive daily
 	// SMS usage reports from Amazon SNS. Each day, Amazon SNS will deliver a usage
 	// report as a CSV file to the bucket. The report includes the following information
-	// for each SMS message that was successfully delivered by your account:
+	// for each SMS message that was successfully delivered by your Amazon Web Services
+	// account:
 	//
 	//    * Time that the message was published (in UTC)
 	//
@@ -5795,7 +8718,7 @@ type SetSMSAttributesInput struct {
 	//    * Total number of parts
 	//
 	// To receive the report, the bucket must have a policy that allows the Amazon
-	// SNS service principle to perform the s3:PutObject and s3:GetBucketLocation
+	// SNS service principal to perform the s3:PutObject and s3:GetBucketLocation
 	// actions.
 	//
 	// For an example bucket policy and usage report, see Monitoring SMS Activity
@@ -5806,12 +8729,20 @@ type SetSMSAttributesInput struct {
 	Attributes map[string]*string `locationName:"attributes" type:"map" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetSMSAttributesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetSMSAttributesInput) GoString() string {
 	return s.String()
 }
@@ -5840,12 +8771,20 @@ type SetSMSAttributesOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetSMSAttributesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetSMSAttributesOutput) GoString() string {
 	return s.String()
 }
@@ -5857,7 +8796,7 @@ type SetSubscriptionAttributesInput struct {
 	// A map of attributes with their corresponding values.
 	//
 	// The following lists the names, descriptions, and values of the special request
-	// parameters that the SetTopicAttributes action uses:
+	// parameters that this action uses:
 	//
 	//    * DeliveryPolicy – The policy that defines how Amazon SNS retries failed
 	//    deliveries to HTTP/S endpoints.
@@ -5866,11 +8805,34 @@ type SetSubscriptionAttributesInput struct {
 	//    only a subset of messages, rather than receiving every message published
 	//    to the topic.
 	//
+	//    * FilterPolicyScope – This attribute lets you choose the filtering scope
+	//    by using one of the following string value types: MessageAttributes (default)
+	//    – The filter is applied on the message attributes. MessageBody – The
+	//    filter is applied on the message body.
+	//
 	//    * RawMessageDelivery – When set to true, enables raw message delivery
 	//    to Amazon SQS or HTTP/S endpoints. This eliminates the need for the endpoints
 	//    to process JSON formatting, which is otherwise created for Amazon SNS
 	//    metadata.
 	//
+	//    * RedrivePolicy – When specified, sends undeliverable messages to the
+	//    specified Amazon SQS dead-letter queue. Messages that can't be delivered
+	//    due to client errors (for example, when the subscribed endpoint is unreachable)
+	//    or server errors (for example, when the service that powers the subscribed
+	//    endpoint becomes unavailable) are held in the dead-letter queue for further
+	//    analysis or reprocessing.
+	//
+	// The following attribute applies only to Amazon Kinesis Data Firehose delivery
+	// stream subscriptions:
+	//
+	//    * SubscriptionRoleArn – The ARN of the IAM role that has the following:
+	//    Permission to write to the Kinesis Data Firehose delivery stream Amazon
+	//    SNS listed as a trusted entity Specifying a valid ARN for this attribute
+	//    is required for Kinesis Data Firehose delivery stream subscriptions. For
+	//    more information, see Fanout to Kinesis Data Firehose delivery streams
+	//    (https://docs.aws.amazon.com/sns/latest/dg/sns-firehose-as-subscriber.html)
+	//    in the Amazon SNS Developer Guide.
+	//
 	// AttributeName is a required field
 	AttributeName *string `type:"string" required:"true"`
 
@@ -5883,12 +8845,20 @@ type SetSubscriptionAttributesInput struct {
 	SubscriptionArn *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetSubscriptionAttributesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetSubscriptionAttributesInput) GoString() string {
 	return s.String()
 }
@@ -5931,12 +8901,20 @@ type SetSubscriptionAttributesOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetSubscriptionAttributesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetSubscriptionAttributesOutput) GoString() string {
 	return s.String()
 }
@@ -5950,6 +8928,10 @@ type SetTopicAttributesInput struct {
 	// The following lists the names, descriptions, and values of the special request
 	// parameters that the SetTopicAttributes action uses:
 	//
+	//    * ApplicationSuccessFeedbackRoleArn – Indicates failed message delivery
+	//    status for an Amazon SNS topic that is subscribed to a platform application
+	//    endpoint.
+	//
 	//    * DeliveryPolicy – The policy that defines how Amazon SNS retries failed
 	//    deliveries to HTTP/S endpoints.
 	//
@@ -5958,12 +8940,90 @@ type SetTopicAttributesInput struct {
 	//    * Policy – The policy that defines who can access your topic. By default,
 	//    only the topic owner can publish or subscribe to the topic.
 	//
+	//    * TracingConfig – Tracing mode of an Amazon SNS topic. By default TracingConfig
+	//    is set to PassThrough, and the topic passes through the tracing header
+	//    it receives from an Amazon SNS publisher to its subscriptions. If set
+	//    to Active, Amazon SNS will vend X-Ray segment data to topic owner account
+	//    if the sampled flag in the tracing header is true. This is only supported
+	//    on standard topics.
+	//
+	//    * HTTP HTTPSuccessFeedbackRoleArn – Indicates successful message delivery
+	//    status for an Amazon SNS topic that is subscribed to an HTTP endpoint.
+	//    HTTPSuccessFeedbackSampleRate – Indicates percentage of successful messages
+	//    to sample for an Amazon SNS topic that is subscribed to an HTTP endpoint.
+	//    HTTPFailureFeedbackRoleArn – Indicates failed message delivery status
+	//    for an Amazon SNS topic that is subscribed to an HTTP endpoint.
+	//
+	//    * Amazon Kinesis Data Firehose FirehoseSuccessFeedbackRoleArn – Indicates
+	//    successful message delivery status for an Amazon SNS topic that is subscribed
+	//    to an Amazon Kinesis Data Firehose endpoint. FirehoseSuccessFeedbackSampleRate
+	//    – Indicates percentage of successful messages to sample for an Amazon
+	//    SNS topic that is subscribed to an Amazon Kinesis Data Firehose endpoint.
+	//    FirehoseFailureFeedbackRoleArn – Indicates failed message delivery status
+	//    for an Amazon SNS topic that is subscribed to an Amazon Kinesis Data Firehose
+	//    endpoint.
+	//
+	//    * Lambda LambdaSuccessFeedbackRoleArn – Indicates successful message
+	//    delivery status for an Amazon SNS topic that is subscribed to an Lambda
+	//    endpoint. LambdaSuccessFeedbackSampleRate – Indicates percentage of
+	//    successful messages to sample for an Amazon SNS topic that is subscribed
+	//    to an Lambda endpoint. LambdaFailureFeedbackRoleArn – Indicates failed
+	//    message delivery status for an Amazon SNS topic that is subscribed to
+	//    an Lambda endpoint.
+	//
+	//    * Platform application endpoint ApplicationSuccessFeedbackRoleArn –
+	//    Indicates successful message delivery status for an Amazon SNS topic that
+	//    is subscribed to an Amazon Web Services application endpoint. ApplicationSuccessFeedbackSampleRate
+	//    – Indicates percentage of successful messages to sample for an Amazon
+	//    SNS topic that is subscribed to an Amazon Web Services application endpoint.
+	//    ApplicationFailureFeedbackRoleArn – Indicates failed message delivery
+	//    status for an Amazon SNS topic that is subscribed to an Amazon Web Services
+	//    application endpoint. In addition to being able to configure topic attributes
+	//    for message delivery status of notification messages sent to Amazon SNS
+	//    application endpoints, you can also configure application attributes for
+	//    the delivery status of push notification messages sent to push notification
+	//    services. For example, For more information, see Using Amazon SNS Application
+	//    Attributes for Message Delivery Status (https://docs.aws.amazon.com/sns/latest/dg/sns-msg-status.html).
+	//
+	//    * Amazon SQS SQSSuccessFeedbackRoleArn – Indicates successful message
+	//    delivery status for an Amazon SNS topic that is subscribed to an Amazon
+	//    SQS endpoint. SQSSuccessFeedbackSampleRate – Indicates percentage of
+	//    successful messages to sample for an Amazon SNS topic that is subscribed
+	//    to an Amazon SQS endpoint. SQSFailureFeedbackRoleArn – Indicates failed
+	//    message delivery status for an Amazon SNS topic that is subscribed to
+	//    an Amazon SQS endpoint.
+	//
+	// The <ENDPOINT>SuccessFeedbackRoleArn and <ENDPOINT>FailureFeedbackRoleArn
+	// attributes are used to give Amazon SNS write access to use CloudWatch Logs
+	// on your behalf. The <ENDPOINT>SuccessFeedbackSampleRate attribute is for
+	// specifying the sample rate percentage (0-100) of successfully delivered messages.
+	// After you configure the <ENDPOINT>FailureFeedbackRoleArn attribute, then
+	// all failed message deliveries generate CloudWatch Logs.
+	//
 	// The following attribute applies only to server-side-encryption (https://docs.aws.amazon.com/sns/latest/dg/sns-server-side-encryption.html):
 	//
-	//    * KmsMasterKeyId - The ID of an AWS-managed customer master key (CMK)
-	//    for Amazon SNS or a custom CMK. For more information, see Key Terms (https://docs.aws.amazon.com/sns/latest/dg/sns-server-side-encryption.html#sse-key-terms).
+	//    * KmsMasterKeyId – The ID of an Amazon Web Services managed customer
+	//    master key (CMK) for Amazon SNS or a custom CMK. For more information,
+	//    see Key Terms (https://docs.aws.amazon.com/sns/latest/dg/sns-server-side-encryption.html#sse-key-terms).
 	//    For more examples, see KeyId (https://docs.aws.amazon.com/kms/latest/APIReference/API_DescribeKey.html#API_DescribeKey_RequestParameters)
-	//    in the AWS Key Management Service API Reference.
+	//    in the Key Management Service API Reference.
+	//
+	//    * SignatureVersion – The signature version corresponds to the hashing
+	//    algorithm used while creating the signature of the notifications, subscription
+	//    confirmations, or unsubscribe confirmation messages sent by Amazon SNS.
+	//    By default, SignatureVersion is set to 1.
+	//
+	// The following attribute applies only to FIFO topics (https://docs.aws.amazon.com/sns/latest/dg/sns-fifo-topics.html):
+	//
+	//    * ContentBasedDeduplication – Enables content-based deduplication for
+	//    FIFO topics. By default, ContentBasedDeduplication is set to false. If
+	//    you create a FIFO topic and this attribute is false, you must specify
+	//    a value for the MessageDeduplicationId parameter for the Publish (https://docs.aws.amazon.com/sns/latest/api/API_Publish.html)
+	//    action. When you set ContentBasedDeduplication to true, Amazon SNS uses
+	//    a SHA-256 hash to generate the MessageDeduplicationId using the body of
+	//    the message (but not the attributes of the message). (Optional) To override
+	//    the generated value, you can specify a value for the MessageDeduplicationId
+	//    parameter for the Publish action.
 	//
 	// AttributeName is a required field
 	AttributeName *string `type:"string" required:"true"`
@@ -5977,12 +9037,20 @@ type SetTopicAttributesInput struct {
 	TopicArn *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetTopicAttributesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetTopicAttributesInput) GoString() string {
 	return s.String()
 }
@@ -6025,12 +9093,20 @@ type SetTopicAttributesOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetTopicAttributesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetTopicAttributesOutput) GoString() string {
 	return s.String()
 }
@@ -6042,7 +9118,7 @@ type SubscribeInput struct {
 	// A map of attributes with their corresponding values.
 	//
 	// The following lists the names, descriptions, and values of the special request
-	// parameters that the SetTopicAttributes action uses:
+	// parameters that the Subscribe action uses:
 	//
 	//    * DeliveryPolicy – The policy that defines how Amazon SNS retries failed
 	//    deliveries to HTTP/S endpoints.
@@ -6051,34 +9127,62 @@ type SubscribeInput struct {
 	//    only a subset of messages, rather than receiving every message published
 	//    to the topic.
 	//
+	//    * FilterPolicyScope – This attribute lets you choose the filtering scope
+	//    by using one of the following string value types: MessageAttributes (default)
+	//    – The filter is applied on the message attributes. MessageBody – The
+	//    filter is applied on the message body.
+	//
 	//    * RawMessageDelivery – When set to true, enables raw message delivery
 	//    to Amazon SQS or HTTP/S endpoints. This eliminates the need for the endpoints
 	//    to process JSON formatting, which is otherwise created for Amazon SNS
 	//    metadata.
+	//
+	//    * RedrivePolicy – When specified, sends undeliverable messages to the
+	//    specified Amazon SQS dead-letter queue. Messages that can't be delivered
+	//    due to client errors (for example, when the subscribed endpoint is unreachable)
+	//    or server errors (for example, when the service that powers the subscribed
+	//    endpoint becomes unavailable) are held in the dead-letter queue for further
+	//    analysis or reprocessing.
+	//
+	// The following attribute applies only to Amazon Kinesis Data Firehose delivery
+	// stream subscriptions:
+	//
+	//    * SubscriptionRoleArn – The ARN of the IAM role that has the following:
+	//    Permission to write to the Kinesis Data Firehose delivery stream Amazon
+	//    SNS listed as a trusted entity Specifying a valid ARN for this attribute
+	//    is required for Kinesis Data Firehose delivery stream subscriptions. For
+	//    more information, see Fanout to Kinesis Data Firehose delivery streams
+	//    (https://docs.aws.amazon.com/sns/latest/dg/sns-firehose-as-subscriber.html)
+	//    in the Amazon SNS Developer Guide.
 	Attributes map[string]*string `type:"map"`
 
 	// The endpoint that you want to receive notifications. Endpoints vary by protocol:
 	//
-	//    * For the http protocol, the endpoint is an URL beginning with "https://"
+	//    * For the http protocol, the (public) endpoint is a URL beginning with
+	//    http://.
 	//
-	//    * For the https protocol, the endpoint is a URL beginning with "https://"
+	//    * For the https protocol, the (public) endpoint is a URL beginning with
+	//    https://.
 	//
-	//    * For the email protocol, the endpoint is an email address
+	//    * For the email protocol, the endpoint is an email address.
 	//
-	//    * For the email-json protocol, the endpoint is an email address
+	//    * For the email-json protocol, the endpoint is an email address.
 	//
 	//    * For the sms protocol, the endpoint is a phone number of an SMS-enabled
-	//    device
+	//    device.
 	//
-	//    * For the sqs protocol, the endpoint is the ARN of an Amazon SQS queue
+	//    * For the sqs protocol, the endpoint is the ARN of an Amazon SQS queue.
 	//
 	//    * For the application protocol, the endpoint is the EndpointArn of a mobile
 	//    app and device.
 	//
-	//    * For the lambda protocol, the endpoint is the ARN of an AWS Lambda function.
+	//    * For the lambda protocol, the endpoint is the ARN of an Lambda function.
+	//
+	//    * For the firehose protocol, the endpoint is the ARN of an Amazon Kinesis
+	//    Data Firehose delivery stream.
 	Endpoint *string `type:"string"`
 
-	// The protocol you want to use. Supported protocols include:
+	// The protocol that you want to use. Supported protocols include:
 	//
 	//    * http – delivery of JSON-encoded message via HTTP POST
 	//
@@ -6093,9 +9197,12 @@ type SubscribeInput struct {
 	//    * sqs – delivery of JSON-encoded message to an Amazon SQS queue
 	//
 	//    * application – delivery of JSON-encoded message to an EndpointArn for
-	//    a mobile app and device.
+	//    a mobile app and device
 	//
-	//    * lambda – delivery of JSON-encoded message to an AWS Lambda function.
+	//    * lambda – delivery of JSON-encoded message to an Lambda function
+	//
+	//    * firehose – delivery of JSON-encoded message to an Amazon Kinesis Data
+	//    Firehose delivery stream.
 	//
 	// Protocol is a required field
 	Protocol *string `type:"string" required:"true"`
@@ -6103,14 +9210,12 @@ type SubscribeInput struct {
 	// Sets whether the response from the Subscribe request includes the subscription
 	// ARN, even if the subscription is not yet confirmed.
 	//
-	// If you set this parameter to false, the response includes the ARN for confirmed
-	// subscriptions, but it includes an ARN value of "pending subscription" for
-	// subscriptions that are not yet confirmed. A subscription becomes confirmed
-	// when the subscriber calls the ConfirmSubscription action with a confirmation
-	// token.
-	//
 	// If you set this parameter to true, the response includes the ARN in all cases,
-	// even if the subscription is not yet confirmed.
+	// even if the subscription is not yet confirmed. In addition to the ARN for
+	// confirmed subscriptions, the response also includes the pending subscription
+	// ARN value for subscriptions that aren't yet confirmed. A subscription becomes
+	// confirmed when the subscriber calls the ConfirmSubscription action with a
+	// confirmation token.
 	//
 	// The default value is false.
 	ReturnSubscriptionArn *bool `type:"boolean"`
@@ -6121,12 +9226,20 @@ type SubscribeInput struct {
 	TopicArn *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SubscribeInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SubscribeInput) GoString() string {
 	return s.String()
 }
@@ -6188,12 +9301,20 @@ type SubscribeOutput struct {
 	SubscriptionArn *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SubscribeOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SubscribeOutput) GoString() string {
 	return s.String()
 }
@@ -6224,12 +9345,20 @@ type Subscription struct {
 	TopicArn *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Subscription) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Subscription) GoString() string {
 	return s.String()
 }
@@ -6279,12 +9408,20 @@ type Tag struct {
 	Value *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Tag) GoString() string {
 	return s.String()
 }
@@ -6335,12 +9472,20 @@ type TagResourceInput struct {
 	Tags []*Tag `type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceInput) GoString() string {
 	return s.String()
 }
@@ -6390,12 +9535,20 @@ type TagResourceOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagResourceOutput) GoString() string {
 	return s.String()
 }
@@ -6409,12 +9562,20 @@ type Topic struct {
 	TopicArn *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Topic) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Topic) GoString() string {
 	return s.String()
 }
@@ -6435,12 +9596,20 @@ type UnsubscribeInput struct {
 	SubscriptionArn *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UnsubscribeInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UnsubscribeInput) GoString() string {
 	return s.String()
 }
@@ -6468,12 +9637,20 @@ type UnsubscribeOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UnsubscribeOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UnsubscribeOutput) GoString() string {
 	return s.String()
 }
@@ -6492,12 +9669,20 @@ type UntagResourceInput struct {
 	TagKeys []*string `type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceInput) GoString() string {
 	return s.String()
 }
@@ -6537,12 +9722,232 @@ type UntagResourceOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagResourceOutput) GoString() string {
 	return s.String()
 }
+
+type VerifySMSSandboxPhoneNumberInput struct {
+	_ struct{} `type:"structure"`
+
+	// The OTP sent to the destination number from the CreateSMSSandBoxPhoneNumber
+	// call.
+	//
+	// OneTimePassword is a required field
+	OneTimePassword *string `min:"5" type:"string" required:"true"`
+
+	// The destination phone number to verify.
+	//
+	// PhoneNumber is a required field
+	PhoneNumber *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VerifySMSSandboxPhoneNumberInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VerifySMSSandboxPhoneNumberInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *VerifySMSSandboxPhoneNumberInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "VerifySMSSandboxPhoneNumberInput"}
+	if s.OneTimePassword == nil {
+		invalidParams.Add(request.NewErrParamRequired("OneTimePassword"))
+	}
+	if s.OneTimePassword != nil && len(*s.OneTimePassword) < 5 {
+		invalidParams.Add(request.NewErrParamMinLen("OneTimePassword", 5))
+	}
+	if s.PhoneNumber == nil {
+		invalidParams.Add(request.NewErrParamRequired("PhoneNumber"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetOneTimePassword sets the OneTimePassword field's value.
+func (s *VerifySMSSandboxPhoneNumberInput) SetOneTimePassword(v string) *VerifySMSSandboxPhoneNumberInput {
+	s.OneTimePassword = &v
+	return s
+}
+
+// SetPhoneNumber sets the PhoneNumber field's value.
+func (s *VerifySMSSandboxPhoneNumberInput) SetPhoneNumber(v string) *VerifySMSSandboxPhoneNumberInput {
+	s.PhoneNumber = &v
+	return s
+}
+
+// The destination phone number's verification status.
+type VerifySMSSandboxPhoneNumberOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VerifySMSSandboxPhoneNumberOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s VerifySMSSandboxPhoneNumberOutput) GoString() string {
+	return s.String()
+}
+
+// Supported language code for sending OTP message
+const (
+	// LanguageCodeStringEnUs is a LanguageCodeString enum value
+	LanguageCodeStringEnUs = "en-US"
+
+	// LanguageCodeStringEnGb is a LanguageCodeString enum value
+	LanguageCodeStringEnGb = "en-GB"
+
+	// LanguageCodeStringEs419 is a LanguageCodeString enum value
+	LanguageCodeStringEs419 = "es-419"
+
+	// LanguageCodeStringEsEs is a LanguageCodeString enum value
+	LanguageCodeStringEsEs = "es-ES"
+
+	// LanguageCodeStringDeDe is a LanguageCodeString enum value
+	LanguageCodeStringDeDe = "de-DE"
+
+	// LanguageCodeStringFrCa is a LanguageCodeString enum value
+	LanguageCodeStringFrCa = "fr-CA"
+
+	// LanguageCodeStringFrFr is a LanguageCodeString enum value
+	LanguageCodeStringFrFr = "fr-FR"
+
+	// LanguageCodeStringItIt is a LanguageCodeString enum value
+	LanguageCodeStringItIt = "it-IT"
+
+	// LanguageCodeStringJaJp is a LanguageCodeString enum value
+	LanguageCodeStringJaJp = "ja-JP"
+
+	// LanguageCodeStringPtBr is a LanguageCodeString enum value
+	LanguageCodeStringPtBr = "pt-BR"
+
+	// LanguageCodeStringKrKr is a LanguageCodeString enum value
+	LanguageCodeStringKrKr = "kr-KR"
+
+	// LanguageCodeStringZhCn is a LanguageCodeString enum value
+	LanguageCodeStringZhCn = "zh-CN"
+
+	// LanguageCodeStringZhTw is a LanguageCodeString enum value
+	LanguageCodeStringZhTw = "zh-TW"
+)
+
+// LanguageCodeString_Values returns all elements of the LanguageCodeString enum
+func LanguageCodeString_Values() []string {
+	return []string{
+		LanguageCodeStringEnUs,
+		LanguageCodeStringEnGb,
+		LanguageCodeStringEs419,
+		LanguageCodeStringEsEs,
+		LanguageCodeStringDeDe,
+		LanguageCodeStringFrCa,
+		LanguageCodeStringFrFr,
+		LanguageCodeStringItIt,
+		LanguageCodeStringJaJp,
+		LanguageCodeStringPtBr,
+		LanguageCodeStringKrKr,
+		LanguageCodeStringZhCn,
+		LanguageCodeStringZhTw,
+	}
+}
+
+// Enum listing out all supported number capabilities.
+const (
+	// NumberCapabilitySms is a NumberCapability enum value
+	NumberCapabilitySms = "SMS"
+
+	// NumberCapabilityMms is a NumberCapability enum value
+	NumberCapabilityMms = "MMS"
+
+	// NumberCapabilityVoice is a NumberCapability enum value
+	NumberCapabilityVoice = "VOICE"
+)
+
+// NumberCapability_Values returns all elements of the NumberCapability enum
+func NumberCapability_Values() []string {
+	return []string{
+		NumberCapabilitySms,
+		NumberCapabilityMms,
+		NumberCapabilityVoice,
+	}
+}
+
+// Enum listing out all supported route types. The following enum values are
+// supported. 1. Transactional : Non-marketing traffic 2. Promotional : Marketing
+// 3. Premium : Premium routes for OTP delivery to the carriers
+const (
+	// RouteTypeTransactional is a RouteType enum value
+	RouteTypeTransactional = "Transactional"
+
+	// RouteTypePromotional is a RouteType enum value
+	RouteTypePromotional = "Promotional"
+
+	// RouteTypePremium is a RouteType enum value
+	RouteTypePremium = "Premium"
+)
+
+// RouteType_Values returns all elements of the RouteType enum
+func RouteType_Values() []string {
+	return []string{
+		RouteTypeTransactional,
+		RouteTypePromotional,
+		RouteTypePremium,
+	}
+}
+
+// Enum listing out all supported destination phone number verification statuses.
+// The following enum values are supported. 1. PENDING : The destination phone
+// number is pending verification. 2. VERIFIED : The destination phone number
+// is verified.
+const (
+	// SMSSandboxPhoneNumberVerificationStatusPending is a SMSSandboxPhoneNumberVerificationStatus enum value
+	SMSSandboxPhoneNumberVerificationStatusPending = "Pending"
+
+	// SMSSandboxPhoneNumberVerificationStatusVerified is a SMSSandboxPhoneNumberVerificationStatus enum value
+	SMSSandboxPhoneNumberVerificationStatusVerified = "Verified"
+)
+
+// SMSSandboxPhoneNumberVerificationStatus_Values returns all elements of the SMSSandboxPhoneNumberVerificationStatus enum
+func SMSSandboxPhoneNumberVerificationStatus_Values() []string {
+	return []string{
+		SMSSandboxPhoneNumberVerificationStatusPending,
+		SMSSandboxPhoneNumberVerificationStatusVerified,
+	}
+}
@@ -7,10 +7,14 @@
 // you to build distributed web-enabled applications. Applications can use Amazon
 // SNS to easily push real-time notification messages to interested subscribers
 // over multiple delivery protocols. For more information about this product
-// see https://aws.amazon.com/sns (http://aws.amazon.com/sns/). For detailed
+// see the Amazon SNS product page (http://aws.amazon.com/sns/). For detailed
 // information about Amazon SNS features and their associated API calls, see
 // the Amazon SNS Developer Guide (https://docs.aws.amazon.com/sns/latest/dg/).
 //
+// For information on the permissions you need to use this API, see Identity
+// and access management in Amazon SNS (https://docs.aws.amazon.com/sns/latest/dg/sns-authentication-and-access-control.html)
+// in the Amazon SNS Developer Guide.
+//
 // We also provide SDKs that enable you to access Amazon SNS from your preferred
 // programming language. The SDKs contain functionality that automatically takes
 // care of tasks such as: cryptographically signing your service requests, retrying
@@ -22,7 +26,7 @@
 // See sns package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/sns/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon Simple Notification Service with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -28,14 +28,13 @@ const opAddPermission = "AddPermission"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AddPermissionRequest method.
+//	req, resp := client.AddPermissionRequest(params)
 //
-//    // Example sending a request using the AddPermissionRequest method.
-//    req, resp := client.AddPermissionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/AddPermission
 func (c *SQS) AddPermissionRequest(input *AddPermissionInput) (req *request.Request, output *AddPermissionOutput) {
@@ -64,30 +63,24 @@ func (c *SQS) AddPermissionRequest(input *AddPermissionInput) (req *request.Requ
 // Only you, the owner of the queue, can grant or deny permissions to the queue.
 // For more information about these permissions, see Allow Developers to Write
 // Messages to a Shared Queue (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-writing-an-sqs-policy.html#write-messages-to-shared-queue)
-// in the Amazon Simple Queue Service Developer Guide.
-//
-//    * AddPermission generates a policy for you. You can use SetQueueAttributes
-//    to upload your policy. For more information, see Using Custom Policies
-//    with the Amazon SQS Access Policy Language (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-creating-custom-policies.html)
-//    in the Amazon Simple Queue Service Developer Guide.
+// in the Amazon SQS Developer Guide.
 //
-//    * An Amazon SQS policy can have a maximum of 7 actions.
+//   - AddPermission generates a policy for you. You can use SetQueueAttributes
+//     to upload your policy. For more information, see Using Custom Policies
+//     with the Amazon SQS Access Policy Language (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-creating-custom-policies.html)
+//     in the Amazon SQS Developer Guide.
 //
-//    * To remove the ability to change queue permissions, you must deny permission
-//    to the AddPermission, RemovePermission, and SetQueueAttributes actions
-//    in your IAM policy.
+//   - An Amazon SQS policy can have a maximum of seven actions per statement.
 //
-// Some actions take lists of parameters. These lists are specified using the
-// param.n notation. Values of n are integers starting from 1. For example,
-// a parameter list with two elements looks like this:
+//   - To remove the ability to change queue permissions, you must deny permission
+//     to the AddPermission, RemovePermission, and SetQueueAttributes actions
+//     in your IAM policy.
 //
-// &Attribute.1=first
-//
-// &Attribute.2=second
+//   - Amazon SQS AddPermission does not support adding a non-account principal.
 //
 // Cross-account permissions don't apply to this action. For more information,
-// see Grant Cross-Account Permissions to a Role and a User Name (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-customer-managed-policy-examples.html#grant-cross-account-permissions-to-role-and-user-name)
-// in the Amazon Simple Queue Service Developer Guide.
+// see Grant cross-account permissions to a role and a username (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-customer-managed-policy-examples.html#grant-cross-account-permissions-to-role-and-user-name)
+// in the Amazon SQS Developer Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -97,11 +90,11 @@ func (c *SQS) AddPermissionRequest(input *AddPermissionInput) (req *request.Requ
 // API operation AddPermission for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeOverLimit "OverLimit"
-//   The specified action violates a limit. For example, ReceiveMessage returns
-//   this error if the maximum number of inflight messages is reached and AddPermission
-//   returns this error if the maximum number of permissions for the queue is
-//   reached.
+//   - ErrCodeOverLimit "OverLimit"
+//     The specified action violates a limit. For example, ReceiveMessage returns
+//     this error if the maximum number of in flight messages is reached and AddPermission
+//     returns this error if the maximum number of permissions for the queue is
+//     reached.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/AddPermission
 func (c *SQS) AddPermission(input *AddPermissionInput) (*AddPermissionOutput, error) {
@@ -125,6 +118,103 @@ func (c *SQS) AddPermissionWithContext(ctx aws.Context, input *AddPermissionInpu
 	return out, req.Send()
 }
 
+const opCancelMessageMoveTask = "CancelMessageMoveTask"
+
+// CancelMessageMoveTaskRequest generates a "aws/request.Request" representing the
+// client's request for the CancelMessageMoveTask operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See CancelMessageMoveTask for more information on using the CancelMessageMoveTask
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the CancelMessageMoveTaskRequest method.
+//	req, resp := client.CancelMessageMoveTaskRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/CancelMessageMoveTask
+func (c *SQS) CancelMessageMoveTaskRequest(input *CancelMessageMoveTaskInput) (req *request.Request, output *CancelMessageMoveTaskOutput) {
+	op := &request.Operation{
+		Name:       opCancelMessageMoveTask,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &CancelMessageMoveTaskInput{}
+	}
+
+	output = &CancelMessageMoveTaskOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// CancelMessageMoveTask API operation for Amazon Simple Queue Service.
+//
+// Cancels a specified message movement task. A message movement can only be
+// cancelled when the current status is RUNNING. Cancelling a message movement
+// task does not revert the messages that have already been moved. It can only
+// stop the messages that have not been moved yet.
+//
+//   - This action is currently limited to supporting message redrive from
+//     dead-letter queues (DLQs) (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-dead-letter-queues.html)
+//     only. In this context, the source queue is the dead-letter queue (DLQ),
+//     while the destination queue can be the original source queue (from which
+//     the messages were driven to the dead-letter-queue), or a custom destination
+//     queue.
+//
+//   - Currently, only standard queues are supported.
+//
+//   - Only one active message movement task is supported per queue at any
+//     given time.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Simple Queue Service's
+// API operation CancelMessageMoveTask for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeResourceNotFoundException "ResourceNotFoundException"
+//     One or more specified resources don't exist.
+//
+//   - ErrCodeUnsupportedOperation "AWS.SimpleQueueService.UnsupportedOperation"
+//     Error code 400. Unsupported operation.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/CancelMessageMoveTask
+func (c *SQS) CancelMessageMoveTask(input *CancelMessageMoveTaskInput) (*CancelMessageMoveTaskOutput, error) {
+	req, out := c.CancelMessageMoveTaskRequest(input)
+	return out, req.Send()
+}
+
+// CancelMessageMoveTaskWithContext is the same as CancelMessageMoveTask with the addition of
+// the ability to pass a context and additional request options.
+//
+// See CancelMessageMoveTask for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *SQS) CancelMessageMoveTaskWithContext(ctx aws.Context, input *CancelMessageMoveTaskInput, opts ...request.Option) (*CancelMessageMoveTaskOutput, error) {
+	req, out := c.CancelMessageMoveTaskRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opChangeMessageVisibility = "ChangeMessageVisibility"
 
 // ChangeMessageVisibilityRequest generates a "aws/request.Request" representing the
@@ -141,14 +231,13 @@ const opChangeMessageVisibility = "ChangeMessageVisibility"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ChangeMessageVisibilityRequest method.
+//	req, resp := client.ChangeMessageVisibilityRequest(params)
 //
-//    // Example sending a request using the ChangeMessageVisibilityRequest method.
-//    req, resp := client.ChangeMessageVisibilityRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/ChangeMessageVisibility
 func (c *SQS) ChangeMessageVisibilityRequest(input *ChangeMessageVisibilityInput) (req *request.Request, output *ChangeMessageVisibilityOutput) {
@@ -174,13 +263,15 @@ func (c *SQS) ChangeMessageVisibilityRequest(input *ChangeMessageVisibilityInput
 // value. The default visibility timeout for a message is 30 seconds. The minimum
 // is 0 seconds. The maximum is 12 hours. For more information, see Visibility
 // Timeout (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-visibility-timeout.html)
-// in the Amazon Simple Queue Service Developer Guide.
+// in the Amazon SQS Developer Guide.
 //
-// For example, you have a message with a visibility timeout of 5 minutes. After
-// 3 minutes, you call ChangeMessageVisibility with a timeout of 10 minutes.
-// You can continue to call ChangeMessageVisibility to extend the visibility
-// timeout to the maximum allowed time. If you try to extend the visibility
-// timeout beyond the maximum, your request is rejected.
+// For example, if the default timeout for a queue is 60 seconds, 15 seconds
+// have elapsed since you received the message, and you send a ChangeMessageVisibility
+// call with VisibilityTimeout set to 10 seconds, the 10 seconds begin to count
+// from the time that you make the ChangeMessageVisibility call. Thus, any attempt
+// to change the visibility timeout or to delete that message 10 seconds after
+// you initially change the visibility timeout (a total of 25 seconds) might
+// result in an error.
 //
 // An Amazon SQS message has three basic states:
 //
@@ -195,20 +286,20 @@ func (c *SQS) ChangeMessageVisibilityRequest(input *ChangeMessageVisibilityInput
 // 1 and 2). There is no limit to the number of stored messages. A message is
 // considered to be in flight after it is received from a queue by a consumer,
 // but not yet deleted from the queue (that is, between states 2 and 3). There
-// is a limit to the number of inflight messages.
+// is a limit to the number of in flight messages.
 //
-// Limits that apply to inflight messages are unrelated to the unlimited number
+// Limits that apply to in flight messages are unrelated to the unlimited number
 // of stored messages.
 //
 // For most standard queues (depending on queue traffic and message backlog),
-// there can be a maximum of approximately 120,000 inflight messages (received
+// there can be a maximum of approximately 120,000 in flight messages (received
 // from a queue by a consumer, but not yet deleted from the queue). If you reach
 // this limit, Amazon SQS returns the OverLimit error message. To avoid reaching
 // the limit, you should delete messages from the queue after they're processed.
 // You can also increase the number of queues you use to process your messages.
 // To request a limit increase, file a support request (https://console.aws.amazon.com/support/home#/case/create?issueType=service-limit-increase&limitType=service-code-sqs).
 //
-// For FIFO queues, there can be a maximum of 20,000 inflight messages (received
+// For FIFO queues, there can be a maximum of 20,000 in flight messages (received
 // from a queue by a consumer, but not yet deleted from the queue). If you reach
 // this limit, Amazon SQS returns no error messages.
 //
@@ -231,11 +322,12 @@ func (c *SQS) ChangeMessageVisibilityRequest(input *ChangeMessageVisibilityInput
 // API operation ChangeMessageVisibility for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeMessageNotInflight "AWS.SimpleQueueService.MessageNotInflight"
-//   The specified message isn't in flight.
 //
-//   * ErrCodeReceiptHandleIsInvalid "ReceiptHandleIsInvalid"
-//   The specified receipt handle isn't valid.
+//   - ErrCodeMessageNotInflight "AWS.SimpleQueueService.MessageNotInflight"
+//     The specified message isn't in flight.
+//
+//   - ErrCodeReceiptHandleIsInvalid "ReceiptHandleIsInvalid"
+//     The specified receipt handle isn't valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/ChangeMessageVisibility
 func (c *SQS) ChangeMessageVisibility(input *ChangeMessageVisibilityInput) (*ChangeMessageVisibilityOutput, error) {
@@ -275,14 +367,13 @@ const opChangeMessageVisibilityBatch = "ChangeMessageVisibilityBatch"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ChangeMessageVisibilityBatchRequest method.
+//	req, resp := client.ChangeMessageVisibilityBatchRequest(params)
 //
-//    // Example sending a request using the ChangeMessageVisibilityBatchRequest method.
-//    req, resp := client.ChangeMessageVisibilityBatchRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/ChangeMessageVisibilityBatch
 func (c *SQS) ChangeMessageVisibilityBatchRequest(input *ChangeMessageVisibilityBatchInput) (req *request.Request, output *ChangeMessageVisibilityBatchOutput) {
@@ -312,14 +403,6 @@ func (c *SQS) ChangeMessageVisibilityBatchRequest(input *ChangeMessageVisibility
 // actions, you should check for batch errors even when the call returns an
 // HTTP status code of 200.
 //
-// Some actions take lists of parameters. These lists are specified using the
-// param.n notation. Values of n are integers starting from 1. For example,
-// a parameter list with two elements looks like this:
-//
-// &Attribute.1=first
-//
-// &Attribute.2=second
-//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -328,17 +411,18 @@ func (c *SQS) ChangeMessageVisibilityBatchRequest(input *ChangeMessageVisibility
 // API operation ChangeMessageVisibilityBatch for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeTooManyEntriesInBatchRequest "AWS.SimpleQueueService.TooManyEntriesInBatchRequest"
-//   The batch request contains more entries than permissible.
 //
-//   * ErrCodeEmptyBatchRequest "AWS.SimpleQueueService.EmptyBatchRequest"
-//   The batch request doesn't contain any entries.
+//   - ErrCodeTooManyEntriesInBatchRequest "AWS.SimpleQueueService.TooManyEntriesInBatchRequest"
+//     The batch request contains more entries than permissible.
+//
+//   - ErrCodeEmptyBatchRequest "AWS.SimpleQueueService.EmptyBatchRequest"
+//     The batch request doesn't contain any entries.
 //
-//   * ErrCodeBatchEntryIdsNotDistinct "AWS.SimpleQueueService.BatchEntryIdsNotDistinct"
-//   Two or more batch entries in the request have the same Id.
+//   - ErrCodeBatchEntryIdsNotDistinct "AWS.SimpleQueueService.BatchEntryIdsNotDistinct"
+//     Two or more batch entries in the request have the same Id.
 //
-//   * ErrCodeInvalidBatchEntryId "AWS.SimpleQueueService.InvalidBatchEntryId"
-//   The Id of a batch entry in a batch request doesn't abide by the specification.
+//   - ErrCodeInvalidBatchEntryId "AWS.SimpleQueueService.InvalidBatchEntryId"
+//     The Id of a batch entry in a batch request doesn't abide by the specification.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/ChangeMessageVisibilityBatch
 func (c *SQS) ChangeMessageVisibilityBatch(input *ChangeMessageVisibilityBatchInput) (*ChangeMessageVisibilityBatchOutput, error) {
@@ -378,14 +462,13 @@ const opCreateQueue = "CreateQueue"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateQueueRequest method.
+//	req, resp := client.CreateQueueRequest(params)
 //
-//    // Example sending a request using the CreateQueueRequest method.
-//    req, resp := client.CreateQueueRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/CreateQueue
 func (c *SQS) CreateQueueRequest(input *CreateQueueInput) (req *request.Request, output *CreateQueueOutput) {
@@ -407,47 +490,42 @@ func (c *SQS) CreateQueueRequest(input *CreateQueueInput) (req *request.Request,
 // CreateQueue API operation for Amazon Simple Queue Service.
 //
 // Creates a new standard or FIFO queue. You can pass one or more attributes
-// in the request. Keep the following caveats in mind:
+// in the request. Keep the following in mind:
 //
-//    * If you don't specify the FifoQueue attribute, Amazon SQS creates a standard
-//    queue. You can't change the queue type after you create it and you can't
-//    convert an existing standard queue into a FIFO queue. You must either
-//    create a new FIFO queue for your application or delete your existing standard
-//    queue and recreate it as a FIFO queue. For more information, see Moving
-//    From a Standard Queue to a FIFO Queue (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues.html#FIFO-queues-moving)
-//    in the Amazon Simple Queue Service Developer Guide.
+//   - If you don't specify the FifoQueue attribute, Amazon SQS creates a standard
+//     queue. You can't change the queue type after you create it and you can't
+//     convert an existing standard queue into a FIFO queue. You must either
+//     create a new FIFO queue for your application or delete your existing standard
+//     queue and recreate it as a FIFO queue. For more information, see Moving
+//     From a Standard Queue to a FIFO Queue (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues.html#FIFO-queues-moving)
+//     in the Amazon SQS Developer Guide.
 //
-//    * If you don't provide a value for an attribute, the queue is created
-//    with the default value for the attribute.
+//   - If you don't provide a value for an attribute, the queue is created
+//     with the default value for the attribute.
 //
-//    * If you delete a queue, you must wait at least 60 seconds before creating
-//    a queue with the same name.
+//   - If you delete a queue, you must wait at least 60 seconds before creating
+//     a queue with the same name.
 //
 // To successfully create a new queue, you must provide a queue name that adheres
 // to the limits related to queues (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/limits-queues.html)
 // and is unique within the scope of your queues.
 //
+// After you create a queue, you must wait at least one second after the queue
+// is created to be able to use the queue.
+//
 // To get the queue URL, use the GetQueueUrl action. GetQueueUrl requires only
 // the QueueName parameter. be aware of existing queue names:
 //
-//    * If you provide the name of an existing queue along with the exact names
-//    and values of all the queue's attributes, CreateQueue returns the queue
-//    URL for the existing queue.
+//   - If you provide the name of an existing queue along with the exact names
+//     and values of all the queue's attributes, CreateQueue returns the queue
+//     URL for the existing queue.
 //
-//    * If the queue name, attribute names, or attribute values don't match
-//    an existing queue, CreateQueue returns an error.
-//
-// Some actions take lists of parameters. These lists are specified using the
-// param.n notation. Values of n are integers starting from 1. For example,
-// a parameter list with two elements looks like this:
-//
-// &Attribute.1=first
-//
-// &Attribute.2=second
+//   - If the queue name, attribute names, or attribute values don't match
+//     an existing queue, CreateQueue returns an error.
 //
 // Cross-account permissions don't apply to this action. For more information,
-// see Grant Cross-Account Permissions to a Role and a User Name (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-customer-managed-policy-examples.html#grant-cross-account-permissions-to-role-and-user-name)
-// in the Amazon Simple Queue Service Developer Guide.
+// see Grant cross-account permissions to a role and a username (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-customer-managed-policy-examples.html#grant-cross-account-permissions-to-role-and-user-name)
+// in the Amazon SQS Developer Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -457,14 +535,15 @@ func (c *SQS) CreateQueueRequest(input *CreateQueueInput) (req *request.Request,
 // API operation CreateQueue for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeQueueDeletedRecently "AWS.SimpleQueueService.QueueDeletedRecently"
-//   You must wait 60 seconds after deleting a queue before you can create another
-//   queue with the same name.
 //
-//   * ErrCodeQueueNameExists "QueueAlreadyExists"
-//   A queue with this name already exists. Amazon SQS returns this error only
-//   if the request includes attributes whose values differ from those of the
-//   existing queue.
+//   - ErrCodeQueueDeletedRecently "AWS.SimpleQueueService.QueueDeletedRecently"
+//     You must wait 60 seconds after deleting a queue before you can create another
+//     queue with the same name.
+//
+//   - ErrCodeQueueNameExists "QueueAlreadyExists"
+//     A queue with this name already exists. Amazon SQS returns this error only
+//     if the request includes attributes whose values differ from those of the
+//     existing queue.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/CreateQueue
 func (c *SQS) CreateQueue(input *CreateQueueInput) (*CreateQueueOutput, error) {
@@ -504,14 +583,13 @@ const opDeleteMessage = "DeleteMessage"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteMessageRequest method.
+//	req, resp := client.DeleteMessageRequest(params)
 //
-//    // Example sending a request using the DeleteMessageRequest method.
-//    req, resp := client.DeleteMessageRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/DeleteMessage
 func (c *SQS) DeleteMessageRequest(input *DeleteMessageInput) (req *request.Request, output *DeleteMessageOutput) {
@@ -544,7 +622,7 @@ func (c *SQS) DeleteMessageRequest(input *DeleteMessageInput) (req *request.Requ
 // If you receive a message more than once, the ReceiptHandle is different each
 // time you receive a message. When you use the DeleteMessage action, you must
 // provide the most recently received ReceiptHandle for the message (otherwise,
-// the request succeeds, but the message might not be deleted).
+// the request succeeds, but the message will not be deleted).
 //
 // For standard queues, it is possible to receive a message even after you delete
 // it. This might happen on rare occasions if one of the servers which stores
@@ -562,11 +640,12 @@ func (c *SQS) DeleteMessageRequest(input *DeleteMessageInput) (req *request.Requ
 // API operation DeleteMessage for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidIdFormat "InvalidIdFormat"
-//   The specified receipt handle isn't valid for the current version.
 //
-//   * ErrCodeReceiptHandleIsInvalid "ReceiptHandleIsInvalid"
-//   The specified receipt handle isn't valid.
+//   - ErrCodeInvalidIdFormat "InvalidIdFormat"
+//     The specified receipt handle isn't valid for the current version.
+//
+//   - ErrCodeReceiptHandleIsInvalid "ReceiptHandleIsInvalid"
+//     The specified receipt handle isn't valid.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/DeleteMessage
 func (c *SQS) DeleteMessage(input *DeleteMessageInput) (*DeleteMessageOutput, error) {
@@ -606,14 +685,13 @@ const opDeleteMessageBatch = "DeleteMessageBatch"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteMessageBatchRequest method.
+//	req, resp := client.DeleteMessageBatchRequest(params)
 //
-//    // Example sending a request using the DeleteMessageBatchRequest method.
-//    req, resp := client.DeleteMessageBatchRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/DeleteMessageBatch
 func (c *SQS) DeleteMessageBatchRequest(input *DeleteMessageBatchInput) (req *request.Request, output *DeleteMessageBatchOutput) {
@@ -642,14 +720,6 @@ func (c *SQS) DeleteMessageBatchRequest(input *DeleteMessageBatchInput) (req *re
 // actions, you should check for batch errors even when the call returns an
 // HTTP status code of 200.
 //
-// Some actions take lists of parameters. These lists are specified using the
-// param.n notation. Values of n are integers starting from 1. For example,
-// a parameter list with two elements looks like this:
-//
-// &Attribute.1=first
-//
-// &Attribute.2=second
-//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -658,17 +728,18 @@ func (c *SQS) DeleteMessageBatchRequest(input *DeleteMessageBatchInput) (req *re
 // API operation DeleteMessageBatch for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeTooManyEntriesInBatchRequest "AWS.SimpleQueueService.TooManyEntriesInBatchRequest"
-//   The batch request contains more entries than permissible.
 //
-//   * ErrCodeEmptyBatchRequest "AWS.SimpleQueueService.EmptyBatchRequest"
-//   The batch request doesn't contain any entries.
+//   - ErrCodeTooManyEntriesInBatchRequest "AWS.SimpleQueueService.TooManyEntriesInBatchRequest"
+//     The batch request contains more entries than permissible.
 //
-//   * ErrCodeBatchEntryIdsNotDistinct "AWS.SimpleQueueService.BatchEntryIdsNotDistinct"
-//   Two or more batch entries in the request have the same Id.
+//   - ErrCodeEmptyBatchRequest "AWS.SimpleQueueService.EmptyBatchRequest"
+//     The batch request doesn't contain any entries.
 //
-//   * ErrCodeInvalidBatchEntryId "AWS.SimpleQueueService.InvalidBatchEntryId"
-//   The Id of a batch entry in a batch request doesn't abide by the specification.
+//   - ErrCodeBatchEntryIdsNotDistinct "AWS.SimpleQueueService.BatchEntryIdsNotDistinct"
+//     Two or more batch entries in the request have the same Id.
+//
+//   - ErrCodeInvalidBatchEntryId "AWS.SimpleQueueService.InvalidBatchEntryId"
+//     The Id of a batch entry in a batch request doesn't abide by the specification.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/DeleteMessageBatch
 func (c *SQS) DeleteMessageBatch(input *DeleteMessageBatchInput) (*DeleteMessageBatchOutput, error) {
@@ -708,14 +779,13 @@ const opDeleteQueue = "DeleteQueue"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteQueueRequest method.
+//	req, resp := client.DeleteQueueRequest(params)
 //
-//    // Example sending a request using the DeleteQueueRequest method.
-//    req, resp := client.DeleteQueueRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/DeleteQueue
 func (c *SQS) DeleteQueueRequest(input *DeleteQueueInput) (req *request.Request, output *DeleteQueueOutput) {
@@ -738,7 +808,6 @@ func (c *SQS) DeleteQueueRequest(input *DeleteQueueInput) (req *request.Request,
 // DeleteQueue API operation for Amazon Simple Queue Service.
 //
 // Deletes the queue specified by the QueueUrl, regardless of the queue's contents.
-// If the specified queue doesn't exist, Amazon SQS returns a successful response.
 //
 // Be careful with the DeleteQueue action: When you delete a queue, any messages
 // in the queue are no longer available.
@@ -752,8 +821,10 @@ func (c *SQS) DeleteQueueRequest(input *DeleteQueueInput) (req *request.Request,
 // a queue with the same name.
 //
 // Cross-account permissions don't apply to this action. For more information,
-// see Grant Cross-Account Permissions to a Role and a User Name (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-customer-managed-policy-examples.html#grant-cross-account-permissions-to-role-and-user-name)
-// in the Amazon Simple Queue Service Developer Guide.
+// see Grant cross-account permissions to a role and a username (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-customer-managed-policy-examples.html#grant-cross-account-permissions-to-role-and-user-name)
+// in the Amazon SQS Developer Guide.
+//
+// The delete operation uses the HTTP GET verb.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -799,14 +870,13 @@ const opGetQueueAttributes = "GetQueueAttributes"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetQueueAttributesRequest method.
+//	req, resp := client.GetQueueAttributesRequest(params)
 //
-//    // Example sending a request using the GetQueueAttributesRequest method.
-//    req, resp := client.GetQueueAttributesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/GetQueueAttributes
 func (c *SQS) GetQueueAttributesRequest(input *GetQueueAttributesInput) (req *request.Request, output *GetQueueAttributesOutput) {
@@ -832,14 +902,6 @@ func (c *SQS) GetQueueAttributesRequest(input *GetQueueAttributesInput) (req *re
 // To determine whether a queue is FIFO (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues.html),
 // you can check whether QueueName ends with the .fifo suffix.
 //
-// Some actions take lists of parameters. These lists are specified using the
-// param.n notation. Values of n are integers starting from 1. For example,
-// a parameter list with two elements looks like this:
-//
-// &Attribute.1=first
-//
-// &Attribute.2=second
-//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -848,8 +910,8 @@ func (c *SQS) GetQueueAttributesRequest(input *GetQueueAttributesInput) (req *re
 // API operation GetQueueAttributes for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidAttributeName "InvalidAttributeName"
-//   The specified attribute doesn't exist.
+//   - ErrCodeInvalidAttributeName "InvalidAttributeName"
+//     The specified attribute doesn't exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/GetQueueAttributes
 func (c *SQS) GetQueueAttributes(input *GetQueueAttributesInput) (*GetQueueAttributesOutput, error) {
@@ -889,14 +951,13 @@ const opGetQueueUrl = "GetQueueUrl"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetQueueUrlRequest method.
+//	req, resp := client.GetQueueUrlRequest(params)
 //
-//    // Example sending a request using the GetQueueUrlRequest method.
-//    req, resp := client.GetQueueUrlRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/GetQueueUrl
 func (c *SQS) GetQueueUrlRequest(input *GetQueueUrlInput) (req *request.Request, output *GetQueueUrlOutput) {
@@ -924,7 +985,7 @@ func (c *SQS) GetQueueUrlRequest(input *GetQueueUrlInput) (req *request.Request,
 // must grant you permission to access the queue. For more information about
 // shared queue access, see AddPermission or see Allow Developers to Write Messages
 // to a Shared Queue (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-writing-an-sqs-policy.html#write-messages-to-shared-queue)
-// in the Amazon Simple Queue Service Developer Guide.
+// in the Amazon SQS Developer Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -934,8 +995,8 @@ func (c *SQS) GetQueueUrlRequest(input *GetQueueUrlInput) (req *request.Request,
 // API operation GetQueueUrl for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeQueueDoesNotExist "AWS.SimpleQueueService.NonExistentQueue"
-//   The specified queue doesn't exist.
+//   - ErrCodeQueueDoesNotExist "AWS.SimpleQueueService.NonExistentQueue"
+//     The specified queue doesn't exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/GetQueueUrl
 func (c *SQS) GetQueueUrl(input *GetQueueUrlInput) (*GetQueueUrlOutput, error) {
@@ -975,14 +1036,13 @@ const opListDeadLetterSourceQueues = "ListDeadLetterSourceQueues"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListDeadLetterSourceQueuesRequest method.
+//	req, resp := client.ListDeadLetterSourceQueuesRequest(params)
 //
-//    // Example sending a request using the ListDeadLetterSourceQueuesRequest method.
-//    req, resp := client.ListDeadLetterSourceQueuesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/ListDeadLetterSourceQueues
 func (c *SQS) ListDeadLetterSourceQueuesRequest(input *ListDeadLetterSourceQueuesInput) (req *request.Request, output *ListDeadLetterSourceQueuesOutput) {
@@ -990,6 +1050,12 @@ func (c *SQS) ListDeadLetterSourceQueuesRequest(input *ListDeadLetterSourceQueue
 		Name:       opListDeadLetterSourceQueues,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "MaxResults",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
@@ -1006,9 +1072,17 @@ func (c *SQS) ListDeadLetterSourceQueuesRequest(input *ListDeadLetterSourceQueue
 // Returns a list of your queues that have the RedrivePolicy queue attribute
 // configured with a dead-letter queue.
 //
+// The ListDeadLetterSourceQueues methods supports pagination. Set parameter
+// MaxResults in the request to specify the maximum number of results to be
+// returned in the response. If you do not set MaxResults, the response includes
+// a maximum of 1,000 results. If you set MaxResults and there are additional
+// results to display, the response includes a value for NextToken. Use NextToken
+// as a parameter in your next request to ListDeadLetterSourceQueues to receive
+// the next page of results.
+//
 // For more information about using dead-letter queues, see Using Amazon SQS
 // Dead-Letter Queues (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-dead-letter-queues.html)
-// in the Amazon Simple Queue Service Developer Guide.
+// in the Amazon SQS Developer Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1018,8 +1092,8 @@ func (c *SQS) ListDeadLetterSourceQueuesRequest(input *ListDeadLetterSourceQueue
 // API operation ListDeadLetterSourceQueues for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeQueueDoesNotExist "AWS.SimpleQueueService.NonExistentQueue"
-//   The specified queue doesn't exist.
+//   - ErrCodeQueueDoesNotExist "AWS.SimpleQueueService.NonExistentQueue"
+//     The specified queue doesn't exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/ListDeadLetterSourceQueues
 func (c *SQS) ListDeadLetterSourceQueues(input *ListDeadLetterSourceQueuesInput) (*ListDeadLetterSourceQueuesOutput, error) {
@@ -1043,6 +1117,152 @@ func (c *SQS) ListDeadLetterSourceQueuesWithContext(ctx aws.Context, input *List
 	return out, req.Send()
 }
 
+// ListDeadLetterSourceQueuesPages iterates over the pages of a ListDeadLetterSourceQueues operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListDeadLetterSourceQueues method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListDeadLetterSourceQueues operation.
+//	pageNum := 0
+//	err := client.ListDeadLetterSourceQueuesPages(params,
+//	    func(page *sqs.ListDeadLetterSourceQueuesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *SQS) ListDeadLetterSourceQueuesPages(input *ListDeadLetterSourceQueuesInput, fn func(*ListDeadLetterSourceQueuesOutput, bool) bool) error {
+	return c.ListDeadLetterSourceQueuesPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListDeadLetterSourceQueuesPagesWithContext same as ListDeadLetterSourceQueuesPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *SQS) ListDeadLetterSourceQueuesPagesWithContext(ctx aws.Context, input *ListDeadLetterSourceQueuesInput, fn func(*ListDeadLetterSourceQueuesOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListDeadLetterSourceQueuesInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListDeadLetterSourceQueuesRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListDeadLetterSourceQueuesOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListMessageMoveTasks = "ListMessageMoveTasks"
+
+// ListMessageMoveTasksRequest generates a "aws/request.Request" representing the
+// client's request for the ListMessageMoveTasks operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListMessageMoveTasks for more information on using the ListMessageMoveTasks
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListMessageMoveTasksRequest method.
+//	req, resp := client.ListMessageMoveTasksRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/ListMessageMoveTasks
+func (c *SQS) ListMessageMoveTasksRequest(input *ListMessageMoveTasksInput) (req *request.Request, output *ListMessageMoveTasksOutput) {
+	op := &request.Operation{
+		Name:       opListMessageMoveTasks,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &ListMessageMoveTasksInput{}
+	}
+
+	output = &ListMessageMoveTasksOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListMessageMoveTasks API operation for Amazon Simple Queue Service.
+//
+// Gets the most recent message movement tasks (up to 10) under a specific source
+// queue.
+//
+//   - This action is currently limited to supporting message redrive from
+//     dead-letter queues (DLQs) (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-dead-letter-queues.html)
+//     only. In this context, the source queue is the dead-letter queue (DLQ),
+//     while the destination queue can be the original source queue (from which
+//     the messages were driven to the dead-letter-queue), or a custom destination
+//     queue.
+//
+//   - Currently, only standard queues are supported.
+//
+//   - Only one active message movement task is supported per queue at any
+//     given time.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Simple Queue Service's
+// API operation ListMessageMoveTasks for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeResourceNotFoundException "ResourceNotFoundException"
+//     One or more specified resources don't exist.
+//
+//   - ErrCodeUnsupportedOperation "AWS.SimpleQueueService.UnsupportedOperation"
+//     Error code 400. Unsupported operation.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/ListMessageMoveTasks
+func (c *SQS) ListMessageMoveTasks(input *ListMessageMoveTasksInput) (*ListMessageMoveTasksOutput, error) {
+	req, out := c.ListMessageMoveTasksRequest(input)
+	return out, req.Send()
+}
+
+// ListMessageMoveTasksWithContext is the same as ListMessageMoveTasks with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListMessageMoveTasks for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *SQS) ListMessageMoveTasksWithContext(ctx aws.Context, input *ListMessageMoveTasksInput, opts ...request.Option) (*ListMessageMoveTasksOutput, error) {
+	req, out := c.ListMessageMoveTasksRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opListQueueTags = "ListQueueTags"
 
 // ListQueueTagsRequest generates a "aws/request.Request" representing the
@@ -1059,14 +1279,13 @@ const opListQueueTags = "ListQueueTags"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListQueueTagsRequest method.
+//	req, resp := client.ListQueueTagsRequest(params)
 //
-//    // Example sending a request using the ListQueueTagsRequest method.
-//    req, resp := client.ListQueueTagsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/ListQueueTags
 func (c *SQS) ListQueueTagsRequest(input *ListQueueTagsInput) (req *request.Request, output *ListQueueTagsOutput) {
@@ -1089,11 +1308,11 @@ func (c *SQS) ListQueueTagsRequest(input *ListQueueTagsInput) (req *request.Requ
 //
 // List all cost allocation tags added to the specified Amazon SQS queue. For
 // an overview, see Tagging Your Amazon SQS Queues (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-queue-tags.html)
-// in the Amazon Simple Queue Service Developer Guide.
+// in the Amazon SQS Developer Guide.
 //
 // Cross-account permissions don't apply to this action. For more information,
-// see Grant Cross-Account Permissions to a Role and a User Name (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-customer-managed-policy-examples.html#grant-cross-account-permissions-to-role-and-user-name)
-// in the Amazon Simple Queue Service Developer Guide.
+// see Grant cross-account permissions to a role and a username (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-customer-managed-policy-examples.html#grant-cross-account-permissions-to-role-and-user-name)
+// in the Amazon SQS Developer Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1139,14 +1358,13 @@ const opListQueues = "ListQueues"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListQueuesRequest method.
+//	req, resp := client.ListQueuesRequest(params)
 //
-//    // Example sending a request using the ListQueuesRequest method.
-//    req, resp := client.ListQueuesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/ListQueues
 func (c *SQS) ListQueuesRequest(input *ListQueuesInput) (req *request.Request, output *ListQueuesOutput) {
@@ -1154,6 +1372,12 @@ func (c *SQS) ListQueuesRequest(input *ListQueuesInput) (req *request.Request, o
 		Name:       opListQueues,
 		HTTPMethod: "POST",
 		HTTPPath:   "/",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "MaxResults",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
@@ -1167,13 +1391,21 @@ func (c *SQS) ListQueuesRequest(input *ListQueuesInput) (req *request.Request, o
 
 // ListQueues API operation for Amazon Simple Queue Service.
 //
-// Returns a list of your queues. The maximum number of queues that can be returned
-// is 1,000. If you specify a value for the optional QueueNamePrefix parameter,
-// only queues with a name that begins with the specified value are returned.
+// Returns a list of your queues in the current region. The response includes
+// a maximum of 1,000 results. If you specify a value for the optional QueueNamePrefix
+// parameter, only queues with a name that begins with the specified value are
+// returned.
+//
+// The listQueues methods supports pagination. Set parameter MaxResults in the
+// request to specify the maximum number of results to be returned in the response.
+// If you do not set MaxResults, the response includes a maximum of 1,000 results.
+// If you set MaxResults and there are additional results to display, the response
+// includes a value for NextToken. Use NextToken as a parameter in your next
+// request to listQueues to receive the next page of results.
 //
 // Cross-account permissions don't apply to this action. For more information,
-// see Grant Cross-Account Permissions to a Role and a User Name (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-customer-managed-policy-examples.html#grant-cross-account-permissions-to-role-and-user-name)
-// in the Amazon Simple Queue Service Developer Guide.
+// see Grant cross-account permissions to a role and a username (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-customer-managed-policy-examples.html#grant-cross-account-permissions-to-role-and-user-name)
+// in the Amazon SQS Developer Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1203,6 +1435,57 @@ func (c *SQS) ListQueuesWithContext(ctx aws.Context, input *ListQueuesInput, opt
 	return out, req.Send()
 }
 
+// ListQueuesPages iterates over the pages of a ListQueues operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListQueues method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListQueues operation.
+//	pageNum := 0
+//	err := client.ListQueuesPages(params,
+//	    func(page *sqs.ListQueuesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *SQS) ListQueuesPages(input *ListQueuesInput, fn func(*ListQueuesOutput, bool) bool) error {
+	return c.ListQueuesPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// ListQueuesPagesWithContext same as ListQueuesPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *SQS) ListQueuesPagesWithContext(ctx aws.Context, input *ListQueuesInput, fn func(*ListQueuesOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListQueuesInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListQueuesRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListQueuesOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
 const opPurgeQueue = "PurgeQueue"
 
 // PurgeQueueRequest generates a "aws/request.Request" representing the
@@ -1219,14 +1502,13 @@ const opPurgeQueue = "PurgeQueue"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the PurgeQueueRequest method.
+//	req, resp := client.PurgeQueueRequest(params)
 //
-//    // Example sending a request using the PurgeQueueRequest method.
-//    req, resp := client.PurgeQueueRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/PurgeQueue
 func (c *SQS) PurgeQueueRequest(input *PurgeQueueInput) (req *request.Request, output *PurgeQueueOutput) {
@@ -1248,7 +1530,8 @@ func (c *SQS) PurgeQueueRequest(input *PurgeQueueInput) (req *request.Request, o
 
 // PurgeQueue API operation for Amazon Simple Queue Service.
 //
-// Deletes the messages in a queue specified by the QueueURL parameter.
+// Deletes available messages in a queue (including in-flight messages) specified
+// by the QueueURL parameter.
 //
 // When you use the PurgeQueue action, you can't retrieve any messages deleted
 // from a queue.
@@ -1270,13 +1553,14 @@ func (c *SQS) PurgeQueueRequest(input *PurgeQueueInput) (req *request.Request, o
 // API operation PurgeQueue for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeQueueDoesNotExist "AWS.SimpleQueueService.NonExistentQueue"
-//   The specified queue doesn't exist.
 //
-//   * ErrCodePurgeQueueInProgress "AWS.SimpleQueueService.PurgeQueueInProgress"
-//   Indicates that the specified queue previously received a PurgeQueue request
-//   within the last 60 seconds (the time it can take to delete the messages in
-//   the queue).
+//   - ErrCodeQueueDoesNotExist "AWS.SimpleQueueService.NonExistentQueue"
+//     The specified queue doesn't exist.
+//
+//   - ErrCodePurgeQueueInProgress "AWS.SimpleQueueService.PurgeQueueInProgress"
+//     Indicates that the specified queue previously received a PurgeQueue request
+//     within the last 60 seconds (the time it can take to delete the messages in
+//     the queue).
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/PurgeQueue
 func (c *SQS) PurgeQueue(input *PurgeQueueInput) (*PurgeQueueOutput, error) {
@@ -1316,14 +1600,13 @@ const opReceiveMessage = "ReceiveMessage"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ReceiveMessageRequest method.
+//	req, resp := client.ReceiveMessageRequest(params)
 //
-//    // Example sending a request using the ReceiveMessageRequest method.
-//    req, resp := client.ReceiveMessageRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/ReceiveMessage
 func (c *SQS) ReceiveMessageRequest(input *ReceiveMessageInput) (req *request.Request, output *ReceiveMessageOutput) {
@@ -1347,7 +1630,7 @@ func (c *SQS) ReceiveMessageRequest(input *ReceiveMessageInput) (req *request.Re
 // Retrieves one or more messages (up to 10), from the specified queue. Using
 // the WaitTimeSeconds parameter enables long-poll support. For more information,
 // see Amazon SQS Long Polling (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-long-polling.html)
-// in the Amazon Simple Queue Service Developer Guide.
+// in the Amazon SQS Developer Guide.
 //
 // Short poll is the default behavior where a weighted random set of machines
 // is sampled on a ReceiveMessage call. Thus, only the messages on the sampled
@@ -1359,29 +1642,29 @@ func (c *SQS) ReceiveMessageRequest(input *ReceiveMessageInput) (req *request.Re
 //
 // For each message returned, the response includes the following:
 //
-//    * The message body.
+//   - The message body.
 //
-//    * An MD5 digest of the message body. For information about MD5, see RFC1321
-//    (https://www.ietf.org/rfc/rfc1321.txt).
+//   - An MD5 digest of the message body. For information about MD5, see RFC1321
+//     (https://www.ietf.org/rfc/rfc1321.txt).
 //
-//    * The MessageId you received when you sent the message to the queue.
+//   - The MessageId you received when you sent the message to the queue.
 //
-//    * The receipt handle.
+//   - The receipt handle.
 //
-//    * The message attributes.
+//   - The message attributes.
 //
-//    * An MD5 digest of the message attributes.
+//   - An MD5 digest of the message attributes.
 //
 // The receipt handle is the identifier you must provide when deleting the message.
 // For more information, see Queue and Message Identifiers (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-queue-message-identifiers.html)
-// in the Amazon Simple Queue Service Developer Guide.
+// in the Amazon SQS Developer Guide.
 //
 // You can provide the VisibilityTimeout parameter in your request. The parameter
 // is applied to the messages that Amazon SQS returns in the response. If you
 // don't include the parameter, the overall visibility timeout for the queue
 // is used for the returned messages. For more information, see Visibility Timeout
 // (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-visibility-timeout.html)
-// in the Amazon Simple Queue Service Developer Guide.
+// in the Amazon SQS Developer Guide.
 //
 // A message that isn't deleted or a message whose visibility isn't extended
 // before the visibility timeout expires counts as a failed receive. Depending
@@ -1400,11 +1683,11 @@ func (c *SQS) ReceiveMessageRequest(input *ReceiveMessageInput) (req *request.Re
 // API operation ReceiveMessage for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeOverLimit "OverLimit"
-//   The specified action violates a limit. For example, ReceiveMessage returns
-//   this error if the maximum number of inflight messages is reached and AddPermission
-//   returns this error if the maximum number of permissions for the queue is
-//   reached.
+//   - ErrCodeOverLimit "OverLimit"
+//     The specified action violates a limit. For example, ReceiveMessage returns
+//     this error if the maximum number of in flight messages is reached and AddPermission
+//     returns this error if the maximum number of permissions for the queue is
+//     reached.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/ReceiveMessage
 func (c *SQS) ReceiveMessage(input *ReceiveMessageInput) (*ReceiveMessageOutput, error) {
@@ -1444,14 +1727,13 @@ const opRemovePermission = "RemovePermission"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the RemovePermissionRequest method.
+//	req, resp := client.RemovePermissionRequest(params)
 //
-//    // Example sending a request using the RemovePermissionRequest method.
-//    req, resp := client.RemovePermissionRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/RemovePermission
 func (c *SQS) RemovePermissionRequest(input *RemovePermissionInput) (req *request.Request, output *RemovePermissionOutput) {
@@ -1476,15 +1758,15 @@ func (c *SQS) RemovePermissionRequest(input *RemovePermissionInput) (req *reques
 // Revokes any permissions in the queue policy that matches the specified Label
 // parameter.
 //
-//    * Only the owner of a queue can remove permissions from it.
+//   - Only the owner of a queue can remove permissions from it.
 //
-//    * Cross-account permissions don't apply to this action. For more information,
-//    see Grant Cross-Account Permissions to a Role and a User Name (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-customer-managed-policy-examples.html#grant-cross-account-permissions-to-role-and-user-name)
-//    in the Amazon Simple Queue Service Developer Guide.
+//   - Cross-account permissions don't apply to this action. For more information,
+//     see Grant cross-account permissions to a role and a username (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-customer-managed-policy-examples.html#grant-cross-account-permissions-to-role-and-user-name)
+//     in the Amazon SQS Developer Guide.
 //
-//    * To remove the ability to change queue permissions, you must deny permission
-//    to the AddPermission, RemovePermission, and SetQueueAttributes actions
-//    in your IAM policy.
+//   - To remove the ability to change queue permissions, you must deny permission
+//     to the AddPermission, RemovePermission, and SetQueueAttributes actions
+//     in your IAM policy.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1530,14 +1812,13 @@ const opSendMessage = "SendMessage"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SendMessageRequest method.
+//	req, resp := client.SendMessageRequest(params)
 //
-//    // Example sending a request using the SendMessageRequest method.
-//    req, resp := client.SendMessageRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/SendMessage
 func (c *SQS) SendMessageRequest(input *SendMessageInput) (req *request.Request, output *SendMessageOutput) {
@@ -1576,11 +1857,12 @@ func (c *SQS) SendMessageRequest(input *SendMessageInput) (req *request.Request,
 // API operation SendMessage for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidMessageContents "InvalidMessageContents"
-//   The message contains characters outside the allowed set.
 //
-//   * ErrCodeUnsupportedOperation "AWS.SimpleQueueService.UnsupportedOperation"
-//   Error code 400. Unsupported operation.
+//   - ErrCodeInvalidMessageContents "InvalidMessageContents"
+//     The message contains characters outside the allowed set.
+//
+//   - ErrCodeUnsupportedOperation "AWS.SimpleQueueService.UnsupportedOperation"
+//     Error code 400. Unsupported operation.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/SendMessage
 func (c *SQS) SendMessage(input *SendMessageInput) (*SendMessageOutput, error) {
@@ -1620,14 +1902,13 @@ const opSendMessageBatch = "SendMessageBatch"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SendMessageBatchRequest method.
+//	req, resp := client.SendMessageBatchRequest(params)
 //
-//    // Example sending a request using the SendMessageBatchRequest method.
-//    req, resp := client.SendMessageBatchRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/SendMessageBatch
 func (c *SQS) SendMessageBatchRequest(input *SendMessageBatchInput) (req *request.Request, output *SendMessageBatchOutput) {
@@ -1648,9 +1929,11 @@ func (c *SQS) SendMessageBatchRequest(input *SendMessageBatchInput) (req *reques
 
 // SendMessageBatch API operation for Amazon Simple Queue Service.
 //
-// Delivers up to ten messages to the specified queue. This is a batch version
-// of SendMessage. For a FIFO queue, multiple messages within a single batch
-// are enqueued in the order they are sent.
+// You can use SendMessageBatch to send up to 10 messages to the specified queue
+// by assigning either identical or different values to each message (or by
+// not assigning values at all). This is a batch version of SendMessage. For
+// a FIFO queue, multiple messages within a single batch are enqueued in the
+// order they are sent.
 //
 // The result of sending each message is reported individually in the response.
 // Because the batch request can result in a combination of successful and unsuccessful
@@ -1659,7 +1942,7 @@ func (c *SQS) SendMessageBatchRequest(input *SendMessageBatchInput) (req *reques
 //
 // The maximum allowed individual message size and the maximum total payload
 // size (the sum of the individual lengths of all of the batched messages) are
-// both 256 KB (262,144 bytes).
+// both 256 KiB (262,144 bytes).
 //
 // A message can include only XML, JSON, and unformatted text. The following
 // Unicode characters are allowed:
@@ -1672,14 +1955,6 @@ func (c *SQS) SendMessageBatchRequest(input *SendMessageBatchInput) (req *reques
 // If you don't specify the DelaySeconds parameter for an entry, Amazon SQS
 // uses the default value for the queue.
 //
-// Some actions take lists of parameters. These lists are specified using the
-// param.n notation. Values of n are integers starting from 1. For example,
-// a parameter list with two elements looks like this:
-//
-// &Attribute.1=first
-//
-// &Attribute.2=second
-//
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
@@ -1688,23 +1963,24 @@ func (c *SQS) SendMessageBatchRequest(input *SendMessageBatchInput) (req *reques
 // API operation SendMessageBatch for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeTooManyEntriesInBatchRequest "AWS.SimpleQueueService.TooManyEntriesInBatchRequest"
-//   The batch request contains more entries than permissible.
 //
-//   * ErrCodeEmptyBatchRequest "AWS.SimpleQueueService.EmptyBatchRequest"
-//   The batch request doesn't contain any entries.
+//   - ErrCodeTooManyEntriesInBatchRequest "AWS.SimpleQueueService.TooManyEntriesInBatchRequest"
+//     The batch request contains more entries than permissible.
 //
-//   * ErrCodeBatchEntryIdsNotDistinct "AWS.SimpleQueueService.BatchEntryIdsNotDistinct"
-//   Two or more batch entries in the request have the same Id.
+//   - ErrCodeEmptyBatchRequest "AWS.SimpleQueueService.EmptyBatchRequest"
+//     The batch request doesn't contain any entries.
 //
-//   * ErrCodeBatchRequestTooLong "AWS.SimpleQueueService.BatchRequestTooLong"
-//   The length of all the messages put together is more than the limit.
+//   - ErrCodeBatchEntryIdsNotDistinct "AWS.SimpleQueueService.BatchEntryIdsNotDistinct"
+//     Two or more batch entries in the request have the same Id.
 //
-//   * ErrCodeInvalidBatchEntryId "AWS.SimpleQueueService.InvalidBatchEntryId"
-//   The Id of a batch entry in a batch request doesn't abide by the specification.
+//   - ErrCodeBatchRequestTooLong "AWS.SimpleQueueService.BatchRequestTooLong"
+//     The length of all the messages put together is more than the limit.
 //
-//   * ErrCodeUnsupportedOperation "AWS.SimpleQueueService.UnsupportedOperation"
-//   Error code 400. Unsupported operation.
+//   - ErrCodeInvalidBatchEntryId "AWS.SimpleQueueService.InvalidBatchEntryId"
+//     The Id of a batch entry in a batch request doesn't abide by the specification.
+//
+//   - ErrCodeUnsupportedOperation "AWS.SimpleQueueService.UnsupportedOperation"
+//     Error code 400. Unsupported operation.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/SendMessageBatch
 func (c *SQS) SendMessageBatch(input *SendMessageBatchInput) (*SendMessageBatchOutput, error) {
@@ -1744,14 +2020,13 @@ const opSetQueueAttributes = "SetQueueAttributes"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SetQueueAttributesRequest method.
+//	req, resp := client.SetQueueAttributesRequest(params)
 //
-//    // Example sending a request using the SetQueueAttributesRequest method.
-//    req, resp := client.SetQueueAttributesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/SetQueueAttributes
 func (c *SQS) SetQueueAttributesRequest(input *SetQueueAttributesInput) (req *request.Request, output *SetQueueAttributesOutput) {
@@ -1776,19 +2051,21 @@ func (c *SQS) SetQueueAttributesRequest(input *SetQueueAttributesInput) (req *re
 // Sets the value of one or more queue attributes. When you change a queue's
 // attributes, the change can take up to 60 seconds for most of the attributes
 // to propagate throughout the Amazon SQS system. Changes made to the MessageRetentionPeriod
-// attribute can take up to 15 minutes.
+// attribute can take up to 15 minutes and will impact existing messages in
+// the queue potentially causing them to be expired and deleted if the MessageRetentionPeriod
+// is reduced below the age of existing messages.
 //
-//    * In the future, new attributes might be added. If you write code that
-//    calls this action, we recommend that you structure your code so that it
-//    can handle new attributes gracefully.
+//   - In the future, new attributes might be added. If you write code that
+//     calls this action, we recommend that you structure your code so that it
+//     can handle new attributes gracefully.
 //
-//    * Cross-account permissions don't apply to this action. For more information,
-//    see Grant Cross-Account Permissions to a Role and a User Name (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-customer-managed-policy-examples.html#grant-cross-account-permissions-to-role-and-user-name)
-//    in the Amazon Simple Queue Service Developer Guide.
+//   - Cross-account permissions don't apply to this action. For more information,
+//     see Grant cross-account permissions to a role and a username (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-customer-managed-policy-examples.html#grant-cross-account-permissions-to-role-and-user-name)
+//     in the Amazon SQS Developer Guide.
 //
-//    * To remove the ability to change queue permissions, you must deny permission
-//    to the AddPermission, RemovePermission, and SetQueueAttributes actions
-//    in your IAM policy.
+//   - To remove the ability to change queue permissions, you must deny permission
+//     to the AddPermission, RemovePermission, and SetQueueAttributes actions
+//     in your IAM policy.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1798,8 +2075,8 @@ func (c *SQS) SetQueueAttributesRequest(input *SetQueueAttributesInput) (req *re
 // API operation SetQueueAttributes for usage and error information.
 //
 // Returned Error Codes:
-//   * ErrCodeInvalidAttributeName "InvalidAttributeName"
-//   The specified attribute doesn't exist.
+//   - ErrCodeInvalidAttributeName "InvalidAttributeName"
+//     The specified attribute doesn't exist.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/SetQueueAttributes
 func (c *SQS) SetQueueAttributes(input *SetQueueAttributesInput) (*SetQueueAttributesOutput, error) {
@@ -1823,6 +2100,105 @@ func (c *SQS) SetQueueAttributesWithContext(ctx aws.Context, input *SetQueueAttr
 	return out, req.Send()
 }
 
+const opStartMessageMoveTask = "StartMessageMoveTask"
+
+// StartMessageMoveTaskRequest generates a "aws/request.Request" representing the
+// client's request for the StartMessageMoveTask operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See StartMessageMoveTask for more information on using the StartMessageMoveTask
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the StartMessageMoveTaskRequest method.
+//	req, resp := client.StartMessageMoveTaskRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/StartMessageMoveTask
+func (c *SQS) StartMessageMoveTaskRequest(input *StartMessageMoveTaskInput) (req *request.Request, output *StartMessageMoveTaskOutput) {
+	op := &request.Operation{
+		Name:       opStartMessageMoveTask,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &StartMessageMoveTaskInput{}
+	}
+
+	output = &StartMessageMoveTaskOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// StartMessageMoveTask API operation for Amazon Simple Queue Service.
+//
+// Starts an asynchronous task to move messages from a specified source queue
+// to a specified destination queue.
+//
+//   - This action is currently limited to supporting message redrive from
+//     queues that are configured as dead-letter queues (DLQs) (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-dead-letter-queues.html)
+//     of other Amazon SQS queues only. Non-SQS queue sources of dead-letter
+//     queues, such as Lambda or Amazon SNS topics, are currently not supported.
+//
+//   - In dead-letter queues redrive context, the StartMessageMoveTask the
+//     source queue is the DLQ, while the destination queue can be the original
+//     source queue (from which the messages were driven to the dead-letter-queue),
+//     or a custom destination queue.
+//
+//   - Currently, only standard queues support redrive. FIFO queues don't support
+//     redrive.
+//
+//   - Only one active message movement task is supported per queue at any
+//     given time.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Simple Queue Service's
+// API operation StartMessageMoveTask for usage and error information.
+//
+// Returned Error Codes:
+//
+//   - ErrCodeResourceNotFoundException "ResourceNotFoundException"
+//     One or more specified resources don't exist.
+//
+//   - ErrCodeUnsupportedOperation "AWS.SimpleQueueService.UnsupportedOperation"
+//     Error code 400. Unsupported operation.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/StartMessageMoveTask
+func (c *SQS) StartMessageMoveTask(input *StartMessageMoveTaskInput) (*StartMessageMoveTaskOutput, error) {
+	req, out := c.StartMessageMoveTaskRequest(input)
+	return out, req.Send()
+}
+
+// StartMessageMoveTaskWithContext is the same as StartMessageMoveTask with the addition of
+// the ability to pass a context and additional request options.
+//
+// See StartMessageMoveTask for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *SQS) StartMessageMoveTaskWithContext(ctx aws.Context, input *StartMessageMoveTaskInput, opts ...request.Option) (*StartMessageMoveTaskOutput, error) {
+	req, out := c.StartMessageMoveTaskRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opTagQueue = "TagQueue"
 
 // TagQueueRequest generates a "aws/request.Request" representing the
@@ -1839,14 +2215,13 @@ const opTagQueue = "TagQueue"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the TagQueueRequest method.
+//	req, resp := client.TagQueueRequest(params)
 //
-//    // Example sending a request using the TagQueueRequest method.
-//    req, resp := client.TagQueueRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/TagQueue
 func (c *SQS) TagQueueRequest(input *TagQueueInput) (req *request.Request, output *TagQueueOutput) {
@@ -1870,26 +2245,26 @@ func (c *SQS) TagQueueRequest(input *TagQueueInput) (req *request.Request, outpu
 //
 // Add cost allocation tags to the specified Amazon SQS queue. For an overview,
 // see Tagging Your Amazon SQS Queues (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-queue-tags.html)
-// in the Amazon Simple Queue Service Developer Guide.
+// in the Amazon SQS Developer Guide.
 //
 // When you use queue tags, keep the following guidelines in mind:
 //
-//    * Adding more than 50 tags to a queue isn't recommended.
+//   - Adding more than 50 tags to a queue isn't recommended.
 //
-//    * Tags don't have any semantic meaning. Amazon SQS interprets tags as
-//    character strings.
+//   - Tags don't have any semantic meaning. Amazon SQS interprets tags as
+//     character strings.
 //
-//    * Tags are case-sensitive.
+//   - Tags are case-sensitive.
 //
-//    * A new tag with a key identical to that of an existing tag overwrites
-//    the existing tag.
+//   - A new tag with a key identical to that of an existing tag overwrites
+//     the existing tag.
 //
-// For a full list of tag restrictions, see Limits Related to Queues (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-limits.html#limits-queues)
-// in the Amazon Simple Queue Service Developer Guide.
+// For a full list of tag restrictions, see Quotas related to queues (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-limits.html#limits-queues)
+// in the Amazon SQS Developer Guide.
 //
 // Cross-account permissions don't apply to this action. For more information,
-// see Grant Cross-Account Permissions to a Role and a User Name (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-customer-managed-policy-examples.html#grant-cross-account-permissions-to-role-and-user-name)
-// in the Amazon Simple Queue Service Developer Guide.
+// see Grant cross-account permissions to a role and a username (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-customer-managed-policy-examples.html#grant-cross-account-permissions-to-role-and-user-name)
+// in the Amazon SQS Developer Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -1935,14 +2310,13 @@ const opUntagQueue = "UntagQueue"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UntagQueueRequest method.
+//	req, resp := client.UntagQueueRequest(params)
 //
-//    // Example sending a request using the UntagQueueRequest method.
-//    req, resp := client.UntagQueueRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05/UntagQueue
 func (c *SQS) UntagQueueRequest(input *UntagQueueInput) (req *request.Request, output *UntagQueueOutput) {
@@ -1966,11 +2340,11 @@ func (c *SQS) UntagQueueRequest(input *UntagQueueInput) (req *request.Request, o
 //
 // Remove cost allocation tags from the specified Amazon SQS queue. For an overview,
 // see Tagging Your Amazon SQS Queues (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-queue-tags.html)
-// in the Amazon Simple Queue Service Developer Guide.
+// in the Amazon SQS Developer Guide.
 //
 // Cross-account permissions don't apply to this action. For more information,
-// see Grant Cross-Account Permissions to a Role and a User Name (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-customer-managed-policy-examples.html#grant-cross-account-permissions-to-role-and-user-name)
-// in the Amazon Simple Queue Service Developer Guide.
+// see Grant cross-account permissions to a role and a username (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-customer-managed-policy-examples.html#grant-cross-account-permissions-to-role-and-user-name)
+// in the Amazon SQS Developer Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -2003,11 +2377,11 @@ func (c *SQS) UntagQueueWithContext(ctx aws.Context, input *UntagQueueInput, opt
 type AddPermissionInput struct {
 	_ struct{} `type:"structure"`
 
-	// The AWS account number of the principal (https://docs.aws.amazon.com/general/latest/gr/glos-chap.html#P)
-	// who is given permission. The principal must have an AWS account, but does
-	// not need to be signed up for Amazon SQS. For information about locating the
-	// AWS account identification, see Your AWS Identifiers (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-making-api-requests.html#sqs-api-request-authentication)
-	// in the Amazon Simple Queue Service Developer Guide.
+	// The Amazon Web Services account numbers of the principals (https://docs.aws.amazon.com/general/latest/gr/glos-chap.html#P)
+	// who are to receive permission. For information about locating the Amazon
+	// Web Services account identification, see Your Amazon Web Services Identifiers
+	// (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-making-api-requests.html#sqs-api-request-authentication)
+	// in the Amazon SQS Developer Guide.
 	//
 	// AWSAccountIds is a required field
 	AWSAccountIds []*string `locationNameList:"AWSAccountId" type:"list" flattened:"true" required:"true"`
@@ -2017,7 +2391,7 @@ type AddPermissionInput struct {
 	//
 	// For more information about these actions, see Overview of Managing Access
 	// Permissions to Your Amazon Simple Queue Service Resource (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-overview-of-managing-access.html)
-	// in the Amazon Simple Queue Service Developer Guide.
+	// in the Amazon SQS Developer Guide.
 	//
 	// Specifying SendMessage, DeleteMessage, or ChangeMessageVisibility for ActionName.n
 	// also grants permissions for the corresponding batch versions of those actions:
@@ -2041,12 +2415,20 @@ type AddPermissionInput struct {
 	QueueUrl *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AddPermissionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AddPermissionInput) GoString() string {
 	return s.String()
 }
@@ -2101,12 +2483,20 @@ type AddPermissionOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AddPermissionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AddPermissionOutput) GoString() string {
 	return s.String()
 }
@@ -2129,18 +2519,26 @@ type BatchResultErrorEntry struct {
 	// A message explaining why the action failed on this entry.
 	Message *string `type:"string"`
 
-	// Specifies whether the error happened due to the producer.
+	// Specifies whether the error happened due to the caller of the batch API action.
 	//
 	// SenderFault is a required field
 	SenderFault *bool `type:"boolean" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BatchResultErrorEntry) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s BatchResultErrorEntry) GoString() string {
 	return s.String()
 }
@@ -2169,10 +2567,87 @@ func (s *BatchResultErrorEntry) SetSenderFault(v bool) *BatchResultErrorEntry {
 	return s
 }
 
+type CancelMessageMoveTaskInput struct {
+	_ struct{} `type:"structure"`
+
+	// An identifier associated with a message movement task.
+	//
+	// TaskHandle is a required field
+	TaskHandle *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CancelMessageMoveTaskInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CancelMessageMoveTaskInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CancelMessageMoveTaskInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CancelMessageMoveTaskInput"}
+	if s.TaskHandle == nil {
+		invalidParams.Add(request.NewErrParamRequired("TaskHandle"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetTaskHandle sets the TaskHandle field's value.
+func (s *CancelMessageMoveTaskInput) SetTaskHandle(v string) *CancelMessageMoveTaskInput {
+	s.TaskHandle = &v
+	return s
+}
+
+type CancelMessageMoveTaskOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The approximate number of messages already moved to the destination queue.
+	ApproximateNumberOfMessagesMoved *int64 `type:"long"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CancelMessageMoveTaskOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CancelMessageMoveTaskOutput) GoString() string {
+	return s.String()
+}
+
+// SetApproximateNumberOfMessagesMoved sets the ApproximateNumberOfMessagesMoved field's value.
+func (s *CancelMessageMoveTaskOutput) SetApproximateNumberOfMessagesMoved(v int64) *CancelMessageMoveTaskOutput {
+	s.ApproximateNumberOfMessagesMoved = &v
+	return s
+}
+
 type ChangeMessageVisibilityBatchInput struct {
 	_ struct{} `type:"structure"`
 
-	// A list of receipt handles of the messages for which the visibility timeout
+	// Lists the receipt handles of the messages for which the visibility timeout
 	// must be changed.
 	//
 	// Entries is a required field
@@ -2186,12 +2661,20 @@ type ChangeMessageVisibilityBatchInput struct {
 	QueueUrl *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ChangeMessageVisibilityBatchInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ChangeMessageVisibilityBatchInput) GoString() string {
 	return s.String()
 }
@@ -2251,12 +2734,20 @@ type ChangeMessageVisibilityBatchOutput struct {
 	Successful []*ChangeMessageVisibilityBatchResultEntry `locationNameList:"ChangeMessageVisibilityBatchResultEntry" type:"list" flattened:"true" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ChangeMessageVisibilityBatchOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ChangeMessageVisibilityBatchOutput) GoString() string {
 	return s.String()
 }
@@ -2273,24 +2764,17 @@ func (s *ChangeMessageVisibilityBatchOutput) SetSuccessful(v []*ChangeMessageVis
 	return s
 }
 
-// Encloses a receipt handle and an entry id for each message in ChangeMessageVisibilityBatch.
-//
-// All of the following list parameters must be prefixed with ChangeMessageVisibilityBatchRequestEntry.n,
-// where n is an integer value starting with 1. For example, a parameter list
-// for this action might look like this:
-//
-// &ChangeMessageVisibilityBatchRequestEntry.1.Id=change_visibility_msg_2
-//
-// &ChangeMessageVisibilityBatchRequestEntry.1.ReceiptHandle=your_receipt_handle
-//
-// &ChangeMessageVisibilityBatchRequestEntry.1.VisibilityTimeout=45
+// Encloses a receipt handle and an entry ID for each message in ChangeMessageVisibilityBatch.
 type ChangeMessageVisibilityBatchRequestEntry struct {
 	_ struct{} `type:"structure"`
 
 	// An identifier for this particular receipt handle used to communicate the
 	// result.
 	//
-	// The Ids of a batch request need to be unique within a request
+	// The Ids of a batch request need to be unique within a request.
+	//
+	// This identifier can have up to 80 characters. The following characters are
+	// accepted: alphanumeric characters, hyphens(-), and underscores (_).
 	//
 	// Id is a required field
 	Id *string `type:"string" required:"true"`
@@ -2304,12 +2788,20 @@ type ChangeMessageVisibilityBatchRequestEntry struct {
 	VisibilityTimeout *int64 `type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ChangeMessageVisibilityBatchRequestEntry) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ChangeMessageVisibilityBatchRequestEntry) GoString() string {
 	return s.String()
 }
@@ -2358,12 +2850,20 @@ type ChangeMessageVisibilityBatchResultEntry struct {
 	Id *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ChangeMessageVisibilityBatchResultEntry) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ChangeMessageVisibilityBatchResultEntry) GoString() string {
 	return s.String()
 }
@@ -2384,25 +2884,33 @@ type ChangeMessageVisibilityInput struct {
 	// QueueUrl is a required field
 	QueueUrl *string `type:"string" required:"true"`
 
-	// The receipt handle associated with the message whose visibility timeout is
-	// changed. This parameter is returned by the ReceiveMessage action.
+	// The receipt handle associated with the message, whose visibility timeout
+	// is changed. This parameter is returned by the ReceiveMessage action.
 	//
 	// ReceiptHandle is a required field
 	ReceiptHandle *string `type:"string" required:"true"`
 
-	// The new value for the message's visibility timeout (in seconds). Values values:
+	// The new value for the message's visibility timeout (in seconds). Values range:
 	// 0 to 43200. Maximum: 12 hours.
 	//
 	// VisibilityTimeout is a required field
 	VisibilityTimeout *int64 `type:"integer" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ChangeMessageVisibilityInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ChangeMessageVisibilityInput) GoString() string {
 	return s.String()
 }
@@ -2448,12 +2956,20 @@ type ChangeMessageVisibilityOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ChangeMessageVisibilityOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ChangeMessageVisibilityOutput) GoString() string {
 	return s.String()
 }
@@ -2466,79 +2982,110 @@ type CreateQueueInput struct {
 	// The following lists the names, descriptions, and values of the special request
 	// parameters that the CreateQueue action uses:
 	//
-	//    * DelaySeconds - The length of time, in seconds, for which the delivery
+	//    * DelaySeconds – The length of time, in seconds, for which the delivery
 	//    of all messages in the queue is delayed. Valid values: An integer from
 	//    0 to 900 seconds (15 minutes). Default: 0.
 	//
-	//    * MaximumMessageSize - The limit of how many bytes a message can contain
+	//    * MaximumMessageSize – The limit of how many bytes a message can contain
 	//    before Amazon SQS rejects it. Valid values: An integer from 1,024 bytes
 	//    (1 KiB) to 262,144 bytes (256 KiB). Default: 262,144 (256 KiB).
 	//
-	//    * MessageRetentionPeriod - The length of time, in seconds, for which Amazon
-	//    SQS retains a message. Valid values: An integer from 60 seconds (1 minute)
-	//    to 1,209,600 seconds (14 days). Default: 345,600 (4 days).
-	//
-	//    * Policy - The queue's policy. A valid AWS policy. For more information
-	//    about policy structure, see Overview of AWS IAM Policies (https://docs.aws.amazon.com/IAM/latest/UserGuide/PoliciesOverview.html)
-	//    in the Amazon IAM User Guide.
-	//
-	//    * ReceiveMessageWaitTimeSeconds - The length of time, in seconds, for
+	//    * MessageRetentionPeriod – The length of time, in seconds, for which
+	//    Amazon SQS retains a message. Valid values: An integer from 60 seconds
+	//    (1 minute) to 1,209,600 seconds (14 days). Default: 345,600 (4 days).
+	//    When you change a queue's attributes, the change can take up to 60 seconds
+	//    for most of the attributes to propagate throughout the Amazon SQS system.
+	//    Changes made to the MessageRetentionPeriod attribute can take up to 15
+	//    minutes and will impact existing messages in the queue potentially causing
+	//    them to be expired and deleted if the MessageRetentionPeriod is reduced
+	//    below the age of existing messages.
+	//
+	//    * Policy – The queue's policy. A valid Amazon Web Services policy. For
+	//    more information about policy structure, see Overview of Amazon Web Services
+	//    IAM Policies (https://docs.aws.amazon.com/IAM/latest/UserGuide/PoliciesOverview.html)
+	//    in the IAM User Guide.
+	//
+	//    * ReceiveMessageWaitTimeSeconds – The length of time, in seconds, for
 	//    which a ReceiveMessage action waits for a message to arrive. Valid values:
 	//    An integer from 0 to 20 (seconds). Default: 0.
 	//
-	//    * RedrivePolicy - The string that includes the parameters for the dead-letter
-	//    queue functionality of the source queue. For more information about the
-	//    redrive policy and dead-letter queues, see Using Amazon SQS Dead-Letter
-	//    Queues (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-dead-letter-queues.html)
-	//    in the Amazon Simple Queue Service Developer Guide. deadLetterTargetArn
-	//    - The Amazon Resource Name (ARN) of the dead-letter queue to which Amazon
-	//    SQS moves messages after the value of maxReceiveCount is exceeded. maxReceiveCount
-	//    - The number of times a message is delivered to the source queue before
-	//    being moved to the dead-letter queue. When the ReceiveCount for a message
-	//    exceeds the maxReceiveCount for a queue, Amazon SQS moves the message
-	//    to the dead-letter-queue. The dead-letter queue of a FIFO queue must also
-	//    be a FIFO queue. Similarly, the dead-letter queue of a standard queue
-	//    must also be a standard queue.
-	//
-	//    * VisibilityTimeout - The visibility timeout for the queue, in seconds.
+	//    * VisibilityTimeout – The visibility timeout for the queue, in seconds.
 	//    Valid values: An integer from 0 to 43,200 (12 hours). Default: 30. For
 	//    more information about the visibility timeout, see Visibility Timeout
 	//    (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-visibility-timeout.html)
-	//    in the Amazon Simple Queue Service Developer Guide.
+	//    in the Amazon SQS Developer Guide.
 	//
-	// The following attributes apply only to server-side-encryption (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-server-side-encryption.html):
+	// The following attributes apply only to dead-letter queues: (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-dead-letter-queues.html)
 	//
-	//    * KmsMasterKeyId - The ID of an AWS-managed customer master key (CMK)
-	//    for Amazon SQS or a custom CMK. For more information, see Key Terms (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-server-side-encryption.html#sqs-sse-key-terms).
-	//    While the alias of the AWS-managed CMK for Amazon SQS is always alias/aws/sqs,
-	//    the alias of a custom CMK can, for example, be alias/MyAlias . For more
-	//    examples, see KeyId (https://docs.aws.amazon.com/kms/latest/APIReference/API_DescribeKey.html#API_DescribeKey_RequestParameters)
-	//    in the AWS Key Management Service API Reference.
+	//    * RedrivePolicy – The string that includes the parameters for the dead-letter
+	//    queue functionality of the source queue as a JSON object. The parameters
+	//    are as follows: deadLetterTargetArn – The Amazon Resource Name (ARN)
+	//    of the dead-letter queue to which Amazon SQS moves messages after the
+	//    value of maxReceiveCount is exceeded. maxReceiveCount – The number of
+	//    times a message is delivered to the source queue before being moved to
+	//    the dead-letter queue. Default: 10. When the ReceiveCount for a message
+	//    exceeds the maxReceiveCount for a queue, Amazon SQS moves the message
+	//    to the dead-letter-queue.
 	//
-	//    * KmsDataKeyReusePeriodSeconds - The length of time, in seconds, for which
-	//    Amazon SQS can reuse a data key (https://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#data-keys)
-	//    to encrypt or decrypt messages before calling AWS KMS again. An integer
-	//    representing seconds, between 60 seconds (1 minute) and 86,400 seconds
-	//    (24 hours). Default: 300 (5 minutes). A shorter time period provides better
-	//    security but results in more calls to KMS which might incur charges after
-	//    Free Tier. For more information, see How Does the Data Key Reuse Period
-	//    Work? (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-server-side-encryption.html#sqs-how-does-the-data-key-reuse-period-work).
+	//    * RedriveAllowPolicy – The string that includes the parameters for the
+	//    permissions for the dead-letter queue redrive permission and which source
+	//    queues can specify dead-letter queues as a JSON object. The parameters
+	//    are as follows: redrivePermission – The permission type that defines
+	//    which source queues can specify the current queue as the dead-letter queue.
+	//    Valid values are: allowAll – (Default) Any source queues in this Amazon
+	//    Web Services account in the same Region can specify this queue as the
+	//    dead-letter queue. denyAll – No source queues can specify this queue
+	//    as the dead-letter queue. byQueue – Only queues specified by the sourceQueueArns
+	//    parameter can specify this queue as the dead-letter queue. sourceQueueArns
+	//    – The Amazon Resource Names (ARN)s of the source queues that can specify
+	//    this queue as the dead-letter queue and redrive messages. You can specify
+	//    this parameter only when the redrivePermission parameter is set to byQueue.
+	//    You can specify up to 10 source queue ARNs. To allow more than 10 source
+	//    queues to specify dead-letter queues, set the redrivePermission parameter
+	//    to allowAll.
+	//
+	// The dead-letter queue of a FIFO queue must also be a FIFO queue. Similarly,
+	// the dead-letter queue of a standard queue must also be a standard queue.
+	//
+	// The following attributes apply only to server-side-encryption (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-server-side-encryption.html):
+	//
+	//    * KmsMasterKeyId – The ID of an Amazon Web Services managed customer
+	//    master key (CMK) for Amazon SQS or a custom CMK. For more information,
+	//    see Key Terms (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-server-side-encryption.html#sqs-sse-key-terms).
+	//    While the alias of the Amazon Web Services managed CMK for Amazon SQS
+	//    is always alias/aws/sqs, the alias of a custom CMK can, for example, be
+	//    alias/MyAlias . For more examples, see KeyId (https://docs.aws.amazon.com/kms/latest/APIReference/API_DescribeKey.html#API_DescribeKey_RequestParameters)
+	//    in the Key Management Service API Reference.
+	//
+	//    * KmsDataKeyReusePeriodSeconds – The length of time, in seconds, for
+	//    which Amazon SQS can reuse a data key (https://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#data-keys)
+	//    to encrypt or decrypt messages before calling KMS again. An integer representing
+	//    seconds, between 60 seconds (1 minute) and 86,400 seconds (24 hours).
+	//    Default: 300 (5 minutes). A shorter time period provides better security
+	//    but results in more calls to KMS which might incur charges after Free
+	//    Tier. For more information, see How Does the Data Key Reuse Period Work?
+	//    (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-server-side-encryption.html#sqs-how-does-the-data-key-reuse-period-work)
+	//
+	//    * SqsManagedSseEnabled – Enables server-side queue encryption using
+	//    SQS owned encryption keys. Only one server-side encryption option is supported
+	//    per queue (for example, SSE-KMS (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-configure-sse-existing-queue.html)
+	//    or SSE-SQS (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-configure-sqs-sse-queue.html)).
 	//
 	// The following attributes apply only to FIFO (first-in-first-out) queues (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues.html):
 	//
-	//    * FifoQueue - Designates a queue as FIFO. Valid values: true, false. If
-	//    you don't specify the FifoQueue attribute, Amazon SQS creates a standard
-	//    queue. You can provide this attribute only during queue creation. You
-	//    can't change it for an existing queue. When you set this attribute, you
-	//    must also provide the MessageGroupId for your messages explicitly. For
-	//    more information, see FIFO Queue Logic (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues.html#FIFO-queues-understanding-logic)
-	//    in the Amazon Simple Queue Service Developer Guide.
-	//
-	//    * ContentBasedDeduplication - Enables content-based deduplication. Valid
-	//    values: true, false. For more information, see Exactly-Once Processing
-	//    (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues.html#FIFO-queues-exactly-once-processing)
-	//    in the Amazon Simple Queue Service Developer Guide. Every message must
-	//    have a unique MessageDeduplicationId, You may provide a MessageDeduplicationId
+	//    * FifoQueue – Designates a queue as FIFO. Valid values are true and
+	//    false. If you don't specify the FifoQueue attribute, Amazon SQS creates
+	//    a standard queue. You can provide this attribute only during queue creation.
+	//    You can't change it for an existing queue. When you set this attribute,
+	//    you must also provide the MessageGroupId for your messages explicitly.
+	//    For more information, see FIFO queue logic (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues-understanding-logic.html)
+	//    in the Amazon SQS Developer Guide.
+	//
+	//    * ContentBasedDeduplication – Enables content-based deduplication. Valid
+	//    values are true and false. For more information, see Exactly-once processing
+	//    (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues-exactly-once-processing.html)
+	//    in the Amazon SQS Developer Guide. Note the following: Every message must
+	//    have a unique MessageDeduplicationId. You may provide a MessageDeduplicationId
 	//    explicitly. If you aren't able to provide a MessageDeduplicationId and
 	//    you enable ContentBasedDeduplication for your queue, Amazon SQS uses a
 	//    SHA-256 hash to generate the MessageDeduplicationId using the body of
@@ -2552,6 +3099,30 @@ type CreateQueueInput struct {
 	//    and then another message with a MessageDeduplicationId that is the same
 	//    as the one generated for the first MessageDeduplicationId, the two messages
 	//    are treated as duplicates and only one copy of the message is delivered.
+	//
+	// The following attributes apply only to high throughput for FIFO queues (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/high-throughput-fifo.html):
+	//
+	//    * DeduplicationScope – Specifies whether message deduplication occurs
+	//    at the message group or queue level. Valid values are messageGroup and
+	//    queue.
+	//
+	//    * FifoThroughputLimit – Specifies whether the FIFO queue throughput
+	//    quota applies to the entire queue or per message group. Valid values are
+	//    perQueue and perMessageGroupId. The perMessageGroupId value is allowed
+	//    only when the value for DeduplicationScope is messageGroup.
+	//
+	// To enable high throughput for FIFO queues, do the following:
+	//
+	//    * Set DeduplicationScope to messageGroup.
+	//
+	//    * Set FifoThroughputLimit to perMessageGroupId.
+	//
+	// If you set these attributes to anything other than the values shown for enabling
+	// high throughput, normal throughput is in effect and deduplication occurs
+	// as specified.
+	//
+	// For information on throughput quotas, see Quotas related to messages (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/quotas-messages.html)
+	// in the Amazon SQS Developer Guide.
 	Attributes map[string]*string `locationName:"Attribute" locationNameKey:"Name" locationNameValue:"Value" type:"map" flattened:"true"`
 
 	// The name of the new queue. The following limits apply to this name:
@@ -2570,7 +3141,7 @@ type CreateQueueInput struct {
 
 	// Add cost allocation tags to the specified Amazon SQS queue. For an overview,
 	// see Tagging Your Amazon SQS Queues (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-queue-tags.html)
-	// in the Amazon Simple Queue Service Developer Guide.
+	// in the Amazon SQS Developer Guide.
 	//
 	// When you use queue tags, keep the following guidelines in mind:
 	//
@@ -2584,24 +3155,32 @@ type CreateQueueInput struct {
 	//    * A new tag with a key identical to that of an existing tag overwrites
 	//    the existing tag.
 	//
-	// For a full list of tag restrictions, see Limits Related to Queues (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-limits.html#limits-queues)
-	// in the Amazon Simple Queue Service Developer Guide.
+	// For a full list of tag restrictions, see Quotas related to queues (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-limits.html#limits-queues)
+	// in the Amazon SQS Developer Guide.
 	//
 	// To be able to tag a queue on creation, you must have the sqs:CreateQueue
 	// and sqs:TagQueue permissions.
 	//
 	// Cross-account permissions don't apply to this action. For more information,
-	// see Grant Cross-Account Permissions to a Role and a User Name (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-customer-managed-policy-examples.html#grant-cross-account-permissions-to-role-and-user-name)
-	// in the Amazon Simple Queue Service Developer Guide.
+	// see Grant cross-account permissions to a role and a username (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-customer-managed-policy-examples.html#grant-cross-account-permissions-to-role-and-user-name)
+	// in the Amazon SQS Developer Guide.
 	Tags map[string]*string `locationName:"Tag" locationNameKey:"Key" locationNameValue:"Value" type:"map" flattened:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateQueueInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateQueueInput) GoString() string {
 	return s.String()
 }
@@ -2645,12 +3224,20 @@ type CreateQueueOutput struct {
 	QueueUrl *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateQueueOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateQueueOutput) GoString() string {
 	return s.String()
 }
@@ -2664,7 +3251,7 @@ func (s *CreateQueueOutput) SetQueueUrl(v string) *CreateQueueOutput {
 type DeleteMessageBatchInput struct {
 	_ struct{} `type:"structure"`
 
-	// A list of receipt handles for the messages to be deleted.
+	// Lists the receipt handles for the messages to be deleted.
 	//
 	// Entries is a required field
 	Entries []*DeleteMessageBatchRequestEntry `locationNameList:"DeleteMessageBatchRequestEntry" type:"list" flattened:"true" required:"true"`
@@ -2677,12 +3264,20 @@ type DeleteMessageBatchInput struct {
 	QueueUrl *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteMessageBatchInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteMessageBatchInput) GoString() string {
 	return s.String()
 }
@@ -2742,12 +3337,20 @@ type DeleteMessageBatchOutput struct {
 	Successful []*DeleteMessageBatchResultEntry `locationNameList:"DeleteMessageBatchResultEntry" type:"list" flattened:"true" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteMessageBatchOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteMessageBatchOutput) GoString() string {
 	return s.String()
 }
@@ -2768,10 +3371,13 @@ func (s *DeleteMessageBatchOutput) SetSuccessful(v []*DeleteMessageBatchResultEn
 type DeleteMessageBatchRequestEntry struct {
 	_ struct{} `type:"structure"`
 
-	// An identifier for this particular receipt handle. This is used to communicate
+	// The identifier for this particular receipt handle. This is used to communicate
 	// the result.
 	//
-	// The Ids of a batch request need to be unique within a request
+	// The Ids of a batch request need to be unique within a request.
+	//
+	// This identifier can have up to 80 characters. The following characters are
+	// accepted: alphanumeric characters, hyphens(-), and underscores (_).
 	//
 	// Id is a required field
 	Id *string `type:"string" required:"true"`
@@ -2782,12 +3388,20 @@ type DeleteMessageBatchRequestEntry struct {
 	ReceiptHandle *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteMessageBatchRequestEntry) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteMessageBatchRequestEntry) GoString() string {
 	return s.String()
 }
@@ -2830,12 +3444,20 @@ type DeleteMessageBatchResultEntry struct {
 	Id *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteMessageBatchResultEntry) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteMessageBatchResultEntry) GoString() string {
 	return s.String()
 }
@@ -2862,12 +3484,20 @@ type DeleteMessageInput struct {
 	ReceiptHandle *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteMessageInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteMessageInput) GoString() string {
 	return s.String()
 }
@@ -2904,12 +3534,20 @@ type DeleteMessageOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteMessageOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteMessageOutput) GoString() string {
 	return s.String()
 }
@@ -2925,12 +3563,20 @@ type DeleteQueueInput struct {
 	QueueUrl *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteQueueInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteQueueInput) GoString() string {
 	return s.String()
 }
@@ -2958,12 +3604,20 @@ type DeleteQueueOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteQueueOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteQueueOutput) GoString() string {
 	return s.String()
 }
@@ -2973,89 +3627,152 @@ type GetQueueAttributesInput struct {
 
 	// A list of attributes for which to retrieve information.
 	//
+	// The AttributeNames parameter is optional, but if you don't specify values
+	// for this parameter, the request returns empty results.
+	//
 	// In the future, new attributes might be added. If you write code that calls
 	// this action, we recommend that you structure your code so that it can handle
 	// new attributes gracefully.
 	//
 	// The following attributes are supported:
 	//
-	//    * All - Returns all values.
+	// The ApproximateNumberOfMessagesDelayed, ApproximateNumberOfMessagesNotVisible,
+	// and ApproximateNumberOfMessages metrics may not achieve consistency until
+	// at least 1 minute after the producers stop sending messages. This period
+	// is required for the queue metadata to reach eventual consistency.
 	//
-	//    * ApproximateNumberOfMessages - Returns the approximate number of messages
+	//    * All – Returns all values.
+	//
+	//    * ApproximateNumberOfMessages – Returns the approximate number of messages
 	//    available for retrieval from the queue.
 	//
-	//    * ApproximateNumberOfMessagesDelayed - Returns the approximate number
+	//    * ApproximateNumberOfMessagesDelayed – Returns the approximate number
 	//    of messages in the queue that are delayed and not available for reading
 	//    immediately. This can happen when the queue is configured as a delay queue
 	//    or when a message has been sent with a delay parameter.
 	//
-	//    * ApproximateNumberOfMessagesNotVisible - Returns the approximate number
+	//    * ApproximateNumberOfMessagesNotVisible – Returns the approximate number
 	//    of messages that are in flight. Messages are considered to be in flight
 	//    if they have been sent to a client but have not yet been deleted or have
 	//    not yet reached the end of their visibility window.
 	//
-	//    * CreatedTimestamp - Returns the time when the queue was created in seconds
-	//    (epoch time (http://en.wikipedia.org/wiki/Unix_time)).
+	//    * CreatedTimestamp – Returns the time when the queue was created in
+	//    seconds (epoch time (http://en.wikipedia.org/wiki/Unix_time)).
 	//
-	//    * DelaySeconds - Returns the default delay on the queue in seconds.
+	//    * DelaySeconds – Returns the default delay on the queue in seconds.
 	//
-	//    * LastModifiedTimestamp - Returns the time when the queue was last changed
+	//    * LastModifiedTimestamp – Returns the time when the queue was last changed
 	//    in seconds (epoch time (http://en.wikipedia.org/wiki/Unix_time)).
 	//
-	//    * MaximumMessageSize - Returns the limit of how many bytes a message can
-	//    contain before Amazon SQS rejects it.
+	//    * MaximumMessageSize – Returns the limit of how many bytes a message
+	//    can contain before Amazon SQS rejects it.
 	//
-	//    * MessageRetentionPeriod - Returns the length of time, in seconds, for
-	//    which Amazon SQS retains a message.
+	//    * MessageRetentionPeriod – Returns the length of time, in seconds, for
+	//    which Amazon SQS retains a message. When you change a queue's attributes,
+	//    the change can take up to 60 seconds for most of the attributes to propagate
+	//    throughout the Amazon SQS system. Changes made to the MessageRetentionPeriod
+	//    attribute can take up to 15 minutes and will impact existing messages
+	//    in the queue potentially causing them to be expired and deleted if the
+	//    MessageRetentionPeriod is reduced below the age of existing messages.
 	//
-	//    * Policy - Returns the policy of the queue.
+	//    * Policy – Returns the policy of the queue.
 	//
-	//    * QueueArn - Returns the Amazon resource name (ARN) of the queue.
+	//    * QueueArn – Returns the Amazon resource name (ARN) of the queue.
 	//
-	//    * ReceiveMessageWaitTimeSeconds - Returns the length of time, in seconds,
+	//    * ReceiveMessageWaitTimeSeconds – Returns the length of time, in seconds,
 	//    for which the ReceiveMessage action waits for a message to arrive.
 	//
-	//    * RedrivePolicy - Returns the string that includes the parameters for
-	//    dead-letter queue functionality of the source queue. For more information
-	//    about the redrive policy and dead-letter queues, see Using Amazon SQS
-	//    Dead-Letter Queues (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-dead-letter-queues.html)
-	//    in the Amazon Simple Queue Service Developer Guide. deadLetterTargetArn
-	//    - The Amazon Resource Name (ARN) of the dead-letter queue to which Amazon
-	//    SQS moves messages after the value of maxReceiveCount is exceeded. maxReceiveCount
-	//    - The number of times a message is delivered to the source queue before
-	//    being moved to the dead-letter queue. When the ReceiveCount for a message
+	//    * VisibilityTimeout – Returns the visibility timeout for the queue.
+	//    For more information about the visibility timeout, see Visibility Timeout
+	//    (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-visibility-timeout.html)
+	//    in the Amazon SQS Developer Guide.
+	//
+	// The following attributes apply only to dead-letter queues: (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-dead-letter-queues.html)
+	//
+	//    * RedrivePolicy – The string that includes the parameters for the dead-letter
+	//    queue functionality of the source queue as a JSON object. The parameters
+	//    are as follows: deadLetterTargetArn – The Amazon Resource Name (ARN)
+	//    of the dead-letter queue to which Amazon SQS moves messages after the
+	//    value of maxReceiveCount is exceeded. maxReceiveCount – The number of
+	//    times a message is delivered to the source queue before being moved to
+	//    the dead-letter queue. Default: 10. When the ReceiveCount for a message
 	//    exceeds the maxReceiveCount for a queue, Amazon SQS moves the message
 	//    to the dead-letter-queue.
 	//
-	//    * VisibilityTimeout - Returns the visibility timeout for the queue. For
-	//    more information about the visibility timeout, see Visibility Timeout
-	//    (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-visibility-timeout.html)
-	//    in the Amazon Simple Queue Service Developer Guide.
+	//    * RedriveAllowPolicy – The string that includes the parameters for the
+	//    permissions for the dead-letter queue redrive permission and which source
+	//    queues can specify dead-letter queues as a JSON object. The parameters
+	//    are as follows: redrivePermission – The permission type that defines
+	//    which source queues can specify the current queue as the dead-letter queue.
+	//    Valid values are: allowAll – (Default) Any source queues in this Amazon
+	//    Web Services account in the same Region can specify this queue as the
+	//    dead-letter queue. denyAll – No source queues can specify this queue
+	//    as the dead-letter queue. byQueue – Only queues specified by the sourceQueueArns
+	//    parameter can specify this queue as the dead-letter queue. sourceQueueArns
+	//    – The Amazon Resource Names (ARN)s of the source queues that can specify
+	//    this queue as the dead-letter queue and redrive messages. You can specify
+	//    this parameter only when the redrivePermission parameter is set to byQueue.
+	//    You can specify up to 10 source queue ARNs. To allow more than 10 source
+	//    queues to specify dead-letter queues, set the redrivePermission parameter
+	//    to allowAll.
+	//
+	// The dead-letter queue of a FIFO queue must also be a FIFO queue. Similarly,
+	// the dead-letter queue of a standard queue must also be a standard queue.
 	//
 	// The following attributes apply only to server-side-encryption (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-server-side-encryption.html):
 	//
-	//    * KmsMasterKeyId - Returns the ID of an AWS-managed customer master key
-	//    (CMK) for Amazon SQS or a custom CMK. For more information, see Key Terms
-	//    (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-server-side-encryption.html#sqs-sse-key-terms).
+	//    * KmsMasterKeyId – Returns the ID of an Amazon Web Services managed
+	//    customer master key (CMK) for Amazon SQS or a custom CMK. For more information,
+	//    see Key Terms (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-server-side-encryption.html#sqs-sse-key-terms).
 	//
-	//    * KmsDataKeyReusePeriodSeconds - Returns the length of time, in seconds,
+	//    * KmsDataKeyReusePeriodSeconds – Returns the length of time, in seconds,
 	//    for which Amazon SQS can reuse a data key to encrypt or decrypt messages
-	//    before calling AWS KMS again. For more information, see How Does the Data
+	//    before calling KMS again. For more information, see How Does the Data
 	//    Key Reuse Period Work? (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-server-side-encryption.html#sqs-how-does-the-data-key-reuse-period-work).
 	//
+	//    * SqsManagedSseEnabled – Returns information about whether the queue
+	//    is using SSE-SQS encryption using SQS owned encryption keys. Only one
+	//    server-side encryption option is supported per queue (for example, SSE-KMS
+	//    (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-configure-sse-existing-queue.html)
+	//    or SSE-SQS (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-configure-sqs-sse-queue.html)).
+	//
 	// The following attributes apply only to FIFO (first-in-first-out) queues (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues.html):
 	//
-	//    * FifoQueue - Returns whether the queue is FIFO. For more information,
-	//    see FIFO Queue Logic (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues.html#FIFO-queues-understanding-logic)
-	//    in the Amazon Simple Queue Service Developer Guide. To determine whether
-	//    a queue is FIFO (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues.html),
+	//    * FifoQueue – Returns information about whether the queue is FIFO. For
+	//    more information, see FIFO queue logic (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues-understanding-logic.html)
+	//    in the Amazon SQS Developer Guide. To determine whether a queue is FIFO
+	//    (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues.html),
 	//    you can check whether QueueName ends with the .fifo suffix.
 	//
-	//    * ContentBasedDeduplication - Returns whether content-based deduplication
-	//    is enabled for the queue. For more information, see Exactly-Once Processing
-	//    (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues.html#FIFO-queues-exactly-once-processing)
-	//    in the Amazon Simple Queue Service Developer Guide.
-	AttributeNames []*string `locationNameList:"AttributeName" type:"list" flattened:"true"`
+	//    * ContentBasedDeduplication – Returns whether content-based deduplication
+	//    is enabled for the queue. For more information, see Exactly-once processing
+	//    (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues-exactly-once-processing.html)
+	//    in the Amazon SQS Developer Guide.
+	//
+	// The following attributes apply only to high throughput for FIFO queues (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/high-throughput-fifo.html):
+	//
+	//    * DeduplicationScope – Specifies whether message deduplication occurs
+	//    at the message group or queue level. Valid values are messageGroup and
+	//    queue.
+	//
+	//    * FifoThroughputLimit – Specifies whether the FIFO queue throughput
+	//    quota applies to the entire queue or per message group. Valid values are
+	//    perQueue and perMessageGroupId. The perMessageGroupId value is allowed
+	//    only when the value for DeduplicationScope is messageGroup.
+	//
+	// To enable high throughput for FIFO queues, do the following:
+	//
+	//    * Set DeduplicationScope to messageGroup.
+	//
+	//    * Set FifoThroughputLimit to perMessageGroupId.
+	//
+	// If you set these attributes to anything other than the values shown for enabling
+	// high throughput, normal throughput is in effect and deduplication occurs
+	// as specified.
+	//
+	// For information on throughput quotas, see Quotas related to messages (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/quotas-messages.html)
+	// in the Amazon SQS Developer Guide.
+	AttributeNames []*string `locationNameList:"AttributeName" type:"list" flattened:"true" enum:"QueueAttributeName"`
 
 	// The URL of the Amazon SQS queue whose attribute information is retrieved.
 	//
@@ -3065,12 +3782,20 @@ type GetQueueAttributesInput struct {
 	QueueUrl *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetQueueAttributesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetQueueAttributesInput) GoString() string {
 	return s.String()
 }
@@ -3108,52 +3833,271 @@ type GetQueueAttributesOutput struct {
 	Attributes map[string]*string `locationName:"Attribute" locationNameKey:"Name" locationNameValue:"Value" type:"map" flattened:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s GetQueueAttributesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetQueueAttributesOutput) GoString() string {
-	return s.String()
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetQueueAttributesOutput) GoString() string {
+	return s.String()
+}
+
+// SetAttributes sets the Attributes field's value.
+func (s *GetQueueAttributesOutput) SetAttributes(v map[string]*string) *GetQueueAttributesOutput {
+	s.Attributes = v
+	return s
+}
+
+type GetQueueUrlInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the queue whose URL must be fetched. Maximum 80 characters. Valid
+	// values: alphanumeric characters, hyphens (-), and underscores (_).
+	//
+	// Queue URLs and names are case-sensitive.
+	//
+	// QueueName is a required field
+	QueueName *string `type:"string" required:"true"`
+
+	// The Amazon Web Services account ID of the account that created the queue.
+	QueueOwnerAWSAccountId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetQueueUrlInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetQueueUrlInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetQueueUrlInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetQueueUrlInput"}
+	if s.QueueName == nil {
+		invalidParams.Add(request.NewErrParamRequired("QueueName"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetQueueName sets the QueueName field's value.
+func (s *GetQueueUrlInput) SetQueueName(v string) *GetQueueUrlInput {
+	s.QueueName = &v
+	return s
+}
+
+// SetQueueOwnerAWSAccountId sets the QueueOwnerAWSAccountId field's value.
+func (s *GetQueueUrlInput) SetQueueOwnerAWSAccountId(v string) *GetQueueUrlInput {
+	s.QueueOwnerAWSAccountId = &v
+	return s
+}
+
+// For more information, see Interpreting Responses (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-api-responses.html)
+// in the Amazon SQS Developer Guide.
+type GetQueueUrlOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The URL of the queue.
+	QueueUrl *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetQueueUrlOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetQueueUrlOutput) GoString() string {
+	return s.String()
+}
+
+// SetQueueUrl sets the QueueUrl field's value.
+func (s *GetQueueUrlOutput) SetQueueUrl(v string) *GetQueueUrlOutput {
+	s.QueueUrl = &v
+	return s
+}
+
+type ListDeadLetterSourceQueuesInput struct {
+	_ struct{} `type:"structure"`
+
+	// Maximum number of results to include in the response. Value range is 1 to
+	// 1000. You must set MaxResults to receive a value for NextToken in the response.
+	MaxResults *int64 `type:"integer"`
+
+	// Pagination token to request the next set of results.
+	NextToken *string `type:"string"`
+
+	// The URL of a dead-letter queue.
+	//
+	// Queue URLs and names are case-sensitive.
+	//
+	// QueueUrl is a required field
+	QueueUrl *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListDeadLetterSourceQueuesInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListDeadLetterSourceQueuesInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListDeadLetterSourceQueuesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListDeadLetterSourceQueuesInput"}
+	if s.QueueUrl == nil {
+		invalidParams.Add(request.NewErrParamRequired("QueueUrl"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListDeadLetterSourceQueuesInput) SetMaxResults(v int64) *ListDeadLetterSourceQueuesInput {
+	s.MaxResults = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListDeadLetterSourceQueuesInput) SetNextToken(v string) *ListDeadLetterSourceQueuesInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetQueueUrl sets the QueueUrl field's value.
+func (s *ListDeadLetterSourceQueuesInput) SetQueueUrl(v string) *ListDeadLetterSourceQueuesInput {
+	s.QueueUrl = &v
+	return s
+}
+
+// A list of your dead letter source queues.
+type ListDeadLetterSourceQueuesOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Pagination token to include in the next request. Token value is null if there
+	// are no additional results to request, or if you did not set MaxResults in
+	// the request.
+	NextToken *string `type:"string"`
+
+	// A list of source queue URLs that have the RedrivePolicy queue attribute configured
+	// with a dead-letter queue.
+	//
+	// QueueUrls is a required field
+	QueueUrls []*string `locationName:"queueUrls" locationNameList:"QueueUrl" type:"list" flattened:"true" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListDeadLetterSourceQueuesOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListDeadLetterSourceQueuesOutput) GoString() string {
+	return s.String()
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListDeadLetterSourceQueuesOutput) SetNextToken(v string) *ListDeadLetterSourceQueuesOutput {
+	s.NextToken = &v
+	return s
 }
 
-// SetAttributes sets the Attributes field's value.
-func (s *GetQueueAttributesOutput) SetAttributes(v map[string]*string) *GetQueueAttributesOutput {
-	s.Attributes = v
+// SetQueueUrls sets the QueueUrls field's value.
+func (s *ListDeadLetterSourceQueuesOutput) SetQueueUrls(v []*string) *ListDeadLetterSourceQueuesOutput {
+	s.QueueUrls = v
 	return s
 }
 
-type GetQueueUrlInput struct {
+type ListMessageMoveTasksInput struct {
 	_ struct{} `type:"structure"`
 
-	// The name of the queue whose URL must be fetched. Maximum 80 characters. Valid
-	// values: alphanumeric characters, hyphens (-), and underscores (_).
-	//
-	// Queue URLs and names are case-sensitive.
-	//
-	// QueueName is a required field
-	QueueName *string `type:"string" required:"true"`
+	// The maximum number of results to include in the response. The default is
+	// 1, which provides the most recent message movement task. The upper limit
+	// is 10.
+	MaxResults *int64 `type:"integer"`
 
-	// The AWS account ID of the account that created the queue.
-	QueueOwnerAWSAccountId *string `type:"string"`
+	// The ARN of the queue whose message movement tasks are to be listed.
+	//
+	// SourceArn is a required field
+	SourceArn *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s GetQueueUrlInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListMessageMoveTasksInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetQueueUrlInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListMessageMoveTasksInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *GetQueueUrlInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetQueueUrlInput"}
-	if s.QueueName == nil {
-		invalidParams.Add(request.NewErrParamRequired("QueueName"))
+func (s *ListMessageMoveTasksInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListMessageMoveTasksInput"}
+	if s.SourceArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("SourceArn"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -3162,107 +4106,159 @@ func (s *GetQueueUrlInput) Validate() error {
 	return nil
 }
 
-// SetQueueName sets the QueueName field's value.
-func (s *GetQueueUrlInput) SetQueueName(v string) *GetQueueUrlInput {
-	s.QueueName = &v
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListMessageMoveTasksInput) SetMaxResults(v int64) *ListMessageMoveTasksInput {
+	s.MaxResults = &v
 	return s
 }
 
-// SetQueueOwnerAWSAccountId sets the QueueOwnerAWSAccountId field's value.
-func (s *GetQueueUrlInput) SetQueueOwnerAWSAccountId(v string) *GetQueueUrlInput {
-	s.QueueOwnerAWSAccountId = &v
+// SetSourceArn sets the SourceArn field's value.
+func (s *ListMessageMoveTasksInput) SetSourceArn(v string) *ListMessageMoveTasksInput {
+	s.SourceArn = &v
 	return s
 }
 
-// For more information, see Interpreting Responses (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-api-responses.html)
-// in the Amazon Simple Queue Service Developer Guide.
-type GetQueueUrlOutput struct {
+type ListMessageMoveTasksOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The URL of the queue.
-	QueueUrl *string `type:"string"`
+	// A list of message movement tasks and their attributes.
+	Results []*ListMessageMoveTasksResultEntry `locationNameList:"ListMessageMoveTasksResultEntry" type:"list" flattened:"true"`
 }
 
-// String returns the string representation
-func (s GetQueueUrlOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListMessageMoveTasksOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetQueueUrlOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListMessageMoveTasksOutput) GoString() string {
 	return s.String()
 }
 
-// SetQueueUrl sets the QueueUrl field's value.
-func (s *GetQueueUrlOutput) SetQueueUrl(v string) *GetQueueUrlOutput {
-	s.QueueUrl = &v
+// SetResults sets the Results field's value.
+func (s *ListMessageMoveTasksOutput) SetResults(v []*ListMessageMoveTasksResultEntry) *ListMessageMoveTasksOutput {
+	s.Results = v
 	return s
 }
 
-type ListDeadLetterSourceQueuesInput struct {
+// Contains the details of a message movement task.
+type ListMessageMoveTasksResultEntry struct {
 	_ struct{} `type:"structure"`
 
-	// The URL of a dead-letter queue.
-	//
-	// Queue URLs and names are case-sensitive.
-	//
-	// QueueUrl is a required field
-	QueueUrl *string `type:"string" required:"true"`
+	// The approximate number of messages already moved to the destination queue.
+	ApproximateNumberOfMessagesMoved *int64 `type:"long"`
+
+	// The number of messages to be moved from the source queue. This number is
+	// obtained at the time of starting the message movement task.
+	ApproximateNumberOfMessagesToMove *int64 `type:"long"`
+
+	// The ARN of the destination queue if it has been specified in the StartMessageMoveTask
+	// request. If a DestinationArn has not been specified in the StartMessageMoveTask
+	// request, this field value will be NULL.
+	DestinationArn *string `type:"string"`
+
+	// The task failure reason (only included if the task status is FAILED).
+	FailureReason *string `type:"string"`
+
+	// The number of messages to be moved per second (the message movement rate),
+	// if it has been specified in the StartMessageMoveTask request. If a MaxNumberOfMessagesPerSecond
+	// has not been specified in the StartMessageMoveTask request, this field value
+	// will be NULL.
+	MaxNumberOfMessagesPerSecond *int64 `type:"integer"`
+
+	// The ARN of the queue that contains the messages to be moved to another queue.
+	SourceArn *string `type:"string"`
+
+	// The timestamp of starting the message movement task.
+	StartedTimestamp *int64 `type:"long"`
+
+	// The status of the message movement task. Possible values are: RUNNING, COMPLETED,
+	// CANCELLING, CANCELLED, and FAILED.
+	Status *string `type:"string"`
+
+	// An identifier associated with a message movement task. When this field is
+	// returned in the response of the ListMessageMoveTasks action, it is only populated
+	// for tasks that are in RUNNING status.
+	TaskHandle *string `type:"string"`
 }
 
-// String returns the string representation
-func (s ListDeadLetterSourceQueuesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListMessageMoveTasksResultEntry) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ListDeadLetterSourceQueuesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListMessageMoveTasksResultEntry) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *ListDeadLetterSourceQueuesInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "ListDeadLetterSourceQueuesInput"}
-	if s.QueueUrl == nil {
-		invalidParams.Add(request.NewErrParamRequired("QueueUrl"))
-	}
+// SetApproximateNumberOfMessagesMoved sets the ApproximateNumberOfMessagesMoved field's value.
+func (s *ListMessageMoveTasksResultEntry) SetApproximateNumberOfMessagesMoved(v int64) *ListMessageMoveTasksResultEntry {
+	s.ApproximateNumberOfMessagesMoved = &v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetApproximateNumberOfMessagesToMove sets the ApproximateNumberOfMessagesToMove field's value.
+func (s *ListMessageMoveTasksResultEntry) SetApproximateNumberOfMessagesToMove(v int64) *ListMessageMoveTasksResultEntry {
+	s.ApproximateNumberOfMessagesToMove = &v
+	return s
 }
 
-// SetQueueUrl sets the QueueUrl field's value.
-func (s *ListDeadLetterSourceQueuesInput) SetQueueUrl(v string) *ListDeadLetterSourceQueuesInput {
-	s.QueueUrl = &v
+// SetDestinationArn sets the DestinationArn field's value.
+func (s *ListMessageMoveTasksResultEntry) SetDestinationArn(v string) *ListMessageMoveTasksResultEntry {
+	s.DestinationArn = &v
 	return s
 }
 
-// A list of your dead letter source queues.
-type ListDeadLetterSourceQueuesOutput struct {
-	_ struct{} `type:"structure"`
+// SetFailureReason sets the FailureReason field's value.
+func (s *ListMessageMoveTasksResultEntry) SetFailureReason(v string) *ListMessageMoveTasksResultEntry {
+	s.FailureReason = &v
+	return s
+}
 
-	// A list of source queue URLs that have the RedrivePolicy queue attribute configured
-	// with a dead-letter queue.
-	//
-	// QueueUrls is a required field
-	QueueUrls []*string `locationName:"queueUrls" locationNameList:"QueueUrl" type:"list" flattened:"true" required:"true"`
+// SetMaxNumberOfMessagesPerSecond sets the MaxNumberOfMessagesPerSecond field's value.
+func (s *ListMessageMoveTasksResultEntry) SetMaxNumberOfMessagesPerSecond(v int64) *ListMessageMoveTasksResultEntry {
+	s.MaxNumberOfMessagesPerSecond = &v
+	return s
 }
 
-// String returns the string representation
-func (s ListDeadLetterSourceQueuesOutput) String() string {
-	return awsutil.Prettify(s)
+// SetSourceArn sets the SourceArn field's value.
+func (s *ListMessageMoveTasksResultEntry) SetSourceArn(v string) *ListMessageMoveTasksResultEntry {
+	s.SourceArn = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s ListDeadLetterSourceQueuesOutput) GoString() string {
-	return s.String()
+// SetStartedTimestamp sets the StartedTimestamp field's value.
+func (s *ListMessageMoveTasksResultEntry) SetStartedTimestamp(v int64) *ListMessageMoveTasksResultEntry {
+	s.StartedTimestamp = &v
+	return s
 }
 
-// SetQueueUrls sets the QueueUrls field's value.
-func (s *ListDeadLetterSourceQueuesOutput) SetQueueUrls(v []*string) *ListDeadLetterSourceQueuesOutput {
-	s.QueueUrls = v
+// SetStatus sets the Status field's value.
+func (s *ListMessageMoveTasksResultEntry) SetStatus(v string) *ListMessageMoveTasksResultEntry {
+	s.Status = &v
+	return s
+}
+
+// SetTaskHandle sets the TaskHandle field's value.
+func (s *ListMessageMoveTasksResultEntry) SetTaskHandle(v string) *ListMessageMoveTasksResultEntry {
+	s.TaskHandle = &v
 	return s
 }
 
@@ -3275,12 +4271,20 @@ type ListQueueTagsInput struct {
 	QueueUrl *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListQueueTagsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListQueueTagsInput) GoString() string {
 	return s.String()
 }
@@ -3311,12 +4315,20 @@ type ListQueueTagsOutput struct {
 	Tags map[string]*string `locationName:"Tag" locationNameKey:"Key" locationNameValue:"Value" type:"map" flattened:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListQueueTagsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListQueueTagsOutput) GoString() string {
 	return s.String()
 }
@@ -3330,6 +4342,13 @@ func (s *ListQueueTagsOutput) SetTags(v map[string]*string) *ListQueueTagsOutput
 type ListQueuesInput struct {
 	_ struct{} `type:"structure"`
 
+	// Maximum number of results to include in the response. Value range is 1 to
+	// 1000. You must set MaxResults to receive a value for NextToken in the response.
+	MaxResults *int64 `type:"integer"`
+
+	// Pagination token to request the next set of results.
+	NextToken *string `type:"string"`
+
 	// A string to use for filtering the list results. Only those queues whose name
 	// begins with the specified string are returned.
 	//
@@ -3337,16 +4356,36 @@ type ListQueuesInput struct {
 	QueueNamePrefix *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListQueuesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListQueuesInput) GoString() string {
 	return s.String()
 }
 
+// SetMaxResults sets the MaxResults field's value.
+func (s *ListQueuesInput) SetMaxResults(v int64) *ListQueuesInput {
+	s.MaxResults = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *ListQueuesInput) SetNextToken(v string) *ListQueuesInput {
+	s.NextToken = &v
+	return s
+}
+
 // SetQueueNamePrefix sets the QueueNamePrefix field's value.
 func (s *ListQueuesInput) SetQueueNamePrefix(v string) *ListQueuesInput {
 	s.QueueNamePrefix = &v
@@ -3357,20 +4396,40 @@ func (s *ListQueuesInput) SetQueueNamePrefix(v string) *ListQueuesInput {
 type ListQueuesOutput struct {
 	_ struct{} `type:"structure"`
 
-	// A list of queue URLs, up to 1,000 entries.
+	// Pagination token to include in the next request. Token value is null if there
+	// are no additional results to request, or if you did not set MaxResults in
+	// the request.
+	NextToken *string `type:"string"`
+
+	// A list of queue URLs, up to 1,000 entries, or the value of MaxResults that
+	// you sent in the request.
 	QueueUrls []*string `locationNameList:"QueueUrl" type:"list" flattened:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListQueuesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListQueuesOutput) GoString() string {
 	return s.String()
 }
 
+// SetNextToken sets the NextToken field's value.
+func (s *ListQueuesOutput) SetNextToken(v string) *ListQueuesOutput {
+	s.NextToken = &v
+	return s
+}
+
 // SetQueueUrls sets the QueueUrls field's value.
 func (s *ListQueuesOutput) SetQueueUrls(v []*string) *ListQueuesOutput {
 	s.QueueUrls = v
@@ -3416,12 +4475,12 @@ type Message struct {
 	MD5OfMessageAttributes *string `type:"string"`
 
 	// Each message attribute consists of a Name, Type, and Value. For more information,
-	// see Amazon SQS Message Attributes (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-message-attributes.html)
-	// in the Amazon Simple Queue Service Developer Guide.
+	// see Amazon SQS message attributes (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-message-metadata.html#sqs-message-attributes)
+	// in the Amazon SQS Developer Guide.
 	MessageAttributes map[string]*MessageAttributeValue `locationName:"MessageAttribute" locationNameKey:"Name" locationNameValue:"Value" type:"map" flattened:"true"`
 
 	// A unique identifier for the message. A MessageIdis considered unique across
-	// all AWS accounts for an extended period of time.
+	// all Amazon Web Services accounts for an extended period of time.
 	MessageId *string `type:"string"`
 
 	// An identifier associated with the act of receiving the message. A new receipt
@@ -3430,12 +4489,20 @@ type Message struct {
 	ReceiptHandle *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Message) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Message) GoString() string {
 	return s.String()
 }
@@ -3488,7 +4555,7 @@ func (s *Message) SetReceiptHandle(v string) *Message {
 //
 // Name, type, value and the message body must not be empty or null. All parts
 // of the message attribute, including Name, Type, and Value, are part of the
-// message size restriction (256 KB or 262,144 bytes).
+// message size restriction (256 KiB or 262,144 bytes).
 type MessageAttributeValue struct {
 	_ struct{} `type:"structure"`
 
@@ -3497,7 +4564,6 @@ type MessageAttributeValue struct {
 
 	// Binary type attributes can store any binary data, such as compressed data,
 	// encrypted data, or images.
-	//
 	// BinaryValue is automatically base64 encoded/decoded by the SDK.
 	BinaryValue []byte `type:"blob"`
 
@@ -3505,8 +4571,8 @@ type MessageAttributeValue struct {
 	// Binary. For the Number data type, you must use StringValue.
 	//
 	// You can also append custom labels. For more information, see Amazon SQS Message
-	// Attributes (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-message-attributes.html)
-	// in the Amazon Simple Queue Service Developer Guide.
+	// Attributes (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-message-metadata.html#sqs-message-attributes)
+	// in the Amazon SQS Developer Guide.
 	//
 	// DataType is a required field
 	DataType *string `type:"string" required:"true"`
@@ -3519,12 +4585,20 @@ type MessageAttributeValue struct {
 	StringValue *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s MessageAttributeValue) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s MessageAttributeValue) GoString() string {
 	return s.String()
 }
@@ -3585,7 +4659,6 @@ type MessageSystemAttributeValue struct {
 
 	// Binary type attributes can store any binary data, such as compressed data,
 	// encrypted data, or images.
-	//
 	// BinaryValue is automatically base64 encoded/decoded by the SDK.
 	BinaryValue []byte `type:"blob"`
 
@@ -3593,8 +4666,8 @@ type MessageSystemAttributeValue struct {
 	// Binary. For the Number data type, you must use StringValue.
 	//
 	// You can also append custom labels. For more information, see Amazon SQS Message
-	// Attributes (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-message-attributes.html)
-	// in the Amazon Simple Queue Service Developer Guide.
+	// Attributes (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-message-metadata.html#sqs-message-attributes)
+	// in the Amazon SQS Developer Guide.
 	//
 	// DataType is a required field
 	DataType *string `type:"string" required:"true"`
@@ -3607,12 +4680,20 @@ type MessageSystemAttributeValue struct {
 	StringValue *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s MessageSystemAttributeValue) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s MessageSystemAttributeValue) GoString() string {
 	return s.String()
 }
@@ -3671,12 +4752,20 @@ type PurgeQueueInput struct {
 	QueueUrl *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PurgeQueueInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PurgeQueueInput) GoString() string {
 	return s.String()
 }
@@ -3704,12 +4793,20 @@ type PurgeQueueOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PurgeQueueOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PurgeQueueOutput) GoString() string {
 	return s.String()
 }
@@ -3720,32 +4817,37 @@ type ReceiveMessageInput struct {
 	// A list of attributes that need to be returned along with each message. These
 	// attributes include:
 	//
-	//    * All - Returns all values.
+	//    * All – Returns all values.
 	//
-	//    * ApproximateFirstReceiveTimestamp - Returns the time the message was
+	//    * ApproximateFirstReceiveTimestamp – Returns the time the message was
 	//    first received from the queue (epoch time (http://en.wikipedia.org/wiki/Unix_time)
 	//    in milliseconds).
 	//
-	//    * ApproximateReceiveCount - Returns the number of times a message has
-	//    been received from the queue but not deleted.
+	//    * ApproximateReceiveCount – Returns the number of times a message has
+	//    been received across all queues but not deleted.
 	//
-	//    * AWSTraceHeader - Returns the AWS X-Ray trace header string.
+	//    * AWSTraceHeader – Returns the X-Ray trace header string.
 	//
-	//    * SenderId For an IAM user, returns the IAM user ID, for example ABCDEFGHI1JKLMNOPQ23R.
+	//    * SenderId For a user, returns the user ID, for example ABCDEFGHI1JKLMNOPQ23R.
 	//    For an IAM role, returns the IAM role ID, for example ABCDE1F2GH3I4JK5LMNOP:i-a123b456.
 	//
-	//    * SentTimestamp - Returns the time the message was sent to the queue (epoch
-	//    time (http://en.wikipedia.org/wiki/Unix_time) in milliseconds).
+	//    * SentTimestamp – Returns the time the message was sent to the queue
+	//    (epoch time (http://en.wikipedia.org/wiki/Unix_time) in milliseconds).
 	//
-	//    * MessageDeduplicationId - Returns the value provided by the producer
+	//    * SqsManagedSseEnabled – Enables server-side queue encryption using
+	//    SQS owned encryption keys. Only one server-side encryption option is supported
+	//    per queue (for example, SSE-KMS (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-configure-sse-existing-queue.html)
+	//    or SSE-SQS (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-configure-sqs-sse-queue.html)).
+	//
+	//    * MessageDeduplicationId – Returns the value provided by the producer
 	//    that calls the SendMessage action.
 	//
-	//    * MessageGroupId - Returns the value provided by the producer that calls
+	//    * MessageGroupId – Returns the value provided by the producer that calls
 	//    the SendMessage action. Messages with the same MessageGroupId are returned
 	//    in sequence.
 	//
-	//    * SequenceNumber - Returns the value provided by Amazon SQS.
-	AttributeNames []*string `locationNameList:"AttributeName" type:"list" flattened:"true"`
+	//    * SequenceNumber – Returns the value provided by Amazon SQS.
+	AttributeNames []*string `locationNameList:"AttributeName" type:"list" flattened:"true" enum:"QueueAttributeName"`
 
 	// The maximum number of messages to return. Amazon SQS never returns more messages
 	// than this value (however, fewer messages might be returned). Valid values:
@@ -3785,9 +4887,9 @@ type ReceiveMessageInput struct {
 	//
 	// The token used for deduplication of ReceiveMessage calls. If a networking
 	// issue occurs after a ReceiveMessage action, and instead of a response you
-	// receive a generic error, you can retry the same action with an identical
-	// ReceiveRequestAttemptId to retrieve the same set of messages, even if their
-	// visibility timeout has not yet expired.
+	// receive a generic error, it is possible to retry the same action with an
+	// identical ReceiveRequestAttemptId to retrieve the same set of messages, even
+	// if their visibility timeout has not yet expired.
 	//
 	//    * You can use ReceiveRequestAttemptId only for 5 minutes after a ReceiveMessage
 	//    action.
@@ -3798,7 +4900,7 @@ type ReceiveMessageInput struct {
 	//    * If a caller of the ReceiveMessage action doesn't provide a ReceiveRequestAttemptId,
 	//    Amazon SQS generates a ReceiveRequestAttemptId.
 	//
-	//    * You can retry the ReceiveMessage action with the same ReceiveRequestAttemptId
+	//    * It is possible to retry the ReceiveMessage action with the same ReceiveRequestAttemptId
 	//    if none of the messages have been modified (deleted or had their visibility
 	//    changes).
 	//
@@ -3806,15 +4908,14 @@ type ReceiveMessageInput struct {
 	//    return the same messages and receipt handles. If a retry occurs within
 	//    the deduplication interval, it resets the visibility timeout. For more
 	//    information, see Visibility Timeout (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-visibility-timeout.html)
-	//    in the Amazon Simple Queue Service Developer Guide. If a caller of the
-	//    ReceiveMessage action still processes messages when the visibility timeout
-	//    expires and messages become visible, another worker consuming from the
-	//    same queue can receive the same messages and therefore process duplicates.
-	//    Also, if a consumer whose message processing time is longer than the visibility
-	//    timeout tries to delete the processed messages, the action fails with
-	//    an error. To mitigate this effect, ensure that your application observes
-	//    a safe threshold before the visibility timeout expires and extend the
-	//    visibility timeout as necessary.
+	//    in the Amazon SQS Developer Guide. If a caller of the ReceiveMessage action
+	//    still processes messages when the visibility timeout expires and messages
+	//    become visible, another worker consuming from the same queue can receive
+	//    the same messages and therefore process duplicates. Also, if a consumer
+	//    whose message processing time is longer than the visibility timeout tries
+	//    to delete the processed messages, the action fails with an error. To mitigate
+	//    this effect, ensure that your application observes a safe threshold before
+	//    the visibility timeout expires and extend the visibility timeout as necessary.
 	//
 	//    * While messages with a particular MessageGroupId are invisible, no more
 	//    messages belonging to the same MessageGroupId are returned until the visibility
@@ -3825,12 +4926,12 @@ type ReceiveMessageInput struct {
 	//    no retries work until the original visibility timeout expires. As a result,
 	//    delays might occur but the messages in the queue remain in a strict order.
 	//
-	// The length of ReceiveRequestAttemptId is 128 characters. ReceiveRequestAttemptId
+	// The maximum length of ReceiveRequestAttemptId is 128 characters. ReceiveRequestAttemptId
 	// can contain alphanumeric characters (a-z, A-Z, 0-9) and punctuation (!"#$%&'()*+,-./:;<=>?@[\]^_`{|}~).
 	//
 	// For best practices of using ReceiveRequestAttemptId, see Using the ReceiveRequestAttemptId
 	// Request Parameter (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/using-receiverequestattemptid-request-parameter.html)
-	// in the Amazon Simple Queue Service Developer Guide.
+	// in the Amazon SQS Developer Guide.
 	ReceiveRequestAttemptId *string `type:"string"`
 
 	// The duration (in seconds) that the received messages are hidden from subsequent
@@ -3841,15 +4942,30 @@ type ReceiveMessageInput struct {
 	// in the queue before returning. If a message is available, the call returns
 	// sooner than WaitTimeSeconds. If no messages are available and the wait time
 	// expires, the call returns successfully with an empty list of messages.
+	//
+	// To avoid HTTP errors, ensure that the HTTP response timeout for ReceiveMessage
+	// requests is longer than the WaitTimeSeconds parameter. For example, with
+	// the Java SDK, you can set HTTP transport settings using the NettyNioAsyncHttpClient
+	// (https://sdk.amazonaws.com/java/api/latest/software/amazon/awssdk/http/nio/netty/NettyNioAsyncHttpClient.html)
+	// for asynchronous clients, or the ApacheHttpClient (https://sdk.amazonaws.com/java/api/latest/software/amazon/awssdk/http/apache/ApacheHttpClient.html)
+	// for synchronous clients.
 	WaitTimeSeconds *int64 `type:"integer"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReceiveMessageInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReceiveMessageInput) GoString() string {
 	return s.String()
 }
@@ -3917,12 +5033,20 @@ type ReceiveMessageOutput struct {
 	Messages []*Message `locationNameList:"Message" type:"list" flattened:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReceiveMessageOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ReceiveMessageOutput) GoString() string {
 	return s.String()
 }
@@ -3950,12 +5074,20 @@ type RemovePermissionInput struct {
 	QueueUrl *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemovePermissionInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemovePermissionInput) GoString() string {
 	return s.String()
 }
@@ -3992,12 +5124,20 @@ type RemovePermissionOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemovePermissionOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RemovePermissionOutput) GoString() string {
 	return s.String()
 }
@@ -4018,12 +5158,20 @@ type SendMessageBatchInput struct {
 	QueueUrl *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendMessageBatchInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendMessageBatchInput) GoString() string {
 	return s.String()
 }
@@ -4084,12 +5232,20 @@ type SendMessageBatchOutput struct {
 	Successful []*SendMessageBatchResultEntry `locationNameList:"SendMessageBatchResultEntry" type:"list" flattened:"true" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendMessageBatchOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendMessageBatchOutput) GoString() string {
 	return s.String()
 }
@@ -4121,7 +5277,7 @@ type SendMessageBatchRequestEntry struct {
 
 	// An identifier for a message in this batch used to communicate the result.
 	//
-	// The Ids of a batch request need to be unique within a request
+	// The Ids of a batch request need to be unique within a request.
 	//
 	// This identifier can have up to 80 characters. The following characters are
 	// accepted: alphanumeric characters, hyphens(-), and underscores (_).
@@ -4130,8 +5286,8 @@ type SendMessageBatchRequestEntry struct {
 	Id *string `type:"string" required:"true"`
 
 	// Each message attribute consists of a Name, Type, and Value. For more information,
-	// see Amazon SQS Message Attributes (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-message-attributes.html)
-	// in the Amazon Simple Queue Service Developer Guide.
+	// see Amazon SQS message attributes (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-message-metadata.html#sqs-message-attributes)
+	// in the Amazon SQS Developer Guide.
 	MessageAttributes map[string]*MessageAttributeValue `locationName:"MessageAttribute" locationNameKey:"Name" locationNameValue:"Value" type:"map" flattened:"true"`
 
 	// The body of the message.
@@ -4144,8 +5300,8 @@ type SendMessageBatchRequestEntry struct {
 	// The token used for deduplication of messages within a 5-minute minimum deduplication
 	// interval. If a message with a particular MessageDeduplicationId is sent successfully,
 	// subsequent messages with the same MessageDeduplicationId are accepted successfully
-	// but aren't delivered. For more information, see Exactly-Once Processing (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues.html#FIFO-queues-exactly-once-processing)
-	// in the Amazon Simple Queue Service Developer Guide.
+	// but aren't delivered. For more information, see Exactly-once processing (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues-exactly-once-processing.html)
+	// in the Amazon SQS Developer Guide.
 	//
 	//    * Every message must have a unique MessageDeduplicationId, You may provide
 	//    a MessageDeduplicationId explicitly. If you aren't able to provide a MessageDeduplicationId
@@ -4180,7 +5336,7 @@ type SendMessageBatchRequestEntry struct {
 	//
 	// For best practices of using MessageDeduplicationId, see Using the MessageDeduplicationId
 	// Property (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/using-messagededuplicationid-property.html)
-	// in the Amazon Simple Queue Service Developer Guide.
+	// in the Amazon SQS Developer Guide.
 	MessageDeduplicationId *string `type:"string"`
 
 	// This parameter applies only to FIFO (first-in-first-out) queues.
@@ -4205,7 +5361,7 @@ type SendMessageBatchRequestEntry struct {
 	//
 	// For best practices of using MessageGroupId, see Using the MessageGroupId
 	// Property (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/using-messagegroupid-property.html)
-	// in the Amazon Simple Queue Service Developer Guide.
+	// in the Amazon SQS Developer Guide.
 	//
 	// MessageGroupId is required for FIFO queues. You can't use it for Standard
 	// queues.
@@ -4215,20 +5371,28 @@ type SendMessageBatchRequestEntry struct {
 	// of a Name, Type, and Value.
 	//
 	//    * Currently, the only supported message system attribute is AWSTraceHeader.
-	//    Its type must be String and its value must be a correctly formatted AWS
-	//    X-Ray trace string.
+	//    Its type must be String and its value must be a correctly formatted X-Ray
+	//    trace header string.
 	//
 	//    * The size of a message system attribute doesn't count towards the total
 	//    size of a message.
 	MessageSystemAttributes map[string]*MessageSystemAttributeValue `locationName:"MessageSystemAttribute" locationNameKey:"Name" locationNameValue:"Value" type:"map" flattened:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendMessageBatchRequestEntry) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendMessageBatchRequestEntry) GoString() string {
 	return s.String()
 }
@@ -4326,9 +5490,9 @@ type SendMessageBatchResultEntry struct {
 	// about MD5, see RFC1321 (https://www.ietf.org/rfc/rfc1321.txt).
 	MD5OfMessageAttributes *string `type:"string"`
 
-	// An MD5 digest of the non-URL-encoded message attribute string. You can use
-	// this attribute to verify that Amazon SQS received the message correctly.
-	// Amazon SQS URL-decodes the message before creating the MD5 digest. For information
+	// An MD5 digest of the non-URL-encoded message body string. You can use this
+	// attribute to verify that Amazon SQS received the message correctly. Amazon
+	// SQS URL-decodes the message before creating the MD5 digest. For information
 	// about MD5, see RFC1321 (https://www.ietf.org/rfc/rfc1321.txt).
 	//
 	// MD5OfMessageBody is a required field
@@ -4354,12 +5518,20 @@ type SendMessageBatchResultEntry struct {
 	SequenceNumber *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendMessageBatchResultEntry) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendMessageBatchResultEntry) GoString() string {
 	return s.String()
 }
@@ -4413,11 +5585,12 @@ type SendMessageInput struct {
 	DelaySeconds *int64 `type:"integer"`
 
 	// Each message attribute consists of a Name, Type, and Value. For more information,
-	// see Amazon SQS Message Attributes (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-message-attributes.html)
-	// in the Amazon Simple Queue Service Developer Guide.
+	// see Amazon SQS message attributes (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-message-metadata.html#sqs-message-attributes)
+	// in the Amazon SQS Developer Guide.
 	MessageAttributes map[string]*MessageAttributeValue `locationName:"MessageAttribute" locationNameKey:"Name" locationNameValue:"Value" type:"map" flattened:"true"`
 
-	// The message to send. The maximum string size is 256 KB.
+	// The message to send. The minimum size is one character. The maximum size
+	// is 256 KiB.
 	//
 	// A message can include only XML, JSON, and unformatted text. The following
 	// Unicode characters are allowed:
@@ -4435,9 +5608,9 @@ type SendMessageInput struct {
 	// The token used for deduplication of sent messages. If a message with a particular
 	// MessageDeduplicationId is sent successfully, any messages sent with the same
 	// MessageDeduplicationId are accepted successfully but aren't delivered during
-	// the 5-minute deduplication interval. For more information, see Exactly-Once
-	// Processing (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues.html#FIFO-queues-exactly-once-processing)
-	// in the Amazon Simple Queue Service Developer Guide.
+	// the 5-minute deduplication interval. For more information, see Exactly-once
+	// processing (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues-exactly-once-processing.html)
+	// in the Amazon SQS Developer Guide.
 	//
 	//    * Every message must have a unique MessageDeduplicationId, You may provide
 	//    a MessageDeduplicationId explicitly. If you aren't able to provide a MessageDeduplicationId
@@ -4467,12 +5640,12 @@ type SendMessageInput struct {
 	// Amazon SQS continues to keep track of the message deduplication ID even after
 	// the message is received and deleted.
 	//
-	// The length of MessageDeduplicationId is 128 characters. MessageDeduplicationId
+	// The maximum length of MessageDeduplicationId is 128 characters. MessageDeduplicationId
 	// can contain alphanumeric characters (a-z, A-Z, 0-9) and punctuation (!"#$%&'()*+,-./:;<=>?@[\]^_`{|}~).
 	//
 	// For best practices of using MessageDeduplicationId, see Using the MessageDeduplicationId
 	// Property (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/using-messagededuplicationid-property.html)
-	// in the Amazon Simple Queue Service Developer Guide.
+	// in the Amazon SQS Developer Guide.
 	MessageDeduplicationId *string `type:"string"`
 
 	// This parameter applies only to FIFO (first-in-first-out) queues.
@@ -4497,7 +5670,7 @@ type SendMessageInput struct {
 	//
 	// For best practices of using MessageGroupId, see Using the MessageGroupId
 	// Property (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/using-messagegroupid-property.html)
-	// in the Amazon Simple Queue Service Developer Guide.
+	// in the Amazon SQS Developer Guide.
 	//
 	// MessageGroupId is required for FIFO queues. You can't use it for Standard
 	// queues.
@@ -4507,8 +5680,8 @@ type SendMessageInput struct {
 	// of a Name, Type, and Value.
 	//
 	//    * Currently, the only supported message system attribute is AWSTraceHeader.
-	//    Its type must be String and its value must be a correctly formatted AWS
-	//    X-Ray trace string.
+	//    Its type must be String and its value must be a correctly formatted X-Ray
+	//    trace header string.
 	//
 	//    * The size of a message system attribute doesn't count towards the total
 	//    size of a message.
@@ -4522,12 +5695,20 @@ type SendMessageInput struct {
 	QueueUrl *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendMessageInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendMessageInput) GoString() string {
 	return s.String()
 }
@@ -4620,9 +5801,9 @@ type SendMessageOutput struct {
 	// about MD5, see RFC1321 (https://www.ietf.org/rfc/rfc1321.txt).
 	MD5OfMessageAttributes *string `type:"string"`
 
-	// An MD5 digest of the non-URL-encoded message attribute string. You can use
-	// this attribute to verify that Amazon SQS received the message correctly.
-	// Amazon SQS URL-decodes the message before creating the MD5 digest. For information
+	// An MD5 digest of the non-URL-encoded message body string. You can use this
+	// attribute to verify that Amazon SQS received the message correctly. Amazon
+	// SQS URL-decodes the message before creating the MD5 digest. For information
 	// about MD5, see RFC1321 (https://www.ietf.org/rfc/rfc1321.txt).
 	MD5OfMessageBody *string `type:"string"`
 
@@ -4633,7 +5814,7 @@ type SendMessageOutput struct {
 
 	// An attribute containing the MessageId of the message sent to the queue. For
 	// more information, see Queue and Message Identifiers (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-queue-message-identifiers.html)
-	// in the Amazon Simple Queue Service Developer Guide.
+	// in the Amazon SQS Developer Guide.
 	MessageId *string `type:"string"`
 
 	// This parameter applies only to FIFO (first-in-first-out) queues.
@@ -4645,12 +5826,20 @@ type SendMessageOutput struct {
 	SequenceNumber *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendMessageOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SendMessageOutput) GoString() string {
 	return s.String()
 }
@@ -4693,71 +5882,102 @@ type SetQueueAttributesInput struct {
 	// The following lists the names, descriptions, and values of the special request
 	// parameters that the SetQueueAttributes action uses:
 	//
-	//    * DelaySeconds - The length of time, in seconds, for which the delivery
+	//    * DelaySeconds – The length of time, in seconds, for which the delivery
 	//    of all messages in the queue is delayed. Valid values: An integer from
 	//    0 to 900 (15 minutes). Default: 0.
 	//
-	//    * MaximumMessageSize - The limit of how many bytes a message can contain
+	//    * MaximumMessageSize – The limit of how many bytes a message can contain
 	//    before Amazon SQS rejects it. Valid values: An integer from 1,024 bytes
 	//    (1 KiB) up to 262,144 bytes (256 KiB). Default: 262,144 (256 KiB).
 	//
-	//    * MessageRetentionPeriod - The length of time, in seconds, for which Amazon
-	//    SQS retains a message. Valid values: An integer representing seconds,
+	//    * MessageRetentionPeriod – The length of time, in seconds, for which
+	//    Amazon SQS retains a message. Valid values: An integer representing seconds,
 	//    from 60 (1 minute) to 1,209,600 (14 days). Default: 345,600 (4 days).
-	//
-	//    * Policy - The queue's policy. A valid AWS policy. For more information
-	//    about policy structure, see Overview of AWS IAM Policies (https://docs.aws.amazon.com/IAM/latest/UserGuide/PoliciesOverview.html)
-	//    in the Amazon IAM User Guide.
-	//
-	//    * ReceiveMessageWaitTimeSeconds - The length of time, in seconds, for
+	//    When you change a queue's attributes, the change can take up to 60 seconds
+	//    for most of the attributes to propagate throughout the Amazon SQS system.
+	//    Changes made to the MessageRetentionPeriod attribute can take up to 15
+	//    minutes and will impact existing messages in the queue potentially causing
+	//    them to be expired and deleted if the MessageRetentionPeriod is reduced
+	//    below the age of existing messages.
+	//
+	//    * Policy – The queue's policy. A valid Amazon Web Services policy. For
+	//    more information about policy structure, see Overview of Amazon Web Services
+	//    IAM Policies (https://docs.aws.amazon.com/IAM/latest/UserGuide/PoliciesOverview.html)
+	//    in the Identity and Access Management User Guide.
+	//
+	//    * ReceiveMessageWaitTimeSeconds – The length of time, in seconds, for
 	//    which a ReceiveMessage action waits for a message to arrive. Valid values:
-	//    an integer from 0 to 20 (seconds). Default: 0.
-	//
-	//    * RedrivePolicy - The string that includes the parameters for the dead-letter
-	//    queue functionality of the source queue. For more information about the
-	//    redrive policy and dead-letter queues, see Using Amazon SQS Dead-Letter
-	//    Queues (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-dead-letter-queues.html)
-	//    in the Amazon Simple Queue Service Developer Guide. deadLetterTargetArn
-	//    - The Amazon Resource Name (ARN) of the dead-letter queue to which Amazon
-	//    SQS moves messages after the value of maxReceiveCount is exceeded. maxReceiveCount
-	//    - The number of times a message is delivered to the source queue before
-	//    being moved to the dead-letter queue. When the ReceiveCount for a message
-	//    exceeds the maxReceiveCount for a queue, Amazon SQS moves the message
-	//    to the dead-letter-queue. The dead-letter queue of a FIFO queue must also
-	//    be a FIFO queue. Similarly, the dead-letter queue of a standard queue
-	//    must also be a standard queue.
+	//    An integer from 0 to 20 (seconds). Default: 0.
 	//
-	//    * VisibilityTimeout - The visibility timeout for the queue, in seconds.
-	//    Valid values: an integer from 0 to 43,200 (12 hours). Default: 30. For
+	//    * VisibilityTimeout – The visibility timeout for the queue, in seconds.
+	//    Valid values: An integer from 0 to 43,200 (12 hours). Default: 30. For
 	//    more information about the visibility timeout, see Visibility Timeout
 	//    (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-visibility-timeout.html)
-	//    in the Amazon Simple Queue Service Developer Guide.
+	//    in the Amazon SQS Developer Guide.
+	//
+	// The following attributes apply only to dead-letter queues: (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-dead-letter-queues.html)
+	//
+	//    * RedrivePolicy – The string that includes the parameters for the dead-letter
+	//    queue functionality of the source queue as a JSON object. The parameters
+	//    are as follows: deadLetterTargetArn – The Amazon Resource Name (ARN)
+	//    of the dead-letter queue to which Amazon SQS moves messages after the
+	//    value of maxReceiveCount is exceeded. maxReceiveCount – The number of
+	//    times a message is delivered to the source queue before being moved to
+	//    the dead-letter queue. Default: 10. When the ReceiveCount for a message
+	//    exceeds the maxReceiveCount for a queue, Amazon SQS moves the message
+	//    to the dead-letter-queue.
+	//
+	//    * RedriveAllowPolicy – The string that includes the parameters for the
+	//    permissions for the dead-letter queue redrive permission and which source
+	//    queues can specify dead-letter queues as a JSON object. The parameters
+	//    are as follows: redrivePermission – The permission type that defines
+	//    which source queues can specify the current queue as the dead-letter queue.
+	//    Valid values are: allowAll – (Default) Any source queues in this Amazon
+	//    Web Services account in the same Region can specify this queue as the
+	//    dead-letter queue. denyAll – No source queues can specify this queue
+	//    as the dead-letter queue. byQueue – Only queues specified by the sourceQueueArns
+	//    parameter can specify this queue as the dead-letter queue. sourceQueueArns
+	//    – The Amazon Resource Names (ARN)s of the source queues that can specify
+	//    this queue as the dead-letter queue and redrive messages. You can specify
+	//    this parameter only when the redrivePermission parameter is set to byQueue.
+	//    You can specify up to 10 source queue ARNs. To allow more than 10 source
+	//    queues to specify dead-letter queues, set the redrivePermission parameter
+	//    to allowAll.
+	//
+	// The dead-letter queue of a FIFO queue must also be a FIFO queue. Similarly,
+	// the dead-letter queue of a standard queue must also be a standard queue.
 	//
 	// The following attributes apply only to server-side-encryption (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-server-side-encryption.html):
 	//
-	//    * KmsMasterKeyId - The ID of an AWS-managed customer master key (CMK)
-	//    for Amazon SQS or a custom CMK. For more information, see Key Terms (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-server-side-encryption.html#sqs-sse-key-terms).
+	//    * KmsMasterKeyId – The ID of an Amazon Web Services managed customer
+	//    master key (CMK) for Amazon SQS or a custom CMK. For more information,
+	//    see Key Terms (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-server-side-encryption.html#sqs-sse-key-terms).
 	//    While the alias of the AWS-managed CMK for Amazon SQS is always alias/aws/sqs,
 	//    the alias of a custom CMK can, for example, be alias/MyAlias . For more
 	//    examples, see KeyId (https://docs.aws.amazon.com/kms/latest/APIReference/API_DescribeKey.html#API_DescribeKey_RequestParameters)
-	//    in the AWS Key Management Service API Reference.
-	//
-	//    * KmsDataKeyReusePeriodSeconds - The length of time, in seconds, for which
-	//    Amazon SQS can reuse a data key (https://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#data-keys)
-	//    to encrypt or decrypt messages before calling AWS KMS again. An integer
-	//    representing seconds, between 60 seconds (1 minute) and 86,400 seconds
-	//    (24 hours). Default: 300 (5 minutes). A shorter time period provides better
-	//    security but results in more calls to KMS which might incur charges after
-	//    Free Tier. For more information, see How Does the Data Key Reuse Period
-	//    Work? (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-server-side-encryption.html#sqs-how-does-the-data-key-reuse-period-work).
+	//    in the Key Management Service API Reference.
+	//
+	//    * KmsDataKeyReusePeriodSeconds – The length of time, in seconds, for
+	//    which Amazon SQS can reuse a data key (https://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#data-keys)
+	//    to encrypt or decrypt messages before calling KMS again. An integer representing
+	//    seconds, between 60 seconds (1 minute) and 86,400 seconds (24 hours).
+	//    Default: 300 (5 minutes). A shorter time period provides better security
+	//    but results in more calls to KMS which might incur charges after Free
+	//    Tier. For more information, see How Does the Data Key Reuse Period Work?
+	//    (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-server-side-encryption.html#sqs-how-does-the-data-key-reuse-period-work).
+	//
+	//    * SqsManagedSseEnabled – Enables server-side queue encryption using
+	//    SQS owned encryption keys. Only one server-side encryption option is supported
+	//    per queue (for example, SSE-KMS (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-configure-sse-existing-queue.html)
+	//    or SSE-SQS (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-configure-sqs-sse-queue.html)).
 	//
 	// The following attribute applies only to FIFO (first-in-first-out) queues
 	// (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues.html):
 	//
-	//    * ContentBasedDeduplication - Enables content-based deduplication. For
-	//    more information, see Exactly-Once Processing (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues.html#FIFO-queues-exactly-once-processing)
-	//    in the Amazon Simple Queue Service Developer Guide. Every message must
-	//    have a unique MessageDeduplicationId, You may provide a MessageDeduplicationId
+	//    * ContentBasedDeduplication – Enables content-based deduplication. For
+	//    more information, see Exactly-once processing (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues-exactly-once-processing.html)
+	//    in the Amazon SQS Developer Guide. Note the following: Every message must
+	//    have a unique MessageDeduplicationId. You may provide a MessageDeduplicationId
 	//    explicitly. If you aren't able to provide a MessageDeduplicationId and
 	//    you enable ContentBasedDeduplication for your queue, Amazon SQS uses a
 	//    SHA-256 hash to generate the MessageDeduplicationId using the body of
@@ -4772,6 +5992,30 @@ type SetQueueAttributesInput struct {
 	//    as the one generated for the first MessageDeduplicationId, the two messages
 	//    are treated as duplicates and only one copy of the message is delivered.
 	//
+	// The following attributes apply only to high throughput for FIFO queues (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/high-throughput-fifo.html):
+	//
+	//    * DeduplicationScope – Specifies whether message deduplication occurs
+	//    at the message group or queue level. Valid values are messageGroup and
+	//    queue.
+	//
+	//    * FifoThroughputLimit – Specifies whether the FIFO queue throughput
+	//    quota applies to the entire queue or per message group. Valid values are
+	//    perQueue and perMessageGroupId. The perMessageGroupId value is allowed
+	//    only when the value for DeduplicationScope is messageGroup.
+	//
+	// To enable high throughput for FIFO queues, do the following:
+	//
+	//    * Set DeduplicationScope to messageGroup.
+	//
+	//    * Set FifoThroughputLimit to perMessageGroupId.
+	//
+	// If you set these attributes to anything other than the values shown for enabling
+	// high throughput, normal throughput is in effect and deduplication occurs
+	// as specified.
+	//
+	// For information on throughput quotas, see Quotas related to messages (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/quotas-messages.html)
+	// in the Amazon SQS Developer Guide.
+	//
 	// Attributes is a required field
 	Attributes map[string]*string `locationName:"Attribute" locationNameKey:"Name" locationNameValue:"Value" type:"map" flattened:"true" required:"true"`
 
@@ -4783,12 +6027,20 @@ type SetQueueAttributesInput struct {
 	QueueUrl *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetQueueAttributesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetQueueAttributesInput) GoString() string {
 	return s.String()
 }
@@ -4825,16 +6077,131 @@ type SetQueueAttributesOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetQueueAttributesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SetQueueAttributesOutput) GoString() string {
 	return s.String()
 }
 
+type StartMessageMoveTaskInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the queue that receives the moved messages. You can use this field
+	// to specify the destination queue where you would like to redrive messages.
+	// If this field is left blank, the messages will be redriven back to their
+	// respective original source queues.
+	DestinationArn *string `type:"string"`
+
+	// The number of messages to be moved per second (the message movement rate).
+	// You can use this field to define a fixed message movement rate. The maximum
+	// value for messages per second is 500. If this field is left blank, the system
+	// will optimize the rate based on the queue message backlog size, which may
+	// vary throughout the duration of the message movement task.
+	MaxNumberOfMessagesPerSecond *int64 `type:"integer"`
+
+	// The ARN of the queue that contains the messages to be moved to another queue.
+	// Currently, only ARNs of dead-letter queues (DLQs) whose sources are other
+	// Amazon SQS queues are accepted. DLQs whose sources are non-SQS queues, such
+	// as Lambda or Amazon SNS topics, are not currently supported.
+	//
+	// SourceArn is a required field
+	SourceArn *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StartMessageMoveTaskInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StartMessageMoveTaskInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *StartMessageMoveTaskInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartMessageMoveTaskInput"}
+	if s.SourceArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("SourceArn"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDestinationArn sets the DestinationArn field's value.
+func (s *StartMessageMoveTaskInput) SetDestinationArn(v string) *StartMessageMoveTaskInput {
+	s.DestinationArn = &v
+	return s
+}
+
+// SetMaxNumberOfMessagesPerSecond sets the MaxNumberOfMessagesPerSecond field's value.
+func (s *StartMessageMoveTaskInput) SetMaxNumberOfMessagesPerSecond(v int64) *StartMessageMoveTaskInput {
+	s.MaxNumberOfMessagesPerSecond = &v
+	return s
+}
+
+// SetSourceArn sets the SourceArn field's value.
+func (s *StartMessageMoveTaskInput) SetSourceArn(v string) *StartMessageMoveTaskInput {
+	s.SourceArn = &v
+	return s
+}
+
+type StartMessageMoveTaskOutput struct {
+	_ struct{} `type:"structure"`
+
+	// An identifier associated with a message movement task. You can use this identifier
+	// to cancel a specified message movement task using the CancelMessageMoveTask
+	// action.
+	TaskHandle *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StartMessageMoveTaskOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s StartMessageMoveTaskOutput) GoString() string {
+	return s.String()
+}
+
+// SetTaskHandle sets the TaskHandle field's value.
+func (s *StartMessageMoveTaskOutput) SetTaskHandle(v string) *StartMessageMoveTaskOutput {
+	s.TaskHandle = &v
+	return s
+}
+
 type TagQueueInput struct {
 	_ struct{} `type:"structure"`
 
@@ -4849,12 +6216,20 @@ type TagQueueInput struct {
 	Tags map[string]*string `locationName:"Tag" locationNameKey:"Key" locationNameValue:"Value" type:"map" flattened:"true" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagQueueInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagQueueInput) GoString() string {
 	return s.String()
 }
@@ -4891,12 +6266,20 @@ type TagQueueOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagQueueOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TagQueueOutput) GoString() string {
 	return s.String()
 }
@@ -4915,12 +6298,20 @@ type UntagQueueInput struct {
 	TagKeys []*string `locationNameList:"TagKey" type:"list" flattened:"true" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagQueueInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagQueueInput) GoString() string {
 	return s.String()
 }
@@ -4957,12 +6348,20 @@ type UntagQueueOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagQueueOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UntagQueueOutput) GoString() string {
 	return s.String()
 }
@@ -4991,13 +6390,38 @@ const (
 
 	// MessageSystemAttributeNameAwstraceHeader is a MessageSystemAttributeName enum value
 	MessageSystemAttributeNameAwstraceHeader = "AWSTraceHeader"
+
+	// MessageSystemAttributeNameDeadLetterQueueSourceArn is a MessageSystemAttributeName enum value
+	MessageSystemAttributeNameDeadLetterQueueSourceArn = "DeadLetterQueueSourceArn"
 )
 
+// MessageSystemAttributeName_Values returns all elements of the MessageSystemAttributeName enum
+func MessageSystemAttributeName_Values() []string {
+	return []string{
+		MessageSystemAttributeNameSenderId,
+		MessageSystemAttributeNameSentTimestamp,
+		MessageSystemAttributeNameApproximateReceiveCount,
+		MessageSystemAttributeNameApproximateFirstReceiveTimestamp,
+		MessageSystemAttributeNameSequenceNumber,
+		MessageSystemAttributeNameMessageDeduplicationId,
+		MessageSystemAttributeNameMessageGroupId,
+		MessageSystemAttributeNameAwstraceHeader,
+		MessageSystemAttributeNameDeadLetterQueueSourceArn,
+	}
+}
+
 const (
 	// MessageSystemAttributeNameForSendsAwstraceHeader is a MessageSystemAttributeNameForSends enum value
 	MessageSystemAttributeNameForSendsAwstraceHeader = "AWSTraceHeader"
 )
 
+// MessageSystemAttributeNameForSends_Values returns all elements of the MessageSystemAttributeNameForSends enum
+func MessageSystemAttributeNameForSends_Values() []string {
+	return []string{
+		MessageSystemAttributeNameForSendsAwstraceHeader,
+	}
+}
+
 const (
 	// QueueAttributeNameAll is a QueueAttributeName enum value
 	QueueAttributeNameAll = "All"
@@ -5052,4 +6476,44 @@ const (
 
 	// QueueAttributeNameKmsDataKeyReusePeriodSeconds is a QueueAttributeName enum value
 	QueueAttributeNameKmsDataKeyReusePeriodSeconds = "KmsDataKeyReusePeriodSeconds"
+
+	// QueueAttributeNameDeduplicationScope is a QueueAttributeName enum value
+	QueueAttributeNameDeduplicationScope = "DeduplicationScope"
+
+	// QueueAttributeNameFifoThroughputLimit is a QueueAttributeName enum value
+	QueueAttributeNameFifoThroughputLimit = "FifoThroughputLimit"
+
+	// QueueAttributeNameRedriveAllowPolicy is a QueueAttributeName enum value
+	QueueAttributeNameRedriveAllowPolicy = "RedriveAllowPolicy"
+
+	// QueueAttributeNameSqsManagedSseEnabled is a QueueAttributeName enum value
+	QueueAttributeNameSqsManagedSseEnabled = "SqsManagedSseEnabled"
 )
+
+// QueueAttributeName_Values returns all elements of the QueueAttributeName enum
+func QueueAttributeName_Values() []string {
+	return []string{
+		QueueAttributeNameAll,
+		QueueAttributeNamePolicy,
+		QueueAttributeNameVisibilityTimeout,
+		QueueAttributeNameMaximumMessageSize,
+		QueueAttributeNameMessageRetentionPeriod,
+		QueueAttributeNameApproximateNumberOfMessages,
+		QueueAttributeNameApproximateNumberOfMessagesNotVisible,
+		QueueAttributeNameCreatedTimestamp,
+		QueueAttributeNameLastModifiedTimestamp,
+		QueueAttributeNameQueueArn,
+		QueueAttributeNameApproximateNumberOfMessagesDelayed,
+		QueueAttributeNameDelaySeconds,
+		QueueAttributeNameReceiveMessageWaitTimeSeconds,
+		QueueAttributeNameRedrivePolicy,
+		QueueAttributeNameFifoQueue,
+		QueueAttributeNameContentBasedDeduplication,
+		QueueAttributeNameKmsMasterKeyId,
+		QueueAttributeNameKmsDataKeyReusePeriodSeconds,
+		QueueAttributeNameDeduplicationScope,
+		QueueAttributeNameFifoThroughputLimit,
+		QueueAttributeNameRedriveAllowPolicy,
+		QueueAttributeNameSqsManagedSseEnabled,
+	}
+}
@@ -3,41 +3,44 @@
 // Package sqs provides the client and types for making API
 // requests to Amazon Simple Queue Service.
 //
-// Welcome to the Amazon Simple Queue Service API Reference.
+// Welcome to the Amazon SQS API Reference.
 //
-// Amazon Simple Queue Service (Amazon SQS) is a reliable, highly-scalable hosted
-// queue for storing messages as they travel between applications or microservices.
-// Amazon SQS moves data between distributed application components and helps
-// you decouple these components.
+// Amazon SQS is a reliable, highly-scalable hosted queue for storing messages
+// as they travel between applications or microservices. Amazon SQS moves data
+// between distributed application components and helps you decouple these components.
 //
-// You can use AWS SDKs (http://aws.amazon.com/tools/#sdk) to access Amazon
-// SQS using your favorite programming language. The SDKs perform tasks such
-// as the following automatically:
+// For information on the permissions you need to use this API, see Identity
+// and access management (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-authentication-and-access-control.html)
+// in the Amazon SQS Developer Guide.
 //
-//    * Cryptographically sign your service requests
+// You can use Amazon Web Services SDKs (http://aws.amazon.com/tools/#sdk) to
+// access Amazon SQS using your favorite programming language. The SDKs perform
+// tasks such as the following automatically:
 //
-//    * Retry requests
+//   - Cryptographically sign your service requests
 //
-//    * Handle error responses
+//   - Retry requests
 //
-// Additional Information
+//   - Handle error responses
 //
-//    * Amazon SQS Product Page (http://aws.amazon.com/sqs/)
+// Additional information
 //
-//    * Amazon Simple Queue Service Developer Guide Making API Requests (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-making-api-requests.html)
-//    Amazon SQS Message Attributes (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-message-attributes.html)
-//    Amazon SQS Dead-Letter Queues (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-dead-letter-queues.html)
+//   - Amazon SQS Product Page (http://aws.amazon.com/sqs/)
 //
-//    * Amazon SQS in the AWS CLI Command Reference (http://docs.aws.amazon.com/cli/latest/reference/sqs/index.html)
+//   - Amazon SQS Developer Guide Making API Requests (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-making-api-requests.html)
+//     Amazon SQS Message Attributes (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-message-metadata.html#sqs-message-attributes)
+//     Amazon SQS Dead-Letter Queues (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-dead-letter-queues.html)
 //
-//    * Amazon Web Services General Reference Regions and Endpoints (https://docs.aws.amazon.com/general/latest/gr/rande.html#sqs_region)
+//   - Amazon SQS in the Command Line Interface (http://docs.aws.amazon.com/cli/latest/reference/sqs/index.html)
+//
+//   - Amazon Web Services General Reference Regions and Endpoints (https://docs.aws.amazon.com/general/latest/gr/rande.html#sqs_region)
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/sqs-2012-11-05 for more information on this service.
 //
 // See sqs package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/sqs/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon Simple Queue Service with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
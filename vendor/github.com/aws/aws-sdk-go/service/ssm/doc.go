@@ -3,31 +3,39 @@
 // Package ssm provides the client and types for making API
 // requests to Amazon Simple Systems Manager (SSM).
 //
-// AWS Systems Manager is a collection of capabilities that helps you automate
-// management tasks such as collecting system inventory, applying operating
-// system (OS) patches, automating the creation of Amazon Machine Images (AMIs),
-// and configuring operating systems (OSs) and applications at scale. Systems
-// Manager lets you remotely and securely manage the configuration of your managed
-// instances. A managed instance is any Amazon EC2 instance or on-premises machine
-// in your hybrid environment that has been configured for Systems Manager.
+// Amazon Web Services Systems Manager is the operations hub for your Amazon
+// Web Services applications and resources and a secure end-to-end management
+// solution for hybrid cloud environments that enables safe and secure operations
+// at scale.
 //
-// This reference is intended to be used with the AWS Systems Manager User Guide
-// (http://docs.aws.amazon.com/systems-manager/latest/userguide/).
+// This reference is intended to be used with the Amazon Web Services Systems
+// Manager User Guide (https://docs.aws.amazon.com/systems-manager/latest/userguide/).
+// To get started, see Setting up Amazon Web Services Systems Manager (https://docs.aws.amazon.com/systems-manager/latest/userguide/systems-manager-setting-up.html).
 //
-// To get started, verify prerequisites and configure managed instances. For
-// more information, see Setting Up AWS Systems Manager (http://docs.aws.amazon.com/systems-manager/latest/userguide/systems-manager-setting-up.html)
-// in the AWS Systems Manager User Guide.
+// Related resources
 //
-// For information about other API actions you can perform on Amazon EC2 instances,
-// see the Amazon EC2 API Reference (http://docs.aws.amazon.com/AWSEC2/latest/APIReference/).
-// For information about how to use a Query API, see Making API Requests (http://docs.aws.amazon.com/AWSEC2/latest/APIReference/making-api-requests.html).
+//   - For information about each of the capabilities that comprise Systems
+//     Manager, see Systems Manager capabilities (https://docs.aws.amazon.com/systems-manager/latest/userguide/what-is-systems-manager.html#systems-manager-capabilities)
+//     in the Amazon Web Services Systems Manager User Guide.
+//
+//   - For details about predefined runbooks for Automation, a capability of
+//     Amazon Web Services Systems Manager, see the Systems Manager Automation
+//     runbook reference (https://docs.aws.amazon.com/systems-manager-automation-runbooks/latest/userguide/automation-runbook-reference.html) .
+//
+//   - For information about AppConfig, a capability of Systems Manager, see
+//     the AppConfig User Guide (https://docs.aws.amazon.com/appconfig/latest/userguide/)
+//     and the AppConfig API Reference (https://docs.aws.amazon.com/appconfig/2019-10-09/APIReference/) .
+//
+//   - For information about Incident Manager, a capability of Systems Manager,
+//     see the Systems Manager Incident Manager User Guide (https://docs.aws.amazon.com/incident-manager/latest/userguide/)
+//     and the Systems Manager Incident Manager API Reference (https://docs.aws.amazon.com/incident-manager/latest/APIReference/) .
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/ssm-2014-11-06 for more information on this service.
 //
 // See ssm package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/ssm/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon Simple Systems Manager (SSM) with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -3,36 +3,37 @@
 // Package storagegateway provides the client and types for making API
 // requests to AWS Storage Gateway.
 //
-// AWS Storage Gateway is the service that connects an on-premises software
-// appliance with cloud-based storage to provide seamless and secure integration
-// between an organization's on-premises IT environment and the AWS storage
-// infrastructure. The service enables you to securely upload data to the AWS
-// cloud for cost effective backup and rapid disaster recovery.
-//
-// Use the following links to get started using the AWS Storage Gateway Service
+// Storage Gateway is the service that connects an on-premises software appliance
+// with cloud-based storage to provide seamless and secure integration between
+// an organization's on-premises IT environment and the Amazon Web Services
+// storage infrastructure. The service enables you to securely upload data to
+// the Amazon Web Services Cloud for cost effective backup and rapid disaster
+// recovery.
+//
+// Use the following links to get started using the Storage Gateway Service
 // API Reference:
 //
-//    * AWS Storage Gateway Required Request Headers (https://docs.aws.amazon.com/storagegateway/latest/userguide/AWSStorageGatewayAPI.html#AWSStorageGatewayHTTPRequestsHeaders):
-//    Describes the required headers that you must send with every POST request
-//    to AWS Storage Gateway.
+//   - Storage Gateway required request headers (https://docs.aws.amazon.com/storagegateway/latest/userguide/AWSStorageGatewayAPI.html#AWSStorageGatewayHTTPRequestsHeaders):
+//     Describes the required headers that you must send with every POST request
+//     to Storage Gateway.
 //
-//    * Signing Requests (https://docs.aws.amazon.com/storagegateway/latest/userguide/AWSStorageGatewayAPI.html#AWSStorageGatewaySigningRequests):
-//    AWS Storage Gateway requires that you authenticate every request you send;
-//    this topic describes how sign such a request.
+//   - Signing requests (https://docs.aws.amazon.com/storagegateway/latest/userguide/AWSStorageGatewayAPI.html#AWSStorageGatewaySigningRequests):
+//     Storage Gateway requires that you authenticate every request you send;
+//     this topic describes how sign such a request.
 //
-//    * Error Responses (https://docs.aws.amazon.com/storagegateway/latest/userguide/AWSStorageGatewayAPI.html#APIErrorResponses):
-//    Provides reference information about AWS Storage Gateway errors.
+//   - Error responses (https://docs.aws.amazon.com/storagegateway/latest/userguide/AWSStorageGatewayAPI.html#APIErrorResponses):
+//     Provides reference information about Storage Gateway errors.
 //
-//    * Operations in AWS Storage Gateway (https://docs.aws.amazon.com/storagegateway/latest/APIReference/API_Operations.html):
-//    Contains detailed descriptions of all AWS Storage Gateway operations,
-//    their request parameters, response elements, possible errors, and examples
-//    of requests and responses.
+//   - Operations in Storage Gateway (https://docs.aws.amazon.com/storagegateway/latest/APIReference/API_Operations.html):
+//     Contains detailed descriptions of all Storage Gateway operations, their
+//     request parameters, response elements, possible errors, and examples of
+//     requests and responses.
 //
-//    * AWS Storage Gateway Regions and Endpoints: (http://docs.aws.amazon.com/general/latest/gr/rande.html#sg_region)
-//    Provides a list of each AWS Region and the endpoints available for use
-//    with AWS Storage Gateway.
+//   - Storage Gateway endpoints and quotas (https://docs.aws.amazon.com/general/latest/gr/sg.html):
+//     Provides a list of each Amazon Web Services Region and the endpoints available
+//     for use with Storage Gateway.
 //
-// AWS Storage Gateway resource IDs are in uppercase. When you use these resource
+// Storage Gateway resource IDs are in uppercase. When you use these resource
 // IDs with the Amazon EC2 API, EC2 expects resource IDs in lowercase. You must
 // change your resource ID to lowercase to use it with the EC2 API. For example,
 // in Storage Gateway the ID for a volume might be vol-AA22BB012345DAF670. When
@@ -44,7 +45,7 @@
 // volumes and snapshots will be created with a 17-character string. Starting
 // in April 2016, you will be able to use these longer IDs so you can test your
 // systems with the new format. For more information, see Longer EC2 and EBS
-// Resource IDs (https://aws.amazon.com/ec2/faqs/#longer-ids).
+// resource IDs (http://aws.amazon.com/ec2/faqs/#longer-ids).
 //
 // For example, a volume Amazon Resource Name (ARN) with the longer volume ID
 // format looks like the following:
@@ -53,15 +54,15 @@
 //
 // A snapshot ID with the longer ID format looks like the following: snap-78e226633445566ee.
 //
-// For more information, see Announcement: Heads-up – Longer AWS Storage Gateway
-// volume and snapshot IDs coming in 2016 (https://forums.aws.amazon.com/ann.jspa?annID=3557).
+// For more information, see Announcement: Heads-up – Longer Storage Gateway
+// volume and snapshot IDs coming in 2016 (http://forums.aws.amazon.com/ann.jspa?annID=3557).
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/storagegateway-2013-06-30 for more information on this service.
 //
 // See storagegateway package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/storagegateway/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact AWS Storage Gateway with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
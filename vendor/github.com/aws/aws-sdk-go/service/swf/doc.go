@@ -20,7 +20,7 @@
 // See swf package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/swf/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon Simple Workflow Service with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -1,27 +1,29 @@
 // Code generated by private/model/cli/gen-api/main.go. DO NOT EDIT.
 
 // Package transfer provides the client and types for making API
-// requests to AWS Transfer for SFTP.
-//
-// AWS Transfer for SFTP is a fully managed service that enables the transfer
-// of files directly into and out of Amazon S3 using the Secure File Transfer
-// Protocol (SFTP)—also known as Secure Shell (SSH) File Transfer Protocol.
-// AWS helps you seamlessly migrate your file transfer workflows to AWS Transfer
-// for SFTP—by integrating with existing authentication systems, and providing
-// DNS routing with Amazon Route 53—so nothing changes for your customers
-// and partners, or their applications. With your data in S3, you can use it
-// with AWS services for processing, analytics, machine learning, and archiving.
-// Getting started with AWS Transfer for SFTP (AWS SFTP) is easy; there is no
-// infrastructure to buy and set up.
+// requests to AWS Transfer Family.
+//
+// Transfer Family is a fully managed service that enables the transfer of files
+// over the File Transfer Protocol (FTP), File Transfer Protocol over SSL (FTPS),
+// or Secure Shell (SSH) File Transfer Protocol (SFTP) directly into and out
+// of Amazon Simple Storage Service (Amazon S3) or Amazon EFS. Additionally,
+// you can use Applicability Statement 2 (AS2) to transfer files into and out
+// of Amazon S3. Amazon Web Services helps you seamlessly migrate your file
+// transfer workflows to Transfer Family by integrating with existing authentication
+// systems, and providing DNS routing with Amazon Route 53 so nothing changes
+// for your customers and partners, or their applications. With your data in
+// Amazon S3, you can use it with Amazon Web Services for processing, analytics,
+// machine learning, and archiving. Getting started with Transfer Family is
+// easy since there is no infrastructure to buy and set up.
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/transfer-2018-11-05 for more information on this service.
 //
 // See transfer package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/transfer/
 //
-// Using the Client
+// # Using the Client
 //
-// To contact AWS Transfer for SFTP with the SDK use the New function to create
+// To contact AWS Transfer Family with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
 // These clients are safe to use concurrently.
 //
@@ -31,7 +33,7 @@
 // See aws.Config documentation for more information on configuring SDK clients.
 // https://docs.aws.amazon.com/sdk-for-go/api/aws/#Config
 //
-// See the AWS Transfer for SFTP client Transfer for more
+// See the AWS Transfer Family client Transfer for more
 // information on creating client for this service.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/transfer/#New
 package transfer
@@ -3,22 +3,33 @@
 // Package wafregional provides the client and types for making API
 // requests to AWS WAF Regional.
 //
-// This is the AWS WAF Regional API Reference for using AWS WAF with Elastic
-// Load Balancing (ELB) Application Load Balancers. The AWS WAF actions and
-// data types listed in the reference are available for protecting Application
-// Load Balancers. You can use these actions and data types by means of the
-// endpoints listed in AWS Regions and Endpoints (https://docs.aws.amazon.com/general/latest/gr/rande.html#waf_region).
+// This is AWS WAF Classic Regional documentation. For more information, see
+// AWS WAF Classic (https://docs.aws.amazon.com/waf/latest/developerguide/classic-waf-chapter.html)
+// in the developer guide.
+//
+// For the latest version of AWS WAF, use the AWS WAFV2 API and see the AWS
+// WAF Developer Guide (https://docs.aws.amazon.com/waf/latest/developerguide/waf-chapter.html).
+// With the latest version, AWS WAF has a single set of endpoints for regional
+// and global use.
+//
+// This is the AWS WAF Regional Classic API Reference for using AWS WAF Classic
+// with the AWS resources, Elastic Load Balancing (ELB) Application Load Balancers
+// and API Gateway APIs. The AWS WAF Classic actions and data types listed in
+// the reference are available for protecting Elastic Load Balancing (ELB) Application
+// Load Balancers and API Gateway APIs. You can use these actions and data types
+// by means of the endpoints listed in AWS Regions and Endpoints (https://docs.aws.amazon.com/general/latest/gr/rande.html#waf_region).
 // This guide is for developers who need detailed information about the AWS
-// WAF API actions, data types, and errors. For detailed information about AWS
-// WAF features and an overview of how to use the AWS WAF API, see the AWS WAF
-// Developer Guide (https://docs.aws.amazon.com/waf/latest/developerguide/).
+// WAF Classic API actions, data types, and errors. For detailed information
+// about AWS WAF Classic features and an overview of how to use the AWS WAF
+// Classic API, see the AWS WAF Classic (https://docs.aws.amazon.com/waf/latest/developerguide/classic-waf-chapter.html)
+// in the developer guide.
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/waf-regional-2016-11-28 for more information on this service.
 //
 // See wafregional package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/wafregional/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact AWS WAF Regional with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
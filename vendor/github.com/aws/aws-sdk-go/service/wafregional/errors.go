@@ -2,6 +2,10 @@
 
 package wafregional
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeWAFBadRequestException for service response error code
@@ -14,6 +18,32 @@ const (
 	// The name specified is invalid.
 	ErrCodeWAFDisallowedNameException = "WAFDisallowedNameException"
 
+	// ErrCodeWAFEntityMigrationException for service response error code
+	// "WAFEntityMigrationException".
+	//
+	// The operation failed due to a problem with the migration. The failure cause
+	// is provided in the exception, in the MigrationErrorType:
+	//
+	//    * ENTITY_NOT_SUPPORTED - The web ACL has an unsupported entity but the
+	//    IgnoreUnsupportedType is not set to true.
+	//
+	//    * ENTITY_NOT_FOUND - The web ACL doesn't exist.
+	//
+	//    * S3_BUCKET_NO_PERMISSION - You don't have permission to perform the PutObject
+	//    action to the specified Amazon S3 bucket.
+	//
+	//    * S3_BUCKET_NOT_ACCESSIBLE - The bucket policy doesn't allow AWS WAF to
+	//    perform the PutObject action in the bucket.
+	//
+	//    * S3_BUCKET_NOT_FOUND - The S3 bucket doesn't exist.
+	//
+	//    * S3_BUCKET_INVALID_REGION - The S3 bucket is not in the same Region as
+	//    the web ACL.
+	//
+	//    * S3_INTERNAL_ERROR - AWS WAF failed to create the template in the S3
+	//    bucket for another reason.
+	ErrCodeWAFEntityMigrationException = "WAFEntityMigrationException"
+
 	// ErrCodeWAFInternalErrorException for service response error code
 	// "WAFInternalErrorException".
 	//
@@ -217,3 +247,26 @@ const (
 	// Retry your request.
 	ErrCodeWAFUnavailableEntityException = "WAFUnavailableEntityException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"WAFBadRequestException":                newErrorWAFBadRequestException,
+	"WAFDisallowedNameException":            newErrorWAFDisallowedNameException,
+	"WAFEntityMigrationException":           newErrorWAFEntityMigrationException,
+	"WAFInternalErrorException":             newErrorWAFInternalErrorException,
+	"WAFInvalidAccountException":            newErrorWAFInvalidAccountException,
+	"WAFInvalidOperationException":          newErrorWAFInvalidOperationException,
+	"WAFInvalidParameterException":          newErrorWAFInvalidParameterException,
+	"WAFInvalidPermissionPolicyException":   newErrorWAFInvalidPermissionPolicyException,
+	"WAFInvalidRegexPatternException":       newErrorWAFInvalidRegexPatternException,
+	"WAFLimitsExceededException":            newErrorWAFLimitsExceededException,
+	"WAFNonEmptyEntityException":            newErrorWAFNonEmptyEntityException,
+	"WAFNonexistentContainerException":      newErrorWAFNonexistentContainerException,
+	"WAFNonexistentItemException":           newErrorWAFNonexistentItemException,
+	"WAFReferencedItemException":            newErrorWAFReferencedItemException,
+	"WAFServiceLinkedRoleErrorException":    newErrorWAFServiceLinkedRoleErrorException,
+	"WAFStaleDataException":                 newErrorWAFStaleDataException,
+	"WAFSubscriptionNotFoundException":      newErrorWAFSubscriptionNotFoundException,
+	"WAFTagOperationException":              newErrorWAFTagOperationException,
+	"WAFTagOperationInternalErrorException": newErrorWAFTagOperationInternalErrorException,
+	"WAFUnavailableEntityException":         newErrorWAFUnavailableEntityException,
+}
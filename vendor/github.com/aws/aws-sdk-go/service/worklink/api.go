@@ -3,6 +3,7 @@
 package worklink
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -28,17 +29,21 @@ const opAssociateDomain = "AssociateDomain"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AssociateDomainRequest method.
+//	req, resp := client.AssociateDomainRequest(params)
 //
-//    // Example sending a request using the AssociateDomainRequest method.
-//    req, resp := client.AssociateDomainRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/AssociateDomain
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) AssociateDomainRequest(input *AssociateDomainInput) (req *request.Request, output *AssociateDomainOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, AssociateDomain, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opAssociateDomain,
 		HTTPMethod: "POST",
@@ -66,26 +71,29 @@ func (c *WorkLink) AssociateDomainRequest(input *AssociateDomainInput) (req *req
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation AssociateDomain for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
 //
-//   * ErrCodeResourceAlreadyExistsException "ResourceAlreadyExistsException"
-//   The resource already exists.
+//   - ResourceAlreadyExistsException
+//     The resource already exists.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/AssociateDomain
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) AssociateDomain(input *AssociateDomainInput) (*AssociateDomainOutput, error) {
 	req, out := c.AssociateDomainRequest(input)
 	return out, req.Send()
@@ -100,6 +108,8 @@ func (c *WorkLink) AssociateDomain(input *AssociateDomainInput) (*AssociateDomai
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) AssociateDomainWithContext(ctx aws.Context, input *AssociateDomainInput, opts ...request.Option) (*AssociateDomainOutput, error) {
 	req, out := c.AssociateDomainRequest(input)
 	req.SetContext(ctx)
@@ -123,17 +133,21 @@ const opAssociateWebsiteAuthorizationProvider = "AssociateWebsiteAuthorizationPr
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AssociateWebsiteAuthorizationProviderRequest method.
+//	req, resp := client.AssociateWebsiteAuthorizationProviderRequest(params)
 //
-//    // Example sending a request using the AssociateWebsiteAuthorizationProviderRequest method.
-//    req, resp := client.AssociateWebsiteAuthorizationProviderRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/AssociateWebsiteAuthorizationProvider
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) AssociateWebsiteAuthorizationProviderRequest(input *AssociateWebsiteAuthorizationProviderInput) (req *request.Request, output *AssociateWebsiteAuthorizationProviderOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, AssociateWebsiteAuthorizationProvider, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opAssociateWebsiteAuthorizationProvider,
 		HTTPMethod: "POST",
@@ -161,26 +175,29 @@ func (c *WorkLink) AssociateWebsiteAuthorizationProviderRequest(input *Associate
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation AssociateWebsiteAuthorizationProvider for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
 //
-//   * ErrCodeResourceAlreadyExistsException "ResourceAlreadyExistsException"
-//   The resource already exists.
+//   - ResourceAlreadyExistsException
+//     The resource already exists.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/AssociateWebsiteAuthorizationProvider
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) AssociateWebsiteAuthorizationProvider(input *AssociateWebsiteAuthorizationProviderInput) (*AssociateWebsiteAuthorizationProviderOutput, error) {
 	req, out := c.AssociateWebsiteAuthorizationProviderRequest(input)
 	return out, req.Send()
@@ -195,6 +212,8 @@ func (c *WorkLink) AssociateWebsiteAuthorizationProvider(input *AssociateWebsite
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) AssociateWebsiteAuthorizationProviderWithContext(ctx aws.Context, input *AssociateWebsiteAuthorizationProviderInput, opts ...request.Option) (*AssociateWebsiteAuthorizationProviderOutput, error) {
 	req, out := c.AssociateWebsiteAuthorizationProviderRequest(input)
 	req.SetContext(ctx)
@@ -218,17 +237,21 @@ const opAssociateWebsiteCertificateAuthority = "AssociateWebsiteCertificateAutho
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the AssociateWebsiteCertificateAuthorityRequest method.
+//	req, resp := client.AssociateWebsiteCertificateAuthorityRequest(params)
 //
-//    // Example sending a request using the AssociateWebsiteCertificateAuthorityRequest method.
-//    req, resp := client.AssociateWebsiteCertificateAuthorityRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/AssociateWebsiteCertificateAuthority
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) AssociateWebsiteCertificateAuthorityRequest(input *AssociateWebsiteCertificateAuthorityInput) (req *request.Request, output *AssociateWebsiteCertificateAuthorityOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, AssociateWebsiteCertificateAuthority, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opAssociateWebsiteCertificateAuthority,
 		HTTPMethod: "POST",
@@ -256,26 +279,29 @@ func (c *WorkLink) AssociateWebsiteCertificateAuthorityRequest(input *AssociateW
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation AssociateWebsiteCertificateAuthority for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
 //
-//   * ErrCodeResourceAlreadyExistsException "ResourceAlreadyExistsException"
-//   The resource already exists.
+//   - ResourceAlreadyExistsException
+//     The resource already exists.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/AssociateWebsiteCertificateAuthority
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) AssociateWebsiteCertificateAuthority(input *AssociateWebsiteCertificateAuthorityInput) (*AssociateWebsiteCertificateAuthorityOutput, error) {
 	req, out := c.AssociateWebsiteCertificateAuthorityRequest(input)
 	return out, req.Send()
@@ -290,6 +316,8 @@ func (c *WorkLink) AssociateWebsiteCertificateAuthority(input *AssociateWebsiteC
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) AssociateWebsiteCertificateAuthorityWithContext(ctx aws.Context, input *AssociateWebsiteCertificateAuthorityInput, opts ...request.Option) (*AssociateWebsiteCertificateAuthorityOutput, error) {
 	req, out := c.AssociateWebsiteCertificateAuthorityRequest(input)
 	req.SetContext(ctx)
@@ -313,17 +341,21 @@ const opCreateFleet = "CreateFleet"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateFleetRequest method.
+//	req, resp := client.CreateFleetRequest(params)
 //
-//    // Example sending a request using the CreateFleetRequest method.
-//    req, resp := client.CreateFleetRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/CreateFleet
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) CreateFleetRequest(input *CreateFleetInput) (req *request.Request, output *CreateFleetOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, CreateFleet, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opCreateFleet,
 		HTTPMethod: "POST",
@@ -352,26 +384,29 @@ func (c *WorkLink) CreateFleetRequest(input *CreateFleetInput) (req *request.Req
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation CreateFleet for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
 //
-//   * ErrCodeResourceAlreadyExistsException "ResourceAlreadyExistsException"
-//   The resource already exists.
+//   - ResourceAlreadyExistsException
+//     The resource already exists.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/CreateFleet
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) CreateFleet(input *CreateFleetInput) (*CreateFleetOutput, error) {
 	req, out := c.CreateFleetRequest(input)
 	return out, req.Send()
@@ -386,6 +421,8 @@ func (c *WorkLink) CreateFleet(input *CreateFleetInput) (*CreateFleetOutput, err
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) CreateFleetWithContext(ctx aws.Context, input *CreateFleetInput, opts ...request.Option) (*CreateFleetOutput, error) {
 	req, out := c.CreateFleetRequest(input)
 	req.SetContext(ctx)
@@ -409,17 +446,21 @@ const opDeleteFleet = "DeleteFleet"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteFleetRequest method.
+//	req, resp := client.DeleteFleetRequest(params)
 //
-//    // Example sending a request using the DeleteFleetRequest method.
-//    req, resp := client.DeleteFleetRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DeleteFleet
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DeleteFleetRequest(input *DeleteFleetInput) (req *request.Request, output *DeleteFleetOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, DeleteFleet, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opDeleteFleet,
 		HTTPMethod: "POST",
@@ -447,23 +488,26 @@ func (c *WorkLink) DeleteFleetRequest(input *DeleteFleetInput) (req *request.Req
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation DeleteFleet for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DeleteFleet
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DeleteFleet(input *DeleteFleetInput) (*DeleteFleetOutput, error) {
 	req, out := c.DeleteFleetRequest(input)
 	return out, req.Send()
@@ -478,6 +522,8 @@ func (c *WorkLink) DeleteFleet(input *DeleteFleetInput) (*DeleteFleetOutput, err
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DeleteFleetWithContext(ctx aws.Context, input *DeleteFleetInput, opts ...request.Option) (*DeleteFleetOutput, error) {
 	req, out := c.DeleteFleetRequest(input)
 	req.SetContext(ctx)
@@ -501,17 +547,21 @@ const opDescribeAuditStreamConfiguration = "DescribeAuditStreamConfiguration"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeAuditStreamConfigurationRequest method.
+//	req, resp := client.DescribeAuditStreamConfigurationRequest(params)
 //
-//    // Example sending a request using the DescribeAuditStreamConfigurationRequest method.
-//    req, resp := client.DescribeAuditStreamConfigurationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DescribeAuditStreamConfiguration
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeAuditStreamConfigurationRequest(input *DescribeAuditStreamConfigurationInput) (req *request.Request, output *DescribeAuditStreamConfigurationOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, DescribeAuditStreamConfiguration, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opDescribeAuditStreamConfiguration,
 		HTTPMethod: "POST",
@@ -539,23 +589,26 @@ func (c *WorkLink) DescribeAuditStreamConfigurationRequest(input *DescribeAuditS
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation DescribeAuditStreamConfiguration for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DescribeAuditStreamConfiguration
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeAuditStreamConfiguration(input *DescribeAuditStreamConfigurationInput) (*DescribeAuditStreamConfigurationOutput, error) {
 	req, out := c.DescribeAuditStreamConfigurationRequest(input)
 	return out, req.Send()
@@ -570,6 +623,8 @@ func (c *WorkLink) DescribeAuditStreamConfiguration(input *DescribeAuditStreamCo
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeAuditStreamConfigurationWithContext(ctx aws.Context, input *DescribeAuditStreamConfigurationInput, opts ...request.Option) (*DescribeAuditStreamConfigurationOutput, error) {
 	req, out := c.DescribeAuditStreamConfigurationRequest(input)
 	req.SetContext(ctx)
@@ -593,17 +648,21 @@ const opDescribeCompanyNetworkConfiguration = "DescribeCompanyNetworkConfigurati
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeCompanyNetworkConfigurationRequest method.
+//	req, resp := client.DescribeCompanyNetworkConfigurationRequest(params)
 //
-//    // Example sending a request using the DescribeCompanyNetworkConfigurationRequest method.
-//    req, resp := client.DescribeCompanyNetworkConfigurationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DescribeCompanyNetworkConfiguration
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeCompanyNetworkConfigurationRequest(input *DescribeCompanyNetworkConfigurationInput) (req *request.Request, output *DescribeCompanyNetworkConfigurationOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, DescribeCompanyNetworkConfiguration, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opDescribeCompanyNetworkConfiguration,
 		HTTPMethod: "POST",
@@ -631,23 +690,26 @@ func (c *WorkLink) DescribeCompanyNetworkConfigurationRequest(input *DescribeCom
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation DescribeCompanyNetworkConfiguration for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DescribeCompanyNetworkConfiguration
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeCompanyNetworkConfiguration(input *DescribeCompanyNetworkConfigurationInput) (*DescribeCompanyNetworkConfigurationOutput, error) {
 	req, out := c.DescribeCompanyNetworkConfigurationRequest(input)
 	return out, req.Send()
@@ -662,6 +724,8 @@ func (c *WorkLink) DescribeCompanyNetworkConfiguration(input *DescribeCompanyNet
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeCompanyNetworkConfigurationWithContext(ctx aws.Context, input *DescribeCompanyNetworkConfigurationInput, opts ...request.Option) (*DescribeCompanyNetworkConfigurationOutput, error) {
 	req, out := c.DescribeCompanyNetworkConfigurationRequest(input)
 	req.SetContext(ctx)
@@ -685,17 +749,21 @@ const opDescribeDevice = "DescribeDevice"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeDeviceRequest method.
+//	req, resp := client.DescribeDeviceRequest(params)
 //
-//    // Example sending a request using the DescribeDeviceRequest method.
-//    req, resp := client.DescribeDeviceRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DescribeDevice
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeDeviceRequest(input *DescribeDeviceInput) (req *request.Request, output *DescribeDeviceOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, DescribeDevice, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opDescribeDevice,
 		HTTPMethod: "POST",
@@ -722,23 +790,26 @@ func (c *WorkLink) DescribeDeviceRequest(input *DescribeDeviceInput) (req *reque
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation DescribeDevice for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DescribeDevice
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeDevice(input *DescribeDeviceInput) (*DescribeDeviceOutput, error) {
 	req, out := c.DescribeDeviceRequest(input)
 	return out, req.Send()
@@ -753,6 +824,8 @@ func (c *WorkLink) DescribeDevice(input *DescribeDeviceInput) (*DescribeDeviceOu
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeDeviceWithContext(ctx aws.Context, input *DescribeDeviceInput, opts ...request.Option) (*DescribeDeviceOutput, error) {
 	req, out := c.DescribeDeviceRequest(input)
 	req.SetContext(ctx)
@@ -776,17 +849,21 @@ const opDescribeDevicePolicyConfiguration = "DescribeDevicePolicyConfiguration"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeDevicePolicyConfigurationRequest method.
+//	req, resp := client.DescribeDevicePolicyConfigurationRequest(params)
 //
-//    // Example sending a request using the DescribeDevicePolicyConfigurationRequest method.
-//    req, resp := client.DescribeDevicePolicyConfigurationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DescribeDevicePolicyConfiguration
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeDevicePolicyConfigurationRequest(input *DescribeDevicePolicyConfigurationInput) (req *request.Request, output *DescribeDevicePolicyConfigurationOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, DescribeDevicePolicyConfiguration, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opDescribeDevicePolicyConfiguration,
 		HTTPMethod: "POST",
@@ -813,23 +890,26 @@ func (c *WorkLink) DescribeDevicePolicyConfigurationRequest(input *DescribeDevic
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation DescribeDevicePolicyConfiguration for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DescribeDevicePolicyConfiguration
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeDevicePolicyConfiguration(input *DescribeDevicePolicyConfigurationInput) (*DescribeDevicePolicyConfigurationOutput, error) {
 	req, out := c.DescribeDevicePolicyConfigurationRequest(input)
 	return out, req.Send()
@@ -844,6 +924,8 @@ func (c *WorkLink) DescribeDevicePolicyConfiguration(input *DescribeDevicePolicy
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeDevicePolicyConfigurationWithContext(ctx aws.Context, input *DescribeDevicePolicyConfigurationInput, opts ...request.Option) (*DescribeDevicePolicyConfigurationOutput, error) {
 	req, out := c.DescribeDevicePolicyConfigurationRequest(input)
 	req.SetContext(ctx)
@@ -867,17 +949,21 @@ const opDescribeDomain = "DescribeDomain"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeDomainRequest method.
+//	req, resp := client.DescribeDomainRequest(params)
 //
-//    // Example sending a request using the DescribeDomainRequest method.
-//    req, resp := client.DescribeDomainRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DescribeDomain
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeDomainRequest(input *DescribeDomainInput) (req *request.Request, output *DescribeDomainOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, DescribeDomain, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opDescribeDomain,
 		HTTPMethod: "POST",
@@ -904,23 +990,26 @@ func (c *WorkLink) DescribeDomainRequest(input *DescribeDomainInput) (req *reque
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation DescribeDomain for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DescribeDomain
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeDomain(input *DescribeDomainInput) (*DescribeDomainOutput, error) {
 	req, out := c.DescribeDomainRequest(input)
 	return out, req.Send()
@@ -935,6 +1024,8 @@ func (c *WorkLink) DescribeDomain(input *DescribeDomainInput) (*DescribeDomainOu
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeDomainWithContext(ctx aws.Context, input *DescribeDomainInput, opts ...request.Option) (*DescribeDomainOutput, error) {
 	req, out := c.DescribeDomainRequest(input)
 	req.SetContext(ctx)
@@ -958,17 +1049,21 @@ const opDescribeFleetMetadata = "DescribeFleetMetadata"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeFleetMetadataRequest method.
+//	req, resp := client.DescribeFleetMetadataRequest(params)
 //
-//    // Example sending a request using the DescribeFleetMetadataRequest method.
-//    req, resp := client.DescribeFleetMetadataRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DescribeFleetMetadata
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeFleetMetadataRequest(input *DescribeFleetMetadataInput) (req *request.Request, output *DescribeFleetMetadataOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, DescribeFleetMetadata, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opDescribeFleetMetadata,
 		HTTPMethod: "POST",
@@ -996,23 +1091,26 @@ func (c *WorkLink) DescribeFleetMetadataRequest(input *DescribeFleetMetadataInpu
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation DescribeFleetMetadata for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DescribeFleetMetadata
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeFleetMetadata(input *DescribeFleetMetadataInput) (*DescribeFleetMetadataOutput, error) {
 	req, out := c.DescribeFleetMetadataRequest(input)
 	return out, req.Send()
@@ -1027,6 +1125,8 @@ func (c *WorkLink) DescribeFleetMetadata(input *DescribeFleetMetadataInput) (*De
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeFleetMetadataWithContext(ctx aws.Context, input *DescribeFleetMetadataInput, opts ...request.Option) (*DescribeFleetMetadataOutput, error) {
 	req, out := c.DescribeFleetMetadataRequest(input)
 	req.SetContext(ctx)
@@ -1050,17 +1150,21 @@ const opDescribeIdentityProviderConfiguration = "DescribeIdentityProviderConfigu
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeIdentityProviderConfigurationRequest method.
+//	req, resp := client.DescribeIdentityProviderConfigurationRequest(params)
 //
-//    // Example sending a request using the DescribeIdentityProviderConfigurationRequest method.
-//    req, resp := client.DescribeIdentityProviderConfigurationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DescribeIdentityProviderConfiguration
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeIdentityProviderConfigurationRequest(input *DescribeIdentityProviderConfigurationInput) (req *request.Request, output *DescribeIdentityProviderConfigurationOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, DescribeIdentityProviderConfiguration, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opDescribeIdentityProviderConfiguration,
 		HTTPMethod: "POST",
@@ -1087,23 +1191,26 @@ func (c *WorkLink) DescribeIdentityProviderConfigurationRequest(input *DescribeI
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation DescribeIdentityProviderConfiguration for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DescribeIdentityProviderConfiguration
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeIdentityProviderConfiguration(input *DescribeIdentityProviderConfigurationInput) (*DescribeIdentityProviderConfigurationOutput, error) {
 	req, out := c.DescribeIdentityProviderConfigurationRequest(input)
 	return out, req.Send()
@@ -1118,6 +1225,8 @@ func (c *WorkLink) DescribeIdentityProviderConfiguration(input *DescribeIdentity
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeIdentityProviderConfigurationWithContext(ctx aws.Context, input *DescribeIdentityProviderConfigurationInput, opts ...request.Option) (*DescribeIdentityProviderConfigurationOutput, error) {
 	req, out := c.DescribeIdentityProviderConfigurationRequest(input)
 	req.SetContext(ctx)
@@ -1141,17 +1250,21 @@ const opDescribeWebsiteCertificateAuthority = "DescribeWebsiteCertificateAuthori
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DescribeWebsiteCertificateAuthorityRequest method.
+//	req, resp := client.DescribeWebsiteCertificateAuthorityRequest(params)
 //
-//    // Example sending a request using the DescribeWebsiteCertificateAuthorityRequest method.
-//    req, resp := client.DescribeWebsiteCertificateAuthorityRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DescribeWebsiteCertificateAuthority
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeWebsiteCertificateAuthorityRequest(input *DescribeWebsiteCertificateAuthorityInput) (req *request.Request, output *DescribeWebsiteCertificateAuthorityOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, DescribeWebsiteCertificateAuthority, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opDescribeWebsiteCertificateAuthority,
 		HTTPMethod: "POST",
@@ -1178,23 +1291,26 @@ func (c *WorkLink) DescribeWebsiteCertificateAuthorityRequest(input *DescribeWeb
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation DescribeWebsiteCertificateAuthority for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DescribeWebsiteCertificateAuthority
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeWebsiteCertificateAuthority(input *DescribeWebsiteCertificateAuthorityInput) (*DescribeWebsiteCertificateAuthorityOutput, error) {
 	req, out := c.DescribeWebsiteCertificateAuthorityRequest(input)
 	return out, req.Send()
@@ -1209,6 +1325,8 @@ func (c *WorkLink) DescribeWebsiteCertificateAuthority(input *DescribeWebsiteCer
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DescribeWebsiteCertificateAuthorityWithContext(ctx aws.Context, input *DescribeWebsiteCertificateAuthorityInput, opts ...request.Option) (*DescribeWebsiteCertificateAuthorityOutput, error) {
 	req, out := c.DescribeWebsiteCertificateAuthorityRequest(input)
 	req.SetContext(ctx)
@@ -1232,17 +1350,21 @@ const opDisassociateDomain = "DisassociateDomain"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DisassociateDomainRequest method.
+//	req, resp := client.DisassociateDomainRequest(params)
 //
-//    // Example sending a request using the DisassociateDomainRequest method.
-//    req, resp := client.DisassociateDomainRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DisassociateDomain
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DisassociateDomainRequest(input *DisassociateDomainInput) (req *request.Request, output *DisassociateDomainOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, DisassociateDomain, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opDisassociateDomain,
 		HTTPMethod: "POST",
@@ -1271,23 +1393,26 @@ func (c *WorkLink) DisassociateDomainRequest(input *DisassociateDomainInput) (re
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation DisassociateDomain for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DisassociateDomain
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DisassociateDomain(input *DisassociateDomainInput) (*DisassociateDomainOutput, error) {
 	req, out := c.DisassociateDomainRequest(input)
 	return out, req.Send()
@@ -1302,6 +1427,8 @@ func (c *WorkLink) DisassociateDomain(input *DisassociateDomainInput) (*Disassoc
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DisassociateDomainWithContext(ctx aws.Context, input *DisassociateDomainInput, opts ...request.Option) (*DisassociateDomainOutput, error) {
 	req, out := c.DisassociateDomainRequest(input)
 	req.SetContext(ctx)
@@ -1325,17 +1452,21 @@ const opDisassociateWebsiteAuthorizationProvider = "DisassociateWebsiteAuthoriza
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DisassociateWebsiteAuthorizationProviderRequest method.
+//	req, resp := client.DisassociateWebsiteAuthorizationProviderRequest(params)
 //
-//    // Example sending a request using the DisassociateWebsiteAuthorizationProviderRequest method.
-//    req, resp := client.DisassociateWebsiteAuthorizationProviderRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DisassociateWebsiteAuthorizationProvider
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DisassociateWebsiteAuthorizationProviderRequest(input *DisassociateWebsiteAuthorizationProviderInput) (req *request.Request, output *DisassociateWebsiteAuthorizationProviderOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, DisassociateWebsiteAuthorizationProvider, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opDisassociateWebsiteAuthorizationProvider,
 		HTTPMethod: "POST",
@@ -1365,26 +1496,29 @@ func (c *WorkLink) DisassociateWebsiteAuthorizationProviderRequest(input *Disass
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation DisassociateWebsiteAuthorizationProvider for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
 //
-//   * ErrCodeResourceAlreadyExistsException "ResourceAlreadyExistsException"
-//   The resource already exists.
+//   - ResourceAlreadyExistsException
+//     The resource already exists.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DisassociateWebsiteAuthorizationProvider
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DisassociateWebsiteAuthorizationProvider(input *DisassociateWebsiteAuthorizationProviderInput) (*DisassociateWebsiteAuthorizationProviderOutput, error) {
 	req, out := c.DisassociateWebsiteAuthorizationProviderRequest(input)
 	return out, req.Send()
@@ -1399,6 +1533,8 @@ func (c *WorkLink) DisassociateWebsiteAuthorizationProvider(input *DisassociateW
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DisassociateWebsiteAuthorizationProviderWithContext(ctx aws.Context, input *DisassociateWebsiteAuthorizationProviderInput, opts ...request.Option) (*DisassociateWebsiteAuthorizationProviderOutput, error) {
 	req, out := c.DisassociateWebsiteAuthorizationProviderRequest(input)
 	req.SetContext(ctx)
@@ -1422,17 +1558,21 @@ const opDisassociateWebsiteCertificateAuthority = "DisassociateWebsiteCertificat
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DisassociateWebsiteCertificateAuthorityRequest method.
+//	req, resp := client.DisassociateWebsiteCertificateAuthorityRequest(params)
 //
-//    // Example sending a request using the DisassociateWebsiteCertificateAuthorityRequest method.
-//    req, resp := client.DisassociateWebsiteCertificateAuthorityRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DisassociateWebsiteCertificateAuthority
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DisassociateWebsiteCertificateAuthorityRequest(input *DisassociateWebsiteCertificateAuthorityInput) (req *request.Request, output *DisassociateWebsiteCertificateAuthorityOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, DisassociateWebsiteCertificateAuthority, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opDisassociateWebsiteCertificateAuthority,
 		HTTPMethod: "POST",
@@ -1460,23 +1600,26 @@ func (c *WorkLink) DisassociateWebsiteCertificateAuthorityRequest(input *Disasso
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation DisassociateWebsiteCertificateAuthority for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/DisassociateWebsiteCertificateAuthority
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DisassociateWebsiteCertificateAuthority(input *DisassociateWebsiteCertificateAuthorityInput) (*DisassociateWebsiteCertificateAuthorityOutput, error) {
 	req, out := c.DisassociateWebsiteCertificateAuthorityRequest(input)
 	return out, req.Send()
@@ -1491,6 +1634,8 @@ func (c *WorkLink) DisassociateWebsiteCertificateAuthority(input *DisassociateWe
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) DisassociateWebsiteCertificateAuthorityWithContext(ctx aws.Context, input *DisassociateWebsiteCertificateAuthorityInput, opts ...request.Option) (*DisassociateWebsiteCertificateAuthorityOutput, error) {
 	req, out := c.DisassociateWebsiteCertificateAuthorityRequest(input)
 	req.SetContext(ctx)
@@ -1514,17 +1659,21 @@ const opListDevices = "ListDevices"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListDevicesRequest method.
+//	req, resp := client.ListDevicesRequest(params)
 //
-//    // Example sending a request using the ListDevicesRequest method.
-//    req, resp := client.ListDevicesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/ListDevices
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListDevicesRequest(input *ListDevicesInput) (req *request.Request, output *ListDevicesOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, ListDevices, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opListDevices,
 		HTTPMethod: "POST",
@@ -1557,23 +1706,26 @@ func (c *WorkLink) ListDevicesRequest(input *ListDevicesInput) (req *request.Req
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation ListDevices for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/ListDevices
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListDevices(input *ListDevicesInput) (*ListDevicesOutput, error) {
 	req, out := c.ListDevicesRequest(input)
 	return out, req.Send()
@@ -1588,6 +1740,8 @@ func (c *WorkLink) ListDevices(input *ListDevicesInput) (*ListDevicesOutput, err
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListDevicesWithContext(ctx aws.Context, input *ListDevicesInput, opts ...request.Option) (*ListDevicesOutput, error) {
 	req, out := c.ListDevicesRequest(input)
 	req.SetContext(ctx)
@@ -1603,15 +1757,16 @@ func (c *WorkLink) ListDevicesWithContext(ctx aws.Context, input *ListDevicesInp
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListDevices operation.
-//    pageNum := 0
-//    err := client.ListDevicesPages(params,
-//        func(page *worklink.ListDevicesOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
+//	// Example iterating over at most 3 pages of a ListDevices operation.
+//	pageNum := 0
+//	err := client.ListDevicesPages(params,
+//	    func(page *worklink.ListDevicesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 //
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListDevicesPages(input *ListDevicesInput, fn func(*ListDevicesOutput, bool) bool) error {
 	return c.ListDevicesPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1623,6 +1778,8 @@ func (c *WorkLink) ListDevicesPages(input *ListDevicesInput, fn func(*ListDevice
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListDevicesPagesWithContext(ctx aws.Context, input *ListDevicesInput, fn func(*ListDevicesOutput, bool) bool, opts ...request.Option) error {
 	p := request.Pagination{
 		NewRequest: func() (*request.Request, error) {
@@ -1638,10 +1795,12 @@ func (c *WorkLink) ListDevicesPagesWithContext(ctx aws.Context, input *ListDevic
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListDevicesOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListDevicesOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -1661,17 +1820,21 @@ const opListDomains = "ListDomains"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListDomainsRequest method.
+//	req, resp := client.ListDomainsRequest(params)
 //
-//    // Example sending a request using the ListDomainsRequest method.
-//    req, resp := client.ListDomainsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/ListDomains
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListDomainsRequest(input *ListDomainsInput) (req *request.Request, output *ListDomainsOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, ListDomains, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opListDomains,
 		HTTPMethod: "POST",
@@ -1704,20 +1867,26 @@ func (c *WorkLink) ListDomainsRequest(input *ListDomainsInput) (req *request.Req
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation ListDomains for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
+//
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/ListDomains
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListDomains(input *ListDomainsInput) (*ListDomainsOutput, error) {
 	req, out := c.ListDomainsRequest(input)
 	return out, req.Send()
@@ -1732,6 +1901,8 @@ func (c *WorkLink) ListDomains(input *ListDomainsInput) (*ListDomainsOutput, err
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListDomainsWithContext(ctx aws.Context, input *ListDomainsInput, opts ...request.Option) (*ListDomainsOutput, error) {
 	req, out := c.ListDomainsRequest(input)
 	req.SetContext(ctx)
@@ -1747,15 +1918,16 @@ func (c *WorkLink) ListDomainsWithContext(ctx aws.Context, input *ListDomainsInp
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListDomains operation.
-//    pageNum := 0
-//    err := client.ListDomainsPages(params,
-//        func(page *worklink.ListDomainsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
+//	// Example iterating over at most 3 pages of a ListDomains operation.
+//	pageNum := 0
+//	err := client.ListDomainsPages(params,
+//	    func(page *worklink.ListDomainsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 //
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListDomainsPages(input *ListDomainsInput, fn func(*ListDomainsOutput, bool) bool) error {
 	return c.ListDomainsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1767,6 +1939,8 @@ func (c *WorkLink) ListDomainsPages(input *ListDomainsInput, fn func(*ListDomain
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListDomainsPagesWithContext(ctx aws.Context, input *ListDomainsInput, fn func(*ListDomainsOutput, bool) bool, opts ...request.Option) error {
 	p := request.Pagination{
 		NewRequest: func() (*request.Request, error) {
@@ -1782,10 +1956,12 @@ func (c *WorkLink) ListDomainsPagesWithContext(ctx aws.Context, input *ListDomai
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListDomainsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListDomainsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -1805,17 +1981,21 @@ const opListFleets = "ListFleets"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListFleetsRequest method.
+//	req, resp := client.ListFleetsRequest(params)
 //
-//    // Example sending a request using the ListFleetsRequest method.
-//    req, resp := client.ListFleetsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/ListFleets
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListFleetsRequest(input *ListFleetsInput) (req *request.Request, output *ListFleetsOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, ListFleets, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opListFleets,
 		HTTPMethod: "POST",
@@ -1848,20 +2028,23 @@ func (c *WorkLink) ListFleetsRequest(input *ListFleetsInput) (req *request.Reque
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation ListFleets for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - InvalidRequestException
+//     The request is not valid.
+//
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/ListFleets
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListFleets(input *ListFleetsInput) (*ListFleetsOutput, error) {
 	req, out := c.ListFleetsRequest(input)
 	return out, req.Send()
@@ -1876,6 +2059,8 @@ func (c *WorkLink) ListFleets(input *ListFleetsInput) (*ListFleetsOutput, error)
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListFleetsWithContext(ctx aws.Context, input *ListFleetsInput, opts ...request.Option) (*ListFleetsOutput, error) {
 	req, out := c.ListFleetsRequest(input)
 	req.SetContext(ctx)
@@ -1891,15 +2076,16 @@ func (c *WorkLink) ListFleetsWithContext(ctx aws.Context, input *ListFleetsInput
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListFleets operation.
-//    pageNum := 0
-//    err := client.ListFleetsPages(params,
-//        func(page *worklink.ListFleetsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
+//	// Example iterating over at most 3 pages of a ListFleets operation.
+//	pageNum := 0
+//	err := client.ListFleetsPages(params,
+//	    func(page *worklink.ListFleetsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 //
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListFleetsPages(input *ListFleetsInput, fn func(*ListFleetsOutput, bool) bool) error {
 	return c.ListFleetsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -1911,6 +2097,8 @@ func (c *WorkLink) ListFleetsPages(input *ListFleetsInput, fn func(*ListFleetsOu
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListFleetsPagesWithContext(ctx aws.Context, input *ListFleetsInput, fn func(*ListFleetsOutput, bool) bool, opts ...request.Option) error {
 	p := request.Pagination{
 		NewRequest: func() (*request.Request, error) {
@@ -1926,13 +2114,102 @@ func (c *WorkLink) ListFleetsPagesWithContext(ctx aws.Context, input *ListFleets
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListFleetsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListFleetsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
+const opListTagsForResource = "ListTagsForResource"
+
+// ListTagsForResourceRequest generates a "aws/request.Request" representing the
+// client's request for the ListTagsForResource operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListTagsForResource for more information on using the ListTagsForResource
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListTagsForResourceRequest method.
+//	req, resp := client.ListTagsForResourceRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/ListTagsForResource
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
+func (c *WorkLink) ListTagsForResourceRequest(input *ListTagsForResourceInput) (req *request.Request, output *ListTagsForResourceOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, ListTagsForResource, has been deprecated")
+	}
+	op := &request.Operation{
+		Name:       opListTagsForResource,
+		HTTPMethod: "GET",
+		HTTPPath:   "/tags/{ResourceArn}",
+	}
+
+	if input == nil {
+		input = &ListTagsForResourceInput{}
+	}
+
+	output = &ListTagsForResourceOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// ListTagsForResource API operation for Amazon WorkLink.
+//
+// Retrieves a list of tags for the specified resource.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon WorkLink's
+// API operation ListTagsForResource for usage and error information.
+//
+// Returned Error Types:
+//   - InvalidRequestException
+//     The request is not valid.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/ListTagsForResource
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
+func (c *WorkLink) ListTagsForResource(input *ListTagsForResourceInput) (*ListTagsForResourceOutput, error) {
+	req, out := c.ListTagsForResourceRequest(input)
+	return out, req.Send()
+}
+
+// ListTagsForResourceWithContext is the same as ListTagsForResource with the addition of
+// the ability to pass a context and additional request options.
+//
+// See ListTagsForResource for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
+func (c *WorkLink) ListTagsForResourceWithContext(ctx aws.Context, input *ListTagsForResourceInput, opts ...request.Option) (*ListTagsForResourceOutput, error) {
+	req, out := c.ListTagsForResourceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opListWebsiteAuthorizationProviders = "ListWebsiteAuthorizationProviders"
 
 // ListWebsiteAuthorizationProvidersRequest generates a "aws/request.Request" representing the
@@ -1949,17 +2226,21 @@ const opListWebsiteAuthorizationProviders = "ListWebsiteAuthorizationProviders"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListWebsiteAuthorizationProvidersRequest method.
+//	req, resp := client.ListWebsiteAuthorizationProvidersRequest(params)
 //
-//    // Example sending a request using the ListWebsiteAuthorizationProvidersRequest method.
-//    req, resp := client.ListWebsiteAuthorizationProvidersRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/ListWebsiteAuthorizationProviders
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListWebsiteAuthorizationProvidersRequest(input *ListWebsiteAuthorizationProvidersInput) (req *request.Request, output *ListWebsiteAuthorizationProvidersOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, ListWebsiteAuthorizationProviders, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opListWebsiteAuthorizationProviders,
 		HTTPMethod: "POST",
@@ -1993,23 +2274,26 @@ func (c *WorkLink) ListWebsiteAuthorizationProvidersRequest(input *ListWebsiteAu
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation ListWebsiteAuthorizationProviders for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
+//
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/ListWebsiteAuthorizationProviders
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListWebsiteAuthorizationProviders(input *ListWebsiteAuthorizationProvidersInput) (*ListWebsiteAuthorizationProvidersOutput, error) {
 	req, out := c.ListWebsiteAuthorizationProvidersRequest(input)
 	return out, req.Send()
@@ -2024,6 +2308,8 @@ func (c *WorkLink) ListWebsiteAuthorizationProviders(input *ListWebsiteAuthoriza
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListWebsiteAuthorizationProvidersWithContext(ctx aws.Context, input *ListWebsiteAuthorizationProvidersInput, opts ...request.Option) (*ListWebsiteAuthorizationProvidersOutput, error) {
 	req, out := c.ListWebsiteAuthorizationProvidersRequest(input)
 	req.SetContext(ctx)
@@ -2039,15 +2325,16 @@ func (c *WorkLink) ListWebsiteAuthorizationProvidersWithContext(ctx aws.Context,
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListWebsiteAuthorizationProviders operation.
-//    pageNum := 0
-//    err := client.ListWebsiteAuthorizationProvidersPages(params,
-//        func(page *worklink.ListWebsiteAuthorizationProvidersOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
+//	// Example iterating over at most 3 pages of a ListWebsiteAuthorizationProviders operation.
+//	pageNum := 0
+//	err := client.ListWebsiteAuthorizationProvidersPages(params,
+//	    func(page *worklink.ListWebsiteAuthorizationProvidersOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 //
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListWebsiteAuthorizationProvidersPages(input *ListWebsiteAuthorizationProvidersInput, fn func(*ListWebsiteAuthorizationProvidersOutput, bool) bool) error {
 	return c.ListWebsiteAuthorizationProvidersPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -2059,6 +2346,8 @@ func (c *WorkLink) ListWebsiteAuthorizationProvidersPages(input *ListWebsiteAuth
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListWebsiteAuthorizationProvidersPagesWithContext(ctx aws.Context, input *ListWebsiteAuthorizationProvidersInput, fn func(*ListWebsiteAuthorizationProvidersOutput, bool) bool, opts ...request.Option) error {
 	p := request.Pagination{
 		NewRequest: func() (*request.Request, error) {
@@ -2074,10 +2363,12 @@ func (c *WorkLink) ListWebsiteAuthorizationProvidersPagesWithContext(ctx aws.Con
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListWebsiteAuthorizationProvidersOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListWebsiteAuthorizationProvidersOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -2097,17 +2388,21 @@ const opListWebsiteCertificateAuthorities = "ListWebsiteCertificateAuthorities"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListWebsiteCertificateAuthoritiesRequest method.
+//	req, resp := client.ListWebsiteCertificateAuthoritiesRequest(params)
 //
-//    // Example sending a request using the ListWebsiteCertificateAuthoritiesRequest method.
-//    req, resp := client.ListWebsiteCertificateAuthoritiesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/ListWebsiteCertificateAuthorities
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListWebsiteCertificateAuthoritiesRequest(input *ListWebsiteCertificateAuthoritiesInput) (req *request.Request, output *ListWebsiteCertificateAuthoritiesOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, ListWebsiteCertificateAuthorities, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opListWebsiteCertificateAuthorities,
 		HTTPMethod: "POST",
@@ -2141,20 +2436,23 @@ func (c *WorkLink) ListWebsiteCertificateAuthoritiesRequest(input *ListWebsiteCe
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation ListWebsiteCertificateAuthorities for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - InvalidRequestException
+//     The request is not valid.
+//
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/ListWebsiteCertificateAuthorities
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListWebsiteCertificateAuthorities(input *ListWebsiteCertificateAuthoritiesInput) (*ListWebsiteCertificateAuthoritiesOutput, error) {
 	req, out := c.ListWebsiteCertificateAuthoritiesRequest(input)
 	return out, req.Send()
@@ -2169,6 +2467,8 @@ func (c *WorkLink) ListWebsiteCertificateAuthorities(input *ListWebsiteCertifica
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListWebsiteCertificateAuthoritiesWithContext(ctx aws.Context, input *ListWebsiteCertificateAuthoritiesInput, opts ...request.Option) (*ListWebsiteCertificateAuthoritiesOutput, error) {
 	req, out := c.ListWebsiteCertificateAuthoritiesRequest(input)
 	req.SetContext(ctx)
@@ -2184,15 +2484,16 @@ func (c *WorkLink) ListWebsiteCertificateAuthoritiesWithContext(ctx aws.Context,
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a ListWebsiteCertificateAuthorities operation.
-//    pageNum := 0
-//    err := client.ListWebsiteCertificateAuthoritiesPages(params,
-//        func(page *worklink.ListWebsiteCertificateAuthoritiesOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
+//	// Example iterating over at most 3 pages of a ListWebsiteCertificateAuthorities operation.
+//	pageNum := 0
+//	err := client.ListWebsiteCertificateAuthoritiesPages(params,
+//	    func(page *worklink.ListWebsiteCertificateAuthoritiesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 //
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListWebsiteCertificateAuthoritiesPages(input *ListWebsiteCertificateAuthoritiesInput, fn func(*ListWebsiteCertificateAuthoritiesOutput, bool) bool) error {
 	return c.ListWebsiteCertificateAuthoritiesPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -2204,6 +2505,8 @@ func (c *WorkLink) ListWebsiteCertificateAuthoritiesPages(input *ListWebsiteCert
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) ListWebsiteCertificateAuthoritiesPagesWithContext(ctx aws.Context, input *ListWebsiteCertificateAuthoritiesInput, fn func(*ListWebsiteCertificateAuthoritiesOutput, bool) bool, opts ...request.Option) error {
 	p := request.Pagination{
 		NewRequest: func() (*request.Request, error) {
@@ -2219,10 +2522,12 @@ func (c *WorkLink) ListWebsiteCertificateAuthoritiesPagesWithContext(ctx aws.Con
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*ListWebsiteCertificateAuthoritiesOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*ListWebsiteCertificateAuthoritiesOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -2242,17 +2547,21 @@ const opRestoreDomainAccess = "RestoreDomainAccess"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the RestoreDomainAccessRequest method.
+//	req, resp := client.RestoreDomainAccessRequest(params)
 //
-//    // Example sending a request using the RestoreDomainAccessRequest method.
-//    req, resp := client.RestoreDomainAccessRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/RestoreDomainAccess
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) RestoreDomainAccessRequest(input *RestoreDomainAccessInput) (req *request.Request, output *RestoreDomainAccessOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, RestoreDomainAccess, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opRestoreDomainAccess,
 		HTTPMethod: "POST",
@@ -2280,23 +2589,26 @@ func (c *WorkLink) RestoreDomainAccessRequest(input *RestoreDomainAccessInput) (
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation RestoreDomainAccess for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
+//
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/RestoreDomainAccess
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) RestoreDomainAccess(input *RestoreDomainAccessInput) (*RestoreDomainAccessOutput, error) {
 	req, out := c.RestoreDomainAccessRequest(input)
 	return out, req.Send()
@@ -2311,6 +2623,8 @@ func (c *WorkLink) RestoreDomainAccess(input *RestoreDomainAccessInput) (*Restor
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) RestoreDomainAccessWithContext(ctx aws.Context, input *RestoreDomainAccessInput, opts ...request.Option) (*RestoreDomainAccessOutput, error) {
 	req, out := c.RestoreDomainAccessRequest(input)
 	req.SetContext(ctx)
@@ -2334,17 +2648,21 @@ const opRevokeDomainAccess = "RevokeDomainAccess"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the RevokeDomainAccessRequest method.
+//	req, resp := client.RevokeDomainAccessRequest(params)
 //
-//    // Example sending a request using the RevokeDomainAccessRequest method.
-//    req, resp := client.RevokeDomainAccessRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/RevokeDomainAccess
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) RevokeDomainAccessRequest(input *RevokeDomainAccessInput) (req *request.Request, output *RevokeDomainAccessOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, RevokeDomainAccess, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opRevokeDomainAccess,
 		HTTPMethod: "POST",
@@ -2372,23 +2690,26 @@ func (c *WorkLink) RevokeDomainAccessRequest(input *RevokeDomainAccessInput) (re
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation RevokeDomainAccess for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
+//
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/RevokeDomainAccess
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) RevokeDomainAccess(input *RevokeDomainAccessInput) (*RevokeDomainAccessOutput, error) {
 	req, out := c.RevokeDomainAccessRequest(input)
 	return out, req.Send()
@@ -2403,6 +2724,8 @@ func (c *WorkLink) RevokeDomainAccess(input *RevokeDomainAccessInput) (*RevokeDo
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) RevokeDomainAccessWithContext(ctx aws.Context, input *RevokeDomainAccessInput, opts ...request.Option) (*RevokeDomainAccessOutput, error) {
 	req, out := c.RevokeDomainAccessRequest(input)
 	req.SetContext(ctx)
@@ -2426,17 +2749,21 @@ const opSignOutUser = "SignOutUser"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the SignOutUserRequest method.
+//	req, resp := client.SignOutUserRequest(params)
 //
-//    // Example sending a request using the SignOutUserRequest method.
-//    req, resp := client.SignOutUserRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/SignOutUser
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) SignOutUserRequest(input *SignOutUserInput) (req *request.Request, output *SignOutUserOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, SignOutUser, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opSignOutUser,
 		HTTPMethod: "POST",
@@ -2465,23 +2792,26 @@ func (c *WorkLink) SignOutUserRequest(input *SignOutUserInput) (req *request.Req
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation SignOutUser for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
+//
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/SignOutUser
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) SignOutUser(input *SignOutUserInput) (*SignOutUserOutput, error) {
 	req, out := c.SignOutUserRequest(input)
 	return out, req.Send()
@@ -2496,6 +2826,8 @@ func (c *WorkLink) SignOutUser(input *SignOutUserInput) (*SignOutUserOutput, err
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) SignOutUserWithContext(ctx aws.Context, input *SignOutUserInput, opts ...request.Option) (*SignOutUserOutput, error) {
 	req, out := c.SignOutUserRequest(input)
 	req.SetContext(ctx)
@@ -2503,184 +2835,380 @@ func (c *WorkLink) SignOutUserWithContext(ctx aws.Context, input *SignOutUserInp
 	return out, req.Send()
 }
 
-const opUpdateAuditStreamConfiguration = "UpdateAuditStreamConfiguration"
+const opTagResource = "TagResource"
 
-// UpdateAuditStreamConfigurationRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateAuditStreamConfiguration operation. The "output" return
+// TagResourceRequest generates a "aws/request.Request" representing the
+// client's request for the TagResource operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateAuditStreamConfiguration for more information on using the UpdateAuditStreamConfiguration
+// See TagResource for more information on using the TagResource
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the TagResourceRequest method.
+//	req, resp := client.TagResourceRequest(params)
 //
-//    // Example sending a request using the UpdateAuditStreamConfigurationRequest method.
-//    req, resp := client.UpdateAuditStreamConfigurationRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+// See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/TagResource
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/UpdateAuditStreamConfiguration
-func (c *WorkLink) UpdateAuditStreamConfigurationRequest(input *UpdateAuditStreamConfigurationInput) (req *request.Request, output *UpdateAuditStreamConfigurationOutput) {
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
+func (c *WorkLink) TagResourceRequest(input *TagResourceInput) (req *request.Request, output *TagResourceOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, TagResource, has been deprecated")
+	}
 	op := &request.Operation{
-		Name:       opUpdateAuditStreamConfiguration,
+		Name:       opTagResource,
 		HTTPMethod: "POST",
-		HTTPPath:   "/updateAuditStreamConfiguration",
+		HTTPPath:   "/tags/{ResourceArn}",
 	}
 
 	if input == nil {
-		input = &UpdateAuditStreamConfigurationInput{}
+		input = &TagResourceInput{}
 	}
 
-	output = &UpdateAuditStreamConfigurationOutput{}
+	output = &TagResourceOutput{}
 	req = c.newRequest(op, input, output)
 	req.Handlers.Unmarshal.Swap(restjson.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// UpdateAuditStreamConfiguration API operation for Amazon WorkLink.
+// TagResource API operation for Amazon WorkLink.
 //
-// Updates the audit stream configuration for the fleet.
+// Adds or overwrites one or more tags for the specified resource, such as a
+// fleet. Each tag consists of a key and an optional value. If a resource already
+// has a tag with the same key, this operation updates its value.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon WorkLink's
-// API operation UpdateAuditStreamConfiguration for usage and error information.
-//
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
-//
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
-//
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+// API operation TagResource for usage and error information.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+// Returned Error Types:
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+// See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/TagResource
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/UpdateAuditStreamConfiguration
-func (c *WorkLink) UpdateAuditStreamConfiguration(input *UpdateAuditStreamConfigurationInput) (*UpdateAuditStreamConfigurationOutput, error) {
-	req, out := c.UpdateAuditStreamConfigurationRequest(input)
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
+func (c *WorkLink) TagResource(input *TagResourceInput) (*TagResourceOutput, error) {
+	req, out := c.TagResourceRequest(input)
 	return out, req.Send()
 }
 
-// UpdateAuditStreamConfigurationWithContext is the same as UpdateAuditStreamConfiguration with the addition of
+// TagResourceWithContext is the same as TagResource with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateAuditStreamConfiguration for details on how to use this API operation.
+// See TagResource for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *WorkLink) UpdateAuditStreamConfigurationWithContext(ctx aws.Context, input *UpdateAuditStreamConfigurationInput, opts ...request.Option) (*UpdateAuditStreamConfigurationOutput, error) {
-	req, out := c.UpdateAuditStreamConfigurationRequest(input)
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
+func (c *WorkLink) TagResourceWithContext(ctx aws.Context, input *TagResourceInput, opts ...request.Option) (*TagResourceOutput, error) {
+	req, out := c.TagResourceRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateCompanyNetworkConfiguration = "UpdateCompanyNetworkConfiguration"
+const opUntagResource = "UntagResource"
 
-// UpdateCompanyNetworkConfigurationRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateCompanyNetworkConfiguration operation. The "output" return
+// UntagResourceRequest generates a "aws/request.Request" representing the
+// client's request for the UntagResource operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateCompanyNetworkConfiguration for more information on using the UpdateCompanyNetworkConfiguration
+// See UntagResource for more information on using the UntagResource
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UntagResourceRequest method.
+//	req, resp := client.UntagResourceRequest(params)
 //
-//    // Example sending a request using the UpdateCompanyNetworkConfigurationRequest method.
-//    req, resp := client.UpdateCompanyNetworkConfigurationRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+// See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/UntagResource
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/UpdateCompanyNetworkConfiguration
-func (c *WorkLink) UpdateCompanyNetworkConfigurationRequest(input *UpdateCompanyNetworkConfigurationInput) (req *request.Request, output *UpdateCompanyNetworkConfigurationOutput) {
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
+func (c *WorkLink) UntagResourceRequest(input *UntagResourceInput) (req *request.Request, output *UntagResourceOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, UntagResource, has been deprecated")
+	}
 	op := &request.Operation{
-		Name:       opUpdateCompanyNetworkConfiguration,
-		HTTPMethod: "POST",
-		HTTPPath:   "/updateCompanyNetworkConfiguration",
+		Name:       opUntagResource,
+		HTTPMethod: "DELETE",
+		HTTPPath:   "/tags/{ResourceArn}",
 	}
 
 	if input == nil {
-		input = &UpdateCompanyNetworkConfigurationInput{}
+		input = &UntagResourceInput{}
 	}
 
-	output = &UpdateCompanyNetworkConfigurationOutput{}
+	output = &UntagResourceOutput{}
 	req = c.newRequest(op, input, output)
 	req.Handlers.Unmarshal.Swap(restjson.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// UpdateCompanyNetworkConfiguration API operation for Amazon WorkLink.
+// UntagResource API operation for Amazon WorkLink.
 //
-// Updates the company network configuration for the fleet.
+// Removes one or more tags from the specified resource.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for Amazon WorkLink's
-// API operation UpdateCompanyNetworkConfiguration for usage and error information.
-//
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// API operation UntagResource for usage and error information.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+// Returned Error Types:
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+// See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/UntagResource
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
-//
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/UpdateCompanyNetworkConfiguration
-func (c *WorkLink) UpdateCompanyNetworkConfiguration(input *UpdateCompanyNetworkConfigurationInput) (*UpdateCompanyNetworkConfigurationOutput, error) {
-	req, out := c.UpdateCompanyNetworkConfigurationRequest(input)
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
+func (c *WorkLink) UntagResource(input *UntagResourceInput) (*UntagResourceOutput, error) {
+	req, out := c.UntagResourceRequest(input)
 	return out, req.Send()
 }
 
-// UpdateCompanyNetworkConfigurationWithContext is the same as UpdateCompanyNetworkConfiguration with the addition of
+// UntagResourceWithContext is the same as UntagResource with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateCompanyNetworkConfiguration for details on how to use this API operation.
+// See UntagResource for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *WorkLink) UpdateCompanyNetworkConfigurationWithContext(ctx aws.Context, input *UpdateCompanyNetworkConfigurationInput, opts ...request.Option) (*UpdateCompanyNetworkConfigurationOutput, error) {
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
+func (c *WorkLink) UntagResourceWithContext(ctx aws.Context, input *UntagResourceInput, opts ...request.Option) (*UntagResourceOutput, error) {
+	req, out := c.UntagResourceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateAuditStreamConfiguration = "UpdateAuditStreamConfiguration"
+
+// UpdateAuditStreamConfigurationRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateAuditStreamConfiguration operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateAuditStreamConfiguration for more information on using the UpdateAuditStreamConfiguration
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateAuditStreamConfigurationRequest method.
+//	req, resp := client.UpdateAuditStreamConfigurationRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/UpdateAuditStreamConfiguration
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
+func (c *WorkLink) UpdateAuditStreamConfigurationRequest(input *UpdateAuditStreamConfigurationInput) (req *request.Request, output *UpdateAuditStreamConfigurationOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, UpdateAuditStreamConfiguration, has been deprecated")
+	}
+	op := &request.Operation{
+		Name:       opUpdateAuditStreamConfiguration,
+		HTTPMethod: "POST",
+		HTTPPath:   "/updateAuditStreamConfiguration",
+	}
+
+	if input == nil {
+		input = &UpdateAuditStreamConfigurationInput{}
+	}
+
+	output = &UpdateAuditStreamConfigurationOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(restjson.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// UpdateAuditStreamConfiguration API operation for Amazon WorkLink.
+//
+// Updates the audit stream configuration for the fleet.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon WorkLink's
+// API operation UpdateAuditStreamConfiguration for usage and error information.
+//
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
+//
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
+//
+//   - InvalidRequestException
+//     The request is not valid.
+//
+//   - ResourceNotFoundException
+//     The requested resource was not found.
+//
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/UpdateAuditStreamConfiguration
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
+func (c *WorkLink) UpdateAuditStreamConfiguration(input *UpdateAuditStreamConfigurationInput) (*UpdateAuditStreamConfigurationOutput, error) {
+	req, out := c.UpdateAuditStreamConfigurationRequest(input)
+	return out, req.Send()
+}
+
+// UpdateAuditStreamConfigurationWithContext is the same as UpdateAuditStreamConfiguration with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateAuditStreamConfiguration for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
+func (c *WorkLink) UpdateAuditStreamConfigurationWithContext(ctx aws.Context, input *UpdateAuditStreamConfigurationInput, opts ...request.Option) (*UpdateAuditStreamConfigurationOutput, error) {
+	req, out := c.UpdateAuditStreamConfigurationRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateCompanyNetworkConfiguration = "UpdateCompanyNetworkConfiguration"
+
+// UpdateCompanyNetworkConfigurationRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateCompanyNetworkConfiguration operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateCompanyNetworkConfiguration for more information on using the UpdateCompanyNetworkConfiguration
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateCompanyNetworkConfigurationRequest method.
+//	req, resp := client.UpdateCompanyNetworkConfigurationRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/UpdateCompanyNetworkConfiguration
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
+func (c *WorkLink) UpdateCompanyNetworkConfigurationRequest(input *UpdateCompanyNetworkConfigurationInput) (req *request.Request, output *UpdateCompanyNetworkConfigurationOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, UpdateCompanyNetworkConfiguration, has been deprecated")
+	}
+	op := &request.Operation{
+		Name:       opUpdateCompanyNetworkConfiguration,
+		HTTPMethod: "POST",
+		HTTPPath:   "/updateCompanyNetworkConfiguration",
+	}
+
+	if input == nil {
+		input = &UpdateCompanyNetworkConfigurationInput{}
+	}
+
+	output = &UpdateCompanyNetworkConfigurationOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(restjson.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// UpdateCompanyNetworkConfiguration API operation for Amazon WorkLink.
+//
+// Updates the company network configuration for the fleet.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon WorkLink's
+// API operation UpdateCompanyNetworkConfiguration for usage and error information.
+//
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
+//
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
+//
+//   - InvalidRequestException
+//     The request is not valid.
+//
+//   - ResourceNotFoundException
+//     The requested resource was not found.
+//
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/UpdateCompanyNetworkConfiguration
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
+func (c *WorkLink) UpdateCompanyNetworkConfiguration(input *UpdateCompanyNetworkConfigurationInput) (*UpdateCompanyNetworkConfigurationOutput, error) {
+	req, out := c.UpdateCompanyNetworkConfigurationRequest(input)
+	return out, req.Send()
+}
+
+// UpdateCompanyNetworkConfigurationWithContext is the same as UpdateCompanyNetworkConfiguration with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateCompanyNetworkConfiguration for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
+func (c *WorkLink) UpdateCompanyNetworkConfigurationWithContext(ctx aws.Context, input *UpdateCompanyNetworkConfigurationInput, opts ...request.Option) (*UpdateCompanyNetworkConfigurationOutput, error) {
 	req, out := c.UpdateCompanyNetworkConfigurationRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
@@ -2703,17 +3231,21 @@ const opUpdateDevicePolicyConfiguration = "UpdateDevicePolicyConfiguration"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateDevicePolicyConfigurationRequest method.
+//	req, resp := client.UpdateDevicePolicyConfigurationRequest(params)
 //
-//    // Example sending a request using the UpdateDevicePolicyConfigurationRequest method.
-//    req, resp := client.UpdateDevicePolicyConfigurationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/UpdateDevicePolicyConfiguration
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) UpdateDevicePolicyConfigurationRequest(input *UpdateDevicePolicyConfigurationInput) (req *request.Request, output *UpdateDevicePolicyConfigurationOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, UpdateDevicePolicyConfiguration, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opUpdateDevicePolicyConfiguration,
 		HTTPMethod: "POST",
@@ -2741,23 +3273,26 @@ func (c *WorkLink) UpdateDevicePolicyConfigurationRequest(input *UpdateDevicePol
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation UpdateDevicePolicyConfiguration for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/UpdateDevicePolicyConfiguration
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) UpdateDevicePolicyConfiguration(input *UpdateDevicePolicyConfigurationInput) (*UpdateDevicePolicyConfigurationOutput, error) {
 	req, out := c.UpdateDevicePolicyConfigurationRequest(input)
 	return out, req.Send()
@@ -2772,6 +3307,8 @@ func (c *WorkLink) UpdateDevicePolicyConfiguration(input *UpdateDevicePolicyConf
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) UpdateDevicePolicyConfigurationWithContext(ctx aws.Context, input *UpdateDevicePolicyConfigurationInput, opts ...request.Option) (*UpdateDevicePolicyConfigurationOutput, error) {
 	req, out := c.UpdateDevicePolicyConfigurationRequest(input)
 	req.SetContext(ctx)
@@ -2795,17 +3332,21 @@ const opUpdateDomainMetadata = "UpdateDomainMetadata"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateDomainMetadataRequest method.
+//	req, resp := client.UpdateDomainMetadataRequest(params)
 //
-//    // Example sending a request using the UpdateDomainMetadataRequest method.
-//    req, resp := client.UpdateDomainMetadataRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/UpdateDomainMetadata
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) UpdateDomainMetadataRequest(input *UpdateDomainMetadataInput) (req *request.Request, output *UpdateDomainMetadataOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, UpdateDomainMetadata, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opUpdateDomainMetadata,
 		HTTPMethod: "POST",
@@ -2833,23 +3374,26 @@ func (c *WorkLink) UpdateDomainMetadataRequest(input *UpdateDomainMetadataInput)
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation UpdateDomainMetadata for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/UpdateDomainMetadata
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) UpdateDomainMetadata(input *UpdateDomainMetadataInput) (*UpdateDomainMetadataOutput, error) {
 	req, out := c.UpdateDomainMetadataRequest(input)
 	return out, req.Send()
@@ -2864,6 +3408,8 @@ func (c *WorkLink) UpdateDomainMetadata(input *UpdateDomainMetadataInput) (*Upda
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) UpdateDomainMetadataWithContext(ctx aws.Context, input *UpdateDomainMetadataInput, opts ...request.Option) (*UpdateDomainMetadataOutput, error) {
 	req, out := c.UpdateDomainMetadataRequest(input)
 	req.SetContext(ctx)
@@ -2887,17 +3433,21 @@ const opUpdateFleetMetadata = "UpdateFleetMetadata"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateFleetMetadataRequest method.
+//	req, resp := client.UpdateFleetMetadataRequest(params)
 //
-//    // Example sending a request using the UpdateFleetMetadataRequest method.
-//    req, resp := client.UpdateFleetMetadataRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/UpdateFleetMetadata
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) UpdateFleetMetadataRequest(input *UpdateFleetMetadataInput) (req *request.Request, output *UpdateFleetMetadataOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, UpdateFleetMetadata, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opUpdateFleetMetadata,
 		HTTPMethod: "POST",
@@ -2925,23 +3475,26 @@ func (c *WorkLink) UpdateFleetMetadataRequest(input *UpdateFleetMetadataInput) (
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation UpdateFleetMetadata for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/UpdateFleetMetadata
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) UpdateFleetMetadata(input *UpdateFleetMetadataInput) (*UpdateFleetMetadataOutput, error) {
 	req, out := c.UpdateFleetMetadataRequest(input)
 	return out, req.Send()
@@ -2956,6 +3509,8 @@ func (c *WorkLink) UpdateFleetMetadata(input *UpdateFleetMetadataInput) (*Update
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) UpdateFleetMetadataWithContext(ctx aws.Context, input *UpdateFleetMetadataInput, opts ...request.Option) (*UpdateFleetMetadataOutput, error) {
 	req, out := c.UpdateFleetMetadataRequest(input)
 	req.SetContext(ctx)
@@ -2979,17 +3534,21 @@ const opUpdateIdentityProviderConfiguration = "UpdateIdentityProviderConfigurati
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the UpdateIdentityProviderConfigurationRequest method.
+//	req, resp := client.UpdateIdentityProviderConfigurationRequest(params)
 //
-//    // Example sending a request using the UpdateIdentityProviderConfigurationRequest method.
-//    req, resp := client.UpdateIdentityProviderConfigurationRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/UpdateIdentityProviderConfiguration
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) UpdateIdentityProviderConfigurationRequest(input *UpdateIdentityProviderConfigurationInput) (req *request.Request, output *UpdateIdentityProviderConfigurationOutput) {
+	if c.Client.Config.Logger != nil {
+		c.Client.Config.Logger.Log("This operation, UpdateIdentityProviderConfiguration, has been deprecated")
+	}
 	op := &request.Operation{
 		Name:       opUpdateIdentityProviderConfiguration,
 		HTTPMethod: "POST",
@@ -3017,23 +3576,26 @@ func (c *WorkLink) UpdateIdentityProviderConfigurationRequest(input *UpdateIdent
 // See the AWS API reference guide for Amazon WorkLink's
 // API operation UpdateIdentityProviderConfiguration for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeUnauthorizedException "UnauthorizedException"
-//   You are not authorized to perform this action.
+// Returned Error Types:
+//
+//   - UnauthorizedException
+//     You are not authorized to perform this action.
 //
-//   * ErrCodeInternalServerErrorException "InternalServerErrorException"
-//   The service is temporarily unavailable.
+//   - InternalServerErrorException
+//     The service is temporarily unavailable.
 //
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is not valid.
+//   - InvalidRequestException
+//     The request is not valid.
 //
-//   * ErrCodeResourceNotFoundException "ResourceNotFoundException"
-//   The requested resource was not found.
+//   - ResourceNotFoundException
+//     The requested resource was not found.
 //
-//   * ErrCodeTooManyRequestsException "TooManyRequestsException"
-//   The number of requests exceeds the limit.
+//   - TooManyRequestsException
+//     The number of requests exceeds the limit.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/worklink-2018-09-25/UpdateIdentityProviderConfiguration
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) UpdateIdentityProviderConfiguration(input *UpdateIdentityProviderConfigurationInput) (*UpdateIdentityProviderConfigurationOutput, error) {
 	req, out := c.UpdateIdentityProviderConfigurationRequest(input)
 	return out, req.Send()
@@ -3048,6 +3610,8 @@ func (c *WorkLink) UpdateIdentityProviderConfiguration(input *UpdateIdentityProv
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
+//
+// Deprecated: Amazon WorkLink is no longer supported. This will be removed in a future version of the SDK.
 func (c *WorkLink) UpdateIdentityProviderConfigurationWithContext(ctx aws.Context, input *UpdateIdentityProviderConfigurationInput, opts ...request.Option) (*UpdateIdentityProviderConfigurationOutput, error) {
 	req, out := c.UpdateIdentityProviderConfigurationRequest(input)
 	req.SetContext(ctx)
@@ -3077,12 +3641,20 @@ type AssociateDomainInput struct {
 	FleetArn *string `min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateDomainInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateDomainInput) GoString() string {
 	return s.String()
 }
@@ -3140,12 +3712,20 @@ type AssociateDomainOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateDomainOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateDomainOutput) GoString() string {
 	return s.String()
 }
@@ -3168,12 +3748,20 @@ type AssociateWebsiteAuthorizationProviderInput struct {
 	FleetArn *string `min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateWebsiteAuthorizationProviderInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateWebsiteAuthorizationProviderInput) GoString() string {
 	return s.String()
 }
@@ -3225,12 +3813,20 @@ type AssociateWebsiteAuthorizationProviderOutput struct {
 	AuthorizationProviderId *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateWebsiteAuthorizationProviderOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateWebsiteAuthorizationProviderOutput) GoString() string {
 	return s.String()
 }
@@ -3258,12 +3854,20 @@ type AssociateWebsiteCertificateAuthorityInput struct {
 	FleetArn *string `min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateWebsiteCertificateAuthorityInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateWebsiteCertificateAuthorityInput) GoString() string {
 	return s.String()
 }
@@ -3315,12 +3919,20 @@ type AssociateWebsiteCertificateAuthorityOutput struct {
 	WebsiteCaId *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateWebsiteCertificateAuthorityOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s AssociateWebsiteCertificateAuthorityOutput) GoString() string {
 	return s.String()
 }
@@ -3345,14 +3957,25 @@ type CreateFleetInput struct {
 	// The option to optimize for better performance by routing traffic through
 	// the closest AWS Region to users, which may be outside of your home Region.
 	OptimizeForEndUserLocation *bool `type:"boolean"`
+
+	// The tags to add to the resource. A tag is a key-value pair.
+	Tags map[string]*string `min:"1" type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateFleetInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateFleetInput) GoString() string {
 	return s.String()
 }
@@ -3366,6 +3989,9 @@ func (s *CreateFleetInput) Validate() error {
 	if s.FleetName != nil && len(*s.FleetName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("FleetName", 1))
 	}
+	if s.Tags != nil && len(s.Tags) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -3391,19 +4017,33 @@ func (s *CreateFleetInput) SetOptimizeForEndUserLocation(v bool) *CreateFleetInp
 	return s
 }
 
+// SetTags sets the Tags field's value.
+func (s *CreateFleetInput) SetTags(v map[string]*string) *CreateFleetInput {
+	s.Tags = v
+	return s
+}
+
 type CreateFleetOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The ARN of the fleet.
+	// The Amazon Resource Name (ARN) of the fleet.
 	FleetArn *string `min:"20" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateFleetOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s CreateFleetOutput) GoString() string {
 	return s.String()
 }
@@ -3423,12 +4063,20 @@ type DeleteFleetInput struct {
 	FleetArn *string `min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteFleetInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteFleetInput) GoString() string {
 	return s.String()
 }
@@ -3459,12 +4107,20 @@ type DeleteFleetOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteFleetOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeleteFleetOutput) GoString() string {
 	return s.String()
 }
@@ -3478,12 +4134,20 @@ type DescribeAuditStreamConfigurationInput struct {
 	FleetArn *string `min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeAuditStreamConfigurationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeAuditStreamConfigurationInput) GoString() string {
 	return s.String()
 }
@@ -3517,12 +4181,20 @@ type DescribeAuditStreamConfigurationOutput struct {
 	AuditStreamArn *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeAuditStreamConfigurationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeAuditStreamConfigurationOutput) GoString() string {
 	return s.String()
 }
@@ -3542,12 +4214,20 @@ type DescribeCompanyNetworkConfigurationInput struct {
 	FleetArn *string `min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeCompanyNetworkConfigurationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeCompanyNetworkConfigurationInput) GoString() string {
 	return s.String()
 }
@@ -3587,12 +4267,20 @@ type DescribeCompanyNetworkConfigurationOutput struct {
 	VpcId *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeCompanyNetworkConfigurationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeCompanyNetworkConfigurationOutput) GoString() string {
 	return s.String()
 }
@@ -3629,12 +4317,20 @@ type DescribeDeviceInput struct {
 	FleetArn *string `min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDeviceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDeviceInput) GoString() string {
 	return s.String()
 }
@@ -3704,12 +4400,20 @@ type DescribeDeviceOutput struct {
 	Username *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDeviceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDeviceOutput) GoString() string {
 	return s.String()
 }
@@ -3777,12 +4481,20 @@ type DescribeDevicePolicyConfigurationInput struct {
 	FleetArn *string `min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDevicePolicyConfigurationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDevicePolicyConfigurationInput) GoString() string {
 	return s.String()
 }
@@ -3817,12 +4529,20 @@ type DescribeDevicePolicyConfigurationOutput struct {
 	DeviceCaCertificate *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDevicePolicyConfigurationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDevicePolicyConfigurationOutput) GoString() string {
 	return s.String()
 }
@@ -3847,12 +4567,20 @@ type DescribeDomainInput struct {
 	FleetArn *string `min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDomainInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDomainInput) GoString() string {
 	return s.String()
 }
@@ -3910,12 +4638,20 @@ type DescribeDomainOutput struct {
 	DomainStatus *string `type:"string" enum:"DomainStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDomainOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeDomainOutput) GoString() string {
 	return s.String()
 }
@@ -3953,18 +4689,26 @@ func (s *DescribeDomainOutput) SetDomainStatus(v string) *DescribeDomainOutput {
 type DescribeFleetMetadataInput struct {
 	_ struct{} `type:"structure"`
 
-	// The ARN of the fleet.
+	// The Amazon Resource Name (ARN) of the fleet.
 	//
 	// FleetArn is a required field
 	FleetArn *string `min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeFleetMetadataInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeFleetMetadataInput) GoString() string {
 	return s.String()
 }
@@ -4015,14 +4759,25 @@ type DescribeFleetMetadataOutput struct {
 	// The option to optimize for better performance by routing traffic through
 	// the closest AWS Region to users, which may be outside of your home Region.
 	OptimizeForEndUserLocation *bool `type:"boolean"`
+
+	// The tags attached to the resource. A tag is a key-value pair.
+	Tags map[string]*string `min:"1" type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeFleetMetadataOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeFleetMetadataOutput) GoString() string {
 	return s.String()
 }
@@ -4069,6 +4824,12 @@ func (s *DescribeFleetMetadataOutput) SetOptimizeForEndUserLocation(v bool) *Des
 	return s
 }
 
+// SetTags sets the Tags field's value.
+func (s *DescribeFleetMetadataOutput) SetTags(v map[string]*string) *DescribeFleetMetadataOutput {
+	s.Tags = v
+	return s
+}
+
 type DescribeIdentityProviderConfigurationInput struct {
 	_ struct{} `type:"structure"`
 
@@ -4078,12 +4839,20 @@ type DescribeIdentityProviderConfigurationInput struct {
 	FleetArn *string `min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeIdentityProviderConfigurationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeIdentityProviderConfigurationInput) GoString() string {
 	return s.String()
 }
@@ -4123,12 +4892,20 @@ type DescribeIdentityProviderConfigurationOutput struct {
 	ServiceProviderSamlMetadata *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeIdentityProviderConfigurationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeIdentityProviderConfigurationOutput) GoString() string {
 	return s.String()
 }
@@ -4165,12 +4942,20 @@ type DescribeWebsiteCertificateAuthorityInput struct {
 	WebsiteCaId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeWebsiteCertificateAuthorityInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeWebsiteCertificateAuthorityInput) GoString() string {
 	return s.String()
 }
@@ -4222,12 +5007,20 @@ type DescribeWebsiteCertificateAuthorityOutput struct {
 	DisplayName *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeWebsiteCertificateAuthorityOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DescribeWebsiteCertificateAuthorityOutput) GoString() string {
 	return s.String()
 }
@@ -4261,12 +5054,20 @@ type DeviceSummary struct {
 	DeviceStatus *string `type:"string" enum:"DeviceStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeviceSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DeviceSummary) GoString() string {
 	return s.String()
 }
@@ -4297,12 +5098,20 @@ type DisassociateDomainInput struct {
 	FleetArn *string `min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateDomainInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateDomainInput) GoString() string {
 	return s.String()
 }
@@ -4345,12 +5154,20 @@ type DisassociateDomainOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateDomainOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateDomainOutput) GoString() string {
 	return s.String()
 }
@@ -4369,12 +5186,20 @@ type DisassociateWebsiteAuthorizationProviderInput struct {
 	FleetArn *string `min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateWebsiteAuthorizationProviderInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateWebsiteAuthorizationProviderInput) GoString() string {
 	return s.String()
 }
@@ -4417,12 +5242,20 @@ type DisassociateWebsiteAuthorizationProviderOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateWebsiteAuthorizationProviderOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateWebsiteAuthorizationProviderOutput) GoString() string {
 	return s.String()
 }
@@ -4441,12 +5274,20 @@ type DisassociateWebsiteCertificateAuthorityInput struct {
 	WebsiteCaId *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateWebsiteCertificateAuthorityInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateWebsiteCertificateAuthorityInput) GoString() string {
 	return s.String()
 }
@@ -4489,12 +5330,20 @@ type DisassociateWebsiteCertificateAuthorityOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateWebsiteCertificateAuthorityOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DisassociateWebsiteCertificateAuthorityOutput) GoString() string {
 	return s.String()
 }
@@ -4522,12 +5371,20 @@ type DomainSummary struct {
 	DomainStatus *string `type:"string" required:"true" enum:"DomainStatus"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DomainSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s DomainSummary) GoString() string {
 	return s.String()
 }
@@ -4566,10 +5423,10 @@ type FleetSummary struct {
 	// The time when the fleet was created.
 	CreatedTime *time.Time `type:"timestamp"`
 
-	// The name to display.
+	// The name of the fleet to display.
 	DisplayName *string `type:"string"`
 
-	// The ARN of the fleet.
+	// The Amazon Resource Name (ARN) of the fleet.
 	FleetArn *string `min:"20" type:"string"`
 
 	// The name of the fleet.
@@ -4580,14 +5437,25 @@ type FleetSummary struct {
 
 	// The time when the fleet was last updated.
 	LastUpdatedTime *time.Time `type:"timestamp"`
+
+	// The tags attached to the resource. A tag is a key-value pair.
+	Tags map[string]*string `min:"1" type:"map"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s FleetSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s FleetSummary) GoString() string {
 	return s.String()
 }
@@ -4634,6 +5502,140 @@ func (s *FleetSummary) SetLastUpdatedTime(v time.Time) *FleetSummary {
 	return s
 }
 
+// SetTags sets the Tags field's value.
+func (s *FleetSummary) SetTags(v map[string]*string) *FleetSummary {
+	s.Tags = v
+	return s
+}
+
+// The service is temporarily unavailable.
+type InternalServerErrorException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InternalServerErrorException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InternalServerErrorException) GoString() string {
+	return s.String()
+}
+
+func newErrorInternalServerErrorException(v protocol.ResponseMetadata) error {
+	return &InternalServerErrorException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InternalServerErrorException) Code() string {
+	return "InternalServerErrorException"
+}
+
+// Message returns the exception's message.
+func (s *InternalServerErrorException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InternalServerErrorException) OrigErr() error {
+	return nil
+}
+
+func (s *InternalServerErrorException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InternalServerErrorException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InternalServerErrorException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The request is not valid.
+type InvalidRequestException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidRequestException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidRequestException) GoString() string {
+	return s.String()
+}
+
+func newErrorInvalidRequestException(v protocol.ResponseMetadata) error {
+	return &InvalidRequestException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *InvalidRequestException) Code() string {
+	return "InvalidRequestException"
+}
+
+// Message returns the exception's message.
+func (s *InvalidRequestException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidRequestException) OrigErr() error {
+	return nil
+}
+
+func (s *InvalidRequestException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidRequestException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidRequestException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 type ListDevicesInput struct {
 	_ struct{} `type:"structure"`
 
@@ -4650,12 +5652,20 @@ type ListDevicesInput struct {
 	NextToken *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDevicesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDevicesInput) GoString() string {
 	return s.String()
 }
@@ -4711,12 +5721,20 @@ type ListDevicesOutput struct {
 	NextToken *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDevicesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDevicesOutput) GoString() string {
 	return s.String()
 }
@@ -4749,12 +5767,20 @@ type ListDomainsInput struct {
 	NextToken *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDomainsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDomainsInput) GoString() string {
 	return s.String()
 }
@@ -4810,12 +5836,20 @@ type ListDomainsOutput struct {
 	NextToken *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDomainsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListDomainsOutput) GoString() string {
 	return s.String()
 }
@@ -4843,12 +5877,20 @@ type ListFleetsInput struct {
 	NextToken *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListFleetsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListFleetsInput) GoString() string {
 	return s.String()
 }
@@ -4892,12 +5934,20 @@ type ListFleetsOutput struct {
 	NextToken *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListFleetsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListFleetsOutput) GoString() string {
 	return s.String()
 }
@@ -4914,6 +5964,86 @@ func (s *ListFleetsOutput) SetNextToken(v string) *ListFleetsOutput {
 	return s
 }
 
+type ListTagsForResourceInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
+	// The Amazon Resource Name (ARN) of the fleet.
+	//
+	// ResourceArn is a required field
+	ResourceArn *string `location:"uri" locationName:"ResourceArn" min:"20" type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListTagsForResourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsForResourceInput"}
+	if s.ResourceArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceArn"))
+	}
+	if s.ResourceArn != nil && len(*s.ResourceArn) < 20 {
+		invalidParams.Add(request.NewErrParamMinLen("ResourceArn", 20))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetResourceArn sets the ResourceArn field's value.
+func (s *ListTagsForResourceInput) SetResourceArn(v string) *ListTagsForResourceInput {
+	s.ResourceArn = &v
+	return s
+}
+
+type ListTagsForResourceOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The tags attached to the resource. A tag is a key-value pair.
+	Tags map[string]*string `min:"1" type:"map"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceOutput) GoString() string {
+	return s.String()
+}
+
+// SetTags sets the Tags field's value.
+func (s *ListTagsForResourceOutput) SetTags(v map[string]*string) *ListTagsForResourceOutput {
+	s.Tags = v
+	return s
+}
+
 type ListWebsiteAuthorizationProvidersInput struct {
 	_ struct{} `type:"structure"`
 
@@ -4930,12 +6060,20 @@ type ListWebsiteAuthorizationProvidersInput struct {
 	NextToken *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListWebsiteAuthorizationProvidersInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListWebsiteAuthorizationProvidersInput) GoString() string {
 	return s.String()
 }
@@ -4991,12 +6129,20 @@ type ListWebsiteAuthorizationProvidersOutput struct {
 	WebsiteAuthorizationProviders []*WebsiteAuthorizationProviderSummary `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListWebsiteAuthorizationProvidersOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListWebsiteAuthorizationProvidersOutput) GoString() string {
 	return s.String()
 }
@@ -5029,12 +6175,20 @@ type ListWebsiteCertificateAuthoritiesInput struct {
 	NextToken *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListWebsiteCertificateAuthoritiesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListWebsiteCertificateAuthoritiesInput) GoString() string {
 	return s.String()
 }
@@ -5090,12 +6244,20 @@ type ListWebsiteCertificateAuthoritiesOutput struct {
 	WebsiteCertificateAuthorities []*WebsiteCaSummary `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListWebsiteCertificateAuthoritiesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ListWebsiteCertificateAuthoritiesOutput) GoString() string {
 	return s.String()
 }
@@ -5112,6 +6274,134 @@ func (s *ListWebsiteCertificateAuthoritiesOutput) SetWebsiteCertificateAuthoriti
 	return s
 }
 
+// The resource already exists.
+type ResourceAlreadyExistsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceAlreadyExistsException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceAlreadyExistsException) GoString() string {
+	return s.String()
+}
+
+func newErrorResourceAlreadyExistsException(v protocol.ResponseMetadata) error {
+	return &ResourceAlreadyExistsException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ResourceAlreadyExistsException) Code() string {
+	return "ResourceAlreadyExistsException"
+}
+
+// Message returns the exception's message.
+func (s *ResourceAlreadyExistsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ResourceAlreadyExistsException) OrigErr() error {
+	return nil
+}
+
+func (s *ResourceAlreadyExistsException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ResourceAlreadyExistsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ResourceAlreadyExistsException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// The requested resource was not found.
+type ResourceNotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceNotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceNotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorResourceNotFoundException(v protocol.ResponseMetadata) error {
+	return &ResourceNotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ResourceNotFoundException) Code() string {
+	return "ResourceNotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *ResourceNotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ResourceNotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *ResourceNotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ResourceNotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ResourceNotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 type RestoreDomainAccessInput struct {
 	_ struct{} `type:"structure"`
 
@@ -5126,12 +6416,20 @@ type RestoreDomainAccessInput struct {
 	FleetArn *string `min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RestoreDomainAccessInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RestoreDomainAccessInput) GoString() string {
 	return s.String()
 }
@@ -5174,12 +6472,20 @@ type RestoreDomainAccessOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RestoreDomainAccessOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RestoreDomainAccessOutput) GoString() string {
 	return s.String()
 }
@@ -5198,12 +6504,20 @@ type RevokeDomainAccessInput struct {
 	FleetArn *string `min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RevokeDomainAccessInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RevokeDomainAccessInput) GoString() string {
 	return s.String()
 }
@@ -5246,12 +6560,20 @@ type RevokeDomainAccessOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RevokeDomainAccessOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RevokeDomainAccessOutput) GoString() string {
 	return s.String()
 }
@@ -5270,12 +6592,20 @@ type SignOutUserInput struct {
 	Username *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SignOutUserInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SignOutUserInput) GoString() string {
 	return s.String()
 }
@@ -5318,16 +6648,328 @@ type SignOutUserOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SignOutUserOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SignOutUserOutput) GoString() string {
 	return s.String()
 }
 
+type TagResourceInput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the fleet.
+	//
+	// ResourceArn is a required field
+	ResourceArn *string `location:"uri" locationName:"ResourceArn" min:"20" type:"string" required:"true"`
+
+	// The tags to add to the resource. A tag is a key-value pair.
+	//
+	// Tags is a required field
+	Tags map[string]*string `min:"1" type:"map" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *TagResourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "TagResourceInput"}
+	if s.ResourceArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceArn"))
+	}
+	if s.ResourceArn != nil && len(*s.ResourceArn) < 20 {
+		invalidParams.Add(request.NewErrParamMinLen("ResourceArn", 20))
+	}
+	if s.Tags == nil {
+		invalidParams.Add(request.NewErrParamRequired("Tags"))
+	}
+	if s.Tags != nil && len(s.Tags) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Tags", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetResourceArn sets the ResourceArn field's value.
+func (s *TagResourceInput) SetResourceArn(v string) *TagResourceInput {
+	s.ResourceArn = &v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *TagResourceInput) SetTags(v map[string]*string) *TagResourceInput {
+	s.Tags = v
+	return s
+}
+
+type TagResourceOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceOutput) GoString() string {
+	return s.String()
+}
+
+// The number of requests exceeds the limit.
+type TooManyRequestsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyRequestsException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyRequestsException) GoString() string {
+	return s.String()
+}
+
+func newErrorTooManyRequestsException(v protocol.ResponseMetadata) error {
+	return &TooManyRequestsException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *TooManyRequestsException) Code() string {
+	return "TooManyRequestsException"
+}
+
+// Message returns the exception's message.
+func (s *TooManyRequestsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *TooManyRequestsException) OrigErr() error {
+	return nil
+}
+
+func (s *TooManyRequestsException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *TooManyRequestsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *TooManyRequestsException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// You are not authorized to perform this action.
+type UnauthorizedException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UnauthorizedException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UnauthorizedException) GoString() string {
+	return s.String()
+}
+
+func newErrorUnauthorizedException(v protocol.ResponseMetadata) error {
+	return &UnauthorizedException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *UnauthorizedException) Code() string {
+	return "UnauthorizedException"
+}
+
+// Message returns the exception's message.
+func (s *UnauthorizedException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *UnauthorizedException) OrigErr() error {
+	return nil
+}
+
+func (s *UnauthorizedException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *UnauthorizedException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *UnauthorizedException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type UntagResourceInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
+	// The Amazon Resource Name (ARN) of the fleet.
+	//
+	// ResourceArn is a required field
+	ResourceArn *string `location:"uri" locationName:"ResourceArn" min:"20" type:"string" required:"true"`
+
+	// The list of tag keys to remove from the resource.
+	//
+	// TagKeys is a required field
+	TagKeys []*string `location:"querystring" locationName:"tagKeys" min:"1" type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *UntagResourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UntagResourceInput"}
+	if s.ResourceArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceArn"))
+	}
+	if s.ResourceArn != nil && len(*s.ResourceArn) < 20 {
+		invalidParams.Add(request.NewErrParamMinLen("ResourceArn", 20))
+	}
+	if s.TagKeys == nil {
+		invalidParams.Add(request.NewErrParamRequired("TagKeys"))
+	}
+	if s.TagKeys != nil && len(s.TagKeys) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("TagKeys", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetResourceArn sets the ResourceArn field's value.
+func (s *UntagResourceInput) SetResourceArn(v string) *UntagResourceInput {
+	s.ResourceArn = &v
+	return s
+}
+
+// SetTagKeys sets the TagKeys field's value.
+func (s *UntagResourceInput) SetTagKeys(v []*string) *UntagResourceInput {
+	s.TagKeys = v
+	return s
+}
+
+type UntagResourceOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateAuditStreamConfigurationInput struct {
 	_ struct{} `type:"structure"`
 
@@ -5340,12 +6982,20 @@ type UpdateAuditStreamConfigurationInput struct {
 	FleetArn *string `min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateAuditStreamConfigurationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateAuditStreamConfigurationInput) GoString() string {
 	return s.String()
 }
@@ -5382,12 +7032,20 @@ type UpdateAuditStreamConfigurationOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateAuditStreamConfigurationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateAuditStreamConfigurationOutput) GoString() string {
 	return s.String()
 }
@@ -5416,12 +7074,20 @@ type UpdateCompanyNetworkConfigurationInput struct {
 	VpcId *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateCompanyNetworkConfigurationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateCompanyNetworkConfigurationInput) GoString() string {
 	return s.String()
 }
@@ -5479,12 +7145,20 @@ type UpdateCompanyNetworkConfigurationOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateCompanyNetworkConfigurationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateCompanyNetworkConfigurationOutput) GoString() string {
 	return s.String()
 }
@@ -5502,12 +7176,20 @@ type UpdateDevicePolicyConfigurationInput struct {
 	FleetArn *string `min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDevicePolicyConfigurationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDevicePolicyConfigurationInput) GoString() string {
 	return s.String()
 }
@@ -5547,12 +7229,20 @@ type UpdateDevicePolicyConfigurationOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDevicePolicyConfigurationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDevicePolicyConfigurationOutput) GoString() string {
 	return s.String()
 }
@@ -5574,12 +7264,20 @@ type UpdateDomainMetadataInput struct {
 	FleetArn *string `min:"20" type:"string" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDomainMetadataInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDomainMetadataInput) GoString() string {
 	return s.String()
 }
@@ -5628,12 +7326,20 @@ type UpdateDomainMetadataOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDomainMetadataOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateDomainMetadataOutput) GoString() string {
 	return s.String()
 }
@@ -5654,12 +7360,20 @@ type UpdateFleetMetadataInput struct {
 	OptimizeForEndUserLocation *bool `type:"boolean"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateFleetMetadataInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateFleetMetadataInput) GoString() string {
 	return s.String()
 }
@@ -5702,12 +7416,20 @@ type UpdateFleetMetadataOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateFleetMetadataOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateFleetMetadataOutput) GoString() string {
 	return s.String()
 }
@@ -5730,12 +7452,20 @@ type UpdateIdentityProviderConfigurationInput struct {
 	IdentityProviderType *string `type:"string" required:"true" enum:"IdentityProviderType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateIdentityProviderConfigurationInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateIdentityProviderConfigurationInput) GoString() string {
 	return s.String()
 }
@@ -5784,12 +7514,20 @@ type UpdateIdentityProviderConfigurationOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateIdentityProviderConfigurationOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateIdentityProviderConfigurationOutput) GoString() string {
 	return s.String()
 }
@@ -5814,12 +7552,20 @@ type WebsiteAuthorizationProviderSummary struct {
 	DomainName *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s WebsiteAuthorizationProviderSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s WebsiteAuthorizationProviderSummary) GoString() string {
 	return s.String()
 }
@@ -5862,12 +7608,20 @@ type WebsiteCaSummary struct {
 	WebsiteCaId *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s WebsiteCaSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s WebsiteCaSummary) GoString() string {
 	return s.String()
 }
@@ -5895,6 +7649,13 @@ const (
 	AuthorizationProviderTypeSaml = "SAML"
 )
 
+// AuthorizationProviderType_Values returns all elements of the AuthorizationProviderType enum
+func AuthorizationProviderType_Values() []string {
+	return []string{
+		AuthorizationProviderTypeSaml,
+	}
+}
+
 const (
 	// DeviceStatusActive is a DeviceStatus enum value
 	DeviceStatusActive = "ACTIVE"
@@ -5903,6 +7664,14 @@ const (
 	DeviceStatusSignedOut = "SIGNED_OUT"
 )
 
+// DeviceStatus_Values returns all elements of the DeviceStatus enum
+func DeviceStatus_Values() []string {
+	return []string{
+		DeviceStatusActive,
+		DeviceStatusSignedOut,
+	}
+}
+
 const (
 	// DomainStatusPendingValidation is a DomainStatus enum value
 	DomainStatusPendingValidation = "PENDING_VALIDATION"
@@ -5929,6 +7698,20 @@ const (
 	DomainStatusFailedToDisassociate = "FAILED_TO_DISASSOCIATE"
 )
 
+// DomainStatus_Values returns all elements of the DomainStatus enum
+func DomainStatus_Values() []string {
+	return []string{
+		DomainStatusPendingValidation,
+		DomainStatusAssociating,
+		DomainStatusActive,
+		DomainStatusInactive,
+		DomainStatusDisassociating,
+		DomainStatusDisassociated,
+		DomainStatusFailedToAssociate,
+		DomainStatusFailedToDisassociate,
+	}
+}
+
 const (
 	// FleetStatusCreating is a FleetStatus enum value
 	FleetStatusCreating = "CREATING"
@@ -5949,7 +7732,26 @@ const (
 	FleetStatusFailedToDelete = "FAILED_TO_DELETE"
 )
 
+// FleetStatus_Values returns all elements of the FleetStatus enum
+func FleetStatus_Values() []string {
+	return []string{
+		FleetStatusCreating,
+		FleetStatusActive,
+		FleetStatusDeleting,
+		FleetStatusDeleted,
+		FleetStatusFailedToCreate,
+		FleetStatusFailedToDelete,
+	}
+}
+
 const (
 	// IdentityProviderTypeSaml is a IdentityProviderType enum value
 	IdentityProviderTypeSaml = "SAML"
 )
+
+// IdentityProviderType_Values returns all elements of the IdentityProviderType enum
+func IdentityProviderType_Values() []string {
+	return []string{
+		IdentityProviderTypeSaml,
+	}
+}
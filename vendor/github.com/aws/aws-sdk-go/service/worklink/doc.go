@@ -4,10 +4,10 @@
 // requests to Amazon WorkLink.
 //
 // Amazon WorkLink is a cloud-based service that provides secure access to internal
-// websites and web apps from iOS phones. In a single step, your users, such
-// as employees, can access internal websites as efficiently as they access
-// any other public website. They enter a URL in their web browser, or choose
-// a link to an internal website in an email. Amazon WorkLink authenticates
+// websites and web apps from iOS and Android phones. In a single step, your
+// users, such as employees, can access internal websites as efficiently as
+// they access any other public website. They enter a URL in their web browser,
+// or choose a link to an internal website in an email. Amazon WorkLink authenticates
 // the user's access and securely renders authorized internal web content in
 // a secure rendering service in the AWS cloud. Amazon WorkLink doesn't download
 // or store any internal web content on mobile devices.
@@ -17,7 +17,7 @@
 // See worklink package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/worklink/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon WorkLink with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -2,6 +2,10 @@
 
 package worklink
 
+import (
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
 const (
 
 	// ErrCodeInternalServerErrorException for service response error code
@@ -40,3 +44,12 @@ const (
 	// You are not authorized to perform this action.
 	ErrCodeUnauthorizedException = "UnauthorizedException"
 )
+
+var exceptionFromCode = map[string]func(protocol.ResponseMetadata) error{
+	"InternalServerErrorException":   newErrorInternalServerErrorException,
+	"InvalidRequestException":        newErrorInvalidRequestException,
+	"ResourceAlreadyExistsException": newErrorResourceAlreadyExistsException,
+	"ResourceNotFoundException":      newErrorResourceNotFoundException,
+	"TooManyRequestsException":       newErrorTooManyRequestsException,
+	"UnauthorizedException":          newErrorUnauthorizedException,
+}
@@ -4,14 +4,32 @@
 // requests to Amazon WorkSpaces.
 //
 // Amazon WorkSpaces enables you to provision virtual, cloud-based Microsoft
-// Windows and Amazon Linux desktops for your users.
+// Windows or Amazon Linux desktops for your users, known as WorkSpaces. WorkSpaces
+// eliminates the need to procure and deploy hardware or install complex software.
+// You can quickly add or remove users as your needs change. Users can access
+// their virtual desktops from multiple devices or web browsers.
+//
+// This API Reference provides detailed information about the actions, data
+// types, parameters, and errors of the WorkSpaces service. For more information
+// about the supported Amazon Web Services Regions, endpoints, and service quotas
+// of the Amazon WorkSpaces service, see WorkSpaces endpoints and quotas (https://docs.aws.amazon.com/general/latest/gr/wsp.html)
+// in the Amazon Web Services General Reference.
+//
+// You can also manage your WorkSpaces resources using the WorkSpaces console,
+// Command Line Interface (CLI), and SDKs. For more information about administering
+// WorkSpaces, see the Amazon WorkSpaces Administration Guide (https://docs.aws.amazon.com/workspaces/latest/adminguide/).
+// For more information about using the Amazon WorkSpaces client application
+// or web browser to access provisioned WorkSpaces, see the Amazon WorkSpaces
+// User Guide (https://docs.aws.amazon.com/workspaces/latest/userguide/). For
+// more information about using the CLI to manage your WorkSpaces resources,
+// see the WorkSpaces section of the CLI Reference (https://docs.aws.amazon.com/cli/latest/reference/workspaces/index.html).
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/workspaces-2015-04-08 for more information on this service.
 //
 // See workspaces package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/workspaces/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact Amazon WorkSpaces with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -29,14 +29,13 @@ const opBatchGetTraces = "BatchGetTraces"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the BatchGetTracesRequest method.
+//	req, resp := client.BatchGetTracesRequest(params)
 //
-//    // Example sending a request using the BatchGetTracesRequest method.
-//    req, resp := client.BatchGetTracesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/BatchGetTraces
 func (c *XRay) BatchGetTracesRequest(input *BatchGetTracesInput) (req *request.Request, output *BatchGetTracesOutput) {
@@ -74,12 +73,13 @@ func (c *XRay) BatchGetTracesRequest(input *BatchGetTracesInput) (req *request.R
 // See the AWS API reference guide for AWS X-Ray's
 // API operation BatchGetTraces for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is missing required parameters or has invalid parameters.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
 //
-//   * ErrCodeThrottledException "ThrottledException"
-//   The request exceeds the maximum number of requests per second.
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/BatchGetTraces
 func (c *XRay) BatchGetTraces(input *BatchGetTracesInput) (*BatchGetTracesOutput, error) {
@@ -111,15 +111,14 @@ func (c *XRay) BatchGetTracesWithContext(ctx aws.Context, input *BatchGetTracesI
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a BatchGetTraces operation.
-//    pageNum := 0
-//    err := client.BatchGetTracesPages(params,
-//        func(page *xray.BatchGetTracesOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a BatchGetTraces operation.
+//	pageNum := 0
+//	err := client.BatchGetTracesPages(params,
+//	    func(page *xray.BatchGetTracesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *XRay) BatchGetTracesPages(input *BatchGetTracesInput, fn func(*BatchGetTracesOutput, bool) bool) error {
 	return c.BatchGetTracesPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -146,10 +145,12 @@ func (c *XRay) BatchGetTracesPagesWithContext(ctx aws.Context, input *BatchGetTr
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*BatchGetTracesOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*BatchGetTracesOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
@@ -169,14 +170,13 @@ const opCreateGroup = "CreateGroup"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateGroupRequest method.
+//	req, resp := client.CreateGroupRequest(params)
 //
-//    // Example sending a request using the CreateGroupRequest method.
-//    req, resp := client.CreateGroupRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/CreateGroup
 func (c *XRay) CreateGroupRequest(input *CreateGroupInput) (req *request.Request, output *CreateGroupOutput) {
@@ -206,12 +206,13 @@ func (c *XRay) CreateGroupRequest(input *CreateGroupInput) (req *request.Request
 // See the AWS API reference guide for AWS X-Ray's
 // API operation CreateGroup for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is missing required parameters or has invalid parameters.
+// Returned Error Types:
 //
-//   * ErrCodeThrottledException "ThrottledException"
-//   The request exceeds the maximum number of requests per second.
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
+//
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/CreateGroup
 func (c *XRay) CreateGroup(input *CreateGroupInput) (*CreateGroupOutput, error) {
@@ -251,14 +252,13 @@ const opCreateSamplingRule = "CreateSamplingRule"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the CreateSamplingRuleRequest method.
+//	req, resp := client.CreateSamplingRuleRequest(params)
 //
-//    // Example sending a request using the CreateSamplingRuleRequest method.
-//    req, resp := client.CreateSamplingRuleRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/CreateSamplingRule
 func (c *XRay) CreateSamplingRuleRequest(input *CreateSamplingRuleInput) (req *request.Request, output *CreateSamplingRuleOutput) {
@@ -280,12 +280,13 @@ func (c *XRay) CreateSamplingRuleRequest(input *CreateSamplingRuleInput) (req *r
 // CreateSamplingRule API operation for AWS X-Ray.
 //
 // Creates a rule to control sampling behavior for instrumented applications.
-// Services retrieve rules with GetSamplingRules, and evaluate each rule in
-// ascending order of priority for each request. If a rule matches, the service
-// records a trace, borrowing it from the reservoir size. After 10 seconds,
-// the service reports back to X-Ray with GetSamplingTargets to get updated
-// versions of each in-use rule. The updated rule contains a trace quota that
-// the service can use instead of borrowing from the reservoir.
+// Services retrieve rules with GetSamplingRules (https://docs.aws.amazon.com/xray/latest/api/API_GetSamplingRules.html),
+// and evaluate each rule in ascending order of priority for each request. If
+// a rule matches, the service records a trace, borrowing it from the reservoir
+// size. After 10 seconds, the service reports back to X-Ray with GetSamplingTargets
+// (https://docs.aws.amazon.com/xray/latest/api/API_GetSamplingTargets.html)
+// to get updated versions of each in-use rule. The updated rule contains a
+// trace quota that the service can use instead of borrowing from the reservoir.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
@@ -294,15 +295,16 @@ func (c *XRay) CreateSamplingRuleRequest(input *CreateSamplingRuleInput) (req *r
 // See the AWS API reference guide for AWS X-Ray's
 // API operation CreateSamplingRule for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is missing required parameters or has invalid parameters.
+// Returned Error Types:
 //
-//   * ErrCodeThrottledException "ThrottledException"
-//   The request exceeds the maximum number of requests per second.
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
 //
-//   * ErrCodeRuleLimitExceededException "RuleLimitExceededException"
-//   You have reached the maximum number of sampling rules.
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
+//
+//   - RuleLimitExceededException
+//     You have reached the maximum number of sampling rules.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/CreateSamplingRule
 func (c *XRay) CreateSamplingRule(input *CreateSamplingRuleInput) (*CreateSamplingRuleOutput, error) {
@@ -342,14 +344,13 @@ const opDeleteGroup = "DeleteGroup"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteGroupRequest method.
+//	req, resp := client.DeleteGroupRequest(params)
 //
-//    // Example sending a request using the DeleteGroupRequest method.
-//    req, resp := client.DeleteGroupRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/DeleteGroup
 func (c *XRay) DeleteGroupRequest(input *DeleteGroupInput) (req *request.Request, output *DeleteGroupOutput) {
@@ -380,12 +381,13 @@ func (c *XRay) DeleteGroupRequest(input *DeleteGroupInput) (req *request.Request
 // See the AWS API reference guide for AWS X-Ray's
 // API operation DeleteGroup for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is missing required parameters or has invalid parameters.
+// Returned Error Types:
 //
-//   * ErrCodeThrottledException "ThrottledException"
-//   The request exceeds the maximum number of requests per second.
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
+//
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/DeleteGroup
 func (c *XRay) DeleteGroup(input *DeleteGroupInput) (*DeleteGroupOutput, error) {
@@ -409,6 +411,94 @@ func (c *XRay) DeleteGroupWithContext(ctx aws.Context, input *DeleteGroupInput,
 	return out, req.Send()
 }
 
+const opDeleteResourcePolicy = "DeleteResourcePolicy"
+
+// DeleteResourcePolicyRequest generates a "aws/request.Request" representing the
+// client's request for the DeleteResourcePolicy operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See DeleteResourcePolicy for more information on using the DeleteResourcePolicy
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the DeleteResourcePolicyRequest method.
+//	req, resp := client.DeleteResourcePolicyRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/DeleteResourcePolicy
+func (c *XRay) DeleteResourcePolicyRequest(input *DeleteResourcePolicyInput) (req *request.Request, output *DeleteResourcePolicyOutput) {
+	op := &request.Operation{
+		Name:       opDeleteResourcePolicy,
+		HTTPMethod: "POST",
+		HTTPPath:   "/DeleteResourcePolicy",
+	}
+
+	if input == nil {
+		input = &DeleteResourcePolicyInput{}
+	}
+
+	output = &DeleteResourcePolicyOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(restjson.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// DeleteResourcePolicy API operation for AWS X-Ray.
+//
+// Deletes a resource policy from the target Amazon Web Services account.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS X-Ray's
+// API operation DeleteResourcePolicy for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
+//
+//   - InvalidPolicyRevisionIdException
+//     A policy revision id was provided which does not match the latest policy
+//     revision. This exception is also if a policy revision id of 0 is provided
+//     via PutResourcePolicy and a policy with the same name already exists.
+//
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/DeleteResourcePolicy
+func (c *XRay) DeleteResourcePolicy(input *DeleteResourcePolicyInput) (*DeleteResourcePolicyOutput, error) {
+	req, out := c.DeleteResourcePolicyRequest(input)
+	return out, req.Send()
+}
+
+// DeleteResourcePolicyWithContext is the same as DeleteResourcePolicy with the addition of
+// the ability to pass a context and additional request options.
+//
+// See DeleteResourcePolicy for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *XRay) DeleteResourcePolicyWithContext(ctx aws.Context, input *DeleteResourcePolicyInput, opts ...request.Option) (*DeleteResourcePolicyOutput, error) {
+	req, out := c.DeleteResourcePolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opDeleteSamplingRule = "DeleteSamplingRule"
 
 // DeleteSamplingRuleRequest generates a "aws/request.Request" representing the
@@ -425,14 +515,13 @@ const opDeleteSamplingRule = "DeleteSamplingRule"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the DeleteSamplingRuleRequest method.
+//	req, resp := client.DeleteSamplingRuleRequest(params)
 //
-//    // Example sending a request using the DeleteSamplingRuleRequest method.
-//    req, resp := client.DeleteSamplingRuleRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/DeleteSamplingRule
 func (c *XRay) DeleteSamplingRuleRequest(input *DeleteSamplingRuleInput) (req *request.Request, output *DeleteSamplingRuleOutput) {
@@ -462,12 +551,13 @@ func (c *XRay) DeleteSamplingRuleRequest(input *DeleteSamplingRuleInput) (req *r
 // See the AWS API reference guide for AWS X-Ray's
 // API operation DeleteSamplingRule for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is missing required parameters or has invalid parameters.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
 //
-//   * ErrCodeThrottledException "ThrottledException"
-//   The request exceeds the maximum number of requests per second.
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/DeleteSamplingRule
 func (c *XRay) DeleteSamplingRule(input *DeleteSamplingRuleInput) (*DeleteSamplingRuleOutput, error) {
@@ -507,14 +597,13 @@ const opGetEncryptionConfig = "GetEncryptionConfig"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetEncryptionConfigRequest method.
+//	req, resp := client.GetEncryptionConfigRequest(params)
 //
-//    // Example sending a request using the GetEncryptionConfigRequest method.
-//    req, resp := client.GetEncryptionConfigRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetEncryptionConfig
 func (c *XRay) GetEncryptionConfigRequest(input *GetEncryptionConfigInput) (req *request.Request, output *GetEncryptionConfigOutput) {
@@ -544,12 +633,13 @@ func (c *XRay) GetEncryptionConfigRequest(input *GetEncryptionConfigInput) (req
 // See the AWS API reference guide for AWS X-Ray's
 // API operation GetEncryptionConfig for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is missing required parameters or has invalid parameters.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
 //
-//   * ErrCodeThrottledException "ThrottledException"
-//   The request exceeds the maximum number of requests per second.
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetEncryptionConfig
 func (c *XRay) GetEncryptionConfig(input *GetEncryptionConfigInput) (*GetEncryptionConfigOutput, error) {
@@ -589,14 +679,13 @@ const opGetGroup = "GetGroup"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetGroupRequest method.
+//	req, resp := client.GetGroupRequest(params)
 //
-//    // Example sending a request using the GetGroupRequest method.
-//    req, resp := client.GetGroupRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetGroup
 func (c *XRay) GetGroupRequest(input *GetGroupInput) (req *request.Request, output *GetGroupOutput) {
@@ -626,12 +715,13 @@ func (c *XRay) GetGroupRequest(input *GetGroupInput) (req *request.Request, outp
 // See the AWS API reference guide for AWS X-Ray's
 // API operation GetGroup for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is missing required parameters or has invalid parameters.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
 //
-//   * ErrCodeThrottledException "ThrottledException"
-//   The request exceeds the maximum number of requests per second.
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetGroup
 func (c *XRay) GetGroup(input *GetGroupInput) (*GetGroupOutput, error) {
@@ -671,14 +761,13 @@ const opGetGroups = "GetGroups"
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetGroupsRequest method.
+//	req, resp := client.GetGroupsRequest(params)
 //
-//    // Example sending a request using the GetGroupsRequest method.
-//    req, resp := client.GetGroupsRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetGroups
 func (c *XRay) GetGroupsRequest(input *GetGroupsInput) (req *request.Request, output *GetGroupsOutput) {
@@ -714,12 +803,13 @@ func (c *XRay) GetGroupsRequest(input *GetGroupsInput) (req *request.Request, ou
 // See the AWS API reference guide for AWS X-Ray's
 // API operation GetGroups for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is missing required parameters or has invalid parameters.
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
 //
-//   * ErrCodeThrottledException "ThrottledException"
-//   The request exceeds the maximum number of requests per second.
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
 //
 // See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetGroups
 func (c *XRay) GetGroups(input *GetGroupsInput) (*GetGroupsOutput, error) {
@@ -751,15 +841,14 @@ func (c *XRay) GetGroupsWithContext(ctx aws.Context, input *GetGroupsInput, opts
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a GetGroups operation.
-//    pageNum := 0
-//    err := client.GetGroupsPages(params,
-//        func(page *xray.GetGroupsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
+//	// Example iterating over at most 3 pages of a GetGroups operation.
+//	pageNum := 0
+//	err := client.GetGroupsPages(params,
+//	    func(page *xray.GetGroupsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
 func (c *XRay) GetGroupsPages(input *GetGroupsInput, fn func(*GetGroupsOutput, bool) bool) error {
 	return c.GetGroupsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
@@ -786,544 +875,494 @@ func (c *XRay) GetGroupsPagesWithContext(ctx aws.Context, input *GetGroupsInput,
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*GetGroupsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*GetGroupsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
-const opGetSamplingRules = "GetSamplingRules"
+const opGetInsight = "GetInsight"
 
-// GetSamplingRulesRequest generates a "aws/request.Request" representing the
-// client's request for the GetSamplingRules operation. The "output" return
+// GetInsightRequest generates a "aws/request.Request" representing the
+// client's request for the GetInsight operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See GetSamplingRules for more information on using the GetSamplingRules
+// See GetInsight for more information on using the GetInsight
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetInsightRequest method.
+//	req, resp := client.GetInsightRequest(params)
 //
-//    // Example sending a request using the GetSamplingRulesRequest method.
-//    req, resp := client.GetSamplingRulesRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetSamplingRules
-func (c *XRay) GetSamplingRulesRequest(input *GetSamplingRulesInput) (req *request.Request, output *GetSamplingRulesOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetInsight
+func (c *XRay) GetInsightRequest(input *GetInsightInput) (req *request.Request, output *GetInsightOutput) {
 	op := &request.Operation{
-		Name:       opGetSamplingRules,
+		Name:       opGetInsight,
 		HTTPMethod: "POST",
-		HTTPPath:   "/GetSamplingRules",
-		Paginator: &request.Paginator{
-			InputTokens:     []string{"NextToken"},
-			OutputTokens:    []string{"NextToken"},
-			LimitToken:      "",
-			TruncationToken: "",
-		},
+		HTTPPath:   "/Insight",
 	}
 
 	if input == nil {
-		input = &GetSamplingRulesInput{}
+		input = &GetInsightInput{}
 	}
 
-	output = &GetSamplingRulesOutput{}
+	output = &GetInsightOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// GetSamplingRules API operation for AWS X-Ray.
+// GetInsight API operation for AWS X-Ray.
 //
-// Retrieves all sampling rules.
+// Retrieves the summary information of an insight. This includes impact to
+// clients and root cause services, the top anomalous services, the category,
+// the state of the insight, and the start and end time of the insight.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS X-Ray's
-// API operation GetSamplingRules for usage and error information.
+// API operation GetInsight for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is missing required parameters or has invalid parameters.
+// Returned Error Types:
 //
-//   * ErrCodeThrottledException "ThrottledException"
-//   The request exceeds the maximum number of requests per second.
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetSamplingRules
-func (c *XRay) GetSamplingRules(input *GetSamplingRulesInput) (*GetSamplingRulesOutput, error) {
-	req, out := c.GetSamplingRulesRequest(input)
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetInsight
+func (c *XRay) GetInsight(input *GetInsightInput) (*GetInsightOutput, error) {
+	req, out := c.GetInsightRequest(input)
 	return out, req.Send()
 }
 
-// GetSamplingRulesWithContext is the same as GetSamplingRules with the addition of
+// GetInsightWithContext is the same as GetInsight with the addition of
 // the ability to pass a context and additional request options.
 //
-// See GetSamplingRules for details on how to use this API operation.
+// See GetInsight for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *XRay) GetSamplingRulesWithContext(ctx aws.Context, input *GetSamplingRulesInput, opts ...request.Option) (*GetSamplingRulesOutput, error) {
-	req, out := c.GetSamplingRulesRequest(input)
+func (c *XRay) GetInsightWithContext(ctx aws.Context, input *GetInsightInput, opts ...request.Option) (*GetInsightOutput, error) {
+	req, out := c.GetInsightRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-// GetSamplingRulesPages iterates over the pages of a GetSamplingRules operation,
-// calling the "fn" function with the response data for each page. To stop
-// iterating, return false from the fn function.
-//
-// See GetSamplingRules method for more information on how to use this operation.
-//
-// Note: This operation can generate multiple requests to a service.
-//
-//    // Example iterating over at most 3 pages of a GetSamplingRules operation.
-//    pageNum := 0
-//    err := client.GetSamplingRulesPages(params,
-//        func(page *xray.GetSamplingRulesOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
-func (c *XRay) GetSamplingRulesPages(input *GetSamplingRulesInput, fn func(*GetSamplingRulesOutput, bool) bool) error {
-	return c.GetSamplingRulesPagesWithContext(aws.BackgroundContext(), input, fn)
-}
-
-// GetSamplingRulesPagesWithContext same as GetSamplingRulesPages except
-// it takes a Context and allows setting request options on the pages.
-//
-// The context must be non-nil and will be used for request cancellation. If
-// the context is nil a panic will occur. In the future the SDK may create
-// sub-contexts for http.Requests. See https://golang.org/pkg/context/
-// for more information on using Contexts.
-func (c *XRay) GetSamplingRulesPagesWithContext(ctx aws.Context, input *GetSamplingRulesInput, fn func(*GetSamplingRulesOutput, bool) bool, opts ...request.Option) error {
-	p := request.Pagination{
-		NewRequest: func() (*request.Request, error) {
-			var inCpy *GetSamplingRulesInput
-			if input != nil {
-				tmp := *input
-				inCpy = &tmp
-			}
-			req, _ := c.GetSamplingRulesRequest(inCpy)
-			req.SetContext(ctx)
-			req.ApplyOptions(opts...)
-			return req, nil
-		},
-	}
-
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*GetSamplingRulesOutput), !p.HasNextPage())
-	}
-	return p.Err()
-}
-
-const opGetSamplingStatisticSummaries = "GetSamplingStatisticSummaries"
+const opGetInsightEvents = "GetInsightEvents"
 
-// GetSamplingStatisticSummariesRequest generates a "aws/request.Request" representing the
-// client's request for the GetSamplingStatisticSummaries operation. The "output" return
+// GetInsightEventsRequest generates a "aws/request.Request" representing the
+// client's request for the GetInsightEvents operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See GetSamplingStatisticSummaries for more information on using the GetSamplingStatisticSummaries
+// See GetInsightEvents for more information on using the GetInsightEvents
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetInsightEventsRequest method.
+//	req, resp := client.GetInsightEventsRequest(params)
 //
-//    // Example sending a request using the GetSamplingStatisticSummariesRequest method.
-//    req, resp := client.GetSamplingStatisticSummariesRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetSamplingStatisticSummaries
-func (c *XRay) GetSamplingStatisticSummariesRequest(input *GetSamplingStatisticSummariesInput) (req *request.Request, output *GetSamplingStatisticSummariesOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetInsightEvents
+func (c *XRay) GetInsightEventsRequest(input *GetInsightEventsInput) (req *request.Request, output *GetInsightEventsOutput) {
 	op := &request.Operation{
-		Name:       opGetSamplingStatisticSummaries,
+		Name:       opGetInsightEvents,
 		HTTPMethod: "POST",
-		HTTPPath:   "/SamplingStatisticSummaries",
+		HTTPPath:   "/InsightEvents",
 		Paginator: &request.Paginator{
 			InputTokens:     []string{"NextToken"},
 			OutputTokens:    []string{"NextToken"},
-			LimitToken:      "",
+			LimitToken:      "MaxResults",
 			TruncationToken: "",
 		},
 	}
 
 	if input == nil {
-		input = &GetSamplingStatisticSummariesInput{}
+		input = &GetInsightEventsInput{}
 	}
 
-	output = &GetSamplingStatisticSummariesOutput{}
+	output = &GetInsightEventsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// GetSamplingStatisticSummaries API operation for AWS X-Ray.
+// GetInsightEvents API operation for AWS X-Ray.
 //
-// Retrieves information about recent sampling results for all sampling rules.
+// X-Ray reevaluates insights periodically until they're resolved, and records
+// each intermediate state as an event. You can review an insight's events in
+// the Impact Timeline on the Inspect page in the X-Ray console.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS X-Ray's
-// API operation GetSamplingStatisticSummaries for usage and error information.
+// API operation GetInsightEvents for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is missing required parameters or has invalid parameters.
+// Returned Error Types:
 //
-//   * ErrCodeThrottledException "ThrottledException"
-//   The request exceeds the maximum number of requests per second.
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetSamplingStatisticSummaries
-func (c *XRay) GetSamplingStatisticSummaries(input *GetSamplingStatisticSummariesInput) (*GetSamplingStatisticSummariesOutput, error) {
-	req, out := c.GetSamplingStatisticSummariesRequest(input)
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetInsightEvents
+func (c *XRay) GetInsightEvents(input *GetInsightEventsInput) (*GetInsightEventsOutput, error) {
+	req, out := c.GetInsightEventsRequest(input)
 	return out, req.Send()
 }
 
-// GetSamplingStatisticSummariesWithContext is the same as GetSamplingStatisticSummaries with the addition of
+// GetInsightEventsWithContext is the same as GetInsightEvents with the addition of
 // the ability to pass a context and additional request options.
 //
-// See GetSamplingStatisticSummaries for details on how to use this API operation.
+// See GetInsightEvents for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *XRay) GetSamplingStatisticSummariesWithContext(ctx aws.Context, input *GetSamplingStatisticSummariesInput, opts ...request.Option) (*GetSamplingStatisticSummariesOutput, error) {
-	req, out := c.GetSamplingStatisticSummariesRequest(input)
+func (c *XRay) GetInsightEventsWithContext(ctx aws.Context, input *GetInsightEventsInput, opts ...request.Option) (*GetInsightEventsOutput, error) {
+	req, out := c.GetInsightEventsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-// GetSamplingStatisticSummariesPages iterates over the pages of a GetSamplingStatisticSummaries operation,
+// GetInsightEventsPages iterates over the pages of a GetInsightEvents operation,
 // calling the "fn" function with the response data for each page. To stop
 // iterating, return false from the fn function.
 //
-// See GetSamplingStatisticSummaries method for more information on how to use this operation.
+// See GetInsightEvents method for more information on how to use this operation.
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a GetSamplingStatisticSummaries operation.
-//    pageNum := 0
-//    err := client.GetSamplingStatisticSummariesPages(params,
-//        func(page *xray.GetSamplingStatisticSummariesOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
-func (c *XRay) GetSamplingStatisticSummariesPages(input *GetSamplingStatisticSummariesInput, fn func(*GetSamplingStatisticSummariesOutput, bool) bool) error {
-	return c.GetSamplingStatisticSummariesPagesWithContext(aws.BackgroundContext(), input, fn)
+//	// Example iterating over at most 3 pages of a GetInsightEvents operation.
+//	pageNum := 0
+//	err := client.GetInsightEventsPages(params,
+//	    func(page *xray.GetInsightEventsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *XRay) GetInsightEventsPages(input *GetInsightEventsInput, fn func(*GetInsightEventsOutput, bool) bool) error {
+	return c.GetInsightEventsPagesWithContext(aws.BackgroundContext(), input, fn)
 }
 
-// GetSamplingStatisticSummariesPagesWithContext same as GetSamplingStatisticSummariesPages except
+// GetInsightEventsPagesWithContext same as GetInsightEventsPages except
 // it takes a Context and allows setting request options on the pages.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *XRay) GetSamplingStatisticSummariesPagesWithContext(ctx aws.Context, input *GetSamplingStatisticSummariesInput, fn func(*GetSamplingStatisticSummariesOutput, bool) bool, opts ...request.Option) error {
+func (c *XRay) GetInsightEventsPagesWithContext(ctx aws.Context, input *GetInsightEventsInput, fn func(*GetInsightEventsOutput, bool) bool, opts ...request.Option) error {
 	p := request.Pagination{
 		NewRequest: func() (*request.Request, error) {
-			var inCpy *GetSamplingStatisticSummariesInput
+			var inCpy *GetInsightEventsInput
 			if input != nil {
 				tmp := *input
 				inCpy = &tmp
 			}
-			req, _ := c.GetSamplingStatisticSummariesRequest(inCpy)
+			req, _ := c.GetInsightEventsRequest(inCpy)
 			req.SetContext(ctx)
 			req.ApplyOptions(opts...)
 			return req, nil
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*GetSamplingStatisticSummariesOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*GetInsightEventsOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
-const opGetSamplingTargets = "GetSamplingTargets"
+const opGetInsightImpactGraph = "GetInsightImpactGraph"
 
-// GetSamplingTargetsRequest generates a "aws/request.Request" representing the
-// client's request for the GetSamplingTargets operation. The "output" return
+// GetInsightImpactGraphRequest generates a "aws/request.Request" representing the
+// client's request for the GetInsightImpactGraph operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See GetSamplingTargets for more information on using the GetSamplingTargets
+// See GetInsightImpactGraph for more information on using the GetInsightImpactGraph
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetInsightImpactGraphRequest method.
+//	req, resp := client.GetInsightImpactGraphRequest(params)
 //
-//    // Example sending a request using the GetSamplingTargetsRequest method.
-//    req, resp := client.GetSamplingTargetsRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetSamplingTargets
-func (c *XRay) GetSamplingTargetsRequest(input *GetSamplingTargetsInput) (req *request.Request, output *GetSamplingTargetsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetInsightImpactGraph
+func (c *XRay) GetInsightImpactGraphRequest(input *GetInsightImpactGraphInput) (req *request.Request, output *GetInsightImpactGraphOutput) {
 	op := &request.Operation{
-		Name:       opGetSamplingTargets,
+		Name:       opGetInsightImpactGraph,
 		HTTPMethod: "POST",
-		HTTPPath:   "/SamplingTargets",
+		HTTPPath:   "/InsightImpactGraph",
 	}
 
 	if input == nil {
-		input = &GetSamplingTargetsInput{}
+		input = &GetInsightImpactGraphInput{}
 	}
 
-	output = &GetSamplingTargetsOutput{}
+	output = &GetInsightImpactGraphOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// GetSamplingTargets API operation for AWS X-Ray.
+// GetInsightImpactGraph API operation for AWS X-Ray.
 //
-// Requests a sampling quota for rules that the service is using to sample requests.
+// Retrieves a service graph structure filtered by the specified insight. The
+// service graph is limited to only structural information. For a complete service
+// graph, use this API with the GetServiceGraph API.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS X-Ray's
-// API operation GetSamplingTargets for usage and error information.
+// API operation GetInsightImpactGraph for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is missing required parameters or has invalid parameters.
+// Returned Error Types:
 //
-//   * ErrCodeThrottledException "ThrottledException"
-//   The request exceeds the maximum number of requests per second.
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetSamplingTargets
-func (c *XRay) GetSamplingTargets(input *GetSamplingTargetsInput) (*GetSamplingTargetsOutput, error) {
-	req, out := c.GetSamplingTargetsRequest(input)
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetInsightImpactGraph
+func (c *XRay) GetInsightImpactGraph(input *GetInsightImpactGraphInput) (*GetInsightImpactGraphOutput, error) {
+	req, out := c.GetInsightImpactGraphRequest(input)
 	return out, req.Send()
 }
 
-// GetSamplingTargetsWithContext is the same as GetSamplingTargets with the addition of
+// GetInsightImpactGraphWithContext is the same as GetInsightImpactGraph with the addition of
 // the ability to pass a context and additional request options.
 //
-// See GetSamplingTargets for details on how to use this API operation.
+// See GetInsightImpactGraph for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *XRay) GetSamplingTargetsWithContext(ctx aws.Context, input *GetSamplingTargetsInput, opts ...request.Option) (*GetSamplingTargetsOutput, error) {
-	req, out := c.GetSamplingTargetsRequest(input)
+func (c *XRay) GetInsightImpactGraphWithContext(ctx aws.Context, input *GetInsightImpactGraphInput, opts ...request.Option) (*GetInsightImpactGraphOutput, error) {
+	req, out := c.GetInsightImpactGraphRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opGetServiceGraph = "GetServiceGraph"
+const opGetInsightSummaries = "GetInsightSummaries"
 
-// GetServiceGraphRequest generates a "aws/request.Request" representing the
-// client's request for the GetServiceGraph operation. The "output" return
+// GetInsightSummariesRequest generates a "aws/request.Request" representing the
+// client's request for the GetInsightSummaries operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See GetServiceGraph for more information on using the GetServiceGraph
+// See GetInsightSummaries for more information on using the GetInsightSummaries
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetInsightSummariesRequest method.
+//	req, resp := client.GetInsightSummariesRequest(params)
 //
-//    // Example sending a request using the GetServiceGraphRequest method.
-//    req, resp := client.GetServiceGraphRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetServiceGraph
-func (c *XRay) GetServiceGraphRequest(input *GetServiceGraphInput) (req *request.Request, output *GetServiceGraphOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetInsightSummaries
+func (c *XRay) GetInsightSummariesRequest(input *GetInsightSummariesInput) (req *request.Request, output *GetInsightSummariesOutput) {
 	op := &request.Operation{
-		Name:       opGetServiceGraph,
+		Name:       opGetInsightSummaries,
 		HTTPMethod: "POST",
-		HTTPPath:   "/ServiceGraph",
+		HTTPPath:   "/InsightSummaries",
 		Paginator: &request.Paginator{
 			InputTokens:     []string{"NextToken"},
 			OutputTokens:    []string{"NextToken"},
-			LimitToken:      "",
+			LimitToken:      "MaxResults",
 			TruncationToken: "",
 		},
 	}
 
 	if input == nil {
-		input = &GetServiceGraphInput{}
+		input = &GetInsightSummariesInput{}
 	}
 
-	output = &GetServiceGraphOutput{}
+	output = &GetInsightSummariesOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// GetServiceGraph API operation for AWS X-Ray.
+// GetInsightSummaries API operation for AWS X-Ray.
 //
-// Retrieves a document that describes services that process incoming requests,
-// and downstream services that they call as a result. Root services process
-// incoming requests and make calls to downstream services. Root services are
-// applications that use the AWS X-Ray SDK. Downstream services can be other
-// applications, AWS resources, HTTP web APIs, or SQL databases.
+// Retrieves the summaries of all insights in the specified group matching the
+// provided filter values.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS X-Ray's
-// API operation GetServiceGraph for usage and error information.
+// API operation GetInsightSummaries for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is missing required parameters or has invalid parameters.
+// Returned Error Types:
 //
-//   * ErrCodeThrottledException "ThrottledException"
-//   The request exceeds the maximum number of requests per second.
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetServiceGraph
-func (c *XRay) GetServiceGraph(input *GetServiceGraphInput) (*GetServiceGraphOutput, error) {
-	req, out := c.GetServiceGraphRequest(input)
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetInsightSummaries
+func (c *XRay) GetInsightSummaries(input *GetInsightSummariesInput) (*GetInsightSummariesOutput, error) {
+	req, out := c.GetInsightSummariesRequest(input)
 	return out, req.Send()
 }
 
-// GetServiceGraphWithContext is the same as GetServiceGraph with the addition of
+// GetInsightSummariesWithContext is the same as GetInsightSummaries with the addition of
 // the ability to pass a context and additional request options.
 //
-// See GetServiceGraph for details on how to use this API operation.
+// See GetInsightSummaries for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *XRay) GetServiceGraphWithContext(ctx aws.Context, input *GetServiceGraphInput, opts ...request.Option) (*GetServiceGraphOutput, error) {
-	req, out := c.GetServiceGraphRequest(input)
+func (c *XRay) GetInsightSummariesWithContext(ctx aws.Context, input *GetInsightSummariesInput, opts ...request.Option) (*GetInsightSummariesOutput, error) {
+	req, out := c.GetInsightSummariesRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-// GetServiceGraphPages iterates over the pages of a GetServiceGraph operation,
+// GetInsightSummariesPages iterates over the pages of a GetInsightSummaries operation,
 // calling the "fn" function with the response data for each page. To stop
 // iterating, return false from the fn function.
 //
-// See GetServiceGraph method for more information on how to use this operation.
+// See GetInsightSummaries method for more information on how to use this operation.
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a GetServiceGraph operation.
-//    pageNum := 0
-//    err := client.GetServiceGraphPages(params,
-//        func(page *xray.GetServiceGraphOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
-func (c *XRay) GetServiceGraphPages(input *GetServiceGraphInput, fn func(*GetServiceGraphOutput, bool) bool) error {
-	return c.GetServiceGraphPagesWithContext(aws.BackgroundContext(), input, fn)
+//	// Example iterating over at most 3 pages of a GetInsightSummaries operation.
+//	pageNum := 0
+//	err := client.GetInsightSummariesPages(params,
+//	    func(page *xray.GetInsightSummariesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *XRay) GetInsightSummariesPages(input *GetInsightSummariesInput, fn func(*GetInsightSummariesOutput, bool) bool) error {
+	return c.GetInsightSummariesPagesWithContext(aws.BackgroundContext(), input, fn)
 }
 
-// GetServiceGraphPagesWithContext same as GetServiceGraphPages except
+// GetInsightSummariesPagesWithContext same as GetInsightSummariesPages except
 // it takes a Context and allows setting request options on the pages.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *XRay) GetServiceGraphPagesWithContext(ctx aws.Context, input *GetServiceGraphInput, fn func(*GetServiceGraphOutput, bool) bool, opts ...request.Option) error {
+func (c *XRay) GetInsightSummariesPagesWithContext(ctx aws.Context, input *GetInsightSummariesInput, fn func(*GetInsightSummariesOutput, bool) bool, opts ...request.Option) error {
 	p := request.Pagination{
 		NewRequest: func() (*request.Request, error) {
-			var inCpy *GetServiceGraphInput
+			var inCpy *GetInsightSummariesInput
 			if input != nil {
 				tmp := *input
 				inCpy = &tmp
 			}
-			req, _ := c.GetServiceGraphRequest(inCpy)
+			req, _ := c.GetInsightSummariesRequest(inCpy)
 			req.SetContext(ctx)
 			req.ApplyOptions(opts...)
 			return req, nil
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*GetServiceGraphOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*GetInsightSummariesOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
-const opGetTimeSeriesServiceStatistics = "GetTimeSeriesServiceStatistics"
+const opGetSamplingRules = "GetSamplingRules"
 
-// GetTimeSeriesServiceStatisticsRequest generates a "aws/request.Request" representing the
-// client's request for the GetTimeSeriesServiceStatistics operation. The "output" return
+// GetSamplingRulesRequest generates a "aws/request.Request" representing the
+// client's request for the GetSamplingRules operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See GetTimeSeriesServiceStatistics for more information on using the GetTimeSeriesServiceStatistics
+// See GetSamplingRules for more information on using the GetSamplingRules
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetSamplingRulesRequest method.
+//	req, resp := client.GetSamplingRulesRequest(params)
 //
-//    // Example sending a request using the GetTimeSeriesServiceStatisticsRequest method.
-//    req, resp := client.GetTimeSeriesServiceStatisticsRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetTimeSeriesServiceStatistics
-func (c *XRay) GetTimeSeriesServiceStatisticsRequest(input *GetTimeSeriesServiceStatisticsInput) (req *request.Request, output *GetTimeSeriesServiceStatisticsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetSamplingRules
+func (c *XRay) GetSamplingRulesRequest(input *GetSamplingRulesInput) (req *request.Request, output *GetSamplingRulesOutput) {
 	op := &request.Operation{
-		Name:       opGetTimeSeriesServiceStatistics,
+		Name:       opGetSamplingRules,
 		HTTPMethod: "POST",
-		HTTPPath:   "/TimeSeriesServiceStatistics",
+		HTTPPath:   "/GetSamplingRules",
 		Paginator: &request.Paginator{
 			InputTokens:     []string{"NextToken"},
 			OutputTokens:    []string{"NextToken"},
@@ -1333,135 +1372,136 @@ func (c *XRay) GetTimeSeriesServiceStatisticsRequest(input *GetTimeSeriesService
 	}
 
 	if input == nil {
-		input = &GetTimeSeriesServiceStatisticsInput{}
+		input = &GetSamplingRulesInput{}
 	}
 
-	output = &GetTimeSeriesServiceStatisticsOutput{}
+	output = &GetSamplingRulesOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// GetTimeSeriesServiceStatistics API operation for AWS X-Ray.
+// GetSamplingRules API operation for AWS X-Ray.
 //
-// Get an aggregation of service statistics defined by a specific time range.
+// Retrieves all sampling rules.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS X-Ray's
-// API operation GetTimeSeriesServiceStatistics for usage and error information.
+// API operation GetSamplingRules for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is missing required parameters or has invalid parameters.
+// Returned Error Types:
 //
-//   * ErrCodeThrottledException "ThrottledException"
-//   The request exceeds the maximum number of requests per second.
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetTimeSeriesServiceStatistics
-func (c *XRay) GetTimeSeriesServiceStatistics(input *GetTimeSeriesServiceStatisticsInput) (*GetTimeSeriesServiceStatisticsOutput, error) {
-	req, out := c.GetTimeSeriesServiceStatisticsRequest(input)
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetSamplingRules
+func (c *XRay) GetSamplingRules(input *GetSamplingRulesInput) (*GetSamplingRulesOutput, error) {
+	req, out := c.GetSamplingRulesRequest(input)
 	return out, req.Send()
 }
 
-// GetTimeSeriesServiceStatisticsWithContext is the same as GetTimeSeriesServiceStatistics with the addition of
+// GetSamplingRulesWithContext is the same as GetSamplingRules with the addition of
 // the ability to pass a context and additional request options.
 //
-// See GetTimeSeriesServiceStatistics for details on how to use this API operation.
+// See GetSamplingRules for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *XRay) GetTimeSeriesServiceStatisticsWithContext(ctx aws.Context, input *GetTimeSeriesServiceStatisticsInput, opts ...request.Option) (*GetTimeSeriesServiceStatisticsOutput, error) {
-	req, out := c.GetTimeSeriesServiceStatisticsRequest(input)
+func (c *XRay) GetSamplingRulesWithContext(ctx aws.Context, input *GetSamplingRulesInput, opts ...request.Option) (*GetSamplingRulesOutput, error) {
+	req, out := c.GetSamplingRulesRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-// GetTimeSeriesServiceStatisticsPages iterates over the pages of a GetTimeSeriesServiceStatistics operation,
+// GetSamplingRulesPages iterates over the pages of a GetSamplingRules operation,
 // calling the "fn" function with the response data for each page. To stop
 // iterating, return false from the fn function.
 //
-// See GetTimeSeriesServiceStatistics method for more information on how to use this operation.
+// See GetSamplingRules method for more information on how to use this operation.
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a GetTimeSeriesServiceStatistics operation.
-//    pageNum := 0
-//    err := client.GetTimeSeriesServiceStatisticsPages(params,
-//        func(page *xray.GetTimeSeriesServiceStatisticsOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
-func (c *XRay) GetTimeSeriesServiceStatisticsPages(input *GetTimeSeriesServiceStatisticsInput, fn func(*GetTimeSeriesServiceStatisticsOutput, bool) bool) error {
-	return c.GetTimeSeriesServiceStatisticsPagesWithContext(aws.BackgroundContext(), input, fn)
+//	// Example iterating over at most 3 pages of a GetSamplingRules operation.
+//	pageNum := 0
+//	err := client.GetSamplingRulesPages(params,
+//	    func(page *xray.GetSamplingRulesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *XRay) GetSamplingRulesPages(input *GetSamplingRulesInput, fn func(*GetSamplingRulesOutput, bool) bool) error {
+	return c.GetSamplingRulesPagesWithContext(aws.BackgroundContext(), input, fn)
 }
 
-// GetTimeSeriesServiceStatisticsPagesWithContext same as GetTimeSeriesServiceStatisticsPages except
+// GetSamplingRulesPagesWithContext same as GetSamplingRulesPages except
 // it takes a Context and allows setting request options on the pages.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *XRay) GetTimeSeriesServiceStatisticsPagesWithContext(ctx aws.Context, input *GetTimeSeriesServiceStatisticsInput, fn func(*GetTimeSeriesServiceStatisticsOutput, bool) bool, opts ...request.Option) error {
+func (c *XRay) GetSamplingRulesPagesWithContext(ctx aws.Context, input *GetSamplingRulesInput, fn func(*GetSamplingRulesOutput, bool) bool, opts ...request.Option) error {
 	p := request.Pagination{
 		NewRequest: func() (*request.Request, error) {
-			var inCpy *GetTimeSeriesServiceStatisticsInput
+			var inCpy *GetSamplingRulesInput
 			if input != nil {
 				tmp := *input
 				inCpy = &tmp
 			}
-			req, _ := c.GetTimeSeriesServiceStatisticsRequest(inCpy)
+			req, _ := c.GetSamplingRulesRequest(inCpy)
 			req.SetContext(ctx)
 			req.ApplyOptions(opts...)
 			return req, nil
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*GetTimeSeriesServiceStatisticsOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*GetSamplingRulesOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
-const opGetTraceGraph = "GetTraceGraph"
+const opGetSamplingStatisticSummaries = "GetSamplingStatisticSummaries"
 
-// GetTraceGraphRequest generates a "aws/request.Request" representing the
-// client's request for the GetTraceGraph operation. The "output" return
+// GetSamplingStatisticSummariesRequest generates a "aws/request.Request" representing the
+// client's request for the GetSamplingStatisticSummaries operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See GetTraceGraph for more information on using the GetTraceGraph
+// See GetSamplingStatisticSummaries for more information on using the GetSamplingStatisticSummaries
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetSamplingStatisticSummariesRequest method.
+//	req, resp := client.GetSamplingStatisticSummariesRequest(params)
 //
-//    // Example sending a request using the GetTraceGraphRequest method.
-//    req, resp := client.GetTraceGraphRequest(params)
-//
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetTraceGraph
-func (c *XRay) GetTraceGraphRequest(input *GetTraceGraphInput) (req *request.Request, output *GetTraceGraphOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetSamplingStatisticSummaries
+func (c *XRay) GetSamplingStatisticSummariesRequest(input *GetSamplingStatisticSummariesInput) (req *request.Request, output *GetSamplingStatisticSummariesOutput) {
 	op := &request.Operation{
-		Name:       opGetTraceGraph,
+		Name:       opGetSamplingStatisticSummaries,
 		HTTPMethod: "POST",
-		HTTPPath:   "/TraceGraph",
+		HTTPPath:   "/SamplingStatisticSummaries",
 		Paginator: &request.Paginator{
 			InputTokens:     []string{"NextToken"},
 			OutputTokens:    []string{"NextToken"},
@@ -1471,135 +1511,218 @@ func (c *XRay) GetTraceGraphRequest(input *GetTraceGraphInput) (req *request.Req
 	}
 
 	if input == nil {
-		input = &GetTraceGraphInput{}
+		input = &GetSamplingStatisticSummariesInput{}
 	}
 
-	output = &GetTraceGraphOutput{}
+	output = &GetSamplingStatisticSummariesOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// GetTraceGraph API operation for AWS X-Ray.
+// GetSamplingStatisticSummaries API operation for AWS X-Ray.
 //
-// Retrieves a service graph for one or more specific trace IDs.
+// Retrieves information about recent sampling results for all sampling rules.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS X-Ray's
-// API operation GetTraceGraph for usage and error information.
+// API operation GetSamplingStatisticSummaries for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is missing required parameters or has invalid parameters.
+// Returned Error Types:
 //
-//   * ErrCodeThrottledException "ThrottledException"
-//   The request exceeds the maximum number of requests per second.
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetTraceGraph
-func (c *XRay) GetTraceGraph(input *GetTraceGraphInput) (*GetTraceGraphOutput, error) {
-	req, out := c.GetTraceGraphRequest(input)
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetSamplingStatisticSummaries
+func (c *XRay) GetSamplingStatisticSummaries(input *GetSamplingStatisticSummariesInput) (*GetSamplingStatisticSummariesOutput, error) {
+	req, out := c.GetSamplingStatisticSummariesRequest(input)
 	return out, req.Send()
 }
 
-// GetTraceGraphWithContext is the same as GetTraceGraph with the addition of
+// GetSamplingStatisticSummariesWithContext is the same as GetSamplingStatisticSummaries with the addition of
 // the ability to pass a context and additional request options.
 //
-// See GetTraceGraph for details on how to use this API operation.
+// See GetSamplingStatisticSummaries for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *XRay) GetTraceGraphWithContext(ctx aws.Context, input *GetTraceGraphInput, opts ...request.Option) (*GetTraceGraphOutput, error) {
-	req, out := c.GetTraceGraphRequest(input)
+func (c *XRay) GetSamplingStatisticSummariesWithContext(ctx aws.Context, input *GetSamplingStatisticSummariesInput, opts ...request.Option) (*GetSamplingStatisticSummariesOutput, error) {
+	req, out := c.GetSamplingStatisticSummariesRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-// GetTraceGraphPages iterates over the pages of a GetTraceGraph operation,
+// GetSamplingStatisticSummariesPages iterates over the pages of a GetSamplingStatisticSummaries operation,
 // calling the "fn" function with the response data for each page. To stop
 // iterating, return false from the fn function.
 //
-// See GetTraceGraph method for more information on how to use this operation.
+// See GetSamplingStatisticSummaries method for more information on how to use this operation.
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a GetTraceGraph operation.
-//    pageNum := 0
-//    err := client.GetTraceGraphPages(params,
-//        func(page *xray.GetTraceGraphOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
-func (c *XRay) GetTraceGraphPages(input *GetTraceGraphInput, fn func(*GetTraceGraphOutput, bool) bool) error {
-	return c.GetTraceGraphPagesWithContext(aws.BackgroundContext(), input, fn)
+//	// Example iterating over at most 3 pages of a GetSamplingStatisticSummaries operation.
+//	pageNum := 0
+//	err := client.GetSamplingStatisticSummariesPages(params,
+//	    func(page *xray.GetSamplingStatisticSummariesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *XRay) GetSamplingStatisticSummariesPages(input *GetSamplingStatisticSummariesInput, fn func(*GetSamplingStatisticSummariesOutput, bool) bool) error {
+	return c.GetSamplingStatisticSummariesPagesWithContext(aws.BackgroundContext(), input, fn)
 }
 
-// GetTraceGraphPagesWithContext same as GetTraceGraphPages except
+// GetSamplingStatisticSummariesPagesWithContext same as GetSamplingStatisticSummariesPages except
 // it takes a Context and allows setting request options on the pages.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *XRay) GetTraceGraphPagesWithContext(ctx aws.Context, input *GetTraceGraphInput, fn func(*GetTraceGraphOutput, bool) bool, opts ...request.Option) error {
+func (c *XRay) GetSamplingStatisticSummariesPagesWithContext(ctx aws.Context, input *GetSamplingStatisticSummariesInput, fn func(*GetSamplingStatisticSummariesOutput, bool) bool, opts ...request.Option) error {
 	p := request.Pagination{
 		NewRequest: func() (*request.Request, error) {
-			var inCpy *GetTraceGraphInput
+			var inCpy *GetSamplingStatisticSummariesInput
 			if input != nil {
 				tmp := *input
 				inCpy = &tmp
 			}
-			req, _ := c.GetTraceGraphRequest(inCpy)
+			req, _ := c.GetSamplingStatisticSummariesRequest(inCpy)
 			req.SetContext(ctx)
 			req.ApplyOptions(opts...)
 			return req, nil
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*GetTraceGraphOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*GetSamplingStatisticSummariesOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
-const opGetTraceSummaries = "GetTraceSummaries"
+const opGetSamplingTargets = "GetSamplingTargets"
 
-// GetTraceSummariesRequest generates a "aws/request.Request" representing the
-// client's request for the GetTraceSummaries operation. The "output" return
+// GetSamplingTargetsRequest generates a "aws/request.Request" representing the
+// client's request for the GetSamplingTargets operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See GetTraceSummaries for more information on using the GetTraceSummaries
+// See GetSamplingTargets for more information on using the GetSamplingTargets
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetSamplingTargetsRequest method.
+//	req, resp := client.GetSamplingTargetsRequest(params)
 //
-//    // Example sending a request using the GetTraceSummariesRequest method.
-//    req, resp := client.GetTraceSummariesRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetSamplingTargets
+func (c *XRay) GetSamplingTargetsRequest(input *GetSamplingTargetsInput) (req *request.Request, output *GetSamplingTargetsOutput) {
+	op := &request.Operation{
+		Name:       opGetSamplingTargets,
+		HTTPMethod: "POST",
+		HTTPPath:   "/SamplingTargets",
+	}
+
+	if input == nil {
+		input = &GetSamplingTargetsInput{}
+	}
+
+	output = &GetSamplingTargetsOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// GetSamplingTargets API operation for AWS X-Ray.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetTraceSummaries
-func (c *XRay) GetTraceSummariesRequest(input *GetTraceSummariesInput) (req *request.Request, output *GetTraceSummariesOutput) {
+// Requests a sampling quota for rules that the service is using to sample requests.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS X-Ray's
+// API operation GetSamplingTargets for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
+//
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetSamplingTargets
+func (c *XRay) GetSamplingTargets(input *GetSamplingTargetsInput) (*GetSamplingTargetsOutput, error) {
+	req, out := c.GetSamplingTargetsRequest(input)
+	return out, req.Send()
+}
+
+// GetSamplingTargetsWithContext is the same as GetSamplingTargets with the addition of
+// the ability to pass a context and additional request options.
+//
+// See GetSamplingTargets for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *XRay) GetSamplingTargetsWithContext(ctx aws.Context, input *GetSamplingTargetsInput, opts ...request.Option) (*GetSamplingTargetsOutput, error) {
+	req, out := c.GetSamplingTargetsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opGetServiceGraph = "GetServiceGraph"
+
+// GetServiceGraphRequest generates a "aws/request.Request" representing the
+// client's request for the GetServiceGraph operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See GetServiceGraph for more information on using the GetServiceGraph
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the GetServiceGraphRequest method.
+//	req, resp := client.GetServiceGraphRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetServiceGraph
+func (c *XRay) GetServiceGraphRequest(input *GetServiceGraphInput) (req *request.Request, output *GetServiceGraphOutput) {
 	op := &request.Operation{
-		Name:       opGetTraceSummaries,
+		Name:       opGetServiceGraph,
 		HTTPMethod: "POST",
-		HTTPPath:   "/TraceSummaries",
+		HTTPPath:   "/ServiceGraph",
 		Paginator: &request.Paginator{
 			InputTokens:     []string{"NextToken"},
 			OutputTokens:    []string{"NextToken"},
@@ -1609,774 +1732,3368 @@ func (c *XRay) GetTraceSummariesRequest(input *GetTraceSummariesInput) (req *req
 	}
 
 	if input == nil {
-		input = &GetTraceSummariesInput{}
+		input = &GetServiceGraphInput{}
 	}
 
-	output = &GetTraceSummariesOutput{}
+	output = &GetServiceGraphOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// GetTraceSummaries API operation for AWS X-Ray.
-//
-// Retrieves IDs and metadata for traces available for a specified time frame
-// using an optional filter. To get the full traces, pass the trace IDs to BatchGetTraces.
-//
-// A filter expression can target traced requests that hit specific service
-// nodes or edges, have errors, or come from a known user. For example, the
-// following filter expression targets traces that pass through api.example.com:
-//
-// service("api.example.com")
-//
-// This filter expression finds traces that have an annotation named account
-// with the value 12345:
-//
-// annotation.account = "12345"
+// GetServiceGraph API operation for AWS X-Ray.
 //
-// For a full list of indexed fields and keywords that you can use in filter
-// expressions, see Using Filter Expressions (https://docs.aws.amazon.com/xray/latest/devguide/xray-console-filters.html)
-// in the AWS X-Ray Developer Guide.
+// Retrieves a document that describes services that process incoming requests,
+// and downstream services that they call as a result. Root services process
+// incoming requests and make calls to downstream services. Root services are
+// applications that use the Amazon Web Services X-Ray SDK (https://docs.aws.amazon.com/xray/index.html).
+// Downstream services can be other applications, Amazon Web Services resources,
+// HTTP web APIs, or SQL databases.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS X-Ray's
-// API operation GetTraceSummaries for usage and error information.
+// API operation GetServiceGraph for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is missing required parameters or has invalid parameters.
+// Returned Error Types:
 //
-//   * ErrCodeThrottledException "ThrottledException"
-//   The request exceeds the maximum number of requests per second.
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetTraceSummaries
-func (c *XRay) GetTraceSummaries(input *GetTraceSummariesInput) (*GetTraceSummariesOutput, error) {
-	req, out := c.GetTraceSummariesRequest(input)
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetServiceGraph
+func (c *XRay) GetServiceGraph(input *GetServiceGraphInput) (*GetServiceGraphOutput, error) {
+	req, out := c.GetServiceGraphRequest(input)
 	return out, req.Send()
 }
 
-// GetTraceSummariesWithContext is the same as GetTraceSummaries with the addition of
+// GetServiceGraphWithContext is the same as GetServiceGraph with the addition of
 // the ability to pass a context and additional request options.
 //
-// See GetTraceSummaries for details on how to use this API operation.
+// See GetServiceGraph for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *XRay) GetTraceSummariesWithContext(ctx aws.Context, input *GetTraceSummariesInput, opts ...request.Option) (*GetTraceSummariesOutput, error) {
-	req, out := c.GetTraceSummariesRequest(input)
+func (c *XRay) GetServiceGraphWithContext(ctx aws.Context, input *GetServiceGraphInput, opts ...request.Option) (*GetServiceGraphOutput, error) {
+	req, out := c.GetServiceGraphRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-// GetTraceSummariesPages iterates over the pages of a GetTraceSummaries operation,
+// GetServiceGraphPages iterates over the pages of a GetServiceGraph operation,
 // calling the "fn" function with the response data for each page. To stop
 // iterating, return false from the fn function.
 //
-// See GetTraceSummaries method for more information on how to use this operation.
+// See GetServiceGraph method for more information on how to use this operation.
 //
 // Note: This operation can generate multiple requests to a service.
 //
-//    // Example iterating over at most 3 pages of a GetTraceSummaries operation.
-//    pageNum := 0
-//    err := client.GetTraceSummariesPages(params,
-//        func(page *xray.GetTraceSummariesOutput, lastPage bool) bool {
-//            pageNum++
-//            fmt.Println(page)
-//            return pageNum <= 3
-//        })
-//
-func (c *XRay) GetTraceSummariesPages(input *GetTraceSummariesInput, fn func(*GetTraceSummariesOutput, bool) bool) error {
-	return c.GetTraceSummariesPagesWithContext(aws.BackgroundContext(), input, fn)
+//	// Example iterating over at most 3 pages of a GetServiceGraph operation.
+//	pageNum := 0
+//	err := client.GetServiceGraphPages(params,
+//	    func(page *xray.GetServiceGraphOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *XRay) GetServiceGraphPages(input *GetServiceGraphInput, fn func(*GetServiceGraphOutput, bool) bool) error {
+	return c.GetServiceGraphPagesWithContext(aws.BackgroundContext(), input, fn)
 }
 
-// GetTraceSummariesPagesWithContext same as GetTraceSummariesPages except
+// GetServiceGraphPagesWithContext same as GetServiceGraphPages except
 // it takes a Context and allows setting request options on the pages.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *XRay) GetTraceSummariesPagesWithContext(ctx aws.Context, input *GetTraceSummariesInput, fn func(*GetTraceSummariesOutput, bool) bool, opts ...request.Option) error {
+func (c *XRay) GetServiceGraphPagesWithContext(ctx aws.Context, input *GetServiceGraphInput, fn func(*GetServiceGraphOutput, bool) bool, opts ...request.Option) error {
 	p := request.Pagination{
 		NewRequest: func() (*request.Request, error) {
-			var inCpy *GetTraceSummariesInput
+			var inCpy *GetServiceGraphInput
 			if input != nil {
 				tmp := *input
 				inCpy = &tmp
 			}
-			req, _ := c.GetTraceSummariesRequest(inCpy)
+			req, _ := c.GetServiceGraphRequest(inCpy)
 			req.SetContext(ctx)
 			req.ApplyOptions(opts...)
 			return req, nil
 		},
 	}
 
-	cont := true
-	for p.Next() && cont {
-		cont = fn(p.Page().(*GetTraceSummariesOutput), !p.HasNextPage())
+	for p.Next() {
+		if !fn(p.Page().(*GetServiceGraphOutput), !p.HasNextPage()) {
+			break
+		}
 	}
+
 	return p.Err()
 }
 
-const opPutEncryptionConfig = "PutEncryptionConfig"
+const opGetTimeSeriesServiceStatistics = "GetTimeSeriesServiceStatistics"
 
-// PutEncryptionConfigRequest generates a "aws/request.Request" representing the
-// client's request for the PutEncryptionConfig operation. The "output" return
+// GetTimeSeriesServiceStatisticsRequest generates a "aws/request.Request" representing the
+// client's request for the GetTimeSeriesServiceStatistics operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See PutEncryptionConfig for more information on using the PutEncryptionConfig
+// See GetTimeSeriesServiceStatistics for more information on using the GetTimeSeriesServiceStatistics
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetTimeSeriesServiceStatisticsRequest method.
+//	req, resp := client.GetTimeSeriesServiceStatisticsRequest(params)
 //
-//    // Example sending a request using the PutEncryptionConfigRequest method.
-//    req, resp := client.PutEncryptionConfigRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/PutEncryptionConfig
-func (c *XRay) PutEncryptionConfigRequest(input *PutEncryptionConfigInput) (req *request.Request, output *PutEncryptionConfigOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetTimeSeriesServiceStatistics
+func (c *XRay) GetTimeSeriesServiceStatisticsRequest(input *GetTimeSeriesServiceStatisticsInput) (req *request.Request, output *GetTimeSeriesServiceStatisticsOutput) {
 	op := &request.Operation{
-		Name:       opPutEncryptionConfig,
+		Name:       opGetTimeSeriesServiceStatistics,
 		HTTPMethod: "POST",
-		HTTPPath:   "/PutEncryptionConfig",
+		HTTPPath:   "/TimeSeriesServiceStatistics",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &PutEncryptionConfigInput{}
+		input = &GetTimeSeriesServiceStatisticsInput{}
 	}
 
-	output = &PutEncryptionConfigOutput{}
+	output = &GetTimeSeriesServiceStatisticsOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// PutEncryptionConfig API operation for AWS X-Ray.
+// GetTimeSeriesServiceStatistics API operation for AWS X-Ray.
 //
-// Updates the encryption configuration for X-Ray data.
+// Get an aggregation of service statistics defined by a specific time range.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS X-Ray's
-// API operation PutEncryptionConfig for usage and error information.
+// API operation GetTimeSeriesServiceStatistics for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is missing required parameters or has invalid parameters.
+// Returned Error Types:
 //
-//   * ErrCodeThrottledException "ThrottledException"
-//   The request exceeds the maximum number of requests per second.
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/PutEncryptionConfig
-func (c *XRay) PutEncryptionConfig(input *PutEncryptionConfigInput) (*PutEncryptionConfigOutput, error) {
-	req, out := c.PutEncryptionConfigRequest(input)
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetTimeSeriesServiceStatistics
+func (c *XRay) GetTimeSeriesServiceStatistics(input *GetTimeSeriesServiceStatisticsInput) (*GetTimeSeriesServiceStatisticsOutput, error) {
+	req, out := c.GetTimeSeriesServiceStatisticsRequest(input)
 	return out, req.Send()
 }
 
-// PutEncryptionConfigWithContext is the same as PutEncryptionConfig with the addition of
+// GetTimeSeriesServiceStatisticsWithContext is the same as GetTimeSeriesServiceStatistics with the addition of
 // the ability to pass a context and additional request options.
 //
-// See PutEncryptionConfig for details on how to use this API operation.
+// See GetTimeSeriesServiceStatistics for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *XRay) PutEncryptionConfigWithContext(ctx aws.Context, input *PutEncryptionConfigInput, opts ...request.Option) (*PutEncryptionConfigOutput, error) {
-	req, out := c.PutEncryptionConfigRequest(input)
+func (c *XRay) GetTimeSeriesServiceStatisticsWithContext(ctx aws.Context, input *GetTimeSeriesServiceStatisticsInput, opts ...request.Option) (*GetTimeSeriesServiceStatisticsOutput, error) {
+	req, out := c.GetTimeSeriesServiceStatisticsRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opPutTelemetryRecords = "PutTelemetryRecords"
+// GetTimeSeriesServiceStatisticsPages iterates over the pages of a GetTimeSeriesServiceStatistics operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See GetTimeSeriesServiceStatistics method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a GetTimeSeriesServiceStatistics operation.
+//	pageNum := 0
+//	err := client.GetTimeSeriesServiceStatisticsPages(params,
+//	    func(page *xray.GetTimeSeriesServiceStatisticsOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *XRay) GetTimeSeriesServiceStatisticsPages(input *GetTimeSeriesServiceStatisticsInput, fn func(*GetTimeSeriesServiceStatisticsOutput, bool) bool) error {
+	return c.GetTimeSeriesServiceStatisticsPagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-// PutTelemetryRecordsRequest generates a "aws/request.Request" representing the
-// client's request for the PutTelemetryRecords operation. The "output" return
+// GetTimeSeriesServiceStatisticsPagesWithContext same as GetTimeSeriesServiceStatisticsPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *XRay) GetTimeSeriesServiceStatisticsPagesWithContext(ctx aws.Context, input *GetTimeSeriesServiceStatisticsInput, fn func(*GetTimeSeriesServiceStatisticsOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *GetTimeSeriesServiceStatisticsInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.GetTimeSeriesServiceStatisticsRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*GetTimeSeriesServiceStatisticsOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opGetTraceGraph = "GetTraceGraph"
+
+// GetTraceGraphRequest generates a "aws/request.Request" representing the
+// client's request for the GetTraceGraph operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See PutTelemetryRecords for more information on using the PutTelemetryRecords
+// See GetTraceGraph for more information on using the GetTraceGraph
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetTraceGraphRequest method.
+//	req, resp := client.GetTraceGraphRequest(params)
 //
-//    // Example sending a request using the PutTelemetryRecordsRequest method.
-//    req, resp := client.PutTelemetryRecordsRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/PutTelemetryRecords
-func (c *XRay) PutTelemetryRecordsRequest(input *PutTelemetryRecordsInput) (req *request.Request, output *PutTelemetryRecordsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetTraceGraph
+func (c *XRay) GetTraceGraphRequest(input *GetTraceGraphInput) (req *request.Request, output *GetTraceGraphOutput) {
 	op := &request.Operation{
-		Name:       opPutTelemetryRecords,
+		Name:       opGetTraceGraph,
 		HTTPMethod: "POST",
-		HTTPPath:   "/TelemetryRecords",
+		HTTPPath:   "/TraceGraph",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &PutTelemetryRecordsInput{}
+		input = &GetTraceGraphInput{}
 	}
 
-	output = &PutTelemetryRecordsOutput{}
+	output = &GetTraceGraphOutput{}
 	req = c.newRequest(op, input, output)
-	req.Handlers.Unmarshal.Swap(restjson.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
 	return
 }
 
-// PutTelemetryRecords API operation for AWS X-Ray.
+// GetTraceGraph API operation for AWS X-Ray.
 //
-// Used by the AWS X-Ray daemon to upload telemetry.
+// Retrieves a service graph for one or more specific trace IDs.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS X-Ray's
-// API operation PutTelemetryRecords for usage and error information.
+// API operation GetTraceGraph for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is missing required parameters or has invalid parameters.
+// Returned Error Types:
 //
-//   * ErrCodeThrottledException "ThrottledException"
-//   The request exceeds the maximum number of requests per second.
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/PutTelemetryRecords
-func (c *XRay) PutTelemetryRecords(input *PutTelemetryRecordsInput) (*PutTelemetryRecordsOutput, error) {
-	req, out := c.PutTelemetryRecordsRequest(input)
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetTraceGraph
+func (c *XRay) GetTraceGraph(input *GetTraceGraphInput) (*GetTraceGraphOutput, error) {
+	req, out := c.GetTraceGraphRequest(input)
 	return out, req.Send()
 }
 
-// PutTelemetryRecordsWithContext is the same as PutTelemetryRecords with the addition of
+// GetTraceGraphWithContext is the same as GetTraceGraph with the addition of
 // the ability to pass a context and additional request options.
 //
-// See PutTelemetryRecords for details on how to use this API operation.
+// See GetTraceGraph for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *XRay) PutTelemetryRecordsWithContext(ctx aws.Context, input *PutTelemetryRecordsInput, opts ...request.Option) (*PutTelemetryRecordsOutput, error) {
-	req, out := c.PutTelemetryRecordsRequest(input)
+func (c *XRay) GetTraceGraphWithContext(ctx aws.Context, input *GetTraceGraphInput, opts ...request.Option) (*GetTraceGraphOutput, error) {
+	req, out := c.GetTraceGraphRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opPutTraceSegments = "PutTraceSegments"
+// GetTraceGraphPages iterates over the pages of a GetTraceGraph operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See GetTraceGraph method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a GetTraceGraph operation.
+//	pageNum := 0
+//	err := client.GetTraceGraphPages(params,
+//	    func(page *xray.GetTraceGraphOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *XRay) GetTraceGraphPages(input *GetTraceGraphInput, fn func(*GetTraceGraphOutput, bool) bool) error {
+	return c.GetTraceGraphPagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-// PutTraceSegmentsRequest generates a "aws/request.Request" representing the
-// client's request for the PutTraceSegments operation. The "output" return
+// GetTraceGraphPagesWithContext same as GetTraceGraphPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *XRay) GetTraceGraphPagesWithContext(ctx aws.Context, input *GetTraceGraphInput, fn func(*GetTraceGraphOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *GetTraceGraphInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.GetTraceGraphRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*GetTraceGraphOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opGetTraceSummaries = "GetTraceSummaries"
+
+// GetTraceSummariesRequest generates a "aws/request.Request" representing the
+// client's request for the GetTraceSummaries operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See PutTraceSegments for more information on using the PutTraceSegments
+// See GetTraceSummaries for more information on using the GetTraceSummaries
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the GetTraceSummariesRequest method.
+//	req, resp := client.GetTraceSummariesRequest(params)
 //
-//    // Example sending a request using the PutTraceSegmentsRequest method.
-//    req, resp := client.PutTraceSegmentsRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/PutTraceSegments
-func (c *XRay) PutTraceSegmentsRequest(input *PutTraceSegmentsInput) (req *request.Request, output *PutTraceSegmentsOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetTraceSummaries
+func (c *XRay) GetTraceSummariesRequest(input *GetTraceSummariesInput) (req *request.Request, output *GetTraceSummariesOutput) {
 	op := &request.Operation{
-		Name:       opPutTraceSegments,
+		Name:       opGetTraceSummaries,
 		HTTPMethod: "POST",
-		HTTPPath:   "/TraceSegments",
+		HTTPPath:   "/TraceSummaries",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &PutTraceSegmentsInput{}
+		input = &GetTraceSummariesInput{}
 	}
 
-	output = &PutTraceSegmentsOutput{}
+	output = &GetTraceSummariesOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// PutTraceSegments API operation for AWS X-Ray.
-//
-// Uploads segment documents to AWS X-Ray. The X-Ray SDK generates segment documents
-// and sends them to the X-Ray daemon, which uploads them in batches. A segment
-// document can be a completed segment, an in-progress segment, or an array
-// of subsegments.
-//
-// Segments must include the following fields. For the full segment document
-// schema, see AWS X-Ray Segment Documents (https://docs.aws.amazon.com/xray/latest/devguide/xray-api-segmentdocuments.html)
-// in the AWS X-Ray Developer Guide.
-//
-// Required Segment Document Fields
-//
-//    * name - The name of the service that handled the request.
-//
-//    * id - A 64-bit identifier for the segment, unique among segments in the
-//    same trace, in 16 hexadecimal digits.
-//
-//    * trace_id - A unique identifier that connects all segments and subsegments
-//    originating from a single client request.
-//
-//    * start_time - Time the segment or subsegment was created, in floating
-//    point seconds in epoch time, accurate to milliseconds. For example, 1480615200.010
-//    or 1.480615200010E9.
-//
-//    * end_time - Time the segment or subsegment was closed. For example, 1480615200.090
-//    or 1.480615200090E9. Specify either an end_time or in_progress.
+// GetTraceSummaries API operation for AWS X-Ray.
 //
-//    * in_progress - Set to true instead of specifying an end_time to record
-//    that a segment has been started, but is not complete. Send an in progress
-//    segment when your application receives a request that will take a long
-//    time to serve, to trace the fact that the request was received. When the
-//    response is sent, send the complete segment to overwrite the in-progress
-//    segment.
+// Retrieves IDs and annotations for traces available for a specified time frame
+// using an optional filter. To get the full traces, pass the trace IDs to BatchGetTraces.
 //
-// A trace_id consists of three numbers separated by hyphens. For example, 1-58406520-a006649127e371903a2de979.
-// This includes:
+// A filter expression can target traced requests that hit specific service
+// nodes or edges, have errors, or come from a known user. For example, the
+// following filter expression targets traces that pass through api.example.com:
 //
-// Trace ID Format
+// service("api.example.com")
 //
-//    * The version number, i.e. 1.
+// This filter expression finds traces that have an annotation named account
+// with the value 12345:
 //
-//    * The time of the original request, in Unix epoch time, in 8 hexadecimal
-//    digits. For example, 10:00AM December 2nd, 2016 PST in epoch time is 1480615200
-//    seconds, or 58406520 in hexadecimal.
+// annotation.account = "12345"
 //
-//    * A 96-bit identifier for the trace, globally unique, in 24 hexadecimal
-//    digits.
+// For a full list of indexed fields and keywords that you can use in filter
+// expressions, see Using Filter Expressions (https://docs.aws.amazon.com/xray/latest/devguide/xray-console-filters.html)
+// in the Amazon Web Services X-Ray Developer Guide.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS X-Ray's
-// API operation PutTraceSegments for usage and error information.
+// API operation GetTraceSummaries for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is missing required parameters or has invalid parameters.
+// Returned Error Types:
 //
-//   * ErrCodeThrottledException "ThrottledException"
-//   The request exceeds the maximum number of requests per second.
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/PutTraceSegments
-func (c *XRay) PutTraceSegments(input *PutTraceSegmentsInput) (*PutTraceSegmentsOutput, error) {
-	req, out := c.PutTraceSegmentsRequest(input)
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/GetTraceSummaries
+func (c *XRay) GetTraceSummaries(input *GetTraceSummariesInput) (*GetTraceSummariesOutput, error) {
+	req, out := c.GetTraceSummariesRequest(input)
 	return out, req.Send()
 }
 
-// PutTraceSegmentsWithContext is the same as PutTraceSegments with the addition of
+// GetTraceSummariesWithContext is the same as GetTraceSummaries with the addition of
 // the ability to pass a context and additional request options.
 //
-// See PutTraceSegments for details on how to use this API operation.
+// See GetTraceSummaries for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *XRay) PutTraceSegmentsWithContext(ctx aws.Context, input *PutTraceSegmentsInput, opts ...request.Option) (*PutTraceSegmentsOutput, error) {
-	req, out := c.PutTraceSegmentsRequest(input)
+func (c *XRay) GetTraceSummariesWithContext(ctx aws.Context, input *GetTraceSummariesInput, opts ...request.Option) (*GetTraceSummariesOutput, error) {
+	req, out := c.GetTraceSummariesRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateGroup = "UpdateGroup"
-
-// UpdateGroupRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateGroup operation. The "output" return
-// value will be populated with the request's response once the request completes
-// successfully.
-//
-// Use "Send" method on the returned Request to send the API call to the service.
-// the "output" return value is not valid until after Send returns without error.
-//
-// See UpdateGroup for more information on using the UpdateGroup
-// API call, and error handling.
-//
-// This method is useful when you want to inject custom logic or configuration
-// into the SDK's request lifecycle. Such as custom headers, or retry logic.
-//
+// GetTraceSummariesPages iterates over the pages of a GetTraceSummaries operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
 //
-//    // Example sending a request using the UpdateGroupRequest method.
-//    req, resp := client.UpdateGroupRequest(params)
+// See GetTraceSummaries method for more information on how to use this operation.
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
+// Note: This operation can generate multiple requests to a service.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/UpdateGroup
-func (c *XRay) UpdateGroupRequest(input *UpdateGroupInput) (req *request.Request, output *UpdateGroupOutput) {
+//	// Example iterating over at most 3 pages of a GetTraceSummaries operation.
+//	pageNum := 0
+//	err := client.GetTraceSummariesPages(params,
+//	    func(page *xray.GetTraceSummariesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *XRay) GetTraceSummariesPages(input *GetTraceSummariesInput, fn func(*GetTraceSummariesOutput, bool) bool) error {
+	return c.GetTraceSummariesPagesWithContext(aws.BackgroundContext(), input, fn)
+}
+
+// GetTraceSummariesPagesWithContext same as GetTraceSummariesPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *XRay) GetTraceSummariesPagesWithContext(ctx aws.Context, input *GetTraceSummariesInput, fn func(*GetTraceSummariesOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *GetTraceSummariesInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.GetTraceSummariesRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*GetTraceSummariesOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListResourcePolicies = "ListResourcePolicies"
+
+// ListResourcePoliciesRequest generates a "aws/request.Request" representing the
+// client's request for the ListResourcePolicies operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See ListResourcePolicies for more information on using the ListResourcePolicies
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the ListResourcePoliciesRequest method.
+//	req, resp := client.ListResourcePoliciesRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/ListResourcePolicies
+func (c *XRay) ListResourcePoliciesRequest(input *ListResourcePoliciesInput) (req *request.Request, output *ListResourcePoliciesOutput) {
 	op := &request.Operation{
-		Name:       opUpdateGroup,
+		Name:       opListResourcePolicies,
 		HTTPMethod: "POST",
-		HTTPPath:   "/UpdateGroup",
+		HTTPPath:   "/ListResourcePolicies",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &UpdateGroupInput{}
+		input = &ListResourcePoliciesInput{}
 	}
 
-	output = &UpdateGroupOutput{}
+	output = &ListResourcePoliciesOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// UpdateGroup API operation for AWS X-Ray.
+// ListResourcePolicies API operation for AWS X-Ray.
 //
-// Updates a group resource.
+// Returns the list of resource policies in the target Amazon Web Services account.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS X-Ray's
-// API operation UpdateGroup for usage and error information.
+// API operation ListResourcePolicies for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is missing required parameters or has invalid parameters.
+// Returned Error Types:
 //
-//   * ErrCodeThrottledException "ThrottledException"
-//   The request exceeds the maximum number of requests per second.
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/UpdateGroup
-func (c *XRay) UpdateGroup(input *UpdateGroupInput) (*UpdateGroupOutput, error) {
-	req, out := c.UpdateGroupRequest(input)
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/ListResourcePolicies
+func (c *XRay) ListResourcePolicies(input *ListResourcePoliciesInput) (*ListResourcePoliciesOutput, error) {
+	req, out := c.ListResourcePoliciesRequest(input)
 	return out, req.Send()
 }
 
-// UpdateGroupWithContext is the same as UpdateGroup with the addition of
+// ListResourcePoliciesWithContext is the same as ListResourcePolicies with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateGroup for details on how to use this API operation.
+// See ListResourcePolicies for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *XRay) UpdateGroupWithContext(ctx aws.Context, input *UpdateGroupInput, opts ...request.Option) (*UpdateGroupOutput, error) {
-	req, out := c.UpdateGroupRequest(input)
+func (c *XRay) ListResourcePoliciesWithContext(ctx aws.Context, input *ListResourcePoliciesInput, opts ...request.Option) (*ListResourcePoliciesOutput, error) {
+	req, out := c.ListResourcePoliciesRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-const opUpdateSamplingRule = "UpdateSamplingRule"
+// ListResourcePoliciesPages iterates over the pages of a ListResourcePolicies operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListResourcePolicies method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListResourcePolicies operation.
+//	pageNum := 0
+//	err := client.ListResourcePoliciesPages(params,
+//	    func(page *xray.ListResourcePoliciesOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *XRay) ListResourcePoliciesPages(input *ListResourcePoliciesInput, fn func(*ListResourcePoliciesOutput, bool) bool) error {
+	return c.ListResourcePoliciesPagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-// UpdateSamplingRuleRequest generates a "aws/request.Request" representing the
-// client's request for the UpdateSamplingRule operation. The "output" return
+// ListResourcePoliciesPagesWithContext same as ListResourcePoliciesPages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *XRay) ListResourcePoliciesPagesWithContext(ctx aws.Context, input *ListResourcePoliciesInput, fn func(*ListResourcePoliciesOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListResourcePoliciesInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListResourcePoliciesRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+
+	for p.Next() {
+		if !fn(p.Page().(*ListResourcePoliciesOutput), !p.HasNextPage()) {
+			break
+		}
+	}
+
+	return p.Err()
+}
+
+const opListTagsForResource = "ListTagsForResource"
+
+// ListTagsForResourceRequest generates a "aws/request.Request" representing the
+// client's request for the ListTagsForResource operation. The "output" return
 // value will be populated with the request's response once the request completes
 // successfully.
 //
 // Use "Send" method on the returned Request to send the API call to the service.
 // the "output" return value is not valid until after Send returns without error.
 //
-// See UpdateSamplingRule for more information on using the UpdateSamplingRule
+// See ListTagsForResource for more information on using the ListTagsForResource
 // API call, and error handling.
 //
 // This method is useful when you want to inject custom logic or configuration
 // into the SDK's request lifecycle. Such as custom headers, or retry logic.
 //
+//	// Example sending a request using the ListTagsForResourceRequest method.
+//	req, resp := client.ListTagsForResourceRequest(params)
 //
-//    // Example sending a request using the UpdateSamplingRuleRequest method.
-//    req, resp := client.UpdateSamplingRuleRequest(params)
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
 //
-//    err := req.Send()
-//    if err == nil { // resp is now filled
-//        fmt.Println(resp)
-//    }
-//
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/UpdateSamplingRule
-func (c *XRay) UpdateSamplingRuleRequest(input *UpdateSamplingRuleInput) (req *request.Request, output *UpdateSamplingRuleOutput) {
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/ListTagsForResource
+func (c *XRay) ListTagsForResourceRequest(input *ListTagsForResourceInput) (req *request.Request, output *ListTagsForResourceOutput) {
 	op := &request.Operation{
-		Name:       opUpdateSamplingRule,
+		Name:       opListTagsForResource,
 		HTTPMethod: "POST",
-		HTTPPath:   "/UpdateSamplingRule",
+		HTTPPath:   "/ListTagsForResource",
+		Paginator: &request.Paginator{
+			InputTokens:     []string{"NextToken"},
+			OutputTokens:    []string{"NextToken"},
+			LimitToken:      "",
+			TruncationToken: "",
+		},
 	}
 
 	if input == nil {
-		input = &UpdateSamplingRuleInput{}
+		input = &ListTagsForResourceInput{}
 	}
 
-	output = &UpdateSamplingRuleOutput{}
+	output = &ListTagsForResourceOutput{}
 	req = c.newRequest(op, input, output)
 	return
 }
 
-// UpdateSamplingRule API operation for AWS X-Ray.
+// ListTagsForResource API operation for AWS X-Ray.
 //
-// Modifies a sampling rule's configuration.
+// Returns a list of tags that are applied to the specified Amazon Web Services
+// X-Ray group or sampling rule.
 //
 // Returns awserr.Error for service API and SDK errors. Use runtime type assertions
 // with awserr.Error's Code and Message methods to get detailed information about
 // the error.
 //
 // See the AWS API reference guide for AWS X-Ray's
-// API operation UpdateSamplingRule for usage and error information.
+// API operation ListTagsForResource for usage and error information.
 //
-// Returned Error Codes:
-//   * ErrCodeInvalidRequestException "InvalidRequestException"
-//   The request is missing required parameters or has invalid parameters.
+// Returned Error Types:
 //
-//   * ErrCodeThrottledException "ThrottledException"
-//   The request exceeds the maximum number of requests per second.
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
 //
-// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/UpdateSamplingRule
-func (c *XRay) UpdateSamplingRule(input *UpdateSamplingRuleInput) (*UpdateSamplingRuleOutput, error) {
-	req, out := c.UpdateSamplingRuleRequest(input)
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
+//
+//   - ResourceNotFoundException
+//     The resource was not found. Verify that the name or Amazon Resource Name
+//     (ARN) of the resource is correct.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/ListTagsForResource
+func (c *XRay) ListTagsForResource(input *ListTagsForResourceInput) (*ListTagsForResourceOutput, error) {
+	req, out := c.ListTagsForResourceRequest(input)
 	return out, req.Send()
 }
 
-// UpdateSamplingRuleWithContext is the same as UpdateSamplingRule with the addition of
+// ListTagsForResourceWithContext is the same as ListTagsForResource with the addition of
 // the ability to pass a context and additional request options.
 //
-// See UpdateSamplingRule for details on how to use this API operation.
+// See ListTagsForResource for details on how to use this API operation.
 //
 // The context must be non-nil and will be used for request cancellation. If
 // the context is nil a panic will occur. In the future the SDK may create
 // sub-contexts for http.Requests. See https://golang.org/pkg/context/
 // for more information on using Contexts.
-func (c *XRay) UpdateSamplingRuleWithContext(ctx aws.Context, input *UpdateSamplingRuleInput, opts ...request.Option) (*UpdateSamplingRuleOutput, error) {
-	req, out := c.UpdateSamplingRuleRequest(input)
+func (c *XRay) ListTagsForResourceWithContext(ctx aws.Context, input *ListTagsForResourceInput, opts ...request.Option) (*ListTagsForResourceOutput, error) {
+	req, out := c.ListTagsForResourceRequest(input)
 	req.SetContext(ctx)
 	req.ApplyOptions(opts...)
 	return out, req.Send()
 }
 
-// An alias for an edge.
-type Alias struct {
-	_ struct{} `type:"structure"`
+// ListTagsForResourcePages iterates over the pages of a ListTagsForResource operation,
+// calling the "fn" function with the response data for each page. To stop
+// iterating, return false from the fn function.
+//
+// See ListTagsForResource method for more information on how to use this operation.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//	// Example iterating over at most 3 pages of a ListTagsForResource operation.
+//	pageNum := 0
+//	err := client.ListTagsForResourcePages(params,
+//	    func(page *xray.ListTagsForResourceOutput, lastPage bool) bool {
+//	        pageNum++
+//	        fmt.Println(page)
+//	        return pageNum <= 3
+//	    })
+func (c *XRay) ListTagsForResourcePages(input *ListTagsForResourceInput, fn func(*ListTagsForResourceOutput, bool) bool) error {
+	return c.ListTagsForResourcePagesWithContext(aws.BackgroundContext(), input, fn)
+}
 
-	// The canonical name of the alias.
-	Name *string `type:"string"`
+// ListTagsForResourcePagesWithContext same as ListTagsForResourcePages except
+// it takes a Context and allows setting request options on the pages.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *XRay) ListTagsForResourcePagesWithContext(ctx aws.Context, input *ListTagsForResourceInput, fn func(*ListTagsForResourceOutput, bool) bool, opts ...request.Option) error {
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			var inCpy *ListTagsForResourceInput
+			if input != nil {
+				tmp := *input
+				inCpy = &tmp
+			}
+			req, _ := c.ListTagsForResourceRequest(inCpy)
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
 
-	// A list of names for the alias, including the canonical name.
-	Names []*string `type:"list"`
+	for p.Next() {
+		if !fn(p.Page().(*ListTagsForResourceOutput), !p.HasNextPage()) {
+			break
+		}
+	}
 
-	// The type of the alias.
-	Type *string `type:"string"`
+	return p.Err()
 }
 
-// String returns the string representation
-func (s Alias) String() string {
-	return awsutil.Prettify(s)
-}
+const opPutEncryptionConfig = "PutEncryptionConfig"
 
-// GoString returns the string representation
-func (s Alias) GoString() string {
-	return s.String()
-}
+// PutEncryptionConfigRequest generates a "aws/request.Request" representing the
+// client's request for the PutEncryptionConfig operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See PutEncryptionConfig for more information on using the PutEncryptionConfig
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the PutEncryptionConfigRequest method.
+//	req, resp := client.PutEncryptionConfigRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/PutEncryptionConfig
+func (c *XRay) PutEncryptionConfigRequest(input *PutEncryptionConfigInput) (req *request.Request, output *PutEncryptionConfigOutput) {
+	op := &request.Operation{
+		Name:       opPutEncryptionConfig,
+		HTTPMethod: "POST",
+		HTTPPath:   "/PutEncryptionConfig",
+	}
 
-// SetName sets the Name field's value.
-func (s *Alias) SetName(v string) *Alias {
-	s.Name = &v
-	return s
-}
+	if input == nil {
+		input = &PutEncryptionConfigInput{}
+	}
 
-// SetNames sets the Names field's value.
-func (s *Alias) SetNames(v []*string) *Alias {
-	s.Names = v
-	return s
+	output = &PutEncryptionConfigOutput{}
+	req = c.newRequest(op, input, output)
+	return
 }
 
-// SetType sets the Type field's value.
-func (s *Alias) SetType(v string) *Alias {
-	s.Type = &v
-	return s
+// PutEncryptionConfig API operation for AWS X-Ray.
+//
+// Updates the encryption configuration for X-Ray data.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS X-Ray's
+// API operation PutEncryptionConfig for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
+//
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/PutEncryptionConfig
+func (c *XRay) PutEncryptionConfig(input *PutEncryptionConfigInput) (*PutEncryptionConfigOutput, error) {
+	req, out := c.PutEncryptionConfigRequest(input)
+	return out, req.Send()
 }
 
-// Value of a segment annotation. Has one of three value types: Number, Boolean
-// or String.
-type AnnotationValue struct {
-	_ struct{} `type:"structure"`
-
-	// Value for a Boolean annotation.
-	BooleanValue *bool `type:"boolean"`
+// PutEncryptionConfigWithContext is the same as PutEncryptionConfig with the addition of
+// the ability to pass a context and additional request options.
+//
+// See PutEncryptionConfig for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *XRay) PutEncryptionConfigWithContext(ctx aws.Context, input *PutEncryptionConfigInput, opts ...request.Option) (*PutEncryptionConfigOutput, error) {
+	req, out := c.PutEncryptionConfigRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
 
-	// Value for a Number annotation.
-	NumberValue *float64 `type:"double"`
+const opPutResourcePolicy = "PutResourcePolicy"
 
-	// Value for a String annotation.
+// PutResourcePolicyRequest generates a "aws/request.Request" representing the
+// client's request for the PutResourcePolicy operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See PutResourcePolicy for more information on using the PutResourcePolicy
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the PutResourcePolicyRequest method.
+//	req, resp := client.PutResourcePolicyRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/PutResourcePolicy
+func (c *XRay) PutResourcePolicyRequest(input *PutResourcePolicyInput) (req *request.Request, output *PutResourcePolicyOutput) {
+	op := &request.Operation{
+		Name:       opPutResourcePolicy,
+		HTTPMethod: "POST",
+		HTTPPath:   "/PutResourcePolicy",
+	}
+
+	if input == nil {
+		input = &PutResourcePolicyInput{}
+	}
+
+	output = &PutResourcePolicyOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// PutResourcePolicy API operation for AWS X-Ray.
+//
+// Sets the resource policy to grant one or more Amazon Web Services services
+// and accounts permissions to access X-Ray. Each resource policy will be associated
+// with a specific Amazon Web Services account. Each Amazon Web Services account
+// can have a maximum of 5 resource policies, and each policy name must be unique
+// within that account. The maximum size of each resource policy is 5KB.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS X-Ray's
+// API operation PutResourcePolicy for usage and error information.
+//
+// Returned Error Types:
+//
+//   - MalformedPolicyDocumentException
+//     Invalid policy document provided in request.
+//
+//   - LockoutPreventionException
+//     The provided resource policy would prevent the caller of this request from
+//     calling PutResourcePolicy in the future.
+//
+//   - InvalidPolicyRevisionIdException
+//     A policy revision id was provided which does not match the latest policy
+//     revision. This exception is also if a policy revision id of 0 is provided
+//     via PutResourcePolicy and a policy with the same name already exists.
+//
+//   - PolicySizeLimitExceededException
+//     Exceeded the maximum size for a resource policy.
+//
+//   - PolicyCountLimitExceededException
+//     Exceeded the maximum number of resource policies for a target Amazon Web
+//     Services account.
+//
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/PutResourcePolicy
+func (c *XRay) PutResourcePolicy(input *PutResourcePolicyInput) (*PutResourcePolicyOutput, error) {
+	req, out := c.PutResourcePolicyRequest(input)
+	return out, req.Send()
+}
+
+// PutResourcePolicyWithContext is the same as PutResourcePolicy with the addition of
+// the ability to pass a context and additional request options.
+//
+// See PutResourcePolicy for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *XRay) PutResourcePolicyWithContext(ctx aws.Context, input *PutResourcePolicyInput, opts ...request.Option) (*PutResourcePolicyOutput, error) {
+	req, out := c.PutResourcePolicyRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opPutTelemetryRecords = "PutTelemetryRecords"
+
+// PutTelemetryRecordsRequest generates a "aws/request.Request" representing the
+// client's request for the PutTelemetryRecords operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See PutTelemetryRecords for more information on using the PutTelemetryRecords
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the PutTelemetryRecordsRequest method.
+//	req, resp := client.PutTelemetryRecordsRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/PutTelemetryRecords
+func (c *XRay) PutTelemetryRecordsRequest(input *PutTelemetryRecordsInput) (req *request.Request, output *PutTelemetryRecordsOutput) {
+	op := &request.Operation{
+		Name:       opPutTelemetryRecords,
+		HTTPMethod: "POST",
+		HTTPPath:   "/TelemetryRecords",
+	}
+
+	if input == nil {
+		input = &PutTelemetryRecordsInput{}
+	}
+
+	output = &PutTelemetryRecordsOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(restjson.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// PutTelemetryRecords API operation for AWS X-Ray.
+//
+// Used by the Amazon Web Services X-Ray daemon to upload telemetry.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS X-Ray's
+// API operation PutTelemetryRecords for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
+//
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/PutTelemetryRecords
+func (c *XRay) PutTelemetryRecords(input *PutTelemetryRecordsInput) (*PutTelemetryRecordsOutput, error) {
+	req, out := c.PutTelemetryRecordsRequest(input)
+	return out, req.Send()
+}
+
+// PutTelemetryRecordsWithContext is the same as PutTelemetryRecords with the addition of
+// the ability to pass a context and additional request options.
+//
+// See PutTelemetryRecords for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *XRay) PutTelemetryRecordsWithContext(ctx aws.Context, input *PutTelemetryRecordsInput, opts ...request.Option) (*PutTelemetryRecordsOutput, error) {
+	req, out := c.PutTelemetryRecordsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opPutTraceSegments = "PutTraceSegments"
+
+// PutTraceSegmentsRequest generates a "aws/request.Request" representing the
+// client's request for the PutTraceSegments operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See PutTraceSegments for more information on using the PutTraceSegments
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the PutTraceSegmentsRequest method.
+//	req, resp := client.PutTraceSegmentsRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/PutTraceSegments
+func (c *XRay) PutTraceSegmentsRequest(input *PutTraceSegmentsInput) (req *request.Request, output *PutTraceSegmentsOutput) {
+	op := &request.Operation{
+		Name:       opPutTraceSegments,
+		HTTPMethod: "POST",
+		HTTPPath:   "/TraceSegments",
+	}
+
+	if input == nil {
+		input = &PutTraceSegmentsInput{}
+	}
+
+	output = &PutTraceSegmentsOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// PutTraceSegments API operation for AWS X-Ray.
+//
+// Uploads segment documents to Amazon Web Services X-Ray. The X-Ray SDK (https://docs.aws.amazon.com/xray/index.html)
+// generates segment documents and sends them to the X-Ray daemon, which uploads
+// them in batches. A segment document can be a completed segment, an in-progress
+// segment, or an array of subsegments.
+//
+// Segments must include the following fields. For the full segment document
+// schema, see Amazon Web Services X-Ray Segment Documents (https://docs.aws.amazon.com/xray/latest/devguide/xray-api-segmentdocuments.html)
+// in the Amazon Web Services X-Ray Developer Guide.
+//
+// Required segment document fields
+//
+//   - name - The name of the service that handled the request.
+//
+//   - id - A 64-bit identifier for the segment, unique among segments in the
+//     same trace, in 16 hexadecimal digits.
+//
+//   - trace_id - A unique identifier that connects all segments and subsegments
+//     originating from a single client request.
+//
+//   - start_time - Time the segment or subsegment was created, in floating
+//     point seconds in epoch time, accurate to milliseconds. For example, 1480615200.010
+//     or 1.480615200010E9.
+//
+//   - end_time - Time the segment or subsegment was closed. For example, 1480615200.090
+//     or 1.480615200090E9. Specify either an end_time or in_progress.
+//
+//   - in_progress - Set to true instead of specifying an end_time to record
+//     that a segment has been started, but is not complete. Send an in-progress
+//     segment when your application receives a request that will take a long
+//     time to serve, to trace that the request was received. When the response
+//     is sent, send the complete segment to overwrite the in-progress segment.
+//
+// A trace_id consists of three numbers separated by hyphens. For example, 1-58406520-a006649127e371903a2de979.
+// This includes:
+//
+// Trace ID Format
+//
+//   - The version number, for instance, 1.
+//
+//   - The time of the original request, in Unix epoch time, in 8 hexadecimal
+//     digits. For example, 10:00AM December 2nd, 2016 PST in epoch time is 1480615200
+//     seconds, or 58406520 in hexadecimal.
+//
+//   - A 96-bit identifier for the trace, globally unique, in 24 hexadecimal
+//     digits.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS X-Ray's
+// API operation PutTraceSegments for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
+//
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/PutTraceSegments
+func (c *XRay) PutTraceSegments(input *PutTraceSegmentsInput) (*PutTraceSegmentsOutput, error) {
+	req, out := c.PutTraceSegmentsRequest(input)
+	return out, req.Send()
+}
+
+// PutTraceSegmentsWithContext is the same as PutTraceSegments with the addition of
+// the ability to pass a context and additional request options.
+//
+// See PutTraceSegments for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *XRay) PutTraceSegmentsWithContext(ctx aws.Context, input *PutTraceSegmentsInput, opts ...request.Option) (*PutTraceSegmentsOutput, error) {
+	req, out := c.PutTraceSegmentsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opTagResource = "TagResource"
+
+// TagResourceRequest generates a "aws/request.Request" representing the
+// client's request for the TagResource operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See TagResource for more information on using the TagResource
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the TagResourceRequest method.
+//	req, resp := client.TagResourceRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/TagResource
+func (c *XRay) TagResourceRequest(input *TagResourceInput) (req *request.Request, output *TagResourceOutput) {
+	op := &request.Operation{
+		Name:       opTagResource,
+		HTTPMethod: "POST",
+		HTTPPath:   "/TagResource",
+	}
+
+	if input == nil {
+		input = &TagResourceInput{}
+	}
+
+	output = &TagResourceOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(restjson.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// TagResource API operation for AWS X-Ray.
+//
+// Applies tags to an existing Amazon Web Services X-Ray group or sampling rule.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS X-Ray's
+// API operation TagResource for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
+//
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
+//
+//   - ResourceNotFoundException
+//     The resource was not found. Verify that the name or Amazon Resource Name
+//     (ARN) of the resource is correct.
+//
+//   - TooManyTagsException
+//     You have exceeded the maximum number of tags you can apply to this resource.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/TagResource
+func (c *XRay) TagResource(input *TagResourceInput) (*TagResourceOutput, error) {
+	req, out := c.TagResourceRequest(input)
+	return out, req.Send()
+}
+
+// TagResourceWithContext is the same as TagResource with the addition of
+// the ability to pass a context and additional request options.
+//
+// See TagResource for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *XRay) TagResourceWithContext(ctx aws.Context, input *TagResourceInput, opts ...request.Option) (*TagResourceOutput, error) {
+	req, out := c.TagResourceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUntagResource = "UntagResource"
+
+// UntagResourceRequest generates a "aws/request.Request" representing the
+// client's request for the UntagResource operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UntagResource for more information on using the UntagResource
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UntagResourceRequest method.
+//	req, resp := client.UntagResourceRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/UntagResource
+func (c *XRay) UntagResourceRequest(input *UntagResourceInput) (req *request.Request, output *UntagResourceOutput) {
+	op := &request.Operation{
+		Name:       opUntagResource,
+		HTTPMethod: "POST",
+		HTTPPath:   "/UntagResource",
+	}
+
+	if input == nil {
+		input = &UntagResourceInput{}
+	}
+
+	output = &UntagResourceOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Swap(restjson.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	return
+}
+
+// UntagResource API operation for AWS X-Ray.
+//
+// Removes tags from an Amazon Web Services X-Ray group or sampling rule. You
+// cannot edit or delete system tags (those with an aws: prefix).
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS X-Ray's
+// API operation UntagResource for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
+//
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
+//
+//   - ResourceNotFoundException
+//     The resource was not found. Verify that the name or Amazon Resource Name
+//     (ARN) of the resource is correct.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/UntagResource
+func (c *XRay) UntagResource(input *UntagResourceInput) (*UntagResourceOutput, error) {
+	req, out := c.UntagResourceRequest(input)
+	return out, req.Send()
+}
+
+// UntagResourceWithContext is the same as UntagResource with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UntagResource for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *XRay) UntagResourceWithContext(ctx aws.Context, input *UntagResourceInput, opts ...request.Option) (*UntagResourceOutput, error) {
+	req, out := c.UntagResourceRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateGroup = "UpdateGroup"
+
+// UpdateGroupRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateGroup operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateGroup for more information on using the UpdateGroup
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateGroupRequest method.
+//	req, resp := client.UpdateGroupRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/UpdateGroup
+func (c *XRay) UpdateGroupRequest(input *UpdateGroupInput) (req *request.Request, output *UpdateGroupOutput) {
+	op := &request.Operation{
+		Name:       opUpdateGroup,
+		HTTPMethod: "POST",
+		HTTPPath:   "/UpdateGroup",
+	}
+
+	if input == nil {
+		input = &UpdateGroupInput{}
+	}
+
+	output = &UpdateGroupOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UpdateGroup API operation for AWS X-Ray.
+//
+// Updates a group resource.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS X-Ray's
+// API operation UpdateGroup for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
+//
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/UpdateGroup
+func (c *XRay) UpdateGroup(input *UpdateGroupInput) (*UpdateGroupOutput, error) {
+	req, out := c.UpdateGroupRequest(input)
+	return out, req.Send()
+}
+
+// UpdateGroupWithContext is the same as UpdateGroup with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateGroup for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *XRay) UpdateGroupWithContext(ctx aws.Context, input *UpdateGroupInput, opts ...request.Option) (*UpdateGroupOutput, error) {
+	req, out := c.UpdateGroupRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+const opUpdateSamplingRule = "UpdateSamplingRule"
+
+// UpdateSamplingRuleRequest generates a "aws/request.Request" representing the
+// client's request for the UpdateSamplingRule operation. The "output" return
+// value will be populated with the request's response once the request completes
+// successfully.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See UpdateSamplingRule for more information on using the UpdateSamplingRule
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//	// Example sending a request using the UpdateSamplingRuleRequest method.
+//	req, resp := client.UpdateSamplingRuleRequest(params)
+//
+//	err := req.Send()
+//	if err == nil { // resp is now filled
+//	    fmt.Println(resp)
+//	}
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/UpdateSamplingRule
+func (c *XRay) UpdateSamplingRuleRequest(input *UpdateSamplingRuleInput) (req *request.Request, output *UpdateSamplingRuleOutput) {
+	op := &request.Operation{
+		Name:       opUpdateSamplingRule,
+		HTTPMethod: "POST",
+		HTTPPath:   "/UpdateSamplingRule",
+	}
+
+	if input == nil {
+		input = &UpdateSamplingRuleInput{}
+	}
+
+	output = &UpdateSamplingRuleOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// UpdateSamplingRule API operation for AWS X-Ray.
+//
+// Modifies a sampling rule's configuration.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for AWS X-Ray's
+// API operation UpdateSamplingRule for usage and error information.
+//
+// Returned Error Types:
+//
+//   - InvalidRequestException
+//     The request is missing required parameters or has invalid parameters.
+//
+//   - ThrottledException
+//     The request exceeds the maximum number of requests per second.
+//
+// See also, https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12/UpdateSamplingRule
+func (c *XRay) UpdateSamplingRule(input *UpdateSamplingRuleInput) (*UpdateSamplingRuleOutput, error) {
+	req, out := c.UpdateSamplingRuleRequest(input)
+	return out, req.Send()
+}
+
+// UpdateSamplingRuleWithContext is the same as UpdateSamplingRule with the addition of
+// the ability to pass a context and additional request options.
+//
+// See UpdateSamplingRule for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *XRay) UpdateSamplingRuleWithContext(ctx aws.Context, input *UpdateSamplingRuleInput, opts ...request.Option) (*UpdateSamplingRuleOutput, error) {
+	req, out := c.UpdateSamplingRuleRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
+// An alias for an edge.
+type Alias struct {
+	_ struct{} `type:"structure"`
+
+	// The canonical name of the alias.
+	Name *string `type:"string"`
+
+	// A list of names for the alias, including the canonical name.
+	Names []*string `type:"list"`
+
+	// The type of the alias.
+	Type *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Alias) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Alias) GoString() string {
+	return s.String()
+}
+
+// SetName sets the Name field's value.
+func (s *Alias) SetName(v string) *Alias {
+	s.Name = &v
+	return s
+}
+
+// SetNames sets the Names field's value.
+func (s *Alias) SetNames(v []*string) *Alias {
+	s.Names = v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *Alias) SetType(v string) *Alias {
+	s.Type = &v
+	return s
+}
+
+// Value of a segment annotation. Has one of three value types: Number, Boolean,
+// or String.
+type AnnotationValue struct {
+	_ struct{} `type:"structure"`
+
+	// Value for a Boolean annotation.
+	BooleanValue *bool `type:"boolean"`
+
+	// Value for a Number annotation.
+	NumberValue *float64 `type:"double"`
+
+	// Value for a String annotation.
 	StringValue *string `type:"string"`
 }
 
-// String returns the string representation
-func (s AnnotationValue) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AnnotationValue) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AnnotationValue) GoString() string {
+	return s.String()
+}
+
+// SetBooleanValue sets the BooleanValue field's value.
+func (s *AnnotationValue) SetBooleanValue(v bool) *AnnotationValue {
+	s.BooleanValue = &v
+	return s
+}
+
+// SetNumberValue sets the NumberValue field's value.
+func (s *AnnotationValue) SetNumberValue(v float64) *AnnotationValue {
+	s.NumberValue = &v
+	return s
+}
+
+// SetStringValue sets the StringValue field's value.
+func (s *AnnotationValue) SetStringValue(v string) *AnnotationValue {
+	s.StringValue = &v
+	return s
+}
+
+// The service within the service graph that has anomalously high fault rates.
+type AnomalousService struct {
+	_ struct{} `type:"structure"`
+
+	ServiceId *ServiceId `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AnomalousService) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AnomalousService) GoString() string {
+	return s.String()
+}
+
+// SetServiceId sets the ServiceId field's value.
+func (s *AnomalousService) SetServiceId(v *ServiceId) *AnomalousService {
+	s.ServiceId = v
+	return s
+}
+
+// A list of Availability Zones corresponding to the segments in a trace.
+type AvailabilityZoneDetail struct {
+	_ struct{} `type:"structure"`
+
+	// The name of a corresponding Availability Zone.
+	Name *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AvailabilityZoneDetail) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s AvailabilityZoneDetail) GoString() string {
+	return s.String()
+}
+
+// SetName sets the Name field's value.
+func (s *AvailabilityZoneDetail) SetName(v string) *AvailabilityZoneDetail {
+	s.Name = &v
+	return s
+}
+
+type BackendConnectionErrors struct {
+	_ struct{} `type:"structure"`
+
+	ConnectionRefusedCount *int64 `type:"integer"`
+
+	HTTPCode4XXCount *int64 `type:"integer"`
+
+	HTTPCode5XXCount *int64 `type:"integer"`
+
+	OtherCount *int64 `type:"integer"`
+
+	TimeoutCount *int64 `type:"integer"`
+
+	UnknownHostCount *int64 `type:"integer"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BackendConnectionErrors) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BackendConnectionErrors) GoString() string {
+	return s.String()
+}
+
+// SetConnectionRefusedCount sets the ConnectionRefusedCount field's value.
+func (s *BackendConnectionErrors) SetConnectionRefusedCount(v int64) *BackendConnectionErrors {
+	s.ConnectionRefusedCount = &v
+	return s
+}
+
+// SetHTTPCode4XXCount sets the HTTPCode4XXCount field's value.
+func (s *BackendConnectionErrors) SetHTTPCode4XXCount(v int64) *BackendConnectionErrors {
+	s.HTTPCode4XXCount = &v
+	return s
+}
+
+// SetHTTPCode5XXCount sets the HTTPCode5XXCount field's value.
+func (s *BackendConnectionErrors) SetHTTPCode5XXCount(v int64) *BackendConnectionErrors {
+	s.HTTPCode5XXCount = &v
+	return s
+}
+
+// SetOtherCount sets the OtherCount field's value.
+func (s *BackendConnectionErrors) SetOtherCount(v int64) *BackendConnectionErrors {
+	s.OtherCount = &v
+	return s
+}
+
+// SetTimeoutCount sets the TimeoutCount field's value.
+func (s *BackendConnectionErrors) SetTimeoutCount(v int64) *BackendConnectionErrors {
+	s.TimeoutCount = &v
+	return s
+}
+
+// SetUnknownHostCount sets the UnknownHostCount field's value.
+func (s *BackendConnectionErrors) SetUnknownHostCount(v int64) *BackendConnectionErrors {
+	s.UnknownHostCount = &v
+	return s
+}
+
+type BatchGetTracesInput struct {
+	_ struct{} `type:"structure"`
+
+	// Pagination token.
+	NextToken *string `type:"string"`
+
+	// Specify the trace IDs of requests for which to retrieve segments.
+	//
+	// TraceIds is a required field
+	TraceIds []*string `type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetTracesInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetTracesInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *BatchGetTracesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchGetTracesInput"}
+	if s.TraceIds == nil {
+		invalidParams.Add(request.NewErrParamRequired("TraceIds"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *BatchGetTracesInput) SetNextToken(v string) *BatchGetTracesInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetTraceIds sets the TraceIds field's value.
+func (s *BatchGetTracesInput) SetTraceIds(v []*string) *BatchGetTracesInput {
+	s.TraceIds = v
+	return s
+}
+
+type BatchGetTracesOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Pagination token.
+	NextToken *string `type:"string"`
+
+	// Full traces for the specified requests.
+	Traces []*Trace `type:"list"`
+
+	// Trace IDs of requests that haven't been processed.
+	UnprocessedTraceIds []*string `type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetTracesOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s BatchGetTracesOutput) GoString() string {
+	return s.String()
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *BatchGetTracesOutput) SetNextToken(v string) *BatchGetTracesOutput {
+	s.NextToken = &v
+	return s
+}
+
+// SetTraces sets the Traces field's value.
+func (s *BatchGetTracesOutput) SetTraces(v []*Trace) *BatchGetTracesOutput {
+	s.Traces = v
+	return s
+}
+
+// SetUnprocessedTraceIds sets the UnprocessedTraceIds field's value.
+func (s *BatchGetTracesOutput) SetUnprocessedTraceIds(v []*string) *BatchGetTracesOutput {
+	s.UnprocessedTraceIds = v
+	return s
+}
+
+type CreateGroupInput struct {
+	_ struct{} `type:"structure"`
+
+	// The filter expression defining criteria by which to group traces.
+	FilterExpression *string `type:"string"`
+
+	// The case-sensitive name of the new group. Default is a reserved name and
+	// names must be unique.
+	//
+	// GroupName is a required field
+	GroupName *string `min:"1" type:"string" required:"true"`
+
+	// The structure containing configurations related to insights.
+	//
+	//    * The InsightsEnabled boolean can be set to true to enable insights for
+	//    the new group or false to disable insights for the new group.
+	//
+	//    * The NotificationsEnabled boolean can be set to true to enable insights
+	//    notifications for the new group. Notifications may only be enabled on
+	//    a group with InsightsEnabled set to true.
+	InsightsConfiguration *InsightsConfiguration `type:"structure"`
+
+	// A map that contains one or more tag keys and tag values to attach to an X-Ray
+	// group. For more information about ways to use tags, see Tagging Amazon Web
+	// Services resources (https://docs.aws.amazon.com/general/latest/gr/aws_tagging.html)
+	// in the Amazon Web Services General Reference.
+	//
+	// The following restrictions apply to tags:
+	//
+	//    * Maximum number of user-applied tags per resource: 50
+	//
+	//    * Maximum tag key length: 128 Unicode characters
+	//
+	//    * Maximum tag value length: 256 Unicode characters
+	//
+	//    * Valid values for key and value: a-z, A-Z, 0-9, space, and the following
+	//    characters: _ . : / = + - and @
+	//
+	//    * Tag keys and values are case sensitive.
+	//
+	//    * Don't use aws: as a prefix for keys; it's reserved for Amazon Web Services
+	//    use.
+	Tags []*Tag `type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateGroupInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateGroupInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateGroupInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateGroupInput"}
+	if s.GroupName == nil {
+		invalidParams.Add(request.NewErrParamRequired("GroupName"))
+	}
+	if s.GroupName != nil && len(*s.GroupName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("GroupName", 1))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetFilterExpression sets the FilterExpression field's value.
+func (s *CreateGroupInput) SetFilterExpression(v string) *CreateGroupInput {
+	s.FilterExpression = &v
+	return s
+}
+
+// SetGroupName sets the GroupName field's value.
+func (s *CreateGroupInput) SetGroupName(v string) *CreateGroupInput {
+	s.GroupName = &v
+	return s
+}
+
+// SetInsightsConfiguration sets the InsightsConfiguration field's value.
+func (s *CreateGroupInput) SetInsightsConfiguration(v *InsightsConfiguration) *CreateGroupInput {
+	s.InsightsConfiguration = v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *CreateGroupInput) SetTags(v []*Tag) *CreateGroupInput {
+	s.Tags = v
+	return s
+}
+
+type CreateGroupOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The group that was created. Contains the name of the group that was created,
+	// the Amazon Resource Name (ARN) of the group that was generated based on the
+	// group name, the filter expression, and the insight configuration that was
+	// assigned to the group.
+	Group *Group `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateGroupOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateGroupOutput) GoString() string {
+	return s.String()
+}
+
+// SetGroup sets the Group field's value.
+func (s *CreateGroupOutput) SetGroup(v *Group) *CreateGroupOutput {
+	s.Group = v
+	return s
+}
+
+type CreateSamplingRuleInput struct {
+	_ struct{} `type:"structure"`
+
+	// The rule definition.
+	//
+	// SamplingRule is a required field
+	SamplingRule *SamplingRule `type:"structure" required:"true"`
+
+	// A map that contains one or more tag keys and tag values to attach to an X-Ray
+	// sampling rule. For more information about ways to use tags, see Tagging Amazon
+	// Web Services resources (https://docs.aws.amazon.com/general/latest/gr/aws_tagging.html)
+	// in the Amazon Web Services General Reference.
+	//
+	// The following restrictions apply to tags:
+	//
+	//    * Maximum number of user-applied tags per resource: 50
+	//
+	//    * Maximum tag key length: 128 Unicode characters
+	//
+	//    * Maximum tag value length: 256 Unicode characters
+	//
+	//    * Valid values for key and value: a-z, A-Z, 0-9, space, and the following
+	//    characters: _ . : / = + - and @
+	//
+	//    * Tag keys and values are case sensitive.
+	//
+	//    * Don't use aws: as a prefix for keys; it's reserved for Amazon Web Services
+	//    use.
+	Tags []*Tag `type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateSamplingRuleInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateSamplingRuleInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *CreateSamplingRuleInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateSamplingRuleInput"}
+	if s.SamplingRule == nil {
+		invalidParams.Add(request.NewErrParamRequired("SamplingRule"))
+	}
+	if s.SamplingRule != nil {
+		if err := s.SamplingRule.Validate(); err != nil {
+			invalidParams.AddNested("SamplingRule", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetSamplingRule sets the SamplingRule field's value.
+func (s *CreateSamplingRuleInput) SetSamplingRule(v *SamplingRule) *CreateSamplingRuleInput {
+	s.SamplingRule = v
+	return s
+}
+
+// SetTags sets the Tags field's value.
+func (s *CreateSamplingRuleInput) SetTags(v []*Tag) *CreateSamplingRuleInput {
+	s.Tags = v
+	return s
+}
+
+type CreateSamplingRuleOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The saved rule definition and metadata.
+	SamplingRuleRecord *SamplingRuleRecord `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateSamplingRuleOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s CreateSamplingRuleOutput) GoString() string {
+	return s.String()
+}
+
+// SetSamplingRuleRecord sets the SamplingRuleRecord field's value.
+func (s *CreateSamplingRuleOutput) SetSamplingRuleRecord(v *SamplingRuleRecord) *CreateSamplingRuleOutput {
+	s.SamplingRuleRecord = v
+	return s
+}
+
+type DeleteGroupInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the group that was generated on creation.
+	GroupARN *string `min:"1" type:"string"`
+
+	// The case-sensitive name of the group.
+	GroupName *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteGroupInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteGroupInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteGroupInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteGroupInput"}
+	if s.GroupARN != nil && len(*s.GroupARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("GroupARN", 1))
+	}
+	if s.GroupName != nil && len(*s.GroupName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("GroupName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetGroupARN sets the GroupARN field's value.
+func (s *DeleteGroupInput) SetGroupARN(v string) *DeleteGroupInput {
+	s.GroupARN = &v
+	return s
+}
+
+// SetGroupName sets the GroupName field's value.
+func (s *DeleteGroupInput) SetGroupName(v string) *DeleteGroupInput {
+	s.GroupName = &v
+	return s
+}
+
+type DeleteGroupOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteGroupOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteGroupOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteResourcePolicyInput struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the resource policy to delete.
+	//
+	// PolicyName is a required field
+	PolicyName *string `min:"1" type:"string" required:"true"`
+
+	// Specifies a specific policy revision to delete. Provide a PolicyRevisionId
+	// to ensure an atomic delete operation. If the provided revision id does not
+	// match the latest policy revision id, an InvalidPolicyRevisionIdException
+	// exception is returned.
+	PolicyRevisionId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteResourcePolicyInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteResourcePolicyInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DeleteResourcePolicyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteResourcePolicyInput"}
+	if s.PolicyName == nil {
+		invalidParams.Add(request.NewErrParamRequired("PolicyName"))
+	}
+	if s.PolicyName != nil && len(*s.PolicyName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("PolicyName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetPolicyName sets the PolicyName field's value.
+func (s *DeleteResourcePolicyInput) SetPolicyName(v string) *DeleteResourcePolicyInput {
+	s.PolicyName = &v
+	return s
+}
+
+// SetPolicyRevisionId sets the PolicyRevisionId field's value.
+func (s *DeleteResourcePolicyInput) SetPolicyRevisionId(v string) *DeleteResourcePolicyInput {
+	s.PolicyRevisionId = &v
+	return s
+}
+
+type DeleteResourcePolicyOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteResourcePolicyOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteResourcePolicyOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteSamplingRuleInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the sampling rule. Specify a rule by either name or ARN, but not
+	// both.
+	RuleARN *string `type:"string"`
+
+	// The name of the sampling rule. Specify a rule by either name or ARN, but
+	// not both.
+	RuleName *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteSamplingRuleInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteSamplingRuleInput) GoString() string {
+	return s.String()
+}
+
+// SetRuleARN sets the RuleARN field's value.
+func (s *DeleteSamplingRuleInput) SetRuleARN(v string) *DeleteSamplingRuleInput {
+	s.RuleARN = &v
+	return s
+}
+
+// SetRuleName sets the RuleName field's value.
+func (s *DeleteSamplingRuleInput) SetRuleName(v string) *DeleteSamplingRuleInput {
+	s.RuleName = &v
+	return s
+}
+
+type DeleteSamplingRuleOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The deleted rule definition and metadata.
+	SamplingRuleRecord *SamplingRuleRecord `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteSamplingRuleOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s DeleteSamplingRuleOutput) GoString() string {
+	return s.String()
+}
+
+// SetSamplingRuleRecord sets the SamplingRuleRecord field's value.
+func (s *DeleteSamplingRuleOutput) SetSamplingRuleRecord(v *SamplingRuleRecord) *DeleteSamplingRuleOutput {
+	s.SamplingRuleRecord = v
+	return s
+}
+
+// Information about a connection between two services. An edge can be a synchronous
+// connection, such as typical call between client and service, or an asynchronous
+// link, such as a Lambda function which retrieves an event from an SNS queue.
+type Edge struct {
+	_ struct{} `type:"structure"`
+
+	// Aliases for the edge.
+	Aliases []*Alias `type:"list"`
+
+	// Describes an asynchronous connection, with a value of link.
+	EdgeType *string `type:"string"`
+
+	// The end time of the last segment on the edge.
+	EndTime *time.Time `type:"timestamp"`
+
+	// A histogram that maps the spread of event age when received by consumers.
+	// Age is calculated each time an event is received. Only populated when EdgeType
+	// is link.
+	ReceivedEventAgeHistogram []*HistogramEntry `type:"list"`
+
+	// Identifier of the edge. Unique within a service map.
+	ReferenceId *int64 `type:"integer"`
+
+	// A histogram that maps the spread of client response times on an edge. Only
+	// populated for synchronous edges.
+	ResponseTimeHistogram []*HistogramEntry `type:"list"`
+
+	// The start time of the first segment on the edge.
+	StartTime *time.Time `type:"timestamp"`
+
+	// Response statistics for segments on the edge.
+	SummaryStatistics *EdgeStatistics `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Edge) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Edge) GoString() string {
+	return s.String()
+}
+
+// SetAliases sets the Aliases field's value.
+func (s *Edge) SetAliases(v []*Alias) *Edge {
+	s.Aliases = v
+	return s
+}
+
+// SetEdgeType sets the EdgeType field's value.
+func (s *Edge) SetEdgeType(v string) *Edge {
+	s.EdgeType = &v
+	return s
+}
+
+// SetEndTime sets the EndTime field's value.
+func (s *Edge) SetEndTime(v time.Time) *Edge {
+	s.EndTime = &v
+	return s
+}
+
+// SetReceivedEventAgeHistogram sets the ReceivedEventAgeHistogram field's value.
+func (s *Edge) SetReceivedEventAgeHistogram(v []*HistogramEntry) *Edge {
+	s.ReceivedEventAgeHistogram = v
+	return s
+}
+
+// SetReferenceId sets the ReferenceId field's value.
+func (s *Edge) SetReferenceId(v int64) *Edge {
+	s.ReferenceId = &v
+	return s
+}
+
+// SetResponseTimeHistogram sets the ResponseTimeHistogram field's value.
+func (s *Edge) SetResponseTimeHistogram(v []*HistogramEntry) *Edge {
+	s.ResponseTimeHistogram = v
+	return s
+}
+
+// SetStartTime sets the StartTime field's value.
+func (s *Edge) SetStartTime(v time.Time) *Edge {
+	s.StartTime = &v
+	return s
+}
+
+// SetSummaryStatistics sets the SummaryStatistics field's value.
+func (s *Edge) SetSummaryStatistics(v *EdgeStatistics) *Edge {
+	s.SummaryStatistics = v
+	return s
+}
+
+// Response statistics for an edge.
+type EdgeStatistics struct {
+	_ struct{} `type:"structure"`
+
+	// Information about requests that failed with a 4xx Client Error status code.
+	ErrorStatistics *ErrorStatistics `type:"structure"`
+
+	// Information about requests that failed with a 5xx Server Error status code.
+	FaultStatistics *FaultStatistics `type:"structure"`
+
+	// The number of requests that completed with a 2xx Success status code.
+	OkCount *int64 `type:"long"`
+
+	// The total number of completed requests.
+	TotalCount *int64 `type:"long"`
+
+	// The aggregate response time of completed requests.
+	TotalResponseTime *float64 `type:"double"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EdgeStatistics) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EdgeStatistics) GoString() string {
+	return s.String()
+}
+
+// SetErrorStatistics sets the ErrorStatistics field's value.
+func (s *EdgeStatistics) SetErrorStatistics(v *ErrorStatistics) *EdgeStatistics {
+	s.ErrorStatistics = v
+	return s
+}
+
+// SetFaultStatistics sets the FaultStatistics field's value.
+func (s *EdgeStatistics) SetFaultStatistics(v *FaultStatistics) *EdgeStatistics {
+	s.FaultStatistics = v
+	return s
+}
+
+// SetOkCount sets the OkCount field's value.
+func (s *EdgeStatistics) SetOkCount(v int64) *EdgeStatistics {
+	s.OkCount = &v
+	return s
+}
+
+// SetTotalCount sets the TotalCount field's value.
+func (s *EdgeStatistics) SetTotalCount(v int64) *EdgeStatistics {
+	s.TotalCount = &v
+	return s
+}
+
+// SetTotalResponseTime sets the TotalResponseTime field's value.
+func (s *EdgeStatistics) SetTotalResponseTime(v float64) *EdgeStatistics {
+	s.TotalResponseTime = &v
+	return s
+}
+
+// A configuration document that specifies encryption configuration settings.
+type EncryptionConfig struct {
+	_ struct{} `type:"structure"`
+
+	// The ID of the KMS key used for encryption, if applicable.
+	KeyId *string `type:"string"`
+
+	// The encryption status. While the status is UPDATING, X-Ray may encrypt data
+	// with a combination of the new and old settings.
+	Status *string `type:"string" enum:"EncryptionStatus"`
+
+	// The type of encryption. Set to KMS for encryption with KMS keys. Set to NONE
+	// for default encryption.
+	Type *string `type:"string" enum:"EncryptionType"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EncryptionConfig) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s EncryptionConfig) GoString() string {
+	return s.String()
+}
+
+// SetKeyId sets the KeyId field's value.
+func (s *EncryptionConfig) SetKeyId(v string) *EncryptionConfig {
+	s.KeyId = &v
+	return s
+}
+
+// SetStatus sets the Status field's value.
+func (s *EncryptionConfig) SetStatus(v string) *EncryptionConfig {
+	s.Status = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *EncryptionConfig) SetType(v string) *EncryptionConfig {
+	s.Type = &v
+	return s
+}
+
+// The root cause of a trace summary error.
+type ErrorRootCause struct {
+	_ struct{} `type:"structure"`
+
+	// A flag that denotes that the root cause impacts the trace client.
+	ClientImpacting *bool `type:"boolean"`
+
+	// A list of services corresponding to an error. A service identifies a segment
+	// and it contains a name, account ID, type, and inferred flag.
+	Services []*ErrorRootCauseService `type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ErrorRootCause) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ErrorRootCause) GoString() string {
+	return s.String()
+}
+
+// SetClientImpacting sets the ClientImpacting field's value.
+func (s *ErrorRootCause) SetClientImpacting(v bool) *ErrorRootCause {
+	s.ClientImpacting = &v
+	return s
+}
+
+// SetServices sets the Services field's value.
+func (s *ErrorRootCause) SetServices(v []*ErrorRootCauseService) *ErrorRootCause {
+	s.Services = v
+	return s
+}
+
+// A collection of segments and corresponding subsegments associated to a trace
+// summary error.
+type ErrorRootCauseEntity struct {
+	_ struct{} `type:"structure"`
+
+	// The types and messages of the exceptions.
+	Exceptions []*RootCauseException `type:"list"`
+
+	// The name of the entity.
+	Name *string `type:"string"`
+
+	// A flag that denotes a remote subsegment.
+	Remote *bool `type:"boolean"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ErrorRootCauseEntity) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ErrorRootCauseEntity) GoString() string {
+	return s.String()
+}
+
+// SetExceptions sets the Exceptions field's value.
+func (s *ErrorRootCauseEntity) SetExceptions(v []*RootCauseException) *ErrorRootCauseEntity {
+	s.Exceptions = v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *ErrorRootCauseEntity) SetName(v string) *ErrorRootCauseEntity {
+	s.Name = &v
+	return s
+}
+
+// SetRemote sets the Remote field's value.
+func (s *ErrorRootCauseEntity) SetRemote(v bool) *ErrorRootCauseEntity {
+	s.Remote = &v
+	return s
+}
+
+// A collection of fields identifying the services in a trace summary error.
+type ErrorRootCauseService struct {
+	_ struct{} `type:"structure"`
+
+	// The account ID associated to the service.
+	AccountId *string `type:"string"`
+
+	// The path of root cause entities found on the service.
+	EntityPath []*ErrorRootCauseEntity `type:"list"`
+
+	// A Boolean value indicating if the service is inferred from the trace.
+	Inferred *bool `type:"boolean"`
+
+	// The service name.
+	Name *string `type:"string"`
+
+	// A collection of associated service names.
+	Names []*string `type:"list"`
+
+	// The type associated to the service.
+	Type *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ErrorRootCauseService) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ErrorRootCauseService) GoString() string {
+	return s.String()
+}
+
+// SetAccountId sets the AccountId field's value.
+func (s *ErrorRootCauseService) SetAccountId(v string) *ErrorRootCauseService {
+	s.AccountId = &v
+	return s
+}
+
+// SetEntityPath sets the EntityPath field's value.
+func (s *ErrorRootCauseService) SetEntityPath(v []*ErrorRootCauseEntity) *ErrorRootCauseService {
+	s.EntityPath = v
+	return s
+}
+
+// SetInferred sets the Inferred field's value.
+func (s *ErrorRootCauseService) SetInferred(v bool) *ErrorRootCauseService {
+	s.Inferred = &v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *ErrorRootCauseService) SetName(v string) *ErrorRootCauseService {
+	s.Name = &v
+	return s
+}
+
+// SetNames sets the Names field's value.
+func (s *ErrorRootCauseService) SetNames(v []*string) *ErrorRootCauseService {
+	s.Names = v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *ErrorRootCauseService) SetType(v string) *ErrorRootCauseService {
+	s.Type = &v
+	return s
+}
+
+// Information about requests that failed with a 4xx Client Error status code.
+type ErrorStatistics struct {
+	_ struct{} `type:"structure"`
+
+	// The number of requests that failed with untracked 4xx Client Error status
+	// codes.
+	OtherCount *int64 `type:"long"`
+
+	// The number of requests that failed with a 419 throttling status code.
+	ThrottleCount *int64 `type:"long"`
+
+	// The total number of requests that failed with a 4xx Client Error status code.
+	TotalCount *int64 `type:"long"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ErrorStatistics) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ErrorStatistics) GoString() string {
+	return s.String()
+}
+
+// SetOtherCount sets the OtherCount field's value.
+func (s *ErrorStatistics) SetOtherCount(v int64) *ErrorStatistics {
+	s.OtherCount = &v
+	return s
+}
+
+// SetThrottleCount sets the ThrottleCount field's value.
+func (s *ErrorStatistics) SetThrottleCount(v int64) *ErrorStatistics {
+	s.ThrottleCount = &v
+	return s
+}
+
+// SetTotalCount sets the TotalCount field's value.
+func (s *ErrorStatistics) SetTotalCount(v int64) *ErrorStatistics {
+	s.TotalCount = &v
+	return s
+}
+
+// The root cause information for a trace summary fault.
+type FaultRootCause struct {
+	_ struct{} `type:"structure"`
+
+	// A flag that denotes that the root cause impacts the trace client.
+	ClientImpacting *bool `type:"boolean"`
+
+	// A list of corresponding services. A service identifies a segment and it contains
+	// a name, account ID, type, and inferred flag.
+	Services []*FaultRootCauseService `type:"list"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s FaultRootCause) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s FaultRootCause) GoString() string {
+	return s.String()
+}
+
+// SetClientImpacting sets the ClientImpacting field's value.
+func (s *FaultRootCause) SetClientImpacting(v bool) *FaultRootCause {
+	s.ClientImpacting = &v
+	return s
+}
+
+// SetServices sets the Services field's value.
+func (s *FaultRootCause) SetServices(v []*FaultRootCauseService) *FaultRootCause {
+	s.Services = v
+	return s
+}
+
+// A collection of segments and corresponding subsegments associated to a trace
+// summary fault error.
+type FaultRootCauseEntity struct {
+	_ struct{} `type:"structure"`
+
+	// The types and messages of the exceptions.
+	Exceptions []*RootCauseException `type:"list"`
+
+	// The name of the entity.
+	Name *string `type:"string"`
+
+	// A flag that denotes a remote subsegment.
+	Remote *bool `type:"boolean"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s FaultRootCauseEntity) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s FaultRootCauseEntity) GoString() string {
+	return s.String()
+}
+
+// SetExceptions sets the Exceptions field's value.
+func (s *FaultRootCauseEntity) SetExceptions(v []*RootCauseException) *FaultRootCauseEntity {
+	s.Exceptions = v
+	return s
+}
+
+// SetName sets the Name field's value.
+func (s *FaultRootCauseEntity) SetName(v string) *FaultRootCauseEntity {
+	s.Name = &v
+	return s
+}
+
+// SetRemote sets the Remote field's value.
+func (s *FaultRootCauseEntity) SetRemote(v bool) *FaultRootCauseEntity {
+	s.Remote = &v
+	return s
+}
+
+// A collection of fields identifying the services in a trace summary fault.
+type FaultRootCauseService struct {
+	_ struct{} `type:"structure"`
+
+	// The account ID associated to the service.
+	AccountId *string `type:"string"`
+
+	// The path of root cause entities found on the service.
+	EntityPath []*FaultRootCauseEntity `type:"list"`
+
+	// A Boolean value indicating if the service is inferred from the trace.
+	Inferred *bool `type:"boolean"`
+
+	// The service name.
+	Name *string `type:"string"`
+
+	// A collection of associated service names.
+	Names []*string `type:"list"`
+
+	// The type associated to the service.
+	Type *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s FaultRootCauseService) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s AnnotationValue) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s FaultRootCauseService) GoString() string {
 	return s.String()
 }
 
-// SetBooleanValue sets the BooleanValue field's value.
-func (s *AnnotationValue) SetBooleanValue(v bool) *AnnotationValue {
-	s.BooleanValue = &v
+// SetAccountId sets the AccountId field's value.
+func (s *FaultRootCauseService) SetAccountId(v string) *FaultRootCauseService {
+	s.AccountId = &v
 	return s
 }
 
-// SetNumberValue sets the NumberValue field's value.
-func (s *AnnotationValue) SetNumberValue(v float64) *AnnotationValue {
-	s.NumberValue = &v
+// SetEntityPath sets the EntityPath field's value.
+func (s *FaultRootCauseService) SetEntityPath(v []*FaultRootCauseEntity) *FaultRootCauseService {
+	s.EntityPath = v
 	return s
 }
 
-// SetStringValue sets the StringValue field's value.
-func (s *AnnotationValue) SetStringValue(v string) *AnnotationValue {
-	s.StringValue = &v
+// SetInferred sets the Inferred field's value.
+func (s *FaultRootCauseService) SetInferred(v bool) *FaultRootCauseService {
+	s.Inferred = &v
 	return s
 }
 
-// A list of availability zones corresponding to the segments in a trace.
-type AvailabilityZoneDetail struct {
+// SetName sets the Name field's value.
+func (s *FaultRootCauseService) SetName(v string) *FaultRootCauseService {
+	s.Name = &v
+	return s
+}
+
+// SetNames sets the Names field's value.
+func (s *FaultRootCauseService) SetNames(v []*string) *FaultRootCauseService {
+	s.Names = v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *FaultRootCauseService) SetType(v string) *FaultRootCauseService {
+	s.Type = &v
+	return s
+}
+
+// Information about requests that failed with a 5xx Server Error status code.
+type FaultStatistics struct {
 	_ struct{} `type:"structure"`
 
-	// The name of a corresponding availability zone.
-	Name *string `type:"string"`
+	// The number of requests that failed with untracked 5xx Server Error status
+	// codes.
+	OtherCount *int64 `type:"long"`
+
+	// The total number of requests that failed with a 5xx Server Error status code.
+	TotalCount *int64 `type:"long"`
 }
 
-// String returns the string representation
-func (s AvailabilityZoneDetail) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s FaultStatistics) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s AvailabilityZoneDetail) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s FaultStatistics) GoString() string {
 	return s.String()
 }
 
-// SetName sets the Name field's value.
-func (s *AvailabilityZoneDetail) SetName(v string) *AvailabilityZoneDetail {
-	s.Name = &v
+// SetOtherCount sets the OtherCount field's value.
+func (s *FaultStatistics) SetOtherCount(v int64) *FaultStatistics {
+	s.OtherCount = &v
 	return s
 }
 
-type BackendConnectionErrors struct {
+// SetTotalCount sets the TotalCount field's value.
+func (s *FaultStatistics) SetTotalCount(v int64) *FaultStatistics {
+	s.TotalCount = &v
+	return s
+}
+
+// The predicted high and low fault count. This is used to determine if a service
+// has become anomalous and if an insight should be created.
+type ForecastStatistics struct {
 	_ struct{} `type:"structure"`
 
-	ConnectionRefusedCount *int64 `type:"integer"`
+	// The upper limit of fault counts for a service.
+	FaultCountHigh *int64 `type:"long"`
 
-	HTTPCode4XXCount *int64 `type:"integer"`
+	// The lower limit of fault counts for a service.
+	FaultCountLow *int64 `type:"long"`
+}
 
-	HTTPCode5XXCount *int64 `type:"integer"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ForecastStatistics) String() string {
+	return awsutil.Prettify(s)
+}
 
-	OtherCount *int64 `type:"integer"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ForecastStatistics) GoString() string {
+	return s.String()
+}
 
-	TimeoutCount *int64 `type:"integer"`
+// SetFaultCountHigh sets the FaultCountHigh field's value.
+func (s *ForecastStatistics) SetFaultCountHigh(v int64) *ForecastStatistics {
+	s.FaultCountHigh = &v
+	return s
+}
 
-	UnknownHostCount *int64 `type:"integer"`
+// SetFaultCountLow sets the FaultCountLow field's value.
+func (s *ForecastStatistics) SetFaultCountLow(v int64) *ForecastStatistics {
+	s.FaultCountLow = &v
+	return s
 }
 
-// String returns the string representation
-func (s BackendConnectionErrors) String() string {
+type GetEncryptionConfigInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetEncryptionConfigInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetEncryptionConfigInput) GoString() string {
+	return s.String()
+}
+
+type GetEncryptionConfigOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The encryption configuration document.
+	EncryptionConfig *EncryptionConfig `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetEncryptionConfigOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetEncryptionConfigOutput) GoString() string {
+	return s.String()
+}
+
+// SetEncryptionConfig sets the EncryptionConfig field's value.
+func (s *GetEncryptionConfigOutput) SetEncryptionConfig(v *EncryptionConfig) *GetEncryptionConfigOutput {
+	s.EncryptionConfig = v
+	return s
+}
+
+type GetGroupInput struct {
+	_ struct{} `type:"structure"`
+
+	// The ARN of the group that was generated on creation.
+	GroupARN *string `min:"1" type:"string"`
+
+	// The case-sensitive name of the group.
+	GroupName *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetGroupInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetGroupInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetGroupInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetGroupInput"}
+	if s.GroupARN != nil && len(*s.GroupARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("GroupARN", 1))
+	}
+	if s.GroupName != nil && len(*s.GroupName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("GroupName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetGroupARN sets the GroupARN field's value.
+func (s *GetGroupInput) SetGroupARN(v string) *GetGroupInput {
+	s.GroupARN = &v
+	return s
+}
+
+// SetGroupName sets the GroupName field's value.
+func (s *GetGroupInput) SetGroupName(v string) *GetGroupInput {
+	s.GroupName = &v
+	return s
+}
+
+type GetGroupOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The group that was requested. Contains the name of the group, the ARN of
+	// the group, the filter expression, and the insight configuration assigned
+	// to the group.
+	Group *Group `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetGroupOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetGroupOutput) GoString() string {
+	return s.String()
+}
+
+// SetGroup sets the Group field's value.
+func (s *GetGroupOutput) SetGroup(v *Group) *GetGroupOutput {
+	s.Group = v
+	return s
+}
+
+type GetGroupsInput struct {
+	_ struct{} `type:"structure"`
+
+	// Pagination token.
+	NextToken *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetGroupsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s BackendConnectionErrors) GoString() string {
-	return s.String()
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetGroupsInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetGroupsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetGroupsInput"}
+	if s.NextToken != nil && len(*s.NextToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetConnectionRefusedCount sets the ConnectionRefusedCount field's value.
-func (s *BackendConnectionErrors) SetConnectionRefusedCount(v int64) *BackendConnectionErrors {
-	s.ConnectionRefusedCount = &v
+// SetNextToken sets the NextToken field's value.
+func (s *GetGroupsInput) SetNextToken(v string) *GetGroupsInput {
+	s.NextToken = &v
 	return s
 }
 
-// SetHTTPCode4XXCount sets the HTTPCode4XXCount field's value.
-func (s *BackendConnectionErrors) SetHTTPCode4XXCount(v int64) *BackendConnectionErrors {
-	s.HTTPCode4XXCount = &v
-	return s
+type GetGroupsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The collection of all active groups.
+	Groups []*GroupSummary `type:"list"`
+
+	// Pagination token.
+	NextToken *string `type:"string"`
 }
 
-// SetHTTPCode5XXCount sets the HTTPCode5XXCount field's value.
-func (s *BackendConnectionErrors) SetHTTPCode5XXCount(v int64) *BackendConnectionErrors {
-	s.HTTPCode5XXCount = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetGroupsOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetOtherCount sets the OtherCount field's value.
-func (s *BackendConnectionErrors) SetOtherCount(v int64) *BackendConnectionErrors {
-	s.OtherCount = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetGroupsOutput) GoString() string {
+	return s.String()
 }
 
-// SetTimeoutCount sets the TimeoutCount field's value.
-func (s *BackendConnectionErrors) SetTimeoutCount(v int64) *BackendConnectionErrors {
-	s.TimeoutCount = &v
+// SetGroups sets the Groups field's value.
+func (s *GetGroupsOutput) SetGroups(v []*GroupSummary) *GetGroupsOutput {
+	s.Groups = v
 	return s
 }
 
-// SetUnknownHostCount sets the UnknownHostCount field's value.
-func (s *BackendConnectionErrors) SetUnknownHostCount(v int64) *BackendConnectionErrors {
-	s.UnknownHostCount = &v
+// SetNextToken sets the NextToken field's value.
+func (s *GetGroupsOutput) SetNextToken(v string) *GetGroupsOutput {
+	s.NextToken = &v
 	return s
 }
 
-type BatchGetTracesInput struct {
+type GetInsightEventsInput struct {
 	_ struct{} `type:"structure"`
 
-	// Pagination token. Not used.
-	NextToken *string `type:"string"`
-
-	// Specify the trace IDs of requests for which to retrieve segments.
+	// The insight's unique identifier. Use the GetInsightSummaries action to retrieve
+	// an InsightId.
 	//
-	// TraceIds is a required field
-	TraceIds []*string `type:"list" required:"true"`
+	// InsightId is a required field
+	InsightId *string `type:"string" required:"true"`
+
+	// Used to retrieve at most the specified value of events.
+	MaxResults *int64 `min:"1" type:"integer"`
+
+	// Specify the pagination token returned by a previous request to retrieve the
+	// next page of events.
+	NextToken *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s BatchGetTracesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetInsightEventsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s BatchGetTracesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetInsightEventsInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *BatchGetTracesInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "BatchGetTracesInput"}
-	if s.TraceIds == nil {
-		invalidParams.Add(request.NewErrParamRequired("TraceIds"))
+func (s *GetInsightEventsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetInsightEventsInput"}
+	if s.InsightId == nil {
+		invalidParams.Add(request.NewErrParamRequired("InsightId"))
+	}
+	if s.MaxResults != nil && *s.MaxResults < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+	}
+	if s.NextToken != nil && len(*s.NextToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -2385,90 +5102,125 @@ func (s *BatchGetTracesInput) Validate() error {
 	return nil
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *BatchGetTracesInput) SetNextToken(v string) *BatchGetTracesInput {
-	s.NextToken = &v
+// SetInsightId sets the InsightId field's value.
+func (s *GetInsightEventsInput) SetInsightId(v string) *GetInsightEventsInput {
+	s.InsightId = &v
 	return s
 }
 
-// SetTraceIds sets the TraceIds field's value.
-func (s *BatchGetTracesInput) SetTraceIds(v []*string) *BatchGetTracesInput {
-	s.TraceIds = v
+// SetMaxResults sets the MaxResults field's value.
+func (s *GetInsightEventsInput) SetMaxResults(v int64) *GetInsightEventsInput {
+	s.MaxResults = &v
 	return s
 }
 
-type BatchGetTracesOutput struct {
-	_ struct{} `type:"structure"`
+// SetNextToken sets the NextToken field's value.
+func (s *GetInsightEventsInput) SetNextToken(v string) *GetInsightEventsInput {
+	s.NextToken = &v
+	return s
+}
 
-	// Pagination token. Not used.
-	NextToken *string `type:"string"`
+type GetInsightEventsOutput struct {
+	_ struct{} `type:"structure"`
 
-	// Full traces for the specified requests.
-	Traces []*Trace `type:"list"`
+	// A detailed description of the event. This includes the time of the event,
+	// client and root cause impact statistics, and the top anomalous service at
+	// the time of the event.
+	InsightEvents []*InsightEvent `type:"list"`
 
-	// Trace IDs of requests that haven't been processed.
-	UnprocessedTraceIds []*string `type:"list"`
+	// Use this token to retrieve the next page of insight events.
+	NextToken *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s BatchGetTracesOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetInsightEventsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s BatchGetTracesOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetInsightEventsOutput) GoString() string {
 	return s.String()
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *BatchGetTracesOutput) SetNextToken(v string) *BatchGetTracesOutput {
-	s.NextToken = &v
-	return s
-}
-
-// SetTraces sets the Traces field's value.
-func (s *BatchGetTracesOutput) SetTraces(v []*Trace) *BatchGetTracesOutput {
-	s.Traces = v
+// SetInsightEvents sets the InsightEvents field's value.
+func (s *GetInsightEventsOutput) SetInsightEvents(v []*InsightEvent) *GetInsightEventsOutput {
+	s.InsightEvents = v
 	return s
 }
 
-// SetUnprocessedTraceIds sets the UnprocessedTraceIds field's value.
-func (s *BatchGetTracesOutput) SetUnprocessedTraceIds(v []*string) *BatchGetTracesOutput {
-	s.UnprocessedTraceIds = v
+// SetNextToken sets the NextToken field's value.
+func (s *GetInsightEventsOutput) SetNextToken(v string) *GetInsightEventsOutput {
+	s.NextToken = &v
 	return s
 }
 
-type CreateGroupInput struct {
+type GetInsightImpactGraphInput struct {
 	_ struct{} `type:"structure"`
 
-	// The filter expression defining criteria by which to group traces.
-	FilterExpression *string `type:"string"`
+	// The estimated end time of the insight, in Unix time seconds. The EndTime
+	// is exclusive of the value provided. The time range between the start time
+	// and end time can't be more than six hours.
+	//
+	// EndTime is a required field
+	EndTime *time.Time `type:"timestamp" required:"true"`
 
-	// The case-sensitive name of the new group. Default is a reserved name and
-	// names must be unique.
+	// The insight's unique identifier. Use the GetInsightSummaries action to retrieve
+	// an InsightId.
 	//
-	// GroupName is a required field
-	GroupName *string `min:"1" type:"string" required:"true"`
+	// InsightId is a required field
+	InsightId *string `type:"string" required:"true"`
+
+	// Specify the pagination token returned by a previous request to retrieve the
+	// next page of results.
+	NextToken *string `min:"1" type:"string"`
+
+	// The estimated start time of the insight, in Unix time seconds. The StartTime
+	// is inclusive of the value provided and can't be more than 30 days old.
+	//
+	// StartTime is a required field
+	StartTime *time.Time `type:"timestamp" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateGroupInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetInsightImpactGraphInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateGroupInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetInsightImpactGraphInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateGroupInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateGroupInput"}
-	if s.GroupName == nil {
-		invalidParams.Add(request.NewErrParamRequired("GroupName"))
+func (s *GetInsightImpactGraphInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetInsightImpactGraphInput"}
+	if s.EndTime == nil {
+		invalidParams.Add(request.NewErrParamRequired("EndTime"))
 	}
-	if s.GroupName != nil && len(*s.GroupName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("GroupName", 1))
+	if s.InsightId == nil {
+		invalidParams.Add(request.NewErrParamRequired("InsightId"))
+	}
+	if s.NextToken != nil && len(*s.NextToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	}
+	if s.StartTime == nil {
+		invalidParams.Add(request.NewErrParamRequired("StartTime"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -2477,72 +5229,148 @@ func (s *CreateGroupInput) Validate() error {
 	return nil
 }
 
-// SetFilterExpression sets the FilterExpression field's value.
-func (s *CreateGroupInput) SetFilterExpression(v string) *CreateGroupInput {
-	s.FilterExpression = &v
+// SetEndTime sets the EndTime field's value.
+func (s *GetInsightImpactGraphInput) SetEndTime(v time.Time) *GetInsightImpactGraphInput {
+	s.EndTime = &v
 	return s
 }
 
-// SetGroupName sets the GroupName field's value.
-func (s *CreateGroupInput) SetGroupName(v string) *CreateGroupInput {
-	s.GroupName = &v
+// SetInsightId sets the InsightId field's value.
+func (s *GetInsightImpactGraphInput) SetInsightId(v string) *GetInsightImpactGraphInput {
+	s.InsightId = &v
 	return s
 }
 
-type CreateGroupOutput struct {
+// SetNextToken sets the NextToken field's value.
+func (s *GetInsightImpactGraphInput) SetNextToken(v string) *GetInsightImpactGraphInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetStartTime sets the StartTime field's value.
+func (s *GetInsightImpactGraphInput) SetStartTime(v time.Time) *GetInsightImpactGraphInput {
+	s.StartTime = &v
+	return s
+}
+
+type GetInsightImpactGraphOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The group that was created. Contains the name of the group that was created,
-	// the ARN of the group that was generated based on the group name, and the
-	// filter expression that was assigned to the group.
-	Group *Group `type:"structure"`
+	// The provided end time.
+	EndTime *time.Time `type:"timestamp"`
+
+	// The insight's unique identifier.
+	InsightId *string `type:"string"`
+
+	// Pagination token.
+	NextToken *string `min:"1" type:"string"`
+
+	// The time, in Unix seconds, at which the service graph ended.
+	ServiceGraphEndTime *time.Time `type:"timestamp"`
+
+	// The time, in Unix seconds, at which the service graph started.
+	ServiceGraphStartTime *time.Time `type:"timestamp"`
+
+	// The Amazon Web Services instrumented services related to the insight.
+	Services []*InsightImpactGraphService `type:"list"`
+
+	// The provided start time.
+	StartTime *time.Time `type:"timestamp"`
 }
 
-// String returns the string representation
-func (s CreateGroupOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetInsightImpactGraphOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateGroupOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetInsightImpactGraphOutput) GoString() string {
 	return s.String()
 }
 
-// SetGroup sets the Group field's value.
-func (s *CreateGroupOutput) SetGroup(v *Group) *CreateGroupOutput {
-	s.Group = v
+// SetEndTime sets the EndTime field's value.
+func (s *GetInsightImpactGraphOutput) SetEndTime(v time.Time) *GetInsightImpactGraphOutput {
+	s.EndTime = &v
 	return s
 }
 
-type CreateSamplingRuleInput struct {
+// SetInsightId sets the InsightId field's value.
+func (s *GetInsightImpactGraphOutput) SetInsightId(v string) *GetInsightImpactGraphOutput {
+	s.InsightId = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *GetInsightImpactGraphOutput) SetNextToken(v string) *GetInsightImpactGraphOutput {
+	s.NextToken = &v
+	return s
+}
+
+// SetServiceGraphEndTime sets the ServiceGraphEndTime field's value.
+func (s *GetInsightImpactGraphOutput) SetServiceGraphEndTime(v time.Time) *GetInsightImpactGraphOutput {
+	s.ServiceGraphEndTime = &v
+	return s
+}
+
+// SetServiceGraphStartTime sets the ServiceGraphStartTime field's value.
+func (s *GetInsightImpactGraphOutput) SetServiceGraphStartTime(v time.Time) *GetInsightImpactGraphOutput {
+	s.ServiceGraphStartTime = &v
+	return s
+}
+
+// SetServices sets the Services field's value.
+func (s *GetInsightImpactGraphOutput) SetServices(v []*InsightImpactGraphService) *GetInsightImpactGraphOutput {
+	s.Services = v
+	return s
+}
+
+// SetStartTime sets the StartTime field's value.
+func (s *GetInsightImpactGraphOutput) SetStartTime(v time.Time) *GetInsightImpactGraphOutput {
+	s.StartTime = &v
+	return s
+}
+
+type GetInsightInput struct {
 	_ struct{} `type:"structure"`
 
-	// The rule definition.
+	// The insight's unique identifier. Use the GetInsightSummaries action to retrieve
+	// an InsightId.
 	//
-	// SamplingRule is a required field
-	SamplingRule *SamplingRule `type:"structure" required:"true"`
+	// InsightId is a required field
+	InsightId *string `type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s CreateSamplingRuleInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetInsightInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateSamplingRuleInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetInsightInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *CreateSamplingRuleInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "CreateSamplingRuleInput"}
-	if s.SamplingRule == nil {
-		invalidParams.Add(request.NewErrParamRequired("SamplingRule"))
-	}
-	if s.SamplingRule != nil {
-		if err := s.SamplingRule.Validate(); err != nil {
-			invalidParams.AddNested("SamplingRule", err.(request.ErrInvalidParams))
-		}
+func (s *GetInsightInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetInsightInput"}
+	if s.InsightId == nil {
+		invalidParams.Add(request.NewErrParamRequired("InsightId"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -2551,64 +5379,114 @@ func (s *CreateSamplingRuleInput) Validate() error {
 	return nil
 }
 
-// SetSamplingRule sets the SamplingRule field's value.
-func (s *CreateSamplingRuleInput) SetSamplingRule(v *SamplingRule) *CreateSamplingRuleInput {
-	s.SamplingRule = v
+// SetInsightId sets the InsightId field's value.
+func (s *GetInsightInput) SetInsightId(v string) *GetInsightInput {
+	s.InsightId = &v
 	return s
 }
 
-type CreateSamplingRuleOutput struct {
+type GetInsightOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The saved rule definition and metadata.
-	SamplingRuleRecord *SamplingRuleRecord `type:"structure"`
+	// The summary information of an insight.
+	Insight *Insight `type:"structure"`
 }
 
-// String returns the string representation
-func (s CreateSamplingRuleOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetInsightOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s CreateSamplingRuleOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetInsightOutput) GoString() string {
 	return s.String()
 }
 
-// SetSamplingRuleRecord sets the SamplingRuleRecord field's value.
-func (s *CreateSamplingRuleOutput) SetSamplingRuleRecord(v *SamplingRuleRecord) *CreateSamplingRuleOutput {
-	s.SamplingRuleRecord = v
+// SetInsight sets the Insight field's value.
+func (s *GetInsightOutput) SetInsight(v *Insight) *GetInsightOutput {
+	s.Insight = v
 	return s
 }
 
-type DeleteGroupInput struct {
+type GetInsightSummariesInput struct {
 	_ struct{} `type:"structure"`
 
-	// The ARN of the group that was generated on creation.
+	// The end of the time frame in which the insights ended. The end time can't
+	// be more than 30 days old.
+	//
+	// EndTime is a required field
+	EndTime *time.Time `type:"timestamp" required:"true"`
+
+	// The Amazon Resource Name (ARN) of the group. Required if the GroupName isn't
+	// provided.
 	GroupARN *string `min:"1" type:"string"`
 
-	// The case-sensitive name of the group.
+	// The name of the group. Required if the GroupARN isn't provided.
 	GroupName *string `min:"1" type:"string"`
+
+	// The maximum number of results to display.
+	MaxResults *int64 `min:"1" type:"integer"`
+
+	// Pagination token.
+	NextToken *string `min:"1" type:"string"`
+
+	// The beginning of the time frame in which the insights started. The start
+	// time can't be more than 30 days old.
+	//
+	// StartTime is a required field
+	StartTime *time.Time `type:"timestamp" required:"true"`
+
+	// The list of insight states.
+	States []*string `type:"list" enum:"InsightState"`
 }
 
-// String returns the string representation
-func (s DeleteGroupInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetInsightSummariesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteGroupInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetInsightSummariesInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *DeleteGroupInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "DeleteGroupInput"}
+func (s *GetInsightSummariesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetInsightSummariesInput"}
+	if s.EndTime == nil {
+		invalidParams.Add(request.NewErrParamRequired("EndTime"))
+	}
 	if s.GroupARN != nil && len(*s.GroupARN) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("GroupARN", 1))
 	}
 	if s.GroupName != nil && len(*s.GroupName) < 1 {
 		invalidParams.Add(request.NewErrParamMinLen("GroupName", 1))
 	}
+	if s.MaxResults != nil && *s.MaxResults < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+	}
+	if s.NextToken != nil && len(*s.NextToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	}
+	if s.StartTime == nil {
+		invalidParams.Add(request.NewErrParamRequired("StartTime"))
+	}
 
 	if invalidParams.Len() > 0 {
 		return invalidParams
@@ -2616,1316 +5494,1905 @@ func (s *DeleteGroupInput) Validate() error {
 	return nil
 }
 
+// SetEndTime sets the EndTime field's value.
+func (s *GetInsightSummariesInput) SetEndTime(v time.Time) *GetInsightSummariesInput {
+	s.EndTime = &v
+	return s
+}
+
 // SetGroupARN sets the GroupARN field's value.
-func (s *DeleteGroupInput) SetGroupARN(v string) *DeleteGroupInput {
+func (s *GetInsightSummariesInput) SetGroupARN(v string) *GetInsightSummariesInput {
 	s.GroupARN = &v
 	return s
 }
 
 // SetGroupName sets the GroupName field's value.
-func (s *DeleteGroupInput) SetGroupName(v string) *DeleteGroupInput {
+func (s *GetInsightSummariesInput) SetGroupName(v string) *GetInsightSummariesInput {
 	s.GroupName = &v
 	return s
 }
 
-type DeleteGroupOutput struct {
-	_ struct{} `type:"structure"`
+// SetMaxResults sets the MaxResults field's value.
+func (s *GetInsightSummariesInput) SetMaxResults(v int64) *GetInsightSummariesInput {
+	s.MaxResults = &v
+	return s
 }
 
-// String returns the string representation
-func (s DeleteGroupOutput) String() string {
-	return awsutil.Prettify(s)
+// SetNextToken sets the NextToken field's value.
+func (s *GetInsightSummariesInput) SetNextToken(v string) *GetInsightSummariesInput {
+	s.NextToken = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s DeleteGroupOutput) GoString() string {
-	return s.String()
+// SetStartTime sets the StartTime field's value.
+func (s *GetInsightSummariesInput) SetStartTime(v time.Time) *GetInsightSummariesInput {
+	s.StartTime = &v
+	return s
 }
 
-type DeleteSamplingRuleInput struct {
+// SetStates sets the States field's value.
+func (s *GetInsightSummariesInput) SetStates(v []*string) *GetInsightSummariesInput {
+	s.States = v
+	return s
+}
+
+type GetInsightSummariesOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The ARN of the sampling rule. Specify a rule by either name or ARN, but not
-	// both.
-	RuleARN *string `type:"string"`
+	// The summary of each insight within the group matching the provided filters.
+	// The summary contains the InsightID, start and end time, the root cause service,
+	// the root cause and client impact statistics, the top anomalous services,
+	// and the status of the insight.
+	InsightSummaries []*InsightSummary `type:"list"`
 
-	// The name of the sampling rule. Specify a rule by either name or ARN, but
-	// not both.
-	RuleName *string `type:"string"`
+	// Pagination token.
+	NextToken *string `min:"1" type:"string"`
 }
 
-// String returns the string representation
-func (s DeleteSamplingRuleInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetInsightSummariesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteSamplingRuleInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetInsightSummariesOutput) GoString() string {
 	return s.String()
 }
 
-// SetRuleARN sets the RuleARN field's value.
-func (s *DeleteSamplingRuleInput) SetRuleARN(v string) *DeleteSamplingRuleInput {
-	s.RuleARN = &v
+// SetInsightSummaries sets the InsightSummaries field's value.
+func (s *GetInsightSummariesOutput) SetInsightSummaries(v []*InsightSummary) *GetInsightSummariesOutput {
+	s.InsightSummaries = v
 	return s
 }
 
-// SetRuleName sets the RuleName field's value.
-func (s *DeleteSamplingRuleInput) SetRuleName(v string) *DeleteSamplingRuleInput {
-	s.RuleName = &v
+// SetNextToken sets the NextToken field's value.
+func (s *GetInsightSummariesOutput) SetNextToken(v string) *GetInsightSummariesOutput {
+	s.NextToken = &v
 	return s
 }
 
-type DeleteSamplingRuleOutput struct {
+type GetSamplingRulesInput struct {
 	_ struct{} `type:"structure"`
 
-	// The deleted rule definition and metadata.
-	SamplingRuleRecord *SamplingRuleRecord `type:"structure"`
+	// Pagination token.
+	NextToken *string `type:"string"`
 }
 
-// String returns the string representation
-func (s DeleteSamplingRuleOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSamplingRulesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s DeleteSamplingRuleOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSamplingRulesInput) GoString() string {
 	return s.String()
 }
 
-// SetSamplingRuleRecord sets the SamplingRuleRecord field's value.
-func (s *DeleteSamplingRuleOutput) SetSamplingRuleRecord(v *SamplingRuleRecord) *DeleteSamplingRuleOutput {
-	s.SamplingRuleRecord = v
+// SetNextToken sets the NextToken field's value.
+func (s *GetSamplingRulesInput) SetNextToken(v string) *GetSamplingRulesInput {
+	s.NextToken = &v
 	return s
 }
 
-// Information about a connection between two services.
-type Edge struct {
+type GetSamplingRulesOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Aliases for the edge.
-	Aliases []*Alias `type:"list"`
+	// Pagination token.
+	NextToken *string `type:"string"`
 
-	// The end time of the last segment on the edge.
-	EndTime *time.Time `type:"timestamp"`
+	// Rule definitions and metadata.
+	SamplingRuleRecords []*SamplingRuleRecord `type:"list"`
+}
 
-	// Identifier of the edge. Unique within a service map.
-	ReferenceId *int64 `type:"integer"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSamplingRulesOutput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// A histogram that maps the spread of client response times on an edge.
-	ResponseTimeHistogram []*HistogramEntry `type:"list"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSamplingRulesOutput) GoString() string {
+	return s.String()
+}
 
-	// The start time of the first segment on the edge.
-	StartTime *time.Time `type:"timestamp"`
+// SetNextToken sets the NextToken field's value.
+func (s *GetSamplingRulesOutput) SetNextToken(v string) *GetSamplingRulesOutput {
+	s.NextToken = &v
+	return s
+}
 
-	// Response statistics for segments on the edge.
-	SummaryStatistics *EdgeStatistics `type:"structure"`
+// SetSamplingRuleRecords sets the SamplingRuleRecords field's value.
+func (s *GetSamplingRulesOutput) SetSamplingRuleRecords(v []*SamplingRuleRecord) *GetSamplingRulesOutput {
+	s.SamplingRuleRecords = v
+	return s
 }
 
-// String returns the string representation
-func (s Edge) String() string {
+type GetSamplingStatisticSummariesInput struct {
+	_ struct{} `type:"structure"`
+
+	// Pagination token.
+	NextToken *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSamplingStatisticSummariesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Edge) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSamplingStatisticSummariesInput) GoString() string {
 	return s.String()
 }
 
-// SetAliases sets the Aliases field's value.
-func (s *Edge) SetAliases(v []*Alias) *Edge {
-	s.Aliases = v
+// SetNextToken sets the NextToken field's value.
+func (s *GetSamplingStatisticSummariesInput) SetNextToken(v string) *GetSamplingStatisticSummariesInput {
+	s.NextToken = &v
 	return s
 }
 
-// SetEndTime sets the EndTime field's value.
-func (s *Edge) SetEndTime(v time.Time) *Edge {
-	s.EndTime = &v
-	return s
+type GetSamplingStatisticSummariesOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Pagination token.
+	NextToken *string `type:"string"`
+
+	// Information about the number of requests instrumented for each sampling rule.
+	SamplingStatisticSummaries []*SamplingStatisticSummary `type:"list"`
 }
 
-// SetReferenceId sets the ReferenceId field's value.
-func (s *Edge) SetReferenceId(v int64) *Edge {
-	s.ReferenceId = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSamplingStatisticSummariesOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetResponseTimeHistogram sets the ResponseTimeHistogram field's value.
-func (s *Edge) SetResponseTimeHistogram(v []*HistogramEntry) *Edge {
-	s.ResponseTimeHistogram = v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSamplingStatisticSummariesOutput) GoString() string {
+	return s.String()
 }
 
-// SetStartTime sets the StartTime field's value.
-func (s *Edge) SetStartTime(v time.Time) *Edge {
-	s.StartTime = &v
+// SetNextToken sets the NextToken field's value.
+func (s *GetSamplingStatisticSummariesOutput) SetNextToken(v string) *GetSamplingStatisticSummariesOutput {
+	s.NextToken = &v
 	return s
 }
 
-// SetSummaryStatistics sets the SummaryStatistics field's value.
-func (s *Edge) SetSummaryStatistics(v *EdgeStatistics) *Edge {
-	s.SummaryStatistics = v
+// SetSamplingStatisticSummaries sets the SamplingStatisticSummaries field's value.
+func (s *GetSamplingStatisticSummariesOutput) SetSamplingStatisticSummaries(v []*SamplingStatisticSummary) *GetSamplingStatisticSummariesOutput {
+	s.SamplingStatisticSummaries = v
 	return s
 }
 
-// Response statistics for an edge.
-type EdgeStatistics struct {
+type GetSamplingTargetsInput struct {
 	_ struct{} `type:"structure"`
 
-	// Information about requests that failed with a 4xx Client Error status code.
-	ErrorStatistics *ErrorStatistics `type:"structure"`
+	// Information about rules that the service is using to sample requests.
+	//
+	// SamplingStatisticsDocuments is a required field
+	SamplingStatisticsDocuments []*SamplingStatisticsDocument `type:"list" required:"true"`
+}
 
-	// Information about requests that failed with a 5xx Server Error status code.
-	FaultStatistics *FaultStatistics `type:"structure"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSamplingTargetsInput) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// The number of requests that completed with a 2xx Success status code.
-	OkCount *int64 `type:"long"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSamplingTargetsInput) GoString() string {
+	return s.String()
+}
 
-	// The total number of completed requests.
-	TotalCount *int64 `type:"long"`
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetSamplingTargetsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetSamplingTargetsInput"}
+	if s.SamplingStatisticsDocuments == nil {
+		invalidParams.Add(request.NewErrParamRequired("SamplingStatisticsDocuments"))
+	}
+	if s.SamplingStatisticsDocuments != nil {
+		for i, v := range s.SamplingStatisticsDocuments {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "SamplingStatisticsDocuments", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
 
-	// The aggregate response time of completed requests.
-	TotalResponseTime *float64 `type:"double"`
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// String returns the string representation
-func (s EdgeStatistics) String() string {
-	return awsutil.Prettify(s)
+// SetSamplingStatisticsDocuments sets the SamplingStatisticsDocuments field's value.
+func (s *GetSamplingTargetsInput) SetSamplingStatisticsDocuments(v []*SamplingStatisticsDocument) *GetSamplingTargetsInput {
+	s.SamplingStatisticsDocuments = v
+	return s
 }
 
-// GoString returns the string representation
-func (s EdgeStatistics) GoString() string {
-	return s.String()
+type GetSamplingTargetsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The last time a user changed the sampling rule configuration. If the sampling
+	// rule configuration changed since the service last retrieved it, the service
+	// should call GetSamplingRules (https://docs.aws.amazon.com/xray/latest/api/API_GetSamplingRules.html)
+	// to get the latest version.
+	LastRuleModification *time.Time `type:"timestamp"`
+
+	// Updated rules that the service should use to sample requests.
+	SamplingTargetDocuments []*SamplingTargetDocument `type:"list"`
+
+	// Information about SamplingStatisticsDocument (https://docs.aws.amazon.com/xray/latest/api/API_SamplingStatisticsDocument.html)
+	// that X-Ray could not process.
+	UnprocessedStatistics []*UnprocessedStatistics `type:"list"`
 }
 
-// SetErrorStatistics sets the ErrorStatistics field's value.
-func (s *EdgeStatistics) SetErrorStatistics(v *ErrorStatistics) *EdgeStatistics {
-	s.ErrorStatistics = v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSamplingTargetsOutput) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetFaultStatistics sets the FaultStatistics field's value.
-func (s *EdgeStatistics) SetFaultStatistics(v *FaultStatistics) *EdgeStatistics {
-	s.FaultStatistics = v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetSamplingTargetsOutput) GoString() string {
+	return s.String()
 }
 
-// SetOkCount sets the OkCount field's value.
-func (s *EdgeStatistics) SetOkCount(v int64) *EdgeStatistics {
-	s.OkCount = &v
+// SetLastRuleModification sets the LastRuleModification field's value.
+func (s *GetSamplingTargetsOutput) SetLastRuleModification(v time.Time) *GetSamplingTargetsOutput {
+	s.LastRuleModification = &v
 	return s
 }
 
-// SetTotalCount sets the TotalCount field's value.
-func (s *EdgeStatistics) SetTotalCount(v int64) *EdgeStatistics {
-	s.TotalCount = &v
+// SetSamplingTargetDocuments sets the SamplingTargetDocuments field's value.
+func (s *GetSamplingTargetsOutput) SetSamplingTargetDocuments(v []*SamplingTargetDocument) *GetSamplingTargetsOutput {
+	s.SamplingTargetDocuments = v
 	return s
 }
 
-// SetTotalResponseTime sets the TotalResponseTime field's value.
-func (s *EdgeStatistics) SetTotalResponseTime(v float64) *EdgeStatistics {
-	s.TotalResponseTime = &v
+// SetUnprocessedStatistics sets the UnprocessedStatistics field's value.
+func (s *GetSamplingTargetsOutput) SetUnprocessedStatistics(v []*UnprocessedStatistics) *GetSamplingTargetsOutput {
+	s.UnprocessedStatistics = v
 	return s
 }
 
-// A configuration document that specifies encryption configuration settings.
-type EncryptionConfig struct {
+type GetServiceGraphInput struct {
 	_ struct{} `type:"structure"`
 
-	// The ID of the customer master key (CMK) used for encryption, if applicable.
-	KeyId *string `type:"string"`
+	// The end of the timeframe for which to generate a graph.
+	//
+	// EndTime is a required field
+	EndTime *time.Time `type:"timestamp" required:"true"`
 
-	// The encryption status. While the status is UPDATING, X-Ray may encrypt data
-	// with a combination of the new and old settings.
-	Status *string `type:"string" enum:"EncryptionStatus"`
+	// The Amazon Resource Name (ARN) of a group based on which you want to generate
+	// a graph.
+	GroupARN *string `min:"1" type:"string"`
 
-	// The type of encryption. Set to KMS for encryption with CMKs. Set to NONE
-	// for default encryption.
-	Type *string `type:"string" enum:"EncryptionType"`
+	// The name of a group based on which you want to generate a graph.
+	GroupName *string `min:"1" type:"string"`
+
+	// Pagination token.
+	NextToken *string `type:"string"`
+
+	// The start of the time frame for which to generate a graph.
+	//
+	// StartTime is a required field
+	StartTime *time.Time `type:"timestamp" required:"true"`
 }
 
-// String returns the string representation
-func (s EncryptionConfig) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetServiceGraphInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s EncryptionConfig) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetServiceGraphInput) GoString() string {
 	return s.String()
 }
 
-// SetKeyId sets the KeyId field's value.
-func (s *EncryptionConfig) SetKeyId(v string) *EncryptionConfig {
-	s.KeyId = &v
-	return s
-}
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetServiceGraphInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetServiceGraphInput"}
+	if s.EndTime == nil {
+		invalidParams.Add(request.NewErrParamRequired("EndTime"))
+	}
+	if s.GroupARN != nil && len(*s.GroupARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("GroupARN", 1))
+	}
+	if s.GroupName != nil && len(*s.GroupName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("GroupName", 1))
+	}
+	if s.StartTime == nil {
+		invalidParams.Add(request.NewErrParamRequired("StartTime"))
+	}
 
-// SetStatus sets the Status field's value.
-func (s *EncryptionConfig) SetStatus(v string) *EncryptionConfig {
-	s.Status = &v
-	return s
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetType sets the Type field's value.
-func (s *EncryptionConfig) SetType(v string) *EncryptionConfig {
-	s.Type = &v
+// SetEndTime sets the EndTime field's value.
+func (s *GetServiceGraphInput) SetEndTime(v time.Time) *GetServiceGraphInput {
+	s.EndTime = &v
 	return s
 }
 
-// The root cause of a trace summary error.
-type ErrorRootCause struct {
-	_ struct{} `type:"structure"`
-
-	// A list of services corresponding to an error. A service identifies a segment
-	// and it contains a name, account ID, type, and inferred flag.
-	Services []*ErrorRootCauseService `type:"list"`
+// SetGroupARN sets the GroupARN field's value.
+func (s *GetServiceGraphInput) SetGroupARN(v string) *GetServiceGraphInput {
+	s.GroupARN = &v
+	return s
 }
 
-// String returns the string representation
-func (s ErrorRootCause) String() string {
-	return awsutil.Prettify(s)
+// SetGroupName sets the GroupName field's value.
+func (s *GetServiceGraphInput) SetGroupName(v string) *GetServiceGraphInput {
+	s.GroupName = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s ErrorRootCause) GoString() string {
-	return s.String()
+// SetNextToken sets the NextToken field's value.
+func (s *GetServiceGraphInput) SetNextToken(v string) *GetServiceGraphInput {
+	s.NextToken = &v
+	return s
 }
 
-// SetServices sets the Services field's value.
-func (s *ErrorRootCause) SetServices(v []*ErrorRootCauseService) *ErrorRootCause {
-	s.Services = v
+// SetStartTime sets the StartTime field's value.
+func (s *GetServiceGraphInput) SetStartTime(v time.Time) *GetServiceGraphInput {
+	s.StartTime = &v
 	return s
 }
 
-// A collection of segments and corresponding subsegments associated to a trace
-// summary error.
-type ErrorRootCauseEntity struct {
+type GetServiceGraphOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The types and messages of the exceptions.
-	Exceptions []*RootCauseException `type:"list"`
+	// A flag indicating whether the group's filter expression has been consistent,
+	// or if the returned service graph may show traces from an older version of
+	// the group's filter expression.
+	ContainsOldGroupVersions *bool `type:"boolean"`
 
-	// The name of the entity.
-	Name *string `type:"string"`
+	// The end of the time frame for which the graph was generated.
+	EndTime *time.Time `type:"timestamp"`
 
-	// A flag that denotes a remote subsegment.
-	Remote *bool `type:"boolean"`
+	// Pagination token.
+	NextToken *string `type:"string"`
+
+	// The services that have processed a traced request during the specified time
+	// frame.
+	Services []*Service `type:"list"`
+
+	// The start of the time frame for which the graph was generated.
+	StartTime *time.Time `type:"timestamp"`
 }
 
-// String returns the string representation
-func (s ErrorRootCauseEntity) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetServiceGraphOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ErrorRootCauseEntity) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetServiceGraphOutput) GoString() string {
 	return s.String()
 }
 
-// SetExceptions sets the Exceptions field's value.
-func (s *ErrorRootCauseEntity) SetExceptions(v []*RootCauseException) *ErrorRootCauseEntity {
-	s.Exceptions = v
+// SetContainsOldGroupVersions sets the ContainsOldGroupVersions field's value.
+func (s *GetServiceGraphOutput) SetContainsOldGroupVersions(v bool) *GetServiceGraphOutput {
+	s.ContainsOldGroupVersions = &v
 	return s
 }
 
-// SetName sets the Name field's value.
-func (s *ErrorRootCauseEntity) SetName(v string) *ErrorRootCauseEntity {
-	s.Name = &v
+// SetEndTime sets the EndTime field's value.
+func (s *GetServiceGraphOutput) SetEndTime(v time.Time) *GetServiceGraphOutput {
+	s.EndTime = &v
 	return s
 }
 
-// SetRemote sets the Remote field's value.
-func (s *ErrorRootCauseEntity) SetRemote(v bool) *ErrorRootCauseEntity {
-	s.Remote = &v
+// SetNextToken sets the NextToken field's value.
+func (s *GetServiceGraphOutput) SetNextToken(v string) *GetServiceGraphOutput {
+	s.NextToken = &v
 	return s
 }
 
-// A collection of fields identifying the services in a trace summary error.
-type ErrorRootCauseService struct {
+// SetServices sets the Services field's value.
+func (s *GetServiceGraphOutput) SetServices(v []*Service) *GetServiceGraphOutput {
+	s.Services = v
+	return s
+}
+
+// SetStartTime sets the StartTime field's value.
+func (s *GetServiceGraphOutput) SetStartTime(v time.Time) *GetServiceGraphOutput {
+	s.StartTime = &v
+	return s
+}
+
+type GetTimeSeriesServiceStatisticsInput struct {
 	_ struct{} `type:"structure"`
 
-	// The account ID associated to the service.
-	AccountId *string `type:"string"`
+	// The end of the time frame for which to aggregate statistics.
+	//
+	// EndTime is a required field
+	EndTime *time.Time `type:"timestamp" required:"true"`
 
-	// The path of root cause entities found on the service.
-	EntityPath []*ErrorRootCauseEntity `type:"list"`
+	// A filter expression defining entities that will be aggregated for statistics.
+	// Supports ID, service, and edge functions. If no selector expression is specified,
+	// edge statistics are returned.
+	EntitySelectorExpression *string `min:"1" type:"string"`
 
-	// A Boolean value indicating if the service is inferred from the trace.
-	Inferred *bool `type:"boolean"`
+	// The forecasted high and low fault count values. Forecast enabled requests
+	// require the EntitySelectorExpression ID be provided.
+	ForecastStatistics *bool `type:"boolean"`
 
-	// The service name.
-	Name *string `type:"string"`
+	// The Amazon Resource Name (ARN) of the group for which to pull statistics
+	// from.
+	GroupARN *string `min:"1" type:"string"`
 
-	// A collection of associated service names.
-	Names []*string `type:"list"`
+	// The case-sensitive name of the group for which to pull statistics from.
+	GroupName *string `min:"1" type:"string"`
 
-	// The type associated to the service.
-	Type *string `type:"string"`
+	// Pagination token.
+	NextToken *string `type:"string"`
+
+	// Aggregation period in seconds.
+	Period *int64 `type:"integer"`
+
+	// The start of the time frame for which to aggregate statistics.
+	//
+	// StartTime is a required field
+	StartTime *time.Time `type:"timestamp" required:"true"`
 }
 
-// String returns the string representation
-func (s ErrorRootCauseService) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTimeSeriesServiceStatisticsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ErrorRootCauseService) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTimeSeriesServiceStatisticsInput) GoString() string {
 	return s.String()
 }
 
-// SetAccountId sets the AccountId field's value.
-func (s *ErrorRootCauseService) SetAccountId(v string) *ErrorRootCauseService {
-	s.AccountId = &v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetTimeSeriesServiceStatisticsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetTimeSeriesServiceStatisticsInput"}
+	if s.EndTime == nil {
+		invalidParams.Add(request.NewErrParamRequired("EndTime"))
+	}
+	if s.EntitySelectorExpression != nil && len(*s.EntitySelectorExpression) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("EntitySelectorExpression", 1))
+	}
+	if s.GroupARN != nil && len(*s.GroupARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("GroupARN", 1))
+	}
+	if s.GroupName != nil && len(*s.GroupName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("GroupName", 1))
+	}
+	if s.StartTime == nil {
+		invalidParams.Add(request.NewErrParamRequired("StartTime"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetEndTime sets the EndTime field's value.
+func (s *GetTimeSeriesServiceStatisticsInput) SetEndTime(v time.Time) *GetTimeSeriesServiceStatisticsInput {
+	s.EndTime = &v
 	return s
 }
 
-// SetEntityPath sets the EntityPath field's value.
-func (s *ErrorRootCauseService) SetEntityPath(v []*ErrorRootCauseEntity) *ErrorRootCauseService {
-	s.EntityPath = v
+// SetEntitySelectorExpression sets the EntitySelectorExpression field's value.
+func (s *GetTimeSeriesServiceStatisticsInput) SetEntitySelectorExpression(v string) *GetTimeSeriesServiceStatisticsInput {
+	s.EntitySelectorExpression = &v
 	return s
 }
 
-// SetInferred sets the Inferred field's value.
-func (s *ErrorRootCauseService) SetInferred(v bool) *ErrorRootCauseService {
-	s.Inferred = &v
+// SetForecastStatistics sets the ForecastStatistics field's value.
+func (s *GetTimeSeriesServiceStatisticsInput) SetForecastStatistics(v bool) *GetTimeSeriesServiceStatisticsInput {
+	s.ForecastStatistics = &v
 	return s
 }
 
-// SetName sets the Name field's value.
-func (s *ErrorRootCauseService) SetName(v string) *ErrorRootCauseService {
-	s.Name = &v
+// SetGroupARN sets the GroupARN field's value.
+func (s *GetTimeSeriesServiceStatisticsInput) SetGroupARN(v string) *GetTimeSeriesServiceStatisticsInput {
+	s.GroupARN = &v
 	return s
 }
 
-// SetNames sets the Names field's value.
-func (s *ErrorRootCauseService) SetNames(v []*string) *ErrorRootCauseService {
-	s.Names = v
+// SetGroupName sets the GroupName field's value.
+func (s *GetTimeSeriesServiceStatisticsInput) SetGroupName(v string) *GetTimeSeriesServiceStatisticsInput {
+	s.GroupName = &v
+	return s
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *GetTimeSeriesServiceStatisticsInput) SetNextToken(v string) *GetTimeSeriesServiceStatisticsInput {
+	s.NextToken = &v
+	return s
+}
+
+// SetPeriod sets the Period field's value.
+func (s *GetTimeSeriesServiceStatisticsInput) SetPeriod(v int64) *GetTimeSeriesServiceStatisticsInput {
+	s.Period = &v
 	return s
 }
 
-// SetType sets the Type field's value.
-func (s *ErrorRootCauseService) SetType(v string) *ErrorRootCauseService {
-	s.Type = &v
+// SetStartTime sets the StartTime field's value.
+func (s *GetTimeSeriesServiceStatisticsInput) SetStartTime(v time.Time) *GetTimeSeriesServiceStatisticsInput {
+	s.StartTime = &v
 	return s
 }
 
-// Information about requests that failed with a 4xx Client Error status code.
-type ErrorStatistics struct {
+type GetTimeSeriesServiceStatisticsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The number of requests that failed with untracked 4xx Client Error status
-	// codes.
-	OtherCount *int64 `type:"long"`
+	// A flag indicating whether or not a group's filter expression has been consistent,
+	// or if a returned aggregation might show statistics from an older version
+	// of the group's filter expression.
+	ContainsOldGroupVersions *bool `type:"boolean"`
 
-	// The number of requests that failed with a 419 throttling status code.
-	ThrottleCount *int64 `type:"long"`
+	// Pagination token.
+	NextToken *string `type:"string"`
 
-	// The total number of requests that failed with a 4xx Client Error status code.
-	TotalCount *int64 `type:"long"`
+	// The collection of statistics.
+	TimeSeriesServiceStatistics []*TimeSeriesServiceStatistics `type:"list"`
 }
 
-// String returns the string representation
-func (s ErrorStatistics) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTimeSeriesServiceStatisticsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ErrorStatistics) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTimeSeriesServiceStatisticsOutput) GoString() string {
 	return s.String()
 }
 
-// SetOtherCount sets the OtherCount field's value.
-func (s *ErrorStatistics) SetOtherCount(v int64) *ErrorStatistics {
-	s.OtherCount = &v
+// SetContainsOldGroupVersions sets the ContainsOldGroupVersions field's value.
+func (s *GetTimeSeriesServiceStatisticsOutput) SetContainsOldGroupVersions(v bool) *GetTimeSeriesServiceStatisticsOutput {
+	s.ContainsOldGroupVersions = &v
 	return s
 }
 
-// SetThrottleCount sets the ThrottleCount field's value.
-func (s *ErrorStatistics) SetThrottleCount(v int64) *ErrorStatistics {
-	s.ThrottleCount = &v
+// SetNextToken sets the NextToken field's value.
+func (s *GetTimeSeriesServiceStatisticsOutput) SetNextToken(v string) *GetTimeSeriesServiceStatisticsOutput {
+	s.NextToken = &v
 	return s
 }
 
-// SetTotalCount sets the TotalCount field's value.
-func (s *ErrorStatistics) SetTotalCount(v int64) *ErrorStatistics {
-	s.TotalCount = &v
+// SetTimeSeriesServiceStatistics sets the TimeSeriesServiceStatistics field's value.
+func (s *GetTimeSeriesServiceStatisticsOutput) SetTimeSeriesServiceStatistics(v []*TimeSeriesServiceStatistics) *GetTimeSeriesServiceStatisticsOutput {
+	s.TimeSeriesServiceStatistics = v
 	return s
 }
 
-// The root cause information for a trace summary fault.
-type FaultRootCause struct {
+type GetTraceGraphInput struct {
 	_ struct{} `type:"structure"`
 
-	// A list of corresponding services. A service identifies a segment and it contains
-	// a name, account ID, type, and inferred flag.
-	Services []*FaultRootCauseService `type:"list"`
+	// Pagination token.
+	NextToken *string `type:"string"`
+
+	// Trace IDs of requests for which to generate a service graph.
+	//
+	// TraceIds is a required field
+	TraceIds []*string `type:"list" required:"true"`
 }
 
-// String returns the string representation
-func (s FaultRootCause) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTraceGraphInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s FaultRootCause) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTraceGraphInput) GoString() string {
 	return s.String()
 }
 
-// SetServices sets the Services field's value.
-func (s *FaultRootCause) SetServices(v []*FaultRootCauseService) *FaultRootCause {
-	s.Services = v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetTraceGraphInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetTraceGraphInput"}
+	if s.TraceIds == nil {
+		invalidParams.Add(request.NewErrParamRequired("TraceIds"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetNextToken sets the NextToken field's value.
+func (s *GetTraceGraphInput) SetNextToken(v string) *GetTraceGraphInput {
+	s.NextToken = &v
 	return s
 }
 
-// A collection of segments and corresponding subsegments associated to a trace
-// summary fault error.
-type FaultRootCauseEntity struct {
-	_ struct{} `type:"structure"`
+// SetTraceIds sets the TraceIds field's value.
+func (s *GetTraceGraphInput) SetTraceIds(v []*string) *GetTraceGraphInput {
+	s.TraceIds = v
+	return s
+}
 
-	// The types and messages of the exceptions.
-	Exceptions []*RootCauseException `type:"list"`
+type GetTraceGraphOutput struct {
+	_ struct{} `type:"structure"`
 
-	// The name of the entity.
-	Name *string `type:"string"`
+	// Pagination token.
+	NextToken *string `type:"string"`
 
-	// A flag that denotes a remote subsegment.
-	Remote *bool `type:"boolean"`
+	// The services that have processed one of the specified requests.
+	Services []*Service `type:"list"`
 }
 
-// String returns the string representation
-func (s FaultRootCauseEntity) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTraceGraphOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s FaultRootCauseEntity) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTraceGraphOutput) GoString() string {
 	return s.String()
 }
 
-// SetExceptions sets the Exceptions field's value.
-func (s *FaultRootCauseEntity) SetExceptions(v []*RootCauseException) *FaultRootCauseEntity {
-	s.Exceptions = v
-	return s
-}
-
-// SetName sets the Name field's value.
-func (s *FaultRootCauseEntity) SetName(v string) *FaultRootCauseEntity {
-	s.Name = &v
+// SetNextToken sets the NextToken field's value.
+func (s *GetTraceGraphOutput) SetNextToken(v string) *GetTraceGraphOutput {
+	s.NextToken = &v
 	return s
 }
 
-// SetRemote sets the Remote field's value.
-func (s *FaultRootCauseEntity) SetRemote(v bool) *FaultRootCauseEntity {
-	s.Remote = &v
+// SetServices sets the Services field's value.
+func (s *GetTraceGraphOutput) SetServices(v []*Service) *GetTraceGraphOutput {
+	s.Services = v
 	return s
 }
 
-// A collection of fields identifying the services in a trace summary fault.
-type FaultRootCauseService struct {
+type GetTraceSummariesInput struct {
 	_ struct{} `type:"structure"`
 
-	// The account ID associated to the service.
-	AccountId *string `type:"string"`
+	// The end of the time frame for which to retrieve traces.
+	//
+	// EndTime is a required field
+	EndTime *time.Time `type:"timestamp" required:"true"`
 
-	// The path of root cause entities found on the service.
-	EntityPath []*FaultRootCauseEntity `type:"list"`
+	// Specify a filter expression to retrieve trace summaries for services or requests
+	// that meet certain requirements.
+	FilterExpression *string `type:"string"`
 
-	// A Boolean value indicating if the service is inferred from the trace.
-	Inferred *bool `type:"boolean"`
+	// Specify the pagination token returned by a previous request to retrieve the
+	// next page of results.
+	NextToken *string `type:"string"`
 
-	// The service name.
-	Name *string `type:"string"`
+	// Set to true to get summaries for only a subset of available traces.
+	Sampling *bool `type:"boolean"`
 
-	// A collection of associated service names.
-	Names []*string `type:"list"`
+	// A parameter to indicate whether to enable sampling on trace summaries. Input
+	// parameters are Name and Value.
+	SamplingStrategy *SamplingStrategy `type:"structure"`
 
-	// The type associated to the service.
-	Type *string `type:"string"`
+	// The start of the time frame for which to retrieve traces.
+	//
+	// StartTime is a required field
+	StartTime *time.Time `type:"timestamp" required:"true"`
+
+	// A parameter to indicate whether to query trace summaries by TraceId, Event
+	// (trace update time), or Service (segment end time).
+	TimeRangeType *string `type:"string" enum:"TimeRangeType"`
 }
 
-// String returns the string representation
-func (s FaultRootCauseService) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTraceSummariesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s FaultRootCauseService) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTraceSummariesInput) GoString() string {
 	return s.String()
 }
 
-// SetAccountId sets the AccountId field's value.
-func (s *FaultRootCauseService) SetAccountId(v string) *FaultRootCauseService {
-	s.AccountId = &v
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetTraceSummariesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetTraceSummariesInput"}
+	if s.EndTime == nil {
+		invalidParams.Add(request.NewErrParamRequired("EndTime"))
+	}
+	if s.StartTime == nil {
+		invalidParams.Add(request.NewErrParamRequired("StartTime"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetEndTime sets the EndTime field's value.
+func (s *GetTraceSummariesInput) SetEndTime(v time.Time) *GetTraceSummariesInput {
+	s.EndTime = &v
 	return s
 }
 
-// SetEntityPath sets the EntityPath field's value.
-func (s *FaultRootCauseService) SetEntityPath(v []*FaultRootCauseEntity) *FaultRootCauseService {
-	s.EntityPath = v
+// SetFilterExpression sets the FilterExpression field's value.
+func (s *GetTraceSummariesInput) SetFilterExpression(v string) *GetTraceSummariesInput {
+	s.FilterExpression = &v
 	return s
 }
 
-// SetInferred sets the Inferred field's value.
-func (s *FaultRootCauseService) SetInferred(v bool) *FaultRootCauseService {
-	s.Inferred = &v
+// SetNextToken sets the NextToken field's value.
+func (s *GetTraceSummariesInput) SetNextToken(v string) *GetTraceSummariesInput {
+	s.NextToken = &v
 	return s
 }
 
-// SetName sets the Name field's value.
-func (s *FaultRootCauseService) SetName(v string) *FaultRootCauseService {
-	s.Name = &v
+// SetSampling sets the Sampling field's value.
+func (s *GetTraceSummariesInput) SetSampling(v bool) *GetTraceSummariesInput {
+	s.Sampling = &v
 	return s
 }
 
-// SetNames sets the Names field's value.
-func (s *FaultRootCauseService) SetNames(v []*string) *FaultRootCauseService {
-	s.Names = v
+// SetSamplingStrategy sets the SamplingStrategy field's value.
+func (s *GetTraceSummariesInput) SetSamplingStrategy(v *SamplingStrategy) *GetTraceSummariesInput {
+	s.SamplingStrategy = v
 	return s
 }
 
-// SetType sets the Type field's value.
-func (s *FaultRootCauseService) SetType(v string) *FaultRootCauseService {
-	s.Type = &v
+// SetStartTime sets the StartTime field's value.
+func (s *GetTraceSummariesInput) SetStartTime(v time.Time) *GetTraceSummariesInput {
+	s.StartTime = &v
 	return s
 }
 
-// Information about requests that failed with a 5xx Server Error status code.
-type FaultStatistics struct {
+// SetTimeRangeType sets the TimeRangeType field's value.
+func (s *GetTraceSummariesInput) SetTimeRangeType(v string) *GetTraceSummariesInput {
+	s.TimeRangeType = &v
+	return s
+}
+
+type GetTraceSummariesOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The number of requests that failed with untracked 5xx Server Error status
-	// codes.
-	OtherCount *int64 `type:"long"`
+	// The start time of this page of results.
+	ApproximateTime *time.Time `type:"timestamp"`
 
-	// The total number of requests that failed with a 5xx Server Error status code.
-	TotalCount *int64 `type:"long"`
+	// If the requested time frame contained more than one page of results, you
+	// can use this token to retrieve the next page. The first page contains the
+	// most recent results, closest to the end of the time frame.
+	NextToken *string `type:"string"`
+
+	// Trace IDs and annotations for traces that were found in the specified time
+	// frame.
+	TraceSummaries []*TraceSummary `type:"list"`
+
+	// The total number of traces processed, including traces that did not match
+	// the specified filter expression.
+	TracesProcessedCount *int64 `type:"long"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTraceSummariesOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GetTraceSummariesOutput) GoString() string {
+	return s.String()
 }
 
-// String returns the string representation
-func (s FaultStatistics) String() string {
-	return awsutil.Prettify(s)
+// SetApproximateTime sets the ApproximateTime field's value.
+func (s *GetTraceSummariesOutput) SetApproximateTime(v time.Time) *GetTraceSummariesOutput {
+	s.ApproximateTime = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s FaultStatistics) GoString() string {
-	return s.String()
+// SetNextToken sets the NextToken field's value.
+func (s *GetTraceSummariesOutput) SetNextToken(v string) *GetTraceSummariesOutput {
+	s.NextToken = &v
+	return s
 }
 
-// SetOtherCount sets the OtherCount field's value.
-func (s *FaultStatistics) SetOtherCount(v int64) *FaultStatistics {
-	s.OtherCount = &v
+// SetTraceSummaries sets the TraceSummaries field's value.
+func (s *GetTraceSummariesOutput) SetTraceSummaries(v []*TraceSummary) *GetTraceSummariesOutput {
+	s.TraceSummaries = v
 	return s
 }
 
-// SetTotalCount sets the TotalCount field's value.
-func (s *FaultStatistics) SetTotalCount(v int64) *FaultStatistics {
-	s.TotalCount = &v
+// SetTracesProcessedCount sets the TracesProcessedCount field's value.
+func (s *GetTraceSummariesOutput) SetTracesProcessedCount(v int64) *GetTraceSummariesOutput {
+	s.TracesProcessedCount = &v
 	return s
 }
 
-type GetEncryptionConfigInput struct {
+// Details and metadata for a group.
+type Group struct {
 	_ struct{} `type:"structure"`
+
+	// The filter expression defining the parameters to include traces.
+	FilterExpression *string `type:"string"`
+
+	// The Amazon Resource Name (ARN) of the group generated based on the GroupName.
+	GroupARN *string `type:"string"`
+
+	// The unique case-sensitive name of the group.
+	GroupName *string `type:"string"`
+
+	// The structure containing configurations related to insights.
+	//
+	//    * The InsightsEnabled boolean can be set to true to enable insights for
+	//    the group or false to disable insights for the group.
+	//
+	//    * The NotificationsEnabled boolean can be set to true to enable insights
+	//    notifications through Amazon EventBridge for the group.
+	InsightsConfiguration *InsightsConfiguration `type:"structure"`
 }
 
-// String returns the string representation
-func (s GetEncryptionConfigInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Group) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetEncryptionConfigInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Group) GoString() string {
 	return s.String()
 }
 
-type GetEncryptionConfigOutput struct {
-	_ struct{} `type:"structure"`
-
-	// The encryption configuration document.
-	EncryptionConfig *EncryptionConfig `type:"structure"`
+// SetFilterExpression sets the FilterExpression field's value.
+func (s *Group) SetFilterExpression(v string) *Group {
+	s.FilterExpression = &v
+	return s
 }
 
-// String returns the string representation
-func (s GetEncryptionConfigOutput) String() string {
-	return awsutil.Prettify(s)
+// SetGroupARN sets the GroupARN field's value.
+func (s *Group) SetGroupARN(v string) *Group {
+	s.GroupARN = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s GetEncryptionConfigOutput) GoString() string {
-	return s.String()
+// SetGroupName sets the GroupName field's value.
+func (s *Group) SetGroupName(v string) *Group {
+	s.GroupName = &v
+	return s
 }
 
-// SetEncryptionConfig sets the EncryptionConfig field's value.
-func (s *GetEncryptionConfigOutput) SetEncryptionConfig(v *EncryptionConfig) *GetEncryptionConfigOutput {
-	s.EncryptionConfig = v
+// SetInsightsConfiguration sets the InsightsConfiguration field's value.
+func (s *Group) SetInsightsConfiguration(v *InsightsConfiguration) *Group {
+	s.InsightsConfiguration = v
 	return s
 }
 
-type GetGroupInput struct {
+// Details for a group without metadata.
+type GroupSummary struct {
 	_ struct{} `type:"structure"`
 
-	// The ARN of the group that was generated on creation.
-	GroupARN *string `min:"1" type:"string"`
+	// The filter expression defining the parameters to include traces.
+	FilterExpression *string `type:"string"`
 
-	// The case-sensitive name of the group.
-	GroupName *string `min:"1" type:"string"`
+	// The ARN of the group generated based on the GroupName.
+	GroupARN *string `type:"string"`
+
+	// The unique case-sensitive name of the group.
+	GroupName *string `type:"string"`
+
+	// The structure containing configurations related to insights.
+	//
+	//    * The InsightsEnabled boolean can be set to true to enable insights for
+	//    the group or false to disable insights for the group.
+	//
+	//    * The NotificationsEnabled boolean can be set to true to enable insights
+	//    notifications. Notifications can only be enabled on a group with InsightsEnabled
+	//    set to true.
+	InsightsConfiguration *InsightsConfiguration `type:"structure"`
 }
 
-// String returns the string representation
-func (s GetGroupInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GroupSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetGroupInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s GroupSummary) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetGroupInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetGroupInput"}
-	if s.GroupARN != nil && len(*s.GroupARN) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("GroupARN", 1))
-	}
-	if s.GroupName != nil && len(*s.GroupName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("GroupName", 1))
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetFilterExpression sets the FilterExpression field's value.
+func (s *GroupSummary) SetFilterExpression(v string) *GroupSummary {
+	s.FilterExpression = &v
+	return s
 }
 
 // SetGroupARN sets the GroupARN field's value.
-func (s *GetGroupInput) SetGroupARN(v string) *GetGroupInput {
+func (s *GroupSummary) SetGroupARN(v string) *GroupSummary {
 	s.GroupARN = &v
 	return s
 }
 
 // SetGroupName sets the GroupName field's value.
-func (s *GetGroupInput) SetGroupName(v string) *GetGroupInput {
+func (s *GroupSummary) SetGroupName(v string) *GroupSummary {
 	s.GroupName = &v
 	return s
 }
 
-type GetGroupOutput struct {
+// SetInsightsConfiguration sets the InsightsConfiguration field's value.
+func (s *GroupSummary) SetInsightsConfiguration(v *InsightsConfiguration) *GroupSummary {
+	s.InsightsConfiguration = v
+	return s
+}
+
+// An entry in a histogram for a statistic. A histogram maps the range of observed
+// values on the X axis, and the prevalence of each value on the Y axis.
+type HistogramEntry struct {
 	_ struct{} `type:"structure"`
 
-	// The group that was requested. Contains the name of the group, the ARN of
-	// the group, and the filter expression that assigned to the group.
-	Group *Group `type:"structure"`
+	// The prevalence of the entry.
+	Count *int64 `type:"integer"`
+
+	// The value of the entry.
+	Value *float64 `type:"double"`
 }
 
-// String returns the string representation
-func (s GetGroupOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HistogramEntry) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetGroupOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s HistogramEntry) GoString() string {
 	return s.String()
 }
 
-// SetGroup sets the Group field's value.
-func (s *GetGroupOutput) SetGroup(v *Group) *GetGroupOutput {
-	s.Group = v
+// SetCount sets the Count field's value.
+func (s *HistogramEntry) SetCount(v int64) *HistogramEntry {
+	s.Count = &v
 	return s
 }
 
-type GetGroupsInput struct {
+// SetValue sets the Value field's value.
+func (s *HistogramEntry) SetValue(v float64) *HistogramEntry {
+	s.Value = &v
+	return s
+}
+
+// Information about an HTTP request.
+type Http struct {
 	_ struct{} `type:"structure"`
 
-	// Pagination token. Not used.
-	NextToken *string `min:"1" type:"string"`
+	// The IP address of the requestor.
+	ClientIp *string `type:"string"`
+
+	// The request method.
+	HttpMethod *string `type:"string"`
+
+	// The response status.
+	HttpStatus *int64 `type:"integer"`
+
+	// The request URL.
+	HttpURL *string `type:"string"`
+
+	// The request's user agent string.
+	UserAgent *string `type:"string"`
 }
 
-// String returns the string representation
-func (s GetGroupsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Http) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetGroupsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Http) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetGroupsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetGroupsInput"}
-	if s.NextToken != nil && len(*s.NextToken) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
-	}
+// SetClientIp sets the ClientIp field's value.
+func (s *Http) SetClientIp(v string) *Http {
+	s.ClientIp = &v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetHttpMethod sets the HttpMethod field's value.
+func (s *Http) SetHttpMethod(v string) *Http {
+	s.HttpMethod = &v
+	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *GetGroupsInput) SetNextToken(v string) *GetGroupsInput {
-	s.NextToken = &v
+// SetHttpStatus sets the HttpStatus field's value.
+func (s *Http) SetHttpStatus(v int64) *Http {
+	s.HttpStatus = &v
 	return s
 }
 
-type GetGroupsOutput struct {
+// SetHttpURL sets the HttpURL field's value.
+func (s *Http) SetHttpURL(v string) *Http {
+	s.HttpURL = &v
+	return s
+}
+
+// SetUserAgent sets the UserAgent field's value.
+func (s *Http) SetUserAgent(v string) *Http {
+	s.UserAgent = &v
+	return s
+}
+
+// When fault rates go outside of the expected range, X-Ray creates an insight.
+// Insights tracks emergent issues within your applications.
+type Insight struct {
 	_ struct{} `type:"structure"`
 
-	// The collection of all active groups.
-	Groups []*GroupSummary `type:"list"`
+	// The categories that label and describe the type of insight.
+	Categories []*string `type:"list" enum:"InsightCategory"`
 
-	// Pagination token. Not used.
-	NextToken *string `type:"string"`
+	// The impact statistics of the client side service. This includes the number
+	// of requests to the client service and whether the requests were faults or
+	// okay.
+	ClientRequestImpactStatistics *RequestImpactStatistics `type:"structure"`
+
+	// The time, in Unix seconds, at which the insight ended.
+	EndTime *time.Time `type:"timestamp"`
+
+	// The Amazon Resource Name (ARN) of the group that the insight belongs to.
+	GroupARN *string `min:"1" type:"string"`
+
+	// The name of the group that the insight belongs to.
+	GroupName *string `min:"1" type:"string"`
+
+	// The insights unique identifier.
+	InsightId *string `type:"string"`
+
+	RootCauseServiceId *ServiceId `type:"structure"`
+
+	// The impact statistics of the root cause service. This includes the number
+	// of requests to the client service and whether the requests were faults or
+	// okay.
+	RootCauseServiceRequestImpactStatistics *RequestImpactStatistics `type:"structure"`
+
+	// The time, in Unix seconds, at which the insight began.
+	StartTime *time.Time `type:"timestamp"`
+
+	// The current state of the insight.
+	State *string `type:"string" enum:"InsightState"`
+
+	// A brief description of the insight.
+	Summary *string `type:"string"`
+
+	// The service within the insight that is most impacted by the incident.
+	TopAnomalousServices []*AnomalousService `type:"list"`
 }
 
-// String returns the string representation
-func (s GetGroupsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Insight) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetGroupsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Insight) GoString() string {
 	return s.String()
 }
 
-// SetGroups sets the Groups field's value.
-func (s *GetGroupsOutput) SetGroups(v []*GroupSummary) *GetGroupsOutput {
-	s.Groups = v
+// SetCategories sets the Categories field's value.
+func (s *Insight) SetCategories(v []*string) *Insight {
+	s.Categories = v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *GetGroupsOutput) SetNextToken(v string) *GetGroupsOutput {
-	s.NextToken = &v
+// SetClientRequestImpactStatistics sets the ClientRequestImpactStatistics field's value.
+func (s *Insight) SetClientRequestImpactStatistics(v *RequestImpactStatistics) *Insight {
+	s.ClientRequestImpactStatistics = v
 	return s
 }
 
-type GetSamplingRulesInput struct {
-	_ struct{} `type:"structure"`
-
-	// Pagination token. Not used.
-	NextToken *string `type:"string"`
-}
-
-// String returns the string representation
-func (s GetSamplingRulesInput) String() string {
-	return awsutil.Prettify(s)
+// SetEndTime sets the EndTime field's value.
+func (s *Insight) SetEndTime(v time.Time) *Insight {
+	s.EndTime = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s GetSamplingRulesInput) GoString() string {
-	return s.String()
+// SetGroupARN sets the GroupARN field's value.
+func (s *Insight) SetGroupARN(v string) *Insight {
+	s.GroupARN = &v
+	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *GetSamplingRulesInput) SetNextToken(v string) *GetSamplingRulesInput {
-	s.NextToken = &v
+// SetGroupName sets the GroupName field's value.
+func (s *Insight) SetGroupName(v string) *Insight {
+	s.GroupName = &v
 	return s
 }
 
-type GetSamplingRulesOutput struct {
-	_ struct{} `type:"structure"`
+// SetInsightId sets the InsightId field's value.
+func (s *Insight) SetInsightId(v string) *Insight {
+	s.InsightId = &v
+	return s
+}
 
-	// Pagination token. Not used.
-	NextToken *string `type:"string"`
+// SetRootCauseServiceId sets the RootCauseServiceId field's value.
+func (s *Insight) SetRootCauseServiceId(v *ServiceId) *Insight {
+	s.RootCauseServiceId = v
+	return s
+}
 
-	// Rule definitions and metadata.
-	SamplingRuleRecords []*SamplingRuleRecord `type:"list"`
+// SetRootCauseServiceRequestImpactStatistics sets the RootCauseServiceRequestImpactStatistics field's value.
+func (s *Insight) SetRootCauseServiceRequestImpactStatistics(v *RequestImpactStatistics) *Insight {
+	s.RootCauseServiceRequestImpactStatistics = v
+	return s
 }
 
-// String returns the string representation
-func (s GetSamplingRulesOutput) String() string {
-	return awsutil.Prettify(s)
+// SetStartTime sets the StartTime field's value.
+func (s *Insight) SetStartTime(v time.Time) *Insight {
+	s.StartTime = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s GetSamplingRulesOutput) GoString() string {
-	return s.String()
+// SetState sets the State field's value.
+func (s *Insight) SetState(v string) *Insight {
+	s.State = &v
+	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *GetSamplingRulesOutput) SetNextToken(v string) *GetSamplingRulesOutput {
-	s.NextToken = &v
+// SetSummary sets the Summary field's value.
+func (s *Insight) SetSummary(v string) *Insight {
+	s.Summary = &v
 	return s
 }
 
-// SetSamplingRuleRecords sets the SamplingRuleRecords field's value.
-func (s *GetSamplingRulesOutput) SetSamplingRuleRecords(v []*SamplingRuleRecord) *GetSamplingRulesOutput {
-	s.SamplingRuleRecords = v
+// SetTopAnomalousServices sets the TopAnomalousServices field's value.
+func (s *Insight) SetTopAnomalousServices(v []*AnomalousService) *Insight {
+	s.TopAnomalousServices = v
 	return s
 }
 
-type GetSamplingStatisticSummariesInput struct {
+// X-Ray reevaluates insights periodically until they are resolved, and records
+// each intermediate state in an event. You can review incident events in the
+// Impact Timeline on the Inspect page in the X-Ray console.
+type InsightEvent struct {
 	_ struct{} `type:"structure"`
 
-	// Pagination token. Not used.
-	NextToken *string `type:"string"`
+	// The impact statistics of the client side service. This includes the number
+	// of requests to the client service and whether the requests were faults or
+	// okay.
+	ClientRequestImpactStatistics *RequestImpactStatistics `type:"structure"`
+
+	// The time, in Unix seconds, at which the event was recorded.
+	EventTime *time.Time `type:"timestamp"`
+
+	// The impact statistics of the root cause service. This includes the number
+	// of requests to the client service and whether the requests were faults or
+	// okay.
+	RootCauseServiceRequestImpactStatistics *RequestImpactStatistics `type:"structure"`
+
+	// A brief description of the event.
+	Summary *string `type:"string"`
+
+	// The service during the event that is most impacted by the incident.
+	TopAnomalousServices []*AnomalousService `type:"list"`
 }
 
-// String returns the string representation
-func (s GetSamplingStatisticSummariesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InsightEvent) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetSamplingStatisticSummariesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InsightEvent) GoString() string {
 	return s.String()
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *GetSamplingStatisticSummariesInput) SetNextToken(v string) *GetSamplingStatisticSummariesInput {
-	s.NextToken = &v
+// SetClientRequestImpactStatistics sets the ClientRequestImpactStatistics field's value.
+func (s *InsightEvent) SetClientRequestImpactStatistics(v *RequestImpactStatistics) *InsightEvent {
+	s.ClientRequestImpactStatistics = v
 	return s
 }
 
-type GetSamplingStatisticSummariesOutput struct {
-	_ struct{} `type:"structure"`
-
-	// Pagination token. Not used.
-	NextToken *string `type:"string"`
-
-	// Information about the number of requests instrumented for each sampling rule.
-	SamplingStatisticSummaries []*SamplingStatisticSummary `type:"list"`
-}
-
-// String returns the string representation
-func (s GetSamplingStatisticSummariesOutput) String() string {
-	return awsutil.Prettify(s)
+// SetEventTime sets the EventTime field's value.
+func (s *InsightEvent) SetEventTime(v time.Time) *InsightEvent {
+	s.EventTime = &v
+	return s
 }
 
-// GoString returns the string representation
-func (s GetSamplingStatisticSummariesOutput) GoString() string {
-	return s.String()
+// SetRootCauseServiceRequestImpactStatistics sets the RootCauseServiceRequestImpactStatistics field's value.
+func (s *InsightEvent) SetRootCauseServiceRequestImpactStatistics(v *RequestImpactStatistics) *InsightEvent {
+	s.RootCauseServiceRequestImpactStatistics = v
+	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *GetSamplingStatisticSummariesOutput) SetNextToken(v string) *GetSamplingStatisticSummariesOutput {
-	s.NextToken = &v
+// SetSummary sets the Summary field's value.
+func (s *InsightEvent) SetSummary(v string) *InsightEvent {
+	s.Summary = &v
 	return s
 }
 
-// SetSamplingStatisticSummaries sets the SamplingStatisticSummaries field's value.
-func (s *GetSamplingStatisticSummariesOutput) SetSamplingStatisticSummaries(v []*SamplingStatisticSummary) *GetSamplingStatisticSummariesOutput {
-	s.SamplingStatisticSummaries = v
+// SetTopAnomalousServices sets the TopAnomalousServices field's value.
+func (s *InsightEvent) SetTopAnomalousServices(v []*AnomalousService) *InsightEvent {
+	s.TopAnomalousServices = v
 	return s
 }
 
-type GetSamplingTargetsInput struct {
+// The connection between two service in an insight impact graph.
+type InsightImpactGraphEdge struct {
 	_ struct{} `type:"structure"`
 
-	// Information about rules that the service is using to sample requests.
-	//
-	// SamplingStatisticsDocuments is a required field
-	SamplingStatisticsDocuments []*SamplingStatisticsDocument `type:"list" required:"true"`
+	// Identifier of the edge. Unique within a service map.
+	ReferenceId *int64 `type:"integer"`
 }
 
-// String returns the string representation
-func (s GetSamplingTargetsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InsightImpactGraphEdge) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetSamplingTargetsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InsightImpactGraphEdge) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetSamplingTargetsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetSamplingTargetsInput"}
-	if s.SamplingStatisticsDocuments == nil {
-		invalidParams.Add(request.NewErrParamRequired("SamplingStatisticsDocuments"))
-	}
-	if s.SamplingStatisticsDocuments != nil {
-		for i, v := range s.SamplingStatisticsDocuments {
-			if v == nil {
-				continue
-			}
-			if err := v.Validate(); err != nil {
-				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "SamplingStatisticsDocuments", i), err.(request.ErrInvalidParams))
-			}
-		}
-	}
-
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
-}
-
-// SetSamplingStatisticsDocuments sets the SamplingStatisticsDocuments field's value.
-func (s *GetSamplingTargetsInput) SetSamplingStatisticsDocuments(v []*SamplingStatisticsDocument) *GetSamplingTargetsInput {
-	s.SamplingStatisticsDocuments = v
+// SetReferenceId sets the ReferenceId field's value.
+func (s *InsightImpactGraphEdge) SetReferenceId(v int64) *InsightImpactGraphEdge {
+	s.ReferenceId = &v
 	return s
 }
 
-type GetSamplingTargetsOutput struct {
+// Information about an application that processed requests, users that made
+// requests, or downstream services, resources, and applications that an application
+// used.
+type InsightImpactGraphService struct {
 	_ struct{} `type:"structure"`
 
-	// The last time a user changed the sampling rule configuration. If the sampling
-	// rule configuration changed since the service last retrieved it, the service
-	// should call GetSamplingRules to get the latest version.
-	LastRuleModification *time.Time `type:"timestamp"`
+	// Identifier of the Amazon Web Services account in which the service runs.
+	AccountId *string `type:"string"`
 
-	// Updated rules that the service should use to sample requests.
-	SamplingTargetDocuments []*SamplingTargetDocument `type:"list"`
+	// Connections to downstream services.
+	Edges []*InsightImpactGraphEdge `type:"list"`
 
-	// Information about SamplingStatisticsDocument that X-Ray could not process.
-	UnprocessedStatistics []*UnprocessedStatistics `type:"list"`
+	// The canonical name of the service.
+	Name *string `type:"string"`
+
+	// A list of names for the service, including the canonical name.
+	Names []*string `type:"list"`
+
+	// Identifier for the service. Unique within the service map.
+	ReferenceId *int64 `type:"integer"`
+
+	// Identifier for the service. Unique within the service map.
+	//
+	//    * Amazon Web Services Resource - The type of an Amazon Web Services resource.
+	//    For example, AWS::EC2::Instance for an application running on Amazon EC2
+	//    or AWS::DynamoDB::Table for an Amazon DynamoDB table that the application
+	//    used.
+	//
+	//    * Amazon Web Services Service - The type of an Amazon Web Services service.
+	//    For example, AWS::DynamoDB for downstream calls to Amazon DynamoDB that
+	//    didn't target a specific table.
+	//
+	//    * Amazon Web Services Service - The type of an Amazon Web Services service.
+	//    For example, AWS::DynamoDB for downstream calls to Amazon DynamoDB that
+	//    didn't target a specific table.
+	//
+	//    * remote - A downstream service of indeterminate type.
+	Type *string `type:"string"`
 }
 
-// String returns the string representation
-func (s GetSamplingTargetsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InsightImpactGraphService) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetSamplingTargetsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InsightImpactGraphService) GoString() string {
 	return s.String()
 }
 
-// SetLastRuleModification sets the LastRuleModification field's value.
-func (s *GetSamplingTargetsOutput) SetLastRuleModification(v time.Time) *GetSamplingTargetsOutput {
-	s.LastRuleModification = &v
+// SetAccountId sets the AccountId field's value.
+func (s *InsightImpactGraphService) SetAccountId(v string) *InsightImpactGraphService {
+	s.AccountId = &v
 	return s
 }
 
-// SetSamplingTargetDocuments sets the SamplingTargetDocuments field's value.
-func (s *GetSamplingTargetsOutput) SetSamplingTargetDocuments(v []*SamplingTargetDocument) *GetSamplingTargetsOutput {
-	s.SamplingTargetDocuments = v
+// SetEdges sets the Edges field's value.
+func (s *InsightImpactGraphService) SetEdges(v []*InsightImpactGraphEdge) *InsightImpactGraphService {
+	s.Edges = v
 	return s
 }
 
-// SetUnprocessedStatistics sets the UnprocessedStatistics field's value.
-func (s *GetSamplingTargetsOutput) SetUnprocessedStatistics(v []*UnprocessedStatistics) *GetSamplingTargetsOutput {
-	s.UnprocessedStatistics = v
+// SetName sets the Name field's value.
+func (s *InsightImpactGraphService) SetName(v string) *InsightImpactGraphService {
+	s.Name = &v
 	return s
 }
 
-type GetServiceGraphInput struct {
+// SetNames sets the Names field's value.
+func (s *InsightImpactGraphService) SetNames(v []*string) *InsightImpactGraphService {
+	s.Names = v
+	return s
+}
+
+// SetReferenceId sets the ReferenceId field's value.
+func (s *InsightImpactGraphService) SetReferenceId(v int64) *InsightImpactGraphService {
+	s.ReferenceId = &v
+	return s
+}
+
+// SetType sets the Type field's value.
+func (s *InsightImpactGraphService) SetType(v string) *InsightImpactGraphService {
+	s.Type = &v
+	return s
+}
+
+// Information that describes an insight.
+type InsightSummary struct {
 	_ struct{} `type:"structure"`
 
-	// The end of the timeframe for which to generate a graph.
-	//
-	// EndTime is a required field
-	EndTime *time.Time `type:"timestamp" required:"true"`
+	// Categories The categories that label and describe the type of insight.
+	Categories []*string `type:"list" enum:"InsightCategory"`
+
+	// The impact statistics of the client side service. This includes the number
+	// of requests to the client service and whether the requests were faults or
+	// okay.
+	ClientRequestImpactStatistics *RequestImpactStatistics `type:"structure"`
+
+	// The time, in Unix seconds, at which the insight ended.
+	EndTime *time.Time `type:"timestamp"`
 
-	// The ARN of a group to generate a graph based on.
+	// The Amazon Resource Name (ARN) of the group that the insight belongs to.
 	GroupARN *string `min:"1" type:"string"`
 
-	// The name of a group to generate a graph based on.
+	// The name of the group that the insight belongs to.
 	GroupName *string `min:"1" type:"string"`
 
-	// Pagination token. Not used.
-	NextToken *string `type:"string"`
+	// The insights unique identifier.
+	InsightId *string `type:"string"`
 
-	// The start of the time frame for which to generate a graph.
-	//
-	// StartTime is a required field
-	StartTime *time.Time `type:"timestamp" required:"true"`
+	// The time, in Unix seconds, that the insight was last updated.
+	LastUpdateTime *time.Time `type:"timestamp"`
+
+	RootCauseServiceId *ServiceId `type:"structure"`
+
+	// The impact statistics of the root cause service. This includes the number
+	// of requests to the client service and whether the requests were faults or
+	// okay.
+	RootCauseServiceRequestImpactStatistics *RequestImpactStatistics `type:"structure"`
+
+	// The time, in Unix seconds, at which the insight began.
+	StartTime *time.Time `type:"timestamp"`
+
+	// The current state of the insight.
+	State *string `type:"string" enum:"InsightState"`
+
+	// A brief description of the insight.
+	Summary *string `type:"string"`
+
+	// The service within the insight that is most impacted by the incident.
+	TopAnomalousServices []*AnomalousService `type:"list"`
 }
 
-// String returns the string representation
-func (s GetServiceGraphInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InsightSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetServiceGraphInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InsightSummary) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetServiceGraphInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetServiceGraphInput"}
-	if s.EndTime == nil {
-		invalidParams.Add(request.NewErrParamRequired("EndTime"))
-	}
-	if s.GroupARN != nil && len(*s.GroupARN) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("GroupARN", 1))
-	}
-	if s.GroupName != nil && len(*s.GroupName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("GroupName", 1))
-	}
-	if s.StartTime == nil {
-		invalidParams.Add(request.NewErrParamRequired("StartTime"))
-	}
+// SetCategories sets the Categories field's value.
+func (s *InsightSummary) SetCategories(v []*string) *InsightSummary {
+	s.Categories = v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// SetClientRequestImpactStatistics sets the ClientRequestImpactStatistics field's value.
+func (s *InsightSummary) SetClientRequestImpactStatistics(v *RequestImpactStatistics) *InsightSummary {
+	s.ClientRequestImpactStatistics = v
+	return s
 }
 
 // SetEndTime sets the EndTime field's value.
-func (s *GetServiceGraphInput) SetEndTime(v time.Time) *GetServiceGraphInput {
+func (s *InsightSummary) SetEndTime(v time.Time) *InsightSummary {
 	s.EndTime = &v
 	return s
 }
 
 // SetGroupARN sets the GroupARN field's value.
-func (s *GetServiceGraphInput) SetGroupARN(v string) *GetServiceGraphInput {
+func (s *InsightSummary) SetGroupARN(v string) *InsightSummary {
 	s.GroupARN = &v
 	return s
 }
 
 // SetGroupName sets the GroupName field's value.
-func (s *GetServiceGraphInput) SetGroupName(v string) *GetServiceGraphInput {
+func (s *InsightSummary) SetGroupName(v string) *InsightSummary {
 	s.GroupName = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *GetServiceGraphInput) SetNextToken(v string) *GetServiceGraphInput {
-	s.NextToken = &v
+// SetInsightId sets the InsightId field's value.
+func (s *InsightSummary) SetInsightId(v string) *InsightSummary {
+	s.InsightId = &v
 	return s
 }
 
-// SetStartTime sets the StartTime field's value.
-func (s *GetServiceGraphInput) SetStartTime(v time.Time) *GetServiceGraphInput {
-	s.StartTime = &v
+// SetLastUpdateTime sets the LastUpdateTime field's value.
+func (s *InsightSummary) SetLastUpdateTime(v time.Time) *InsightSummary {
+	s.LastUpdateTime = &v
 	return s
 }
 
-type GetServiceGraphOutput struct {
-	_ struct{} `type:"structure"`
-
-	// A flag indicating whether the group's filter expression has been consistent,
-	// or if the returned service graph may show traces from an older version of
-	// the group's filter expression.
-	ContainsOldGroupVersions *bool `type:"boolean"`
-
-	// The end of the time frame for which the graph was generated.
-	EndTime *time.Time `type:"timestamp"`
-
-	// Pagination token. Not used.
-	NextToken *string `type:"string"`
-
-	// The services that have processed a traced request during the specified time
-	// frame.
-	Services []*Service `type:"list"`
-
-	// The start of the time frame for which the graph was generated.
-	StartTime *time.Time `type:"timestamp"`
-}
-
-// String returns the string representation
-func (s GetServiceGraphOutput) String() string {
-	return awsutil.Prettify(s)
-}
-
-// GoString returns the string representation
-func (s GetServiceGraphOutput) GoString() string {
-	return s.String()
+// SetRootCauseServiceId sets the RootCauseServiceId field's value.
+func (s *InsightSummary) SetRootCauseServiceId(v *ServiceId) *InsightSummary {
+	s.RootCauseServiceId = v
+	return s
 }
 
-// SetContainsOldGroupVersions sets the ContainsOldGroupVersions field's value.
-func (s *GetServiceGraphOutput) SetContainsOldGroupVersions(v bool) *GetServiceGraphOutput {
-	s.ContainsOldGroupVersions = &v
+// SetRootCauseServiceRequestImpactStatistics sets the RootCauseServiceRequestImpactStatistics field's value.
+func (s *InsightSummary) SetRootCauseServiceRequestImpactStatistics(v *RequestImpactStatistics) *InsightSummary {
+	s.RootCauseServiceRequestImpactStatistics = v
 	return s
 }
 
-// SetEndTime sets the EndTime field's value.
-func (s *GetServiceGraphOutput) SetEndTime(v time.Time) *GetServiceGraphOutput {
-	s.EndTime = &v
+// SetStartTime sets the StartTime field's value.
+func (s *InsightSummary) SetStartTime(v time.Time) *InsightSummary {
+	s.StartTime = &v
 	return s
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *GetServiceGraphOutput) SetNextToken(v string) *GetServiceGraphOutput {
-	s.NextToken = &v
+// SetState sets the State field's value.
+func (s *InsightSummary) SetState(v string) *InsightSummary {
+	s.State = &v
 	return s
 }
 
-// SetServices sets the Services field's value.
-func (s *GetServiceGraphOutput) SetServices(v []*Service) *GetServiceGraphOutput {
-	s.Services = v
+// SetSummary sets the Summary field's value.
+func (s *InsightSummary) SetSummary(v string) *InsightSummary {
+	s.Summary = &v
 	return s
 }
 
-// SetStartTime sets the StartTime field's value.
-func (s *GetServiceGraphOutput) SetStartTime(v time.Time) *GetServiceGraphOutput {
-	s.StartTime = &v
+// SetTopAnomalousServices sets the TopAnomalousServices field's value.
+func (s *InsightSummary) SetTopAnomalousServices(v []*AnomalousService) *InsightSummary {
+	s.TopAnomalousServices = v
 	return s
 }
 
-type GetTimeSeriesServiceStatisticsInput struct {
+// The structure containing configurations related to insights.
+type InsightsConfiguration struct {
 	_ struct{} `type:"structure"`
 
-	// The end of the time frame for which to aggregate statistics.
-	//
-	// EndTime is a required field
-	EndTime *time.Time `type:"timestamp" required:"true"`
+	// Set the InsightsEnabled value to true to enable insights or false to disable
+	// insights.
+	InsightsEnabled *bool `type:"boolean"`
 
-	// A filter expression defining entities that will be aggregated for statistics.
-	// Supports ID, service, and edge functions. If no selector expression is specified,
-	// edge statistics are returned.
-	EntitySelectorExpression *string `min:"1" type:"string"`
+	// Set the NotificationsEnabled value to true to enable insights notifications.
+	// Notifications can only be enabled on a group with InsightsEnabled set to
+	// true.
+	NotificationsEnabled *bool `type:"boolean"`
+}
 
-	// The ARN of the group for which to pull statistics from.
-	GroupARN *string `min:"1" type:"string"`
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InsightsConfiguration) String() string {
+	return awsutil.Prettify(s)
+}
 
-	// The case-sensitive name of the group for which to pull statistics from.
-	GroupName *string `min:"1" type:"string"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InsightsConfiguration) GoString() string {
+	return s.String()
+}
 
-	// Pagination token. Not used.
-	NextToken *string `type:"string"`
+// SetInsightsEnabled sets the InsightsEnabled field's value.
+func (s *InsightsConfiguration) SetInsightsEnabled(v bool) *InsightsConfiguration {
+	s.InsightsEnabled = &v
+	return s
+}
 
-	// Aggregation period in seconds.
-	Period *int64 `type:"integer"`
+// SetNotificationsEnabled sets the NotificationsEnabled field's value.
+func (s *InsightsConfiguration) SetNotificationsEnabled(v bool) *InsightsConfiguration {
+	s.NotificationsEnabled = &v
+	return s
+}
 
-	// The start of the time frame for which to aggregate statistics.
-	//
-	// StartTime is a required field
-	StartTime *time.Time `type:"timestamp" required:"true"`
+// A list of EC2 instance IDs corresponding to the segments in a trace.
+type InstanceIdDetail struct {
+	_ struct{} `type:"structure"`
+
+	// The ID of a corresponding EC2 instance.
+	Id *string `type:"string"`
 }
 
-// String returns the string representation
-func (s GetTimeSeriesServiceStatisticsInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InstanceIdDetail) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetTimeSeriesServiceStatisticsInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InstanceIdDetail) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetTimeSeriesServiceStatisticsInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetTimeSeriesServiceStatisticsInput"}
-	if s.EndTime == nil {
-		invalidParams.Add(request.NewErrParamRequired("EndTime"))
-	}
-	if s.EntitySelectorExpression != nil && len(*s.EntitySelectorExpression) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("EntitySelectorExpression", 1))
-	}
-	if s.GroupARN != nil && len(*s.GroupARN) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("GroupARN", 1))
-	}
-	if s.GroupName != nil && len(*s.GroupName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("GroupName", 1))
-	}
-	if s.StartTime == nil {
-		invalidParams.Add(request.NewErrParamRequired("StartTime"))
-	}
+// SetId sets the Id field's value.
+func (s *InstanceIdDetail) SetId(v string) *InstanceIdDetail {
+	s.Id = &v
+	return s
+}
 
-	if invalidParams.Len() > 0 {
-		return invalidParams
-	}
-	return nil
+// A policy revision id was provided which does not match the latest policy
+// revision. This exception is also if a policy revision id of 0 is provided
+// via PutResourcePolicy and a policy with the same name already exists.
+type InvalidPolicyRevisionIdException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
 }
 
-// SetEndTime sets the EndTime field's value.
-func (s *GetTimeSeriesServiceStatisticsInput) SetEndTime(v time.Time) *GetTimeSeriesServiceStatisticsInput {
-	s.EndTime = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidPolicyRevisionIdException) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetEntitySelectorExpression sets the EntitySelectorExpression field's value.
-func (s *GetTimeSeriesServiceStatisticsInput) SetEntitySelectorExpression(v string) *GetTimeSeriesServiceStatisticsInput {
-	s.EntitySelectorExpression = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidPolicyRevisionIdException) GoString() string {
+	return s.String()
 }
 
-// SetGroupARN sets the GroupARN field's value.
-func (s *GetTimeSeriesServiceStatisticsInput) SetGroupARN(v string) *GetTimeSeriesServiceStatisticsInput {
-	s.GroupARN = &v
-	return s
+func newErrorInvalidPolicyRevisionIdException(v protocol.ResponseMetadata) error {
+	return &InvalidPolicyRevisionIdException{
+		RespMetadata: v,
+	}
 }
 
-// SetGroupName sets the GroupName field's value.
-func (s *GetTimeSeriesServiceStatisticsInput) SetGroupName(v string) *GetTimeSeriesServiceStatisticsInput {
-	s.GroupName = &v
-	return s
+// Code returns the exception type name.
+func (s *InvalidPolicyRevisionIdException) Code() string {
+	return "InvalidPolicyRevisionIdException"
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *GetTimeSeriesServiceStatisticsInput) SetNextToken(v string) *GetTimeSeriesServiceStatisticsInput {
-	s.NextToken = &v
-	return s
+// Message returns the exception's message.
+func (s *InvalidPolicyRevisionIdException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// SetPeriod sets the Period field's value.
-func (s *GetTimeSeriesServiceStatisticsInput) SetPeriod(v int64) *GetTimeSeriesServiceStatisticsInput {
-	s.Period = &v
-	return s
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidPolicyRevisionIdException) OrigErr() error {
+	return nil
 }
 
-// SetStartTime sets the StartTime field's value.
-func (s *GetTimeSeriesServiceStatisticsInput) SetStartTime(v time.Time) *GetTimeSeriesServiceStatisticsInput {
-	s.StartTime = &v
-	return s
+func (s *InvalidPolicyRevisionIdException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-type GetTimeSeriesServiceStatisticsOutput struct {
-	_ struct{} `type:"structure"`
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidPolicyRevisionIdException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
 
-	// A flag indicating whether or not a group's filter expression has been consistent,
-	// or if a returned aggregation may show statistics from an older version of
-	// the group's filter expression.
-	ContainsOldGroupVersions *bool `type:"boolean"`
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidPolicyRevisionIdException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
 
-	// Pagination token. Not used.
-	NextToken *string `type:"string"`
+// The request is missing required parameters or has invalid parameters.
+type InvalidRequestException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The collection of statistics.
-	TimeSeriesServiceStatistics []*TimeSeriesServiceStatistics `type:"list"`
+	Message_ *string `locationName:"Message" type:"string"`
 }
 
-// String returns the string representation
-func (s GetTimeSeriesServiceStatisticsOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidRequestException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetTimeSeriesServiceStatisticsOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s InvalidRequestException) GoString() string {
 	return s.String()
 }
 
-// SetContainsOldGroupVersions sets the ContainsOldGroupVersions field's value.
-func (s *GetTimeSeriesServiceStatisticsOutput) SetContainsOldGroupVersions(v bool) *GetTimeSeriesServiceStatisticsOutput {
-	s.ContainsOldGroupVersions = &v
-	return s
+func newErrorInvalidRequestException(v protocol.ResponseMetadata) error {
+	return &InvalidRequestException{
+		RespMetadata: v,
+	}
 }
 
-// SetNextToken sets the NextToken field's value.
-func (s *GetTimeSeriesServiceStatisticsOutput) SetNextToken(v string) *GetTimeSeriesServiceStatisticsOutput {
-	s.NextToken = &v
-	return s
+// Code returns the exception type name.
+func (s *InvalidRequestException) Code() string {
+	return "InvalidRequestException"
 }
 
-// SetTimeSeriesServiceStatistics sets the TimeSeriesServiceStatistics field's value.
-func (s *GetTimeSeriesServiceStatisticsOutput) SetTimeSeriesServiceStatistics(v []*TimeSeriesServiceStatistics) *GetTimeSeriesServiceStatisticsOutput {
-	s.TimeSeriesServiceStatistics = v
-	return s
+// Message returns the exception's message.
+func (s *InvalidRequestException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-type GetTraceGraphInput struct {
-	_ struct{} `type:"structure"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *InvalidRequestException) OrigErr() error {
+	return nil
+}
 
-	// Pagination token. Not used.
-	NextToken *string `type:"string"`
+func (s *InvalidRequestException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
 
-	// Trace IDs of requests for which to generate a service graph.
-	//
-	// TraceIds is a required field
-	TraceIds []*string `type:"list" required:"true"`
+// Status code returns the HTTP status code for the request's response error.
+func (s *InvalidRequestException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// String returns the string representation
-func (s GetTraceGraphInput) String() string {
+// RequestID returns the service's response RequestID for request.
+func (s *InvalidRequestException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+type ListResourcePoliciesInput struct {
+	_ struct{} `type:"structure"`
+
+	// Not currently supported.
+	NextToken *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListResourcePoliciesInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetTraceGraphInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListResourcePoliciesInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *GetTraceGraphInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetTraceGraphInput"}
-	if s.TraceIds == nil {
-		invalidParams.Add(request.NewErrParamRequired("TraceIds"))
+func (s *ListResourcePoliciesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListResourcePoliciesInput"}
+	if s.NextToken != nil && len(*s.NextToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -3935,100 +7402,91 @@ func (s *GetTraceGraphInput) Validate() error {
 }
 
 // SetNextToken sets the NextToken field's value.
-func (s *GetTraceGraphInput) SetNextToken(v string) *GetTraceGraphInput {
+func (s *ListResourcePoliciesInput) SetNextToken(v string) *ListResourcePoliciesInput {
 	s.NextToken = &v
 	return s
 }
 
-// SetTraceIds sets the TraceIds field's value.
-func (s *GetTraceGraphInput) SetTraceIds(v []*string) *GetTraceGraphInput {
-	s.TraceIds = v
-	return s
-}
-
-type GetTraceGraphOutput struct {
+type ListResourcePoliciesOutput struct {
 	_ struct{} `type:"structure"`
 
-	// Pagination token. Not used.
-	NextToken *string `type:"string"`
+	// Pagination token. Not currently supported.
+	NextToken *string `min:"1" type:"string"`
 
-	// The services that have processed one of the specified requests.
-	Services []*Service `type:"list"`
+	// The list of resource policies in the target Amazon Web Services account.
+	ResourcePolicies []*ResourcePolicy `type:"list"`
 }
 
-// String returns the string representation
-func (s GetTraceGraphOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListResourcePoliciesOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetTraceGraphOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListResourcePoliciesOutput) GoString() string {
 	return s.String()
 }
 
 // SetNextToken sets the NextToken field's value.
-func (s *GetTraceGraphOutput) SetNextToken(v string) *GetTraceGraphOutput {
+func (s *ListResourcePoliciesOutput) SetNextToken(v string) *ListResourcePoliciesOutput {
 	s.NextToken = &v
 	return s
 }
 
-// SetServices sets the Services field's value.
-func (s *GetTraceGraphOutput) SetServices(v []*Service) *GetTraceGraphOutput {
-	s.Services = v
+// SetResourcePolicies sets the ResourcePolicies field's value.
+func (s *ListResourcePoliciesOutput) SetResourcePolicies(v []*ResourcePolicy) *ListResourcePoliciesOutput {
+	s.ResourcePolicies = v
 	return s
 }
 
-type GetTraceSummariesInput struct {
+type ListTagsForResourceInput struct {
 	_ struct{} `type:"structure"`
 
-	// The end of the time frame for which to retrieve traces.
-	//
-	// EndTime is a required field
-	EndTime *time.Time `type:"timestamp" required:"true"`
-
-	// Specify a filter expression to retrieve trace summaries for services or requests
-	// that meet certain requirements.
-	FilterExpression *string `type:"string"`
-
-	// Specify the pagination token returned by a previous request to retrieve the
-	// next page of results.
+	// A pagination token. If multiple pages of results are returned, use the NextToken
+	// value returned with the current page of results as the value of this parameter
+	// to get the next page of results.
 	NextToken *string `type:"string"`
 
-	// Set to true to get summaries for only a subset of available traces.
-	Sampling *bool `type:"boolean"`
-
-	// A paramater to indicate whether to enable sampling on trace summaries. Input
-	// parameters are Name and Value.
-	SamplingStrategy *SamplingStrategy `type:"structure"`
-
-	// The start of the time frame for which to retrieve traces.
+	// The Amazon Resource Number (ARN) of an X-Ray group or sampling rule.
 	//
-	// StartTime is a required field
-	StartTime *time.Time `type:"timestamp" required:"true"`
-
-	// A parameter to indicate whether to query trace summaries by TraceId or Event
-	// time.
-	TimeRangeType *string `type:"string" enum:"TimeRangeType"`
+	// ResourceARN is a required field
+	ResourceARN *string `min:"1" type:"string" required:"true"`
 }
 
-// String returns the string representation
-func (s GetTraceSummariesInput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetTraceSummariesInput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceInput) GoString() string {
 	return s.String()
 }
 
 // Validate inspects the fields of the type to determine if they are valid.
-func (s *GetTraceSummariesInput) Validate() error {
-	invalidParams := request.ErrInvalidParams{Context: "GetTraceSummariesInput"}
-	if s.EndTime == nil {
-		invalidParams.Add(request.NewErrParamRequired("EndTime"))
+func (s *ListTagsForResourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsForResourceInput"}
+	if s.ResourceARN == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceARN"))
 	}
-	if s.StartTime == nil {
-		invalidParams.Add(request.NewErrParamRequired("StartTime"))
+	if s.ResourceARN != nil && len(*s.ResourceARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ResourceARN", 1))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -4037,311 +7495,327 @@ func (s *GetTraceSummariesInput) Validate() error {
 	return nil
 }
 
-// SetEndTime sets the EndTime field's value.
-func (s *GetTraceSummariesInput) SetEndTime(v time.Time) *GetTraceSummariesInput {
-	s.EndTime = &v
-	return s
-}
-
-// SetFilterExpression sets the FilterExpression field's value.
-func (s *GetTraceSummariesInput) SetFilterExpression(v string) *GetTraceSummariesInput {
-	s.FilterExpression = &v
-	return s
-}
-
 // SetNextToken sets the NextToken field's value.
-func (s *GetTraceSummariesInput) SetNextToken(v string) *GetTraceSummariesInput {
+func (s *ListTagsForResourceInput) SetNextToken(v string) *ListTagsForResourceInput {
 	s.NextToken = &v
 	return s
 }
 
-// SetSampling sets the Sampling field's value.
-func (s *GetTraceSummariesInput) SetSampling(v bool) *GetTraceSummariesInput {
-	s.Sampling = &v
-	return s
-}
-
-// SetSamplingStrategy sets the SamplingStrategy field's value.
-func (s *GetTraceSummariesInput) SetSamplingStrategy(v *SamplingStrategy) *GetTraceSummariesInput {
-	s.SamplingStrategy = v
-	return s
-}
-
-// SetStartTime sets the StartTime field's value.
-func (s *GetTraceSummariesInput) SetStartTime(v time.Time) *GetTraceSummariesInput {
-	s.StartTime = &v
-	return s
-}
-
-// SetTimeRangeType sets the TimeRangeType field's value.
-func (s *GetTraceSummariesInput) SetTimeRangeType(v string) *GetTraceSummariesInput {
-	s.TimeRangeType = &v
+// SetResourceARN sets the ResourceARN field's value.
+func (s *ListTagsForResourceInput) SetResourceARN(v string) *ListTagsForResourceInput {
+	s.ResourceARN = &v
 	return s
 }
 
-type GetTraceSummariesOutput struct {
+type ListTagsForResourceOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The start time of this page of results.
-	ApproximateTime *time.Time `type:"timestamp"`
-
-	// If the requested time frame contained more than one page of results, you
-	// can use this token to retrieve the next page. The first page contains the
-	// most most recent results, closest to the end of the time frame.
+	// A pagination token. If multiple pages of results are returned, use the NextToken
+	// value returned with the current page of results to get the next page of results.
 	NextToken *string `type:"string"`
 
-	// Trace IDs and metadata for traces that were found in the specified time frame.
-	TraceSummaries []*TraceSummary `type:"list"`
-
-	// The total number of traces processed, including traces that did not match
-	// the specified filter expression.
-	TracesProcessedCount *int64 `type:"long"`
+	// A list of tags, as key and value pairs, that is associated with the specified
+	// X-Ray group or sampling rule.
+	Tags []*Tag `type:"list"`
 }
 
-// String returns the string representation
-func (s GetTraceSummariesOutput) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GetTraceSummariesOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ListTagsForResourceOutput) GoString() string {
 	return s.String()
 }
 
-// SetApproximateTime sets the ApproximateTime field's value.
-func (s *GetTraceSummariesOutput) SetApproximateTime(v time.Time) *GetTraceSummariesOutput {
-	s.ApproximateTime = &v
-	return s
-}
-
 // SetNextToken sets the NextToken field's value.
-func (s *GetTraceSummariesOutput) SetNextToken(v string) *GetTraceSummariesOutput {
+func (s *ListTagsForResourceOutput) SetNextToken(v string) *ListTagsForResourceOutput {
 	s.NextToken = &v
 	return s
 }
 
-// SetTraceSummaries sets the TraceSummaries field's value.
-func (s *GetTraceSummariesOutput) SetTraceSummaries(v []*TraceSummary) *GetTraceSummariesOutput {
-	s.TraceSummaries = v
-	return s
-}
-
-// SetTracesProcessedCount sets the TracesProcessedCount field's value.
-func (s *GetTraceSummariesOutput) SetTracesProcessedCount(v int64) *GetTraceSummariesOutput {
-	s.TracesProcessedCount = &v
+// SetTags sets the Tags field's value.
+func (s *ListTagsForResourceOutput) SetTags(v []*Tag) *ListTagsForResourceOutput {
+	s.Tags = v
 	return s
 }
 
-// Details and metadata for a group.
-type Group struct {
-	_ struct{} `type:"structure"`
-
-	// The filter expression defining the parameters to include traces.
-	FilterExpression *string `type:"string"`
-
-	// The ARN of the group generated based on the GroupName.
-	GroupARN *string `type:"string"`
+// The provided resource policy would prevent the caller of this request from
+// calling PutResourcePolicy in the future.
+type LockoutPreventionException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
 
-	// The unique case-sensitive name of the group.
-	GroupName *string `type:"string"`
+	Message_ *string `locationName:"Message" type:"string"`
 }
 
-// String returns the string representation
-func (s Group) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LockoutPreventionException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s Group) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s LockoutPreventionException) GoString() string {
 	return s.String()
 }
 
-// SetFilterExpression sets the FilterExpression field's value.
-func (s *Group) SetFilterExpression(v string) *Group {
-	s.FilterExpression = &v
-	return s
+func newErrorLockoutPreventionException(v protocol.ResponseMetadata) error {
+	return &LockoutPreventionException{
+		RespMetadata: v,
+	}
 }
 
-// SetGroupARN sets the GroupARN field's value.
-func (s *Group) SetGroupARN(v string) *Group {
-	s.GroupARN = &v
-	return s
+// Code returns the exception type name.
+func (s *LockoutPreventionException) Code() string {
+	return "LockoutPreventionException"
 }
 
-// SetGroupName sets the GroupName field's value.
-func (s *Group) SetGroupName(v string) *Group {
-	s.GroupName = &v
-	return s
+// Message returns the exception's message.
+func (s *LockoutPreventionException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// Details for a group without metadata.
-type GroupSummary struct {
-	_ struct{} `type:"structure"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *LockoutPreventionException) OrigErr() error {
+	return nil
+}
 
-	// The filter expression defining the parameters to include traces.
-	FilterExpression *string `type:"string"`
+func (s *LockoutPreventionException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
 
-	// The ARN of the group generated based on the GroupName.
-	GroupARN *string `type:"string"`
+// Status code returns the HTTP status code for the request's response error.
+func (s *LockoutPreventionException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
 
-	// The unique case-sensitive name of the group.
-	GroupName *string `type:"string"`
+// RequestID returns the service's response RequestID for request.
+func (s *LockoutPreventionException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// String returns the string representation
-func (s GroupSummary) String() string {
+// Invalid policy document provided in request.
+type MalformedPolicyDocumentException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MalformedPolicyDocumentException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s GroupSummary) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s MalformedPolicyDocumentException) GoString() string {
 	return s.String()
 }
 
-// SetFilterExpression sets the FilterExpression field's value.
-func (s *GroupSummary) SetFilterExpression(v string) *GroupSummary {
-	s.FilterExpression = &v
-	return s
+func newErrorMalformedPolicyDocumentException(v protocol.ResponseMetadata) error {
+	return &MalformedPolicyDocumentException{
+		RespMetadata: v,
+	}
 }
 
-// SetGroupARN sets the GroupARN field's value.
-func (s *GroupSummary) SetGroupARN(v string) *GroupSummary {
-	s.GroupARN = &v
-	return s
+// Code returns the exception type name.
+func (s *MalformedPolicyDocumentException) Code() string {
+	return "MalformedPolicyDocumentException"
 }
 
-// SetGroupName sets the GroupName field's value.
-func (s *GroupSummary) SetGroupName(v string) *GroupSummary {
-	s.GroupName = &v
-	return s
+// Message returns the exception's message.
+func (s *MalformedPolicyDocumentException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
 }
 
-// An entry in a histogram for a statistic. A histogram maps the range of observed
-// values on the X axis, and the prevalence of each value on the Y axis.
-type HistogramEntry struct {
-	_ struct{} `type:"structure"`
-
-	// The prevalence of the entry.
-	Count *int64 `type:"integer"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *MalformedPolicyDocumentException) OrigErr() error {
+	return nil
+}
 
-	// The value of the entry.
-	Value *float64 `type:"double"`
+func (s *MalformedPolicyDocumentException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// String returns the string representation
-func (s HistogramEntry) String() string {
-	return awsutil.Prettify(s)
+// Status code returns the HTTP status code for the request's response error.
+func (s *MalformedPolicyDocumentException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// GoString returns the string representation
-func (s HistogramEntry) GoString() string {
-	return s.String()
+// RequestID returns the service's response RequestID for request.
+func (s *MalformedPolicyDocumentException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// SetCount sets the Count field's value.
-func (s *HistogramEntry) SetCount(v int64) *HistogramEntry {
-	s.Count = &v
-	return s
+// Exceeded the maximum number of resource policies for a target Amazon Web
+// Services account.
+type PolicyCountLimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
 }
 
-// SetValue sets the Value field's value.
-func (s *HistogramEntry) SetValue(v float64) *HistogramEntry {
-	s.Value = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PolicyCountLimitExceededException) String() string {
+	return awsutil.Prettify(s)
 }
 
-// Information about an HTTP request.
-type Http struct {
-	_ struct{} `type:"structure"`
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PolicyCountLimitExceededException) GoString() string {
+	return s.String()
+}
 
-	// The IP address of the requestor.
-	ClientIp *string `type:"string"`
+func newErrorPolicyCountLimitExceededException(v protocol.ResponseMetadata) error {
+	return &PolicyCountLimitExceededException{
+		RespMetadata: v,
+	}
+}
 
-	// The request method.
-	HttpMethod *string `type:"string"`
+// Code returns the exception type name.
+func (s *PolicyCountLimitExceededException) Code() string {
+	return "PolicyCountLimitExceededException"
+}
 
-	// The response status.
-	HttpStatus *int64 `type:"integer"`
+// Message returns the exception's message.
+func (s *PolicyCountLimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
 
-	// The request URL.
-	HttpURL *string `type:"string"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *PolicyCountLimitExceededException) OrigErr() error {
+	return nil
+}
 
-	// The request's user agent string.
-	UserAgent *string `type:"string"`
+func (s *PolicyCountLimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// String returns the string representation
-func (s Http) String() string {
-	return awsutil.Prettify(s)
+// Status code returns the HTTP status code for the request's response error.
+func (s *PolicyCountLimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// GoString returns the string representation
-func (s Http) GoString() string {
-	return s.String()
+// RequestID returns the service's response RequestID for request.
+func (s *PolicyCountLimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
-// SetClientIp sets the ClientIp field's value.
-func (s *Http) SetClientIp(v string) *Http {
-	s.ClientIp = &v
-	return s
+// Exceeded the maximum size for a resource policy.
+type PolicySizeLimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
 }
 
-// SetHttpMethod sets the HttpMethod field's value.
-func (s *Http) SetHttpMethod(v string) *Http {
-	s.HttpMethod = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PolicySizeLimitExceededException) String() string {
+	return awsutil.Prettify(s)
 }
 
-// SetHttpStatus sets the HttpStatus field's value.
-func (s *Http) SetHttpStatus(v int64) *Http {
-	s.HttpStatus = &v
-	return s
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PolicySizeLimitExceededException) GoString() string {
+	return s.String()
 }
 
-// SetHttpURL sets the HttpURL field's value.
-func (s *Http) SetHttpURL(v string) *Http {
-	s.HttpURL = &v
-	return s
+func newErrorPolicySizeLimitExceededException(v protocol.ResponseMetadata) error {
+	return &PolicySizeLimitExceededException{
+		RespMetadata: v,
+	}
 }
 
-// SetUserAgent sets the UserAgent field's value.
-func (s *Http) SetUserAgent(v string) *Http {
-	s.UserAgent = &v
-	return s
+// Code returns the exception type name.
+func (s *PolicySizeLimitExceededException) Code() string {
+	return "PolicySizeLimitExceededException"
 }
 
-// A list of EC2 instance IDs corresponding to the segments in a trace.
-type InstanceIdDetail struct {
-	_ struct{} `type:"structure"`
+// Message returns the exception's message.
+func (s *PolicySizeLimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
 
-	// The ID of a corresponding EC2 instance.
-	Id *string `type:"string"`
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *PolicySizeLimitExceededException) OrigErr() error {
+	return nil
 }
 
-// String returns the string representation
-func (s InstanceIdDetail) String() string {
-	return awsutil.Prettify(s)
+func (s *PolicySizeLimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
 }
 
-// GoString returns the string representation
-func (s InstanceIdDetail) GoString() string {
-	return s.String()
+// Status code returns the HTTP status code for the request's response error.
+func (s *PolicySizeLimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
 }
 
-// SetId sets the Id field's value.
-func (s *InstanceIdDetail) SetId(v string) *InstanceIdDetail {
-	s.Id = &v
-	return s
+// RequestID returns the service's response RequestID for request.
+func (s *PolicySizeLimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
 }
 
 type PutEncryptionConfigInput struct {
 	_ struct{} `type:"structure"`
 
-	// An AWS KMS customer master key (CMK) in one of the following formats:
+	// An Amazon Web Services KMS key in one of the following formats:
 	//
 	//    * Alias - The name of the key. For example, alias/MyKey.
 	//
 	//    * Key ID - The KMS key ID of the key. For example, ae4aa6d49-a4d8-9df9-a475-4ff6d7898456.
+	//    Amazon Web Services X-Ray does not support asymmetric KMS keys.
 	//
 	//    * ARN - The full Amazon Resource Name of the key ID or alias. For example,
 	//    arn:aws:kms:us-east-2:123456789012:key/ae4aa6d49-a4d8-9df9-a475-4ff6d7898456.
@@ -4357,12 +7831,20 @@ type PutEncryptionConfigInput struct {
 	Type *string `type:"string" required:"true" enum:"EncryptionType"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutEncryptionConfigInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutEncryptionConfigInput) GoString() string {
 	return s.String()
 }
@@ -4402,19 +7884,158 @@ type PutEncryptionConfigOutput struct {
 	EncryptionConfig *EncryptionConfig `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutEncryptionConfigOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s PutEncryptionConfigOutput) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutEncryptionConfigOutput) GoString() string {
+	return s.String()
+}
+
+// SetEncryptionConfig sets the EncryptionConfig field's value.
+func (s *PutEncryptionConfigOutput) SetEncryptionConfig(v *EncryptionConfig) *PutEncryptionConfigOutput {
+	s.EncryptionConfig = v
+	return s
+}
+
+type PutResourcePolicyInput struct {
+	_ struct{} `type:"structure"`
+
+	// A flag to indicate whether to bypass the resource policy lockout safety check.
+	//
+	// Setting this value to true increases the risk that the policy becomes unmanageable.
+	// Do not set this value to true indiscriminately.
+	//
+	// Use this parameter only when you include a policy in the request and you
+	// intend to prevent the principal that is making the request from making a
+	// subsequent PutResourcePolicy request.
+	//
+	// The default value is false.
+	BypassPolicyLockoutCheck *bool `type:"boolean"`
+
+	// The resource policy document, which can be up to 5kb in size.
+	//
+	// PolicyDocument is a required field
+	PolicyDocument *string `type:"string" required:"true"`
+
+	// The name of the resource policy. Must be unique within a specific Amazon
+	// Web Services account.
+	//
+	// PolicyName is a required field
+	PolicyName *string `min:"1" type:"string" required:"true"`
+
+	// Specifies a specific policy revision, to ensure an atomic create operation.
+	// By default the resource policy is created if it does not exist, or updated
+	// with an incremented revision id. The revision id is unique to each policy
+	// in the account.
+	//
+	// If the policy revision id does not match the latest revision id, the operation
+	// will fail with an InvalidPolicyRevisionIdException exception. You can also
+	// provide a PolicyRevisionId of 0. In this case, the operation will fail with
+	// an InvalidPolicyRevisionIdException exception if a resource policy with the
+	// same name already exists.
+	PolicyRevisionId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutResourcePolicyInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutResourcePolicyInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *PutResourcePolicyInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutResourcePolicyInput"}
+	if s.PolicyDocument == nil {
+		invalidParams.Add(request.NewErrParamRequired("PolicyDocument"))
+	}
+	if s.PolicyName == nil {
+		invalidParams.Add(request.NewErrParamRequired("PolicyName"))
+	}
+	if s.PolicyName != nil && len(*s.PolicyName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("PolicyName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetBypassPolicyLockoutCheck sets the BypassPolicyLockoutCheck field's value.
+func (s *PutResourcePolicyInput) SetBypassPolicyLockoutCheck(v bool) *PutResourcePolicyInput {
+	s.BypassPolicyLockoutCheck = &v
+	return s
+}
+
+// SetPolicyDocument sets the PolicyDocument field's value.
+func (s *PutResourcePolicyInput) SetPolicyDocument(v string) *PutResourcePolicyInput {
+	s.PolicyDocument = &v
+	return s
+}
+
+// SetPolicyName sets the PolicyName field's value.
+func (s *PutResourcePolicyInput) SetPolicyName(v string) *PutResourcePolicyInput {
+	s.PolicyName = &v
+	return s
+}
+
+// SetPolicyRevisionId sets the PolicyRevisionId field's value.
+func (s *PutResourcePolicyInput) SetPolicyRevisionId(v string) *PutResourcePolicyInput {
+	s.PolicyRevisionId = &v
+	return s
+}
+
+type PutResourcePolicyOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The resource policy document, as provided in the PutResourcePolicyRequest.
+	ResourcePolicy *ResourcePolicy `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutResourcePolicyOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s PutResourcePolicyOutput) GoString() string {
 	return s.String()
 }
 
-// SetEncryptionConfig sets the EncryptionConfig field's value.
-func (s *PutEncryptionConfigOutput) SetEncryptionConfig(v *EncryptionConfig) *PutEncryptionConfigOutput {
-	s.EncryptionConfig = v
+// SetResourcePolicy sets the ResourcePolicy field's value.
+func (s *PutResourcePolicyOutput) SetResourcePolicy(v *ResourcePolicy) *PutResourcePolicyOutput {
+	s.ResourcePolicy = v
 	return s
 }
 
@@ -4431,12 +8052,20 @@ type PutTelemetryRecordsInput struct {
 	TelemetryRecords []*TelemetryRecord `type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutTelemetryRecordsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutTelemetryRecordsInput) GoString() string {
 	return s.String()
 }
@@ -4492,12 +8121,20 @@ type PutTelemetryRecordsOutput struct {
 	_ struct{} `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutTelemetryRecordsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutTelemetryRecordsOutput) GoString() string {
 	return s.String()
 }
@@ -4511,12 +8148,20 @@ type PutTraceSegmentsInput struct {
 	TraceSegmentDocuments []*string `type:"list" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutTraceSegmentsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutTraceSegmentsInput) GoString() string {
 	return s.String()
 }
@@ -4547,12 +8192,20 @@ type PutTraceSegmentsOutput struct {
 	UnprocessedTraceSegments []*UnprocessedTraceSegment `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutTraceSegmentsOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s PutTraceSegmentsOutput) GoString() string {
 	return s.String()
 }
@@ -4563,6 +8216,56 @@ func (s *PutTraceSegmentsOutput) SetUnprocessedTraceSegments(v []*UnprocessedTra
 	return s
 }
 
+// Statistics that describe how the incident has impacted a service.
+type RequestImpactStatistics struct {
+	_ struct{} `type:"structure"`
+
+	// The number of requests that have resulted in a fault,
+	FaultCount *int64 `type:"long"`
+
+	// The number of successful requests.
+	OkCount *int64 `type:"long"`
+
+	// The total number of requests to the service.
+	TotalCount *int64 `type:"long"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RequestImpactStatistics) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RequestImpactStatistics) GoString() string {
+	return s.String()
+}
+
+// SetFaultCount sets the FaultCount field's value.
+func (s *RequestImpactStatistics) SetFaultCount(v int64) *RequestImpactStatistics {
+	s.FaultCount = &v
+	return s
+}
+
+// SetOkCount sets the OkCount field's value.
+func (s *RequestImpactStatistics) SetOkCount(v int64) *RequestImpactStatistics {
+	s.OkCount = &v
+	return s
+}
+
+// SetTotalCount sets the TotalCount field's value.
+func (s *RequestImpactStatistics) SetTotalCount(v int64) *RequestImpactStatistics {
+	s.TotalCount = &v
+	return s
+}
+
 // A list of resources ARNs corresponding to the segments in a trace.
 type ResourceARNDetail struct {
 	_ struct{} `type:"structure"`
@@ -4571,12 +8274,20 @@ type ResourceARNDetail struct {
 	ARN *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResourceARNDetail) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResourceARNDetail) GoString() string {
 	return s.String()
 }
@@ -4587,25 +8298,171 @@ func (s *ResourceARNDetail) SetARN(v string) *ResourceARNDetail {
 	return s
 }
 
+// The resource was not found. Verify that the name or Amazon Resource Name
+// (ARN) of the resource is correct.
+type ResourceNotFoundException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+
+	ResourceName *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceNotFoundException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourceNotFoundException) GoString() string {
+	return s.String()
+}
+
+func newErrorResourceNotFoundException(v protocol.ResponseMetadata) error {
+	return &ResourceNotFoundException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ResourceNotFoundException) Code() string {
+	return "ResourceNotFoundException"
+}
+
+// Message returns the exception's message.
+func (s *ResourceNotFoundException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ResourceNotFoundException) OrigErr() error {
+	return nil
+}
+
+func (s *ResourceNotFoundException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ResourceNotFoundException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ResourceNotFoundException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
+// A resource policy grants one or more Amazon Web Services services and accounts
+// permissions to access X-Ray. Each resource policy is associated with a specific
+// Amazon Web Services account.
+type ResourcePolicy struct {
+	_ struct{} `type:"structure"`
+
+	// When the policy was last updated, in Unix time seconds.
+	LastUpdatedTime *time.Time `type:"timestamp"`
+
+	// The resource policy document, which can be up to 5kb in size.
+	PolicyDocument *string `type:"string"`
+
+	// The name of the resource policy. Must be unique within a specific Amazon
+	// Web Services account.
+	PolicyName *string `min:"1" type:"string"`
+
+	// Returns the current policy revision id for this policy name.
+	PolicyRevisionId *string `type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourcePolicy) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ResourcePolicy) GoString() string {
+	return s.String()
+}
+
+// SetLastUpdatedTime sets the LastUpdatedTime field's value.
+func (s *ResourcePolicy) SetLastUpdatedTime(v time.Time) *ResourcePolicy {
+	s.LastUpdatedTime = &v
+	return s
+}
+
+// SetPolicyDocument sets the PolicyDocument field's value.
+func (s *ResourcePolicy) SetPolicyDocument(v string) *ResourcePolicy {
+	s.PolicyDocument = &v
+	return s
+}
+
+// SetPolicyName sets the PolicyName field's value.
+func (s *ResourcePolicy) SetPolicyName(v string) *ResourcePolicy {
+	s.PolicyName = &v
+	return s
+}
+
+// SetPolicyRevisionId sets the PolicyRevisionId field's value.
+func (s *ResourcePolicy) SetPolicyRevisionId(v string) *ResourcePolicy {
+	s.PolicyRevisionId = &v
+	return s
+}
+
 // The root cause information for a response time warning.
 type ResponseTimeRootCause struct {
 	_ struct{} `type:"structure"`
 
+	// A flag that denotes that the root cause impacts the trace client.
+	ClientImpacting *bool `type:"boolean"`
+
 	// A list of corresponding services. A service identifies a segment and contains
 	// a name, account ID, type, and inferred flag.
 	Services []*ResponseTimeRootCauseService `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResponseTimeRootCause) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResponseTimeRootCause) GoString() string {
 	return s.String()
 }
 
+// SetClientImpacting sets the ClientImpacting field's value.
+func (s *ResponseTimeRootCause) SetClientImpacting(v bool) *ResponseTimeRootCause {
+	s.ClientImpacting = &v
+	return s
+}
+
 // SetServices sets the Services field's value.
 func (s *ResponseTimeRootCause) SetServices(v []*ResponseTimeRootCauseService) *ResponseTimeRootCause {
 	s.Services = v
@@ -4617,7 +8474,7 @@ func (s *ResponseTimeRootCause) SetServices(v []*ResponseTimeRootCauseService) *
 type ResponseTimeRootCauseEntity struct {
 	_ struct{} `type:"structure"`
 
-	// The types and messages of the exceptions.
+	// The type and messages of the exceptions.
 	Coverage *float64 `type:"double"`
 
 	// The name of the entity.
@@ -4627,12 +8484,20 @@ type ResponseTimeRootCauseEntity struct {
 	Remote *bool `type:"boolean"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResponseTimeRootCauseEntity) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResponseTimeRootCauseEntity) GoString() string {
 	return s.String()
 }
@@ -4678,12 +8543,20 @@ type ResponseTimeRootCauseService struct {
 	Type *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResponseTimeRootCauseService) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ResponseTimeRootCauseService) GoString() string {
 	return s.String()
 }
@@ -4735,12 +8608,20 @@ type RootCauseException struct {
 	Name *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RootCauseException) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s RootCauseException) GoString() string {
 	return s.String()
 }
@@ -4757,6 +8638,70 @@ func (s *RootCauseException) SetName(v string) *RootCauseException {
 	return s
 }
 
+// You have reached the maximum number of sampling rules.
+type RuleLimitExceededException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RuleLimitExceededException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s RuleLimitExceededException) GoString() string {
+	return s.String()
+}
+
+func newErrorRuleLimitExceededException(v protocol.ResponseMetadata) error {
+	return &RuleLimitExceededException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *RuleLimitExceededException) Code() string {
+	return "RuleLimitExceededException"
+}
+
+// Message returns the exception's message.
+func (s *RuleLimitExceededException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *RuleLimitExceededException) OrigErr() error {
+	return nil
+}
+
+func (s *RuleLimitExceededException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *RuleLimitExceededException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *RuleLimitExceededException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // A sampling rule that services use to decide whether to instrument a request.
 // Rule fields can match properties of the service, or properties of a request.
 // The service can ignore rules that don't match its properties.
@@ -4794,7 +8739,8 @@ type SamplingRule struct {
 	// ReservoirSize is a required field
 	ReservoirSize *int64 `type:"integer" required:"true"`
 
-	// Matches the ARN of the AWS resource on which the service runs.
+	// Matches the ARN of the Amazon Web Services resource on which the service
+	// runs.
 	//
 	// ResourceARN is a required field
 	ResourceARN *string `type:"string" required:"true"`
@@ -4828,12 +8774,20 @@ type SamplingRule struct {
 	Version *int64 `min:"1" type:"integer" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SamplingRule) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SamplingRule) GoString() string {
 	return s.String()
 }
@@ -4965,7 +8919,8 @@ func (s *SamplingRule) SetVersion(v int64) *SamplingRule {
 	return s
 }
 
-// A SamplingRule and its metadata.
+// A SamplingRule (https://docs.aws.amazon.com/xray/latest/api/API_SamplingRule.html)
+// and its metadata.
 type SamplingRuleRecord struct {
 	_ struct{} `type:"structure"`
 
@@ -4979,12 +8934,20 @@ type SamplingRuleRecord struct {
 	SamplingRule *SamplingRule `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SamplingRuleRecord) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SamplingRuleRecord) GoString() string {
 	return s.String()
 }
@@ -5032,7 +8995,8 @@ type SamplingRuleUpdate struct {
 	// to all services using the rule collectively.
 	ReservoirSize *int64 `type:"integer"`
 
-	// Matches the ARN of the AWS resource on which the service runs.
+	// Matches the ARN of the Amazon Web Services resource on which the service
+	// runs.
 	ResourceARN *string `type:"string"`
 
 	// The ARN of the sampling rule. Specify a rule by either name or ARN, but not
@@ -5053,12 +9017,20 @@ type SamplingRuleUpdate struct {
 	URLPath *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SamplingRuleUpdate) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SamplingRuleUpdate) GoString() string {
 	return s.String()
 }
@@ -5149,7 +9121,7 @@ func (s *SamplingRuleUpdate) SetURLPath(v string) *SamplingRuleUpdate {
 }
 
 // Aggregated request sampling data for a sampling rule across all services
-// for a 10 second window.
+// for a 10-second window.
 type SamplingStatisticSummary struct {
 	_ struct{} `type:"structure"`
 
@@ -5169,12 +9141,20 @@ type SamplingStatisticSummary struct {
 	Timestamp *time.Time `type:"timestamp"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SamplingStatisticSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SamplingStatisticSummary) GoString() string {
 	return s.String()
 }
@@ -5211,7 +9191,7 @@ func (s *SamplingStatisticSummary) SetTimestamp(v time.Time) *SamplingStatisticS
 
 // Request sampling results for a single rule from a service. Results are for
 // the last 10 seconds unless the service has been assigned a longer reporting
-// interval after a previous call to GetSamplingTargets.
+// interval after a previous call to GetSamplingTargets (https://docs.aws.amazon.com/xray/latest/api/API_GetSamplingTargets.html).
 type SamplingStatisticsDocument struct {
 	_ struct{} `type:"structure"`
 
@@ -5244,12 +9224,20 @@ type SamplingStatisticsDocument struct {
 	Timestamp *time.Time `type:"timestamp" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SamplingStatisticsDocument) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SamplingStatisticsDocument) GoString() string {
 	return s.String()
 }
@@ -5332,12 +9320,20 @@ type SamplingStrategy struct {
 	Value *float64 `type:"double"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SamplingStrategy) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SamplingStrategy) GoString() string {
 	return s.String()
 }
@@ -5356,7 +9352,8 @@ func (s *SamplingStrategy) SetValue(v float64) *SamplingStrategy {
 
 // Temporary changes to a sampling rule configuration. To meet the global sampling
 // target for a rule, X-Ray calculates a new reservoir for each service based
-// on the recent sampling results of all services that called GetSamplingTargets.
+// on the recent sampling results of all services that called GetSamplingTargets
+// (https://docs.aws.amazon.com/xray/latest/api/API_GetSamplingTargets.html).
 type SamplingTargetDocument struct {
 	_ struct{} `type:"structure"`
 
@@ -5368,7 +9365,7 @@ type SamplingTargetDocument struct {
 	// again.
 	Interval *int64 `type:"integer"`
 
-	// The number of requests per second that X-Ray allocated this service.
+	// The number of requests per second that X-Ray allocated for this service.
 	ReservoirQuota *int64 `type:"integer"`
 
 	// When the reservoir quota expires.
@@ -5378,12 +9375,20 @@ type SamplingTargetDocument struct {
 	RuleName *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SamplingTargetDocument) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s SamplingTargetDocument) GoString() string {
 	return s.String()
 }
@@ -5419,12 +9424,13 @@ func (s *SamplingTargetDocument) SetRuleName(v string) *SamplingTargetDocument {
 }
 
 // A segment from a trace that has been ingested by the X-Ray service. The segment
-// can be compiled from documents uploaded with PutTraceSegments, or an inferred
-// segment for a downstream service, generated from a subsegment sent by the
-// service that called it.
+// can be compiled from documents uploaded with PutTraceSegments (https://docs.aws.amazon.com/xray/latest/api/API_PutTraceSegments.html),
+// or an inferred segment for a downstream service, generated from a subsegment
+// sent by the service that called it.
 //
-// For the full segment document schema, see AWS X-Ray Segment Documents (https://docs.aws.amazon.com/xray/latest/devguide/xray-api-segmentdocuments.html)
-// in the AWS X-Ray Developer Guide.
+// For the full segment document schema, see Amazon Web Services X-Ray Segment
+// Documents (https://docs.aws.amazon.com/xray/latest/devguide/xray-api-segmentdocuments.html)
+// in the Amazon Web Services X-Ray Developer Guide.
 type Segment struct {
 	_ struct{} `type:"structure"`
 
@@ -5435,12 +9441,20 @@ type Segment struct {
 	Id *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Segment) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Segment) GoString() string {
 	return s.String()
 }
@@ -5458,12 +9472,12 @@ func (s *Segment) SetId(v string) *Segment {
 }
 
 // Information about an application that processed requests, users that made
-// requests, or downstream services, resources and applications that an application
+// requests, or downstream services, resources, and applications that an application
 // used.
 type Service struct {
 	_ struct{} `type:"structure"`
 
-	// Identifier of the AWS account in which the service runs.
+	// Identifier of the Amazon Web Services account in which the service runs.
 	AccountId *string `type:"string"`
 
 	// A histogram that maps the spread of service durations.
@@ -5501,13 +9515,14 @@ type Service struct {
 
 	// The type of service.
 	//
-	//    * AWS Resource - The type of an AWS resource. For example, AWS::EC2::Instance
-	//    for a application running on Amazon EC2 or AWS::DynamoDB::Table for an
-	//    Amazon DynamoDB table that the application used.
+	//    * Amazon Web Services Resource - The type of an Amazon Web Services resource.
+	//    For example, AWS::EC2::Instance for an application running on Amazon EC2
+	//    or AWS::DynamoDB::Table for an Amazon DynamoDB table that the application
+	//    used.
 	//
-	//    * AWS Service - The type of an AWS service. For example, AWS::DynamoDB
-	//    for downstream calls to Amazon DynamoDB that didn't target a specific
-	//    table.
+	//    * Amazon Web Services Service - The type of an Amazon Web Services service.
+	//    For example, AWS::DynamoDB for downstream calls to Amazon DynamoDB that
+	//    didn't target a specific table.
 	//
 	//    * client - Represents the clients that sent requests to a root service.
 	//
@@ -5515,12 +9530,20 @@ type Service struct {
 	Type *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Service) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Service) GoString() string {
 	return s.String()
 }
@@ -5615,12 +9638,20 @@ type ServiceId struct {
 	Type *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ServiceId) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ServiceId) GoString() string {
 	return s.String()
 }
@@ -5649,64 +9680,266 @@ func (s *ServiceId) SetType(v string) *ServiceId {
 	return s
 }
 
-// Response statistics for a service.
-type ServiceStatistics struct {
+// Response statistics for a service.
+type ServiceStatistics struct {
+	_ struct{} `type:"structure"`
+
+	// Information about requests that failed with a 4xx Client Error status code.
+	ErrorStatistics *ErrorStatistics `type:"structure"`
+
+	// Information about requests that failed with a 5xx Server Error status code.
+	FaultStatistics *FaultStatistics `type:"structure"`
+
+	// The number of requests that completed with a 2xx Success status code.
+	OkCount *int64 `type:"long"`
+
+	// The total number of completed requests.
+	TotalCount *int64 `type:"long"`
+
+	// The aggregate response time of completed requests.
+	TotalResponseTime *float64 `type:"double"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceStatistics) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ServiceStatistics) GoString() string {
+	return s.String()
+}
+
+// SetErrorStatistics sets the ErrorStatistics field's value.
+func (s *ServiceStatistics) SetErrorStatistics(v *ErrorStatistics) *ServiceStatistics {
+	s.ErrorStatistics = v
+	return s
+}
+
+// SetFaultStatistics sets the FaultStatistics field's value.
+func (s *ServiceStatistics) SetFaultStatistics(v *FaultStatistics) *ServiceStatistics {
+	s.FaultStatistics = v
+	return s
+}
+
+// SetOkCount sets the OkCount field's value.
+func (s *ServiceStatistics) SetOkCount(v int64) *ServiceStatistics {
+	s.OkCount = &v
+	return s
+}
+
+// SetTotalCount sets the TotalCount field's value.
+func (s *ServiceStatistics) SetTotalCount(v int64) *ServiceStatistics {
+	s.TotalCount = &v
+	return s
+}
+
+// SetTotalResponseTime sets the TotalResponseTime field's value.
+func (s *ServiceStatistics) SetTotalResponseTime(v float64) *ServiceStatistics {
+	s.TotalResponseTime = &v
+	return s
+}
+
+// A map that contains tag keys and tag values to attach to an Amazon Web Services
+// X-Ray group or sampling rule. For more information about ways to use tags,
+// see Tagging Amazon Web Services resources (https://docs.aws.amazon.com/general/latest/gr/aws_tagging.html)
+// in the Amazon Web Services General Reference.
+//
+// The following restrictions apply to tags:
+//
+//   - Maximum number of user-applied tags per resource: 50
+//
+//   - Tag keys and values are case sensitive.
+//
+//   - Don't use aws: as a prefix for keys; it's reserved for Amazon Web Services
+//     use. You cannot edit or delete system tags.
+type Tag struct {
+	_ struct{} `type:"structure"`
+
+	// A tag key, such as Stage or Name. A tag key cannot be empty. The key can
+	// be a maximum of 128 characters, and can contain only Unicode letters, numbers,
+	// or separators, or the following special characters: + - = . _ : /
+	//
+	// Key is a required field
+	Key *string `min:"1" type:"string" required:"true"`
+
+	// An optional tag value, such as Production or test-only. The value can be
+	// a maximum of 255 characters, and contain only Unicode letters, numbers, or
+	// separators, or the following special characters: + - = . _ : /
+	//
+	// Value is a required field
+	Value *string `type:"string" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Tag) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s Tag) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *Tag) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key == nil {
+		invalidParams.Add(request.NewErrParamRequired("Key"))
+	}
+	if s.Key != nil && len(*s.Key) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Key", 1))
+	}
+	if s.Value == nil {
+		invalidParams.Add(request.NewErrParamRequired("Value"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetKey sets the Key field's value.
+func (s *Tag) SetKey(v string) *Tag {
+	s.Key = &v
+	return s
+}
+
+// SetValue sets the Value field's value.
+func (s *Tag) SetValue(v string) *Tag {
+	s.Value = &v
+	return s
+}
+
+type TagResourceInput struct {
 	_ struct{} `type:"structure"`
 
-	// Information about requests that failed with a 4xx Client Error status code.
-	ErrorStatistics *ErrorStatistics `type:"structure"`
-
-	// Information about requests that failed with a 5xx Server Error status code.
-	FaultStatistics *FaultStatistics `type:"structure"`
-
-	// The number of requests that completed with a 2xx Success status code.
-	OkCount *int64 `type:"long"`
-
-	// The total number of completed requests.
-	TotalCount *int64 `type:"long"`
+	// The Amazon Resource Number (ARN) of an X-Ray group or sampling rule.
+	//
+	// ResourceARN is a required field
+	ResourceARN *string `min:"1" type:"string" required:"true"`
 
-	// The aggregate response time of completed requests.
-	TotalResponseTime *float64 `type:"double"`
+	// A map that contains one or more tag keys and tag values to attach to an X-Ray
+	// group or sampling rule. For more information about ways to use tags, see
+	// Tagging Amazon Web Services resources (https://docs.aws.amazon.com/general/latest/gr/aws_tagging.html)
+	// in the Amazon Web Services General Reference.
+	//
+	// The following restrictions apply to tags:
+	//
+	//    * Maximum number of user-applied tags per resource: 50
+	//
+	//    * Maximum tag key length: 128 Unicode characters
+	//
+	//    * Maximum tag value length: 256 Unicode characters
+	//
+	//    * Valid values for key and value: a-z, A-Z, 0-9, space, and the following
+	//    characters: _ . : / = + - and @
+	//
+	//    * Tag keys and values are case sensitive.
+	//
+	//    * Don't use aws: as a prefix for keys; it's reserved for Amazon Web Services
+	//    use. You cannot edit or delete system tags.
+	//
+	// Tags is a required field
+	Tags []*Tag `type:"list" required:"true"`
 }
 
-// String returns the string representation
-func (s ServiceStatistics) String() string {
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s ServiceStatistics) GoString() string {
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceInput) GoString() string {
 	return s.String()
 }
 
-// SetErrorStatistics sets the ErrorStatistics field's value.
-func (s *ServiceStatistics) SetErrorStatistics(v *ErrorStatistics) *ServiceStatistics {
-	s.ErrorStatistics = v
-	return s
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *TagResourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "TagResourceInput"}
+	if s.ResourceARN == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceARN"))
+	}
+	if s.ResourceARN != nil && len(*s.ResourceARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ResourceARN", 1))
+	}
+	if s.Tags == nil {
+		invalidParams.Add(request.NewErrParamRequired("Tags"))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// SetFaultStatistics sets the FaultStatistics field's value.
-func (s *ServiceStatistics) SetFaultStatistics(v *FaultStatistics) *ServiceStatistics {
-	s.FaultStatistics = v
+// SetResourceARN sets the ResourceARN field's value.
+func (s *TagResourceInput) SetResourceARN(v string) *TagResourceInput {
+	s.ResourceARN = &v
 	return s
 }
 
-// SetOkCount sets the OkCount field's value.
-func (s *ServiceStatistics) SetOkCount(v int64) *ServiceStatistics {
-	s.OkCount = &v
+// SetTags sets the Tags field's value.
+func (s *TagResourceInput) SetTags(v []*Tag) *TagResourceInput {
+	s.Tags = v
 	return s
 }
 
-// SetTotalCount sets the TotalCount field's value.
-func (s *ServiceStatistics) SetTotalCount(v int64) *ServiceStatistics {
-	s.TotalCount = &v
-	return s
+type TagResourceOutput struct {
+	_ struct{} `type:"structure"`
 }
 
-// SetTotalResponseTime sets the TotalResponseTime field's value.
-func (s *ServiceStatistics) SetTotalResponseTime(v float64) *ServiceStatistics {
-	s.TotalResponseTime = &v
-	return s
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TagResourceOutput) GoString() string {
+	return s.String()
 }
 
 type TelemetryRecord struct {
@@ -5726,12 +9959,20 @@ type TelemetryRecord struct {
 	Timestamp *time.Time `type:"timestamp" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TelemetryRecord) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TelemetryRecord) GoString() string {
 	return s.String()
 }
@@ -5785,6 +10026,70 @@ func (s *TelemetryRecord) SetTimestamp(v time.Time) *TelemetryRecord {
 	return s
 }
 
+// The request exceeds the maximum number of requests per second.
+type ThrottledException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ThrottledException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s ThrottledException) GoString() string {
+	return s.String()
+}
+
+func newErrorThrottledException(v protocol.ResponseMetadata) error {
+	return &ThrottledException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *ThrottledException) Code() string {
+	return "ThrottledException"
+}
+
+// Message returns the exception's message.
+func (s *ThrottledException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *ThrottledException) OrigErr() error {
+	return nil
+}
+
+func (s *ThrottledException) Error() string {
+	return fmt.Sprintf("%s: %s", s.Code(), s.Message())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *ThrottledException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *ThrottledException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // A list of TimeSeriesStatistic structures.
 type TimeSeriesServiceStatistics struct {
 	_ struct{} `type:"structure"`
@@ -5795,6 +10100,9 @@ type TimeSeriesServiceStatistics struct {
 	// The response time histogram for the selected entities.
 	ResponseTimeHistogram []*HistogramEntry `type:"list"`
 
+	// The forecasted high and low fault count values.
+	ServiceForecastStatistics *ForecastStatistics `type:"structure"`
+
 	// Response statistics for a service.
 	ServiceSummaryStatistics *ServiceStatistics `type:"structure"`
 
@@ -5802,12 +10110,20 @@ type TimeSeriesServiceStatistics struct {
 	Timestamp *time.Time `type:"timestamp"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TimeSeriesServiceStatistics) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TimeSeriesServiceStatistics) GoString() string {
 	return s.String()
 }
@@ -5824,6 +10140,12 @@ func (s *TimeSeriesServiceStatistics) SetResponseTimeHistogram(v []*HistogramEnt
 	return s
 }
 
+// SetServiceForecastStatistics sets the ServiceForecastStatistics field's value.
+func (s *TimeSeriesServiceStatistics) SetServiceForecastStatistics(v *ForecastStatistics) *TimeSeriesServiceStatistics {
+	s.ServiceForecastStatistics = v
+	return s
+}
+
 // SetServiceSummaryStatistics sets the ServiceSummaryStatistics field's value.
 func (s *TimeSeriesServiceStatistics) SetServiceSummaryStatistics(v *ServiceStatistics) *TimeSeriesServiceStatistics {
 	s.ServiceSummaryStatistics = v
@@ -5836,6 +10158,72 @@ func (s *TimeSeriesServiceStatistics) SetTimestamp(v time.Time) *TimeSeriesServi
 	return s
 }
 
+// You have exceeded the maximum number of tags you can apply to this resource.
+type TooManyTagsException struct {
+	_            struct{}                  `type:"structure"`
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message_ *string `locationName:"Message" type:"string"`
+
+	ResourceName *string `min:"1" type:"string"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyTagsException) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s TooManyTagsException) GoString() string {
+	return s.String()
+}
+
+func newErrorTooManyTagsException(v protocol.ResponseMetadata) error {
+	return &TooManyTagsException{
+		RespMetadata: v,
+	}
+}
+
+// Code returns the exception type name.
+func (s *TooManyTagsException) Code() string {
+	return "TooManyTagsException"
+}
+
+// Message returns the exception's message.
+func (s *TooManyTagsException) Message() string {
+	if s.Message_ != nil {
+		return *s.Message_
+	}
+	return ""
+}
+
+// OrigErr always returns nil, satisfies awserr.Error interface.
+func (s *TooManyTagsException) OrigErr() error {
+	return nil
+}
+
+func (s *TooManyTagsException) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", s.Code(), s.Message(), s.String())
+}
+
+// Status code returns the HTTP status code for the request's response error.
+func (s *TooManyTagsException) StatusCode() int {
+	return s.RespMetadata.StatusCode
+}
+
+// RequestID returns the service's response RequestID for request.
+func (s *TooManyTagsException) RequestID() string {
+	return s.RespMetadata.RequestID
+}
+
 // A collection of segment documents with matching trace IDs.
 type Trace struct {
 	_ struct{} `type:"structure"`
@@ -5848,16 +10236,29 @@ type Trace struct {
 	// and subsegments.
 	Id *string `min:"1" type:"string"`
 
+	// LimitExceeded is set to true when the trace has exceeded the Trace document
+	// size limit. For more information about this limit and other X-Ray limits
+	// and quotas, see Amazon Web Services X-Ray endpoints and quotas (https://docs.aws.amazon.com/general/latest/gr/xray.html).
+	LimitExceeded *bool `type:"boolean"`
+
 	// Segment documents for the segments and subsegments that comprise the trace.
 	Segments []*Segment `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Trace) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s Trace) GoString() string {
 	return s.String()
 }
@@ -5874,6 +10275,12 @@ func (s *Trace) SetId(v string) *Trace {
 	return s
 }
 
+// SetLimitExceeded sets the LimitExceeded field's value.
+func (s *Trace) SetLimitExceeded(v bool) *Trace {
+	s.LimitExceeded = &v
+	return s
+}
+
 // SetSegments sets the Segments field's value.
 func (s *Trace) SetSegments(v []*Segment) *Trace {
 	s.Segments = v
@@ -5887,7 +10294,7 @@ type TraceSummary struct {
 	// Annotations from the trace's segment documents.
 	Annotations map[string][]*ValueWithServiceIds `type:"map"`
 
-	// A list of availability zones for any zone corresponding to the trace segments.
+	// A list of Availability Zones for any zone corresponding to the trace segments.
 	AvailabilityZones []*AvailabilityZoneDetail `type:"list"`
 
 	// The length of time in seconds between the start time of the root segment
@@ -5900,14 +10307,13 @@ type TraceSummary struct {
 	// A collection of ErrorRootCause structures corresponding to the trace segments.
 	ErrorRootCauses []*ErrorRootCause `type:"list"`
 
-	// A collection of FaultRootCause structures corresponding to the the trace
-	// segments.
+	// A collection of FaultRootCause structures corresponding to the trace segments.
 	FaultRootCauses []*FaultRootCause `type:"list"`
 
-	// One or more of the segment documents has a 400 series error.
+	// The root segment document has a 400 series error.
 	HasError *bool `type:"boolean"`
 
-	// One or more of the segment documents has a 500 series error.
+	// The root segment document has a 500 series error.
 	HasFault *bool `type:"boolean"`
 
 	// One or more of the segment documents has a 429 throttling error.
@@ -5948,16 +10354,27 @@ type TraceSummary struct {
 	// Service IDs from the trace's segment documents.
 	ServiceIds []*ServiceId `type:"list"`
 
+	// The start time of a trace, based on the earliest trace segment start time.
+	StartTime *time.Time `type:"timestamp"`
+
 	// Users from the trace's segment documents.
 	Users []*TraceUser `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TraceSummary) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TraceSummary) GoString() string {
 	return s.String()
 }
@@ -6076,6 +10493,12 @@ func (s *TraceSummary) SetServiceIds(v []*ServiceId) *TraceSummary {
 	return s
 }
 
+// SetStartTime sets the StartTime field's value.
+func (s *TraceSummary) SetStartTime(v time.Time) *TraceSummary {
+	s.StartTime = &v
+	return s
+}
+
 // SetUsers sets the Users field's value.
 func (s *TraceSummary) SetUsers(v []*TraceUser) *TraceSummary {
 	s.Users = v
@@ -6093,12 +10516,20 @@ type TraceUser struct {
 	UserName *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TraceUser) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s TraceUser) GoString() string {
 	return s.String()
 }
@@ -6115,8 +10546,8 @@ func (s *TraceUser) SetUserName(v string) *TraceUser {
 	return s
 }
 
-// Sampling statistics from a call to GetSamplingTargets that X-Ray could not
-// process.
+// Sampling statistics from a call to GetSamplingTargets (https://docs.aws.amazon.com/xray/latest/api/API_GetSamplingTargets.html)
+// that X-Ray could not process.
 type UnprocessedStatistics struct {
 	_ struct{} `type:"structure"`
 
@@ -6130,12 +10561,20 @@ type UnprocessedStatistics struct {
 	RuleName *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UnprocessedStatistics) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UnprocessedStatistics) GoString() string {
 	return s.String()
 }
@@ -6172,12 +10611,20 @@ type UnprocessedTraceSegment struct {
 	Message *string `type:"string"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UnprocessedTraceSegment) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UnprocessedTraceSegment) GoString() string {
 	return s.String()
 }
@@ -6200,6 +10647,92 @@ func (s *UnprocessedTraceSegment) SetMessage(v string) *UnprocessedTraceSegment
 	return s
 }
 
+type UntagResourceInput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Number (ARN) of an X-Ray group or sampling rule.
+	//
+	// ResourceARN is a required field
+	ResourceARN *string `min:"1" type:"string" required:"true"`
+
+	// Keys for one or more tags that you want to remove from an X-Ray group or
+	// sampling rule.
+	//
+	// TagKeys is a required field
+	TagKeys []*string `type:"list" required:"true"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *UntagResourceInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "UntagResourceInput"}
+	if s.ResourceARN == nil {
+		invalidParams.Add(request.NewErrParamRequired("ResourceARN"))
+	}
+	if s.ResourceARN != nil && len(*s.ResourceARN) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ResourceARN", 1))
+	}
+	if s.TagKeys == nil {
+		invalidParams.Add(request.NewErrParamRequired("TagKeys"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetResourceARN sets the ResourceARN field's value.
+func (s *UntagResourceInput) SetResourceARN(v string) *UntagResourceInput {
+	s.ResourceARN = &v
+	return s
+}
+
+// SetTagKeys sets the TagKeys field's value.
+func (s *UntagResourceInput) SetTagKeys(v []*string) *UntagResourceInput {
+	s.TagKeys = v
+	return s
+}
+
+type UntagResourceOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
+func (s UntagResourceOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateGroupInput struct {
 	_ struct{} `type:"structure"`
 
@@ -6211,14 +10744,32 @@ type UpdateGroupInput struct {
 
 	// The case-sensitive name of the group.
 	GroupName *string `min:"1" type:"string"`
+
+	// The structure containing configurations related to insights.
+	//
+	//    * The InsightsEnabled boolean can be set to true to enable insights for
+	//    the group or false to disable insights for the group.
+	//
+	//    * The NotificationsEnabled boolean can be set to true to enable insights
+	//    notifications for the group. Notifications can only be enabled on a group
+	//    with InsightsEnabled set to true.
+	InsightsConfiguration *InsightsConfiguration `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateGroupInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateGroupInput) GoString() string {
 	return s.String()
 }
@@ -6257,21 +10808,35 @@ func (s *UpdateGroupInput) SetGroupName(v string) *UpdateGroupInput {
 	return s
 }
 
+// SetInsightsConfiguration sets the InsightsConfiguration field's value.
+func (s *UpdateGroupInput) SetInsightsConfiguration(v *InsightsConfiguration) *UpdateGroupInput {
+	s.InsightsConfiguration = v
+	return s
+}
+
 type UpdateGroupOutput struct {
 	_ struct{} `type:"structure"`
 
 	// The group that was updated. Contains the name of the group that was updated,
-	// the ARN of the group that was updated, and the updated filter expression
-	// assigned to the group.
+	// the ARN of the group that was updated, the updated filter expression, and
+	// the updated insight configuration assigned to the group.
 	Group *Group `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateGroupOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateGroupOutput) GoString() string {
 	return s.String()
 }
@@ -6291,12 +10856,20 @@ type UpdateSamplingRuleInput struct {
 	SamplingRuleUpdate *SamplingRuleUpdate `type:"structure" required:"true"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateSamplingRuleInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateSamplingRuleInput) GoString() string {
 	return s.String()
 }
@@ -6332,12 +10905,20 @@ type UpdateSamplingRuleOutput struct {
 	SamplingRuleRecord *SamplingRuleRecord `type:"structure"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateSamplingRuleOutput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s UpdateSamplingRuleOutput) GoString() string {
 	return s.String()
 }
@@ -6359,12 +10940,20 @@ type ValueWithServiceIds struct {
 	ServiceIds []*ServiceId `type:"list"`
 }
 
-// String returns the string representation
+// String returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ValueWithServiceIds) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
+// GoString returns the string representation.
+//
+// API parameter values that are decorated as "sensitive" in the API will not
+// be included in the string output. The member name will be present, but the
+// value will be replaced with "sensitive".
 func (s ValueWithServiceIds) GoString() string {
 	return s.String()
 }
@@ -6389,6 +10978,14 @@ const (
 	EncryptionStatusActive = "ACTIVE"
 )
 
+// EncryptionStatus_Values returns all elements of the EncryptionStatus enum
+func EncryptionStatus_Values() []string {
+	return []string{
+		EncryptionStatusUpdating,
+		EncryptionStatusActive,
+	}
+}
+
 const (
 	// EncryptionTypeNone is a EncryptionType enum value
 	EncryptionTypeNone = "NONE"
@@ -6397,6 +10994,42 @@ const (
 	EncryptionTypeKms = "KMS"
 )
 
+// EncryptionType_Values returns all elements of the EncryptionType enum
+func EncryptionType_Values() []string {
+	return []string{
+		EncryptionTypeNone,
+		EncryptionTypeKms,
+	}
+}
+
+const (
+	// InsightCategoryFault is a InsightCategory enum value
+	InsightCategoryFault = "FAULT"
+)
+
+// InsightCategory_Values returns all elements of the InsightCategory enum
+func InsightCategory_Values() []string {
+	return []string{
+		InsightCategoryFault,
+	}
+}
+
+const (
+	// InsightStateActive is a InsightState enum value
+	InsightStateActive = "ACTIVE"
+
+	// InsightStateClosed is a InsightState enum value
+	InsightStateClosed = "CLOSED"
+)
+
+// InsightState_Values returns all elements of the InsightState enum
+func InsightState_Values() []string {
+	return []string{
+		InsightStateActive,
+		InsightStateClosed,
+	}
+}
+
 const (
 	// SamplingStrategyNamePartialScan is a SamplingStrategyName enum value
 	SamplingStrategyNamePartialScan = "PartialScan"
@@ -6405,10 +11038,30 @@ const (
 	SamplingStrategyNameFixedRate = "FixedRate"
 )
 
+// SamplingStrategyName_Values returns all elements of the SamplingStrategyName enum
+func SamplingStrategyName_Values() []string {
+	return []string{
+		SamplingStrategyNamePartialScan,
+		SamplingStrategyNameFixedRate,
+	}
+}
+
 const (
 	// TimeRangeTypeTraceId is a TimeRangeType enum value
 	TimeRangeTypeTraceId = "TraceId"
 
 	// TimeRangeTypeEvent is a TimeRangeType enum value
 	TimeRangeTypeEvent = "Event"
+
+	// TimeRangeTypeService is a TimeRangeType enum value
+	TimeRangeTypeService = "Service"
 )
+
+// TimeRangeType_Values returns all elements of the TimeRangeType enum
+func TimeRangeType_Values() []string {
+	return []string{
+		TimeRangeTypeTraceId,
+		TimeRangeTypeEvent,
+		TimeRangeTypeService,
+	}
+}
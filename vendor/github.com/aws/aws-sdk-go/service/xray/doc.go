@@ -3,15 +3,15 @@
 // Package xray provides the client and types for making API
 // requests to AWS X-Ray.
 //
-// AWS X-Ray provides APIs for managing debug traces and retrieving service
-// maps and other data created by processing those traces.
+// Amazon Web Services X-Ray provides APIs for managing debug traces and retrieving
+// service maps and other data created by processing those traces.
 //
 // See https://docs.aws.amazon.com/goto/WebAPI/xray-2016-04-12 for more information on this service.
 //
 // See xray package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/xray/
 //
-// Using the Client
+// # Using the Client
 //
 // To contact AWS X-Ray with the SDK use the New function to create
 // a new service client. With that client you can make API requests to the service.
@@ -1,6 +1,7 @@
 // Code generated by running "go generate" in golang.org/x/text. DO NOT EDIT.
 
-// +build go1.13
+//go:build go1.13 && !go1.14
+// +build go1.13,!go1.14
 
 package idna
 
@@ -2,6 +2,7 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
+// Package shadow defines an Analyzer that checks for shadowed variables.
 package shadow
 
 import (
@@ -119,7 +120,6 @@ func run(pass *analysis.Pass) (interface{}, error) {
 // the block, we should complain about it but don't.
 // - A variable declared inside a function literal can falsely be identified
 // as shadowing a variable in the outer function.
-//
 type span struct {
 	min token.Pos
 	max token.Pos
@@ -160,7 +160,7 @@ func checkShadowAssignment(pass *analysis.Pass, spans map[types.Object]span, a *
 	for _, expr := range a.Lhs {
 		ident, ok := expr.(*ast.Ident)
 		if !ok {
-			pass.Reportf(expr.Pos(), "invalid AST: short variable declaration of non-identifier")
+			pass.ReportRangef(expr, "invalid AST: short variable declaration of non-identifier")
 			return
 		}
 		checkShadowing(pass, spans, ident)
@@ -182,7 +182,7 @@ func idiomaticShortRedecl(pass *analysis.Pass, a *ast.AssignStmt) bool {
 	for i, expr := range a.Lhs {
 		lhs, ok := expr.(*ast.Ident)
 		if !ok {
-			pass.Reportf(expr.Pos(), "invalid AST: short variable declaration of non-identifier")
+			pass.ReportRangef(expr, "invalid AST: short variable declaration of non-identifier")
 			return true // Don't do any more processing.
 		}
 		switch rhs := a.Rhs[i].(type) {
@@ -208,14 +208,15 @@ func idiomaticShortRedecl(pass *analysis.Pass, a *ast.AssignStmt) bool {
 func idiomaticRedecl(d *ast.ValueSpec) bool {
 	// Don't complain about deliberate redeclarations of the form
 	//	var i, j = i, j
+	// Don't ignore redeclarations of the form
+	//	var i = 3
 	if len(d.Names) != len(d.Values) {
 		return false
 	}
 	for i, lhs := range d.Names {
-		if rhs, ok := d.Values[i].(*ast.Ident); ok {
-			if lhs.Name != rhs.Name {
-				return false
-			}
+		rhs, ok := d.Values[i].(*ast.Ident)
+		if !ok || lhs.Name != rhs.Name {
+			return false
 		}
 	}
 	return true
@@ -229,7 +230,7 @@ func checkShadowDecl(pass *analysis.Pass, spans map[types.Object]span, d *ast.Ge
 	for _, spec := range d.Specs {
 		valueSpec, ok := spec.(*ast.ValueSpec)
 		if !ok {
-			pass.Reportf(spec.Pos(), "invalid AST: var GenDecl not ValueSpec")
+			pass.ReportRangef(spec, "invalid AST: var GenDecl not ValueSpec")
 			return
 		}
 		// Don't complain about deliberate redeclarations of the form
@@ -273,7 +274,7 @@ func checkShadowing(pass *analysis.Pass, spans map[types.Object]span, ident *ast
 		// the shadowing identifier.
 		span, ok := spans[shadowed]
 		if !ok {
-			pass.Reportf(ident.Pos(), "internal error: no range for %q", ident.Name)
+			pass.ReportRangef(ident, "internal error: no range for %q", ident.Name)
 			return
 		}
 		if !span.contains(ident.Pos()) {
@@ -283,6 +284,6 @@ func checkShadowing(pass *analysis.Pass, spans map[types.Object]span, ident *ast
 	// Don't complain if the types differ: that implies the programmer really wants two different things.
 	if types.Identical(obj.Type(), shadowed.Type()) {
 		line := pass.Fset.Position(shadowed.Pos()).Line
-		pass.Reportf(ident.Pos(), "declaration of %q shadows declaration at line %d", obj.Name(), line)
+		pass.ReportRangef(ident, "declaration of %q shadows declaration at line %d", obj.Name(), line)
 	}
 }